@@ -0,0 +1,32 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// appendBSON generates the methods of the Marshaler and Unmarshaler interfaces of the MongoDB driver, which store
+// the struct as document with the structure of its FHIR JSON instead of using the bson tags.
+func appendBSON(file *jen.File, s *goStruct) {
+	file.Commentf("MarshalBSON marshals the given %s as BSON document with the structure of its FHIR JSON", s.Name)
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("MarshalBSON").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+		jen.Return(jen.Id("marshalBSON").Call(jen.Id("r"))),
+	)
+	file.Commentf("UnmarshalBSON unmarshals the given %s from a BSON document with the structure of its FHIR JSON", s.Name)
+	file.Func().Params(jen.Id("r").Op("*").Id(s.Name)).Id("UnmarshalBSON").Params(jen.Id("b").Op("[]").Byte()).Error().Block(
+		jen.Return(jen.Id("unmarshalBSON").Call(jen.Id("b"), jen.Id("r"))),
+	)
+}
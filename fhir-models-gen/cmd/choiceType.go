@@ -0,0 +1,140 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	. "strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/samply/golang-fhir-models/fhir-models-gen/fhir"
+)
+
+// primitiveTypeCodes are the FHIR primitive types which can appear in polymorphic elements
+var primitiveTypeCodes = []string{
+	"base64Binary",
+	"boolean",
+	"canonical",
+	"code",
+	"date",
+	"dateTime",
+	"decimal",
+	"id",
+	"instant",
+	"integer",
+	"markdown",
+	"oid",
+	"positiveInt",
+	"string",
+	"time",
+	"unsignedInt",
+	"uri",
+	"url",
+	"uuid",
+}
+
+// generatePrimitiveTypes generates named Go types for FHIR primitive types, so that values of polymorphic elements
+// can be distinguished in type switches.
+func generatePrimitiveTypes() *jen.File {
+	file := jen.NewFile("fhir")
+	appendLicenseComment(file)
+	appendGeneratorComment(file)
+
+	for _, code := range primitiveTypeCodes {
+		file.Commentf("%s is the FHIR primitive type %s used as value of polymorphic elements", Title(code), code)
+		statement := file.Type().Id(Title(code))
+		if code == "decimal" {
+			statement.Qual("encoding/json", "Number")
+		} else {
+			statement.Id(typeCodeToTypeIdentifier(code))
+		}
+	}
+	return file
+}
+
+// appendChoiceType generates a sealed interface for the types of the polymorphic element together with an accessor
+// and a setter on its parent struct.
+func appendChoiceType(file *jen.File, parentName string, element fhir.ElementDefinition) {
+	pathParts := Split(element.Path, ".")
+	name := Replace(pathParts[len(pathParts)-1], "[x]", "", -1)
+	interfaceName := parentName + Title(name)
+	markerName := "is" + interfaceName
+
+	file.Commentf("%s is implemented by the types allowed for %s", interfaceName, element.Path)
+	file.Type().Id(interfaceName).Interface(jen.Id(markerName).Params())
+	for _, elementType := range element.Type {
+		file.Func().Params(jen.Id(choiceTypeIdentifier(elementType.Code))).Id(markerName).Params().Block()
+	}
+
+	file.Commentf("%s returns the value of %s together with its FHIR type code or nil if no value is set", Title(name), element.Path)
+	file.Func().Params(jen.Id("r").Id(parentName)).Id(Title(name)).Params().Params(jen.Id(interfaceName), jen.String()).
+		BlockFunc(func(group *jen.Group) {
+			for _, elementType := range element.Type {
+				field := jen.Id("r").Dot(Title(name + Title(elementType.Code)))
+				value := jen.Op("*").Add(field.Clone())
+				if isPrimitiveTypeCode(elementType.Code) {
+					value = jen.Id(choiceTypeIdentifier(elementType.Code)).Call(value)
+				}
+				group.If(field.Clone().Op("!=").Nil()).Block(jen.Return(value, jen.Lit(elementType.Code)))
+			}
+			group.Return(jen.Nil(), jen.Lit(""))
+		})
+
+	file.Commentf("Set%s sets the value of %s, given as value or pointer, and clears all other types", Title(name), element.Path)
+	file.Func().Params(jen.Id("r").Op("*").Id(parentName)).Id("Set" + Title(name)).Params(jen.Id("value").Id(interfaceName)).
+		BlockFunc(func(group *jen.Group) {
+			for _, elementType := range element.Type {
+				group.Id("r").Dot(Title(name + Title(elementType.Code))).Op("=").Nil()
+			}
+			group.Switch(jen.Id("v").Op(":=").Id("value").Assert(jen.Type())).BlockFunc(func(cases *jen.Group) {
+				for _, elementType := range element.Type {
+					fieldName := Title(name + Title(elementType.Code))
+					if isPrimitiveTypeCode(elementType.Code) {
+						var underlying *jen.Statement
+						if elementType.Code == "decimal" {
+							underlying = jen.Qual("encoding/json", "Number")
+						} else {
+							underlying = jen.Id(typeCodeToTypeIdentifier(elementType.Code))
+						}
+						cases.Case(jen.Id(choiceTypeIdentifier(elementType.Code))).Block(
+							jen.Id("primitive").Op(":=").Add(underlying.Clone()).Call(jen.Id("v")),
+							jen.Id("r").Dot(fieldName).Op("=").Op("&").Id("primitive"),
+						)
+						cases.Case(jen.Op("*").Id(choiceTypeIdentifier(elementType.Code))).Block(
+							jen.Id("r").Dot(fieldName).Op("=").Parens(jen.Op("*").Add(underlying)).Call(jen.Id("v")),
+						)
+					} else {
+						cases.Case(jen.Id(choiceTypeIdentifier(elementType.Code))).Block(
+							jen.Id("r").Dot(fieldName).Op("=").Op("&").Id("v"),
+						)
+						cases.Case(jen.Op("*").Id(choiceTypeIdentifier(elementType.Code))).Block(
+							jen.Id("r").Dot(fieldName).Op("=").Id("v"),
+						)
+					}
+				}
+			})
+		})
+}
+
+func isPrimitiveTypeCode(code string) bool {
+	return unicode.IsLower(rune(code[0]))
+}
+
+func choiceTypeIdentifier(code string) string {
+	if isPrimitiveTypeCode(code) {
+		return Title(code)
+	}
+	return code
+}
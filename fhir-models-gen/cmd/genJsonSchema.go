@@ -0,0 +1,428 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	. "strings"
+	"unicode"
+
+	"github.com/samply/golang-fhir-models/fhir-models-gen/fhir"
+	"github.com/spf13/cobra"
+)
+
+// jsonSchema is the subset of JSON Schema draft 2020-12 needed to describe FHIR JSON
+type jsonSchema struct {
+	Schema               string                   `json:"$schema,omitempty"`
+	Id                   string                   `json:"$id,omitempty"`
+	Ref                  string                   `json:"$ref,omitempty"`
+	Description          string                   `json:"description,omitempty"`
+	Discriminator        *jsonSchemaDiscriminator `json:"discriminator,omitempty"`
+	OneOf                []*jsonSchema            `json:"oneOf,omitempty"`
+	Type                 string                   `json:"type,omitempty"`
+	Const                string                   `json:"const,omitempty"`
+	Pattern              string                   `json:"pattern,omitempty"`
+	Minimum              *int                     `json:"minimum,omitempty"`
+	Enum                 []string                 `json:"enum,omitempty"`
+	Items                *jsonSchema              `json:"items,omitempty"`
+	MinItems             int                      `json:"minItems,omitempty"`
+	MaxItems             *int                     `json:"maxItems,omitempty"`
+	Properties           jsonSchemaProperties     `json:"properties,omitempty"`
+	AdditionalProperties *bool                    `json:"additionalProperties,omitempty"`
+	Required             []string                 `json:"required,omitempty"`
+	Defs                 map[string]*jsonSchema   `json:"$defs,omitempty"`
+}
+
+// jsonSchemaDiscriminator is the OpenAPI discriminator also found in the fhir.schema.json of the specification
+type jsonSchemaDiscriminator struct {
+	PropertyName string            `json:"propertyName"`
+	Mapping      map[string]string `json:"mapping"`
+}
+
+type jsonSchemaProperty struct {
+	name   string
+	schema *jsonSchema
+}
+
+// jsonSchemaProperties keeps the properties in the order of the elements
+type jsonSchemaProperties []jsonSchemaProperty
+
+func (p jsonSchemaProperties) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	buf.WriteByte('{')
+	for i, property := range p {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := enc.Encode(property.name); err != nil {
+			return nil, err
+		}
+		buf.WriteByte(':')
+		if err := enc.Encode(property.schema); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func jsonSchemaRef(name string) *jsonSchema {
+	return &jsonSchema{Ref: "#/$defs/" + name}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+// jsonSchemaPrimitives returns the definitions of the primitive types with the regular expressions of the specification
+func jsonSchemaPrimitives() map[string]*jsonSchema {
+	const date = `([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)`
+	const time = `([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]+)?`
+	const zone = `(Z|(\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00))`
+	return map[string]*jsonSchema{
+		"base64Binary": {Type: "string", Pattern: `^(\s*([0-9a-zA-Z\+/=]){4}\s*)+$`, Description: "A stream of bytes, base64 encoded"},
+		"boolean":      {Type: "boolean", Description: "Value of \"true\" or \"false\""},
+		"canonical":    {Type: "string", Pattern: `^\S*$`, Description: "A URI that refers to a resource by its canonical URL (resources with a url property). The canonical type differs from a uri in that it has special meaning in this specification, and in that it may have a version appended, separated by a vertical bar (|)."},
+		"code":         {Type: "string", Pattern: `^[^\s]+(\s[^\s]+)*$`, Description: "A string which has at least one character and no leading or trailing whitespace and where there is no whitespace other than single spaces in the contents"},
+		"date":         {Type: "string", Pattern: `^` + date + `(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1]))?)?$`, Description: "A date or partial date (e.g. just year or year + month). There is no time zone. The format is a union of the schema types gYear, gYearMonth and date.  Dates SHALL be valid dates."},
+		"dateTime":     {Type: "string", Pattern: `^` + date + `(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1])(T` + time + zone + `)?)?)?$`, Description: "A date, date-time or partial date (e.g. just year or year + month).  If hours and minutes are specified, a time zone SHALL be populated. The format is a union of the schema types gYear, gYearMonth, date and dateTime. Seconds must be provided due to schema type constraints but may be zero-filled and may be ignored.                 Dates SHALL be valid dates."},
+		"decimal":      {Type: "number", Description: "A rational number with implicit precision"},
+		"id":           {Type: "string", Pattern: `^[A-Za-z0-9\-\.]{1,64}$`, Description: "Any combination of letters, numerals, \"-\" and \".\", with a length limit of 64 characters.  (This might be an integer, an unprefixed OID, UUID or any other identifier pattern that meets these constraints.)  Ids are case-insensitive."},
+		"instant":      {Type: "string", Pattern: `^` + date + `-(0[1-9]|1[0-2])-(0[1-9]|[1-2][0-9]|3[0-1])T` + time + zone + `$`, Description: "An instant in time - known at least to the second"},
+		"integer":      {Type: "integer", Description: "A whole number"},
+		"markdown":     {Type: "string", Pattern: `^[ \r\n\t\S]+$`, Description: "A string that may contain Github Flavored Markdown syntax for optional processing by a mark down presentation engine"},
+		"oid":          {Type: "string", Pattern: `^urn:oid:[0-2](\.(0|[1-9][0-9]*))+$`, Description: "An OID represented as a URI"},
+		"positiveInt":  {Type: "integer", Minimum: intPtr(1), Description: "An integer with a value that is positive (e.g. >0)"},
+		"string":       {Type: "string", Pattern: `^[ \r\n\t\S]+$`, Description: "A sequence of Unicode characters"},
+		"time":         {Type: "string", Pattern: `^` + time + `$`, Description: "A time during the day, with no date specified"},
+		"unsignedInt":  {Type: "integer", Minimum: intPtr(0), Description: "An integer with a value that is not negative (e.g. >= 0)"},
+		"uri":          {Type: "string", Pattern: `^\S*$`, Description: "String of characters used to identify a name or a resource"},
+		"url":          {Type: "string", Pattern: `^\S*$`, Description: "A URI that is a literal reference"},
+		"uuid":         {Type: "string", Pattern: `^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, Description: "A UUID, represented as a URI"},
+		"xhtml":        {Type: "string", Description: "xhtml - escaped html (see specfication)"},
+	}
+}
+
+// genJsonSchemaCmd represents the genJsonSchema command
+var genJsonSchemaCmd = &cobra.Command{
+	Use:   "gen-jsonschema",
+	Short: "Generates a JSON Schema from FHIR resource structure definitions and profiles.",
+	Long: `Generates a JSON Schema from FHIR resource structure definitions and profiles.
+
+Profiles get a definition named after them with their cardinalities and required bindings. Slices and extension
+profiles are not part of the schema: sliced elements only get the constraints of the element itself, so extensions
+are accepted as any Extension, and fixed values, patterns and invariants are not checked.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		dir := args[0]
+
+		resources, err := loadResources(dir)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		schema, err := generateJSONSchema(resources)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		err = saveJSONSchema(schema, "fhir.schema.json")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	},
+}
+
+// jsonSchemaGenerator collects the definitions of the schema
+type jsonSchemaGenerator struct {
+	resources ResourceMap
+	defs      map[string]*jsonSchema
+}
+
+// generateJSONSchema returns a schema accepting any resource, which has a definition for every type, resource,
+// backbone element and profile. Definitions of profiles are named after the profile and contain the cardinalities and
+// required bindings of its snapshot. Extension definitions are left out, as extensions are covered by Extension, and so
+// are the slices of profiles, so that the schema doesn't check the extensions or slices a profile requires.
+func generateJSONSchema(resources ResourceMap) (*jsonSchema, error) {
+	g := &jsonSchemaGenerator{resources: resources, defs: jsonSchemaPrimitives()}
+
+	var names []string
+	for name := range resources["StructureDefinition"] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var resourceNames []string
+	for _, name := range names {
+		structureDefinition, err := fhir.UnmarshalStructureDefinition(resources["StructureDefinition"][name])
+		if err != nil {
+			return nil, err
+		}
+		profile := structureDefinition.Derivation != nil && *structureDefinition.Derivation == fhir.TypeDerivationRuleConstraint
+		switch {
+		case structureDefinition.Kind != fhir.StructureDefinitionKindResource &&
+			structureDefinition.Kind != fhir.StructureDefinitionKindComplexType:
+			continue
+		case profile && structureDefinition.Type == "Extension":
+			continue
+		case structureDefinition.Kind == fhir.StructureDefinitionKindResource && !profile && !structureDefinition.Abstract:
+			resourceNames = append(resourceNames, structureDefinition.Name)
+		}
+		fmt.Printf("Generate JSON Schema for StructureDefinition: %s\n", structureDefinition.Name)
+		if err := g.addStructureDefinition(structureDefinition); err != nil {
+			return nil, err
+		}
+	}
+
+	resourceList := &jsonSchema{}
+	mapping := make(map[string]string, len(resourceNames))
+	for _, name := range resourceNames {
+		resourceList.OneOf = append(resourceList.OneOf, jsonSchemaRef(name))
+		mapping[name] = "#/$defs/" + name
+	}
+	g.defs["ResourceList"] = resourceList
+
+	return &jsonSchema{
+		Schema:        "https://json-schema.org/draft/2020-12/schema",
+		Id:            "http://hl7.org/fhir/json-schema/4.0",
+		Description:   "see http://hl7.org/fhir/json.html#schema for information about the FHIR Json Schemas",
+		Discriminator: &jsonSchemaDiscriminator{PropertyName: "resourceType", Mapping: mapping},
+		OneOf:         resourceList.OneOf,
+		Defs:          g.defs,
+	}, nil
+}
+
+func (g *jsonSchemaGenerator) addStructureDefinition(definition fhir.StructureDefinition) error {
+	if definition.Snapshot == nil || len(definition.Snapshot.Element) == 0 {
+		return fmt.Errorf("missing element definitions in structure definition `%s`", definition.Name)
+	}
+	if g.defs[definition.Name] != nil {
+		return fmt.Errorf("duplicate JSON Schema definition `%s`", definition.Name)
+	}
+
+	// slices only constrain the elements they are part of and are left out
+	var elements []fhir.ElementDefinition
+	for _, element := range definition.Snapshot.Element {
+		if element.SliceName == nil && (element.Id == nil || !Contains(*element.Id, ":")) {
+			elements = append(elements, element)
+		}
+	}
+
+	resourceType := ""
+	if definition.Kind == fhir.StructureDefinitionKindResource {
+		resourceType = definition.Type
+	}
+	return g.addObject(definition.Name, definition.Name, elements, 0, resourceType)
+}
+
+// addObject adds the definition of the object described by the element at index and its children. Backbone elements
+// are named after the root definition and the path of the element, like Patient_Contact or Bundle_EntryRequest.
+func (g *jsonSchemaGenerator) addObject(root, name string, elements []fhir.ElementDefinition, index int, resourceType string) error {
+	object := &jsonSchema{
+		Description:          elementDescription(elements[index]),
+		Type:                 "object",
+		AdditionalProperties: new(bool),
+	}
+	g.defs[name] = object
+	if resourceType != "" {
+		object.Properties = append(object.Properties, jsonSchemaProperty{"resourceType", &jsonSchema{
+			Description: "This is a " + resourceType + " resource",
+			Const:       resourceType,
+		}})
+		object.Required = append(object.Required, "resourceType")
+	}
+
+	path := elements[index].Path
+	for i, element := range elements {
+		if !HasPrefix(element.Path, path+".") || Contains(element.Path[len(path)+1:], ".") {
+			continue
+		}
+		if element.Max != nil && *element.Max == "0" {
+			continue
+		}
+		elementName := element.Path[len(path)+1:]
+
+		if element.ContentReference != nil {
+			reference := *element.ContentReference
+			schema := jsonSchemaRef(backboneElementName(root, reference[Index(reference, "#")+1:]))
+			g.addProperty(object, elementName, element, schema, false)
+			continue
+		}
+
+		choice := HasSuffix(elementName, "[x]")
+		for _, elementType := range element.Type {
+			propertyName := elementName
+			if choice {
+				propertyName = TrimSuffix(elementName, "[x]") + Title(elementType.Code)
+			}
+			var schema *jsonSchema
+			primitive := false
+			switch code := elementType.Code; {
+			case code == "Resource":
+				schema = jsonSchemaRef("ResourceList")
+			case code == "Element" || code == "BackboneElement":
+				backboneName := backboneElementName(root, element.Path)
+				if err := g.addObject(root, backboneName, elements, i, ""); err != nil {
+					return err
+				}
+				schema = jsonSchemaRef(backboneName)
+			case HasPrefix(code, "http://hl7.org/fhirpath/System."):
+				schema = jsonSchemaRef(systemTypeCode(code))
+			case unicode.IsLower(rune(code[0])):
+				primitive = true
+				schema = jsonSchemaRef(code)
+				if code == "code" {
+					codes, err := g.requiredBindingCodes(element)
+					if err != nil {
+						return err
+					}
+					if codes != nil {
+						schema = &jsonSchema{Enum: codes}
+					}
+				}
+			default:
+				schema = jsonSchemaRef(code)
+			}
+			g.addProperty(object, propertyName, element, schema, primitive)
+			if !choice && element.Min != nil && *element.Min > 0 {
+				object.Required = append(object.Required, propertyName)
+			}
+		}
+	}
+	return nil
+}
+
+// addProperty adds the property holding the value of the element, which is an array for repeating elements. Primitive
+// values get the additional property for their id and extensions prefixed with an underscore.
+func (g *jsonSchemaGenerator) addProperty(object *jsonSchema, name string, element fhir.ElementDefinition, schema *jsonSchema, primitive bool) {
+	extensions := jsonSchemaRef("Element")
+	if isArrayElement(element) {
+		schema = &jsonSchema{Type: "array", Items: schema}
+		if element.Min != nil {
+			schema.MinItems = *element.Min
+		}
+		if element.Max != nil && *element.Max != "*" {
+			if max, err := strconv.Atoi(*element.Max); err == nil {
+				schema.MaxItems = &max
+			}
+		}
+		extensions = &jsonSchema{Type: "array", Items: extensions}
+	}
+	schema.Description = elementDescription(element)
+	object.Properties = append(object.Properties, jsonSchemaProperty{name, schema})
+	if primitive {
+		extensions.Description = "Extensions for " + name
+		object.Properties = append(object.Properties, jsonSchemaProperty{"_" + name, extensions})
+	}
+}
+
+// requiredBindingCodes returns the codes of the ValueSet the element is bound to, if it is a required binding and the
+// ValueSet can be resolved into an enum like in generateValueSet
+func (g *jsonSchemaGenerator) requiredBindingCodes(element fhir.ElementDefinition) ([]string, error) {
+	url := requiredValueSetBinding(element)
+	if url == nil {
+		return nil, nil
+	}
+	bytes := g.resources["ValueSet"][*url]
+	if bytes == nil {
+		return nil, nil
+	}
+	valueSet, err := fhir.UnmarshalValueSet(bytes)
+	if err != nil {
+		return nil, err
+	}
+	if valueSet.Name == nil {
+		return nil, nil
+	}
+	codeSystem, err := valueSetCodeSystem(g.resources, valueSet)
+	if err != nil {
+		return nil, nil
+	}
+	return conceptCodes(codeSystem.Concept), nil
+}
+
+// isArrayElement returns true if the element is represented as array, which depends on the cardinality of the base
+// element, as profiles can restrict repeating elements to a single value
+func isArrayElement(element fhir.ElementDefinition) bool {
+	if element.Base != nil && element.Base.Max != "" {
+		return element.Base.Max != "1"
+	}
+	return element.Max != nil && *element.Max != "1"
+}
+
+func elementDescription(element fhir.ElementDefinition) string {
+	if element.Definition != nil {
+		return *element.Definition
+	}
+	if element.Short != nil {
+		return *element.Short
+	}
+	return ""
+}
+
+func backboneElementName(root, path string) string {
+	parts := Split(path, ".")
+	if len(parts) == 1 {
+		return root
+	}
+	name := root + "_"
+	for _, part := range parts[1:] {
+		name += Title(part)
+	}
+	return name
+}
+
+// systemTypeCode returns the primitive type corresponding to a FHIRPath system type like the type of Element.id
+func systemTypeCode(code string) string {
+	switch code = TrimPrefix(code, "http://hl7.org/fhirpath/System."); code {
+	case "DateTime":
+		return "dateTime"
+	default:
+		return ToLower(code)
+	}
+}
+
+func saveJSONSchema(schema *jsonSchema, filename string) error {
+	b, err := encodeJSONSchema(schema)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, b, 0644)
+}
+
+// encodeJSONSchema returns the indented JSON of the schema
+func encodeJSONSchema(schema *jsonSchema) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(schema); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func init() {
+	rootCmd.AddCommand(genJsonSchemaCmd)
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"os"
+	"testing"
+)
+
+// TestJSONSchemaGolden generates the schema of reduced Patient and Observation definitions, a Patient profile with a
+// slice and an extension profile. The schema has to match testdata/sample.schema.json.
+func TestJSONSchemaGolden(t *testing.T) {
+	resources, err := loadResources("testdata/jsonschema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := generateJSONSchema(resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := encodeJSONSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("testdata/sample.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
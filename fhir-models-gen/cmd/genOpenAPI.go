@@ -0,0 +1,754 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	. "strings"
+	"unicode"
+
+	"github.com/samply/golang-fhir-models/fhir-models-gen/fhir"
+	"github.com/spf13/cobra"
+)
+
+const fhirJSONMediaType = "application/fhir+json"
+
+// openAPI is the subset of an OpenAPI 3.1 document needed to describe a FHIR RESTful API
+type openAPI struct {
+	OpenAPI    string                      `json:"openapi"`
+	Info       openAPIInfo                 `json:"info"`
+	Servers    []openAPIServer             `json:"servers,omitempty"`
+	Tags       []openAPITag                `json:"tags,omitempty"`
+	Paths      map[string]*openAPIPathItem `json:"paths"`
+	Components openAPIComponents           `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type openAPIServer struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+type openAPITag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type openAPIPathItem struct {
+	Get    *openAPIOperation `json:"get,omitempty"`
+	Put    *openAPIOperation `json:"put,omitempty"`
+	Post   *openAPIOperation `json:"post,omitempty"`
+	Delete *openAPIOperation `json:"delete,omitempty"`
+	Patch  *openAPIOperation `json:"patch,omitempty"`
+}
+
+type openAPIOperation struct {
+	Tags        []string                    `json:"tags,omitempty"`
+	Summary     string                      `json:"summary,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	OperationId string                      `json:"operationId"`
+	Parameters  []*openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string      `json:"name"`
+	In          string      `json:"in"`
+	Description string      `json:"description,omitempty"`
+	Required    bool        `json:"required,omitempty"`
+	Schema      *jsonSchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIMediaType struct {
+	Schema *jsonSchema `json:"schema"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*jsonSchema `json:"schemas"`
+}
+
+// openAPIOutput is the file the gen-openapi command writes the document to
+var openAPIOutput string
+
+// genOpenAPICmd represents the genOpenAPI command
+var genOpenAPICmd = &cobra.Command{
+	Use:   "gen-openapi <definitions dir> <capability statement>",
+	Short: "Generates an OpenAPI document from FHIR definitions and a CapabilityStatement.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		resources, err := loadResources(args[0])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		bytes, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		capabilityStatement, err := fhir.UnmarshalCapabilityStatement(bytes)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		document, err := generateOpenAPI(resources, capabilityStatement)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		err = saveOpenAPI(document, openAPIOutput)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	},
+}
+
+// openAPIGenerator collects the paths of the document and the names of the schemas they reference
+type openAPIGenerator struct {
+	resources  ResourceMap
+	schema     *jsonSchema
+	profiles   map[string]string // canonical URL of a profile to the name of its schema
+	patchTypes []string
+	document   *openAPI
+}
+
+// generateOpenAPI returns an OpenAPI 3.1 document describing the interactions, search parameters and operations the
+// server mode rest entries of the CapabilityStatement support. Request and response bodies reference the JSON Schema
+// of the resource or its profile in the components of the document, which only contain the schemas in use. Only the
+// JSON format is described.
+func generateOpenAPI(resources ResourceMap, capabilityStatement fhir.CapabilityStatement) (*openAPI, error) {
+	schema, err := generateJSONSchema(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make(map[string]string)
+	for name, bytes := range resources["StructureDefinition"] {
+		structureDefinition, err := fhir.UnmarshalStructureDefinition(bytes)
+		if err != nil {
+			return nil, err
+		}
+		if schema.Defs[name] != nil {
+			profiles[structureDefinition.Url] = name
+			if structureDefinition.Version != nil {
+				profiles[structureDefinition.Url+"|"+*structureDefinition.Version] = name
+			}
+		}
+	}
+
+	g := &openAPIGenerator{
+		resources:  resources,
+		schema:     schema,
+		profiles:   profiles,
+		patchTypes: capabilityStatement.PatchFormat,
+		document: &openAPI{
+			OpenAPI: "3.1.0",
+			Info:    openAPIInfo{Title: "FHIR API", Version: capabilityStatement.FhirVersion.Code()},
+			Paths:   make(map[string]*openAPIPathItem),
+		},
+	}
+	if capabilityStatement.Title != nil {
+		g.document.Info.Title = *capabilityStatement.Title
+	} else if capabilityStatement.Name != nil {
+		g.document.Info.Title = *capabilityStatement.Name
+	}
+	if capabilityStatement.Version != nil {
+		g.document.Info.Version = *capabilityStatement.Version
+	}
+	if capabilityStatement.Description != nil {
+		g.document.Info.Description = *capabilityStatement.Description
+	}
+	if implementation := capabilityStatement.Implementation; implementation != nil && implementation.Url != nil {
+		g.document.Servers = []openAPIServer{{URL: *implementation.Url, Description: implementation.Description}}
+	}
+
+	found := false
+	for _, rest := range capabilityStatement.Rest {
+		if rest.Mode != fhir.RestfulCapabilityModeServer {
+			continue
+		}
+		found = true
+		fmt.Println("Generate OpenAPI paths for the server mode of the CapabilityStatement")
+		g.addCapabilities()
+		for _, resource := range rest.Resource {
+			if err := g.addResource(rest, resource); err != nil {
+				return nil, err
+			}
+		}
+		if err := g.addSystem(rest); err != nil {
+			return nil, err
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("the CapabilityStatement has no rest entry in server mode")
+	}
+
+	g.document.Components.Schemas = g.usedSchemas()
+	return g.document, nil
+}
+
+// addResource adds the paths of the interactions and operations on a resource type
+func (g *openAPIGenerator) addResource(rest fhir.CapabilityStatementRest, resource fhir.CapabilityStatementRestResource) error {
+	resourceType := resource.Type.Code()
+	if g.schema.Defs[resourceType] == nil {
+		return fmt.Errorf("missing StructureDefinition of resource `%s`", resourceType)
+	}
+	fmt.Printf("Generate OpenAPI paths for resource: %s\n", resourceType)
+
+	tag := openAPITag{Name: resourceType}
+	if resource.Documentation != nil {
+		tag.Description = *resource.Documentation
+	}
+	g.document.Tags = append(g.document.Tags, tag)
+
+	body := g.schemaRef(resourceType)
+	if resource.Profile != nil {
+		if name, ok := g.profiles[*resource.Profile]; ok {
+			body = g.schemaRef(name)
+		} else if name, ok := g.profiles[canonicalURL(*resource.Profile)]; ok {
+			body = g.schemaRef(name)
+		}
+	}
+
+	typePath := "/" + resourceType
+	instancePath := typePath + "/{id}"
+	id := &openAPIParameter{Name: "id", In: "path", Description: "The logical id of the resource", Required: true, Schema: g.schemaRef("id")}
+	for _, interaction := range resource.Interaction {
+		code := interaction.Code.Code()
+		operation := &openAPIOperation{
+			Tags:        []string{resourceType},
+			OperationId: resourceType + "-" + code,
+			Responses:   map[string]*openAPIResponse{"default": g.response("Error", "OperationOutcome")},
+		}
+		if interaction.Documentation != nil {
+			operation.Description = *interaction.Documentation
+		}
+		switch interaction.Code {
+		case fhir.TypeRestfulInteractionRead:
+			operation.Summary = "Read the current state of a " + resourceType
+			operation.Parameters = []*openAPIParameter{id}
+			operation.Responses["200"] = g.bodyResponse("The "+resourceType, body)
+			g.path(instancePath).Get = operation
+		case fhir.TypeRestfulInteractionVread:
+			operation.Summary = "Read a version of a " + resourceType
+			operation.Parameters = []*openAPIParameter{id, {Name: "vid", In: "path", Description: "The version id", Required: true, Schema: g.schemaRef("id")}}
+			operation.Responses["200"] = g.bodyResponse("The version of the "+resourceType, body)
+			g.path(instancePath + "/_history/{vid}").Get = operation
+		case fhir.TypeRestfulInteractionUpdate:
+			operation.Summary = "Update a " + resourceType
+			operation.Parameters = []*openAPIParameter{id}
+			if resource.Versioning != nil && *resource.Versioning == fhir.ResourceVersionPolicyVersionedUpdate {
+				operation.Parameters = append(operation.Parameters, &openAPIParameter{Name: "If-Match", In: "header", Description: "The ETag of the version the update is based on", Schema: &jsonSchema{Type: "string"}})
+			}
+			operation.RequestBody = g.requestBody(body)
+			operation.Responses["200"] = g.bodyResponse("The updated "+resourceType, body)
+			if resource.UpdateCreate != nil && *resource.UpdateCreate {
+				operation.Responses["201"] = g.bodyResponse("The created "+resourceType, body)
+			}
+			g.path(instancePath).Put = operation
+		case fhir.TypeRestfulInteractionPatch:
+			operation.Summary = "Patch a " + resourceType
+			operation.Parameters = []*openAPIParameter{id}
+			operation.RequestBody = g.patchBody()
+			operation.Responses["200"] = g.bodyResponse("The patched "+resourceType, body)
+			g.path(instancePath).Patch = operation
+		case fhir.TypeRestfulInteractionDelete:
+			operation.Summary = "Delete a " + resourceType
+			operation.Parameters = []*openAPIParameter{id}
+			operation.Responses["200"] = g.response("Deleted", "OperationOutcome")
+			operation.Responses["204"] = &openAPIResponse{Description: "Deleted"}
+			g.path(instancePath).Delete = operation
+		case fhir.TypeRestfulInteractionHistoryInstance:
+			operation.Summary = "Read the history of a " + resourceType
+			operation.Parameters = append([]*openAPIParameter{id}, historyParameters()...)
+			operation.Responses["200"] = g.response("A history Bundle", "Bundle")
+			g.path(instancePath + "/_history").Get = operation
+		case fhir.TypeRestfulInteractionHistoryType:
+			operation.Summary = "Read the history of all " + resourceType + " resources"
+			operation.Parameters = historyParameters()
+			operation.Responses["200"] = g.response("A history Bundle", "Bundle")
+			g.path(typePath + "/_history").Get = operation
+		case fhir.TypeRestfulInteractionCreate:
+			operation.Summary = "Create a " + resourceType
+			if resource.ConditionalCreate != nil && *resource.ConditionalCreate {
+				operation.Parameters = []*openAPIParameter{{Name: "If-None-Exist", In: "header", Description: "Search parameters matching an existing resource, which prevents the creation", Schema: &jsonSchema{Type: "string"}}}
+			}
+			operation.RequestBody = g.requestBody(body)
+			operation.Responses["201"] = g.bodyResponse("The created "+resourceType, body)
+			g.path(typePath).Post = operation
+		case fhir.TypeRestfulInteractionSearchType:
+			parameters := g.searchParameters(resource, append(resource.SearchParam, rest.SearchParam...))
+			operation.Summary = "Search " + resourceType + " resources"
+			operation.Parameters = parameters
+			operation.Responses["200"] = g.response("A searchset Bundle", "Bundle")
+			g.path(typePath).Get = operation
+
+			post := *operation
+			post.OperationId += "-post"
+			post.Parameters = nil
+			post.RequestBody = formBody(parameters)
+			g.path(typePath + "/_search").Post = &post
+		}
+	}
+
+	for _, operation := range resource.Operation {
+		if err := g.addOperation(resourceType, operation, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addSystem adds the paths of the interactions and operations of the whole system
+func (g *openAPIGenerator) addSystem(rest fhir.CapabilityStatementRest) error {
+	for _, interaction := range rest.Interaction {
+		code := interaction.Code.Code()
+		operation := &openAPIOperation{
+			Tags:        []string{"System"},
+			OperationId: code,
+			Responses:   map[string]*openAPIResponse{"default": g.response("Error", "OperationOutcome")},
+		}
+		if interaction.Documentation != nil {
+			operation.Description = *interaction.Documentation
+		}
+		switch interaction.Code {
+		case fhir.SystemRestfulInteractionTransaction, fhir.SystemRestfulInteractionBatch:
+			operation.Summary = "Process a " + code + " Bundle"
+			operation.RequestBody = g.requestBody(g.schemaRef("Bundle"))
+			operation.Responses["200"] = g.response("The "+code+"-response Bundle", "Bundle")
+			if g.path("/").Post != nil {
+				operation.OperationId = "transaction-or-batch"
+				operation.Summary = "Process a transaction or batch Bundle"
+			}
+			g.path("/").Post = operation
+		case fhir.SystemRestfulInteractionHistorySystem:
+			operation.Summary = "Read the history of all resources"
+			operation.Parameters = historyParameters()
+			operation.Responses["200"] = g.response("A history Bundle", "Bundle")
+			g.path("/_history").Get = operation
+		case fhir.SystemRestfulInteractionSearchSystem:
+			operation.Summary = "Search resources of all types"
+			operation.Parameters = g.searchParameters(fhir.CapabilityStatementRestResource{}, rest.SearchParam)
+			operation.Parameters = append(operation.Parameters, &openAPIParameter{Name: "_type", In: "query", Description: "The resource types to search", Schema: &jsonSchema{Type: "string"}})
+			operation.Responses["200"] = g.response("A searchset Bundle", "Bundle")
+			g.path("/").Get = operation
+		}
+	}
+	for _, operation := range rest.Operation {
+		if err := g.addOperation("", operation, true); err != nil {
+			return err
+		}
+	}
+	if len(rest.Interaction) > 0 || len(rest.Operation) > 0 {
+		g.document.Tags = append(g.document.Tags, openAPITag{Name: "System"})
+	}
+	return nil
+}
+
+// addCapabilities adds the path of the capabilities interaction, which every server supports
+func (g *openAPIGenerator) addCapabilities() {
+	g.path("/metadata").Get = &openAPIOperation{
+		Tags:        []string{"System"},
+		Summary:     "Read the CapabilityStatement of the server",
+		OperationId: "capabilities",
+		Responses: map[string]*openAPIResponse{
+			"200":     g.response("The CapabilityStatement", "CapabilityStatement"),
+			"default": g.response("Error", "OperationOutcome"),
+		},
+	}
+}
+
+// addOperation adds the paths of an operation, invoked with POST and Parameters. If its OperationDefinition is found,
+// it is added on the levels it defines and if it doesn't affect the state and has only primitive input parameters, it
+// can also be invoked with GET. Without OperationDefinition operations on resources are invoked on the type level.
+func (g *openAPIGenerator) addOperation(resourceType string, operation fhir.CapabilityStatementRestResourceOperation, system bool) error {
+	var definition *fhir.OperationDefinition
+	bytes := g.resources["OperationDefinition"][operation.Definition]
+	if bytes == nil {
+		bytes = g.resources["OperationDefinition"][canonicalURL(operation.Definition)]
+	}
+	if bytes != nil {
+		d, err := fhir.UnmarshalOperationDefinition(bytes)
+		if err != nil {
+			return err
+		}
+		definition = &d
+	}
+
+	var paths []string
+	switch {
+	case system:
+		paths = []string{"/$" + operation.Name}
+	case definition == nil:
+		paths = []string{"/" + resourceType + "/$" + operation.Name}
+	default:
+		if definition.Type {
+			paths = append(paths, "/"+resourceType+"/$"+operation.Name)
+		}
+		if definition.Instance {
+			paths = append(paths, "/"+resourceType+"/{id}/$"+operation.Name)
+		}
+	}
+
+	tag := "System"
+	if resourceType != "" {
+		tag = resourceType
+	}
+	response := g.response("The output parameters", "Parameters")
+	summary := "Invoke the operation $" + operation.Name
+	description := ""
+	var queryParameters []*openAPIParameter
+	get := definition != nil
+	if definition != nil {
+		if definition.Title != nil {
+			summary = *definition.Title
+		}
+		if definition.Description != nil {
+			description = *definition.Description
+		}
+		if definition.AffectsState != nil && *definition.AffectsState {
+			get = false
+		}
+		var outputs []fhir.OperationDefinitionParameter
+		for _, parameter := range definition.Parameter {
+			if parameter.Use == fhir.OperationParameterUseOut {
+				outputs = append(outputs, parameter)
+				continue
+			}
+			if len(parameter.Part) > 0 || parameter.Type == nil || !g.isPrimitive(*parameter.Type) {
+				get = false
+				continue
+			}
+			schema := g.schemaRef(*parameter.Type)
+			if parameter.Max != "1" {
+				schema = &jsonSchema{Type: "array", Items: schema}
+			}
+			queryParameter := &openAPIParameter{Name: parameter.Name, In: "query", Required: parameter.Min > 0, Schema: schema}
+			if parameter.Documentation != nil {
+				queryParameter.Description = *parameter.Documentation
+			}
+			queryParameters = append(queryParameters, queryParameter)
+		}
+		// a single resource named return is returned directly instead of in Parameters
+		if len(outputs) == 1 && outputs[0].Name == "return" && outputs[0].Type != nil && g.isResource(*outputs[0].Type) {
+			name := *outputs[0].Type
+			if name == "Resource" || name == "DomainResource" {
+				name = "ResourceList"
+			}
+			response = g.response("The result of the operation", name)
+		}
+	}
+	if operation.Documentation != nil {
+		description = *operation.Documentation
+	}
+
+	for _, path := range paths {
+		var pathParameters []*openAPIParameter
+		operationId := operation.Name
+		if resourceType != "" {
+			operationId = resourceType + "-" + operationId
+		}
+		if HasSuffix(path, "{id}/$"+operation.Name) {
+			pathParameters = []*openAPIParameter{{Name: "id", In: "path", Description: "The logical id of the resource", Required: true, Schema: g.schemaRef("id")}}
+			operationId += "-instance"
+		}
+		g.path(path).Post = &openAPIOperation{
+			Tags:        []string{tag},
+			Summary:     summary,
+			Description: description,
+			OperationId: operationId,
+			Parameters:  pathParameters,
+			RequestBody: g.requestBody(g.schemaRef("Parameters")),
+			Responses:   map[string]*openAPIResponse{"200": response, "default": g.response("Error", "OperationOutcome")},
+		}
+		if get {
+			g.path(path).Get = &openAPIOperation{
+				Tags:        []string{tag},
+				Summary:     summary,
+				Description: description,
+				OperationId: operationId + "-get",
+				Parameters:  append(pathParameters, queryParameters...),
+				Responses:   map[string]*openAPIResponse{"200": response, "default": g.response("Error", "OperationOutcome")},
+			}
+		}
+	}
+	return nil
+}
+
+// searchParameters returns the query parameters of a search, the search parameters supported by the server followed by
+// the parameters controlling the result
+func (g *openAPIGenerator) searchParameters(resource fhir.CapabilityStatementRestResource, searchParams []fhir.CapabilityStatementRestResourceSearchParam) []*openAPIParameter {
+	var parameters []*openAPIParameter
+	seen := make(map[string]bool)
+	for _, searchParam := range searchParams {
+		if seen[searchParam.Name] {
+			continue
+		}
+		seen[searchParam.Name] = true
+		parameter := &openAPIParameter{Name: searchParam.Name, In: "query", Schema: &jsonSchema{Type: "string"}}
+		if searchParam.Type == fhir.SearchParamTypeNumber {
+			parameter.Schema.Type = "number"
+		}
+		if searchParam.Documentation != nil {
+			parameter.Description = *searchParam.Documentation
+		} else {
+			parameter.Description = "A search parameter of type " + searchParam.Type.Code()
+		}
+		parameters = append(parameters, parameter)
+	}
+	parameters = append(parameters,
+		&openAPIParameter{Name: "_count", In: "query", Description: "The number of resources per page", Schema: g.schemaRef("unsignedInt")},
+		&openAPIParameter{Name: "_sort", In: "query", Description: "The search parameters to sort by, separated by comma and prefixed with - for descending order", Schema: &jsonSchema{Type: "string"}},
+		&openAPIParameter{Name: "_summary", In: "query", Description: "Returns only a summary of the resources", Schema: &jsonSchema{Type: "string", Enum: []string{"true", "text", "data", "count", "false"}}},
+		&openAPIParameter{Name: "_elements", In: "query", Description: "The elements to return, separated by comma", Schema: &jsonSchema{Type: "string"}},
+	)
+	if len(resource.SearchInclude) > 0 {
+		parameters = append(parameters, &openAPIParameter{Name: "_include", In: "query", Description: "Includes the resources referenced by the matches", Schema: &jsonSchema{Type: "string", Enum: resource.SearchInclude}})
+	}
+	if len(resource.SearchRevInclude) > 0 {
+		parameters = append(parameters, &openAPIParameter{Name: "_revinclude", In: "query", Description: "Includes the resources referencing the matches", Schema: &jsonSchema{Type: "string", Enum: resource.SearchRevInclude}})
+	}
+	return parameters
+}
+
+func historyParameters() []*openAPIParameter {
+	return []*openAPIParameter{
+		{Name: "_count", In: "query", Description: "The number of versions per page", Schema: &jsonSchema{Type: "integer"}},
+		{Name: "_since", In: "query", Description: "Only include versions created at or after the given instant", Schema: &jsonSchema{Type: "string"}},
+		{Name: "_at", In: "query", Description: "Only include versions that were current at some point during the given period", Schema: &jsonSchema{Type: "string"}},
+	}
+}
+
+// formBody returns the body of a search with POST, which carries the query parameters as form
+func formBody(parameters []*openAPIParameter) *openAPIRequestBody {
+	form := &jsonSchema{Type: "object"}
+	for _, parameter := range parameters {
+		schema := *parameter.Schema
+		schema.Description = parameter.Description
+		form.Properties = append(form.Properties, jsonSchemaProperty{parameter.Name, &schema})
+	}
+	return &openAPIRequestBody{
+		Required: false,
+		Content:  map[string]openAPIMediaType{"application/x-www-form-urlencoded": {Schema: form}},
+	}
+}
+
+// patchBody returns the body of a patch interaction in the patch formats of the CapabilityStatement, which defaults
+// to JSON Patch. FHIRPath Patch documents are Parameters.
+func (g *openAPIGenerator) patchBody() *openAPIRequestBody {
+	body := &openAPIRequestBody{Required: true, Content: make(map[string]openAPIMediaType)}
+	patchTypes := g.patchTypes
+	if len(patchTypes) == 0 {
+		patchTypes = []string{"application/json-patch+json"}
+	}
+	for _, patchType := range patchTypes {
+		switch patchType {
+		case "application/json-patch+json":
+			body.Content[patchType] = openAPIMediaType{Schema: &jsonSchema{
+				Description: "A JSON Patch document",
+				Type:        "array",
+				Items: &jsonSchema{
+					Type:     "object",
+					Required: []string{"op", "path"},
+					Properties: jsonSchemaProperties{
+						{"op", &jsonSchema{Type: "string", Enum: []string{"add", "remove", "replace", "move", "copy", "test"}}},
+						{"path", &jsonSchema{Type: "string"}},
+						{"from", &jsonSchema{Type: "string"}},
+						{"value", &jsonSchema{}},
+					},
+				},
+			}}
+		case fhirJSONMediaType, "application/json":
+			body.Content[patchType] = openAPIMediaType{Schema: g.schemaRef("Parameters")}
+		}
+	}
+	return body
+}
+
+func (g *openAPIGenerator) path(path string) *openAPIPathItem {
+	item := g.document.Paths[path]
+	if item == nil {
+		item = &openAPIPathItem{}
+		g.document.Paths[path] = item
+	}
+	return item
+}
+
+func (g *openAPIGenerator) schemaRef(name string) *jsonSchema {
+	return &jsonSchema{Ref: "#/components/schemas/" + name}
+}
+
+func (g *openAPIGenerator) requestBody(schema *jsonSchema) *openAPIRequestBody {
+	return &openAPIRequestBody{Required: true, Content: map[string]openAPIMediaType{fhirJSONMediaType: {Schema: schema}}}
+}
+
+func (g *openAPIGenerator) response(description, schemaName string) *openAPIResponse {
+	return g.bodyResponse(description, g.schemaRef(schemaName))
+}
+
+func (g *openAPIGenerator) bodyResponse(description string, schema *jsonSchema) *openAPIResponse {
+	return &openAPIResponse{Description: description, Content: map[string]openAPIMediaType{fhirJSONMediaType: {Schema: schema}}}
+}
+
+// isPrimitive returns true for the primitive types, whose names start lower case
+func (g *openAPIGenerator) isPrimitive(typeName string) bool {
+	return g.schema.Defs[typeName] != nil && unicode.IsLower([]rune(typeName)[0])
+}
+
+func (g *openAPIGenerator) isResource(typeName string) bool {
+	if typeName == "Resource" || typeName == "DomainResource" {
+		return true
+	}
+	for _, ref := range g.schema.OneOf {
+		if ref.Ref == "#/$defs/"+typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// usedSchemas returns the definitions of the JSON Schema referenced by the paths and by each other, with references
+// pointing into the components of the document
+func (g *openAPIGenerator) usedSchemas() map[string]*jsonSchema {
+	used := make(map[string]*jsonSchema)
+	var queue []string
+	use := func(ref string) {
+		var name string
+		switch {
+		case HasPrefix(ref, "#/components/schemas/"):
+			name = TrimPrefix(ref, "#/components/schemas/")
+		case HasPrefix(ref, "#/$defs/"):
+			name = TrimPrefix(ref, "#/$defs/")
+		default:
+			return
+		}
+		if _, ok := used[name]; !ok {
+			used[name] = g.schema.Defs[name]
+			queue = append(queue, name)
+		}
+	}
+
+	var names []string
+	for name := range g.document.Paths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		item := g.document.Paths[name]
+		for _, operation := range []*openAPIOperation{item.Get, item.Put, item.Post, item.Delete, item.Patch} {
+			if operation == nil {
+				continue
+			}
+			for _, parameter := range operation.Parameters {
+				parameter.Schema.walk(func(s *jsonSchema) { use(s.Ref) })
+			}
+			if operation.RequestBody != nil {
+				for _, mediaType := range operation.RequestBody.Content {
+					mediaType.Schema.walk(func(s *jsonSchema) { use(s.Ref) })
+				}
+			}
+			for _, response := range operation.Responses {
+				for _, mediaType := range response.Content {
+					mediaType.Schema.walk(func(s *jsonSchema) { use(s.Ref) })
+				}
+			}
+		}
+	}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		used[name].walk(func(s *jsonSchema) {
+			use(s.Ref)
+			if HasPrefix(s.Ref, "#/$defs/") {
+				s.Ref = "#/components/schemas/" + TrimPrefix(s.Ref, "#/$defs/")
+			}
+		})
+	}
+
+	// lets code generators pick the type of a resource like in the schema of the specification
+	if resourceList := used["ResourceList"]; resourceList != nil {
+		mapping := make(map[string]string, len(resourceList.OneOf))
+		for _, ref := range resourceList.OneOf {
+			mapping[TrimPrefix(ref.Ref, "#/components/schemas/")] = ref.Ref
+		}
+		resourceList.Discriminator = &jsonSchemaDiscriminator{PropertyName: "resourceType", Mapping: mapping}
+	}
+	return used
+}
+
+// walk calls f for the schema and all schemas nested in it
+func (s *jsonSchema) walk(f func(*jsonSchema)) {
+	f(s)
+	for _, schema := range s.OneOf {
+		schema.walk(f)
+	}
+	if s.Items != nil {
+		s.Items.walk(f)
+	}
+	for _, property := range s.Properties {
+		property.schema.walk(f)
+	}
+}
+
+func saveOpenAPI(document *openAPI, filename string) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(document); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, buf.Bytes(), 0644)
+}
+
+// canonicalURL returns the canonical without the version following a vertical bar, which definitions without version
+// are found by.
+func canonicalURL(canonical string) string {
+	if i := Index(canonical, "|"); i >= 0 {
+		return canonical[:i]
+	}
+	return canonical
+}
+
+func init() {
+	genOpenAPICmd.Flags().StringVarP(&openAPIOutput, "output", "o", "openapi.json", "file to write the OpenAPI document to")
+	rootCmd.AddCommand(genOpenAPICmd)
+}
@@ -0,0 +1,282 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	. "strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models-gen/fhir"
+)
+
+// testStructureDefinition returns a StructureDefinition with an id and the given elements, which are paths relative
+// to the type followed by a space and the type code, like "active boolean". A non-empty base makes it a profile of
+// that type.
+func testStructureDefinition(name, kind, base string, elements ...string) []byte {
+	resourceType := name
+	derivation := "specialization"
+	if base != "" {
+		resourceType, derivation = base, "constraint"
+	}
+	snapshot := []map[string]interface{}{
+		{"id": resourceType, "path": resourceType, "min": 0, "max": "*"},
+		{"id": resourceType + ".id", "path": resourceType + ".id", "min": 0, "max": "1",
+			"type": []map[string]string{{"code": "http://hl7.org/fhirpath/System.String"}}},
+	}
+	for _, element := range elements {
+		path, code, _ := Cut(element, " ")
+		snapshot = append(snapshot, map[string]interface{}{"id": resourceType + "." + path,
+			"path": resourceType + "." + path, "min": 0, "max": "1", "type": []map[string]string{{"code": code}}})
+	}
+	bytes, err := json.Marshal(map[string]interface{}{
+		"resourceType": "StructureDefinition",
+		"url":          "http://example.org/StructureDefinition/" + name,
+		"name":         name,
+		"status":       "active",
+		"kind":         kind,
+		"abstract":     false,
+		"type":         resourceType,
+		"derivation":   derivation,
+		"snapshot":     map[string]interface{}{"element": snapshot},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bytes
+}
+
+func testOpenAPIResources() ResourceMap {
+	return ResourceMap{
+		"StructureDefinition": {
+			"Patient":             testStructureDefinition("Patient", "resource", "", "active boolean"),
+			"MyPatient":           testStructureDefinition("MyPatient", "resource", "Patient", "active boolean"),
+			"Bundle":              testStructureDefinition("Bundle", "resource", "", "type code"),
+			"Parameters":          testStructureDefinition("Parameters", "resource", ""),
+			"OperationOutcome":    testStructureDefinition("OperationOutcome", "resource", ""),
+			"CapabilityStatement": testStructureDefinition("CapabilityStatement", "resource", ""),
+			"Element":             testStructureDefinition("Element", "complex-type", ""),
+		},
+		"OperationDefinition": {
+			"http://example.org/OperationDefinition/everything": []byte(`{
+				"resourceType": "OperationDefinition",
+				"url": "http://example.org/OperationDefinition/everything",
+				"name": "Everything",
+				"title": "Fetch everything",
+				"status": "active",
+				"kind": "operation",
+				"code": "everything",
+				"affectsState": false,
+				"system": false,
+				"type": true,
+				"instance": true,
+				"parameter": [
+					{"name": "start", "use": "in", "min": 0, "max": "1", "type": "date"},
+					{"name": "return", "use": "out", "min": 1, "max": "1", "type": "Bundle"}
+				]
+			}`),
+		},
+	}
+}
+
+const testCapabilityStatement = `{
+	"resourceType": "CapabilityStatement",
+	"status": "active",
+	"date": "2022-01-01",
+	"kind": "instance",
+	"title": "Test Server",
+	"version": "1.2.3",
+	"fhirVersion": "4.0.1",
+	"format": ["json"],
+	"implementation": {"description": "Test", "url": "https://example.org/fhir"},
+	"rest": [
+		{
+			"mode": "client",
+			"resource": [{"type": "Observation", "interaction": [{"code": "read"}]}]
+		},
+		{
+			"mode": "server",
+			"resource": [{
+				"type": "Patient",
+				"profile": "http://example.org/StructureDefinition/MyPatient|1.0",
+				"interaction": [
+					{"code": "read"}, {"code": "vread"}, {"code": "update"}, {"code": "delete"},
+					{"code": "create"}, {"code": "search-type"}
+				],
+				"versioning": "versioned-update",
+				"conditionalCreate": true,
+				"searchInclude": ["Patient:organization"],
+				"searchParam": [{"name": "active", "type": "token"}],
+				"operation": [{"name": "everything", "definition": "http://example.org/OperationDefinition/everything|4.0.1"}]
+			}],
+			"interaction": [{"code": "transaction"}, {"code": "batch"}],
+			"operation": [{"name": "convert", "definition": "http://example.org/OperationDefinition/convert"}]
+		}
+	]
+}`
+
+func TestGenerateOpenAPI(t *testing.T) {
+	capabilityStatement, err := fhir.UnmarshalCapabilityStatement([]byte(testCapabilityStatement))
+	if err != nil {
+		t.Fatal(err)
+	}
+	document, err := generateOpenAPI(testOpenAPIResources(), capabilityStatement)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if document.Info.Title != "Test Server" || document.Info.Version != "1.2.3" {
+		t.Errorf("info = %+v", document.Info)
+	}
+	if len(document.Servers) != 1 || document.Servers[0].URL != "https://example.org/fhir" {
+		t.Errorf("servers = %+v", document.Servers)
+	}
+
+	var paths []string
+	for path := range document.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	wantPaths := []string{"/", "/$convert", "/Patient", "/Patient/$everything", "/Patient/_search", "/Patient/{id}",
+		"/Patient/{id}/$everything", "/Patient/{id}/_history/{vid}", "/metadata"}
+	if !reflect.DeepEqual(paths, wantPaths) {
+		t.Errorf("paths = %v, want %v", paths, wantPaths)
+	}
+
+	operationIds := map[string]string{
+		"GET /Patient/{id}":                "Patient-read",
+		"PUT /Patient/{id}":                "Patient-update",
+		"DELETE /Patient/{id}":             "Patient-delete",
+		"POST /Patient":                    "Patient-create",
+		"GET /Patient":                     "Patient-search-type",
+		"POST /Patient/_search":            "Patient-search-type-post",
+		"GET /Patient/$everything":         "Patient-everything-get",
+		"POST /Patient/{id}/$everything":   "Patient-everything-instance",
+		"POST /":                           "transaction-or-batch",
+		"POST /$convert":                   "convert",
+		"GET /metadata":                    "capabilities",
+		"GET /Patient/{id}/_history/{vid}": "Patient-vread",
+		"GET /Patient/{id}/$everything":    "Patient-everything-instance-get",
+		"POST /Patient/$everything":        "Patient-everything",
+	}
+	for key, want := range operationIds {
+		method, path, _ := Cut(key, " ")
+		if operation := openAPIOperationOf(document.Paths[path], method); operation == nil || operation.OperationId != want {
+			t.Errorf("%s has operation %+v, want %s", key, operation, want)
+		}
+	}
+	if operation := document.Paths["/$convert"].Get; operation != nil {
+		t.Errorf("operation without definition can be invoked with GET: %+v", operation)
+	}
+
+	update := document.Paths["/Patient/{id}"].Put
+	if names := openAPIParameterNames(update.Parameters); !reflect.DeepEqual(names, []string{"id", "If-Match"}) {
+		t.Errorf("update parameters = %v", names)
+	}
+	if ref := update.RequestBody.Content[fhirJSONMediaType].Schema.Ref; ref != "#/components/schemas/MyPatient" {
+		t.Errorf("update body references %s", ref)
+	}
+	if names := openAPIParameterNames(document.Paths["/Patient"].Post.Parameters); !reflect.DeepEqual(names, []string{"If-None-Exist"}) {
+		t.Errorf("create parameters = %v", names)
+	}
+	search := openAPIParameterNames(document.Paths["/Patient"].Get.Parameters)
+	if !reflect.DeepEqual(search, []string{"active", "_count", "_sort", "_summary", "_elements", "_include"}) {
+		t.Errorf("search parameters = %v", search)
+	}
+	everything := document.Paths["/Patient/{id}/$everything"].Get
+	if names := openAPIParameterNames(everything.Parameters); !reflect.DeepEqual(names, []string{"id", "start"}) {
+		t.Errorf("$everything parameters = %v", names)
+	}
+	if ref := everything.Responses["200"].Content[fhirJSONMediaType].Schema.Ref; ref != "#/components/schemas/Bundle" {
+		t.Errorf("$everything returns %s", ref)
+	}
+	if document.Paths["/Patient/$everything"].Get.Summary != "Fetch everything" {
+		t.Errorf("$everything summary = %q", document.Paths["/Patient/$everything"].Get.Summary)
+	}
+
+	var schemas []string
+	for name := range document.Components.Schemas {
+		schemas = append(schemas, name)
+	}
+	sort.Strings(schemas)
+	for _, name := range []string{"Bundle", "CapabilityStatement", "Element", "MyPatient", "OperationOutcome", "Parameters", "boolean", "id"} {
+		if document.Components.Schemas[name] == nil {
+			t.Errorf("schema %s is missing from %v", name, schemas)
+		}
+	}
+	if document.Components.Schemas["Patient"] != nil {
+		t.Errorf("unused schema Patient in %v", schemas)
+	}
+	bytes, err := json.Marshal(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Contains(string(bytes), "#/$defs/") {
+		t.Error("document references the definitions of the JSON Schema")
+	}
+}
+
+func TestGenerateOpenAPIErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		capabilityStatement string
+		err                 string
+	}{
+		{"no server mode", `{"resourceType": "CapabilityStatement", "rest": [{"mode": "client"}]}`, "no rest entry in server mode"},
+		{"unknown resource type", `{"resourceType": "CapabilityStatement", "rest": [{"mode": "server", "resource": [{"type": "Observation"}]}]}`, "missing StructureDefinition of resource `Observation`"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			capabilityStatement, err := fhir.UnmarshalCapabilityStatement([]byte(tt.capabilityStatement))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = generateOpenAPI(testOpenAPIResources(), capabilityStatement)
+			if err == nil || !Contains(err.Error(), tt.err) {
+				t.Errorf("error = %v, want %s", err, tt.err)
+			}
+		})
+	}
+}
+
+func openAPIOperationOf(item *openAPIPathItem, method string) *openAPIOperation {
+	if item == nil {
+		return nil
+	}
+	switch method {
+	case "GET":
+		return item.Get
+	case "PUT":
+		return item.Put
+	case "POST":
+		return item.Post
+	case "DELETE":
+		return item.Delete
+	case "PATCH":
+		return item.Patch
+	}
+	panic(fmt.Sprintf("unknown method %s", method))
+}
+
+func openAPIParameterNames(parameters []*openAPIParameter) []string {
+	var names []string
+	for _, parameter := range parameters {
+		names = append(names, parameter.Name)
+	}
+	return names
+}
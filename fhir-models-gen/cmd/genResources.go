@@ -21,6 +21,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	. "strings"
 	"unicode"
 
@@ -71,79 +72,7 @@ var genResourcesCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		dir := args[0]
 
-		resources := make(ResourceMap)
-		resources["StructureDefinition"] = make(map[string][]byte)
-		resources["ValueSet"] = make(map[string][]byte)
-		resources["CodeSystem"] = make(map[string][]byte)
-
-		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-			if err != nil {
-				return err
-			}
-			if info.IsDir() {
-				return nil
-			}
-			if !HasSuffix(info.Name(), ".json") {
-				return nil
-			}
-			bytes, err := ioutil.ReadFile(path)
-			if err != nil {
-				return err
-			}
-			fmt.Printf("Generate Go sources from file: %s\n", path)
-			resource, err := UnmarshalResource(bytes)
-			if err != nil {
-				return err
-			}
-			if resource.ResourceType == "Bundle" {
-				bundle, err := fhir.UnmarshalBundle(bytes)
-				if err != nil {
-					return err
-				}
-				for _, entry := range bundle.Entry {
-					entryResource, err := UnmarshalResource(entry.Resource)
-					if err != nil {
-						return err
-					}
-					switch entryResource.ResourceType {
-					case "StructureDefinition":
-						if entryResource.Name != nil {
-							resources[entryResource.ResourceType][*entryResource.Name] = entry.Resource
-						}
-					case "ValueSet":
-						fallthrough
-					case "CodeSystem":
-						if entryResource.Url != nil {
-							if entryResource.Version != nil {
-								resources[entryResource.ResourceType][*entryResource.Url+"|"+*entryResource.Version] = entry.Resource
-								resources[entryResource.ResourceType][*entryResource.Url] = entry.Resource
-							} else {
-								resources[entryResource.ResourceType][*entryResource.Url] = entry.Resource
-							}
-						}
-					}
-				}
-			}
-			switch resource.ResourceType {
-			case "StructureDefinition":
-				if resource.Name != nil {
-					resources[resource.ResourceType][*resource.Name] = bytes
-				}
-			case "ValueSet":
-				fallthrough
-			case "CodeSystem":
-				if resource.Url != nil {
-					if resource.Version != nil {
-						resources[resource.ResourceType][*resource.Url+"|"+*resource.Version] = bytes
-						resources[resource.ResourceType][*resource.Url] = bytes
-					} else {
-						resources[resource.ResourceType][*resource.Url] = bytes
-					}
-				}
-			}
-			return nil
-		})
-
+		resources, err := loadResources(dir)
 		if err != nil {
 			fmt.Println(err)
 			os.Exit(1)
@@ -151,6 +80,14 @@ var genResourcesCmd = &cobra.Command{
 
 		requiredTypes := make(map[string]bool, 0)
 		requiredValueSetBindings := make(map[string]bool, 0)
+		schema := newTypeSchema()
+		// keep the numbers of the fhir.proto generated before
+		schema.numbers, err = loadProtoNumbers()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		var resourceNames []string
 
 		for _, bytes := range resources["StructureDefinition"] {
 			structureDefinition, err := fhir.UnmarshalStructureDefinition(bytes)
@@ -161,7 +98,7 @@ var genResourcesCmd = &cobra.Command{
 			if (structureDefinition.Kind == fhir.StructureDefinitionKindResource) &&
 				structureDefinition.Name != "Element" &&
 				structureDefinition.Name != "BackboneElement" {
-				goFile, err := generateResourceOrType(resources, requiredTypes, requiredValueSetBindings, structureDefinition)
+				goFile, err := generateResourceOrType(resources, requiredTypes, requiredValueSetBindings, schema, structureDefinition)
 				if err != nil {
 					fmt.Println(err)
 					os.Exit(1)
@@ -171,10 +108,64 @@ var genResourcesCmd = &cobra.Command{
 					fmt.Println(err)
 					os.Exit(1)
 				}
+				if !structureDefinition.Abstract {
+					resourceNames = append(resourceNames, structureDefinition.Name)
+				}
 			}
 		}
+		sort.Strings(resourceNames)
+
+		err = generateTypes(resources, make(map[string]bool, 0), requiredTypes, requiredValueSetBindings, schema)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 
-		err = generateTypes(resources, make(map[string]bool, 0), requiredTypes, requiredValueSetBindings)
+		goFile := generatePrimitiveTypes()
+		err = goFile.Save("primitiveTypes.go")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		err = saveTemplate("json.go")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		err = saveTemplate("equality.go")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		goFile = generateResourceRegistry(resourceNames, schema)
+		err = goFile.Save("resourceRegistry.go")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		err = saveTemplate("xml.go")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		err = saveTemplate("turtle.go")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		err = saveTemplate("bson.go")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		err = saveTemplate("proto.go")
 		if err != nil {
 			fmt.Println(err)
 			os.Exit(1)
@@ -199,7 +190,7 @@ var genResourcesCmd = &cobra.Command{
 				fmt.Printf("Skip ValueSet with non-conforming name `%s`.\n", *valueSet.Name)
 				continue
 			}
-			goFile, err := generateValueSet(resources, valueSet)
+			goFile, err := generateValueSet(resources, schema, valueSet)
 			if err != nil {
 				fmt.Println(err)
 				os.Exit(1)
@@ -210,14 +201,114 @@ var genResourcesCmd = &cobra.Command{
 				os.Exit(1)
 			}
 		}
+
+		schema.resources = resourceNames
+		schema.searchParameters, err = searchParameterCodes(resources)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		err = schema.saveProto()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		err = schema.saveGraphQL()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 	},
 }
 
+// loadResources reads the StructureDefinitions, ValueSets, CodeSystems, OperationDefinitions and SearchParameters from the
+// JSON files in dir, which may also contain Bundles of them. StructureDefinitions are keyed by name, the others by
+// canonical URL.
+func loadResources(dir string) (ResourceMap, error) {
+	resources := make(ResourceMap)
+	resources["StructureDefinition"] = make(map[string][]byte)
+	resources["ValueSet"] = make(map[string][]byte)
+	resources["CodeSystem"] = make(map[string][]byte)
+	resources["OperationDefinition"] = make(map[string][]byte)
+	resources["SearchParameter"] = make(map[string][]byte)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if !HasSuffix(info.Name(), ".json") {
+			return nil
+		}
+		bytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Read definitions from file: %s\n", path)
+		resource, err := UnmarshalResource(bytes)
+		if err != nil {
+			return err
+		}
+		if resource.ResourceType == "Bundle" {
+			bundle, err := fhir.UnmarshalBundle(bytes)
+			if err != nil {
+				return err
+			}
+			for _, entry := range bundle.Entry {
+				entryResource, err := UnmarshalResource(entry.Resource)
+				if err != nil {
+					return err
+				}
+				switch entryResource.ResourceType {
+				case "StructureDefinition":
+					if entryResource.Name != nil {
+						resources[entryResource.ResourceType][*entryResource.Name] = entry.Resource
+					}
+				case "ValueSet", "OperationDefinition", "SearchParameter":
+					fallthrough
+				case "CodeSystem":
+					if entryResource.Url != nil {
+						if entryResource.Version != nil {
+							resources[entryResource.ResourceType][*entryResource.Url+"|"+*entryResource.Version] = entry.Resource
+							resources[entryResource.ResourceType][*entryResource.Url] = entry.Resource
+						} else {
+							resources[entryResource.ResourceType][*entryResource.Url] = entry.Resource
+						}
+					}
+				}
+			}
+		}
+		switch resource.ResourceType {
+		case "StructureDefinition":
+			if resource.Name != nil {
+				resources[resource.ResourceType][*resource.Name] = bytes
+			}
+		case "ValueSet", "OperationDefinition", "SearchParameter":
+			fallthrough
+		case "CodeSystem":
+			if resource.Url != nil {
+				if resource.Version != nil {
+					resources[resource.ResourceType][*resource.Url+"|"+*resource.Version] = bytes
+					resources[resource.ResourceType][*resource.Url] = bytes
+				} else {
+					resources[resource.ResourceType][*resource.Url] = bytes
+				}
+			}
+		}
+		return nil
+	})
+
+	return resources, err
+}
+
 func FirstLower(s string) string {
 	return ToLower(s[:1]) + s[1:]
 }
 
-func generateTypes(resources ResourceMap, alreadyGeneratedTypes map[string]bool, types map[string]bool, requiredValueSetBindings map[string]bool) error {
+func generateTypes(resources ResourceMap, alreadyGeneratedTypes map[string]bool, types map[string]bool, requiredValueSetBindings map[string]bool, schema *typeSchema) error {
 	moreRequiredTypes := make(map[string]bool, 0)
 	for name := range types {
 		bytes := resources["StructureDefinition"][name]
@@ -228,7 +319,7 @@ func generateTypes(resources ResourceMap, alreadyGeneratedTypes map[string]bool,
 		if err != nil {
 			return err
 		}
-		goFile, err := generateResourceOrType(resources, moreRequiredTypes, requiredValueSetBindings, structureDefinition)
+		goFile, err := generateResourceOrType(resources, moreRequiredTypes, requiredValueSetBindings, schema, structureDefinition)
 		if err != nil {
 			return err
 		}
@@ -242,12 +333,12 @@ func generateTypes(resources ResourceMap, alreadyGeneratedTypes map[string]bool,
 		delete(moreRequiredTypes, name)
 	}
 	if len(moreRequiredTypes) > 0 {
-		return generateTypes(resources, alreadyGeneratedTypes, moreRequiredTypes, requiredValueSetBindings)
+		return generateTypes(resources, alreadyGeneratedTypes, moreRequiredTypes, requiredValueSetBindings, schema)
 	}
 	return nil
 }
 
-func generateResourceOrType(resources ResourceMap, requiredTypes map[string]bool, requiredValueSetBindings map[string]bool, definition fhir.StructureDefinition) (*jen.File, error) {
+func generateResourceOrType(resources ResourceMap, requiredTypes map[string]bool, requiredValueSetBindings map[string]bool, schema *typeSchema, definition fhir.StructureDefinition) (*jen.File, error) {
 	elementDefinitions := definition.Snapshot.Element
 	if len(elementDefinitions) == 0 {
 		return nil, fmt.Errorf("missing element definitions in structure definition `%s`", definition.Name)
@@ -261,28 +352,21 @@ func generateResourceOrType(resources ResourceMap, requiredTypes map[string]bool
 	// generate structs
 	file.Commentf("%s is documented here %s", definition.Name, definition.Url)
 	var err error
+	var structs []*goStruct
 	file.Type().Id(definition.Name).StructFunc(func(rootStruct *jen.Group) {
-		_, err = appendFields(resources, requiredTypes, requiredValueSetBindings, file, rootStruct, definition.Name, elementDefinitions, 1, 1)
+		_, err = appendFields(resources, requiredTypes, requiredValueSetBindings, file, rootStruct, &structs, definition.Name, elementDefinitions, 1, 1)
 	})
 	if err != nil {
 		return nil, err
 	}
+	structs[0].Resource = definition.Kind == fhir.StructureDefinitionKindResource
 
-	// generate marshal
-	if definition.Kind == fhir.StructureDefinitionKindResource {
-		file.Type().Id("Other" + definition.Name).Id(definition.Name)
-		file.Commentf("MarshalJSON marshals the given %s as JSON into a byte slice", definition.Name)
-		file.Func().Params(jen.Id("r").Id(definition.Name)).Id("MarshalJSON").Params().
-			Params(jen.Op("[]").Byte(), jen.Error()).Block(
-			jen.Return().Qual("encoding/json", "Marshal").Call(jen.Struct(
-				jen.Id("Other"+definition.Name),
-				jen.Id("ResourceType").String().Tag(map[string]string{"json": "resourceType"}),
-			).Values(jen.Dict{
-				jen.Id("Other" + definition.Name): jen.Id("Other" + definition.Name).Call(jen.Id("r")),
-				jen.Id("ResourceType"):            jen.Lit(definition.Name),
-			})),
-		)
+	// generate marshal, unmarshal, deep copy and equality
+	for _, s := range structs {
+		schema.numberProtoFields(s)
+		appendStructMethods(file, s)
 	}
+	schema.messages = append(schema.messages, structs...)
 
 	// generate unmarshal
 	if definition.Kind == fhir.StructureDefinitionKindResource {
@@ -293,10 +377,7 @@ func generateResourceOrType(resources ResourceMap, requiredTypes map[string]bool
 			Block(
 				jen.Var().Id(FirstLower(definition.Name)).Id(definition.Name),
 				jen.If(
-					jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(
-						jen.Id("b"),
-						jen.Op("&").Id(FirstLower(definition.Name)),
-					),
+					jen.Err().Op(":=").Id(FirstLower(definition.Name)).Dot("UnmarshalJSON").Call(jen.Id("b")),
 					jen.Err().Op("!=").Nil(),
 				).Block(
 					jen.Return(jen.Id(FirstLower(definition.Name)), jen.Err()),
@@ -308,6 +389,21 @@ func generateResourceOrType(resources ResourceMap, requiredTypes map[string]bool
 	return file, nil
 }
 
+// appendStructMethods generates the methods of a struct for the encodings, deep copies and comparisons.
+func appendStructMethods(file *jen.File, s *goStruct) {
+	appendMarshalJSON(file, s)
+	appendUnmarshalJSON(file, s)
+	appendDeepCopy(file, s)
+	appendEqual(file, s)
+	appendEquivalent(file, s)
+	appendMarshalXML(file, s)
+	appendUnmarshalXML(file, s)
+	appendTurtle(file, s)
+	appendBSON(file, s)
+	appendMarshalProto(file, s)
+	appendUnmarshalProto(file, s)
+}
+
 func appendLicenseComment(file *jen.File) {
 	for _, line := range licenseComment {
 		file.HeaderComment(line)
@@ -319,9 +415,11 @@ func appendGeneratorComment(file *jen.File) {
 }
 
 func appendFields(resources ResourceMap, requiredTypes map[string]bool, requiredValueSetBindings map[string]bool,
-	file *jen.File, fields *jen.Group, parentName string, elementDefinitions []fhir.ElementDefinition, start,
+	file *jen.File, fields *jen.Group, structs *[]*goStruct, parentName string, elementDefinitions []fhir.ElementDefinition, start,
 	level int) (int, error) {
 	//fmt.Printf("appendFields parentName=%s, start=%d, level=%d\n", parentName, start, level)
+	current := &goStruct{Name: parentName}
+	*structs = append(*structs, current)
 	for i := start; i < len(elementDefinitions); i++ {
 		element := elementDefinitions[i]
 		pathParts := Split(element.Path, ".")
@@ -329,47 +427,56 @@ func appendFields(resources ResourceMap, requiredTypes map[string]bool, required
 			// direct childs
 			name := Title(pathParts[level])
 
-			// support contained resources later
-			if name != "Contained" {
-				switch len(element.Type) {
-				case 0:
-					if element.ContentReference != nil && (*element.ContentReference)[:1] == "#" {
-						statement := fields.Id(name)
-
-						if *element.Max == "*" {
-							statement.Op("[]")
-						} else if *element.Min == 0 {
-							statement.Op("*")
-						}
+			switch len(element.Type) {
+			case 0:
+				if element.ContentReference != nil && (*element.ContentReference)[:1] == "#" {
+					statement := fields.Id(name)
 
-						typeIdentifier := ""
-						for _, pathPart := range Split((*element.ContentReference)[1:], ".") {
-							typeIdentifier = typeIdentifier + Title(pathPart)
-						}
-						statement.Id(typeIdentifier).Tag(map[string]string{"json": pathParts[level] + ",omitempty", "bson": pathParts[level] + ",omitempty"})
+					cardinality := ""
+					if *element.Max == "*" {
+						cardinality = "[]"
+					} else if *element.Min == 0 {
+						cardinality = "*"
 					}
-				case 1:
-					var err error
-					i, err = addFieldStatement(resources, requiredTypes, requiredValueSetBindings, file, fields,
-						pathParts[level], parentName, elementDefinitions, i, level, element.Type[0])
+					statement.Op(cardinality)
 
-					if err != nil {
-						return 0, err
+					typeIdentifier := ""
+					for _, pathPart := range Split((*element.ContentReference)[1:], ".") {
+						typeIdentifier = typeIdentifier + Title(pathPart)
 					}
-				default: //polymorphic type
-					name = Replace(pathParts[level], "[x]", "", -1)
-					for _, eleType := range element.Type {
-						name := name + Title(eleType.Code)
+					statement.Id(typeIdentifier).Tag(map[string]string{"json": pathParts[level] + ",omitempty", "bson": pathParts[level] + ",omitempty"})
+					current.Fields = append(current.Fields, goField{
+						Name:        name,
+						JSONName:    pathParts[level],
+						Cardinality: cardinality,
+						Type:        typeIdentifier,
+						TypeCode:    "BackboneElement",
+						Kind:        complexField,
+						Predicate:   basePath(element),
+					})
+				}
+			case 1:
+				var err error
+				i, err = addFieldStatement(resources, requiredTypes, requiredValueSetBindings, file, fields, structs,
+					current, pathParts[level], parentName, elementDefinitions, i, level, element.Type[0], false)
+
+				if err != nil {
+					return 0, err
+				}
+			default: //polymorphic type
+				name = Replace(pathParts[level], "[x]", "", -1)
+				for _, eleType := range element.Type {
+					name := name + Title(eleType.Code)
 
-						var err error
-						i, err = addFieldStatement(resources, requiredTypes, requiredValueSetBindings, file, fields,
-							name, parentName, elementDefinitions, i, level, eleType)
+					var err error
+					i, err = addFieldStatement(resources, requiredTypes, requiredValueSetBindings, file, fields, structs,
+						current, name, parentName, elementDefinitions, i, level, eleType, true)
 
-						if err != nil {
-							return 0, err
-						}
+					if err != nil {
+						return 0, err
 					}
 				}
+				appendChoiceType(file, parentName, element)
 			}
 		} else {
 			// index of the next parent sibling
@@ -385,23 +492,47 @@ func addFieldStatement(
 	requiredValueSetBindings map[string]bool,
 	file *jen.File,
 	fields *jen.Group,
+	structs *[]*goStruct,
+	current *goStruct,
 	name string,
 	parentName string,
 	elementDefinitions []fhir.ElementDefinition,
 	elementIndex, level int,
 	elementType fhir.ElementDefinitionType,
+	polymorphic bool,
 ) (idx int, err error) {
 	fieldName := Title(name)
 	element := elementDefinitions[elementIndex]
 	statement := fields.Id(fieldName)
 
+	// only one type of a polymorphic element can be present, so all of them are optional
+	if polymorphic {
+		min := 0
+		element.Min = &min
+	}
+
+	field := goField{
+		Name:      fieldName,
+		JSONName:  name,
+		TypeCode:  fhirTypeCode(elementType),
+		Required:  *element.Min > 0,
+		Predicate: basePath(element),
+	}
+	if polymorphic {
+		field.Choice = Replace(Split(element.Path, ".")[level], "[x]", "", -1)
+		field.Predicate = Replace(field.Predicate, "[x]", Title(elementType.Code), -1)
+	}
+	if *element.Max == "*" {
+		field.Cardinality = "[]"
+	} else if *element.Min == 0 {
+		field.Cardinality = "*"
+	}
+
 	switch elementType.Code {
 	case "code":
-		if *element.Max == "*" {
-			statement.Op("[]")
-		} else if *element.Min == 0 {
-			statement.Op("*")
-		}
+		statement.Op(field.Cardinality)
+		field.Kind = primitiveField
+		field.Type = "string"
 
 		if url := requiredValueSetBinding(element); url != nil {
 			if bytes := resources["ValueSet"][*url]; bytes != nil {
@@ -422,6 +553,8 @@ func addFieldStatement(
 					} else {
 						requiredValueSetBindings[*url] = true
 						statement.Id(*name)
+						field.Kind = enumField
+						field.Type = *name
 					}
 				} else {
 					return 0, fmt.Errorf("missing name in ValueSet with canonical URL `%s`", *url)
@@ -433,13 +566,15 @@ func addFieldStatement(
 			statement.Id("string")
 		}
 	case "Resource":
-		statement.Qual("encoding/json", "RawMessage")
-	default:
-		if *element.Max == "*" {
-			statement.Op("[]")
-		} else if *element.Min == 0 {
-			statement.Op("*")
+		// a json.RawMessage can be nil itself
+		if field.Cardinality == "*" {
+			field.Cardinality = ""
 		}
+		statement.Op(field.Cardinality).Qual("encoding/json", "RawMessage")
+		field.Kind = resourceField
+		field.Type = "json.RawMessage"
+	default:
+		statement.Op(field.Cardinality)
 
 		var typeIdentifier string
 		if parentName == "Element" && fieldName == "Id" ||
@@ -451,11 +586,13 @@ func addFieldStatement(
 		if typeIdentifier == "Element" || typeIdentifier == "BackboneElement" {
 			backboneElementName := parentName + fieldName
 			statement.Id(backboneElementName)
+			field.Kind = complexField
+			field.Type = backboneElementName
 			var err error
 			file.Type().Id(backboneElementName).StructFunc(func(childFields *jen.Group) {
 				//var err error
 				elementIndex, err = appendFields(resources, requiredTypes, requiredValueSetBindings, file, childFields,
-					backboneElementName, elementDefinitions, elementIndex+1, level+1)
+					structs, backboneElementName, elementDefinitions, elementIndex+1, level+1)
 			})
 			if err != nil {
 				return 0, err
@@ -463,13 +600,27 @@ func addFieldStatement(
 			elementIndex--
 		} else if typeIdentifier == "decimal" {
 			statement.Qual("encoding/json", "Number")
+			field.Kind = decimalField
+			field.Type = "json.Number"
 		} else {
 			if unicode.IsUpper(rune(typeIdentifier[0])) {
 				requiredTypes[typeIdentifier] = true
+				field.Kind = complexField
+			} else {
+				field.Kind = primitiveField
 			}
 			statement.Id(typeIdentifier)
+			field.Type = typeIdentifier
 		}
 	}
+	// primitives hold their id and extensions in an additional field named like the JSON property prefixed with an
+	// underscore, except for the ids of elements and the urls of extensions, which have FHIRPath system types
+	extensible := field.Kind != complexField && field.Kind != resourceField && field.TypeCode != "xhtml" &&
+		!HasPrefix(elementType.Code, "http://hl7.org/fhirpath/System.")
+	if extensible {
+		field.Element = fieldName + "Element"
+	}
+	current.Fields = append(current.Fields, field)
 
 	if *element.Min == 0 {
 		statement.Tag(map[string]string{"json": name + ",omitempty", "bson": name + ",omitempty"})
@@ -477,9 +628,40 @@ func addFieldStatement(
 		statement.Tag(map[string]string{"json": name, "bson": name})
 	}
 
+	if extensible {
+		requiredTypes["Element"] = true
+		companion := goField{
+			Name:        field.Element,
+			JSONName:    "_" + name,
+			Cardinality: "*",
+			Type:        "Element",
+			TypeCode:    "Element",
+			Kind:        elementField,
+			Predicate:   field.Predicate,
+			Primitive:   fieldName,
+		}
+		// items of lists are nil for values without id and extensions
+		pointer := "*"
+		if field.Cardinality == "[]" {
+			companion.Cardinality = "[]"
+			pointer = "[]*"
+		}
+		fields.Id(companion.Name).Op(pointer).Id("Element").
+			Tag(map[string]string{"json": companion.JSONName + ",omitempty", "bson": companion.JSONName + ",omitempty"})
+		current.Fields = append(current.Fields, companion)
+	}
+
 	return elementIndex, err
 }
 
+// basePath returns the path of the element in the type which originally defines it, like Resource.id for Patient.id.
+func basePath(element fhir.ElementDefinition) string {
+	if element.Base != nil {
+		return element.Base.Path
+	}
+	return element.Path
+}
+
 func requiredValueSetBinding(elementDefinition fhir.ElementDefinition) *string {
 	if elementDefinition.Binding != nil {
 		binding := *elementDefinition.Binding
@@ -490,6 +672,20 @@ func requiredValueSetBinding(elementDefinition fhir.ElementDefinition) *string {
 	return nil
 }
 
+// fhirTypeCode returns the FHIR type code of the element type. FHIRPath system types like the one of Extension.url are
+// replaced by the FHIR type their structuredefinition-fhir-type extension names.
+func fhirTypeCode(elementType fhir.ElementDefinitionType) string {
+	if !HasPrefix(elementType.Code, "http://hl7.org/fhirpath/System.") {
+		return elementType.Code
+	}
+	for _, extension := range elementType.Extension {
+		if extension.Url == "http://hl7.org/fhir/StructureDefinition/structuredefinition-fhir-type" && extension.ValueUrl != nil {
+			return *extension.ValueUrl
+		}
+	}
+	return "string"
+}
+
 func typeCodeToTypeIdentifier(typeCode string) string {
 	switch typeCode {
 	case "base64Binary":
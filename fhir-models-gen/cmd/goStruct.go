@@ -0,0 +1,252 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"embed"
+	"os"
+
+	"github.com/dave/jennifer/jen"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// saveTemplate writes the template with the given file name into the current directory.
+func saveTemplate(name string) error {
+	bytes, err := templates.ReadFile("templates/" + name + ".tmpl")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(name, bytes, 0644)
+}
+
+type fieldKind int
+
+const (
+	primitiveField fieldKind = iota
+	decimalField
+	enumField
+	resourceField
+	complexField
+	elementField // the id and extensions of a primitive element, e.g. _birthDate
+)
+
+// typeSchema collects the structs and enums while the Go sources are generated, so that schemas like fhir.proto and
+// fhir.graphql can be written from them
+type typeSchema struct {
+	messages  []*goStruct
+	enums     map[string][]string // Go identifiers of the codes in the order of their values
+	codes     map[string][]string // FHIR codes in the order of their values
+	resources []string            // non-abstract resources
+	numbers   protoNumbers        // numbers of the fields and enum values in fhir.proto
+	// codes of the search parameters by resource type, including those of all resources under "Resource"
+	searchParameters map[string][]string
+}
+
+func newTypeSchema() *typeSchema {
+	return &typeSchema{enums: make(map[string][]string), codes: make(map[string][]string), numbers: make(protoNumbers)}
+}
+
+// goStruct describes a generated struct, so that methods can be generated for it
+type goStruct struct {
+	Name     string
+	Fields   []goField
+	Resource bool // the root struct of a resource
+}
+
+// goField describes a field of a generated struct
+type goField struct {
+	Name        string
+	JSONName    string
+	Cardinality string // "[]", "*" or ""
+	Type        string // Go type identifier of a single value
+	TypeCode    string // FHIR type code
+	Kind        fieldKind
+	Required    bool
+	Choice      string // name of the polymorphic element without [x]
+	Predicate   string // RDF predicate, the path of the element where it is defined
+	Element     string // name of the field holding the ids and extensions of a primitive element
+	Primitive   string // name of the primitive field whose ids and extensions an element field holds
+	ProtoNumber int    // number of the field in the protobuf message
+}
+
+// typeStatement returns the Go type of a single value of the field.
+func (f goField) typeStatement() *jen.Statement {
+	switch f.Kind {
+	case decimalField:
+		return jen.Qual("encoding/json", "Number")
+	case resourceField:
+		return jen.Qual("encoding/json", "RawMessage")
+	default:
+		return jen.Id(f.Type)
+	}
+}
+
+func appendDeepCopy(file *jen.File, s *goStruct) {
+	file.Commentf("DeepCopy returns a copy of the %s which shares no memory with the original", s.Name)
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("DeepCopy").Params().Id(s.Name).BlockFunc(func(group *jen.Group) {
+		group.Id("out").Op(":=").Id("r")
+		for _, f := range s.Fields {
+			src := jen.Id("r").Dot(f.Name)
+			dst := jen.Id("out").Dot(f.Name)
+			switch {
+			case f.Kind == resourceField && f.Cardinality == "[]":
+				group.If(src.Clone().Op("!=").Nil()).Block(
+					dst.Clone().Op("=").Make(jen.Op("[]").Qual("encoding/json", "RawMessage"), jen.Len(src.Clone())),
+					jen.For(jen.Id("i").Op(":=").Range().Add(src.Clone())).Block(
+						dst.Clone().Index(jen.Id("i")).Op("=").Append(jen.Qual("encoding/json", "RawMessage").Call(jen.Nil()), src.Clone().Index(jen.Id("i")).Op("...")),
+					),
+				)
+			case f.Kind == resourceField:
+				group.If(src.Clone().Op("!=").Nil()).Block(
+					dst.Clone().Op("=").Make(jen.Qual("encoding/json", "RawMessage"), jen.Len(src.Clone())),
+					jen.Copy(dst.Clone(), src.Clone()),
+				)
+			case f.Kind == elementField && f.Cardinality == "[]":
+				// items are nil for primitive values without id and extensions
+				group.If(src.Clone().Op("!=").Nil()).Block(
+					dst.Clone().Op("=").Make(jen.Op("[]*").Id("Element"), jen.Len(src.Clone())),
+					jen.For(jen.List(jen.Id("i"), jen.Id("v")).Op(":=").Range().Add(src.Clone())).Block(
+						jen.If(jen.Id("v").Op("!=").Nil()).Block(
+							jen.Id("c").Op(":=").Id("v").Dot("DeepCopy").Call(),
+							dst.Clone().Index(jen.Id("i")).Op("=").Op("&").Id("c"),
+						),
+					),
+				)
+			case f.Cardinality == "[]" && f.Kind == complexField:
+				group.If(src.Clone().Op("!=").Nil()).Block(
+					dst.Clone().Op("=").Make(jen.Op("[]").Add(f.typeStatement()), jen.Len(src.Clone())),
+					jen.For(jen.Id("i").Op(":=").Range().Add(src.Clone())).Block(
+						dst.Clone().Index(jen.Id("i")).Op("=").Add(src.Clone()).Index(jen.Id("i")).Dot("DeepCopy").Call(),
+					),
+				)
+			case f.Cardinality == "[]":
+				group.If(src.Clone().Op("!=").Nil()).Block(
+					dst.Clone().Op("=").Make(jen.Op("[]").Add(f.typeStatement()), jen.Len(src.Clone())),
+					jen.Copy(dst.Clone(), src.Clone()),
+				)
+			case f.Cardinality == "*" && (f.Kind == complexField || f.Kind == elementField):
+				group.If(src.Clone().Op("!=").Nil()).Block(
+					jen.Id("v").Op(":=").Add(src.Clone()).Dot("DeepCopy").Call(),
+					dst.Clone().Op("=").Op("&").Id("v"),
+				)
+			case f.Cardinality == "*":
+				group.If(src.Clone().Op("!=").Nil()).Block(
+					jen.Id("v").Op(":=").Op("*").Add(src.Clone()),
+					dst.Clone().Op("=").Op("&").Id("v"),
+				)
+			case f.Kind == complexField:
+				group.Add(dst).Op("=").Add(src).Dot("DeepCopy").Call()
+			}
+		}
+		group.Return(jen.Id("out"))
+	})
+}
+
+func appendEqual(file *jen.File, s *goStruct) {
+	file.Commentf("Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.")
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("Equal").Params(jen.Id("other").Id(s.Name)).Bool().BlockFunc(func(group *jen.Group) {
+		for _, f := range s.Fields {
+			appendFieldComparison(group, f, false)
+		}
+		group.Return(jen.True())
+	})
+}
+
+// appendEquivalent generates the methods EqualsDeep and EqualsShallow which follow the semantics of the FHIRPath
+// equivalence operator ~.
+func appendEquivalent(file *jen.File, s *goStruct) {
+	file.Commentf("EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.")
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("EqualsDeep").Params(jen.Id("other").Id(s.Name)).Bool().BlockFunc(func(group *jen.Group) {
+		for _, f := range s.Fields {
+			appendFieldComparison(group, f, true)
+		}
+		group.Return(jen.True())
+	})
+
+	file.Commentf("EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.")
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("EqualsShallow").Params(jen.Id("other").Id(s.Name)).Bool().BlockFunc(func(group *jen.Group) {
+		for _, f := range s.Fields {
+			if f.Kind != complexField && f.Kind != resourceField && f.Kind != elementField {
+				appendFieldComparison(group, f, true)
+			}
+		}
+		group.Return(jen.True())
+	})
+}
+
+// appendFieldComparison generates a statement returning false if the field of r and other differs.
+func appendFieldComparison(group *jen.Group, f goField, equivalent bool) {
+	a := jen.Id("r").Dot(f.Name)
+	b := jen.Id("other").Dot(f.Name)
+	switch {
+	case f.Kind == elementField && f.Cardinality == "[]":
+		// the elements are compared by index as they belong to the primitive values at the same index
+		group.If(jen.Op("!").Id("equalElements").Call(a.Clone(), b.Clone(), jen.Lit(equivalent))).Block(jen.Return(jen.False()))
+	case f.Cardinality == "[]":
+		if equivalent {
+			group.If(jen.Op("!").Id("equivalentList").Call(jen.Len(a.Clone()), jen.Len(b.Clone()),
+				jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+					jen.Return(valueComparison(f, a.Clone().Index(jen.Id("i")), b.Clone().Index(jen.Id("j")), true, false)),
+				))).Block(jen.Return(jen.False()))
+		} else {
+			group.If(jen.Len(a.Clone()).Op("!=").Len(b.Clone())).Block(jen.Return(jen.False()))
+			group.For(jen.Id("i").Op(":=").Range().Add(a.Clone())).Block(
+				jen.If(valueComparison(f, a.Clone().Index(jen.Id("i")), b.Clone().Index(jen.Id("i")), false, true)).
+					Block(jen.Return(jen.False())),
+			)
+		}
+	case f.Cardinality == "*":
+		// methods of complex values can be called on the pointer directly
+		value := jen.Op("*").Add(a.Clone())
+		if f.Kind == complexField || f.Kind == elementField {
+			value = a.Clone()
+		}
+		group.If(jen.Parens(a.Clone().Op("==").Nil()).Op("!=").Parens(b.Clone().Op("==").Nil()).Op("||").
+			Add(a.Clone()).Op("!=").Nil().Op("&&").
+			Add(valueComparison(f, value, jen.Op("*").Add(b.Clone()), equivalent, true))).
+			Block(jen.Return(jen.False()))
+	default:
+		group.If(valueComparison(f, a, b, equivalent, true)).Block(jen.Return(jen.False()))
+	}
+}
+
+// valueComparison returns an expression comparing single values of the field, which is true if the values are equal
+// or if negate is set, if they differ.
+func valueComparison(f goField, a, b *jen.Statement, equivalent, negate bool) *jen.Statement {
+	not := jen.Null()
+	if negate {
+		not = jen.Op("!")
+	}
+	switch {
+	case (f.Kind == complexField || f.Kind == elementField) && equivalent:
+		return not.Add(a).Dot("EqualsDeep").Call(b)
+	case f.Kind == complexField || f.Kind == elementField:
+		return not.Add(a).Dot("Equal").Call(b)
+	case f.Kind == resourceField:
+		return not.Id("equalResource").Call(a, b)
+	case f.Kind == decimalField && equivalent:
+		return not.Id("equivalentDecimal").Call(a, b)
+	case f.Kind == decimalField:
+		return not.Id("EqualDecimal").Call(a, b)
+	case f.Type == "string" && equivalent:
+		return not.Id("equivalentString").Call(a, b)
+	case negate:
+		return a.Op("!=").Add(b)
+	default:
+		return a.Op("==").Add(b)
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// graphqlScalars are the FHIR primitive types which are GraphQL scalars of their own, while boolean, integer, string
+// and id map to the built-in scalars
+var graphqlScalars = map[string]bool{"base64Binary": true, "canonical": true, "code": true, "date": true,
+	"dateTime": true, "decimal": true, "instant": true, "markdown": true, "oid": true, "time": true, "uri": true,
+	"url": true, "uuid": true, "xhtml": true}
+
+var graphqlNamePattern = regexp.MustCompile("^[_A-Za-z][_0-9A-Za-z]*$")
+
+// graphqlEnumValue returns the GraphQL enum value of a code. Codes which aren't GraphQL names are converted like in
+// the graphql package of the models.
+func graphqlEnumValue(code string) string {
+	switch code {
+	case "=":
+		return "Equals"
+	case "!=":
+		return "NotEquals"
+	case ">":
+		return "GreaterThan"
+	case "<":
+		return "LessThan"
+	case ">=":
+		return "GreaterOrEquals"
+	case "<=":
+		return "LessOrEquals"
+	case "true", "false", "null":
+		return code + "_"
+	}
+	if graphqlNamePattern.MatchString(code) {
+		return code
+	}
+	var b strings.Builder
+	for i, r := range code {
+		switch {
+		case r == '_' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+// graphqlType returns the GraphQL type of a single value of the field.
+func (f goField) graphqlType() string {
+	switch f.Kind {
+	case decimalField:
+		return "decimal"
+	case resourceField:
+		return "Resource"
+	case elementField:
+		return "Element"
+	case enumField, complexField:
+		return f.Type
+	}
+	switch {
+	case f.Type == "bool":
+		return "Boolean"
+	case f.Type == "int":
+		return "Int"
+	case f.TypeCode == "id":
+		return "ID"
+	case graphqlScalars[f.TypeCode]:
+		return f.TypeCode
+	}
+	return "String"
+}
+
+// graphqlListArguments are the arguments of fields holding lists. Further arguments named after a property of the
+// items filter by the value of that property.
+const graphqlListArguments = "(_offset: Int, _count: Int, fhirpath: String, _filter: String)"
+
+// graphqlFixedSearchArguments are the arguments every list field of the query type has.
+var graphqlFixedSearchArguments = map[string]bool{"_filter": true, "_count": true, "_offset": true, "_sort": true,
+	"_id": true, "_lastUpdated": true}
+
+// graphqlSearchArgumentPattern matches the search parameter codes which are valid GraphQL names after replacing dashes.
+var graphqlSearchArgumentPattern = regexp.MustCompile(`^[_A-Za-z][-_0-9A-Za-z]*$`)
+
+// searchParameterCodes returns the codes of the SearchParameters among the resources by the resource types they apply
+// to. Those of Resource and DomainResource are listed under "Resource".
+func searchParameterCodes(resources ResourceMap) (map[string][]string, error) {
+	codes := make(map[string]map[string]bool)
+	for _, b := range resources["SearchParameter"] {
+		var searchParameter struct {
+			Code string
+			Base []string
+		}
+		if err := json.Unmarshal(b, &searchParameter); err != nil {
+			return nil, err
+		}
+		if !graphqlSearchArgumentPattern.MatchString(searchParameter.Code) {
+			continue
+		}
+		for _, base := range searchParameter.Base {
+			if base == "DomainResource" {
+				base = "Resource"
+			}
+			if codes[base] == nil {
+				codes[base] = make(map[string]bool)
+			}
+			codes[base][searchParameter.Code] = true
+		}
+	}
+	result := make(map[string][]string, len(codes))
+	for base, set := range codes {
+		for code := range set {
+			result[base] = append(result[base], code)
+		}
+		sort.Strings(result[base])
+	}
+	return result, nil
+}
+
+// resourceSearchParameters returns the sorted codes of the search parameters of the resource type, which become
+// arguments of its list field in addition to the fixed ones.
+func (p *typeSchema) resourceSearchParameters(resourceType string) []string {
+	var codes []string
+	seen := make(map[string]bool)
+	for _, code := range append(append([]string(nil), p.searchParameters["Resource"]...), p.searchParameters[resourceType]...) {
+		if !graphqlFixedSearchArguments[code] && !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// graphqlSearchArgument returns the argument of a search parameter, whose dashes are replaced by underscores like
+// general_practitioner for general-practitioner.
+func graphqlSearchArgument(code string) string {
+	return strings.ReplaceAll(code, "-", "_")
+}
+
+// saveGraphQL writes the schema as fhir.graphql into the current directory.
+func (p *typeSchema) saveGraphQL() error {
+	return os.WriteFile("fhir.graphql", []byte(p.graphql()), 0644)
+}
+
+// graphql returns the schema as GraphQL SDL following http://hl7.org/fhir/graphql.html.
+func (p *typeSchema) graphql() string {
+	var b strings.Builder
+	for _, line := range licenseComment {
+		b.WriteString(strings.TrimRight("# "+line, " ") + "\n")
+	}
+	b.WriteString("\n# THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models\n# PLEASE DO NOT EDIT BY HAND\n")
+
+	b.WriteString("\nschema {\n  query: Query\n}\n")
+	b.WriteString("\ntype Query {\n")
+	for _, name := range p.resources {
+		fmt.Fprintf(&b, "  %s(id: ID!): %s\n", name, name)
+		fmt.Fprintf(&b, "  %sList(_filter: String, _count: Int, _offset: Int, _sort: String, _id: String, _lastUpdated: String", name)
+		for _, code := range p.resourceSearchParameters(name) {
+			fmt.Fprintf(&b, ", %s: String", graphqlSearchArgument(code))
+		}
+		fmt.Fprintf(&b, "): [%s]\n", name)
+	}
+	b.WriteString("}\n")
+	fmt.Fprintf(&b, "\nunion Resource = %s\n", strings.Join(p.resources, " | "))
+
+	concrete := make(map[string]bool, len(p.resources))
+	for _, name := range p.resources {
+		concrete[name] = true
+	}
+	scalars := make(map[string]bool)
+	messages := append([]*goStruct(nil), p.messages...)
+	sort.Slice(messages, func(i, j int) bool { return messages[i].Name < messages[j].Name })
+	for _, s := range messages {
+		// abstract resources like DomainResource never occur in results, which use the union Resource instead
+		if s.Resource && !concrete[s.Name] {
+			continue
+		}
+		fmt.Fprintf(&b, "\ntype %s {\n", s.Name)
+		for _, f := range s.Fields {
+			t := f.graphqlType()
+			if graphqlScalars[t] {
+				scalars[t] = true
+			}
+			if f.Kind == elementField && f.Cardinality == "[]" {
+				// the list is aligned with the values of the primitive, so it can't be filtered
+				fmt.Fprintf(&b, "  %s: [%s]\n", f.JSONName, t)
+			} else if f.Cardinality == "[]" {
+				fmt.Fprintf(&b, "  %s%s: [%s]\n", f.JSONName, graphqlListArguments, t)
+			} else {
+				fmt.Fprintf(&b, "  %s: %s\n", f.JSONName, t)
+			}
+		}
+		if s.Name == "Reference" {
+			b.WriteString("  resource(type: String, optional: Boolean): Resource\n")
+		}
+		b.WriteString("}\n")
+	}
+
+	names := make([]string, 0, len(p.codes))
+	for name := range p.codes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "\nenum %s {\n", name)
+		for _, code := range p.codes[name] {
+			fmt.Fprintf(&b, "  %s\n", graphqlEnumValue(code))
+		}
+		b.WriteString("}\n")
+	}
+
+	names = names[:0]
+	for name := range scalars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	b.WriteByte('\n')
+	for _, name := range names {
+		fmt.Fprintf(&b, "scalar %s\n", name)
+	}
+	return b.String()
+}
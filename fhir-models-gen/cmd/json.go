@@ -0,0 +1,206 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// jsonValue returns the statement appending a single value v of the field.
+func (f goField) jsonValue(v *jen.Statement) *jen.Statement {
+	switch {
+	case f.Kind == resourceField:
+		return jen.Id("e").Dot("raw").Call(v)
+	case f.Kind == complexField || f.Kind == elementField:
+		return v.Dot("appendJSON").Call(jen.Id("e"))
+	case f.Kind == enumField:
+		return jen.Id("e").Dot("string").Call(v.Dot("Code").Call())
+	case f.Kind == decimalField:
+		return jen.Id("e").Dot("number").Call(v)
+	case f.Type == "bool":
+		return jen.Id("e").Dot("bool").Call(v)
+	case f.Type == "int":
+		return jen.Id("e").Dot("int").Call(v)
+	default:
+		return jen.Id("e").Dot("string").Call(v)
+	}
+}
+
+func appendMarshalJSON(file *jen.File, s *goStruct) {
+	file.Commentf("MarshalJSON marshals the given %s as JSON into a byte slice", s.Name)
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("MarshalJSON").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+		jen.Var().Id("e").Id("jsonEncoder"),
+		jen.Id("r").Dot("appendJSON").Call(jen.Op("&").Id("e")),
+		jen.If(jen.Id("e").Dot("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Id("e").Dot("err"))),
+		jen.Return(jen.Id("e").Dot("buf"), jen.Nil()),
+	)
+
+	file.Commentf("appendJSON appends the %s as JSON object with the members in the order of the fields", s.Name)
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("appendJSON").Params(jen.Id("e").Op("*").Id("jsonEncoder")).BlockFunc(func(group *jen.Group) {
+		if s.Resource {
+			group.Id("e").Dot("buf").Op("=").Append(jen.Id("e").Dot("buf"), jen.Lit(`{"resourceType":"`+s.Name+`"`).Op("..."))
+		} else {
+			group.Id("e").Dot("buf").Op("=").Append(jen.Id("e").Dot("buf"), jen.LitRune('{'))
+		}
+		for _, f := range s.Fields {
+			field := jen.Id("r").Dot(f.Name)
+			key := jen.Id("e").Dot("key").Call(jen.Lit(f.JSONName))
+			switch {
+			case f.Kind == elementField && f.Cardinality == "[]":
+				// the list is aligned with the values of the primitive
+				group.If(jen.Len(field.Clone()).Op(">").Lit(0)).Block(
+					key,
+					jen.Id("e").Dot("elements").Call(field.Clone(), jen.Len(jen.Id("r").Dot(f.Primitive))),
+				)
+			case f.Cardinality == "[]":
+				items := []jen.Code{
+					jen.Id("e").Dot("buf").Op("=").Append(jen.Id("e").Dot("buf"), jen.LitRune('[')),
+					jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Add(field.Clone())).Block(
+						jen.Id("e").Dot("item").Call(),
+						f.jsonValue(jen.Id("v")),
+					),
+					jen.Id("e").Dot("buf").Op("=").Append(jen.Id("e").Dot("buf"), jen.LitRune(']')),
+				}
+				if f.Required {
+					group.Add(key)
+					group.If(field.Clone().Op("==").Nil()).Block(jen.Id("e").Dot("null").Call()).Else().Block(items...)
+				} else {
+					group.If(jen.Len(field.Clone()).Op(">").Lit(0)).Block(append([]jen.Code{key}, items...)...)
+				}
+			case f.Cardinality == "*":
+				value := field.Clone()
+				if f.Kind != complexField && f.Kind != enumField && f.Kind != elementField {
+					value = jen.Op("*").Add(field.Clone())
+				}
+				group.If(field.Clone().Op("!=").Nil()).Block(key, f.jsonValue(value))
+			default:
+				if f.Kind == resourceField && !f.Required {
+					group.If(jen.Len(field.Clone()).Op(">").Lit(0)).Block(key, f.jsonValue(field.Clone()))
+				} else {
+					group.Add(key)
+					group.Add(f.jsonValue(field.Clone()))
+				}
+			}
+		}
+		group.Id("e").Dot("buf").Op("=").Append(jen.Id("e").Dot("buf"), jen.LitRune('}'))
+	})
+}
+
+func appendUnmarshalJSON(file *jen.File, s *goStruct) {
+	file.Commentf("UnmarshalJSON unmarshals the given %s from JSON. Unknown members are ignored.", s.Name)
+	file.Func().Params(jen.Id("r").Op("*").Id(s.Name)).Id("UnmarshalJSON").Params(jen.Id("b").Op("[]").Byte()).Error().Block(
+		jen.Id("d").Op(":=").Id("jsonDecoder").Values(jen.Dict{jen.Id("buf"): jen.Id("b")}),
+		jen.Id("r").Dot("decodeJSON").Call(jen.Op("&").Id("d")),
+		jen.Id("d").Dot("end").Call(),
+		jen.Return(jen.Id("d").Dot("err")),
+	)
+
+	file.Commentf("decodeJSON decodes the JSON object at the position of the decoder into the %s", s.Name)
+	file.Func().Params(jen.Id("r").Op("*").Id(s.Name)).Id("decodeJSON").Params(jen.Id("d").Op("*").Id("jsonDecoder")).BlockFunc(func(group *jen.Group) {
+		var cases []jen.Code
+		for _, f := range s.Fields {
+			cases = append(cases, jen.Case(jen.Lit(f.JSONName)).BlockFunc(func(c *jen.Group) {
+				appendJSONFieldDecoder(c, f)
+			}))
+		}
+		cases = append(cases, jen.Default().Block(jen.Id("d").Dot("skip").Call()))
+		group.For(jen.Id("o").Op(":=").Id("d").Dot("object").Call(), jen.Id("o").Dot("next").Call(), jen.Empty()).Block(
+			jen.Switch(jen.String().Call(jen.Id("o").Dot("key"))).Block(cases...),
+		)
+	})
+}
+
+// jsonReader returns the name of the decoder method reading a primitive value of the field.
+func (f goField) jsonReader() string {
+	switch {
+	case f.Kind == decimalField:
+		return "number"
+	case f.Type == "bool":
+		return "bool"
+	case f.Type == "int":
+		return "int"
+	default:
+		return "string"
+	}
+}
+
+func appendJSONFieldDecoder(group *jen.Group, f goField) {
+	field := jen.Id("r").Dot(f.Name)
+	// decode declares v and decodes a single value into it
+	decode := func(item *jen.Group) {
+		switch f.Kind {
+		case resourceField:
+			item.Id("v").Op(":=").Id("d").Dot("raw").Call()
+		case complexField:
+			item.Var().Id("v").Id(f.Type)
+			item.Id("v").Dot("decodeJSON").Call(jen.Id("d"))
+		case enumField:
+			item.Var().Id("v").Id(f.Type)
+			item.Id("d").Dot("unmarshal").Call(jen.Op("&").Id("v"))
+		default:
+			item.List(jen.Id("v"), jen.Id("_")).Op(":=").Id("d").Dot(f.jsonReader()).Call()
+		}
+	}
+
+	switch {
+	case f.Kind == elementField && f.Cardinality == "[]":
+		group.Id("a").Op(":=").Id("d").Dot("array").Call()
+		group.Add(field.Clone()).Op("=").Nil()
+		group.For(jen.Id("a").Dot("next").Call()).Block(
+			field.Clone().Op("=").Append(field.Clone(), jen.Id("d").Dot("element").Call()),
+		)
+	case f.Kind == elementField:
+		group.Add(field.Clone()).Op("=").Id("d").Dot("element").Call()
+	case f.Cardinality == "[]":
+		group.Id("a").Op(":=").Id("d").Dot("array").Call()
+		group.Add(field.Clone()).Op("=").Nil()
+		group.For(jen.Id("a").Dot("next").Call()).BlockFunc(func(item *jen.Group) {
+			decode(item)
+			item.Add(field.Clone()).Op("=").Append(field.Clone(), jen.Id("v"))
+		})
+		group.If(field.Clone().Op("==").Nil().Op("&&").Op("!").Id("a").Dot("isNull")).Block(
+			field.Clone().Op("=").Index().Add(f.typeStatement()).Values(),
+		)
+	case f.Cardinality == "*":
+		switch f.Kind {
+		case complexField, enumField:
+			group.If(jen.Id("d").Dot("null").Call()).Block(
+				field.Clone().Op("=").Nil(),
+			).Else().BlockFunc(func(item *jen.Group) {
+				decode(item)
+				item.Add(field.Clone()).Op("=").Op("&").Id("v")
+			})
+		default:
+			group.If(jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("d").Dot(f.jsonReader()).Call(), jen.Id("ok")).Block(
+				field.Clone().Op("=").Op("&").Id("v"),
+			).Else().Block(
+				field.Clone().Op("=").Nil(),
+			)
+		}
+	default:
+		switch f.Kind {
+		case resourceField:
+			group.Add(field.Clone()).Op("=").Id("d").Dot("raw").Call()
+		case complexField:
+			group.Add(field.Clone()).Dot("decodeJSON").Call(jen.Id("d"))
+		case enumField:
+			group.Id("d").Dot("unmarshal").Call(jen.Op("&").Add(field.Clone()))
+		default:
+			group.If(jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("d").Dot(f.jsonReader()).Call(), jen.Id("ok")).Block(
+				field.Clone().Op("=").Id("v"),
+			)
+		}
+	}
+}
@@ -0,0 +1,541 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// protoName converts a Go or JSON name into snake case, e.g. valueQuantity into value_quantity.
+func protoName(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && runes[i-1] != '_' &&
+			(unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// protoType returns the protobuf type of a single value of the field.
+func (f goField) protoType() string {
+	switch f.Kind {
+	case decimalField:
+		// decimals keep their precision as string
+		return "string"
+	case resourceField:
+		return "ContainedResource"
+	case enumField, complexField, elementField:
+		return f.Type
+	}
+	switch f.Type {
+	case "bool":
+		return "bool"
+	case "int":
+		if f.TypeCode == "positiveInt" || f.TypeCode == "unsignedInt" {
+			return "uint32"
+		}
+		return "int32"
+	}
+	return "string"
+}
+
+// protoFieldName returns the name of the field in its protobuf message. The ids and extensions of a primitive are held
+// by a field named after the primitive, e.g. birth_date_element.
+func (f goField) protoFieldName() string {
+	if f.Kind == elementField {
+		return protoName(f.Name)
+	}
+	return protoName(f.JSONName)
+}
+
+// protoEnumValue returns the name of the value of a protobuf enum for the Go identifier of a code. Enum values are
+// scoped by the package, so they are prefixed with the name of the enum.
+func protoEnumValue(identifier string) string {
+	return strings.ToUpper(protoName(identifier))
+}
+
+// numberProtoFields sets the protobuf field numbers of the fields of the struct.
+func (p *typeSchema) numberProtoFields(s *goStruct) {
+	scope := p.numbers.scope(s.Name)
+	for _, f := range s.protoFields() {
+		for i := range s.Fields {
+			if s.Fields[i].Name == f.Name {
+				s.Fields[i].ProtoNumber = scope.number(f.protoFieldName())
+			}
+		}
+	}
+}
+
+// saveProto writes the schema as fhir.proto into the current directory.
+func (p *typeSchema) saveProto() error {
+	return os.WriteFile("fhir.proto", []byte(p.proto()), 0644)
+}
+
+// proto returns the schema in the protobuf language. The field and value numbers are taken from p.numbers.
+func (p *typeSchema) proto() string {
+	var b strings.Builder
+	for _, line := range []string{"Copyright 2019 - 2022 The Samply Community", "",
+		`Licensed under the Apache License, Version 2.0 (the "License");`,
+		"you may not use this file except in compliance with the License.",
+		"You may obtain a copy of the License at", "",
+		"    http://www.apache.org/licenses/LICENSE-2.0", "",
+		"Unless required by applicable law or agreed to in writing, software",
+		`distributed under the License is distributed on an "AS IS" BASIS,`,
+		"WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.",
+		"See the License for the specific language governing permissions and",
+		"limitations under the License."} {
+		b.WriteString(strings.TrimRight("// "+line, " ") + "\n")
+	}
+	b.WriteString("\n// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models\n// PLEASE DO NOT EDIT BY HAND\n\n")
+	b.WriteString("syntax = \"proto3\";\n\npackage fhir.r4;\n")
+
+	b.WriteString("\n// ContainedResource holds a contained or inline resource\nmessage ContainedResource {\n")
+	resources := p.numbers.scope("ContainedResource")
+	for _, name := range p.resources {
+		resources.number(protoName(name))
+	}
+	writeProtoReserved(&b, resources, "  ")
+	b.WriteString("  oneof resource {\n")
+	for _, name := range p.resources {
+		fmt.Fprintf(&b, "    %s %s = %d;\n", name, protoName(name), resources.number(protoName(name)))
+	}
+	b.WriteString("  }\n}\n")
+
+	messages := append([]*goStruct(nil), p.messages...)
+	sort.Slice(messages, func(i, j int) bool { return messages[i].Name < messages[j].Name })
+	for _, s := range messages {
+		fmt.Fprintf(&b, "\nmessage %s {\n", s.Name)
+		writeProtoReserved(&b, p.numbers.scope(s.Name), "  ")
+		fields := s.protoFields()
+		for i, f := range fields {
+			indent, label := "  ", ""
+			if f.Choice != "" {
+				if i == 0 || fields[i-1].Choice != f.Choice {
+					fmt.Fprintf(&b, "  oneof %s {\n", protoName(f.Choice))
+				}
+				indent = "    "
+			} else if f.Cardinality == "[]" {
+				label = "repeated "
+			} else if f.Cardinality == "*" && f.Kind != complexField && f.Kind != elementField {
+				label = "optional "
+			}
+			fmt.Fprintf(&b, "%s%s%s %s = %d;\n", indent, label, f.protoType(), f.protoFieldName(), f.ProtoNumber)
+			if f.Choice != "" && (i+1 == len(fields) || fields[i+1].Choice != f.Choice) {
+				b.WriteString("  }\n")
+			}
+		}
+		b.WriteString("}\n")
+	}
+
+	names := make([]string, 0, len(p.enums))
+	for name := range p.enums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		scope := p.numbers.scope(name)
+		for _, identifier := range p.enums[name] {
+			scope.number(protoEnumValue(identifier))
+		}
+		fmt.Fprintf(&b, "\nenum %s {\n", name)
+		writeProtoReserved(&b, scope, "  ")
+		fmt.Fprintf(&b, "  %s_INVALID_UNINITIALIZED = 0;\n", strings.ToUpper(protoName(name)))
+		for _, identifier := range p.enums[name] {
+			fmt.Fprintf(&b, "  %s = %d;\n", protoEnumValue(identifier), scope.number(protoEnumValue(identifier)))
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// protoScope holds the numbers of the fields of a message or the values of an enum.
+type protoScope struct {
+	numbers  map[string]int
+	reserved []int // numbers of fields or values removed before
+	names    []string
+	max      int
+	used     map[string]bool
+}
+
+// protoNumbers holds the numbers of fields and enum values by message or enum name. They are read from the
+// fhir.proto written before and kept, so that the wire format stays compatible when the definitions change. New
+// fields and values get numbers above all numbers used before, removed ones are reserved.
+type protoNumbers map[string]*protoScope
+
+func (n protoNumbers) scope(name string) *protoScope {
+	scope, ok := n[name]
+	if !ok {
+		scope = &protoScope{numbers: make(map[string]int), used: make(map[string]bool)}
+		n[name] = scope
+	}
+	return scope
+}
+
+// number returns the number of the field or value with the given name, allocating a new one if it has none.
+func (s *protoScope) number(name string) int {
+	s.used[name] = true
+	number, ok := s.numbers[name]
+	if !ok {
+		s.max++
+		number = s.max
+		s.numbers[name] = number
+	}
+	return number
+}
+
+// unused returns the numbers and names of the fields or values which are no longer used, including the ones reserved
+// before, in ascending order.
+func (s *protoScope) unused() ([]int, []string) {
+	numbers := append([]int(nil), s.reserved...)
+	var names []string
+	for _, name := range s.names {
+		// a removed field can be added again under a new number
+		if !s.used[name] {
+			names = append(names, name)
+		}
+	}
+	for name, number := range s.numbers {
+		if !s.used[name] {
+			numbers = append(numbers, number)
+			names = append(names, name)
+		}
+	}
+	sort.Ints(numbers)
+	sort.Strings(names)
+	return numbers, names
+}
+
+var (
+	protoScopePattern    = regexp.MustCompile(`^(?:message|enum) (\w+) \{$`)
+	protoNumberPattern   = regexp.MustCompile(`^(?:(?:optional|repeated) )?(?:\w+ )?(\w+) = (\d+);$`)
+	protoReservedPattern = regexp.MustCompile(`^reserved (.+);$`)
+)
+
+// readProtoNumbers reads the numbers of the fields and enum values of a fhir.proto written by saveProto.
+func readProtoNumbers(src string) (protoNumbers, error) {
+	numbers := make(protoNumbers)
+	var scope *protoScope
+	for i, line := range strings.Split(src, "\n") {
+		line = strings.TrimSpace(line)
+		if m := protoScopePattern.FindStringSubmatch(line); m != nil {
+			scope = numbers.scope(m[1])
+			continue
+		}
+		if scope == nil || line == "" || strings.HasPrefix(line, "//") || strings.HasPrefix(line, "oneof ") || line == "}" {
+			continue
+		}
+		if m := protoReservedPattern.FindStringSubmatch(line); m != nil {
+			for _, item := range strings.Split(m[1], ",") {
+				item = strings.TrimSpace(item)
+				if name, err := strconv.Unquote(item); err == nil {
+					scope.names = append(scope.names, name)
+					continue
+				}
+				number, err := strconv.Atoi(item)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid reserved number `%s`", i+1, item)
+				}
+				scope.reserved = append(scope.reserved, number)
+				if number > scope.max {
+					scope.max = number
+				}
+			}
+			continue
+		}
+		m := protoNumberPattern.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("line %d: unexpected `%s`", i+1, line)
+		}
+		number, _ := strconv.Atoi(m[2])
+		if number == 0 {
+			// the invalid value every enum starts with
+			continue
+		}
+		scope.numbers[m[1]] = number
+		if number > scope.max {
+			scope.max = number
+		}
+	}
+	return numbers, nil
+}
+
+// loadProtoNumbers reads the numbers of the fhir.proto in the current directory, if there is one.
+func loadProtoNumbers() (protoNumbers, error) {
+	src, err := os.ReadFile("fhir.proto")
+	if os.IsNotExist(err) {
+		return make(protoNumbers), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return readProtoNumbers(string(src))
+}
+
+// writeProtoReserved writes the reserved numbers and names of the message or enum.
+func writeProtoReserved(b *strings.Builder, scope *protoScope, indent string) {
+	numbers, names := scope.unused()
+	if len(numbers) > 0 {
+		items := make([]string, len(numbers))
+		for i, number := range numbers {
+			items[i] = strconv.Itoa(number)
+		}
+		fmt.Fprintf(b, "%sreserved %s;\n", indent, strings.Join(items, ", "))
+	}
+	if len(names) > 0 {
+		items := make([]string, len(names))
+		for i, name := range names {
+			items[i] = strconv.Quote(name)
+		}
+		fmt.Fprintf(b, "%sreserved %s;\n", indent, strings.Join(items, ", "))
+	}
+}
+
+// protoFields returns the fields of the struct in the order of its protobuf message. The fields holding the ids and
+// extensions of primitives follow the others, so that the fields of polymorphic elements stay together in their oneof.
+func (s *goStruct) protoFields() []goField {
+	var fields, elements []goField
+	for _, f := range s.Fields {
+		if f.Kind == elementField {
+			elements = append(elements, f)
+		} else {
+			fields = append(fields, f)
+		}
+	}
+	return append(fields, elements...)
+}
+
+func appendMarshalProto(file *jen.File, s *goStruct) {
+	file.Commentf("MarshalProto marshals the given %s as protobuf message %s of fhir.proto", s.Name, s.Name)
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("MarshalProto").Params().Params(jen.Op("[]").Byte(), jen.Error()).Block(
+		jen.Var().Id("e").Id("protoEncoder"),
+		jen.Id("r").Dot("appendProto").Call(jen.Op("&").Id("e")),
+		jen.Return(jen.Id("e").Dot("buf"), jen.Id("e").Dot("err")),
+	)
+	file.Commentf("appendProto appends the fields of the %s to the encoded message", s.Name)
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("appendProto").Params(jen.Id("e").Op("*").Id("protoEncoder")).BlockFunc(func(group *jen.Group) {
+		for _, f := range s.protoFields() {
+			appendProtoFieldEncoder(group, f)
+		}
+	})
+}
+
+// protoVarint returns the conversion of a single value of a bool, int or enum field v into a varint.
+func (f goField) protoVarint(v *jen.Statement) *jen.Statement {
+	switch {
+	case f.Kind == enumField:
+		return v.Dot("protoNumber").Call()
+	case f.Type == "bool":
+		return jen.Id("protoBool").Call(v)
+	default:
+		return jen.Uint64().Call(jen.Int64().Call(v))
+	}
+}
+
+func appendProtoFieldEncoder(group *jen.Group, f goField) {
+	number := f.ProtoNumber
+	field := jen.Id("r").Dot(f.Name)
+	var encode func(v *jen.Statement) *jen.Statement
+	switch {
+	case f.Kind == resourceField:
+		encode = func(v *jen.Statement) *jen.Statement { return jen.Id("e").Dot("resource").Call(jen.Lit(number), v) }
+	case f.Kind == elementField && f.Cardinality == "[]":
+		encode = func(v *jen.Statement) *jen.Statement { return jen.Id("e").Dot("element").Call(jen.Lit(number), v) }
+	case f.Kind == complexField || f.Kind == elementField:
+		encode = func(v *jen.Statement) *jen.Statement { return jen.Id("e").Dot("message").Call(jen.Lit(number), v) }
+	case f.Kind == decimalField:
+		encode = func(v *jen.Statement) *jen.Statement {
+			return jen.Id("e").Dot("string").Call(jen.Lit(number), jen.String().Call(v))
+		}
+	case f.Kind == primitiveField && f.Type == "string":
+		encode = func(v *jen.Statement) *jen.Statement { return jen.Id("e").Dot("string").Call(jen.Lit(number), v) }
+	default:
+		encode = func(v *jen.Statement) *jen.Statement {
+			return jen.Id("e").Dot("varint").Call(jen.Lit(number), f.protoVarint(v))
+		}
+	}
+
+	switch f.Cardinality {
+	case "[]":
+		if f.Kind == enumField || f.Kind == primitiveField && f.Type != "string" {
+			// repeated scalars are packed
+			group.If(jen.Len(field.Clone()).Op(">").Lit(0)).Block(
+				jen.Var().Id("p").Id("protoEncoder"),
+				jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Add(field.Clone())).Block(
+					jen.Id("p").Dot("appendVarint").Call(f.protoVarint(jen.Id("v"))),
+				),
+				jen.Id("e").Dot("bytes").Call(jen.Lit(number), jen.Id("p").Dot("buf")),
+			)
+			return
+		}
+		group.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Add(field.Clone())).Block(encode(jen.Id("v")))
+	case "*":
+		if f.Kind == enumField {
+			// the methods of enums can be called on the pointer
+			group.If(field.Clone().Op("!=").Nil()).Block(encode(field.Clone()))
+			return
+		}
+		group.If(field.Clone().Op("!=").Nil()).Block(encode(jen.Op("*").Add(field.Clone())))
+	default:
+		switch {
+		case f.Kind == resourceField:
+			group.If(jen.Len(field.Clone()).Op(">").Lit(0)).Block(encode(field.Clone()))
+		case f.Kind == complexField || f.Kind == enumField:
+			group.Add(encode(field.Clone()))
+		case f.Type == "bool":
+			group.If(field.Clone()).Block(encode(field.Clone()))
+		case f.Type == "int":
+			group.If(field.Clone().Op("!=").Lit(0)).Block(encode(field.Clone()))
+		default:
+			group.If(field.Clone().Op("!=").Lit("")).Block(encode(field.Clone()))
+		}
+	}
+}
+
+func appendUnmarshalProto(file *jen.File, s *goStruct) {
+	file.Commentf("UnmarshalProto unmarshals the given %s from protobuf message %s of fhir.proto", s.Name, s.Name)
+	file.Func().Params(jen.Id("r").Op("*").Id(s.Name)).Id("UnmarshalProto").Params(jen.Id("b").Op("[]").Byte()).Error().BlockFunc(func(group *jen.Group) {
+		group.Op("*").Id("r").Op("=").Id(s.Name).Values()
+		group.Id("d").Op(":=").Id("protoDecoder").Values(jen.Dict{jen.Id("buf"): jen.Id("b")})
+		var cases []jen.Code
+		for _, f := range s.protoFields() {
+			cases = append(cases, jen.Case(jen.Lit(f.ProtoNumber)).BlockFunc(func(c *jen.Group) {
+				appendProtoFieldDecoder(c, f)
+			}))
+		}
+		cases = append(cases, jen.Default().Block(jen.Id("d").Dot("skip").Call()))
+		group.For(jen.Id("d").Dot("next").Call()).Block(jen.Switch(jen.Id("d").Dot("field")).Block(cases...))
+		group.Return(jen.Id("d").Dot("err"))
+	})
+}
+
+func appendProtoFieldDecoder(group *jen.Group, f goField) {
+	field := jen.Id("r").Dot(f.Name)
+	store := func(v jen.Code) *jen.Statement {
+		switch f.Cardinality {
+		case "[]":
+			return field.Clone().Op("=").Append(field.Clone(), v)
+		case "*":
+			return jen.Id("v").Op(":=").Add(v).Line().Add(field.Clone()).Op("=").Op("&").Id("v")
+		}
+		return field.Clone().Op("=").Add(v)
+	}
+
+	switch {
+	case f.Kind == resourceField:
+		group.Add(store(jen.Id("d").Dot("resource").Call()))
+	case f.Kind == elementField && f.Cardinality == "[]":
+		group.Add(field.Clone().Op("=").Append(field.Clone(), jen.Id("d").Dot("element").Call()))
+	case f.Kind == complexField || f.Kind == elementField:
+		group.Var().Id("v").Id(f.Type)
+		group.Id("d").Dot("message").Call(jen.Op("&").Id("v"))
+		switch f.Cardinality {
+		case "[]":
+			group.Add(field.Clone().Op("=").Append(field.Clone(), jen.Id("v")))
+		case "*":
+			group.Add(field.Clone().Op("=").Op("&").Id("v"))
+		default:
+			group.Add(field.Clone().Op("=").Id("v"))
+		}
+	case f.Kind == decimalField:
+		group.Add(store(jen.Qual("encoding/json", "Number").Call(jen.Id("d").Dot("string").Call())))
+	case f.Type == "string":
+		group.Add(store(jen.Id("d").Dot("string").Call()))
+	case f.Cardinality == "[]" && f.Kind == enumField:
+		group.For(jen.List(jen.Id("_"), jen.Id("n")).Op(":=").Range().Id("d").Dot("varints").Call()).Block(
+			jen.Var().Id("v").Id(f.Type),
+			jen.Id("d").Dot("enum").Call(jen.Id("n"), jen.Id("v").Dot("setProtoNumber")),
+			field.Clone().Op("=").Append(field.Clone(), jen.Id("v")),
+		)
+	case f.Cardinality == "[]":
+		var v *jen.Statement
+		switch {
+		case f.Type == "bool":
+			v = jen.Id("v").Op("!=").Lit(0)
+		default:
+			v = jen.Int().Call(jen.Int32().Call(jen.Id("v")))
+		}
+		group.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Id("d").Dot("varints").Call()).Block(
+			field.Clone().Op("=").Append(field.Clone(), v),
+		)
+	case f.Kind == enumField:
+		group.Var().Id("v").Id(f.Type)
+		group.Id("d").Dot("enum").Call(jen.Id("d").Dot("varint").Call(), jen.Id("v").Dot("setProtoNumber"))
+		if f.Cardinality == "*" {
+			group.Add(field.Clone().Op("=").Op("&").Id("v"))
+		} else {
+			group.Add(field.Clone().Op("=").Id("v"))
+		}
+	case f.Type == "bool":
+		group.Add(store(jen.Id("d").Dot("bool").Call()))
+	default:
+		group.Add(store(jen.Id("d").Dot("int").Call()))
+	}
+}
+
+// appendEnumProtoNumbers generates the methods converting the codes of an enum from and to the numbers of their
+// values in fhir.proto.
+func appendEnumProtoNumbers(file *jen.File, p *typeSchema, name string) {
+	scope := p.numbers.scope(name)
+	file.Func().
+		Params(jen.Id("code").Id(name)).
+		Id("protoNumber").
+		Params().
+		Uint64().
+		Block(
+			jen.Switch(jen.Id("code")).BlockFunc(func(group *jen.Group) {
+				for _, identifier := range p.enums[name] {
+					group.Case(jen.Id(identifier)).Block(jen.Return(jen.Lit(scope.number(protoEnumValue(identifier)))))
+				}
+			}),
+			jen.Return(jen.Lit(0)),
+		)
+	file.Func().
+		Params(jen.Id("code").Op("*").Id(name)).
+		Id("setProtoNumber").
+		Params(jen.Id("number").Uint64()).
+		Bool().
+		Block(
+			jen.Switch(jen.Id("number")).BlockFunc(func(group *jen.Group) {
+				for _, identifier := range p.enums[name] {
+					group.Case(jen.Lit(scope.number(protoEnumValue(identifier)))).Block(jen.Op("*").Id("code").Op("=").Id(identifier))
+				}
+				group.Default().Block(jen.Return(jen.False()))
+			}),
+			jen.Return(jen.True()),
+		)
+}
+
+// appendProtoResourceNumbers generates the map of resource types to their field numbers in ContainedResource.
+func appendProtoResourceNumbers(file *jen.File, p *typeSchema, names []string) {
+	scope := p.numbers.scope("ContainedResource")
+	file.Comment("protoResourceNumbers maps resource types to their field numbers in the ContainedResource message")
+	file.Var().Id("protoResourceNumbers").Op("=").Map(jen.String()).Int().Values(jen.DictFunc(func(dict jen.Dict) {
+		for _, name := range names {
+			dict[jen.Lit(name)] = jen.Lit(scope.number(protoName(name)))
+		}
+	}))
+}
@@ -0,0 +1,246 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/samply/golang-fhir-models/fhir-models-gen/fhir"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// testProtoPrevious is the fhir.proto of an earlier version of the test schema. Sample had a field note and
+// SampleStatus a value retired since then, while Sample got a field count.
+const testProtoPrevious = `syntax = "proto3";
+
+package fhir.r4;
+
+message ContainedResource {
+  reserved 3;
+  reserved "gone";
+  oneof resource {
+    Other other = 1;
+    Sample sample = 2;
+  }
+}
+
+message Sample {
+  optional string id = 1;
+  SampleStatus status = 2;
+  optional string note = 3;
+  oneof value {
+    string value_string = 4;
+    bool value_boolean = 5;
+  }
+  repeated string given = 6;
+  repeated ContainedResource contained = 7;
+  optional string amount = 8;
+  repeated SampleComponent component = 9;
+}
+
+message SampleComponent {
+  string code = 1;
+}
+
+enum SampleStatus {
+  SAMPLE_STATUS_INVALID_UNINITIALIZED = 0;
+  SAMPLE_STATUS_DRAFT = 1;
+  SAMPLE_STATUS_RETIRED = 2;
+  SAMPLE_STATUS_FINAL = 3;
+}
+`
+
+// testTypeSchema returns a schema of a resource Sample with fields of all kinds, numbered after the given fhir.proto.
+func testTypeSchema(t *testing.T, previous string) *typeSchema {
+	schema := newTypeSchema()
+	if previous != "" {
+		numbers, err := readProtoNumbers(previous)
+		if err != nil {
+			t.Fatal(err)
+		}
+		schema.numbers = numbers
+	}
+	schema.messages = []*goStruct{
+		{Name: "Sample", Resource: true, Fields: []goField{
+			{Name: "Id", JSONName: "id", Cardinality: "*", Type: "string", TypeCode: "id", Kind: primitiveField, Element: "IdElement"},
+			{Name: "IdElement", JSONName: "_id", Cardinality: "*", Type: "Element", Kind: elementField, Primitive: "Id"},
+			{Name: "Status", JSONName: "status", Type: "SampleStatus", TypeCode: "code", Kind: enumField, Required: true, Element: "StatusElement"},
+			{Name: "StatusElement", JSONName: "_status", Cardinality: "*", Type: "Element", Kind: elementField, Primitive: "Status"},
+			{Name: "ValueString", JSONName: "valueString", Cardinality: "*", Type: "string", TypeCode: "string", Kind: primitiveField, Choice: "value", Element: "ValueStringElement"},
+			{Name: "ValueStringElement", JSONName: "_valueString", Cardinality: "*", Type: "Element", Kind: elementField, Primitive: "ValueString"},
+			{Name: "ValueBoolean", JSONName: "valueBoolean", Cardinality: "*", Type: "bool", TypeCode: "boolean", Kind: primitiveField, Choice: "value"},
+			{Name: "Given", JSONName: "given", Cardinality: "[]", Type: "string", TypeCode: "string", Kind: primitiveField, Element: "GivenElement"},
+			{Name: "GivenElement", JSONName: "_given", Cardinality: "[]", Type: "Element", Kind: elementField, Primitive: "Given"},
+			{Name: "Contained", JSONName: "contained", Cardinality: "[]", Type: "json.RawMessage", TypeCode: "Resource", Kind: resourceField},
+			{Name: "Amount", JSONName: "amount", Cardinality: "*", Type: "json.Number", TypeCode: "decimal", Kind: decimalField},
+			{Name: "Count", JSONName: "count", Cardinality: "*", Type: "int", TypeCode: "positiveInt", Kind: primitiveField},
+			{Name: "Component", JSONName: "component", Cardinality: "[]", Type: "SampleComponent", TypeCode: "BackboneElement", Kind: complexField},
+		}},
+		{Name: "SampleComponent", Fields: []goField{
+			{Name: "Code", JSONName: "code", Type: "string", TypeCode: "uri", Kind: primitiveField, Required: true},
+		}},
+	}
+	schema.enums["SampleStatus"] = []string{"SampleStatusDraft", "SampleStatusFinal", "SampleStatusEnteredInError"}
+	schema.codes["SampleStatus"] = []string{"draft", "final", "entered-in-error"}
+	schema.resources = []string{"Other", "Sample"}
+	for _, s := range schema.messages {
+		schema.numberProtoFields(s)
+	}
+	return schema
+}
+
+func TestProtoGolden(t *testing.T) {
+	want, err := os.ReadFile("testdata/sample.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := testTypeSchema(t, testProtoPrevious).proto(); got != string(want) {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGraphQLGolden(t *testing.T) {
+	want, err := os.ReadFile("testdata/sample.graphql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := testTypeSchema(t, "")
+	schema.searchParameters, err = searchParameterCodes(ResourceMap{"SearchParameter": {
+		"Resource-id":                 []byte(`{"resourceType": "SearchParameter", "code": "_id", "base": ["Resource"]}`),
+		"Resource-tag":                []byte(`{"resourceType": "SearchParameter", "code": "_tag", "base": ["Resource"]}`),
+		"DomainResource-text":         []byte(`{"resourceType": "SearchParameter", "code": "_text", "base": ["DomainResource"]}`),
+		"status":                      []byte(`{"resourceType": "SearchParameter", "code": "status", "base": ["Other", "Sample"]}`),
+		"Sample-general-practitioner": []byte(`{"resourceType": "SearchParameter", "code": "general-practitioner", "base": ["Sample"]}`),
+		"Sample-invalid":              []byte(`{"resourceType": "SearchParameter", "code": "not:valid", "base": ["Sample"]}`),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := schema.graphql(); got != string(want) {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestProtoNumbersStable(t *testing.T) {
+	first := testTypeSchema(t, testProtoPrevious).proto()
+	if second := testTypeSchema(t, first).proto(); second != first {
+		t.Errorf("numbers changed when generating again from\n%s\ngot\n%s", first, second)
+	}
+
+	// without previous fhir.proto, fields and values are numbered in order
+	fresh := testTypeSchema(t, "").proto()
+	for _, line := range []string{"  optional string id = 1;\n", "  repeated SampleComponent component = 9;\n",
+		"  Element id_element = 10;\n", "  repeated Element given_element = 13;\n", "  SAMPLE_STATUS_ENTERED_IN_ERROR = 3;\n",
+		"    Sample sample = 2;\n"} {
+		if !strings.Contains(fresh, line) {
+			t.Errorf("missing %q in\n%s", line, fresh)
+		}
+	}
+	if strings.Contains(fresh, "reserved") {
+		t.Errorf("fresh schema reserves numbers:\n%s", fresh)
+	}
+}
+
+func TestReadProtoNumbersErrors(t *testing.T) {
+	for _, src := range []string{
+		"message Sample {\n  reserved x;\n}\n",
+		"message Sample {\n  optional string id;\n}\n",
+	} {
+		if _, err := readProtoNumbers(src); err == nil {
+			t.Errorf("no error for %q", src)
+		}
+	}
+}
+
+// TestProtoWireCompatibility checks that the binary encoding of the generated structs is the one of the messages
+// protoc generates from fhir.proto, by reading and writing it with the protobuf runtime.
+func TestProtoWireCompatibility(t *testing.T) {
+	compiler := protocompile.Compiler{Resolver: &protocompile.SourceResolver{ImportPaths: []string{"../fhir"}}}
+	files, err := compiler.Compile(context.Background(), "fhir.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	descriptor := files[0].Messages().ByName("CodeSystem")
+	if descriptor == nil {
+		t.Fatal("no message CodeSystem in fhir.proto")
+	}
+
+	name, publisher, count, value := "Sample", "Acme", 2, "note"
+	resource := fhir.CodeSystem{
+		Name:         &name,
+		Status:       fhir.PublicationStatusActive,
+		Content:      fhir.CodeSystemContentModeComplete,
+		Count:        &count,
+		Publisher:    &publisher,
+		CountElement: &fhir.Element{Extension: []fhir.Extension{{Url: "http://example.org/estimated", ValueString: &value}}},
+		Concept:      []fhir.CodeSystemConcept{{Code: "a"}, {Code: "b"}},
+	}
+	b, err := resource.MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := dynamicpb.NewMessage(descriptor)
+	if err := proto.Unmarshal(b, message); err != nil {
+		t.Fatal(err)
+	}
+	assertProtoJSON(t, message, `{
+		"name": "Sample",
+		"status": "PUBLICATION_STATUS_ACTIVE",
+		"content": "CODE_SYSTEM_CONTENT_MODE_COMPLETE",
+		"count": 2,
+		"publisher": "Acme",
+		"count_element": {"extension": [{"url": "http://example.org/estimated", "value_string": "note"}]},
+		"concept": [{"code": "a"}, {"code": "b"}]
+	}`)
+
+	b, err = proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded fhir.CodeSystem
+	if err := decoded.UnmarshalProto(b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, resource) {
+		t.Errorf("got %+v, want %+v", decoded, resource)
+	}
+}
+
+func assertProtoJSON(t *testing.T, message protoreflect.ProtoMessage, want string) {
+	t.Helper()
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got, wanted interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(want), &wanted); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, wanted) {
+		t.Errorf("got %s, want %s", b, want)
+	}
+}
@@ -0,0 +1,516 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+// The tests in this file regenerate the code derived from the structs from already generated sources instead of the
+// StructureDefinitions, for changes of the generator which have to be applied without access to the definitions. They
+// are skipped unless the directory of the generated sources is given, e.g. in fhir-models-gen:
+//
+//	REGEN_DIR=$PWD/../fhir-models/fhir go test -run 'TestRegen$' ./cmd
+//	REGEN_DIR=$PWD/../fhir-models/fhir REGEN_STRUCTS=1 go test -run 'TestRegen$' ./cmd
+//	REGEN_SCHEMA_DIR=$PWD/../fhir-models/fhir REGEN_SEARCH_DIR=$PWD/../fhir-models/search go test -run TestRegenSchemas ./cmd
+//
+// TestRegen replaces the generated methods of each struct. With REGEN_STRUCTS=1 it also adds the XxxElement fields
+// holding id and extensions of primitives to the struct declarations. TestRegenSchemas rewrites fhir.proto and
+// fhir.graphql, whose list fields get the search parameters of the SearchParameters in REGEN_SEARCH_DIR. The goStructs
+// are reconstructed from the struct declarations and the predicates and type codes of the turtle methods, so type codes
+// of elements not rendered as primitives default to the Go type.
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+)
+
+type srcFile struct {
+	path    string
+	src     []byte
+	file    *ast.File
+	structs []*goStruct
+	decls   map[string]*ast.StructType
+}
+
+var regenMethods = map[string]bool{}
+
+func recvName(fd *ast.FuncDecl) string {
+	if fd.Recv == nil || len(fd.Recv.List) == 0 {
+		return ""
+	}
+	t := fd.Recv.List[0].Type
+	if s, ok := t.(*ast.StarExpr); ok {
+		t = s.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// loadGenerated parses the generated sources in dir and reconstructs their goStructs. It also returns the enums with
+// the identifiers and codes of their constants.
+func loadGenerated(t *testing.T, dir string) ([]*srcFile, map[string]bool, map[string][]string, map[string][]string) {
+	fset := token.NewFileSet()
+	paths, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	sort.Strings(paths)
+	var files []*srcFile
+	enums := map[string]bool{}
+	enumIdents := map[string][]string{}
+	enumCodes := map[string][]string{}
+	for _, p := range paths {
+		if strings.HasSuffix(p, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := parser.ParseFile(fset, p, src, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, &srcFile{path: p, src: src, file: f, decls: map[string]*ast.StructType{}})
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok && fd.Name.Name == "Code" && fd.Recv != nil {
+				if id, ok := fd.Recv.List[0].Type.(*ast.Ident); ok {
+					sw, ok := fd.Body.List[0].(*ast.SwitchStmt)
+					if !ok {
+						continue
+					}
+					enums[id.Name] = true
+					for _, c := range sw.Body.List {
+						cc := c.(*ast.CaseClause)
+						enumIdents[id.Name] = append(enumIdents[id.Name], cc.List[0].(*ast.Ident).Name)
+						lit := cc.Body[0].(*ast.ReturnStmt).Results[0].(*ast.BasicLit)
+						s, _ := strconv.Unquote(lit.Value)
+						enumCodes[id.Name] = append(enumCodes[id.Name], s)
+					}
+				}
+			}
+		}
+	}
+	for _, sf := range files {
+		for _, d := range sf.file.Decls {
+			gd, ok := d.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if st, ok := ts.Type.(*ast.StructType); ok {
+					sf.decls[ts.Name.Name] = st
+				}
+			}
+		}
+		funcs := map[string]map[string]*ast.FuncDecl{}
+		var order []string
+		for _, d := range sf.file.Decls {
+			fd, ok := d.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			r := recvName(fd)
+			if r == "" {
+				continue
+			}
+			if funcs[r] == nil {
+				funcs[r] = map[string]*ast.FuncDecl{}
+			}
+			funcs[r][fd.Name.Name] = fd
+			if fd.Name.Name == "MarshalJSON" && sf.decls[r] != nil {
+				order = append(order, r)
+			}
+		}
+		for _, name := range order {
+			if funcs[name]["turtle"] == nil {
+				continue
+			}
+			s := &goStruct{Name: name}
+			predicates := map[string][2]string{}
+			ast.Inspect(funcs[name]["turtle"].Body, func(n ast.Node) bool {
+				switch x := n.(type) {
+				case *ast.RangeStmt:
+					field := x.X.(*ast.SelectorExpr).Sel.Name
+					call := x.Body.List[0].(*ast.ExprStmt).X.(*ast.CallExpr)
+					rec(predicates, field, call)
+					return false
+				case *ast.CallExpr:
+					sel, ok := x.Fun.(*ast.SelectorExpr)
+					if !ok {
+						return true
+					}
+					if sel.Sel.Name == "resource" {
+						s.Resource = true
+						return false
+					}
+					if len(x.Args) >= 3 {
+						if fs, ok := x.Args[2].(*ast.SelectorExpr); ok {
+							rec(predicates, fs.Sel.Name, x)
+						}
+					}
+					return false
+				}
+				return true
+			})
+			choices := map[string]string{}
+			for mname, fd := range funcs[name] {
+				if !strings.HasPrefix(mname, "Set") || fd.Recv == nil {
+					continue
+				}
+				if _, ok := fd.Recv.List[0].Type.(*ast.StarExpr); !ok {
+					continue
+				}
+				choice := strings.ToLower(mname[3:4]) + mname[4:]
+				for _, st := range fd.Body.List {
+					if as, ok := st.(*ast.AssignStmt); ok {
+						if se, ok := as.Lhs[0].(*ast.SelectorExpr); ok {
+							if id, ok := as.Rhs[0].(*ast.Ident); ok && id.Name == "nil" {
+								choices[se.Sel.Name] = choice
+							}
+						}
+					}
+				}
+			}
+			for _, fl := range sf.decls[name].Fields.List {
+				f := goField{Name: fl.Names[0].Name}
+				tag, _ := strconv.Unquote(fl.Tag.Value)
+				jsonTag := reflect.StructTag(tag).Get("json")
+				parts := strings.Split(jsonTag, ",")
+				f.JSONName = parts[0]
+				f.Required = len(parts) == 1
+				typ := fl.Type
+				switch x := typ.(type) {
+				case *ast.ArrayType:
+					f.Cardinality = "[]"
+					typ = x.Elt
+					if p, ok := typ.(*ast.StarExpr); ok {
+						typ = p.X
+					}
+				case *ast.StarExpr:
+					f.Cardinality = "*"
+					typ = x.X
+				}
+				if se, ok := typ.(*ast.SelectorExpr); ok {
+					if se.Sel.Name == "RawMessage" {
+						f.Kind = resourceField
+						f.Type = "json.RawMessage"
+						f.TypeCode = "Resource"
+					} else {
+						f.Kind = decimalField
+						f.Type = "json.Number"
+					}
+				} else {
+					id := typ.(*ast.Ident).Name
+					f.Type = id
+					switch {
+					case enums[id]:
+						f.Kind = enumField
+					case id == "bool" || id == "int" || id == "string":
+						f.Kind = primitiveField
+					default:
+						f.Kind = complexField
+						f.TypeCode = id
+					}
+				}
+				if strings.HasPrefix(f.JSONName, "_") {
+					f.Kind = elementField
+					f.TypeCode = "Element"
+					f.Primitive = strings.TrimSuffix(f.Name, "Element")
+					s.Fields = append(s.Fields, f)
+					continue
+				}
+				if p, ok := predicates[f.Name]; ok {
+					f.Predicate = p[0]
+					if p[1] != "" {
+						f.TypeCode = p[1]
+					}
+				} else {
+					t.Logf("%s.%s has no predicate", name, f.Name)
+				}
+				f.Choice = choices[f.Name]
+				s.Fields = append(s.Fields, f)
+			}
+			linkElements(s)
+			sf.structs = append(sf.structs, s)
+		}
+	}
+	return files, enums, enumIdents, enumCodes
+}
+
+// rec records the predicate and, for primitives, the type code of a field passed to a turtle method.
+func rec(predicates map[string][2]string, field string, call *ast.CallExpr) {
+	p, _ := strconv.Unquote(call.Args[0].(*ast.BasicLit).Value)
+	code := ""
+	if sel := call.Fun.(*ast.SelectorExpr); sel.Sel.Name == "primitive" {
+		code, _ = strconv.Unquote(call.Args[len(call.Args)-1].(*ast.BasicLit).Value)
+	}
+	predicates[field] = [2]string{p, code}
+}
+
+// renderMethods renders the generated methods of the structs without package clause and imports.
+func renderMethods(t *testing.T, structs []*goStruct) string {
+	file := jen.NewFile("fhir")
+	for _, s := range structs {
+		appendStructMethods(file, s)
+	}
+	var b bytes.Buffer
+	if err := file.Render(&b); err != nil {
+		t.Fatal(err)
+	}
+	src := b.String()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range f.Decls {
+		if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			continue
+		}
+		start := d.Pos()
+		if fd, ok := d.(*ast.FuncDecl); ok && fd.Doc != nil {
+			start = fd.Doc.Pos()
+		}
+		return src[fset.Position(start).Offset:]
+	}
+	return ""
+}
+
+func TestRegen(t *testing.T) {
+	dir := os.Getenv("REGEN_DIR")
+	if dir == "" {
+		t.Skip()
+	}
+	files, _, _, _ := loadGenerated(t, dir)
+	numbers := regenNumbers(t, dir)
+	for _, sf := range files {
+		for _, s := range sf.structs {
+			extendStruct(s)
+			(&typeSchema{numbers: numbers}).numberProtoFields(s)
+		}
+	}
+	for _, sf := range files {
+		if len(sf.structs) == 0 {
+			continue
+		}
+		// the block of methods runs from the first method of the first struct to the last method of the last one
+		fset := token.NewFileSet()
+		f, _ := parser.ParseFile(fset, sf.path, sf.src, parser.ParseComments)
+		names := map[string]bool{}
+		for _, s := range sf.structs {
+			names[s.Name] = true
+		}
+		start, end := -1, -1
+		for _, d := range f.Decls {
+			fd, ok := d.(*ast.FuncDecl)
+			if !ok || !names[recvName(fd)] || !isGeneratedMethod(fd.Name.Name) {
+				continue
+			}
+			s := fset.Position(fd.Pos()).Offset
+			if fd.Doc != nil {
+				s = fset.Position(fd.Doc.Pos()).Offset
+			}
+			if start < 0 {
+				start = s
+			}
+			end = fset.Position(fd.End()).Offset
+		}
+		methods := renderMethods(t, sf.structs)
+		out := string(sf.src[:start]) + strings.TrimRight(methods, "\n") + string(sf.src[end:])
+		if regenStructDecl != nil {
+			out = regenStructDecl(t, sf, out)
+		}
+		formatted, err := format.Source([]byte(out))
+		if err != nil {
+			t.Fatalf("%s: %v", sf.path, err)
+		}
+		if err := os.WriteFile(sf.path, formatted, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+var generatedMethods = []string{"MarshalJSON", "appendJSON", "UnmarshalJSON", "decodeJSON", "DeepCopy", "Equal",
+	"EqualsDeep", "EqualsShallow", "MarshalXML", "UnmarshalXML", "turtle", "MarshalBSON", "UnmarshalBSON",
+	"MarshalProto", "appendProto", "UnmarshalProto", "decodeProto"}
+
+func isGeneratedMethod(name string) bool {
+	for _, m := range generatedMethods {
+		if m == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hooks set by REGEN_STRUCTS to change the struct declarations
+var extendStruct = func(s *goStruct) {}
+var regenStructDecl func(t *testing.T, sf *srcFile, out string) string
+
+// linkElements sets the names of the element fields of primitives and their predicates.
+func linkElements(s *goStruct) {
+	for i := range s.Fields {
+		if s.Fields[i].Kind != elementField {
+			continue
+		}
+		for j := range s.Fields {
+			if s.Fields[j].Name == s.Fields[i].Primitive {
+				s.Fields[j].Element = s.Fields[i].Name
+				s.Fields[i].Predicate = s.Fields[j].Predicate
+			}
+		}
+	}
+}
+
+// addElements adds the element fields to primitives which don't have them yet.
+func addElements(s *goStruct) {
+	var fields []goField
+	for _, f := range s.Fields {
+		if f.Kind == elementField {
+			continue
+		}
+		fields = append(fields, f)
+		eligible := (f.Kind == primitiveField || f.Kind == decimalField || f.Kind == enumField) && f.TypeCode != "xhtml" &&
+			f.Predicate != "Element.id" && f.Predicate != "Extension.url"
+		if !eligible {
+			continue
+		}
+		c := goField{Name: f.Name + "Element", JSONName: "_" + f.JSONName, Cardinality: "*", Type: "Element",
+			TypeCode: "Element", Kind: elementField, Predicate: f.Predicate, Primitive: f.Name}
+		if f.Cardinality == "[]" {
+			c.Cardinality = "[]"
+		}
+		fields[len(fields)-1].Element = c.Name
+		fields = append(fields, c)
+	}
+	s.Fields = fields
+}
+
+// structDecl renders the declaration of the struct without doc comment.
+func structDecl(s *goStruct) string {
+	file := jen.NewFile("fhir")
+	file.Type().Id(s.Name).StructFunc(func(g *jen.Group) {
+		for _, f := range s.Fields {
+			st := g.Id(f.Name)
+			switch {
+			case f.Kind == elementField && f.Cardinality == "[]":
+				st.Op("[]*").Id("Element")
+			case f.Kind == elementField:
+				st.Op("*").Id("Element")
+			default:
+				st.Op(f.Cardinality).Add(f.typeStatement())
+			}
+			if f.Required {
+				st.Tag(map[string]string{"json": f.JSONName, "bson": f.JSONName})
+			} else {
+				st.Tag(map[string]string{"json": f.JSONName + ",omitempty", "bson": f.JSONName + ",omitempty"})
+			}
+		}
+	})
+	var b bytes.Buffer
+	if err := file.Render(&b); err != nil {
+		panic(err)
+	}
+	src := b.String()
+	return src[strings.Index(src, "type "+s.Name+" struct"):]
+}
+
+func init() {
+	if os.Getenv("REGEN_STRUCTS") == "1" {
+		extendStruct = addElements
+		regenStructDecl = func(t *testing.T, sf *srcFile, out string) string {
+			for _, s := range sf.structs {
+				start := strings.Index(out, "type "+s.Name+" struct {")
+				if start < 0 {
+					t.Fatalf("no declaration of %s", s.Name)
+				}
+				end := start + strings.Index(out[start:], "\n}\n") + 3
+				out = out[:start] + strings.TrimRight(structDecl(s), "\n") + "\n" + out[end:]
+			}
+			return out
+		}
+	}
+}
+
+func TestRegenSchemas(t *testing.T) {
+	dir := os.Getenv("REGEN_SCHEMA_DIR")
+	if dir == "" {
+		t.Skip()
+	}
+	files, _, enumIdents, enumCodes := loadGenerated(t, dir)
+	schema := newTypeSchema()
+	for _, sf := range files {
+		schema.messages = append(schema.messages, sf.structs...)
+	}
+	schema.enums = enumIdents
+	schema.codes = enumCodes
+	registry, err := os.ReadFile(filepath.Join(dir, "resourceRegistry.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := string(registry)
+	list := src[strings.Index(src, "var resourceTypes = []string{")+len("var resourceTypes = []string{"):]
+	list = list[:strings.Index(list, "}")]
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			unquoted, _ := strconv.Unquote(name)
+			schema.resources = append(schema.resources, unquoted)
+		}
+	}
+	if searchDir := os.Getenv("REGEN_SEARCH_DIR"); searchDir != "" {
+		searchParameters, err := loadResources(searchDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if schema.searchParameters, err = searchParameterCodes(searchParameters); err != nil {
+			t.Fatal(err)
+		}
+	}
+	schema.numbers = regenNumbers(t, dir)
+	for _, s := range schema.messages {
+		schema.numberProtoFields(s)
+	}
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd)
+	os.Chdir(dir)
+	if err := schema.saveProto(); err != nil {
+		t.Fatal(err)
+	}
+	if err := schema.saveGraphQL(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func regenNumbers(t *testing.T, dir string) protoNumbers {
+	src, err := os.ReadFile(filepath.Join(dir, "fhir.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	numbers, err := readProtoNumbers(string(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return numbers
+}
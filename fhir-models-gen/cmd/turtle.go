@@ -0,0 +1,66 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// turtleStatement returns the call adding a single value of the field to the RDF node n. The id and extensions of
+// primitives are added to the node of their value.
+func (f goField) turtleStatement(value jen.Code, index jen.Code) *jen.Statement {
+	predicate := jen.Lit(f.Predicate)
+	switch f.Kind {
+	case resourceField:
+		return jen.Id("n").Dot("inline").Call(predicate, index, value)
+	case complexField:
+		return jen.Id("n").Dot("element").Call(predicate, index, value)
+	default:
+		var element jen.Code = jen.Nil()
+		if f.Element != "" && f.Cardinality == "[]" {
+			element = jen.Id("elementAt").Call(jen.Id("r").Dot(f.Element), index)
+		} else if f.Element != "" {
+			element = jen.Id("r").Dot(f.Element)
+		}
+		return jen.Id("n").Dot("primitive").Call(predicate, index, value, element, jen.Lit(f.TypeCode))
+	}
+}
+
+func appendTurtle(file *jen.File, s *goStruct) {
+	file.Commentf("turtle adds the elements of the %s as properties to the RDF node n", s.Name)
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("turtle").Params(jen.Id("n").Op("*").Id("turtleNode")).BlockFunc(func(group *jen.Group) {
+		if s.Resource {
+			group.Id("n").Dot("resource").Call(jen.Lit(s.Name), jen.Id("r").Dot("Id"))
+		}
+		for _, f := range s.Fields {
+			if f.Kind == elementField {
+				continue
+			}
+			if f.Cardinality == "[]" {
+				group.For(jen.List(jen.Id("i"), jen.Id("v")).Op(":=").Range().Id("r").Dot(f.Name)).Block(
+					f.turtleStatement(jen.Id("v"), jen.Id("i")),
+				)
+			} else {
+				group.Add(f.turtleStatement(jen.Id("r").Dot(f.Name), jen.Lit(-1)))
+			}
+		}
+		if s.Name == "Reference" {
+			group.Id("n").Dot("link").Call(jen.Id("r").Dot("Reference"), jen.Id("r").Dot("Type"))
+		}
+		if s.Name == "Coding" {
+			group.Id("n").Dot("concept").Call(jen.Id("r").Dot("System"), jen.Id("r").Dot("Code"))
+		}
+	})
+}
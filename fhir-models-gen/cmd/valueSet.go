@@ -8,39 +8,19 @@ import (
 	"strings"
 )
 
-func generateValueSet(resources ResourceMap, valueSet fhir.ValueSet) (*jen.File, error) {
+func generateValueSet(resources ResourceMap, schema *typeSchema, valueSet fhir.ValueSet) (*jen.File, error) {
 	if valueSet.Name == nil {
 		return nil, errors.New("ValueSet without name")
 	}
 
-	if valueSet.Compose == nil || len(valueSet.Compose.Include) == 0 {
-		return nil, fmt.Errorf("the ValueSet `%s` doens't include any CodeSystems", *valueSet.Name)
-	}
-
-	if len(valueSet.Compose.Include) > 1 {
-		return nil, fmt.Errorf("the ValueSet `%s` includes more than one CodeSystem", *valueSet.Name)
-	}
-
-	url := canonical(valueSet.Compose.Include[0])
-	if url == "" {
-		return nil, fmt.Errorf("the ValueSet `%s` doens't include any CodeSystems", *valueSet.Name)
-	}
-
-	bytes := resources["CodeSystem"][url]
-	if bytes == nil {
-		return nil, fmt.Errorf("missing CodeSystem with canonical URL `%s` in ValueSet `%s`", url, *valueSet.Name)
-	}
-
-	codeSystem, err := fhir.UnmarshalCodeSystem(bytes)
+	codeSystem, err := valueSetCodeSystem(resources, valueSet)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(codeSystem.Concept) == 0 {
-		return nil, fmt.Errorf("the CodeSystem with canonical URL `%s` has no codes", url)
-	}
-
 	fmt.Printf("Generate Go sources for ValueSet: %s\n", *valueSet.Name)
+	schema.enums[*valueSet.Name] = identifiers(*valueSet.Name, codeSystem.Concept)
+	schema.codes[*valueSet.Name] = conceptCodes(codeSystem.Concept)
 	file := jen.NewFile("fhir")
 	appendLicenseComment(file)
 	appendGeneratorComment(file)
@@ -64,14 +44,39 @@ func generateValueSet(resources ResourceMap, valueSet fhir.ValueSet) (*jen.File,
 	file.Func().
 		Params(jen.Id("code").Op("*").Id(*valueSet.Name)).
 		Id("UnmarshalJSON").
-		Params(jen.Id("json").Op("[]").Byte()).
+		Params(jen.Id("b").Op("[]").Byte()).
 		Error().
 		Block(
-			jen.Id("s").Op(":=").Qual("strings", "Trim").Call(jen.String().Call(jen.Id("json")), jen.Lit(`"`)),
+			jen.Id("d").Op(":=").Id("jsonDecoder").Values(jen.Dict{jen.Id("buf"): jen.Id("b")}),
+			jen.List(jen.Id("s"), jen.Id("_")).Op(":=").Id("d").Dot("string").Call(),
+			jen.Id("d").Dot("end").Call(),
+			jen.If(jen.Id("d").Dot("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Id("d").Dot("err")),
+			),
 			jen.Switch(jen.Id("s")).BlockFunc(unmarshalRoot(*valueSet.Name, codeSystem.Concept)),
 			jen.Return(jen.Nil()),
 		)
 
+	// MarshalBSONValue function
+	file.Func().
+		Params(jen.Id("code").Id(*valueSet.Name)).
+		Id("MarshalBSONValue").
+		Params().
+		Params(jen.Byte(), jen.Op("[]").Byte(), jen.Error()).
+		Block(
+			jen.Return(jen.Id("marshalBSONValue").Call(jen.Id("code"))),
+		)
+
+	// UnmarshalBSONValue function
+	file.Func().
+		Params(jen.Id("code").Op("*").Id(*valueSet.Name)).
+		Id("UnmarshalBSONValue").
+		Params(jen.Id("t").Byte(), jen.Id("b").Op("[]").Byte()).
+		Error().
+		Block(
+			jen.Return(jen.Id("unmarshalBSONValue").Call(jen.Id("t"), jen.Id("b"), jen.Id("code"))),
+		)
+
 	// String function
 	file.Func().
 		Params(jen.Id("code").Id(*valueSet.Name)).
@@ -115,9 +120,43 @@ func generateValueSet(resources ResourceMap, valueSet fhir.ValueSet) (*jen.File,
 			jen.Return(jen.Lit("<unknown>")),
 		)
 
+	// protoNumber and setProtoNumber functions
+	appendEnumProtoNumbers(file, schema, *valueSet.Name)
+
 	return file, nil
 }
 
+// valueSetCodeSystem returns the only CodeSystem included by the ValueSet, whose codes make up an enum.
+func valueSetCodeSystem(resources ResourceMap, valueSet fhir.ValueSet) (fhir.CodeSystem, error) {
+	if valueSet.Compose == nil || len(valueSet.Compose.Include) == 0 {
+		return fhir.CodeSystem{}, fmt.Errorf("the ValueSet `%s` doens't include any CodeSystems", *valueSet.Name)
+	}
+
+	if len(valueSet.Compose.Include) > 1 {
+		return fhir.CodeSystem{}, fmt.Errorf("the ValueSet `%s` includes more than one CodeSystem", *valueSet.Name)
+	}
+
+	url := canonical(valueSet.Compose.Include[0])
+	if url == "" {
+		return fhir.CodeSystem{}, fmt.Errorf("the ValueSet `%s` doens't include any CodeSystems", *valueSet.Name)
+	}
+
+	bytes := resources["CodeSystem"][url]
+	if bytes == nil {
+		return fhir.CodeSystem{}, fmt.Errorf("missing CodeSystem with canonical URL `%s` in ValueSet `%s`", url, *valueSet.Name)
+	}
+
+	codeSystem, err := fhir.UnmarshalCodeSystem(bytes)
+	if err != nil {
+		return codeSystem, err
+	}
+
+	if len(codeSystem.Concept) == 0 {
+		return fhir.CodeSystem{}, fmt.Errorf("the CodeSystem with canonical URL `%s` has no codes", url)
+	}
+	return codeSystem, nil
+}
+
 func canonical(include fhir.ValueSetComposeInclude) string {
 	if system := include.System; system != nil {
 		if version := include.Version; version != nil {
@@ -149,6 +188,26 @@ func consts(valueSetName string, concepts []fhir.CodeSystemConcept) func(*jen.Gr
 	}
 }
 
+// identifiers returns the identifiers of the constants in the order of their values.
+func identifiers(valueSetName string, concepts []fhir.CodeSystemConcept) []string {
+	var result []string
+	for _, concept := range concepts {
+		result = append(result, codeIdentifier(valueSetName, concept.Code))
+		result = append(result, identifiers(valueSetName, concept.Concept)...)
+	}
+	return result
+}
+
+// conceptCodes returns the codes of the concepts including the nested ones
+func conceptCodes(concepts []fhir.CodeSystemConcept) []string {
+	var result []string
+	for _, concept := range concepts {
+		result = append(result, concept.Code)
+		result = append(result, conceptCodes(concept.Concept)...)
+	}
+	return result
+}
+
 func codeIdentifier(valueSetName, s string) string {
 	switch s {
 	case "=":
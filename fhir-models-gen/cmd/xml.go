@@ -0,0 +1,258 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// xmlAttribute reports whether the field is represented as XML attribute instead of a child element. These are the
+// ids of elements, which are elements themselves in resources, and the url of extensions.
+func (s *goStruct) xmlAttribute(f goField) bool {
+	return f.JSONName == "id" && !s.Resource || s.Name == "Extension" && f.JSONName == "url"
+}
+
+// xmlEncoder returns the name of the helper function which encodes a single value of the field.
+func (f goField) xmlEncoder() string {
+	switch {
+	case f.TypeCode == "xhtml":
+		return "encodeXHTML"
+	case f.Kind == resourceField:
+		return "encodeXMLResource"
+	case f.Kind == complexField:
+		return "encodeXMLElement"
+	default:
+		return "encodeXMLPrimitive"
+	}
+}
+
+// xmlEncoderArgs returns the arguments of the helper function encoding the value v, which is the item at index i of
+// lists. Primitives get their id and extensions as well.
+func (f goField) xmlEncoderArgs(v, i *jen.Statement) []jen.Code {
+	args := []jen.Code{jen.Id("e"), jen.Lit(f.JSONName), v}
+	if f.xmlEncoder() != "encodeXMLPrimitive" {
+		return args
+	}
+	switch {
+	case f.Element == "":
+		return append(args, jen.Nil())
+	case f.Cardinality == "[]":
+		return append(args, jen.Id("elementAt").Call(jen.Id("r").Dot(f.Element), i))
+	default:
+		return append(args, jen.Id("r").Dot(f.Element))
+	}
+}
+
+func appendMarshalXML(file *jen.File, s *goStruct) {
+	file.Commentf("MarshalXML marshals the given %s as FHIR XML", s.Name)
+	file.Func().Params(jen.Id("r").Id(s.Name)).Id("MarshalXML").
+		Params(jen.Id("e").Op("*").Qual("encoding/xml", "Encoder"), jen.Id("start").Qual("encoding/xml", "StartElement")).
+		Error().BlockFunc(func(group *jen.Group) {
+		if s.Resource {
+			group.Id("start").Dot("Name").Op("=").Qual("encoding/xml", "Name").Values(jen.Dict{
+				jen.Id("Space"): jen.Id("fhirNamespace"),
+				jen.Id("Local"): jen.Lit(s.Name),
+			})
+		}
+		for _, f := range s.Fields {
+			if !s.xmlAttribute(f) {
+				continue
+			}
+			value := jen.Id("r").Dot(f.Name)
+			if f.Cardinality == "*" {
+				value = jen.Op("*").Add(value)
+			}
+			attr := jen.Id("start").Dot("Attr").Op("=").Append(jen.Id("start").Dot("Attr"), jen.Qual("encoding/xml", "Attr").Values(jen.Dict{
+				jen.Id("Name"):  jen.Qual("encoding/xml", "Name").Values(jen.Dict{jen.Id("Local"): jen.Lit(f.JSONName)}),
+				jen.Id("Value"): value,
+			}))
+			if f.Cardinality == "*" {
+				group.If(jen.Id("r").Dot(f.Name).Op("!=").Nil()).Block(attr)
+			} else {
+				group.Add(attr)
+			}
+		}
+		group.If(jen.Err().Op(":=").Id("e").Dot("EncodeToken").Call(jen.Id("start")), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		)
+		for _, f := range s.Fields {
+			// the ids and extensions of primitives are encoded together with their values
+			if s.xmlAttribute(f) || f.Kind == elementField {
+				continue
+			}
+			if f.Cardinality == "[]" {
+				index := jen.Id("_")
+				if f.Element != "" {
+					index = jen.Id("i")
+				}
+				group.For(jen.List(index, jen.Id("v")).Op(":=").Range().Id("r").Dot(f.Name)).Block(
+					jen.If(jen.Err().Op(":=").Id(f.xmlEncoder()).Call(f.xmlEncoderArgs(jen.Id("v"), jen.Id("i"))...), jen.Err().Op("!=").Nil()).Block(
+						jen.Return(jen.Err()),
+					),
+				)
+			} else {
+				group.If(jen.Err().Op(":=").Id(f.xmlEncoder()).Call(f.xmlEncoderArgs(jen.Id("r").Dot(f.Name), nil)...), jen.Err().Op("!=").Nil()).Block(
+					jen.Return(jen.Err()),
+				)
+			}
+		}
+		group.Return(jen.Id("e").Dot("EncodeToken").Call(jen.Id("start").Dot("End").Call()))
+	})
+}
+
+func appendUnmarshalXML(file *jen.File, s *goStruct) {
+	file.Commentf("UnmarshalXML unmarshals the given %s from FHIR XML", s.Name)
+	file.Func().Params(jen.Id("r").Op("*").Id(s.Name)).Id("UnmarshalXML").
+		Params(jen.Id("d").Op("*").Qual("encoding/xml", "Decoder"), jen.Id("start").Qual("encoding/xml", "StartElement")).
+		Error().BlockFunc(func(group *jen.Group) {
+		var attributes []jen.Code
+		for _, f := range s.Fields {
+			if !s.xmlAttribute(f) {
+				continue
+			}
+			assign := jen.Id("r").Dot(f.Name).Op("=").Id("attr").Dot("Value")
+			if f.Cardinality == "*" {
+				assign = jen.Id("r").Dot(f.Name).Op("=").Op("&").Id("attr").Dot("Value")
+			}
+			attributes = append(attributes, jen.Case(jen.Lit(f.JSONName)).Block(assign))
+		}
+		if len(attributes) > 0 {
+			group.For(jen.List(jen.Id("_"), jen.Id("attr")).Op(":=").Range().Id("start").Dot("Attr")).Block(
+				jen.Id("attr").Op(":=").Id("attr"),
+				jen.Switch(jen.Id("attr").Dot("Name").Dot("Local")).Block(attributes...),
+			)
+		}
+
+		var elements []jen.Code
+		for _, f := range s.Fields {
+			if s.xmlAttribute(f) || f.Kind == elementField {
+				continue
+			}
+			elements = append(elements, jen.Case(jen.Lit(f.JSONName)).BlockFunc(func(c *jen.Group) {
+				appendXMLFieldDecoder(c, f)
+			}))
+		}
+		elements = append(elements, jen.Default().Block(
+			jen.If(jen.Err().Op(":=").Id("d").Dot("Skip").Call(), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		))
+
+		group.For().Block(
+			jen.List(jen.Id("token"), jen.Err()).Op(":=").Id("d").Dot("Token").Call(),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+			jen.Switch(jen.Id("t").Op(":=").Id("token").Assert(jen.Type())).Block(
+				jen.Case(jen.Qual("encoding/xml", "StartElement")).Block(
+					jen.Switch(jen.Id("t").Dot("Name").Dot("Local")).Block(elements...),
+				),
+				jen.Case(jen.Qual("encoding/xml", "EndElement")).Block(jen.Return(jen.Nil())),
+			),
+		)
+	})
+}
+
+// appendXMLFieldDecoder generates the statements decoding the start element t into the field.
+func appendXMLFieldDecoder(group *jen.Group, f goField) {
+	field := jen.Id("r").Dot(f.Name)
+	var store *jen.Statement
+	switch f.Cardinality {
+	case "[]":
+		store = field.Clone().Op("=").Append(field.Clone(), jen.Id("v"))
+	case "*":
+		store = field.Clone().Op("=").Op("&").Id("v")
+	default:
+		store = field.Clone().Op("=").Id("v")
+	}
+	returnErr := jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err()))
+
+	switch {
+	case f.TypeCode == "xhtml" || f.Kind == resourceField:
+		decoder := "decodeXHTML"
+		if f.Kind == resourceField {
+			decoder = "decodeXMLResource"
+		}
+		group.List(jen.Id("v"), jen.Err()).Op(":=").Id(decoder).Call(jen.Id("d"), jen.Id("t"))
+		group.Add(returnErr)
+		group.Add(store)
+	case f.Kind == complexField:
+		group.Var().Id("v").Add(f.typeStatement())
+		group.If(jen.Err().Op(":=").Id("d").Dot("DecodeElement").Call(jen.Op("&").Id("v"), jen.Op("&").Id("t")), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		)
+		group.Add(store)
+	case f.Element == "":
+		group.Var().Id("v").Add(f.typeStatement())
+		group.List(jen.Id("ok"), jen.Id("_"), jen.Err()).Op(":=").Id("decodeXMLPrimitive").Call(jen.Id("d"), jen.Id("t"), jen.Op("&").Id("v"))
+		group.Add(returnErr)
+		group.If(jen.Id("ok")).Block(store)
+	default:
+		// primitives without value attribute only carry an id or extensions
+		element := jen.Id("r").Dot(f.Element)
+		group.Var().Id("v").Add(f.typeStatement())
+		group.List(jen.Id("ok"), jen.Id("element"), jen.Err()).Op(":=").Id("decodeXMLPrimitive").Call(jen.Id("d"), jen.Id("t"), jen.Op("&").Id("v"))
+		group.Add(returnErr)
+		if f.Cardinality == "[]" {
+			group.If(jen.Id("ok").Op("||").Id("element").Op("!=").Nil()).Block(
+				element.Clone().Op("=").Id("appendElement").Call(element.Clone(), jen.Len(field.Clone()), jen.Id("element")),
+				store,
+			)
+		} else {
+			group.If(jen.Id("ok")).Block(store)
+			group.Add(element.Clone()).Op("=").Id("element")
+		}
+	}
+}
+
+// generateResourceRegistry generates a lookup of the resource types, which is needed to decode contained and inline
+// resources, and of the FHIR types of primitive fields.
+func generateResourceRegistry(names []string, schema *typeSchema) *jen.File {
+	file := jen.NewFile("fhir")
+	appendLicenseComment(file)
+	appendGeneratorComment(file)
+
+	file.Comment("resourceTypes lists all resources in alphabetical order")
+	file.Var().Id("resourceTypes").Op("=").Index().String().ValuesFunc(func(group *jen.Group) {
+		for _, name := range names {
+			group.Lit(name)
+		}
+	})
+
+	file.Comment("newResource returns a pointer to a new instance of the resource with the given type")
+	file.Func().Id("newResource").Params(jen.Id("resourceType").String()).Params(jen.Interface(), jen.Bool()).Block(
+		jen.Switch(jen.Id("resourceType")).BlockFunc(func(group *jen.Group) {
+			for _, name := range names {
+				group.Case(jen.Lit(name)).Block(jen.Return(jen.Op("&").Id(name).Values(), jen.True()))
+			}
+		}),
+		jen.Return(jen.Nil(), jen.False()),
+	)
+
+	file.Comment("primitiveTypeCodes maps the fields of primitive elements, named by struct and field, to their FHIR type code")
+	file.Var().Id("primitiveTypeCodes").Op("=").Map(jen.String()).String().Values(jen.DictFunc(func(dict jen.Dict) {
+		for _, s := range schema.messages {
+			for _, f := range s.Fields {
+				if f.Kind != complexField && f.Kind != resourceField && f.Kind != elementField {
+					dict[jen.Lit(s.Name+"."+f.Name)] = jen.Lit(f.TypeCode)
+				}
+			}
+		}
+	}))
+
+	file.Comment("PrimitiveTypeCode returns the FHIR type code of the primitive field of the given struct, like date or positiveInt,")
+	file.Comment("as the Go types string and int don't distinguish them. It returns false if the field is no primitive field.")
+	file.Func().Id("PrimitiveTypeCode").Params(jen.List(jen.Id("structName"), jen.Id("fieldName")).String()).Params(jen.String(), jen.Bool()).Block(
+		jen.List(jen.Id("code"), jen.Id("ok")).Op(":=").Id("primitiveTypeCodes").Index(jen.Id("structName").Op("+").Lit(".").Op("+").Id("fieldName")),
+		jen.Return(jen.Id("code"), jen.Id("ok")),
+	)
+	return file
+}
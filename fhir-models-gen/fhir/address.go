@@ -14,21 +14,924 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Address is documented here http://hl7.org/fhir/StructureDefinition/Address
 type Address struct {
-	Id         *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension  []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	Use        *AddressUse  `bson:"use,omitempty" json:"use,omitempty"`
-	Type       *AddressType `bson:"type,omitempty" json:"type,omitempty"`
-	Text       *string      `bson:"text,omitempty" json:"text,omitempty"`
-	Line       []string     `bson:"line,omitempty" json:"line,omitempty"`
-	City       *string      `bson:"city,omitempty" json:"city,omitempty"`
-	District   *string      `bson:"district,omitempty" json:"district,omitempty"`
-	State      *string      `bson:"state,omitempty" json:"state,omitempty"`
-	PostalCode *string      `bson:"postalCode,omitempty" json:"postalCode,omitempty"`
-	Country    *string      `bson:"country,omitempty" json:"country,omitempty"`
-	Period     *Period      `bson:"period,omitempty" json:"period,omitempty"`
+	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	Use               *AddressUse  `bson:"use,omitempty" json:"use,omitempty"`
+	UseElement        *Element     `bson:"_use,omitempty" json:"_use,omitempty"`
+	Type              *AddressType `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element     `bson:"_type,omitempty" json:"_type,omitempty"`
+	Text              *string      `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element     `bson:"_text,omitempty" json:"_text,omitempty"`
+	Line              []string     `bson:"line,omitempty" json:"line,omitempty"`
+	LineElement       []*Element   `bson:"_line,omitempty" json:"_line,omitempty"`
+	City              *string      `bson:"city,omitempty" json:"city,omitempty"`
+	CityElement       *Element     `bson:"_city,omitempty" json:"_city,omitempty"`
+	District          *string      `bson:"district,omitempty" json:"district,omitempty"`
+	DistrictElement   *Element     `bson:"_district,omitempty" json:"_district,omitempty"`
+	State             *string      `bson:"state,omitempty" json:"state,omitempty"`
+	StateElement      *Element     `bson:"_state,omitempty" json:"_state,omitempty"`
+	PostalCode        *string      `bson:"postalCode,omitempty" json:"postalCode,omitempty"`
+	PostalCodeElement *Element     `bson:"_postalCode,omitempty" json:"_postalCode,omitempty"`
+	Country           *string      `bson:"country,omitempty" json:"country,omitempty"`
+	CountryElement    *Element     `bson:"_country,omitempty" json:"_country,omitempty"`
+	Period            *Period      `bson:"period,omitempty" json:"period,omitempty"`
+}
+
+// MarshalJSON marshals the given Address as JSON into a byte slice
+func (r Address) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Address as JSON object with the members in the order of the fields
+func (r Address) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Use != nil {
+		e.key("use")
+		e.string(r.Use.Code())
+	}
+	if r.UseElement != nil {
+		e.key("_use")
+		r.UseElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(r.Type.Code())
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	if len(r.Line) > 0 {
+		e.key("line")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Line {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LineElement) > 0 {
+		e.key("_line")
+		e.elements(r.LineElement, len(r.Line))
+	}
+	if r.City != nil {
+		e.key("city")
+		e.string(*r.City)
+	}
+	if r.CityElement != nil {
+		e.key("_city")
+		r.CityElement.appendJSON(e)
+	}
+	if r.District != nil {
+		e.key("district")
+		e.string(*r.District)
+	}
+	if r.DistrictElement != nil {
+		e.key("_district")
+		r.DistrictElement.appendJSON(e)
+	}
+	if r.State != nil {
+		e.key("state")
+		e.string(*r.State)
+	}
+	if r.StateElement != nil {
+		e.key("_state")
+		r.StateElement.appendJSON(e)
+	}
+	if r.PostalCode != nil {
+		e.key("postalCode")
+		e.string(*r.PostalCode)
+	}
+	if r.PostalCodeElement != nil {
+		e.key("_postalCode")
+		r.PostalCodeElement.appendJSON(e)
+	}
+	if r.Country != nil {
+		e.key("country")
+		e.string(*r.Country)
+	}
+	if r.CountryElement != nil {
+		e.key("_country")
+		r.CountryElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Address from JSON. Unknown members are ignored.
+func (r *Address) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Address
+func (r *Address) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "use":
+			if d.null() {
+				r.Use = nil
+			} else {
+				var v AddressUse
+				d.unmarshal(&v)
+				r.Use = &v
+			}
+		case "_use":
+			r.UseElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v AddressType
+				d.unmarshal(&v)
+				r.Type = &v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "line":
+			a := d.array()
+			r.Line = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Line = append(r.Line, v)
+			}
+			if r.Line == nil && !a.isNull {
+				r.Line = []string{}
+			}
+		case "_line":
+			a := d.array()
+			r.LineElement = nil
+			for a.next() {
+				r.LineElement = append(r.LineElement, d.element())
+			}
+		case "city":
+			if v, ok := d.string(); ok {
+				r.City = &v
+			} else {
+				r.City = nil
+			}
+		case "_city":
+			r.CityElement = d.element()
+		case "district":
+			if v, ok := d.string(); ok {
+				r.District = &v
+			} else {
+				r.District = nil
+			}
+		case "_district":
+			r.DistrictElement = d.element()
+		case "state":
+			if v, ok := d.string(); ok {
+				r.State = &v
+			} else {
+				r.State = nil
+			}
+		case "_state":
+			r.StateElement = d.element()
+		case "postalCode":
+			if v, ok := d.string(); ok {
+				r.PostalCode = &v
+			} else {
+				r.PostalCode = nil
+			}
+		case "_postalCode":
+			r.PostalCodeElement = d.element()
+		case "country":
+			if v, ok := d.string(); ok {
+				r.Country = &v
+			} else {
+				r.Country = nil
+			}
+		case "_country":
+			r.CountryElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Address which shares no memory with the original
+func (r Address) DeepCopy() Address {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Use != nil {
+		v := *r.Use
+		out.Use = &v
+	}
+	if r.UseElement != nil {
+		v := r.UseElement.DeepCopy()
+		out.UseElement = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.Line != nil {
+		out.Line = make([]string, len(r.Line))
+		copy(out.Line, r.Line)
+	}
+	if r.LineElement != nil {
+		out.LineElement = make([]*Element, len(r.LineElement))
+		for i, v := range r.LineElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LineElement[i] = &c
+			}
+		}
+	}
+	if r.City != nil {
+		v := *r.City
+		out.City = &v
+	}
+	if r.CityElement != nil {
+		v := r.CityElement.DeepCopy()
+		out.CityElement = &v
+	}
+	if r.District != nil {
+		v := *r.District
+		out.District = &v
+	}
+	if r.DistrictElement != nil {
+		v := r.DistrictElement.DeepCopy()
+		out.DistrictElement = &v
+	}
+	if r.State != nil {
+		v := *r.State
+		out.State = &v
+	}
+	if r.StateElement != nil {
+		v := r.StateElement.DeepCopy()
+		out.StateElement = &v
+	}
+	if r.PostalCode != nil {
+		v := *r.PostalCode
+		out.PostalCode = &v
+	}
+	if r.PostalCodeElement != nil {
+		v := r.PostalCodeElement.DeepCopy()
+		out.PostalCodeElement = &v
+	}
+	if r.Country != nil {
+		v := *r.Country
+		out.Country = &v
+	}
+	if r.CountryElement != nil {
+		v := r.CountryElement.DeepCopy()
+		out.CountryElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Address) Equal(other Address) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && *r.Use != *other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.Equal(*other.UseElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if len(r.Line) != len(other.Line) {
+		return false
+	}
+	for i := range r.Line {
+		if r.Line[i] != other.Line[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LineElement, other.LineElement, false) {
+		return false
+	}
+	if (r.City == nil) != (other.City == nil) || r.City != nil && *r.City != *other.City {
+		return false
+	}
+	if (r.CityElement == nil) != (other.CityElement == nil) || r.CityElement != nil && !r.CityElement.Equal(*other.CityElement) {
+		return false
+	}
+	if (r.District == nil) != (other.District == nil) || r.District != nil && *r.District != *other.District {
+		return false
+	}
+	if (r.DistrictElement == nil) != (other.DistrictElement == nil) || r.DistrictElement != nil && !r.DistrictElement.Equal(*other.DistrictElement) {
+		return false
+	}
+	if (r.State == nil) != (other.State == nil) || r.State != nil && *r.State != *other.State {
+		return false
+	}
+	if (r.StateElement == nil) != (other.StateElement == nil) || r.StateElement != nil && !r.StateElement.Equal(*other.StateElement) {
+		return false
+	}
+	if (r.PostalCode == nil) != (other.PostalCode == nil) || r.PostalCode != nil && *r.PostalCode != *other.PostalCode {
+		return false
+	}
+	if (r.PostalCodeElement == nil) != (other.PostalCodeElement == nil) || r.PostalCodeElement != nil && !r.PostalCodeElement.Equal(*other.PostalCodeElement) {
+		return false
+	}
+	if (r.Country == nil) != (other.Country == nil) || r.Country != nil && *r.Country != *other.Country {
+		return false
+	}
+	if (r.CountryElement == nil) != (other.CountryElement == nil) || r.CountryElement != nil && !r.CountryElement.Equal(*other.CountryElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Address) EqualsDeep(other Address) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && *r.Use != *other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.EqualsDeep(*other.UseElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if !equivalentList(len(r.Line), len(other.Line), func(i, j int) bool {
+		return equivalentString(r.Line[i], other.Line[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LineElement, other.LineElement, true) {
+		return false
+	}
+	if (r.City == nil) != (other.City == nil) || r.City != nil && !equivalentString(*r.City, *other.City) {
+		return false
+	}
+	if (r.CityElement == nil) != (other.CityElement == nil) || r.CityElement != nil && !r.CityElement.EqualsDeep(*other.CityElement) {
+		return false
+	}
+	if (r.District == nil) != (other.District == nil) || r.District != nil && !equivalentString(*r.District, *other.District) {
+		return false
+	}
+	if (r.DistrictElement == nil) != (other.DistrictElement == nil) || r.DistrictElement != nil && !r.DistrictElement.EqualsDeep(*other.DistrictElement) {
+		return false
+	}
+	if (r.State == nil) != (other.State == nil) || r.State != nil && !equivalentString(*r.State, *other.State) {
+		return false
+	}
+	if (r.StateElement == nil) != (other.StateElement == nil) || r.StateElement != nil && !r.StateElement.EqualsDeep(*other.StateElement) {
+		return false
+	}
+	if (r.PostalCode == nil) != (other.PostalCode == nil) || r.PostalCode != nil && !equivalentString(*r.PostalCode, *other.PostalCode) {
+		return false
+	}
+	if (r.PostalCodeElement == nil) != (other.PostalCodeElement == nil) || r.PostalCodeElement != nil && !r.PostalCodeElement.EqualsDeep(*other.PostalCodeElement) {
+		return false
+	}
+	if (r.Country == nil) != (other.Country == nil) || r.Country != nil && !equivalentString(*r.Country, *other.Country) {
+		return false
+	}
+	if (r.CountryElement == nil) != (other.CountryElement == nil) || r.CountryElement != nil && !r.CountryElement.EqualsDeep(*other.CountryElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Address) EqualsShallow(other Address) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && *r.Use != *other.Use {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Line), len(other.Line), func(i, j int) bool {
+		return equivalentString(r.Line[i], other.Line[j])
+	}) {
+		return false
+	}
+	if (r.City == nil) != (other.City == nil) || r.City != nil && !equivalentString(*r.City, *other.City) {
+		return false
+	}
+	if (r.District == nil) != (other.District == nil) || r.District != nil && !equivalentString(*r.District, *other.District) {
+		return false
+	}
+	if (r.State == nil) != (other.State == nil) || r.State != nil && !equivalentString(*r.State, *other.State) {
+		return false
+	}
+	if (r.PostalCode == nil) != (other.PostalCode == nil) || r.PostalCode != nil && !equivalentString(*r.PostalCode, *other.PostalCode) {
+		return false
+	}
+	if (r.Country == nil) != (other.Country == nil) || r.Country != nil && !equivalentString(*r.Country, *other.Country) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Address as FHIR XML
+func (r Address) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "use", r.Use, r.UseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	for i, v := range r.Line {
+		if err := encodeXMLPrimitive(e, "line", v, elementAt(r.LineElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "city", r.City, r.CityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "district", r.District, r.DistrictElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "state", r.State, r.StateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "postalCode", r.PostalCode, r.PostalCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "country", r.Country, r.CountryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Address from FHIR XML
+func (r *Address) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "use":
+				var v AddressUse
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Use = &v
+				}
+				r.UseElement = element
+			case "type":
+				var v AddressType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			case "line":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LineElement = appendElement(r.LineElement, len(r.Line), element)
+					r.Line = append(r.Line, v)
+				}
+			case "city":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.City = &v
+				}
+				r.CityElement = element
+			case "district":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.District = &v
+				}
+				r.DistrictElement = element
+			case "state":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.State = &v
+				}
+				r.StateElement = element
+			case "postalCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PostalCode = &v
+				}
+				r.PostalCodeElement = element
+			case "country":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Country = &v
+				}
+				r.CountryElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Address as properties to the RDF node n
+func (r Address) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Address.use", -1, r.Use, r.UseElement, "code")
+	n.primitive("Address.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("Address.text", -1, r.Text, r.TextElement, "string")
+	for i, v := range r.Line {
+		n.primitive("Address.line", i, v, elementAt(r.LineElement, i), "string")
+	}
+	n.primitive("Address.city", -1, r.City, r.CityElement, "string")
+	n.primitive("Address.district", -1, r.District, r.DistrictElement, "string")
+	n.primitive("Address.state", -1, r.State, r.StateElement, "string")
+	n.primitive("Address.postalCode", -1, r.PostalCode, r.PostalCodeElement, "string")
+	n.primitive("Address.country", -1, r.Country, r.CountryElement, "string")
+	n.element("Address.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given Address as BSON document with the structure of its FHIR JSON
+func (r Address) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Address from a BSON document with the structure of its FHIR JSON
+func (r *Address) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Address as protobuf message Address of fhir.proto
+func (r Address) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Address to the encoded message
+func (r Address) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Use != nil {
+		e.varint(3, r.Use.protoNumber())
+	}
+	if r.Type != nil {
+		e.varint(4, r.Type.protoNumber())
+	}
+	if r.Text != nil {
+		e.string(5, *r.Text)
+	}
+	for _, v := range r.Line {
+		e.string(6, v)
+	}
+	if r.City != nil {
+		e.string(7, *r.City)
+	}
+	if r.District != nil {
+		e.string(8, *r.District)
+	}
+	if r.State != nil {
+		e.string(9, *r.State)
+	}
+	if r.PostalCode != nil {
+		e.string(10, *r.PostalCode)
+	}
+	if r.Country != nil {
+		e.string(11, *r.Country)
+	}
+	if r.Period != nil {
+		e.message(12, *r.Period)
+	}
+	if r.UseElement != nil {
+		e.message(13, *r.UseElement)
+	}
+	if r.TypeElement != nil {
+		e.message(14, *r.TypeElement)
+	}
+	if r.TextElement != nil {
+		e.message(15, *r.TextElement)
+	}
+	for _, v := range r.LineElement {
+		e.element(16, v)
+	}
+	if r.CityElement != nil {
+		e.message(17, *r.CityElement)
+	}
+	if r.DistrictElement != nil {
+		e.message(18, *r.DistrictElement)
+	}
+	if r.StateElement != nil {
+		e.message(19, *r.StateElement)
+	}
+	if r.PostalCodeElement != nil {
+		e.message(20, *r.PostalCodeElement)
+	}
+	if r.CountryElement != nil {
+		e.message(21, *r.CountryElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Address from protobuf message Address of fhir.proto
+func (r *Address) UnmarshalProto(b []byte) error {
+	*r = Address{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v AddressUse
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Use = &v
+		case 4:
+			var v AddressType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = &v
+		case 5:
+			v := d.string()
+			r.Text = &v
+		case 6:
+			r.Line = append(r.Line, d.string())
+		case 7:
+			v := d.string()
+			r.City = &v
+		case 8:
+			v := d.string()
+			r.District = &v
+		case 9:
+			v := d.string()
+			r.State = &v
+		case 10:
+			v := d.string()
+			r.PostalCode = &v
+		case 11:
+			v := d.string()
+			r.Country = &v
+		case 12:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.UseElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		case 16:
+			r.LineElement = append(r.LineElement, d.element())
+		case 17:
+			var v Element
+			d.message(&v)
+			r.CityElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.DistrictElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.StateElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.PostalCodeElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.CountryElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code AddressType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AddressType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AddressType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "postal":
 		*code = AddressTypePostal
@@ -49,6 +53,12 @@ func (code *AddressType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AddressType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AddressType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AddressType) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code AddressType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AddressType) protoNumber() uint64 {
+	switch code {
+	case AddressTypePostal:
+		return 1
+	case AddressTypePhysical:
+		return 2
+	case AddressTypeBoth:
+		return 3
+	}
+	return 0
+}
+func (code *AddressType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AddressTypePostal
+	case 2:
+		*code = AddressTypePhysical
+	case 3:
+		*code = AddressTypeBoth
+	default:
+		return false
+	}
+	return true
+}
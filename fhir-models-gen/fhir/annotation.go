@@ -14,15 +14,509 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Annotation is documented here http://hl7.org/fhir/StructureDefinition/Annotation
 type Annotation struct {
-	Id              *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension       []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	AuthorReference *Reference  `bson:"authorReference,omitempty" json:"authorReference,omitempty"`
-	AuthorString    *string     `bson:"authorString,omitempty" json:"authorString,omitempty"`
-	Time            *string     `bson:"time,omitempty" json:"time,omitempty"`
-	Text            string      `bson:"text" json:"text"`
+	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	AuthorReference     *Reference  `bson:"authorReference,omitempty" json:"authorReference,omitempty"`
+	AuthorString        *string     `bson:"authorString,omitempty" json:"authorString,omitempty"`
+	AuthorStringElement *Element    `bson:"_authorString,omitempty" json:"_authorString,omitempty"`
+	Time                *string     `bson:"time,omitempty" json:"time,omitempty"`
+	TimeElement         *Element    `bson:"_time,omitempty" json:"_time,omitempty"`
+	Text                string      `bson:"text" json:"text"`
+	TextElement         *Element    `bson:"_text,omitempty" json:"_text,omitempty"`
+}
+
+// AnnotationAuthor is implemented by the types allowed for Annotation.author[x]
+type AnnotationAuthor interface {
+	isAnnotationAuthor()
+}
+
+func (Reference) isAnnotationAuthor() {}
+func (String) isAnnotationAuthor()    {}
+
+// Author returns the value of Annotation.author[x] together with its FHIR type code or nil if no value is set
+func (r Annotation) Author() (AnnotationAuthor, string) {
+	if r.AuthorReference != nil {
+		return *r.AuthorReference, "Reference"
+	}
+	if r.AuthorString != nil {
+		return String(*r.AuthorString), "string"
+	}
+	return nil, ""
+}
+
+// SetAuthor sets the value of Annotation.author[x], given as value or pointer, and clears all other types
+func (r *Annotation) SetAuthor(value AnnotationAuthor) {
+	r.AuthorReference = nil
+	r.AuthorString = nil
+	switch v := value.(type) {
+	case Reference:
+		r.AuthorReference = &v
+	case *Reference:
+		r.AuthorReference = v
+	case String:
+		primitive := string(v)
+		r.AuthorString = &primitive
+	case *String:
+		r.AuthorString = (*string)(v)
+	}
+}
+
+// MarshalJSON marshals the given Annotation as JSON into a byte slice
+func (r Annotation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Annotation as JSON object with the members in the order of the fields
+func (r Annotation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AuthorReference != nil {
+		e.key("authorReference")
+		r.AuthorReference.appendJSON(e)
+	}
+	if r.AuthorString != nil {
+		e.key("authorString")
+		e.string(*r.AuthorString)
+	}
+	if r.AuthorStringElement != nil {
+		e.key("_authorString")
+		r.AuthorStringElement.appendJSON(e)
+	}
+	if r.Time != nil {
+		e.key("time")
+		e.string(*r.Time)
+	}
+	if r.TimeElement != nil {
+		e.key("_time")
+		r.TimeElement.appendJSON(e)
+	}
+	e.key("text")
+	e.string(r.Text)
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Annotation from JSON. Unknown members are ignored.
+func (r *Annotation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Annotation
+func (r *Annotation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "authorReference":
+			if d.null() {
+				r.AuthorReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.AuthorReference = &v
+			}
+		case "authorString":
+			if v, ok := d.string(); ok {
+				r.AuthorString = &v
+			} else {
+				r.AuthorString = nil
+			}
+		case "_authorString":
+			r.AuthorStringElement = d.element()
+		case "time":
+			if v, ok := d.string(); ok {
+				r.Time = &v
+			} else {
+				r.Time = nil
+			}
+		case "_time":
+			r.TimeElement = d.element()
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = v
+			}
+		case "_text":
+			r.TextElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Annotation which shares no memory with the original
+func (r Annotation) DeepCopy() Annotation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.AuthorReference != nil {
+		v := r.AuthorReference.DeepCopy()
+		out.AuthorReference = &v
+	}
+	if r.AuthorString != nil {
+		v := *r.AuthorString
+		out.AuthorString = &v
+	}
+	if r.AuthorStringElement != nil {
+		v := r.AuthorStringElement.DeepCopy()
+		out.AuthorStringElement = &v
+	}
+	if r.Time != nil {
+		v := *r.Time
+		out.Time = &v
+	}
+	if r.TimeElement != nil {
+		v := r.TimeElement.DeepCopy()
+		out.TimeElement = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Annotation) Equal(other Annotation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.AuthorReference == nil) != (other.AuthorReference == nil) || r.AuthorReference != nil && !r.AuthorReference.Equal(*other.AuthorReference) {
+		return false
+	}
+	if (r.AuthorString == nil) != (other.AuthorString == nil) || r.AuthorString != nil && *r.AuthorString != *other.AuthorString {
+		return false
+	}
+	if (r.AuthorStringElement == nil) != (other.AuthorStringElement == nil) || r.AuthorStringElement != nil && !r.AuthorStringElement.Equal(*other.AuthorStringElement) {
+		return false
+	}
+	if (r.Time == nil) != (other.Time == nil) || r.Time != nil && *r.Time != *other.Time {
+		return false
+	}
+	if (r.TimeElement == nil) != (other.TimeElement == nil) || r.TimeElement != nil && !r.TimeElement.Equal(*other.TimeElement) {
+		return false
+	}
+	if r.Text != other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Annotation) EqualsDeep(other Annotation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.AuthorReference == nil) != (other.AuthorReference == nil) || r.AuthorReference != nil && !r.AuthorReference.EqualsDeep(*other.AuthorReference) {
+		return false
+	}
+	if (r.AuthorString == nil) != (other.AuthorString == nil) || r.AuthorString != nil && !equivalentString(*r.AuthorString, *other.AuthorString) {
+		return false
+	}
+	if (r.AuthorStringElement == nil) != (other.AuthorStringElement == nil) || r.AuthorStringElement != nil && !r.AuthorStringElement.EqualsDeep(*other.AuthorStringElement) {
+		return false
+	}
+	if (r.Time == nil) != (other.Time == nil) || r.Time != nil && !equivalentString(*r.Time, *other.Time) {
+		return false
+	}
+	if (r.TimeElement == nil) != (other.TimeElement == nil) || r.TimeElement != nil && !r.TimeElement.EqualsDeep(*other.TimeElement) {
+		return false
+	}
+	if !equivalentString(r.Text, other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Annotation) EqualsShallow(other Annotation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.AuthorString == nil) != (other.AuthorString == nil) || r.AuthorString != nil && !equivalentString(*r.AuthorString, *other.AuthorString) {
+		return false
+	}
+	if (r.Time == nil) != (other.Time == nil) || r.Time != nil && !equivalentString(*r.Time, *other.Time) {
+		return false
+	}
+	if !equivalentString(r.Text, other.Text) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Annotation as FHIR XML
+func (r Annotation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "authorReference", r.AuthorReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "authorString", r.AuthorString, r.AuthorStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "time", r.Time, r.TimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Annotation from FHIR XML
+func (r *Annotation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "authorReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AuthorReference = &v
+			case "authorString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthorString = &v
+				}
+				r.AuthorStringElement = element
+			case "time":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Time = &v
+				}
+				r.TimeElement = element
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = v
+				}
+				r.TextElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Annotation as properties to the RDF node n
+func (r Annotation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.element("Annotation.authorReference", -1, r.AuthorReference)
+	n.primitive("Annotation.authorString", -1, r.AuthorString, r.AuthorStringElement, "string")
+	n.primitive("Annotation.time", -1, r.Time, r.TimeElement, "dateTime")
+	n.primitive("Annotation.text", -1, r.Text, r.TextElement, "string")
+}
+
+// MarshalBSON marshals the given Annotation as BSON document with the structure of its FHIR JSON
+func (r Annotation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Annotation from a BSON document with the structure of its FHIR JSON
+func (r *Annotation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Annotation as protobuf message Annotation of fhir.proto
+func (r Annotation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Annotation to the encoded message
+func (r Annotation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.AuthorReference != nil {
+		e.message(3, *r.AuthorReference)
+	}
+	if r.AuthorString != nil {
+		e.string(4, *r.AuthorString)
+	}
+	if r.Time != nil {
+		e.string(5, *r.Time)
+	}
+	if r.Text != "" {
+		e.string(6, r.Text)
+	}
+	if r.AuthorStringElement != nil {
+		e.message(7, *r.AuthorStringElement)
+	}
+	if r.TimeElement != nil {
+		e.message(8, *r.TimeElement)
+	}
+	if r.TextElement != nil {
+		e.message(9, *r.TextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Annotation from protobuf message Annotation of fhir.proto
+func (r *Annotation) UnmarshalProto(b []byte) error {
+	*r = Annotation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Reference
+			d.message(&v)
+			r.AuthorReference = &v
+		case 4:
+			v := d.string()
+			r.AuthorString = &v
+		case 5:
+			v := d.string()
+			r.Time = &v
+		case 6:
+			r.Text = d.string()
+		case 7:
+			var v Element
+			d.message(&v)
+			r.AuthorStringElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.TimeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
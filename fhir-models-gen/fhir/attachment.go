@@ -14,19 +14,782 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Attachment is documented here http://hl7.org/fhir/StructureDefinition/Attachment
 type Attachment struct {
-	Id          *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ContentType *string     `bson:"contentType,omitempty" json:"contentType,omitempty"`
-	Language    *string     `bson:"language,omitempty" json:"language,omitempty"`
-	Data        *string     `bson:"data,omitempty" json:"data,omitempty"`
-	Url         *string     `bson:"url,omitempty" json:"url,omitempty"`
-	Size        *int        `bson:"size,omitempty" json:"size,omitempty"`
-	Hash        *string     `bson:"hash,omitempty" json:"hash,omitempty"`
-	Title       *string     `bson:"title,omitempty" json:"title,omitempty"`
-	Creation    *string     `bson:"creation,omitempty" json:"creation,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ContentType        *string     `bson:"contentType,omitempty" json:"contentType,omitempty"`
+	ContentTypeElement *Element    `bson:"_contentType,omitempty" json:"_contentType,omitempty"`
+	Language           *string     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement    *Element    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Data               *string     `bson:"data,omitempty" json:"data,omitempty"`
+	DataElement        *Element    `bson:"_data,omitempty" json:"_data,omitempty"`
+	Url                *string     `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement         *Element    `bson:"_url,omitempty" json:"_url,omitempty"`
+	Size               *int        `bson:"size,omitempty" json:"size,omitempty"`
+	SizeElement        *Element    `bson:"_size,omitempty" json:"_size,omitempty"`
+	Hash               *string     `bson:"hash,omitempty" json:"hash,omitempty"`
+	HashElement        *Element    `bson:"_hash,omitempty" json:"_hash,omitempty"`
+	Title              *string     `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement       *Element    `bson:"_title,omitempty" json:"_title,omitempty"`
+	Creation           *string     `bson:"creation,omitempty" json:"creation,omitempty"`
+	CreationElement    *Element    `bson:"_creation,omitempty" json:"_creation,omitempty"`
+}
+
+// MarshalJSON marshals the given Attachment as JSON into a byte slice
+func (r Attachment) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Attachment as JSON object with the members in the order of the fields
+func (r Attachment) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ContentType != nil {
+		e.key("contentType")
+		e.string(*r.ContentType)
+	}
+	if r.ContentTypeElement != nil {
+		e.key("_contentType")
+		r.ContentTypeElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Data != nil {
+		e.key("data")
+		e.string(*r.Data)
+	}
+	if r.DataElement != nil {
+		e.key("_data")
+		r.DataElement.appendJSON(e)
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Size != nil {
+		e.key("size")
+		e.int(*r.Size)
+	}
+	if r.SizeElement != nil {
+		e.key("_size")
+		r.SizeElement.appendJSON(e)
+	}
+	if r.Hash != nil {
+		e.key("hash")
+		e.string(*r.Hash)
+	}
+	if r.HashElement != nil {
+		e.key("_hash")
+		r.HashElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Creation != nil {
+		e.key("creation")
+		e.string(*r.Creation)
+	}
+	if r.CreationElement != nil {
+		e.key("_creation")
+		r.CreationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Attachment from JSON. Unknown members are ignored.
+func (r *Attachment) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Attachment
+func (r *Attachment) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "contentType":
+			if v, ok := d.string(); ok {
+				r.ContentType = &v
+			} else {
+				r.ContentType = nil
+			}
+		case "_contentType":
+			r.ContentTypeElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "data":
+			if v, ok := d.string(); ok {
+				r.Data = &v
+			} else {
+				r.Data = nil
+			}
+		case "_data":
+			r.DataElement = d.element()
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "size":
+			if v, ok := d.int(); ok {
+				r.Size = &v
+			} else {
+				r.Size = nil
+			}
+		case "_size":
+			r.SizeElement = d.element()
+		case "hash":
+			if v, ok := d.string(); ok {
+				r.Hash = &v
+			} else {
+				r.Hash = nil
+			}
+		case "_hash":
+			r.HashElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "creation":
+			if v, ok := d.string(); ok {
+				r.Creation = &v
+			} else {
+				r.Creation = nil
+			}
+		case "_creation":
+			r.CreationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Attachment which shares no memory with the original
+func (r Attachment) DeepCopy() Attachment {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ContentType != nil {
+		v := *r.ContentType
+		out.ContentType = &v
+	}
+	if r.ContentTypeElement != nil {
+		v := r.ContentTypeElement.DeepCopy()
+		out.ContentTypeElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Data != nil {
+		v := *r.Data
+		out.Data = &v
+	}
+	if r.DataElement != nil {
+		v := r.DataElement.DeepCopy()
+		out.DataElement = &v
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Size != nil {
+		v := *r.Size
+		out.Size = &v
+	}
+	if r.SizeElement != nil {
+		v := r.SizeElement.DeepCopy()
+		out.SizeElement = &v
+	}
+	if r.Hash != nil {
+		v := *r.Hash
+		out.Hash = &v
+	}
+	if r.HashElement != nil {
+		v := r.HashElement.DeepCopy()
+		out.HashElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Creation != nil {
+		v := *r.Creation
+		out.Creation = &v
+	}
+	if r.CreationElement != nil {
+		v := r.CreationElement.DeepCopy()
+		out.CreationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Attachment) Equal(other Attachment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.ContentType == nil) != (other.ContentType == nil) || r.ContentType != nil && *r.ContentType != *other.ContentType {
+		return false
+	}
+	if (r.ContentTypeElement == nil) != (other.ContentTypeElement == nil) || r.ContentTypeElement != nil && !r.ContentTypeElement.Equal(*other.ContentTypeElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && *r.Data != *other.Data {
+		return false
+	}
+	if (r.DataElement == nil) != (other.DataElement == nil) || r.DataElement != nil && !r.DataElement.Equal(*other.DataElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Size == nil) != (other.Size == nil) || r.Size != nil && *r.Size != *other.Size {
+		return false
+	}
+	if (r.SizeElement == nil) != (other.SizeElement == nil) || r.SizeElement != nil && !r.SizeElement.Equal(*other.SizeElement) {
+		return false
+	}
+	if (r.Hash == nil) != (other.Hash == nil) || r.Hash != nil && *r.Hash != *other.Hash {
+		return false
+	}
+	if (r.HashElement == nil) != (other.HashElement == nil) || r.HashElement != nil && !r.HashElement.Equal(*other.HashElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Creation == nil) != (other.Creation == nil) || r.Creation != nil && *r.Creation != *other.Creation {
+		return false
+	}
+	if (r.CreationElement == nil) != (other.CreationElement == nil) || r.CreationElement != nil && !r.CreationElement.Equal(*other.CreationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Attachment) EqualsDeep(other Attachment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.ContentType == nil) != (other.ContentType == nil) || r.ContentType != nil && !equivalentString(*r.ContentType, *other.ContentType) {
+		return false
+	}
+	if (r.ContentTypeElement == nil) != (other.ContentTypeElement == nil) || r.ContentTypeElement != nil && !r.ContentTypeElement.EqualsDeep(*other.ContentTypeElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && !equivalentString(*r.Data, *other.Data) {
+		return false
+	}
+	if (r.DataElement == nil) != (other.DataElement == nil) || r.DataElement != nil && !r.DataElement.EqualsDeep(*other.DataElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Size == nil) != (other.Size == nil) || r.Size != nil && *r.Size != *other.Size {
+		return false
+	}
+	if (r.SizeElement == nil) != (other.SizeElement == nil) || r.SizeElement != nil && !r.SizeElement.EqualsDeep(*other.SizeElement) {
+		return false
+	}
+	if (r.Hash == nil) != (other.Hash == nil) || r.Hash != nil && !equivalentString(*r.Hash, *other.Hash) {
+		return false
+	}
+	if (r.HashElement == nil) != (other.HashElement == nil) || r.HashElement != nil && !r.HashElement.EqualsDeep(*other.HashElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Creation == nil) != (other.Creation == nil) || r.Creation != nil && !equivalentString(*r.Creation, *other.Creation) {
+		return false
+	}
+	if (r.CreationElement == nil) != (other.CreationElement == nil) || r.CreationElement != nil && !r.CreationElement.EqualsDeep(*other.CreationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Attachment) EqualsShallow(other Attachment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ContentType == nil) != (other.ContentType == nil) || r.ContentType != nil && !equivalentString(*r.ContentType, *other.ContentType) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && !equivalentString(*r.Data, *other.Data) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Size == nil) != (other.Size == nil) || r.Size != nil && *r.Size != *other.Size {
+		return false
+	}
+	if (r.Hash == nil) != (other.Hash == nil) || r.Hash != nil && !equivalentString(*r.Hash, *other.Hash) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.Creation == nil) != (other.Creation == nil) || r.Creation != nil && !equivalentString(*r.Creation, *other.Creation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Attachment as FHIR XML
+func (r Attachment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "contentType", r.ContentType, r.ContentTypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "data", r.Data, r.DataElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "size", r.Size, r.SizeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "hash", r.Hash, r.HashElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "creation", r.Creation, r.CreationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Attachment from FHIR XML
+func (r *Attachment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "contentType":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ContentType = &v
+				}
+				r.ContentTypeElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "data":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Data = &v
+				}
+				r.DataElement = element
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "size":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Size = &v
+				}
+				r.SizeElement = element
+			case "hash":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Hash = &v
+				}
+				r.HashElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "creation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Creation = &v
+				}
+				r.CreationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Attachment as properties to the RDF node n
+func (r Attachment) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Attachment.contentType", -1, r.ContentType, r.ContentTypeElement, "string")
+	n.primitive("Attachment.language", -1, r.Language, r.LanguageElement, "string")
+	n.primitive("Attachment.data", -1, r.Data, r.DataElement, "base64Binary")
+	n.primitive("Attachment.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("Attachment.size", -1, r.Size, r.SizeElement, "unsignedInt")
+	n.primitive("Attachment.hash", -1, r.Hash, r.HashElement, "base64Binary")
+	n.primitive("Attachment.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("Attachment.creation", -1, r.Creation, r.CreationElement, "dateTime")
+}
+
+// MarshalBSON marshals the given Attachment as BSON document with the structure of its FHIR JSON
+func (r Attachment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Attachment from a BSON document with the structure of its FHIR JSON
+func (r *Attachment) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Attachment as protobuf message Attachment of fhir.proto
+func (r Attachment) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Attachment to the encoded message
+func (r Attachment) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.ContentType != nil {
+		e.string(3, *r.ContentType)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Data != nil {
+		e.string(5, *r.Data)
+	}
+	if r.Url != nil {
+		e.string(6, *r.Url)
+	}
+	if r.Size != nil {
+		e.varint(7, uint64(int64(*r.Size)))
+	}
+	if r.Hash != nil {
+		e.string(8, *r.Hash)
+	}
+	if r.Title != nil {
+		e.string(9, *r.Title)
+	}
+	if r.Creation != nil {
+		e.string(10, *r.Creation)
+	}
+	if r.ContentTypeElement != nil {
+		e.message(11, *r.ContentTypeElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(12, *r.LanguageElement)
+	}
+	if r.DataElement != nil {
+		e.message(13, *r.DataElement)
+	}
+	if r.UrlElement != nil {
+		e.message(14, *r.UrlElement)
+	}
+	if r.SizeElement != nil {
+		e.message(15, *r.SizeElement)
+	}
+	if r.HashElement != nil {
+		e.message(16, *r.HashElement)
+	}
+	if r.TitleElement != nil {
+		e.message(17, *r.TitleElement)
+	}
+	if r.CreationElement != nil {
+		e.message(18, *r.CreationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Attachment from protobuf message Attachment of fhir.proto
+func (r *Attachment) UnmarshalProto(b []byte) error {
+	*r = Attachment{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.ContentType = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			v := d.string()
+			r.Data = &v
+		case 6:
+			v := d.string()
+			r.Url = &v
+		case 7:
+			v := d.int()
+			r.Size = &v
+		case 8:
+			v := d.string()
+			r.Hash = &v
+		case 9:
+			v := d.string()
+			r.Title = &v
+		case 10:
+			v := d.string()
+			r.Creation = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ContentTypeElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DataElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.SizeElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.HashElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.CreationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code BindingStrength) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *BindingStrength) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *BindingStrength) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "required":
 		*code = BindingStrengthRequired
@@ -52,6 +56,12 @@ func (code *BindingStrength) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code BindingStrength) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *BindingStrength) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code BindingStrength) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code BindingStrength) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code BindingStrength) protoNumber() uint64 {
+	switch code {
+	case BindingStrengthRequired:
+		return 1
+	case BindingStrengthExtensible:
+		return 2
+	case BindingStrengthPreferred:
+		return 3
+	case BindingStrengthExample:
+		return 4
+	}
+	return 0
+}
+func (code *BindingStrength) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = BindingStrengthRequired
+	case 2:
+		*code = BindingStrengthExtensible
+	case 3:
+		*code = BindingStrengthPreferred
+	case 4:
+		*code = BindingStrengthExample
+	default:
+		return false
+	}
+	return true
+}
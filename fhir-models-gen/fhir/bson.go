@@ -0,0 +1,359 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// The types of BSON elements, see https://bsonspec.org/spec.html
+const (
+	bsonDouble     byte = 0x01
+	bsonString     byte = 0x02
+	bsonDocument   byte = 0x03
+	bsonArray      byte = 0x04
+	bsonBinary     byte = 0x05
+	bsonObjectID   byte = 0x07
+	bsonBoolean    byte = 0x08
+	bsonDateTime   byte = 0x09
+	bsonNull       byte = 0x0A
+	bsonInt32      byte = 0x10
+	bsonTimestamp  byte = 0x11
+	bsonInt64      byte = 0x12
+	bsonDecimal128 byte = 0x13
+)
+
+// marshalBSON marshals the value as JSON and converts the JSON object into a BSON document with the same structure,
+// so that enums are stored as codes and resources as they look in FHIR JSON. Integers are stored as int32 or int64
+// and all other numbers as Decimal128, which keeps the precision of decimals.
+func marshalBSON(v interface{}) ([]byte, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(bs))
+	d.UseNumber()
+	if t, err := d.Token(); err != nil {
+		return nil, err
+	} else if t != json.Delim('{') {
+		return nil, fmt.Errorf("%T isn't marshalled as JSON object", v)
+	}
+	return appendBSONDocument(nil, d, false)
+}
+
+// unmarshalBSON converts the BSON document into JSON and unmarshals it into v.
+func unmarshalBSON(b []byte, v interface{}) error {
+	var out bytes.Buffer
+	if err := writeBSONDocument(&out, b, false); err != nil {
+		return err
+	}
+	return json.Unmarshal(out.Bytes(), v)
+}
+
+// marshalBSONValue marshals the value as JSON and converts the JSON value into a BSON value, whose type and bytes it
+// returns like the ValueMarshaler interface of the MongoDB driver.
+func marshalBSONValue(v interface{}) (byte, []byte, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return 0, nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(bs))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return 0, nil, err
+	}
+	// the element consists of the type, the empty key terminated by 0 and the value
+	element, err := appendBSONElement(nil, d, "", t)
+	if err != nil {
+		return 0, nil, err
+	}
+	return element[0], element[2:], nil
+}
+
+// unmarshalBSONValue converts the BSON value of type t into JSON and unmarshals it into v.
+func unmarshalBSONValue(t byte, b []byte, v interface{}) error {
+	var out bytes.Buffer
+	n, err := writeBSONValue(&out, t, b)
+	if err != nil {
+		return err
+	}
+	if n != len(b) {
+		return fmt.Errorf("invalid BSON value")
+	}
+	return json.Unmarshal(out.Bytes(), v)
+}
+
+// appendBSONDocument appends the JSON object or array, the opening delimiter of which was already read, as BSON
+// document.
+func appendBSONDocument(dst []byte, d *json.Decoder, array bool) ([]byte, error) {
+	start := len(dst)
+	dst = append(dst, 0, 0, 0, 0)
+	for i := 0; d.More(); i++ {
+		key := strconv.Itoa(i)
+		if !array {
+			t, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			key = t.(string)
+		}
+		t, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		if dst, err = appendBSONElement(dst, d, key, t); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := d.Token(); err != nil {
+		return nil, err
+	}
+	dst = append(dst, 0)
+	binary.LittleEndian.PutUint32(dst[start:], uint32(len(dst)-start))
+	return dst, nil
+}
+
+func appendBSONElement(dst []byte, d *json.Decoder, key string, token json.Token) ([]byte, error) {
+	if strings.IndexByte(key, 0) >= 0 {
+		return nil, fmt.Errorf("invalid BSON key %q", key)
+	}
+	header := func(t byte) []byte {
+		dst = append(dst, t)
+		dst = append(dst, key...)
+		return append(dst, 0)
+	}
+	switch t := token.(type) {
+	case json.Delim:
+		if t == '[' {
+			return appendBSONDocument(header(bsonArray), d, true)
+		}
+		return appendBSONDocument(header(bsonDocument), d, false)
+	case string:
+		dst = header(bsonString)
+		dst = binary.LittleEndian.AppendUint32(dst, uint32(len(t)+1))
+		dst = append(dst, t...)
+		return append(dst, 0), nil
+	case bool:
+		if t {
+			return append(header(bsonBoolean), 1), nil
+		}
+		return append(header(bsonBoolean), 0), nil
+	case nil:
+		return header(bsonNull), nil
+	case json.Number:
+		s := string(t)
+		// negative zero is only kept by Decimal128
+		if !strings.ContainsAny(s, ".eE") && s != "-0" {
+			if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+				if i >= math.MinInt32 && i <= math.MaxInt32 {
+					return binary.LittleEndian.AppendUint32(header(bsonInt32), uint32(int32(i))), nil
+				}
+				return binary.LittleEndian.AppendUint64(header(bsonInt64), uint64(i)), nil
+			}
+		}
+		low, high, err := parseDecimal128(s)
+		if err != nil {
+			return nil, err
+		}
+		dst = binary.LittleEndian.AppendUint64(header(bsonDecimal128), low)
+		return binary.LittleEndian.AppendUint64(dst, high), nil
+	}
+	return nil, fmt.Errorf("unexpected JSON token %v", token)
+}
+
+// parseDecimal128 converts a JSON number into the two halves of an IEEE 754-2008 128-bit decimal using the binary
+// integer encoding of its coefficient. The exponent is chosen so that the number of digits is kept.
+func parseDecimal128(s string) (uint64, uint64, error) {
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid decimal %s", s)
+		}
+		mantissa, exponent = s[:i], e
+	}
+	negative := strings.HasPrefix(mantissa, "-")
+	mantissa = strings.TrimPrefix(mantissa, "-")
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		exponent -= len(mantissa) - i - 1
+		mantissa = mantissa[:i] + mantissa[i+1:]
+	}
+	coefficient, ok := new(big.Int).SetString(mantissa, 10)
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid decimal %s", s)
+	}
+	if len(strings.TrimLeft(mantissa, "0")) > 34 || exponent < -6176 || exponent > 6111 {
+		return 0, 0, fmt.Errorf("decimal %s exceeds the range of Decimal128", s)
+	}
+	low := new(big.Int).And(coefficient, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+	high := new(big.Int).Rsh(coefficient, 64).Uint64() | uint64(exponent+6176)<<49
+	if negative {
+		high |= 1 << 63
+	}
+	return low, high, nil
+}
+
+// formatDecimal128 returns the decimal as JSON number.
+func formatDecimal128(low, high uint64) (string, error) {
+	if high>>61&3 == 3 {
+		if high>>58&0x1F >= 0x1E {
+			return "", fmt.Errorf("infinite or NaN Decimal128 can't be represented in JSON")
+		}
+		// coefficients of this form exceed the maximum and are treated as zero
+		low, high = 0, high&(1<<63)|(high>>47&0x3FFF)<<49
+	}
+	exponent := int(high>>49&0x3FFF) - 6176
+	coefficient := new(big.Int).Lsh(new(big.Int).SetUint64(high&(1<<49-1)), 64)
+	digits := coefficient.Or(coefficient, new(big.Int).SetUint64(low)).String()
+	sign := ""
+	if high>>63 == 1 {
+		sign = "-"
+	}
+	// like the to-scientific-string conversion of IEEE 754-2008, which keeps the number of digits
+	adjusted := exponent + len(digits) - 1
+	switch {
+	case exponent > 0 || adjusted < -6:
+		scientific := sign + digits[:1]
+		if len(digits) > 1 {
+			scientific += "." + digits[1:]
+		}
+		if adjusted >= 0 {
+			return scientific + "E+" + strconv.Itoa(adjusted), nil
+		}
+		return scientific + "E" + strconv.Itoa(adjusted), nil
+	case exponent == 0:
+		return sign + digits, nil
+	case len(digits) > -exponent:
+		return sign + digits[:len(digits)+exponent] + "." + digits[len(digits)+exponent:], nil
+	}
+	return sign + "0." + strings.Repeat("0", -exponent-len(digits)) + digits, nil
+}
+
+// writeBSONDocument writes the BSON document or array as JSON.
+func writeBSONDocument(out *bytes.Buffer, b []byte, array bool) error {
+	if len(b) < 5 || int(binary.LittleEndian.Uint32(b)) != len(b) || b[len(b)-1] != 0 {
+		return fmt.Errorf("invalid BSON document")
+	}
+	open, close := byte('{'), byte('}')
+	if array {
+		open, close = '[', ']'
+	}
+	out.WriteByte(open)
+	b = b[4 : len(b)-1]
+	for first := true; len(b) > 0; first = false {
+		if !first {
+			out.WriteByte(',')
+		}
+		t := b[0]
+		end := bytes.IndexByte(b[1:], 0)
+		if end < 0 {
+			return fmt.Errorf("invalid BSON key")
+		}
+		if !array {
+			key, _ := json.Marshal(string(b[1 : end+1]))
+			out.Write(key)
+			out.WriteByte(':')
+		}
+		n, err := writeBSONValue(out, t, b[end+2:])
+		if err != nil {
+			return fmt.Errorf("%s: %v", b[1:end+1], err)
+		}
+		b = b[end+2+n:]
+	}
+	out.WriteByte(close)
+	return nil
+}
+
+// writeBSONValue writes the value of type t at the start of b as JSON and returns its length.
+func writeBSONValue(out *bytes.Buffer, t byte, b []byte) (int, error) {
+	fixed := map[byte]int{bsonDouble: 8, bsonObjectID: 12, bsonBoolean: 1, bsonDateTime: 8, bsonNull: 0,
+		bsonInt32: 4, bsonTimestamp: 8, bsonInt64: 8, bsonDecimal128: 16}
+	n, ok := fixed[t]
+	if !ok {
+		if len(b) < 4 {
+			return 0, fmt.Errorf("truncated BSON value")
+		}
+		n = int(binary.LittleEndian.Uint32(b))
+		switch t {
+		case bsonString:
+			n += 4
+		case bsonBinary:
+			n += 5
+		}
+	}
+	if n < 0 || len(b) < n {
+		return 0, fmt.Errorf("truncated BSON value")
+	}
+	b = b[:n]
+
+	switch t {
+	case bsonDouble:
+		f := math.Float64frombits(binary.LittleEndian.Uint64(b))
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return 0, fmt.Errorf("infinite or NaN double can't be represented in JSON")
+		}
+		out.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+	case bsonString:
+		if n < 5 || b[n-1] != 0 {
+			return 0, fmt.Errorf("invalid BSON string")
+		}
+		s, _ := json.Marshal(string(b[4 : n-1]))
+		out.Write(s)
+	case bsonDocument, bsonArray:
+		if err := writeBSONDocument(out, b, t == bsonArray); err != nil {
+			return 0, err
+		}
+	case bsonBinary:
+		out.WriteString(`"` + base64.StdEncoding.EncodeToString(b[5:]) + `"`)
+	case bsonObjectID:
+		out.WriteString(`"` + hex.EncodeToString(b) + `"`)
+	case bsonBoolean:
+		out.WriteString(strconv.FormatBool(b[0] != 0))
+	case bsonDateTime:
+		ms := int64(binary.LittleEndian.Uint64(b))
+		out.WriteString(`"` + time.UnixMilli(ms).UTC().Format(time.RFC3339Nano) + `"`)
+	case bsonNull:
+		out.WriteString("null")
+	case bsonInt32:
+		out.WriteString(strconv.Itoa(int(int32(binary.LittleEndian.Uint32(b)))))
+	case bsonTimestamp:
+		out.WriteString(strconv.FormatUint(binary.LittleEndian.Uint64(b), 10))
+	case bsonInt64:
+		out.WriteString(strconv.FormatInt(int64(binary.LittleEndian.Uint64(b)), 10))
+	case bsonDecimal128:
+		s, err := formatDecimal128(binary.LittleEndian.Uint64(b), binary.LittleEndian.Uint64(b[8:]))
+		if err != nil {
+			return 0, err
+		}
+		out.WriteString(s)
+	default:
+		return 0, fmt.Errorf("unsupported BSON type 0x%02X", t)
+	}
+	return n, nil
+}
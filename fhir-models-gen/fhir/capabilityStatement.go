@@ -0,0 +1,9817 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"encoding/xml"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// CapabilityStatement is documented here http://hl7.org/fhir/StructureDefinition/CapabilityStatement
+type CapabilityStatement struct {
+	Id                         *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                  *Element                           `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                       *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules              *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement       *Element                           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                   *string                            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement            *Element                           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                       *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                  []json.RawMessage                  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                  []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                        *string                            `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement                 *Element                           `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version                    *string                            `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement             *Element                           `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                       *string                            `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                *Element                           `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                      *string                            `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement               *Element                           `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                     PublicationStatus                  `bson:"status" json:"status"`
+	StatusElement              *Element                           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental               *bool                              `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement        *Element                           `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                       string                             `bson:"date" json:"date"`
+	DateElement                *Element                           `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher                  *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement           *Element                           `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                    []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description                *string                            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement         *Element                           `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext                 []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction               []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                    *string                            `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement             *Element                           `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright                  *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement           *Element                           `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Kind                       CapabilityStatementKind            `bson:"kind" json:"kind"`
+	KindElement                *Element                           `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Instantiates               []string                           `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
+	InstantiatesElement        []*Element                         `bson:"_instantiates,omitempty" json:"_instantiates,omitempty"`
+	Imports                    []string                           `bson:"imports,omitempty" json:"imports,omitempty"`
+	ImportsElement             []*Element                         `bson:"_imports,omitempty" json:"_imports,omitempty"`
+	Software                   *CapabilityStatementSoftware       `bson:"software,omitempty" json:"software,omitempty"`
+	Implementation             *CapabilityStatementImplementation `bson:"implementation,omitempty" json:"implementation,omitempty"`
+	FhirVersion                FHIRVersion                        `bson:"fhirVersion" json:"fhirVersion"`
+	FhirVersionElement         *Element                           `bson:"_fhirVersion,omitempty" json:"_fhirVersion,omitempty"`
+	Format                     []string                           `bson:"format" json:"format"`
+	FormatElement              []*Element                         `bson:"_format,omitempty" json:"_format,omitempty"`
+	PatchFormat                []string                           `bson:"patchFormat,omitempty" json:"patchFormat,omitempty"`
+	PatchFormatElement         []*Element                         `bson:"_patchFormat,omitempty" json:"_patchFormat,omitempty"`
+	ImplementationGuide        []string                           `bson:"implementationGuide,omitempty" json:"implementationGuide,omitempty"`
+	ImplementationGuideElement []*Element                         `bson:"_implementationGuide,omitempty" json:"_implementationGuide,omitempty"`
+	Rest                       []CapabilityStatementRest          `bson:"rest,omitempty" json:"rest,omitempty"`
+	Messaging                  []CapabilityStatementMessaging     `bson:"messaging,omitempty" json:"messaging,omitempty"`
+	Document                   []CapabilityStatementDocument      `bson:"document,omitempty" json:"document,omitempty"`
+}
+type CapabilityStatementSoftware struct {
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               string      `bson:"name" json:"name"`
+	NameElement        *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	Version            *string     `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement     *Element    `bson:"_version,omitempty" json:"_version,omitempty"`
+	ReleaseDate        *string     `bson:"releaseDate,omitempty" json:"releaseDate,omitempty"`
+	ReleaseDateElement *Element    `bson:"_releaseDate,omitempty" json:"_releaseDate,omitempty"`
+}
+type CapabilityStatementImplementation struct {
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        string      `bson:"description" json:"description"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Url                *string     `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement         *Element    `bson:"_url,omitempty" json:"_url,omitempty"`
+	Custodian          *Reference  `bson:"custodian,omitempty" json:"custodian,omitempty"`
+}
+type CapabilityStatementRest struct {
+	Id                   *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Mode                 RestfulCapabilityMode                        `bson:"mode" json:"mode"`
+	ModeElement          *Element                                     `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Documentation        *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                                     `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Security             *CapabilityStatementRestSecurity             `bson:"security,omitempty" json:"security,omitempty"`
+	Resource             []CapabilityStatementRestResource            `bson:"resource,omitempty" json:"resource,omitempty"`
+	Interaction          []CapabilityStatementRestInteraction         `bson:"interaction,omitempty" json:"interaction,omitempty"`
+	SearchParam          []CapabilityStatementRestResourceSearchParam `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
+	Operation            []CapabilityStatementRestResourceOperation   `bson:"operation,omitempty" json:"operation,omitempty"`
+	Compartment          []string                                     `bson:"compartment,omitempty" json:"compartment,omitempty"`
+	CompartmentElement   []*Element                                   `bson:"_compartment,omitempty" json:"_compartment,omitempty"`
+}
+type CapabilityStatementRestSecurity struct {
+	Id                 *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Cors               *bool             `bson:"cors,omitempty" json:"cors,omitempty"`
+	CorsElement        *Element          `bson:"_cors,omitempty" json:"_cors,omitempty"`
+	Service            []CodeableConcept `bson:"service,omitempty" json:"service,omitempty"`
+	Description        *string           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element          `bson:"_description,omitempty" json:"_description,omitempty"`
+}
+type CapabilityStatementRestResource struct {
+	Id                       *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     ResourceType                                 `bson:"type" json:"type"`
+	TypeElement              *Element                                     `bson:"_type,omitempty" json:"_type,omitempty"`
+	Profile                  *string                                      `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement           *Element                                     `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	SupportedProfile         []string                                     `bson:"supportedProfile,omitempty" json:"supportedProfile,omitempty"`
+	SupportedProfileElement  []*Element                                   `bson:"_supportedProfile,omitempty" json:"_supportedProfile,omitempty"`
+	Documentation            *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement     *Element                                     `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Interaction              []CapabilityStatementRestResourceInteraction `bson:"interaction,omitempty" json:"interaction,omitempty"`
+	Versioning               *ResourceVersionPolicy                       `bson:"versioning,omitempty" json:"versioning,omitempty"`
+	VersioningElement        *Element                                     `bson:"_versioning,omitempty" json:"_versioning,omitempty"`
+	ReadHistory              *bool                                        `bson:"readHistory,omitempty" json:"readHistory,omitempty"`
+	ReadHistoryElement       *Element                                     `bson:"_readHistory,omitempty" json:"_readHistory,omitempty"`
+	UpdateCreate             *bool                                        `bson:"updateCreate,omitempty" json:"updateCreate,omitempty"`
+	UpdateCreateElement      *Element                                     `bson:"_updateCreate,omitempty" json:"_updateCreate,omitempty"`
+	ConditionalCreate        *bool                                        `bson:"conditionalCreate,omitempty" json:"conditionalCreate,omitempty"`
+	ConditionalCreateElement *Element                                     `bson:"_conditionalCreate,omitempty" json:"_conditionalCreate,omitempty"`
+	ConditionalRead          *ConditionalReadStatus                       `bson:"conditionalRead,omitempty" json:"conditionalRead,omitempty"`
+	ConditionalReadElement   *Element                                     `bson:"_conditionalRead,omitempty" json:"_conditionalRead,omitempty"`
+	ConditionalUpdate        *bool                                        `bson:"conditionalUpdate,omitempty" json:"conditionalUpdate,omitempty"`
+	ConditionalUpdateElement *Element                                     `bson:"_conditionalUpdate,omitempty" json:"_conditionalUpdate,omitempty"`
+	ConditionalDelete        *ConditionalDeleteStatus                     `bson:"conditionalDelete,omitempty" json:"conditionalDelete,omitempty"`
+	ConditionalDeleteElement *Element                                     `bson:"_conditionalDelete,omitempty" json:"_conditionalDelete,omitempty"`
+	ReferencePolicy          []ReferenceHandlingPolicy                    `bson:"referencePolicy,omitempty" json:"referencePolicy,omitempty"`
+	ReferencePolicyElement   []*Element                                   `bson:"_referencePolicy,omitempty" json:"_referencePolicy,omitempty"`
+	SearchInclude            []string                                     `bson:"searchInclude,omitempty" json:"searchInclude,omitempty"`
+	SearchIncludeElement     []*Element                                   `bson:"_searchInclude,omitempty" json:"_searchInclude,omitempty"`
+	SearchRevInclude         []string                                     `bson:"searchRevInclude,omitempty" json:"searchRevInclude,omitempty"`
+	SearchRevIncludeElement  []*Element                                   `bson:"_searchRevInclude,omitempty" json:"_searchRevInclude,omitempty"`
+	SearchParam              []CapabilityStatementRestResourceSearchParam `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
+	Operation                []CapabilityStatementRestResourceOperation   `bson:"operation,omitempty" json:"operation,omitempty"`
+}
+type CapabilityStatementRestResourceInteraction struct {
+	Id                   *string                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 TypeRestfulInteraction `bson:"code" json:"code"`
+	CodeElement          *Element               `bson:"_code,omitempty" json:"_code,omitempty"`
+	Documentation        *string                `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element               `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+}
+type CapabilityStatementRestResourceSearchParam struct {
+	Id                   *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string          `bson:"name" json:"name"`
+	NameElement          *Element        `bson:"_name,omitempty" json:"_name,omitempty"`
+	Definition           *string         `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement    *Element        `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	Type                 SearchParamType `bson:"type" json:"type"`
+	TypeElement          *Element        `bson:"_type,omitempty" json:"_type,omitempty"`
+	Documentation        *string         `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element        `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+}
+type CapabilityStatementRestResourceOperation struct {
+	Id                   *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string      `bson:"name" json:"name"`
+	NameElement          *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	Definition           string      `bson:"definition" json:"definition"`
+	DefinitionElement    *Element    `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	Documentation        *string     `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element    `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+}
+type CapabilityStatementRestInteraction struct {
+	Id                   *string                  `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 SystemRestfulInteraction `bson:"code" json:"code"`
+	CodeElement          *Element                 `bson:"_code,omitempty" json:"_code,omitempty"`
+	Documentation        *string                  `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                 `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+}
+type CapabilityStatementMessaging struct {
+	Id                   *string                                        `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Endpoint             []CapabilityStatementMessagingEndpoint         `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	ReliableCache        *int                                           `bson:"reliableCache,omitempty" json:"reliableCache,omitempty"`
+	ReliableCacheElement *Element                                       `bson:"_reliableCache,omitempty" json:"_reliableCache,omitempty"`
+	Documentation        *string                                        `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                                       `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	SupportedMessage     []CapabilityStatementMessagingSupportedMessage `bson:"supportedMessage,omitempty" json:"supportedMessage,omitempty"`
+}
+type CapabilityStatementMessagingEndpoint struct {
+	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Protocol          Coding      `bson:"protocol" json:"protocol"`
+	Address           string      `bson:"address" json:"address"`
+	AddressElement    *Element    `bson:"_address,omitempty" json:"_address,omitempty"`
+}
+type CapabilityStatementMessagingSupportedMessage struct {
+	Id                *string             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Mode              EventCapabilityMode `bson:"mode" json:"mode"`
+	ModeElement       *Element            `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Definition        string              `bson:"definition" json:"definition"`
+	DefinitionElement *Element            `bson:"_definition,omitempty" json:"_definition,omitempty"`
+}
+type CapabilityStatementDocument struct {
+	Id                   *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Mode                 DocumentMode `bson:"mode" json:"mode"`
+	ModeElement          *Element     `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Documentation        *string      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element     `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Profile              string       `bson:"profile" json:"profile"`
+	ProfileElement       *Element     `bson:"_profile,omitempty" json:"_profile,omitempty"`
+}
+
+// MarshalJSON marshals the given CapabilityStatement as JSON into a byte slice
+func (r CapabilityStatement) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatement as JSON object with the members in the order of the fields
+func (r CapabilityStatement) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"CapabilityStatement\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	e.key("date")
+	e.string(r.Date)
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	e.key("kind")
+	e.string(r.Kind.Code())
+	if r.KindElement != nil {
+		e.key("_kind")
+		r.KindElement.appendJSON(e)
+	}
+	if len(r.Instantiates) > 0 {
+		e.key("instantiates")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Instantiates {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesElement) > 0 {
+		e.key("_instantiates")
+		e.elements(r.InstantiatesElement, len(r.Instantiates))
+	}
+	if len(r.Imports) > 0 {
+		e.key("imports")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Imports {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ImportsElement) > 0 {
+		e.key("_imports")
+		e.elements(r.ImportsElement, len(r.Imports))
+	}
+	if r.Software != nil {
+		e.key("software")
+		r.Software.appendJSON(e)
+	}
+	if r.Implementation != nil {
+		e.key("implementation")
+		r.Implementation.appendJSON(e)
+	}
+	e.key("fhirVersion")
+	e.string(r.FhirVersion.Code())
+	if r.FhirVersionElement != nil {
+		e.key("_fhirVersion")
+		r.FhirVersionElement.appendJSON(e)
+	}
+	e.key("format")
+	if r.Format == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Format {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.FormatElement) > 0 {
+		e.key("_format")
+		e.elements(r.FormatElement, len(r.Format))
+	}
+	if len(r.PatchFormat) > 0 {
+		e.key("patchFormat")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PatchFormat {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PatchFormatElement) > 0 {
+		e.key("_patchFormat")
+		e.elements(r.PatchFormatElement, len(r.PatchFormat))
+	}
+	if len(r.ImplementationGuide) > 0 {
+		e.key("implementationGuide")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ImplementationGuide {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ImplementationGuideElement) > 0 {
+		e.key("_implementationGuide")
+		e.elements(r.ImplementationGuideElement, len(r.ImplementationGuide))
+	}
+	if len(r.Rest) > 0 {
+		e.key("rest")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Rest {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Messaging) > 0 {
+		e.key("messaging")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Messaging {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Document) > 0 {
+		e.key("document")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Document {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatement from JSON. Unknown members are ignored.
+func (r *CapabilityStatement) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatement
+func (r *CapabilityStatement) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = v
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "kind":
+			d.unmarshal(&r.Kind)
+		case "_kind":
+			r.KindElement = d.element()
+		case "instantiates":
+			a := d.array()
+			r.Instantiates = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Instantiates = append(r.Instantiates, v)
+			}
+			if r.Instantiates == nil && !a.isNull {
+				r.Instantiates = []string{}
+			}
+		case "_instantiates":
+			a := d.array()
+			r.InstantiatesElement = nil
+			for a.next() {
+				r.InstantiatesElement = append(r.InstantiatesElement, d.element())
+			}
+		case "imports":
+			a := d.array()
+			r.Imports = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Imports = append(r.Imports, v)
+			}
+			if r.Imports == nil && !a.isNull {
+				r.Imports = []string{}
+			}
+		case "_imports":
+			a := d.array()
+			r.ImportsElement = nil
+			for a.next() {
+				r.ImportsElement = append(r.ImportsElement, d.element())
+			}
+		case "software":
+			if d.null() {
+				r.Software = nil
+			} else {
+				var v CapabilityStatementSoftware
+				v.decodeJSON(d)
+				r.Software = &v
+			}
+		case "implementation":
+			if d.null() {
+				r.Implementation = nil
+			} else {
+				var v CapabilityStatementImplementation
+				v.decodeJSON(d)
+				r.Implementation = &v
+			}
+		case "fhirVersion":
+			d.unmarshal(&r.FhirVersion)
+		case "_fhirVersion":
+			r.FhirVersionElement = d.element()
+		case "format":
+			a := d.array()
+			r.Format = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Format = append(r.Format, v)
+			}
+			if r.Format == nil && !a.isNull {
+				r.Format = []string{}
+			}
+		case "_format":
+			a := d.array()
+			r.FormatElement = nil
+			for a.next() {
+				r.FormatElement = append(r.FormatElement, d.element())
+			}
+		case "patchFormat":
+			a := d.array()
+			r.PatchFormat = nil
+			for a.next() {
+				v, _ := d.string()
+				r.PatchFormat = append(r.PatchFormat, v)
+			}
+			if r.PatchFormat == nil && !a.isNull {
+				r.PatchFormat = []string{}
+			}
+		case "_patchFormat":
+			a := d.array()
+			r.PatchFormatElement = nil
+			for a.next() {
+				r.PatchFormatElement = append(r.PatchFormatElement, d.element())
+			}
+		case "implementationGuide":
+			a := d.array()
+			r.ImplementationGuide = nil
+			for a.next() {
+				v, _ := d.string()
+				r.ImplementationGuide = append(r.ImplementationGuide, v)
+			}
+			if r.ImplementationGuide == nil && !a.isNull {
+				r.ImplementationGuide = []string{}
+			}
+		case "_implementationGuide":
+			a := d.array()
+			r.ImplementationGuideElement = nil
+			for a.next() {
+				r.ImplementationGuideElement = append(r.ImplementationGuideElement, d.element())
+			}
+		case "rest":
+			a := d.array()
+			r.Rest = nil
+			for a.next() {
+				var v CapabilityStatementRest
+				v.decodeJSON(d)
+				r.Rest = append(r.Rest, v)
+			}
+			if r.Rest == nil && !a.isNull {
+				r.Rest = []CapabilityStatementRest{}
+			}
+		case "messaging":
+			a := d.array()
+			r.Messaging = nil
+			for a.next() {
+				var v CapabilityStatementMessaging
+				v.decodeJSON(d)
+				r.Messaging = append(r.Messaging, v)
+			}
+			if r.Messaging == nil && !a.isNull {
+				r.Messaging = []CapabilityStatementMessaging{}
+			}
+		case "document":
+			a := d.array()
+			r.Document = nil
+			for a.next() {
+				var v CapabilityStatementDocument
+				v.decodeJSON(d)
+				r.Document = append(r.Document, v)
+			}
+			if r.Document == nil && !a.isNull {
+				r.Document = []CapabilityStatementDocument{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatement which shares no memory with the original
+func (r CapabilityStatement) DeepCopy() CapabilityStatement {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.KindElement != nil {
+		v := r.KindElement.DeepCopy()
+		out.KindElement = &v
+	}
+	if r.Instantiates != nil {
+		out.Instantiates = make([]string, len(r.Instantiates))
+		copy(out.Instantiates, r.Instantiates)
+	}
+	if r.InstantiatesElement != nil {
+		out.InstantiatesElement = make([]*Element, len(r.InstantiatesElement))
+		for i, v := range r.InstantiatesElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesElement[i] = &c
+			}
+		}
+	}
+	if r.Imports != nil {
+		out.Imports = make([]string, len(r.Imports))
+		copy(out.Imports, r.Imports)
+	}
+	if r.ImportsElement != nil {
+		out.ImportsElement = make([]*Element, len(r.ImportsElement))
+		for i, v := range r.ImportsElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ImportsElement[i] = &c
+			}
+		}
+	}
+	if r.Software != nil {
+		v := r.Software.DeepCopy()
+		out.Software = &v
+	}
+	if r.Implementation != nil {
+		v := r.Implementation.DeepCopy()
+		out.Implementation = &v
+	}
+	if r.FhirVersionElement != nil {
+		v := r.FhirVersionElement.DeepCopy()
+		out.FhirVersionElement = &v
+	}
+	if r.Format != nil {
+		out.Format = make([]string, len(r.Format))
+		copy(out.Format, r.Format)
+	}
+	if r.FormatElement != nil {
+		out.FormatElement = make([]*Element, len(r.FormatElement))
+		for i, v := range r.FormatElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.FormatElement[i] = &c
+			}
+		}
+	}
+	if r.PatchFormat != nil {
+		out.PatchFormat = make([]string, len(r.PatchFormat))
+		copy(out.PatchFormat, r.PatchFormat)
+	}
+	if r.PatchFormatElement != nil {
+		out.PatchFormatElement = make([]*Element, len(r.PatchFormatElement))
+		for i, v := range r.PatchFormatElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PatchFormatElement[i] = &c
+			}
+		}
+	}
+	if r.ImplementationGuide != nil {
+		out.ImplementationGuide = make([]string, len(r.ImplementationGuide))
+		copy(out.ImplementationGuide, r.ImplementationGuide)
+	}
+	if r.ImplementationGuideElement != nil {
+		out.ImplementationGuideElement = make([]*Element, len(r.ImplementationGuideElement))
+		for i, v := range r.ImplementationGuideElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ImplementationGuideElement[i] = &c
+			}
+		}
+	}
+	if r.Rest != nil {
+		out.Rest = make([]CapabilityStatementRest, len(r.Rest))
+		for i := range r.Rest {
+			out.Rest[i] = r.Rest[i].DeepCopy()
+		}
+	}
+	if r.Messaging != nil {
+		out.Messaging = make([]CapabilityStatementMessaging, len(r.Messaging))
+		for i := range r.Messaging {
+			out.Messaging[i] = r.Messaging[i].DeepCopy()
+		}
+	}
+	if r.Document != nil {
+		out.Document = make([]CapabilityStatementDocument, len(r.Document))
+		for i := range r.Document {
+			out.Document[i] = r.Document[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatement) Equal(other CapabilityStatement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if r.Date != other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.Equal(*other.KindElement) {
+		return false
+	}
+	if len(r.Instantiates) != len(other.Instantiates) {
+		return false
+	}
+	for i := range r.Instantiates {
+		if r.Instantiates[i] != other.Instantiates[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesElement, other.InstantiatesElement, false) {
+		return false
+	}
+	if len(r.Imports) != len(other.Imports) {
+		return false
+	}
+	for i := range r.Imports {
+		if r.Imports[i] != other.Imports[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ImportsElement, other.ImportsElement, false) {
+		return false
+	}
+	if (r.Software == nil) != (other.Software == nil) || r.Software != nil && !r.Software.Equal(*other.Software) {
+		return false
+	}
+	if (r.Implementation == nil) != (other.Implementation == nil) || r.Implementation != nil && !r.Implementation.Equal(*other.Implementation) {
+		return false
+	}
+	if r.FhirVersion != other.FhirVersion {
+		return false
+	}
+	if (r.FhirVersionElement == nil) != (other.FhirVersionElement == nil) || r.FhirVersionElement != nil && !r.FhirVersionElement.Equal(*other.FhirVersionElement) {
+		return false
+	}
+	if len(r.Format) != len(other.Format) {
+		return false
+	}
+	for i := range r.Format {
+		if r.Format[i] != other.Format[i] {
+			return false
+		}
+	}
+	if !equalElements(r.FormatElement, other.FormatElement, false) {
+		return false
+	}
+	if len(r.PatchFormat) != len(other.PatchFormat) {
+		return false
+	}
+	for i := range r.PatchFormat {
+		if r.PatchFormat[i] != other.PatchFormat[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PatchFormatElement, other.PatchFormatElement, false) {
+		return false
+	}
+	if len(r.ImplementationGuide) != len(other.ImplementationGuide) {
+		return false
+	}
+	for i := range r.ImplementationGuide {
+		if r.ImplementationGuide[i] != other.ImplementationGuide[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ImplementationGuideElement, other.ImplementationGuideElement, false) {
+		return false
+	}
+	if len(r.Rest) != len(other.Rest) {
+		return false
+	}
+	for i := range r.Rest {
+		if !r.Rest[i].Equal(other.Rest[i]) {
+			return false
+		}
+	}
+	if len(r.Messaging) != len(other.Messaging) {
+		return false
+	}
+	for i := range r.Messaging {
+		if !r.Messaging[i].Equal(other.Messaging[i]) {
+			return false
+		}
+	}
+	if len(r.Document) != len(other.Document) {
+		return false
+	}
+	for i := range r.Document {
+		if !r.Document[i].Equal(other.Document[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatement) EqualsDeep(other CapabilityStatement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.EqualsDeep(*other.KindElement) {
+		return false
+	}
+	if !equivalentList(len(r.Instantiates), len(other.Instantiates), func(i, j int) bool {
+		return equivalentString(r.Instantiates[i], other.Instantiates[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesElement, other.InstantiatesElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Imports), len(other.Imports), func(i, j int) bool {
+		return equivalentString(r.Imports[i], other.Imports[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ImportsElement, other.ImportsElement, true) {
+		return false
+	}
+	if (r.Software == nil) != (other.Software == nil) || r.Software != nil && !r.Software.EqualsDeep(*other.Software) {
+		return false
+	}
+	if (r.Implementation == nil) != (other.Implementation == nil) || r.Implementation != nil && !r.Implementation.EqualsDeep(*other.Implementation) {
+		return false
+	}
+	if r.FhirVersion != other.FhirVersion {
+		return false
+	}
+	if (r.FhirVersionElement == nil) != (other.FhirVersionElement == nil) || r.FhirVersionElement != nil && !r.FhirVersionElement.EqualsDeep(*other.FhirVersionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Format), len(other.Format), func(i, j int) bool {
+		return equivalentString(r.Format[i], other.Format[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.FormatElement, other.FormatElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.PatchFormat), len(other.PatchFormat), func(i, j int) bool {
+		return equivalentString(r.PatchFormat[i], other.PatchFormat[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PatchFormatElement, other.PatchFormatElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.ImplementationGuide), len(other.ImplementationGuide), func(i, j int) bool {
+		return equivalentString(r.ImplementationGuide[i], other.ImplementationGuide[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ImplementationGuideElement, other.ImplementationGuideElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Rest), len(other.Rest), func(i, j int) bool {
+		return r.Rest[i].EqualsDeep(other.Rest[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Messaging), len(other.Messaging), func(i, j int) bool {
+		return r.Messaging[i].EqualsDeep(other.Messaging[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Document), len(other.Document), func(i, j int) bool {
+		return r.Document[i].EqualsDeep(other.Document[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatement) EqualsShallow(other CapabilityStatement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if !equivalentList(len(r.Instantiates), len(other.Instantiates), func(i, j int) bool {
+		return equivalentString(r.Instantiates[i], other.Instantiates[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Imports), len(other.Imports), func(i, j int) bool {
+		return equivalentString(r.Imports[i], other.Imports[j])
+	}) {
+		return false
+	}
+	if r.FhirVersion != other.FhirVersion {
+		return false
+	}
+	if !equivalentList(len(r.Format), len(other.Format), func(i, j int) bool {
+		return equivalentString(r.Format[i], other.Format[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PatchFormat), len(other.PatchFormat), func(i, j int) bool {
+		return equivalentString(r.PatchFormat[i], other.PatchFormat[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ImplementationGuide), len(other.ImplementationGuide), func(i, j int) bool {
+		return equivalentString(r.ImplementationGuide[i], other.ImplementationGuide[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatement as FHIR XML
+func (r CapabilityStatement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "CapabilityStatement",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "kind", r.Kind, r.KindElement); err != nil {
+		return err
+	}
+	for i, v := range r.Instantiates {
+		if err := encodeXMLPrimitive(e, "instantiates", v, elementAt(r.InstantiatesElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Imports {
+		if err := encodeXMLPrimitive(e, "imports", v, elementAt(r.ImportsElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "software", r.Software); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "implementation", r.Implementation); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fhirVersion", r.FhirVersion, r.FhirVersionElement); err != nil {
+		return err
+	}
+	for i, v := range r.Format {
+		if err := encodeXMLPrimitive(e, "format", v, elementAt(r.FormatElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.PatchFormat {
+		if err := encodeXMLPrimitive(e, "patchFormat", v, elementAt(r.PatchFormatElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ImplementationGuide {
+		if err := encodeXMLPrimitive(e, "implementationGuide", v, elementAt(r.ImplementationGuideElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Rest {
+		if err := encodeXMLElement(e, "rest", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Messaging {
+		if err := encodeXMLElement(e, "messaging", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Document {
+		if err := encodeXMLElement(e, "document", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatement from FHIR XML
+func (r *CapabilityStatement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "kind":
+				var v CapabilityStatementKind
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Kind = v
+				}
+				r.KindElement = element
+			case "instantiates":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesElement = appendElement(r.InstantiatesElement, len(r.Instantiates), element)
+					r.Instantiates = append(r.Instantiates, v)
+				}
+			case "imports":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ImportsElement = appendElement(r.ImportsElement, len(r.Imports), element)
+					r.Imports = append(r.Imports, v)
+				}
+			case "software":
+				var v CapabilityStatementSoftware
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Software = &v
+			case "implementation":
+				var v CapabilityStatementImplementation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Implementation = &v
+			case "fhirVersion":
+				var v FHIRVersion
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FhirVersion = v
+				}
+				r.FhirVersionElement = element
+			case "format":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.FormatElement = appendElement(r.FormatElement, len(r.Format), element)
+					r.Format = append(r.Format, v)
+				}
+			case "patchFormat":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PatchFormatElement = appendElement(r.PatchFormatElement, len(r.PatchFormat), element)
+					r.PatchFormat = append(r.PatchFormat, v)
+				}
+			case "implementationGuide":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ImplementationGuideElement = appendElement(r.ImplementationGuideElement, len(r.ImplementationGuide), element)
+					r.ImplementationGuide = append(r.ImplementationGuide, v)
+				}
+			case "rest":
+				var v CapabilityStatementRest
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Rest = append(r.Rest, v)
+			case "messaging":
+				var v CapabilityStatementMessaging
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Messaging = append(r.Messaging, v)
+			case "document":
+				var v CapabilityStatementDocument
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Document = append(r.Document, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatement as properties to the RDF node n
+func (r CapabilityStatement) turtle(n *turtleNode) {
+	n.resource("CapabilityStatement", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("CapabilityStatement.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("CapabilityStatement.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("CapabilityStatement.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("CapabilityStatement.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("CapabilityStatement.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("CapabilityStatement.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("CapabilityStatement.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("CapabilityStatement.contact", i, v)
+	}
+	n.primitive("CapabilityStatement.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("CapabilityStatement.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("CapabilityStatement.jurisdiction", i, v)
+	}
+	n.primitive("CapabilityStatement.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("CapabilityStatement.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("CapabilityStatement.kind", -1, r.Kind, r.KindElement, "code")
+	for i, v := range r.Instantiates {
+		n.primitive("CapabilityStatement.instantiates", i, v, elementAt(r.InstantiatesElement, i), "string")
+	}
+	for i, v := range r.Imports {
+		n.primitive("CapabilityStatement.imports", i, v, elementAt(r.ImportsElement, i), "string")
+	}
+	n.element("CapabilityStatement.software", -1, r.Software)
+	n.element("CapabilityStatement.implementation", -1, r.Implementation)
+	n.primitive("CapabilityStatement.fhirVersion", -1, r.FhirVersion, r.FhirVersionElement, "code")
+	for i, v := range r.Format {
+		n.primitive("CapabilityStatement.format", i, v, elementAt(r.FormatElement, i), "string")
+	}
+	for i, v := range r.PatchFormat {
+		n.primitive("CapabilityStatement.patchFormat", i, v, elementAt(r.PatchFormatElement, i), "string")
+	}
+	for i, v := range r.ImplementationGuide {
+		n.primitive("CapabilityStatement.implementationGuide", i, v, elementAt(r.ImplementationGuideElement, i), "string")
+	}
+	for i, v := range r.Rest {
+		n.element("CapabilityStatement.rest", i, v)
+	}
+	for i, v := range r.Messaging {
+		n.element("CapabilityStatement.messaging", i, v)
+	}
+	for i, v := range r.Document {
+		n.element("CapabilityStatement.document", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CapabilityStatement as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatement from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatement) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatement as protobuf message CapabilityStatement of fhir.proto
+func (r CapabilityStatement) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatement to the encoded message
+func (r CapabilityStatement) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	if r.Version != nil {
+		e.string(10, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(11, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(12, *r.Title)
+	}
+	e.varint(13, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(14, protoBool(*r.Experimental))
+	}
+	if r.Date != "" {
+		e.string(15, r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(16, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(17, v)
+	}
+	if r.Description != nil {
+		e.string(18, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(19, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(20, v)
+	}
+	if r.Purpose != nil {
+		e.string(21, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(22, *r.Copyright)
+	}
+	e.varint(23, r.Kind.protoNumber())
+	for _, v := range r.Instantiates {
+		e.string(24, v)
+	}
+	for _, v := range r.Imports {
+		e.string(25, v)
+	}
+	if r.Software != nil {
+		e.message(26, *r.Software)
+	}
+	if r.Implementation != nil {
+		e.message(27, *r.Implementation)
+	}
+	e.varint(28, r.FhirVersion.protoNumber())
+	for _, v := range r.Format {
+		e.string(29, v)
+	}
+	for _, v := range r.PatchFormat {
+		e.string(30, v)
+	}
+	for _, v := range r.ImplementationGuide {
+		e.string(31, v)
+	}
+	for _, v := range r.Rest {
+		e.message(32, v)
+	}
+	for _, v := range r.Messaging {
+		e.message(33, v)
+	}
+	for _, v := range r.Document {
+		e.message(34, v)
+	}
+	if r.IdElement != nil {
+		e.message(35, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(36, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(37, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(38, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(39, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(40, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(41, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(42, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(43, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(44, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(45, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(46, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(47, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(48, *r.CopyrightElement)
+	}
+	if r.KindElement != nil {
+		e.message(49, *r.KindElement)
+	}
+	for _, v := range r.InstantiatesElement {
+		e.element(50, v)
+	}
+	for _, v := range r.ImportsElement {
+		e.element(51, v)
+	}
+	if r.FhirVersionElement != nil {
+		e.message(52, *r.FhirVersionElement)
+	}
+	for _, v := range r.FormatElement {
+		e.element(53, v)
+	}
+	for _, v := range r.PatchFormatElement {
+		e.element(54, v)
+	}
+	for _, v := range r.ImplementationGuideElement {
+		e.element(55, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatement from protobuf message CapabilityStatement of fhir.proto
+func (r *CapabilityStatement) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatement{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			v := d.string()
+			r.Version = &v
+		case 11:
+			v := d.string()
+			r.Name = &v
+		case 12:
+			v := d.string()
+			r.Title = &v
+		case 13:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 14:
+			v := d.bool()
+			r.Experimental = &v
+		case 15:
+			r.Date = d.string()
+		case 16:
+			v := d.string()
+			r.Publisher = &v
+		case 17:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 18:
+			v := d.string()
+			r.Description = &v
+		case 19:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 21:
+			v := d.string()
+			r.Purpose = &v
+		case 22:
+			v := d.string()
+			r.Copyright = &v
+		case 23:
+			var v CapabilityStatementKind
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Kind = v
+		case 24:
+			r.Instantiates = append(r.Instantiates, d.string())
+		case 25:
+			r.Imports = append(r.Imports, d.string())
+		case 26:
+			var v CapabilityStatementSoftware
+			d.message(&v)
+			r.Software = &v
+		case 27:
+			var v CapabilityStatementImplementation
+			d.message(&v)
+			r.Implementation = &v
+		case 28:
+			var v FHIRVersion
+			d.enum(d.varint(), v.setProtoNumber)
+			r.FhirVersion = v
+		case 29:
+			r.Format = append(r.Format, d.string())
+		case 30:
+			r.PatchFormat = append(r.PatchFormat, d.string())
+		case 31:
+			r.ImplementationGuide = append(r.ImplementationGuide, d.string())
+		case 32:
+			var v CapabilityStatementRest
+			d.message(&v)
+			r.Rest = append(r.Rest, v)
+		case 33:
+			var v CapabilityStatementMessaging
+			d.message(&v)
+			r.Messaging = append(r.Messaging, v)
+		case 34:
+			var v CapabilityStatementDocument
+			d.message(&v)
+			r.Document = append(r.Document, v)
+		case 35:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.KindElement = &v
+		case 50:
+			r.InstantiatesElement = append(r.InstantiatesElement, d.element())
+		case 51:
+			r.ImportsElement = append(r.ImportsElement, d.element())
+		case 52:
+			var v Element
+			d.message(&v)
+			r.FhirVersionElement = &v
+		case 53:
+			r.FormatElement = append(r.FormatElement, d.element())
+		case 54:
+			r.PatchFormatElement = append(r.PatchFormatElement, d.element())
+		case 55:
+			r.ImplementationGuideElement = append(r.ImplementationGuideElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementSoftware as JSON into a byte slice
+func (r CapabilityStatementSoftware) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementSoftware as JSON object with the members in the order of the fields
+func (r CapabilityStatementSoftware) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.ReleaseDate != nil {
+		e.key("releaseDate")
+		e.string(*r.ReleaseDate)
+	}
+	if r.ReleaseDateElement != nil {
+		e.key("_releaseDate")
+		r.ReleaseDateElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementSoftware from JSON. Unknown members are ignored.
+func (r *CapabilityStatementSoftware) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementSoftware
+func (r *CapabilityStatementSoftware) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "releaseDate":
+			if v, ok := d.string(); ok {
+				r.ReleaseDate = &v
+			} else {
+				r.ReleaseDate = nil
+			}
+		case "_releaseDate":
+			r.ReleaseDateElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementSoftware which shares no memory with the original
+func (r CapabilityStatementSoftware) DeepCopy() CapabilityStatementSoftware {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.ReleaseDate != nil {
+		v := *r.ReleaseDate
+		out.ReleaseDate = &v
+	}
+	if r.ReleaseDateElement != nil {
+		v := r.ReleaseDateElement.DeepCopy()
+		out.ReleaseDateElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementSoftware) Equal(other CapabilityStatementSoftware) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.ReleaseDate == nil) != (other.ReleaseDate == nil) || r.ReleaseDate != nil && *r.ReleaseDate != *other.ReleaseDate {
+		return false
+	}
+	if (r.ReleaseDateElement == nil) != (other.ReleaseDateElement == nil) || r.ReleaseDateElement != nil && !r.ReleaseDateElement.Equal(*other.ReleaseDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementSoftware) EqualsDeep(other CapabilityStatementSoftware) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.ReleaseDate == nil) != (other.ReleaseDate == nil) || r.ReleaseDate != nil && !equivalentString(*r.ReleaseDate, *other.ReleaseDate) {
+		return false
+	}
+	if (r.ReleaseDateElement == nil) != (other.ReleaseDateElement == nil) || r.ReleaseDateElement != nil && !r.ReleaseDateElement.EqualsDeep(*other.ReleaseDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementSoftware) EqualsShallow(other CapabilityStatementSoftware) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.ReleaseDate == nil) != (other.ReleaseDate == nil) || r.ReleaseDate != nil && !equivalentString(*r.ReleaseDate, *other.ReleaseDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementSoftware as FHIR XML
+func (r CapabilityStatementSoftware) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "releaseDate", r.ReleaseDate, r.ReleaseDateElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementSoftware from FHIR XML
+func (r *CapabilityStatementSoftware) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "releaseDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReleaseDate = &v
+				}
+				r.ReleaseDateElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementSoftware as properties to the RDF node n
+func (r CapabilityStatementSoftware) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.software.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("CapabilityStatement.software.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("CapabilityStatement.software.releaseDate", -1, r.ReleaseDate, r.ReleaseDateElement, "dateTime")
+}
+
+// MarshalBSON marshals the given CapabilityStatementSoftware as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementSoftware) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementSoftware from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementSoftware) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementSoftware as protobuf message CapabilityStatementSoftware of fhir.proto
+func (r CapabilityStatementSoftware) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementSoftware to the encoded message
+func (r CapabilityStatementSoftware) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Version != nil {
+		e.string(5, *r.Version)
+	}
+	if r.ReleaseDate != nil {
+		e.string(6, *r.ReleaseDate)
+	}
+	if r.NameElement != nil {
+		e.message(7, *r.NameElement)
+	}
+	if r.VersionElement != nil {
+		e.message(8, *r.VersionElement)
+	}
+	if r.ReleaseDateElement != nil {
+		e.message(9, *r.ReleaseDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementSoftware from protobuf message CapabilityStatementSoftware of fhir.proto
+func (r *CapabilityStatementSoftware) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementSoftware{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.Version = &v
+		case 6:
+			v := d.string()
+			r.ReleaseDate = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ReleaseDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementImplementation as JSON into a byte slice
+func (r CapabilityStatementImplementation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementImplementation as JSON object with the members in the order of the fields
+func (r CapabilityStatementImplementation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("description")
+	e.string(r.Description)
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Custodian != nil {
+		e.key("custodian")
+		r.Custodian.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementImplementation from JSON. Unknown members are ignored.
+func (r *CapabilityStatementImplementation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementImplementation
+func (r *CapabilityStatementImplementation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = v
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "custodian":
+			if d.null() {
+				r.Custodian = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Custodian = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementImplementation which shares no memory with the original
+func (r CapabilityStatementImplementation) DeepCopy() CapabilityStatementImplementation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Custodian != nil {
+		v := r.Custodian.DeepCopy()
+		out.Custodian = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementImplementation) Equal(other CapabilityStatementImplementation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Description != other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Custodian == nil) != (other.Custodian == nil) || r.Custodian != nil && !r.Custodian.Equal(*other.Custodian) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementImplementation) EqualsDeep(other CapabilityStatementImplementation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Custodian == nil) != (other.Custodian == nil) || r.Custodian != nil && !r.Custodian.EqualsDeep(*other.Custodian) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementImplementation) EqualsShallow(other CapabilityStatementImplementation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementImplementation as FHIR XML
+func (r CapabilityStatementImplementation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "custodian", r.Custodian); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementImplementation from FHIR XML
+func (r *CapabilityStatementImplementation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = v
+				}
+				r.DescriptionElement = element
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "custodian":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Custodian = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementImplementation as properties to the RDF node n
+func (r CapabilityStatementImplementation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.implementation.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("CapabilityStatement.implementation.url", -1, r.Url, r.UrlElement, "string")
+	n.element("CapabilityStatement.implementation.custodian", -1, r.Custodian)
+}
+
+// MarshalBSON marshals the given CapabilityStatementImplementation as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementImplementation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementImplementation from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementImplementation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementImplementation as protobuf message CapabilityStatementImplementation of fhir.proto
+func (r CapabilityStatementImplementation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementImplementation to the encoded message
+func (r CapabilityStatementImplementation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != "" {
+		e.string(4, r.Description)
+	}
+	if r.Url != nil {
+		e.string(5, *r.Url)
+	}
+	if r.Custodian != nil {
+		e.message(6, *r.Custodian)
+	}
+	if r.DescriptionElement != nil {
+		e.message(7, *r.DescriptionElement)
+	}
+	if r.UrlElement != nil {
+		e.message(8, *r.UrlElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementImplementation from protobuf message CapabilityStatementImplementation of fhir.proto
+func (r *CapabilityStatementImplementation) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementImplementation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Description = d.string()
+		case 5:
+			v := d.string()
+			r.Url = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Custodian = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementRest as JSON into a byte slice
+func (r CapabilityStatementRest) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementRest as JSON object with the members in the order of the fields
+func (r CapabilityStatementRest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("mode")
+	e.string(r.Mode.Code())
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	if r.Security != nil {
+		e.key("security")
+		r.Security.appendJSON(e)
+	}
+	if len(r.Resource) > 0 {
+		e.key("resource")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Resource {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Interaction) > 0 {
+		e.key("interaction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Interaction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SearchParam) > 0 {
+		e.key("searchParam")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SearchParam {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Operation) > 0 {
+		e.key("operation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Operation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Compartment) > 0 {
+		e.key("compartment")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Compartment {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CompartmentElement) > 0 {
+		e.key("_compartment")
+		e.elements(r.CompartmentElement, len(r.Compartment))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementRest from JSON. Unknown members are ignored.
+func (r *CapabilityStatementRest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementRest
+func (r *CapabilityStatementRest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "mode":
+			d.unmarshal(&r.Mode)
+		case "_mode":
+			r.ModeElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		case "security":
+			if d.null() {
+				r.Security = nil
+			} else {
+				var v CapabilityStatementRestSecurity
+				v.decodeJSON(d)
+				r.Security = &v
+			}
+		case "resource":
+			a := d.array()
+			r.Resource = nil
+			for a.next() {
+				var v CapabilityStatementRestResource
+				v.decodeJSON(d)
+				r.Resource = append(r.Resource, v)
+			}
+			if r.Resource == nil && !a.isNull {
+				r.Resource = []CapabilityStatementRestResource{}
+			}
+		case "interaction":
+			a := d.array()
+			r.Interaction = nil
+			for a.next() {
+				var v CapabilityStatementRestInteraction
+				v.decodeJSON(d)
+				r.Interaction = append(r.Interaction, v)
+			}
+			if r.Interaction == nil && !a.isNull {
+				r.Interaction = []CapabilityStatementRestInteraction{}
+			}
+		case "searchParam":
+			a := d.array()
+			r.SearchParam = nil
+			for a.next() {
+				var v CapabilityStatementRestResourceSearchParam
+				v.decodeJSON(d)
+				r.SearchParam = append(r.SearchParam, v)
+			}
+			if r.SearchParam == nil && !a.isNull {
+				r.SearchParam = []CapabilityStatementRestResourceSearchParam{}
+			}
+		case "operation":
+			a := d.array()
+			r.Operation = nil
+			for a.next() {
+				var v CapabilityStatementRestResourceOperation
+				v.decodeJSON(d)
+				r.Operation = append(r.Operation, v)
+			}
+			if r.Operation == nil && !a.isNull {
+				r.Operation = []CapabilityStatementRestResourceOperation{}
+			}
+		case "compartment":
+			a := d.array()
+			r.Compartment = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Compartment = append(r.Compartment, v)
+			}
+			if r.Compartment == nil && !a.isNull {
+				r.Compartment = []string{}
+			}
+		case "_compartment":
+			a := d.array()
+			r.CompartmentElement = nil
+			for a.next() {
+				r.CompartmentElement = append(r.CompartmentElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementRest which shares no memory with the original
+func (r CapabilityStatementRest) DeepCopy() CapabilityStatementRest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	if r.Security != nil {
+		v := r.Security.DeepCopy()
+		out.Security = &v
+	}
+	if r.Resource != nil {
+		out.Resource = make([]CapabilityStatementRestResource, len(r.Resource))
+		for i := range r.Resource {
+			out.Resource[i] = r.Resource[i].DeepCopy()
+		}
+	}
+	if r.Interaction != nil {
+		out.Interaction = make([]CapabilityStatementRestInteraction, len(r.Interaction))
+		for i := range r.Interaction {
+			out.Interaction[i] = r.Interaction[i].DeepCopy()
+		}
+	}
+	if r.SearchParam != nil {
+		out.SearchParam = make([]CapabilityStatementRestResourceSearchParam, len(r.SearchParam))
+		for i := range r.SearchParam {
+			out.SearchParam[i] = r.SearchParam[i].DeepCopy()
+		}
+	}
+	if r.Operation != nil {
+		out.Operation = make([]CapabilityStatementRestResourceOperation, len(r.Operation))
+		for i := range r.Operation {
+			out.Operation[i] = r.Operation[i].DeepCopy()
+		}
+	}
+	if r.Compartment != nil {
+		out.Compartment = make([]string, len(r.Compartment))
+		copy(out.Compartment, r.Compartment)
+	}
+	if r.CompartmentElement != nil {
+		out.CompartmentElement = make([]*Element, len(r.CompartmentElement))
+		for i, v := range r.CompartmentElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.CompartmentElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementRest) Equal(other CapabilityStatementRest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	if (r.Security == nil) != (other.Security == nil) || r.Security != nil && !r.Security.Equal(*other.Security) {
+		return false
+	}
+	if len(r.Resource) != len(other.Resource) {
+		return false
+	}
+	for i := range r.Resource {
+		if !r.Resource[i].Equal(other.Resource[i]) {
+			return false
+		}
+	}
+	if len(r.Interaction) != len(other.Interaction) {
+		return false
+	}
+	for i := range r.Interaction {
+		if !r.Interaction[i].Equal(other.Interaction[i]) {
+			return false
+		}
+	}
+	if len(r.SearchParam) != len(other.SearchParam) {
+		return false
+	}
+	for i := range r.SearchParam {
+		if !r.SearchParam[i].Equal(other.SearchParam[i]) {
+			return false
+		}
+	}
+	if len(r.Operation) != len(other.Operation) {
+		return false
+	}
+	for i := range r.Operation {
+		if !r.Operation[i].Equal(other.Operation[i]) {
+			return false
+		}
+	}
+	if len(r.Compartment) != len(other.Compartment) {
+		return false
+	}
+	for i := range r.Compartment {
+		if r.Compartment[i] != other.Compartment[i] {
+			return false
+		}
+	}
+	if !equalElements(r.CompartmentElement, other.CompartmentElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementRest) EqualsDeep(other CapabilityStatementRest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	if (r.Security == nil) != (other.Security == nil) || r.Security != nil && !r.Security.EqualsDeep(*other.Security) {
+		return false
+	}
+	if !equivalentList(len(r.Resource), len(other.Resource), func(i, j int) bool {
+		return r.Resource[i].EqualsDeep(other.Resource[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Interaction), len(other.Interaction), func(i, j int) bool {
+		return r.Interaction[i].EqualsDeep(other.Interaction[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SearchParam), len(other.SearchParam), func(i, j int) bool {
+		return r.SearchParam[i].EqualsDeep(other.SearchParam[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Operation), len(other.Operation), func(i, j int) bool {
+		return r.Operation[i].EqualsDeep(other.Operation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Compartment), len(other.Compartment), func(i, j int) bool {
+		return equivalentString(r.Compartment[i], other.Compartment[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.CompartmentElement, other.CompartmentElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementRest) EqualsShallow(other CapabilityStatementRest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if !equivalentList(len(r.Compartment), len(other.Compartment), func(i, j int) bool {
+		return equivalentString(r.Compartment[i], other.Compartment[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementRest as FHIR XML
+func (r CapabilityStatementRest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "security", r.Security); err != nil {
+		return err
+	}
+	for _, v := range r.Resource {
+		if err := encodeXMLElement(e, "resource", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Interaction {
+		if err := encodeXMLElement(e, "interaction", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SearchParam {
+		if err := encodeXMLElement(e, "searchParam", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Operation {
+		if err := encodeXMLElement(e, "operation", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Compartment {
+		if err := encodeXMLPrimitive(e, "compartment", v, elementAt(r.CompartmentElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementRest from FHIR XML
+func (r *CapabilityStatementRest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "mode":
+				var v RestfulCapabilityMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = v
+				}
+				r.ModeElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			case "security":
+				var v CapabilityStatementRestSecurity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Security = &v
+			case "resource":
+				var v CapabilityStatementRestResource
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Resource = append(r.Resource, v)
+			case "interaction":
+				var v CapabilityStatementRestInteraction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Interaction = append(r.Interaction, v)
+			case "searchParam":
+				var v CapabilityStatementRestResourceSearchParam
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SearchParam = append(r.SearchParam, v)
+			case "operation":
+				var v CapabilityStatementRestResourceOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operation = append(r.Operation, v)
+			case "compartment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.CompartmentElement = appendElement(r.CompartmentElement, len(r.Compartment), element)
+					r.Compartment = append(r.Compartment, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementRest as properties to the RDF node n
+func (r CapabilityStatementRest) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.rest.mode", -1, r.Mode, r.ModeElement, "code")
+	n.primitive("CapabilityStatement.rest.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+	n.element("CapabilityStatement.rest.security", -1, r.Security)
+	for i, v := range r.Resource {
+		n.element("CapabilityStatement.rest.resource", i, v)
+	}
+	for i, v := range r.Interaction {
+		n.element("CapabilityStatement.rest.interaction", i, v)
+	}
+	for i, v := range r.SearchParam {
+		n.element("CapabilityStatement.rest.searchParam", i, v)
+	}
+	for i, v := range r.Operation {
+		n.element("CapabilityStatement.rest.operation", i, v)
+	}
+	for i, v := range r.Compartment {
+		n.primitive("CapabilityStatement.rest.compartment", i, v, elementAt(r.CompartmentElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given CapabilityStatementRest as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementRest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementRest from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementRest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementRest as protobuf message CapabilityStatementRest of fhir.proto
+func (r CapabilityStatementRest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementRest to the encoded message
+func (r CapabilityStatementRest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Mode.protoNumber())
+	if r.Documentation != nil {
+		e.string(5, *r.Documentation)
+	}
+	if r.Security != nil {
+		e.message(6, *r.Security)
+	}
+	for _, v := range r.Resource {
+		e.message(7, v)
+	}
+	for _, v := range r.Interaction {
+		e.message(8, v)
+	}
+	for _, v := range r.SearchParam {
+		e.message(9, v)
+	}
+	for _, v := range r.Operation {
+		e.message(10, v)
+	}
+	for _, v := range r.Compartment {
+		e.string(11, v)
+	}
+	if r.ModeElement != nil {
+		e.message(12, *r.ModeElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(13, *r.DocumentationElement)
+	}
+	for _, v := range r.CompartmentElement {
+		e.element(14, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementRest from protobuf message CapabilityStatementRest of fhir.proto
+func (r *CapabilityStatementRest) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementRest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v RestfulCapabilityMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = v
+		case 5:
+			v := d.string()
+			r.Documentation = &v
+		case 6:
+			var v CapabilityStatementRestSecurity
+			d.message(&v)
+			r.Security = &v
+		case 7:
+			var v CapabilityStatementRestResource
+			d.message(&v)
+			r.Resource = append(r.Resource, v)
+		case 8:
+			var v CapabilityStatementRestInteraction
+			d.message(&v)
+			r.Interaction = append(r.Interaction, v)
+		case 9:
+			var v CapabilityStatementRestResourceSearchParam
+			d.message(&v)
+			r.SearchParam = append(r.SearchParam, v)
+		case 10:
+			var v CapabilityStatementRestResourceOperation
+			d.message(&v)
+			r.Operation = append(r.Operation, v)
+		case 11:
+			r.Compartment = append(r.Compartment, d.string())
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		case 14:
+			r.CompartmentElement = append(r.CompartmentElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementRestSecurity as JSON into a byte slice
+func (r CapabilityStatementRestSecurity) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementRestSecurity as JSON object with the members in the order of the fields
+func (r CapabilityStatementRestSecurity) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Cors != nil {
+		e.key("cors")
+		e.bool(*r.Cors)
+	}
+	if r.CorsElement != nil {
+		e.key("_cors")
+		r.CorsElement.appendJSON(e)
+	}
+	if len(r.Service) > 0 {
+		e.key("service")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Service {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementRestSecurity from JSON. Unknown members are ignored.
+func (r *CapabilityStatementRestSecurity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementRestSecurity
+func (r *CapabilityStatementRestSecurity) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "cors":
+			if v, ok := d.bool(); ok {
+				r.Cors = &v
+			} else {
+				r.Cors = nil
+			}
+		case "_cors":
+			r.CorsElement = d.element()
+		case "service":
+			a := d.array()
+			r.Service = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Service = append(r.Service, v)
+			}
+			if r.Service == nil && !a.isNull {
+				r.Service = []CodeableConcept{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementRestSecurity which shares no memory with the original
+func (r CapabilityStatementRestSecurity) DeepCopy() CapabilityStatementRestSecurity {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Cors != nil {
+		v := *r.Cors
+		out.Cors = &v
+	}
+	if r.CorsElement != nil {
+		v := r.CorsElement.DeepCopy()
+		out.CorsElement = &v
+	}
+	if r.Service != nil {
+		out.Service = make([]CodeableConcept, len(r.Service))
+		for i := range r.Service {
+			out.Service[i] = r.Service[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementRestSecurity) Equal(other CapabilityStatementRestSecurity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Cors == nil) != (other.Cors == nil) || r.Cors != nil && *r.Cors != *other.Cors {
+		return false
+	}
+	if (r.CorsElement == nil) != (other.CorsElement == nil) || r.CorsElement != nil && !r.CorsElement.Equal(*other.CorsElement) {
+		return false
+	}
+	if len(r.Service) != len(other.Service) {
+		return false
+	}
+	for i := range r.Service {
+		if !r.Service[i].Equal(other.Service[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementRestSecurity) EqualsDeep(other CapabilityStatementRestSecurity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Cors == nil) != (other.Cors == nil) || r.Cors != nil && *r.Cors != *other.Cors {
+		return false
+	}
+	if (r.CorsElement == nil) != (other.CorsElement == nil) || r.CorsElement != nil && !r.CorsElement.EqualsDeep(*other.CorsElement) {
+		return false
+	}
+	if !equivalentList(len(r.Service), len(other.Service), func(i, j int) bool {
+		return r.Service[i].EqualsDeep(other.Service[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementRestSecurity) EqualsShallow(other CapabilityStatementRestSecurity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Cors == nil) != (other.Cors == nil) || r.Cors != nil && *r.Cors != *other.Cors {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementRestSecurity as FHIR XML
+func (r CapabilityStatementRestSecurity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "cors", r.Cors, r.CorsElement); err != nil {
+		return err
+	}
+	for _, v := range r.Service {
+		if err := encodeXMLElement(e, "service", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementRestSecurity from FHIR XML
+func (r *CapabilityStatementRestSecurity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "cors":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Cors = &v
+				}
+				r.CorsElement = element
+			case "service":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Service = append(r.Service, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementRestSecurity as properties to the RDF node n
+func (r CapabilityStatementRestSecurity) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.rest.security.cors", -1, r.Cors, r.CorsElement, "boolean")
+	for i, v := range r.Service {
+		n.element("CapabilityStatement.rest.security.service", i, v)
+	}
+	n.primitive("CapabilityStatement.rest.security.description", -1, r.Description, r.DescriptionElement, "string")
+}
+
+// MarshalBSON marshals the given CapabilityStatementRestSecurity as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementRestSecurity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementRestSecurity from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementRestSecurity) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementRestSecurity as protobuf message CapabilityStatementRestSecurity of fhir.proto
+func (r CapabilityStatementRestSecurity) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementRestSecurity to the encoded message
+func (r CapabilityStatementRestSecurity) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Cors != nil {
+		e.varint(4, protoBool(*r.Cors))
+	}
+	for _, v := range r.Service {
+		e.message(5, v)
+	}
+	if r.Description != nil {
+		e.string(6, *r.Description)
+	}
+	if r.CorsElement != nil {
+		e.message(7, *r.CorsElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(8, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementRestSecurity from protobuf message CapabilityStatementRestSecurity of fhir.proto
+func (r *CapabilityStatementRestSecurity) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementRestSecurity{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.bool()
+			r.Cors = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Service = append(r.Service, v)
+		case 6:
+			v := d.string()
+			r.Description = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.CorsElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementRestResource as JSON into a byte slice
+func (r CapabilityStatementRestResource) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementRestResource as JSON object with the members in the order of the fields
+func (r CapabilityStatementRestResource) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Profile != nil {
+		e.key("profile")
+		e.string(*r.Profile)
+	}
+	if r.ProfileElement != nil {
+		e.key("_profile")
+		r.ProfileElement.appendJSON(e)
+	}
+	if len(r.SupportedProfile) > 0 {
+		e.key("supportedProfile")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportedProfile {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportedProfileElement) > 0 {
+		e.key("_supportedProfile")
+		e.elements(r.SupportedProfileElement, len(r.SupportedProfile))
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	if len(r.Interaction) > 0 {
+		e.key("interaction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Interaction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Versioning != nil {
+		e.key("versioning")
+		e.string(r.Versioning.Code())
+	}
+	if r.VersioningElement != nil {
+		e.key("_versioning")
+		r.VersioningElement.appendJSON(e)
+	}
+	if r.ReadHistory != nil {
+		e.key("readHistory")
+		e.bool(*r.ReadHistory)
+	}
+	if r.ReadHistoryElement != nil {
+		e.key("_readHistory")
+		r.ReadHistoryElement.appendJSON(e)
+	}
+	if r.UpdateCreate != nil {
+		e.key("updateCreate")
+		e.bool(*r.UpdateCreate)
+	}
+	if r.UpdateCreateElement != nil {
+		e.key("_updateCreate")
+		r.UpdateCreateElement.appendJSON(e)
+	}
+	if r.ConditionalCreate != nil {
+		e.key("conditionalCreate")
+		e.bool(*r.ConditionalCreate)
+	}
+	if r.ConditionalCreateElement != nil {
+		e.key("_conditionalCreate")
+		r.ConditionalCreateElement.appendJSON(e)
+	}
+	if r.ConditionalRead != nil {
+		e.key("conditionalRead")
+		e.string(r.ConditionalRead.Code())
+	}
+	if r.ConditionalReadElement != nil {
+		e.key("_conditionalRead")
+		r.ConditionalReadElement.appendJSON(e)
+	}
+	if r.ConditionalUpdate != nil {
+		e.key("conditionalUpdate")
+		e.bool(*r.ConditionalUpdate)
+	}
+	if r.ConditionalUpdateElement != nil {
+		e.key("_conditionalUpdate")
+		r.ConditionalUpdateElement.appendJSON(e)
+	}
+	if r.ConditionalDelete != nil {
+		e.key("conditionalDelete")
+		e.string(r.ConditionalDelete.Code())
+	}
+	if r.ConditionalDeleteElement != nil {
+		e.key("_conditionalDelete")
+		r.ConditionalDeleteElement.appendJSON(e)
+	}
+	if len(r.ReferencePolicy) > 0 {
+		e.key("referencePolicy")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReferencePolicy {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReferencePolicyElement) > 0 {
+		e.key("_referencePolicy")
+		e.elements(r.ReferencePolicyElement, len(r.ReferencePolicy))
+	}
+	if len(r.SearchInclude) > 0 {
+		e.key("searchInclude")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SearchInclude {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SearchIncludeElement) > 0 {
+		e.key("_searchInclude")
+		e.elements(r.SearchIncludeElement, len(r.SearchInclude))
+	}
+	if len(r.SearchRevInclude) > 0 {
+		e.key("searchRevInclude")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SearchRevInclude {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SearchRevIncludeElement) > 0 {
+		e.key("_searchRevInclude")
+		e.elements(r.SearchRevIncludeElement, len(r.SearchRevInclude))
+	}
+	if len(r.SearchParam) > 0 {
+		e.key("searchParam")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SearchParam {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Operation) > 0 {
+		e.key("operation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Operation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementRestResource from JSON. Unknown members are ignored.
+func (r *CapabilityStatementRestResource) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementRestResource
+func (r *CapabilityStatementRestResource) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "profile":
+			if v, ok := d.string(); ok {
+				r.Profile = &v
+			} else {
+				r.Profile = nil
+			}
+		case "_profile":
+			r.ProfileElement = d.element()
+		case "supportedProfile":
+			a := d.array()
+			r.SupportedProfile = nil
+			for a.next() {
+				v, _ := d.string()
+				r.SupportedProfile = append(r.SupportedProfile, v)
+			}
+			if r.SupportedProfile == nil && !a.isNull {
+				r.SupportedProfile = []string{}
+			}
+		case "_supportedProfile":
+			a := d.array()
+			r.SupportedProfileElement = nil
+			for a.next() {
+				r.SupportedProfileElement = append(r.SupportedProfileElement, d.element())
+			}
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		case "interaction":
+			a := d.array()
+			r.Interaction = nil
+			for a.next() {
+				var v CapabilityStatementRestResourceInteraction
+				v.decodeJSON(d)
+				r.Interaction = append(r.Interaction, v)
+			}
+			if r.Interaction == nil && !a.isNull {
+				r.Interaction = []CapabilityStatementRestResourceInteraction{}
+			}
+		case "versioning":
+			if d.null() {
+				r.Versioning = nil
+			} else {
+				var v ResourceVersionPolicy
+				d.unmarshal(&v)
+				r.Versioning = &v
+			}
+		case "_versioning":
+			r.VersioningElement = d.element()
+		case "readHistory":
+			if v, ok := d.bool(); ok {
+				r.ReadHistory = &v
+			} else {
+				r.ReadHistory = nil
+			}
+		case "_readHistory":
+			r.ReadHistoryElement = d.element()
+		case "updateCreate":
+			if v, ok := d.bool(); ok {
+				r.UpdateCreate = &v
+			} else {
+				r.UpdateCreate = nil
+			}
+		case "_updateCreate":
+			r.UpdateCreateElement = d.element()
+		case "conditionalCreate":
+			if v, ok := d.bool(); ok {
+				r.ConditionalCreate = &v
+			} else {
+				r.ConditionalCreate = nil
+			}
+		case "_conditionalCreate":
+			r.ConditionalCreateElement = d.element()
+		case "conditionalRead":
+			if d.null() {
+				r.ConditionalRead = nil
+			} else {
+				var v ConditionalReadStatus
+				d.unmarshal(&v)
+				r.ConditionalRead = &v
+			}
+		case "_conditionalRead":
+			r.ConditionalReadElement = d.element()
+		case "conditionalUpdate":
+			if v, ok := d.bool(); ok {
+				r.ConditionalUpdate = &v
+			} else {
+				r.ConditionalUpdate = nil
+			}
+		case "_conditionalUpdate":
+			r.ConditionalUpdateElement = d.element()
+		case "conditionalDelete":
+			if d.null() {
+				r.ConditionalDelete = nil
+			} else {
+				var v ConditionalDeleteStatus
+				d.unmarshal(&v)
+				r.ConditionalDelete = &v
+			}
+		case "_conditionalDelete":
+			r.ConditionalDeleteElement = d.element()
+		case "referencePolicy":
+			a := d.array()
+			r.ReferencePolicy = nil
+			for a.next() {
+				var v ReferenceHandlingPolicy
+				d.unmarshal(&v)
+				r.ReferencePolicy = append(r.ReferencePolicy, v)
+			}
+			if r.ReferencePolicy == nil && !a.isNull {
+				r.ReferencePolicy = []ReferenceHandlingPolicy{}
+			}
+		case "_referencePolicy":
+			a := d.array()
+			r.ReferencePolicyElement = nil
+			for a.next() {
+				r.ReferencePolicyElement = append(r.ReferencePolicyElement, d.element())
+			}
+		case "searchInclude":
+			a := d.array()
+			r.SearchInclude = nil
+			for a.next() {
+				v, _ := d.string()
+				r.SearchInclude = append(r.SearchInclude, v)
+			}
+			if r.SearchInclude == nil && !a.isNull {
+				r.SearchInclude = []string{}
+			}
+		case "_searchInclude":
+			a := d.array()
+			r.SearchIncludeElement = nil
+			for a.next() {
+				r.SearchIncludeElement = append(r.SearchIncludeElement, d.element())
+			}
+		case "searchRevInclude":
+			a := d.array()
+			r.SearchRevInclude = nil
+			for a.next() {
+				v, _ := d.string()
+				r.SearchRevInclude = append(r.SearchRevInclude, v)
+			}
+			if r.SearchRevInclude == nil && !a.isNull {
+				r.SearchRevInclude = []string{}
+			}
+		case "_searchRevInclude":
+			a := d.array()
+			r.SearchRevIncludeElement = nil
+			for a.next() {
+				r.SearchRevIncludeElement = append(r.SearchRevIncludeElement, d.element())
+			}
+		case "searchParam":
+			a := d.array()
+			r.SearchParam = nil
+			for a.next() {
+				var v CapabilityStatementRestResourceSearchParam
+				v.decodeJSON(d)
+				r.SearchParam = append(r.SearchParam, v)
+			}
+			if r.SearchParam == nil && !a.isNull {
+				r.SearchParam = []CapabilityStatementRestResourceSearchParam{}
+			}
+		case "operation":
+			a := d.array()
+			r.Operation = nil
+			for a.next() {
+				var v CapabilityStatementRestResourceOperation
+				v.decodeJSON(d)
+				r.Operation = append(r.Operation, v)
+			}
+			if r.Operation == nil && !a.isNull {
+				r.Operation = []CapabilityStatementRestResourceOperation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementRestResource which shares no memory with the original
+func (r CapabilityStatementRestResource) DeepCopy() CapabilityStatementRestResource {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Profile != nil {
+		v := *r.Profile
+		out.Profile = &v
+	}
+	if r.ProfileElement != nil {
+		v := r.ProfileElement.DeepCopy()
+		out.ProfileElement = &v
+	}
+	if r.SupportedProfile != nil {
+		out.SupportedProfile = make([]string, len(r.SupportedProfile))
+		copy(out.SupportedProfile, r.SupportedProfile)
+	}
+	if r.SupportedProfileElement != nil {
+		out.SupportedProfileElement = make([]*Element, len(r.SupportedProfileElement))
+		for i, v := range r.SupportedProfileElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SupportedProfileElement[i] = &c
+			}
+		}
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	if r.Interaction != nil {
+		out.Interaction = make([]CapabilityStatementRestResourceInteraction, len(r.Interaction))
+		for i := range r.Interaction {
+			out.Interaction[i] = r.Interaction[i].DeepCopy()
+		}
+	}
+	if r.Versioning != nil {
+		v := *r.Versioning
+		out.Versioning = &v
+	}
+	if r.VersioningElement != nil {
+		v := r.VersioningElement.DeepCopy()
+		out.VersioningElement = &v
+	}
+	if r.ReadHistory != nil {
+		v := *r.ReadHistory
+		out.ReadHistory = &v
+	}
+	if r.ReadHistoryElement != nil {
+		v := r.ReadHistoryElement.DeepCopy()
+		out.ReadHistoryElement = &v
+	}
+	if r.UpdateCreate != nil {
+		v := *r.UpdateCreate
+		out.UpdateCreate = &v
+	}
+	if r.UpdateCreateElement != nil {
+		v := r.UpdateCreateElement.DeepCopy()
+		out.UpdateCreateElement = &v
+	}
+	if r.ConditionalCreate != nil {
+		v := *r.ConditionalCreate
+		out.ConditionalCreate = &v
+	}
+	if r.ConditionalCreateElement != nil {
+		v := r.ConditionalCreateElement.DeepCopy()
+		out.ConditionalCreateElement = &v
+	}
+	if r.ConditionalRead != nil {
+		v := *r.ConditionalRead
+		out.ConditionalRead = &v
+	}
+	if r.ConditionalReadElement != nil {
+		v := r.ConditionalReadElement.DeepCopy()
+		out.ConditionalReadElement = &v
+	}
+	if r.ConditionalUpdate != nil {
+		v := *r.ConditionalUpdate
+		out.ConditionalUpdate = &v
+	}
+	if r.ConditionalUpdateElement != nil {
+		v := r.ConditionalUpdateElement.DeepCopy()
+		out.ConditionalUpdateElement = &v
+	}
+	if r.ConditionalDelete != nil {
+		v := *r.ConditionalDelete
+		out.ConditionalDelete = &v
+	}
+	if r.ConditionalDeleteElement != nil {
+		v := r.ConditionalDeleteElement.DeepCopy()
+		out.ConditionalDeleteElement = &v
+	}
+	if r.ReferencePolicy != nil {
+		out.ReferencePolicy = make([]ReferenceHandlingPolicy, len(r.ReferencePolicy))
+		copy(out.ReferencePolicy, r.ReferencePolicy)
+	}
+	if r.ReferencePolicyElement != nil {
+		out.ReferencePolicyElement = make([]*Element, len(r.ReferencePolicyElement))
+		for i, v := range r.ReferencePolicyElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ReferencePolicyElement[i] = &c
+			}
+		}
+	}
+	if r.SearchInclude != nil {
+		out.SearchInclude = make([]string, len(r.SearchInclude))
+		copy(out.SearchInclude, r.SearchInclude)
+	}
+	if r.SearchIncludeElement != nil {
+		out.SearchIncludeElement = make([]*Element, len(r.SearchIncludeElement))
+		for i, v := range r.SearchIncludeElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SearchIncludeElement[i] = &c
+			}
+		}
+	}
+	if r.SearchRevInclude != nil {
+		out.SearchRevInclude = make([]string, len(r.SearchRevInclude))
+		copy(out.SearchRevInclude, r.SearchRevInclude)
+	}
+	if r.SearchRevIncludeElement != nil {
+		out.SearchRevIncludeElement = make([]*Element, len(r.SearchRevIncludeElement))
+		for i, v := range r.SearchRevIncludeElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SearchRevIncludeElement[i] = &c
+			}
+		}
+	}
+	if r.SearchParam != nil {
+		out.SearchParam = make([]CapabilityStatementRestResourceSearchParam, len(r.SearchParam))
+		for i := range r.SearchParam {
+			out.SearchParam[i] = r.SearchParam[i].DeepCopy()
+		}
+	}
+	if r.Operation != nil {
+		out.Operation = make([]CapabilityStatementRestResourceOperation, len(r.Operation))
+		for i := range r.Operation {
+			out.Operation[i] = r.Operation[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementRestResource) Equal(other CapabilityStatementRestResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && *r.Profile != *other.Profile {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.Equal(*other.ProfileElement) {
+		return false
+	}
+	if len(r.SupportedProfile) != len(other.SupportedProfile) {
+		return false
+	}
+	for i := range r.SupportedProfile {
+		if r.SupportedProfile[i] != other.SupportedProfile[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SupportedProfileElement, other.SupportedProfileElement, false) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	if len(r.Interaction) != len(other.Interaction) {
+		return false
+	}
+	for i := range r.Interaction {
+		if !r.Interaction[i].Equal(other.Interaction[i]) {
+			return false
+		}
+	}
+	if (r.Versioning == nil) != (other.Versioning == nil) || r.Versioning != nil && *r.Versioning != *other.Versioning {
+		return false
+	}
+	if (r.VersioningElement == nil) != (other.VersioningElement == nil) || r.VersioningElement != nil && !r.VersioningElement.Equal(*other.VersioningElement) {
+		return false
+	}
+	if (r.ReadHistory == nil) != (other.ReadHistory == nil) || r.ReadHistory != nil && *r.ReadHistory != *other.ReadHistory {
+		return false
+	}
+	if (r.ReadHistoryElement == nil) != (other.ReadHistoryElement == nil) || r.ReadHistoryElement != nil && !r.ReadHistoryElement.Equal(*other.ReadHistoryElement) {
+		return false
+	}
+	if (r.UpdateCreate == nil) != (other.UpdateCreate == nil) || r.UpdateCreate != nil && *r.UpdateCreate != *other.UpdateCreate {
+		return false
+	}
+	if (r.UpdateCreateElement == nil) != (other.UpdateCreateElement == nil) || r.UpdateCreateElement != nil && !r.UpdateCreateElement.Equal(*other.UpdateCreateElement) {
+		return false
+	}
+	if (r.ConditionalCreate == nil) != (other.ConditionalCreate == nil) || r.ConditionalCreate != nil && *r.ConditionalCreate != *other.ConditionalCreate {
+		return false
+	}
+	if (r.ConditionalCreateElement == nil) != (other.ConditionalCreateElement == nil) || r.ConditionalCreateElement != nil && !r.ConditionalCreateElement.Equal(*other.ConditionalCreateElement) {
+		return false
+	}
+	if (r.ConditionalRead == nil) != (other.ConditionalRead == nil) || r.ConditionalRead != nil && *r.ConditionalRead != *other.ConditionalRead {
+		return false
+	}
+	if (r.ConditionalReadElement == nil) != (other.ConditionalReadElement == nil) || r.ConditionalReadElement != nil && !r.ConditionalReadElement.Equal(*other.ConditionalReadElement) {
+		return false
+	}
+	if (r.ConditionalUpdate == nil) != (other.ConditionalUpdate == nil) || r.ConditionalUpdate != nil && *r.ConditionalUpdate != *other.ConditionalUpdate {
+		return false
+	}
+	if (r.ConditionalUpdateElement == nil) != (other.ConditionalUpdateElement == nil) || r.ConditionalUpdateElement != nil && !r.ConditionalUpdateElement.Equal(*other.ConditionalUpdateElement) {
+		return false
+	}
+	if (r.ConditionalDelete == nil) != (other.ConditionalDelete == nil) || r.ConditionalDelete != nil && *r.ConditionalDelete != *other.ConditionalDelete {
+		return false
+	}
+	if (r.ConditionalDeleteElement == nil) != (other.ConditionalDeleteElement == nil) || r.ConditionalDeleteElement != nil && !r.ConditionalDeleteElement.Equal(*other.ConditionalDeleteElement) {
+		return false
+	}
+	if len(r.ReferencePolicy) != len(other.ReferencePolicy) {
+		return false
+	}
+	for i := range r.ReferencePolicy {
+		if r.ReferencePolicy[i] != other.ReferencePolicy[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ReferencePolicyElement, other.ReferencePolicyElement, false) {
+		return false
+	}
+	if len(r.SearchInclude) != len(other.SearchInclude) {
+		return false
+	}
+	for i := range r.SearchInclude {
+		if r.SearchInclude[i] != other.SearchInclude[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SearchIncludeElement, other.SearchIncludeElement, false) {
+		return false
+	}
+	if len(r.SearchRevInclude) != len(other.SearchRevInclude) {
+		return false
+	}
+	for i := range r.SearchRevInclude {
+		if r.SearchRevInclude[i] != other.SearchRevInclude[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SearchRevIncludeElement, other.SearchRevIncludeElement, false) {
+		return false
+	}
+	if len(r.SearchParam) != len(other.SearchParam) {
+		return false
+	}
+	for i := range r.SearchParam {
+		if !r.SearchParam[i].Equal(other.SearchParam[i]) {
+			return false
+		}
+	}
+	if len(r.Operation) != len(other.Operation) {
+		return false
+	}
+	for i := range r.Operation {
+		if !r.Operation[i].Equal(other.Operation[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementRestResource) EqualsDeep(other CapabilityStatementRestResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.EqualsDeep(*other.ProfileElement) {
+		return false
+	}
+	if !equivalentList(len(r.SupportedProfile), len(other.SupportedProfile), func(i, j int) bool {
+		return equivalentString(r.SupportedProfile[i], other.SupportedProfile[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.SupportedProfileElement, other.SupportedProfileElement, true) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	if !equivalentList(len(r.Interaction), len(other.Interaction), func(i, j int) bool {
+		return r.Interaction[i].EqualsDeep(other.Interaction[j])
+	}) {
+		return false
+	}
+	if (r.Versioning == nil) != (other.Versioning == nil) || r.Versioning != nil && *r.Versioning != *other.Versioning {
+		return false
+	}
+	if (r.VersioningElement == nil) != (other.VersioningElement == nil) || r.VersioningElement != nil && !r.VersioningElement.EqualsDeep(*other.VersioningElement) {
+		return false
+	}
+	if (r.ReadHistory == nil) != (other.ReadHistory == nil) || r.ReadHistory != nil && *r.ReadHistory != *other.ReadHistory {
+		return false
+	}
+	if (r.ReadHistoryElement == nil) != (other.ReadHistoryElement == nil) || r.ReadHistoryElement != nil && !r.ReadHistoryElement.EqualsDeep(*other.ReadHistoryElement) {
+		return false
+	}
+	if (r.UpdateCreate == nil) != (other.UpdateCreate == nil) || r.UpdateCreate != nil && *r.UpdateCreate != *other.UpdateCreate {
+		return false
+	}
+	if (r.UpdateCreateElement == nil) != (other.UpdateCreateElement == nil) || r.UpdateCreateElement != nil && !r.UpdateCreateElement.EqualsDeep(*other.UpdateCreateElement) {
+		return false
+	}
+	if (r.ConditionalCreate == nil) != (other.ConditionalCreate == nil) || r.ConditionalCreate != nil && *r.ConditionalCreate != *other.ConditionalCreate {
+		return false
+	}
+	if (r.ConditionalCreateElement == nil) != (other.ConditionalCreateElement == nil) || r.ConditionalCreateElement != nil && !r.ConditionalCreateElement.EqualsDeep(*other.ConditionalCreateElement) {
+		return false
+	}
+	if (r.ConditionalRead == nil) != (other.ConditionalRead == nil) || r.ConditionalRead != nil && *r.ConditionalRead != *other.ConditionalRead {
+		return false
+	}
+	if (r.ConditionalReadElement == nil) != (other.ConditionalReadElement == nil) || r.ConditionalReadElement != nil && !r.ConditionalReadElement.EqualsDeep(*other.ConditionalReadElement) {
+		return false
+	}
+	if (r.ConditionalUpdate == nil) != (other.ConditionalUpdate == nil) || r.ConditionalUpdate != nil && *r.ConditionalUpdate != *other.ConditionalUpdate {
+		return false
+	}
+	if (r.ConditionalUpdateElement == nil) != (other.ConditionalUpdateElement == nil) || r.ConditionalUpdateElement != nil && !r.ConditionalUpdateElement.EqualsDeep(*other.ConditionalUpdateElement) {
+		return false
+	}
+	if (r.ConditionalDelete == nil) != (other.ConditionalDelete == nil) || r.ConditionalDelete != nil && *r.ConditionalDelete != *other.ConditionalDelete {
+		return false
+	}
+	if (r.ConditionalDeleteElement == nil) != (other.ConditionalDeleteElement == nil) || r.ConditionalDeleteElement != nil && !r.ConditionalDeleteElement.EqualsDeep(*other.ConditionalDeleteElement) {
+		return false
+	}
+	if !equivalentList(len(r.ReferencePolicy), len(other.ReferencePolicy), func(i, j int) bool {
+		return r.ReferencePolicy[i] == other.ReferencePolicy[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ReferencePolicyElement, other.ReferencePolicyElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.SearchInclude), len(other.SearchInclude), func(i, j int) bool {
+		return equivalentString(r.SearchInclude[i], other.SearchInclude[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.SearchIncludeElement, other.SearchIncludeElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.SearchRevInclude), len(other.SearchRevInclude), func(i, j int) bool {
+		return equivalentString(r.SearchRevInclude[i], other.SearchRevInclude[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.SearchRevIncludeElement, other.SearchRevIncludeElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.SearchParam), len(other.SearchParam), func(i, j int) bool {
+		return r.SearchParam[i].EqualsDeep(other.SearchParam[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Operation), len(other.Operation), func(i, j int) bool {
+		return r.Operation[i].EqualsDeep(other.Operation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementRestResource) EqualsShallow(other CapabilityStatementRestResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	if !equivalentList(len(r.SupportedProfile), len(other.SupportedProfile), func(i, j int) bool {
+		return equivalentString(r.SupportedProfile[i], other.SupportedProfile[j])
+	}) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.Versioning == nil) != (other.Versioning == nil) || r.Versioning != nil && *r.Versioning != *other.Versioning {
+		return false
+	}
+	if (r.ReadHistory == nil) != (other.ReadHistory == nil) || r.ReadHistory != nil && *r.ReadHistory != *other.ReadHistory {
+		return false
+	}
+	if (r.UpdateCreate == nil) != (other.UpdateCreate == nil) || r.UpdateCreate != nil && *r.UpdateCreate != *other.UpdateCreate {
+		return false
+	}
+	if (r.ConditionalCreate == nil) != (other.ConditionalCreate == nil) || r.ConditionalCreate != nil && *r.ConditionalCreate != *other.ConditionalCreate {
+		return false
+	}
+	if (r.ConditionalRead == nil) != (other.ConditionalRead == nil) || r.ConditionalRead != nil && *r.ConditionalRead != *other.ConditionalRead {
+		return false
+	}
+	if (r.ConditionalUpdate == nil) != (other.ConditionalUpdate == nil) || r.ConditionalUpdate != nil && *r.ConditionalUpdate != *other.ConditionalUpdate {
+		return false
+	}
+	if (r.ConditionalDelete == nil) != (other.ConditionalDelete == nil) || r.ConditionalDelete != nil && *r.ConditionalDelete != *other.ConditionalDelete {
+		return false
+	}
+	if !equivalentList(len(r.ReferencePolicy), len(other.ReferencePolicy), func(i, j int) bool {
+		return r.ReferencePolicy[i] == other.ReferencePolicy[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SearchInclude), len(other.SearchInclude), func(i, j int) bool {
+		return equivalentString(r.SearchInclude[i], other.SearchInclude[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SearchRevInclude), len(other.SearchRevInclude), func(i, j int) bool {
+		return equivalentString(r.SearchRevInclude[i], other.SearchRevInclude[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementRestResource as FHIR XML
+func (r CapabilityStatementRestResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "profile", r.Profile, r.ProfileElement); err != nil {
+		return err
+	}
+	for i, v := range r.SupportedProfile {
+		if err := encodeXMLPrimitive(e, "supportedProfile", v, elementAt(r.SupportedProfileElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	for _, v := range r.Interaction {
+		if err := encodeXMLElement(e, "interaction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "versioning", r.Versioning, r.VersioningElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "readHistory", r.ReadHistory, r.ReadHistoryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "updateCreate", r.UpdateCreate, r.UpdateCreateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "conditionalCreate", r.ConditionalCreate, r.ConditionalCreateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "conditionalRead", r.ConditionalRead, r.ConditionalReadElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "conditionalUpdate", r.ConditionalUpdate, r.ConditionalUpdateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "conditionalDelete", r.ConditionalDelete, r.ConditionalDeleteElement); err != nil {
+		return err
+	}
+	for i, v := range r.ReferencePolicy {
+		if err := encodeXMLPrimitive(e, "referencePolicy", v, elementAt(r.ReferencePolicyElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.SearchInclude {
+		if err := encodeXMLPrimitive(e, "searchInclude", v, elementAt(r.SearchIncludeElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.SearchRevInclude {
+		if err := encodeXMLPrimitive(e, "searchRevInclude", v, elementAt(r.SearchRevIncludeElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SearchParam {
+		if err := encodeXMLElement(e, "searchParam", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Operation {
+		if err := encodeXMLElement(e, "operation", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementRestResource from FHIR XML
+func (r *CapabilityStatementRestResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Profile = &v
+				}
+				r.ProfileElement = element
+			case "supportedProfile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SupportedProfileElement = appendElement(r.SupportedProfileElement, len(r.SupportedProfile), element)
+					r.SupportedProfile = append(r.SupportedProfile, v)
+				}
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			case "interaction":
+				var v CapabilityStatementRestResourceInteraction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Interaction = append(r.Interaction, v)
+			case "versioning":
+				var v ResourceVersionPolicy
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Versioning = &v
+				}
+				r.VersioningElement = element
+			case "readHistory":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReadHistory = &v
+				}
+				r.ReadHistoryElement = element
+			case "updateCreate":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.UpdateCreate = &v
+				}
+				r.UpdateCreateElement = element
+			case "conditionalCreate":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ConditionalCreate = &v
+				}
+				r.ConditionalCreateElement = element
+			case "conditionalRead":
+				var v ConditionalReadStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ConditionalRead = &v
+				}
+				r.ConditionalReadElement = element
+			case "conditionalUpdate":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ConditionalUpdate = &v
+				}
+				r.ConditionalUpdateElement = element
+			case "conditionalDelete":
+				var v ConditionalDeleteStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ConditionalDelete = &v
+				}
+				r.ConditionalDeleteElement = element
+			case "referencePolicy":
+				var v ReferenceHandlingPolicy
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ReferencePolicyElement = appendElement(r.ReferencePolicyElement, len(r.ReferencePolicy), element)
+					r.ReferencePolicy = append(r.ReferencePolicy, v)
+				}
+			case "searchInclude":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SearchIncludeElement = appendElement(r.SearchIncludeElement, len(r.SearchInclude), element)
+					r.SearchInclude = append(r.SearchInclude, v)
+				}
+			case "searchRevInclude":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SearchRevIncludeElement = appendElement(r.SearchRevIncludeElement, len(r.SearchRevInclude), element)
+					r.SearchRevInclude = append(r.SearchRevInclude, v)
+				}
+			case "searchParam":
+				var v CapabilityStatementRestResourceSearchParam
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SearchParam = append(r.SearchParam, v)
+			case "operation":
+				var v CapabilityStatementRestResourceOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operation = append(r.Operation, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementRestResource as properties to the RDF node n
+func (r CapabilityStatementRestResource) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.rest.resource.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("CapabilityStatement.rest.resource.profile", -1, r.Profile, r.ProfileElement, "string")
+	for i, v := range r.SupportedProfile {
+		n.primitive("CapabilityStatement.rest.resource.supportedProfile", i, v, elementAt(r.SupportedProfileElement, i), "string")
+	}
+	n.primitive("CapabilityStatement.rest.resource.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+	for i, v := range r.Interaction {
+		n.element("CapabilityStatement.rest.resource.interaction", i, v)
+	}
+	n.primitive("CapabilityStatement.rest.resource.versioning", -1, r.Versioning, r.VersioningElement, "code")
+	n.primitive("CapabilityStatement.rest.resource.readHistory", -1, r.ReadHistory, r.ReadHistoryElement, "boolean")
+	n.primitive("CapabilityStatement.rest.resource.updateCreate", -1, r.UpdateCreate, r.UpdateCreateElement, "boolean")
+	n.primitive("CapabilityStatement.rest.resource.conditionalCreate", -1, r.ConditionalCreate, r.ConditionalCreateElement, "boolean")
+	n.primitive("CapabilityStatement.rest.resource.conditionalRead", -1, r.ConditionalRead, r.ConditionalReadElement, "code")
+	n.primitive("CapabilityStatement.rest.resource.conditionalUpdate", -1, r.ConditionalUpdate, r.ConditionalUpdateElement, "boolean")
+	n.primitive("CapabilityStatement.rest.resource.conditionalDelete", -1, r.ConditionalDelete, r.ConditionalDeleteElement, "code")
+	for i, v := range r.ReferencePolicy {
+		n.primitive("CapabilityStatement.rest.resource.referencePolicy", i, v, elementAt(r.ReferencePolicyElement, i), "code")
+	}
+	for i, v := range r.SearchInclude {
+		n.primitive("CapabilityStatement.rest.resource.searchInclude", i, v, elementAt(r.SearchIncludeElement, i), "string")
+	}
+	for i, v := range r.SearchRevInclude {
+		n.primitive("CapabilityStatement.rest.resource.searchRevInclude", i, v, elementAt(r.SearchRevIncludeElement, i), "string")
+	}
+	for i, v := range r.SearchParam {
+		n.element("CapabilityStatement.rest.resource.searchParam", i, v)
+	}
+	for i, v := range r.Operation {
+		n.element("CapabilityStatement.rest.resource.operation", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CapabilityStatementRestResource as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementRestResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementRestResource from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementRestResource) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementRestResource as protobuf message CapabilityStatementRestResource of fhir.proto
+func (r CapabilityStatementRestResource) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementRestResource to the encoded message
+func (r CapabilityStatementRestResource) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Profile != nil {
+		e.string(5, *r.Profile)
+	}
+	for _, v := range r.SupportedProfile {
+		e.string(6, v)
+	}
+	if r.Documentation != nil {
+		e.string(7, *r.Documentation)
+	}
+	for _, v := range r.Interaction {
+		e.message(8, v)
+	}
+	if r.Versioning != nil {
+		e.varint(9, r.Versioning.protoNumber())
+	}
+	if r.ReadHistory != nil {
+		e.varint(10, protoBool(*r.ReadHistory))
+	}
+	if r.UpdateCreate != nil {
+		e.varint(11, protoBool(*r.UpdateCreate))
+	}
+	if r.ConditionalCreate != nil {
+		e.varint(12, protoBool(*r.ConditionalCreate))
+	}
+	if r.ConditionalRead != nil {
+		e.varint(13, r.ConditionalRead.protoNumber())
+	}
+	if r.ConditionalUpdate != nil {
+		e.varint(14, protoBool(*r.ConditionalUpdate))
+	}
+	if r.ConditionalDelete != nil {
+		e.varint(15, r.ConditionalDelete.protoNumber())
+	}
+	if len(r.ReferencePolicy) > 0 {
+		var p protoEncoder
+		for _, v := range r.ReferencePolicy {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(16, p.buf)
+	}
+	for _, v := range r.SearchInclude {
+		e.string(17, v)
+	}
+	for _, v := range r.SearchRevInclude {
+		e.string(18, v)
+	}
+	for _, v := range r.SearchParam {
+		e.message(19, v)
+	}
+	for _, v := range r.Operation {
+		e.message(20, v)
+	}
+	if r.TypeElement != nil {
+		e.message(21, *r.TypeElement)
+	}
+	if r.ProfileElement != nil {
+		e.message(22, *r.ProfileElement)
+	}
+	for _, v := range r.SupportedProfileElement {
+		e.element(23, v)
+	}
+	if r.DocumentationElement != nil {
+		e.message(24, *r.DocumentationElement)
+	}
+	if r.VersioningElement != nil {
+		e.message(25, *r.VersioningElement)
+	}
+	if r.ReadHistoryElement != nil {
+		e.message(26, *r.ReadHistoryElement)
+	}
+	if r.UpdateCreateElement != nil {
+		e.message(27, *r.UpdateCreateElement)
+	}
+	if r.ConditionalCreateElement != nil {
+		e.message(28, *r.ConditionalCreateElement)
+	}
+	if r.ConditionalReadElement != nil {
+		e.message(29, *r.ConditionalReadElement)
+	}
+	if r.ConditionalUpdateElement != nil {
+		e.message(30, *r.ConditionalUpdateElement)
+	}
+	if r.ConditionalDeleteElement != nil {
+		e.message(31, *r.ConditionalDeleteElement)
+	}
+	for _, v := range r.ReferencePolicyElement {
+		e.element(32, v)
+	}
+	for _, v := range r.SearchIncludeElement {
+		e.element(33, v)
+	}
+	for _, v := range r.SearchRevIncludeElement {
+		e.element(34, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementRestResource from protobuf message CapabilityStatementRestResource of fhir.proto
+func (r *CapabilityStatementRestResource) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementRestResource{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ResourceType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			v := d.string()
+			r.Profile = &v
+		case 6:
+			r.SupportedProfile = append(r.SupportedProfile, d.string())
+		case 7:
+			v := d.string()
+			r.Documentation = &v
+		case 8:
+			var v CapabilityStatementRestResourceInteraction
+			d.message(&v)
+			r.Interaction = append(r.Interaction, v)
+		case 9:
+			var v ResourceVersionPolicy
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Versioning = &v
+		case 10:
+			v := d.bool()
+			r.ReadHistory = &v
+		case 11:
+			v := d.bool()
+			r.UpdateCreate = &v
+		case 12:
+			v := d.bool()
+			r.ConditionalCreate = &v
+		case 13:
+			var v ConditionalReadStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.ConditionalRead = &v
+		case 14:
+			v := d.bool()
+			r.ConditionalUpdate = &v
+		case 15:
+			var v ConditionalDeleteStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.ConditionalDelete = &v
+		case 16:
+			for _, n := range d.varints() {
+				var v ReferenceHandlingPolicy
+				d.enum(n, v.setProtoNumber)
+				r.ReferencePolicy = append(r.ReferencePolicy, v)
+			}
+		case 17:
+			r.SearchInclude = append(r.SearchInclude, d.string())
+		case 18:
+			r.SearchRevInclude = append(r.SearchRevInclude, d.string())
+		case 19:
+			var v CapabilityStatementRestResourceSearchParam
+			d.message(&v)
+			r.SearchParam = append(r.SearchParam, v)
+		case 20:
+			var v CapabilityStatementRestResourceOperation
+			d.message(&v)
+			r.Operation = append(r.Operation, v)
+		case 21:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ProfileElement = &v
+		case 23:
+			r.SupportedProfileElement = append(r.SupportedProfileElement, d.element())
+		case 24:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.VersioningElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ReadHistoryElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.UpdateCreateElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.ConditionalCreateElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.ConditionalReadElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ConditionalUpdateElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.ConditionalDeleteElement = &v
+		case 32:
+			r.ReferencePolicyElement = append(r.ReferencePolicyElement, d.element())
+		case 33:
+			r.SearchIncludeElement = append(r.SearchIncludeElement, d.element())
+		case 34:
+			r.SearchRevIncludeElement = append(r.SearchRevIncludeElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementRestResourceInteraction as JSON into a byte slice
+func (r CapabilityStatementRestResourceInteraction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementRestResourceInteraction as JSON object with the members in the order of the fields
+func (r CapabilityStatementRestResourceInteraction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementRestResourceInteraction from JSON. Unknown members are ignored.
+func (r *CapabilityStatementRestResourceInteraction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementRestResourceInteraction
+func (r *CapabilityStatementRestResourceInteraction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementRestResourceInteraction which shares no memory with the original
+func (r CapabilityStatementRestResourceInteraction) DeepCopy() CapabilityStatementRestResourceInteraction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementRestResourceInteraction) Equal(other CapabilityStatementRestResourceInteraction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementRestResourceInteraction) EqualsDeep(other CapabilityStatementRestResourceInteraction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementRestResourceInteraction) EqualsShallow(other CapabilityStatementRestResourceInteraction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementRestResourceInteraction as FHIR XML
+func (r CapabilityStatementRestResourceInteraction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementRestResourceInteraction from FHIR XML
+func (r *CapabilityStatementRestResourceInteraction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v TypeRestfulInteraction
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementRestResourceInteraction as properties to the RDF node n
+func (r CapabilityStatementRestResourceInteraction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.rest.resource.interaction.code", -1, r.Code, r.CodeElement, "code")
+	n.primitive("CapabilityStatement.rest.resource.interaction.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+}
+
+// MarshalBSON marshals the given CapabilityStatementRestResourceInteraction as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementRestResourceInteraction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementRestResourceInteraction from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementRestResourceInteraction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementRestResourceInteraction as protobuf message CapabilityStatementRestResourceInteraction of fhir.proto
+func (r CapabilityStatementRestResourceInteraction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementRestResourceInteraction to the encoded message
+func (r CapabilityStatementRestResourceInteraction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Code.protoNumber())
+	if r.Documentation != nil {
+		e.string(5, *r.Documentation)
+	}
+	if r.CodeElement != nil {
+		e.message(6, *r.CodeElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(7, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementRestResourceInteraction from protobuf message CapabilityStatementRestResourceInteraction of fhir.proto
+func (r *CapabilityStatementRestResourceInteraction) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementRestResourceInteraction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TypeRestfulInteraction
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 5:
+			v := d.string()
+			r.Documentation = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementRestResourceSearchParam as JSON into a byte slice
+func (r CapabilityStatementRestResourceSearchParam) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementRestResourceSearchParam as JSON object with the members in the order of the fields
+func (r CapabilityStatementRestResourceSearchParam) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Definition != nil {
+		e.key("definition")
+		e.string(*r.Definition)
+	}
+	if r.DefinitionElement != nil {
+		e.key("_definition")
+		r.DefinitionElement.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementRestResourceSearchParam from JSON. Unknown members are ignored.
+func (r *CapabilityStatementRestResourceSearchParam) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementRestResourceSearchParam
+func (r *CapabilityStatementRestResourceSearchParam) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "definition":
+			if v, ok := d.string(); ok {
+				r.Definition = &v
+			} else {
+				r.Definition = nil
+			}
+		case "_definition":
+			r.DefinitionElement = d.element()
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementRestResourceSearchParam which shares no memory with the original
+func (r CapabilityStatementRestResourceSearchParam) DeepCopy() CapabilityStatementRestResourceSearchParam {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Definition != nil {
+		v := *r.Definition
+		out.Definition = &v
+	}
+	if r.DefinitionElement != nil {
+		v := r.DefinitionElement.DeepCopy()
+		out.DefinitionElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementRestResourceSearchParam) Equal(other CapabilityStatementRestResourceSearchParam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && *r.Definition != *other.Definition {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.Equal(*other.DefinitionElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementRestResourceSearchParam) EqualsDeep(other CapabilityStatementRestResourceSearchParam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.EqualsDeep(*other.DefinitionElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementRestResourceSearchParam) EqualsShallow(other CapabilityStatementRestResourceSearchParam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementRestResourceSearchParam as FHIR XML
+func (r CapabilityStatementRestResourceSearchParam) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementRestResourceSearchParam from FHIR XML
+func (r *CapabilityStatementRestResourceSearchParam) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "definition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Definition = &v
+				}
+				r.DefinitionElement = element
+			case "type":
+				var v SearchParamType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementRestResourceSearchParam as properties to the RDF node n
+func (r CapabilityStatementRestResourceSearchParam) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.rest.resource.searchParam.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("CapabilityStatement.rest.resource.searchParam.definition", -1, r.Definition, r.DefinitionElement, "string")
+	n.primitive("CapabilityStatement.rest.resource.searchParam.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("CapabilityStatement.rest.resource.searchParam.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+}
+
+// MarshalBSON marshals the given CapabilityStatementRestResourceSearchParam as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementRestResourceSearchParam) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementRestResourceSearchParam from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementRestResourceSearchParam) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementRestResourceSearchParam as protobuf message CapabilityStatementRestResourceSearchParam of fhir.proto
+func (r CapabilityStatementRestResourceSearchParam) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementRestResourceSearchParam to the encoded message
+func (r CapabilityStatementRestResourceSearchParam) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Definition != nil {
+		e.string(5, *r.Definition)
+	}
+	e.varint(6, r.Type.protoNumber())
+	if r.Documentation != nil {
+		e.string(7, *r.Documentation)
+	}
+	if r.NameElement != nil {
+		e.message(8, *r.NameElement)
+	}
+	if r.DefinitionElement != nil {
+		e.message(9, *r.DefinitionElement)
+	}
+	if r.TypeElement != nil {
+		e.message(10, *r.TypeElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(11, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementRestResourceSearchParam from protobuf message CapabilityStatementRestResourceSearchParam of fhir.proto
+func (r *CapabilityStatementRestResourceSearchParam) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementRestResourceSearchParam{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.Definition = &v
+		case 6:
+			var v SearchParamType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 7:
+			v := d.string()
+			r.Documentation = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DefinitionElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementRestResourceOperation as JSON into a byte slice
+func (r CapabilityStatementRestResourceOperation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementRestResourceOperation as JSON object with the members in the order of the fields
+func (r CapabilityStatementRestResourceOperation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("definition")
+	e.string(r.Definition)
+	if r.DefinitionElement != nil {
+		e.key("_definition")
+		r.DefinitionElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementRestResourceOperation from JSON. Unknown members are ignored.
+func (r *CapabilityStatementRestResourceOperation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementRestResourceOperation
+func (r *CapabilityStatementRestResourceOperation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "definition":
+			if v, ok := d.string(); ok {
+				r.Definition = v
+			}
+		case "_definition":
+			r.DefinitionElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementRestResourceOperation which shares no memory with the original
+func (r CapabilityStatementRestResourceOperation) DeepCopy() CapabilityStatementRestResourceOperation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.DefinitionElement != nil {
+		v := r.DefinitionElement.DeepCopy()
+		out.DefinitionElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementRestResourceOperation) Equal(other CapabilityStatementRestResourceOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Definition != other.Definition {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.Equal(*other.DefinitionElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementRestResourceOperation) EqualsDeep(other CapabilityStatementRestResourceOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if !equivalentString(r.Definition, other.Definition) {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.EqualsDeep(*other.DefinitionElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementRestResourceOperation) EqualsShallow(other CapabilityStatementRestResourceOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if !equivalentString(r.Definition, other.Definition) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementRestResourceOperation as FHIR XML
+func (r CapabilityStatementRestResourceOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementRestResourceOperation from FHIR XML
+func (r *CapabilityStatementRestResourceOperation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "definition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Definition = v
+				}
+				r.DefinitionElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementRestResourceOperation as properties to the RDF node n
+func (r CapabilityStatementRestResourceOperation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.rest.resource.operation.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("CapabilityStatement.rest.resource.operation.definition", -1, r.Definition, r.DefinitionElement, "string")
+	n.primitive("CapabilityStatement.rest.resource.operation.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+}
+
+// MarshalBSON marshals the given CapabilityStatementRestResourceOperation as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementRestResourceOperation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementRestResourceOperation from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementRestResourceOperation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementRestResourceOperation as protobuf message CapabilityStatementRestResourceOperation of fhir.proto
+func (r CapabilityStatementRestResourceOperation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementRestResourceOperation to the encoded message
+func (r CapabilityStatementRestResourceOperation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Definition != "" {
+		e.string(5, r.Definition)
+	}
+	if r.Documentation != nil {
+		e.string(6, *r.Documentation)
+	}
+	if r.NameElement != nil {
+		e.message(7, *r.NameElement)
+	}
+	if r.DefinitionElement != nil {
+		e.message(8, *r.DefinitionElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(9, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementRestResourceOperation from protobuf message CapabilityStatementRestResourceOperation of fhir.proto
+func (r *CapabilityStatementRestResourceOperation) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementRestResourceOperation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			r.Definition = d.string()
+		case 6:
+			v := d.string()
+			r.Documentation = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DefinitionElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementRestInteraction as JSON into a byte slice
+func (r CapabilityStatementRestInteraction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementRestInteraction as JSON object with the members in the order of the fields
+func (r CapabilityStatementRestInteraction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementRestInteraction from JSON. Unknown members are ignored.
+func (r *CapabilityStatementRestInteraction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementRestInteraction
+func (r *CapabilityStatementRestInteraction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementRestInteraction which shares no memory with the original
+func (r CapabilityStatementRestInteraction) DeepCopy() CapabilityStatementRestInteraction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementRestInteraction) Equal(other CapabilityStatementRestInteraction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementRestInteraction) EqualsDeep(other CapabilityStatementRestInteraction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementRestInteraction) EqualsShallow(other CapabilityStatementRestInteraction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementRestInteraction as FHIR XML
+func (r CapabilityStatementRestInteraction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementRestInteraction from FHIR XML
+func (r *CapabilityStatementRestInteraction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v SystemRestfulInteraction
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementRestInteraction as properties to the RDF node n
+func (r CapabilityStatementRestInteraction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.rest.interaction.code", -1, r.Code, r.CodeElement, "code")
+	n.primitive("CapabilityStatement.rest.interaction.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+}
+
+// MarshalBSON marshals the given CapabilityStatementRestInteraction as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementRestInteraction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementRestInteraction from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementRestInteraction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementRestInteraction as protobuf message CapabilityStatementRestInteraction of fhir.proto
+func (r CapabilityStatementRestInteraction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementRestInteraction to the encoded message
+func (r CapabilityStatementRestInteraction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Code.protoNumber())
+	if r.Documentation != nil {
+		e.string(5, *r.Documentation)
+	}
+	if r.CodeElement != nil {
+		e.message(6, *r.CodeElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(7, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementRestInteraction from protobuf message CapabilityStatementRestInteraction of fhir.proto
+func (r *CapabilityStatementRestInteraction) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementRestInteraction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v SystemRestfulInteraction
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 5:
+			v := d.string()
+			r.Documentation = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementMessaging as JSON into a byte slice
+func (r CapabilityStatementMessaging) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementMessaging as JSON object with the members in the order of the fields
+func (r CapabilityStatementMessaging) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endpoint) > 0 {
+		e.key("endpoint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endpoint {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ReliableCache != nil {
+		e.key("reliableCache")
+		e.int(*r.ReliableCache)
+	}
+	if r.ReliableCacheElement != nil {
+		e.key("_reliableCache")
+		r.ReliableCacheElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	if len(r.SupportedMessage) > 0 {
+		e.key("supportedMessage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportedMessage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementMessaging from JSON. Unknown members are ignored.
+func (r *CapabilityStatementMessaging) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementMessaging
+func (r *CapabilityStatementMessaging) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "endpoint":
+			a := d.array()
+			r.Endpoint = nil
+			for a.next() {
+				var v CapabilityStatementMessagingEndpoint
+				v.decodeJSON(d)
+				r.Endpoint = append(r.Endpoint, v)
+			}
+			if r.Endpoint == nil && !a.isNull {
+				r.Endpoint = []CapabilityStatementMessagingEndpoint{}
+			}
+		case "reliableCache":
+			if v, ok := d.int(); ok {
+				r.ReliableCache = &v
+			} else {
+				r.ReliableCache = nil
+			}
+		case "_reliableCache":
+			r.ReliableCacheElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		case "supportedMessage":
+			a := d.array()
+			r.SupportedMessage = nil
+			for a.next() {
+				var v CapabilityStatementMessagingSupportedMessage
+				v.decodeJSON(d)
+				r.SupportedMessage = append(r.SupportedMessage, v)
+			}
+			if r.SupportedMessage == nil && !a.isNull {
+				r.SupportedMessage = []CapabilityStatementMessagingSupportedMessage{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementMessaging which shares no memory with the original
+func (r CapabilityStatementMessaging) DeepCopy() CapabilityStatementMessaging {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Endpoint != nil {
+		out.Endpoint = make([]CapabilityStatementMessagingEndpoint, len(r.Endpoint))
+		for i := range r.Endpoint {
+			out.Endpoint[i] = r.Endpoint[i].DeepCopy()
+		}
+	}
+	if r.ReliableCache != nil {
+		v := *r.ReliableCache
+		out.ReliableCache = &v
+	}
+	if r.ReliableCacheElement != nil {
+		v := r.ReliableCacheElement.DeepCopy()
+		out.ReliableCacheElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	if r.SupportedMessage != nil {
+		out.SupportedMessage = make([]CapabilityStatementMessagingSupportedMessage, len(r.SupportedMessage))
+		for i := range r.SupportedMessage {
+			out.SupportedMessage[i] = r.SupportedMessage[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementMessaging) Equal(other CapabilityStatementMessaging) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Endpoint) != len(other.Endpoint) {
+		return false
+	}
+	for i := range r.Endpoint {
+		if !r.Endpoint[i].Equal(other.Endpoint[i]) {
+			return false
+		}
+	}
+	if (r.ReliableCache == nil) != (other.ReliableCache == nil) || r.ReliableCache != nil && *r.ReliableCache != *other.ReliableCache {
+		return false
+	}
+	if (r.ReliableCacheElement == nil) != (other.ReliableCacheElement == nil) || r.ReliableCacheElement != nil && !r.ReliableCacheElement.Equal(*other.ReliableCacheElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	if len(r.SupportedMessage) != len(other.SupportedMessage) {
+		return false
+	}
+	for i := range r.SupportedMessage {
+		if !r.SupportedMessage[i].Equal(other.SupportedMessage[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementMessaging) EqualsDeep(other CapabilityStatementMessaging) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endpoint), len(other.Endpoint), func(i, j int) bool {
+		return r.Endpoint[i].EqualsDeep(other.Endpoint[j])
+	}) {
+		return false
+	}
+	if (r.ReliableCache == nil) != (other.ReliableCache == nil) || r.ReliableCache != nil && *r.ReliableCache != *other.ReliableCache {
+		return false
+	}
+	if (r.ReliableCacheElement == nil) != (other.ReliableCacheElement == nil) || r.ReliableCacheElement != nil && !r.ReliableCacheElement.EqualsDeep(*other.ReliableCacheElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	if !equivalentList(len(r.SupportedMessage), len(other.SupportedMessage), func(i, j int) bool {
+		return r.SupportedMessage[i].EqualsDeep(other.SupportedMessage[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementMessaging) EqualsShallow(other CapabilityStatementMessaging) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ReliableCache == nil) != (other.ReliableCache == nil) || r.ReliableCache != nil && *r.ReliableCache != *other.ReliableCache {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementMessaging as FHIR XML
+func (r CapabilityStatementMessaging) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endpoint {
+		if err := encodeXMLElement(e, "endpoint", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "reliableCache", r.ReliableCache, r.ReliableCacheElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	for _, v := range r.SupportedMessage {
+		if err := encodeXMLElement(e, "supportedMessage", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementMessaging from FHIR XML
+func (r *CapabilityStatementMessaging) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "endpoint":
+				var v CapabilityStatementMessagingEndpoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endpoint = append(r.Endpoint, v)
+			case "reliableCache":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReliableCache = &v
+				}
+				r.ReliableCacheElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			case "supportedMessage":
+				var v CapabilityStatementMessagingSupportedMessage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportedMessage = append(r.SupportedMessage, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementMessaging as properties to the RDF node n
+func (r CapabilityStatementMessaging) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Endpoint {
+		n.element("CapabilityStatement.messaging.endpoint", i, v)
+	}
+	n.primitive("CapabilityStatement.messaging.reliableCache", -1, r.ReliableCache, r.ReliableCacheElement, "unsignedInt")
+	n.primitive("CapabilityStatement.messaging.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+	for i, v := range r.SupportedMessage {
+		n.element("CapabilityStatement.messaging.supportedMessage", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CapabilityStatementMessaging as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementMessaging) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementMessaging from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementMessaging) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementMessaging as protobuf message CapabilityStatementMessaging of fhir.proto
+func (r CapabilityStatementMessaging) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementMessaging to the encoded message
+func (r CapabilityStatementMessaging) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Endpoint {
+		e.message(4, v)
+	}
+	if r.ReliableCache != nil {
+		e.varint(5, uint64(int64(*r.ReliableCache)))
+	}
+	if r.Documentation != nil {
+		e.string(6, *r.Documentation)
+	}
+	for _, v := range r.SupportedMessage {
+		e.message(7, v)
+	}
+	if r.ReliableCacheElement != nil {
+		e.message(8, *r.ReliableCacheElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(9, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementMessaging from protobuf message CapabilityStatementMessaging of fhir.proto
+func (r *CapabilityStatementMessaging) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementMessaging{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CapabilityStatementMessagingEndpoint
+			d.message(&v)
+			r.Endpoint = append(r.Endpoint, v)
+		case 5:
+			v := d.int()
+			r.ReliableCache = &v
+		case 6:
+			v := d.string()
+			r.Documentation = &v
+		case 7:
+			var v CapabilityStatementMessagingSupportedMessage
+			d.message(&v)
+			r.SupportedMessage = append(r.SupportedMessage, v)
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ReliableCacheElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementMessagingEndpoint as JSON into a byte slice
+func (r CapabilityStatementMessagingEndpoint) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementMessagingEndpoint as JSON object with the members in the order of the fields
+func (r CapabilityStatementMessagingEndpoint) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("protocol")
+	r.Protocol.appendJSON(e)
+	e.key("address")
+	e.string(r.Address)
+	if r.AddressElement != nil {
+		e.key("_address")
+		r.AddressElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementMessagingEndpoint from JSON. Unknown members are ignored.
+func (r *CapabilityStatementMessagingEndpoint) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementMessagingEndpoint
+func (r *CapabilityStatementMessagingEndpoint) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "protocol":
+			r.Protocol.decodeJSON(d)
+		case "address":
+			if v, ok := d.string(); ok {
+				r.Address = v
+			}
+		case "_address":
+			r.AddressElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementMessagingEndpoint which shares no memory with the original
+func (r CapabilityStatementMessagingEndpoint) DeepCopy() CapabilityStatementMessagingEndpoint {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Protocol = r.Protocol.DeepCopy()
+	if r.AddressElement != nil {
+		v := r.AddressElement.DeepCopy()
+		out.AddressElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementMessagingEndpoint) Equal(other CapabilityStatementMessagingEndpoint) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Protocol.Equal(other.Protocol) {
+		return false
+	}
+	if r.Address != other.Address {
+		return false
+	}
+	if (r.AddressElement == nil) != (other.AddressElement == nil) || r.AddressElement != nil && !r.AddressElement.Equal(*other.AddressElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementMessagingEndpoint) EqualsDeep(other CapabilityStatementMessagingEndpoint) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Protocol.EqualsDeep(other.Protocol) {
+		return false
+	}
+	if !equivalentString(r.Address, other.Address) {
+		return false
+	}
+	if (r.AddressElement == nil) != (other.AddressElement == nil) || r.AddressElement != nil && !r.AddressElement.EqualsDeep(*other.AddressElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementMessagingEndpoint) EqualsShallow(other CapabilityStatementMessagingEndpoint) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Address, other.Address) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementMessagingEndpoint as FHIR XML
+func (r CapabilityStatementMessagingEndpoint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "protocol", r.Protocol); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "address", r.Address, r.AddressElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementMessagingEndpoint from FHIR XML
+func (r *CapabilityStatementMessagingEndpoint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "protocol":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Protocol = v
+			case "address":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Address = v
+				}
+				r.AddressElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementMessagingEndpoint as properties to the RDF node n
+func (r CapabilityStatementMessagingEndpoint) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("CapabilityStatement.messaging.endpoint.protocol", -1, r.Protocol)
+	n.primitive("CapabilityStatement.messaging.endpoint.address", -1, r.Address, r.AddressElement, "string")
+}
+
+// MarshalBSON marshals the given CapabilityStatementMessagingEndpoint as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementMessagingEndpoint) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementMessagingEndpoint from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementMessagingEndpoint) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementMessagingEndpoint as protobuf message CapabilityStatementMessagingEndpoint of fhir.proto
+func (r CapabilityStatementMessagingEndpoint) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementMessagingEndpoint to the encoded message
+func (r CapabilityStatementMessagingEndpoint) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Protocol)
+	if r.Address != "" {
+		e.string(5, r.Address)
+	}
+	if r.AddressElement != nil {
+		e.message(6, *r.AddressElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementMessagingEndpoint from protobuf message CapabilityStatementMessagingEndpoint of fhir.proto
+func (r *CapabilityStatementMessagingEndpoint) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementMessagingEndpoint{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Coding
+			d.message(&v)
+			r.Protocol = v
+		case 5:
+			r.Address = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.AddressElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementMessagingSupportedMessage as JSON into a byte slice
+func (r CapabilityStatementMessagingSupportedMessage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementMessagingSupportedMessage as JSON object with the members in the order of the fields
+func (r CapabilityStatementMessagingSupportedMessage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("mode")
+	e.string(r.Mode.Code())
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	e.key("definition")
+	e.string(r.Definition)
+	if r.DefinitionElement != nil {
+		e.key("_definition")
+		r.DefinitionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementMessagingSupportedMessage from JSON. Unknown members are ignored.
+func (r *CapabilityStatementMessagingSupportedMessage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementMessagingSupportedMessage
+func (r *CapabilityStatementMessagingSupportedMessage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "mode":
+			d.unmarshal(&r.Mode)
+		case "_mode":
+			r.ModeElement = d.element()
+		case "definition":
+			if v, ok := d.string(); ok {
+				r.Definition = v
+			}
+		case "_definition":
+			r.DefinitionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementMessagingSupportedMessage which shares no memory with the original
+func (r CapabilityStatementMessagingSupportedMessage) DeepCopy() CapabilityStatementMessagingSupportedMessage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.DefinitionElement != nil {
+		v := r.DefinitionElement.DeepCopy()
+		out.DefinitionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementMessagingSupportedMessage) Equal(other CapabilityStatementMessagingSupportedMessage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if r.Definition != other.Definition {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.Equal(*other.DefinitionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementMessagingSupportedMessage) EqualsDeep(other CapabilityStatementMessagingSupportedMessage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if !equivalentString(r.Definition, other.Definition) {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.EqualsDeep(*other.DefinitionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementMessagingSupportedMessage) EqualsShallow(other CapabilityStatementMessagingSupportedMessage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if !equivalentString(r.Definition, other.Definition) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementMessagingSupportedMessage as FHIR XML
+func (r CapabilityStatementMessagingSupportedMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementMessagingSupportedMessage from FHIR XML
+func (r *CapabilityStatementMessagingSupportedMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "mode":
+				var v EventCapabilityMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = v
+				}
+				r.ModeElement = element
+			case "definition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Definition = v
+				}
+				r.DefinitionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementMessagingSupportedMessage as properties to the RDF node n
+func (r CapabilityStatementMessagingSupportedMessage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.messaging.supportedMessage.mode", -1, r.Mode, r.ModeElement, "code")
+	n.primitive("CapabilityStatement.messaging.supportedMessage.definition", -1, r.Definition, r.DefinitionElement, "string")
+}
+
+// MarshalBSON marshals the given CapabilityStatementMessagingSupportedMessage as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementMessagingSupportedMessage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementMessagingSupportedMessage from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementMessagingSupportedMessage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementMessagingSupportedMessage as protobuf message CapabilityStatementMessagingSupportedMessage of fhir.proto
+func (r CapabilityStatementMessagingSupportedMessage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementMessagingSupportedMessage to the encoded message
+func (r CapabilityStatementMessagingSupportedMessage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Mode.protoNumber())
+	if r.Definition != "" {
+		e.string(5, r.Definition)
+	}
+	if r.ModeElement != nil {
+		e.message(6, *r.ModeElement)
+	}
+	if r.DefinitionElement != nil {
+		e.message(7, *r.DefinitionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementMessagingSupportedMessage from protobuf message CapabilityStatementMessagingSupportedMessage of fhir.proto
+func (r *CapabilityStatementMessagingSupportedMessage) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementMessagingSupportedMessage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v EventCapabilityMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = v
+		case 5:
+			r.Definition = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DefinitionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CapabilityStatementDocument as JSON into a byte slice
+func (r CapabilityStatementDocument) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CapabilityStatementDocument as JSON object with the members in the order of the fields
+func (r CapabilityStatementDocument) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("mode")
+	e.string(r.Mode.Code())
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.key("profile")
+	e.string(r.Profile)
+	if r.ProfileElement != nil {
+		e.key("_profile")
+		r.ProfileElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CapabilityStatementDocument from JSON. Unknown members are ignored.
+func (r *CapabilityStatementDocument) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CapabilityStatementDocument
+func (r *CapabilityStatementDocument) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "mode":
+			d.unmarshal(&r.Mode)
+		case "_mode":
+			r.ModeElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		case "profile":
+			if v, ok := d.string(); ok {
+				r.Profile = v
+			}
+		case "_profile":
+			r.ProfileElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CapabilityStatementDocument which shares no memory with the original
+func (r CapabilityStatementDocument) DeepCopy() CapabilityStatementDocument {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	if r.ProfileElement != nil {
+		v := r.ProfileElement.DeepCopy()
+		out.ProfileElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CapabilityStatementDocument) Equal(other CapabilityStatementDocument) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	if r.Profile != other.Profile {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.Equal(*other.ProfileElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CapabilityStatementDocument) EqualsDeep(other CapabilityStatementDocument) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	if !equivalentString(r.Profile, other.Profile) {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.EqualsDeep(*other.ProfileElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CapabilityStatementDocument) EqualsShallow(other CapabilityStatementDocument) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if !equivalentString(r.Profile, other.Profile) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CapabilityStatementDocument as FHIR XML
+func (r CapabilityStatementDocument) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "profile", r.Profile, r.ProfileElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CapabilityStatementDocument from FHIR XML
+func (r *CapabilityStatementDocument) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "mode":
+				var v DocumentMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = v
+				}
+				r.ModeElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Profile = v
+				}
+				r.ProfileElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CapabilityStatementDocument as properties to the RDF node n
+func (r CapabilityStatementDocument) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CapabilityStatement.document.mode", -1, r.Mode, r.ModeElement, "code")
+	n.primitive("CapabilityStatement.document.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+	n.primitive("CapabilityStatement.document.profile", -1, r.Profile, r.ProfileElement, "string")
+}
+
+// MarshalBSON marshals the given CapabilityStatementDocument as BSON document with the structure of its FHIR JSON
+func (r CapabilityStatementDocument) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CapabilityStatementDocument from a BSON document with the structure of its FHIR JSON
+func (r *CapabilityStatementDocument) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CapabilityStatementDocument as protobuf message CapabilityStatementDocument of fhir.proto
+func (r CapabilityStatementDocument) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CapabilityStatementDocument to the encoded message
+func (r CapabilityStatementDocument) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Mode.protoNumber())
+	if r.Documentation != nil {
+		e.string(5, *r.Documentation)
+	}
+	if r.Profile != "" {
+		e.string(6, r.Profile)
+	}
+	if r.ModeElement != nil {
+		e.message(7, *r.ModeElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(8, *r.DocumentationElement)
+	}
+	if r.ProfileElement != nil {
+		e.message(9, *r.ProfileElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CapabilityStatementDocument from protobuf message CapabilityStatementDocument of fhir.proto
+func (r *CapabilityStatementDocument) UnmarshalProto(b []byte) error {
+	*r = CapabilityStatementDocument{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v DocumentMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = v
+		case 5:
+			v := d.string()
+			r.Documentation = &v
+		case 6:
+			r.Profile = d.string()
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ProfileElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// UnmarshalCapabilityStatement unmarshals a CapabilityStatement.
+func UnmarshalCapabilityStatement(b []byte) (CapabilityStatement, error) {
+	var capabilityStatement CapabilityStatement
+	if err := capabilityStatement.UnmarshalJSON(b); err != nil {
+		return capabilityStatement, err
+	}
+	return capabilityStatement, nil
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code CodeSystemContentMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CodeSystemContentMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CodeSystemContentMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "not-present":
 		*code = CodeSystemContentModeNotPresent
@@ -55,6 +59,12 @@ func (code *CodeSystemContentMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CodeSystemContentMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CodeSystemContentMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CodeSystemContentMode) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code CodeSystemContentMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CodeSystemContentMode) protoNumber() uint64 {
+	switch code {
+	case CodeSystemContentModeNotPresent:
+		return 1
+	case CodeSystemContentModeExample:
+		return 2
+	case CodeSystemContentModeFragment:
+		return 3
+	case CodeSystemContentModeComplete:
+		return 4
+	case CodeSystemContentModeSupplement:
+		return 5
+	}
+	return 0
+}
+func (code *CodeSystemContentMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CodeSystemContentModeNotPresent
+	case 2:
+		*code = CodeSystemContentModeExample
+	case 3:
+		*code = CodeSystemContentModeFragment
+	case 4:
+		*code = CodeSystemContentModeComplete
+	case 5:
+		*code = CodeSystemContentModeSupplement
+	default:
+		return false
+	}
+	return true
+}
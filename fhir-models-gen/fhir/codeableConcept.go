@@ -14,13 +14,367 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // CodeableConcept is documented here http://hl7.org/fhir/StructureDefinition/CodeableConcept
 type CodeableConcept struct {
-	Id        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Coding    []Coding    `bson:"coding,omitempty" json:"coding,omitempty"`
-	Text      *string     `bson:"text,omitempty" json:"text,omitempty"`
+	Id          *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Coding      []Coding    `bson:"coding,omitempty" json:"coding,omitempty"`
+	Text        *string     `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement *Element    `bson:"_text,omitempty" json:"_text,omitempty"`
+}
+
+// MarshalJSON marshals the given CodeableConcept as JSON into a byte slice
+func (r CodeableConcept) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CodeableConcept as JSON object with the members in the order of the fields
+func (r CodeableConcept) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Coding) > 0 {
+		e.key("coding")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Coding {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CodeableConcept from JSON. Unknown members are ignored.
+func (r *CodeableConcept) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CodeableConcept
+func (r *CodeableConcept) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "coding":
+			a := d.array()
+			r.Coding = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Coding = append(r.Coding, v)
+			}
+			if r.Coding == nil && !a.isNull {
+				r.Coding = []Coding{}
+			}
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CodeableConcept which shares no memory with the original
+func (r CodeableConcept) DeepCopy() CodeableConcept {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Coding != nil {
+		out.Coding = make([]Coding, len(r.Coding))
+		for i := range r.Coding {
+			out.Coding[i] = r.Coding[i].DeepCopy()
+		}
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CodeableConcept) Equal(other CodeableConcept) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.Coding) != len(other.Coding) {
+		return false
+	}
+	for i := range r.Coding {
+		if !r.Coding[i].Equal(other.Coding[i]) {
+			return false
+		}
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CodeableConcept) EqualsDeep(other CodeableConcept) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Coding), len(other.Coding), func(i, j int) bool {
+		return r.Coding[i].EqualsDeep(other.Coding[j])
+	}) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CodeableConcept) EqualsShallow(other CodeableConcept) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CodeableConcept as FHIR XML
+func (r CodeableConcept) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Coding {
+		if err := encodeXMLElement(e, "coding", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CodeableConcept from FHIR XML
+func (r *CodeableConcept) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "coding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coding = append(r.Coding, v)
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CodeableConcept as properties to the RDF node n
+func (r CodeableConcept) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.Coding {
+		n.element("CodeableConcept.coding", i, v)
+	}
+	n.primitive("CodeableConcept.text", -1, r.Text, r.TextElement, "string")
+}
+
+// MarshalBSON marshals the given CodeableConcept as BSON document with the structure of its FHIR JSON
+func (r CodeableConcept) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CodeableConcept from a BSON document with the structure of its FHIR JSON
+func (r *CodeableConcept) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CodeableConcept as protobuf message CodeableConcept of fhir.proto
+func (r CodeableConcept) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CodeableConcept to the encoded message
+func (r CodeableConcept) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.Coding {
+		e.message(3, v)
+	}
+	if r.Text != nil {
+		e.string(4, *r.Text)
+	}
+	if r.TextElement != nil {
+		e.message(5, *r.TextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CodeableConcept from protobuf message CodeableConcept of fhir.proto
+func (r *CodeableConcept) UnmarshalProto(b []byte) error {
+	*r = CodeableConcept{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Coding
+			d.message(&v)
+			r.Coding = append(r.Coding, v)
+		case 4:
+			v := d.string()
+			r.Text = &v
+		case 5:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -0,0 +1,134 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// ConditionalReadStatus is documented here http://hl7.org/fhir/ValueSet/conditional-read-status
+type ConditionalReadStatus int
+
+const (
+	ConditionalReadStatusNotSupported ConditionalReadStatus = iota
+	ConditionalReadStatusModifiedSince
+	ConditionalReadStatusNotMatch
+	ConditionalReadStatusFullSupport
+)
+
+func (code ConditionalReadStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(code.Code())
+}
+func (code *ConditionalReadStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
+	switch s {
+	case "not-supported":
+		*code = ConditionalReadStatusNotSupported
+	case "modified-since":
+		*code = ConditionalReadStatusModifiedSince
+	case "not-match":
+		*code = ConditionalReadStatusNotMatch
+	case "full-support":
+		*code = ConditionalReadStatusFullSupport
+	default:
+		return fmt.Errorf("unknown ConditionalReadStatus code `%s`", s)
+	}
+	return nil
+}
+func (code ConditionalReadStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ConditionalReadStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
+func (code ConditionalReadStatus) String() string {
+	return code.Code()
+}
+func (code ConditionalReadStatus) Code() string {
+	switch code {
+	case ConditionalReadStatusNotSupported:
+		return "not-supported"
+	case ConditionalReadStatusModifiedSince:
+		return "modified-since"
+	case ConditionalReadStatusNotMatch:
+		return "not-match"
+	case ConditionalReadStatusFullSupport:
+		return "full-support"
+	}
+	return "<unknown>"
+}
+func (code ConditionalReadStatus) Display() string {
+	switch code {
+	case ConditionalReadStatusNotSupported:
+		return "Not Supported"
+	case ConditionalReadStatusModifiedSince:
+		return "If-Modified-Since"
+	case ConditionalReadStatusNotMatch:
+		return "If-None-Match"
+	case ConditionalReadStatusFullSupport:
+		return "Full Support"
+	}
+	return "<unknown>"
+}
+func (code ConditionalReadStatus) Definition() string {
+	switch code {
+	case ConditionalReadStatusNotSupported:
+		return "No support for conditional reads."
+	case ConditionalReadStatusModifiedSince:
+		return "Conditional reads are supported, but only with the If-Modified-Since HTTP Header."
+	case ConditionalReadStatusNotMatch:
+		return "Conditional reads are supported, but only with the If-None-Match HTTP Header."
+	case ConditionalReadStatusFullSupport:
+		return "Conditional reads are supported, with both If-Modified-Since and If-None-Match HTTP Headers."
+	}
+	return "<unknown>"
+}
+func (code ConditionalReadStatus) protoNumber() uint64 {
+	switch code {
+	case ConditionalReadStatusNotSupported:
+		return 1
+	case ConditionalReadStatusModifiedSince:
+		return 2
+	case ConditionalReadStatusNotMatch:
+		return 3
+	case ConditionalReadStatusFullSupport:
+		return 4
+	}
+	return 0
+}
+func (code *ConditionalReadStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ConditionalReadStatusNotSupported
+	case 2:
+		*code = ConditionalReadStatusModifiedSince
+	case 3:
+		*code = ConditionalReadStatusNotMatch
+	case 4:
+		*code = ConditionalReadStatusFullSupport
+	default:
+		return false
+	}
+	return true
+}
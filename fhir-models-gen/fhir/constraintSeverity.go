@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code ConstraintSeverity) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ConstraintSeverity) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ConstraintSeverity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "error":
 		*code = ConstraintSeverityError
@@ -46,6 +50,12 @@ func (code *ConstraintSeverity) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ConstraintSeverity) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ConstraintSeverity) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ConstraintSeverity) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code ConstraintSeverity) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ConstraintSeverity) protoNumber() uint64 {
+	switch code {
+	case ConstraintSeverityError:
+		return 1
+	case ConstraintSeverityWarning:
+		return 2
+	}
+	return 0
+}
+func (code *ConstraintSeverity) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ConstraintSeverityError
+	case 2:
+		*code = ConstraintSeverityWarning
+	default:
+		return false
+	}
+	return true
+}
@@ -14,13 +14,367 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ContactDetail is documented here http://hl7.org/fhir/StructureDefinition/ContactDetail
 type ContactDetail struct {
-	Id        *string        `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
-	Name      *string        `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom   []ContactPoint `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Id          *string        `bson:"id,omitempty" json:"id,omitempty"`
+	Extension   []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
+	Name        *string        `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement *Element       `bson:"_name,omitempty" json:"_name,omitempty"`
+	Telecom     []ContactPoint `bson:"telecom,omitempty" json:"telecom,omitempty"`
+}
+
+// MarshalJSON marshals the given ContactDetail as JSON into a byte slice
+func (r ContactDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContactDetail as JSON object with the members in the order of the fields
+func (r ContactDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContactDetail from JSON. Unknown members are ignored.
+func (r *ContactDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContactDetail
+func (r *ContactDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContactDetail which shares no memory with the original
+func (r ContactDetail) DeepCopy() ContactDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContactDetail) Equal(other ContactDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContactDetail) EqualsDeep(other ContactDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContactDetail) EqualsShallow(other ContactDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContactDetail as FHIR XML
+func (r ContactDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContactDetail from FHIR XML
+func (r *ContactDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContactDetail as properties to the RDF node n
+func (r ContactDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("ContactDetail.name", -1, r.Name, r.NameElement, "string")
+	for i, v := range r.Telecom {
+		n.element("ContactDetail.telecom", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ContactDetail as BSON document with the structure of its FHIR JSON
+func (r ContactDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContactDetail from a BSON document with the structure of its FHIR JSON
+func (r *ContactDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContactDetail as protobuf message ContactDetail of fhir.proto
+func (r ContactDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContactDetail to the encoded message
+func (r ContactDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Name != nil {
+		e.string(3, *r.Name)
+	}
+	for _, v := range r.Telecom {
+		e.message(4, v)
+	}
+	if r.NameElement != nil {
+		e.message(5, *r.NameElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContactDetail from protobuf message ContactDetail of fhir.proto
+func (r *ContactDetail) UnmarshalProto(b []byte) error {
+	*r = ContactDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.Name = &v
+		case 4:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 5:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
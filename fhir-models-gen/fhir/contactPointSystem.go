@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code ContactPointSystem) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ContactPointSystem) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ContactPointSystem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "phone":
 		*code = ContactPointSystemPhone
@@ -61,6 +65,12 @@ func (code *ContactPointSystem) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ContactPointSystem) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ContactPointSystem) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ContactPointSystem) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code ContactPointSystem) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ContactPointSystem) protoNumber() uint64 {
+	switch code {
+	case ContactPointSystemPhone:
+		return 1
+	case ContactPointSystemFax:
+		return 2
+	case ContactPointSystemEmail:
+		return 3
+	case ContactPointSystemPager:
+		return 4
+	case ContactPointSystemUrl:
+		return 5
+	case ContactPointSystemSms:
+		return 6
+	case ContactPointSystemOther:
+		return 7
+	}
+	return 0
+}
+func (code *ContactPointSystem) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ContactPointSystemPhone
+	case 2:
+		*code = ContactPointSystemFax
+	case 3:
+		*code = ContactPointSystemEmail
+	case 4:
+		*code = ContactPointSystemPager
+	case 5:
+		*code = ContactPointSystemUrl
+	case 6:
+		*code = ContactPointSystemSms
+	case 7:
+		*code = ContactPointSystemOther
+	default:
+		return false
+	}
+	return true
+}
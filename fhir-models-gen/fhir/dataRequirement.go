@@ -14,6 +14,8 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
@@ -22,35 +24,2244 @@ type DataRequirement struct {
 	Id                     *string                     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension              []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
 	Type                   string                      `bson:"type" json:"type"`
+	TypeElement            *Element                    `bson:"_type,omitempty" json:"_type,omitempty"`
 	Profile                []string                    `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement         []*Element                  `bson:"_profile,omitempty" json:"_profile,omitempty"`
 	SubjectCodeableConcept *CodeableConcept            `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
 	SubjectReference       *Reference                  `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
 	MustSupport            []string                    `bson:"mustSupport,omitempty" json:"mustSupport,omitempty"`
+	MustSupportElement     []*Element                  `bson:"_mustSupport,omitempty" json:"_mustSupport,omitempty"`
 	CodeFilter             []DataRequirementCodeFilter `bson:"codeFilter,omitempty" json:"codeFilter,omitempty"`
 	DateFilter             []DataRequirementDateFilter `bson:"dateFilter,omitempty" json:"dateFilter,omitempty"`
 	Limit                  *int                        `bson:"limit,omitempty" json:"limit,omitempty"`
+	LimitElement           *Element                    `bson:"_limit,omitempty" json:"_limit,omitempty"`
 	Sort                   []DataRequirementSort       `bson:"sort,omitempty" json:"sort,omitempty"`
 }
+
+// DataRequirementSubject is implemented by the types allowed for DataRequirement.subject[x]
+type DataRequirementSubject interface {
+	isDataRequirementSubject()
+}
+
+func (CodeableConcept) isDataRequirementSubject() {}
+func (Reference) isDataRequirementSubject()       {}
+
+// Subject returns the value of DataRequirement.subject[x] together with its FHIR type code or nil if no value is set
+func (r DataRequirement) Subject() (DataRequirementSubject, string) {
+	if r.SubjectCodeableConcept != nil {
+		return *r.SubjectCodeableConcept, "CodeableConcept"
+	}
+	if r.SubjectReference != nil {
+		return *r.SubjectReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetSubject sets the value of DataRequirement.subject[x], given as value or pointer, and clears all other types
+func (r *DataRequirement) SetSubject(value DataRequirementSubject) {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.SubjectCodeableConcept = &v
+	case *CodeableConcept:
+		r.SubjectCodeableConcept = v
+	case Reference:
+		r.SubjectReference = &v
+	case *Reference:
+		r.SubjectReference = v
+	}
+}
+
 type DataRequirementCodeFilter struct {
-	Id          *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Path        *string     `bson:"path,omitempty" json:"path,omitempty"`
-	SearchParam *string     `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
-	ValueSet    *string     `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
-	Code        []Coding    `bson:"code,omitempty" json:"code,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Path               *string     `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement        *Element    `bson:"_path,omitempty" json:"_path,omitempty"`
+	SearchParam        *string     `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
+	SearchParamElement *Element    `bson:"_searchParam,omitempty" json:"_searchParam,omitempty"`
+	ValueSet           *string     `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ValueSetElement    *Element    `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
+	Code               []Coding    `bson:"code,omitempty" json:"code,omitempty"`
 }
 type DataRequirementDateFilter struct {
-	Id            *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension     []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Path          *string     `bson:"path,omitempty" json:"path,omitempty"`
-	SearchParam   *string     `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
-	ValueDateTime *string     `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
-	ValuePeriod   *Period     `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
-	ValueDuration *Duration   `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+	Id                   *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Path                 *string     `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement          *Element    `bson:"_path,omitempty" json:"_path,omitempty"`
+	SearchParam          *string     `bson:"searchParam,omitempty" json:"searchParam,omitempty"`
+	SearchParamElement   *Element    `bson:"_searchParam,omitempty" json:"_searchParam,omitempty"`
+	ValueDateTime        *string     `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element    `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValuePeriod          *Period     `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueDuration        *Duration   `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
 }
+
+// DataRequirementDateFilterValue is implemented by the types allowed for DataRequirement.dateFilter.value[x]
+type DataRequirementDateFilterValue interface {
+	isDataRequirementDateFilterValue()
+}
+
+func (DateTime) isDataRequirementDateFilterValue() {}
+func (Period) isDataRequirementDateFilterValue()   {}
+func (Duration) isDataRequirementDateFilterValue() {}
+
+// Value returns the value of DataRequirement.dateFilter.value[x] together with its FHIR type code or nil if no value is set
+func (r DataRequirementDateFilter) Value() (DataRequirementDateFilterValue, string) {
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValuePeriod != nil {
+		return *r.ValuePeriod, "Period"
+	}
+	if r.ValueDuration != nil {
+		return *r.ValueDuration, "Duration"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of DataRequirement.dateFilter.value[x], given as value or pointer, and clears all other types
+func (r *DataRequirementDateFilter) SetValue(value DataRequirementDateFilterValue) {
+	r.ValueDateTime = nil
+	r.ValuePeriod = nil
+	r.ValueDuration = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Period:
+		r.ValuePeriod = &v
+	case *Period:
+		r.ValuePeriod = v
+	case Duration:
+		r.ValueDuration = &v
+	case *Duration:
+		r.ValueDuration = v
+	}
+}
+
 type DataRequirementSort struct {
-	Id        *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
-	Path      string        `bson:"path" json:"path"`
-	Direction SortDirection `bson:"direction" json:"direction"`
+	Id               *string       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension        []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
+	Path             string        `bson:"path" json:"path"`
+	PathElement      *Element      `bson:"_path,omitempty" json:"_path,omitempty"`
+	Direction        SortDirection `bson:"direction" json:"direction"`
+	DirectionElement *Element      `bson:"_direction,omitempty" json:"_direction,omitempty"`
+}
+
+// MarshalJSON marshals the given DataRequirement as JSON into a byte slice
+func (r DataRequirement) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DataRequirement as JSON object with the members in the order of the fields
+func (r DataRequirement) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type)
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if len(r.Profile) > 0 {
+		e.key("profile")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Profile {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProfileElement) > 0 {
+		e.key("_profile")
+		e.elements(r.ProfileElement, len(r.Profile))
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.key("subjectCodeableConcept")
+		r.SubjectCodeableConcept.appendJSON(e)
+	}
+	if r.SubjectReference != nil {
+		e.key("subjectReference")
+		r.SubjectReference.appendJSON(e)
+	}
+	if len(r.MustSupport) > 0 {
+		e.key("mustSupport")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.MustSupport {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.MustSupportElement) > 0 {
+		e.key("_mustSupport")
+		e.elements(r.MustSupportElement, len(r.MustSupport))
+	}
+	if len(r.CodeFilter) > 0 {
+		e.key("codeFilter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CodeFilter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DateFilter) > 0 {
+		e.key("dateFilter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DateFilter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Limit != nil {
+		e.key("limit")
+		e.int(*r.Limit)
+	}
+	if r.LimitElement != nil {
+		e.key("_limit")
+		r.LimitElement.appendJSON(e)
+	}
+	if len(r.Sort) > 0 {
+		e.key("sort")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Sort {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DataRequirement from JSON. Unknown members are ignored.
+func (r *DataRequirement) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DataRequirement
+func (r *DataRequirement) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "profile":
+			a := d.array()
+			r.Profile = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Profile = append(r.Profile, v)
+			}
+			if r.Profile == nil && !a.isNull {
+				r.Profile = []string{}
+			}
+		case "_profile":
+			a := d.array()
+			r.ProfileElement = nil
+			for a.next() {
+				r.ProfileElement = append(r.ProfileElement, d.element())
+			}
+		case "subjectCodeableConcept":
+			if d.null() {
+				r.SubjectCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubjectCodeableConcept = &v
+			}
+		case "subjectReference":
+			if d.null() {
+				r.SubjectReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubjectReference = &v
+			}
+		case "mustSupport":
+			a := d.array()
+			r.MustSupport = nil
+			for a.next() {
+				v, _ := d.string()
+				r.MustSupport = append(r.MustSupport, v)
+			}
+			if r.MustSupport == nil && !a.isNull {
+				r.MustSupport = []string{}
+			}
+		case "_mustSupport":
+			a := d.array()
+			r.MustSupportElement = nil
+			for a.next() {
+				r.MustSupportElement = append(r.MustSupportElement, d.element())
+			}
+		case "codeFilter":
+			a := d.array()
+			r.CodeFilter = nil
+			for a.next() {
+				var v DataRequirementCodeFilter
+				v.decodeJSON(d)
+				r.CodeFilter = append(r.CodeFilter, v)
+			}
+			if r.CodeFilter == nil && !a.isNull {
+				r.CodeFilter = []DataRequirementCodeFilter{}
+			}
+		case "dateFilter":
+			a := d.array()
+			r.DateFilter = nil
+			for a.next() {
+				var v DataRequirementDateFilter
+				v.decodeJSON(d)
+				r.DateFilter = append(r.DateFilter, v)
+			}
+			if r.DateFilter == nil && !a.isNull {
+				r.DateFilter = []DataRequirementDateFilter{}
+			}
+		case "limit":
+			if v, ok := d.int(); ok {
+				r.Limit = &v
+			} else {
+				r.Limit = nil
+			}
+		case "_limit":
+			r.LimitElement = d.element()
+		case "sort":
+			a := d.array()
+			r.Sort = nil
+			for a.next() {
+				var v DataRequirementSort
+				v.decodeJSON(d)
+				r.Sort = append(r.Sort, v)
+			}
+			if r.Sort == nil && !a.isNull {
+				r.Sort = []DataRequirementSort{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DataRequirement which shares no memory with the original
+func (r DataRequirement) DeepCopy() DataRequirement {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Profile != nil {
+		out.Profile = make([]string, len(r.Profile))
+		copy(out.Profile, r.Profile)
+	}
+	if r.ProfileElement != nil {
+		out.ProfileElement = make([]*Element, len(r.ProfileElement))
+		for i, v := range r.ProfileElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ProfileElement[i] = &c
+			}
+		}
+	}
+	if r.SubjectCodeableConcept != nil {
+		v := r.SubjectCodeableConcept.DeepCopy()
+		out.SubjectCodeableConcept = &v
+	}
+	if r.SubjectReference != nil {
+		v := r.SubjectReference.DeepCopy()
+		out.SubjectReference = &v
+	}
+	if r.MustSupport != nil {
+		out.MustSupport = make([]string, len(r.MustSupport))
+		copy(out.MustSupport, r.MustSupport)
+	}
+	if r.MustSupportElement != nil {
+		out.MustSupportElement = make([]*Element, len(r.MustSupportElement))
+		for i, v := range r.MustSupportElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.MustSupportElement[i] = &c
+			}
+		}
+	}
+	if r.CodeFilter != nil {
+		out.CodeFilter = make([]DataRequirementCodeFilter, len(r.CodeFilter))
+		for i := range r.CodeFilter {
+			out.CodeFilter[i] = r.CodeFilter[i].DeepCopy()
+		}
+	}
+	if r.DateFilter != nil {
+		out.DateFilter = make([]DataRequirementDateFilter, len(r.DateFilter))
+		for i := range r.DateFilter {
+			out.DateFilter[i] = r.DateFilter[i].DeepCopy()
+		}
+	}
+	if r.Limit != nil {
+		v := *r.Limit
+		out.Limit = &v
+	}
+	if r.LimitElement != nil {
+		v := r.LimitElement.DeepCopy()
+		out.LimitElement = &v
+	}
+	if r.Sort != nil {
+		out.Sort = make([]DataRequirementSort, len(r.Sort))
+		for i := range r.Sort {
+			out.Sort[i] = r.Sort[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DataRequirement) Equal(other DataRequirement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if len(r.Profile) != len(other.Profile) {
+		return false
+	}
+	for i := range r.Profile {
+		if r.Profile[i] != other.Profile[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ProfileElement, other.ProfileElement, false) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.Equal(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.Equal(*other.SubjectReference) {
+		return false
+	}
+	if len(r.MustSupport) != len(other.MustSupport) {
+		return false
+	}
+	for i := range r.MustSupport {
+		if r.MustSupport[i] != other.MustSupport[i] {
+			return false
+		}
+	}
+	if !equalElements(r.MustSupportElement, other.MustSupportElement, false) {
+		return false
+	}
+	if len(r.CodeFilter) != len(other.CodeFilter) {
+		return false
+	}
+	for i := range r.CodeFilter {
+		if !r.CodeFilter[i].Equal(other.CodeFilter[i]) {
+			return false
+		}
+	}
+	if len(r.DateFilter) != len(other.DateFilter) {
+		return false
+	}
+	for i := range r.DateFilter {
+		if !r.DateFilter[i].Equal(other.DateFilter[i]) {
+			return false
+		}
+	}
+	if (r.Limit == nil) != (other.Limit == nil) || r.Limit != nil && *r.Limit != *other.Limit {
+		return false
+	}
+	if (r.LimitElement == nil) != (other.LimitElement == nil) || r.LimitElement != nil && !r.LimitElement.Equal(*other.LimitElement) {
+		return false
+	}
+	if len(r.Sort) != len(other.Sort) {
+		return false
+	}
+	for i := range r.Sort {
+		if !r.Sort[i].Equal(other.Sort[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DataRequirement) EqualsDeep(other DataRequirement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Profile), len(other.Profile), func(i, j int) bool {
+		return equivalentString(r.Profile[i], other.Profile[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ProfileElement, other.ProfileElement, true) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.EqualsDeep(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.EqualsDeep(*other.SubjectReference) {
+		return false
+	}
+	if !equivalentList(len(r.MustSupport), len(other.MustSupport), func(i, j int) bool {
+		return equivalentString(r.MustSupport[i], other.MustSupport[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.MustSupportElement, other.MustSupportElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.CodeFilter), len(other.CodeFilter), func(i, j int) bool {
+		return r.CodeFilter[i].EqualsDeep(other.CodeFilter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DateFilter), len(other.DateFilter), func(i, j int) bool {
+		return r.DateFilter[i].EqualsDeep(other.DateFilter[j])
+	}) {
+		return false
+	}
+	if (r.Limit == nil) != (other.Limit == nil) || r.Limit != nil && *r.Limit != *other.Limit {
+		return false
+	}
+	if (r.LimitElement == nil) != (other.LimitElement == nil) || r.LimitElement != nil && !r.LimitElement.EqualsDeep(*other.LimitElement) {
+		return false
+	}
+	if !equivalentList(len(r.Sort), len(other.Sort), func(i, j int) bool {
+		return r.Sort[i].EqualsDeep(other.Sort[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DataRequirement) EqualsShallow(other DataRequirement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Profile), len(other.Profile), func(i, j int) bool {
+		return equivalentString(r.Profile[i], other.Profile[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.MustSupport), len(other.MustSupport), func(i, j int) bool {
+		return equivalentString(r.MustSupport[i], other.MustSupport[j])
+	}) {
+		return false
+	}
+	if (r.Limit == nil) != (other.Limit == nil) || r.Limit != nil && *r.Limit != *other.Limit {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DataRequirement as FHIR XML
+func (r DataRequirement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	for i, v := range r.Profile {
+		if err := encodeXMLPrimitive(e, "profile", v, elementAt(r.ProfileElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subjectCodeableConcept", r.SubjectCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectReference", r.SubjectReference); err != nil {
+		return err
+	}
+	for i, v := range r.MustSupport {
+		if err := encodeXMLPrimitive(e, "mustSupport", v, elementAt(r.MustSupportElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CodeFilter {
+		if err := encodeXMLElement(e, "codeFilter", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.DateFilter {
+		if err := encodeXMLElement(e, "dateFilter", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "limit", r.Limit, r.LimitElement); err != nil {
+		return err
+	}
+	for _, v := range r.Sort {
+		if err := encodeXMLElement(e, "sort", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DataRequirement from FHIR XML
+func (r *DataRequirement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ProfileElement = appendElement(r.ProfileElement, len(r.Profile), element)
+					r.Profile = append(r.Profile, v)
+				}
+			case "subjectCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectCodeableConcept = &v
+			case "subjectReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectReference = &v
+			case "mustSupport":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.MustSupportElement = appendElement(r.MustSupportElement, len(r.MustSupport), element)
+					r.MustSupport = append(r.MustSupport, v)
+				}
+			case "codeFilter":
+				var v DataRequirementCodeFilter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CodeFilter = append(r.CodeFilter, v)
+			case "dateFilter":
+				var v DataRequirementDateFilter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DateFilter = append(r.DateFilter, v)
+			case "limit":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Limit = &v
+				}
+				r.LimitElement = element
+			case "sort":
+				var v DataRequirementSort
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Sort = append(r.Sort, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DataRequirement as properties to the RDF node n
+func (r DataRequirement) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("DataRequirement.type", -1, r.Type, r.TypeElement, "string")
+	for i, v := range r.Profile {
+		n.primitive("DataRequirement.profile", i, v, elementAt(r.ProfileElement, i), "string")
+	}
+	n.element("DataRequirement.subjectCodeableConcept", -1, r.SubjectCodeableConcept)
+	n.element("DataRequirement.subjectReference", -1, r.SubjectReference)
+	for i, v := range r.MustSupport {
+		n.primitive("DataRequirement.mustSupport", i, v, elementAt(r.MustSupportElement, i), "string")
+	}
+	for i, v := range r.CodeFilter {
+		n.element("DataRequirement.codeFilter", i, v)
+	}
+	for i, v := range r.DateFilter {
+		n.element("DataRequirement.dateFilter", i, v)
+	}
+	n.primitive("DataRequirement.limit", -1, r.Limit, r.LimitElement, "positiveInt")
+	for i, v := range r.Sort {
+		n.element("DataRequirement.sort", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DataRequirement as BSON document with the structure of its FHIR JSON
+func (r DataRequirement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DataRequirement from a BSON document with the structure of its FHIR JSON
+func (r *DataRequirement) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DataRequirement as protobuf message DataRequirement of fhir.proto
+func (r DataRequirement) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DataRequirement to the encoded message
+func (r DataRequirement) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Type != "" {
+		e.string(3, r.Type)
+	}
+	for _, v := range r.Profile {
+		e.string(4, v)
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.message(5, *r.SubjectCodeableConcept)
+	}
+	if r.SubjectReference != nil {
+		e.message(6, *r.SubjectReference)
+	}
+	for _, v := range r.MustSupport {
+		e.string(7, v)
+	}
+	for _, v := range r.CodeFilter {
+		e.message(8, v)
+	}
+	for _, v := range r.DateFilter {
+		e.message(9, v)
+	}
+	if r.Limit != nil {
+		e.varint(10, uint64(int64(*r.Limit)))
+	}
+	for _, v := range r.Sort {
+		e.message(11, v)
+	}
+	if r.TypeElement != nil {
+		e.message(12, *r.TypeElement)
+	}
+	for _, v := range r.ProfileElement {
+		e.element(13, v)
+	}
+	for _, v := range r.MustSupportElement {
+		e.element(14, v)
+	}
+	if r.LimitElement != nil {
+		e.message(15, *r.LimitElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DataRequirement from protobuf message DataRequirement of fhir.proto
+func (r *DataRequirement) UnmarshalProto(b []byte) error {
+	*r = DataRequirement{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			r.Type = d.string()
+		case 4:
+			r.Profile = append(r.Profile, d.string())
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubjectCodeableConcept = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.SubjectReference = &v
+		case 7:
+			r.MustSupport = append(r.MustSupport, d.string())
+		case 8:
+			var v DataRequirementCodeFilter
+			d.message(&v)
+			r.CodeFilter = append(r.CodeFilter, v)
+		case 9:
+			var v DataRequirementDateFilter
+			d.message(&v)
+			r.DateFilter = append(r.DateFilter, v)
+		case 10:
+			v := d.int()
+			r.Limit = &v
+		case 11:
+			var v DataRequirementSort
+			d.message(&v)
+			r.Sort = append(r.Sort, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 13:
+			r.ProfileElement = append(r.ProfileElement, d.element())
+		case 14:
+			r.MustSupportElement = append(r.MustSupportElement, d.element())
+		case 15:
+			var v Element
+			d.message(&v)
+			r.LimitElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DataRequirementCodeFilter as JSON into a byte slice
+func (r DataRequirementCodeFilter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DataRequirementCodeFilter as JSON object with the members in the order of the fields
+func (r DataRequirementCodeFilter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Path != nil {
+		e.key("path")
+		e.string(*r.Path)
+	}
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	if r.SearchParam != nil {
+		e.key("searchParam")
+		e.string(*r.SearchParam)
+	}
+	if r.SearchParamElement != nil {
+		e.key("_searchParam")
+		r.SearchParamElement.appendJSON(e)
+	}
+	if r.ValueSet != nil {
+		e.key("valueSet")
+		e.string(*r.ValueSet)
+	}
+	if r.ValueSetElement != nil {
+		e.key("_valueSet")
+		r.ValueSetElement.appendJSON(e)
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DataRequirementCodeFilter from JSON. Unknown members are ignored.
+func (r *DataRequirementCodeFilter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DataRequirementCodeFilter
+func (r *DataRequirementCodeFilter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = &v
+			} else {
+				r.Path = nil
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "searchParam":
+			if v, ok := d.string(); ok {
+				r.SearchParam = &v
+			} else {
+				r.SearchParam = nil
+			}
+		case "_searchParam":
+			r.SearchParamElement = d.element()
+		case "valueSet":
+			if v, ok := d.string(); ok {
+				r.ValueSet = &v
+			} else {
+				r.ValueSet = nil
+			}
+		case "_valueSet":
+			r.ValueSetElement = d.element()
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []Coding{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DataRequirementCodeFilter which shares no memory with the original
+func (r DataRequirementCodeFilter) DeepCopy() DataRequirementCodeFilter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Path != nil {
+		v := *r.Path
+		out.Path = &v
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	if r.SearchParam != nil {
+		v := *r.SearchParam
+		out.SearchParam = &v
+	}
+	if r.SearchParamElement != nil {
+		v := r.SearchParamElement.DeepCopy()
+		out.SearchParamElement = &v
+	}
+	if r.ValueSet != nil {
+		v := *r.ValueSet
+		out.ValueSet = &v
+	}
+	if r.ValueSetElement != nil {
+		v := r.ValueSetElement.DeepCopy()
+		out.ValueSetElement = &v
+	}
+	if r.Code != nil {
+		out.Code = make([]Coding, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DataRequirementCodeFilter) Equal(other DataRequirementCodeFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && *r.Path != *other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if (r.SearchParam == nil) != (other.SearchParam == nil) || r.SearchParam != nil && *r.SearchParam != *other.SearchParam {
+		return false
+	}
+	if (r.SearchParamElement == nil) != (other.SearchParamElement == nil) || r.SearchParamElement != nil && !r.SearchParamElement.Equal(*other.SearchParamElement) {
+		return false
+	}
+	if (r.ValueSet == nil) != (other.ValueSet == nil) || r.ValueSet != nil && *r.ValueSet != *other.ValueSet {
+		return false
+	}
+	if (r.ValueSetElement == nil) != (other.ValueSetElement == nil) || r.ValueSetElement != nil && !r.ValueSetElement.Equal(*other.ValueSetElement) {
+		return false
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DataRequirementCodeFilter) EqualsDeep(other DataRequirementCodeFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if (r.SearchParam == nil) != (other.SearchParam == nil) || r.SearchParam != nil && !equivalentString(*r.SearchParam, *other.SearchParam) {
+		return false
+	}
+	if (r.SearchParamElement == nil) != (other.SearchParamElement == nil) || r.SearchParamElement != nil && !r.SearchParamElement.EqualsDeep(*other.SearchParamElement) {
+		return false
+	}
+	if (r.ValueSet == nil) != (other.ValueSet == nil) || r.ValueSet != nil && !equivalentString(*r.ValueSet, *other.ValueSet) {
+		return false
+	}
+	if (r.ValueSetElement == nil) != (other.ValueSetElement == nil) || r.ValueSetElement != nil && !r.ValueSetElement.EqualsDeep(*other.ValueSetElement) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DataRequirementCodeFilter) EqualsShallow(other DataRequirementCodeFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.SearchParam == nil) != (other.SearchParam == nil) || r.SearchParam != nil && !equivalentString(*r.SearchParam, *other.SearchParam) {
+		return false
+	}
+	if (r.ValueSet == nil) != (other.ValueSet == nil) || r.ValueSet != nil && !equivalentString(*r.ValueSet, *other.ValueSet) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DataRequirementCodeFilter as FHIR XML
+func (r DataRequirementCodeFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "searchParam", r.SearchParam, r.SearchParamElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueSet", r.ValueSet, r.ValueSetElement); err != nil {
+		return err
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DataRequirementCodeFilter from FHIR XML
+func (r *DataRequirementCodeFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = &v
+				}
+				r.PathElement = element
+			case "searchParam":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SearchParam = &v
+				}
+				r.SearchParamElement = element
+			case "valueSet":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueSet = &v
+				}
+				r.ValueSetElement = element
+			case "code":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DataRequirementCodeFilter as properties to the RDF node n
+func (r DataRequirementCodeFilter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("DataRequirement.codeFilter.path", -1, r.Path, r.PathElement, "string")
+	n.primitive("DataRequirement.codeFilter.searchParam", -1, r.SearchParam, r.SearchParamElement, "string")
+	n.primitive("DataRequirement.codeFilter.valueSet", -1, r.ValueSet, r.ValueSetElement, "string")
+	for i, v := range r.Code {
+		n.element("DataRequirement.codeFilter.code", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DataRequirementCodeFilter as BSON document with the structure of its FHIR JSON
+func (r DataRequirementCodeFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DataRequirementCodeFilter from a BSON document with the structure of its FHIR JSON
+func (r *DataRequirementCodeFilter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DataRequirementCodeFilter as protobuf message DataRequirementCodeFilter of fhir.proto
+func (r DataRequirementCodeFilter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DataRequirementCodeFilter to the encoded message
+func (r DataRequirementCodeFilter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Path != nil {
+		e.string(3, *r.Path)
+	}
+	if r.SearchParam != nil {
+		e.string(4, *r.SearchParam)
+	}
+	if r.ValueSet != nil {
+		e.string(5, *r.ValueSet)
+	}
+	for _, v := range r.Code {
+		e.message(6, v)
+	}
+	if r.PathElement != nil {
+		e.message(7, *r.PathElement)
+	}
+	if r.SearchParamElement != nil {
+		e.message(8, *r.SearchParamElement)
+	}
+	if r.ValueSetElement != nil {
+		e.message(9, *r.ValueSetElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DataRequirementCodeFilter from protobuf message DataRequirementCodeFilter of fhir.proto
+func (r *DataRequirementCodeFilter) UnmarshalProto(b []byte) error {
+	*r = DataRequirementCodeFilter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.Path = &v
+		case 4:
+			v := d.string()
+			r.SearchParam = &v
+		case 5:
+			v := d.string()
+			r.ValueSet = &v
+		case 6:
+			var v Coding
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.SearchParamElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ValueSetElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DataRequirementDateFilter as JSON into a byte slice
+func (r DataRequirementDateFilter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DataRequirementDateFilter as JSON object with the members in the order of the fields
+func (r DataRequirementDateFilter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Path != nil {
+		e.key("path")
+		e.string(*r.Path)
+	}
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	if r.SearchParam != nil {
+		e.key("searchParam")
+		e.string(*r.SearchParam)
+	}
+	if r.SearchParamElement != nil {
+		e.key("_searchParam")
+		r.SearchParamElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValuePeriod != nil {
+		e.key("valuePeriod")
+		r.ValuePeriod.appendJSON(e)
+	}
+	if r.ValueDuration != nil {
+		e.key("valueDuration")
+		r.ValueDuration.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DataRequirementDateFilter from JSON. Unknown members are ignored.
+func (r *DataRequirementDateFilter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DataRequirementDateFilter
+func (r *DataRequirementDateFilter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = &v
+			} else {
+				r.Path = nil
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "searchParam":
+			if v, ok := d.string(); ok {
+				r.SearchParam = &v
+			} else {
+				r.SearchParam = nil
+			}
+		case "_searchParam":
+			r.SearchParamElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valuePeriod":
+			if d.null() {
+				r.ValuePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValuePeriod = &v
+			}
+		case "valueDuration":
+			if d.null() {
+				r.ValueDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.ValueDuration = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DataRequirementDateFilter which shares no memory with the original
+func (r DataRequirementDateFilter) DeepCopy() DataRequirementDateFilter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Path != nil {
+		v := *r.Path
+		out.Path = &v
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	if r.SearchParam != nil {
+		v := *r.SearchParam
+		out.SearchParam = &v
+	}
+	if r.SearchParamElement != nil {
+		v := r.SearchParamElement.DeepCopy()
+		out.SearchParamElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValuePeriod != nil {
+		v := r.ValuePeriod.DeepCopy()
+		out.ValuePeriod = &v
+	}
+	if r.ValueDuration != nil {
+		v := r.ValueDuration.DeepCopy()
+		out.ValueDuration = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DataRequirementDateFilter) Equal(other DataRequirementDateFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && *r.Path != *other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if (r.SearchParam == nil) != (other.SearchParam == nil) || r.SearchParam != nil && *r.SearchParam != *other.SearchParam {
+		return false
+	}
+	if (r.SearchParamElement == nil) != (other.SearchParamElement == nil) || r.SearchParamElement != nil && !r.SearchParamElement.Equal(*other.SearchParamElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.Equal(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.Equal(*other.ValueDuration) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DataRequirementDateFilter) EqualsDeep(other DataRequirementDateFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if (r.SearchParam == nil) != (other.SearchParam == nil) || r.SearchParam != nil && !equivalentString(*r.SearchParam, *other.SearchParam) {
+		return false
+	}
+	if (r.SearchParamElement == nil) != (other.SearchParamElement == nil) || r.SearchParamElement != nil && !r.SearchParamElement.EqualsDeep(*other.SearchParamElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.EqualsDeep(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.EqualsDeep(*other.ValueDuration) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DataRequirementDateFilter) EqualsShallow(other DataRequirementDateFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.SearchParam == nil) != (other.SearchParam == nil) || r.SearchParam != nil && !equivalentString(*r.SearchParam, *other.SearchParam) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DataRequirementDateFilter as FHIR XML
+func (r DataRequirementDateFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "searchParam", r.SearchParam, r.SearchParamElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valuePeriod", r.ValuePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDuration", r.ValueDuration); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DataRequirementDateFilter from FHIR XML
+func (r *DataRequirementDateFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = &v
+				}
+				r.PathElement = element
+			case "searchParam":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SearchParam = &v
+				}
+				r.SearchParamElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valuePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValuePeriod = &v
+			case "valueDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDuration = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DataRequirementDateFilter as properties to the RDF node n
+func (r DataRequirementDateFilter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("DataRequirement.dateFilter.path", -1, r.Path, r.PathElement, "string")
+	n.primitive("DataRequirement.dateFilter.searchParam", -1, r.SearchParam, r.SearchParamElement, "string")
+	n.primitive("DataRequirement.dateFilter.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.element("DataRequirement.dateFilter.valuePeriod", -1, r.ValuePeriod)
+	n.element("DataRequirement.dateFilter.valueDuration", -1, r.ValueDuration)
+}
+
+// MarshalBSON marshals the given DataRequirementDateFilter as BSON document with the structure of its FHIR JSON
+func (r DataRequirementDateFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DataRequirementDateFilter from a BSON document with the structure of its FHIR JSON
+func (r *DataRequirementDateFilter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DataRequirementDateFilter as protobuf message DataRequirementDateFilter of fhir.proto
+func (r DataRequirementDateFilter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DataRequirementDateFilter to the encoded message
+func (r DataRequirementDateFilter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Path != nil {
+		e.string(3, *r.Path)
+	}
+	if r.SearchParam != nil {
+		e.string(4, *r.SearchParam)
+	}
+	if r.ValueDateTime != nil {
+		e.string(5, *r.ValueDateTime)
+	}
+	if r.ValuePeriod != nil {
+		e.message(6, *r.ValuePeriod)
+	}
+	if r.ValueDuration != nil {
+		e.message(7, *r.ValueDuration)
+	}
+	if r.PathElement != nil {
+		e.message(8, *r.PathElement)
+	}
+	if r.SearchParamElement != nil {
+		e.message(9, *r.SearchParamElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(10, *r.ValueDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DataRequirementDateFilter from protobuf message DataRequirementDateFilter of fhir.proto
+func (r *DataRequirementDateFilter) UnmarshalProto(b []byte) error {
+	*r = DataRequirementDateFilter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.Path = &v
+		case 4:
+			v := d.string()
+			r.SearchParam = &v
+		case 5:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 6:
+			var v Period
+			d.message(&v)
+			r.ValuePeriod = &v
+		case 7:
+			var v Duration
+			d.message(&v)
+			r.ValueDuration = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.SearchParamElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DataRequirementSort as JSON into a byte slice
+func (r DataRequirementSort) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DataRequirementSort as JSON object with the members in the order of the fields
+func (r DataRequirementSort) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("path")
+	e.string(r.Path)
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	e.key("direction")
+	e.string(r.Direction.Code())
+	if r.DirectionElement != nil {
+		e.key("_direction")
+		r.DirectionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DataRequirementSort from JSON. Unknown members are ignored.
+func (r *DataRequirementSort) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DataRequirementSort
+func (r *DataRequirementSort) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = v
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "direction":
+			d.unmarshal(&r.Direction)
+		case "_direction":
+			r.DirectionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DataRequirementSort which shares no memory with the original
+func (r DataRequirementSort) DeepCopy() DataRequirementSort {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	if r.DirectionElement != nil {
+		v := r.DirectionElement.DeepCopy()
+		out.DirectionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DataRequirementSort) Equal(other DataRequirementSort) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Path != other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if r.Direction != other.Direction {
+		return false
+	}
+	if (r.DirectionElement == nil) != (other.DirectionElement == nil) || r.DirectionElement != nil && !r.DirectionElement.Equal(*other.DirectionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DataRequirementSort) EqualsDeep(other DataRequirementSort) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if r.Direction != other.Direction {
+		return false
+	}
+	if (r.DirectionElement == nil) != (other.DirectionElement == nil) || r.DirectionElement != nil && !r.DirectionElement.EqualsDeep(*other.DirectionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DataRequirementSort) EqualsShallow(other DataRequirementSort) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	if r.Direction != other.Direction {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DataRequirementSort as FHIR XML
+func (r DataRequirementSort) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "direction", r.Direction, r.DirectionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DataRequirementSort from FHIR XML
+func (r *DataRequirementSort) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = v
+				}
+				r.PathElement = element
+			case "direction":
+				var v SortDirection
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Direction = v
+				}
+				r.DirectionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DataRequirementSort as properties to the RDF node n
+func (r DataRequirementSort) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("DataRequirement.sort.path", -1, r.Path, r.PathElement, "string")
+	n.primitive("DataRequirement.sort.direction", -1, r.Direction, r.DirectionElement, "code")
+}
+
+// MarshalBSON marshals the given DataRequirementSort as BSON document with the structure of its FHIR JSON
+func (r DataRequirementSort) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DataRequirementSort from a BSON document with the structure of its FHIR JSON
+func (r *DataRequirementSort) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DataRequirementSort as protobuf message DataRequirementSort of fhir.proto
+func (r DataRequirementSort) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DataRequirementSort to the encoded message
+func (r DataRequirementSort) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Path != "" {
+		e.string(3, r.Path)
+	}
+	e.varint(4, r.Direction.protoNumber())
+	if r.PathElement != nil {
+		e.message(5, *r.PathElement)
+	}
+	if r.DirectionElement != nil {
+		e.message(6, *r.DirectionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DataRequirementSort from protobuf message DataRequirementSort of fhir.proto
+func (r *DataRequirementSort) UnmarshalProto(b []byte) error {
+	*r = DataRequirementSort{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			r.Path = d.string()
+		case 4:
+			var v SortDirection
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Direction = v
+		case 5:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.DirectionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code DaysOfWeek) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DaysOfWeek) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DaysOfWeek) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "mon":
 		*code = DaysOfWeekMon
@@ -61,6 +65,12 @@ func (code *DaysOfWeek) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DaysOfWeek) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DaysOfWeek) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DaysOfWeek) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code DaysOfWeek) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DaysOfWeek) protoNumber() uint64 {
+	switch code {
+	case DaysOfWeekMon:
+		return 1
+	case DaysOfWeekTue:
+		return 2
+	case DaysOfWeekWed:
+		return 3
+	case DaysOfWeekThu:
+		return 4
+	case DaysOfWeekFri:
+		return 5
+	case DaysOfWeekSat:
+		return 6
+	case DaysOfWeekSun:
+		return 7
+	}
+	return 0
+}
+func (code *DaysOfWeek) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DaysOfWeekMon
+	case 2:
+		*code = DaysOfWeekTue
+	case 3:
+		*code = DaysOfWeekWed
+	case 4:
+		*code = DaysOfWeekThu
+	case 5:
+		*code = DaysOfWeekFri
+	case 6:
+		*code = DaysOfWeekSat
+	case 7:
+		*code = DaysOfWeekSun
+	default:
+		return false
+	}
+	return true
+}
@@ -14,29 +14,72 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Dosage is documented here http://hl7.org/fhir/StructureDefinition/Dosage
 type Dosage struct {
-	Id                       *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension        []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence                 *int                `bson:"sequence,omitempty" json:"sequence,omitempty"`
-	Text                     *string             `bson:"text,omitempty" json:"text,omitempty"`
-	AdditionalInstruction    []CodeableConcept   `bson:"additionalInstruction,omitempty" json:"additionalInstruction,omitempty"`
-	PatientInstruction       *string             `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
-	Timing                   *Timing             `bson:"timing,omitempty" json:"timing,omitempty"`
-	AsNeededBoolean          *bool               `bson:"asNeededBoolean,omitempty" json:"asNeededBoolean,omitempty"`
-	AsNeededCodeableConcept  *CodeableConcept    `bson:"asNeededCodeableConcept,omitempty" json:"asNeededCodeableConcept,omitempty"`
-	Site                     *CodeableConcept    `bson:"site,omitempty" json:"site,omitempty"`
-	Route                    *CodeableConcept    `bson:"route,omitempty" json:"route,omitempty"`
-	Method                   *CodeableConcept    `bson:"method,omitempty" json:"method,omitempty"`
-	DoseAndRate              []DosageDoseAndRate `bson:"doseAndRate,omitempty" json:"doseAndRate,omitempty"`
-	MaxDosePerPeriod         *Ratio              `bson:"maxDosePerPeriod,omitempty" json:"maxDosePerPeriod,omitempty"`
-	MaxDosePerAdministration *Quantity           `bson:"maxDosePerAdministration,omitempty" json:"maxDosePerAdministration,omitempty"`
-	MaxDosePerLifetime       *Quantity           `bson:"maxDosePerLifetime,omitempty" json:"maxDosePerLifetime,omitempty"`
+	Id                        *string             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                  *int                `bson:"sequence,omitempty" json:"sequence,omitempty"`
+	SequenceElement           *Element            `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Text                      *string             `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement               *Element            `bson:"_text,omitempty" json:"_text,omitempty"`
+	AdditionalInstruction     []CodeableConcept   `bson:"additionalInstruction,omitempty" json:"additionalInstruction,omitempty"`
+	PatientInstruction        *string             `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
+	PatientInstructionElement *Element            `bson:"_patientInstruction,omitempty" json:"_patientInstruction,omitempty"`
+	Timing                    *Timing             `bson:"timing,omitempty" json:"timing,omitempty"`
+	AsNeededBoolean           *bool               `bson:"asNeededBoolean,omitempty" json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement    *Element            `bson:"_asNeededBoolean,omitempty" json:"_asNeededBoolean,omitempty"`
+	AsNeededCodeableConcept   *CodeableConcept    `bson:"asNeededCodeableConcept,omitempty" json:"asNeededCodeableConcept,omitempty"`
+	Site                      *CodeableConcept    `bson:"site,omitempty" json:"site,omitempty"`
+	Route                     *CodeableConcept    `bson:"route,omitempty" json:"route,omitempty"`
+	Method                    *CodeableConcept    `bson:"method,omitempty" json:"method,omitempty"`
+	DoseAndRate               []DosageDoseAndRate `bson:"doseAndRate,omitempty" json:"doseAndRate,omitempty"`
+	MaxDosePerPeriod          *Ratio              `bson:"maxDosePerPeriod,omitempty" json:"maxDosePerPeriod,omitempty"`
+	MaxDosePerAdministration  *Quantity           `bson:"maxDosePerAdministration,omitempty" json:"maxDosePerAdministration,omitempty"`
+	MaxDosePerLifetime        *Quantity           `bson:"maxDosePerLifetime,omitempty" json:"maxDosePerLifetime,omitempty"`
+}
+
+// DosageAsNeeded is implemented by the types allowed for Dosage.asNeeded[x]
+type DosageAsNeeded interface {
+	isDosageAsNeeded()
+}
+
+func (Boolean) isDosageAsNeeded()         {}
+func (CodeableConcept) isDosageAsNeeded() {}
+
+// AsNeeded returns the value of Dosage.asNeeded[x] together with its FHIR type code or nil if no value is set
+func (r Dosage) AsNeeded() (DosageAsNeeded, string) {
+	if r.AsNeededBoolean != nil {
+		return Boolean(*r.AsNeededBoolean), "boolean"
+	}
+	if r.AsNeededCodeableConcept != nil {
+		return *r.AsNeededCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
 }
+
+// SetAsNeeded sets the value of Dosage.asNeeded[x], given as value or pointer, and clears all other types
+func (r *Dosage) SetAsNeeded(value DosageAsNeeded) {
+	r.AsNeededBoolean = nil
+	r.AsNeededCodeableConcept = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.AsNeededBoolean = &primitive
+	case *Boolean:
+		r.AsNeededBoolean = (*bool)(v)
+	case CodeableConcept:
+		r.AsNeededCodeableConcept = &v
+	case *CodeableConcept:
+		r.AsNeededCodeableConcept = v
+	}
+}
+
 type DosageDoseAndRate struct {
 	Id           *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension    []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -47,3 +90,1522 @@ type DosageDoseAndRate struct {
 	RateRange    *Range           `bson:"rateRange,omitempty" json:"rateRange,omitempty"`
 	RateQuantity *Quantity        `bson:"rateQuantity,omitempty" json:"rateQuantity,omitempty"`
 }
+
+// DosageDoseAndRateDose is implemented by the types allowed for Dosage.doseAndRate.dose[x]
+type DosageDoseAndRateDose interface {
+	isDosageDoseAndRateDose()
+}
+
+func (Range) isDosageDoseAndRateDose()    {}
+func (Quantity) isDosageDoseAndRateDose() {}
+
+// Dose returns the value of Dosage.doseAndRate.dose[x] together with its FHIR type code or nil if no value is set
+func (r DosageDoseAndRate) Dose() (DosageDoseAndRateDose, string) {
+	if r.DoseRange != nil {
+		return *r.DoseRange, "Range"
+	}
+	if r.DoseQuantity != nil {
+		return *r.DoseQuantity, "Quantity"
+	}
+	return nil, ""
+}
+
+// SetDose sets the value of Dosage.doseAndRate.dose[x], given as value or pointer, and clears all other types
+func (r *DosageDoseAndRate) SetDose(value DosageDoseAndRateDose) {
+	r.DoseRange = nil
+	r.DoseQuantity = nil
+	switch v := value.(type) {
+	case Range:
+		r.DoseRange = &v
+	case *Range:
+		r.DoseRange = v
+	case Quantity:
+		r.DoseQuantity = &v
+	case *Quantity:
+		r.DoseQuantity = v
+	}
+}
+
+// DosageDoseAndRateRate is implemented by the types allowed for Dosage.doseAndRate.rate[x]
+type DosageDoseAndRateRate interface {
+	isDosageDoseAndRateRate()
+}
+
+func (Ratio) isDosageDoseAndRateRate()    {}
+func (Range) isDosageDoseAndRateRate()    {}
+func (Quantity) isDosageDoseAndRateRate() {}
+
+// Rate returns the value of Dosage.doseAndRate.rate[x] together with its FHIR type code or nil if no value is set
+func (r DosageDoseAndRate) Rate() (DosageDoseAndRateRate, string) {
+	if r.RateRatio != nil {
+		return *r.RateRatio, "Ratio"
+	}
+	if r.RateRange != nil {
+		return *r.RateRange, "Range"
+	}
+	if r.RateQuantity != nil {
+		return *r.RateQuantity, "Quantity"
+	}
+	return nil, ""
+}
+
+// SetRate sets the value of Dosage.doseAndRate.rate[x], given as value or pointer, and clears all other types
+func (r *DosageDoseAndRate) SetRate(value DosageDoseAndRateRate) {
+	r.RateRatio = nil
+	r.RateRange = nil
+	r.RateQuantity = nil
+	switch v := value.(type) {
+	case Ratio:
+		r.RateRatio = &v
+	case *Ratio:
+		r.RateRatio = v
+	case Range:
+		r.RateRange = &v
+	case *Range:
+		r.RateRange = v
+	case Quantity:
+		r.RateQuantity = &v
+	case *Quantity:
+		r.RateQuantity = v
+	}
+}
+
+// MarshalJSON marshals the given Dosage as JSON into a byte slice
+func (r Dosage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Dosage as JSON object with the members in the order of the fields
+func (r Dosage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Sequence != nil {
+		e.key("sequence")
+		e.int(*r.Sequence)
+	}
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	if len(r.AdditionalInstruction) > 0 {
+		e.key("additionalInstruction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AdditionalInstruction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PatientInstruction != nil {
+		e.key("patientInstruction")
+		e.string(*r.PatientInstruction)
+	}
+	if r.PatientInstructionElement != nil {
+		e.key("_patientInstruction")
+		r.PatientInstructionElement.appendJSON(e)
+	}
+	if r.Timing != nil {
+		e.key("timing")
+		r.Timing.appendJSON(e)
+	}
+	if r.AsNeededBoolean != nil {
+		e.key("asNeededBoolean")
+		e.bool(*r.AsNeededBoolean)
+	}
+	if r.AsNeededBooleanElement != nil {
+		e.key("_asNeededBoolean")
+		r.AsNeededBooleanElement.appendJSON(e)
+	}
+	if r.AsNeededCodeableConcept != nil {
+		e.key("asNeededCodeableConcept")
+		r.AsNeededCodeableConcept.appendJSON(e)
+	}
+	if r.Site != nil {
+		e.key("site")
+		r.Site.appendJSON(e)
+	}
+	if r.Route != nil {
+		e.key("route")
+		r.Route.appendJSON(e)
+	}
+	if r.Method != nil {
+		e.key("method")
+		r.Method.appendJSON(e)
+	}
+	if len(r.DoseAndRate) > 0 {
+		e.key("doseAndRate")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DoseAndRate {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MaxDosePerPeriod != nil {
+		e.key("maxDosePerPeriod")
+		r.MaxDosePerPeriod.appendJSON(e)
+	}
+	if r.MaxDosePerAdministration != nil {
+		e.key("maxDosePerAdministration")
+		r.MaxDosePerAdministration.appendJSON(e)
+	}
+	if r.MaxDosePerLifetime != nil {
+		e.key("maxDosePerLifetime")
+		r.MaxDosePerLifetime.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Dosage from JSON. Unknown members are ignored.
+func (r *Dosage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Dosage
+func (r *Dosage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = &v
+			} else {
+				r.Sequence = nil
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "additionalInstruction":
+			a := d.array()
+			r.AdditionalInstruction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdditionalInstruction = append(r.AdditionalInstruction, v)
+			}
+			if r.AdditionalInstruction == nil && !a.isNull {
+				r.AdditionalInstruction = []CodeableConcept{}
+			}
+		case "patientInstruction":
+			if v, ok := d.string(); ok {
+				r.PatientInstruction = &v
+			} else {
+				r.PatientInstruction = nil
+			}
+		case "_patientInstruction":
+			r.PatientInstructionElement = d.element()
+		case "timing":
+			if d.null() {
+				r.Timing = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.Timing = &v
+			}
+		case "asNeededBoolean":
+			if v, ok := d.bool(); ok {
+				r.AsNeededBoolean = &v
+			} else {
+				r.AsNeededBoolean = nil
+			}
+		case "_asNeededBoolean":
+			r.AsNeededBooleanElement = d.element()
+		case "asNeededCodeableConcept":
+			if d.null() {
+				r.AsNeededCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AsNeededCodeableConcept = &v
+			}
+		case "site":
+			if d.null() {
+				r.Site = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Site = &v
+			}
+		case "route":
+			if d.null() {
+				r.Route = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Route = &v
+			}
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Method = &v
+			}
+		case "doseAndRate":
+			a := d.array()
+			r.DoseAndRate = nil
+			for a.next() {
+				var v DosageDoseAndRate
+				v.decodeJSON(d)
+				r.DoseAndRate = append(r.DoseAndRate, v)
+			}
+			if r.DoseAndRate == nil && !a.isNull {
+				r.DoseAndRate = []DosageDoseAndRate{}
+			}
+		case "maxDosePerPeriod":
+			if d.null() {
+				r.MaxDosePerPeriod = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.MaxDosePerPeriod = &v
+			}
+		case "maxDosePerAdministration":
+			if d.null() {
+				r.MaxDosePerAdministration = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MaxDosePerAdministration = &v
+			}
+		case "maxDosePerLifetime":
+			if d.null() {
+				r.MaxDosePerLifetime = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MaxDosePerLifetime = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Dosage which shares no memory with the original
+func (r Dosage) DeepCopy() Dosage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Sequence != nil {
+		v := *r.Sequence
+		out.Sequence = &v
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.AdditionalInstruction != nil {
+		out.AdditionalInstruction = make([]CodeableConcept, len(r.AdditionalInstruction))
+		for i := range r.AdditionalInstruction {
+			out.AdditionalInstruction[i] = r.AdditionalInstruction[i].DeepCopy()
+		}
+	}
+	if r.PatientInstruction != nil {
+		v := *r.PatientInstruction
+		out.PatientInstruction = &v
+	}
+	if r.PatientInstructionElement != nil {
+		v := r.PatientInstructionElement.DeepCopy()
+		out.PatientInstructionElement = &v
+	}
+	if r.Timing != nil {
+		v := r.Timing.DeepCopy()
+		out.Timing = &v
+	}
+	if r.AsNeededBoolean != nil {
+		v := *r.AsNeededBoolean
+		out.AsNeededBoolean = &v
+	}
+	if r.AsNeededBooleanElement != nil {
+		v := r.AsNeededBooleanElement.DeepCopy()
+		out.AsNeededBooleanElement = &v
+	}
+	if r.AsNeededCodeableConcept != nil {
+		v := r.AsNeededCodeableConcept.DeepCopy()
+		out.AsNeededCodeableConcept = &v
+	}
+	if r.Site != nil {
+		v := r.Site.DeepCopy()
+		out.Site = &v
+	}
+	if r.Route != nil {
+		v := r.Route.DeepCopy()
+		out.Route = &v
+	}
+	if r.Method != nil {
+		v := r.Method.DeepCopy()
+		out.Method = &v
+	}
+	if r.DoseAndRate != nil {
+		out.DoseAndRate = make([]DosageDoseAndRate, len(r.DoseAndRate))
+		for i := range r.DoseAndRate {
+			out.DoseAndRate[i] = r.DoseAndRate[i].DeepCopy()
+		}
+	}
+	if r.MaxDosePerPeriod != nil {
+		v := r.MaxDosePerPeriod.DeepCopy()
+		out.MaxDosePerPeriod = &v
+	}
+	if r.MaxDosePerAdministration != nil {
+		v := r.MaxDosePerAdministration.DeepCopy()
+		out.MaxDosePerAdministration = &v
+	}
+	if r.MaxDosePerLifetime != nil {
+		v := r.MaxDosePerLifetime.DeepCopy()
+		out.MaxDosePerLifetime = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Dosage) Equal(other Dosage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && *r.Sequence != *other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if len(r.AdditionalInstruction) != len(other.AdditionalInstruction) {
+		return false
+	}
+	for i := range r.AdditionalInstruction {
+		if !r.AdditionalInstruction[i].Equal(other.AdditionalInstruction[i]) {
+			return false
+		}
+	}
+	if (r.PatientInstruction == nil) != (other.PatientInstruction == nil) || r.PatientInstruction != nil && *r.PatientInstruction != *other.PatientInstruction {
+		return false
+	}
+	if (r.PatientInstructionElement == nil) != (other.PatientInstructionElement == nil) || r.PatientInstructionElement != nil && !r.PatientInstructionElement.Equal(*other.PatientInstructionElement) {
+		return false
+	}
+	if (r.Timing == nil) != (other.Timing == nil) || r.Timing != nil && !r.Timing.Equal(*other.Timing) {
+		return false
+	}
+	if (r.AsNeededBoolean == nil) != (other.AsNeededBoolean == nil) || r.AsNeededBoolean != nil && *r.AsNeededBoolean != *other.AsNeededBoolean {
+		return false
+	}
+	if (r.AsNeededBooleanElement == nil) != (other.AsNeededBooleanElement == nil) || r.AsNeededBooleanElement != nil && !r.AsNeededBooleanElement.Equal(*other.AsNeededBooleanElement) {
+		return false
+	}
+	if (r.AsNeededCodeableConcept == nil) != (other.AsNeededCodeableConcept == nil) || r.AsNeededCodeableConcept != nil && !r.AsNeededCodeableConcept.Equal(*other.AsNeededCodeableConcept) {
+		return false
+	}
+	if (r.Site == nil) != (other.Site == nil) || r.Site != nil && !r.Site.Equal(*other.Site) {
+		return false
+	}
+	if (r.Route == nil) != (other.Route == nil) || r.Route != nil && !r.Route.Equal(*other.Route) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.Equal(*other.Method) {
+		return false
+	}
+	if len(r.DoseAndRate) != len(other.DoseAndRate) {
+		return false
+	}
+	for i := range r.DoseAndRate {
+		if !r.DoseAndRate[i].Equal(other.DoseAndRate[i]) {
+			return false
+		}
+	}
+	if (r.MaxDosePerPeriod == nil) != (other.MaxDosePerPeriod == nil) || r.MaxDosePerPeriod != nil && !r.MaxDosePerPeriod.Equal(*other.MaxDosePerPeriod) {
+		return false
+	}
+	if (r.MaxDosePerAdministration == nil) != (other.MaxDosePerAdministration == nil) || r.MaxDosePerAdministration != nil && !r.MaxDosePerAdministration.Equal(*other.MaxDosePerAdministration) {
+		return false
+	}
+	if (r.MaxDosePerLifetime == nil) != (other.MaxDosePerLifetime == nil) || r.MaxDosePerLifetime != nil && !r.MaxDosePerLifetime.Equal(*other.MaxDosePerLifetime) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Dosage) EqualsDeep(other Dosage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && *r.Sequence != *other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if !equivalentList(len(r.AdditionalInstruction), len(other.AdditionalInstruction), func(i, j int) bool {
+		return r.AdditionalInstruction[i].EqualsDeep(other.AdditionalInstruction[j])
+	}) {
+		return false
+	}
+	if (r.PatientInstruction == nil) != (other.PatientInstruction == nil) || r.PatientInstruction != nil && !equivalentString(*r.PatientInstruction, *other.PatientInstruction) {
+		return false
+	}
+	if (r.PatientInstructionElement == nil) != (other.PatientInstructionElement == nil) || r.PatientInstructionElement != nil && !r.PatientInstructionElement.EqualsDeep(*other.PatientInstructionElement) {
+		return false
+	}
+	if (r.Timing == nil) != (other.Timing == nil) || r.Timing != nil && !r.Timing.EqualsDeep(*other.Timing) {
+		return false
+	}
+	if (r.AsNeededBoolean == nil) != (other.AsNeededBoolean == nil) || r.AsNeededBoolean != nil && *r.AsNeededBoolean != *other.AsNeededBoolean {
+		return false
+	}
+	if (r.AsNeededBooleanElement == nil) != (other.AsNeededBooleanElement == nil) || r.AsNeededBooleanElement != nil && !r.AsNeededBooleanElement.EqualsDeep(*other.AsNeededBooleanElement) {
+		return false
+	}
+	if (r.AsNeededCodeableConcept == nil) != (other.AsNeededCodeableConcept == nil) || r.AsNeededCodeableConcept != nil && !r.AsNeededCodeableConcept.EqualsDeep(*other.AsNeededCodeableConcept) {
+		return false
+	}
+	if (r.Site == nil) != (other.Site == nil) || r.Site != nil && !r.Site.EqualsDeep(*other.Site) {
+		return false
+	}
+	if (r.Route == nil) != (other.Route == nil) || r.Route != nil && !r.Route.EqualsDeep(*other.Route) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.EqualsDeep(*other.Method) {
+		return false
+	}
+	if !equivalentList(len(r.DoseAndRate), len(other.DoseAndRate), func(i, j int) bool {
+		return r.DoseAndRate[i].EqualsDeep(other.DoseAndRate[j])
+	}) {
+		return false
+	}
+	if (r.MaxDosePerPeriod == nil) != (other.MaxDosePerPeriod == nil) || r.MaxDosePerPeriod != nil && !r.MaxDosePerPeriod.EqualsDeep(*other.MaxDosePerPeriod) {
+		return false
+	}
+	if (r.MaxDosePerAdministration == nil) != (other.MaxDosePerAdministration == nil) || r.MaxDosePerAdministration != nil && !r.MaxDosePerAdministration.EqualsDeep(*other.MaxDosePerAdministration) {
+		return false
+	}
+	if (r.MaxDosePerLifetime == nil) != (other.MaxDosePerLifetime == nil) || r.MaxDosePerLifetime != nil && !r.MaxDosePerLifetime.EqualsDeep(*other.MaxDosePerLifetime) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Dosage) EqualsShallow(other Dosage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && *r.Sequence != *other.Sequence {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.PatientInstruction == nil) != (other.PatientInstruction == nil) || r.PatientInstruction != nil && !equivalentString(*r.PatientInstruction, *other.PatientInstruction) {
+		return false
+	}
+	if (r.AsNeededBoolean == nil) != (other.AsNeededBoolean == nil) || r.AsNeededBoolean != nil && *r.AsNeededBoolean != *other.AsNeededBoolean {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Dosage as FHIR XML
+func (r Dosage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	for _, v := range r.AdditionalInstruction {
+		if err := encodeXMLElement(e, "additionalInstruction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "patientInstruction", r.PatientInstruction, r.PatientInstructionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timing", r.Timing); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "asNeededBoolean", r.AsNeededBoolean, r.AsNeededBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "asNeededCodeableConcept", r.AsNeededCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "site", r.Site); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "route", r.Route); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "method", r.Method); err != nil {
+		return err
+	}
+	for _, v := range r.DoseAndRate {
+		if err := encodeXMLElement(e, "doseAndRate", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "maxDosePerPeriod", r.MaxDosePerPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "maxDosePerAdministration", r.MaxDosePerAdministration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "maxDosePerLifetime", r.MaxDosePerLifetime); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Dosage from FHIR XML
+func (r *Dosage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = &v
+				}
+				r.SequenceElement = element
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			case "additionalInstruction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditionalInstruction = append(r.AdditionalInstruction, v)
+			case "patientInstruction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatientInstruction = &v
+				}
+				r.PatientInstructionElement = element
+			case "timing":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Timing = &v
+			case "asNeededBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AsNeededBoolean = &v
+				}
+				r.AsNeededBooleanElement = element
+			case "asNeededCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AsNeededCodeableConcept = &v
+			case "site":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Site = &v
+			case "route":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Route = &v
+			case "method":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Method = &v
+			case "doseAndRate":
+				var v DosageDoseAndRate
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DoseAndRate = append(r.DoseAndRate, v)
+			case "maxDosePerPeriod":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxDosePerPeriod = &v
+			case "maxDosePerAdministration":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxDosePerAdministration = &v
+			case "maxDosePerLifetime":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxDosePerLifetime = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Dosage as properties to the RDF node n
+func (r Dosage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Dosage.sequence", -1, r.Sequence, r.SequenceElement, "integer")
+	n.primitive("Dosage.text", -1, r.Text, r.TextElement, "string")
+	for i, v := range r.AdditionalInstruction {
+		n.element("Dosage.additionalInstruction", i, v)
+	}
+	n.primitive("Dosage.patientInstruction", -1, r.PatientInstruction, r.PatientInstructionElement, "string")
+	n.element("Dosage.timing", -1, r.Timing)
+	n.primitive("Dosage.asNeededBoolean", -1, r.AsNeededBoolean, r.AsNeededBooleanElement, "boolean")
+	n.element("Dosage.asNeededCodeableConcept", -1, r.AsNeededCodeableConcept)
+	n.element("Dosage.site", -1, r.Site)
+	n.element("Dosage.route", -1, r.Route)
+	n.element("Dosage.method", -1, r.Method)
+	for i, v := range r.DoseAndRate {
+		n.element("Dosage.doseAndRate", i, v)
+	}
+	n.element("Dosage.maxDosePerPeriod", -1, r.MaxDosePerPeriod)
+	n.element("Dosage.maxDosePerAdministration", -1, r.MaxDosePerAdministration)
+	n.element("Dosage.maxDosePerLifetime", -1, r.MaxDosePerLifetime)
+}
+
+// MarshalBSON marshals the given Dosage as BSON document with the structure of its FHIR JSON
+func (r Dosage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Dosage from a BSON document with the structure of its FHIR JSON
+func (r *Dosage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Dosage as protobuf message Dosage of fhir.proto
+func (r Dosage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Dosage to the encoded message
+func (r Dosage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != nil {
+		e.varint(4, uint64(int64(*r.Sequence)))
+	}
+	if r.Text != nil {
+		e.string(5, *r.Text)
+	}
+	for _, v := range r.AdditionalInstruction {
+		e.message(6, v)
+	}
+	if r.PatientInstruction != nil {
+		e.string(7, *r.PatientInstruction)
+	}
+	if r.Timing != nil {
+		e.message(8, *r.Timing)
+	}
+	if r.AsNeededBoolean != nil {
+		e.varint(9, protoBool(*r.AsNeededBoolean))
+	}
+	if r.AsNeededCodeableConcept != nil {
+		e.message(10, *r.AsNeededCodeableConcept)
+	}
+	if r.Site != nil {
+		e.message(11, *r.Site)
+	}
+	if r.Route != nil {
+		e.message(12, *r.Route)
+	}
+	if r.Method != nil {
+		e.message(13, *r.Method)
+	}
+	for _, v := range r.DoseAndRate {
+		e.message(14, v)
+	}
+	if r.MaxDosePerPeriod != nil {
+		e.message(15, *r.MaxDosePerPeriod)
+	}
+	if r.MaxDosePerAdministration != nil {
+		e.message(16, *r.MaxDosePerAdministration)
+	}
+	if r.MaxDosePerLifetime != nil {
+		e.message(17, *r.MaxDosePerLifetime)
+	}
+	if r.SequenceElement != nil {
+		e.message(18, *r.SequenceElement)
+	}
+	if r.TextElement != nil {
+		e.message(19, *r.TextElement)
+	}
+	if r.PatientInstructionElement != nil {
+		e.message(20, *r.PatientInstructionElement)
+	}
+	if r.AsNeededBooleanElement != nil {
+		e.message(21, *r.AsNeededBooleanElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Dosage from protobuf message Dosage of fhir.proto
+func (r *Dosage) UnmarshalProto(b []byte) error {
+	*r = Dosage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Sequence = &v
+		case 5:
+			v := d.string()
+			r.Text = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdditionalInstruction = append(r.AdditionalInstruction, v)
+		case 7:
+			v := d.string()
+			r.PatientInstruction = &v
+		case 8:
+			var v Timing
+			d.message(&v)
+			r.Timing = &v
+		case 9:
+			v := d.bool()
+			r.AsNeededBoolean = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.AsNeededCodeableConcept = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Site = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Route = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Method = &v
+		case 14:
+			var v DosageDoseAndRate
+			d.message(&v)
+			r.DoseAndRate = append(r.DoseAndRate, v)
+		case 15:
+			var v Ratio
+			d.message(&v)
+			r.MaxDosePerPeriod = &v
+		case 16:
+			var v Quantity
+			d.message(&v)
+			r.MaxDosePerAdministration = &v
+		case 17:
+			var v Quantity
+			d.message(&v)
+			r.MaxDosePerLifetime = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.PatientInstructionElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.AsNeededBooleanElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DosageDoseAndRate as JSON into a byte slice
+func (r DosageDoseAndRate) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DosageDoseAndRate as JSON object with the members in the order of the fields
+func (r DosageDoseAndRate) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.DoseRange != nil {
+		e.key("doseRange")
+		r.DoseRange.appendJSON(e)
+	}
+	if r.DoseQuantity != nil {
+		e.key("doseQuantity")
+		r.DoseQuantity.appendJSON(e)
+	}
+	if r.RateRatio != nil {
+		e.key("rateRatio")
+		r.RateRatio.appendJSON(e)
+	}
+	if r.RateRange != nil {
+		e.key("rateRange")
+		r.RateRange.appendJSON(e)
+	}
+	if r.RateQuantity != nil {
+		e.key("rateQuantity")
+		r.RateQuantity.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DosageDoseAndRate from JSON. Unknown members are ignored.
+func (r *DosageDoseAndRate) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DosageDoseAndRate
+func (r *DosageDoseAndRate) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "doseRange":
+			if d.null() {
+				r.DoseRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.DoseRange = &v
+			}
+		case "doseQuantity":
+			if d.null() {
+				r.DoseQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.DoseQuantity = &v
+			}
+		case "rateRatio":
+			if d.null() {
+				r.RateRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.RateRatio = &v
+			}
+		case "rateRange":
+			if d.null() {
+				r.RateRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.RateRange = &v
+			}
+		case "rateQuantity":
+			if d.null() {
+				r.RateQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.RateQuantity = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DosageDoseAndRate which shares no memory with the original
+func (r DosageDoseAndRate) DeepCopy() DosageDoseAndRate {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.DoseRange != nil {
+		v := r.DoseRange.DeepCopy()
+		out.DoseRange = &v
+	}
+	if r.DoseQuantity != nil {
+		v := r.DoseQuantity.DeepCopy()
+		out.DoseQuantity = &v
+	}
+	if r.RateRatio != nil {
+		v := r.RateRatio.DeepCopy()
+		out.RateRatio = &v
+	}
+	if r.RateRange != nil {
+		v := r.RateRange.DeepCopy()
+		out.RateRange = &v
+	}
+	if r.RateQuantity != nil {
+		v := r.RateQuantity.DeepCopy()
+		out.RateQuantity = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DosageDoseAndRate) Equal(other DosageDoseAndRate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.DoseRange == nil) != (other.DoseRange == nil) || r.DoseRange != nil && !r.DoseRange.Equal(*other.DoseRange) {
+		return false
+	}
+	if (r.DoseQuantity == nil) != (other.DoseQuantity == nil) || r.DoseQuantity != nil && !r.DoseQuantity.Equal(*other.DoseQuantity) {
+		return false
+	}
+	if (r.RateRatio == nil) != (other.RateRatio == nil) || r.RateRatio != nil && !r.RateRatio.Equal(*other.RateRatio) {
+		return false
+	}
+	if (r.RateRange == nil) != (other.RateRange == nil) || r.RateRange != nil && !r.RateRange.Equal(*other.RateRange) {
+		return false
+	}
+	if (r.RateQuantity == nil) != (other.RateQuantity == nil) || r.RateQuantity != nil && !r.RateQuantity.Equal(*other.RateQuantity) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DosageDoseAndRate) EqualsDeep(other DosageDoseAndRate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.DoseRange == nil) != (other.DoseRange == nil) || r.DoseRange != nil && !r.DoseRange.EqualsDeep(*other.DoseRange) {
+		return false
+	}
+	if (r.DoseQuantity == nil) != (other.DoseQuantity == nil) || r.DoseQuantity != nil && !r.DoseQuantity.EqualsDeep(*other.DoseQuantity) {
+		return false
+	}
+	if (r.RateRatio == nil) != (other.RateRatio == nil) || r.RateRatio != nil && !r.RateRatio.EqualsDeep(*other.RateRatio) {
+		return false
+	}
+	if (r.RateRange == nil) != (other.RateRange == nil) || r.RateRange != nil && !r.RateRange.EqualsDeep(*other.RateRange) {
+		return false
+	}
+	if (r.RateQuantity == nil) != (other.RateQuantity == nil) || r.RateQuantity != nil && !r.RateQuantity.EqualsDeep(*other.RateQuantity) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DosageDoseAndRate) EqualsShallow(other DosageDoseAndRate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DosageDoseAndRate as FHIR XML
+func (r DosageDoseAndRate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "doseRange", r.DoseRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "doseQuantity", r.DoseQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "rateRatio", r.RateRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "rateRange", r.RateRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "rateQuantity", r.RateQuantity); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DosageDoseAndRate from FHIR XML
+func (r *DosageDoseAndRate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "doseRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DoseRange = &v
+			case "doseQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DoseQuantity = &v
+			case "rateRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RateRatio = &v
+			case "rateRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RateRange = &v
+			case "rateQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RateQuantity = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DosageDoseAndRate as properties to the RDF node n
+func (r DosageDoseAndRate) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.element("Dosage.doseAndRate.type", -1, r.Type)
+	n.element("Dosage.doseAndRate.doseRange", -1, r.DoseRange)
+	n.element("Dosage.doseAndRate.doseQuantity", -1, r.DoseQuantity)
+	n.element("Dosage.doseAndRate.rateRatio", -1, r.RateRatio)
+	n.element("Dosage.doseAndRate.rateRange", -1, r.RateRange)
+	n.element("Dosage.doseAndRate.rateQuantity", -1, r.RateQuantity)
+}
+
+// MarshalBSON marshals the given DosageDoseAndRate as BSON document with the structure of its FHIR JSON
+func (r DosageDoseAndRate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DosageDoseAndRate from a BSON document with the structure of its FHIR JSON
+func (r *DosageDoseAndRate) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DosageDoseAndRate as protobuf message DosageDoseAndRate of fhir.proto
+func (r DosageDoseAndRate) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DosageDoseAndRate to the encoded message
+func (r DosageDoseAndRate) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Type != nil {
+		e.message(3, *r.Type)
+	}
+	if r.DoseRange != nil {
+		e.message(4, *r.DoseRange)
+	}
+	if r.DoseQuantity != nil {
+		e.message(5, *r.DoseQuantity)
+	}
+	if r.RateRatio != nil {
+		e.message(6, *r.RateRatio)
+	}
+	if r.RateRange != nil {
+		e.message(7, *r.RateRange)
+	}
+	if r.RateQuantity != nil {
+		e.message(8, *r.RateQuantity)
+	}
+}
+
+// UnmarshalProto unmarshals the given DosageDoseAndRate from protobuf message DosageDoseAndRate of fhir.proto
+func (r *DosageDoseAndRate) UnmarshalProto(b []byte) error {
+	*r = DosageDoseAndRate{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 4:
+			var v Range
+			d.message(&v)
+			r.DoseRange = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.DoseQuantity = &v
+		case 6:
+			var v Ratio
+			d.message(&v)
+			r.RateRatio = &v
+		case 7:
+			var v Range
+			d.message(&v)
+			r.RateRange = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.RateQuantity = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
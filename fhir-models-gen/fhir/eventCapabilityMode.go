@@ -0,0 +1,108 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// EventCapabilityMode is documented here http://hl7.org/fhir/ValueSet/event-capability-mode
+type EventCapabilityMode int
+
+const (
+	EventCapabilityModeSender EventCapabilityMode = iota
+	EventCapabilityModeReceiver
+)
+
+func (code EventCapabilityMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(code.Code())
+}
+func (code *EventCapabilityMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
+	switch s {
+	case "sender":
+		*code = EventCapabilityModeSender
+	case "receiver":
+		*code = EventCapabilityModeReceiver
+	default:
+		return fmt.Errorf("unknown EventCapabilityMode code `%s`", s)
+	}
+	return nil
+}
+func (code EventCapabilityMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EventCapabilityMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
+func (code EventCapabilityMode) String() string {
+	return code.Code()
+}
+func (code EventCapabilityMode) Code() string {
+	switch code {
+	case EventCapabilityModeSender:
+		return "sender"
+	case EventCapabilityModeReceiver:
+		return "receiver"
+	}
+	return "<unknown>"
+}
+func (code EventCapabilityMode) Display() string {
+	switch code {
+	case EventCapabilityModeSender:
+		return "Sender"
+	case EventCapabilityModeReceiver:
+		return "Receiver"
+	}
+	return "<unknown>"
+}
+func (code EventCapabilityMode) Definition() string {
+	switch code {
+	case EventCapabilityModeSender:
+		return "The application sends requests and receives responses."
+	case EventCapabilityModeReceiver:
+		return "The application receives requests and sends responses."
+	}
+	return "<unknown>"
+}
+func (code EventCapabilityMode) protoNumber() uint64 {
+	switch code {
+	case EventCapabilityModeSender:
+		return 1
+	case EventCapabilityModeReceiver:
+		return 2
+	}
+	return 0
+}
+func (code *EventCapabilityMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EventCapabilityModeSender
+	case 2:
+		*code = EventCapabilityModeReceiver
+	default:
+		return false
+	}
+	return true
+}
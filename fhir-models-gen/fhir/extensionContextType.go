@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ExtensionContextType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ExtensionContextType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ExtensionContextType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "fhirpath":
 		*code = ExtensionContextTypeFhirpath
@@ -49,6 +53,12 @@ func (code *ExtensionContextType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ExtensionContextType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ExtensionContextType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ExtensionContextType) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ExtensionContextType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ExtensionContextType) protoNumber() uint64 {
+	switch code {
+	case ExtensionContextTypeFhirpath:
+		return 1
+	case ExtensionContextTypeElement:
+		return 2
+	case ExtensionContextTypeExtension:
+		return 3
+	}
+	return 0
+}
+func (code *ExtensionContextType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ExtensionContextTypeFhirpath
+	case 2:
+		*code = ExtensionContextTypeElement
+	case 3:
+		*code = ExtensionContextTypeExtension
+	default:
+		return false
+	}
+	return true
+}
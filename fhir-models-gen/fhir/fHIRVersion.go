@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -54,8 +53,13 @@ const (
 func (code FHIRVersion) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *FHIRVersion) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *FHIRVersion) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "0.01":
 		*code = FHIRVersion0_01
@@ -106,6 +110,12 @@ func (code *FHIRVersion) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code FHIRVersion) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *FHIRVersion) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code FHIRVersion) String() string {
 	return code.Code()
 }
@@ -256,3 +266,103 @@ func (code FHIRVersion) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code FHIRVersion) protoNumber() uint64 {
+	switch code {
+	case FHIRVersion0_01:
+		return 1
+	case FHIRVersion0_05:
+		return 2
+	case FHIRVersion0_06:
+		return 3
+	case FHIRVersion0_11:
+		return 4
+	case FHIRVersion0_0_80:
+		return 5
+	case FHIRVersion0_0_81:
+		return 6
+	case FHIRVersion0_0_82:
+		return 7
+	case FHIRVersion0_4_0:
+		return 8
+	case FHIRVersion0_5_0:
+		return 9
+	case FHIRVersion1_0_0:
+		return 10
+	case FHIRVersion1_0_1:
+		return 11
+	case FHIRVersion1_0_2:
+		return 12
+	case FHIRVersion1_1_0:
+		return 13
+	case FHIRVersion1_4_0:
+		return 14
+	case FHIRVersion1_6_0:
+		return 15
+	case FHIRVersion1_8_0:
+		return 16
+	case FHIRVersion3_0_0:
+		return 17
+	case FHIRVersion3_0_1:
+		return 18
+	case FHIRVersion3_3_0:
+		return 19
+	case FHIRVersion3_5_0:
+		return 20
+	case FHIRVersion4_0_0:
+		return 21
+	case FHIRVersion4_0_1:
+		return 22
+	}
+	return 0
+}
+func (code *FHIRVersion) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = FHIRVersion0_01
+	case 2:
+		*code = FHIRVersion0_05
+	case 3:
+		*code = FHIRVersion0_06
+	case 4:
+		*code = FHIRVersion0_11
+	case 5:
+		*code = FHIRVersion0_0_80
+	case 6:
+		*code = FHIRVersion0_0_81
+	case 7:
+		*code = FHIRVersion0_0_82
+	case 8:
+		*code = FHIRVersion0_4_0
+	case 9:
+		*code = FHIRVersion0_5_0
+	case 10:
+		*code = FHIRVersion1_0_0
+	case 11:
+		*code = FHIRVersion1_0_1
+	case 12:
+		*code = FHIRVersion1_0_2
+	case 13:
+		*code = FHIRVersion1_1_0
+	case 14:
+		*code = FHIRVersion1_4_0
+	case 15:
+		*code = FHIRVersion1_6_0
+	case 16:
+		*code = FHIRVersion1_8_0
+	case 17:
+		*code = FHIRVersion3_0_0
+	case 18:
+		*code = FHIRVersion3_0_1
+	case 19:
+		*code = FHIRVersion3_3_0
+	case 20:
+		*code = FHIRVersion3_5_0
+	case 21:
+		*code = FHIRVersion4_0_0
+	case 22:
+		*code = FHIRVersion4_0_1
+	default:
+		return false
+	}
+	return true
+}
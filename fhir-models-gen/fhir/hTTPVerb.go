@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code HTTPVerb) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *HTTPVerb) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *HTTPVerb) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "GET":
 		*code = HTTPVerbGET
@@ -58,6 +62,12 @@ func (code *HTTPVerb) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code HTTPVerb) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *HTTPVerb) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code HTTPVerb) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code HTTPVerb) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code HTTPVerb) protoNumber() uint64 {
+	switch code {
+	case HTTPVerbGET:
+		return 1
+	case HTTPVerbHEAD:
+		return 2
+	case HTTPVerbPOST:
+		return 3
+	case HTTPVerbPUT:
+		return 4
+	case HTTPVerbDELETE:
+		return 5
+	case HTTPVerbPATCH:
+		return 6
+	}
+	return 0
+}
+func (code *HTTPVerb) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = HTTPVerbGET
+	case 2:
+		*code = HTTPVerbHEAD
+	case 3:
+		*code = HTTPVerbPOST
+	case 4:
+		*code = HTTPVerbPUT
+	case 5:
+		*code = HTTPVerbDELETE
+	case 6:
+		*code = HTTPVerbPATCH
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code IdentifierUse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *IdentifierUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *IdentifierUse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "usual":
 		*code = IdentifierUseUsual
@@ -55,6 +59,12 @@ func (code *IdentifierUse) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code IdentifierUse) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *IdentifierUse) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code IdentifierUse) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code IdentifierUse) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code IdentifierUse) protoNumber() uint64 {
+	switch code {
+	case IdentifierUseUsual:
+		return 1
+	case IdentifierUseOfficial:
+		return 2
+	case IdentifierUseTemp:
+		return 3
+	case IdentifierUseSecondary:
+		return 4
+	case IdentifierUseOld:
+		return 5
+	}
+	return 0
+}
+func (code *IdentifierUse) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = IdentifierUseUsual
+	case 2:
+		*code = IdentifierUseOfficial
+	case 3:
+		*code = IdentifierUseTemp
+	case 4:
+		*code = IdentifierUseSecondary
+	case 5:
+		*code = IdentifierUseOld
+	default:
+		return false
+	}
+	return true
+}
@@ -14,17 +14,660 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Meta is documented here http://hl7.org/fhir/StructureDefinition/Meta
 type Meta struct {
-	Id          *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	VersionId   *string     `bson:"versionId,omitempty" json:"versionId,omitempty"`
-	LastUpdated *string     `bson:"lastUpdated,omitempty" json:"lastUpdated,omitempty"`
-	Source      *string     `bson:"source,omitempty" json:"source,omitempty"`
-	Profile     []string    `bson:"profile,omitempty" json:"profile,omitempty"`
-	Security    []Coding    `bson:"security,omitempty" json:"security,omitempty"`
-	Tag         []Coding    `bson:"tag,omitempty" json:"tag,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	VersionId          *string     `bson:"versionId,omitempty" json:"versionId,omitempty"`
+	VersionIdElement   *Element    `bson:"_versionId,omitempty" json:"_versionId,omitempty"`
+	LastUpdated        *string     `bson:"lastUpdated,omitempty" json:"lastUpdated,omitempty"`
+	LastUpdatedElement *Element    `bson:"_lastUpdated,omitempty" json:"_lastUpdated,omitempty"`
+	Source             *string     `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement      *Element    `bson:"_source,omitempty" json:"_source,omitempty"`
+	Profile            []string    `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement     []*Element  `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	Security           []Coding    `bson:"security,omitempty" json:"security,omitempty"`
+	Tag                []Coding    `bson:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// MarshalJSON marshals the given Meta as JSON into a byte slice
+func (r Meta) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Meta as JSON object with the members in the order of the fields
+func (r Meta) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.VersionId != nil {
+		e.key("versionId")
+		e.string(*r.VersionId)
+	}
+	if r.VersionIdElement != nil {
+		e.key("_versionId")
+		r.VersionIdElement.appendJSON(e)
+	}
+	if r.LastUpdated != nil {
+		e.key("lastUpdated")
+		e.string(*r.LastUpdated)
+	}
+	if r.LastUpdatedElement != nil {
+		e.key("_lastUpdated")
+		r.LastUpdatedElement.appendJSON(e)
+	}
+	if r.Source != nil {
+		e.key("source")
+		e.string(*r.Source)
+	}
+	if r.SourceElement != nil {
+		e.key("_source")
+		r.SourceElement.appendJSON(e)
+	}
+	if len(r.Profile) > 0 {
+		e.key("profile")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Profile {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProfileElement) > 0 {
+		e.key("_profile")
+		e.elements(r.ProfileElement, len(r.Profile))
+	}
+	if len(r.Security) > 0 {
+		e.key("security")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Security {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Tag) > 0 {
+		e.key("tag")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Tag {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Meta from JSON. Unknown members are ignored.
+func (r *Meta) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Meta
+func (r *Meta) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "versionId":
+			if v, ok := d.string(); ok {
+				r.VersionId = &v
+			} else {
+				r.VersionId = nil
+			}
+		case "_versionId":
+			r.VersionIdElement = d.element()
+		case "lastUpdated":
+			if v, ok := d.string(); ok {
+				r.LastUpdated = &v
+			} else {
+				r.LastUpdated = nil
+			}
+		case "_lastUpdated":
+			r.LastUpdatedElement = d.element()
+		case "source":
+			if v, ok := d.string(); ok {
+				r.Source = &v
+			} else {
+				r.Source = nil
+			}
+		case "_source":
+			r.SourceElement = d.element()
+		case "profile":
+			a := d.array()
+			r.Profile = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Profile = append(r.Profile, v)
+			}
+			if r.Profile == nil && !a.isNull {
+				r.Profile = []string{}
+			}
+		case "_profile":
+			a := d.array()
+			r.ProfileElement = nil
+			for a.next() {
+				r.ProfileElement = append(r.ProfileElement, d.element())
+			}
+		case "security":
+			a := d.array()
+			r.Security = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Security = append(r.Security, v)
+			}
+			if r.Security == nil && !a.isNull {
+				r.Security = []Coding{}
+			}
+		case "tag":
+			a := d.array()
+			r.Tag = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Tag = append(r.Tag, v)
+			}
+			if r.Tag == nil && !a.isNull {
+				r.Tag = []Coding{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Meta which shares no memory with the original
+func (r Meta) DeepCopy() Meta {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.VersionId != nil {
+		v := *r.VersionId
+		out.VersionId = &v
+	}
+	if r.VersionIdElement != nil {
+		v := r.VersionIdElement.DeepCopy()
+		out.VersionIdElement = &v
+	}
+	if r.LastUpdated != nil {
+		v := *r.LastUpdated
+		out.LastUpdated = &v
+	}
+	if r.LastUpdatedElement != nil {
+		v := r.LastUpdatedElement.DeepCopy()
+		out.LastUpdatedElement = &v
+	}
+	if r.Source != nil {
+		v := *r.Source
+		out.Source = &v
+	}
+	if r.SourceElement != nil {
+		v := r.SourceElement.DeepCopy()
+		out.SourceElement = &v
+	}
+	if r.Profile != nil {
+		out.Profile = make([]string, len(r.Profile))
+		copy(out.Profile, r.Profile)
+	}
+	if r.ProfileElement != nil {
+		out.ProfileElement = make([]*Element, len(r.ProfileElement))
+		for i, v := range r.ProfileElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ProfileElement[i] = &c
+			}
+		}
+	}
+	if r.Security != nil {
+		out.Security = make([]Coding, len(r.Security))
+		for i := range r.Security {
+			out.Security[i] = r.Security[i].DeepCopy()
+		}
+	}
+	if r.Tag != nil {
+		out.Tag = make([]Coding, len(r.Tag))
+		for i := range r.Tag {
+			out.Tag[i] = r.Tag[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Meta) Equal(other Meta) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.VersionId == nil) != (other.VersionId == nil) || r.VersionId != nil && *r.VersionId != *other.VersionId {
+		return false
+	}
+	if (r.VersionIdElement == nil) != (other.VersionIdElement == nil) || r.VersionIdElement != nil && !r.VersionIdElement.Equal(*other.VersionIdElement) {
+		return false
+	}
+	if (r.LastUpdated == nil) != (other.LastUpdated == nil) || r.LastUpdated != nil && *r.LastUpdated != *other.LastUpdated {
+		return false
+	}
+	if (r.LastUpdatedElement == nil) != (other.LastUpdatedElement == nil) || r.LastUpdatedElement != nil && !r.LastUpdatedElement.Equal(*other.LastUpdatedElement) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && *r.Source != *other.Source {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.Equal(*other.SourceElement) {
+		return false
+	}
+	if len(r.Profile) != len(other.Profile) {
+		return false
+	}
+	for i := range r.Profile {
+		if r.Profile[i] != other.Profile[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ProfileElement, other.ProfileElement, false) {
+		return false
+	}
+	if len(r.Security) != len(other.Security) {
+		return false
+	}
+	for i := range r.Security {
+		if !r.Security[i].Equal(other.Security[i]) {
+			return false
+		}
+	}
+	if len(r.Tag) != len(other.Tag) {
+		return false
+	}
+	for i := range r.Tag {
+		if !r.Tag[i].Equal(other.Tag[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Meta) EqualsDeep(other Meta) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.VersionId == nil) != (other.VersionId == nil) || r.VersionId != nil && !equivalentString(*r.VersionId, *other.VersionId) {
+		return false
+	}
+	if (r.VersionIdElement == nil) != (other.VersionIdElement == nil) || r.VersionIdElement != nil && !r.VersionIdElement.EqualsDeep(*other.VersionIdElement) {
+		return false
+	}
+	if (r.LastUpdated == nil) != (other.LastUpdated == nil) || r.LastUpdated != nil && !equivalentString(*r.LastUpdated, *other.LastUpdated) {
+		return false
+	}
+	if (r.LastUpdatedElement == nil) != (other.LastUpdatedElement == nil) || r.LastUpdatedElement != nil && !r.LastUpdatedElement.EqualsDeep(*other.LastUpdatedElement) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.EqualsDeep(*other.SourceElement) {
+		return false
+	}
+	if !equivalentList(len(r.Profile), len(other.Profile), func(i, j int) bool {
+		return equivalentString(r.Profile[i], other.Profile[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ProfileElement, other.ProfileElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Security), len(other.Security), func(i, j int) bool {
+		return r.Security[i].EqualsDeep(other.Security[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Tag), len(other.Tag), func(i, j int) bool {
+		return r.Tag[i].EqualsDeep(other.Tag[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Meta) EqualsShallow(other Meta) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.VersionId == nil) != (other.VersionId == nil) || r.VersionId != nil && !equivalentString(*r.VersionId, *other.VersionId) {
+		return false
+	}
+	if (r.LastUpdated == nil) != (other.LastUpdated == nil) || r.LastUpdated != nil && !equivalentString(*r.LastUpdated, *other.LastUpdated) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	if !equivalentList(len(r.Profile), len(other.Profile), func(i, j int) bool {
+		return equivalentString(r.Profile[i], other.Profile[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Meta as FHIR XML
+func (r Meta) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "versionId", r.VersionId, r.VersionIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastUpdated", r.LastUpdated, r.LastUpdatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "source", r.Source, r.SourceElement); err != nil {
+		return err
+	}
+	for i, v := range r.Profile {
+		if err := encodeXMLPrimitive(e, "profile", v, elementAt(r.ProfileElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Security {
+		if err := encodeXMLElement(e, "security", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Tag {
+		if err := encodeXMLElement(e, "tag", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Meta from FHIR XML
+func (r *Meta) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "versionId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.VersionId = &v
+				}
+				r.VersionIdElement = element
+			case "lastUpdated":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastUpdated = &v
+				}
+				r.LastUpdatedElement = element
+			case "source":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Source = &v
+				}
+				r.SourceElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ProfileElement = appendElement(r.ProfileElement, len(r.Profile), element)
+					r.Profile = append(r.Profile, v)
+				}
+			case "security":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Security = append(r.Security, v)
+			case "tag":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Tag = append(r.Tag, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Meta as properties to the RDF node n
+func (r Meta) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Meta.versionId", -1, r.VersionId, r.VersionIdElement, "string")
+	n.primitive("Meta.lastUpdated", -1, r.LastUpdated, r.LastUpdatedElement, "instant")
+	n.primitive("Meta.source", -1, r.Source, r.SourceElement, "string")
+	for i, v := range r.Profile {
+		n.primitive("Meta.profile", i, v, elementAt(r.ProfileElement, i), "string")
+	}
+	for i, v := range r.Security {
+		n.element("Meta.security", i, v)
+	}
+	for i, v := range r.Tag {
+		n.element("Meta.tag", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Meta as BSON document with the structure of its FHIR JSON
+func (r Meta) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Meta from a BSON document with the structure of its FHIR JSON
+func (r *Meta) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Meta as protobuf message Meta of fhir.proto
+func (r Meta) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Meta to the encoded message
+func (r Meta) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.VersionId != nil {
+		e.string(3, *r.VersionId)
+	}
+	if r.LastUpdated != nil {
+		e.string(4, *r.LastUpdated)
+	}
+	if r.Source != nil {
+		e.string(5, *r.Source)
+	}
+	for _, v := range r.Profile {
+		e.string(6, v)
+	}
+	for _, v := range r.Security {
+		e.message(7, v)
+	}
+	for _, v := range r.Tag {
+		e.message(8, v)
+	}
+	if r.VersionIdElement != nil {
+		e.message(9, *r.VersionIdElement)
+	}
+	if r.LastUpdatedElement != nil {
+		e.message(10, *r.LastUpdatedElement)
+	}
+	if r.SourceElement != nil {
+		e.message(11, *r.SourceElement)
+	}
+	for _, v := range r.ProfileElement {
+		e.element(12, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given Meta from protobuf message Meta of fhir.proto
+func (r *Meta) UnmarshalProto(b []byte) error {
+	*r = Meta{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.VersionId = &v
+		case 4:
+			v := d.string()
+			r.LastUpdated = &v
+		case 5:
+			v := d.string()
+			r.Source = &v
+		case 6:
+			r.Profile = append(r.Profile, d.string())
+		case 7:
+			var v Coding
+			d.message(&v)
+			r.Security = append(r.Security, v)
+		case 8:
+			var v Coding
+			d.message(&v)
+			r.Tag = append(r.Tag, v)
+		case 9:
+			var v Element
+			d.message(&v)
+			r.VersionIdElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.LastUpdatedElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.SourceElement = &v
+		case 12:
+			r.ProfileElement = append(r.ProfileElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
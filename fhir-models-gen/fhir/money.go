@@ -14,15 +14,377 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Money is documented here http://hl7.org/fhir/StructureDefinition/Money
 type Money struct {
-	Id        *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	Value     *json.Number `bson:"value,omitempty" json:"value,omitempty"`
-	Currency  *string      `bson:"currency,omitempty" json:"currency,omitempty"`
+	Id              *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension       []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	Value           *json.Number `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement    *Element     `bson:"_value,omitempty" json:"_value,omitempty"`
+	Currency        *string      `bson:"currency,omitempty" json:"currency,omitempty"`
+	CurrencyElement *Element     `bson:"_currency,omitempty" json:"_currency,omitempty"`
+}
+
+// MarshalJSON marshals the given Money as JSON into a byte slice
+func (r Money) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Money as JSON object with the members in the order of the fields
+func (r Money) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Value != nil {
+		e.key("value")
+		e.number(*r.Value)
+	}
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	if r.Currency != nil {
+		e.key("currency")
+		e.string(*r.Currency)
+	}
+	if r.CurrencyElement != nil {
+		e.key("_currency")
+		r.CurrencyElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Money from JSON. Unknown members are ignored.
+func (r *Money) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Money
+func (r *Money) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "value":
+			if v, ok := d.number(); ok {
+				r.Value = &v
+			} else {
+				r.Value = nil
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		case "currency":
+			if v, ok := d.string(); ok {
+				r.Currency = &v
+			} else {
+				r.Currency = nil
+			}
+		case "_currency":
+			r.CurrencyElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Money which shares no memory with the original
+func (r Money) DeepCopy() Money {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Value != nil {
+		v := *r.Value
+		out.Value = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	if r.Currency != nil {
+		v := *r.Currency
+		out.Currency = &v
+	}
+	if r.CurrencyElement != nil {
+		v := r.CurrencyElement.DeepCopy()
+		out.CurrencyElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Money) Equal(other Money) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !EqualDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	if (r.Currency == nil) != (other.Currency == nil) || r.Currency != nil && *r.Currency != *other.Currency {
+		return false
+	}
+	if (r.CurrencyElement == nil) != (other.CurrencyElement == nil) || r.CurrencyElement != nil && !r.CurrencyElement.Equal(*other.CurrencyElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Money) EqualsDeep(other Money) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	if (r.Currency == nil) != (other.Currency == nil) || r.Currency != nil && !equivalentString(*r.Currency, *other.Currency) {
+		return false
+	}
+	if (r.CurrencyElement == nil) != (other.CurrencyElement == nil) || r.CurrencyElement != nil && !r.CurrencyElement.EqualsDeep(*other.CurrencyElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Money) EqualsShallow(other Money) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.Currency == nil) != (other.Currency == nil) || r.Currency != nil && !equivalentString(*r.Currency, *other.Currency) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Money as FHIR XML
+func (r Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "currency", r.Currency, r.CurrencyElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Money from FHIR XML
+func (r *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "value":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = &v
+				}
+				r.ValueElement = element
+			case "currency":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Currency = &v
+				}
+				r.CurrencyElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Money as properties to the RDF node n
+func (r Money) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Money.value", -1, r.Value, r.ValueElement, "decimal")
+	n.primitive("Money.currency", -1, r.Currency, r.CurrencyElement, "string")
+}
+
+// MarshalBSON marshals the given Money as BSON document with the structure of its FHIR JSON
+func (r Money) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Money from a BSON document with the structure of its FHIR JSON
+func (r *Money) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Money as protobuf message Money of fhir.proto
+func (r Money) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Money to the encoded message
+func (r Money) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Value != nil {
+		e.string(3, string(*r.Value))
+	}
+	if r.Currency != nil {
+		e.string(4, *r.Currency)
+	}
+	if r.ValueElement != nil {
+		e.message(5, *r.ValueElement)
+	}
+	if r.CurrencyElement != nil {
+		e.message(6, *r.CurrencyElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Money from protobuf message Money of fhir.proto
+func (r *Money) UnmarshalProto(b []byte) error {
+	*r = Money{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := json.Number(d.string())
+			r.Value = &v
+		case 4:
+			v := d.string()
+			r.Currency = &v
+		case 5:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.CurrencyElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
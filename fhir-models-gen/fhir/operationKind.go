@@ -0,0 +1,108 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// OperationKind is documented here http://hl7.org/fhir/ValueSet/operation-kind
+type OperationKind int
+
+const (
+	OperationKindOperation OperationKind = iota
+	OperationKindQuery
+)
+
+func (code OperationKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(code.Code())
+}
+func (code *OperationKind) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
+	switch s {
+	case "operation":
+		*code = OperationKindOperation
+	case "query":
+		*code = OperationKindQuery
+	default:
+		return fmt.Errorf("unknown OperationKind code `%s`", s)
+	}
+	return nil
+}
+func (code OperationKind) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *OperationKind) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
+func (code OperationKind) String() string {
+	return code.Code()
+}
+func (code OperationKind) Code() string {
+	switch code {
+	case OperationKindOperation:
+		return "operation"
+	case OperationKindQuery:
+		return "query"
+	}
+	return "<unknown>"
+}
+func (code OperationKind) Display() string {
+	switch code {
+	case OperationKindOperation:
+		return "Operation"
+	case OperationKindQuery:
+		return "Query"
+	}
+	return "<unknown>"
+}
+func (code OperationKind) Definition() string {
+	switch code {
+	case OperationKindOperation:
+		return "This operation is invoked as an operation."
+	case OperationKindQuery:
+		return "This operation is a named query, invoked using the search mechanism."
+	}
+	return "<unknown>"
+}
+func (code OperationKind) protoNumber() uint64 {
+	switch code {
+	case OperationKindOperation:
+		return 1
+	case OperationKindQuery:
+		return 2
+	}
+	return 0
+}
+func (code *OperationKind) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = OperationKindOperation
+	case 2:
+		*code = OperationKindQuery
+	default:
+		return false
+	}
+	return true
+}
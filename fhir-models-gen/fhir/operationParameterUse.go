@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code OperationParameterUse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *OperationParameterUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *OperationParameterUse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "in":
 		*code = OperationParameterUseIn
@@ -46,6 +50,12 @@ func (code *OperationParameterUse) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code OperationParameterUse) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *OperationParameterUse) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code OperationParameterUse) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code OperationParameterUse) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code OperationParameterUse) protoNumber() uint64 {
+	switch code {
+	case OperationParameterUseIn:
+		return 1
+	case OperationParameterUseOut:
+		return 2
+	}
+	return 0
+}
+func (code *OperationParameterUse) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = OperationParameterUseIn
+	case 2:
+		*code = OperationParameterUseOut
+	default:
+		return false
+	}
+	return true
+}
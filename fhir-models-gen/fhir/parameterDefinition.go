@@ -14,18 +14,694 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ParameterDefinition is documented here http://hl7.org/fhir/StructureDefinition/ParameterDefinition
 type ParameterDefinition struct {
-	Id            *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension     []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	Name          *string               `bson:"name,omitempty" json:"name,omitempty"`
-	Use           OperationParameterUse `bson:"use" json:"use"`
-	Min           *int                  `bson:"min,omitempty" json:"min,omitempty"`
-	Max           *string               `bson:"max,omitempty" json:"max,omitempty"`
-	Documentation *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Type          string                `bson:"type" json:"type"`
-	Profile       *string               `bson:"profile,omitempty" json:"profile,omitempty"`
+	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	Name                 *string               `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element              `bson:"_name,omitempty" json:"_name,omitempty"`
+	Use                  OperationParameterUse `bson:"use" json:"use"`
+	UseElement           *Element              `bson:"_use,omitempty" json:"_use,omitempty"`
+	Min                  *int                  `bson:"min,omitempty" json:"min,omitempty"`
+	MinElement           *Element              `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max                  *string               `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement           *Element              `bson:"_max,omitempty" json:"_max,omitempty"`
+	Documentation        *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element              `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Type                 string                `bson:"type" json:"type"`
+	TypeElement          *Element              `bson:"_type,omitempty" json:"_type,omitempty"`
+	Profile              *string               `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement       *Element              `bson:"_profile,omitempty" json:"_profile,omitempty"`
+}
+
+// MarshalJSON marshals the given ParameterDefinition as JSON into a byte slice
+func (r ParameterDefinition) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ParameterDefinition as JSON object with the members in the order of the fields
+func (r ParameterDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("use")
+	e.string(r.Use.Code())
+	if r.UseElement != nil {
+		e.key("_use")
+		r.UseElement.appendJSON(e)
+	}
+	if r.Min != nil {
+		e.key("min")
+		e.int(*r.Min)
+	}
+	if r.MinElement != nil {
+		e.key("_min")
+		r.MinElement.appendJSON(e)
+	}
+	if r.Max != nil {
+		e.key("max")
+		e.string(*r.Max)
+	}
+	if r.MaxElement != nil {
+		e.key("_max")
+		r.MaxElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type)
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Profile != nil {
+		e.key("profile")
+		e.string(*r.Profile)
+	}
+	if r.ProfileElement != nil {
+		e.key("_profile")
+		r.ProfileElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ParameterDefinition from JSON. Unknown members are ignored.
+func (r *ParameterDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ParameterDefinition
+func (r *ParameterDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "use":
+			d.unmarshal(&r.Use)
+		case "_use":
+			r.UseElement = d.element()
+		case "min":
+			if v, ok := d.int(); ok {
+				r.Min = &v
+			} else {
+				r.Min = nil
+			}
+		case "_min":
+			r.MinElement = d.element()
+		case "max":
+			if v, ok := d.string(); ok {
+				r.Max = &v
+			} else {
+				r.Max = nil
+			}
+		case "_max":
+			r.MaxElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "profile":
+			if v, ok := d.string(); ok {
+				r.Profile = &v
+			} else {
+				r.Profile = nil
+			}
+		case "_profile":
+			r.ProfileElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ParameterDefinition which shares no memory with the original
+func (r ParameterDefinition) DeepCopy() ParameterDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.UseElement != nil {
+		v := r.UseElement.DeepCopy()
+		out.UseElement = &v
+	}
+	if r.Min != nil {
+		v := *r.Min
+		out.Min = &v
+	}
+	if r.MinElement != nil {
+		v := r.MinElement.DeepCopy()
+		out.MinElement = &v
+	}
+	if r.Max != nil {
+		v := *r.Max
+		out.Max = &v
+	}
+	if r.MaxElement != nil {
+		v := r.MaxElement.DeepCopy()
+		out.MaxElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Profile != nil {
+		v := *r.Profile
+		out.Profile = &v
+	}
+	if r.ProfileElement != nil {
+		v := r.ProfileElement.DeepCopy()
+		out.ProfileElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ParameterDefinition) Equal(other ParameterDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.Equal(*other.UseElement) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.Equal(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && *r.Max != *other.Max {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.Equal(*other.MaxElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && *r.Profile != *other.Profile {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.Equal(*other.ProfileElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ParameterDefinition) EqualsDeep(other ParameterDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.EqualsDeep(*other.UseElement) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.EqualsDeep(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.EqualsDeep(*other.MaxElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.EqualsDeep(*other.ProfileElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ParameterDefinition) EqualsShallow(other ParameterDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ParameterDefinition as FHIR XML
+func (r ParameterDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "use", r.Use, r.UseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "min", r.Min, r.MinElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "max", r.Max, r.MaxElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "profile", r.Profile, r.ProfileElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ParameterDefinition from FHIR XML
+func (r *ParameterDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "use":
+				var v OperationParameterUse
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Use = v
+				}
+				r.UseElement = element
+			case "min":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Min = &v
+				}
+				r.MinElement = element
+			case "max":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Max = &v
+				}
+				r.MaxElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Profile = &v
+				}
+				r.ProfileElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ParameterDefinition as properties to the RDF node n
+func (r ParameterDefinition) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("ParameterDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ParameterDefinition.use", -1, r.Use, r.UseElement, "code")
+	n.primitive("ParameterDefinition.min", -1, r.Min, r.MinElement, "integer")
+	n.primitive("ParameterDefinition.max", -1, r.Max, r.MaxElement, "string")
+	n.primitive("ParameterDefinition.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+	n.primitive("ParameterDefinition.type", -1, r.Type, r.TypeElement, "string")
+	n.primitive("ParameterDefinition.profile", -1, r.Profile, r.ProfileElement, "string")
+}
+
+// MarshalBSON marshals the given ParameterDefinition as BSON document with the structure of its FHIR JSON
+func (r ParameterDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ParameterDefinition from a BSON document with the structure of its FHIR JSON
+func (r *ParameterDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ParameterDefinition as protobuf message ParameterDefinition of fhir.proto
+func (r ParameterDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ParameterDefinition to the encoded message
+func (r ParameterDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Name != nil {
+		e.string(3, *r.Name)
+	}
+	e.varint(4, r.Use.protoNumber())
+	if r.Min != nil {
+		e.varint(5, uint64(int64(*r.Min)))
+	}
+	if r.Max != nil {
+		e.string(6, *r.Max)
+	}
+	if r.Documentation != nil {
+		e.string(7, *r.Documentation)
+	}
+	if r.Type != "" {
+		e.string(8, r.Type)
+	}
+	if r.Profile != nil {
+		e.string(9, *r.Profile)
+	}
+	if r.NameElement != nil {
+		e.message(10, *r.NameElement)
+	}
+	if r.UseElement != nil {
+		e.message(11, *r.UseElement)
+	}
+	if r.MinElement != nil {
+		e.message(12, *r.MinElement)
+	}
+	if r.MaxElement != nil {
+		e.message(13, *r.MaxElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(14, *r.DocumentationElement)
+	}
+	if r.TypeElement != nil {
+		e.message(15, *r.TypeElement)
+	}
+	if r.ProfileElement != nil {
+		e.message(16, *r.ProfileElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ParameterDefinition from protobuf message ParameterDefinition of fhir.proto
+func (r *ParameterDefinition) UnmarshalProto(b []byte) error {
+	*r = ParameterDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.Name = &v
+		case 4:
+			var v OperationParameterUse
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Use = v
+		case 5:
+			v := d.int()
+			r.Min = &v
+		case 6:
+			v := d.string()
+			r.Max = &v
+		case 7:
+			v := d.string()
+			r.Documentation = &v
+		case 8:
+			r.Type = d.string()
+		case 9:
+			v := d.string()
+			r.Profile = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.UseElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.MinElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.MaxElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.ProfileElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code PropertyRepresentation) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *PropertyRepresentation) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *PropertyRepresentation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "xmlAttr":
 		*code = PropertyRepresentationXmlAttr
@@ -55,6 +59,12 @@ func (code *PropertyRepresentation) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code PropertyRepresentation) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *PropertyRepresentation) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code PropertyRepresentation) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code PropertyRepresentation) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code PropertyRepresentation) protoNumber() uint64 {
+	switch code {
+	case PropertyRepresentationXmlAttr:
+		return 1
+	case PropertyRepresentationXmlText:
+		return 2
+	case PropertyRepresentationTypeAttr:
+		return 3
+	case PropertyRepresentationCdaText:
+		return 4
+	case PropertyRepresentationXhtml:
+		return 5
+	}
+	return 0
+}
+func (code *PropertyRepresentation) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = PropertyRepresentationXmlAttr
+	case 2:
+		*code = PropertyRepresentationXmlText
+	case 3:
+		*code = PropertyRepresentationTypeAttr
+	case 4:
+		*code = PropertyRepresentationCdaText
+	case 5:
+		*code = PropertyRepresentationXhtml
+	default:
+		return false
+	}
+	return true
+}
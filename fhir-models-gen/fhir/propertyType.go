@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code PropertyType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *PropertyType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *PropertyType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "code":
 		*code = PropertyTypeCode
@@ -61,6 +65,12 @@ func (code *PropertyType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code PropertyType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *PropertyType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code PropertyType) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code PropertyType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code PropertyType) protoNumber() uint64 {
+	switch code {
+	case PropertyTypeCode:
+		return 1
+	case PropertyTypeCoding:
+		return 2
+	case PropertyTypeString:
+		return 3
+	case PropertyTypeInteger:
+		return 4
+	case PropertyTypeBoolean:
+		return 5
+	case PropertyTypeDateTime:
+		return 6
+	case PropertyTypeDecimal:
+		return 7
+	}
+	return 0
+}
+func (code *PropertyType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = PropertyTypeCode
+	case 2:
+		*code = PropertyTypeCoding
+	case 3:
+		*code = PropertyTypeString
+	case 4:
+		*code = PropertyTypeInteger
+	case 5:
+		*code = PropertyTypeBoolean
+	case 6:
+		*code = PropertyTypeDateTime
+	case 7:
+		*code = PropertyTypeDecimal
+	default:
+		return false
+	}
+	return true
+}
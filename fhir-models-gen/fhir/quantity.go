@@ -14,18 +14,584 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Quantity is documented here http://hl7.org/fhir/StructureDefinition/Quantity
 type Quantity struct {
-	Id         *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension  []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	Value      *json.Number        `bson:"value,omitempty" json:"value,omitempty"`
-	Comparator *QuantityComparator `bson:"comparator,omitempty" json:"comparator,omitempty"`
-	Unit       *string             `bson:"unit,omitempty" json:"unit,omitempty"`
-	System     *string             `bson:"system,omitempty" json:"system,omitempty"`
-	Code       *string             `bson:"code,omitempty" json:"code,omitempty"`
+	Id                *string             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	Value             *json.Number        `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement      *Element            `bson:"_value,omitempty" json:"_value,omitempty"`
+	Comparator        *QuantityComparator `bson:"comparator,omitempty" json:"comparator,omitempty"`
+	ComparatorElement *Element            `bson:"_comparator,omitempty" json:"_comparator,omitempty"`
+	Unit              *string             `bson:"unit,omitempty" json:"unit,omitempty"`
+	UnitElement       *Element            `bson:"_unit,omitempty" json:"_unit,omitempty"`
+	System            *string             `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement     *Element            `bson:"_system,omitempty" json:"_system,omitempty"`
+	Code              *string             `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement       *Element            `bson:"_code,omitempty" json:"_code,omitempty"`
+}
+
+// MarshalJSON marshals the given Quantity as JSON into a byte slice
+func (r Quantity) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Quantity as JSON object with the members in the order of the fields
+func (r Quantity) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Value != nil {
+		e.key("value")
+		e.number(*r.Value)
+	}
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	if r.Comparator != nil {
+		e.key("comparator")
+		e.string(r.Comparator.Code())
+	}
+	if r.ComparatorElement != nil {
+		e.key("_comparator")
+		r.ComparatorElement.appendJSON(e)
+	}
+	if r.Unit != nil {
+		e.key("unit")
+		e.string(*r.Unit)
+	}
+	if r.UnitElement != nil {
+		e.key("_unit")
+		r.UnitElement.appendJSON(e)
+	}
+	if r.System != nil {
+		e.key("system")
+		e.string(*r.System)
+	}
+	if r.SystemElement != nil {
+		e.key("_system")
+		r.SystemElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		e.string(*r.Code)
+	}
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Quantity from JSON. Unknown members are ignored.
+func (r *Quantity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Quantity
+func (r *Quantity) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "value":
+			if v, ok := d.number(); ok {
+				r.Value = &v
+			} else {
+				r.Value = nil
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		case "comparator":
+			if d.null() {
+				r.Comparator = nil
+			} else {
+				var v QuantityComparator
+				d.unmarshal(&v)
+				r.Comparator = &v
+			}
+		case "_comparator":
+			r.ComparatorElement = d.element()
+		case "unit":
+			if v, ok := d.string(); ok {
+				r.Unit = &v
+			} else {
+				r.Unit = nil
+			}
+		case "_unit":
+			r.UnitElement = d.element()
+		case "system":
+			if v, ok := d.string(); ok {
+				r.System = &v
+			} else {
+				r.System = nil
+			}
+		case "_system":
+			r.SystemElement = d.element()
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = &v
+			} else {
+				r.Code = nil
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Quantity which shares no memory with the original
+func (r Quantity) DeepCopy() Quantity {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Value != nil {
+		v := *r.Value
+		out.Value = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	if r.Comparator != nil {
+		v := *r.Comparator
+		out.Comparator = &v
+	}
+	if r.ComparatorElement != nil {
+		v := r.ComparatorElement.DeepCopy()
+		out.ComparatorElement = &v
+	}
+	if r.Unit != nil {
+		v := *r.Unit
+		out.Unit = &v
+	}
+	if r.UnitElement != nil {
+		v := r.UnitElement.DeepCopy()
+		out.UnitElement = &v
+	}
+	if r.System != nil {
+		v := *r.System
+		out.System = &v
+	}
+	if r.SystemElement != nil {
+		v := r.SystemElement.DeepCopy()
+		out.SystemElement = &v
+	}
+	if r.Code != nil {
+		v := *r.Code
+		out.Code = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Quantity) Equal(other Quantity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !EqualDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	if (r.Comparator == nil) != (other.Comparator == nil) || r.Comparator != nil && *r.Comparator != *other.Comparator {
+		return false
+	}
+	if (r.ComparatorElement == nil) != (other.ComparatorElement == nil) || r.ComparatorElement != nil && !r.ComparatorElement.Equal(*other.ComparatorElement) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && *r.Unit != *other.Unit {
+		return false
+	}
+	if (r.UnitElement == nil) != (other.UnitElement == nil) || r.UnitElement != nil && !r.UnitElement.Equal(*other.UnitElement) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && *r.System != *other.System {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.Equal(*other.SystemElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && *r.Code != *other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Quantity) EqualsDeep(other Quantity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	if (r.Comparator == nil) != (other.Comparator == nil) || r.Comparator != nil && *r.Comparator != *other.Comparator {
+		return false
+	}
+	if (r.ComparatorElement == nil) != (other.ComparatorElement == nil) || r.ComparatorElement != nil && !r.ComparatorElement.EqualsDeep(*other.ComparatorElement) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !equivalentString(*r.Unit, *other.Unit) {
+		return false
+	}
+	if (r.UnitElement == nil) != (other.UnitElement == nil) || r.UnitElement != nil && !r.UnitElement.EqualsDeep(*other.UnitElement) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.EqualsDeep(*other.SystemElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Quantity) EqualsShallow(other Quantity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.Comparator == nil) != (other.Comparator == nil) || r.Comparator != nil && *r.Comparator != *other.Comparator {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !equivalentString(*r.Unit, *other.Unit) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Quantity as FHIR XML
+func (r Quantity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comparator", r.Comparator, r.ComparatorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "unit", r.Unit, r.UnitElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "system", r.System, r.SystemElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Quantity from FHIR XML
+func (r *Quantity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "value":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = &v
+				}
+				r.ValueElement = element
+			case "comparator":
+				var v QuantityComparator
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comparator = &v
+				}
+				r.ComparatorElement = element
+			case "unit":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Unit = &v
+				}
+				r.UnitElement = element
+			case "system":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.System = &v
+				}
+				r.SystemElement = element
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = &v
+				}
+				r.CodeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Quantity as properties to the RDF node n
+func (r Quantity) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Quantity.value", -1, r.Value, r.ValueElement, "decimal")
+	n.primitive("Quantity.comparator", -1, r.Comparator, r.ComparatorElement, "code")
+	n.primitive("Quantity.unit", -1, r.Unit, r.UnitElement, "string")
+	n.primitive("Quantity.system", -1, r.System, r.SystemElement, "string")
+	n.primitive("Quantity.code", -1, r.Code, r.CodeElement, "string")
+}
+
+// MarshalBSON marshals the given Quantity as BSON document with the structure of its FHIR JSON
+func (r Quantity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Quantity from a BSON document with the structure of its FHIR JSON
+func (r *Quantity) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Quantity as protobuf message Quantity of fhir.proto
+func (r Quantity) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Quantity to the encoded message
+func (r Quantity) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Value != nil {
+		e.string(3, string(*r.Value))
+	}
+	if r.Comparator != nil {
+		e.varint(4, r.Comparator.protoNumber())
+	}
+	if r.Unit != nil {
+		e.string(5, *r.Unit)
+	}
+	if r.System != nil {
+		e.string(6, *r.System)
+	}
+	if r.Code != nil {
+		e.string(7, *r.Code)
+	}
+	if r.ValueElement != nil {
+		e.message(8, *r.ValueElement)
+	}
+	if r.ComparatorElement != nil {
+		e.message(9, *r.ComparatorElement)
+	}
+	if r.UnitElement != nil {
+		e.message(10, *r.UnitElement)
+	}
+	if r.SystemElement != nil {
+		e.message(11, *r.SystemElement)
+	}
+	if r.CodeElement != nil {
+		e.message(12, *r.CodeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Quantity from protobuf message Quantity of fhir.proto
+func (r *Quantity) UnmarshalProto(b []byte) error {
+	*r = Quantity{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := json.Number(d.string())
+			r.Value = &v
+		case 4:
+			var v QuantityComparator
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Comparator = &v
+		case 5:
+			v := d.string()
+			r.Unit = &v
+		case 6:
+			v := d.string()
+			r.System = &v
+		case 7:
+			v := d.string()
+			r.Code = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ComparatorElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.UnitElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.SystemElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
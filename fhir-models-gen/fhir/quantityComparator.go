@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code QuantityComparator) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *QuantityComparator) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *QuantityComparator) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "<":
 		*code = QuantityComparatorLessThan
@@ -52,6 +56,12 @@ func (code *QuantityComparator) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code QuantityComparator) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *QuantityComparator) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code QuantityComparator) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code QuantityComparator) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code QuantityComparator) protoNumber() uint64 {
+	switch code {
+	case QuantityComparatorLessThan:
+		return 1
+	case QuantityComparatorLessOrEquals:
+		return 2
+	case QuantityComparatorGreaterOrEquals:
+		return 3
+	case QuantityComparatorGreaterThan:
+		return 4
+	}
+	return 0
+}
+func (code *QuantityComparator) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = QuantityComparatorLessThan
+	case 2:
+		*code = QuantityComparatorLessOrEquals
+	case 3:
+		*code = QuantityComparatorGreaterOrEquals
+	case 4:
+		*code = QuantityComparatorGreaterThan
+	default:
+		return false
+	}
+	return true
+}
@@ -14,15 +14,483 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Reference is documented here http://hl7.org/fhir/StructureDefinition/Reference
 type Reference struct {
-	Id         *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension  []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Reference  *string     `bson:"reference,omitempty" json:"reference,omitempty"`
-	Type       *string     `bson:"type,omitempty" json:"type,omitempty"`
-	Identifier *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Display    *string     `bson:"display,omitempty" json:"display,omitempty"`
+	Id               *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension        []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Reference        *string     `bson:"reference,omitempty" json:"reference,omitempty"`
+	ReferenceElement *Element    `bson:"_reference,omitempty" json:"_reference,omitempty"`
+	Type             *string     `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement      *Element    `bson:"_type,omitempty" json:"_type,omitempty"`
+	Identifier       *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Display          *string     `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement   *Element    `bson:"_display,omitempty" json:"_display,omitempty"`
+}
+
+// MarshalJSON marshals the given Reference as JSON into a byte slice
+func (r Reference) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Reference as JSON object with the members in the order of the fields
+func (r Reference) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Reference != nil {
+		e.key("reference")
+		e.string(*r.Reference)
+	}
+	if r.ReferenceElement != nil {
+		e.key("_reference")
+		r.ReferenceElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(*r.Type)
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Reference from JSON. Unknown members are ignored.
+func (r *Reference) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Reference
+func (r *Reference) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "reference":
+			if v, ok := d.string(); ok {
+				r.Reference = &v
+			} else {
+				r.Reference = nil
+			}
+		case "_reference":
+			r.ReferenceElement = d.element()
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = &v
+			} else {
+				r.Type = nil
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Reference which shares no memory with the original
+func (r Reference) DeepCopy() Reference {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Reference != nil {
+		v := *r.Reference
+		out.Reference = &v
+	}
+	if r.ReferenceElement != nil {
+		v := r.ReferenceElement.DeepCopy()
+		out.ReferenceElement = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Reference) Equal(other Reference) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && *r.Reference != *other.Reference {
+		return false
+	}
+	if (r.ReferenceElement == nil) != (other.ReferenceElement == nil) || r.ReferenceElement != nil && !r.ReferenceElement.Equal(*other.ReferenceElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Reference) EqualsDeep(other Reference) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !equivalentString(*r.Reference, *other.Reference) {
+		return false
+	}
+	if (r.ReferenceElement == nil) != (other.ReferenceElement == nil) || r.ReferenceElement != nil && !r.ReferenceElement.EqualsDeep(*other.ReferenceElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Reference) EqualsShallow(other Reference) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !equivalentString(*r.Reference, *other.Reference) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Reference as FHIR XML
+func (r Reference) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "reference", r.Reference, r.ReferenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Reference from FHIR XML
+func (r *Reference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "reference":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Reference = &v
+				}
+				r.ReferenceElement = element
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Reference as properties to the RDF node n
+func (r Reference) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Reference.reference", -1, r.Reference, r.ReferenceElement, "string")
+	n.primitive("Reference.type", -1, r.Type, r.TypeElement, "string")
+	n.element("Reference.identifier", -1, r.Identifier)
+	n.primitive("Reference.display", -1, r.Display, r.DisplayElement, "string")
+	n.link(r.Reference, r.Type)
+}
+
+// MarshalBSON marshals the given Reference as BSON document with the structure of its FHIR JSON
+func (r Reference) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Reference from a BSON document with the structure of its FHIR JSON
+func (r *Reference) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Reference as protobuf message Reference of fhir.proto
+func (r Reference) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Reference to the encoded message
+func (r Reference) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Reference != nil {
+		e.string(3, *r.Reference)
+	}
+	if r.Type != nil {
+		e.string(4, *r.Type)
+	}
+	if r.Identifier != nil {
+		e.message(5, *r.Identifier)
+	}
+	if r.Display != nil {
+		e.string(6, *r.Display)
+	}
+	if r.ReferenceElement != nil {
+		e.message(7, *r.ReferenceElement)
+	}
+	if r.TypeElement != nil {
+		e.message(8, *r.TypeElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(9, *r.DisplayElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Reference from protobuf message Reference of fhir.proto
+func (r *Reference) UnmarshalProto(b []byte) error {
+	*r = Reference{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.Reference = &v
+		case 4:
+			v := d.string()
+			r.Type = &v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 6:
+			v := d.string()
+			r.Display = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ReferenceElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -14,18 +14,675 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // RelatedArtifact is documented here http://hl7.org/fhir/StructureDefinition/RelatedArtifact
 type RelatedArtifact struct {
-	Id        *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type      RelatedArtifactType `bson:"type" json:"type"`
-	Label     *string             `bson:"label,omitempty" json:"label,omitempty"`
-	Display   *string             `bson:"display,omitempty" json:"display,omitempty"`
-	Citation  *string             `bson:"citation,omitempty" json:"citation,omitempty"`
-	Url       *string             `bson:"url,omitempty" json:"url,omitempty"`
-	Document  *Attachment         `bson:"document,omitempty" json:"document,omitempty"`
-	Resource  *string             `bson:"resource,omitempty" json:"resource,omitempty"`
+	Id              *string             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension       []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type            RelatedArtifactType `bson:"type" json:"type"`
+	TypeElement     *Element            `bson:"_type,omitempty" json:"_type,omitempty"`
+	Label           *string             `bson:"label,omitempty" json:"label,omitempty"`
+	LabelElement    *Element            `bson:"_label,omitempty" json:"_label,omitempty"`
+	Display         *string             `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement  *Element            `bson:"_display,omitempty" json:"_display,omitempty"`
+	Citation        *string             `bson:"citation,omitempty" json:"citation,omitempty"`
+	CitationElement *Element            `bson:"_citation,omitempty" json:"_citation,omitempty"`
+	Url             *string             `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement      *Element            `bson:"_url,omitempty" json:"_url,omitempty"`
+	Document        *Attachment         `bson:"document,omitempty" json:"document,omitempty"`
+	Resource        *string             `bson:"resource,omitempty" json:"resource,omitempty"`
+	ResourceElement *Element            `bson:"_resource,omitempty" json:"_resource,omitempty"`
+}
+
+// MarshalJSON marshals the given RelatedArtifact as JSON into a byte slice
+func (r RelatedArtifact) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RelatedArtifact as JSON object with the members in the order of the fields
+func (r RelatedArtifact) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Label != nil {
+		e.key("label")
+		e.string(*r.Label)
+	}
+	if r.LabelElement != nil {
+		e.key("_label")
+		r.LabelElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	if r.Citation != nil {
+		e.key("citation")
+		e.string(*r.Citation)
+	}
+	if r.CitationElement != nil {
+		e.key("_citation")
+		r.CitationElement.appendJSON(e)
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Document != nil {
+		e.key("document")
+		r.Document.appendJSON(e)
+	}
+	if r.Resource != nil {
+		e.key("resource")
+		e.string(*r.Resource)
+	}
+	if r.ResourceElement != nil {
+		e.key("_resource")
+		r.ResourceElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RelatedArtifact from JSON. Unknown members are ignored.
+func (r *RelatedArtifact) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RelatedArtifact
+func (r *RelatedArtifact) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "label":
+			if v, ok := d.string(); ok {
+				r.Label = &v
+			} else {
+				r.Label = nil
+			}
+		case "_label":
+			r.LabelElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		case "citation":
+			if v, ok := d.string(); ok {
+				r.Citation = &v
+			} else {
+				r.Citation = nil
+			}
+		case "_citation":
+			r.CitationElement = d.element()
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "document":
+			if d.null() {
+				r.Document = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Document = &v
+			}
+		case "resource":
+			if v, ok := d.string(); ok {
+				r.Resource = &v
+			} else {
+				r.Resource = nil
+			}
+		case "_resource":
+			r.ResourceElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RelatedArtifact which shares no memory with the original
+func (r RelatedArtifact) DeepCopy() RelatedArtifact {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Label != nil {
+		v := *r.Label
+		out.Label = &v
+	}
+	if r.LabelElement != nil {
+		v := r.LabelElement.DeepCopy()
+		out.LabelElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	if r.Citation != nil {
+		v := *r.Citation
+		out.Citation = &v
+	}
+	if r.CitationElement != nil {
+		v := r.CitationElement.DeepCopy()
+		out.CitationElement = &v
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Document != nil {
+		v := r.Document.DeepCopy()
+		out.Document = &v
+	}
+	if r.Resource != nil {
+		v := *r.Resource
+		out.Resource = &v
+	}
+	if r.ResourceElement != nil {
+		v := r.ResourceElement.DeepCopy()
+		out.ResourceElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RelatedArtifact) Equal(other RelatedArtifact) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && *r.Label != *other.Label {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.Equal(*other.LabelElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	if (r.Citation == nil) != (other.Citation == nil) || r.Citation != nil && *r.Citation != *other.Citation {
+		return false
+	}
+	if (r.CitationElement == nil) != (other.CitationElement == nil) || r.CitationElement != nil && !r.CitationElement.Equal(*other.CitationElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Document == nil) != (other.Document == nil) || r.Document != nil && !r.Document.Equal(*other.Document) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && *r.Resource != *other.Resource {
+		return false
+	}
+	if (r.ResourceElement == nil) != (other.ResourceElement == nil) || r.ResourceElement != nil && !r.ResourceElement.Equal(*other.ResourceElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RelatedArtifact) EqualsDeep(other RelatedArtifact) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && !equivalentString(*r.Label, *other.Label) {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.EqualsDeep(*other.LabelElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	if (r.Citation == nil) != (other.Citation == nil) || r.Citation != nil && !equivalentString(*r.Citation, *other.Citation) {
+		return false
+	}
+	if (r.CitationElement == nil) != (other.CitationElement == nil) || r.CitationElement != nil && !r.CitationElement.EqualsDeep(*other.CitationElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Document == nil) != (other.Document == nil) || r.Document != nil && !r.Document.EqualsDeep(*other.Document) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !equivalentString(*r.Resource, *other.Resource) {
+		return false
+	}
+	if (r.ResourceElement == nil) != (other.ResourceElement == nil) || r.ResourceElement != nil && !r.ResourceElement.EqualsDeep(*other.ResourceElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RelatedArtifact) EqualsShallow(other RelatedArtifact) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && !equivalentString(*r.Label, *other.Label) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.Citation == nil) != (other.Citation == nil) || r.Citation != nil && !equivalentString(*r.Citation, *other.Citation) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !equivalentString(*r.Resource, *other.Resource) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RelatedArtifact as FHIR XML
+func (r RelatedArtifact) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "label", r.Label, r.LabelElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "citation", r.Citation, r.CitationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "document", r.Document); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "resource", r.Resource, r.ResourceElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RelatedArtifact from FHIR XML
+func (r *RelatedArtifact) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "type":
+				var v RelatedArtifactType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "label":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Label = &v
+				}
+				r.LabelElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			case "citation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Citation = &v
+				}
+				r.CitationElement = element
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "document":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Document = &v
+			case "resource":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Resource = &v
+				}
+				r.ResourceElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RelatedArtifact as properties to the RDF node n
+func (r RelatedArtifact) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("RelatedArtifact.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("RelatedArtifact.label", -1, r.Label, r.LabelElement, "string")
+	n.primitive("RelatedArtifact.display", -1, r.Display, r.DisplayElement, "string")
+	n.primitive("RelatedArtifact.citation", -1, r.Citation, r.CitationElement, "string")
+	n.primitive("RelatedArtifact.url", -1, r.Url, r.UrlElement, "string")
+	n.element("RelatedArtifact.document", -1, r.Document)
+	n.primitive("RelatedArtifact.resource", -1, r.Resource, r.ResourceElement, "string")
+}
+
+// MarshalBSON marshals the given RelatedArtifact as BSON document with the structure of its FHIR JSON
+func (r RelatedArtifact) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RelatedArtifact from a BSON document with the structure of its FHIR JSON
+func (r *RelatedArtifact) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RelatedArtifact as protobuf message RelatedArtifact of fhir.proto
+func (r RelatedArtifact) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RelatedArtifact to the encoded message
+func (r RelatedArtifact) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	e.varint(3, r.Type.protoNumber())
+	if r.Label != nil {
+		e.string(4, *r.Label)
+	}
+	if r.Display != nil {
+		e.string(5, *r.Display)
+	}
+	if r.Citation != nil {
+		e.string(6, *r.Citation)
+	}
+	if r.Url != nil {
+		e.string(7, *r.Url)
+	}
+	if r.Document != nil {
+		e.message(8, *r.Document)
+	}
+	if r.Resource != nil {
+		e.string(9, *r.Resource)
+	}
+	if r.TypeElement != nil {
+		e.message(10, *r.TypeElement)
+	}
+	if r.LabelElement != nil {
+		e.message(11, *r.LabelElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(12, *r.DisplayElement)
+	}
+	if r.CitationElement != nil {
+		e.message(13, *r.CitationElement)
+	}
+	if r.UrlElement != nil {
+		e.message(14, *r.UrlElement)
+	}
+	if r.ResourceElement != nil {
+		e.message(15, *r.ResourceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RelatedArtifact from protobuf message RelatedArtifact of fhir.proto
+func (r *RelatedArtifact) UnmarshalProto(b []byte) error {
+	*r = RelatedArtifact{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v RelatedArtifactType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 4:
+			v := d.string()
+			r.Label = &v
+		case 5:
+			v := d.string()
+			r.Display = &v
+		case 6:
+			v := d.string()
+			r.Citation = &v
+		case 7:
+			v := d.string()
+			r.Url = &v
+		case 8:
+			var v Attachment
+			d.message(&v)
+			r.Document = &v
+		case 9:
+			v := d.string()
+			r.Resource = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.LabelElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.CitationElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ResourceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -40,8 +39,13 @@ const (
 func (code RelatedArtifactType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *RelatedArtifactType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *RelatedArtifactType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "documentation":
 		*code = RelatedArtifactTypeDocumentation
@@ -64,6 +68,12 @@ func (code *RelatedArtifactType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code RelatedArtifactType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *RelatedArtifactType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code RelatedArtifactType) String() string {
 	return code.Code()
 }
@@ -130,3 +140,47 @@ func (code RelatedArtifactType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code RelatedArtifactType) protoNumber() uint64 {
+	switch code {
+	case RelatedArtifactTypeDocumentation:
+		return 1
+	case RelatedArtifactTypeJustification:
+		return 2
+	case RelatedArtifactTypeCitation:
+		return 3
+	case RelatedArtifactTypePredecessor:
+		return 4
+	case RelatedArtifactTypeSuccessor:
+		return 5
+	case RelatedArtifactTypeDerivedFrom:
+		return 6
+	case RelatedArtifactTypeDependsOn:
+		return 7
+	case RelatedArtifactTypeComposedOf:
+		return 8
+	}
+	return 0
+}
+func (code *RelatedArtifactType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = RelatedArtifactTypeDocumentation
+	case 2:
+		*code = RelatedArtifactTypeJustification
+	case 3:
+		*code = RelatedArtifactTypeCitation
+	case 4:
+		*code = RelatedArtifactTypePredecessor
+	case 5:
+		*code = RelatedArtifactTypeSuccessor
+	case 6:
+		*code = RelatedArtifactTypeDerivedFrom
+	case 7:
+		*code = RelatedArtifactTypeDependsOn
+	case 8:
+		*code = RelatedArtifactTypeComposedOf
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,688 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// resourceTypes lists all resources in alphabetical order
+var resourceTypes = []string{"Bundle", "CapabilityStatement", "CodeSystem", "OperationDefinition", "StructureDefinition", "ValueSet"}
+
+// newResource returns a pointer to a new instance of the resource with the given type
+func newResource(resourceType string) (interface{}, bool) {
+	switch resourceType {
+	case "Bundle":
+		return &Bundle{}, true
+	case "CapabilityStatement":
+		return &CapabilityStatement{}, true
+	case "CodeSystem":
+		return &CodeSystem{}, true
+	case "OperationDefinition":
+		return &OperationDefinition{}, true
+	case "StructureDefinition":
+		return &StructureDefinition{}, true
+	case "ValueSet":
+		return &ValueSet{}, true
+	}
+	return nil, false
+}
+
+// primitiveTypeCodes maps the fields of primitive elements, named by struct and field, to their FHIR type code
+var primitiveTypeCodes = map[string]string{
+	"Address.City":                                             "string",
+	"Address.Country":                                          "string",
+	"Address.District":                                         "string",
+	"Address.Id":                                               "string",
+	"Address.Line":                                             "string",
+	"Address.PostalCode":                                       "string",
+	"Address.State":                                            "string",
+	"Address.Text":                                             "string",
+	"Address.Type":                                             "code",
+	"Address.Use":                                              "code",
+	"Age.Code":                                                 "string",
+	"Age.Comparator":                                           "code",
+	"Age.Id":                                                   "string",
+	"Age.System":                                               "string",
+	"Age.Unit":                                                 "string",
+	"Age.Value":                                                "decimal",
+	"Annotation.AuthorString":                                  "string",
+	"Annotation.Id":                                            "string",
+	"Annotation.Text":                                          "string",
+	"Annotation.Time":                                          "dateTime",
+	"Attachment.ContentType":                                   "string",
+	"Attachment.Creation":                                      "dateTime",
+	"Attachment.Data":                                          "base64Binary",
+	"Attachment.Hash":                                          "base64Binary",
+	"Attachment.Id":                                            "string",
+	"Attachment.Language":                                      "string",
+	"Attachment.Size":                                          "unsignedInt",
+	"Attachment.Title":                                         "string",
+	"Attachment.Url":                                           "string",
+	"Bundle.Id":                                                "string",
+	"Bundle.ImplicitRules":                                     "string",
+	"Bundle.Language":                                          "string",
+	"Bundle.Timestamp":                                         "instant",
+	"Bundle.Total":                                             "unsignedInt",
+	"Bundle.Type":                                              "code",
+	"BundleEntry.FullUrl":                                      "string",
+	"BundleEntry.Id":                                           "string",
+	"BundleEntryRequest.Id":                                    "string",
+	"BundleEntryRequest.IfMatch":                               "string",
+	"BundleEntryRequest.IfModifiedSince":                       "instant",
+	"BundleEntryRequest.IfNoneExist":                           "string",
+	"BundleEntryRequest.IfNoneMatch":                           "string",
+	"BundleEntryRequest.Method":                                "code",
+	"BundleEntryRequest.Url":                                   "string",
+	"BundleEntryResponse.Etag":                                 "string",
+	"BundleEntryResponse.Id":                                   "string",
+	"BundleEntryResponse.LastModified":                         "instant",
+	"BundleEntryResponse.Location":                             "string",
+	"BundleEntryResponse.Status":                               "string",
+	"BundleEntrySearch.Id":                                     "string",
+	"BundleEntrySearch.Mode":                                   "code",
+	"BundleEntrySearch.Score":                                  "decimal",
+	"BundleLink.Id":                                            "string",
+	"BundleLink.Relation":                                      "string",
+	"BundleLink.Url":                                           "string",
+	"CapabilityStatement.Copyright":                            "string",
+	"CapabilityStatement.Date":                                 "dateTime",
+	"CapabilityStatement.Description":                          "string",
+	"CapabilityStatement.Experimental":                         "boolean",
+	"CapabilityStatement.FhirVersion":                          "code",
+	"CapabilityStatement.Format":                               "string",
+	"CapabilityStatement.Id":                                   "string",
+	"CapabilityStatement.ImplementationGuide":                  "string",
+	"CapabilityStatement.ImplicitRules":                        "string",
+	"CapabilityStatement.Imports":                              "string",
+	"CapabilityStatement.Instantiates":                         "string",
+	"CapabilityStatement.Kind":                                 "code",
+	"CapabilityStatement.Language":                             "string",
+	"CapabilityStatement.Name":                                 "string",
+	"CapabilityStatement.PatchFormat":                          "string",
+	"CapabilityStatement.Publisher":                            "string",
+	"CapabilityStatement.Purpose":                              "string",
+	"CapabilityStatement.Status":                               "code",
+	"CapabilityStatement.Title":                                "string",
+	"CapabilityStatement.Url":                                  "string",
+	"CapabilityStatement.Version":                              "string",
+	"CapabilityStatementDocument.Documentation":                "string",
+	"CapabilityStatementDocument.Id":                           "string",
+	"CapabilityStatementDocument.Mode":                         "code",
+	"CapabilityStatementDocument.Profile":                      "string",
+	"CapabilityStatementImplementation.Description":            "string",
+	"CapabilityStatementImplementation.Id":                     "string",
+	"CapabilityStatementImplementation.Url":                    "string",
+	"CapabilityStatementMessaging.Documentation":               "string",
+	"CapabilityStatementMessaging.Id":                          "string",
+	"CapabilityStatementMessaging.ReliableCache":               "unsignedInt",
+	"CapabilityStatementMessagingEndpoint.Address":             "string",
+	"CapabilityStatementMessagingEndpoint.Id":                  "string",
+	"CapabilityStatementMessagingSupportedMessage.Definition":  "string",
+	"CapabilityStatementMessagingSupportedMessage.Id":          "string",
+	"CapabilityStatementMessagingSupportedMessage.Mode":        "code",
+	"CapabilityStatementRest.Compartment":                      "string",
+	"CapabilityStatementRest.Documentation":                    "string",
+	"CapabilityStatementRest.Id":                               "string",
+	"CapabilityStatementRest.Mode":                             "code",
+	"CapabilityStatementRestInteraction.Code":                  "code",
+	"CapabilityStatementRestInteraction.Documentation":         "string",
+	"CapabilityStatementRestInteraction.Id":                    "string",
+	"CapabilityStatementRestResource.ConditionalCreate":        "boolean",
+	"CapabilityStatementRestResource.ConditionalDelete":        "code",
+	"CapabilityStatementRestResource.ConditionalRead":          "code",
+	"CapabilityStatementRestResource.ConditionalUpdate":        "boolean",
+	"CapabilityStatementRestResource.Documentation":            "string",
+	"CapabilityStatementRestResource.Id":                       "string",
+	"CapabilityStatementRestResource.Profile":                  "string",
+	"CapabilityStatementRestResource.ReadHistory":              "boolean",
+	"CapabilityStatementRestResource.ReferencePolicy":          "code",
+	"CapabilityStatementRestResource.SearchInclude":            "string",
+	"CapabilityStatementRestResource.SearchRevInclude":         "string",
+	"CapabilityStatementRestResource.SupportedProfile":         "string",
+	"CapabilityStatementRestResource.Type":                     "code",
+	"CapabilityStatementRestResource.UpdateCreate":             "boolean",
+	"CapabilityStatementRestResource.Versioning":               "code",
+	"CapabilityStatementRestResourceInteraction.Code":          "code",
+	"CapabilityStatementRestResourceInteraction.Documentation": "string",
+	"CapabilityStatementRestResourceInteraction.Id":            "string",
+	"CapabilityStatementRestResourceOperation.Definition":      "string",
+	"CapabilityStatementRestResourceOperation.Documentation":   "string",
+	"CapabilityStatementRestResourceOperation.Id":              "string",
+	"CapabilityStatementRestResourceOperation.Name":            "string",
+	"CapabilityStatementRestResourceSearchParam.Definition":    "string",
+	"CapabilityStatementRestResourceSearchParam.Documentation": "string",
+	"CapabilityStatementRestResourceSearchParam.Id":            "string",
+	"CapabilityStatementRestResourceSearchParam.Name":          "string",
+	"CapabilityStatementRestResourceSearchParam.Type":          "code",
+	"CapabilityStatementRestSecurity.Cors":                     "boolean",
+	"CapabilityStatementRestSecurity.Description":              "string",
+	"CapabilityStatementRestSecurity.Id":                       "string",
+	"CapabilityStatementSoftware.Id":                           "string",
+	"CapabilityStatementSoftware.Name":                         "string",
+	"CapabilityStatementSoftware.ReleaseDate":                  "dateTime",
+	"CapabilityStatementSoftware.Version":                      "string",
+	"CodeSystem.CaseSensitive":                                 "boolean",
+	"CodeSystem.Compositional":                                 "boolean",
+	"CodeSystem.Content":                                       "code",
+	"CodeSystem.Copyright":                                     "string",
+	"CodeSystem.Count":                                         "unsignedInt",
+	"CodeSystem.Date":                                          "dateTime",
+	"CodeSystem.Description":                                   "string",
+	"CodeSystem.Experimental":                                  "boolean",
+	"CodeSystem.HierarchyMeaning":                              "code",
+	"CodeSystem.Id":                                            "string",
+	"CodeSystem.ImplicitRules":                                 "string",
+	"CodeSystem.Language":                                      "string",
+	"CodeSystem.Name":                                          "string",
+	"CodeSystem.Publisher":                                     "string",
+	"CodeSystem.Purpose":                                       "string",
+	"CodeSystem.Status":                                        "code",
+	"CodeSystem.Supplements":                                   "string",
+	"CodeSystem.Title":                                         "string",
+	"CodeSystem.Url":                                           "string",
+	"CodeSystem.ValueSet":                                      "string",
+	"CodeSystem.Version":                                       "string",
+	"CodeSystem.VersionNeeded":                                 "boolean",
+	"CodeSystemConcept.Code":                                   "string",
+	"CodeSystemConcept.Definition":                             "string",
+	"CodeSystemConcept.Display":                                "string",
+	"CodeSystemConcept.Id":                                     "string",
+	"CodeSystemConceptDesignation.Id":                          "string",
+	"CodeSystemConceptDesignation.Language":                    "string",
+	"CodeSystemConceptDesignation.Value":                       "string",
+	"CodeSystemConceptProperty.Code":                           "string",
+	"CodeSystemConceptProperty.Id":                             "string",
+	"CodeSystemConceptProperty.ValueBoolean":                   "boolean",
+	"CodeSystemConceptProperty.ValueCode":                      "code",
+	"CodeSystemConceptProperty.ValueDateTime":                  "dateTime",
+	"CodeSystemConceptProperty.ValueDecimal":                   "decimal",
+	"CodeSystemConceptProperty.ValueInteger":                   "integer",
+	"CodeSystemConceptProperty.ValueString":                    "string",
+	"CodeSystemFilter.Code":                                    "string",
+	"CodeSystemFilter.Description":                             "string",
+	"CodeSystemFilter.Id":                                      "string",
+	"CodeSystemFilter.Operator":                                "code",
+	"CodeSystemFilter.Value":                                   "string",
+	"CodeSystemProperty.Code":                                  "string",
+	"CodeSystemProperty.Description":                           "string",
+	"CodeSystemProperty.Id":                                    "string",
+	"CodeSystemProperty.Type":                                  "code",
+	"CodeSystemProperty.Uri":                                   "string",
+	"CodeableConcept.Id":                                       "string",
+	"CodeableConcept.Text":                                     "string",
+	"Coding.Code":                                              "string",
+	"Coding.Display":                                           "string",
+	"Coding.Id":                                                "string",
+	"Coding.System":                                            "string",
+	"Coding.UserSelected":                                      "boolean",
+	"Coding.Version":                                           "string",
+	"ContactDetail.Id":                                         "string",
+	"ContactDetail.Name":                                       "string",
+	"ContactPoint.Id":                                          "string",
+	"ContactPoint.Rank":                                        "positiveInt",
+	"ContactPoint.System":                                      "code",
+	"ContactPoint.Use":                                         "code",
+	"ContactPoint.Value":                                       "string",
+	"Contributor.Id":                                           "string",
+	"Contributor.Name":                                         "string",
+	"Contributor.Type":                                         "code",
+	"Count.Code":                                               "string",
+	"Count.Comparator":                                         "code",
+	"Count.Id":                                                 "string",
+	"Count.System":                                             "string",
+	"Count.Unit":                                               "string",
+	"Count.Value":                                              "decimal",
+	"DataRequirement.Id":                                       "string",
+	"DataRequirement.Limit":                                    "positiveInt",
+	"DataRequirement.MustSupport":                              "string",
+	"DataRequirement.Profile":                                  "string",
+	"DataRequirement.Type":                                     "string",
+	"DataRequirementCodeFilter.Id":                             "string",
+	"DataRequirementCodeFilter.Path":                           "string",
+	"DataRequirementCodeFilter.SearchParam":                    "string",
+	"DataRequirementCodeFilter.ValueSet":                       "string",
+	"DataRequirementDateFilter.Id":                             "string",
+	"DataRequirementDateFilter.Path":                           "string",
+	"DataRequirementDateFilter.SearchParam":                    "string",
+	"DataRequirementDateFilter.ValueDateTime":                  "dateTime",
+	"DataRequirementSort.Direction":                            "code",
+	"DataRequirementSort.Id":                                   "string",
+	"DataRequirementSort.Path":                                 "string",
+	"Distance.Code":                                            "string",
+	"Distance.Comparator":                                      "code",
+	"Distance.Id":                                              "string",
+	"Distance.System":                                          "string",
+	"Distance.Unit":                                            "string",
+	"Distance.Value":                                           "decimal",
+	"Dosage.AsNeededBoolean":                                   "boolean",
+	"Dosage.Id":                                                "string",
+	"Dosage.PatientInstruction":                                "string",
+	"Dosage.Sequence":                                          "integer",
+	"Dosage.Text":                                              "string",
+	"DosageDoseAndRate.Id":                                     "string",
+	"Duration.Code":                                            "string",
+	"Duration.Comparator":                                      "code",
+	"Duration.Id":                                              "string",
+	"Duration.System":                                          "string",
+	"Duration.Unit":                                            "string",
+	"Duration.Value":                                           "decimal",
+	"ElementDefinition.Alias":                                  "string",
+	"ElementDefinition.Comment":                                "string",
+	"ElementDefinition.Condition":                              "string",
+	"ElementDefinition.ContentReference":                       "string",
+	"ElementDefinition.DefaultValueBase64Binary":               "base64Binary",
+	"ElementDefinition.DefaultValueBoolean":                    "boolean",
+	"ElementDefinition.DefaultValueCanonical":                  "canonical",
+	"ElementDefinition.DefaultValueCode":                       "code",
+	"ElementDefinition.DefaultValueDate":                       "date",
+	"ElementDefinition.DefaultValueDateTime":                   "dateTime",
+	"ElementDefinition.DefaultValueDecimal":                    "decimal",
+	"ElementDefinition.DefaultValueId":                         "id",
+	"ElementDefinition.DefaultValueInstant":                    "instant",
+	"ElementDefinition.DefaultValueInteger":                    "integer",
+	"ElementDefinition.DefaultValueMarkdown":                   "markdown",
+	"ElementDefinition.DefaultValueOid":                        "oid",
+	"ElementDefinition.DefaultValuePositiveInt":                "positiveInt",
+	"ElementDefinition.DefaultValueString":                     "string",
+	"ElementDefinition.DefaultValueTime":                       "time",
+	"ElementDefinition.DefaultValueUnsignedInt":                "unsignedInt",
+	"ElementDefinition.DefaultValueUri":                        "uri",
+	"ElementDefinition.DefaultValueUrl":                        "url",
+	"ElementDefinition.DefaultValueUuid":                       "uuid",
+	"ElementDefinition.Definition":                             "string",
+	"ElementDefinition.FixedBase64Binary":                      "base64Binary",
+	"ElementDefinition.FixedBoolean":                           "boolean",
+	"ElementDefinition.FixedCanonical":                         "canonical",
+	"ElementDefinition.FixedCode":                              "code",
+	"ElementDefinition.FixedDate":                              "date",
+	"ElementDefinition.FixedDateTime":                          "dateTime",
+	"ElementDefinition.FixedDecimal":                           "decimal",
+	"ElementDefinition.FixedId":                                "id",
+	"ElementDefinition.FixedInstant":                           "instant",
+	"ElementDefinition.FixedInteger":                           "integer",
+	"ElementDefinition.FixedMarkdown":                          "markdown",
+	"ElementDefinition.FixedOid":                               "oid",
+	"ElementDefinition.FixedPositiveInt":                       "positiveInt",
+	"ElementDefinition.FixedString":                            "string",
+	"ElementDefinition.FixedTime":                              "time",
+	"ElementDefinition.FixedUnsignedInt":                       "unsignedInt",
+	"ElementDefinition.FixedUri":                               "uri",
+	"ElementDefinition.FixedUrl":                               "url",
+	"ElementDefinition.FixedUuid":                              "uuid",
+	"ElementDefinition.Id":                                     "string",
+	"ElementDefinition.IsModifier":                             "boolean",
+	"ElementDefinition.IsModifierReason":                       "string",
+	"ElementDefinition.IsSummary":                              "boolean",
+	"ElementDefinition.Label":                                  "string",
+	"ElementDefinition.Max":                                    "string",
+	"ElementDefinition.MaxLength":                              "integer",
+	"ElementDefinition.MaxValueDate":                           "date",
+	"ElementDefinition.MaxValueDateTime":                       "dateTime",
+	"ElementDefinition.MaxValueDecimal":                        "decimal",
+	"ElementDefinition.MaxValueInstant":                        "instant",
+	"ElementDefinition.MaxValueInteger":                        "integer",
+	"ElementDefinition.MaxValuePositiveInt":                    "positiveInt",
+	"ElementDefinition.MaxValueTime":                           "time",
+	"ElementDefinition.MaxValueUnsignedInt":                    "unsignedInt",
+	"ElementDefinition.MeaningWhenMissing":                     "string",
+	"ElementDefinition.Min":                                    "unsignedInt",
+	"ElementDefinition.MinValueDate":                           "date",
+	"ElementDefinition.MinValueDateTime":                       "dateTime",
+	"ElementDefinition.MinValueDecimal":                        "decimal",
+	"ElementDefinition.MinValueInstant":                        "instant",
+	"ElementDefinition.MinValueInteger":                        "integer",
+	"ElementDefinition.MinValuePositiveInt":                    "positiveInt",
+	"ElementDefinition.MinValueTime":                           "time",
+	"ElementDefinition.MinValueUnsignedInt":                    "unsignedInt",
+	"ElementDefinition.MustSupport":                            "boolean",
+	"ElementDefinition.OrderMeaning":                           "string",
+	"ElementDefinition.Path":                                   "string",
+	"ElementDefinition.PatternBase64Binary":                    "base64Binary",
+	"ElementDefinition.PatternBoolean":                         "boolean",
+	"ElementDefinition.PatternCanonical":                       "canonical",
+	"ElementDefinition.PatternCode":                            "code",
+	"ElementDefinition.PatternDate":                            "date",
+	"ElementDefinition.PatternDateTime":                        "dateTime",
+	"ElementDefinition.PatternDecimal":                         "decimal",
+	"ElementDefinition.PatternId":                              "id",
+	"ElementDefinition.PatternInstant":                         "instant",
+	"ElementDefinition.PatternInteger":                         "integer",
+	"ElementDefinition.PatternMarkdown":                        "markdown",
+	"ElementDefinition.PatternOid":                             "oid",
+	"ElementDefinition.PatternPositiveInt":                     "positiveInt",
+	"ElementDefinition.PatternString":                          "string",
+	"ElementDefinition.PatternTime":                            "time",
+	"ElementDefinition.PatternUnsignedInt":                     "unsignedInt",
+	"ElementDefinition.PatternUri":                             "uri",
+	"ElementDefinition.PatternUrl":                             "url",
+	"ElementDefinition.PatternUuid":                            "uuid",
+	"ElementDefinition.Representation":                         "code",
+	"ElementDefinition.Requirements":                           "string",
+	"ElementDefinition.Short":                                  "string",
+	"ElementDefinition.SliceIsConstraining":                    "boolean",
+	"ElementDefinition.SliceName":                              "string",
+	"ElementDefinitionBase.Id":                                 "string",
+	"ElementDefinitionBase.Max":                                "string",
+	"ElementDefinitionBase.Min":                                "unsignedInt",
+	"ElementDefinitionBase.Path":                               "string",
+	"ElementDefinitionBinding.Description":                     "string",
+	"ElementDefinitionBinding.Id":                              "string",
+	"ElementDefinitionBinding.Strength":                        "code",
+	"ElementDefinitionBinding.ValueSet":                        "string",
+	"ElementDefinitionConstraint.Expression":                   "string",
+	"ElementDefinitionConstraint.Human":                        "string",
+	"ElementDefinitionConstraint.Id":                           "string",
+	"ElementDefinitionConstraint.Key":                          "string",
+	"ElementDefinitionConstraint.Requirements":                 "string",
+	"ElementDefinitionConstraint.Severity":                     "code",
+	"ElementDefinitionConstraint.Source":                       "string",
+	"ElementDefinitionConstraint.Xpath":                        "string",
+	"ElementDefinitionExample.Id":                              "string",
+	"ElementDefinitionExample.Label":                           "string",
+	"ElementDefinitionExample.ValueBase64Binary":               "base64Binary",
+	"ElementDefinitionExample.ValueBoolean":                    "boolean",
+	"ElementDefinitionExample.ValueCanonical":                  "canonical",
+	"ElementDefinitionExample.ValueCode":                       "code",
+	"ElementDefinitionExample.ValueDate":                       "date",
+	"ElementDefinitionExample.ValueDateTime":                   "dateTime",
+	"ElementDefinitionExample.ValueDecimal":                    "decimal",
+	"ElementDefinitionExample.ValueId":                         "id",
+	"ElementDefinitionExample.ValueInstant":                    "instant",
+	"ElementDefinitionExample.ValueInteger":                    "integer",
+	"ElementDefinitionExample.ValueMarkdown":                   "markdown",
+	"ElementDefinitionExample.ValueOid":                        "oid",
+	"ElementDefinitionExample.ValuePositiveInt":                "positiveInt",
+	"ElementDefinitionExample.ValueString":                     "string",
+	"ElementDefinitionExample.ValueTime":                       "time",
+	"ElementDefinitionExample.ValueUnsignedInt":                "unsignedInt",
+	"ElementDefinitionExample.ValueUri":                        "uri",
+	"ElementDefinitionExample.ValueUrl":                        "url",
+	"ElementDefinitionExample.ValueUuid":                       "uuid",
+	"ElementDefinitionMapping.Comment":                         "string",
+	"ElementDefinitionMapping.Id":                              "string",
+	"ElementDefinitionMapping.Identity":                        "string",
+	"ElementDefinitionMapping.Language":                        "string",
+	"ElementDefinitionMapping.Map":                             "string",
+	"ElementDefinitionSlicing.Description":                     "string",
+	"ElementDefinitionSlicing.Id":                              "string",
+	"ElementDefinitionSlicing.Ordered":                         "boolean",
+	"ElementDefinitionSlicing.Rules":                           "code",
+	"ElementDefinitionSlicingDiscriminator.Id":                 "string",
+	"ElementDefinitionSlicingDiscriminator.Path":               "string",
+	"ElementDefinitionSlicingDiscriminator.Type":               "code",
+	"ElementDefinitionType.Aggregation":                        "code",
+	"ElementDefinitionType.Code":                               "string",
+	"ElementDefinitionType.Id":                                 "string",
+	"ElementDefinitionType.Profile":                            "string",
+	"ElementDefinitionType.TargetProfile":                      "string",
+	"ElementDefinitionType.Versioning":                         "code",
+	"Expression.Description":                                   "string",
+	"Expression.Expression":                                    "string",
+	"Expression.Id":                                            "string",
+	"Expression.Language":                                      "string",
+	"Expression.Name":                                          "string",
+	"Expression.Reference":                                     "string",
+	"Extension.Id":                                             "string",
+	"Extension.Url":                                            "uri",
+	"Extension.ValueBase64Binary":                              "base64Binary",
+	"Extension.ValueBoolean":                                   "boolean",
+	"Extension.ValueCanonical":                                 "canonical",
+	"Extension.ValueCode":                                      "code",
+	"Extension.ValueDate":                                      "date",
+	"Extension.ValueDateTime":                                  "dateTime",
+	"Extension.ValueDecimal":                                   "decimal",
+	"Extension.ValueId":                                        "id",
+	"Extension.ValueInstant":                                   "instant",
+	"Extension.ValueInteger":                                   "integer",
+	"Extension.ValueMarkdown":                                  "markdown",
+	"Extension.ValueOid":                                       "oid",
+	"Extension.ValuePositiveInt":                               "positiveInt",
+	"Extension.ValueString":                                    "string",
+	"Extension.ValueTime":                                      "time",
+	"Extension.ValueUnsignedInt":                               "unsignedInt",
+	"Extension.ValueUri":                                       "uri",
+	"Extension.ValueUrl":                                       "url",
+	"Extension.ValueUuid":                                      "uuid",
+	"HumanName.Family":                                         "string",
+	"HumanName.Given":                                          "string",
+	"HumanName.Id":                                             "string",
+	"HumanName.Prefix":                                         "string",
+	"HumanName.Suffix":                                         "string",
+	"HumanName.Text":                                           "string",
+	"HumanName.Use":                                            "code",
+	"Identifier.Id":                                            "string",
+	"Identifier.System":                                        "string",
+	"Identifier.Use":                                           "code",
+	"Identifier.Value":                                         "string",
+	"Meta.Id":                                                  "string",
+	"Meta.LastUpdated":                                         "instant",
+	"Meta.Profile":                                             "string",
+	"Meta.Source":                                              "string",
+	"Meta.VersionId":                                           "string",
+	"Money.Currency":                                           "string",
+	"Money.Id":                                                 "string",
+	"Money.Value":                                              "decimal",
+	"Narrative.Div":                                            "xhtml",
+	"Narrative.Id":                                             "string",
+	"Narrative.Status":                                         "code",
+	"OperationDefinition.AffectsState":                         "boolean",
+	"OperationDefinition.Base":                                 "string",
+	"OperationDefinition.Code":                                 "string",
+	"OperationDefinition.Comment":                              "string",
+	"OperationDefinition.Date":                                 "dateTime",
+	"OperationDefinition.Description":                          "string",
+	"OperationDefinition.Experimental":                         "boolean",
+	"OperationDefinition.Id":                                   "string",
+	"OperationDefinition.ImplicitRules":                        "string",
+	"OperationDefinition.InputProfile":                         "string",
+	"OperationDefinition.Instance":                             "boolean",
+	"OperationDefinition.Kind":                                 "code",
+	"OperationDefinition.Language":                             "string",
+	"OperationDefinition.Name":                                 "string",
+	"OperationDefinition.OutputProfile":                        "string",
+	"OperationDefinition.Publisher":                            "string",
+	"OperationDefinition.Purpose":                              "string",
+	"OperationDefinition.Resource":                             "code",
+	"OperationDefinition.Status":                               "code",
+	"OperationDefinition.System":                               "boolean",
+	"OperationDefinition.Title":                                "string",
+	"OperationDefinition.Type":                                 "boolean",
+	"OperationDefinition.Url":                                  "string",
+	"OperationDefinition.Version":                              "string",
+	"OperationDefinitionOverload.Comment":                      "string",
+	"OperationDefinitionOverload.Id":                           "string",
+	"OperationDefinitionOverload.ParameterName":                "string",
+	"OperationDefinitionParameter.Documentation":               "string",
+	"OperationDefinitionParameter.Id":                          "string",
+	"OperationDefinitionParameter.Max":                         "string",
+	"OperationDefinitionParameter.Min":                         "integer",
+	"OperationDefinitionParameter.Name":                        "string",
+	"OperationDefinitionParameter.SearchType":                  "code",
+	"OperationDefinitionParameter.TargetProfile":               "string",
+	"OperationDefinitionParameter.Type":                        "string",
+	"OperationDefinitionParameter.Use":                         "code",
+	"OperationDefinitionParameterBinding.Id":                   "string",
+	"OperationDefinitionParameterBinding.Strength":             "code",
+	"OperationDefinitionParameterBinding.ValueSet":             "string",
+	"OperationDefinitionParameterReferencedFrom.Id":            "string",
+	"OperationDefinitionParameterReferencedFrom.Source":        "string",
+	"OperationDefinitionParameterReferencedFrom.SourceId":      "string",
+	"ParameterDefinition.Documentation":                        "string",
+	"ParameterDefinition.Id":                                   "string",
+	"ParameterDefinition.Max":                                  "string",
+	"ParameterDefinition.Min":                                  "integer",
+	"ParameterDefinition.Name":                                 "string",
+	"ParameterDefinition.Profile":                              "string",
+	"ParameterDefinition.Type":                                 "string",
+	"ParameterDefinition.Use":                                  "code",
+	"Period.End":                                               "dateTime",
+	"Period.Id":                                                "string",
+	"Period.Start":                                             "dateTime",
+	"Quantity.Code":                                            "string",
+	"Quantity.Comparator":                                      "code",
+	"Quantity.Id":                                              "string",
+	"Quantity.System":                                          "string",
+	"Quantity.Unit":                                            "string",
+	"Quantity.Value":                                           "decimal",
+	"Range.Id":                                                 "string",
+	"Ratio.Id":                                                 "string",
+	"Reference.Display":                                        "string",
+	"Reference.Id":                                             "string",
+	"Reference.Reference":                                      "string",
+	"Reference.Type":                                           "string",
+	"RelatedArtifact.Citation":                                 "string",
+	"RelatedArtifact.Display":                                  "string",
+	"RelatedArtifact.Id":                                       "string",
+	"RelatedArtifact.Label":                                    "string",
+	"RelatedArtifact.Resource":                                 "string",
+	"RelatedArtifact.Type":                                     "code",
+	"RelatedArtifact.Url":                                      "string",
+	"SampledData.Data":                                         "string",
+	"SampledData.Dimensions":                                   "positiveInt",
+	"SampledData.Factor":                                       "decimal",
+	"SampledData.Id":                                           "string",
+	"SampledData.LowerLimit":                                   "decimal",
+	"SampledData.Period":                                       "decimal",
+	"SampledData.UpperLimit":                                   "decimal",
+	"Signature.Data":                                           "base64Binary",
+	"Signature.Id":                                             "string",
+	"Signature.SigFormat":                                      "string",
+	"Signature.TargetFormat":                                   "string",
+	"Signature.When":                                           "instant",
+	"StructureDefinition.Abstract":                             "boolean",
+	"StructureDefinition.BaseDefinition":                       "string",
+	"StructureDefinition.ContextInvariant":                     "string",
+	"StructureDefinition.Copyright":                            "string",
+	"StructureDefinition.Date":                                 "dateTime",
+	"StructureDefinition.Derivation":                           "code",
+	"StructureDefinition.Description":                          "string",
+	"StructureDefinition.Experimental":                         "boolean",
+	"StructureDefinition.FhirVersion":                          "code",
+	"StructureDefinition.Id":                                   "string",
+	"StructureDefinition.ImplicitRules":                        "string",
+	"StructureDefinition.Kind":                                 "code",
+	"StructureDefinition.Language":                             "string",
+	"StructureDefinition.Name":                                 "string",
+	"StructureDefinition.Publisher":                            "string",
+	"StructureDefinition.Purpose":                              "string",
+	"StructureDefinition.Status":                               "code",
+	"StructureDefinition.Title":                                "string",
+	"StructureDefinition.Type":                                 "string",
+	"StructureDefinition.Url":                                  "string",
+	"StructureDefinition.Version":                              "string",
+	"StructureDefinitionContext.Expression":                    "string",
+	"StructureDefinitionContext.Id":                            "string",
+	"StructureDefinitionContext.Type":                          "code",
+	"StructureDefinitionDifferential.Id":                       "string",
+	"StructureDefinitionMapping.Comment":                       "string",
+	"StructureDefinitionMapping.Id":                            "string",
+	"StructureDefinitionMapping.Identity":                      "string",
+	"StructureDefinitionMapping.Name":                          "string",
+	"StructureDefinitionMapping.Uri":                           "string",
+	"StructureDefinitionSnapshot.Id":                           "string",
+	"Timing.Event":                                             "dateTime",
+	"Timing.Id":                                                "string",
+	"TimingRepeat.Count":                                       "positiveInt",
+	"TimingRepeat.CountMax":                                    "positiveInt",
+	"TimingRepeat.DayOfWeek":                                   "code",
+	"TimingRepeat.Duration":                                    "decimal",
+	"TimingRepeat.DurationMax":                                 "decimal",
+	"TimingRepeat.DurationUnit":                                "string",
+	"TimingRepeat.Frequency":                                   "positiveInt",
+	"TimingRepeat.FrequencyMax":                                "positiveInt",
+	"TimingRepeat.Id":                                          "string",
+	"TimingRepeat.Offset":                                      "unsignedInt",
+	"TimingRepeat.Period":                                      "decimal",
+	"TimingRepeat.PeriodMax":                                   "decimal",
+	"TimingRepeat.PeriodUnit":                                  "string",
+	"TimingRepeat.TimeOfDay":                                   "time",
+	"TimingRepeat.When":                                        "string",
+	"TriggerDefinition.Id":                                     "string",
+	"TriggerDefinition.Name":                                   "string",
+	"TriggerDefinition.TimingDate":                             "date",
+	"TriggerDefinition.TimingDateTime":                         "dateTime",
+	"TriggerDefinition.Type":                                   "code",
+	"UsageContext.Id":                                          "string",
+	"ValueSet.Copyright":                                       "string",
+	"ValueSet.Date":                                            "dateTime",
+	"ValueSet.Description":                                     "string",
+	"ValueSet.Experimental":                                    "boolean",
+	"ValueSet.Id":                                              "string",
+	"ValueSet.Immutable":                                       "boolean",
+	"ValueSet.ImplicitRules":                                   "string",
+	"ValueSet.Language":                                        "string",
+	"ValueSet.Name":                                            "string",
+	"ValueSet.Publisher":                                       "string",
+	"ValueSet.Purpose":                                         "string",
+	"ValueSet.Status":                                          "code",
+	"ValueSet.Title":                                           "string",
+	"ValueSet.Url":                                             "string",
+	"ValueSet.Version":                                         "string",
+	"ValueSetCompose.Id":                                       "string",
+	"ValueSetCompose.Inactive":                                 "boolean",
+	"ValueSetCompose.LockedDate":                               "date",
+	"ValueSetComposeInclude.Id":                                "string",
+	"ValueSetComposeInclude.System":                            "string",
+	"ValueSetComposeInclude.ValueSet":                          "string",
+	"ValueSetComposeInclude.Version":                           "string",
+	"ValueSetComposeIncludeConcept.Code":                       "string",
+	"ValueSetComposeIncludeConcept.Display":                    "string",
+	"ValueSetComposeIncludeConcept.Id":                         "string",
+	"ValueSetComposeIncludeConceptDesignation.Id":              "string",
+	"ValueSetComposeIncludeConceptDesignation.Language":        "string",
+	"ValueSetComposeIncludeConceptDesignation.Value":           "string",
+	"ValueSetComposeIncludeFilter.Id":                          "string",
+	"ValueSetComposeIncludeFilter.Op":                          "code",
+	"ValueSetComposeIncludeFilter.Property":                    "string",
+	"ValueSetComposeIncludeFilter.Value":                       "string",
+	"ValueSetExpansion.Id":                                     "string",
+	"ValueSetExpansion.Identifier":                             "string",
+	"ValueSetExpansion.Offset":                                 "integer",
+	"ValueSetExpansion.Timestamp":                              "dateTime",
+	"ValueSetExpansion.Total":                                  "integer",
+	"ValueSetExpansionContains.Abstract":                       "boolean",
+	"ValueSetExpansionContains.Code":                           "string",
+	"ValueSetExpansionContains.Display":                        "string",
+	"ValueSetExpansionContains.Id":                             "string",
+	"ValueSetExpansionContains.Inactive":                       "boolean",
+	"ValueSetExpansionContains.System":                         "string",
+	"ValueSetExpansionContains.Version":                        "string",
+	"ValueSetExpansionParameter.Id":                            "string",
+	"ValueSetExpansionParameter.Name":                          "string",
+	"ValueSetExpansionParameter.ValueBoolean":                  "boolean",
+	"ValueSetExpansionParameter.ValueCode":                     "code",
+	"ValueSetExpansionParameter.ValueDateTime":                 "dateTime",
+	"ValueSetExpansionParameter.ValueDecimal":                  "decimal",
+	"ValueSetExpansionParameter.ValueInteger":                  "integer",
+	"ValueSetExpansionParameter.ValueString":                   "string",
+	"ValueSetExpansionParameter.ValueUri":                      "uri",
+}
+
+// PrimitiveTypeCode returns the FHIR type code of the primitive field of the given struct, like date or positiveInt,
+// as the Go types string and int don't distinguish them. It returns false if the field is no primitive field.
+func PrimitiveTypeCode(structName, fieldName string) (string, bool) {
+	code, ok := primitiveTypeCodes[structName+"."+fieldName]
+	return code, ok
+}
+
+// protoResourceNumbers maps resource types to their field numbers in the ContainedResource message
+var protoResourceNumbers = map[string]int{
+	"Bundle":              1,
+	"CapabilityStatement": 2,
+	"CodeSystem":          3,
+	"OperationDefinition": 4,
+	"StructureDefinition": 5,
+	"ValueSet":            6,
+}
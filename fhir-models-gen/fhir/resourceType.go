@@ -0,0 +1,2006 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// ResourceType is documented here http://hl7.org/fhir/ValueSet/resource-types
+type ResourceType int
+
+const (
+	ResourceTypeAccount ResourceType = iota
+	ResourceTypeActivityDefinition
+	ResourceTypeAdverseEvent
+	ResourceTypeAllergyIntolerance
+	ResourceTypeAppointment
+	ResourceTypeAppointmentResponse
+	ResourceTypeAuditEvent
+	ResourceTypeBasic
+	ResourceTypeBinary
+	ResourceTypeBiologicallyDerivedProduct
+	ResourceTypeBodyStructure
+	ResourceTypeBundle
+	ResourceTypeCapabilityStatement
+	ResourceTypeCarePlan
+	ResourceTypeCareTeam
+	ResourceTypeCatalogEntry
+	ResourceTypeChargeItem
+	ResourceTypeChargeItemDefinition
+	ResourceTypeClaim
+	ResourceTypeClaimResponse
+	ResourceTypeClinicalImpression
+	ResourceTypeCodeSystem
+	ResourceTypeCommunication
+	ResourceTypeCommunicationRequest
+	ResourceTypeCompartmentDefinition
+	ResourceTypeComposition
+	ResourceTypeConceptMap
+	ResourceTypeCondition
+	ResourceTypeConsent
+	ResourceTypeContract
+	ResourceTypeCoverage
+	ResourceTypeCoverageEligibilityRequest
+	ResourceTypeCoverageEligibilityResponse
+	ResourceTypeDetectedIssue
+	ResourceTypeDevice
+	ResourceTypeDeviceDefinition
+	ResourceTypeDeviceMetric
+	ResourceTypeDeviceRequest
+	ResourceTypeDeviceUseStatement
+	ResourceTypeDiagnosticReport
+	ResourceTypeDocumentManifest
+	ResourceTypeDocumentReference
+	ResourceTypeDomainResource
+	ResourceTypeEffectEvidenceSynthesis
+	ResourceTypeEncounter
+	ResourceTypeEndpoint
+	ResourceTypeEnrollmentRequest
+	ResourceTypeEnrollmentResponse
+	ResourceTypeEpisodeOfCare
+	ResourceTypeEventDefinition
+	ResourceTypeEvidence
+	ResourceTypeEvidenceVariable
+	ResourceTypeExampleScenario
+	ResourceTypeExplanationOfBenefit
+	ResourceTypeFamilyMemberHistory
+	ResourceTypeFlag
+	ResourceTypeGoal
+	ResourceTypeGraphDefinition
+	ResourceTypeGroup
+	ResourceTypeGuidanceResponse
+	ResourceTypeHealthcareService
+	ResourceTypeImagingStudy
+	ResourceTypeImmunization
+	ResourceTypeImmunizationEvaluation
+	ResourceTypeImmunizationRecommendation
+	ResourceTypeImplementationGuide
+	ResourceTypeInsurancePlan
+	ResourceTypeInvoice
+	ResourceTypeLibrary
+	ResourceTypeLinkage
+	ResourceTypeList
+	ResourceTypeLocation
+	ResourceTypeMeasure
+	ResourceTypeMeasureReport
+	ResourceTypeMedia
+	ResourceTypeMedication
+	ResourceTypeMedicationAdministration
+	ResourceTypeMedicationDispense
+	ResourceTypeMedicationKnowledge
+	ResourceTypeMedicationRequest
+	ResourceTypeMedicationStatement
+	ResourceTypeMedicinalProduct
+	ResourceTypeMedicinalProductAuthorization
+	ResourceTypeMedicinalProductContraindication
+	ResourceTypeMedicinalProductIndication
+	ResourceTypeMedicinalProductIngredient
+	ResourceTypeMedicinalProductInteraction
+	ResourceTypeMedicinalProductManufactured
+	ResourceTypeMedicinalProductPackaged
+	ResourceTypeMedicinalProductPharmaceutical
+	ResourceTypeMedicinalProductUndesirableEffect
+	ResourceTypeMessageDefinition
+	ResourceTypeMessageHeader
+	ResourceTypeMolecularSequence
+	ResourceTypeNamingSystem
+	ResourceTypeNutritionOrder
+	ResourceTypeObservation
+	ResourceTypeObservationDefinition
+	ResourceTypeOperationDefinition
+	ResourceTypeOperationOutcome
+	ResourceTypeOrganization
+	ResourceTypeOrganizationAffiliation
+	ResourceTypeParameters
+	ResourceTypePatient
+	ResourceTypePaymentNotice
+	ResourceTypePaymentReconciliation
+	ResourceTypePerson
+	ResourceTypePlanDefinition
+	ResourceTypePractitioner
+	ResourceTypePractitionerRole
+	ResourceTypeProcedure
+	ResourceTypeProvenance
+	ResourceTypeQuestionnaire
+	ResourceTypeQuestionnaireResponse
+	ResourceTypeRelatedPerson
+	ResourceTypeRequestGroup
+	ResourceTypeResearchDefinition
+	ResourceTypeResearchElementDefinition
+	ResourceTypeResearchStudy
+	ResourceTypeResearchSubject
+	ResourceTypeResource
+	ResourceTypeRiskAssessment
+	ResourceTypeRiskEvidenceSynthesis
+	ResourceTypeSchedule
+	ResourceTypeSearchParameter
+	ResourceTypeServiceRequest
+	ResourceTypeSlot
+	ResourceTypeSpecimen
+	ResourceTypeSpecimenDefinition
+	ResourceTypeStructureDefinition
+	ResourceTypeStructureMap
+	ResourceTypeSubscription
+	ResourceTypeSubstance
+	ResourceTypeSubstanceNucleicAcid
+	ResourceTypeSubstancePolymer
+	ResourceTypeSubstanceProtein
+	ResourceTypeSubstanceReferenceInformation
+	ResourceTypeSubstanceSourceMaterial
+	ResourceTypeSubstanceSpecification
+	ResourceTypeSupplyDelivery
+	ResourceTypeSupplyRequest
+	ResourceTypeTask
+	ResourceTypeTerminologyCapabilities
+	ResourceTypeTestReport
+	ResourceTypeTestScript
+	ResourceTypeValueSet
+	ResourceTypeVerificationResult
+	ResourceTypeVisionPrescription
+)
+
+func (code ResourceType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(code.Code())
+}
+func (code *ResourceType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
+	switch s {
+	case "Account":
+		*code = ResourceTypeAccount
+	case "ActivityDefinition":
+		*code = ResourceTypeActivityDefinition
+	case "AdverseEvent":
+		*code = ResourceTypeAdverseEvent
+	case "AllergyIntolerance":
+		*code = ResourceTypeAllergyIntolerance
+	case "Appointment":
+		*code = ResourceTypeAppointment
+	case "AppointmentResponse":
+		*code = ResourceTypeAppointmentResponse
+	case "AuditEvent":
+		*code = ResourceTypeAuditEvent
+	case "Basic":
+		*code = ResourceTypeBasic
+	case "Binary":
+		*code = ResourceTypeBinary
+	case "BiologicallyDerivedProduct":
+		*code = ResourceTypeBiologicallyDerivedProduct
+	case "BodyStructure":
+		*code = ResourceTypeBodyStructure
+	case "Bundle":
+		*code = ResourceTypeBundle
+	case "CapabilityStatement":
+		*code = ResourceTypeCapabilityStatement
+	case "CarePlan":
+		*code = ResourceTypeCarePlan
+	case "CareTeam":
+		*code = ResourceTypeCareTeam
+	case "CatalogEntry":
+		*code = ResourceTypeCatalogEntry
+	case "ChargeItem":
+		*code = ResourceTypeChargeItem
+	case "ChargeItemDefinition":
+		*code = ResourceTypeChargeItemDefinition
+	case "Claim":
+		*code = ResourceTypeClaim
+	case "ClaimResponse":
+		*code = ResourceTypeClaimResponse
+	case "ClinicalImpression":
+		*code = ResourceTypeClinicalImpression
+	case "CodeSystem":
+		*code = ResourceTypeCodeSystem
+	case "Communication":
+		*code = ResourceTypeCommunication
+	case "CommunicationRequest":
+		*code = ResourceTypeCommunicationRequest
+	case "CompartmentDefinition":
+		*code = ResourceTypeCompartmentDefinition
+	case "Composition":
+		*code = ResourceTypeComposition
+	case "ConceptMap":
+		*code = ResourceTypeConceptMap
+	case "Condition":
+		*code = ResourceTypeCondition
+	case "Consent":
+		*code = ResourceTypeConsent
+	case "Contract":
+		*code = ResourceTypeContract
+	case "Coverage":
+		*code = ResourceTypeCoverage
+	case "CoverageEligibilityRequest":
+		*code = ResourceTypeCoverageEligibilityRequest
+	case "CoverageEligibilityResponse":
+		*code = ResourceTypeCoverageEligibilityResponse
+	case "DetectedIssue":
+		*code = ResourceTypeDetectedIssue
+	case "Device":
+		*code = ResourceTypeDevice
+	case "DeviceDefinition":
+		*code = ResourceTypeDeviceDefinition
+	case "DeviceMetric":
+		*code = ResourceTypeDeviceMetric
+	case "DeviceRequest":
+		*code = ResourceTypeDeviceRequest
+	case "DeviceUseStatement":
+		*code = ResourceTypeDeviceUseStatement
+	case "DiagnosticReport":
+		*code = ResourceTypeDiagnosticReport
+	case "DocumentManifest":
+		*code = ResourceTypeDocumentManifest
+	case "DocumentReference":
+		*code = ResourceTypeDocumentReference
+	case "DomainResource":
+		*code = ResourceTypeDomainResource
+	case "EffectEvidenceSynthesis":
+		*code = ResourceTypeEffectEvidenceSynthesis
+	case "Encounter":
+		*code = ResourceTypeEncounter
+	case "Endpoint":
+		*code = ResourceTypeEndpoint
+	case "EnrollmentRequest":
+		*code = ResourceTypeEnrollmentRequest
+	case "EnrollmentResponse":
+		*code = ResourceTypeEnrollmentResponse
+	case "EpisodeOfCare":
+		*code = ResourceTypeEpisodeOfCare
+	case "EventDefinition":
+		*code = ResourceTypeEventDefinition
+	case "Evidence":
+		*code = ResourceTypeEvidence
+	case "EvidenceVariable":
+		*code = ResourceTypeEvidenceVariable
+	case "ExampleScenario":
+		*code = ResourceTypeExampleScenario
+	case "ExplanationOfBenefit":
+		*code = ResourceTypeExplanationOfBenefit
+	case "FamilyMemberHistory":
+		*code = ResourceTypeFamilyMemberHistory
+	case "Flag":
+		*code = ResourceTypeFlag
+	case "Goal":
+		*code = ResourceTypeGoal
+	case "GraphDefinition":
+		*code = ResourceTypeGraphDefinition
+	case "Group":
+		*code = ResourceTypeGroup
+	case "GuidanceResponse":
+		*code = ResourceTypeGuidanceResponse
+	case "HealthcareService":
+		*code = ResourceTypeHealthcareService
+	case "ImagingStudy":
+		*code = ResourceTypeImagingStudy
+	case "Immunization":
+		*code = ResourceTypeImmunization
+	case "ImmunizationEvaluation":
+		*code = ResourceTypeImmunizationEvaluation
+	case "ImmunizationRecommendation":
+		*code = ResourceTypeImmunizationRecommendation
+	case "ImplementationGuide":
+		*code = ResourceTypeImplementationGuide
+	case "InsurancePlan":
+		*code = ResourceTypeInsurancePlan
+	case "Invoice":
+		*code = ResourceTypeInvoice
+	case "Library":
+		*code = ResourceTypeLibrary
+	case "Linkage":
+		*code = ResourceTypeLinkage
+	case "List":
+		*code = ResourceTypeList
+	case "Location":
+		*code = ResourceTypeLocation
+	case "Measure":
+		*code = ResourceTypeMeasure
+	case "MeasureReport":
+		*code = ResourceTypeMeasureReport
+	case "Media":
+		*code = ResourceTypeMedia
+	case "Medication":
+		*code = ResourceTypeMedication
+	case "MedicationAdministration":
+		*code = ResourceTypeMedicationAdministration
+	case "MedicationDispense":
+		*code = ResourceTypeMedicationDispense
+	case "MedicationKnowledge":
+		*code = ResourceTypeMedicationKnowledge
+	case "MedicationRequest":
+		*code = ResourceTypeMedicationRequest
+	case "MedicationStatement":
+		*code = ResourceTypeMedicationStatement
+	case "MedicinalProduct":
+		*code = ResourceTypeMedicinalProduct
+	case "MedicinalProductAuthorization":
+		*code = ResourceTypeMedicinalProductAuthorization
+	case "MedicinalProductContraindication":
+		*code = ResourceTypeMedicinalProductContraindication
+	case "MedicinalProductIndication":
+		*code = ResourceTypeMedicinalProductIndication
+	case "MedicinalProductIngredient":
+		*code = ResourceTypeMedicinalProductIngredient
+	case "MedicinalProductInteraction":
+		*code = ResourceTypeMedicinalProductInteraction
+	case "MedicinalProductManufactured":
+		*code = ResourceTypeMedicinalProductManufactured
+	case "MedicinalProductPackaged":
+		*code = ResourceTypeMedicinalProductPackaged
+	case "MedicinalProductPharmaceutical":
+		*code = ResourceTypeMedicinalProductPharmaceutical
+	case "MedicinalProductUndesirableEffect":
+		*code = ResourceTypeMedicinalProductUndesirableEffect
+	case "MessageDefinition":
+		*code = ResourceTypeMessageDefinition
+	case "MessageHeader":
+		*code = ResourceTypeMessageHeader
+	case "MolecularSequence":
+		*code = ResourceTypeMolecularSequence
+	case "NamingSystem":
+		*code = ResourceTypeNamingSystem
+	case "NutritionOrder":
+		*code = ResourceTypeNutritionOrder
+	case "Observation":
+		*code = ResourceTypeObservation
+	case "ObservationDefinition":
+		*code = ResourceTypeObservationDefinition
+	case "OperationDefinition":
+		*code = ResourceTypeOperationDefinition
+	case "OperationOutcome":
+		*code = ResourceTypeOperationOutcome
+	case "Organization":
+		*code = ResourceTypeOrganization
+	case "OrganizationAffiliation":
+		*code = ResourceTypeOrganizationAffiliation
+	case "Parameters":
+		*code = ResourceTypeParameters
+	case "Patient":
+		*code = ResourceTypePatient
+	case "PaymentNotice":
+		*code = ResourceTypePaymentNotice
+	case "PaymentReconciliation":
+		*code = ResourceTypePaymentReconciliation
+	case "Person":
+		*code = ResourceTypePerson
+	case "PlanDefinition":
+		*code = ResourceTypePlanDefinition
+	case "Practitioner":
+		*code = ResourceTypePractitioner
+	case "PractitionerRole":
+		*code = ResourceTypePractitionerRole
+	case "Procedure":
+		*code = ResourceTypeProcedure
+	case "Provenance":
+		*code = ResourceTypeProvenance
+	case "Questionnaire":
+		*code = ResourceTypeQuestionnaire
+	case "QuestionnaireResponse":
+		*code = ResourceTypeQuestionnaireResponse
+	case "RelatedPerson":
+		*code = ResourceTypeRelatedPerson
+	case "RequestGroup":
+		*code = ResourceTypeRequestGroup
+	case "ResearchDefinition":
+		*code = ResourceTypeResearchDefinition
+	case "ResearchElementDefinition":
+		*code = ResourceTypeResearchElementDefinition
+	case "ResearchStudy":
+		*code = ResourceTypeResearchStudy
+	case "ResearchSubject":
+		*code = ResourceTypeResearchSubject
+	case "Resource":
+		*code = ResourceTypeResource
+	case "RiskAssessment":
+		*code = ResourceTypeRiskAssessment
+	case "RiskEvidenceSynthesis":
+		*code = ResourceTypeRiskEvidenceSynthesis
+	case "Schedule":
+		*code = ResourceTypeSchedule
+	case "SearchParameter":
+		*code = ResourceTypeSearchParameter
+	case "ServiceRequest":
+		*code = ResourceTypeServiceRequest
+	case "Slot":
+		*code = ResourceTypeSlot
+	case "Specimen":
+		*code = ResourceTypeSpecimen
+	case "SpecimenDefinition":
+		*code = ResourceTypeSpecimenDefinition
+	case "StructureDefinition":
+		*code = ResourceTypeStructureDefinition
+	case "StructureMap":
+		*code = ResourceTypeStructureMap
+	case "Subscription":
+		*code = ResourceTypeSubscription
+	case "Substance":
+		*code = ResourceTypeSubstance
+	case "SubstanceNucleicAcid":
+		*code = ResourceTypeSubstanceNucleicAcid
+	case "SubstancePolymer":
+		*code = ResourceTypeSubstancePolymer
+	case "SubstanceProtein":
+		*code = ResourceTypeSubstanceProtein
+	case "SubstanceReferenceInformation":
+		*code = ResourceTypeSubstanceReferenceInformation
+	case "SubstanceSourceMaterial":
+		*code = ResourceTypeSubstanceSourceMaterial
+	case "SubstanceSpecification":
+		*code = ResourceTypeSubstanceSpecification
+	case "SupplyDelivery":
+		*code = ResourceTypeSupplyDelivery
+	case "SupplyRequest":
+		*code = ResourceTypeSupplyRequest
+	case "Task":
+		*code = ResourceTypeTask
+	case "TerminologyCapabilities":
+		*code = ResourceTypeTerminologyCapabilities
+	case "TestReport":
+		*code = ResourceTypeTestReport
+	case "TestScript":
+		*code = ResourceTypeTestScript
+	case "ValueSet":
+		*code = ResourceTypeValueSet
+	case "VerificationResult":
+		*code = ResourceTypeVerificationResult
+	case "VisionPrescription":
+		*code = ResourceTypeVisionPrescription
+	default:
+		return fmt.Errorf("unknown ResourceType code `%s`", s)
+	}
+	return nil
+}
+func (code ResourceType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ResourceType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
+func (code ResourceType) String() string {
+	return code.Code()
+}
+func (code ResourceType) Code() string {
+	switch code {
+	case ResourceTypeAccount:
+		return "Account"
+	case ResourceTypeActivityDefinition:
+		return "ActivityDefinition"
+	case ResourceTypeAdverseEvent:
+		return "AdverseEvent"
+	case ResourceTypeAllergyIntolerance:
+		return "AllergyIntolerance"
+	case ResourceTypeAppointment:
+		return "Appointment"
+	case ResourceTypeAppointmentResponse:
+		return "AppointmentResponse"
+	case ResourceTypeAuditEvent:
+		return "AuditEvent"
+	case ResourceTypeBasic:
+		return "Basic"
+	case ResourceTypeBinary:
+		return "Binary"
+	case ResourceTypeBiologicallyDerivedProduct:
+		return "BiologicallyDerivedProduct"
+	case ResourceTypeBodyStructure:
+		return "BodyStructure"
+	case ResourceTypeBundle:
+		return "Bundle"
+	case ResourceTypeCapabilityStatement:
+		return "CapabilityStatement"
+	case ResourceTypeCarePlan:
+		return "CarePlan"
+	case ResourceTypeCareTeam:
+		return "CareTeam"
+	case ResourceTypeCatalogEntry:
+		return "CatalogEntry"
+	case ResourceTypeChargeItem:
+		return "ChargeItem"
+	case ResourceTypeChargeItemDefinition:
+		return "ChargeItemDefinition"
+	case ResourceTypeClaim:
+		return "Claim"
+	case ResourceTypeClaimResponse:
+		return "ClaimResponse"
+	case ResourceTypeClinicalImpression:
+		return "ClinicalImpression"
+	case ResourceTypeCodeSystem:
+		return "CodeSystem"
+	case ResourceTypeCommunication:
+		return "Communication"
+	case ResourceTypeCommunicationRequest:
+		return "CommunicationRequest"
+	case ResourceTypeCompartmentDefinition:
+		return "CompartmentDefinition"
+	case ResourceTypeComposition:
+		return "Composition"
+	case ResourceTypeConceptMap:
+		return "ConceptMap"
+	case ResourceTypeCondition:
+		return "Condition"
+	case ResourceTypeConsent:
+		return "Consent"
+	case ResourceTypeContract:
+		return "Contract"
+	case ResourceTypeCoverage:
+		return "Coverage"
+	case ResourceTypeCoverageEligibilityRequest:
+		return "CoverageEligibilityRequest"
+	case ResourceTypeCoverageEligibilityResponse:
+		return "CoverageEligibilityResponse"
+	case ResourceTypeDetectedIssue:
+		return "DetectedIssue"
+	case ResourceTypeDevice:
+		return "Device"
+	case ResourceTypeDeviceDefinition:
+		return "DeviceDefinition"
+	case ResourceTypeDeviceMetric:
+		return "DeviceMetric"
+	case ResourceTypeDeviceRequest:
+		return "DeviceRequest"
+	case ResourceTypeDeviceUseStatement:
+		return "DeviceUseStatement"
+	case ResourceTypeDiagnosticReport:
+		return "DiagnosticReport"
+	case ResourceTypeDocumentManifest:
+		return "DocumentManifest"
+	case ResourceTypeDocumentReference:
+		return "DocumentReference"
+	case ResourceTypeDomainResource:
+		return "DomainResource"
+	case ResourceTypeEffectEvidenceSynthesis:
+		return "EffectEvidenceSynthesis"
+	case ResourceTypeEncounter:
+		return "Encounter"
+	case ResourceTypeEndpoint:
+		return "Endpoint"
+	case ResourceTypeEnrollmentRequest:
+		return "EnrollmentRequest"
+	case ResourceTypeEnrollmentResponse:
+		return "EnrollmentResponse"
+	case ResourceTypeEpisodeOfCare:
+		return "EpisodeOfCare"
+	case ResourceTypeEventDefinition:
+		return "EventDefinition"
+	case ResourceTypeEvidence:
+		return "Evidence"
+	case ResourceTypeEvidenceVariable:
+		return "EvidenceVariable"
+	case ResourceTypeExampleScenario:
+		return "ExampleScenario"
+	case ResourceTypeExplanationOfBenefit:
+		return "ExplanationOfBenefit"
+	case ResourceTypeFamilyMemberHistory:
+		return "FamilyMemberHistory"
+	case ResourceTypeFlag:
+		return "Flag"
+	case ResourceTypeGoal:
+		return "Goal"
+	case ResourceTypeGraphDefinition:
+		return "GraphDefinition"
+	case ResourceTypeGroup:
+		return "Group"
+	case ResourceTypeGuidanceResponse:
+		return "GuidanceResponse"
+	case ResourceTypeHealthcareService:
+		return "HealthcareService"
+	case ResourceTypeImagingStudy:
+		return "ImagingStudy"
+	case ResourceTypeImmunization:
+		return "Immunization"
+	case ResourceTypeImmunizationEvaluation:
+		return "ImmunizationEvaluation"
+	case ResourceTypeImmunizationRecommendation:
+		return "ImmunizationRecommendation"
+	case ResourceTypeImplementationGuide:
+		return "ImplementationGuide"
+	case ResourceTypeInsurancePlan:
+		return "InsurancePlan"
+	case ResourceTypeInvoice:
+		return "Invoice"
+	case ResourceTypeLibrary:
+		return "Library"
+	case ResourceTypeLinkage:
+		return "Linkage"
+	case ResourceTypeList:
+		return "List"
+	case ResourceTypeLocation:
+		return "Location"
+	case ResourceTypeMeasure:
+		return "Measure"
+	case ResourceTypeMeasureReport:
+		return "MeasureReport"
+	case ResourceTypeMedia:
+		return "Media"
+	case ResourceTypeMedication:
+		return "Medication"
+	case ResourceTypeMedicationAdministration:
+		return "MedicationAdministration"
+	case ResourceTypeMedicationDispense:
+		return "MedicationDispense"
+	case ResourceTypeMedicationKnowledge:
+		return "MedicationKnowledge"
+	case ResourceTypeMedicationRequest:
+		return "MedicationRequest"
+	case ResourceTypeMedicationStatement:
+		return "MedicationStatement"
+	case ResourceTypeMedicinalProduct:
+		return "MedicinalProduct"
+	case ResourceTypeMedicinalProductAuthorization:
+		return "MedicinalProductAuthorization"
+	case ResourceTypeMedicinalProductContraindication:
+		return "MedicinalProductContraindication"
+	case ResourceTypeMedicinalProductIndication:
+		return "MedicinalProductIndication"
+	case ResourceTypeMedicinalProductIngredient:
+		return "MedicinalProductIngredient"
+	case ResourceTypeMedicinalProductInteraction:
+		return "MedicinalProductInteraction"
+	case ResourceTypeMedicinalProductManufactured:
+		return "MedicinalProductManufactured"
+	case ResourceTypeMedicinalProductPackaged:
+		return "MedicinalProductPackaged"
+	case ResourceTypeMedicinalProductPharmaceutical:
+		return "MedicinalProductPharmaceutical"
+	case ResourceTypeMedicinalProductUndesirableEffect:
+		return "MedicinalProductUndesirableEffect"
+	case ResourceTypeMessageDefinition:
+		return "MessageDefinition"
+	case ResourceTypeMessageHeader:
+		return "MessageHeader"
+	case ResourceTypeMolecularSequence:
+		return "MolecularSequence"
+	case ResourceTypeNamingSystem:
+		return "NamingSystem"
+	case ResourceTypeNutritionOrder:
+		return "NutritionOrder"
+	case ResourceTypeObservation:
+		return "Observation"
+	case ResourceTypeObservationDefinition:
+		return "ObservationDefinition"
+	case ResourceTypeOperationDefinition:
+		return "OperationDefinition"
+	case ResourceTypeOperationOutcome:
+		return "OperationOutcome"
+	case ResourceTypeOrganization:
+		return "Organization"
+	case ResourceTypeOrganizationAffiliation:
+		return "OrganizationAffiliation"
+	case ResourceTypeParameters:
+		return "Parameters"
+	case ResourceTypePatient:
+		return "Patient"
+	case ResourceTypePaymentNotice:
+		return "PaymentNotice"
+	case ResourceTypePaymentReconciliation:
+		return "PaymentReconciliation"
+	case ResourceTypePerson:
+		return "Person"
+	case ResourceTypePlanDefinition:
+		return "PlanDefinition"
+	case ResourceTypePractitioner:
+		return "Practitioner"
+	case ResourceTypePractitionerRole:
+		return "PractitionerRole"
+	case ResourceTypeProcedure:
+		return "Procedure"
+	case ResourceTypeProvenance:
+		return "Provenance"
+	case ResourceTypeQuestionnaire:
+		return "Questionnaire"
+	case ResourceTypeQuestionnaireResponse:
+		return "QuestionnaireResponse"
+	case ResourceTypeRelatedPerson:
+		return "RelatedPerson"
+	case ResourceTypeRequestGroup:
+		return "RequestGroup"
+	case ResourceTypeResearchDefinition:
+		return "ResearchDefinition"
+	case ResourceTypeResearchElementDefinition:
+		return "ResearchElementDefinition"
+	case ResourceTypeResearchStudy:
+		return "ResearchStudy"
+	case ResourceTypeResearchSubject:
+		return "ResearchSubject"
+	case ResourceTypeResource:
+		return "Resource"
+	case ResourceTypeRiskAssessment:
+		return "RiskAssessment"
+	case ResourceTypeRiskEvidenceSynthesis:
+		return "RiskEvidenceSynthesis"
+	case ResourceTypeSchedule:
+		return "Schedule"
+	case ResourceTypeSearchParameter:
+		return "SearchParameter"
+	case ResourceTypeServiceRequest:
+		return "ServiceRequest"
+	case ResourceTypeSlot:
+		return "Slot"
+	case ResourceTypeSpecimen:
+		return "Specimen"
+	case ResourceTypeSpecimenDefinition:
+		return "SpecimenDefinition"
+	case ResourceTypeStructureDefinition:
+		return "StructureDefinition"
+	case ResourceTypeStructureMap:
+		return "StructureMap"
+	case ResourceTypeSubscription:
+		return "Subscription"
+	case ResourceTypeSubstance:
+		return "Substance"
+	case ResourceTypeSubstanceNucleicAcid:
+		return "SubstanceNucleicAcid"
+	case ResourceTypeSubstancePolymer:
+		return "SubstancePolymer"
+	case ResourceTypeSubstanceProtein:
+		return "SubstanceProtein"
+	case ResourceTypeSubstanceReferenceInformation:
+		return "SubstanceReferenceInformation"
+	case ResourceTypeSubstanceSourceMaterial:
+		return "SubstanceSourceMaterial"
+	case ResourceTypeSubstanceSpecification:
+		return "SubstanceSpecification"
+	case ResourceTypeSupplyDelivery:
+		return "SupplyDelivery"
+	case ResourceTypeSupplyRequest:
+		return "SupplyRequest"
+	case ResourceTypeTask:
+		return "Task"
+	case ResourceTypeTerminologyCapabilities:
+		return "TerminologyCapabilities"
+	case ResourceTypeTestReport:
+		return "TestReport"
+	case ResourceTypeTestScript:
+		return "TestScript"
+	case ResourceTypeValueSet:
+		return "ValueSet"
+	case ResourceTypeVerificationResult:
+		return "VerificationResult"
+	case ResourceTypeVisionPrescription:
+		return "VisionPrescription"
+	}
+	return "<unknown>"
+}
+func (code ResourceType) Display() string {
+	switch code {
+	case ResourceTypeAccount:
+		return "Account"
+	case ResourceTypeActivityDefinition:
+		return "ActivityDefinition"
+	case ResourceTypeAdverseEvent:
+		return "AdverseEvent"
+	case ResourceTypeAllergyIntolerance:
+		return "AllergyIntolerance"
+	case ResourceTypeAppointment:
+		return "Appointment"
+	case ResourceTypeAppointmentResponse:
+		return "AppointmentResponse"
+	case ResourceTypeAuditEvent:
+		return "AuditEvent"
+	case ResourceTypeBasic:
+		return "Basic"
+	case ResourceTypeBinary:
+		return "Binary"
+	case ResourceTypeBiologicallyDerivedProduct:
+		return "BiologicallyDerivedProduct"
+	case ResourceTypeBodyStructure:
+		return "BodyStructure"
+	case ResourceTypeBundle:
+		return "Bundle"
+	case ResourceTypeCapabilityStatement:
+		return "CapabilityStatement"
+	case ResourceTypeCarePlan:
+		return "CarePlan"
+	case ResourceTypeCareTeam:
+		return "CareTeam"
+	case ResourceTypeCatalogEntry:
+		return "CatalogEntry"
+	case ResourceTypeChargeItem:
+		return "ChargeItem"
+	case ResourceTypeChargeItemDefinition:
+		return "ChargeItemDefinition"
+	case ResourceTypeClaim:
+		return "Claim"
+	case ResourceTypeClaimResponse:
+		return "ClaimResponse"
+	case ResourceTypeClinicalImpression:
+		return "ClinicalImpression"
+	case ResourceTypeCodeSystem:
+		return "CodeSystem"
+	case ResourceTypeCommunication:
+		return "Communication"
+	case ResourceTypeCommunicationRequest:
+		return "CommunicationRequest"
+	case ResourceTypeCompartmentDefinition:
+		return "CompartmentDefinition"
+	case ResourceTypeComposition:
+		return "Composition"
+	case ResourceTypeConceptMap:
+		return "ConceptMap"
+	case ResourceTypeCondition:
+		return "Condition"
+	case ResourceTypeConsent:
+		return "Consent"
+	case ResourceTypeContract:
+		return "Contract"
+	case ResourceTypeCoverage:
+		return "Coverage"
+	case ResourceTypeCoverageEligibilityRequest:
+		return "CoverageEligibilityRequest"
+	case ResourceTypeCoverageEligibilityResponse:
+		return "CoverageEligibilityResponse"
+	case ResourceTypeDetectedIssue:
+		return "DetectedIssue"
+	case ResourceTypeDevice:
+		return "Device"
+	case ResourceTypeDeviceDefinition:
+		return "DeviceDefinition"
+	case ResourceTypeDeviceMetric:
+		return "DeviceMetric"
+	case ResourceTypeDeviceRequest:
+		return "DeviceRequest"
+	case ResourceTypeDeviceUseStatement:
+		return "DeviceUseStatement"
+	case ResourceTypeDiagnosticReport:
+		return "DiagnosticReport"
+	case ResourceTypeDocumentManifest:
+		return "DocumentManifest"
+	case ResourceTypeDocumentReference:
+		return "DocumentReference"
+	case ResourceTypeDomainResource:
+		return "DomainResource"
+	case ResourceTypeEffectEvidenceSynthesis:
+		return "EffectEvidenceSynthesis"
+	case ResourceTypeEncounter:
+		return "Encounter"
+	case ResourceTypeEndpoint:
+		return "Endpoint"
+	case ResourceTypeEnrollmentRequest:
+		return "EnrollmentRequest"
+	case ResourceTypeEnrollmentResponse:
+		return "EnrollmentResponse"
+	case ResourceTypeEpisodeOfCare:
+		return "EpisodeOfCare"
+	case ResourceTypeEventDefinition:
+		return "EventDefinition"
+	case ResourceTypeEvidence:
+		return "Evidence"
+	case ResourceTypeEvidenceVariable:
+		return "EvidenceVariable"
+	case ResourceTypeExampleScenario:
+		return "ExampleScenario"
+	case ResourceTypeExplanationOfBenefit:
+		return "ExplanationOfBenefit"
+	case ResourceTypeFamilyMemberHistory:
+		return "FamilyMemberHistory"
+	case ResourceTypeFlag:
+		return "Flag"
+	case ResourceTypeGoal:
+		return "Goal"
+	case ResourceTypeGraphDefinition:
+		return "GraphDefinition"
+	case ResourceTypeGroup:
+		return "Group"
+	case ResourceTypeGuidanceResponse:
+		return "GuidanceResponse"
+	case ResourceTypeHealthcareService:
+		return "HealthcareService"
+	case ResourceTypeImagingStudy:
+		return "ImagingStudy"
+	case ResourceTypeImmunization:
+		return "Immunization"
+	case ResourceTypeImmunizationEvaluation:
+		return "ImmunizationEvaluation"
+	case ResourceTypeImmunizationRecommendation:
+		return "ImmunizationRecommendation"
+	case ResourceTypeImplementationGuide:
+		return "ImplementationGuide"
+	case ResourceTypeInsurancePlan:
+		return "InsurancePlan"
+	case ResourceTypeInvoice:
+		return "Invoice"
+	case ResourceTypeLibrary:
+		return "Library"
+	case ResourceTypeLinkage:
+		return "Linkage"
+	case ResourceTypeList:
+		return "List"
+	case ResourceTypeLocation:
+		return "Location"
+	case ResourceTypeMeasure:
+		return "Measure"
+	case ResourceTypeMeasureReport:
+		return "MeasureReport"
+	case ResourceTypeMedia:
+		return "Media"
+	case ResourceTypeMedication:
+		return "Medication"
+	case ResourceTypeMedicationAdministration:
+		return "MedicationAdministration"
+	case ResourceTypeMedicationDispense:
+		return "MedicationDispense"
+	case ResourceTypeMedicationKnowledge:
+		return "MedicationKnowledge"
+	case ResourceTypeMedicationRequest:
+		return "MedicationRequest"
+	case ResourceTypeMedicationStatement:
+		return "MedicationStatement"
+	case ResourceTypeMedicinalProduct:
+		return "MedicinalProduct"
+	case ResourceTypeMedicinalProductAuthorization:
+		return "MedicinalProductAuthorization"
+	case ResourceTypeMedicinalProductContraindication:
+		return "MedicinalProductContraindication"
+	case ResourceTypeMedicinalProductIndication:
+		return "MedicinalProductIndication"
+	case ResourceTypeMedicinalProductIngredient:
+		return "MedicinalProductIngredient"
+	case ResourceTypeMedicinalProductInteraction:
+		return "MedicinalProductInteraction"
+	case ResourceTypeMedicinalProductManufactured:
+		return "MedicinalProductManufactured"
+	case ResourceTypeMedicinalProductPackaged:
+		return "MedicinalProductPackaged"
+	case ResourceTypeMedicinalProductPharmaceutical:
+		return "MedicinalProductPharmaceutical"
+	case ResourceTypeMedicinalProductUndesirableEffect:
+		return "MedicinalProductUndesirableEffect"
+	case ResourceTypeMessageDefinition:
+		return "MessageDefinition"
+	case ResourceTypeMessageHeader:
+		return "MessageHeader"
+	case ResourceTypeMolecularSequence:
+		return "MolecularSequence"
+	case ResourceTypeNamingSystem:
+		return "NamingSystem"
+	case ResourceTypeNutritionOrder:
+		return "NutritionOrder"
+	case ResourceTypeObservation:
+		return "Observation"
+	case ResourceTypeObservationDefinition:
+		return "ObservationDefinition"
+	case ResourceTypeOperationDefinition:
+		return "OperationDefinition"
+	case ResourceTypeOperationOutcome:
+		return "OperationOutcome"
+	case ResourceTypeOrganization:
+		return "Organization"
+	case ResourceTypeOrganizationAffiliation:
+		return "OrganizationAffiliation"
+	case ResourceTypeParameters:
+		return "Parameters"
+	case ResourceTypePatient:
+		return "Patient"
+	case ResourceTypePaymentNotice:
+		return "PaymentNotice"
+	case ResourceTypePaymentReconciliation:
+		return "PaymentReconciliation"
+	case ResourceTypePerson:
+		return "Person"
+	case ResourceTypePlanDefinition:
+		return "PlanDefinition"
+	case ResourceTypePractitioner:
+		return "Practitioner"
+	case ResourceTypePractitionerRole:
+		return "PractitionerRole"
+	case ResourceTypeProcedure:
+		return "Procedure"
+	case ResourceTypeProvenance:
+		return "Provenance"
+	case ResourceTypeQuestionnaire:
+		return "Questionnaire"
+	case ResourceTypeQuestionnaireResponse:
+		return "QuestionnaireResponse"
+	case ResourceTypeRelatedPerson:
+		return "RelatedPerson"
+	case ResourceTypeRequestGroup:
+		return "RequestGroup"
+	case ResourceTypeResearchDefinition:
+		return "ResearchDefinition"
+	case ResourceTypeResearchElementDefinition:
+		return "ResearchElementDefinition"
+	case ResourceTypeResearchStudy:
+		return "ResearchStudy"
+	case ResourceTypeResearchSubject:
+		return "ResearchSubject"
+	case ResourceTypeResource:
+		return "Resource"
+	case ResourceTypeRiskAssessment:
+		return "RiskAssessment"
+	case ResourceTypeRiskEvidenceSynthesis:
+		return "RiskEvidenceSynthesis"
+	case ResourceTypeSchedule:
+		return "Schedule"
+	case ResourceTypeSearchParameter:
+		return "SearchParameter"
+	case ResourceTypeServiceRequest:
+		return "ServiceRequest"
+	case ResourceTypeSlot:
+		return "Slot"
+	case ResourceTypeSpecimen:
+		return "Specimen"
+	case ResourceTypeSpecimenDefinition:
+		return "SpecimenDefinition"
+	case ResourceTypeStructureDefinition:
+		return "StructureDefinition"
+	case ResourceTypeStructureMap:
+		return "StructureMap"
+	case ResourceTypeSubscription:
+		return "Subscription"
+	case ResourceTypeSubstance:
+		return "Substance"
+	case ResourceTypeSubstanceNucleicAcid:
+		return "SubstanceNucleicAcid"
+	case ResourceTypeSubstancePolymer:
+		return "SubstancePolymer"
+	case ResourceTypeSubstanceProtein:
+		return "SubstanceProtein"
+	case ResourceTypeSubstanceReferenceInformation:
+		return "SubstanceReferenceInformation"
+	case ResourceTypeSubstanceSourceMaterial:
+		return "SubstanceSourceMaterial"
+	case ResourceTypeSubstanceSpecification:
+		return "SubstanceSpecification"
+	case ResourceTypeSupplyDelivery:
+		return "SupplyDelivery"
+	case ResourceTypeSupplyRequest:
+		return "SupplyRequest"
+	case ResourceTypeTask:
+		return "Task"
+	case ResourceTypeTerminologyCapabilities:
+		return "TerminologyCapabilities"
+	case ResourceTypeTestReport:
+		return "TestReport"
+	case ResourceTypeTestScript:
+		return "TestScript"
+	case ResourceTypeValueSet:
+		return "ValueSet"
+	case ResourceTypeVerificationResult:
+		return "VerificationResult"
+	case ResourceTypeVisionPrescription:
+		return "VisionPrescription"
+	}
+	return "<unknown>"
+}
+func (code ResourceType) Definition() string {
+	switch code {
+	case ResourceTypeAccount:
+		return "A financial tool for tracking value accrued for a particular purpose.  In the healthcare field, used to track charges for a patient, cost centers, etc."
+	case ResourceTypeActivityDefinition:
+		return "This resource allows for the definition of some activity to be performed, independent of a particular patient, practitioner, or other performance context."
+	case ResourceTypeAdverseEvent:
+		return "Actual or  potential/avoided event causing unintended physical injury resulting from or contributed to by medical care, a research study or other healthcare setting factors that requires additional monitoring, treatment, or hospitalization, or that results in death."
+	case ResourceTypeAllergyIntolerance:
+		return "Risk of harmful or undesirable, physiological response which is unique to an individual and associated with exposure to a substance."
+	case ResourceTypeAppointment:
+		return "A booking of a healthcare event among patient(s), practitioner(s), related person(s) and/or device(s) for a specific date/time. This may result in one or more Encounter(s)."
+	case ResourceTypeAppointmentResponse:
+		return "A reply to an appointment request for a patient and/or practitioner(s), such as a confirmation or rejection."
+	case ResourceTypeAuditEvent:
+		return "A record of an event made for purposes of maintaining a security log. Typical uses include detection of intrusion attempts and monitoring for inappropriate usage."
+	case ResourceTypeBasic:
+		return "Basic is used for handling concepts not yet defined in FHIR, narrative-only resources that don't map to an existing resource, and custom resources not appropriate for inclusion in the FHIR specification."
+	case ResourceTypeBinary:
+		return "A resource that represents the data of a single raw artifact as digital content accessible in its native format.  A Binary resource can contain any content, whether text, image, pdf, zip archive, etc."
+	case ResourceTypeBiologicallyDerivedProduct:
+		return "A material substance originating from a biological entity intended to be transplanted or infused\ninto another (possibly the same) biological entity."
+	case ResourceTypeBodyStructure:
+		return "Record details about an anatomical structure.  This resource may be used when a coded concept does not provide the necessary detail needed for the use case."
+	case ResourceTypeBundle:
+		return "A container for a collection of resources."
+	case ResourceTypeCapabilityStatement:
+		return "A Capability Statement documents a set of capabilities (behaviors) of a FHIR Server for a particular version of FHIR that may be used as a statement of actual server functionality or a statement of required or desired server implementation."
+	case ResourceTypeCarePlan:
+		return "Describes the intention of how one or more practitioners intend to deliver care for a particular patient, group or community for a period of time, possibly limited to care for a specific condition or set of conditions."
+	case ResourceTypeCareTeam:
+		return "The Care Team includes all the people and organizations who plan to participate in the coordination and delivery of care for a patient."
+	case ResourceTypeCatalogEntry:
+		return "Catalog entries are wrappers that contextualize items included in a catalog."
+	case ResourceTypeChargeItem:
+		return "The resource ChargeItem describes the provision of healthcare provider products for a certain patient, therefore referring not only to the product, but containing in addition details of the provision, like date, time, amounts and participating organizations and persons. Main Usage of the ChargeItem is to enable the billing process and internal cost allocation."
+	case ResourceTypeChargeItemDefinition:
+		return "The ChargeItemDefinition resource provides the properties that apply to the (billing) codes necessary to calculate costs and prices. The properties may differ largely depending on type and realm, therefore this resource gives only a rough structure and requires profiling for each type of billing code system."
+	case ResourceTypeClaim:
+		return "A provider issued list of professional services and products which have been provided, or are to be provided, to a patient which is sent to an insurer for reimbursement."
+	case ResourceTypeClaimResponse:
+		return "This resource provides the adjudication details from the processing of a Claim resource."
+	case ResourceTypeClinicalImpression:
+		return "A record of a clinical assessment performed to determine what problem(s) may affect the patient and before planning the treatments or management strategies that are best to manage a patient's condition. Assessments are often 1:1 with a clinical consultation / encounter,  but this varies greatly depending on the clinical workflow. This resource is called \"ClinicalImpression\" rather than \"ClinicalAssessment\" to avoid confusion with the recording of assessment tools such as Apgar score."
+	case ResourceTypeCodeSystem:
+		return "The CodeSystem resource is used to declare the existence of and describe a code system or code system supplement and its key properties, and optionally define a part or all of its content."
+	case ResourceTypeCommunication:
+		return "An occurrence of information being transmitted; e.g. an alert that was sent to a responsible provider, a public health agency that was notified about a reportable condition."
+	case ResourceTypeCommunicationRequest:
+		return "A request to convey information; e.g. the CDS system proposes that an alert be sent to a responsible provider, the CDS system proposes that the public health agency be notified about a reportable condition."
+	case ResourceTypeCompartmentDefinition:
+		return "A compartment definition that defines how resources are accessed on a server."
+	case ResourceTypeComposition:
+		return "A set of healthcare-related information that is assembled together into a single logical package that provides a single coherent statement of meaning, establishes its own context and that has clinical attestation with regard to who is making the statement. A Composition defines the structure and narrative content necessary for a document. However, a Composition alone does not constitute a document. Rather, the Composition must be the first entry in a Bundle where Bundle.type=document, and any other resources referenced from Composition must be included as subsequent entries in the Bundle (for example Patient, Practitioner, Encounter, etc.)."
+	case ResourceTypeConceptMap:
+		return "A statement of relationships from one set of concepts to one or more other concepts - either concepts in code systems, or data element/data element concepts, or classes in class models."
+	case ResourceTypeCondition:
+		return "A clinical condition, problem, diagnosis, or other event, situation, issue, or clinical concept that has risen to a level of concern."
+	case ResourceTypeConsent:
+		return "A record of a healthcare consumer’s  choices, which permits or denies identified recipient(s) or recipient role(s) to perform one or more actions within a given policy context, for specific purposes and periods of time."
+	case ResourceTypeContract:
+		return "Legally enforceable, formally recorded unilateral or bilateral directive i.e., a policy or agreement."
+	case ResourceTypeCoverage:
+		return "Financial instrument which may be used to reimburse or pay for health care products and services. Includes both insurance and self-payment."
+	case ResourceTypeCoverageEligibilityRequest:
+		return "The CoverageEligibilityRequest provides patient and insurance coverage information to an insurer for them to respond, in the form of an CoverageEligibilityResponse, with information regarding whether the stated coverage is valid and in-force and optionally to provide the insurance details of the policy."
+	case ResourceTypeCoverageEligibilityResponse:
+		return "This resource provides eligibility and plan details from the processing of an CoverageEligibilityRequest resource."
+	case ResourceTypeDetectedIssue:
+		return "Indicates an actual or potential clinical issue with or between one or more active or proposed clinical actions for a patient; e.g. Drug-drug interaction, Ineffective treatment frequency, Procedure-condition conflict, etc."
+	case ResourceTypeDevice:
+		return "A type of a manufactured item that is used in the provision of healthcare without being substantially changed through that activity. The device may be a medical or non-medical device."
+	case ResourceTypeDeviceDefinition:
+		return "The characteristics, operational status and capabilities of a medical-related component of a medical device."
+	case ResourceTypeDeviceMetric:
+		return "Describes a measurement, calculation or setting capability of a medical device."
+	case ResourceTypeDeviceRequest:
+		return "Represents a request for a patient to employ a medical device. The device may be an implantable device, or an external assistive device, such as a walker."
+	case ResourceTypeDeviceUseStatement:
+		return "A record of a device being used by a patient where the record is the result of a report from the patient or another clinician."
+	case ResourceTypeDiagnosticReport:
+		return "The findings and interpretation of diagnostic  tests performed on patients, groups of patients, devices, and locations, and/or specimens derived from these. The report includes clinical context such as requesting and provider information, and some mix of atomic results, images, textual and coded interpretations, and formatted representation of diagnostic reports."
+	case ResourceTypeDocumentManifest:
+		return "A collection of documents compiled for a purpose together with metadata that applies to the collection."
+	case ResourceTypeDocumentReference:
+		return "A reference to a document of any kind for any purpose. Provides metadata about the document so that the document can be discovered and managed. The scope of a document is any seralized object with a mime-type, so includes formal patient centric documents (CDA), cliical notes, scanned paper, and non-patient specific documents like policy text."
+	case ResourceTypeDomainResource:
+		return "A resource that includes narrative, extensions, and contained resources."
+	case ResourceTypeEffectEvidenceSynthesis:
+		return "The EffectEvidenceSynthesis resource describes the difference in an outcome between exposures states in a population where the effect estimate is derived from a combination of research studies."
+	case ResourceTypeEncounter:
+		return "An interaction between a patient and healthcare provider(s) for the purpose of providing healthcare service(s) or assessing the health status of a patient."
+	case ResourceTypeEndpoint:
+		return "The technical details of an endpoint that can be used for electronic services, such as for web services providing XDS.b or a REST endpoint for another FHIR server. This may include any security context information."
+	case ResourceTypeEnrollmentRequest:
+		return "This resource provides the insurance enrollment details to the insurer regarding a specified coverage."
+	case ResourceTypeEnrollmentResponse:
+		return "This resource provides enrollment and plan details from the processing of an EnrollmentRequest resource."
+	case ResourceTypeEpisodeOfCare:
+		return "An association between a patient and an organization / healthcare provider(s) during which time encounters may occur. The managing organization assumes a level of responsibility for the patient during this time."
+	case ResourceTypeEventDefinition:
+		return "The EventDefinition resource provides a reusable description of when a particular event can occur."
+	case ResourceTypeEvidence:
+		return "The Evidence resource describes the conditional state (population and any exposures being compared within the population) and outcome (if specified) that the knowledge (evidence, assertion, recommendation) is about."
+	case ResourceTypeEvidenceVariable:
+		return "The EvidenceVariable resource describes a \"PICO\" element that knowledge (evidence, assertion, recommendation) is about."
+	case ResourceTypeExampleScenario:
+		return "Example of workflow instance."
+	case ResourceTypeExplanationOfBenefit:
+		return "This resource provides: the claim details; adjudication details from the processing of a Claim; and optionally account balance information, for informing the subscriber of the benefits provided."
+	case ResourceTypeFamilyMemberHistory:
+		return "Significant health conditions for a person related to the patient relevant in the context of care for the patient."
+	case ResourceTypeFlag:
+		return "Prospective warnings of potential issues when providing care to the patient."
+	case ResourceTypeGoal:
+		return "Describes the intended objective(s) for a patient, group or organization care, for example, weight loss, restoring an activity of daily living, obtaining herd immunity via immunization, meeting a process improvement objective, etc."
+	case ResourceTypeGraphDefinition:
+		return "A formal computable definition of a graph of resources - that is, a coherent set of resources that form a graph by following references. The Graph Definition resource defines a set and makes rules about the set."
+	case ResourceTypeGroup:
+		return "Represents a defined collection of entities that may be discussed or acted upon collectively but which are not expected to act collectively, and are not formally or legally recognized; i.e. a collection of entities that isn't an Organization."
+	case ResourceTypeGuidanceResponse:
+		return "A guidance response is the formal response to a guidance request, including any output parameters returned by the evaluation, as well as the description of any proposed actions to be taken."
+	case ResourceTypeHealthcareService:
+		return "The details of a healthcare service available at a location."
+	case ResourceTypeImagingStudy:
+		return "Representation of the content produced in a DICOM imaging study. A study comprises a set of series, each of which includes a set of Service-Object Pair Instances (SOP Instances - images or other data) acquired or produced in a common context.  A series is of only one modality (e.g. X-ray, CT, MR, ultrasound), but a study may have multiple series of different modalities."
+	case ResourceTypeImmunization:
+		return "Describes the event of a patient being administered a vaccine or a record of an immunization as reported by a patient, a clinician or another party."
+	case ResourceTypeImmunizationEvaluation:
+		return "Describes a comparison of an immunization event against published recommendations to determine if the administration is \"valid\" in relation to those  recommendations."
+	case ResourceTypeImmunizationRecommendation:
+		return "A patient's point-in-time set of recommendations (i.e. forecasting) according to a published schedule with optional supporting justification."
+	case ResourceTypeImplementationGuide:
+		return "A set of rules of how a particular interoperability or standards problem is solved - typically through the use of FHIR resources. This resource is used to gather all the parts of an implementation guide into a logical whole and to publish a computable definition of all the parts."
+	case ResourceTypeInsurancePlan:
+		return "Details of a Health Insurance product/plan provided by an organization."
+	case ResourceTypeInvoice:
+		return "Invoice containing collected ChargeItems from an Account with calculated individual and total price for Billing purpose."
+	case ResourceTypeLibrary:
+		return "The Library resource is a general-purpose container for knowledge asset definitions. It can be used to describe and expose existing knowledge assets such as logic libraries and information model descriptions, as well as to describe a collection of knowledge assets."
+	case ResourceTypeLinkage:
+		return "Identifies two or more records (resource instances) that refer to the same real-world \"occurrence\"."
+	case ResourceTypeList:
+		return "A list is a curated collection of resources."
+	case ResourceTypeLocation:
+		return "Details and position information for a physical place where services are provided and resources and participants may be stored, found, contained, or accommodated."
+	case ResourceTypeMeasure:
+		return "The Measure resource provides the definition of a quality measure."
+	case ResourceTypeMeasureReport:
+		return "The MeasureReport resource contains the results of the calculation of a measure; and optionally a reference to the resources involved in that calculation."
+	case ResourceTypeMedia:
+		return "A photo, video, or audio recording acquired or used in healthcare. The actual content may be inline or provided by direct reference."
+	case ResourceTypeMedication:
+		return "This resource is primarily used for the identification and definition of a medication for the purposes of prescribing, dispensing, and administering a medication as well as for making statements about medication use."
+	case ResourceTypeMedicationAdministration:
+		return "Describes the event of a patient consuming or otherwise being administered a medication.  This may be as simple as swallowing a tablet or it may be a long running infusion.  Related resources tie this event to the authorizing prescription, and the specific encounter between patient and health care practitioner."
+	case ResourceTypeMedicationDispense:
+		return "Indicates that a medication product is to be or has been dispensed for a named person/patient.  This includes a description of the medication product (supply) provided and the instructions for administering the medication.  The medication dispense is the result of a pharmacy system responding to a medication order."
+	case ResourceTypeMedicationKnowledge:
+		return "Information about a medication that is used to support knowledge."
+	case ResourceTypeMedicationRequest:
+		return "An order or request for both supply of the medication and the instructions for administration of the medication to a patient. The resource is called \"MedicationRequest\" rather than \"MedicationPrescription\" or \"MedicationOrder\" to generalize the use across inpatient and outpatient settings, including care plans, etc., and to harmonize with workflow patterns."
+	case ResourceTypeMedicationStatement:
+		return "A record of a medication that is being consumed by a patient.   A MedicationStatement may indicate that the patient may be taking the medication now or has taken the medication in the past or will be taking the medication in the future.  The source of this information can be the patient, significant other (such as a family member or spouse), or a clinician.  A common scenario where this information is captured is during the history taking process during a patient visit or stay.   The medication information may come from sources such as the patient's memory, from a prescription bottle,  or from a list of medications the patient, clinician or other party maintains. \n\nThe primary difference between a medication statement and a medication administration is that the medication administration has complete administration information and is based on actual administration information from the person who administered the medication.  A medication statement is often, if not always, less specific.  There is no required date/time when the medication was administered, in fact we only know that a source has reported the patient is taking this medication, where details such as time, quantity, or rate or even medication product may be incomplete or missing or less precise.  As stated earlier, the medication statement information may come from the patient's memory, from a prescription bottle or from a list of medications the patient, clinician or other party maintains.  Medication administration is more formal and is not missing detailed information."
+	case ResourceTypeMedicinalProduct:
+		return "Detailed definition of a medicinal product, typically for uses other than direct patient care (e.g. regulatory use)."
+	case ResourceTypeMedicinalProductAuthorization:
+		return "The regulatory authorization of a medicinal product."
+	case ResourceTypeMedicinalProductContraindication:
+		return "The clinical particulars - indications, contraindications etc. of a medicinal product, including for regulatory purposes."
+	case ResourceTypeMedicinalProductIndication:
+		return "Indication for the Medicinal Product."
+	case ResourceTypeMedicinalProductIngredient:
+		return "An ingredient of a manufactured item or pharmaceutical product."
+	case ResourceTypeMedicinalProductInteraction:
+		return "The interactions of the medicinal product with other medicinal products, or other forms of interactions."
+	case ResourceTypeMedicinalProductManufactured:
+		return "The manufactured item as contained in the packaged medicinal product."
+	case ResourceTypeMedicinalProductPackaged:
+		return "A medicinal product in a container or package."
+	case ResourceTypeMedicinalProductPharmaceutical:
+		return "A pharmaceutical product described in terms of its composition and dose form."
+	case ResourceTypeMedicinalProductUndesirableEffect:
+		return "Describe the undesirable effects of the medicinal product."
+	case ResourceTypeMessageDefinition:
+		return "Defines the characteristics of a message that can be shared between systems, including the type of event that initiates the message, the content to be transmitted and what response(s), if any, are permitted."
+	case ResourceTypeMessageHeader:
+		return "The header for a message exchange that is either requesting or responding to an action.  The reference(s) that are the subject of the action as well as other information related to the action are typically transmitted in a bundle in which the MessageHeader resource instance is the first resource in the bundle."
+	case ResourceTypeMolecularSequence:
+		return "Raw data describing a biological sequence."
+	case ResourceTypeNamingSystem:
+		return "A curated namespace that issues unique symbols within that namespace for the identification of concepts, people, devices, etc.  Represents a \"System\" used within the Identifier and Coding data types."
+	case ResourceTypeNutritionOrder:
+		return "A request to supply a diet, formula feeding (enteral) or oral nutritional supplement to a patient/resident."
+	case ResourceTypeObservation:
+		return "Measurements and simple assertions made about a patient, device or other subject."
+	case ResourceTypeObservationDefinition:
+		return "Set of definitional characteristics for a kind of observation or measurement produced or consumed by an orderable health care service."
+	case ResourceTypeOperationDefinition:
+		return "A formal computable definition of an operation (on the RESTful interface) or a named query (using the search interaction)."
+	case ResourceTypeOperationOutcome:
+		return "A collection of error, warning, or information messages that result from a system action."
+	case ResourceTypeOrganization:
+		return "A formally or informally recognized grouping of people or organizations formed for the purpose of achieving some form of collective action.  Includes companies, institutions, corporations, departments, community groups, healthcare practice groups, payer/insurer, etc."
+	case ResourceTypeOrganizationAffiliation:
+		return "Defines an affiliation/assotiation/relationship between 2 distinct oganizations, that is not a part-of relationship/sub-division relationship."
+	case ResourceTypeParameters:
+		return "This resource is a non-persisted resource used to pass information into and back from an [operation](operations.html). It has no other use, and there is no RESTful endpoint associated with it."
+	case ResourceTypePatient:
+		return "Demographics and other administrative information about an individual or animal receiving care or other health-related services."
+	case ResourceTypePaymentNotice:
+		return "This resource provides the status of the payment for goods and services rendered, and the request and response resource references."
+	case ResourceTypePaymentReconciliation:
+		return "This resource provides the details including amount of a payment and allocates the payment items being paid."
+	case ResourceTypePerson:
+		return "Demographics and administrative information about a person independent of a specific health-related context."
+	case ResourceTypePlanDefinition:
+		return "This resource allows for the definition of various types of plans as a sharable, consumable, and executable artifact. The resource is general enough to support the description of a broad range of clinical artifacts such as clinical decision support rules, order sets and protocols."
+	case ResourceTypePractitioner:
+		return "A person who is directly or indirectly involved in the provisioning of healthcare."
+	case ResourceTypePractitionerRole:
+		return "A specific set of Roles/Locations/specialties/services that a practitioner may perform at an organization for a period of time."
+	case ResourceTypeProcedure:
+		return "An action that is or was performed on or for a patient. This can be a physical intervention like an operation, or less invasive like long term services, counseling, or hypnotherapy."
+	case ResourceTypeProvenance:
+		return "Provenance of a resource is a record that describes entities and processes involved in producing and delivering or otherwise influencing that resource. Provenance provides a critical foundation for assessing authenticity, enabling trust, and allowing reproducibility. Provenance assertions are a form of contextual metadata and can themselves become important records with their own provenance. Provenance statement indicates clinical significance in terms of confidence in authenticity, reliability, and trustworthiness, integrity, and stage in lifecycle (e.g. Document Completion - has the artifact been legally authenticated), all of which may impact security, privacy, and trust policies."
+	case ResourceTypeQuestionnaire:
+		return "A structured set of questions intended to guide the collection of answers from end-users. Questionnaires provide detailed control over order, presentation, phraseology and grouping to allow coherent, consistent data collection."
+	case ResourceTypeQuestionnaireResponse:
+		return "A structured set of questions and their answers. The questions are ordered and grouped into coherent subsets, corresponding to the structure of the grouping of the questionnaire being responded to."
+	case ResourceTypeRelatedPerson:
+		return "Information about a person that is involved in the care for a patient, but who is not the target of healthcare, nor has a formal responsibility in the care process."
+	case ResourceTypeRequestGroup:
+		return "A group of related requests that can be used to capture intended activities that have inter-dependencies such as \"give this medication after that one\"."
+	case ResourceTypeResearchDefinition:
+		return "The ResearchDefinition resource describes the conditional state (population and any exposures being compared within the population) and outcome (if specified) that the knowledge (evidence, assertion, recommendation) is about."
+	case ResourceTypeResearchElementDefinition:
+		return "The ResearchElementDefinition resource describes a \"PICO\" element that knowledge (evidence, assertion, recommendation) is about."
+	case ResourceTypeResearchStudy:
+		return "A process where a researcher or organization plans and then executes a series of steps intended to increase the field of healthcare-related knowledge.  This includes studies of safety, efficacy, comparative effectiveness and other information about medications, devices, therapies and other interventional and investigative techniques.  A ResearchStudy involves the gathering of information about human or animal subjects."
+	case ResourceTypeResearchSubject:
+		return "A physical entity which is the primary unit of operational and/or administrative interest in a study."
+	case ResourceTypeResource:
+		return "This is the base resource type for everything."
+	case ResourceTypeRiskAssessment:
+		return "An assessment of the likely outcome(s) for a patient or other subject as well as the likelihood of each outcome."
+	case ResourceTypeRiskEvidenceSynthesis:
+		return "The RiskEvidenceSynthesis resource describes the likelihood of an outcome in a population plus exposure state where the risk estimate is derived from a combination of research studies."
+	case ResourceTypeSchedule:
+		return "A container for slots of time that may be available for booking appointments."
+	case ResourceTypeSearchParameter:
+		return "A search parameter that defines a named search item that can be used to search/filter on a resource."
+	case ResourceTypeServiceRequest:
+		return "A record of a request for service such as diagnostic investigations, treatments, or operations to be performed."
+	case ResourceTypeSlot:
+		return "A slot of time on a schedule that may be available for booking appointments."
+	case ResourceTypeSpecimen:
+		return "A sample to be used for analysis."
+	case ResourceTypeSpecimenDefinition:
+		return "A kind of specimen with associated set of requirements."
+	case ResourceTypeStructureDefinition:
+		return "A definition of a FHIR structure. This resource is used to describe the underlying resources, data types defined in FHIR, and also for describing extensions and constraints on resources and data types."
+	case ResourceTypeStructureMap:
+		return "A Map of relationships between 2 structures that can be used to transform data."
+	case ResourceTypeSubscription:
+		return "The subscription resource is used to define a push-based subscription from a server to another system. Once a subscription is registered with the server, the server checks every resource that is created or updated, and if the resource matches the given criteria, it sends a message on the defined \"channel\" so that another system can take an appropriate action."
+	case ResourceTypeSubstance:
+		return "A homogeneous material with a definite composition."
+	case ResourceTypeSubstanceNucleicAcid:
+		return "Nucleic acids are defined by three distinct elements: the base, sugar and linkage. Individual substance/moiety IDs will be created for each of these elements. The nucleotide sequence will be always entered in the 5’-3’ direction."
+	case ResourceTypeSubstancePolymer:
+		return "Todo."
+	case ResourceTypeSubstanceProtein:
+		return "A SubstanceProtein is defined as a single unit of a linear amino acid sequence, or a combination of subunits that are either covalently linked or have a defined invariant stoichiometric relationship. This includes all synthetic, recombinant and purified SubstanceProteins of defined sequence, whether the use is therapeutic or prophylactic. This set of elements will be used to describe albumins, coagulation factors, cytokines, growth factors, peptide/SubstanceProtein hormones, enzymes, toxins, toxoids, recombinant vaccines, and immunomodulators."
+	case ResourceTypeSubstanceReferenceInformation:
+		return "Todo."
+	case ResourceTypeSubstanceSourceMaterial:
+		return "Source material shall capture information on the taxonomic and anatomical origins as well as the fraction of a material that can result in or can be modified to form a substance. This set of data elements shall be used to define polymer substances isolated from biological matrices. Taxonomic and anatomical origins shall be described using a controlled vocabulary as required. This information is captured for naturally derived polymers ( . starch) and structurally diverse substances. For Organisms belonging to the Kingdom Plantae the Substance level defines the fresh material of a single species or infraspecies, the Herbal Drug and the Herbal preparation. For Herbal preparations, the fraction information will be captured at the Substance information level and additional information for herbal extracts will be captured at the Specified Substance Group 1 information level. See for further explanation the Substance Class: Structurally Diverse and the herbal annex."
+	case ResourceTypeSubstanceSpecification:
+		return "The detailed description of a substance, typically at a level beyond what is used for prescribing."
+	case ResourceTypeSupplyDelivery:
+		return "Record of delivery of what is supplied."
+	case ResourceTypeSupplyRequest:
+		return "A record of a request for a medication, substance or device used in the healthcare setting."
+	case ResourceTypeTask:
+		return "A task to be performed."
+	case ResourceTypeTerminologyCapabilities:
+		return "A TerminologyCapabilities resource documents a set of capabilities (behaviors) of a FHIR Terminology Server that may be used as a statement of actual server functionality or a statement of required or desired server implementation."
+	case ResourceTypeTestReport:
+		return "A summary of information based on the results of executing a TestScript."
+	case ResourceTypeTestScript:
+		return "A structured set of tests against a FHIR server or client implementation to determine compliance against the FHIR specification."
+	case ResourceTypeValueSet:
+		return "A ValueSet resource instance specifies a set of codes drawn from one or more code systems, intended for use in a particular context. Value sets link between [[[CodeSystem]]] definitions and their use in [coded elements](terminologies.html)."
+	case ResourceTypeVerificationResult:
+		return "Describes validation requirements, source(s), status and dates for one or more elements."
+	case ResourceTypeVisionPrescription:
+		return "An authorization for the provision of glasses and/or contact lenses to a patient."
+	}
+	return "<unknown>"
+}
+func (code ResourceType) protoNumber() uint64 {
+	switch code {
+	case ResourceTypeAccount:
+		return 1
+	case ResourceTypeActivityDefinition:
+		return 2
+	case ResourceTypeAdverseEvent:
+		return 3
+	case ResourceTypeAllergyIntolerance:
+		return 4
+	case ResourceTypeAppointment:
+		return 5
+	case ResourceTypeAppointmentResponse:
+		return 6
+	case ResourceTypeAuditEvent:
+		return 7
+	case ResourceTypeBasic:
+		return 8
+	case ResourceTypeBinary:
+		return 9
+	case ResourceTypeBiologicallyDerivedProduct:
+		return 10
+	case ResourceTypeBodyStructure:
+		return 11
+	case ResourceTypeBundle:
+		return 12
+	case ResourceTypeCapabilityStatement:
+		return 13
+	case ResourceTypeCarePlan:
+		return 14
+	case ResourceTypeCareTeam:
+		return 15
+	case ResourceTypeCatalogEntry:
+		return 16
+	case ResourceTypeChargeItem:
+		return 17
+	case ResourceTypeChargeItemDefinition:
+		return 18
+	case ResourceTypeClaim:
+		return 19
+	case ResourceTypeClaimResponse:
+		return 20
+	case ResourceTypeClinicalImpression:
+		return 21
+	case ResourceTypeCodeSystem:
+		return 22
+	case ResourceTypeCommunication:
+		return 23
+	case ResourceTypeCommunicationRequest:
+		return 24
+	case ResourceTypeCompartmentDefinition:
+		return 25
+	case ResourceTypeComposition:
+		return 26
+	case ResourceTypeConceptMap:
+		return 27
+	case ResourceTypeCondition:
+		return 28
+	case ResourceTypeConsent:
+		return 29
+	case ResourceTypeContract:
+		return 30
+	case ResourceTypeCoverage:
+		return 31
+	case ResourceTypeCoverageEligibilityRequest:
+		return 32
+	case ResourceTypeCoverageEligibilityResponse:
+		return 33
+	case ResourceTypeDetectedIssue:
+		return 34
+	case ResourceTypeDevice:
+		return 35
+	case ResourceTypeDeviceDefinition:
+		return 36
+	case ResourceTypeDeviceMetric:
+		return 37
+	case ResourceTypeDeviceRequest:
+		return 38
+	case ResourceTypeDeviceUseStatement:
+		return 39
+	case ResourceTypeDiagnosticReport:
+		return 40
+	case ResourceTypeDocumentManifest:
+		return 41
+	case ResourceTypeDocumentReference:
+		return 42
+	case ResourceTypeDomainResource:
+		return 43
+	case ResourceTypeEffectEvidenceSynthesis:
+		return 44
+	case ResourceTypeEncounter:
+		return 45
+	case ResourceTypeEndpoint:
+		return 46
+	case ResourceTypeEnrollmentRequest:
+		return 47
+	case ResourceTypeEnrollmentResponse:
+		return 48
+	case ResourceTypeEpisodeOfCare:
+		return 49
+	case ResourceTypeEventDefinition:
+		return 50
+	case ResourceTypeEvidence:
+		return 51
+	case ResourceTypeEvidenceVariable:
+		return 52
+	case ResourceTypeExampleScenario:
+		return 53
+	case ResourceTypeExplanationOfBenefit:
+		return 54
+	case ResourceTypeFamilyMemberHistory:
+		return 55
+	case ResourceTypeFlag:
+		return 56
+	case ResourceTypeGoal:
+		return 57
+	case ResourceTypeGraphDefinition:
+		return 58
+	case ResourceTypeGroup:
+		return 59
+	case ResourceTypeGuidanceResponse:
+		return 60
+	case ResourceTypeHealthcareService:
+		return 61
+	case ResourceTypeImagingStudy:
+		return 62
+	case ResourceTypeImmunization:
+		return 63
+	case ResourceTypeImmunizationEvaluation:
+		return 64
+	case ResourceTypeImmunizationRecommendation:
+		return 65
+	case ResourceTypeImplementationGuide:
+		return 66
+	case ResourceTypeInsurancePlan:
+		return 67
+	case ResourceTypeInvoice:
+		return 68
+	case ResourceTypeLibrary:
+		return 69
+	case ResourceTypeLinkage:
+		return 70
+	case ResourceTypeList:
+		return 71
+	case ResourceTypeLocation:
+		return 72
+	case ResourceTypeMeasure:
+		return 73
+	case ResourceTypeMeasureReport:
+		return 74
+	case ResourceTypeMedia:
+		return 75
+	case ResourceTypeMedication:
+		return 76
+	case ResourceTypeMedicationAdministration:
+		return 77
+	case ResourceTypeMedicationDispense:
+		return 78
+	case ResourceTypeMedicationKnowledge:
+		return 79
+	case ResourceTypeMedicationRequest:
+		return 80
+	case ResourceTypeMedicationStatement:
+		return 81
+	case ResourceTypeMedicinalProduct:
+		return 82
+	case ResourceTypeMedicinalProductAuthorization:
+		return 83
+	case ResourceTypeMedicinalProductContraindication:
+		return 84
+	case ResourceTypeMedicinalProductIndication:
+		return 85
+	case ResourceTypeMedicinalProductIngredient:
+		return 86
+	case ResourceTypeMedicinalProductInteraction:
+		return 87
+	case ResourceTypeMedicinalProductManufactured:
+		return 88
+	case ResourceTypeMedicinalProductPackaged:
+		return 89
+	case ResourceTypeMedicinalProductPharmaceutical:
+		return 90
+	case ResourceTypeMedicinalProductUndesirableEffect:
+		return 91
+	case ResourceTypeMessageDefinition:
+		return 92
+	case ResourceTypeMessageHeader:
+		return 93
+	case ResourceTypeMolecularSequence:
+		return 94
+	case ResourceTypeNamingSystem:
+		return 95
+	case ResourceTypeNutritionOrder:
+		return 96
+	case ResourceTypeObservation:
+		return 97
+	case ResourceTypeObservationDefinition:
+		return 98
+	case ResourceTypeOperationDefinition:
+		return 99
+	case ResourceTypeOperationOutcome:
+		return 100
+	case ResourceTypeOrganization:
+		return 101
+	case ResourceTypeOrganizationAffiliation:
+		return 102
+	case ResourceTypeParameters:
+		return 103
+	case ResourceTypePatient:
+		return 104
+	case ResourceTypePaymentNotice:
+		return 105
+	case ResourceTypePaymentReconciliation:
+		return 106
+	case ResourceTypePerson:
+		return 107
+	case ResourceTypePlanDefinition:
+		return 108
+	case ResourceTypePractitioner:
+		return 109
+	case ResourceTypePractitionerRole:
+		return 110
+	case ResourceTypeProcedure:
+		return 111
+	case ResourceTypeProvenance:
+		return 112
+	case ResourceTypeQuestionnaire:
+		return 113
+	case ResourceTypeQuestionnaireResponse:
+		return 114
+	case ResourceTypeRelatedPerson:
+		return 115
+	case ResourceTypeRequestGroup:
+		return 116
+	case ResourceTypeResearchDefinition:
+		return 117
+	case ResourceTypeResearchElementDefinition:
+		return 118
+	case ResourceTypeResearchStudy:
+		return 119
+	case ResourceTypeResearchSubject:
+		return 120
+	case ResourceTypeResource:
+		return 121
+	case ResourceTypeRiskAssessment:
+		return 122
+	case ResourceTypeRiskEvidenceSynthesis:
+		return 123
+	case ResourceTypeSchedule:
+		return 124
+	case ResourceTypeSearchParameter:
+		return 125
+	case ResourceTypeServiceRequest:
+		return 126
+	case ResourceTypeSlot:
+		return 127
+	case ResourceTypeSpecimen:
+		return 128
+	case ResourceTypeSpecimenDefinition:
+		return 129
+	case ResourceTypeStructureDefinition:
+		return 130
+	case ResourceTypeStructureMap:
+		return 131
+	case ResourceTypeSubscription:
+		return 132
+	case ResourceTypeSubstance:
+		return 133
+	case ResourceTypeSubstanceNucleicAcid:
+		return 134
+	case ResourceTypeSubstancePolymer:
+		return 135
+	case ResourceTypeSubstanceProtein:
+		return 136
+	case ResourceTypeSubstanceReferenceInformation:
+		return 137
+	case ResourceTypeSubstanceSourceMaterial:
+		return 138
+	case ResourceTypeSubstanceSpecification:
+		return 139
+	case ResourceTypeSupplyDelivery:
+		return 140
+	case ResourceTypeSupplyRequest:
+		return 141
+	case ResourceTypeTask:
+		return 142
+	case ResourceTypeTerminologyCapabilities:
+		return 143
+	case ResourceTypeTestReport:
+		return 144
+	case ResourceTypeTestScript:
+		return 145
+	case ResourceTypeValueSet:
+		return 146
+	case ResourceTypeVerificationResult:
+		return 147
+	case ResourceTypeVisionPrescription:
+		return 148
+	}
+	return 0
+}
+func (code *ResourceType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ResourceTypeAccount
+	case 2:
+		*code = ResourceTypeActivityDefinition
+	case 3:
+		*code = ResourceTypeAdverseEvent
+	case 4:
+		*code = ResourceTypeAllergyIntolerance
+	case 5:
+		*code = ResourceTypeAppointment
+	case 6:
+		*code = ResourceTypeAppointmentResponse
+	case 7:
+		*code = ResourceTypeAuditEvent
+	case 8:
+		*code = ResourceTypeBasic
+	case 9:
+		*code = ResourceTypeBinary
+	case 10:
+		*code = ResourceTypeBiologicallyDerivedProduct
+	case 11:
+		*code = ResourceTypeBodyStructure
+	case 12:
+		*code = ResourceTypeBundle
+	case 13:
+		*code = ResourceTypeCapabilityStatement
+	case 14:
+		*code = ResourceTypeCarePlan
+	case 15:
+		*code = ResourceTypeCareTeam
+	case 16:
+		*code = ResourceTypeCatalogEntry
+	case 17:
+		*code = ResourceTypeChargeItem
+	case 18:
+		*code = ResourceTypeChargeItemDefinition
+	case 19:
+		*code = ResourceTypeClaim
+	case 20:
+		*code = ResourceTypeClaimResponse
+	case 21:
+		*code = ResourceTypeClinicalImpression
+	case 22:
+		*code = ResourceTypeCodeSystem
+	case 23:
+		*code = ResourceTypeCommunication
+	case 24:
+		*code = ResourceTypeCommunicationRequest
+	case 25:
+		*code = ResourceTypeCompartmentDefinition
+	case 26:
+		*code = ResourceTypeComposition
+	case 27:
+		*code = ResourceTypeConceptMap
+	case 28:
+		*code = ResourceTypeCondition
+	case 29:
+		*code = ResourceTypeConsent
+	case 30:
+		*code = ResourceTypeContract
+	case 31:
+		*code = ResourceTypeCoverage
+	case 32:
+		*code = ResourceTypeCoverageEligibilityRequest
+	case 33:
+		*code = ResourceTypeCoverageEligibilityResponse
+	case 34:
+		*code = ResourceTypeDetectedIssue
+	case 35:
+		*code = ResourceTypeDevice
+	case 36:
+		*code = ResourceTypeDeviceDefinition
+	case 37:
+		*code = ResourceTypeDeviceMetric
+	case 38:
+		*code = ResourceTypeDeviceRequest
+	case 39:
+		*code = ResourceTypeDeviceUseStatement
+	case 40:
+		*code = ResourceTypeDiagnosticReport
+	case 41:
+		*code = ResourceTypeDocumentManifest
+	case 42:
+		*code = ResourceTypeDocumentReference
+	case 43:
+		*code = ResourceTypeDomainResource
+	case 44:
+		*code = ResourceTypeEffectEvidenceSynthesis
+	case 45:
+		*code = ResourceTypeEncounter
+	case 46:
+		*code = ResourceTypeEndpoint
+	case 47:
+		*code = ResourceTypeEnrollmentRequest
+	case 48:
+		*code = ResourceTypeEnrollmentResponse
+	case 49:
+		*code = ResourceTypeEpisodeOfCare
+	case 50:
+		*code = ResourceTypeEventDefinition
+	case 51:
+		*code = ResourceTypeEvidence
+	case 52:
+		*code = ResourceTypeEvidenceVariable
+	case 53:
+		*code = ResourceTypeExampleScenario
+	case 54:
+		*code = ResourceTypeExplanationOfBenefit
+	case 55:
+		*code = ResourceTypeFamilyMemberHistory
+	case 56:
+		*code = ResourceTypeFlag
+	case 57:
+		*code = ResourceTypeGoal
+	case 58:
+		*code = ResourceTypeGraphDefinition
+	case 59:
+		*code = ResourceTypeGroup
+	case 60:
+		*code = ResourceTypeGuidanceResponse
+	case 61:
+		*code = ResourceTypeHealthcareService
+	case 62:
+		*code = ResourceTypeImagingStudy
+	case 63:
+		*code = ResourceTypeImmunization
+	case 64:
+		*code = ResourceTypeImmunizationEvaluation
+	case 65:
+		*code = ResourceTypeImmunizationRecommendation
+	case 66:
+		*code = ResourceTypeImplementationGuide
+	case 67:
+		*code = ResourceTypeInsurancePlan
+	case 68:
+		*code = ResourceTypeInvoice
+	case 69:
+		*code = ResourceTypeLibrary
+	case 70:
+		*code = ResourceTypeLinkage
+	case 71:
+		*code = ResourceTypeList
+	case 72:
+		*code = ResourceTypeLocation
+	case 73:
+		*code = ResourceTypeMeasure
+	case 74:
+		*code = ResourceTypeMeasureReport
+	case 75:
+		*code = ResourceTypeMedia
+	case 76:
+		*code = ResourceTypeMedication
+	case 77:
+		*code = ResourceTypeMedicationAdministration
+	case 78:
+		*code = ResourceTypeMedicationDispense
+	case 79:
+		*code = ResourceTypeMedicationKnowledge
+	case 80:
+		*code = ResourceTypeMedicationRequest
+	case 81:
+		*code = ResourceTypeMedicationStatement
+	case 82:
+		*code = ResourceTypeMedicinalProduct
+	case 83:
+		*code = ResourceTypeMedicinalProductAuthorization
+	case 84:
+		*code = ResourceTypeMedicinalProductContraindication
+	case 85:
+		*code = ResourceTypeMedicinalProductIndication
+	case 86:
+		*code = ResourceTypeMedicinalProductIngredient
+	case 87:
+		*code = ResourceTypeMedicinalProductInteraction
+	case 88:
+		*code = ResourceTypeMedicinalProductManufactured
+	case 89:
+		*code = ResourceTypeMedicinalProductPackaged
+	case 90:
+		*code = ResourceTypeMedicinalProductPharmaceutical
+	case 91:
+		*code = ResourceTypeMedicinalProductUndesirableEffect
+	case 92:
+		*code = ResourceTypeMessageDefinition
+	case 93:
+		*code = ResourceTypeMessageHeader
+	case 94:
+		*code = ResourceTypeMolecularSequence
+	case 95:
+		*code = ResourceTypeNamingSystem
+	case 96:
+		*code = ResourceTypeNutritionOrder
+	case 97:
+		*code = ResourceTypeObservation
+	case 98:
+		*code = ResourceTypeObservationDefinition
+	case 99:
+		*code = ResourceTypeOperationDefinition
+	case 100:
+		*code = ResourceTypeOperationOutcome
+	case 101:
+		*code = ResourceTypeOrganization
+	case 102:
+		*code = ResourceTypeOrganizationAffiliation
+	case 103:
+		*code = ResourceTypeParameters
+	case 104:
+		*code = ResourceTypePatient
+	case 105:
+		*code = ResourceTypePaymentNotice
+	case 106:
+		*code = ResourceTypePaymentReconciliation
+	case 107:
+		*code = ResourceTypePerson
+	case 108:
+		*code = ResourceTypePlanDefinition
+	case 109:
+		*code = ResourceTypePractitioner
+	case 110:
+		*code = ResourceTypePractitionerRole
+	case 111:
+		*code = ResourceTypeProcedure
+	case 112:
+		*code = ResourceTypeProvenance
+	case 113:
+		*code = ResourceTypeQuestionnaire
+	case 114:
+		*code = ResourceTypeQuestionnaireResponse
+	case 115:
+		*code = ResourceTypeRelatedPerson
+	case 116:
+		*code = ResourceTypeRequestGroup
+	case 117:
+		*code = ResourceTypeResearchDefinition
+	case 118:
+		*code = ResourceTypeResearchElementDefinition
+	case 119:
+		*code = ResourceTypeResearchStudy
+	case 120:
+		*code = ResourceTypeResearchSubject
+	case 121:
+		*code = ResourceTypeResource
+	case 122:
+		*code = ResourceTypeRiskAssessment
+	case 123:
+		*code = ResourceTypeRiskEvidenceSynthesis
+	case 124:
+		*code = ResourceTypeSchedule
+	case 125:
+		*code = ResourceTypeSearchParameter
+	case 126:
+		*code = ResourceTypeServiceRequest
+	case 127:
+		*code = ResourceTypeSlot
+	case 128:
+		*code = ResourceTypeSpecimen
+	case 129:
+		*code = ResourceTypeSpecimenDefinition
+	case 130:
+		*code = ResourceTypeStructureDefinition
+	case 131:
+		*code = ResourceTypeStructureMap
+	case 132:
+		*code = ResourceTypeSubscription
+	case 133:
+		*code = ResourceTypeSubstance
+	case 134:
+		*code = ResourceTypeSubstanceNucleicAcid
+	case 135:
+		*code = ResourceTypeSubstancePolymer
+	case 136:
+		*code = ResourceTypeSubstanceProtein
+	case 137:
+		*code = ResourceTypeSubstanceReferenceInformation
+	case 138:
+		*code = ResourceTypeSubstanceSourceMaterial
+	case 139:
+		*code = ResourceTypeSubstanceSpecification
+	case 140:
+		*code = ResourceTypeSupplyDelivery
+	case 141:
+		*code = ResourceTypeSupplyRequest
+	case 142:
+		*code = ResourceTypeTask
+	case 143:
+		*code = ResourceTypeTerminologyCapabilities
+	case 144:
+		*code = ResourceTypeTestReport
+	case 145:
+		*code = ResourceTypeTestScript
+	case 146:
+		*code = ResourceTypeValueSet
+	case 147:
+		*code = ResourceTypeVerificationResult
+	case 148:
+		*code = ResourceTypeVisionPrescription
+	default:
+		return false
+	}
+	return true
+}
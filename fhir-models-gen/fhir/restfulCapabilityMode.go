@@ -0,0 +1,108 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// RestfulCapabilityMode is documented here http://hl7.org/fhir/ValueSet/restful-capability-mode
+type RestfulCapabilityMode int
+
+const (
+	RestfulCapabilityModeClient RestfulCapabilityMode = iota
+	RestfulCapabilityModeServer
+)
+
+func (code RestfulCapabilityMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(code.Code())
+}
+func (code *RestfulCapabilityMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
+	switch s {
+	case "client":
+		*code = RestfulCapabilityModeClient
+	case "server":
+		*code = RestfulCapabilityModeServer
+	default:
+		return fmt.Errorf("unknown RestfulCapabilityMode code `%s`", s)
+	}
+	return nil
+}
+func (code RestfulCapabilityMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *RestfulCapabilityMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
+func (code RestfulCapabilityMode) String() string {
+	return code.Code()
+}
+func (code RestfulCapabilityMode) Code() string {
+	switch code {
+	case RestfulCapabilityModeClient:
+		return "client"
+	case RestfulCapabilityModeServer:
+		return "server"
+	}
+	return "<unknown>"
+}
+func (code RestfulCapabilityMode) Display() string {
+	switch code {
+	case RestfulCapabilityModeClient:
+		return "Client"
+	case RestfulCapabilityModeServer:
+		return "Server"
+	}
+	return "<unknown>"
+}
+func (code RestfulCapabilityMode) Definition() string {
+	switch code {
+	case RestfulCapabilityModeClient:
+		return "The application acts as a client for this resource."
+	case RestfulCapabilityModeServer:
+		return "The application acts as a server for this resource."
+	}
+	return "<unknown>"
+}
+func (code RestfulCapabilityMode) protoNumber() uint64 {
+	switch code {
+	case RestfulCapabilityModeClient:
+		return 1
+	case RestfulCapabilityModeServer:
+		return 2
+	}
+	return 0
+}
+func (code *RestfulCapabilityMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = RestfulCapabilityModeClient
+	case 2:
+		*code = RestfulCapabilityModeServer
+	default:
+		return false
+	}
+	return true
+}
@@ -14,20 +14,658 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // SampledData is documented here http://hl7.org/fhir/StructureDefinition/SampledData
 type SampledData struct {
-	Id         *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension  []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	Origin     Quantity     `bson:"origin" json:"origin"`
-	Period     json.Number  `bson:"period" json:"period"`
-	Factor     *json.Number `bson:"factor,omitempty" json:"factor,omitempty"`
-	LowerLimit *json.Number `bson:"lowerLimit,omitempty" json:"lowerLimit,omitempty"`
-	UpperLimit *json.Number `bson:"upperLimit,omitempty" json:"upperLimit,omitempty"`
-	Dimensions int          `bson:"dimensions" json:"dimensions"`
-	Data       *string      `bson:"data,omitempty" json:"data,omitempty"`
+	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	Origin            Quantity     `bson:"origin" json:"origin"`
+	Period            json.Number  `bson:"period" json:"period"`
+	PeriodElement     *Element     `bson:"_period,omitempty" json:"_period,omitempty"`
+	Factor            *json.Number `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element     `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	LowerLimit        *json.Number `bson:"lowerLimit,omitempty" json:"lowerLimit,omitempty"`
+	LowerLimitElement *Element     `bson:"_lowerLimit,omitempty" json:"_lowerLimit,omitempty"`
+	UpperLimit        *json.Number `bson:"upperLimit,omitempty" json:"upperLimit,omitempty"`
+	UpperLimitElement *Element     `bson:"_upperLimit,omitempty" json:"_upperLimit,omitempty"`
+	Dimensions        int          `bson:"dimensions" json:"dimensions"`
+	DimensionsElement *Element     `bson:"_dimensions,omitempty" json:"_dimensions,omitempty"`
+	Data              *string      `bson:"data,omitempty" json:"data,omitempty"`
+	DataElement       *Element     `bson:"_data,omitempty" json:"_data,omitempty"`
+}
+
+// MarshalJSON marshals the given SampledData as JSON into a byte slice
+func (r SampledData) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SampledData as JSON object with the members in the order of the fields
+func (r SampledData) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("origin")
+	r.Origin.appendJSON(e)
+	e.key("period")
+	e.number(r.Period)
+	if r.PeriodElement != nil {
+		e.key("_period")
+		r.PeriodElement.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.LowerLimit != nil {
+		e.key("lowerLimit")
+		e.number(*r.LowerLimit)
+	}
+	if r.LowerLimitElement != nil {
+		e.key("_lowerLimit")
+		r.LowerLimitElement.appendJSON(e)
+	}
+	if r.UpperLimit != nil {
+		e.key("upperLimit")
+		e.number(*r.UpperLimit)
+	}
+	if r.UpperLimitElement != nil {
+		e.key("_upperLimit")
+		r.UpperLimitElement.appendJSON(e)
+	}
+	e.key("dimensions")
+	e.int(r.Dimensions)
+	if r.DimensionsElement != nil {
+		e.key("_dimensions")
+		r.DimensionsElement.appendJSON(e)
+	}
+	if r.Data != nil {
+		e.key("data")
+		e.string(*r.Data)
+	}
+	if r.DataElement != nil {
+		e.key("_data")
+		r.DataElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SampledData from JSON. Unknown members are ignored.
+func (r *SampledData) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SampledData
+func (r *SampledData) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "origin":
+			r.Origin.decodeJSON(d)
+		case "period":
+			if v, ok := d.number(); ok {
+				r.Period = v
+			}
+		case "_period":
+			r.PeriodElement = d.element()
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "lowerLimit":
+			if v, ok := d.number(); ok {
+				r.LowerLimit = &v
+			} else {
+				r.LowerLimit = nil
+			}
+		case "_lowerLimit":
+			r.LowerLimitElement = d.element()
+		case "upperLimit":
+			if v, ok := d.number(); ok {
+				r.UpperLimit = &v
+			} else {
+				r.UpperLimit = nil
+			}
+		case "_upperLimit":
+			r.UpperLimitElement = d.element()
+		case "dimensions":
+			if v, ok := d.int(); ok {
+				r.Dimensions = v
+			}
+		case "_dimensions":
+			r.DimensionsElement = d.element()
+		case "data":
+			if v, ok := d.string(); ok {
+				r.Data = &v
+			} else {
+				r.Data = nil
+			}
+		case "_data":
+			r.DataElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SampledData which shares no memory with the original
+func (r SampledData) DeepCopy() SampledData {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	out.Origin = r.Origin.DeepCopy()
+	if r.PeriodElement != nil {
+		v := r.PeriodElement.DeepCopy()
+		out.PeriodElement = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.LowerLimit != nil {
+		v := *r.LowerLimit
+		out.LowerLimit = &v
+	}
+	if r.LowerLimitElement != nil {
+		v := r.LowerLimitElement.DeepCopy()
+		out.LowerLimitElement = &v
+	}
+	if r.UpperLimit != nil {
+		v := *r.UpperLimit
+		out.UpperLimit = &v
+	}
+	if r.UpperLimitElement != nil {
+		v := r.UpperLimitElement.DeepCopy()
+		out.UpperLimitElement = &v
+	}
+	if r.DimensionsElement != nil {
+		v := r.DimensionsElement.DeepCopy()
+		out.DimensionsElement = &v
+	}
+	if r.Data != nil {
+		v := *r.Data
+		out.Data = &v
+	}
+	if r.DataElement != nil {
+		v := r.DataElement.DeepCopy()
+		out.DataElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SampledData) Equal(other SampledData) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if !r.Origin.Equal(other.Origin) {
+		return false
+	}
+	if !EqualDecimal(r.Period, other.Period) {
+		return false
+	}
+	if (r.PeriodElement == nil) != (other.PeriodElement == nil) || r.PeriodElement != nil && !r.PeriodElement.Equal(*other.PeriodElement) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.LowerLimit == nil) != (other.LowerLimit == nil) || r.LowerLimit != nil && !EqualDecimal(*r.LowerLimit, *other.LowerLimit) {
+		return false
+	}
+	if (r.LowerLimitElement == nil) != (other.LowerLimitElement == nil) || r.LowerLimitElement != nil && !r.LowerLimitElement.Equal(*other.LowerLimitElement) {
+		return false
+	}
+	if (r.UpperLimit == nil) != (other.UpperLimit == nil) || r.UpperLimit != nil && !EqualDecimal(*r.UpperLimit, *other.UpperLimit) {
+		return false
+	}
+	if (r.UpperLimitElement == nil) != (other.UpperLimitElement == nil) || r.UpperLimitElement != nil && !r.UpperLimitElement.Equal(*other.UpperLimitElement) {
+		return false
+	}
+	if r.Dimensions != other.Dimensions {
+		return false
+	}
+	if (r.DimensionsElement == nil) != (other.DimensionsElement == nil) || r.DimensionsElement != nil && !r.DimensionsElement.Equal(*other.DimensionsElement) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && *r.Data != *other.Data {
+		return false
+	}
+	if (r.DataElement == nil) != (other.DataElement == nil) || r.DataElement != nil && !r.DataElement.Equal(*other.DataElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SampledData) EqualsDeep(other SampledData) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !r.Origin.EqualsDeep(other.Origin) {
+		return false
+	}
+	if !equivalentDecimal(r.Period, other.Period) {
+		return false
+	}
+	if (r.PeriodElement == nil) != (other.PeriodElement == nil) || r.PeriodElement != nil && !r.PeriodElement.EqualsDeep(*other.PeriodElement) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.LowerLimit == nil) != (other.LowerLimit == nil) || r.LowerLimit != nil && !equivalentDecimal(*r.LowerLimit, *other.LowerLimit) {
+		return false
+	}
+	if (r.LowerLimitElement == nil) != (other.LowerLimitElement == nil) || r.LowerLimitElement != nil && !r.LowerLimitElement.EqualsDeep(*other.LowerLimitElement) {
+		return false
+	}
+	if (r.UpperLimit == nil) != (other.UpperLimit == nil) || r.UpperLimit != nil && !equivalentDecimal(*r.UpperLimit, *other.UpperLimit) {
+		return false
+	}
+	if (r.UpperLimitElement == nil) != (other.UpperLimitElement == nil) || r.UpperLimitElement != nil && !r.UpperLimitElement.EqualsDeep(*other.UpperLimitElement) {
+		return false
+	}
+	if r.Dimensions != other.Dimensions {
+		return false
+	}
+	if (r.DimensionsElement == nil) != (other.DimensionsElement == nil) || r.DimensionsElement != nil && !r.DimensionsElement.EqualsDeep(*other.DimensionsElement) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && !equivalentString(*r.Data, *other.Data) {
+		return false
+	}
+	if (r.DataElement == nil) != (other.DataElement == nil) || r.DataElement != nil && !r.DataElement.EqualsDeep(*other.DataElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SampledData) EqualsShallow(other SampledData) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentDecimal(r.Period, other.Period) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.LowerLimit == nil) != (other.LowerLimit == nil) || r.LowerLimit != nil && !equivalentDecimal(*r.LowerLimit, *other.LowerLimit) {
+		return false
+	}
+	if (r.UpperLimit == nil) != (other.UpperLimit == nil) || r.UpperLimit != nil && !equivalentDecimal(*r.UpperLimit, *other.UpperLimit) {
+		return false
+	}
+	if r.Dimensions != other.Dimensions {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && !equivalentString(*r.Data, *other.Data) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SampledData as FHIR XML
+func (r SampledData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "origin", r.Origin); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "period", r.Period, r.PeriodElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lowerLimit", r.LowerLimit, r.LowerLimitElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "upperLimit", r.UpperLimit, r.UpperLimitElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "dimensions", r.Dimensions, r.DimensionsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "data", r.Data, r.DataElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SampledData from FHIR XML
+func (r *SampledData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "origin":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Origin = v
+			case "period":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Period = v
+				}
+				r.PeriodElement = element
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "lowerLimit":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LowerLimit = &v
+				}
+				r.LowerLimitElement = element
+			case "upperLimit":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.UpperLimit = &v
+				}
+				r.UpperLimitElement = element
+			case "dimensions":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Dimensions = v
+				}
+				r.DimensionsElement = element
+			case "data":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Data = &v
+				}
+				r.DataElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SampledData as properties to the RDF node n
+func (r SampledData) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.element("SampledData.origin", -1, r.Origin)
+	n.primitive("SampledData.period", -1, r.Period, r.PeriodElement, "decimal")
+	n.primitive("SampledData.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.primitive("SampledData.lowerLimit", -1, r.LowerLimit, r.LowerLimitElement, "decimal")
+	n.primitive("SampledData.upperLimit", -1, r.UpperLimit, r.UpperLimitElement, "decimal")
+	n.primitive("SampledData.dimensions", -1, r.Dimensions, r.DimensionsElement, "positiveInt")
+	n.primitive("SampledData.data", -1, r.Data, r.DataElement, "string")
+}
+
+// MarshalBSON marshals the given SampledData as BSON document with the structure of its FHIR JSON
+func (r SampledData) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SampledData from a BSON document with the structure of its FHIR JSON
+func (r *SampledData) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SampledData as protobuf message SampledData of fhir.proto
+func (r SampledData) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SampledData to the encoded message
+func (r SampledData) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	e.message(3, r.Origin)
+	if r.Period != "" {
+		e.string(4, string(r.Period))
+	}
+	if r.Factor != nil {
+		e.string(5, string(*r.Factor))
+	}
+	if r.LowerLimit != nil {
+		e.string(6, string(*r.LowerLimit))
+	}
+	if r.UpperLimit != nil {
+		e.string(7, string(*r.UpperLimit))
+	}
+	if r.Dimensions != 0 {
+		e.varint(8, uint64(int64(r.Dimensions)))
+	}
+	if r.Data != nil {
+		e.string(9, *r.Data)
+	}
+	if r.PeriodElement != nil {
+		e.message(10, *r.PeriodElement)
+	}
+	if r.FactorElement != nil {
+		e.message(11, *r.FactorElement)
+	}
+	if r.LowerLimitElement != nil {
+		e.message(12, *r.LowerLimitElement)
+	}
+	if r.UpperLimitElement != nil {
+		e.message(13, *r.UpperLimitElement)
+	}
+	if r.DimensionsElement != nil {
+		e.message(14, *r.DimensionsElement)
+	}
+	if r.DataElement != nil {
+		e.message(15, *r.DataElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SampledData from protobuf message SampledData of fhir.proto
+func (r *SampledData) UnmarshalProto(b []byte) error {
+	*r = SampledData{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Quantity
+			d.message(&v)
+			r.Origin = v
+		case 4:
+			r.Period = json.Number(d.string())
+		case 5:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 6:
+			v := json.Number(d.string())
+			r.LowerLimit = &v
+		case 7:
+			v := json.Number(d.string())
+			r.UpperLimit = &v
+		case 8:
+			r.Dimensions = d.int()
+		case 9:
+			v := d.string()
+			r.Data = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.PeriodElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.LowerLimitElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.UpperLimitElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.DimensionsElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.DataElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
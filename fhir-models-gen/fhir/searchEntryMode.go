@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code SearchEntryMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SearchEntryMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SearchEntryMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "match":
 		*code = SearchEntryModeMatch
@@ -49,6 +53,12 @@ func (code *SearchEntryMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SearchEntryMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SearchEntryMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SearchEntryMode) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code SearchEntryMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SearchEntryMode) protoNumber() uint64 {
+	switch code {
+	case SearchEntryModeMatch:
+		return 1
+	case SearchEntryModeInclude:
+		return 2
+	case SearchEntryModeOutcome:
+		return 3
+	}
+	return 0
+}
+func (code *SearchEntryMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SearchEntryModeMatch
+	case 2:
+		*code = SearchEntryModeInclude
+	case 3:
+		*code = SearchEntryModeOutcome
+	default:
+		return false
+	}
+	return true
+}
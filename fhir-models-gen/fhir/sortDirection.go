@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code SortDirection) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SortDirection) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SortDirection) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "ascending":
 		*code = SortDirectionAscending
@@ -46,6 +50,12 @@ func (code *SortDirection) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SortDirection) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SortDirection) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SortDirection) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code SortDirection) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SortDirection) protoNumber() uint64 {
+	switch code {
+	case SortDirectionAscending:
+		return 1
+	case SortDirectionDescending:
+		return 2
+	}
+	return 0
+}
+func (code *SortDirection) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SortDirectionAscending
+	case 2:
+		*code = SortDirectionDescending
+	default:
+		return false
+	}
+	return true
+}
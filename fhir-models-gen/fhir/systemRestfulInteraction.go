@@ -0,0 +1,303 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// SystemRestfulInteraction is documented here http://hl7.org/fhir/ValueSet/system-restful-interaction
+type SystemRestfulInteraction int
+
+const (
+	SystemRestfulInteractionRead SystemRestfulInteraction = iota
+	SystemRestfulInteractionVread
+	SystemRestfulInteractionUpdate
+	SystemRestfulInteractionPatch
+	SystemRestfulInteractionDelete
+	SystemRestfulInteractionHistory
+	SystemRestfulInteractionHistoryInstance
+	SystemRestfulInteractionHistoryType
+	SystemRestfulInteractionHistorySystem
+	SystemRestfulInteractionCreate
+	SystemRestfulInteractionSearch
+	SystemRestfulInteractionSearchType
+	SystemRestfulInteractionSearchSystem
+	SystemRestfulInteractionCapabilities
+	SystemRestfulInteractionTransaction
+	SystemRestfulInteractionBatch
+	SystemRestfulInteractionOperation
+)
+
+func (code SystemRestfulInteraction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(code.Code())
+}
+func (code *SystemRestfulInteraction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
+	switch s {
+	case "read":
+		*code = SystemRestfulInteractionRead
+	case "vread":
+		*code = SystemRestfulInteractionVread
+	case "update":
+		*code = SystemRestfulInteractionUpdate
+	case "patch":
+		*code = SystemRestfulInteractionPatch
+	case "delete":
+		*code = SystemRestfulInteractionDelete
+	case "history":
+		*code = SystemRestfulInteractionHistory
+	case "history-instance":
+		*code = SystemRestfulInteractionHistoryInstance
+	case "history-type":
+		*code = SystemRestfulInteractionHistoryType
+	case "history-system":
+		*code = SystemRestfulInteractionHistorySystem
+	case "create":
+		*code = SystemRestfulInteractionCreate
+	case "search":
+		*code = SystemRestfulInteractionSearch
+	case "search-type":
+		*code = SystemRestfulInteractionSearchType
+	case "search-system":
+		*code = SystemRestfulInteractionSearchSystem
+	case "capabilities":
+		*code = SystemRestfulInteractionCapabilities
+	case "transaction":
+		*code = SystemRestfulInteractionTransaction
+	case "batch":
+		*code = SystemRestfulInteractionBatch
+	case "operation":
+		*code = SystemRestfulInteractionOperation
+	default:
+		return fmt.Errorf("unknown SystemRestfulInteraction code `%s`", s)
+	}
+	return nil
+}
+func (code SystemRestfulInteraction) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SystemRestfulInteraction) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
+func (code SystemRestfulInteraction) String() string {
+	return code.Code()
+}
+func (code SystemRestfulInteraction) Code() string {
+	switch code {
+	case SystemRestfulInteractionRead:
+		return "read"
+	case SystemRestfulInteractionVread:
+		return "vread"
+	case SystemRestfulInteractionUpdate:
+		return "update"
+	case SystemRestfulInteractionPatch:
+		return "patch"
+	case SystemRestfulInteractionDelete:
+		return "delete"
+	case SystemRestfulInteractionHistory:
+		return "history"
+	case SystemRestfulInteractionHistoryInstance:
+		return "history-instance"
+	case SystemRestfulInteractionHistoryType:
+		return "history-type"
+	case SystemRestfulInteractionHistorySystem:
+		return "history-system"
+	case SystemRestfulInteractionCreate:
+		return "create"
+	case SystemRestfulInteractionSearch:
+		return "search"
+	case SystemRestfulInteractionSearchType:
+		return "search-type"
+	case SystemRestfulInteractionSearchSystem:
+		return "search-system"
+	case SystemRestfulInteractionCapabilities:
+		return "capabilities"
+	case SystemRestfulInteractionTransaction:
+		return "transaction"
+	case SystemRestfulInteractionBatch:
+		return "batch"
+	case SystemRestfulInteractionOperation:
+		return "operation"
+	}
+	return "<unknown>"
+}
+func (code SystemRestfulInteraction) Display() string {
+	switch code {
+	case SystemRestfulInteractionRead:
+		return "read"
+	case SystemRestfulInteractionVread:
+		return "vread"
+	case SystemRestfulInteractionUpdate:
+		return "update"
+	case SystemRestfulInteractionPatch:
+		return "patch"
+	case SystemRestfulInteractionDelete:
+		return "delete"
+	case SystemRestfulInteractionHistory:
+		return "history"
+	case SystemRestfulInteractionHistoryInstance:
+		return "history-instance"
+	case SystemRestfulInteractionHistoryType:
+		return "history-type"
+	case SystemRestfulInteractionHistorySystem:
+		return "history-system"
+	case SystemRestfulInteractionCreate:
+		return "create"
+	case SystemRestfulInteractionSearch:
+		return "search"
+	case SystemRestfulInteractionSearchType:
+		return "search-type"
+	case SystemRestfulInteractionSearchSystem:
+		return "search-system"
+	case SystemRestfulInteractionCapabilities:
+		return "capabilities"
+	case SystemRestfulInteractionTransaction:
+		return "transaction"
+	case SystemRestfulInteractionBatch:
+		return "batch"
+	case SystemRestfulInteractionOperation:
+		return "operation"
+	}
+	return "<unknown>"
+}
+func (code SystemRestfulInteraction) Definition() string {
+	switch code {
+	case SystemRestfulInteractionRead:
+		return "Read the current state of the resource."
+	case SystemRestfulInteractionVread:
+		return "Read the state of a specific version of the resource."
+	case SystemRestfulInteractionUpdate:
+		return "Update an existing resource by its id (or create it if it is new)."
+	case SystemRestfulInteractionPatch:
+		return "Update an existing resource by posting a set of changes to it."
+	case SystemRestfulInteractionDelete:
+		return "Delete a resource."
+	case SystemRestfulInteractionHistory:
+		return "Retrieve the change history for a particular resource, type of resource, or the entire system."
+	case SystemRestfulInteractionHistoryInstance:
+		return "Retrieve the change history for a particular resource."
+	case SystemRestfulInteractionHistoryType:
+		return "Retrieve the change history for all resources of a particular type."
+	case SystemRestfulInteractionHistorySystem:
+		return "Retrieve the change history for all resources on a system."
+	case SystemRestfulInteractionCreate:
+		return "Create a new resource with a server assigned id."
+	case SystemRestfulInteractionSearch:
+		return "Search a resource type or all resources based on some filter criteria."
+	case SystemRestfulInteractionSearchType:
+		return "Search all resources of the specified type based on some filter criteria."
+	case SystemRestfulInteractionSearchSystem:
+		return "Search all resources based on some filter criteria."
+	case SystemRestfulInteractionCapabilities:
+		return "Get a Capability Statement for the system."
+	case SystemRestfulInteractionTransaction:
+		return "Update, create or delete a set of resources as a single transaction."
+	case SystemRestfulInteractionBatch:
+		return "perform a set of a separate interactions in a single http operation"
+	case SystemRestfulInteractionOperation:
+		return "Perform an operation as defined by an OperationDefinition."
+	}
+	return "<unknown>"
+}
+func (code SystemRestfulInteraction) protoNumber() uint64 {
+	switch code {
+	case SystemRestfulInteractionRead:
+		return 1
+	case SystemRestfulInteractionVread:
+		return 2
+	case SystemRestfulInteractionUpdate:
+		return 3
+	case SystemRestfulInteractionPatch:
+		return 4
+	case SystemRestfulInteractionDelete:
+		return 5
+	case SystemRestfulInteractionHistory:
+		return 6
+	case SystemRestfulInteractionHistoryInstance:
+		return 7
+	case SystemRestfulInteractionHistoryType:
+		return 8
+	case SystemRestfulInteractionHistorySystem:
+		return 9
+	case SystemRestfulInteractionCreate:
+		return 10
+	case SystemRestfulInteractionSearch:
+		return 11
+	case SystemRestfulInteractionSearchType:
+		return 12
+	case SystemRestfulInteractionSearchSystem:
+		return 13
+	case SystemRestfulInteractionCapabilities:
+		return 14
+	case SystemRestfulInteractionTransaction:
+		return 15
+	case SystemRestfulInteractionBatch:
+		return 16
+	case SystemRestfulInteractionOperation:
+		return 17
+	}
+	return 0
+}
+func (code *SystemRestfulInteraction) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SystemRestfulInteractionRead
+	case 2:
+		*code = SystemRestfulInteractionVread
+	case 3:
+		*code = SystemRestfulInteractionUpdate
+	case 4:
+		*code = SystemRestfulInteractionPatch
+	case 5:
+		*code = SystemRestfulInteractionDelete
+	case 6:
+		*code = SystemRestfulInteractionHistory
+	case 7:
+		*code = SystemRestfulInteractionHistoryInstance
+	case 8:
+		*code = SystemRestfulInteractionHistoryType
+	case 9:
+		*code = SystemRestfulInteractionHistorySystem
+	case 10:
+		*code = SystemRestfulInteractionCreate
+	case 11:
+		*code = SystemRestfulInteractionSearch
+	case 12:
+		*code = SystemRestfulInteractionSearchType
+	case 13:
+		*code = SystemRestfulInteractionSearchSystem
+	case 14:
+		*code = SystemRestfulInteractionCapabilities
+	case 15:
+		*code = SystemRestfulInteractionTransaction
+	case 16:
+		*code = SystemRestfulInteractionBatch
+	case 17:
+		*code = SystemRestfulInteractionOperation
+	default:
+		return false
+	}
+	return true
+}
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -25,27 +28,1902 @@ type Timing struct {
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Event             []string         `bson:"event,omitempty" json:"event,omitempty"`
+	EventElement      []*Element       `bson:"_event,omitempty" json:"_event,omitempty"`
 	Repeat            *TimingRepeat    `bson:"repeat,omitempty" json:"repeat,omitempty"`
 	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
 }
 type TimingRepeat struct {
-	Id             *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension      []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	BoundsDuration *Duration    `bson:"boundsDuration,omitempty" json:"boundsDuration,omitempty"`
-	BoundsRange    *Range       `bson:"boundsRange,omitempty" json:"boundsRange,omitempty"`
-	BoundsPeriod   *Period      `bson:"boundsPeriod,omitempty" json:"boundsPeriod,omitempty"`
-	Count          *int         `bson:"count,omitempty" json:"count,omitempty"`
-	CountMax       *int         `bson:"countMax,omitempty" json:"countMax,omitempty"`
-	Duration       *json.Number `bson:"duration,omitempty" json:"duration,omitempty"`
-	DurationMax    *json.Number `bson:"durationMax,omitempty" json:"durationMax,omitempty"`
-	DurationUnit   *string      `bson:"durationUnit,omitempty" json:"durationUnit,omitempty"`
-	Frequency      *int         `bson:"frequency,omitempty" json:"frequency,omitempty"`
-	FrequencyMax   *int         `bson:"frequencyMax,omitempty" json:"frequencyMax,omitempty"`
-	Period         *json.Number `bson:"period,omitempty" json:"period,omitempty"`
-	PeriodMax      *json.Number `bson:"periodMax,omitempty" json:"periodMax,omitempty"`
-	PeriodUnit     *string      `bson:"periodUnit,omitempty" json:"periodUnit,omitempty"`
-	DayOfWeek      []DaysOfWeek `bson:"dayOfWeek,omitempty" json:"dayOfWeek,omitempty"`
-	TimeOfDay      []string     `bson:"timeOfDay,omitempty" json:"timeOfDay,omitempty"`
-	When           []string     `bson:"when,omitempty" json:"when,omitempty"`
-	Offset         *int         `bson:"offset,omitempty" json:"offset,omitempty"`
+	Id                  *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	BoundsDuration      *Duration    `bson:"boundsDuration,omitempty" json:"boundsDuration,omitempty"`
+	BoundsRange         *Range       `bson:"boundsRange,omitempty" json:"boundsRange,omitempty"`
+	BoundsPeriod        *Period      `bson:"boundsPeriod,omitempty" json:"boundsPeriod,omitempty"`
+	Count               *int         `bson:"count,omitempty" json:"count,omitempty"`
+	CountElement        *Element     `bson:"_count,omitempty" json:"_count,omitempty"`
+	CountMax            *int         `bson:"countMax,omitempty" json:"countMax,omitempty"`
+	CountMaxElement     *Element     `bson:"_countMax,omitempty" json:"_countMax,omitempty"`
+	Duration            *json.Number `bson:"duration,omitempty" json:"duration,omitempty"`
+	DurationElement     *Element     `bson:"_duration,omitempty" json:"_duration,omitempty"`
+	DurationMax         *json.Number `bson:"durationMax,omitempty" json:"durationMax,omitempty"`
+	DurationMaxElement  *Element     `bson:"_durationMax,omitempty" json:"_durationMax,omitempty"`
+	DurationUnit        *string      `bson:"durationUnit,omitempty" json:"durationUnit,omitempty"`
+	DurationUnitElement *Element     `bson:"_durationUnit,omitempty" json:"_durationUnit,omitempty"`
+	Frequency           *int         `bson:"frequency,omitempty" json:"frequency,omitempty"`
+	FrequencyElement    *Element     `bson:"_frequency,omitempty" json:"_frequency,omitempty"`
+	FrequencyMax        *int         `bson:"frequencyMax,omitempty" json:"frequencyMax,omitempty"`
+	FrequencyMaxElement *Element     `bson:"_frequencyMax,omitempty" json:"_frequencyMax,omitempty"`
+	Period              *json.Number `bson:"period,omitempty" json:"period,omitempty"`
+	PeriodElement       *Element     `bson:"_period,omitempty" json:"_period,omitempty"`
+	PeriodMax           *json.Number `bson:"periodMax,omitempty" json:"periodMax,omitempty"`
+	PeriodMaxElement    *Element     `bson:"_periodMax,omitempty" json:"_periodMax,omitempty"`
+	PeriodUnit          *string      `bson:"periodUnit,omitempty" json:"periodUnit,omitempty"`
+	PeriodUnitElement   *Element     `bson:"_periodUnit,omitempty" json:"_periodUnit,omitempty"`
+	DayOfWeek           []DaysOfWeek `bson:"dayOfWeek,omitempty" json:"dayOfWeek,omitempty"`
+	DayOfWeekElement    []*Element   `bson:"_dayOfWeek,omitempty" json:"_dayOfWeek,omitempty"`
+	TimeOfDay           []string     `bson:"timeOfDay,omitempty" json:"timeOfDay,omitempty"`
+	TimeOfDayElement    []*Element   `bson:"_timeOfDay,omitempty" json:"_timeOfDay,omitempty"`
+	When                []string     `bson:"when,omitempty" json:"when,omitempty"`
+	WhenElement         []*Element   `bson:"_when,omitempty" json:"_when,omitempty"`
+	Offset              *int         `bson:"offset,omitempty" json:"offset,omitempty"`
+	OffsetElement       *Element     `bson:"_offset,omitempty" json:"_offset,omitempty"`
+}
+
+// TimingRepeatBounds is implemented by the types allowed for Timing.repeat.bounds[x]
+type TimingRepeatBounds interface {
+	isTimingRepeatBounds()
+}
+
+func (Duration) isTimingRepeatBounds() {}
+func (Range) isTimingRepeatBounds()    {}
+func (Period) isTimingRepeatBounds()   {}
+
+// Bounds returns the value of Timing.repeat.bounds[x] together with its FHIR type code or nil if no value is set
+func (r TimingRepeat) Bounds() (TimingRepeatBounds, string) {
+	if r.BoundsDuration != nil {
+		return *r.BoundsDuration, "Duration"
+	}
+	if r.BoundsRange != nil {
+		return *r.BoundsRange, "Range"
+	}
+	if r.BoundsPeriod != nil {
+		return *r.BoundsPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetBounds sets the value of Timing.repeat.bounds[x], given as value or pointer, and clears all other types
+func (r *TimingRepeat) SetBounds(value TimingRepeatBounds) {
+	r.BoundsDuration = nil
+	r.BoundsRange = nil
+	r.BoundsPeriod = nil
+	switch v := value.(type) {
+	case Duration:
+		r.BoundsDuration = &v
+	case *Duration:
+		r.BoundsDuration = v
+	case Range:
+		r.BoundsRange = &v
+	case *Range:
+		r.BoundsRange = v
+	case Period:
+		r.BoundsPeriod = &v
+	case *Period:
+		r.BoundsPeriod = v
+	}
+}
+
+// MarshalJSON marshals the given Timing as JSON into a byte slice
+func (r Timing) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Timing as JSON object with the members in the order of the fields
+func (r Timing) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Event) > 0 {
+		e.key("event")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Event {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.EventElement) > 0 {
+		e.key("_event")
+		e.elements(r.EventElement, len(r.Event))
+	}
+	if r.Repeat != nil {
+		e.key("repeat")
+		r.Repeat.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Timing from JSON. Unknown members are ignored.
+func (r *Timing) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Timing
+func (r *Timing) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "event":
+			a := d.array()
+			r.Event = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Event = append(r.Event, v)
+			}
+			if r.Event == nil && !a.isNull {
+				r.Event = []string{}
+			}
+		case "_event":
+			a := d.array()
+			r.EventElement = nil
+			for a.next() {
+				r.EventElement = append(r.EventElement, d.element())
+			}
+		case "repeat":
+			if d.null() {
+				r.Repeat = nil
+			} else {
+				var v TimingRepeat
+				v.decodeJSON(d)
+				r.Repeat = &v
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Timing which shares no memory with the original
+func (r Timing) DeepCopy() Timing {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Event != nil {
+		out.Event = make([]string, len(r.Event))
+		copy(out.Event, r.Event)
+	}
+	if r.EventElement != nil {
+		out.EventElement = make([]*Element, len(r.EventElement))
+		for i, v := range r.EventElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.EventElement[i] = &c
+			}
+		}
+	}
+	if r.Repeat != nil {
+		v := r.Repeat.DeepCopy()
+		out.Repeat = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Timing) Equal(other Timing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Event) != len(other.Event) {
+		return false
+	}
+	for i := range r.Event {
+		if r.Event[i] != other.Event[i] {
+			return false
+		}
+	}
+	if !equalElements(r.EventElement, other.EventElement, false) {
+		return false
+	}
+	if (r.Repeat == nil) != (other.Repeat == nil) || r.Repeat != nil && !r.Repeat.Equal(*other.Repeat) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Timing) EqualsDeep(other Timing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Event), len(other.Event), func(i, j int) bool {
+		return equivalentString(r.Event[i], other.Event[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.EventElement, other.EventElement, true) {
+		return false
+	}
+	if (r.Repeat == nil) != (other.Repeat == nil) || r.Repeat != nil && !r.Repeat.EqualsDeep(*other.Repeat) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Timing) EqualsShallow(other Timing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Event), len(other.Event), func(i, j int) bool {
+		return equivalentString(r.Event[i], other.Event[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Timing as FHIR XML
+func (r Timing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Event {
+		if err := encodeXMLPrimitive(e, "event", v, elementAt(r.EventElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "repeat", r.Repeat); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Timing from FHIR XML
+func (r *Timing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "event":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.EventElement = appendElement(r.EventElement, len(r.Event), element)
+					r.Event = append(r.Event, v)
+				}
+			case "repeat":
+				var v TimingRepeat
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Repeat = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Timing as properties to the RDF node n
+func (r Timing) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Event {
+		n.primitive("Timing.event", i, v, elementAt(r.EventElement, i), "dateTime")
+	}
+	n.element("Timing.repeat", -1, r.Repeat)
+	n.element("Timing.code", -1, r.Code)
+}
+
+// MarshalBSON marshals the given Timing as BSON document with the structure of its FHIR JSON
+func (r Timing) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Timing from a BSON document with the structure of its FHIR JSON
+func (r *Timing) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Timing as protobuf message Timing of fhir.proto
+func (r Timing) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Timing to the encoded message
+func (r Timing) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Event {
+		e.string(4, v)
+	}
+	if r.Repeat != nil {
+		e.message(5, *r.Repeat)
+	}
+	if r.Code != nil {
+		e.message(6, *r.Code)
+	}
+	for _, v := range r.EventElement {
+		e.element(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given Timing from protobuf message Timing of fhir.proto
+func (r *Timing) UnmarshalProto(b []byte) error {
+	*r = Timing{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Event = append(r.Event, d.string())
+		case 5:
+			var v TimingRepeat
+			d.message(&v)
+			r.Repeat = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 7:
+			r.EventElement = append(r.EventElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TimingRepeat as JSON into a byte slice
+func (r TimingRepeat) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TimingRepeat as JSON object with the members in the order of the fields
+func (r TimingRepeat) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BoundsDuration != nil {
+		e.key("boundsDuration")
+		r.BoundsDuration.appendJSON(e)
+	}
+	if r.BoundsRange != nil {
+		e.key("boundsRange")
+		r.BoundsRange.appendJSON(e)
+	}
+	if r.BoundsPeriod != nil {
+		e.key("boundsPeriod")
+		r.BoundsPeriod.appendJSON(e)
+	}
+	if r.Count != nil {
+		e.key("count")
+		e.int(*r.Count)
+	}
+	if r.CountElement != nil {
+		e.key("_count")
+		r.CountElement.appendJSON(e)
+	}
+	if r.CountMax != nil {
+		e.key("countMax")
+		e.int(*r.CountMax)
+	}
+	if r.CountMaxElement != nil {
+		e.key("_countMax")
+		r.CountMaxElement.appendJSON(e)
+	}
+	if r.Duration != nil {
+		e.key("duration")
+		e.number(*r.Duration)
+	}
+	if r.DurationElement != nil {
+		e.key("_duration")
+		r.DurationElement.appendJSON(e)
+	}
+	if r.DurationMax != nil {
+		e.key("durationMax")
+		e.number(*r.DurationMax)
+	}
+	if r.DurationMaxElement != nil {
+		e.key("_durationMax")
+		r.DurationMaxElement.appendJSON(e)
+	}
+	if r.DurationUnit != nil {
+		e.key("durationUnit")
+		e.string(*r.DurationUnit)
+	}
+	if r.DurationUnitElement != nil {
+		e.key("_durationUnit")
+		r.DurationUnitElement.appendJSON(e)
+	}
+	if r.Frequency != nil {
+		e.key("frequency")
+		e.int(*r.Frequency)
+	}
+	if r.FrequencyElement != nil {
+		e.key("_frequency")
+		r.FrequencyElement.appendJSON(e)
+	}
+	if r.FrequencyMax != nil {
+		e.key("frequencyMax")
+		e.int(*r.FrequencyMax)
+	}
+	if r.FrequencyMaxElement != nil {
+		e.key("_frequencyMax")
+		r.FrequencyMaxElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		e.number(*r.Period)
+	}
+	if r.PeriodElement != nil {
+		e.key("_period")
+		r.PeriodElement.appendJSON(e)
+	}
+	if r.PeriodMax != nil {
+		e.key("periodMax")
+		e.number(*r.PeriodMax)
+	}
+	if r.PeriodMaxElement != nil {
+		e.key("_periodMax")
+		r.PeriodMaxElement.appendJSON(e)
+	}
+	if r.PeriodUnit != nil {
+		e.key("periodUnit")
+		e.string(*r.PeriodUnit)
+	}
+	if r.PeriodUnitElement != nil {
+		e.key("_periodUnit")
+		r.PeriodUnitElement.appendJSON(e)
+	}
+	if len(r.DayOfWeek) > 0 {
+		e.key("dayOfWeek")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DayOfWeek {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DayOfWeekElement) > 0 {
+		e.key("_dayOfWeek")
+		e.elements(r.DayOfWeekElement, len(r.DayOfWeek))
+	}
+	if len(r.TimeOfDay) > 0 {
+		e.key("timeOfDay")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.TimeOfDay {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.TimeOfDayElement) > 0 {
+		e.key("_timeOfDay")
+		e.elements(r.TimeOfDayElement, len(r.TimeOfDay))
+	}
+	if len(r.When) > 0 {
+		e.key("when")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.When {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.WhenElement) > 0 {
+		e.key("_when")
+		e.elements(r.WhenElement, len(r.When))
+	}
+	if r.Offset != nil {
+		e.key("offset")
+		e.int(*r.Offset)
+	}
+	if r.OffsetElement != nil {
+		e.key("_offset")
+		r.OffsetElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TimingRepeat from JSON. Unknown members are ignored.
+func (r *TimingRepeat) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TimingRepeat
+func (r *TimingRepeat) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "boundsDuration":
+			if d.null() {
+				r.BoundsDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.BoundsDuration = &v
+			}
+		case "boundsRange":
+			if d.null() {
+				r.BoundsRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.BoundsRange = &v
+			}
+		case "boundsPeriod":
+			if d.null() {
+				r.BoundsPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.BoundsPeriod = &v
+			}
+		case "count":
+			if v, ok := d.int(); ok {
+				r.Count = &v
+			} else {
+				r.Count = nil
+			}
+		case "_count":
+			r.CountElement = d.element()
+		case "countMax":
+			if v, ok := d.int(); ok {
+				r.CountMax = &v
+			} else {
+				r.CountMax = nil
+			}
+		case "_countMax":
+			r.CountMaxElement = d.element()
+		case "duration":
+			if v, ok := d.number(); ok {
+				r.Duration = &v
+			} else {
+				r.Duration = nil
+			}
+		case "_duration":
+			r.DurationElement = d.element()
+		case "durationMax":
+			if v, ok := d.number(); ok {
+				r.DurationMax = &v
+			} else {
+				r.DurationMax = nil
+			}
+		case "_durationMax":
+			r.DurationMaxElement = d.element()
+		case "durationUnit":
+			if v, ok := d.string(); ok {
+				r.DurationUnit = &v
+			} else {
+				r.DurationUnit = nil
+			}
+		case "_durationUnit":
+			r.DurationUnitElement = d.element()
+		case "frequency":
+			if v, ok := d.int(); ok {
+				r.Frequency = &v
+			} else {
+				r.Frequency = nil
+			}
+		case "_frequency":
+			r.FrequencyElement = d.element()
+		case "frequencyMax":
+			if v, ok := d.int(); ok {
+				r.FrequencyMax = &v
+			} else {
+				r.FrequencyMax = nil
+			}
+		case "_frequencyMax":
+			r.FrequencyMaxElement = d.element()
+		case "period":
+			if v, ok := d.number(); ok {
+				r.Period = &v
+			} else {
+				r.Period = nil
+			}
+		case "_period":
+			r.PeriodElement = d.element()
+		case "periodMax":
+			if v, ok := d.number(); ok {
+				r.PeriodMax = &v
+			} else {
+				r.PeriodMax = nil
+			}
+		case "_periodMax":
+			r.PeriodMaxElement = d.element()
+		case "periodUnit":
+			if v, ok := d.string(); ok {
+				r.PeriodUnit = &v
+			} else {
+				r.PeriodUnit = nil
+			}
+		case "_periodUnit":
+			r.PeriodUnitElement = d.element()
+		case "dayOfWeek":
+			a := d.array()
+			r.DayOfWeek = nil
+			for a.next() {
+				var v DaysOfWeek
+				d.unmarshal(&v)
+				r.DayOfWeek = append(r.DayOfWeek, v)
+			}
+			if r.DayOfWeek == nil && !a.isNull {
+				r.DayOfWeek = []DaysOfWeek{}
+			}
+		case "_dayOfWeek":
+			a := d.array()
+			r.DayOfWeekElement = nil
+			for a.next() {
+				r.DayOfWeekElement = append(r.DayOfWeekElement, d.element())
+			}
+		case "timeOfDay":
+			a := d.array()
+			r.TimeOfDay = nil
+			for a.next() {
+				v, _ := d.string()
+				r.TimeOfDay = append(r.TimeOfDay, v)
+			}
+			if r.TimeOfDay == nil && !a.isNull {
+				r.TimeOfDay = []string{}
+			}
+		case "_timeOfDay":
+			a := d.array()
+			r.TimeOfDayElement = nil
+			for a.next() {
+				r.TimeOfDayElement = append(r.TimeOfDayElement, d.element())
+			}
+		case "when":
+			a := d.array()
+			r.When = nil
+			for a.next() {
+				v, _ := d.string()
+				r.When = append(r.When, v)
+			}
+			if r.When == nil && !a.isNull {
+				r.When = []string{}
+			}
+		case "_when":
+			a := d.array()
+			r.WhenElement = nil
+			for a.next() {
+				r.WhenElement = append(r.WhenElement, d.element())
+			}
+		case "offset":
+			if v, ok := d.int(); ok {
+				r.Offset = &v
+			} else {
+				r.Offset = nil
+			}
+		case "_offset":
+			r.OffsetElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TimingRepeat which shares no memory with the original
+func (r TimingRepeat) DeepCopy() TimingRepeat {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.BoundsDuration != nil {
+		v := r.BoundsDuration.DeepCopy()
+		out.BoundsDuration = &v
+	}
+	if r.BoundsRange != nil {
+		v := r.BoundsRange.DeepCopy()
+		out.BoundsRange = &v
+	}
+	if r.BoundsPeriod != nil {
+		v := r.BoundsPeriod.DeepCopy()
+		out.BoundsPeriod = &v
+	}
+	if r.Count != nil {
+		v := *r.Count
+		out.Count = &v
+	}
+	if r.CountElement != nil {
+		v := r.CountElement.DeepCopy()
+		out.CountElement = &v
+	}
+	if r.CountMax != nil {
+		v := *r.CountMax
+		out.CountMax = &v
+	}
+	if r.CountMaxElement != nil {
+		v := r.CountMaxElement.DeepCopy()
+		out.CountMaxElement = &v
+	}
+	if r.Duration != nil {
+		v := *r.Duration
+		out.Duration = &v
+	}
+	if r.DurationElement != nil {
+		v := r.DurationElement.DeepCopy()
+		out.DurationElement = &v
+	}
+	if r.DurationMax != nil {
+		v := *r.DurationMax
+		out.DurationMax = &v
+	}
+	if r.DurationMaxElement != nil {
+		v := r.DurationMaxElement.DeepCopy()
+		out.DurationMaxElement = &v
+	}
+	if r.DurationUnit != nil {
+		v := *r.DurationUnit
+		out.DurationUnit = &v
+	}
+	if r.DurationUnitElement != nil {
+		v := r.DurationUnitElement.DeepCopy()
+		out.DurationUnitElement = &v
+	}
+	if r.Frequency != nil {
+		v := *r.Frequency
+		out.Frequency = &v
+	}
+	if r.FrequencyElement != nil {
+		v := r.FrequencyElement.DeepCopy()
+		out.FrequencyElement = &v
+	}
+	if r.FrequencyMax != nil {
+		v := *r.FrequencyMax
+		out.FrequencyMax = &v
+	}
+	if r.FrequencyMaxElement != nil {
+		v := r.FrequencyMaxElement.DeepCopy()
+		out.FrequencyMaxElement = &v
+	}
+	if r.Period != nil {
+		v := *r.Period
+		out.Period = &v
+	}
+	if r.PeriodElement != nil {
+		v := r.PeriodElement.DeepCopy()
+		out.PeriodElement = &v
+	}
+	if r.PeriodMax != nil {
+		v := *r.PeriodMax
+		out.PeriodMax = &v
+	}
+	if r.PeriodMaxElement != nil {
+		v := r.PeriodMaxElement.DeepCopy()
+		out.PeriodMaxElement = &v
+	}
+	if r.PeriodUnit != nil {
+		v := *r.PeriodUnit
+		out.PeriodUnit = &v
+	}
+	if r.PeriodUnitElement != nil {
+		v := r.PeriodUnitElement.DeepCopy()
+		out.PeriodUnitElement = &v
+	}
+	if r.DayOfWeek != nil {
+		out.DayOfWeek = make([]DaysOfWeek, len(r.DayOfWeek))
+		copy(out.DayOfWeek, r.DayOfWeek)
+	}
+	if r.DayOfWeekElement != nil {
+		out.DayOfWeekElement = make([]*Element, len(r.DayOfWeekElement))
+		for i, v := range r.DayOfWeekElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DayOfWeekElement[i] = &c
+			}
+		}
+	}
+	if r.TimeOfDay != nil {
+		out.TimeOfDay = make([]string, len(r.TimeOfDay))
+		copy(out.TimeOfDay, r.TimeOfDay)
+	}
+	if r.TimeOfDayElement != nil {
+		out.TimeOfDayElement = make([]*Element, len(r.TimeOfDayElement))
+		for i, v := range r.TimeOfDayElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.TimeOfDayElement[i] = &c
+			}
+		}
+	}
+	if r.When != nil {
+		out.When = make([]string, len(r.When))
+		copy(out.When, r.When)
+	}
+	if r.WhenElement != nil {
+		out.WhenElement = make([]*Element, len(r.WhenElement))
+		for i, v := range r.WhenElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.WhenElement[i] = &c
+			}
+		}
+	}
+	if r.Offset != nil {
+		v := *r.Offset
+		out.Offset = &v
+	}
+	if r.OffsetElement != nil {
+		v := r.OffsetElement.DeepCopy()
+		out.OffsetElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TimingRepeat) Equal(other TimingRepeat) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.BoundsDuration == nil) != (other.BoundsDuration == nil) || r.BoundsDuration != nil && !r.BoundsDuration.Equal(*other.BoundsDuration) {
+		return false
+	}
+	if (r.BoundsRange == nil) != (other.BoundsRange == nil) || r.BoundsRange != nil && !r.BoundsRange.Equal(*other.BoundsRange) {
+		return false
+	}
+	if (r.BoundsPeriod == nil) != (other.BoundsPeriod == nil) || r.BoundsPeriod != nil && !r.BoundsPeriod.Equal(*other.BoundsPeriod) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	if (r.CountElement == nil) != (other.CountElement == nil) || r.CountElement != nil && !r.CountElement.Equal(*other.CountElement) {
+		return false
+	}
+	if (r.CountMax == nil) != (other.CountMax == nil) || r.CountMax != nil && *r.CountMax != *other.CountMax {
+		return false
+	}
+	if (r.CountMaxElement == nil) != (other.CountMaxElement == nil) || r.CountMaxElement != nil && !r.CountMaxElement.Equal(*other.CountMaxElement) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !EqualDecimal(*r.Duration, *other.Duration) {
+		return false
+	}
+	if (r.DurationElement == nil) != (other.DurationElement == nil) || r.DurationElement != nil && !r.DurationElement.Equal(*other.DurationElement) {
+		return false
+	}
+	if (r.DurationMax == nil) != (other.DurationMax == nil) || r.DurationMax != nil && !EqualDecimal(*r.DurationMax, *other.DurationMax) {
+		return false
+	}
+	if (r.DurationMaxElement == nil) != (other.DurationMaxElement == nil) || r.DurationMaxElement != nil && !r.DurationMaxElement.Equal(*other.DurationMaxElement) {
+		return false
+	}
+	if (r.DurationUnit == nil) != (other.DurationUnit == nil) || r.DurationUnit != nil && *r.DurationUnit != *other.DurationUnit {
+		return false
+	}
+	if (r.DurationUnitElement == nil) != (other.DurationUnitElement == nil) || r.DurationUnitElement != nil && !r.DurationUnitElement.Equal(*other.DurationUnitElement) {
+		return false
+	}
+	if (r.Frequency == nil) != (other.Frequency == nil) || r.Frequency != nil && *r.Frequency != *other.Frequency {
+		return false
+	}
+	if (r.FrequencyElement == nil) != (other.FrequencyElement == nil) || r.FrequencyElement != nil && !r.FrequencyElement.Equal(*other.FrequencyElement) {
+		return false
+	}
+	if (r.FrequencyMax == nil) != (other.FrequencyMax == nil) || r.FrequencyMax != nil && *r.FrequencyMax != *other.FrequencyMax {
+		return false
+	}
+	if (r.FrequencyMaxElement == nil) != (other.FrequencyMaxElement == nil) || r.FrequencyMaxElement != nil && !r.FrequencyMaxElement.Equal(*other.FrequencyMaxElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !EqualDecimal(*r.Period, *other.Period) {
+		return false
+	}
+	if (r.PeriodElement == nil) != (other.PeriodElement == nil) || r.PeriodElement != nil && !r.PeriodElement.Equal(*other.PeriodElement) {
+		return false
+	}
+	if (r.PeriodMax == nil) != (other.PeriodMax == nil) || r.PeriodMax != nil && !EqualDecimal(*r.PeriodMax, *other.PeriodMax) {
+		return false
+	}
+	if (r.PeriodMaxElement == nil) != (other.PeriodMaxElement == nil) || r.PeriodMaxElement != nil && !r.PeriodMaxElement.Equal(*other.PeriodMaxElement) {
+		return false
+	}
+	if (r.PeriodUnit == nil) != (other.PeriodUnit == nil) || r.PeriodUnit != nil && *r.PeriodUnit != *other.PeriodUnit {
+		return false
+	}
+	if (r.PeriodUnitElement == nil) != (other.PeriodUnitElement == nil) || r.PeriodUnitElement != nil && !r.PeriodUnitElement.Equal(*other.PeriodUnitElement) {
+		return false
+	}
+	if len(r.DayOfWeek) != len(other.DayOfWeek) {
+		return false
+	}
+	for i := range r.DayOfWeek {
+		if r.DayOfWeek[i] != other.DayOfWeek[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DayOfWeekElement, other.DayOfWeekElement, false) {
+		return false
+	}
+	if len(r.TimeOfDay) != len(other.TimeOfDay) {
+		return false
+	}
+	for i := range r.TimeOfDay {
+		if r.TimeOfDay[i] != other.TimeOfDay[i] {
+			return false
+		}
+	}
+	if !equalElements(r.TimeOfDayElement, other.TimeOfDayElement, false) {
+		return false
+	}
+	if len(r.When) != len(other.When) {
+		return false
+	}
+	for i := range r.When {
+		if r.When[i] != other.When[i] {
+			return false
+		}
+	}
+	if !equalElements(r.WhenElement, other.WhenElement, false) {
+		return false
+	}
+	if (r.Offset == nil) != (other.Offset == nil) || r.Offset != nil && *r.Offset != *other.Offset {
+		return false
+	}
+	if (r.OffsetElement == nil) != (other.OffsetElement == nil) || r.OffsetElement != nil && !r.OffsetElement.Equal(*other.OffsetElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TimingRepeat) EqualsDeep(other TimingRepeat) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.BoundsDuration == nil) != (other.BoundsDuration == nil) || r.BoundsDuration != nil && !r.BoundsDuration.EqualsDeep(*other.BoundsDuration) {
+		return false
+	}
+	if (r.BoundsRange == nil) != (other.BoundsRange == nil) || r.BoundsRange != nil && !r.BoundsRange.EqualsDeep(*other.BoundsRange) {
+		return false
+	}
+	if (r.BoundsPeriod == nil) != (other.BoundsPeriod == nil) || r.BoundsPeriod != nil && !r.BoundsPeriod.EqualsDeep(*other.BoundsPeriod) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	if (r.CountElement == nil) != (other.CountElement == nil) || r.CountElement != nil && !r.CountElement.EqualsDeep(*other.CountElement) {
+		return false
+	}
+	if (r.CountMax == nil) != (other.CountMax == nil) || r.CountMax != nil && *r.CountMax != *other.CountMax {
+		return false
+	}
+	if (r.CountMaxElement == nil) != (other.CountMaxElement == nil) || r.CountMaxElement != nil && !r.CountMaxElement.EqualsDeep(*other.CountMaxElement) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !equivalentDecimal(*r.Duration, *other.Duration) {
+		return false
+	}
+	if (r.DurationElement == nil) != (other.DurationElement == nil) || r.DurationElement != nil && !r.DurationElement.EqualsDeep(*other.DurationElement) {
+		return false
+	}
+	if (r.DurationMax == nil) != (other.DurationMax == nil) || r.DurationMax != nil && !equivalentDecimal(*r.DurationMax, *other.DurationMax) {
+		return false
+	}
+	if (r.DurationMaxElement == nil) != (other.DurationMaxElement == nil) || r.DurationMaxElement != nil && !r.DurationMaxElement.EqualsDeep(*other.DurationMaxElement) {
+		return false
+	}
+	if (r.DurationUnit == nil) != (other.DurationUnit == nil) || r.DurationUnit != nil && !equivalentString(*r.DurationUnit, *other.DurationUnit) {
+		return false
+	}
+	if (r.DurationUnitElement == nil) != (other.DurationUnitElement == nil) || r.DurationUnitElement != nil && !r.DurationUnitElement.EqualsDeep(*other.DurationUnitElement) {
+		return false
+	}
+	if (r.Frequency == nil) != (other.Frequency == nil) || r.Frequency != nil && *r.Frequency != *other.Frequency {
+		return false
+	}
+	if (r.FrequencyElement == nil) != (other.FrequencyElement == nil) || r.FrequencyElement != nil && !r.FrequencyElement.EqualsDeep(*other.FrequencyElement) {
+		return false
+	}
+	if (r.FrequencyMax == nil) != (other.FrequencyMax == nil) || r.FrequencyMax != nil && *r.FrequencyMax != *other.FrequencyMax {
+		return false
+	}
+	if (r.FrequencyMaxElement == nil) != (other.FrequencyMaxElement == nil) || r.FrequencyMaxElement != nil && !r.FrequencyMaxElement.EqualsDeep(*other.FrequencyMaxElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !equivalentDecimal(*r.Period, *other.Period) {
+		return false
+	}
+	if (r.PeriodElement == nil) != (other.PeriodElement == nil) || r.PeriodElement != nil && !r.PeriodElement.EqualsDeep(*other.PeriodElement) {
+		return false
+	}
+	if (r.PeriodMax == nil) != (other.PeriodMax == nil) || r.PeriodMax != nil && !equivalentDecimal(*r.PeriodMax, *other.PeriodMax) {
+		return false
+	}
+	if (r.PeriodMaxElement == nil) != (other.PeriodMaxElement == nil) || r.PeriodMaxElement != nil && !r.PeriodMaxElement.EqualsDeep(*other.PeriodMaxElement) {
+		return false
+	}
+	if (r.PeriodUnit == nil) != (other.PeriodUnit == nil) || r.PeriodUnit != nil && !equivalentString(*r.PeriodUnit, *other.PeriodUnit) {
+		return false
+	}
+	if (r.PeriodUnitElement == nil) != (other.PeriodUnitElement == nil) || r.PeriodUnitElement != nil && !r.PeriodUnitElement.EqualsDeep(*other.PeriodUnitElement) {
+		return false
+	}
+	if !equivalentList(len(r.DayOfWeek), len(other.DayOfWeek), func(i, j int) bool {
+		return r.DayOfWeek[i] == other.DayOfWeek[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.DayOfWeekElement, other.DayOfWeekElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.TimeOfDay), len(other.TimeOfDay), func(i, j int) bool {
+		return equivalentString(r.TimeOfDay[i], other.TimeOfDay[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.TimeOfDayElement, other.TimeOfDayElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.When), len(other.When), func(i, j int) bool {
+		return equivalentString(r.When[i], other.When[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.WhenElement, other.WhenElement, true) {
+		return false
+	}
+	if (r.Offset == nil) != (other.Offset == nil) || r.Offset != nil && *r.Offset != *other.Offset {
+		return false
+	}
+	if (r.OffsetElement == nil) != (other.OffsetElement == nil) || r.OffsetElement != nil && !r.OffsetElement.EqualsDeep(*other.OffsetElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TimingRepeat) EqualsShallow(other TimingRepeat) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	if (r.CountMax == nil) != (other.CountMax == nil) || r.CountMax != nil && *r.CountMax != *other.CountMax {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !equivalentDecimal(*r.Duration, *other.Duration) {
+		return false
+	}
+	if (r.DurationMax == nil) != (other.DurationMax == nil) || r.DurationMax != nil && !equivalentDecimal(*r.DurationMax, *other.DurationMax) {
+		return false
+	}
+	if (r.DurationUnit == nil) != (other.DurationUnit == nil) || r.DurationUnit != nil && !equivalentString(*r.DurationUnit, *other.DurationUnit) {
+		return false
+	}
+	if (r.Frequency == nil) != (other.Frequency == nil) || r.Frequency != nil && *r.Frequency != *other.Frequency {
+		return false
+	}
+	if (r.FrequencyMax == nil) != (other.FrequencyMax == nil) || r.FrequencyMax != nil && *r.FrequencyMax != *other.FrequencyMax {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !equivalentDecimal(*r.Period, *other.Period) {
+		return false
+	}
+	if (r.PeriodMax == nil) != (other.PeriodMax == nil) || r.PeriodMax != nil && !equivalentDecimal(*r.PeriodMax, *other.PeriodMax) {
+		return false
+	}
+	if (r.PeriodUnit == nil) != (other.PeriodUnit == nil) || r.PeriodUnit != nil && !equivalentString(*r.PeriodUnit, *other.PeriodUnit) {
+		return false
+	}
+	if !equivalentList(len(r.DayOfWeek), len(other.DayOfWeek), func(i, j int) bool {
+		return r.DayOfWeek[i] == other.DayOfWeek[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.TimeOfDay), len(other.TimeOfDay), func(i, j int) bool {
+		return equivalentString(r.TimeOfDay[i], other.TimeOfDay[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.When), len(other.When), func(i, j int) bool {
+		return equivalentString(r.When[i], other.When[j])
+	}) {
+		return false
+	}
+	if (r.Offset == nil) != (other.Offset == nil) || r.Offset != nil && *r.Offset != *other.Offset {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TimingRepeat as FHIR XML
+func (r TimingRepeat) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "boundsDuration", r.BoundsDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "boundsRange", r.BoundsRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "boundsPeriod", r.BoundsPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "count", r.Count, r.CountElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "countMax", r.CountMax, r.CountMaxElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "duration", r.Duration, r.DurationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "durationMax", r.DurationMax, r.DurationMaxElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "durationUnit", r.DurationUnit, r.DurationUnitElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "frequency", r.Frequency, r.FrequencyElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "frequencyMax", r.FrequencyMax, r.FrequencyMaxElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "period", r.Period, r.PeriodElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "periodMax", r.PeriodMax, r.PeriodMaxElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "periodUnit", r.PeriodUnit, r.PeriodUnitElement); err != nil {
+		return err
+	}
+	for i, v := range r.DayOfWeek {
+		if err := encodeXMLPrimitive(e, "dayOfWeek", v, elementAt(r.DayOfWeekElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.TimeOfDay {
+		if err := encodeXMLPrimitive(e, "timeOfDay", v, elementAt(r.TimeOfDayElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.When {
+		if err := encodeXMLPrimitive(e, "when", v, elementAt(r.WhenElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "offset", r.Offset, r.OffsetElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TimingRepeat from FHIR XML
+func (r *TimingRepeat) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "boundsDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BoundsDuration = &v
+			case "boundsRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BoundsRange = &v
+			case "boundsPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BoundsPeriod = &v
+			case "count":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Count = &v
+				}
+				r.CountElement = element
+			case "countMax":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CountMax = &v
+				}
+				r.CountMaxElement = element
+			case "duration":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Duration = &v
+				}
+				r.DurationElement = element
+			case "durationMax":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DurationMax = &v
+				}
+				r.DurationMaxElement = element
+			case "durationUnit":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DurationUnit = &v
+				}
+				r.DurationUnitElement = element
+			case "frequency":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Frequency = &v
+				}
+				r.FrequencyElement = element
+			case "frequencyMax":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FrequencyMax = &v
+				}
+				r.FrequencyMaxElement = element
+			case "period":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Period = &v
+				}
+				r.PeriodElement = element
+			case "periodMax":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PeriodMax = &v
+				}
+				r.PeriodMaxElement = element
+			case "periodUnit":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PeriodUnit = &v
+				}
+				r.PeriodUnitElement = element
+			case "dayOfWeek":
+				var v DaysOfWeek
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DayOfWeekElement = appendElement(r.DayOfWeekElement, len(r.DayOfWeek), element)
+					r.DayOfWeek = append(r.DayOfWeek, v)
+				}
+			case "timeOfDay":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.TimeOfDayElement = appendElement(r.TimeOfDayElement, len(r.TimeOfDay), element)
+					r.TimeOfDay = append(r.TimeOfDay, v)
+				}
+			case "when":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.WhenElement = appendElement(r.WhenElement, len(r.When), element)
+					r.When = append(r.When, v)
+				}
+			case "offset":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Offset = &v
+				}
+				r.OffsetElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TimingRepeat as properties to the RDF node n
+func (r TimingRepeat) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.element("Timing.repeat.boundsDuration", -1, r.BoundsDuration)
+	n.element("Timing.repeat.boundsRange", -1, r.BoundsRange)
+	n.element("Timing.repeat.boundsPeriod", -1, r.BoundsPeriod)
+	n.primitive("Timing.repeat.count", -1, r.Count, r.CountElement, "positiveInt")
+	n.primitive("Timing.repeat.countMax", -1, r.CountMax, r.CountMaxElement, "positiveInt")
+	n.primitive("Timing.repeat.duration", -1, r.Duration, r.DurationElement, "decimal")
+	n.primitive("Timing.repeat.durationMax", -1, r.DurationMax, r.DurationMaxElement, "decimal")
+	n.primitive("Timing.repeat.durationUnit", -1, r.DurationUnit, r.DurationUnitElement, "string")
+	n.primitive("Timing.repeat.frequency", -1, r.Frequency, r.FrequencyElement, "positiveInt")
+	n.primitive("Timing.repeat.frequencyMax", -1, r.FrequencyMax, r.FrequencyMaxElement, "positiveInt")
+	n.primitive("Timing.repeat.period", -1, r.Period, r.PeriodElement, "decimal")
+	n.primitive("Timing.repeat.periodMax", -1, r.PeriodMax, r.PeriodMaxElement, "decimal")
+	n.primitive("Timing.repeat.periodUnit", -1, r.PeriodUnit, r.PeriodUnitElement, "string")
+	for i, v := range r.DayOfWeek {
+		n.primitive("Timing.repeat.dayOfWeek", i, v, elementAt(r.DayOfWeekElement, i), "code")
+	}
+	for i, v := range r.TimeOfDay {
+		n.primitive("Timing.repeat.timeOfDay", i, v, elementAt(r.TimeOfDayElement, i), "time")
+	}
+	for i, v := range r.When {
+		n.primitive("Timing.repeat.when", i, v, elementAt(r.WhenElement, i), "string")
+	}
+	n.primitive("Timing.repeat.offset", -1, r.Offset, r.OffsetElement, "unsignedInt")
+}
+
+// MarshalBSON marshals the given TimingRepeat as BSON document with the structure of its FHIR JSON
+func (r TimingRepeat) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TimingRepeat from a BSON document with the structure of its FHIR JSON
+func (r *TimingRepeat) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TimingRepeat as protobuf message TimingRepeat of fhir.proto
+func (r TimingRepeat) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TimingRepeat to the encoded message
+func (r TimingRepeat) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.BoundsDuration != nil {
+		e.message(3, *r.BoundsDuration)
+	}
+	if r.BoundsRange != nil {
+		e.message(4, *r.BoundsRange)
+	}
+	if r.BoundsPeriod != nil {
+		e.message(5, *r.BoundsPeriod)
+	}
+	if r.Count != nil {
+		e.varint(6, uint64(int64(*r.Count)))
+	}
+	if r.CountMax != nil {
+		e.varint(7, uint64(int64(*r.CountMax)))
+	}
+	if r.Duration != nil {
+		e.string(8, string(*r.Duration))
+	}
+	if r.DurationMax != nil {
+		e.string(9, string(*r.DurationMax))
+	}
+	if r.DurationUnit != nil {
+		e.string(10, *r.DurationUnit)
+	}
+	if r.Frequency != nil {
+		e.varint(11, uint64(int64(*r.Frequency)))
+	}
+	if r.FrequencyMax != nil {
+		e.varint(12, uint64(int64(*r.FrequencyMax)))
+	}
+	if r.Period != nil {
+		e.string(13, string(*r.Period))
+	}
+	if r.PeriodMax != nil {
+		e.string(14, string(*r.PeriodMax))
+	}
+	if r.PeriodUnit != nil {
+		e.string(15, *r.PeriodUnit)
+	}
+	if len(r.DayOfWeek) > 0 {
+		var p protoEncoder
+		for _, v := range r.DayOfWeek {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(16, p.buf)
+	}
+	for _, v := range r.TimeOfDay {
+		e.string(17, v)
+	}
+	for _, v := range r.When {
+		e.string(18, v)
+	}
+	if r.Offset != nil {
+		e.varint(19, uint64(int64(*r.Offset)))
+	}
+	if r.CountElement != nil {
+		e.message(20, *r.CountElement)
+	}
+	if r.CountMaxElement != nil {
+		e.message(21, *r.CountMaxElement)
+	}
+	if r.DurationElement != nil {
+		e.message(22, *r.DurationElement)
+	}
+	if r.DurationMaxElement != nil {
+		e.message(23, *r.DurationMaxElement)
+	}
+	if r.DurationUnitElement != nil {
+		e.message(24, *r.DurationUnitElement)
+	}
+	if r.FrequencyElement != nil {
+		e.message(25, *r.FrequencyElement)
+	}
+	if r.FrequencyMaxElement != nil {
+		e.message(26, *r.FrequencyMaxElement)
+	}
+	if r.PeriodElement != nil {
+		e.message(27, *r.PeriodElement)
+	}
+	if r.PeriodMaxElement != nil {
+		e.message(28, *r.PeriodMaxElement)
+	}
+	if r.PeriodUnitElement != nil {
+		e.message(29, *r.PeriodUnitElement)
+	}
+	for _, v := range r.DayOfWeekElement {
+		e.element(30, v)
+	}
+	for _, v := range r.TimeOfDayElement {
+		e.element(31, v)
+	}
+	for _, v := range r.WhenElement {
+		e.element(32, v)
+	}
+	if r.OffsetElement != nil {
+		e.message(33, *r.OffsetElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TimingRepeat from protobuf message TimingRepeat of fhir.proto
+func (r *TimingRepeat) UnmarshalProto(b []byte) error {
+	*r = TimingRepeat{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Duration
+			d.message(&v)
+			r.BoundsDuration = &v
+		case 4:
+			var v Range
+			d.message(&v)
+			r.BoundsRange = &v
+		case 5:
+			var v Period
+			d.message(&v)
+			r.BoundsPeriod = &v
+		case 6:
+			v := d.int()
+			r.Count = &v
+		case 7:
+			v := d.int()
+			r.CountMax = &v
+		case 8:
+			v := json.Number(d.string())
+			r.Duration = &v
+		case 9:
+			v := json.Number(d.string())
+			r.DurationMax = &v
+		case 10:
+			v := d.string()
+			r.DurationUnit = &v
+		case 11:
+			v := d.int()
+			r.Frequency = &v
+		case 12:
+			v := d.int()
+			r.FrequencyMax = &v
+		case 13:
+			v := json.Number(d.string())
+			r.Period = &v
+		case 14:
+			v := json.Number(d.string())
+			r.PeriodMax = &v
+		case 15:
+			v := d.string()
+			r.PeriodUnit = &v
+		case 16:
+			for _, n := range d.varints() {
+				var v DaysOfWeek
+				d.enum(n, v.setProtoNumber)
+				r.DayOfWeek = append(r.DayOfWeek, v)
+			}
+		case 17:
+			r.TimeOfDay = append(r.TimeOfDay, d.string())
+		case 18:
+			r.When = append(r.When, d.string())
+		case 19:
+			v := d.int()
+			r.Offset = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.CountElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.CountMaxElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.DurationElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.DurationMaxElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.DurationUnitElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.FrequencyElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.FrequencyMaxElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.PeriodElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.PeriodMaxElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.PeriodUnitElement = &v
+		case 30:
+			r.DayOfWeekElement = append(r.DayOfWeekElement, d.element())
+		case 31:
+			r.TimeOfDayElement = append(r.TimeOfDayElement, d.element())
+		case 32:
+			r.WhenElement = append(r.WhenElement, d.element())
+		case 33:
+			var v Element
+			d.message(&v)
+			r.OffsetElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -14,19 +14,735 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // TriggerDefinition is documented here http://hl7.org/fhir/StructureDefinition/TriggerDefinition
 type TriggerDefinition struct {
-	Id              *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension       []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type            TriggerType       `bson:"type" json:"type"`
-	Name            *string           `bson:"name,omitempty" json:"name,omitempty"`
-	TimingTiming    *Timing           `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
-	TimingReference *Reference        `bson:"timingReference,omitempty" json:"timingReference,omitempty"`
-	TimingDate      *string           `bson:"timingDate,omitempty" json:"timingDate,omitempty"`
-	TimingDateTime  *string           `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
-	Data            []DataRequirement `bson:"data,omitempty" json:"data,omitempty"`
-	Condition       *Expression       `bson:"condition,omitempty" json:"condition,omitempty"`
+	Id                    *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type                  TriggerType       `bson:"type" json:"type"`
+	TypeElement           *Element          `bson:"_type,omitempty" json:"_type,omitempty"`
+	Name                  *string           `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element          `bson:"_name,omitempty" json:"_name,omitempty"`
+	TimingTiming          *Timing           `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	TimingReference       *Reference        `bson:"timingReference,omitempty" json:"timingReference,omitempty"`
+	TimingDate            *string           `bson:"timingDate,omitempty" json:"timingDate,omitempty"`
+	TimingDateElement     *Element          `bson:"_timingDate,omitempty" json:"_timingDate,omitempty"`
+	TimingDateTime        *string           `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *Element          `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
+	Data                  []DataRequirement `bson:"data,omitempty" json:"data,omitempty"`
+	Condition             *Expression       `bson:"condition,omitempty" json:"condition,omitempty"`
+}
+
+// TriggerDefinitionTiming is implemented by the types allowed for TriggerDefinition.timing[x]
+type TriggerDefinitionTiming interface {
+	isTriggerDefinitionTiming()
+}
+
+func (Timing) isTriggerDefinitionTiming()    {}
+func (Reference) isTriggerDefinitionTiming() {}
+func (Date) isTriggerDefinitionTiming()      {}
+func (DateTime) isTriggerDefinitionTiming()  {}
+
+// Timing returns the value of TriggerDefinition.timing[x] together with its FHIR type code or nil if no value is set
+func (r TriggerDefinition) Timing() (TriggerDefinitionTiming, string) {
+	if r.TimingTiming != nil {
+		return *r.TimingTiming, "Timing"
+	}
+	if r.TimingReference != nil {
+		return *r.TimingReference, "Reference"
+	}
+	if r.TimingDate != nil {
+		return Date(*r.TimingDate), "date"
+	}
+	if r.TimingDateTime != nil {
+		return DateTime(*r.TimingDateTime), "dateTime"
+	}
+	return nil, ""
+}
+
+// SetTiming sets the value of TriggerDefinition.timing[x], given as value or pointer, and clears all other types
+func (r *TriggerDefinition) SetTiming(value TriggerDefinitionTiming) {
+	r.TimingTiming = nil
+	r.TimingReference = nil
+	r.TimingDate = nil
+	r.TimingDateTime = nil
+	switch v := value.(type) {
+	case Timing:
+		r.TimingTiming = &v
+	case *Timing:
+		r.TimingTiming = v
+	case Reference:
+		r.TimingReference = &v
+	case *Reference:
+		r.TimingReference = v
+	case Date:
+		primitive := string(v)
+		r.TimingDate = &primitive
+	case *Date:
+		r.TimingDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.TimingDateTime = &primitive
+	case *DateTime:
+		r.TimingDateTime = (*string)(v)
+	}
+}
+
+// MarshalJSON marshals the given TriggerDefinition as JSON into a byte slice
+func (r TriggerDefinition) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TriggerDefinition as JSON object with the members in the order of the fields
+func (r TriggerDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.TimingTiming != nil {
+		e.key("timingTiming")
+		r.TimingTiming.appendJSON(e)
+	}
+	if r.TimingReference != nil {
+		e.key("timingReference")
+		r.TimingReference.appendJSON(e)
+	}
+	if r.TimingDate != nil {
+		e.key("timingDate")
+		e.string(*r.TimingDate)
+	}
+	if r.TimingDateElement != nil {
+		e.key("_timingDate")
+		r.TimingDateElement.appendJSON(e)
+	}
+	if r.TimingDateTime != nil {
+		e.key("timingDateTime")
+		e.string(*r.TimingDateTime)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.key("_timingDateTime")
+		r.TimingDateTimeElement.appendJSON(e)
+	}
+	if len(r.Data) > 0 {
+		e.key("data")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Data {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Condition != nil {
+		e.key("condition")
+		r.Condition.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TriggerDefinition from JSON. Unknown members are ignored.
+func (r *TriggerDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TriggerDefinition
+func (r *TriggerDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "timingTiming":
+			if d.null() {
+				r.TimingTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.TimingTiming = &v
+			}
+		case "timingReference":
+			if d.null() {
+				r.TimingReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.TimingReference = &v
+			}
+		case "timingDate":
+			if v, ok := d.string(); ok {
+				r.TimingDate = &v
+			} else {
+				r.TimingDate = nil
+			}
+		case "_timingDate":
+			r.TimingDateElement = d.element()
+		case "timingDateTime":
+			if v, ok := d.string(); ok {
+				r.TimingDateTime = &v
+			} else {
+				r.TimingDateTime = nil
+			}
+		case "_timingDateTime":
+			r.TimingDateTimeElement = d.element()
+		case "data":
+			a := d.array()
+			r.Data = nil
+			for a.next() {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.Data = append(r.Data, v)
+			}
+			if r.Data == nil && !a.isNull {
+				r.Data = []DataRequirement{}
+			}
+		case "condition":
+			if d.null() {
+				r.Condition = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.Condition = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TriggerDefinition which shares no memory with the original
+func (r TriggerDefinition) DeepCopy() TriggerDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.TimingTiming != nil {
+		v := r.TimingTiming.DeepCopy()
+		out.TimingTiming = &v
+	}
+	if r.TimingReference != nil {
+		v := r.TimingReference.DeepCopy()
+		out.TimingReference = &v
+	}
+	if r.TimingDate != nil {
+		v := *r.TimingDate
+		out.TimingDate = &v
+	}
+	if r.TimingDateElement != nil {
+		v := r.TimingDateElement.DeepCopy()
+		out.TimingDateElement = &v
+	}
+	if r.TimingDateTime != nil {
+		v := *r.TimingDateTime
+		out.TimingDateTime = &v
+	}
+	if r.TimingDateTimeElement != nil {
+		v := r.TimingDateTimeElement.DeepCopy()
+		out.TimingDateTimeElement = &v
+	}
+	if r.Data != nil {
+		out.Data = make([]DataRequirement, len(r.Data))
+		for i := range r.Data {
+			out.Data[i] = r.Data[i].DeepCopy()
+		}
+	}
+	if r.Condition != nil {
+		v := r.Condition.DeepCopy()
+		out.Condition = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TriggerDefinition) Equal(other TriggerDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.Equal(*other.TimingTiming) {
+		return false
+	}
+	if (r.TimingReference == nil) != (other.TimingReference == nil) || r.TimingReference != nil && !r.TimingReference.Equal(*other.TimingReference) {
+		return false
+	}
+	if (r.TimingDate == nil) != (other.TimingDate == nil) || r.TimingDate != nil && *r.TimingDate != *other.TimingDate {
+		return false
+	}
+	if (r.TimingDateElement == nil) != (other.TimingDateElement == nil) || r.TimingDateElement != nil && !r.TimingDateElement.Equal(*other.TimingDateElement) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && *r.TimingDateTime != *other.TimingDateTime {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.Equal(*other.TimingDateTimeElement) {
+		return false
+	}
+	if len(r.Data) != len(other.Data) {
+		return false
+	}
+	for i := range r.Data {
+		if !r.Data[i].Equal(other.Data[i]) {
+			return false
+		}
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !r.Condition.Equal(*other.Condition) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TriggerDefinition) EqualsDeep(other TriggerDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.EqualsDeep(*other.TimingTiming) {
+		return false
+	}
+	if (r.TimingReference == nil) != (other.TimingReference == nil) || r.TimingReference != nil && !r.TimingReference.EqualsDeep(*other.TimingReference) {
+		return false
+	}
+	if (r.TimingDate == nil) != (other.TimingDate == nil) || r.TimingDate != nil && !equivalentString(*r.TimingDate, *other.TimingDate) {
+		return false
+	}
+	if (r.TimingDateElement == nil) != (other.TimingDateElement == nil) || r.TimingDateElement != nil && !r.TimingDateElement.EqualsDeep(*other.TimingDateElement) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.EqualsDeep(*other.TimingDateTimeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Data), len(other.Data), func(i, j int) bool {
+		return r.Data[i].EqualsDeep(other.Data[j])
+	}) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !r.Condition.EqualsDeep(*other.Condition) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TriggerDefinition) EqualsShallow(other TriggerDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.TimingDate == nil) != (other.TimingDate == nil) || r.TimingDate != nil && !equivalentString(*r.TimingDate, *other.TimingDate) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TriggerDefinition as FHIR XML
+func (r TriggerDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingTiming", r.TimingTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingReference", r.TimingReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timingDate", r.TimingDate, r.TimingDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timingDateTime", r.TimingDateTime, r.TimingDateTimeElement); err != nil {
+		return err
+	}
+	for _, v := range r.Data {
+		if err := encodeXMLElement(e, "data", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "condition", r.Condition); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TriggerDefinition from FHIR XML
+func (r *TriggerDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "type":
+				var v TriggerType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "timingTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingTiming = &v
+			case "timingReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingReference = &v
+			case "timingDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimingDate = &v
+				}
+				r.TimingDateElement = element
+			case "timingDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimingDateTime = &v
+				}
+				r.TimingDateTimeElement = element
+			case "data":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Data = append(r.Data, v)
+			case "condition":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Condition = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TriggerDefinition as properties to the RDF node n
+func (r TriggerDefinition) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("TriggerDefinition.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("TriggerDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.element("TriggerDefinition.timingTiming", -1, r.TimingTiming)
+	n.element("TriggerDefinition.timingReference", -1, r.TimingReference)
+	n.primitive("TriggerDefinition.timingDate", -1, r.TimingDate, r.TimingDateElement, "date")
+	n.primitive("TriggerDefinition.timingDateTime", -1, r.TimingDateTime, r.TimingDateTimeElement, "dateTime")
+	for i, v := range r.Data {
+		n.element("TriggerDefinition.data", i, v)
+	}
+	n.element("TriggerDefinition.condition", -1, r.Condition)
+}
+
+// MarshalBSON marshals the given TriggerDefinition as BSON document with the structure of its FHIR JSON
+func (r TriggerDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TriggerDefinition from a BSON document with the structure of its FHIR JSON
+func (r *TriggerDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TriggerDefinition as protobuf message TriggerDefinition of fhir.proto
+func (r TriggerDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TriggerDefinition to the encoded message
+func (r TriggerDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	e.varint(3, r.Type.protoNumber())
+	if r.Name != nil {
+		e.string(4, *r.Name)
+	}
+	if r.TimingTiming != nil {
+		e.message(5, *r.TimingTiming)
+	}
+	if r.TimingReference != nil {
+		e.message(6, *r.TimingReference)
+	}
+	if r.TimingDate != nil {
+		e.string(7, *r.TimingDate)
+	}
+	if r.TimingDateTime != nil {
+		e.string(8, *r.TimingDateTime)
+	}
+	for _, v := range r.Data {
+		e.message(9, v)
+	}
+	if r.Condition != nil {
+		e.message(10, *r.Condition)
+	}
+	if r.TypeElement != nil {
+		e.message(11, *r.TypeElement)
+	}
+	if r.NameElement != nil {
+		e.message(12, *r.NameElement)
+	}
+	if r.TimingDateElement != nil {
+		e.message(13, *r.TimingDateElement)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.message(14, *r.TimingDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TriggerDefinition from protobuf message TriggerDefinition of fhir.proto
+func (r *TriggerDefinition) UnmarshalProto(b []byte) error {
+	*r = TriggerDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v TriggerType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 4:
+			v := d.string()
+			r.Name = &v
+		case 5:
+			var v Timing
+			d.message(&v)
+			r.TimingTiming = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.TimingReference = &v
+		case 7:
+			v := d.string()
+			r.TimingDate = &v
+		case 8:
+			v := d.string()
+			r.TimingDateTime = &v
+		case 9:
+			var v DataRequirement
+			d.message(&v)
+			r.Data = append(r.Data, v)
+		case 10:
+			var v Expression
+			d.message(&v)
+			r.Condition = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.TimingDateElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.TimingDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
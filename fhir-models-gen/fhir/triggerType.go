@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -40,8 +39,13 @@ const (
 func (code TriggerType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *TriggerType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *TriggerType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "named-event":
 		*code = TriggerTypeNamedEvent
@@ -64,6 +68,12 @@ func (code *TriggerType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code TriggerType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TriggerType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code TriggerType) String() string {
 	return code.Code()
 }
@@ -130,3 +140,47 @@ func (code TriggerType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code TriggerType) protoNumber() uint64 {
+	switch code {
+	case TriggerTypeNamedEvent:
+		return 1
+	case TriggerTypePeriodic:
+		return 2
+	case TriggerTypeDataChanged:
+		return 3
+	case TriggerTypeDataAdded:
+		return 4
+	case TriggerTypeDataModified:
+		return 5
+	case TriggerTypeDataRemoved:
+		return 6
+	case TriggerTypeDataAccessed:
+		return 7
+	case TriggerTypeDataAccessEnded:
+		return 8
+	}
+	return 0
+}
+func (code *TriggerType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TriggerTypeNamedEvent
+	case 2:
+		*code = TriggerTypePeriodic
+	case 3:
+		*code = TriggerTypeDataChanged
+	case 4:
+		*code = TriggerTypeDataAdded
+	case 5:
+		*code = TriggerTypeDataModified
+	case 6:
+		*code = TriggerTypeDataRemoved
+	case 7:
+		*code = TriggerTypeDataAccessed
+	case 8:
+		*code = TriggerTypeDataAccessEnded
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,394 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+const turtlePrefixes = "@prefix fhir: <http://hl7.org/fhir/> .\n" +
+	"@prefix loinc: <http://loinc.org/rdf#> .\n" +
+	"@prefix sct: <http://snomed.info/id/> .\n" +
+	"@prefix xsd: <http://www.w3.org/2001/XMLSchema#> .\n"
+
+// TurtleEncoder writes resources as RDF Turtle following the FHIR R4 RDF representation, see
+// http://hl7.org/fhir/R4/rdf.html. Elements are named by their type-qualified path like fhir:Patient.birthDate and
+// codings of LOINC and SNOMED CT are typed by their code. Values of primitives are fhir:v literals like in the current
+// representation instead of fhir:value.
+type TurtleEncoder struct {
+	w       io.Writer
+	base    string
+	started bool
+}
+
+// NewTurtleEncoder returns a new encoder that writes to w. Resources and references are resolved against the base
+// http://hl7.org/fhir/ until another base is set.
+func NewTurtleEncoder(w io.Writer) *TurtleEncoder {
+	return &TurtleEncoder{w: w, base: "http://hl7.org/fhir/"}
+}
+
+// SetBase sets the base URL of the server the resources belong to. The IRIs of resources and of the targets of
+// relative references are formed by appending the resource type and id to it.
+func (e *TurtleEncoder) SetBase(base string) {
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	e.base = base
+}
+
+// Encode writes the resource, a generated resource like Patient or a pointer to it, as tree of blank nodes below
+// the node of the resource. The prefixes are written before the first resource.
+func (e *TurtleEncoder) Encode(resource interface{}) error {
+	t, ok := resource.(turtler)
+	if !ok {
+		return fmt.Errorf("%T is not a resource", resource)
+	}
+	g := &turtleGraph{base: e.base}
+	n := g.node()
+	t.turtle(n)
+	if g.err != nil {
+		return g.err
+	}
+	if n.resourceType == "" {
+		return fmt.Errorf("%T is not a resource", resource)
+	}
+
+	var b strings.Builder
+	if !e.started {
+		b.WriteString(turtlePrefixes)
+		e.started = true
+	}
+	b.WriteString("\n")
+	if n.id != nil {
+		b.WriteString(turtleIRI(e.base + n.resourceType + "/" + *n.id))
+	} else {
+		b.WriteString("[]")
+	}
+	b.WriteString(" a fhir:" + n.resourceType + " ;\n  fhir:nodeRole fhir:treeRoot")
+	for _, p := range n.properties {
+		b.WriteString(" ;\n  ")
+		p.write(&b, "  ")
+	}
+	b.WriteString(" .\n")
+	if len(g.links) > 0 {
+		b.WriteString("\n")
+	}
+	for _, link := range g.links {
+		b.WriteString(link.iri + " a fhir:" + link.resourceType + " .\n")
+	}
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+// turtler is implemented by all generated structs.
+type turtler interface {
+	turtle(n *turtleNode)
+}
+
+// turtleGraph collects the typed targets of references and the first error while the nodes are built.
+type turtleGraph struct {
+	base  string
+	links []turtleLink
+	err   error
+}
+
+type turtleLink struct {
+	iri          string
+	resourceType string
+}
+
+func (g *turtleGraph) node() *turtleNode {
+	return &turtleNode{graph: g}
+}
+
+// turtleNode is a blank node, the properties of which are the elements of a resource or complex value.
+type turtleNode struct {
+	graph        *turtleGraph
+	resourceType string
+	id           *string
+	types        []string
+	properties   []turtleProperty
+}
+
+// turtleProperty has either a literal or IRI object, or a nested node.
+type turtleProperty struct {
+	predicate string
+	object    string
+	node      *turtleNode
+}
+
+// resource marks the node as node of a resource with the given type and id.
+func (n *turtleNode) resource(resourceType string, id *string) {
+	n.resourceType = resourceType
+	n.id = id
+	n.types = append(n.types, resourceType)
+}
+
+func (n *turtleNode) add(predicate string, object string) {
+	n.properties = append(n.properties, turtleProperty{predicate: predicate, object: object})
+}
+
+// child adds a nested node as value of the element. Items of repeating elements carry their index.
+func (n *turtleNode) child(predicate string, index int) *turtleNode {
+	c := n.graph.node()
+	if index >= 0 {
+		c.add("fhir:index", strconv.Itoa(index))
+	}
+	n.properties = append(n.properties, turtleProperty{predicate: "fhir:" + predicate, node: c})
+	return c
+}
+
+// primitive adds the value of a primitive element, which may be passed by pointer, as fhir:v literal together with
+// the id and extensions of the element. The XHTML of narratives is the literal object of the element itself.
+func (n *turtleNode) primitive(predicate string, index int, value interface{}, element *Element, typeCode string) {
+	s, ok := xmlValue(value)
+	if !ok && element == nil {
+		return
+	}
+	if typeCode == "xhtml" {
+		n.add("fhir:"+predicate, turtleString(s))
+		return
+	}
+	c := n.child(predicate, index)
+	if ok {
+		c.add("fhir:v", turtleLiteral(s, typeCode))
+	}
+	if element != nil {
+		element.turtle(c)
+	}
+}
+
+// element adds a complex value, which may be passed by pointer.
+func (n *turtleNode) element(predicate string, index int, value turtler) {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && v.IsNil() {
+		return
+	}
+	value.turtle(n.child(predicate, index))
+}
+
+// inline adds a contained or inline resource held as JSON.
+func (n *turtleNode) inline(predicate string, index int, raw json.RawMessage) {
+	if len(raw) == 0 || n.graph.err != nil {
+		return
+	}
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		n.graph.err = err
+		return
+	}
+	resource, ok := newResource(header.ResourceType)
+	if !ok {
+		n.graph.err = fmt.Errorf("unknown resource type `%s` in %s", header.ResourceType, predicate)
+		return
+	}
+	if err := json.Unmarshal(raw, resource); err != nil {
+		n.graph.err = err
+		return
+	}
+	resource.(turtler).turtle(n.child(predicate, index))
+}
+
+// link adds the target of a reference as fhir:link and records its type. Relative references are resolved against
+// the base, references to contained resources aren't linked.
+func (n *turtleNode) link(reference *string, resourceType *string) {
+	if reference == nil || *reference == "" || strings.HasPrefix(*reference, "#") {
+		return
+	}
+	ref := *reference
+	absolute := strings.Contains(ref, ":")
+	if !absolute {
+		ref = n.graph.base + ref
+	}
+	iri := turtleIRI(ref)
+	n.add("fhir:link", iri)
+
+	t := ""
+	if resourceType != nil {
+		t = *resourceType
+	} else if !absolute || strings.HasPrefix(ref, n.graph.base) {
+		t = strings.Split(strings.TrimPrefix(ref, n.graph.base), "/")[0]
+	} else if segments := strings.Split(strings.Split(ref, "/_history/")[0], "/"); len(segments) > 1 {
+		t = segments[len(segments)-2]
+	}
+	if _, ok := newResource(t); ok {
+		n.graph.links = append(n.graph.links, turtleLink{iri: iri, resourceType: t})
+	}
+}
+
+// concept types the node of a coding of LOINC or SNOMED CT by its code, e.g. a loinc:29463-7.
+func (n *turtleNode) concept(system *string, code *string) {
+	if system == nil || code == nil || *code == "" {
+		return
+	}
+	switch *system {
+	case "http://loinc.org":
+		n.types = append(n.types, "loinc:"+turtleLocalName(*code))
+	case "http://snomed.info/sct":
+		n.types = append(n.types, "sct:"+turtleLocalName(*code))
+	}
+}
+
+func (p turtleProperty) write(b *strings.Builder, indent string) {
+	b.WriteString(p.predicate + " ")
+	if p.node == nil {
+		b.WriteString(p.object)
+		return
+	}
+	p.node.write(b, indent)
+}
+
+// write writes the node as blank node property list. Nodes without nested nodes are written in a single line.
+func (n *turtleNode) write(b *strings.Builder, indent string) {
+	nested := false
+	for _, p := range n.properties {
+		nested = nested || p.node != nil
+	}
+	separator, end := " ; ", " ]"
+	if nested {
+		separator, end = " ;\n"+indent+"  ", "\n"+indent+"]"
+	}
+	b.WriteString("[")
+	if nested {
+		b.WriteString("\n" + indent + "  ")
+	} else {
+		b.WriteString(" ")
+	}
+	first := true
+	for _, t := range n.types {
+		if !first {
+			b.WriteString(separator)
+		}
+		if !strings.Contains(t, ":") {
+			t = "fhir:" + t
+		}
+		b.WriteString("a " + t)
+		first = false
+	}
+	for _, p := range n.properties {
+		if !first {
+			b.WriteString(separator)
+		}
+		p.write(b, indent+"  ")
+		first = false
+	}
+	b.WriteString(end)
+}
+
+// turtleLiteral returns the literal of a primitive value with the XML schema datatype of its FHIR type. Like in R4,
+// strings, codes and URIs are plain literals.
+func turtleLiteral(s string, typeCode string) string {
+	quoted := turtleString(s)
+	switch typeCode {
+	case "boolean":
+		return quoted + "^^xsd:boolean"
+	case "integer":
+		return quoted + "^^xsd:integer"
+	case "positiveInt":
+		return quoted + "^^xsd:positiveInteger"
+	case "unsignedInt":
+		return quoted + "^^xsd:nonNegativeInteger"
+	case "decimal":
+		return quoted + "^^xsd:decimal"
+	case "base64Binary":
+		return quoted + "^^xsd:base64Binary"
+	case "instant":
+		return quoted + "^^xsd:dateTime"
+	case "time":
+		return quoted + "^^xsd:time"
+	case "date", "dateTime":
+		// dates may be partial, a time zone only counts for dates with time
+		switch date := strings.SplitN(s, "T", 2)[0]; {
+		case len(s) > len(date):
+			return quoted + "^^xsd:dateTime"
+		case len(date) == 4:
+			return quoted + "^^xsd:gYear"
+		case len(date) == 7:
+			return quoted + "^^xsd:gYearMonth"
+		case len(date) == 10:
+			return quoted + "^^xsd:date"
+		}
+	}
+	return quoted
+}
+
+func turtleString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// turtleIRI returns the IRI reference, percent-encoding the characters Turtle doesn't allow in it.
+func turtleIRI(iri string) string {
+	var b strings.Builder
+	b.WriteByte('<')
+	for _, c := range []byte(iri) {
+		if c <= ' ' || strings.IndexByte("<>\"{}|^`\\", c) >= 0 {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+// turtleLocalName returns the code as local name of a prefixed name, escaping the characters Turtle doesn't allow in
+// it.
+func turtleLocalName(code string) string {
+	var b strings.Builder
+	for i, r := range code {
+		switch {
+		case r == '_' || r == ':' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case (r == '-' || r == '.') && i > 0 && i < len(code)-1:
+			b.WriteRune(r)
+		case strings.ContainsRune("~.-!$&'()*+,;=/?#@%", r):
+			b.WriteString("\\" + string(r))
+		default:
+			for _, c := range []byte(string(r)) {
+				fmt.Fprintf(&b, "%%%02X", c)
+			}
+		}
+	}
+	return b.String()
+}
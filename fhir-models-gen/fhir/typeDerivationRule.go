@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code TypeDerivationRule) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *TypeDerivationRule) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *TypeDerivationRule) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "specialization":
 		*code = TypeDerivationRuleSpecialization
@@ -46,6 +50,12 @@ func (code *TypeDerivationRule) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code TypeDerivationRule) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TypeDerivationRule) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code TypeDerivationRule) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code TypeDerivationRule) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code TypeDerivationRule) protoNumber() uint64 {
+	switch code {
+	case TypeDerivationRuleSpecialization:
+		return 1
+	case TypeDerivationRuleConstraint:
+		return 2
+	}
+	return 0
+}
+func (code *TypeDerivationRule) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TypeDerivationRuleSpecialization
+	case 2:
+		*code = TypeDerivationRuleConstraint
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,303 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// TypeRestfulInteraction is documented here http://hl7.org/fhir/ValueSet/type-restful-interaction
+type TypeRestfulInteraction int
+
+const (
+	TypeRestfulInteractionRead TypeRestfulInteraction = iota
+	TypeRestfulInteractionVread
+	TypeRestfulInteractionUpdate
+	TypeRestfulInteractionPatch
+	TypeRestfulInteractionDelete
+	TypeRestfulInteractionHistory
+	TypeRestfulInteractionHistoryInstance
+	TypeRestfulInteractionHistoryType
+	TypeRestfulInteractionHistorySystem
+	TypeRestfulInteractionCreate
+	TypeRestfulInteractionSearch
+	TypeRestfulInteractionSearchType
+	TypeRestfulInteractionSearchSystem
+	TypeRestfulInteractionCapabilities
+	TypeRestfulInteractionTransaction
+	TypeRestfulInteractionBatch
+	TypeRestfulInteractionOperation
+)
+
+func (code TypeRestfulInteraction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(code.Code())
+}
+func (code *TypeRestfulInteraction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
+	switch s {
+	case "read":
+		*code = TypeRestfulInteractionRead
+	case "vread":
+		*code = TypeRestfulInteractionVread
+	case "update":
+		*code = TypeRestfulInteractionUpdate
+	case "patch":
+		*code = TypeRestfulInteractionPatch
+	case "delete":
+		*code = TypeRestfulInteractionDelete
+	case "history":
+		*code = TypeRestfulInteractionHistory
+	case "history-instance":
+		*code = TypeRestfulInteractionHistoryInstance
+	case "history-type":
+		*code = TypeRestfulInteractionHistoryType
+	case "history-system":
+		*code = TypeRestfulInteractionHistorySystem
+	case "create":
+		*code = TypeRestfulInteractionCreate
+	case "search":
+		*code = TypeRestfulInteractionSearch
+	case "search-type":
+		*code = TypeRestfulInteractionSearchType
+	case "search-system":
+		*code = TypeRestfulInteractionSearchSystem
+	case "capabilities":
+		*code = TypeRestfulInteractionCapabilities
+	case "transaction":
+		*code = TypeRestfulInteractionTransaction
+	case "batch":
+		*code = TypeRestfulInteractionBatch
+	case "operation":
+		*code = TypeRestfulInteractionOperation
+	default:
+		return fmt.Errorf("unknown TypeRestfulInteraction code `%s`", s)
+	}
+	return nil
+}
+func (code TypeRestfulInteraction) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TypeRestfulInteraction) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
+func (code TypeRestfulInteraction) String() string {
+	return code.Code()
+}
+func (code TypeRestfulInteraction) Code() string {
+	switch code {
+	case TypeRestfulInteractionRead:
+		return "read"
+	case TypeRestfulInteractionVread:
+		return "vread"
+	case TypeRestfulInteractionUpdate:
+		return "update"
+	case TypeRestfulInteractionPatch:
+		return "patch"
+	case TypeRestfulInteractionDelete:
+		return "delete"
+	case TypeRestfulInteractionHistory:
+		return "history"
+	case TypeRestfulInteractionHistoryInstance:
+		return "history-instance"
+	case TypeRestfulInteractionHistoryType:
+		return "history-type"
+	case TypeRestfulInteractionHistorySystem:
+		return "history-system"
+	case TypeRestfulInteractionCreate:
+		return "create"
+	case TypeRestfulInteractionSearch:
+		return "search"
+	case TypeRestfulInteractionSearchType:
+		return "search-type"
+	case TypeRestfulInteractionSearchSystem:
+		return "search-system"
+	case TypeRestfulInteractionCapabilities:
+		return "capabilities"
+	case TypeRestfulInteractionTransaction:
+		return "transaction"
+	case TypeRestfulInteractionBatch:
+		return "batch"
+	case TypeRestfulInteractionOperation:
+		return "operation"
+	}
+	return "<unknown>"
+}
+func (code TypeRestfulInteraction) Display() string {
+	switch code {
+	case TypeRestfulInteractionRead:
+		return "read"
+	case TypeRestfulInteractionVread:
+		return "vread"
+	case TypeRestfulInteractionUpdate:
+		return "update"
+	case TypeRestfulInteractionPatch:
+		return "patch"
+	case TypeRestfulInteractionDelete:
+		return "delete"
+	case TypeRestfulInteractionHistory:
+		return "history"
+	case TypeRestfulInteractionHistoryInstance:
+		return "history-instance"
+	case TypeRestfulInteractionHistoryType:
+		return "history-type"
+	case TypeRestfulInteractionHistorySystem:
+		return "history-system"
+	case TypeRestfulInteractionCreate:
+		return "create"
+	case TypeRestfulInteractionSearch:
+		return "search"
+	case TypeRestfulInteractionSearchType:
+		return "search-type"
+	case TypeRestfulInteractionSearchSystem:
+		return "search-system"
+	case TypeRestfulInteractionCapabilities:
+		return "capabilities"
+	case TypeRestfulInteractionTransaction:
+		return "transaction"
+	case TypeRestfulInteractionBatch:
+		return "batch"
+	case TypeRestfulInteractionOperation:
+		return "operation"
+	}
+	return "<unknown>"
+}
+func (code TypeRestfulInteraction) Definition() string {
+	switch code {
+	case TypeRestfulInteractionRead:
+		return "Read the current state of the resource."
+	case TypeRestfulInteractionVread:
+		return "Read the state of a specific version of the resource."
+	case TypeRestfulInteractionUpdate:
+		return "Update an existing resource by its id (or create it if it is new)."
+	case TypeRestfulInteractionPatch:
+		return "Update an existing resource by posting a set of changes to it."
+	case TypeRestfulInteractionDelete:
+		return "Delete a resource."
+	case TypeRestfulInteractionHistory:
+		return "Retrieve the change history for a particular resource, type of resource, or the entire system."
+	case TypeRestfulInteractionHistoryInstance:
+		return "Retrieve the change history for a particular resource."
+	case TypeRestfulInteractionHistoryType:
+		return "Retrieve the change history for all resources of a particular type."
+	case TypeRestfulInteractionHistorySystem:
+		return "Retrieve the change history for all resources on a system."
+	case TypeRestfulInteractionCreate:
+		return "Create a new resource with a server assigned id."
+	case TypeRestfulInteractionSearch:
+		return "Search a resource type or all resources based on some filter criteria."
+	case TypeRestfulInteractionSearchType:
+		return "Search all resources of the specified type based on some filter criteria."
+	case TypeRestfulInteractionSearchSystem:
+		return "Search all resources based on some filter criteria."
+	case TypeRestfulInteractionCapabilities:
+		return "Get a Capability Statement for the system."
+	case TypeRestfulInteractionTransaction:
+		return "Update, create or delete a set of resources as a single transaction."
+	case TypeRestfulInteractionBatch:
+		return "perform a set of a separate interactions in a single http operation"
+	case TypeRestfulInteractionOperation:
+		return "Perform an operation as defined by an OperationDefinition."
+	}
+	return "<unknown>"
+}
+func (code TypeRestfulInteraction) protoNumber() uint64 {
+	switch code {
+	case TypeRestfulInteractionRead:
+		return 1
+	case TypeRestfulInteractionVread:
+		return 2
+	case TypeRestfulInteractionUpdate:
+		return 3
+	case TypeRestfulInteractionPatch:
+		return 4
+	case TypeRestfulInteractionDelete:
+		return 5
+	case TypeRestfulInteractionHistory:
+		return 6
+	case TypeRestfulInteractionHistoryInstance:
+		return 7
+	case TypeRestfulInteractionHistoryType:
+		return 8
+	case TypeRestfulInteractionHistorySystem:
+		return 9
+	case TypeRestfulInteractionCreate:
+		return 10
+	case TypeRestfulInteractionSearch:
+		return 11
+	case TypeRestfulInteractionSearchType:
+		return 12
+	case TypeRestfulInteractionSearchSystem:
+		return 13
+	case TypeRestfulInteractionCapabilities:
+		return 14
+	case TypeRestfulInteractionTransaction:
+		return 15
+	case TypeRestfulInteractionBatch:
+		return 16
+	case TypeRestfulInteractionOperation:
+		return 17
+	}
+	return 0
+}
+func (code *TypeRestfulInteraction) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TypeRestfulInteractionRead
+	case 2:
+		*code = TypeRestfulInteractionVread
+	case 3:
+		*code = TypeRestfulInteractionUpdate
+	case 4:
+		*code = TypeRestfulInteractionPatch
+	case 5:
+		*code = TypeRestfulInteractionDelete
+	case 6:
+		*code = TypeRestfulInteractionHistory
+	case 7:
+		*code = TypeRestfulInteractionHistoryInstance
+	case 8:
+		*code = TypeRestfulInteractionHistoryType
+	case 9:
+		*code = TypeRestfulInteractionHistorySystem
+	case 10:
+		*code = TypeRestfulInteractionCreate
+	case 11:
+		*code = TypeRestfulInteractionSearch
+	case 12:
+		*code = TypeRestfulInteractionSearchType
+	case 13:
+		*code = TypeRestfulInteractionSearchSystem
+	case 14:
+		*code = TypeRestfulInteractionCapabilities
+	case 15:
+		*code = TypeRestfulInteractionTransaction
+	case 16:
+		*code = TypeRestfulInteractionBatch
+	case 17:
+		*code = TypeRestfulInteractionOperation
+	default:
+		return false
+	}
+	return true
+}
@@ -14,45 +14,66 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ValueSet is documented here http://hl7.org/fhir/StructureDefinition/ValueSet
 type ValueSet struct {
-	Id                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string            `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string            `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string            `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string            `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus  `bson:"status" json:"status"`
-	Experimental      *bool              `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string            `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string            `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string            `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext     `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Immutable         *bool              `bson:"immutable,omitempty" json:"immutable,omitempty"`
-	Purpose           *string            `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string            `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Compose           *ValueSetCompose   `bson:"compose,omitempty" json:"compose,omitempty"`
-	Expansion         *ValueSetExpansion `bson:"expansion,omitempty" json:"expansion,omitempty"`
+	Id                   *string            `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element           `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  *string            `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement           *Element           `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier           []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version              *string            `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element           `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 *string            `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element           `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string            `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element           `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus  `bson:"status" json:"status"`
+	StatusElement        *Element           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool              `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element           `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *string            `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element           `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string            `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element           `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail    `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element           `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext     `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Immutable            *bool              `bson:"immutable,omitempty" json:"immutable,omitempty"`
+	ImmutableElement     *Element           `bson:"_immutable,omitempty" json:"_immutable,omitempty"`
+	Purpose              *string            `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element           `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright            *string            `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element           `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Compose              *ValueSetCompose   `bson:"compose,omitempty" json:"compose,omitempty"`
+	Expansion            *ValueSetExpansion `bson:"expansion,omitempty" json:"expansion,omitempty"`
 }
 type ValueSetCompose struct {
 	Id                *string                  `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	LockedDate        *string                  `bson:"lockedDate,omitempty" json:"lockedDate,omitempty"`
+	LockedDateElement *Element                 `bson:"_lockedDate,omitempty" json:"_lockedDate,omitempty"`
 	Inactive          *bool                    `bson:"inactive,omitempty" json:"inactive,omitempty"`
+	InactiveElement   *Element                 `bson:"_inactive,omitempty" json:"_inactive,omitempty"`
 	Include           []ValueSetComposeInclude `bson:"include" json:"include"`
 	Exclude           []ValueSetComposeInclude `bson:"exclude,omitempty" json:"exclude,omitempty"`
 }
@@ -61,17 +82,22 @@ type ValueSetComposeInclude struct {
 	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	System            *string                         `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement     *Element                        `bson:"_system,omitempty" json:"_system,omitempty"`
 	Version           *string                         `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element                        `bson:"_version,omitempty" json:"_version,omitempty"`
 	Concept           []ValueSetComposeIncludeConcept `bson:"concept,omitempty" json:"concept,omitempty"`
 	Filter            []ValueSetComposeIncludeFilter  `bson:"filter,omitempty" json:"filter,omitempty"`
 	ValueSet          []string                        `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ValueSetElement   []*Element                      `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
 }
 type ValueSetComposeIncludeConcept struct {
 	Id                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              string                                     `bson:"code" json:"code"`
+	CodeElement       *Element                                   `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                                    `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                                   `bson:"_display,omitempty" json:"_display,omitempty"`
 	Designation       []ValueSetComposeIncludeConceptDesignation `bson:"designation,omitempty" json:"designation,omitempty"`
 }
 type ValueSetComposeIncludeConceptDesignation struct {
@@ -79,71 +105,6653 @@ type ValueSetComposeIncludeConceptDesignation struct {
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Language          *string     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement   *Element    `bson:"_language,omitempty" json:"_language,omitempty"`
 	Use               *Coding     `bson:"use,omitempty" json:"use,omitempty"`
 	Value             string      `bson:"value" json:"value"`
+	ValueElement      *Element    `bson:"_value,omitempty" json:"_value,omitempty"`
 }
 type ValueSetComposeIncludeFilter struct {
 	Id                *string        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Property          string         `bson:"property" json:"property"`
+	PropertyElement   *Element       `bson:"_property,omitempty" json:"_property,omitempty"`
 	Op                FilterOperator `bson:"op" json:"op"`
+	OpElement         *Element       `bson:"_op,omitempty" json:"_op,omitempty"`
 	Value             string         `bson:"value" json:"value"`
+	ValueElement      *Element       `bson:"_value,omitempty" json:"_value,omitempty"`
 }
 type ValueSetExpansion struct {
 	Id                *string                      `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier        *string                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	IdentifierElement *Element                     `bson:"_identifier,omitempty" json:"_identifier,omitempty"`
 	Timestamp         string                       `bson:"timestamp" json:"timestamp"`
+	TimestampElement  *Element                     `bson:"_timestamp,omitempty" json:"_timestamp,omitempty"`
 	Total             *int                         `bson:"total,omitempty" json:"total,omitempty"`
+	TotalElement      *Element                     `bson:"_total,omitempty" json:"_total,omitempty"`
 	Offset            *int                         `bson:"offset,omitempty" json:"offset,omitempty"`
+	OffsetElement     *Element                     `bson:"_offset,omitempty" json:"_offset,omitempty"`
 	Parameter         []ValueSetExpansionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
 	Contains          []ValueSetExpansionContains  `bson:"contains,omitempty" json:"contains,omitempty"`
 }
 type ValueSetExpansionParameter struct {
-	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string       `bson:"name" json:"name"`
-	ValueString       *string      `bson:"valueString,omitempty" json:"valueString,omitempty"`
-	ValueBoolean      *bool        `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
-	ValueInteger      *int         `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
-	ValueDecimal      *json.Number `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
-	ValueUri          *string      `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
-	ValueCode         *string      `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
-	ValueDateTime     *string      `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	Id                   *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string       `bson:"name" json:"name"`
+	NameElement          *Element     `bson:"_name,omitempty" json:"_name,omitempty"`
+	ValueString          *string      `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element     `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueBoolean         *bool        `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element     `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueInteger         *int         `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element     `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDecimal         *json.Number `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *Element     `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueUri             *string      `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement      *Element     `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueCode            *string      `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement     *Element     `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDateTime        *string      `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element     `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
 }
+
+// ValueSetExpansionParameterValue is implemented by the types allowed for ValueSet.expansion.parameter.value[x]
+type ValueSetExpansionParameterValue interface {
+	isValueSetExpansionParameterValue()
+}
+
+func (String) isValueSetExpansionParameterValue()   {}
+func (Boolean) isValueSetExpansionParameterValue()  {}
+func (Integer) isValueSetExpansionParameterValue()  {}
+func (Decimal) isValueSetExpansionParameterValue()  {}
+func (Uri) isValueSetExpansionParameterValue()      {}
+func (Code) isValueSetExpansionParameterValue()     {}
+func (DateTime) isValueSetExpansionParameterValue() {}
+
+// Value returns the value of ValueSet.expansion.parameter.value[x] together with its FHIR type code or nil if no value is set
+func (r ValueSetExpansionParameter) Value() (ValueSetExpansionParameterValue, string) {
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueDecimal != nil {
+		return Decimal(*r.ValueDecimal), "decimal"
+	}
+	if r.ValueUri != nil {
+		return Uri(*r.ValueUri), "uri"
+	}
+	if r.ValueCode != nil {
+		return Code(*r.ValueCode), "code"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of ValueSet.expansion.parameter.value[x], given as value or pointer, and clears all other types
+func (r *ValueSetExpansionParameter) SetValue(value ValueSetExpansionParameterValue) {
+	r.ValueString = nil
+	r.ValueBoolean = nil
+	r.ValueInteger = nil
+	r.ValueDecimal = nil
+	r.ValueUri = nil
+	r.ValueCode = nil
+	r.ValueDateTime = nil
+	switch v := value.(type) {
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.ValueDecimal = &primitive
+	case *Decimal:
+		r.ValueDecimal = (*json.Number)(v)
+	case Uri:
+		primitive := string(v)
+		r.ValueUri = &primitive
+	case *Uri:
+		r.ValueUri = (*string)(v)
+	case Code:
+		primitive := string(v)
+		r.ValueCode = &primitive
+	case *Code:
+		r.ValueCode = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	}
+}
+
 type ValueSetExpansionContains struct {
 	Id                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	System            *string                                    `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement     *Element                                   `bson:"_system,omitempty" json:"_system,omitempty"`
 	Abstract          *bool                                      `bson:"abstract,omitempty" json:"abstract,omitempty"`
+	AbstractElement   *Element                                   `bson:"_abstract,omitempty" json:"_abstract,omitempty"`
 	Inactive          *bool                                      `bson:"inactive,omitempty" json:"inactive,omitempty"`
+	InactiveElement   *Element                                   `bson:"_inactive,omitempty" json:"_inactive,omitempty"`
 	Version           *string                                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element                                   `bson:"_version,omitempty" json:"_version,omitempty"`
 	Code              *string                                    `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement       *Element                                   `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                                    `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                                   `bson:"_display,omitempty" json:"_display,omitempty"`
 	Designation       []ValueSetComposeIncludeConceptDesignation `bson:"designation,omitempty" json:"designation,omitempty"`
 	Contains          []ValueSetExpansionContains                `bson:"contains,omitempty" json:"contains,omitempty"`
 }
-type OtherValueSet ValueSet
 
 // MarshalJSON marshals the given ValueSet as JSON into a byte slice
 func (r ValueSet) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherValueSet
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherValueSet: OtherValueSet(r),
-		ResourceType:  "ValueSet",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ValueSet as JSON object with the members in the order of the fields
+func (r ValueSet) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ValueSet\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Immutable != nil {
+		e.key("immutable")
+		e.bool(*r.Immutable)
+	}
+	if r.ImmutableElement != nil {
+		e.key("_immutable")
+		r.ImmutableElement.appendJSON(e)
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.Compose != nil {
+		e.key("compose")
+		r.Compose.appendJSON(e)
+	}
+	if r.Expansion != nil {
+		e.key("expansion")
+		r.Expansion.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ValueSet from JSON. Unknown members are ignored.
+func (r *ValueSet) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ValueSet
+func (r *ValueSet) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "immutable":
+			if v, ok := d.bool(); ok {
+				r.Immutable = &v
+			} else {
+				r.Immutable = nil
+			}
+		case "_immutable":
+			r.ImmutableElement = d.element()
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "compose":
+			if d.null() {
+				r.Compose = nil
+			} else {
+				var v ValueSetCompose
+				v.decodeJSON(d)
+				r.Compose = &v
+			}
+		case "expansion":
+			if d.null() {
+				r.Expansion = nil
+			} else {
+				var v ValueSetExpansion
+				v.decodeJSON(d)
+				r.Expansion = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ValueSet which shares no memory with the original
+func (r ValueSet) DeepCopy() ValueSet {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Immutable != nil {
+		v := *r.Immutable
+		out.Immutable = &v
+	}
+	if r.ImmutableElement != nil {
+		v := r.ImmutableElement.DeepCopy()
+		out.ImmutableElement = &v
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.Compose != nil {
+		v := r.Compose.DeepCopy()
+		out.Compose = &v
+	}
+	if r.Expansion != nil {
+		v := r.Expansion.DeepCopy()
+		out.Expansion = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ValueSet) Equal(other ValueSet) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Immutable == nil) != (other.Immutable == nil) || r.Immutable != nil && *r.Immutable != *other.Immutable {
+		return false
+	}
+	if (r.ImmutableElement == nil) != (other.ImmutableElement == nil) || r.ImmutableElement != nil && !r.ImmutableElement.Equal(*other.ImmutableElement) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.Compose == nil) != (other.Compose == nil) || r.Compose != nil && !r.Compose.Equal(*other.Compose) {
+		return false
+	}
+	if (r.Expansion == nil) != (other.Expansion == nil) || r.Expansion != nil && !r.Expansion.Equal(*other.Expansion) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ValueSet) EqualsDeep(other ValueSet) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Immutable == nil) != (other.Immutable == nil) || r.Immutable != nil && *r.Immutable != *other.Immutable {
+		return false
+	}
+	if (r.ImmutableElement == nil) != (other.ImmutableElement == nil) || r.ImmutableElement != nil && !r.ImmutableElement.EqualsDeep(*other.ImmutableElement) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.Compose == nil) != (other.Compose == nil) || r.Compose != nil && !r.Compose.EqualsDeep(*other.Compose) {
+		return false
+	}
+	if (r.Expansion == nil) != (other.Expansion == nil) || r.Expansion != nil && !r.Expansion.EqualsDeep(*other.Expansion) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ValueSet) EqualsShallow(other ValueSet) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Immutable == nil) != (other.Immutable == nil) || r.Immutable != nil && *r.Immutable != *other.Immutable {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ValueSet as FHIR XML
+func (r ValueSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ValueSet",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "immutable", r.Immutable, r.ImmutableElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "compose", r.Compose); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "expansion", r.Expansion); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ValueSet from FHIR XML
+func (r *ValueSet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "immutable":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Immutable = &v
+				}
+				r.ImmutableElement = element
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "compose":
+				var v ValueSetCompose
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Compose = &v
+			case "expansion":
+				var v ValueSetExpansion
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Expansion = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ValueSet as properties to the RDF node n
+func (r ValueSet) turtle(n *turtleNode) {
+	n.resource("ValueSet", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("ValueSet.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("ValueSet.identifier", i, v)
+	}
+	n.primitive("ValueSet.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("ValueSet.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ValueSet.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("ValueSet.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("ValueSet.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("ValueSet.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("ValueSet.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("ValueSet.contact", i, v)
+	}
+	n.primitive("ValueSet.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("ValueSet.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("ValueSet.jurisdiction", i, v)
+	}
+	n.primitive("ValueSet.immutable", -1, r.Immutable, r.ImmutableElement, "boolean")
+	n.primitive("ValueSet.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("ValueSet.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.element("ValueSet.compose", -1, r.Compose)
+	n.element("ValueSet.expansion", -1, r.Expansion)
+}
+
+// MarshalBSON marshals the given ValueSet as BSON document with the structure of its FHIR JSON
+func (r ValueSet) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ValueSet from a BSON document with the structure of its FHIR JSON
+func (r *ValueSet) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ValueSet as protobuf message ValueSet of fhir.proto
+func (r ValueSet) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ValueSet to the encoded message
+func (r ValueSet) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	e.varint(14, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(15, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(16, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(17, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(18, v)
+	}
+	if r.Description != nil {
+		e.string(19, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(20, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(21, v)
+	}
+	if r.Immutable != nil {
+		e.varint(22, protoBool(*r.Immutable))
+	}
+	if r.Purpose != nil {
+		e.string(23, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(24, *r.Copyright)
+	}
+	if r.Compose != nil {
+		e.message(25, *r.Compose)
+	}
+	if r.Expansion != nil {
+		e.message(26, *r.Expansion)
+	}
+	if r.IdElement != nil {
+		e.message(27, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(28, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(29, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(30, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(31, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(32, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(33, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(34, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(35, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(36, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(37, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(38, *r.DescriptionElement)
+	}
+	if r.ImmutableElement != nil {
+		e.message(39, *r.ImmutableElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(40, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(41, *r.CopyrightElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ValueSet from protobuf message ValueSet of fhir.proto
+func (r *ValueSet) UnmarshalProto(b []byte) error {
+	*r = ValueSet{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 15:
+			v := d.bool()
+			r.Experimental = &v
+		case 16:
+			v := d.string()
+			r.Date = &v
+		case 17:
+			v := d.string()
+			r.Publisher = &v
+		case 18:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 19:
+			v := d.string()
+			r.Description = &v
+		case 20:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 22:
+			v := d.bool()
+			r.Immutable = &v
+		case 23:
+			v := d.string()
+			r.Purpose = &v
+		case 24:
+			v := d.string()
+			r.Copyright = &v
+		case 25:
+			var v ValueSetCompose
+			d.message(&v)
+			r.Compose = &v
+		case 26:
+			var v ValueSetExpansion
+			d.message(&v)
+			r.Expansion = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.ImmutableElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ValueSetCompose as JSON into a byte slice
+func (r ValueSetCompose) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ValueSetCompose as JSON object with the members in the order of the fields
+func (r ValueSetCompose) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.LockedDate != nil {
+		e.key("lockedDate")
+		e.string(*r.LockedDate)
+	}
+	if r.LockedDateElement != nil {
+		e.key("_lockedDate")
+		r.LockedDateElement.appendJSON(e)
+	}
+	if r.Inactive != nil {
+		e.key("inactive")
+		e.bool(*r.Inactive)
+	}
+	if r.InactiveElement != nil {
+		e.key("_inactive")
+		r.InactiveElement.appendJSON(e)
+	}
+	e.key("include")
+	if r.Include == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Include {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Exclude) > 0 {
+		e.key("exclude")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Exclude {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ValueSetCompose from JSON. Unknown members are ignored.
+func (r *ValueSetCompose) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ValueSetCompose
+func (r *ValueSetCompose) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "lockedDate":
+			if v, ok := d.string(); ok {
+				r.LockedDate = &v
+			} else {
+				r.LockedDate = nil
+			}
+		case "_lockedDate":
+			r.LockedDateElement = d.element()
+		case "inactive":
+			if v, ok := d.bool(); ok {
+				r.Inactive = &v
+			} else {
+				r.Inactive = nil
+			}
+		case "_inactive":
+			r.InactiveElement = d.element()
+		case "include":
+			a := d.array()
+			r.Include = nil
+			for a.next() {
+				var v ValueSetComposeInclude
+				v.decodeJSON(d)
+				r.Include = append(r.Include, v)
+			}
+			if r.Include == nil && !a.isNull {
+				r.Include = []ValueSetComposeInclude{}
+			}
+		case "exclude":
+			a := d.array()
+			r.Exclude = nil
+			for a.next() {
+				var v ValueSetComposeInclude
+				v.decodeJSON(d)
+				r.Exclude = append(r.Exclude, v)
+			}
+			if r.Exclude == nil && !a.isNull {
+				r.Exclude = []ValueSetComposeInclude{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ValueSetCompose which shares no memory with the original
+func (r ValueSetCompose) DeepCopy() ValueSetCompose {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.LockedDate != nil {
+		v := *r.LockedDate
+		out.LockedDate = &v
+	}
+	if r.LockedDateElement != nil {
+		v := r.LockedDateElement.DeepCopy()
+		out.LockedDateElement = &v
+	}
+	if r.Inactive != nil {
+		v := *r.Inactive
+		out.Inactive = &v
+	}
+	if r.InactiveElement != nil {
+		v := r.InactiveElement.DeepCopy()
+		out.InactiveElement = &v
+	}
+	if r.Include != nil {
+		out.Include = make([]ValueSetComposeInclude, len(r.Include))
+		for i := range r.Include {
+			out.Include[i] = r.Include[i].DeepCopy()
+		}
+	}
+	if r.Exclude != nil {
+		out.Exclude = make([]ValueSetComposeInclude, len(r.Exclude))
+		for i := range r.Exclude {
+			out.Exclude[i] = r.Exclude[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ValueSetCompose) Equal(other ValueSetCompose) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.LockedDate == nil) != (other.LockedDate == nil) || r.LockedDate != nil && *r.LockedDate != *other.LockedDate {
+		return false
+	}
+	if (r.LockedDateElement == nil) != (other.LockedDateElement == nil) || r.LockedDateElement != nil && !r.LockedDateElement.Equal(*other.LockedDateElement) {
+		return false
+	}
+	if (r.Inactive == nil) != (other.Inactive == nil) || r.Inactive != nil && *r.Inactive != *other.Inactive {
+		return false
+	}
+	if (r.InactiveElement == nil) != (other.InactiveElement == nil) || r.InactiveElement != nil && !r.InactiveElement.Equal(*other.InactiveElement) {
+		return false
+	}
+	if len(r.Include) != len(other.Include) {
+		return false
+	}
+	for i := range r.Include {
+		if !r.Include[i].Equal(other.Include[i]) {
+			return false
+		}
+	}
+	if len(r.Exclude) != len(other.Exclude) {
+		return false
+	}
+	for i := range r.Exclude {
+		if !r.Exclude[i].Equal(other.Exclude[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ValueSetCompose) EqualsDeep(other ValueSetCompose) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.LockedDate == nil) != (other.LockedDate == nil) || r.LockedDate != nil && !equivalentString(*r.LockedDate, *other.LockedDate) {
+		return false
+	}
+	if (r.LockedDateElement == nil) != (other.LockedDateElement == nil) || r.LockedDateElement != nil && !r.LockedDateElement.EqualsDeep(*other.LockedDateElement) {
+		return false
+	}
+	if (r.Inactive == nil) != (other.Inactive == nil) || r.Inactive != nil && *r.Inactive != *other.Inactive {
+		return false
+	}
+	if (r.InactiveElement == nil) != (other.InactiveElement == nil) || r.InactiveElement != nil && !r.InactiveElement.EqualsDeep(*other.InactiveElement) {
+		return false
+	}
+	if !equivalentList(len(r.Include), len(other.Include), func(i, j int) bool {
+		return r.Include[i].EqualsDeep(other.Include[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Exclude), len(other.Exclude), func(i, j int) bool {
+		return r.Exclude[i].EqualsDeep(other.Exclude[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ValueSetCompose) EqualsShallow(other ValueSetCompose) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.LockedDate == nil) != (other.LockedDate == nil) || r.LockedDate != nil && !equivalentString(*r.LockedDate, *other.LockedDate) {
+		return false
+	}
+	if (r.Inactive == nil) != (other.Inactive == nil) || r.Inactive != nil && *r.Inactive != *other.Inactive {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ValueSetCompose as FHIR XML
+func (r ValueSetCompose) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "lockedDate", r.LockedDate, r.LockedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "inactive", r.Inactive, r.InactiveElement); err != nil {
+		return err
+	}
+	for _, v := range r.Include {
+		if err := encodeXMLElement(e, "include", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Exclude {
+		if err := encodeXMLElement(e, "exclude", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ValueSetCompose from FHIR XML
+func (r *ValueSetCompose) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "lockedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LockedDate = &v
+				}
+				r.LockedDateElement = element
+			case "inactive":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Inactive = &v
+				}
+				r.InactiveElement = element
+			case "include":
+				var v ValueSetComposeInclude
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Include = append(r.Include, v)
+			case "exclude":
+				var v ValueSetComposeInclude
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Exclude = append(r.Exclude, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ValueSetCompose as properties to the RDF node n
+func (r ValueSetCompose) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ValueSet.compose.lockedDate", -1, r.LockedDate, r.LockedDateElement, "date")
+	n.primitive("ValueSet.compose.inactive", -1, r.Inactive, r.InactiveElement, "boolean")
+	for i, v := range r.Include {
+		n.element("ValueSet.compose.include", i, v)
+	}
+	for i, v := range r.Exclude {
+		n.element("ValueSet.compose.exclude", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ValueSetCompose as BSON document with the structure of its FHIR JSON
+func (r ValueSetCompose) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ValueSetCompose from a BSON document with the structure of its FHIR JSON
+func (r *ValueSetCompose) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ValueSetCompose as protobuf message ValueSetCompose of fhir.proto
+func (r ValueSetCompose) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ValueSetCompose to the encoded message
+func (r ValueSetCompose) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.LockedDate != nil {
+		e.string(4, *r.LockedDate)
+	}
+	if r.Inactive != nil {
+		e.varint(5, protoBool(*r.Inactive))
+	}
+	for _, v := range r.Include {
+		e.message(6, v)
+	}
+	for _, v := range r.Exclude {
+		e.message(7, v)
+	}
+	if r.LockedDateElement != nil {
+		e.message(8, *r.LockedDateElement)
+	}
+	if r.InactiveElement != nil {
+		e.message(9, *r.InactiveElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ValueSetCompose from protobuf message ValueSetCompose of fhir.proto
+func (r *ValueSetCompose) UnmarshalProto(b []byte) error {
+	*r = ValueSetCompose{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.LockedDate = &v
+		case 5:
+			v := d.bool()
+			r.Inactive = &v
+		case 6:
+			var v ValueSetComposeInclude
+			d.message(&v)
+			r.Include = append(r.Include, v)
+		case 7:
+			var v ValueSetComposeInclude
+			d.message(&v)
+			r.Exclude = append(r.Exclude, v)
+		case 8:
+			var v Element
+			d.message(&v)
+			r.LockedDateElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.InactiveElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ValueSetComposeInclude as JSON into a byte slice
+func (r ValueSetComposeInclude) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ValueSetComposeInclude as JSON object with the members in the order of the fields
+func (r ValueSetComposeInclude) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.System != nil {
+		e.key("system")
+		e.string(*r.System)
+	}
+	if r.SystemElement != nil {
+		e.key("_system")
+		r.SystemElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if len(r.Concept) > 0 {
+		e.key("concept")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Concept {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Filter) > 0 {
+		e.key("filter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Filter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ValueSet) > 0 {
+		e.key("valueSet")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ValueSet {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ValueSetElement) > 0 {
+		e.key("_valueSet")
+		e.elements(r.ValueSetElement, len(r.ValueSet))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ValueSetComposeInclude from JSON. Unknown members are ignored.
+func (r *ValueSetComposeInclude) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ValueSetComposeInclude
+func (r *ValueSetComposeInclude) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "system":
+			if v, ok := d.string(); ok {
+				r.System = &v
+			} else {
+				r.System = nil
+			}
+		case "_system":
+			r.SystemElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "concept":
+			a := d.array()
+			r.Concept = nil
+			for a.next() {
+				var v ValueSetComposeIncludeConcept
+				v.decodeJSON(d)
+				r.Concept = append(r.Concept, v)
+			}
+			if r.Concept == nil && !a.isNull {
+				r.Concept = []ValueSetComposeIncludeConcept{}
+			}
+		case "filter":
+			a := d.array()
+			r.Filter = nil
+			for a.next() {
+				var v ValueSetComposeIncludeFilter
+				v.decodeJSON(d)
+				r.Filter = append(r.Filter, v)
+			}
+			if r.Filter == nil && !a.isNull {
+				r.Filter = []ValueSetComposeIncludeFilter{}
+			}
+		case "valueSet":
+			a := d.array()
+			r.ValueSet = nil
+			for a.next() {
+				v, _ := d.string()
+				r.ValueSet = append(r.ValueSet, v)
+			}
+			if r.ValueSet == nil && !a.isNull {
+				r.ValueSet = []string{}
+			}
+		case "_valueSet":
+			a := d.array()
+			r.ValueSetElement = nil
+			for a.next() {
+				r.ValueSetElement = append(r.ValueSetElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ValueSetComposeInclude which shares no memory with the original
+func (r ValueSetComposeInclude) DeepCopy() ValueSetComposeInclude {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.System != nil {
+		v := *r.System
+		out.System = &v
+	}
+	if r.SystemElement != nil {
+		v := r.SystemElement.DeepCopy()
+		out.SystemElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Concept != nil {
+		out.Concept = make([]ValueSetComposeIncludeConcept, len(r.Concept))
+		for i := range r.Concept {
+			out.Concept[i] = r.Concept[i].DeepCopy()
+		}
+	}
+	if r.Filter != nil {
+		out.Filter = make([]ValueSetComposeIncludeFilter, len(r.Filter))
+		for i := range r.Filter {
+			out.Filter[i] = r.Filter[i].DeepCopy()
+		}
+	}
+	if r.ValueSet != nil {
+		out.ValueSet = make([]string, len(r.ValueSet))
+		copy(out.ValueSet, r.ValueSet)
+	}
+	if r.ValueSetElement != nil {
+		out.ValueSetElement = make([]*Element, len(r.ValueSetElement))
+		for i, v := range r.ValueSetElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ValueSetElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ValueSetComposeInclude) Equal(other ValueSetComposeInclude) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && *r.System != *other.System {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.Equal(*other.SystemElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if len(r.Concept) != len(other.Concept) {
+		return false
+	}
+	for i := range r.Concept {
+		if !r.Concept[i].Equal(other.Concept[i]) {
+			return false
+		}
+	}
+	if len(r.Filter) != len(other.Filter) {
+		return false
+	}
+	for i := range r.Filter {
+		if !r.Filter[i].Equal(other.Filter[i]) {
+			return false
+		}
+	}
+	if len(r.ValueSet) != len(other.ValueSet) {
+		return false
+	}
+	for i := range r.ValueSet {
+		if r.ValueSet[i] != other.ValueSet[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ValueSetElement, other.ValueSetElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ValueSetComposeInclude) EqualsDeep(other ValueSetComposeInclude) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.EqualsDeep(*other.SystemElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Concept), len(other.Concept), func(i, j int) bool {
+		return r.Concept[i].EqualsDeep(other.Concept[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Filter), len(other.Filter), func(i, j int) bool {
+		return r.Filter[i].EqualsDeep(other.Filter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ValueSet), len(other.ValueSet), func(i, j int) bool {
+		return equivalentString(r.ValueSet[i], other.ValueSet[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ValueSetElement, other.ValueSetElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ValueSetComposeInclude) EqualsShallow(other ValueSetComposeInclude) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentList(len(r.ValueSet), len(other.ValueSet), func(i, j int) bool {
+		return equivalentString(r.ValueSet[i], other.ValueSet[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ValueSetComposeInclude as FHIR XML
+func (r ValueSetComposeInclude) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "system", r.System, r.SystemElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Concept {
+		if err := encodeXMLElement(e, "concept", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Filter {
+		if err := encodeXMLElement(e, "filter", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ValueSet {
+		if err := encodeXMLPrimitive(e, "valueSet", v, elementAt(r.ValueSetElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ValueSetComposeInclude from FHIR XML
+func (r *ValueSetComposeInclude) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "system":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.System = &v
+				}
+				r.SystemElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "concept":
+				var v ValueSetComposeIncludeConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Concept = append(r.Concept, v)
+			case "filter":
+				var v ValueSetComposeIncludeFilter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Filter = append(r.Filter, v)
+			case "valueSet":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ValueSetElement = appendElement(r.ValueSetElement, len(r.ValueSet), element)
+					r.ValueSet = append(r.ValueSet, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ValueSetComposeInclude as properties to the RDF node n
+func (r ValueSetComposeInclude) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ValueSet.compose.include.system", -1, r.System, r.SystemElement, "string")
+	n.primitive("ValueSet.compose.include.version", -1, r.Version, r.VersionElement, "string")
+	for i, v := range r.Concept {
+		n.element("ValueSet.compose.include.concept", i, v)
+	}
+	for i, v := range r.Filter {
+		n.element("ValueSet.compose.include.filter", i, v)
+	}
+	for i, v := range r.ValueSet {
+		n.primitive("ValueSet.compose.include.valueSet", i, v, elementAt(r.ValueSetElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given ValueSetComposeInclude as BSON document with the structure of its FHIR JSON
+func (r ValueSetComposeInclude) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ValueSetComposeInclude from a BSON document with the structure of its FHIR JSON
+func (r *ValueSetComposeInclude) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ValueSetComposeInclude as protobuf message ValueSetComposeInclude of fhir.proto
+func (r ValueSetComposeInclude) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ValueSetComposeInclude to the encoded message
+func (r ValueSetComposeInclude) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.System != nil {
+		e.string(4, *r.System)
+	}
+	if r.Version != nil {
+		e.string(5, *r.Version)
+	}
+	for _, v := range r.Concept {
+		e.message(6, v)
+	}
+	for _, v := range r.Filter {
+		e.message(7, v)
+	}
+	for _, v := range r.ValueSet {
+		e.string(8, v)
+	}
+	if r.SystemElement != nil {
+		e.message(9, *r.SystemElement)
+	}
+	if r.VersionElement != nil {
+		e.message(10, *r.VersionElement)
+	}
+	for _, v := range r.ValueSetElement {
+		e.element(11, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ValueSetComposeInclude from protobuf message ValueSetComposeInclude of fhir.proto
+func (r *ValueSetComposeInclude) UnmarshalProto(b []byte) error {
+	*r = ValueSetComposeInclude{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.System = &v
+		case 5:
+			v := d.string()
+			r.Version = &v
+		case 6:
+			var v ValueSetComposeIncludeConcept
+			d.message(&v)
+			r.Concept = append(r.Concept, v)
+		case 7:
+			var v ValueSetComposeIncludeFilter
+			d.message(&v)
+			r.Filter = append(r.Filter, v)
+		case 8:
+			r.ValueSet = append(r.ValueSet, d.string())
+		case 9:
+			var v Element
+			d.message(&v)
+			r.SystemElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 11:
+			r.ValueSetElement = append(r.ValueSetElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ValueSetComposeIncludeConcept as JSON into a byte slice
+func (r ValueSetComposeIncludeConcept) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ValueSetComposeIncludeConcept as JSON object with the members in the order of the fields
+func (r ValueSetComposeIncludeConcept) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	if len(r.Designation) > 0 {
+		e.key("designation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Designation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ValueSetComposeIncludeConcept from JSON. Unknown members are ignored.
+func (r *ValueSetComposeIncludeConcept) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ValueSetComposeIncludeConcept
+func (r *ValueSetComposeIncludeConcept) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		case "designation":
+			a := d.array()
+			r.Designation = nil
+			for a.next() {
+				var v ValueSetComposeIncludeConceptDesignation
+				v.decodeJSON(d)
+				r.Designation = append(r.Designation, v)
+			}
+			if r.Designation == nil && !a.isNull {
+				r.Designation = []ValueSetComposeIncludeConceptDesignation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ValueSetComposeIncludeConcept which shares no memory with the original
+func (r ValueSetComposeIncludeConcept) DeepCopy() ValueSetComposeIncludeConcept {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	if r.Designation != nil {
+		out.Designation = make([]ValueSetComposeIncludeConceptDesignation, len(r.Designation))
+		for i := range r.Designation {
+			out.Designation[i] = r.Designation[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ValueSetComposeIncludeConcept) Equal(other ValueSetComposeIncludeConcept) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	if len(r.Designation) != len(other.Designation) {
+		return false
+	}
+	for i := range r.Designation {
+		if !r.Designation[i].Equal(other.Designation[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ValueSetComposeIncludeConcept) EqualsDeep(other ValueSetComposeIncludeConcept) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	if !equivalentList(len(r.Designation), len(other.Designation), func(i, j int) bool {
+		return r.Designation[i].EqualsDeep(other.Designation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ValueSetComposeIncludeConcept) EqualsShallow(other ValueSetComposeIncludeConcept) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ValueSetComposeIncludeConcept as FHIR XML
+func (r ValueSetComposeIncludeConcept) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	for _, v := range r.Designation {
+		if err := encodeXMLElement(e, "designation", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ValueSetComposeIncludeConcept from FHIR XML
+func (r *ValueSetComposeIncludeConcept) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			case "designation":
+				var v ValueSetComposeIncludeConceptDesignation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Designation = append(r.Designation, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ValueSetComposeIncludeConcept as properties to the RDF node n
+func (r ValueSetComposeIncludeConcept) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ValueSet.compose.include.concept.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("ValueSet.compose.include.concept.display", -1, r.Display, r.DisplayElement, "string")
+	for i, v := range r.Designation {
+		n.element("ValueSet.compose.include.concept.designation", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ValueSetComposeIncludeConcept as BSON document with the structure of its FHIR JSON
+func (r ValueSetComposeIncludeConcept) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ValueSetComposeIncludeConcept from a BSON document with the structure of its FHIR JSON
+func (r *ValueSetComposeIncludeConcept) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ValueSetComposeIncludeConcept as protobuf message ValueSetComposeIncludeConcept of fhir.proto
+func (r ValueSetComposeIncludeConcept) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ValueSetComposeIncludeConcept to the encoded message
+func (r ValueSetComposeIncludeConcept) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != "" {
+		e.string(4, r.Code)
+	}
+	if r.Display != nil {
+		e.string(5, *r.Display)
+	}
+	for _, v := range r.Designation {
+		e.message(6, v)
+	}
+	if r.CodeElement != nil {
+		e.message(7, *r.CodeElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(8, *r.DisplayElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ValueSetComposeIncludeConcept from protobuf message ValueSetComposeIncludeConcept of fhir.proto
+func (r *ValueSetComposeIncludeConcept) UnmarshalProto(b []byte) error {
+	*r = ValueSetComposeIncludeConcept{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Code = d.string()
+		case 5:
+			v := d.string()
+			r.Display = &v
+		case 6:
+			var v ValueSetComposeIncludeConceptDesignation
+			d.message(&v)
+			r.Designation = append(r.Designation, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ValueSetComposeIncludeConceptDesignation as JSON into a byte slice
+func (r ValueSetComposeIncludeConceptDesignation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ValueSetComposeIncludeConceptDesignation as JSON object with the members in the order of the fields
+func (r ValueSetComposeIncludeConceptDesignation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Use != nil {
+		e.key("use")
+		r.Use.appendJSON(e)
+	}
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ValueSetComposeIncludeConceptDesignation from JSON. Unknown members are ignored.
+func (r *ValueSetComposeIncludeConceptDesignation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ValueSetComposeIncludeConceptDesignation
+func (r *ValueSetComposeIncludeConceptDesignation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "use":
+			if d.null() {
+				r.Use = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Use = &v
+			}
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ValueSetComposeIncludeConceptDesignation which shares no memory with the original
+func (r ValueSetComposeIncludeConceptDesignation) DeepCopy() ValueSetComposeIncludeConceptDesignation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Use != nil {
+		v := r.Use.DeepCopy()
+		out.Use = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ValueSetComposeIncludeConceptDesignation) Equal(other ValueSetComposeIncludeConceptDesignation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && !r.Use.Equal(*other.Use) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ValueSetComposeIncludeConceptDesignation) EqualsDeep(other ValueSetComposeIncludeConceptDesignation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && !r.Use.EqualsDeep(*other.Use) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ValueSetComposeIncludeConceptDesignation) EqualsShallow(other ValueSetComposeIncludeConceptDesignation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ValueSetComposeIncludeConceptDesignation as FHIR XML
+func (r ValueSetComposeIncludeConceptDesignation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "use", r.Use); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ValueSetComposeIncludeConceptDesignation from FHIR XML
+func (r *ValueSetComposeIncludeConceptDesignation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "use":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Use = &v
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ValueSetComposeIncludeConceptDesignation as properties to the RDF node n
+func (r ValueSetComposeIncludeConceptDesignation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ValueSet.compose.include.concept.designation.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("ValueSet.compose.include.concept.designation.use", -1, r.Use)
+	n.primitive("ValueSet.compose.include.concept.designation.value", -1, r.Value, r.ValueElement, "string")
+}
+
+// MarshalBSON marshals the given ValueSetComposeIncludeConceptDesignation as BSON document with the structure of its FHIR JSON
+func (r ValueSetComposeIncludeConceptDesignation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ValueSetComposeIncludeConceptDesignation from a BSON document with the structure of its FHIR JSON
+func (r *ValueSetComposeIncludeConceptDesignation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ValueSetComposeIncludeConceptDesignation as protobuf message ValueSetComposeIncludeConceptDesignation of fhir.proto
+func (r ValueSetComposeIncludeConceptDesignation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ValueSetComposeIncludeConceptDesignation to the encoded message
+func (r ValueSetComposeIncludeConceptDesignation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Use != nil {
+		e.message(5, *r.Use)
+	}
+	if r.Value != "" {
+		e.string(6, r.Value)
+	}
+	if r.LanguageElement != nil {
+		e.message(7, *r.LanguageElement)
+	}
+	if r.ValueElement != nil {
+		e.message(8, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ValueSetComposeIncludeConceptDesignation from protobuf message ValueSetComposeIncludeConceptDesignation of fhir.proto
+func (r *ValueSetComposeIncludeConceptDesignation) UnmarshalProto(b []byte) error {
+	*r = ValueSetComposeIncludeConceptDesignation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Coding
+			d.message(&v)
+			r.Use = &v
+		case 6:
+			r.Value = d.string()
+		case 7:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ValueSetComposeIncludeFilter as JSON into a byte slice
+func (r ValueSetComposeIncludeFilter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ValueSetComposeIncludeFilter as JSON object with the members in the order of the fields
+func (r ValueSetComposeIncludeFilter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("property")
+	e.string(r.Property)
+	if r.PropertyElement != nil {
+		e.key("_property")
+		r.PropertyElement.appendJSON(e)
+	}
+	e.key("op")
+	e.string(r.Op.Code())
+	if r.OpElement != nil {
+		e.key("_op")
+		r.OpElement.appendJSON(e)
+	}
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ValueSetComposeIncludeFilter from JSON. Unknown members are ignored.
+func (r *ValueSetComposeIncludeFilter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ValueSetComposeIncludeFilter
+func (r *ValueSetComposeIncludeFilter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "property":
+			if v, ok := d.string(); ok {
+				r.Property = v
+			}
+		case "_property":
+			r.PropertyElement = d.element()
+		case "op":
+			d.unmarshal(&r.Op)
+		case "_op":
+			r.OpElement = d.element()
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ValueSetComposeIncludeFilter which shares no memory with the original
+func (r ValueSetComposeIncludeFilter) DeepCopy() ValueSetComposeIncludeFilter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.PropertyElement != nil {
+		v := r.PropertyElement.DeepCopy()
+		out.PropertyElement = &v
+	}
+	if r.OpElement != nil {
+		v := r.OpElement.DeepCopy()
+		out.OpElement = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ValueSetComposeIncludeFilter) Equal(other ValueSetComposeIncludeFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Property != other.Property {
+		return false
+	}
+	if (r.PropertyElement == nil) != (other.PropertyElement == nil) || r.PropertyElement != nil && !r.PropertyElement.Equal(*other.PropertyElement) {
+		return false
+	}
+	if r.Op != other.Op {
+		return false
+	}
+	if (r.OpElement == nil) != (other.OpElement == nil) || r.OpElement != nil && !r.OpElement.Equal(*other.OpElement) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ValueSetComposeIncludeFilter) EqualsDeep(other ValueSetComposeIncludeFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Property, other.Property) {
+		return false
+	}
+	if (r.PropertyElement == nil) != (other.PropertyElement == nil) || r.PropertyElement != nil && !r.PropertyElement.EqualsDeep(*other.PropertyElement) {
+		return false
+	}
+	if r.Op != other.Op {
+		return false
+	}
+	if (r.OpElement == nil) != (other.OpElement == nil) || r.OpElement != nil && !r.OpElement.EqualsDeep(*other.OpElement) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ValueSetComposeIncludeFilter) EqualsShallow(other ValueSetComposeIncludeFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Property, other.Property) {
+		return false
+	}
+	if r.Op != other.Op {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ValueSetComposeIncludeFilter as FHIR XML
+func (r ValueSetComposeIncludeFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "property", r.Property, r.PropertyElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "op", r.Op, r.OpElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ValueSetComposeIncludeFilter from FHIR XML
+func (r *ValueSetComposeIncludeFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "property":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Property = v
+				}
+				r.PropertyElement = element
+			case "op":
+				var v FilterOperator
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Op = v
+				}
+				r.OpElement = element
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ValueSetComposeIncludeFilter as properties to the RDF node n
+func (r ValueSetComposeIncludeFilter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ValueSet.compose.include.filter.property", -1, r.Property, r.PropertyElement, "string")
+	n.primitive("ValueSet.compose.include.filter.op", -1, r.Op, r.OpElement, "code")
+	n.primitive("ValueSet.compose.include.filter.value", -1, r.Value, r.ValueElement, "string")
+}
+
+// MarshalBSON marshals the given ValueSetComposeIncludeFilter as BSON document with the structure of its FHIR JSON
+func (r ValueSetComposeIncludeFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ValueSetComposeIncludeFilter from a BSON document with the structure of its FHIR JSON
+func (r *ValueSetComposeIncludeFilter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ValueSetComposeIncludeFilter as protobuf message ValueSetComposeIncludeFilter of fhir.proto
+func (r ValueSetComposeIncludeFilter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ValueSetComposeIncludeFilter to the encoded message
+func (r ValueSetComposeIncludeFilter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Property != "" {
+		e.string(4, r.Property)
+	}
+	e.varint(5, r.Op.protoNumber())
+	if r.Value != "" {
+		e.string(6, r.Value)
+	}
+	if r.PropertyElement != nil {
+		e.message(7, *r.PropertyElement)
+	}
+	if r.OpElement != nil {
+		e.message(8, *r.OpElement)
+	}
+	if r.ValueElement != nil {
+		e.message(9, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ValueSetComposeIncludeFilter from protobuf message ValueSetComposeIncludeFilter of fhir.proto
+func (r *ValueSetComposeIncludeFilter) UnmarshalProto(b []byte) error {
+	*r = ValueSetComposeIncludeFilter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Property = d.string()
+		case 5:
+			var v FilterOperator
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Op = v
+		case 6:
+			r.Value = d.string()
+		case 7:
+			var v Element
+			d.message(&v)
+			r.PropertyElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.OpElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ValueSetExpansion as JSON into a byte slice
+func (r ValueSetExpansion) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ValueSetExpansion as JSON object with the members in the order of the fields
+func (r ValueSetExpansion) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		e.string(*r.Identifier)
+	}
+	if r.IdentifierElement != nil {
+		e.key("_identifier")
+		r.IdentifierElement.appendJSON(e)
+	}
+	e.key("timestamp")
+	e.string(r.Timestamp)
+	if r.TimestampElement != nil {
+		e.key("_timestamp")
+		r.TimestampElement.appendJSON(e)
+	}
+	if r.Total != nil {
+		e.key("total")
+		e.int(*r.Total)
+	}
+	if r.TotalElement != nil {
+		e.key("_total")
+		r.TotalElement.appendJSON(e)
+	}
+	if r.Offset != nil {
+		e.key("offset")
+		e.int(*r.Offset)
+	}
+	if r.OffsetElement != nil {
+		e.key("_offset")
+		r.OffsetElement.appendJSON(e)
+	}
+	if len(r.Parameter) > 0 {
+		e.key("parameter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parameter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Contains) > 0 {
+		e.key("contains")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contains {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ValueSetExpansion from JSON. Unknown members are ignored.
+func (r *ValueSetExpansion) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ValueSetExpansion
+func (r *ValueSetExpansion) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if v, ok := d.string(); ok {
+				r.Identifier = &v
+			} else {
+				r.Identifier = nil
+			}
+		case "_identifier":
+			r.IdentifierElement = d.element()
+		case "timestamp":
+			if v, ok := d.string(); ok {
+				r.Timestamp = v
+			}
+		case "_timestamp":
+			r.TimestampElement = d.element()
+		case "total":
+			if v, ok := d.int(); ok {
+				r.Total = &v
+			} else {
+				r.Total = nil
+			}
+		case "_total":
+			r.TotalElement = d.element()
+		case "offset":
+			if v, ok := d.int(); ok {
+				r.Offset = &v
+			} else {
+				r.Offset = nil
+			}
+		case "_offset":
+			r.OffsetElement = d.element()
+		case "parameter":
+			a := d.array()
+			r.Parameter = nil
+			for a.next() {
+				var v ValueSetExpansionParameter
+				v.decodeJSON(d)
+				r.Parameter = append(r.Parameter, v)
+			}
+			if r.Parameter == nil && !a.isNull {
+				r.Parameter = []ValueSetExpansionParameter{}
+			}
+		case "contains":
+			a := d.array()
+			r.Contains = nil
+			for a.next() {
+				var v ValueSetExpansionContains
+				v.decodeJSON(d)
+				r.Contains = append(r.Contains, v)
+			}
+			if r.Contains == nil && !a.isNull {
+				r.Contains = []ValueSetExpansionContains{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ValueSetExpansion which shares no memory with the original
+func (r ValueSetExpansion) DeepCopy() ValueSetExpansion {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := *r.Identifier
+		out.Identifier = &v
+	}
+	if r.IdentifierElement != nil {
+		v := r.IdentifierElement.DeepCopy()
+		out.IdentifierElement = &v
+	}
+	if r.TimestampElement != nil {
+		v := r.TimestampElement.DeepCopy()
+		out.TimestampElement = &v
+	}
+	if r.Total != nil {
+		v := *r.Total
+		out.Total = &v
+	}
+	if r.TotalElement != nil {
+		v := r.TotalElement.DeepCopy()
+		out.TotalElement = &v
+	}
+	if r.Offset != nil {
+		v := *r.Offset
+		out.Offset = &v
+	}
+	if r.OffsetElement != nil {
+		v := r.OffsetElement.DeepCopy()
+		out.OffsetElement = &v
+	}
+	if r.Parameter != nil {
+		out.Parameter = make([]ValueSetExpansionParameter, len(r.Parameter))
+		for i := range r.Parameter {
+			out.Parameter[i] = r.Parameter[i].DeepCopy()
+		}
+	}
+	if r.Contains != nil {
+		out.Contains = make([]ValueSetExpansionContains, len(r.Contains))
+		for i := range r.Contains {
+			out.Contains[i] = r.Contains[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ValueSetExpansion) Equal(other ValueSetExpansion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && *r.Identifier != *other.Identifier {
+		return false
+	}
+	if (r.IdentifierElement == nil) != (other.IdentifierElement == nil) || r.IdentifierElement != nil && !r.IdentifierElement.Equal(*other.IdentifierElement) {
+		return false
+	}
+	if r.Timestamp != other.Timestamp {
+		return false
+	}
+	if (r.TimestampElement == nil) != (other.TimestampElement == nil) || r.TimestampElement != nil && !r.TimestampElement.Equal(*other.TimestampElement) {
+		return false
+	}
+	if (r.Total == nil) != (other.Total == nil) || r.Total != nil && *r.Total != *other.Total {
+		return false
+	}
+	if (r.TotalElement == nil) != (other.TotalElement == nil) || r.TotalElement != nil && !r.TotalElement.Equal(*other.TotalElement) {
+		return false
+	}
+	if (r.Offset == nil) != (other.Offset == nil) || r.Offset != nil && *r.Offset != *other.Offset {
+		return false
+	}
+	if (r.OffsetElement == nil) != (other.OffsetElement == nil) || r.OffsetElement != nil && !r.OffsetElement.Equal(*other.OffsetElement) {
+		return false
+	}
+	if len(r.Parameter) != len(other.Parameter) {
+		return false
+	}
+	for i := range r.Parameter {
+		if !r.Parameter[i].Equal(other.Parameter[i]) {
+			return false
+		}
+	}
+	if len(r.Contains) != len(other.Contains) {
+		return false
+	}
+	for i := range r.Contains {
+		if !r.Contains[i].Equal(other.Contains[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ValueSetExpansion) EqualsDeep(other ValueSetExpansion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !equivalentString(*r.Identifier, *other.Identifier) {
+		return false
+	}
+	if (r.IdentifierElement == nil) != (other.IdentifierElement == nil) || r.IdentifierElement != nil && !r.IdentifierElement.EqualsDeep(*other.IdentifierElement) {
+		return false
+	}
+	if !equivalentString(r.Timestamp, other.Timestamp) {
+		return false
+	}
+	if (r.TimestampElement == nil) != (other.TimestampElement == nil) || r.TimestampElement != nil && !r.TimestampElement.EqualsDeep(*other.TimestampElement) {
+		return false
+	}
+	if (r.Total == nil) != (other.Total == nil) || r.Total != nil && *r.Total != *other.Total {
+		return false
+	}
+	if (r.TotalElement == nil) != (other.TotalElement == nil) || r.TotalElement != nil && !r.TotalElement.EqualsDeep(*other.TotalElement) {
+		return false
+	}
+	if (r.Offset == nil) != (other.Offset == nil) || r.Offset != nil && *r.Offset != *other.Offset {
+		return false
+	}
+	if (r.OffsetElement == nil) != (other.OffsetElement == nil) || r.OffsetElement != nil && !r.OffsetElement.EqualsDeep(*other.OffsetElement) {
+		return false
+	}
+	if !equivalentList(len(r.Parameter), len(other.Parameter), func(i, j int) bool {
+		return r.Parameter[i].EqualsDeep(other.Parameter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Contains), len(other.Contains), func(i, j int) bool {
+		return r.Contains[i].EqualsDeep(other.Contains[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ValueSetExpansion) EqualsShallow(other ValueSetExpansion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !equivalentString(*r.Identifier, *other.Identifier) {
+		return false
+	}
+	if !equivalentString(r.Timestamp, other.Timestamp) {
+		return false
+	}
+	if (r.Total == nil) != (other.Total == nil) || r.Total != nil && *r.Total != *other.Total {
+		return false
+	}
+	if (r.Offset == nil) != (other.Offset == nil) || r.Offset != nil && *r.Offset != *other.Offset {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ValueSetExpansion as FHIR XML
+func (r ValueSetExpansion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "identifier", r.Identifier, r.IdentifierElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timestamp", r.Timestamp, r.TimestampElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "total", r.Total, r.TotalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "offset", r.Offset, r.OffsetElement); err != nil {
+		return err
+	}
+	for _, v := range r.Parameter {
+		if err := encodeXMLElement(e, "parameter", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Contains {
+		if err := encodeXMLElement(e, "contains", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ValueSetExpansion from FHIR XML
+func (r *ValueSetExpansion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Identifier = &v
+				}
+				r.IdentifierElement = element
+			case "timestamp":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Timestamp = v
+				}
+				r.TimestampElement = element
+			case "total":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Total = &v
+				}
+				r.TotalElement = element
+			case "offset":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Offset = &v
+				}
+				r.OffsetElement = element
+			case "parameter":
+				var v ValueSetExpansionParameter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parameter = append(r.Parameter, v)
+			case "contains":
+				var v ValueSetExpansionContains
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contains = append(r.Contains, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ValueSetExpansion as properties to the RDF node n
+func (r ValueSetExpansion) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ValueSet.expansion.identifier", -1, r.Identifier, r.IdentifierElement, "string")
+	n.primitive("ValueSet.expansion.timestamp", -1, r.Timestamp, r.TimestampElement, "dateTime")
+	n.primitive("ValueSet.expansion.total", -1, r.Total, r.TotalElement, "integer")
+	n.primitive("ValueSet.expansion.offset", -1, r.Offset, r.OffsetElement, "integer")
+	for i, v := range r.Parameter {
+		n.element("ValueSet.expansion.parameter", i, v)
+	}
+	for i, v := range r.Contains {
+		n.element("ValueSet.expansion.contains", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ValueSetExpansion as BSON document with the structure of its FHIR JSON
+func (r ValueSetExpansion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ValueSetExpansion from a BSON document with the structure of its FHIR JSON
+func (r *ValueSetExpansion) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ValueSetExpansion as protobuf message ValueSetExpansion of fhir.proto
+func (r ValueSetExpansion) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ValueSetExpansion to the encoded message
+func (r ValueSetExpansion) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identifier != nil {
+		e.string(4, *r.Identifier)
+	}
+	if r.Timestamp != "" {
+		e.string(5, r.Timestamp)
+	}
+	if r.Total != nil {
+		e.varint(6, uint64(int64(*r.Total)))
+	}
+	if r.Offset != nil {
+		e.varint(7, uint64(int64(*r.Offset)))
+	}
+	for _, v := range r.Parameter {
+		e.message(8, v)
+	}
+	for _, v := range r.Contains {
+		e.message(9, v)
+	}
+	if r.IdentifierElement != nil {
+		e.message(10, *r.IdentifierElement)
+	}
+	if r.TimestampElement != nil {
+		e.message(11, *r.TimestampElement)
+	}
+	if r.TotalElement != nil {
+		e.message(12, *r.TotalElement)
+	}
+	if r.OffsetElement != nil {
+		e.message(13, *r.OffsetElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ValueSetExpansion from protobuf message ValueSetExpansion of fhir.proto
+func (r *ValueSetExpansion) UnmarshalProto(b []byte) error {
+	*r = ValueSetExpansion{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Identifier = &v
+		case 5:
+			r.Timestamp = d.string()
+		case 6:
+			v := d.int()
+			r.Total = &v
+		case 7:
+			v := d.int()
+			r.Offset = &v
+		case 8:
+			var v ValueSetExpansionParameter
+			d.message(&v)
+			r.Parameter = append(r.Parameter, v)
+		case 9:
+			var v ValueSetExpansionContains
+			d.message(&v)
+			r.Contains = append(r.Contains, v)
+		case 10:
+			var v Element
+			d.message(&v)
+			r.IdentifierElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.TimestampElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.TotalElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.OffsetElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ValueSetExpansionParameter as JSON into a byte slice
+func (r ValueSetExpansionParameter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ValueSetExpansionParameter as JSON object with the members in the order of the fields
+func (r ValueSetExpansionParameter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueDecimal != nil {
+		e.key("valueDecimal")
+		e.number(*r.ValueDecimal)
+	}
+	if r.ValueDecimalElement != nil {
+		e.key("_valueDecimal")
+		r.ValueDecimalElement.appendJSON(e)
+	}
+	if r.ValueUri != nil {
+		e.key("valueUri")
+		e.string(*r.ValueUri)
+	}
+	if r.ValueUriElement != nil {
+		e.key("_valueUri")
+		r.ValueUriElement.appendJSON(e)
+	}
+	if r.ValueCode != nil {
+		e.key("valueCode")
+		e.string(*r.ValueCode)
+	}
+	if r.ValueCodeElement != nil {
+		e.key("_valueCode")
+		r.ValueCodeElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ValueSetExpansionParameter from JSON. Unknown members are ignored.
+func (r *ValueSetExpansionParameter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ValueSetExpansionParameter
+func (r *ValueSetExpansionParameter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueDecimal":
+			if v, ok := d.number(); ok {
+				r.ValueDecimal = &v
+			} else {
+				r.ValueDecimal = nil
+			}
+		case "_valueDecimal":
+			r.ValueDecimalElement = d.element()
+		case "valueUri":
+			if v, ok := d.string(); ok {
+				r.ValueUri = &v
+			} else {
+				r.ValueUri = nil
+			}
+		case "_valueUri":
+			r.ValueUriElement = d.element()
+		case "valueCode":
+			if v, ok := d.string(); ok {
+				r.ValueCode = &v
+			} else {
+				r.ValueCode = nil
+			}
+		case "_valueCode":
+			r.ValueCodeElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ValueSetExpansionParameter which shares no memory with the original
+func (r ValueSetExpansionParameter) DeepCopy() ValueSetExpansionParameter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueDecimal != nil {
+		v := *r.ValueDecimal
+		out.ValueDecimal = &v
+	}
+	if r.ValueDecimalElement != nil {
+		v := r.ValueDecimalElement.DeepCopy()
+		out.ValueDecimalElement = &v
+	}
+	if r.ValueUri != nil {
+		v := *r.ValueUri
+		out.ValueUri = &v
+	}
+	if r.ValueUriElement != nil {
+		v := r.ValueUriElement.DeepCopy()
+		out.ValueUriElement = &v
+	}
+	if r.ValueCode != nil {
+		v := *r.ValueCode
+		out.ValueCode = &v
+	}
+	if r.ValueCodeElement != nil {
+		v := r.ValueCodeElement.DeepCopy()
+		out.ValueCodeElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ValueSetExpansionParameter) Equal(other ValueSetExpansionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !EqualDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.Equal(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && *r.ValueUri != *other.ValueUri {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.Equal(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && *r.ValueCode != *other.ValueCode {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.Equal(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ValueSetExpansionParameter) EqualsDeep(other ValueSetExpansionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.EqualsDeep(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.EqualsDeep(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.EqualsDeep(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ValueSetExpansionParameter) EqualsShallow(other ValueSetExpansionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ValueSetExpansionParameter as FHIR XML
+func (r ValueSetExpansionParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDecimal", r.ValueDecimal, r.ValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUri", r.ValueUri, r.ValueUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCode", r.ValueCode, r.ValueCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ValueSetExpansionParameter from FHIR XML
+func (r *ValueSetExpansionParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDecimal = &v
+				}
+				r.ValueDecimalElement = element
+			case "valueUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUri = &v
+				}
+				r.ValueUriElement = element
+			case "valueCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCode = &v
+				}
+				r.ValueCodeElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ValueSetExpansionParameter as properties to the RDF node n
+func (r ValueSetExpansionParameter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ValueSet.expansion.parameter.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ValueSet.expansion.parameter.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("ValueSet.expansion.parameter.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("ValueSet.expansion.parameter.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("ValueSet.expansion.parameter.valueDecimal", -1, r.ValueDecimal, r.ValueDecimalElement, "decimal")
+	n.primitive("ValueSet.expansion.parameter.valueUri", -1, r.ValueUri, r.ValueUriElement, "uri")
+	n.primitive("ValueSet.expansion.parameter.valueCode", -1, r.ValueCode, r.ValueCodeElement, "code")
+	n.primitive("ValueSet.expansion.parameter.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+}
+
+// MarshalBSON marshals the given ValueSetExpansionParameter as BSON document with the structure of its FHIR JSON
+func (r ValueSetExpansionParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ValueSetExpansionParameter from a BSON document with the structure of its FHIR JSON
+func (r *ValueSetExpansionParameter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ValueSetExpansionParameter as protobuf message ValueSetExpansionParameter of fhir.proto
+func (r ValueSetExpansionParameter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ValueSetExpansionParameter to the encoded message
+func (r ValueSetExpansionParameter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.ValueString != nil {
+		e.string(5, *r.ValueString)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(6, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueInteger != nil {
+		e.varint(7, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueDecimal != nil {
+		e.string(8, string(*r.ValueDecimal))
+	}
+	if r.ValueUri != nil {
+		e.string(9, *r.ValueUri)
+	}
+	if r.ValueCode != nil {
+		e.string(10, *r.ValueCode)
+	}
+	if r.ValueDateTime != nil {
+		e.string(11, *r.ValueDateTime)
+	}
+	if r.NameElement != nil {
+		e.message(12, *r.NameElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(13, *r.ValueStringElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(14, *r.ValueBooleanElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(15, *r.ValueIntegerElement)
+	}
+	if r.ValueDecimalElement != nil {
+		e.message(16, *r.ValueDecimalElement)
+	}
+	if r.ValueUriElement != nil {
+		e.message(17, *r.ValueUriElement)
+	}
+	if r.ValueCodeElement != nil {
+		e.message(18, *r.ValueCodeElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(19, *r.ValueDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ValueSetExpansionParameter from protobuf message ValueSetExpansionParameter of fhir.proto
+func (r *ValueSetExpansionParameter) UnmarshalProto(b []byte) error {
+	*r = ValueSetExpansionParameter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.ValueString = &v
+		case 6:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 7:
+			v := d.int()
+			r.ValueInteger = &v
+		case 8:
+			v := json.Number(d.string())
+			r.ValueDecimal = &v
+		case 9:
+			v := d.string()
+			r.ValueUri = &v
+		case 10:
+			v := d.string()
+			r.ValueCode = &v
+		case 11:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.ValueDecimalElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ValueUriElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ValueCodeElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ValueSetExpansionContains as JSON into a byte slice
+func (r ValueSetExpansionContains) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ValueSetExpansionContains as JSON object with the members in the order of the fields
+func (r ValueSetExpansionContains) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.System != nil {
+		e.key("system")
+		e.string(*r.System)
+	}
+	if r.SystemElement != nil {
+		e.key("_system")
+		r.SystemElement.appendJSON(e)
+	}
+	if r.Abstract != nil {
+		e.key("abstract")
+		e.bool(*r.Abstract)
+	}
+	if r.AbstractElement != nil {
+		e.key("_abstract")
+		r.AbstractElement.appendJSON(e)
+	}
+	if r.Inactive != nil {
+		e.key("inactive")
+		e.bool(*r.Inactive)
+	}
+	if r.InactiveElement != nil {
+		e.key("_inactive")
+		r.InactiveElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		e.string(*r.Code)
+	}
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	if len(r.Designation) > 0 {
+		e.key("designation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Designation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Contains) > 0 {
+		e.key("contains")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contains {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ValueSetExpansionContains from JSON. Unknown members are ignored.
+func (r *ValueSetExpansionContains) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ValueSetExpansionContains
+func (r *ValueSetExpansionContains) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "system":
+			if v, ok := d.string(); ok {
+				r.System = &v
+			} else {
+				r.System = nil
+			}
+		case "_system":
+			r.SystemElement = d.element()
+		case "abstract":
+			if v, ok := d.bool(); ok {
+				r.Abstract = &v
+			} else {
+				r.Abstract = nil
+			}
+		case "_abstract":
+			r.AbstractElement = d.element()
+		case "inactive":
+			if v, ok := d.bool(); ok {
+				r.Inactive = &v
+			} else {
+				r.Inactive = nil
+			}
+		case "_inactive":
+			r.InactiveElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = &v
+			} else {
+				r.Code = nil
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		case "designation":
+			a := d.array()
+			r.Designation = nil
+			for a.next() {
+				var v ValueSetComposeIncludeConceptDesignation
+				v.decodeJSON(d)
+				r.Designation = append(r.Designation, v)
+			}
+			if r.Designation == nil && !a.isNull {
+				r.Designation = []ValueSetComposeIncludeConceptDesignation{}
+			}
+		case "contains":
+			a := d.array()
+			r.Contains = nil
+			for a.next() {
+				var v ValueSetExpansionContains
+				v.decodeJSON(d)
+				r.Contains = append(r.Contains, v)
+			}
+			if r.Contains == nil && !a.isNull {
+				r.Contains = []ValueSetExpansionContains{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ValueSetExpansionContains which shares no memory with the original
+func (r ValueSetExpansionContains) DeepCopy() ValueSetExpansionContains {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.System != nil {
+		v := *r.System
+		out.System = &v
+	}
+	if r.SystemElement != nil {
+		v := r.SystemElement.DeepCopy()
+		out.SystemElement = &v
+	}
+	if r.Abstract != nil {
+		v := *r.Abstract
+		out.Abstract = &v
+	}
+	if r.AbstractElement != nil {
+		v := r.AbstractElement.DeepCopy()
+		out.AbstractElement = &v
+	}
+	if r.Inactive != nil {
+		v := *r.Inactive
+		out.Inactive = &v
+	}
+	if r.InactiveElement != nil {
+		v := r.InactiveElement.DeepCopy()
+		out.InactiveElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Code != nil {
+		v := *r.Code
+		out.Code = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	if r.Designation != nil {
+		out.Designation = make([]ValueSetComposeIncludeConceptDesignation, len(r.Designation))
+		for i := range r.Designation {
+			out.Designation[i] = r.Designation[i].DeepCopy()
+		}
+	}
+	if r.Contains != nil {
+		out.Contains = make([]ValueSetExpansionContains, len(r.Contains))
+		for i := range r.Contains {
+			out.Contains[i] = r.Contains[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ValueSetExpansionContains) Equal(other ValueSetExpansionContains) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && *r.System != *other.System {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.Equal(*other.SystemElement) {
+		return false
+	}
+	if (r.Abstract == nil) != (other.Abstract == nil) || r.Abstract != nil && *r.Abstract != *other.Abstract {
+		return false
+	}
+	if (r.AbstractElement == nil) != (other.AbstractElement == nil) || r.AbstractElement != nil && !r.AbstractElement.Equal(*other.AbstractElement) {
+		return false
+	}
+	if (r.Inactive == nil) != (other.Inactive == nil) || r.Inactive != nil && *r.Inactive != *other.Inactive {
+		return false
+	}
+	if (r.InactiveElement == nil) != (other.InactiveElement == nil) || r.InactiveElement != nil && !r.InactiveElement.Equal(*other.InactiveElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && *r.Code != *other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	if len(r.Designation) != len(other.Designation) {
+		return false
+	}
+	for i := range r.Designation {
+		if !r.Designation[i].Equal(other.Designation[i]) {
+			return false
+		}
+	}
+	if len(r.Contains) != len(other.Contains) {
+		return false
+	}
+	for i := range r.Contains {
+		if !r.Contains[i].Equal(other.Contains[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ValueSetExpansionContains) EqualsDeep(other ValueSetExpansionContains) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.EqualsDeep(*other.SystemElement) {
+		return false
+	}
+	if (r.Abstract == nil) != (other.Abstract == nil) || r.Abstract != nil && *r.Abstract != *other.Abstract {
+		return false
+	}
+	if (r.AbstractElement == nil) != (other.AbstractElement == nil) || r.AbstractElement != nil && !r.AbstractElement.EqualsDeep(*other.AbstractElement) {
+		return false
+	}
+	if (r.Inactive == nil) != (other.Inactive == nil) || r.Inactive != nil && *r.Inactive != *other.Inactive {
+		return false
+	}
+	if (r.InactiveElement == nil) != (other.InactiveElement == nil) || r.InactiveElement != nil && !r.InactiveElement.EqualsDeep(*other.InactiveElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	if !equivalentList(len(r.Designation), len(other.Designation), func(i, j int) bool {
+		return r.Designation[i].EqualsDeep(other.Designation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Contains), len(other.Contains), func(i, j int) bool {
+		return r.Contains[i].EqualsDeep(other.Contains[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ValueSetExpansionContains) EqualsShallow(other ValueSetExpansionContains) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.Abstract == nil) != (other.Abstract == nil) || r.Abstract != nil && *r.Abstract != *other.Abstract {
+		return false
+	}
+	if (r.Inactive == nil) != (other.Inactive == nil) || r.Inactive != nil && *r.Inactive != *other.Inactive {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ValueSetExpansionContains as FHIR XML
+func (r ValueSetExpansionContains) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "system", r.System, r.SystemElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "abstract", r.Abstract, r.AbstractElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "inactive", r.Inactive, r.InactiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	for _, v := range r.Designation {
+		if err := encodeXMLElement(e, "designation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Contains {
+		if err := encodeXMLElement(e, "contains", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ValueSetExpansionContains from FHIR XML
+func (r *ValueSetExpansionContains) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "system":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.System = &v
+				}
+				r.SystemElement = element
+			case "abstract":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Abstract = &v
+				}
+				r.AbstractElement = element
+			case "inactive":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Inactive = &v
+				}
+				r.InactiveElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = &v
+				}
+				r.CodeElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			case "designation":
+				var v ValueSetComposeIncludeConceptDesignation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Designation = append(r.Designation, v)
+			case "contains":
+				var v ValueSetExpansionContains
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contains = append(r.Contains, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ValueSetExpansionContains as properties to the RDF node n
+func (r ValueSetExpansionContains) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ValueSet.expansion.contains.system", -1, r.System, r.SystemElement, "string")
+	n.primitive("ValueSet.expansion.contains.abstract", -1, r.Abstract, r.AbstractElement, "boolean")
+	n.primitive("ValueSet.expansion.contains.inactive", -1, r.Inactive, r.InactiveElement, "boolean")
+	n.primitive("ValueSet.expansion.contains.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("ValueSet.expansion.contains.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("ValueSet.expansion.contains.display", -1, r.Display, r.DisplayElement, "string")
+	for i, v := range r.Designation {
+		n.element("ValueSet.expansion.contains.designation", i, v)
+	}
+	for i, v := range r.Contains {
+		n.element("ValueSet.expansion.contains.contains", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ValueSetExpansionContains as BSON document with the structure of its FHIR JSON
+func (r ValueSetExpansionContains) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ValueSetExpansionContains from a BSON document with the structure of its FHIR JSON
+func (r *ValueSetExpansionContains) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ValueSetExpansionContains as protobuf message ValueSetExpansionContains of fhir.proto
+func (r ValueSetExpansionContains) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ValueSetExpansionContains to the encoded message
+func (r ValueSetExpansionContains) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.System != nil {
+		e.string(4, *r.System)
+	}
+	if r.Abstract != nil {
+		e.varint(5, protoBool(*r.Abstract))
+	}
+	if r.Inactive != nil {
+		e.varint(6, protoBool(*r.Inactive))
+	}
+	if r.Version != nil {
+		e.string(7, *r.Version)
+	}
+	if r.Code != nil {
+		e.string(8, *r.Code)
+	}
+	if r.Display != nil {
+		e.string(9, *r.Display)
+	}
+	for _, v := range r.Designation {
+		e.message(10, v)
+	}
+	for _, v := range r.Contains {
+		e.message(11, v)
+	}
+	if r.SystemElement != nil {
+		e.message(12, *r.SystemElement)
+	}
+	if r.AbstractElement != nil {
+		e.message(13, *r.AbstractElement)
+	}
+	if r.InactiveElement != nil {
+		e.message(14, *r.InactiveElement)
+	}
+	if r.VersionElement != nil {
+		e.message(15, *r.VersionElement)
+	}
+	if r.CodeElement != nil {
+		e.message(16, *r.CodeElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(17, *r.DisplayElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ValueSetExpansionContains from protobuf message ValueSetExpansionContains of fhir.proto
+func (r *ValueSetExpansionContains) UnmarshalProto(b []byte) error {
+	*r = ValueSetExpansionContains{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.System = &v
+		case 5:
+			v := d.bool()
+			r.Abstract = &v
+		case 6:
+			v := d.bool()
+			r.Inactive = &v
+		case 7:
+			v := d.string()
+			r.Version = &v
+		case 8:
+			v := d.string()
+			r.Code = &v
+		case 9:
+			v := d.string()
+			r.Display = &v
+		case 10:
+			var v ValueSetComposeIncludeConceptDesignation
+			d.message(&v)
+			r.Designation = append(r.Designation, v)
+		case 11:
+			var v ValueSetExpansionContains
+			d.message(&v)
+			r.Contains = append(r.Contains, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.SystemElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.AbstractElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.InactiveElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalValueSet unmarshals a ValueSet.
 func UnmarshalValueSet(b []byte) (ValueSet, error) {
 	var valueSet ValueSet
-	if err := json.Unmarshal(b, &valueSet); err != nil {
+	if err := valueSet.UnmarshalJSON(b); err != nil {
 		return valueSet, err
 	}
 	return valueSet, nil
@@ -0,0 +1,301 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+const (
+	fhirNamespace  = "http://hl7.org/fhir"
+	xhtmlNamespace = "http://www.w3.org/1999/xhtml"
+	xmlNamespace   = "http://www.w3.org/XML/1998/namespace"
+)
+
+// xmlValue returns the value attribute of a primitive, which may be passed by pointer. It returns false for nil.
+func xmlValue(value interface{}) (string, bool) {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", false
+		}
+		value = v.Elem().Interface()
+	}
+	switch v := value.(type) {
+	case interface{ Code() string }:
+		return v.Code(), true
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int:
+		return strconv.Itoa(v), true
+	case json.Number:
+		return string(v), true
+	}
+	return fmt.Sprint(value), true
+}
+
+// encodeXMLPrimitive encodes a primitive as element with a value attribute, e.g. <active value="true"/>, and the id
+// and extensions of the element if it has them.
+func encodeXMLPrimitive(e *xml.Encoder, name string, value interface{}, element *Element) error {
+	s, ok := xmlValue(value)
+	if !ok && element == nil {
+		return nil
+	}
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if ok {
+		start.Attr = []xml.Attr{{Name: xml.Name{Local: "value"}, Value: s}}
+	}
+	if element != nil {
+		return element.MarshalXML(e, start)
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// decodeXMLPrimitive decodes the value attribute of the element into v, which points to a primitive or enum, and
+// returns the id and extensions of the element or nil if it has none. It returns false if the element has no value
+// attribute.
+func decodeXMLPrimitive(d *xml.Decoder, start xml.StartElement, v interface{}) (bool, *Element, error) {
+	var element Element
+	if err := element.UnmarshalXML(d, start); err != nil {
+		return false, nil, err
+	}
+	var extended *Element
+	if element.Id != nil || len(element.Extension) > 0 {
+		extended = &element
+	}
+	var s string
+	found := false
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "value" && attr.Name.Space == "" {
+			s, found = attr.Value, true
+		}
+	}
+	if !found {
+		return false, extended, nil
+	}
+	var err error
+	switch v := v.(type) {
+	case *string:
+		*v = s
+	case *bool:
+		*v, err = strconv.ParseBool(s)
+	case *int:
+		*v, err = strconv.Atoi(s)
+	case *json.Number:
+		err = json.Unmarshal([]byte(s), v)
+	case json.Unmarshaler:
+		quoted, _ := json.Marshal(s)
+		err = v.UnmarshalJSON(quoted)
+	default:
+		err = fmt.Errorf("unsupported primitive %T", v)
+	}
+	if err != nil {
+		return false, nil, fmt.Errorf("invalid value of %s: %v", start.Name.Local, err)
+	}
+	return true, extended, nil
+}
+
+// appendElement appends the id and extensions of the primitive value at index i of a list. The list stays nil as long
+// as no value has them.
+func appendElement(elements []*Element, i int, element *Element) []*Element {
+	if element == nil {
+		return elements
+	}
+	for len(elements) < i {
+		elements = append(elements, nil)
+	}
+	return append(elements, element)
+}
+
+// encodeXMLElement encodes a complex value, which may be passed by pointer, using its MarshalXML method.
+func encodeXMLElement(e *xml.Encoder, name string, value interface{}) error {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	return e.EncodeElement(value, xml.StartElement{Name: xml.Name{Local: name}})
+}
+
+// encodeXMLResource encodes a contained or inline resource held as JSON as element containing the resource, e.g.
+// <resource><Patient xmlns="http://hl7.org/fhir">...</Patient></resource>.
+func encodeXMLResource(e *xml.Encoder, name string, raw json.RawMessage) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return err
+	}
+	resource, ok := newResource(header.ResourceType)
+	if !ok {
+		return fmt.Errorf("unknown resource type `%s` in %s", header.ResourceType, name)
+	}
+	if err := json.Unmarshal(raw, resource); err != nil {
+		return err
+	}
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := e.Encode(resource); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// decodeXMLResource decodes the resource inside of the element and returns it as JSON.
+func decodeXMLResource(d *xml.Decoder, start xml.StartElement) (json.RawMessage, error) {
+	var raw json.RawMessage
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if raw != nil {
+				return nil, fmt.Errorf("more than one resource in %s", start.Name.Local)
+			}
+			resource, ok := newResource(t.Name.Local)
+			if !ok {
+				return nil, fmt.Errorf("unknown resource type `%s` in %s", t.Name.Local, start.Name.Local)
+			}
+			if err := d.DecodeElement(resource, &t); err != nil {
+				return nil, err
+			}
+			if raw, err = json.Marshal(resource); err != nil {
+				return nil, err
+			}
+		case xml.EndElement:
+			if raw == nil {
+				return nil, fmt.Errorf("missing resource in %s", start.Name.Local)
+			}
+			return raw, nil
+		}
+	}
+}
+
+// xhtmlName keeps namespace prefixes of the XHTML of narratives as they are.
+func xhtmlName(name xml.Name) xml.Name {
+	if name.Space != "" {
+		return xml.Name{Local: name.Space + ":" + name.Local}
+	}
+	return name
+}
+
+// encodeXHTML embeds the XHTML div of a narrative into the XML. The content of the div is copied verbatim, so that
+// the XHTML round trips unchanged.
+func encodeXHTML(e *xml.Encoder, name string, div string) error {
+	if div == "" {
+		return nil
+	}
+	d := xml.NewDecoder(strings.NewReader(div))
+	var root xml.StartElement
+	var inner string
+	var innerStart int64
+	depth := 0
+	for {
+		offset := d.InputOffset()
+		token, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("invalid XHTML in %s: %v", name, err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				if root.Name.Local != "" {
+					return fmt.Errorf("invalid XHTML in %s: more than one root element", name)
+				}
+				root = xml.StartElement{Name: xml.Name{Space: xhtmlNamespace, Local: t.Name.Local}}
+				for _, attr := range t.Attr {
+					if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+						continue
+					}
+					root.Attr = append(root.Attr, xml.Attr{Name: xhtmlName(attr.Name), Value: attr.Value})
+				}
+				innerStart = d.InputOffset()
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("invalid XHTML in %s: unexpected end element %s", name, t.Name.Local)
+			}
+			if depth == 0 {
+				// the end of a self-closing root has the offset of its start
+				inner = div[innerStart:offset]
+			}
+		}
+	}
+	if root.Name.Local == "" {
+		return fmt.Errorf("invalid XHTML in %s: missing root element", name)
+	}
+	if depth != 0 {
+		return fmt.Errorf("invalid XHTML in %s: unclosed element", name)
+	}
+	return e.EncodeElement(struct {
+		Inner string `xml:",innerxml"`
+	}{inner}, root)
+}
+
+// decodeXHTML returns the XHTML div of a narrative as string like in FHIR JSON.
+func decodeXHTML(d *xml.Decoder, start xml.StartElement) (string, error) {
+	var div struct {
+		Attr  []xml.Attr `xml:",any,attr"`
+		Inner string     `xml:",innerxml"`
+	}
+	if err := d.DecodeElement(&div, &start); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString(`<div xmlns="` + xhtmlNamespace + `"`)
+	for _, attr := range div.Attr {
+		name := attr.Name.Local
+		switch attr.Name.Space {
+		case "":
+			if name == "xmlns" {
+				continue
+			}
+		case "xmlns":
+			name = "xmlns:" + name
+		case xmlNamespace:
+			name = "xml:" + name
+		}
+		b.WriteString(" " + name + `="`)
+		if err := xml.EscapeText(&b, []byte(attr.Value)); err != nil {
+			return "", err
+		}
+		b.WriteString(`"`)
+	}
+	b.WriteString(">" + div.Inner + "</div>")
+	return b.String(), nil
+}
@@ -3,11 +3,14 @@ module github.com/samply/golang-fhir-models/fhir-models-gen
 go 1.19
 
 require (
+	github.com/bufbuild/protocompile v0.6.0
 	github.com/dave/jennifer v1.6.0
 	github.com/spf13/cobra v1.6.1
+	google.golang.org/protobuf v1.31.0
 )
 
 require (
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
+	golang.org/x/sync v0.3.0 // indirect
 )
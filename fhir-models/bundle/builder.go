@@ -0,0 +1,193 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle builds and reads Bundles of generated resources.
+//
+// A Builder adds resources to a transaction, batch or collection and returns the full URLs other resources reference
+// them with:
+//
+//	b := bundle.NewBuilder(fhir.BundleTypeTransaction)
+//	patient := b.AddCreate(&fhir.Patient{})
+//	b.AddCreate(&fhir.Observation{Subject: &fhir.Reference{Reference: &patient}})
+//	transaction, err := b.Bundle()
+//
+// A Reader decodes the entries of a Bundle and finds them by type, search mode, full URL or reference.
+package bundle
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// Builder builds a Bundle. Errors of adding resources are returned by Bundle.
+type Builder struct {
+	bundle fhir.Bundle
+	err    error
+}
+
+// NewBuilder returns a builder of a Bundle of the type.
+func NewBuilder(bundleType fhir.BundleType) *Builder {
+	return &Builder{bundle: fhir.Bundle{Type: bundleType}}
+}
+
+// Add adds the resource without request, like to a collection, and returns its full URL, which is a new urn:uuid.
+func (b *Builder) Add(resource interface{}) string {
+	fullUrl, _ := b.add(resource, nil)
+	return fullUrl
+}
+
+// AddCreate adds the creation of the resource and returns its full URL, which is a new urn:uuid.
+func (b *Builder) AddCreate(resource interface{}) string {
+	fullUrl, _ := b.add(resource, func(resourceType, _ string) fhir.BundleEntryRequest {
+		return fhir.BundleEntryRequest{Method: fhir.HTTPVerbPOST, Url: resourceType}
+	})
+	return fullUrl
+}
+
+// AddConditionalCreate adds the creation of the resource unless a resource matches the criteria and returns its
+// full URL, which is a new urn:uuid.
+func (b *Builder) AddConditionalCreate(resource interface{}, criteria url.Values) string {
+	ifNoneExist := criteria.Encode()
+	fullUrl, _ := b.add(resource, func(resourceType, _ string) fhir.BundleEntryRequest {
+		return fhir.BundleEntryRequest{Method: fhir.HTTPVerbPOST, Url: resourceType, IfNoneExist: &ifNoneExist}
+	})
+	return fullUrl
+}
+
+// AddUpdate adds the update of the resource, which needs an id, and returns its full URL, which is a new urn:uuid.
+// Unless versionId is empty, the update requires the resource to be at that version.
+func (b *Builder) AddUpdate(resource interface{}, versionId string) string {
+	fullUrl, id := b.add(resource, func(resourceType, id string) fhir.BundleEntryRequest {
+		request := fhir.BundleEntryRequest{Method: fhir.HTTPVerbPUT, Url: resourceType + "/" + url.PathEscape(id)}
+		if versionId != "" {
+			ifMatch := `W/"` + versionId + `"`
+			request.IfMatch = &ifMatch
+		}
+		return request
+	})
+	if id == "" && b.err == nil {
+		b.err = fmt.Errorf("entry %d: update of a resource without id", len(b.bundle.Entry)-1)
+	}
+	return fullUrl
+}
+
+// AddConditionalUpdate adds the update of the resource matching the criteria and returns its full URL, which is a
+// new urn:uuid.
+func (b *Builder) AddConditionalUpdate(resource interface{}, criteria url.Values) string {
+	fullUrl, _ := b.add(resource, func(resourceType, _ string) fhir.BundleEntryRequest {
+		return fhir.BundleEntryRequest{Method: fhir.HTTPVerbPUT, Url: resourceType + "?" + criteria.Encode()}
+	})
+	return fullUrl
+}
+
+// AddDelete adds the deletion of the resource.
+func (b *Builder) AddDelete(resourceType, id string) {
+	b.addRequest(fhir.BundleEntryRequest{Method: fhir.HTTPVerbDELETE, Url: resourceType + "/" + url.PathEscape(id)})
+}
+
+// AddConditionalDelete adds the deletion of the resources of the type matching the criteria.
+func (b *Builder) AddConditionalDelete(resourceType string, criteria url.Values) {
+	b.addRequest(fhir.BundleEntryRequest{Method: fhir.HTTPVerbDELETE, Url: resourceType + "?" + criteria.Encode()})
+}
+
+// AddRead adds the read of the resource.
+func (b *Builder) AddRead(resourceType, id string) {
+	b.addRequest(fhir.BundleEntryRequest{Method: fhir.HTTPVerbGET, Url: resourceType + "/" + url.PathEscape(id)})
+}
+
+// AddSearch adds the search for resources of the type matching the query, which may be built with search.Query.
+// Without type, it searches resources of all types.
+func (b *Builder) AddSearch(resourceType string, query url.Values) {
+	target := resourceType
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	b.addRequest(fhir.BundleEntryRequest{Method: fhir.HTTPVerbGET, Url: target})
+}
+
+// SetTotal sets the total number of matches of a searchset or history.
+func (b *Builder) SetTotal(total int) {
+	b.bundle.Total = &total
+}
+
+// AddLink adds a link with the relation, like self or next.
+func (b *Builder) AddLink(relation, target string) {
+	b.bundle.Link = append(b.bundle.Link, fhir.BundleLink{Relation: relation, Url: target})
+}
+
+// Bundle returns the built Bundle or the first error of adding a resource.
+func (b *Builder) Bundle() (fhir.Bundle, error) {
+	if b.err != nil {
+		return fhir.Bundle{}, b.err
+	}
+	bundle := b.bundle
+	bundle.Entry = append([]fhir.BundleEntry(nil), b.bundle.Entry...)
+	bundle.Link = append([]fhir.BundleLink(nil), b.bundle.Link...)
+	return bundle, nil
+}
+
+// add adds the resource with a new urn:uuid and the request returned by the function, if any, and returns the full
+// URL and id of the resource.
+func (b *Builder) add(resource interface{}, request func(resourceType, id string) fhir.BundleEntryRequest) (string, string) {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		b.fail(err)
+		return "", ""
+	}
+	var header struct {
+		ResourceType string `json:"resourceType"`
+		Id           string `json:"id"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil || header.ResourceType == "" {
+		b.fail(fmt.Errorf("%T isn't a resource", resource))
+		return "", ""
+	}
+	fullUrl, err := newUUID()
+	if err != nil {
+		b.fail(err)
+		return "", ""
+	}
+	entry := fhir.BundleEntry{FullUrl: &fullUrl, Resource: raw}
+	if request != nil {
+		r := request(header.ResourceType, header.Id)
+		entry.Request = &r
+	}
+	b.bundle.Entry = append(b.bundle.Entry, entry)
+	return fullUrl, header.Id
+}
+
+func (b *Builder) addRequest(request fhir.BundleEntryRequest) {
+	b.bundle.Entry = append(b.bundle.Entry, fhir.BundleEntry{Request: &request})
+}
+
+func (b *Builder) fail(err error) {
+	if b.err == nil {
+		b.err = fmt.Errorf("entry %d: %v", len(b.bundle.Entry), err)
+	}
+}
+
+// newUUID returns a random urn:uuid.
+func newUUID() (string, error) {
+	var u [16]byte
+	if _, err := rand.Read(u[:]); err != nil {
+		return "", err
+	}
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:]), nil
+}
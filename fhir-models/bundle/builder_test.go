@@ -0,0 +1,158 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+var uuidPattern = regexp.MustCompile(`^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder(fhir.BundleTypeTransaction)
+	patient := b.AddCreate(&fhir.Patient{Id: stringPtr("ignored")})
+	b.AddCreate(&fhir.Observation{Subject: &fhir.Reference{Reference: &patient}})
+	b.AddConditionalCreate(&fhir.Patient{}, url.Values{"identifier": {"http://example.org/mrn|1"}})
+	b.AddUpdate(&fhir.Patient{Id: stringPtr("p 1")}, "")
+	b.AddUpdate(fhir.Patient{Id: stringPtr("p2")}, "3")
+	b.AddConditionalUpdate(&fhir.Patient{}, url.Values{"identifier": {"x"}, "active": {"true"}})
+	b.AddDelete("Patient", "p3")
+	b.AddConditionalDelete("Patient", url.Values{"family": {"Doe"}})
+	b.AddRead("Patient", "p/4")
+	b.AddSearch("Patient", url.Values{"name": {"a b"}})
+	b.AddSearch("", nil)
+	b.Add(&fhir.Organization{})
+	bundle, err := b.Bundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		method      fhir.HTTPVerb
+		url         string
+		ifNoneExist string
+		ifMatch     string
+		resource    bool
+	}{
+		{fhir.HTTPVerbPOST, "Patient", "", "", true},
+		{fhir.HTTPVerbPOST, "Observation", "", "", true},
+		{fhir.HTTPVerbPOST, "Patient", "identifier=http%3A%2F%2Fexample.org%2Fmrn%7C1", "", true},
+		{fhir.HTTPVerbPUT, "Patient/p%201", "", "", true},
+		{fhir.HTTPVerbPUT, "Patient/p2", "", `W/"3"`, true},
+		{fhir.HTTPVerbPUT, "Patient?active=true&identifier=x", "", "", true},
+		{fhir.HTTPVerbDELETE, "Patient/p3", "", "", false},
+		{fhir.HTTPVerbDELETE, "Patient?family=Doe", "", "", false},
+		{fhir.HTTPVerbGET, "Patient/p%2F4", "", "", false},
+		{fhir.HTTPVerbGET, "Patient?name=a+b", "", "", false},
+		{fhir.HTTPVerbGET, "", "", "", false},
+	}
+	if bundle.Type != fhir.BundleTypeTransaction || len(bundle.Entry) != len(tests)+1 {
+		t.Fatalf("bundle has type %s and %d entries", bundle.Type.Code(), len(bundle.Entry))
+	}
+	for i, test := range tests {
+		entry := bundle.Entry[i]
+		request := entry.Request
+		if request.Method != test.method || request.Url != test.url {
+			t.Errorf("entry %d: request %s %s, want %s %s", i, request.Method.Code(), request.Url, test.method.Code(), test.url)
+		}
+		if ifNoneExist := request.IfNoneExist; (ifNoneExist == nil) != (test.ifNoneExist == "") || ifNoneExist != nil && *ifNoneExist != test.ifNoneExist {
+			t.Errorf("entry %d: ifNoneExist = %v, want %s", i, ifNoneExist, test.ifNoneExist)
+		}
+		if ifMatch := request.IfMatch; (ifMatch == nil) != (test.ifMatch == "") || ifMatch != nil && *ifMatch != test.ifMatch {
+			t.Errorf("entry %d: ifMatch = %v, want %s", i, ifMatch, test.ifMatch)
+		}
+		if (entry.Resource != nil) != test.resource || (entry.FullUrl != nil) != test.resource {
+			t.Errorf("entry %d: resource = %s, fullUrl = %v", i, entry.Resource, entry.FullUrl)
+		}
+		if entry.FullUrl != nil && !uuidPattern.MatchString(*entry.FullUrl) {
+			t.Errorf("entry %d: full URL %s isn't a urn:uuid", i, *entry.FullUrl)
+		}
+	}
+
+	if *bundle.Entry[0].FullUrl != patient || *bundle.Entry[1].FullUrl == patient {
+		t.Errorf("full URLs = %s, %s, want %s first", *bundle.Entry[0].FullUrl, *bundle.Entry[1].FullUrl, patient)
+	}
+	if !strings.Contains(string(bundle.Entry[1].Resource), `"reference":"`+patient+`"`) {
+		t.Errorf("observation = %s", bundle.Entry[1].Resource)
+	}
+	// the ids of created resources are kept, the server replaces them
+	if !strings.Contains(string(bundle.Entry[0].Resource), `"id":"ignored"`) {
+		t.Errorf("patient = %s", bundle.Entry[0].Resource)
+	}
+	if last := bundle.Entry[len(tests)]; last.Request != nil || last.FullUrl == nil {
+		t.Errorf("entry without request = %+v", last)
+	}
+}
+
+func TestBuilderSearchset(t *testing.T) {
+	b := NewBuilder(fhir.BundleTypeSearchset)
+	b.Add(&fhir.Patient{Id: stringPtr("p1")})
+	b.SetTotal(10)
+	b.AddLink("self", "http://example.org/fhir/Patient")
+	b.AddLink("next", "http://example.org/fhir/Patient?_offset=1")
+	bundle, err := b.Bundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *bundle.Total != 10 || len(bundle.Link) != 2 || bundle.Link[1].Relation != "next" {
+		t.Errorf("bundle = %+v", bundle)
+	}
+
+	// the returned Bundle doesn't change with further entries
+	b.Add(&fhir.Patient{})
+	b.AddLink("previous", "x")
+	if len(bundle.Entry) != 1 || len(bundle.Link) != 2 {
+		t.Errorf("bundle changed to %d entries and %d links", len(bundle.Entry), len(bundle.Link))
+	}
+}
+
+func TestBuilderErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(b *Builder)
+		err   string
+	}{
+		{"update without id", func(b *Builder) {
+			b.AddCreate(&fhir.Patient{})
+			b.AddUpdate(&fhir.Patient{}, "")
+		}, "entry 1: update of a resource without id"},
+		{"no resource", func(b *Builder) { b.AddCreate("Patient") }, "entry 0: string isn't a resource"},
+		{"element", func(b *Builder) { b.Add(&fhir.HumanName{}) }, "entry 0: *fhir.HumanName isn't a resource"},
+		{"first error", func(b *Builder) {
+			b.AddDelete("Patient", "p1")
+			b.AddUpdate(&fhir.Patient{}, "1")
+			b.AddCreate(1)
+		}, "entry 1: update of a resource without id"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := NewBuilder(fhir.BundleTypeTransaction)
+			test.build(b)
+			_, err := b.Bundle()
+			if err == nil || err.Error() != test.err {
+				t.Errorf("err = %v, want %s", err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// Entry is an entry of a Bundle with its decoded resource.
+type Entry struct {
+	Entry fhir.BundleEntry
+	// Resource is a pointer to a generated resource like *fhir.Patient or nil if the entry has none
+	Resource interface{}
+}
+
+// Mode returns the search mode of the entry, which is match for entries without one.
+func (e Entry) Mode() fhir.SearchEntryMode {
+	if e.Entry.Search == nil || e.Entry.Search.Mode == nil {
+		return fhir.SearchEntryModeMatch
+	}
+	return *e.Entry.Search.Mode
+}
+
+// Reader gives access to the decoded entries of a Bundle.
+type Reader struct {
+	bundle    fhir.Bundle
+	entries   []Entry
+	fullUrls  map[string]int
+	resources map[string]int
+}
+
+// NewReader decodes the resources of the Bundle.
+func NewReader(bundle fhir.Bundle) (*Reader, error) {
+	r := &Reader{
+		bundle:    bundle,
+		entries:   make([]Entry, len(bundle.Entry)),
+		fullUrls:  make(map[string]int),
+		resources: make(map[string]int),
+	}
+	for i, entry := range bundle.Entry {
+		r.entries[i].Entry = entry
+		if entry.FullUrl != nil {
+			r.fullUrls[*entry.FullUrl] = i
+		}
+		if entry.Resource == nil {
+			continue
+		}
+		resource, err := fhir.DecodeResource(entry.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %v", i, err)
+		}
+		r.entries[i].Resource = resource
+		if id := resourceID(resource); id != "" {
+			if _, ok := r.resources[typeName(resource)+"/"+id]; !ok {
+				r.resources[typeName(resource)+"/"+id] = i
+			}
+		}
+	}
+	return r, nil
+}
+
+// Bundle returns the Bundle read.
+func (r *Reader) Bundle() fhir.Bundle {
+	return r.bundle
+}
+
+// Entries returns all entries.
+func (r *Reader) Entries() []Entry {
+	return r.entries
+}
+
+// Mode returns the entries with one of the search modes.
+func (r *Reader) Mode(modes ...fhir.SearchEntryMode) []Entry {
+	var entries []Entry
+	for _, entry := range r.entries {
+		for _, mode := range modes {
+			if entry.Mode() == mode {
+				entries = append(entries, entry)
+				break
+			}
+		}
+	}
+	return entries
+}
+
+// Matches returns the entries matching a search.
+func (r *Reader) Matches() []Entry {
+	return r.Mode(fhir.SearchEntryModeMatch)
+}
+
+// Included returns the entries included by a search.
+func (r *Reader) Included() []Entry {
+	return r.Mode(fhir.SearchEntryModeInclude)
+}
+
+// Outcome returns the OperationOutcome of a search or nil.
+func (r *Reader) Outcome() *fhir.OperationOutcome {
+	for _, entry := range r.Mode(fhir.SearchEntryModeOutcome) {
+		if outcome, ok := entry.Resource.(*fhir.OperationOutcome); ok {
+			return outcome
+		}
+	}
+	return nil
+}
+
+// Resources appends the resources of the type of the elements of the slice target points to, like *[]*fhir.Patient,
+// to the slice. With modes, only the resources of entries with one of the search modes are appended.
+func (r *Reader) Resources(target interface{}, modes ...fhir.SearchEntryMode) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice || v.Elem().Type().Elem().Kind() != reflect.Ptr {
+		return fmt.Errorf("expected a pointer to a slice of pointers but got %T", target)
+	}
+	slice := v.Elem()
+	entries := r.entries
+	if len(modes) > 0 {
+		entries = r.Mode(modes...)
+	}
+	for _, entry := range entries {
+		if entry.Resource != nil && reflect.TypeOf(entry.Resource) == slice.Type().Elem() {
+			slice.Set(reflect.Append(slice, reflect.ValueOf(entry.Resource)))
+		}
+	}
+	return nil
+}
+
+// FullUrl returns the entry with the full URL.
+func (r *Reader) FullUrl(fullUrl string) (Entry, bool) {
+	i, ok := r.fullUrls[fullUrl]
+	if !ok {
+		return Entry{}, false
+	}
+	return r.entries[i], true
+}
+
+// Reference returns the entry a reference like Patient/123, http://example.org/fhir/Patient/123 or urn:uuid:... refers
+// to. References match full URLs, relative references also the type and id of resources. The base of the full URLs is
+// ignored for relative references, which thereby match the entries of any server. ReferenceFrom resolves them against
+// the base of the referencing entry instead.
+func (r *Reader) Reference(reference string) (Entry, bool) {
+	if entry, ok := r.FullUrl(reference); ok {
+		return entry, true
+	}
+	if i := strings.Index(reference, "/_history/"); i >= 0 {
+		reference = reference[:i]
+		if entry, ok := r.FullUrl(reference); ok {
+			return entry, true
+		}
+	}
+	if i, ok := r.resources[reference]; ok {
+		return r.entries[i], true
+	}
+	if strings.Count(reference, "/") != 1 {
+		return Entry{}, false
+	}
+	for _, entry := range r.entries {
+		if entry.Entry.FullUrl != nil && strings.HasSuffix(*entry.Entry.FullUrl, "/"+reference) {
+			return entry, true
+		}
+	}
+	return Entry{}, false
+}
+
+// ReferenceFrom returns the entry a reference within the entry with the full URL refers to. Following
+// http://hl7.org/fhir/bundle.html#references, relative references are resolved against the base of a RESTful full URL
+// like http://example.org/fhir/Patient/123. References within entries with a urn:uuid or without full URL are resolved
+// by Reference.
+func (r *Reader) ReferenceFrom(fullUrl, reference string) (Entry, bool) {
+	base, ok := restBase(fullUrl)
+	if !ok || strings.Contains(reference, ":") || strings.HasPrefix(reference, "#") {
+		return r.Reference(reference)
+	}
+	if i := strings.Index(reference, "/_history/"); i >= 0 {
+		reference = reference[:i]
+	}
+	return r.FullUrl(base + "/" + reference)
+}
+
+// restBase returns the base of a RESTful URL like http://example.org/fhir/Patient/123/_history/1.
+func restBase(fullUrl string) (string, bool) {
+	if i := strings.Index(fullUrl, "/_history/"); i >= 0 {
+		fullUrl = fullUrl[:i]
+	}
+	if !strings.HasPrefix(fullUrl, "http://") && !strings.HasPrefix(fullUrl, "https://") {
+		return "", false
+	}
+	parts := strings.Split(fullUrl, "/")
+	if len(parts) < 5 || parts[len(parts)-1] == "" {
+		return "", false
+	}
+	if resourceType := parts[len(parts)-2]; resourceType == "" || resourceType[0] < 'A' || resourceType[0] > 'Z' {
+		return "", false
+	}
+	return strings.Join(parts[:len(parts)-2], "/"), true
+}
+
+// Total returns the total number of matches of a search or history, if the Bundle has one.
+func (r *Reader) Total() (int, bool) {
+	if r.bundle.Total == nil {
+		return 0, false
+	}
+	return *r.bundle.Total, true
+}
+
+// Link returns the URL of the link with the relation, like self or next.
+func (r *Reader) Link(relation string) (string, bool) {
+	for _, link := range r.bundle.Link {
+		if link.Relation == relation {
+			return link.Url, true
+		}
+	}
+	return "", false
+}
+
+func typeName(resource interface{}) string {
+	t := reflect.TypeOf(resource)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+func resourceID(resource interface{}) string {
+	v := reflect.ValueOf(resource)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	field := v.FieldByName("Id")
+	if !field.IsValid() {
+		return ""
+	}
+	id, ok := field.Interface().(*string)
+	if !ok || id == nil {
+		return ""
+	}
+	return *id
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// testBundle is a searchset with full URLs of a server and a urn:uuid.
+const testBundle = `{"resourceType":"Bundle","type":"searchset","total":2,
+	"link":[{"relation":"self","url":"http://example.org/fhir/Patient?_count=1"},{"relation":"next","url":"http://example.org/fhir/Patient?_count=1&_offset=1"}],
+	"entry":[
+		{"fullUrl":"http://example.org/fhir/Patient/p1","resource":{"resourceType":"Patient","id":"p1"},"search":{"mode":"match"}},
+		{"fullUrl":"urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11","resource":{"resourceType":"Patient"}},
+		{"fullUrl":"http://other.org/fhir/Organization/o1","resource":{"resourceType":"Organization","id":"o1"},"search":{"mode":"include"}},
+		{"resource":{"resourceType":"Practitioner","id":"pr1"},"search":{"mode":"include"}},
+		{"resource":{"resourceType":"OperationOutcome","issue":[{"severity":"warning","code":"not-supported"}]},"search":{"mode":"outcome"}},
+		{"request":{"method":"DELETE","url":"Patient/p9"}}
+	]}`
+
+func testReader(t *testing.T) *Reader {
+	t.Helper()
+	bundle, err := fhir.UnmarshalBundle([]byte(testBundle))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReader(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestReaderReference(t *testing.T) {
+	r := testReader(t)
+	tests := []struct {
+		reference string
+		index     int // -1 if the reference doesn't resolve
+	}{
+		{"http://example.org/fhir/Patient/p1", 0},
+		{"Patient/p1", 0},
+		{"Patient/p1/_history/2", 0},
+		{"http://example.org/fhir/Patient/p1/_history/2", 0},
+		{"http://other.org/fhir/Patient/p1", -1},
+		{"urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11", 1},
+		{"urn:uuid:00000000-0000-4000-8000-000000000000", -1},
+		{"Organization/o1", 2},
+		{"http://other.org/fhir/Organization/o1", 2},
+		{"Practitioner/pr1", 3},
+		{"http://example.org/fhir/Practitioner/pr1", -1},
+		{"Patient/p9", -1},
+		{"p1", -1},
+		{"#p1", -1},
+	}
+	for _, test := range tests {
+		t.Run(test.reference, func(t *testing.T) {
+			entry, ok := r.Reference(test.reference)
+			if ok != (test.index >= 0) {
+				t.Fatalf("found = %v", ok)
+			}
+			if ok && entry.Entry.Resource == nil {
+				t.Fatal("entry without resource")
+			}
+			if ok && string(entry.Entry.Resource) != string(r.Entries()[test.index].Entry.Resource) {
+				t.Errorf("got %s, want entry %d", entry.Entry.Resource, test.index)
+			}
+		})
+	}
+}
+
+func TestReaderReferenceFrom(t *testing.T) {
+	r := testReader(t)
+	const uuid = "urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11"
+	tests := []struct {
+		fullUrl, reference string
+		index              int // -1 if the reference doesn't resolve
+	}{
+		{"http://example.org/fhir/Patient/p1", "Patient/p1", 0},
+		{"http://example.org/fhir/Patient/p1/_history/1", "Patient/p1/_history/2", 0},
+		{"http://example.org/fhir/Patient/p1", "Organization/o1", -1},
+		{"http://example.org/fhir/Patient/p1", "http://other.org/fhir/Organization/o1", 2},
+		{"http://example.org/fhir/Patient/p1", uuid, 1},
+		{"http://other.org/fhir/Organization/o1", "Organization/o1", 2},
+		{"http://other.org/fhir/Organization/o1", "Patient/p1", -1},
+		{uuid, "Organization/o1", 2},
+		{uuid, "Practitioner/pr1", 3},
+		{"", "Patient/p1", 0},
+	}
+	for _, test := range tests {
+		t.Run(test.fullUrl+" "+test.reference, func(t *testing.T) {
+			entry, ok := r.ReferenceFrom(test.fullUrl, test.reference)
+			if ok != (test.index >= 0) {
+				t.Fatalf("found = %v", ok)
+			}
+			if ok && string(entry.Entry.Resource) != string(r.Entries()[test.index].Entry.Resource) {
+				t.Errorf("got %s, want entry %d", entry.Entry.Resource, test.index)
+			}
+		})
+	}
+}
+
+func TestReaderEntries(t *testing.T) {
+	r := testReader(t)
+	if len(r.Entries()) != 6 || r.Entries()[5].Resource != nil {
+		t.Fatalf("entries = %+v", r.Entries())
+	}
+	// entries without search mode are matches
+	if matches := r.Matches(); len(matches) != 3 || matches[1].Mode() != fhir.SearchEntryModeMatch {
+		t.Errorf("matches = %+v", matches)
+	}
+	if included := r.Included(); len(included) != 2 {
+		t.Errorf("included = %+v", included)
+	}
+	if entries := r.Mode(fhir.SearchEntryModeInclude, fhir.SearchEntryModeOutcome); len(entries) != 3 {
+		t.Errorf("include and outcome = %+v", entries)
+	}
+	if outcome := r.Outcome(); outcome == nil || outcome.Issue[0].Code != fhir.IssueTypeNotSupported {
+		t.Errorf("outcome = %+v", outcome)
+	}
+
+	var patients []*fhir.Patient
+	if err := r.Resources(&patients); err != nil || len(patients) != 2 || *patients[0].Id != "p1" {
+		t.Errorf("patients = %v, %v", patients, err)
+	}
+	var included []*fhir.Organization
+	if err := r.Resources(&included, fhir.SearchEntryModeMatch); err != nil || len(included) != 0 {
+		t.Errorf("matching organizations = %v, %v", included, err)
+	}
+	for _, target := range []interface{}{patients, &[]fhir.Patient{}, new(int)} {
+		if err := r.Resources(target); err == nil {
+			t.Errorf("Resources(%T) returned no error", target)
+		}
+	}
+
+	if entry, ok := r.FullUrl("http://other.org/fhir/Organization/o1"); !ok || *entry.Resource.(*fhir.Organization).Id != "o1" {
+		t.Errorf("FullUrl = %+v, %v", entry, ok)
+	}
+	if _, ok := r.FullUrl("Organization/o1"); ok {
+		t.Error("FullUrl found a relative reference")
+	}
+	if total, ok := r.Total(); !ok || total != 2 {
+		t.Errorf("Total = %d, %v", total, ok)
+	}
+	if next, ok := r.Link("next"); !ok || next != "http://example.org/fhir/Patient?_count=1&_offset=1" {
+		t.Errorf("next = %s, %v", next, ok)
+	}
+	if _, ok := r.Link("previous"); ok {
+		t.Error("found a previous link")
+	}
+}
+
+func TestReaderRoundTrip(t *testing.T) {
+	b := NewBuilder(fhir.BundleTypeCollection)
+	patient := b.Add(&fhir.Patient{Id: stringPtr("p1")})
+	observation := b.Add(&fhir.Observation{Subject: &fhir.Reference{Reference: &patient}})
+	bundle, err := b.Bundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReader(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := r.Total(); ok {
+		t.Error("collection has a total")
+	}
+	entry, ok := r.Reference(observation)
+	if !ok {
+		t.Fatal("observation not found")
+	}
+	subject, ok := r.Reference(*entry.Resource.(*fhir.Observation).Subject.Reference)
+	if !ok || *subject.Resource.(*fhir.Patient).Id != "p1" {
+		t.Errorf("subject = %+v, %v", subject, ok)
+	}
+	if _, ok := r.Reference("Patient/p1"); !ok {
+		t.Error("Patient/p1 not found")
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	bundle := fhir.Bundle{Type: fhir.BundleTypeCollection, Entry: []fhir.BundleEntry{
+		{Resource: []byte(`{"resourceType":"Patient"}`)},
+		{Resource: []byte(`{"resourceType":"Unknown"}`)},
+	}}
+	if _, err := NewReader(bundle); err == nil || !strings.HasPrefix(err.Error(), "entry 1: ") {
+		t.Errorf("err = %v", err)
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client implements the RESTful API of FHIR (http://hl7.org/fhir/http.html) on top of the generated models.
+//
+// Resources are passed as pointers to generated resources like *fhir.Patient, whose type determines the resource type
+// of the request. Responses with an error status are returned as *Error carrying the OperationOutcome of the server.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+const fhirJSON = "application/fhir+json"
+
+// Client sends requests to a FHIR server.
+type Client struct {
+	// Header is sent with every request, e.g. for authorization
+	Header http.Header
+
+	baseURL    string
+	httpClient *http.Client
+}
+
+// New returns a client of the server with the given base URL, like https://example.org/fhir, which sends its requests
+// with httpClient or http.DefaultClient if it is nil.
+func New(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{Header: make(http.Header), baseURL: strings.TrimSuffix(baseURL, "/"), httpClient: httpClient}
+}
+
+// BaseURL returns the base URL of the server without trailing slash.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// Result describes the response to a successful request.
+type Result struct {
+	StatusCode int
+	// Location of the created or updated resource, including its version
+	Location string
+	// ETag of the returned version, like W/"3"
+	ETag string
+	// version id from the ETag
+	VersionId    string
+	LastModified string
+	// NotModified is set if a conditional read returned 304 Not Modified, in which case the resource is left as it is
+	NotModified bool
+	// OperationOutcome returned instead of a resource, e.g. if requested with ReturnOperationOutcome
+	Outcome *fhir.OperationOutcome
+}
+
+// Return is the content a server returns after create, update and patch.
+type Return string
+
+const (
+	ReturnMinimal          Return = "minimal"
+	ReturnRepresentation   Return = "representation"
+	ReturnOperationOutcome Return = "OperationOutcome"
+)
+
+// Option modifies a request.
+type Option func(r *http.Request)
+
+// PreferReturn asks the server to return the given content.
+func PreferReturn(value Return) Option {
+	return func(r *http.Request) {
+		r.Header.Set("Prefer", "return="+string(value))
+	}
+}
+
+// IfNoneExist makes a create conditional. The resource is only created if no resource matches the search parameters.
+func IfNoneExist(criteria url.Values) Option {
+	return func(r *http.Request) {
+		r.Header.Set("If-None-Exist", criteria.Encode())
+	}
+}
+
+// IfMatch makes an update, patch or delete conditional on the current version of the resource, which is given as
+// ETag or version id.
+func IfMatch(version string) Option {
+	return func(r *http.Request) {
+		r.Header.Set("If-Match", etag(version))
+	}
+}
+
+// IfNoneMatch makes a read conditional on the version of the resource, given as ETag or version id. If the version is
+// still current, the server returns 304 Not Modified, which is reported by Result.NotModified.
+func IfNoneMatch(version string) Option {
+	return func(r *http.Request) {
+		r.Header.Set("If-None-Match", etag(version))
+	}
+}
+
+// IfModifiedSince makes a read conditional on the modification of the resource after the given time. If it wasn't
+// modified, the server returns 304 Not Modified, which is reported by Result.NotModified.
+func IfModifiedSince(t time.Time) Option {
+	return func(r *http.Request) {
+		r.Header.Set("If-Modified-Since", t.UTC().Format(http.TimeFormat))
+	}
+}
+
+// WithHeader sets a header of the request.
+func WithHeader(key, value string) Option {
+	return func(r *http.Request) {
+		r.Header.Set(key, value)
+	}
+}
+
+// Read reads the current version of the resource with the given id into resource.
+func (c *Client) Read(ctx context.Context, id string, resource interface{}, options ...Option) (*Result, error) {
+	resourceType, err := resourceTypeOf(resource)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(ctx, http.MethodGet, resourceType+"/"+url.PathEscape(id), nil, nil, "", options, resource)
+}
+
+// VRead reads the version of the resource with the given id into resource.
+func (c *Client) VRead(ctx context.Context, id, versionId string, resource interface{}, options ...Option) (*Result, error) {
+	resourceType, err := resourceTypeOf(resource)
+	if err != nil {
+		return nil, err
+	}
+	path := resourceType + "/" + url.PathEscape(id) + "/_history/" + url.PathEscape(versionId)
+	return c.do(ctx, http.MethodGet, path, nil, nil, "", options, resource)
+}
+
+// Create creates the resource. If the server returns the created resource, it replaces resource.
+func (c *Client) Create(ctx context.Context, resource interface{}, options ...Option) (*Result, error) {
+	resourceType, err := resourceTypeOf(resource)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(ctx, http.MethodPost, resourceType, nil, body, fhirJSON, options, resource)
+}
+
+// Update updates the resource with the id of the resource or creates it if the server allows it. If the server
+// returns the updated resource, it replaces resource.
+func (c *Client) Update(ctx context.Context, resource interface{}, options ...Option) (*Result, error) {
+	resourceType, err := resourceTypeOf(resource)
+	if err != nil {
+		return nil, err
+	}
+	id := reflect.ValueOf(resource).Elem().FieldByName("Id")
+	if !id.IsValid() || id.IsNil() || id.Elem().String() == "" {
+		return nil, fmt.Errorf("update of %s without id", resourceType)
+	}
+	body, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	path := resourceType + "/" + url.PathEscape(id.Elem().String())
+	return c.do(ctx, http.MethodPut, path, nil, body, fhirJSON, options, resource)
+}
+
+// Patch patches the resource with the given id with a JSON Patch document, given as []byte or json.RawMessage like
+// the output of diff.JSONPatch, or a FHIRPath Patch given as fhir.Parameters. The type of resource determines the
+// resource type and the patched resource is read into it, if the server returns it.
+func (c *Client) Patch(ctx context.Context, id string, patch interface{}, resource interface{}, options ...Option) (*Result, error) {
+	resourceType, err := resourceTypeOf(resource)
+	if err != nil {
+		return nil, err
+	}
+	var body []byte
+	contentType := "application/json-patch+json"
+	switch patch := patch.(type) {
+	case []byte:
+		body = patch
+	case json.RawMessage:
+		body = patch
+	case fhir.Parameters:
+		contentType = fhirJSON
+		if body, err = json.Marshal(patch); err != nil {
+			return nil, err
+		}
+	case *fhir.Parameters:
+		contentType = fhirJSON
+		if body, err = json.Marshal(patch); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expected a JSON Patch or FHIRPath Patch but got %T", patch)
+	}
+	return c.do(ctx, http.MethodPatch, resourceType+"/"+url.PathEscape(id), nil, body, contentType, options, resource)
+}
+
+// Delete deletes the resource with the given type and id.
+func (c *Client) Delete(ctx context.Context, resourceType, id string, options ...Option) (*Result, error) {
+	return c.do(ctx, http.MethodDelete, resourceType+"/"+url.PathEscape(id), nil, nil, "", options, nil)
+}
+
+// History returns the history of the resource with the given type and id. Without id, it returns the history of all
+// resources of the type and without type the history of all resources.
+func (c *Client) History(ctx context.Context, resourceType, id string, query url.Values, options ...Option) (fhir.Bundle, error) {
+	path := "_history"
+	if id != "" {
+		path = resourceType + "/" + url.PathEscape(id) + "/_history"
+	} else if resourceType != "" {
+		path = resourceType + "/_history"
+	}
+	return c.bundle(ctx, c.target(path, query), options)
+}
+
+// Search returns the first page of the resources of the given type matching the query. Without type, it searches
+// resources of all types.
+func (c *Client) Search(ctx context.Context, resourceType string, query url.Values, options ...Option) (fhir.Bundle, error) {
+	return c.bundle(ctx, c.target(resourceType, query), options)
+}
+
+// Capabilities returns the CapabilityStatement of the server.
+func (c *Client) Capabilities(ctx context.Context, options ...Option) (fhir.CapabilityStatement, error) {
+	var capabilityStatement fhir.CapabilityStatement
+	_, err := c.do(ctx, http.MethodGet, "metadata", nil, nil, "", options, &capabilityStatement)
+	return capabilityStatement, err
+}
+
+// bundle gets the Bundle of a search or history. An OperationOutcome returned instead is reported as *Error, as the
+// search failed although the status is successful.
+func (c *Client) bundle(ctx context.Context, target string, options []Option) (fhir.Bundle, error) {
+	var bundle fhir.Bundle
+	r, err := c.doURL(ctx, http.MethodGet, target, nil, "", options, &bundle)
+	if err != nil {
+		return fhir.Bundle{}, err
+	}
+	if r.Outcome != nil {
+		return fhir.Bundle{}, &Error{Method: http.MethodGet, URL: target, StatusCode: r.StatusCode, Outcome: *r.Outcome}
+	}
+	return bundle, nil
+}
+
+// do sends the request and decodes the returned resource into result, unless the server returned an OperationOutcome
+// or no resource at all.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body []byte, contentType string, options []Option, result interface{}) (*Result, error) {
+	return c.doURL(ctx, method, c.target(path, query), body, contentType, options, result)
+}
+
+// target returns the URL of the path relative to the base URL with the query.
+func (c *Client) target(path string, query url.Values) string {
+	target := c.baseURL + "/" + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	return target
+}
+
+func (c *Client) doURL(ctx context.Context, method, target string, body []byte, contentType string, options []Option, result interface{}) (*Result, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range c.Header {
+		request.Header[key] = append([]string(nil), values...)
+	}
+	request.Header.Set("Accept", fhirJSON)
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	for _, option := range options {
+		option(request)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= 300 && response.StatusCode != http.StatusNotModified {
+		return nil, newError(method, target, response.StatusCode, responseBody)
+	}
+
+	r := &Result{
+		StatusCode:   response.StatusCode,
+		Location:     response.Header.Get("Location"),
+		ETag:         response.Header.Get("ETag"),
+		LastModified: response.Header.Get("Last-Modified"),
+		NotModified:  response.StatusCode == http.StatusNotModified,
+	}
+	if r.Location == "" {
+		r.Location = response.Header.Get("Content-Location")
+	}
+	r.VersionId = versionId(r.ETag)
+	if r.NotModified || len(bytes.TrimSpace(responseBody)) == 0 {
+		return r, nil
+	}
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(responseBody, &header); err != nil {
+		return nil, fmt.Errorf("%s %s: invalid response: %w", method, target, err)
+	}
+	if header.ResourceType == "OperationOutcome" {
+		if _, ok := result.(*fhir.OperationOutcome); !ok {
+			outcome, err := fhir.UnmarshalOperationOutcome(responseBody)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: invalid response: %w", method, target, err)
+			}
+			r.Outcome = &outcome
+			return r, nil
+		}
+	}
+	if result != nil {
+		if err := json.Unmarshal(responseBody, result); err != nil {
+			return nil, fmt.Errorf("%s %s: invalid response: %w", method, target, err)
+		}
+	}
+	return r, nil
+}
+
+// resourceTypeOf returns the name of the generated resource the pointer points to.
+func resourceTypeOf(resource interface{}) (string, error) {
+	t := reflect.TypeOf(resource)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct || reflect.ValueOf(resource).IsNil() {
+		return "", fmt.Errorf("expected a pointer to a resource but got %T", resource)
+	}
+	return t.Elem().Name(), nil
+}
+
+// etag returns the weak ETag of the version id, leaving ETags as they are.
+func etag(version string) string {
+	if strings.HasPrefix(version, "W/") || strings.HasPrefix(version, `"`) {
+		return version
+	}
+	return `W/"` + version + `"`
+}
+
+// versionId returns the version id of the ETag.
+func versionId(etag string) string {
+	return strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+}
@@ -0,0 +1,533 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// request is a request received by the test server.
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	header http.Header
+	body   string
+}
+
+// response is the response of the test server.
+type response struct {
+	status int
+	header map[string]string
+	body   string
+}
+
+// testServer returns a client of a server which records the requests and answers each with the response for its
+// method and path, or 404 if there is none.
+func testServer(t *testing.T, responses map[string]response) (*Client, *[]request) {
+	t.Helper()
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, request{r.Method, r.URL.Path, r.URL.Query(), r.Header, string(body)})
+		res, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			res = response{status: http.StatusNotFound}
+		}
+		for key, value := range res.header {
+			w.Header().Set(key, value)
+		}
+		w.WriteHeader(res.status)
+		io.WriteString(w, res.body)
+	}))
+	t.Cleanup(server.Close)
+	return New(server.URL+"/fhir/", server.Client()), &requests
+}
+
+func TestRead(t *testing.T) {
+	c, requests := testServer(t, map[string]response{
+		"GET /fhir/Patient/p1": {
+			status: http.StatusOK,
+			header: map[string]string{"ETag": `W/"3"`, "Last-Modified": "Mon, 19 Oct 2026 10:00:00 GMT"},
+			body:   `{"resourceType":"Patient","id":"p1","active":true}`,
+		},
+	})
+	c.Header.Set("Authorization", "Bearer token")
+	var patient fhir.Patient
+	result, err := c.Read(context.Background(), "p1", &patient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if patient.Id == nil || *patient.Id != "p1" || patient.Active == nil || !*patient.Active {
+		t.Errorf("patient = %+v", patient)
+	}
+	if result.StatusCode != http.StatusOK || result.ETag != `W/"3"` || result.VersionId != "3" || result.LastModified == "" {
+		t.Errorf("result = %+v", result)
+	}
+	r := (*requests)[0]
+	if r.header.Get("Accept") != "application/fhir+json" || r.header.Get("Authorization") != "Bearer token" {
+		t.Errorf("header = %v", r.header)
+	}
+}
+
+func TestReadNotModified(t *testing.T) {
+	c, requests := testServer(t, map[string]response{
+		"GET /fhir/Patient/p1": {status: http.StatusNotModified, header: map[string]string{"ETag": `W/"3"`}},
+	})
+	active := true
+	patient := fhir.Patient{Active: &active}
+	since := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	result, err := c.Read(context.Background(), "p1", &patient, IfNoneMatch("3"), IfModifiedSince(since))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.NotModified || result.VersionId != "3" {
+		t.Errorf("result = %+v", result)
+	}
+	if patient.Active == nil || !*patient.Active {
+		t.Errorf("patient was modified: %+v", patient)
+	}
+	header := (*requests)[0].header
+	if header.Get("If-None-Match") != `W/"3"` || header.Get("If-Modified-Since") != "Mon, 19 Oct 2026 10:00:00 GMT" {
+		t.Errorf("header = %v", header)
+	}
+}
+
+func TestVRead(t *testing.T) {
+	c, _ := testServer(t, map[string]response{
+		"GET /fhir/Patient/p1/_history/2": {status: http.StatusOK, body: `{"resourceType":"Patient","id":"p1","meta":{"versionId":"2"}}`},
+	})
+	var patient fhir.Patient
+	if _, err := c.VRead(context.Background(), "p1", "2", &patient); err != nil {
+		t.Fatal(err)
+	}
+	if patient.Meta == nil || *patient.Meta.VersionId != "2" {
+		t.Errorf("patient = %+v", patient)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	c, requests := testServer(t, map[string]response{
+		"POST /fhir/Patient": {
+			status: http.StatusCreated,
+			header: map[string]string{"Location": "http://example.org/fhir/Patient/p1/_history/1", "ETag": `W/"1"`},
+			body:   `{"resourceType":"Patient","id":"p1","active":true}`,
+		},
+	})
+	active := true
+	patient := fhir.Patient{Active: &active}
+	criteria := url.Values{"identifier": []string{"http://example.org|1"}}
+	result, err := c.Create(context.Background(), &patient, IfNoneExist(criteria), PreferReturn(ReturnRepresentation))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.StatusCode != http.StatusCreated || result.Location != "http://example.org/fhir/Patient/p1/_history/1" || result.VersionId != "1" {
+		t.Errorf("result = %+v", result)
+	}
+	if patient.Id == nil || *patient.Id != "p1" {
+		t.Errorf("patient = %+v", patient)
+	}
+	r := (*requests)[0]
+	if r.header.Get("Content-Type") != "application/fhir+json" || r.header.Get("If-None-Exist") != "identifier=http%3A%2F%2Fexample.org%7C1" || r.header.Get("Prefer") != "return=representation" {
+		t.Errorf("header = %v", r.header)
+	}
+	if r.body != `{"resourceType":"Patient","active":true}` {
+		t.Errorf("body = %s", r.body)
+	}
+}
+
+func TestCreateReturnsOperationOutcome(t *testing.T) {
+	c, _ := testServer(t, map[string]response{
+		"POST /fhir/Patient": {
+			status: http.StatusCreated,
+			body:   `{"resourceType":"OperationOutcome","issue":[{"severity":"information","code":"informational"}]}`,
+		},
+	})
+	var patient fhir.Patient
+	result, err := c.Create(context.Background(), &patient, PreferReturn(ReturnOperationOutcome))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Outcome == nil || len(result.Outcome.Issue) != 1 || result.Outcome.Issue[0].Severity != fhir.IssueSeverityInformation {
+		t.Errorf("outcome = %+v", result.Outcome)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	c, requests := testServer(t, map[string]response{
+		"PUT /fhir/Patient/p1": {status: http.StatusOK, header: map[string]string{"ETag": `W/"4"`}},
+	})
+	id := "p1"
+	patient := fhir.Patient{Id: &id}
+	result, err := c.Update(context.Background(), &patient, IfMatch("3"), PreferReturn(ReturnMinimal))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.VersionId != "4" {
+		t.Errorf("result = %+v", result)
+	}
+	r := (*requests)[0]
+	if r.header.Get("If-Match") != `W/"3"` || r.header.Get("Prefer") != "return=minimal" {
+		t.Errorf("header = %v", r.header)
+	}
+
+	if _, err := c.Update(context.Background(), &fhir.Patient{}); err == nil {
+		t.Error("expected an error for an update without id")
+	}
+}
+
+func TestPatch(t *testing.T) {
+	c, requests := testServer(t, map[string]response{
+		"PATCH /fhir/Patient/p1": {status: http.StatusOK, body: `{"resourceType":"Patient","id":"p1","active":false}`},
+	})
+	var patient fhir.Patient
+	jsonPatch := []byte(`[{"op":"replace","path":"/active","value":false}]`)
+	if _, err := c.Patch(context.Background(), "p1", jsonPatch, &patient, IfMatch(`W/"2"`)); err != nil {
+		t.Fatal(err)
+	}
+	if patient.Active == nil || *patient.Active {
+		t.Errorf("patient = %+v", patient)
+	}
+	var parameters fhir.Parameters
+	operation := fhir.ParametersParameter{Name: "operation"}
+	operation.AddCode("type", "delete").AddString("path", "Patient.active")
+	parameters.AddParameter(operation)
+	if _, err := c.Patch(context.Background(), "p1", parameters, &patient); err != nil {
+		t.Fatal(err)
+	}
+
+	first, second := (*requests)[0], (*requests)[1]
+	if first.header.Get("Content-Type") != "application/json-patch+json" || first.header.Get("If-Match") != `W/"2"` || first.body != string(jsonPatch) {
+		t.Errorf("JSON Patch request = %+v", first)
+	}
+	if second.header.Get("Content-Type") != "application/fhir+json" || !strings.Contains(second.body, `"resourceType":"Parameters"`) {
+		t.Errorf("FHIRPath Patch request = %+v", second)
+	}
+
+	if _, err := c.Patch(context.Background(), "p1", "replace", &patient); err == nil {
+		t.Error("expected an error for a patch of unknown type")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	c, requests := testServer(t, map[string]response{
+		"DELETE /fhir/Patient/p1": {status: http.StatusNoContent},
+	})
+	result, err := c.Delete(context.Background(), "Patient", "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.StatusCode != http.StatusNoContent || (*requests)[0].method != http.MethodDelete {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	c, requests := testServer(t, map[string]response{
+		"GET /fhir/Patient/p1/_history": {status: http.StatusOK, body: `{"resourceType":"Bundle","type":"history","total":2}`},
+		"GET /fhir/Patient/_history":    {status: http.StatusOK, body: `{"resourceType":"Bundle","type":"history","total":5}`},
+		"GET /fhir/_history":            {status: http.StatusOK, body: `{"resourceType":"Bundle","type":"history","total":9}`},
+	})
+	tests := []struct {
+		resourceType, id string
+		total            int
+	}{
+		{"Patient", "p1", 2},
+		{"Patient", "", 5},
+		{"", "", 9},
+	}
+	for _, test := range tests {
+		bundle, err := c.History(context.Background(), test.resourceType, test.id, url.Values{"_count": []string{"10"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bundle.Type != fhir.BundleTypeHistory || bundle.Total == nil || *bundle.Total != test.total {
+			t.Errorf("history of %s/%s = %+v", test.resourceType, test.id, bundle)
+		}
+	}
+	if got := (*requests)[0].query.Get("_count"); got != "10" {
+		t.Errorf("_count = %q", got)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	c, requests := testServer(t, map[string]response{
+		"GET /fhir/Patient": {status: http.StatusOK, body: `{"resourceType":"Bundle","type":"searchset","total":1}`},
+	})
+	bundle, err := c.Search(context.Background(), "Patient", url.Values{"name": []string{"Chalmers"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bundle.Type != fhir.BundleTypeSearchset || (*requests)[0].query.Get("name") != "Chalmers" {
+		t.Errorf("bundle = %+v, request = %+v", bundle, (*requests)[0])
+	}
+}
+
+func TestSearchOperationOutcome(t *testing.T) {
+	outcome := `{"resourceType":"OperationOutcome","issue":[{"severity":"error","code":"too-costly","diagnostics":"too many results"}]}`
+	c, _ := testServer(t, map[string]response{
+		"GET /fhir/Patient":          {status: http.StatusOK, body: outcome},
+		"GET /fhir/Patient/_history": {status: http.StatusOK, body: outcome},
+	})
+	_, err := c.Search(context.Background(), "Patient", nil)
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode != http.StatusOK || e.Outcome.Issue[0].Code != fhir.IssueTypeTooCostly {
+		t.Errorf("search err = %v, want the OperationOutcome", err)
+	}
+	if !strings.HasSuffix(err.Error(), "200 OK: too many results") {
+		t.Errorf("message = %s", err.Error())
+	}
+	if _, err := c.History(context.Background(), "Patient", "", nil); !errors.As(err, &e) {
+		t.Errorf("history err = %v, want *Error", err)
+	}
+	if _, err := c.SearchPager("Patient", nil).NextPage(context.Background()); !errors.As(err, &e) {
+		t.Errorf("pager err = %v, want *Error", err)
+	}
+}
+
+func TestError(t *testing.T) {
+	c, _ := testServer(t, map[string]response{
+		"PUT /fhir/Patient/p1": {
+			status: http.StatusPreconditionFailed,
+			body:   `{"resourceType":"OperationOutcome","issue":[{"severity":"error","code":"conflict","diagnostics":"version mismatch","expression":["Patient"]}]}`,
+		},
+		"GET /fhir/Patient/p2": {status: http.StatusGone, body: "deleted"},
+	})
+	id := "p1"
+	_, err := c.Update(context.Background(), &fhir.Patient{Id: &id}, IfMatch("1"))
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("err = %v, want *Error", err)
+	}
+	if !e.Conflict() || e.NotFound() || e.Outcome.Issue[0].Code != fhir.IssueTypeConflict {
+		t.Errorf("error = %+v", e)
+	}
+	if !strings.HasSuffix(e.Error(), "412 Precondition Failed: Patient: version mismatch") {
+		t.Errorf("message = %s", e.Error())
+	}
+
+	_, err = c.Read(context.Background(), "p2", &fhir.Patient{})
+	if !errors.As(err, &e) {
+		t.Fatalf("err = %v, want *Error", err)
+	}
+	if !e.NotFound() || e.Outcome.Issue[0].Code != fhir.IssueTypeNotFound || *e.Outcome.Issue[0].Diagnostics != "deleted" {
+		t.Errorf("error = %+v", e)
+	}
+}
+
+func TestInvalidResource(t *testing.T) {
+	c := New("http://example.org/fhir", nil)
+	if _, err := c.Read(context.Background(), "p1", fhir.Patient{}); err == nil {
+		t.Error("expected an error for a resource passed by value")
+	}
+	var patient *fhir.Patient
+	if _, err := c.Read(context.Background(), "p1", patient); err == nil {
+		t.Error("expected an error for a nil pointer")
+	}
+}
+
+func TestPager(t *testing.T) {
+	var serverURL string
+	page := func(ids []string, next string) string {
+		bundle := fhir.Bundle{Type: fhir.BundleTypeSearchset}
+		for _, id := range ids {
+			bundle.Entry = append(bundle.Entry, fhir.BundleEntry{Resource: json.RawMessage(`{"resourceType":"Patient","id":"` + id + `"}`)})
+		}
+		if next != "" {
+			bundle.Link = []fhir.BundleLink{{Relation: "next", Url: serverURL + next}}
+		}
+		bs, _ := json.Marshal(bundle)
+		return string(bs)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			io.WriteString(w, page([]string{"p1", "p2"}, "/fhir/Patient?page=2"))
+		case "2":
+			io.WriteString(w, page(nil, "/fhir/Patient?page=3"))
+		default:
+			io.WriteString(w, page([]string{"p3"}, ""))
+		}
+	}))
+	defer server.Close()
+	serverURL = server.URL
+
+	pager := New(server.URL+"/fhir", server.Client()).SearchPager("Patient", url.Values{"active": []string{"true"}})
+	var ids []string
+	for {
+		_, resource, err := pager.Next(context.Background())
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, *resource.(*fhir.Patient).Id)
+	}
+	if strings.Join(ids, ",") != "p1,p2,p3" {
+		t.Errorf("ids = %v", ids)
+	}
+	if _, err := pager.NextPage(context.Background()); err != io.EOF {
+		t.Errorf("NextPage after the last page = %v, want io.EOF", err)
+	}
+}
+
+func TestPagerURLs(t *testing.T) {
+	tests := []struct {
+		name  string
+		pager func(c *Client) *Pager
+		path  string
+		query url.Values
+	}{
+		{"search", func(c *Client) *Pager { return c.SearchPager("Patient", url.Values{"name": {"a b"}}) },
+			"/fhir/Patient", url.Values{"name": {"a b"}}},
+		{"search all types", func(c *Client) *Pager { return c.SearchPager("", nil) }, "/fhir/", url.Values{}},
+		{"system history", func(c *Client) *Pager { return c.HistoryPager("", "", nil) }, "/fhir/_history", url.Values{}},
+		{"type history", func(c *Client) *Pager { return c.HistoryPager("Patient", "", url.Values{"_count": {"2"}}) },
+			"/fhir/Patient/_history", url.Values{"_count": {"2"}}},
+		{"instance history", func(c *Client) *Pager { return c.HistoryPager("Patient", "p 1", nil) },
+			"/fhir/Patient/p 1/_history", url.Values{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, requests := testServer(t, map[string]response{
+				"GET " + test.path: {status: http.StatusOK, body: `{"resourceType":"Bundle","type":"history"}`},
+			})
+			if _, err := test.pager(c).NextPage(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if r := (*requests)[0]; r.path != test.path || !reflect.DeepEqual(r.query, test.query) {
+				t.Errorf("request = %s %v, want %s %v", r.path, r.query, test.path, test.query)
+			}
+		})
+	}
+}
+
+func TestPagerEntryWithoutResource(t *testing.T) {
+	c, _ := testServer(t, map[string]response{
+		"GET /fhir/Patient/p1/_history": {
+			status: http.StatusOK,
+			body: `{"resourceType":"Bundle","type":"history","entry":[` +
+				`{"request":{"method":"DELETE","url":"Patient/p1"},"response":{"status":"204"}},` +
+				`{"resource":{"resourceType":"Patient","id":"p1"},"response":{"status":"201"}}]}`,
+		},
+	})
+	pager := c.HistoryPager("Patient", "p1", nil)
+	entry, resource, err := pager.Next(context.Background())
+	if err != nil || resource != nil || entry.Request.Method != fhir.HTTPVerbDELETE {
+		t.Errorf("first entry = %+v, %v, %v", entry, resource, err)
+	}
+	if _, resource, err = pager.Next(context.Background()); err != nil || *resource.(*fhir.Patient).Id != "p1" {
+		t.Errorf("second entry = %v, %v", resource, err)
+	}
+	if _, _, err = pager.Next(context.Background()); err != io.EOF {
+		t.Errorf("err = %v, want io.EOF", err)
+	}
+}
+
+func TestPagerErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"error response", ""},
+		{"invalid resource", `{"resourceType":"Bundle","type":"searchset","entry":[{"resource":{"resourceType":"Unknown"}}]}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			responses := map[string]response{}
+			if test.body != "" {
+				responses["GET /fhir/Patient"] = response{status: http.StatusOK, body: test.body}
+			}
+			c, _ := testServer(t, responses)
+			if _, _, err := c.SearchPager("Patient", nil).Next(context.Background()); err == nil || err == io.EOF {
+				t.Errorf("err = %v, want an error", err)
+			}
+		})
+	}
+
+	c, _ := testServer(t, nil)
+	var e *Error
+	if _, err := c.SearchPager("Patient", nil).NextPage(context.Background()); !errors.As(err, &e) || !e.NotFound() {
+		t.Errorf("err = %v, want not found", err)
+	}
+}
+
+func TestPagerNextLinks(t *testing.T) {
+	var foreignRequests int
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		foreignRequests++
+		io.WriteString(w, `{"resourceType":"Bundle","type":"searchset"}`)
+	}))
+	defer foreign.Close()
+
+	tests := []struct {
+		name  string
+		next  func(serverURL string) string
+		pages int
+		err   string
+	}{
+		{"relative", func(string) string { return "Patient?page=2" }, 2, ""},
+		{"outside of the base URL", func(string) string { return foreign.URL + "/fhir/Patient?page=2" }, 1, "outside of the base URL"},
+		{"other path", func(serverURL string) string { return serverURL + "/other/Patient?page=2" }, 1, "outside of the base URL"},
+		{"repeated", func(serverURL string) string { return serverURL + "/fhir/Patient" }, 1, "already followed"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var serverURL string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("page") == "2" {
+					io.WriteString(w, `{"resourceType":"Bundle","type":"searchset"}`)
+					return
+				}
+				bundle := fhir.Bundle{Type: fhir.BundleTypeSearchset, Link: []fhir.BundleLink{{Relation: "next", Url: test.next(serverURL)}}}
+				bs, _ := json.Marshal(bundle)
+				w.Write(bs)
+			}))
+			defer server.Close()
+			serverURL = server.URL
+
+			c := New(server.URL+"/fhir", server.Client())
+			c.Header.Set("Authorization", "Bearer secret")
+			pager := c.SearchPager("Patient", nil)
+			pages := 0
+			var err error
+			for ; err == nil; pages++ {
+				_, err = pager.NextPage(context.Background())
+			}
+			if test.err == "" && err != io.EOF || test.err != "" && (err == io.EOF || !strings.Contains(err.Error(), test.err)) {
+				t.Errorf("err = %v, want %q", err, test.err)
+			}
+			if pages-1 != test.pages {
+				t.Errorf("got %d pages, want %d", pages-1, test.pages)
+			}
+		})
+	}
+	if foreignRequests != 0 {
+		t.Errorf("%d requests sent outside of the base URL", foreignRequests)
+	}
+}
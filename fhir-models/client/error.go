@@ -0,0 +1,112 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// Error is returned for responses with an error status and for searches and histories answered with an
+// OperationOutcome instead of a Bundle. Outcome is the OperationOutcome of the response or, if the server didn't return
+// one, an OperationOutcome with a single issue describing the status.
+type Error struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Outcome    fhir.OperationOutcome
+}
+
+func (e *Error) Error() string {
+	var messages []string
+	for _, issue := range e.Outcome.Issue {
+		if issue.Severity != fhir.IssueSeverityError && issue.Severity != fhir.IssueSeverityFatal {
+			continue
+		}
+		message := issue.Code.Code()
+		if issue.Diagnostics != nil {
+			message = *issue.Diagnostics
+		} else if issue.Details != nil && issue.Details.Text != nil {
+			message = *issue.Details.Text
+		}
+		if len(issue.Expression) > 0 {
+			message = strings.Join(issue.Expression, ", ") + ": " + message
+		}
+		messages = append(messages, message)
+	}
+	status := strconv.Itoa(e.StatusCode) + " " + http.StatusText(e.StatusCode)
+	if len(messages) == 0 {
+		return e.Method + " " + e.URL + ": " + status
+	}
+	return e.Method + " " + e.URL + ": " + status + ": " + strings.Join(messages, "; ")
+}
+
+// NotFound reports whether the resource doesn't exist or was deleted.
+func (e *Error) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound || e.StatusCode == http.StatusGone
+}
+
+// Conflict reports whether a conditional request failed, because the version didn't match or several resources
+// matched the criteria.
+func (e *Error) Conflict() bool {
+	return e.StatusCode == http.StatusConflict || e.StatusCode == http.StatusPreconditionFailed
+}
+
+func newError(method, url string, statusCode int, body []byte) *Error {
+	e := &Error{Method: method, URL: url, StatusCode: statusCode}
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if json.Unmarshal(body, &header) == nil && header.ResourceType == "OperationOutcome" {
+		if outcome, err := fhir.UnmarshalOperationOutcome(body); err == nil {
+			e.Outcome = outcome
+			return e
+		}
+	}
+	diagnostics := strings.TrimSpace(string(body))
+	if len(diagnostics) > 200 {
+		diagnostics = diagnostics[:200] + "..."
+	}
+	issue := fhir.OperationOutcomeIssue{Severity: fhir.IssueSeverityError, Code: issueType(statusCode)}
+	if diagnostics != "" {
+		issue.Diagnostics = &diagnostics
+	}
+	e.Outcome.Issue = []fhir.OperationOutcomeIssue{issue}
+	return e
+}
+
+// issueType returns the issue type corresponding to the HTTP status.
+func issueType(statusCode int) fhir.IssueType {
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return fhir.IssueTypeInvalid
+	case http.StatusUnauthorized:
+		return fhir.IssueTypeLogin
+	case http.StatusForbidden:
+		return fhir.IssueTypeForbidden
+	case http.StatusNotFound, http.StatusGone:
+		return fhir.IssueTypeNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return fhir.IssueTypeConflict
+	case http.StatusTooManyRequests:
+		return fhir.IssueTypeThrottled
+	default:
+		return fhir.IssueTypeException
+	}
+}
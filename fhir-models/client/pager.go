@@ -0,0 +1,133 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// Pager iterates over the pages of a search or history by following the next links of the returned Bundles.
+type Pager struct {
+	client  *Client
+	options []Option
+	next    string
+	visited map[string]bool
+	err     error // of the next link, returned instead of the next page
+	entries []fhir.BundleEntry
+	index   int
+	started bool
+}
+
+// SearchPager returns a pager of the results of a search for resources of the given type matching the query, which
+// may be built with search.Query. Without type, it searches resources of all types.
+func (c *Client) SearchPager(resourceType string, query url.Values, options ...Option) *Pager {
+	return &Pager{client: c, options: options, next: c.target(resourceType, query)}
+}
+
+// HistoryPager returns a pager of a history like History.
+func (c *Client) HistoryPager(resourceType, id string, query url.Values, options ...Option) *Pager {
+	path := "_history"
+	if id != "" {
+		path = resourceType + "/" + url.PathEscape(id) + "/_history"
+	} else if resourceType != "" {
+		path = resourceType + "/_history"
+	}
+	return &Pager{client: c, options: options, next: c.target(path, query)}
+}
+
+// NextPage returns the next page. Entries of the current page not returned by Next are skipped. It returns io.EOF
+// after the last page. Next links are only followed within the base URL of the client, which the Header with its
+// credentials is sent to, and only once, so that a server linking back to an earlier page doesn't loop forever. Other
+// next links are reported as error instead of the next page.
+func (p *Pager) NextPage(ctx context.Context) (fhir.Bundle, error) {
+	if p.err != nil {
+		return fhir.Bundle{}, p.err
+	}
+	if p.started && p.next == "" {
+		return fhir.Bundle{}, io.EOF
+	}
+	current := p.next
+	bundle, err := p.client.bundle(ctx, current, p.options)
+	if err != nil {
+		return fhir.Bundle{}, err
+	}
+	if p.visited == nil {
+		p.visited = make(map[string]bool)
+	}
+	p.visited[current] = true
+	p.started = true
+	p.next = ""
+	for _, link := range bundle.Link {
+		if link.Relation != "next" {
+			continue
+		}
+		next, err := p.client.resolve(current, link.Url)
+		if err == nil && p.visited[next] {
+			err = fmt.Errorf("next link %s of %s was already followed", next, current)
+		}
+		if err != nil {
+			p.err = err
+			break
+		}
+		p.next = next
+	}
+	p.entries = bundle.Entry
+	p.index = 0
+	return bundle, nil
+}
+
+// resolve returns the absolute URL of a link of the page at current, which has to be below the base URL.
+func (c *Client) resolve(current, link string) (string, error) {
+	base, err := url.Parse(current)
+	if err != nil {
+		return "", err
+	}
+	reference, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid next link %s: %w", link, err)
+	}
+	target := base.ResolveReference(reference).String()
+	if target != c.baseURL && !strings.HasPrefix(target, c.baseURL+"/") && !strings.HasPrefix(target, c.baseURL+"?") {
+		return "", fmt.Errorf("next link %s is outside of the base URL %s", target, c.baseURL)
+	}
+	return target, nil
+}
+
+// Next returns the next entry of all pages, fetching pages as needed, and its resource decoded into a pointer to
+// its type, like *fhir.Patient. Included resources and OperationOutcomes are returned as well and can be told apart
+// by the search mode of the entry. It returns io.EOF after the last entry.
+func (p *Pager) Next(ctx context.Context) (fhir.BundleEntry, interface{}, error) {
+	for p.index >= len(p.entries) {
+		if _, err := p.NextPage(ctx); err != nil {
+			return fhir.BundleEntry{}, nil, err
+		}
+	}
+	entry := p.entries[p.index]
+	p.index++
+	if entry.Resource == nil {
+		return entry, nil, nil
+	}
+	resource, err := fhir.DecodeResource(entry.Resource)
+	if err != nil {
+		return entry, nil, err
+	}
+	return entry, resource, nil
+}
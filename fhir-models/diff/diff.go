@@ -0,0 +1,372 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff computes element-level differences between two instances of a FHIR resource.
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// Op is the kind of a change.
+type Op string
+
+const (
+	OpAdd     Op = "add"
+	OpRemove  Op = "remove"
+	OpReplace Op = "replace"
+)
+
+// Step is one element of the path to a changed element. Index is -1 unless the step addresses an item of a list.
+type Step struct {
+	// JSON property name, e.g. valueQuantity or _birthDate
+	Key string
+	// FHIRPath element name, e.g. value or birthDate
+	Name  string
+	Index int
+	// FHIR type of polymorphic elements, e.g. Quantity
+	ChoiceType string
+	// FHIR type of primitive elements, e.g. date or positiveInt
+	PrimitiveType string
+}
+
+// Change is a single difference between two resources. Old is nil for additions and New is nil for removals. Both
+// hold values as decoded by encoding/json with json.Number for numbers.
+type Change struct {
+	Op           Op
+	ResourceType string
+	Steps        []Step
+	Old          interface{}
+	New          interface{}
+	// Go type of the changed value or nil if unknown
+	Type reflect.Type
+}
+
+// Path returns the FHIRPath-style path of the changed element, e.g. Patient.name[0].given[1].
+func (c Change) Path() string {
+	var b strings.Builder
+	b.WriteString(c.ResourceType)
+	for _, step := range c.Steps {
+		if step.Name != "" {
+			b.WriteByte('.')
+			b.WriteString(step.Name)
+		}
+		if step.Index >= 0 {
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(step.Index))
+			b.WriteByte(']')
+		}
+	}
+	return b.String()
+}
+
+// Pointer returns the JSON Pointer of the changed element, e.g. /name/0/given/1.
+func (c Change) Pointer() string {
+	var b strings.Builder
+	for _, step := range c.Steps {
+		if step.Key != "" {
+			b.WriteByte('/')
+			b.WriteString(strings.ReplaceAll(strings.ReplaceAll(step.Key, "~", "~0"), "/", "~1"))
+		}
+		if step.Index >= 0 {
+			b.WriteByte('/')
+			b.WriteString(strconv.Itoa(step.Index))
+		}
+	}
+	return b.String()
+}
+
+func (c Change) String() string {
+	switch c.Op {
+	case OpAdd:
+		return fmt.Sprintf("add %s = %v", c.Path(), c.New)
+	case OpRemove:
+		return fmt.Sprintf("remove %s", c.Path())
+	default:
+		return fmt.Sprintf("replace %s = %v", c.Path(), c.New)
+	}
+}
+
+// Options control which differences are reported.
+type Options struct {
+	// IgnoreVersionMeta ignores differences in Meta.VersionId and Meta.LastUpdated.
+	IgnoreVersionMeta bool
+}
+
+// Diff returns the changes which turn resource a into resource b. Both have to be resources of the same type like
+// fhir.Patient or pointers to them.
+func Diff(a, b interface{}, options Options) ([]Change, error) {
+	t := reflect.TypeOf(a)
+	if t != reflect.TypeOf(b) {
+		return nil, fmt.Errorf("can't diff a %v with a %v", t, reflect.TypeOf(b))
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	x, err := decode(a)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decode(b)
+	if err != nil {
+		return nil, err
+	}
+	if options.IgnoreVersionMeta {
+		stripVersionMeta(x)
+		stripVersionMeta(y)
+	}
+	resourceType, _ := x["resourceType"].(string)
+	if resourceType == "" {
+		resourceType = t.Name()
+	}
+	d := differ{resourceType: resourceType}
+	d.object(nil, x, y, t)
+	return d.changes, nil
+}
+
+func decode(resource interface{}) (map[string]interface{}, error) {
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var value map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func stripVersionMeta(resource map[string]interface{}) {
+	meta, ok := resource["meta"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	delete(meta, "versionId")
+	delete(meta, "lastUpdated")
+	if len(meta) == 0 {
+		delete(resource, "meta")
+	}
+}
+
+type differ struct {
+	resourceType string
+	changes      []Change
+}
+
+func (d *differ) add(op Op, steps []Step, old, new interface{}, t reflect.Type) {
+	d.changes = append(d.changes, Change{
+		Op:           op,
+		ResourceType: d.resourceType,
+		Steps:        append([]Step(nil), steps...),
+		Old:          old,
+		New:          new,
+		Type:         t,
+	})
+}
+
+// object diffs two JSON objects whose Go type is t, which is nil for unknown elements.
+func (d *differ) object(steps []Step, a, b map[string]interface{}, t reflect.Type) {
+	for _, key := range keys(a, b, t) {
+		if key == "resourceType" {
+			continue
+		}
+		x, inA := a[key]
+		y, inB := b[key]
+		fieldType := fieldType(t, key)
+		step := step(t, key)
+		switch {
+		case !inA:
+			d.add(OpAdd, append(steps, step), nil, y, fieldType)
+		case !inB:
+			d.add(OpRemove, append(steps, step), x, nil, fieldType)
+		default:
+			d.value(append(steps, step), x, y, fieldType)
+		}
+	}
+}
+
+func (d *differ) value(steps []Step, a, b interface{}, t reflect.Type) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch x := a.(type) {
+	case map[string]interface{}:
+		if y, ok := b.(map[string]interface{}); ok && x["resourceType"] == y["resourceType"] {
+			if t != nil && t.Kind() != reflect.Struct {
+				t = resourceGoType(x["resourceType"])
+			}
+			d.object(steps, x, y, t)
+			return
+		}
+	case []interface{}:
+		if y, ok := b.([]interface{}); ok {
+			d.list(steps, x, y, t)
+			return
+		}
+	case json.Number:
+		if y, ok := b.(json.Number); ok && fhir.EqualDecimal(x, y) {
+			return
+		}
+	default:
+		if a == b {
+			return
+		}
+	}
+	d.add(OpReplace, steps, a, b, t)
+}
+
+// list diffs two JSON arrays item by item. Items missing in a are added and surplus items of a are removed from the
+// end, so that the changes can be applied in order.
+func (d *differ) list(steps []Step, a, b []interface{}, t reflect.Type) {
+	var itemType reflect.Type
+	if t != nil && t.Kind() == reflect.Slice {
+		itemType = t.Elem()
+	}
+	last := len(steps) - 1
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		steps[last].Index = i
+		d.value(steps, a[i], b[i], itemType)
+	}
+	for i := n; i < len(b); i++ {
+		steps[last].Index = i
+		d.add(OpAdd, steps, nil, b[i], itemType)
+	}
+	for i := len(a) - 1; i >= n; i-- {
+		steps[last].Index = i
+		d.add(OpRemove, steps, a[i], nil, itemType)
+	}
+	steps[last].Index = -1
+}
+
+// keys returns the keys of both objects in the order of the fields of t followed by unknown keys in lexical order.
+func keys(a, b map[string]interface{}, t reflect.Type) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var result []string
+	if t != nil {
+		for i := 0; i < t.NumField(); i++ {
+			key := jsonName(t.Field(i))
+			_, inA := a[key]
+			_, inB := b[key]
+			if inA || inB {
+				seen[key] = true
+				result = append(result, key)
+			}
+		}
+	}
+	var unknown []string
+	for _, m := range []map[string]interface{}{a, b} {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				unknown = append(unknown, key)
+			}
+		}
+	}
+	sort.Strings(unknown)
+	return append(result, unknown...)
+}
+
+func jsonName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("json"), ",")[0]
+}
+
+func structField(t reflect.Type, key string) (reflect.StructField, bool) {
+	if t == nil {
+		return reflect.StructField{}, false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == key {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func fieldType(t reflect.Type, key string) reflect.Type {
+	if field, ok := structField(t, key); ok {
+		return field.Type
+	}
+	return nil
+}
+
+// step returns the path step of the property key of an object of type t. Polymorphic elements are named without
+// their type suffix. The property _x holding the id and extensions of the primitive x is named like the primitive, so
+// that its extensions have the path x.extension.
+func step(t reflect.Type, key string) Step {
+	if strings.HasPrefix(key, "_") {
+		primitive := step(t, key[1:])
+		return Step{Key: key, Name: primitive.Name, Index: -1}
+	}
+	s := Step{Key: key, Name: key, Index: -1}
+	field, ok := structField(t, key)
+	if !ok {
+		return s
+	}
+	s.PrimitiveType, _ = fhir.PrimitiveTypeCode(t.Name(), field.Name)
+	for i := len(key) - 1; i > 0; i-- {
+		if !unicode.IsUpper(rune(key[i])) {
+			continue
+		}
+		// polymorphic elements have an accessor named after the element
+		if _, ok := reflect.PtrTo(t).MethodByName(strings.Title(key[:i])); ok && strings.HasPrefix(field.Name, strings.Title(key[:i])) {
+			s.Name = key[:i]
+			s.ChoiceType = choiceType(key[i:], field.Type)
+			return s
+		}
+	}
+	return s
+}
+
+func choiceType(suffix string, t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Struct {
+		return t.Name()
+	}
+	return strings.ToLower(suffix[:1]) + suffix[1:]
+}
+
+// resourceGoType returns the Go type of the resource with the given type, which is needed to diff contained resources
+// by their elements, or nil if the type is unknown.
+func resourceGoType(resourceType interface{}) reflect.Type {
+	name, ok := resourceType.(string)
+	if !ok {
+		return nil
+	}
+	header, err := json.Marshal(map[string]string{"resourceType": name})
+	if err != nil {
+		return nil
+	}
+	resource, err := fhir.DecodeResource(header)
+	if err != nil {
+		return nil
+	}
+	return reflect.TypeOf(resource).Elem()
+}
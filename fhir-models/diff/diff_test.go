@@ -0,0 +1,176 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+func TestChangePath(t *testing.T) {
+	tests := []struct {
+		steps   []Step
+		path    string
+		pointer string
+	}{
+		{nil, "Patient", ""},
+		{[]Step{{Key: "gender", Name: "gender", Index: -1}}, "Patient.gender", "/gender"},
+		{[]Step{{Key: "name", Name: "name", Index: 0}, {Key: "given", Name: "given", Index: 1}},
+			"Patient.name[0].given[1]", "/name/0/given/1"},
+		{[]Step{{Key: "name", Name: "name", Index: -1}}, "Patient.name", "/name"},
+		{[]Step{{Key: "deceasedBoolean", Name: "deceased", Index: -1, ChoiceType: "boolean"}}, "Patient.deceased",
+			"/deceasedBoolean"},
+		{[]Step{{Key: "_birthDate", Name: "birthDate", Index: -1}, {Key: "extension", Name: "extension", Index: 0}},
+			"Patient.birthDate.extension[0]", "/_birthDate/extension/0"},
+		{[]Step{{Key: "a/b~c", Name: "x", Index: -1}}, "Patient.x", "/a~1b~0c"},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			change := Change{ResourceType: "Patient", Steps: test.steps}
+			if path := change.Path(); path != test.path {
+				t.Errorf("Path() = %s, want %s", path, test.path)
+			}
+			if pointer := change.Pointer(); pointer != test.pointer {
+				t.Errorf("Pointer() = %s, want %s", pointer, test.pointer)
+			}
+		})
+	}
+}
+
+func unmarshalPatient(t *testing.T, s string) *fhir.Patient {
+	t.Helper()
+	patient, err := fhir.UnmarshalPatient([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &patient
+}
+
+// changeStrings returns the changes as strings with their JSON pointers.
+func changeStrings(changes []Change) []string {
+	var result []string
+	for _, change := range changes {
+		result = append(result, change.String()+" "+change.Pointer())
+	}
+	return result
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		changes []string
+	}{
+		{"equal",
+			`{"resourceType":"Patient","gender":"male","name":[{"family":"Doe"}]}`,
+			`{"resourceType":"Patient","gender":"male","name":[{"family":"Doe"}]}`,
+			nil},
+		{"elements",
+			`{"resourceType":"Patient","gender":"male","name":[{"family":"Doe","given":["John"]}],"telecom":[{"value":"1"}]}`,
+			`{"resourceType":"Patient","gender":"female","name":[{"family":"Doe","given":["John","Jim"]}],"active":true}`,
+			[]string{
+				"add Patient.active = true /active",
+				"add Patient.name[0].given[1] = Jim /name/0/given/1",
+				"remove Patient.telecom /telecom",
+				"replace Patient.gender = female /gender",
+			}},
+		{"list items removed from the end",
+			`{"resourceType":"Patient","name":[{"given":["A","B","C"]}]}`,
+			`{"resourceType":"Patient","name":[{"given":["A"]}]}`,
+			[]string{
+				"remove Patient.name[0].given[2] /name/0/given/2",
+				"remove Patient.name[0].given[1] /name/0/given/1",
+			}},
+		{"choice types",
+			`{"resourceType":"Patient","deceasedBoolean":false}`,
+			`{"resourceType":"Patient","deceasedDateTime":"2020-01-01"}`,
+			[]string{
+				"remove Patient.deceased /deceasedBoolean",
+				"add Patient.deceased = 2020-01-01 /deceasedDateTime",
+			}},
+		{"primitive extensions",
+			`{"resourceType":"Patient","birthDate":"1974-12-25","_birthDate":{"extension":[{"url":"http://example.org/a","valueString":"x"}]}}`,
+			`{"resourceType":"Patient","birthDate":"1974-12-25","_birthDate":{"id":"b","extension":[{"url":"http://example.org/a","valueString":"y"}]}}`,
+			[]string{
+				"add Patient.birthDate.id = b /_birthDate/id",
+				"replace Patient.birthDate.extension[0].value = y /_birthDate/extension/0/valueString",
+			}},
+		{"contained resources",
+			`{"resourceType":"Patient","contained":[{"resourceType":"Organization","id":"o","name":"A"}]}`,
+			`{"resourceType":"Patient","contained":[{"resourceType":"Organization","id":"o","name":"B"}]}`,
+			[]string{"replace Patient.contained[0].name = B /contained/0/name"}},
+		{"equal decimals",
+			`{"resourceType":"Patient","extension":[{"url":"http://example.org/d","valueDecimal":1.5}]}`,
+			`{"resourceType":"Patient","extension":[{"url":"http://example.org/d","valueDecimal":1.50}]}`,
+			nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changes, err := Diff(unmarshalPatient(t, test.a), unmarshalPatient(t, test.b), Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := changeStrings(changes); !reflect.DeepEqual(got, test.changes) {
+				t.Errorf("got changes %q, want %q", got, test.changes)
+			}
+		})
+	}
+}
+
+func TestDiffIgnoreVersionMeta(t *testing.T) {
+	a := unmarshalPatient(t, `{"resourceType":"Patient","meta":{"versionId":"1","lastUpdated":"2020-01-01T00:00:00Z"},"gender":"male"}`)
+	b := unmarshalPatient(t, `{"resourceType":"Patient","meta":{"versionId":"2","lastUpdated":"2021-01-01T00:00:00Z"},"gender":"male"}`)
+	c := unmarshalPatient(t, `{"resourceType":"Patient","meta":{"versionId":"2","source":"#s"},"gender":"male"}`)
+
+	changes, err := Diff(a, b, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{
+		"replace Patient.meta.versionId = 2 /meta/versionId",
+		"replace Patient.meta.lastUpdated = 2021-01-01T00:00:00Z /meta/lastUpdated",
+	}; !reflect.DeepEqual(changeStrings(changes), want) {
+		t.Errorf("got changes %q, want %q", changeStrings(changes), want)
+	}
+	if changes, err = Diff(a, b, Options{IgnoreVersionMeta: true}); err != nil || len(changes) != 0 {
+		t.Errorf("got changes %q, %v, want none", changeStrings(changes), err)
+	}
+	changes, err = Diff(a, c, Options{IgnoreVersionMeta: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"add Patient.meta = map[source:#s] /meta"}; !reflect.DeepEqual(changeStrings(changes), want) {
+		t.Errorf("got changes %q, want %q", changeStrings(changes), want)
+	}
+}
+
+func TestDiffTypes(t *testing.T) {
+	if _, err := Diff(&fhir.Patient{}, &fhir.Observation{}, Options{}); err == nil {
+		t.Error("no error diffing a Patient with an Observation")
+	}
+	changes, err := Diff(fhir.Patient{}, fhir.Patient{Active: boolPtr(true)}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Type != reflect.TypeOf(boolPtr(true)) {
+		t.Errorf("changes = %v, want one of type *bool", changes)
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,325 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+type jsonPatchOperation struct {
+	Op    Op          `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// JSONPatch returns the changes as JSON Patch document according to RFC 6902.
+func JSONPatch(changes []Change) ([]byte, error) {
+	operations := make([]jsonPatchOperation, 0, len(changes))
+	for _, change := range changes {
+		operations = append(operations, jsonPatchOperation{Op: change.Op, Path: change.Pointer(), Value: change.New})
+	}
+	return json.Marshal(operations)
+}
+
+// FHIRPathPatch returns the changes as FHIRPath Patch, a Parameters resource with one operation parameter per
+// change. Additions and removals of whole lists are split into one operation per item.
+func FHIRPathPatch(changes []Change) (fhir.Parameters, error) {
+	var parameters fhir.Parameters
+	changes = mergeChoiceChanges(expandElements(changes))
+	for _, change := range changes {
+		if err := appendOperations(&parameters, change); err != nil {
+			return fhir.Parameters{}, err
+		}
+	}
+	return parameters, nil
+}
+
+// mergeChoiceChanges turns the removal of one type of a polymorphic element and the addition of another type into a
+// single replacement, since FHIRPath Patch addresses both by the same path.
+func mergeChoiceChanges(changes []Change) []Change {
+	var result []Change
+	merged := make(map[int]bool)
+	for i, change := range changes {
+		if merged[i] {
+			continue
+		}
+		last := change.Steps[len(change.Steps)-1]
+		if last.ChoiceType != "" && change.Op != OpReplace {
+			for j := i + 1; j < len(changes); j++ {
+				if other := changes[j]; other.Op != change.Op && other.Op != OpReplace && other.Path() == change.Path() {
+					if change.Op == OpRemove {
+						change = other
+					}
+					change.Op = OpReplace
+					merged[j] = true
+					break
+				}
+			}
+		}
+		result = append(result, change)
+	}
+	return result
+}
+
+// expandElements replaces the changes of whole _x properties, which hold the id and extensions of the primitive x in
+// JSON, by changes of the id and extension of the primitive element, since FHIRPath Patch addresses them as x.id and
+// x.extension. The ids and extensions of removed primitives are removed with them.
+func expandElements(changes []Change) []Change {
+	removed := make(map[string]bool)
+	for _, change := range changes {
+		if change.Op == OpRemove {
+			removed[change.Pointer()] = true
+		}
+	}
+	var result []Change
+	for _, change := range changes {
+		if last := change.Steps[len(change.Steps)-1]; strings.HasPrefix(last.Key, "_") {
+			result = append(result, elementChanges(change, removed)...)
+		} else {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+// elementChanges returns the changes of the ids and extensions of the change of a _x property or of one of its items,
+// leaving out the removals of primitives with a pointer in removed.
+func elementChanges(change Change, removed map[string]bool) []Change {
+	if change.Op == OpReplace {
+		// the id and extensions of an item of a list are replaced by null or set where they were null
+		if change.Old != nil && change.New != nil {
+			return []Change{change}
+		}
+		if change.Old == nil {
+			change.Op = OpAdd
+		} else {
+			change.Op = OpRemove
+		}
+	}
+	last := len(change.Steps) - 1
+	value := change.New
+	if change.Op == OpRemove {
+		value = change.Old
+		primitive := change
+		primitive.Steps = append([]Step(nil), change.Steps...)
+		primitive.Steps[last].Key = change.Steps[last].Key[1:]
+		if removed[primitive.Pointer()] {
+			return nil
+		}
+		primitive.Steps[last].Index = -1
+		if removed[primitive.Pointer()] {
+			return nil
+		}
+	}
+	var result []Change
+	switch v := value.(type) {
+	case []interface{}:
+		// all items of a list like _given, removed from the end
+		for i := range v {
+			if change.Op == OpRemove {
+				i = len(v) - 1 - i
+			}
+			item := change
+			item.Steps = append([]Step(nil), change.Steps...)
+			item.Steps[last].Index = i
+			item.Old, item.New = nil, nil
+			if change.Op == OpRemove {
+				item.Old = v[i]
+			} else {
+				item.New = v[i]
+			}
+			result = append(result, elementChanges(item, removed)...)
+		}
+	case map[string]interface{}:
+		elementType := reflect.TypeOf(fhir.Element{})
+		for _, key := range keys(v, nil, elementType) {
+			property := change
+			property.Steps = append(append([]Step(nil), change.Steps...), step(elementType, key))
+			property.Old, property.New = nil, nil
+			if change.Op == OpRemove {
+				property.Old = v[key]
+			} else {
+				property.New = v[key]
+			}
+			property.Type = fieldType(elementType, key)
+			result = append(result, property)
+		}
+	}
+	return result
+}
+
+func appendOperations(parameters *fhir.Parameters, change Change) error {
+	last := change.Steps[len(change.Steps)-1]
+	switch change.Op {
+	case OpReplace:
+		return appendOperation(parameters, "replace", change.Path(), func(operation *fhir.ParametersParameter) error {
+			return appendValue(operation, change.New, change.Type, last.typeCode())
+		})
+	case OpRemove:
+		if items, ok := change.Old.([]interface{}); ok && last.Index < 0 {
+			for i := len(items) - 1; i >= 0; i-- {
+				path := fmt.Sprintf("%s[%d]", change.Path(), i)
+				if err := appendOperation(parameters, "delete", path, nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return appendOperation(parameters, "delete", change.Path(), nil)
+	default:
+		if last.Index >= 0 {
+			list := change
+			list.Steps = append(append([]Step(nil), change.Steps[:len(change.Steps)-1]...), Step{Name: last.Name, Index: -1})
+			return appendOperation(parameters, "insert", list.Path(), func(operation *fhir.ParametersParameter) error {
+				operation.AddInteger("index", last.Index)
+				return appendValue(operation, change.New, change.Type, last.typeCode())
+			})
+		}
+		parent := change
+		parent.Steps = change.Steps[:len(change.Steps)-1]
+		values := []interface{}{change.New}
+		itemType := change.Type
+		if items, ok := change.New.([]interface{}); ok {
+			values = items
+			if itemType != nil && itemType.Kind() == reflect.Slice {
+				itemType = itemType.Elem()
+			}
+		}
+		for _, value := range values {
+			value := value
+			err := appendOperation(parameters, "add", parent.Path(), func(operation *fhir.ParametersParameter) error {
+				operation.AddString("name", last.Name)
+				return appendValue(operation, value, itemType, last.typeCode())
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func appendOperation(parameters *fhir.Parameters, operationType, path string, build func(operation *fhir.ParametersParameter) error) error {
+	operation := fhir.ParametersParameter{Name: "operation"}
+	operation.AddCode("type", operationType).AddString("path", path)
+	if build != nil {
+		if err := build(&operation); err != nil {
+			return err
+		}
+	}
+	parameters.AddParameter(operation)
+	return nil
+}
+
+// appendValue appends a value part to the operation. The FHIR type of the value is taken from the step of the
+// element or derived from the Go type t. Values of backbone elements are appended as parts.
+func appendValue(operation *fhir.ParametersParameter, value interface{}, t reflect.Type, stepType string) error {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	part := fhir.ParametersParameter{Name: "value"}
+	fhirType := stepType
+	if fhirType == "" {
+		fhirType = typeCode(t)
+	}
+	if fhirType == "Resource" {
+		bytes, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		part.Resource = bytes
+		operation.AddParameter(part)
+		return nil
+	}
+	if field := reflect.ValueOf(&part).Elem().FieldByName("Value" + strings.Title(fhirType)); field.IsValid() {
+		if err := setValue(field, value); err != nil {
+			return err
+		}
+		operation.AddParameter(part)
+		return nil
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok || t == nil || t.Kind() != reflect.Struct {
+		return fmt.Errorf("can't express a value of type %s in a FHIRPath Patch", fhirType)
+	}
+	for _, key := range keys(object, nil, t) {
+		values := []interface{}{object[key]}
+		itemType := fieldType(t, key)
+		if items, ok := object[key].([]interface{}); ok {
+			values = items
+			itemType = itemType.Elem()
+		}
+		s := step(t, key)
+		for _, v := range values {
+			if err := appendValue(&part, v, itemType, s.typeCode()); err != nil {
+				return err
+			}
+			part.Part[len(part.Part)-1].Name = s.Name
+		}
+	}
+	operation.AddParameter(part)
+	return nil
+}
+
+// typeCode returns the FHIR type the step determines for its values or the empty string if it has to be derived from
+// the Go type.
+func (s Step) typeCode() string {
+	if s.ChoiceType != "" {
+		return s.ChoiceType
+	}
+	return s.PrimitiveType
+}
+
+// typeCode returns the FHIR type of values of the Go type t.
+func typeCode(t reflect.Type) string {
+	if t == nil {
+		return "string"
+	}
+	switch {
+	case t == reflect.TypeOf(json.RawMessage{}):
+		return "Resource"
+	case t == reflect.TypeOf(json.Number("")):
+		return "decimal"
+	case t.Kind() == reflect.Struct:
+		return t.Name()
+	case t.Kind() == reflect.Bool:
+		return "boolean"
+	case t.Kind() == reflect.Int && t.Name() == "int":
+		return "integer"
+	case t.Kind() == reflect.Int:
+		// enums
+		return "code"
+	default:
+		return "string"
+	}
+}
+
+func setValue(field reflect.Value, value interface{}) error {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	ptr := reflect.New(field.Type().Elem())
+	if err := json.Unmarshal(bytes, ptr.Interface()); err != nil {
+		return err
+	}
+	field.Set(ptr)
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+func operationValue(t *testing.T, parameters fhir.Parameters, path string) fhir.ParametersParameter {
+	for _, operation := range parameters.GetAll("operation") {
+		if p, _ := operation.GetString("path"); p == path {
+			value, ok := operation.Get("value")
+			if !ok {
+				t.Fatalf("operation on %s has no value", path)
+			}
+			return value
+		}
+	}
+	t.Fatalf("no operation on %s in %v", path, parameters)
+	return fhir.ParametersParameter{}
+}
+
+func TestJSONPatch(t *testing.T) {
+	a := unmarshalPatient(t, `{"resourceType":"Patient","gender":"male","name":[{"given":["John"]}],"telecom":[{"value":"1"}]}`)
+	b := unmarshalPatient(t, `{"resourceType":"Patient","gender":"female","name":[{"given":["John","Jim"],"_given":[null,{"id":"g"}]}],
+		"birthDate":"1974-12-25","_birthDate":{"extension":[{"url":"http://example.org/a","valueString":"x"}]}}`)
+	changes, err := Diff(a, b, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	patch, err := JSONPatch(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"op":"add","path":"/name/0/given/1","value":"Jim"},` +
+		`{"op":"add","path":"/name/0/_given","value":[null,{"id":"g"}]},` +
+		`{"op":"remove","path":"/telecom"},` +
+		`{"op":"replace","path":"/gender","value":"female"},` +
+		`{"op":"add","path":"/birthDate","value":"1974-12-25"},` +
+		`{"op":"add","path":"/_birthDate","value":{"extension":[{"url":"http://example.org/a","valueString":"x"}]}}]`
+	if string(patch) != want {
+		t.Errorf("got %s, want %s", patch, want)
+	}
+}
+
+// operations returns the type, path and name of the operations of the FHIRPath Patch.
+func operations(parameters fhir.Parameters) []string {
+	var result []string
+	for _, operation := range parameters.GetAll("operation") {
+		s, _ := operation.GetCode("type")
+		path, _ := operation.GetString("path")
+		s += " " + path
+		if name, ok := operation.GetString("name"); ok {
+			s += " " + name
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+func TestFHIRPathPatchPrimitiveExtensions(t *testing.T) {
+	a := unmarshalPatient(t, `{"resourceType":"Patient","name":[{"given":["John"]}],"birthDate":"1974-12-25",
+		"_gender":{"id":"g"},"gender":"male"}`)
+	b := unmarshalPatient(t, `{"resourceType":"Patient","name":[{"given":["John","Jim"],"_given":[null,{"id":"g2"}]}],
+		"birthDate":"1974-12-25","_birthDate":{"extension":[{"url":"http://example.org/a","valueString":"x"}]}}`)
+	tests := []struct {
+		name       string
+		a, b       *fhir.Patient
+		operations []string
+	}{
+		{"add", a, b, []string{
+			"insert Patient.name[0].given",
+			"add Patient.name[0].given[1] id",
+			"delete Patient.gender",
+			"add Patient.birthDate extension",
+		}},
+		{"remove", b, a, []string{
+			"delete Patient.name[0].given[1]",
+			"add Patient gender",
+			"add Patient.gender id",
+			"delete Patient.birthDate.extension[0]",
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changes, err := Diff(test.a, test.b, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			patch, err := FHIRPathPatch(changes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := operations(patch); !reflect.DeepEqual(got, test.operations) {
+				t.Errorf("got operations %q, want %q", got, test.operations)
+			}
+		})
+	}
+
+	changes, err := Diff(a, b, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	patch, err := FHIRPathPatch(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	extension := operationValue(t, patch, "Patient.birthDate")
+	if url, ok := extension.GetUri("url"); !ok || url != "http://example.org/a" {
+		t.Errorf("url = %q, %v, want a valueUri", url, ok)
+	}
+	if value, ok := extension.GetString("value"); !ok || value != "x" {
+		t.Errorf("value = %q, %v", value, ok)
+	}
+}
+
+func TestFHIRPathPatchContainedComplexValue(t *testing.T) {
+	a := fhir.Patient{Contained: []json.RawMessage{
+		json.RawMessage(`{"resourceType":"Encounter","id":"e","status":"finished","class":{"code":"AMB"}}`),
+	}}
+	b := fhir.Patient{Contained: []json.RawMessage{
+		json.RawMessage(`{"resourceType":"Encounter","id":"e","status":"finished","class":{"code":"AMB"},"diagnosis":[{"condition":{"reference":"Condition/0"},"rank":2}]}`),
+	}}
+	changes, err := Diff(a, b, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	patch, err := FHIRPathPatch(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := operationValue(t, patch, "Patient.contained[0]")
+	if condition, ok := value.GetReference("condition"); !ok || *condition.Reference != "Condition/0" {
+		t.Errorf("condition = %v, %v", condition, ok)
+	}
+	if rank, ok := value.Get("rank"); !ok || rank.ValuePositiveInt == nil || *rank.ValuePositiveInt != 2 {
+		t.Errorf("rank = %+v, want valuePositiveInt 2", rank)
+	}
+}
+
+func TestFHIRPathPatchIntegerTypes(t *testing.T) {
+	one, two := 1, 2
+	a := fhir.Bundle{Type: fhir.BundleTypeSearchset, Total: &one}
+	b := fhir.Bundle{Type: fhir.BundleTypeSearchset, Total: &two}
+	changes, err := Diff(a, b, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	patch, err := FHIRPathPatch(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := operationValue(t, patch, "Bundle.total"); v.ValueUnsignedInt == nil || *v.ValueUnsignedInt != 2 {
+		t.Errorf("total = %+v, want valueUnsignedInt 2", v)
+	}
+}
+
+func TestFHIRPathPatchPrimitiveTypes(t *testing.T) {
+	before, after := "1974-12-25", "1974-12-26"
+	a := fhir.Patient{BirthDate: &before}
+	b := fhir.Patient{BirthDate: &after}
+	changes, err := Diff(a, b, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	patch, err := FHIRPathPatch(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := operationValue(t, patch, "Patient.birthDate"); v.ValueDate == nil || *v.ValueDate != after || v.ValueString != nil {
+		t.Errorf("birthDate = %+v, want valueDate %s", v, after)
+	}
+}
+
+func TestDiffEqualDecimals(t *testing.T) {
+	a := fhir.Quantity{Value: numberPtr("1.5")}
+	b := fhir.Quantity{Value: numberPtr("1.50")}
+	changes, err := Diff(&fhir.Observation{ValueQuantity: &a}, &fhir.Observation{ValueQuantity: &b}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("changes = %v, want none", changes)
+	}
+}
+
+func numberPtr(s string) *json.Number {
+	n := json.Number(s)
+	return &n
+}
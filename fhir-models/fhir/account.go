@@ -14,31 +14,41 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Account is documented here http://hl7.org/fhir/StructureDefinition/Account
 type Account struct {
-	Id                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            AccountStatus      `bson:"status" json:"status"`
-	Type              *CodeableConcept   `bson:"type,omitempty" json:"type,omitempty"`
-	Name              *string            `bson:"name,omitempty" json:"name,omitempty"`
-	Subject           []Reference        `bson:"subject,omitempty" json:"subject,omitempty"`
-	ServicePeriod     *Period            `bson:"servicePeriod,omitempty" json:"servicePeriod,omitempty"`
-	Coverage          []AccountCoverage  `bson:"coverage,omitempty" json:"coverage,omitempty"`
-	Owner             *Reference         `bson:"owner,omitempty" json:"owner,omitempty"`
-	Description       *string            `bson:"description,omitempty" json:"description,omitempty"`
-	Guarantor         []AccountGuarantor `bson:"guarantor,omitempty" json:"guarantor,omitempty"`
-	PartOf            *Reference         `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Id                   *string            `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element           `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               AccountStatus      `bson:"status" json:"status"`
+	StatusElement        *Element           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 *CodeableConcept   `bson:"type,omitempty" json:"type,omitempty"`
+	Name                 *string            `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element           `bson:"_name,omitempty" json:"_name,omitempty"`
+	Subject              []Reference        `bson:"subject,omitempty" json:"subject,omitempty"`
+	ServicePeriod        *Period            `bson:"servicePeriod,omitempty" json:"servicePeriod,omitempty"`
+	Coverage             []AccountCoverage  `bson:"coverage,omitempty" json:"coverage,omitempty"`
+	Owner                *Reference         `bson:"owner,omitempty" json:"owner,omitempty"`
+	Description          *string            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element           `bson:"_description,omitempty" json:"_description,omitempty"`
+	Guarantor            []AccountGuarantor `bson:"guarantor,omitempty" json:"guarantor,omitempty"`
+	PartOf               *Reference         `bson:"partOf,omitempty" json:"partOf,omitempty"`
 }
 type AccountCoverage struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
@@ -46,6 +56,7 @@ type AccountCoverage struct {
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Coverage          Reference   `bson:"coverage" json:"coverage"`
 	Priority          *int        `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement   *Element    `bson:"_priority,omitempty" json:"_priority,omitempty"`
 }
 type AccountGuarantor struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
@@ -53,25 +64,1970 @@ type AccountGuarantor struct {
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Party             Reference   `bson:"party" json:"party"`
 	OnHold            *bool       `bson:"onHold,omitempty" json:"onHold,omitempty"`
+	OnHoldElement     *Element    `bson:"_onHold,omitempty" json:"_onHold,omitempty"`
 	Period            *Period     `bson:"period,omitempty" json:"period,omitempty"`
 }
-type OtherAccount Account
 
 // MarshalJSON marshals the given Account as JSON into a byte slice
 func (r Account) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherAccount
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherAccount: OtherAccount(r),
-		ResourceType: "Account",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Account as JSON object with the members in the order of the fields
+func (r Account) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Account\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if len(r.Subject) > 0 {
+		e.key("subject")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subject {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ServicePeriod != nil {
+		e.key("servicePeriod")
+		r.ServicePeriod.appendJSON(e)
+	}
+	if len(r.Coverage) > 0 {
+		e.key("coverage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Coverage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Owner != nil {
+		e.key("owner")
+		r.Owner.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Guarantor) > 0 {
+		e.key("guarantor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Guarantor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PartOf != nil {
+		e.key("partOf")
+		r.PartOf.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Account from JSON. Unknown members are ignored.
+func (r *Account) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Account
+func (r *Account) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "subject":
+			a := d.array()
+			r.Subject = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = append(r.Subject, v)
+			}
+			if r.Subject == nil && !a.isNull {
+				r.Subject = []Reference{}
+			}
+		case "servicePeriod":
+			if d.null() {
+				r.ServicePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ServicePeriod = &v
+			}
+		case "coverage":
+			a := d.array()
+			r.Coverage = nil
+			for a.next() {
+				var v AccountCoverage
+				v.decodeJSON(d)
+				r.Coverage = append(r.Coverage, v)
+			}
+			if r.Coverage == nil && !a.isNull {
+				r.Coverage = []AccountCoverage{}
+			}
+		case "owner":
+			if d.null() {
+				r.Owner = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Owner = &v
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "guarantor":
+			a := d.array()
+			r.Guarantor = nil
+			for a.next() {
+				var v AccountGuarantor
+				v.decodeJSON(d)
+				r.Guarantor = append(r.Guarantor, v)
+			}
+			if r.Guarantor == nil && !a.isNull {
+				r.Guarantor = []AccountGuarantor{}
+			}
+		case "partOf":
+			if d.null() {
+				r.PartOf = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Account which shares no memory with the original
+func (r Account) DeepCopy() Account {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Subject != nil {
+		out.Subject = make([]Reference, len(r.Subject))
+		for i := range r.Subject {
+			out.Subject[i] = r.Subject[i].DeepCopy()
+		}
+	}
+	if r.ServicePeriod != nil {
+		v := r.ServicePeriod.DeepCopy()
+		out.ServicePeriod = &v
+	}
+	if r.Coverage != nil {
+		out.Coverage = make([]AccountCoverage, len(r.Coverage))
+		for i := range r.Coverage {
+			out.Coverage[i] = r.Coverage[i].DeepCopy()
+		}
+	}
+	if r.Owner != nil {
+		v := r.Owner.DeepCopy()
+		out.Owner = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Guarantor != nil {
+		out.Guarantor = make([]AccountGuarantor, len(r.Guarantor))
+		for i := range r.Guarantor {
+			out.Guarantor[i] = r.Guarantor[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		v := r.PartOf.DeepCopy()
+		out.PartOf = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Account) Equal(other Account) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if len(r.Subject) != len(other.Subject) {
+		return false
+	}
+	for i := range r.Subject {
+		if !r.Subject[i].Equal(other.Subject[i]) {
+			return false
+		}
+	}
+	if (r.ServicePeriod == nil) != (other.ServicePeriod == nil) || r.ServicePeriod != nil && !r.ServicePeriod.Equal(*other.ServicePeriod) {
+		return false
+	}
+	if len(r.Coverage) != len(other.Coverage) {
+		return false
+	}
+	for i := range r.Coverage {
+		if !r.Coverage[i].Equal(other.Coverage[i]) {
+			return false
+		}
+	}
+	if (r.Owner == nil) != (other.Owner == nil) || r.Owner != nil && !r.Owner.Equal(*other.Owner) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Guarantor) != len(other.Guarantor) {
+		return false
+	}
+	for i := range r.Guarantor {
+		if !r.Guarantor[i].Equal(other.Guarantor[i]) {
+			return false
+		}
+	}
+	if (r.PartOf == nil) != (other.PartOf == nil) || r.PartOf != nil && !r.PartOf.Equal(*other.PartOf) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Account) EqualsDeep(other Account) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if !equivalentList(len(r.Subject), len(other.Subject), func(i, j int) bool {
+		return r.Subject[i].EqualsDeep(other.Subject[j])
+	}) {
+		return false
+	}
+	if (r.ServicePeriod == nil) != (other.ServicePeriod == nil) || r.ServicePeriod != nil && !r.ServicePeriod.EqualsDeep(*other.ServicePeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Coverage), len(other.Coverage), func(i, j int) bool {
+		return r.Coverage[i].EqualsDeep(other.Coverage[j])
+	}) {
+		return false
+	}
+	if (r.Owner == nil) != (other.Owner == nil) || r.Owner != nil && !r.Owner.EqualsDeep(*other.Owner) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Guarantor), len(other.Guarantor), func(i, j int) bool {
+		return r.Guarantor[i].EqualsDeep(other.Guarantor[j])
+	}) {
+		return false
+	}
+	if (r.PartOf == nil) != (other.PartOf == nil) || r.PartOf != nil && !r.PartOf.EqualsDeep(*other.PartOf) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Account) EqualsShallow(other Account) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Account as FHIR XML
+func (r Account) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Account",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	for _, v := range r.Subject {
+		if err := encodeXMLElement(e, "subject", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "servicePeriod", r.ServicePeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Coverage {
+		if err := encodeXMLElement(e, "coverage", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "owner", r.Owner); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Guarantor {
+		if err := encodeXMLElement(e, "guarantor", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "partOf", r.PartOf); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Account from FHIR XML
+func (r *Account) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v AccountStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = append(r.Subject, v)
+			case "servicePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServicePeriod = &v
+			case "coverage":
+				var v AccountCoverage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coverage = append(r.Coverage, v)
+			case "owner":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Owner = &v
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "guarantor":
+				var v AccountGuarantor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Guarantor = append(r.Guarantor, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Account as properties to the RDF node n
+func (r Account) turtle(n *turtleNode) {
+	n.resource("Account", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Account.identifier", i, v)
+	}
+	n.primitive("Account.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Account.type", -1, r.Type)
+	n.primitive("Account.name", -1, r.Name, r.NameElement, "string")
+	for i, v := range r.Subject {
+		n.element("Account.subject", i, v)
+	}
+	n.element("Account.servicePeriod", -1, r.ServicePeriod)
+	for i, v := range r.Coverage {
+		n.element("Account.coverage", i, v)
+	}
+	n.element("Account.owner", -1, r.Owner)
+	n.primitive("Account.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Guarantor {
+		n.element("Account.guarantor", i, v)
+	}
+	n.element("Account.partOf", -1, r.PartOf)
+}
+
+// MarshalBSON marshals the given Account as BSON document with the structure of its FHIR JSON
+func (r Account) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Account from a BSON document with the structure of its FHIR JSON
+func (r *Account) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Account as protobuf message Account of fhir.proto
+func (r Account) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Account to the encoded message
+func (r Account) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.Type != nil {
+		e.message(11, *r.Type)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	for _, v := range r.Subject {
+		e.message(13, v)
+	}
+	if r.ServicePeriod != nil {
+		e.message(14, *r.ServicePeriod)
+	}
+	for _, v := range r.Coverage {
+		e.message(15, v)
+	}
+	if r.Owner != nil {
+		e.message(16, *r.Owner)
+	}
+	if r.Description != nil {
+		e.string(17, *r.Description)
+	}
+	for _, v := range r.Guarantor {
+		e.message(18, v)
+	}
+	if r.PartOf != nil {
+		e.message(19, *r.PartOf)
+	}
+	if r.IdElement != nil {
+		e.message(20, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(21, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(22, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(23, *r.StatusElement)
+	}
+	if r.NameElement != nil {
+		e.message(24, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(25, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Account from protobuf message Account of fhir.proto
+func (r *Account) UnmarshalProto(b []byte) error {
+	*r = Account{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v AccountStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Subject = append(r.Subject, v)
+		case 14:
+			var v Period
+			d.message(&v)
+			r.ServicePeriod = &v
+		case 15:
+			var v AccountCoverage
+			d.message(&v)
+			r.Coverage = append(r.Coverage, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Owner = &v
+		case 17:
+			v := d.string()
+			r.Description = &v
+		case 18:
+			var v AccountGuarantor
+			d.message(&v)
+			r.Guarantor = append(r.Guarantor, v)
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.PartOf = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AccountCoverage as JSON into a byte slice
+func (r AccountCoverage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AccountCoverage as JSON object with the members in the order of the fields
+func (r AccountCoverage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("coverage")
+	r.Coverage.appendJSON(e)
+	if r.Priority != nil {
+		e.key("priority")
+		e.int(*r.Priority)
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AccountCoverage from JSON. Unknown members are ignored.
+func (r *AccountCoverage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AccountCoverage
+func (r *AccountCoverage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "coverage":
+			r.Coverage.decodeJSON(d)
+		case "priority":
+			if v, ok := d.int(); ok {
+				r.Priority = &v
+			} else {
+				r.Priority = nil
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AccountCoverage which shares no memory with the original
+func (r AccountCoverage) DeepCopy() AccountCoverage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Coverage = r.Coverage.DeepCopy()
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AccountCoverage) Equal(other AccountCoverage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Coverage.Equal(other.Coverage) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AccountCoverage) EqualsDeep(other AccountCoverage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Coverage.EqualsDeep(other.Coverage) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AccountCoverage) EqualsShallow(other AccountCoverage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AccountCoverage as FHIR XML
+func (r AccountCoverage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "coverage", r.Coverage); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AccountCoverage from FHIR XML
+func (r *AccountCoverage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "coverage":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coverage = v
+			case "priority":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AccountCoverage as properties to the RDF node n
+func (r AccountCoverage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Account.coverage.coverage", -1, r.Coverage)
+	n.primitive("Account.coverage.priority", -1, r.Priority, r.PriorityElement, "positiveInt")
+}
+
+// MarshalBSON marshals the given AccountCoverage as BSON document with the structure of its FHIR JSON
+func (r AccountCoverage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AccountCoverage from a BSON document with the structure of its FHIR JSON
+func (r *AccountCoverage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AccountCoverage as protobuf message AccountCoverage of fhir.proto
+func (r AccountCoverage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AccountCoverage to the encoded message
+func (r AccountCoverage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Coverage)
+	if r.Priority != nil {
+		e.varint(5, uint64(int64(*r.Priority)))
+	}
+	if r.PriorityElement != nil {
+		e.message(6, *r.PriorityElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AccountCoverage from protobuf message AccountCoverage of fhir.proto
+func (r *AccountCoverage) UnmarshalProto(b []byte) error {
+	*r = AccountCoverage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Coverage = v
+		case 5:
+			v := d.int()
+			r.Priority = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AccountGuarantor as JSON into a byte slice
+func (r AccountGuarantor) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AccountGuarantor as JSON object with the members in the order of the fields
+func (r AccountGuarantor) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("party")
+	r.Party.appendJSON(e)
+	if r.OnHold != nil {
+		e.key("onHold")
+		e.bool(*r.OnHold)
+	}
+	if r.OnHoldElement != nil {
+		e.key("_onHold")
+		r.OnHoldElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AccountGuarantor from JSON. Unknown members are ignored.
+func (r *AccountGuarantor) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AccountGuarantor
+func (r *AccountGuarantor) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "party":
+			r.Party.decodeJSON(d)
+		case "onHold":
+			if v, ok := d.bool(); ok {
+				r.OnHold = &v
+			} else {
+				r.OnHold = nil
+			}
+		case "_onHold":
+			r.OnHoldElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AccountGuarantor which shares no memory with the original
+func (r AccountGuarantor) DeepCopy() AccountGuarantor {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Party = r.Party.DeepCopy()
+	if r.OnHold != nil {
+		v := *r.OnHold
+		out.OnHold = &v
+	}
+	if r.OnHoldElement != nil {
+		v := r.OnHoldElement.DeepCopy()
+		out.OnHoldElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AccountGuarantor) Equal(other AccountGuarantor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Party.Equal(other.Party) {
+		return false
+	}
+	if (r.OnHold == nil) != (other.OnHold == nil) || r.OnHold != nil && *r.OnHold != *other.OnHold {
+		return false
+	}
+	if (r.OnHoldElement == nil) != (other.OnHoldElement == nil) || r.OnHoldElement != nil && !r.OnHoldElement.Equal(*other.OnHoldElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AccountGuarantor) EqualsDeep(other AccountGuarantor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Party.EqualsDeep(other.Party) {
+		return false
+	}
+	if (r.OnHold == nil) != (other.OnHold == nil) || r.OnHold != nil && *r.OnHold != *other.OnHold {
+		return false
+	}
+	if (r.OnHoldElement == nil) != (other.OnHoldElement == nil) || r.OnHoldElement != nil && !r.OnHoldElement.EqualsDeep(*other.OnHoldElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AccountGuarantor) EqualsShallow(other AccountGuarantor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.OnHold == nil) != (other.OnHold == nil) || r.OnHold != nil && *r.OnHold != *other.OnHold {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AccountGuarantor as FHIR XML
+func (r AccountGuarantor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "party", r.Party); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "onHold", r.OnHold, r.OnHoldElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AccountGuarantor from FHIR XML
+func (r *AccountGuarantor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "party":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Party = v
+			case "onHold":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OnHold = &v
+				}
+				r.OnHoldElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AccountGuarantor as properties to the RDF node n
+func (r AccountGuarantor) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Account.guarantor.party", -1, r.Party)
+	n.primitive("Account.guarantor.onHold", -1, r.OnHold, r.OnHoldElement, "boolean")
+	n.element("Account.guarantor.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given AccountGuarantor as BSON document with the structure of its FHIR JSON
+func (r AccountGuarantor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AccountGuarantor from a BSON document with the structure of its FHIR JSON
+func (r *AccountGuarantor) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AccountGuarantor as protobuf message AccountGuarantor of fhir.proto
+func (r AccountGuarantor) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AccountGuarantor to the encoded message
+func (r AccountGuarantor) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Party)
+	if r.OnHold != nil {
+		e.varint(5, protoBool(*r.OnHold))
+	}
+	if r.Period != nil {
+		e.message(6, *r.Period)
+	}
+	if r.OnHoldElement != nil {
+		e.message(7, *r.OnHoldElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AccountGuarantor from protobuf message AccountGuarantor of fhir.proto
+func (r *AccountGuarantor) UnmarshalProto(b []byte) error {
+	*r = AccountGuarantor{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Party = v
+		case 5:
+			v := d.bool()
+			r.OnHold = &v
+		case 6:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.OnHoldElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalAccount unmarshals a Account.
 func UnmarshalAccount(b []byte) (Account, error) {
 	var account Account
-	if err := json.Unmarshal(b, &account); err != nil {
+	if err := account.UnmarshalJSON(b); err != nil {
 		return account, err
 	}
 	return account, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code AccountStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AccountStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AccountStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = AccountStatusActive
@@ -55,6 +59,12 @@ func (code *AccountStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AccountStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AccountStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AccountStatus) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code AccountStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AccountStatus) protoNumber() uint64 {
+	switch code {
+	case AccountStatusActive:
+		return 1
+	case AccountStatusInactive:
+		return 2
+	case AccountStatusEnteredInError:
+		return 3
+	case AccountStatusOnHold:
+		return 4
+	case AccountStatusUnknown:
+		return 5
+	}
+	return 0
+}
+func (code *AccountStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AccountStatusActive
+	case 2:
+		*code = AccountStatusInactive
+	case 3:
+		*code = AccountStatusEnteredInError
+	case 4:
+		*code = AccountStatusOnHold
+	case 5:
+		*code = AccountStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code ActionCardinalityBehavior) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ActionCardinalityBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ActionCardinalityBehavior) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "single":
 		*code = ActionCardinalityBehaviorSingle
@@ -46,6 +50,12 @@ func (code *ActionCardinalityBehavior) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ActionCardinalityBehavior) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ActionCardinalityBehavior) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ActionCardinalityBehavior) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code ActionCardinalityBehavior) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ActionCardinalityBehavior) protoNumber() uint64 {
+	switch code {
+	case ActionCardinalityBehaviorSingle:
+		return 1
+	case ActionCardinalityBehaviorMultiple:
+		return 2
+	}
+	return 0
+}
+func (code *ActionCardinalityBehavior) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ActionCardinalityBehaviorSingle
+	case 2:
+		*code = ActionCardinalityBehaviorMultiple
+	default:
+		return false
+	}
+	return true
+}
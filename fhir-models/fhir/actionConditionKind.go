@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ActionConditionKind) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ActionConditionKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ActionConditionKind) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "applicability":
 		*code = ActionConditionKindApplicability
@@ -49,6 +53,12 @@ func (code *ActionConditionKind) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ActionConditionKind) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ActionConditionKind) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ActionConditionKind) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ActionConditionKind) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ActionConditionKind) protoNumber() uint64 {
+	switch code {
+	case ActionConditionKindApplicability:
+		return 1
+	case ActionConditionKindStart:
+		return 2
+	case ActionConditionKindStop:
+		return 3
+	}
+	return 0
+}
+func (code *ActionConditionKind) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ActionConditionKindApplicability
+	case 2:
+		*code = ActionConditionKindStart
+	case 3:
+		*code = ActionConditionKindStop
+	default:
+		return false
+	}
+	return true
+}
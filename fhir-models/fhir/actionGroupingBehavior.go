@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ActionGroupingBehavior) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ActionGroupingBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ActionGroupingBehavior) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "visual-group":
 		*code = ActionGroupingBehaviorVisualGroup
@@ -49,6 +53,12 @@ func (code *ActionGroupingBehavior) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ActionGroupingBehavior) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ActionGroupingBehavior) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ActionGroupingBehavior) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ActionGroupingBehavior) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ActionGroupingBehavior) protoNumber() uint64 {
+	switch code {
+	case ActionGroupingBehaviorVisualGroup:
+		return 1
+	case ActionGroupingBehaviorLogicalGroup:
+		return 2
+	case ActionGroupingBehaviorSentenceGroup:
+		return 3
+	}
+	return 0
+}
+func (code *ActionGroupingBehavior) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ActionGroupingBehaviorVisualGroup
+	case 2:
+		*code = ActionGroupingBehaviorLogicalGroup
+	case 3:
+		*code = ActionGroupingBehaviorSentenceGroup
+	default:
+		return false
+	}
+	return true
+}
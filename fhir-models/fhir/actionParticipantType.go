@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code ActionParticipantType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ActionParticipantType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ActionParticipantType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "patient":
 		*code = ActionParticipantTypePatient
@@ -52,6 +56,12 @@ func (code *ActionParticipantType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ActionParticipantType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ActionParticipantType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ActionParticipantType) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code ActionParticipantType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ActionParticipantType) protoNumber() uint64 {
+	switch code {
+	case ActionParticipantTypePatient:
+		return 1
+	case ActionParticipantTypePractitioner:
+		return 2
+	case ActionParticipantTypeRelatedPerson:
+		return 3
+	case ActionParticipantTypeDevice:
+		return 4
+	}
+	return 0
+}
+func (code *ActionParticipantType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ActionParticipantTypePatient
+	case 2:
+		*code = ActionParticipantTypePractitioner
+	case 3:
+		*code = ActionParticipantTypeRelatedPerson
+	case 4:
+		*code = ActionParticipantTypeDevice
+	default:
+		return false
+	}
+	return true
+}
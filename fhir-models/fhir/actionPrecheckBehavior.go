@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code ActionPrecheckBehavior) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ActionPrecheckBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ActionPrecheckBehavior) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "yes":
 		*code = ActionPrecheckBehaviorYes
@@ -46,6 +50,12 @@ func (code *ActionPrecheckBehavior) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ActionPrecheckBehavior) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ActionPrecheckBehavior) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ActionPrecheckBehavior) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code ActionPrecheckBehavior) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ActionPrecheckBehavior) protoNumber() uint64 {
+	switch code {
+	case ActionPrecheckBehaviorYes:
+		return 1
+	case ActionPrecheckBehaviorNo:
+		return 2
+	}
+	return 0
+}
+func (code *ActionPrecheckBehavior) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ActionPrecheckBehaviorYes
+	case 2:
+		*code = ActionPrecheckBehaviorNo
+	default:
+		return false
+	}
+	return true
+}
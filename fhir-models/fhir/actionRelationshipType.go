@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code ActionRelationshipType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ActionRelationshipType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ActionRelationshipType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "before-start":
 		*code = ActionRelationshipTypeBeforeStart
@@ -67,6 +71,12 @@ func (code *ActionRelationshipType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ActionRelationshipType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ActionRelationshipType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ActionRelationshipType) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code ActionRelationshipType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ActionRelationshipType) protoNumber() uint64 {
+	switch code {
+	case ActionRelationshipTypeBeforeStart:
+		return 1
+	case ActionRelationshipTypeBefore:
+		return 2
+	case ActionRelationshipTypeBeforeEnd:
+		return 3
+	case ActionRelationshipTypeConcurrentWithStart:
+		return 4
+	case ActionRelationshipTypeConcurrent:
+		return 5
+	case ActionRelationshipTypeConcurrentWithEnd:
+		return 6
+	case ActionRelationshipTypeAfterStart:
+		return 7
+	case ActionRelationshipTypeAfter:
+		return 8
+	case ActionRelationshipTypeAfterEnd:
+		return 9
+	}
+	return 0
+}
+func (code *ActionRelationshipType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ActionRelationshipTypeBeforeStart
+	case 2:
+		*code = ActionRelationshipTypeBefore
+	case 3:
+		*code = ActionRelationshipTypeBeforeEnd
+	case 4:
+		*code = ActionRelationshipTypeConcurrentWithStart
+	case 5:
+		*code = ActionRelationshipTypeConcurrent
+	case 6:
+		*code = ActionRelationshipTypeConcurrentWithEnd
+	case 7:
+		*code = ActionRelationshipTypeAfterStart
+	case 8:
+		*code = ActionRelationshipTypeAfter
+	case 9:
+		*code = ActionRelationshipTypeAfterEnd
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ActionRequiredBehavior) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ActionRequiredBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ActionRequiredBehavior) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "must":
 		*code = ActionRequiredBehaviorMust
@@ -49,6 +53,12 @@ func (code *ActionRequiredBehavior) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ActionRequiredBehavior) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ActionRequiredBehavior) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ActionRequiredBehavior) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ActionRequiredBehavior) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ActionRequiredBehavior) protoNumber() uint64 {
+	switch code {
+	case ActionRequiredBehaviorMust:
+		return 1
+	case ActionRequiredBehaviorCould:
+		return 2
+	case ActionRequiredBehaviorMustUnlessDocumented:
+		return 3
+	}
+	return 0
+}
+func (code *ActionRequiredBehavior) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ActionRequiredBehaviorMust
+	case 2:
+		*code = ActionRequiredBehaviorCould
+	case 3:
+		*code = ActionRequiredBehaviorMustUnlessDocumented
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code ActionSelectionBehavior) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ActionSelectionBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ActionSelectionBehavior) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "any":
 		*code = ActionSelectionBehaviorAny
@@ -58,6 +62,12 @@ func (code *ActionSelectionBehavior) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ActionSelectionBehavior) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ActionSelectionBehavior) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ActionSelectionBehavior) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code ActionSelectionBehavior) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ActionSelectionBehavior) protoNumber() uint64 {
+	switch code {
+	case ActionSelectionBehaviorAny:
+		return 1
+	case ActionSelectionBehaviorAll:
+		return 2
+	case ActionSelectionBehaviorAllOrNone:
+		return 3
+	case ActionSelectionBehaviorExactlyOne:
+		return 4
+	case ActionSelectionBehaviorAtMostOne:
+		return 5
+	case ActionSelectionBehaviorOneOrMore:
+		return 6
+	}
+	return 0
+}
+func (code *ActionSelectionBehavior) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ActionSelectionBehaviorAny
+	case 2:
+		*code = ActionSelectionBehaviorAll
+	case 3:
+		*code = ActionSelectionBehaviorAllOrNone
+	case 4:
+		*code = ActionSelectionBehaviorExactlyOne
+	case 5:
+		*code = ActionSelectionBehaviorAtMostOne
+	case 6:
+		*code = ActionSelectionBehaviorOneOrMore
+	default:
+		return false
+	}
+	return true
+}
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,33 +25,52 @@ import "encoding/json"
 // ActivityDefinition is documented here http://hl7.org/fhir/StructureDefinition/ActivityDefinition
 type ActivityDefinition struct {
 	Id                           *string                          `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element                         `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                         *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules                *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                     *string                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                         `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                         *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage                `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                    []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension            []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Url                          *string                          `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement                   *Element                         `bson:"_url,omitempty" json:"_url,omitempty"`
 	Identifier                   []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Version                      *string                          `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement               *Element                         `bson:"_version,omitempty" json:"_version,omitempty"`
 	Name                         *string                          `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                  *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
 	Title                        *string                          `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement                 *Element                         `bson:"_title,omitempty" json:"_title,omitempty"`
 	Subtitle                     *string                          `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement              *Element                         `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
 	Status                       PublicationStatus                `bson:"status" json:"status"`
+	StatusElement                *Element                         `bson:"_status,omitempty" json:"_status,omitempty"`
 	Experimental                 *bool                            `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement          *Element                         `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
 	SubjectCodeableConcept       *CodeableConcept                 `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
 	SubjectReference             *Reference                       `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
 	Date                         *string                          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement                  *Element                         `bson:"_date,omitempty" json:"_date,omitempty"`
 	Publisher                    *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement             *Element                         `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
 	Contact                      []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
 	Description                  *string                          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element                         `bson:"_description,omitempty" json:"_description,omitempty"`
 	UseContext                   []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
 	Jurisdiction                 []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
 	Purpose                      *string                          `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement               *Element                         `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
 	Usage                        *string                          `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement                 *Element                         `bson:"_usage,omitempty" json:"_usage,omitempty"`
 	Copyright                    *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement             *Element                         `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
 	ApprovalDate                 *string                          `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement          *Element                         `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
 	LastReviewDate               *string                          `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement        *Element                         `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
 	EffectivePeriod              *Period                          `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
 	Topic                        []CodeableConcept                `bson:"topic,omitempty" json:"topic,omitempty"`
 	Author                       []ContactDetail                  `bson:"author,omitempty" json:"author,omitempty"`
@@ -57,14 +79,21 @@ type ActivityDefinition struct {
 	Endorser                     []ContactDetail                  `bson:"endorser,omitempty" json:"endorser,omitempty"`
 	RelatedArtifact              []RelatedArtifact                `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
 	Library                      []string                         `bson:"library,omitempty" json:"library,omitempty"`
+	LibraryElement               []*Element                       `bson:"_library,omitempty" json:"_library,omitempty"`
 	Kind                         *RequestResourceType             `bson:"kind,omitempty" json:"kind,omitempty"`
+	KindElement                  *Element                         `bson:"_kind,omitempty" json:"_kind,omitempty"`
 	Profile                      *string                          `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement               *Element                         `bson:"_profile,omitempty" json:"_profile,omitempty"`
 	Code                         *CodeableConcept                 `bson:"code,omitempty" json:"code,omitempty"`
 	Intent                       *RequestIntent                   `bson:"intent,omitempty" json:"intent,omitempty"`
+	IntentElement                *Element                         `bson:"_intent,omitempty" json:"_intent,omitempty"`
 	Priority                     *RequestPriority                 `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                         `bson:"_priority,omitempty" json:"_priority,omitempty"`
 	DoNotPerform                 *bool                            `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement          *Element                         `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
 	TimingTiming                 *Timing                          `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
 	TimingDateTime               *string                          `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement        *Element                         `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
 	TimingAge                    *Age                             `bson:"timingAge,omitempty" json:"timingAge,omitempty"`
 	TimingPeriod                 *Period                          `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
 	TimingRange                  *Range                           `bson:"timingRange,omitempty" json:"timingRange,omitempty"`
@@ -80,39 +109,4572 @@ type ActivityDefinition struct {
 	ObservationRequirement       []Reference                      `bson:"observationRequirement,omitempty" json:"observationRequirement,omitempty"`
 	ObservationResultRequirement []Reference                      `bson:"observationResultRequirement,omitempty" json:"observationResultRequirement,omitempty"`
 	Transform                    *string                          `bson:"transform,omitempty" json:"transform,omitempty"`
+	TransformElement             *Element                         `bson:"_transform,omitempty" json:"_transform,omitempty"`
 	DynamicValue                 []ActivityDefinitionDynamicValue `bson:"dynamicValue,omitempty" json:"dynamicValue,omitempty"`
 }
+
+// ActivityDefinitionSubject is implemented by the types allowed for ActivityDefinition.subject[x]
+type ActivityDefinitionSubject interface {
+	isActivityDefinitionSubject()
+}
+
+func (CodeableConcept) isActivityDefinitionSubject() {}
+func (Reference) isActivityDefinitionSubject()       {}
+
+// Subject returns the value of ActivityDefinition.subject[x] together with its FHIR type code or nil if no value is set
+func (r ActivityDefinition) Subject() (ActivityDefinitionSubject, string) {
+	if r.SubjectCodeableConcept != nil {
+		return *r.SubjectCodeableConcept, "CodeableConcept"
+	}
+	if r.SubjectReference != nil {
+		return *r.SubjectReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetSubject sets the value of ActivityDefinition.subject[x], given as value or pointer, and clears all other types
+func (r *ActivityDefinition) SetSubject(value ActivityDefinitionSubject) {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.SubjectCodeableConcept = &v
+	case *CodeableConcept:
+		r.SubjectCodeableConcept = v
+	case Reference:
+		r.SubjectReference = &v
+	case *Reference:
+		r.SubjectReference = v
+	}
+}
+
+// ActivityDefinitionTiming is implemented by the types allowed for ActivityDefinition.timing[x]
+type ActivityDefinitionTiming interface {
+	isActivityDefinitionTiming()
+}
+
+func (Timing) isActivityDefinitionTiming()   {}
+func (DateTime) isActivityDefinitionTiming() {}
+func (Age) isActivityDefinitionTiming()      {}
+func (Period) isActivityDefinitionTiming()   {}
+func (Range) isActivityDefinitionTiming()    {}
+func (Duration) isActivityDefinitionTiming() {}
+
+// Timing returns the value of ActivityDefinition.timing[x] together with its FHIR type code or nil if no value is set
+func (r ActivityDefinition) Timing() (ActivityDefinitionTiming, string) {
+	if r.TimingTiming != nil {
+		return *r.TimingTiming, "Timing"
+	}
+	if r.TimingDateTime != nil {
+		return DateTime(*r.TimingDateTime), "dateTime"
+	}
+	if r.TimingAge != nil {
+		return *r.TimingAge, "Age"
+	}
+	if r.TimingPeriod != nil {
+		return *r.TimingPeriod, "Period"
+	}
+	if r.TimingRange != nil {
+		return *r.TimingRange, "Range"
+	}
+	if r.TimingDuration != nil {
+		return *r.TimingDuration, "Duration"
+	}
+	return nil, ""
+}
+
+// SetTiming sets the value of ActivityDefinition.timing[x], given as value or pointer, and clears all other types
+func (r *ActivityDefinition) SetTiming(value ActivityDefinitionTiming) {
+	r.TimingTiming = nil
+	r.TimingDateTime = nil
+	r.TimingAge = nil
+	r.TimingPeriod = nil
+	r.TimingRange = nil
+	r.TimingDuration = nil
+	switch v := value.(type) {
+	case Timing:
+		r.TimingTiming = &v
+	case *Timing:
+		r.TimingTiming = v
+	case DateTime:
+		primitive := string(v)
+		r.TimingDateTime = &primitive
+	case *DateTime:
+		r.TimingDateTime = (*string)(v)
+	case Age:
+		r.TimingAge = &v
+	case *Age:
+		r.TimingAge = v
+	case Period:
+		r.TimingPeriod = &v
+	case *Period:
+		r.TimingPeriod = v
+	case Range:
+		r.TimingRange = &v
+	case *Range:
+		r.TimingRange = v
+	case Duration:
+		r.TimingDuration = &v
+	case *Duration:
+		r.TimingDuration = v
+	}
+}
+
 type ActivityDefinitionParticipant struct {
 	Id                *string               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              ActionParticipantType `bson:"type" json:"type"`
+	TypeElement       *Element              `bson:"_type,omitempty" json:"_type,omitempty"`
 	Role              *CodeableConcept      `bson:"role,omitempty" json:"role,omitempty"`
 }
+
+// ActivityDefinitionProduct is implemented by the types allowed for ActivityDefinition.product[x]
+type ActivityDefinitionProduct interface {
+	isActivityDefinitionProduct()
+}
+
+func (Reference) isActivityDefinitionProduct()       {}
+func (CodeableConcept) isActivityDefinitionProduct() {}
+
+// Product returns the value of ActivityDefinition.product[x] together with its FHIR type code or nil if no value is set
+func (r ActivityDefinition) Product() (ActivityDefinitionProduct, string) {
+	if r.ProductReference != nil {
+		return *r.ProductReference, "Reference"
+	}
+	if r.ProductCodeableConcept != nil {
+		return *r.ProductCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetProduct sets the value of ActivityDefinition.product[x], given as value or pointer, and clears all other types
+func (r *ActivityDefinition) SetProduct(value ActivityDefinitionProduct) {
+	r.ProductReference = nil
+	r.ProductCodeableConcept = nil
+	switch v := value.(type) {
+	case Reference:
+		r.ProductReference = &v
+	case *Reference:
+		r.ProductReference = v
+	case CodeableConcept:
+		r.ProductCodeableConcept = &v
+	case *CodeableConcept:
+		r.ProductCodeableConcept = v
+	}
+}
+
 type ActivityDefinitionDynamicValue struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Path              string      `bson:"path" json:"path"`
+	PathElement       *Element    `bson:"_path,omitempty" json:"_path,omitempty"`
 	Expression        Expression  `bson:"expression" json:"expression"`
 }
-type OtherActivityDefinition ActivityDefinition
 
 // MarshalJSON marshals the given ActivityDefinition as JSON into a byte slice
 func (r ActivityDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherActivityDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherActivityDefinition: OtherActivityDefinition(r),
-		ResourceType:            "ActivityDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ActivityDefinition as JSON object with the members in the order of the fields
+func (r ActivityDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ActivityDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Subtitle != nil {
+		e.key("subtitle")
+		e.string(*r.Subtitle)
+	}
+	if r.SubtitleElement != nil {
+		e.key("_subtitle")
+		r.SubtitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.key("subjectCodeableConcept")
+		r.SubjectCodeableConcept.appendJSON(e)
+	}
+	if r.SubjectReference != nil {
+		e.key("subjectReference")
+		r.SubjectReference.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Usage != nil {
+		e.key("usage")
+		e.string(*r.Usage)
+	}
+	if r.UsageElement != nil {
+		e.key("_usage")
+		r.UsageElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.ApprovalDate != nil {
+		e.key("approvalDate")
+		e.string(*r.ApprovalDate)
+	}
+	if r.ApprovalDateElement != nil {
+		e.key("_approvalDate")
+		r.ApprovalDateElement.appendJSON(e)
+	}
+	if r.LastReviewDate != nil {
+		e.key("lastReviewDate")
+		e.string(*r.LastReviewDate)
+	}
+	if r.LastReviewDateElement != nil {
+		e.key("_lastReviewDate")
+		r.LastReviewDateElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if len(r.Topic) > 0 {
+		e.key("topic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Topic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Editor) > 0 {
+		e.key("editor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Editor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Reviewer) > 0 {
+		e.key("reviewer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reviewer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endorser) > 0 {
+		e.key("endorser")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endorser {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedArtifact) > 0 {
+		e.key("relatedArtifact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedArtifact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Library) > 0 {
+		e.key("library")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Library {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LibraryElement) > 0 {
+		e.key("_library")
+		e.elements(r.LibraryElement, len(r.Library))
+	}
+	if r.Kind != nil {
+		e.key("kind")
+		e.string(r.Kind.Code())
+	}
+	if r.KindElement != nil {
+		e.key("_kind")
+		r.KindElement.appendJSON(e)
+	}
+	if r.Profile != nil {
+		e.key("profile")
+		e.string(*r.Profile)
+	}
+	if r.ProfileElement != nil {
+		e.key("_profile")
+		r.ProfileElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Intent != nil {
+		e.key("intent")
+		e.string(r.Intent.Code())
+	}
+	if r.IntentElement != nil {
+		e.key("_intent")
+		r.IntentElement.appendJSON(e)
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if r.DoNotPerform != nil {
+		e.key("doNotPerform")
+		e.bool(*r.DoNotPerform)
+	}
+	if r.DoNotPerformElement != nil {
+		e.key("_doNotPerform")
+		r.DoNotPerformElement.appendJSON(e)
+	}
+	if r.TimingTiming != nil {
+		e.key("timingTiming")
+		r.TimingTiming.appendJSON(e)
+	}
+	if r.TimingDateTime != nil {
+		e.key("timingDateTime")
+		e.string(*r.TimingDateTime)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.key("_timingDateTime")
+		r.TimingDateTimeElement.appendJSON(e)
+	}
+	if r.TimingAge != nil {
+		e.key("timingAge")
+		r.TimingAge.appendJSON(e)
+	}
+	if r.TimingPeriod != nil {
+		e.key("timingPeriod")
+		r.TimingPeriod.appendJSON(e)
+	}
+	if r.TimingRange != nil {
+		e.key("timingRange")
+		r.TimingRange.appendJSON(e)
+	}
+	if r.TimingDuration != nil {
+		e.key("timingDuration")
+		r.TimingDuration.appendJSON(e)
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if len(r.Participant) > 0 {
+		e.key("participant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Participant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ProductReference != nil {
+		e.key("productReference")
+		r.ProductReference.appendJSON(e)
+	}
+	if r.ProductCodeableConcept != nil {
+		e.key("productCodeableConcept")
+		r.ProductCodeableConcept.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if len(r.Dosage) > 0 {
+		e.key("dosage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Dosage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BodySite) > 0 {
+		e.key("bodySite")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BodySite {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SpecimenRequirement) > 0 {
+		e.key("specimenRequirement")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SpecimenRequirement {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ObservationRequirement) > 0 {
+		e.key("observationRequirement")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ObservationRequirement {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ObservationResultRequirement) > 0 {
+		e.key("observationResultRequirement")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ObservationResultRequirement {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Transform != nil {
+		e.key("transform")
+		e.string(*r.Transform)
+	}
+	if r.TransformElement != nil {
+		e.key("_transform")
+		r.TransformElement.appendJSON(e)
+	}
+	if len(r.DynamicValue) > 0 {
+		e.key("dynamicValue")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DynamicValue {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ActivityDefinition from JSON. Unknown members are ignored.
+func (r *ActivityDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ActivityDefinition
+func (r *ActivityDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "subtitle":
+			if v, ok := d.string(); ok {
+				r.Subtitle = &v
+			} else {
+				r.Subtitle = nil
+			}
+		case "_subtitle":
+			r.SubtitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "subjectCodeableConcept":
+			if d.null() {
+				r.SubjectCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubjectCodeableConcept = &v
+			}
+		case "subjectReference":
+			if d.null() {
+				r.SubjectReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubjectReference = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "usage":
+			if v, ok := d.string(); ok {
+				r.Usage = &v
+			} else {
+				r.Usage = nil
+			}
+		case "_usage":
+			r.UsageElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "approvalDate":
+			if v, ok := d.string(); ok {
+				r.ApprovalDate = &v
+			} else {
+				r.ApprovalDate = nil
+			}
+		case "_approvalDate":
+			r.ApprovalDateElement = d.element()
+		case "lastReviewDate":
+			if v, ok := d.string(); ok {
+				r.LastReviewDate = &v
+			} else {
+				r.LastReviewDate = nil
+			}
+		case "_lastReviewDate":
+			r.LastReviewDateElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "topic":
+			a := d.array()
+			r.Topic = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Topic = append(r.Topic, v)
+			}
+			if r.Topic == nil && !a.isNull {
+				r.Topic = []CodeableConcept{}
+			}
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []ContactDetail{}
+			}
+		case "editor":
+			a := d.array()
+			r.Editor = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Editor = append(r.Editor, v)
+			}
+			if r.Editor == nil && !a.isNull {
+				r.Editor = []ContactDetail{}
+			}
+		case "reviewer":
+			a := d.array()
+			r.Reviewer = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Reviewer = append(r.Reviewer, v)
+			}
+			if r.Reviewer == nil && !a.isNull {
+				r.Reviewer = []ContactDetail{}
+			}
+		case "endorser":
+			a := d.array()
+			r.Endorser = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Endorser = append(r.Endorser, v)
+			}
+			if r.Endorser == nil && !a.isNull {
+				r.Endorser = []ContactDetail{}
+			}
+		case "relatedArtifact":
+			a := d.array()
+			r.RelatedArtifact = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			}
+			if r.RelatedArtifact == nil && !a.isNull {
+				r.RelatedArtifact = []RelatedArtifact{}
+			}
+		case "library":
+			a := d.array()
+			r.Library = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Library = append(r.Library, v)
+			}
+			if r.Library == nil && !a.isNull {
+				r.Library = []string{}
+			}
+		case "_library":
+			a := d.array()
+			r.LibraryElement = nil
+			for a.next() {
+				r.LibraryElement = append(r.LibraryElement, d.element())
+			}
+		case "kind":
+			if d.null() {
+				r.Kind = nil
+			} else {
+				var v RequestResourceType
+				d.unmarshal(&v)
+				r.Kind = &v
+			}
+		case "_kind":
+			r.KindElement = d.element()
+		case "profile":
+			if v, ok := d.string(); ok {
+				r.Profile = &v
+			} else {
+				r.Profile = nil
+			}
+		case "_profile":
+			r.ProfileElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "intent":
+			if d.null() {
+				r.Intent = nil
+			} else {
+				var v RequestIntent
+				d.unmarshal(&v)
+				r.Intent = &v
+			}
+		case "_intent":
+			r.IntentElement = d.element()
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "doNotPerform":
+			if v, ok := d.bool(); ok {
+				r.DoNotPerform = &v
+			} else {
+				r.DoNotPerform = nil
+			}
+		case "_doNotPerform":
+			r.DoNotPerformElement = d.element()
+		case "timingTiming":
+			if d.null() {
+				r.TimingTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.TimingTiming = &v
+			}
+		case "timingDateTime":
+			if v, ok := d.string(); ok {
+				r.TimingDateTime = &v
+			} else {
+				r.TimingDateTime = nil
+			}
+		case "_timingDateTime":
+			r.TimingDateTimeElement = d.element()
+		case "timingAge":
+			if d.null() {
+				r.TimingAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.TimingAge = &v
+			}
+		case "timingPeriod":
+			if d.null() {
+				r.TimingPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.TimingPeriod = &v
+			}
+		case "timingRange":
+			if d.null() {
+				r.TimingRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.TimingRange = &v
+			}
+		case "timingDuration":
+			if d.null() {
+				r.TimingDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.TimingDuration = &v
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "participant":
+			a := d.array()
+			r.Participant = nil
+			for a.next() {
+				var v ActivityDefinitionParticipant
+				v.decodeJSON(d)
+				r.Participant = append(r.Participant, v)
+			}
+			if r.Participant == nil && !a.isNull {
+				r.Participant = []ActivityDefinitionParticipant{}
+			}
+		case "productReference":
+			if d.null() {
+				r.ProductReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ProductReference = &v
+			}
+		case "productCodeableConcept":
+			if d.null() {
+				r.ProductCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProductCodeableConcept = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "dosage":
+			a := d.array()
+			r.Dosage = nil
+			for a.next() {
+				var v Dosage
+				v.decodeJSON(d)
+				r.Dosage = append(r.Dosage, v)
+			}
+			if r.Dosage == nil && !a.isNull {
+				r.Dosage = []Dosage{}
+			}
+		case "bodySite":
+			a := d.array()
+			r.BodySite = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = append(r.BodySite, v)
+			}
+			if r.BodySite == nil && !a.isNull {
+				r.BodySite = []CodeableConcept{}
+			}
+		case "specimenRequirement":
+			a := d.array()
+			r.SpecimenRequirement = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SpecimenRequirement = append(r.SpecimenRequirement, v)
+			}
+			if r.SpecimenRequirement == nil && !a.isNull {
+				r.SpecimenRequirement = []Reference{}
+			}
+		case "observationRequirement":
+			a := d.array()
+			r.ObservationRequirement = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ObservationRequirement = append(r.ObservationRequirement, v)
+			}
+			if r.ObservationRequirement == nil && !a.isNull {
+				r.ObservationRequirement = []Reference{}
+			}
+		case "observationResultRequirement":
+			a := d.array()
+			r.ObservationResultRequirement = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ObservationResultRequirement = append(r.ObservationResultRequirement, v)
+			}
+			if r.ObservationResultRequirement == nil && !a.isNull {
+				r.ObservationResultRequirement = []Reference{}
+			}
+		case "transform":
+			if v, ok := d.string(); ok {
+				r.Transform = &v
+			} else {
+				r.Transform = nil
+			}
+		case "_transform":
+			r.TransformElement = d.element()
+		case "dynamicValue":
+			a := d.array()
+			r.DynamicValue = nil
+			for a.next() {
+				var v ActivityDefinitionDynamicValue
+				v.decodeJSON(d)
+				r.DynamicValue = append(r.DynamicValue, v)
+			}
+			if r.DynamicValue == nil && !a.isNull {
+				r.DynamicValue = []ActivityDefinitionDynamicValue{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ActivityDefinition which shares no memory with the original
+func (r ActivityDefinition) DeepCopy() ActivityDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Subtitle != nil {
+		v := *r.Subtitle
+		out.Subtitle = &v
+	}
+	if r.SubtitleElement != nil {
+		v := r.SubtitleElement.DeepCopy()
+		out.SubtitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.SubjectCodeableConcept != nil {
+		v := r.SubjectCodeableConcept.DeepCopy()
+		out.SubjectCodeableConcept = &v
+	}
+	if r.SubjectReference != nil {
+		v := r.SubjectReference.DeepCopy()
+		out.SubjectReference = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Usage != nil {
+		v := *r.Usage
+		out.Usage = &v
+	}
+	if r.UsageElement != nil {
+		v := r.UsageElement.DeepCopy()
+		out.UsageElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.ApprovalDate != nil {
+		v := *r.ApprovalDate
+		out.ApprovalDate = &v
+	}
+	if r.ApprovalDateElement != nil {
+		v := r.ApprovalDateElement.DeepCopy()
+		out.ApprovalDateElement = &v
+	}
+	if r.LastReviewDate != nil {
+		v := *r.LastReviewDate
+		out.LastReviewDate = &v
+	}
+	if r.LastReviewDateElement != nil {
+		v := r.LastReviewDateElement.DeepCopy()
+		out.LastReviewDateElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Topic != nil {
+		out.Topic = make([]CodeableConcept, len(r.Topic))
+		for i := range r.Topic {
+			out.Topic[i] = r.Topic[i].DeepCopy()
+		}
+	}
+	if r.Author != nil {
+		out.Author = make([]ContactDetail, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Editor != nil {
+		out.Editor = make([]ContactDetail, len(r.Editor))
+		for i := range r.Editor {
+			out.Editor[i] = r.Editor[i].DeepCopy()
+		}
+	}
+	if r.Reviewer != nil {
+		out.Reviewer = make([]ContactDetail, len(r.Reviewer))
+		for i := range r.Reviewer {
+			out.Reviewer[i] = r.Reviewer[i].DeepCopy()
+		}
+	}
+	if r.Endorser != nil {
+		out.Endorser = make([]ContactDetail, len(r.Endorser))
+		for i := range r.Endorser {
+			out.Endorser[i] = r.Endorser[i].DeepCopy()
+		}
+	}
+	if r.RelatedArtifact != nil {
+		out.RelatedArtifact = make([]RelatedArtifact, len(r.RelatedArtifact))
+		for i := range r.RelatedArtifact {
+			out.RelatedArtifact[i] = r.RelatedArtifact[i].DeepCopy()
+		}
+	}
+	if r.Library != nil {
+		out.Library = make([]string, len(r.Library))
+		copy(out.Library, r.Library)
+	}
+	if r.LibraryElement != nil {
+		out.LibraryElement = make([]*Element, len(r.LibraryElement))
+		for i, v := range r.LibraryElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LibraryElement[i] = &c
+			}
+		}
+	}
+	if r.Kind != nil {
+		v := *r.Kind
+		out.Kind = &v
+	}
+	if r.KindElement != nil {
+		v := r.KindElement.DeepCopy()
+		out.KindElement = &v
+	}
+	if r.Profile != nil {
+		v := *r.Profile
+		out.Profile = &v
+	}
+	if r.ProfileElement != nil {
+		v := r.ProfileElement.DeepCopy()
+		out.ProfileElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Intent != nil {
+		v := *r.Intent
+		out.Intent = &v
+	}
+	if r.IntentElement != nil {
+		v := r.IntentElement.DeepCopy()
+		out.IntentElement = &v
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.DoNotPerform != nil {
+		v := *r.DoNotPerform
+		out.DoNotPerform = &v
+	}
+	if r.DoNotPerformElement != nil {
+		v := r.DoNotPerformElement.DeepCopy()
+		out.DoNotPerformElement = &v
+	}
+	if r.TimingTiming != nil {
+		v := r.TimingTiming.DeepCopy()
+		out.TimingTiming = &v
+	}
+	if r.TimingDateTime != nil {
+		v := *r.TimingDateTime
+		out.TimingDateTime = &v
+	}
+	if r.TimingDateTimeElement != nil {
+		v := r.TimingDateTimeElement.DeepCopy()
+		out.TimingDateTimeElement = &v
+	}
+	if r.TimingAge != nil {
+		v := r.TimingAge.DeepCopy()
+		out.TimingAge = &v
+	}
+	if r.TimingPeriod != nil {
+		v := r.TimingPeriod.DeepCopy()
+		out.TimingPeriod = &v
+	}
+	if r.TimingRange != nil {
+		v := r.TimingRange.DeepCopy()
+		out.TimingRange = &v
+	}
+	if r.TimingDuration != nil {
+		v := r.TimingDuration.DeepCopy()
+		out.TimingDuration = &v
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.Participant != nil {
+		out.Participant = make([]ActivityDefinitionParticipant, len(r.Participant))
+		for i := range r.Participant {
+			out.Participant[i] = r.Participant[i].DeepCopy()
+		}
+	}
+	if r.ProductReference != nil {
+		v := r.ProductReference.DeepCopy()
+		out.ProductReference = &v
+	}
+	if r.ProductCodeableConcept != nil {
+		v := r.ProductCodeableConcept.DeepCopy()
+		out.ProductCodeableConcept = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.Dosage != nil {
+		out.Dosage = make([]Dosage, len(r.Dosage))
+		for i := range r.Dosage {
+			out.Dosage[i] = r.Dosage[i].DeepCopy()
+		}
+	}
+	if r.BodySite != nil {
+		out.BodySite = make([]CodeableConcept, len(r.BodySite))
+		for i := range r.BodySite {
+			out.BodySite[i] = r.BodySite[i].DeepCopy()
+		}
+	}
+	if r.SpecimenRequirement != nil {
+		out.SpecimenRequirement = make([]Reference, len(r.SpecimenRequirement))
+		for i := range r.SpecimenRequirement {
+			out.SpecimenRequirement[i] = r.SpecimenRequirement[i].DeepCopy()
+		}
+	}
+	if r.ObservationRequirement != nil {
+		out.ObservationRequirement = make([]Reference, len(r.ObservationRequirement))
+		for i := range r.ObservationRequirement {
+			out.ObservationRequirement[i] = r.ObservationRequirement[i].DeepCopy()
+		}
+	}
+	if r.ObservationResultRequirement != nil {
+		out.ObservationResultRequirement = make([]Reference, len(r.ObservationResultRequirement))
+		for i := range r.ObservationResultRequirement {
+			out.ObservationResultRequirement[i] = r.ObservationResultRequirement[i].DeepCopy()
+		}
+	}
+	if r.Transform != nil {
+		v := *r.Transform
+		out.Transform = &v
+	}
+	if r.TransformElement != nil {
+		v := r.TransformElement.DeepCopy()
+		out.TransformElement = &v
+	}
+	if r.DynamicValue != nil {
+		out.DynamicValue = make([]ActivityDefinitionDynamicValue, len(r.DynamicValue))
+		for i := range r.DynamicValue {
+			out.DynamicValue[i] = r.DynamicValue[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ActivityDefinition) Equal(other ActivityDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && *r.Subtitle != *other.Subtitle {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.Equal(*other.SubtitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.Equal(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.Equal(*other.SubjectReference) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && *r.Usage != *other.Usage {
+		return false
+	}
+	if (r.UsageElement == nil) != (other.UsageElement == nil) || r.UsageElement != nil && !r.UsageElement.Equal(*other.UsageElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && *r.ApprovalDate != *other.ApprovalDate {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.Equal(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && *r.LastReviewDate != *other.LastReviewDate {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.Equal(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if len(r.Topic) != len(other.Topic) {
+		return false
+	}
+	for i := range r.Topic {
+		if !r.Topic[i].Equal(other.Topic[i]) {
+			return false
+		}
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if len(r.Editor) != len(other.Editor) {
+		return false
+	}
+	for i := range r.Editor {
+		if !r.Editor[i].Equal(other.Editor[i]) {
+			return false
+		}
+	}
+	if len(r.Reviewer) != len(other.Reviewer) {
+		return false
+	}
+	for i := range r.Reviewer {
+		if !r.Reviewer[i].Equal(other.Reviewer[i]) {
+			return false
+		}
+	}
+	if len(r.Endorser) != len(other.Endorser) {
+		return false
+	}
+	for i := range r.Endorser {
+		if !r.Endorser[i].Equal(other.Endorser[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedArtifact) != len(other.RelatedArtifact) {
+		return false
+	}
+	for i := range r.RelatedArtifact {
+		if !r.RelatedArtifact[i].Equal(other.RelatedArtifact[i]) {
+			return false
+		}
+	}
+	if len(r.Library) != len(other.Library) {
+		return false
+	}
+	for i := range r.Library {
+		if r.Library[i] != other.Library[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LibraryElement, other.LibraryElement, false) {
+		return false
+	}
+	if (r.Kind == nil) != (other.Kind == nil) || r.Kind != nil && *r.Kind != *other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.Equal(*other.KindElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && *r.Profile != *other.Profile {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.Equal(*other.ProfileElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Intent == nil) != (other.Intent == nil) || r.Intent != nil && *r.Intent != *other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.Equal(*other.IntentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.Equal(*other.DoNotPerformElement) {
+		return false
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.Equal(*other.TimingTiming) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && *r.TimingDateTime != *other.TimingDateTime {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.Equal(*other.TimingDateTimeElement) {
+		return false
+	}
+	if (r.TimingAge == nil) != (other.TimingAge == nil) || r.TimingAge != nil && !r.TimingAge.Equal(*other.TimingAge) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.Equal(*other.TimingPeriod) {
+		return false
+	}
+	if (r.TimingRange == nil) != (other.TimingRange == nil) || r.TimingRange != nil && !r.TimingRange.Equal(*other.TimingRange) {
+		return false
+	}
+	if (r.TimingDuration == nil) != (other.TimingDuration == nil) || r.TimingDuration != nil && !r.TimingDuration.Equal(*other.TimingDuration) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if len(r.Participant) != len(other.Participant) {
+		return false
+	}
+	for i := range r.Participant {
+		if !r.Participant[i].Equal(other.Participant[i]) {
+			return false
+		}
+	}
+	if (r.ProductReference == nil) != (other.ProductReference == nil) || r.ProductReference != nil && !r.ProductReference.Equal(*other.ProductReference) {
+		return false
+	}
+	if (r.ProductCodeableConcept == nil) != (other.ProductCodeableConcept == nil) || r.ProductCodeableConcept != nil && !r.ProductCodeableConcept.Equal(*other.ProductCodeableConcept) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if len(r.Dosage) != len(other.Dosage) {
+		return false
+	}
+	for i := range r.Dosage {
+		if !r.Dosage[i].Equal(other.Dosage[i]) {
+			return false
+		}
+	}
+	if len(r.BodySite) != len(other.BodySite) {
+		return false
+	}
+	for i := range r.BodySite {
+		if !r.BodySite[i].Equal(other.BodySite[i]) {
+			return false
+		}
+	}
+	if len(r.SpecimenRequirement) != len(other.SpecimenRequirement) {
+		return false
+	}
+	for i := range r.SpecimenRequirement {
+		if !r.SpecimenRequirement[i].Equal(other.SpecimenRequirement[i]) {
+			return false
+		}
+	}
+	if len(r.ObservationRequirement) != len(other.ObservationRequirement) {
+		return false
+	}
+	for i := range r.ObservationRequirement {
+		if !r.ObservationRequirement[i].Equal(other.ObservationRequirement[i]) {
+			return false
+		}
+	}
+	if len(r.ObservationResultRequirement) != len(other.ObservationResultRequirement) {
+		return false
+	}
+	for i := range r.ObservationResultRequirement {
+		if !r.ObservationResultRequirement[i].Equal(other.ObservationResultRequirement[i]) {
+			return false
+		}
+	}
+	if (r.Transform == nil) != (other.Transform == nil) || r.Transform != nil && *r.Transform != *other.Transform {
+		return false
+	}
+	if (r.TransformElement == nil) != (other.TransformElement == nil) || r.TransformElement != nil && !r.TransformElement.Equal(*other.TransformElement) {
+		return false
+	}
+	if len(r.DynamicValue) != len(other.DynamicValue) {
+		return false
+	}
+	for i := range r.DynamicValue {
+		if !r.DynamicValue[i].Equal(other.DynamicValue[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ActivityDefinition) EqualsDeep(other ActivityDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.EqualsDeep(*other.SubtitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.EqualsDeep(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.EqualsDeep(*other.SubjectReference) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && !equivalentString(*r.Usage, *other.Usage) {
+		return false
+	}
+	if (r.UsageElement == nil) != (other.UsageElement == nil) || r.UsageElement != nil && !r.UsageElement.EqualsDeep(*other.UsageElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.EqualsDeep(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.EqualsDeep(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Topic), len(other.Topic), func(i, j int) bool {
+		return r.Topic[i].EqualsDeep(other.Topic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Editor), len(other.Editor), func(i, j int) bool {
+		return r.Editor[i].EqualsDeep(other.Editor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reviewer), len(other.Reviewer), func(i, j int) bool {
+		return r.Reviewer[i].EqualsDeep(other.Reviewer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endorser), len(other.Endorser), func(i, j int) bool {
+		return r.Endorser[i].EqualsDeep(other.Endorser[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedArtifact), len(other.RelatedArtifact), func(i, j int) bool {
+		return r.RelatedArtifact[i].EqualsDeep(other.RelatedArtifact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Library), len(other.Library), func(i, j int) bool {
+		return equivalentString(r.Library[i], other.Library[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LibraryElement, other.LibraryElement, true) {
+		return false
+	}
+	if (r.Kind == nil) != (other.Kind == nil) || r.Kind != nil && *r.Kind != *other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.EqualsDeep(*other.KindElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.EqualsDeep(*other.ProfileElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Intent == nil) != (other.Intent == nil) || r.Intent != nil && *r.Intent != *other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.EqualsDeep(*other.IntentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.EqualsDeep(*other.DoNotPerformElement) {
+		return false
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.EqualsDeep(*other.TimingTiming) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.EqualsDeep(*other.TimingDateTimeElement) {
+		return false
+	}
+	if (r.TimingAge == nil) != (other.TimingAge == nil) || r.TimingAge != nil && !r.TimingAge.EqualsDeep(*other.TimingAge) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.EqualsDeep(*other.TimingPeriod) {
+		return false
+	}
+	if (r.TimingRange == nil) != (other.TimingRange == nil) || r.TimingRange != nil && !r.TimingRange.EqualsDeep(*other.TimingRange) {
+		return false
+	}
+	if (r.TimingDuration == nil) != (other.TimingDuration == nil) || r.TimingDuration != nil && !r.TimingDuration.EqualsDeep(*other.TimingDuration) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if !equivalentList(len(r.Participant), len(other.Participant), func(i, j int) bool {
+		return r.Participant[i].EqualsDeep(other.Participant[j])
+	}) {
+		return false
+	}
+	if (r.ProductReference == nil) != (other.ProductReference == nil) || r.ProductReference != nil && !r.ProductReference.EqualsDeep(*other.ProductReference) {
+		return false
+	}
+	if (r.ProductCodeableConcept == nil) != (other.ProductCodeableConcept == nil) || r.ProductCodeableConcept != nil && !r.ProductCodeableConcept.EqualsDeep(*other.ProductCodeableConcept) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if !equivalentList(len(r.Dosage), len(other.Dosage), func(i, j int) bool {
+		return r.Dosage[i].EqualsDeep(other.Dosage[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BodySite), len(other.BodySite), func(i, j int) bool {
+		return r.BodySite[i].EqualsDeep(other.BodySite[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SpecimenRequirement), len(other.SpecimenRequirement), func(i, j int) bool {
+		return r.SpecimenRequirement[i].EqualsDeep(other.SpecimenRequirement[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ObservationRequirement), len(other.ObservationRequirement), func(i, j int) bool {
+		return r.ObservationRequirement[i].EqualsDeep(other.ObservationRequirement[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ObservationResultRequirement), len(other.ObservationResultRequirement), func(i, j int) bool {
+		return r.ObservationResultRequirement[i].EqualsDeep(other.ObservationResultRequirement[j])
+	}) {
+		return false
+	}
+	if (r.Transform == nil) != (other.Transform == nil) || r.Transform != nil && !equivalentString(*r.Transform, *other.Transform) {
+		return false
+	}
+	if (r.TransformElement == nil) != (other.TransformElement == nil) || r.TransformElement != nil && !r.TransformElement.EqualsDeep(*other.TransformElement) {
+		return false
+	}
+	if !equivalentList(len(r.DynamicValue), len(other.DynamicValue), func(i, j int) bool {
+		return r.DynamicValue[i].EqualsDeep(other.DynamicValue[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ActivityDefinition) EqualsShallow(other ActivityDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && !equivalentString(*r.Usage, *other.Usage) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if !equivalentList(len(r.Library), len(other.Library), func(i, j int) bool {
+		return equivalentString(r.Library[i], other.Library[j])
+	}) {
+		return false
+	}
+	if (r.Kind == nil) != (other.Kind == nil) || r.Kind != nil && *r.Kind != *other.Kind {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	if (r.Intent == nil) != (other.Intent == nil) || r.Intent != nil && *r.Intent != *other.Intent {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	if (r.Transform == nil) != (other.Transform == nil) || r.Transform != nil && !equivalentString(*r.Transform, *other.Transform) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ActivityDefinition as FHIR XML
+func (r ActivityDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ActivityDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "subtitle", r.Subtitle, r.SubtitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectCodeableConcept", r.SubjectCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectReference", r.SubjectReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "usage", r.Usage, r.UsageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "approvalDate", r.ApprovalDate, r.ApprovalDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastReviewDate", r.LastReviewDate, r.LastReviewDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Topic {
+		if err := encodeXMLElement(e, "topic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Editor {
+		if err := encodeXMLElement(e, "editor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reviewer {
+		if err := encodeXMLElement(e, "reviewer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endorser {
+		if err := encodeXMLElement(e, "endorser", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedArtifact {
+		if err := encodeXMLElement(e, "relatedArtifact", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Library {
+		if err := encodeXMLPrimitive(e, "library", v, elementAt(r.LibraryElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "kind", r.Kind, r.KindElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "profile", r.Profile, r.ProfileElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "intent", r.Intent, r.IntentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doNotPerform", r.DoNotPerform, r.DoNotPerformElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingTiming", r.TimingTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timingDateTime", r.TimingDateTime, r.TimingDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingAge", r.TimingAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingPeriod", r.TimingPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingRange", r.TimingRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingDuration", r.TimingDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	for _, v := range r.Participant {
+		if err := encodeXMLElement(e, "participant", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "productReference", r.ProductReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productCodeableConcept", r.ProductCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	for _, v := range r.Dosage {
+		if err := encodeXMLElement(e, "dosage", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BodySite {
+		if err := encodeXMLElement(e, "bodySite", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SpecimenRequirement {
+		if err := encodeXMLElement(e, "specimenRequirement", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ObservationRequirement {
+		if err := encodeXMLElement(e, "observationRequirement", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ObservationResultRequirement {
+		if err := encodeXMLElement(e, "observationResultRequirement", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "transform", r.Transform, r.TransformElement); err != nil {
+		return err
+	}
+	for _, v := range r.DynamicValue {
+		if err := encodeXMLElement(e, "dynamicValue", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ActivityDefinition from FHIR XML
+func (r *ActivityDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "subtitle":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subtitle = &v
+				}
+				r.SubtitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "subjectCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectCodeableConcept = &v
+			case "subjectReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectReference = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "usage":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Usage = &v
+				}
+				r.UsageElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "approvalDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ApprovalDate = &v
+				}
+				r.ApprovalDateElement = element
+			case "lastReviewDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastReviewDate = &v
+				}
+				r.LastReviewDateElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "topic":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Topic = append(r.Topic, v)
+			case "author":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "editor":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Editor = append(r.Editor, v)
+			case "reviewer":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reviewer = append(r.Reviewer, v)
+			case "endorser":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endorser = append(r.Endorser, v)
+			case "relatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			case "library":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LibraryElement = appendElement(r.LibraryElement, len(r.Library), element)
+					r.Library = append(r.Library, v)
+				}
+			case "kind":
+				var v RequestResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Kind = &v
+				}
+				r.KindElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Profile = &v
+				}
+				r.ProfileElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "intent":
+				var v RequestIntent
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Intent = &v
+				}
+				r.IntentElement = element
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "doNotPerform":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoNotPerform = &v
+				}
+				r.DoNotPerformElement = element
+			case "timingTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingTiming = &v
+			case "timingDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimingDateTime = &v
+				}
+				r.TimingDateTimeElement = element
+			case "timingAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingAge = &v
+			case "timingPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingPeriod = &v
+			case "timingRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingRange = &v
+			case "timingDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingDuration = &v
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "participant":
+				var v ActivityDefinitionParticipant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Participant = append(r.Participant, v)
+			case "productReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductReference = &v
+			case "productCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductCodeableConcept = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "dosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Dosage = append(r.Dosage, v)
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = append(r.BodySite, v)
+			case "specimenRequirement":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SpecimenRequirement = append(r.SpecimenRequirement, v)
+			case "observationRequirement":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ObservationRequirement = append(r.ObservationRequirement, v)
+			case "observationResultRequirement":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ObservationResultRequirement = append(r.ObservationResultRequirement, v)
+			case "transform":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Transform = &v
+				}
+				r.TransformElement = element
+			case "dynamicValue":
+				var v ActivityDefinitionDynamicValue
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DynamicValue = append(r.DynamicValue, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ActivityDefinition as properties to the RDF node n
+func (r ActivityDefinition) turtle(n *turtleNode) {
+	n.resource("ActivityDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("ActivityDefinition.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("ActivityDefinition.identifier", i, v)
+	}
+	n.primitive("ActivityDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("ActivityDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ActivityDefinition.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("ActivityDefinition.subtitle", -1, r.Subtitle, r.SubtitleElement, "string")
+	n.primitive("ActivityDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("ActivityDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.element("ActivityDefinition.subjectCodeableConcept", -1, r.SubjectCodeableConcept)
+	n.element("ActivityDefinition.subjectReference", -1, r.SubjectReference)
+	n.primitive("ActivityDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("ActivityDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("ActivityDefinition.contact", i, v)
+	}
+	n.primitive("ActivityDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("ActivityDefinition.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("ActivityDefinition.jurisdiction", i, v)
+	}
+	n.primitive("ActivityDefinition.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("ActivityDefinition.usage", -1, r.Usage, r.UsageElement, "string")
+	n.primitive("ActivityDefinition.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("ActivityDefinition.approvalDate", -1, r.ApprovalDate, r.ApprovalDateElement, "date")
+	n.primitive("ActivityDefinition.lastReviewDate", -1, r.LastReviewDate, r.LastReviewDateElement, "date")
+	n.element("ActivityDefinition.effectivePeriod", -1, r.EffectivePeriod)
+	for i, v := range r.Topic {
+		n.element("ActivityDefinition.topic", i, v)
+	}
+	for i, v := range r.Author {
+		n.element("ActivityDefinition.author", i, v)
+	}
+	for i, v := range r.Editor {
+		n.element("ActivityDefinition.editor", i, v)
+	}
+	for i, v := range r.Reviewer {
+		n.element("ActivityDefinition.reviewer", i, v)
+	}
+	for i, v := range r.Endorser {
+		n.element("ActivityDefinition.endorser", i, v)
+	}
+	for i, v := range r.RelatedArtifact {
+		n.element("ActivityDefinition.relatedArtifact", i, v)
+	}
+	for i, v := range r.Library {
+		n.primitive("ActivityDefinition.library", i, v, elementAt(r.LibraryElement, i), "string")
+	}
+	n.primitive("ActivityDefinition.kind", -1, r.Kind, r.KindElement, "code")
+	n.primitive("ActivityDefinition.profile", -1, r.Profile, r.ProfileElement, "string")
+	n.element("ActivityDefinition.code", -1, r.Code)
+	n.primitive("ActivityDefinition.intent", -1, r.Intent, r.IntentElement, "code")
+	n.primitive("ActivityDefinition.priority", -1, r.Priority, r.PriorityElement, "code")
+	n.primitive("ActivityDefinition.doNotPerform", -1, r.DoNotPerform, r.DoNotPerformElement, "boolean")
+	n.element("ActivityDefinition.timingTiming", -1, r.TimingTiming)
+	n.primitive("ActivityDefinition.timingDateTime", -1, r.TimingDateTime, r.TimingDateTimeElement, "dateTime")
+	n.element("ActivityDefinition.timingAge", -1, r.TimingAge)
+	n.element("ActivityDefinition.timingPeriod", -1, r.TimingPeriod)
+	n.element("ActivityDefinition.timingRange", -1, r.TimingRange)
+	n.element("ActivityDefinition.timingDuration", -1, r.TimingDuration)
+	n.element("ActivityDefinition.location", -1, r.Location)
+	for i, v := range r.Participant {
+		n.element("ActivityDefinition.participant", i, v)
+	}
+	n.element("ActivityDefinition.productReference", -1, r.ProductReference)
+	n.element("ActivityDefinition.productCodeableConcept", -1, r.ProductCodeableConcept)
+	n.element("ActivityDefinition.quantity", -1, r.Quantity)
+	for i, v := range r.Dosage {
+		n.element("ActivityDefinition.dosage", i, v)
+	}
+	for i, v := range r.BodySite {
+		n.element("ActivityDefinition.bodySite", i, v)
+	}
+	for i, v := range r.SpecimenRequirement {
+		n.element("ActivityDefinition.specimenRequirement", i, v)
+	}
+	for i, v := range r.ObservationRequirement {
+		n.element("ActivityDefinition.observationRequirement", i, v)
+	}
+	for i, v := range r.ObservationResultRequirement {
+		n.element("ActivityDefinition.observationResultRequirement", i, v)
+	}
+	n.primitive("ActivityDefinition.transform", -1, r.Transform, r.TransformElement, "string")
+	for i, v := range r.DynamicValue {
+		n.element("ActivityDefinition.dynamicValue", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ActivityDefinition as BSON document with the structure of its FHIR JSON
+func (r ActivityDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ActivityDefinition from a BSON document with the structure of its FHIR JSON
+func (r *ActivityDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ActivityDefinition as protobuf message ActivityDefinition of fhir.proto
+func (r ActivityDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ActivityDefinition to the encoded message
+func (r ActivityDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	if r.Subtitle != nil {
+		e.string(14, *r.Subtitle)
+	}
+	e.varint(15, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(16, protoBool(*r.Experimental))
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.message(17, *r.SubjectCodeableConcept)
+	}
+	if r.SubjectReference != nil {
+		e.message(18, *r.SubjectReference)
+	}
+	if r.Date != nil {
+		e.string(19, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(20, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(21, v)
+	}
+	if r.Description != nil {
+		e.string(22, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(23, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(24, v)
+	}
+	if r.Purpose != nil {
+		e.string(25, *r.Purpose)
+	}
+	if r.Usage != nil {
+		e.string(26, *r.Usage)
+	}
+	if r.Copyright != nil {
+		e.string(27, *r.Copyright)
+	}
+	if r.ApprovalDate != nil {
+		e.string(28, *r.ApprovalDate)
+	}
+	if r.LastReviewDate != nil {
+		e.string(29, *r.LastReviewDate)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(30, *r.EffectivePeriod)
+	}
+	for _, v := range r.Topic {
+		e.message(31, v)
+	}
+	for _, v := range r.Author {
+		e.message(32, v)
+	}
+	for _, v := range r.Editor {
+		e.message(33, v)
+	}
+	for _, v := range r.Reviewer {
+		e.message(34, v)
+	}
+	for _, v := range r.Endorser {
+		e.message(35, v)
+	}
+	for _, v := range r.RelatedArtifact {
+		e.message(36, v)
+	}
+	for _, v := range r.Library {
+		e.string(37, v)
+	}
+	if r.Kind != nil {
+		e.varint(38, r.Kind.protoNumber())
+	}
+	if r.Profile != nil {
+		e.string(39, *r.Profile)
+	}
+	if r.Code != nil {
+		e.message(40, *r.Code)
+	}
+	if r.Intent != nil {
+		e.varint(41, r.Intent.protoNumber())
+	}
+	if r.Priority != nil {
+		e.varint(42, r.Priority.protoNumber())
+	}
+	if r.DoNotPerform != nil {
+		e.varint(43, protoBool(*r.DoNotPerform))
+	}
+	if r.TimingTiming != nil {
+		e.message(44, *r.TimingTiming)
+	}
+	if r.TimingDateTime != nil {
+		e.string(45, *r.TimingDateTime)
+	}
+	if r.TimingAge != nil {
+		e.message(46, *r.TimingAge)
+	}
+	if r.TimingPeriod != nil {
+		e.message(47, *r.TimingPeriod)
+	}
+	if r.TimingRange != nil {
+		e.message(48, *r.TimingRange)
+	}
+	if r.TimingDuration != nil {
+		e.message(49, *r.TimingDuration)
+	}
+	if r.Location != nil {
+		e.message(50, *r.Location)
+	}
+	for _, v := range r.Participant {
+		e.message(51, v)
+	}
+	if r.ProductReference != nil {
+		e.message(52, *r.ProductReference)
+	}
+	if r.ProductCodeableConcept != nil {
+		e.message(53, *r.ProductCodeableConcept)
+	}
+	if r.Quantity != nil {
+		e.message(54, *r.Quantity)
+	}
+	for _, v := range r.Dosage {
+		e.message(55, v)
+	}
+	for _, v := range r.BodySite {
+		e.message(56, v)
+	}
+	for _, v := range r.SpecimenRequirement {
+		e.message(57, v)
+	}
+	for _, v := range r.ObservationRequirement {
+		e.message(58, v)
+	}
+	for _, v := range r.ObservationResultRequirement {
+		e.message(59, v)
+	}
+	if r.Transform != nil {
+		e.string(60, *r.Transform)
+	}
+	for _, v := range r.DynamicValue {
+		e.message(61, v)
+	}
+	if r.IdElement != nil {
+		e.message(62, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(63, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(64, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(65, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(66, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(67, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(68, *r.TitleElement)
+	}
+	if r.SubtitleElement != nil {
+		e.message(69, *r.SubtitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(70, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(71, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(72, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(73, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(74, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(75, *r.PurposeElement)
+	}
+	if r.UsageElement != nil {
+		e.message(76, *r.UsageElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(77, *r.CopyrightElement)
+	}
+	if r.ApprovalDateElement != nil {
+		e.message(78, *r.ApprovalDateElement)
+	}
+	if r.LastReviewDateElement != nil {
+		e.message(79, *r.LastReviewDateElement)
+	}
+	for _, v := range r.LibraryElement {
+		e.element(80, v)
+	}
+	if r.KindElement != nil {
+		e.message(81, *r.KindElement)
+	}
+	if r.ProfileElement != nil {
+		e.message(82, *r.ProfileElement)
+	}
+	if r.IntentElement != nil {
+		e.message(83, *r.IntentElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(84, *r.PriorityElement)
+	}
+	if r.DoNotPerformElement != nil {
+		e.message(85, *r.DoNotPerformElement)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.message(86, *r.TimingDateTimeElement)
+	}
+	if r.TransformElement != nil {
+		e.message(87, *r.TransformElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ActivityDefinition from protobuf message ActivityDefinition of fhir.proto
+func (r *ActivityDefinition) UnmarshalProto(b []byte) error {
+	*r = ActivityDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			v := d.string()
+			r.Subtitle = &v
+		case 15:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 16:
+			v := d.bool()
+			r.Experimental = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubjectCodeableConcept = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.SubjectReference = &v
+		case 19:
+			v := d.string()
+			r.Date = &v
+		case 20:
+			v := d.string()
+			r.Publisher = &v
+		case 21:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 22:
+			v := d.string()
+			r.Description = &v
+		case 23:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 25:
+			v := d.string()
+			r.Purpose = &v
+		case 26:
+			v := d.string()
+			r.Usage = &v
+		case 27:
+			v := d.string()
+			r.Copyright = &v
+		case 28:
+			v := d.string()
+			r.ApprovalDate = &v
+		case 29:
+			v := d.string()
+			r.LastReviewDate = &v
+		case 30:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 31:
+			var v CodeableConcept
+			d.message(&v)
+			r.Topic = append(r.Topic, v)
+		case 32:
+			var v ContactDetail
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 33:
+			var v ContactDetail
+			d.message(&v)
+			r.Editor = append(r.Editor, v)
+		case 34:
+			var v ContactDetail
+			d.message(&v)
+			r.Reviewer = append(r.Reviewer, v)
+		case 35:
+			var v ContactDetail
+			d.message(&v)
+			r.Endorser = append(r.Endorser, v)
+		case 36:
+			var v RelatedArtifact
+			d.message(&v)
+			r.RelatedArtifact = append(r.RelatedArtifact, v)
+		case 37:
+			r.Library = append(r.Library, d.string())
+		case 38:
+			var v RequestResourceType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Kind = &v
+		case 39:
+			v := d.string()
+			r.Profile = &v
+		case 40:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 41:
+			var v RequestIntent
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Intent = &v
+		case 42:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 43:
+			v := d.bool()
+			r.DoNotPerform = &v
+		case 44:
+			var v Timing
+			d.message(&v)
+			r.TimingTiming = &v
+		case 45:
+			v := d.string()
+			r.TimingDateTime = &v
+		case 46:
+			var v Age
+			d.message(&v)
+			r.TimingAge = &v
+		case 47:
+			var v Period
+			d.message(&v)
+			r.TimingPeriod = &v
+		case 48:
+			var v Range
+			d.message(&v)
+			r.TimingRange = &v
+		case 49:
+			var v Duration
+			d.message(&v)
+			r.TimingDuration = &v
+		case 50:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 51:
+			var v ActivityDefinitionParticipant
+			d.message(&v)
+			r.Participant = append(r.Participant, v)
+		case 52:
+			var v Reference
+			d.message(&v)
+			r.ProductReference = &v
+		case 53:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductCodeableConcept = &v
+		case 54:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 55:
+			var v Dosage
+			d.message(&v)
+			r.Dosage = append(r.Dosage, v)
+		case 56:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = append(r.BodySite, v)
+		case 57:
+			var v Reference
+			d.message(&v)
+			r.SpecimenRequirement = append(r.SpecimenRequirement, v)
+		case 58:
+			var v Reference
+			d.message(&v)
+			r.ObservationRequirement = append(r.ObservationRequirement, v)
+		case 59:
+			var v Reference
+			d.message(&v)
+			r.ObservationResultRequirement = append(r.ObservationResultRequirement, v)
+		case 60:
+			v := d.string()
+			r.Transform = &v
+		case 61:
+			var v ActivityDefinitionDynamicValue
+			d.message(&v)
+			r.DynamicValue = append(r.DynamicValue, v)
+		case 62:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 63:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 64:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 65:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 66:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 67:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 68:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 69:
+			var v Element
+			d.message(&v)
+			r.SubtitleElement = &v
+		case 70:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 71:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 72:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 73:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 74:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 75:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 76:
+			var v Element
+			d.message(&v)
+			r.UsageElement = &v
+		case 77:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 78:
+			var v Element
+			d.message(&v)
+			r.ApprovalDateElement = &v
+		case 79:
+			var v Element
+			d.message(&v)
+			r.LastReviewDateElement = &v
+		case 80:
+			r.LibraryElement = append(r.LibraryElement, d.element())
+		case 81:
+			var v Element
+			d.message(&v)
+			r.KindElement = &v
+		case 82:
+			var v Element
+			d.message(&v)
+			r.ProfileElement = &v
+		case 83:
+			var v Element
+			d.message(&v)
+			r.IntentElement = &v
+		case 84:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 85:
+			var v Element
+			d.message(&v)
+			r.DoNotPerformElement = &v
+		case 86:
+			var v Element
+			d.message(&v)
+			r.TimingDateTimeElement = &v
+		case 87:
+			var v Element
+			d.message(&v)
+			r.TransformElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ActivityDefinitionParticipant as JSON into a byte slice
+func (r ActivityDefinitionParticipant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ActivityDefinitionParticipant as JSON object with the members in the order of the fields
+func (r ActivityDefinitionParticipant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Role != nil {
+		e.key("role")
+		r.Role.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ActivityDefinitionParticipant from JSON. Unknown members are ignored.
+func (r *ActivityDefinitionParticipant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ActivityDefinitionParticipant
+func (r *ActivityDefinitionParticipant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "role":
+			if d.null() {
+				r.Role = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ActivityDefinitionParticipant which shares no memory with the original
+func (r ActivityDefinitionParticipant) DeepCopy() ActivityDefinitionParticipant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Role != nil {
+		v := r.Role.DeepCopy()
+		out.Role = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ActivityDefinitionParticipant) Equal(other ActivityDefinitionParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.Equal(*other.Role) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ActivityDefinitionParticipant) EqualsDeep(other ActivityDefinitionParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.EqualsDeep(*other.Role) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ActivityDefinitionParticipant) EqualsShallow(other ActivityDefinitionParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ActivityDefinitionParticipant as FHIR XML
+func (r ActivityDefinitionParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ActivityDefinitionParticipant from FHIR XML
+func (r *ActivityDefinitionParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v ActionParticipantType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ActivityDefinitionParticipant as properties to the RDF node n
+func (r ActivityDefinitionParticipant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ActivityDefinition.participant.type", -1, r.Type, r.TypeElement, "code")
+	n.element("ActivityDefinition.participant.role", -1, r.Role)
+}
+
+// MarshalBSON marshals the given ActivityDefinitionParticipant as BSON document with the structure of its FHIR JSON
+func (r ActivityDefinitionParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ActivityDefinitionParticipant from a BSON document with the structure of its FHIR JSON
+func (r *ActivityDefinitionParticipant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ActivityDefinitionParticipant as protobuf message ActivityDefinitionParticipant of fhir.proto
+func (r ActivityDefinitionParticipant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ActivityDefinitionParticipant to the encoded message
+func (r ActivityDefinitionParticipant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Role != nil {
+		e.message(5, *r.Role)
+	}
+	if r.TypeElement != nil {
+		e.message(6, *r.TypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ActivityDefinitionParticipant from protobuf message ActivityDefinitionParticipant of fhir.proto
+func (r *ActivityDefinitionParticipant) UnmarshalProto(b []byte) error {
+	*r = ActivityDefinitionParticipant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ActionParticipantType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ActivityDefinitionDynamicValue as JSON into a byte slice
+func (r ActivityDefinitionDynamicValue) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ActivityDefinitionDynamicValue as JSON object with the members in the order of the fields
+func (r ActivityDefinitionDynamicValue) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("path")
+	e.string(r.Path)
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	e.key("expression")
+	r.Expression.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ActivityDefinitionDynamicValue from JSON. Unknown members are ignored.
+func (r *ActivityDefinitionDynamicValue) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ActivityDefinitionDynamicValue
+func (r *ActivityDefinitionDynamicValue) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = v
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "expression":
+			r.Expression.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ActivityDefinitionDynamicValue which shares no memory with the original
+func (r ActivityDefinitionDynamicValue) DeepCopy() ActivityDefinitionDynamicValue {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	out.Expression = r.Expression.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ActivityDefinitionDynamicValue) Equal(other ActivityDefinitionDynamicValue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Path != other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if !r.Expression.Equal(other.Expression) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ActivityDefinitionDynamicValue) EqualsDeep(other ActivityDefinitionDynamicValue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if !r.Expression.EqualsDeep(other.Expression) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ActivityDefinitionDynamicValue) EqualsShallow(other ActivityDefinitionDynamicValue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ActivityDefinitionDynamicValue as FHIR XML
+func (r ActivityDefinitionDynamicValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "expression", r.Expression); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ActivityDefinitionDynamicValue from FHIR XML
+func (r *ActivityDefinitionDynamicValue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = v
+				}
+				r.PathElement = element
+			case "expression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Expression = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ActivityDefinitionDynamicValue as properties to the RDF node n
+func (r ActivityDefinitionDynamicValue) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ActivityDefinition.dynamicValue.path", -1, r.Path, r.PathElement, "string")
+	n.element("ActivityDefinition.dynamicValue.expression", -1, r.Expression)
+}
+
+// MarshalBSON marshals the given ActivityDefinitionDynamicValue as BSON document with the structure of its FHIR JSON
+func (r ActivityDefinitionDynamicValue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ActivityDefinitionDynamicValue from a BSON document with the structure of its FHIR JSON
+func (r *ActivityDefinitionDynamicValue) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ActivityDefinitionDynamicValue as protobuf message ActivityDefinitionDynamicValue of fhir.proto
+func (r ActivityDefinitionDynamicValue) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ActivityDefinitionDynamicValue to the encoded message
+func (r ActivityDefinitionDynamicValue) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Path != "" {
+		e.string(4, r.Path)
+	}
+	e.message(5, r.Expression)
+	if r.PathElement != nil {
+		e.message(6, *r.PathElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ActivityDefinitionDynamicValue from protobuf message ActivityDefinitionDynamicValue of fhir.proto
+func (r *ActivityDefinitionDynamicValue) UnmarshalProto(b []byte) error {
+	*r = ActivityDefinitionDynamicValue{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Path = d.string()
+		case 5:
+			var v Expression
+			d.message(&v)
+			r.Expression = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalActivityDefinition unmarshals a ActivityDefinition.
 func UnmarshalActivityDefinition(b []byte) (ActivityDefinition, error) {
 	var activityDefinition ActivityDefinition
-	if err := json.Unmarshal(b, &activityDefinition); err != nil {
+	if err := activityDefinition.UnmarshalJSON(b); err != nil {
 		return activityDefinition, err
 	}
 	return activityDefinition, nil
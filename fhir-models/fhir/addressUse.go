@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code AddressUse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AddressUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AddressUse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "home":
 		*code = AddressUseHome
@@ -55,6 +59,12 @@ func (code *AddressUse) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AddressUse) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AddressUse) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AddressUse) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code AddressUse) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AddressUse) protoNumber() uint64 {
+	switch code {
+	case AddressUseHome:
+		return 1
+	case AddressUseWork:
+		return 2
+	case AddressUseTemp:
+		return 3
+	case AddressUseOld:
+		return 4
+	case AddressUseBilling:
+		return 5
+	}
+	return 0
+}
+func (code *AddressUse) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AddressUseHome
+	case 2:
+		*code = AddressUseWork
+	case 3:
+		*code = AddressUseTemp
+	case 4:
+		*code = AddressUseOld
+	case 5:
+		*code = AddressUseBilling
+	default:
+		return false
+	}
+	return true
+}
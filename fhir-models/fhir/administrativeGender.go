@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code AdministrativeGender) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AdministrativeGender) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AdministrativeGender) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "male":
 		*code = AdministrativeGenderMale
@@ -52,6 +56,12 @@ func (code *AdministrativeGender) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AdministrativeGender) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AdministrativeGender) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AdministrativeGender) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code AdministrativeGender) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AdministrativeGender) protoNumber() uint64 {
+	switch code {
+	case AdministrativeGenderMale:
+		return 1
+	case AdministrativeGenderFemale:
+		return 2
+	case AdministrativeGenderOther:
+		return 3
+	case AdministrativeGenderUnknown:
+		return 4
+	}
+	return 0
+}
+func (code *AdministrativeGender) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AdministrativeGenderMale
+	case 2:
+		*code = AdministrativeGenderFemale
+	case 3:
+		*code = AdministrativeGenderOther
+	case 4:
+		*code = AdministrativeGenderUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,21 +25,29 @@ import "encoding/json"
 // AdverseEvent is documented here http://hl7.org/fhir/StructureDefinition/AdverseEvent
 type AdverseEvent struct {
 	Id                    *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                    `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                  *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage           `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier            *Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Actuality             AdverseEventActuality       `bson:"actuality" json:"actuality"`
+	ActualityElement      *Element                    `bson:"_actuality,omitempty" json:"_actuality,omitempty"`
 	Category              []CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
 	Event                 *CodeableConcept            `bson:"event,omitempty" json:"event,omitempty"`
 	Subject               Reference                   `bson:"subject" json:"subject"`
 	Encounter             *Reference                  `bson:"encounter,omitempty" json:"encounter,omitempty"`
 	Date                  *string                     `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                    `bson:"_date,omitempty" json:"_date,omitempty"`
 	Detected              *string                     `bson:"detected,omitempty" json:"detected,omitempty"`
+	DetectedElement       *Element                    `bson:"_detected,omitempty" json:"_detected,omitempty"`
 	RecordedDate          *string                     `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
+	RecordedDateElement   *Element                    `bson:"_recordedDate,omitempty" json:"_recordedDate,omitempty"`
 	ResultingCondition    []Reference                 `bson:"resultingCondition,omitempty" json:"resultingCondition,omitempty"`
 	Location              *Reference                  `bson:"location,omitempty" json:"location,omitempty"`
 	Seriousness           *CodeableConcept            `bson:"seriousness,omitempty" json:"seriousness,omitempty"`
@@ -57,31 +68,2450 @@ type AdverseEventSuspectEntity struct {
 	Causality         []AdverseEventSuspectEntityCausality `bson:"causality,omitempty" json:"causality,omitempty"`
 }
 type AdverseEventSuspectEntityCausality struct {
-	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Assessment         *CodeableConcept `bson:"assessment,omitempty" json:"assessment,omitempty"`
-	ProductRelatedness *string          `bson:"productRelatedness,omitempty" json:"productRelatedness,omitempty"`
-	Author             *Reference       `bson:"author,omitempty" json:"author,omitempty"`
-	Method             *CodeableConcept `bson:"method,omitempty" json:"method,omitempty"`
+	Id                        *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Assessment                *CodeableConcept `bson:"assessment,omitempty" json:"assessment,omitempty"`
+	ProductRelatedness        *string          `bson:"productRelatedness,omitempty" json:"productRelatedness,omitempty"`
+	ProductRelatednessElement *Element         `bson:"_productRelatedness,omitempty" json:"_productRelatedness,omitempty"`
+	Author                    *Reference       `bson:"author,omitempty" json:"author,omitempty"`
+	Method                    *CodeableConcept `bson:"method,omitempty" json:"method,omitempty"`
 }
-type OtherAdverseEvent AdverseEvent
 
 // MarshalJSON marshals the given AdverseEvent as JSON into a byte slice
 func (r AdverseEvent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherAdverseEvent
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherAdverseEvent: OtherAdverseEvent(r),
-		ResourceType:      "AdverseEvent",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AdverseEvent as JSON object with the members in the order of the fields
+func (r AdverseEvent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"AdverseEvent\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	e.key("actuality")
+	e.string(r.Actuality.Code())
+	if r.ActualityElement != nil {
+		e.key("_actuality")
+		r.ActualityElement.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Event != nil {
+		e.key("event")
+		r.Event.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Detected != nil {
+		e.key("detected")
+		e.string(*r.Detected)
+	}
+	if r.DetectedElement != nil {
+		e.key("_detected")
+		r.DetectedElement.appendJSON(e)
+	}
+	if r.RecordedDate != nil {
+		e.key("recordedDate")
+		e.string(*r.RecordedDate)
+	}
+	if r.RecordedDateElement != nil {
+		e.key("_recordedDate")
+		r.RecordedDateElement.appendJSON(e)
+	}
+	if len(r.ResultingCondition) > 0 {
+		e.key("resultingCondition")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ResultingCondition {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if r.Seriousness != nil {
+		e.key("seriousness")
+		r.Seriousness.appendJSON(e)
+	}
+	if r.Severity != nil {
+		e.key("severity")
+		r.Severity.appendJSON(e)
+	}
+	if r.Outcome != nil {
+		e.key("outcome")
+		r.Outcome.appendJSON(e)
+	}
+	if r.Recorder != nil {
+		e.key("recorder")
+		r.Recorder.appendJSON(e)
+	}
+	if len(r.Contributor) > 0 {
+		e.key("contributor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contributor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SuspectEntity) > 0 {
+		e.key("suspectEntity")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SuspectEntity {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SubjectMedicalHistory) > 0 {
+		e.key("subjectMedicalHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubjectMedicalHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReferenceDocument) > 0 {
+		e.key("referenceDocument")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReferenceDocument {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Study) > 0 {
+		e.key("study")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Study {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AdverseEvent from JSON. Unknown members are ignored.
+func (r *AdverseEvent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AdverseEvent
+func (r *AdverseEvent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "actuality":
+			d.unmarshal(&r.Actuality)
+		case "_actuality":
+			r.ActualityElement = d.element()
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "event":
+			if d.null() {
+				r.Event = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Event = &v
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "detected":
+			if v, ok := d.string(); ok {
+				r.Detected = &v
+			} else {
+				r.Detected = nil
+			}
+		case "_detected":
+			r.DetectedElement = d.element()
+		case "recordedDate":
+			if v, ok := d.string(); ok {
+				r.RecordedDate = &v
+			} else {
+				r.RecordedDate = nil
+			}
+		case "_recordedDate":
+			r.RecordedDateElement = d.element()
+		case "resultingCondition":
+			a := d.array()
+			r.ResultingCondition = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ResultingCondition = append(r.ResultingCondition, v)
+			}
+			if r.ResultingCondition == nil && !a.isNull {
+				r.ResultingCondition = []Reference{}
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "seriousness":
+			if d.null() {
+				r.Seriousness = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Seriousness = &v
+			}
+		case "severity":
+			if d.null() {
+				r.Severity = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Severity = &v
+			}
+		case "outcome":
+			if d.null() {
+				r.Outcome = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Outcome = &v
+			}
+		case "recorder":
+			if d.null() {
+				r.Recorder = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recorder = &v
+			}
+		case "contributor":
+			a := d.array()
+			r.Contributor = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Contributor = append(r.Contributor, v)
+			}
+			if r.Contributor == nil && !a.isNull {
+				r.Contributor = []Reference{}
+			}
+		case "suspectEntity":
+			a := d.array()
+			r.SuspectEntity = nil
+			for a.next() {
+				var v AdverseEventSuspectEntity
+				v.decodeJSON(d)
+				r.SuspectEntity = append(r.SuspectEntity, v)
+			}
+			if r.SuspectEntity == nil && !a.isNull {
+				r.SuspectEntity = []AdverseEventSuspectEntity{}
+			}
+		case "subjectMedicalHistory":
+			a := d.array()
+			r.SubjectMedicalHistory = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubjectMedicalHistory = append(r.SubjectMedicalHistory, v)
+			}
+			if r.SubjectMedicalHistory == nil && !a.isNull {
+				r.SubjectMedicalHistory = []Reference{}
+			}
+		case "referenceDocument":
+			a := d.array()
+			r.ReferenceDocument = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReferenceDocument = append(r.ReferenceDocument, v)
+			}
+			if r.ReferenceDocument == nil && !a.isNull {
+				r.ReferenceDocument = []Reference{}
+			}
+		case "study":
+			a := d.array()
+			r.Study = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Study = append(r.Study, v)
+			}
+			if r.Study == nil && !a.isNull {
+				r.Study = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AdverseEvent which shares no memory with the original
+func (r AdverseEvent) DeepCopy() AdverseEvent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.ActualityElement != nil {
+		v := r.ActualityElement.DeepCopy()
+		out.ActualityElement = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Event != nil {
+		v := r.Event.DeepCopy()
+		out.Event = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Detected != nil {
+		v := *r.Detected
+		out.Detected = &v
+	}
+	if r.DetectedElement != nil {
+		v := r.DetectedElement.DeepCopy()
+		out.DetectedElement = &v
+	}
+	if r.RecordedDate != nil {
+		v := *r.RecordedDate
+		out.RecordedDate = &v
+	}
+	if r.RecordedDateElement != nil {
+		v := r.RecordedDateElement.DeepCopy()
+		out.RecordedDateElement = &v
+	}
+	if r.ResultingCondition != nil {
+		out.ResultingCondition = make([]Reference, len(r.ResultingCondition))
+		for i := range r.ResultingCondition {
+			out.ResultingCondition[i] = r.ResultingCondition[i].DeepCopy()
+		}
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.Seriousness != nil {
+		v := r.Seriousness.DeepCopy()
+		out.Seriousness = &v
+	}
+	if r.Severity != nil {
+		v := r.Severity.DeepCopy()
+		out.Severity = &v
+	}
+	if r.Outcome != nil {
+		v := r.Outcome.DeepCopy()
+		out.Outcome = &v
+	}
+	if r.Recorder != nil {
+		v := r.Recorder.DeepCopy()
+		out.Recorder = &v
+	}
+	if r.Contributor != nil {
+		out.Contributor = make([]Reference, len(r.Contributor))
+		for i := range r.Contributor {
+			out.Contributor[i] = r.Contributor[i].DeepCopy()
+		}
+	}
+	if r.SuspectEntity != nil {
+		out.SuspectEntity = make([]AdverseEventSuspectEntity, len(r.SuspectEntity))
+		for i := range r.SuspectEntity {
+			out.SuspectEntity[i] = r.SuspectEntity[i].DeepCopy()
+		}
+	}
+	if r.SubjectMedicalHistory != nil {
+		out.SubjectMedicalHistory = make([]Reference, len(r.SubjectMedicalHistory))
+		for i := range r.SubjectMedicalHistory {
+			out.SubjectMedicalHistory[i] = r.SubjectMedicalHistory[i].DeepCopy()
+		}
+	}
+	if r.ReferenceDocument != nil {
+		out.ReferenceDocument = make([]Reference, len(r.ReferenceDocument))
+		for i := range r.ReferenceDocument {
+			out.ReferenceDocument[i] = r.ReferenceDocument[i].DeepCopy()
+		}
+	}
+	if r.Study != nil {
+		out.Study = make([]Reference, len(r.Study))
+		for i := range r.Study {
+			out.Study[i] = r.Study[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AdverseEvent) Equal(other AdverseEvent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if r.Actuality != other.Actuality {
+		return false
+	}
+	if (r.ActualityElement == nil) != (other.ActualityElement == nil) || r.ActualityElement != nil && !r.ActualityElement.Equal(*other.ActualityElement) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Event == nil) != (other.Event == nil) || r.Event != nil && !r.Event.Equal(*other.Event) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Detected == nil) != (other.Detected == nil) || r.Detected != nil && *r.Detected != *other.Detected {
+		return false
+	}
+	if (r.DetectedElement == nil) != (other.DetectedElement == nil) || r.DetectedElement != nil && !r.DetectedElement.Equal(*other.DetectedElement) {
+		return false
+	}
+	if (r.RecordedDate == nil) != (other.RecordedDate == nil) || r.RecordedDate != nil && *r.RecordedDate != *other.RecordedDate {
+		return false
+	}
+	if (r.RecordedDateElement == nil) != (other.RecordedDateElement == nil) || r.RecordedDateElement != nil && !r.RecordedDateElement.Equal(*other.RecordedDateElement) {
+		return false
+	}
+	if len(r.ResultingCondition) != len(other.ResultingCondition) {
+		return false
+	}
+	for i := range r.ResultingCondition {
+		if !r.ResultingCondition[i].Equal(other.ResultingCondition[i]) {
+			return false
+		}
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if (r.Seriousness == nil) != (other.Seriousness == nil) || r.Seriousness != nil && !r.Seriousness.Equal(*other.Seriousness) {
+		return false
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && !r.Severity.Equal(*other.Severity) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && !r.Outcome.Equal(*other.Outcome) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.Equal(*other.Recorder) {
+		return false
+	}
+	if len(r.Contributor) != len(other.Contributor) {
+		return false
+	}
+	for i := range r.Contributor {
+		if !r.Contributor[i].Equal(other.Contributor[i]) {
+			return false
+		}
+	}
+	if len(r.SuspectEntity) != len(other.SuspectEntity) {
+		return false
+	}
+	for i := range r.SuspectEntity {
+		if !r.SuspectEntity[i].Equal(other.SuspectEntity[i]) {
+			return false
+		}
+	}
+	if len(r.SubjectMedicalHistory) != len(other.SubjectMedicalHistory) {
+		return false
+	}
+	for i := range r.SubjectMedicalHistory {
+		if !r.SubjectMedicalHistory[i].Equal(other.SubjectMedicalHistory[i]) {
+			return false
+		}
+	}
+	if len(r.ReferenceDocument) != len(other.ReferenceDocument) {
+		return false
+	}
+	for i := range r.ReferenceDocument {
+		if !r.ReferenceDocument[i].Equal(other.ReferenceDocument[i]) {
+			return false
+		}
+	}
+	if len(r.Study) != len(other.Study) {
+		return false
+	}
+	for i := range r.Study {
+		if !r.Study[i].Equal(other.Study[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AdverseEvent) EqualsDeep(other AdverseEvent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if r.Actuality != other.Actuality {
+		return false
+	}
+	if (r.ActualityElement == nil) != (other.ActualityElement == nil) || r.ActualityElement != nil && !r.ActualityElement.EqualsDeep(*other.ActualityElement) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Event == nil) != (other.Event == nil) || r.Event != nil && !r.Event.EqualsDeep(*other.Event) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Detected == nil) != (other.Detected == nil) || r.Detected != nil && !equivalentString(*r.Detected, *other.Detected) {
+		return false
+	}
+	if (r.DetectedElement == nil) != (other.DetectedElement == nil) || r.DetectedElement != nil && !r.DetectedElement.EqualsDeep(*other.DetectedElement) {
+		return false
+	}
+	if (r.RecordedDate == nil) != (other.RecordedDate == nil) || r.RecordedDate != nil && !equivalentString(*r.RecordedDate, *other.RecordedDate) {
+		return false
+	}
+	if (r.RecordedDateElement == nil) != (other.RecordedDateElement == nil) || r.RecordedDateElement != nil && !r.RecordedDateElement.EqualsDeep(*other.RecordedDateElement) {
+		return false
+	}
+	if !equivalentList(len(r.ResultingCondition), len(other.ResultingCondition), func(i, j int) bool {
+		return r.ResultingCondition[i].EqualsDeep(other.ResultingCondition[j])
+	}) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if (r.Seriousness == nil) != (other.Seriousness == nil) || r.Seriousness != nil && !r.Seriousness.EqualsDeep(*other.Seriousness) {
+		return false
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && !r.Severity.EqualsDeep(*other.Severity) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && !r.Outcome.EqualsDeep(*other.Outcome) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.EqualsDeep(*other.Recorder) {
+		return false
+	}
+	if !equivalentList(len(r.Contributor), len(other.Contributor), func(i, j int) bool {
+		return r.Contributor[i].EqualsDeep(other.Contributor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SuspectEntity), len(other.SuspectEntity), func(i, j int) bool {
+		return r.SuspectEntity[i].EqualsDeep(other.SuspectEntity[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SubjectMedicalHistory), len(other.SubjectMedicalHistory), func(i, j int) bool {
+		return r.SubjectMedicalHistory[i].EqualsDeep(other.SubjectMedicalHistory[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReferenceDocument), len(other.ReferenceDocument), func(i, j int) bool {
+		return r.ReferenceDocument[i].EqualsDeep(other.ReferenceDocument[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Study), len(other.Study), func(i, j int) bool {
+		return r.Study[i].EqualsDeep(other.Study[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AdverseEvent) EqualsShallow(other AdverseEvent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Actuality != other.Actuality {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Detected == nil) != (other.Detected == nil) || r.Detected != nil && !equivalentString(*r.Detected, *other.Detected) {
+		return false
+	}
+	if (r.RecordedDate == nil) != (other.RecordedDate == nil) || r.RecordedDate != nil && !equivalentString(*r.RecordedDate, *other.RecordedDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AdverseEvent as FHIR XML
+func (r AdverseEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "AdverseEvent",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "actuality", r.Actuality, r.ActualityElement); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "event", r.Event); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "detected", r.Detected, r.DetectedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "recordedDate", r.RecordedDate, r.RecordedDateElement); err != nil {
+		return err
+	}
+	for _, v := range r.ResultingCondition {
+		if err := encodeXMLElement(e, "resultingCondition", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "seriousness", r.Seriousness); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "severity", r.Severity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "outcome", r.Outcome); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "recorder", r.Recorder); err != nil {
+		return err
+	}
+	for _, v := range r.Contributor {
+		if err := encodeXMLElement(e, "contributor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SuspectEntity {
+		if err := encodeXMLElement(e, "suspectEntity", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SubjectMedicalHistory {
+		if err := encodeXMLElement(e, "subjectMedicalHistory", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReferenceDocument {
+		if err := encodeXMLElement(e, "referenceDocument", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Study {
+		if err := encodeXMLElement(e, "study", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AdverseEvent from FHIR XML
+func (r *AdverseEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "actuality":
+				var v AdverseEventActuality
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Actuality = v
+				}
+				r.ActualityElement = element
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "event":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Event = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "detected":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Detected = &v
+				}
+				r.DetectedElement = element
+			case "recordedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RecordedDate = &v
+				}
+				r.RecordedDateElement = element
+			case "resultingCondition":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ResultingCondition = append(r.ResultingCondition, v)
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "seriousness":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Seriousness = &v
+			case "severity":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Severity = &v
+			case "outcome":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Outcome = &v
+			case "recorder":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recorder = &v
+			case "contributor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contributor = append(r.Contributor, v)
+			case "suspectEntity":
+				var v AdverseEventSuspectEntity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SuspectEntity = append(r.SuspectEntity, v)
+			case "subjectMedicalHistory":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectMedicalHistory = append(r.SubjectMedicalHistory, v)
+			case "referenceDocument":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferenceDocument = append(r.ReferenceDocument, v)
+			case "study":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Study = append(r.Study, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AdverseEvent as properties to the RDF node n
+func (r AdverseEvent) turtle(n *turtleNode) {
+	n.resource("AdverseEvent", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("AdverseEvent.identifier", -1, r.Identifier)
+	n.primitive("AdverseEvent.actuality", -1, r.Actuality, r.ActualityElement, "code")
+	for i, v := range r.Category {
+		n.element("AdverseEvent.category", i, v)
+	}
+	n.element("AdverseEvent.event", -1, r.Event)
+	n.element("AdverseEvent.subject", -1, r.Subject)
+	n.element("AdverseEvent.encounter", -1, r.Encounter)
+	n.primitive("AdverseEvent.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("AdverseEvent.detected", -1, r.Detected, r.DetectedElement, "dateTime")
+	n.primitive("AdverseEvent.recordedDate", -1, r.RecordedDate, r.RecordedDateElement, "dateTime")
+	for i, v := range r.ResultingCondition {
+		n.element("AdverseEvent.resultingCondition", i, v)
+	}
+	n.element("AdverseEvent.location", -1, r.Location)
+	n.element("AdverseEvent.seriousness", -1, r.Seriousness)
+	n.element("AdverseEvent.severity", -1, r.Severity)
+	n.element("AdverseEvent.outcome", -1, r.Outcome)
+	n.element("AdverseEvent.recorder", -1, r.Recorder)
+	for i, v := range r.Contributor {
+		n.element("AdverseEvent.contributor", i, v)
+	}
+	for i, v := range r.SuspectEntity {
+		n.element("AdverseEvent.suspectEntity", i, v)
+	}
+	for i, v := range r.SubjectMedicalHistory {
+		n.element("AdverseEvent.subjectMedicalHistory", i, v)
+	}
+	for i, v := range r.ReferenceDocument {
+		n.element("AdverseEvent.referenceDocument", i, v)
+	}
+	for i, v := range r.Study {
+		n.element("AdverseEvent.study", i, v)
+	}
+}
+
+// MarshalBSON marshals the given AdverseEvent as BSON document with the structure of its FHIR JSON
+func (r AdverseEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AdverseEvent from a BSON document with the structure of its FHIR JSON
+func (r *AdverseEvent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AdverseEvent as protobuf message AdverseEvent of fhir.proto
+func (r AdverseEvent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AdverseEvent to the encoded message
+func (r AdverseEvent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Identifier != nil {
+		e.message(9, *r.Identifier)
+	}
+	e.varint(10, r.Actuality.protoNumber())
+	for _, v := range r.Category {
+		e.message(11, v)
+	}
+	if r.Event != nil {
+		e.message(12, *r.Event)
+	}
+	e.message(13, r.Subject)
+	if r.Encounter != nil {
+		e.message(14, *r.Encounter)
+	}
+	if r.Date != nil {
+		e.string(15, *r.Date)
+	}
+	if r.Detected != nil {
+		e.string(16, *r.Detected)
+	}
+	if r.RecordedDate != nil {
+		e.string(17, *r.RecordedDate)
+	}
+	for _, v := range r.ResultingCondition {
+		e.message(18, v)
+	}
+	if r.Location != nil {
+		e.message(19, *r.Location)
+	}
+	if r.Seriousness != nil {
+		e.message(20, *r.Seriousness)
+	}
+	if r.Severity != nil {
+		e.message(21, *r.Severity)
+	}
+	if r.Outcome != nil {
+		e.message(22, *r.Outcome)
+	}
+	if r.Recorder != nil {
+		e.message(23, *r.Recorder)
+	}
+	for _, v := range r.Contributor {
+		e.message(24, v)
+	}
+	for _, v := range r.SuspectEntity {
+		e.message(25, v)
+	}
+	for _, v := range r.SubjectMedicalHistory {
+		e.message(26, v)
+	}
+	for _, v := range r.ReferenceDocument {
+		e.message(27, v)
+	}
+	for _, v := range r.Study {
+		e.message(28, v)
+	}
+	if r.IdElement != nil {
+		e.message(29, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(30, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(31, *r.LanguageElement)
+	}
+	if r.ActualityElement != nil {
+		e.message(32, *r.ActualityElement)
+	}
+	if r.DateElement != nil {
+		e.message(33, *r.DateElement)
+	}
+	if r.DetectedElement != nil {
+		e.message(34, *r.DetectedElement)
+	}
+	if r.RecordedDateElement != nil {
+		e.message(35, *r.RecordedDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AdverseEvent from protobuf message AdverseEvent of fhir.proto
+func (r *AdverseEvent) UnmarshalProto(b []byte) error {
+	*r = AdverseEvent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 10:
+			var v AdverseEventActuality
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Actuality = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Event = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 15:
+			v := d.string()
+			r.Date = &v
+		case 16:
+			v := d.string()
+			r.Detected = &v
+		case 17:
+			v := d.string()
+			r.RecordedDate = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.ResultingCondition = append(r.ResultingCondition, v)
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Seriousness = &v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Severity = &v
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.Outcome = &v
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Recorder = &v
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Contributor = append(r.Contributor, v)
+		case 25:
+			var v AdverseEventSuspectEntity
+			d.message(&v)
+			r.SuspectEntity = append(r.SuspectEntity, v)
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.SubjectMedicalHistory = append(r.SubjectMedicalHistory, v)
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.ReferenceDocument = append(r.ReferenceDocument, v)
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Study = append(r.Study, v)
+		case 29:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.ActualityElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.DetectedElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.RecordedDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AdverseEventSuspectEntity as JSON into a byte slice
+func (r AdverseEventSuspectEntity) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AdverseEventSuspectEntity as JSON object with the members in the order of the fields
+func (r AdverseEventSuspectEntity) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("instance")
+	r.Instance.appendJSON(e)
+	if len(r.Causality) > 0 {
+		e.key("causality")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Causality {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AdverseEventSuspectEntity from JSON. Unknown members are ignored.
+func (r *AdverseEventSuspectEntity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AdverseEventSuspectEntity
+func (r *AdverseEventSuspectEntity) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "instance":
+			r.Instance.decodeJSON(d)
+		case "causality":
+			a := d.array()
+			r.Causality = nil
+			for a.next() {
+				var v AdverseEventSuspectEntityCausality
+				v.decodeJSON(d)
+				r.Causality = append(r.Causality, v)
+			}
+			if r.Causality == nil && !a.isNull {
+				r.Causality = []AdverseEventSuspectEntityCausality{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AdverseEventSuspectEntity which shares no memory with the original
+func (r AdverseEventSuspectEntity) DeepCopy() AdverseEventSuspectEntity {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Instance = r.Instance.DeepCopy()
+	if r.Causality != nil {
+		out.Causality = make([]AdverseEventSuspectEntityCausality, len(r.Causality))
+		for i := range r.Causality {
+			out.Causality[i] = r.Causality[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AdverseEventSuspectEntity) Equal(other AdverseEventSuspectEntity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Instance.Equal(other.Instance) {
+		return false
+	}
+	if len(r.Causality) != len(other.Causality) {
+		return false
+	}
+	for i := range r.Causality {
+		if !r.Causality[i].Equal(other.Causality[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AdverseEventSuspectEntity) EqualsDeep(other AdverseEventSuspectEntity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Instance.EqualsDeep(other.Instance) {
+		return false
+	}
+	if !equivalentList(len(r.Causality), len(other.Causality), func(i, j int) bool {
+		return r.Causality[i].EqualsDeep(other.Causality[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AdverseEventSuspectEntity) EqualsShallow(other AdverseEventSuspectEntity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AdverseEventSuspectEntity as FHIR XML
+func (r AdverseEventSuspectEntity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "instance", r.Instance); err != nil {
+		return err
+	}
+	for _, v := range r.Causality {
+		if err := encodeXMLElement(e, "causality", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AdverseEventSuspectEntity from FHIR XML
+func (r *AdverseEventSuspectEntity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "instance":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Instance = v
+			case "causality":
+				var v AdverseEventSuspectEntityCausality
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Causality = append(r.Causality, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AdverseEventSuspectEntity as properties to the RDF node n
+func (r AdverseEventSuspectEntity) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("AdverseEvent.suspectEntity.instance", -1, r.Instance)
+	for i, v := range r.Causality {
+		n.element("AdverseEvent.suspectEntity.causality", i, v)
+	}
+}
+
+// MarshalBSON marshals the given AdverseEventSuspectEntity as BSON document with the structure of its FHIR JSON
+func (r AdverseEventSuspectEntity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AdverseEventSuspectEntity from a BSON document with the structure of its FHIR JSON
+func (r *AdverseEventSuspectEntity) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AdverseEventSuspectEntity as protobuf message AdverseEventSuspectEntity of fhir.proto
+func (r AdverseEventSuspectEntity) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AdverseEventSuspectEntity to the encoded message
+func (r AdverseEventSuspectEntity) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Instance)
+	for _, v := range r.Causality {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given AdverseEventSuspectEntity from protobuf message AdverseEventSuspectEntity of fhir.proto
+func (r *AdverseEventSuspectEntity) UnmarshalProto(b []byte) error {
+	*r = AdverseEventSuspectEntity{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Instance = v
+		case 5:
+			var v AdverseEventSuspectEntityCausality
+			d.message(&v)
+			r.Causality = append(r.Causality, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AdverseEventSuspectEntityCausality as JSON into a byte slice
+func (r AdverseEventSuspectEntityCausality) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AdverseEventSuspectEntityCausality as JSON object with the members in the order of the fields
+func (r AdverseEventSuspectEntityCausality) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Assessment != nil {
+		e.key("assessment")
+		r.Assessment.appendJSON(e)
+	}
+	if r.ProductRelatedness != nil {
+		e.key("productRelatedness")
+		e.string(*r.ProductRelatedness)
+	}
+	if r.ProductRelatednessElement != nil {
+		e.key("_productRelatedness")
+		r.ProductRelatednessElement.appendJSON(e)
+	}
+	if r.Author != nil {
+		e.key("author")
+		r.Author.appendJSON(e)
+	}
+	if r.Method != nil {
+		e.key("method")
+		r.Method.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AdverseEventSuspectEntityCausality from JSON. Unknown members are ignored.
+func (r *AdverseEventSuspectEntityCausality) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AdverseEventSuspectEntityCausality
+func (r *AdverseEventSuspectEntityCausality) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "assessment":
+			if d.null() {
+				r.Assessment = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Assessment = &v
+			}
+		case "productRelatedness":
+			if v, ok := d.string(); ok {
+				r.ProductRelatedness = &v
+			} else {
+				r.ProductRelatedness = nil
+			}
+		case "_productRelatedness":
+			r.ProductRelatednessElement = d.element()
+		case "author":
+			if d.null() {
+				r.Author = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = &v
+			}
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Method = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AdverseEventSuspectEntityCausality which shares no memory with the original
+func (r AdverseEventSuspectEntityCausality) DeepCopy() AdverseEventSuspectEntityCausality {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Assessment != nil {
+		v := r.Assessment.DeepCopy()
+		out.Assessment = &v
+	}
+	if r.ProductRelatedness != nil {
+		v := *r.ProductRelatedness
+		out.ProductRelatedness = &v
+	}
+	if r.ProductRelatednessElement != nil {
+		v := r.ProductRelatednessElement.DeepCopy()
+		out.ProductRelatednessElement = &v
+	}
+	if r.Author != nil {
+		v := r.Author.DeepCopy()
+		out.Author = &v
+	}
+	if r.Method != nil {
+		v := r.Method.DeepCopy()
+		out.Method = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AdverseEventSuspectEntityCausality) Equal(other AdverseEventSuspectEntityCausality) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Assessment == nil) != (other.Assessment == nil) || r.Assessment != nil && !r.Assessment.Equal(*other.Assessment) {
+		return false
+	}
+	if (r.ProductRelatedness == nil) != (other.ProductRelatedness == nil) || r.ProductRelatedness != nil && *r.ProductRelatedness != *other.ProductRelatedness {
+		return false
+	}
+	if (r.ProductRelatednessElement == nil) != (other.ProductRelatednessElement == nil) || r.ProductRelatednessElement != nil && !r.ProductRelatednessElement.Equal(*other.ProductRelatednessElement) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.Equal(*other.Author) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.Equal(*other.Method) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AdverseEventSuspectEntityCausality) EqualsDeep(other AdverseEventSuspectEntityCausality) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Assessment == nil) != (other.Assessment == nil) || r.Assessment != nil && !r.Assessment.EqualsDeep(*other.Assessment) {
+		return false
+	}
+	if (r.ProductRelatedness == nil) != (other.ProductRelatedness == nil) || r.ProductRelatedness != nil && !equivalentString(*r.ProductRelatedness, *other.ProductRelatedness) {
+		return false
+	}
+	if (r.ProductRelatednessElement == nil) != (other.ProductRelatednessElement == nil) || r.ProductRelatednessElement != nil && !r.ProductRelatednessElement.EqualsDeep(*other.ProductRelatednessElement) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.EqualsDeep(*other.Author) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.EqualsDeep(*other.Method) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AdverseEventSuspectEntityCausality) EqualsShallow(other AdverseEventSuspectEntityCausality) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ProductRelatedness == nil) != (other.ProductRelatedness == nil) || r.ProductRelatedness != nil && !equivalentString(*r.ProductRelatedness, *other.ProductRelatedness) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AdverseEventSuspectEntityCausality as FHIR XML
+func (r AdverseEventSuspectEntityCausality) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "assessment", r.Assessment); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "productRelatedness", r.ProductRelatedness, r.ProductRelatednessElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "author", r.Author); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "method", r.Method); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AdverseEventSuspectEntityCausality from FHIR XML
+func (r *AdverseEventSuspectEntityCausality) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "assessment":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Assessment = &v
+			case "productRelatedness":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ProductRelatedness = &v
+				}
+				r.ProductRelatednessElement = element
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = &v
+			case "method":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Method = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AdverseEventSuspectEntityCausality as properties to the RDF node n
+func (r AdverseEventSuspectEntityCausality) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("AdverseEvent.suspectEntity.causality.assessment", -1, r.Assessment)
+	n.primitive("AdverseEvent.suspectEntity.causality.productRelatedness", -1, r.ProductRelatedness, r.ProductRelatednessElement, "string")
+	n.element("AdverseEvent.suspectEntity.causality.author", -1, r.Author)
+	n.element("AdverseEvent.suspectEntity.causality.method", -1, r.Method)
+}
+
+// MarshalBSON marshals the given AdverseEventSuspectEntityCausality as BSON document with the structure of its FHIR JSON
+func (r AdverseEventSuspectEntityCausality) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AdverseEventSuspectEntityCausality from a BSON document with the structure of its FHIR JSON
+func (r *AdverseEventSuspectEntityCausality) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AdverseEventSuspectEntityCausality as protobuf message AdverseEventSuspectEntityCausality of fhir.proto
+func (r AdverseEventSuspectEntityCausality) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AdverseEventSuspectEntityCausality to the encoded message
+func (r AdverseEventSuspectEntityCausality) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Assessment != nil {
+		e.message(4, *r.Assessment)
+	}
+	if r.ProductRelatedness != nil {
+		e.string(5, *r.ProductRelatedness)
+	}
+	if r.Author != nil {
+		e.message(6, *r.Author)
+	}
+	if r.Method != nil {
+		e.message(7, *r.Method)
+	}
+	if r.ProductRelatednessElement != nil {
+		e.message(8, *r.ProductRelatednessElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AdverseEventSuspectEntityCausality from protobuf message AdverseEventSuspectEntityCausality of fhir.proto
+func (r *AdverseEventSuspectEntityCausality) UnmarshalProto(b []byte) error {
+	*r = AdverseEventSuspectEntityCausality{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Assessment = &v
+		case 5:
+			v := d.string()
+			r.ProductRelatedness = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Author = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Method = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ProductRelatednessElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalAdverseEvent unmarshals a AdverseEvent.
 func UnmarshalAdverseEvent(b []byte) (AdverseEvent, error) {
 	var adverseEvent AdverseEvent
-	if err := json.Unmarshal(b, &adverseEvent); err != nil {
+	if err := adverseEvent.UnmarshalJSON(b); err != nil {
 		return adverseEvent, err
 	}
 	return adverseEvent, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code AdverseEventActuality) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AdverseEventActuality) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AdverseEventActuality) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "actual":
 		*code = AdverseEventActualityActual
@@ -46,6 +50,12 @@ func (code *AdverseEventActuality) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AdverseEventActuality) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AdverseEventActuality) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AdverseEventActuality) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code AdverseEventActuality) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AdverseEventActuality) protoNumber() uint64 {
+	switch code {
+	case AdverseEventActualityActual:
+		return 1
+	case AdverseEventActualityPotential:
+		return 2
+	}
+	return 0
+}
+func (code *AdverseEventActuality) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AdverseEventActualityActual
+	case 2:
+		*code = AdverseEventActualityPotential
+	default:
+		return false
+	}
+	return true
+}
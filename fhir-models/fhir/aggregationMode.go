@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code AggregationMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AggregationMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AggregationMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "contained":
 		*code = AggregationModeContained
@@ -49,6 +53,12 @@ func (code *AggregationMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AggregationMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AggregationMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AggregationMode) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code AggregationMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AggregationMode) protoNumber() uint64 {
+	switch code {
+	case AggregationModeContained:
+		return 1
+	case AggregationModeReferenced:
+		return 2
+	case AggregationModeBundled:
+		return 3
+	}
+	return 0
+}
+func (code *AggregationMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AggregationModeContained
+	case 2:
+		*code = AggregationModeReferenced
+	case 3:
+		*code = AggregationModeBundled
+	default:
+		return false
+	}
+	return true
+}
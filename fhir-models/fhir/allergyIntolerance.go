@@ -14,70 +14,2468 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // AllergyIntolerance is documented here http://hl7.org/fhir/StructureDefinition/AllergyIntolerance
 type AllergyIntolerance struct {
-	Id                 *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	ClinicalStatus     *CodeableConcept               `bson:"clinicalStatus,omitempty" json:"clinicalStatus,omitempty"`
-	VerificationStatus *CodeableConcept               `bson:"verificationStatus,omitempty" json:"verificationStatus,omitempty"`
-	Type               *AllergyIntoleranceType        `bson:"type,omitempty" json:"type,omitempty"`
-	Category           []AllergyIntoleranceCategory   `bson:"category,omitempty" json:"category,omitempty"`
-	Criticality        *AllergyIntoleranceCriticality `bson:"criticality,omitempty" json:"criticality,omitempty"`
-	Code               *CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
-	Patient            Reference                      `bson:"patient" json:"patient"`
-	Encounter          *Reference                     `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	OnsetDateTime      *string                        `bson:"onsetDateTime,omitempty" json:"onsetDateTime,omitempty"`
-	OnsetAge           *Age                           `bson:"onsetAge,omitempty" json:"onsetAge,omitempty"`
-	OnsetPeriod        *Period                        `bson:"onsetPeriod,omitempty" json:"onsetPeriod,omitempty"`
-	OnsetRange         *Range                         `bson:"onsetRange,omitempty" json:"onsetRange,omitempty"`
-	OnsetString        *string                        `bson:"onsetString,omitempty" json:"onsetString,omitempty"`
-	RecordedDate       *string                        `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
-	Recorder           *Reference                     `bson:"recorder,omitempty" json:"recorder,omitempty"`
-	Asserter           *Reference                     `bson:"asserter,omitempty" json:"asserter,omitempty"`
-	LastOccurrence     *string                        `bson:"lastOccurrence,omitempty" json:"lastOccurrence,omitempty"`
-	Note               []Annotation                   `bson:"note,omitempty" json:"note,omitempty"`
-	Reaction           []AllergyIntoleranceReaction   `bson:"reaction,omitempty" json:"reaction,omitempty"`
+	Id                    *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                       `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                  *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier            []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ClinicalStatus        *CodeableConcept               `bson:"clinicalStatus,omitempty" json:"clinicalStatus,omitempty"`
+	VerificationStatus    *CodeableConcept               `bson:"verificationStatus,omitempty" json:"verificationStatus,omitempty"`
+	Type                  *AllergyIntoleranceType        `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement           *Element                       `bson:"_type,omitempty" json:"_type,omitempty"`
+	Category              []AllergyIntoleranceCategory   `bson:"category,omitempty" json:"category,omitempty"`
+	CategoryElement       []*Element                     `bson:"_category,omitempty" json:"_category,omitempty"`
+	Criticality           *AllergyIntoleranceCriticality `bson:"criticality,omitempty" json:"criticality,omitempty"`
+	CriticalityElement    *Element                       `bson:"_criticality,omitempty" json:"_criticality,omitempty"`
+	Code                  *CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
+	Patient               Reference                      `bson:"patient" json:"patient"`
+	Encounter             *Reference                     `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OnsetDateTime         *string                        `bson:"onsetDateTime,omitempty" json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement  *Element                       `bson:"_onsetDateTime,omitempty" json:"_onsetDateTime,omitempty"`
+	OnsetAge              *Age                           `bson:"onsetAge,omitempty" json:"onsetAge,omitempty"`
+	OnsetPeriod           *Period                        `bson:"onsetPeriod,omitempty" json:"onsetPeriod,omitempty"`
+	OnsetRange            *Range                         `bson:"onsetRange,omitempty" json:"onsetRange,omitempty"`
+	OnsetString           *string                        `bson:"onsetString,omitempty" json:"onsetString,omitempty"`
+	OnsetStringElement    *Element                       `bson:"_onsetString,omitempty" json:"_onsetString,omitempty"`
+	RecordedDate          *string                        `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
+	RecordedDateElement   *Element                       `bson:"_recordedDate,omitempty" json:"_recordedDate,omitempty"`
+	Recorder              *Reference                     `bson:"recorder,omitempty" json:"recorder,omitempty"`
+	Asserter              *Reference                     `bson:"asserter,omitempty" json:"asserter,omitempty"`
+	LastOccurrence        *string                        `bson:"lastOccurrence,omitempty" json:"lastOccurrence,omitempty"`
+	LastOccurrenceElement *Element                       `bson:"_lastOccurrence,omitempty" json:"_lastOccurrence,omitempty"`
+	Note                  []Annotation                   `bson:"note,omitempty" json:"note,omitempty"`
+	Reaction              []AllergyIntoleranceReaction   `bson:"reaction,omitempty" json:"reaction,omitempty"`
 }
+
+// AllergyIntoleranceOnset is implemented by the types allowed for AllergyIntolerance.onset[x]
+type AllergyIntoleranceOnset interface {
+	isAllergyIntoleranceOnset()
+}
+
+func (DateTime) isAllergyIntoleranceOnset() {}
+func (Age) isAllergyIntoleranceOnset()      {}
+func (Period) isAllergyIntoleranceOnset()   {}
+func (Range) isAllergyIntoleranceOnset()    {}
+func (String) isAllergyIntoleranceOnset()   {}
+
+// Onset returns the value of AllergyIntolerance.onset[x] together with its FHIR type code or nil if no value is set
+func (r AllergyIntolerance) Onset() (AllergyIntoleranceOnset, string) {
+	if r.OnsetDateTime != nil {
+		return DateTime(*r.OnsetDateTime), "dateTime"
+	}
+	if r.OnsetAge != nil {
+		return *r.OnsetAge, "Age"
+	}
+	if r.OnsetPeriod != nil {
+		return *r.OnsetPeriod, "Period"
+	}
+	if r.OnsetRange != nil {
+		return *r.OnsetRange, "Range"
+	}
+	if r.OnsetString != nil {
+		return String(*r.OnsetString), "string"
+	}
+	return nil, ""
+}
+
+// SetOnset sets the value of AllergyIntolerance.onset[x], given as value or pointer, and clears all other types
+func (r *AllergyIntolerance) SetOnset(value AllergyIntoleranceOnset) {
+	r.OnsetDateTime = nil
+	r.OnsetAge = nil
+	r.OnsetPeriod = nil
+	r.OnsetRange = nil
+	r.OnsetString = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OnsetDateTime = &primitive
+	case *DateTime:
+		r.OnsetDateTime = (*string)(v)
+	case Age:
+		r.OnsetAge = &v
+	case *Age:
+		r.OnsetAge = v
+	case Period:
+		r.OnsetPeriod = &v
+	case *Period:
+		r.OnsetPeriod = v
+	case Range:
+		r.OnsetRange = &v
+	case *Range:
+		r.OnsetRange = v
+	case String:
+		primitive := string(v)
+		r.OnsetString = &primitive
+	case *String:
+		r.OnsetString = (*string)(v)
+	}
+}
+
 type AllergyIntoleranceReaction struct {
-	Id                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Substance         *CodeableConcept            `bson:"substance,omitempty" json:"substance,omitempty"`
-	Manifestation     []CodeableConcept           `bson:"manifestation" json:"manifestation"`
-	Description       *string                     `bson:"description,omitempty" json:"description,omitempty"`
-	Onset             *string                     `bson:"onset,omitempty" json:"onset,omitempty"`
-	Severity          *AllergyIntoleranceSeverity `bson:"severity,omitempty" json:"severity,omitempty"`
-	ExposureRoute     *CodeableConcept            `bson:"exposureRoute,omitempty" json:"exposureRoute,omitempty"`
-	Note              []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
-}
-type OtherAllergyIntolerance AllergyIntolerance
+	Id                 *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Substance          *CodeableConcept            `bson:"substance,omitempty" json:"substance,omitempty"`
+	Manifestation      []CodeableConcept           `bson:"manifestation" json:"manifestation"`
+	Description        *string                     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Onset              *string                     `bson:"onset,omitempty" json:"onset,omitempty"`
+	OnsetElement       *Element                    `bson:"_onset,omitempty" json:"_onset,omitempty"`
+	Severity           *AllergyIntoleranceSeverity `bson:"severity,omitempty" json:"severity,omitempty"`
+	SeverityElement    *Element                    `bson:"_severity,omitempty" json:"_severity,omitempty"`
+	ExposureRoute      *CodeableConcept            `bson:"exposureRoute,omitempty" json:"exposureRoute,omitempty"`
+	Note               []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
+}
 
 // MarshalJSON marshals the given AllergyIntolerance as JSON into a byte slice
 func (r AllergyIntolerance) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherAllergyIntolerance
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherAllergyIntolerance: OtherAllergyIntolerance(r),
-		ResourceType:            "AllergyIntolerance",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AllergyIntolerance as JSON object with the members in the order of the fields
+func (r AllergyIntolerance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"AllergyIntolerance\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ClinicalStatus != nil {
+		e.key("clinicalStatus")
+		r.ClinicalStatus.appendJSON(e)
+	}
+	if r.VerificationStatus != nil {
+		e.key("verificationStatus")
+		r.VerificationStatus.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(r.Type.Code())
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CategoryElement) > 0 {
+		e.key("_category")
+		e.elements(r.CategoryElement, len(r.Category))
+	}
+	if r.Criticality != nil {
+		e.key("criticality")
+		e.string(r.Criticality.Code())
+	}
+	if r.CriticalityElement != nil {
+		e.key("_criticality")
+		r.CriticalityElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.OnsetDateTime != nil {
+		e.key("onsetDateTime")
+		e.string(*r.OnsetDateTime)
+	}
+	if r.OnsetDateTimeElement != nil {
+		e.key("_onsetDateTime")
+		r.OnsetDateTimeElement.appendJSON(e)
+	}
+	if r.OnsetAge != nil {
+		e.key("onsetAge")
+		r.OnsetAge.appendJSON(e)
+	}
+	if r.OnsetPeriod != nil {
+		e.key("onsetPeriod")
+		r.OnsetPeriod.appendJSON(e)
+	}
+	if r.OnsetRange != nil {
+		e.key("onsetRange")
+		r.OnsetRange.appendJSON(e)
+	}
+	if r.OnsetString != nil {
+		e.key("onsetString")
+		e.string(*r.OnsetString)
+	}
+	if r.OnsetStringElement != nil {
+		e.key("_onsetString")
+		r.OnsetStringElement.appendJSON(e)
+	}
+	if r.RecordedDate != nil {
+		e.key("recordedDate")
+		e.string(*r.RecordedDate)
+	}
+	if r.RecordedDateElement != nil {
+		e.key("_recordedDate")
+		r.RecordedDateElement.appendJSON(e)
+	}
+	if r.Recorder != nil {
+		e.key("recorder")
+		r.Recorder.appendJSON(e)
+	}
+	if r.Asserter != nil {
+		e.key("asserter")
+		r.Asserter.appendJSON(e)
+	}
+	if r.LastOccurrence != nil {
+		e.key("lastOccurrence")
+		e.string(*r.LastOccurrence)
+	}
+	if r.LastOccurrenceElement != nil {
+		e.key("_lastOccurrence")
+		r.LastOccurrenceElement.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Reaction) > 0 {
+		e.key("reaction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reaction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AllergyIntolerance from JSON. Unknown members are ignored.
+func (r *AllergyIntolerance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AllergyIntolerance
+func (r *AllergyIntolerance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "clinicalStatus":
+			if d.null() {
+				r.ClinicalStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ClinicalStatus = &v
+			}
+		case "verificationStatus":
+			if d.null() {
+				r.VerificationStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.VerificationStatus = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v AllergyIntoleranceType
+				d.unmarshal(&v)
+				r.Type = &v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v AllergyIntoleranceCategory
+				d.unmarshal(&v)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []AllergyIntoleranceCategory{}
+			}
+		case "_category":
+			a := d.array()
+			r.CategoryElement = nil
+			for a.next() {
+				r.CategoryElement = append(r.CategoryElement, d.element())
+			}
+		case "criticality":
+			if d.null() {
+				r.Criticality = nil
+			} else {
+				var v AllergyIntoleranceCriticality
+				d.unmarshal(&v)
+				r.Criticality = &v
+			}
+		case "_criticality":
+			r.CriticalityElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "onsetDateTime":
+			if v, ok := d.string(); ok {
+				r.OnsetDateTime = &v
+			} else {
+				r.OnsetDateTime = nil
+			}
+		case "_onsetDateTime":
+			r.OnsetDateTimeElement = d.element()
+		case "onsetAge":
+			if d.null() {
+				r.OnsetAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.OnsetAge = &v
+			}
+		case "onsetPeriod":
+			if d.null() {
+				r.OnsetPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OnsetPeriod = &v
+			}
+		case "onsetRange":
+			if d.null() {
+				r.OnsetRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.OnsetRange = &v
+			}
+		case "onsetString":
+			if v, ok := d.string(); ok {
+				r.OnsetString = &v
+			} else {
+				r.OnsetString = nil
+			}
+		case "_onsetString":
+			r.OnsetStringElement = d.element()
+		case "recordedDate":
+			if v, ok := d.string(); ok {
+				r.RecordedDate = &v
+			} else {
+				r.RecordedDate = nil
+			}
+		case "_recordedDate":
+			r.RecordedDateElement = d.element()
+		case "recorder":
+			if d.null() {
+				r.Recorder = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recorder = &v
+			}
+		case "asserter":
+			if d.null() {
+				r.Asserter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Asserter = &v
+			}
+		case "lastOccurrence":
+			if v, ok := d.string(); ok {
+				r.LastOccurrence = &v
+			} else {
+				r.LastOccurrence = nil
+			}
+		case "_lastOccurrence":
+			r.LastOccurrenceElement = d.element()
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "reaction":
+			a := d.array()
+			r.Reaction = nil
+			for a.next() {
+				var v AllergyIntoleranceReaction
+				v.decodeJSON(d)
+				r.Reaction = append(r.Reaction, v)
+			}
+			if r.Reaction == nil && !a.isNull {
+				r.Reaction = []AllergyIntoleranceReaction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AllergyIntolerance which shares no memory with the original
+func (r AllergyIntolerance) DeepCopy() AllergyIntolerance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.ClinicalStatus != nil {
+		v := r.ClinicalStatus.DeepCopy()
+		out.ClinicalStatus = &v
+	}
+	if r.VerificationStatus != nil {
+		v := r.VerificationStatus.DeepCopy()
+		out.VerificationStatus = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]AllergyIntoleranceCategory, len(r.Category))
+		copy(out.Category, r.Category)
+	}
+	if r.CategoryElement != nil {
+		out.CategoryElement = make([]*Element, len(r.CategoryElement))
+		for i, v := range r.CategoryElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.CategoryElement[i] = &c
+			}
+		}
+	}
+	if r.Criticality != nil {
+		v := *r.Criticality
+		out.Criticality = &v
+	}
+	if r.CriticalityElement != nil {
+		v := r.CriticalityElement.DeepCopy()
+		out.CriticalityElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.OnsetDateTime != nil {
+		v := *r.OnsetDateTime
+		out.OnsetDateTime = &v
+	}
+	if r.OnsetDateTimeElement != nil {
+		v := r.OnsetDateTimeElement.DeepCopy()
+		out.OnsetDateTimeElement = &v
+	}
+	if r.OnsetAge != nil {
+		v := r.OnsetAge.DeepCopy()
+		out.OnsetAge = &v
+	}
+	if r.OnsetPeriod != nil {
+		v := r.OnsetPeriod.DeepCopy()
+		out.OnsetPeriod = &v
+	}
+	if r.OnsetRange != nil {
+		v := r.OnsetRange.DeepCopy()
+		out.OnsetRange = &v
+	}
+	if r.OnsetString != nil {
+		v := *r.OnsetString
+		out.OnsetString = &v
+	}
+	if r.OnsetStringElement != nil {
+		v := r.OnsetStringElement.DeepCopy()
+		out.OnsetStringElement = &v
+	}
+	if r.RecordedDate != nil {
+		v := *r.RecordedDate
+		out.RecordedDate = &v
+	}
+	if r.RecordedDateElement != nil {
+		v := r.RecordedDateElement.DeepCopy()
+		out.RecordedDateElement = &v
+	}
+	if r.Recorder != nil {
+		v := r.Recorder.DeepCopy()
+		out.Recorder = &v
+	}
+	if r.Asserter != nil {
+		v := r.Asserter.DeepCopy()
+		out.Asserter = &v
+	}
+	if r.LastOccurrence != nil {
+		v := *r.LastOccurrence
+		out.LastOccurrence = &v
+	}
+	if r.LastOccurrenceElement != nil {
+		v := r.LastOccurrenceElement.DeepCopy()
+		out.LastOccurrenceElement = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.Reaction != nil {
+		out.Reaction = make([]AllergyIntoleranceReaction, len(r.Reaction))
+		for i := range r.Reaction {
+			out.Reaction[i] = r.Reaction[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AllergyIntolerance) Equal(other AllergyIntolerance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.ClinicalStatus == nil) != (other.ClinicalStatus == nil) || r.ClinicalStatus != nil && !r.ClinicalStatus.Equal(*other.ClinicalStatus) {
+		return false
+	}
+	if (r.VerificationStatus == nil) != (other.VerificationStatus == nil) || r.VerificationStatus != nil && !r.VerificationStatus.Equal(*other.VerificationStatus) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if r.Category[i] != other.Category[i] {
+			return false
+		}
+	}
+	if !equalElements(r.CategoryElement, other.CategoryElement, false) {
+		return false
+	}
+	if (r.Criticality == nil) != (other.Criticality == nil) || r.Criticality != nil && *r.Criticality != *other.Criticality {
+		return false
+	}
+	if (r.CriticalityElement == nil) != (other.CriticalityElement == nil) || r.CriticalityElement != nil && !r.CriticalityElement.Equal(*other.CriticalityElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.OnsetDateTime == nil) != (other.OnsetDateTime == nil) || r.OnsetDateTime != nil && *r.OnsetDateTime != *other.OnsetDateTime {
+		return false
+	}
+	if (r.OnsetDateTimeElement == nil) != (other.OnsetDateTimeElement == nil) || r.OnsetDateTimeElement != nil && !r.OnsetDateTimeElement.Equal(*other.OnsetDateTimeElement) {
+		return false
+	}
+	if (r.OnsetAge == nil) != (other.OnsetAge == nil) || r.OnsetAge != nil && !r.OnsetAge.Equal(*other.OnsetAge) {
+		return false
+	}
+	if (r.OnsetPeriod == nil) != (other.OnsetPeriod == nil) || r.OnsetPeriod != nil && !r.OnsetPeriod.Equal(*other.OnsetPeriod) {
+		return false
+	}
+	if (r.OnsetRange == nil) != (other.OnsetRange == nil) || r.OnsetRange != nil && !r.OnsetRange.Equal(*other.OnsetRange) {
+		return false
+	}
+	if (r.OnsetString == nil) != (other.OnsetString == nil) || r.OnsetString != nil && *r.OnsetString != *other.OnsetString {
+		return false
+	}
+	if (r.OnsetStringElement == nil) != (other.OnsetStringElement == nil) || r.OnsetStringElement != nil && !r.OnsetStringElement.Equal(*other.OnsetStringElement) {
+		return false
+	}
+	if (r.RecordedDate == nil) != (other.RecordedDate == nil) || r.RecordedDate != nil && *r.RecordedDate != *other.RecordedDate {
+		return false
+	}
+	if (r.RecordedDateElement == nil) != (other.RecordedDateElement == nil) || r.RecordedDateElement != nil && !r.RecordedDateElement.Equal(*other.RecordedDateElement) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.Equal(*other.Recorder) {
+		return false
+	}
+	if (r.Asserter == nil) != (other.Asserter == nil) || r.Asserter != nil && !r.Asserter.Equal(*other.Asserter) {
+		return false
+	}
+	if (r.LastOccurrence == nil) != (other.LastOccurrence == nil) || r.LastOccurrence != nil && *r.LastOccurrence != *other.LastOccurrence {
+		return false
+	}
+	if (r.LastOccurrenceElement == nil) != (other.LastOccurrenceElement == nil) || r.LastOccurrenceElement != nil && !r.LastOccurrenceElement.Equal(*other.LastOccurrenceElement) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.Reaction) != len(other.Reaction) {
+		return false
+	}
+	for i := range r.Reaction {
+		if !r.Reaction[i].Equal(other.Reaction[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AllergyIntolerance) EqualsDeep(other AllergyIntolerance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.ClinicalStatus == nil) != (other.ClinicalStatus == nil) || r.ClinicalStatus != nil && !r.ClinicalStatus.EqualsDeep(*other.ClinicalStatus) {
+		return false
+	}
+	if (r.VerificationStatus == nil) != (other.VerificationStatus == nil) || r.VerificationStatus != nil && !r.VerificationStatus.EqualsDeep(*other.VerificationStatus) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i] == other.Category[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.CategoryElement, other.CategoryElement, true) {
+		return false
+	}
+	if (r.Criticality == nil) != (other.Criticality == nil) || r.Criticality != nil && *r.Criticality != *other.Criticality {
+		return false
+	}
+	if (r.CriticalityElement == nil) != (other.CriticalityElement == nil) || r.CriticalityElement != nil && !r.CriticalityElement.EqualsDeep(*other.CriticalityElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.OnsetDateTime == nil) != (other.OnsetDateTime == nil) || r.OnsetDateTime != nil && !equivalentString(*r.OnsetDateTime, *other.OnsetDateTime) {
+		return false
+	}
+	if (r.OnsetDateTimeElement == nil) != (other.OnsetDateTimeElement == nil) || r.OnsetDateTimeElement != nil && !r.OnsetDateTimeElement.EqualsDeep(*other.OnsetDateTimeElement) {
+		return false
+	}
+	if (r.OnsetAge == nil) != (other.OnsetAge == nil) || r.OnsetAge != nil && !r.OnsetAge.EqualsDeep(*other.OnsetAge) {
+		return false
+	}
+	if (r.OnsetPeriod == nil) != (other.OnsetPeriod == nil) || r.OnsetPeriod != nil && !r.OnsetPeriod.EqualsDeep(*other.OnsetPeriod) {
+		return false
+	}
+	if (r.OnsetRange == nil) != (other.OnsetRange == nil) || r.OnsetRange != nil && !r.OnsetRange.EqualsDeep(*other.OnsetRange) {
+		return false
+	}
+	if (r.OnsetString == nil) != (other.OnsetString == nil) || r.OnsetString != nil && !equivalentString(*r.OnsetString, *other.OnsetString) {
+		return false
+	}
+	if (r.OnsetStringElement == nil) != (other.OnsetStringElement == nil) || r.OnsetStringElement != nil && !r.OnsetStringElement.EqualsDeep(*other.OnsetStringElement) {
+		return false
+	}
+	if (r.RecordedDate == nil) != (other.RecordedDate == nil) || r.RecordedDate != nil && !equivalentString(*r.RecordedDate, *other.RecordedDate) {
+		return false
+	}
+	if (r.RecordedDateElement == nil) != (other.RecordedDateElement == nil) || r.RecordedDateElement != nil && !r.RecordedDateElement.EqualsDeep(*other.RecordedDateElement) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.EqualsDeep(*other.Recorder) {
+		return false
+	}
+	if (r.Asserter == nil) != (other.Asserter == nil) || r.Asserter != nil && !r.Asserter.EqualsDeep(*other.Asserter) {
+		return false
+	}
+	if (r.LastOccurrence == nil) != (other.LastOccurrence == nil) || r.LastOccurrence != nil && !equivalentString(*r.LastOccurrence, *other.LastOccurrence) {
+		return false
+	}
+	if (r.LastOccurrenceElement == nil) != (other.LastOccurrenceElement == nil) || r.LastOccurrenceElement != nil && !r.LastOccurrenceElement.EqualsDeep(*other.LastOccurrenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reaction), len(other.Reaction), func(i, j int) bool {
+		return r.Reaction[i].EqualsDeep(other.Reaction[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AllergyIntolerance) EqualsShallow(other AllergyIntolerance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i] == other.Category[j]
+	}) {
+		return false
+	}
+	if (r.Criticality == nil) != (other.Criticality == nil) || r.Criticality != nil && *r.Criticality != *other.Criticality {
+		return false
+	}
+	if (r.OnsetDateTime == nil) != (other.OnsetDateTime == nil) || r.OnsetDateTime != nil && !equivalentString(*r.OnsetDateTime, *other.OnsetDateTime) {
+		return false
+	}
+	if (r.OnsetString == nil) != (other.OnsetString == nil) || r.OnsetString != nil && !equivalentString(*r.OnsetString, *other.OnsetString) {
+		return false
+	}
+	if (r.RecordedDate == nil) != (other.RecordedDate == nil) || r.RecordedDate != nil && !equivalentString(*r.RecordedDate, *other.RecordedDate) {
+		return false
+	}
+	if (r.LastOccurrence == nil) != (other.LastOccurrence == nil) || r.LastOccurrence != nil && !equivalentString(*r.LastOccurrence, *other.LastOccurrence) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AllergyIntolerance as FHIR XML
+func (r AllergyIntolerance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "AllergyIntolerance",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "clinicalStatus", r.ClinicalStatus); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "verificationStatus", r.VerificationStatus); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	for i, v := range r.Category {
+		if err := encodeXMLPrimitive(e, "category", v, elementAt(r.CategoryElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "criticality", r.Criticality, r.CriticalityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "onsetDateTime", r.OnsetDateTime, r.OnsetDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onsetAge", r.OnsetAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onsetPeriod", r.OnsetPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onsetRange", r.OnsetRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "onsetString", r.OnsetString, r.OnsetStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "recordedDate", r.RecordedDate, r.RecordedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "recorder", r.Recorder); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "asserter", r.Asserter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastOccurrence", r.LastOccurrence, r.LastOccurrenceElement); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reaction {
+		if err := encodeXMLElement(e, "reaction", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AllergyIntolerance from FHIR XML
+func (r *AllergyIntolerance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "clinicalStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ClinicalStatus = &v
+			case "verificationStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.VerificationStatus = &v
+			case "type":
+				var v AllergyIntoleranceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "category":
+				var v AllergyIntoleranceCategory
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.CategoryElement = appendElement(r.CategoryElement, len(r.Category), element)
+					r.Category = append(r.Category, v)
+				}
+			case "criticality":
+				var v AllergyIntoleranceCriticality
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Criticality = &v
+				}
+				r.CriticalityElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "onsetDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OnsetDateTime = &v
+				}
+				r.OnsetDateTimeElement = element
+			case "onsetAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnsetAge = &v
+			case "onsetPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnsetPeriod = &v
+			case "onsetRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnsetRange = &v
+			case "onsetString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OnsetString = &v
+				}
+				r.OnsetStringElement = element
+			case "recordedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RecordedDate = &v
+				}
+				r.RecordedDateElement = element
+			case "recorder":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recorder = &v
+			case "asserter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Asserter = &v
+			case "lastOccurrence":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastOccurrence = &v
+				}
+				r.LastOccurrenceElement = element
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "reaction":
+				var v AllergyIntoleranceReaction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reaction = append(r.Reaction, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AllergyIntolerance as properties to the RDF node n
+func (r AllergyIntolerance) turtle(n *turtleNode) {
+	n.resource("AllergyIntolerance", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("AllergyIntolerance.identifier", i, v)
+	}
+	n.element("AllergyIntolerance.clinicalStatus", -1, r.ClinicalStatus)
+	n.element("AllergyIntolerance.verificationStatus", -1, r.VerificationStatus)
+	n.primitive("AllergyIntolerance.type", -1, r.Type, r.TypeElement, "code")
+	for i, v := range r.Category {
+		n.primitive("AllergyIntolerance.category", i, v, elementAt(r.CategoryElement, i), "code")
+	}
+	n.primitive("AllergyIntolerance.criticality", -1, r.Criticality, r.CriticalityElement, "code")
+	n.element("AllergyIntolerance.code", -1, r.Code)
+	n.element("AllergyIntolerance.patient", -1, r.Patient)
+	n.element("AllergyIntolerance.encounter", -1, r.Encounter)
+	n.primitive("AllergyIntolerance.onsetDateTime", -1, r.OnsetDateTime, r.OnsetDateTimeElement, "dateTime")
+	n.element("AllergyIntolerance.onsetAge", -1, r.OnsetAge)
+	n.element("AllergyIntolerance.onsetPeriod", -1, r.OnsetPeriod)
+	n.element("AllergyIntolerance.onsetRange", -1, r.OnsetRange)
+	n.primitive("AllergyIntolerance.onsetString", -1, r.OnsetString, r.OnsetStringElement, "string")
+	n.primitive("AllergyIntolerance.recordedDate", -1, r.RecordedDate, r.RecordedDateElement, "dateTime")
+	n.element("AllergyIntolerance.recorder", -1, r.Recorder)
+	n.element("AllergyIntolerance.asserter", -1, r.Asserter)
+	n.primitive("AllergyIntolerance.lastOccurrence", -1, r.LastOccurrence, r.LastOccurrenceElement, "dateTime")
+	for i, v := range r.Note {
+		n.element("AllergyIntolerance.note", i, v)
+	}
+	for i, v := range r.Reaction {
+		n.element("AllergyIntolerance.reaction", i, v)
+	}
+}
+
+// MarshalBSON marshals the given AllergyIntolerance as BSON document with the structure of its FHIR JSON
+func (r AllergyIntolerance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AllergyIntolerance from a BSON document with the structure of its FHIR JSON
+func (r *AllergyIntolerance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AllergyIntolerance as protobuf message AllergyIntolerance of fhir.proto
+func (r AllergyIntolerance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AllergyIntolerance to the encoded message
+func (r AllergyIntolerance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.ClinicalStatus != nil {
+		e.message(10, *r.ClinicalStatus)
+	}
+	if r.VerificationStatus != nil {
+		e.message(11, *r.VerificationStatus)
+	}
+	if r.Type != nil {
+		e.varint(12, r.Type.protoNumber())
+	}
+	if len(r.Category) > 0 {
+		var p protoEncoder
+		for _, v := range r.Category {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(13, p.buf)
+	}
+	if r.Criticality != nil {
+		e.varint(14, r.Criticality.protoNumber())
+	}
+	if r.Code != nil {
+		e.message(15, *r.Code)
+	}
+	e.message(16, r.Patient)
+	if r.Encounter != nil {
+		e.message(17, *r.Encounter)
+	}
+	if r.OnsetDateTime != nil {
+		e.string(18, *r.OnsetDateTime)
+	}
+	if r.OnsetAge != nil {
+		e.message(19, *r.OnsetAge)
+	}
+	if r.OnsetPeriod != nil {
+		e.message(20, *r.OnsetPeriod)
+	}
+	if r.OnsetRange != nil {
+		e.message(21, *r.OnsetRange)
+	}
+	if r.OnsetString != nil {
+		e.string(22, *r.OnsetString)
+	}
+	if r.RecordedDate != nil {
+		e.string(23, *r.RecordedDate)
+	}
+	if r.Recorder != nil {
+		e.message(24, *r.Recorder)
+	}
+	if r.Asserter != nil {
+		e.message(25, *r.Asserter)
+	}
+	if r.LastOccurrence != nil {
+		e.string(26, *r.LastOccurrence)
+	}
+	for _, v := range r.Note {
+		e.message(27, v)
+	}
+	for _, v := range r.Reaction {
+		e.message(28, v)
+	}
+	if r.IdElement != nil {
+		e.message(29, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(30, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(31, *r.LanguageElement)
+	}
+	if r.TypeElement != nil {
+		e.message(32, *r.TypeElement)
+	}
+	for _, v := range r.CategoryElement {
+		e.element(33, v)
+	}
+	if r.CriticalityElement != nil {
+		e.message(34, *r.CriticalityElement)
+	}
+	if r.OnsetDateTimeElement != nil {
+		e.message(35, *r.OnsetDateTimeElement)
+	}
+	if r.OnsetStringElement != nil {
+		e.message(36, *r.OnsetStringElement)
+	}
+	if r.RecordedDateElement != nil {
+		e.message(37, *r.RecordedDateElement)
+	}
+	if r.LastOccurrenceElement != nil {
+		e.message(38, *r.LastOccurrenceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AllergyIntolerance from protobuf message AllergyIntolerance of fhir.proto
+func (r *AllergyIntolerance) UnmarshalProto(b []byte) error {
+	*r = AllergyIntolerance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.ClinicalStatus = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.VerificationStatus = &v
+		case 12:
+			var v AllergyIntoleranceType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = &v
+		case 13:
+			for _, n := range d.varints() {
+				var v AllergyIntoleranceCategory
+				d.enum(n, v.setProtoNumber)
+				r.Category = append(r.Category, v)
+			}
+		case 14:
+			var v AllergyIntoleranceCriticality
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Criticality = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 18:
+			v := d.string()
+			r.OnsetDateTime = &v
+		case 19:
+			var v Age
+			d.message(&v)
+			r.OnsetAge = &v
+		case 20:
+			var v Period
+			d.message(&v)
+			r.OnsetPeriod = &v
+		case 21:
+			var v Range
+			d.message(&v)
+			r.OnsetRange = &v
+		case 22:
+			v := d.string()
+			r.OnsetString = &v
+		case 23:
+			v := d.string()
+			r.RecordedDate = &v
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Recorder = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Asserter = &v
+		case 26:
+			v := d.string()
+			r.LastOccurrence = &v
+		case 27:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 28:
+			var v AllergyIntoleranceReaction
+			d.message(&v)
+			r.Reaction = append(r.Reaction, v)
+		case 29:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 33:
+			r.CategoryElement = append(r.CategoryElement, d.element())
+		case 34:
+			var v Element
+			d.message(&v)
+			r.CriticalityElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.OnsetDateTimeElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.OnsetStringElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.RecordedDateElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.LastOccurrenceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AllergyIntoleranceReaction as JSON into a byte slice
+func (r AllergyIntoleranceReaction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AllergyIntoleranceReaction as JSON object with the members in the order of the fields
+func (r AllergyIntoleranceReaction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Substance != nil {
+		e.key("substance")
+		r.Substance.appendJSON(e)
+	}
+	e.key("manifestation")
+	if r.Manifestation == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Manifestation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Onset != nil {
+		e.key("onset")
+		e.string(*r.Onset)
+	}
+	if r.OnsetElement != nil {
+		e.key("_onset")
+		r.OnsetElement.appendJSON(e)
+	}
+	if r.Severity != nil {
+		e.key("severity")
+		e.string(r.Severity.Code())
+	}
+	if r.SeverityElement != nil {
+		e.key("_severity")
+		r.SeverityElement.appendJSON(e)
+	}
+	if r.ExposureRoute != nil {
+		e.key("exposureRoute")
+		r.ExposureRoute.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AllergyIntoleranceReaction from JSON. Unknown members are ignored.
+func (r *AllergyIntoleranceReaction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AllergyIntoleranceReaction
+func (r *AllergyIntoleranceReaction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "substance":
+			if d.null() {
+				r.Substance = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Substance = &v
+			}
+		case "manifestation":
+			a := d.array()
+			r.Manifestation = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Manifestation = append(r.Manifestation, v)
+			}
+			if r.Manifestation == nil && !a.isNull {
+				r.Manifestation = []CodeableConcept{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "onset":
+			if v, ok := d.string(); ok {
+				r.Onset = &v
+			} else {
+				r.Onset = nil
+			}
+		case "_onset":
+			r.OnsetElement = d.element()
+		case "severity":
+			if d.null() {
+				r.Severity = nil
+			} else {
+				var v AllergyIntoleranceSeverity
+				d.unmarshal(&v)
+				r.Severity = &v
+			}
+		case "_severity":
+			r.SeverityElement = d.element()
+		case "exposureRoute":
+			if d.null() {
+				r.ExposureRoute = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ExposureRoute = &v
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AllergyIntoleranceReaction which shares no memory with the original
+func (r AllergyIntoleranceReaction) DeepCopy() AllergyIntoleranceReaction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Substance != nil {
+		v := r.Substance.DeepCopy()
+		out.Substance = &v
+	}
+	if r.Manifestation != nil {
+		out.Manifestation = make([]CodeableConcept, len(r.Manifestation))
+		for i := range r.Manifestation {
+			out.Manifestation[i] = r.Manifestation[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Onset != nil {
+		v := *r.Onset
+		out.Onset = &v
+	}
+	if r.OnsetElement != nil {
+		v := r.OnsetElement.DeepCopy()
+		out.OnsetElement = &v
+	}
+	if r.Severity != nil {
+		v := *r.Severity
+		out.Severity = &v
+	}
+	if r.SeverityElement != nil {
+		v := r.SeverityElement.DeepCopy()
+		out.SeverityElement = &v
+	}
+	if r.ExposureRoute != nil {
+		v := r.ExposureRoute.DeepCopy()
+		out.ExposureRoute = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AllergyIntoleranceReaction) Equal(other AllergyIntoleranceReaction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Substance == nil) != (other.Substance == nil) || r.Substance != nil && !r.Substance.Equal(*other.Substance) {
+		return false
+	}
+	if len(r.Manifestation) != len(other.Manifestation) {
+		return false
+	}
+	for i := range r.Manifestation {
+		if !r.Manifestation[i].Equal(other.Manifestation[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Onset == nil) != (other.Onset == nil) || r.Onset != nil && *r.Onset != *other.Onset {
+		return false
+	}
+	if (r.OnsetElement == nil) != (other.OnsetElement == nil) || r.OnsetElement != nil && !r.OnsetElement.Equal(*other.OnsetElement) {
+		return false
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && *r.Severity != *other.Severity {
+		return false
+	}
+	if (r.SeverityElement == nil) != (other.SeverityElement == nil) || r.SeverityElement != nil && !r.SeverityElement.Equal(*other.SeverityElement) {
+		return false
+	}
+	if (r.ExposureRoute == nil) != (other.ExposureRoute == nil) || r.ExposureRoute != nil && !r.ExposureRoute.Equal(*other.ExposureRoute) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AllergyIntoleranceReaction) EqualsDeep(other AllergyIntoleranceReaction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Substance == nil) != (other.Substance == nil) || r.Substance != nil && !r.Substance.EqualsDeep(*other.Substance) {
+		return false
+	}
+	if !equivalentList(len(r.Manifestation), len(other.Manifestation), func(i, j int) bool {
+		return r.Manifestation[i].EqualsDeep(other.Manifestation[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Onset == nil) != (other.Onset == nil) || r.Onset != nil && !equivalentString(*r.Onset, *other.Onset) {
+		return false
+	}
+	if (r.OnsetElement == nil) != (other.OnsetElement == nil) || r.OnsetElement != nil && !r.OnsetElement.EqualsDeep(*other.OnsetElement) {
+		return false
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && *r.Severity != *other.Severity {
+		return false
+	}
+	if (r.SeverityElement == nil) != (other.SeverityElement == nil) || r.SeverityElement != nil && !r.SeverityElement.EqualsDeep(*other.SeverityElement) {
+		return false
+	}
+	if (r.ExposureRoute == nil) != (other.ExposureRoute == nil) || r.ExposureRoute != nil && !r.ExposureRoute.EqualsDeep(*other.ExposureRoute) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AllergyIntoleranceReaction) EqualsShallow(other AllergyIntoleranceReaction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Onset == nil) != (other.Onset == nil) || r.Onset != nil && !equivalentString(*r.Onset, *other.Onset) {
+		return false
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && *r.Severity != *other.Severity {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AllergyIntoleranceReaction as FHIR XML
+func (r AllergyIntoleranceReaction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "substance", r.Substance); err != nil {
+		return err
+	}
+	for _, v := range r.Manifestation {
+		if err := encodeXMLElement(e, "manifestation", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "onset", r.Onset, r.OnsetElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "severity", r.Severity, r.SeverityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "exposureRoute", r.ExposureRoute); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AllergyIntoleranceReaction from FHIR XML
+func (r *AllergyIntoleranceReaction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "substance":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Substance = &v
+			case "manifestation":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manifestation = append(r.Manifestation, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "onset":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Onset = &v
+				}
+				r.OnsetElement = element
+			case "severity":
+				var v AllergyIntoleranceSeverity
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Severity = &v
+				}
+				r.SeverityElement = element
+			case "exposureRoute":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ExposureRoute = &v
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AllergyIntoleranceReaction as properties to the RDF node n
+func (r AllergyIntoleranceReaction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("AllergyIntolerance.reaction.substance", -1, r.Substance)
+	for i, v := range r.Manifestation {
+		n.element("AllergyIntolerance.reaction.manifestation", i, v)
+	}
+	n.primitive("AllergyIntolerance.reaction.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("AllergyIntolerance.reaction.onset", -1, r.Onset, r.OnsetElement, "dateTime")
+	n.primitive("AllergyIntolerance.reaction.severity", -1, r.Severity, r.SeverityElement, "code")
+	n.element("AllergyIntolerance.reaction.exposureRoute", -1, r.ExposureRoute)
+	for i, v := range r.Note {
+		n.element("AllergyIntolerance.reaction.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given AllergyIntoleranceReaction as BSON document with the structure of its FHIR JSON
+func (r AllergyIntoleranceReaction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AllergyIntoleranceReaction from a BSON document with the structure of its FHIR JSON
+func (r *AllergyIntoleranceReaction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AllergyIntoleranceReaction as protobuf message AllergyIntoleranceReaction of fhir.proto
+func (r AllergyIntoleranceReaction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AllergyIntoleranceReaction to the encoded message
+func (r AllergyIntoleranceReaction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Substance != nil {
+		e.message(4, *r.Substance)
+	}
+	for _, v := range r.Manifestation {
+		e.message(5, v)
+	}
+	if r.Description != nil {
+		e.string(6, *r.Description)
+	}
+	if r.Onset != nil {
+		e.string(7, *r.Onset)
+	}
+	if r.Severity != nil {
+		e.varint(8, r.Severity.protoNumber())
+	}
+	if r.ExposureRoute != nil {
+		e.message(9, *r.ExposureRoute)
+	}
+	for _, v := range r.Note {
+		e.message(10, v)
+	}
+	if r.DescriptionElement != nil {
+		e.message(11, *r.DescriptionElement)
+	}
+	if r.OnsetElement != nil {
+		e.message(12, *r.OnsetElement)
+	}
+	if r.SeverityElement != nil {
+		e.message(13, *r.SeverityElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AllergyIntoleranceReaction from protobuf message AllergyIntoleranceReaction of fhir.proto
+func (r *AllergyIntoleranceReaction) UnmarshalProto(b []byte) error {
+	*r = AllergyIntoleranceReaction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Substance = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Manifestation = append(r.Manifestation, v)
+		case 6:
+			v := d.string()
+			r.Description = &v
+		case 7:
+			v := d.string()
+			r.Onset = &v
+		case 8:
+			var v AllergyIntoleranceSeverity
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Severity = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.ExposureRoute = &v
+		case 10:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.OnsetElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.SeverityElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalAllergyIntolerance unmarshals a AllergyIntolerance.
 func UnmarshalAllergyIntolerance(b []byte) (AllergyIntolerance, error) {
 	var allergyIntolerance AllergyIntolerance
-	if err := json.Unmarshal(b, &allergyIntolerance); err != nil {
+	if err := allergyIntolerance.UnmarshalJSON(b); err != nil {
 		return allergyIntolerance, err
 	}
 	return allergyIntolerance, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code AllergyIntoleranceCategory) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AllergyIntoleranceCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AllergyIntoleranceCategory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "food":
 		*code = AllergyIntoleranceCategoryFood
@@ -52,6 +56,12 @@ func (code *AllergyIntoleranceCategory) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AllergyIntoleranceCategory) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AllergyIntoleranceCategory) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AllergyIntoleranceCategory) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code AllergyIntoleranceCategory) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AllergyIntoleranceCategory) protoNumber() uint64 {
+	switch code {
+	case AllergyIntoleranceCategoryFood:
+		return 1
+	case AllergyIntoleranceCategoryMedication:
+		return 2
+	case AllergyIntoleranceCategoryEnvironment:
+		return 3
+	case AllergyIntoleranceCategoryBiologic:
+		return 4
+	}
+	return 0
+}
+func (code *AllergyIntoleranceCategory) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AllergyIntoleranceCategoryFood
+	case 2:
+		*code = AllergyIntoleranceCategoryMedication
+	case 3:
+		*code = AllergyIntoleranceCategoryEnvironment
+	case 4:
+		*code = AllergyIntoleranceCategoryBiologic
+	default:
+		return false
+	}
+	return true
+}
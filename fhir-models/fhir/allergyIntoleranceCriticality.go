@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code AllergyIntoleranceCriticality) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AllergyIntoleranceCriticality) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AllergyIntoleranceCriticality) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "low":
 		*code = AllergyIntoleranceCriticalityLow
@@ -49,6 +53,12 @@ func (code *AllergyIntoleranceCriticality) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AllergyIntoleranceCriticality) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AllergyIntoleranceCriticality) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AllergyIntoleranceCriticality) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code AllergyIntoleranceCriticality) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AllergyIntoleranceCriticality) protoNumber() uint64 {
+	switch code {
+	case AllergyIntoleranceCriticalityLow:
+		return 1
+	case AllergyIntoleranceCriticalityHigh:
+		return 2
+	case AllergyIntoleranceCriticalityUnableToAssess:
+		return 3
+	}
+	return 0
+}
+func (code *AllergyIntoleranceCriticality) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AllergyIntoleranceCriticalityLow
+	case 2:
+		*code = AllergyIntoleranceCriticalityHigh
+	case 3:
+		*code = AllergyIntoleranceCriticalityUnableToAssess
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code AllergyIntoleranceSeverity) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AllergyIntoleranceSeverity) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AllergyIntoleranceSeverity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "mild":
 		*code = AllergyIntoleranceSeverityMild
@@ -49,6 +53,12 @@ func (code *AllergyIntoleranceSeverity) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AllergyIntoleranceSeverity) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AllergyIntoleranceSeverity) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AllergyIntoleranceSeverity) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code AllergyIntoleranceSeverity) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AllergyIntoleranceSeverity) protoNumber() uint64 {
+	switch code {
+	case AllergyIntoleranceSeverityMild:
+		return 1
+	case AllergyIntoleranceSeverityModerate:
+		return 2
+	case AllergyIntoleranceSeveritySevere:
+		return 3
+	}
+	return 0
+}
+func (code *AllergyIntoleranceSeverity) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AllergyIntoleranceSeverityMild
+	case 2:
+		*code = AllergyIntoleranceSeverityModerate
+	case 3:
+		*code = AllergyIntoleranceSeveritySevere
+	default:
+		return false
+	}
+	return true
+}
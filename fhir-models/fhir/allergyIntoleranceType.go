@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code AllergyIntoleranceType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AllergyIntoleranceType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AllergyIntoleranceType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "allergy":
 		*code = AllergyIntoleranceTypeAllergy
@@ -46,6 +50,12 @@ func (code *AllergyIntoleranceType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AllergyIntoleranceType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AllergyIntoleranceType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AllergyIntoleranceType) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code AllergyIntoleranceType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AllergyIntoleranceType) protoNumber() uint64 {
+	switch code {
+	case AllergyIntoleranceTypeAllergy:
+		return 1
+	case AllergyIntoleranceTypeIntolerance:
+		return 2
+	}
+	return 0
+}
+func (code *AllergyIntoleranceType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AllergyIntoleranceTypeAllergy
+	case 2:
+		*code = AllergyIntoleranceTypeIntolerance
+	default:
+		return false
+	}
+	return true
+}
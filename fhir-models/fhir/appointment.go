@@ -14,42 +14,58 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Appointment is documented here http://hl7.org/fhir/StructureDefinition/Appointment
 type Appointment struct {
-	Id                    *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status                AppointmentStatus        `bson:"status" json:"status"`
-	CancelationReason     *CodeableConcept         `bson:"cancelationReason,omitempty" json:"cancelationReason,omitempty"`
-	ServiceCategory       []CodeableConcept        `bson:"serviceCategory,omitempty" json:"serviceCategory,omitempty"`
-	ServiceType           []CodeableConcept        `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
-	Specialty             []CodeableConcept        `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	AppointmentType       *CodeableConcept         `bson:"appointmentType,omitempty" json:"appointmentType,omitempty"`
-	ReasonCode            []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Priority              *int                     `bson:"priority,omitempty" json:"priority,omitempty"`
-	Description           *string                  `bson:"description,omitempty" json:"description,omitempty"`
-	SupportingInformation []Reference              `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
-	Start                 *string                  `bson:"start,omitempty" json:"start,omitempty"`
-	End                   *string                  `bson:"end,omitempty" json:"end,omitempty"`
-	MinutesDuration       *int                     `bson:"minutesDuration,omitempty" json:"minutesDuration,omitempty"`
-	Slot                  []Reference              `bson:"slot,omitempty" json:"slot,omitempty"`
-	Created               *string                  `bson:"created,omitempty" json:"created,omitempty"`
-	Comment               *string                  `bson:"comment,omitempty" json:"comment,omitempty"`
-	PatientInstruction    *string                  `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
-	BasedOn               []Reference              `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Participant           []AppointmentParticipant `bson:"participant" json:"participant"`
-	RequestedPeriod       []Period                 `bson:"requestedPeriod,omitempty" json:"requestedPeriod,omitempty"`
+	Id                        *string                  `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element                 `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                      *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                 `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                  `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                 `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage        `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                    AppointmentStatus        `bson:"status" json:"status"`
+	StatusElement             *Element                 `bson:"_status,omitempty" json:"_status,omitempty"`
+	CancelationReason         *CodeableConcept         `bson:"cancelationReason,omitempty" json:"cancelationReason,omitempty"`
+	ServiceCategory           []CodeableConcept        `bson:"serviceCategory,omitempty" json:"serviceCategory,omitempty"`
+	ServiceType               []CodeableConcept        `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
+	Specialty                 []CodeableConcept        `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	AppointmentType           *CodeableConcept         `bson:"appointmentType,omitempty" json:"appointmentType,omitempty"`
+	ReasonCode                []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Priority                  *int                     `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement           *Element                 `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Description               *string                  `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement        *Element                 `bson:"_description,omitempty" json:"_description,omitempty"`
+	SupportingInformation     []Reference              `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	Start                     *string                  `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement              *Element                 `bson:"_start,omitempty" json:"_start,omitempty"`
+	End                       *string                  `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement                *Element                 `bson:"_end,omitempty" json:"_end,omitempty"`
+	MinutesDuration           *int                     `bson:"minutesDuration,omitempty" json:"minutesDuration,omitempty"`
+	MinutesDurationElement    *Element                 `bson:"_minutesDuration,omitempty" json:"_minutesDuration,omitempty"`
+	Slot                      []Reference              `bson:"slot,omitempty" json:"slot,omitempty"`
+	Created                   *string                  `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement            *Element                 `bson:"_created,omitempty" json:"_created,omitempty"`
+	Comment                   *string                  `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement            *Element                 `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	PatientInstruction        *string                  `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
+	PatientInstructionElement *Element                 `bson:"_patientInstruction,omitempty" json:"_patientInstruction,omitempty"`
+	BasedOn                   []Reference              `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Participant               []AppointmentParticipant `bson:"participant" json:"participant"`
+	RequestedPeriod           []Period                 `bson:"requestedPeriod,omitempty" json:"requestedPeriod,omitempty"`
 }
 type AppointmentParticipant struct {
 	Id                *string              `bson:"id,omitempty" json:"id,omitempty"`
@@ -58,26 +74,2466 @@ type AppointmentParticipant struct {
 	Type              []CodeableConcept    `bson:"type,omitempty" json:"type,omitempty"`
 	Actor             *Reference           `bson:"actor,omitempty" json:"actor,omitempty"`
 	Required          *ParticipantRequired `bson:"required,omitempty" json:"required,omitempty"`
+	RequiredElement   *Element             `bson:"_required,omitempty" json:"_required,omitempty"`
 	Status            ParticipationStatus  `bson:"status" json:"status"`
+	StatusElement     *Element             `bson:"_status,omitempty" json:"_status,omitempty"`
 	Period            *Period              `bson:"period,omitempty" json:"period,omitempty"`
 }
-type OtherAppointment Appointment
 
 // MarshalJSON marshals the given Appointment as JSON into a byte slice
 func (r Appointment) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherAppointment
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherAppointment: OtherAppointment(r),
-		ResourceType:     "Appointment",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Appointment as JSON object with the members in the order of the fields
+func (r Appointment) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Appointment\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.CancelationReason != nil {
+		e.key("cancelationReason")
+		r.CancelationReason.appendJSON(e)
+	}
+	if len(r.ServiceCategory) > 0 {
+		e.key("serviceCategory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ServiceCategory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ServiceType) > 0 {
+		e.key("serviceType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ServiceType {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Specialty) > 0 {
+		e.key("specialty")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Specialty {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AppointmentType != nil {
+		e.key("appointmentType")
+		r.AppointmentType.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.int(*r.Priority)
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.SupportingInformation) > 0 {
+		e.key("supportingInformation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInformation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Start != nil {
+		e.key("start")
+		e.string(*r.Start)
+	}
+	if r.StartElement != nil {
+		e.key("_start")
+		r.StartElement.appendJSON(e)
+	}
+	if r.End != nil {
+		e.key("end")
+		e.string(*r.End)
+	}
+	if r.EndElement != nil {
+		e.key("_end")
+		r.EndElement.appendJSON(e)
+	}
+	if r.MinutesDuration != nil {
+		e.key("minutesDuration")
+		e.int(*r.MinutesDuration)
+	}
+	if r.MinutesDurationElement != nil {
+		e.key("_minutesDuration")
+		r.MinutesDurationElement.appendJSON(e)
+	}
+	if len(r.Slot) > 0 {
+		e.key("slot")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Slot {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Created != nil {
+		e.key("created")
+		e.string(*r.Created)
+	}
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	if r.PatientInstruction != nil {
+		e.key("patientInstruction")
+		e.string(*r.PatientInstruction)
+	}
+	if r.PatientInstructionElement != nil {
+		e.key("_patientInstruction")
+		r.PatientInstructionElement.appendJSON(e)
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("participant")
+	if r.Participant == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Participant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RequestedPeriod) > 0 {
+		e.key("requestedPeriod")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RequestedPeriod {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Appointment from JSON. Unknown members are ignored.
+func (r *Appointment) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Appointment
+func (r *Appointment) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "cancelationReason":
+			if d.null() {
+				r.CancelationReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CancelationReason = &v
+			}
+		case "serviceCategory":
+			a := d.array()
+			r.ServiceCategory = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ServiceCategory = append(r.ServiceCategory, v)
+			}
+			if r.ServiceCategory == nil && !a.isNull {
+				r.ServiceCategory = []CodeableConcept{}
+			}
+		case "serviceType":
+			a := d.array()
+			r.ServiceType = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ServiceType = append(r.ServiceType, v)
+			}
+			if r.ServiceType == nil && !a.isNull {
+				r.ServiceType = []CodeableConcept{}
+			}
+		case "specialty":
+			a := d.array()
+			r.Specialty = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Specialty = append(r.Specialty, v)
+			}
+			if r.Specialty == nil && !a.isNull {
+				r.Specialty = []CodeableConcept{}
+			}
+		case "appointmentType":
+			if d.null() {
+				r.AppointmentType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AppointmentType = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "priority":
+			if v, ok := d.int(); ok {
+				r.Priority = &v
+			} else {
+				r.Priority = nil
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "supportingInformation":
+			a := d.array()
+			r.SupportingInformation = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			}
+			if r.SupportingInformation == nil && !a.isNull {
+				r.SupportingInformation = []Reference{}
+			}
+		case "start":
+			if v, ok := d.string(); ok {
+				r.Start = &v
+			} else {
+				r.Start = nil
+			}
+		case "_start":
+			r.StartElement = d.element()
+		case "end":
+			if v, ok := d.string(); ok {
+				r.End = &v
+			} else {
+				r.End = nil
+			}
+		case "_end":
+			r.EndElement = d.element()
+		case "minutesDuration":
+			if v, ok := d.int(); ok {
+				r.MinutesDuration = &v
+			} else {
+				r.MinutesDuration = nil
+			}
+		case "_minutesDuration":
+			r.MinutesDurationElement = d.element()
+		case "slot":
+			a := d.array()
+			r.Slot = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Slot = append(r.Slot, v)
+			}
+			if r.Slot == nil && !a.isNull {
+				r.Slot = []Reference{}
+			}
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = &v
+			} else {
+				r.Created = nil
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		case "patientInstruction":
+			if v, ok := d.string(); ok {
+				r.PatientInstruction = &v
+			} else {
+				r.PatientInstruction = nil
+			}
+		case "_patientInstruction":
+			r.PatientInstructionElement = d.element()
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "participant":
+			a := d.array()
+			r.Participant = nil
+			for a.next() {
+				var v AppointmentParticipant
+				v.decodeJSON(d)
+				r.Participant = append(r.Participant, v)
+			}
+			if r.Participant == nil && !a.isNull {
+				r.Participant = []AppointmentParticipant{}
+			}
+		case "requestedPeriod":
+			a := d.array()
+			r.RequestedPeriod = nil
+			for a.next() {
+				var v Period
+				v.decodeJSON(d)
+				r.RequestedPeriod = append(r.RequestedPeriod, v)
+			}
+			if r.RequestedPeriod == nil && !a.isNull {
+				r.RequestedPeriod = []Period{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Appointment which shares no memory with the original
+func (r Appointment) DeepCopy() Appointment {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.CancelationReason != nil {
+		v := r.CancelationReason.DeepCopy()
+		out.CancelationReason = &v
+	}
+	if r.ServiceCategory != nil {
+		out.ServiceCategory = make([]CodeableConcept, len(r.ServiceCategory))
+		for i := range r.ServiceCategory {
+			out.ServiceCategory[i] = r.ServiceCategory[i].DeepCopy()
+		}
+	}
+	if r.ServiceType != nil {
+		out.ServiceType = make([]CodeableConcept, len(r.ServiceType))
+		for i := range r.ServiceType {
+			out.ServiceType[i] = r.ServiceType[i].DeepCopy()
+		}
+	}
+	if r.Specialty != nil {
+		out.Specialty = make([]CodeableConcept, len(r.Specialty))
+		for i := range r.Specialty {
+			out.Specialty[i] = r.Specialty[i].DeepCopy()
+		}
+	}
+	if r.AppointmentType != nil {
+		v := r.AppointmentType.DeepCopy()
+		out.AppointmentType = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.SupportingInformation != nil {
+		out.SupportingInformation = make([]Reference, len(r.SupportingInformation))
+		for i := range r.SupportingInformation {
+			out.SupportingInformation[i] = r.SupportingInformation[i].DeepCopy()
+		}
+	}
+	if r.Start != nil {
+		v := *r.Start
+		out.Start = &v
+	}
+	if r.StartElement != nil {
+		v := r.StartElement.DeepCopy()
+		out.StartElement = &v
+	}
+	if r.End != nil {
+		v := *r.End
+		out.End = &v
+	}
+	if r.EndElement != nil {
+		v := r.EndElement.DeepCopy()
+		out.EndElement = &v
+	}
+	if r.MinutesDuration != nil {
+		v := *r.MinutesDuration
+		out.MinutesDuration = &v
+	}
+	if r.MinutesDurationElement != nil {
+		v := r.MinutesDurationElement.DeepCopy()
+		out.MinutesDurationElement = &v
+	}
+	if r.Slot != nil {
+		out.Slot = make([]Reference, len(r.Slot))
+		for i := range r.Slot {
+			out.Slot[i] = r.Slot[i].DeepCopy()
+		}
+	}
+	if r.Created != nil {
+		v := *r.Created
+		out.Created = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	if r.PatientInstruction != nil {
+		v := *r.PatientInstruction
+		out.PatientInstruction = &v
+	}
+	if r.PatientInstructionElement != nil {
+		v := r.PatientInstructionElement.DeepCopy()
+		out.PatientInstructionElement = &v
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.Participant != nil {
+		out.Participant = make([]AppointmentParticipant, len(r.Participant))
+		for i := range r.Participant {
+			out.Participant[i] = r.Participant[i].DeepCopy()
+		}
+	}
+	if r.RequestedPeriod != nil {
+		out.RequestedPeriod = make([]Period, len(r.RequestedPeriod))
+		for i := range r.RequestedPeriod {
+			out.RequestedPeriod[i] = r.RequestedPeriod[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Appointment) Equal(other Appointment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.CancelationReason == nil) != (other.CancelationReason == nil) || r.CancelationReason != nil && !r.CancelationReason.Equal(*other.CancelationReason) {
+		return false
+	}
+	if len(r.ServiceCategory) != len(other.ServiceCategory) {
+		return false
+	}
+	for i := range r.ServiceCategory {
+		if !r.ServiceCategory[i].Equal(other.ServiceCategory[i]) {
+			return false
+		}
+	}
+	if len(r.ServiceType) != len(other.ServiceType) {
+		return false
+	}
+	for i := range r.ServiceType {
+		if !r.ServiceType[i].Equal(other.ServiceType[i]) {
+			return false
+		}
+	}
+	if len(r.Specialty) != len(other.Specialty) {
+		return false
+	}
+	for i := range r.Specialty {
+		if !r.Specialty[i].Equal(other.Specialty[i]) {
+			return false
+		}
+	}
+	if (r.AppointmentType == nil) != (other.AppointmentType == nil) || r.AppointmentType != nil && !r.AppointmentType.Equal(*other.AppointmentType) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.SupportingInformation) != len(other.SupportingInformation) {
+		return false
+	}
+	for i := range r.SupportingInformation {
+		if !r.SupportingInformation[i].Equal(other.SupportingInformation[i]) {
+			return false
+		}
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.Equal(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.Equal(*other.EndElement) {
+		return false
+	}
+	if (r.MinutesDuration == nil) != (other.MinutesDuration == nil) || r.MinutesDuration != nil && *r.MinutesDuration != *other.MinutesDuration {
+		return false
+	}
+	if (r.MinutesDurationElement == nil) != (other.MinutesDurationElement == nil) || r.MinutesDurationElement != nil && !r.MinutesDurationElement.Equal(*other.MinutesDurationElement) {
+		return false
+	}
+	if len(r.Slot) != len(other.Slot) {
+		return false
+	}
+	for i := range r.Slot {
+		if !r.Slot[i].Equal(other.Slot[i]) {
+			return false
+		}
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && *r.Created != *other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	if (r.PatientInstruction == nil) != (other.PatientInstruction == nil) || r.PatientInstruction != nil && *r.PatientInstruction != *other.PatientInstruction {
+		return false
+	}
+	if (r.PatientInstructionElement == nil) != (other.PatientInstructionElement == nil) || r.PatientInstructionElement != nil && !r.PatientInstructionElement.Equal(*other.PatientInstructionElement) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.Participant) != len(other.Participant) {
+		return false
+	}
+	for i := range r.Participant {
+		if !r.Participant[i].Equal(other.Participant[i]) {
+			return false
+		}
+	}
+	if len(r.RequestedPeriod) != len(other.RequestedPeriod) {
+		return false
+	}
+	for i := range r.RequestedPeriod {
+		if !r.RequestedPeriod[i].Equal(other.RequestedPeriod[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Appointment) EqualsDeep(other Appointment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.CancelationReason == nil) != (other.CancelationReason == nil) || r.CancelationReason != nil && !r.CancelationReason.EqualsDeep(*other.CancelationReason) {
+		return false
+	}
+	if !equivalentList(len(r.ServiceCategory), len(other.ServiceCategory), func(i, j int) bool {
+		return r.ServiceCategory[i].EqualsDeep(other.ServiceCategory[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ServiceType), len(other.ServiceType), func(i, j int) bool {
+		return r.ServiceType[i].EqualsDeep(other.ServiceType[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Specialty), len(other.Specialty), func(i, j int) bool {
+		return r.Specialty[i].EqualsDeep(other.Specialty[j])
+	}) {
+		return false
+	}
+	if (r.AppointmentType == nil) != (other.AppointmentType == nil) || r.AppointmentType != nil && !r.AppointmentType.EqualsDeep(*other.AppointmentType) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInformation), len(other.SupportingInformation), func(i, j int) bool {
+		return r.SupportingInformation[i].EqualsDeep(other.SupportingInformation[j])
+	}) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && !equivalentString(*r.Start, *other.Start) {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.EqualsDeep(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && !equivalentString(*r.End, *other.End) {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.EqualsDeep(*other.EndElement) {
+		return false
+	}
+	if (r.MinutesDuration == nil) != (other.MinutesDuration == nil) || r.MinutesDuration != nil && *r.MinutesDuration != *other.MinutesDuration {
+		return false
+	}
+	if (r.MinutesDurationElement == nil) != (other.MinutesDurationElement == nil) || r.MinutesDurationElement != nil && !r.MinutesDurationElement.EqualsDeep(*other.MinutesDurationElement) {
+		return false
+	}
+	if !equivalentList(len(r.Slot), len(other.Slot), func(i, j int) bool {
+		return r.Slot[i].EqualsDeep(other.Slot[j])
+	}) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	if (r.PatientInstruction == nil) != (other.PatientInstruction == nil) || r.PatientInstruction != nil && !equivalentString(*r.PatientInstruction, *other.PatientInstruction) {
+		return false
+	}
+	if (r.PatientInstructionElement == nil) != (other.PatientInstructionElement == nil) || r.PatientInstructionElement != nil && !r.PatientInstructionElement.EqualsDeep(*other.PatientInstructionElement) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Participant), len(other.Participant), func(i, j int) bool {
+		return r.Participant[i].EqualsDeep(other.Participant[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RequestedPeriod), len(other.RequestedPeriod), func(i, j int) bool {
+		return r.RequestedPeriod[i].EqualsDeep(other.RequestedPeriod[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Appointment) EqualsShallow(other Appointment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && !equivalentString(*r.Start, *other.Start) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && !equivalentString(*r.End, *other.End) {
+		return false
+	}
+	if (r.MinutesDuration == nil) != (other.MinutesDuration == nil) || r.MinutesDuration != nil && *r.MinutesDuration != *other.MinutesDuration {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.PatientInstruction == nil) != (other.PatientInstruction == nil) || r.PatientInstruction != nil && !equivalentString(*r.PatientInstruction, *other.PatientInstruction) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Appointment as FHIR XML
+func (r Appointment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Appointment",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "cancelationReason", r.CancelationReason); err != nil {
+		return err
+	}
+	for _, v := range r.ServiceCategory {
+		if err := encodeXMLElement(e, "serviceCategory", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ServiceType {
+		if err := encodeXMLElement(e, "serviceType", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Specialty {
+		if err := encodeXMLElement(e, "specialty", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "appointmentType", r.AppointmentType); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.SupportingInformation {
+		if err := encodeXMLElement(e, "supportingInformation", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "start", r.Start, r.StartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "end", r.End, r.EndElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minutesDuration", r.MinutesDuration, r.MinutesDurationElement); err != nil {
+		return err
+	}
+	for _, v := range r.Slot {
+		if err := encodeXMLElement(e, "slot", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patientInstruction", r.PatientInstruction, r.PatientInstructionElement); err != nil {
+		return err
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Participant {
+		if err := encodeXMLElement(e, "participant", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RequestedPeriod {
+		if err := encodeXMLElement(e, "requestedPeriod", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Appointment from FHIR XML
+func (r *Appointment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v AppointmentStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "cancelationReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CancelationReason = &v
+			case "serviceCategory":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServiceCategory = append(r.ServiceCategory, v)
+			case "serviceType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServiceType = append(r.ServiceType, v)
+			case "specialty":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specialty = append(r.Specialty, v)
+			case "appointmentType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AppointmentType = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "priority":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "supportingInformation":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			case "start":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Start = &v
+				}
+				r.StartElement = element
+			case "end":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.End = &v
+				}
+				r.EndElement = element
+			case "minutesDuration":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinutesDuration = &v
+				}
+				r.MinutesDurationElement = element
+			case "slot":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Slot = append(r.Slot, v)
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = &v
+				}
+				r.CreatedElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			case "patientInstruction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatientInstruction = &v
+				}
+				r.PatientInstructionElement = element
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "participant":
+				var v AppointmentParticipant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Participant = append(r.Participant, v)
+			case "requestedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RequestedPeriod = append(r.RequestedPeriod, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Appointment as properties to the RDF node n
+func (r Appointment) turtle(n *turtleNode) {
+	n.resource("Appointment", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Appointment.identifier", i, v)
+	}
+	n.primitive("Appointment.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Appointment.cancelationReason", -1, r.CancelationReason)
+	for i, v := range r.ServiceCategory {
+		n.element("Appointment.serviceCategory", i, v)
+	}
+	for i, v := range r.ServiceType {
+		n.element("Appointment.serviceType", i, v)
+	}
+	for i, v := range r.Specialty {
+		n.element("Appointment.specialty", i, v)
+	}
+	n.element("Appointment.appointmentType", -1, r.AppointmentType)
+	for i, v := range r.ReasonCode {
+		n.element("Appointment.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("Appointment.reasonReference", i, v)
+	}
+	n.primitive("Appointment.priority", -1, r.Priority, r.PriorityElement, "unsignedInt")
+	n.primitive("Appointment.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.SupportingInformation {
+		n.element("Appointment.supportingInformation", i, v)
+	}
+	n.primitive("Appointment.start", -1, r.Start, r.StartElement, "instant")
+	n.primitive("Appointment.end", -1, r.End, r.EndElement, "instant")
+	n.primitive("Appointment.minutesDuration", -1, r.MinutesDuration, r.MinutesDurationElement, "positiveInt")
+	for i, v := range r.Slot {
+		n.element("Appointment.slot", i, v)
+	}
+	n.primitive("Appointment.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.primitive("Appointment.comment", -1, r.Comment, r.CommentElement, "string")
+	n.primitive("Appointment.patientInstruction", -1, r.PatientInstruction, r.PatientInstructionElement, "string")
+	for i, v := range r.BasedOn {
+		n.element("Appointment.basedOn", i, v)
+	}
+	for i, v := range r.Participant {
+		n.element("Appointment.participant", i, v)
+	}
+	for i, v := range r.RequestedPeriod {
+		n.element("Appointment.requestedPeriod", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Appointment as BSON document with the structure of its FHIR JSON
+func (r Appointment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Appointment from a BSON document with the structure of its FHIR JSON
+func (r *Appointment) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Appointment as protobuf message Appointment of fhir.proto
+func (r Appointment) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Appointment to the encoded message
+func (r Appointment) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.CancelationReason != nil {
+		e.message(11, *r.CancelationReason)
+	}
+	for _, v := range r.ServiceCategory {
+		e.message(12, v)
+	}
+	for _, v := range r.ServiceType {
+		e.message(13, v)
+	}
+	for _, v := range r.Specialty {
+		e.message(14, v)
+	}
+	if r.AppointmentType != nil {
+		e.message(15, *r.AppointmentType)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(16, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(17, v)
+	}
+	if r.Priority != nil {
+		e.varint(18, uint64(int64(*r.Priority)))
+	}
+	if r.Description != nil {
+		e.string(19, *r.Description)
+	}
+	for _, v := range r.SupportingInformation {
+		e.message(20, v)
+	}
+	if r.Start != nil {
+		e.string(21, *r.Start)
+	}
+	if r.End != nil {
+		e.string(22, *r.End)
+	}
+	if r.MinutesDuration != nil {
+		e.varint(23, uint64(int64(*r.MinutesDuration)))
+	}
+	for _, v := range r.Slot {
+		e.message(24, v)
+	}
+	if r.Created != nil {
+		e.string(25, *r.Created)
+	}
+	if r.Comment != nil {
+		e.string(26, *r.Comment)
+	}
+	if r.PatientInstruction != nil {
+		e.string(27, *r.PatientInstruction)
+	}
+	for _, v := range r.BasedOn {
+		e.message(28, v)
+	}
+	for _, v := range r.Participant {
+		e.message(29, v)
+	}
+	for _, v := range r.RequestedPeriod {
+		e.message(30, v)
+	}
+	if r.IdElement != nil {
+		e.message(31, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(32, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(33, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(34, *r.StatusElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(35, *r.PriorityElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(36, *r.DescriptionElement)
+	}
+	if r.StartElement != nil {
+		e.message(37, *r.StartElement)
+	}
+	if r.EndElement != nil {
+		e.message(38, *r.EndElement)
+	}
+	if r.MinutesDurationElement != nil {
+		e.message(39, *r.MinutesDurationElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(40, *r.CreatedElement)
+	}
+	if r.CommentElement != nil {
+		e.message(41, *r.CommentElement)
+	}
+	if r.PatientInstructionElement != nil {
+		e.message(42, *r.PatientInstructionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Appointment from protobuf message Appointment of fhir.proto
+func (r *Appointment) UnmarshalProto(b []byte) error {
+	*r = Appointment{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v AppointmentStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.CancelationReason = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.ServiceCategory = append(r.ServiceCategory, v)
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.ServiceType = append(r.ServiceType, v)
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Specialty = append(r.Specialty, v)
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.AppointmentType = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 18:
+			v := d.int()
+			r.Priority = &v
+		case 19:
+			v := d.string()
+			r.Description = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.SupportingInformation = append(r.SupportingInformation, v)
+		case 21:
+			v := d.string()
+			r.Start = &v
+		case 22:
+			v := d.string()
+			r.End = &v
+		case 23:
+			v := d.int()
+			r.MinutesDuration = &v
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Slot = append(r.Slot, v)
+		case 25:
+			v := d.string()
+			r.Created = &v
+		case 26:
+			v := d.string()
+			r.Comment = &v
+		case 27:
+			v := d.string()
+			r.PatientInstruction = &v
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 29:
+			var v AppointmentParticipant
+			d.message(&v)
+			r.Participant = append(r.Participant, v)
+		case 30:
+			var v Period
+			d.message(&v)
+			r.RequestedPeriod = append(r.RequestedPeriod, v)
+		case 31:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.StartElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.EndElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.MinutesDurationElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.PatientInstructionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AppointmentParticipant as JSON into a byte slice
+func (r AppointmentParticipant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AppointmentParticipant as JSON object with the members in the order of the fields
+func (r AppointmentParticipant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Actor != nil {
+		e.key("actor")
+		r.Actor.appendJSON(e)
+	}
+	if r.Required != nil {
+		e.key("required")
+		e.string(r.Required.Code())
+	}
+	if r.RequiredElement != nil {
+		e.key("_required")
+		r.RequiredElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AppointmentParticipant from JSON. Unknown members are ignored.
+func (r *AppointmentParticipant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AppointmentParticipant
+func (r *AppointmentParticipant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "actor":
+			if d.null() {
+				r.Actor = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Actor = &v
+			}
+		case "required":
+			if d.null() {
+				r.Required = nil
+			} else {
+				var v ParticipantRequired
+				d.unmarshal(&v)
+				r.Required = &v
+			}
+		case "_required":
+			r.RequiredElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AppointmentParticipant which shares no memory with the original
+func (r AppointmentParticipant) DeepCopy() AppointmentParticipant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.Actor != nil {
+		v := r.Actor.DeepCopy()
+		out.Actor = &v
+	}
+	if r.Required != nil {
+		v := *r.Required
+		out.Required = &v
+	}
+	if r.RequiredElement != nil {
+		v := r.RequiredElement.DeepCopy()
+		out.RequiredElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AppointmentParticipant) Equal(other AppointmentParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if (r.Actor == nil) != (other.Actor == nil) || r.Actor != nil && !r.Actor.Equal(*other.Actor) {
+		return false
+	}
+	if (r.Required == nil) != (other.Required == nil) || r.Required != nil && *r.Required != *other.Required {
+		return false
+	}
+	if (r.RequiredElement == nil) != (other.RequiredElement == nil) || r.RequiredElement != nil && !r.RequiredElement.Equal(*other.RequiredElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AppointmentParticipant) EqualsDeep(other AppointmentParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if (r.Actor == nil) != (other.Actor == nil) || r.Actor != nil && !r.Actor.EqualsDeep(*other.Actor) {
+		return false
+	}
+	if (r.Required == nil) != (other.Required == nil) || r.Required != nil && *r.Required != *other.Required {
+		return false
+	}
+	if (r.RequiredElement == nil) != (other.RequiredElement == nil) || r.RequiredElement != nil && !r.RequiredElement.EqualsDeep(*other.RequiredElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AppointmentParticipant) EqualsShallow(other AppointmentParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Required == nil) != (other.Required == nil) || r.Required != nil && *r.Required != *other.Required {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AppointmentParticipant as FHIR XML
+func (r AppointmentParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "actor", r.Actor); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "required", r.Required, r.RequiredElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AppointmentParticipant from FHIR XML
+func (r *AppointmentParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "actor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = &v
+			case "required":
+				var v ParticipantRequired
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Required = &v
+				}
+				r.RequiredElement = element
+			case "status":
+				var v ParticipationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AppointmentParticipant as properties to the RDF node n
+func (r AppointmentParticipant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Type {
+		n.element("Appointment.participant.type", i, v)
+	}
+	n.element("Appointment.participant.actor", -1, r.Actor)
+	n.primitive("Appointment.participant.required", -1, r.Required, r.RequiredElement, "code")
+	n.primitive("Appointment.participant.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Appointment.participant.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given AppointmentParticipant as BSON document with the structure of its FHIR JSON
+func (r AppointmentParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AppointmentParticipant from a BSON document with the structure of its FHIR JSON
+func (r *AppointmentParticipant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AppointmentParticipant as protobuf message AppointmentParticipant of fhir.proto
+func (r AppointmentParticipant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AppointmentParticipant to the encoded message
+func (r AppointmentParticipant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Type {
+		e.message(4, v)
+	}
+	if r.Actor != nil {
+		e.message(5, *r.Actor)
+	}
+	if r.Required != nil {
+		e.varint(6, r.Required.protoNumber())
+	}
+	e.varint(7, r.Status.protoNumber())
+	if r.Period != nil {
+		e.message(8, *r.Period)
+	}
+	if r.RequiredElement != nil {
+		e.message(9, *r.RequiredElement)
+	}
+	if r.StatusElement != nil {
+		e.message(10, *r.StatusElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AppointmentParticipant from protobuf message AppointmentParticipant of fhir.proto
+func (r *AppointmentParticipant) UnmarshalProto(b []byte) error {
+	*r = AppointmentParticipant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Actor = &v
+		case 6:
+			var v ParticipantRequired
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Required = &v
+		case 7:
+			var v ParticipationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 8:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.RequiredElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalAppointment unmarshals a Appointment.
 func UnmarshalAppointment(b []byte) (Appointment, error) {
 	var appointment Appointment
-	if err := json.Unmarshal(b, &appointment); err != nil {
+	if err := appointment.UnmarshalJSON(b); err != nil {
 		return appointment, err
 	}
 	return appointment, nil
@@ -14,46 +14,1063 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // AppointmentResponse is documented here http://hl7.org/fhir/StructureDefinition/AppointmentResponse
 type AppointmentResponse struct {
-	Id                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Appointment       Reference           `bson:"appointment" json:"appointment"`
-	Start             *string             `bson:"start,omitempty" json:"start,omitempty"`
-	End               *string             `bson:"end,omitempty" json:"end,omitempty"`
-	ParticipantType   []CodeableConcept   `bson:"participantType,omitempty" json:"participantType,omitempty"`
-	Actor             *Reference          `bson:"actor,omitempty" json:"actor,omitempty"`
-	ParticipantStatus ParticipationStatus `bson:"participantStatus" json:"participantStatus"`
-	Comment           *string             `bson:"comment,omitempty" json:"comment,omitempty"`
-}
-type OtherAppointmentResponse AppointmentResponse
+	Id                       *string             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                *Element            `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                     *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                []json.RawMessage   `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Appointment              Reference           `bson:"appointment" json:"appointment"`
+	Start                    *string             `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement             *Element            `bson:"_start,omitempty" json:"_start,omitempty"`
+	End                      *string             `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement               *Element            `bson:"_end,omitempty" json:"_end,omitempty"`
+	ParticipantType          []CodeableConcept   `bson:"participantType,omitempty" json:"participantType,omitempty"`
+	Actor                    *Reference          `bson:"actor,omitempty" json:"actor,omitempty"`
+	ParticipantStatus        ParticipationStatus `bson:"participantStatus" json:"participantStatus"`
+	ParticipantStatusElement *Element            `bson:"_participantStatus,omitempty" json:"_participantStatus,omitempty"`
+	Comment                  *string             `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement           *Element            `bson:"_comment,omitempty" json:"_comment,omitempty"`
+}
 
 // MarshalJSON marshals the given AppointmentResponse as JSON into a byte slice
 func (r AppointmentResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherAppointmentResponse
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherAppointmentResponse: OtherAppointmentResponse(r),
-		ResourceType:             "AppointmentResponse",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AppointmentResponse as JSON object with the members in the order of the fields
+func (r AppointmentResponse) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"AppointmentResponse\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("appointment")
+	r.Appointment.appendJSON(e)
+	if r.Start != nil {
+		e.key("start")
+		e.string(*r.Start)
+	}
+	if r.StartElement != nil {
+		e.key("_start")
+		r.StartElement.appendJSON(e)
+	}
+	if r.End != nil {
+		e.key("end")
+		e.string(*r.End)
+	}
+	if r.EndElement != nil {
+		e.key("_end")
+		r.EndElement.appendJSON(e)
+	}
+	if len(r.ParticipantType) > 0 {
+		e.key("participantType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ParticipantType {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Actor != nil {
+		e.key("actor")
+		r.Actor.appendJSON(e)
+	}
+	e.key("participantStatus")
+	e.string(r.ParticipantStatus.Code())
+	if r.ParticipantStatusElement != nil {
+		e.key("_participantStatus")
+		r.ParticipantStatusElement.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AppointmentResponse from JSON. Unknown members are ignored.
+func (r *AppointmentResponse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AppointmentResponse
+func (r *AppointmentResponse) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "appointment":
+			r.Appointment.decodeJSON(d)
+		case "start":
+			if v, ok := d.string(); ok {
+				r.Start = &v
+			} else {
+				r.Start = nil
+			}
+		case "_start":
+			r.StartElement = d.element()
+		case "end":
+			if v, ok := d.string(); ok {
+				r.End = &v
+			} else {
+				r.End = nil
+			}
+		case "_end":
+			r.EndElement = d.element()
+		case "participantType":
+			a := d.array()
+			r.ParticipantType = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ParticipantType = append(r.ParticipantType, v)
+			}
+			if r.ParticipantType == nil && !a.isNull {
+				r.ParticipantType = []CodeableConcept{}
+			}
+		case "actor":
+			if d.null() {
+				r.Actor = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Actor = &v
+			}
+		case "participantStatus":
+			d.unmarshal(&r.ParticipantStatus)
+		case "_participantStatus":
+			r.ParticipantStatusElement = d.element()
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AppointmentResponse which shares no memory with the original
+func (r AppointmentResponse) DeepCopy() AppointmentResponse {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	out.Appointment = r.Appointment.DeepCopy()
+	if r.Start != nil {
+		v := *r.Start
+		out.Start = &v
+	}
+	if r.StartElement != nil {
+		v := r.StartElement.DeepCopy()
+		out.StartElement = &v
+	}
+	if r.End != nil {
+		v := *r.End
+		out.End = &v
+	}
+	if r.EndElement != nil {
+		v := r.EndElement.DeepCopy()
+		out.EndElement = &v
+	}
+	if r.ParticipantType != nil {
+		out.ParticipantType = make([]CodeableConcept, len(r.ParticipantType))
+		for i := range r.ParticipantType {
+			out.ParticipantType[i] = r.ParticipantType[i].DeepCopy()
+		}
+	}
+	if r.Actor != nil {
+		v := r.Actor.DeepCopy()
+		out.Actor = &v
+	}
+	if r.ParticipantStatusElement != nil {
+		v := r.ParticipantStatusElement.DeepCopy()
+		out.ParticipantStatusElement = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AppointmentResponse) Equal(other AppointmentResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if !r.Appointment.Equal(other.Appointment) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.Equal(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.Equal(*other.EndElement) {
+		return false
+	}
+	if len(r.ParticipantType) != len(other.ParticipantType) {
+		return false
+	}
+	for i := range r.ParticipantType {
+		if !r.ParticipantType[i].Equal(other.ParticipantType[i]) {
+			return false
+		}
+	}
+	if (r.Actor == nil) != (other.Actor == nil) || r.Actor != nil && !r.Actor.Equal(*other.Actor) {
+		return false
+	}
+	if r.ParticipantStatus != other.ParticipantStatus {
+		return false
+	}
+	if (r.ParticipantStatusElement == nil) != (other.ParticipantStatusElement == nil) || r.ParticipantStatusElement != nil && !r.ParticipantStatusElement.Equal(*other.ParticipantStatusElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AppointmentResponse) EqualsDeep(other AppointmentResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !r.Appointment.EqualsDeep(other.Appointment) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && !equivalentString(*r.Start, *other.Start) {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.EqualsDeep(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && !equivalentString(*r.End, *other.End) {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.EqualsDeep(*other.EndElement) {
+		return false
+	}
+	if !equivalentList(len(r.ParticipantType), len(other.ParticipantType), func(i, j int) bool {
+		return r.ParticipantType[i].EqualsDeep(other.ParticipantType[j])
+	}) {
+		return false
+	}
+	if (r.Actor == nil) != (other.Actor == nil) || r.Actor != nil && !r.Actor.EqualsDeep(*other.Actor) {
+		return false
+	}
+	if r.ParticipantStatus != other.ParticipantStatus {
+		return false
+	}
+	if (r.ParticipantStatusElement == nil) != (other.ParticipantStatusElement == nil) || r.ParticipantStatusElement != nil && !r.ParticipantStatusElement.EqualsDeep(*other.ParticipantStatusElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AppointmentResponse) EqualsShallow(other AppointmentResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && !equivalentString(*r.Start, *other.Start) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && !equivalentString(*r.End, *other.End) {
+		return false
+	}
+	if r.ParticipantStatus != other.ParticipantStatus {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AppointmentResponse as FHIR XML
+func (r AppointmentResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "AppointmentResponse",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "appointment", r.Appointment); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "start", r.Start, r.StartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "end", r.End, r.EndElement); err != nil {
+		return err
+	}
+	for _, v := range r.ParticipantType {
+		if err := encodeXMLElement(e, "participantType", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "actor", r.Actor); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "participantStatus", r.ParticipantStatus, r.ParticipantStatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AppointmentResponse from FHIR XML
+func (r *AppointmentResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "appointment":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Appointment = v
+			case "start":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Start = &v
+				}
+				r.StartElement = element
+			case "end":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.End = &v
+				}
+				r.EndElement = element
+			case "participantType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParticipantType = append(r.ParticipantType, v)
+			case "actor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = &v
+			case "participantStatus":
+				var v ParticipationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ParticipantStatus = v
+				}
+				r.ParticipantStatusElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AppointmentResponse as properties to the RDF node n
+func (r AppointmentResponse) turtle(n *turtleNode) {
+	n.resource("AppointmentResponse", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("AppointmentResponse.identifier", i, v)
+	}
+	n.element("AppointmentResponse.appointment", -1, r.Appointment)
+	n.primitive("AppointmentResponse.start", -1, r.Start, r.StartElement, "instant")
+	n.primitive("AppointmentResponse.end", -1, r.End, r.EndElement, "instant")
+	for i, v := range r.ParticipantType {
+		n.element("AppointmentResponse.participantType", i, v)
+	}
+	n.element("AppointmentResponse.actor", -1, r.Actor)
+	n.primitive("AppointmentResponse.participantStatus", -1, r.ParticipantStatus, r.ParticipantStatusElement, "code")
+	n.primitive("AppointmentResponse.comment", -1, r.Comment, r.CommentElement, "string")
+}
+
+// MarshalBSON marshals the given AppointmentResponse as BSON document with the structure of its FHIR JSON
+func (r AppointmentResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AppointmentResponse from a BSON document with the structure of its FHIR JSON
+func (r *AppointmentResponse) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AppointmentResponse as protobuf message AppointmentResponse of fhir.proto
+func (r AppointmentResponse) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AppointmentResponse to the encoded message
+func (r AppointmentResponse) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.message(10, r.Appointment)
+	if r.Start != nil {
+		e.string(11, *r.Start)
+	}
+	if r.End != nil {
+		e.string(12, *r.End)
+	}
+	for _, v := range r.ParticipantType {
+		e.message(13, v)
+	}
+	if r.Actor != nil {
+		e.message(14, *r.Actor)
+	}
+	e.varint(15, r.ParticipantStatus.protoNumber())
+	if r.Comment != nil {
+		e.string(16, *r.Comment)
+	}
+	if r.IdElement != nil {
+		e.message(17, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(18, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(19, *r.LanguageElement)
+	}
+	if r.StartElement != nil {
+		e.message(20, *r.StartElement)
+	}
+	if r.EndElement != nil {
+		e.message(21, *r.EndElement)
+	}
+	if r.ParticipantStatusElement != nil {
+		e.message(22, *r.ParticipantStatusElement)
+	}
+	if r.CommentElement != nil {
+		e.message(23, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AppointmentResponse from protobuf message AppointmentResponse of fhir.proto
+func (r *AppointmentResponse) UnmarshalProto(b []byte) error {
+	*r = AppointmentResponse{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Appointment = v
+		case 11:
+			v := d.string()
+			r.Start = &v
+		case 12:
+			v := d.string()
+			r.End = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.ParticipantType = append(r.ParticipantType, v)
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Actor = &v
+		case 15:
+			var v ParticipationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.ParticipantStatus = v
+		case 16:
+			v := d.string()
+			r.Comment = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.StartElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.EndElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ParticipantStatusElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalAppointmentResponse unmarshals a AppointmentResponse.
 func UnmarshalAppointmentResponse(b []byte) (AppointmentResponse, error) {
 	var appointmentResponse AppointmentResponse
-	if err := json.Unmarshal(b, &appointmentResponse); err != nil {
+	if err := appointmentResponse.UnmarshalJSON(b); err != nil {
 		return appointmentResponse, err
 	}
 	return appointmentResponse, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -42,8 +41,13 @@ const (
 func (code AppointmentStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AppointmentStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AppointmentStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "proposed":
 		*code = AppointmentStatusProposed
@@ -70,6 +74,12 @@ func (code *AppointmentStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AppointmentStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AppointmentStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AppointmentStatus) String() string {
 	return code.Code()
 }
@@ -148,3 +158,55 @@ func (code AppointmentStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AppointmentStatus) protoNumber() uint64 {
+	switch code {
+	case AppointmentStatusProposed:
+		return 1
+	case AppointmentStatusPending:
+		return 2
+	case AppointmentStatusBooked:
+		return 3
+	case AppointmentStatusArrived:
+		return 4
+	case AppointmentStatusFulfilled:
+		return 5
+	case AppointmentStatusCancelled:
+		return 6
+	case AppointmentStatusNoshow:
+		return 7
+	case AppointmentStatusEnteredInError:
+		return 8
+	case AppointmentStatusCheckedIn:
+		return 9
+	case AppointmentStatusWaitlist:
+		return 10
+	}
+	return 0
+}
+func (code *AppointmentStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AppointmentStatusProposed
+	case 2:
+		*code = AppointmentStatusPending
+	case 3:
+		*code = AppointmentStatusBooked
+	case 4:
+		*code = AppointmentStatusArrived
+	case 5:
+		*code = AppointmentStatusFulfilled
+	case 6:
+		*code = AppointmentStatusCancelled
+	case 7:
+		*code = AppointmentStatusNoshow
+	case 8:
+		*code = AppointmentStatusEnteredInError
+	case 9:
+		*code = AppointmentStatusCheckedIn
+	case 10:
+		*code = AppointmentStatusWaitlist
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code AssertionDirectionType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AssertionDirectionType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AssertionDirectionType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "response":
 		*code = AssertionDirectionTypeResponse
@@ -46,6 +50,12 @@ func (code *AssertionDirectionType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AssertionDirectionType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AssertionDirectionType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AssertionDirectionType) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code AssertionDirectionType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AssertionDirectionType) protoNumber() uint64 {
+	switch code {
+	case AssertionDirectionTypeResponse:
+		return 1
+	case AssertionDirectionTypeRequest:
+		return 2
+	}
+	return 0
+}
+func (code *AssertionDirectionType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AssertionDirectionTypeResponse
+	case 2:
+		*code = AssertionDirectionTypeRequest
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -43,8 +42,13 @@ const (
 func (code AssertionOperatorType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AssertionOperatorType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AssertionOperatorType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "equals":
 		*code = AssertionOperatorTypeEquals
@@ -73,6 +77,12 @@ func (code *AssertionOperatorType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AssertionOperatorType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AssertionOperatorType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AssertionOperatorType) String() string {
 	return code.Code()
 }
@@ -157,3 +167,59 @@ func (code AssertionOperatorType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AssertionOperatorType) protoNumber() uint64 {
+	switch code {
+	case AssertionOperatorTypeEquals:
+		return 1
+	case AssertionOperatorTypeNotEquals:
+		return 2
+	case AssertionOperatorTypeIn:
+		return 3
+	case AssertionOperatorTypeNotIn:
+		return 4
+	case AssertionOperatorTypeGreaterThan:
+		return 5
+	case AssertionOperatorTypeLessThan:
+		return 6
+	case AssertionOperatorTypeEmpty:
+		return 7
+	case AssertionOperatorTypeNotEmpty:
+		return 8
+	case AssertionOperatorTypeContains:
+		return 9
+	case AssertionOperatorTypeNotContains:
+		return 10
+	case AssertionOperatorTypeEval:
+		return 11
+	}
+	return 0
+}
+func (code *AssertionOperatorType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AssertionOperatorTypeEquals
+	case 2:
+		*code = AssertionOperatorTypeNotEquals
+	case 3:
+		*code = AssertionOperatorTypeIn
+	case 4:
+		*code = AssertionOperatorTypeNotIn
+	case 5:
+		*code = AssertionOperatorTypeGreaterThan
+	case 6:
+		*code = AssertionOperatorTypeLessThan
+	case 7:
+		*code = AssertionOperatorTypeEmpty
+	case 8:
+		*code = AssertionOperatorTypeNotEmpty
+	case 9:
+		*code = AssertionOperatorTypeContains
+	case 10:
+		*code = AssertionOperatorTypeNotContains
+	case 11:
+		*code = AssertionOperatorTypeEval
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -44,8 +43,13 @@ const (
 func (code AssertionResponseTypes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AssertionResponseTypes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AssertionResponseTypes) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "okay":
 		*code = AssertionResponseTypesOkay
@@ -76,6 +80,12 @@ func (code *AssertionResponseTypes) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AssertionResponseTypes) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AssertionResponseTypes) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AssertionResponseTypes) String() string {
 	return code.Code()
 }
@@ -166,3 +176,63 @@ func (code AssertionResponseTypes) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AssertionResponseTypes) protoNumber() uint64 {
+	switch code {
+	case AssertionResponseTypesOkay:
+		return 1
+	case AssertionResponseTypesCreated:
+		return 2
+	case AssertionResponseTypesNoContent:
+		return 3
+	case AssertionResponseTypesNotModified:
+		return 4
+	case AssertionResponseTypesBad:
+		return 5
+	case AssertionResponseTypesForbidden:
+		return 6
+	case AssertionResponseTypesNotFound:
+		return 7
+	case AssertionResponseTypesMethodNotAllowed:
+		return 8
+	case AssertionResponseTypesConflict:
+		return 9
+	case AssertionResponseTypesGone:
+		return 10
+	case AssertionResponseTypesPreconditionFailed:
+		return 11
+	case AssertionResponseTypesUnprocessable:
+		return 12
+	}
+	return 0
+}
+func (code *AssertionResponseTypes) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AssertionResponseTypesOkay
+	case 2:
+		*code = AssertionResponseTypesCreated
+	case 3:
+		*code = AssertionResponseTypesNoContent
+	case 4:
+		*code = AssertionResponseTypesNotModified
+	case 5:
+		*code = AssertionResponseTypesBad
+	case 6:
+		*code = AssertionResponseTypesForbidden
+	case 7:
+		*code = AssertionResponseTypesNotFound
+	case 8:
+		*code = AssertionResponseTypesMethodNotAllowed
+	case 9:
+		*code = AssertionResponseTypesConflict
+	case 10:
+		*code = AssertionResponseTypesGone
+	case 11:
+		*code = AssertionResponseTypesPreconditionFailed
+	case 12:
+		*code = AssertionResponseTypesUnprocessable
+	default:
+		return false
+	}
+	return true
+}
@@ -14,31 +14,42 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // AuditEvent is documented here http://hl7.org/fhir/StructureDefinition/AuditEvent
 type AuditEvent struct {
-	Id                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              Coding             `bson:"type" json:"type"`
-	Subtype           []Coding           `bson:"subtype,omitempty" json:"subtype,omitempty"`
-	Action            *AuditEventAction  `bson:"action,omitempty" json:"action,omitempty"`
-	Period            *Period            `bson:"period,omitempty" json:"period,omitempty"`
-	Recorded          string             `bson:"recorded" json:"recorded"`
-	Outcome           *AuditEventOutcome `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	OutcomeDesc       *string            `bson:"outcomeDesc,omitempty" json:"outcomeDesc,omitempty"`
-	PurposeOfEvent    []CodeableConcept  `bson:"purposeOfEvent,omitempty" json:"purposeOfEvent,omitempty"`
-	Agent             []AuditEventAgent  `bson:"agent" json:"agent"`
-	Source            AuditEventSource   `bson:"source" json:"source"`
-	Entity            []AuditEventEntity `bson:"entity,omitempty" json:"entity,omitempty"`
+	Id                   *string            `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element           `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                 Coding             `bson:"type" json:"type"`
+	Subtype              []Coding           `bson:"subtype,omitempty" json:"subtype,omitempty"`
+	Action               *AuditEventAction  `bson:"action,omitempty" json:"action,omitempty"`
+	ActionElement        *Element           `bson:"_action,omitempty" json:"_action,omitempty"`
+	Period               *Period            `bson:"period,omitempty" json:"period,omitempty"`
+	Recorded             string             `bson:"recorded" json:"recorded"`
+	RecordedElement      *Element           `bson:"_recorded,omitempty" json:"_recorded,omitempty"`
+	Outcome              *AuditEventOutcome `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	OutcomeElement       *Element           `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
+	OutcomeDesc          *string            `bson:"outcomeDesc,omitempty" json:"outcomeDesc,omitempty"`
+	OutcomeDescElement   *Element           `bson:"_outcomeDesc,omitempty" json:"_outcomeDesc,omitempty"`
+	PurposeOfEvent       []CodeableConcept  `bson:"purposeOfEvent,omitempty" json:"purposeOfEvent,omitempty"`
+	Agent                []AuditEventAgent  `bson:"agent" json:"agent"`
+	Source               AuditEventSource   `bson:"source" json:"source"`
+	Entity               []AuditEventEntity `bson:"entity,omitempty" json:"entity,omitempty"`
 }
 type AuditEventAgent struct {
 	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
@@ -48,10 +59,14 @@ type AuditEventAgent struct {
 	Role              []CodeableConcept       `bson:"role,omitempty" json:"role,omitempty"`
 	Who               *Reference              `bson:"who,omitempty" json:"who,omitempty"`
 	AltId             *string                 `bson:"altId,omitempty" json:"altId,omitempty"`
+	AltIdElement      *Element                `bson:"_altId,omitempty" json:"_altId,omitempty"`
 	Name              *string                 `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element                `bson:"_name,omitempty" json:"_name,omitempty"`
 	Requestor         bool                    `bson:"requestor" json:"requestor"`
+	RequestorElement  *Element                `bson:"_requestor,omitempty" json:"_requestor,omitempty"`
 	Location          *Reference              `bson:"location,omitempty" json:"location,omitempty"`
 	Policy            []string                `bson:"policy,omitempty" json:"policy,omitempty"`
+	PolicyElement     []*Element              `bson:"_policy,omitempty" json:"_policy,omitempty"`
 	Media             *Coding                 `bson:"media,omitempty" json:"media,omitempty"`
 	Network           *AuditEventAgentNetwork `bson:"network,omitempty" json:"network,omitempty"`
 	PurposeOfUse      []CodeableConcept       `bson:"purposeOfUse,omitempty" json:"purposeOfUse,omitempty"`
@@ -61,55 +76,4236 @@ type AuditEventAgentNetwork struct {
 	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Address           *string                     `bson:"address,omitempty" json:"address,omitempty"`
+	AddressElement    *Element                    `bson:"_address,omitempty" json:"_address,omitempty"`
 	Type              *AuditEventAgentNetworkType `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element                    `bson:"_type,omitempty" json:"_type,omitempty"`
 }
 type AuditEventSource struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Site              *string     `bson:"site,omitempty" json:"site,omitempty"`
+	SiteElement       *Element    `bson:"_site,omitempty" json:"_site,omitempty"`
 	Observer          Reference   `bson:"observer" json:"observer"`
 	Type              []Coding    `bson:"type,omitempty" json:"type,omitempty"`
 }
 type AuditEventEntity struct {
-	Id                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	What              *Reference               `bson:"what,omitempty" json:"what,omitempty"`
-	Type              *Coding                  `bson:"type,omitempty" json:"type,omitempty"`
-	Role              *Coding                  `bson:"role,omitempty" json:"role,omitempty"`
-	Lifecycle         *Coding                  `bson:"lifecycle,omitempty" json:"lifecycle,omitempty"`
-	SecurityLabel     []Coding                 `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
-	Name              *string                  `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                  `bson:"description,omitempty" json:"description,omitempty"`
-	Query             *string                  `bson:"query,omitempty" json:"query,omitempty"`
-	Detail            []AuditEventEntityDetail `bson:"detail,omitempty" json:"detail,omitempty"`
+	Id                 *string                  `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	What               *Reference               `bson:"what,omitempty" json:"what,omitempty"`
+	Type               *Coding                  `bson:"type,omitempty" json:"type,omitempty"`
+	Role               *Coding                  `bson:"role,omitempty" json:"role,omitempty"`
+	Lifecycle          *Coding                  `bson:"lifecycle,omitempty" json:"lifecycle,omitempty"`
+	SecurityLabel      []Coding                 `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
+	Name               *string                  `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element                 `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                  `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                 `bson:"_description,omitempty" json:"_description,omitempty"`
+	Query              *string                  `bson:"query,omitempty" json:"query,omitempty"`
+	QueryElement       *Element                 `bson:"_query,omitempty" json:"_query,omitempty"`
+	Detail             []AuditEventEntityDetail `bson:"detail,omitempty" json:"detail,omitempty"`
 }
 type AuditEventEntityDetail struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              string      `bson:"type" json:"type"`
-	ValueString       string      `bson:"valueString" json:"valueString"`
-	ValueBase64Binary string      `bson:"valueBase64Binary" json:"valueBase64Binary"`
+	Id                       *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     string      `bson:"type" json:"type"`
+	TypeElement              *Element    `bson:"_type,omitempty" json:"_type,omitempty"`
+	ValueString              *string     `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element    `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueBase64Binary        *string     `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element    `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+}
+
+// AuditEventEntityDetailValue is implemented by the types allowed for AuditEvent.entity.detail.value[x]
+type AuditEventEntityDetailValue interface {
+	isAuditEventEntityDetailValue()
+}
+
+func (String) isAuditEventEntityDetailValue()       {}
+func (Base64Binary) isAuditEventEntityDetailValue() {}
+
+// Value returns the value of AuditEvent.entity.detail.value[x] together with its FHIR type code or nil if no value is set
+func (r AuditEventEntityDetail) Value() (AuditEventEntityDetailValue, string) {
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueBase64Binary != nil {
+		return Base64Binary(*r.ValueBase64Binary), "base64Binary"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of AuditEvent.entity.detail.value[x], given as value or pointer, and clears all other types
+func (r *AuditEventEntityDetail) SetValue(value AuditEventEntityDetailValue) {
+	r.ValueString = nil
+	r.ValueBase64Binary = nil
+	switch v := value.(type) {
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Base64Binary:
+		primitive := string(v)
+		r.ValueBase64Binary = &primitive
+	case *Base64Binary:
+		r.ValueBase64Binary = (*string)(v)
+	}
 }
-type OtherAuditEvent AuditEvent
 
 // MarshalJSON marshals the given AuditEvent as JSON into a byte slice
 func (r AuditEvent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherAuditEvent
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherAuditEvent: OtherAuditEvent(r),
-		ResourceType:    "AuditEvent",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AuditEvent as JSON object with the members in the order of the fields
+func (r AuditEvent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"AuditEvent\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if len(r.Subtype) > 0 {
+		e.key("subtype")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subtype {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Action != nil {
+		e.key("action")
+		e.string(r.Action.Code())
+	}
+	if r.ActionElement != nil {
+		e.key("_action")
+		r.ActionElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.key("recorded")
+	e.string(r.Recorded)
+	if r.RecordedElement != nil {
+		e.key("_recorded")
+		r.RecordedElement.appendJSON(e)
+	}
+	if r.Outcome != nil {
+		e.key("outcome")
+		e.string(r.Outcome.Code())
+	}
+	if r.OutcomeElement != nil {
+		e.key("_outcome")
+		r.OutcomeElement.appendJSON(e)
+	}
+	if r.OutcomeDesc != nil {
+		e.key("outcomeDesc")
+		e.string(*r.OutcomeDesc)
+	}
+	if r.OutcomeDescElement != nil {
+		e.key("_outcomeDesc")
+		r.OutcomeDescElement.appendJSON(e)
+	}
+	if len(r.PurposeOfEvent) > 0 {
+		e.key("purposeOfEvent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PurposeOfEvent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("agent")
+	if r.Agent == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Agent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("source")
+	r.Source.appendJSON(e)
+	if len(r.Entity) > 0 {
+		e.key("entity")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Entity {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AuditEvent from JSON. Unknown members are ignored.
+func (r *AuditEvent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AuditEvent
+func (r *AuditEvent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "subtype":
+			a := d.array()
+			r.Subtype = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Subtype = append(r.Subtype, v)
+			}
+			if r.Subtype == nil && !a.isNull {
+				r.Subtype = []Coding{}
+			}
+		case "action":
+			if d.null() {
+				r.Action = nil
+			} else {
+				var v AuditEventAction
+				d.unmarshal(&v)
+				r.Action = &v
+			}
+		case "_action":
+			r.ActionElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "recorded":
+			if v, ok := d.string(); ok {
+				r.Recorded = v
+			}
+		case "_recorded":
+			r.RecordedElement = d.element()
+		case "outcome":
+			if d.null() {
+				r.Outcome = nil
+			} else {
+				var v AuditEventOutcome
+				d.unmarshal(&v)
+				r.Outcome = &v
+			}
+		case "_outcome":
+			r.OutcomeElement = d.element()
+		case "outcomeDesc":
+			if v, ok := d.string(); ok {
+				r.OutcomeDesc = &v
+			} else {
+				r.OutcomeDesc = nil
+			}
+		case "_outcomeDesc":
+			r.OutcomeDescElement = d.element()
+		case "purposeOfEvent":
+			a := d.array()
+			r.PurposeOfEvent = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PurposeOfEvent = append(r.PurposeOfEvent, v)
+			}
+			if r.PurposeOfEvent == nil && !a.isNull {
+				r.PurposeOfEvent = []CodeableConcept{}
+			}
+		case "agent":
+			a := d.array()
+			r.Agent = nil
+			for a.next() {
+				var v AuditEventAgent
+				v.decodeJSON(d)
+				r.Agent = append(r.Agent, v)
+			}
+			if r.Agent == nil && !a.isNull {
+				r.Agent = []AuditEventAgent{}
+			}
+		case "source":
+			r.Source.decodeJSON(d)
+		case "entity":
+			a := d.array()
+			r.Entity = nil
+			for a.next() {
+				var v AuditEventEntity
+				v.decodeJSON(d)
+				r.Entity = append(r.Entity, v)
+			}
+			if r.Entity == nil && !a.isNull {
+				r.Entity = []AuditEventEntity{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AuditEvent which shares no memory with the original
+func (r AuditEvent) DeepCopy() AuditEvent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Subtype != nil {
+		out.Subtype = make([]Coding, len(r.Subtype))
+		for i := range r.Subtype {
+			out.Subtype[i] = r.Subtype[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		v := *r.Action
+		out.Action = &v
+	}
+	if r.ActionElement != nil {
+		v := r.ActionElement.DeepCopy()
+		out.ActionElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.RecordedElement != nil {
+		v := r.RecordedElement.DeepCopy()
+		out.RecordedElement = &v
+	}
+	if r.Outcome != nil {
+		v := *r.Outcome
+		out.Outcome = &v
+	}
+	if r.OutcomeElement != nil {
+		v := r.OutcomeElement.DeepCopy()
+		out.OutcomeElement = &v
+	}
+	if r.OutcomeDesc != nil {
+		v := *r.OutcomeDesc
+		out.OutcomeDesc = &v
+	}
+	if r.OutcomeDescElement != nil {
+		v := r.OutcomeDescElement.DeepCopy()
+		out.OutcomeDescElement = &v
+	}
+	if r.PurposeOfEvent != nil {
+		out.PurposeOfEvent = make([]CodeableConcept, len(r.PurposeOfEvent))
+		for i := range r.PurposeOfEvent {
+			out.PurposeOfEvent[i] = r.PurposeOfEvent[i].DeepCopy()
+		}
+	}
+	if r.Agent != nil {
+		out.Agent = make([]AuditEventAgent, len(r.Agent))
+		for i := range r.Agent {
+			out.Agent[i] = r.Agent[i].DeepCopy()
+		}
+	}
+	out.Source = r.Source.DeepCopy()
+	if r.Entity != nil {
+		out.Entity = make([]AuditEventEntity, len(r.Entity))
+		for i := range r.Entity {
+			out.Entity[i] = r.Entity[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AuditEvent) Equal(other AuditEvent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.Subtype) != len(other.Subtype) {
+		return false
+	}
+	for i := range r.Subtype {
+		if !r.Subtype[i].Equal(other.Subtype[i]) {
+			return false
+		}
+	}
+	if (r.Action == nil) != (other.Action == nil) || r.Action != nil && *r.Action != *other.Action {
+		return false
+	}
+	if (r.ActionElement == nil) != (other.ActionElement == nil) || r.ActionElement != nil && !r.ActionElement.Equal(*other.ActionElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if r.Recorded != other.Recorded {
+		return false
+	}
+	if (r.RecordedElement == nil) != (other.RecordedElement == nil) || r.RecordedElement != nil && !r.RecordedElement.Equal(*other.RecordedElement) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && *r.Outcome != *other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.Equal(*other.OutcomeElement) {
+		return false
+	}
+	if (r.OutcomeDesc == nil) != (other.OutcomeDesc == nil) || r.OutcomeDesc != nil && *r.OutcomeDesc != *other.OutcomeDesc {
+		return false
+	}
+	if (r.OutcomeDescElement == nil) != (other.OutcomeDescElement == nil) || r.OutcomeDescElement != nil && !r.OutcomeDescElement.Equal(*other.OutcomeDescElement) {
+		return false
+	}
+	if len(r.PurposeOfEvent) != len(other.PurposeOfEvent) {
+		return false
+	}
+	for i := range r.PurposeOfEvent {
+		if !r.PurposeOfEvent[i].Equal(other.PurposeOfEvent[i]) {
+			return false
+		}
+	}
+	if len(r.Agent) != len(other.Agent) {
+		return false
+	}
+	for i := range r.Agent {
+		if !r.Agent[i].Equal(other.Agent[i]) {
+			return false
+		}
+	}
+	if !r.Source.Equal(other.Source) {
+		return false
+	}
+	if len(r.Entity) != len(other.Entity) {
+		return false
+	}
+	for i := range r.Entity {
+		if !r.Entity[i].Equal(other.Entity[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AuditEvent) EqualsDeep(other AuditEvent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Subtype), len(other.Subtype), func(i, j int) bool {
+		return r.Subtype[i].EqualsDeep(other.Subtype[j])
+	}) {
+		return false
+	}
+	if (r.Action == nil) != (other.Action == nil) || r.Action != nil && *r.Action != *other.Action {
+		return false
+	}
+	if (r.ActionElement == nil) != (other.ActionElement == nil) || r.ActionElement != nil && !r.ActionElement.EqualsDeep(*other.ActionElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentString(r.Recorded, other.Recorded) {
+		return false
+	}
+	if (r.RecordedElement == nil) != (other.RecordedElement == nil) || r.RecordedElement != nil && !r.RecordedElement.EqualsDeep(*other.RecordedElement) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && *r.Outcome != *other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.EqualsDeep(*other.OutcomeElement) {
+		return false
+	}
+	if (r.OutcomeDesc == nil) != (other.OutcomeDesc == nil) || r.OutcomeDesc != nil && !equivalentString(*r.OutcomeDesc, *other.OutcomeDesc) {
+		return false
+	}
+	if (r.OutcomeDescElement == nil) != (other.OutcomeDescElement == nil) || r.OutcomeDescElement != nil && !r.OutcomeDescElement.EqualsDeep(*other.OutcomeDescElement) {
+		return false
+	}
+	if !equivalentList(len(r.PurposeOfEvent), len(other.PurposeOfEvent), func(i, j int) bool {
+		return r.PurposeOfEvent[i].EqualsDeep(other.PurposeOfEvent[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Agent), len(other.Agent), func(i, j int) bool {
+		return r.Agent[i].EqualsDeep(other.Agent[j])
+	}) {
+		return false
+	}
+	if !r.Source.EqualsDeep(other.Source) {
+		return false
+	}
+	if !equivalentList(len(r.Entity), len(other.Entity), func(i, j int) bool {
+		return r.Entity[i].EqualsDeep(other.Entity[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AuditEvent) EqualsShallow(other AuditEvent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Action == nil) != (other.Action == nil) || r.Action != nil && *r.Action != *other.Action {
+		return false
+	}
+	if !equivalentString(r.Recorded, other.Recorded) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && *r.Outcome != *other.Outcome {
+		return false
+	}
+	if (r.OutcomeDesc == nil) != (other.OutcomeDesc == nil) || r.OutcomeDesc != nil && !equivalentString(*r.OutcomeDesc, *other.OutcomeDesc) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AuditEvent as FHIR XML
+func (r AuditEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "AuditEvent",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Subtype {
+		if err := encodeXMLElement(e, "subtype", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "action", r.Action, r.ActionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "recorded", r.Recorded, r.RecordedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "outcome", r.Outcome, r.OutcomeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "outcomeDesc", r.OutcomeDesc, r.OutcomeDescElement); err != nil {
+		return err
+	}
+	for _, v := range r.PurposeOfEvent {
+		if err := encodeXMLElement(e, "purposeOfEvent", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Agent {
+		if err := encodeXMLElement(e, "agent", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "source", r.Source); err != nil {
+		return err
+	}
+	for _, v := range r.Entity {
+		if err := encodeXMLElement(e, "entity", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AuditEvent from FHIR XML
+func (r *AuditEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "subtype":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subtype = append(r.Subtype, v)
+			case "action":
+				var v AuditEventAction
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Action = &v
+				}
+				r.ActionElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "recorded":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Recorded = v
+				}
+				r.RecordedElement = element
+			case "outcome":
+				var v AuditEventOutcome
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Outcome = &v
+				}
+				r.OutcomeElement = element
+			case "outcomeDesc":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OutcomeDesc = &v
+				}
+				r.OutcomeDescElement = element
+			case "purposeOfEvent":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PurposeOfEvent = append(r.PurposeOfEvent, v)
+			case "agent":
+				var v AuditEventAgent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Agent = append(r.Agent, v)
+			case "source":
+				var v AuditEventSource
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = v
+			case "entity":
+				var v AuditEventEntity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Entity = append(r.Entity, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AuditEvent as properties to the RDF node n
+func (r AuditEvent) turtle(n *turtleNode) {
+	n.resource("AuditEvent", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("AuditEvent.type", -1, r.Type)
+	for i, v := range r.Subtype {
+		n.element("AuditEvent.subtype", i, v)
+	}
+	n.primitive("AuditEvent.action", -1, r.Action, r.ActionElement, "code")
+	n.element("AuditEvent.period", -1, r.Period)
+	n.primitive("AuditEvent.recorded", -1, r.Recorded, r.RecordedElement, "instant")
+	n.primitive("AuditEvent.outcome", -1, r.Outcome, r.OutcomeElement, "code")
+	n.primitive("AuditEvent.outcomeDesc", -1, r.OutcomeDesc, r.OutcomeDescElement, "string")
+	for i, v := range r.PurposeOfEvent {
+		n.element("AuditEvent.purposeOfEvent", i, v)
+	}
+	for i, v := range r.Agent {
+		n.element("AuditEvent.agent", i, v)
+	}
+	n.element("AuditEvent.source", -1, r.Source)
+	for i, v := range r.Entity {
+		n.element("AuditEvent.entity", i, v)
+	}
+}
+
+// MarshalBSON marshals the given AuditEvent as BSON document with the structure of its FHIR JSON
+func (r AuditEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AuditEvent from a BSON document with the structure of its FHIR JSON
+func (r *AuditEvent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AuditEvent as protobuf message AuditEvent of fhir.proto
+func (r AuditEvent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AuditEvent to the encoded message
+func (r AuditEvent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	e.message(9, r.Type)
+	for _, v := range r.Subtype {
+		e.message(10, v)
+	}
+	if r.Action != nil {
+		e.varint(11, r.Action.protoNumber())
+	}
+	if r.Period != nil {
+		e.message(12, *r.Period)
+	}
+	if r.Recorded != "" {
+		e.string(13, r.Recorded)
+	}
+	if r.Outcome != nil {
+		e.varint(14, r.Outcome.protoNumber())
+	}
+	if r.OutcomeDesc != nil {
+		e.string(15, *r.OutcomeDesc)
+	}
+	for _, v := range r.PurposeOfEvent {
+		e.message(16, v)
+	}
+	for _, v := range r.Agent {
+		e.message(17, v)
+	}
+	e.message(18, r.Source)
+	for _, v := range r.Entity {
+		e.message(19, v)
+	}
+	if r.IdElement != nil {
+		e.message(20, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(21, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(22, *r.LanguageElement)
+	}
+	if r.ActionElement != nil {
+		e.message(23, *r.ActionElement)
+	}
+	if r.RecordedElement != nil {
+		e.message(24, *r.RecordedElement)
+	}
+	if r.OutcomeElement != nil {
+		e.message(25, *r.OutcomeElement)
+	}
+	if r.OutcomeDescElement != nil {
+		e.message(26, *r.OutcomeDescElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AuditEvent from protobuf message AuditEvent of fhir.proto
+func (r *AuditEvent) UnmarshalProto(b []byte) error {
+	*r = AuditEvent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Coding
+			d.message(&v)
+			r.Type = v
+		case 10:
+			var v Coding
+			d.message(&v)
+			r.Subtype = append(r.Subtype, v)
+		case 11:
+			var v AuditEventAction
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Action = &v
+		case 12:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 13:
+			r.Recorded = d.string()
+		case 14:
+			var v AuditEventOutcome
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Outcome = &v
+		case 15:
+			v := d.string()
+			r.OutcomeDesc = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.PurposeOfEvent = append(r.PurposeOfEvent, v)
+		case 17:
+			var v AuditEventAgent
+			d.message(&v)
+			r.Agent = append(r.Agent, v)
+		case 18:
+			var v AuditEventSource
+			d.message(&v)
+			r.Source = v
+		case 19:
+			var v AuditEventEntity
+			d.message(&v)
+			r.Entity = append(r.Entity, v)
+		case 20:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ActionElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.RecordedElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.OutcomeElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.OutcomeDescElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AuditEventAgent as JSON into a byte slice
+func (r AuditEventAgent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AuditEventAgent as JSON object with the members in the order of the fields
+func (r AuditEventAgent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.Role) > 0 {
+		e.key("role")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Role {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Who != nil {
+		e.key("who")
+		r.Who.appendJSON(e)
+	}
+	if r.AltId != nil {
+		e.key("altId")
+		e.string(*r.AltId)
+	}
+	if r.AltIdElement != nil {
+		e.key("_altId")
+		r.AltIdElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("requestor")
+	e.bool(r.Requestor)
+	if r.RequestorElement != nil {
+		e.key("_requestor")
+		r.RequestorElement.appendJSON(e)
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if len(r.Policy) > 0 {
+		e.key("policy")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Policy {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PolicyElement) > 0 {
+		e.key("_policy")
+		e.elements(r.PolicyElement, len(r.Policy))
+	}
+	if r.Media != nil {
+		e.key("media")
+		r.Media.appendJSON(e)
+	}
+	if r.Network != nil {
+		e.key("network")
+		r.Network.appendJSON(e)
+	}
+	if len(r.PurposeOfUse) > 0 {
+		e.key("purposeOfUse")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PurposeOfUse {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AuditEventAgent from JSON. Unknown members are ignored.
+func (r *AuditEventAgent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AuditEventAgent
+func (r *AuditEventAgent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "role":
+			a := d.array()
+			r.Role = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = append(r.Role, v)
+			}
+			if r.Role == nil && !a.isNull {
+				r.Role = []CodeableConcept{}
+			}
+		case "who":
+			if d.null() {
+				r.Who = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Who = &v
+			}
+		case "altId":
+			if v, ok := d.string(); ok {
+				r.AltId = &v
+			} else {
+				r.AltId = nil
+			}
+		case "_altId":
+			r.AltIdElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "requestor":
+			if v, ok := d.bool(); ok {
+				r.Requestor = v
+			}
+		case "_requestor":
+			r.RequestorElement = d.element()
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "policy":
+			a := d.array()
+			r.Policy = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Policy = append(r.Policy, v)
+			}
+			if r.Policy == nil && !a.isNull {
+				r.Policy = []string{}
+			}
+		case "_policy":
+			a := d.array()
+			r.PolicyElement = nil
+			for a.next() {
+				r.PolicyElement = append(r.PolicyElement, d.element())
+			}
+		case "media":
+			if d.null() {
+				r.Media = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Media = &v
+			}
+		case "network":
+			if d.null() {
+				r.Network = nil
+			} else {
+				var v AuditEventAgentNetwork
+				v.decodeJSON(d)
+				r.Network = &v
+			}
+		case "purposeOfUse":
+			a := d.array()
+			r.PurposeOfUse = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PurposeOfUse = append(r.PurposeOfUse, v)
+			}
+			if r.PurposeOfUse == nil && !a.isNull {
+				r.PurposeOfUse = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AuditEventAgent which shares no memory with the original
+func (r AuditEventAgent) DeepCopy() AuditEventAgent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Role != nil {
+		out.Role = make([]CodeableConcept, len(r.Role))
+		for i := range r.Role {
+			out.Role[i] = r.Role[i].DeepCopy()
+		}
+	}
+	if r.Who != nil {
+		v := r.Who.DeepCopy()
+		out.Who = &v
+	}
+	if r.AltId != nil {
+		v := *r.AltId
+		out.AltId = &v
+	}
+	if r.AltIdElement != nil {
+		v := r.AltIdElement.DeepCopy()
+		out.AltIdElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.RequestorElement != nil {
+		v := r.RequestorElement.DeepCopy()
+		out.RequestorElement = &v
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.Policy != nil {
+		out.Policy = make([]string, len(r.Policy))
+		copy(out.Policy, r.Policy)
+	}
+	if r.PolicyElement != nil {
+		out.PolicyElement = make([]*Element, len(r.PolicyElement))
+		for i, v := range r.PolicyElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PolicyElement[i] = &c
+			}
+		}
+	}
+	if r.Media != nil {
+		v := r.Media.DeepCopy()
+		out.Media = &v
+	}
+	if r.Network != nil {
+		v := r.Network.DeepCopy()
+		out.Network = &v
+	}
+	if r.PurposeOfUse != nil {
+		out.PurposeOfUse = make([]CodeableConcept, len(r.PurposeOfUse))
+		for i := range r.PurposeOfUse {
+			out.PurposeOfUse[i] = r.PurposeOfUse[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AuditEventAgent) Equal(other AuditEventAgent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.Role) != len(other.Role) {
+		return false
+	}
+	for i := range r.Role {
+		if !r.Role[i].Equal(other.Role[i]) {
+			return false
+		}
+	}
+	if (r.Who == nil) != (other.Who == nil) || r.Who != nil && !r.Who.Equal(*other.Who) {
+		return false
+	}
+	if (r.AltId == nil) != (other.AltId == nil) || r.AltId != nil && *r.AltId != *other.AltId {
+		return false
+	}
+	if (r.AltIdElement == nil) != (other.AltIdElement == nil) || r.AltIdElement != nil && !r.AltIdElement.Equal(*other.AltIdElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Requestor != other.Requestor {
+		return false
+	}
+	if (r.RequestorElement == nil) != (other.RequestorElement == nil) || r.RequestorElement != nil && !r.RequestorElement.Equal(*other.RequestorElement) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if len(r.Policy) != len(other.Policy) {
+		return false
+	}
+	for i := range r.Policy {
+		if r.Policy[i] != other.Policy[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PolicyElement, other.PolicyElement, false) {
+		return false
+	}
+	if (r.Media == nil) != (other.Media == nil) || r.Media != nil && !r.Media.Equal(*other.Media) {
+		return false
+	}
+	if (r.Network == nil) != (other.Network == nil) || r.Network != nil && !r.Network.Equal(*other.Network) {
+		return false
+	}
+	if len(r.PurposeOfUse) != len(other.PurposeOfUse) {
+		return false
+	}
+	for i := range r.PurposeOfUse {
+		if !r.PurposeOfUse[i].Equal(other.PurposeOfUse[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AuditEventAgent) EqualsDeep(other AuditEventAgent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Role), len(other.Role), func(i, j int) bool {
+		return r.Role[i].EqualsDeep(other.Role[j])
+	}) {
+		return false
+	}
+	if (r.Who == nil) != (other.Who == nil) || r.Who != nil && !r.Who.EqualsDeep(*other.Who) {
+		return false
+	}
+	if (r.AltId == nil) != (other.AltId == nil) || r.AltId != nil && !equivalentString(*r.AltId, *other.AltId) {
+		return false
+	}
+	if (r.AltIdElement == nil) != (other.AltIdElement == nil) || r.AltIdElement != nil && !r.AltIdElement.EqualsDeep(*other.AltIdElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if r.Requestor != other.Requestor {
+		return false
+	}
+	if (r.RequestorElement == nil) != (other.RequestorElement == nil) || r.RequestorElement != nil && !r.RequestorElement.EqualsDeep(*other.RequestorElement) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if !equivalentList(len(r.Policy), len(other.Policy), func(i, j int) bool {
+		return equivalentString(r.Policy[i], other.Policy[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PolicyElement, other.PolicyElement, true) {
+		return false
+	}
+	if (r.Media == nil) != (other.Media == nil) || r.Media != nil && !r.Media.EqualsDeep(*other.Media) {
+		return false
+	}
+	if (r.Network == nil) != (other.Network == nil) || r.Network != nil && !r.Network.EqualsDeep(*other.Network) {
+		return false
+	}
+	if !equivalentList(len(r.PurposeOfUse), len(other.PurposeOfUse), func(i, j int) bool {
+		return r.PurposeOfUse[i].EqualsDeep(other.PurposeOfUse[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AuditEventAgent) EqualsShallow(other AuditEventAgent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.AltId == nil) != (other.AltId == nil) || r.AltId != nil && !equivalentString(*r.AltId, *other.AltId) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if r.Requestor != other.Requestor {
+		return false
+	}
+	if !equivalentList(len(r.Policy), len(other.Policy), func(i, j int) bool {
+		return equivalentString(r.Policy[i], other.Policy[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AuditEventAgent as FHIR XML
+func (r AuditEventAgent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Role {
+		if err := encodeXMLElement(e, "role", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "who", r.Who); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "altId", r.AltId, r.AltIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "requestor", r.Requestor, r.RequestorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	for i, v := range r.Policy {
+		if err := encodeXMLPrimitive(e, "policy", v, elementAt(r.PolicyElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "media", r.Media); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "network", r.Network); err != nil {
+		return err
+	}
+	for _, v := range r.PurposeOfUse {
+		if err := encodeXMLElement(e, "purposeOfUse", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AuditEventAgent from FHIR XML
+func (r *AuditEventAgent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = append(r.Role, v)
+			case "who":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Who = &v
+			case "altId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AltId = &v
+				}
+				r.AltIdElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "requestor":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Requestor = v
+				}
+				r.RequestorElement = element
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "policy":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PolicyElement = appendElement(r.PolicyElement, len(r.Policy), element)
+					r.Policy = append(r.Policy, v)
+				}
+			case "media":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Media = &v
+			case "network":
+				var v AuditEventAgentNetwork
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Network = &v
+			case "purposeOfUse":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PurposeOfUse = append(r.PurposeOfUse, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AuditEventAgent as properties to the RDF node n
+func (r AuditEventAgent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("AuditEvent.agent.type", -1, r.Type)
+	for i, v := range r.Role {
+		n.element("AuditEvent.agent.role", i, v)
+	}
+	n.element("AuditEvent.agent.who", -1, r.Who)
+	n.primitive("AuditEvent.agent.altId", -1, r.AltId, r.AltIdElement, "string")
+	n.primitive("AuditEvent.agent.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("AuditEvent.agent.requestor", -1, r.Requestor, r.RequestorElement, "boolean")
+	n.element("AuditEvent.agent.location", -1, r.Location)
+	for i, v := range r.Policy {
+		n.primitive("AuditEvent.agent.policy", i, v, elementAt(r.PolicyElement, i), "string")
+	}
+	n.element("AuditEvent.agent.media", -1, r.Media)
+	n.element("AuditEvent.agent.network", -1, r.Network)
+	for i, v := range r.PurposeOfUse {
+		n.element("AuditEvent.agent.purposeOfUse", i, v)
+	}
+}
+
+// MarshalBSON marshals the given AuditEventAgent as BSON document with the structure of its FHIR JSON
+func (r AuditEventAgent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AuditEventAgent from a BSON document with the structure of its FHIR JSON
+func (r *AuditEventAgent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AuditEventAgent as protobuf message AuditEventAgent of fhir.proto
+func (r AuditEventAgent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AuditEventAgent to the encoded message
+func (r AuditEventAgent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	for _, v := range r.Role {
+		e.message(5, v)
+	}
+	if r.Who != nil {
+		e.message(6, *r.Who)
+	}
+	if r.AltId != nil {
+		e.string(7, *r.AltId)
+	}
+	if r.Name != nil {
+		e.string(8, *r.Name)
+	}
+	if r.Requestor {
+		e.varint(9, protoBool(r.Requestor))
+	}
+	if r.Location != nil {
+		e.message(10, *r.Location)
+	}
+	for _, v := range r.Policy {
+		e.string(11, v)
+	}
+	if r.Media != nil {
+		e.message(12, *r.Media)
+	}
+	if r.Network != nil {
+		e.message(13, *r.Network)
+	}
+	for _, v := range r.PurposeOfUse {
+		e.message(14, v)
+	}
+	if r.AltIdElement != nil {
+		e.message(15, *r.AltIdElement)
+	}
+	if r.NameElement != nil {
+		e.message(16, *r.NameElement)
+	}
+	if r.RequestorElement != nil {
+		e.message(17, *r.RequestorElement)
+	}
+	for _, v := range r.PolicyElement {
+		e.element(18, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given AuditEventAgent from protobuf message AuditEventAgent of fhir.proto
+func (r *AuditEventAgent) UnmarshalProto(b []byte) error {
+	*r = AuditEventAgent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = append(r.Role, v)
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Who = &v
+		case 7:
+			v := d.string()
+			r.AltId = &v
+		case 8:
+			v := d.string()
+			r.Name = &v
+		case 9:
+			r.Requestor = d.bool()
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 11:
+			r.Policy = append(r.Policy, d.string())
+		case 12:
+			var v Coding
+			d.message(&v)
+			r.Media = &v
+		case 13:
+			var v AuditEventAgentNetwork
+			d.message(&v)
+			r.Network = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.PurposeOfUse = append(r.PurposeOfUse, v)
+		case 15:
+			var v Element
+			d.message(&v)
+			r.AltIdElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.RequestorElement = &v
+		case 18:
+			r.PolicyElement = append(r.PolicyElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AuditEventAgentNetwork as JSON into a byte slice
+func (r AuditEventAgentNetwork) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AuditEventAgentNetwork as JSON object with the members in the order of the fields
+func (r AuditEventAgentNetwork) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Address != nil {
+		e.key("address")
+		e.string(*r.Address)
+	}
+	if r.AddressElement != nil {
+		e.key("_address")
+		r.AddressElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(r.Type.Code())
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AuditEventAgentNetwork from JSON. Unknown members are ignored.
+func (r *AuditEventAgentNetwork) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AuditEventAgentNetwork
+func (r *AuditEventAgentNetwork) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "address":
+			if v, ok := d.string(); ok {
+				r.Address = &v
+			} else {
+				r.Address = nil
+			}
+		case "_address":
+			r.AddressElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v AuditEventAgentNetworkType
+				d.unmarshal(&v)
+				r.Type = &v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AuditEventAgentNetwork which shares no memory with the original
+func (r AuditEventAgentNetwork) DeepCopy() AuditEventAgentNetwork {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Address != nil {
+		v := *r.Address
+		out.Address = &v
+	}
+	if r.AddressElement != nil {
+		v := r.AddressElement.DeepCopy()
+		out.AddressElement = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AuditEventAgentNetwork) Equal(other AuditEventAgentNetwork) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Address == nil) != (other.Address == nil) || r.Address != nil && *r.Address != *other.Address {
+		return false
+	}
+	if (r.AddressElement == nil) != (other.AddressElement == nil) || r.AddressElement != nil && !r.AddressElement.Equal(*other.AddressElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AuditEventAgentNetwork) EqualsDeep(other AuditEventAgentNetwork) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Address == nil) != (other.Address == nil) || r.Address != nil && !equivalentString(*r.Address, *other.Address) {
+		return false
+	}
+	if (r.AddressElement == nil) != (other.AddressElement == nil) || r.AddressElement != nil && !r.AddressElement.EqualsDeep(*other.AddressElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AuditEventAgentNetwork) EqualsShallow(other AuditEventAgentNetwork) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Address == nil) != (other.Address == nil) || r.Address != nil && !equivalentString(*r.Address, *other.Address) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AuditEventAgentNetwork as FHIR XML
+func (r AuditEventAgentNetwork) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "address", r.Address, r.AddressElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AuditEventAgentNetwork from FHIR XML
+func (r *AuditEventAgentNetwork) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "address":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Address = &v
+				}
+				r.AddressElement = element
+			case "type":
+				var v AuditEventAgentNetworkType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AuditEventAgentNetwork as properties to the RDF node n
+func (r AuditEventAgentNetwork) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("AuditEvent.agent.network.address", -1, r.Address, r.AddressElement, "string")
+	n.primitive("AuditEvent.agent.network.type", -1, r.Type, r.TypeElement, "code")
+}
+
+// MarshalBSON marshals the given AuditEventAgentNetwork as BSON document with the structure of its FHIR JSON
+func (r AuditEventAgentNetwork) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AuditEventAgentNetwork from a BSON document with the structure of its FHIR JSON
+func (r *AuditEventAgentNetwork) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AuditEventAgentNetwork as protobuf message AuditEventAgentNetwork of fhir.proto
+func (r AuditEventAgentNetwork) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AuditEventAgentNetwork to the encoded message
+func (r AuditEventAgentNetwork) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Address != nil {
+		e.string(4, *r.Address)
+	}
+	if r.Type != nil {
+		e.varint(5, r.Type.protoNumber())
+	}
+	if r.AddressElement != nil {
+		e.message(6, *r.AddressElement)
+	}
+	if r.TypeElement != nil {
+		e.message(7, *r.TypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AuditEventAgentNetwork from protobuf message AuditEventAgentNetwork of fhir.proto
+func (r *AuditEventAgentNetwork) UnmarshalProto(b []byte) error {
+	*r = AuditEventAgentNetwork{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Address = &v
+		case 5:
+			var v AuditEventAgentNetworkType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.AddressElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AuditEventSource as JSON into a byte slice
+func (r AuditEventSource) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AuditEventSource as JSON object with the members in the order of the fields
+func (r AuditEventSource) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Site != nil {
+		e.key("site")
+		e.string(*r.Site)
+	}
+	if r.SiteElement != nil {
+		e.key("_site")
+		r.SiteElement.appendJSON(e)
+	}
+	e.key("observer")
+	r.Observer.appendJSON(e)
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AuditEventSource from JSON. Unknown members are ignored.
+func (r *AuditEventSource) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AuditEventSource
+func (r *AuditEventSource) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "site":
+			if v, ok := d.string(); ok {
+				r.Site = &v
+			} else {
+				r.Site = nil
+			}
+		case "_site":
+			r.SiteElement = d.element()
+		case "observer":
+			r.Observer.decodeJSON(d)
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []Coding{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AuditEventSource which shares no memory with the original
+func (r AuditEventSource) DeepCopy() AuditEventSource {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Site != nil {
+		v := *r.Site
+		out.Site = &v
+	}
+	if r.SiteElement != nil {
+		v := r.SiteElement.DeepCopy()
+		out.SiteElement = &v
+	}
+	out.Observer = r.Observer.DeepCopy()
+	if r.Type != nil {
+		out.Type = make([]Coding, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AuditEventSource) Equal(other AuditEventSource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Site == nil) != (other.Site == nil) || r.Site != nil && *r.Site != *other.Site {
+		return false
+	}
+	if (r.SiteElement == nil) != (other.SiteElement == nil) || r.SiteElement != nil && !r.SiteElement.Equal(*other.SiteElement) {
+		return false
+	}
+	if !r.Observer.Equal(other.Observer) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AuditEventSource) EqualsDeep(other AuditEventSource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Site == nil) != (other.Site == nil) || r.Site != nil && !equivalentString(*r.Site, *other.Site) {
+		return false
+	}
+	if (r.SiteElement == nil) != (other.SiteElement == nil) || r.SiteElement != nil && !r.SiteElement.EqualsDeep(*other.SiteElement) {
+		return false
+	}
+	if !r.Observer.EqualsDeep(other.Observer) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AuditEventSource) EqualsShallow(other AuditEventSource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Site == nil) != (other.Site == nil) || r.Site != nil && !equivalentString(*r.Site, *other.Site) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AuditEventSource as FHIR XML
+func (r AuditEventSource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "site", r.Site, r.SiteElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "observer", r.Observer); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AuditEventSource from FHIR XML
+func (r *AuditEventSource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "site":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Site = &v
+				}
+				r.SiteElement = element
+			case "observer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Observer = v
+			case "type":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AuditEventSource as properties to the RDF node n
+func (r AuditEventSource) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("AuditEvent.source.site", -1, r.Site, r.SiteElement, "string")
+	n.element("AuditEvent.source.observer", -1, r.Observer)
+	for i, v := range r.Type {
+		n.element("AuditEvent.source.type", i, v)
+	}
+}
+
+// MarshalBSON marshals the given AuditEventSource as BSON document with the structure of its FHIR JSON
+func (r AuditEventSource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AuditEventSource from a BSON document with the structure of its FHIR JSON
+func (r *AuditEventSource) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AuditEventSource as protobuf message AuditEventSource of fhir.proto
+func (r AuditEventSource) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AuditEventSource to the encoded message
+func (r AuditEventSource) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Site != nil {
+		e.string(4, *r.Site)
+	}
+	e.message(5, r.Observer)
+	for _, v := range r.Type {
+		e.message(6, v)
+	}
+	if r.SiteElement != nil {
+		e.message(7, *r.SiteElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AuditEventSource from protobuf message AuditEventSource of fhir.proto
+func (r *AuditEventSource) UnmarshalProto(b []byte) error {
+	*r = AuditEventSource{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Site = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Observer = v
+		case 6:
+			var v Coding
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.SiteElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AuditEventEntity as JSON into a byte slice
+func (r AuditEventEntity) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AuditEventEntity as JSON object with the members in the order of the fields
+func (r AuditEventEntity) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.What != nil {
+		e.key("what")
+		r.What.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Role != nil {
+		e.key("role")
+		r.Role.appendJSON(e)
+	}
+	if r.Lifecycle != nil {
+		e.key("lifecycle")
+		r.Lifecycle.appendJSON(e)
+	}
+	if len(r.SecurityLabel) > 0 {
+		e.key("securityLabel")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SecurityLabel {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Query != nil {
+		e.key("query")
+		e.string(*r.Query)
+	}
+	if r.QueryElement != nil {
+		e.key("_query")
+		r.QueryElement.appendJSON(e)
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AuditEventEntity from JSON. Unknown members are ignored.
+func (r *AuditEventEntity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AuditEventEntity
+func (r *AuditEventEntity) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "what":
+			if d.null() {
+				r.What = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.What = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "role":
+			if d.null() {
+				r.Role = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Role = &v
+			}
+		case "lifecycle":
+			if d.null() {
+				r.Lifecycle = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Lifecycle = &v
+			}
+		case "securityLabel":
+			a := d.array()
+			r.SecurityLabel = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.SecurityLabel = append(r.SecurityLabel, v)
+			}
+			if r.SecurityLabel == nil && !a.isNull {
+				r.SecurityLabel = []Coding{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "query":
+			if v, ok := d.string(); ok {
+				r.Query = &v
+			} else {
+				r.Query = nil
+			}
+		case "_query":
+			r.QueryElement = d.element()
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v AuditEventEntityDetail
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []AuditEventEntityDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AuditEventEntity which shares no memory with the original
+func (r AuditEventEntity) DeepCopy() AuditEventEntity {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.What != nil {
+		v := r.What.DeepCopy()
+		out.What = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Role != nil {
+		v := r.Role.DeepCopy()
+		out.Role = &v
+	}
+	if r.Lifecycle != nil {
+		v := r.Lifecycle.DeepCopy()
+		out.Lifecycle = &v
+	}
+	if r.SecurityLabel != nil {
+		out.SecurityLabel = make([]Coding, len(r.SecurityLabel))
+		for i := range r.SecurityLabel {
+			out.SecurityLabel[i] = r.SecurityLabel[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Query != nil {
+		v := *r.Query
+		out.Query = &v
+	}
+	if r.QueryElement != nil {
+		v := r.QueryElement.DeepCopy()
+		out.QueryElement = &v
+	}
+	if r.Detail != nil {
+		out.Detail = make([]AuditEventEntityDetail, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AuditEventEntity) Equal(other AuditEventEntity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.What == nil) != (other.What == nil) || r.What != nil && !r.What.Equal(*other.What) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.Equal(*other.Role) {
+		return false
+	}
+	if (r.Lifecycle == nil) != (other.Lifecycle == nil) || r.Lifecycle != nil && !r.Lifecycle.Equal(*other.Lifecycle) {
+		return false
+	}
+	if len(r.SecurityLabel) != len(other.SecurityLabel) {
+		return false
+	}
+	for i := range r.SecurityLabel {
+		if !r.SecurityLabel[i].Equal(other.SecurityLabel[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Query == nil) != (other.Query == nil) || r.Query != nil && *r.Query != *other.Query {
+		return false
+	}
+	if (r.QueryElement == nil) != (other.QueryElement == nil) || r.QueryElement != nil && !r.QueryElement.Equal(*other.QueryElement) {
+		return false
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AuditEventEntity) EqualsDeep(other AuditEventEntity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.What == nil) != (other.What == nil) || r.What != nil && !r.What.EqualsDeep(*other.What) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.EqualsDeep(*other.Role) {
+		return false
+	}
+	if (r.Lifecycle == nil) != (other.Lifecycle == nil) || r.Lifecycle != nil && !r.Lifecycle.EqualsDeep(*other.Lifecycle) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabel), len(other.SecurityLabel), func(i, j int) bool {
+		return r.SecurityLabel[i].EqualsDeep(other.SecurityLabel[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Query == nil) != (other.Query == nil) || r.Query != nil && !equivalentString(*r.Query, *other.Query) {
+		return false
+	}
+	if (r.QueryElement == nil) != (other.QueryElement == nil) || r.QueryElement != nil && !r.QueryElement.EqualsDeep(*other.QueryElement) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AuditEventEntity) EqualsShallow(other AuditEventEntity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Query == nil) != (other.Query == nil) || r.Query != nil && !equivalentString(*r.Query, *other.Query) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AuditEventEntity as FHIR XML
+func (r AuditEventEntity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "what", r.What); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "lifecycle", r.Lifecycle); err != nil {
+		return err
+	}
+	for _, v := range r.SecurityLabel {
+		if err := encodeXMLElement(e, "securityLabel", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "query", r.Query, r.QueryElement); err != nil {
+		return err
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AuditEventEntity from FHIR XML
+func (r *AuditEventEntity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "what":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.What = &v
+			case "type":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "role":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = &v
+			case "lifecycle":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Lifecycle = &v
+			case "securityLabel":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SecurityLabel = append(r.SecurityLabel, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "query":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Query = &v
+				}
+				r.QueryElement = element
+			case "detail":
+				var v AuditEventEntityDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AuditEventEntity as properties to the RDF node n
+func (r AuditEventEntity) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("AuditEvent.entity.what", -1, r.What)
+	n.element("AuditEvent.entity.type", -1, r.Type)
+	n.element("AuditEvent.entity.role", -1, r.Role)
+	n.element("AuditEvent.entity.lifecycle", -1, r.Lifecycle)
+	for i, v := range r.SecurityLabel {
+		n.element("AuditEvent.entity.securityLabel", i, v)
+	}
+	n.primitive("AuditEvent.entity.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("AuditEvent.entity.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("AuditEvent.entity.query", -1, r.Query, r.QueryElement, "base64Binary")
+	for i, v := range r.Detail {
+		n.element("AuditEvent.entity.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given AuditEventEntity as BSON document with the structure of its FHIR JSON
+func (r AuditEventEntity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AuditEventEntity from a BSON document with the structure of its FHIR JSON
+func (r *AuditEventEntity) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AuditEventEntity as protobuf message AuditEventEntity of fhir.proto
+func (r AuditEventEntity) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AuditEventEntity to the encoded message
+func (r AuditEventEntity) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.What != nil {
+		e.message(4, *r.What)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.Role != nil {
+		e.message(6, *r.Role)
+	}
+	if r.Lifecycle != nil {
+		e.message(7, *r.Lifecycle)
+	}
+	for _, v := range r.SecurityLabel {
+		e.message(8, v)
+	}
+	if r.Name != nil {
+		e.string(9, *r.Name)
+	}
+	if r.Description != nil {
+		e.string(10, *r.Description)
+	}
+	if r.Query != nil {
+		e.string(11, *r.Query)
+	}
+	for _, v := range r.Detail {
+		e.message(12, v)
+	}
+	if r.NameElement != nil {
+		e.message(13, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(14, *r.DescriptionElement)
+	}
+	if r.QueryElement != nil {
+		e.message(15, *r.QueryElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AuditEventEntity from protobuf message AuditEventEntity of fhir.proto
+func (r *AuditEventEntity) UnmarshalProto(b []byte) error {
+	*r = AuditEventEntity{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.What = &v
+		case 5:
+			var v Coding
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v Coding
+			d.message(&v)
+			r.Role = &v
+		case 7:
+			var v Coding
+			d.message(&v)
+			r.Lifecycle = &v
+		case 8:
+			var v Coding
+			d.message(&v)
+			r.SecurityLabel = append(r.SecurityLabel, v)
+		case 9:
+			v := d.string()
+			r.Name = &v
+		case 10:
+			v := d.string()
+			r.Description = &v
+		case 11:
+			v := d.string()
+			r.Query = &v
+		case 12:
+			var v AuditEventEntityDetail
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		case 13:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.QueryElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given AuditEventEntityDetail as JSON into a byte slice
+func (r AuditEventEntityDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the AuditEventEntityDetail as JSON object with the members in the order of the fields
+func (r AuditEventEntityDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type)
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueBase64Binary != nil {
+		e.key("valueBase64Binary")
+		e.string(*r.ValueBase64Binary)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.key("_valueBase64Binary")
+		r.ValueBase64BinaryElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given AuditEventEntityDetail from JSON. Unknown members are ignored.
+func (r *AuditEventEntityDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the AuditEventEntityDetail
+func (r *AuditEventEntityDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueBase64Binary":
+			if v, ok := d.string(); ok {
+				r.ValueBase64Binary = &v
+			} else {
+				r.ValueBase64Binary = nil
+			}
+		case "_valueBase64Binary":
+			r.ValueBase64BinaryElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the AuditEventEntityDetail which shares no memory with the original
+func (r AuditEventEntityDetail) DeepCopy() AuditEventEntityDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueBase64Binary != nil {
+		v := *r.ValueBase64Binary
+		out.ValueBase64Binary = &v
+	}
+	if r.ValueBase64BinaryElement != nil {
+		v := r.ValueBase64BinaryElement.DeepCopy()
+		out.ValueBase64BinaryElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r AuditEventEntityDetail) Equal(other AuditEventEntityDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && *r.ValueBase64Binary != *other.ValueBase64Binary {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.Equal(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r AuditEventEntityDetail) EqualsDeep(other AuditEventEntityDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.EqualsDeep(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r AuditEventEntityDetail) EqualsShallow(other AuditEventEntityDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given AuditEventEntityDetail as FHIR XML
+func (r AuditEventEntityDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBase64Binary", r.ValueBase64Binary, r.ValueBase64BinaryElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given AuditEventEntityDetail from FHIR XML
+func (r *AuditEventEntityDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBase64Binary = &v
+				}
+				r.ValueBase64BinaryElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the AuditEventEntityDetail as properties to the RDF node n
+func (r AuditEventEntityDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("AuditEvent.entity.detail.type", -1, r.Type, r.TypeElement, "string")
+	n.primitive("AuditEvent.entity.detail.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("AuditEvent.entity.detail.valueBase64Binary", -1, r.ValueBase64Binary, r.ValueBase64BinaryElement, "base64Binary")
+}
+
+// MarshalBSON marshals the given AuditEventEntityDetail as BSON document with the structure of its FHIR JSON
+func (r AuditEventEntityDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given AuditEventEntityDetail from a BSON document with the structure of its FHIR JSON
+func (r *AuditEventEntityDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given AuditEventEntityDetail as protobuf message AuditEventEntityDetail of fhir.proto
+func (r AuditEventEntityDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the AuditEventEntityDetail to the encoded message
+func (r AuditEventEntityDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != "" {
+		e.string(4, r.Type)
+	}
+	if r.ValueString != nil {
+		e.string(5, *r.ValueString)
+	}
+	if r.ValueBase64Binary != nil {
+		e.string(6, *r.ValueBase64Binary)
+	}
+	if r.TypeElement != nil {
+		e.message(7, *r.TypeElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(8, *r.ValueStringElement)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.message(9, *r.ValueBase64BinaryElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given AuditEventEntityDetail from protobuf message AuditEventEntityDetail of fhir.proto
+func (r *AuditEventEntityDetail) UnmarshalProto(b []byte) error {
+	*r = AuditEventEntityDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Type = d.string()
+		case 5:
+			v := d.string()
+			r.ValueString = &v
+		case 6:
+			v := d.string()
+			r.ValueBase64Binary = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ValueBase64BinaryElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalAuditEvent unmarshals a AuditEvent.
 func UnmarshalAuditEvent(b []byte) (AuditEvent, error) {
 	var auditEvent AuditEvent
-	if err := json.Unmarshal(b, &auditEvent); err != nil {
+	if err := auditEvent.UnmarshalJSON(b); err != nil {
 		return auditEvent, err
 	}
 	return auditEvent, nil
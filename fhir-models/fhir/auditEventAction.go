@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code AuditEventAction) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AuditEventAction) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AuditEventAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "C":
 		*code = AuditEventActionC
@@ -55,6 +59,12 @@ func (code *AuditEventAction) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AuditEventAction) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AuditEventAction) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AuditEventAction) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code AuditEventAction) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AuditEventAction) protoNumber() uint64 {
+	switch code {
+	case AuditEventActionC:
+		return 1
+	case AuditEventActionR:
+		return 2
+	case AuditEventActionU:
+		return 3
+	case AuditEventActionD:
+		return 4
+	case AuditEventActionE:
+		return 5
+	}
+	return 0
+}
+func (code *AuditEventAction) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AuditEventActionC
+	case 2:
+		*code = AuditEventActionR
+	case 3:
+		*code = AuditEventActionU
+	case 4:
+		*code = AuditEventActionD
+	case 5:
+		*code = AuditEventActionE
+	default:
+		return false
+	}
+	return true
+}
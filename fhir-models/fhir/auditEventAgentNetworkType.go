@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code AuditEventAgentNetworkType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AuditEventAgentNetworkType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AuditEventAgentNetworkType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "1":
 		*code = AuditEventAgentNetworkType1
@@ -55,6 +59,12 @@ func (code *AuditEventAgentNetworkType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AuditEventAgentNetworkType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AuditEventAgentNetworkType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AuditEventAgentNetworkType) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code AuditEventAgentNetworkType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AuditEventAgentNetworkType) protoNumber() uint64 {
+	switch code {
+	case AuditEventAgentNetworkType1:
+		return 1
+	case AuditEventAgentNetworkType2:
+		return 2
+	case AuditEventAgentNetworkType3:
+		return 3
+	case AuditEventAgentNetworkType4:
+		return 4
+	case AuditEventAgentNetworkType5:
+		return 5
+	}
+	return 0
+}
+func (code *AuditEventAgentNetworkType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AuditEventAgentNetworkType1
+	case 2:
+		*code = AuditEventAgentNetworkType2
+	case 3:
+		*code = AuditEventAgentNetworkType3
+	case 4:
+		*code = AuditEventAgentNetworkType4
+	case 5:
+		*code = AuditEventAgentNetworkType5
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code AuditEventOutcome) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *AuditEventOutcome) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *AuditEventOutcome) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "0":
 		*code = AuditEventOutcome0
@@ -52,6 +56,12 @@ func (code *AuditEventOutcome) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code AuditEventOutcome) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *AuditEventOutcome) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code AuditEventOutcome) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code AuditEventOutcome) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code AuditEventOutcome) protoNumber() uint64 {
+	switch code {
+	case AuditEventOutcome0:
+		return 1
+	case AuditEventOutcome4:
+		return 2
+	case AuditEventOutcome8:
+		return 3
+	case AuditEventOutcome12:
+		return 4
+	}
+	return 0
+}
+func (code *AuditEventOutcome) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = AuditEventOutcome0
+	case 2:
+		*code = AuditEventOutcome4
+	case 3:
+		*code = AuditEventOutcome8
+	case 4:
+		*code = AuditEventOutcome12
+	default:
+		return false
+	}
+	return true
+}
@@ -14,38 +14,557 @@
 
 package fhir
 
-import "encoding/json"
+import "encoding/xml"
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Binary is documented here http://hl7.org/fhir/StructureDefinition/Binary
 type Binary struct {
-	Id              *string    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta            *Meta      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules   *string    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language        *string    `bson:"language,omitempty" json:"language,omitempty"`
-	ContentType     string     `bson:"contentType" json:"contentType"`
-	SecurityContext *Reference `bson:"securityContext,omitempty" json:"securityContext,omitempty"`
-	Data            *string    `bson:"data,omitempty" json:"data,omitempty"`
+	Id                   *string    `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element   `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element   `bson:"_language,omitempty" json:"_language,omitempty"`
+	ContentType          string     `bson:"contentType" json:"contentType"`
+	ContentTypeElement   *Element   `bson:"_contentType,omitempty" json:"_contentType,omitempty"`
+	SecurityContext      *Reference `bson:"securityContext,omitempty" json:"securityContext,omitempty"`
+	Data                 *string    `bson:"data,omitempty" json:"data,omitempty"`
+	DataElement          *Element   `bson:"_data,omitempty" json:"_data,omitempty"`
 }
-type OtherBinary Binary
 
 // MarshalJSON marshals the given Binary as JSON into a byte slice
 func (r Binary) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherBinary
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherBinary:  OtherBinary(r),
-		ResourceType: "Binary",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Binary as JSON object with the members in the order of the fields
+func (r Binary) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Binary\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	e.key("contentType")
+	e.string(r.ContentType)
+	if r.ContentTypeElement != nil {
+		e.key("_contentType")
+		r.ContentTypeElement.appendJSON(e)
+	}
+	if r.SecurityContext != nil {
+		e.key("securityContext")
+		r.SecurityContext.appendJSON(e)
+	}
+	if r.Data != nil {
+		e.key("data")
+		e.string(*r.Data)
+	}
+	if r.DataElement != nil {
+		e.key("_data")
+		r.DataElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Binary from JSON. Unknown members are ignored.
+func (r *Binary) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Binary
+func (r *Binary) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "contentType":
+			if v, ok := d.string(); ok {
+				r.ContentType = v
+			}
+		case "_contentType":
+			r.ContentTypeElement = d.element()
+		case "securityContext":
+			if d.null() {
+				r.SecurityContext = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SecurityContext = &v
+			}
+		case "data":
+			if v, ok := d.string(); ok {
+				r.Data = &v
+			} else {
+				r.Data = nil
+			}
+		case "_data":
+			r.DataElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Binary which shares no memory with the original
+func (r Binary) DeepCopy() Binary {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.ContentTypeElement != nil {
+		v := r.ContentTypeElement.DeepCopy()
+		out.ContentTypeElement = &v
+	}
+	if r.SecurityContext != nil {
+		v := r.SecurityContext.DeepCopy()
+		out.SecurityContext = &v
+	}
+	if r.Data != nil {
+		v := *r.Data
+		out.Data = &v
+	}
+	if r.DataElement != nil {
+		v := r.DataElement.DeepCopy()
+		out.DataElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Binary) Equal(other Binary) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if r.ContentType != other.ContentType {
+		return false
+	}
+	if (r.ContentTypeElement == nil) != (other.ContentTypeElement == nil) || r.ContentTypeElement != nil && !r.ContentTypeElement.Equal(*other.ContentTypeElement) {
+		return false
+	}
+	if (r.SecurityContext == nil) != (other.SecurityContext == nil) || r.SecurityContext != nil && !r.SecurityContext.Equal(*other.SecurityContext) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && *r.Data != *other.Data {
+		return false
+	}
+	if (r.DataElement == nil) != (other.DataElement == nil) || r.DataElement != nil && !r.DataElement.Equal(*other.DataElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Binary) EqualsDeep(other Binary) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if !equivalentString(r.ContentType, other.ContentType) {
+		return false
+	}
+	if (r.ContentTypeElement == nil) != (other.ContentTypeElement == nil) || r.ContentTypeElement != nil && !r.ContentTypeElement.EqualsDeep(*other.ContentTypeElement) {
+		return false
+	}
+	if (r.SecurityContext == nil) != (other.SecurityContext == nil) || r.SecurityContext != nil && !r.SecurityContext.EqualsDeep(*other.SecurityContext) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && !equivalentString(*r.Data, *other.Data) {
+		return false
+	}
+	if (r.DataElement == nil) != (other.DataElement == nil) || r.DataElement != nil && !r.DataElement.EqualsDeep(*other.DataElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Binary) EqualsShallow(other Binary) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.ContentType, other.ContentType) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && !equivalentString(*r.Data, *other.Data) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Binary as FHIR XML
+func (r Binary) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Binary",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "contentType", r.ContentType, r.ContentTypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "securityContext", r.SecurityContext); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "data", r.Data, r.DataElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Binary from FHIR XML
+func (r *Binary) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "contentType":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ContentType = v
+				}
+				r.ContentTypeElement = element
+			case "securityContext":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SecurityContext = &v
+			case "data":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Data = &v
+				}
+				r.DataElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Binary as properties to the RDF node n
+func (r Binary) turtle(n *turtleNode) {
+	n.resource("Binary", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.primitive("Binary.contentType", -1, r.ContentType, r.ContentTypeElement, "string")
+	n.element("Binary.securityContext", -1, r.SecurityContext)
+	n.primitive("Binary.data", -1, r.Data, r.DataElement, "base64Binary")
+}
+
+// MarshalBSON marshals the given Binary as BSON document with the structure of its FHIR JSON
+func (r Binary) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Binary from a BSON document with the structure of its FHIR JSON
+func (r *Binary) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Binary as protobuf message Binary of fhir.proto
+func (r Binary) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Binary to the encoded message
+func (r Binary) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.ContentType != "" {
+		e.string(5, r.ContentType)
+	}
+	if r.SecurityContext != nil {
+		e.message(6, *r.SecurityContext)
+	}
+	if r.Data != nil {
+		e.string(7, *r.Data)
+	}
+	if r.IdElement != nil {
+		e.message(8, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(9, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(10, *r.LanguageElement)
+	}
+	if r.ContentTypeElement != nil {
+		e.message(11, *r.ContentTypeElement)
+	}
+	if r.DataElement != nil {
+		e.message(12, *r.DataElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Binary from protobuf message Binary of fhir.proto
+func (r *Binary) UnmarshalProto(b []byte) error {
+	*r = Binary{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			r.ContentType = d.string()
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.SecurityContext = &v
+		case 7:
+			v := d.string()
+			r.Data = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ContentTypeElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.DataElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalBinary unmarshals a Binary.
 func UnmarshalBinary(b []byte) (Binary, error) {
 	var binary Binary
-	if err := json.Unmarshal(b, &binary); err != nil {
+	if err := binary.UnmarshalJSON(b); err != nil {
 		return binary, err
 	}
 	return binary, nil
@@ -14,85 +14,3386 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // BiologicallyDerivedProduct is documented here http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct
 type BiologicallyDerivedProduct struct {
-	Id                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	ProductCategory   *BiologicallyDerivedProductCategory     `bson:"productCategory,omitempty" json:"productCategory,omitempty"`
-	ProductCode       *CodeableConcept                        `bson:"productCode,omitempty" json:"productCode,omitempty"`
-	Status            *BiologicallyDerivedProductStatus       `bson:"status,omitempty" json:"status,omitempty"`
-	Request           []Reference                             `bson:"request,omitempty" json:"request,omitempty"`
-	Quantity          *int                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Parent            []Reference                             `bson:"parent,omitempty" json:"parent,omitempty"`
-	Collection        *BiologicallyDerivedProductCollection   `bson:"collection,omitempty" json:"collection,omitempty"`
-	Processing        []BiologicallyDerivedProductProcessing  `bson:"processing,omitempty" json:"processing,omitempty"`
-	Manipulation      *BiologicallyDerivedProductManipulation `bson:"manipulation,omitempty" json:"manipulation,omitempty"`
-	Storage           []BiologicallyDerivedProductStorage     `bson:"storage,omitempty" json:"storage,omitempty"`
+	Id                     *string                                 `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element                                `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                   *Meta                                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                                `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage                       `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier                            `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ProductCategory        *BiologicallyDerivedProductCategory     `bson:"productCategory,omitempty" json:"productCategory,omitempty"`
+	ProductCategoryElement *Element                                `bson:"_productCategory,omitempty" json:"_productCategory,omitempty"`
+	ProductCode            *CodeableConcept                        `bson:"productCode,omitempty" json:"productCode,omitempty"`
+	Status                 *BiologicallyDerivedProductStatus       `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement          *Element                                `bson:"_status,omitempty" json:"_status,omitempty"`
+	Request                []Reference                             `bson:"request,omitempty" json:"request,omitempty"`
+	Quantity               *int                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	QuantityElement        *Element                                `bson:"_quantity,omitempty" json:"_quantity,omitempty"`
+	Parent                 []Reference                             `bson:"parent,omitempty" json:"parent,omitempty"`
+	Collection             *BiologicallyDerivedProductCollection   `bson:"collection,omitempty" json:"collection,omitempty"`
+	Processing             []BiologicallyDerivedProductProcessing  `bson:"processing,omitempty" json:"processing,omitempty"`
+	Manipulation           *BiologicallyDerivedProductManipulation `bson:"manipulation,omitempty" json:"manipulation,omitempty"`
+	Storage                []BiologicallyDerivedProductStorage     `bson:"storage,omitempty" json:"storage,omitempty"`
 }
 type BiologicallyDerivedProductCollection struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Collector         *Reference  `bson:"collector,omitempty" json:"collector,omitempty"`
-	Source            *Reference  `bson:"source,omitempty" json:"source,omitempty"`
-	CollectedDateTime *string     `bson:"collectedDateTime,omitempty" json:"collectedDateTime,omitempty"`
-	CollectedPeriod   *Period     `bson:"collectedPeriod,omitempty" json:"collectedPeriod,omitempty"`
+	Id                       *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Collector                *Reference  `bson:"collector,omitempty" json:"collector,omitempty"`
+	Source                   *Reference  `bson:"source,omitempty" json:"source,omitempty"`
+	CollectedDateTime        *string     `bson:"collectedDateTime,omitempty" json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement *Element    `bson:"_collectedDateTime,omitempty" json:"_collectedDateTime,omitempty"`
+	CollectedPeriod          *Period     `bson:"collectedPeriod,omitempty" json:"collectedPeriod,omitempty"`
 }
+
+// BiologicallyDerivedProductCollectionCollected is implemented by the types allowed for BiologicallyDerivedProduct.collection.collected[x]
+type BiologicallyDerivedProductCollectionCollected interface {
+	isBiologicallyDerivedProductCollectionCollected()
+}
+
+func (DateTime) isBiologicallyDerivedProductCollectionCollected() {}
+func (Period) isBiologicallyDerivedProductCollectionCollected()   {}
+
+// Collected returns the value of BiologicallyDerivedProduct.collection.collected[x] together with its FHIR type code or nil if no value is set
+func (r BiologicallyDerivedProductCollection) Collected() (BiologicallyDerivedProductCollectionCollected, string) {
+	if r.CollectedDateTime != nil {
+		return DateTime(*r.CollectedDateTime), "dateTime"
+	}
+	if r.CollectedPeriod != nil {
+		return *r.CollectedPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetCollected sets the value of BiologicallyDerivedProduct.collection.collected[x], given as value or pointer, and clears all other types
+func (r *BiologicallyDerivedProductCollection) SetCollected(value BiologicallyDerivedProductCollectionCollected) {
+	r.CollectedDateTime = nil
+	r.CollectedPeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.CollectedDateTime = &primitive
+	case *DateTime:
+		r.CollectedDateTime = (*string)(v)
+	case Period:
+		r.CollectedPeriod = &v
+	case *Period:
+		r.CollectedPeriod = v
+	}
+}
+
 type BiologicallyDerivedProductProcessing struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
-	Procedure         *CodeableConcept `bson:"procedure,omitempty" json:"procedure,omitempty"`
-	Additive          *Reference       `bson:"additive,omitempty" json:"additive,omitempty"`
-	TimeDateTime      *string          `bson:"timeDateTime,omitempty" json:"timeDateTime,omitempty"`
-	TimePeriod        *Period          `bson:"timePeriod,omitempty" json:"timePeriod,omitempty"`
+	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description         *string          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element         `bson:"_description,omitempty" json:"_description,omitempty"`
+	Procedure           *CodeableConcept `bson:"procedure,omitempty" json:"procedure,omitempty"`
+	Additive            *Reference       `bson:"additive,omitempty" json:"additive,omitempty"`
+	TimeDateTime        *string          `bson:"timeDateTime,omitempty" json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *Element         `bson:"_timeDateTime,omitempty" json:"_timeDateTime,omitempty"`
+	TimePeriod          *Period          `bson:"timePeriod,omitempty" json:"timePeriod,omitempty"`
+}
+
+// BiologicallyDerivedProductProcessingTime is implemented by the types allowed for BiologicallyDerivedProduct.processing.time[x]
+type BiologicallyDerivedProductProcessingTime interface {
+	isBiologicallyDerivedProductProcessingTime()
+}
+
+func (DateTime) isBiologicallyDerivedProductProcessingTime() {}
+func (Period) isBiologicallyDerivedProductProcessingTime()   {}
+
+// Time returns the value of BiologicallyDerivedProduct.processing.time[x] together with its FHIR type code or nil if no value is set
+func (r BiologicallyDerivedProductProcessing) Time() (BiologicallyDerivedProductProcessingTime, string) {
+	if r.TimeDateTime != nil {
+		return DateTime(*r.TimeDateTime), "dateTime"
+	}
+	if r.TimePeriod != nil {
+		return *r.TimePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetTime sets the value of BiologicallyDerivedProduct.processing.time[x], given as value or pointer, and clears all other types
+func (r *BiologicallyDerivedProductProcessing) SetTime(value BiologicallyDerivedProductProcessingTime) {
+	r.TimeDateTime = nil
+	r.TimePeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.TimeDateTime = &primitive
+	case *DateTime:
+		r.TimeDateTime = (*string)(v)
+	case Period:
+		r.TimePeriod = &v
+	case *Period:
+		r.TimePeriod = v
+	}
 }
+
 type BiologicallyDerivedProductManipulation struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
-	TimeDateTime      *string     `bson:"timeDateTime,omitempty" json:"timeDateTime,omitempty"`
-	TimePeriod        *Period     `bson:"timePeriod,omitempty" json:"timePeriod,omitempty"`
+	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description         *string     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	TimeDateTime        *string     `bson:"timeDateTime,omitempty" json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *Element    `bson:"_timeDateTime,omitempty" json:"_timeDateTime,omitempty"`
+	TimePeriod          *Period     `bson:"timePeriod,omitempty" json:"timePeriod,omitempty"`
+}
+
+// BiologicallyDerivedProductManipulationTime is implemented by the types allowed for BiologicallyDerivedProduct.manipulation.time[x]
+type BiologicallyDerivedProductManipulationTime interface {
+	isBiologicallyDerivedProductManipulationTime()
+}
+
+func (DateTime) isBiologicallyDerivedProductManipulationTime() {}
+func (Period) isBiologicallyDerivedProductManipulationTime()   {}
+
+// Time returns the value of BiologicallyDerivedProduct.manipulation.time[x] together with its FHIR type code or nil if no value is set
+func (r BiologicallyDerivedProductManipulation) Time() (BiologicallyDerivedProductManipulationTime, string) {
+	if r.TimeDateTime != nil {
+		return DateTime(*r.TimeDateTime), "dateTime"
+	}
+	if r.TimePeriod != nil {
+		return *r.TimePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetTime sets the value of BiologicallyDerivedProduct.manipulation.time[x], given as value or pointer, and clears all other types
+func (r *BiologicallyDerivedProductManipulation) SetTime(value BiologicallyDerivedProductManipulationTime) {
+	r.TimeDateTime = nil
+	r.TimePeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.TimeDateTime = &primitive
+	case *DateTime:
+		r.TimeDateTime = (*string)(v)
+	case Period:
+		r.TimePeriod = &v
+	case *Period:
+		r.TimePeriod = v
+	}
 }
+
 type BiologicallyDerivedProductStorage struct {
-	Id                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string                                 `bson:"description,omitempty" json:"description,omitempty"`
-	Temperature       *json.Number                            `bson:"temperature,omitempty" json:"temperature,omitempty"`
-	Scale             *BiologicallyDerivedProductStorageScale `bson:"scale,omitempty" json:"scale,omitempty"`
-	Duration          *Period                                 `bson:"duration,omitempty" json:"duration,omitempty"`
+	Id                 *string                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        *string                                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                                `bson:"_description,omitempty" json:"_description,omitempty"`
+	Temperature        *json.Number                            `bson:"temperature,omitempty" json:"temperature,omitempty"`
+	TemperatureElement *Element                                `bson:"_temperature,omitempty" json:"_temperature,omitempty"`
+	Scale              *BiologicallyDerivedProductStorageScale `bson:"scale,omitempty" json:"scale,omitempty"`
+	ScaleElement       *Element                                `bson:"_scale,omitempty" json:"_scale,omitempty"`
+	Duration           *Period                                 `bson:"duration,omitempty" json:"duration,omitempty"`
 }
-type OtherBiologicallyDerivedProduct BiologicallyDerivedProduct
 
 // MarshalJSON marshals the given BiologicallyDerivedProduct as JSON into a byte slice
 func (r BiologicallyDerivedProduct) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherBiologicallyDerivedProduct
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherBiologicallyDerivedProduct: OtherBiologicallyDerivedProduct(r),
-		ResourceType:                    "BiologicallyDerivedProduct",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BiologicallyDerivedProduct as JSON object with the members in the order of the fields
+func (r BiologicallyDerivedProduct) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"BiologicallyDerivedProduct\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ProductCategory != nil {
+		e.key("productCategory")
+		e.string(r.ProductCategory.Code())
+	}
+	if r.ProductCategoryElement != nil {
+		e.key("_productCategory")
+		r.ProductCategoryElement.appendJSON(e)
+	}
+	if r.ProductCode != nil {
+		e.key("productCode")
+		r.ProductCode.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.Request) > 0 {
+		e.key("request")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Request {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		e.int(*r.Quantity)
+	}
+	if r.QuantityElement != nil {
+		e.key("_quantity")
+		r.QuantityElement.appendJSON(e)
+	}
+	if len(r.Parent) > 0 {
+		e.key("parent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Collection != nil {
+		e.key("collection")
+		r.Collection.appendJSON(e)
+	}
+	if len(r.Processing) > 0 {
+		e.key("processing")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Processing {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Manipulation != nil {
+		e.key("manipulation")
+		r.Manipulation.appendJSON(e)
+	}
+	if len(r.Storage) > 0 {
+		e.key("storage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Storage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BiologicallyDerivedProduct from JSON. Unknown members are ignored.
+func (r *BiologicallyDerivedProduct) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BiologicallyDerivedProduct
+func (r *BiologicallyDerivedProduct) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "productCategory":
+			if d.null() {
+				r.ProductCategory = nil
+			} else {
+				var v BiologicallyDerivedProductCategory
+				d.unmarshal(&v)
+				r.ProductCategory = &v
+			}
+		case "_productCategory":
+			r.ProductCategoryElement = d.element()
+		case "productCode":
+			if d.null() {
+				r.ProductCode = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProductCode = &v
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v BiologicallyDerivedProductStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "request":
+			a := d.array()
+			r.Request = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Request = append(r.Request, v)
+			}
+			if r.Request == nil && !a.isNull {
+				r.Request = []Reference{}
+			}
+		case "quantity":
+			if v, ok := d.int(); ok {
+				r.Quantity = &v
+			} else {
+				r.Quantity = nil
+			}
+		case "_quantity":
+			r.QuantityElement = d.element()
+		case "parent":
+			a := d.array()
+			r.Parent = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Parent = append(r.Parent, v)
+			}
+			if r.Parent == nil && !a.isNull {
+				r.Parent = []Reference{}
+			}
+		case "collection":
+			if d.null() {
+				r.Collection = nil
+			} else {
+				var v BiologicallyDerivedProductCollection
+				v.decodeJSON(d)
+				r.Collection = &v
+			}
+		case "processing":
+			a := d.array()
+			r.Processing = nil
+			for a.next() {
+				var v BiologicallyDerivedProductProcessing
+				v.decodeJSON(d)
+				r.Processing = append(r.Processing, v)
+			}
+			if r.Processing == nil && !a.isNull {
+				r.Processing = []BiologicallyDerivedProductProcessing{}
+			}
+		case "manipulation":
+			if d.null() {
+				r.Manipulation = nil
+			} else {
+				var v BiologicallyDerivedProductManipulation
+				v.decodeJSON(d)
+				r.Manipulation = &v
+			}
+		case "storage":
+			a := d.array()
+			r.Storage = nil
+			for a.next() {
+				var v BiologicallyDerivedProductStorage
+				v.decodeJSON(d)
+				r.Storage = append(r.Storage, v)
+			}
+			if r.Storage == nil && !a.isNull {
+				r.Storage = []BiologicallyDerivedProductStorage{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BiologicallyDerivedProduct which shares no memory with the original
+func (r BiologicallyDerivedProduct) DeepCopy() BiologicallyDerivedProduct {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.ProductCategory != nil {
+		v := *r.ProductCategory
+		out.ProductCategory = &v
+	}
+	if r.ProductCategoryElement != nil {
+		v := r.ProductCategoryElement.DeepCopy()
+		out.ProductCategoryElement = &v
+	}
+	if r.ProductCode != nil {
+		v := r.ProductCode.DeepCopy()
+		out.ProductCode = &v
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Request != nil {
+		out.Request = make([]Reference, len(r.Request))
+		for i := range r.Request {
+			out.Request[i] = r.Request[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := *r.Quantity
+		out.Quantity = &v
+	}
+	if r.QuantityElement != nil {
+		v := r.QuantityElement.DeepCopy()
+		out.QuantityElement = &v
+	}
+	if r.Parent != nil {
+		out.Parent = make([]Reference, len(r.Parent))
+		for i := range r.Parent {
+			out.Parent[i] = r.Parent[i].DeepCopy()
+		}
+	}
+	if r.Collection != nil {
+		v := r.Collection.DeepCopy()
+		out.Collection = &v
+	}
+	if r.Processing != nil {
+		out.Processing = make([]BiologicallyDerivedProductProcessing, len(r.Processing))
+		for i := range r.Processing {
+			out.Processing[i] = r.Processing[i].DeepCopy()
+		}
+	}
+	if r.Manipulation != nil {
+		v := r.Manipulation.DeepCopy()
+		out.Manipulation = &v
+	}
+	if r.Storage != nil {
+		out.Storage = make([]BiologicallyDerivedProductStorage, len(r.Storage))
+		for i := range r.Storage {
+			out.Storage[i] = r.Storage[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BiologicallyDerivedProduct) Equal(other BiologicallyDerivedProduct) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.ProductCategory == nil) != (other.ProductCategory == nil) || r.ProductCategory != nil && *r.ProductCategory != *other.ProductCategory {
+		return false
+	}
+	if (r.ProductCategoryElement == nil) != (other.ProductCategoryElement == nil) || r.ProductCategoryElement != nil && !r.ProductCategoryElement.Equal(*other.ProductCategoryElement) {
+		return false
+	}
+	if (r.ProductCode == nil) != (other.ProductCode == nil) || r.ProductCode != nil && !r.ProductCode.Equal(*other.ProductCode) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.Request) != len(other.Request) {
+		return false
+	}
+	for i := range r.Request {
+		if !r.Request[i].Equal(other.Request[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && *r.Quantity != *other.Quantity {
+		return false
+	}
+	if (r.QuantityElement == nil) != (other.QuantityElement == nil) || r.QuantityElement != nil && !r.QuantityElement.Equal(*other.QuantityElement) {
+		return false
+	}
+	if len(r.Parent) != len(other.Parent) {
+		return false
+	}
+	for i := range r.Parent {
+		if !r.Parent[i].Equal(other.Parent[i]) {
+			return false
+		}
+	}
+	if (r.Collection == nil) != (other.Collection == nil) || r.Collection != nil && !r.Collection.Equal(*other.Collection) {
+		return false
+	}
+	if len(r.Processing) != len(other.Processing) {
+		return false
+	}
+	for i := range r.Processing {
+		if !r.Processing[i].Equal(other.Processing[i]) {
+			return false
+		}
+	}
+	if (r.Manipulation == nil) != (other.Manipulation == nil) || r.Manipulation != nil && !r.Manipulation.Equal(*other.Manipulation) {
+		return false
+	}
+	if len(r.Storage) != len(other.Storage) {
+		return false
+	}
+	for i := range r.Storage {
+		if !r.Storage[i].Equal(other.Storage[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BiologicallyDerivedProduct) EqualsDeep(other BiologicallyDerivedProduct) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.ProductCategory == nil) != (other.ProductCategory == nil) || r.ProductCategory != nil && *r.ProductCategory != *other.ProductCategory {
+		return false
+	}
+	if (r.ProductCategoryElement == nil) != (other.ProductCategoryElement == nil) || r.ProductCategoryElement != nil && !r.ProductCategoryElement.EqualsDeep(*other.ProductCategoryElement) {
+		return false
+	}
+	if (r.ProductCode == nil) != (other.ProductCode == nil) || r.ProductCode != nil && !r.ProductCode.EqualsDeep(*other.ProductCode) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.Request), len(other.Request), func(i, j int) bool {
+		return r.Request[i].EqualsDeep(other.Request[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && *r.Quantity != *other.Quantity {
+		return false
+	}
+	if (r.QuantityElement == nil) != (other.QuantityElement == nil) || r.QuantityElement != nil && !r.QuantityElement.EqualsDeep(*other.QuantityElement) {
+		return false
+	}
+	if !equivalentList(len(r.Parent), len(other.Parent), func(i, j int) bool {
+		return r.Parent[i].EqualsDeep(other.Parent[j])
+	}) {
+		return false
+	}
+	if (r.Collection == nil) != (other.Collection == nil) || r.Collection != nil && !r.Collection.EqualsDeep(*other.Collection) {
+		return false
+	}
+	if !equivalentList(len(r.Processing), len(other.Processing), func(i, j int) bool {
+		return r.Processing[i].EqualsDeep(other.Processing[j])
+	}) {
+		return false
+	}
+	if (r.Manipulation == nil) != (other.Manipulation == nil) || r.Manipulation != nil && !r.Manipulation.EqualsDeep(*other.Manipulation) {
+		return false
+	}
+	if !equivalentList(len(r.Storage), len(other.Storage), func(i, j int) bool {
+		return r.Storage[i].EqualsDeep(other.Storage[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BiologicallyDerivedProduct) EqualsShallow(other BiologicallyDerivedProduct) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.ProductCategory == nil) != (other.ProductCategory == nil) || r.ProductCategory != nil && *r.ProductCategory != *other.ProductCategory {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && *r.Quantity != *other.Quantity {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BiologicallyDerivedProduct as FHIR XML
+func (r BiologicallyDerivedProduct) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "BiologicallyDerivedProduct",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "productCategory", r.ProductCategory, r.ProductCategoryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productCode", r.ProductCode); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.Request {
+		if err := encodeXMLElement(e, "request", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "quantity", r.Quantity, r.QuantityElement); err != nil {
+		return err
+	}
+	for _, v := range r.Parent {
+		if err := encodeXMLElement(e, "parent", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "collection", r.Collection); err != nil {
+		return err
+	}
+	for _, v := range r.Processing {
+		if err := encodeXMLElement(e, "processing", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "manipulation", r.Manipulation); err != nil {
+		return err
+	}
+	for _, v := range r.Storage {
+		if err := encodeXMLElement(e, "storage", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BiologicallyDerivedProduct from FHIR XML
+func (r *BiologicallyDerivedProduct) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "productCategory":
+				var v BiologicallyDerivedProductCategory
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ProductCategory = &v
+				}
+				r.ProductCategoryElement = element
+			case "productCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductCode = &v
+			case "status":
+				var v BiologicallyDerivedProductStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "request":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = append(r.Request, v)
+			case "quantity":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Quantity = &v
+				}
+				r.QuantityElement = element
+			case "parent":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parent = append(r.Parent, v)
+			case "collection":
+				var v BiologicallyDerivedProductCollection
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Collection = &v
+			case "processing":
+				var v BiologicallyDerivedProductProcessing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Processing = append(r.Processing, v)
+			case "manipulation":
+				var v BiologicallyDerivedProductManipulation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manipulation = &v
+			case "storage":
+				var v BiologicallyDerivedProductStorage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Storage = append(r.Storage, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BiologicallyDerivedProduct as properties to the RDF node n
+func (r BiologicallyDerivedProduct) turtle(n *turtleNode) {
+	n.resource("BiologicallyDerivedProduct", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("BiologicallyDerivedProduct.identifier", i, v)
+	}
+	n.primitive("BiologicallyDerivedProduct.productCategory", -1, r.ProductCategory, r.ProductCategoryElement, "code")
+	n.element("BiologicallyDerivedProduct.productCode", -1, r.ProductCode)
+	n.primitive("BiologicallyDerivedProduct.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.Request {
+		n.element("BiologicallyDerivedProduct.request", i, v)
+	}
+	n.primitive("BiologicallyDerivedProduct.quantity", -1, r.Quantity, r.QuantityElement, "integer")
+	for i, v := range r.Parent {
+		n.element("BiologicallyDerivedProduct.parent", i, v)
+	}
+	n.element("BiologicallyDerivedProduct.collection", -1, r.Collection)
+	for i, v := range r.Processing {
+		n.element("BiologicallyDerivedProduct.processing", i, v)
+	}
+	n.element("BiologicallyDerivedProduct.manipulation", -1, r.Manipulation)
+	for i, v := range r.Storage {
+		n.element("BiologicallyDerivedProduct.storage", i, v)
+	}
+}
+
+// MarshalBSON marshals the given BiologicallyDerivedProduct as BSON document with the structure of its FHIR JSON
+func (r BiologicallyDerivedProduct) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BiologicallyDerivedProduct from a BSON document with the structure of its FHIR JSON
+func (r *BiologicallyDerivedProduct) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BiologicallyDerivedProduct as protobuf message BiologicallyDerivedProduct of fhir.proto
+func (r BiologicallyDerivedProduct) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BiologicallyDerivedProduct to the encoded message
+func (r BiologicallyDerivedProduct) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.ProductCategory != nil {
+		e.varint(10, r.ProductCategory.protoNumber())
+	}
+	if r.ProductCode != nil {
+		e.message(11, *r.ProductCode)
+	}
+	if r.Status != nil {
+		e.varint(12, r.Status.protoNumber())
+	}
+	for _, v := range r.Request {
+		e.message(13, v)
+	}
+	if r.Quantity != nil {
+		e.varint(14, uint64(int64(*r.Quantity)))
+	}
+	for _, v := range r.Parent {
+		e.message(15, v)
+	}
+	if r.Collection != nil {
+		e.message(16, *r.Collection)
+	}
+	for _, v := range r.Processing {
+		e.message(17, v)
+	}
+	if r.Manipulation != nil {
+		e.message(18, *r.Manipulation)
+	}
+	for _, v := range r.Storage {
+		e.message(19, v)
+	}
+	if r.IdElement != nil {
+		e.message(20, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(21, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(22, *r.LanguageElement)
+	}
+	if r.ProductCategoryElement != nil {
+		e.message(23, *r.ProductCategoryElement)
+	}
+	if r.StatusElement != nil {
+		e.message(24, *r.StatusElement)
+	}
+	if r.QuantityElement != nil {
+		e.message(25, *r.QuantityElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BiologicallyDerivedProduct from protobuf message BiologicallyDerivedProduct of fhir.proto
+func (r *BiologicallyDerivedProduct) UnmarshalProto(b []byte) error {
+	*r = BiologicallyDerivedProduct{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v BiologicallyDerivedProductCategory
+			d.enum(d.varint(), v.setProtoNumber)
+			r.ProductCategory = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductCode = &v
+		case 12:
+			var v BiologicallyDerivedProductStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Request = append(r.Request, v)
+		case 14:
+			v := d.int()
+			r.Quantity = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Parent = append(r.Parent, v)
+		case 16:
+			var v BiologicallyDerivedProductCollection
+			d.message(&v)
+			r.Collection = &v
+		case 17:
+			var v BiologicallyDerivedProductProcessing
+			d.message(&v)
+			r.Processing = append(r.Processing, v)
+		case 18:
+			var v BiologicallyDerivedProductManipulation
+			d.message(&v)
+			r.Manipulation = &v
+		case 19:
+			var v BiologicallyDerivedProductStorage
+			d.message(&v)
+			r.Storage = append(r.Storage, v)
+		case 20:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ProductCategoryElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.QuantityElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given BiologicallyDerivedProductCollection as JSON into a byte slice
+func (r BiologicallyDerivedProductCollection) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BiologicallyDerivedProductCollection as JSON object with the members in the order of the fields
+func (r BiologicallyDerivedProductCollection) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Collector != nil {
+		e.key("collector")
+		r.Collector.appendJSON(e)
+	}
+	if r.Source != nil {
+		e.key("source")
+		r.Source.appendJSON(e)
+	}
+	if r.CollectedDateTime != nil {
+		e.key("collectedDateTime")
+		e.string(*r.CollectedDateTime)
+	}
+	if r.CollectedDateTimeElement != nil {
+		e.key("_collectedDateTime")
+		r.CollectedDateTimeElement.appendJSON(e)
+	}
+	if r.CollectedPeriod != nil {
+		e.key("collectedPeriod")
+		r.CollectedPeriod.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BiologicallyDerivedProductCollection from JSON. Unknown members are ignored.
+func (r *BiologicallyDerivedProductCollection) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BiologicallyDerivedProductCollection
+func (r *BiologicallyDerivedProductCollection) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "collector":
+			if d.null() {
+				r.Collector = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Collector = &v
+			}
+		case "source":
+			if d.null() {
+				r.Source = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = &v
+			}
+		case "collectedDateTime":
+			if v, ok := d.string(); ok {
+				r.CollectedDateTime = &v
+			} else {
+				r.CollectedDateTime = nil
+			}
+		case "_collectedDateTime":
+			r.CollectedDateTimeElement = d.element()
+		case "collectedPeriod":
+			if d.null() {
+				r.CollectedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.CollectedPeriod = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BiologicallyDerivedProductCollection which shares no memory with the original
+func (r BiologicallyDerivedProductCollection) DeepCopy() BiologicallyDerivedProductCollection {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Collector != nil {
+		v := r.Collector.DeepCopy()
+		out.Collector = &v
+	}
+	if r.Source != nil {
+		v := r.Source.DeepCopy()
+		out.Source = &v
+	}
+	if r.CollectedDateTime != nil {
+		v := *r.CollectedDateTime
+		out.CollectedDateTime = &v
+	}
+	if r.CollectedDateTimeElement != nil {
+		v := r.CollectedDateTimeElement.DeepCopy()
+		out.CollectedDateTimeElement = &v
+	}
+	if r.CollectedPeriod != nil {
+		v := r.CollectedPeriod.DeepCopy()
+		out.CollectedPeriod = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BiologicallyDerivedProductCollection) Equal(other BiologicallyDerivedProductCollection) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Collector == nil) != (other.Collector == nil) || r.Collector != nil && !r.Collector.Equal(*other.Collector) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !r.Source.Equal(*other.Source) {
+		return false
+	}
+	if (r.CollectedDateTime == nil) != (other.CollectedDateTime == nil) || r.CollectedDateTime != nil && *r.CollectedDateTime != *other.CollectedDateTime {
+		return false
+	}
+	if (r.CollectedDateTimeElement == nil) != (other.CollectedDateTimeElement == nil) || r.CollectedDateTimeElement != nil && !r.CollectedDateTimeElement.Equal(*other.CollectedDateTimeElement) {
+		return false
+	}
+	if (r.CollectedPeriod == nil) != (other.CollectedPeriod == nil) || r.CollectedPeriod != nil && !r.CollectedPeriod.Equal(*other.CollectedPeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BiologicallyDerivedProductCollection) EqualsDeep(other BiologicallyDerivedProductCollection) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Collector == nil) != (other.Collector == nil) || r.Collector != nil && !r.Collector.EqualsDeep(*other.Collector) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !r.Source.EqualsDeep(*other.Source) {
+		return false
+	}
+	if (r.CollectedDateTime == nil) != (other.CollectedDateTime == nil) || r.CollectedDateTime != nil && !equivalentString(*r.CollectedDateTime, *other.CollectedDateTime) {
+		return false
+	}
+	if (r.CollectedDateTimeElement == nil) != (other.CollectedDateTimeElement == nil) || r.CollectedDateTimeElement != nil && !r.CollectedDateTimeElement.EqualsDeep(*other.CollectedDateTimeElement) {
+		return false
+	}
+	if (r.CollectedPeriod == nil) != (other.CollectedPeriod == nil) || r.CollectedPeriod != nil && !r.CollectedPeriod.EqualsDeep(*other.CollectedPeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BiologicallyDerivedProductCollection) EqualsShallow(other BiologicallyDerivedProductCollection) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.CollectedDateTime == nil) != (other.CollectedDateTime == nil) || r.CollectedDateTime != nil && !equivalentString(*r.CollectedDateTime, *other.CollectedDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BiologicallyDerivedProductCollection as FHIR XML
+func (r BiologicallyDerivedProductCollection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "collector", r.Collector); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "source", r.Source); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "collectedDateTime", r.CollectedDateTime, r.CollectedDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "collectedPeriod", r.CollectedPeriod); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BiologicallyDerivedProductCollection from FHIR XML
+func (r *BiologicallyDerivedProductCollection) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "collector":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Collector = &v
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = &v
+			case "collectedDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CollectedDateTime = &v
+				}
+				r.CollectedDateTimeElement = element
+			case "collectedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CollectedPeriod = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BiologicallyDerivedProductCollection as properties to the RDF node n
+func (r BiologicallyDerivedProductCollection) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("BiologicallyDerivedProduct.collection.collector", -1, r.Collector)
+	n.element("BiologicallyDerivedProduct.collection.source", -1, r.Source)
+	n.primitive("BiologicallyDerivedProduct.collection.collectedDateTime", -1, r.CollectedDateTime, r.CollectedDateTimeElement, "dateTime")
+	n.element("BiologicallyDerivedProduct.collection.collectedPeriod", -1, r.CollectedPeriod)
+}
+
+// MarshalBSON marshals the given BiologicallyDerivedProductCollection as BSON document with the structure of its FHIR JSON
+func (r BiologicallyDerivedProductCollection) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BiologicallyDerivedProductCollection from a BSON document with the structure of its FHIR JSON
+func (r *BiologicallyDerivedProductCollection) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BiologicallyDerivedProductCollection as protobuf message BiologicallyDerivedProductCollection of fhir.proto
+func (r BiologicallyDerivedProductCollection) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BiologicallyDerivedProductCollection to the encoded message
+func (r BiologicallyDerivedProductCollection) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Collector != nil {
+		e.message(4, *r.Collector)
+	}
+	if r.Source != nil {
+		e.message(5, *r.Source)
+	}
+	if r.CollectedDateTime != nil {
+		e.string(6, *r.CollectedDateTime)
+	}
+	if r.CollectedPeriod != nil {
+		e.message(7, *r.CollectedPeriod)
+	}
+	if r.CollectedDateTimeElement != nil {
+		e.message(8, *r.CollectedDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BiologicallyDerivedProductCollection from protobuf message BiologicallyDerivedProductCollection of fhir.proto
+func (r *BiologicallyDerivedProductCollection) UnmarshalProto(b []byte) error {
+	*r = BiologicallyDerivedProductCollection{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Collector = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Source = &v
+		case 6:
+			v := d.string()
+			r.CollectedDateTime = &v
+		case 7:
+			var v Period
+			d.message(&v)
+			r.CollectedPeriod = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.CollectedDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given BiologicallyDerivedProductProcessing as JSON into a byte slice
+func (r BiologicallyDerivedProductProcessing) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BiologicallyDerivedProductProcessing as JSON object with the members in the order of the fields
+func (r BiologicallyDerivedProductProcessing) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Procedure != nil {
+		e.key("procedure")
+		r.Procedure.appendJSON(e)
+	}
+	if r.Additive != nil {
+		e.key("additive")
+		r.Additive.appendJSON(e)
+	}
+	if r.TimeDateTime != nil {
+		e.key("timeDateTime")
+		e.string(*r.TimeDateTime)
+	}
+	if r.TimeDateTimeElement != nil {
+		e.key("_timeDateTime")
+		r.TimeDateTimeElement.appendJSON(e)
+	}
+	if r.TimePeriod != nil {
+		e.key("timePeriod")
+		r.TimePeriod.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BiologicallyDerivedProductProcessing from JSON. Unknown members are ignored.
+func (r *BiologicallyDerivedProductProcessing) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BiologicallyDerivedProductProcessing
+func (r *BiologicallyDerivedProductProcessing) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "procedure":
+			if d.null() {
+				r.Procedure = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Procedure = &v
+			}
+		case "additive":
+			if d.null() {
+				r.Additive = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Additive = &v
+			}
+		case "timeDateTime":
+			if v, ok := d.string(); ok {
+				r.TimeDateTime = &v
+			} else {
+				r.TimeDateTime = nil
+			}
+		case "_timeDateTime":
+			r.TimeDateTimeElement = d.element()
+		case "timePeriod":
+			if d.null() {
+				r.TimePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.TimePeriod = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BiologicallyDerivedProductProcessing which shares no memory with the original
+func (r BiologicallyDerivedProductProcessing) DeepCopy() BiologicallyDerivedProductProcessing {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Procedure != nil {
+		v := r.Procedure.DeepCopy()
+		out.Procedure = &v
+	}
+	if r.Additive != nil {
+		v := r.Additive.DeepCopy()
+		out.Additive = &v
+	}
+	if r.TimeDateTime != nil {
+		v := *r.TimeDateTime
+		out.TimeDateTime = &v
+	}
+	if r.TimeDateTimeElement != nil {
+		v := r.TimeDateTimeElement.DeepCopy()
+		out.TimeDateTimeElement = &v
+	}
+	if r.TimePeriod != nil {
+		v := r.TimePeriod.DeepCopy()
+		out.TimePeriod = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BiologicallyDerivedProductProcessing) Equal(other BiologicallyDerivedProductProcessing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Procedure == nil) != (other.Procedure == nil) || r.Procedure != nil && !r.Procedure.Equal(*other.Procedure) {
+		return false
+	}
+	if (r.Additive == nil) != (other.Additive == nil) || r.Additive != nil && !r.Additive.Equal(*other.Additive) {
+		return false
+	}
+	if (r.TimeDateTime == nil) != (other.TimeDateTime == nil) || r.TimeDateTime != nil && *r.TimeDateTime != *other.TimeDateTime {
+		return false
+	}
+	if (r.TimeDateTimeElement == nil) != (other.TimeDateTimeElement == nil) || r.TimeDateTimeElement != nil && !r.TimeDateTimeElement.Equal(*other.TimeDateTimeElement) {
+		return false
+	}
+	if (r.TimePeriod == nil) != (other.TimePeriod == nil) || r.TimePeriod != nil && !r.TimePeriod.Equal(*other.TimePeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BiologicallyDerivedProductProcessing) EqualsDeep(other BiologicallyDerivedProductProcessing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Procedure == nil) != (other.Procedure == nil) || r.Procedure != nil && !r.Procedure.EqualsDeep(*other.Procedure) {
+		return false
+	}
+	if (r.Additive == nil) != (other.Additive == nil) || r.Additive != nil && !r.Additive.EqualsDeep(*other.Additive) {
+		return false
+	}
+	if (r.TimeDateTime == nil) != (other.TimeDateTime == nil) || r.TimeDateTime != nil && !equivalentString(*r.TimeDateTime, *other.TimeDateTime) {
+		return false
+	}
+	if (r.TimeDateTimeElement == nil) != (other.TimeDateTimeElement == nil) || r.TimeDateTimeElement != nil && !r.TimeDateTimeElement.EqualsDeep(*other.TimeDateTimeElement) {
+		return false
+	}
+	if (r.TimePeriod == nil) != (other.TimePeriod == nil) || r.TimePeriod != nil && !r.TimePeriod.EqualsDeep(*other.TimePeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BiologicallyDerivedProductProcessing) EqualsShallow(other BiologicallyDerivedProductProcessing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.TimeDateTime == nil) != (other.TimeDateTime == nil) || r.TimeDateTime != nil && !equivalentString(*r.TimeDateTime, *other.TimeDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BiologicallyDerivedProductProcessing as FHIR XML
+func (r BiologicallyDerivedProductProcessing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "procedure", r.Procedure); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "additive", r.Additive); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timeDateTime", r.TimeDateTime, r.TimeDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timePeriod", r.TimePeriod); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BiologicallyDerivedProductProcessing from FHIR XML
+func (r *BiologicallyDerivedProductProcessing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "procedure":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Procedure = &v
+			case "additive":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Additive = &v
+			case "timeDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimeDateTime = &v
+				}
+				r.TimeDateTimeElement = element
+			case "timePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimePeriod = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BiologicallyDerivedProductProcessing as properties to the RDF node n
+func (r BiologicallyDerivedProductProcessing) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("BiologicallyDerivedProduct.processing.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("BiologicallyDerivedProduct.processing.procedure", -1, r.Procedure)
+	n.element("BiologicallyDerivedProduct.processing.additive", -1, r.Additive)
+	n.primitive("BiologicallyDerivedProduct.processing.timeDateTime", -1, r.TimeDateTime, r.TimeDateTimeElement, "dateTime")
+	n.element("BiologicallyDerivedProduct.processing.timePeriod", -1, r.TimePeriod)
+}
+
+// MarshalBSON marshals the given BiologicallyDerivedProductProcessing as BSON document with the structure of its FHIR JSON
+func (r BiologicallyDerivedProductProcessing) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BiologicallyDerivedProductProcessing from a BSON document with the structure of its FHIR JSON
+func (r *BiologicallyDerivedProductProcessing) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BiologicallyDerivedProductProcessing as protobuf message BiologicallyDerivedProductProcessing of fhir.proto
+func (r BiologicallyDerivedProductProcessing) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BiologicallyDerivedProductProcessing to the encoded message
+func (r BiologicallyDerivedProductProcessing) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.Procedure != nil {
+		e.message(5, *r.Procedure)
+	}
+	if r.Additive != nil {
+		e.message(6, *r.Additive)
+	}
+	if r.TimeDateTime != nil {
+		e.string(7, *r.TimeDateTime)
+	}
+	if r.TimePeriod != nil {
+		e.message(8, *r.TimePeriod)
+	}
+	if r.DescriptionElement != nil {
+		e.message(9, *r.DescriptionElement)
+	}
+	if r.TimeDateTimeElement != nil {
+		e.message(10, *r.TimeDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BiologicallyDerivedProductProcessing from protobuf message BiologicallyDerivedProductProcessing of fhir.proto
+func (r *BiologicallyDerivedProductProcessing) UnmarshalProto(b []byte) error {
+	*r = BiologicallyDerivedProductProcessing{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Procedure = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Additive = &v
+		case 7:
+			v := d.string()
+			r.TimeDateTime = &v
+		case 8:
+			var v Period
+			d.message(&v)
+			r.TimePeriod = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TimeDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given BiologicallyDerivedProductManipulation as JSON into a byte slice
+func (r BiologicallyDerivedProductManipulation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BiologicallyDerivedProductManipulation as JSON object with the members in the order of the fields
+func (r BiologicallyDerivedProductManipulation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.TimeDateTime != nil {
+		e.key("timeDateTime")
+		e.string(*r.TimeDateTime)
+	}
+	if r.TimeDateTimeElement != nil {
+		e.key("_timeDateTime")
+		r.TimeDateTimeElement.appendJSON(e)
+	}
+	if r.TimePeriod != nil {
+		e.key("timePeriod")
+		r.TimePeriod.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BiologicallyDerivedProductManipulation from JSON. Unknown members are ignored.
+func (r *BiologicallyDerivedProductManipulation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BiologicallyDerivedProductManipulation
+func (r *BiologicallyDerivedProductManipulation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "timeDateTime":
+			if v, ok := d.string(); ok {
+				r.TimeDateTime = &v
+			} else {
+				r.TimeDateTime = nil
+			}
+		case "_timeDateTime":
+			r.TimeDateTimeElement = d.element()
+		case "timePeriod":
+			if d.null() {
+				r.TimePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.TimePeriod = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BiologicallyDerivedProductManipulation which shares no memory with the original
+func (r BiologicallyDerivedProductManipulation) DeepCopy() BiologicallyDerivedProductManipulation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.TimeDateTime != nil {
+		v := *r.TimeDateTime
+		out.TimeDateTime = &v
+	}
+	if r.TimeDateTimeElement != nil {
+		v := r.TimeDateTimeElement.DeepCopy()
+		out.TimeDateTimeElement = &v
+	}
+	if r.TimePeriod != nil {
+		v := r.TimePeriod.DeepCopy()
+		out.TimePeriod = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BiologicallyDerivedProductManipulation) Equal(other BiologicallyDerivedProductManipulation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.TimeDateTime == nil) != (other.TimeDateTime == nil) || r.TimeDateTime != nil && *r.TimeDateTime != *other.TimeDateTime {
+		return false
+	}
+	if (r.TimeDateTimeElement == nil) != (other.TimeDateTimeElement == nil) || r.TimeDateTimeElement != nil && !r.TimeDateTimeElement.Equal(*other.TimeDateTimeElement) {
+		return false
+	}
+	if (r.TimePeriod == nil) != (other.TimePeriod == nil) || r.TimePeriod != nil && !r.TimePeriod.Equal(*other.TimePeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BiologicallyDerivedProductManipulation) EqualsDeep(other BiologicallyDerivedProductManipulation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.TimeDateTime == nil) != (other.TimeDateTime == nil) || r.TimeDateTime != nil && !equivalentString(*r.TimeDateTime, *other.TimeDateTime) {
+		return false
+	}
+	if (r.TimeDateTimeElement == nil) != (other.TimeDateTimeElement == nil) || r.TimeDateTimeElement != nil && !r.TimeDateTimeElement.EqualsDeep(*other.TimeDateTimeElement) {
+		return false
+	}
+	if (r.TimePeriod == nil) != (other.TimePeriod == nil) || r.TimePeriod != nil && !r.TimePeriod.EqualsDeep(*other.TimePeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BiologicallyDerivedProductManipulation) EqualsShallow(other BiologicallyDerivedProductManipulation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.TimeDateTime == nil) != (other.TimeDateTime == nil) || r.TimeDateTime != nil && !equivalentString(*r.TimeDateTime, *other.TimeDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BiologicallyDerivedProductManipulation as FHIR XML
+func (r BiologicallyDerivedProductManipulation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timeDateTime", r.TimeDateTime, r.TimeDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timePeriod", r.TimePeriod); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BiologicallyDerivedProductManipulation from FHIR XML
+func (r *BiologicallyDerivedProductManipulation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "timeDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimeDateTime = &v
+				}
+				r.TimeDateTimeElement = element
+			case "timePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimePeriod = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BiologicallyDerivedProductManipulation as properties to the RDF node n
+func (r BiologicallyDerivedProductManipulation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("BiologicallyDerivedProduct.manipulation.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("BiologicallyDerivedProduct.manipulation.timeDateTime", -1, r.TimeDateTime, r.TimeDateTimeElement, "dateTime")
+	n.element("BiologicallyDerivedProduct.manipulation.timePeriod", -1, r.TimePeriod)
+}
+
+// MarshalBSON marshals the given BiologicallyDerivedProductManipulation as BSON document with the structure of its FHIR JSON
+func (r BiologicallyDerivedProductManipulation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BiologicallyDerivedProductManipulation from a BSON document with the structure of its FHIR JSON
+func (r *BiologicallyDerivedProductManipulation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BiologicallyDerivedProductManipulation as protobuf message BiologicallyDerivedProductManipulation of fhir.proto
+func (r BiologicallyDerivedProductManipulation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BiologicallyDerivedProductManipulation to the encoded message
+func (r BiologicallyDerivedProductManipulation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.TimeDateTime != nil {
+		e.string(5, *r.TimeDateTime)
+	}
+	if r.TimePeriod != nil {
+		e.message(6, *r.TimePeriod)
+	}
+	if r.DescriptionElement != nil {
+		e.message(7, *r.DescriptionElement)
+	}
+	if r.TimeDateTimeElement != nil {
+		e.message(8, *r.TimeDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BiologicallyDerivedProductManipulation from protobuf message BiologicallyDerivedProductManipulation of fhir.proto
+func (r *BiologicallyDerivedProductManipulation) UnmarshalProto(b []byte) error {
+	*r = BiologicallyDerivedProductManipulation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			v := d.string()
+			r.TimeDateTime = &v
+		case 6:
+			var v Period
+			d.message(&v)
+			r.TimePeriod = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.TimeDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given BiologicallyDerivedProductStorage as JSON into a byte slice
+func (r BiologicallyDerivedProductStorage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BiologicallyDerivedProductStorage as JSON object with the members in the order of the fields
+func (r BiologicallyDerivedProductStorage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Temperature != nil {
+		e.key("temperature")
+		e.number(*r.Temperature)
+	}
+	if r.TemperatureElement != nil {
+		e.key("_temperature")
+		r.TemperatureElement.appendJSON(e)
+	}
+	if r.Scale != nil {
+		e.key("scale")
+		e.string(r.Scale.Code())
+	}
+	if r.ScaleElement != nil {
+		e.key("_scale")
+		r.ScaleElement.appendJSON(e)
+	}
+	if r.Duration != nil {
+		e.key("duration")
+		r.Duration.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BiologicallyDerivedProductStorage from JSON. Unknown members are ignored.
+func (r *BiologicallyDerivedProductStorage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BiologicallyDerivedProductStorage
+func (r *BiologicallyDerivedProductStorage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "temperature":
+			if v, ok := d.number(); ok {
+				r.Temperature = &v
+			} else {
+				r.Temperature = nil
+			}
+		case "_temperature":
+			r.TemperatureElement = d.element()
+		case "scale":
+			if d.null() {
+				r.Scale = nil
+			} else {
+				var v BiologicallyDerivedProductStorageScale
+				d.unmarshal(&v)
+				r.Scale = &v
+			}
+		case "_scale":
+			r.ScaleElement = d.element()
+		case "duration":
+			if d.null() {
+				r.Duration = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Duration = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BiologicallyDerivedProductStorage which shares no memory with the original
+func (r BiologicallyDerivedProductStorage) DeepCopy() BiologicallyDerivedProductStorage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Temperature != nil {
+		v := *r.Temperature
+		out.Temperature = &v
+	}
+	if r.TemperatureElement != nil {
+		v := r.TemperatureElement.DeepCopy()
+		out.TemperatureElement = &v
+	}
+	if r.Scale != nil {
+		v := *r.Scale
+		out.Scale = &v
+	}
+	if r.ScaleElement != nil {
+		v := r.ScaleElement.DeepCopy()
+		out.ScaleElement = &v
+	}
+	if r.Duration != nil {
+		v := r.Duration.DeepCopy()
+		out.Duration = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BiologicallyDerivedProductStorage) Equal(other BiologicallyDerivedProductStorage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Temperature == nil) != (other.Temperature == nil) || r.Temperature != nil && !EqualDecimal(*r.Temperature, *other.Temperature) {
+		return false
+	}
+	if (r.TemperatureElement == nil) != (other.TemperatureElement == nil) || r.TemperatureElement != nil && !r.TemperatureElement.Equal(*other.TemperatureElement) {
+		return false
+	}
+	if (r.Scale == nil) != (other.Scale == nil) || r.Scale != nil && *r.Scale != *other.Scale {
+		return false
+	}
+	if (r.ScaleElement == nil) != (other.ScaleElement == nil) || r.ScaleElement != nil && !r.ScaleElement.Equal(*other.ScaleElement) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.Equal(*other.Duration) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BiologicallyDerivedProductStorage) EqualsDeep(other BiologicallyDerivedProductStorage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Temperature == nil) != (other.Temperature == nil) || r.Temperature != nil && !equivalentDecimal(*r.Temperature, *other.Temperature) {
+		return false
+	}
+	if (r.TemperatureElement == nil) != (other.TemperatureElement == nil) || r.TemperatureElement != nil && !r.TemperatureElement.EqualsDeep(*other.TemperatureElement) {
+		return false
+	}
+	if (r.Scale == nil) != (other.Scale == nil) || r.Scale != nil && *r.Scale != *other.Scale {
+		return false
+	}
+	if (r.ScaleElement == nil) != (other.ScaleElement == nil) || r.ScaleElement != nil && !r.ScaleElement.EqualsDeep(*other.ScaleElement) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.EqualsDeep(*other.Duration) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BiologicallyDerivedProductStorage) EqualsShallow(other BiologicallyDerivedProductStorage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Temperature == nil) != (other.Temperature == nil) || r.Temperature != nil && !equivalentDecimal(*r.Temperature, *other.Temperature) {
+		return false
+	}
+	if (r.Scale == nil) != (other.Scale == nil) || r.Scale != nil && *r.Scale != *other.Scale {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BiologicallyDerivedProductStorage as FHIR XML
+func (r BiologicallyDerivedProductStorage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "temperature", r.Temperature, r.TemperatureElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "scale", r.Scale, r.ScaleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "duration", r.Duration); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BiologicallyDerivedProductStorage from FHIR XML
+func (r *BiologicallyDerivedProductStorage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "temperature":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Temperature = &v
+				}
+				r.TemperatureElement = element
+			case "scale":
+				var v BiologicallyDerivedProductStorageScale
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Scale = &v
+				}
+				r.ScaleElement = element
+			case "duration":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Duration = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BiologicallyDerivedProductStorage as properties to the RDF node n
+func (r BiologicallyDerivedProductStorage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("BiologicallyDerivedProduct.storage.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("BiologicallyDerivedProduct.storage.temperature", -1, r.Temperature, r.TemperatureElement, "decimal")
+	n.primitive("BiologicallyDerivedProduct.storage.scale", -1, r.Scale, r.ScaleElement, "code")
+	n.element("BiologicallyDerivedProduct.storage.duration", -1, r.Duration)
+}
+
+// MarshalBSON marshals the given BiologicallyDerivedProductStorage as BSON document with the structure of its FHIR JSON
+func (r BiologicallyDerivedProductStorage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BiologicallyDerivedProductStorage from a BSON document with the structure of its FHIR JSON
+func (r *BiologicallyDerivedProductStorage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BiologicallyDerivedProductStorage as protobuf message BiologicallyDerivedProductStorage of fhir.proto
+func (r BiologicallyDerivedProductStorage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BiologicallyDerivedProductStorage to the encoded message
+func (r BiologicallyDerivedProductStorage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.Temperature != nil {
+		e.string(5, string(*r.Temperature))
+	}
+	if r.Scale != nil {
+		e.varint(6, r.Scale.protoNumber())
+	}
+	if r.Duration != nil {
+		e.message(7, *r.Duration)
+	}
+	if r.DescriptionElement != nil {
+		e.message(8, *r.DescriptionElement)
+	}
+	if r.TemperatureElement != nil {
+		e.message(9, *r.TemperatureElement)
+	}
+	if r.ScaleElement != nil {
+		e.message(10, *r.ScaleElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BiologicallyDerivedProductStorage from protobuf message BiologicallyDerivedProductStorage of fhir.proto
+func (r *BiologicallyDerivedProductStorage) UnmarshalProto(b []byte) error {
+	*r = BiologicallyDerivedProductStorage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			v := json.Number(d.string())
+			r.Temperature = &v
+		case 6:
+			var v BiologicallyDerivedProductStorageScale
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Scale = &v
+		case 7:
+			var v Period
+			d.message(&v)
+			r.Duration = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.TemperatureElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ScaleElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalBiologicallyDerivedProduct unmarshals a BiologicallyDerivedProduct.
 func UnmarshalBiologicallyDerivedProduct(b []byte) (BiologicallyDerivedProduct, error) {
 	var biologicallyDerivedProduct BiologicallyDerivedProduct
-	if err := json.Unmarshal(b, &biologicallyDerivedProduct); err != nil {
+	if err := biologicallyDerivedProduct.UnmarshalJSON(b); err != nil {
 		return biologicallyDerivedProduct, err
 	}
 	return biologicallyDerivedProduct, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code BiologicallyDerivedProductCategory) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *BiologicallyDerivedProductCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *BiologicallyDerivedProductCategory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "organ":
 		*code = BiologicallyDerivedProductCategoryOrgan
@@ -55,6 +59,12 @@ func (code *BiologicallyDerivedProductCategory) UnmarshalJSON(json []byte) error
 	}
 	return nil
 }
+func (code BiologicallyDerivedProductCategory) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *BiologicallyDerivedProductCategory) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code BiologicallyDerivedProductCategory) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code BiologicallyDerivedProductCategory) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code BiologicallyDerivedProductCategory) protoNumber() uint64 {
+	switch code {
+	case BiologicallyDerivedProductCategoryOrgan:
+		return 1
+	case BiologicallyDerivedProductCategoryTissue:
+		return 2
+	case BiologicallyDerivedProductCategoryFluid:
+		return 3
+	case BiologicallyDerivedProductCategoryCells:
+		return 4
+	case BiologicallyDerivedProductCategoryBiologicalAgent:
+		return 5
+	}
+	return 0
+}
+func (code *BiologicallyDerivedProductCategory) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = BiologicallyDerivedProductCategoryOrgan
+	case 2:
+		*code = BiologicallyDerivedProductCategoryTissue
+	case 3:
+		*code = BiologicallyDerivedProductCategoryFluid
+	case 4:
+		*code = BiologicallyDerivedProductCategoryCells
+	case 5:
+		*code = BiologicallyDerivedProductCategoryBiologicalAgent
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code BiologicallyDerivedProductStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *BiologicallyDerivedProductStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *BiologicallyDerivedProductStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "available":
 		*code = BiologicallyDerivedProductStatusAvailable
@@ -46,6 +50,12 @@ func (code *BiologicallyDerivedProductStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code BiologicallyDerivedProductStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *BiologicallyDerivedProductStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code BiologicallyDerivedProductStatus) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code BiologicallyDerivedProductStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code BiologicallyDerivedProductStatus) protoNumber() uint64 {
+	switch code {
+	case BiologicallyDerivedProductStatusAvailable:
+		return 1
+	case BiologicallyDerivedProductStatusUnavailable:
+		return 2
+	}
+	return 0
+}
+func (code *BiologicallyDerivedProductStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = BiologicallyDerivedProductStatusAvailable
+	case 2:
+		*code = BiologicallyDerivedProductStatusUnavailable
+	default:
+		return false
+	}
+	return true
+}
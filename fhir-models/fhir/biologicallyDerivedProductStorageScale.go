@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code BiologicallyDerivedProductStorageScale) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *BiologicallyDerivedProductStorageScale) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *BiologicallyDerivedProductStorageScale) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "farenheit":
 		*code = BiologicallyDerivedProductStorageScaleFarenheit
@@ -49,6 +53,12 @@ func (code *BiologicallyDerivedProductStorageScale) UnmarshalJSON(json []byte) e
 	}
 	return nil
 }
+func (code BiologicallyDerivedProductStorageScale) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *BiologicallyDerivedProductStorageScale) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code BiologicallyDerivedProductStorageScale) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code BiologicallyDerivedProductStorageScale) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code BiologicallyDerivedProductStorageScale) protoNumber() uint64 {
+	switch code {
+	case BiologicallyDerivedProductStorageScaleFarenheit:
+		return 1
+	case BiologicallyDerivedProductStorageScaleCelsius:
+		return 2
+	case BiologicallyDerivedProductStorageScaleKelvin:
+		return 3
+	}
+	return 0
+}
+func (code *BiologicallyDerivedProductStorageScale) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = BiologicallyDerivedProductStorageScaleFarenheit
+	case 2:
+		*code = BiologicallyDerivedProductStorageScaleCelsius
+	case 3:
+		*code = BiologicallyDerivedProductStorageScaleKelvin
+	default:
+		return false
+	}
+	return true
+}
@@ -14,46 +14,1039 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // BodyStructure is documented here http://hl7.org/fhir/StructureDefinition/BodyStructure
 type BodyStructure struct {
-	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool             `bson:"active,omitempty" json:"active,omitempty"`
-	Morphology        *CodeableConcept  `bson:"morphology,omitempty" json:"morphology,omitempty"`
-	Location          *CodeableConcept  `bson:"location,omitempty" json:"location,omitempty"`
-	LocationQualifier []CodeableConcept `bson:"locationQualifier,omitempty" json:"locationQualifier,omitempty"`
-	Description       *string           `bson:"description,omitempty" json:"description,omitempty"`
-	Image             []Attachment      `bson:"image,omitempty" json:"image,omitempty"`
-	Patient           Reference         `bson:"patient" json:"patient"`
-}
-type OtherBodyStructure BodyStructure
+	Id                   *string           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool             `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element          `bson:"_active,omitempty" json:"_active,omitempty"`
+	Morphology           *CodeableConcept  `bson:"morphology,omitempty" json:"morphology,omitempty"`
+	Location             *CodeableConcept  `bson:"location,omitempty" json:"location,omitempty"`
+	LocationQualifier    []CodeableConcept `bson:"locationQualifier,omitempty" json:"locationQualifier,omitempty"`
+	Description          *string           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element          `bson:"_description,omitempty" json:"_description,omitempty"`
+	Image                []Attachment      `bson:"image,omitempty" json:"image,omitempty"`
+	Patient              Reference         `bson:"patient" json:"patient"`
+}
 
 // MarshalJSON marshals the given BodyStructure as JSON into a byte slice
 func (r BodyStructure) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherBodyStructure
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherBodyStructure: OtherBodyStructure(r),
-		ResourceType:       "BodyStructure",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BodyStructure as JSON object with the members in the order of the fields
+func (r BodyStructure) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"BodyStructure\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Active != nil {
+		e.key("active")
+		e.bool(*r.Active)
+	}
+	if r.ActiveElement != nil {
+		e.key("_active")
+		r.ActiveElement.appendJSON(e)
+	}
+	if r.Morphology != nil {
+		e.key("morphology")
+		r.Morphology.appendJSON(e)
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if len(r.LocationQualifier) > 0 {
+		e.key("locationQualifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LocationQualifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Image) > 0 {
+		e.key("image")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Image {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BodyStructure from JSON. Unknown members are ignored.
+func (r *BodyStructure) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BodyStructure
+func (r *BodyStructure) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "active":
+			if v, ok := d.bool(); ok {
+				r.Active = &v
+			} else {
+				r.Active = nil
+			}
+		case "_active":
+			r.ActiveElement = d.element()
+		case "morphology":
+			if d.null() {
+				r.Morphology = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Morphology = &v
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "locationQualifier":
+			a := d.array()
+			r.LocationQualifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LocationQualifier = append(r.LocationQualifier, v)
+			}
+			if r.LocationQualifier == nil && !a.isNull {
+				r.LocationQualifier = []CodeableConcept{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "image":
+			a := d.array()
+			r.Image = nil
+			for a.next() {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Image = append(r.Image, v)
+			}
+			if r.Image == nil && !a.isNull {
+				r.Image = []Attachment{}
+			}
+		case "patient":
+			r.Patient.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BodyStructure which shares no memory with the original
+func (r BodyStructure) DeepCopy() BodyStructure {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Active != nil {
+		v := *r.Active
+		out.Active = &v
+	}
+	if r.ActiveElement != nil {
+		v := r.ActiveElement.DeepCopy()
+		out.ActiveElement = &v
+	}
+	if r.Morphology != nil {
+		v := r.Morphology.DeepCopy()
+		out.Morphology = &v
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.LocationQualifier != nil {
+		out.LocationQualifier = make([]CodeableConcept, len(r.LocationQualifier))
+		for i := range r.LocationQualifier {
+			out.LocationQualifier[i] = r.LocationQualifier[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Image != nil {
+		out.Image = make([]Attachment, len(r.Image))
+		for i := range r.Image {
+			out.Image[i] = r.Image[i].DeepCopy()
+		}
+	}
+	out.Patient = r.Patient.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BodyStructure) Equal(other BodyStructure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.Equal(*other.ActiveElement) {
+		return false
+	}
+	if (r.Morphology == nil) != (other.Morphology == nil) || r.Morphology != nil && !r.Morphology.Equal(*other.Morphology) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if len(r.LocationQualifier) != len(other.LocationQualifier) {
+		return false
+	}
+	for i := range r.LocationQualifier {
+		if !r.LocationQualifier[i].Equal(other.LocationQualifier[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Image) != len(other.Image) {
+		return false
+	}
+	for i := range r.Image {
+		if !r.Image[i].Equal(other.Image[i]) {
+			return false
+		}
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BodyStructure) EqualsDeep(other BodyStructure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.EqualsDeep(*other.ActiveElement) {
+		return false
+	}
+	if (r.Morphology == nil) != (other.Morphology == nil) || r.Morphology != nil && !r.Morphology.EqualsDeep(*other.Morphology) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if !equivalentList(len(r.LocationQualifier), len(other.LocationQualifier), func(i, j int) bool {
+		return r.LocationQualifier[i].EqualsDeep(other.LocationQualifier[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Image), len(other.Image), func(i, j int) bool {
+		return r.Image[i].EqualsDeep(other.Image[j])
+	}) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BodyStructure) EqualsShallow(other BodyStructure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BodyStructure as FHIR XML
+func (r BodyStructure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "BodyStructure",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "active", r.Active, r.ActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "morphology", r.Morphology); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	for _, v := range r.LocationQualifier {
+		if err := encodeXMLElement(e, "locationQualifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Image {
+		if err := encodeXMLElement(e, "image", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BodyStructure from FHIR XML
+func (r *BodyStructure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "active":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Active = &v
+				}
+				r.ActiveElement = element
+			case "morphology":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Morphology = &v
+			case "location":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "locationQualifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationQualifier = append(r.LocationQualifier, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "image":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Image = append(r.Image, v)
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BodyStructure as properties to the RDF node n
+func (r BodyStructure) turtle(n *turtleNode) {
+	n.resource("BodyStructure", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("BodyStructure.identifier", i, v)
+	}
+	n.primitive("BodyStructure.active", -1, r.Active, r.ActiveElement, "boolean")
+	n.element("BodyStructure.morphology", -1, r.Morphology)
+	n.element("BodyStructure.location", -1, r.Location)
+	for i, v := range r.LocationQualifier {
+		n.element("BodyStructure.locationQualifier", i, v)
+	}
+	n.primitive("BodyStructure.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Image {
+		n.element("BodyStructure.image", i, v)
+	}
+	n.element("BodyStructure.patient", -1, r.Patient)
+}
+
+// MarshalBSON marshals the given BodyStructure as BSON document with the structure of its FHIR JSON
+func (r BodyStructure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BodyStructure from a BSON document with the structure of its FHIR JSON
+func (r *BodyStructure) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BodyStructure as protobuf message BodyStructure of fhir.proto
+func (r BodyStructure) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BodyStructure to the encoded message
+func (r BodyStructure) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Active != nil {
+		e.varint(10, protoBool(*r.Active))
+	}
+	if r.Morphology != nil {
+		e.message(11, *r.Morphology)
+	}
+	if r.Location != nil {
+		e.message(12, *r.Location)
+	}
+	for _, v := range r.LocationQualifier {
+		e.message(13, v)
+	}
+	if r.Description != nil {
+		e.string(14, *r.Description)
+	}
+	for _, v := range r.Image {
+		e.message(15, v)
+	}
+	e.message(16, r.Patient)
+	if r.IdElement != nil {
+		e.message(17, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(18, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(19, *r.LanguageElement)
+	}
+	if r.ActiveElement != nil {
+		e.message(20, *r.ActiveElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(21, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BodyStructure from protobuf message BodyStructure of fhir.proto
+func (r *BodyStructure) UnmarshalProto(b []byte) error {
+	*r = BodyStructure{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.bool()
+			r.Active = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Morphology = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Location = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.LocationQualifier = append(r.LocationQualifier, v)
+		case 14:
+			v := d.string()
+			r.Description = &v
+		case 15:
+			var v Attachment
+			d.message(&v)
+			r.Image = append(r.Image, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.ActiveElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalBodyStructure unmarshals a BodyStructure.
 func UnmarshalBodyStructure(b []byte) (BodyStructure, error) {
 	var bodyStructure BodyStructure
-	if err := json.Unmarshal(b, &bodyStructure); err != nil {
+	if err := bodyStructure.UnmarshalJSON(b); err != nil {
 		return bodyStructure, err
 	}
 	return bodyStructure, nil
@@ -0,0 +1,142 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumBSONValue(t *testing.T) {
+	for _, code := range []AdministrativeGender{AdministrativeGenderMale, AdministrativeGenderFemale,
+		AdministrativeGenderOther, AdministrativeGenderUnknown} {
+		typ, data, err := code.MarshalBSONValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := binary.LittleEndian.AppendUint32(nil, uint32(len(code.Code())+1))
+		want = append(append(want, code.Code()...), 0)
+		if typ != bsonString || !bytes.Equal(data, want) {
+			t.Errorf("%s: got 0x%02X %v, want string %v", code, typ, data, want)
+		}
+		var got AdministrativeGender
+		if err := got.UnmarshalBSONValue(typ, data); err != nil || got != code {
+			t.Errorf("%s: UnmarshalBSONValue = %s, %v", code, got, err)
+		}
+	}
+
+	var code ObservationStatus
+	unknown := append(binary.LittleEndian.AppendUint32(nil, 4), "foo\x00"...)
+	if err := code.UnmarshalBSONValue(bsonString, unknown); err == nil {
+		t.Error("unknown code was accepted")
+	}
+	if err := code.UnmarshalBSONValue(bsonInt32, []byte{1, 0, 0, 0}); err == nil {
+		t.Error("int32 was accepted")
+	}
+	if err := code.UnmarshalBSONValue(bsonString, unknown[:5]); err == nil {
+		t.Error("truncated string was accepted")
+	}
+}
+
+func TestBSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+	}{
+		{"enums", `{"resourceType":"Patient","gender":"female","contact":[{"gender":"other"}],"link":[{"other":{"reference":"Patient/1"},"type":"seealso"}]}`},
+		{"required enum and decimal", `{"resourceType":"Observation","status":"final","code":{"text":"t"},"valueQuantity":{"value":1.50,"comparator":"<"}}`},
+		{"contained resources", `{"resourceType":"Patient","contained":[{"resourceType":"Organization","id":"o","name":"x"},{"resourceType":"Practitioner","id":"p","active":true}],"managingOrganization":{"reference":"#o"}}`},
+		{"bundle entries", `{"resourceType":"Bundle","type":"collection","total":2,"entry":[{"fullUrl":"urn:uuid:1","resource":{"resourceType":"Patient","id":"1","birthDate":"2000"}},{"resource":{"resourceType":"Observation","status":"final","code":{},"valueInteger":-3}}]}`},
+		{"primitive extensions", `{"resourceType":"Patient","name":[{"given":["a","b"],"_given":[null,{"id":"x"}]}],"_birthDate":{"extension":[{"url":"u","valueDecimal":2.0}]}}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resource, err := DecodeResource([]byte(test.resource))
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := resource.(interface{ MarshalBSON() ([]byte, error) }).MarshalBSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bytes.Contains(b, []byte(`"resourceType"`)) {
+				t.Errorf("resources are stored as JSON strings: %q", b)
+			}
+			decoded, err := DecodeResource([]byte(test.resource))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := decoded.(interface{ UnmarshalBSON([]byte) error }).UnmarshalBSON(b); err != nil {
+				t.Fatal(err)
+			}
+			js, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := canonicalJSON(t, js), canonicalJSON(t, []byte(test.resource)); got != want {
+				t.Errorf("got\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestBSONDecimals(t *testing.T) {
+	tests := []struct {
+		value, want string
+		typ         byte
+	}{
+		{"1.50", "1.50", bsonDecimal128},
+		{"100", "100", bsonInt32},
+		{"4294967296", "4294967296", bsonInt64},
+		{"-0", "-0", bsonDecimal128},
+		{"-0.0", "-0.0", bsonDecimal128},
+		{"0.00", "0.00", bsonDecimal128},
+		{"-12.345", "-12.345", bsonDecimal128},
+		{"0.000001", "0.000001", bsonDecimal128},
+		{"1.0E-7", "1.0E-7", bsonDecimal128},
+		{"1e3", "1E+3", bsonDecimal128},
+		{"1.50e+2", "150", bsonDecimal128},
+		{"12345678901234567890123456789012.34", "12345678901234567890123456789012.34", bsonDecimal128},
+		{"1e6111", "1E+6111", bsonDecimal128},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			value := json.Number(test.value)
+			b, err := Quantity{Value: &value}.MarshalBSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the document holds the single element value
+			if typ := b[4]; typ != test.typ {
+				t.Errorf("stored as type 0x%02X, want 0x%02X", typ, test.typ)
+			}
+			var got Quantity
+			if err := got.UnmarshalBSON(b); err != nil {
+				t.Fatal(err)
+			}
+			if got.Value == nil || string(*got.Value) != test.want {
+				t.Errorf("got %v, want %s", got.Value, test.want)
+			}
+		})
+	}
+
+	for _, value := range []json.Number{"1234567890123456789012345678901234.5", "1e6112", "1e-6177"} {
+		if _, err := (Quantity{Value: &value}).MarshalBSON(); err == nil {
+			t.Errorf("%s exceeding Decimal128 was accepted", value)
+		}
+	}
+}
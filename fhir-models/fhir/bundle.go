@@ -14,31 +14,42 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Bundle is documented here http://hl7.org/fhir/StructureDefinition/Bundle
 type Bundle struct {
-	Id            *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta          *Meta         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules *string       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language      *string       `bson:"language,omitempty" json:"language,omitempty"`
-	Identifier    *Identifier   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type          BundleType    `bson:"type" json:"type"`
-	Timestamp     *string       `bson:"timestamp,omitempty" json:"timestamp,omitempty"`
-	Total         *int          `bson:"total,omitempty" json:"total,omitempty"`
-	Link          []BundleLink  `bson:"link,omitempty" json:"link,omitempty"`
-	Entry         []BundleEntry `bson:"entry,omitempty" json:"entry,omitempty"`
-	Signature     *Signature    `bson:"signature,omitempty" json:"signature,omitempty"`
+	Id                   *string       `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element      `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Identifier           *Identifier   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type                 BundleType    `bson:"type" json:"type"`
+	TypeElement          *Element      `bson:"_type,omitempty" json:"_type,omitempty"`
+	Timestamp            *string       `bson:"timestamp,omitempty" json:"timestamp,omitempty"`
+	TimestampElement     *Element      `bson:"_timestamp,omitempty" json:"_timestamp,omitempty"`
+	Total                *int          `bson:"total,omitempty" json:"total,omitempty"`
+	TotalElement         *Element      `bson:"_total,omitempty" json:"_total,omitempty"`
+	Link                 []BundleLink  `bson:"link,omitempty" json:"link,omitempty"`
+	Entry                []BundleEntry `bson:"entry,omitempty" json:"entry,omitempty"`
+	Signature            *Signature    `bson:"signature,omitempty" json:"signature,omitempty"`
 }
 type BundleLink struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Relation          string      `bson:"relation" json:"relation"`
+	RelationElement   *Element    `bson:"_relation,omitempty" json:"_relation,omitempty"`
 	Url               string      `bson:"url" json:"url"`
+	UrlElement        *Element    `bson:"_url,omitempty" json:"_url,omitempty"`
 }
 type BundleEntry struct {
 	Id                *string              `bson:"id,omitempty" json:"id,omitempty"`
@@ -46,6 +57,7 @@ type BundleEntry struct {
 	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Link              []BundleLink         `bson:"link,omitempty" json:"link,omitempty"`
 	FullUrl           *string              `bson:"fullUrl,omitempty" json:"fullUrl,omitempty"`
+	FullUrlElement    *Element             `bson:"_fullUrl,omitempty" json:"_fullUrl,omitempty"`
 	Resource          json.RawMessage      `bson:"resource,omitempty" json:"resource,omitempty"`
 	Search            *BundleEntrySearch   `bson:"search,omitempty" json:"search,omitempty"`
 	Request           *BundleEntryRequest  `bson:"request,omitempty" json:"request,omitempty"`
@@ -56,46 +68,3405 @@ type BundleEntrySearch struct {
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Mode              *SearchEntryMode `bson:"mode,omitempty" json:"mode,omitempty"`
+	ModeElement       *Element         `bson:"_mode,omitempty" json:"_mode,omitempty"`
 	Score             *json.Number     `bson:"score,omitempty" json:"score,omitempty"`
+	ScoreElement      *Element         `bson:"_score,omitempty" json:"_score,omitempty"`
 }
 type BundleEntryRequest struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Method            HTTPVerb    `bson:"method" json:"method"`
-	Url               string      `bson:"url" json:"url"`
-	IfNoneMatch       *string     `bson:"ifNoneMatch,omitempty" json:"ifNoneMatch,omitempty"`
-	IfModifiedSince   *string     `bson:"ifModifiedSince,omitempty" json:"ifModifiedSince,omitempty"`
-	IfMatch           *string     `bson:"ifMatch,omitempty" json:"ifMatch,omitempty"`
-	IfNoneExist       *string     `bson:"ifNoneExist,omitempty" json:"ifNoneExist,omitempty"`
+	Id                     *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Method                 HTTPVerb    `bson:"method" json:"method"`
+	MethodElement          *Element    `bson:"_method,omitempty" json:"_method,omitempty"`
+	Url                    string      `bson:"url" json:"url"`
+	UrlElement             *Element    `bson:"_url,omitempty" json:"_url,omitempty"`
+	IfNoneMatch            *string     `bson:"ifNoneMatch,omitempty" json:"ifNoneMatch,omitempty"`
+	IfNoneMatchElement     *Element    `bson:"_ifNoneMatch,omitempty" json:"_ifNoneMatch,omitempty"`
+	IfModifiedSince        *string     `bson:"ifModifiedSince,omitempty" json:"ifModifiedSince,omitempty"`
+	IfModifiedSinceElement *Element    `bson:"_ifModifiedSince,omitempty" json:"_ifModifiedSince,omitempty"`
+	IfMatch                *string     `bson:"ifMatch,omitempty" json:"ifMatch,omitempty"`
+	IfMatchElement         *Element    `bson:"_ifMatch,omitempty" json:"_ifMatch,omitempty"`
+	IfNoneExist            *string     `bson:"ifNoneExist,omitempty" json:"ifNoneExist,omitempty"`
+	IfNoneExistElement     *Element    `bson:"_ifNoneExist,omitempty" json:"_ifNoneExist,omitempty"`
 }
 type BundleEntryResponse struct {
-	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Status            string          `bson:"status" json:"status"`
-	Location          *string         `bson:"location,omitempty" json:"location,omitempty"`
-	Etag              *string         `bson:"etag,omitempty" json:"etag,omitempty"`
-	LastModified      *string         `bson:"lastModified,omitempty" json:"lastModified,omitempty"`
-	Outcome           json.RawMessage `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	Id                  *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Status              string          `bson:"status" json:"status"`
+	StatusElement       *Element        `bson:"_status,omitempty" json:"_status,omitempty"`
+	Location            *string         `bson:"location,omitempty" json:"location,omitempty"`
+	LocationElement     *Element        `bson:"_location,omitempty" json:"_location,omitempty"`
+	Etag                *string         `bson:"etag,omitempty" json:"etag,omitempty"`
+	EtagElement         *Element        `bson:"_etag,omitempty" json:"_etag,omitempty"`
+	LastModified        *string         `bson:"lastModified,omitempty" json:"lastModified,omitempty"`
+	LastModifiedElement *Element        `bson:"_lastModified,omitempty" json:"_lastModified,omitempty"`
+	Outcome             json.RawMessage `bson:"outcome,omitempty" json:"outcome,omitempty"`
 }
-type OtherBundle Bundle
 
 // MarshalJSON marshals the given Bundle as JSON into a byte slice
 func (r Bundle) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherBundle
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherBundle:  OtherBundle(r),
-		ResourceType: "Bundle",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Bundle as JSON object with the members in the order of the fields
+func (r Bundle) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Bundle\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Timestamp != nil {
+		e.key("timestamp")
+		e.string(*r.Timestamp)
+	}
+	if r.TimestampElement != nil {
+		e.key("_timestamp")
+		r.TimestampElement.appendJSON(e)
+	}
+	if r.Total != nil {
+		e.key("total")
+		e.int(*r.Total)
+	}
+	if r.TotalElement != nil {
+		e.key("_total")
+		r.TotalElement.appendJSON(e)
+	}
+	if len(r.Link) > 0 {
+		e.key("link")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Link {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Entry) > 0 {
+		e.key("entry")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Entry {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Signature != nil {
+		e.key("signature")
+		r.Signature.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Bundle from JSON. Unknown members are ignored.
+func (r *Bundle) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Bundle
+func (r *Bundle) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "timestamp":
+			if v, ok := d.string(); ok {
+				r.Timestamp = &v
+			} else {
+				r.Timestamp = nil
+			}
+		case "_timestamp":
+			r.TimestampElement = d.element()
+		case "total":
+			if v, ok := d.int(); ok {
+				r.Total = &v
+			} else {
+				r.Total = nil
+			}
+		case "_total":
+			r.TotalElement = d.element()
+		case "link":
+			a := d.array()
+			r.Link = nil
+			for a.next() {
+				var v BundleLink
+				v.decodeJSON(d)
+				r.Link = append(r.Link, v)
+			}
+			if r.Link == nil && !a.isNull {
+				r.Link = []BundleLink{}
+			}
+		case "entry":
+			a := d.array()
+			r.Entry = nil
+			for a.next() {
+				var v BundleEntry
+				v.decodeJSON(d)
+				r.Entry = append(r.Entry, v)
+			}
+			if r.Entry == nil && !a.isNull {
+				r.Entry = []BundleEntry{}
+			}
+		case "signature":
+			if d.null() {
+				r.Signature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.Signature = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Bundle which shares no memory with the original
+func (r Bundle) DeepCopy() Bundle {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Timestamp != nil {
+		v := *r.Timestamp
+		out.Timestamp = &v
+	}
+	if r.TimestampElement != nil {
+		v := r.TimestampElement.DeepCopy()
+		out.TimestampElement = &v
+	}
+	if r.Total != nil {
+		v := *r.Total
+		out.Total = &v
+	}
+	if r.TotalElement != nil {
+		v := r.TotalElement.DeepCopy()
+		out.TotalElement = &v
+	}
+	if r.Link != nil {
+		out.Link = make([]BundleLink, len(r.Link))
+		for i := range r.Link {
+			out.Link[i] = r.Link[i].DeepCopy()
+		}
+	}
+	if r.Entry != nil {
+		out.Entry = make([]BundleEntry, len(r.Entry))
+		for i := range r.Entry {
+			out.Entry[i] = r.Entry[i].DeepCopy()
+		}
+	}
+	if r.Signature != nil {
+		v := r.Signature.DeepCopy()
+		out.Signature = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Bundle) Equal(other Bundle) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Timestamp == nil) != (other.Timestamp == nil) || r.Timestamp != nil && *r.Timestamp != *other.Timestamp {
+		return false
+	}
+	if (r.TimestampElement == nil) != (other.TimestampElement == nil) || r.TimestampElement != nil && !r.TimestampElement.Equal(*other.TimestampElement) {
+		return false
+	}
+	if (r.Total == nil) != (other.Total == nil) || r.Total != nil && *r.Total != *other.Total {
+		return false
+	}
+	if (r.TotalElement == nil) != (other.TotalElement == nil) || r.TotalElement != nil && !r.TotalElement.Equal(*other.TotalElement) {
+		return false
+	}
+	if len(r.Link) != len(other.Link) {
+		return false
+	}
+	for i := range r.Link {
+		if !r.Link[i].Equal(other.Link[i]) {
+			return false
+		}
+	}
+	if len(r.Entry) != len(other.Entry) {
+		return false
+	}
+	for i := range r.Entry {
+		if !r.Entry[i].Equal(other.Entry[i]) {
+			return false
+		}
+	}
+	if (r.Signature == nil) != (other.Signature == nil) || r.Signature != nil && !r.Signature.Equal(*other.Signature) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Bundle) EqualsDeep(other Bundle) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Timestamp == nil) != (other.Timestamp == nil) || r.Timestamp != nil && !equivalentString(*r.Timestamp, *other.Timestamp) {
+		return false
+	}
+	if (r.TimestampElement == nil) != (other.TimestampElement == nil) || r.TimestampElement != nil && !r.TimestampElement.EqualsDeep(*other.TimestampElement) {
+		return false
+	}
+	if (r.Total == nil) != (other.Total == nil) || r.Total != nil && *r.Total != *other.Total {
+		return false
+	}
+	if (r.TotalElement == nil) != (other.TotalElement == nil) || r.TotalElement != nil && !r.TotalElement.EqualsDeep(*other.TotalElement) {
+		return false
+	}
+	if !equivalentList(len(r.Link), len(other.Link), func(i, j int) bool {
+		return r.Link[i].EqualsDeep(other.Link[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Entry), len(other.Entry), func(i, j int) bool {
+		return r.Entry[i].EqualsDeep(other.Entry[j])
+	}) {
+		return false
+	}
+	if (r.Signature == nil) != (other.Signature == nil) || r.Signature != nil && !r.Signature.EqualsDeep(*other.Signature) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Bundle) EqualsShallow(other Bundle) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Timestamp == nil) != (other.Timestamp == nil) || r.Timestamp != nil && !equivalentString(*r.Timestamp, *other.Timestamp) {
+		return false
+	}
+	if (r.Total == nil) != (other.Total == nil) || r.Total != nil && *r.Total != *other.Total {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Bundle as FHIR XML
+func (r Bundle) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Bundle",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timestamp", r.Timestamp, r.TimestampElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "total", r.Total, r.TotalElement); err != nil {
+		return err
+	}
+	for _, v := range r.Link {
+		if err := encodeXMLElement(e, "link", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Entry {
+		if err := encodeXMLElement(e, "entry", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "signature", r.Signature); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Bundle from FHIR XML
+func (r *Bundle) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "type":
+				var v BundleType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "timestamp":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Timestamp = &v
+				}
+				r.TimestampElement = element
+			case "total":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Total = &v
+				}
+				r.TotalElement = element
+			case "link":
+				var v BundleLink
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Link = append(r.Link, v)
+			case "entry":
+				var v BundleEntry
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Entry = append(r.Entry, v)
+			case "signature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Signature = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Bundle as properties to the RDF node n
+func (r Bundle) turtle(n *turtleNode) {
+	n.resource("Bundle", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("Bundle.identifier", -1, r.Identifier)
+	n.primitive("Bundle.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("Bundle.timestamp", -1, r.Timestamp, r.TimestampElement, "instant")
+	n.primitive("Bundle.total", -1, r.Total, r.TotalElement, "unsignedInt")
+	for i, v := range r.Link {
+		n.element("Bundle.link", i, v)
+	}
+	for i, v := range r.Entry {
+		n.element("Bundle.entry", i, v)
+	}
+	n.element("Bundle.signature", -1, r.Signature)
+}
+
+// MarshalBSON marshals the given Bundle as BSON document with the structure of its FHIR JSON
+func (r Bundle) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Bundle from a BSON document with the structure of its FHIR JSON
+func (r *Bundle) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Bundle as protobuf message Bundle of fhir.proto
+func (r Bundle) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Bundle to the encoded message
+func (r Bundle) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Identifier != nil {
+		e.message(5, *r.Identifier)
+	}
+	e.varint(6, r.Type.protoNumber())
+	if r.Timestamp != nil {
+		e.string(7, *r.Timestamp)
+	}
+	if r.Total != nil {
+		e.varint(8, uint64(int64(*r.Total)))
+	}
+	for _, v := range r.Link {
+		e.message(9, v)
+	}
+	for _, v := range r.Entry {
+		e.message(10, v)
+	}
+	if r.Signature != nil {
+		e.message(11, *r.Signature)
+	}
+	if r.IdElement != nil {
+		e.message(12, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(13, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(14, *r.LanguageElement)
+	}
+	if r.TypeElement != nil {
+		e.message(15, *r.TypeElement)
+	}
+	if r.TimestampElement != nil {
+		e.message(16, *r.TimestampElement)
+	}
+	if r.TotalElement != nil {
+		e.message(17, *r.TotalElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Bundle from protobuf message Bundle of fhir.proto
+func (r *Bundle) UnmarshalProto(b []byte) error {
+	*r = Bundle{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 6:
+			var v BundleType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 7:
+			v := d.string()
+			r.Timestamp = &v
+		case 8:
+			v := d.int()
+			r.Total = &v
+		case 9:
+			var v BundleLink
+			d.message(&v)
+			r.Link = append(r.Link, v)
+		case 10:
+			var v BundleEntry
+			d.message(&v)
+			r.Entry = append(r.Entry, v)
+		case 11:
+			var v Signature
+			d.message(&v)
+			r.Signature = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.TimestampElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.TotalElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given BundleLink as JSON into a byte slice
+func (r BundleLink) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BundleLink as JSON object with the members in the order of the fields
+func (r BundleLink) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("relation")
+	e.string(r.Relation)
+	if r.RelationElement != nil {
+		e.key("_relation")
+		r.RelationElement.appendJSON(e)
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BundleLink from JSON. Unknown members are ignored.
+func (r *BundleLink) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BundleLink
+func (r *BundleLink) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "relation":
+			if v, ok := d.string(); ok {
+				r.Relation = v
+			}
+		case "_relation":
+			r.RelationElement = d.element()
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BundleLink which shares no memory with the original
+func (r BundleLink) DeepCopy() BundleLink {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.RelationElement != nil {
+		v := r.RelationElement.DeepCopy()
+		out.RelationElement = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BundleLink) Equal(other BundleLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Relation != other.Relation {
+		return false
+	}
+	if (r.RelationElement == nil) != (other.RelationElement == nil) || r.RelationElement != nil && !r.RelationElement.Equal(*other.RelationElement) {
+		return false
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BundleLink) EqualsDeep(other BundleLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Relation, other.Relation) {
+		return false
+	}
+	if (r.RelationElement == nil) != (other.RelationElement == nil) || r.RelationElement != nil && !r.RelationElement.EqualsDeep(*other.RelationElement) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BundleLink) EqualsShallow(other BundleLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Relation, other.Relation) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BundleLink as FHIR XML
+func (r BundleLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "relation", r.Relation, r.RelationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BundleLink from FHIR XML
+func (r *BundleLink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "relation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Relation = v
+				}
+				r.RelationElement = element
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BundleLink as properties to the RDF node n
+func (r BundleLink) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Bundle.link.relation", -1, r.Relation, r.RelationElement, "string")
+	n.primitive("Bundle.link.url", -1, r.Url, r.UrlElement, "string")
+}
+
+// MarshalBSON marshals the given BundleLink as BSON document with the structure of its FHIR JSON
+func (r BundleLink) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BundleLink from a BSON document with the structure of its FHIR JSON
+func (r *BundleLink) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BundleLink as protobuf message BundleLink of fhir.proto
+func (r BundleLink) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BundleLink to the encoded message
+func (r BundleLink) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Relation != "" {
+		e.string(4, r.Relation)
+	}
+	if r.Url != "" {
+		e.string(5, r.Url)
+	}
+	if r.RelationElement != nil {
+		e.message(6, *r.RelationElement)
+	}
+	if r.UrlElement != nil {
+		e.message(7, *r.UrlElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BundleLink from protobuf message BundleLink of fhir.proto
+func (r *BundleLink) UnmarshalProto(b []byte) error {
+	*r = BundleLink{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Relation = d.string()
+		case 5:
+			r.Url = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.RelationElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given BundleEntry as JSON into a byte slice
+func (r BundleEntry) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BundleEntry as JSON object with the members in the order of the fields
+func (r BundleEntry) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Link) > 0 {
+		e.key("link")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Link {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.FullUrl != nil {
+		e.key("fullUrl")
+		e.string(*r.FullUrl)
+	}
+	if r.FullUrlElement != nil {
+		e.key("_fullUrl")
+		r.FullUrlElement.appendJSON(e)
+	}
+	if len(r.Resource) > 0 {
+		e.key("resource")
+		e.raw(r.Resource)
+	}
+	if r.Search != nil {
+		e.key("search")
+		r.Search.appendJSON(e)
+	}
+	if r.Request != nil {
+		e.key("request")
+		r.Request.appendJSON(e)
+	}
+	if r.Response != nil {
+		e.key("response")
+		r.Response.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BundleEntry from JSON. Unknown members are ignored.
+func (r *BundleEntry) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BundleEntry
+func (r *BundleEntry) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "link":
+			a := d.array()
+			r.Link = nil
+			for a.next() {
+				var v BundleLink
+				v.decodeJSON(d)
+				r.Link = append(r.Link, v)
+			}
+			if r.Link == nil && !a.isNull {
+				r.Link = []BundleLink{}
+			}
+		case "fullUrl":
+			if v, ok := d.string(); ok {
+				r.FullUrl = &v
+			} else {
+				r.FullUrl = nil
+			}
+		case "_fullUrl":
+			r.FullUrlElement = d.element()
+		case "resource":
+			r.Resource = d.raw()
+		case "search":
+			if d.null() {
+				r.Search = nil
+			} else {
+				var v BundleEntrySearch
+				v.decodeJSON(d)
+				r.Search = &v
+			}
+		case "request":
+			if d.null() {
+				r.Request = nil
+			} else {
+				var v BundleEntryRequest
+				v.decodeJSON(d)
+				r.Request = &v
+			}
+		case "response":
+			if d.null() {
+				r.Response = nil
+			} else {
+				var v BundleEntryResponse
+				v.decodeJSON(d)
+				r.Response = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BundleEntry which shares no memory with the original
+func (r BundleEntry) DeepCopy() BundleEntry {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Link != nil {
+		out.Link = make([]BundleLink, len(r.Link))
+		for i := range r.Link {
+			out.Link[i] = r.Link[i].DeepCopy()
+		}
+	}
+	if r.FullUrl != nil {
+		v := *r.FullUrl
+		out.FullUrl = &v
+	}
+	if r.FullUrlElement != nil {
+		v := r.FullUrlElement.DeepCopy()
+		out.FullUrlElement = &v
+	}
+	if r.Resource != nil {
+		out.Resource = make(json.RawMessage, len(r.Resource))
+		copy(out.Resource, r.Resource)
+	}
+	if r.Search != nil {
+		v := r.Search.DeepCopy()
+		out.Search = &v
+	}
+	if r.Request != nil {
+		v := r.Request.DeepCopy()
+		out.Request = &v
+	}
+	if r.Response != nil {
+		v := r.Response.DeepCopy()
+		out.Response = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BundleEntry) Equal(other BundleEntry) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Link) != len(other.Link) {
+		return false
+	}
+	for i := range r.Link {
+		if !r.Link[i].Equal(other.Link[i]) {
+			return false
+		}
+	}
+	if (r.FullUrl == nil) != (other.FullUrl == nil) || r.FullUrl != nil && *r.FullUrl != *other.FullUrl {
+		return false
+	}
+	if (r.FullUrlElement == nil) != (other.FullUrlElement == nil) || r.FullUrlElement != nil && !r.FullUrlElement.Equal(*other.FullUrlElement) {
+		return false
+	}
+	if !equalResource(r.Resource, other.Resource) {
+		return false
+	}
+	if (r.Search == nil) != (other.Search == nil) || r.Search != nil && !r.Search.Equal(*other.Search) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.Equal(*other.Request) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.Equal(*other.Response) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BundleEntry) EqualsDeep(other BundleEntry) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Link), len(other.Link), func(i, j int) bool {
+		return r.Link[i].EqualsDeep(other.Link[j])
+	}) {
+		return false
+	}
+	if (r.FullUrl == nil) != (other.FullUrl == nil) || r.FullUrl != nil && !equivalentString(*r.FullUrl, *other.FullUrl) {
+		return false
+	}
+	if (r.FullUrlElement == nil) != (other.FullUrlElement == nil) || r.FullUrlElement != nil && !r.FullUrlElement.EqualsDeep(*other.FullUrlElement) {
+		return false
+	}
+	if !equalResource(r.Resource, other.Resource) {
+		return false
+	}
+	if (r.Search == nil) != (other.Search == nil) || r.Search != nil && !r.Search.EqualsDeep(*other.Search) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.EqualsDeep(*other.Request) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.EqualsDeep(*other.Response) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BundleEntry) EqualsShallow(other BundleEntry) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.FullUrl == nil) != (other.FullUrl == nil) || r.FullUrl != nil && !equivalentString(*r.FullUrl, *other.FullUrl) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BundleEntry as FHIR XML
+func (r BundleEntry) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Link {
+		if err := encodeXMLElement(e, "link", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "fullUrl", r.FullUrl, r.FullUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLResource(e, "resource", r.Resource); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "search", r.Search); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "request", r.Request); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "response", r.Response); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BundleEntry from FHIR XML
+func (r *BundleEntry) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "link":
+				var v BundleLink
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Link = append(r.Link, v)
+			case "fullUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FullUrl = &v
+				}
+				r.FullUrlElement = element
+			case "resource":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Resource = v
+			case "search":
+				var v BundleEntrySearch
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Search = &v
+			case "request":
+				var v BundleEntryRequest
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = &v
+			case "response":
+				var v BundleEntryResponse
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Response = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BundleEntry as properties to the RDF node n
+func (r BundleEntry) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Link {
+		n.element("Bundle.entry.link", i, v)
+	}
+	n.primitive("Bundle.entry.fullUrl", -1, r.FullUrl, r.FullUrlElement, "string")
+	n.inline("Bundle.entry.resource", -1, r.Resource)
+	n.element("Bundle.entry.search", -1, r.Search)
+	n.element("Bundle.entry.request", -1, r.Request)
+	n.element("Bundle.entry.response", -1, r.Response)
+}
+
+// MarshalBSON marshals the given BundleEntry as BSON document with the structure of its FHIR JSON
+func (r BundleEntry) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BundleEntry from a BSON document with the structure of its FHIR JSON
+func (r *BundleEntry) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BundleEntry as protobuf message BundleEntry of fhir.proto
+func (r BundleEntry) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BundleEntry to the encoded message
+func (r BundleEntry) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Link {
+		e.message(4, v)
+	}
+	if r.FullUrl != nil {
+		e.string(5, *r.FullUrl)
+	}
+	if len(r.Resource) > 0 {
+		e.resource(6, r.Resource)
+	}
+	if r.Search != nil {
+		e.message(7, *r.Search)
+	}
+	if r.Request != nil {
+		e.message(8, *r.Request)
+	}
+	if r.Response != nil {
+		e.message(9, *r.Response)
+	}
+	if r.FullUrlElement != nil {
+		e.message(10, *r.FullUrlElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BundleEntry from protobuf message BundleEntry of fhir.proto
+func (r *BundleEntry) UnmarshalProto(b []byte) error {
+	*r = BundleEntry{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v BundleLink
+			d.message(&v)
+			r.Link = append(r.Link, v)
+		case 5:
+			v := d.string()
+			r.FullUrl = &v
+		case 6:
+			r.Resource = d.resource()
+		case 7:
+			var v BundleEntrySearch
+			d.message(&v)
+			r.Search = &v
+		case 8:
+			var v BundleEntryRequest
+			d.message(&v)
+			r.Request = &v
+		case 9:
+			var v BundleEntryResponse
+			d.message(&v)
+			r.Response = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.FullUrlElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given BundleEntrySearch as JSON into a byte slice
+func (r BundleEntrySearch) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BundleEntrySearch as JSON object with the members in the order of the fields
+func (r BundleEntrySearch) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Mode != nil {
+		e.key("mode")
+		e.string(r.Mode.Code())
+	}
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	if r.Score != nil {
+		e.key("score")
+		e.number(*r.Score)
+	}
+	if r.ScoreElement != nil {
+		e.key("_score")
+		r.ScoreElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BundleEntrySearch from JSON. Unknown members are ignored.
+func (r *BundleEntrySearch) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BundleEntrySearch
+func (r *BundleEntrySearch) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "mode":
+			if d.null() {
+				r.Mode = nil
+			} else {
+				var v SearchEntryMode
+				d.unmarshal(&v)
+				r.Mode = &v
+			}
+		case "_mode":
+			r.ModeElement = d.element()
+		case "score":
+			if v, ok := d.number(); ok {
+				r.Score = &v
+			} else {
+				r.Score = nil
+			}
+		case "_score":
+			r.ScoreElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BundleEntrySearch which shares no memory with the original
+func (r BundleEntrySearch) DeepCopy() BundleEntrySearch {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Mode != nil {
+		v := *r.Mode
+		out.Mode = &v
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.Score != nil {
+		v := *r.Score
+		out.Score = &v
+	}
+	if r.ScoreElement != nil {
+		v := r.ScoreElement.DeepCopy()
+		out.ScoreElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BundleEntrySearch) Equal(other BundleEntrySearch) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Mode == nil) != (other.Mode == nil) || r.Mode != nil && *r.Mode != *other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if (r.Score == nil) != (other.Score == nil) || r.Score != nil && !EqualDecimal(*r.Score, *other.Score) {
+		return false
+	}
+	if (r.ScoreElement == nil) != (other.ScoreElement == nil) || r.ScoreElement != nil && !r.ScoreElement.Equal(*other.ScoreElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BundleEntrySearch) EqualsDeep(other BundleEntrySearch) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Mode == nil) != (other.Mode == nil) || r.Mode != nil && *r.Mode != *other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if (r.Score == nil) != (other.Score == nil) || r.Score != nil && !equivalentDecimal(*r.Score, *other.Score) {
+		return false
+	}
+	if (r.ScoreElement == nil) != (other.ScoreElement == nil) || r.ScoreElement != nil && !r.ScoreElement.EqualsDeep(*other.ScoreElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BundleEntrySearch) EqualsShallow(other BundleEntrySearch) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Mode == nil) != (other.Mode == nil) || r.Mode != nil && *r.Mode != *other.Mode {
+		return false
+	}
+	if (r.Score == nil) != (other.Score == nil) || r.Score != nil && !equivalentDecimal(*r.Score, *other.Score) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BundleEntrySearch as FHIR XML
+func (r BundleEntrySearch) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "score", r.Score, r.ScoreElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BundleEntrySearch from FHIR XML
+func (r *BundleEntrySearch) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "mode":
+				var v SearchEntryMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = &v
+				}
+				r.ModeElement = element
+			case "score":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Score = &v
+				}
+				r.ScoreElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BundleEntrySearch as properties to the RDF node n
+func (r BundleEntrySearch) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Bundle.entry.search.mode", -1, r.Mode, r.ModeElement, "code")
+	n.primitive("Bundle.entry.search.score", -1, r.Score, r.ScoreElement, "decimal")
+}
+
+// MarshalBSON marshals the given BundleEntrySearch as BSON document with the structure of its FHIR JSON
+func (r BundleEntrySearch) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BundleEntrySearch from a BSON document with the structure of its FHIR JSON
+func (r *BundleEntrySearch) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BundleEntrySearch as protobuf message BundleEntrySearch of fhir.proto
+func (r BundleEntrySearch) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BundleEntrySearch to the encoded message
+func (r BundleEntrySearch) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Mode != nil {
+		e.varint(4, r.Mode.protoNumber())
+	}
+	if r.Score != nil {
+		e.string(5, string(*r.Score))
+	}
+	if r.ModeElement != nil {
+		e.message(6, *r.ModeElement)
+	}
+	if r.ScoreElement != nil {
+		e.message(7, *r.ScoreElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BundleEntrySearch from protobuf message BundleEntrySearch of fhir.proto
+func (r *BundleEntrySearch) UnmarshalProto(b []byte) error {
+	*r = BundleEntrySearch{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v SearchEntryMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = &v
+		case 5:
+			v := json.Number(d.string())
+			r.Score = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ScoreElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given BundleEntryRequest as JSON into a byte slice
+func (r BundleEntryRequest) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BundleEntryRequest as JSON object with the members in the order of the fields
+func (r BundleEntryRequest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("method")
+	e.string(r.Method.Code())
+	if r.MethodElement != nil {
+		e.key("_method")
+		r.MethodElement.appendJSON(e)
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.IfNoneMatch != nil {
+		e.key("ifNoneMatch")
+		e.string(*r.IfNoneMatch)
+	}
+	if r.IfNoneMatchElement != nil {
+		e.key("_ifNoneMatch")
+		r.IfNoneMatchElement.appendJSON(e)
+	}
+	if r.IfModifiedSince != nil {
+		e.key("ifModifiedSince")
+		e.string(*r.IfModifiedSince)
+	}
+	if r.IfModifiedSinceElement != nil {
+		e.key("_ifModifiedSince")
+		r.IfModifiedSinceElement.appendJSON(e)
+	}
+	if r.IfMatch != nil {
+		e.key("ifMatch")
+		e.string(*r.IfMatch)
+	}
+	if r.IfMatchElement != nil {
+		e.key("_ifMatch")
+		r.IfMatchElement.appendJSON(e)
+	}
+	if r.IfNoneExist != nil {
+		e.key("ifNoneExist")
+		e.string(*r.IfNoneExist)
+	}
+	if r.IfNoneExistElement != nil {
+		e.key("_ifNoneExist")
+		r.IfNoneExistElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BundleEntryRequest from JSON. Unknown members are ignored.
+func (r *BundleEntryRequest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BundleEntryRequest
+func (r *BundleEntryRequest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "method":
+			d.unmarshal(&r.Method)
+		case "_method":
+			r.MethodElement = d.element()
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "ifNoneMatch":
+			if v, ok := d.string(); ok {
+				r.IfNoneMatch = &v
+			} else {
+				r.IfNoneMatch = nil
+			}
+		case "_ifNoneMatch":
+			r.IfNoneMatchElement = d.element()
+		case "ifModifiedSince":
+			if v, ok := d.string(); ok {
+				r.IfModifiedSince = &v
+			} else {
+				r.IfModifiedSince = nil
+			}
+		case "_ifModifiedSince":
+			r.IfModifiedSinceElement = d.element()
+		case "ifMatch":
+			if v, ok := d.string(); ok {
+				r.IfMatch = &v
+			} else {
+				r.IfMatch = nil
+			}
+		case "_ifMatch":
+			r.IfMatchElement = d.element()
+		case "ifNoneExist":
+			if v, ok := d.string(); ok {
+				r.IfNoneExist = &v
+			} else {
+				r.IfNoneExist = nil
+			}
+		case "_ifNoneExist":
+			r.IfNoneExistElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BundleEntryRequest which shares no memory with the original
+func (r BundleEntryRequest) DeepCopy() BundleEntryRequest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.MethodElement != nil {
+		v := r.MethodElement.DeepCopy()
+		out.MethodElement = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.IfNoneMatch != nil {
+		v := *r.IfNoneMatch
+		out.IfNoneMatch = &v
+	}
+	if r.IfNoneMatchElement != nil {
+		v := r.IfNoneMatchElement.DeepCopy()
+		out.IfNoneMatchElement = &v
+	}
+	if r.IfModifiedSince != nil {
+		v := *r.IfModifiedSince
+		out.IfModifiedSince = &v
+	}
+	if r.IfModifiedSinceElement != nil {
+		v := r.IfModifiedSinceElement.DeepCopy()
+		out.IfModifiedSinceElement = &v
+	}
+	if r.IfMatch != nil {
+		v := *r.IfMatch
+		out.IfMatch = &v
+	}
+	if r.IfMatchElement != nil {
+		v := r.IfMatchElement.DeepCopy()
+		out.IfMatchElement = &v
+	}
+	if r.IfNoneExist != nil {
+		v := *r.IfNoneExist
+		out.IfNoneExist = &v
+	}
+	if r.IfNoneExistElement != nil {
+		v := r.IfNoneExistElement.DeepCopy()
+		out.IfNoneExistElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BundleEntryRequest) Equal(other BundleEntryRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Method != other.Method {
+		return false
+	}
+	if (r.MethodElement == nil) != (other.MethodElement == nil) || r.MethodElement != nil && !r.MethodElement.Equal(*other.MethodElement) {
+		return false
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.IfNoneMatch == nil) != (other.IfNoneMatch == nil) || r.IfNoneMatch != nil && *r.IfNoneMatch != *other.IfNoneMatch {
+		return false
+	}
+	if (r.IfNoneMatchElement == nil) != (other.IfNoneMatchElement == nil) || r.IfNoneMatchElement != nil && !r.IfNoneMatchElement.Equal(*other.IfNoneMatchElement) {
+		return false
+	}
+	if (r.IfModifiedSince == nil) != (other.IfModifiedSince == nil) || r.IfModifiedSince != nil && *r.IfModifiedSince != *other.IfModifiedSince {
+		return false
+	}
+	if (r.IfModifiedSinceElement == nil) != (other.IfModifiedSinceElement == nil) || r.IfModifiedSinceElement != nil && !r.IfModifiedSinceElement.Equal(*other.IfModifiedSinceElement) {
+		return false
+	}
+	if (r.IfMatch == nil) != (other.IfMatch == nil) || r.IfMatch != nil && *r.IfMatch != *other.IfMatch {
+		return false
+	}
+	if (r.IfMatchElement == nil) != (other.IfMatchElement == nil) || r.IfMatchElement != nil && !r.IfMatchElement.Equal(*other.IfMatchElement) {
+		return false
+	}
+	if (r.IfNoneExist == nil) != (other.IfNoneExist == nil) || r.IfNoneExist != nil && *r.IfNoneExist != *other.IfNoneExist {
+		return false
+	}
+	if (r.IfNoneExistElement == nil) != (other.IfNoneExistElement == nil) || r.IfNoneExistElement != nil && !r.IfNoneExistElement.Equal(*other.IfNoneExistElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BundleEntryRequest) EqualsDeep(other BundleEntryRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Method != other.Method {
+		return false
+	}
+	if (r.MethodElement == nil) != (other.MethodElement == nil) || r.MethodElement != nil && !r.MethodElement.EqualsDeep(*other.MethodElement) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.IfNoneMatch == nil) != (other.IfNoneMatch == nil) || r.IfNoneMatch != nil && !equivalentString(*r.IfNoneMatch, *other.IfNoneMatch) {
+		return false
+	}
+	if (r.IfNoneMatchElement == nil) != (other.IfNoneMatchElement == nil) || r.IfNoneMatchElement != nil && !r.IfNoneMatchElement.EqualsDeep(*other.IfNoneMatchElement) {
+		return false
+	}
+	if (r.IfModifiedSince == nil) != (other.IfModifiedSince == nil) || r.IfModifiedSince != nil && !equivalentString(*r.IfModifiedSince, *other.IfModifiedSince) {
+		return false
+	}
+	if (r.IfModifiedSinceElement == nil) != (other.IfModifiedSinceElement == nil) || r.IfModifiedSinceElement != nil && !r.IfModifiedSinceElement.EqualsDeep(*other.IfModifiedSinceElement) {
+		return false
+	}
+	if (r.IfMatch == nil) != (other.IfMatch == nil) || r.IfMatch != nil && !equivalentString(*r.IfMatch, *other.IfMatch) {
+		return false
+	}
+	if (r.IfMatchElement == nil) != (other.IfMatchElement == nil) || r.IfMatchElement != nil && !r.IfMatchElement.EqualsDeep(*other.IfMatchElement) {
+		return false
+	}
+	if (r.IfNoneExist == nil) != (other.IfNoneExist == nil) || r.IfNoneExist != nil && !equivalentString(*r.IfNoneExist, *other.IfNoneExist) {
+		return false
+	}
+	if (r.IfNoneExistElement == nil) != (other.IfNoneExistElement == nil) || r.IfNoneExistElement != nil && !r.IfNoneExistElement.EqualsDeep(*other.IfNoneExistElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BundleEntryRequest) EqualsShallow(other BundleEntryRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Method != other.Method {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.IfNoneMatch == nil) != (other.IfNoneMatch == nil) || r.IfNoneMatch != nil && !equivalentString(*r.IfNoneMatch, *other.IfNoneMatch) {
+		return false
+	}
+	if (r.IfModifiedSince == nil) != (other.IfModifiedSince == nil) || r.IfModifiedSince != nil && !equivalentString(*r.IfModifiedSince, *other.IfModifiedSince) {
+		return false
+	}
+	if (r.IfMatch == nil) != (other.IfMatch == nil) || r.IfMatch != nil && !equivalentString(*r.IfMatch, *other.IfMatch) {
+		return false
+	}
+	if (r.IfNoneExist == nil) != (other.IfNoneExist == nil) || r.IfNoneExist != nil && !equivalentString(*r.IfNoneExist, *other.IfNoneExist) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BundleEntryRequest as FHIR XML
+func (r BundleEntryRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "method", r.Method, r.MethodElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "ifNoneMatch", r.IfNoneMatch, r.IfNoneMatchElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "ifModifiedSince", r.IfModifiedSince, r.IfModifiedSinceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "ifMatch", r.IfMatch, r.IfMatchElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "ifNoneExist", r.IfNoneExist, r.IfNoneExistElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BundleEntryRequest from FHIR XML
+func (r *BundleEntryRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "method":
+				var v HTTPVerb
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Method = v
+				}
+				r.MethodElement = element
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "ifNoneMatch":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IfNoneMatch = &v
+				}
+				r.IfNoneMatchElement = element
+			case "ifModifiedSince":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IfModifiedSince = &v
+				}
+				r.IfModifiedSinceElement = element
+			case "ifMatch":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IfMatch = &v
+				}
+				r.IfMatchElement = element
+			case "ifNoneExist":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IfNoneExist = &v
+				}
+				r.IfNoneExistElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BundleEntryRequest as properties to the RDF node n
+func (r BundleEntryRequest) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Bundle.entry.request.method", -1, r.Method, r.MethodElement, "code")
+	n.primitive("Bundle.entry.request.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("Bundle.entry.request.ifNoneMatch", -1, r.IfNoneMatch, r.IfNoneMatchElement, "string")
+	n.primitive("Bundle.entry.request.ifModifiedSince", -1, r.IfModifiedSince, r.IfModifiedSinceElement, "instant")
+	n.primitive("Bundle.entry.request.ifMatch", -1, r.IfMatch, r.IfMatchElement, "string")
+	n.primitive("Bundle.entry.request.ifNoneExist", -1, r.IfNoneExist, r.IfNoneExistElement, "string")
+}
+
+// MarshalBSON marshals the given BundleEntryRequest as BSON document with the structure of its FHIR JSON
+func (r BundleEntryRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BundleEntryRequest from a BSON document with the structure of its FHIR JSON
+func (r *BundleEntryRequest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BundleEntryRequest as protobuf message BundleEntryRequest of fhir.proto
+func (r BundleEntryRequest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BundleEntryRequest to the encoded message
+func (r BundleEntryRequest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Method.protoNumber())
+	if r.Url != "" {
+		e.string(5, r.Url)
+	}
+	if r.IfNoneMatch != nil {
+		e.string(6, *r.IfNoneMatch)
+	}
+	if r.IfModifiedSince != nil {
+		e.string(7, *r.IfModifiedSince)
+	}
+	if r.IfMatch != nil {
+		e.string(8, *r.IfMatch)
+	}
+	if r.IfNoneExist != nil {
+		e.string(9, *r.IfNoneExist)
+	}
+	if r.MethodElement != nil {
+		e.message(10, *r.MethodElement)
+	}
+	if r.UrlElement != nil {
+		e.message(11, *r.UrlElement)
+	}
+	if r.IfNoneMatchElement != nil {
+		e.message(12, *r.IfNoneMatchElement)
+	}
+	if r.IfModifiedSinceElement != nil {
+		e.message(13, *r.IfModifiedSinceElement)
+	}
+	if r.IfMatchElement != nil {
+		e.message(14, *r.IfMatchElement)
+	}
+	if r.IfNoneExistElement != nil {
+		e.message(15, *r.IfNoneExistElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BundleEntryRequest from protobuf message BundleEntryRequest of fhir.proto
+func (r *BundleEntryRequest) UnmarshalProto(b []byte) error {
+	*r = BundleEntryRequest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v HTTPVerb
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Method = v
+		case 5:
+			r.Url = d.string()
+		case 6:
+			v := d.string()
+			r.IfNoneMatch = &v
+		case 7:
+			v := d.string()
+			r.IfModifiedSince = &v
+		case 8:
+			v := d.string()
+			r.IfMatch = &v
+		case 9:
+			v := d.string()
+			r.IfNoneExist = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.MethodElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.IfNoneMatchElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.IfModifiedSinceElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.IfMatchElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.IfNoneExistElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given BundleEntryResponse as JSON into a byte slice
+func (r BundleEntryResponse) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the BundleEntryResponse as JSON object with the members in the order of the fields
+func (r BundleEntryResponse) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status)
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Location != nil {
+		e.key("location")
+		e.string(*r.Location)
+	}
+	if r.LocationElement != nil {
+		e.key("_location")
+		r.LocationElement.appendJSON(e)
+	}
+	if r.Etag != nil {
+		e.key("etag")
+		e.string(*r.Etag)
+	}
+	if r.EtagElement != nil {
+		e.key("_etag")
+		r.EtagElement.appendJSON(e)
+	}
+	if r.LastModified != nil {
+		e.key("lastModified")
+		e.string(*r.LastModified)
+	}
+	if r.LastModifiedElement != nil {
+		e.key("_lastModified")
+		r.LastModifiedElement.appendJSON(e)
+	}
+	if len(r.Outcome) > 0 {
+		e.key("outcome")
+		e.raw(r.Outcome)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given BundleEntryResponse from JSON. Unknown members are ignored.
+func (r *BundleEntryResponse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the BundleEntryResponse
+func (r *BundleEntryResponse) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "status":
+			if v, ok := d.string(); ok {
+				r.Status = v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "location":
+			if v, ok := d.string(); ok {
+				r.Location = &v
+			} else {
+				r.Location = nil
+			}
+		case "_location":
+			r.LocationElement = d.element()
+		case "etag":
+			if v, ok := d.string(); ok {
+				r.Etag = &v
+			} else {
+				r.Etag = nil
+			}
+		case "_etag":
+			r.EtagElement = d.element()
+		case "lastModified":
+			if v, ok := d.string(); ok {
+				r.LastModified = &v
+			} else {
+				r.LastModified = nil
+			}
+		case "_lastModified":
+			r.LastModifiedElement = d.element()
+		case "outcome":
+			r.Outcome = d.raw()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the BundleEntryResponse which shares no memory with the original
+func (r BundleEntryResponse) DeepCopy() BundleEntryResponse {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Location != nil {
+		v := *r.Location
+		out.Location = &v
+	}
+	if r.LocationElement != nil {
+		v := r.LocationElement.DeepCopy()
+		out.LocationElement = &v
+	}
+	if r.Etag != nil {
+		v := *r.Etag
+		out.Etag = &v
+	}
+	if r.EtagElement != nil {
+		v := r.EtagElement.DeepCopy()
+		out.EtagElement = &v
+	}
+	if r.LastModified != nil {
+		v := *r.LastModified
+		out.LastModified = &v
+	}
+	if r.LastModifiedElement != nil {
+		v := r.LastModifiedElement.DeepCopy()
+		out.LastModifiedElement = &v
+	}
+	if r.Outcome != nil {
+		out.Outcome = make(json.RawMessage, len(r.Outcome))
+		copy(out.Outcome, r.Outcome)
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r BundleEntryResponse) Equal(other BundleEntryResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && *r.Location != *other.Location {
+		return false
+	}
+	if (r.LocationElement == nil) != (other.LocationElement == nil) || r.LocationElement != nil && !r.LocationElement.Equal(*other.LocationElement) {
+		return false
+	}
+	if (r.Etag == nil) != (other.Etag == nil) || r.Etag != nil && *r.Etag != *other.Etag {
+		return false
+	}
+	if (r.EtagElement == nil) != (other.EtagElement == nil) || r.EtagElement != nil && !r.EtagElement.Equal(*other.EtagElement) {
+		return false
+	}
+	if (r.LastModified == nil) != (other.LastModified == nil) || r.LastModified != nil && *r.LastModified != *other.LastModified {
+		return false
+	}
+	if (r.LastModifiedElement == nil) != (other.LastModifiedElement == nil) || r.LastModifiedElement != nil && !r.LastModifiedElement.Equal(*other.LastModifiedElement) {
+		return false
+	}
+	if !equalResource(r.Outcome, other.Outcome) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r BundleEntryResponse) EqualsDeep(other BundleEntryResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !equivalentString(*r.Location, *other.Location) {
+		return false
+	}
+	if (r.LocationElement == nil) != (other.LocationElement == nil) || r.LocationElement != nil && !r.LocationElement.EqualsDeep(*other.LocationElement) {
+		return false
+	}
+	if (r.Etag == nil) != (other.Etag == nil) || r.Etag != nil && !equivalentString(*r.Etag, *other.Etag) {
+		return false
+	}
+	if (r.EtagElement == nil) != (other.EtagElement == nil) || r.EtagElement != nil && !r.EtagElement.EqualsDeep(*other.EtagElement) {
+		return false
+	}
+	if (r.LastModified == nil) != (other.LastModified == nil) || r.LastModified != nil && !equivalentString(*r.LastModified, *other.LastModified) {
+		return false
+	}
+	if (r.LastModifiedElement == nil) != (other.LastModifiedElement == nil) || r.LastModifiedElement != nil && !r.LastModifiedElement.EqualsDeep(*other.LastModifiedElement) {
+		return false
+	}
+	if !equalResource(r.Outcome, other.Outcome) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r BundleEntryResponse) EqualsShallow(other BundleEntryResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !equivalentString(*r.Location, *other.Location) {
+		return false
+	}
+	if (r.Etag == nil) != (other.Etag == nil) || r.Etag != nil && !equivalentString(*r.Etag, *other.Etag) {
+		return false
+	}
+	if (r.LastModified == nil) != (other.LastModified == nil) || r.LastModified != nil && !equivalentString(*r.LastModified, *other.LastModified) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given BundleEntryResponse as FHIR XML
+func (r BundleEntryResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "location", r.Location, r.LocationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "etag", r.Etag, r.EtagElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastModified", r.LastModified, r.LastModifiedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLResource(e, "outcome", r.Outcome); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given BundleEntryResponse from FHIR XML
+func (r *BundleEntryResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "status":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "location":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Location = &v
+				}
+				r.LocationElement = element
+			case "etag":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Etag = &v
+				}
+				r.EtagElement = element
+			case "lastModified":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastModified = &v
+				}
+				r.LastModifiedElement = element
+			case "outcome":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Outcome = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the BundleEntryResponse as properties to the RDF node n
+func (r BundleEntryResponse) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Bundle.entry.response.status", -1, r.Status, r.StatusElement, "string")
+	n.primitive("Bundle.entry.response.location", -1, r.Location, r.LocationElement, "string")
+	n.primitive("Bundle.entry.response.etag", -1, r.Etag, r.EtagElement, "string")
+	n.primitive("Bundle.entry.response.lastModified", -1, r.LastModified, r.LastModifiedElement, "instant")
+	n.inline("Bundle.entry.response.outcome", -1, r.Outcome)
+}
+
+// MarshalBSON marshals the given BundleEntryResponse as BSON document with the structure of its FHIR JSON
+func (r BundleEntryResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given BundleEntryResponse from a BSON document with the structure of its FHIR JSON
+func (r *BundleEntryResponse) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given BundleEntryResponse as protobuf message BundleEntryResponse of fhir.proto
+func (r BundleEntryResponse) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the BundleEntryResponse to the encoded message
+func (r BundleEntryResponse) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Status != "" {
+		e.string(4, r.Status)
+	}
+	if r.Location != nil {
+		e.string(5, *r.Location)
+	}
+	if r.Etag != nil {
+		e.string(6, *r.Etag)
+	}
+	if r.LastModified != nil {
+		e.string(7, *r.LastModified)
+	}
+	if len(r.Outcome) > 0 {
+		e.resource(8, r.Outcome)
+	}
+	if r.StatusElement != nil {
+		e.message(9, *r.StatusElement)
+	}
+	if r.LocationElement != nil {
+		e.message(10, *r.LocationElement)
+	}
+	if r.EtagElement != nil {
+		e.message(11, *r.EtagElement)
+	}
+	if r.LastModifiedElement != nil {
+		e.message(12, *r.LastModifiedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given BundleEntryResponse from protobuf message BundleEntryResponse of fhir.proto
+func (r *BundleEntryResponse) UnmarshalProto(b []byte) error {
+	*r = BundleEntryResponse{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Status = d.string()
+		case 5:
+			v := d.string()
+			r.Location = &v
+		case 6:
+			v := d.string()
+			r.Etag = &v
+		case 7:
+			v := d.string()
+			r.LastModified = &v
+		case 8:
+			r.Outcome = d.resource()
+		case 9:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.LocationElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.EtagElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.LastModifiedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalBundle unmarshals a Bundle.
 func UnmarshalBundle(b []byte) (Bundle, error) {
 	var bundle Bundle
-	if err := json.Unmarshal(b, &bundle); err != nil {
+	if err := bundle.UnmarshalJSON(b); err != nil {
 		return bundle, err
 	}
 	return bundle, nil
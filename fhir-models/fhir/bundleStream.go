@@ -0,0 +1,267 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// BundleReader reads the entries of a Bundle from a stream one at a time, so that large search results and exports
+// don't have to be held in memory.
+type BundleReader struct {
+	dec     *json.Decoder
+	members []bundleMember
+	entries int // number of entries read
+	started bool
+	inEntry bool
+	done    bool
+	err     error
+}
+
+type bundleMember struct {
+	key   string
+	value json.RawMessage
+}
+
+// NewBundleReader returns a reader of the Bundle JSON read from r.
+func NewBundleReader(r io.Reader) *BundleReader {
+	return &BundleReader{dec: json.NewDecoder(r)}
+}
+
+// Next returns the next entry of the Bundle. It returns io.EOF after the last entry has been read. Errors of malformed
+// entries name the index of the entry.
+func (r *BundleReader) Next() (BundleEntry, error) {
+	var entry BundleEntry
+	if r.err != nil {
+		return entry, r.err
+	}
+	if !r.inEntry {
+		r.err = r.advance()
+		if r.err != nil {
+			return entry, r.err
+		}
+	}
+	if err := r.dec.Decode(&entry); err != nil {
+		r.err = fmt.Errorf("entry[%d]: %w", r.entries, err)
+		return entry, r.err
+	}
+	r.entries++
+	if !r.dec.More() {
+		if _, err := r.dec.Token(); err != nil {
+			r.err = err
+		}
+		r.inEntry = false
+	}
+	return entry, nil
+}
+
+// NextResource returns the next entry of the Bundle together with its decoded resource, which is nil if the entry has
+// no resource. It returns io.EOF after the last entry has been read.
+func (r *BundleReader) NextResource() (BundleEntry, interface{}, error) {
+	entry, err := r.Next()
+	if err != nil || len(entry.Resource) == 0 {
+		return entry, nil, err
+	}
+	resource, err := DecodeResource(entry.Resource)
+	if err != nil {
+		return entry, nil, fmt.Errorf("entry[%d]: %w", r.entries-1, err)
+	}
+	return entry, resource, nil
+}
+
+// Bundle returns the Bundle with all elements except the entries. It reads ahead up to the next entry, so elements like
+// type, total and link, which normally precede the entries, are available before the first call of Next, while elements
+// following the entries are only available after the last entry was read.
+func (r *BundleReader) Bundle() (Bundle, error) {
+	if !r.inEntry && r.err == nil {
+		if err := r.advance(); err != nil {
+			r.err = err
+		}
+	}
+	if r.err != nil && r.err != io.EOF {
+		return Bundle{}, r.err
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, member := range r.members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(member.key)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(member.value)
+	}
+	buf.WriteByte('}')
+	var bundle Bundle
+	err := bundle.UnmarshalJSON(buf.Bytes())
+	return bundle, err
+}
+
+// advance reads the members of the Bundle until the next entry is reached. It returns io.EOF at the end of the Bundle.
+func (r *BundleReader) advance() error {
+	if r.done {
+		return io.EOF
+	}
+	if !r.started {
+		r.started = true
+		if t, err := r.dec.Token(); err != nil {
+			return err
+		} else if t != json.Delim('{') {
+			return fmt.Errorf("expected a Bundle JSON object but got %v", t)
+		}
+	}
+	for r.dec.More() {
+		t, err := r.dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := t.(string)
+		if key == "entry" {
+			if t, err := r.dec.Token(); err != nil {
+				return err
+			} else if t == nil {
+				continue
+			} else if t != json.Delim('[') {
+				return fmt.Errorf("expected an array of entries but got %v", t)
+			}
+			if r.dec.More() {
+				r.inEntry = true
+				return nil
+			}
+			if _, err := r.dec.Token(); err != nil {
+				return err
+			}
+			continue
+		}
+		var value json.RawMessage
+		if err := r.dec.Decode(&value); err != nil {
+			return err
+		}
+		if key == "resourceType" && string(value) != `"Bundle"` {
+			return fmt.Errorf("expected resource type Bundle but got %s", value)
+		}
+		r.members = append(r.members, bundleMember{key: key, value: value})
+	}
+	if _, err := r.dec.Token(); err != nil {
+		return err
+	}
+	r.done = true
+	return io.EOF
+}
+
+// BundleWriter writes a Bundle to a stream one entry at a time. The elements of the Bundle other than the entries are
+// written before the first entry, except the signature, which is written by Close.
+type BundleWriter struct {
+	w         io.Writer
+	signature *Signature
+	entries   int
+	err       error
+}
+
+// NewBundleWriter writes the elements of the given bundle except its entries to w and returns a writer for the entries.
+// Entries already contained in the bundle are ignored.
+func NewBundleWriter(w io.Writer, bundle Bundle) (*BundleWriter, error) {
+	writer := &BundleWriter{w: w, signature: bundle.Signature}
+	bundle.Entry = nil
+	bundle.Signature = nil
+	header, err := bundle.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(header[:len(header)-1]); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// WriteEntry writes the given entry.
+func (w *BundleWriter) WriteEntry(entry BundleEntry) error {
+	if w.err != nil {
+		return w.err
+	}
+	b, err := entry.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	separator := []byte{','}
+	if w.entries == 0 {
+		separator = []byte(`,"entry":[`)
+	}
+	if _, w.err = w.w.Write(append(separator, b...)); w.err != nil {
+		return w.err
+	}
+	w.entries++
+	return nil
+}
+
+// WriteResource writes an entry with the given full URL and resource. The full URL is omitted if empty.
+func (w *BundleWriter) WriteResource(fullUrl string, resource json.Marshaler) error {
+	b, err := resource.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	entry := BundleEntry{Resource: b}
+	if fullUrl != "" {
+		entry.FullUrl = &fullUrl
+	}
+	return w.WriteEntry(entry)
+}
+
+// Close finishes the Bundle. It doesn't close the underlying writer.
+func (w *BundleWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	var end []byte
+	if w.entries > 0 {
+		end = append(end, ']')
+	}
+	if w.signature != nil {
+		b, err := w.signature.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		end = append(append(end, `,"signature":`...), b...)
+	}
+	_, w.err = w.w.Write(append(end, '}'))
+	if w.err == nil {
+		w.err = fmt.Errorf("bundle writer is closed")
+		return nil
+	}
+	return w.err
+}
+
+// DecodeResource unmarshals the given resource JSON into a new instance of the type named by its resourceType and
+// returns a pointer to it, for example a *Patient.
+func DecodeResource(b []byte) (interface{}, error) {
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(b, &header); err != nil {
+		return nil, err
+	}
+	resource, ok := newResource(header.ResourceType)
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type `%s`", header.ResourceType)
+	}
+	if err := json.Unmarshal(b, resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBundleReader(t *testing.T) {
+	const bundle = `{
+		"resourceType": "Bundle",
+		"type": "searchset",
+		"link": [{"relation": "self", "url": "https://example.org/Patient"}],
+		"entry": [
+			{"fullUrl": "https://example.org/Patient/1", "resource": {"resourceType": "Patient", "id": "1"}},
+			{"fullUrl": "https://example.org/Observation/2", "resource": {"resourceType": "Observation", "id": "2", "status": "final", "code": {}}},
+			{"search": {"mode": "outcome"}}
+		],
+		"total": 2,
+		"signature": {"type": [{"code": "1.2.840.10065.1.12.1.1"}], "when": "2022-01-01T00:00:00Z", "who": {"reference": "Device/1"}}
+	}`
+	r := NewBundleReader(strings.NewReader(bundle))
+	header, err := r.Bundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Type != BundleTypeSearchset || len(header.Link) != 1 || header.Total != nil || header.Signature != nil {
+		t.Errorf("elements before the entries = %+v", header)
+	}
+
+	var ids []string
+	for {
+		entry, resource, err := r.NextResource()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch resource := resource.(type) {
+		case *Patient:
+			ids = append(ids, "Patient/"+*resource.Id)
+		case *Observation:
+			ids = append(ids, "Observation/"+*resource.Id)
+		case nil:
+			if entry.Search == nil || entry.Search.Mode == nil || *entry.Search.Mode != SearchEntryModeOutcome {
+				t.Errorf("entry without resource = %+v", entry)
+			}
+			ids = append(ids, "-")
+		}
+	}
+	if want := []string{"Patient/1", "Observation/2", "-"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("entries = %v, want %v", ids, want)
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("Next after the last entry = %v", err)
+	}
+
+	full, err := r.Bundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if full.Total == nil || *full.Total != 2 || full.Signature == nil || len(full.Link) != 1 || len(full.Entry) != 0 {
+		t.Errorf("elements after the entries = %+v", full)
+	}
+}
+
+func TestBundleReaderWithoutEntries(t *testing.T) {
+	for _, bundle := range []string{
+		`{"resourceType": "Bundle", "type": "collection"}`,
+		`{"resourceType": "Bundle", "entry": [], "type": "collection"}`,
+		`{"resourceType": "Bundle", "entry": null, "type": "collection"}`,
+	} {
+		r := NewBundleReader(strings.NewReader(bundle))
+		if _, err := r.Next(); err != io.EOF {
+			t.Errorf("%s: Next = %v", bundle, err)
+		}
+		if b, err := r.Bundle(); err != nil || b.Type != BundleTypeCollection {
+			t.Errorf("%s: Bundle = %+v, %v", bundle, b, err)
+		}
+	}
+}
+
+func TestBundleReaderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		bundle  string
+		entries int // entries read before the error
+		err     string
+		skip    bool // whether the reader continues with the next entry
+	}{
+		{"no object", `[]`, 0, "expected a Bundle JSON object", false},
+		{"other resource", `{"resourceType": "Patient", "entry": []}`, 0, "expected resource type Bundle", false},
+		{"entry no array", `{"resourceType": "Bundle", "entry": {}}`, 0, "expected an array of entries", false},
+		{"malformed entry", `{"resourceType": "Bundle", "entry": [{}, {"fullUrl": 1}]}`, 1, "entry[1]: ", false},
+		{"truncated entry", `{"resourceType": "Bundle", "entry": [{}, {}, {"fullUrl": "`, 2, "entry[2]: ", false},
+		{"unknown resource type", `{"resourceType": "Bundle", "entry": [{"resource": {"resourceType": "Foo"}}]}`, 0, "entry[0]: unknown resource type `Foo`", true},
+		{"truncated after entries", `{"resourceType": "Bundle", "entry": [{}], "total": `, 1, "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := NewBundleReader(strings.NewReader(test.bundle))
+			var err error
+			entries := 0
+			for err == nil {
+				if _, _, err = r.NextResource(); err == nil {
+					entries++
+				}
+			}
+			if err == io.EOF || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %v, want %s", err, test.err)
+			}
+			if entries != test.entries {
+				t.Errorf("read %d entries, want %d", entries, test.entries)
+			}
+			if _, err := r.Next(); test.skip != (err == io.EOF) {
+				t.Errorf("Next after error = %v", err)
+			}
+		})
+	}
+}
+
+func TestBundleWriter(t *testing.T) {
+	total := 2
+	var buf bytes.Buffer
+	w, err := NewBundleWriter(&buf, Bundle{
+		Type:      BundleTypeSearchset,
+		Total:     &total,
+		Entry:     []BundleEntry{{FullUrl: stringPtr("ignored")}},
+		Signature: &Signature{Data: stringPtr("c2ln")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := "1"
+	if err := w.WriteResource("https://example.org/Patient/1", Patient{Id: &id}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteResource("", Observation{Status: ObservationStatusFinal}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteEntry(BundleEntry{Search: &BundleEntrySearch{Mode: searchEntryModePtr(SearchEntryModeOutcome)}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteEntry(BundleEntry{}); err == nil {
+		t.Error("entry written after Close")
+	}
+
+	if !json.Valid(buf.Bytes()) {
+		t.Fatalf("invalid JSON %s", buf.Bytes())
+	}
+	bundle, err := UnmarshalBundle(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bundle.Type != BundleTypeSearchset || bundle.Total == nil || *bundle.Total != 2 ||
+		bundle.Signature == nil || *bundle.Signature.Data != "c2ln" || len(bundle.Entry) != 3 {
+		t.Fatalf("bundle = %s", buf.Bytes())
+	}
+	if *bundle.Entry[0].FullUrl != "https://example.org/Patient/1" || bundle.Entry[1].FullUrl != nil {
+		t.Errorf("full URLs of %s", buf.Bytes())
+	}
+	patient, err := UnmarshalPatient(bundle.Entry[0].Resource)
+	if err != nil || *patient.Id != "1" {
+		t.Errorf("patient = %+v, %v", patient, err)
+	}
+
+	// the reader reads what the writer wrote
+	r := NewBundleReader(&buf)
+	for i := 0; i < 3; i++ {
+		if _, err := r.Next(); err != nil {
+			t.Fatalf("entry %d: %v", i, err)
+		}
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("Next after the last entry = %v", err)
+	}
+}
+
+func TestBundleWriterWithoutEntries(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewBundleWriter(&buf, Bundle{Type: BundleTypeCollection})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	bundle, err := UnmarshalBundle(buf.Bytes())
+	if err != nil || bundle.Type != BundleTypeCollection || bundle.Entry != nil {
+		t.Errorf("bundle %s = %+v, %v", buf.Bytes(), bundle, err)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestBundleWriterError(t *testing.T) {
+	if _, err := NewBundleWriter(failingWriter{}, Bundle{}); err == nil {
+		t.Error("no error writing the header")
+	}
+}
+
+func searchEntryModePtr(mode SearchEntryMode) *SearchEntryMode {
+	return &mode
+}
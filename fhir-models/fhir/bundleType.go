@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code BundleType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *BundleType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *BundleType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "document":
 		*code = BundleTypeDocument
@@ -67,6 +71,12 @@ func (code *BundleType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code BundleType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *BundleType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code BundleType) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code BundleType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code BundleType) protoNumber() uint64 {
+	switch code {
+	case BundleTypeDocument:
+		return 1
+	case BundleTypeMessage:
+		return 2
+	case BundleTypeTransaction:
+		return 3
+	case BundleTypeTransactionResponse:
+		return 4
+	case BundleTypeBatch:
+		return 5
+	case BundleTypeBatchResponse:
+		return 6
+	case BundleTypeHistory:
+		return 7
+	case BundleTypeSearchset:
+		return 8
+	case BundleTypeCollection:
+		return 9
+	}
+	return 0
+}
+func (code *BundleType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = BundleTypeDocument
+	case 2:
+		*code = BundleTypeMessage
+	case 3:
+		*code = BundleTypeTransaction
+	case 4:
+		*code = BundleTypeTransactionResponse
+	case 5:
+		*code = BundleTypeBatch
+	case 6:
+		*code = BundleTypeBatchResponse
+	case 7:
+		*code = BundleTypeHistory
+	case 8:
+		*code = BundleTypeSearchset
+	case 9:
+		*code = BundleTypeCollection
+	default:
+		return false
+	}
+	return true
+}
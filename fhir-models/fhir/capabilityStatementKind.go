@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code CapabilityStatementKind) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CapabilityStatementKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CapabilityStatementKind) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "instance":
 		*code = CapabilityStatementKindInstance
@@ -49,6 +53,12 @@ func (code *CapabilityStatementKind) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CapabilityStatementKind) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CapabilityStatementKind) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CapabilityStatementKind) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code CapabilityStatementKind) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CapabilityStatementKind) protoNumber() uint64 {
+	switch code {
+	case CapabilityStatementKindInstance:
+		return 1
+	case CapabilityStatementKindCapability:
+		return 2
+	case CapabilityStatementKindRequirements:
+		return 3
+	}
+	return 0
+}
+func (code *CapabilityStatementKind) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CapabilityStatementKindInstance
+	case 2:
+		*code = CapabilityStatementKindCapability
+	case 3:
+		*code = CapabilityStatementKindRequirements
+	default:
+		return false
+	}
+	return true
+}
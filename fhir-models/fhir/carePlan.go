@@ -14,43 +14,57 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // CarePlan is documented here http://hl7.org/fhir/StructureDefinition/CarePlan
 type CarePlan struct {
-	Id                    *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string           `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string           `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference        `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Replaces              []Reference        `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	PartOf                []Reference        `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status                RequestStatus      `bson:"status" json:"status"`
-	Intent                CarePlanIntent     `bson:"intent" json:"intent"`
-	Category              []CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
-	Title                 *string            `bson:"title,omitempty" json:"title,omitempty"`
-	Description           *string            `bson:"description,omitempty" json:"description,omitempty"`
-	Subject               Reference          `bson:"subject" json:"subject"`
-	Encounter             *Reference         `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Period                *Period            `bson:"period,omitempty" json:"period,omitempty"`
-	Created               *string            `bson:"created,omitempty" json:"created,omitempty"`
-	Author                *Reference         `bson:"author,omitempty" json:"author,omitempty"`
-	Contributor           []Reference        `bson:"contributor,omitempty" json:"contributor,omitempty"`
-	CareTeam              []Reference        `bson:"careTeam,omitempty" json:"careTeam,omitempty"`
-	Addresses             []Reference        `bson:"addresses,omitempty" json:"addresses,omitempty"`
-	SupportingInfo        []Reference        `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
-	Goal                  []Reference        `bson:"goal,omitempty" json:"goal,omitempty"`
-	Activity              []CarePlanActivity `bson:"activity,omitempty" json:"activity,omitempty"`
-	Note                  []Annotation       `bson:"note,omitempty" json:"note,omitempty"`
+	Id                           *string            `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element           `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []string           `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element         `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string           `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element         `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference        `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Replaces                     []Reference        `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	PartOf                       []Reference        `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                       RequestStatus      `bson:"status" json:"status"`
+	StatusElement                *Element           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       CarePlanIntent     `bson:"intent" json:"intent"`
+	IntentElement                *Element           `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Category                     []CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
+	Title                        *string            `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement                 *Element           `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description                  *string            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element           `bson:"_description,omitempty" json:"_description,omitempty"`
+	Subject                      Reference          `bson:"subject" json:"subject"`
+	Encounter                    *Reference         `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Period                       *Period            `bson:"period,omitempty" json:"period,omitempty"`
+	Created                      *string            `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement               *Element           `bson:"_created,omitempty" json:"_created,omitempty"`
+	Author                       *Reference         `bson:"author,omitempty" json:"author,omitempty"`
+	Contributor                  []Reference        `bson:"contributor,omitempty" json:"contributor,omitempty"`
+	CareTeam                     []Reference        `bson:"careTeam,omitempty" json:"careTeam,omitempty"`
+	Addresses                    []Reference        `bson:"addresses,omitempty" json:"addresses,omitempty"`
+	SupportingInfo               []Reference        `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
+	Goal                         []Reference        `bson:"goal,omitempty" json:"goal,omitempty"`
+	Activity                     []CarePlanActivity `bson:"activity,omitempty" json:"activity,omitempty"`
+	Note                         []Annotation       `bson:"note,omitempty" json:"note,omitempty"`
 }
 type CarePlanActivity struct {
 	Id                     *string                 `bson:"id,omitempty" json:"id,omitempty"`
@@ -63,47 +77,3990 @@ type CarePlanActivity struct {
 	Detail                 *CarePlanActivityDetail `bson:"detail,omitempty" json:"detail,omitempty"`
 }
 type CarePlanActivityDetail struct {
-	Id                     *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Kind                   *CarePlanActivityKind  `bson:"kind,omitempty" json:"kind,omitempty"`
-	InstantiatesCanonical  []string               `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri        []string               `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	Code                   *CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
-	ReasonCode             []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference        []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Goal                   []Reference            `bson:"goal,omitempty" json:"goal,omitempty"`
-	Status                 CarePlanActivityStatus `bson:"status" json:"status"`
-	StatusReason           *CodeableConcept       `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	DoNotPerform           *bool                  `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	ScheduledTiming        *Timing                `bson:"scheduledTiming,omitempty" json:"scheduledTiming,omitempty"`
-	ScheduledPeriod        *Period                `bson:"scheduledPeriod,omitempty" json:"scheduledPeriod,omitempty"`
-	ScheduledString        *string                `bson:"scheduledString,omitempty" json:"scheduledString,omitempty"`
-	Location               *Reference             `bson:"location,omitempty" json:"location,omitempty"`
-	Performer              []Reference            `bson:"performer,omitempty" json:"performer,omitempty"`
-	ProductCodeableConcept *CodeableConcept       `bson:"productCodeableConcept,omitempty" json:"productCodeableConcept,omitempty"`
-	ProductReference       *Reference             `bson:"productReference,omitempty" json:"productReference,omitempty"`
-	DailyAmount            *Quantity              `bson:"dailyAmount,omitempty" json:"dailyAmount,omitempty"`
-	Quantity               *Quantity              `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Description            *string                `bson:"description,omitempty" json:"description,omitempty"`
-}
-type OtherCarePlan CarePlan
+	Id                           *string                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Kind                         *CarePlanActivityKind  `bson:"kind,omitempty" json:"kind,omitempty"`
+	KindElement                  *Element               `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	InstantiatesCanonical        []string               `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element             `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string               `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element             `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	Code                         *CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
+	ReasonCode                   []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Goal                         []Reference            `bson:"goal,omitempty" json:"goal,omitempty"`
+	Status                       CarePlanActivityStatus `bson:"status" json:"status"`
+	StatusElement                *Element               `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept       `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	DoNotPerform                 *bool                  `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement          *Element               `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	ScheduledTiming              *Timing                `bson:"scheduledTiming,omitempty" json:"scheduledTiming,omitempty"`
+	ScheduledPeriod              *Period                `bson:"scheduledPeriod,omitempty" json:"scheduledPeriod,omitempty"`
+	ScheduledString              *string                `bson:"scheduledString,omitempty" json:"scheduledString,omitempty"`
+	ScheduledStringElement       *Element               `bson:"_scheduledString,omitempty" json:"_scheduledString,omitempty"`
+	Location                     *Reference             `bson:"location,omitempty" json:"location,omitempty"`
+	Performer                    []Reference            `bson:"performer,omitempty" json:"performer,omitempty"`
+	ProductCodeableConcept       *CodeableConcept       `bson:"productCodeableConcept,omitempty" json:"productCodeableConcept,omitempty"`
+	ProductReference             *Reference             `bson:"productReference,omitempty" json:"productReference,omitempty"`
+	DailyAmount                  *Quantity              `bson:"dailyAmount,omitempty" json:"dailyAmount,omitempty"`
+	Quantity                     *Quantity              `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	Description                  *string                `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element               `bson:"_description,omitempty" json:"_description,omitempty"`
+}
+
+// CarePlanActivityDetailScheduled is implemented by the types allowed for CarePlan.activity.detail.scheduled[x]
+type CarePlanActivityDetailScheduled interface {
+	isCarePlanActivityDetailScheduled()
+}
+
+func (Timing) isCarePlanActivityDetailScheduled() {}
+func (Period) isCarePlanActivityDetailScheduled() {}
+func (String) isCarePlanActivityDetailScheduled() {}
+
+// Scheduled returns the value of CarePlan.activity.detail.scheduled[x] together with its FHIR type code or nil if no value is set
+func (r CarePlanActivityDetail) Scheduled() (CarePlanActivityDetailScheduled, string) {
+	if r.ScheduledTiming != nil {
+		return *r.ScheduledTiming, "Timing"
+	}
+	if r.ScheduledPeriod != nil {
+		return *r.ScheduledPeriod, "Period"
+	}
+	if r.ScheduledString != nil {
+		return String(*r.ScheduledString), "string"
+	}
+	return nil, ""
+}
+
+// SetScheduled sets the value of CarePlan.activity.detail.scheduled[x], given as value or pointer, and clears all other types
+func (r *CarePlanActivityDetail) SetScheduled(value CarePlanActivityDetailScheduled) {
+	r.ScheduledTiming = nil
+	r.ScheduledPeriod = nil
+	r.ScheduledString = nil
+	switch v := value.(type) {
+	case Timing:
+		r.ScheduledTiming = &v
+	case *Timing:
+		r.ScheduledTiming = v
+	case Period:
+		r.ScheduledPeriod = &v
+	case *Period:
+		r.ScheduledPeriod = v
+	case String:
+		primitive := string(v)
+		r.ScheduledString = &primitive
+	case *String:
+		r.ScheduledString = (*string)(v)
+	}
+}
+
+// CarePlanActivityDetailProduct is implemented by the types allowed for CarePlan.activity.detail.product[x]
+type CarePlanActivityDetailProduct interface {
+	isCarePlanActivityDetailProduct()
+}
+
+func (CodeableConcept) isCarePlanActivityDetailProduct() {}
+func (Reference) isCarePlanActivityDetailProduct()       {}
+
+// Product returns the value of CarePlan.activity.detail.product[x] together with its FHIR type code or nil if no value is set
+func (r CarePlanActivityDetail) Product() (CarePlanActivityDetailProduct, string) {
+	if r.ProductCodeableConcept != nil {
+		return *r.ProductCodeableConcept, "CodeableConcept"
+	}
+	if r.ProductReference != nil {
+		return *r.ProductReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetProduct sets the value of CarePlan.activity.detail.product[x], given as value or pointer, and clears all other types
+func (r *CarePlanActivityDetail) SetProduct(value CarePlanActivityDetailProduct) {
+	r.ProductCodeableConcept = nil
+	r.ProductReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ProductCodeableConcept = &v
+	case *CodeableConcept:
+		r.ProductCodeableConcept = v
+	case Reference:
+		r.ProductReference = &v
+	case *Reference:
+		r.ProductReference = v
+	}
+}
 
 // MarshalJSON marshals the given CarePlan as JSON into a byte slice
 func (r CarePlan) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCarePlan
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCarePlan: OtherCarePlan(r),
-		ResourceType:  "CarePlan",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CarePlan as JSON object with the members in the order of the fields
+func (r CarePlan) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"CarePlan\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Replaces) > 0 {
+		e.key("replaces")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Replaces {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("intent")
+	e.string(r.Intent.Code())
+	if r.IntentElement != nil {
+		e.key("_intent")
+		r.IntentElement.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.Created != nil {
+		e.key("created")
+		e.string(*r.Created)
+	}
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.Author != nil {
+		e.key("author")
+		r.Author.appendJSON(e)
+	}
+	if len(r.Contributor) > 0 {
+		e.key("contributor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contributor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CareTeam) > 0 {
+		e.key("careTeam")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CareTeam {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Addresses) > 0 {
+		e.key("addresses")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Addresses {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInfo) > 0 {
+		e.key("supportingInfo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInfo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Goal) > 0 {
+		e.key("goal")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Goal {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Activity) > 0 {
+		e.key("activity")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Activity {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CarePlan from JSON. Unknown members are ignored.
+func (r *CarePlan) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CarePlan
+func (r *CarePlan) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "replaces":
+			a := d.array()
+			r.Replaces = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Replaces = append(r.Replaces, v)
+			}
+			if r.Replaces == nil && !a.isNull {
+				r.Replaces = []Reference{}
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "intent":
+			d.unmarshal(&r.Intent)
+		case "_intent":
+			r.IntentElement = d.element()
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = &v
+			} else {
+				r.Created = nil
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "author":
+			if d.null() {
+				r.Author = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = &v
+			}
+		case "contributor":
+			a := d.array()
+			r.Contributor = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Contributor = append(r.Contributor, v)
+			}
+			if r.Contributor == nil && !a.isNull {
+				r.Contributor = []Reference{}
+			}
+		case "careTeam":
+			a := d.array()
+			r.CareTeam = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.CareTeam = append(r.CareTeam, v)
+			}
+			if r.CareTeam == nil && !a.isNull {
+				r.CareTeam = []Reference{}
+			}
+		case "addresses":
+			a := d.array()
+			r.Addresses = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Addresses = append(r.Addresses, v)
+			}
+			if r.Addresses == nil && !a.isNull {
+				r.Addresses = []Reference{}
+			}
+		case "supportingInfo":
+			a := d.array()
+			r.SupportingInfo = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			}
+			if r.SupportingInfo == nil && !a.isNull {
+				r.SupportingInfo = []Reference{}
+			}
+		case "goal":
+			a := d.array()
+			r.Goal = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Goal = append(r.Goal, v)
+			}
+			if r.Goal == nil && !a.isNull {
+				r.Goal = []Reference{}
+			}
+		case "activity":
+			a := d.array()
+			r.Activity = nil
+			for a.next() {
+				var v CarePlanActivity
+				v.decodeJSON(d)
+				r.Activity = append(r.Activity, v)
+			}
+			if r.Activity == nil && !a.isNull {
+				r.Activity = []CarePlanActivity{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CarePlan which shares no memory with the original
+func (r CarePlan) DeepCopy() CarePlan {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.Replaces != nil {
+		out.Replaces = make([]Reference, len(r.Replaces))
+		for i := range r.Replaces {
+			out.Replaces[i] = r.Replaces[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.IntentElement != nil {
+		v := r.IntentElement.DeepCopy()
+		out.IntentElement = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Created != nil {
+		v := *r.Created
+		out.Created = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Author != nil {
+		v := r.Author.DeepCopy()
+		out.Author = &v
+	}
+	if r.Contributor != nil {
+		out.Contributor = make([]Reference, len(r.Contributor))
+		for i := range r.Contributor {
+			out.Contributor[i] = r.Contributor[i].DeepCopy()
+		}
+	}
+	if r.CareTeam != nil {
+		out.CareTeam = make([]Reference, len(r.CareTeam))
+		for i := range r.CareTeam {
+			out.CareTeam[i] = r.CareTeam[i].DeepCopy()
+		}
+	}
+	if r.Addresses != nil {
+		out.Addresses = make([]Reference, len(r.Addresses))
+		for i := range r.Addresses {
+			out.Addresses[i] = r.Addresses[i].DeepCopy()
+		}
+	}
+	if r.SupportingInfo != nil {
+		out.SupportingInfo = make([]Reference, len(r.SupportingInfo))
+		for i := range r.SupportingInfo {
+			out.SupportingInfo[i] = r.SupportingInfo[i].DeepCopy()
+		}
+	}
+	if r.Goal != nil {
+		out.Goal = make([]Reference, len(r.Goal))
+		for i := range r.Goal {
+			out.Goal[i] = r.Goal[i].DeepCopy()
+		}
+	}
+	if r.Activity != nil {
+		out.Activity = make([]CarePlanActivity, len(r.Activity))
+		for i := range r.Activity {
+			out.Activity[i] = r.Activity[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CarePlan) Equal(other CarePlan) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.Replaces) != len(other.Replaces) {
+		return false
+	}
+	for i := range r.Replaces {
+		if !r.Replaces[i].Equal(other.Replaces[i]) {
+			return false
+		}
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.Equal(*other.IntentElement) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && *r.Created != *other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.Equal(*other.Author) {
+		return false
+	}
+	if len(r.Contributor) != len(other.Contributor) {
+		return false
+	}
+	for i := range r.Contributor {
+		if !r.Contributor[i].Equal(other.Contributor[i]) {
+			return false
+		}
+	}
+	if len(r.CareTeam) != len(other.CareTeam) {
+		return false
+	}
+	for i := range r.CareTeam {
+		if !r.CareTeam[i].Equal(other.CareTeam[i]) {
+			return false
+		}
+	}
+	if len(r.Addresses) != len(other.Addresses) {
+		return false
+	}
+	for i := range r.Addresses {
+		if !r.Addresses[i].Equal(other.Addresses[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingInfo) != len(other.SupportingInfo) {
+		return false
+	}
+	for i := range r.SupportingInfo {
+		if !r.SupportingInfo[i].Equal(other.SupportingInfo[i]) {
+			return false
+		}
+	}
+	if len(r.Goal) != len(other.Goal) {
+		return false
+	}
+	for i := range r.Goal {
+		if !r.Goal[i].Equal(other.Goal[i]) {
+			return false
+		}
+	}
+	if len(r.Activity) != len(other.Activity) {
+		return false
+	}
+	for i := range r.Activity {
+		if !r.Activity[i].Equal(other.Activity[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CarePlan) EqualsDeep(other CarePlan) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Replaces), len(other.Replaces), func(i, j int) bool {
+		return r.Replaces[i].EqualsDeep(other.Replaces[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.EqualsDeep(*other.IntentElement) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.EqualsDeep(*other.Author) {
+		return false
+	}
+	if !equivalentList(len(r.Contributor), len(other.Contributor), func(i, j int) bool {
+		return r.Contributor[i].EqualsDeep(other.Contributor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.CareTeam), len(other.CareTeam), func(i, j int) bool {
+		return r.CareTeam[i].EqualsDeep(other.CareTeam[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Addresses), len(other.Addresses), func(i, j int) bool {
+		return r.Addresses[i].EqualsDeep(other.Addresses[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfo), len(other.SupportingInfo), func(i, j int) bool {
+		return r.SupportingInfo[i].EqualsDeep(other.SupportingInfo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Goal), len(other.Goal), func(i, j int) bool {
+		return r.Goal[i].EqualsDeep(other.Goal[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Activity), len(other.Activity), func(i, j int) bool {
+		return r.Activity[i].EqualsDeep(other.Activity[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CarePlan) EqualsShallow(other CarePlan) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CarePlan as FHIR XML
+func (r CarePlan) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "CarePlan",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Replaces {
+		if err := encodeXMLElement(e, "replaces", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "intent", r.Intent, r.IntentElement); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "author", r.Author); err != nil {
+		return err
+	}
+	for _, v := range r.Contributor {
+		if err := encodeXMLElement(e, "contributor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CareTeam {
+		if err := encodeXMLElement(e, "careTeam", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Addresses {
+		if err := encodeXMLElement(e, "addresses", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SupportingInfo {
+		if err := encodeXMLElement(e, "supportingInfo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Goal {
+		if err := encodeXMLElement(e, "goal", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Activity {
+		if err := encodeXMLElement(e, "activity", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CarePlan from FHIR XML
+func (r *CarePlan) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "replaces":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Replaces = append(r.Replaces, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v RequestStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "intent":
+				var v CarePlanIntent
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Intent = v
+				}
+				r.IntentElement = element
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = &v
+				}
+				r.CreatedElement = element
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = &v
+			case "contributor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contributor = append(r.Contributor, v)
+			case "careTeam":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CareTeam = append(r.CareTeam, v)
+			case "addresses":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Addresses = append(r.Addresses, v)
+			case "supportingInfo":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			case "goal":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Goal = append(r.Goal, v)
+			case "activity":
+				var v CarePlanActivity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Activity = append(r.Activity, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CarePlan as properties to the RDF node n
+func (r CarePlan) turtle(n *turtleNode) {
+	n.resource("CarePlan", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("CarePlan.identifier", i, v)
+	}
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("CarePlan.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("CarePlan.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	for i, v := range r.BasedOn {
+		n.element("CarePlan.basedOn", i, v)
+	}
+	for i, v := range r.Replaces {
+		n.element("CarePlan.replaces", i, v)
+	}
+	for i, v := range r.PartOf {
+		n.element("CarePlan.partOf", i, v)
+	}
+	n.primitive("CarePlan.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("CarePlan.intent", -1, r.Intent, r.IntentElement, "code")
+	for i, v := range r.Category {
+		n.element("CarePlan.category", i, v)
+	}
+	n.primitive("CarePlan.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("CarePlan.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("CarePlan.subject", -1, r.Subject)
+	n.element("CarePlan.encounter", -1, r.Encounter)
+	n.element("CarePlan.period", -1, r.Period)
+	n.primitive("CarePlan.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("CarePlan.author", -1, r.Author)
+	for i, v := range r.Contributor {
+		n.element("CarePlan.contributor", i, v)
+	}
+	for i, v := range r.CareTeam {
+		n.element("CarePlan.careTeam", i, v)
+	}
+	for i, v := range r.Addresses {
+		n.element("CarePlan.addresses", i, v)
+	}
+	for i, v := range r.SupportingInfo {
+		n.element("CarePlan.supportingInfo", i, v)
+	}
+	for i, v := range r.Goal {
+		n.element("CarePlan.goal", i, v)
+	}
+	for i, v := range r.Activity {
+		n.element("CarePlan.activity", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("CarePlan.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CarePlan as BSON document with the structure of its FHIR JSON
+func (r CarePlan) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CarePlan from a BSON document with the structure of its FHIR JSON
+func (r *CarePlan) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CarePlan as protobuf message CarePlan of fhir.proto
+func (r CarePlan) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CarePlan to the encoded message
+func (r CarePlan) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(10, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(11, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(12, v)
+	}
+	for _, v := range r.Replaces {
+		e.message(13, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(14, v)
+	}
+	e.varint(15, r.Status.protoNumber())
+	e.varint(16, r.Intent.protoNumber())
+	for _, v := range r.Category {
+		e.message(17, v)
+	}
+	if r.Title != nil {
+		e.string(18, *r.Title)
+	}
+	if r.Description != nil {
+		e.string(19, *r.Description)
+	}
+	e.message(20, r.Subject)
+	if r.Encounter != nil {
+		e.message(21, *r.Encounter)
+	}
+	if r.Period != nil {
+		e.message(22, *r.Period)
+	}
+	if r.Created != nil {
+		e.string(23, *r.Created)
+	}
+	if r.Author != nil {
+		e.message(24, *r.Author)
+	}
+	for _, v := range r.Contributor {
+		e.message(25, v)
+	}
+	for _, v := range r.CareTeam {
+		e.message(26, v)
+	}
+	for _, v := range r.Addresses {
+		e.message(27, v)
+	}
+	for _, v := range r.SupportingInfo {
+		e.message(28, v)
+	}
+	for _, v := range r.Goal {
+		e.message(29, v)
+	}
+	for _, v := range r.Activity {
+		e.message(30, v)
+	}
+	for _, v := range r.Note {
+		e.message(31, v)
+	}
+	if r.IdElement != nil {
+		e.message(32, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(33, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(34, *r.LanguageElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(35, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(36, v)
+	}
+	if r.StatusElement != nil {
+		e.message(37, *r.StatusElement)
+	}
+	if r.IntentElement != nil {
+		e.message(38, *r.IntentElement)
+	}
+	if r.TitleElement != nil {
+		e.message(39, *r.TitleElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(40, *r.DescriptionElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(41, *r.CreatedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CarePlan from protobuf message CarePlan of fhir.proto
+func (r *CarePlan) UnmarshalProto(b []byte) error {
+	*r = CarePlan{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 11:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Replaces = append(r.Replaces, v)
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 15:
+			var v RequestStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 16:
+			var v CarePlanIntent
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Intent = v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 18:
+			v := d.string()
+			r.Title = &v
+		case 19:
+			v := d.string()
+			r.Description = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 22:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 23:
+			v := d.string()
+			r.Created = &v
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Author = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Contributor = append(r.Contributor, v)
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.CareTeam = append(r.CareTeam, v)
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.Addresses = append(r.Addresses, v)
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.SupportingInfo = append(r.SupportingInfo, v)
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.Goal = append(r.Goal, v)
+		case 30:
+			var v CarePlanActivity
+			d.message(&v)
+			r.Activity = append(r.Activity, v)
+		case 31:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 32:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 35:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 36:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		case 37:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.IntentElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CarePlanActivity as JSON into a byte slice
+func (r CarePlanActivity) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CarePlanActivity as JSON object with the members in the order of the fields
+func (r CarePlanActivity) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.OutcomeCodeableConcept) > 0 {
+		e.key("outcomeCodeableConcept")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.OutcomeCodeableConcept {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.OutcomeReference) > 0 {
+		e.key("outcomeReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.OutcomeReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Progress) > 0 {
+		e.key("progress")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Progress {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Reference != nil {
+		e.key("reference")
+		r.Reference.appendJSON(e)
+	}
+	if r.Detail != nil {
+		e.key("detail")
+		r.Detail.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CarePlanActivity from JSON. Unknown members are ignored.
+func (r *CarePlanActivity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CarePlanActivity
+func (r *CarePlanActivity) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "outcomeCodeableConcept":
+			a := d.array()
+			r.OutcomeCodeableConcept = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OutcomeCodeableConcept = append(r.OutcomeCodeableConcept, v)
+			}
+			if r.OutcomeCodeableConcept == nil && !a.isNull {
+				r.OutcomeCodeableConcept = []CodeableConcept{}
+			}
+		case "outcomeReference":
+			a := d.array()
+			r.OutcomeReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.OutcomeReference = append(r.OutcomeReference, v)
+			}
+			if r.OutcomeReference == nil && !a.isNull {
+				r.OutcomeReference = []Reference{}
+			}
+		case "progress":
+			a := d.array()
+			r.Progress = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Progress = append(r.Progress, v)
+			}
+			if r.Progress == nil && !a.isNull {
+				r.Progress = []Annotation{}
+			}
+		case "reference":
+			if d.null() {
+				r.Reference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Reference = &v
+			}
+		case "detail":
+			if d.null() {
+				r.Detail = nil
+			} else {
+				var v CarePlanActivityDetail
+				v.decodeJSON(d)
+				r.Detail = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CarePlanActivity which shares no memory with the original
+func (r CarePlanActivity) DeepCopy() CarePlanActivity {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.OutcomeCodeableConcept != nil {
+		out.OutcomeCodeableConcept = make([]CodeableConcept, len(r.OutcomeCodeableConcept))
+		for i := range r.OutcomeCodeableConcept {
+			out.OutcomeCodeableConcept[i] = r.OutcomeCodeableConcept[i].DeepCopy()
+		}
+	}
+	if r.OutcomeReference != nil {
+		out.OutcomeReference = make([]Reference, len(r.OutcomeReference))
+		for i := range r.OutcomeReference {
+			out.OutcomeReference[i] = r.OutcomeReference[i].DeepCopy()
+		}
+	}
+	if r.Progress != nil {
+		out.Progress = make([]Annotation, len(r.Progress))
+		for i := range r.Progress {
+			out.Progress[i] = r.Progress[i].DeepCopy()
+		}
+	}
+	if r.Reference != nil {
+		v := r.Reference.DeepCopy()
+		out.Reference = &v
+	}
+	if r.Detail != nil {
+		v := r.Detail.DeepCopy()
+		out.Detail = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CarePlanActivity) Equal(other CarePlanActivity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.OutcomeCodeableConcept) != len(other.OutcomeCodeableConcept) {
+		return false
+	}
+	for i := range r.OutcomeCodeableConcept {
+		if !r.OutcomeCodeableConcept[i].Equal(other.OutcomeCodeableConcept[i]) {
+			return false
+		}
+	}
+	if len(r.OutcomeReference) != len(other.OutcomeReference) {
+		return false
+	}
+	for i := range r.OutcomeReference {
+		if !r.OutcomeReference[i].Equal(other.OutcomeReference[i]) {
+			return false
+		}
+	}
+	if len(r.Progress) != len(other.Progress) {
+		return false
+	}
+	for i := range r.Progress {
+		if !r.Progress[i].Equal(other.Progress[i]) {
+			return false
+		}
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !r.Reference.Equal(*other.Reference) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !r.Detail.Equal(*other.Detail) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CarePlanActivity) EqualsDeep(other CarePlanActivity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.OutcomeCodeableConcept), len(other.OutcomeCodeableConcept), func(i, j int) bool {
+		return r.OutcomeCodeableConcept[i].EqualsDeep(other.OutcomeCodeableConcept[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.OutcomeReference), len(other.OutcomeReference), func(i, j int) bool {
+		return r.OutcomeReference[i].EqualsDeep(other.OutcomeReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Progress), len(other.Progress), func(i, j int) bool {
+		return r.Progress[i].EqualsDeep(other.Progress[j])
+	}) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !r.Reference.EqualsDeep(*other.Reference) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !r.Detail.EqualsDeep(*other.Detail) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CarePlanActivity) EqualsShallow(other CarePlanActivity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CarePlanActivity as FHIR XML
+func (r CarePlanActivity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.OutcomeCodeableConcept {
+		if err := encodeXMLElement(e, "outcomeCodeableConcept", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.OutcomeReference {
+		if err := encodeXMLElement(e, "outcomeReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Progress {
+		if err := encodeXMLElement(e, "progress", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "reference", r.Reference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "detail", r.Detail); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CarePlanActivity from FHIR XML
+func (r *CarePlanActivity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "outcomeCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OutcomeCodeableConcept = append(r.OutcomeCodeableConcept, v)
+			case "outcomeReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OutcomeReference = append(r.OutcomeReference, v)
+			case "progress":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Progress = append(r.Progress, v)
+			case "reference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = &v
+			case "detail":
+				var v CarePlanActivityDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CarePlanActivity as properties to the RDF node n
+func (r CarePlanActivity) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.OutcomeCodeableConcept {
+		n.element("CarePlan.activity.outcomeCodeableConcept", i, v)
+	}
+	for i, v := range r.OutcomeReference {
+		n.element("CarePlan.activity.outcomeReference", i, v)
+	}
+	for i, v := range r.Progress {
+		n.element("CarePlan.activity.progress", i, v)
+	}
+	n.element("CarePlan.activity.reference", -1, r.Reference)
+	n.element("CarePlan.activity.detail", -1, r.Detail)
+}
+
+// MarshalBSON marshals the given CarePlanActivity as BSON document with the structure of its FHIR JSON
+func (r CarePlanActivity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CarePlanActivity from a BSON document with the structure of its FHIR JSON
+func (r *CarePlanActivity) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CarePlanActivity as protobuf message CarePlanActivity of fhir.proto
+func (r CarePlanActivity) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CarePlanActivity to the encoded message
+func (r CarePlanActivity) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.OutcomeCodeableConcept {
+		e.message(4, v)
+	}
+	for _, v := range r.OutcomeReference {
+		e.message(5, v)
+	}
+	for _, v := range r.Progress {
+		e.message(6, v)
+	}
+	if r.Reference != nil {
+		e.message(7, *r.Reference)
+	}
+	if r.Detail != nil {
+		e.message(8, *r.Detail)
+	}
+}
+
+// UnmarshalProto unmarshals the given CarePlanActivity from protobuf message CarePlanActivity of fhir.proto
+func (r *CarePlanActivity) UnmarshalProto(b []byte) error {
+	*r = CarePlanActivity{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.OutcomeCodeableConcept = append(r.OutcomeCodeableConcept, v)
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.OutcomeReference = append(r.OutcomeReference, v)
+		case 6:
+			var v Annotation
+			d.message(&v)
+			r.Progress = append(r.Progress, v)
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Reference = &v
+		case 8:
+			var v CarePlanActivityDetail
+			d.message(&v)
+			r.Detail = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CarePlanActivityDetail as JSON into a byte slice
+func (r CarePlanActivityDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CarePlanActivityDetail as JSON object with the members in the order of the fields
+func (r CarePlanActivityDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Kind != nil {
+		e.key("kind")
+		e.string(r.Kind.Code())
+	}
+	if r.KindElement != nil {
+		e.key("_kind")
+		r.KindElement.appendJSON(e)
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Goal) > 0 {
+		e.key("goal")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Goal {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusReason != nil {
+		e.key("statusReason")
+		r.StatusReason.appendJSON(e)
+	}
+	if r.DoNotPerform != nil {
+		e.key("doNotPerform")
+		e.bool(*r.DoNotPerform)
+	}
+	if r.DoNotPerformElement != nil {
+		e.key("_doNotPerform")
+		r.DoNotPerformElement.appendJSON(e)
+	}
+	if r.ScheduledTiming != nil {
+		e.key("scheduledTiming")
+		r.ScheduledTiming.appendJSON(e)
+	}
+	if r.ScheduledPeriod != nil {
+		e.key("scheduledPeriod")
+		r.ScheduledPeriod.appendJSON(e)
+	}
+	if r.ScheduledString != nil {
+		e.key("scheduledString")
+		e.string(*r.ScheduledString)
+	}
+	if r.ScheduledStringElement != nil {
+		e.key("_scheduledString")
+		r.ScheduledStringElement.appendJSON(e)
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ProductCodeableConcept != nil {
+		e.key("productCodeableConcept")
+		r.ProductCodeableConcept.appendJSON(e)
+	}
+	if r.ProductReference != nil {
+		e.key("productReference")
+		r.ProductReference.appendJSON(e)
+	}
+	if r.DailyAmount != nil {
+		e.key("dailyAmount")
+		r.DailyAmount.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CarePlanActivityDetail from JSON. Unknown members are ignored.
+func (r *CarePlanActivityDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CarePlanActivityDetail
+func (r *CarePlanActivityDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "kind":
+			if d.null() {
+				r.Kind = nil
+			} else {
+				var v CarePlanActivityKind
+				d.unmarshal(&v)
+				r.Kind = &v
+			}
+		case "_kind":
+			r.KindElement = d.element()
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "goal":
+			a := d.array()
+			r.Goal = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Goal = append(r.Goal, v)
+			}
+			if r.Goal == nil && !a.isNull {
+				r.Goal = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			if d.null() {
+				r.StatusReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = &v
+			}
+		case "doNotPerform":
+			if v, ok := d.bool(); ok {
+				r.DoNotPerform = &v
+			} else {
+				r.DoNotPerform = nil
+			}
+		case "_doNotPerform":
+			r.DoNotPerformElement = d.element()
+		case "scheduledTiming":
+			if d.null() {
+				r.ScheduledTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.ScheduledTiming = &v
+			}
+		case "scheduledPeriod":
+			if d.null() {
+				r.ScheduledPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ScheduledPeriod = &v
+			}
+		case "scheduledString":
+			if v, ok := d.string(); ok {
+				r.ScheduledString = &v
+			} else {
+				r.ScheduledString = nil
+			}
+		case "_scheduledString":
+			r.ScheduledStringElement = d.element()
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []Reference{}
+			}
+		case "productCodeableConcept":
+			if d.null() {
+				r.ProductCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProductCodeableConcept = &v
+			}
+		case "productReference":
+			if d.null() {
+				r.ProductReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ProductReference = &v
+			}
+		case "dailyAmount":
+			if d.null() {
+				r.DailyAmount = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.DailyAmount = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CarePlanActivityDetail which shares no memory with the original
+func (r CarePlanActivityDetail) DeepCopy() CarePlanActivityDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Kind != nil {
+		v := *r.Kind
+		out.Kind = &v
+	}
+	if r.KindElement != nil {
+		v := r.KindElement.DeepCopy()
+		out.KindElement = &v
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Goal != nil {
+		out.Goal = make([]Reference, len(r.Goal))
+		for i := range r.Goal {
+			out.Goal[i] = r.Goal[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		v := r.StatusReason.DeepCopy()
+		out.StatusReason = &v
+	}
+	if r.DoNotPerform != nil {
+		v := *r.DoNotPerform
+		out.DoNotPerform = &v
+	}
+	if r.DoNotPerformElement != nil {
+		v := r.DoNotPerformElement.DeepCopy()
+		out.DoNotPerformElement = &v
+	}
+	if r.ScheduledTiming != nil {
+		v := r.ScheduledTiming.DeepCopy()
+		out.ScheduledTiming = &v
+	}
+	if r.ScheduledPeriod != nil {
+		v := r.ScheduledPeriod.DeepCopy()
+		out.ScheduledPeriod = &v
+	}
+	if r.ScheduledString != nil {
+		v := *r.ScheduledString
+		out.ScheduledString = &v
+	}
+	if r.ScheduledStringElement != nil {
+		v := r.ScheduledStringElement.DeepCopy()
+		out.ScheduledStringElement = &v
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]Reference, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.ProductCodeableConcept != nil {
+		v := r.ProductCodeableConcept.DeepCopy()
+		out.ProductCodeableConcept = &v
+	}
+	if r.ProductReference != nil {
+		v := r.ProductReference.DeepCopy()
+		out.ProductReference = &v
+	}
+	if r.DailyAmount != nil {
+		v := r.DailyAmount.DeepCopy()
+		out.DailyAmount = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CarePlanActivityDetail) Equal(other CarePlanActivityDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Kind == nil) != (other.Kind == nil) || r.Kind != nil && *r.Kind != *other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.Equal(*other.KindElement) {
+		return false
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Goal) != len(other.Goal) {
+		return false
+	}
+	for i := range r.Goal {
+		if !r.Goal[i].Equal(other.Goal[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.Equal(*other.StatusReason) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.Equal(*other.DoNotPerformElement) {
+		return false
+	}
+	if (r.ScheduledTiming == nil) != (other.ScheduledTiming == nil) || r.ScheduledTiming != nil && !r.ScheduledTiming.Equal(*other.ScheduledTiming) {
+		return false
+	}
+	if (r.ScheduledPeriod == nil) != (other.ScheduledPeriod == nil) || r.ScheduledPeriod != nil && !r.ScheduledPeriod.Equal(*other.ScheduledPeriod) {
+		return false
+	}
+	if (r.ScheduledString == nil) != (other.ScheduledString == nil) || r.ScheduledString != nil && *r.ScheduledString != *other.ScheduledString {
+		return false
+	}
+	if (r.ScheduledStringElement == nil) != (other.ScheduledStringElement == nil) || r.ScheduledStringElement != nil && !r.ScheduledStringElement.Equal(*other.ScheduledStringElement) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if (r.ProductCodeableConcept == nil) != (other.ProductCodeableConcept == nil) || r.ProductCodeableConcept != nil && !r.ProductCodeableConcept.Equal(*other.ProductCodeableConcept) {
+		return false
+	}
+	if (r.ProductReference == nil) != (other.ProductReference == nil) || r.ProductReference != nil && !r.ProductReference.Equal(*other.ProductReference) {
+		return false
+	}
+	if (r.DailyAmount == nil) != (other.DailyAmount == nil) || r.DailyAmount != nil && !r.DailyAmount.Equal(*other.DailyAmount) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CarePlanActivityDetail) EqualsDeep(other CarePlanActivityDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Kind == nil) != (other.Kind == nil) || r.Kind != nil && *r.Kind != *other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.EqualsDeep(*other.KindElement) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Goal), len(other.Goal), func(i, j int) bool {
+		return r.Goal[i].EqualsDeep(other.Goal[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.EqualsDeep(*other.StatusReason) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.EqualsDeep(*other.DoNotPerformElement) {
+		return false
+	}
+	if (r.ScheduledTiming == nil) != (other.ScheduledTiming == nil) || r.ScheduledTiming != nil && !r.ScheduledTiming.EqualsDeep(*other.ScheduledTiming) {
+		return false
+	}
+	if (r.ScheduledPeriod == nil) != (other.ScheduledPeriod == nil) || r.ScheduledPeriod != nil && !r.ScheduledPeriod.EqualsDeep(*other.ScheduledPeriod) {
+		return false
+	}
+	if (r.ScheduledString == nil) != (other.ScheduledString == nil) || r.ScheduledString != nil && !equivalentString(*r.ScheduledString, *other.ScheduledString) {
+		return false
+	}
+	if (r.ScheduledStringElement == nil) != (other.ScheduledStringElement == nil) || r.ScheduledStringElement != nil && !r.ScheduledStringElement.EqualsDeep(*other.ScheduledStringElement) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if (r.ProductCodeableConcept == nil) != (other.ProductCodeableConcept == nil) || r.ProductCodeableConcept != nil && !r.ProductCodeableConcept.EqualsDeep(*other.ProductCodeableConcept) {
+		return false
+	}
+	if (r.ProductReference == nil) != (other.ProductReference == nil) || r.ProductReference != nil && !r.ProductReference.EqualsDeep(*other.ProductReference) {
+		return false
+	}
+	if (r.DailyAmount == nil) != (other.DailyAmount == nil) || r.DailyAmount != nil && !r.DailyAmount.EqualsDeep(*other.DailyAmount) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CarePlanActivityDetail) EqualsShallow(other CarePlanActivityDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Kind == nil) != (other.Kind == nil) || r.Kind != nil && *r.Kind != *other.Kind {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.ScheduledString == nil) != (other.ScheduledString == nil) || r.ScheduledString != nil && !equivalentString(*r.ScheduledString, *other.ScheduledString) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CarePlanActivityDetail as FHIR XML
+func (r CarePlanActivityDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "kind", r.Kind, r.KindElement); err != nil {
+		return err
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Goal {
+		if err := encodeXMLElement(e, "goal", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReason", r.StatusReason); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doNotPerform", r.DoNotPerform, r.DoNotPerformElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "scheduledTiming", r.ScheduledTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "scheduledPeriod", r.ScheduledPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "scheduledString", r.ScheduledString, r.ScheduledStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "productCodeableConcept", r.ProductCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productReference", r.ProductReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dailyAmount", r.DailyAmount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CarePlanActivityDetail from FHIR XML
+func (r *CarePlanActivityDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "kind":
+				var v CarePlanActivityKind
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Kind = &v
+				}
+				r.KindElement = element
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "goal":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Goal = append(r.Goal, v)
+			case "status":
+				var v CarePlanActivityStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = &v
+			case "doNotPerform":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoNotPerform = &v
+				}
+				r.DoNotPerformElement = element
+			case "scheduledTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ScheduledTiming = &v
+			case "scheduledPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ScheduledPeriod = &v
+			case "scheduledString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ScheduledString = &v
+				}
+				r.ScheduledStringElement = element
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "productCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductCodeableConcept = &v
+			case "productReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductReference = &v
+			case "dailyAmount":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DailyAmount = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CarePlanActivityDetail as properties to the RDF node n
+func (r CarePlanActivityDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CarePlan.activity.detail.kind", -1, r.Kind, r.KindElement, "code")
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("CarePlan.activity.detail.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("CarePlan.activity.detail.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	n.element("CarePlan.activity.detail.code", -1, r.Code)
+	for i, v := range r.ReasonCode {
+		n.element("CarePlan.activity.detail.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("CarePlan.activity.detail.reasonReference", i, v)
+	}
+	for i, v := range r.Goal {
+		n.element("CarePlan.activity.detail.goal", i, v)
+	}
+	n.primitive("CarePlan.activity.detail.status", -1, r.Status, r.StatusElement, "code")
+	n.element("CarePlan.activity.detail.statusReason", -1, r.StatusReason)
+	n.primitive("CarePlan.activity.detail.doNotPerform", -1, r.DoNotPerform, r.DoNotPerformElement, "boolean")
+	n.element("CarePlan.activity.detail.scheduledTiming", -1, r.ScheduledTiming)
+	n.element("CarePlan.activity.detail.scheduledPeriod", -1, r.ScheduledPeriod)
+	n.primitive("CarePlan.activity.detail.scheduledString", -1, r.ScheduledString, r.ScheduledStringElement, "string")
+	n.element("CarePlan.activity.detail.location", -1, r.Location)
+	for i, v := range r.Performer {
+		n.element("CarePlan.activity.detail.performer", i, v)
+	}
+	n.element("CarePlan.activity.detail.productCodeableConcept", -1, r.ProductCodeableConcept)
+	n.element("CarePlan.activity.detail.productReference", -1, r.ProductReference)
+	n.element("CarePlan.activity.detail.dailyAmount", -1, r.DailyAmount)
+	n.element("CarePlan.activity.detail.quantity", -1, r.Quantity)
+	n.primitive("CarePlan.activity.detail.description", -1, r.Description, r.DescriptionElement, "string")
+}
+
+// MarshalBSON marshals the given CarePlanActivityDetail as BSON document with the structure of its FHIR JSON
+func (r CarePlanActivityDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CarePlanActivityDetail from a BSON document with the structure of its FHIR JSON
+func (r *CarePlanActivityDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CarePlanActivityDetail as protobuf message CarePlanActivityDetail of fhir.proto
+func (r CarePlanActivityDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CarePlanActivityDetail to the encoded message
+func (r CarePlanActivityDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Kind != nil {
+		e.varint(4, r.Kind.protoNumber())
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(5, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(6, v)
+	}
+	if r.Code != nil {
+		e.message(7, *r.Code)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(8, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(9, v)
+	}
+	for _, v := range r.Goal {
+		e.message(10, v)
+	}
+	e.varint(11, r.Status.protoNumber())
+	if r.StatusReason != nil {
+		e.message(12, *r.StatusReason)
+	}
+	if r.DoNotPerform != nil {
+		e.varint(13, protoBool(*r.DoNotPerform))
+	}
+	if r.ScheduledTiming != nil {
+		e.message(14, *r.ScheduledTiming)
+	}
+	if r.ScheduledPeriod != nil {
+		e.message(15, *r.ScheduledPeriod)
+	}
+	if r.ScheduledString != nil {
+		e.string(16, *r.ScheduledString)
+	}
+	if r.Location != nil {
+		e.message(17, *r.Location)
+	}
+	for _, v := range r.Performer {
+		e.message(18, v)
+	}
+	if r.ProductCodeableConcept != nil {
+		e.message(19, *r.ProductCodeableConcept)
+	}
+	if r.ProductReference != nil {
+		e.message(20, *r.ProductReference)
+	}
+	if r.DailyAmount != nil {
+		e.message(21, *r.DailyAmount)
+	}
+	if r.Quantity != nil {
+		e.message(22, *r.Quantity)
+	}
+	if r.Description != nil {
+		e.string(23, *r.Description)
+	}
+	if r.KindElement != nil {
+		e.message(24, *r.KindElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(25, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(26, v)
+	}
+	if r.StatusElement != nil {
+		e.message(27, *r.StatusElement)
+	}
+	if r.DoNotPerformElement != nil {
+		e.message(28, *r.DoNotPerformElement)
+	}
+	if r.ScheduledStringElement != nil {
+		e.message(29, *r.ScheduledStringElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(30, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CarePlanActivityDetail from protobuf message CarePlanActivityDetail of fhir.proto
+func (r *CarePlanActivityDetail) UnmarshalProto(b []byte) error {
+	*r = CarePlanActivityDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CarePlanActivityKind
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Kind = &v
+		case 5:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 6:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Goal = append(r.Goal, v)
+		case 11:
+			var v CarePlanActivityStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = &v
+		case 13:
+			v := d.bool()
+			r.DoNotPerform = &v
+		case 14:
+			var v Timing
+			d.message(&v)
+			r.ScheduledTiming = &v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.ScheduledPeriod = &v
+		case 16:
+			v := d.string()
+			r.ScheduledString = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductCodeableConcept = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.ProductReference = &v
+		case 21:
+			var v Quantity
+			d.message(&v)
+			r.DailyAmount = &v
+		case 22:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 23:
+			v := d.string()
+			r.Description = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.KindElement = &v
+		case 25:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 26:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		case 27:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.DoNotPerformElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.ScheduledStringElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCarePlan unmarshals a CarePlan.
 func UnmarshalCarePlan(b []byte) (CarePlan, error) {
 	var carePlan CarePlan
-	if err := json.Unmarshal(b, &carePlan); err != nil {
+	if err := carePlan.UnmarshalJSON(b); err != nil {
 		return carePlan, err
 	}
 	return carePlan, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -180,8 +179,13 @@ const (
 func (code CarePlanActivityKind) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CarePlanActivityKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CarePlanActivityKind) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "Account":
 		*code = CarePlanActivityKindAccount
@@ -484,6 +488,12 @@ func (code *CarePlanActivityKind) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CarePlanActivityKind) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CarePlanActivityKind) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CarePlanActivityKind) String() string {
 	return code.Code()
 }
@@ -1390,3 +1400,607 @@ func (code CarePlanActivityKind) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CarePlanActivityKind) protoNumber() uint64 {
+	switch code {
+	case CarePlanActivityKindAccount:
+		return 1
+	case CarePlanActivityKindActivityDefinition:
+		return 2
+	case CarePlanActivityKindAdverseEvent:
+		return 3
+	case CarePlanActivityKindAllergyIntolerance:
+		return 4
+	case CarePlanActivityKindAppointment:
+		return 5
+	case CarePlanActivityKindAppointmentResponse:
+		return 6
+	case CarePlanActivityKindAuditEvent:
+		return 7
+	case CarePlanActivityKindBasic:
+		return 8
+	case CarePlanActivityKindBinary:
+		return 9
+	case CarePlanActivityKindBiologicallyDerivedProduct:
+		return 10
+	case CarePlanActivityKindBodyStructure:
+		return 11
+	case CarePlanActivityKindBundle:
+		return 12
+	case CarePlanActivityKindCapabilityStatement:
+		return 13
+	case CarePlanActivityKindCarePlan:
+		return 14
+	case CarePlanActivityKindCareTeam:
+		return 15
+	case CarePlanActivityKindCatalogEntry:
+		return 16
+	case CarePlanActivityKindChargeItem:
+		return 17
+	case CarePlanActivityKindChargeItemDefinition:
+		return 18
+	case CarePlanActivityKindClaim:
+		return 19
+	case CarePlanActivityKindClaimResponse:
+		return 20
+	case CarePlanActivityKindClinicalImpression:
+		return 21
+	case CarePlanActivityKindCodeSystem:
+		return 22
+	case CarePlanActivityKindCommunication:
+		return 23
+	case CarePlanActivityKindCommunicationRequest:
+		return 24
+	case CarePlanActivityKindCompartmentDefinition:
+		return 25
+	case CarePlanActivityKindComposition:
+		return 26
+	case CarePlanActivityKindConceptMap:
+		return 27
+	case CarePlanActivityKindCondition:
+		return 28
+	case CarePlanActivityKindConsent:
+		return 29
+	case CarePlanActivityKindContract:
+		return 30
+	case CarePlanActivityKindCoverage:
+		return 31
+	case CarePlanActivityKindCoverageEligibilityRequest:
+		return 32
+	case CarePlanActivityKindCoverageEligibilityResponse:
+		return 33
+	case CarePlanActivityKindDetectedIssue:
+		return 34
+	case CarePlanActivityKindDevice:
+		return 35
+	case CarePlanActivityKindDeviceDefinition:
+		return 36
+	case CarePlanActivityKindDeviceMetric:
+		return 37
+	case CarePlanActivityKindDeviceRequest:
+		return 38
+	case CarePlanActivityKindDeviceUseStatement:
+		return 39
+	case CarePlanActivityKindDiagnosticReport:
+		return 40
+	case CarePlanActivityKindDocumentManifest:
+		return 41
+	case CarePlanActivityKindDocumentReference:
+		return 42
+	case CarePlanActivityKindDomainResource:
+		return 43
+	case CarePlanActivityKindEffectEvidenceSynthesis:
+		return 44
+	case CarePlanActivityKindEncounter:
+		return 45
+	case CarePlanActivityKindEndpoint:
+		return 46
+	case CarePlanActivityKindEnrollmentRequest:
+		return 47
+	case CarePlanActivityKindEnrollmentResponse:
+		return 48
+	case CarePlanActivityKindEpisodeOfCare:
+		return 49
+	case CarePlanActivityKindEventDefinition:
+		return 50
+	case CarePlanActivityKindEvidence:
+		return 51
+	case CarePlanActivityKindEvidenceVariable:
+		return 52
+	case CarePlanActivityKindExampleScenario:
+		return 53
+	case CarePlanActivityKindExplanationOfBenefit:
+		return 54
+	case CarePlanActivityKindFamilyMemberHistory:
+		return 55
+	case CarePlanActivityKindFlag:
+		return 56
+	case CarePlanActivityKindGoal:
+		return 57
+	case CarePlanActivityKindGraphDefinition:
+		return 58
+	case CarePlanActivityKindGroup:
+		return 59
+	case CarePlanActivityKindGuidanceResponse:
+		return 60
+	case CarePlanActivityKindHealthcareService:
+		return 61
+	case CarePlanActivityKindImagingStudy:
+		return 62
+	case CarePlanActivityKindImmunization:
+		return 63
+	case CarePlanActivityKindImmunizationEvaluation:
+		return 64
+	case CarePlanActivityKindImmunizationRecommendation:
+		return 65
+	case CarePlanActivityKindImplementationGuide:
+		return 66
+	case CarePlanActivityKindInsurancePlan:
+		return 67
+	case CarePlanActivityKindInvoice:
+		return 68
+	case CarePlanActivityKindLibrary:
+		return 69
+	case CarePlanActivityKindLinkage:
+		return 70
+	case CarePlanActivityKindList:
+		return 71
+	case CarePlanActivityKindLocation:
+		return 72
+	case CarePlanActivityKindMeasure:
+		return 73
+	case CarePlanActivityKindMeasureReport:
+		return 74
+	case CarePlanActivityKindMedia:
+		return 75
+	case CarePlanActivityKindMedication:
+		return 76
+	case CarePlanActivityKindMedicationAdministration:
+		return 77
+	case CarePlanActivityKindMedicationDispense:
+		return 78
+	case CarePlanActivityKindMedicationKnowledge:
+		return 79
+	case CarePlanActivityKindMedicationRequest:
+		return 80
+	case CarePlanActivityKindMedicationStatement:
+		return 81
+	case CarePlanActivityKindMedicinalProduct:
+		return 82
+	case CarePlanActivityKindMedicinalProductAuthorization:
+		return 83
+	case CarePlanActivityKindMedicinalProductContraindication:
+		return 84
+	case CarePlanActivityKindMedicinalProductIndication:
+		return 85
+	case CarePlanActivityKindMedicinalProductIngredient:
+		return 86
+	case CarePlanActivityKindMedicinalProductInteraction:
+		return 87
+	case CarePlanActivityKindMedicinalProductManufactured:
+		return 88
+	case CarePlanActivityKindMedicinalProductPackaged:
+		return 89
+	case CarePlanActivityKindMedicinalProductPharmaceutical:
+		return 90
+	case CarePlanActivityKindMedicinalProductUndesirableEffect:
+		return 91
+	case CarePlanActivityKindMessageDefinition:
+		return 92
+	case CarePlanActivityKindMessageHeader:
+		return 93
+	case CarePlanActivityKindMolecularSequence:
+		return 94
+	case CarePlanActivityKindNamingSystem:
+		return 95
+	case CarePlanActivityKindNutritionOrder:
+		return 96
+	case CarePlanActivityKindObservation:
+		return 97
+	case CarePlanActivityKindObservationDefinition:
+		return 98
+	case CarePlanActivityKindOperationDefinition:
+		return 99
+	case CarePlanActivityKindOperationOutcome:
+		return 100
+	case CarePlanActivityKindOrganization:
+		return 101
+	case CarePlanActivityKindOrganizationAffiliation:
+		return 102
+	case CarePlanActivityKindParameters:
+		return 103
+	case CarePlanActivityKindPatient:
+		return 104
+	case CarePlanActivityKindPaymentNotice:
+		return 105
+	case CarePlanActivityKindPaymentReconciliation:
+		return 106
+	case CarePlanActivityKindPerson:
+		return 107
+	case CarePlanActivityKindPlanDefinition:
+		return 108
+	case CarePlanActivityKindPractitioner:
+		return 109
+	case CarePlanActivityKindPractitionerRole:
+		return 110
+	case CarePlanActivityKindProcedure:
+		return 111
+	case CarePlanActivityKindProvenance:
+		return 112
+	case CarePlanActivityKindQuestionnaire:
+		return 113
+	case CarePlanActivityKindQuestionnaireResponse:
+		return 114
+	case CarePlanActivityKindRelatedPerson:
+		return 115
+	case CarePlanActivityKindRequestGroup:
+		return 116
+	case CarePlanActivityKindResearchDefinition:
+		return 117
+	case CarePlanActivityKindResearchElementDefinition:
+		return 118
+	case CarePlanActivityKindResearchStudy:
+		return 119
+	case CarePlanActivityKindResearchSubject:
+		return 120
+	case CarePlanActivityKindResource:
+		return 121
+	case CarePlanActivityKindRiskAssessment:
+		return 122
+	case CarePlanActivityKindRiskEvidenceSynthesis:
+		return 123
+	case CarePlanActivityKindSchedule:
+		return 124
+	case CarePlanActivityKindSearchParameter:
+		return 125
+	case CarePlanActivityKindServiceRequest:
+		return 126
+	case CarePlanActivityKindSlot:
+		return 127
+	case CarePlanActivityKindSpecimen:
+		return 128
+	case CarePlanActivityKindSpecimenDefinition:
+		return 129
+	case CarePlanActivityKindStructureDefinition:
+		return 130
+	case CarePlanActivityKindStructureMap:
+		return 131
+	case CarePlanActivityKindSubscription:
+		return 132
+	case CarePlanActivityKindSubstance:
+		return 133
+	case CarePlanActivityKindSubstanceNucleicAcid:
+		return 134
+	case CarePlanActivityKindSubstancePolymer:
+		return 135
+	case CarePlanActivityKindSubstanceProtein:
+		return 136
+	case CarePlanActivityKindSubstanceReferenceInformation:
+		return 137
+	case CarePlanActivityKindSubstanceSourceMaterial:
+		return 138
+	case CarePlanActivityKindSubstanceSpecification:
+		return 139
+	case CarePlanActivityKindSupplyDelivery:
+		return 140
+	case CarePlanActivityKindSupplyRequest:
+		return 141
+	case CarePlanActivityKindTask:
+		return 142
+	case CarePlanActivityKindTerminologyCapabilities:
+		return 143
+	case CarePlanActivityKindTestReport:
+		return 144
+	case CarePlanActivityKindTestScript:
+		return 145
+	case CarePlanActivityKindValueSet:
+		return 146
+	case CarePlanActivityKindVerificationResult:
+		return 147
+	case CarePlanActivityKindVisionPrescription:
+		return 148
+	}
+	return 0
+}
+func (code *CarePlanActivityKind) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CarePlanActivityKindAccount
+	case 2:
+		*code = CarePlanActivityKindActivityDefinition
+	case 3:
+		*code = CarePlanActivityKindAdverseEvent
+	case 4:
+		*code = CarePlanActivityKindAllergyIntolerance
+	case 5:
+		*code = CarePlanActivityKindAppointment
+	case 6:
+		*code = CarePlanActivityKindAppointmentResponse
+	case 7:
+		*code = CarePlanActivityKindAuditEvent
+	case 8:
+		*code = CarePlanActivityKindBasic
+	case 9:
+		*code = CarePlanActivityKindBinary
+	case 10:
+		*code = CarePlanActivityKindBiologicallyDerivedProduct
+	case 11:
+		*code = CarePlanActivityKindBodyStructure
+	case 12:
+		*code = CarePlanActivityKindBundle
+	case 13:
+		*code = CarePlanActivityKindCapabilityStatement
+	case 14:
+		*code = CarePlanActivityKindCarePlan
+	case 15:
+		*code = CarePlanActivityKindCareTeam
+	case 16:
+		*code = CarePlanActivityKindCatalogEntry
+	case 17:
+		*code = CarePlanActivityKindChargeItem
+	case 18:
+		*code = CarePlanActivityKindChargeItemDefinition
+	case 19:
+		*code = CarePlanActivityKindClaim
+	case 20:
+		*code = CarePlanActivityKindClaimResponse
+	case 21:
+		*code = CarePlanActivityKindClinicalImpression
+	case 22:
+		*code = CarePlanActivityKindCodeSystem
+	case 23:
+		*code = CarePlanActivityKindCommunication
+	case 24:
+		*code = CarePlanActivityKindCommunicationRequest
+	case 25:
+		*code = CarePlanActivityKindCompartmentDefinition
+	case 26:
+		*code = CarePlanActivityKindComposition
+	case 27:
+		*code = CarePlanActivityKindConceptMap
+	case 28:
+		*code = CarePlanActivityKindCondition
+	case 29:
+		*code = CarePlanActivityKindConsent
+	case 30:
+		*code = CarePlanActivityKindContract
+	case 31:
+		*code = CarePlanActivityKindCoverage
+	case 32:
+		*code = CarePlanActivityKindCoverageEligibilityRequest
+	case 33:
+		*code = CarePlanActivityKindCoverageEligibilityResponse
+	case 34:
+		*code = CarePlanActivityKindDetectedIssue
+	case 35:
+		*code = CarePlanActivityKindDevice
+	case 36:
+		*code = CarePlanActivityKindDeviceDefinition
+	case 37:
+		*code = CarePlanActivityKindDeviceMetric
+	case 38:
+		*code = CarePlanActivityKindDeviceRequest
+	case 39:
+		*code = CarePlanActivityKindDeviceUseStatement
+	case 40:
+		*code = CarePlanActivityKindDiagnosticReport
+	case 41:
+		*code = CarePlanActivityKindDocumentManifest
+	case 42:
+		*code = CarePlanActivityKindDocumentReference
+	case 43:
+		*code = CarePlanActivityKindDomainResource
+	case 44:
+		*code = CarePlanActivityKindEffectEvidenceSynthesis
+	case 45:
+		*code = CarePlanActivityKindEncounter
+	case 46:
+		*code = CarePlanActivityKindEndpoint
+	case 47:
+		*code = CarePlanActivityKindEnrollmentRequest
+	case 48:
+		*code = CarePlanActivityKindEnrollmentResponse
+	case 49:
+		*code = CarePlanActivityKindEpisodeOfCare
+	case 50:
+		*code = CarePlanActivityKindEventDefinition
+	case 51:
+		*code = CarePlanActivityKindEvidence
+	case 52:
+		*code = CarePlanActivityKindEvidenceVariable
+	case 53:
+		*code = CarePlanActivityKindExampleScenario
+	case 54:
+		*code = CarePlanActivityKindExplanationOfBenefit
+	case 55:
+		*code = CarePlanActivityKindFamilyMemberHistory
+	case 56:
+		*code = CarePlanActivityKindFlag
+	case 57:
+		*code = CarePlanActivityKindGoal
+	case 58:
+		*code = CarePlanActivityKindGraphDefinition
+	case 59:
+		*code = CarePlanActivityKindGroup
+	case 60:
+		*code = CarePlanActivityKindGuidanceResponse
+	case 61:
+		*code = CarePlanActivityKindHealthcareService
+	case 62:
+		*code = CarePlanActivityKindImagingStudy
+	case 63:
+		*code = CarePlanActivityKindImmunization
+	case 64:
+		*code = CarePlanActivityKindImmunizationEvaluation
+	case 65:
+		*code = CarePlanActivityKindImmunizationRecommendation
+	case 66:
+		*code = CarePlanActivityKindImplementationGuide
+	case 67:
+		*code = CarePlanActivityKindInsurancePlan
+	case 68:
+		*code = CarePlanActivityKindInvoice
+	case 69:
+		*code = CarePlanActivityKindLibrary
+	case 70:
+		*code = CarePlanActivityKindLinkage
+	case 71:
+		*code = CarePlanActivityKindList
+	case 72:
+		*code = CarePlanActivityKindLocation
+	case 73:
+		*code = CarePlanActivityKindMeasure
+	case 74:
+		*code = CarePlanActivityKindMeasureReport
+	case 75:
+		*code = CarePlanActivityKindMedia
+	case 76:
+		*code = CarePlanActivityKindMedication
+	case 77:
+		*code = CarePlanActivityKindMedicationAdministration
+	case 78:
+		*code = CarePlanActivityKindMedicationDispense
+	case 79:
+		*code = CarePlanActivityKindMedicationKnowledge
+	case 80:
+		*code = CarePlanActivityKindMedicationRequest
+	case 81:
+		*code = CarePlanActivityKindMedicationStatement
+	case 82:
+		*code = CarePlanActivityKindMedicinalProduct
+	case 83:
+		*code = CarePlanActivityKindMedicinalProductAuthorization
+	case 84:
+		*code = CarePlanActivityKindMedicinalProductContraindication
+	case 85:
+		*code = CarePlanActivityKindMedicinalProductIndication
+	case 86:
+		*code = CarePlanActivityKindMedicinalProductIngredient
+	case 87:
+		*code = CarePlanActivityKindMedicinalProductInteraction
+	case 88:
+		*code = CarePlanActivityKindMedicinalProductManufactured
+	case 89:
+		*code = CarePlanActivityKindMedicinalProductPackaged
+	case 90:
+		*code = CarePlanActivityKindMedicinalProductPharmaceutical
+	case 91:
+		*code = CarePlanActivityKindMedicinalProductUndesirableEffect
+	case 92:
+		*code = CarePlanActivityKindMessageDefinition
+	case 93:
+		*code = CarePlanActivityKindMessageHeader
+	case 94:
+		*code = CarePlanActivityKindMolecularSequence
+	case 95:
+		*code = CarePlanActivityKindNamingSystem
+	case 96:
+		*code = CarePlanActivityKindNutritionOrder
+	case 97:
+		*code = CarePlanActivityKindObservation
+	case 98:
+		*code = CarePlanActivityKindObservationDefinition
+	case 99:
+		*code = CarePlanActivityKindOperationDefinition
+	case 100:
+		*code = CarePlanActivityKindOperationOutcome
+	case 101:
+		*code = CarePlanActivityKindOrganization
+	case 102:
+		*code = CarePlanActivityKindOrganizationAffiliation
+	case 103:
+		*code = CarePlanActivityKindParameters
+	case 104:
+		*code = CarePlanActivityKindPatient
+	case 105:
+		*code = CarePlanActivityKindPaymentNotice
+	case 106:
+		*code = CarePlanActivityKindPaymentReconciliation
+	case 107:
+		*code = CarePlanActivityKindPerson
+	case 108:
+		*code = CarePlanActivityKindPlanDefinition
+	case 109:
+		*code = CarePlanActivityKindPractitioner
+	case 110:
+		*code = CarePlanActivityKindPractitionerRole
+	case 111:
+		*code = CarePlanActivityKindProcedure
+	case 112:
+		*code = CarePlanActivityKindProvenance
+	case 113:
+		*code = CarePlanActivityKindQuestionnaire
+	case 114:
+		*code = CarePlanActivityKindQuestionnaireResponse
+	case 115:
+		*code = CarePlanActivityKindRelatedPerson
+	case 116:
+		*code = CarePlanActivityKindRequestGroup
+	case 117:
+		*code = CarePlanActivityKindResearchDefinition
+	case 118:
+		*code = CarePlanActivityKindResearchElementDefinition
+	case 119:
+		*code = CarePlanActivityKindResearchStudy
+	case 120:
+		*code = CarePlanActivityKindResearchSubject
+	case 121:
+		*code = CarePlanActivityKindResource
+	case 122:
+		*code = CarePlanActivityKindRiskAssessment
+	case 123:
+		*code = CarePlanActivityKindRiskEvidenceSynthesis
+	case 124:
+		*code = CarePlanActivityKindSchedule
+	case 125:
+		*code = CarePlanActivityKindSearchParameter
+	case 126:
+		*code = CarePlanActivityKindServiceRequest
+	case 127:
+		*code = CarePlanActivityKindSlot
+	case 128:
+		*code = CarePlanActivityKindSpecimen
+	case 129:
+		*code = CarePlanActivityKindSpecimenDefinition
+	case 130:
+		*code = CarePlanActivityKindStructureDefinition
+	case 131:
+		*code = CarePlanActivityKindStructureMap
+	case 132:
+		*code = CarePlanActivityKindSubscription
+	case 133:
+		*code = CarePlanActivityKindSubstance
+	case 134:
+		*code = CarePlanActivityKindSubstanceNucleicAcid
+	case 135:
+		*code = CarePlanActivityKindSubstancePolymer
+	case 136:
+		*code = CarePlanActivityKindSubstanceProtein
+	case 137:
+		*code = CarePlanActivityKindSubstanceReferenceInformation
+	case 138:
+		*code = CarePlanActivityKindSubstanceSourceMaterial
+	case 139:
+		*code = CarePlanActivityKindSubstanceSpecification
+	case 140:
+		*code = CarePlanActivityKindSupplyDelivery
+	case 141:
+		*code = CarePlanActivityKindSupplyRequest
+	case 142:
+		*code = CarePlanActivityKindTask
+	case 143:
+		*code = CarePlanActivityKindTerminologyCapabilities
+	case 144:
+		*code = CarePlanActivityKindTestReport
+	case 145:
+		*code = CarePlanActivityKindTestScript
+	case 146:
+		*code = CarePlanActivityKindValueSet
+	case 147:
+		*code = CarePlanActivityKindVerificationResult
+	case 148:
+		*code = CarePlanActivityKindVisionPrescription
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code CarePlanActivityStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CarePlanActivityStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CarePlanActivityStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "not-started":
 		*code = CarePlanActivityStatusNotStarted
@@ -67,6 +71,12 @@ func (code *CarePlanActivityStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CarePlanActivityStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CarePlanActivityStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CarePlanActivityStatus) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code CarePlanActivityStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CarePlanActivityStatus) protoNumber() uint64 {
+	switch code {
+	case CarePlanActivityStatusNotStarted:
+		return 1
+	case CarePlanActivityStatusScheduled:
+		return 2
+	case CarePlanActivityStatusInProgress:
+		return 3
+	case CarePlanActivityStatusOnHold:
+		return 4
+	case CarePlanActivityStatusCompleted:
+		return 5
+	case CarePlanActivityStatusCancelled:
+		return 6
+	case CarePlanActivityStatusStopped:
+		return 7
+	case CarePlanActivityStatusUnknown:
+		return 8
+	case CarePlanActivityStatusEnteredInError:
+		return 9
+	}
+	return 0
+}
+func (code *CarePlanActivityStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CarePlanActivityStatusNotStarted
+	case 2:
+		*code = CarePlanActivityStatusScheduled
+	case 3:
+		*code = CarePlanActivityStatusInProgress
+	case 4:
+		*code = CarePlanActivityStatusOnHold
+	case 5:
+		*code = CarePlanActivityStatusCompleted
+	case 6:
+		*code = CarePlanActivityStatusCancelled
+	case 7:
+		*code = CarePlanActivityStatusStopped
+	case 8:
+		*code = CarePlanActivityStatusUnknown
+	case 9:
+		*code = CarePlanActivityStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
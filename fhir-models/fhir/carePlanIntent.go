@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code CarePlanIntent) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CarePlanIntent) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CarePlanIntent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "proposal":
 		*code = CarePlanIntentProposal
@@ -67,6 +71,12 @@ func (code *CarePlanIntent) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CarePlanIntent) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CarePlanIntent) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CarePlanIntent) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code CarePlanIntent) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CarePlanIntent) protoNumber() uint64 {
+	switch code {
+	case CarePlanIntentProposal:
+		return 1
+	case CarePlanIntentPlan:
+		return 2
+	case CarePlanIntentDirective:
+		return 3
+	case CarePlanIntentOrder:
+		return 4
+	case CarePlanIntentOriginalOrder:
+		return 5
+	case CarePlanIntentReflexOrder:
+		return 6
+	case CarePlanIntentFillerOrder:
+		return 7
+	case CarePlanIntentInstanceOrder:
+		return 8
+	case CarePlanIntentOption:
+		return 9
+	}
+	return 0
+}
+func (code *CarePlanIntent) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CarePlanIntentProposal
+	case 2:
+		*code = CarePlanIntentPlan
+	case 3:
+		*code = CarePlanIntentDirective
+	case 4:
+		*code = CarePlanIntentOrder
+	case 5:
+		*code = CarePlanIntentOriginalOrder
+	case 6:
+		*code = CarePlanIntentReflexOrder
+	case 7:
+		*code = CarePlanIntentFillerOrder
+	case 8:
+		*code = CarePlanIntentInstanceOrder
+	case 9:
+		*code = CarePlanIntentOption
+	default:
+		return false
+	}
+	return true
+}
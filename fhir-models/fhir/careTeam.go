@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,16 +25,22 @@ import "encoding/json"
 // CareTeam is documented here http://hl7.org/fhir/StructureDefinition/CareTeam
 type CareTeam struct {
 	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element              `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element              `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage     `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status               *CareTeamStatus       `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element              `bson:"_status,omitempty" json:"_status,omitempty"`
 	Category             []CodeableConcept     `bson:"category,omitempty" json:"category,omitempty"`
 	Name                 *string               `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element              `bson:"_name,omitempty" json:"_name,omitempty"`
 	Subject              *Reference            `bson:"subject,omitempty" json:"subject,omitempty"`
 	Encounter            *Reference            `bson:"encounter,omitempty" json:"encounter,omitempty"`
 	Period               *Period               `bson:"period,omitempty" json:"period,omitempty"`
@@ -51,23 +60,1785 @@ type CareTeamParticipant struct {
 	OnBehalfOf        *Reference        `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
 	Period            *Period           `bson:"period,omitempty" json:"period,omitempty"`
 }
-type OtherCareTeam CareTeam
 
 // MarshalJSON marshals the given CareTeam as JSON into a byte slice
 func (r CareTeam) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCareTeam
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCareTeam: OtherCareTeam(r),
-		ResourceType:  "CareTeam",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CareTeam as JSON object with the members in the order of the fields
+func (r CareTeam) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"CareTeam\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if len(r.Participant) > 0 {
+		e.key("participant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Participant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ManagingOrganization) > 0 {
+		e.key("managingOrganization")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ManagingOrganization {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CareTeam from JSON. Unknown members are ignored.
+func (r *CareTeam) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CareTeam
+func (r *CareTeam) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v CareTeamStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "participant":
+			a := d.array()
+			r.Participant = nil
+			for a.next() {
+				var v CareTeamParticipant
+				v.decodeJSON(d)
+				r.Participant = append(r.Participant, v)
+			}
+			if r.Participant == nil && !a.isNull {
+				r.Participant = []CareTeamParticipant{}
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "managingOrganization":
+			a := d.array()
+			r.ManagingOrganization = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ManagingOrganization = append(r.ManagingOrganization, v)
+			}
+			if r.ManagingOrganization == nil && !a.isNull {
+				r.ManagingOrganization = []Reference{}
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CareTeam which shares no memory with the original
+func (r CareTeam) DeepCopy() CareTeam {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Participant != nil {
+		out.Participant = make([]CareTeamParticipant, len(r.Participant))
+		for i := range r.Participant {
+			out.Participant[i] = r.Participant[i].DeepCopy()
+		}
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.ManagingOrganization != nil {
+		out.ManagingOrganization = make([]Reference, len(r.ManagingOrganization))
+		for i := range r.ManagingOrganization {
+			out.ManagingOrganization[i] = r.ManagingOrganization[i].DeepCopy()
+		}
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CareTeam) Equal(other CareTeam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if len(r.Participant) != len(other.Participant) {
+		return false
+	}
+	for i := range r.Participant {
+		if !r.Participant[i].Equal(other.Participant[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.ManagingOrganization) != len(other.ManagingOrganization) {
+		return false
+	}
+	for i := range r.ManagingOrganization {
+		if !r.ManagingOrganization[i].Equal(other.ManagingOrganization[i]) {
+			return false
+		}
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CareTeam) EqualsDeep(other CareTeam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentList(len(r.Participant), len(other.Participant), func(i, j int) bool {
+		return r.Participant[i].EqualsDeep(other.Participant[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ManagingOrganization), len(other.ManagingOrganization), func(i, j int) bool {
+		return r.ManagingOrganization[i].EqualsDeep(other.ManagingOrganization[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CareTeam) EqualsShallow(other CareTeam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CareTeam as FHIR XML
+func (r CareTeam) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "CareTeam",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	for _, v := range r.Participant {
+		if err := encodeXMLElement(e, "participant", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ManagingOrganization {
+		if err := encodeXMLElement(e, "managingOrganization", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CareTeam from FHIR XML
+func (r *CareTeam) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v CareTeamStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "participant":
+				var v CareTeamParticipant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Participant = append(r.Participant, v)
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "managingOrganization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManagingOrganization = append(r.ManagingOrganization, v)
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CareTeam as properties to the RDF node n
+func (r CareTeam) turtle(n *turtleNode) {
+	n.resource("CareTeam", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("CareTeam.identifier", i, v)
+	}
+	n.primitive("CareTeam.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.Category {
+		n.element("CareTeam.category", i, v)
+	}
+	n.primitive("CareTeam.name", -1, r.Name, r.NameElement, "string")
+	n.element("CareTeam.subject", -1, r.Subject)
+	n.element("CareTeam.encounter", -1, r.Encounter)
+	n.element("CareTeam.period", -1, r.Period)
+	for i, v := range r.Participant {
+		n.element("CareTeam.participant", i, v)
+	}
+	for i, v := range r.ReasonCode {
+		n.element("CareTeam.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("CareTeam.reasonReference", i, v)
+	}
+	for i, v := range r.ManagingOrganization {
+		n.element("CareTeam.managingOrganization", i, v)
+	}
+	for i, v := range r.Telecom {
+		n.element("CareTeam.telecom", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("CareTeam.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CareTeam as BSON document with the structure of its FHIR JSON
+func (r CareTeam) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CareTeam from a BSON document with the structure of its FHIR JSON
+func (r *CareTeam) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CareTeam as protobuf message CareTeam of fhir.proto
+func (r CareTeam) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CareTeam to the encoded message
+func (r CareTeam) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Status != nil {
+		e.varint(10, r.Status.protoNumber())
+	}
+	for _, v := range r.Category {
+		e.message(11, v)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Subject != nil {
+		e.message(13, *r.Subject)
+	}
+	if r.Encounter != nil {
+		e.message(14, *r.Encounter)
+	}
+	if r.Period != nil {
+		e.message(15, *r.Period)
+	}
+	for _, v := range r.Participant {
+		e.message(16, v)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(17, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(18, v)
+	}
+	for _, v := range r.ManagingOrganization {
+		e.message(19, v)
+	}
+	for _, v := range r.Telecom {
+		e.message(20, v)
+	}
+	for _, v := range r.Note {
+		e.message(21, v)
+	}
+	if r.IdElement != nil {
+		e.message(22, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(23, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(24, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(25, *r.StatusElement)
+	}
+	if r.NameElement != nil {
+		e.message(26, *r.NameElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CareTeam from protobuf message CareTeam of fhir.proto
+func (r *CareTeam) UnmarshalProto(b []byte) error {
+	*r = CareTeam{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v CareTeamStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 16:
+			var v CareTeamParticipant
+			d.message(&v)
+			r.Participant = append(r.Participant, v)
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.ManagingOrganization = append(r.ManagingOrganization, v)
+		case 20:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 21:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 22:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CareTeamParticipant as JSON into a byte slice
+func (r CareTeamParticipant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CareTeamParticipant as JSON object with the members in the order of the fields
+func (r CareTeamParticipant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Role) > 0 {
+		e.key("role")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Role {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Member != nil {
+		e.key("member")
+		r.Member.appendJSON(e)
+	}
+	if r.OnBehalfOf != nil {
+		e.key("onBehalfOf")
+		r.OnBehalfOf.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CareTeamParticipant from JSON. Unknown members are ignored.
+func (r *CareTeamParticipant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CareTeamParticipant
+func (r *CareTeamParticipant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "role":
+			a := d.array()
+			r.Role = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = append(r.Role, v)
+			}
+			if r.Role == nil && !a.isNull {
+				r.Role = []CodeableConcept{}
+			}
+		case "member":
+			if d.null() {
+				r.Member = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Member = &v
+			}
+		case "onBehalfOf":
+			if d.null() {
+				r.OnBehalfOf = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.OnBehalfOf = &v
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CareTeamParticipant which shares no memory with the original
+func (r CareTeamParticipant) DeepCopy() CareTeamParticipant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Role != nil {
+		out.Role = make([]CodeableConcept, len(r.Role))
+		for i := range r.Role {
+			out.Role[i] = r.Role[i].DeepCopy()
+		}
+	}
+	if r.Member != nil {
+		v := r.Member.DeepCopy()
+		out.Member = &v
+	}
+	if r.OnBehalfOf != nil {
+		v := r.OnBehalfOf.DeepCopy()
+		out.OnBehalfOf = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CareTeamParticipant) Equal(other CareTeamParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Role) != len(other.Role) {
+		return false
+	}
+	for i := range r.Role {
+		if !r.Role[i].Equal(other.Role[i]) {
+			return false
+		}
+	}
+	if (r.Member == nil) != (other.Member == nil) || r.Member != nil && !r.Member.Equal(*other.Member) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.Equal(*other.OnBehalfOf) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CareTeamParticipant) EqualsDeep(other CareTeamParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Role), len(other.Role), func(i, j int) bool {
+		return r.Role[i].EqualsDeep(other.Role[j])
+	}) {
+		return false
+	}
+	if (r.Member == nil) != (other.Member == nil) || r.Member != nil && !r.Member.EqualsDeep(*other.Member) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.EqualsDeep(*other.OnBehalfOf) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CareTeamParticipant) EqualsShallow(other CareTeamParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CareTeamParticipant as FHIR XML
+func (r CareTeamParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Role {
+		if err := encodeXMLElement(e, "role", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "member", r.Member); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onBehalfOf", r.OnBehalfOf); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CareTeamParticipant from FHIR XML
+func (r *CareTeamParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = append(r.Role, v)
+			case "member":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Member = &v
+			case "onBehalfOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnBehalfOf = &v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CareTeamParticipant as properties to the RDF node n
+func (r CareTeamParticipant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Role {
+		n.element("CareTeam.participant.role", i, v)
+	}
+	n.element("CareTeam.participant.member", -1, r.Member)
+	n.element("CareTeam.participant.onBehalfOf", -1, r.OnBehalfOf)
+	n.element("CareTeam.participant.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given CareTeamParticipant as BSON document with the structure of its FHIR JSON
+func (r CareTeamParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CareTeamParticipant from a BSON document with the structure of its FHIR JSON
+func (r *CareTeamParticipant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CareTeamParticipant as protobuf message CareTeamParticipant of fhir.proto
+func (r CareTeamParticipant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CareTeamParticipant to the encoded message
+func (r CareTeamParticipant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Role {
+		e.message(4, v)
+	}
+	if r.Member != nil {
+		e.message(5, *r.Member)
+	}
+	if r.OnBehalfOf != nil {
+		e.message(6, *r.OnBehalfOf)
+	}
+	if r.Period != nil {
+		e.message(7, *r.Period)
+	}
+}
+
+// UnmarshalProto unmarshals the given CareTeamParticipant from protobuf message CareTeamParticipant of fhir.proto
+func (r *CareTeamParticipant) UnmarshalProto(b []byte) error {
+	*r = CareTeamParticipant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = append(r.Role, v)
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Member = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.OnBehalfOf = &v
+		case 7:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCareTeam unmarshals a CareTeam.
 func UnmarshalCareTeam(b []byte) (CareTeam, error) {
 	var careTeam CareTeam
-	if err := json.Unmarshal(b, &careTeam); err != nil {
+	if err := careTeam.UnmarshalJSON(b); err != nil {
 		return careTeam, err
 	}
 	return careTeam, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code CareTeamStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CareTeamStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CareTeamStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "proposed":
 		*code = CareTeamStatusProposed
@@ -55,6 +59,12 @@ func (code *CareTeamStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CareTeamStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CareTeamStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CareTeamStatus) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code CareTeamStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CareTeamStatus) protoNumber() uint64 {
+	switch code {
+	case CareTeamStatusProposed:
+		return 1
+	case CareTeamStatusActive:
+		return 2
+	case CareTeamStatusSuspended:
+		return 3
+	case CareTeamStatusInactive:
+		return 4
+	case CareTeamStatusEnteredInError:
+		return 5
+	}
+	return 0
+}
+func (code *CareTeamStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CareTeamStatusProposed
+	case 2:
+		*code = CareTeamStatusActive
+	case 3:
+		*code = CareTeamStatusSuspended
+	case 4:
+		*code = CareTeamStatusInactive
+	case 5:
+		*code = CareTeamStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
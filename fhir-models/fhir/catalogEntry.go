@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,50 +25,1715 @@ import "encoding/json"
 // CatalogEntry is documented here http://hl7.org/fhir/StructureDefinition/CatalogEntry
 type CatalogEntry struct {
 	Id                       *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                *Element                   `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                     *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules            *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                 *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                     *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                []json.RawMessage          `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier               []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Type                     *CodeableConcept           `bson:"type,omitempty" json:"type,omitempty"`
 	Orderable                bool                       `bson:"orderable" json:"orderable"`
+	OrderableElement         *Element                   `bson:"_orderable,omitempty" json:"_orderable,omitempty"`
 	ReferencedItem           Reference                  `bson:"referencedItem" json:"referencedItem"`
 	AdditionalIdentifier     []Identifier               `bson:"additionalIdentifier,omitempty" json:"additionalIdentifier,omitempty"`
 	Classification           []CodeableConcept          `bson:"classification,omitempty" json:"classification,omitempty"`
 	Status                   *PublicationStatus         `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement            *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
 	ValidityPeriod           *Period                    `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
 	ValidTo                  *string                    `bson:"validTo,omitempty" json:"validTo,omitempty"`
+	ValidToElement           *Element                   `bson:"_validTo,omitempty" json:"_validTo,omitempty"`
 	LastUpdated              *string                    `bson:"lastUpdated,omitempty" json:"lastUpdated,omitempty"`
+	LastUpdatedElement       *Element                   `bson:"_lastUpdated,omitempty" json:"_lastUpdated,omitempty"`
 	AdditionalCharacteristic []CodeableConcept          `bson:"additionalCharacteristic,omitempty" json:"additionalCharacteristic,omitempty"`
 	AdditionalClassification []CodeableConcept          `bson:"additionalClassification,omitempty" json:"additionalClassification,omitempty"`
 	RelatedEntry             []CatalogEntryRelatedEntry `bson:"relatedEntry,omitempty" json:"relatedEntry,omitempty"`
 }
 type CatalogEntryRelatedEntry struct {
-	Id                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Relationtype      CatalogEntryRelationType `bson:"relationtype" json:"relationtype"`
-	Item              Reference                `bson:"item" json:"item"`
+	Id                  *string                  `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Relationtype        CatalogEntryRelationType `bson:"relationtype" json:"relationtype"`
+	RelationtypeElement *Element                 `bson:"_relationtype,omitempty" json:"_relationtype,omitempty"`
+	Item                Reference                `bson:"item" json:"item"`
 }
-type OtherCatalogEntry CatalogEntry
 
 // MarshalJSON marshals the given CatalogEntry as JSON into a byte slice
 func (r CatalogEntry) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCatalogEntry
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCatalogEntry: OtherCatalogEntry(r),
-		ResourceType:      "CatalogEntry",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CatalogEntry as JSON object with the members in the order of the fields
+func (r CatalogEntry) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"CatalogEntry\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	e.key("orderable")
+	e.bool(r.Orderable)
+	if r.OrderableElement != nil {
+		e.key("_orderable")
+		r.OrderableElement.appendJSON(e)
+	}
+	e.key("referencedItem")
+	r.ReferencedItem.appendJSON(e)
+	if len(r.AdditionalIdentifier) > 0 {
+		e.key("additionalIdentifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AdditionalIdentifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Classification) > 0 {
+		e.key("classification")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Classification {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.ValidityPeriod != nil {
+		e.key("validityPeriod")
+		r.ValidityPeriod.appendJSON(e)
+	}
+	if r.ValidTo != nil {
+		e.key("validTo")
+		e.string(*r.ValidTo)
+	}
+	if r.ValidToElement != nil {
+		e.key("_validTo")
+		r.ValidToElement.appendJSON(e)
+	}
+	if r.LastUpdated != nil {
+		e.key("lastUpdated")
+		e.string(*r.LastUpdated)
+	}
+	if r.LastUpdatedElement != nil {
+		e.key("_lastUpdated")
+		r.LastUpdatedElement.appendJSON(e)
+	}
+	if len(r.AdditionalCharacteristic) > 0 {
+		e.key("additionalCharacteristic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AdditionalCharacteristic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AdditionalClassification) > 0 {
+		e.key("additionalClassification")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AdditionalClassification {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedEntry) > 0 {
+		e.key("relatedEntry")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedEntry {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CatalogEntry from JSON. Unknown members are ignored.
+func (r *CatalogEntry) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CatalogEntry
+func (r *CatalogEntry) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "orderable":
+			if v, ok := d.bool(); ok {
+				r.Orderable = v
+			}
+		case "_orderable":
+			r.OrderableElement = d.element()
+		case "referencedItem":
+			r.ReferencedItem.decodeJSON(d)
+		case "additionalIdentifier":
+			a := d.array()
+			r.AdditionalIdentifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.AdditionalIdentifier = append(r.AdditionalIdentifier, v)
+			}
+			if r.AdditionalIdentifier == nil && !a.isNull {
+				r.AdditionalIdentifier = []Identifier{}
+			}
+		case "classification":
+			a := d.array()
+			r.Classification = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Classification = append(r.Classification, v)
+			}
+			if r.Classification == nil && !a.isNull {
+				r.Classification = []CodeableConcept{}
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v PublicationStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "validityPeriod":
+			if d.null() {
+				r.ValidityPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValidityPeriod = &v
+			}
+		case "validTo":
+			if v, ok := d.string(); ok {
+				r.ValidTo = &v
+			} else {
+				r.ValidTo = nil
+			}
+		case "_validTo":
+			r.ValidToElement = d.element()
+		case "lastUpdated":
+			if v, ok := d.string(); ok {
+				r.LastUpdated = &v
+			} else {
+				r.LastUpdated = nil
+			}
+		case "_lastUpdated":
+			r.LastUpdatedElement = d.element()
+		case "additionalCharacteristic":
+			a := d.array()
+			r.AdditionalCharacteristic = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdditionalCharacteristic = append(r.AdditionalCharacteristic, v)
+			}
+			if r.AdditionalCharacteristic == nil && !a.isNull {
+				r.AdditionalCharacteristic = []CodeableConcept{}
+			}
+		case "additionalClassification":
+			a := d.array()
+			r.AdditionalClassification = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdditionalClassification = append(r.AdditionalClassification, v)
+			}
+			if r.AdditionalClassification == nil && !a.isNull {
+				r.AdditionalClassification = []CodeableConcept{}
+			}
+		case "relatedEntry":
+			a := d.array()
+			r.RelatedEntry = nil
+			for a.next() {
+				var v CatalogEntryRelatedEntry
+				v.decodeJSON(d)
+				r.RelatedEntry = append(r.RelatedEntry, v)
+			}
+			if r.RelatedEntry == nil && !a.isNull {
+				r.RelatedEntry = []CatalogEntryRelatedEntry{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CatalogEntry which shares no memory with the original
+func (r CatalogEntry) DeepCopy() CatalogEntry {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.OrderableElement != nil {
+		v := r.OrderableElement.DeepCopy()
+		out.OrderableElement = &v
+	}
+	out.ReferencedItem = r.ReferencedItem.DeepCopy()
+	if r.AdditionalIdentifier != nil {
+		out.AdditionalIdentifier = make([]Identifier, len(r.AdditionalIdentifier))
+		for i := range r.AdditionalIdentifier {
+			out.AdditionalIdentifier[i] = r.AdditionalIdentifier[i].DeepCopy()
+		}
+	}
+	if r.Classification != nil {
+		out.Classification = make([]CodeableConcept, len(r.Classification))
+		for i := range r.Classification {
+			out.Classification[i] = r.Classification[i].DeepCopy()
+		}
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.ValidityPeriod != nil {
+		v := r.ValidityPeriod.DeepCopy()
+		out.ValidityPeriod = &v
+	}
+	if r.ValidTo != nil {
+		v := *r.ValidTo
+		out.ValidTo = &v
+	}
+	if r.ValidToElement != nil {
+		v := r.ValidToElement.DeepCopy()
+		out.ValidToElement = &v
+	}
+	if r.LastUpdated != nil {
+		v := *r.LastUpdated
+		out.LastUpdated = &v
+	}
+	if r.LastUpdatedElement != nil {
+		v := r.LastUpdatedElement.DeepCopy()
+		out.LastUpdatedElement = &v
+	}
+	if r.AdditionalCharacteristic != nil {
+		out.AdditionalCharacteristic = make([]CodeableConcept, len(r.AdditionalCharacteristic))
+		for i := range r.AdditionalCharacteristic {
+			out.AdditionalCharacteristic[i] = r.AdditionalCharacteristic[i].DeepCopy()
+		}
+	}
+	if r.AdditionalClassification != nil {
+		out.AdditionalClassification = make([]CodeableConcept, len(r.AdditionalClassification))
+		for i := range r.AdditionalClassification {
+			out.AdditionalClassification[i] = r.AdditionalClassification[i].DeepCopy()
+		}
+	}
+	if r.RelatedEntry != nil {
+		out.RelatedEntry = make([]CatalogEntryRelatedEntry, len(r.RelatedEntry))
+		for i := range r.RelatedEntry {
+			out.RelatedEntry[i] = r.RelatedEntry[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CatalogEntry) Equal(other CatalogEntry) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if r.Orderable != other.Orderable {
+		return false
+	}
+	if (r.OrderableElement == nil) != (other.OrderableElement == nil) || r.OrderableElement != nil && !r.OrderableElement.Equal(*other.OrderableElement) {
+		return false
+	}
+	if !r.ReferencedItem.Equal(other.ReferencedItem) {
+		return false
+	}
+	if len(r.AdditionalIdentifier) != len(other.AdditionalIdentifier) {
+		return false
+	}
+	for i := range r.AdditionalIdentifier {
+		if !r.AdditionalIdentifier[i].Equal(other.AdditionalIdentifier[i]) {
+			return false
+		}
+	}
+	if len(r.Classification) != len(other.Classification) {
+		return false
+	}
+	for i := range r.Classification {
+		if !r.Classification[i].Equal(other.Classification[i]) {
+			return false
+		}
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.ValidityPeriod == nil) != (other.ValidityPeriod == nil) || r.ValidityPeriod != nil && !r.ValidityPeriod.Equal(*other.ValidityPeriod) {
+		return false
+	}
+	if (r.ValidTo == nil) != (other.ValidTo == nil) || r.ValidTo != nil && *r.ValidTo != *other.ValidTo {
+		return false
+	}
+	if (r.ValidToElement == nil) != (other.ValidToElement == nil) || r.ValidToElement != nil && !r.ValidToElement.Equal(*other.ValidToElement) {
+		return false
+	}
+	if (r.LastUpdated == nil) != (other.LastUpdated == nil) || r.LastUpdated != nil && *r.LastUpdated != *other.LastUpdated {
+		return false
+	}
+	if (r.LastUpdatedElement == nil) != (other.LastUpdatedElement == nil) || r.LastUpdatedElement != nil && !r.LastUpdatedElement.Equal(*other.LastUpdatedElement) {
+		return false
+	}
+	if len(r.AdditionalCharacteristic) != len(other.AdditionalCharacteristic) {
+		return false
+	}
+	for i := range r.AdditionalCharacteristic {
+		if !r.AdditionalCharacteristic[i].Equal(other.AdditionalCharacteristic[i]) {
+			return false
+		}
+	}
+	if len(r.AdditionalClassification) != len(other.AdditionalClassification) {
+		return false
+	}
+	for i := range r.AdditionalClassification {
+		if !r.AdditionalClassification[i].Equal(other.AdditionalClassification[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedEntry) != len(other.RelatedEntry) {
+		return false
+	}
+	for i := range r.RelatedEntry {
+		if !r.RelatedEntry[i].Equal(other.RelatedEntry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CatalogEntry) EqualsDeep(other CatalogEntry) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if r.Orderable != other.Orderable {
+		return false
+	}
+	if (r.OrderableElement == nil) != (other.OrderableElement == nil) || r.OrderableElement != nil && !r.OrderableElement.EqualsDeep(*other.OrderableElement) {
+		return false
+	}
+	if !r.ReferencedItem.EqualsDeep(other.ReferencedItem) {
+		return false
+	}
+	if !equivalentList(len(r.AdditionalIdentifier), len(other.AdditionalIdentifier), func(i, j int) bool {
+		return r.AdditionalIdentifier[i].EqualsDeep(other.AdditionalIdentifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Classification), len(other.Classification), func(i, j int) bool {
+		return r.Classification[i].EqualsDeep(other.Classification[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.ValidityPeriod == nil) != (other.ValidityPeriod == nil) || r.ValidityPeriod != nil && !r.ValidityPeriod.EqualsDeep(*other.ValidityPeriod) {
+		return false
+	}
+	if (r.ValidTo == nil) != (other.ValidTo == nil) || r.ValidTo != nil && !equivalentString(*r.ValidTo, *other.ValidTo) {
+		return false
+	}
+	if (r.ValidToElement == nil) != (other.ValidToElement == nil) || r.ValidToElement != nil && !r.ValidToElement.EqualsDeep(*other.ValidToElement) {
+		return false
+	}
+	if (r.LastUpdated == nil) != (other.LastUpdated == nil) || r.LastUpdated != nil && !equivalentString(*r.LastUpdated, *other.LastUpdated) {
+		return false
+	}
+	if (r.LastUpdatedElement == nil) != (other.LastUpdatedElement == nil) || r.LastUpdatedElement != nil && !r.LastUpdatedElement.EqualsDeep(*other.LastUpdatedElement) {
+		return false
+	}
+	if !equivalentList(len(r.AdditionalCharacteristic), len(other.AdditionalCharacteristic), func(i, j int) bool {
+		return r.AdditionalCharacteristic[i].EqualsDeep(other.AdditionalCharacteristic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.AdditionalClassification), len(other.AdditionalClassification), func(i, j int) bool {
+		return r.AdditionalClassification[i].EqualsDeep(other.AdditionalClassification[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedEntry), len(other.RelatedEntry), func(i, j int) bool {
+		return r.RelatedEntry[i].EqualsDeep(other.RelatedEntry[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CatalogEntry) EqualsShallow(other CatalogEntry) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Orderable != other.Orderable {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.ValidTo == nil) != (other.ValidTo == nil) || r.ValidTo != nil && !equivalentString(*r.ValidTo, *other.ValidTo) {
+		return false
+	}
+	if (r.LastUpdated == nil) != (other.LastUpdated == nil) || r.LastUpdated != nil && !equivalentString(*r.LastUpdated, *other.LastUpdated) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CatalogEntry as FHIR XML
+func (r CatalogEntry) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "CatalogEntry",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "orderable", r.Orderable, r.OrderableElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "referencedItem", r.ReferencedItem); err != nil {
+		return err
+	}
+	for _, v := range r.AdditionalIdentifier {
+		if err := encodeXMLElement(e, "additionalIdentifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Classification {
+		if err := encodeXMLElement(e, "classification", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "validityPeriod", r.ValidityPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "validTo", r.ValidTo, r.ValidToElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastUpdated", r.LastUpdated, r.LastUpdatedElement); err != nil {
+		return err
+	}
+	for _, v := range r.AdditionalCharacteristic {
+		if err := encodeXMLElement(e, "additionalCharacteristic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.AdditionalClassification {
+		if err := encodeXMLElement(e, "additionalClassification", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedEntry {
+		if err := encodeXMLElement(e, "relatedEntry", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CatalogEntry from FHIR XML
+func (r *CatalogEntry) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "orderable":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Orderable = v
+				}
+				r.OrderableElement = element
+			case "referencedItem":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferencedItem = v
+			case "additionalIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditionalIdentifier = append(r.AdditionalIdentifier, v)
+			case "classification":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Classification = append(r.Classification, v)
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "validityPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValidityPeriod = &v
+			case "validTo":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValidTo = &v
+				}
+				r.ValidToElement = element
+			case "lastUpdated":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastUpdated = &v
+				}
+				r.LastUpdatedElement = element
+			case "additionalCharacteristic":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditionalCharacteristic = append(r.AdditionalCharacteristic, v)
+			case "additionalClassification":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditionalClassification = append(r.AdditionalClassification, v)
+			case "relatedEntry":
+				var v CatalogEntryRelatedEntry
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedEntry = append(r.RelatedEntry, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CatalogEntry as properties to the RDF node n
+func (r CatalogEntry) turtle(n *turtleNode) {
+	n.resource("CatalogEntry", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("CatalogEntry.identifier", i, v)
+	}
+	n.element("CatalogEntry.type", -1, r.Type)
+	n.primitive("CatalogEntry.orderable", -1, r.Orderable, r.OrderableElement, "boolean")
+	n.element("CatalogEntry.referencedItem", -1, r.ReferencedItem)
+	for i, v := range r.AdditionalIdentifier {
+		n.element("CatalogEntry.additionalIdentifier", i, v)
+	}
+	for i, v := range r.Classification {
+		n.element("CatalogEntry.classification", i, v)
+	}
+	n.primitive("CatalogEntry.status", -1, r.Status, r.StatusElement, "code")
+	n.element("CatalogEntry.validityPeriod", -1, r.ValidityPeriod)
+	n.primitive("CatalogEntry.validTo", -1, r.ValidTo, r.ValidToElement, "string")
+	n.primitive("CatalogEntry.lastUpdated", -1, r.LastUpdated, r.LastUpdatedElement, "dateTime")
+	for i, v := range r.AdditionalCharacteristic {
+		n.element("CatalogEntry.additionalCharacteristic", i, v)
+	}
+	for i, v := range r.AdditionalClassification {
+		n.element("CatalogEntry.additionalClassification", i, v)
+	}
+	for i, v := range r.RelatedEntry {
+		n.element("CatalogEntry.relatedEntry", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CatalogEntry as BSON document with the structure of its FHIR JSON
+func (r CatalogEntry) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CatalogEntry from a BSON document with the structure of its FHIR JSON
+func (r *CatalogEntry) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CatalogEntry as protobuf message CatalogEntry of fhir.proto
+func (r CatalogEntry) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CatalogEntry to the encoded message
+func (r CatalogEntry) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Type != nil {
+		e.message(10, *r.Type)
+	}
+	if r.Orderable {
+		e.varint(11, protoBool(r.Orderable))
+	}
+	e.message(12, r.ReferencedItem)
+	for _, v := range r.AdditionalIdentifier {
+		e.message(13, v)
+	}
+	for _, v := range r.Classification {
+		e.message(14, v)
+	}
+	if r.Status != nil {
+		e.varint(15, r.Status.protoNumber())
+	}
+	if r.ValidityPeriod != nil {
+		e.message(16, *r.ValidityPeriod)
+	}
+	if r.ValidTo != nil {
+		e.string(17, *r.ValidTo)
+	}
+	if r.LastUpdated != nil {
+		e.string(18, *r.LastUpdated)
+	}
+	for _, v := range r.AdditionalCharacteristic {
+		e.message(19, v)
+	}
+	for _, v := range r.AdditionalClassification {
+		e.message(20, v)
+	}
+	for _, v := range r.RelatedEntry {
+		e.message(21, v)
+	}
+	if r.IdElement != nil {
+		e.message(22, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(23, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(24, *r.LanguageElement)
+	}
+	if r.OrderableElement != nil {
+		e.message(25, *r.OrderableElement)
+	}
+	if r.StatusElement != nil {
+		e.message(26, *r.StatusElement)
+	}
+	if r.ValidToElement != nil {
+		e.message(27, *r.ValidToElement)
+	}
+	if r.LastUpdatedElement != nil {
+		e.message(28, *r.LastUpdatedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CatalogEntry from protobuf message CatalogEntry of fhir.proto
+func (r *CatalogEntry) UnmarshalProto(b []byte) error {
+	*r = CatalogEntry{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 11:
+			r.Orderable = d.bool()
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.ReferencedItem = v
+		case 13:
+			var v Identifier
+			d.message(&v)
+			r.AdditionalIdentifier = append(r.AdditionalIdentifier, v)
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Classification = append(r.Classification, v)
+		case 15:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 16:
+			var v Period
+			d.message(&v)
+			r.ValidityPeriod = &v
+		case 17:
+			v := d.string()
+			r.ValidTo = &v
+		case 18:
+			v := d.string()
+			r.LastUpdated = &v
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdditionalCharacteristic = append(r.AdditionalCharacteristic, v)
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdditionalClassification = append(r.AdditionalClassification, v)
+		case 21:
+			var v CatalogEntryRelatedEntry
+			d.message(&v)
+			r.RelatedEntry = append(r.RelatedEntry, v)
+		case 22:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.OrderableElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.ValidToElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.LastUpdatedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CatalogEntryRelatedEntry as JSON into a byte slice
+func (r CatalogEntryRelatedEntry) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CatalogEntryRelatedEntry as JSON object with the members in the order of the fields
+func (r CatalogEntryRelatedEntry) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("relationtype")
+	e.string(r.Relationtype.Code())
+	if r.RelationtypeElement != nil {
+		e.key("_relationtype")
+		r.RelationtypeElement.appendJSON(e)
+	}
+	e.key("item")
+	r.Item.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CatalogEntryRelatedEntry from JSON. Unknown members are ignored.
+func (r *CatalogEntryRelatedEntry) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CatalogEntryRelatedEntry
+func (r *CatalogEntryRelatedEntry) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "relationtype":
+			d.unmarshal(&r.Relationtype)
+		case "_relationtype":
+			r.RelationtypeElement = d.element()
+		case "item":
+			r.Item.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CatalogEntryRelatedEntry which shares no memory with the original
+func (r CatalogEntryRelatedEntry) DeepCopy() CatalogEntryRelatedEntry {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.RelationtypeElement != nil {
+		v := r.RelationtypeElement.DeepCopy()
+		out.RelationtypeElement = &v
+	}
+	out.Item = r.Item.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CatalogEntryRelatedEntry) Equal(other CatalogEntryRelatedEntry) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Relationtype != other.Relationtype {
+		return false
+	}
+	if (r.RelationtypeElement == nil) != (other.RelationtypeElement == nil) || r.RelationtypeElement != nil && !r.RelationtypeElement.Equal(*other.RelationtypeElement) {
+		return false
+	}
+	if !r.Item.Equal(other.Item) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CatalogEntryRelatedEntry) EqualsDeep(other CatalogEntryRelatedEntry) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Relationtype != other.Relationtype {
+		return false
+	}
+	if (r.RelationtypeElement == nil) != (other.RelationtypeElement == nil) || r.RelationtypeElement != nil && !r.RelationtypeElement.EqualsDeep(*other.RelationtypeElement) {
+		return false
+	}
+	if !r.Item.EqualsDeep(other.Item) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CatalogEntryRelatedEntry) EqualsShallow(other CatalogEntryRelatedEntry) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Relationtype != other.Relationtype {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CatalogEntryRelatedEntry as FHIR XML
+func (r CatalogEntryRelatedEntry) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "relationtype", r.Relationtype, r.RelationtypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "item", r.Item); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CatalogEntryRelatedEntry from FHIR XML
+func (r *CatalogEntryRelatedEntry) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "relationtype":
+				var v CatalogEntryRelationType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Relationtype = v
+				}
+				r.RelationtypeElement = element
+			case "item":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Item = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CatalogEntryRelatedEntry as properties to the RDF node n
+func (r CatalogEntryRelatedEntry) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CatalogEntry.relatedEntry.relationtype", -1, r.Relationtype, r.RelationtypeElement, "code")
+	n.element("CatalogEntry.relatedEntry.item", -1, r.Item)
+}
+
+// MarshalBSON marshals the given CatalogEntryRelatedEntry as BSON document with the structure of its FHIR JSON
+func (r CatalogEntryRelatedEntry) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CatalogEntryRelatedEntry from a BSON document with the structure of its FHIR JSON
+func (r *CatalogEntryRelatedEntry) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CatalogEntryRelatedEntry as protobuf message CatalogEntryRelatedEntry of fhir.proto
+func (r CatalogEntryRelatedEntry) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CatalogEntryRelatedEntry to the encoded message
+func (r CatalogEntryRelatedEntry) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Relationtype.protoNumber())
+	e.message(5, r.Item)
+	if r.RelationtypeElement != nil {
+		e.message(6, *r.RelationtypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CatalogEntryRelatedEntry from protobuf message CatalogEntryRelatedEntry of fhir.proto
+func (r *CatalogEntryRelatedEntry) UnmarshalProto(b []byte) error {
+	*r = CatalogEntryRelatedEntry{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CatalogEntryRelationType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Relationtype = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Item = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.RelationtypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCatalogEntry unmarshals a CatalogEntry.
 func UnmarshalCatalogEntry(b []byte) (CatalogEntry, error) {
 	var catalogEntry CatalogEntry
-	if err := json.Unmarshal(b, &catalogEntry); err != nil {
+	if err := catalogEntry.UnmarshalJSON(b); err != nil {
 		return catalogEntry, err
 	}
 	return catalogEntry, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code CatalogEntryRelationType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CatalogEntryRelationType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CatalogEntryRelationType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "triggers":
 		*code = CatalogEntryRelationTypeTriggers
@@ -46,6 +50,12 @@ func (code *CatalogEntryRelationType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CatalogEntryRelationType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CatalogEntryRelationType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CatalogEntryRelationType) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code CatalogEntryRelationType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CatalogEntryRelationType) protoNumber() uint64 {
+	switch code {
+	case CatalogEntryRelationTypeTriggers:
+		return 1
+	case CatalogEntryRelationTypeIsReplacedBy:
+		return 2
+	}
+	return 0
+}
+func (code *CatalogEntryRelationType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CatalogEntryRelationTypeTriggers
+	case 2:
+		*code = CatalogEntryRelationTypeIsReplacedBy
+	default:
+		return false
+	}
+	return true
+}
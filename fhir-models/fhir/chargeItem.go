@@ -14,50 +14,110 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ChargeItem is documented here http://hl7.org/fhir/StructureDefinition/ChargeItem
 type ChargeItem struct {
-	Id                     *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	DefinitionUri          []string              `bson:"definitionUri,omitempty" json:"definitionUri,omitempty"`
-	DefinitionCanonical    []string              `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
-	Status                 ChargeItemStatus      `bson:"status" json:"status"`
-	PartOf                 []Reference           `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Code                   CodeableConcept       `bson:"code" json:"code"`
-	Subject                Reference             `bson:"subject" json:"subject"`
-	Context                *Reference            `bson:"context,omitempty" json:"context,omitempty"`
-	OccurrenceDateTime     *string               `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod       *Period               `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming       *Timing               `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
-	Performer              []ChargeItemPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
-	PerformingOrganization *Reference            `bson:"performingOrganization,omitempty" json:"performingOrganization,omitempty"`
-	RequestingOrganization *Reference            `bson:"requestingOrganization,omitempty" json:"requestingOrganization,omitempty"`
-	CostCenter             *Reference            `bson:"costCenter,omitempty" json:"costCenter,omitempty"`
-	Quantity               *Quantity             `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Bodysite               []CodeableConcept     `bson:"bodysite,omitempty" json:"bodysite,omitempty"`
-	FactorOverride         *json.Number          `bson:"factorOverride,omitempty" json:"factorOverride,omitempty"`
-	PriceOverride          *Money                `bson:"priceOverride,omitempty" json:"priceOverride,omitempty"`
-	OverrideReason         *string               `bson:"overrideReason,omitempty" json:"overrideReason,omitempty"`
-	Enterer                *Reference            `bson:"enterer,omitempty" json:"enterer,omitempty"`
-	EnteredDate            *string               `bson:"enteredDate,omitempty" json:"enteredDate,omitempty"`
-	Reason                 []CodeableConcept     `bson:"reason,omitempty" json:"reason,omitempty"`
-	Service                []Reference           `bson:"service,omitempty" json:"service,omitempty"`
-	ProductReference       *Reference            `bson:"productReference,omitempty" json:"productReference,omitempty"`
-	ProductCodeableConcept *CodeableConcept      `bson:"productCodeableConcept,omitempty" json:"productCodeableConcept,omitempty"`
-	Account                []Reference           `bson:"account,omitempty" json:"account,omitempty"`
-	Note                   []Annotation          `bson:"note,omitempty" json:"note,omitempty"`
-	SupportingInformation  []Reference           `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	Id                         *string               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                  *Element              `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                       *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules              *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement       *Element              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                   *string               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement            *Element              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                       *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                  []json.RawMessage     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                  []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                 []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	DefinitionUri              []string              `bson:"definitionUri,omitempty" json:"definitionUri,omitempty"`
+	DefinitionUriElement       []*Element            `bson:"_definitionUri,omitempty" json:"_definitionUri,omitempty"`
+	DefinitionCanonical        []string              `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement []*Element            `bson:"_definitionCanonical,omitempty" json:"_definitionCanonical,omitempty"`
+	Status                     ChargeItemStatus      `bson:"status" json:"status"`
+	StatusElement              *Element              `bson:"_status,omitempty" json:"_status,omitempty"`
+	PartOf                     []Reference           `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Code                       CodeableConcept       `bson:"code" json:"code"`
+	Subject                    Reference             `bson:"subject" json:"subject"`
+	Context                    *Reference            `bson:"context,omitempty" json:"context,omitempty"`
+	OccurrenceDateTime         *string               `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement  *Element              `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod           *Period               `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming           *Timing               `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	Performer                  []ChargeItemPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
+	PerformingOrganization     *Reference            `bson:"performingOrganization,omitempty" json:"performingOrganization,omitempty"`
+	RequestingOrganization     *Reference            `bson:"requestingOrganization,omitempty" json:"requestingOrganization,omitempty"`
+	CostCenter                 *Reference            `bson:"costCenter,omitempty" json:"costCenter,omitempty"`
+	Quantity                   *Quantity             `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	Bodysite                   []CodeableConcept     `bson:"bodysite,omitempty" json:"bodysite,omitempty"`
+	FactorOverride             *json.Number          `bson:"factorOverride,omitempty" json:"factorOverride,omitempty"`
+	FactorOverrideElement      *Element              `bson:"_factorOverride,omitempty" json:"_factorOverride,omitempty"`
+	PriceOverride              *Money                `bson:"priceOverride,omitempty" json:"priceOverride,omitempty"`
+	OverrideReason             *string               `bson:"overrideReason,omitempty" json:"overrideReason,omitempty"`
+	OverrideReasonElement      *Element              `bson:"_overrideReason,omitempty" json:"_overrideReason,omitempty"`
+	Enterer                    *Reference            `bson:"enterer,omitempty" json:"enterer,omitempty"`
+	EnteredDate                *string               `bson:"enteredDate,omitempty" json:"enteredDate,omitempty"`
+	EnteredDateElement         *Element              `bson:"_enteredDate,omitempty" json:"_enteredDate,omitempty"`
+	Reason                     []CodeableConcept     `bson:"reason,omitempty" json:"reason,omitempty"`
+	Service                    []Reference           `bson:"service,omitempty" json:"service,omitempty"`
+	ProductReference           *Reference            `bson:"productReference,omitempty" json:"productReference,omitempty"`
+	ProductCodeableConcept     *CodeableConcept      `bson:"productCodeableConcept,omitempty" json:"productCodeableConcept,omitempty"`
+	Account                    []Reference           `bson:"account,omitempty" json:"account,omitempty"`
+	Note                       []Annotation          `bson:"note,omitempty" json:"note,omitempty"`
+	SupportingInformation      []Reference           `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
 }
+
+// ChargeItemOccurrence is implemented by the types allowed for ChargeItem.occurrence[x]
+type ChargeItemOccurrence interface {
+	isChargeItemOccurrence()
+}
+
+func (DateTime) isChargeItemOccurrence() {}
+func (Period) isChargeItemOccurrence()   {}
+func (Timing) isChargeItemOccurrence()   {}
+
+// Occurrence returns the value of ChargeItem.occurrence[x] together with its FHIR type code or nil if no value is set
+func (r ChargeItem) Occurrence() (ChargeItemOccurrence, string) {
+	if r.OccurrenceDateTime != nil {
+		return DateTime(*r.OccurrenceDateTime), "dateTime"
+	}
+	if r.OccurrencePeriod != nil {
+		return *r.OccurrencePeriod, "Period"
+	}
+	if r.OccurrenceTiming != nil {
+		return *r.OccurrenceTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetOccurrence sets the value of ChargeItem.occurrence[x], given as value or pointer, and clears all other types
+func (r *ChargeItem) SetOccurrence(value ChargeItemOccurrence) {
+	r.OccurrenceDateTime = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OccurrenceDateTime = &primitive
+	case *DateTime:
+		r.OccurrenceDateTime = (*string)(v)
+	case Period:
+		r.OccurrencePeriod = &v
+	case *Period:
+		r.OccurrencePeriod = v
+	case Timing:
+		r.OccurrenceTiming = &v
+	case *Timing:
+		r.OccurrenceTiming = v
+	}
+}
+
 type ChargeItemPerformer struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -65,23 +125,2504 @@ type ChargeItemPerformer struct {
 	Function          *CodeableConcept `bson:"function,omitempty" json:"function,omitempty"`
 	Actor             Reference        `bson:"actor" json:"actor"`
 }
-type OtherChargeItem ChargeItem
+
+// ChargeItemProduct is implemented by the types allowed for ChargeItem.product[x]
+type ChargeItemProduct interface {
+	isChargeItemProduct()
+}
+
+func (Reference) isChargeItemProduct()       {}
+func (CodeableConcept) isChargeItemProduct() {}
+
+// Product returns the value of ChargeItem.product[x] together with its FHIR type code or nil if no value is set
+func (r ChargeItem) Product() (ChargeItemProduct, string) {
+	if r.ProductReference != nil {
+		return *r.ProductReference, "Reference"
+	}
+	if r.ProductCodeableConcept != nil {
+		return *r.ProductCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetProduct sets the value of ChargeItem.product[x], given as value or pointer, and clears all other types
+func (r *ChargeItem) SetProduct(value ChargeItemProduct) {
+	r.ProductReference = nil
+	r.ProductCodeableConcept = nil
+	switch v := value.(type) {
+	case Reference:
+		r.ProductReference = &v
+	case *Reference:
+		r.ProductReference = v
+	case CodeableConcept:
+		r.ProductCodeableConcept = &v
+	case *CodeableConcept:
+		r.ProductCodeableConcept = v
+	}
+}
 
 // MarshalJSON marshals the given ChargeItem as JSON into a byte slice
 func (r ChargeItem) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherChargeItem
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherChargeItem: OtherChargeItem(r),
-		ResourceType:    "ChargeItem",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ChargeItem as JSON object with the members in the order of the fields
+func (r ChargeItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ChargeItem\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DefinitionUri) > 0 {
+		e.key("definitionUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DefinitionUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DefinitionUriElement) > 0 {
+		e.key("_definitionUri")
+		e.elements(r.DefinitionUriElement, len(r.DefinitionUri))
+	}
+	if len(r.DefinitionCanonical) > 0 {
+		e.key("definitionCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DefinitionCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DefinitionCanonicalElement) > 0 {
+		e.key("_definitionCanonical")
+		e.elements(r.DefinitionCanonicalElement, len(r.DefinitionCanonical))
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Context != nil {
+		e.key("context")
+		r.Context.appendJSON(e)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.key("occurrenceDateTime")
+		e.string(*r.OccurrenceDateTime)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.key("_occurrenceDateTime")
+		r.OccurrenceDateTimeElement.appendJSON(e)
+	}
+	if r.OccurrencePeriod != nil {
+		e.key("occurrencePeriod")
+		r.OccurrencePeriod.appendJSON(e)
+	}
+	if r.OccurrenceTiming != nil {
+		e.key("occurrenceTiming")
+		r.OccurrenceTiming.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PerformingOrganization != nil {
+		e.key("performingOrganization")
+		r.PerformingOrganization.appendJSON(e)
+	}
+	if r.RequestingOrganization != nil {
+		e.key("requestingOrganization")
+		r.RequestingOrganization.appendJSON(e)
+	}
+	if r.CostCenter != nil {
+		e.key("costCenter")
+		r.CostCenter.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if len(r.Bodysite) > 0 {
+		e.key("bodysite")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Bodysite {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.FactorOverride != nil {
+		e.key("factorOverride")
+		e.number(*r.FactorOverride)
+	}
+	if r.FactorOverrideElement != nil {
+		e.key("_factorOverride")
+		r.FactorOverrideElement.appendJSON(e)
+	}
+	if r.PriceOverride != nil {
+		e.key("priceOverride")
+		r.PriceOverride.appendJSON(e)
+	}
+	if r.OverrideReason != nil {
+		e.key("overrideReason")
+		e.string(*r.OverrideReason)
+	}
+	if r.OverrideReasonElement != nil {
+		e.key("_overrideReason")
+		r.OverrideReasonElement.appendJSON(e)
+	}
+	if r.Enterer != nil {
+		e.key("enterer")
+		r.Enterer.appendJSON(e)
+	}
+	if r.EnteredDate != nil {
+		e.key("enteredDate")
+		e.string(*r.EnteredDate)
+	}
+	if r.EnteredDateElement != nil {
+		e.key("_enteredDate")
+		r.EnteredDateElement.appendJSON(e)
+	}
+	if len(r.Reason) > 0 {
+		e.key("reason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Service) > 0 {
+		e.key("service")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Service {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ProductReference != nil {
+		e.key("productReference")
+		r.ProductReference.appendJSON(e)
+	}
+	if r.ProductCodeableConcept != nil {
+		e.key("productCodeableConcept")
+		r.ProductCodeableConcept.appendJSON(e)
+	}
+	if len(r.Account) > 0 {
+		e.key("account")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Account {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInformation) > 0 {
+		e.key("supportingInformation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInformation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ChargeItem from JSON. Unknown members are ignored.
+func (r *ChargeItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ChargeItem
+func (r *ChargeItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "definitionUri":
+			a := d.array()
+			r.DefinitionUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.DefinitionUri = append(r.DefinitionUri, v)
+			}
+			if r.DefinitionUri == nil && !a.isNull {
+				r.DefinitionUri = []string{}
+			}
+		case "_definitionUri":
+			a := d.array()
+			r.DefinitionUriElement = nil
+			for a.next() {
+				r.DefinitionUriElement = append(r.DefinitionUriElement, d.element())
+			}
+		case "definitionCanonical":
+			a := d.array()
+			r.DefinitionCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.DefinitionCanonical = append(r.DefinitionCanonical, v)
+			}
+			if r.DefinitionCanonical == nil && !a.isNull {
+				r.DefinitionCanonical = []string{}
+			}
+		case "_definitionCanonical":
+			a := d.array()
+			r.DefinitionCanonicalElement = nil
+			for a.next() {
+				r.DefinitionCanonicalElement = append(r.DefinitionCanonicalElement, d.element())
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "context":
+			if d.null() {
+				r.Context = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Context = &v
+			}
+		case "occurrenceDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurrenceDateTime = &v
+			} else {
+				r.OccurrenceDateTime = nil
+			}
+		case "_occurrenceDateTime":
+			r.OccurrenceDateTimeElement = d.element()
+		case "occurrencePeriod":
+			if d.null() {
+				r.OccurrencePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OccurrencePeriod = &v
+			}
+		case "occurrenceTiming":
+			if d.null() {
+				r.OccurrenceTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.OccurrenceTiming = &v
+			}
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v ChargeItemPerformer
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []ChargeItemPerformer{}
+			}
+		case "performingOrganization":
+			if d.null() {
+				r.PerformingOrganization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.PerformingOrganization = &v
+			}
+		case "requestingOrganization":
+			if d.null() {
+				r.RequestingOrganization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.RequestingOrganization = &v
+			}
+		case "costCenter":
+			if d.null() {
+				r.CostCenter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.CostCenter = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "bodysite":
+			a := d.array()
+			r.Bodysite = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Bodysite = append(r.Bodysite, v)
+			}
+			if r.Bodysite == nil && !a.isNull {
+				r.Bodysite = []CodeableConcept{}
+			}
+		case "factorOverride":
+			if v, ok := d.number(); ok {
+				r.FactorOverride = &v
+			} else {
+				r.FactorOverride = nil
+			}
+		case "_factorOverride":
+			r.FactorOverrideElement = d.element()
+		case "priceOverride":
+			if d.null() {
+				r.PriceOverride = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.PriceOverride = &v
+			}
+		case "overrideReason":
+			if v, ok := d.string(); ok {
+				r.OverrideReason = &v
+			} else {
+				r.OverrideReason = nil
+			}
+		case "_overrideReason":
+			r.OverrideReasonElement = d.element()
+		case "enterer":
+			if d.null() {
+				r.Enterer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Enterer = &v
+			}
+		case "enteredDate":
+			if v, ok := d.string(); ok {
+				r.EnteredDate = &v
+			} else {
+				r.EnteredDate = nil
+			}
+		case "_enteredDate":
+			r.EnteredDateElement = d.element()
+		case "reason":
+			a := d.array()
+			r.Reason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Reason = append(r.Reason, v)
+			}
+			if r.Reason == nil && !a.isNull {
+				r.Reason = []CodeableConcept{}
+			}
+		case "service":
+			a := d.array()
+			r.Service = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Service = append(r.Service, v)
+			}
+			if r.Service == nil && !a.isNull {
+				r.Service = []Reference{}
+			}
+		case "productReference":
+			if d.null() {
+				r.ProductReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ProductReference = &v
+			}
+		case "productCodeableConcept":
+			if d.null() {
+				r.ProductCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProductCodeableConcept = &v
+			}
+		case "account":
+			a := d.array()
+			r.Account = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Account = append(r.Account, v)
+			}
+			if r.Account == nil && !a.isNull {
+				r.Account = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "supportingInformation":
+			a := d.array()
+			r.SupportingInformation = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			}
+			if r.SupportingInformation == nil && !a.isNull {
+				r.SupportingInformation = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ChargeItem which shares no memory with the original
+func (r ChargeItem) DeepCopy() ChargeItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.DefinitionUri != nil {
+		out.DefinitionUri = make([]string, len(r.DefinitionUri))
+		copy(out.DefinitionUri, r.DefinitionUri)
+	}
+	if r.DefinitionUriElement != nil {
+		out.DefinitionUriElement = make([]*Element, len(r.DefinitionUriElement))
+		for i, v := range r.DefinitionUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DefinitionUriElement[i] = &c
+			}
+		}
+	}
+	if r.DefinitionCanonical != nil {
+		out.DefinitionCanonical = make([]string, len(r.DefinitionCanonical))
+		copy(out.DefinitionCanonical, r.DefinitionCanonical)
+	}
+	if r.DefinitionCanonicalElement != nil {
+		out.DefinitionCanonicalElement = make([]*Element, len(r.DefinitionCanonicalElement))
+		for i, v := range r.DefinitionCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DefinitionCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	out.Subject = r.Subject.DeepCopy()
+	if r.Context != nil {
+		v := r.Context.DeepCopy()
+		out.Context = &v
+	}
+	if r.OccurrenceDateTime != nil {
+		v := *r.OccurrenceDateTime
+		out.OccurrenceDateTime = &v
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		v := r.OccurrenceDateTimeElement.DeepCopy()
+		out.OccurrenceDateTimeElement = &v
+	}
+	if r.OccurrencePeriod != nil {
+		v := r.OccurrencePeriod.DeepCopy()
+		out.OccurrencePeriod = &v
+	}
+	if r.OccurrenceTiming != nil {
+		v := r.OccurrenceTiming.DeepCopy()
+		out.OccurrenceTiming = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]ChargeItemPerformer, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.PerformingOrganization != nil {
+		v := r.PerformingOrganization.DeepCopy()
+		out.PerformingOrganization = &v
+	}
+	if r.RequestingOrganization != nil {
+		v := r.RequestingOrganization.DeepCopy()
+		out.RequestingOrganization = &v
+	}
+	if r.CostCenter != nil {
+		v := r.CostCenter.DeepCopy()
+		out.CostCenter = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.Bodysite != nil {
+		out.Bodysite = make([]CodeableConcept, len(r.Bodysite))
+		for i := range r.Bodysite {
+			out.Bodysite[i] = r.Bodysite[i].DeepCopy()
+		}
+	}
+	if r.FactorOverride != nil {
+		v := *r.FactorOverride
+		out.FactorOverride = &v
+	}
+	if r.FactorOverrideElement != nil {
+		v := r.FactorOverrideElement.DeepCopy()
+		out.FactorOverrideElement = &v
+	}
+	if r.PriceOverride != nil {
+		v := r.PriceOverride.DeepCopy()
+		out.PriceOverride = &v
+	}
+	if r.OverrideReason != nil {
+		v := *r.OverrideReason
+		out.OverrideReason = &v
+	}
+	if r.OverrideReasonElement != nil {
+		v := r.OverrideReasonElement.DeepCopy()
+		out.OverrideReasonElement = &v
+	}
+	if r.Enterer != nil {
+		v := r.Enterer.DeepCopy()
+		out.Enterer = &v
+	}
+	if r.EnteredDate != nil {
+		v := *r.EnteredDate
+		out.EnteredDate = &v
+	}
+	if r.EnteredDateElement != nil {
+		v := r.EnteredDateElement.DeepCopy()
+		out.EnteredDateElement = &v
+	}
+	if r.Reason != nil {
+		out.Reason = make([]CodeableConcept, len(r.Reason))
+		for i := range r.Reason {
+			out.Reason[i] = r.Reason[i].DeepCopy()
+		}
+	}
+	if r.Service != nil {
+		out.Service = make([]Reference, len(r.Service))
+		for i := range r.Service {
+			out.Service[i] = r.Service[i].DeepCopy()
+		}
+	}
+	if r.ProductReference != nil {
+		v := r.ProductReference.DeepCopy()
+		out.ProductReference = &v
+	}
+	if r.ProductCodeableConcept != nil {
+		v := r.ProductCodeableConcept.DeepCopy()
+		out.ProductCodeableConcept = &v
+	}
+	if r.Account != nil {
+		out.Account = make([]Reference, len(r.Account))
+		for i := range r.Account {
+			out.Account[i] = r.Account[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.SupportingInformation != nil {
+		out.SupportingInformation = make([]Reference, len(r.SupportingInformation))
+		for i := range r.SupportingInformation {
+			out.SupportingInformation[i] = r.SupportingInformation[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ChargeItem) Equal(other ChargeItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.DefinitionUri) != len(other.DefinitionUri) {
+		return false
+	}
+	for i := range r.DefinitionUri {
+		if r.DefinitionUri[i] != other.DefinitionUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DefinitionUriElement, other.DefinitionUriElement, false) {
+		return false
+	}
+	if len(r.DefinitionCanonical) != len(other.DefinitionCanonical) {
+		return false
+	}
+	for i := range r.DefinitionCanonical {
+		if r.DefinitionCanonical[i] != other.DefinitionCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DefinitionCanonicalElement, other.DefinitionCanonicalElement, false) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.Equal(*other.Context) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && *r.OccurrenceDateTime != *other.OccurrenceDateTime {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.Equal(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.Equal(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.Equal(*other.OccurrenceTiming) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if (r.PerformingOrganization == nil) != (other.PerformingOrganization == nil) || r.PerformingOrganization != nil && !r.PerformingOrganization.Equal(*other.PerformingOrganization) {
+		return false
+	}
+	if (r.RequestingOrganization == nil) != (other.RequestingOrganization == nil) || r.RequestingOrganization != nil && !r.RequestingOrganization.Equal(*other.RequestingOrganization) {
+		return false
+	}
+	if (r.CostCenter == nil) != (other.CostCenter == nil) || r.CostCenter != nil && !r.CostCenter.Equal(*other.CostCenter) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if len(r.Bodysite) != len(other.Bodysite) {
+		return false
+	}
+	for i := range r.Bodysite {
+		if !r.Bodysite[i].Equal(other.Bodysite[i]) {
+			return false
+		}
+	}
+	if (r.FactorOverride == nil) != (other.FactorOverride == nil) || r.FactorOverride != nil && !EqualDecimal(*r.FactorOverride, *other.FactorOverride) {
+		return false
+	}
+	if (r.FactorOverrideElement == nil) != (other.FactorOverrideElement == nil) || r.FactorOverrideElement != nil && !r.FactorOverrideElement.Equal(*other.FactorOverrideElement) {
+		return false
+	}
+	if (r.PriceOverride == nil) != (other.PriceOverride == nil) || r.PriceOverride != nil && !r.PriceOverride.Equal(*other.PriceOverride) {
+		return false
+	}
+	if (r.OverrideReason == nil) != (other.OverrideReason == nil) || r.OverrideReason != nil && *r.OverrideReason != *other.OverrideReason {
+		return false
+	}
+	if (r.OverrideReasonElement == nil) != (other.OverrideReasonElement == nil) || r.OverrideReasonElement != nil && !r.OverrideReasonElement.Equal(*other.OverrideReasonElement) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.Equal(*other.Enterer) {
+		return false
+	}
+	if (r.EnteredDate == nil) != (other.EnteredDate == nil) || r.EnteredDate != nil && *r.EnteredDate != *other.EnteredDate {
+		return false
+	}
+	if (r.EnteredDateElement == nil) != (other.EnteredDateElement == nil) || r.EnteredDateElement != nil && !r.EnteredDateElement.Equal(*other.EnteredDateElement) {
+		return false
+	}
+	if len(r.Reason) != len(other.Reason) {
+		return false
+	}
+	for i := range r.Reason {
+		if !r.Reason[i].Equal(other.Reason[i]) {
+			return false
+		}
+	}
+	if len(r.Service) != len(other.Service) {
+		return false
+	}
+	for i := range r.Service {
+		if !r.Service[i].Equal(other.Service[i]) {
+			return false
+		}
+	}
+	if (r.ProductReference == nil) != (other.ProductReference == nil) || r.ProductReference != nil && !r.ProductReference.Equal(*other.ProductReference) {
+		return false
+	}
+	if (r.ProductCodeableConcept == nil) != (other.ProductCodeableConcept == nil) || r.ProductCodeableConcept != nil && !r.ProductCodeableConcept.Equal(*other.ProductCodeableConcept) {
+		return false
+	}
+	if len(r.Account) != len(other.Account) {
+		return false
+	}
+	for i := range r.Account {
+		if !r.Account[i].Equal(other.Account[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingInformation) != len(other.SupportingInformation) {
+		return false
+	}
+	for i := range r.SupportingInformation {
+		if !r.SupportingInformation[i].Equal(other.SupportingInformation[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ChargeItem) EqualsDeep(other ChargeItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DefinitionUri), len(other.DefinitionUri), func(i, j int) bool {
+		return equivalentString(r.DefinitionUri[i], other.DefinitionUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.DefinitionUriElement, other.DefinitionUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.DefinitionCanonical), len(other.DefinitionCanonical), func(i, j int) bool {
+		return equivalentString(r.DefinitionCanonical[i], other.DefinitionCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.DefinitionCanonicalElement, other.DefinitionCanonicalElement, true) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.EqualsDeep(*other.Context) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.EqualsDeep(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.EqualsDeep(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.EqualsDeep(*other.OccurrenceTiming) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if (r.PerformingOrganization == nil) != (other.PerformingOrganization == nil) || r.PerformingOrganization != nil && !r.PerformingOrganization.EqualsDeep(*other.PerformingOrganization) {
+		return false
+	}
+	if (r.RequestingOrganization == nil) != (other.RequestingOrganization == nil) || r.RequestingOrganization != nil && !r.RequestingOrganization.EqualsDeep(*other.RequestingOrganization) {
+		return false
+	}
+	if (r.CostCenter == nil) != (other.CostCenter == nil) || r.CostCenter != nil && !r.CostCenter.EqualsDeep(*other.CostCenter) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if !equivalentList(len(r.Bodysite), len(other.Bodysite), func(i, j int) bool {
+		return r.Bodysite[i].EqualsDeep(other.Bodysite[j])
+	}) {
+		return false
+	}
+	if (r.FactorOverride == nil) != (other.FactorOverride == nil) || r.FactorOverride != nil && !equivalentDecimal(*r.FactorOverride, *other.FactorOverride) {
+		return false
+	}
+	if (r.FactorOverrideElement == nil) != (other.FactorOverrideElement == nil) || r.FactorOverrideElement != nil && !r.FactorOverrideElement.EqualsDeep(*other.FactorOverrideElement) {
+		return false
+	}
+	if (r.PriceOverride == nil) != (other.PriceOverride == nil) || r.PriceOverride != nil && !r.PriceOverride.EqualsDeep(*other.PriceOverride) {
+		return false
+	}
+	if (r.OverrideReason == nil) != (other.OverrideReason == nil) || r.OverrideReason != nil && !equivalentString(*r.OverrideReason, *other.OverrideReason) {
+		return false
+	}
+	if (r.OverrideReasonElement == nil) != (other.OverrideReasonElement == nil) || r.OverrideReasonElement != nil && !r.OverrideReasonElement.EqualsDeep(*other.OverrideReasonElement) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.EqualsDeep(*other.Enterer) {
+		return false
+	}
+	if (r.EnteredDate == nil) != (other.EnteredDate == nil) || r.EnteredDate != nil && !equivalentString(*r.EnteredDate, *other.EnteredDate) {
+		return false
+	}
+	if (r.EnteredDateElement == nil) != (other.EnteredDateElement == nil) || r.EnteredDateElement != nil && !r.EnteredDateElement.EqualsDeep(*other.EnteredDateElement) {
+		return false
+	}
+	if !equivalentList(len(r.Reason), len(other.Reason), func(i, j int) bool {
+		return r.Reason[i].EqualsDeep(other.Reason[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Service), len(other.Service), func(i, j int) bool {
+		return r.Service[i].EqualsDeep(other.Service[j])
+	}) {
+		return false
+	}
+	if (r.ProductReference == nil) != (other.ProductReference == nil) || r.ProductReference != nil && !r.ProductReference.EqualsDeep(*other.ProductReference) {
+		return false
+	}
+	if (r.ProductCodeableConcept == nil) != (other.ProductCodeableConcept == nil) || r.ProductCodeableConcept != nil && !r.ProductCodeableConcept.EqualsDeep(*other.ProductCodeableConcept) {
+		return false
+	}
+	if !equivalentList(len(r.Account), len(other.Account), func(i, j int) bool {
+		return r.Account[i].EqualsDeep(other.Account[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInformation), len(other.SupportingInformation), func(i, j int) bool {
+		return r.SupportingInformation[i].EqualsDeep(other.SupportingInformation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ChargeItem) EqualsShallow(other ChargeItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.DefinitionUri), len(other.DefinitionUri), func(i, j int) bool {
+		return equivalentString(r.DefinitionUri[i], other.DefinitionUri[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DefinitionCanonical), len(other.DefinitionCanonical), func(i, j int) bool {
+		return equivalentString(r.DefinitionCanonical[i], other.DefinitionCanonical[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.FactorOverride == nil) != (other.FactorOverride == nil) || r.FactorOverride != nil && !equivalentDecimal(*r.FactorOverride, *other.FactorOverride) {
+		return false
+	}
+	if (r.OverrideReason == nil) != (other.OverrideReason == nil) || r.OverrideReason != nil && !equivalentString(*r.OverrideReason, *other.OverrideReason) {
+		return false
+	}
+	if (r.EnteredDate == nil) != (other.EnteredDate == nil) || r.EnteredDate != nil && !equivalentString(*r.EnteredDate, *other.EnteredDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ChargeItem as FHIR XML
+func (r ChargeItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ChargeItem",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.DefinitionUri {
+		if err := encodeXMLPrimitive(e, "definitionUri", v, elementAt(r.DefinitionUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.DefinitionCanonical {
+		if err := encodeXMLPrimitive(e, "definitionCanonical", v, elementAt(r.DefinitionCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "context", r.Context); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceDateTime", r.OccurrenceDateTime, r.OccurrenceDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrencePeriod", r.OccurrencePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrenceTiming", r.OccurrenceTiming); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "performingOrganization", r.PerformingOrganization); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requestingOrganization", r.RequestingOrganization); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "costCenter", r.CostCenter); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	for _, v := range r.Bodysite {
+		if err := encodeXMLElement(e, "bodysite", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "factorOverride", r.FactorOverride, r.FactorOverrideElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "priceOverride", r.PriceOverride); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "overrideReason", r.OverrideReason, r.OverrideReasonElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "enterer", r.Enterer); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "enteredDate", r.EnteredDate, r.EnteredDateElement); err != nil {
+		return err
+	}
+	for _, v := range r.Reason {
+		if err := encodeXMLElement(e, "reason", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Service {
+		if err := encodeXMLElement(e, "service", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "productReference", r.ProductReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productCodeableConcept", r.ProductCodeableConcept); err != nil {
+		return err
+	}
+	for _, v := range r.Account {
+		if err := encodeXMLElement(e, "account", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SupportingInformation {
+		if err := encodeXMLElement(e, "supportingInformation", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ChargeItem from FHIR XML
+func (r *ChargeItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "definitionUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DefinitionUriElement = appendElement(r.DefinitionUriElement, len(r.DefinitionUri), element)
+					r.DefinitionUri = append(r.DefinitionUri, v)
+				}
+			case "definitionCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DefinitionCanonicalElement = appendElement(r.DefinitionCanonicalElement, len(r.DefinitionCanonical), element)
+					r.DefinitionCanonical = append(r.DefinitionCanonical, v)
+				}
+			case "status":
+				var v ChargeItemStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "context":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Context = &v
+			case "occurrenceDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceDateTime = &v
+				}
+				r.OccurrenceDateTimeElement = element
+			case "occurrencePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrencePeriod = &v
+			case "occurrenceTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrenceTiming = &v
+			case "performer":
+				var v ChargeItemPerformer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "performingOrganization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformingOrganization = &v
+			case "requestingOrganization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RequestingOrganization = &v
+			case "costCenter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CostCenter = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "bodysite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Bodysite = append(r.Bodysite, v)
+			case "factorOverride":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FactorOverride = &v
+				}
+				r.FactorOverrideElement = element
+			case "priceOverride":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PriceOverride = &v
+			case "overrideReason":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OverrideReason = &v
+				}
+				r.OverrideReasonElement = element
+			case "enterer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Enterer = &v
+			case "enteredDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EnteredDate = &v
+				}
+				r.EnteredDateElement = element
+			case "reason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = append(r.Reason, v)
+			case "service":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Service = append(r.Service, v)
+			case "productReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductReference = &v
+			case "productCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductCodeableConcept = &v
+			case "account":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Account = append(r.Account, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "supportingInformation":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ChargeItem as properties to the RDF node n
+func (r ChargeItem) turtle(n *turtleNode) {
+	n.resource("ChargeItem", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ChargeItem.identifier", i, v)
+	}
+	for i, v := range r.DefinitionUri {
+		n.primitive("ChargeItem.definitionUri", i, v, elementAt(r.DefinitionUriElement, i), "string")
+	}
+	for i, v := range r.DefinitionCanonical {
+		n.primitive("ChargeItem.definitionCanonical", i, v, elementAt(r.DefinitionCanonicalElement, i), "string")
+	}
+	n.primitive("ChargeItem.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.PartOf {
+		n.element("ChargeItem.partOf", i, v)
+	}
+	n.element("ChargeItem.code", -1, r.Code)
+	n.element("ChargeItem.subject", -1, r.Subject)
+	n.element("ChargeItem.context", -1, r.Context)
+	n.primitive("ChargeItem.occurrenceDateTime", -1, r.OccurrenceDateTime, r.OccurrenceDateTimeElement, "dateTime")
+	n.element("ChargeItem.occurrencePeriod", -1, r.OccurrencePeriod)
+	n.element("ChargeItem.occurrenceTiming", -1, r.OccurrenceTiming)
+	for i, v := range r.Performer {
+		n.element("ChargeItem.performer", i, v)
+	}
+	n.element("ChargeItem.performingOrganization", -1, r.PerformingOrganization)
+	n.element("ChargeItem.requestingOrganization", -1, r.RequestingOrganization)
+	n.element("ChargeItem.costCenter", -1, r.CostCenter)
+	n.element("ChargeItem.quantity", -1, r.Quantity)
+	for i, v := range r.Bodysite {
+		n.element("ChargeItem.bodysite", i, v)
+	}
+	n.primitive("ChargeItem.factorOverride", -1, r.FactorOverride, r.FactorOverrideElement, "decimal")
+	n.element("ChargeItem.priceOverride", -1, r.PriceOverride)
+	n.primitive("ChargeItem.overrideReason", -1, r.OverrideReason, r.OverrideReasonElement, "string")
+	n.element("ChargeItem.enterer", -1, r.Enterer)
+	n.primitive("ChargeItem.enteredDate", -1, r.EnteredDate, r.EnteredDateElement, "dateTime")
+	for i, v := range r.Reason {
+		n.element("ChargeItem.reason", i, v)
+	}
+	for i, v := range r.Service {
+		n.element("ChargeItem.service", i, v)
+	}
+	n.element("ChargeItem.productReference", -1, r.ProductReference)
+	n.element("ChargeItem.productCodeableConcept", -1, r.ProductCodeableConcept)
+	for i, v := range r.Account {
+		n.element("ChargeItem.account", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("ChargeItem.note", i, v)
+	}
+	for i, v := range r.SupportingInformation {
+		n.element("ChargeItem.supportingInformation", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ChargeItem as BSON document with the structure of its FHIR JSON
+func (r ChargeItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ChargeItem from a BSON document with the structure of its FHIR JSON
+func (r *ChargeItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ChargeItem as protobuf message ChargeItem of fhir.proto
+func (r ChargeItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ChargeItem to the encoded message
+func (r ChargeItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.DefinitionUri {
+		e.string(10, v)
+	}
+	for _, v := range r.DefinitionCanonical {
+		e.string(11, v)
+	}
+	e.varint(12, r.Status.protoNumber())
+	for _, v := range r.PartOf {
+		e.message(13, v)
+	}
+	e.message(14, r.Code)
+	e.message(15, r.Subject)
+	if r.Context != nil {
+		e.message(16, *r.Context)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.string(17, *r.OccurrenceDateTime)
+	}
+	if r.OccurrencePeriod != nil {
+		e.message(18, *r.OccurrencePeriod)
+	}
+	if r.OccurrenceTiming != nil {
+		e.message(19, *r.OccurrenceTiming)
+	}
+	for _, v := range r.Performer {
+		e.message(20, v)
+	}
+	if r.PerformingOrganization != nil {
+		e.message(21, *r.PerformingOrganization)
+	}
+	if r.RequestingOrganization != nil {
+		e.message(22, *r.RequestingOrganization)
+	}
+	if r.CostCenter != nil {
+		e.message(23, *r.CostCenter)
+	}
+	if r.Quantity != nil {
+		e.message(24, *r.Quantity)
+	}
+	for _, v := range r.Bodysite {
+		e.message(25, v)
+	}
+	if r.FactorOverride != nil {
+		e.string(26, string(*r.FactorOverride))
+	}
+	if r.PriceOverride != nil {
+		e.message(27, *r.PriceOverride)
+	}
+	if r.OverrideReason != nil {
+		e.string(28, *r.OverrideReason)
+	}
+	if r.Enterer != nil {
+		e.message(29, *r.Enterer)
+	}
+	if r.EnteredDate != nil {
+		e.string(30, *r.EnteredDate)
+	}
+	for _, v := range r.Reason {
+		e.message(31, v)
+	}
+	for _, v := range r.Service {
+		e.message(32, v)
+	}
+	if r.ProductReference != nil {
+		e.message(33, *r.ProductReference)
+	}
+	if r.ProductCodeableConcept != nil {
+		e.message(34, *r.ProductCodeableConcept)
+	}
+	for _, v := range r.Account {
+		e.message(35, v)
+	}
+	for _, v := range r.Note {
+		e.message(36, v)
+	}
+	for _, v := range r.SupportingInformation {
+		e.message(37, v)
+	}
+	if r.IdElement != nil {
+		e.message(38, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(39, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(40, *r.LanguageElement)
+	}
+	for _, v := range r.DefinitionUriElement {
+		e.element(41, v)
+	}
+	for _, v := range r.DefinitionCanonicalElement {
+		e.element(42, v)
+	}
+	if r.StatusElement != nil {
+		e.message(43, *r.StatusElement)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.message(44, *r.OccurrenceDateTimeElement)
+	}
+	if r.FactorOverrideElement != nil {
+		e.message(45, *r.FactorOverrideElement)
+	}
+	if r.OverrideReasonElement != nil {
+		e.message(46, *r.OverrideReasonElement)
+	}
+	if r.EnteredDateElement != nil {
+		e.message(47, *r.EnteredDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ChargeItem from protobuf message ChargeItem of fhir.proto
+func (r *ChargeItem) UnmarshalProto(b []byte) error {
+	*r = ChargeItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.DefinitionUri = append(r.DefinitionUri, d.string())
+		case 11:
+			r.DefinitionCanonical = append(r.DefinitionCanonical, d.string())
+		case 12:
+			var v ChargeItemStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Context = &v
+		case 17:
+			v := d.string()
+			r.OccurrenceDateTime = &v
+		case 18:
+			var v Period
+			d.message(&v)
+			r.OccurrencePeriod = &v
+		case 19:
+			var v Timing
+			d.message(&v)
+			r.OccurrenceTiming = &v
+		case 20:
+			var v ChargeItemPerformer
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.PerformingOrganization = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.RequestingOrganization = &v
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.CostCenter = &v
+		case 24:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 25:
+			var v CodeableConcept
+			d.message(&v)
+			r.Bodysite = append(r.Bodysite, v)
+		case 26:
+			v := json.Number(d.string())
+			r.FactorOverride = &v
+		case 27:
+			var v Money
+			d.message(&v)
+			r.PriceOverride = &v
+		case 28:
+			v := d.string()
+			r.OverrideReason = &v
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.Enterer = &v
+		case 30:
+			v := d.string()
+			r.EnteredDate = &v
+		case 31:
+			var v CodeableConcept
+			d.message(&v)
+			r.Reason = append(r.Reason, v)
+		case 32:
+			var v Reference
+			d.message(&v)
+			r.Service = append(r.Service, v)
+		case 33:
+			var v Reference
+			d.message(&v)
+			r.ProductReference = &v
+		case 34:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductCodeableConcept = &v
+		case 35:
+			var v Reference
+			d.message(&v)
+			r.Account = append(r.Account, v)
+		case 36:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 37:
+			var v Reference
+			d.message(&v)
+			r.SupportingInformation = append(r.SupportingInformation, v)
+		case 38:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 41:
+			r.DefinitionUriElement = append(r.DefinitionUriElement, d.element())
+		case 42:
+			r.DefinitionCanonicalElement = append(r.DefinitionCanonicalElement, d.element())
+		case 43:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.OccurrenceDateTimeElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.FactorOverrideElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.OverrideReasonElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.EnteredDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ChargeItemPerformer as JSON into a byte slice
+func (r ChargeItemPerformer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ChargeItemPerformer as JSON object with the members in the order of the fields
+func (r ChargeItemPerformer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Function != nil {
+		e.key("function")
+		r.Function.appendJSON(e)
+	}
+	e.key("actor")
+	r.Actor.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ChargeItemPerformer from JSON. Unknown members are ignored.
+func (r *ChargeItemPerformer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ChargeItemPerformer
+func (r *ChargeItemPerformer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "function":
+			if d.null() {
+				r.Function = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Function = &v
+			}
+		case "actor":
+			r.Actor.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ChargeItemPerformer which shares no memory with the original
+func (r ChargeItemPerformer) DeepCopy() ChargeItemPerformer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Function != nil {
+		v := r.Function.DeepCopy()
+		out.Function = &v
+	}
+	out.Actor = r.Actor.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ChargeItemPerformer) Equal(other ChargeItemPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.Equal(*other.Function) {
+		return false
+	}
+	if !r.Actor.Equal(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ChargeItemPerformer) EqualsDeep(other ChargeItemPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.EqualsDeep(*other.Function) {
+		return false
+	}
+	if !r.Actor.EqualsDeep(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ChargeItemPerformer) EqualsShallow(other ChargeItemPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ChargeItemPerformer as FHIR XML
+func (r ChargeItemPerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "function", r.Function); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "actor", r.Actor); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ChargeItemPerformer from FHIR XML
+func (r *ChargeItemPerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "function":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Function = &v
+			case "actor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ChargeItemPerformer as properties to the RDF node n
+func (r ChargeItemPerformer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ChargeItem.performer.function", -1, r.Function)
+	n.element("ChargeItem.performer.actor", -1, r.Actor)
+}
+
+// MarshalBSON marshals the given ChargeItemPerformer as BSON document with the structure of its FHIR JSON
+func (r ChargeItemPerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ChargeItemPerformer from a BSON document with the structure of its FHIR JSON
+func (r *ChargeItemPerformer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ChargeItemPerformer as protobuf message ChargeItemPerformer of fhir.proto
+func (r ChargeItemPerformer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ChargeItemPerformer to the encoded message
+func (r ChargeItemPerformer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Function != nil {
+		e.message(4, *r.Function)
+	}
+	e.message(5, r.Actor)
+}
+
+// UnmarshalProto unmarshals the given ChargeItemPerformer from protobuf message ChargeItemPerformer of fhir.proto
+func (r *ChargeItemPerformer) UnmarshalProto(b []byte) error {
+	*r = ChargeItemPerformer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Function = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Actor = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalChargeItem unmarshals a ChargeItem.
 func UnmarshalChargeItem(b []byte) (ChargeItem, error) {
 	var chargeItem ChargeItem
-	if err := json.Unmarshal(b, &chargeItem); err != nil {
+	if err := chargeItem.UnmarshalJSON(b); err != nil {
 		return chargeItem, err
 	}
 	return chargeItem, nil
@@ -14,51 +14,75 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ChargeItemDefinition is documented here http://hl7.org/fhir/StructureDefinition/ChargeItemDefinition
 type ChargeItemDefinition struct {
-	Id                *string                             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               string                              `bson:"url" json:"url"`
-	Identifier        []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                             `bson:"version,omitempty" json:"version,omitempty"`
-	Title             *string                             `bson:"title,omitempty" json:"title,omitempty"`
-	DerivedFromUri    []string                            `bson:"derivedFromUri,omitempty" json:"derivedFromUri,omitempty"`
-	PartOf            []string                            `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Replaces          []string                            `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	Status            PublicationStatus                   `bson:"status" json:"status"`
-	Experimental      *bool                               `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                             `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                     `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                             `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                      `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                   `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                             `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *string                             `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *string                             `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period                             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Code              *CodeableConcept                    `bson:"code,omitempty" json:"code,omitempty"`
-	Instance          []Reference                         `bson:"instance,omitempty" json:"instance,omitempty"`
-	Applicability     []ChargeItemDefinitionApplicability `bson:"applicability,omitempty" json:"applicability,omitempty"`
-	PropertyGroup     []ChargeItemDefinitionPropertyGroup `bson:"propertyGroup,omitempty" json:"propertyGroup,omitempty"`
+	Id                    *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                            `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                  *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage                   `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                   string                              `bson:"url" json:"url"`
+	UrlElement            *Element                            `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string                             `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element                            `bson:"_version,omitempty" json:"_version,omitempty"`
+	Title                 *string                             `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                            `bson:"_title,omitempty" json:"_title,omitempty"`
+	DerivedFromUri        []string                            `bson:"derivedFromUri,omitempty" json:"derivedFromUri,omitempty"`
+	DerivedFromUriElement []*Element                          `bson:"_derivedFromUri,omitempty" json:"_derivedFromUri,omitempty"`
+	PartOf                []string                            `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	PartOfElement         []*Element                          `bson:"_partOf,omitempty" json:"_partOf,omitempty"`
+	Replaces              []string                            `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	ReplacesElement       []*Element                          `bson:"_replaces,omitempty" json:"_replaces,omitempty"`
+	Status                PublicationStatus                   `bson:"status" json:"status"`
+	StatusElement         *Element                            `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental          *bool                               `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement   *Element                            `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                  *string                             `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                            `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element                            `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail                     `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string                             `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                            `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext            []UsageContext                      `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept                   `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright             *string                             `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element                            `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *string                             `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element                            `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *string                             `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element                            `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period                             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Code                  *CodeableConcept                    `bson:"code,omitempty" json:"code,omitempty"`
+	Instance              []Reference                         `bson:"instance,omitempty" json:"instance,omitempty"`
+	Applicability         []ChargeItemDefinitionApplicability `bson:"applicability,omitempty" json:"applicability,omitempty"`
+	PropertyGroup         []ChargeItemDefinitionPropertyGroup `bson:"propertyGroup,omitempty" json:"propertyGroup,omitempty"`
 }
 type ChargeItemDefinitionApplicability struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
-	Language          *string     `bson:"language,omitempty" json:"language,omitempty"`
-	Expression        *string     `bson:"expression,omitempty" json:"expression,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        *string     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Language           *string     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement    *Element    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Expression         *string     `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement  *Element    `bson:"_expression,omitempty" json:"_expression,omitempty"`
 }
 type ChargeItemDefinitionPropertyGroup struct {
 	Id                *string                                           `bson:"id,omitempty" json:"id,omitempty"`
@@ -72,27 +96,3455 @@ type ChargeItemDefinitionPropertyGroupPriceComponent struct {
 	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              InvoicePriceComponentType `bson:"type" json:"type"`
+	TypeElement       *Element                  `bson:"_type,omitempty" json:"_type,omitempty"`
 	Code              *CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
 	Factor            *json.Number              `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                  `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Amount            *Money                    `bson:"amount,omitempty" json:"amount,omitempty"`
 }
-type OtherChargeItemDefinition ChargeItemDefinition
 
 // MarshalJSON marshals the given ChargeItemDefinition as JSON into a byte slice
 func (r ChargeItemDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherChargeItemDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherChargeItemDefinition: OtherChargeItemDefinition(r),
-		ResourceType:              "ChargeItemDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ChargeItemDefinition as JSON object with the members in the order of the fields
+func (r ChargeItemDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ChargeItemDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if len(r.DerivedFromUri) > 0 {
+		e.key("derivedFromUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DerivedFromUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DerivedFromUriElement) > 0 {
+		e.key("_derivedFromUri")
+		e.elements(r.DerivedFromUriElement, len(r.DerivedFromUri))
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOfElement) > 0 {
+		e.key("_partOf")
+		e.elements(r.PartOfElement, len(r.PartOf))
+	}
+	if len(r.Replaces) > 0 {
+		e.key("replaces")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Replaces {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReplacesElement) > 0 {
+		e.key("_replaces")
+		e.elements(r.ReplacesElement, len(r.Replaces))
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.ApprovalDate != nil {
+		e.key("approvalDate")
+		e.string(*r.ApprovalDate)
+	}
+	if r.ApprovalDateElement != nil {
+		e.key("_approvalDate")
+		r.ApprovalDateElement.appendJSON(e)
+	}
+	if r.LastReviewDate != nil {
+		e.key("lastReviewDate")
+		e.string(*r.LastReviewDate)
+	}
+	if r.LastReviewDateElement != nil {
+		e.key("_lastReviewDate")
+		r.LastReviewDateElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if len(r.Instance) > 0 {
+		e.key("instance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Instance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Applicability) > 0 {
+		e.key("applicability")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Applicability {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PropertyGroup) > 0 {
+		e.key("propertyGroup")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PropertyGroup {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ChargeItemDefinition from JSON. Unknown members are ignored.
+func (r *ChargeItemDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ChargeItemDefinition
+func (r *ChargeItemDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "derivedFromUri":
+			a := d.array()
+			r.DerivedFromUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.DerivedFromUri = append(r.DerivedFromUri, v)
+			}
+			if r.DerivedFromUri == nil && !a.isNull {
+				r.DerivedFromUri = []string{}
+			}
+		case "_derivedFromUri":
+			a := d.array()
+			r.DerivedFromUriElement = nil
+			for a.next() {
+				r.DerivedFromUriElement = append(r.DerivedFromUriElement, d.element())
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				v, _ := d.string()
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []string{}
+			}
+		case "_partOf":
+			a := d.array()
+			r.PartOfElement = nil
+			for a.next() {
+				r.PartOfElement = append(r.PartOfElement, d.element())
+			}
+		case "replaces":
+			a := d.array()
+			r.Replaces = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Replaces = append(r.Replaces, v)
+			}
+			if r.Replaces == nil && !a.isNull {
+				r.Replaces = []string{}
+			}
+		case "_replaces":
+			a := d.array()
+			r.ReplacesElement = nil
+			for a.next() {
+				r.ReplacesElement = append(r.ReplacesElement, d.element())
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "approvalDate":
+			if v, ok := d.string(); ok {
+				r.ApprovalDate = &v
+			} else {
+				r.ApprovalDate = nil
+			}
+		case "_approvalDate":
+			r.ApprovalDateElement = d.element()
+		case "lastReviewDate":
+			if v, ok := d.string(); ok {
+				r.LastReviewDate = &v
+			} else {
+				r.LastReviewDate = nil
+			}
+		case "_lastReviewDate":
+			r.LastReviewDateElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "instance":
+			a := d.array()
+			r.Instance = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Instance = append(r.Instance, v)
+			}
+			if r.Instance == nil && !a.isNull {
+				r.Instance = []Reference{}
+			}
+		case "applicability":
+			a := d.array()
+			r.Applicability = nil
+			for a.next() {
+				var v ChargeItemDefinitionApplicability
+				v.decodeJSON(d)
+				r.Applicability = append(r.Applicability, v)
+			}
+			if r.Applicability == nil && !a.isNull {
+				r.Applicability = []ChargeItemDefinitionApplicability{}
+			}
+		case "propertyGroup":
+			a := d.array()
+			r.PropertyGroup = nil
+			for a.next() {
+				var v ChargeItemDefinitionPropertyGroup
+				v.decodeJSON(d)
+				r.PropertyGroup = append(r.PropertyGroup, v)
+			}
+			if r.PropertyGroup == nil && !a.isNull {
+				r.PropertyGroup = []ChargeItemDefinitionPropertyGroup{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ChargeItemDefinition which shares no memory with the original
+func (r ChargeItemDefinition) DeepCopy() ChargeItemDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.DerivedFromUri != nil {
+		out.DerivedFromUri = make([]string, len(r.DerivedFromUri))
+		copy(out.DerivedFromUri, r.DerivedFromUri)
+	}
+	if r.DerivedFromUriElement != nil {
+		out.DerivedFromUriElement = make([]*Element, len(r.DerivedFromUriElement))
+		for i, v := range r.DerivedFromUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DerivedFromUriElement[i] = &c
+			}
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]string, len(r.PartOf))
+		copy(out.PartOf, r.PartOf)
+	}
+	if r.PartOfElement != nil {
+		out.PartOfElement = make([]*Element, len(r.PartOfElement))
+		for i, v := range r.PartOfElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PartOfElement[i] = &c
+			}
+		}
+	}
+	if r.Replaces != nil {
+		out.Replaces = make([]string, len(r.Replaces))
+		copy(out.Replaces, r.Replaces)
+	}
+	if r.ReplacesElement != nil {
+		out.ReplacesElement = make([]*Element, len(r.ReplacesElement))
+		for i, v := range r.ReplacesElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ReplacesElement[i] = &c
+			}
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.ApprovalDate != nil {
+		v := *r.ApprovalDate
+		out.ApprovalDate = &v
+	}
+	if r.ApprovalDateElement != nil {
+		v := r.ApprovalDateElement.DeepCopy()
+		out.ApprovalDateElement = &v
+	}
+	if r.LastReviewDate != nil {
+		v := *r.LastReviewDate
+		out.LastReviewDate = &v
+	}
+	if r.LastReviewDateElement != nil {
+		v := r.LastReviewDateElement.DeepCopy()
+		out.LastReviewDateElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Instance != nil {
+		out.Instance = make([]Reference, len(r.Instance))
+		for i := range r.Instance {
+			out.Instance[i] = r.Instance[i].DeepCopy()
+		}
+	}
+	if r.Applicability != nil {
+		out.Applicability = make([]ChargeItemDefinitionApplicability, len(r.Applicability))
+		for i := range r.Applicability {
+			out.Applicability[i] = r.Applicability[i].DeepCopy()
+		}
+	}
+	if r.PropertyGroup != nil {
+		out.PropertyGroup = make([]ChargeItemDefinitionPropertyGroup, len(r.PropertyGroup))
+		for i := range r.PropertyGroup {
+			out.PropertyGroup[i] = r.PropertyGroup[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ChargeItemDefinition) Equal(other ChargeItemDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if len(r.DerivedFromUri) != len(other.DerivedFromUri) {
+		return false
+	}
+	for i := range r.DerivedFromUri {
+		if r.DerivedFromUri[i] != other.DerivedFromUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DerivedFromUriElement, other.DerivedFromUriElement, false) {
+		return false
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if r.PartOf[i] != other.PartOf[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PartOfElement, other.PartOfElement, false) {
+		return false
+	}
+	if len(r.Replaces) != len(other.Replaces) {
+		return false
+	}
+	for i := range r.Replaces {
+		if r.Replaces[i] != other.Replaces[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ReplacesElement, other.ReplacesElement, false) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && *r.ApprovalDate != *other.ApprovalDate {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.Equal(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && *r.LastReviewDate != *other.LastReviewDate {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.Equal(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if len(r.Instance) != len(other.Instance) {
+		return false
+	}
+	for i := range r.Instance {
+		if !r.Instance[i].Equal(other.Instance[i]) {
+			return false
+		}
+	}
+	if len(r.Applicability) != len(other.Applicability) {
+		return false
+	}
+	for i := range r.Applicability {
+		if !r.Applicability[i].Equal(other.Applicability[i]) {
+			return false
+		}
+	}
+	if len(r.PropertyGroup) != len(other.PropertyGroup) {
+		return false
+	}
+	for i := range r.PropertyGroup {
+		if !r.PropertyGroup[i].Equal(other.PropertyGroup[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ChargeItemDefinition) EqualsDeep(other ChargeItemDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if !equivalentList(len(r.DerivedFromUri), len(other.DerivedFromUri), func(i, j int) bool {
+		return equivalentString(r.DerivedFromUri[i], other.DerivedFromUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.DerivedFromUriElement, other.DerivedFromUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return equivalentString(r.PartOf[i], other.PartOf[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PartOfElement, other.PartOfElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Replaces), len(other.Replaces), func(i, j int) bool {
+		return equivalentString(r.Replaces[i], other.Replaces[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ReplacesElement, other.ReplacesElement, true) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.EqualsDeep(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.EqualsDeep(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.Instance), len(other.Instance), func(i, j int) bool {
+		return r.Instance[i].EqualsDeep(other.Instance[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Applicability), len(other.Applicability), func(i, j int) bool {
+		return r.Applicability[i].EqualsDeep(other.Applicability[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PropertyGroup), len(other.PropertyGroup), func(i, j int) bool {
+		return r.PropertyGroup[i].EqualsDeep(other.PropertyGroup[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ChargeItemDefinition) EqualsShallow(other ChargeItemDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if !equivalentList(len(r.DerivedFromUri), len(other.DerivedFromUri), func(i, j int) bool {
+		return equivalentString(r.DerivedFromUri[i], other.DerivedFromUri[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return equivalentString(r.PartOf[i], other.PartOf[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Replaces), len(other.Replaces), func(i, j int) bool {
+		return equivalentString(r.Replaces[i], other.Replaces[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ChargeItemDefinition as FHIR XML
+func (r ChargeItemDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ChargeItemDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	for i, v := range r.DerivedFromUri {
+		if err := encodeXMLPrimitive(e, "derivedFromUri", v, elementAt(r.DerivedFromUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.PartOf {
+		if err := encodeXMLPrimitive(e, "partOf", v, elementAt(r.PartOfElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Replaces {
+		if err := encodeXMLPrimitive(e, "replaces", v, elementAt(r.ReplacesElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "approvalDate", r.ApprovalDate, r.ApprovalDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastReviewDate", r.LastReviewDate, r.LastReviewDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.Instance {
+		if err := encodeXMLElement(e, "instance", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Applicability {
+		if err := encodeXMLElement(e, "applicability", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PropertyGroup {
+		if err := encodeXMLElement(e, "propertyGroup", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ChargeItemDefinition from FHIR XML
+func (r *ChargeItemDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "derivedFromUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DerivedFromUriElement = appendElement(r.DerivedFromUriElement, len(r.DerivedFromUri), element)
+					r.DerivedFromUri = append(r.DerivedFromUri, v)
+				}
+			case "partOf":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PartOfElement = appendElement(r.PartOfElement, len(r.PartOf), element)
+					r.PartOf = append(r.PartOf, v)
+				}
+			case "replaces":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ReplacesElement = appendElement(r.ReplacesElement, len(r.Replaces), element)
+					r.Replaces = append(r.Replaces, v)
+				}
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "approvalDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ApprovalDate = &v
+				}
+				r.ApprovalDateElement = element
+			case "lastReviewDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastReviewDate = &v
+				}
+				r.LastReviewDateElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "instance":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Instance = append(r.Instance, v)
+			case "applicability":
+				var v ChargeItemDefinitionApplicability
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Applicability = append(r.Applicability, v)
+			case "propertyGroup":
+				var v ChargeItemDefinitionPropertyGroup
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PropertyGroup = append(r.PropertyGroup, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ChargeItemDefinition as properties to the RDF node n
+func (r ChargeItemDefinition) turtle(n *turtleNode) {
+	n.resource("ChargeItemDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("ChargeItemDefinition.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("ChargeItemDefinition.identifier", i, v)
+	}
+	n.primitive("ChargeItemDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("ChargeItemDefinition.title", -1, r.Title, r.TitleElement, "string")
+	for i, v := range r.DerivedFromUri {
+		n.primitive("ChargeItemDefinition.derivedFromUri", i, v, elementAt(r.DerivedFromUriElement, i), "string")
+	}
+	for i, v := range r.PartOf {
+		n.primitive("ChargeItemDefinition.partOf", i, v, elementAt(r.PartOfElement, i), "string")
+	}
+	for i, v := range r.Replaces {
+		n.primitive("ChargeItemDefinition.replaces", i, v, elementAt(r.ReplacesElement, i), "string")
+	}
+	n.primitive("ChargeItemDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("ChargeItemDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("ChargeItemDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("ChargeItemDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("ChargeItemDefinition.contact", i, v)
+	}
+	n.primitive("ChargeItemDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("ChargeItemDefinition.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("ChargeItemDefinition.jurisdiction", i, v)
+	}
+	n.primitive("ChargeItemDefinition.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("ChargeItemDefinition.approvalDate", -1, r.ApprovalDate, r.ApprovalDateElement, "date")
+	n.primitive("ChargeItemDefinition.lastReviewDate", -1, r.LastReviewDate, r.LastReviewDateElement, "date")
+	n.element("ChargeItemDefinition.effectivePeriod", -1, r.EffectivePeriod)
+	n.element("ChargeItemDefinition.code", -1, r.Code)
+	for i, v := range r.Instance {
+		n.element("ChargeItemDefinition.instance", i, v)
+	}
+	for i, v := range r.Applicability {
+		n.element("ChargeItemDefinition.applicability", i, v)
+	}
+	for i, v := range r.PropertyGroup {
+		n.element("ChargeItemDefinition.propertyGroup", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ChargeItemDefinition as BSON document with the structure of its FHIR JSON
+func (r ChargeItemDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ChargeItemDefinition from a BSON document with the structure of its FHIR JSON
+func (r *ChargeItemDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ChargeItemDefinition as protobuf message ChargeItemDefinition of fhir.proto
+func (r ChargeItemDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ChargeItemDefinition to the encoded message
+func (r ChargeItemDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != "" {
+		e.string(9, r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Title != nil {
+		e.string(12, *r.Title)
+	}
+	for _, v := range r.DerivedFromUri {
+		e.string(13, v)
+	}
+	for _, v := range r.PartOf {
+		e.string(14, v)
+	}
+	for _, v := range r.Replaces {
+		e.string(15, v)
+	}
+	e.varint(16, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(17, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(18, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(19, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(20, v)
+	}
+	if r.Description != nil {
+		e.string(21, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(22, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(23, v)
+	}
+	if r.Copyright != nil {
+		e.string(24, *r.Copyright)
+	}
+	if r.ApprovalDate != nil {
+		e.string(25, *r.ApprovalDate)
+	}
+	if r.LastReviewDate != nil {
+		e.string(26, *r.LastReviewDate)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(27, *r.EffectivePeriod)
+	}
+	if r.Code != nil {
+		e.message(28, *r.Code)
+	}
+	for _, v := range r.Instance {
+		e.message(29, v)
+	}
+	for _, v := range r.Applicability {
+		e.message(30, v)
+	}
+	for _, v := range r.PropertyGroup {
+		e.message(31, v)
+	}
+	if r.IdElement != nil {
+		e.message(32, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(33, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(34, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(35, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(36, *r.VersionElement)
+	}
+	if r.TitleElement != nil {
+		e.message(37, *r.TitleElement)
+	}
+	for _, v := range r.DerivedFromUriElement {
+		e.element(38, v)
+	}
+	for _, v := range r.PartOfElement {
+		e.element(39, v)
+	}
+	for _, v := range r.ReplacesElement {
+		e.element(40, v)
+	}
+	if r.StatusElement != nil {
+		e.message(41, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(42, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(43, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(44, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(45, *r.DescriptionElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(46, *r.CopyrightElement)
+	}
+	if r.ApprovalDateElement != nil {
+		e.message(47, *r.ApprovalDateElement)
+	}
+	if r.LastReviewDateElement != nil {
+		e.message(48, *r.LastReviewDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ChargeItemDefinition from protobuf message ChargeItemDefinition of fhir.proto
+func (r *ChargeItemDefinition) UnmarshalProto(b []byte) error {
+	*r = ChargeItemDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			r.Url = d.string()
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Title = &v
+		case 13:
+			r.DerivedFromUri = append(r.DerivedFromUri, d.string())
+		case 14:
+			r.PartOf = append(r.PartOf, d.string())
+		case 15:
+			r.Replaces = append(r.Replaces, d.string())
+		case 16:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 17:
+			v := d.bool()
+			r.Experimental = &v
+		case 18:
+			v := d.string()
+			r.Date = &v
+		case 19:
+			v := d.string()
+			r.Publisher = &v
+		case 20:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 21:
+			v := d.string()
+			r.Description = &v
+		case 22:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 24:
+			v := d.string()
+			r.Copyright = &v
+		case 25:
+			v := d.string()
+			r.ApprovalDate = &v
+		case 26:
+			v := d.string()
+			r.LastReviewDate = &v
+		case 27:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 28:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.Instance = append(r.Instance, v)
+		case 30:
+			var v ChargeItemDefinitionApplicability
+			d.message(&v)
+			r.Applicability = append(r.Applicability, v)
+		case 31:
+			var v ChargeItemDefinitionPropertyGroup
+			d.message(&v)
+			r.PropertyGroup = append(r.PropertyGroup, v)
+		case 32:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 38:
+			r.DerivedFromUriElement = append(r.DerivedFromUriElement, d.element())
+		case 39:
+			r.PartOfElement = append(r.PartOfElement, d.element())
+		case 40:
+			r.ReplacesElement = append(r.ReplacesElement, d.element())
+		case 41:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.ApprovalDateElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.LastReviewDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ChargeItemDefinitionApplicability as JSON into a byte slice
+func (r ChargeItemDefinitionApplicability) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ChargeItemDefinitionApplicability as JSON object with the members in the order of the fields
+func (r ChargeItemDefinitionApplicability) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		e.string(*r.Expression)
+	}
+	if r.ExpressionElement != nil {
+		e.key("_expression")
+		r.ExpressionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ChargeItemDefinitionApplicability from JSON. Unknown members are ignored.
+func (r *ChargeItemDefinitionApplicability) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ChargeItemDefinitionApplicability
+func (r *ChargeItemDefinitionApplicability) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "expression":
+			if v, ok := d.string(); ok {
+				r.Expression = &v
+			} else {
+				r.Expression = nil
+			}
+		case "_expression":
+			r.ExpressionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ChargeItemDefinitionApplicability which shares no memory with the original
+func (r ChargeItemDefinitionApplicability) DeepCopy() ChargeItemDefinitionApplicability {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Expression != nil {
+		v := *r.Expression
+		out.Expression = &v
+	}
+	if r.ExpressionElement != nil {
+		v := r.ExpressionElement.DeepCopy()
+		out.ExpressionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ChargeItemDefinitionApplicability) Equal(other ChargeItemDefinitionApplicability) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && *r.Expression != *other.Expression {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.Equal(*other.ExpressionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ChargeItemDefinitionApplicability) EqualsDeep(other ChargeItemDefinitionApplicability) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.EqualsDeep(*other.ExpressionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ChargeItemDefinitionApplicability) EqualsShallow(other ChargeItemDefinitionApplicability) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ChargeItemDefinitionApplicability as FHIR XML
+func (r ChargeItemDefinitionApplicability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expression", r.Expression, r.ExpressionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ChargeItemDefinitionApplicability from FHIR XML
+func (r *ChargeItemDefinitionApplicability) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Expression = &v
+				}
+				r.ExpressionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ChargeItemDefinitionApplicability as properties to the RDF node n
+func (r ChargeItemDefinitionApplicability) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ChargeItemDefinition.applicability.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("ChargeItemDefinition.applicability.language", -1, r.Language, r.LanguageElement, "string")
+	n.primitive("ChargeItemDefinition.applicability.expression", -1, r.Expression, r.ExpressionElement, "string")
+}
+
+// MarshalBSON marshals the given ChargeItemDefinitionApplicability as BSON document with the structure of its FHIR JSON
+func (r ChargeItemDefinitionApplicability) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ChargeItemDefinitionApplicability from a BSON document with the structure of its FHIR JSON
+func (r *ChargeItemDefinitionApplicability) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ChargeItemDefinitionApplicability as protobuf message ChargeItemDefinitionApplicability of fhir.proto
+func (r ChargeItemDefinitionApplicability) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ChargeItemDefinitionApplicability to the encoded message
+func (r ChargeItemDefinitionApplicability) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.Language != nil {
+		e.string(5, *r.Language)
+	}
+	if r.Expression != nil {
+		e.string(6, *r.Expression)
+	}
+	if r.DescriptionElement != nil {
+		e.message(7, *r.DescriptionElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(8, *r.LanguageElement)
+	}
+	if r.ExpressionElement != nil {
+		e.message(9, *r.ExpressionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ChargeItemDefinitionApplicability from protobuf message ChargeItemDefinitionApplicability of fhir.proto
+func (r *ChargeItemDefinitionApplicability) UnmarshalProto(b []byte) error {
+	*r = ChargeItemDefinitionApplicability{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			v := d.string()
+			r.Language = &v
+		case 6:
+			v := d.string()
+			r.Expression = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ExpressionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ChargeItemDefinitionPropertyGroup as JSON into a byte slice
+func (r ChargeItemDefinitionPropertyGroup) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ChargeItemDefinitionPropertyGroup as JSON object with the members in the order of the fields
+func (r ChargeItemDefinitionPropertyGroup) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Applicability) > 0 {
+		e.key("applicability")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Applicability {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PriceComponent) > 0 {
+		e.key("priceComponent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PriceComponent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ChargeItemDefinitionPropertyGroup from JSON. Unknown members are ignored.
+func (r *ChargeItemDefinitionPropertyGroup) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ChargeItemDefinitionPropertyGroup
+func (r *ChargeItemDefinitionPropertyGroup) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "applicability":
+			a := d.array()
+			r.Applicability = nil
+			for a.next() {
+				var v ChargeItemDefinitionApplicability
+				v.decodeJSON(d)
+				r.Applicability = append(r.Applicability, v)
+			}
+			if r.Applicability == nil && !a.isNull {
+				r.Applicability = []ChargeItemDefinitionApplicability{}
+			}
+		case "priceComponent":
+			a := d.array()
+			r.PriceComponent = nil
+			for a.next() {
+				var v ChargeItemDefinitionPropertyGroupPriceComponent
+				v.decodeJSON(d)
+				r.PriceComponent = append(r.PriceComponent, v)
+			}
+			if r.PriceComponent == nil && !a.isNull {
+				r.PriceComponent = []ChargeItemDefinitionPropertyGroupPriceComponent{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ChargeItemDefinitionPropertyGroup which shares no memory with the original
+func (r ChargeItemDefinitionPropertyGroup) DeepCopy() ChargeItemDefinitionPropertyGroup {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Applicability != nil {
+		out.Applicability = make([]ChargeItemDefinitionApplicability, len(r.Applicability))
+		for i := range r.Applicability {
+			out.Applicability[i] = r.Applicability[i].DeepCopy()
+		}
+	}
+	if r.PriceComponent != nil {
+		out.PriceComponent = make([]ChargeItemDefinitionPropertyGroupPriceComponent, len(r.PriceComponent))
+		for i := range r.PriceComponent {
+			out.PriceComponent[i] = r.PriceComponent[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ChargeItemDefinitionPropertyGroup) Equal(other ChargeItemDefinitionPropertyGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Applicability) != len(other.Applicability) {
+		return false
+	}
+	for i := range r.Applicability {
+		if !r.Applicability[i].Equal(other.Applicability[i]) {
+			return false
+		}
+	}
+	if len(r.PriceComponent) != len(other.PriceComponent) {
+		return false
+	}
+	for i := range r.PriceComponent {
+		if !r.PriceComponent[i].Equal(other.PriceComponent[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ChargeItemDefinitionPropertyGroup) EqualsDeep(other ChargeItemDefinitionPropertyGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Applicability), len(other.Applicability), func(i, j int) bool {
+		return r.Applicability[i].EqualsDeep(other.Applicability[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PriceComponent), len(other.PriceComponent), func(i, j int) bool {
+		return r.PriceComponent[i].EqualsDeep(other.PriceComponent[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ChargeItemDefinitionPropertyGroup) EqualsShallow(other ChargeItemDefinitionPropertyGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ChargeItemDefinitionPropertyGroup as FHIR XML
+func (r ChargeItemDefinitionPropertyGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Applicability {
+		if err := encodeXMLElement(e, "applicability", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PriceComponent {
+		if err := encodeXMLElement(e, "priceComponent", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ChargeItemDefinitionPropertyGroup from FHIR XML
+func (r *ChargeItemDefinitionPropertyGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "applicability":
+				var v ChargeItemDefinitionApplicability
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Applicability = append(r.Applicability, v)
+			case "priceComponent":
+				var v ChargeItemDefinitionPropertyGroupPriceComponent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PriceComponent = append(r.PriceComponent, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ChargeItemDefinitionPropertyGroup as properties to the RDF node n
+func (r ChargeItemDefinitionPropertyGroup) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Applicability {
+		n.element("ChargeItemDefinition.propertyGroup.applicability", i, v)
+	}
+	for i, v := range r.PriceComponent {
+		n.element("ChargeItemDefinition.propertyGroup.priceComponent", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ChargeItemDefinitionPropertyGroup as BSON document with the structure of its FHIR JSON
+func (r ChargeItemDefinitionPropertyGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ChargeItemDefinitionPropertyGroup from a BSON document with the structure of its FHIR JSON
+func (r *ChargeItemDefinitionPropertyGroup) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ChargeItemDefinitionPropertyGroup as protobuf message ChargeItemDefinitionPropertyGroup of fhir.proto
+func (r ChargeItemDefinitionPropertyGroup) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ChargeItemDefinitionPropertyGroup to the encoded message
+func (r ChargeItemDefinitionPropertyGroup) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Applicability {
+		e.message(4, v)
+	}
+	for _, v := range r.PriceComponent {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ChargeItemDefinitionPropertyGroup from protobuf message ChargeItemDefinitionPropertyGroup of fhir.proto
+func (r *ChargeItemDefinitionPropertyGroup) UnmarshalProto(b []byte) error {
+	*r = ChargeItemDefinitionPropertyGroup{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ChargeItemDefinitionApplicability
+			d.message(&v)
+			r.Applicability = append(r.Applicability, v)
+		case 5:
+			var v ChargeItemDefinitionPropertyGroupPriceComponent
+			d.message(&v)
+			r.PriceComponent = append(r.PriceComponent, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ChargeItemDefinitionPropertyGroupPriceComponent as JSON into a byte slice
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ChargeItemDefinitionPropertyGroupPriceComponent as JSON object with the members in the order of the fields
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ChargeItemDefinitionPropertyGroupPriceComponent from JSON. Unknown members are ignored.
+func (r *ChargeItemDefinitionPropertyGroupPriceComponent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ChargeItemDefinitionPropertyGroupPriceComponent
+func (r *ChargeItemDefinitionPropertyGroupPriceComponent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ChargeItemDefinitionPropertyGroupPriceComponent which shares no memory with the original
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) DeepCopy() ChargeItemDefinitionPropertyGroupPriceComponent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) Equal(other ChargeItemDefinitionPropertyGroupPriceComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) EqualsDeep(other ChargeItemDefinitionPropertyGroupPriceComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) EqualsShallow(other ChargeItemDefinitionPropertyGroupPriceComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ChargeItemDefinitionPropertyGroupPriceComponent as FHIR XML
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ChargeItemDefinitionPropertyGroupPriceComponent from FHIR XML
+func (r *ChargeItemDefinitionPropertyGroupPriceComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v InvoicePriceComponentType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ChargeItemDefinitionPropertyGroupPriceComponent as properties to the RDF node n
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ChargeItemDefinition.propertyGroup.priceComponent.type", -1, r.Type, r.TypeElement, "code")
+	n.element("ChargeItemDefinition.propertyGroup.priceComponent.code", -1, r.Code)
+	n.primitive("ChargeItemDefinition.propertyGroup.priceComponent.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ChargeItemDefinition.propertyGroup.priceComponent.amount", -1, r.Amount)
+}
+
+// MarshalBSON marshals the given ChargeItemDefinitionPropertyGroupPriceComponent as BSON document with the structure of its FHIR JSON
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ChargeItemDefinitionPropertyGroupPriceComponent from a BSON document with the structure of its FHIR JSON
+func (r *ChargeItemDefinitionPropertyGroupPriceComponent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ChargeItemDefinitionPropertyGroupPriceComponent as protobuf message ChargeItemDefinitionPropertyGroupPriceComponent of fhir.proto
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ChargeItemDefinitionPropertyGroupPriceComponent to the encoded message
+func (r ChargeItemDefinitionPropertyGroupPriceComponent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Code != nil {
+		e.message(5, *r.Code)
+	}
+	if r.Factor != nil {
+		e.string(6, string(*r.Factor))
+	}
+	if r.Amount != nil {
+		e.message(7, *r.Amount)
+	}
+	if r.TypeElement != nil {
+		e.message(8, *r.TypeElement)
+	}
+	if r.FactorElement != nil {
+		e.message(9, *r.FactorElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ChargeItemDefinitionPropertyGroupPriceComponent from protobuf message ChargeItemDefinitionPropertyGroupPriceComponent of fhir.proto
+func (r *ChargeItemDefinitionPropertyGroupPriceComponent) UnmarshalProto(b []byte) error {
+	*r = ChargeItemDefinitionPropertyGroupPriceComponent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v InvoicePriceComponentType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 6:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 7:
+			var v Money
+			d.message(&v)
+			r.Amount = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalChargeItemDefinition unmarshals a ChargeItemDefinition.
 func UnmarshalChargeItemDefinition(b []byte) (ChargeItemDefinition, error) {
 	var chargeItemDefinition ChargeItemDefinition
-	if err := json.Unmarshal(b, &chargeItemDefinition); err != nil {
+	if err := chargeItemDefinition.UnmarshalJSON(b); err != nil {
 		return chargeItemDefinition, err
 	}
 	return chargeItemDefinition, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code ChargeItemStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ChargeItemStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ChargeItemStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "planned":
 		*code = ChargeItemStatusPlanned
@@ -61,6 +65,12 @@ func (code *ChargeItemStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ChargeItemStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ChargeItemStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ChargeItemStatus) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code ChargeItemStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ChargeItemStatus) protoNumber() uint64 {
+	switch code {
+	case ChargeItemStatusPlanned:
+		return 1
+	case ChargeItemStatusBillable:
+		return 2
+	case ChargeItemStatusNotBillable:
+		return 3
+	case ChargeItemStatusAborted:
+		return 4
+	case ChargeItemStatusBilled:
+		return 5
+	case ChargeItemStatusEnteredInError:
+		return 6
+	case ChargeItemStatusUnknown:
+		return 7
+	}
+	return 0
+}
+func (code *ChargeItemStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ChargeItemStatusPlanned
+	case 2:
+		*code = ChargeItemStatusBillable
+	case 3:
+		*code = ChargeItemStatusNotBillable
+	case 4:
+		*code = ChargeItemStatusAborted
+	case 5:
+		*code = ChargeItemStatusBilled
+	case 6:
+		*code = ChargeItemStatusEnteredInError
+	case 7:
+		*code = ChargeItemStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
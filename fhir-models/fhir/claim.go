@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,20 +25,27 @@ import "encoding/json"
 // Claim is documented here http://hl7.org/fhir/StructureDefinition/Claim
 type Claim struct {
 	Id                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                     `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage            `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status               FinancialResourceStatusCodes `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
 	Type                 CodeableConcept              `bson:"type" json:"type"`
 	SubType              *CodeableConcept             `bson:"subType,omitempty" json:"subType,omitempty"`
 	Use                  Use                          `bson:"use" json:"use"`
+	UseElement           *Element                     `bson:"_use,omitempty" json:"_use,omitempty"`
 	Patient              Reference                    `bson:"patient" json:"patient"`
 	BillablePeriod       *Period                      `bson:"billablePeriod,omitempty" json:"billablePeriod,omitempty"`
 	Created              string                       `bson:"created" json:"created"`
+	CreatedElement       *Element                     `bson:"_created,omitempty" json:"_created,omitempty"`
 	Enterer              *Reference                   `bson:"enterer,omitempty" json:"enterer,omitempty"`
 	Insurer              *Reference                   `bson:"insurer,omitempty" json:"insurer,omitempty"`
 	Provider             Reference                    `bson:"provider" json:"provider"`
@@ -72,108 +82,420 @@ type ClaimPayee struct {
 	Party             *Reference      `bson:"party,omitempty" json:"party,omitempty"`
 }
 type ClaimCareTeam struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int              `bson:"sequence" json:"sequence"`
-	Provider          Reference        `bson:"provider" json:"provider"`
-	Responsible       *bool            `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Qualification     *CodeableConcept `bson:"qualification,omitempty" json:"qualification,omitempty"`
+	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence           int              `bson:"sequence" json:"sequence"`
+	SequenceElement    *Element         `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Provider           Reference        `bson:"provider" json:"provider"`
+	Responsible        *bool            `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	ResponsibleElement *Element         `bson:"_responsible,omitempty" json:"_responsible,omitempty"`
+	Role               *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
+	Qualification      *CodeableConcept `bson:"qualification,omitempty" json:"qualification,omitempty"`
 }
 type ClaimSupportingInfo struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int              `bson:"sequence" json:"sequence"`
-	Category          CodeableConcept  `bson:"category" json:"category"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	TimingDate        *string          `bson:"timingDate,omitempty" json:"timingDate,omitempty"`
-	TimingPeriod      *Period          `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
-	ValueBoolean      *bool            `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
-	ValueString       *string          `bson:"valueString,omitempty" json:"valueString,omitempty"`
-	ValueQuantity     *Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
-	ValueAttachment   *Attachment      `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
-	ValueReference    *Reference       `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
-	Reason            *CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
+	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence            int              `bson:"sequence" json:"sequence"`
+	SequenceElement     *Element         `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Category            CodeableConcept  `bson:"category" json:"category"`
+	Code                *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
+	TimingDate          *string          `bson:"timingDate,omitempty" json:"timingDate,omitempty"`
+	TimingDateElement   *Element         `bson:"_timingDate,omitempty" json:"_timingDate,omitempty"`
+	TimingPeriod        *Period          `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	ValueBoolean        *bool            `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement *Element         `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueString         *string          `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement  *Element         `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueQuantity       *Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueAttachment     *Attachment      `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueReference      *Reference       `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	Reason              *CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// ClaimSupportingInfoTiming is implemented by the types allowed for Claim.supportingInfo.timing[x]
+type ClaimSupportingInfoTiming interface {
+	isClaimSupportingInfoTiming()
+}
+
+func (Date) isClaimSupportingInfoTiming()   {}
+func (Period) isClaimSupportingInfoTiming() {}
+
+// Timing returns the value of Claim.supportingInfo.timing[x] together with its FHIR type code or nil if no value is set
+func (r ClaimSupportingInfo) Timing() (ClaimSupportingInfoTiming, string) {
+	if r.TimingDate != nil {
+		return Date(*r.TimingDate), "date"
+	}
+	if r.TimingPeriod != nil {
+		return *r.TimingPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetTiming sets the value of Claim.supportingInfo.timing[x], given as value or pointer, and clears all other types
+func (r *ClaimSupportingInfo) SetTiming(value ClaimSupportingInfoTiming) {
+	r.TimingDate = nil
+	r.TimingPeriod = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.TimingDate = &primitive
+	case *Date:
+		r.TimingDate = (*string)(v)
+	case Period:
+		r.TimingPeriod = &v
+	case *Period:
+		r.TimingPeriod = v
+	}
 }
+
+// ClaimSupportingInfoValue is implemented by the types allowed for Claim.supportingInfo.value[x]
+type ClaimSupportingInfoValue interface {
+	isClaimSupportingInfoValue()
+}
+
+func (Boolean) isClaimSupportingInfoValue()    {}
+func (String) isClaimSupportingInfoValue()     {}
+func (Quantity) isClaimSupportingInfoValue()   {}
+func (Attachment) isClaimSupportingInfoValue() {}
+func (Reference) isClaimSupportingInfoValue()  {}
+
+// Value returns the value of Claim.supportingInfo.value[x] together with its FHIR type code or nil if no value is set
+func (r ClaimSupportingInfo) Value() (ClaimSupportingInfoValue, string) {
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueAttachment != nil {
+		return *r.ValueAttachment, "Attachment"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Claim.supportingInfo.value[x], given as value or pointer, and clears all other types
+func (r *ClaimSupportingInfo) SetValue(value ClaimSupportingInfoValue) {
+	r.ValueBoolean = nil
+	r.ValueString = nil
+	r.ValueQuantity = nil
+	r.ValueAttachment = nil
+	r.ValueReference = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Attachment:
+		r.ValueAttachment = &v
+	case *Attachment:
+		r.ValueAttachment = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	}
+}
+
 type ClaimDiagnosis struct {
 	Id                       *string           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension                []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence                 int               `bson:"sequence" json:"sequence"`
-	DiagnosisCodeableConcept CodeableConcept   `bson:"diagnosisCodeableConcept" json:"diagnosisCodeableConcept"`
-	DiagnosisReference       Reference         `bson:"diagnosisReference" json:"diagnosisReference"`
+	SequenceElement          *Element          `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	DiagnosisCodeableConcept *CodeableConcept  `bson:"diagnosisCodeableConcept,omitempty" json:"diagnosisCodeableConcept,omitempty"`
+	DiagnosisReference       *Reference        `bson:"diagnosisReference,omitempty" json:"diagnosisReference,omitempty"`
 	Type                     []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	OnAdmission              *CodeableConcept  `bson:"onAdmission,omitempty" json:"onAdmission,omitempty"`
 	PackageCode              *CodeableConcept  `bson:"packageCode,omitempty" json:"packageCode,omitempty"`
 }
+
+// ClaimDiagnosisDiagnosis is implemented by the types allowed for Claim.diagnosis.diagnosis[x]
+type ClaimDiagnosisDiagnosis interface {
+	isClaimDiagnosisDiagnosis()
+}
+
+func (CodeableConcept) isClaimDiagnosisDiagnosis() {}
+func (Reference) isClaimDiagnosisDiagnosis()       {}
+
+// Diagnosis returns the value of Claim.diagnosis.diagnosis[x] together with its FHIR type code or nil if no value is set
+func (r ClaimDiagnosis) Diagnosis() (ClaimDiagnosisDiagnosis, string) {
+	if r.DiagnosisCodeableConcept != nil {
+		return *r.DiagnosisCodeableConcept, "CodeableConcept"
+	}
+	if r.DiagnosisReference != nil {
+		return *r.DiagnosisReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetDiagnosis sets the value of Claim.diagnosis.diagnosis[x], given as value or pointer, and clears all other types
+func (r *ClaimDiagnosis) SetDiagnosis(value ClaimDiagnosisDiagnosis) {
+	r.DiagnosisCodeableConcept = nil
+	r.DiagnosisReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.DiagnosisCodeableConcept = &v
+	case *CodeableConcept:
+		r.DiagnosisCodeableConcept = v
+	case Reference:
+		r.DiagnosisReference = &v
+	case *Reference:
+		r.DiagnosisReference = v
+	}
+}
+
 type ClaimProcedure struct {
 	Id                       *string           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension                []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence                 int               `bson:"sequence" json:"sequence"`
+	SequenceElement          *Element          `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Type                     []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	Date                     *string           `bson:"date,omitempty" json:"date,omitempty"`
-	ProcedureCodeableConcept CodeableConcept   `bson:"procedureCodeableConcept" json:"procedureCodeableConcept"`
-	ProcedureReference       Reference         `bson:"procedureReference" json:"procedureReference"`
+	DateElement              *Element          `bson:"_date,omitempty" json:"_date,omitempty"`
+	ProcedureCodeableConcept *CodeableConcept  `bson:"procedureCodeableConcept,omitempty" json:"procedureCodeableConcept,omitempty"`
+	ProcedureReference       *Reference        `bson:"procedureReference,omitempty" json:"procedureReference,omitempty"`
 	Udi                      []Reference       `bson:"udi,omitempty" json:"udi,omitempty"`
 }
+
+// ClaimProcedureProcedure is implemented by the types allowed for Claim.procedure.procedure[x]
+type ClaimProcedureProcedure interface {
+	isClaimProcedureProcedure()
+}
+
+func (CodeableConcept) isClaimProcedureProcedure() {}
+func (Reference) isClaimProcedureProcedure()       {}
+
+// Procedure returns the value of Claim.procedure.procedure[x] together with its FHIR type code or nil if no value is set
+func (r ClaimProcedure) Procedure() (ClaimProcedureProcedure, string) {
+	if r.ProcedureCodeableConcept != nil {
+		return *r.ProcedureCodeableConcept, "CodeableConcept"
+	}
+	if r.ProcedureReference != nil {
+		return *r.ProcedureReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetProcedure sets the value of Claim.procedure.procedure[x], given as value or pointer, and clears all other types
+func (r *ClaimProcedure) SetProcedure(value ClaimProcedureProcedure) {
+	r.ProcedureCodeableConcept = nil
+	r.ProcedureReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ProcedureCodeableConcept = &v
+	case *CodeableConcept:
+		r.ProcedureCodeableConcept = v
+	case Reference:
+		r.ProcedureReference = &v
+	case *Reference:
+		r.ProcedureReference = v
+	}
+}
+
 type ClaimInsurance struct {
-	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence            int         `bson:"sequence" json:"sequence"`
-	Focal               bool        `bson:"focal" json:"focal"`
-	Identifier          *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Coverage            Reference   `bson:"coverage" json:"coverage"`
-	BusinessArrangement *string     `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
-	PreAuthRef          []string    `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
-	ClaimResponse       *Reference  `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
+	Id                         *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                   int         `bson:"sequence" json:"sequence"`
+	SequenceElement            *Element    `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Focal                      bool        `bson:"focal" json:"focal"`
+	FocalElement               *Element    `bson:"_focal,omitempty" json:"_focal,omitempty"`
+	Identifier                 *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Coverage                   Reference   `bson:"coverage" json:"coverage"`
+	BusinessArrangement        *string     `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *Element    `bson:"_businessArrangement,omitempty" json:"_businessArrangement,omitempty"`
+	PreAuthRef                 []string    `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement          []*Element  `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
+	ClaimResponse              *Reference  `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
 }
 type ClaimAccident struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Date              string           `bson:"date" json:"date"`
+	DateElement       *Element         `bson:"_date,omitempty" json:"_date,omitempty"`
 	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	LocationAddress   *Address         `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
 	LocationReference *Reference       `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
 }
+
+// ClaimAccidentLocation is implemented by the types allowed for Claim.accident.location[x]
+type ClaimAccidentLocation interface {
+	isClaimAccidentLocation()
+}
+
+func (Address) isClaimAccidentLocation()   {}
+func (Reference) isClaimAccidentLocation() {}
+
+// Location returns the value of Claim.accident.location[x] together with its FHIR type code or nil if no value is set
+func (r ClaimAccident) Location() (ClaimAccidentLocation, string) {
+	if r.LocationAddress != nil {
+		return *r.LocationAddress, "Address"
+	}
+	if r.LocationReference != nil {
+		return *r.LocationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetLocation sets the value of Claim.accident.location[x], given as value or pointer, and clears all other types
+func (r *ClaimAccident) SetLocation(value ClaimAccidentLocation) {
+	r.LocationAddress = nil
+	r.LocationReference = nil
+	switch v := value.(type) {
+	case Address:
+		r.LocationAddress = &v
+	case *Address:
+		r.LocationAddress = v
+	case Reference:
+		r.LocationReference = &v
+	case *Reference:
+		r.LocationReference = v
+	}
+}
+
 type ClaimItem struct {
-	Id                      *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence                int               `bson:"sequence" json:"sequence"`
-	CareTeamSequence        []int             `bson:"careTeamSequence,omitempty" json:"careTeamSequence,omitempty"`
-	DiagnosisSequence       []int             `bson:"diagnosisSequence,omitempty" json:"diagnosisSequence,omitempty"`
-	ProcedureSequence       []int             `bson:"procedureSequence,omitempty" json:"procedureSequence,omitempty"`
-	InformationSequence     []int             `bson:"informationSequence,omitempty" json:"informationSequence,omitempty"`
-	Revenue                 *CodeableConcept  `bson:"revenue,omitempty" json:"revenue,omitempty"`
-	Category                *CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
-	ProductOrService        CodeableConcept   `bson:"productOrService" json:"productOrService"`
-	Modifier                []CodeableConcept `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	ProgramCode             []CodeableConcept `bson:"programCode,omitempty" json:"programCode,omitempty"`
-	ServicedDate            *string           `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
-	ServicedPeriod          *Period           `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
-	LocationCodeableConcept *CodeableConcept  `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
-	LocationAddress         *Address          `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
-	LocationReference       *Reference        `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
-	Quantity                *Quantity         `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice               *Money            `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor                  *json.Number      `bson:"factor,omitempty" json:"factor,omitempty"`
-	Net                     *Money            `bson:"net,omitempty" json:"net,omitempty"`
-	Udi                     []Reference       `bson:"udi,omitempty" json:"udi,omitempty"`
-	BodySite                *CodeableConcept  `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	SubSite                 []CodeableConcept `bson:"subSite,omitempty" json:"subSite,omitempty"`
-	Encounter               []Reference       `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Detail                  []ClaimItemDetail `bson:"detail,omitempty" json:"detail,omitempty"`
+	Id                         *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                   int               `bson:"sequence" json:"sequence"`
+	SequenceElement            *Element          `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	CareTeamSequence           []int             `bson:"careTeamSequence,omitempty" json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement    []*Element        `bson:"_careTeamSequence,omitempty" json:"_careTeamSequence,omitempty"`
+	DiagnosisSequence          []int             `bson:"diagnosisSequence,omitempty" json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement   []*Element        `bson:"_diagnosisSequence,omitempty" json:"_diagnosisSequence,omitempty"`
+	ProcedureSequence          []int             `bson:"procedureSequence,omitempty" json:"procedureSequence,omitempty"`
+	ProcedureSequenceElement   []*Element        `bson:"_procedureSequence,omitempty" json:"_procedureSequence,omitempty"`
+	InformationSequence        []int             `bson:"informationSequence,omitempty" json:"informationSequence,omitempty"`
+	InformationSequenceElement []*Element        `bson:"_informationSequence,omitempty" json:"_informationSequence,omitempty"`
+	Revenue                    *CodeableConcept  `bson:"revenue,omitempty" json:"revenue,omitempty"`
+	Category                   *CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
+	ProductOrService           CodeableConcept   `bson:"productOrService" json:"productOrService"`
+	Modifier                   []CodeableConcept `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ProgramCode                []CodeableConcept `bson:"programCode,omitempty" json:"programCode,omitempty"`
+	ServicedDate               *string           `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement        *Element          `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod             *Period           `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept    *CodeableConcept  `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
+	LocationAddress            *Address          `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference          *Reference        `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	Quantity                   *Quantity         `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                  *Money            `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                     *json.Number      `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement              *Element          `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Net                        *Money            `bson:"net,omitempty" json:"net,omitempty"`
+	Udi                        []Reference       `bson:"udi,omitempty" json:"udi,omitempty"`
+	BodySite                   *CodeableConcept  `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	SubSite                    []CodeableConcept `bson:"subSite,omitempty" json:"subSite,omitempty"`
+	Encounter                  []Reference       `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Detail                     []ClaimItemDetail `bson:"detail,omitempty" json:"detail,omitempty"`
+}
+
+// ClaimItemServiced is implemented by the types allowed for Claim.item.serviced[x]
+type ClaimItemServiced interface {
+	isClaimItemServiced()
+}
+
+func (Date) isClaimItemServiced()   {}
+func (Period) isClaimItemServiced() {}
+
+// Serviced returns the value of Claim.item.serviced[x] together with its FHIR type code or nil if no value is set
+func (r ClaimItem) Serviced() (ClaimItemServiced, string) {
+	if r.ServicedDate != nil {
+		return Date(*r.ServicedDate), "date"
+	}
+	if r.ServicedPeriod != nil {
+		return *r.ServicedPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetServiced sets the value of Claim.item.serviced[x], given as value or pointer, and clears all other types
+func (r *ClaimItem) SetServiced(value ClaimItemServiced) {
+	r.ServicedDate = nil
+	r.ServicedPeriod = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.ServicedDate = &primitive
+	case *Date:
+		r.ServicedDate = (*string)(v)
+	case Period:
+		r.ServicedPeriod = &v
+	case *Period:
+		r.ServicedPeriod = v
+	}
+}
+
+// ClaimItemLocation is implemented by the types allowed for Claim.item.location[x]
+type ClaimItemLocation interface {
+	isClaimItemLocation()
+}
+
+func (CodeableConcept) isClaimItemLocation() {}
+func (Address) isClaimItemLocation()         {}
+func (Reference) isClaimItemLocation()       {}
+
+// Location returns the value of Claim.item.location[x] together with its FHIR type code or nil if no value is set
+func (r ClaimItem) Location() (ClaimItemLocation, string) {
+	if r.LocationCodeableConcept != nil {
+		return *r.LocationCodeableConcept, "CodeableConcept"
+	}
+	if r.LocationAddress != nil {
+		return *r.LocationAddress, "Address"
+	}
+	if r.LocationReference != nil {
+		return *r.LocationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetLocation sets the value of Claim.item.location[x], given as value or pointer, and clears all other types
+func (r *ClaimItem) SetLocation(value ClaimItemLocation) {
+	r.LocationCodeableConcept = nil
+	r.LocationAddress = nil
+	r.LocationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.LocationCodeableConcept = &v
+	case *CodeableConcept:
+		r.LocationCodeableConcept = v
+	case Address:
+		r.LocationAddress = &v
+	case *Address:
+		r.LocationAddress = v
+	case Reference:
+		r.LocationReference = &v
+	case *Reference:
+		r.LocationReference = v
+	}
 }
+
 type ClaimItemDetail struct {
 	Id                *string                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence          int                        `bson:"sequence" json:"sequence"`
+	SequenceElement   *Element                   `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Revenue           *CodeableConcept           `bson:"revenue,omitempty" json:"revenue,omitempty"`
 	Category          *CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
 	ProductOrService  CodeableConcept            `bson:"productOrService" json:"productOrService"`
@@ -182,6 +504,7 @@ type ClaimItemDetail struct {
 	Quantity          *Quantity                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                     `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *json.Number               `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                   `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                     `bson:"net,omitempty" json:"net,omitempty"`
 	Udi               []Reference                `bson:"udi,omitempty" json:"udi,omitempty"`
 	SubDetail         []ClaimItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
@@ -191,6 +514,7 @@ type ClaimItemDetailSubDetail struct {
 	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence          int               `bson:"sequence" json:"sequence"`
+	SequenceElement   *Element          `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Revenue           *CodeableConcept  `bson:"revenue,omitempty" json:"revenue,omitempty"`
 	Category          *CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
 	ProductOrService  CodeableConcept   `bson:"productOrService" json:"productOrService"`
@@ -199,26 +523,9494 @@ type ClaimItemDetailSubDetail struct {
 	Quantity          *Quantity         `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money            `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *json.Number      `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element          `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money            `bson:"net,omitempty" json:"net,omitempty"`
 	Udi               []Reference       `bson:"udi,omitempty" json:"udi,omitempty"`
 }
-type OtherClaim Claim
 
 // MarshalJSON marshals the given Claim as JSON into a byte slice
 func (r Claim) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherClaim
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherClaim:   OtherClaim(r),
-		ResourceType: "Claim",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Claim as JSON object with the members in the order of the fields
+func (r Claim) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Claim\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.SubType != nil {
+		e.key("subType")
+		r.SubType.appendJSON(e)
+	}
+	e.key("use")
+	e.string(r.Use.Code())
+	if r.UseElement != nil {
+		e.key("_use")
+		r.UseElement.appendJSON(e)
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.BillablePeriod != nil {
+		e.key("billablePeriod")
+		r.BillablePeriod.appendJSON(e)
+	}
+	e.key("created")
+	e.string(r.Created)
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.Enterer != nil {
+		e.key("enterer")
+		r.Enterer.appendJSON(e)
+	}
+	if r.Insurer != nil {
+		e.key("insurer")
+		r.Insurer.appendJSON(e)
+	}
+	e.key("provider")
+	r.Provider.appendJSON(e)
+	e.key("priority")
+	r.Priority.appendJSON(e)
+	if r.FundsReserve != nil {
+		e.key("fundsReserve")
+		r.FundsReserve.appendJSON(e)
+	}
+	if len(r.Related) > 0 {
+		e.key("related")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Related {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Prescription != nil {
+		e.key("prescription")
+		r.Prescription.appendJSON(e)
+	}
+	if r.OriginalPrescription != nil {
+		e.key("originalPrescription")
+		r.OriginalPrescription.appendJSON(e)
+	}
+	if r.Payee != nil {
+		e.key("payee")
+		r.Payee.appendJSON(e)
+	}
+	if r.Referral != nil {
+		e.key("referral")
+		r.Referral.appendJSON(e)
+	}
+	if r.Facility != nil {
+		e.key("facility")
+		r.Facility.appendJSON(e)
+	}
+	if len(r.CareTeam) > 0 {
+		e.key("careTeam")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CareTeam {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInfo) > 0 {
+		e.key("supportingInfo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInfo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Diagnosis) > 0 {
+		e.key("diagnosis")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Diagnosis {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Procedure) > 0 {
+		e.key("procedure")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Procedure {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("insurance")
+	if r.Insurance == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Insurance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Accident != nil {
+		e.key("accident")
+		r.Accident.appendJSON(e)
+	}
+	if len(r.Item) > 0 {
+		e.key("item")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Item {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Total != nil {
+		e.key("total")
+		r.Total.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Claim from JSON. Unknown members are ignored.
+func (r *Claim) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Claim
+func (r *Claim) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			r.Type.decodeJSON(d)
+		case "subType":
+			if d.null() {
+				r.SubType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubType = &v
+			}
+		case "use":
+			d.unmarshal(&r.Use)
+		case "_use":
+			r.UseElement = d.element()
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "billablePeriod":
+			if d.null() {
+				r.BillablePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.BillablePeriod = &v
+			}
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = v
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "enterer":
+			if d.null() {
+				r.Enterer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Enterer = &v
+			}
+		case "insurer":
+			if d.null() {
+				r.Insurer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Insurer = &v
+			}
+		case "provider":
+			r.Provider.decodeJSON(d)
+		case "priority":
+			r.Priority.decodeJSON(d)
+		case "fundsReserve":
+			if d.null() {
+				r.FundsReserve = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FundsReserve = &v
+			}
+		case "related":
+			a := d.array()
+			r.Related = nil
+			for a.next() {
+				var v ClaimRelated
+				v.decodeJSON(d)
+				r.Related = append(r.Related, v)
+			}
+			if r.Related == nil && !a.isNull {
+				r.Related = []ClaimRelated{}
+			}
+		case "prescription":
+			if d.null() {
+				r.Prescription = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Prescription = &v
+			}
+		case "originalPrescription":
+			if d.null() {
+				r.OriginalPrescription = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.OriginalPrescription = &v
+			}
+		case "payee":
+			if d.null() {
+				r.Payee = nil
+			} else {
+				var v ClaimPayee
+				v.decodeJSON(d)
+				r.Payee = &v
+			}
+		case "referral":
+			if d.null() {
+				r.Referral = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Referral = &v
+			}
+		case "facility":
+			if d.null() {
+				r.Facility = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Facility = &v
+			}
+		case "careTeam":
+			a := d.array()
+			r.CareTeam = nil
+			for a.next() {
+				var v ClaimCareTeam
+				v.decodeJSON(d)
+				r.CareTeam = append(r.CareTeam, v)
+			}
+			if r.CareTeam == nil && !a.isNull {
+				r.CareTeam = []ClaimCareTeam{}
+			}
+		case "supportingInfo":
+			a := d.array()
+			r.SupportingInfo = nil
+			for a.next() {
+				var v ClaimSupportingInfo
+				v.decodeJSON(d)
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			}
+			if r.SupportingInfo == nil && !a.isNull {
+				r.SupportingInfo = []ClaimSupportingInfo{}
+			}
+		case "diagnosis":
+			a := d.array()
+			r.Diagnosis = nil
+			for a.next() {
+				var v ClaimDiagnosis
+				v.decodeJSON(d)
+				r.Diagnosis = append(r.Diagnosis, v)
+			}
+			if r.Diagnosis == nil && !a.isNull {
+				r.Diagnosis = []ClaimDiagnosis{}
+			}
+		case "procedure":
+			a := d.array()
+			r.Procedure = nil
+			for a.next() {
+				var v ClaimProcedure
+				v.decodeJSON(d)
+				r.Procedure = append(r.Procedure, v)
+			}
+			if r.Procedure == nil && !a.isNull {
+				r.Procedure = []ClaimProcedure{}
+			}
+		case "insurance":
+			a := d.array()
+			r.Insurance = nil
+			for a.next() {
+				var v ClaimInsurance
+				v.decodeJSON(d)
+				r.Insurance = append(r.Insurance, v)
+			}
+			if r.Insurance == nil && !a.isNull {
+				r.Insurance = []ClaimInsurance{}
+			}
+		case "accident":
+			if d.null() {
+				r.Accident = nil
+			} else {
+				var v ClaimAccident
+				v.decodeJSON(d)
+				r.Accident = &v
+			}
+		case "item":
+			a := d.array()
+			r.Item = nil
+			for a.next() {
+				var v ClaimItem
+				v.decodeJSON(d)
+				r.Item = append(r.Item, v)
+			}
+			if r.Item == nil && !a.isNull {
+				r.Item = []ClaimItem{}
+			}
+		case "total":
+			if d.null() {
+				r.Total = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Total = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Claim which shares no memory with the original
+func (r Claim) DeepCopy() Claim {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.SubType != nil {
+		v := r.SubType.DeepCopy()
+		out.SubType = &v
+	}
+	if r.UseElement != nil {
+		v := r.UseElement.DeepCopy()
+		out.UseElement = &v
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.BillablePeriod != nil {
+		v := r.BillablePeriod.DeepCopy()
+		out.BillablePeriod = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Enterer != nil {
+		v := r.Enterer.DeepCopy()
+		out.Enterer = &v
+	}
+	if r.Insurer != nil {
+		v := r.Insurer.DeepCopy()
+		out.Insurer = &v
+	}
+	out.Provider = r.Provider.DeepCopy()
+	out.Priority = r.Priority.DeepCopy()
+	if r.FundsReserve != nil {
+		v := r.FundsReserve.DeepCopy()
+		out.FundsReserve = &v
+	}
+	if r.Related != nil {
+		out.Related = make([]ClaimRelated, len(r.Related))
+		for i := range r.Related {
+			out.Related[i] = r.Related[i].DeepCopy()
+		}
+	}
+	if r.Prescription != nil {
+		v := r.Prescription.DeepCopy()
+		out.Prescription = &v
+	}
+	if r.OriginalPrescription != nil {
+		v := r.OriginalPrescription.DeepCopy()
+		out.OriginalPrescription = &v
+	}
+	if r.Payee != nil {
+		v := r.Payee.DeepCopy()
+		out.Payee = &v
+	}
+	if r.Referral != nil {
+		v := r.Referral.DeepCopy()
+		out.Referral = &v
+	}
+	if r.Facility != nil {
+		v := r.Facility.DeepCopy()
+		out.Facility = &v
+	}
+	if r.CareTeam != nil {
+		out.CareTeam = make([]ClaimCareTeam, len(r.CareTeam))
+		for i := range r.CareTeam {
+			out.CareTeam[i] = r.CareTeam[i].DeepCopy()
+		}
+	}
+	if r.SupportingInfo != nil {
+		out.SupportingInfo = make([]ClaimSupportingInfo, len(r.SupportingInfo))
+		for i := range r.SupportingInfo {
+			out.SupportingInfo[i] = r.SupportingInfo[i].DeepCopy()
+		}
+	}
+	if r.Diagnosis != nil {
+		out.Diagnosis = make([]ClaimDiagnosis, len(r.Diagnosis))
+		for i := range r.Diagnosis {
+			out.Diagnosis[i] = r.Diagnosis[i].DeepCopy()
+		}
+	}
+	if r.Procedure != nil {
+		out.Procedure = make([]ClaimProcedure, len(r.Procedure))
+		for i := range r.Procedure {
+			out.Procedure[i] = r.Procedure[i].DeepCopy()
+		}
+	}
+	if r.Insurance != nil {
+		out.Insurance = make([]ClaimInsurance, len(r.Insurance))
+		for i := range r.Insurance {
+			out.Insurance[i] = r.Insurance[i].DeepCopy()
+		}
+	}
+	if r.Accident != nil {
+		v := r.Accident.DeepCopy()
+		out.Accident = &v
+	}
+	if r.Item != nil {
+		out.Item = make([]ClaimItem, len(r.Item))
+		for i := range r.Item {
+			out.Item[i] = r.Item[i].DeepCopy()
+		}
+	}
+	if r.Total != nil {
+		v := r.Total.DeepCopy()
+		out.Total = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Claim) Equal(other Claim) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.Equal(*other.SubType) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.Equal(*other.UseElement) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.BillablePeriod == nil) != (other.BillablePeriod == nil) || r.BillablePeriod != nil && !r.BillablePeriod.Equal(*other.BillablePeriod) {
+		return false
+	}
+	if r.Created != other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.Equal(*other.Enterer) {
+		return false
+	}
+	if (r.Insurer == nil) != (other.Insurer == nil) || r.Insurer != nil && !r.Insurer.Equal(*other.Insurer) {
+		return false
+	}
+	if !r.Provider.Equal(other.Provider) {
+		return false
+	}
+	if !r.Priority.Equal(other.Priority) {
+		return false
+	}
+	if (r.FundsReserve == nil) != (other.FundsReserve == nil) || r.FundsReserve != nil && !r.FundsReserve.Equal(*other.FundsReserve) {
+		return false
+	}
+	if len(r.Related) != len(other.Related) {
+		return false
+	}
+	for i := range r.Related {
+		if !r.Related[i].Equal(other.Related[i]) {
+			return false
+		}
+	}
+	if (r.Prescription == nil) != (other.Prescription == nil) || r.Prescription != nil && !r.Prescription.Equal(*other.Prescription) {
+		return false
+	}
+	if (r.OriginalPrescription == nil) != (other.OriginalPrescription == nil) || r.OriginalPrescription != nil && !r.OriginalPrescription.Equal(*other.OriginalPrescription) {
+		return false
+	}
+	if (r.Payee == nil) != (other.Payee == nil) || r.Payee != nil && !r.Payee.Equal(*other.Payee) {
+		return false
+	}
+	if (r.Referral == nil) != (other.Referral == nil) || r.Referral != nil && !r.Referral.Equal(*other.Referral) {
+		return false
+	}
+	if (r.Facility == nil) != (other.Facility == nil) || r.Facility != nil && !r.Facility.Equal(*other.Facility) {
+		return false
+	}
+	if len(r.CareTeam) != len(other.CareTeam) {
+		return false
+	}
+	for i := range r.CareTeam {
+		if !r.CareTeam[i].Equal(other.CareTeam[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingInfo) != len(other.SupportingInfo) {
+		return false
+	}
+	for i := range r.SupportingInfo {
+		if !r.SupportingInfo[i].Equal(other.SupportingInfo[i]) {
+			return false
+		}
+	}
+	if len(r.Diagnosis) != len(other.Diagnosis) {
+		return false
+	}
+	for i := range r.Diagnosis {
+		if !r.Diagnosis[i].Equal(other.Diagnosis[i]) {
+			return false
+		}
+	}
+	if len(r.Procedure) != len(other.Procedure) {
+		return false
+	}
+	for i := range r.Procedure {
+		if !r.Procedure[i].Equal(other.Procedure[i]) {
+			return false
+		}
+	}
+	if len(r.Insurance) != len(other.Insurance) {
+		return false
+	}
+	for i := range r.Insurance {
+		if !r.Insurance[i].Equal(other.Insurance[i]) {
+			return false
+		}
+	}
+	if (r.Accident == nil) != (other.Accident == nil) || r.Accident != nil && !r.Accident.Equal(*other.Accident) {
+		return false
+	}
+	if len(r.Item) != len(other.Item) {
+		return false
+	}
+	for i := range r.Item {
+		if !r.Item[i].Equal(other.Item[i]) {
+			return false
+		}
+	}
+	if (r.Total == nil) != (other.Total == nil) || r.Total != nil && !r.Total.Equal(*other.Total) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Claim) EqualsDeep(other Claim) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.EqualsDeep(*other.SubType) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.EqualsDeep(*other.UseElement) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.BillablePeriod == nil) != (other.BillablePeriod == nil) || r.BillablePeriod != nil && !r.BillablePeriod.EqualsDeep(*other.BillablePeriod) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.EqualsDeep(*other.Enterer) {
+		return false
+	}
+	if (r.Insurer == nil) != (other.Insurer == nil) || r.Insurer != nil && !r.Insurer.EqualsDeep(*other.Insurer) {
+		return false
+	}
+	if !r.Provider.EqualsDeep(other.Provider) {
+		return false
+	}
+	if !r.Priority.EqualsDeep(other.Priority) {
+		return false
+	}
+	if (r.FundsReserve == nil) != (other.FundsReserve == nil) || r.FundsReserve != nil && !r.FundsReserve.EqualsDeep(*other.FundsReserve) {
+		return false
+	}
+	if !equivalentList(len(r.Related), len(other.Related), func(i, j int) bool {
+		return r.Related[i].EqualsDeep(other.Related[j])
+	}) {
+		return false
+	}
+	if (r.Prescription == nil) != (other.Prescription == nil) || r.Prescription != nil && !r.Prescription.EqualsDeep(*other.Prescription) {
+		return false
+	}
+	if (r.OriginalPrescription == nil) != (other.OriginalPrescription == nil) || r.OriginalPrescription != nil && !r.OriginalPrescription.EqualsDeep(*other.OriginalPrescription) {
+		return false
+	}
+	if (r.Payee == nil) != (other.Payee == nil) || r.Payee != nil && !r.Payee.EqualsDeep(*other.Payee) {
+		return false
+	}
+	if (r.Referral == nil) != (other.Referral == nil) || r.Referral != nil && !r.Referral.EqualsDeep(*other.Referral) {
+		return false
+	}
+	if (r.Facility == nil) != (other.Facility == nil) || r.Facility != nil && !r.Facility.EqualsDeep(*other.Facility) {
+		return false
+	}
+	if !equivalentList(len(r.CareTeam), len(other.CareTeam), func(i, j int) bool {
+		return r.CareTeam[i].EqualsDeep(other.CareTeam[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfo), len(other.SupportingInfo), func(i, j int) bool {
+		return r.SupportingInfo[i].EqualsDeep(other.SupportingInfo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Diagnosis), len(other.Diagnosis), func(i, j int) bool {
+		return r.Diagnosis[i].EqualsDeep(other.Diagnosis[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Procedure), len(other.Procedure), func(i, j int) bool {
+		return r.Procedure[i].EqualsDeep(other.Procedure[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Insurance), len(other.Insurance), func(i, j int) bool {
+		return r.Insurance[i].EqualsDeep(other.Insurance[j])
+	}) {
+		return false
+	}
+	if (r.Accident == nil) != (other.Accident == nil) || r.Accident != nil && !r.Accident.EqualsDeep(*other.Accident) {
+		return false
+	}
+	if !equivalentList(len(r.Item), len(other.Item), func(i, j int) bool {
+		return r.Item[i].EqualsDeep(other.Item[j])
+	}) {
+		return false
+	}
+	if (r.Total == nil) != (other.Total == nil) || r.Total != nil && !r.Total.EqualsDeep(*other.Total) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Claim) EqualsShallow(other Claim) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Claim as FHIR XML
+func (r Claim) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Claim",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subType", r.SubType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "use", r.Use, r.UseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "billablePeriod", r.BillablePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "enterer", r.Enterer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "insurer", r.Insurer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "provider", r.Provider); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "priority", r.Priority); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fundsReserve", r.FundsReserve); err != nil {
+		return err
+	}
+	for _, v := range r.Related {
+		if err := encodeXMLElement(e, "related", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "prescription", r.Prescription); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "originalPrescription", r.OriginalPrescription); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "payee", r.Payee); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "referral", r.Referral); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "facility", r.Facility); err != nil {
+		return err
+	}
+	for _, v := range r.CareTeam {
+		if err := encodeXMLElement(e, "careTeam", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SupportingInfo {
+		if err := encodeXMLElement(e, "supportingInfo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Diagnosis {
+		if err := encodeXMLElement(e, "diagnosis", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Procedure {
+		if err := encodeXMLElement(e, "procedure", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Insurance {
+		if err := encodeXMLElement(e, "insurance", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "accident", r.Accident); err != nil {
+		return err
+	}
+	for _, v := range r.Item {
+		if err := encodeXMLElement(e, "item", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "total", r.Total); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Claim from FHIR XML
+func (r *Claim) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "subType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubType = &v
+			case "use":
+				var v Use
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Use = v
+				}
+				r.UseElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "billablePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BillablePeriod = &v
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = v
+				}
+				r.CreatedElement = element
+			case "enterer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Enterer = &v
+			case "insurer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurer = &v
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = v
+			case "priority":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Priority = v
+			case "fundsReserve":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FundsReserve = &v
+			case "related":
+				var v ClaimRelated
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Related = append(r.Related, v)
+			case "prescription":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Prescription = &v
+			case "originalPrescription":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OriginalPrescription = &v
+			case "payee":
+				var v ClaimPayee
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payee = &v
+			case "referral":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Referral = &v
+			case "facility":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Facility = &v
+			case "careTeam":
+				var v ClaimCareTeam
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CareTeam = append(r.CareTeam, v)
+			case "supportingInfo":
+				var v ClaimSupportingInfo
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			case "diagnosis":
+				var v ClaimDiagnosis
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Diagnosis = append(r.Diagnosis, v)
+			case "procedure":
+				var v ClaimProcedure
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Procedure = append(r.Procedure, v)
+			case "insurance":
+				var v ClaimInsurance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurance = append(r.Insurance, v)
+			case "accident":
+				var v ClaimAccident
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Accident = &v
+			case "item":
+				var v ClaimItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Item = append(r.Item, v)
+			case "total":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Total = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Claim as properties to the RDF node n
+func (r Claim) turtle(n *turtleNode) {
+	n.resource("Claim", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Claim.identifier", i, v)
+	}
+	n.primitive("Claim.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Claim.type", -1, r.Type)
+	n.element("Claim.subType", -1, r.SubType)
+	n.primitive("Claim.use", -1, r.Use, r.UseElement, "code")
+	n.element("Claim.patient", -1, r.Patient)
+	n.element("Claim.billablePeriod", -1, r.BillablePeriod)
+	n.primitive("Claim.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("Claim.enterer", -1, r.Enterer)
+	n.element("Claim.insurer", -1, r.Insurer)
+	n.element("Claim.provider", -1, r.Provider)
+	n.element("Claim.priority", -1, r.Priority)
+	n.element("Claim.fundsReserve", -1, r.FundsReserve)
+	for i, v := range r.Related {
+		n.element("Claim.related", i, v)
+	}
+	n.element("Claim.prescription", -1, r.Prescription)
+	n.element("Claim.originalPrescription", -1, r.OriginalPrescription)
+	n.element("Claim.payee", -1, r.Payee)
+	n.element("Claim.referral", -1, r.Referral)
+	n.element("Claim.facility", -1, r.Facility)
+	for i, v := range r.CareTeam {
+		n.element("Claim.careTeam", i, v)
+	}
+	for i, v := range r.SupportingInfo {
+		n.element("Claim.supportingInfo", i, v)
+	}
+	for i, v := range r.Diagnosis {
+		n.element("Claim.diagnosis", i, v)
+	}
+	for i, v := range r.Procedure {
+		n.element("Claim.procedure", i, v)
+	}
+	for i, v := range r.Insurance {
+		n.element("Claim.insurance", i, v)
+	}
+	n.element("Claim.accident", -1, r.Accident)
+	for i, v := range r.Item {
+		n.element("Claim.item", i, v)
+	}
+	n.element("Claim.total", -1, r.Total)
+}
+
+// MarshalBSON marshals the given Claim as BSON document with the structure of its FHIR JSON
+func (r Claim) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Claim from a BSON document with the structure of its FHIR JSON
+func (r *Claim) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Claim as protobuf message Claim of fhir.proto
+func (r Claim) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Claim to the encoded message
+func (r Claim) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	e.message(11, r.Type)
+	if r.SubType != nil {
+		e.message(12, *r.SubType)
+	}
+	e.varint(13, r.Use.protoNumber())
+	e.message(14, r.Patient)
+	if r.BillablePeriod != nil {
+		e.message(15, *r.BillablePeriod)
+	}
+	if r.Created != "" {
+		e.string(16, r.Created)
+	}
+	if r.Enterer != nil {
+		e.message(17, *r.Enterer)
+	}
+	if r.Insurer != nil {
+		e.message(18, *r.Insurer)
+	}
+	e.message(19, r.Provider)
+	e.message(20, r.Priority)
+	if r.FundsReserve != nil {
+		e.message(21, *r.FundsReserve)
+	}
+	for _, v := range r.Related {
+		e.message(22, v)
+	}
+	if r.Prescription != nil {
+		e.message(23, *r.Prescription)
+	}
+	if r.OriginalPrescription != nil {
+		e.message(24, *r.OriginalPrescription)
+	}
+	if r.Payee != nil {
+		e.message(25, *r.Payee)
+	}
+	if r.Referral != nil {
+		e.message(26, *r.Referral)
+	}
+	if r.Facility != nil {
+		e.message(27, *r.Facility)
+	}
+	for _, v := range r.CareTeam {
+		e.message(28, v)
+	}
+	for _, v := range r.SupportingInfo {
+		e.message(29, v)
+	}
+	for _, v := range r.Diagnosis {
+		e.message(30, v)
+	}
+	for _, v := range r.Procedure {
+		e.message(31, v)
+	}
+	for _, v := range r.Insurance {
+		e.message(32, v)
+	}
+	if r.Accident != nil {
+		e.message(33, *r.Accident)
+	}
+	for _, v := range r.Item {
+		e.message(34, v)
+	}
+	if r.Total != nil {
+		e.message(35, *r.Total)
+	}
+	if r.IdElement != nil {
+		e.message(36, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(37, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(38, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(39, *r.StatusElement)
+	}
+	if r.UseElement != nil {
+		e.message(40, *r.UseElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(41, *r.CreatedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Claim from protobuf message Claim of fhir.proto
+func (r *Claim) UnmarshalProto(b []byte) error {
+	*r = Claim{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubType = &v
+		case 13:
+			var v Use
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Use = v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.BillablePeriod = &v
+		case 16:
+			r.Created = d.string()
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Enterer = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Insurer = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Provider = v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Priority = v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.FundsReserve = &v
+		case 22:
+			var v ClaimRelated
+			d.message(&v)
+			r.Related = append(r.Related, v)
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Prescription = &v
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.OriginalPrescription = &v
+		case 25:
+			var v ClaimPayee
+			d.message(&v)
+			r.Payee = &v
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Referral = &v
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.Facility = &v
+		case 28:
+			var v ClaimCareTeam
+			d.message(&v)
+			r.CareTeam = append(r.CareTeam, v)
+		case 29:
+			var v ClaimSupportingInfo
+			d.message(&v)
+			r.SupportingInfo = append(r.SupportingInfo, v)
+		case 30:
+			var v ClaimDiagnosis
+			d.message(&v)
+			r.Diagnosis = append(r.Diagnosis, v)
+		case 31:
+			var v ClaimProcedure
+			d.message(&v)
+			r.Procedure = append(r.Procedure, v)
+		case 32:
+			var v ClaimInsurance
+			d.message(&v)
+			r.Insurance = append(r.Insurance, v)
+		case 33:
+			var v ClaimAccident
+			d.message(&v)
+			r.Accident = &v
+		case 34:
+			var v ClaimItem
+			d.message(&v)
+			r.Item = append(r.Item, v)
+		case 35:
+			var v Money
+			d.message(&v)
+			r.Total = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.UseElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimRelated as JSON into a byte slice
+func (r ClaimRelated) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimRelated as JSON object with the members in the order of the fields
+func (r ClaimRelated) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Claim != nil {
+		e.key("claim")
+		r.Claim.appendJSON(e)
+	}
+	if r.Relationship != nil {
+		e.key("relationship")
+		r.Relationship.appendJSON(e)
+	}
+	if r.Reference != nil {
+		e.key("reference")
+		r.Reference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimRelated from JSON. Unknown members are ignored.
+func (r *ClaimRelated) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimRelated
+func (r *ClaimRelated) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "claim":
+			if d.null() {
+				r.Claim = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Claim = &v
+			}
+		case "relationship":
+			if d.null() {
+				r.Relationship = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Relationship = &v
+			}
+		case "reference":
+			if d.null() {
+				r.Reference = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Reference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimRelated which shares no memory with the original
+func (r ClaimRelated) DeepCopy() ClaimRelated {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Claim != nil {
+		v := r.Claim.DeepCopy()
+		out.Claim = &v
+	}
+	if r.Relationship != nil {
+		v := r.Relationship.DeepCopy()
+		out.Relationship = &v
+	}
+	if r.Reference != nil {
+		v := r.Reference.DeepCopy()
+		out.Reference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimRelated) Equal(other ClaimRelated) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Claim == nil) != (other.Claim == nil) || r.Claim != nil && !r.Claim.Equal(*other.Claim) {
+		return false
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.Equal(*other.Relationship) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !r.Reference.Equal(*other.Reference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimRelated) EqualsDeep(other ClaimRelated) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Claim == nil) != (other.Claim == nil) || r.Claim != nil && !r.Claim.EqualsDeep(*other.Claim) {
+		return false
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.EqualsDeep(*other.Relationship) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !r.Reference.EqualsDeep(*other.Reference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimRelated) EqualsShallow(other ClaimRelated) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimRelated as FHIR XML
+func (r ClaimRelated) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "claim", r.Claim); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "relationship", r.Relationship); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reference", r.Reference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimRelated from FHIR XML
+func (r *ClaimRelated) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "claim":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Claim = &v
+			case "relationship":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Relationship = &v
+			case "reference":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimRelated as properties to the RDF node n
+func (r ClaimRelated) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Claim.related.claim", -1, r.Claim)
+	n.element("Claim.related.relationship", -1, r.Relationship)
+	n.element("Claim.related.reference", -1, r.Reference)
+}
+
+// MarshalBSON marshals the given ClaimRelated as BSON document with the structure of its FHIR JSON
+func (r ClaimRelated) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimRelated from a BSON document with the structure of its FHIR JSON
+func (r *ClaimRelated) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimRelated as protobuf message ClaimRelated of fhir.proto
+func (r ClaimRelated) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimRelated to the encoded message
+func (r ClaimRelated) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Claim != nil {
+		e.message(4, *r.Claim)
+	}
+	if r.Relationship != nil {
+		e.message(5, *r.Relationship)
+	}
+	if r.Reference != nil {
+		e.message(6, *r.Reference)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimRelated from protobuf message ClaimRelated of fhir.proto
+func (r *ClaimRelated) UnmarshalProto(b []byte) error {
+	*r = ClaimRelated{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Claim = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Relationship = &v
+		case 6:
+			var v Identifier
+			d.message(&v)
+			r.Reference = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimPayee as JSON into a byte slice
+func (r ClaimPayee) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimPayee as JSON object with the members in the order of the fields
+func (r ClaimPayee) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.Party != nil {
+		e.key("party")
+		r.Party.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimPayee from JSON. Unknown members are ignored.
+func (r *ClaimPayee) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimPayee
+func (r *ClaimPayee) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "party":
+			if d.null() {
+				r.Party = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Party = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimPayee which shares no memory with the original
+func (r ClaimPayee) DeepCopy() ClaimPayee {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Party != nil {
+		v := r.Party.DeepCopy()
+		out.Party = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimPayee) Equal(other ClaimPayee) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.Party == nil) != (other.Party == nil) || r.Party != nil && !r.Party.Equal(*other.Party) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimPayee) EqualsDeep(other ClaimPayee) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.Party == nil) != (other.Party == nil) || r.Party != nil && !r.Party.EqualsDeep(*other.Party) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimPayee) EqualsShallow(other ClaimPayee) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimPayee as FHIR XML
+func (r ClaimPayee) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "party", r.Party); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimPayee from FHIR XML
+func (r *ClaimPayee) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "party":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Party = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimPayee as properties to the RDF node n
+func (r ClaimPayee) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Claim.payee.type", -1, r.Type)
+	n.element("Claim.payee.party", -1, r.Party)
+}
+
+// MarshalBSON marshals the given ClaimPayee as BSON document with the structure of its FHIR JSON
+func (r ClaimPayee) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimPayee from a BSON document with the structure of its FHIR JSON
+func (r *ClaimPayee) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimPayee as protobuf message ClaimPayee of fhir.proto
+func (r ClaimPayee) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimPayee to the encoded message
+func (r ClaimPayee) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.Party != nil {
+		e.message(5, *r.Party)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimPayee from protobuf message ClaimPayee of fhir.proto
+func (r *ClaimPayee) UnmarshalProto(b []byte) error {
+	*r = ClaimPayee{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Party = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimCareTeam as JSON into a byte slice
+func (r ClaimCareTeam) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimCareTeam as JSON object with the members in the order of the fields
+func (r ClaimCareTeam) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	e.key("provider")
+	r.Provider.appendJSON(e)
+	if r.Responsible != nil {
+		e.key("responsible")
+		e.bool(*r.Responsible)
+	}
+	if r.ResponsibleElement != nil {
+		e.key("_responsible")
+		r.ResponsibleElement.appendJSON(e)
+	}
+	if r.Role != nil {
+		e.key("role")
+		r.Role.appendJSON(e)
+	}
+	if r.Qualification != nil {
+		e.key("qualification")
+		r.Qualification.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimCareTeam from JSON. Unknown members are ignored.
+func (r *ClaimCareTeam) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimCareTeam
+func (r *ClaimCareTeam) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "provider":
+			r.Provider.decodeJSON(d)
+		case "responsible":
+			if v, ok := d.bool(); ok {
+				r.Responsible = &v
+			} else {
+				r.Responsible = nil
+			}
+		case "_responsible":
+			r.ResponsibleElement = d.element()
+		case "role":
+			if d.null() {
+				r.Role = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = &v
+			}
+		case "qualification":
+			if d.null() {
+				r.Qualification = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Qualification = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimCareTeam which shares no memory with the original
+func (r ClaimCareTeam) DeepCopy() ClaimCareTeam {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	out.Provider = r.Provider.DeepCopy()
+	if r.Responsible != nil {
+		v := *r.Responsible
+		out.Responsible = &v
+	}
+	if r.ResponsibleElement != nil {
+		v := r.ResponsibleElement.DeepCopy()
+		out.ResponsibleElement = &v
+	}
+	if r.Role != nil {
+		v := r.Role.DeepCopy()
+		out.Role = &v
+	}
+	if r.Qualification != nil {
+		v := r.Qualification.DeepCopy()
+		out.Qualification = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimCareTeam) Equal(other ClaimCareTeam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if !r.Provider.Equal(other.Provider) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && *r.Responsible != *other.Responsible {
+		return false
+	}
+	if (r.ResponsibleElement == nil) != (other.ResponsibleElement == nil) || r.ResponsibleElement != nil && !r.ResponsibleElement.Equal(*other.ResponsibleElement) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.Equal(*other.Role) {
+		return false
+	}
+	if (r.Qualification == nil) != (other.Qualification == nil) || r.Qualification != nil && !r.Qualification.Equal(*other.Qualification) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimCareTeam) EqualsDeep(other ClaimCareTeam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if !r.Provider.EqualsDeep(other.Provider) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && *r.Responsible != *other.Responsible {
+		return false
+	}
+	if (r.ResponsibleElement == nil) != (other.ResponsibleElement == nil) || r.ResponsibleElement != nil && !r.ResponsibleElement.EqualsDeep(*other.ResponsibleElement) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.EqualsDeep(*other.Role) {
+		return false
+	}
+	if (r.Qualification == nil) != (other.Qualification == nil) || r.Qualification != nil && !r.Qualification.EqualsDeep(*other.Qualification) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimCareTeam) EqualsShallow(other ClaimCareTeam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && *r.Responsible != *other.Responsible {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimCareTeam as FHIR XML
+func (r ClaimCareTeam) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "provider", r.Provider); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "responsible", r.Responsible, r.ResponsibleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "qualification", r.Qualification); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimCareTeam from FHIR XML
+func (r *ClaimCareTeam) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = v
+			case "responsible":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Responsible = &v
+				}
+				r.ResponsibleElement = element
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = &v
+			case "qualification":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Qualification = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimCareTeam as properties to the RDF node n
+func (r ClaimCareTeam) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Claim.careTeam.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("Claim.careTeam.provider", -1, r.Provider)
+	n.primitive("Claim.careTeam.responsible", -1, r.Responsible, r.ResponsibleElement, "boolean")
+	n.element("Claim.careTeam.role", -1, r.Role)
+	n.element("Claim.careTeam.qualification", -1, r.Qualification)
+}
+
+// MarshalBSON marshals the given ClaimCareTeam as BSON document with the structure of its FHIR JSON
+func (r ClaimCareTeam) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimCareTeam from a BSON document with the structure of its FHIR JSON
+func (r *ClaimCareTeam) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimCareTeam as protobuf message ClaimCareTeam of fhir.proto
+func (r ClaimCareTeam) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimCareTeam to the encoded message
+func (r ClaimCareTeam) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	e.message(5, r.Provider)
+	if r.Responsible != nil {
+		e.varint(6, protoBool(*r.Responsible))
+	}
+	if r.Role != nil {
+		e.message(7, *r.Role)
+	}
+	if r.Qualification != nil {
+		e.message(8, *r.Qualification)
+	}
+	if r.SequenceElement != nil {
+		e.message(9, *r.SequenceElement)
+	}
+	if r.ResponsibleElement != nil {
+		e.message(10, *r.ResponsibleElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimCareTeam from protobuf message ClaimCareTeam of fhir.proto
+func (r *ClaimCareTeam) UnmarshalProto(b []byte) error {
+	*r = ClaimCareTeam{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Provider = v
+		case 6:
+			v := d.bool()
+			r.Responsible = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Qualification = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ResponsibleElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimSupportingInfo as JSON into a byte slice
+func (r ClaimSupportingInfo) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimSupportingInfo as JSON object with the members in the order of the fields
+func (r ClaimSupportingInfo) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	e.key("category")
+	r.Category.appendJSON(e)
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.TimingDate != nil {
+		e.key("timingDate")
+		e.string(*r.TimingDate)
+	}
+	if r.TimingDateElement != nil {
+		e.key("_timingDate")
+		r.TimingDateElement.appendJSON(e)
+	}
+	if r.TimingPeriod != nil {
+		e.key("timingPeriod")
+		r.TimingPeriod.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueAttachment != nil {
+		e.key("valueAttachment")
+		r.ValueAttachment.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	if r.Reason != nil {
+		e.key("reason")
+		r.Reason.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimSupportingInfo from JSON. Unknown members are ignored.
+func (r *ClaimSupportingInfo) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimSupportingInfo
+func (r *ClaimSupportingInfo) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "category":
+			r.Category.decodeJSON(d)
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "timingDate":
+			if v, ok := d.string(); ok {
+				r.TimingDate = &v
+			} else {
+				r.TimingDate = nil
+			}
+		case "_timingDate":
+			r.TimingDateElement = d.element()
+		case "timingPeriod":
+			if d.null() {
+				r.TimingPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.TimingPeriod = &v
+			}
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueAttachment":
+			if d.null() {
+				r.ValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ValueAttachment = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		case "reason":
+			if d.null() {
+				r.Reason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Reason = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimSupportingInfo which shares no memory with the original
+func (r ClaimSupportingInfo) DeepCopy() ClaimSupportingInfo {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	out.Category = r.Category.DeepCopy()
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.TimingDate != nil {
+		v := *r.TimingDate
+		out.TimingDate = &v
+	}
+	if r.TimingDateElement != nil {
+		v := r.TimingDateElement.DeepCopy()
+		out.TimingDateElement = &v
+	}
+	if r.TimingPeriod != nil {
+		v := r.TimingPeriod.DeepCopy()
+		out.TimingPeriod = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueAttachment != nil {
+		v := r.ValueAttachment.DeepCopy()
+		out.ValueAttachment = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	if r.Reason != nil {
+		v := r.Reason.DeepCopy()
+		out.Reason = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimSupportingInfo) Equal(other ClaimSupportingInfo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if !r.Category.Equal(other.Category) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.TimingDate == nil) != (other.TimingDate == nil) || r.TimingDate != nil && *r.TimingDate != *other.TimingDate {
+		return false
+	}
+	if (r.TimingDateElement == nil) != (other.TimingDateElement == nil) || r.TimingDateElement != nil && !r.TimingDateElement.Equal(*other.TimingDateElement) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.Equal(*other.TimingPeriod) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.Equal(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.Equal(*other.Reason) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimSupportingInfo) EqualsDeep(other ClaimSupportingInfo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if !r.Category.EqualsDeep(other.Category) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.TimingDate == nil) != (other.TimingDate == nil) || r.TimingDate != nil && !equivalentString(*r.TimingDate, *other.TimingDate) {
+		return false
+	}
+	if (r.TimingDateElement == nil) != (other.TimingDateElement == nil) || r.TimingDateElement != nil && !r.TimingDateElement.EqualsDeep(*other.TimingDateElement) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.EqualsDeep(*other.TimingPeriod) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.EqualsDeep(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.EqualsDeep(*other.Reason) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimSupportingInfo) EqualsShallow(other ClaimSupportingInfo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.TimingDate == nil) != (other.TimingDate == nil) || r.TimingDate != nil && !equivalentString(*r.TimingDate, *other.TimingDate) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimSupportingInfo as FHIR XML
+func (r ClaimSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timingDate", r.TimingDate, r.TimingDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingPeriod", r.TimingPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAttachment", r.ValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reason", r.Reason); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimSupportingInfo from FHIR XML
+func (r *ClaimSupportingInfo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "timingDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimingDate = &v
+				}
+				r.TimingDateElement = element
+			case "timingPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingPeriod = &v
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAttachment = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			case "reason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimSupportingInfo as properties to the RDF node n
+func (r ClaimSupportingInfo) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Claim.supportingInfo.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("Claim.supportingInfo.category", -1, r.Category)
+	n.element("Claim.supportingInfo.code", -1, r.Code)
+	n.primitive("Claim.supportingInfo.timingDate", -1, r.TimingDate, r.TimingDateElement, "date")
+	n.element("Claim.supportingInfo.timingPeriod", -1, r.TimingPeriod)
+	n.primitive("Claim.supportingInfo.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("Claim.supportingInfo.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.element("Claim.supportingInfo.valueQuantity", -1, r.ValueQuantity)
+	n.element("Claim.supportingInfo.valueAttachment", -1, r.ValueAttachment)
+	n.element("Claim.supportingInfo.valueReference", -1, r.ValueReference)
+	n.element("Claim.supportingInfo.reason", -1, r.Reason)
+}
+
+// MarshalBSON marshals the given ClaimSupportingInfo as BSON document with the structure of its FHIR JSON
+func (r ClaimSupportingInfo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimSupportingInfo from a BSON document with the structure of its FHIR JSON
+func (r *ClaimSupportingInfo) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimSupportingInfo as protobuf message ClaimSupportingInfo of fhir.proto
+func (r ClaimSupportingInfo) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimSupportingInfo to the encoded message
+func (r ClaimSupportingInfo) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	e.message(5, r.Category)
+	if r.Code != nil {
+		e.message(6, *r.Code)
+	}
+	if r.TimingDate != nil {
+		e.string(7, *r.TimingDate)
+	}
+	if r.TimingPeriod != nil {
+		e.message(8, *r.TimingPeriod)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(9, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueString != nil {
+		e.string(10, *r.ValueString)
+	}
+	if r.ValueQuantity != nil {
+		e.message(11, *r.ValueQuantity)
+	}
+	if r.ValueAttachment != nil {
+		e.message(12, *r.ValueAttachment)
+	}
+	if r.ValueReference != nil {
+		e.message(13, *r.ValueReference)
+	}
+	if r.Reason != nil {
+		e.message(14, *r.Reason)
+	}
+	if r.SequenceElement != nil {
+		e.message(15, *r.SequenceElement)
+	}
+	if r.TimingDateElement != nil {
+		e.message(16, *r.TimingDateElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(17, *r.ValueBooleanElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(18, *r.ValueStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimSupportingInfo from protobuf message ClaimSupportingInfo of fhir.proto
+func (r *ClaimSupportingInfo) UnmarshalProto(b []byte) error {
+	*r = ClaimSupportingInfo{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 7:
+			v := d.string()
+			r.TimingDate = &v
+		case 8:
+			var v Period
+			d.message(&v)
+			r.TimingPeriod = &v
+		case 9:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 10:
+			v := d.string()
+			r.ValueString = &v
+		case 11:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 12:
+			var v Attachment
+			d.message(&v)
+			r.ValueAttachment = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Reason = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.TimingDateElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimDiagnosis as JSON into a byte slice
+func (r ClaimDiagnosis) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimDiagnosis as JSON object with the members in the order of the fields
+func (r ClaimDiagnosis) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.DiagnosisCodeableConcept != nil {
+		e.key("diagnosisCodeableConcept")
+		r.DiagnosisCodeableConcept.appendJSON(e)
+	}
+	if r.DiagnosisReference != nil {
+		e.key("diagnosisReference")
+		r.DiagnosisReference.appendJSON(e)
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.OnAdmission != nil {
+		e.key("onAdmission")
+		r.OnAdmission.appendJSON(e)
+	}
+	if r.PackageCode != nil {
+		e.key("packageCode")
+		r.PackageCode.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimDiagnosis from JSON. Unknown members are ignored.
+func (r *ClaimDiagnosis) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimDiagnosis
+func (r *ClaimDiagnosis) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "diagnosisCodeableConcept":
+			if d.null() {
+				r.DiagnosisCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DiagnosisCodeableConcept = &v
+			}
+		case "diagnosisReference":
+			if d.null() {
+				r.DiagnosisReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.DiagnosisReference = &v
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "onAdmission":
+			if d.null() {
+				r.OnAdmission = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OnAdmission = &v
+			}
+		case "packageCode":
+			if d.null() {
+				r.PackageCode = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PackageCode = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimDiagnosis which shares no memory with the original
+func (r ClaimDiagnosis) DeepCopy() ClaimDiagnosis {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.DiagnosisCodeableConcept != nil {
+		v := r.DiagnosisCodeableConcept.DeepCopy()
+		out.DiagnosisCodeableConcept = &v
+	}
+	if r.DiagnosisReference != nil {
+		v := r.DiagnosisReference.DeepCopy()
+		out.DiagnosisReference = &v
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.OnAdmission != nil {
+		v := r.OnAdmission.DeepCopy()
+		out.OnAdmission = &v
+	}
+	if r.PackageCode != nil {
+		v := r.PackageCode.DeepCopy()
+		out.PackageCode = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimDiagnosis) Equal(other ClaimDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.DiagnosisCodeableConcept == nil) != (other.DiagnosisCodeableConcept == nil) || r.DiagnosisCodeableConcept != nil && !r.DiagnosisCodeableConcept.Equal(*other.DiagnosisCodeableConcept) {
+		return false
+	}
+	if (r.DiagnosisReference == nil) != (other.DiagnosisReference == nil) || r.DiagnosisReference != nil && !r.DiagnosisReference.Equal(*other.DiagnosisReference) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if (r.OnAdmission == nil) != (other.OnAdmission == nil) || r.OnAdmission != nil && !r.OnAdmission.Equal(*other.OnAdmission) {
+		return false
+	}
+	if (r.PackageCode == nil) != (other.PackageCode == nil) || r.PackageCode != nil && !r.PackageCode.Equal(*other.PackageCode) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimDiagnosis) EqualsDeep(other ClaimDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.DiagnosisCodeableConcept == nil) != (other.DiagnosisCodeableConcept == nil) || r.DiagnosisCodeableConcept != nil && !r.DiagnosisCodeableConcept.EqualsDeep(*other.DiagnosisCodeableConcept) {
+		return false
+	}
+	if (r.DiagnosisReference == nil) != (other.DiagnosisReference == nil) || r.DiagnosisReference != nil && !r.DiagnosisReference.EqualsDeep(*other.DiagnosisReference) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if (r.OnAdmission == nil) != (other.OnAdmission == nil) || r.OnAdmission != nil && !r.OnAdmission.EqualsDeep(*other.OnAdmission) {
+		return false
+	}
+	if (r.PackageCode == nil) != (other.PackageCode == nil) || r.PackageCode != nil && !r.PackageCode.EqualsDeep(*other.PackageCode) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimDiagnosis) EqualsShallow(other ClaimDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimDiagnosis as FHIR XML
+func (r ClaimDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "diagnosisCodeableConcept", r.DiagnosisCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "diagnosisReference", r.DiagnosisReference); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "onAdmission", r.OnAdmission); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "packageCode", r.PackageCode); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimDiagnosis from FHIR XML
+func (r *ClaimDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "diagnosisCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DiagnosisCodeableConcept = &v
+			case "diagnosisReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DiagnosisReference = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "onAdmission":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnAdmission = &v
+			case "packageCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PackageCode = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimDiagnosis as properties to the RDF node n
+func (r ClaimDiagnosis) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Claim.diagnosis.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("Claim.diagnosis.diagnosisCodeableConcept", -1, r.DiagnosisCodeableConcept)
+	n.element("Claim.diagnosis.diagnosisReference", -1, r.DiagnosisReference)
+	for i, v := range r.Type {
+		n.element("Claim.diagnosis.type", i, v)
+	}
+	n.element("Claim.diagnosis.onAdmission", -1, r.OnAdmission)
+	n.element("Claim.diagnosis.packageCode", -1, r.PackageCode)
+}
+
+// MarshalBSON marshals the given ClaimDiagnosis as BSON document with the structure of its FHIR JSON
+func (r ClaimDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimDiagnosis from a BSON document with the structure of its FHIR JSON
+func (r *ClaimDiagnosis) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimDiagnosis as protobuf message ClaimDiagnosis of fhir.proto
+func (r ClaimDiagnosis) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimDiagnosis to the encoded message
+func (r ClaimDiagnosis) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if r.DiagnosisCodeableConcept != nil {
+		e.message(5, *r.DiagnosisCodeableConcept)
+	}
+	if r.DiagnosisReference != nil {
+		e.message(6, *r.DiagnosisReference)
+	}
+	for _, v := range r.Type {
+		e.message(7, v)
+	}
+	if r.OnAdmission != nil {
+		e.message(8, *r.OnAdmission)
+	}
+	if r.PackageCode != nil {
+		e.message(9, *r.PackageCode)
+	}
+	if r.SequenceElement != nil {
+		e.message(10, *r.SequenceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimDiagnosis from protobuf message ClaimDiagnosis of fhir.proto
+func (r *ClaimDiagnosis) UnmarshalProto(b []byte) error {
+	*r = ClaimDiagnosis{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.DiagnosisCodeableConcept = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.DiagnosisReference = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.OnAdmission = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.PackageCode = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimProcedure as JSON into a byte slice
+func (r ClaimProcedure) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimProcedure as JSON object with the members in the order of the fields
+func (r ClaimProcedure) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.ProcedureCodeableConcept != nil {
+		e.key("procedureCodeableConcept")
+		r.ProcedureCodeableConcept.appendJSON(e)
+	}
+	if r.ProcedureReference != nil {
+		e.key("procedureReference")
+		r.ProcedureReference.appendJSON(e)
+	}
+	if len(r.Udi) > 0 {
+		e.key("udi")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Udi {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimProcedure from JSON. Unknown members are ignored.
+func (r *ClaimProcedure) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimProcedure
+func (r *ClaimProcedure) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "procedureCodeableConcept":
+			if d.null() {
+				r.ProcedureCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProcedureCodeableConcept = &v
+			}
+		case "procedureReference":
+			if d.null() {
+				r.ProcedureReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ProcedureReference = &v
+			}
+		case "udi":
+			a := d.array()
+			r.Udi = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Udi = append(r.Udi, v)
+			}
+			if r.Udi == nil && !a.isNull {
+				r.Udi = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimProcedure which shares no memory with the original
+func (r ClaimProcedure) DeepCopy() ClaimProcedure {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.ProcedureCodeableConcept != nil {
+		v := r.ProcedureCodeableConcept.DeepCopy()
+		out.ProcedureCodeableConcept = &v
+	}
+	if r.ProcedureReference != nil {
+		v := r.ProcedureReference.DeepCopy()
+		out.ProcedureReference = &v
+	}
+	if r.Udi != nil {
+		out.Udi = make([]Reference, len(r.Udi))
+		for i := range r.Udi {
+			out.Udi[i] = r.Udi[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimProcedure) Equal(other ClaimProcedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.ProcedureCodeableConcept == nil) != (other.ProcedureCodeableConcept == nil) || r.ProcedureCodeableConcept != nil && !r.ProcedureCodeableConcept.Equal(*other.ProcedureCodeableConcept) {
+		return false
+	}
+	if (r.ProcedureReference == nil) != (other.ProcedureReference == nil) || r.ProcedureReference != nil && !r.ProcedureReference.Equal(*other.ProcedureReference) {
+		return false
+	}
+	if len(r.Udi) != len(other.Udi) {
+		return false
+	}
+	for i := range r.Udi {
+		if !r.Udi[i].Equal(other.Udi[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimProcedure) EqualsDeep(other ClaimProcedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.ProcedureCodeableConcept == nil) != (other.ProcedureCodeableConcept == nil) || r.ProcedureCodeableConcept != nil && !r.ProcedureCodeableConcept.EqualsDeep(*other.ProcedureCodeableConcept) {
+		return false
+	}
+	if (r.ProcedureReference == nil) != (other.ProcedureReference == nil) || r.ProcedureReference != nil && !r.ProcedureReference.EqualsDeep(*other.ProcedureReference) {
+		return false
+	}
+	if !equivalentList(len(r.Udi), len(other.Udi), func(i, j int) bool {
+		return r.Udi[i].EqualsDeep(other.Udi[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimProcedure) EqualsShallow(other ClaimProcedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimProcedure as FHIR XML
+func (r ClaimProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "procedureCodeableConcept", r.ProcedureCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "procedureReference", r.ProcedureReference); err != nil {
+		return err
+	}
+	for _, v := range r.Udi {
+		if err := encodeXMLElement(e, "udi", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimProcedure from FHIR XML
+func (r *ClaimProcedure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "procedureCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProcedureCodeableConcept = &v
+			case "procedureReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProcedureReference = &v
+			case "udi":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Udi = append(r.Udi, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimProcedure as properties to the RDF node n
+func (r ClaimProcedure) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Claim.procedure.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	for i, v := range r.Type {
+		n.element("Claim.procedure.type", i, v)
+	}
+	n.primitive("Claim.procedure.date", -1, r.Date, r.DateElement, "dateTime")
+	n.element("Claim.procedure.procedureCodeableConcept", -1, r.ProcedureCodeableConcept)
+	n.element("Claim.procedure.procedureReference", -1, r.ProcedureReference)
+	for i, v := range r.Udi {
+		n.element("Claim.procedure.udi", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimProcedure as BSON document with the structure of its FHIR JSON
+func (r ClaimProcedure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimProcedure from a BSON document with the structure of its FHIR JSON
+func (r *ClaimProcedure) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimProcedure as protobuf message ClaimProcedure of fhir.proto
+func (r ClaimProcedure) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimProcedure to the encoded message
+func (r ClaimProcedure) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	for _, v := range r.Type {
+		e.message(5, v)
+	}
+	if r.Date != nil {
+		e.string(6, *r.Date)
+	}
+	if r.ProcedureCodeableConcept != nil {
+		e.message(7, *r.ProcedureCodeableConcept)
+	}
+	if r.ProcedureReference != nil {
+		e.message(8, *r.ProcedureReference)
+	}
+	for _, v := range r.Udi {
+		e.message(9, v)
+	}
+	if r.SequenceElement != nil {
+		e.message(10, *r.SequenceElement)
+	}
+	if r.DateElement != nil {
+		e.message(11, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimProcedure from protobuf message ClaimProcedure of fhir.proto
+func (r *ClaimProcedure) UnmarshalProto(b []byte) error {
+	*r = ClaimProcedure{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 6:
+			v := d.string()
+			r.Date = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProcedureCodeableConcept = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.ProcedureReference = &v
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Udi = append(r.Udi, v)
+		case 10:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimInsurance as JSON into a byte slice
+func (r ClaimInsurance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimInsurance as JSON object with the members in the order of the fields
+func (r ClaimInsurance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	e.key("focal")
+	e.bool(r.Focal)
+	if r.FocalElement != nil {
+		e.key("_focal")
+		r.FocalElement.appendJSON(e)
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	e.key("coverage")
+	r.Coverage.appendJSON(e)
+	if r.BusinessArrangement != nil {
+		e.key("businessArrangement")
+		e.string(*r.BusinessArrangement)
+	}
+	if r.BusinessArrangementElement != nil {
+		e.key("_businessArrangement")
+		r.BusinessArrangementElement.appendJSON(e)
+	}
+	if len(r.PreAuthRef) > 0 {
+		e.key("preAuthRef")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PreAuthRef {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PreAuthRefElement) > 0 {
+		e.key("_preAuthRef")
+		e.elements(r.PreAuthRefElement, len(r.PreAuthRef))
+	}
+	if r.ClaimResponse != nil {
+		e.key("claimResponse")
+		r.ClaimResponse.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimInsurance from JSON. Unknown members are ignored.
+func (r *ClaimInsurance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimInsurance
+func (r *ClaimInsurance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "focal":
+			if v, ok := d.bool(); ok {
+				r.Focal = v
+			}
+		case "_focal":
+			r.FocalElement = d.element()
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "coverage":
+			r.Coverage.decodeJSON(d)
+		case "businessArrangement":
+			if v, ok := d.string(); ok {
+				r.BusinessArrangement = &v
+			} else {
+				r.BusinessArrangement = nil
+			}
+		case "_businessArrangement":
+			r.BusinessArrangementElement = d.element()
+		case "preAuthRef":
+			a := d.array()
+			r.PreAuthRef = nil
+			for a.next() {
+				v, _ := d.string()
+				r.PreAuthRef = append(r.PreAuthRef, v)
+			}
+			if r.PreAuthRef == nil && !a.isNull {
+				r.PreAuthRef = []string{}
+			}
+		case "_preAuthRef":
+			a := d.array()
+			r.PreAuthRefElement = nil
+			for a.next() {
+				r.PreAuthRefElement = append(r.PreAuthRefElement, d.element())
+			}
+		case "claimResponse":
+			if d.null() {
+				r.ClaimResponse = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ClaimResponse = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimInsurance which shares no memory with the original
+func (r ClaimInsurance) DeepCopy() ClaimInsurance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.FocalElement != nil {
+		v := r.FocalElement.DeepCopy()
+		out.FocalElement = &v
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	out.Coverage = r.Coverage.DeepCopy()
+	if r.BusinessArrangement != nil {
+		v := *r.BusinessArrangement
+		out.BusinessArrangement = &v
+	}
+	if r.BusinessArrangementElement != nil {
+		v := r.BusinessArrangementElement.DeepCopy()
+		out.BusinessArrangementElement = &v
+	}
+	if r.PreAuthRef != nil {
+		out.PreAuthRef = make([]string, len(r.PreAuthRef))
+		copy(out.PreAuthRef, r.PreAuthRef)
+	}
+	if r.PreAuthRefElement != nil {
+		out.PreAuthRefElement = make([]*Element, len(r.PreAuthRefElement))
+		for i, v := range r.PreAuthRefElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PreAuthRefElement[i] = &c
+			}
+		}
+	}
+	if r.ClaimResponse != nil {
+		v := r.ClaimResponse.DeepCopy()
+		out.ClaimResponse = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimInsurance) Equal(other ClaimInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if r.Focal != other.Focal {
+		return false
+	}
+	if (r.FocalElement == nil) != (other.FocalElement == nil) || r.FocalElement != nil && !r.FocalElement.Equal(*other.FocalElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if !r.Coverage.Equal(other.Coverage) {
+		return false
+	}
+	if (r.BusinessArrangement == nil) != (other.BusinessArrangement == nil) || r.BusinessArrangement != nil && *r.BusinessArrangement != *other.BusinessArrangement {
+		return false
+	}
+	if (r.BusinessArrangementElement == nil) != (other.BusinessArrangementElement == nil) || r.BusinessArrangementElement != nil && !r.BusinessArrangementElement.Equal(*other.BusinessArrangementElement) {
+		return false
+	}
+	if len(r.PreAuthRef) != len(other.PreAuthRef) {
+		return false
+	}
+	for i := range r.PreAuthRef {
+		if r.PreAuthRef[i] != other.PreAuthRef[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PreAuthRefElement, other.PreAuthRefElement, false) {
+		return false
+	}
+	if (r.ClaimResponse == nil) != (other.ClaimResponse == nil) || r.ClaimResponse != nil && !r.ClaimResponse.Equal(*other.ClaimResponse) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimInsurance) EqualsDeep(other ClaimInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if r.Focal != other.Focal {
+		return false
+	}
+	if (r.FocalElement == nil) != (other.FocalElement == nil) || r.FocalElement != nil && !r.FocalElement.EqualsDeep(*other.FocalElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if !r.Coverage.EqualsDeep(other.Coverage) {
+		return false
+	}
+	if (r.BusinessArrangement == nil) != (other.BusinessArrangement == nil) || r.BusinessArrangement != nil && !equivalentString(*r.BusinessArrangement, *other.BusinessArrangement) {
+		return false
+	}
+	if (r.BusinessArrangementElement == nil) != (other.BusinessArrangementElement == nil) || r.BusinessArrangementElement != nil && !r.BusinessArrangementElement.EqualsDeep(*other.BusinessArrangementElement) {
+		return false
+	}
+	if !equivalentList(len(r.PreAuthRef), len(other.PreAuthRef), func(i, j int) bool {
+		return equivalentString(r.PreAuthRef[i], other.PreAuthRef[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PreAuthRefElement, other.PreAuthRefElement, true) {
+		return false
+	}
+	if (r.ClaimResponse == nil) != (other.ClaimResponse == nil) || r.ClaimResponse != nil && !r.ClaimResponse.EqualsDeep(*other.ClaimResponse) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimInsurance) EqualsShallow(other ClaimInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if r.Focal != other.Focal {
+		return false
+	}
+	if (r.BusinessArrangement == nil) != (other.BusinessArrangement == nil) || r.BusinessArrangement != nil && !equivalentString(*r.BusinessArrangement, *other.BusinessArrangement) {
+		return false
+	}
+	if !equivalentList(len(r.PreAuthRef), len(other.PreAuthRef), func(i, j int) bool {
+		return equivalentString(r.PreAuthRef[i], other.PreAuthRef[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimInsurance as FHIR XML
+func (r ClaimInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "focal", r.Focal, r.FocalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "coverage", r.Coverage); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "businessArrangement", r.BusinessArrangement, r.BusinessArrangementElement); err != nil {
+		return err
+	}
+	for i, v := range r.PreAuthRef {
+		if err := encodeXMLPrimitive(e, "preAuthRef", v, elementAt(r.PreAuthRefElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "claimResponse", r.ClaimResponse); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimInsurance from FHIR XML
+func (r *ClaimInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "focal":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Focal = v
+				}
+				r.FocalElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "coverage":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coverage = v
+			case "businessArrangement":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BusinessArrangement = &v
+				}
+				r.BusinessArrangementElement = element
+			case "preAuthRef":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PreAuthRefElement = appendElement(r.PreAuthRefElement, len(r.PreAuthRef), element)
+					r.PreAuthRef = append(r.PreAuthRef, v)
+				}
+			case "claimResponse":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ClaimResponse = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimInsurance as properties to the RDF node n
+func (r ClaimInsurance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Claim.insurance.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.primitive("Claim.insurance.focal", -1, r.Focal, r.FocalElement, "boolean")
+	n.element("Claim.insurance.identifier", -1, r.Identifier)
+	n.element("Claim.insurance.coverage", -1, r.Coverage)
+	n.primitive("Claim.insurance.businessArrangement", -1, r.BusinessArrangement, r.BusinessArrangementElement, "string")
+	for i, v := range r.PreAuthRef {
+		n.primitive("Claim.insurance.preAuthRef", i, v, elementAt(r.PreAuthRefElement, i), "string")
+	}
+	n.element("Claim.insurance.claimResponse", -1, r.ClaimResponse)
+}
+
+// MarshalBSON marshals the given ClaimInsurance as BSON document with the structure of its FHIR JSON
+func (r ClaimInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimInsurance from a BSON document with the structure of its FHIR JSON
+func (r *ClaimInsurance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimInsurance as protobuf message ClaimInsurance of fhir.proto
+func (r ClaimInsurance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimInsurance to the encoded message
+func (r ClaimInsurance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if r.Focal {
+		e.varint(5, protoBool(r.Focal))
+	}
+	if r.Identifier != nil {
+		e.message(6, *r.Identifier)
+	}
+	e.message(7, r.Coverage)
+	if r.BusinessArrangement != nil {
+		e.string(8, *r.BusinessArrangement)
+	}
+	for _, v := range r.PreAuthRef {
+		e.string(9, v)
+	}
+	if r.ClaimResponse != nil {
+		e.message(10, *r.ClaimResponse)
+	}
+	if r.SequenceElement != nil {
+		e.message(11, *r.SequenceElement)
+	}
+	if r.FocalElement != nil {
+		e.message(12, *r.FocalElement)
+	}
+	if r.BusinessArrangementElement != nil {
+		e.message(13, *r.BusinessArrangementElement)
+	}
+	for _, v := range r.PreAuthRefElement {
+		e.element(14, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimInsurance from protobuf message ClaimInsurance of fhir.proto
+func (r *ClaimInsurance) UnmarshalProto(b []byte) error {
+	*r = ClaimInsurance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			r.Focal = d.bool()
+		case 6:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Coverage = v
+		case 8:
+			v := d.string()
+			r.BusinessArrangement = &v
+		case 9:
+			r.PreAuthRef = append(r.PreAuthRef, d.string())
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.ClaimResponse = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.FocalElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.BusinessArrangementElement = &v
+		case 14:
+			r.PreAuthRefElement = append(r.PreAuthRefElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimAccident as JSON into a byte slice
+func (r ClaimAccident) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimAccident as JSON object with the members in the order of the fields
+func (r ClaimAccident) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("date")
+	e.string(r.Date)
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.LocationAddress != nil {
+		e.key("locationAddress")
+		r.LocationAddress.appendJSON(e)
+	}
+	if r.LocationReference != nil {
+		e.key("locationReference")
+		r.LocationReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimAccident from JSON. Unknown members are ignored.
+func (r *ClaimAccident) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimAccident
+func (r *ClaimAccident) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = v
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "locationAddress":
+			if d.null() {
+				r.LocationAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.LocationAddress = &v
+			}
+		case "locationReference":
+			if d.null() {
+				r.LocationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.LocationReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimAccident which shares no memory with the original
+func (r ClaimAccident) DeepCopy() ClaimAccident {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.LocationAddress != nil {
+		v := r.LocationAddress.DeepCopy()
+		out.LocationAddress = &v
+	}
+	if r.LocationReference != nil {
+		v := r.LocationReference.DeepCopy()
+		out.LocationReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimAccident) Equal(other ClaimAccident) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Date != other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.Equal(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.Equal(*other.LocationReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimAccident) EqualsDeep(other ClaimAccident) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.EqualsDeep(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.EqualsDeep(*other.LocationReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimAccident) EqualsShallow(other ClaimAccident) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimAccident as FHIR XML
+func (r ClaimAccident) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationAddress", r.LocationAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationReference", r.LocationReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimAccident from FHIR XML
+func (r *ClaimAccident) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = v
+				}
+				r.DateElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "locationAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationAddress = &v
+			case "locationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimAccident as properties to the RDF node n
+func (r ClaimAccident) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Claim.accident.date", -1, r.Date, r.DateElement, "date")
+	n.element("Claim.accident.type", -1, r.Type)
+	n.element("Claim.accident.locationAddress", -1, r.LocationAddress)
+	n.element("Claim.accident.locationReference", -1, r.LocationReference)
+}
+
+// MarshalBSON marshals the given ClaimAccident as BSON document with the structure of its FHIR JSON
+func (r ClaimAccident) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimAccident from a BSON document with the structure of its FHIR JSON
+func (r *ClaimAccident) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimAccident as protobuf message ClaimAccident of fhir.proto
+func (r ClaimAccident) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimAccident to the encoded message
+func (r ClaimAccident) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Date != "" {
+		e.string(4, r.Date)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.LocationAddress != nil {
+		e.message(6, *r.LocationAddress)
+	}
+	if r.LocationReference != nil {
+		e.message(7, *r.LocationReference)
+	}
+	if r.DateElement != nil {
+		e.message(8, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimAccident from protobuf message ClaimAccident of fhir.proto
+func (r *ClaimAccident) UnmarshalProto(b []byte) error {
+	*r = ClaimAccident{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Date = d.string()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v Address
+			d.message(&v)
+			r.LocationAddress = &v
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.LocationReference = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimItem as JSON into a byte slice
+func (r ClaimItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimItem as JSON object with the members in the order of the fields
+func (r ClaimItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if len(r.CareTeamSequence) > 0 {
+		e.key("careTeamSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CareTeamSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CareTeamSequenceElement) > 0 {
+		e.key("_careTeamSequence")
+		e.elements(r.CareTeamSequenceElement, len(r.CareTeamSequence))
+	}
+	if len(r.DiagnosisSequence) > 0 {
+		e.key("diagnosisSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DiagnosisSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DiagnosisSequenceElement) > 0 {
+		e.key("_diagnosisSequence")
+		e.elements(r.DiagnosisSequenceElement, len(r.DiagnosisSequence))
+	}
+	if len(r.ProcedureSequence) > 0 {
+		e.key("procedureSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProcedureSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProcedureSequenceElement) > 0 {
+		e.key("_procedureSequence")
+		e.elements(r.ProcedureSequenceElement, len(r.ProcedureSequence))
+	}
+	if len(r.InformationSequence) > 0 {
+		e.key("informationSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InformationSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InformationSequenceElement) > 0 {
+		e.key("_informationSequence")
+		e.elements(r.InformationSequenceElement, len(r.InformationSequence))
+	}
+	if r.Revenue != nil {
+		e.key("revenue")
+		r.Revenue.appendJSON(e)
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProgramCode) > 0 {
+		e.key("programCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProgramCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ServicedDate != nil {
+		e.key("servicedDate")
+		e.string(*r.ServicedDate)
+	}
+	if r.ServicedDateElement != nil {
+		e.key("_servicedDate")
+		r.ServicedDateElement.appendJSON(e)
+	}
+	if r.ServicedPeriod != nil {
+		e.key("servicedPeriod")
+		r.ServicedPeriod.appendJSON(e)
+	}
+	if r.LocationCodeableConcept != nil {
+		e.key("locationCodeableConcept")
+		r.LocationCodeableConcept.appendJSON(e)
+	}
+	if r.LocationAddress != nil {
+		e.key("locationAddress")
+		r.LocationAddress.appendJSON(e)
+	}
+	if r.LocationReference != nil {
+		e.key("locationReference")
+		r.LocationReference.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.Udi) > 0 {
+		e.key("udi")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Udi {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BodySite != nil {
+		e.key("bodySite")
+		r.BodySite.appendJSON(e)
+	}
+	if len(r.SubSite) > 0 {
+		e.key("subSite")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubSite {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Encounter) > 0 {
+		e.key("encounter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Encounter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimItem from JSON. Unknown members are ignored.
+func (r *ClaimItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimItem
+func (r *ClaimItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "careTeamSequence":
+			a := d.array()
+			r.CareTeamSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.CareTeamSequence = append(r.CareTeamSequence, v)
+			}
+			if r.CareTeamSequence == nil && !a.isNull {
+				r.CareTeamSequence = []int{}
+			}
+		case "_careTeamSequence":
+			a := d.array()
+			r.CareTeamSequenceElement = nil
+			for a.next() {
+				r.CareTeamSequenceElement = append(r.CareTeamSequenceElement, d.element())
+			}
+		case "diagnosisSequence":
+			a := d.array()
+			r.DiagnosisSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.DiagnosisSequence = append(r.DiagnosisSequence, v)
+			}
+			if r.DiagnosisSequence == nil && !a.isNull {
+				r.DiagnosisSequence = []int{}
+			}
+		case "_diagnosisSequence":
+			a := d.array()
+			r.DiagnosisSequenceElement = nil
+			for a.next() {
+				r.DiagnosisSequenceElement = append(r.DiagnosisSequenceElement, d.element())
+			}
+		case "procedureSequence":
+			a := d.array()
+			r.ProcedureSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.ProcedureSequence = append(r.ProcedureSequence, v)
+			}
+			if r.ProcedureSequence == nil && !a.isNull {
+				r.ProcedureSequence = []int{}
+			}
+		case "_procedureSequence":
+			a := d.array()
+			r.ProcedureSequenceElement = nil
+			for a.next() {
+				r.ProcedureSequenceElement = append(r.ProcedureSequenceElement, d.element())
+			}
+		case "informationSequence":
+			a := d.array()
+			r.InformationSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.InformationSequence = append(r.InformationSequence, v)
+			}
+			if r.InformationSequence == nil && !a.isNull {
+				r.InformationSequence = []int{}
+			}
+		case "_informationSequence":
+			a := d.array()
+			r.InformationSequenceElement = nil
+			for a.next() {
+				r.InformationSequenceElement = append(r.InformationSequenceElement, d.element())
+			}
+		case "revenue":
+			if d.null() {
+				r.Revenue = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Revenue = &v
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "programCode":
+			a := d.array()
+			r.ProgramCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProgramCode = append(r.ProgramCode, v)
+			}
+			if r.ProgramCode == nil && !a.isNull {
+				r.ProgramCode = []CodeableConcept{}
+			}
+		case "servicedDate":
+			if v, ok := d.string(); ok {
+				r.ServicedDate = &v
+			} else {
+				r.ServicedDate = nil
+			}
+		case "_servicedDate":
+			r.ServicedDateElement = d.element()
+		case "servicedPeriod":
+			if d.null() {
+				r.ServicedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ServicedPeriod = &v
+			}
+		case "locationCodeableConcept":
+			if d.null() {
+				r.LocationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LocationCodeableConcept = &v
+			}
+		case "locationAddress":
+			if d.null() {
+				r.LocationAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.LocationAddress = &v
+			}
+		case "locationReference":
+			if d.null() {
+				r.LocationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.LocationReference = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "udi":
+			a := d.array()
+			r.Udi = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Udi = append(r.Udi, v)
+			}
+			if r.Udi == nil && !a.isNull {
+				r.Udi = []Reference{}
+			}
+		case "bodySite":
+			if d.null() {
+				r.BodySite = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = &v
+			}
+		case "subSite":
+			a := d.array()
+			r.SubSite = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubSite = append(r.SubSite, v)
+			}
+			if r.SubSite == nil && !a.isNull {
+				r.SubSite = []CodeableConcept{}
+			}
+		case "encounter":
+			a := d.array()
+			r.Encounter = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = append(r.Encounter, v)
+			}
+			if r.Encounter == nil && !a.isNull {
+				r.Encounter = []Reference{}
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v ClaimItemDetail
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []ClaimItemDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimItem which shares no memory with the original
+func (r ClaimItem) DeepCopy() ClaimItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.CareTeamSequence != nil {
+		out.CareTeamSequence = make([]int, len(r.CareTeamSequence))
+		copy(out.CareTeamSequence, r.CareTeamSequence)
+	}
+	if r.CareTeamSequenceElement != nil {
+		out.CareTeamSequenceElement = make([]*Element, len(r.CareTeamSequenceElement))
+		for i, v := range r.CareTeamSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.CareTeamSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.DiagnosisSequence != nil {
+		out.DiagnosisSequence = make([]int, len(r.DiagnosisSequence))
+		copy(out.DiagnosisSequence, r.DiagnosisSequence)
+	}
+	if r.DiagnosisSequenceElement != nil {
+		out.DiagnosisSequenceElement = make([]*Element, len(r.DiagnosisSequenceElement))
+		for i, v := range r.DiagnosisSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DiagnosisSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.ProcedureSequence != nil {
+		out.ProcedureSequence = make([]int, len(r.ProcedureSequence))
+		copy(out.ProcedureSequence, r.ProcedureSequence)
+	}
+	if r.ProcedureSequenceElement != nil {
+		out.ProcedureSequenceElement = make([]*Element, len(r.ProcedureSequenceElement))
+		for i, v := range r.ProcedureSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ProcedureSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.InformationSequence != nil {
+		out.InformationSequence = make([]int, len(r.InformationSequence))
+		copy(out.InformationSequence, r.InformationSequence)
+	}
+	if r.InformationSequenceElement != nil {
+		out.InformationSequenceElement = make([]*Element, len(r.InformationSequenceElement))
+		for i, v := range r.InformationSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InformationSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.Revenue != nil {
+		v := r.Revenue.DeepCopy()
+		out.Revenue = &v
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.ProgramCode != nil {
+		out.ProgramCode = make([]CodeableConcept, len(r.ProgramCode))
+		for i := range r.ProgramCode {
+			out.ProgramCode[i] = r.ProgramCode[i].DeepCopy()
+		}
+	}
+	if r.ServicedDate != nil {
+		v := *r.ServicedDate
+		out.ServicedDate = &v
+	}
+	if r.ServicedDateElement != nil {
+		v := r.ServicedDateElement.DeepCopy()
+		out.ServicedDateElement = &v
+	}
+	if r.ServicedPeriod != nil {
+		v := r.ServicedPeriod.DeepCopy()
+		out.ServicedPeriod = &v
+	}
+	if r.LocationCodeableConcept != nil {
+		v := r.LocationCodeableConcept.DeepCopy()
+		out.LocationCodeableConcept = &v
+	}
+	if r.LocationAddress != nil {
+		v := r.LocationAddress.DeepCopy()
+		out.LocationAddress = &v
+	}
+	if r.LocationReference != nil {
+		v := r.LocationReference.DeepCopy()
+		out.LocationReference = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.Udi != nil {
+		out.Udi = make([]Reference, len(r.Udi))
+		for i := range r.Udi {
+			out.Udi[i] = r.Udi[i].DeepCopy()
+		}
+	}
+	if r.BodySite != nil {
+		v := r.BodySite.DeepCopy()
+		out.BodySite = &v
+	}
+	if r.SubSite != nil {
+		out.SubSite = make([]CodeableConcept, len(r.SubSite))
+		for i := range r.SubSite {
+			out.SubSite[i] = r.SubSite[i].DeepCopy()
+		}
+	}
+	if r.Encounter != nil {
+		out.Encounter = make([]Reference, len(r.Encounter))
+		for i := range r.Encounter {
+			out.Encounter[i] = r.Encounter[i].DeepCopy()
+		}
+	}
+	if r.Detail != nil {
+		out.Detail = make([]ClaimItemDetail, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimItem) Equal(other ClaimItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if len(r.CareTeamSequence) != len(other.CareTeamSequence) {
+		return false
+	}
+	for i := range r.CareTeamSequence {
+		if r.CareTeamSequence[i] != other.CareTeamSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.CareTeamSequenceElement, other.CareTeamSequenceElement, false) {
+		return false
+	}
+	if len(r.DiagnosisSequence) != len(other.DiagnosisSequence) {
+		return false
+	}
+	for i := range r.DiagnosisSequence {
+		if r.DiagnosisSequence[i] != other.DiagnosisSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DiagnosisSequenceElement, other.DiagnosisSequenceElement, false) {
+		return false
+	}
+	if len(r.ProcedureSequence) != len(other.ProcedureSequence) {
+		return false
+	}
+	for i := range r.ProcedureSequence {
+		if r.ProcedureSequence[i] != other.ProcedureSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ProcedureSequenceElement, other.ProcedureSequenceElement, false) {
+		return false
+	}
+	if len(r.InformationSequence) != len(other.InformationSequence) {
+		return false
+	}
+	for i := range r.InformationSequence {
+		if r.InformationSequence[i] != other.InformationSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InformationSequenceElement, other.InformationSequenceElement, false) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.Equal(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if len(r.ProgramCode) != len(other.ProgramCode) {
+		return false
+	}
+	for i := range r.ProgramCode {
+		if !r.ProgramCode[i].Equal(other.ProgramCode[i]) {
+			return false
+		}
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && *r.ServicedDate != *other.ServicedDate {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.Equal(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.Equal(*other.ServicedPeriod) {
+		return false
+	}
+	if (r.LocationCodeableConcept == nil) != (other.LocationCodeableConcept == nil) || r.LocationCodeableConcept != nil && !r.LocationCodeableConcept.Equal(*other.LocationCodeableConcept) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.Equal(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.Equal(*other.LocationReference) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.Udi) != len(other.Udi) {
+		return false
+	}
+	for i := range r.Udi {
+		if !r.Udi[i].Equal(other.Udi[i]) {
+			return false
+		}
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.Equal(*other.BodySite) {
+		return false
+	}
+	if len(r.SubSite) != len(other.SubSite) {
+		return false
+	}
+	for i := range r.SubSite {
+		if !r.SubSite[i].Equal(other.SubSite[i]) {
+			return false
+		}
+	}
+	if len(r.Encounter) != len(other.Encounter) {
+		return false
+	}
+	for i := range r.Encounter {
+		if !r.Encounter[i].Equal(other.Encounter[i]) {
+			return false
+		}
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimItem) EqualsDeep(other ClaimItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.CareTeamSequence), len(other.CareTeamSequence), func(i, j int) bool {
+		return r.CareTeamSequence[i] == other.CareTeamSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.CareTeamSequenceElement, other.CareTeamSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.DiagnosisSequence), len(other.DiagnosisSequence), func(i, j int) bool {
+		return r.DiagnosisSequence[i] == other.DiagnosisSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.DiagnosisSequenceElement, other.DiagnosisSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.ProcedureSequence), len(other.ProcedureSequence), func(i, j int) bool {
+		return r.ProcedureSequence[i] == other.ProcedureSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ProcedureSequenceElement, other.ProcedureSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InformationSequence), len(other.InformationSequence), func(i, j int) bool {
+		return r.InformationSequence[i] == other.InformationSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.InformationSequenceElement, other.InformationSequenceElement, true) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.EqualsDeep(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProgramCode), len(other.ProgramCode), func(i, j int) bool {
+		return r.ProgramCode[i].EqualsDeep(other.ProgramCode[j])
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.EqualsDeep(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.EqualsDeep(*other.ServicedPeriod) {
+		return false
+	}
+	if (r.LocationCodeableConcept == nil) != (other.LocationCodeableConcept == nil) || r.LocationCodeableConcept != nil && !r.LocationCodeableConcept.EqualsDeep(*other.LocationCodeableConcept) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.EqualsDeep(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.EqualsDeep(*other.LocationReference) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.Udi), len(other.Udi), func(i, j int) bool {
+		return r.Udi[i].EqualsDeep(other.Udi[j])
+	}) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.EqualsDeep(*other.BodySite) {
+		return false
+	}
+	if !equivalentList(len(r.SubSite), len(other.SubSite), func(i, j int) bool {
+		return r.SubSite[i].EqualsDeep(other.SubSite[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Encounter), len(other.Encounter), func(i, j int) bool {
+		return r.Encounter[i].EqualsDeep(other.Encounter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimItem) EqualsShallow(other ClaimItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if !equivalentList(len(r.CareTeamSequence), len(other.CareTeamSequence), func(i, j int) bool {
+		return r.CareTeamSequence[i] == other.CareTeamSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DiagnosisSequence), len(other.DiagnosisSequence), func(i, j int) bool {
+		return r.DiagnosisSequence[i] == other.DiagnosisSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProcedureSequence), len(other.ProcedureSequence), func(i, j int) bool {
+		return r.ProcedureSequence[i] == other.ProcedureSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InformationSequence), len(other.InformationSequence), func(i, j int) bool {
+		return r.InformationSequence[i] == other.InformationSequence[j]
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimItem as FHIR XML
+func (r ClaimItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	for i, v := range r.CareTeamSequence {
+		if err := encodeXMLPrimitive(e, "careTeamSequence", v, elementAt(r.CareTeamSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.DiagnosisSequence {
+		if err := encodeXMLPrimitive(e, "diagnosisSequence", v, elementAt(r.DiagnosisSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ProcedureSequence {
+		if err := encodeXMLPrimitive(e, "procedureSequence", v, elementAt(r.ProcedureSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InformationSequence {
+		if err := encodeXMLPrimitive(e, "informationSequence", v, elementAt(r.InformationSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "revenue", r.Revenue); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProgramCode {
+		if err := encodeXMLElement(e, "programCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "servicedDate", r.ServicedDate, r.ServicedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "servicedPeriod", r.ServicedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationCodeableConcept", r.LocationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationAddress", r.LocationAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationReference", r.LocationReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for _, v := range r.Udi {
+		if err := encodeXMLElement(e, "udi", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "bodySite", r.BodySite); err != nil {
+		return err
+	}
+	for _, v := range r.SubSite {
+		if err := encodeXMLElement(e, "subSite", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Encounter {
+		if err := encodeXMLElement(e, "encounter", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimItem from FHIR XML
+func (r *ClaimItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "careTeamSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.CareTeamSequenceElement = appendElement(r.CareTeamSequenceElement, len(r.CareTeamSequence), element)
+					r.CareTeamSequence = append(r.CareTeamSequence, v)
+				}
+			case "diagnosisSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DiagnosisSequenceElement = appendElement(r.DiagnosisSequenceElement, len(r.DiagnosisSequence), element)
+					r.DiagnosisSequence = append(r.DiagnosisSequence, v)
+				}
+			case "procedureSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ProcedureSequenceElement = appendElement(r.ProcedureSequenceElement, len(r.ProcedureSequence), element)
+					r.ProcedureSequence = append(r.ProcedureSequence, v)
+				}
+			case "informationSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InformationSequenceElement = appendElement(r.InformationSequenceElement, len(r.InformationSequence), element)
+					r.InformationSequence = append(r.InformationSequence, v)
+				}
+			case "revenue":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Revenue = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "programCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProgramCode = append(r.ProgramCode, v)
+			case "servicedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ServicedDate = &v
+				}
+				r.ServicedDateElement = element
+			case "servicedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServicedPeriod = &v
+			case "locationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationCodeableConcept = &v
+			case "locationAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationAddress = &v
+			case "locationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationReference = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "udi":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Udi = append(r.Udi, v)
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = &v
+			case "subSite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubSite = append(r.SubSite, v)
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = append(r.Encounter, v)
+			case "detail":
+				var v ClaimItemDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimItem as properties to the RDF node n
+func (r ClaimItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Claim.item.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	for i, v := range r.CareTeamSequence {
+		n.primitive("Claim.item.careTeamSequence", i, v, elementAt(r.CareTeamSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.DiagnosisSequence {
+		n.primitive("Claim.item.diagnosisSequence", i, v, elementAt(r.DiagnosisSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.ProcedureSequence {
+		n.primitive("Claim.item.procedureSequence", i, v, elementAt(r.ProcedureSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.InformationSequence {
+		n.primitive("Claim.item.informationSequence", i, v, elementAt(r.InformationSequenceElement, i), "positiveInt")
+	}
+	n.element("Claim.item.revenue", -1, r.Revenue)
+	n.element("Claim.item.category", -1, r.Category)
+	n.element("Claim.item.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("Claim.item.modifier", i, v)
+	}
+	for i, v := range r.ProgramCode {
+		n.element("Claim.item.programCode", i, v)
+	}
+	n.primitive("Claim.item.servicedDate", -1, r.ServicedDate, r.ServicedDateElement, "date")
+	n.element("Claim.item.servicedPeriod", -1, r.ServicedPeriod)
+	n.element("Claim.item.locationCodeableConcept", -1, r.LocationCodeableConcept)
+	n.element("Claim.item.locationAddress", -1, r.LocationAddress)
+	n.element("Claim.item.locationReference", -1, r.LocationReference)
+	n.element("Claim.item.quantity", -1, r.Quantity)
+	n.element("Claim.item.unitPrice", -1, r.UnitPrice)
+	n.primitive("Claim.item.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("Claim.item.net", -1, r.Net)
+	for i, v := range r.Udi {
+		n.element("Claim.item.udi", i, v)
+	}
+	n.element("Claim.item.bodySite", -1, r.BodySite)
+	for i, v := range r.SubSite {
+		n.element("Claim.item.subSite", i, v)
+	}
+	for i, v := range r.Encounter {
+		n.element("Claim.item.encounter", i, v)
+	}
+	for i, v := range r.Detail {
+		n.element("Claim.item.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimItem as BSON document with the structure of its FHIR JSON
+func (r ClaimItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimItem from a BSON document with the structure of its FHIR JSON
+func (r *ClaimItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimItem as protobuf message ClaimItem of fhir.proto
+func (r ClaimItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimItem to the encoded message
+func (r ClaimItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if len(r.CareTeamSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.CareTeamSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(5, p.buf)
+	}
+	if len(r.DiagnosisSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.DiagnosisSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(6, p.buf)
+	}
+	if len(r.ProcedureSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.ProcedureSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(7, p.buf)
+	}
+	if len(r.InformationSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.InformationSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(8, p.buf)
+	}
+	if r.Revenue != nil {
+		e.message(9, *r.Revenue)
+	}
+	if r.Category != nil {
+		e.message(10, *r.Category)
+	}
+	e.message(11, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(12, v)
+	}
+	for _, v := range r.ProgramCode {
+		e.message(13, v)
+	}
+	if r.ServicedDate != nil {
+		e.string(14, *r.ServicedDate)
+	}
+	if r.ServicedPeriod != nil {
+		e.message(15, *r.ServicedPeriod)
+	}
+	if r.LocationCodeableConcept != nil {
+		e.message(16, *r.LocationCodeableConcept)
+	}
+	if r.LocationAddress != nil {
+		e.message(17, *r.LocationAddress)
+	}
+	if r.LocationReference != nil {
+		e.message(18, *r.LocationReference)
+	}
+	if r.Quantity != nil {
+		e.message(19, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(20, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(21, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(22, *r.Net)
+	}
+	for _, v := range r.Udi {
+		e.message(23, v)
+	}
+	if r.BodySite != nil {
+		e.message(24, *r.BodySite)
+	}
+	for _, v := range r.SubSite {
+		e.message(25, v)
+	}
+	for _, v := range r.Encounter {
+		e.message(26, v)
+	}
+	for _, v := range r.Detail {
+		e.message(27, v)
+	}
+	if r.SequenceElement != nil {
+		e.message(28, *r.SequenceElement)
+	}
+	for _, v := range r.CareTeamSequenceElement {
+		e.element(29, v)
+	}
+	for _, v := range r.DiagnosisSequenceElement {
+		e.element(30, v)
+	}
+	for _, v := range r.ProcedureSequenceElement {
+		e.element(31, v)
+	}
+	for _, v := range r.InformationSequenceElement {
+		e.element(32, v)
+	}
+	if r.ServicedDateElement != nil {
+		e.message(33, *r.ServicedDateElement)
+	}
+	if r.FactorElement != nil {
+		e.message(34, *r.FactorElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimItem from protobuf message ClaimItem of fhir.proto
+func (r *ClaimItem) UnmarshalProto(b []byte) error {
+	*r = ClaimItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			for _, v := range d.varints() {
+				r.CareTeamSequence = append(r.CareTeamSequence, int(int32(v)))
+			}
+		case 6:
+			for _, v := range d.varints() {
+				r.DiagnosisSequence = append(r.DiagnosisSequence, int(int32(v)))
+			}
+		case 7:
+			for _, v := range d.varints() {
+				r.ProcedureSequence = append(r.ProcedureSequence, int(int32(v)))
+			}
+		case 8:
+			for _, v := range d.varints() {
+				r.InformationSequence = append(r.InformationSequence, int(int32(v)))
+			}
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Revenue = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProgramCode = append(r.ProgramCode, v)
+		case 14:
+			v := d.string()
+			r.ServicedDate = &v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.ServicedPeriod = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.LocationCodeableConcept = &v
+		case 17:
+			var v Address
+			d.message(&v)
+			r.LocationAddress = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.LocationReference = &v
+		case 19:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 20:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 21:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 22:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Udi = append(r.Udi, v)
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = &v
+		case 25:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubSite = append(r.SubSite, v)
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Encounter = append(r.Encounter, v)
+		case 27:
+			var v ClaimItemDetail
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		case 28:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 29:
+			r.CareTeamSequenceElement = append(r.CareTeamSequenceElement, d.element())
+		case 30:
+			r.DiagnosisSequenceElement = append(r.DiagnosisSequenceElement, d.element())
+		case 31:
+			r.ProcedureSequenceElement = append(r.ProcedureSequenceElement, d.element())
+		case 32:
+			r.InformationSequenceElement = append(r.InformationSequenceElement, d.element())
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ServicedDateElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimItemDetail as JSON into a byte slice
+func (r ClaimItemDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimItemDetail as JSON object with the members in the order of the fields
+func (r ClaimItemDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.Revenue != nil {
+		e.key("revenue")
+		r.Revenue.appendJSON(e)
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProgramCode) > 0 {
+		e.key("programCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProgramCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.Udi) > 0 {
+		e.key("udi")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Udi {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SubDetail) > 0 {
+		e.key("subDetail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubDetail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimItemDetail from JSON. Unknown members are ignored.
+func (r *ClaimItemDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimItemDetail
+func (r *ClaimItemDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "revenue":
+			if d.null() {
+				r.Revenue = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Revenue = &v
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "programCode":
+			a := d.array()
+			r.ProgramCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProgramCode = append(r.ProgramCode, v)
+			}
+			if r.ProgramCode == nil && !a.isNull {
+				r.ProgramCode = []CodeableConcept{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "udi":
+			a := d.array()
+			r.Udi = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Udi = append(r.Udi, v)
+			}
+			if r.Udi == nil && !a.isNull {
+				r.Udi = []Reference{}
+			}
+		case "subDetail":
+			a := d.array()
+			r.SubDetail = nil
+			for a.next() {
+				var v ClaimItemDetailSubDetail
+				v.decodeJSON(d)
+				r.SubDetail = append(r.SubDetail, v)
+			}
+			if r.SubDetail == nil && !a.isNull {
+				r.SubDetail = []ClaimItemDetailSubDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimItemDetail which shares no memory with the original
+func (r ClaimItemDetail) DeepCopy() ClaimItemDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.Revenue != nil {
+		v := r.Revenue.DeepCopy()
+		out.Revenue = &v
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.ProgramCode != nil {
+		out.ProgramCode = make([]CodeableConcept, len(r.ProgramCode))
+		for i := range r.ProgramCode {
+			out.ProgramCode[i] = r.ProgramCode[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.Udi != nil {
+		out.Udi = make([]Reference, len(r.Udi))
+		for i := range r.Udi {
+			out.Udi[i] = r.Udi[i].DeepCopy()
+		}
+	}
+	if r.SubDetail != nil {
+		out.SubDetail = make([]ClaimItemDetailSubDetail, len(r.SubDetail))
+		for i := range r.SubDetail {
+			out.SubDetail[i] = r.SubDetail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimItemDetail) Equal(other ClaimItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.Equal(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if len(r.ProgramCode) != len(other.ProgramCode) {
+		return false
+	}
+	for i := range r.ProgramCode {
+		if !r.ProgramCode[i].Equal(other.ProgramCode[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.Udi) != len(other.Udi) {
+		return false
+	}
+	for i := range r.Udi {
+		if !r.Udi[i].Equal(other.Udi[i]) {
+			return false
+		}
+	}
+	if len(r.SubDetail) != len(other.SubDetail) {
+		return false
+	}
+	for i := range r.SubDetail {
+		if !r.SubDetail[i].Equal(other.SubDetail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimItemDetail) EqualsDeep(other ClaimItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.EqualsDeep(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProgramCode), len(other.ProgramCode), func(i, j int) bool {
+		return r.ProgramCode[i].EqualsDeep(other.ProgramCode[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.Udi), len(other.Udi), func(i, j int) bool {
+		return r.Udi[i].EqualsDeep(other.Udi[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SubDetail), len(other.SubDetail), func(i, j int) bool {
+		return r.SubDetail[i].EqualsDeep(other.SubDetail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimItemDetail) EqualsShallow(other ClaimItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimItemDetail as FHIR XML
+func (r ClaimItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "revenue", r.Revenue); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProgramCode {
+		if err := encodeXMLElement(e, "programCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for _, v := range r.Udi {
+		if err := encodeXMLElement(e, "udi", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SubDetail {
+		if err := encodeXMLElement(e, "subDetail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimItemDetail from FHIR XML
+func (r *ClaimItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "revenue":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Revenue = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "programCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProgramCode = append(r.ProgramCode, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "udi":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Udi = append(r.Udi, v)
+			case "subDetail":
+				var v ClaimItemDetailSubDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubDetail = append(r.SubDetail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimItemDetail as properties to the RDF node n
+func (r ClaimItemDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Claim.item.detail.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("Claim.item.detail.revenue", -1, r.Revenue)
+	n.element("Claim.item.detail.category", -1, r.Category)
+	n.element("Claim.item.detail.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("Claim.item.detail.modifier", i, v)
+	}
+	for i, v := range r.ProgramCode {
+		n.element("Claim.item.detail.programCode", i, v)
+	}
+	n.element("Claim.item.detail.quantity", -1, r.Quantity)
+	n.element("Claim.item.detail.unitPrice", -1, r.UnitPrice)
+	n.primitive("Claim.item.detail.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("Claim.item.detail.net", -1, r.Net)
+	for i, v := range r.Udi {
+		n.element("Claim.item.detail.udi", i, v)
+	}
+	for i, v := range r.SubDetail {
+		n.element("Claim.item.detail.subDetail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimItemDetail as BSON document with the structure of its FHIR JSON
+func (r ClaimItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimItemDetail from a BSON document with the structure of its FHIR JSON
+func (r *ClaimItemDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimItemDetail as protobuf message ClaimItemDetail of fhir.proto
+func (r ClaimItemDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimItemDetail to the encoded message
+func (r ClaimItemDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if r.Revenue != nil {
+		e.message(5, *r.Revenue)
+	}
+	if r.Category != nil {
+		e.message(6, *r.Category)
+	}
+	e.message(7, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(8, v)
+	}
+	for _, v := range r.ProgramCode {
+		e.message(9, v)
+	}
+	if r.Quantity != nil {
+		e.message(10, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(11, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(12, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(13, *r.Net)
+	}
+	for _, v := range r.Udi {
+		e.message(14, v)
+	}
+	for _, v := range r.SubDetail {
+		e.message(15, v)
+	}
+	if r.SequenceElement != nil {
+		e.message(16, *r.SequenceElement)
+	}
+	if r.FactorElement != nil {
+		e.message(17, *r.FactorElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimItemDetail from protobuf message ClaimItemDetail of fhir.proto
+func (r *ClaimItemDetail) UnmarshalProto(b []byte) error {
+	*r = ClaimItemDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Revenue = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProgramCode = append(r.ProgramCode, v)
+		case 10:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 11:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 12:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 13:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Udi = append(r.Udi, v)
+		case 15:
+			var v ClaimItemDetailSubDetail
+			d.message(&v)
+			r.SubDetail = append(r.SubDetail, v)
+		case 16:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimItemDetailSubDetail as JSON into a byte slice
+func (r ClaimItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimItemDetailSubDetail as JSON object with the members in the order of the fields
+func (r ClaimItemDetailSubDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.Revenue != nil {
+		e.key("revenue")
+		r.Revenue.appendJSON(e)
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProgramCode) > 0 {
+		e.key("programCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProgramCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.Udi) > 0 {
+		e.key("udi")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Udi {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimItemDetailSubDetail from JSON. Unknown members are ignored.
+func (r *ClaimItemDetailSubDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimItemDetailSubDetail
+func (r *ClaimItemDetailSubDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "revenue":
+			if d.null() {
+				r.Revenue = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Revenue = &v
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "programCode":
+			a := d.array()
+			r.ProgramCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProgramCode = append(r.ProgramCode, v)
+			}
+			if r.ProgramCode == nil && !a.isNull {
+				r.ProgramCode = []CodeableConcept{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "udi":
+			a := d.array()
+			r.Udi = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Udi = append(r.Udi, v)
+			}
+			if r.Udi == nil && !a.isNull {
+				r.Udi = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimItemDetailSubDetail which shares no memory with the original
+func (r ClaimItemDetailSubDetail) DeepCopy() ClaimItemDetailSubDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.Revenue != nil {
+		v := r.Revenue.DeepCopy()
+		out.Revenue = &v
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.ProgramCode != nil {
+		out.ProgramCode = make([]CodeableConcept, len(r.ProgramCode))
+		for i := range r.ProgramCode {
+			out.ProgramCode[i] = r.ProgramCode[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.Udi != nil {
+		out.Udi = make([]Reference, len(r.Udi))
+		for i := range r.Udi {
+			out.Udi[i] = r.Udi[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimItemDetailSubDetail) Equal(other ClaimItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.Equal(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if len(r.ProgramCode) != len(other.ProgramCode) {
+		return false
+	}
+	for i := range r.ProgramCode {
+		if !r.ProgramCode[i].Equal(other.ProgramCode[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.Udi) != len(other.Udi) {
+		return false
+	}
+	for i := range r.Udi {
+		if !r.Udi[i].Equal(other.Udi[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimItemDetailSubDetail) EqualsDeep(other ClaimItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.EqualsDeep(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProgramCode), len(other.ProgramCode), func(i, j int) bool {
+		return r.ProgramCode[i].EqualsDeep(other.ProgramCode[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.Udi), len(other.Udi), func(i, j int) bool {
+		return r.Udi[i].EqualsDeep(other.Udi[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimItemDetailSubDetail) EqualsShallow(other ClaimItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimItemDetailSubDetail as FHIR XML
+func (r ClaimItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "revenue", r.Revenue); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProgramCode {
+		if err := encodeXMLElement(e, "programCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for _, v := range r.Udi {
+		if err := encodeXMLElement(e, "udi", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimItemDetailSubDetail from FHIR XML
+func (r *ClaimItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "revenue":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Revenue = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "programCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProgramCode = append(r.ProgramCode, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "udi":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Udi = append(r.Udi, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimItemDetailSubDetail as properties to the RDF node n
+func (r ClaimItemDetailSubDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Claim.item.detail.subDetail.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("Claim.item.detail.subDetail.revenue", -1, r.Revenue)
+	n.element("Claim.item.detail.subDetail.category", -1, r.Category)
+	n.element("Claim.item.detail.subDetail.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("Claim.item.detail.subDetail.modifier", i, v)
+	}
+	for i, v := range r.ProgramCode {
+		n.element("Claim.item.detail.subDetail.programCode", i, v)
+	}
+	n.element("Claim.item.detail.subDetail.quantity", -1, r.Quantity)
+	n.element("Claim.item.detail.subDetail.unitPrice", -1, r.UnitPrice)
+	n.primitive("Claim.item.detail.subDetail.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("Claim.item.detail.subDetail.net", -1, r.Net)
+	for i, v := range r.Udi {
+		n.element("Claim.item.detail.subDetail.udi", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimItemDetailSubDetail as BSON document with the structure of its FHIR JSON
+func (r ClaimItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimItemDetailSubDetail from a BSON document with the structure of its FHIR JSON
+func (r *ClaimItemDetailSubDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimItemDetailSubDetail as protobuf message ClaimItemDetailSubDetail of fhir.proto
+func (r ClaimItemDetailSubDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimItemDetailSubDetail to the encoded message
+func (r ClaimItemDetailSubDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if r.Revenue != nil {
+		e.message(5, *r.Revenue)
+	}
+	if r.Category != nil {
+		e.message(6, *r.Category)
+	}
+	e.message(7, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(8, v)
+	}
+	for _, v := range r.ProgramCode {
+		e.message(9, v)
+	}
+	if r.Quantity != nil {
+		e.message(10, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(11, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(12, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(13, *r.Net)
+	}
+	for _, v := range r.Udi {
+		e.message(14, v)
+	}
+	if r.SequenceElement != nil {
+		e.message(15, *r.SequenceElement)
+	}
+	if r.FactorElement != nil {
+		e.message(16, *r.FactorElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimItemDetailSubDetail from protobuf message ClaimItemDetailSubDetail of fhir.proto
+func (r *ClaimItemDetailSubDetail) UnmarshalProto(b []byte) error {
+	*r = ClaimItemDetailSubDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Revenue = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProgramCode = append(r.ProgramCode, v)
+		case 10:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 11:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 12:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 13:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Udi = append(r.Udi, v)
+		case 15:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalClaim unmarshals a Claim.
 func UnmarshalClaim(b []byte) (Claim, error) {
 	var claim Claim
-	if err := json.Unmarshal(b, &claim); err != nil {
+	if err := claim.UnmarshalJSON(b); err != nil {
 		return claim, err
 	}
 	return claim, nil
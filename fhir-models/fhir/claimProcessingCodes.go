@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code ClaimProcessingCodes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ClaimProcessingCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ClaimProcessingCodes) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "queued":
 		*code = ClaimProcessingCodesQueued
@@ -52,6 +56,12 @@ func (code *ClaimProcessingCodes) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ClaimProcessingCodes) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ClaimProcessingCodes) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ClaimProcessingCodes) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code ClaimProcessingCodes) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ClaimProcessingCodes) protoNumber() uint64 {
+	switch code {
+	case ClaimProcessingCodesQueued:
+		return 1
+	case ClaimProcessingCodesComplete:
+		return 2
+	case ClaimProcessingCodesError:
+		return 3
+	case ClaimProcessingCodesPartial:
+		return 4
+	}
+	return 0
+}
+func (code *ClaimProcessingCodes) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ClaimProcessingCodesQueued
+	case 2:
+		*code = ClaimProcessingCodesComplete
+	case 3:
+		*code = ClaimProcessingCodesError
+	case 4:
+		*code = ClaimProcessingCodesPartial
+	default:
+		return false
+	}
+	return true
+}
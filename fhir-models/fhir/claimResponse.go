@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,25 +25,35 @@ import "encoding/json"
 // ClaimResponse is documented here http://hl7.org/fhir/StructureDefinition/ClaimResponse
 type ClaimResponse struct {
 	Id                   *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                        `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                 *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage               `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status               FinancialResourceStatusCodes    `bson:"status" json:"status"`
+	StatusElement        *Element                        `bson:"_status,omitempty" json:"_status,omitempty"`
 	Type                 CodeableConcept                 `bson:"type" json:"type"`
 	SubType              *CodeableConcept                `bson:"subType,omitempty" json:"subType,omitempty"`
 	Use                  Use                             `bson:"use" json:"use"`
+	UseElement           *Element                        `bson:"_use,omitempty" json:"_use,omitempty"`
 	Patient              Reference                       `bson:"patient" json:"patient"`
 	Created              string                          `bson:"created" json:"created"`
+	CreatedElement       *Element                        `bson:"_created,omitempty" json:"_created,omitempty"`
 	Insurer              Reference                       `bson:"insurer" json:"insurer"`
 	Requestor            *Reference                      `bson:"requestor,omitempty" json:"requestor,omitempty"`
 	Request              *Reference                      `bson:"request,omitempty" json:"request,omitempty"`
 	Outcome              ClaimProcessingCodes            `bson:"outcome" json:"outcome"`
+	OutcomeElement       *Element                        `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
 	Disposition          *string                         `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	DispositionElement   *Element                        `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
 	PreAuthRef           *string                         `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement    *Element                        `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
 	PreAuthPeriod        *Period                         `bson:"preAuthPeriod,omitempty" json:"preAuthPeriod,omitempty"`
 	PayeeType            *CodeableConcept                `bson:"payeeType,omitempty" json:"payeeType,omitempty"`
 	Item                 []ClaimResponseItem             `bson:"item,omitempty" json:"item,omitempty"`
@@ -57,13 +70,15 @@ type ClaimResponse struct {
 	Error                []ClaimResponseError            `bson:"error,omitempty" json:"error,omitempty"`
 }
 type ClaimResponseItem struct {
-	Id                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemSequence      int                             `bson:"itemSequence" json:"itemSequence"`
-	NoteNumber        []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication      []ClaimResponseItemAdjudication `bson:"adjudication" json:"adjudication"`
-	Detail            []ClaimResponseItemDetail       `bson:"detail,omitempty" json:"detail,omitempty"`
+	Id                  *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemSequence        int                             `bson:"itemSequence" json:"itemSequence"`
+	ItemSequenceElement *Element                        `bson:"_itemSequence,omitempty" json:"_itemSequence,omitempty"`
+	NoteNumber          []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement   []*Element                      `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication        []ClaimResponseItemAdjudication `bson:"adjudication" json:"adjudication"`
+	Detail              []ClaimResponseItemDetail       `bson:"detail,omitempty" json:"detail,omitempty"`
 }
 type ClaimResponseItemAdjudication struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -73,50 +88,142 @@ type ClaimResponseItemAdjudication struct {
 	Reason            *CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
 	Amount            *Money           `bson:"amount,omitempty" json:"amount,omitempty"`
 	Value             *json.Number     `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement      *Element         `bson:"_value,omitempty" json:"_value,omitempty"`
 }
 type ClaimResponseItemDetail struct {
-	Id                *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DetailSequence    int                                `bson:"detailSequence" json:"detailSequence"`
-	NoteNumber        []int                              `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication      []ClaimResponseItemAdjudication    `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
-	SubDetail         []ClaimResponseItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
+	Id                    *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DetailSequence        int                                `bson:"detailSequence" json:"detailSequence"`
+	DetailSequenceElement *Element                           `bson:"_detailSequence,omitempty" json:"_detailSequence,omitempty"`
+	NoteNumber            []int                              `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement     []*Element                         `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication          []ClaimResponseItemAdjudication    `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	SubDetail             []ClaimResponseItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
 }
 type ClaimResponseItemDetailSubDetail struct {
-	Id                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SubDetailSequence int                             `bson:"subDetailSequence" json:"subDetailSequence"`
-	NoteNumber        []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication      []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	Id                       *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SubDetailSequence        int                             `bson:"subDetailSequence" json:"subDetailSequence"`
+	SubDetailSequenceElement *Element                        `bson:"_subDetailSequence,omitempty" json:"_subDetailSequence,omitempty"`
+	NoteNumber               []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement        []*Element                      `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication             []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 }
 type ClaimResponseAddItem struct {
-	Id                      *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemSequence            []int                           `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
-	DetailSequence          []int                           `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
-	SubdetailSequence       []int                           `bson:"subdetailSequence,omitempty" json:"subdetailSequence,omitempty"`
-	Provider                []Reference                     `bson:"provider,omitempty" json:"provider,omitempty"`
-	ProductOrService        CodeableConcept                 `bson:"productOrService" json:"productOrService"`
-	Modifier                []CodeableConcept               `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	ProgramCode             []CodeableConcept               `bson:"programCode,omitempty" json:"programCode,omitempty"`
-	ServicedDate            *string                         `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
-	ServicedPeriod          *Period                         `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
-	LocationCodeableConcept *CodeableConcept                `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
-	LocationAddress         *Address                        `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
-	LocationReference       *Reference                      `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
-	Quantity                *Quantity                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice               *Money                          `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor                  *json.Number                    `bson:"factor,omitempty" json:"factor,omitempty"`
-	Net                     *Money                          `bson:"net,omitempty" json:"net,omitempty"`
-	BodySite                *CodeableConcept                `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	SubSite                 []CodeableConcept               `bson:"subSite,omitempty" json:"subSite,omitempty"`
-	NoteNumber              []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication            []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
-	Detail                  []ClaimResponseAddItemDetail    `bson:"detail,omitempty" json:"detail,omitempty"`
+	Id                       *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemSequence             []int                           `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
+	ItemSequenceElement      []*Element                      `bson:"_itemSequence,omitempty" json:"_itemSequence,omitempty"`
+	DetailSequence           []int                           `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
+	DetailSequenceElement    []*Element                      `bson:"_detailSequence,omitempty" json:"_detailSequence,omitempty"`
+	SubdetailSequence        []int                           `bson:"subdetailSequence,omitempty" json:"subdetailSequence,omitempty"`
+	SubdetailSequenceElement []*Element                      `bson:"_subdetailSequence,omitempty" json:"_subdetailSequence,omitempty"`
+	Provider                 []Reference                     `bson:"provider,omitempty" json:"provider,omitempty"`
+	ProductOrService         CodeableConcept                 `bson:"productOrService" json:"productOrService"`
+	Modifier                 []CodeableConcept               `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ProgramCode              []CodeableConcept               `bson:"programCode,omitempty" json:"programCode,omitempty"`
+	ServicedDate             *string                         `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement      *Element                        `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod           *Period                         `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept  *CodeableConcept                `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
+	LocationAddress          *Address                        `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference        *Reference                      `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	Quantity                 *Quantity                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                *Money                          `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                   *json.Number                    `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement            *Element                        `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Net                      *Money                          `bson:"net,omitempty" json:"net,omitempty"`
+	BodySite                 *CodeableConcept                `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	SubSite                  []CodeableConcept               `bson:"subSite,omitempty" json:"subSite,omitempty"`
+	NoteNumber               []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement        []*Element                      `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication             []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	Detail                   []ClaimResponseAddItemDetail    `bson:"detail,omitempty" json:"detail,omitempty"`
+}
+
+// ClaimResponseAddItemServiced is implemented by the types allowed for ClaimResponse.addItem.serviced[x]
+type ClaimResponseAddItemServiced interface {
+	isClaimResponseAddItemServiced()
+}
+
+func (Date) isClaimResponseAddItemServiced()   {}
+func (Period) isClaimResponseAddItemServiced() {}
+
+// Serviced returns the value of ClaimResponse.addItem.serviced[x] together with its FHIR type code or nil if no value is set
+func (r ClaimResponseAddItem) Serviced() (ClaimResponseAddItemServiced, string) {
+	if r.ServicedDate != nil {
+		return Date(*r.ServicedDate), "date"
+	}
+	if r.ServicedPeriod != nil {
+		return *r.ServicedPeriod, "Period"
+	}
+	return nil, ""
 }
+
+// SetServiced sets the value of ClaimResponse.addItem.serviced[x], given as value or pointer, and clears all other types
+func (r *ClaimResponseAddItem) SetServiced(value ClaimResponseAddItemServiced) {
+	r.ServicedDate = nil
+	r.ServicedPeriod = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.ServicedDate = &primitive
+	case *Date:
+		r.ServicedDate = (*string)(v)
+	case Period:
+		r.ServicedPeriod = &v
+	case *Period:
+		r.ServicedPeriod = v
+	}
+}
+
+// ClaimResponseAddItemLocation is implemented by the types allowed for ClaimResponse.addItem.location[x]
+type ClaimResponseAddItemLocation interface {
+	isClaimResponseAddItemLocation()
+}
+
+func (CodeableConcept) isClaimResponseAddItemLocation() {}
+func (Address) isClaimResponseAddItemLocation()         {}
+func (Reference) isClaimResponseAddItemLocation()       {}
+
+// Location returns the value of ClaimResponse.addItem.location[x] together with its FHIR type code or nil if no value is set
+func (r ClaimResponseAddItem) Location() (ClaimResponseAddItemLocation, string) {
+	if r.LocationCodeableConcept != nil {
+		return *r.LocationCodeableConcept, "CodeableConcept"
+	}
+	if r.LocationAddress != nil {
+		return *r.LocationAddress, "Address"
+	}
+	if r.LocationReference != nil {
+		return *r.LocationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetLocation sets the value of ClaimResponse.addItem.location[x], given as value or pointer, and clears all other types
+func (r *ClaimResponseAddItem) SetLocation(value ClaimResponseAddItemLocation) {
+	r.LocationCodeableConcept = nil
+	r.LocationAddress = nil
+	r.LocationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.LocationCodeableConcept = &v
+	case *CodeableConcept:
+		r.LocationCodeableConcept = v
+	case Address:
+		r.LocationAddress = &v
+	case *Address:
+		r.LocationAddress = v
+	case Reference:
+		r.LocationReference = &v
+	case *Reference:
+		r.LocationReference = v
+	}
+}
+
 type ClaimResponseAddItemDetail struct {
 	Id                *string                               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -126,8 +233,10 @@ type ClaimResponseAddItemDetail struct {
 	Quantity          *Quantity                             `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *json.Number                          `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                              `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                `bson:"net,omitempty" json:"net,omitempty"`
 	NoteNumber        []int                                 `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                            `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ClaimResponseItemAdjudication       `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 	SubDetail         []ClaimResponseAddItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
 }
@@ -140,8 +249,10 @@ type ClaimResponseAddItemDetailSubDetail struct {
 	Quantity          *Quantity                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                          `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *json.Number                    `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                        `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                          `bson:"net,omitempty" json:"net,omitempty"`
 	NoteNumber        []int                           `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                      `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ClaimResponseItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 }
 type ClaimResponseTotal struct {
@@ -159,6 +270,7 @@ type ClaimResponsePayment struct {
 	Adjustment        *Money           `bson:"adjustment,omitempty" json:"adjustment,omitempty"`
 	AdjustmentReason  *CodeableConcept `bson:"adjustmentReason,omitempty" json:"adjustmentReason,omitempty"`
 	Date              *string          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element         `bson:"_date,omitempty" json:"_date,omitempty"`
 	Amount            Money            `bson:"amount" json:"amount"`
 	Identifier        *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
 }
@@ -167,46 +279,9444 @@ type ClaimResponseProcessNote struct {
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Number            *int             `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement     *Element         `bson:"_number,omitempty" json:"_number,omitempty"`
 	Type              *NoteType        `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element         `bson:"_type,omitempty" json:"_type,omitempty"`
 	Text              string           `bson:"text" json:"text"`
+	TextElement       *Element         `bson:"_text,omitempty" json:"_text,omitempty"`
 	Language          *CodeableConcept `bson:"language,omitempty" json:"language,omitempty"`
 }
 type ClaimResponseInsurance struct {
-	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence            int         `bson:"sequence" json:"sequence"`
-	Focal               bool        `bson:"focal" json:"focal"`
-	Coverage            Reference   `bson:"coverage" json:"coverage"`
-	BusinessArrangement *string     `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
-	ClaimResponse       *Reference  `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
+	Id                         *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                   int         `bson:"sequence" json:"sequence"`
+	SequenceElement            *Element    `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Focal                      bool        `bson:"focal" json:"focal"`
+	FocalElement               *Element    `bson:"_focal,omitempty" json:"_focal,omitempty"`
+	Coverage                   Reference   `bson:"coverage" json:"coverage"`
+	BusinessArrangement        *string     `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *Element    `bson:"_businessArrangement,omitempty" json:"_businessArrangement,omitempty"`
+	ClaimResponse              *Reference  `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
 }
 type ClaimResponseError struct {
-	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemSequence      *int            `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
-	DetailSequence    *int            `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
-	SubDetailSequence *int            `bson:"subDetailSequence,omitempty" json:"subDetailSequence,omitempty"`
-	Code              CodeableConcept `bson:"code" json:"code"`
+	Id                       *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemSequence             *int            `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
+	ItemSequenceElement      *Element        `bson:"_itemSequence,omitempty" json:"_itemSequence,omitempty"`
+	DetailSequence           *int            `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
+	DetailSequenceElement    *Element        `bson:"_detailSequence,omitempty" json:"_detailSequence,omitempty"`
+	SubDetailSequence        *int            `bson:"subDetailSequence,omitempty" json:"subDetailSequence,omitempty"`
+	SubDetailSequenceElement *Element        `bson:"_subDetailSequence,omitempty" json:"_subDetailSequence,omitempty"`
+	Code                     CodeableConcept `bson:"code" json:"code"`
 }
-type OtherClaimResponse ClaimResponse
 
 // MarshalJSON marshals the given ClaimResponse as JSON into a byte slice
 func (r ClaimResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherClaimResponse
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherClaimResponse: OtherClaimResponse(r),
-		ResourceType:       "ClaimResponse",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponse as JSON object with the members in the order of the fields
+func (r ClaimResponse) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ClaimResponse\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.SubType != nil {
+		e.key("subType")
+		r.SubType.appendJSON(e)
+	}
+	e.key("use")
+	e.string(r.Use.Code())
+	if r.UseElement != nil {
+		e.key("_use")
+		r.UseElement.appendJSON(e)
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	e.key("created")
+	e.string(r.Created)
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	e.key("insurer")
+	r.Insurer.appendJSON(e)
+	if r.Requestor != nil {
+		e.key("requestor")
+		r.Requestor.appendJSON(e)
+	}
+	if r.Request != nil {
+		e.key("request")
+		r.Request.appendJSON(e)
+	}
+	e.key("outcome")
+	e.string(r.Outcome.Code())
+	if r.OutcomeElement != nil {
+		e.key("_outcome")
+		r.OutcomeElement.appendJSON(e)
+	}
+	if r.Disposition != nil {
+		e.key("disposition")
+		e.string(*r.Disposition)
+	}
+	if r.DispositionElement != nil {
+		e.key("_disposition")
+		r.DispositionElement.appendJSON(e)
+	}
+	if r.PreAuthRef != nil {
+		e.key("preAuthRef")
+		e.string(*r.PreAuthRef)
+	}
+	if r.PreAuthRefElement != nil {
+		e.key("_preAuthRef")
+		r.PreAuthRefElement.appendJSON(e)
+	}
+	if r.PreAuthPeriod != nil {
+		e.key("preAuthPeriod")
+		r.PreAuthPeriod.appendJSON(e)
+	}
+	if r.PayeeType != nil {
+		e.key("payeeType")
+		r.PayeeType.appendJSON(e)
+	}
+	if len(r.Item) > 0 {
+		e.key("item")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Item {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AddItem) > 0 {
+		e.key("addItem")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AddItem {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Total) > 0 {
+		e.key("total")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Total {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Payment != nil {
+		e.key("payment")
+		r.Payment.appendJSON(e)
+	}
+	if r.FundsReserve != nil {
+		e.key("fundsReserve")
+		r.FundsReserve.appendJSON(e)
+	}
+	if r.FormCode != nil {
+		e.key("formCode")
+		r.FormCode.appendJSON(e)
+	}
+	if r.Form != nil {
+		e.key("form")
+		r.Form.appendJSON(e)
+	}
+	if len(r.ProcessNote) > 0 {
+		e.key("processNote")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProcessNote {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CommunicationRequest) > 0 {
+		e.key("communicationRequest")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CommunicationRequest {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Insurance) > 0 {
+		e.key("insurance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Insurance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Error) > 0 {
+		e.key("error")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Error {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponse from JSON. Unknown members are ignored.
+func (r *ClaimResponse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponse
+func (r *ClaimResponse) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			r.Type.decodeJSON(d)
+		case "subType":
+			if d.null() {
+				r.SubType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubType = &v
+			}
+		case "use":
+			d.unmarshal(&r.Use)
+		case "_use":
+			r.UseElement = d.element()
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = v
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "insurer":
+			r.Insurer.decodeJSON(d)
+		case "requestor":
+			if d.null() {
+				r.Requestor = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Requestor = &v
+			}
+		case "request":
+			if d.null() {
+				r.Request = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Request = &v
+			}
+		case "outcome":
+			d.unmarshal(&r.Outcome)
+		case "_outcome":
+			r.OutcomeElement = d.element()
+		case "disposition":
+			if v, ok := d.string(); ok {
+				r.Disposition = &v
+			} else {
+				r.Disposition = nil
+			}
+		case "_disposition":
+			r.DispositionElement = d.element()
+		case "preAuthRef":
+			if v, ok := d.string(); ok {
+				r.PreAuthRef = &v
+			} else {
+				r.PreAuthRef = nil
+			}
+		case "_preAuthRef":
+			r.PreAuthRefElement = d.element()
+		case "preAuthPeriod":
+			if d.null() {
+				r.PreAuthPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.PreAuthPeriod = &v
+			}
+		case "payeeType":
+			if d.null() {
+				r.PayeeType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PayeeType = &v
+			}
+		case "item":
+			a := d.array()
+			r.Item = nil
+			for a.next() {
+				var v ClaimResponseItem
+				v.decodeJSON(d)
+				r.Item = append(r.Item, v)
+			}
+			if r.Item == nil && !a.isNull {
+				r.Item = []ClaimResponseItem{}
+			}
+		case "addItem":
+			a := d.array()
+			r.AddItem = nil
+			for a.next() {
+				var v ClaimResponseAddItem
+				v.decodeJSON(d)
+				r.AddItem = append(r.AddItem, v)
+			}
+			if r.AddItem == nil && !a.isNull {
+				r.AddItem = []ClaimResponseAddItem{}
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ClaimResponseItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ClaimResponseItemAdjudication{}
+			}
+		case "total":
+			a := d.array()
+			r.Total = nil
+			for a.next() {
+				var v ClaimResponseTotal
+				v.decodeJSON(d)
+				r.Total = append(r.Total, v)
+			}
+			if r.Total == nil && !a.isNull {
+				r.Total = []ClaimResponseTotal{}
+			}
+		case "payment":
+			if d.null() {
+				r.Payment = nil
+			} else {
+				var v ClaimResponsePayment
+				v.decodeJSON(d)
+				r.Payment = &v
+			}
+		case "fundsReserve":
+			if d.null() {
+				r.FundsReserve = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FundsReserve = &v
+			}
+		case "formCode":
+			if d.null() {
+				r.FormCode = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FormCode = &v
+			}
+		case "form":
+			if d.null() {
+				r.Form = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Form = &v
+			}
+		case "processNote":
+			a := d.array()
+			r.ProcessNote = nil
+			for a.next() {
+				var v ClaimResponseProcessNote
+				v.decodeJSON(d)
+				r.ProcessNote = append(r.ProcessNote, v)
+			}
+			if r.ProcessNote == nil && !a.isNull {
+				r.ProcessNote = []ClaimResponseProcessNote{}
+			}
+		case "communicationRequest":
+			a := d.array()
+			r.CommunicationRequest = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.CommunicationRequest = append(r.CommunicationRequest, v)
+			}
+			if r.CommunicationRequest == nil && !a.isNull {
+				r.CommunicationRequest = []Reference{}
+			}
+		case "insurance":
+			a := d.array()
+			r.Insurance = nil
+			for a.next() {
+				var v ClaimResponseInsurance
+				v.decodeJSON(d)
+				r.Insurance = append(r.Insurance, v)
+			}
+			if r.Insurance == nil && !a.isNull {
+				r.Insurance = []ClaimResponseInsurance{}
+			}
+		case "error":
+			a := d.array()
+			r.Error = nil
+			for a.next() {
+				var v ClaimResponseError
+				v.decodeJSON(d)
+				r.Error = append(r.Error, v)
+			}
+			if r.Error == nil && !a.isNull {
+				r.Error = []ClaimResponseError{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponse which shares no memory with the original
+func (r ClaimResponse) DeepCopy() ClaimResponse {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.SubType != nil {
+		v := r.SubType.DeepCopy()
+		out.SubType = &v
+	}
+	if r.UseElement != nil {
+		v := r.UseElement.DeepCopy()
+		out.UseElement = &v
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	out.Insurer = r.Insurer.DeepCopy()
+	if r.Requestor != nil {
+		v := r.Requestor.DeepCopy()
+		out.Requestor = &v
+	}
+	if r.Request != nil {
+		v := r.Request.DeepCopy()
+		out.Request = &v
+	}
+	if r.OutcomeElement != nil {
+		v := r.OutcomeElement.DeepCopy()
+		out.OutcomeElement = &v
+	}
+	if r.Disposition != nil {
+		v := *r.Disposition
+		out.Disposition = &v
+	}
+	if r.DispositionElement != nil {
+		v := r.DispositionElement.DeepCopy()
+		out.DispositionElement = &v
+	}
+	if r.PreAuthRef != nil {
+		v := *r.PreAuthRef
+		out.PreAuthRef = &v
+	}
+	if r.PreAuthRefElement != nil {
+		v := r.PreAuthRefElement.DeepCopy()
+		out.PreAuthRefElement = &v
+	}
+	if r.PreAuthPeriod != nil {
+		v := r.PreAuthPeriod.DeepCopy()
+		out.PreAuthPeriod = &v
+	}
+	if r.PayeeType != nil {
+		v := r.PayeeType.DeepCopy()
+		out.PayeeType = &v
+	}
+	if r.Item != nil {
+		out.Item = make([]ClaimResponseItem, len(r.Item))
+		for i := range r.Item {
+			out.Item[i] = r.Item[i].DeepCopy()
+		}
+	}
+	if r.AddItem != nil {
+		out.AddItem = make([]ClaimResponseAddItem, len(r.AddItem))
+		for i := range r.AddItem {
+			out.AddItem[i] = r.AddItem[i].DeepCopy()
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ClaimResponseItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.Total != nil {
+		out.Total = make([]ClaimResponseTotal, len(r.Total))
+		for i := range r.Total {
+			out.Total[i] = r.Total[i].DeepCopy()
+		}
+	}
+	if r.Payment != nil {
+		v := r.Payment.DeepCopy()
+		out.Payment = &v
+	}
+	if r.FundsReserve != nil {
+		v := r.FundsReserve.DeepCopy()
+		out.FundsReserve = &v
+	}
+	if r.FormCode != nil {
+		v := r.FormCode.DeepCopy()
+		out.FormCode = &v
+	}
+	if r.Form != nil {
+		v := r.Form.DeepCopy()
+		out.Form = &v
+	}
+	if r.ProcessNote != nil {
+		out.ProcessNote = make([]ClaimResponseProcessNote, len(r.ProcessNote))
+		for i := range r.ProcessNote {
+			out.ProcessNote[i] = r.ProcessNote[i].DeepCopy()
+		}
+	}
+	if r.CommunicationRequest != nil {
+		out.CommunicationRequest = make([]Reference, len(r.CommunicationRequest))
+		for i := range r.CommunicationRequest {
+			out.CommunicationRequest[i] = r.CommunicationRequest[i].DeepCopy()
+		}
+	}
+	if r.Insurance != nil {
+		out.Insurance = make([]ClaimResponseInsurance, len(r.Insurance))
+		for i := range r.Insurance {
+			out.Insurance[i] = r.Insurance[i].DeepCopy()
+		}
+	}
+	if r.Error != nil {
+		out.Error = make([]ClaimResponseError, len(r.Error))
+		for i := range r.Error {
+			out.Error[i] = r.Error[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponse) Equal(other ClaimResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.Equal(*other.SubType) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.Equal(*other.UseElement) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if r.Created != other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if !r.Insurer.Equal(other.Insurer) {
+		return false
+	}
+	if (r.Requestor == nil) != (other.Requestor == nil) || r.Requestor != nil && !r.Requestor.Equal(*other.Requestor) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.Equal(*other.Request) {
+		return false
+	}
+	if r.Outcome != other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.Equal(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && *r.Disposition != *other.Disposition {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.Equal(*other.DispositionElement) {
+		return false
+	}
+	if (r.PreAuthRef == nil) != (other.PreAuthRef == nil) || r.PreAuthRef != nil && *r.PreAuthRef != *other.PreAuthRef {
+		return false
+	}
+	if (r.PreAuthRefElement == nil) != (other.PreAuthRefElement == nil) || r.PreAuthRefElement != nil && !r.PreAuthRefElement.Equal(*other.PreAuthRefElement) {
+		return false
+	}
+	if (r.PreAuthPeriod == nil) != (other.PreAuthPeriod == nil) || r.PreAuthPeriod != nil && !r.PreAuthPeriod.Equal(*other.PreAuthPeriod) {
+		return false
+	}
+	if (r.PayeeType == nil) != (other.PayeeType == nil) || r.PayeeType != nil && !r.PayeeType.Equal(*other.PayeeType) {
+		return false
+	}
+	if len(r.Item) != len(other.Item) {
+		return false
+	}
+	for i := range r.Item {
+		if !r.Item[i].Equal(other.Item[i]) {
+			return false
+		}
+	}
+	if len(r.AddItem) != len(other.AddItem) {
+		return false
+	}
+	for i := range r.AddItem {
+		if !r.AddItem[i].Equal(other.AddItem[i]) {
+			return false
+		}
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.Total) != len(other.Total) {
+		return false
+	}
+	for i := range r.Total {
+		if !r.Total[i].Equal(other.Total[i]) {
+			return false
+		}
+	}
+	if (r.Payment == nil) != (other.Payment == nil) || r.Payment != nil && !r.Payment.Equal(*other.Payment) {
+		return false
+	}
+	if (r.FundsReserve == nil) != (other.FundsReserve == nil) || r.FundsReserve != nil && !r.FundsReserve.Equal(*other.FundsReserve) {
+		return false
+	}
+	if (r.FormCode == nil) != (other.FormCode == nil) || r.FormCode != nil && !r.FormCode.Equal(*other.FormCode) {
+		return false
+	}
+	if (r.Form == nil) != (other.Form == nil) || r.Form != nil && !r.Form.Equal(*other.Form) {
+		return false
+	}
+	if len(r.ProcessNote) != len(other.ProcessNote) {
+		return false
+	}
+	for i := range r.ProcessNote {
+		if !r.ProcessNote[i].Equal(other.ProcessNote[i]) {
+			return false
+		}
+	}
+	if len(r.CommunicationRequest) != len(other.CommunicationRequest) {
+		return false
+	}
+	for i := range r.CommunicationRequest {
+		if !r.CommunicationRequest[i].Equal(other.CommunicationRequest[i]) {
+			return false
+		}
+	}
+	if len(r.Insurance) != len(other.Insurance) {
+		return false
+	}
+	for i := range r.Insurance {
+		if !r.Insurance[i].Equal(other.Insurance[i]) {
+			return false
+		}
+	}
+	if len(r.Error) != len(other.Error) {
+		return false
+	}
+	for i := range r.Error {
+		if !r.Error[i].Equal(other.Error[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponse) EqualsDeep(other ClaimResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.EqualsDeep(*other.SubType) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.EqualsDeep(*other.UseElement) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if !r.Insurer.EqualsDeep(other.Insurer) {
+		return false
+	}
+	if (r.Requestor == nil) != (other.Requestor == nil) || r.Requestor != nil && !r.Requestor.EqualsDeep(*other.Requestor) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.EqualsDeep(*other.Request) {
+		return false
+	}
+	if r.Outcome != other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.EqualsDeep(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.EqualsDeep(*other.DispositionElement) {
+		return false
+	}
+	if (r.PreAuthRef == nil) != (other.PreAuthRef == nil) || r.PreAuthRef != nil && !equivalentString(*r.PreAuthRef, *other.PreAuthRef) {
+		return false
+	}
+	if (r.PreAuthRefElement == nil) != (other.PreAuthRefElement == nil) || r.PreAuthRefElement != nil && !r.PreAuthRefElement.EqualsDeep(*other.PreAuthRefElement) {
+		return false
+	}
+	if (r.PreAuthPeriod == nil) != (other.PreAuthPeriod == nil) || r.PreAuthPeriod != nil && !r.PreAuthPeriod.EqualsDeep(*other.PreAuthPeriod) {
+		return false
+	}
+	if (r.PayeeType == nil) != (other.PayeeType == nil) || r.PayeeType != nil && !r.PayeeType.EqualsDeep(*other.PayeeType) {
+		return false
+	}
+	if !equivalentList(len(r.Item), len(other.Item), func(i, j int) bool {
+		return r.Item[i].EqualsDeep(other.Item[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.AddItem), len(other.AddItem), func(i, j int) bool {
+		return r.AddItem[i].EqualsDeep(other.AddItem[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Total), len(other.Total), func(i, j int) bool {
+		return r.Total[i].EqualsDeep(other.Total[j])
+	}) {
+		return false
+	}
+	if (r.Payment == nil) != (other.Payment == nil) || r.Payment != nil && !r.Payment.EqualsDeep(*other.Payment) {
+		return false
+	}
+	if (r.FundsReserve == nil) != (other.FundsReserve == nil) || r.FundsReserve != nil && !r.FundsReserve.EqualsDeep(*other.FundsReserve) {
+		return false
+	}
+	if (r.FormCode == nil) != (other.FormCode == nil) || r.FormCode != nil && !r.FormCode.EqualsDeep(*other.FormCode) {
+		return false
+	}
+	if (r.Form == nil) != (other.Form == nil) || r.Form != nil && !r.Form.EqualsDeep(*other.Form) {
+		return false
+	}
+	if !equivalentList(len(r.ProcessNote), len(other.ProcessNote), func(i, j int) bool {
+		return r.ProcessNote[i].EqualsDeep(other.ProcessNote[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.CommunicationRequest), len(other.CommunicationRequest), func(i, j int) bool {
+		return r.CommunicationRequest[i].EqualsDeep(other.CommunicationRequest[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Insurance), len(other.Insurance), func(i, j int) bool {
+		return r.Insurance[i].EqualsDeep(other.Insurance[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Error), len(other.Error), func(i, j int) bool {
+		return r.Error[i].EqualsDeep(other.Error[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponse) EqualsShallow(other ClaimResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if r.Outcome != other.Outcome {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if (r.PreAuthRef == nil) != (other.PreAuthRef == nil) || r.PreAuthRef != nil && !equivalentString(*r.PreAuthRef, *other.PreAuthRef) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponse as FHIR XML
+func (r ClaimResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ClaimResponse",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subType", r.SubType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "use", r.Use, r.UseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "insurer", r.Insurer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requestor", r.Requestor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "request", r.Request); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "outcome", r.Outcome, r.OutcomeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "disposition", r.Disposition, r.DispositionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "preAuthRef", r.PreAuthRef, r.PreAuthRefElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "preAuthPeriod", r.PreAuthPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "payeeType", r.PayeeType); err != nil {
+		return err
+	}
+	for _, v := range r.Item {
+		if err := encodeXMLElement(e, "item", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.AddItem {
+		if err := encodeXMLElement(e, "addItem", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Total {
+		if err := encodeXMLElement(e, "total", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "payment", r.Payment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fundsReserve", r.FundsReserve); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "formCode", r.FormCode); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "form", r.Form); err != nil {
+		return err
+	}
+	for _, v := range r.ProcessNote {
+		if err := encodeXMLElement(e, "processNote", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CommunicationRequest {
+		if err := encodeXMLElement(e, "communicationRequest", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Insurance {
+		if err := encodeXMLElement(e, "insurance", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Error {
+		if err := encodeXMLElement(e, "error", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponse from FHIR XML
+func (r *ClaimResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "subType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubType = &v
+			case "use":
+				var v Use
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Use = v
+				}
+				r.UseElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = v
+				}
+				r.CreatedElement = element
+			case "insurer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurer = v
+			case "requestor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requestor = &v
+			case "request":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = &v
+			case "outcome":
+				var v ClaimProcessingCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Outcome = v
+				}
+				r.OutcomeElement = element
+			case "disposition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Disposition = &v
+				}
+				r.DispositionElement = element
+			case "preAuthRef":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PreAuthRef = &v
+				}
+				r.PreAuthRefElement = element
+			case "preAuthPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PreAuthPeriod = &v
+			case "payeeType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PayeeType = &v
+			case "item":
+				var v ClaimResponseItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Item = append(r.Item, v)
+			case "addItem":
+				var v ClaimResponseAddItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AddItem = append(r.AddItem, v)
+			case "adjudication":
+				var v ClaimResponseItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "total":
+				var v ClaimResponseTotal
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Total = append(r.Total, v)
+			case "payment":
+				var v ClaimResponsePayment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payment = &v
+			case "fundsReserve":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FundsReserve = &v
+			case "formCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FormCode = &v
+			case "form":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Form = &v
+			case "processNote":
+				var v ClaimResponseProcessNote
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProcessNote = append(r.ProcessNote, v)
+			case "communicationRequest":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CommunicationRequest = append(r.CommunicationRequest, v)
+			case "insurance":
+				var v ClaimResponseInsurance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurance = append(r.Insurance, v)
+			case "error":
+				var v ClaimResponseError
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Error = append(r.Error, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponse as properties to the RDF node n
+func (r ClaimResponse) turtle(n *turtleNode) {
+	n.resource("ClaimResponse", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ClaimResponse.identifier", i, v)
+	}
+	n.primitive("ClaimResponse.status", -1, r.Status, r.StatusElement, "code")
+	n.element("ClaimResponse.type", -1, r.Type)
+	n.element("ClaimResponse.subType", -1, r.SubType)
+	n.primitive("ClaimResponse.use", -1, r.Use, r.UseElement, "code")
+	n.element("ClaimResponse.patient", -1, r.Patient)
+	n.primitive("ClaimResponse.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("ClaimResponse.insurer", -1, r.Insurer)
+	n.element("ClaimResponse.requestor", -1, r.Requestor)
+	n.element("ClaimResponse.request", -1, r.Request)
+	n.primitive("ClaimResponse.outcome", -1, r.Outcome, r.OutcomeElement, "code")
+	n.primitive("ClaimResponse.disposition", -1, r.Disposition, r.DispositionElement, "string")
+	n.primitive("ClaimResponse.preAuthRef", -1, r.PreAuthRef, r.PreAuthRefElement, "string")
+	n.element("ClaimResponse.preAuthPeriod", -1, r.PreAuthPeriod)
+	n.element("ClaimResponse.payeeType", -1, r.PayeeType)
+	for i, v := range r.Item {
+		n.element("ClaimResponse.item", i, v)
+	}
+	for i, v := range r.AddItem {
+		n.element("ClaimResponse.addItem", i, v)
+	}
+	for i, v := range r.Adjudication {
+		n.element("ClaimResponse.adjudication", i, v)
+	}
+	for i, v := range r.Total {
+		n.element("ClaimResponse.total", i, v)
+	}
+	n.element("ClaimResponse.payment", -1, r.Payment)
+	n.element("ClaimResponse.fundsReserve", -1, r.FundsReserve)
+	n.element("ClaimResponse.formCode", -1, r.FormCode)
+	n.element("ClaimResponse.form", -1, r.Form)
+	for i, v := range r.ProcessNote {
+		n.element("ClaimResponse.processNote", i, v)
+	}
+	for i, v := range r.CommunicationRequest {
+		n.element("ClaimResponse.communicationRequest", i, v)
+	}
+	for i, v := range r.Insurance {
+		n.element("ClaimResponse.insurance", i, v)
+	}
+	for i, v := range r.Error {
+		n.element("ClaimResponse.error", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimResponse as BSON document with the structure of its FHIR JSON
+func (r ClaimResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponse from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponse) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponse as protobuf message ClaimResponse of fhir.proto
+func (r ClaimResponse) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponse to the encoded message
+func (r ClaimResponse) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	e.message(11, r.Type)
+	if r.SubType != nil {
+		e.message(12, *r.SubType)
+	}
+	e.varint(13, r.Use.protoNumber())
+	e.message(14, r.Patient)
+	if r.Created != "" {
+		e.string(15, r.Created)
+	}
+	e.message(16, r.Insurer)
+	if r.Requestor != nil {
+		e.message(17, *r.Requestor)
+	}
+	if r.Request != nil {
+		e.message(18, *r.Request)
+	}
+	e.varint(19, r.Outcome.protoNumber())
+	if r.Disposition != nil {
+		e.string(20, *r.Disposition)
+	}
+	if r.PreAuthRef != nil {
+		e.string(21, *r.PreAuthRef)
+	}
+	if r.PreAuthPeriod != nil {
+		e.message(22, *r.PreAuthPeriod)
+	}
+	if r.PayeeType != nil {
+		e.message(23, *r.PayeeType)
+	}
+	for _, v := range r.Item {
+		e.message(24, v)
+	}
+	for _, v := range r.AddItem {
+		e.message(25, v)
+	}
+	for _, v := range r.Adjudication {
+		e.message(26, v)
+	}
+	for _, v := range r.Total {
+		e.message(27, v)
+	}
+	if r.Payment != nil {
+		e.message(28, *r.Payment)
+	}
+	if r.FundsReserve != nil {
+		e.message(29, *r.FundsReserve)
+	}
+	if r.FormCode != nil {
+		e.message(30, *r.FormCode)
+	}
+	if r.Form != nil {
+		e.message(31, *r.Form)
+	}
+	for _, v := range r.ProcessNote {
+		e.message(32, v)
+	}
+	for _, v := range r.CommunicationRequest {
+		e.message(33, v)
+	}
+	for _, v := range r.Insurance {
+		e.message(34, v)
+	}
+	for _, v := range r.Error {
+		e.message(35, v)
+	}
+	if r.IdElement != nil {
+		e.message(36, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(37, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(38, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(39, *r.StatusElement)
+	}
+	if r.UseElement != nil {
+		e.message(40, *r.UseElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(41, *r.CreatedElement)
+	}
+	if r.OutcomeElement != nil {
+		e.message(42, *r.OutcomeElement)
+	}
+	if r.DispositionElement != nil {
+		e.message(43, *r.DispositionElement)
+	}
+	if r.PreAuthRefElement != nil {
+		e.message(44, *r.PreAuthRefElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponse from protobuf message ClaimResponse of fhir.proto
+func (r *ClaimResponse) UnmarshalProto(b []byte) error {
+	*r = ClaimResponse{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubType = &v
+		case 13:
+			var v Use
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Use = v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 15:
+			r.Created = d.string()
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Insurer = v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Requestor = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Request = &v
+		case 19:
+			var v ClaimProcessingCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Outcome = v
+		case 20:
+			v := d.string()
+			r.Disposition = &v
+		case 21:
+			v := d.string()
+			r.PreAuthRef = &v
+		case 22:
+			var v Period
+			d.message(&v)
+			r.PreAuthPeriod = &v
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.PayeeType = &v
+		case 24:
+			var v ClaimResponseItem
+			d.message(&v)
+			r.Item = append(r.Item, v)
+		case 25:
+			var v ClaimResponseAddItem
+			d.message(&v)
+			r.AddItem = append(r.AddItem, v)
+		case 26:
+			var v ClaimResponseItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 27:
+			var v ClaimResponseTotal
+			d.message(&v)
+			r.Total = append(r.Total, v)
+		case 28:
+			var v ClaimResponsePayment
+			d.message(&v)
+			r.Payment = &v
+		case 29:
+			var v CodeableConcept
+			d.message(&v)
+			r.FundsReserve = &v
+		case 30:
+			var v CodeableConcept
+			d.message(&v)
+			r.FormCode = &v
+		case 31:
+			var v Attachment
+			d.message(&v)
+			r.Form = &v
+		case 32:
+			var v ClaimResponseProcessNote
+			d.message(&v)
+			r.ProcessNote = append(r.ProcessNote, v)
+		case 33:
+			var v Reference
+			d.message(&v)
+			r.CommunicationRequest = append(r.CommunicationRequest, v)
+		case 34:
+			var v ClaimResponseInsurance
+			d.message(&v)
+			r.Insurance = append(r.Insurance, v)
+		case 35:
+			var v ClaimResponseError
+			d.message(&v)
+			r.Error = append(r.Error, v)
+		case 36:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.UseElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.OutcomeElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.DispositionElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.PreAuthRefElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseItem as JSON into a byte slice
+func (r ClaimResponseItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseItem as JSON object with the members in the order of the fields
+func (r ClaimResponseItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("itemSequence")
+	e.int(r.ItemSequence)
+	if r.ItemSequenceElement != nil {
+		e.key("_itemSequence")
+		r.ItemSequenceElement.appendJSON(e)
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	e.key("adjudication")
+	if r.Adjudication == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseItem from JSON. Unknown members are ignored.
+func (r *ClaimResponseItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseItem
+func (r *ClaimResponseItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "itemSequence":
+			if v, ok := d.int(); ok {
+				r.ItemSequence = v
+			}
+		case "_itemSequence":
+			r.ItemSequenceElement = d.element()
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ClaimResponseItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ClaimResponseItemAdjudication{}
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v ClaimResponseItemDetail
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []ClaimResponseItemDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseItem which shares no memory with the original
+func (r ClaimResponseItem) DeepCopy() ClaimResponseItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ItemSequenceElement != nil {
+		v := r.ItemSequenceElement.DeepCopy()
+		out.ItemSequenceElement = &v
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ClaimResponseItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.Detail != nil {
+		out.Detail = make([]ClaimResponseItemDetail, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseItem) Equal(other ClaimResponseItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.ItemSequence != other.ItemSequence {
+		return false
+	}
+	if (r.ItemSequenceElement == nil) != (other.ItemSequenceElement == nil) || r.ItemSequenceElement != nil && !r.ItemSequenceElement.Equal(*other.ItemSequenceElement) {
+		return false
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseItem) EqualsDeep(other ClaimResponseItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.ItemSequence != other.ItemSequence {
+		return false
+	}
+	if (r.ItemSequenceElement == nil) != (other.ItemSequenceElement == nil) || r.ItemSequenceElement != nil && !r.ItemSequenceElement.EqualsDeep(*other.ItemSequenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseItem) EqualsShallow(other ClaimResponseItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.ItemSequence != other.ItemSequence {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseItem as FHIR XML
+func (r ClaimResponseItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "itemSequence", r.ItemSequence, r.ItemSequenceElement); err != nil {
+		return err
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseItem from FHIR XML
+func (r *ClaimResponseItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "itemSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ItemSequence = v
+				}
+				r.ItemSequenceElement = element
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ClaimResponseItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "detail":
+				var v ClaimResponseItemDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseItem as properties to the RDF node n
+func (r ClaimResponseItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ClaimResponse.item.itemSequence", -1, r.ItemSequence, r.ItemSequenceElement, "positiveInt")
+	for i, v := range r.NoteNumber {
+		n.primitive("ClaimResponse.item.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ClaimResponse.item.adjudication", i, v)
+	}
+	for i, v := range r.Detail {
+		n.element("ClaimResponse.item.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimResponseItem as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseItem from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseItem as protobuf message ClaimResponseItem of fhir.proto
+func (r ClaimResponseItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseItem to the encoded message
+func (r ClaimResponseItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ItemSequence != 0 {
+		e.varint(4, uint64(int64(r.ItemSequence)))
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(5, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(6, v)
+	}
+	for _, v := range r.Detail {
+		e.message(7, v)
+	}
+	if r.ItemSequenceElement != nil {
+		e.message(8, *r.ItemSequenceElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(9, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseItem from protobuf message ClaimResponseItem of fhir.proto
+func (r *ClaimResponseItem) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.ItemSequence = d.int()
+		case 5:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 6:
+			var v ClaimResponseItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 7:
+			var v ClaimResponseItemDetail
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ItemSequenceElement = &v
+		case 9:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseItemAdjudication as JSON into a byte slice
+func (r ClaimResponseItemAdjudication) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseItemAdjudication as JSON object with the members in the order of the fields
+func (r ClaimResponseItemAdjudication) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("category")
+	r.Category.appendJSON(e)
+	if r.Reason != nil {
+		e.key("reason")
+		r.Reason.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	if r.Value != nil {
+		e.key("value")
+		e.number(*r.Value)
+	}
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseItemAdjudication from JSON. Unknown members are ignored.
+func (r *ClaimResponseItemAdjudication) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseItemAdjudication
+func (r *ClaimResponseItemAdjudication) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			r.Category.decodeJSON(d)
+		case "reason":
+			if d.null() {
+				r.Reason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Reason = &v
+			}
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		case "value":
+			if v, ok := d.number(); ok {
+				r.Value = &v
+			} else {
+				r.Value = nil
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseItemAdjudication which shares no memory with the original
+func (r ClaimResponseItemAdjudication) DeepCopy() ClaimResponseItemAdjudication {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Category = r.Category.DeepCopy()
+	if r.Reason != nil {
+		v := r.Reason.DeepCopy()
+		out.Reason = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	if r.Value != nil {
+		v := *r.Value
+		out.Value = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseItemAdjudication) Equal(other ClaimResponseItemAdjudication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Category.Equal(other.Category) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.Equal(*other.Reason) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !EqualDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseItemAdjudication) EqualsDeep(other ClaimResponseItemAdjudication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Category.EqualsDeep(other.Category) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.EqualsDeep(*other.Reason) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseItemAdjudication) EqualsShallow(other ClaimResponseItemAdjudication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseItemAdjudication as FHIR XML
+func (r ClaimResponseItemAdjudication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reason", r.Reason); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseItemAdjudication from FHIR XML
+func (r *ClaimResponseItemAdjudication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = v
+			case "reason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = &v
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			case "value":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = &v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseItemAdjudication as properties to the RDF node n
+func (r ClaimResponseItemAdjudication) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ClaimResponse.item.adjudication.category", -1, r.Category)
+	n.element("ClaimResponse.item.adjudication.reason", -1, r.Reason)
+	n.element("ClaimResponse.item.adjudication.amount", -1, r.Amount)
+	n.primitive("ClaimResponse.item.adjudication.value", -1, r.Value, r.ValueElement, "decimal")
+}
+
+// MarshalBSON marshals the given ClaimResponseItemAdjudication as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseItemAdjudication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseItemAdjudication from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseItemAdjudication) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseItemAdjudication as protobuf message ClaimResponseItemAdjudication of fhir.proto
+func (r ClaimResponseItemAdjudication) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseItemAdjudication to the encoded message
+func (r ClaimResponseItemAdjudication) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Category)
+	if r.Reason != nil {
+		e.message(5, *r.Reason)
+	}
+	if r.Amount != nil {
+		e.message(6, *r.Amount)
+	}
+	if r.Value != nil {
+		e.string(7, string(*r.Value))
+	}
+	if r.ValueElement != nil {
+		e.message(8, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseItemAdjudication from protobuf message ClaimResponseItemAdjudication of fhir.proto
+func (r *ClaimResponseItemAdjudication) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseItemAdjudication{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Reason = &v
+		case 6:
+			var v Money
+			d.message(&v)
+			r.Amount = &v
+		case 7:
+			v := json.Number(d.string())
+			r.Value = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseItemDetail as JSON into a byte slice
+func (r ClaimResponseItemDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseItemDetail as JSON object with the members in the order of the fields
+func (r ClaimResponseItemDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("detailSequence")
+	e.int(r.DetailSequence)
+	if r.DetailSequenceElement != nil {
+		e.key("_detailSequence")
+		r.DetailSequenceElement.appendJSON(e)
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SubDetail) > 0 {
+		e.key("subDetail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubDetail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseItemDetail from JSON. Unknown members are ignored.
+func (r *ClaimResponseItemDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseItemDetail
+func (r *ClaimResponseItemDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "detailSequence":
+			if v, ok := d.int(); ok {
+				r.DetailSequence = v
+			}
+		case "_detailSequence":
+			r.DetailSequenceElement = d.element()
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ClaimResponseItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ClaimResponseItemAdjudication{}
+			}
+		case "subDetail":
+			a := d.array()
+			r.SubDetail = nil
+			for a.next() {
+				var v ClaimResponseItemDetailSubDetail
+				v.decodeJSON(d)
+				r.SubDetail = append(r.SubDetail, v)
+			}
+			if r.SubDetail == nil && !a.isNull {
+				r.SubDetail = []ClaimResponseItemDetailSubDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseItemDetail which shares no memory with the original
+func (r ClaimResponseItemDetail) DeepCopy() ClaimResponseItemDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DetailSequenceElement != nil {
+		v := r.DetailSequenceElement.DeepCopy()
+		out.DetailSequenceElement = &v
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ClaimResponseItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.SubDetail != nil {
+		out.SubDetail = make([]ClaimResponseItemDetailSubDetail, len(r.SubDetail))
+		for i := range r.SubDetail {
+			out.SubDetail[i] = r.SubDetail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseItemDetail) Equal(other ClaimResponseItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.DetailSequence != other.DetailSequence {
+		return false
+	}
+	if (r.DetailSequenceElement == nil) != (other.DetailSequenceElement == nil) || r.DetailSequenceElement != nil && !r.DetailSequenceElement.Equal(*other.DetailSequenceElement) {
+		return false
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.SubDetail) != len(other.SubDetail) {
+		return false
+	}
+	for i := range r.SubDetail {
+		if !r.SubDetail[i].Equal(other.SubDetail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseItemDetail) EqualsDeep(other ClaimResponseItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.DetailSequence != other.DetailSequence {
+		return false
+	}
+	if (r.DetailSequenceElement == nil) != (other.DetailSequenceElement == nil) || r.DetailSequenceElement != nil && !r.DetailSequenceElement.EqualsDeep(*other.DetailSequenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SubDetail), len(other.SubDetail), func(i, j int) bool {
+		return r.SubDetail[i].EqualsDeep(other.SubDetail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseItemDetail) EqualsShallow(other ClaimResponseItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.DetailSequence != other.DetailSequence {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseItemDetail as FHIR XML
+func (r ClaimResponseItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "detailSequence", r.DetailSequence, r.DetailSequenceElement); err != nil {
+		return err
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SubDetail {
+		if err := encodeXMLElement(e, "subDetail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseItemDetail from FHIR XML
+func (r *ClaimResponseItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "detailSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DetailSequence = v
+				}
+				r.DetailSequenceElement = element
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ClaimResponseItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "subDetail":
+				var v ClaimResponseItemDetailSubDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubDetail = append(r.SubDetail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseItemDetail as properties to the RDF node n
+func (r ClaimResponseItemDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ClaimResponse.item.detail.detailSequence", -1, r.DetailSequence, r.DetailSequenceElement, "positiveInt")
+	for i, v := range r.NoteNumber {
+		n.primitive("ClaimResponse.item.detail.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ClaimResponse.item.detail.adjudication", i, v)
+	}
+	for i, v := range r.SubDetail {
+		n.element("ClaimResponse.item.detail.subDetail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimResponseItemDetail as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseItemDetail from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseItemDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseItemDetail as protobuf message ClaimResponseItemDetail of fhir.proto
+func (r ClaimResponseItemDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseItemDetail to the encoded message
+func (r ClaimResponseItemDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.DetailSequence != 0 {
+		e.varint(4, uint64(int64(r.DetailSequence)))
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(5, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(6, v)
+	}
+	for _, v := range r.SubDetail {
+		e.message(7, v)
+	}
+	if r.DetailSequenceElement != nil {
+		e.message(8, *r.DetailSequenceElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(9, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseItemDetail from protobuf message ClaimResponseItemDetail of fhir.proto
+func (r *ClaimResponseItemDetail) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseItemDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.DetailSequence = d.int()
+		case 5:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 6:
+			var v ClaimResponseItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 7:
+			var v ClaimResponseItemDetailSubDetail
+			d.message(&v)
+			r.SubDetail = append(r.SubDetail, v)
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DetailSequenceElement = &v
+		case 9:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseItemDetailSubDetail as JSON into a byte slice
+func (r ClaimResponseItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseItemDetailSubDetail as JSON object with the members in the order of the fields
+func (r ClaimResponseItemDetailSubDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("subDetailSequence")
+	e.int(r.SubDetailSequence)
+	if r.SubDetailSequenceElement != nil {
+		e.key("_subDetailSequence")
+		r.SubDetailSequenceElement.appendJSON(e)
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseItemDetailSubDetail from JSON. Unknown members are ignored.
+func (r *ClaimResponseItemDetailSubDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseItemDetailSubDetail
+func (r *ClaimResponseItemDetailSubDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "subDetailSequence":
+			if v, ok := d.int(); ok {
+				r.SubDetailSequence = v
+			}
+		case "_subDetailSequence":
+			r.SubDetailSequenceElement = d.element()
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ClaimResponseItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ClaimResponseItemAdjudication{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseItemDetailSubDetail which shares no memory with the original
+func (r ClaimResponseItemDetailSubDetail) DeepCopy() ClaimResponseItemDetailSubDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SubDetailSequenceElement != nil {
+		v := r.SubDetailSequenceElement.DeepCopy()
+		out.SubDetailSequenceElement = &v
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ClaimResponseItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseItemDetailSubDetail) Equal(other ClaimResponseItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.SubDetailSequence != other.SubDetailSequence {
+		return false
+	}
+	if (r.SubDetailSequenceElement == nil) != (other.SubDetailSequenceElement == nil) || r.SubDetailSequenceElement != nil && !r.SubDetailSequenceElement.Equal(*other.SubDetailSequenceElement) {
+		return false
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseItemDetailSubDetail) EqualsDeep(other ClaimResponseItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.SubDetailSequence != other.SubDetailSequence {
+		return false
+	}
+	if (r.SubDetailSequenceElement == nil) != (other.SubDetailSequenceElement == nil) || r.SubDetailSequenceElement != nil && !r.SubDetailSequenceElement.EqualsDeep(*other.SubDetailSequenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseItemDetailSubDetail) EqualsShallow(other ClaimResponseItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.SubDetailSequence != other.SubDetailSequence {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseItemDetailSubDetail as FHIR XML
+func (r ClaimResponseItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "subDetailSequence", r.SubDetailSequence, r.SubDetailSequenceElement); err != nil {
+		return err
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseItemDetailSubDetail from FHIR XML
+func (r *ClaimResponseItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "subDetailSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SubDetailSequence = v
+				}
+				r.SubDetailSequenceElement = element
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ClaimResponseItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseItemDetailSubDetail as properties to the RDF node n
+func (r ClaimResponseItemDetailSubDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ClaimResponse.item.detail.subDetail.subDetailSequence", -1, r.SubDetailSequence, r.SubDetailSequenceElement, "positiveInt")
+	for i, v := range r.NoteNumber {
+		n.primitive("ClaimResponse.item.detail.subDetail.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ClaimResponse.item.detail.subDetail.adjudication", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimResponseItemDetailSubDetail as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseItemDetailSubDetail from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseItemDetailSubDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseItemDetailSubDetail as protobuf message ClaimResponseItemDetailSubDetail of fhir.proto
+func (r ClaimResponseItemDetailSubDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseItemDetailSubDetail to the encoded message
+func (r ClaimResponseItemDetailSubDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.SubDetailSequence != 0 {
+		e.varint(4, uint64(int64(r.SubDetailSequence)))
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(5, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(6, v)
+	}
+	if r.SubDetailSequenceElement != nil {
+		e.message(7, *r.SubDetailSequenceElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(8, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseItemDetailSubDetail from protobuf message ClaimResponseItemDetailSubDetail of fhir.proto
+func (r *ClaimResponseItemDetailSubDetail) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseItemDetailSubDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.SubDetailSequence = d.int()
+		case 5:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 6:
+			var v ClaimResponseItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.SubDetailSequenceElement = &v
+		case 8:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseAddItem as JSON into a byte slice
+func (r ClaimResponseAddItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseAddItem as JSON object with the members in the order of the fields
+func (r ClaimResponseAddItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ItemSequence) > 0 {
+		e.key("itemSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ItemSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ItemSequenceElement) > 0 {
+		e.key("_itemSequence")
+		e.elements(r.ItemSequenceElement, len(r.ItemSequence))
+	}
+	if len(r.DetailSequence) > 0 {
+		e.key("detailSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DetailSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DetailSequenceElement) > 0 {
+		e.key("_detailSequence")
+		e.elements(r.DetailSequenceElement, len(r.DetailSequence))
+	}
+	if len(r.SubdetailSequence) > 0 {
+		e.key("subdetailSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubdetailSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SubdetailSequenceElement) > 0 {
+		e.key("_subdetailSequence")
+		e.elements(r.SubdetailSequenceElement, len(r.SubdetailSequence))
+	}
+	if len(r.Provider) > 0 {
+		e.key("provider")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Provider {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProgramCode) > 0 {
+		e.key("programCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProgramCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ServicedDate != nil {
+		e.key("servicedDate")
+		e.string(*r.ServicedDate)
+	}
+	if r.ServicedDateElement != nil {
+		e.key("_servicedDate")
+		r.ServicedDateElement.appendJSON(e)
+	}
+	if r.ServicedPeriod != nil {
+		e.key("servicedPeriod")
+		r.ServicedPeriod.appendJSON(e)
+	}
+	if r.LocationCodeableConcept != nil {
+		e.key("locationCodeableConcept")
+		r.LocationCodeableConcept.appendJSON(e)
+	}
+	if r.LocationAddress != nil {
+		e.key("locationAddress")
+		r.LocationAddress.appendJSON(e)
+	}
+	if r.LocationReference != nil {
+		e.key("locationReference")
+		r.LocationReference.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if r.BodySite != nil {
+		e.key("bodySite")
+		r.BodySite.appendJSON(e)
+	}
+	if len(r.SubSite) > 0 {
+		e.key("subSite")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubSite {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseAddItem from JSON. Unknown members are ignored.
+func (r *ClaimResponseAddItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseAddItem
+func (r *ClaimResponseAddItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "itemSequence":
+			a := d.array()
+			r.ItemSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.ItemSequence = append(r.ItemSequence, v)
+			}
+			if r.ItemSequence == nil && !a.isNull {
+				r.ItemSequence = []int{}
+			}
+		case "_itemSequence":
+			a := d.array()
+			r.ItemSequenceElement = nil
+			for a.next() {
+				r.ItemSequenceElement = append(r.ItemSequenceElement, d.element())
+			}
+		case "detailSequence":
+			a := d.array()
+			r.DetailSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.DetailSequence = append(r.DetailSequence, v)
+			}
+			if r.DetailSequence == nil && !a.isNull {
+				r.DetailSequence = []int{}
+			}
+		case "_detailSequence":
+			a := d.array()
+			r.DetailSequenceElement = nil
+			for a.next() {
+				r.DetailSequenceElement = append(r.DetailSequenceElement, d.element())
+			}
+		case "subdetailSequence":
+			a := d.array()
+			r.SubdetailSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.SubdetailSequence = append(r.SubdetailSequence, v)
+			}
+			if r.SubdetailSequence == nil && !a.isNull {
+				r.SubdetailSequence = []int{}
+			}
+		case "_subdetailSequence":
+			a := d.array()
+			r.SubdetailSequenceElement = nil
+			for a.next() {
+				r.SubdetailSequenceElement = append(r.SubdetailSequenceElement, d.element())
+			}
+		case "provider":
+			a := d.array()
+			r.Provider = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Provider = append(r.Provider, v)
+			}
+			if r.Provider == nil && !a.isNull {
+				r.Provider = []Reference{}
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "programCode":
+			a := d.array()
+			r.ProgramCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProgramCode = append(r.ProgramCode, v)
+			}
+			if r.ProgramCode == nil && !a.isNull {
+				r.ProgramCode = []CodeableConcept{}
+			}
+		case "servicedDate":
+			if v, ok := d.string(); ok {
+				r.ServicedDate = &v
+			} else {
+				r.ServicedDate = nil
+			}
+		case "_servicedDate":
+			r.ServicedDateElement = d.element()
+		case "servicedPeriod":
+			if d.null() {
+				r.ServicedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ServicedPeriod = &v
+			}
+		case "locationCodeableConcept":
+			if d.null() {
+				r.LocationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LocationCodeableConcept = &v
+			}
+		case "locationAddress":
+			if d.null() {
+				r.LocationAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.LocationAddress = &v
+			}
+		case "locationReference":
+			if d.null() {
+				r.LocationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.LocationReference = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "bodySite":
+			if d.null() {
+				r.BodySite = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = &v
+			}
+		case "subSite":
+			a := d.array()
+			r.SubSite = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubSite = append(r.SubSite, v)
+			}
+			if r.SubSite == nil && !a.isNull {
+				r.SubSite = []CodeableConcept{}
+			}
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ClaimResponseItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ClaimResponseItemAdjudication{}
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v ClaimResponseAddItemDetail
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []ClaimResponseAddItemDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseAddItem which shares no memory with the original
+func (r ClaimResponseAddItem) DeepCopy() ClaimResponseAddItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ItemSequence != nil {
+		out.ItemSequence = make([]int, len(r.ItemSequence))
+		copy(out.ItemSequence, r.ItemSequence)
+	}
+	if r.ItemSequenceElement != nil {
+		out.ItemSequenceElement = make([]*Element, len(r.ItemSequenceElement))
+		for i, v := range r.ItemSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ItemSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.DetailSequence != nil {
+		out.DetailSequence = make([]int, len(r.DetailSequence))
+		copy(out.DetailSequence, r.DetailSequence)
+	}
+	if r.DetailSequenceElement != nil {
+		out.DetailSequenceElement = make([]*Element, len(r.DetailSequenceElement))
+		for i, v := range r.DetailSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DetailSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.SubdetailSequence != nil {
+		out.SubdetailSequence = make([]int, len(r.SubdetailSequence))
+		copy(out.SubdetailSequence, r.SubdetailSequence)
+	}
+	if r.SubdetailSequenceElement != nil {
+		out.SubdetailSequenceElement = make([]*Element, len(r.SubdetailSequenceElement))
+		for i, v := range r.SubdetailSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SubdetailSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.Provider != nil {
+		out.Provider = make([]Reference, len(r.Provider))
+		for i := range r.Provider {
+			out.Provider[i] = r.Provider[i].DeepCopy()
+		}
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.ProgramCode != nil {
+		out.ProgramCode = make([]CodeableConcept, len(r.ProgramCode))
+		for i := range r.ProgramCode {
+			out.ProgramCode[i] = r.ProgramCode[i].DeepCopy()
+		}
+	}
+	if r.ServicedDate != nil {
+		v := *r.ServicedDate
+		out.ServicedDate = &v
+	}
+	if r.ServicedDateElement != nil {
+		v := r.ServicedDateElement.DeepCopy()
+		out.ServicedDateElement = &v
+	}
+	if r.ServicedPeriod != nil {
+		v := r.ServicedPeriod.DeepCopy()
+		out.ServicedPeriod = &v
+	}
+	if r.LocationCodeableConcept != nil {
+		v := r.LocationCodeableConcept.DeepCopy()
+		out.LocationCodeableConcept = &v
+	}
+	if r.LocationAddress != nil {
+		v := r.LocationAddress.DeepCopy()
+		out.LocationAddress = &v
+	}
+	if r.LocationReference != nil {
+		v := r.LocationReference.DeepCopy()
+		out.LocationReference = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.BodySite != nil {
+		v := r.BodySite.DeepCopy()
+		out.BodySite = &v
+	}
+	if r.SubSite != nil {
+		out.SubSite = make([]CodeableConcept, len(r.SubSite))
+		for i := range r.SubSite {
+			out.SubSite[i] = r.SubSite[i].DeepCopy()
+		}
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ClaimResponseItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.Detail != nil {
+		out.Detail = make([]ClaimResponseAddItemDetail, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseAddItem) Equal(other ClaimResponseAddItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.ItemSequence) != len(other.ItemSequence) {
+		return false
+	}
+	for i := range r.ItemSequence {
+		if r.ItemSequence[i] != other.ItemSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ItemSequenceElement, other.ItemSequenceElement, false) {
+		return false
+	}
+	if len(r.DetailSequence) != len(other.DetailSequence) {
+		return false
+	}
+	for i := range r.DetailSequence {
+		if r.DetailSequence[i] != other.DetailSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DetailSequenceElement, other.DetailSequenceElement, false) {
+		return false
+	}
+	if len(r.SubdetailSequence) != len(other.SubdetailSequence) {
+		return false
+	}
+	for i := range r.SubdetailSequence {
+		if r.SubdetailSequence[i] != other.SubdetailSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SubdetailSequenceElement, other.SubdetailSequenceElement, false) {
+		return false
+	}
+	if len(r.Provider) != len(other.Provider) {
+		return false
+	}
+	for i := range r.Provider {
+		if !r.Provider[i].Equal(other.Provider[i]) {
+			return false
+		}
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if len(r.ProgramCode) != len(other.ProgramCode) {
+		return false
+	}
+	for i := range r.ProgramCode {
+		if !r.ProgramCode[i].Equal(other.ProgramCode[i]) {
+			return false
+		}
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && *r.ServicedDate != *other.ServicedDate {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.Equal(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.Equal(*other.ServicedPeriod) {
+		return false
+	}
+	if (r.LocationCodeableConcept == nil) != (other.LocationCodeableConcept == nil) || r.LocationCodeableConcept != nil && !r.LocationCodeableConcept.Equal(*other.LocationCodeableConcept) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.Equal(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.Equal(*other.LocationReference) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.Equal(*other.BodySite) {
+		return false
+	}
+	if len(r.SubSite) != len(other.SubSite) {
+		return false
+	}
+	for i := range r.SubSite {
+		if !r.SubSite[i].Equal(other.SubSite[i]) {
+			return false
+		}
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseAddItem) EqualsDeep(other ClaimResponseAddItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ItemSequence), len(other.ItemSequence), func(i, j int) bool {
+		return r.ItemSequence[i] == other.ItemSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ItemSequenceElement, other.ItemSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.DetailSequence), len(other.DetailSequence), func(i, j int) bool {
+		return r.DetailSequence[i] == other.DetailSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.DetailSequenceElement, other.DetailSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.SubdetailSequence), len(other.SubdetailSequence), func(i, j int) bool {
+		return r.SubdetailSequence[i] == other.SubdetailSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.SubdetailSequenceElement, other.SubdetailSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Provider), len(other.Provider), func(i, j int) bool {
+		return r.Provider[i].EqualsDeep(other.Provider[j])
+	}) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProgramCode), len(other.ProgramCode), func(i, j int) bool {
+		return r.ProgramCode[i].EqualsDeep(other.ProgramCode[j])
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.EqualsDeep(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.EqualsDeep(*other.ServicedPeriod) {
+		return false
+	}
+	if (r.LocationCodeableConcept == nil) != (other.LocationCodeableConcept == nil) || r.LocationCodeableConcept != nil && !r.LocationCodeableConcept.EqualsDeep(*other.LocationCodeableConcept) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.EqualsDeep(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.EqualsDeep(*other.LocationReference) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.EqualsDeep(*other.BodySite) {
+		return false
+	}
+	if !equivalentList(len(r.SubSite), len(other.SubSite), func(i, j int) bool {
+		return r.SubSite[i].EqualsDeep(other.SubSite[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseAddItem) EqualsShallow(other ClaimResponseAddItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.ItemSequence), len(other.ItemSequence), func(i, j int) bool {
+		return r.ItemSequence[i] == other.ItemSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DetailSequence), len(other.DetailSequence), func(i, j int) bool {
+		return r.DetailSequence[i] == other.DetailSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SubdetailSequence), len(other.SubdetailSequence), func(i, j int) bool {
+		return r.SubdetailSequence[i] == other.SubdetailSequence[j]
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseAddItem as FHIR XML
+func (r ClaimResponseAddItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ItemSequence {
+		if err := encodeXMLPrimitive(e, "itemSequence", v, elementAt(r.ItemSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.DetailSequence {
+		if err := encodeXMLPrimitive(e, "detailSequence", v, elementAt(r.DetailSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.SubdetailSequence {
+		if err := encodeXMLPrimitive(e, "subdetailSequence", v, elementAt(r.SubdetailSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Provider {
+		if err := encodeXMLElement(e, "provider", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProgramCode {
+		if err := encodeXMLElement(e, "programCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "servicedDate", r.ServicedDate, r.ServicedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "servicedPeriod", r.ServicedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationCodeableConcept", r.LocationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationAddress", r.LocationAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationReference", r.LocationReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "bodySite", r.BodySite); err != nil {
+		return err
+	}
+	for _, v := range r.SubSite {
+		if err := encodeXMLElement(e, "subSite", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseAddItem from FHIR XML
+func (r *ClaimResponseAddItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "itemSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ItemSequenceElement = appendElement(r.ItemSequenceElement, len(r.ItemSequence), element)
+					r.ItemSequence = append(r.ItemSequence, v)
+				}
+			case "detailSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DetailSequenceElement = appendElement(r.DetailSequenceElement, len(r.DetailSequence), element)
+					r.DetailSequence = append(r.DetailSequence, v)
+				}
+			case "subdetailSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SubdetailSequenceElement = appendElement(r.SubdetailSequenceElement, len(r.SubdetailSequence), element)
+					r.SubdetailSequence = append(r.SubdetailSequence, v)
+				}
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = append(r.Provider, v)
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "programCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProgramCode = append(r.ProgramCode, v)
+			case "servicedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ServicedDate = &v
+				}
+				r.ServicedDateElement = element
+			case "servicedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServicedPeriod = &v
+			case "locationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationCodeableConcept = &v
+			case "locationAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationAddress = &v
+			case "locationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationReference = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = &v
+			case "subSite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubSite = append(r.SubSite, v)
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ClaimResponseItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "detail":
+				var v ClaimResponseAddItemDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseAddItem as properties to the RDF node n
+func (r ClaimResponseAddItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.ItemSequence {
+		n.primitive("ClaimResponse.addItem.itemSequence", i, v, elementAt(r.ItemSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.DetailSequence {
+		n.primitive("ClaimResponse.addItem.detailSequence", i, v, elementAt(r.DetailSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.SubdetailSequence {
+		n.primitive("ClaimResponse.addItem.subdetailSequence", i, v, elementAt(r.SubdetailSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.Provider {
+		n.element("ClaimResponse.addItem.provider", i, v)
+	}
+	n.element("ClaimResponse.addItem.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("ClaimResponse.addItem.modifier", i, v)
+	}
+	for i, v := range r.ProgramCode {
+		n.element("ClaimResponse.addItem.programCode", i, v)
+	}
+	n.primitive("ClaimResponse.addItem.servicedDate", -1, r.ServicedDate, r.ServicedDateElement, "date")
+	n.element("ClaimResponse.addItem.servicedPeriod", -1, r.ServicedPeriod)
+	n.element("ClaimResponse.addItem.locationCodeableConcept", -1, r.LocationCodeableConcept)
+	n.element("ClaimResponse.addItem.locationAddress", -1, r.LocationAddress)
+	n.element("ClaimResponse.addItem.locationReference", -1, r.LocationReference)
+	n.element("ClaimResponse.addItem.quantity", -1, r.Quantity)
+	n.element("ClaimResponse.addItem.unitPrice", -1, r.UnitPrice)
+	n.primitive("ClaimResponse.addItem.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ClaimResponse.addItem.net", -1, r.Net)
+	n.element("ClaimResponse.addItem.bodySite", -1, r.BodySite)
+	for i, v := range r.SubSite {
+		n.element("ClaimResponse.addItem.subSite", i, v)
+	}
+	for i, v := range r.NoteNumber {
+		n.primitive("ClaimResponse.addItem.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ClaimResponse.addItem.adjudication", i, v)
+	}
+	for i, v := range r.Detail {
+		n.element("ClaimResponse.addItem.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimResponseAddItem as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseAddItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseAddItem from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseAddItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseAddItem as protobuf message ClaimResponseAddItem of fhir.proto
+func (r ClaimResponseAddItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseAddItem to the encoded message
+func (r ClaimResponseAddItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if len(r.ItemSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.ItemSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(4, p.buf)
+	}
+	if len(r.DetailSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.DetailSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(5, p.buf)
+	}
+	if len(r.SubdetailSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.SubdetailSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(6, p.buf)
+	}
+	for _, v := range r.Provider {
+		e.message(7, v)
+	}
+	e.message(8, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(9, v)
+	}
+	for _, v := range r.ProgramCode {
+		e.message(10, v)
+	}
+	if r.ServicedDate != nil {
+		e.string(11, *r.ServicedDate)
+	}
+	if r.ServicedPeriod != nil {
+		e.message(12, *r.ServicedPeriod)
+	}
+	if r.LocationCodeableConcept != nil {
+		e.message(13, *r.LocationCodeableConcept)
+	}
+	if r.LocationAddress != nil {
+		e.message(14, *r.LocationAddress)
+	}
+	if r.LocationReference != nil {
+		e.message(15, *r.LocationReference)
+	}
+	if r.Quantity != nil {
+		e.message(16, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(17, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(18, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(19, *r.Net)
+	}
+	if r.BodySite != nil {
+		e.message(20, *r.BodySite)
+	}
+	for _, v := range r.SubSite {
+		e.message(21, v)
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(22, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(23, v)
+	}
+	for _, v := range r.Detail {
+		e.message(24, v)
+	}
+	for _, v := range r.ItemSequenceElement {
+		e.element(25, v)
+	}
+	for _, v := range r.DetailSequenceElement {
+		e.element(26, v)
+	}
+	for _, v := range r.SubdetailSequenceElement {
+		e.element(27, v)
+	}
+	if r.ServicedDateElement != nil {
+		e.message(28, *r.ServicedDateElement)
+	}
+	if r.FactorElement != nil {
+		e.message(29, *r.FactorElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(30, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseAddItem from protobuf message ClaimResponseAddItem of fhir.proto
+func (r *ClaimResponseAddItem) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseAddItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			for _, v := range d.varints() {
+				r.ItemSequence = append(r.ItemSequence, int(int32(v)))
+			}
+		case 5:
+			for _, v := range d.varints() {
+				r.DetailSequence = append(r.DetailSequence, int(int32(v)))
+			}
+		case 6:
+			for _, v := range d.varints() {
+				r.SubdetailSequence = append(r.SubdetailSequence, int(int32(v)))
+			}
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Provider = append(r.Provider, v)
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProgramCode = append(r.ProgramCode, v)
+		case 11:
+			v := d.string()
+			r.ServicedDate = &v
+		case 12:
+			var v Period
+			d.message(&v)
+			r.ServicedPeriod = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.LocationCodeableConcept = &v
+		case 14:
+			var v Address
+			d.message(&v)
+			r.LocationAddress = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.LocationReference = &v
+		case 16:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 17:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 18:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 19:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = &v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubSite = append(r.SubSite, v)
+		case 22:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 23:
+			var v ClaimResponseItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 24:
+			var v ClaimResponseAddItemDetail
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		case 25:
+			r.ItemSequenceElement = append(r.ItemSequenceElement, d.element())
+		case 26:
+			r.DetailSequenceElement = append(r.DetailSequenceElement, d.element())
+		case 27:
+			r.SubdetailSequenceElement = append(r.SubdetailSequenceElement, d.element())
+		case 28:
+			var v Element
+			d.message(&v)
+			r.ServicedDateElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 30:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseAddItemDetail as JSON into a byte slice
+func (r ClaimResponseAddItemDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseAddItemDetail as JSON object with the members in the order of the fields
+func (r ClaimResponseAddItemDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SubDetail) > 0 {
+		e.key("subDetail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubDetail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseAddItemDetail from JSON. Unknown members are ignored.
+func (r *ClaimResponseAddItemDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseAddItemDetail
+func (r *ClaimResponseAddItemDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ClaimResponseItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ClaimResponseItemAdjudication{}
+			}
+		case "subDetail":
+			a := d.array()
+			r.SubDetail = nil
+			for a.next() {
+				var v ClaimResponseAddItemDetailSubDetail
+				v.decodeJSON(d)
+				r.SubDetail = append(r.SubDetail, v)
+			}
+			if r.SubDetail == nil && !a.isNull {
+				r.SubDetail = []ClaimResponseAddItemDetailSubDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseAddItemDetail which shares no memory with the original
+func (r ClaimResponseAddItemDetail) DeepCopy() ClaimResponseAddItemDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ClaimResponseItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.SubDetail != nil {
+		out.SubDetail = make([]ClaimResponseAddItemDetailSubDetail, len(r.SubDetail))
+		for i := range r.SubDetail {
+			out.SubDetail[i] = r.SubDetail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseAddItemDetail) Equal(other ClaimResponseAddItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.SubDetail) != len(other.SubDetail) {
+		return false
+	}
+	for i := range r.SubDetail {
+		if !r.SubDetail[i].Equal(other.SubDetail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseAddItemDetail) EqualsDeep(other ClaimResponseAddItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SubDetail), len(other.SubDetail), func(i, j int) bool {
+		return r.SubDetail[i].EqualsDeep(other.SubDetail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseAddItemDetail) EqualsShallow(other ClaimResponseAddItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseAddItemDetail as FHIR XML
+func (r ClaimResponseAddItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SubDetail {
+		if err := encodeXMLElement(e, "subDetail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseAddItemDetail from FHIR XML
+func (r *ClaimResponseAddItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ClaimResponseItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "subDetail":
+				var v ClaimResponseAddItemDetailSubDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubDetail = append(r.SubDetail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseAddItemDetail as properties to the RDF node n
+func (r ClaimResponseAddItemDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ClaimResponse.addItem.detail.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("ClaimResponse.addItem.detail.modifier", i, v)
+	}
+	n.element("ClaimResponse.addItem.detail.quantity", -1, r.Quantity)
+	n.element("ClaimResponse.addItem.detail.unitPrice", -1, r.UnitPrice)
+	n.primitive("ClaimResponse.addItem.detail.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ClaimResponse.addItem.detail.net", -1, r.Net)
+	for i, v := range r.NoteNumber {
+		n.primitive("ClaimResponse.addItem.detail.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ClaimResponse.addItem.detail.adjudication", i, v)
+	}
+	for i, v := range r.SubDetail {
+		n.element("ClaimResponse.addItem.detail.subDetail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimResponseAddItemDetail as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseAddItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseAddItemDetail from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseAddItemDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseAddItemDetail as protobuf message ClaimResponseAddItemDetail of fhir.proto
+func (r ClaimResponseAddItemDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseAddItemDetail to the encoded message
+func (r ClaimResponseAddItemDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(5, v)
+	}
+	if r.Quantity != nil {
+		e.message(6, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(7, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(8, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(9, *r.Net)
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(10, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(11, v)
+	}
+	for _, v := range r.SubDetail {
+		e.message(12, v)
+	}
+	if r.FactorElement != nil {
+		e.message(13, *r.FactorElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(14, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseAddItemDetail from protobuf message ClaimResponseAddItemDetail of fhir.proto
+func (r *ClaimResponseAddItemDetail) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseAddItemDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 7:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 8:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 9:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 10:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 11:
+			var v ClaimResponseItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 12:
+			var v ClaimResponseAddItemDetailSubDetail
+			d.message(&v)
+			r.SubDetail = append(r.SubDetail, v)
+		case 13:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 14:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseAddItemDetailSubDetail as JSON into a byte slice
+func (r ClaimResponseAddItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseAddItemDetailSubDetail as JSON object with the members in the order of the fields
+func (r ClaimResponseAddItemDetailSubDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseAddItemDetailSubDetail from JSON. Unknown members are ignored.
+func (r *ClaimResponseAddItemDetailSubDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseAddItemDetailSubDetail
+func (r *ClaimResponseAddItemDetailSubDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ClaimResponseItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ClaimResponseItemAdjudication{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseAddItemDetailSubDetail which shares no memory with the original
+func (r ClaimResponseAddItemDetailSubDetail) DeepCopy() ClaimResponseAddItemDetailSubDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ClaimResponseItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseAddItemDetailSubDetail) Equal(other ClaimResponseAddItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseAddItemDetailSubDetail) EqualsDeep(other ClaimResponseAddItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseAddItemDetailSubDetail) EqualsShallow(other ClaimResponseAddItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseAddItemDetailSubDetail as FHIR XML
+func (r ClaimResponseAddItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseAddItemDetailSubDetail from FHIR XML
+func (r *ClaimResponseAddItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ClaimResponseItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseAddItemDetailSubDetail as properties to the RDF node n
+func (r ClaimResponseAddItemDetailSubDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ClaimResponse.addItem.detail.subDetail.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("ClaimResponse.addItem.detail.subDetail.modifier", i, v)
+	}
+	n.element("ClaimResponse.addItem.detail.subDetail.quantity", -1, r.Quantity)
+	n.element("ClaimResponse.addItem.detail.subDetail.unitPrice", -1, r.UnitPrice)
+	n.primitive("ClaimResponse.addItem.detail.subDetail.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ClaimResponse.addItem.detail.subDetail.net", -1, r.Net)
+	for i, v := range r.NoteNumber {
+		n.primitive("ClaimResponse.addItem.detail.subDetail.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ClaimResponse.addItem.detail.subDetail.adjudication", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClaimResponseAddItemDetailSubDetail as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseAddItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseAddItemDetailSubDetail from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseAddItemDetailSubDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseAddItemDetailSubDetail as protobuf message ClaimResponseAddItemDetailSubDetail of fhir.proto
+func (r ClaimResponseAddItemDetailSubDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseAddItemDetailSubDetail to the encoded message
+func (r ClaimResponseAddItemDetailSubDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(5, v)
+	}
+	if r.Quantity != nil {
+		e.message(6, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(7, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(8, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(9, *r.Net)
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(10, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(11, v)
+	}
+	if r.FactorElement != nil {
+		e.message(12, *r.FactorElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(13, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseAddItemDetailSubDetail from protobuf message ClaimResponseAddItemDetailSubDetail of fhir.proto
+func (r *ClaimResponseAddItemDetailSubDetail) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseAddItemDetailSubDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 7:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 8:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 9:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 10:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 11:
+			var v ClaimResponseItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 13:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseTotal as JSON into a byte slice
+func (r ClaimResponseTotal) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseTotal as JSON object with the members in the order of the fields
+func (r ClaimResponseTotal) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("category")
+	r.Category.appendJSON(e)
+	e.key("amount")
+	r.Amount.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseTotal from JSON. Unknown members are ignored.
+func (r *ClaimResponseTotal) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseTotal
+func (r *ClaimResponseTotal) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			r.Category.decodeJSON(d)
+		case "amount":
+			r.Amount.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseTotal which shares no memory with the original
+func (r ClaimResponseTotal) DeepCopy() ClaimResponseTotal {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Category = r.Category.DeepCopy()
+	out.Amount = r.Amount.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseTotal) Equal(other ClaimResponseTotal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Category.Equal(other.Category) {
+		return false
+	}
+	if !r.Amount.Equal(other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseTotal) EqualsDeep(other ClaimResponseTotal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Category.EqualsDeep(other.Category) {
+		return false
+	}
+	if !r.Amount.EqualsDeep(other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseTotal) EqualsShallow(other ClaimResponseTotal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseTotal as FHIR XML
+func (r ClaimResponseTotal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseTotal from FHIR XML
+func (r *ClaimResponseTotal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = v
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseTotal as properties to the RDF node n
+func (r ClaimResponseTotal) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ClaimResponse.total.category", -1, r.Category)
+	n.element("ClaimResponse.total.amount", -1, r.Amount)
+}
+
+// MarshalBSON marshals the given ClaimResponseTotal as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseTotal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseTotal from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseTotal) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseTotal as protobuf message ClaimResponseTotal of fhir.proto
+func (r ClaimResponseTotal) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseTotal to the encoded message
+func (r ClaimResponseTotal) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Category)
+	e.message(5, r.Amount)
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseTotal from protobuf message ClaimResponseTotal of fhir.proto
+func (r *ClaimResponseTotal) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseTotal{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = v
+		case 5:
+			var v Money
+			d.message(&v)
+			r.Amount = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponsePayment as JSON into a byte slice
+func (r ClaimResponsePayment) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponsePayment as JSON object with the members in the order of the fields
+func (r ClaimResponsePayment) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.Adjustment != nil {
+		e.key("adjustment")
+		r.Adjustment.appendJSON(e)
+	}
+	if r.AdjustmentReason != nil {
+		e.key("adjustmentReason")
+		r.AdjustmentReason.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	e.key("amount")
+	r.Amount.appendJSON(e)
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponsePayment from JSON. Unknown members are ignored.
+func (r *ClaimResponsePayment) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponsePayment
+func (r *ClaimResponsePayment) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "adjustment":
+			if d.null() {
+				r.Adjustment = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Adjustment = &v
+			}
+		case "adjustmentReason":
+			if d.null() {
+				r.AdjustmentReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdjustmentReason = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "amount":
+			r.Amount.decodeJSON(d)
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponsePayment which shares no memory with the original
+func (r ClaimResponsePayment) DeepCopy() ClaimResponsePayment {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Adjustment != nil {
+		v := r.Adjustment.DeepCopy()
+		out.Adjustment = &v
+	}
+	if r.AdjustmentReason != nil {
+		v := r.AdjustmentReason.DeepCopy()
+		out.AdjustmentReason = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	out.Amount = r.Amount.DeepCopy()
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponsePayment) Equal(other ClaimResponsePayment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.Adjustment == nil) != (other.Adjustment == nil) || r.Adjustment != nil && !r.Adjustment.Equal(*other.Adjustment) {
+		return false
+	}
+	if (r.AdjustmentReason == nil) != (other.AdjustmentReason == nil) || r.AdjustmentReason != nil && !r.AdjustmentReason.Equal(*other.AdjustmentReason) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if !r.Amount.Equal(other.Amount) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponsePayment) EqualsDeep(other ClaimResponsePayment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.Adjustment == nil) != (other.Adjustment == nil) || r.Adjustment != nil && !r.Adjustment.EqualsDeep(*other.Adjustment) {
+		return false
+	}
+	if (r.AdjustmentReason == nil) != (other.AdjustmentReason == nil) || r.AdjustmentReason != nil && !r.AdjustmentReason.EqualsDeep(*other.AdjustmentReason) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if !r.Amount.EqualsDeep(other.Amount) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponsePayment) EqualsShallow(other ClaimResponsePayment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponsePayment as FHIR XML
+func (r ClaimResponsePayment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "adjustment", r.Adjustment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "adjustmentReason", r.AdjustmentReason); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponsePayment from FHIR XML
+func (r *ClaimResponsePayment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "adjustment":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjustment = &v
+			case "adjustmentReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdjustmentReason = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = v
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponsePayment as properties to the RDF node n
+func (r ClaimResponsePayment) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ClaimResponse.payment.type", -1, r.Type)
+	n.element("ClaimResponse.payment.adjustment", -1, r.Adjustment)
+	n.element("ClaimResponse.payment.adjustmentReason", -1, r.AdjustmentReason)
+	n.primitive("ClaimResponse.payment.date", -1, r.Date, r.DateElement, "date")
+	n.element("ClaimResponse.payment.amount", -1, r.Amount)
+	n.element("ClaimResponse.payment.identifier", -1, r.Identifier)
+}
+
+// MarshalBSON marshals the given ClaimResponsePayment as BSON document with the structure of its FHIR JSON
+func (r ClaimResponsePayment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponsePayment from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponsePayment) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponsePayment as protobuf message ClaimResponsePayment of fhir.proto
+func (r ClaimResponsePayment) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponsePayment to the encoded message
+func (r ClaimResponsePayment) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.Adjustment != nil {
+		e.message(5, *r.Adjustment)
+	}
+	if r.AdjustmentReason != nil {
+		e.message(6, *r.AdjustmentReason)
+	}
+	if r.Date != nil {
+		e.string(7, *r.Date)
+	}
+	e.message(8, r.Amount)
+	if r.Identifier != nil {
+		e.message(9, *r.Identifier)
+	}
+	if r.DateElement != nil {
+		e.message(10, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponsePayment from protobuf message ClaimResponsePayment of fhir.proto
+func (r *ClaimResponsePayment) UnmarshalProto(b []byte) error {
+	*r = ClaimResponsePayment{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v Money
+			d.message(&v)
+			r.Adjustment = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdjustmentReason = &v
+		case 7:
+			v := d.string()
+			r.Date = &v
+		case 8:
+			var v Money
+			d.message(&v)
+			r.Amount = v
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseProcessNote as JSON into a byte slice
+func (r ClaimResponseProcessNote) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseProcessNote as JSON object with the members in the order of the fields
+func (r ClaimResponseProcessNote) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Number != nil {
+		e.key("number")
+		e.int(*r.Number)
+	}
+	if r.NumberElement != nil {
+		e.key("_number")
+		r.NumberElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(r.Type.Code())
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("text")
+	e.string(r.Text)
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		r.Language.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseProcessNote from JSON. Unknown members are ignored.
+func (r *ClaimResponseProcessNote) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseProcessNote
+func (r *ClaimResponseProcessNote) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "number":
+			if v, ok := d.int(); ok {
+				r.Number = &v
+			} else {
+				r.Number = nil
+			}
+		case "_number":
+			r.NumberElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v NoteType
+				d.unmarshal(&v)
+				r.Type = &v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = v
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "language":
+			if d.null() {
+				r.Language = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Language = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseProcessNote which shares no memory with the original
+func (r ClaimResponseProcessNote) DeepCopy() ClaimResponseProcessNote {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Number != nil {
+		v := *r.Number
+		out.Number = &v
+	}
+	if r.NumberElement != nil {
+		v := r.NumberElement.DeepCopy()
+		out.NumberElement = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.Language != nil {
+		v := r.Language.DeepCopy()
+		out.Language = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseProcessNote) Equal(other ClaimResponseProcessNote) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.Equal(*other.NumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.Text != other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !r.Language.Equal(*other.Language) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseProcessNote) EqualsDeep(other ClaimResponseProcessNote) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.EqualsDeep(*other.NumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentString(r.Text, other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !r.Language.EqualsDeep(*other.Language) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseProcessNote) EqualsShallow(other ClaimResponseProcessNote) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if !equivalentString(r.Text, other.Text) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseProcessNote as FHIR XML
+func (r ClaimResponseProcessNote) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "number", r.Number, r.NumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "language", r.Language); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseProcessNote from FHIR XML
+func (r *ClaimResponseProcessNote) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "number":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Number = &v
+				}
+				r.NumberElement = element
+			case "type":
+				var v NoteType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = v
+				}
+				r.TextElement = element
+			case "language":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Language = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseProcessNote as properties to the RDF node n
+func (r ClaimResponseProcessNote) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ClaimResponse.processNote.number", -1, r.Number, r.NumberElement, "positiveInt")
+	n.primitive("ClaimResponse.processNote.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("ClaimResponse.processNote.text", -1, r.Text, r.TextElement, "string")
+	n.element("ClaimResponse.processNote.language", -1, r.Language)
+}
+
+// MarshalBSON marshals the given ClaimResponseProcessNote as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseProcessNote) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseProcessNote from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseProcessNote) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseProcessNote as protobuf message ClaimResponseProcessNote of fhir.proto
+func (r ClaimResponseProcessNote) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseProcessNote to the encoded message
+func (r ClaimResponseProcessNote) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Number != nil {
+		e.varint(4, uint64(int64(*r.Number)))
+	}
+	if r.Type != nil {
+		e.varint(5, r.Type.protoNumber())
+	}
+	if r.Text != "" {
+		e.string(6, r.Text)
+	}
+	if r.Language != nil {
+		e.message(7, *r.Language)
+	}
+	if r.NumberElement != nil {
+		e.message(8, *r.NumberElement)
+	}
+	if r.TypeElement != nil {
+		e.message(9, *r.TypeElement)
+	}
+	if r.TextElement != nil {
+		e.message(10, *r.TextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseProcessNote from protobuf message ClaimResponseProcessNote of fhir.proto
+func (r *ClaimResponseProcessNote) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseProcessNote{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Number = &v
+		case 5:
+			var v NoteType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = &v
+		case 6:
+			r.Text = d.string()
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Language = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.NumberElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseInsurance as JSON into a byte slice
+func (r ClaimResponseInsurance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseInsurance as JSON object with the members in the order of the fields
+func (r ClaimResponseInsurance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	e.key("focal")
+	e.bool(r.Focal)
+	if r.FocalElement != nil {
+		e.key("_focal")
+		r.FocalElement.appendJSON(e)
+	}
+	e.key("coverage")
+	r.Coverage.appendJSON(e)
+	if r.BusinessArrangement != nil {
+		e.key("businessArrangement")
+		e.string(*r.BusinessArrangement)
+	}
+	if r.BusinessArrangementElement != nil {
+		e.key("_businessArrangement")
+		r.BusinessArrangementElement.appendJSON(e)
+	}
+	if r.ClaimResponse != nil {
+		e.key("claimResponse")
+		r.ClaimResponse.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseInsurance from JSON. Unknown members are ignored.
+func (r *ClaimResponseInsurance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseInsurance
+func (r *ClaimResponseInsurance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "focal":
+			if v, ok := d.bool(); ok {
+				r.Focal = v
+			}
+		case "_focal":
+			r.FocalElement = d.element()
+		case "coverage":
+			r.Coverage.decodeJSON(d)
+		case "businessArrangement":
+			if v, ok := d.string(); ok {
+				r.BusinessArrangement = &v
+			} else {
+				r.BusinessArrangement = nil
+			}
+		case "_businessArrangement":
+			r.BusinessArrangementElement = d.element()
+		case "claimResponse":
+			if d.null() {
+				r.ClaimResponse = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ClaimResponse = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseInsurance which shares no memory with the original
+func (r ClaimResponseInsurance) DeepCopy() ClaimResponseInsurance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.FocalElement != nil {
+		v := r.FocalElement.DeepCopy()
+		out.FocalElement = &v
+	}
+	out.Coverage = r.Coverage.DeepCopy()
+	if r.BusinessArrangement != nil {
+		v := *r.BusinessArrangement
+		out.BusinessArrangement = &v
+	}
+	if r.BusinessArrangementElement != nil {
+		v := r.BusinessArrangementElement.DeepCopy()
+		out.BusinessArrangementElement = &v
+	}
+	if r.ClaimResponse != nil {
+		v := r.ClaimResponse.DeepCopy()
+		out.ClaimResponse = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseInsurance) Equal(other ClaimResponseInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if r.Focal != other.Focal {
+		return false
+	}
+	if (r.FocalElement == nil) != (other.FocalElement == nil) || r.FocalElement != nil && !r.FocalElement.Equal(*other.FocalElement) {
+		return false
+	}
+	if !r.Coverage.Equal(other.Coverage) {
+		return false
+	}
+	if (r.BusinessArrangement == nil) != (other.BusinessArrangement == nil) || r.BusinessArrangement != nil && *r.BusinessArrangement != *other.BusinessArrangement {
+		return false
+	}
+	if (r.BusinessArrangementElement == nil) != (other.BusinessArrangementElement == nil) || r.BusinessArrangementElement != nil && !r.BusinessArrangementElement.Equal(*other.BusinessArrangementElement) {
+		return false
+	}
+	if (r.ClaimResponse == nil) != (other.ClaimResponse == nil) || r.ClaimResponse != nil && !r.ClaimResponse.Equal(*other.ClaimResponse) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseInsurance) EqualsDeep(other ClaimResponseInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if r.Focal != other.Focal {
+		return false
+	}
+	if (r.FocalElement == nil) != (other.FocalElement == nil) || r.FocalElement != nil && !r.FocalElement.EqualsDeep(*other.FocalElement) {
+		return false
+	}
+	if !r.Coverage.EqualsDeep(other.Coverage) {
+		return false
+	}
+	if (r.BusinessArrangement == nil) != (other.BusinessArrangement == nil) || r.BusinessArrangement != nil && !equivalentString(*r.BusinessArrangement, *other.BusinessArrangement) {
+		return false
+	}
+	if (r.BusinessArrangementElement == nil) != (other.BusinessArrangementElement == nil) || r.BusinessArrangementElement != nil && !r.BusinessArrangementElement.EqualsDeep(*other.BusinessArrangementElement) {
+		return false
+	}
+	if (r.ClaimResponse == nil) != (other.ClaimResponse == nil) || r.ClaimResponse != nil && !r.ClaimResponse.EqualsDeep(*other.ClaimResponse) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseInsurance) EqualsShallow(other ClaimResponseInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if r.Focal != other.Focal {
+		return false
+	}
+	if (r.BusinessArrangement == nil) != (other.BusinessArrangement == nil) || r.BusinessArrangement != nil && !equivalentString(*r.BusinessArrangement, *other.BusinessArrangement) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseInsurance as FHIR XML
+func (r ClaimResponseInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "focal", r.Focal, r.FocalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "coverage", r.Coverage); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "businessArrangement", r.BusinessArrangement, r.BusinessArrangementElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "claimResponse", r.ClaimResponse); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseInsurance from FHIR XML
+func (r *ClaimResponseInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "focal":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Focal = v
+				}
+				r.FocalElement = element
+			case "coverage":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coverage = v
+			case "businessArrangement":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BusinessArrangement = &v
+				}
+				r.BusinessArrangementElement = element
+			case "claimResponse":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ClaimResponse = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseInsurance as properties to the RDF node n
+func (r ClaimResponseInsurance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ClaimResponse.insurance.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.primitive("ClaimResponse.insurance.focal", -1, r.Focal, r.FocalElement, "boolean")
+	n.element("ClaimResponse.insurance.coverage", -1, r.Coverage)
+	n.primitive("ClaimResponse.insurance.businessArrangement", -1, r.BusinessArrangement, r.BusinessArrangementElement, "string")
+	n.element("ClaimResponse.insurance.claimResponse", -1, r.ClaimResponse)
+}
+
+// MarshalBSON marshals the given ClaimResponseInsurance as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseInsurance from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseInsurance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseInsurance as protobuf message ClaimResponseInsurance of fhir.proto
+func (r ClaimResponseInsurance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseInsurance to the encoded message
+func (r ClaimResponseInsurance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if r.Focal {
+		e.varint(5, protoBool(r.Focal))
+	}
+	e.message(6, r.Coverage)
+	if r.BusinessArrangement != nil {
+		e.string(7, *r.BusinessArrangement)
+	}
+	if r.ClaimResponse != nil {
+		e.message(8, *r.ClaimResponse)
+	}
+	if r.SequenceElement != nil {
+		e.message(9, *r.SequenceElement)
+	}
+	if r.FocalElement != nil {
+		e.message(10, *r.FocalElement)
+	}
+	if r.BusinessArrangementElement != nil {
+		e.message(11, *r.BusinessArrangementElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseInsurance from protobuf message ClaimResponseInsurance of fhir.proto
+func (r *ClaimResponseInsurance) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseInsurance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			r.Focal = d.bool()
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Coverage = v
+		case 7:
+			v := d.string()
+			r.BusinessArrangement = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.ClaimResponse = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.FocalElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.BusinessArrangementElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClaimResponseError as JSON into a byte slice
+func (r ClaimResponseError) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClaimResponseError as JSON object with the members in the order of the fields
+func (r ClaimResponseError) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ItemSequence != nil {
+		e.key("itemSequence")
+		e.int(*r.ItemSequence)
+	}
+	if r.ItemSequenceElement != nil {
+		e.key("_itemSequence")
+		r.ItemSequenceElement.appendJSON(e)
+	}
+	if r.DetailSequence != nil {
+		e.key("detailSequence")
+		e.int(*r.DetailSequence)
+	}
+	if r.DetailSequenceElement != nil {
+		e.key("_detailSequence")
+		r.DetailSequenceElement.appendJSON(e)
+	}
+	if r.SubDetailSequence != nil {
+		e.key("subDetailSequence")
+		e.int(*r.SubDetailSequence)
+	}
+	if r.SubDetailSequenceElement != nil {
+		e.key("_subDetailSequence")
+		r.SubDetailSequenceElement.appendJSON(e)
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClaimResponseError from JSON. Unknown members are ignored.
+func (r *ClaimResponseError) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClaimResponseError
+func (r *ClaimResponseError) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "itemSequence":
+			if v, ok := d.int(); ok {
+				r.ItemSequence = &v
+			} else {
+				r.ItemSequence = nil
+			}
+		case "_itemSequence":
+			r.ItemSequenceElement = d.element()
+		case "detailSequence":
+			if v, ok := d.int(); ok {
+				r.DetailSequence = &v
+			} else {
+				r.DetailSequence = nil
+			}
+		case "_detailSequence":
+			r.DetailSequenceElement = d.element()
+		case "subDetailSequence":
+			if v, ok := d.int(); ok {
+				r.SubDetailSequence = &v
+			} else {
+				r.SubDetailSequence = nil
+			}
+		case "_subDetailSequence":
+			r.SubDetailSequenceElement = d.element()
+		case "code":
+			r.Code.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClaimResponseError which shares no memory with the original
+func (r ClaimResponseError) DeepCopy() ClaimResponseError {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ItemSequence != nil {
+		v := *r.ItemSequence
+		out.ItemSequence = &v
+	}
+	if r.ItemSequenceElement != nil {
+		v := r.ItemSequenceElement.DeepCopy()
+		out.ItemSequenceElement = &v
+	}
+	if r.DetailSequence != nil {
+		v := *r.DetailSequence
+		out.DetailSequence = &v
+	}
+	if r.DetailSequenceElement != nil {
+		v := r.DetailSequenceElement.DeepCopy()
+		out.DetailSequenceElement = &v
+	}
+	if r.SubDetailSequence != nil {
+		v := *r.SubDetailSequence
+		out.SubDetailSequence = &v
+	}
+	if r.SubDetailSequenceElement != nil {
+		v := r.SubDetailSequenceElement.DeepCopy()
+		out.SubDetailSequenceElement = &v
+	}
+	out.Code = r.Code.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClaimResponseError) Equal(other ClaimResponseError) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ItemSequence == nil) != (other.ItemSequence == nil) || r.ItemSequence != nil && *r.ItemSequence != *other.ItemSequence {
+		return false
+	}
+	if (r.ItemSequenceElement == nil) != (other.ItemSequenceElement == nil) || r.ItemSequenceElement != nil && !r.ItemSequenceElement.Equal(*other.ItemSequenceElement) {
+		return false
+	}
+	if (r.DetailSequence == nil) != (other.DetailSequence == nil) || r.DetailSequence != nil && *r.DetailSequence != *other.DetailSequence {
+		return false
+	}
+	if (r.DetailSequenceElement == nil) != (other.DetailSequenceElement == nil) || r.DetailSequenceElement != nil && !r.DetailSequenceElement.Equal(*other.DetailSequenceElement) {
+		return false
+	}
+	if (r.SubDetailSequence == nil) != (other.SubDetailSequence == nil) || r.SubDetailSequence != nil && *r.SubDetailSequence != *other.SubDetailSequence {
+		return false
+	}
+	if (r.SubDetailSequenceElement == nil) != (other.SubDetailSequenceElement == nil) || r.SubDetailSequenceElement != nil && !r.SubDetailSequenceElement.Equal(*other.SubDetailSequenceElement) {
+		return false
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClaimResponseError) EqualsDeep(other ClaimResponseError) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ItemSequence == nil) != (other.ItemSequence == nil) || r.ItemSequence != nil && *r.ItemSequence != *other.ItemSequence {
+		return false
+	}
+	if (r.ItemSequenceElement == nil) != (other.ItemSequenceElement == nil) || r.ItemSequenceElement != nil && !r.ItemSequenceElement.EqualsDeep(*other.ItemSequenceElement) {
+		return false
+	}
+	if (r.DetailSequence == nil) != (other.DetailSequence == nil) || r.DetailSequence != nil && *r.DetailSequence != *other.DetailSequence {
+		return false
+	}
+	if (r.DetailSequenceElement == nil) != (other.DetailSequenceElement == nil) || r.DetailSequenceElement != nil && !r.DetailSequenceElement.EqualsDeep(*other.DetailSequenceElement) {
+		return false
+	}
+	if (r.SubDetailSequence == nil) != (other.SubDetailSequence == nil) || r.SubDetailSequence != nil && *r.SubDetailSequence != *other.SubDetailSequence {
+		return false
+	}
+	if (r.SubDetailSequenceElement == nil) != (other.SubDetailSequenceElement == nil) || r.SubDetailSequenceElement != nil && !r.SubDetailSequenceElement.EqualsDeep(*other.SubDetailSequenceElement) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClaimResponseError) EqualsShallow(other ClaimResponseError) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ItemSequence == nil) != (other.ItemSequence == nil) || r.ItemSequence != nil && *r.ItemSequence != *other.ItemSequence {
+		return false
+	}
+	if (r.DetailSequence == nil) != (other.DetailSequence == nil) || r.DetailSequence != nil && *r.DetailSequence != *other.DetailSequence {
+		return false
+	}
+	if (r.SubDetailSequence == nil) != (other.SubDetailSequence == nil) || r.SubDetailSequence != nil && *r.SubDetailSequence != *other.SubDetailSequence {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClaimResponseError as FHIR XML
+func (r ClaimResponseError) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "itemSequence", r.ItemSequence, r.ItemSequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "detailSequence", r.DetailSequence, r.DetailSequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "subDetailSequence", r.SubDetailSequence, r.SubDetailSequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClaimResponseError from FHIR XML
+func (r *ClaimResponseError) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "itemSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ItemSequence = &v
+				}
+				r.ItemSequenceElement = element
+			case "detailSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DetailSequence = &v
+				}
+				r.DetailSequenceElement = element
+			case "subDetailSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SubDetailSequence = &v
+				}
+				r.SubDetailSequenceElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClaimResponseError as properties to the RDF node n
+func (r ClaimResponseError) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ClaimResponse.error.itemSequence", -1, r.ItemSequence, r.ItemSequenceElement, "positiveInt")
+	n.primitive("ClaimResponse.error.detailSequence", -1, r.DetailSequence, r.DetailSequenceElement, "positiveInt")
+	n.primitive("ClaimResponse.error.subDetailSequence", -1, r.SubDetailSequence, r.SubDetailSequenceElement, "positiveInt")
+	n.element("ClaimResponse.error.code", -1, r.Code)
+}
+
+// MarshalBSON marshals the given ClaimResponseError as BSON document with the structure of its FHIR JSON
+func (r ClaimResponseError) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClaimResponseError from a BSON document with the structure of its FHIR JSON
+func (r *ClaimResponseError) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClaimResponseError as protobuf message ClaimResponseError of fhir.proto
+func (r ClaimResponseError) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClaimResponseError to the encoded message
+func (r ClaimResponseError) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ItemSequence != nil {
+		e.varint(4, uint64(int64(*r.ItemSequence)))
+	}
+	if r.DetailSequence != nil {
+		e.varint(5, uint64(int64(*r.DetailSequence)))
+	}
+	if r.SubDetailSequence != nil {
+		e.varint(6, uint64(int64(*r.SubDetailSequence)))
+	}
+	e.message(7, r.Code)
+	if r.ItemSequenceElement != nil {
+		e.message(8, *r.ItemSequenceElement)
+	}
+	if r.DetailSequenceElement != nil {
+		e.message(9, *r.DetailSequenceElement)
+	}
+	if r.SubDetailSequenceElement != nil {
+		e.message(10, *r.SubDetailSequenceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClaimResponseError from protobuf message ClaimResponseError of fhir.proto
+func (r *ClaimResponseError) UnmarshalProto(b []byte) error {
+	*r = ClaimResponseError{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.ItemSequence = &v
+		case 5:
+			v := d.int()
+			r.DetailSequence = &v
+		case 6:
+			v := d.int()
+			r.SubDetailSequence = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ItemSequenceElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DetailSequenceElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.SubDetailSequenceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalClaimResponse unmarshals a ClaimResponse.
 func UnmarshalClaimResponse(b []byte) (ClaimResponse, error) {
 	var claimResponse ClaimResponse
-	if err := json.Unmarshal(b, &claimResponse); err != nil {
+	if err := claimResponse.UnmarshalJSON(b); err != nil {
 		return claimResponse, err
 	}
 	return claimResponse, nil
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,34 +25,81 @@ import "encoding/json"
 // ClinicalImpression is documented here http://hl7.org/fhir/StructureDefinition/ClinicalImpression
 type ClinicalImpression struct {
 	Id                       *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                *Element                          `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                     *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules            *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                 *string                           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                          `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                     *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                []json.RawMessage                 `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier               []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status                   ClinicalImpressionStatus          `bson:"status" json:"status"`
+	StatusElement            *Element                          `bson:"_status,omitempty" json:"_status,omitempty"`
 	StatusReason             *CodeableConcept                  `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
 	Code                     *CodeableConcept                  `bson:"code,omitempty" json:"code,omitempty"`
 	Description              *string                           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement       *Element                          `bson:"_description,omitempty" json:"_description,omitempty"`
 	Subject                  Reference                         `bson:"subject" json:"subject"`
 	Encounter                *Reference                        `bson:"encounter,omitempty" json:"encounter,omitempty"`
 	EffectiveDateTime        *string                           `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *Element                          `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
 	EffectivePeriod          *Period                           `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
 	Date                     *string                           `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement              *Element                          `bson:"_date,omitempty" json:"_date,omitempty"`
 	Assessor                 *Reference                        `bson:"assessor,omitempty" json:"assessor,omitempty"`
 	Previous                 *Reference                        `bson:"previous,omitempty" json:"previous,omitempty"`
 	Problem                  []Reference                       `bson:"problem,omitempty" json:"problem,omitempty"`
 	Investigation            []ClinicalImpressionInvestigation `bson:"investigation,omitempty" json:"investigation,omitempty"`
 	Protocol                 []string                          `bson:"protocol,omitempty" json:"protocol,omitempty"`
+	ProtocolElement          []*Element                        `bson:"_protocol,omitempty" json:"_protocol,omitempty"`
 	Summary                  *string                           `bson:"summary,omitempty" json:"summary,omitempty"`
+	SummaryElement           *Element                          `bson:"_summary,omitempty" json:"_summary,omitempty"`
 	Finding                  []ClinicalImpressionFinding       `bson:"finding,omitempty" json:"finding,omitempty"`
 	PrognosisCodeableConcept []CodeableConcept                 `bson:"prognosisCodeableConcept,omitempty" json:"prognosisCodeableConcept,omitempty"`
 	PrognosisReference       []Reference                       `bson:"prognosisReference,omitempty" json:"prognosisReference,omitempty"`
 	SupportingInfo           []Reference                       `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
 	Note                     []Annotation                      `bson:"note,omitempty" json:"note,omitempty"`
 }
+
+// ClinicalImpressionEffective is implemented by the types allowed for ClinicalImpression.effective[x]
+type ClinicalImpressionEffective interface {
+	isClinicalImpressionEffective()
+}
+
+func (DateTime) isClinicalImpressionEffective() {}
+func (Period) isClinicalImpressionEffective()   {}
+
+// Effective returns the value of ClinicalImpression.effective[x] together with its FHIR type code or nil if no value is set
+func (r ClinicalImpression) Effective() (ClinicalImpressionEffective, string) {
+	if r.EffectiveDateTime != nil {
+		return DateTime(*r.EffectiveDateTime), "dateTime"
+	}
+	if r.EffectivePeriod != nil {
+		return *r.EffectivePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetEffective sets the value of ClinicalImpression.effective[x], given as value or pointer, and clears all other types
+func (r *ClinicalImpression) SetEffective(value ClinicalImpressionEffective) {
+	r.EffectiveDateTime = nil
+	r.EffectivePeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.EffectiveDateTime = &primitive
+	case *DateTime:
+		r.EffectiveDateTime = (*string)(v)
+	case Period:
+		r.EffectivePeriod = &v
+	case *Period:
+		r.EffectivePeriod = v
+	}
+}
+
 type ClinicalImpressionInvestigation struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -64,24 +114,2581 @@ type ClinicalImpressionFinding struct {
 	ItemCodeableConcept *CodeableConcept `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
 	ItemReference       *Reference       `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
 	Basis               *string          `bson:"basis,omitempty" json:"basis,omitempty"`
+	BasisElement        *Element         `bson:"_basis,omitempty" json:"_basis,omitempty"`
+}
+
+// ClinicalImpressionFindingItem is implemented by the types allowed for ClinicalImpression.finding.item[x]
+type ClinicalImpressionFindingItem interface {
+	isClinicalImpressionFindingItem()
+}
+
+func (CodeableConcept) isClinicalImpressionFindingItem() {}
+func (Reference) isClinicalImpressionFindingItem()       {}
+
+// Item returns the value of ClinicalImpression.finding.item[x] together with its FHIR type code or nil if no value is set
+func (r ClinicalImpressionFinding) Item() (ClinicalImpressionFindingItem, string) {
+	if r.ItemCodeableConcept != nil {
+		return *r.ItemCodeableConcept, "CodeableConcept"
+	}
+	if r.ItemReference != nil {
+		return *r.ItemReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetItem sets the value of ClinicalImpression.finding.item[x], given as value or pointer, and clears all other types
+func (r *ClinicalImpressionFinding) SetItem(value ClinicalImpressionFindingItem) {
+	r.ItemCodeableConcept = nil
+	r.ItemReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ItemCodeableConcept = &v
+	case *CodeableConcept:
+		r.ItemCodeableConcept = v
+	case Reference:
+		r.ItemReference = &v
+	case *Reference:
+		r.ItemReference = v
+	}
 }
-type OtherClinicalImpression ClinicalImpression
 
 // MarshalJSON marshals the given ClinicalImpression as JSON into a byte slice
 func (r ClinicalImpression) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherClinicalImpression
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherClinicalImpression: OtherClinicalImpression(r),
-		ResourceType:            "ClinicalImpression",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClinicalImpression as JSON object with the members in the order of the fields
+func (r ClinicalImpression) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ClinicalImpression\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusReason != nil {
+		e.key("statusReason")
+		r.StatusReason.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.EffectiveDateTime != nil {
+		e.key("effectiveDateTime")
+		e.string(*r.EffectiveDateTime)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.key("_effectiveDateTime")
+		r.EffectiveDateTimeElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Assessor != nil {
+		e.key("assessor")
+		r.Assessor.appendJSON(e)
+	}
+	if r.Previous != nil {
+		e.key("previous")
+		r.Previous.appendJSON(e)
+	}
+	if len(r.Problem) > 0 {
+		e.key("problem")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Problem {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Investigation) > 0 {
+		e.key("investigation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Investigation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Protocol) > 0 {
+		e.key("protocol")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Protocol {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProtocolElement) > 0 {
+		e.key("_protocol")
+		e.elements(r.ProtocolElement, len(r.Protocol))
+	}
+	if r.Summary != nil {
+		e.key("summary")
+		e.string(*r.Summary)
+	}
+	if r.SummaryElement != nil {
+		e.key("_summary")
+		r.SummaryElement.appendJSON(e)
+	}
+	if len(r.Finding) > 0 {
+		e.key("finding")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Finding {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PrognosisCodeableConcept) > 0 {
+		e.key("prognosisCodeableConcept")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PrognosisCodeableConcept {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PrognosisReference) > 0 {
+		e.key("prognosisReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PrognosisReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInfo) > 0 {
+		e.key("supportingInfo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInfo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClinicalImpression from JSON. Unknown members are ignored.
+func (r *ClinicalImpression) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClinicalImpression
+func (r *ClinicalImpression) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			if d.null() {
+				r.StatusReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = &v
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "effectiveDateTime":
+			if v, ok := d.string(); ok {
+				r.EffectiveDateTime = &v
+			} else {
+				r.EffectiveDateTime = nil
+			}
+		case "_effectiveDateTime":
+			r.EffectiveDateTimeElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "assessor":
+			if d.null() {
+				r.Assessor = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Assessor = &v
+			}
+		case "previous":
+			if d.null() {
+				r.Previous = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Previous = &v
+			}
+		case "problem":
+			a := d.array()
+			r.Problem = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Problem = append(r.Problem, v)
+			}
+			if r.Problem == nil && !a.isNull {
+				r.Problem = []Reference{}
+			}
+		case "investigation":
+			a := d.array()
+			r.Investigation = nil
+			for a.next() {
+				var v ClinicalImpressionInvestigation
+				v.decodeJSON(d)
+				r.Investigation = append(r.Investigation, v)
+			}
+			if r.Investigation == nil && !a.isNull {
+				r.Investigation = []ClinicalImpressionInvestigation{}
+			}
+		case "protocol":
+			a := d.array()
+			r.Protocol = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Protocol = append(r.Protocol, v)
+			}
+			if r.Protocol == nil && !a.isNull {
+				r.Protocol = []string{}
+			}
+		case "_protocol":
+			a := d.array()
+			r.ProtocolElement = nil
+			for a.next() {
+				r.ProtocolElement = append(r.ProtocolElement, d.element())
+			}
+		case "summary":
+			if v, ok := d.string(); ok {
+				r.Summary = &v
+			} else {
+				r.Summary = nil
+			}
+		case "_summary":
+			r.SummaryElement = d.element()
+		case "finding":
+			a := d.array()
+			r.Finding = nil
+			for a.next() {
+				var v ClinicalImpressionFinding
+				v.decodeJSON(d)
+				r.Finding = append(r.Finding, v)
+			}
+			if r.Finding == nil && !a.isNull {
+				r.Finding = []ClinicalImpressionFinding{}
+			}
+		case "prognosisCodeableConcept":
+			a := d.array()
+			r.PrognosisCodeableConcept = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PrognosisCodeableConcept = append(r.PrognosisCodeableConcept, v)
+			}
+			if r.PrognosisCodeableConcept == nil && !a.isNull {
+				r.PrognosisCodeableConcept = []CodeableConcept{}
+			}
+		case "prognosisReference":
+			a := d.array()
+			r.PrognosisReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PrognosisReference = append(r.PrognosisReference, v)
+			}
+			if r.PrognosisReference == nil && !a.isNull {
+				r.PrognosisReference = []Reference{}
+			}
+		case "supportingInfo":
+			a := d.array()
+			r.SupportingInfo = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			}
+			if r.SupportingInfo == nil && !a.isNull {
+				r.SupportingInfo = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClinicalImpression which shares no memory with the original
+func (r ClinicalImpression) DeepCopy() ClinicalImpression {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		v := r.StatusReason.DeepCopy()
+		out.StatusReason = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.EffectiveDateTime != nil {
+		v := *r.EffectiveDateTime
+		out.EffectiveDateTime = &v
+	}
+	if r.EffectiveDateTimeElement != nil {
+		v := r.EffectiveDateTimeElement.DeepCopy()
+		out.EffectiveDateTimeElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Assessor != nil {
+		v := r.Assessor.DeepCopy()
+		out.Assessor = &v
+	}
+	if r.Previous != nil {
+		v := r.Previous.DeepCopy()
+		out.Previous = &v
+	}
+	if r.Problem != nil {
+		out.Problem = make([]Reference, len(r.Problem))
+		for i := range r.Problem {
+			out.Problem[i] = r.Problem[i].DeepCopy()
+		}
+	}
+	if r.Investigation != nil {
+		out.Investigation = make([]ClinicalImpressionInvestigation, len(r.Investigation))
+		for i := range r.Investigation {
+			out.Investigation[i] = r.Investigation[i].DeepCopy()
+		}
+	}
+	if r.Protocol != nil {
+		out.Protocol = make([]string, len(r.Protocol))
+		copy(out.Protocol, r.Protocol)
+	}
+	if r.ProtocolElement != nil {
+		out.ProtocolElement = make([]*Element, len(r.ProtocolElement))
+		for i, v := range r.ProtocolElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ProtocolElement[i] = &c
+			}
+		}
+	}
+	if r.Summary != nil {
+		v := *r.Summary
+		out.Summary = &v
+	}
+	if r.SummaryElement != nil {
+		v := r.SummaryElement.DeepCopy()
+		out.SummaryElement = &v
+	}
+	if r.Finding != nil {
+		out.Finding = make([]ClinicalImpressionFinding, len(r.Finding))
+		for i := range r.Finding {
+			out.Finding[i] = r.Finding[i].DeepCopy()
+		}
+	}
+	if r.PrognosisCodeableConcept != nil {
+		out.PrognosisCodeableConcept = make([]CodeableConcept, len(r.PrognosisCodeableConcept))
+		for i := range r.PrognosisCodeableConcept {
+			out.PrognosisCodeableConcept[i] = r.PrognosisCodeableConcept[i].DeepCopy()
+		}
+	}
+	if r.PrognosisReference != nil {
+		out.PrognosisReference = make([]Reference, len(r.PrognosisReference))
+		for i := range r.PrognosisReference {
+			out.PrognosisReference[i] = r.PrognosisReference[i].DeepCopy()
+		}
+	}
+	if r.SupportingInfo != nil {
+		out.SupportingInfo = make([]Reference, len(r.SupportingInfo))
+		for i := range r.SupportingInfo {
+			out.SupportingInfo[i] = r.SupportingInfo[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClinicalImpression) Equal(other ClinicalImpression) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.Equal(*other.StatusReason) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && *r.EffectiveDateTime != *other.EffectiveDateTime {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.Equal(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Assessor == nil) != (other.Assessor == nil) || r.Assessor != nil && !r.Assessor.Equal(*other.Assessor) {
+		return false
+	}
+	if (r.Previous == nil) != (other.Previous == nil) || r.Previous != nil && !r.Previous.Equal(*other.Previous) {
+		return false
+	}
+	if len(r.Problem) != len(other.Problem) {
+		return false
+	}
+	for i := range r.Problem {
+		if !r.Problem[i].Equal(other.Problem[i]) {
+			return false
+		}
+	}
+	if len(r.Investigation) != len(other.Investigation) {
+		return false
+	}
+	for i := range r.Investigation {
+		if !r.Investigation[i].Equal(other.Investigation[i]) {
+			return false
+		}
+	}
+	if len(r.Protocol) != len(other.Protocol) {
+		return false
+	}
+	for i := range r.Protocol {
+		if r.Protocol[i] != other.Protocol[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ProtocolElement, other.ProtocolElement, false) {
+		return false
+	}
+	if (r.Summary == nil) != (other.Summary == nil) || r.Summary != nil && *r.Summary != *other.Summary {
+		return false
+	}
+	if (r.SummaryElement == nil) != (other.SummaryElement == nil) || r.SummaryElement != nil && !r.SummaryElement.Equal(*other.SummaryElement) {
+		return false
+	}
+	if len(r.Finding) != len(other.Finding) {
+		return false
+	}
+	for i := range r.Finding {
+		if !r.Finding[i].Equal(other.Finding[i]) {
+			return false
+		}
+	}
+	if len(r.PrognosisCodeableConcept) != len(other.PrognosisCodeableConcept) {
+		return false
+	}
+	for i := range r.PrognosisCodeableConcept {
+		if !r.PrognosisCodeableConcept[i].Equal(other.PrognosisCodeableConcept[i]) {
+			return false
+		}
+	}
+	if len(r.PrognosisReference) != len(other.PrognosisReference) {
+		return false
+	}
+	for i := range r.PrognosisReference {
+		if !r.PrognosisReference[i].Equal(other.PrognosisReference[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingInfo) != len(other.SupportingInfo) {
+		return false
+	}
+	for i := range r.SupportingInfo {
+		if !r.SupportingInfo[i].Equal(other.SupportingInfo[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClinicalImpression) EqualsDeep(other ClinicalImpression) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.EqualsDeep(*other.StatusReason) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.EqualsDeep(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Assessor == nil) != (other.Assessor == nil) || r.Assessor != nil && !r.Assessor.EqualsDeep(*other.Assessor) {
+		return false
+	}
+	if (r.Previous == nil) != (other.Previous == nil) || r.Previous != nil && !r.Previous.EqualsDeep(*other.Previous) {
+		return false
+	}
+	if !equivalentList(len(r.Problem), len(other.Problem), func(i, j int) bool {
+		return r.Problem[i].EqualsDeep(other.Problem[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Investigation), len(other.Investigation), func(i, j int) bool {
+		return r.Investigation[i].EqualsDeep(other.Investigation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Protocol), len(other.Protocol), func(i, j int) bool {
+		return equivalentString(r.Protocol[i], other.Protocol[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ProtocolElement, other.ProtocolElement, true) {
+		return false
+	}
+	if (r.Summary == nil) != (other.Summary == nil) || r.Summary != nil && !equivalentString(*r.Summary, *other.Summary) {
+		return false
+	}
+	if (r.SummaryElement == nil) != (other.SummaryElement == nil) || r.SummaryElement != nil && !r.SummaryElement.EqualsDeep(*other.SummaryElement) {
+		return false
+	}
+	if !equivalentList(len(r.Finding), len(other.Finding), func(i, j int) bool {
+		return r.Finding[i].EqualsDeep(other.Finding[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PrognosisCodeableConcept), len(other.PrognosisCodeableConcept), func(i, j int) bool {
+		return r.PrognosisCodeableConcept[i].EqualsDeep(other.PrognosisCodeableConcept[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PrognosisReference), len(other.PrognosisReference), func(i, j int) bool {
+		return r.PrognosisReference[i].EqualsDeep(other.PrognosisReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfo), len(other.SupportingInfo), func(i, j int) bool {
+		return r.SupportingInfo[i].EqualsDeep(other.SupportingInfo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClinicalImpression) EqualsShallow(other ClinicalImpression) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if !equivalentList(len(r.Protocol), len(other.Protocol), func(i, j int) bool {
+		return equivalentString(r.Protocol[i], other.Protocol[j])
+	}) {
+		return false
+	}
+	if (r.Summary == nil) != (other.Summary == nil) || r.Summary != nil && !equivalentString(*r.Summary, *other.Summary) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClinicalImpression as FHIR XML
+func (r ClinicalImpression) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ClinicalImpression",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReason", r.StatusReason); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "effectiveDateTime", r.EffectiveDateTime, r.EffectiveDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "assessor", r.Assessor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "previous", r.Previous); err != nil {
+		return err
+	}
+	for _, v := range r.Problem {
+		if err := encodeXMLElement(e, "problem", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Investigation {
+		if err := encodeXMLElement(e, "investigation", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Protocol {
+		if err := encodeXMLPrimitive(e, "protocol", v, elementAt(r.ProtocolElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "summary", r.Summary, r.SummaryElement); err != nil {
+		return err
+	}
+	for _, v := range r.Finding {
+		if err := encodeXMLElement(e, "finding", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PrognosisCodeableConcept {
+		if err := encodeXMLElement(e, "prognosisCodeableConcept", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PrognosisReference {
+		if err := encodeXMLElement(e, "prognosisReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SupportingInfo {
+		if err := encodeXMLElement(e, "supportingInfo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClinicalImpression from FHIR XML
+func (r *ClinicalImpression) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v ClinicalImpressionStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "effectiveDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EffectiveDateTime = &v
+				}
+				r.EffectiveDateTimeElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "assessor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Assessor = &v
+			case "previous":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Previous = &v
+			case "problem":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Problem = append(r.Problem, v)
+			case "investigation":
+				var v ClinicalImpressionInvestigation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Investigation = append(r.Investigation, v)
+			case "protocol":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ProtocolElement = appendElement(r.ProtocolElement, len(r.Protocol), element)
+					r.Protocol = append(r.Protocol, v)
+				}
+			case "summary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Summary = &v
+				}
+				r.SummaryElement = element
+			case "finding":
+				var v ClinicalImpressionFinding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Finding = append(r.Finding, v)
+			case "prognosisCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PrognosisCodeableConcept = append(r.PrognosisCodeableConcept, v)
+			case "prognosisReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PrognosisReference = append(r.PrognosisReference, v)
+			case "supportingInfo":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClinicalImpression as properties to the RDF node n
+func (r ClinicalImpression) turtle(n *turtleNode) {
+	n.resource("ClinicalImpression", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ClinicalImpression.identifier", i, v)
+	}
+	n.primitive("ClinicalImpression.status", -1, r.Status, r.StatusElement, "code")
+	n.element("ClinicalImpression.statusReason", -1, r.StatusReason)
+	n.element("ClinicalImpression.code", -1, r.Code)
+	n.primitive("ClinicalImpression.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("ClinicalImpression.subject", -1, r.Subject)
+	n.element("ClinicalImpression.encounter", -1, r.Encounter)
+	n.primitive("ClinicalImpression.effectiveDateTime", -1, r.EffectiveDateTime, r.EffectiveDateTimeElement, "dateTime")
+	n.element("ClinicalImpression.effectivePeriod", -1, r.EffectivePeriod)
+	n.primitive("ClinicalImpression.date", -1, r.Date, r.DateElement, "dateTime")
+	n.element("ClinicalImpression.assessor", -1, r.Assessor)
+	n.element("ClinicalImpression.previous", -1, r.Previous)
+	for i, v := range r.Problem {
+		n.element("ClinicalImpression.problem", i, v)
+	}
+	for i, v := range r.Investigation {
+		n.element("ClinicalImpression.investigation", i, v)
+	}
+	for i, v := range r.Protocol {
+		n.primitive("ClinicalImpression.protocol", i, v, elementAt(r.ProtocolElement, i), "string")
+	}
+	n.primitive("ClinicalImpression.summary", -1, r.Summary, r.SummaryElement, "string")
+	for i, v := range r.Finding {
+		n.element("ClinicalImpression.finding", i, v)
+	}
+	for i, v := range r.PrognosisCodeableConcept {
+		n.element("ClinicalImpression.prognosisCodeableConcept", i, v)
+	}
+	for i, v := range r.PrognosisReference {
+		n.element("ClinicalImpression.prognosisReference", i, v)
+	}
+	for i, v := range r.SupportingInfo {
+		n.element("ClinicalImpression.supportingInfo", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("ClinicalImpression.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClinicalImpression as BSON document with the structure of its FHIR JSON
+func (r ClinicalImpression) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClinicalImpression from a BSON document with the structure of its FHIR JSON
+func (r *ClinicalImpression) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClinicalImpression as protobuf message ClinicalImpression of fhir.proto
+func (r ClinicalImpression) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClinicalImpression to the encoded message
+func (r ClinicalImpression) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.StatusReason != nil {
+		e.message(11, *r.StatusReason)
+	}
+	if r.Code != nil {
+		e.message(12, *r.Code)
+	}
+	if r.Description != nil {
+		e.string(13, *r.Description)
+	}
+	e.message(14, r.Subject)
+	if r.Encounter != nil {
+		e.message(15, *r.Encounter)
+	}
+	if r.EffectiveDateTime != nil {
+		e.string(16, *r.EffectiveDateTime)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(17, *r.EffectivePeriod)
+	}
+	if r.Date != nil {
+		e.string(18, *r.Date)
+	}
+	if r.Assessor != nil {
+		e.message(19, *r.Assessor)
+	}
+	if r.Previous != nil {
+		e.message(20, *r.Previous)
+	}
+	for _, v := range r.Problem {
+		e.message(21, v)
+	}
+	for _, v := range r.Investigation {
+		e.message(22, v)
+	}
+	for _, v := range r.Protocol {
+		e.string(23, v)
+	}
+	if r.Summary != nil {
+		e.string(24, *r.Summary)
+	}
+	for _, v := range r.Finding {
+		e.message(25, v)
+	}
+	for _, v := range r.PrognosisCodeableConcept {
+		e.message(26, v)
+	}
+	for _, v := range r.PrognosisReference {
+		e.message(27, v)
+	}
+	for _, v := range r.SupportingInfo {
+		e.message(28, v)
+	}
+	for _, v := range r.Note {
+		e.message(29, v)
+	}
+	if r.IdElement != nil {
+		e.message(30, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(31, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(32, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(33, *r.StatusElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(34, *r.DescriptionElement)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.message(35, *r.EffectiveDateTimeElement)
+	}
+	if r.DateElement != nil {
+		e.message(36, *r.DateElement)
+	}
+	for _, v := range r.ProtocolElement {
+		e.element(37, v)
+	}
+	if r.SummaryElement != nil {
+		e.message(38, *r.SummaryElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClinicalImpression from protobuf message ClinicalImpression of fhir.proto
+func (r *ClinicalImpression) UnmarshalProto(b []byte) error {
+	*r = ClinicalImpression{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v ClinicalImpressionStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 13:
+			v := d.string()
+			r.Description = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 16:
+			v := d.string()
+			r.EffectiveDateTime = &v
+		case 17:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 18:
+			v := d.string()
+			r.Date = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Assessor = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Previous = &v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Problem = append(r.Problem, v)
+		case 22:
+			var v ClinicalImpressionInvestigation
+			d.message(&v)
+			r.Investigation = append(r.Investigation, v)
+		case 23:
+			r.Protocol = append(r.Protocol, d.string())
+		case 24:
+			v := d.string()
+			r.Summary = &v
+		case 25:
+			var v ClinicalImpressionFinding
+			d.message(&v)
+			r.Finding = append(r.Finding, v)
+		case 26:
+			var v CodeableConcept
+			d.message(&v)
+			r.PrognosisCodeableConcept = append(r.PrognosisCodeableConcept, v)
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.PrognosisReference = append(r.PrognosisReference, v)
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.SupportingInfo = append(r.SupportingInfo, v)
+		case 29:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 30:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.EffectiveDateTimeElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 37:
+			r.ProtocolElement = append(r.ProtocolElement, d.element())
+		case 38:
+			var v Element
+			d.message(&v)
+			r.SummaryElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClinicalImpressionInvestigation as JSON into a byte slice
+func (r ClinicalImpressionInvestigation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClinicalImpressionInvestigation as JSON object with the members in the order of the fields
+func (r ClinicalImpressionInvestigation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if len(r.Item) > 0 {
+		e.key("item")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Item {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClinicalImpressionInvestigation from JSON. Unknown members are ignored.
+func (r *ClinicalImpressionInvestigation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClinicalImpressionInvestigation
+func (r *ClinicalImpressionInvestigation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "item":
+			a := d.array()
+			r.Item = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Item = append(r.Item, v)
+			}
+			if r.Item == nil && !a.isNull {
+				r.Item = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClinicalImpressionInvestigation which shares no memory with the original
+func (r ClinicalImpressionInvestigation) DeepCopy() ClinicalImpressionInvestigation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.Item != nil {
+		out.Item = make([]Reference, len(r.Item))
+		for i := range r.Item {
+			out.Item[i] = r.Item[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClinicalImpressionInvestigation) Equal(other ClinicalImpressionInvestigation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if len(r.Item) != len(other.Item) {
+		return false
+	}
+	for i := range r.Item {
+		if !r.Item[i].Equal(other.Item[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClinicalImpressionInvestigation) EqualsDeep(other ClinicalImpressionInvestigation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.Item), len(other.Item), func(i, j int) bool {
+		return r.Item[i].EqualsDeep(other.Item[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClinicalImpressionInvestigation) EqualsShallow(other ClinicalImpressionInvestigation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClinicalImpressionInvestigation as FHIR XML
+func (r ClinicalImpressionInvestigation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.Item {
+		if err := encodeXMLElement(e, "item", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClinicalImpressionInvestigation from FHIR XML
+func (r *ClinicalImpressionInvestigation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "item":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Item = append(r.Item, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClinicalImpressionInvestigation as properties to the RDF node n
+func (r ClinicalImpressionInvestigation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ClinicalImpression.investigation.code", -1, r.Code)
+	for i, v := range r.Item {
+		n.element("ClinicalImpression.investigation.item", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ClinicalImpressionInvestigation as BSON document with the structure of its FHIR JSON
+func (r ClinicalImpressionInvestigation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClinicalImpressionInvestigation from a BSON document with the structure of its FHIR JSON
+func (r *ClinicalImpressionInvestigation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClinicalImpressionInvestigation as protobuf message ClinicalImpressionInvestigation of fhir.proto
+func (r ClinicalImpressionInvestigation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClinicalImpressionInvestigation to the encoded message
+func (r ClinicalImpressionInvestigation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	for _, v := range r.Item {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClinicalImpressionInvestigation from protobuf message ClinicalImpressionInvestigation of fhir.proto
+func (r *ClinicalImpressionInvestigation) UnmarshalProto(b []byte) error {
+	*r = ClinicalImpressionInvestigation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Item = append(r.Item, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ClinicalImpressionFinding as JSON into a byte slice
+func (r ClinicalImpressionFinding) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ClinicalImpressionFinding as JSON object with the members in the order of the fields
+func (r ClinicalImpressionFinding) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ItemCodeableConcept != nil {
+		e.key("itemCodeableConcept")
+		r.ItemCodeableConcept.appendJSON(e)
+	}
+	if r.ItemReference != nil {
+		e.key("itemReference")
+		r.ItemReference.appendJSON(e)
+	}
+	if r.Basis != nil {
+		e.key("basis")
+		e.string(*r.Basis)
+	}
+	if r.BasisElement != nil {
+		e.key("_basis")
+		r.BasisElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ClinicalImpressionFinding from JSON. Unknown members are ignored.
+func (r *ClinicalImpressionFinding) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ClinicalImpressionFinding
+func (r *ClinicalImpressionFinding) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "itemCodeableConcept":
+			if d.null() {
+				r.ItemCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ItemCodeableConcept = &v
+			}
+		case "itemReference":
+			if d.null() {
+				r.ItemReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ItemReference = &v
+			}
+		case "basis":
+			if v, ok := d.string(); ok {
+				r.Basis = &v
+			} else {
+				r.Basis = nil
+			}
+		case "_basis":
+			r.BasisElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ClinicalImpressionFinding which shares no memory with the original
+func (r ClinicalImpressionFinding) DeepCopy() ClinicalImpressionFinding {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ItemCodeableConcept != nil {
+		v := r.ItemCodeableConcept.DeepCopy()
+		out.ItemCodeableConcept = &v
+	}
+	if r.ItemReference != nil {
+		v := r.ItemReference.DeepCopy()
+		out.ItemReference = &v
+	}
+	if r.Basis != nil {
+		v := *r.Basis
+		out.Basis = &v
+	}
+	if r.BasisElement != nil {
+		v := r.BasisElement.DeepCopy()
+		out.BasisElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ClinicalImpressionFinding) Equal(other ClinicalImpressionFinding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.Equal(*other.ItemCodeableConcept) {
+		return false
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.Equal(*other.ItemReference) {
+		return false
+	}
+	if (r.Basis == nil) != (other.Basis == nil) || r.Basis != nil && *r.Basis != *other.Basis {
+		return false
+	}
+	if (r.BasisElement == nil) != (other.BasisElement == nil) || r.BasisElement != nil && !r.BasisElement.Equal(*other.BasisElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ClinicalImpressionFinding) EqualsDeep(other ClinicalImpressionFinding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.EqualsDeep(*other.ItemCodeableConcept) {
+		return false
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.EqualsDeep(*other.ItemReference) {
+		return false
+	}
+	if (r.Basis == nil) != (other.Basis == nil) || r.Basis != nil && !equivalentString(*r.Basis, *other.Basis) {
+		return false
+	}
+	if (r.BasisElement == nil) != (other.BasisElement == nil) || r.BasisElement != nil && !r.BasisElement.EqualsDeep(*other.BasisElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ClinicalImpressionFinding) EqualsShallow(other ClinicalImpressionFinding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Basis == nil) != (other.Basis == nil) || r.Basis != nil && !equivalentString(*r.Basis, *other.Basis) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ClinicalImpressionFinding as FHIR XML
+func (r ClinicalImpressionFinding) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "itemCodeableConcept", r.ItemCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "itemReference", r.ItemReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "basis", r.Basis, r.BasisElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ClinicalImpressionFinding from FHIR XML
+func (r *ClinicalImpressionFinding) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "itemCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemCodeableConcept = &v
+			case "itemReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemReference = &v
+			case "basis":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Basis = &v
+				}
+				r.BasisElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ClinicalImpressionFinding as properties to the RDF node n
+func (r ClinicalImpressionFinding) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ClinicalImpression.finding.itemCodeableConcept", -1, r.ItemCodeableConcept)
+	n.element("ClinicalImpression.finding.itemReference", -1, r.ItemReference)
+	n.primitive("ClinicalImpression.finding.basis", -1, r.Basis, r.BasisElement, "string")
+}
+
+// MarshalBSON marshals the given ClinicalImpressionFinding as BSON document with the structure of its FHIR JSON
+func (r ClinicalImpressionFinding) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ClinicalImpressionFinding from a BSON document with the structure of its FHIR JSON
+func (r *ClinicalImpressionFinding) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ClinicalImpressionFinding as protobuf message ClinicalImpressionFinding of fhir.proto
+func (r ClinicalImpressionFinding) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ClinicalImpressionFinding to the encoded message
+func (r ClinicalImpressionFinding) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ItemCodeableConcept != nil {
+		e.message(4, *r.ItemCodeableConcept)
+	}
+	if r.ItemReference != nil {
+		e.message(5, *r.ItemReference)
+	}
+	if r.Basis != nil {
+		e.string(6, *r.Basis)
+	}
+	if r.BasisElement != nil {
+		e.message(7, *r.BasisElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ClinicalImpressionFinding from protobuf message ClinicalImpressionFinding of fhir.proto
+func (r *ClinicalImpressionFinding) UnmarshalProto(b []byte) error {
+	*r = ClinicalImpressionFinding{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.ItemCodeableConcept = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.ItemReference = &v
+		case 6:
+			v := d.string()
+			r.Basis = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.BasisElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalClinicalImpression unmarshals a ClinicalImpression.
 func UnmarshalClinicalImpression(b []byte) (ClinicalImpression, error) {
 	var clinicalImpression ClinicalImpression
-	if err := json.Unmarshal(b, &clinicalImpression); err != nil {
+	if err := clinicalImpression.UnmarshalJSON(b); err != nil {
 		return clinicalImpression, err
 	}
 	return clinicalImpression, nil
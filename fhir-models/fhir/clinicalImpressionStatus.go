@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -40,8 +39,13 @@ const (
 func (code ClinicalImpressionStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ClinicalImpressionStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ClinicalImpressionStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "preparation":
 		*code = ClinicalImpressionStatusPreparation
@@ -64,6 +68,12 @@ func (code *ClinicalImpressionStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ClinicalImpressionStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ClinicalImpressionStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ClinicalImpressionStatus) String() string {
 	return code.Code()
 }
@@ -130,3 +140,47 @@ func (code ClinicalImpressionStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ClinicalImpressionStatus) protoNumber() uint64 {
+	switch code {
+	case ClinicalImpressionStatusPreparation:
+		return 1
+	case ClinicalImpressionStatusInProgress:
+		return 2
+	case ClinicalImpressionStatusNotDone:
+		return 3
+	case ClinicalImpressionStatusOnHold:
+		return 4
+	case ClinicalImpressionStatusStopped:
+		return 5
+	case ClinicalImpressionStatusCompleted:
+		return 6
+	case ClinicalImpressionStatusEnteredInError:
+		return 7
+	case ClinicalImpressionStatusUnknown:
+		return 8
+	}
+	return 0
+}
+func (code *ClinicalImpressionStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ClinicalImpressionStatusPreparation
+	case 2:
+		*code = ClinicalImpressionStatusInProgress
+	case 3:
+		*code = ClinicalImpressionStatusNotDone
+	case 4:
+		*code = ClinicalImpressionStatusOnHold
+	case 5:
+		*code = ClinicalImpressionStatusStopped
+	case 6:
+		*code = ClinicalImpressionStatusCompleted
+	case 7:
+		*code = ClinicalImpressionStatusEnteredInError
+	case 8:
+		*code = ClinicalImpressionStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code CodeSearchSupport) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CodeSearchSupport) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CodeSearchSupport) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "explicit":
 		*code = CodeSearchSupportExplicit
@@ -46,6 +50,12 @@ func (code *CodeSearchSupport) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CodeSearchSupport) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CodeSearchSupport) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CodeSearchSupport) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code CodeSearchSupport) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CodeSearchSupport) protoNumber() uint64 {
+	switch code {
+	case CodeSearchSupportExplicit:
+		return 1
+	case CodeSearchSupportAll:
+		return 2
+	}
+	return 0
+}
+func (code *CodeSearchSupport) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CodeSearchSupportExplicit
+	case 2:
+		*code = CodeSearchSupportAll
+	default:
+		return false
+	}
+	return true
+}
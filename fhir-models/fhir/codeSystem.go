@@ -14,72 +14,109 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // CodeSystem is documented here http://hl7.org/fhir/StructureDefinition/CodeSystem
 type CodeSystem struct {
-	Id                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                     `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string                     `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                     `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                     `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                     `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus           `bson:"status" json:"status"`
-	Experimental      *bool                       `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                     `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                     `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail             `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                     `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext              `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept           `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                     `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                     `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	CaseSensitive     *bool                       `bson:"caseSensitive,omitempty" json:"caseSensitive,omitempty"`
-	ValueSet          *string                     `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
-	HierarchyMeaning  *CodeSystemHierarchyMeaning `bson:"hierarchyMeaning,omitempty" json:"hierarchyMeaning,omitempty"`
-	Compositional     *bool                       `bson:"compositional,omitempty" json:"compositional,omitempty"`
-	VersionNeeded     *bool                       `bson:"versionNeeded,omitempty" json:"versionNeeded,omitempty"`
-	Content           CodeSystemContentMode       `bson:"content" json:"content"`
-	Supplements       *string                     `bson:"supplements,omitempty" json:"supplements,omitempty"`
-	Count             *int                        `bson:"count,omitempty" json:"count,omitempty"`
-	Filter            []CodeSystemFilter          `bson:"filter,omitempty" json:"filter,omitempty"`
-	Property          []CodeSystemProperty        `bson:"property,omitempty" json:"property,omitempty"`
-	Concept           []CodeSystemConcept         `bson:"concept,omitempty" json:"concept,omitempty"`
+	Id                      *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement               *Element                    `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                    *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               []json.RawMessage           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                     *string                     `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement              *Element                    `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier              []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                 *string                     `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement          *Element                    `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                    *string                     `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement             *Element                    `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                   *string                     `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement            *Element                    `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                  PublicationStatus           `bson:"status" json:"status"`
+	StatusElement           *Element                    `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental            *bool                       `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement     *Element                    `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                    *string                     `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement             *Element                    `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher               *string                     `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement        *Element                    `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                 []ContactDetail             `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description             *string                     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                    `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext              []UsageContext              `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction            []CodeableConcept           `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                 *string                     `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement          *Element                    `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright               *string                     `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement        *Element                    `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	CaseSensitive           *bool                       `bson:"caseSensitive,omitempty" json:"caseSensitive,omitempty"`
+	CaseSensitiveElement    *Element                    `bson:"_caseSensitive,omitempty" json:"_caseSensitive,omitempty"`
+	ValueSet                *string                     `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ValueSetElement         *Element                    `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
+	HierarchyMeaning        *CodeSystemHierarchyMeaning `bson:"hierarchyMeaning,omitempty" json:"hierarchyMeaning,omitempty"`
+	HierarchyMeaningElement *Element                    `bson:"_hierarchyMeaning,omitempty" json:"_hierarchyMeaning,omitempty"`
+	Compositional           *bool                       `bson:"compositional,omitempty" json:"compositional,omitempty"`
+	CompositionalElement    *Element                    `bson:"_compositional,omitempty" json:"_compositional,omitempty"`
+	VersionNeeded           *bool                       `bson:"versionNeeded,omitempty" json:"versionNeeded,omitempty"`
+	VersionNeededElement    *Element                    `bson:"_versionNeeded,omitempty" json:"_versionNeeded,omitempty"`
+	Content                 CodeSystemContentMode       `bson:"content" json:"content"`
+	ContentElement          *Element                    `bson:"_content,omitempty" json:"_content,omitempty"`
+	Supplements             *string                     `bson:"supplements,omitempty" json:"supplements,omitempty"`
+	SupplementsElement      *Element                    `bson:"_supplements,omitempty" json:"_supplements,omitempty"`
+	Count                   *int                        `bson:"count,omitempty" json:"count,omitempty"`
+	CountElement            *Element                    `bson:"_count,omitempty" json:"_count,omitempty"`
+	Filter                  []CodeSystemFilter          `bson:"filter,omitempty" json:"filter,omitempty"`
+	Property                []CodeSystemProperty        `bson:"property,omitempty" json:"property,omitempty"`
+	Concept                 []CodeSystemConcept         `bson:"concept,omitempty" json:"concept,omitempty"`
 }
 type CodeSystemFilter struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              string           `bson:"code" json:"code"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
-	Operator          []FilterOperator `bson:"operator" json:"operator"`
-	Value             string           `bson:"value" json:"value"`
+	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               string           `bson:"code" json:"code"`
+	CodeElement        *Element         `bson:"_code,omitempty" json:"_code,omitempty"`
+	Description        *string          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element         `bson:"_description,omitempty" json:"_description,omitempty"`
+	Operator           []FilterOperator `bson:"operator" json:"operator"`
+	OperatorElement    []*Element       `bson:"_operator,omitempty" json:"_operator,omitempty"`
+	Value              string           `bson:"value" json:"value"`
+	ValueElement       *Element         `bson:"_value,omitempty" json:"_value,omitempty"`
 }
 type CodeSystemProperty struct {
-	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              string       `bson:"code" json:"code"`
-	Uri               *string      `bson:"uri,omitempty" json:"uri,omitempty"`
-	Description       *string      `bson:"description,omitempty" json:"description,omitempty"`
-	Type              PropertyType `bson:"type" json:"type"`
+	Id                 *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               string       `bson:"code" json:"code"`
+	CodeElement        *Element     `bson:"_code,omitempty" json:"_code,omitempty"`
+	Uri                *string      `bson:"uri,omitempty" json:"uri,omitempty"`
+	UriElement         *Element     `bson:"_uri,omitempty" json:"_uri,omitempty"`
+	Description        *string      `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element     `bson:"_description,omitempty" json:"_description,omitempty"`
+	Type               PropertyType `bson:"type" json:"type"`
+	TypeElement        *Element     `bson:"_type,omitempty" json:"_type,omitempty"`
 }
 type CodeSystemConcept struct {
 	Id                *string                        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              string                         `bson:"code" json:"code"`
+	CodeElement       *Element                       `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                        `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                       `bson:"_display,omitempty" json:"_display,omitempty"`
 	Definition        *string                        `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement *Element                       `bson:"_definition,omitempty" json:"_definition,omitempty"`
 	Designation       []CodeSystemConceptDesignation `bson:"designation,omitempty" json:"designation,omitempty"`
 	Property          []CodeSystemConceptProperty    `bson:"property,omitempty" json:"property,omitempty"`
 	Concept           []CodeSystemConcept            `bson:"concept,omitempty" json:"concept,omitempty"`
@@ -89,39 +126,5334 @@ type CodeSystemConceptDesignation struct {
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Language          *string     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement   *Element    `bson:"_language,omitempty" json:"_language,omitempty"`
 	Use               *Coding     `bson:"use,omitempty" json:"use,omitempty"`
 	Value             string      `bson:"value" json:"value"`
+	ValueElement      *Element    `bson:"_value,omitempty" json:"_value,omitempty"`
 }
 type CodeSystemConceptProperty struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              string      `bson:"code" json:"code"`
-	ValueCode         string      `bson:"valueCode" json:"valueCode"`
-	ValueCoding       Coding      `bson:"valueCoding" json:"valueCoding"`
-	ValueString       string      `bson:"valueString" json:"valueString"`
-	ValueInteger      int         `bson:"valueInteger" json:"valueInteger"`
-	ValueBoolean      bool        `bson:"valueBoolean" json:"valueBoolean"`
-	ValueDateTime     string      `bson:"valueDateTime" json:"valueDateTime"`
-	ValueDecimal      json.Number `bson:"valueDecimal" json:"valueDecimal"`
+	Id                   *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 string       `bson:"code" json:"code"`
+	CodeElement          *Element     `bson:"_code,omitempty" json:"_code,omitempty"`
+	ValueCode            *string      `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement     *Element     `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueCoding          *Coding      `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueString          *string      `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element     `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueInteger         *int         `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element     `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueBoolean         *bool        `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element     `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueDateTime        *string      `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element     `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal         *json.Number `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *Element     `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+}
+
+// CodeSystemConceptPropertyValue is implemented by the types allowed for CodeSystem.concept.property.value[x]
+type CodeSystemConceptPropertyValue interface {
+	isCodeSystemConceptPropertyValue()
+}
+
+func (Code) isCodeSystemConceptPropertyValue()     {}
+func (Coding) isCodeSystemConceptPropertyValue()   {}
+func (String) isCodeSystemConceptPropertyValue()   {}
+func (Integer) isCodeSystemConceptPropertyValue()  {}
+func (Boolean) isCodeSystemConceptPropertyValue()  {}
+func (DateTime) isCodeSystemConceptPropertyValue() {}
+func (Decimal) isCodeSystemConceptPropertyValue()  {}
+
+// Value returns the value of CodeSystem.concept.property.value[x] together with its FHIR type code or nil if no value is set
+func (r CodeSystemConceptProperty) Value() (CodeSystemConceptPropertyValue, string) {
+	if r.ValueCode != nil {
+		return Code(*r.ValueCode), "code"
+	}
+	if r.ValueCoding != nil {
+		return *r.ValueCoding, "Coding"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValueDecimal != nil {
+		return Decimal(*r.ValueDecimal), "decimal"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of CodeSystem.concept.property.value[x], given as value or pointer, and clears all other types
+func (r *CodeSystemConceptProperty) SetValue(value CodeSystemConceptPropertyValue) {
+	r.ValueCode = nil
+	r.ValueCoding = nil
+	r.ValueString = nil
+	r.ValueInteger = nil
+	r.ValueBoolean = nil
+	r.ValueDateTime = nil
+	r.ValueDecimal = nil
+	switch v := value.(type) {
+	case Code:
+		primitive := string(v)
+		r.ValueCode = &primitive
+	case *Code:
+		r.ValueCode = (*string)(v)
+	case Coding:
+		r.ValueCoding = &v
+	case *Coding:
+		r.ValueCoding = v
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.ValueDecimal = &primitive
+	case *Decimal:
+		r.ValueDecimal = (*json.Number)(v)
+	}
 }
-type OtherCodeSystem CodeSystem
 
 // MarshalJSON marshals the given CodeSystem as JSON into a byte slice
 func (r CodeSystem) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCodeSystem
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCodeSystem: OtherCodeSystem(r),
-		ResourceType:    "CodeSystem",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CodeSystem as JSON object with the members in the order of the fields
+func (r CodeSystem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"CodeSystem\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.CaseSensitive != nil {
+		e.key("caseSensitive")
+		e.bool(*r.CaseSensitive)
+	}
+	if r.CaseSensitiveElement != nil {
+		e.key("_caseSensitive")
+		r.CaseSensitiveElement.appendJSON(e)
+	}
+	if r.ValueSet != nil {
+		e.key("valueSet")
+		e.string(*r.ValueSet)
+	}
+	if r.ValueSetElement != nil {
+		e.key("_valueSet")
+		r.ValueSetElement.appendJSON(e)
+	}
+	if r.HierarchyMeaning != nil {
+		e.key("hierarchyMeaning")
+		e.string(r.HierarchyMeaning.Code())
+	}
+	if r.HierarchyMeaningElement != nil {
+		e.key("_hierarchyMeaning")
+		r.HierarchyMeaningElement.appendJSON(e)
+	}
+	if r.Compositional != nil {
+		e.key("compositional")
+		e.bool(*r.Compositional)
+	}
+	if r.CompositionalElement != nil {
+		e.key("_compositional")
+		r.CompositionalElement.appendJSON(e)
+	}
+	if r.VersionNeeded != nil {
+		e.key("versionNeeded")
+		e.bool(*r.VersionNeeded)
+	}
+	if r.VersionNeededElement != nil {
+		e.key("_versionNeeded")
+		r.VersionNeededElement.appendJSON(e)
+	}
+	e.key("content")
+	e.string(r.Content.Code())
+	if r.ContentElement != nil {
+		e.key("_content")
+		r.ContentElement.appendJSON(e)
+	}
+	if r.Supplements != nil {
+		e.key("supplements")
+		e.string(*r.Supplements)
+	}
+	if r.SupplementsElement != nil {
+		e.key("_supplements")
+		r.SupplementsElement.appendJSON(e)
+	}
+	if r.Count != nil {
+		e.key("count")
+		e.int(*r.Count)
+	}
+	if r.CountElement != nil {
+		e.key("_count")
+		r.CountElement.appendJSON(e)
+	}
+	if len(r.Filter) > 0 {
+		e.key("filter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Filter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Property) > 0 {
+		e.key("property")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Property {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Concept) > 0 {
+		e.key("concept")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Concept {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CodeSystem from JSON. Unknown members are ignored.
+func (r *CodeSystem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CodeSystem
+func (r *CodeSystem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "caseSensitive":
+			if v, ok := d.bool(); ok {
+				r.CaseSensitive = &v
+			} else {
+				r.CaseSensitive = nil
+			}
+		case "_caseSensitive":
+			r.CaseSensitiveElement = d.element()
+		case "valueSet":
+			if v, ok := d.string(); ok {
+				r.ValueSet = &v
+			} else {
+				r.ValueSet = nil
+			}
+		case "_valueSet":
+			r.ValueSetElement = d.element()
+		case "hierarchyMeaning":
+			if d.null() {
+				r.HierarchyMeaning = nil
+			} else {
+				var v CodeSystemHierarchyMeaning
+				d.unmarshal(&v)
+				r.HierarchyMeaning = &v
+			}
+		case "_hierarchyMeaning":
+			r.HierarchyMeaningElement = d.element()
+		case "compositional":
+			if v, ok := d.bool(); ok {
+				r.Compositional = &v
+			} else {
+				r.Compositional = nil
+			}
+		case "_compositional":
+			r.CompositionalElement = d.element()
+		case "versionNeeded":
+			if v, ok := d.bool(); ok {
+				r.VersionNeeded = &v
+			} else {
+				r.VersionNeeded = nil
+			}
+		case "_versionNeeded":
+			r.VersionNeededElement = d.element()
+		case "content":
+			d.unmarshal(&r.Content)
+		case "_content":
+			r.ContentElement = d.element()
+		case "supplements":
+			if v, ok := d.string(); ok {
+				r.Supplements = &v
+			} else {
+				r.Supplements = nil
+			}
+		case "_supplements":
+			r.SupplementsElement = d.element()
+		case "count":
+			if v, ok := d.int(); ok {
+				r.Count = &v
+			} else {
+				r.Count = nil
+			}
+		case "_count":
+			r.CountElement = d.element()
+		case "filter":
+			a := d.array()
+			r.Filter = nil
+			for a.next() {
+				var v CodeSystemFilter
+				v.decodeJSON(d)
+				r.Filter = append(r.Filter, v)
+			}
+			if r.Filter == nil && !a.isNull {
+				r.Filter = []CodeSystemFilter{}
+			}
+		case "property":
+			a := d.array()
+			r.Property = nil
+			for a.next() {
+				var v CodeSystemProperty
+				v.decodeJSON(d)
+				r.Property = append(r.Property, v)
+			}
+			if r.Property == nil && !a.isNull {
+				r.Property = []CodeSystemProperty{}
+			}
+		case "concept":
+			a := d.array()
+			r.Concept = nil
+			for a.next() {
+				var v CodeSystemConcept
+				v.decodeJSON(d)
+				r.Concept = append(r.Concept, v)
+			}
+			if r.Concept == nil && !a.isNull {
+				r.Concept = []CodeSystemConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CodeSystem which shares no memory with the original
+func (r CodeSystem) DeepCopy() CodeSystem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.CaseSensitive != nil {
+		v := *r.CaseSensitive
+		out.CaseSensitive = &v
+	}
+	if r.CaseSensitiveElement != nil {
+		v := r.CaseSensitiveElement.DeepCopy()
+		out.CaseSensitiveElement = &v
+	}
+	if r.ValueSet != nil {
+		v := *r.ValueSet
+		out.ValueSet = &v
+	}
+	if r.ValueSetElement != nil {
+		v := r.ValueSetElement.DeepCopy()
+		out.ValueSetElement = &v
+	}
+	if r.HierarchyMeaning != nil {
+		v := *r.HierarchyMeaning
+		out.HierarchyMeaning = &v
+	}
+	if r.HierarchyMeaningElement != nil {
+		v := r.HierarchyMeaningElement.DeepCopy()
+		out.HierarchyMeaningElement = &v
+	}
+	if r.Compositional != nil {
+		v := *r.Compositional
+		out.Compositional = &v
+	}
+	if r.CompositionalElement != nil {
+		v := r.CompositionalElement.DeepCopy()
+		out.CompositionalElement = &v
+	}
+	if r.VersionNeeded != nil {
+		v := *r.VersionNeeded
+		out.VersionNeeded = &v
+	}
+	if r.VersionNeededElement != nil {
+		v := r.VersionNeededElement.DeepCopy()
+		out.VersionNeededElement = &v
+	}
+	if r.ContentElement != nil {
+		v := r.ContentElement.DeepCopy()
+		out.ContentElement = &v
+	}
+	if r.Supplements != nil {
+		v := *r.Supplements
+		out.Supplements = &v
+	}
+	if r.SupplementsElement != nil {
+		v := r.SupplementsElement.DeepCopy()
+		out.SupplementsElement = &v
+	}
+	if r.Count != nil {
+		v := *r.Count
+		out.Count = &v
+	}
+	if r.CountElement != nil {
+		v := r.CountElement.DeepCopy()
+		out.CountElement = &v
+	}
+	if r.Filter != nil {
+		out.Filter = make([]CodeSystemFilter, len(r.Filter))
+		for i := range r.Filter {
+			out.Filter[i] = r.Filter[i].DeepCopy()
+		}
+	}
+	if r.Property != nil {
+		out.Property = make([]CodeSystemProperty, len(r.Property))
+		for i := range r.Property {
+			out.Property[i] = r.Property[i].DeepCopy()
+		}
+	}
+	if r.Concept != nil {
+		out.Concept = make([]CodeSystemConcept, len(r.Concept))
+		for i := range r.Concept {
+			out.Concept[i] = r.Concept[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CodeSystem) Equal(other CodeSystem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.CaseSensitive == nil) != (other.CaseSensitive == nil) || r.CaseSensitive != nil && *r.CaseSensitive != *other.CaseSensitive {
+		return false
+	}
+	if (r.CaseSensitiveElement == nil) != (other.CaseSensitiveElement == nil) || r.CaseSensitiveElement != nil && !r.CaseSensitiveElement.Equal(*other.CaseSensitiveElement) {
+		return false
+	}
+	if (r.ValueSet == nil) != (other.ValueSet == nil) || r.ValueSet != nil && *r.ValueSet != *other.ValueSet {
+		return false
+	}
+	if (r.ValueSetElement == nil) != (other.ValueSetElement == nil) || r.ValueSetElement != nil && !r.ValueSetElement.Equal(*other.ValueSetElement) {
+		return false
+	}
+	if (r.HierarchyMeaning == nil) != (other.HierarchyMeaning == nil) || r.HierarchyMeaning != nil && *r.HierarchyMeaning != *other.HierarchyMeaning {
+		return false
+	}
+	if (r.HierarchyMeaningElement == nil) != (other.HierarchyMeaningElement == nil) || r.HierarchyMeaningElement != nil && !r.HierarchyMeaningElement.Equal(*other.HierarchyMeaningElement) {
+		return false
+	}
+	if (r.Compositional == nil) != (other.Compositional == nil) || r.Compositional != nil && *r.Compositional != *other.Compositional {
+		return false
+	}
+	if (r.CompositionalElement == nil) != (other.CompositionalElement == nil) || r.CompositionalElement != nil && !r.CompositionalElement.Equal(*other.CompositionalElement) {
+		return false
+	}
+	if (r.VersionNeeded == nil) != (other.VersionNeeded == nil) || r.VersionNeeded != nil && *r.VersionNeeded != *other.VersionNeeded {
+		return false
+	}
+	if (r.VersionNeededElement == nil) != (other.VersionNeededElement == nil) || r.VersionNeededElement != nil && !r.VersionNeededElement.Equal(*other.VersionNeededElement) {
+		return false
+	}
+	if r.Content != other.Content {
+		return false
+	}
+	if (r.ContentElement == nil) != (other.ContentElement == nil) || r.ContentElement != nil && !r.ContentElement.Equal(*other.ContentElement) {
+		return false
+	}
+	if (r.Supplements == nil) != (other.Supplements == nil) || r.Supplements != nil && *r.Supplements != *other.Supplements {
+		return false
+	}
+	if (r.SupplementsElement == nil) != (other.SupplementsElement == nil) || r.SupplementsElement != nil && !r.SupplementsElement.Equal(*other.SupplementsElement) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	if (r.CountElement == nil) != (other.CountElement == nil) || r.CountElement != nil && !r.CountElement.Equal(*other.CountElement) {
+		return false
+	}
+	if len(r.Filter) != len(other.Filter) {
+		return false
+	}
+	for i := range r.Filter {
+		if !r.Filter[i].Equal(other.Filter[i]) {
+			return false
+		}
+	}
+	if len(r.Property) != len(other.Property) {
+		return false
+	}
+	for i := range r.Property {
+		if !r.Property[i].Equal(other.Property[i]) {
+			return false
+		}
+	}
+	if len(r.Concept) != len(other.Concept) {
+		return false
+	}
+	for i := range r.Concept {
+		if !r.Concept[i].Equal(other.Concept[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CodeSystem) EqualsDeep(other CodeSystem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.CaseSensitive == nil) != (other.CaseSensitive == nil) || r.CaseSensitive != nil && *r.CaseSensitive != *other.CaseSensitive {
+		return false
+	}
+	if (r.CaseSensitiveElement == nil) != (other.CaseSensitiveElement == nil) || r.CaseSensitiveElement != nil && !r.CaseSensitiveElement.EqualsDeep(*other.CaseSensitiveElement) {
+		return false
+	}
+	if (r.ValueSet == nil) != (other.ValueSet == nil) || r.ValueSet != nil && !equivalentString(*r.ValueSet, *other.ValueSet) {
+		return false
+	}
+	if (r.ValueSetElement == nil) != (other.ValueSetElement == nil) || r.ValueSetElement != nil && !r.ValueSetElement.EqualsDeep(*other.ValueSetElement) {
+		return false
+	}
+	if (r.HierarchyMeaning == nil) != (other.HierarchyMeaning == nil) || r.HierarchyMeaning != nil && *r.HierarchyMeaning != *other.HierarchyMeaning {
+		return false
+	}
+	if (r.HierarchyMeaningElement == nil) != (other.HierarchyMeaningElement == nil) || r.HierarchyMeaningElement != nil && !r.HierarchyMeaningElement.EqualsDeep(*other.HierarchyMeaningElement) {
+		return false
+	}
+	if (r.Compositional == nil) != (other.Compositional == nil) || r.Compositional != nil && *r.Compositional != *other.Compositional {
+		return false
+	}
+	if (r.CompositionalElement == nil) != (other.CompositionalElement == nil) || r.CompositionalElement != nil && !r.CompositionalElement.EqualsDeep(*other.CompositionalElement) {
+		return false
+	}
+	if (r.VersionNeeded == nil) != (other.VersionNeeded == nil) || r.VersionNeeded != nil && *r.VersionNeeded != *other.VersionNeeded {
+		return false
+	}
+	if (r.VersionNeededElement == nil) != (other.VersionNeededElement == nil) || r.VersionNeededElement != nil && !r.VersionNeededElement.EqualsDeep(*other.VersionNeededElement) {
+		return false
+	}
+	if r.Content != other.Content {
+		return false
+	}
+	if (r.ContentElement == nil) != (other.ContentElement == nil) || r.ContentElement != nil && !r.ContentElement.EqualsDeep(*other.ContentElement) {
+		return false
+	}
+	if (r.Supplements == nil) != (other.Supplements == nil) || r.Supplements != nil && !equivalentString(*r.Supplements, *other.Supplements) {
+		return false
+	}
+	if (r.SupplementsElement == nil) != (other.SupplementsElement == nil) || r.SupplementsElement != nil && !r.SupplementsElement.EqualsDeep(*other.SupplementsElement) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	if (r.CountElement == nil) != (other.CountElement == nil) || r.CountElement != nil && !r.CountElement.EqualsDeep(*other.CountElement) {
+		return false
+	}
+	if !equivalentList(len(r.Filter), len(other.Filter), func(i, j int) bool {
+		return r.Filter[i].EqualsDeep(other.Filter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Property), len(other.Property), func(i, j int) bool {
+		return r.Property[i].EqualsDeep(other.Property[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Concept), len(other.Concept), func(i, j int) bool {
+		return r.Concept[i].EqualsDeep(other.Concept[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CodeSystem) EqualsShallow(other CodeSystem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CaseSensitive == nil) != (other.CaseSensitive == nil) || r.CaseSensitive != nil && *r.CaseSensitive != *other.CaseSensitive {
+		return false
+	}
+	if (r.ValueSet == nil) != (other.ValueSet == nil) || r.ValueSet != nil && !equivalentString(*r.ValueSet, *other.ValueSet) {
+		return false
+	}
+	if (r.HierarchyMeaning == nil) != (other.HierarchyMeaning == nil) || r.HierarchyMeaning != nil && *r.HierarchyMeaning != *other.HierarchyMeaning {
+		return false
+	}
+	if (r.Compositional == nil) != (other.Compositional == nil) || r.Compositional != nil && *r.Compositional != *other.Compositional {
+		return false
+	}
+	if (r.VersionNeeded == nil) != (other.VersionNeeded == nil) || r.VersionNeeded != nil && *r.VersionNeeded != *other.VersionNeeded {
+		return false
+	}
+	if r.Content != other.Content {
+		return false
+	}
+	if (r.Supplements == nil) != (other.Supplements == nil) || r.Supplements != nil && !equivalentString(*r.Supplements, *other.Supplements) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CodeSystem as FHIR XML
+func (r CodeSystem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "CodeSystem",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "caseSensitive", r.CaseSensitive, r.CaseSensitiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueSet", r.ValueSet, r.ValueSetElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "hierarchyMeaning", r.HierarchyMeaning, r.HierarchyMeaningElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "compositional", r.Compositional, r.CompositionalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "versionNeeded", r.VersionNeeded, r.VersionNeededElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "content", r.Content, r.ContentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "supplements", r.Supplements, r.SupplementsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "count", r.Count, r.CountElement); err != nil {
+		return err
+	}
+	for _, v := range r.Filter {
+		if err := encodeXMLElement(e, "filter", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Property {
+		if err := encodeXMLElement(e, "property", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Concept {
+		if err := encodeXMLElement(e, "concept", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CodeSystem from FHIR XML
+func (r *CodeSystem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "caseSensitive":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CaseSensitive = &v
+				}
+				r.CaseSensitiveElement = element
+			case "valueSet":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueSet = &v
+				}
+				r.ValueSetElement = element
+			case "hierarchyMeaning":
+				var v CodeSystemHierarchyMeaning
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.HierarchyMeaning = &v
+				}
+				r.HierarchyMeaningElement = element
+			case "compositional":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Compositional = &v
+				}
+				r.CompositionalElement = element
+			case "versionNeeded":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.VersionNeeded = &v
+				}
+				r.VersionNeededElement = element
+			case "content":
+				var v CodeSystemContentMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Content = v
+				}
+				r.ContentElement = element
+			case "supplements":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Supplements = &v
+				}
+				r.SupplementsElement = element
+			case "count":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Count = &v
+				}
+				r.CountElement = element
+			case "filter":
+				var v CodeSystemFilter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Filter = append(r.Filter, v)
+			case "property":
+				var v CodeSystemProperty
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Property = append(r.Property, v)
+			case "concept":
+				var v CodeSystemConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Concept = append(r.Concept, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CodeSystem as properties to the RDF node n
+func (r CodeSystem) turtle(n *turtleNode) {
+	n.resource("CodeSystem", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("CodeSystem.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("CodeSystem.identifier", i, v)
+	}
+	n.primitive("CodeSystem.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("CodeSystem.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("CodeSystem.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("CodeSystem.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("CodeSystem.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("CodeSystem.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("CodeSystem.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("CodeSystem.contact", i, v)
+	}
+	n.primitive("CodeSystem.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("CodeSystem.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("CodeSystem.jurisdiction", i, v)
+	}
+	n.primitive("CodeSystem.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("CodeSystem.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("CodeSystem.caseSensitive", -1, r.CaseSensitive, r.CaseSensitiveElement, "boolean")
+	n.primitive("CodeSystem.valueSet", -1, r.ValueSet, r.ValueSetElement, "string")
+	n.primitive("CodeSystem.hierarchyMeaning", -1, r.HierarchyMeaning, r.HierarchyMeaningElement, "code")
+	n.primitive("CodeSystem.compositional", -1, r.Compositional, r.CompositionalElement, "boolean")
+	n.primitive("CodeSystem.versionNeeded", -1, r.VersionNeeded, r.VersionNeededElement, "boolean")
+	n.primitive("CodeSystem.content", -1, r.Content, r.ContentElement, "code")
+	n.primitive("CodeSystem.supplements", -1, r.Supplements, r.SupplementsElement, "string")
+	n.primitive("CodeSystem.count", -1, r.Count, r.CountElement, "unsignedInt")
+	for i, v := range r.Filter {
+		n.element("CodeSystem.filter", i, v)
+	}
+	for i, v := range r.Property {
+		n.element("CodeSystem.property", i, v)
+	}
+	for i, v := range r.Concept {
+		n.element("CodeSystem.concept", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CodeSystem as BSON document with the structure of its FHIR JSON
+func (r CodeSystem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CodeSystem from a BSON document with the structure of its FHIR JSON
+func (r *CodeSystem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CodeSystem as protobuf message CodeSystem of fhir.proto
+func (r CodeSystem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CodeSystem to the encoded message
+func (r CodeSystem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	e.varint(14, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(15, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(16, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(17, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(18, v)
+	}
+	if r.Description != nil {
+		e.string(19, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(20, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(21, v)
+	}
+	if r.Purpose != nil {
+		e.string(22, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(23, *r.Copyright)
+	}
+	if r.CaseSensitive != nil {
+		e.varint(24, protoBool(*r.CaseSensitive))
+	}
+	if r.ValueSet != nil {
+		e.string(25, *r.ValueSet)
+	}
+	if r.HierarchyMeaning != nil {
+		e.varint(26, r.HierarchyMeaning.protoNumber())
+	}
+	if r.Compositional != nil {
+		e.varint(27, protoBool(*r.Compositional))
+	}
+	if r.VersionNeeded != nil {
+		e.varint(28, protoBool(*r.VersionNeeded))
+	}
+	e.varint(29, r.Content.protoNumber())
+	if r.Supplements != nil {
+		e.string(30, *r.Supplements)
+	}
+	if r.Count != nil {
+		e.varint(31, uint64(int64(*r.Count)))
+	}
+	for _, v := range r.Filter {
+		e.message(32, v)
+	}
+	for _, v := range r.Property {
+		e.message(33, v)
+	}
+	for _, v := range r.Concept {
+		e.message(34, v)
+	}
+	if r.IdElement != nil {
+		e.message(35, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(36, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(37, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(38, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(39, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(40, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(41, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(42, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(43, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(44, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(45, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(46, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(47, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(48, *r.CopyrightElement)
+	}
+	if r.CaseSensitiveElement != nil {
+		e.message(49, *r.CaseSensitiveElement)
+	}
+	if r.ValueSetElement != nil {
+		e.message(50, *r.ValueSetElement)
+	}
+	if r.HierarchyMeaningElement != nil {
+		e.message(51, *r.HierarchyMeaningElement)
+	}
+	if r.CompositionalElement != nil {
+		e.message(52, *r.CompositionalElement)
+	}
+	if r.VersionNeededElement != nil {
+		e.message(53, *r.VersionNeededElement)
+	}
+	if r.ContentElement != nil {
+		e.message(54, *r.ContentElement)
+	}
+	if r.SupplementsElement != nil {
+		e.message(55, *r.SupplementsElement)
+	}
+	if r.CountElement != nil {
+		e.message(56, *r.CountElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CodeSystem from protobuf message CodeSystem of fhir.proto
+func (r *CodeSystem) UnmarshalProto(b []byte) error {
+	*r = CodeSystem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 15:
+			v := d.bool()
+			r.Experimental = &v
+		case 16:
+			v := d.string()
+			r.Date = &v
+		case 17:
+			v := d.string()
+			r.Publisher = &v
+		case 18:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 19:
+			v := d.string()
+			r.Description = &v
+		case 20:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 22:
+			v := d.string()
+			r.Purpose = &v
+		case 23:
+			v := d.string()
+			r.Copyright = &v
+		case 24:
+			v := d.bool()
+			r.CaseSensitive = &v
+		case 25:
+			v := d.string()
+			r.ValueSet = &v
+		case 26:
+			var v CodeSystemHierarchyMeaning
+			d.enum(d.varint(), v.setProtoNumber)
+			r.HierarchyMeaning = &v
+		case 27:
+			v := d.bool()
+			r.Compositional = &v
+		case 28:
+			v := d.bool()
+			r.VersionNeeded = &v
+		case 29:
+			var v CodeSystemContentMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Content = v
+		case 30:
+			v := d.string()
+			r.Supplements = &v
+		case 31:
+			v := d.int()
+			r.Count = &v
+		case 32:
+			var v CodeSystemFilter
+			d.message(&v)
+			r.Filter = append(r.Filter, v)
+		case 33:
+			var v CodeSystemProperty
+			d.message(&v)
+			r.Property = append(r.Property, v)
+		case 34:
+			var v CodeSystemConcept
+			d.message(&v)
+			r.Concept = append(r.Concept, v)
+		case 35:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.CaseSensitiveElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.ValueSetElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.HierarchyMeaningElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.CompositionalElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.VersionNeededElement = &v
+		case 54:
+			var v Element
+			d.message(&v)
+			r.ContentElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.SupplementsElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.CountElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CodeSystemFilter as JSON into a byte slice
+func (r CodeSystemFilter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CodeSystemFilter as JSON object with the members in the order of the fields
+func (r CodeSystemFilter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.key("operator")
+	if r.Operator == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Operator {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.OperatorElement) > 0 {
+		e.key("_operator")
+		e.elements(r.OperatorElement, len(r.Operator))
+	}
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CodeSystemFilter from JSON. Unknown members are ignored.
+func (r *CodeSystemFilter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CodeSystemFilter
+func (r *CodeSystemFilter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "operator":
+			a := d.array()
+			r.Operator = nil
+			for a.next() {
+				var v FilterOperator
+				d.unmarshal(&v)
+				r.Operator = append(r.Operator, v)
+			}
+			if r.Operator == nil && !a.isNull {
+				r.Operator = []FilterOperator{}
+			}
+		case "_operator":
+			a := d.array()
+			r.OperatorElement = nil
+			for a.next() {
+				r.OperatorElement = append(r.OperatorElement, d.element())
+			}
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CodeSystemFilter which shares no memory with the original
+func (r CodeSystemFilter) DeepCopy() CodeSystemFilter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Operator != nil {
+		out.Operator = make([]FilterOperator, len(r.Operator))
+		copy(out.Operator, r.Operator)
+	}
+	if r.OperatorElement != nil {
+		out.OperatorElement = make([]*Element, len(r.OperatorElement))
+		for i, v := range r.OperatorElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.OperatorElement[i] = &c
+			}
+		}
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CodeSystemFilter) Equal(other CodeSystemFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Operator) != len(other.Operator) {
+		return false
+	}
+	for i := range r.Operator {
+		if r.Operator[i] != other.Operator[i] {
+			return false
+		}
+	}
+	if !equalElements(r.OperatorElement, other.OperatorElement, false) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CodeSystemFilter) EqualsDeep(other CodeSystemFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Operator), len(other.Operator), func(i, j int) bool {
+		return r.Operator[i] == other.Operator[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.OperatorElement, other.OperatorElement, true) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CodeSystemFilter) EqualsShallow(other CodeSystemFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if !equivalentList(len(r.Operator), len(other.Operator), func(i, j int) bool {
+		return r.Operator[i] == other.Operator[j]
+	}) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CodeSystemFilter as FHIR XML
+func (r CodeSystemFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for i, v := range r.Operator {
+		if err := encodeXMLPrimitive(e, "operator", v, elementAt(r.OperatorElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CodeSystemFilter from FHIR XML
+func (r *CodeSystemFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "operator":
+				var v FilterOperator
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.OperatorElement = appendElement(r.OperatorElement, len(r.Operator), element)
+					r.Operator = append(r.Operator, v)
+				}
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CodeSystemFilter as properties to the RDF node n
+func (r CodeSystemFilter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CodeSystem.filter.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("CodeSystem.filter.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Operator {
+		n.primitive("CodeSystem.filter.operator", i, v, elementAt(r.OperatorElement, i), "code")
+	}
+	n.primitive("CodeSystem.filter.value", -1, r.Value, r.ValueElement, "string")
+}
+
+// MarshalBSON marshals the given CodeSystemFilter as BSON document with the structure of its FHIR JSON
+func (r CodeSystemFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CodeSystemFilter from a BSON document with the structure of its FHIR JSON
+func (r *CodeSystemFilter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CodeSystemFilter as protobuf message CodeSystemFilter of fhir.proto
+func (r CodeSystemFilter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CodeSystemFilter to the encoded message
+func (r CodeSystemFilter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != "" {
+		e.string(4, r.Code)
+	}
+	if r.Description != nil {
+		e.string(5, *r.Description)
+	}
+	if len(r.Operator) > 0 {
+		var p protoEncoder
+		for _, v := range r.Operator {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(6, p.buf)
+	}
+	if r.Value != "" {
+		e.string(7, r.Value)
+	}
+	if r.CodeElement != nil {
+		e.message(8, *r.CodeElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(9, *r.DescriptionElement)
+	}
+	for _, v := range r.OperatorElement {
+		e.element(10, v)
+	}
+	if r.ValueElement != nil {
+		e.message(11, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CodeSystemFilter from protobuf message CodeSystemFilter of fhir.proto
+func (r *CodeSystemFilter) UnmarshalProto(b []byte) error {
+	*r = CodeSystemFilter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Code = d.string()
+		case 5:
+			v := d.string()
+			r.Description = &v
+		case 6:
+			for _, n := range d.varints() {
+				var v FilterOperator
+				d.enum(n, v.setProtoNumber)
+				r.Operator = append(r.Operator, v)
+			}
+		case 7:
+			r.Value = d.string()
+		case 8:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 10:
+			r.OperatorElement = append(r.OperatorElement, d.element())
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CodeSystemProperty as JSON into a byte slice
+func (r CodeSystemProperty) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CodeSystemProperty as JSON object with the members in the order of the fields
+func (r CodeSystemProperty) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Uri != nil {
+		e.key("uri")
+		e.string(*r.Uri)
+	}
+	if r.UriElement != nil {
+		e.key("_uri")
+		r.UriElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CodeSystemProperty from JSON. Unknown members are ignored.
+func (r *CodeSystemProperty) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CodeSystemProperty
+func (r *CodeSystemProperty) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "uri":
+			if v, ok := d.string(); ok {
+				r.Uri = &v
+			} else {
+				r.Uri = nil
+			}
+		case "_uri":
+			r.UriElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CodeSystemProperty which shares no memory with the original
+func (r CodeSystemProperty) DeepCopy() CodeSystemProperty {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Uri != nil {
+		v := *r.Uri
+		out.Uri = &v
+	}
+	if r.UriElement != nil {
+		v := r.UriElement.DeepCopy()
+		out.UriElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CodeSystemProperty) Equal(other CodeSystemProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && *r.Uri != *other.Uri {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.Equal(*other.UriElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CodeSystemProperty) EqualsDeep(other CodeSystemProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && !equivalentString(*r.Uri, *other.Uri) {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.EqualsDeep(*other.UriElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CodeSystemProperty) EqualsShallow(other CodeSystemProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && !equivalentString(*r.Uri, *other.Uri) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CodeSystemProperty as FHIR XML
+func (r CodeSystemProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "uri", r.Uri, r.UriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CodeSystemProperty from FHIR XML
+func (r *CodeSystemProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "uri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Uri = &v
+				}
+				r.UriElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "type":
+				var v PropertyType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CodeSystemProperty as properties to the RDF node n
+func (r CodeSystemProperty) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CodeSystem.property.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("CodeSystem.property.uri", -1, r.Uri, r.UriElement, "string")
+	n.primitive("CodeSystem.property.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("CodeSystem.property.type", -1, r.Type, r.TypeElement, "code")
+}
+
+// MarshalBSON marshals the given CodeSystemProperty as BSON document with the structure of its FHIR JSON
+func (r CodeSystemProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CodeSystemProperty from a BSON document with the structure of its FHIR JSON
+func (r *CodeSystemProperty) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CodeSystemProperty as protobuf message CodeSystemProperty of fhir.proto
+func (r CodeSystemProperty) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CodeSystemProperty to the encoded message
+func (r CodeSystemProperty) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != "" {
+		e.string(4, r.Code)
+	}
+	if r.Uri != nil {
+		e.string(5, *r.Uri)
+	}
+	if r.Description != nil {
+		e.string(6, *r.Description)
+	}
+	e.varint(7, r.Type.protoNumber())
+	if r.CodeElement != nil {
+		e.message(8, *r.CodeElement)
+	}
+	if r.UriElement != nil {
+		e.message(9, *r.UriElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(10, *r.DescriptionElement)
+	}
+	if r.TypeElement != nil {
+		e.message(11, *r.TypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CodeSystemProperty from protobuf message CodeSystemProperty of fhir.proto
+func (r *CodeSystemProperty) UnmarshalProto(b []byte) error {
+	*r = CodeSystemProperty{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Code = d.string()
+		case 5:
+			v := d.string()
+			r.Uri = &v
+		case 6:
+			v := d.string()
+			r.Description = &v
+		case 7:
+			var v PropertyType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.UriElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CodeSystemConcept as JSON into a byte slice
+func (r CodeSystemConcept) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CodeSystemConcept as JSON object with the members in the order of the fields
+func (r CodeSystemConcept) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	if r.Definition != nil {
+		e.key("definition")
+		e.string(*r.Definition)
+	}
+	if r.DefinitionElement != nil {
+		e.key("_definition")
+		r.DefinitionElement.appendJSON(e)
+	}
+	if len(r.Designation) > 0 {
+		e.key("designation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Designation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Property) > 0 {
+		e.key("property")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Property {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Concept) > 0 {
+		e.key("concept")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Concept {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CodeSystemConcept from JSON. Unknown members are ignored.
+func (r *CodeSystemConcept) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CodeSystemConcept
+func (r *CodeSystemConcept) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		case "definition":
+			if v, ok := d.string(); ok {
+				r.Definition = &v
+			} else {
+				r.Definition = nil
+			}
+		case "_definition":
+			r.DefinitionElement = d.element()
+		case "designation":
+			a := d.array()
+			r.Designation = nil
+			for a.next() {
+				var v CodeSystemConceptDesignation
+				v.decodeJSON(d)
+				r.Designation = append(r.Designation, v)
+			}
+			if r.Designation == nil && !a.isNull {
+				r.Designation = []CodeSystemConceptDesignation{}
+			}
+		case "property":
+			a := d.array()
+			r.Property = nil
+			for a.next() {
+				var v CodeSystemConceptProperty
+				v.decodeJSON(d)
+				r.Property = append(r.Property, v)
+			}
+			if r.Property == nil && !a.isNull {
+				r.Property = []CodeSystemConceptProperty{}
+			}
+		case "concept":
+			a := d.array()
+			r.Concept = nil
+			for a.next() {
+				var v CodeSystemConcept
+				v.decodeJSON(d)
+				r.Concept = append(r.Concept, v)
+			}
+			if r.Concept == nil && !a.isNull {
+				r.Concept = []CodeSystemConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CodeSystemConcept which shares no memory with the original
+func (r CodeSystemConcept) DeepCopy() CodeSystemConcept {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	if r.Definition != nil {
+		v := *r.Definition
+		out.Definition = &v
+	}
+	if r.DefinitionElement != nil {
+		v := r.DefinitionElement.DeepCopy()
+		out.DefinitionElement = &v
+	}
+	if r.Designation != nil {
+		out.Designation = make([]CodeSystemConceptDesignation, len(r.Designation))
+		for i := range r.Designation {
+			out.Designation[i] = r.Designation[i].DeepCopy()
+		}
+	}
+	if r.Property != nil {
+		out.Property = make([]CodeSystemConceptProperty, len(r.Property))
+		for i := range r.Property {
+			out.Property[i] = r.Property[i].DeepCopy()
+		}
+	}
+	if r.Concept != nil {
+		out.Concept = make([]CodeSystemConcept, len(r.Concept))
+		for i := range r.Concept {
+			out.Concept[i] = r.Concept[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CodeSystemConcept) Equal(other CodeSystemConcept) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && *r.Definition != *other.Definition {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.Equal(*other.DefinitionElement) {
+		return false
+	}
+	if len(r.Designation) != len(other.Designation) {
+		return false
+	}
+	for i := range r.Designation {
+		if !r.Designation[i].Equal(other.Designation[i]) {
+			return false
+		}
+	}
+	if len(r.Property) != len(other.Property) {
+		return false
+	}
+	for i := range r.Property {
+		if !r.Property[i].Equal(other.Property[i]) {
+			return false
+		}
+	}
+	if len(r.Concept) != len(other.Concept) {
+		return false
+	}
+	for i := range r.Concept {
+		if !r.Concept[i].Equal(other.Concept[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CodeSystemConcept) EqualsDeep(other CodeSystemConcept) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.EqualsDeep(*other.DefinitionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Designation), len(other.Designation), func(i, j int) bool {
+		return r.Designation[i].EqualsDeep(other.Designation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Property), len(other.Property), func(i, j int) bool {
+		return r.Property[i].EqualsDeep(other.Property[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Concept), len(other.Concept), func(i, j int) bool {
+		return r.Concept[i].EqualsDeep(other.Concept[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CodeSystemConcept) EqualsShallow(other CodeSystemConcept) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CodeSystemConcept as FHIR XML
+func (r CodeSystemConcept) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Designation {
+		if err := encodeXMLElement(e, "designation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Property {
+		if err := encodeXMLElement(e, "property", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Concept {
+		if err := encodeXMLElement(e, "concept", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CodeSystemConcept from FHIR XML
+func (r *CodeSystemConcept) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			case "definition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Definition = &v
+				}
+				r.DefinitionElement = element
+			case "designation":
+				var v CodeSystemConceptDesignation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Designation = append(r.Designation, v)
+			case "property":
+				var v CodeSystemConceptProperty
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Property = append(r.Property, v)
+			case "concept":
+				var v CodeSystemConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Concept = append(r.Concept, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CodeSystemConcept as properties to the RDF node n
+func (r CodeSystemConcept) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CodeSystem.concept.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("CodeSystem.concept.display", -1, r.Display, r.DisplayElement, "string")
+	n.primitive("CodeSystem.concept.definition", -1, r.Definition, r.DefinitionElement, "string")
+	for i, v := range r.Designation {
+		n.element("CodeSystem.concept.designation", i, v)
+	}
+	for i, v := range r.Property {
+		n.element("CodeSystem.concept.property", i, v)
+	}
+	for i, v := range r.Concept {
+		n.element("CodeSystem.concept.concept", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CodeSystemConcept as BSON document with the structure of its FHIR JSON
+func (r CodeSystemConcept) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CodeSystemConcept from a BSON document with the structure of its FHIR JSON
+func (r *CodeSystemConcept) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CodeSystemConcept as protobuf message CodeSystemConcept of fhir.proto
+func (r CodeSystemConcept) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CodeSystemConcept to the encoded message
+func (r CodeSystemConcept) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != "" {
+		e.string(4, r.Code)
+	}
+	if r.Display != nil {
+		e.string(5, *r.Display)
+	}
+	if r.Definition != nil {
+		e.string(6, *r.Definition)
+	}
+	for _, v := range r.Designation {
+		e.message(7, v)
+	}
+	for _, v := range r.Property {
+		e.message(8, v)
+	}
+	for _, v := range r.Concept {
+		e.message(9, v)
+	}
+	if r.CodeElement != nil {
+		e.message(10, *r.CodeElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(11, *r.DisplayElement)
+	}
+	if r.DefinitionElement != nil {
+		e.message(12, *r.DefinitionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CodeSystemConcept from protobuf message CodeSystemConcept of fhir.proto
+func (r *CodeSystemConcept) UnmarshalProto(b []byte) error {
+	*r = CodeSystemConcept{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Code = d.string()
+		case 5:
+			v := d.string()
+			r.Display = &v
+		case 6:
+			v := d.string()
+			r.Definition = &v
+		case 7:
+			var v CodeSystemConceptDesignation
+			d.message(&v)
+			r.Designation = append(r.Designation, v)
+		case 8:
+			var v CodeSystemConceptProperty
+			d.message(&v)
+			r.Property = append(r.Property, v)
+		case 9:
+			var v CodeSystemConcept
+			d.message(&v)
+			r.Concept = append(r.Concept, v)
+		case 10:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.DefinitionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CodeSystemConceptDesignation as JSON into a byte slice
+func (r CodeSystemConceptDesignation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CodeSystemConceptDesignation as JSON object with the members in the order of the fields
+func (r CodeSystemConceptDesignation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Use != nil {
+		e.key("use")
+		r.Use.appendJSON(e)
+	}
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CodeSystemConceptDesignation from JSON. Unknown members are ignored.
+func (r *CodeSystemConceptDesignation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CodeSystemConceptDesignation
+func (r *CodeSystemConceptDesignation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "use":
+			if d.null() {
+				r.Use = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Use = &v
+			}
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CodeSystemConceptDesignation which shares no memory with the original
+func (r CodeSystemConceptDesignation) DeepCopy() CodeSystemConceptDesignation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Use != nil {
+		v := r.Use.DeepCopy()
+		out.Use = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CodeSystemConceptDesignation) Equal(other CodeSystemConceptDesignation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && !r.Use.Equal(*other.Use) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CodeSystemConceptDesignation) EqualsDeep(other CodeSystemConceptDesignation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && !r.Use.EqualsDeep(*other.Use) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CodeSystemConceptDesignation) EqualsShallow(other CodeSystemConceptDesignation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CodeSystemConceptDesignation as FHIR XML
+func (r CodeSystemConceptDesignation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "use", r.Use); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CodeSystemConceptDesignation from FHIR XML
+func (r *CodeSystemConceptDesignation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "use":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Use = &v
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CodeSystemConceptDesignation as properties to the RDF node n
+func (r CodeSystemConceptDesignation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CodeSystem.concept.designation.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("CodeSystem.concept.designation.use", -1, r.Use)
+	n.primitive("CodeSystem.concept.designation.value", -1, r.Value, r.ValueElement, "string")
+}
+
+// MarshalBSON marshals the given CodeSystemConceptDesignation as BSON document with the structure of its FHIR JSON
+func (r CodeSystemConceptDesignation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CodeSystemConceptDesignation from a BSON document with the structure of its FHIR JSON
+func (r *CodeSystemConceptDesignation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CodeSystemConceptDesignation as protobuf message CodeSystemConceptDesignation of fhir.proto
+func (r CodeSystemConceptDesignation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CodeSystemConceptDesignation to the encoded message
+func (r CodeSystemConceptDesignation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Use != nil {
+		e.message(5, *r.Use)
+	}
+	if r.Value != "" {
+		e.string(6, r.Value)
+	}
+	if r.LanguageElement != nil {
+		e.message(7, *r.LanguageElement)
+	}
+	if r.ValueElement != nil {
+		e.message(8, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CodeSystemConceptDesignation from protobuf message CodeSystemConceptDesignation of fhir.proto
+func (r *CodeSystemConceptDesignation) UnmarshalProto(b []byte) error {
+	*r = CodeSystemConceptDesignation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Coding
+			d.message(&v)
+			r.Use = &v
+		case 6:
+			r.Value = d.string()
+		case 7:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CodeSystemConceptProperty as JSON into a byte slice
+func (r CodeSystemConceptProperty) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CodeSystemConceptProperty as JSON object with the members in the order of the fields
+func (r CodeSystemConceptProperty) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.ValueCode != nil {
+		e.key("valueCode")
+		e.string(*r.ValueCode)
+	}
+	if r.ValueCodeElement != nil {
+		e.key("_valueCode")
+		r.ValueCodeElement.appendJSON(e)
+	}
+	if r.ValueCoding != nil {
+		e.key("valueCoding")
+		r.ValueCoding.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValueDecimal != nil {
+		e.key("valueDecimal")
+		e.number(*r.ValueDecimal)
+	}
+	if r.ValueDecimalElement != nil {
+		e.key("_valueDecimal")
+		r.ValueDecimalElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CodeSystemConceptProperty from JSON. Unknown members are ignored.
+func (r *CodeSystemConceptProperty) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CodeSystemConceptProperty
+func (r *CodeSystemConceptProperty) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "valueCode":
+			if v, ok := d.string(); ok {
+				r.ValueCode = &v
+			} else {
+				r.ValueCode = nil
+			}
+		case "_valueCode":
+			r.ValueCodeElement = d.element()
+		case "valueCoding":
+			if d.null() {
+				r.ValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.ValueCoding = &v
+			}
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valueDecimal":
+			if v, ok := d.number(); ok {
+				r.ValueDecimal = &v
+			} else {
+				r.ValueDecimal = nil
+			}
+		case "_valueDecimal":
+			r.ValueDecimalElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CodeSystemConceptProperty which shares no memory with the original
+func (r CodeSystemConceptProperty) DeepCopy() CodeSystemConceptProperty {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.ValueCode != nil {
+		v := *r.ValueCode
+		out.ValueCode = &v
+	}
+	if r.ValueCodeElement != nil {
+		v := r.ValueCodeElement.DeepCopy()
+		out.ValueCodeElement = &v
+	}
+	if r.ValueCoding != nil {
+		v := r.ValueCoding.DeepCopy()
+		out.ValueCoding = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValueDecimal != nil {
+		v := *r.ValueDecimal
+		out.ValueDecimal = &v
+	}
+	if r.ValueDecimalElement != nil {
+		v := r.ValueDecimalElement.DeepCopy()
+		out.ValueDecimalElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CodeSystemConceptProperty) Equal(other CodeSystemConceptProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && *r.ValueCode != *other.ValueCode {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.Equal(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.Equal(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !EqualDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.Equal(*other.ValueDecimalElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CodeSystemConceptProperty) EqualsDeep(other CodeSystemConceptProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.EqualsDeep(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.EqualsDeep(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.EqualsDeep(*other.ValueDecimalElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CodeSystemConceptProperty) EqualsShallow(other CodeSystemConceptProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CodeSystemConceptProperty as FHIR XML
+func (r CodeSystemConceptProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCode", r.ValueCode, r.ValueCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCoding", r.ValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDecimal", r.ValueDecimal, r.ValueDecimalElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CodeSystemConceptProperty from FHIR XML
+func (r *CodeSystemConceptProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "valueCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCode = &v
+				}
+				r.ValueCodeElement = element
+			case "valueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCoding = &v
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDecimal = &v
+				}
+				r.ValueDecimalElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CodeSystemConceptProperty as properties to the RDF node n
+func (r CodeSystemConceptProperty) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CodeSystem.concept.property.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("CodeSystem.concept.property.valueCode", -1, r.ValueCode, r.ValueCodeElement, "code")
+	n.element("CodeSystem.concept.property.valueCoding", -1, r.ValueCoding)
+	n.primitive("CodeSystem.concept.property.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("CodeSystem.concept.property.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("CodeSystem.concept.property.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("CodeSystem.concept.property.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.primitive("CodeSystem.concept.property.valueDecimal", -1, r.ValueDecimal, r.ValueDecimalElement, "decimal")
+}
+
+// MarshalBSON marshals the given CodeSystemConceptProperty as BSON document with the structure of its FHIR JSON
+func (r CodeSystemConceptProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CodeSystemConceptProperty from a BSON document with the structure of its FHIR JSON
+func (r *CodeSystemConceptProperty) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CodeSystemConceptProperty as protobuf message CodeSystemConceptProperty of fhir.proto
+func (r CodeSystemConceptProperty) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CodeSystemConceptProperty to the encoded message
+func (r CodeSystemConceptProperty) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != "" {
+		e.string(4, r.Code)
+	}
+	if r.ValueCode != nil {
+		e.string(5, *r.ValueCode)
+	}
+	if r.ValueCoding != nil {
+		e.message(6, *r.ValueCoding)
+	}
+	if r.ValueString != nil {
+		e.string(7, *r.ValueString)
+	}
+	if r.ValueInteger != nil {
+		e.varint(8, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueBoolean != nil {
+		e.varint(9, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueDateTime != nil {
+		e.string(10, *r.ValueDateTime)
+	}
+	if r.ValueDecimal != nil {
+		e.string(11, string(*r.ValueDecimal))
+	}
+	if r.CodeElement != nil {
+		e.message(12, *r.CodeElement)
+	}
+	if r.ValueCodeElement != nil {
+		e.message(13, *r.ValueCodeElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(14, *r.ValueStringElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(15, *r.ValueIntegerElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(16, *r.ValueBooleanElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(17, *r.ValueDateTimeElement)
+	}
+	if r.ValueDecimalElement != nil {
+		e.message(18, *r.ValueDecimalElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CodeSystemConceptProperty from protobuf message CodeSystemConceptProperty of fhir.proto
+func (r *CodeSystemConceptProperty) UnmarshalProto(b []byte) error {
+	*r = CodeSystemConceptProperty{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Code = d.string()
+		case 5:
+			v := d.string()
+			r.ValueCode = &v
+		case 6:
+			var v Coding
+			d.message(&v)
+			r.ValueCoding = &v
+		case 7:
+			v := d.string()
+			r.ValueString = &v
+		case 8:
+			v := d.int()
+			r.ValueInteger = &v
+		case 9:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 10:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 11:
+			v := json.Number(d.string())
+			r.ValueDecimal = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.ValueCodeElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ValueDecimalElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCodeSystem unmarshals a CodeSystem.
 func UnmarshalCodeSystem(b []byte) (CodeSystem, error) {
 	var codeSystem CodeSystem
-	if err := json.Unmarshal(b, &codeSystem); err != nil {
+	if err := codeSystem.UnmarshalJSON(b); err != nil {
 		return codeSystem, err
 	}
 	return codeSystem, nil
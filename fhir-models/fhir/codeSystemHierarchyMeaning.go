@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code CodeSystemHierarchyMeaning) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CodeSystemHierarchyMeaning) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CodeSystemHierarchyMeaning) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "grouped-by":
 		*code = CodeSystemHierarchyMeaningGroupedBy
@@ -52,6 +56,12 @@ func (code *CodeSystemHierarchyMeaning) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CodeSystemHierarchyMeaning) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CodeSystemHierarchyMeaning) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CodeSystemHierarchyMeaning) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code CodeSystemHierarchyMeaning) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CodeSystemHierarchyMeaning) protoNumber() uint64 {
+	switch code {
+	case CodeSystemHierarchyMeaningGroupedBy:
+		return 1
+	case CodeSystemHierarchyMeaningIsA:
+		return 2
+	case CodeSystemHierarchyMeaningPartOf:
+		return 3
+	case CodeSystemHierarchyMeaningClassifiedWith:
+		return 4
+	}
+	return 0
+}
+func (code *CodeSystemHierarchyMeaning) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CodeSystemHierarchyMeaningGroupedBy
+	case 2:
+		*code = CodeSystemHierarchyMeaningIsA
+	case 3:
+		*code = CodeSystemHierarchyMeaningPartOf
+	case 4:
+		*code = CodeSystemHierarchyMeaningClassifiedWith
+	default:
+		return false
+	}
+	return true
+}
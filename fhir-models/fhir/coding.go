@@ -14,16 +14,579 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Coding is documented here http://hl7.org/fhir/StructureDefinition/Coding
 type Coding struct {
-	Id           *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension    []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	System       *string     `bson:"system,omitempty" json:"system,omitempty"`
-	Version      *string     `bson:"version,omitempty" json:"version,omitempty"`
-	Code         *string     `bson:"code,omitempty" json:"code,omitempty"`
-	Display      *string     `bson:"display,omitempty" json:"display,omitempty"`
-	UserSelected *bool       `bson:"userSelected,omitempty" json:"userSelected,omitempty"`
+	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	System              *string     `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement       *Element    `bson:"_system,omitempty" json:"_system,omitempty"`
+	Version             *string     `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement      *Element    `bson:"_version,omitempty" json:"_version,omitempty"`
+	Code                *string     `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement         *Element    `bson:"_code,omitempty" json:"_code,omitempty"`
+	Display             *string     `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement      *Element    `bson:"_display,omitempty" json:"_display,omitempty"`
+	UserSelected        *bool       `bson:"userSelected,omitempty" json:"userSelected,omitempty"`
+	UserSelectedElement *Element    `bson:"_userSelected,omitempty" json:"_userSelected,omitempty"`
+}
+
+// MarshalJSON marshals the given Coding as JSON into a byte slice
+func (r Coding) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Coding as JSON object with the members in the order of the fields
+func (r Coding) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.System != nil {
+		e.key("system")
+		e.string(*r.System)
+	}
+	if r.SystemElement != nil {
+		e.key("_system")
+		r.SystemElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		e.string(*r.Code)
+	}
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	if r.UserSelected != nil {
+		e.key("userSelected")
+		e.bool(*r.UserSelected)
+	}
+	if r.UserSelectedElement != nil {
+		e.key("_userSelected")
+		r.UserSelectedElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Coding from JSON. Unknown members are ignored.
+func (r *Coding) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Coding
+func (r *Coding) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "system":
+			if v, ok := d.string(); ok {
+				r.System = &v
+			} else {
+				r.System = nil
+			}
+		case "_system":
+			r.SystemElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = &v
+			} else {
+				r.Code = nil
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		case "userSelected":
+			if v, ok := d.bool(); ok {
+				r.UserSelected = &v
+			} else {
+				r.UserSelected = nil
+			}
+		case "_userSelected":
+			r.UserSelectedElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Coding which shares no memory with the original
+func (r Coding) DeepCopy() Coding {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.System != nil {
+		v := *r.System
+		out.System = &v
+	}
+	if r.SystemElement != nil {
+		v := r.SystemElement.DeepCopy()
+		out.SystemElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Code != nil {
+		v := *r.Code
+		out.Code = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	if r.UserSelected != nil {
+		v := *r.UserSelected
+		out.UserSelected = &v
+	}
+	if r.UserSelectedElement != nil {
+		v := r.UserSelectedElement.DeepCopy()
+		out.UserSelectedElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Coding) Equal(other Coding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && *r.System != *other.System {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.Equal(*other.SystemElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && *r.Code != *other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	if (r.UserSelected == nil) != (other.UserSelected == nil) || r.UserSelected != nil && *r.UserSelected != *other.UserSelected {
+		return false
+	}
+	if (r.UserSelectedElement == nil) != (other.UserSelectedElement == nil) || r.UserSelectedElement != nil && !r.UserSelectedElement.Equal(*other.UserSelectedElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Coding) EqualsDeep(other Coding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.EqualsDeep(*other.SystemElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	if (r.UserSelected == nil) != (other.UserSelected == nil) || r.UserSelected != nil && *r.UserSelected != *other.UserSelected {
+		return false
+	}
+	if (r.UserSelectedElement == nil) != (other.UserSelectedElement == nil) || r.UserSelectedElement != nil && !r.UserSelectedElement.EqualsDeep(*other.UserSelectedElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Coding) EqualsShallow(other Coding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.UserSelected == nil) != (other.UserSelected == nil) || r.UserSelected != nil && *r.UserSelected != *other.UserSelected {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Coding as FHIR XML
+func (r Coding) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "system", r.System, r.SystemElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "userSelected", r.UserSelected, r.UserSelectedElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Coding from FHIR XML
+func (r *Coding) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "system":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.System = &v
+				}
+				r.SystemElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = &v
+				}
+				r.CodeElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			case "userSelected":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.UserSelected = &v
+				}
+				r.UserSelectedElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Coding as properties to the RDF node n
+func (r Coding) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Coding.system", -1, r.System, r.SystemElement, "string")
+	n.primitive("Coding.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("Coding.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("Coding.display", -1, r.Display, r.DisplayElement, "string")
+	n.primitive("Coding.userSelected", -1, r.UserSelected, r.UserSelectedElement, "boolean")
+	n.concept(r.System, r.Code)
+}
+
+// MarshalBSON marshals the given Coding as BSON document with the structure of its FHIR JSON
+func (r Coding) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Coding from a BSON document with the structure of its FHIR JSON
+func (r *Coding) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Coding as protobuf message Coding of fhir.proto
+func (r Coding) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Coding to the encoded message
+func (r Coding) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.System != nil {
+		e.string(3, *r.System)
+	}
+	if r.Version != nil {
+		e.string(4, *r.Version)
+	}
+	if r.Code != nil {
+		e.string(5, *r.Code)
+	}
+	if r.Display != nil {
+		e.string(6, *r.Display)
+	}
+	if r.UserSelected != nil {
+		e.varint(7, protoBool(*r.UserSelected))
+	}
+	if r.SystemElement != nil {
+		e.message(8, *r.SystemElement)
+	}
+	if r.VersionElement != nil {
+		e.message(9, *r.VersionElement)
+	}
+	if r.CodeElement != nil {
+		e.message(10, *r.CodeElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(11, *r.DisplayElement)
+	}
+	if r.UserSelectedElement != nil {
+		e.message(12, *r.UserSelectedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Coding from protobuf message Coding of fhir.proto
+func (r *Coding) UnmarshalProto(b []byte) error {
+	*r = Coding{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.System = &v
+		case 4:
+			v := d.string()
+			r.Version = &v
+		case 5:
+			v := d.string()
+			r.Code = &v
+		case 6:
+			v := d.string()
+			r.Display = &v
+		case 7:
+			v := d.bool()
+			r.UserSelected = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.SystemElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.UserSelectedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -14,69 +14,2484 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Communication is documented here http://hl7.org/fhir/StructureDefinition/Communication
 type Communication struct {
-	Id                    *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string               `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string               `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference            `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf                []Reference            `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	InResponseTo          []Reference            `bson:"inResponseTo,omitempty" json:"inResponseTo,omitempty"`
-	Status                EventStatus            `bson:"status" json:"status"`
-	StatusReason          *CodeableConcept       `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Category              []CodeableConcept      `bson:"category,omitempty" json:"category,omitempty"`
-	Priority              *RequestPriority       `bson:"priority,omitempty" json:"priority,omitempty"`
-	Medium                []CodeableConcept      `bson:"medium,omitempty" json:"medium,omitempty"`
-	Subject               *Reference             `bson:"subject,omitempty" json:"subject,omitempty"`
-	Topic                 *CodeableConcept       `bson:"topic,omitempty" json:"topic,omitempty"`
-	About                 []Reference            `bson:"about,omitempty" json:"about,omitempty"`
-	Encounter             *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Sent                  *string                `bson:"sent,omitempty" json:"sent,omitempty"`
-	Received              *string                `bson:"received,omitempty" json:"received,omitempty"`
-	Recipient             []Reference            `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Sender                *Reference             `bson:"sender,omitempty" json:"sender,omitempty"`
-	ReasonCode            []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Payload               []CommunicationPayload `bson:"payload,omitempty" json:"payload,omitempty"`
-	Note                  []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
+	Id                           *string                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element               `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []string               `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element             `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string               `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element             `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference            `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                       []Reference            `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	InResponseTo                 []Reference            `bson:"inResponseTo,omitempty" json:"inResponseTo,omitempty"`
+	Status                       EventStatus            `bson:"status" json:"status"`
+	StatusElement                *Element               `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept       `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Category                     []CodeableConcept      `bson:"category,omitempty" json:"category,omitempty"`
+	Priority                     *RequestPriority       `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element               `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Medium                       []CodeableConcept      `bson:"medium,omitempty" json:"medium,omitempty"`
+	Subject                      *Reference             `bson:"subject,omitempty" json:"subject,omitempty"`
+	Topic                        *CodeableConcept       `bson:"topic,omitempty" json:"topic,omitempty"`
+	About                        []Reference            `bson:"about,omitempty" json:"about,omitempty"`
+	Encounter                    *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Sent                         *string                `bson:"sent,omitempty" json:"sent,omitempty"`
+	SentElement                  *Element               `bson:"_sent,omitempty" json:"_sent,omitempty"`
+	Received                     *string                `bson:"received,omitempty" json:"received,omitempty"`
+	ReceivedElement              *Element               `bson:"_received,omitempty" json:"_received,omitempty"`
+	Recipient                    []Reference            `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Sender                       *Reference             `bson:"sender,omitempty" json:"sender,omitempty"`
+	ReasonCode                   []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Payload                      []CommunicationPayload `bson:"payload,omitempty" json:"payload,omitempty"`
+	Note                         []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
 }
 type CommunicationPayload struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ContentString     string      `bson:"contentString" json:"contentString"`
-	ContentAttachment Attachment  `bson:"contentAttachment" json:"contentAttachment"`
-	ContentReference  Reference   `bson:"contentReference" json:"contentReference"`
+	Id                   *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ContentString        *string     `bson:"contentString,omitempty" json:"contentString,omitempty"`
+	ContentStringElement *Element    `bson:"_contentString,omitempty" json:"_contentString,omitempty"`
+	ContentAttachment    *Attachment `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference     *Reference  `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+}
+
+// CommunicationPayloadContent is implemented by the types allowed for Communication.payload.content[x]
+type CommunicationPayloadContent interface {
+	isCommunicationPayloadContent()
+}
+
+func (String) isCommunicationPayloadContent()     {}
+func (Attachment) isCommunicationPayloadContent() {}
+func (Reference) isCommunicationPayloadContent()  {}
+
+// Content returns the value of Communication.payload.content[x] together with its FHIR type code or nil if no value is set
+func (r CommunicationPayload) Content() (CommunicationPayloadContent, string) {
+	if r.ContentString != nil {
+		return String(*r.ContentString), "string"
+	}
+	if r.ContentAttachment != nil {
+		return *r.ContentAttachment, "Attachment"
+	}
+	if r.ContentReference != nil {
+		return *r.ContentReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetContent sets the value of Communication.payload.content[x], given as value or pointer, and clears all other types
+func (r *CommunicationPayload) SetContent(value CommunicationPayloadContent) {
+	r.ContentString = nil
+	r.ContentAttachment = nil
+	r.ContentReference = nil
+	switch v := value.(type) {
+	case String:
+		primitive := string(v)
+		r.ContentString = &primitive
+	case *String:
+		r.ContentString = (*string)(v)
+	case Attachment:
+		r.ContentAttachment = &v
+	case *Attachment:
+		r.ContentAttachment = v
+	case Reference:
+		r.ContentReference = &v
+	case *Reference:
+		r.ContentReference = v
+	}
 }
-type OtherCommunication Communication
 
 // MarshalJSON marshals the given Communication as JSON into a byte slice
 func (r Communication) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCommunication
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCommunication: OtherCommunication(r),
-		ResourceType:       "Communication",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Communication as JSON object with the members in the order of the fields
+func (r Communication) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Communication\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InResponseTo) > 0 {
+		e.key("inResponseTo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InResponseTo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusReason != nil {
+		e.key("statusReason")
+		r.StatusReason.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if len(r.Medium) > 0 {
+		e.key("medium")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Medium {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Topic != nil {
+		e.key("topic")
+		r.Topic.appendJSON(e)
+	}
+	if len(r.About) > 0 {
+		e.key("about")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.About {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.Sent != nil {
+		e.key("sent")
+		e.string(*r.Sent)
+	}
+	if r.SentElement != nil {
+		e.key("_sent")
+		r.SentElement.appendJSON(e)
+	}
+	if r.Received != nil {
+		e.key("received")
+		e.string(*r.Received)
+	}
+	if r.ReceivedElement != nil {
+		e.key("_received")
+		r.ReceivedElement.appendJSON(e)
+	}
+	if len(r.Recipient) > 0 {
+		e.key("recipient")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Recipient {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Sender != nil {
+		e.key("sender")
+		r.Sender.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Payload) > 0 {
+		e.key("payload")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Payload {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Communication from JSON. Unknown members are ignored.
+func (r *Communication) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Communication
+func (r *Communication) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "inResponseTo":
+			a := d.array()
+			r.InResponseTo = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.InResponseTo = append(r.InResponseTo, v)
+			}
+			if r.InResponseTo == nil && !a.isNull {
+				r.InResponseTo = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			if d.null() {
+				r.StatusReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = &v
+			}
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "medium":
+			a := d.array()
+			r.Medium = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Medium = append(r.Medium, v)
+			}
+			if r.Medium == nil && !a.isNull {
+				r.Medium = []CodeableConcept{}
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "topic":
+			if d.null() {
+				r.Topic = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Topic = &v
+			}
+		case "about":
+			a := d.array()
+			r.About = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.About = append(r.About, v)
+			}
+			if r.About == nil && !a.isNull {
+				r.About = []Reference{}
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "sent":
+			if v, ok := d.string(); ok {
+				r.Sent = &v
+			} else {
+				r.Sent = nil
+			}
+		case "_sent":
+			r.SentElement = d.element()
+		case "received":
+			if v, ok := d.string(); ok {
+				r.Received = &v
+			} else {
+				r.Received = nil
+			}
+		case "_received":
+			r.ReceivedElement = d.element()
+		case "recipient":
+			a := d.array()
+			r.Recipient = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recipient = append(r.Recipient, v)
+			}
+			if r.Recipient == nil && !a.isNull {
+				r.Recipient = []Reference{}
+			}
+		case "sender":
+			if d.null() {
+				r.Sender = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Sender = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "payload":
+			a := d.array()
+			r.Payload = nil
+			for a.next() {
+				var v CommunicationPayload
+				v.decodeJSON(d)
+				r.Payload = append(r.Payload, v)
+			}
+			if r.Payload == nil && !a.isNull {
+				r.Payload = []CommunicationPayload{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Communication which shares no memory with the original
+func (r Communication) DeepCopy() Communication {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.InResponseTo != nil {
+		out.InResponseTo = make([]Reference, len(r.InResponseTo))
+		for i := range r.InResponseTo {
+			out.InResponseTo[i] = r.InResponseTo[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		v := r.StatusReason.DeepCopy()
+		out.StatusReason = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.Medium != nil {
+		out.Medium = make([]CodeableConcept, len(r.Medium))
+		for i := range r.Medium {
+			out.Medium[i] = r.Medium[i].DeepCopy()
+		}
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Topic != nil {
+		v := r.Topic.DeepCopy()
+		out.Topic = &v
+	}
+	if r.About != nil {
+		out.About = make([]Reference, len(r.About))
+		for i := range r.About {
+			out.About[i] = r.About[i].DeepCopy()
+		}
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.Sent != nil {
+		v := *r.Sent
+		out.Sent = &v
+	}
+	if r.SentElement != nil {
+		v := r.SentElement.DeepCopy()
+		out.SentElement = &v
+	}
+	if r.Received != nil {
+		v := *r.Received
+		out.Received = &v
+	}
+	if r.ReceivedElement != nil {
+		v := r.ReceivedElement.DeepCopy()
+		out.ReceivedElement = &v
+	}
+	if r.Recipient != nil {
+		out.Recipient = make([]Reference, len(r.Recipient))
+		for i := range r.Recipient {
+			out.Recipient[i] = r.Recipient[i].DeepCopy()
+		}
+	}
+	if r.Sender != nil {
+		v := r.Sender.DeepCopy()
+		out.Sender = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Payload != nil {
+		out.Payload = make([]CommunicationPayload, len(r.Payload))
+		for i := range r.Payload {
+			out.Payload[i] = r.Payload[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Communication) Equal(other Communication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if len(r.InResponseTo) != len(other.InResponseTo) {
+		return false
+	}
+	for i := range r.InResponseTo {
+		if !r.InResponseTo[i].Equal(other.InResponseTo[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.Equal(*other.StatusReason) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if len(r.Medium) != len(other.Medium) {
+		return false
+	}
+	for i := range r.Medium {
+		if !r.Medium[i].Equal(other.Medium[i]) {
+			return false
+		}
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Topic == nil) != (other.Topic == nil) || r.Topic != nil && !r.Topic.Equal(*other.Topic) {
+		return false
+	}
+	if len(r.About) != len(other.About) {
+		return false
+	}
+	for i := range r.About {
+		if !r.About[i].Equal(other.About[i]) {
+			return false
+		}
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.Sent == nil) != (other.Sent == nil) || r.Sent != nil && *r.Sent != *other.Sent {
+		return false
+	}
+	if (r.SentElement == nil) != (other.SentElement == nil) || r.SentElement != nil && !r.SentElement.Equal(*other.SentElement) {
+		return false
+	}
+	if (r.Received == nil) != (other.Received == nil) || r.Received != nil && *r.Received != *other.Received {
+		return false
+	}
+	if (r.ReceivedElement == nil) != (other.ReceivedElement == nil) || r.ReceivedElement != nil && !r.ReceivedElement.Equal(*other.ReceivedElement) {
+		return false
+	}
+	if len(r.Recipient) != len(other.Recipient) {
+		return false
+	}
+	for i := range r.Recipient {
+		if !r.Recipient[i].Equal(other.Recipient[i]) {
+			return false
+		}
+	}
+	if (r.Sender == nil) != (other.Sender == nil) || r.Sender != nil && !r.Sender.Equal(*other.Sender) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Payload) != len(other.Payload) {
+		return false
+	}
+	for i := range r.Payload {
+		if !r.Payload[i].Equal(other.Payload[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Communication) EqualsDeep(other Communication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InResponseTo), len(other.InResponseTo), func(i, j int) bool {
+		return r.InResponseTo[i].EqualsDeep(other.InResponseTo[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.EqualsDeep(*other.StatusReason) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if !equivalentList(len(r.Medium), len(other.Medium), func(i, j int) bool {
+		return r.Medium[i].EqualsDeep(other.Medium[j])
+	}) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Topic == nil) != (other.Topic == nil) || r.Topic != nil && !r.Topic.EqualsDeep(*other.Topic) {
+		return false
+	}
+	if !equivalentList(len(r.About), len(other.About), func(i, j int) bool {
+		return r.About[i].EqualsDeep(other.About[j])
+	}) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.Sent == nil) != (other.Sent == nil) || r.Sent != nil && !equivalentString(*r.Sent, *other.Sent) {
+		return false
+	}
+	if (r.SentElement == nil) != (other.SentElement == nil) || r.SentElement != nil && !r.SentElement.EqualsDeep(*other.SentElement) {
+		return false
+	}
+	if (r.Received == nil) != (other.Received == nil) || r.Received != nil && !equivalentString(*r.Received, *other.Received) {
+		return false
+	}
+	if (r.ReceivedElement == nil) != (other.ReceivedElement == nil) || r.ReceivedElement != nil && !r.ReceivedElement.EqualsDeep(*other.ReceivedElement) {
+		return false
+	}
+	if !equivalentList(len(r.Recipient), len(other.Recipient), func(i, j int) bool {
+		return r.Recipient[i].EqualsDeep(other.Recipient[j])
+	}) {
+		return false
+	}
+	if (r.Sender == nil) != (other.Sender == nil) || r.Sender != nil && !r.Sender.EqualsDeep(*other.Sender) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Payload), len(other.Payload), func(i, j int) bool {
+		return r.Payload[i].EqualsDeep(other.Payload[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Communication) EqualsShallow(other Communication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.Sent == nil) != (other.Sent == nil) || r.Sent != nil && !equivalentString(*r.Sent, *other.Sent) {
+		return false
+	}
+	if (r.Received == nil) != (other.Received == nil) || r.Received != nil && !equivalentString(*r.Received, *other.Received) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Communication as FHIR XML
+func (r Communication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Communication",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.InResponseTo {
+		if err := encodeXMLElement(e, "inResponseTo", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReason", r.StatusReason); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	for _, v := range r.Medium {
+		if err := encodeXMLElement(e, "medium", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "topic", r.Topic); err != nil {
+		return err
+	}
+	for _, v := range r.About {
+		if err := encodeXMLElement(e, "about", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sent", r.Sent, r.SentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "received", r.Received, r.ReceivedElement); err != nil {
+		return err
+	}
+	for _, v := range r.Recipient {
+		if err := encodeXMLElement(e, "recipient", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "sender", r.Sender); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Payload {
+		if err := encodeXMLElement(e, "payload", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Communication from FHIR XML
+func (r *Communication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "inResponseTo":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.InResponseTo = append(r.InResponseTo, v)
+			case "status":
+				var v EventStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "medium":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Medium = append(r.Medium, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "topic":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Topic = &v
+			case "about":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.About = append(r.About, v)
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "sent":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sent = &v
+				}
+				r.SentElement = element
+			case "received":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Received = &v
+				}
+				r.ReceivedElement = element
+			case "recipient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recipient = append(r.Recipient, v)
+			case "sender":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Sender = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "payload":
+				var v CommunicationPayload
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payload = append(r.Payload, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Communication as properties to the RDF node n
+func (r Communication) turtle(n *turtleNode) {
+	n.resource("Communication", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Communication.identifier", i, v)
+	}
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("Communication.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("Communication.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	for i, v := range r.BasedOn {
+		n.element("Communication.basedOn", i, v)
+	}
+	for i, v := range r.PartOf {
+		n.element("Communication.partOf", i, v)
+	}
+	for i, v := range r.InResponseTo {
+		n.element("Communication.inResponseTo", i, v)
+	}
+	n.primitive("Communication.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Communication.statusReason", -1, r.StatusReason)
+	for i, v := range r.Category {
+		n.element("Communication.category", i, v)
+	}
+	n.primitive("Communication.priority", -1, r.Priority, r.PriorityElement, "code")
+	for i, v := range r.Medium {
+		n.element("Communication.medium", i, v)
+	}
+	n.element("Communication.subject", -1, r.Subject)
+	n.element("Communication.topic", -1, r.Topic)
+	for i, v := range r.About {
+		n.element("Communication.about", i, v)
+	}
+	n.element("Communication.encounter", -1, r.Encounter)
+	n.primitive("Communication.sent", -1, r.Sent, r.SentElement, "dateTime")
+	n.primitive("Communication.received", -1, r.Received, r.ReceivedElement, "dateTime")
+	for i, v := range r.Recipient {
+		n.element("Communication.recipient", i, v)
+	}
+	n.element("Communication.sender", -1, r.Sender)
+	for i, v := range r.ReasonCode {
+		n.element("Communication.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("Communication.reasonReference", i, v)
+	}
+	for i, v := range r.Payload {
+		n.element("Communication.payload", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("Communication.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Communication as BSON document with the structure of its FHIR JSON
+func (r Communication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Communication from a BSON document with the structure of its FHIR JSON
+func (r *Communication) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Communication as protobuf message Communication of fhir.proto
+func (r Communication) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Communication to the encoded message
+func (r Communication) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(10, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(11, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(12, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(13, v)
+	}
+	for _, v := range r.InResponseTo {
+		e.message(14, v)
+	}
+	e.varint(15, r.Status.protoNumber())
+	if r.StatusReason != nil {
+		e.message(16, *r.StatusReason)
+	}
+	for _, v := range r.Category {
+		e.message(17, v)
+	}
+	if r.Priority != nil {
+		e.varint(18, r.Priority.protoNumber())
+	}
+	for _, v := range r.Medium {
+		e.message(19, v)
+	}
+	if r.Subject != nil {
+		e.message(20, *r.Subject)
+	}
+	if r.Topic != nil {
+		e.message(21, *r.Topic)
+	}
+	for _, v := range r.About {
+		e.message(22, v)
+	}
+	if r.Encounter != nil {
+		e.message(23, *r.Encounter)
+	}
+	if r.Sent != nil {
+		e.string(24, *r.Sent)
+	}
+	if r.Received != nil {
+		e.string(25, *r.Received)
+	}
+	for _, v := range r.Recipient {
+		e.message(26, v)
+	}
+	if r.Sender != nil {
+		e.message(27, *r.Sender)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(28, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(29, v)
+	}
+	for _, v := range r.Payload {
+		e.message(30, v)
+	}
+	for _, v := range r.Note {
+		e.message(31, v)
+	}
+	if r.IdElement != nil {
+		e.message(32, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(33, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(34, *r.LanguageElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(35, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(36, v)
+	}
+	if r.StatusElement != nil {
+		e.message(37, *r.StatusElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(38, *r.PriorityElement)
+	}
+	if r.SentElement != nil {
+		e.message(39, *r.SentElement)
+	}
+	if r.ReceivedElement != nil {
+		e.message(40, *r.ReceivedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Communication from protobuf message Communication of fhir.proto
+func (r *Communication) UnmarshalProto(b []byte) error {
+	*r = Communication{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 11:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.InResponseTo = append(r.InResponseTo, v)
+		case 15:
+			var v EventStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 18:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.Medium = append(r.Medium, v)
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Topic = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.About = append(r.About, v)
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 24:
+			v := d.string()
+			r.Sent = &v
+		case 25:
+			v := d.string()
+			r.Received = &v
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Recipient = append(r.Recipient, v)
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.Sender = &v
+		case 28:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 30:
+			var v CommunicationPayload
+			d.message(&v)
+			r.Payload = append(r.Payload, v)
+		case 31:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 32:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 35:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 36:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		case 37:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.SentElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.ReceivedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CommunicationPayload as JSON into a byte slice
+func (r CommunicationPayload) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CommunicationPayload as JSON object with the members in the order of the fields
+func (r CommunicationPayload) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ContentString != nil {
+		e.key("contentString")
+		e.string(*r.ContentString)
+	}
+	if r.ContentStringElement != nil {
+		e.key("_contentString")
+		r.ContentStringElement.appendJSON(e)
+	}
+	if r.ContentAttachment != nil {
+		e.key("contentAttachment")
+		r.ContentAttachment.appendJSON(e)
+	}
+	if r.ContentReference != nil {
+		e.key("contentReference")
+		r.ContentReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CommunicationPayload from JSON. Unknown members are ignored.
+func (r *CommunicationPayload) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CommunicationPayload
+func (r *CommunicationPayload) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "contentString":
+			if v, ok := d.string(); ok {
+				r.ContentString = &v
+			} else {
+				r.ContentString = nil
+			}
+		case "_contentString":
+			r.ContentStringElement = d.element()
+		case "contentAttachment":
+			if d.null() {
+				r.ContentAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ContentAttachment = &v
+			}
+		case "contentReference":
+			if d.null() {
+				r.ContentReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ContentReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CommunicationPayload which shares no memory with the original
+func (r CommunicationPayload) DeepCopy() CommunicationPayload {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ContentString != nil {
+		v := *r.ContentString
+		out.ContentString = &v
+	}
+	if r.ContentStringElement != nil {
+		v := r.ContentStringElement.DeepCopy()
+		out.ContentStringElement = &v
+	}
+	if r.ContentAttachment != nil {
+		v := r.ContentAttachment.DeepCopy()
+		out.ContentAttachment = &v
+	}
+	if r.ContentReference != nil {
+		v := r.ContentReference.DeepCopy()
+		out.ContentReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CommunicationPayload) Equal(other CommunicationPayload) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ContentString == nil) != (other.ContentString == nil) || r.ContentString != nil && *r.ContentString != *other.ContentString {
+		return false
+	}
+	if (r.ContentStringElement == nil) != (other.ContentStringElement == nil) || r.ContentStringElement != nil && !r.ContentStringElement.Equal(*other.ContentStringElement) {
+		return false
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.Equal(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.Equal(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CommunicationPayload) EqualsDeep(other CommunicationPayload) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ContentString == nil) != (other.ContentString == nil) || r.ContentString != nil && !equivalentString(*r.ContentString, *other.ContentString) {
+		return false
+	}
+	if (r.ContentStringElement == nil) != (other.ContentStringElement == nil) || r.ContentStringElement != nil && !r.ContentStringElement.EqualsDeep(*other.ContentStringElement) {
+		return false
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.EqualsDeep(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.EqualsDeep(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CommunicationPayload) EqualsShallow(other CommunicationPayload) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ContentString == nil) != (other.ContentString == nil) || r.ContentString != nil && !equivalentString(*r.ContentString, *other.ContentString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CommunicationPayload as FHIR XML
+func (r CommunicationPayload) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "contentString", r.ContentString, r.ContentStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "contentAttachment", r.ContentAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "contentReference", r.ContentReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CommunicationPayload from FHIR XML
+func (r *CommunicationPayload) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "contentString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ContentString = &v
+				}
+				r.ContentStringElement = element
+			case "contentAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentAttachment = &v
+			case "contentReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CommunicationPayload as properties to the RDF node n
+func (r CommunicationPayload) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Communication.payload.contentString", -1, r.ContentString, r.ContentStringElement, "string")
+	n.element("Communication.payload.contentAttachment", -1, r.ContentAttachment)
+	n.element("Communication.payload.contentReference", -1, r.ContentReference)
+}
+
+// MarshalBSON marshals the given CommunicationPayload as BSON document with the structure of its FHIR JSON
+func (r CommunicationPayload) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CommunicationPayload from a BSON document with the structure of its FHIR JSON
+func (r *CommunicationPayload) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CommunicationPayload as protobuf message CommunicationPayload of fhir.proto
+func (r CommunicationPayload) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CommunicationPayload to the encoded message
+func (r CommunicationPayload) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ContentString != nil {
+		e.string(4, *r.ContentString)
+	}
+	if r.ContentAttachment != nil {
+		e.message(5, *r.ContentAttachment)
+	}
+	if r.ContentReference != nil {
+		e.message(6, *r.ContentReference)
+	}
+	if r.ContentStringElement != nil {
+		e.message(7, *r.ContentStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CommunicationPayload from protobuf message CommunicationPayload of fhir.proto
+func (r *CommunicationPayload) UnmarshalProto(b []byte) error {
+	*r = CommunicationPayload{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.ContentString = &v
+		case 5:
+			var v Attachment
+			d.message(&v)
+			r.ContentAttachment = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.ContentReference = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ContentStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCommunication unmarshals a Communication.
 func UnmarshalCommunication(b []byte) (Communication, error) {
 	var communication Communication
-	if err := json.Unmarshal(b, &communication); err != nil {
+	if err := communication.UnmarshalJSON(b); err != nil {
 		return communication, err
 	}
 	return communication, nil
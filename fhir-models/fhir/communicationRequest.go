@@ -14,69 +14,2415 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // CommunicationRequest is documented here http://hl7.org/fhir/StructureDefinition/CommunicationRequest
 type CommunicationRequest struct {
-	Id                 *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn            []Reference                   `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Replaces           []Reference                   `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	GroupIdentifier    *Identifier                   `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	Status             RequestStatus                 `bson:"status" json:"status"`
-	StatusReason       *CodeableConcept              `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Category           []CodeableConcept             `bson:"category,omitempty" json:"category,omitempty"`
-	Priority           *RequestPriority              `bson:"priority,omitempty" json:"priority,omitempty"`
-	DoNotPerform       *bool                         `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	Medium             []CodeableConcept             `bson:"medium,omitempty" json:"medium,omitempty"`
-	Subject            *Reference                    `bson:"subject,omitempty" json:"subject,omitempty"`
-	About              []Reference                   `bson:"about,omitempty" json:"about,omitempty"`
-	Encounter          *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Payload            []CommunicationRequestPayload `bson:"payload,omitempty" json:"payload,omitempty"`
-	OccurrenceDateTime *string                       `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *Period                       `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
-	AuthoredOn         *string                       `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Requester          *Reference                    `bson:"requester,omitempty" json:"requester,omitempty"`
-	Recipient          []Reference                   `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Sender             *Reference                    `bson:"sender,omitempty" json:"sender,omitempty"`
-	ReasonCode         []CodeableConcept             `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference    []Reference                   `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note               []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
+	Id                        *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element                      `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                      *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                   []Reference                   `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Replaces                  []Reference                   `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	GroupIdentifier           *Identifier                   `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	Status                    RequestStatus                 `bson:"status" json:"status"`
+	StatusElement             *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason              *CodeableConcept              `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Category                  []CodeableConcept             `bson:"category,omitempty" json:"category,omitempty"`
+	Priority                  *RequestPriority              `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement           *Element                      `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	DoNotPerform              *bool                         `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement       *Element                      `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	Medium                    []CodeableConcept             `bson:"medium,omitempty" json:"medium,omitempty"`
+	Subject                   *Reference                    `bson:"subject,omitempty" json:"subject,omitempty"`
+	About                     []Reference                   `bson:"about,omitempty" json:"about,omitempty"`
+	Encounter                 *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Payload                   []CommunicationRequestPayload `bson:"payload,omitempty" json:"payload,omitempty"`
+	OccurrenceDateTime        *string                       `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                      `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *Period                       `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	AuthoredOn                *string                       `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement         *Element                      `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Requester                 *Reference                    `bson:"requester,omitempty" json:"requester,omitempty"`
+	Recipient                 []Reference                   `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Sender                    *Reference                    `bson:"sender,omitempty" json:"sender,omitempty"`
+	ReasonCode                []CodeableConcept             `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                   `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                      []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
 }
 type CommunicationRequestPayload struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ContentString     string      `bson:"contentString" json:"contentString"`
-	ContentAttachment Attachment  `bson:"contentAttachment" json:"contentAttachment"`
-	ContentReference  Reference   `bson:"contentReference" json:"contentReference"`
+	Id                   *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ContentString        *string     `bson:"contentString,omitempty" json:"contentString,omitempty"`
+	ContentStringElement *Element    `bson:"_contentString,omitempty" json:"_contentString,omitempty"`
+	ContentAttachment    *Attachment `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference     *Reference  `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+}
+
+// CommunicationRequestPayloadContent is implemented by the types allowed for CommunicationRequest.payload.content[x]
+type CommunicationRequestPayloadContent interface {
+	isCommunicationRequestPayloadContent()
+}
+
+func (String) isCommunicationRequestPayloadContent()     {}
+func (Attachment) isCommunicationRequestPayloadContent() {}
+func (Reference) isCommunicationRequestPayloadContent()  {}
+
+// Content returns the value of CommunicationRequest.payload.content[x] together with its FHIR type code or nil if no value is set
+func (r CommunicationRequestPayload) Content() (CommunicationRequestPayloadContent, string) {
+	if r.ContentString != nil {
+		return String(*r.ContentString), "string"
+	}
+	if r.ContentAttachment != nil {
+		return *r.ContentAttachment, "Attachment"
+	}
+	if r.ContentReference != nil {
+		return *r.ContentReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetContent sets the value of CommunicationRequest.payload.content[x], given as value or pointer, and clears all other types
+func (r *CommunicationRequestPayload) SetContent(value CommunicationRequestPayloadContent) {
+	r.ContentString = nil
+	r.ContentAttachment = nil
+	r.ContentReference = nil
+	switch v := value.(type) {
+	case String:
+		primitive := string(v)
+		r.ContentString = &primitive
+	case *String:
+		r.ContentString = (*string)(v)
+	case Attachment:
+		r.ContentAttachment = &v
+	case *Attachment:
+		r.ContentAttachment = v
+	case Reference:
+		r.ContentReference = &v
+	case *Reference:
+		r.ContentReference = v
+	}
+}
+
+// CommunicationRequestOccurrence is implemented by the types allowed for CommunicationRequest.occurrence[x]
+type CommunicationRequestOccurrence interface {
+	isCommunicationRequestOccurrence()
+}
+
+func (DateTime) isCommunicationRequestOccurrence() {}
+func (Period) isCommunicationRequestOccurrence()   {}
+
+// Occurrence returns the value of CommunicationRequest.occurrence[x] together with its FHIR type code or nil if no value is set
+func (r CommunicationRequest) Occurrence() (CommunicationRequestOccurrence, string) {
+	if r.OccurrenceDateTime != nil {
+		return DateTime(*r.OccurrenceDateTime), "dateTime"
+	}
+	if r.OccurrencePeriod != nil {
+		return *r.OccurrencePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetOccurrence sets the value of CommunicationRequest.occurrence[x], given as value or pointer, and clears all other types
+func (r *CommunicationRequest) SetOccurrence(value CommunicationRequestOccurrence) {
+	r.OccurrenceDateTime = nil
+	r.OccurrencePeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OccurrenceDateTime = &primitive
+	case *DateTime:
+		r.OccurrenceDateTime = (*string)(v)
+	case Period:
+		r.OccurrencePeriod = &v
+	case *Period:
+		r.OccurrencePeriod = v
+	}
 }
-type OtherCommunicationRequest CommunicationRequest
 
 // MarshalJSON marshals the given CommunicationRequest as JSON into a byte slice
 func (r CommunicationRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCommunicationRequest
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCommunicationRequest: OtherCommunicationRequest(r),
-		ResourceType:              "CommunicationRequest",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CommunicationRequest as JSON object with the members in the order of the fields
+func (r CommunicationRequest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"CommunicationRequest\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Replaces) > 0 {
+		e.key("replaces")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Replaces {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.GroupIdentifier != nil {
+		e.key("groupIdentifier")
+		r.GroupIdentifier.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusReason != nil {
+		e.key("statusReason")
+		r.StatusReason.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if r.DoNotPerform != nil {
+		e.key("doNotPerform")
+		e.bool(*r.DoNotPerform)
+	}
+	if r.DoNotPerformElement != nil {
+		e.key("_doNotPerform")
+		r.DoNotPerformElement.appendJSON(e)
+	}
+	if len(r.Medium) > 0 {
+		e.key("medium")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Medium {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if len(r.About) > 0 {
+		e.key("about")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.About {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if len(r.Payload) > 0 {
+		e.key("payload")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Payload {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.OccurrenceDateTime != nil {
+		e.key("occurrenceDateTime")
+		e.string(*r.OccurrenceDateTime)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.key("_occurrenceDateTime")
+		r.OccurrenceDateTimeElement.appendJSON(e)
+	}
+	if r.OccurrencePeriod != nil {
+		e.key("occurrencePeriod")
+		r.OccurrencePeriod.appendJSON(e)
+	}
+	if r.AuthoredOn != nil {
+		e.key("authoredOn")
+		e.string(*r.AuthoredOn)
+	}
+	if r.AuthoredOnElement != nil {
+		e.key("_authoredOn")
+		r.AuthoredOnElement.appendJSON(e)
+	}
+	if r.Requester != nil {
+		e.key("requester")
+		r.Requester.appendJSON(e)
+	}
+	if len(r.Recipient) > 0 {
+		e.key("recipient")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Recipient {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Sender != nil {
+		e.key("sender")
+		r.Sender.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CommunicationRequest from JSON. Unknown members are ignored.
+func (r *CommunicationRequest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CommunicationRequest
+func (r *CommunicationRequest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "replaces":
+			a := d.array()
+			r.Replaces = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Replaces = append(r.Replaces, v)
+			}
+			if r.Replaces == nil && !a.isNull {
+				r.Replaces = []Reference{}
+			}
+		case "groupIdentifier":
+			if d.null() {
+				r.GroupIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.GroupIdentifier = &v
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			if d.null() {
+				r.StatusReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = &v
+			}
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "doNotPerform":
+			if v, ok := d.bool(); ok {
+				r.DoNotPerform = &v
+			} else {
+				r.DoNotPerform = nil
+			}
+		case "_doNotPerform":
+			r.DoNotPerformElement = d.element()
+		case "medium":
+			a := d.array()
+			r.Medium = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Medium = append(r.Medium, v)
+			}
+			if r.Medium == nil && !a.isNull {
+				r.Medium = []CodeableConcept{}
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "about":
+			a := d.array()
+			r.About = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.About = append(r.About, v)
+			}
+			if r.About == nil && !a.isNull {
+				r.About = []Reference{}
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "payload":
+			a := d.array()
+			r.Payload = nil
+			for a.next() {
+				var v CommunicationRequestPayload
+				v.decodeJSON(d)
+				r.Payload = append(r.Payload, v)
+			}
+			if r.Payload == nil && !a.isNull {
+				r.Payload = []CommunicationRequestPayload{}
+			}
+		case "occurrenceDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurrenceDateTime = &v
+			} else {
+				r.OccurrenceDateTime = nil
+			}
+		case "_occurrenceDateTime":
+			r.OccurrenceDateTimeElement = d.element()
+		case "occurrencePeriod":
+			if d.null() {
+				r.OccurrencePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OccurrencePeriod = &v
+			}
+		case "authoredOn":
+			if v, ok := d.string(); ok {
+				r.AuthoredOn = &v
+			} else {
+				r.AuthoredOn = nil
+			}
+		case "_authoredOn":
+			r.AuthoredOnElement = d.element()
+		case "requester":
+			if d.null() {
+				r.Requester = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Requester = &v
+			}
+		case "recipient":
+			a := d.array()
+			r.Recipient = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recipient = append(r.Recipient, v)
+			}
+			if r.Recipient == nil && !a.isNull {
+				r.Recipient = []Reference{}
+			}
+		case "sender":
+			if d.null() {
+				r.Sender = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Sender = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CommunicationRequest which shares no memory with the original
+func (r CommunicationRequest) DeepCopy() CommunicationRequest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.Replaces != nil {
+		out.Replaces = make([]Reference, len(r.Replaces))
+		for i := range r.Replaces {
+			out.Replaces[i] = r.Replaces[i].DeepCopy()
+		}
+	}
+	if r.GroupIdentifier != nil {
+		v := r.GroupIdentifier.DeepCopy()
+		out.GroupIdentifier = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		v := r.StatusReason.DeepCopy()
+		out.StatusReason = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.DoNotPerform != nil {
+		v := *r.DoNotPerform
+		out.DoNotPerform = &v
+	}
+	if r.DoNotPerformElement != nil {
+		v := r.DoNotPerformElement.DeepCopy()
+		out.DoNotPerformElement = &v
+	}
+	if r.Medium != nil {
+		out.Medium = make([]CodeableConcept, len(r.Medium))
+		for i := range r.Medium {
+			out.Medium[i] = r.Medium[i].DeepCopy()
+		}
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.About != nil {
+		out.About = make([]Reference, len(r.About))
+		for i := range r.About {
+			out.About[i] = r.About[i].DeepCopy()
+		}
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.Payload != nil {
+		out.Payload = make([]CommunicationRequestPayload, len(r.Payload))
+		for i := range r.Payload {
+			out.Payload[i] = r.Payload[i].DeepCopy()
+		}
+	}
+	if r.OccurrenceDateTime != nil {
+		v := *r.OccurrenceDateTime
+		out.OccurrenceDateTime = &v
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		v := r.OccurrenceDateTimeElement.DeepCopy()
+		out.OccurrenceDateTimeElement = &v
+	}
+	if r.OccurrencePeriod != nil {
+		v := r.OccurrencePeriod.DeepCopy()
+		out.OccurrencePeriod = &v
+	}
+	if r.AuthoredOn != nil {
+		v := *r.AuthoredOn
+		out.AuthoredOn = &v
+	}
+	if r.AuthoredOnElement != nil {
+		v := r.AuthoredOnElement.DeepCopy()
+		out.AuthoredOnElement = &v
+	}
+	if r.Requester != nil {
+		v := r.Requester.DeepCopy()
+		out.Requester = &v
+	}
+	if r.Recipient != nil {
+		out.Recipient = make([]Reference, len(r.Recipient))
+		for i := range r.Recipient {
+			out.Recipient[i] = r.Recipient[i].DeepCopy()
+		}
+	}
+	if r.Sender != nil {
+		v := r.Sender.DeepCopy()
+		out.Sender = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CommunicationRequest) Equal(other CommunicationRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.Replaces) != len(other.Replaces) {
+		return false
+	}
+	for i := range r.Replaces {
+		if !r.Replaces[i].Equal(other.Replaces[i]) {
+			return false
+		}
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.Equal(*other.GroupIdentifier) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.Equal(*other.StatusReason) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.Equal(*other.DoNotPerformElement) {
+		return false
+	}
+	if len(r.Medium) != len(other.Medium) {
+		return false
+	}
+	for i := range r.Medium {
+		if !r.Medium[i].Equal(other.Medium[i]) {
+			return false
+		}
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if len(r.About) != len(other.About) {
+		return false
+	}
+	for i := range r.About {
+		if !r.About[i].Equal(other.About[i]) {
+			return false
+		}
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if len(r.Payload) != len(other.Payload) {
+		return false
+	}
+	for i := range r.Payload {
+		if !r.Payload[i].Equal(other.Payload[i]) {
+			return false
+		}
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && *r.OccurrenceDateTime != *other.OccurrenceDateTime {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.Equal(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.Equal(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && *r.AuthoredOn != *other.AuthoredOn {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.Equal(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.Equal(*other.Requester) {
+		return false
+	}
+	if len(r.Recipient) != len(other.Recipient) {
+		return false
+	}
+	for i := range r.Recipient {
+		if !r.Recipient[i].Equal(other.Recipient[i]) {
+			return false
+		}
+	}
+	if (r.Sender == nil) != (other.Sender == nil) || r.Sender != nil && !r.Sender.Equal(*other.Sender) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CommunicationRequest) EqualsDeep(other CommunicationRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Replaces), len(other.Replaces), func(i, j int) bool {
+		return r.Replaces[i].EqualsDeep(other.Replaces[j])
+	}) {
+		return false
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.EqualsDeep(*other.GroupIdentifier) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.EqualsDeep(*other.StatusReason) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.EqualsDeep(*other.DoNotPerformElement) {
+		return false
+	}
+	if !equivalentList(len(r.Medium), len(other.Medium), func(i, j int) bool {
+		return r.Medium[i].EqualsDeep(other.Medium[j])
+	}) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if !equivalentList(len(r.About), len(other.About), func(i, j int) bool {
+		return r.About[i].EqualsDeep(other.About[j])
+	}) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if !equivalentList(len(r.Payload), len(other.Payload), func(i, j int) bool {
+		return r.Payload[i].EqualsDeep(other.Payload[j])
+	}) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.EqualsDeep(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.EqualsDeep(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.EqualsDeep(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.EqualsDeep(*other.Requester) {
+		return false
+	}
+	if !equivalentList(len(r.Recipient), len(other.Recipient), func(i, j int) bool {
+		return r.Recipient[i].EqualsDeep(other.Recipient[j])
+	}) {
+		return false
+	}
+	if (r.Sender == nil) != (other.Sender == nil) || r.Sender != nil && !r.Sender.EqualsDeep(*other.Sender) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CommunicationRequest) EqualsShallow(other CommunicationRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CommunicationRequest as FHIR XML
+func (r CommunicationRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "CommunicationRequest",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Replaces {
+		if err := encodeXMLElement(e, "replaces", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "groupIdentifier", r.GroupIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReason", r.StatusReason); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doNotPerform", r.DoNotPerform, r.DoNotPerformElement); err != nil {
+		return err
+	}
+	for _, v := range r.Medium {
+		if err := encodeXMLElement(e, "medium", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	for _, v := range r.About {
+		if err := encodeXMLElement(e, "about", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	for _, v := range r.Payload {
+		if err := encodeXMLElement(e, "payload", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceDateTime", r.OccurrenceDateTime, r.OccurrenceDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrencePeriod", r.OccurrencePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "authoredOn", r.AuthoredOn, r.AuthoredOnElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requester", r.Requester); err != nil {
+		return err
+	}
+	for _, v := range r.Recipient {
+		if err := encodeXMLElement(e, "recipient", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "sender", r.Sender); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CommunicationRequest from FHIR XML
+func (r *CommunicationRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "replaces":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Replaces = append(r.Replaces, v)
+			case "groupIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GroupIdentifier = &v
+			case "status":
+				var v RequestStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "doNotPerform":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoNotPerform = &v
+				}
+				r.DoNotPerformElement = element
+			case "medium":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Medium = append(r.Medium, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "about":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.About = append(r.About, v)
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "payload":
+				var v CommunicationRequestPayload
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payload = append(r.Payload, v)
+			case "occurrenceDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceDateTime = &v
+				}
+				r.OccurrenceDateTimeElement = element
+			case "occurrencePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrencePeriod = &v
+			case "authoredOn":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthoredOn = &v
+				}
+				r.AuthoredOnElement = element
+			case "requester":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requester = &v
+			case "recipient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recipient = append(r.Recipient, v)
+			case "sender":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Sender = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CommunicationRequest as properties to the RDF node n
+func (r CommunicationRequest) turtle(n *turtleNode) {
+	n.resource("CommunicationRequest", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("CommunicationRequest.identifier", i, v)
+	}
+	for i, v := range r.BasedOn {
+		n.element("CommunicationRequest.basedOn", i, v)
+	}
+	for i, v := range r.Replaces {
+		n.element("CommunicationRequest.replaces", i, v)
+	}
+	n.element("CommunicationRequest.groupIdentifier", -1, r.GroupIdentifier)
+	n.primitive("CommunicationRequest.status", -1, r.Status, r.StatusElement, "code")
+	n.element("CommunicationRequest.statusReason", -1, r.StatusReason)
+	for i, v := range r.Category {
+		n.element("CommunicationRequest.category", i, v)
+	}
+	n.primitive("CommunicationRequest.priority", -1, r.Priority, r.PriorityElement, "code")
+	n.primitive("CommunicationRequest.doNotPerform", -1, r.DoNotPerform, r.DoNotPerformElement, "boolean")
+	for i, v := range r.Medium {
+		n.element("CommunicationRequest.medium", i, v)
+	}
+	n.element("CommunicationRequest.subject", -1, r.Subject)
+	for i, v := range r.About {
+		n.element("CommunicationRequest.about", i, v)
+	}
+	n.element("CommunicationRequest.encounter", -1, r.Encounter)
+	for i, v := range r.Payload {
+		n.element("CommunicationRequest.payload", i, v)
+	}
+	n.primitive("CommunicationRequest.occurrenceDateTime", -1, r.OccurrenceDateTime, r.OccurrenceDateTimeElement, "dateTime")
+	n.element("CommunicationRequest.occurrencePeriod", -1, r.OccurrencePeriod)
+	n.primitive("CommunicationRequest.authoredOn", -1, r.AuthoredOn, r.AuthoredOnElement, "dateTime")
+	n.element("CommunicationRequest.requester", -1, r.Requester)
+	for i, v := range r.Recipient {
+		n.element("CommunicationRequest.recipient", i, v)
+	}
+	n.element("CommunicationRequest.sender", -1, r.Sender)
+	for i, v := range r.ReasonCode {
+		n.element("CommunicationRequest.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("CommunicationRequest.reasonReference", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("CommunicationRequest.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CommunicationRequest as BSON document with the structure of its FHIR JSON
+func (r CommunicationRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CommunicationRequest from a BSON document with the structure of its FHIR JSON
+func (r *CommunicationRequest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CommunicationRequest as protobuf message CommunicationRequest of fhir.proto
+func (r CommunicationRequest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CommunicationRequest to the encoded message
+func (r CommunicationRequest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(10, v)
+	}
+	for _, v := range r.Replaces {
+		e.message(11, v)
+	}
+	if r.GroupIdentifier != nil {
+		e.message(12, *r.GroupIdentifier)
+	}
+	e.varint(13, r.Status.protoNumber())
+	if r.StatusReason != nil {
+		e.message(14, *r.StatusReason)
+	}
+	for _, v := range r.Category {
+		e.message(15, v)
+	}
+	if r.Priority != nil {
+		e.varint(16, r.Priority.protoNumber())
+	}
+	if r.DoNotPerform != nil {
+		e.varint(17, protoBool(*r.DoNotPerform))
+	}
+	for _, v := range r.Medium {
+		e.message(18, v)
+	}
+	if r.Subject != nil {
+		e.message(19, *r.Subject)
+	}
+	for _, v := range r.About {
+		e.message(20, v)
+	}
+	if r.Encounter != nil {
+		e.message(21, *r.Encounter)
+	}
+	for _, v := range r.Payload {
+		e.message(22, v)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.string(23, *r.OccurrenceDateTime)
+	}
+	if r.OccurrencePeriod != nil {
+		e.message(24, *r.OccurrencePeriod)
+	}
+	if r.AuthoredOn != nil {
+		e.string(25, *r.AuthoredOn)
+	}
+	if r.Requester != nil {
+		e.message(26, *r.Requester)
+	}
+	for _, v := range r.Recipient {
+		e.message(27, v)
+	}
+	if r.Sender != nil {
+		e.message(28, *r.Sender)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(29, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(30, v)
+	}
+	for _, v := range r.Note {
+		e.message(31, v)
+	}
+	if r.IdElement != nil {
+		e.message(32, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(33, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(34, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(35, *r.StatusElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(36, *r.PriorityElement)
+	}
+	if r.DoNotPerformElement != nil {
+		e.message(37, *r.DoNotPerformElement)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.message(38, *r.OccurrenceDateTimeElement)
+	}
+	if r.AuthoredOnElement != nil {
+		e.message(39, *r.AuthoredOnElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CommunicationRequest from protobuf message CommunicationRequest of fhir.proto
+func (r *CommunicationRequest) UnmarshalProto(b []byte) error {
+	*r = CommunicationRequest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Replaces = append(r.Replaces, v)
+		case 12:
+			var v Identifier
+			d.message(&v)
+			r.GroupIdentifier = &v
+		case 13:
+			var v RequestStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 16:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 17:
+			v := d.bool()
+			r.DoNotPerform = &v
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.Medium = append(r.Medium, v)
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.About = append(r.About, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 22:
+			var v CommunicationRequestPayload
+			d.message(&v)
+			r.Payload = append(r.Payload, v)
+		case 23:
+			v := d.string()
+			r.OccurrenceDateTime = &v
+		case 24:
+			var v Period
+			d.message(&v)
+			r.OccurrencePeriod = &v
+		case 25:
+			v := d.string()
+			r.AuthoredOn = &v
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Requester = &v
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.Recipient = append(r.Recipient, v)
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Sender = &v
+		case 29:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 30:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 31:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 32:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.DoNotPerformElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.OccurrenceDateTimeElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.AuthoredOnElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CommunicationRequestPayload as JSON into a byte slice
+func (r CommunicationRequestPayload) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CommunicationRequestPayload as JSON object with the members in the order of the fields
+func (r CommunicationRequestPayload) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ContentString != nil {
+		e.key("contentString")
+		e.string(*r.ContentString)
+	}
+	if r.ContentStringElement != nil {
+		e.key("_contentString")
+		r.ContentStringElement.appendJSON(e)
+	}
+	if r.ContentAttachment != nil {
+		e.key("contentAttachment")
+		r.ContentAttachment.appendJSON(e)
+	}
+	if r.ContentReference != nil {
+		e.key("contentReference")
+		r.ContentReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CommunicationRequestPayload from JSON. Unknown members are ignored.
+func (r *CommunicationRequestPayload) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CommunicationRequestPayload
+func (r *CommunicationRequestPayload) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "contentString":
+			if v, ok := d.string(); ok {
+				r.ContentString = &v
+			} else {
+				r.ContentString = nil
+			}
+		case "_contentString":
+			r.ContentStringElement = d.element()
+		case "contentAttachment":
+			if d.null() {
+				r.ContentAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ContentAttachment = &v
+			}
+		case "contentReference":
+			if d.null() {
+				r.ContentReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ContentReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CommunicationRequestPayload which shares no memory with the original
+func (r CommunicationRequestPayload) DeepCopy() CommunicationRequestPayload {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ContentString != nil {
+		v := *r.ContentString
+		out.ContentString = &v
+	}
+	if r.ContentStringElement != nil {
+		v := r.ContentStringElement.DeepCopy()
+		out.ContentStringElement = &v
+	}
+	if r.ContentAttachment != nil {
+		v := r.ContentAttachment.DeepCopy()
+		out.ContentAttachment = &v
+	}
+	if r.ContentReference != nil {
+		v := r.ContentReference.DeepCopy()
+		out.ContentReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CommunicationRequestPayload) Equal(other CommunicationRequestPayload) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ContentString == nil) != (other.ContentString == nil) || r.ContentString != nil && *r.ContentString != *other.ContentString {
+		return false
+	}
+	if (r.ContentStringElement == nil) != (other.ContentStringElement == nil) || r.ContentStringElement != nil && !r.ContentStringElement.Equal(*other.ContentStringElement) {
+		return false
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.Equal(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.Equal(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CommunicationRequestPayload) EqualsDeep(other CommunicationRequestPayload) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ContentString == nil) != (other.ContentString == nil) || r.ContentString != nil && !equivalentString(*r.ContentString, *other.ContentString) {
+		return false
+	}
+	if (r.ContentStringElement == nil) != (other.ContentStringElement == nil) || r.ContentStringElement != nil && !r.ContentStringElement.EqualsDeep(*other.ContentStringElement) {
+		return false
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.EqualsDeep(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.EqualsDeep(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CommunicationRequestPayload) EqualsShallow(other CommunicationRequestPayload) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ContentString == nil) != (other.ContentString == nil) || r.ContentString != nil && !equivalentString(*r.ContentString, *other.ContentString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CommunicationRequestPayload as FHIR XML
+func (r CommunicationRequestPayload) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "contentString", r.ContentString, r.ContentStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "contentAttachment", r.ContentAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "contentReference", r.ContentReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CommunicationRequestPayload from FHIR XML
+func (r *CommunicationRequestPayload) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "contentString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ContentString = &v
+				}
+				r.ContentStringElement = element
+			case "contentAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentAttachment = &v
+			case "contentReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CommunicationRequestPayload as properties to the RDF node n
+func (r CommunicationRequestPayload) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CommunicationRequest.payload.contentString", -1, r.ContentString, r.ContentStringElement, "string")
+	n.element("CommunicationRequest.payload.contentAttachment", -1, r.ContentAttachment)
+	n.element("CommunicationRequest.payload.contentReference", -1, r.ContentReference)
+}
+
+// MarshalBSON marshals the given CommunicationRequestPayload as BSON document with the structure of its FHIR JSON
+func (r CommunicationRequestPayload) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CommunicationRequestPayload from a BSON document with the structure of its FHIR JSON
+func (r *CommunicationRequestPayload) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CommunicationRequestPayload as protobuf message CommunicationRequestPayload of fhir.proto
+func (r CommunicationRequestPayload) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CommunicationRequestPayload to the encoded message
+func (r CommunicationRequestPayload) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ContentString != nil {
+		e.string(4, *r.ContentString)
+	}
+	if r.ContentAttachment != nil {
+		e.message(5, *r.ContentAttachment)
+	}
+	if r.ContentReference != nil {
+		e.message(6, *r.ContentReference)
+	}
+	if r.ContentStringElement != nil {
+		e.message(7, *r.ContentStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CommunicationRequestPayload from protobuf message CommunicationRequestPayload of fhir.proto
+func (r *CommunicationRequestPayload) UnmarshalProto(b []byte) error {
+	*r = CommunicationRequestPayload{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.ContentString = &v
+		case 5:
+			var v Attachment
+			d.message(&v)
+			r.ContentAttachment = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.ContentReference = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ContentStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCommunicationRequest unmarshals a CommunicationRequest.
 func UnmarshalCommunicationRequest(b []byte) (CommunicationRequest, error) {
 	var communicationRequest CommunicationRequest
-	if err := json.Unmarshal(b, &communicationRequest); err != nil {
+	if err := communicationRequest.UnmarshalJSON(b); err != nil {
 		return communicationRequest, err
 	}
 	return communicationRequest, nil
@@ -14,60 +14,2006 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // CompartmentDefinition is documented here http://hl7.org/fhir/StructureDefinition/CompartmentDefinition
 type CompartmentDefinition struct {
-	Id                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                         `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               string                          `bson:"url" json:"url"`
-	Version           *string                         `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                          `bson:"name" json:"name"`
-	Status            PublicationStatus               `bson:"status" json:"status"`
-	Experimental      *bool                           `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                         `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                         `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                 `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                         `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                  `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Purpose           *string                         `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Code              CompartmentType                 `bson:"code" json:"code"`
-	Search            bool                            `bson:"search" json:"search"`
-	Resource          []CompartmentDefinitionResource `bson:"resource,omitempty" json:"resource,omitempty"`
+	Id                   *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                        `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage               `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  string                          `bson:"url" json:"url"`
+	UrlElement           *Element                        `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                         `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                        `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                          `bson:"name" json:"name"`
+	NameElement          *Element                        `bson:"_name,omitempty" json:"_name,omitempty"`
+	Status               PublicationStatus               `bson:"status" json:"status"`
+	StatusElement        *Element                        `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                           `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                        `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *string                         `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                        `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                         `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                        `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail                 `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                         `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                        `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext                  `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Purpose              *string                         `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                        `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Code                 CompartmentType                 `bson:"code" json:"code"`
+	CodeElement          *Element                        `bson:"_code,omitempty" json:"_code,omitempty"`
+	Search               bool                            `bson:"search" json:"search"`
+	SearchElement        *Element                        `bson:"_search,omitempty" json:"_search,omitempty"`
+	Resource             []CompartmentDefinitionResource `bson:"resource,omitempty" json:"resource,omitempty"`
 }
 type CompartmentDefinitionResource struct {
-	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              ResourceType `bson:"code" json:"code"`
-	Param             []string     `bson:"param,omitempty" json:"param,omitempty"`
-	Documentation     *string      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	Id                   *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 ResourceType `bson:"code" json:"code"`
+	CodeElement          *Element     `bson:"_code,omitempty" json:"_code,omitempty"`
+	Param                []string     `bson:"param,omitempty" json:"param,omitempty"`
+	ParamElement         []*Element   `bson:"_param,omitempty" json:"_param,omitempty"`
+	Documentation        *string      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element     `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
 }
-type OtherCompartmentDefinition CompartmentDefinition
 
 // MarshalJSON marshals the given CompartmentDefinition as JSON into a byte slice
 func (r CompartmentDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCompartmentDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCompartmentDefinition: OtherCompartmentDefinition(r),
-		ResourceType:               "CompartmentDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CompartmentDefinition as JSON object with the members in the order of the fields
+func (r CompartmentDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"CompartmentDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	e.key("search")
+	e.bool(r.Search)
+	if r.SearchElement != nil {
+		e.key("_search")
+		r.SearchElement.appendJSON(e)
+	}
+	if len(r.Resource) > 0 {
+		e.key("resource")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Resource {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CompartmentDefinition from JSON. Unknown members are ignored.
+func (r *CompartmentDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CompartmentDefinition
+func (r *CompartmentDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "search":
+			if v, ok := d.bool(); ok {
+				r.Search = v
+			}
+		case "_search":
+			r.SearchElement = d.element()
+		case "resource":
+			a := d.array()
+			r.Resource = nil
+			for a.next() {
+				var v CompartmentDefinitionResource
+				v.decodeJSON(d)
+				r.Resource = append(r.Resource, v)
+			}
+			if r.Resource == nil && !a.isNull {
+				r.Resource = []CompartmentDefinitionResource{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CompartmentDefinition which shares no memory with the original
+func (r CompartmentDefinition) DeepCopy() CompartmentDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.SearchElement != nil {
+		v := r.SearchElement.DeepCopy()
+		out.SearchElement = &v
+	}
+	if r.Resource != nil {
+		out.Resource = make([]CompartmentDefinitionResource, len(r.Resource))
+		for i := range r.Resource {
+			out.Resource[i] = r.Resource[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CompartmentDefinition) Equal(other CompartmentDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if r.Search != other.Search {
+		return false
+	}
+	if (r.SearchElement == nil) != (other.SearchElement == nil) || r.SearchElement != nil && !r.SearchElement.Equal(*other.SearchElement) {
+		return false
+	}
+	if len(r.Resource) != len(other.Resource) {
+		return false
+	}
+	for i := range r.Resource {
+		if !r.Resource[i].Equal(other.Resource[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CompartmentDefinition) EqualsDeep(other CompartmentDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if r.Search != other.Search {
+		return false
+	}
+	if (r.SearchElement == nil) != (other.SearchElement == nil) || r.SearchElement != nil && !r.SearchElement.EqualsDeep(*other.SearchElement) {
+		return false
+	}
+	if !equivalentList(len(r.Resource), len(other.Resource), func(i, j int) bool {
+		return r.Resource[i].EqualsDeep(other.Resource[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CompartmentDefinition) EqualsShallow(other CompartmentDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if r.Search != other.Search {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CompartmentDefinition as FHIR XML
+func (r CompartmentDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "CompartmentDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "search", r.Search, r.SearchElement); err != nil {
+		return err
+	}
+	for _, v := range r.Resource {
+		if err := encodeXMLElement(e, "resource", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CompartmentDefinition from FHIR XML
+func (r *CompartmentDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "code":
+				var v CompartmentType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "search":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Search = v
+				}
+				r.SearchElement = element
+			case "resource":
+				var v CompartmentDefinitionResource
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Resource = append(r.Resource, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CompartmentDefinition as properties to the RDF node n
+func (r CompartmentDefinition) turtle(n *turtleNode) {
+	n.resource("CompartmentDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("CompartmentDefinition.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("CompartmentDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("CompartmentDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("CompartmentDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("CompartmentDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("CompartmentDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("CompartmentDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("CompartmentDefinition.contact", i, v)
+	}
+	n.primitive("CompartmentDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("CompartmentDefinition.useContext", i, v)
+	}
+	n.primitive("CompartmentDefinition.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("CompartmentDefinition.code", -1, r.Code, r.CodeElement, "code")
+	n.primitive("CompartmentDefinition.search", -1, r.Search, r.SearchElement, "boolean")
+	for i, v := range r.Resource {
+		n.element("CompartmentDefinition.resource", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CompartmentDefinition as BSON document with the structure of its FHIR JSON
+func (r CompartmentDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CompartmentDefinition from a BSON document with the structure of its FHIR JSON
+func (r *CompartmentDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CompartmentDefinition as protobuf message CompartmentDefinition of fhir.proto
+func (r CompartmentDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CompartmentDefinition to the encoded message
+func (r CompartmentDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != "" {
+		e.string(9, r.Url)
+	}
+	if r.Version != nil {
+		e.string(10, *r.Version)
+	}
+	if r.Name != "" {
+		e.string(11, r.Name)
+	}
+	e.varint(12, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(13, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(14, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(15, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(16, v)
+	}
+	if r.Description != nil {
+		e.string(17, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(18, v)
+	}
+	if r.Purpose != nil {
+		e.string(19, *r.Purpose)
+	}
+	e.varint(20, r.Code.protoNumber())
+	if r.Search {
+		e.varint(21, protoBool(r.Search))
+	}
+	for _, v := range r.Resource {
+		e.message(22, v)
+	}
+	if r.IdElement != nil {
+		e.message(23, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(24, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(25, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(26, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(27, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(28, *r.NameElement)
+	}
+	if r.StatusElement != nil {
+		e.message(29, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(30, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(31, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(32, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(33, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(34, *r.PurposeElement)
+	}
+	if r.CodeElement != nil {
+		e.message(35, *r.CodeElement)
+	}
+	if r.SearchElement != nil {
+		e.message(36, *r.SearchElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CompartmentDefinition from protobuf message CompartmentDefinition of fhir.proto
+func (r *CompartmentDefinition) UnmarshalProto(b []byte) error {
+	*r = CompartmentDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			r.Url = d.string()
+		case 10:
+			v := d.string()
+			r.Version = &v
+		case 11:
+			r.Name = d.string()
+		case 12:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 13:
+			v := d.bool()
+			r.Experimental = &v
+		case 14:
+			v := d.string()
+			r.Date = &v
+		case 15:
+			v := d.string()
+			r.Publisher = &v
+		case 16:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 17:
+			v := d.string()
+			r.Description = &v
+		case 18:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 19:
+			v := d.string()
+			r.Purpose = &v
+		case 20:
+			var v CompartmentType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 21:
+			r.Search = d.bool()
+		case 22:
+			var v CompartmentDefinitionResource
+			d.message(&v)
+			r.Resource = append(r.Resource, v)
+		case 23:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.SearchElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CompartmentDefinitionResource as JSON into a byte slice
+func (r CompartmentDefinitionResource) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CompartmentDefinitionResource as JSON object with the members in the order of the fields
+func (r CompartmentDefinitionResource) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if len(r.Param) > 0 {
+		e.key("param")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Param {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ParamElement) > 0 {
+		e.key("_param")
+		e.elements(r.ParamElement, len(r.Param))
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CompartmentDefinitionResource from JSON. Unknown members are ignored.
+func (r *CompartmentDefinitionResource) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CompartmentDefinitionResource
+func (r *CompartmentDefinitionResource) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "param":
+			a := d.array()
+			r.Param = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Param = append(r.Param, v)
+			}
+			if r.Param == nil && !a.isNull {
+				r.Param = []string{}
+			}
+		case "_param":
+			a := d.array()
+			r.ParamElement = nil
+			for a.next() {
+				r.ParamElement = append(r.ParamElement, d.element())
+			}
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CompartmentDefinitionResource which shares no memory with the original
+func (r CompartmentDefinitionResource) DeepCopy() CompartmentDefinitionResource {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Param != nil {
+		out.Param = make([]string, len(r.Param))
+		copy(out.Param, r.Param)
+	}
+	if r.ParamElement != nil {
+		out.ParamElement = make([]*Element, len(r.ParamElement))
+		for i, v := range r.ParamElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ParamElement[i] = &c
+			}
+		}
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CompartmentDefinitionResource) Equal(other CompartmentDefinitionResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if len(r.Param) != len(other.Param) {
+		return false
+	}
+	for i := range r.Param {
+		if r.Param[i] != other.Param[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ParamElement, other.ParamElement, false) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CompartmentDefinitionResource) EqualsDeep(other CompartmentDefinitionResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Param), len(other.Param), func(i, j int) bool {
+		return equivalentString(r.Param[i], other.Param[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ParamElement, other.ParamElement, true) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CompartmentDefinitionResource) EqualsShallow(other CompartmentDefinitionResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if !equivalentList(len(r.Param), len(other.Param), func(i, j int) bool {
+		return equivalentString(r.Param[i], other.Param[j])
+	}) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CompartmentDefinitionResource as FHIR XML
+func (r CompartmentDefinitionResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	for i, v := range r.Param {
+		if err := encodeXMLPrimitive(e, "param", v, elementAt(r.ParamElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CompartmentDefinitionResource from FHIR XML
+func (r *CompartmentDefinitionResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "param":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ParamElement = appendElement(r.ParamElement, len(r.Param), element)
+					r.Param = append(r.Param, v)
+				}
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CompartmentDefinitionResource as properties to the RDF node n
+func (r CompartmentDefinitionResource) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CompartmentDefinition.resource.code", -1, r.Code, r.CodeElement, "code")
+	for i, v := range r.Param {
+		n.primitive("CompartmentDefinition.resource.param", i, v, elementAt(r.ParamElement, i), "string")
+	}
+	n.primitive("CompartmentDefinition.resource.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+}
+
+// MarshalBSON marshals the given CompartmentDefinitionResource as BSON document with the structure of its FHIR JSON
+func (r CompartmentDefinitionResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CompartmentDefinitionResource from a BSON document with the structure of its FHIR JSON
+func (r *CompartmentDefinitionResource) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CompartmentDefinitionResource as protobuf message CompartmentDefinitionResource of fhir.proto
+func (r CompartmentDefinitionResource) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CompartmentDefinitionResource to the encoded message
+func (r CompartmentDefinitionResource) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Code.protoNumber())
+	for _, v := range r.Param {
+		e.string(5, v)
+	}
+	if r.Documentation != nil {
+		e.string(6, *r.Documentation)
+	}
+	if r.CodeElement != nil {
+		e.message(7, *r.CodeElement)
+	}
+	for _, v := range r.ParamElement {
+		e.element(8, v)
+	}
+	if r.DocumentationElement != nil {
+		e.message(9, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CompartmentDefinitionResource from protobuf message CompartmentDefinitionResource of fhir.proto
+func (r *CompartmentDefinitionResource) UnmarshalProto(b []byte) error {
+	*r = CompartmentDefinitionResource{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ResourceType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 5:
+			r.Param = append(r.Param, d.string())
+		case 6:
+			v := d.string()
+			r.Documentation = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 8:
+			r.ParamElement = append(r.ParamElement, d.element())
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCompartmentDefinition unmarshals a CompartmentDefinition.
 func UnmarshalCompartmentDefinition(b []byte) (CompartmentDefinition, error) {
 	var compartmentDefinition CompartmentDefinition
-	if err := json.Unmarshal(b, &compartmentDefinition); err != nil {
+	if err := compartmentDefinition.UnmarshalJSON(b); err != nil {
 		return compartmentDefinition, err
 	}
 	return compartmentDefinition, nil
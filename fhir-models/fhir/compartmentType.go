@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code CompartmentType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CompartmentType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CompartmentType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "Patient":
 		*code = CompartmentTypePatient
@@ -55,6 +59,12 @@ func (code *CompartmentType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CompartmentType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CompartmentType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CompartmentType) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code CompartmentType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CompartmentType) protoNumber() uint64 {
+	switch code {
+	case CompartmentTypePatient:
+		return 1
+	case CompartmentTypeEncounter:
+		return 2
+	case CompartmentTypeRelatedPerson:
+		return 3
+	case CompartmentTypePractitioner:
+		return 4
+	case CompartmentTypeDevice:
+		return 5
+	}
+	return 0
+}
+func (code *CompartmentType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CompartmentTypePatient
+	case 2:
+		*code = CompartmentTypeEncounter
+	case 3:
+		*code = CompartmentTypeRelatedPerson
+	case 4:
+		*code = CompartmentTypePractitioner
+	case 5:
+		*code = CompartmentTypeDevice
+	default:
+		return false
+	}
+	return true
+}
@@ -14,42 +14,55 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Composition is documented here http://hl7.org/fhir/StructureDefinition/Composition
 type Composition struct {
-	Id                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            CompositionStatus      `bson:"status" json:"status"`
-	Type              CodeableConcept        `bson:"type" json:"type"`
-	Category          []CodeableConcept      `bson:"category,omitempty" json:"category,omitempty"`
-	Subject           *Reference             `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter         *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Date              string                 `bson:"date" json:"date"`
-	Author            []Reference            `bson:"author" json:"author"`
-	Title             string                 `bson:"title" json:"title"`
-	Confidentiality   *string                `bson:"confidentiality,omitempty" json:"confidentiality,omitempty"`
-	Attester          []CompositionAttester  `bson:"attester,omitempty" json:"attester,omitempty"`
-	Custodian         *Reference             `bson:"custodian,omitempty" json:"custodian,omitempty"`
-	RelatesTo         []CompositionRelatesTo `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
-	Event             []CompositionEvent     `bson:"event,omitempty" json:"event,omitempty"`
-	Section           []CompositionSection   `bson:"section,omitempty" json:"section,omitempty"`
+	Id                     *string                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element               `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                   *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             *Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                 CompositionStatus      `bson:"status" json:"status"`
+	StatusElement          *Element               `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                   CodeableConcept        `bson:"type" json:"type"`
+	Category               []CodeableConcept      `bson:"category,omitempty" json:"category,omitempty"`
+	Subject                *Reference             `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter              *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Date                   string                 `bson:"date" json:"date"`
+	DateElement            *Element               `bson:"_date,omitempty" json:"_date,omitempty"`
+	Author                 []Reference            `bson:"author" json:"author"`
+	Title                  string                 `bson:"title" json:"title"`
+	TitleElement           *Element               `bson:"_title,omitempty" json:"_title,omitempty"`
+	Confidentiality        *string                `bson:"confidentiality,omitempty" json:"confidentiality,omitempty"`
+	ConfidentialityElement *Element               `bson:"_confidentiality,omitempty" json:"_confidentiality,omitempty"`
+	Attester               []CompositionAttester  `bson:"attester,omitempty" json:"attester,omitempty"`
+	Custodian              *Reference             `bson:"custodian,omitempty" json:"custodian,omitempty"`
+	RelatesTo              []CompositionRelatesTo `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
+	Event                  []CompositionEvent     `bson:"event,omitempty" json:"event,omitempty"`
+	Section                []CompositionSection   `bson:"section,omitempty" json:"section,omitempty"`
 }
 type CompositionAttester struct {
 	Id                *string                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Mode              CompositionAttestationMode `bson:"mode" json:"mode"`
+	ModeElement       *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
 	Time              *string                    `bson:"time,omitempty" json:"time,omitempty"`
+	TimeElement       *Element                   `bson:"_time,omitempty" json:"_time,omitempty"`
 	Party             *Reference                 `bson:"party,omitempty" json:"party,omitempty"`
 }
 type CompositionRelatesTo struct {
@@ -57,9 +70,46 @@ type CompositionRelatesTo struct {
 	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              DocumentRelationshipType `bson:"code" json:"code"`
-	TargetIdentifier  Identifier               `bson:"targetIdentifier" json:"targetIdentifier"`
-	TargetReference   Reference                `bson:"targetReference" json:"targetReference"`
+	CodeElement       *Element                 `bson:"_code,omitempty" json:"_code,omitempty"`
+	TargetIdentifier  *Identifier              `bson:"targetIdentifier,omitempty" json:"targetIdentifier,omitempty"`
+	TargetReference   *Reference               `bson:"targetReference,omitempty" json:"targetReference,omitempty"`
 }
+
+// CompositionRelatesToTarget is implemented by the types allowed for Composition.relatesTo.target[x]
+type CompositionRelatesToTarget interface {
+	isCompositionRelatesToTarget()
+}
+
+func (Identifier) isCompositionRelatesToTarget() {}
+func (Reference) isCompositionRelatesToTarget()  {}
+
+// Target returns the value of Composition.relatesTo.target[x] together with its FHIR type code or nil if no value is set
+func (r CompositionRelatesTo) Target() (CompositionRelatesToTarget, string) {
+	if r.TargetIdentifier != nil {
+		return *r.TargetIdentifier, "Identifier"
+	}
+	if r.TargetReference != nil {
+		return *r.TargetReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetTarget sets the value of Composition.relatesTo.target[x], given as value or pointer, and clears all other types
+func (r *CompositionRelatesTo) SetTarget(value CompositionRelatesToTarget) {
+	r.TargetIdentifier = nil
+	r.TargetReference = nil
+	switch v := value.(type) {
+	case Identifier:
+		r.TargetIdentifier = &v
+	case *Identifier:
+		r.TargetIdentifier = v
+	case Reference:
+		r.TargetReference = &v
+	case *Reference:
+		r.TargetReference = v
+	}
+}
+
 type CompositionEvent struct {
 	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -73,33 +123,3484 @@ type CompositionSection struct {
 	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Title             *string              `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement      *Element             `bson:"_title,omitempty" json:"_title,omitempty"`
 	Code              *CodeableConcept     `bson:"code,omitempty" json:"code,omitempty"`
 	Author            []Reference          `bson:"author,omitempty" json:"author,omitempty"`
 	Focus             *Reference           `bson:"focus,omitempty" json:"focus,omitempty"`
 	Text              *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
 	Mode              *ListMode            `bson:"mode,omitempty" json:"mode,omitempty"`
+	ModeElement       *Element             `bson:"_mode,omitempty" json:"_mode,omitempty"`
 	OrderedBy         *CodeableConcept     `bson:"orderedBy,omitempty" json:"orderedBy,omitempty"`
 	Entry             []Reference          `bson:"entry,omitempty" json:"entry,omitempty"`
 	EmptyReason       *CodeableConcept     `bson:"emptyReason,omitempty" json:"emptyReason,omitempty"`
 	Section           []CompositionSection `bson:"section,omitempty" json:"section,omitempty"`
 }
-type OtherComposition Composition
 
 // MarshalJSON marshals the given Composition as JSON into a byte slice
 func (r Composition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherComposition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherComposition: OtherComposition(r),
-		ResourceType:     "Composition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Composition as JSON object with the members in the order of the fields
+func (r Composition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Composition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	e.key("date")
+	e.string(r.Date)
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	e.key("author")
+	if r.Author == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("title")
+	e.string(r.Title)
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Confidentiality != nil {
+		e.key("confidentiality")
+		e.string(*r.Confidentiality)
+	}
+	if r.ConfidentialityElement != nil {
+		e.key("_confidentiality")
+		r.ConfidentialityElement.appendJSON(e)
+	}
+	if len(r.Attester) > 0 {
+		e.key("attester")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Attester {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Custodian != nil {
+		e.key("custodian")
+		r.Custodian.appendJSON(e)
+	}
+	if len(r.RelatesTo) > 0 {
+		e.key("relatesTo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatesTo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Event) > 0 {
+		e.key("event")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Event {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Section) > 0 {
+		e.key("section")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Section {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Composition from JSON. Unknown members are ignored.
+func (r *Composition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Composition
+func (r *Composition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			r.Type.decodeJSON(d)
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = v
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []Reference{}
+			}
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = v
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "confidentiality":
+			if v, ok := d.string(); ok {
+				r.Confidentiality = &v
+			} else {
+				r.Confidentiality = nil
+			}
+		case "_confidentiality":
+			r.ConfidentialityElement = d.element()
+		case "attester":
+			a := d.array()
+			r.Attester = nil
+			for a.next() {
+				var v CompositionAttester
+				v.decodeJSON(d)
+				r.Attester = append(r.Attester, v)
+			}
+			if r.Attester == nil && !a.isNull {
+				r.Attester = []CompositionAttester{}
+			}
+		case "custodian":
+			if d.null() {
+				r.Custodian = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Custodian = &v
+			}
+		case "relatesTo":
+			a := d.array()
+			r.RelatesTo = nil
+			for a.next() {
+				var v CompositionRelatesTo
+				v.decodeJSON(d)
+				r.RelatesTo = append(r.RelatesTo, v)
+			}
+			if r.RelatesTo == nil && !a.isNull {
+				r.RelatesTo = []CompositionRelatesTo{}
+			}
+		case "event":
+			a := d.array()
+			r.Event = nil
+			for a.next() {
+				var v CompositionEvent
+				v.decodeJSON(d)
+				r.Event = append(r.Event, v)
+			}
+			if r.Event == nil && !a.isNull {
+				r.Event = []CompositionEvent{}
+			}
+		case "section":
+			a := d.array()
+			r.Section = nil
+			for a.next() {
+				var v CompositionSection
+				v.decodeJSON(d)
+				r.Section = append(r.Section, v)
+			}
+			if r.Section == nil && !a.isNull {
+				r.Section = []CompositionSection{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Composition which shares no memory with the original
+func (r Composition) DeepCopy() Composition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Author != nil {
+		out.Author = make([]Reference, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Confidentiality != nil {
+		v := *r.Confidentiality
+		out.Confidentiality = &v
+	}
+	if r.ConfidentialityElement != nil {
+		v := r.ConfidentialityElement.DeepCopy()
+		out.ConfidentialityElement = &v
+	}
+	if r.Attester != nil {
+		out.Attester = make([]CompositionAttester, len(r.Attester))
+		for i := range r.Attester {
+			out.Attester[i] = r.Attester[i].DeepCopy()
+		}
+	}
+	if r.Custodian != nil {
+		v := r.Custodian.DeepCopy()
+		out.Custodian = &v
+	}
+	if r.RelatesTo != nil {
+		out.RelatesTo = make([]CompositionRelatesTo, len(r.RelatesTo))
+		for i := range r.RelatesTo {
+			out.RelatesTo[i] = r.RelatesTo[i].DeepCopy()
+		}
+	}
+	if r.Event != nil {
+		out.Event = make([]CompositionEvent, len(r.Event))
+		for i := range r.Event {
+			out.Event[i] = r.Event[i].DeepCopy()
+		}
+	}
+	if r.Section != nil {
+		out.Section = make([]CompositionSection, len(r.Section))
+		for i := range r.Section {
+			out.Section[i] = r.Section[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Composition) Equal(other Composition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if r.Date != other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if r.Title != other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Confidentiality == nil) != (other.Confidentiality == nil) || r.Confidentiality != nil && *r.Confidentiality != *other.Confidentiality {
+		return false
+	}
+	if (r.ConfidentialityElement == nil) != (other.ConfidentialityElement == nil) || r.ConfidentialityElement != nil && !r.ConfidentialityElement.Equal(*other.ConfidentialityElement) {
+		return false
+	}
+	if len(r.Attester) != len(other.Attester) {
+		return false
+	}
+	for i := range r.Attester {
+		if !r.Attester[i].Equal(other.Attester[i]) {
+			return false
+		}
+	}
+	if (r.Custodian == nil) != (other.Custodian == nil) || r.Custodian != nil && !r.Custodian.Equal(*other.Custodian) {
+		return false
+	}
+	if len(r.RelatesTo) != len(other.RelatesTo) {
+		return false
+	}
+	for i := range r.RelatesTo {
+		if !r.RelatesTo[i].Equal(other.RelatesTo[i]) {
+			return false
+		}
+	}
+	if len(r.Event) != len(other.Event) {
+		return false
+	}
+	for i := range r.Event {
+		if !r.Event[i].Equal(other.Event[i]) {
+			return false
+		}
+	}
+	if len(r.Section) != len(other.Section) {
+		return false
+	}
+	for i := range r.Section {
+		if !r.Section[i].Equal(other.Section[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Composition) EqualsDeep(other Composition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Title, other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Confidentiality == nil) != (other.Confidentiality == nil) || r.Confidentiality != nil && !equivalentString(*r.Confidentiality, *other.Confidentiality) {
+		return false
+	}
+	if (r.ConfidentialityElement == nil) != (other.ConfidentialityElement == nil) || r.ConfidentialityElement != nil && !r.ConfidentialityElement.EqualsDeep(*other.ConfidentialityElement) {
+		return false
+	}
+	if !equivalentList(len(r.Attester), len(other.Attester), func(i, j int) bool {
+		return r.Attester[i].EqualsDeep(other.Attester[j])
+	}) {
+		return false
+	}
+	if (r.Custodian == nil) != (other.Custodian == nil) || r.Custodian != nil && !r.Custodian.EqualsDeep(*other.Custodian) {
+		return false
+	}
+	if !equivalentList(len(r.RelatesTo), len(other.RelatesTo), func(i, j int) bool {
+		return r.RelatesTo[i].EqualsDeep(other.RelatesTo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Event), len(other.Event), func(i, j int) bool {
+		return r.Event[i].EqualsDeep(other.Event[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Section), len(other.Section), func(i, j int) bool {
+		return r.Section[i].EqualsDeep(other.Section[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Composition) EqualsShallow(other Composition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if !equivalentString(r.Title, other.Title) {
+		return false
+	}
+	if (r.Confidentiality == nil) != (other.Confidentiality == nil) || r.Confidentiality != nil && !equivalentString(*r.Confidentiality, *other.Confidentiality) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Composition as FHIR XML
+func (r Composition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Composition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "confidentiality", r.Confidentiality, r.ConfidentialityElement); err != nil {
+		return err
+	}
+	for _, v := range r.Attester {
+		if err := encodeXMLElement(e, "attester", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "custodian", r.Custodian); err != nil {
+		return err
+	}
+	for _, v := range r.RelatesTo {
+		if err := encodeXMLElement(e, "relatesTo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Event {
+		if err := encodeXMLElement(e, "event", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Section {
+		if err := encodeXMLElement(e, "section", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Composition from FHIR XML
+func (r *Composition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "status":
+				var v CompositionStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = v
+				}
+				r.DateElement = element
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = v
+				}
+				r.TitleElement = element
+			case "confidentiality":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Confidentiality = &v
+				}
+				r.ConfidentialityElement = element
+			case "attester":
+				var v CompositionAttester
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Attester = append(r.Attester, v)
+			case "custodian":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Custodian = &v
+			case "relatesTo":
+				var v CompositionRelatesTo
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatesTo = append(r.RelatesTo, v)
+			case "event":
+				var v CompositionEvent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Event = append(r.Event, v)
+			case "section":
+				var v CompositionSection
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Section = append(r.Section, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Composition as properties to the RDF node n
+func (r Composition) turtle(n *turtleNode) {
+	n.resource("Composition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("Composition.identifier", -1, r.Identifier)
+	n.primitive("Composition.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Composition.type", -1, r.Type)
+	for i, v := range r.Category {
+		n.element("Composition.category", i, v)
+	}
+	n.element("Composition.subject", -1, r.Subject)
+	n.element("Composition.encounter", -1, r.Encounter)
+	n.primitive("Composition.date", -1, r.Date, r.DateElement, "dateTime")
+	for i, v := range r.Author {
+		n.element("Composition.author", i, v)
+	}
+	n.primitive("Composition.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("Composition.confidentiality", -1, r.Confidentiality, r.ConfidentialityElement, "string")
+	for i, v := range r.Attester {
+		n.element("Composition.attester", i, v)
+	}
+	n.element("Composition.custodian", -1, r.Custodian)
+	for i, v := range r.RelatesTo {
+		n.element("Composition.relatesTo", i, v)
+	}
+	for i, v := range r.Event {
+		n.element("Composition.event", i, v)
+	}
+	for i, v := range r.Section {
+		n.element("Composition.section", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Composition as BSON document with the structure of its FHIR JSON
+func (r Composition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Composition from a BSON document with the structure of its FHIR JSON
+func (r *Composition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Composition as protobuf message Composition of fhir.proto
+func (r Composition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Composition to the encoded message
+func (r Composition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Identifier != nil {
+		e.message(9, *r.Identifier)
+	}
+	e.varint(10, r.Status.protoNumber())
+	e.message(11, r.Type)
+	for _, v := range r.Category {
+		e.message(12, v)
+	}
+	if r.Subject != nil {
+		e.message(13, *r.Subject)
+	}
+	if r.Encounter != nil {
+		e.message(14, *r.Encounter)
+	}
+	if r.Date != "" {
+		e.string(15, r.Date)
+	}
+	for _, v := range r.Author {
+		e.message(16, v)
+	}
+	if r.Title != "" {
+		e.string(17, r.Title)
+	}
+	if r.Confidentiality != nil {
+		e.string(18, *r.Confidentiality)
+	}
+	for _, v := range r.Attester {
+		e.message(19, v)
+	}
+	if r.Custodian != nil {
+		e.message(20, *r.Custodian)
+	}
+	for _, v := range r.RelatesTo {
+		e.message(21, v)
+	}
+	for _, v := range r.Event {
+		e.message(22, v)
+	}
+	for _, v := range r.Section {
+		e.message(23, v)
+	}
+	if r.IdElement != nil {
+		e.message(24, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(25, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(26, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(27, *r.StatusElement)
+	}
+	if r.DateElement != nil {
+		e.message(28, *r.DateElement)
+	}
+	if r.TitleElement != nil {
+		e.message(29, *r.TitleElement)
+	}
+	if r.ConfidentialityElement != nil {
+		e.message(30, *r.ConfidentialityElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Composition from protobuf message Composition of fhir.proto
+func (r *Composition) UnmarshalProto(b []byte) error {
+	*r = Composition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 10:
+			var v CompositionStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 15:
+			r.Date = d.string()
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 17:
+			r.Title = d.string()
+		case 18:
+			v := d.string()
+			r.Confidentiality = &v
+		case 19:
+			var v CompositionAttester
+			d.message(&v)
+			r.Attester = append(r.Attester, v)
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Custodian = &v
+		case 21:
+			var v CompositionRelatesTo
+			d.message(&v)
+			r.RelatesTo = append(r.RelatesTo, v)
+		case 22:
+			var v CompositionEvent
+			d.message(&v)
+			r.Event = append(r.Event, v)
+		case 23:
+			var v CompositionSection
+			d.message(&v)
+			r.Section = append(r.Section, v)
+		case 24:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ConfidentialityElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CompositionAttester as JSON into a byte slice
+func (r CompositionAttester) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CompositionAttester as JSON object with the members in the order of the fields
+func (r CompositionAttester) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("mode")
+	e.string(r.Mode.Code())
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	if r.Time != nil {
+		e.key("time")
+		e.string(*r.Time)
+	}
+	if r.TimeElement != nil {
+		e.key("_time")
+		r.TimeElement.appendJSON(e)
+	}
+	if r.Party != nil {
+		e.key("party")
+		r.Party.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CompositionAttester from JSON. Unknown members are ignored.
+func (r *CompositionAttester) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CompositionAttester
+func (r *CompositionAttester) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "mode":
+			d.unmarshal(&r.Mode)
+		case "_mode":
+			r.ModeElement = d.element()
+		case "time":
+			if v, ok := d.string(); ok {
+				r.Time = &v
+			} else {
+				r.Time = nil
+			}
+		case "_time":
+			r.TimeElement = d.element()
+		case "party":
+			if d.null() {
+				r.Party = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Party = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CompositionAttester which shares no memory with the original
+func (r CompositionAttester) DeepCopy() CompositionAttester {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.Time != nil {
+		v := *r.Time
+		out.Time = &v
+	}
+	if r.TimeElement != nil {
+		v := r.TimeElement.DeepCopy()
+		out.TimeElement = &v
+	}
+	if r.Party != nil {
+		v := r.Party.DeepCopy()
+		out.Party = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CompositionAttester) Equal(other CompositionAttester) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if (r.Time == nil) != (other.Time == nil) || r.Time != nil && *r.Time != *other.Time {
+		return false
+	}
+	if (r.TimeElement == nil) != (other.TimeElement == nil) || r.TimeElement != nil && !r.TimeElement.Equal(*other.TimeElement) {
+		return false
+	}
+	if (r.Party == nil) != (other.Party == nil) || r.Party != nil && !r.Party.Equal(*other.Party) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CompositionAttester) EqualsDeep(other CompositionAttester) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if (r.Time == nil) != (other.Time == nil) || r.Time != nil && !equivalentString(*r.Time, *other.Time) {
+		return false
+	}
+	if (r.TimeElement == nil) != (other.TimeElement == nil) || r.TimeElement != nil && !r.TimeElement.EqualsDeep(*other.TimeElement) {
+		return false
+	}
+	if (r.Party == nil) != (other.Party == nil) || r.Party != nil && !r.Party.EqualsDeep(*other.Party) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CompositionAttester) EqualsShallow(other CompositionAttester) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.Time == nil) != (other.Time == nil) || r.Time != nil && !equivalentString(*r.Time, *other.Time) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CompositionAttester as FHIR XML
+func (r CompositionAttester) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "time", r.Time, r.TimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "party", r.Party); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CompositionAttester from FHIR XML
+func (r *CompositionAttester) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "mode":
+				var v CompositionAttestationMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = v
+				}
+				r.ModeElement = element
+			case "time":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Time = &v
+				}
+				r.TimeElement = element
+			case "party":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Party = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CompositionAttester as properties to the RDF node n
+func (r CompositionAttester) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Composition.attester.mode", -1, r.Mode, r.ModeElement, "code")
+	n.primitive("Composition.attester.time", -1, r.Time, r.TimeElement, "dateTime")
+	n.element("Composition.attester.party", -1, r.Party)
+}
+
+// MarshalBSON marshals the given CompositionAttester as BSON document with the structure of its FHIR JSON
+func (r CompositionAttester) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CompositionAttester from a BSON document with the structure of its FHIR JSON
+func (r *CompositionAttester) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CompositionAttester as protobuf message CompositionAttester of fhir.proto
+func (r CompositionAttester) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CompositionAttester to the encoded message
+func (r CompositionAttester) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Mode.protoNumber())
+	if r.Time != nil {
+		e.string(5, *r.Time)
+	}
+	if r.Party != nil {
+		e.message(6, *r.Party)
+	}
+	if r.ModeElement != nil {
+		e.message(7, *r.ModeElement)
+	}
+	if r.TimeElement != nil {
+		e.message(8, *r.TimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CompositionAttester from protobuf message CompositionAttester of fhir.proto
+func (r *CompositionAttester) UnmarshalProto(b []byte) error {
+	*r = CompositionAttester{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CompositionAttestationMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = v
+		case 5:
+			v := d.string()
+			r.Time = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Party = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.TimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CompositionRelatesTo as JSON into a byte slice
+func (r CompositionRelatesTo) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CompositionRelatesTo as JSON object with the members in the order of the fields
+func (r CompositionRelatesTo) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.TargetIdentifier != nil {
+		e.key("targetIdentifier")
+		r.TargetIdentifier.appendJSON(e)
+	}
+	if r.TargetReference != nil {
+		e.key("targetReference")
+		r.TargetReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CompositionRelatesTo from JSON. Unknown members are ignored.
+func (r *CompositionRelatesTo) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CompositionRelatesTo
+func (r *CompositionRelatesTo) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "targetIdentifier":
+			if d.null() {
+				r.TargetIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.TargetIdentifier = &v
+			}
+		case "targetReference":
+			if d.null() {
+				r.TargetReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.TargetReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CompositionRelatesTo which shares no memory with the original
+func (r CompositionRelatesTo) DeepCopy() CompositionRelatesTo {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.TargetIdentifier != nil {
+		v := r.TargetIdentifier.DeepCopy()
+		out.TargetIdentifier = &v
+	}
+	if r.TargetReference != nil {
+		v := r.TargetReference.DeepCopy()
+		out.TargetReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CompositionRelatesTo) Equal(other CompositionRelatesTo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.TargetIdentifier == nil) != (other.TargetIdentifier == nil) || r.TargetIdentifier != nil && !r.TargetIdentifier.Equal(*other.TargetIdentifier) {
+		return false
+	}
+	if (r.TargetReference == nil) != (other.TargetReference == nil) || r.TargetReference != nil && !r.TargetReference.Equal(*other.TargetReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CompositionRelatesTo) EqualsDeep(other CompositionRelatesTo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.TargetIdentifier == nil) != (other.TargetIdentifier == nil) || r.TargetIdentifier != nil && !r.TargetIdentifier.EqualsDeep(*other.TargetIdentifier) {
+		return false
+	}
+	if (r.TargetReference == nil) != (other.TargetReference == nil) || r.TargetReference != nil && !r.TargetReference.EqualsDeep(*other.TargetReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CompositionRelatesTo) EqualsShallow(other CompositionRelatesTo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CompositionRelatesTo as FHIR XML
+func (r CompositionRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "targetIdentifier", r.TargetIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "targetReference", r.TargetReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CompositionRelatesTo from FHIR XML
+func (r *CompositionRelatesTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v DocumentRelationshipType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "targetIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TargetIdentifier = &v
+			case "targetReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TargetReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CompositionRelatesTo as properties to the RDF node n
+func (r CompositionRelatesTo) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Composition.relatesTo.code", -1, r.Code, r.CodeElement, "code")
+	n.element("Composition.relatesTo.targetIdentifier", -1, r.TargetIdentifier)
+	n.element("Composition.relatesTo.targetReference", -1, r.TargetReference)
+}
+
+// MarshalBSON marshals the given CompositionRelatesTo as BSON document with the structure of its FHIR JSON
+func (r CompositionRelatesTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CompositionRelatesTo from a BSON document with the structure of its FHIR JSON
+func (r *CompositionRelatesTo) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CompositionRelatesTo as protobuf message CompositionRelatesTo of fhir.proto
+func (r CompositionRelatesTo) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CompositionRelatesTo to the encoded message
+func (r CompositionRelatesTo) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Code.protoNumber())
+	if r.TargetIdentifier != nil {
+		e.message(5, *r.TargetIdentifier)
+	}
+	if r.TargetReference != nil {
+		e.message(6, *r.TargetReference)
+	}
+	if r.CodeElement != nil {
+		e.message(7, *r.CodeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CompositionRelatesTo from protobuf message CompositionRelatesTo of fhir.proto
+func (r *CompositionRelatesTo) UnmarshalProto(b []byte) error {
+	*r = CompositionRelatesTo{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v DocumentRelationshipType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.TargetIdentifier = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.TargetReference = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CompositionEvent as JSON into a byte slice
+func (r CompositionEvent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CompositionEvent as JSON object with the members in the order of the fields
+func (r CompositionEvent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CompositionEvent from JSON. Unknown members are ignored.
+func (r *CompositionEvent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CompositionEvent
+func (r *CompositionEvent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CompositionEvent which shares no memory with the original
+func (r CompositionEvent) DeepCopy() CompositionEvent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Detail != nil {
+		out.Detail = make([]Reference, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CompositionEvent) Equal(other CompositionEvent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CompositionEvent) EqualsDeep(other CompositionEvent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CompositionEvent) EqualsShallow(other CompositionEvent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CompositionEvent as FHIR XML
+func (r CompositionEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CompositionEvent from FHIR XML
+func (r *CompositionEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "detail":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CompositionEvent as properties to the RDF node n
+func (r CompositionEvent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Code {
+		n.element("Composition.event.code", i, v)
+	}
+	n.element("Composition.event.period", -1, r.Period)
+	for i, v := range r.Detail {
+		n.element("Composition.event.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CompositionEvent as BSON document with the structure of its FHIR JSON
+func (r CompositionEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CompositionEvent from a BSON document with the structure of its FHIR JSON
+func (r *CompositionEvent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CompositionEvent as protobuf message CompositionEvent of fhir.proto
+func (r CompositionEvent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CompositionEvent to the encoded message
+func (r CompositionEvent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Code {
+		e.message(4, v)
+	}
+	if r.Period != nil {
+		e.message(5, *r.Period)
+	}
+	for _, v := range r.Detail {
+		e.message(6, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given CompositionEvent from protobuf message CompositionEvent of fhir.proto
+func (r *CompositionEvent) UnmarshalProto(b []byte) error {
+	*r = CompositionEvent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 5:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CompositionSection as JSON into a byte slice
+func (r CompositionSection) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CompositionSection as JSON object with the members in the order of the fields
+func (r CompositionSection) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Focus != nil {
+		e.key("focus")
+		r.Focus.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if r.Mode != nil {
+		e.key("mode")
+		e.string(r.Mode.Code())
+	}
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	if r.OrderedBy != nil {
+		e.key("orderedBy")
+		r.OrderedBy.appendJSON(e)
+	}
+	if len(r.Entry) > 0 {
+		e.key("entry")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Entry {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.EmptyReason != nil {
+		e.key("emptyReason")
+		r.EmptyReason.appendJSON(e)
+	}
+	if len(r.Section) > 0 {
+		e.key("section")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Section {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CompositionSection from JSON. Unknown members are ignored.
+func (r *CompositionSection) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CompositionSection
+func (r *CompositionSection) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []Reference{}
+			}
+		case "focus":
+			if d.null() {
+				r.Focus = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Focus = &v
+			}
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "mode":
+			if d.null() {
+				r.Mode = nil
+			} else {
+				var v ListMode
+				d.unmarshal(&v)
+				r.Mode = &v
+			}
+		case "_mode":
+			r.ModeElement = d.element()
+		case "orderedBy":
+			if d.null() {
+				r.OrderedBy = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OrderedBy = &v
+			}
+		case "entry":
+			a := d.array()
+			r.Entry = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Entry = append(r.Entry, v)
+			}
+			if r.Entry == nil && !a.isNull {
+				r.Entry = []Reference{}
+			}
+		case "emptyReason":
+			if d.null() {
+				r.EmptyReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.EmptyReason = &v
+			}
+		case "section":
+			a := d.array()
+			r.Section = nil
+			for a.next() {
+				var v CompositionSection
+				v.decodeJSON(d)
+				r.Section = append(r.Section, v)
+			}
+			if r.Section == nil && !a.isNull {
+				r.Section = []CompositionSection{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CompositionSection which shares no memory with the original
+func (r CompositionSection) DeepCopy() CompositionSection {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Author != nil {
+		out.Author = make([]Reference, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Focus != nil {
+		v := r.Focus.DeepCopy()
+		out.Focus = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Mode != nil {
+		v := *r.Mode
+		out.Mode = &v
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.OrderedBy != nil {
+		v := r.OrderedBy.DeepCopy()
+		out.OrderedBy = &v
+	}
+	if r.Entry != nil {
+		out.Entry = make([]Reference, len(r.Entry))
+		for i := range r.Entry {
+			out.Entry[i] = r.Entry[i].DeepCopy()
+		}
+	}
+	if r.EmptyReason != nil {
+		v := r.EmptyReason.DeepCopy()
+		out.EmptyReason = &v
+	}
+	if r.Section != nil {
+		out.Section = make([]CompositionSection, len(r.Section))
+		for i := range r.Section {
+			out.Section[i] = r.Section[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CompositionSection) Equal(other CompositionSection) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if (r.Focus == nil) != (other.Focus == nil) || r.Focus != nil && !r.Focus.Equal(*other.Focus) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if (r.Mode == nil) != (other.Mode == nil) || r.Mode != nil && *r.Mode != *other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if (r.OrderedBy == nil) != (other.OrderedBy == nil) || r.OrderedBy != nil && !r.OrderedBy.Equal(*other.OrderedBy) {
+		return false
+	}
+	if len(r.Entry) != len(other.Entry) {
+		return false
+	}
+	for i := range r.Entry {
+		if !r.Entry[i].Equal(other.Entry[i]) {
+			return false
+		}
+	}
+	if (r.EmptyReason == nil) != (other.EmptyReason == nil) || r.EmptyReason != nil && !r.EmptyReason.Equal(*other.EmptyReason) {
+		return false
+	}
+	if len(r.Section) != len(other.Section) {
+		return false
+	}
+	for i := range r.Section {
+		if !r.Section[i].Equal(other.Section[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CompositionSection) EqualsDeep(other CompositionSection) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if (r.Focus == nil) != (other.Focus == nil) || r.Focus != nil && !r.Focus.EqualsDeep(*other.Focus) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if (r.Mode == nil) != (other.Mode == nil) || r.Mode != nil && *r.Mode != *other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if (r.OrderedBy == nil) != (other.OrderedBy == nil) || r.OrderedBy != nil && !r.OrderedBy.EqualsDeep(*other.OrderedBy) {
+		return false
+	}
+	if !equivalentList(len(r.Entry), len(other.Entry), func(i, j int) bool {
+		return r.Entry[i].EqualsDeep(other.Entry[j])
+	}) {
+		return false
+	}
+	if (r.EmptyReason == nil) != (other.EmptyReason == nil) || r.EmptyReason != nil && !r.EmptyReason.EqualsDeep(*other.EmptyReason) {
+		return false
+	}
+	if !equivalentList(len(r.Section), len(other.Section), func(i, j int) bool {
+		return r.Section[i].EqualsDeep(other.Section[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CompositionSection) EqualsShallow(other CompositionSection) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.Mode == nil) != (other.Mode == nil) || r.Mode != nil && *r.Mode != *other.Mode {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CompositionSection as FHIR XML
+func (r CompositionSection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "focus", r.Focus); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "orderedBy", r.OrderedBy); err != nil {
+		return err
+	}
+	for _, v := range r.Entry {
+		if err := encodeXMLElement(e, "entry", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "emptyReason", r.EmptyReason); err != nil {
+		return err
+	}
+	for _, v := range r.Section {
+		if err := encodeXMLElement(e, "section", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CompositionSection from FHIR XML
+func (r *CompositionSection) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "focus":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Focus = &v
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "mode":
+				var v ListMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = &v
+				}
+				r.ModeElement = element
+			case "orderedBy":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OrderedBy = &v
+			case "entry":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Entry = append(r.Entry, v)
+			case "emptyReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EmptyReason = &v
+			case "section":
+				var v CompositionSection
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Section = append(r.Section, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CompositionSection as properties to the RDF node n
+func (r CompositionSection) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Composition.section.title", -1, r.Title, r.TitleElement, "string")
+	n.element("Composition.section.code", -1, r.Code)
+	for i, v := range r.Author {
+		n.element("Composition.section.author", i, v)
+	}
+	n.element("Composition.section.focus", -1, r.Focus)
+	n.element("Composition.section.text", -1, r.Text)
+	n.primitive("Composition.section.mode", -1, r.Mode, r.ModeElement, "code")
+	n.element("Composition.section.orderedBy", -1, r.OrderedBy)
+	for i, v := range r.Entry {
+		n.element("Composition.section.entry", i, v)
+	}
+	n.element("Composition.section.emptyReason", -1, r.EmptyReason)
+	for i, v := range r.Section {
+		n.element("Composition.section.section", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CompositionSection as BSON document with the structure of its FHIR JSON
+func (r CompositionSection) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CompositionSection from a BSON document with the structure of its FHIR JSON
+func (r *CompositionSection) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CompositionSection as protobuf message CompositionSection of fhir.proto
+func (r CompositionSection) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CompositionSection to the encoded message
+func (r CompositionSection) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Title != nil {
+		e.string(4, *r.Title)
+	}
+	if r.Code != nil {
+		e.message(5, *r.Code)
+	}
+	for _, v := range r.Author {
+		e.message(6, v)
+	}
+	if r.Focus != nil {
+		e.message(7, *r.Focus)
+	}
+	if r.Text != nil {
+		e.message(8, *r.Text)
+	}
+	if r.Mode != nil {
+		e.varint(9, r.Mode.protoNumber())
+	}
+	if r.OrderedBy != nil {
+		e.message(10, *r.OrderedBy)
+	}
+	for _, v := range r.Entry {
+		e.message(11, v)
+	}
+	if r.EmptyReason != nil {
+		e.message(12, *r.EmptyReason)
+	}
+	for _, v := range r.Section {
+		e.message(13, v)
+	}
+	if r.TitleElement != nil {
+		e.message(14, *r.TitleElement)
+	}
+	if r.ModeElement != nil {
+		e.message(15, *r.ModeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CompositionSection from protobuf message CompositionSection of fhir.proto
+func (r *CompositionSection) UnmarshalProto(b []byte) error {
+	*r = CompositionSection{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Title = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Focus = &v
+		case 8:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 9:
+			var v ListMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.OrderedBy = &v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Entry = append(r.Entry, v)
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.EmptyReason = &v
+		case 13:
+			var v CompositionSection
+			d.message(&v)
+			r.Section = append(r.Section, v)
+		case 14:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalComposition unmarshals a Composition.
 func UnmarshalComposition(b []byte) (Composition, error) {
 	var composition Composition
-	if err := json.Unmarshal(b, &composition); err != nil {
+	if err := composition.UnmarshalJSON(b); err != nil {
 		return composition, err
 	}
 	return composition, nil
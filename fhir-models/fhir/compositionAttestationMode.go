@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code CompositionAttestationMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CompositionAttestationMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CompositionAttestationMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "personal":
 		*code = CompositionAttestationModePersonal
@@ -52,6 +56,12 @@ func (code *CompositionAttestationMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CompositionAttestationMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CompositionAttestationMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CompositionAttestationMode) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code CompositionAttestationMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CompositionAttestationMode) protoNumber() uint64 {
+	switch code {
+	case CompositionAttestationModePersonal:
+		return 1
+	case CompositionAttestationModeProfessional:
+		return 2
+	case CompositionAttestationModeLegal:
+		return 3
+	case CompositionAttestationModeOfficial:
+		return 4
+	}
+	return 0
+}
+func (code *CompositionAttestationMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CompositionAttestationModePersonal
+	case 2:
+		*code = CompositionAttestationModeProfessional
+	case 3:
+		*code = CompositionAttestationModeLegal
+	case 4:
+		*code = CompositionAttestationModeOfficial
+	default:
+		return false
+	}
+	return true
+}
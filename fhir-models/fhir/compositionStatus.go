@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code CompositionStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *CompositionStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *CompositionStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "preliminary":
 		*code = CompositionStatusPreliminary
@@ -52,6 +56,12 @@ func (code *CompositionStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code CompositionStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *CompositionStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code CompositionStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code CompositionStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code CompositionStatus) protoNumber() uint64 {
+	switch code {
+	case CompositionStatusPreliminary:
+		return 1
+	case CompositionStatusFinal:
+		return 2
+	case CompositionStatusAmended:
+		return 3
+	case CompositionStatusEnteredInError:
+		return 4
+	}
+	return 0
+}
+func (code *CompositionStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = CompositionStatusPreliminary
+	case 2:
+		*code = CompositionStatusFinal
+	case 3:
+		*code = CompositionStatusAmended
+	case 4:
+		*code = CompositionStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
@@ -14,106 +14,4901 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ConceptMap is documented here http://hl7.org/fhir/StructureDefinition/ConceptMap
 type ConceptMap struct {
-	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string           `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        *Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string           `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string           `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string           `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus `bson:"status" json:"status"`
-	Experimental      *bool             `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string           `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string           `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail   `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string           `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext    `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string           `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string           `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	SourceUri         *string           `bson:"sourceUri,omitempty" json:"sourceUri,omitempty"`
-	SourceCanonical   *string           `bson:"sourceCanonical,omitempty" json:"sourceCanonical,omitempty"`
-	TargetUri         *string           `bson:"targetUri,omitempty" json:"targetUri,omitempty"`
-	TargetCanonical   *string           `bson:"targetCanonical,omitempty" json:"targetCanonical,omitempty"`
-	Group             []ConceptMapGroup `bson:"group,omitempty" json:"group,omitempty"`
+	Id                     *string           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                   *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                    *string           `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement             *Element          `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier             *Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                *string           `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement         *Element          `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                   *string           `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element          `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                  *string           `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement           *Element          `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                 PublicationStatus `bson:"status" json:"status"`
+	StatusElement          *Element          `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental           *bool             `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement    *Element          `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                   *string           `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element          `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher              *string           `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement       *Element          `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                []ContactDetail   `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description            *string           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element          `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext             []UsageContext    `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction           []CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                *string           `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement         *Element          `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright              *string           `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement       *Element          `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	SourceUri              *string           `bson:"sourceUri,omitempty" json:"sourceUri,omitempty"`
+	SourceUriElement       *Element          `bson:"_sourceUri,omitempty" json:"_sourceUri,omitempty"`
+	SourceCanonical        *string           `bson:"sourceCanonical,omitempty" json:"sourceCanonical,omitempty"`
+	SourceCanonicalElement *Element          `bson:"_sourceCanonical,omitempty" json:"_sourceCanonical,omitempty"`
+	TargetUri              *string           `bson:"targetUri,omitempty" json:"targetUri,omitempty"`
+	TargetUriElement       *Element          `bson:"_targetUri,omitempty" json:"_targetUri,omitempty"`
+	TargetCanonical        *string           `bson:"targetCanonical,omitempty" json:"targetCanonical,omitempty"`
+	TargetCanonicalElement *Element          `bson:"_targetCanonical,omitempty" json:"_targetCanonical,omitempty"`
+	Group                  []ConceptMapGroup `bson:"group,omitempty" json:"group,omitempty"`
 }
+
+// ConceptMapSource is implemented by the types allowed for ConceptMap.source[x]
+type ConceptMapSource interface {
+	isConceptMapSource()
+}
+
+func (Uri) isConceptMapSource()       {}
+func (Canonical) isConceptMapSource() {}
+
+// Source returns the value of ConceptMap.source[x] together with its FHIR type code or nil if no value is set
+func (r ConceptMap) Source() (ConceptMapSource, string) {
+	if r.SourceUri != nil {
+		return Uri(*r.SourceUri), "uri"
+	}
+	if r.SourceCanonical != nil {
+		return Canonical(*r.SourceCanonical), "canonical"
+	}
+	return nil, ""
+}
+
+// SetSource sets the value of ConceptMap.source[x], given as value or pointer, and clears all other types
+func (r *ConceptMap) SetSource(value ConceptMapSource) {
+	r.SourceUri = nil
+	r.SourceCanonical = nil
+	switch v := value.(type) {
+	case Uri:
+		primitive := string(v)
+		r.SourceUri = &primitive
+	case *Uri:
+		r.SourceUri = (*string)(v)
+	case Canonical:
+		primitive := string(v)
+		r.SourceCanonical = &primitive
+	case *Canonical:
+		r.SourceCanonical = (*string)(v)
+	}
+}
+
+// ConceptMapTarget is implemented by the types allowed for ConceptMap.target[x]
+type ConceptMapTarget interface {
+	isConceptMapTarget()
+}
+
+func (Uri) isConceptMapTarget()       {}
+func (Canonical) isConceptMapTarget() {}
+
+// Target returns the value of ConceptMap.target[x] together with its FHIR type code or nil if no value is set
+func (r ConceptMap) Target() (ConceptMapTarget, string) {
+	if r.TargetUri != nil {
+		return Uri(*r.TargetUri), "uri"
+	}
+	if r.TargetCanonical != nil {
+		return Canonical(*r.TargetCanonical), "canonical"
+	}
+	return nil, ""
+}
+
+// SetTarget sets the value of ConceptMap.target[x], given as value or pointer, and clears all other types
+func (r *ConceptMap) SetTarget(value ConceptMapTarget) {
+	r.TargetUri = nil
+	r.TargetCanonical = nil
+	switch v := value.(type) {
+	case Uri:
+		primitive := string(v)
+		r.TargetUri = &primitive
+	case *Uri:
+		r.TargetUri = (*string)(v)
+	case Canonical:
+		primitive := string(v)
+		r.TargetCanonical = &primitive
+	case *Canonical:
+		r.TargetCanonical = (*string)(v)
+	}
+}
+
 type ConceptMapGroup struct {
-	Id                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Source            *string                  `bson:"source,omitempty" json:"source,omitempty"`
-	SourceVersion     *string                  `bson:"sourceVersion,omitempty" json:"sourceVersion,omitempty"`
-	Target            *string                  `bson:"target,omitempty" json:"target,omitempty"`
-	TargetVersion     *string                  `bson:"targetVersion,omitempty" json:"targetVersion,omitempty"`
-	Element           []ConceptMapGroupElement `bson:"element" json:"element"`
-	Unmapped          *ConceptMapGroupUnmapped `bson:"unmapped,omitempty" json:"unmapped,omitempty"`
+	Id                   *string                  `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Source               *string                  `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement        *Element                 `bson:"_source,omitempty" json:"_source,omitempty"`
+	SourceVersion        *string                  `bson:"sourceVersion,omitempty" json:"sourceVersion,omitempty"`
+	SourceVersionElement *Element                 `bson:"_sourceVersion,omitempty" json:"_sourceVersion,omitempty"`
+	Target               *string                  `bson:"target,omitempty" json:"target,omitempty"`
+	TargetElement        *Element                 `bson:"_target,omitempty" json:"_target,omitempty"`
+	TargetVersion        *string                  `bson:"targetVersion,omitempty" json:"targetVersion,omitempty"`
+	TargetVersionElement *Element                 `bson:"_targetVersion,omitempty" json:"_targetVersion,omitempty"`
+	Element              []ConceptMapGroupElement `bson:"element" json:"element"`
+	Unmapped             *ConceptMapGroupUnmapped `bson:"unmapped,omitempty" json:"unmapped,omitempty"`
 }
 type ConceptMapGroupElement struct {
 	Id                *string                        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              *string                        `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement       *Element                       `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                        `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                       `bson:"_display,omitempty" json:"_display,omitempty"`
 	Target            []ConceptMapGroupElementTarget `bson:"target,omitempty" json:"target,omitempty"`
 }
 type ConceptMapGroupElementTarget struct {
-	Id                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *string                                 `bson:"code,omitempty" json:"code,omitempty"`
-	Display           *string                                 `bson:"display,omitempty" json:"display,omitempty"`
-	Equivalence       ConceptMapEquivalence                   `bson:"equivalence" json:"equivalence"`
-	Comment           *string                                 `bson:"comment,omitempty" json:"comment,omitempty"`
-	DependsOn         []ConceptMapGroupElementTargetDependsOn `bson:"dependsOn,omitempty" json:"dependsOn,omitempty"`
-	Product           []ConceptMapGroupElementTargetDependsOn `bson:"product,omitempty" json:"product,omitempty"`
+	Id                 *string                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code               *string                                 `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement        *Element                                `bson:"_code,omitempty" json:"_code,omitempty"`
+	Display            *string                                 `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement     *Element                                `bson:"_display,omitempty" json:"_display,omitempty"`
+	Equivalence        ConceptMapEquivalence                   `bson:"equivalence" json:"equivalence"`
+	EquivalenceElement *Element                                `bson:"_equivalence,omitempty" json:"_equivalence,omitempty"`
+	Comment            *string                                 `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement     *Element                                `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	DependsOn          []ConceptMapGroupElementTargetDependsOn `bson:"dependsOn,omitempty" json:"dependsOn,omitempty"`
+	Product            []ConceptMapGroupElementTargetDependsOn `bson:"product,omitempty" json:"product,omitempty"`
 }
 type ConceptMapGroupElementTargetDependsOn struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Property          string      `bson:"property" json:"property"`
+	PropertyElement   *Element    `bson:"_property,omitempty" json:"_property,omitempty"`
 	System            *string     `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement     *Element    `bson:"_system,omitempty" json:"_system,omitempty"`
 	Value             string      `bson:"value" json:"value"`
+	ValueElement      *Element    `bson:"_value,omitempty" json:"_value,omitempty"`
 	Display           *string     `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element    `bson:"_display,omitempty" json:"_display,omitempty"`
 }
 type ConceptMapGroupUnmapped struct {
 	Id                *string                     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Mode              ConceptMapGroupUnmappedMode `bson:"mode" json:"mode"`
+	ModeElement       *Element                    `bson:"_mode,omitempty" json:"_mode,omitempty"`
 	Code              *string                     `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement       *Element                    `bson:"_code,omitempty" json:"_code,omitempty"`
 	Display           *string                     `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                    `bson:"_display,omitempty" json:"_display,omitempty"`
 	Url               *string                     `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement        *Element                    `bson:"_url,omitempty" json:"_url,omitempty"`
 }
-type OtherConceptMap ConceptMap
 
 // MarshalJSON marshals the given ConceptMap as JSON into a byte slice
 func (r ConceptMap) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherConceptMap
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherConceptMap: OtherConceptMap(r),
-		ResourceType:    "ConceptMap",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConceptMap as JSON object with the members in the order of the fields
+func (r ConceptMap) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ConceptMap\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.SourceUri != nil {
+		e.key("sourceUri")
+		e.string(*r.SourceUri)
+	}
+	if r.SourceUriElement != nil {
+		e.key("_sourceUri")
+		r.SourceUriElement.appendJSON(e)
+	}
+	if r.SourceCanonical != nil {
+		e.key("sourceCanonical")
+		e.string(*r.SourceCanonical)
+	}
+	if r.SourceCanonicalElement != nil {
+		e.key("_sourceCanonical")
+		r.SourceCanonicalElement.appendJSON(e)
+	}
+	if r.TargetUri != nil {
+		e.key("targetUri")
+		e.string(*r.TargetUri)
+	}
+	if r.TargetUriElement != nil {
+		e.key("_targetUri")
+		r.TargetUriElement.appendJSON(e)
+	}
+	if r.TargetCanonical != nil {
+		e.key("targetCanonical")
+		e.string(*r.TargetCanonical)
+	}
+	if r.TargetCanonicalElement != nil {
+		e.key("_targetCanonical")
+		r.TargetCanonicalElement.appendJSON(e)
+	}
+	if len(r.Group) > 0 {
+		e.key("group")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Group {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConceptMap from JSON. Unknown members are ignored.
+func (r *ConceptMap) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConceptMap
+func (r *ConceptMap) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "sourceUri":
+			if v, ok := d.string(); ok {
+				r.SourceUri = &v
+			} else {
+				r.SourceUri = nil
+			}
+		case "_sourceUri":
+			r.SourceUriElement = d.element()
+		case "sourceCanonical":
+			if v, ok := d.string(); ok {
+				r.SourceCanonical = &v
+			} else {
+				r.SourceCanonical = nil
+			}
+		case "_sourceCanonical":
+			r.SourceCanonicalElement = d.element()
+		case "targetUri":
+			if v, ok := d.string(); ok {
+				r.TargetUri = &v
+			} else {
+				r.TargetUri = nil
+			}
+		case "_targetUri":
+			r.TargetUriElement = d.element()
+		case "targetCanonical":
+			if v, ok := d.string(); ok {
+				r.TargetCanonical = &v
+			} else {
+				r.TargetCanonical = nil
+			}
+		case "_targetCanonical":
+			r.TargetCanonicalElement = d.element()
+		case "group":
+			a := d.array()
+			r.Group = nil
+			for a.next() {
+				var v ConceptMapGroup
+				v.decodeJSON(d)
+				r.Group = append(r.Group, v)
+			}
+			if r.Group == nil && !a.isNull {
+				r.Group = []ConceptMapGroup{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConceptMap which shares no memory with the original
+func (r ConceptMap) DeepCopy() ConceptMap {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.SourceUri != nil {
+		v := *r.SourceUri
+		out.SourceUri = &v
+	}
+	if r.SourceUriElement != nil {
+		v := r.SourceUriElement.DeepCopy()
+		out.SourceUriElement = &v
+	}
+	if r.SourceCanonical != nil {
+		v := *r.SourceCanonical
+		out.SourceCanonical = &v
+	}
+	if r.SourceCanonicalElement != nil {
+		v := r.SourceCanonicalElement.DeepCopy()
+		out.SourceCanonicalElement = &v
+	}
+	if r.TargetUri != nil {
+		v := *r.TargetUri
+		out.TargetUri = &v
+	}
+	if r.TargetUriElement != nil {
+		v := r.TargetUriElement.DeepCopy()
+		out.TargetUriElement = &v
+	}
+	if r.TargetCanonical != nil {
+		v := *r.TargetCanonical
+		out.TargetCanonical = &v
+	}
+	if r.TargetCanonicalElement != nil {
+		v := r.TargetCanonicalElement.DeepCopy()
+		out.TargetCanonicalElement = &v
+	}
+	if r.Group != nil {
+		out.Group = make([]ConceptMapGroup, len(r.Group))
+		for i := range r.Group {
+			out.Group[i] = r.Group[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConceptMap) Equal(other ConceptMap) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.SourceUri == nil) != (other.SourceUri == nil) || r.SourceUri != nil && *r.SourceUri != *other.SourceUri {
+		return false
+	}
+	if (r.SourceUriElement == nil) != (other.SourceUriElement == nil) || r.SourceUriElement != nil && !r.SourceUriElement.Equal(*other.SourceUriElement) {
+		return false
+	}
+	if (r.SourceCanonical == nil) != (other.SourceCanonical == nil) || r.SourceCanonical != nil && *r.SourceCanonical != *other.SourceCanonical {
+		return false
+	}
+	if (r.SourceCanonicalElement == nil) != (other.SourceCanonicalElement == nil) || r.SourceCanonicalElement != nil && !r.SourceCanonicalElement.Equal(*other.SourceCanonicalElement) {
+		return false
+	}
+	if (r.TargetUri == nil) != (other.TargetUri == nil) || r.TargetUri != nil && *r.TargetUri != *other.TargetUri {
+		return false
+	}
+	if (r.TargetUriElement == nil) != (other.TargetUriElement == nil) || r.TargetUriElement != nil && !r.TargetUriElement.Equal(*other.TargetUriElement) {
+		return false
+	}
+	if (r.TargetCanonical == nil) != (other.TargetCanonical == nil) || r.TargetCanonical != nil && *r.TargetCanonical != *other.TargetCanonical {
+		return false
+	}
+	if (r.TargetCanonicalElement == nil) != (other.TargetCanonicalElement == nil) || r.TargetCanonicalElement != nil && !r.TargetCanonicalElement.Equal(*other.TargetCanonicalElement) {
+		return false
+	}
+	if len(r.Group) != len(other.Group) {
+		return false
+	}
+	for i := range r.Group {
+		if !r.Group[i].Equal(other.Group[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConceptMap) EqualsDeep(other ConceptMap) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.SourceUri == nil) != (other.SourceUri == nil) || r.SourceUri != nil && !equivalentString(*r.SourceUri, *other.SourceUri) {
+		return false
+	}
+	if (r.SourceUriElement == nil) != (other.SourceUriElement == nil) || r.SourceUriElement != nil && !r.SourceUriElement.EqualsDeep(*other.SourceUriElement) {
+		return false
+	}
+	if (r.SourceCanonical == nil) != (other.SourceCanonical == nil) || r.SourceCanonical != nil && !equivalentString(*r.SourceCanonical, *other.SourceCanonical) {
+		return false
+	}
+	if (r.SourceCanonicalElement == nil) != (other.SourceCanonicalElement == nil) || r.SourceCanonicalElement != nil && !r.SourceCanonicalElement.EqualsDeep(*other.SourceCanonicalElement) {
+		return false
+	}
+	if (r.TargetUri == nil) != (other.TargetUri == nil) || r.TargetUri != nil && !equivalentString(*r.TargetUri, *other.TargetUri) {
+		return false
+	}
+	if (r.TargetUriElement == nil) != (other.TargetUriElement == nil) || r.TargetUriElement != nil && !r.TargetUriElement.EqualsDeep(*other.TargetUriElement) {
+		return false
+	}
+	if (r.TargetCanonical == nil) != (other.TargetCanonical == nil) || r.TargetCanonical != nil && !equivalentString(*r.TargetCanonical, *other.TargetCanonical) {
+		return false
+	}
+	if (r.TargetCanonicalElement == nil) != (other.TargetCanonicalElement == nil) || r.TargetCanonicalElement != nil && !r.TargetCanonicalElement.EqualsDeep(*other.TargetCanonicalElement) {
+		return false
+	}
+	if !equivalentList(len(r.Group), len(other.Group), func(i, j int) bool {
+		return r.Group[i].EqualsDeep(other.Group[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConceptMap) EqualsShallow(other ConceptMap) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.SourceUri == nil) != (other.SourceUri == nil) || r.SourceUri != nil && !equivalentString(*r.SourceUri, *other.SourceUri) {
+		return false
+	}
+	if (r.SourceCanonical == nil) != (other.SourceCanonical == nil) || r.SourceCanonical != nil && !equivalentString(*r.SourceCanonical, *other.SourceCanonical) {
+		return false
+	}
+	if (r.TargetUri == nil) != (other.TargetUri == nil) || r.TargetUri != nil && !equivalentString(*r.TargetUri, *other.TargetUri) {
+		return false
+	}
+	if (r.TargetCanonical == nil) != (other.TargetCanonical == nil) || r.TargetCanonical != nil && !equivalentString(*r.TargetCanonical, *other.TargetCanonical) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConceptMap as FHIR XML
+func (r ConceptMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ConceptMap",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sourceUri", r.SourceUri, r.SourceUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sourceCanonical", r.SourceCanonical, r.SourceCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "targetUri", r.TargetUri, r.TargetUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "targetCanonical", r.TargetCanonical, r.TargetCanonicalElement); err != nil {
+		return err
+	}
+	for _, v := range r.Group {
+		if err := encodeXMLElement(e, "group", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConceptMap from FHIR XML
+func (r *ConceptMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "sourceUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SourceUri = &v
+				}
+				r.SourceUriElement = element
+			case "sourceCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SourceCanonical = &v
+				}
+				r.SourceCanonicalElement = element
+			case "targetUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TargetUri = &v
+				}
+				r.TargetUriElement = element
+			case "targetCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TargetCanonical = &v
+				}
+				r.TargetCanonicalElement = element
+			case "group":
+				var v ConceptMapGroup
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Group = append(r.Group, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConceptMap as properties to the RDF node n
+func (r ConceptMap) turtle(n *turtleNode) {
+	n.resource("ConceptMap", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("ConceptMap.url", -1, r.Url, r.UrlElement, "string")
+	n.element("ConceptMap.identifier", -1, r.Identifier)
+	n.primitive("ConceptMap.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("ConceptMap.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ConceptMap.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("ConceptMap.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("ConceptMap.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("ConceptMap.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("ConceptMap.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("ConceptMap.contact", i, v)
+	}
+	n.primitive("ConceptMap.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("ConceptMap.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("ConceptMap.jurisdiction", i, v)
+	}
+	n.primitive("ConceptMap.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("ConceptMap.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("ConceptMap.sourceUri", -1, r.SourceUri, r.SourceUriElement, "uri")
+	n.primitive("ConceptMap.sourceCanonical", -1, r.SourceCanonical, r.SourceCanonicalElement, "canonical")
+	n.primitive("ConceptMap.targetUri", -1, r.TargetUri, r.TargetUriElement, "uri")
+	n.primitive("ConceptMap.targetCanonical", -1, r.TargetCanonical, r.TargetCanonicalElement, "canonical")
+	for i, v := range r.Group {
+		n.element("ConceptMap.group", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ConceptMap as BSON document with the structure of its FHIR JSON
+func (r ConceptMap) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConceptMap from a BSON document with the structure of its FHIR JSON
+func (r *ConceptMap) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConceptMap as protobuf message ConceptMap of fhir.proto
+func (r ConceptMap) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConceptMap to the encoded message
+func (r ConceptMap) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	if r.Identifier != nil {
+		e.message(10, *r.Identifier)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	e.varint(14, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(15, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(16, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(17, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(18, v)
+	}
+	if r.Description != nil {
+		e.string(19, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(20, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(21, v)
+	}
+	if r.Purpose != nil {
+		e.string(22, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(23, *r.Copyright)
+	}
+	if r.SourceUri != nil {
+		e.string(24, *r.SourceUri)
+	}
+	if r.SourceCanonical != nil {
+		e.string(25, *r.SourceCanonical)
+	}
+	if r.TargetUri != nil {
+		e.string(26, *r.TargetUri)
+	}
+	if r.TargetCanonical != nil {
+		e.string(27, *r.TargetCanonical)
+	}
+	for _, v := range r.Group {
+		e.message(28, v)
+	}
+	if r.IdElement != nil {
+		e.message(29, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(30, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(31, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(32, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(33, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(34, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(35, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(36, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(37, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(38, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(39, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(40, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(41, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(42, *r.CopyrightElement)
+	}
+	if r.SourceUriElement != nil {
+		e.message(43, *r.SourceUriElement)
+	}
+	if r.SourceCanonicalElement != nil {
+		e.message(44, *r.SourceCanonicalElement)
+	}
+	if r.TargetUriElement != nil {
+		e.message(45, *r.TargetUriElement)
+	}
+	if r.TargetCanonicalElement != nil {
+		e.message(46, *r.TargetCanonicalElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConceptMap from protobuf message ConceptMap of fhir.proto
+func (r *ConceptMap) UnmarshalProto(b []byte) error {
+	*r = ConceptMap{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 15:
+			v := d.bool()
+			r.Experimental = &v
+		case 16:
+			v := d.string()
+			r.Date = &v
+		case 17:
+			v := d.string()
+			r.Publisher = &v
+		case 18:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 19:
+			v := d.string()
+			r.Description = &v
+		case 20:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 22:
+			v := d.string()
+			r.Purpose = &v
+		case 23:
+			v := d.string()
+			r.Copyright = &v
+		case 24:
+			v := d.string()
+			r.SourceUri = &v
+		case 25:
+			v := d.string()
+			r.SourceCanonical = &v
+		case 26:
+			v := d.string()
+			r.TargetUri = &v
+		case 27:
+			v := d.string()
+			r.TargetCanonical = &v
+		case 28:
+			var v ConceptMapGroup
+			d.message(&v)
+			r.Group = append(r.Group, v)
+		case 29:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.SourceUriElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.SourceCanonicalElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.TargetUriElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.TargetCanonicalElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConceptMapGroup as JSON into a byte slice
+func (r ConceptMapGroup) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConceptMapGroup as JSON object with the members in the order of the fields
+func (r ConceptMapGroup) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Source != nil {
+		e.key("source")
+		e.string(*r.Source)
+	}
+	if r.SourceElement != nil {
+		e.key("_source")
+		r.SourceElement.appendJSON(e)
+	}
+	if r.SourceVersion != nil {
+		e.key("sourceVersion")
+		e.string(*r.SourceVersion)
+	}
+	if r.SourceVersionElement != nil {
+		e.key("_sourceVersion")
+		r.SourceVersionElement.appendJSON(e)
+	}
+	if r.Target != nil {
+		e.key("target")
+		e.string(*r.Target)
+	}
+	if r.TargetElement != nil {
+		e.key("_target")
+		r.TargetElement.appendJSON(e)
+	}
+	if r.TargetVersion != nil {
+		e.key("targetVersion")
+		e.string(*r.TargetVersion)
+	}
+	if r.TargetVersionElement != nil {
+		e.key("_targetVersion")
+		r.TargetVersionElement.appendJSON(e)
+	}
+	e.key("element")
+	if r.Element == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Element {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Unmapped != nil {
+		e.key("unmapped")
+		r.Unmapped.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConceptMapGroup from JSON. Unknown members are ignored.
+func (r *ConceptMapGroup) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConceptMapGroup
+func (r *ConceptMapGroup) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "source":
+			if v, ok := d.string(); ok {
+				r.Source = &v
+			} else {
+				r.Source = nil
+			}
+		case "_source":
+			r.SourceElement = d.element()
+		case "sourceVersion":
+			if v, ok := d.string(); ok {
+				r.SourceVersion = &v
+			} else {
+				r.SourceVersion = nil
+			}
+		case "_sourceVersion":
+			r.SourceVersionElement = d.element()
+		case "target":
+			if v, ok := d.string(); ok {
+				r.Target = &v
+			} else {
+				r.Target = nil
+			}
+		case "_target":
+			r.TargetElement = d.element()
+		case "targetVersion":
+			if v, ok := d.string(); ok {
+				r.TargetVersion = &v
+			} else {
+				r.TargetVersion = nil
+			}
+		case "_targetVersion":
+			r.TargetVersionElement = d.element()
+		case "element":
+			a := d.array()
+			r.Element = nil
+			for a.next() {
+				var v ConceptMapGroupElement
+				v.decodeJSON(d)
+				r.Element = append(r.Element, v)
+			}
+			if r.Element == nil && !a.isNull {
+				r.Element = []ConceptMapGroupElement{}
+			}
+		case "unmapped":
+			if d.null() {
+				r.Unmapped = nil
+			} else {
+				var v ConceptMapGroupUnmapped
+				v.decodeJSON(d)
+				r.Unmapped = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConceptMapGroup which shares no memory with the original
+func (r ConceptMapGroup) DeepCopy() ConceptMapGroup {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Source != nil {
+		v := *r.Source
+		out.Source = &v
+	}
+	if r.SourceElement != nil {
+		v := r.SourceElement.DeepCopy()
+		out.SourceElement = &v
+	}
+	if r.SourceVersion != nil {
+		v := *r.SourceVersion
+		out.SourceVersion = &v
+	}
+	if r.SourceVersionElement != nil {
+		v := r.SourceVersionElement.DeepCopy()
+		out.SourceVersionElement = &v
+	}
+	if r.Target != nil {
+		v := *r.Target
+		out.Target = &v
+	}
+	if r.TargetElement != nil {
+		v := r.TargetElement.DeepCopy()
+		out.TargetElement = &v
+	}
+	if r.TargetVersion != nil {
+		v := *r.TargetVersion
+		out.TargetVersion = &v
+	}
+	if r.TargetVersionElement != nil {
+		v := r.TargetVersionElement.DeepCopy()
+		out.TargetVersionElement = &v
+	}
+	if r.Element != nil {
+		out.Element = make([]ConceptMapGroupElement, len(r.Element))
+		for i := range r.Element {
+			out.Element[i] = r.Element[i].DeepCopy()
+		}
+	}
+	if r.Unmapped != nil {
+		v := r.Unmapped.DeepCopy()
+		out.Unmapped = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConceptMapGroup) Equal(other ConceptMapGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && *r.Source != *other.Source {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.Equal(*other.SourceElement) {
+		return false
+	}
+	if (r.SourceVersion == nil) != (other.SourceVersion == nil) || r.SourceVersion != nil && *r.SourceVersion != *other.SourceVersion {
+		return false
+	}
+	if (r.SourceVersionElement == nil) != (other.SourceVersionElement == nil) || r.SourceVersionElement != nil && !r.SourceVersionElement.Equal(*other.SourceVersionElement) {
+		return false
+	}
+	if (r.Target == nil) != (other.Target == nil) || r.Target != nil && *r.Target != *other.Target {
+		return false
+	}
+	if (r.TargetElement == nil) != (other.TargetElement == nil) || r.TargetElement != nil && !r.TargetElement.Equal(*other.TargetElement) {
+		return false
+	}
+	if (r.TargetVersion == nil) != (other.TargetVersion == nil) || r.TargetVersion != nil && *r.TargetVersion != *other.TargetVersion {
+		return false
+	}
+	if (r.TargetVersionElement == nil) != (other.TargetVersionElement == nil) || r.TargetVersionElement != nil && !r.TargetVersionElement.Equal(*other.TargetVersionElement) {
+		return false
+	}
+	if len(r.Element) != len(other.Element) {
+		return false
+	}
+	for i := range r.Element {
+		if !r.Element[i].Equal(other.Element[i]) {
+			return false
+		}
+	}
+	if (r.Unmapped == nil) != (other.Unmapped == nil) || r.Unmapped != nil && !r.Unmapped.Equal(*other.Unmapped) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConceptMapGroup) EqualsDeep(other ConceptMapGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.EqualsDeep(*other.SourceElement) {
+		return false
+	}
+	if (r.SourceVersion == nil) != (other.SourceVersion == nil) || r.SourceVersion != nil && !equivalentString(*r.SourceVersion, *other.SourceVersion) {
+		return false
+	}
+	if (r.SourceVersionElement == nil) != (other.SourceVersionElement == nil) || r.SourceVersionElement != nil && !r.SourceVersionElement.EqualsDeep(*other.SourceVersionElement) {
+		return false
+	}
+	if (r.Target == nil) != (other.Target == nil) || r.Target != nil && !equivalentString(*r.Target, *other.Target) {
+		return false
+	}
+	if (r.TargetElement == nil) != (other.TargetElement == nil) || r.TargetElement != nil && !r.TargetElement.EqualsDeep(*other.TargetElement) {
+		return false
+	}
+	if (r.TargetVersion == nil) != (other.TargetVersion == nil) || r.TargetVersion != nil && !equivalentString(*r.TargetVersion, *other.TargetVersion) {
+		return false
+	}
+	if (r.TargetVersionElement == nil) != (other.TargetVersionElement == nil) || r.TargetVersionElement != nil && !r.TargetVersionElement.EqualsDeep(*other.TargetVersionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Element), len(other.Element), func(i, j int) bool {
+		return r.Element[i].EqualsDeep(other.Element[j])
+	}) {
+		return false
+	}
+	if (r.Unmapped == nil) != (other.Unmapped == nil) || r.Unmapped != nil && !r.Unmapped.EqualsDeep(*other.Unmapped) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConceptMapGroup) EqualsShallow(other ConceptMapGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	if (r.SourceVersion == nil) != (other.SourceVersion == nil) || r.SourceVersion != nil && !equivalentString(*r.SourceVersion, *other.SourceVersion) {
+		return false
+	}
+	if (r.Target == nil) != (other.Target == nil) || r.Target != nil && !equivalentString(*r.Target, *other.Target) {
+		return false
+	}
+	if (r.TargetVersion == nil) != (other.TargetVersion == nil) || r.TargetVersion != nil && !equivalentString(*r.TargetVersion, *other.TargetVersion) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConceptMapGroup as FHIR XML
+func (r ConceptMapGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "source", r.Source, r.SourceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sourceVersion", r.SourceVersion, r.SourceVersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "target", r.Target, r.TargetElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "targetVersion", r.TargetVersion, r.TargetVersionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Element {
+		if err := encodeXMLElement(e, "element", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "unmapped", r.Unmapped); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConceptMapGroup from FHIR XML
+func (r *ConceptMapGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "source":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Source = &v
+				}
+				r.SourceElement = element
+			case "sourceVersion":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SourceVersion = &v
+				}
+				r.SourceVersionElement = element
+			case "target":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Target = &v
+				}
+				r.TargetElement = element
+			case "targetVersion":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TargetVersion = &v
+				}
+				r.TargetVersionElement = element
+			case "element":
+				var v ConceptMapGroupElement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Element = append(r.Element, v)
+			case "unmapped":
+				var v ConceptMapGroupUnmapped
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Unmapped = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConceptMapGroup as properties to the RDF node n
+func (r ConceptMapGroup) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ConceptMap.group.source", -1, r.Source, r.SourceElement, "string")
+	n.primitive("ConceptMap.group.sourceVersion", -1, r.SourceVersion, r.SourceVersionElement, "string")
+	n.primitive("ConceptMap.group.target", -1, r.Target, r.TargetElement, "string")
+	n.primitive("ConceptMap.group.targetVersion", -1, r.TargetVersion, r.TargetVersionElement, "string")
+	for i, v := range r.Element {
+		n.element("ConceptMap.group.element", i, v)
+	}
+	n.element("ConceptMap.group.unmapped", -1, r.Unmapped)
+}
+
+// MarshalBSON marshals the given ConceptMapGroup as BSON document with the structure of its FHIR JSON
+func (r ConceptMapGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConceptMapGroup from a BSON document with the structure of its FHIR JSON
+func (r *ConceptMapGroup) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConceptMapGroup as protobuf message ConceptMapGroup of fhir.proto
+func (r ConceptMapGroup) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConceptMapGroup to the encoded message
+func (r ConceptMapGroup) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Source != nil {
+		e.string(4, *r.Source)
+	}
+	if r.SourceVersion != nil {
+		e.string(5, *r.SourceVersion)
+	}
+	if r.Target != nil {
+		e.string(6, *r.Target)
+	}
+	if r.TargetVersion != nil {
+		e.string(7, *r.TargetVersion)
+	}
+	for _, v := range r.Element {
+		e.message(8, v)
+	}
+	if r.Unmapped != nil {
+		e.message(9, *r.Unmapped)
+	}
+	if r.SourceElement != nil {
+		e.message(10, *r.SourceElement)
+	}
+	if r.SourceVersionElement != nil {
+		e.message(11, *r.SourceVersionElement)
+	}
+	if r.TargetElement != nil {
+		e.message(12, *r.TargetElement)
+	}
+	if r.TargetVersionElement != nil {
+		e.message(13, *r.TargetVersionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConceptMapGroup from protobuf message ConceptMapGroup of fhir.proto
+func (r *ConceptMapGroup) UnmarshalProto(b []byte) error {
+	*r = ConceptMapGroup{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Source = &v
+		case 5:
+			v := d.string()
+			r.SourceVersion = &v
+		case 6:
+			v := d.string()
+			r.Target = &v
+		case 7:
+			v := d.string()
+			r.TargetVersion = &v
+		case 8:
+			var v ConceptMapGroupElement
+			d.message(&v)
+			r.Element = append(r.Element, v)
+		case 9:
+			var v ConceptMapGroupUnmapped
+			d.message(&v)
+			r.Unmapped = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.SourceElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.SourceVersionElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.TargetElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.TargetVersionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConceptMapGroupElement as JSON into a byte slice
+func (r ConceptMapGroupElement) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConceptMapGroupElement as JSON object with the members in the order of the fields
+func (r ConceptMapGroupElement) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		e.string(*r.Code)
+	}
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	if len(r.Target) > 0 {
+		e.key("target")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Target {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConceptMapGroupElement from JSON. Unknown members are ignored.
+func (r *ConceptMapGroupElement) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConceptMapGroupElement
+func (r *ConceptMapGroupElement) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = &v
+			} else {
+				r.Code = nil
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		case "target":
+			a := d.array()
+			r.Target = nil
+			for a.next() {
+				var v ConceptMapGroupElementTarget
+				v.decodeJSON(d)
+				r.Target = append(r.Target, v)
+			}
+			if r.Target == nil && !a.isNull {
+				r.Target = []ConceptMapGroupElementTarget{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConceptMapGroupElement which shares no memory with the original
+func (r ConceptMapGroupElement) DeepCopy() ConceptMapGroupElement {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := *r.Code
+		out.Code = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	if r.Target != nil {
+		out.Target = make([]ConceptMapGroupElementTarget, len(r.Target))
+		for i := range r.Target {
+			out.Target[i] = r.Target[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConceptMapGroupElement) Equal(other ConceptMapGroupElement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && *r.Code != *other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	if len(r.Target) != len(other.Target) {
+		return false
+	}
+	for i := range r.Target {
+		if !r.Target[i].Equal(other.Target[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConceptMapGroupElement) EqualsDeep(other ConceptMapGroupElement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i].EqualsDeep(other.Target[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConceptMapGroupElement) EqualsShallow(other ConceptMapGroupElement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConceptMapGroupElement as FHIR XML
+func (r ConceptMapGroupElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	for _, v := range r.Target {
+		if err := encodeXMLElement(e, "target", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConceptMapGroupElement from FHIR XML
+func (r *ConceptMapGroupElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = &v
+				}
+				r.CodeElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			case "target":
+				var v ConceptMapGroupElementTarget
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = append(r.Target, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConceptMapGroupElement as properties to the RDF node n
+func (r ConceptMapGroupElement) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ConceptMap.group.element.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("ConceptMap.group.element.display", -1, r.Display, r.DisplayElement, "string")
+	for i, v := range r.Target {
+		n.element("ConceptMap.group.element.target", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ConceptMapGroupElement as BSON document with the structure of its FHIR JSON
+func (r ConceptMapGroupElement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConceptMapGroupElement from a BSON document with the structure of its FHIR JSON
+func (r *ConceptMapGroupElement) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConceptMapGroupElement as protobuf message ConceptMapGroupElement of fhir.proto
+func (r ConceptMapGroupElement) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConceptMapGroupElement to the encoded message
+func (r ConceptMapGroupElement) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != nil {
+		e.string(4, *r.Code)
+	}
+	if r.Display != nil {
+		e.string(5, *r.Display)
+	}
+	for _, v := range r.Target {
+		e.message(6, v)
+	}
+	if r.CodeElement != nil {
+		e.message(7, *r.CodeElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(8, *r.DisplayElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConceptMapGroupElement from protobuf message ConceptMapGroupElement of fhir.proto
+func (r *ConceptMapGroupElement) UnmarshalProto(b []byte) error {
+	*r = ConceptMapGroupElement{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Code = &v
+		case 5:
+			v := d.string()
+			r.Display = &v
+		case 6:
+			var v ConceptMapGroupElementTarget
+			d.message(&v)
+			r.Target = append(r.Target, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConceptMapGroupElementTarget as JSON into a byte slice
+func (r ConceptMapGroupElementTarget) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConceptMapGroupElementTarget as JSON object with the members in the order of the fields
+func (r ConceptMapGroupElementTarget) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		e.string(*r.Code)
+	}
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	e.key("equivalence")
+	e.string(r.Equivalence.Code())
+	if r.EquivalenceElement != nil {
+		e.key("_equivalence")
+		r.EquivalenceElement.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	if len(r.DependsOn) > 0 {
+		e.key("dependsOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DependsOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Product) > 0 {
+		e.key("product")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Product {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConceptMapGroupElementTarget from JSON. Unknown members are ignored.
+func (r *ConceptMapGroupElementTarget) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConceptMapGroupElementTarget
+func (r *ConceptMapGroupElementTarget) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = &v
+			} else {
+				r.Code = nil
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		case "equivalence":
+			d.unmarshal(&r.Equivalence)
+		case "_equivalence":
+			r.EquivalenceElement = d.element()
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		case "dependsOn":
+			a := d.array()
+			r.DependsOn = nil
+			for a.next() {
+				var v ConceptMapGroupElementTargetDependsOn
+				v.decodeJSON(d)
+				r.DependsOn = append(r.DependsOn, v)
+			}
+			if r.DependsOn == nil && !a.isNull {
+				r.DependsOn = []ConceptMapGroupElementTargetDependsOn{}
+			}
+		case "product":
+			a := d.array()
+			r.Product = nil
+			for a.next() {
+				var v ConceptMapGroupElementTargetDependsOn
+				v.decodeJSON(d)
+				r.Product = append(r.Product, v)
+			}
+			if r.Product == nil && !a.isNull {
+				r.Product = []ConceptMapGroupElementTargetDependsOn{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConceptMapGroupElementTarget which shares no memory with the original
+func (r ConceptMapGroupElementTarget) DeepCopy() ConceptMapGroupElementTarget {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := *r.Code
+		out.Code = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	if r.EquivalenceElement != nil {
+		v := r.EquivalenceElement.DeepCopy()
+		out.EquivalenceElement = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	if r.DependsOn != nil {
+		out.DependsOn = make([]ConceptMapGroupElementTargetDependsOn, len(r.DependsOn))
+		for i := range r.DependsOn {
+			out.DependsOn[i] = r.DependsOn[i].DeepCopy()
+		}
+	}
+	if r.Product != nil {
+		out.Product = make([]ConceptMapGroupElementTargetDependsOn, len(r.Product))
+		for i := range r.Product {
+			out.Product[i] = r.Product[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConceptMapGroupElementTarget) Equal(other ConceptMapGroupElementTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && *r.Code != *other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	if r.Equivalence != other.Equivalence {
+		return false
+	}
+	if (r.EquivalenceElement == nil) != (other.EquivalenceElement == nil) || r.EquivalenceElement != nil && !r.EquivalenceElement.Equal(*other.EquivalenceElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	if len(r.DependsOn) != len(other.DependsOn) {
+		return false
+	}
+	for i := range r.DependsOn {
+		if !r.DependsOn[i].Equal(other.DependsOn[i]) {
+			return false
+		}
+	}
+	if len(r.Product) != len(other.Product) {
+		return false
+	}
+	for i := range r.Product {
+		if !r.Product[i].Equal(other.Product[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConceptMapGroupElementTarget) EqualsDeep(other ConceptMapGroupElementTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	if r.Equivalence != other.Equivalence {
+		return false
+	}
+	if (r.EquivalenceElement == nil) != (other.EquivalenceElement == nil) || r.EquivalenceElement != nil && !r.EquivalenceElement.EqualsDeep(*other.EquivalenceElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	if !equivalentList(len(r.DependsOn), len(other.DependsOn), func(i, j int) bool {
+		return r.DependsOn[i].EqualsDeep(other.DependsOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Product), len(other.Product), func(i, j int) bool {
+		return r.Product[i].EqualsDeep(other.Product[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConceptMapGroupElementTarget) EqualsShallow(other ConceptMapGroupElementTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if r.Equivalence != other.Equivalence {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConceptMapGroupElementTarget as FHIR XML
+func (r ConceptMapGroupElementTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "equivalence", r.Equivalence, r.EquivalenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	for _, v := range r.DependsOn {
+		if err := encodeXMLElement(e, "dependsOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Product {
+		if err := encodeXMLElement(e, "product", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConceptMapGroupElementTarget from FHIR XML
+func (r *ConceptMapGroupElementTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = &v
+				}
+				r.CodeElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			case "equivalence":
+				var v ConceptMapEquivalence
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Equivalence = v
+				}
+				r.EquivalenceElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			case "dependsOn":
+				var v ConceptMapGroupElementTargetDependsOn
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DependsOn = append(r.DependsOn, v)
+			case "product":
+				var v ConceptMapGroupElementTargetDependsOn
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Product = append(r.Product, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConceptMapGroupElementTarget as properties to the RDF node n
+func (r ConceptMapGroupElementTarget) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ConceptMap.group.element.target.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("ConceptMap.group.element.target.display", -1, r.Display, r.DisplayElement, "string")
+	n.primitive("ConceptMap.group.element.target.equivalence", -1, r.Equivalence, r.EquivalenceElement, "code")
+	n.primitive("ConceptMap.group.element.target.comment", -1, r.Comment, r.CommentElement, "string")
+	for i, v := range r.DependsOn {
+		n.element("ConceptMap.group.element.target.dependsOn", i, v)
+	}
+	for i, v := range r.Product {
+		n.element("ConceptMap.group.element.target.product", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ConceptMapGroupElementTarget as BSON document with the structure of its FHIR JSON
+func (r ConceptMapGroupElementTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConceptMapGroupElementTarget from a BSON document with the structure of its FHIR JSON
+func (r *ConceptMapGroupElementTarget) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConceptMapGroupElementTarget as protobuf message ConceptMapGroupElementTarget of fhir.proto
+func (r ConceptMapGroupElementTarget) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConceptMapGroupElementTarget to the encoded message
+func (r ConceptMapGroupElementTarget) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != nil {
+		e.string(4, *r.Code)
+	}
+	if r.Display != nil {
+		e.string(5, *r.Display)
+	}
+	e.varint(6, r.Equivalence.protoNumber())
+	if r.Comment != nil {
+		e.string(7, *r.Comment)
+	}
+	for _, v := range r.DependsOn {
+		e.message(8, v)
+	}
+	for _, v := range r.Product {
+		e.message(9, v)
+	}
+	if r.CodeElement != nil {
+		e.message(10, *r.CodeElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(11, *r.DisplayElement)
+	}
+	if r.EquivalenceElement != nil {
+		e.message(12, *r.EquivalenceElement)
+	}
+	if r.CommentElement != nil {
+		e.message(13, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConceptMapGroupElementTarget from protobuf message ConceptMapGroupElementTarget of fhir.proto
+func (r *ConceptMapGroupElementTarget) UnmarshalProto(b []byte) error {
+	*r = ConceptMapGroupElementTarget{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Code = &v
+		case 5:
+			v := d.string()
+			r.Display = &v
+		case 6:
+			var v ConceptMapEquivalence
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Equivalence = v
+		case 7:
+			v := d.string()
+			r.Comment = &v
+		case 8:
+			var v ConceptMapGroupElementTargetDependsOn
+			d.message(&v)
+			r.DependsOn = append(r.DependsOn, v)
+		case 9:
+			var v ConceptMapGroupElementTargetDependsOn
+			d.message(&v)
+			r.Product = append(r.Product, v)
+		case 10:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.EquivalenceElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConceptMapGroupElementTargetDependsOn as JSON into a byte slice
+func (r ConceptMapGroupElementTargetDependsOn) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConceptMapGroupElementTargetDependsOn as JSON object with the members in the order of the fields
+func (r ConceptMapGroupElementTargetDependsOn) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("property")
+	e.string(r.Property)
+	if r.PropertyElement != nil {
+		e.key("_property")
+		r.PropertyElement.appendJSON(e)
+	}
+	if r.System != nil {
+		e.key("system")
+		e.string(*r.System)
+	}
+	if r.SystemElement != nil {
+		e.key("_system")
+		r.SystemElement.appendJSON(e)
+	}
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConceptMapGroupElementTargetDependsOn from JSON. Unknown members are ignored.
+func (r *ConceptMapGroupElementTargetDependsOn) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConceptMapGroupElementTargetDependsOn
+func (r *ConceptMapGroupElementTargetDependsOn) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "property":
+			if v, ok := d.string(); ok {
+				r.Property = v
+			}
+		case "_property":
+			r.PropertyElement = d.element()
+		case "system":
+			if v, ok := d.string(); ok {
+				r.System = &v
+			} else {
+				r.System = nil
+			}
+		case "_system":
+			r.SystemElement = d.element()
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConceptMapGroupElementTargetDependsOn which shares no memory with the original
+func (r ConceptMapGroupElementTargetDependsOn) DeepCopy() ConceptMapGroupElementTargetDependsOn {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.PropertyElement != nil {
+		v := r.PropertyElement.DeepCopy()
+		out.PropertyElement = &v
+	}
+	if r.System != nil {
+		v := *r.System
+		out.System = &v
+	}
+	if r.SystemElement != nil {
+		v := r.SystemElement.DeepCopy()
+		out.SystemElement = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConceptMapGroupElementTargetDependsOn) Equal(other ConceptMapGroupElementTargetDependsOn) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Property != other.Property {
+		return false
+	}
+	if (r.PropertyElement == nil) != (other.PropertyElement == nil) || r.PropertyElement != nil && !r.PropertyElement.Equal(*other.PropertyElement) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && *r.System != *other.System {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.Equal(*other.SystemElement) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConceptMapGroupElementTargetDependsOn) EqualsDeep(other ConceptMapGroupElementTargetDependsOn) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Property, other.Property) {
+		return false
+	}
+	if (r.PropertyElement == nil) != (other.PropertyElement == nil) || r.PropertyElement != nil && !r.PropertyElement.EqualsDeep(*other.PropertyElement) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.EqualsDeep(*other.SystemElement) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConceptMapGroupElementTargetDependsOn) EqualsShallow(other ConceptMapGroupElementTargetDependsOn) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Property, other.Property) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConceptMapGroupElementTargetDependsOn as FHIR XML
+func (r ConceptMapGroupElementTargetDependsOn) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "property", r.Property, r.PropertyElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "system", r.System, r.SystemElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConceptMapGroupElementTargetDependsOn from FHIR XML
+func (r *ConceptMapGroupElementTargetDependsOn) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "property":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Property = v
+				}
+				r.PropertyElement = element
+			case "system":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.System = &v
+				}
+				r.SystemElement = element
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConceptMapGroupElementTargetDependsOn as properties to the RDF node n
+func (r ConceptMapGroupElementTargetDependsOn) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ConceptMap.group.element.target.dependsOn.property", -1, r.Property, r.PropertyElement, "string")
+	n.primitive("ConceptMap.group.element.target.dependsOn.system", -1, r.System, r.SystemElement, "string")
+	n.primitive("ConceptMap.group.element.target.dependsOn.value", -1, r.Value, r.ValueElement, "string")
+	n.primitive("ConceptMap.group.element.target.dependsOn.display", -1, r.Display, r.DisplayElement, "string")
+}
+
+// MarshalBSON marshals the given ConceptMapGroupElementTargetDependsOn as BSON document with the structure of its FHIR JSON
+func (r ConceptMapGroupElementTargetDependsOn) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConceptMapGroupElementTargetDependsOn from a BSON document with the structure of its FHIR JSON
+func (r *ConceptMapGroupElementTargetDependsOn) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConceptMapGroupElementTargetDependsOn as protobuf message ConceptMapGroupElementTargetDependsOn of fhir.proto
+func (r ConceptMapGroupElementTargetDependsOn) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConceptMapGroupElementTargetDependsOn to the encoded message
+func (r ConceptMapGroupElementTargetDependsOn) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Property != "" {
+		e.string(4, r.Property)
+	}
+	if r.System != nil {
+		e.string(5, *r.System)
+	}
+	if r.Value != "" {
+		e.string(6, r.Value)
+	}
+	if r.Display != nil {
+		e.string(7, *r.Display)
+	}
+	if r.PropertyElement != nil {
+		e.message(8, *r.PropertyElement)
+	}
+	if r.SystemElement != nil {
+		e.message(9, *r.SystemElement)
+	}
+	if r.ValueElement != nil {
+		e.message(10, *r.ValueElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(11, *r.DisplayElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConceptMapGroupElementTargetDependsOn from protobuf message ConceptMapGroupElementTargetDependsOn of fhir.proto
+func (r *ConceptMapGroupElementTargetDependsOn) UnmarshalProto(b []byte) error {
+	*r = ConceptMapGroupElementTargetDependsOn{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Property = d.string()
+		case 5:
+			v := d.string()
+			r.System = &v
+		case 6:
+			r.Value = d.string()
+		case 7:
+			v := d.string()
+			r.Display = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.PropertyElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.SystemElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConceptMapGroupUnmapped as JSON into a byte slice
+func (r ConceptMapGroupUnmapped) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConceptMapGroupUnmapped as JSON object with the members in the order of the fields
+func (r ConceptMapGroupUnmapped) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("mode")
+	e.string(r.Mode.Code())
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		e.string(*r.Code)
+	}
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConceptMapGroupUnmapped from JSON. Unknown members are ignored.
+func (r *ConceptMapGroupUnmapped) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConceptMapGroupUnmapped
+func (r *ConceptMapGroupUnmapped) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "mode":
+			d.unmarshal(&r.Mode)
+		case "_mode":
+			r.ModeElement = d.element()
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = &v
+			} else {
+				r.Code = nil
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConceptMapGroupUnmapped which shares no memory with the original
+func (r ConceptMapGroupUnmapped) DeepCopy() ConceptMapGroupUnmapped {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.Code != nil {
+		v := *r.Code
+		out.Code = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConceptMapGroupUnmapped) Equal(other ConceptMapGroupUnmapped) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && *r.Code != *other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConceptMapGroupUnmapped) EqualsDeep(other ConceptMapGroupUnmapped) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConceptMapGroupUnmapped) EqualsShallow(other ConceptMapGroupUnmapped) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConceptMapGroupUnmapped as FHIR XML
+func (r ConceptMapGroupUnmapped) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConceptMapGroupUnmapped from FHIR XML
+func (r *ConceptMapGroupUnmapped) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "mode":
+				var v ConceptMapGroupUnmappedMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = v
+				}
+				r.ModeElement = element
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = &v
+				}
+				r.CodeElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConceptMapGroupUnmapped as properties to the RDF node n
+func (r ConceptMapGroupUnmapped) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ConceptMap.group.unmapped.mode", -1, r.Mode, r.ModeElement, "code")
+	n.primitive("ConceptMap.group.unmapped.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("ConceptMap.group.unmapped.display", -1, r.Display, r.DisplayElement, "string")
+	n.primitive("ConceptMap.group.unmapped.url", -1, r.Url, r.UrlElement, "string")
+}
+
+// MarshalBSON marshals the given ConceptMapGroupUnmapped as BSON document with the structure of its FHIR JSON
+func (r ConceptMapGroupUnmapped) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConceptMapGroupUnmapped from a BSON document with the structure of its FHIR JSON
+func (r *ConceptMapGroupUnmapped) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConceptMapGroupUnmapped as protobuf message ConceptMapGroupUnmapped of fhir.proto
+func (r ConceptMapGroupUnmapped) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConceptMapGroupUnmapped to the encoded message
+func (r ConceptMapGroupUnmapped) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Mode.protoNumber())
+	if r.Code != nil {
+		e.string(5, *r.Code)
+	}
+	if r.Display != nil {
+		e.string(6, *r.Display)
+	}
+	if r.Url != nil {
+		e.string(7, *r.Url)
+	}
+	if r.ModeElement != nil {
+		e.message(8, *r.ModeElement)
+	}
+	if r.CodeElement != nil {
+		e.message(9, *r.CodeElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(10, *r.DisplayElement)
+	}
+	if r.UrlElement != nil {
+		e.message(11, *r.UrlElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConceptMapGroupUnmapped from protobuf message ConceptMapGroupUnmapped of fhir.proto
+func (r *ConceptMapGroupUnmapped) UnmarshalProto(b []byte) error {
+	*r = ConceptMapGroupUnmapped{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ConceptMapGroupUnmappedMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = v
+		case 5:
+			v := d.string()
+			r.Code = &v
+		case 6:
+			v := d.string()
+			r.Display = &v
+		case 7:
+			v := d.string()
+			r.Url = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalConceptMap unmarshals a ConceptMap.
 func UnmarshalConceptMap(b []byte) (ConceptMap, error) {
 	var conceptMap ConceptMap
-	if err := json.Unmarshal(b, &conceptMap); err != nil {
+	if err := conceptMap.UnmarshalJSON(b); err != nil {
 		return conceptMap, err
 	}
 	return conceptMap, nil
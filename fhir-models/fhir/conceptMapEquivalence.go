@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -42,8 +41,13 @@ const (
 func (code ConceptMapEquivalence) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ConceptMapEquivalence) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ConceptMapEquivalence) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "relatedto":
 		*code = ConceptMapEquivalenceRelatedto
@@ -70,6 +74,12 @@ func (code *ConceptMapEquivalence) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ConceptMapEquivalence) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ConceptMapEquivalence) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ConceptMapEquivalence) String() string {
 	return code.Code()
 }
@@ -148,3 +158,55 @@ func (code ConceptMapEquivalence) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ConceptMapEquivalence) protoNumber() uint64 {
+	switch code {
+	case ConceptMapEquivalenceRelatedto:
+		return 1
+	case ConceptMapEquivalenceEquivalent:
+		return 2
+	case ConceptMapEquivalenceEqual:
+		return 3
+	case ConceptMapEquivalenceWider:
+		return 4
+	case ConceptMapEquivalenceSubsumes:
+		return 5
+	case ConceptMapEquivalenceNarrower:
+		return 6
+	case ConceptMapEquivalenceSpecializes:
+		return 7
+	case ConceptMapEquivalenceInexact:
+		return 8
+	case ConceptMapEquivalenceUnmatched:
+		return 9
+	case ConceptMapEquivalenceDisjoint:
+		return 10
+	}
+	return 0
+}
+func (code *ConceptMapEquivalence) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ConceptMapEquivalenceRelatedto
+	case 2:
+		*code = ConceptMapEquivalenceEquivalent
+	case 3:
+		*code = ConceptMapEquivalenceEqual
+	case 4:
+		*code = ConceptMapEquivalenceWider
+	case 5:
+		*code = ConceptMapEquivalenceSubsumes
+	case 6:
+		*code = ConceptMapEquivalenceNarrower
+	case 7:
+		*code = ConceptMapEquivalenceSpecializes
+	case 8:
+		*code = ConceptMapEquivalenceInexact
+	case 9:
+		*code = ConceptMapEquivalenceUnmatched
+	case 10:
+		*code = ConceptMapEquivalenceDisjoint
+	default:
+		return false
+	}
+	return true
+}
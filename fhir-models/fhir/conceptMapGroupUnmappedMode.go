@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ConceptMapGroupUnmappedMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ConceptMapGroupUnmappedMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ConceptMapGroupUnmappedMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "provided":
 		*code = ConceptMapGroupUnmappedModeProvided
@@ -49,6 +53,12 @@ func (code *ConceptMapGroupUnmappedMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ConceptMapGroupUnmappedMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ConceptMapGroupUnmappedMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ConceptMapGroupUnmappedMode) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ConceptMapGroupUnmappedMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ConceptMapGroupUnmappedMode) protoNumber() uint64 {
+	switch code {
+	case ConceptMapGroupUnmappedModeProvided:
+		return 1
+	case ConceptMapGroupUnmappedModeFixed:
+		return 2
+	case ConceptMapGroupUnmappedModeOtherMap:
+		return 3
+	}
+	return 0
+}
+func (code *ConceptMapGroupUnmappedMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ConceptMapGroupUnmappedModeProvided
+	case 2:
+		*code = ConceptMapGroupUnmappedModeFixed
+	case 3:
+		*code = ConceptMapGroupUnmappedModeOtherMap
+	default:
+		return false
+	}
+	return true
+}
@@ -14,46 +14,187 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Condition is documented here http://hl7.org/fhir/StructureDefinition/Condition
 type Condition struct {
-	Id                 *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	ClinicalStatus     *CodeableConcept    `bson:"clinicalStatus,omitempty" json:"clinicalStatus,omitempty"`
-	VerificationStatus *CodeableConcept    `bson:"verificationStatus,omitempty" json:"verificationStatus,omitempty"`
-	Category           []CodeableConcept   `bson:"category,omitempty" json:"category,omitempty"`
-	Severity           *CodeableConcept    `bson:"severity,omitempty" json:"severity,omitempty"`
-	Code               *CodeableConcept    `bson:"code,omitempty" json:"code,omitempty"`
-	BodySite           []CodeableConcept   `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Subject            Reference           `bson:"subject" json:"subject"`
-	Encounter          *Reference          `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	OnsetDateTime      *string             `bson:"onsetDateTime,omitempty" json:"onsetDateTime,omitempty"`
-	OnsetAge           *Age                `bson:"onsetAge,omitempty" json:"onsetAge,omitempty"`
-	OnsetPeriod        *Period             `bson:"onsetPeriod,omitempty" json:"onsetPeriod,omitempty"`
-	OnsetRange         *Range              `bson:"onsetRange,omitempty" json:"onsetRange,omitempty"`
-	OnsetString        *string             `bson:"onsetString,omitempty" json:"onsetString,omitempty"`
-	AbatementDateTime  *string             `bson:"abatementDateTime,omitempty" json:"abatementDateTime,omitempty"`
-	AbatementAge       *Age                `bson:"abatementAge,omitempty" json:"abatementAge,omitempty"`
-	AbatementPeriod    *Period             `bson:"abatementPeriod,omitempty" json:"abatementPeriod,omitempty"`
-	AbatementRange     *Range              `bson:"abatementRange,omitempty" json:"abatementRange,omitempty"`
-	AbatementString    *string             `bson:"abatementString,omitempty" json:"abatementString,omitempty"`
-	RecordedDate       *string             `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
-	Recorder           *Reference          `bson:"recorder,omitempty" json:"recorder,omitempty"`
-	Asserter           *Reference          `bson:"asserter,omitempty" json:"asserter,omitempty"`
-	Stage              []ConditionStage    `bson:"stage,omitempty" json:"stage,omitempty"`
-	Evidence           []ConditionEvidence `bson:"evidence,omitempty" json:"evidence,omitempty"`
-	Note               []Annotation        `bson:"note,omitempty" json:"note,omitempty"`
+	Id                       *string             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                *Element            `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                     *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                []json.RawMessage   `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ClinicalStatus           *CodeableConcept    `bson:"clinicalStatus,omitempty" json:"clinicalStatus,omitempty"`
+	VerificationStatus       *CodeableConcept    `bson:"verificationStatus,omitempty" json:"verificationStatus,omitempty"`
+	Category                 []CodeableConcept   `bson:"category,omitempty" json:"category,omitempty"`
+	Severity                 *CodeableConcept    `bson:"severity,omitempty" json:"severity,omitempty"`
+	Code                     *CodeableConcept    `bson:"code,omitempty" json:"code,omitempty"`
+	BodySite                 []CodeableConcept   `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Subject                  Reference           `bson:"subject" json:"subject"`
+	Encounter                *Reference          `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OnsetDateTime            *string             `bson:"onsetDateTime,omitempty" json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement     *Element            `bson:"_onsetDateTime,omitempty" json:"_onsetDateTime,omitempty"`
+	OnsetAge                 *Age                `bson:"onsetAge,omitempty" json:"onsetAge,omitempty"`
+	OnsetPeriod              *Period             `bson:"onsetPeriod,omitempty" json:"onsetPeriod,omitempty"`
+	OnsetRange               *Range              `bson:"onsetRange,omitempty" json:"onsetRange,omitempty"`
+	OnsetString              *string             `bson:"onsetString,omitempty" json:"onsetString,omitempty"`
+	OnsetStringElement       *Element            `bson:"_onsetString,omitempty" json:"_onsetString,omitempty"`
+	AbatementDateTime        *string             `bson:"abatementDateTime,omitempty" json:"abatementDateTime,omitempty"`
+	AbatementDateTimeElement *Element            `bson:"_abatementDateTime,omitempty" json:"_abatementDateTime,omitempty"`
+	AbatementAge             *Age                `bson:"abatementAge,omitempty" json:"abatementAge,omitempty"`
+	AbatementPeriod          *Period             `bson:"abatementPeriod,omitempty" json:"abatementPeriod,omitempty"`
+	AbatementRange           *Range              `bson:"abatementRange,omitempty" json:"abatementRange,omitempty"`
+	AbatementString          *string             `bson:"abatementString,omitempty" json:"abatementString,omitempty"`
+	AbatementStringElement   *Element            `bson:"_abatementString,omitempty" json:"_abatementString,omitempty"`
+	RecordedDate             *string             `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
+	RecordedDateElement      *Element            `bson:"_recordedDate,omitempty" json:"_recordedDate,omitempty"`
+	Recorder                 *Reference          `bson:"recorder,omitempty" json:"recorder,omitempty"`
+	Asserter                 *Reference          `bson:"asserter,omitempty" json:"asserter,omitempty"`
+	Stage                    []ConditionStage    `bson:"stage,omitempty" json:"stage,omitempty"`
+	Evidence                 []ConditionEvidence `bson:"evidence,omitempty" json:"evidence,omitempty"`
+	Note                     []Annotation        `bson:"note,omitempty" json:"note,omitempty"`
 }
+
+// ConditionOnset is implemented by the types allowed for Condition.onset[x]
+type ConditionOnset interface {
+	isConditionOnset()
+}
+
+func (DateTime) isConditionOnset() {}
+func (Age) isConditionOnset()      {}
+func (Period) isConditionOnset()   {}
+func (Range) isConditionOnset()    {}
+func (String) isConditionOnset()   {}
+
+// Onset returns the value of Condition.onset[x] together with its FHIR type code or nil if no value is set
+func (r Condition) Onset() (ConditionOnset, string) {
+	if r.OnsetDateTime != nil {
+		return DateTime(*r.OnsetDateTime), "dateTime"
+	}
+	if r.OnsetAge != nil {
+		return *r.OnsetAge, "Age"
+	}
+	if r.OnsetPeriod != nil {
+		return *r.OnsetPeriod, "Period"
+	}
+	if r.OnsetRange != nil {
+		return *r.OnsetRange, "Range"
+	}
+	if r.OnsetString != nil {
+		return String(*r.OnsetString), "string"
+	}
+	return nil, ""
+}
+
+// SetOnset sets the value of Condition.onset[x], given as value or pointer, and clears all other types
+func (r *Condition) SetOnset(value ConditionOnset) {
+	r.OnsetDateTime = nil
+	r.OnsetAge = nil
+	r.OnsetPeriod = nil
+	r.OnsetRange = nil
+	r.OnsetString = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OnsetDateTime = &primitive
+	case *DateTime:
+		r.OnsetDateTime = (*string)(v)
+	case Age:
+		r.OnsetAge = &v
+	case *Age:
+		r.OnsetAge = v
+	case Period:
+		r.OnsetPeriod = &v
+	case *Period:
+		r.OnsetPeriod = v
+	case Range:
+		r.OnsetRange = &v
+	case *Range:
+		r.OnsetRange = v
+	case String:
+		primitive := string(v)
+		r.OnsetString = &primitive
+	case *String:
+		r.OnsetString = (*string)(v)
+	}
+}
+
+// ConditionAbatement is implemented by the types allowed for Condition.abatement[x]
+type ConditionAbatement interface {
+	isConditionAbatement()
+}
+
+func (DateTime) isConditionAbatement() {}
+func (Age) isConditionAbatement()      {}
+func (Period) isConditionAbatement()   {}
+func (Range) isConditionAbatement()    {}
+func (String) isConditionAbatement()   {}
+
+// Abatement returns the value of Condition.abatement[x] together with its FHIR type code or nil if no value is set
+func (r Condition) Abatement() (ConditionAbatement, string) {
+	if r.AbatementDateTime != nil {
+		return DateTime(*r.AbatementDateTime), "dateTime"
+	}
+	if r.AbatementAge != nil {
+		return *r.AbatementAge, "Age"
+	}
+	if r.AbatementPeriod != nil {
+		return *r.AbatementPeriod, "Period"
+	}
+	if r.AbatementRange != nil {
+		return *r.AbatementRange, "Range"
+	}
+	if r.AbatementString != nil {
+		return String(*r.AbatementString), "string"
+	}
+	return nil, ""
+}
+
+// SetAbatement sets the value of Condition.abatement[x], given as value or pointer, and clears all other types
+func (r *Condition) SetAbatement(value ConditionAbatement) {
+	r.AbatementDateTime = nil
+	r.AbatementAge = nil
+	r.AbatementPeriod = nil
+	r.AbatementRange = nil
+	r.AbatementString = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.AbatementDateTime = &primitive
+	case *DateTime:
+		r.AbatementDateTime = (*string)(v)
+	case Age:
+		r.AbatementAge = &v
+	case *Age:
+		r.AbatementAge = v
+	case Period:
+		r.AbatementPeriod = &v
+	case *Period:
+		r.AbatementPeriod = v
+	case Range:
+		r.AbatementRange = &v
+	case *Range:
+		r.AbatementRange = v
+	case String:
+		primitive := string(v)
+		r.AbatementString = &primitive
+	case *String:
+		r.AbatementString = (*string)(v)
+	}
+}
+
 type ConditionStage struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -69,23 +210,2642 @@ type ConditionEvidence struct {
 	Code              []CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
 	Detail            []Reference       `bson:"detail,omitempty" json:"detail,omitempty"`
 }
-type OtherCondition Condition
 
 // MarshalJSON marshals the given Condition as JSON into a byte slice
 func (r Condition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCondition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCondition: OtherCondition(r),
-		ResourceType:   "Condition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Condition as JSON object with the members in the order of the fields
+func (r Condition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Condition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ClinicalStatus != nil {
+		e.key("clinicalStatus")
+		r.ClinicalStatus.appendJSON(e)
+	}
+	if r.VerificationStatus != nil {
+		e.key("verificationStatus")
+		r.VerificationStatus.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Severity != nil {
+		e.key("severity")
+		r.Severity.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if len(r.BodySite) > 0 {
+		e.key("bodySite")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BodySite {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.OnsetDateTime != nil {
+		e.key("onsetDateTime")
+		e.string(*r.OnsetDateTime)
+	}
+	if r.OnsetDateTimeElement != nil {
+		e.key("_onsetDateTime")
+		r.OnsetDateTimeElement.appendJSON(e)
+	}
+	if r.OnsetAge != nil {
+		e.key("onsetAge")
+		r.OnsetAge.appendJSON(e)
+	}
+	if r.OnsetPeriod != nil {
+		e.key("onsetPeriod")
+		r.OnsetPeriod.appendJSON(e)
+	}
+	if r.OnsetRange != nil {
+		e.key("onsetRange")
+		r.OnsetRange.appendJSON(e)
+	}
+	if r.OnsetString != nil {
+		e.key("onsetString")
+		e.string(*r.OnsetString)
+	}
+	if r.OnsetStringElement != nil {
+		e.key("_onsetString")
+		r.OnsetStringElement.appendJSON(e)
+	}
+	if r.AbatementDateTime != nil {
+		e.key("abatementDateTime")
+		e.string(*r.AbatementDateTime)
+	}
+	if r.AbatementDateTimeElement != nil {
+		e.key("_abatementDateTime")
+		r.AbatementDateTimeElement.appendJSON(e)
+	}
+	if r.AbatementAge != nil {
+		e.key("abatementAge")
+		r.AbatementAge.appendJSON(e)
+	}
+	if r.AbatementPeriod != nil {
+		e.key("abatementPeriod")
+		r.AbatementPeriod.appendJSON(e)
+	}
+	if r.AbatementRange != nil {
+		e.key("abatementRange")
+		r.AbatementRange.appendJSON(e)
+	}
+	if r.AbatementString != nil {
+		e.key("abatementString")
+		e.string(*r.AbatementString)
+	}
+	if r.AbatementStringElement != nil {
+		e.key("_abatementString")
+		r.AbatementStringElement.appendJSON(e)
+	}
+	if r.RecordedDate != nil {
+		e.key("recordedDate")
+		e.string(*r.RecordedDate)
+	}
+	if r.RecordedDateElement != nil {
+		e.key("_recordedDate")
+		r.RecordedDateElement.appendJSON(e)
+	}
+	if r.Recorder != nil {
+		e.key("recorder")
+		r.Recorder.appendJSON(e)
+	}
+	if r.Asserter != nil {
+		e.key("asserter")
+		r.Asserter.appendJSON(e)
+	}
+	if len(r.Stage) > 0 {
+		e.key("stage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Stage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Evidence) > 0 {
+		e.key("evidence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Evidence {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Condition from JSON. Unknown members are ignored.
+func (r *Condition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Condition
+func (r *Condition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "clinicalStatus":
+			if d.null() {
+				r.ClinicalStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ClinicalStatus = &v
+			}
+		case "verificationStatus":
+			if d.null() {
+				r.VerificationStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.VerificationStatus = &v
+			}
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "severity":
+			if d.null() {
+				r.Severity = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Severity = &v
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "bodySite":
+			a := d.array()
+			r.BodySite = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = append(r.BodySite, v)
+			}
+			if r.BodySite == nil && !a.isNull {
+				r.BodySite = []CodeableConcept{}
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "onsetDateTime":
+			if v, ok := d.string(); ok {
+				r.OnsetDateTime = &v
+			} else {
+				r.OnsetDateTime = nil
+			}
+		case "_onsetDateTime":
+			r.OnsetDateTimeElement = d.element()
+		case "onsetAge":
+			if d.null() {
+				r.OnsetAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.OnsetAge = &v
+			}
+		case "onsetPeriod":
+			if d.null() {
+				r.OnsetPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OnsetPeriod = &v
+			}
+		case "onsetRange":
+			if d.null() {
+				r.OnsetRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.OnsetRange = &v
+			}
+		case "onsetString":
+			if v, ok := d.string(); ok {
+				r.OnsetString = &v
+			} else {
+				r.OnsetString = nil
+			}
+		case "_onsetString":
+			r.OnsetStringElement = d.element()
+		case "abatementDateTime":
+			if v, ok := d.string(); ok {
+				r.AbatementDateTime = &v
+			} else {
+				r.AbatementDateTime = nil
+			}
+		case "_abatementDateTime":
+			r.AbatementDateTimeElement = d.element()
+		case "abatementAge":
+			if d.null() {
+				r.AbatementAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.AbatementAge = &v
+			}
+		case "abatementPeriod":
+			if d.null() {
+				r.AbatementPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.AbatementPeriod = &v
+			}
+		case "abatementRange":
+			if d.null() {
+				r.AbatementRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.AbatementRange = &v
+			}
+		case "abatementString":
+			if v, ok := d.string(); ok {
+				r.AbatementString = &v
+			} else {
+				r.AbatementString = nil
+			}
+		case "_abatementString":
+			r.AbatementStringElement = d.element()
+		case "recordedDate":
+			if v, ok := d.string(); ok {
+				r.RecordedDate = &v
+			} else {
+				r.RecordedDate = nil
+			}
+		case "_recordedDate":
+			r.RecordedDateElement = d.element()
+		case "recorder":
+			if d.null() {
+				r.Recorder = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recorder = &v
+			}
+		case "asserter":
+			if d.null() {
+				r.Asserter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Asserter = &v
+			}
+		case "stage":
+			a := d.array()
+			r.Stage = nil
+			for a.next() {
+				var v ConditionStage
+				v.decodeJSON(d)
+				r.Stage = append(r.Stage, v)
+			}
+			if r.Stage == nil && !a.isNull {
+				r.Stage = []ConditionStage{}
+			}
+		case "evidence":
+			a := d.array()
+			r.Evidence = nil
+			for a.next() {
+				var v ConditionEvidence
+				v.decodeJSON(d)
+				r.Evidence = append(r.Evidence, v)
+			}
+			if r.Evidence == nil && !a.isNull {
+				r.Evidence = []ConditionEvidence{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Condition which shares no memory with the original
+func (r Condition) DeepCopy() Condition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.ClinicalStatus != nil {
+		v := r.ClinicalStatus.DeepCopy()
+		out.ClinicalStatus = &v
+	}
+	if r.VerificationStatus != nil {
+		v := r.VerificationStatus.DeepCopy()
+		out.VerificationStatus = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Severity != nil {
+		v := r.Severity.DeepCopy()
+		out.Severity = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.BodySite != nil {
+		out.BodySite = make([]CodeableConcept, len(r.BodySite))
+		for i := range r.BodySite {
+			out.BodySite[i] = r.BodySite[i].DeepCopy()
+		}
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.OnsetDateTime != nil {
+		v := *r.OnsetDateTime
+		out.OnsetDateTime = &v
+	}
+	if r.OnsetDateTimeElement != nil {
+		v := r.OnsetDateTimeElement.DeepCopy()
+		out.OnsetDateTimeElement = &v
+	}
+	if r.OnsetAge != nil {
+		v := r.OnsetAge.DeepCopy()
+		out.OnsetAge = &v
+	}
+	if r.OnsetPeriod != nil {
+		v := r.OnsetPeriod.DeepCopy()
+		out.OnsetPeriod = &v
+	}
+	if r.OnsetRange != nil {
+		v := r.OnsetRange.DeepCopy()
+		out.OnsetRange = &v
+	}
+	if r.OnsetString != nil {
+		v := *r.OnsetString
+		out.OnsetString = &v
+	}
+	if r.OnsetStringElement != nil {
+		v := r.OnsetStringElement.DeepCopy()
+		out.OnsetStringElement = &v
+	}
+	if r.AbatementDateTime != nil {
+		v := *r.AbatementDateTime
+		out.AbatementDateTime = &v
+	}
+	if r.AbatementDateTimeElement != nil {
+		v := r.AbatementDateTimeElement.DeepCopy()
+		out.AbatementDateTimeElement = &v
+	}
+	if r.AbatementAge != nil {
+		v := r.AbatementAge.DeepCopy()
+		out.AbatementAge = &v
+	}
+	if r.AbatementPeriod != nil {
+		v := r.AbatementPeriod.DeepCopy()
+		out.AbatementPeriod = &v
+	}
+	if r.AbatementRange != nil {
+		v := r.AbatementRange.DeepCopy()
+		out.AbatementRange = &v
+	}
+	if r.AbatementString != nil {
+		v := *r.AbatementString
+		out.AbatementString = &v
+	}
+	if r.AbatementStringElement != nil {
+		v := r.AbatementStringElement.DeepCopy()
+		out.AbatementStringElement = &v
+	}
+	if r.RecordedDate != nil {
+		v := *r.RecordedDate
+		out.RecordedDate = &v
+	}
+	if r.RecordedDateElement != nil {
+		v := r.RecordedDateElement.DeepCopy()
+		out.RecordedDateElement = &v
+	}
+	if r.Recorder != nil {
+		v := r.Recorder.DeepCopy()
+		out.Recorder = &v
+	}
+	if r.Asserter != nil {
+		v := r.Asserter.DeepCopy()
+		out.Asserter = &v
+	}
+	if r.Stage != nil {
+		out.Stage = make([]ConditionStage, len(r.Stage))
+		for i := range r.Stage {
+			out.Stage[i] = r.Stage[i].DeepCopy()
+		}
+	}
+	if r.Evidence != nil {
+		out.Evidence = make([]ConditionEvidence, len(r.Evidence))
+		for i := range r.Evidence {
+			out.Evidence[i] = r.Evidence[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Condition) Equal(other Condition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.ClinicalStatus == nil) != (other.ClinicalStatus == nil) || r.ClinicalStatus != nil && !r.ClinicalStatus.Equal(*other.ClinicalStatus) {
+		return false
+	}
+	if (r.VerificationStatus == nil) != (other.VerificationStatus == nil) || r.VerificationStatus != nil && !r.VerificationStatus.Equal(*other.VerificationStatus) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && !r.Severity.Equal(*other.Severity) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if len(r.BodySite) != len(other.BodySite) {
+		return false
+	}
+	for i := range r.BodySite {
+		if !r.BodySite[i].Equal(other.BodySite[i]) {
+			return false
+		}
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.OnsetDateTime == nil) != (other.OnsetDateTime == nil) || r.OnsetDateTime != nil && *r.OnsetDateTime != *other.OnsetDateTime {
+		return false
+	}
+	if (r.OnsetDateTimeElement == nil) != (other.OnsetDateTimeElement == nil) || r.OnsetDateTimeElement != nil && !r.OnsetDateTimeElement.Equal(*other.OnsetDateTimeElement) {
+		return false
+	}
+	if (r.OnsetAge == nil) != (other.OnsetAge == nil) || r.OnsetAge != nil && !r.OnsetAge.Equal(*other.OnsetAge) {
+		return false
+	}
+	if (r.OnsetPeriod == nil) != (other.OnsetPeriod == nil) || r.OnsetPeriod != nil && !r.OnsetPeriod.Equal(*other.OnsetPeriod) {
+		return false
+	}
+	if (r.OnsetRange == nil) != (other.OnsetRange == nil) || r.OnsetRange != nil && !r.OnsetRange.Equal(*other.OnsetRange) {
+		return false
+	}
+	if (r.OnsetString == nil) != (other.OnsetString == nil) || r.OnsetString != nil && *r.OnsetString != *other.OnsetString {
+		return false
+	}
+	if (r.OnsetStringElement == nil) != (other.OnsetStringElement == nil) || r.OnsetStringElement != nil && !r.OnsetStringElement.Equal(*other.OnsetStringElement) {
+		return false
+	}
+	if (r.AbatementDateTime == nil) != (other.AbatementDateTime == nil) || r.AbatementDateTime != nil && *r.AbatementDateTime != *other.AbatementDateTime {
+		return false
+	}
+	if (r.AbatementDateTimeElement == nil) != (other.AbatementDateTimeElement == nil) || r.AbatementDateTimeElement != nil && !r.AbatementDateTimeElement.Equal(*other.AbatementDateTimeElement) {
+		return false
+	}
+	if (r.AbatementAge == nil) != (other.AbatementAge == nil) || r.AbatementAge != nil && !r.AbatementAge.Equal(*other.AbatementAge) {
+		return false
+	}
+	if (r.AbatementPeriod == nil) != (other.AbatementPeriod == nil) || r.AbatementPeriod != nil && !r.AbatementPeriod.Equal(*other.AbatementPeriod) {
+		return false
+	}
+	if (r.AbatementRange == nil) != (other.AbatementRange == nil) || r.AbatementRange != nil && !r.AbatementRange.Equal(*other.AbatementRange) {
+		return false
+	}
+	if (r.AbatementString == nil) != (other.AbatementString == nil) || r.AbatementString != nil && *r.AbatementString != *other.AbatementString {
+		return false
+	}
+	if (r.AbatementStringElement == nil) != (other.AbatementStringElement == nil) || r.AbatementStringElement != nil && !r.AbatementStringElement.Equal(*other.AbatementStringElement) {
+		return false
+	}
+	if (r.RecordedDate == nil) != (other.RecordedDate == nil) || r.RecordedDate != nil && *r.RecordedDate != *other.RecordedDate {
+		return false
+	}
+	if (r.RecordedDateElement == nil) != (other.RecordedDateElement == nil) || r.RecordedDateElement != nil && !r.RecordedDateElement.Equal(*other.RecordedDateElement) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.Equal(*other.Recorder) {
+		return false
+	}
+	if (r.Asserter == nil) != (other.Asserter == nil) || r.Asserter != nil && !r.Asserter.Equal(*other.Asserter) {
+		return false
+	}
+	if len(r.Stage) != len(other.Stage) {
+		return false
+	}
+	for i := range r.Stage {
+		if !r.Stage[i].Equal(other.Stage[i]) {
+			return false
+		}
+	}
+	if len(r.Evidence) != len(other.Evidence) {
+		return false
+	}
+	for i := range r.Evidence {
+		if !r.Evidence[i].Equal(other.Evidence[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Condition) EqualsDeep(other Condition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.ClinicalStatus == nil) != (other.ClinicalStatus == nil) || r.ClinicalStatus != nil && !r.ClinicalStatus.EqualsDeep(*other.ClinicalStatus) {
+		return false
+	}
+	if (r.VerificationStatus == nil) != (other.VerificationStatus == nil) || r.VerificationStatus != nil && !r.VerificationStatus.EqualsDeep(*other.VerificationStatus) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && !r.Severity.EqualsDeep(*other.Severity) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.BodySite), len(other.BodySite), func(i, j int) bool {
+		return r.BodySite[i].EqualsDeep(other.BodySite[j])
+	}) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.OnsetDateTime == nil) != (other.OnsetDateTime == nil) || r.OnsetDateTime != nil && !equivalentString(*r.OnsetDateTime, *other.OnsetDateTime) {
+		return false
+	}
+	if (r.OnsetDateTimeElement == nil) != (other.OnsetDateTimeElement == nil) || r.OnsetDateTimeElement != nil && !r.OnsetDateTimeElement.EqualsDeep(*other.OnsetDateTimeElement) {
+		return false
+	}
+	if (r.OnsetAge == nil) != (other.OnsetAge == nil) || r.OnsetAge != nil && !r.OnsetAge.EqualsDeep(*other.OnsetAge) {
+		return false
+	}
+	if (r.OnsetPeriod == nil) != (other.OnsetPeriod == nil) || r.OnsetPeriod != nil && !r.OnsetPeriod.EqualsDeep(*other.OnsetPeriod) {
+		return false
+	}
+	if (r.OnsetRange == nil) != (other.OnsetRange == nil) || r.OnsetRange != nil && !r.OnsetRange.EqualsDeep(*other.OnsetRange) {
+		return false
+	}
+	if (r.OnsetString == nil) != (other.OnsetString == nil) || r.OnsetString != nil && !equivalentString(*r.OnsetString, *other.OnsetString) {
+		return false
+	}
+	if (r.OnsetStringElement == nil) != (other.OnsetStringElement == nil) || r.OnsetStringElement != nil && !r.OnsetStringElement.EqualsDeep(*other.OnsetStringElement) {
+		return false
+	}
+	if (r.AbatementDateTime == nil) != (other.AbatementDateTime == nil) || r.AbatementDateTime != nil && !equivalentString(*r.AbatementDateTime, *other.AbatementDateTime) {
+		return false
+	}
+	if (r.AbatementDateTimeElement == nil) != (other.AbatementDateTimeElement == nil) || r.AbatementDateTimeElement != nil && !r.AbatementDateTimeElement.EqualsDeep(*other.AbatementDateTimeElement) {
+		return false
+	}
+	if (r.AbatementAge == nil) != (other.AbatementAge == nil) || r.AbatementAge != nil && !r.AbatementAge.EqualsDeep(*other.AbatementAge) {
+		return false
+	}
+	if (r.AbatementPeriod == nil) != (other.AbatementPeriod == nil) || r.AbatementPeriod != nil && !r.AbatementPeriod.EqualsDeep(*other.AbatementPeriod) {
+		return false
+	}
+	if (r.AbatementRange == nil) != (other.AbatementRange == nil) || r.AbatementRange != nil && !r.AbatementRange.EqualsDeep(*other.AbatementRange) {
+		return false
+	}
+	if (r.AbatementString == nil) != (other.AbatementString == nil) || r.AbatementString != nil && !equivalentString(*r.AbatementString, *other.AbatementString) {
+		return false
+	}
+	if (r.AbatementStringElement == nil) != (other.AbatementStringElement == nil) || r.AbatementStringElement != nil && !r.AbatementStringElement.EqualsDeep(*other.AbatementStringElement) {
+		return false
+	}
+	if (r.RecordedDate == nil) != (other.RecordedDate == nil) || r.RecordedDate != nil && !equivalentString(*r.RecordedDate, *other.RecordedDate) {
+		return false
+	}
+	if (r.RecordedDateElement == nil) != (other.RecordedDateElement == nil) || r.RecordedDateElement != nil && !r.RecordedDateElement.EqualsDeep(*other.RecordedDateElement) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.EqualsDeep(*other.Recorder) {
+		return false
+	}
+	if (r.Asserter == nil) != (other.Asserter == nil) || r.Asserter != nil && !r.Asserter.EqualsDeep(*other.Asserter) {
+		return false
+	}
+	if !equivalentList(len(r.Stage), len(other.Stage), func(i, j int) bool {
+		return r.Stage[i].EqualsDeep(other.Stage[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Evidence), len(other.Evidence), func(i, j int) bool {
+		return r.Evidence[i].EqualsDeep(other.Evidence[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Condition) EqualsShallow(other Condition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.OnsetDateTime == nil) != (other.OnsetDateTime == nil) || r.OnsetDateTime != nil && !equivalentString(*r.OnsetDateTime, *other.OnsetDateTime) {
+		return false
+	}
+	if (r.OnsetString == nil) != (other.OnsetString == nil) || r.OnsetString != nil && !equivalentString(*r.OnsetString, *other.OnsetString) {
+		return false
+	}
+	if (r.AbatementDateTime == nil) != (other.AbatementDateTime == nil) || r.AbatementDateTime != nil && !equivalentString(*r.AbatementDateTime, *other.AbatementDateTime) {
+		return false
+	}
+	if (r.AbatementString == nil) != (other.AbatementString == nil) || r.AbatementString != nil && !equivalentString(*r.AbatementString, *other.AbatementString) {
+		return false
+	}
+	if (r.RecordedDate == nil) != (other.RecordedDate == nil) || r.RecordedDate != nil && !equivalentString(*r.RecordedDate, *other.RecordedDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Condition as FHIR XML
+func (r Condition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Condition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "clinicalStatus", r.ClinicalStatus); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "verificationStatus", r.VerificationStatus); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "severity", r.Severity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.BodySite {
+		if err := encodeXMLElement(e, "bodySite", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "onsetDateTime", r.OnsetDateTime, r.OnsetDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onsetAge", r.OnsetAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onsetPeriod", r.OnsetPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onsetRange", r.OnsetRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "onsetString", r.OnsetString, r.OnsetStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "abatementDateTime", r.AbatementDateTime, r.AbatementDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "abatementAge", r.AbatementAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "abatementPeriod", r.AbatementPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "abatementRange", r.AbatementRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "abatementString", r.AbatementString, r.AbatementStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "recordedDate", r.RecordedDate, r.RecordedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "recorder", r.Recorder); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "asserter", r.Asserter); err != nil {
+		return err
+	}
+	for _, v := range r.Stage {
+		if err := encodeXMLElement(e, "stage", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Evidence {
+		if err := encodeXMLElement(e, "evidence", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Condition from FHIR XML
+func (r *Condition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "clinicalStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ClinicalStatus = &v
+			case "verificationStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.VerificationStatus = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "severity":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Severity = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = append(r.BodySite, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "onsetDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OnsetDateTime = &v
+				}
+				r.OnsetDateTimeElement = element
+			case "onsetAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnsetAge = &v
+			case "onsetPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnsetPeriod = &v
+			case "onsetRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnsetRange = &v
+			case "onsetString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OnsetString = &v
+				}
+				r.OnsetStringElement = element
+			case "abatementDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AbatementDateTime = &v
+				}
+				r.AbatementDateTimeElement = element
+			case "abatementAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AbatementAge = &v
+			case "abatementPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AbatementPeriod = &v
+			case "abatementRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AbatementRange = &v
+			case "abatementString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AbatementString = &v
+				}
+				r.AbatementStringElement = element
+			case "recordedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RecordedDate = &v
+				}
+				r.RecordedDateElement = element
+			case "recorder":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recorder = &v
+			case "asserter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Asserter = &v
+			case "stage":
+				var v ConditionStage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Stage = append(r.Stage, v)
+			case "evidence":
+				var v ConditionEvidence
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Evidence = append(r.Evidence, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Condition as properties to the RDF node n
+func (r Condition) turtle(n *turtleNode) {
+	n.resource("Condition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Condition.identifier", i, v)
+	}
+	n.element("Condition.clinicalStatus", -1, r.ClinicalStatus)
+	n.element("Condition.verificationStatus", -1, r.VerificationStatus)
+	for i, v := range r.Category {
+		n.element("Condition.category", i, v)
+	}
+	n.element("Condition.severity", -1, r.Severity)
+	n.element("Condition.code", -1, r.Code)
+	for i, v := range r.BodySite {
+		n.element("Condition.bodySite", i, v)
+	}
+	n.element("Condition.subject", -1, r.Subject)
+	n.element("Condition.encounter", -1, r.Encounter)
+	n.primitive("Condition.onsetDateTime", -1, r.OnsetDateTime, r.OnsetDateTimeElement, "dateTime")
+	n.element("Condition.onsetAge", -1, r.OnsetAge)
+	n.element("Condition.onsetPeriod", -1, r.OnsetPeriod)
+	n.element("Condition.onsetRange", -1, r.OnsetRange)
+	n.primitive("Condition.onsetString", -1, r.OnsetString, r.OnsetStringElement, "string")
+	n.primitive("Condition.abatementDateTime", -1, r.AbatementDateTime, r.AbatementDateTimeElement, "dateTime")
+	n.element("Condition.abatementAge", -1, r.AbatementAge)
+	n.element("Condition.abatementPeriod", -1, r.AbatementPeriod)
+	n.element("Condition.abatementRange", -1, r.AbatementRange)
+	n.primitive("Condition.abatementString", -1, r.AbatementString, r.AbatementStringElement, "string")
+	n.primitive("Condition.recordedDate", -1, r.RecordedDate, r.RecordedDateElement, "dateTime")
+	n.element("Condition.recorder", -1, r.Recorder)
+	n.element("Condition.asserter", -1, r.Asserter)
+	for i, v := range r.Stage {
+		n.element("Condition.stage", i, v)
+	}
+	for i, v := range r.Evidence {
+		n.element("Condition.evidence", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("Condition.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Condition as BSON document with the structure of its FHIR JSON
+func (r Condition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Condition from a BSON document with the structure of its FHIR JSON
+func (r *Condition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Condition as protobuf message Condition of fhir.proto
+func (r Condition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Condition to the encoded message
+func (r Condition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.ClinicalStatus != nil {
+		e.message(10, *r.ClinicalStatus)
+	}
+	if r.VerificationStatus != nil {
+		e.message(11, *r.VerificationStatus)
+	}
+	for _, v := range r.Category {
+		e.message(12, v)
+	}
+	if r.Severity != nil {
+		e.message(13, *r.Severity)
+	}
+	if r.Code != nil {
+		e.message(14, *r.Code)
+	}
+	for _, v := range r.BodySite {
+		e.message(15, v)
+	}
+	e.message(16, r.Subject)
+	if r.Encounter != nil {
+		e.message(17, *r.Encounter)
+	}
+	if r.OnsetDateTime != nil {
+		e.string(18, *r.OnsetDateTime)
+	}
+	if r.OnsetAge != nil {
+		e.message(19, *r.OnsetAge)
+	}
+	if r.OnsetPeriod != nil {
+		e.message(20, *r.OnsetPeriod)
+	}
+	if r.OnsetRange != nil {
+		e.message(21, *r.OnsetRange)
+	}
+	if r.OnsetString != nil {
+		e.string(22, *r.OnsetString)
+	}
+	if r.AbatementDateTime != nil {
+		e.string(23, *r.AbatementDateTime)
+	}
+	if r.AbatementAge != nil {
+		e.message(24, *r.AbatementAge)
+	}
+	if r.AbatementPeriod != nil {
+		e.message(25, *r.AbatementPeriod)
+	}
+	if r.AbatementRange != nil {
+		e.message(26, *r.AbatementRange)
+	}
+	if r.AbatementString != nil {
+		e.string(27, *r.AbatementString)
+	}
+	if r.RecordedDate != nil {
+		e.string(28, *r.RecordedDate)
+	}
+	if r.Recorder != nil {
+		e.message(29, *r.Recorder)
+	}
+	if r.Asserter != nil {
+		e.message(30, *r.Asserter)
+	}
+	for _, v := range r.Stage {
+		e.message(31, v)
+	}
+	for _, v := range r.Evidence {
+		e.message(32, v)
+	}
+	for _, v := range r.Note {
+		e.message(33, v)
+	}
+	if r.IdElement != nil {
+		e.message(34, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(35, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(36, *r.LanguageElement)
+	}
+	if r.OnsetDateTimeElement != nil {
+		e.message(37, *r.OnsetDateTimeElement)
+	}
+	if r.OnsetStringElement != nil {
+		e.message(38, *r.OnsetStringElement)
+	}
+	if r.AbatementDateTimeElement != nil {
+		e.message(39, *r.AbatementDateTimeElement)
+	}
+	if r.AbatementStringElement != nil {
+		e.message(40, *r.AbatementStringElement)
+	}
+	if r.RecordedDateElement != nil {
+		e.message(41, *r.RecordedDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Condition from protobuf message Condition of fhir.proto
+func (r *Condition) UnmarshalProto(b []byte) error {
+	*r = Condition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.ClinicalStatus = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.VerificationStatus = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Severity = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = append(r.BodySite, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 18:
+			v := d.string()
+			r.OnsetDateTime = &v
+		case 19:
+			var v Age
+			d.message(&v)
+			r.OnsetAge = &v
+		case 20:
+			var v Period
+			d.message(&v)
+			r.OnsetPeriod = &v
+		case 21:
+			var v Range
+			d.message(&v)
+			r.OnsetRange = &v
+		case 22:
+			v := d.string()
+			r.OnsetString = &v
+		case 23:
+			v := d.string()
+			r.AbatementDateTime = &v
+		case 24:
+			var v Age
+			d.message(&v)
+			r.AbatementAge = &v
+		case 25:
+			var v Period
+			d.message(&v)
+			r.AbatementPeriod = &v
+		case 26:
+			var v Range
+			d.message(&v)
+			r.AbatementRange = &v
+		case 27:
+			v := d.string()
+			r.AbatementString = &v
+		case 28:
+			v := d.string()
+			r.RecordedDate = &v
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.Recorder = &v
+		case 30:
+			var v Reference
+			d.message(&v)
+			r.Asserter = &v
+		case 31:
+			var v ConditionStage
+			d.message(&v)
+			r.Stage = append(r.Stage, v)
+		case 32:
+			var v ConditionEvidence
+			d.message(&v)
+			r.Evidence = append(r.Evidence, v)
+		case 33:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 34:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.OnsetDateTimeElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.OnsetStringElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.AbatementDateTimeElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.AbatementStringElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.RecordedDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConditionStage as JSON into a byte slice
+func (r ConditionStage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConditionStage as JSON object with the members in the order of the fields
+func (r ConditionStage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Summary != nil {
+		e.key("summary")
+		r.Summary.appendJSON(e)
+	}
+	if len(r.Assessment) > 0 {
+		e.key("assessment")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Assessment {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConditionStage from JSON. Unknown members are ignored.
+func (r *ConditionStage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConditionStage
+func (r *ConditionStage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "summary":
+			if d.null() {
+				r.Summary = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Summary = &v
+			}
+		case "assessment":
+			a := d.array()
+			r.Assessment = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Assessment = append(r.Assessment, v)
+			}
+			if r.Assessment == nil && !a.isNull {
+				r.Assessment = []Reference{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConditionStage which shares no memory with the original
+func (r ConditionStage) DeepCopy() ConditionStage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Summary != nil {
+		v := r.Summary.DeepCopy()
+		out.Summary = &v
+	}
+	if r.Assessment != nil {
+		out.Assessment = make([]Reference, len(r.Assessment))
+		for i := range r.Assessment {
+			out.Assessment[i] = r.Assessment[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConditionStage) Equal(other ConditionStage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Summary == nil) != (other.Summary == nil) || r.Summary != nil && !r.Summary.Equal(*other.Summary) {
+		return false
+	}
+	if len(r.Assessment) != len(other.Assessment) {
+		return false
+	}
+	for i := range r.Assessment {
+		if !r.Assessment[i].Equal(other.Assessment[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConditionStage) EqualsDeep(other ConditionStage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Summary == nil) != (other.Summary == nil) || r.Summary != nil && !r.Summary.EqualsDeep(*other.Summary) {
+		return false
+	}
+	if !equivalentList(len(r.Assessment), len(other.Assessment), func(i, j int) bool {
+		return r.Assessment[i].EqualsDeep(other.Assessment[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConditionStage) EqualsShallow(other ConditionStage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConditionStage as FHIR XML
+func (r ConditionStage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "summary", r.Summary); err != nil {
+		return err
+	}
+	for _, v := range r.Assessment {
+		if err := encodeXMLElement(e, "assessment", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConditionStage from FHIR XML
+func (r *ConditionStage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "summary":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Summary = &v
+			case "assessment":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Assessment = append(r.Assessment, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConditionStage as properties to the RDF node n
+func (r ConditionStage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Condition.stage.summary", -1, r.Summary)
+	for i, v := range r.Assessment {
+		n.element("Condition.stage.assessment", i, v)
+	}
+	n.element("Condition.stage.type", -1, r.Type)
+}
+
+// MarshalBSON marshals the given ConditionStage as BSON document with the structure of its FHIR JSON
+func (r ConditionStage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConditionStage from a BSON document with the structure of its FHIR JSON
+func (r *ConditionStage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConditionStage as protobuf message ConditionStage of fhir.proto
+func (r ConditionStage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConditionStage to the encoded message
+func (r ConditionStage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Summary != nil {
+		e.message(4, *r.Summary)
+	}
+	for _, v := range r.Assessment {
+		e.message(5, v)
+	}
+	if r.Type != nil {
+		e.message(6, *r.Type)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConditionStage from protobuf message ConditionStage of fhir.proto
+func (r *ConditionStage) UnmarshalProto(b []byte) error {
+	*r = ConditionStage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Summary = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Assessment = append(r.Assessment, v)
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConditionEvidence as JSON into a byte slice
+func (r ConditionEvidence) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConditionEvidence as JSON object with the members in the order of the fields
+func (r ConditionEvidence) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConditionEvidence from JSON. Unknown members are ignored.
+func (r *ConditionEvidence) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConditionEvidence
+func (r *ConditionEvidence) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConditionEvidence which shares no memory with the original
+func (r ConditionEvidence) DeepCopy() ConditionEvidence {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Detail != nil {
+		out.Detail = make([]Reference, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConditionEvidence) Equal(other ConditionEvidence) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConditionEvidence) EqualsDeep(other ConditionEvidence) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConditionEvidence) EqualsShallow(other ConditionEvidence) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConditionEvidence as FHIR XML
+func (r ConditionEvidence) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConditionEvidence from FHIR XML
+func (r *ConditionEvidence) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "detail":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConditionEvidence as properties to the RDF node n
+func (r ConditionEvidence) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Code {
+		n.element("Condition.evidence.code", i, v)
+	}
+	for i, v := range r.Detail {
+		n.element("Condition.evidence.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ConditionEvidence as BSON document with the structure of its FHIR JSON
+func (r ConditionEvidence) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConditionEvidence from a BSON document with the structure of its FHIR JSON
+func (r *ConditionEvidence) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConditionEvidence as protobuf message ConditionEvidence of fhir.proto
+func (r ConditionEvidence) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConditionEvidence to the encoded message
+func (r ConditionEvidence) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Code {
+		e.message(4, v)
+	}
+	for _, v := range r.Detail {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConditionEvidence from protobuf message ConditionEvidence of fhir.proto
+func (r *ConditionEvidence) UnmarshalProto(b []byte) error {
+	*r = ConditionEvidence{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCondition unmarshals a Condition.
 func UnmarshalCondition(b []byte) (Condition, error) {
 	var condition Condition
-	if err := json.Unmarshal(b, &condition); err != nil {
+	if err := condition.UnmarshalJSON(b); err != nil {
 		return condition, err
 	}
 	return condition, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ConditionalDeleteStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ConditionalDeleteStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ConditionalDeleteStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "not-supported":
 		*code = ConditionalDeleteStatusNotSupported
@@ -49,6 +53,12 @@ func (code *ConditionalDeleteStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ConditionalDeleteStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ConditionalDeleteStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ConditionalDeleteStatus) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ConditionalDeleteStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ConditionalDeleteStatus) protoNumber() uint64 {
+	switch code {
+	case ConditionalDeleteStatusNotSupported:
+		return 1
+	case ConditionalDeleteStatusSingle:
+		return 2
+	case ConditionalDeleteStatusMultiple:
+		return 3
+	}
+	return 0
+}
+func (code *ConditionalDeleteStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ConditionalDeleteStatusNotSupported
+	case 2:
+		*code = ConditionalDeleteStatusSingle
+	case 3:
+		*code = ConditionalDeleteStatusMultiple
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code ConditionalReadStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ConditionalReadStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ConditionalReadStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "not-supported":
 		*code = ConditionalReadStatusNotSupported
@@ -52,6 +56,12 @@ func (code *ConditionalReadStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ConditionalReadStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ConditionalReadStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ConditionalReadStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code ConditionalReadStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ConditionalReadStatus) protoNumber() uint64 {
+	switch code {
+	case ConditionalReadStatusNotSupported:
+		return 1
+	case ConditionalReadStatusModifiedSince:
+		return 2
+	case ConditionalReadStatusNotMatch:
+		return 3
+	case ConditionalReadStatusFullSupport:
+		return 4
+	}
+	return 0
+}
+func (code *ConditionalReadStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ConditionalReadStatusNotSupported
+	case 2:
+		*code = ConditionalReadStatusModifiedSince
+	case 3:
+		*code = ConditionalReadStatusNotMatch
+	case 4:
+		*code = ConditionalReadStatusFullSupport
+	default:
+		return false
+	}
+	return true
+}
@@ -14,55 +14,105 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Consent is documented here http://hl7.org/fhir/StructureDefinition/Consent
 type Consent struct {
-	Id                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            ConsentState          `bson:"status" json:"status"`
-	Scope             CodeableConcept       `bson:"scope" json:"scope"`
-	Category          []CodeableConcept     `bson:"category" json:"category"`
-	Patient           *Reference            `bson:"patient,omitempty" json:"patient,omitempty"`
-	DateTime          *string               `bson:"dateTime,omitempty" json:"dateTime,omitempty"`
-	Performer         []Reference           `bson:"performer,omitempty" json:"performer,omitempty"`
-	Organization      []Reference           `bson:"organization,omitempty" json:"organization,omitempty"`
-	SourceAttachment  *Attachment           `bson:"sourceAttachment,omitempty" json:"sourceAttachment,omitempty"`
-	SourceReference   *Reference            `bson:"sourceReference,omitempty" json:"sourceReference,omitempty"`
-	Policy            []ConsentPolicy       `bson:"policy,omitempty" json:"policy,omitempty"`
-	PolicyRule        *CodeableConcept      `bson:"policyRule,omitempty" json:"policyRule,omitempty"`
-	Verification      []ConsentVerification `bson:"verification,omitempty" json:"verification,omitempty"`
-	Provision         *ConsentProvision     `bson:"provision,omitempty" json:"provision,omitempty"`
+	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element              `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               ConsentState          `bson:"status" json:"status"`
+	StatusElement        *Element              `bson:"_status,omitempty" json:"_status,omitempty"`
+	Scope                CodeableConcept       `bson:"scope" json:"scope"`
+	Category             []CodeableConcept     `bson:"category" json:"category"`
+	Patient              *Reference            `bson:"patient,omitempty" json:"patient,omitempty"`
+	DateTime             *string               `bson:"dateTime,omitempty" json:"dateTime,omitempty"`
+	DateTimeElement      *Element              `bson:"_dateTime,omitempty" json:"_dateTime,omitempty"`
+	Performer            []Reference           `bson:"performer,omitempty" json:"performer,omitempty"`
+	Organization         []Reference           `bson:"organization,omitempty" json:"organization,omitempty"`
+	SourceAttachment     *Attachment           `bson:"sourceAttachment,omitempty" json:"sourceAttachment,omitempty"`
+	SourceReference      *Reference            `bson:"sourceReference,omitempty" json:"sourceReference,omitempty"`
+	Policy               []ConsentPolicy       `bson:"policy,omitempty" json:"policy,omitempty"`
+	PolicyRule           *CodeableConcept      `bson:"policyRule,omitempty" json:"policyRule,omitempty"`
+	Verification         []ConsentVerification `bson:"verification,omitempty" json:"verification,omitempty"`
+	Provision            *ConsentProvision     `bson:"provision,omitempty" json:"provision,omitempty"`
 }
+
+// ConsentSource is implemented by the types allowed for Consent.source[x]
+type ConsentSource interface {
+	isConsentSource()
+}
+
+func (Attachment) isConsentSource() {}
+func (Reference) isConsentSource()  {}
+
+// Source returns the value of Consent.source[x] together with its FHIR type code or nil if no value is set
+func (r Consent) Source() (ConsentSource, string) {
+	if r.SourceAttachment != nil {
+		return *r.SourceAttachment, "Attachment"
+	}
+	if r.SourceReference != nil {
+		return *r.SourceReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetSource sets the value of Consent.source[x], given as value or pointer, and clears all other types
+func (r *Consent) SetSource(value ConsentSource) {
+	r.SourceAttachment = nil
+	r.SourceReference = nil
+	switch v := value.(type) {
+	case Attachment:
+		r.SourceAttachment = &v
+	case *Attachment:
+		r.SourceAttachment = v
+	case Reference:
+		r.SourceReference = &v
+	case *Reference:
+		r.SourceReference = v
+	}
+}
+
 type ConsentPolicy struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Authority         *string     `bson:"authority,omitempty" json:"authority,omitempty"`
+	AuthorityElement  *Element    `bson:"_authority,omitempty" json:"_authority,omitempty"`
 	Uri               *string     `bson:"uri,omitempty" json:"uri,omitempty"`
+	UriElement        *Element    `bson:"_uri,omitempty" json:"_uri,omitempty"`
 }
 type ConsentVerification struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Verified          bool        `bson:"verified" json:"verified"`
-	VerifiedWith      *Reference  `bson:"verifiedWith,omitempty" json:"verifiedWith,omitempty"`
-	VerificationDate  *string     `bson:"verificationDate,omitempty" json:"verificationDate,omitempty"`
+	Id                      *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Verified                bool        `bson:"verified" json:"verified"`
+	VerifiedElement         *Element    `bson:"_verified,omitempty" json:"_verified,omitempty"`
+	VerifiedWith            *Reference  `bson:"verifiedWith,omitempty" json:"verifiedWith,omitempty"`
+	VerificationDate        *string     `bson:"verificationDate,omitempty" json:"verificationDate,omitempty"`
+	VerificationDateElement *Element    `bson:"_verificationDate,omitempty" json:"_verificationDate,omitempty"`
 }
 type ConsentProvision struct {
 	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              *ConsentProvisionType   `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element                `bson:"_type,omitempty" json:"_type,omitempty"`
 	Period            *Period                 `bson:"period,omitempty" json:"period,omitempty"`
 	Actor             []ConsentProvisionActor `bson:"actor,omitempty" json:"actor,omitempty"`
 	Action            []CodeableConcept       `bson:"action,omitempty" json:"action,omitempty"`
@@ -86,25 +136,3777 @@ type ConsentProvisionData struct {
 	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Meaning           ConsentDataMeaning `bson:"meaning" json:"meaning"`
+	MeaningElement    *Element           `bson:"_meaning,omitempty" json:"_meaning,omitempty"`
 	Reference         Reference          `bson:"reference" json:"reference"`
 }
-type OtherConsent Consent
 
 // MarshalJSON marshals the given Consent as JSON into a byte slice
 func (r Consent) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherConsent
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherConsent: OtherConsent(r),
-		ResourceType: "Consent",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Consent as JSON object with the members in the order of the fields
+func (r Consent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Consent\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("scope")
+	r.Scope.appendJSON(e)
+	e.key("category")
+	if r.Category == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Patient != nil {
+		e.key("patient")
+		r.Patient.appendJSON(e)
+	}
+	if r.DateTime != nil {
+		e.key("dateTime")
+		e.string(*r.DateTime)
+	}
+	if r.DateTimeElement != nil {
+		e.key("_dateTime")
+		r.DateTimeElement.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Organization) > 0 {
+		e.key("organization")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Organization {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.SourceAttachment != nil {
+		e.key("sourceAttachment")
+		r.SourceAttachment.appendJSON(e)
+	}
+	if r.SourceReference != nil {
+		e.key("sourceReference")
+		r.SourceReference.appendJSON(e)
+	}
+	if len(r.Policy) > 0 {
+		e.key("policy")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Policy {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PolicyRule != nil {
+		e.key("policyRule")
+		r.PolicyRule.appendJSON(e)
+	}
+	if len(r.Verification) > 0 {
+		e.key("verification")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Verification {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Provision != nil {
+		e.key("provision")
+		r.Provision.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Consent from JSON. Unknown members are ignored.
+func (r *Consent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Consent
+func (r *Consent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "scope":
+			r.Scope.decodeJSON(d)
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "patient":
+			if d.null() {
+				r.Patient = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Patient = &v
+			}
+		case "dateTime":
+			if v, ok := d.string(); ok {
+				r.DateTime = &v
+			} else {
+				r.DateTime = nil
+			}
+		case "_dateTime":
+			r.DateTimeElement = d.element()
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []Reference{}
+			}
+		case "organization":
+			a := d.array()
+			r.Organization = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Organization = append(r.Organization, v)
+			}
+			if r.Organization == nil && !a.isNull {
+				r.Organization = []Reference{}
+			}
+		case "sourceAttachment":
+			if d.null() {
+				r.SourceAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.SourceAttachment = &v
+			}
+		case "sourceReference":
+			if d.null() {
+				r.SourceReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SourceReference = &v
+			}
+		case "policy":
+			a := d.array()
+			r.Policy = nil
+			for a.next() {
+				var v ConsentPolicy
+				v.decodeJSON(d)
+				r.Policy = append(r.Policy, v)
+			}
+			if r.Policy == nil && !a.isNull {
+				r.Policy = []ConsentPolicy{}
+			}
+		case "policyRule":
+			if d.null() {
+				r.PolicyRule = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PolicyRule = &v
+			}
+		case "verification":
+			a := d.array()
+			r.Verification = nil
+			for a.next() {
+				var v ConsentVerification
+				v.decodeJSON(d)
+				r.Verification = append(r.Verification, v)
+			}
+			if r.Verification == nil && !a.isNull {
+				r.Verification = []ConsentVerification{}
+			}
+		case "provision":
+			if d.null() {
+				r.Provision = nil
+			} else {
+				var v ConsentProvision
+				v.decodeJSON(d)
+				r.Provision = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Consent which shares no memory with the original
+func (r Consent) DeepCopy() Consent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.Scope = r.Scope.DeepCopy()
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Patient != nil {
+		v := r.Patient.DeepCopy()
+		out.Patient = &v
+	}
+	if r.DateTime != nil {
+		v := *r.DateTime
+		out.DateTime = &v
+	}
+	if r.DateTimeElement != nil {
+		v := r.DateTimeElement.DeepCopy()
+		out.DateTimeElement = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]Reference, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.Organization != nil {
+		out.Organization = make([]Reference, len(r.Organization))
+		for i := range r.Organization {
+			out.Organization[i] = r.Organization[i].DeepCopy()
+		}
+	}
+	if r.SourceAttachment != nil {
+		v := r.SourceAttachment.DeepCopy()
+		out.SourceAttachment = &v
+	}
+	if r.SourceReference != nil {
+		v := r.SourceReference.DeepCopy()
+		out.SourceReference = &v
+	}
+	if r.Policy != nil {
+		out.Policy = make([]ConsentPolicy, len(r.Policy))
+		for i := range r.Policy {
+			out.Policy[i] = r.Policy[i].DeepCopy()
+		}
+	}
+	if r.PolicyRule != nil {
+		v := r.PolicyRule.DeepCopy()
+		out.PolicyRule = &v
+	}
+	if r.Verification != nil {
+		out.Verification = make([]ConsentVerification, len(r.Verification))
+		for i := range r.Verification {
+			out.Verification[i] = r.Verification[i].DeepCopy()
+		}
+	}
+	if r.Provision != nil {
+		v := r.Provision.DeepCopy()
+		out.Provision = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Consent) Equal(other Consent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.Scope.Equal(other.Scope) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.Equal(*other.Patient) {
+		return false
+	}
+	if (r.DateTime == nil) != (other.DateTime == nil) || r.DateTime != nil && *r.DateTime != *other.DateTime {
+		return false
+	}
+	if (r.DateTimeElement == nil) != (other.DateTimeElement == nil) || r.DateTimeElement != nil && !r.DateTimeElement.Equal(*other.DateTimeElement) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if len(r.Organization) != len(other.Organization) {
+		return false
+	}
+	for i := range r.Organization {
+		if !r.Organization[i].Equal(other.Organization[i]) {
+			return false
+		}
+	}
+	if (r.SourceAttachment == nil) != (other.SourceAttachment == nil) || r.SourceAttachment != nil && !r.SourceAttachment.Equal(*other.SourceAttachment) {
+		return false
+	}
+	if (r.SourceReference == nil) != (other.SourceReference == nil) || r.SourceReference != nil && !r.SourceReference.Equal(*other.SourceReference) {
+		return false
+	}
+	if len(r.Policy) != len(other.Policy) {
+		return false
+	}
+	for i := range r.Policy {
+		if !r.Policy[i].Equal(other.Policy[i]) {
+			return false
+		}
+	}
+	if (r.PolicyRule == nil) != (other.PolicyRule == nil) || r.PolicyRule != nil && !r.PolicyRule.Equal(*other.PolicyRule) {
+		return false
+	}
+	if len(r.Verification) != len(other.Verification) {
+		return false
+	}
+	for i := range r.Verification {
+		if !r.Verification[i].Equal(other.Verification[i]) {
+			return false
+		}
+	}
+	if (r.Provision == nil) != (other.Provision == nil) || r.Provision != nil && !r.Provision.Equal(*other.Provision) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Consent) EqualsDeep(other Consent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.Scope.EqualsDeep(other.Scope) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.EqualsDeep(*other.Patient) {
+		return false
+	}
+	if (r.DateTime == nil) != (other.DateTime == nil) || r.DateTime != nil && !equivalentString(*r.DateTime, *other.DateTime) {
+		return false
+	}
+	if (r.DateTimeElement == nil) != (other.DateTimeElement == nil) || r.DateTimeElement != nil && !r.DateTimeElement.EqualsDeep(*other.DateTimeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Organization), len(other.Organization), func(i, j int) bool {
+		return r.Organization[i].EqualsDeep(other.Organization[j])
+	}) {
+		return false
+	}
+	if (r.SourceAttachment == nil) != (other.SourceAttachment == nil) || r.SourceAttachment != nil && !r.SourceAttachment.EqualsDeep(*other.SourceAttachment) {
+		return false
+	}
+	if (r.SourceReference == nil) != (other.SourceReference == nil) || r.SourceReference != nil && !r.SourceReference.EqualsDeep(*other.SourceReference) {
+		return false
+	}
+	if !equivalentList(len(r.Policy), len(other.Policy), func(i, j int) bool {
+		return r.Policy[i].EqualsDeep(other.Policy[j])
+	}) {
+		return false
+	}
+	if (r.PolicyRule == nil) != (other.PolicyRule == nil) || r.PolicyRule != nil && !r.PolicyRule.EqualsDeep(*other.PolicyRule) {
+		return false
+	}
+	if !equivalentList(len(r.Verification), len(other.Verification), func(i, j int) bool {
+		return r.Verification[i].EqualsDeep(other.Verification[j])
+	}) {
+		return false
+	}
+	if (r.Provision == nil) != (other.Provision == nil) || r.Provision != nil && !r.Provision.EqualsDeep(*other.Provision) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Consent) EqualsShallow(other Consent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.DateTime == nil) != (other.DateTime == nil) || r.DateTime != nil && !equivalentString(*r.DateTime, *other.DateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Consent as FHIR XML
+func (r Consent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Consent",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "scope", r.Scope); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "dateTime", r.DateTime, r.DateTimeElement); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Organization {
+		if err := encodeXMLElement(e, "organization", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "sourceAttachment", r.SourceAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sourceReference", r.SourceReference); err != nil {
+		return err
+	}
+	for _, v := range r.Policy {
+		if err := encodeXMLElement(e, "policy", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "policyRule", r.PolicyRule); err != nil {
+		return err
+	}
+	for _, v := range r.Verification {
+		if err := encodeXMLElement(e, "verification", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "provision", r.Provision); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Consent from FHIR XML
+func (r *Consent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v ConsentState
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "scope":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Scope = v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = &v
+			case "dateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DateTime = &v
+				}
+				r.DateTimeElement = element
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "organization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Organization = append(r.Organization, v)
+			case "sourceAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SourceAttachment = &v
+			case "sourceReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SourceReference = &v
+			case "policy":
+				var v ConsentPolicy
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Policy = append(r.Policy, v)
+			case "policyRule":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PolicyRule = &v
+			case "verification":
+				var v ConsentVerification
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Verification = append(r.Verification, v)
+			case "provision":
+				var v ConsentProvision
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provision = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Consent as properties to the RDF node n
+func (r Consent) turtle(n *turtleNode) {
+	n.resource("Consent", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Consent.identifier", i, v)
+	}
+	n.primitive("Consent.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Consent.scope", -1, r.Scope)
+	for i, v := range r.Category {
+		n.element("Consent.category", i, v)
+	}
+	n.element("Consent.patient", -1, r.Patient)
+	n.primitive("Consent.dateTime", -1, r.DateTime, r.DateTimeElement, "dateTime")
+	for i, v := range r.Performer {
+		n.element("Consent.performer", i, v)
+	}
+	for i, v := range r.Organization {
+		n.element("Consent.organization", i, v)
+	}
+	n.element("Consent.sourceAttachment", -1, r.SourceAttachment)
+	n.element("Consent.sourceReference", -1, r.SourceReference)
+	for i, v := range r.Policy {
+		n.element("Consent.policy", i, v)
+	}
+	n.element("Consent.policyRule", -1, r.PolicyRule)
+	for i, v := range r.Verification {
+		n.element("Consent.verification", i, v)
+	}
+	n.element("Consent.provision", -1, r.Provision)
+}
+
+// MarshalBSON marshals the given Consent as BSON document with the structure of its FHIR JSON
+func (r Consent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Consent from a BSON document with the structure of its FHIR JSON
+func (r *Consent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Consent as protobuf message Consent of fhir.proto
+func (r Consent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Consent to the encoded message
+func (r Consent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	e.message(11, r.Scope)
+	for _, v := range r.Category {
+		e.message(12, v)
+	}
+	if r.Patient != nil {
+		e.message(13, *r.Patient)
+	}
+	if r.DateTime != nil {
+		e.string(14, *r.DateTime)
+	}
+	for _, v := range r.Performer {
+		e.message(15, v)
+	}
+	for _, v := range r.Organization {
+		e.message(16, v)
+	}
+	if r.SourceAttachment != nil {
+		e.message(17, *r.SourceAttachment)
+	}
+	if r.SourceReference != nil {
+		e.message(18, *r.SourceReference)
+	}
+	for _, v := range r.Policy {
+		e.message(19, v)
+	}
+	if r.PolicyRule != nil {
+		e.message(20, *r.PolicyRule)
+	}
+	for _, v := range r.Verification {
+		e.message(21, v)
+	}
+	if r.Provision != nil {
+		e.message(22, *r.Provision)
+	}
+	if r.IdElement != nil {
+		e.message(23, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(24, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(25, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(26, *r.StatusElement)
+	}
+	if r.DateTimeElement != nil {
+		e.message(27, *r.DateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Consent from protobuf message Consent of fhir.proto
+func (r *Consent) UnmarshalProto(b []byte) error {
+	*r = Consent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v ConsentState
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Scope = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Patient = &v
+		case 14:
+			v := d.string()
+			r.DateTime = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Organization = append(r.Organization, v)
+		case 17:
+			var v Attachment
+			d.message(&v)
+			r.SourceAttachment = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.SourceReference = &v
+		case 19:
+			var v ConsentPolicy
+			d.message(&v)
+			r.Policy = append(r.Policy, v)
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.PolicyRule = &v
+		case 21:
+			var v ConsentVerification
+			d.message(&v)
+			r.Verification = append(r.Verification, v)
+		case 22:
+			var v ConsentProvision
+			d.message(&v)
+			r.Provision = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.DateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConsentPolicy as JSON into a byte slice
+func (r ConsentPolicy) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConsentPolicy as JSON object with the members in the order of the fields
+func (r ConsentPolicy) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Authority != nil {
+		e.key("authority")
+		e.string(*r.Authority)
+	}
+	if r.AuthorityElement != nil {
+		e.key("_authority")
+		r.AuthorityElement.appendJSON(e)
+	}
+	if r.Uri != nil {
+		e.key("uri")
+		e.string(*r.Uri)
+	}
+	if r.UriElement != nil {
+		e.key("_uri")
+		r.UriElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConsentPolicy from JSON. Unknown members are ignored.
+func (r *ConsentPolicy) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConsentPolicy
+func (r *ConsentPolicy) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "authority":
+			if v, ok := d.string(); ok {
+				r.Authority = &v
+			} else {
+				r.Authority = nil
+			}
+		case "_authority":
+			r.AuthorityElement = d.element()
+		case "uri":
+			if v, ok := d.string(); ok {
+				r.Uri = &v
+			} else {
+				r.Uri = nil
+			}
+		case "_uri":
+			r.UriElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConsentPolicy which shares no memory with the original
+func (r ConsentPolicy) DeepCopy() ConsentPolicy {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Authority != nil {
+		v := *r.Authority
+		out.Authority = &v
+	}
+	if r.AuthorityElement != nil {
+		v := r.AuthorityElement.DeepCopy()
+		out.AuthorityElement = &v
+	}
+	if r.Uri != nil {
+		v := *r.Uri
+		out.Uri = &v
+	}
+	if r.UriElement != nil {
+		v := r.UriElement.DeepCopy()
+		out.UriElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConsentPolicy) Equal(other ConsentPolicy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && *r.Authority != *other.Authority {
+		return false
+	}
+	if (r.AuthorityElement == nil) != (other.AuthorityElement == nil) || r.AuthorityElement != nil && !r.AuthorityElement.Equal(*other.AuthorityElement) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && *r.Uri != *other.Uri {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.Equal(*other.UriElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConsentPolicy) EqualsDeep(other ConsentPolicy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !equivalentString(*r.Authority, *other.Authority) {
+		return false
+	}
+	if (r.AuthorityElement == nil) != (other.AuthorityElement == nil) || r.AuthorityElement != nil && !r.AuthorityElement.EqualsDeep(*other.AuthorityElement) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && !equivalentString(*r.Uri, *other.Uri) {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.EqualsDeep(*other.UriElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConsentPolicy) EqualsShallow(other ConsentPolicy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !equivalentString(*r.Authority, *other.Authority) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && !equivalentString(*r.Uri, *other.Uri) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConsentPolicy as FHIR XML
+func (r ConsentPolicy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "authority", r.Authority, r.AuthorityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "uri", r.Uri, r.UriElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConsentPolicy from FHIR XML
+func (r *ConsentPolicy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "authority":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Authority = &v
+				}
+				r.AuthorityElement = element
+			case "uri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Uri = &v
+				}
+				r.UriElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConsentPolicy as properties to the RDF node n
+func (r ConsentPolicy) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Consent.policy.authority", -1, r.Authority, r.AuthorityElement, "string")
+	n.primitive("Consent.policy.uri", -1, r.Uri, r.UriElement, "string")
+}
+
+// MarshalBSON marshals the given ConsentPolicy as BSON document with the structure of its FHIR JSON
+func (r ConsentPolicy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConsentPolicy from a BSON document with the structure of its FHIR JSON
+func (r *ConsentPolicy) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConsentPolicy as protobuf message ConsentPolicy of fhir.proto
+func (r ConsentPolicy) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConsentPolicy to the encoded message
+func (r ConsentPolicy) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Authority != nil {
+		e.string(4, *r.Authority)
+	}
+	if r.Uri != nil {
+		e.string(5, *r.Uri)
+	}
+	if r.AuthorityElement != nil {
+		e.message(6, *r.AuthorityElement)
+	}
+	if r.UriElement != nil {
+		e.message(7, *r.UriElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConsentPolicy from protobuf message ConsentPolicy of fhir.proto
+func (r *ConsentPolicy) UnmarshalProto(b []byte) error {
+	*r = ConsentPolicy{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Authority = &v
+		case 5:
+			v := d.string()
+			r.Uri = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.AuthorityElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.UriElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConsentVerification as JSON into a byte slice
+func (r ConsentVerification) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConsentVerification as JSON object with the members in the order of the fields
+func (r ConsentVerification) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("verified")
+	e.bool(r.Verified)
+	if r.VerifiedElement != nil {
+		e.key("_verified")
+		r.VerifiedElement.appendJSON(e)
+	}
+	if r.VerifiedWith != nil {
+		e.key("verifiedWith")
+		r.VerifiedWith.appendJSON(e)
+	}
+	if r.VerificationDate != nil {
+		e.key("verificationDate")
+		e.string(*r.VerificationDate)
+	}
+	if r.VerificationDateElement != nil {
+		e.key("_verificationDate")
+		r.VerificationDateElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConsentVerification from JSON. Unknown members are ignored.
+func (r *ConsentVerification) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConsentVerification
+func (r *ConsentVerification) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "verified":
+			if v, ok := d.bool(); ok {
+				r.Verified = v
+			}
+		case "_verified":
+			r.VerifiedElement = d.element()
+		case "verifiedWith":
+			if d.null() {
+				r.VerifiedWith = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.VerifiedWith = &v
+			}
+		case "verificationDate":
+			if v, ok := d.string(); ok {
+				r.VerificationDate = &v
+			} else {
+				r.VerificationDate = nil
+			}
+		case "_verificationDate":
+			r.VerificationDateElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConsentVerification which shares no memory with the original
+func (r ConsentVerification) DeepCopy() ConsentVerification {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.VerifiedElement != nil {
+		v := r.VerifiedElement.DeepCopy()
+		out.VerifiedElement = &v
+	}
+	if r.VerifiedWith != nil {
+		v := r.VerifiedWith.DeepCopy()
+		out.VerifiedWith = &v
+	}
+	if r.VerificationDate != nil {
+		v := *r.VerificationDate
+		out.VerificationDate = &v
+	}
+	if r.VerificationDateElement != nil {
+		v := r.VerificationDateElement.DeepCopy()
+		out.VerificationDateElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConsentVerification) Equal(other ConsentVerification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Verified != other.Verified {
+		return false
+	}
+	if (r.VerifiedElement == nil) != (other.VerifiedElement == nil) || r.VerifiedElement != nil && !r.VerifiedElement.Equal(*other.VerifiedElement) {
+		return false
+	}
+	if (r.VerifiedWith == nil) != (other.VerifiedWith == nil) || r.VerifiedWith != nil && !r.VerifiedWith.Equal(*other.VerifiedWith) {
+		return false
+	}
+	if (r.VerificationDate == nil) != (other.VerificationDate == nil) || r.VerificationDate != nil && *r.VerificationDate != *other.VerificationDate {
+		return false
+	}
+	if (r.VerificationDateElement == nil) != (other.VerificationDateElement == nil) || r.VerificationDateElement != nil && !r.VerificationDateElement.Equal(*other.VerificationDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConsentVerification) EqualsDeep(other ConsentVerification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Verified != other.Verified {
+		return false
+	}
+	if (r.VerifiedElement == nil) != (other.VerifiedElement == nil) || r.VerifiedElement != nil && !r.VerifiedElement.EqualsDeep(*other.VerifiedElement) {
+		return false
+	}
+	if (r.VerifiedWith == nil) != (other.VerifiedWith == nil) || r.VerifiedWith != nil && !r.VerifiedWith.EqualsDeep(*other.VerifiedWith) {
+		return false
+	}
+	if (r.VerificationDate == nil) != (other.VerificationDate == nil) || r.VerificationDate != nil && !equivalentString(*r.VerificationDate, *other.VerificationDate) {
+		return false
+	}
+	if (r.VerificationDateElement == nil) != (other.VerificationDateElement == nil) || r.VerificationDateElement != nil && !r.VerificationDateElement.EqualsDeep(*other.VerificationDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConsentVerification) EqualsShallow(other ConsentVerification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Verified != other.Verified {
+		return false
+	}
+	if (r.VerificationDate == nil) != (other.VerificationDate == nil) || r.VerificationDate != nil && !equivalentString(*r.VerificationDate, *other.VerificationDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConsentVerification as FHIR XML
+func (r ConsentVerification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "verified", r.Verified, r.VerifiedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "verifiedWith", r.VerifiedWith); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "verificationDate", r.VerificationDate, r.VerificationDateElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConsentVerification from FHIR XML
+func (r *ConsentVerification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "verified":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Verified = v
+				}
+				r.VerifiedElement = element
+			case "verifiedWith":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.VerifiedWith = &v
+			case "verificationDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.VerificationDate = &v
+				}
+				r.VerificationDateElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConsentVerification as properties to the RDF node n
+func (r ConsentVerification) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Consent.verification.verified", -1, r.Verified, r.VerifiedElement, "boolean")
+	n.element("Consent.verification.verifiedWith", -1, r.VerifiedWith)
+	n.primitive("Consent.verification.verificationDate", -1, r.VerificationDate, r.VerificationDateElement, "dateTime")
+}
+
+// MarshalBSON marshals the given ConsentVerification as BSON document with the structure of its FHIR JSON
+func (r ConsentVerification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConsentVerification from a BSON document with the structure of its FHIR JSON
+func (r *ConsentVerification) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConsentVerification as protobuf message ConsentVerification of fhir.proto
+func (r ConsentVerification) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConsentVerification to the encoded message
+func (r ConsentVerification) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Verified {
+		e.varint(4, protoBool(r.Verified))
+	}
+	if r.VerifiedWith != nil {
+		e.message(5, *r.VerifiedWith)
+	}
+	if r.VerificationDate != nil {
+		e.string(6, *r.VerificationDate)
+	}
+	if r.VerifiedElement != nil {
+		e.message(7, *r.VerifiedElement)
+	}
+	if r.VerificationDateElement != nil {
+		e.message(8, *r.VerificationDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConsentVerification from protobuf message ConsentVerification of fhir.proto
+func (r *ConsentVerification) UnmarshalProto(b []byte) error {
+	*r = ConsentVerification{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Verified = d.bool()
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.VerifiedWith = &v
+		case 6:
+			v := d.string()
+			r.VerificationDate = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.VerifiedElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.VerificationDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConsentProvision as JSON into a byte slice
+func (r ConsentProvision) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConsentProvision as JSON object with the members in the order of the fields
+func (r ConsentProvision) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(r.Type.Code())
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if len(r.Actor) > 0 {
+		e.key("actor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Actor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Action) > 0 {
+		e.key("action")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SecurityLabel) > 0 {
+		e.key("securityLabel")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SecurityLabel {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Purpose) > 0 {
+		e.key("purpose")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Purpose {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Class) > 0 {
+		e.key("class")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Class {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DataPeriod != nil {
+		e.key("dataPeriod")
+		r.DataPeriod.appendJSON(e)
+	}
+	if len(r.Data) > 0 {
+		e.key("data")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Data {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Provision) > 0 {
+		e.key("provision")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Provision {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConsentProvision from JSON. Unknown members are ignored.
+func (r *ConsentProvision) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConsentProvision
+func (r *ConsentProvision) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v ConsentProvisionType
+				d.unmarshal(&v)
+				r.Type = &v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "actor":
+			a := d.array()
+			r.Actor = nil
+			for a.next() {
+				var v ConsentProvisionActor
+				v.decodeJSON(d)
+				r.Actor = append(r.Actor, v)
+			}
+			if r.Actor == nil && !a.isNull {
+				r.Actor = []ConsentProvisionActor{}
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []CodeableConcept{}
+			}
+		case "securityLabel":
+			a := d.array()
+			r.SecurityLabel = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.SecurityLabel = append(r.SecurityLabel, v)
+			}
+			if r.SecurityLabel == nil && !a.isNull {
+				r.SecurityLabel = []Coding{}
+			}
+		case "purpose":
+			a := d.array()
+			r.Purpose = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Purpose = append(r.Purpose, v)
+			}
+			if r.Purpose == nil && !a.isNull {
+				r.Purpose = []Coding{}
+			}
+		case "class":
+			a := d.array()
+			r.Class = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Class = append(r.Class, v)
+			}
+			if r.Class == nil && !a.isNull {
+				r.Class = []Coding{}
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "dataPeriod":
+			if d.null() {
+				r.DataPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.DataPeriod = &v
+			}
+		case "data":
+			a := d.array()
+			r.Data = nil
+			for a.next() {
+				var v ConsentProvisionData
+				v.decodeJSON(d)
+				r.Data = append(r.Data, v)
+			}
+			if r.Data == nil && !a.isNull {
+				r.Data = []ConsentProvisionData{}
+			}
+		case "provision":
+			a := d.array()
+			r.Provision = nil
+			for a.next() {
+				var v ConsentProvision
+				v.decodeJSON(d)
+				r.Provision = append(r.Provision, v)
+			}
+			if r.Provision == nil && !a.isNull {
+				r.Provision = []ConsentProvision{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConsentProvision which shares no memory with the original
+func (r ConsentProvision) DeepCopy() ConsentProvision {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Actor != nil {
+		out.Actor = make([]ConsentProvisionActor, len(r.Actor))
+		for i := range r.Actor {
+			out.Actor[i] = r.Actor[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		out.Action = make([]CodeableConcept, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	if r.SecurityLabel != nil {
+		out.SecurityLabel = make([]Coding, len(r.SecurityLabel))
+		for i := range r.SecurityLabel {
+			out.SecurityLabel[i] = r.SecurityLabel[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		out.Purpose = make([]Coding, len(r.Purpose))
+		for i := range r.Purpose {
+			out.Purpose[i] = r.Purpose[i].DeepCopy()
+		}
+	}
+	if r.Class != nil {
+		out.Class = make([]Coding, len(r.Class))
+		for i := range r.Class {
+			out.Class[i] = r.Class[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.DataPeriod != nil {
+		v := r.DataPeriod.DeepCopy()
+		out.DataPeriod = &v
+	}
+	if r.Data != nil {
+		out.Data = make([]ConsentProvisionData, len(r.Data))
+		for i := range r.Data {
+			out.Data[i] = r.Data[i].DeepCopy()
+		}
+	}
+	if r.Provision != nil {
+		out.Provision = make([]ConsentProvision, len(r.Provision))
+		for i := range r.Provision {
+			out.Provision[i] = r.Provision[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConsentProvision) Equal(other ConsentProvision) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if len(r.Actor) != len(other.Actor) {
+		return false
+	}
+	for i := range r.Actor {
+		if !r.Actor[i].Equal(other.Actor[i]) {
+			return false
+		}
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	if len(r.SecurityLabel) != len(other.SecurityLabel) {
+		return false
+	}
+	for i := range r.SecurityLabel {
+		if !r.SecurityLabel[i].Equal(other.SecurityLabel[i]) {
+			return false
+		}
+	}
+	if len(r.Purpose) != len(other.Purpose) {
+		return false
+	}
+	for i := range r.Purpose {
+		if !r.Purpose[i].Equal(other.Purpose[i]) {
+			return false
+		}
+	}
+	if len(r.Class) != len(other.Class) {
+		return false
+	}
+	for i := range r.Class {
+		if !r.Class[i].Equal(other.Class[i]) {
+			return false
+		}
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if (r.DataPeriod == nil) != (other.DataPeriod == nil) || r.DataPeriod != nil && !r.DataPeriod.Equal(*other.DataPeriod) {
+		return false
+	}
+	if len(r.Data) != len(other.Data) {
+		return false
+	}
+	for i := range r.Data {
+		if !r.Data[i].Equal(other.Data[i]) {
+			return false
+		}
+	}
+	if len(r.Provision) != len(other.Provision) {
+		return false
+	}
+	for i := range r.Provision {
+		if !r.Provision[i].Equal(other.Provision[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConsentProvision) EqualsDeep(other ConsentProvision) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentList(len(r.Actor), len(other.Actor), func(i, j int) bool {
+		return r.Actor[i].EqualsDeep(other.Actor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabel), len(other.SecurityLabel), func(i, j int) bool {
+		return r.SecurityLabel[i].EqualsDeep(other.SecurityLabel[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Purpose), len(other.Purpose), func(i, j int) bool {
+		return r.Purpose[i].EqualsDeep(other.Purpose[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Class), len(other.Class), func(i, j int) bool {
+		return r.Class[i].EqualsDeep(other.Class[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if (r.DataPeriod == nil) != (other.DataPeriod == nil) || r.DataPeriod != nil && !r.DataPeriod.EqualsDeep(*other.DataPeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Data), len(other.Data), func(i, j int) bool {
+		return r.Data[i].EqualsDeep(other.Data[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Provision), len(other.Provision), func(i, j int) bool {
+		return r.Provision[i].EqualsDeep(other.Provision[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConsentProvision) EqualsShallow(other ConsentProvision) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConsentProvision as FHIR XML
+func (r ConsentProvision) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	for _, v := range r.Actor {
+		if err := encodeXMLElement(e, "actor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SecurityLabel {
+		if err := encodeXMLElement(e, "securityLabel", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Purpose {
+		if err := encodeXMLElement(e, "purpose", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Class {
+		if err := encodeXMLElement(e, "class", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "dataPeriod", r.DataPeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Data {
+		if err := encodeXMLElement(e, "data", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Provision {
+		if err := encodeXMLElement(e, "provision", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConsentProvision from FHIR XML
+func (r *ConsentProvision) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v ConsentProvisionType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "actor":
+				var v ConsentProvisionActor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = append(r.Actor, v)
+			case "action":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			case "securityLabel":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SecurityLabel = append(r.SecurityLabel, v)
+			case "purpose":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Purpose = append(r.Purpose, v)
+			case "class":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Class = append(r.Class, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "dataPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DataPeriod = &v
+			case "data":
+				var v ConsentProvisionData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Data = append(r.Data, v)
+			case "provision":
+				var v ConsentProvision
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provision = append(r.Provision, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConsentProvision as properties to the RDF node n
+func (r ConsentProvision) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Consent.provision.type", -1, r.Type, r.TypeElement, "code")
+	n.element("Consent.provision.period", -1, r.Period)
+	for i, v := range r.Actor {
+		n.element("Consent.provision.actor", i, v)
+	}
+	for i, v := range r.Action {
+		n.element("Consent.provision.action", i, v)
+	}
+	for i, v := range r.SecurityLabel {
+		n.element("Consent.provision.securityLabel", i, v)
+	}
+	for i, v := range r.Purpose {
+		n.element("Consent.provision.purpose", i, v)
+	}
+	for i, v := range r.Class {
+		n.element("Consent.provision.class", i, v)
+	}
+	for i, v := range r.Code {
+		n.element("Consent.provision.code", i, v)
+	}
+	n.element("Consent.provision.dataPeriod", -1, r.DataPeriod)
+	for i, v := range r.Data {
+		n.element("Consent.provision.data", i, v)
+	}
+	for i, v := range r.Provision {
+		n.element("Consent.provision.provision", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ConsentProvision as BSON document with the structure of its FHIR JSON
+func (r ConsentProvision) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConsentProvision from a BSON document with the structure of its FHIR JSON
+func (r *ConsentProvision) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConsentProvision as protobuf message ConsentProvision of fhir.proto
+func (r ConsentProvision) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConsentProvision to the encoded message
+func (r ConsentProvision) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.varint(4, r.Type.protoNumber())
+	}
+	if r.Period != nil {
+		e.message(5, *r.Period)
+	}
+	for _, v := range r.Actor {
+		e.message(6, v)
+	}
+	for _, v := range r.Action {
+		e.message(7, v)
+	}
+	for _, v := range r.SecurityLabel {
+		e.message(8, v)
+	}
+	for _, v := range r.Purpose {
+		e.message(9, v)
+	}
+	for _, v := range r.Class {
+		e.message(10, v)
+	}
+	for _, v := range r.Code {
+		e.message(11, v)
+	}
+	if r.DataPeriod != nil {
+		e.message(12, *r.DataPeriod)
+	}
+	for _, v := range r.Data {
+		e.message(13, v)
+	}
+	for _, v := range r.Provision {
+		e.message(14, v)
+	}
+	if r.TypeElement != nil {
+		e.message(15, *r.TypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConsentProvision from protobuf message ConsentProvision of fhir.proto
+func (r *ConsentProvision) UnmarshalProto(b []byte) error {
+	*r = ConsentProvision{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ConsentProvisionType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = &v
+		case 5:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 6:
+			var v ConsentProvisionActor
+			d.message(&v)
+			r.Actor = append(r.Actor, v)
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		case 8:
+			var v Coding
+			d.message(&v)
+			r.SecurityLabel = append(r.SecurityLabel, v)
+		case 9:
+			var v Coding
+			d.message(&v)
+			r.Purpose = append(r.Purpose, v)
+		case 10:
+			var v Coding
+			d.message(&v)
+			r.Class = append(r.Class, v)
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 12:
+			var v Period
+			d.message(&v)
+			r.DataPeriod = &v
+		case 13:
+			var v ConsentProvisionData
+			d.message(&v)
+			r.Data = append(r.Data, v)
+		case 14:
+			var v ConsentProvision
+			d.message(&v)
+			r.Provision = append(r.Provision, v)
+		case 15:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConsentProvisionActor as JSON into a byte slice
+func (r ConsentProvisionActor) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConsentProvisionActor as JSON object with the members in the order of the fields
+func (r ConsentProvisionActor) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("role")
+	r.Role.appendJSON(e)
+	e.key("reference")
+	r.Reference.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConsentProvisionActor from JSON. Unknown members are ignored.
+func (r *ConsentProvisionActor) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConsentProvisionActor
+func (r *ConsentProvisionActor) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "role":
+			r.Role.decodeJSON(d)
+		case "reference":
+			r.Reference.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConsentProvisionActor which shares no memory with the original
+func (r ConsentProvisionActor) DeepCopy() ConsentProvisionActor {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Role = r.Role.DeepCopy()
+	out.Reference = r.Reference.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConsentProvisionActor) Equal(other ConsentProvisionActor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Role.Equal(other.Role) {
+		return false
+	}
+	if !r.Reference.Equal(other.Reference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConsentProvisionActor) EqualsDeep(other ConsentProvisionActor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Role.EqualsDeep(other.Role) {
+		return false
+	}
+	if !r.Reference.EqualsDeep(other.Reference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConsentProvisionActor) EqualsShallow(other ConsentProvisionActor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConsentProvisionActor as FHIR XML
+func (r ConsentProvisionActor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reference", r.Reference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConsentProvisionActor from FHIR XML
+func (r *ConsentProvisionActor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = v
+			case "reference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConsentProvisionActor as properties to the RDF node n
+func (r ConsentProvisionActor) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Consent.provision.actor.role", -1, r.Role)
+	n.element("Consent.provision.actor.reference", -1, r.Reference)
+}
+
+// MarshalBSON marshals the given ConsentProvisionActor as BSON document with the structure of its FHIR JSON
+func (r ConsentProvisionActor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConsentProvisionActor from a BSON document with the structure of its FHIR JSON
+func (r *ConsentProvisionActor) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConsentProvisionActor as protobuf message ConsentProvisionActor of fhir.proto
+func (r ConsentProvisionActor) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConsentProvisionActor to the encoded message
+func (r ConsentProvisionActor) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Role)
+	e.message(5, r.Reference)
+}
+
+// UnmarshalProto unmarshals the given ConsentProvisionActor from protobuf message ConsentProvisionActor of fhir.proto
+func (r *ConsentProvisionActor) UnmarshalProto(b []byte) error {
+	*r = ConsentProvisionActor{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Reference = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ConsentProvisionData as JSON into a byte slice
+func (r ConsentProvisionData) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ConsentProvisionData as JSON object with the members in the order of the fields
+func (r ConsentProvisionData) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("meaning")
+	e.string(r.Meaning.Code())
+	if r.MeaningElement != nil {
+		e.key("_meaning")
+		r.MeaningElement.appendJSON(e)
+	}
+	e.key("reference")
+	r.Reference.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ConsentProvisionData from JSON. Unknown members are ignored.
+func (r *ConsentProvisionData) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ConsentProvisionData
+func (r *ConsentProvisionData) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "meaning":
+			d.unmarshal(&r.Meaning)
+		case "_meaning":
+			r.MeaningElement = d.element()
+		case "reference":
+			r.Reference.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ConsentProvisionData which shares no memory with the original
+func (r ConsentProvisionData) DeepCopy() ConsentProvisionData {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.MeaningElement != nil {
+		v := r.MeaningElement.DeepCopy()
+		out.MeaningElement = &v
+	}
+	out.Reference = r.Reference.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ConsentProvisionData) Equal(other ConsentProvisionData) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Meaning != other.Meaning {
+		return false
+	}
+	if (r.MeaningElement == nil) != (other.MeaningElement == nil) || r.MeaningElement != nil && !r.MeaningElement.Equal(*other.MeaningElement) {
+		return false
+	}
+	if !r.Reference.Equal(other.Reference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ConsentProvisionData) EqualsDeep(other ConsentProvisionData) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Meaning != other.Meaning {
+		return false
+	}
+	if (r.MeaningElement == nil) != (other.MeaningElement == nil) || r.MeaningElement != nil && !r.MeaningElement.EqualsDeep(*other.MeaningElement) {
+		return false
+	}
+	if !r.Reference.EqualsDeep(other.Reference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ConsentProvisionData) EqualsShallow(other ConsentProvisionData) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Meaning != other.Meaning {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ConsentProvisionData as FHIR XML
+func (r ConsentProvisionData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "meaning", r.Meaning, r.MeaningElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reference", r.Reference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ConsentProvisionData from FHIR XML
+func (r *ConsentProvisionData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "meaning":
+				var v ConsentDataMeaning
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Meaning = v
+				}
+				r.MeaningElement = element
+			case "reference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ConsentProvisionData as properties to the RDF node n
+func (r ConsentProvisionData) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Consent.provision.data.meaning", -1, r.Meaning, r.MeaningElement, "code")
+	n.element("Consent.provision.data.reference", -1, r.Reference)
+}
+
+// MarshalBSON marshals the given ConsentProvisionData as BSON document with the structure of its FHIR JSON
+func (r ConsentProvisionData) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ConsentProvisionData from a BSON document with the structure of its FHIR JSON
+func (r *ConsentProvisionData) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ConsentProvisionData as protobuf message ConsentProvisionData of fhir.proto
+func (r ConsentProvisionData) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ConsentProvisionData to the encoded message
+func (r ConsentProvisionData) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Meaning.protoNumber())
+	e.message(5, r.Reference)
+	if r.MeaningElement != nil {
+		e.message(6, *r.MeaningElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ConsentProvisionData from protobuf message ConsentProvisionData of fhir.proto
+func (r *ConsentProvisionData) UnmarshalProto(b []byte) error {
+	*r = ConsentProvisionData{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ConsentDataMeaning
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Meaning = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Reference = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.MeaningElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalConsent unmarshals a Consent.
 func UnmarshalConsent(b []byte) (Consent, error) {
 	var consent Consent
-	if err := json.Unmarshal(b, &consent); err != nil {
+	if err := consent.UnmarshalJSON(b); err != nil {
 		return consent, err
 	}
 	return consent, nil
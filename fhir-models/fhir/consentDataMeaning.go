@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code ConsentDataMeaning) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ConsentDataMeaning) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ConsentDataMeaning) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "instance":
 		*code = ConsentDataMeaningInstance
@@ -52,6 +56,12 @@ func (code *ConsentDataMeaning) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ConsentDataMeaning) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ConsentDataMeaning) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ConsentDataMeaning) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code ConsentDataMeaning) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ConsentDataMeaning) protoNumber() uint64 {
+	switch code {
+	case ConsentDataMeaningInstance:
+		return 1
+	case ConsentDataMeaningRelated:
+		return 2
+	case ConsentDataMeaningDependents:
+		return 3
+	case ConsentDataMeaningAuthoredby:
+		return 4
+	}
+	return 0
+}
+func (code *ConsentDataMeaning) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ConsentDataMeaningInstance
+	case 2:
+		*code = ConsentDataMeaningRelated
+	case 3:
+		*code = ConsentDataMeaningDependents
+	case 4:
+		*code = ConsentDataMeaningAuthoredby
+	default:
+		return false
+	}
+	return true
+}
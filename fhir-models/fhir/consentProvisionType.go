@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code ConsentProvisionType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ConsentProvisionType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ConsentProvisionType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "deny":
 		*code = ConsentProvisionTypeDeny
@@ -46,6 +50,12 @@ func (code *ConsentProvisionType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ConsentProvisionType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ConsentProvisionType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ConsentProvisionType) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code ConsentProvisionType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ConsentProvisionType) protoNumber() uint64 {
+	switch code {
+	case ConsentProvisionTypeDeny:
+		return 1
+	case ConsentProvisionTypePermit:
+		return 2
+	}
+	return 0
+}
+func (code *ConsentProvisionType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ConsentProvisionTypeDeny
+	case 2:
+		*code = ConsentProvisionTypePermit
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code ConsentState) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ConsentState) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ConsentState) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "draft":
 		*code = ConsentStateDraft
@@ -58,6 +62,12 @@ func (code *ConsentState) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ConsentState) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ConsentState) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ConsentState) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code ConsentState) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ConsentState) protoNumber() uint64 {
+	switch code {
+	case ConsentStateDraft:
+		return 1
+	case ConsentStateProposed:
+		return 2
+	case ConsentStateActive:
+		return 3
+	case ConsentStateRejected:
+		return 4
+	case ConsentStateInactive:
+		return 5
+	case ConsentStateEnteredInError:
+		return 6
+	}
+	return 0
+}
+func (code *ConsentState) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ConsentStateDraft
+	case 2:
+		*code = ConsentStateProposed
+	case 3:
+		*code = ConsentStateActive
+	case 4:
+		*code = ConsentStateRejected
+	case 5:
+		*code = ConsentStateInactive
+	case 6:
+		*code = ConsentStateEnteredInError
+	default:
+		return false
+	}
+	return true
+}
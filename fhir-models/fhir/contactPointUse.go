@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code ContactPointUse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ContactPointUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ContactPointUse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "home":
 		*code = ContactPointUseHome
@@ -55,6 +59,12 @@ func (code *ContactPointUse) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ContactPointUse) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ContactPointUse) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ContactPointUse) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code ContactPointUse) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ContactPointUse) protoNumber() uint64 {
+	switch code {
+	case ContactPointUseHome:
+		return 1
+	case ContactPointUseWork:
+		return 2
+	case ContactPointUseTemp:
+		return 3
+	case ContactPointUseOld:
+		return 4
+	case ContactPointUseMobile:
+		return 5
+	}
+	return 0
+}
+func (code *ContactPointUse) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ContactPointUseHome
+	case 2:
+		*code = ContactPointUseWork
+	case 3:
+		*code = ContactPointUseTemp
+	case 4:
+		*code = ContactPointUseOld
+	case 5:
+		*code = ContactPointUseMobile
+	default:
+		return false
+	}
+	return true
+}
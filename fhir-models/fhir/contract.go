@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,21 +25,30 @@ import "encoding/json"
 // Contract is documented here http://hl7.org/fhir/StructureDefinition/Contract
 type Contract struct {
 	Id                       *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                *Element                     `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                     *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules            *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                 *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                     *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                []json.RawMessage            `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier               []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Url                      *string                      `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement               *Element                     `bson:"_url,omitempty" json:"_url,omitempty"`
 	Version                  *string                      `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement           *Element                     `bson:"_version,omitempty" json:"_version,omitempty"`
 	Status                   *ContractResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement            *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
 	LegalState               *CodeableConcept             `bson:"legalState,omitempty" json:"legalState,omitempty"`
 	InstantiatesCanonical    *Reference                   `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
 	InstantiatesUri          *string                      `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement   *Element                     `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
 	ContentDerivative        *CodeableConcept             `bson:"contentDerivative,omitempty" json:"contentDerivative,omitempty"`
 	Issued                   *string                      `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement            *Element                     `bson:"_issued,omitempty" json:"_issued,omitempty"`
 	Applies                  *Period                      `bson:"applies,omitempty" json:"applies,omitempty"`
 	ExpirationType           *CodeableConcept             `bson:"expirationType,omitempty" json:"expirationType,omitempty"`
 	Subject                  []Reference                  `bson:"subject,omitempty" json:"subject,omitempty"`
@@ -44,9 +56,13 @@ type Contract struct {
 	Domain                   []Reference                  `bson:"domain,omitempty" json:"domain,omitempty"`
 	Site                     []Reference                  `bson:"site,omitempty" json:"site,omitempty"`
 	Name                     *string                      `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement              *Element                     `bson:"_name,omitempty" json:"_name,omitempty"`
 	Title                    *string                      `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement             *Element                     `bson:"_title,omitempty" json:"_title,omitempty"`
 	Subtitle                 *string                      `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement          *Element                     `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
 	Alias                    []string                     `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement             []*Element                   `bson:"_alias,omitempty" json:"_alias,omitempty"`
 	Author                   *Reference                   `bson:"author,omitempty" json:"author,omitempty"`
 	Scope                    *CodeableConcept             `bson:"scope,omitempty" json:"scope,omitempty"`
 	TopicCodeableConcept     *CodeableConcept             `bson:"topicCodeableConcept,omitempty" json:"topicCodeableConcept,omitempty"`
@@ -64,16 +80,55 @@ type Contract struct {
 	LegallyBindingAttachment *Attachment                  `bson:"legallyBindingAttachment,omitempty" json:"legallyBindingAttachment,omitempty"`
 	LegallyBindingReference  *Reference                   `bson:"legallyBindingReference,omitempty" json:"legallyBindingReference,omitempty"`
 }
+
+// ContractTopic is implemented by the types allowed for Contract.topic[x]
+type ContractTopic interface {
+	isContractTopic()
+}
+
+func (CodeableConcept) isContractTopic() {}
+func (Reference) isContractTopic()       {}
+
+// Topic returns the value of Contract.topic[x] together with its FHIR type code or nil if no value is set
+func (r Contract) Topic() (ContractTopic, string) {
+	if r.TopicCodeableConcept != nil {
+		return *r.TopicCodeableConcept, "CodeableConcept"
+	}
+	if r.TopicReference != nil {
+		return *r.TopicReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetTopic sets the value of Contract.topic[x], given as value or pointer, and clears all other types
+func (r *Contract) SetTopic(value ContractTopic) {
+	r.TopicCodeableConcept = nil
+	r.TopicReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.TopicCodeableConcept = &v
+	case *CodeableConcept:
+		r.TopicCodeableConcept = v
+	case Reference:
+		r.TopicReference = &v
+	case *Reference:
+		r.TopicReference = v
+	}
+}
+
 type ContractContentDefinition struct {
-	Id                *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept                        `bson:"type" json:"type"`
-	SubType           *CodeableConcept                       `bson:"subType,omitempty" json:"subType,omitempty"`
-	Publisher         *Reference                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	PublicationDate   *string                                `bson:"publicationDate,omitempty" json:"publicationDate,omitempty"`
-	PublicationStatus ContractResourcePublicationStatusCodes `bson:"publicationStatus" json:"publicationStatus"`
-	Copyright         *string                                `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	Id                       *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     CodeableConcept                        `bson:"type" json:"type"`
+	SubType                  *CodeableConcept                       `bson:"subType,omitempty" json:"subType,omitempty"`
+	Publisher                *Reference                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublicationDate          *string                                `bson:"publicationDate,omitempty" json:"publicationDate,omitempty"`
+	PublicationDateElement   *Element                               `bson:"_publicationDate,omitempty" json:"_publicationDate,omitempty"`
+	PublicationStatus        ContractResourcePublicationStatusCodes `bson:"publicationStatus" json:"publicationStatus"`
+	PublicationStatusElement *Element                               `bson:"_publicationStatus,omitempty" json:"_publicationStatus,omitempty"`
+	Copyright                *string                                `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement         *Element                               `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
 }
 type ContractTerm struct {
 	Id                   *string                     `bson:"id,omitempty" json:"id,omitempty"`
@@ -81,41 +136,83 @@ type ContractTerm struct {
 	ModifierExtension    []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           *Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Issued               *string                     `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement        *Element                    `bson:"_issued,omitempty" json:"_issued,omitempty"`
 	Applies              *Period                     `bson:"applies,omitempty" json:"applies,omitempty"`
 	TopicCodeableConcept *CodeableConcept            `bson:"topicCodeableConcept,omitempty" json:"topicCodeableConcept,omitempty"`
 	TopicReference       *Reference                  `bson:"topicReference,omitempty" json:"topicReference,omitempty"`
 	Type                 *CodeableConcept            `bson:"type,omitempty" json:"type,omitempty"`
 	SubType              *CodeableConcept            `bson:"subType,omitempty" json:"subType,omitempty"`
 	Text                 *string                     `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement          *Element                    `bson:"_text,omitempty" json:"_text,omitempty"`
 	SecurityLabel        []ContractTermSecurityLabel `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
 	Offer                ContractTermOffer           `bson:"offer" json:"offer"`
 	Asset                []ContractTermAsset         `bson:"asset,omitempty" json:"asset,omitempty"`
 	Action               []ContractTermAction        `bson:"action,omitempty" json:"action,omitempty"`
 	Group                []ContractTerm              `bson:"group,omitempty" json:"group,omitempty"`
 }
+
+// ContractTermTopic is implemented by the types allowed for Contract.term.topic[x]
+type ContractTermTopic interface {
+	isContractTermTopic()
+}
+
+func (CodeableConcept) isContractTermTopic() {}
+func (Reference) isContractTermTopic()       {}
+
+// Topic returns the value of Contract.term.topic[x] together with its FHIR type code or nil if no value is set
+func (r ContractTerm) Topic() (ContractTermTopic, string) {
+	if r.TopicCodeableConcept != nil {
+		return *r.TopicCodeableConcept, "CodeableConcept"
+	}
+	if r.TopicReference != nil {
+		return *r.TopicReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetTopic sets the value of Contract.term.topic[x], given as value or pointer, and clears all other types
+func (r *ContractTerm) SetTopic(value ContractTermTopic) {
+	r.TopicCodeableConcept = nil
+	r.TopicReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.TopicCodeableConcept = &v
+	case *CodeableConcept:
+		r.TopicCodeableConcept = v
+	case Reference:
+		r.TopicReference = &v
+	case *Reference:
+		r.TopicReference = v
+	}
+}
+
 type ContractTermSecurityLabel struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Number            []int       `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement     []*Element  `bson:"_number,omitempty" json:"_number,omitempty"`
 	Classification    Coding      `bson:"classification" json:"classification"`
 	Category          []Coding    `bson:"category,omitempty" json:"category,omitempty"`
 	Control           []Coding    `bson:"control,omitempty" json:"control,omitempty"`
 }
 type ContractTermOffer struct {
-	Id                  *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Party               []ContractTermOfferParty  `bson:"party,omitempty" json:"party,omitempty"`
-	Topic               *Reference                `bson:"topic,omitempty" json:"topic,omitempty"`
-	Type                *CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
-	Decision            *CodeableConcept          `bson:"decision,omitempty" json:"decision,omitempty"`
-	DecisionMode        []CodeableConcept         `bson:"decisionMode,omitempty" json:"decisionMode,omitempty"`
-	Answer              []ContractTermOfferAnswer `bson:"answer,omitempty" json:"answer,omitempty"`
-	Text                *string                   `bson:"text,omitempty" json:"text,omitempty"`
-	LinkId              []string                  `bson:"linkId,omitempty" json:"linkId,omitempty"`
-	SecurityLabelNumber []int                     `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	Id                         *string                   `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                 []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Party                      []ContractTermOfferParty  `bson:"party,omitempty" json:"party,omitempty"`
+	Topic                      *Reference                `bson:"topic,omitempty" json:"topic,omitempty"`
+	Type                       *CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Decision                   *CodeableConcept          `bson:"decision,omitempty" json:"decision,omitempty"`
+	DecisionMode               []CodeableConcept         `bson:"decisionMode,omitempty" json:"decisionMode,omitempty"`
+	Answer                     []ContractTermOfferAnswer `bson:"answer,omitempty" json:"answer,omitempty"`
+	Text                       *string                   `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement                *Element                  `bson:"_text,omitempty" json:"_text,omitempty"`
+	LinkId                     []string                  `bson:"linkId,omitempty" json:"linkId,omitempty"`
+	LinkIdElement              []*Element                `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	SecurityLabelNumber        []int                     `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*Element                `bson:"_securityLabelNumber,omitempty" json:"_securityLabelNumber,omitempty"`
 }
 type ContractTermOfferParty struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
@@ -125,41 +222,187 @@ type ContractTermOfferParty struct {
 	Role              CodeableConcept `bson:"role" json:"role"`
 }
 type ContractTermOfferAnswer struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ValueBoolean      bool        `bson:"valueBoolean" json:"valueBoolean"`
-	ValueDecimal      json.Number `bson:"valueDecimal" json:"valueDecimal"`
-	ValueInteger      int         `bson:"valueInteger" json:"valueInteger"`
-	ValueDate         string      `bson:"valueDate" json:"valueDate"`
-	ValueDateTime     string      `bson:"valueDateTime" json:"valueDateTime"`
-	ValueTime         string      `bson:"valueTime" json:"valueTime"`
-	ValueString       string      `bson:"valueString" json:"valueString"`
-	ValueUri          string      `bson:"valueUri" json:"valueUri"`
-	ValueAttachment   Attachment  `bson:"valueAttachment" json:"valueAttachment"`
-	ValueCoding       Coding      `bson:"valueCoding" json:"valueCoding"`
-	ValueQuantity     Quantity    `bson:"valueQuantity" json:"valueQuantity"`
-	ValueReference    Reference   `bson:"valueReference" json:"valueReference"`
+	Id                   *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ValueBoolean         *bool        `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element     `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueDecimal         *json.Number `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *Element     `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueInteger         *int         `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element     `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDate            *string      `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement     *Element     `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime        *string      `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element     `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueTime            *string      `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement     *Element     `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueString          *string      `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element     `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueUri             *string      `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement      *Element     `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment  `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCoding          *Coding      `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueQuantity        *Quantity    `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueReference       *Reference   `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+}
+
+// ContractTermOfferAnswerValue is implemented by the types allowed for Contract.term.offer.answer.value[x]
+type ContractTermOfferAnswerValue interface {
+	isContractTermOfferAnswerValue()
+}
+
+func (Boolean) isContractTermOfferAnswerValue()    {}
+func (Decimal) isContractTermOfferAnswerValue()    {}
+func (Integer) isContractTermOfferAnswerValue()    {}
+func (Date) isContractTermOfferAnswerValue()       {}
+func (DateTime) isContractTermOfferAnswerValue()   {}
+func (Time) isContractTermOfferAnswerValue()       {}
+func (String) isContractTermOfferAnswerValue()     {}
+func (Uri) isContractTermOfferAnswerValue()        {}
+func (Attachment) isContractTermOfferAnswerValue() {}
+func (Coding) isContractTermOfferAnswerValue()     {}
+func (Quantity) isContractTermOfferAnswerValue()   {}
+func (Reference) isContractTermOfferAnswerValue()  {}
+
+// Value returns the value of Contract.term.offer.answer.value[x] together with its FHIR type code or nil if no value is set
+func (r ContractTermOfferAnswer) Value() (ContractTermOfferAnswerValue, string) {
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueDecimal != nil {
+		return Decimal(*r.ValueDecimal), "decimal"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueDate != nil {
+		return Date(*r.ValueDate), "date"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValueTime != nil {
+		return Time(*r.ValueTime), "time"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueUri != nil {
+		return Uri(*r.ValueUri), "uri"
+	}
+	if r.ValueAttachment != nil {
+		return *r.ValueAttachment, "Attachment"
+	}
+	if r.ValueCoding != nil {
+		return *r.ValueCoding, "Coding"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Contract.term.offer.answer.value[x], given as value or pointer, and clears all other types
+func (r *ContractTermOfferAnswer) SetValue(value ContractTermOfferAnswerValue) {
+	r.ValueBoolean = nil
+	r.ValueDecimal = nil
+	r.ValueInteger = nil
+	r.ValueDate = nil
+	r.ValueDateTime = nil
+	r.ValueTime = nil
+	r.ValueString = nil
+	r.ValueUri = nil
+	r.ValueAttachment = nil
+	r.ValueCoding = nil
+	r.ValueQuantity = nil
+	r.ValueReference = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.ValueDecimal = &primitive
+	case *Decimal:
+		r.ValueDecimal = (*json.Number)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Date:
+		primitive := string(v)
+		r.ValueDate = &primitive
+	case *Date:
+		r.ValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.ValueTime = &primitive
+	case *Time:
+		r.ValueTime = (*string)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Uri:
+		primitive := string(v)
+		r.ValueUri = &primitive
+	case *Uri:
+		r.ValueUri = (*string)(v)
+	case Attachment:
+		r.ValueAttachment = &v
+	case *Attachment:
+		r.ValueAttachment = v
+	case Coding:
+		r.ValueCoding = &v
+	case *Coding:
+		r.ValueCoding = v
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	}
 }
+
 type ContractTermAsset struct {
-	Id                  *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Scope               *CodeableConcept              `bson:"scope,omitempty" json:"scope,omitempty"`
-	Type                []CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
-	TypeReference       []Reference                   `bson:"typeReference,omitempty" json:"typeReference,omitempty"`
-	Subtype             []CodeableConcept             `bson:"subtype,omitempty" json:"subtype,omitempty"`
-	Relationship        *Coding                       `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Context             []ContractTermAssetContext    `bson:"context,omitempty" json:"context,omitempty"`
-	Condition           *string                       `bson:"condition,omitempty" json:"condition,omitempty"`
-	PeriodType          []CodeableConcept             `bson:"periodType,omitempty" json:"periodType,omitempty"`
-	Period              []Period                      `bson:"period,omitempty" json:"period,omitempty"`
-	UsePeriod           []Period                      `bson:"usePeriod,omitempty" json:"usePeriod,omitempty"`
-	Text                *string                       `bson:"text,omitempty" json:"text,omitempty"`
-	LinkId              []string                      `bson:"linkId,omitempty" json:"linkId,omitempty"`
-	Answer              []ContractTermOfferAnswer     `bson:"answer,omitempty" json:"answer,omitempty"`
-	SecurityLabelNumber []int                         `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
-	ValuedItem          []ContractTermAssetValuedItem `bson:"valuedItem,omitempty" json:"valuedItem,omitempty"`
+	Id                         *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Scope                      *CodeableConcept              `bson:"scope,omitempty" json:"scope,omitempty"`
+	Type                       []CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
+	TypeReference              []Reference                   `bson:"typeReference,omitempty" json:"typeReference,omitempty"`
+	Subtype                    []CodeableConcept             `bson:"subtype,omitempty" json:"subtype,omitempty"`
+	Relationship               *Coding                       `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Context                    []ContractTermAssetContext    `bson:"context,omitempty" json:"context,omitempty"`
+	Condition                  *string                       `bson:"condition,omitempty" json:"condition,omitempty"`
+	ConditionElement           *Element                      `bson:"_condition,omitempty" json:"_condition,omitempty"`
+	PeriodType                 []CodeableConcept             `bson:"periodType,omitempty" json:"periodType,omitempty"`
+	Period                     []Period                      `bson:"period,omitempty" json:"period,omitempty"`
+	UsePeriod                  []Period                      `bson:"usePeriod,omitempty" json:"usePeriod,omitempty"`
+	Text                       *string                       `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement                *Element                      `bson:"_text,omitempty" json:"_text,omitempty"`
+	LinkId                     []string                      `bson:"linkId,omitempty" json:"linkId,omitempty"`
+	LinkIdElement              []*Element                    `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	Answer                     []ContractTermOfferAnswer     `bson:"answer,omitempty" json:"answer,omitempty"`
+	SecurityLabelNumber        []int                         `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*Element                    `bson:"_securityLabelNumber,omitempty" json:"_securityLabelNumber,omitempty"`
+	ValuedItem                 []ContractTermAssetValuedItem `bson:"valuedItem,omitempty" json:"valuedItem,omitempty"`
 }
 type ContractTermAssetContext struct {
 	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
@@ -168,54 +411,107 @@ type ContractTermAssetContext struct {
 	Reference         *Reference        `bson:"reference,omitempty" json:"reference,omitempty"`
 	Code              []CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
 	Text              *string           `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element          `bson:"_text,omitempty" json:"_text,omitempty"`
 }
 type ContractTermAssetValuedItem struct {
-	Id                    *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension             []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	EntityCodeableConcept *CodeableConcept `bson:"entityCodeableConcept,omitempty" json:"entityCodeableConcept,omitempty"`
-	EntityReference       *Reference       `bson:"entityReference,omitempty" json:"entityReference,omitempty"`
-	Identifier            *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	EffectiveTime         *string          `bson:"effectiveTime,omitempty" json:"effectiveTime,omitempty"`
-	Quantity              *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice             *Money           `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor                *json.Number     `bson:"factor,omitempty" json:"factor,omitempty"`
-	Points                *json.Number     `bson:"points,omitempty" json:"points,omitempty"`
-	Net                   *Money           `bson:"net,omitempty" json:"net,omitempty"`
-	Payment               *string          `bson:"payment,omitempty" json:"payment,omitempty"`
-	PaymentDate           *string          `bson:"paymentDate,omitempty" json:"paymentDate,omitempty"`
-	Responsible           *Reference       `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Recipient             *Reference       `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	LinkId                []string         `bson:"linkId,omitempty" json:"linkId,omitempty"`
-	SecurityLabelNumber   []int            `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	Id                         *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	EntityCodeableConcept      *CodeableConcept `bson:"entityCodeableConcept,omitempty" json:"entityCodeableConcept,omitempty"`
+	EntityReference            *Reference       `bson:"entityReference,omitempty" json:"entityReference,omitempty"`
+	Identifier                 *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	EffectiveTime              *string          `bson:"effectiveTime,omitempty" json:"effectiveTime,omitempty"`
+	EffectiveTimeElement       *Element         `bson:"_effectiveTime,omitempty" json:"_effectiveTime,omitempty"`
+	Quantity                   *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                  *Money           `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                     *json.Number     `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement              *Element         `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Points                     *json.Number     `bson:"points,omitempty" json:"points,omitempty"`
+	PointsElement              *Element         `bson:"_points,omitempty" json:"_points,omitempty"`
+	Net                        *Money           `bson:"net,omitempty" json:"net,omitempty"`
+	Payment                    *string          `bson:"payment,omitempty" json:"payment,omitempty"`
+	PaymentElement             *Element         `bson:"_payment,omitempty" json:"_payment,omitempty"`
+	PaymentDate                *string          `bson:"paymentDate,omitempty" json:"paymentDate,omitempty"`
+	PaymentDateElement         *Element         `bson:"_paymentDate,omitempty" json:"_paymentDate,omitempty"`
+	Responsible                *Reference       `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	Recipient                  *Reference       `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	LinkId                     []string         `bson:"linkId,omitempty" json:"linkId,omitempty"`
+	LinkIdElement              []*Element       `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	SecurityLabelNumber        []int            `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*Element       `bson:"_securityLabelNumber,omitempty" json:"_securityLabelNumber,omitempty"`
+}
+
+// ContractTermAssetValuedItemEntity is implemented by the types allowed for Contract.term.asset.valuedItem.entity[x]
+type ContractTermAssetValuedItemEntity interface {
+	isContractTermAssetValuedItemEntity()
 }
+
+func (CodeableConcept) isContractTermAssetValuedItemEntity() {}
+func (Reference) isContractTermAssetValuedItemEntity()       {}
+
+// Entity returns the value of Contract.term.asset.valuedItem.entity[x] together with its FHIR type code or nil if no value is set
+func (r ContractTermAssetValuedItem) Entity() (ContractTermAssetValuedItemEntity, string) {
+	if r.EntityCodeableConcept != nil {
+		return *r.EntityCodeableConcept, "CodeableConcept"
+	}
+	if r.EntityReference != nil {
+		return *r.EntityReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetEntity sets the value of Contract.term.asset.valuedItem.entity[x], given as value or pointer, and clears all other types
+func (r *ContractTermAssetValuedItem) SetEntity(value ContractTermAssetValuedItemEntity) {
+	r.EntityCodeableConcept = nil
+	r.EntityReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.EntityCodeableConcept = &v
+	case *CodeableConcept:
+		r.EntityCodeableConcept = v
+	case Reference:
+		r.EntityReference = &v
+	case *Reference:
+		r.EntityReference = v
+	}
+}
+
 type ContractTermAction struct {
-	Id                  *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DoNotPerform        *bool                       `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	Type                CodeableConcept             `bson:"type" json:"type"`
-	Subject             []ContractTermActionSubject `bson:"subject,omitempty" json:"subject,omitempty"`
-	Intent              CodeableConcept             `bson:"intent" json:"intent"`
-	LinkId              []string                    `bson:"linkId,omitempty" json:"linkId,omitempty"`
-	Status              CodeableConcept             `bson:"status" json:"status"`
-	Context             *Reference                  `bson:"context,omitempty" json:"context,omitempty"`
-	ContextLinkId       []string                    `bson:"contextLinkId,omitempty" json:"contextLinkId,omitempty"`
-	OccurrenceDateTime  *string                     `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod    *Period                     `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming    *Timing                     `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
-	Requester           []Reference                 `bson:"requester,omitempty" json:"requester,omitempty"`
-	RequesterLinkId     []string                    `bson:"requesterLinkId,omitempty" json:"requesterLinkId,omitempty"`
-	PerformerType       []CodeableConcept           `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	PerformerRole       *CodeableConcept            `bson:"performerRole,omitempty" json:"performerRole,omitempty"`
-	Performer           *Reference                  `bson:"performer,omitempty" json:"performer,omitempty"`
-	PerformerLinkId     []string                    `bson:"performerLinkId,omitempty" json:"performerLinkId,omitempty"`
-	ReasonCode          []CodeableConcept           `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference     []Reference                 `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Reason              []string                    `bson:"reason,omitempty" json:"reason,omitempty"`
-	ReasonLinkId        []string                    `bson:"reasonLinkId,omitempty" json:"reasonLinkId,omitempty"`
-	Note                []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
-	SecurityLabelNumber []int                       `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	Id                         *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DoNotPerform               *bool                       `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement        *Element                    `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	Type                       CodeableConcept             `bson:"type" json:"type"`
+	Subject                    []ContractTermActionSubject `bson:"subject,omitempty" json:"subject,omitempty"`
+	Intent                     CodeableConcept             `bson:"intent" json:"intent"`
+	LinkId                     []string                    `bson:"linkId,omitempty" json:"linkId,omitempty"`
+	LinkIdElement              []*Element                  `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	Status                     CodeableConcept             `bson:"status" json:"status"`
+	Context                    *Reference                  `bson:"context,omitempty" json:"context,omitempty"`
+	ContextLinkId              []string                    `bson:"contextLinkId,omitempty" json:"contextLinkId,omitempty"`
+	ContextLinkIdElement       []*Element                  `bson:"_contextLinkId,omitempty" json:"_contextLinkId,omitempty"`
+	OccurrenceDateTime         *string                     `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement  *Element                    `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod           *Period                     `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming           *Timing                     `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	Requester                  []Reference                 `bson:"requester,omitempty" json:"requester,omitempty"`
+	RequesterLinkId            []string                    `bson:"requesterLinkId,omitempty" json:"requesterLinkId,omitempty"`
+	RequesterLinkIdElement     []*Element                  `bson:"_requesterLinkId,omitempty" json:"_requesterLinkId,omitempty"`
+	PerformerType              []CodeableConcept           `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	PerformerRole              *CodeableConcept            `bson:"performerRole,omitempty" json:"performerRole,omitempty"`
+	Performer                  *Reference                  `bson:"performer,omitempty" json:"performer,omitempty"`
+	PerformerLinkId            []string                    `bson:"performerLinkId,omitempty" json:"performerLinkId,omitempty"`
+	PerformerLinkIdElement     []*Element                  `bson:"_performerLinkId,omitempty" json:"_performerLinkId,omitempty"`
+	ReasonCode                 []CodeableConcept           `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference            []Reference                 `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Reason                     []string                    `bson:"reason,omitempty" json:"reason,omitempty"`
+	ReasonElement              []*Element                  `bson:"_reason,omitempty" json:"_reason,omitempty"`
+	ReasonLinkId               []string                    `bson:"reasonLinkId,omitempty" json:"reasonLinkId,omitempty"`
+	ReasonLinkIdElement        []*Element                  `bson:"_reasonLinkId,omitempty" json:"_reasonLinkId,omitempty"`
+	Note                       []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
+	SecurityLabelNumber        []int                       `bson:"securityLabelNumber,omitempty" json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*Element                  `bson:"_securityLabelNumber,omitempty" json:"_securityLabelNumber,omitempty"`
 }
 type ContractTermActionSubject struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -224,6 +520,52 @@ type ContractTermActionSubject struct {
 	Reference         []Reference      `bson:"reference" json:"reference"`
 	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
 }
+
+// ContractTermActionOccurrence is implemented by the types allowed for Contract.term.action.occurrence[x]
+type ContractTermActionOccurrence interface {
+	isContractTermActionOccurrence()
+}
+
+func (DateTime) isContractTermActionOccurrence() {}
+func (Period) isContractTermActionOccurrence()   {}
+func (Timing) isContractTermActionOccurrence()   {}
+
+// Occurrence returns the value of Contract.term.action.occurrence[x] together with its FHIR type code or nil if no value is set
+func (r ContractTermAction) Occurrence() (ContractTermActionOccurrence, string) {
+	if r.OccurrenceDateTime != nil {
+		return DateTime(*r.OccurrenceDateTime), "dateTime"
+	}
+	if r.OccurrencePeriod != nil {
+		return *r.OccurrencePeriod, "Period"
+	}
+	if r.OccurrenceTiming != nil {
+		return *r.OccurrenceTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetOccurrence sets the value of Contract.term.action.occurrence[x], given as value or pointer, and clears all other types
+func (r *ContractTermAction) SetOccurrence(value ContractTermActionOccurrence) {
+	r.OccurrenceDateTime = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OccurrenceDateTime = &primitive
+	case *DateTime:
+		r.OccurrenceDateTime = (*string)(v)
+	case Period:
+		r.OccurrencePeriod = &v
+	case *Period:
+		r.OccurrencePeriod = v
+	case Timing:
+		r.OccurrenceTiming = &v
+	case *Timing:
+		r.OccurrenceTiming = v
+	}
+}
+
 type ContractSigner struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -236,40 +578,13318 @@ type ContractFriendly struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ContentAttachment Attachment  `bson:"contentAttachment" json:"contentAttachment"`
-	ContentReference  Reference   `bson:"contentReference" json:"contentReference"`
+	ContentAttachment *Attachment `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference  *Reference  `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+}
+
+// ContractFriendlyContent is implemented by the types allowed for Contract.friendly.content[x]
+type ContractFriendlyContent interface {
+	isContractFriendlyContent()
+}
+
+func (Attachment) isContractFriendlyContent() {}
+func (Reference) isContractFriendlyContent()  {}
+
+// Content returns the value of Contract.friendly.content[x] together with its FHIR type code or nil if no value is set
+func (r ContractFriendly) Content() (ContractFriendlyContent, string) {
+	if r.ContentAttachment != nil {
+		return *r.ContentAttachment, "Attachment"
+	}
+	if r.ContentReference != nil {
+		return *r.ContentReference, "Reference"
+	}
+	return nil, ""
 }
+
+// SetContent sets the value of Contract.friendly.content[x], given as value or pointer, and clears all other types
+func (r *ContractFriendly) SetContent(value ContractFriendlyContent) {
+	r.ContentAttachment = nil
+	r.ContentReference = nil
+	switch v := value.(type) {
+	case Attachment:
+		r.ContentAttachment = &v
+	case *Attachment:
+		r.ContentAttachment = v
+	case Reference:
+		r.ContentReference = &v
+	case *Reference:
+		r.ContentReference = v
+	}
+}
+
 type ContractLegal struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ContentAttachment Attachment  `bson:"contentAttachment" json:"contentAttachment"`
-	ContentReference  Reference   `bson:"contentReference" json:"contentReference"`
+	ContentAttachment *Attachment `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference  *Reference  `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
 }
+
+// ContractLegalContent is implemented by the types allowed for Contract.legal.content[x]
+type ContractLegalContent interface {
+	isContractLegalContent()
+}
+
+func (Attachment) isContractLegalContent() {}
+func (Reference) isContractLegalContent()  {}
+
+// Content returns the value of Contract.legal.content[x] together with its FHIR type code or nil if no value is set
+func (r ContractLegal) Content() (ContractLegalContent, string) {
+	if r.ContentAttachment != nil {
+		return *r.ContentAttachment, "Attachment"
+	}
+	if r.ContentReference != nil {
+		return *r.ContentReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetContent sets the value of Contract.legal.content[x], given as value or pointer, and clears all other types
+func (r *ContractLegal) SetContent(value ContractLegalContent) {
+	r.ContentAttachment = nil
+	r.ContentReference = nil
+	switch v := value.(type) {
+	case Attachment:
+		r.ContentAttachment = &v
+	case *Attachment:
+		r.ContentAttachment = v
+	case Reference:
+		r.ContentReference = &v
+	case *Reference:
+		r.ContentReference = v
+	}
+}
+
 type ContractRule struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ContentAttachment Attachment  `bson:"contentAttachment" json:"contentAttachment"`
-	ContentReference  Reference   `bson:"contentReference" json:"contentReference"`
+	ContentAttachment *Attachment `bson:"contentAttachment,omitempty" json:"contentAttachment,omitempty"`
+	ContentReference  *Reference  `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+}
+
+// ContractRuleContent is implemented by the types allowed for Contract.rule.content[x]
+type ContractRuleContent interface {
+	isContractRuleContent()
+}
+
+func (Attachment) isContractRuleContent() {}
+func (Reference) isContractRuleContent()  {}
+
+// Content returns the value of Contract.rule.content[x] together with its FHIR type code or nil if no value is set
+func (r ContractRule) Content() (ContractRuleContent, string) {
+	if r.ContentAttachment != nil {
+		return *r.ContentAttachment, "Attachment"
+	}
+	if r.ContentReference != nil {
+		return *r.ContentReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetContent sets the value of Contract.rule.content[x], given as value or pointer, and clears all other types
+func (r *ContractRule) SetContent(value ContractRuleContent) {
+	r.ContentAttachment = nil
+	r.ContentReference = nil
+	switch v := value.(type) {
+	case Attachment:
+		r.ContentAttachment = &v
+	case *Attachment:
+		r.ContentAttachment = v
+	case Reference:
+		r.ContentReference = &v
+	case *Reference:
+		r.ContentReference = v
+	}
+}
+
+// ContractLegallyBinding is implemented by the types allowed for Contract.legallyBinding[x]
+type ContractLegallyBinding interface {
+	isContractLegallyBinding()
+}
+
+func (Attachment) isContractLegallyBinding() {}
+func (Reference) isContractLegallyBinding()  {}
+
+// LegallyBinding returns the value of Contract.legallyBinding[x] together with its FHIR type code or nil if no value is set
+func (r Contract) LegallyBinding() (ContractLegallyBinding, string) {
+	if r.LegallyBindingAttachment != nil {
+		return *r.LegallyBindingAttachment, "Attachment"
+	}
+	if r.LegallyBindingReference != nil {
+		return *r.LegallyBindingReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetLegallyBinding sets the value of Contract.legallyBinding[x], given as value or pointer, and clears all other types
+func (r *Contract) SetLegallyBinding(value ContractLegallyBinding) {
+	r.LegallyBindingAttachment = nil
+	r.LegallyBindingReference = nil
+	switch v := value.(type) {
+	case Attachment:
+		r.LegallyBindingAttachment = &v
+	case *Attachment:
+		r.LegallyBindingAttachment = v
+	case Reference:
+		r.LegallyBindingReference = &v
+	case *Reference:
+		r.LegallyBindingReference = v
+	}
 }
-type OtherContract Contract
 
 // MarshalJSON marshals the given Contract as JSON into a byte slice
 func (r Contract) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherContract
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherContract: OtherContract(r),
-		ResourceType:  "Contract",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Contract as JSON object with the members in the order of the fields
+func (r Contract) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Contract\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.LegalState != nil {
+		e.key("legalState")
+		r.LegalState.appendJSON(e)
+	}
+	if r.InstantiatesCanonical != nil {
+		e.key("instantiatesCanonical")
+		r.InstantiatesCanonical.appendJSON(e)
+	}
+	if r.InstantiatesUri != nil {
+		e.key("instantiatesUri")
+		e.string(*r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		e.key("_instantiatesUri")
+		r.InstantiatesUriElement.appendJSON(e)
+	}
+	if r.ContentDerivative != nil {
+		e.key("contentDerivative")
+		r.ContentDerivative.appendJSON(e)
+	}
+	if r.Issued != nil {
+		e.key("issued")
+		e.string(*r.Issued)
+	}
+	if r.IssuedElement != nil {
+		e.key("_issued")
+		r.IssuedElement.appendJSON(e)
+	}
+	if r.Applies != nil {
+		e.key("applies")
+		r.Applies.appendJSON(e)
+	}
+	if r.ExpirationType != nil {
+		e.key("expirationType")
+		r.ExpirationType.appendJSON(e)
+	}
+	if len(r.Subject) > 0 {
+		e.key("subject")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subject {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Authority) > 0 {
+		e.key("authority")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Authority {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Domain) > 0 {
+		e.key("domain")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Domain {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Site) > 0 {
+		e.key("site")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Site {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Subtitle != nil {
+		e.key("subtitle")
+		e.string(*r.Subtitle)
+	}
+	if r.SubtitleElement != nil {
+		e.key("_subtitle")
+		r.SubtitleElement.appendJSON(e)
+	}
+	if len(r.Alias) > 0 {
+		e.key("alias")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Alias {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AliasElement) > 0 {
+		e.key("_alias")
+		e.elements(r.AliasElement, len(r.Alias))
+	}
+	if r.Author != nil {
+		e.key("author")
+		r.Author.appendJSON(e)
+	}
+	if r.Scope != nil {
+		e.key("scope")
+		r.Scope.appendJSON(e)
+	}
+	if r.TopicCodeableConcept != nil {
+		e.key("topicCodeableConcept")
+		r.TopicCodeableConcept.appendJSON(e)
+	}
+	if r.TopicReference != nil {
+		e.key("topicReference")
+		r.TopicReference.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.SubType) > 0 {
+		e.key("subType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubType {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ContentDefinition != nil {
+		e.key("contentDefinition")
+		r.ContentDefinition.appendJSON(e)
+	}
+	if len(r.Term) > 0 {
+		e.key("term")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Term {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInfo) > 0 {
+		e.key("supportingInfo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInfo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelevantHistory) > 0 {
+		e.key("relevantHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelevantHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Signer) > 0 {
+		e.key("signer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Signer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Friendly) > 0 {
+		e.key("friendly")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Friendly {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Legal) > 0 {
+		e.key("legal")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Legal {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Rule) > 0 {
+		e.key("rule")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Rule {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.LegallyBindingAttachment != nil {
+		e.key("legallyBindingAttachment")
+		r.LegallyBindingAttachment.appendJSON(e)
+	}
+	if r.LegallyBindingReference != nil {
+		e.key("legallyBindingReference")
+		r.LegallyBindingReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Contract from JSON. Unknown members are ignored.
+func (r *Contract) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Contract
+func (r *Contract) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v ContractResourceStatusCodes
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "legalState":
+			if d.null() {
+				r.LegalState = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LegalState = &v
+			}
+		case "instantiatesCanonical":
+			if d.null() {
+				r.InstantiatesCanonical = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.InstantiatesCanonical = &v
+			}
+		case "instantiatesUri":
+			if v, ok := d.string(); ok {
+				r.InstantiatesUri = &v
+			} else {
+				r.InstantiatesUri = nil
+			}
+		case "_instantiatesUri":
+			r.InstantiatesUriElement = d.element()
+		case "contentDerivative":
+			if d.null() {
+				r.ContentDerivative = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ContentDerivative = &v
+			}
+		case "issued":
+			if v, ok := d.string(); ok {
+				r.Issued = &v
+			} else {
+				r.Issued = nil
+			}
+		case "_issued":
+			r.IssuedElement = d.element()
+		case "applies":
+			if d.null() {
+				r.Applies = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Applies = &v
+			}
+		case "expirationType":
+			if d.null() {
+				r.ExpirationType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ExpirationType = &v
+			}
+		case "subject":
+			a := d.array()
+			r.Subject = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = append(r.Subject, v)
+			}
+			if r.Subject == nil && !a.isNull {
+				r.Subject = []Reference{}
+			}
+		case "authority":
+			a := d.array()
+			r.Authority = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Authority = append(r.Authority, v)
+			}
+			if r.Authority == nil && !a.isNull {
+				r.Authority = []Reference{}
+			}
+		case "domain":
+			a := d.array()
+			r.Domain = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Domain = append(r.Domain, v)
+			}
+			if r.Domain == nil && !a.isNull {
+				r.Domain = []Reference{}
+			}
+		case "site":
+			a := d.array()
+			r.Site = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Site = append(r.Site, v)
+			}
+			if r.Site == nil && !a.isNull {
+				r.Site = []Reference{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "subtitle":
+			if v, ok := d.string(); ok {
+				r.Subtitle = &v
+			} else {
+				r.Subtitle = nil
+			}
+		case "_subtitle":
+			r.SubtitleElement = d.element()
+		case "alias":
+			a := d.array()
+			r.Alias = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Alias = append(r.Alias, v)
+			}
+			if r.Alias == nil && !a.isNull {
+				r.Alias = []string{}
+			}
+		case "_alias":
+			a := d.array()
+			r.AliasElement = nil
+			for a.next() {
+				r.AliasElement = append(r.AliasElement, d.element())
+			}
+		case "author":
+			if d.null() {
+				r.Author = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = &v
+			}
+		case "scope":
+			if d.null() {
+				r.Scope = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Scope = &v
+			}
+		case "topicCodeableConcept":
+			if d.null() {
+				r.TopicCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.TopicCodeableConcept = &v
+			}
+		case "topicReference":
+			if d.null() {
+				r.TopicReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.TopicReference = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "subType":
+			a := d.array()
+			r.SubType = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubType = append(r.SubType, v)
+			}
+			if r.SubType == nil && !a.isNull {
+				r.SubType = []CodeableConcept{}
+			}
+		case "contentDefinition":
+			if d.null() {
+				r.ContentDefinition = nil
+			} else {
+				var v ContractContentDefinition
+				v.decodeJSON(d)
+				r.ContentDefinition = &v
+			}
+		case "term":
+			a := d.array()
+			r.Term = nil
+			for a.next() {
+				var v ContractTerm
+				v.decodeJSON(d)
+				r.Term = append(r.Term, v)
+			}
+			if r.Term == nil && !a.isNull {
+				r.Term = []ContractTerm{}
+			}
+		case "supportingInfo":
+			a := d.array()
+			r.SupportingInfo = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			}
+			if r.SupportingInfo == nil && !a.isNull {
+				r.SupportingInfo = []Reference{}
+			}
+		case "relevantHistory":
+			a := d.array()
+			r.RelevantHistory = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.RelevantHistory = append(r.RelevantHistory, v)
+			}
+			if r.RelevantHistory == nil && !a.isNull {
+				r.RelevantHistory = []Reference{}
+			}
+		case "signer":
+			a := d.array()
+			r.Signer = nil
+			for a.next() {
+				var v ContractSigner
+				v.decodeJSON(d)
+				r.Signer = append(r.Signer, v)
+			}
+			if r.Signer == nil && !a.isNull {
+				r.Signer = []ContractSigner{}
+			}
+		case "friendly":
+			a := d.array()
+			r.Friendly = nil
+			for a.next() {
+				var v ContractFriendly
+				v.decodeJSON(d)
+				r.Friendly = append(r.Friendly, v)
+			}
+			if r.Friendly == nil && !a.isNull {
+				r.Friendly = []ContractFriendly{}
+			}
+		case "legal":
+			a := d.array()
+			r.Legal = nil
+			for a.next() {
+				var v ContractLegal
+				v.decodeJSON(d)
+				r.Legal = append(r.Legal, v)
+			}
+			if r.Legal == nil && !a.isNull {
+				r.Legal = []ContractLegal{}
+			}
+		case "rule":
+			a := d.array()
+			r.Rule = nil
+			for a.next() {
+				var v ContractRule
+				v.decodeJSON(d)
+				r.Rule = append(r.Rule, v)
+			}
+			if r.Rule == nil && !a.isNull {
+				r.Rule = []ContractRule{}
+			}
+		case "legallyBindingAttachment":
+			if d.null() {
+				r.LegallyBindingAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.LegallyBindingAttachment = &v
+			}
+		case "legallyBindingReference":
+			if d.null() {
+				r.LegallyBindingReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.LegallyBindingReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Contract which shares no memory with the original
+func (r Contract) DeepCopy() Contract {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.LegalState != nil {
+		v := r.LegalState.DeepCopy()
+		out.LegalState = &v
+	}
+	if r.InstantiatesCanonical != nil {
+		v := r.InstantiatesCanonical.DeepCopy()
+		out.InstantiatesCanonical = &v
+	}
+	if r.InstantiatesUri != nil {
+		v := *r.InstantiatesUri
+		out.InstantiatesUri = &v
+	}
+	if r.InstantiatesUriElement != nil {
+		v := r.InstantiatesUriElement.DeepCopy()
+		out.InstantiatesUriElement = &v
+	}
+	if r.ContentDerivative != nil {
+		v := r.ContentDerivative.DeepCopy()
+		out.ContentDerivative = &v
+	}
+	if r.Issued != nil {
+		v := *r.Issued
+		out.Issued = &v
+	}
+	if r.IssuedElement != nil {
+		v := r.IssuedElement.DeepCopy()
+		out.IssuedElement = &v
+	}
+	if r.Applies != nil {
+		v := r.Applies.DeepCopy()
+		out.Applies = &v
+	}
+	if r.ExpirationType != nil {
+		v := r.ExpirationType.DeepCopy()
+		out.ExpirationType = &v
+	}
+	if r.Subject != nil {
+		out.Subject = make([]Reference, len(r.Subject))
+		for i := range r.Subject {
+			out.Subject[i] = r.Subject[i].DeepCopy()
+		}
+	}
+	if r.Authority != nil {
+		out.Authority = make([]Reference, len(r.Authority))
+		for i := range r.Authority {
+			out.Authority[i] = r.Authority[i].DeepCopy()
+		}
+	}
+	if r.Domain != nil {
+		out.Domain = make([]Reference, len(r.Domain))
+		for i := range r.Domain {
+			out.Domain[i] = r.Domain[i].DeepCopy()
+		}
+	}
+	if r.Site != nil {
+		out.Site = make([]Reference, len(r.Site))
+		for i := range r.Site {
+			out.Site[i] = r.Site[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Subtitle != nil {
+		v := *r.Subtitle
+		out.Subtitle = &v
+	}
+	if r.SubtitleElement != nil {
+		v := r.SubtitleElement.DeepCopy()
+		out.SubtitleElement = &v
+	}
+	if r.Alias != nil {
+		out.Alias = make([]string, len(r.Alias))
+		copy(out.Alias, r.Alias)
+	}
+	if r.AliasElement != nil {
+		out.AliasElement = make([]*Element, len(r.AliasElement))
+		for i, v := range r.AliasElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.AliasElement[i] = &c
+			}
+		}
+	}
+	if r.Author != nil {
+		v := r.Author.DeepCopy()
+		out.Author = &v
+	}
+	if r.Scope != nil {
+		v := r.Scope.DeepCopy()
+		out.Scope = &v
+	}
+	if r.TopicCodeableConcept != nil {
+		v := r.TopicCodeableConcept.DeepCopy()
+		out.TopicCodeableConcept = &v
+	}
+	if r.TopicReference != nil {
+		v := r.TopicReference.DeepCopy()
+		out.TopicReference = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.SubType != nil {
+		out.SubType = make([]CodeableConcept, len(r.SubType))
+		for i := range r.SubType {
+			out.SubType[i] = r.SubType[i].DeepCopy()
+		}
+	}
+	if r.ContentDefinition != nil {
+		v := r.ContentDefinition.DeepCopy()
+		out.ContentDefinition = &v
+	}
+	if r.Term != nil {
+		out.Term = make([]ContractTerm, len(r.Term))
+		for i := range r.Term {
+			out.Term[i] = r.Term[i].DeepCopy()
+		}
+	}
+	if r.SupportingInfo != nil {
+		out.SupportingInfo = make([]Reference, len(r.SupportingInfo))
+		for i := range r.SupportingInfo {
+			out.SupportingInfo[i] = r.SupportingInfo[i].DeepCopy()
+		}
+	}
+	if r.RelevantHistory != nil {
+		out.RelevantHistory = make([]Reference, len(r.RelevantHistory))
+		for i := range r.RelevantHistory {
+			out.RelevantHistory[i] = r.RelevantHistory[i].DeepCopy()
+		}
+	}
+	if r.Signer != nil {
+		out.Signer = make([]ContractSigner, len(r.Signer))
+		for i := range r.Signer {
+			out.Signer[i] = r.Signer[i].DeepCopy()
+		}
+	}
+	if r.Friendly != nil {
+		out.Friendly = make([]ContractFriendly, len(r.Friendly))
+		for i := range r.Friendly {
+			out.Friendly[i] = r.Friendly[i].DeepCopy()
+		}
+	}
+	if r.Legal != nil {
+		out.Legal = make([]ContractLegal, len(r.Legal))
+		for i := range r.Legal {
+			out.Legal[i] = r.Legal[i].DeepCopy()
+		}
+	}
+	if r.Rule != nil {
+		out.Rule = make([]ContractRule, len(r.Rule))
+		for i := range r.Rule {
+			out.Rule[i] = r.Rule[i].DeepCopy()
+		}
+	}
+	if r.LegallyBindingAttachment != nil {
+		v := r.LegallyBindingAttachment.DeepCopy()
+		out.LegallyBindingAttachment = &v
+	}
+	if r.LegallyBindingReference != nil {
+		v := r.LegallyBindingReference.DeepCopy()
+		out.LegallyBindingReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Contract) Equal(other Contract) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.LegalState == nil) != (other.LegalState == nil) || r.LegalState != nil && !r.LegalState.Equal(*other.LegalState) {
+		return false
+	}
+	if (r.InstantiatesCanonical == nil) != (other.InstantiatesCanonical == nil) || r.InstantiatesCanonical != nil && !r.InstantiatesCanonical.Equal(*other.InstantiatesCanonical) {
+		return false
+	}
+	if (r.InstantiatesUri == nil) != (other.InstantiatesUri == nil) || r.InstantiatesUri != nil && *r.InstantiatesUri != *other.InstantiatesUri {
+		return false
+	}
+	if (r.InstantiatesUriElement == nil) != (other.InstantiatesUriElement == nil) || r.InstantiatesUriElement != nil && !r.InstantiatesUriElement.Equal(*other.InstantiatesUriElement) {
+		return false
+	}
+	if (r.ContentDerivative == nil) != (other.ContentDerivative == nil) || r.ContentDerivative != nil && !r.ContentDerivative.Equal(*other.ContentDerivative) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && *r.Issued != *other.Issued {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.Equal(*other.IssuedElement) {
+		return false
+	}
+	if (r.Applies == nil) != (other.Applies == nil) || r.Applies != nil && !r.Applies.Equal(*other.Applies) {
+		return false
+	}
+	if (r.ExpirationType == nil) != (other.ExpirationType == nil) || r.ExpirationType != nil && !r.ExpirationType.Equal(*other.ExpirationType) {
+		return false
+	}
+	if len(r.Subject) != len(other.Subject) {
+		return false
+	}
+	for i := range r.Subject {
+		if !r.Subject[i].Equal(other.Subject[i]) {
+			return false
+		}
+	}
+	if len(r.Authority) != len(other.Authority) {
+		return false
+	}
+	for i := range r.Authority {
+		if !r.Authority[i].Equal(other.Authority[i]) {
+			return false
+		}
+	}
+	if len(r.Domain) != len(other.Domain) {
+		return false
+	}
+	for i := range r.Domain {
+		if !r.Domain[i].Equal(other.Domain[i]) {
+			return false
+		}
+	}
+	if len(r.Site) != len(other.Site) {
+		return false
+	}
+	for i := range r.Site {
+		if !r.Site[i].Equal(other.Site[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && *r.Subtitle != *other.Subtitle {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.Equal(*other.SubtitleElement) {
+		return false
+	}
+	if len(r.Alias) != len(other.Alias) {
+		return false
+	}
+	for i := range r.Alias {
+		if r.Alias[i] != other.Alias[i] {
+			return false
+		}
+	}
+	if !equalElements(r.AliasElement, other.AliasElement, false) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.Equal(*other.Author) {
+		return false
+	}
+	if (r.Scope == nil) != (other.Scope == nil) || r.Scope != nil && !r.Scope.Equal(*other.Scope) {
+		return false
+	}
+	if (r.TopicCodeableConcept == nil) != (other.TopicCodeableConcept == nil) || r.TopicCodeableConcept != nil && !r.TopicCodeableConcept.Equal(*other.TopicCodeableConcept) {
+		return false
+	}
+	if (r.TopicReference == nil) != (other.TopicReference == nil) || r.TopicReference != nil && !r.TopicReference.Equal(*other.TopicReference) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.SubType) != len(other.SubType) {
+		return false
+	}
+	for i := range r.SubType {
+		if !r.SubType[i].Equal(other.SubType[i]) {
+			return false
+		}
+	}
+	if (r.ContentDefinition == nil) != (other.ContentDefinition == nil) || r.ContentDefinition != nil && !r.ContentDefinition.Equal(*other.ContentDefinition) {
+		return false
+	}
+	if len(r.Term) != len(other.Term) {
+		return false
+	}
+	for i := range r.Term {
+		if !r.Term[i].Equal(other.Term[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingInfo) != len(other.SupportingInfo) {
+		return false
+	}
+	for i := range r.SupportingInfo {
+		if !r.SupportingInfo[i].Equal(other.SupportingInfo[i]) {
+			return false
+		}
+	}
+	if len(r.RelevantHistory) != len(other.RelevantHistory) {
+		return false
+	}
+	for i := range r.RelevantHistory {
+		if !r.RelevantHistory[i].Equal(other.RelevantHistory[i]) {
+			return false
+		}
+	}
+	if len(r.Signer) != len(other.Signer) {
+		return false
+	}
+	for i := range r.Signer {
+		if !r.Signer[i].Equal(other.Signer[i]) {
+			return false
+		}
+	}
+	if len(r.Friendly) != len(other.Friendly) {
+		return false
+	}
+	for i := range r.Friendly {
+		if !r.Friendly[i].Equal(other.Friendly[i]) {
+			return false
+		}
+	}
+	if len(r.Legal) != len(other.Legal) {
+		return false
+	}
+	for i := range r.Legal {
+		if !r.Legal[i].Equal(other.Legal[i]) {
+			return false
+		}
+	}
+	if len(r.Rule) != len(other.Rule) {
+		return false
+	}
+	for i := range r.Rule {
+		if !r.Rule[i].Equal(other.Rule[i]) {
+			return false
+		}
+	}
+	if (r.LegallyBindingAttachment == nil) != (other.LegallyBindingAttachment == nil) || r.LegallyBindingAttachment != nil && !r.LegallyBindingAttachment.Equal(*other.LegallyBindingAttachment) {
+		return false
+	}
+	if (r.LegallyBindingReference == nil) != (other.LegallyBindingReference == nil) || r.LegallyBindingReference != nil && !r.LegallyBindingReference.Equal(*other.LegallyBindingReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Contract) EqualsDeep(other Contract) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.LegalState == nil) != (other.LegalState == nil) || r.LegalState != nil && !r.LegalState.EqualsDeep(*other.LegalState) {
+		return false
+	}
+	if (r.InstantiatesCanonical == nil) != (other.InstantiatesCanonical == nil) || r.InstantiatesCanonical != nil && !r.InstantiatesCanonical.EqualsDeep(*other.InstantiatesCanonical) {
+		return false
+	}
+	if (r.InstantiatesUri == nil) != (other.InstantiatesUri == nil) || r.InstantiatesUri != nil && !equivalentString(*r.InstantiatesUri, *other.InstantiatesUri) {
+		return false
+	}
+	if (r.InstantiatesUriElement == nil) != (other.InstantiatesUriElement == nil) || r.InstantiatesUriElement != nil && !r.InstantiatesUriElement.EqualsDeep(*other.InstantiatesUriElement) {
+		return false
+	}
+	if (r.ContentDerivative == nil) != (other.ContentDerivative == nil) || r.ContentDerivative != nil && !r.ContentDerivative.EqualsDeep(*other.ContentDerivative) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.EqualsDeep(*other.IssuedElement) {
+		return false
+	}
+	if (r.Applies == nil) != (other.Applies == nil) || r.Applies != nil && !r.Applies.EqualsDeep(*other.Applies) {
+		return false
+	}
+	if (r.ExpirationType == nil) != (other.ExpirationType == nil) || r.ExpirationType != nil && !r.ExpirationType.EqualsDeep(*other.ExpirationType) {
+		return false
+	}
+	if !equivalentList(len(r.Subject), len(other.Subject), func(i, j int) bool {
+		return r.Subject[i].EqualsDeep(other.Subject[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Authority), len(other.Authority), func(i, j int) bool {
+		return r.Authority[i].EqualsDeep(other.Authority[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Domain), len(other.Domain), func(i, j int) bool {
+		return r.Domain[i].EqualsDeep(other.Domain[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Site), len(other.Site), func(i, j int) bool {
+		return r.Site[i].EqualsDeep(other.Site[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.EqualsDeep(*other.SubtitleElement) {
+		return false
+	}
+	if !equivalentList(len(r.Alias), len(other.Alias), func(i, j int) bool {
+		return equivalentString(r.Alias[i], other.Alias[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.AliasElement, other.AliasElement, true) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.EqualsDeep(*other.Author) {
+		return false
+	}
+	if (r.Scope == nil) != (other.Scope == nil) || r.Scope != nil && !r.Scope.EqualsDeep(*other.Scope) {
+		return false
+	}
+	if (r.TopicCodeableConcept == nil) != (other.TopicCodeableConcept == nil) || r.TopicCodeableConcept != nil && !r.TopicCodeableConcept.EqualsDeep(*other.TopicCodeableConcept) {
+		return false
+	}
+	if (r.TopicReference == nil) != (other.TopicReference == nil) || r.TopicReference != nil && !r.TopicReference.EqualsDeep(*other.TopicReference) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.SubType), len(other.SubType), func(i, j int) bool {
+		return r.SubType[i].EqualsDeep(other.SubType[j])
+	}) {
+		return false
+	}
+	if (r.ContentDefinition == nil) != (other.ContentDefinition == nil) || r.ContentDefinition != nil && !r.ContentDefinition.EqualsDeep(*other.ContentDefinition) {
+		return false
+	}
+	if !equivalentList(len(r.Term), len(other.Term), func(i, j int) bool {
+		return r.Term[i].EqualsDeep(other.Term[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfo), len(other.SupportingInfo), func(i, j int) bool {
+		return r.SupportingInfo[i].EqualsDeep(other.SupportingInfo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelevantHistory), len(other.RelevantHistory), func(i, j int) bool {
+		return r.RelevantHistory[i].EqualsDeep(other.RelevantHistory[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Signer), len(other.Signer), func(i, j int) bool {
+		return r.Signer[i].EqualsDeep(other.Signer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Friendly), len(other.Friendly), func(i, j int) bool {
+		return r.Friendly[i].EqualsDeep(other.Friendly[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Legal), len(other.Legal), func(i, j int) bool {
+		return r.Legal[i].EqualsDeep(other.Legal[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Rule), len(other.Rule), func(i, j int) bool {
+		return r.Rule[i].EqualsDeep(other.Rule[j])
+	}) {
+		return false
+	}
+	if (r.LegallyBindingAttachment == nil) != (other.LegallyBindingAttachment == nil) || r.LegallyBindingAttachment != nil && !r.LegallyBindingAttachment.EqualsDeep(*other.LegallyBindingAttachment) {
+		return false
+	}
+	if (r.LegallyBindingReference == nil) != (other.LegallyBindingReference == nil) || r.LegallyBindingReference != nil && !r.LegallyBindingReference.EqualsDeep(*other.LegallyBindingReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Contract) EqualsShallow(other Contract) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.InstantiatesUri == nil) != (other.InstantiatesUri == nil) || r.InstantiatesUri != nil && !equivalentString(*r.InstantiatesUri, *other.InstantiatesUri) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if !equivalentList(len(r.Alias), len(other.Alias), func(i, j int) bool {
+		return equivalentString(r.Alias[i], other.Alias[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Contract as FHIR XML
+func (r Contract) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Contract",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "legalState", r.LegalState); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "instantiatesCanonical", r.InstantiatesCanonical); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "instantiatesUri", r.InstantiatesUri, r.InstantiatesUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "contentDerivative", r.ContentDerivative); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "issued", r.Issued, r.IssuedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "applies", r.Applies); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "expirationType", r.ExpirationType); err != nil {
+		return err
+	}
+	for _, v := range r.Subject {
+		if err := encodeXMLElement(e, "subject", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Authority {
+		if err := encodeXMLElement(e, "authority", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Domain {
+		if err := encodeXMLElement(e, "domain", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Site {
+		if err := encodeXMLElement(e, "site", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "subtitle", r.Subtitle, r.SubtitleElement); err != nil {
+		return err
+	}
+	for i, v := range r.Alias {
+		if err := encodeXMLPrimitive(e, "alias", v, elementAt(r.AliasElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "author", r.Author); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "scope", r.Scope); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "topicCodeableConcept", r.TopicCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "topicReference", r.TopicReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.SubType {
+		if err := encodeXMLElement(e, "subType", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "contentDefinition", r.ContentDefinition); err != nil {
+		return err
+	}
+	for _, v := range r.Term {
+		if err := encodeXMLElement(e, "term", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SupportingInfo {
+		if err := encodeXMLElement(e, "supportingInfo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelevantHistory {
+		if err := encodeXMLElement(e, "relevantHistory", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Signer {
+		if err := encodeXMLElement(e, "signer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Friendly {
+		if err := encodeXMLElement(e, "friendly", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Legal {
+		if err := encodeXMLElement(e, "legal", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Rule {
+		if err := encodeXMLElement(e, "rule", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "legallyBindingAttachment", r.LegallyBindingAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "legallyBindingReference", r.LegallyBindingReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Contract from FHIR XML
+func (r *Contract) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "status":
+				var v ContractResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "legalState":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LegalState = &v
+			case "instantiatesCanonical":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.InstantiatesCanonical = &v
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.InstantiatesUri = &v
+				}
+				r.InstantiatesUriElement = element
+			case "contentDerivative":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentDerivative = &v
+			case "issued":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Issued = &v
+				}
+				r.IssuedElement = element
+			case "applies":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Applies = &v
+			case "expirationType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ExpirationType = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = append(r.Subject, v)
+			case "authority":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Authority = append(r.Authority, v)
+			case "domain":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Domain = append(r.Domain, v)
+			case "site":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Site = append(r.Site, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "subtitle":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subtitle = &v
+				}
+				r.SubtitleElement = element
+			case "alias":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.AliasElement = appendElement(r.AliasElement, len(r.Alias), element)
+					r.Alias = append(r.Alias, v)
+				}
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = &v
+			case "scope":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Scope = &v
+			case "topicCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TopicCodeableConcept = &v
+			case "topicReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TopicReference = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "subType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubType = append(r.SubType, v)
+			case "contentDefinition":
+				var v ContractContentDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentDefinition = &v
+			case "term":
+				var v ContractTerm
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Term = append(r.Term, v)
+			case "supportingInfo":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			case "relevantHistory":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelevantHistory = append(r.RelevantHistory, v)
+			case "signer":
+				var v ContractSigner
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Signer = append(r.Signer, v)
+			case "friendly":
+				var v ContractFriendly
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Friendly = append(r.Friendly, v)
+			case "legal":
+				var v ContractLegal
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Legal = append(r.Legal, v)
+			case "rule":
+				var v ContractRule
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Rule = append(r.Rule, v)
+			case "legallyBindingAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LegallyBindingAttachment = &v
+			case "legallyBindingReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LegallyBindingReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Contract as properties to the RDF node n
+func (r Contract) turtle(n *turtleNode) {
+	n.resource("Contract", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Contract.identifier", i, v)
+	}
+	n.primitive("Contract.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("Contract.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("Contract.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Contract.legalState", -1, r.LegalState)
+	n.element("Contract.instantiatesCanonical", -1, r.InstantiatesCanonical)
+	n.primitive("Contract.instantiatesUri", -1, r.InstantiatesUri, r.InstantiatesUriElement, "string")
+	n.element("Contract.contentDerivative", -1, r.ContentDerivative)
+	n.primitive("Contract.issued", -1, r.Issued, r.IssuedElement, "dateTime")
+	n.element("Contract.applies", -1, r.Applies)
+	n.element("Contract.expirationType", -1, r.ExpirationType)
+	for i, v := range r.Subject {
+		n.element("Contract.subject", i, v)
+	}
+	for i, v := range r.Authority {
+		n.element("Contract.authority", i, v)
+	}
+	for i, v := range r.Domain {
+		n.element("Contract.domain", i, v)
+	}
+	for i, v := range r.Site {
+		n.element("Contract.site", i, v)
+	}
+	n.primitive("Contract.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("Contract.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("Contract.subtitle", -1, r.Subtitle, r.SubtitleElement, "string")
+	for i, v := range r.Alias {
+		n.primitive("Contract.alias", i, v, elementAt(r.AliasElement, i), "string")
+	}
+	n.element("Contract.author", -1, r.Author)
+	n.element("Contract.scope", -1, r.Scope)
+	n.element("Contract.topicCodeableConcept", -1, r.TopicCodeableConcept)
+	n.element("Contract.topicReference", -1, r.TopicReference)
+	n.element("Contract.type", -1, r.Type)
+	for i, v := range r.SubType {
+		n.element("Contract.subType", i, v)
+	}
+	n.element("Contract.contentDefinition", -1, r.ContentDefinition)
+	for i, v := range r.Term {
+		n.element("Contract.term", i, v)
+	}
+	for i, v := range r.SupportingInfo {
+		n.element("Contract.supportingInfo", i, v)
+	}
+	for i, v := range r.RelevantHistory {
+		n.element("Contract.relevantHistory", i, v)
+	}
+	for i, v := range r.Signer {
+		n.element("Contract.signer", i, v)
+	}
+	for i, v := range r.Friendly {
+		n.element("Contract.friendly", i, v)
+	}
+	for i, v := range r.Legal {
+		n.element("Contract.legal", i, v)
+	}
+	for i, v := range r.Rule {
+		n.element("Contract.rule", i, v)
+	}
+	n.element("Contract.legallyBindingAttachment", -1, r.LegallyBindingAttachment)
+	n.element("Contract.legallyBindingReference", -1, r.LegallyBindingReference)
+}
+
+// MarshalBSON marshals the given Contract as BSON document with the structure of its FHIR JSON
+func (r Contract) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Contract from a BSON document with the structure of its FHIR JSON
+func (r *Contract) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Contract as protobuf message Contract of fhir.proto
+func (r Contract) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Contract to the encoded message
+func (r Contract) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Url != nil {
+		e.string(10, *r.Url)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Status != nil {
+		e.varint(12, r.Status.protoNumber())
+	}
+	if r.LegalState != nil {
+		e.message(13, *r.LegalState)
+	}
+	if r.InstantiatesCanonical != nil {
+		e.message(14, *r.InstantiatesCanonical)
+	}
+	if r.InstantiatesUri != nil {
+		e.string(15, *r.InstantiatesUri)
+	}
+	if r.ContentDerivative != nil {
+		e.message(16, *r.ContentDerivative)
+	}
+	if r.Issued != nil {
+		e.string(17, *r.Issued)
+	}
+	if r.Applies != nil {
+		e.message(18, *r.Applies)
+	}
+	if r.ExpirationType != nil {
+		e.message(19, *r.ExpirationType)
+	}
+	for _, v := range r.Subject {
+		e.message(20, v)
+	}
+	for _, v := range r.Authority {
+		e.message(21, v)
+	}
+	for _, v := range r.Domain {
+		e.message(22, v)
+	}
+	for _, v := range r.Site {
+		e.message(23, v)
+	}
+	if r.Name != nil {
+		e.string(24, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(25, *r.Title)
+	}
+	if r.Subtitle != nil {
+		e.string(26, *r.Subtitle)
+	}
+	for _, v := range r.Alias {
+		e.string(27, v)
+	}
+	if r.Author != nil {
+		e.message(28, *r.Author)
+	}
+	if r.Scope != nil {
+		e.message(29, *r.Scope)
+	}
+	if r.TopicCodeableConcept != nil {
+		e.message(30, *r.TopicCodeableConcept)
+	}
+	if r.TopicReference != nil {
+		e.message(31, *r.TopicReference)
+	}
+	if r.Type != nil {
+		e.message(32, *r.Type)
+	}
+	for _, v := range r.SubType {
+		e.message(33, v)
+	}
+	if r.ContentDefinition != nil {
+		e.message(34, *r.ContentDefinition)
+	}
+	for _, v := range r.Term {
+		e.message(35, v)
+	}
+	for _, v := range r.SupportingInfo {
+		e.message(36, v)
+	}
+	for _, v := range r.RelevantHistory {
+		e.message(37, v)
+	}
+	for _, v := range r.Signer {
+		e.message(38, v)
+	}
+	for _, v := range r.Friendly {
+		e.message(39, v)
+	}
+	for _, v := range r.Legal {
+		e.message(40, v)
+	}
+	for _, v := range r.Rule {
+		e.message(41, v)
+	}
+	if r.LegallyBindingAttachment != nil {
+		e.message(42, *r.LegallyBindingAttachment)
+	}
+	if r.LegallyBindingReference != nil {
+		e.message(43, *r.LegallyBindingReference)
+	}
+	if r.IdElement != nil {
+		e.message(44, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(45, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(46, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(47, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(48, *r.VersionElement)
+	}
+	if r.StatusElement != nil {
+		e.message(49, *r.StatusElement)
+	}
+	if r.InstantiatesUriElement != nil {
+		e.message(50, *r.InstantiatesUriElement)
+	}
+	if r.IssuedElement != nil {
+		e.message(51, *r.IssuedElement)
+	}
+	if r.NameElement != nil {
+		e.message(52, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(53, *r.TitleElement)
+	}
+	if r.SubtitleElement != nil {
+		e.message(54, *r.SubtitleElement)
+	}
+	for _, v := range r.AliasElement {
+		e.element(55, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given Contract from protobuf message Contract of fhir.proto
+func (r *Contract) UnmarshalProto(b []byte) error {
+	*r = Contract{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.string()
+			r.Url = &v
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			var v ContractResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.LegalState = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.InstantiatesCanonical = &v
+		case 15:
+			v := d.string()
+			r.InstantiatesUri = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.ContentDerivative = &v
+		case 17:
+			v := d.string()
+			r.Issued = &v
+		case 18:
+			var v Period
+			d.message(&v)
+			r.Applies = &v
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.ExpirationType = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Subject = append(r.Subject, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Authority = append(r.Authority, v)
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.Domain = append(r.Domain, v)
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Site = append(r.Site, v)
+		case 24:
+			v := d.string()
+			r.Name = &v
+		case 25:
+			v := d.string()
+			r.Title = &v
+		case 26:
+			v := d.string()
+			r.Subtitle = &v
+		case 27:
+			r.Alias = append(r.Alias, d.string())
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Author = &v
+		case 29:
+			var v CodeableConcept
+			d.message(&v)
+			r.Scope = &v
+		case 30:
+			var v CodeableConcept
+			d.message(&v)
+			r.TopicCodeableConcept = &v
+		case 31:
+			var v Reference
+			d.message(&v)
+			r.TopicReference = &v
+		case 32:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 33:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubType = append(r.SubType, v)
+		case 34:
+			var v ContractContentDefinition
+			d.message(&v)
+			r.ContentDefinition = &v
+		case 35:
+			var v ContractTerm
+			d.message(&v)
+			r.Term = append(r.Term, v)
+		case 36:
+			var v Reference
+			d.message(&v)
+			r.SupportingInfo = append(r.SupportingInfo, v)
+		case 37:
+			var v Reference
+			d.message(&v)
+			r.RelevantHistory = append(r.RelevantHistory, v)
+		case 38:
+			var v ContractSigner
+			d.message(&v)
+			r.Signer = append(r.Signer, v)
+		case 39:
+			var v ContractFriendly
+			d.message(&v)
+			r.Friendly = append(r.Friendly, v)
+		case 40:
+			var v ContractLegal
+			d.message(&v)
+			r.Legal = append(r.Legal, v)
+		case 41:
+			var v ContractRule
+			d.message(&v)
+			r.Rule = append(r.Rule, v)
+		case 42:
+			var v Attachment
+			d.message(&v)
+			r.LegallyBindingAttachment = &v
+		case 43:
+			var v Reference
+			d.message(&v)
+			r.LegallyBindingReference = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.InstantiatesUriElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.IssuedElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 54:
+			var v Element
+			d.message(&v)
+			r.SubtitleElement = &v
+		case 55:
+			r.AliasElement = append(r.AliasElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractContentDefinition as JSON into a byte slice
+func (r ContractContentDefinition) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractContentDefinition as JSON object with the members in the order of the fields
+func (r ContractContentDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.SubType != nil {
+		e.key("subType")
+		r.SubType.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		r.Publisher.appendJSON(e)
+	}
+	if r.PublicationDate != nil {
+		e.key("publicationDate")
+		e.string(*r.PublicationDate)
+	}
+	if r.PublicationDateElement != nil {
+		e.key("_publicationDate")
+		r.PublicationDateElement.appendJSON(e)
+	}
+	e.key("publicationStatus")
+	e.string(r.PublicationStatus.Code())
+	if r.PublicationStatusElement != nil {
+		e.key("_publicationStatus")
+		r.PublicationStatusElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractContentDefinition from JSON. Unknown members are ignored.
+func (r *ContractContentDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractContentDefinition
+func (r *ContractContentDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "subType":
+			if d.null() {
+				r.SubType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubType = &v
+			}
+		case "publisher":
+			if d.null() {
+				r.Publisher = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Publisher = &v
+			}
+		case "publicationDate":
+			if v, ok := d.string(); ok {
+				r.PublicationDate = &v
+			} else {
+				r.PublicationDate = nil
+			}
+		case "_publicationDate":
+			r.PublicationDateElement = d.element()
+		case "publicationStatus":
+			d.unmarshal(&r.PublicationStatus)
+		case "_publicationStatus":
+			r.PublicationStatusElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractContentDefinition which shares no memory with the original
+func (r ContractContentDefinition) DeepCopy() ContractContentDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.SubType != nil {
+		v := r.SubType.DeepCopy()
+		out.SubType = &v
+	}
+	if r.Publisher != nil {
+		v := r.Publisher.DeepCopy()
+		out.Publisher = &v
+	}
+	if r.PublicationDate != nil {
+		v := *r.PublicationDate
+		out.PublicationDate = &v
+	}
+	if r.PublicationDateElement != nil {
+		v := r.PublicationDateElement.DeepCopy()
+		out.PublicationDateElement = &v
+	}
+	if r.PublicationStatusElement != nil {
+		v := r.PublicationStatusElement.DeepCopy()
+		out.PublicationStatusElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractContentDefinition) Equal(other ContractContentDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.Equal(*other.SubType) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !r.Publisher.Equal(*other.Publisher) {
+		return false
+	}
+	if (r.PublicationDate == nil) != (other.PublicationDate == nil) || r.PublicationDate != nil && *r.PublicationDate != *other.PublicationDate {
+		return false
+	}
+	if (r.PublicationDateElement == nil) != (other.PublicationDateElement == nil) || r.PublicationDateElement != nil && !r.PublicationDateElement.Equal(*other.PublicationDateElement) {
+		return false
+	}
+	if r.PublicationStatus != other.PublicationStatus {
+		return false
+	}
+	if (r.PublicationStatusElement == nil) != (other.PublicationStatusElement == nil) || r.PublicationStatusElement != nil && !r.PublicationStatusElement.Equal(*other.PublicationStatusElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractContentDefinition) EqualsDeep(other ContractContentDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.EqualsDeep(*other.SubType) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !r.Publisher.EqualsDeep(*other.Publisher) {
+		return false
+	}
+	if (r.PublicationDate == nil) != (other.PublicationDate == nil) || r.PublicationDate != nil && !equivalentString(*r.PublicationDate, *other.PublicationDate) {
+		return false
+	}
+	if (r.PublicationDateElement == nil) != (other.PublicationDateElement == nil) || r.PublicationDateElement != nil && !r.PublicationDateElement.EqualsDeep(*other.PublicationDateElement) {
+		return false
+	}
+	if r.PublicationStatus != other.PublicationStatus {
+		return false
+	}
+	if (r.PublicationStatusElement == nil) != (other.PublicationStatusElement == nil) || r.PublicationStatusElement != nil && !r.PublicationStatusElement.EqualsDeep(*other.PublicationStatusElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractContentDefinition) EqualsShallow(other ContractContentDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.PublicationDate == nil) != (other.PublicationDate == nil) || r.PublicationDate != nil && !equivalentString(*r.PublicationDate, *other.PublicationDate) {
+		return false
+	}
+	if r.PublicationStatus != other.PublicationStatus {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractContentDefinition as FHIR XML
+func (r ContractContentDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subType", r.SubType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "publisher", r.Publisher); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publicationDate", r.PublicationDate, r.PublicationDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publicationStatus", r.PublicationStatus, r.PublicationStatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractContentDefinition from FHIR XML
+func (r *ContractContentDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "subType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubType = &v
+			case "publisher":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Publisher = &v
+			case "publicationDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PublicationDate = &v
+				}
+				r.PublicationDateElement = element
+			case "publicationStatus":
+				var v ContractResourcePublicationStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PublicationStatus = v
+				}
+				r.PublicationStatusElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractContentDefinition as properties to the RDF node n
+func (r ContractContentDefinition) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Contract.contentDefinition.type", -1, r.Type)
+	n.element("Contract.contentDefinition.subType", -1, r.SubType)
+	n.element("Contract.contentDefinition.publisher", -1, r.Publisher)
+	n.primitive("Contract.contentDefinition.publicationDate", -1, r.PublicationDate, r.PublicationDateElement, "dateTime")
+	n.primitive("Contract.contentDefinition.publicationStatus", -1, r.PublicationStatus, r.PublicationStatusElement, "code")
+	n.primitive("Contract.contentDefinition.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+}
+
+// MarshalBSON marshals the given ContractContentDefinition as BSON document with the structure of its FHIR JSON
+func (r ContractContentDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractContentDefinition from a BSON document with the structure of its FHIR JSON
+func (r *ContractContentDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractContentDefinition as protobuf message ContractContentDefinition of fhir.proto
+func (r ContractContentDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractContentDefinition to the encoded message
+func (r ContractContentDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.SubType != nil {
+		e.message(5, *r.SubType)
+	}
+	if r.Publisher != nil {
+		e.message(6, *r.Publisher)
+	}
+	if r.PublicationDate != nil {
+		e.string(7, *r.PublicationDate)
+	}
+	e.varint(8, r.PublicationStatus.protoNumber())
+	if r.Copyright != nil {
+		e.string(9, *r.Copyright)
+	}
+	if r.PublicationDateElement != nil {
+		e.message(10, *r.PublicationDateElement)
+	}
+	if r.PublicationStatusElement != nil {
+		e.message(11, *r.PublicationStatusElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(12, *r.CopyrightElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractContentDefinition from protobuf message ContractContentDefinition of fhir.proto
+func (r *ContractContentDefinition) UnmarshalProto(b []byte) error {
+	*r = ContractContentDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubType = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Publisher = &v
+		case 7:
+			v := d.string()
+			r.PublicationDate = &v
+		case 8:
+			var v ContractResourcePublicationStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.PublicationStatus = v
+		case 9:
+			v := d.string()
+			r.Copyright = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.PublicationDateElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.PublicationStatusElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTerm as JSON into a byte slice
+func (r ContractTerm) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTerm as JSON object with the members in the order of the fields
+func (r ContractTerm) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Issued != nil {
+		e.key("issued")
+		e.string(*r.Issued)
+	}
+	if r.IssuedElement != nil {
+		e.key("_issued")
+		r.IssuedElement.appendJSON(e)
+	}
+	if r.Applies != nil {
+		e.key("applies")
+		r.Applies.appendJSON(e)
+	}
+	if r.TopicCodeableConcept != nil {
+		e.key("topicCodeableConcept")
+		r.TopicCodeableConcept.appendJSON(e)
+	}
+	if r.TopicReference != nil {
+		e.key("topicReference")
+		r.TopicReference.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.SubType != nil {
+		e.key("subType")
+		r.SubType.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	if len(r.SecurityLabel) > 0 {
+		e.key("securityLabel")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SecurityLabel {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("offer")
+	r.Offer.appendJSON(e)
+	if len(r.Asset) > 0 {
+		e.key("asset")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Asset {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Action) > 0 {
+		e.key("action")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Group) > 0 {
+		e.key("group")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Group {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTerm from JSON. Unknown members are ignored.
+func (r *ContractTerm) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTerm
+func (r *ContractTerm) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "issued":
+			if v, ok := d.string(); ok {
+				r.Issued = &v
+			} else {
+				r.Issued = nil
+			}
+		case "_issued":
+			r.IssuedElement = d.element()
+		case "applies":
+			if d.null() {
+				r.Applies = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Applies = &v
+			}
+		case "topicCodeableConcept":
+			if d.null() {
+				r.TopicCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.TopicCodeableConcept = &v
+			}
+		case "topicReference":
+			if d.null() {
+				r.TopicReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.TopicReference = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "subType":
+			if d.null() {
+				r.SubType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubType = &v
+			}
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "securityLabel":
+			a := d.array()
+			r.SecurityLabel = nil
+			for a.next() {
+				var v ContractTermSecurityLabel
+				v.decodeJSON(d)
+				r.SecurityLabel = append(r.SecurityLabel, v)
+			}
+			if r.SecurityLabel == nil && !a.isNull {
+				r.SecurityLabel = []ContractTermSecurityLabel{}
+			}
+		case "offer":
+			r.Offer.decodeJSON(d)
+		case "asset":
+			a := d.array()
+			r.Asset = nil
+			for a.next() {
+				var v ContractTermAsset
+				v.decodeJSON(d)
+				r.Asset = append(r.Asset, v)
+			}
+			if r.Asset == nil && !a.isNull {
+				r.Asset = []ContractTermAsset{}
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v ContractTermAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []ContractTermAction{}
+			}
+		case "group":
+			a := d.array()
+			r.Group = nil
+			for a.next() {
+				var v ContractTerm
+				v.decodeJSON(d)
+				r.Group = append(r.Group, v)
+			}
+			if r.Group == nil && !a.isNull {
+				r.Group = []ContractTerm{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTerm which shares no memory with the original
+func (r ContractTerm) DeepCopy() ContractTerm {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Issued != nil {
+		v := *r.Issued
+		out.Issued = &v
+	}
+	if r.IssuedElement != nil {
+		v := r.IssuedElement.DeepCopy()
+		out.IssuedElement = &v
+	}
+	if r.Applies != nil {
+		v := r.Applies.DeepCopy()
+		out.Applies = &v
+	}
+	if r.TopicCodeableConcept != nil {
+		v := r.TopicCodeableConcept.DeepCopy()
+		out.TopicCodeableConcept = &v
+	}
+	if r.TopicReference != nil {
+		v := r.TopicReference.DeepCopy()
+		out.TopicReference = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.SubType != nil {
+		v := r.SubType.DeepCopy()
+		out.SubType = &v
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.SecurityLabel != nil {
+		out.SecurityLabel = make([]ContractTermSecurityLabel, len(r.SecurityLabel))
+		for i := range r.SecurityLabel {
+			out.SecurityLabel[i] = r.SecurityLabel[i].DeepCopy()
+		}
+	}
+	out.Offer = r.Offer.DeepCopy()
+	if r.Asset != nil {
+		out.Asset = make([]ContractTermAsset, len(r.Asset))
+		for i := range r.Asset {
+			out.Asset[i] = r.Asset[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		out.Action = make([]ContractTermAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	if r.Group != nil {
+		out.Group = make([]ContractTerm, len(r.Group))
+		for i := range r.Group {
+			out.Group[i] = r.Group[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTerm) Equal(other ContractTerm) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && *r.Issued != *other.Issued {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.Equal(*other.IssuedElement) {
+		return false
+	}
+	if (r.Applies == nil) != (other.Applies == nil) || r.Applies != nil && !r.Applies.Equal(*other.Applies) {
+		return false
+	}
+	if (r.TopicCodeableConcept == nil) != (other.TopicCodeableConcept == nil) || r.TopicCodeableConcept != nil && !r.TopicCodeableConcept.Equal(*other.TopicCodeableConcept) {
+		return false
+	}
+	if (r.TopicReference == nil) != (other.TopicReference == nil) || r.TopicReference != nil && !r.TopicReference.Equal(*other.TopicReference) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.Equal(*other.SubType) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if len(r.SecurityLabel) != len(other.SecurityLabel) {
+		return false
+	}
+	for i := range r.SecurityLabel {
+		if !r.SecurityLabel[i].Equal(other.SecurityLabel[i]) {
+			return false
+		}
+	}
+	if !r.Offer.Equal(other.Offer) {
+		return false
+	}
+	if len(r.Asset) != len(other.Asset) {
+		return false
+	}
+	for i := range r.Asset {
+		if !r.Asset[i].Equal(other.Asset[i]) {
+			return false
+		}
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	if len(r.Group) != len(other.Group) {
+		return false
+	}
+	for i := range r.Group {
+		if !r.Group[i].Equal(other.Group[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTerm) EqualsDeep(other ContractTerm) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.EqualsDeep(*other.IssuedElement) {
+		return false
+	}
+	if (r.Applies == nil) != (other.Applies == nil) || r.Applies != nil && !r.Applies.EqualsDeep(*other.Applies) {
+		return false
+	}
+	if (r.TopicCodeableConcept == nil) != (other.TopicCodeableConcept == nil) || r.TopicCodeableConcept != nil && !r.TopicCodeableConcept.EqualsDeep(*other.TopicCodeableConcept) {
+		return false
+	}
+	if (r.TopicReference == nil) != (other.TopicReference == nil) || r.TopicReference != nil && !r.TopicReference.EqualsDeep(*other.TopicReference) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.EqualsDeep(*other.SubType) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabel), len(other.SecurityLabel), func(i, j int) bool {
+		return r.SecurityLabel[i].EqualsDeep(other.SecurityLabel[j])
+	}) {
+		return false
+	}
+	if !r.Offer.EqualsDeep(other.Offer) {
+		return false
+	}
+	if !equivalentList(len(r.Asset), len(other.Asset), func(i, j int) bool {
+		return r.Asset[i].EqualsDeep(other.Asset[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Group), len(other.Group), func(i, j int) bool {
+		return r.Group[i].EqualsDeep(other.Group[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTerm) EqualsShallow(other ContractTerm) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTerm as FHIR XML
+func (r ContractTerm) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "issued", r.Issued, r.IssuedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "applies", r.Applies); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "topicCodeableConcept", r.TopicCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "topicReference", r.TopicReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subType", r.SubType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	for _, v := range r.SecurityLabel {
+		if err := encodeXMLElement(e, "securityLabel", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "offer", r.Offer); err != nil {
+		return err
+	}
+	for _, v := range r.Asset {
+		if err := encodeXMLElement(e, "asset", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Group {
+		if err := encodeXMLElement(e, "group", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTerm from FHIR XML
+func (r *ContractTerm) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "issued":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Issued = &v
+				}
+				r.IssuedElement = element
+			case "applies":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Applies = &v
+			case "topicCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TopicCodeableConcept = &v
+			case "topicReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TopicReference = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "subType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubType = &v
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			case "securityLabel":
+				var v ContractTermSecurityLabel
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SecurityLabel = append(r.SecurityLabel, v)
+			case "offer":
+				var v ContractTermOffer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Offer = v
+			case "asset":
+				var v ContractTermAsset
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Asset = append(r.Asset, v)
+			case "action":
+				var v ContractTermAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			case "group":
+				var v ContractTerm
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Group = append(r.Group, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTerm as properties to the RDF node n
+func (r ContractTerm) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Contract.term.identifier", -1, r.Identifier)
+	n.primitive("Contract.term.issued", -1, r.Issued, r.IssuedElement, "dateTime")
+	n.element("Contract.term.applies", -1, r.Applies)
+	n.element("Contract.term.topicCodeableConcept", -1, r.TopicCodeableConcept)
+	n.element("Contract.term.topicReference", -1, r.TopicReference)
+	n.element("Contract.term.type", -1, r.Type)
+	n.element("Contract.term.subType", -1, r.SubType)
+	n.primitive("Contract.term.text", -1, r.Text, r.TextElement, "string")
+	for i, v := range r.SecurityLabel {
+		n.element("Contract.term.securityLabel", i, v)
+	}
+	n.element("Contract.term.offer", -1, r.Offer)
+	for i, v := range r.Asset {
+		n.element("Contract.term.asset", i, v)
+	}
+	for i, v := range r.Action {
+		n.element("Contract.term.action", i, v)
+	}
+	for i, v := range r.Group {
+		n.element("Contract.term.group", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ContractTerm as BSON document with the structure of its FHIR JSON
+func (r ContractTerm) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTerm from a BSON document with the structure of its FHIR JSON
+func (r *ContractTerm) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTerm as protobuf message ContractTerm of fhir.proto
+func (r ContractTerm) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTerm to the encoded message
+func (r ContractTerm) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identifier != nil {
+		e.message(4, *r.Identifier)
+	}
+	if r.Issued != nil {
+		e.string(5, *r.Issued)
+	}
+	if r.Applies != nil {
+		e.message(6, *r.Applies)
+	}
+	if r.TopicCodeableConcept != nil {
+		e.message(7, *r.TopicCodeableConcept)
+	}
+	if r.TopicReference != nil {
+		e.message(8, *r.TopicReference)
+	}
+	if r.Type != nil {
+		e.message(9, *r.Type)
+	}
+	if r.SubType != nil {
+		e.message(10, *r.SubType)
+	}
+	if r.Text != nil {
+		e.string(11, *r.Text)
+	}
+	for _, v := range r.SecurityLabel {
+		e.message(12, v)
+	}
+	e.message(13, r.Offer)
+	for _, v := range r.Asset {
+		e.message(14, v)
+	}
+	for _, v := range r.Action {
+		e.message(15, v)
+	}
+	for _, v := range r.Group {
+		e.message(16, v)
+	}
+	if r.IssuedElement != nil {
+		e.message(17, *r.IssuedElement)
+	}
+	if r.TextElement != nil {
+		e.message(18, *r.TextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractTerm from protobuf message ContractTerm of fhir.proto
+func (r *ContractTerm) UnmarshalProto(b []byte) error {
+	*r = ContractTerm{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 5:
+			v := d.string()
+			r.Issued = &v
+		case 6:
+			var v Period
+			d.message(&v)
+			r.Applies = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.TopicCodeableConcept = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.TopicReference = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubType = &v
+		case 11:
+			v := d.string()
+			r.Text = &v
+		case 12:
+			var v ContractTermSecurityLabel
+			d.message(&v)
+			r.SecurityLabel = append(r.SecurityLabel, v)
+		case 13:
+			var v ContractTermOffer
+			d.message(&v)
+			r.Offer = v
+		case 14:
+			var v ContractTermAsset
+			d.message(&v)
+			r.Asset = append(r.Asset, v)
+		case 15:
+			var v ContractTermAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		case 16:
+			var v ContractTerm
+			d.message(&v)
+			r.Group = append(r.Group, v)
+		case 17:
+			var v Element
+			d.message(&v)
+			r.IssuedElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTermSecurityLabel as JSON into a byte slice
+func (r ContractTermSecurityLabel) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTermSecurityLabel as JSON object with the members in the order of the fields
+func (r ContractTermSecurityLabel) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Number) > 0 {
+		e.key("number")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Number {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NumberElement) > 0 {
+		e.key("_number")
+		e.elements(r.NumberElement, len(r.Number))
+	}
+	e.key("classification")
+	r.Classification.appendJSON(e)
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Control) > 0 {
+		e.key("control")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Control {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTermSecurityLabel from JSON. Unknown members are ignored.
+func (r *ContractTermSecurityLabel) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTermSecurityLabel
+func (r *ContractTermSecurityLabel) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "number":
+			a := d.array()
+			r.Number = nil
+			for a.next() {
+				v, _ := d.int()
+				r.Number = append(r.Number, v)
+			}
+			if r.Number == nil && !a.isNull {
+				r.Number = []int{}
+			}
+		case "_number":
+			a := d.array()
+			r.NumberElement = nil
+			for a.next() {
+				r.NumberElement = append(r.NumberElement, d.element())
+			}
+		case "classification":
+			r.Classification.decodeJSON(d)
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []Coding{}
+			}
+		case "control":
+			a := d.array()
+			r.Control = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Control = append(r.Control, v)
+			}
+			if r.Control == nil && !a.isNull {
+				r.Control = []Coding{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTermSecurityLabel which shares no memory with the original
+func (r ContractTermSecurityLabel) DeepCopy() ContractTermSecurityLabel {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Number != nil {
+		out.Number = make([]int, len(r.Number))
+		copy(out.Number, r.Number)
+	}
+	if r.NumberElement != nil {
+		out.NumberElement = make([]*Element, len(r.NumberElement))
+		for i, v := range r.NumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NumberElement[i] = &c
+			}
+		}
+	}
+	out.Classification = r.Classification.DeepCopy()
+	if r.Category != nil {
+		out.Category = make([]Coding, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Control != nil {
+		out.Control = make([]Coding, len(r.Control))
+		for i := range r.Control {
+			out.Control[i] = r.Control[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTermSecurityLabel) Equal(other ContractTermSecurityLabel) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Number) != len(other.Number) {
+		return false
+	}
+	for i := range r.Number {
+		if r.Number[i] != other.Number[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NumberElement, other.NumberElement, false) {
+		return false
+	}
+	if !r.Classification.Equal(other.Classification) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if len(r.Control) != len(other.Control) {
+		return false
+	}
+	for i := range r.Control {
+		if !r.Control[i].Equal(other.Control[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTermSecurityLabel) EqualsDeep(other ContractTermSecurityLabel) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Number), len(other.Number), func(i, j int) bool {
+		return r.Number[i] == other.Number[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NumberElement, other.NumberElement, true) {
+		return false
+	}
+	if !r.Classification.EqualsDeep(other.Classification) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Control), len(other.Control), func(i, j int) bool {
+		return r.Control[i].EqualsDeep(other.Control[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTermSecurityLabel) EqualsShallow(other ContractTermSecurityLabel) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Number), len(other.Number), func(i, j int) bool {
+		return r.Number[i] == other.Number[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTermSecurityLabel as FHIR XML
+func (r ContractTermSecurityLabel) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Number {
+		if err := encodeXMLPrimitive(e, "number", v, elementAt(r.NumberElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "classification", r.Classification); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Control {
+		if err := encodeXMLElement(e, "control", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTermSecurityLabel from FHIR XML
+func (r *ContractTermSecurityLabel) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "number":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NumberElement = appendElement(r.NumberElement, len(r.Number), element)
+					r.Number = append(r.Number, v)
+				}
+			case "classification":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Classification = v
+			case "category":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "control":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Control = append(r.Control, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTermSecurityLabel as properties to the RDF node n
+func (r ContractTermSecurityLabel) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Number {
+		n.primitive("Contract.term.securityLabel.number", i, v, elementAt(r.NumberElement, i), "unsignedInt")
+	}
+	n.element("Contract.term.securityLabel.classification", -1, r.Classification)
+	for i, v := range r.Category {
+		n.element("Contract.term.securityLabel.category", i, v)
+	}
+	for i, v := range r.Control {
+		n.element("Contract.term.securityLabel.control", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ContractTermSecurityLabel as BSON document with the structure of its FHIR JSON
+func (r ContractTermSecurityLabel) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTermSecurityLabel from a BSON document with the structure of its FHIR JSON
+func (r *ContractTermSecurityLabel) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTermSecurityLabel as protobuf message ContractTermSecurityLabel of fhir.proto
+func (r ContractTermSecurityLabel) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTermSecurityLabel to the encoded message
+func (r ContractTermSecurityLabel) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if len(r.Number) > 0 {
+		var p protoEncoder
+		for _, v := range r.Number {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(4, p.buf)
+	}
+	e.message(5, r.Classification)
+	for _, v := range r.Category {
+		e.message(6, v)
+	}
+	for _, v := range r.Control {
+		e.message(7, v)
+	}
+	for _, v := range r.NumberElement {
+		e.element(8, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractTermSecurityLabel from protobuf message ContractTermSecurityLabel of fhir.proto
+func (r *ContractTermSecurityLabel) UnmarshalProto(b []byte) error {
+	*r = ContractTermSecurityLabel{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			for _, v := range d.varints() {
+				r.Number = append(r.Number, int(int32(v)))
+			}
+		case 5:
+			var v Coding
+			d.message(&v)
+			r.Classification = v
+		case 6:
+			var v Coding
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 7:
+			var v Coding
+			d.message(&v)
+			r.Control = append(r.Control, v)
+		case 8:
+			r.NumberElement = append(r.NumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTermOffer as JSON into a byte slice
+func (r ContractTermOffer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTermOffer as JSON object with the members in the order of the fields
+func (r ContractTermOffer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Party) > 0 {
+		e.key("party")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Party {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Topic != nil {
+		e.key("topic")
+		r.Topic.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Decision != nil {
+		e.key("decision")
+		r.Decision.appendJSON(e)
+	}
+	if len(r.DecisionMode) > 0 {
+		e.key("decisionMode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DecisionMode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Answer) > 0 {
+		e.key("answer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Answer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	if len(r.LinkId) > 0 {
+		e.key("linkId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LinkId {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LinkIdElement) > 0 {
+		e.key("_linkId")
+		e.elements(r.LinkIdElement, len(r.LinkId))
+	}
+	if len(r.SecurityLabelNumber) > 0 {
+		e.key("securityLabelNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SecurityLabelNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SecurityLabelNumberElement) > 0 {
+		e.key("_securityLabelNumber")
+		e.elements(r.SecurityLabelNumberElement, len(r.SecurityLabelNumber))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTermOffer from JSON. Unknown members are ignored.
+func (r *ContractTermOffer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTermOffer
+func (r *ContractTermOffer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "party":
+			a := d.array()
+			r.Party = nil
+			for a.next() {
+				var v ContractTermOfferParty
+				v.decodeJSON(d)
+				r.Party = append(r.Party, v)
+			}
+			if r.Party == nil && !a.isNull {
+				r.Party = []ContractTermOfferParty{}
+			}
+		case "topic":
+			if d.null() {
+				r.Topic = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Topic = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "decision":
+			if d.null() {
+				r.Decision = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Decision = &v
+			}
+		case "decisionMode":
+			a := d.array()
+			r.DecisionMode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DecisionMode = append(r.DecisionMode, v)
+			}
+			if r.DecisionMode == nil && !a.isNull {
+				r.DecisionMode = []CodeableConcept{}
+			}
+		case "answer":
+			a := d.array()
+			r.Answer = nil
+			for a.next() {
+				var v ContractTermOfferAnswer
+				v.decodeJSON(d)
+				r.Answer = append(r.Answer, v)
+			}
+			if r.Answer == nil && !a.isNull {
+				r.Answer = []ContractTermOfferAnswer{}
+			}
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "linkId":
+			a := d.array()
+			r.LinkId = nil
+			for a.next() {
+				v, _ := d.string()
+				r.LinkId = append(r.LinkId, v)
+			}
+			if r.LinkId == nil && !a.isNull {
+				r.LinkId = []string{}
+			}
+		case "_linkId":
+			a := d.array()
+			r.LinkIdElement = nil
+			for a.next() {
+				r.LinkIdElement = append(r.LinkIdElement, d.element())
+			}
+		case "securityLabelNumber":
+			a := d.array()
+			r.SecurityLabelNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.SecurityLabelNumber = append(r.SecurityLabelNumber, v)
+			}
+			if r.SecurityLabelNumber == nil && !a.isNull {
+				r.SecurityLabelNumber = []int{}
+			}
+		case "_securityLabelNumber":
+			a := d.array()
+			r.SecurityLabelNumberElement = nil
+			for a.next() {
+				r.SecurityLabelNumberElement = append(r.SecurityLabelNumberElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTermOffer which shares no memory with the original
+func (r ContractTermOffer) DeepCopy() ContractTermOffer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Party != nil {
+		out.Party = make([]ContractTermOfferParty, len(r.Party))
+		for i := range r.Party {
+			out.Party[i] = r.Party[i].DeepCopy()
+		}
+	}
+	if r.Topic != nil {
+		v := r.Topic.DeepCopy()
+		out.Topic = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Decision != nil {
+		v := r.Decision.DeepCopy()
+		out.Decision = &v
+	}
+	if r.DecisionMode != nil {
+		out.DecisionMode = make([]CodeableConcept, len(r.DecisionMode))
+		for i := range r.DecisionMode {
+			out.DecisionMode[i] = r.DecisionMode[i].DeepCopy()
+		}
+	}
+	if r.Answer != nil {
+		out.Answer = make([]ContractTermOfferAnswer, len(r.Answer))
+		for i := range r.Answer {
+			out.Answer[i] = r.Answer[i].DeepCopy()
+		}
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.LinkId != nil {
+		out.LinkId = make([]string, len(r.LinkId))
+		copy(out.LinkId, r.LinkId)
+	}
+	if r.LinkIdElement != nil {
+		out.LinkIdElement = make([]*Element, len(r.LinkIdElement))
+		for i, v := range r.LinkIdElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LinkIdElement[i] = &c
+			}
+		}
+	}
+	if r.SecurityLabelNumber != nil {
+		out.SecurityLabelNumber = make([]int, len(r.SecurityLabelNumber))
+		copy(out.SecurityLabelNumber, r.SecurityLabelNumber)
+	}
+	if r.SecurityLabelNumberElement != nil {
+		out.SecurityLabelNumberElement = make([]*Element, len(r.SecurityLabelNumberElement))
+		for i, v := range r.SecurityLabelNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SecurityLabelNumberElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTermOffer) Equal(other ContractTermOffer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.Party) != len(other.Party) {
+		return false
+	}
+	for i := range r.Party {
+		if !r.Party[i].Equal(other.Party[i]) {
+			return false
+		}
+	}
+	if (r.Topic == nil) != (other.Topic == nil) || r.Topic != nil && !r.Topic.Equal(*other.Topic) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Decision == nil) != (other.Decision == nil) || r.Decision != nil && !r.Decision.Equal(*other.Decision) {
+		return false
+	}
+	if len(r.DecisionMode) != len(other.DecisionMode) {
+		return false
+	}
+	for i := range r.DecisionMode {
+		if !r.DecisionMode[i].Equal(other.DecisionMode[i]) {
+			return false
+		}
+	}
+	if len(r.Answer) != len(other.Answer) {
+		return false
+	}
+	for i := range r.Answer {
+		if !r.Answer[i].Equal(other.Answer[i]) {
+			return false
+		}
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if len(r.LinkId) != len(other.LinkId) {
+		return false
+	}
+	for i := range r.LinkId {
+		if r.LinkId[i] != other.LinkId[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LinkIdElement, other.LinkIdElement, false) {
+		return false
+	}
+	if len(r.SecurityLabelNumber) != len(other.SecurityLabelNumber) {
+		return false
+	}
+	for i := range r.SecurityLabelNumber {
+		if r.SecurityLabelNumber[i] != other.SecurityLabelNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SecurityLabelNumberElement, other.SecurityLabelNumberElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTermOffer) EqualsDeep(other ContractTermOffer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Party), len(other.Party), func(i, j int) bool {
+		return r.Party[i].EqualsDeep(other.Party[j])
+	}) {
+		return false
+	}
+	if (r.Topic == nil) != (other.Topic == nil) || r.Topic != nil && !r.Topic.EqualsDeep(*other.Topic) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Decision == nil) != (other.Decision == nil) || r.Decision != nil && !r.Decision.EqualsDeep(*other.Decision) {
+		return false
+	}
+	if !equivalentList(len(r.DecisionMode), len(other.DecisionMode), func(i, j int) bool {
+		return r.DecisionMode[i].EqualsDeep(other.DecisionMode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Answer), len(other.Answer), func(i, j int) bool {
+		return r.Answer[i].EqualsDeep(other.Answer[j])
+	}) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if !equivalentList(len(r.LinkId), len(other.LinkId), func(i, j int) bool {
+		return equivalentString(r.LinkId[i], other.LinkId[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LinkIdElement, other.LinkIdElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabelNumber), len(other.SecurityLabelNumber), func(i, j int) bool {
+		return r.SecurityLabelNumber[i] == other.SecurityLabelNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.SecurityLabelNumberElement, other.SecurityLabelNumberElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTermOffer) EqualsShallow(other ContractTermOffer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.LinkId), len(other.LinkId), func(i, j int) bool {
+		return equivalentString(r.LinkId[i], other.LinkId[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabelNumber), len(other.SecurityLabelNumber), func(i, j int) bool {
+		return r.SecurityLabelNumber[i] == other.SecurityLabelNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTermOffer as FHIR XML
+func (r ContractTermOffer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Party {
+		if err := encodeXMLElement(e, "party", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "topic", r.Topic); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "decision", r.Decision); err != nil {
+		return err
+	}
+	for _, v := range r.DecisionMode {
+		if err := encodeXMLElement(e, "decisionMode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Answer {
+		if err := encodeXMLElement(e, "answer", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	for i, v := range r.LinkId {
+		if err := encodeXMLPrimitive(e, "linkId", v, elementAt(r.LinkIdElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.SecurityLabelNumber {
+		if err := encodeXMLPrimitive(e, "securityLabelNumber", v, elementAt(r.SecurityLabelNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTermOffer from FHIR XML
+func (r *ContractTermOffer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "party":
+				var v ContractTermOfferParty
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Party = append(r.Party, v)
+			case "topic":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Topic = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "decision":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Decision = &v
+			case "decisionMode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DecisionMode = append(r.DecisionMode, v)
+			case "answer":
+				var v ContractTermOfferAnswer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Answer = append(r.Answer, v)
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			case "linkId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LinkIdElement = appendElement(r.LinkIdElement, len(r.LinkId), element)
+					r.LinkId = append(r.LinkId, v)
+				}
+			case "securityLabelNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SecurityLabelNumberElement = appendElement(r.SecurityLabelNumberElement, len(r.SecurityLabelNumber), element)
+					r.SecurityLabelNumber = append(r.SecurityLabelNumber, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTermOffer as properties to the RDF node n
+func (r ContractTermOffer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Contract.term.offer.identifier", i, v)
+	}
+	for i, v := range r.Party {
+		n.element("Contract.term.offer.party", i, v)
+	}
+	n.element("Contract.term.offer.topic", -1, r.Topic)
+	n.element("Contract.term.offer.type", -1, r.Type)
+	n.element("Contract.term.offer.decision", -1, r.Decision)
+	for i, v := range r.DecisionMode {
+		n.element("Contract.term.offer.decisionMode", i, v)
+	}
+	for i, v := range r.Answer {
+		n.element("Contract.term.offer.answer", i, v)
+	}
+	n.primitive("Contract.term.offer.text", -1, r.Text, r.TextElement, "string")
+	for i, v := range r.LinkId {
+		n.primitive("Contract.term.offer.linkId", i, v, elementAt(r.LinkIdElement, i), "string")
+	}
+	for i, v := range r.SecurityLabelNumber {
+		n.primitive("Contract.term.offer.securityLabelNumber", i, v, elementAt(r.SecurityLabelNumberElement, i), "unsignedInt")
+	}
+}
+
+// MarshalBSON marshals the given ContractTermOffer as BSON document with the structure of its FHIR JSON
+func (r ContractTermOffer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTermOffer from a BSON document with the structure of its FHIR JSON
+func (r *ContractTermOffer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTermOffer as protobuf message ContractTermOffer of fhir.proto
+func (r ContractTermOffer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTermOffer to the encoded message
+func (r ContractTermOffer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(4, v)
+	}
+	for _, v := range r.Party {
+		e.message(5, v)
+	}
+	if r.Topic != nil {
+		e.message(6, *r.Topic)
+	}
+	if r.Type != nil {
+		e.message(7, *r.Type)
+	}
+	if r.Decision != nil {
+		e.message(8, *r.Decision)
+	}
+	for _, v := range r.DecisionMode {
+		e.message(9, v)
+	}
+	for _, v := range r.Answer {
+		e.message(10, v)
+	}
+	if r.Text != nil {
+		e.string(11, *r.Text)
+	}
+	for _, v := range r.LinkId {
+		e.string(12, v)
+	}
+	if len(r.SecurityLabelNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.SecurityLabelNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(13, p.buf)
+	}
+	if r.TextElement != nil {
+		e.message(14, *r.TextElement)
+	}
+	for _, v := range r.LinkIdElement {
+		e.element(15, v)
+	}
+	for _, v := range r.SecurityLabelNumberElement {
+		e.element(16, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractTermOffer from protobuf message ContractTermOffer of fhir.proto
+func (r *ContractTermOffer) UnmarshalProto(b []byte) error {
+	*r = ContractTermOffer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 5:
+			var v ContractTermOfferParty
+			d.message(&v)
+			r.Party = append(r.Party, v)
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Topic = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Decision = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.DecisionMode = append(r.DecisionMode, v)
+		case 10:
+			var v ContractTermOfferAnswer
+			d.message(&v)
+			r.Answer = append(r.Answer, v)
+		case 11:
+			v := d.string()
+			r.Text = &v
+		case 12:
+			r.LinkId = append(r.LinkId, d.string())
+		case 13:
+			for _, v := range d.varints() {
+				r.SecurityLabelNumber = append(r.SecurityLabelNumber, int(int32(v)))
+			}
+		case 14:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		case 15:
+			r.LinkIdElement = append(r.LinkIdElement, d.element())
+		case 16:
+			r.SecurityLabelNumberElement = append(r.SecurityLabelNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTermOfferParty as JSON into a byte slice
+func (r ContractTermOfferParty) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTermOfferParty as JSON object with the members in the order of the fields
+func (r ContractTermOfferParty) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("reference")
+	if r.Reference == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("role")
+	r.Role.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTermOfferParty from JSON. Unknown members are ignored.
+func (r *ContractTermOfferParty) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTermOfferParty
+func (r *ContractTermOfferParty) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "reference":
+			a := d.array()
+			r.Reference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Reference = append(r.Reference, v)
+			}
+			if r.Reference == nil && !a.isNull {
+				r.Reference = []Reference{}
+			}
+		case "role":
+			r.Role.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTermOfferParty which shares no memory with the original
+func (r ContractTermOfferParty) DeepCopy() ContractTermOfferParty {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Reference != nil {
+		out.Reference = make([]Reference, len(r.Reference))
+		for i := range r.Reference {
+			out.Reference[i] = r.Reference[i].DeepCopy()
+		}
+	}
+	out.Role = r.Role.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTermOfferParty) Equal(other ContractTermOfferParty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Reference) != len(other.Reference) {
+		return false
+	}
+	for i := range r.Reference {
+		if !r.Reference[i].Equal(other.Reference[i]) {
+			return false
+		}
+	}
+	if !r.Role.Equal(other.Role) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTermOfferParty) EqualsDeep(other ContractTermOfferParty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reference), len(other.Reference), func(i, j int) bool {
+		return r.Reference[i].EqualsDeep(other.Reference[j])
+	}) {
+		return false
+	}
+	if !r.Role.EqualsDeep(other.Role) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTermOfferParty) EqualsShallow(other ContractTermOfferParty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTermOfferParty as FHIR XML
+func (r ContractTermOfferParty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reference {
+		if err := encodeXMLElement(e, "reference", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTermOfferParty from FHIR XML
+func (r *ContractTermOfferParty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "reference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = append(r.Reference, v)
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTermOfferParty as properties to the RDF node n
+func (r ContractTermOfferParty) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Reference {
+		n.element("Contract.term.offer.party.reference", i, v)
+	}
+	n.element("Contract.term.offer.party.role", -1, r.Role)
+}
+
+// MarshalBSON marshals the given ContractTermOfferParty as BSON document with the structure of its FHIR JSON
+func (r ContractTermOfferParty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTermOfferParty from a BSON document with the structure of its FHIR JSON
+func (r *ContractTermOfferParty) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTermOfferParty as protobuf message ContractTermOfferParty of fhir.proto
+func (r ContractTermOfferParty) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTermOfferParty to the encoded message
+func (r ContractTermOfferParty) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Reference {
+		e.message(4, v)
+	}
+	e.message(5, r.Role)
+}
+
+// UnmarshalProto unmarshals the given ContractTermOfferParty from protobuf message ContractTermOfferParty of fhir.proto
+func (r *ContractTermOfferParty) UnmarshalProto(b []byte) error {
+	*r = ContractTermOfferParty{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Reference = append(r.Reference, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTermOfferAnswer as JSON into a byte slice
+func (r ContractTermOfferAnswer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTermOfferAnswer as JSON object with the members in the order of the fields
+func (r ContractTermOfferAnswer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueDecimal != nil {
+		e.key("valueDecimal")
+		e.number(*r.ValueDecimal)
+	}
+	if r.ValueDecimalElement != nil {
+		e.key("_valueDecimal")
+		r.ValueDecimalElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueDate != nil {
+		e.key("valueDate")
+		e.string(*r.ValueDate)
+	}
+	if r.ValueDateElement != nil {
+		e.key("_valueDate")
+		r.ValueDateElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValueTime != nil {
+		e.key("valueTime")
+		e.string(*r.ValueTime)
+	}
+	if r.ValueTimeElement != nil {
+		e.key("_valueTime")
+		r.ValueTimeElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueUri != nil {
+		e.key("valueUri")
+		e.string(*r.ValueUri)
+	}
+	if r.ValueUriElement != nil {
+		e.key("_valueUri")
+		r.ValueUriElement.appendJSON(e)
+	}
+	if r.ValueAttachment != nil {
+		e.key("valueAttachment")
+		r.ValueAttachment.appendJSON(e)
+	}
+	if r.ValueCoding != nil {
+		e.key("valueCoding")
+		r.ValueCoding.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTermOfferAnswer from JSON. Unknown members are ignored.
+func (r *ContractTermOfferAnswer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTermOfferAnswer
+func (r *ContractTermOfferAnswer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueDecimal":
+			if v, ok := d.number(); ok {
+				r.ValueDecimal = &v
+			} else {
+				r.ValueDecimal = nil
+			}
+		case "_valueDecimal":
+			r.ValueDecimalElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueDate":
+			if v, ok := d.string(); ok {
+				r.ValueDate = &v
+			} else {
+				r.ValueDate = nil
+			}
+		case "_valueDate":
+			r.ValueDateElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valueTime":
+			if v, ok := d.string(); ok {
+				r.ValueTime = &v
+			} else {
+				r.ValueTime = nil
+			}
+		case "_valueTime":
+			r.ValueTimeElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueUri":
+			if v, ok := d.string(); ok {
+				r.ValueUri = &v
+			} else {
+				r.ValueUri = nil
+			}
+		case "_valueUri":
+			r.ValueUriElement = d.element()
+		case "valueAttachment":
+			if d.null() {
+				r.ValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ValueAttachment = &v
+			}
+		case "valueCoding":
+			if d.null() {
+				r.ValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.ValueCoding = &v
+			}
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTermOfferAnswer which shares no memory with the original
+func (r ContractTermOfferAnswer) DeepCopy() ContractTermOfferAnswer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueDecimal != nil {
+		v := *r.ValueDecimal
+		out.ValueDecimal = &v
+	}
+	if r.ValueDecimalElement != nil {
+		v := r.ValueDecimalElement.DeepCopy()
+		out.ValueDecimalElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueDate != nil {
+		v := *r.ValueDate
+		out.ValueDate = &v
+	}
+	if r.ValueDateElement != nil {
+		v := r.ValueDateElement.DeepCopy()
+		out.ValueDateElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValueTime != nil {
+		v := *r.ValueTime
+		out.ValueTime = &v
+	}
+	if r.ValueTimeElement != nil {
+		v := r.ValueTimeElement.DeepCopy()
+		out.ValueTimeElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueUri != nil {
+		v := *r.ValueUri
+		out.ValueUri = &v
+	}
+	if r.ValueUriElement != nil {
+		v := r.ValueUriElement.DeepCopy()
+		out.ValueUriElement = &v
+	}
+	if r.ValueAttachment != nil {
+		v := r.ValueAttachment.DeepCopy()
+		out.ValueAttachment = &v
+	}
+	if r.ValueCoding != nil {
+		v := r.ValueCoding.DeepCopy()
+		out.ValueCoding = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTermOfferAnswer) Equal(other ContractTermOfferAnswer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !EqualDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.Equal(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && *r.ValueDate != *other.ValueDate {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.Equal(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && *r.ValueTime != *other.ValueTime {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.Equal(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && *r.ValueUri != *other.ValueUri {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.Equal(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.Equal(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.Equal(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTermOfferAnswer) EqualsDeep(other ContractTermOfferAnswer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.EqualsDeep(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.EqualsDeep(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.EqualsDeep(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.EqualsDeep(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.EqualsDeep(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.EqualsDeep(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTermOfferAnswer) EqualsShallow(other ContractTermOfferAnswer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTermOfferAnswer as FHIR XML
+func (r ContractTermOfferAnswer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDecimal", r.ValueDecimal, r.ValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDate", r.ValueDate, r.ValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueTime", r.ValueTime, r.ValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUri", r.ValueUri, r.ValueUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAttachment", r.ValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCoding", r.ValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTermOfferAnswer from FHIR XML
+func (r *ContractTermOfferAnswer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDecimal = &v
+				}
+				r.ValueDecimalElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDate = &v
+				}
+				r.ValueDateElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueTime = &v
+				}
+				r.ValueTimeElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUri = &v
+				}
+				r.ValueUriElement = element
+			case "valueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAttachment = &v
+			case "valueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCoding = &v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTermOfferAnswer as properties to the RDF node n
+func (r ContractTermOfferAnswer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Contract.term.offer.answer.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("Contract.term.offer.answer.valueDecimal", -1, r.ValueDecimal, r.ValueDecimalElement, "decimal")
+	n.primitive("Contract.term.offer.answer.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("Contract.term.offer.answer.valueDate", -1, r.ValueDate, r.ValueDateElement, "date")
+	n.primitive("Contract.term.offer.answer.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.primitive("Contract.term.offer.answer.valueTime", -1, r.ValueTime, r.ValueTimeElement, "time")
+	n.primitive("Contract.term.offer.answer.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("Contract.term.offer.answer.valueUri", -1, r.ValueUri, r.ValueUriElement, "uri")
+	n.element("Contract.term.offer.answer.valueAttachment", -1, r.ValueAttachment)
+	n.element("Contract.term.offer.answer.valueCoding", -1, r.ValueCoding)
+	n.element("Contract.term.offer.answer.valueQuantity", -1, r.ValueQuantity)
+	n.element("Contract.term.offer.answer.valueReference", -1, r.ValueReference)
+}
+
+// MarshalBSON marshals the given ContractTermOfferAnswer as BSON document with the structure of its FHIR JSON
+func (r ContractTermOfferAnswer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTermOfferAnswer from a BSON document with the structure of its FHIR JSON
+func (r *ContractTermOfferAnswer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTermOfferAnswer as protobuf message ContractTermOfferAnswer of fhir.proto
+func (r ContractTermOfferAnswer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTermOfferAnswer to the encoded message
+func (r ContractTermOfferAnswer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(4, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueDecimal != nil {
+		e.string(5, string(*r.ValueDecimal))
+	}
+	if r.ValueInteger != nil {
+		e.varint(6, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueDate != nil {
+		e.string(7, *r.ValueDate)
+	}
+	if r.ValueDateTime != nil {
+		e.string(8, *r.ValueDateTime)
+	}
+	if r.ValueTime != nil {
+		e.string(9, *r.ValueTime)
+	}
+	if r.ValueString != nil {
+		e.string(10, *r.ValueString)
+	}
+	if r.ValueUri != nil {
+		e.string(11, *r.ValueUri)
+	}
+	if r.ValueAttachment != nil {
+		e.message(12, *r.ValueAttachment)
+	}
+	if r.ValueCoding != nil {
+		e.message(13, *r.ValueCoding)
+	}
+	if r.ValueQuantity != nil {
+		e.message(14, *r.ValueQuantity)
+	}
+	if r.ValueReference != nil {
+		e.message(15, *r.ValueReference)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(16, *r.ValueBooleanElement)
+	}
+	if r.ValueDecimalElement != nil {
+		e.message(17, *r.ValueDecimalElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(18, *r.ValueIntegerElement)
+	}
+	if r.ValueDateElement != nil {
+		e.message(19, *r.ValueDateElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(20, *r.ValueDateTimeElement)
+	}
+	if r.ValueTimeElement != nil {
+		e.message(21, *r.ValueTimeElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(22, *r.ValueStringElement)
+	}
+	if r.ValueUriElement != nil {
+		e.message(23, *r.ValueUriElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractTermOfferAnswer from protobuf message ContractTermOfferAnswer of fhir.proto
+func (r *ContractTermOfferAnswer) UnmarshalProto(b []byte) error {
+	*r = ContractTermOfferAnswer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 5:
+			v := json.Number(d.string())
+			r.ValueDecimal = &v
+		case 6:
+			v := d.int()
+			r.ValueInteger = &v
+		case 7:
+			v := d.string()
+			r.ValueDate = &v
+		case 8:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 9:
+			v := d.string()
+			r.ValueTime = &v
+		case 10:
+			v := d.string()
+			r.ValueString = &v
+		case 11:
+			v := d.string()
+			r.ValueUri = &v
+		case 12:
+			var v Attachment
+			d.message(&v)
+			r.ValueAttachment = &v
+		case 13:
+			var v Coding
+			d.message(&v)
+			r.ValueCoding = &v
+		case 14:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ValueDecimalElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.ValueDateElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ValueTimeElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ValueUriElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTermAsset as JSON into a byte slice
+func (r ContractTermAsset) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTermAsset as JSON object with the members in the order of the fields
+func (r ContractTermAsset) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Scope != nil {
+		e.key("scope")
+		r.Scope.appendJSON(e)
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.TypeReference) > 0 {
+		e.key("typeReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.TypeReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Subtype) > 0 {
+		e.key("subtype")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subtype {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Relationship != nil {
+		e.key("relationship")
+		r.Relationship.appendJSON(e)
+	}
+	if len(r.Context) > 0 {
+		e.key("context")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Context {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Condition != nil {
+		e.key("condition")
+		e.string(*r.Condition)
+	}
+	if r.ConditionElement != nil {
+		e.key("_condition")
+		r.ConditionElement.appendJSON(e)
+	}
+	if len(r.PeriodType) > 0 {
+		e.key("periodType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PeriodType {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Period) > 0 {
+		e.key("period")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Period {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.UsePeriod) > 0 {
+		e.key("usePeriod")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UsePeriod {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	if len(r.LinkId) > 0 {
+		e.key("linkId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LinkId {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LinkIdElement) > 0 {
+		e.key("_linkId")
+		e.elements(r.LinkIdElement, len(r.LinkId))
+	}
+	if len(r.Answer) > 0 {
+		e.key("answer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Answer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SecurityLabelNumber) > 0 {
+		e.key("securityLabelNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SecurityLabelNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SecurityLabelNumberElement) > 0 {
+		e.key("_securityLabelNumber")
+		e.elements(r.SecurityLabelNumberElement, len(r.SecurityLabelNumber))
+	}
+	if len(r.ValuedItem) > 0 {
+		e.key("valuedItem")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ValuedItem {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTermAsset from JSON. Unknown members are ignored.
+func (r *ContractTermAsset) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTermAsset
+func (r *ContractTermAsset) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "scope":
+			if d.null() {
+				r.Scope = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Scope = &v
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "typeReference":
+			a := d.array()
+			r.TypeReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.TypeReference = append(r.TypeReference, v)
+			}
+			if r.TypeReference == nil && !a.isNull {
+				r.TypeReference = []Reference{}
+			}
+		case "subtype":
+			a := d.array()
+			r.Subtype = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Subtype = append(r.Subtype, v)
+			}
+			if r.Subtype == nil && !a.isNull {
+				r.Subtype = []CodeableConcept{}
+			}
+		case "relationship":
+			if d.null() {
+				r.Relationship = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Relationship = &v
+			}
+		case "context":
+			a := d.array()
+			r.Context = nil
+			for a.next() {
+				var v ContractTermAssetContext
+				v.decodeJSON(d)
+				r.Context = append(r.Context, v)
+			}
+			if r.Context == nil && !a.isNull {
+				r.Context = []ContractTermAssetContext{}
+			}
+		case "condition":
+			if v, ok := d.string(); ok {
+				r.Condition = &v
+			} else {
+				r.Condition = nil
+			}
+		case "_condition":
+			r.ConditionElement = d.element()
+		case "periodType":
+			a := d.array()
+			r.PeriodType = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PeriodType = append(r.PeriodType, v)
+			}
+			if r.PeriodType == nil && !a.isNull {
+				r.PeriodType = []CodeableConcept{}
+			}
+		case "period":
+			a := d.array()
+			r.Period = nil
+			for a.next() {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = append(r.Period, v)
+			}
+			if r.Period == nil && !a.isNull {
+				r.Period = []Period{}
+			}
+		case "usePeriod":
+			a := d.array()
+			r.UsePeriod = nil
+			for a.next() {
+				var v Period
+				v.decodeJSON(d)
+				r.UsePeriod = append(r.UsePeriod, v)
+			}
+			if r.UsePeriod == nil && !a.isNull {
+				r.UsePeriod = []Period{}
+			}
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "linkId":
+			a := d.array()
+			r.LinkId = nil
+			for a.next() {
+				v, _ := d.string()
+				r.LinkId = append(r.LinkId, v)
+			}
+			if r.LinkId == nil && !a.isNull {
+				r.LinkId = []string{}
+			}
+		case "_linkId":
+			a := d.array()
+			r.LinkIdElement = nil
+			for a.next() {
+				r.LinkIdElement = append(r.LinkIdElement, d.element())
+			}
+		case "answer":
+			a := d.array()
+			r.Answer = nil
+			for a.next() {
+				var v ContractTermOfferAnswer
+				v.decodeJSON(d)
+				r.Answer = append(r.Answer, v)
+			}
+			if r.Answer == nil && !a.isNull {
+				r.Answer = []ContractTermOfferAnswer{}
+			}
+		case "securityLabelNumber":
+			a := d.array()
+			r.SecurityLabelNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.SecurityLabelNumber = append(r.SecurityLabelNumber, v)
+			}
+			if r.SecurityLabelNumber == nil && !a.isNull {
+				r.SecurityLabelNumber = []int{}
+			}
+		case "_securityLabelNumber":
+			a := d.array()
+			r.SecurityLabelNumberElement = nil
+			for a.next() {
+				r.SecurityLabelNumberElement = append(r.SecurityLabelNumberElement, d.element())
+			}
+		case "valuedItem":
+			a := d.array()
+			r.ValuedItem = nil
+			for a.next() {
+				var v ContractTermAssetValuedItem
+				v.decodeJSON(d)
+				r.ValuedItem = append(r.ValuedItem, v)
+			}
+			if r.ValuedItem == nil && !a.isNull {
+				r.ValuedItem = []ContractTermAssetValuedItem{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTermAsset which shares no memory with the original
+func (r ContractTermAsset) DeepCopy() ContractTermAsset {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Scope != nil {
+		v := r.Scope.DeepCopy()
+		out.Scope = &v
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.TypeReference != nil {
+		out.TypeReference = make([]Reference, len(r.TypeReference))
+		for i := range r.TypeReference {
+			out.TypeReference[i] = r.TypeReference[i].DeepCopy()
+		}
+	}
+	if r.Subtype != nil {
+		out.Subtype = make([]CodeableConcept, len(r.Subtype))
+		for i := range r.Subtype {
+			out.Subtype[i] = r.Subtype[i].DeepCopy()
+		}
+	}
+	if r.Relationship != nil {
+		v := r.Relationship.DeepCopy()
+		out.Relationship = &v
+	}
+	if r.Context != nil {
+		out.Context = make([]ContractTermAssetContext, len(r.Context))
+		for i := range r.Context {
+			out.Context[i] = r.Context[i].DeepCopy()
+		}
+	}
+	if r.Condition != nil {
+		v := *r.Condition
+		out.Condition = &v
+	}
+	if r.ConditionElement != nil {
+		v := r.ConditionElement.DeepCopy()
+		out.ConditionElement = &v
+	}
+	if r.PeriodType != nil {
+		out.PeriodType = make([]CodeableConcept, len(r.PeriodType))
+		for i := range r.PeriodType {
+			out.PeriodType[i] = r.PeriodType[i].DeepCopy()
+		}
+	}
+	if r.Period != nil {
+		out.Period = make([]Period, len(r.Period))
+		for i := range r.Period {
+			out.Period[i] = r.Period[i].DeepCopy()
+		}
+	}
+	if r.UsePeriod != nil {
+		out.UsePeriod = make([]Period, len(r.UsePeriod))
+		for i := range r.UsePeriod {
+			out.UsePeriod[i] = r.UsePeriod[i].DeepCopy()
+		}
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.LinkId != nil {
+		out.LinkId = make([]string, len(r.LinkId))
+		copy(out.LinkId, r.LinkId)
+	}
+	if r.LinkIdElement != nil {
+		out.LinkIdElement = make([]*Element, len(r.LinkIdElement))
+		for i, v := range r.LinkIdElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LinkIdElement[i] = &c
+			}
+		}
+	}
+	if r.Answer != nil {
+		out.Answer = make([]ContractTermOfferAnswer, len(r.Answer))
+		for i := range r.Answer {
+			out.Answer[i] = r.Answer[i].DeepCopy()
+		}
+	}
+	if r.SecurityLabelNumber != nil {
+		out.SecurityLabelNumber = make([]int, len(r.SecurityLabelNumber))
+		copy(out.SecurityLabelNumber, r.SecurityLabelNumber)
+	}
+	if r.SecurityLabelNumberElement != nil {
+		out.SecurityLabelNumberElement = make([]*Element, len(r.SecurityLabelNumberElement))
+		for i, v := range r.SecurityLabelNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SecurityLabelNumberElement[i] = &c
+			}
+		}
+	}
+	if r.ValuedItem != nil {
+		out.ValuedItem = make([]ContractTermAssetValuedItem, len(r.ValuedItem))
+		for i := range r.ValuedItem {
+			out.ValuedItem[i] = r.ValuedItem[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTermAsset) Equal(other ContractTermAsset) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Scope == nil) != (other.Scope == nil) || r.Scope != nil && !r.Scope.Equal(*other.Scope) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if len(r.TypeReference) != len(other.TypeReference) {
+		return false
+	}
+	for i := range r.TypeReference {
+		if !r.TypeReference[i].Equal(other.TypeReference[i]) {
+			return false
+		}
+	}
+	if len(r.Subtype) != len(other.Subtype) {
+		return false
+	}
+	for i := range r.Subtype {
+		if !r.Subtype[i].Equal(other.Subtype[i]) {
+			return false
+		}
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.Equal(*other.Relationship) {
+		return false
+	}
+	if len(r.Context) != len(other.Context) {
+		return false
+	}
+	for i := range r.Context {
+		if !r.Context[i].Equal(other.Context[i]) {
+			return false
+		}
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && *r.Condition != *other.Condition {
+		return false
+	}
+	if (r.ConditionElement == nil) != (other.ConditionElement == nil) || r.ConditionElement != nil && !r.ConditionElement.Equal(*other.ConditionElement) {
+		return false
+	}
+	if len(r.PeriodType) != len(other.PeriodType) {
+		return false
+	}
+	for i := range r.PeriodType {
+		if !r.PeriodType[i].Equal(other.PeriodType[i]) {
+			return false
+		}
+	}
+	if len(r.Period) != len(other.Period) {
+		return false
+	}
+	for i := range r.Period {
+		if !r.Period[i].Equal(other.Period[i]) {
+			return false
+		}
+	}
+	if len(r.UsePeriod) != len(other.UsePeriod) {
+		return false
+	}
+	for i := range r.UsePeriod {
+		if !r.UsePeriod[i].Equal(other.UsePeriod[i]) {
+			return false
+		}
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if len(r.LinkId) != len(other.LinkId) {
+		return false
+	}
+	for i := range r.LinkId {
+		if r.LinkId[i] != other.LinkId[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LinkIdElement, other.LinkIdElement, false) {
+		return false
+	}
+	if len(r.Answer) != len(other.Answer) {
+		return false
+	}
+	for i := range r.Answer {
+		if !r.Answer[i].Equal(other.Answer[i]) {
+			return false
+		}
+	}
+	if len(r.SecurityLabelNumber) != len(other.SecurityLabelNumber) {
+		return false
+	}
+	for i := range r.SecurityLabelNumber {
+		if r.SecurityLabelNumber[i] != other.SecurityLabelNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SecurityLabelNumberElement, other.SecurityLabelNumberElement, false) {
+		return false
+	}
+	if len(r.ValuedItem) != len(other.ValuedItem) {
+		return false
+	}
+	for i := range r.ValuedItem {
+		if !r.ValuedItem[i].Equal(other.ValuedItem[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTermAsset) EqualsDeep(other ContractTermAsset) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Scope == nil) != (other.Scope == nil) || r.Scope != nil && !r.Scope.EqualsDeep(*other.Scope) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.TypeReference), len(other.TypeReference), func(i, j int) bool {
+		return r.TypeReference[i].EqualsDeep(other.TypeReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Subtype), len(other.Subtype), func(i, j int) bool {
+		return r.Subtype[i].EqualsDeep(other.Subtype[j])
+	}) {
+		return false
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.EqualsDeep(*other.Relationship) {
+		return false
+	}
+	if !equivalentList(len(r.Context), len(other.Context), func(i, j int) bool {
+		return r.Context[i].EqualsDeep(other.Context[j])
+	}) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !equivalentString(*r.Condition, *other.Condition) {
+		return false
+	}
+	if (r.ConditionElement == nil) != (other.ConditionElement == nil) || r.ConditionElement != nil && !r.ConditionElement.EqualsDeep(*other.ConditionElement) {
+		return false
+	}
+	if !equivalentList(len(r.PeriodType), len(other.PeriodType), func(i, j int) bool {
+		return r.PeriodType[i].EqualsDeep(other.PeriodType[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Period), len(other.Period), func(i, j int) bool {
+		return r.Period[i].EqualsDeep(other.Period[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.UsePeriod), len(other.UsePeriod), func(i, j int) bool {
+		return r.UsePeriod[i].EqualsDeep(other.UsePeriod[j])
+	}) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if !equivalentList(len(r.LinkId), len(other.LinkId), func(i, j int) bool {
+		return equivalentString(r.LinkId[i], other.LinkId[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LinkIdElement, other.LinkIdElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Answer), len(other.Answer), func(i, j int) bool {
+		return r.Answer[i].EqualsDeep(other.Answer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabelNumber), len(other.SecurityLabelNumber), func(i, j int) bool {
+		return r.SecurityLabelNumber[i] == other.SecurityLabelNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.SecurityLabelNumberElement, other.SecurityLabelNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.ValuedItem), len(other.ValuedItem), func(i, j int) bool {
+		return r.ValuedItem[i].EqualsDeep(other.ValuedItem[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTermAsset) EqualsShallow(other ContractTermAsset) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !equivalentString(*r.Condition, *other.Condition) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.LinkId), len(other.LinkId), func(i, j int) bool {
+		return equivalentString(r.LinkId[i], other.LinkId[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabelNumber), len(other.SecurityLabelNumber), func(i, j int) bool {
+		return r.SecurityLabelNumber[i] == other.SecurityLabelNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTermAsset as FHIR XML
+func (r ContractTermAsset) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "scope", r.Scope); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.TypeReference {
+		if err := encodeXMLElement(e, "typeReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Subtype {
+		if err := encodeXMLElement(e, "subtype", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "relationship", r.Relationship); err != nil {
+		return err
+	}
+	for _, v := range r.Context {
+		if err := encodeXMLElement(e, "context", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "condition", r.Condition, r.ConditionElement); err != nil {
+		return err
+	}
+	for _, v := range r.PeriodType {
+		if err := encodeXMLElement(e, "periodType", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Period {
+		if err := encodeXMLElement(e, "period", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.UsePeriod {
+		if err := encodeXMLElement(e, "usePeriod", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	for i, v := range r.LinkId {
+		if err := encodeXMLPrimitive(e, "linkId", v, elementAt(r.LinkIdElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Answer {
+		if err := encodeXMLElement(e, "answer", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.SecurityLabelNumber {
+		if err := encodeXMLPrimitive(e, "securityLabelNumber", v, elementAt(r.SecurityLabelNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ValuedItem {
+		if err := encodeXMLElement(e, "valuedItem", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTermAsset from FHIR XML
+func (r *ContractTermAsset) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "scope":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Scope = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "typeReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TypeReference = append(r.TypeReference, v)
+			case "subtype":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subtype = append(r.Subtype, v)
+			case "relationship":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Relationship = &v
+			case "context":
+				var v ContractTermAssetContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Context = append(r.Context, v)
+			case "condition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Condition = &v
+				}
+				r.ConditionElement = element
+			case "periodType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PeriodType = append(r.PeriodType, v)
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = append(r.Period, v)
+			case "usePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UsePeriod = append(r.UsePeriod, v)
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			case "linkId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LinkIdElement = appendElement(r.LinkIdElement, len(r.LinkId), element)
+					r.LinkId = append(r.LinkId, v)
+				}
+			case "answer":
+				var v ContractTermOfferAnswer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Answer = append(r.Answer, v)
+			case "securityLabelNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SecurityLabelNumberElement = appendElement(r.SecurityLabelNumberElement, len(r.SecurityLabelNumber), element)
+					r.SecurityLabelNumber = append(r.SecurityLabelNumber, v)
+				}
+			case "valuedItem":
+				var v ContractTermAssetValuedItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValuedItem = append(r.ValuedItem, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTermAsset as properties to the RDF node n
+func (r ContractTermAsset) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Contract.term.asset.scope", -1, r.Scope)
+	for i, v := range r.Type {
+		n.element("Contract.term.asset.type", i, v)
+	}
+	for i, v := range r.TypeReference {
+		n.element("Contract.term.asset.typeReference", i, v)
+	}
+	for i, v := range r.Subtype {
+		n.element("Contract.term.asset.subtype", i, v)
+	}
+	n.element("Contract.term.asset.relationship", -1, r.Relationship)
+	for i, v := range r.Context {
+		n.element("Contract.term.asset.context", i, v)
+	}
+	n.primitive("Contract.term.asset.condition", -1, r.Condition, r.ConditionElement, "string")
+	for i, v := range r.PeriodType {
+		n.element("Contract.term.asset.periodType", i, v)
+	}
+	for i, v := range r.Period {
+		n.element("Contract.term.asset.period", i, v)
+	}
+	for i, v := range r.UsePeriod {
+		n.element("Contract.term.asset.usePeriod", i, v)
+	}
+	n.primitive("Contract.term.asset.text", -1, r.Text, r.TextElement, "string")
+	for i, v := range r.LinkId {
+		n.primitive("Contract.term.asset.linkId", i, v, elementAt(r.LinkIdElement, i), "string")
+	}
+	for i, v := range r.Answer {
+		n.element("Contract.term.asset.answer", i, v)
+	}
+	for i, v := range r.SecurityLabelNumber {
+		n.primitive("Contract.term.asset.securityLabelNumber", i, v, elementAt(r.SecurityLabelNumberElement, i), "unsignedInt")
+	}
+	for i, v := range r.ValuedItem {
+		n.element("Contract.term.asset.valuedItem", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ContractTermAsset as BSON document with the structure of its FHIR JSON
+func (r ContractTermAsset) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTermAsset from a BSON document with the structure of its FHIR JSON
+func (r *ContractTermAsset) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTermAsset as protobuf message ContractTermAsset of fhir.proto
+func (r ContractTermAsset) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTermAsset to the encoded message
+func (r ContractTermAsset) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Scope != nil {
+		e.message(4, *r.Scope)
+	}
+	for _, v := range r.Type {
+		e.message(5, v)
+	}
+	for _, v := range r.TypeReference {
+		e.message(6, v)
+	}
+	for _, v := range r.Subtype {
+		e.message(7, v)
+	}
+	if r.Relationship != nil {
+		e.message(8, *r.Relationship)
+	}
+	for _, v := range r.Context {
+		e.message(9, v)
+	}
+	if r.Condition != nil {
+		e.string(10, *r.Condition)
+	}
+	for _, v := range r.PeriodType {
+		e.message(11, v)
+	}
+	for _, v := range r.Period {
+		e.message(12, v)
+	}
+	for _, v := range r.UsePeriod {
+		e.message(13, v)
+	}
+	if r.Text != nil {
+		e.string(14, *r.Text)
+	}
+	for _, v := range r.LinkId {
+		e.string(15, v)
+	}
+	for _, v := range r.Answer {
+		e.message(16, v)
+	}
+	if len(r.SecurityLabelNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.SecurityLabelNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(17, p.buf)
+	}
+	for _, v := range r.ValuedItem {
+		e.message(18, v)
+	}
+	if r.ConditionElement != nil {
+		e.message(19, *r.ConditionElement)
+	}
+	if r.TextElement != nil {
+		e.message(20, *r.TextElement)
+	}
+	for _, v := range r.LinkIdElement {
+		e.element(21, v)
+	}
+	for _, v := range r.SecurityLabelNumberElement {
+		e.element(22, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractTermAsset from protobuf message ContractTermAsset of fhir.proto
+func (r *ContractTermAsset) UnmarshalProto(b []byte) error {
+	*r = ContractTermAsset{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Scope = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.TypeReference = append(r.TypeReference, v)
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Subtype = append(r.Subtype, v)
+		case 8:
+			var v Coding
+			d.message(&v)
+			r.Relationship = &v
+		case 9:
+			var v ContractTermAssetContext
+			d.message(&v)
+			r.Context = append(r.Context, v)
+		case 10:
+			v := d.string()
+			r.Condition = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.PeriodType = append(r.PeriodType, v)
+		case 12:
+			var v Period
+			d.message(&v)
+			r.Period = append(r.Period, v)
+		case 13:
+			var v Period
+			d.message(&v)
+			r.UsePeriod = append(r.UsePeriod, v)
+		case 14:
+			v := d.string()
+			r.Text = &v
+		case 15:
+			r.LinkId = append(r.LinkId, d.string())
+		case 16:
+			var v ContractTermOfferAnswer
+			d.message(&v)
+			r.Answer = append(r.Answer, v)
+		case 17:
+			for _, v := range d.varints() {
+				r.SecurityLabelNumber = append(r.SecurityLabelNumber, int(int32(v)))
+			}
+		case 18:
+			var v ContractTermAssetValuedItem
+			d.message(&v)
+			r.ValuedItem = append(r.ValuedItem, v)
+		case 19:
+			var v Element
+			d.message(&v)
+			r.ConditionElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		case 21:
+			r.LinkIdElement = append(r.LinkIdElement, d.element())
+		case 22:
+			r.SecurityLabelNumberElement = append(r.SecurityLabelNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTermAssetContext as JSON into a byte slice
+func (r ContractTermAssetContext) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTermAssetContext as JSON object with the members in the order of the fields
+func (r ContractTermAssetContext) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Reference != nil {
+		e.key("reference")
+		r.Reference.appendJSON(e)
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTermAssetContext from JSON. Unknown members are ignored.
+func (r *ContractTermAssetContext) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTermAssetContext
+func (r *ContractTermAssetContext) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "reference":
+			if d.null() {
+				r.Reference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Reference = &v
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTermAssetContext which shares no memory with the original
+func (r ContractTermAssetContext) DeepCopy() ContractTermAssetContext {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Reference != nil {
+		v := r.Reference.DeepCopy()
+		out.Reference = &v
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTermAssetContext) Equal(other ContractTermAssetContext) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !r.Reference.Equal(*other.Reference) {
+		return false
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTermAssetContext) EqualsDeep(other ContractTermAssetContext) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !r.Reference.EqualsDeep(*other.Reference) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTermAssetContext) EqualsShallow(other ContractTermAssetContext) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTermAssetContext as FHIR XML
+func (r ContractTermAssetContext) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "reference", r.Reference); err != nil {
+		return err
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTermAssetContext from FHIR XML
+func (r *ContractTermAssetContext) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "reference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTermAssetContext as properties to the RDF node n
+func (r ContractTermAssetContext) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Contract.term.asset.context.reference", -1, r.Reference)
+	for i, v := range r.Code {
+		n.element("Contract.term.asset.context.code", i, v)
+	}
+	n.primitive("Contract.term.asset.context.text", -1, r.Text, r.TextElement, "string")
+}
+
+// MarshalBSON marshals the given ContractTermAssetContext as BSON document with the structure of its FHIR JSON
+func (r ContractTermAssetContext) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTermAssetContext from a BSON document with the structure of its FHIR JSON
+func (r *ContractTermAssetContext) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTermAssetContext as protobuf message ContractTermAssetContext of fhir.proto
+func (r ContractTermAssetContext) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTermAssetContext to the encoded message
+func (r ContractTermAssetContext) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Reference != nil {
+		e.message(4, *r.Reference)
+	}
+	for _, v := range r.Code {
+		e.message(5, v)
+	}
+	if r.Text != nil {
+		e.string(6, *r.Text)
+	}
+	if r.TextElement != nil {
+		e.message(7, *r.TextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractTermAssetContext from protobuf message ContractTermAssetContext of fhir.proto
+func (r *ContractTermAssetContext) UnmarshalProto(b []byte) error {
+	*r = ContractTermAssetContext{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Reference = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 6:
+			v := d.string()
+			r.Text = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTermAssetValuedItem as JSON into a byte slice
+func (r ContractTermAssetValuedItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTermAssetValuedItem as JSON object with the members in the order of the fields
+func (r ContractTermAssetValuedItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.EntityCodeableConcept != nil {
+		e.key("entityCodeableConcept")
+		r.EntityCodeableConcept.appendJSON(e)
+	}
+	if r.EntityReference != nil {
+		e.key("entityReference")
+		r.EntityReference.appendJSON(e)
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.EffectiveTime != nil {
+		e.key("effectiveTime")
+		e.string(*r.EffectiveTime)
+	}
+	if r.EffectiveTimeElement != nil {
+		e.key("_effectiveTime")
+		r.EffectiveTimeElement.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Points != nil {
+		e.key("points")
+		e.number(*r.Points)
+	}
+	if r.PointsElement != nil {
+		e.key("_points")
+		r.PointsElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if r.Payment != nil {
+		e.key("payment")
+		e.string(*r.Payment)
+	}
+	if r.PaymentElement != nil {
+		e.key("_payment")
+		r.PaymentElement.appendJSON(e)
+	}
+	if r.PaymentDate != nil {
+		e.key("paymentDate")
+		e.string(*r.PaymentDate)
+	}
+	if r.PaymentDateElement != nil {
+		e.key("_paymentDate")
+		r.PaymentDateElement.appendJSON(e)
+	}
+	if r.Responsible != nil {
+		e.key("responsible")
+		r.Responsible.appendJSON(e)
+	}
+	if r.Recipient != nil {
+		e.key("recipient")
+		r.Recipient.appendJSON(e)
+	}
+	if len(r.LinkId) > 0 {
+		e.key("linkId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LinkId {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LinkIdElement) > 0 {
+		e.key("_linkId")
+		e.elements(r.LinkIdElement, len(r.LinkId))
+	}
+	if len(r.SecurityLabelNumber) > 0 {
+		e.key("securityLabelNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SecurityLabelNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SecurityLabelNumberElement) > 0 {
+		e.key("_securityLabelNumber")
+		e.elements(r.SecurityLabelNumberElement, len(r.SecurityLabelNumber))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTermAssetValuedItem from JSON. Unknown members are ignored.
+func (r *ContractTermAssetValuedItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTermAssetValuedItem
+func (r *ContractTermAssetValuedItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "entityCodeableConcept":
+			if d.null() {
+				r.EntityCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.EntityCodeableConcept = &v
+			}
+		case "entityReference":
+			if d.null() {
+				r.EntityReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.EntityReference = &v
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "effectiveTime":
+			if v, ok := d.string(); ok {
+				r.EffectiveTime = &v
+			} else {
+				r.EffectiveTime = nil
+			}
+		case "_effectiveTime":
+			r.EffectiveTimeElement = d.element()
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "points":
+			if v, ok := d.number(); ok {
+				r.Points = &v
+			} else {
+				r.Points = nil
+			}
+		case "_points":
+			r.PointsElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "payment":
+			if v, ok := d.string(); ok {
+				r.Payment = &v
+			} else {
+				r.Payment = nil
+			}
+		case "_payment":
+			r.PaymentElement = d.element()
+		case "paymentDate":
+			if v, ok := d.string(); ok {
+				r.PaymentDate = &v
+			} else {
+				r.PaymentDate = nil
+			}
+		case "_paymentDate":
+			r.PaymentDateElement = d.element()
+		case "responsible":
+			if d.null() {
+				r.Responsible = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Responsible = &v
+			}
+		case "recipient":
+			if d.null() {
+				r.Recipient = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recipient = &v
+			}
+		case "linkId":
+			a := d.array()
+			r.LinkId = nil
+			for a.next() {
+				v, _ := d.string()
+				r.LinkId = append(r.LinkId, v)
+			}
+			if r.LinkId == nil && !a.isNull {
+				r.LinkId = []string{}
+			}
+		case "_linkId":
+			a := d.array()
+			r.LinkIdElement = nil
+			for a.next() {
+				r.LinkIdElement = append(r.LinkIdElement, d.element())
+			}
+		case "securityLabelNumber":
+			a := d.array()
+			r.SecurityLabelNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.SecurityLabelNumber = append(r.SecurityLabelNumber, v)
+			}
+			if r.SecurityLabelNumber == nil && !a.isNull {
+				r.SecurityLabelNumber = []int{}
+			}
+		case "_securityLabelNumber":
+			a := d.array()
+			r.SecurityLabelNumberElement = nil
+			for a.next() {
+				r.SecurityLabelNumberElement = append(r.SecurityLabelNumberElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTermAssetValuedItem which shares no memory with the original
+func (r ContractTermAssetValuedItem) DeepCopy() ContractTermAssetValuedItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.EntityCodeableConcept != nil {
+		v := r.EntityCodeableConcept.DeepCopy()
+		out.EntityCodeableConcept = &v
+	}
+	if r.EntityReference != nil {
+		v := r.EntityReference.DeepCopy()
+		out.EntityReference = &v
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.EffectiveTime != nil {
+		v := *r.EffectiveTime
+		out.EffectiveTime = &v
+	}
+	if r.EffectiveTimeElement != nil {
+		v := r.EffectiveTimeElement.DeepCopy()
+		out.EffectiveTimeElement = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Points != nil {
+		v := *r.Points
+		out.Points = &v
+	}
+	if r.PointsElement != nil {
+		v := r.PointsElement.DeepCopy()
+		out.PointsElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.Payment != nil {
+		v := *r.Payment
+		out.Payment = &v
+	}
+	if r.PaymentElement != nil {
+		v := r.PaymentElement.DeepCopy()
+		out.PaymentElement = &v
+	}
+	if r.PaymentDate != nil {
+		v := *r.PaymentDate
+		out.PaymentDate = &v
+	}
+	if r.PaymentDateElement != nil {
+		v := r.PaymentDateElement.DeepCopy()
+		out.PaymentDateElement = &v
+	}
+	if r.Responsible != nil {
+		v := r.Responsible.DeepCopy()
+		out.Responsible = &v
+	}
+	if r.Recipient != nil {
+		v := r.Recipient.DeepCopy()
+		out.Recipient = &v
+	}
+	if r.LinkId != nil {
+		out.LinkId = make([]string, len(r.LinkId))
+		copy(out.LinkId, r.LinkId)
+	}
+	if r.LinkIdElement != nil {
+		out.LinkIdElement = make([]*Element, len(r.LinkIdElement))
+		for i, v := range r.LinkIdElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LinkIdElement[i] = &c
+			}
+		}
+	}
+	if r.SecurityLabelNumber != nil {
+		out.SecurityLabelNumber = make([]int, len(r.SecurityLabelNumber))
+		copy(out.SecurityLabelNumber, r.SecurityLabelNumber)
+	}
+	if r.SecurityLabelNumberElement != nil {
+		out.SecurityLabelNumberElement = make([]*Element, len(r.SecurityLabelNumberElement))
+		for i, v := range r.SecurityLabelNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SecurityLabelNumberElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTermAssetValuedItem) Equal(other ContractTermAssetValuedItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.EntityCodeableConcept == nil) != (other.EntityCodeableConcept == nil) || r.EntityCodeableConcept != nil && !r.EntityCodeableConcept.Equal(*other.EntityCodeableConcept) {
+		return false
+	}
+	if (r.EntityReference == nil) != (other.EntityReference == nil) || r.EntityReference != nil && !r.EntityReference.Equal(*other.EntityReference) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.EffectiveTime == nil) != (other.EffectiveTime == nil) || r.EffectiveTime != nil && *r.EffectiveTime != *other.EffectiveTime {
+		return false
+	}
+	if (r.EffectiveTimeElement == nil) != (other.EffectiveTimeElement == nil) || r.EffectiveTimeElement != nil && !r.EffectiveTimeElement.Equal(*other.EffectiveTimeElement) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Points == nil) != (other.Points == nil) || r.Points != nil && !EqualDecimal(*r.Points, *other.Points) {
+		return false
+	}
+	if (r.PointsElement == nil) != (other.PointsElement == nil) || r.PointsElement != nil && !r.PointsElement.Equal(*other.PointsElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if (r.Payment == nil) != (other.Payment == nil) || r.Payment != nil && *r.Payment != *other.Payment {
+		return false
+	}
+	if (r.PaymentElement == nil) != (other.PaymentElement == nil) || r.PaymentElement != nil && !r.PaymentElement.Equal(*other.PaymentElement) {
+		return false
+	}
+	if (r.PaymentDate == nil) != (other.PaymentDate == nil) || r.PaymentDate != nil && *r.PaymentDate != *other.PaymentDate {
+		return false
+	}
+	if (r.PaymentDateElement == nil) != (other.PaymentDateElement == nil) || r.PaymentDateElement != nil && !r.PaymentDateElement.Equal(*other.PaymentDateElement) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && !r.Responsible.Equal(*other.Responsible) {
+		return false
+	}
+	if (r.Recipient == nil) != (other.Recipient == nil) || r.Recipient != nil && !r.Recipient.Equal(*other.Recipient) {
+		return false
+	}
+	if len(r.LinkId) != len(other.LinkId) {
+		return false
+	}
+	for i := range r.LinkId {
+		if r.LinkId[i] != other.LinkId[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LinkIdElement, other.LinkIdElement, false) {
+		return false
+	}
+	if len(r.SecurityLabelNumber) != len(other.SecurityLabelNumber) {
+		return false
+	}
+	for i := range r.SecurityLabelNumber {
+		if r.SecurityLabelNumber[i] != other.SecurityLabelNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SecurityLabelNumberElement, other.SecurityLabelNumberElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTermAssetValuedItem) EqualsDeep(other ContractTermAssetValuedItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.EntityCodeableConcept == nil) != (other.EntityCodeableConcept == nil) || r.EntityCodeableConcept != nil && !r.EntityCodeableConcept.EqualsDeep(*other.EntityCodeableConcept) {
+		return false
+	}
+	if (r.EntityReference == nil) != (other.EntityReference == nil) || r.EntityReference != nil && !r.EntityReference.EqualsDeep(*other.EntityReference) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.EffectiveTime == nil) != (other.EffectiveTime == nil) || r.EffectiveTime != nil && !equivalentString(*r.EffectiveTime, *other.EffectiveTime) {
+		return false
+	}
+	if (r.EffectiveTimeElement == nil) != (other.EffectiveTimeElement == nil) || r.EffectiveTimeElement != nil && !r.EffectiveTimeElement.EqualsDeep(*other.EffectiveTimeElement) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Points == nil) != (other.Points == nil) || r.Points != nil && !equivalentDecimal(*r.Points, *other.Points) {
+		return false
+	}
+	if (r.PointsElement == nil) != (other.PointsElement == nil) || r.PointsElement != nil && !r.PointsElement.EqualsDeep(*other.PointsElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if (r.Payment == nil) != (other.Payment == nil) || r.Payment != nil && !equivalentString(*r.Payment, *other.Payment) {
+		return false
+	}
+	if (r.PaymentElement == nil) != (other.PaymentElement == nil) || r.PaymentElement != nil && !r.PaymentElement.EqualsDeep(*other.PaymentElement) {
+		return false
+	}
+	if (r.PaymentDate == nil) != (other.PaymentDate == nil) || r.PaymentDate != nil && !equivalentString(*r.PaymentDate, *other.PaymentDate) {
+		return false
+	}
+	if (r.PaymentDateElement == nil) != (other.PaymentDateElement == nil) || r.PaymentDateElement != nil && !r.PaymentDateElement.EqualsDeep(*other.PaymentDateElement) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && !r.Responsible.EqualsDeep(*other.Responsible) {
+		return false
+	}
+	if (r.Recipient == nil) != (other.Recipient == nil) || r.Recipient != nil && !r.Recipient.EqualsDeep(*other.Recipient) {
+		return false
+	}
+	if !equivalentList(len(r.LinkId), len(other.LinkId), func(i, j int) bool {
+		return equivalentString(r.LinkId[i], other.LinkId[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LinkIdElement, other.LinkIdElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabelNumber), len(other.SecurityLabelNumber), func(i, j int) bool {
+		return r.SecurityLabelNumber[i] == other.SecurityLabelNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.SecurityLabelNumberElement, other.SecurityLabelNumberElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTermAssetValuedItem) EqualsShallow(other ContractTermAssetValuedItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.EffectiveTime == nil) != (other.EffectiveTime == nil) || r.EffectiveTime != nil && !equivalentString(*r.EffectiveTime, *other.EffectiveTime) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.Points == nil) != (other.Points == nil) || r.Points != nil && !equivalentDecimal(*r.Points, *other.Points) {
+		return false
+	}
+	if (r.Payment == nil) != (other.Payment == nil) || r.Payment != nil && !equivalentString(*r.Payment, *other.Payment) {
+		return false
+	}
+	if (r.PaymentDate == nil) != (other.PaymentDate == nil) || r.PaymentDate != nil && !equivalentString(*r.PaymentDate, *other.PaymentDate) {
+		return false
+	}
+	if !equivalentList(len(r.LinkId), len(other.LinkId), func(i, j int) bool {
+		return equivalentString(r.LinkId[i], other.LinkId[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabelNumber), len(other.SecurityLabelNumber), func(i, j int) bool {
+		return r.SecurityLabelNumber[i] == other.SecurityLabelNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTermAssetValuedItem as FHIR XML
+func (r ContractTermAssetValuedItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "entityCodeableConcept", r.EntityCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "entityReference", r.EntityReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "effectiveTime", r.EffectiveTime, r.EffectiveTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "points", r.Points, r.PointsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "payment", r.Payment, r.PaymentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "paymentDate", r.PaymentDate, r.PaymentDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "responsible", r.Responsible); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "recipient", r.Recipient); err != nil {
+		return err
+	}
+	for i, v := range r.LinkId {
+		if err := encodeXMLPrimitive(e, "linkId", v, elementAt(r.LinkIdElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.SecurityLabelNumber {
+		if err := encodeXMLPrimitive(e, "securityLabelNumber", v, elementAt(r.SecurityLabelNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTermAssetValuedItem from FHIR XML
+func (r *ContractTermAssetValuedItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "entityCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EntityCodeableConcept = &v
+			case "entityReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EntityReference = &v
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "effectiveTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EffectiveTime = &v
+				}
+				r.EffectiveTimeElement = element
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "points":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Points = &v
+				}
+				r.PointsElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "payment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Payment = &v
+				}
+				r.PaymentElement = element
+			case "paymentDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PaymentDate = &v
+				}
+				r.PaymentDateElement = element
+			case "responsible":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Responsible = &v
+			case "recipient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recipient = &v
+			case "linkId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LinkIdElement = appendElement(r.LinkIdElement, len(r.LinkId), element)
+					r.LinkId = append(r.LinkId, v)
+				}
+			case "securityLabelNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SecurityLabelNumberElement = appendElement(r.SecurityLabelNumberElement, len(r.SecurityLabelNumber), element)
+					r.SecurityLabelNumber = append(r.SecurityLabelNumber, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTermAssetValuedItem as properties to the RDF node n
+func (r ContractTermAssetValuedItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Contract.term.asset.valuedItem.entityCodeableConcept", -1, r.EntityCodeableConcept)
+	n.element("Contract.term.asset.valuedItem.entityReference", -1, r.EntityReference)
+	n.element("Contract.term.asset.valuedItem.identifier", -1, r.Identifier)
+	n.primitive("Contract.term.asset.valuedItem.effectiveTime", -1, r.EffectiveTime, r.EffectiveTimeElement, "dateTime")
+	n.element("Contract.term.asset.valuedItem.quantity", -1, r.Quantity)
+	n.element("Contract.term.asset.valuedItem.unitPrice", -1, r.UnitPrice)
+	n.primitive("Contract.term.asset.valuedItem.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.primitive("Contract.term.asset.valuedItem.points", -1, r.Points, r.PointsElement, "decimal")
+	n.element("Contract.term.asset.valuedItem.net", -1, r.Net)
+	n.primitive("Contract.term.asset.valuedItem.payment", -1, r.Payment, r.PaymentElement, "string")
+	n.primitive("Contract.term.asset.valuedItem.paymentDate", -1, r.PaymentDate, r.PaymentDateElement, "dateTime")
+	n.element("Contract.term.asset.valuedItem.responsible", -1, r.Responsible)
+	n.element("Contract.term.asset.valuedItem.recipient", -1, r.Recipient)
+	for i, v := range r.LinkId {
+		n.primitive("Contract.term.asset.valuedItem.linkId", i, v, elementAt(r.LinkIdElement, i), "string")
+	}
+	for i, v := range r.SecurityLabelNumber {
+		n.primitive("Contract.term.asset.valuedItem.securityLabelNumber", i, v, elementAt(r.SecurityLabelNumberElement, i), "unsignedInt")
+	}
+}
+
+// MarshalBSON marshals the given ContractTermAssetValuedItem as BSON document with the structure of its FHIR JSON
+func (r ContractTermAssetValuedItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTermAssetValuedItem from a BSON document with the structure of its FHIR JSON
+func (r *ContractTermAssetValuedItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTermAssetValuedItem as protobuf message ContractTermAssetValuedItem of fhir.proto
+func (r ContractTermAssetValuedItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTermAssetValuedItem to the encoded message
+func (r ContractTermAssetValuedItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.EntityCodeableConcept != nil {
+		e.message(4, *r.EntityCodeableConcept)
+	}
+	if r.EntityReference != nil {
+		e.message(5, *r.EntityReference)
+	}
+	if r.Identifier != nil {
+		e.message(6, *r.Identifier)
+	}
+	if r.EffectiveTime != nil {
+		e.string(7, *r.EffectiveTime)
+	}
+	if r.Quantity != nil {
+		e.message(8, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(9, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(10, string(*r.Factor))
+	}
+	if r.Points != nil {
+		e.string(11, string(*r.Points))
+	}
+	if r.Net != nil {
+		e.message(12, *r.Net)
+	}
+	if r.Payment != nil {
+		e.string(13, *r.Payment)
+	}
+	if r.PaymentDate != nil {
+		e.string(14, *r.PaymentDate)
+	}
+	if r.Responsible != nil {
+		e.message(15, *r.Responsible)
+	}
+	if r.Recipient != nil {
+		e.message(16, *r.Recipient)
+	}
+	for _, v := range r.LinkId {
+		e.string(17, v)
+	}
+	if len(r.SecurityLabelNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.SecurityLabelNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(18, p.buf)
+	}
+	if r.EffectiveTimeElement != nil {
+		e.message(19, *r.EffectiveTimeElement)
+	}
+	if r.FactorElement != nil {
+		e.message(20, *r.FactorElement)
+	}
+	if r.PointsElement != nil {
+		e.message(21, *r.PointsElement)
+	}
+	if r.PaymentElement != nil {
+		e.message(22, *r.PaymentElement)
+	}
+	if r.PaymentDateElement != nil {
+		e.message(23, *r.PaymentDateElement)
+	}
+	for _, v := range r.LinkIdElement {
+		e.element(24, v)
+	}
+	for _, v := range r.SecurityLabelNumberElement {
+		e.element(25, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractTermAssetValuedItem from protobuf message ContractTermAssetValuedItem of fhir.proto
+func (r *ContractTermAssetValuedItem) UnmarshalProto(b []byte) error {
+	*r = ContractTermAssetValuedItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.EntityCodeableConcept = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.EntityReference = &v
+		case 6:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 7:
+			v := d.string()
+			r.EffectiveTime = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 9:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 10:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 11:
+			v := json.Number(d.string())
+			r.Points = &v
+		case 12:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 13:
+			v := d.string()
+			r.Payment = &v
+		case 14:
+			v := d.string()
+			r.PaymentDate = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Responsible = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Recipient = &v
+		case 17:
+			r.LinkId = append(r.LinkId, d.string())
+		case 18:
+			for _, v := range d.varints() {
+				r.SecurityLabelNumber = append(r.SecurityLabelNumber, int(int32(v)))
+			}
+		case 19:
+			var v Element
+			d.message(&v)
+			r.EffectiveTimeElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.PointsElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.PaymentElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.PaymentDateElement = &v
+		case 24:
+			r.LinkIdElement = append(r.LinkIdElement, d.element())
+		case 25:
+			r.SecurityLabelNumberElement = append(r.SecurityLabelNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTermAction as JSON into a byte slice
+func (r ContractTermAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTermAction as JSON object with the members in the order of the fields
+func (r ContractTermAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DoNotPerform != nil {
+		e.key("doNotPerform")
+		e.bool(*r.DoNotPerform)
+	}
+	if r.DoNotPerformElement != nil {
+		e.key("_doNotPerform")
+		r.DoNotPerformElement.appendJSON(e)
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if len(r.Subject) > 0 {
+		e.key("subject")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subject {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("intent")
+	r.Intent.appendJSON(e)
+	if len(r.LinkId) > 0 {
+		e.key("linkId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LinkId {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LinkIdElement) > 0 {
+		e.key("_linkId")
+		e.elements(r.LinkIdElement, len(r.LinkId))
+	}
+	e.key("status")
+	r.Status.appendJSON(e)
+	if r.Context != nil {
+		e.key("context")
+		r.Context.appendJSON(e)
+	}
+	if len(r.ContextLinkId) > 0 {
+		e.key("contextLinkId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ContextLinkId {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ContextLinkIdElement) > 0 {
+		e.key("_contextLinkId")
+		e.elements(r.ContextLinkIdElement, len(r.ContextLinkId))
+	}
+	if r.OccurrenceDateTime != nil {
+		e.key("occurrenceDateTime")
+		e.string(*r.OccurrenceDateTime)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.key("_occurrenceDateTime")
+		r.OccurrenceDateTimeElement.appendJSON(e)
+	}
+	if r.OccurrencePeriod != nil {
+		e.key("occurrencePeriod")
+		r.OccurrencePeriod.appendJSON(e)
+	}
+	if r.OccurrenceTiming != nil {
+		e.key("occurrenceTiming")
+		r.OccurrenceTiming.appendJSON(e)
+	}
+	if len(r.Requester) > 0 {
+		e.key("requester")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Requester {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RequesterLinkId) > 0 {
+		e.key("requesterLinkId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RequesterLinkId {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RequesterLinkIdElement) > 0 {
+		e.key("_requesterLinkId")
+		e.elements(r.RequesterLinkIdElement, len(r.RequesterLinkId))
+	}
+	if len(r.PerformerType) > 0 {
+		e.key("performerType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PerformerType {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PerformerRole != nil {
+		e.key("performerRole")
+		r.PerformerRole.appendJSON(e)
+	}
+	if r.Performer != nil {
+		e.key("performer")
+		r.Performer.appendJSON(e)
+	}
+	if len(r.PerformerLinkId) > 0 {
+		e.key("performerLinkId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PerformerLinkId {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PerformerLinkIdElement) > 0 {
+		e.key("_performerLinkId")
+		e.elements(r.PerformerLinkIdElement, len(r.PerformerLinkId))
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Reason) > 0 {
+		e.key("reason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reason {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonElement) > 0 {
+		e.key("_reason")
+		e.elements(r.ReasonElement, len(r.Reason))
+	}
+	if len(r.ReasonLinkId) > 0 {
+		e.key("reasonLinkId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonLinkId {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonLinkIdElement) > 0 {
+		e.key("_reasonLinkId")
+		e.elements(r.ReasonLinkIdElement, len(r.ReasonLinkId))
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SecurityLabelNumber) > 0 {
+		e.key("securityLabelNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SecurityLabelNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SecurityLabelNumberElement) > 0 {
+		e.key("_securityLabelNumber")
+		e.elements(r.SecurityLabelNumberElement, len(r.SecurityLabelNumber))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTermAction from JSON. Unknown members are ignored.
+func (r *ContractTermAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTermAction
+func (r *ContractTermAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "doNotPerform":
+			if v, ok := d.bool(); ok {
+				r.DoNotPerform = &v
+			} else {
+				r.DoNotPerform = nil
+			}
+		case "_doNotPerform":
+			r.DoNotPerformElement = d.element()
+		case "type":
+			r.Type.decodeJSON(d)
+		case "subject":
+			a := d.array()
+			r.Subject = nil
+			for a.next() {
+				var v ContractTermActionSubject
+				v.decodeJSON(d)
+				r.Subject = append(r.Subject, v)
+			}
+			if r.Subject == nil && !a.isNull {
+				r.Subject = []ContractTermActionSubject{}
+			}
+		case "intent":
+			r.Intent.decodeJSON(d)
+		case "linkId":
+			a := d.array()
+			r.LinkId = nil
+			for a.next() {
+				v, _ := d.string()
+				r.LinkId = append(r.LinkId, v)
+			}
+			if r.LinkId == nil && !a.isNull {
+				r.LinkId = []string{}
+			}
+		case "_linkId":
+			a := d.array()
+			r.LinkIdElement = nil
+			for a.next() {
+				r.LinkIdElement = append(r.LinkIdElement, d.element())
+			}
+		case "status":
+			r.Status.decodeJSON(d)
+		case "context":
+			if d.null() {
+				r.Context = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Context = &v
+			}
+		case "contextLinkId":
+			a := d.array()
+			r.ContextLinkId = nil
+			for a.next() {
+				v, _ := d.string()
+				r.ContextLinkId = append(r.ContextLinkId, v)
+			}
+			if r.ContextLinkId == nil && !a.isNull {
+				r.ContextLinkId = []string{}
+			}
+		case "_contextLinkId":
+			a := d.array()
+			r.ContextLinkIdElement = nil
+			for a.next() {
+				r.ContextLinkIdElement = append(r.ContextLinkIdElement, d.element())
+			}
+		case "occurrenceDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurrenceDateTime = &v
+			} else {
+				r.OccurrenceDateTime = nil
+			}
+		case "_occurrenceDateTime":
+			r.OccurrenceDateTimeElement = d.element()
+		case "occurrencePeriod":
+			if d.null() {
+				r.OccurrencePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OccurrencePeriod = &v
+			}
+		case "occurrenceTiming":
+			if d.null() {
+				r.OccurrenceTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.OccurrenceTiming = &v
+			}
+		case "requester":
+			a := d.array()
+			r.Requester = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Requester = append(r.Requester, v)
+			}
+			if r.Requester == nil && !a.isNull {
+				r.Requester = []Reference{}
+			}
+		case "requesterLinkId":
+			a := d.array()
+			r.RequesterLinkId = nil
+			for a.next() {
+				v, _ := d.string()
+				r.RequesterLinkId = append(r.RequesterLinkId, v)
+			}
+			if r.RequesterLinkId == nil && !a.isNull {
+				r.RequesterLinkId = []string{}
+			}
+		case "_requesterLinkId":
+			a := d.array()
+			r.RequesterLinkIdElement = nil
+			for a.next() {
+				r.RequesterLinkIdElement = append(r.RequesterLinkIdElement, d.element())
+			}
+		case "performerType":
+			a := d.array()
+			r.PerformerType = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PerformerType = append(r.PerformerType, v)
+			}
+			if r.PerformerType == nil && !a.isNull {
+				r.PerformerType = []CodeableConcept{}
+			}
+		case "performerRole":
+			if d.null() {
+				r.PerformerRole = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PerformerRole = &v
+			}
+		case "performer":
+			if d.null() {
+				r.Performer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = &v
+			}
+		case "performerLinkId":
+			a := d.array()
+			r.PerformerLinkId = nil
+			for a.next() {
+				v, _ := d.string()
+				r.PerformerLinkId = append(r.PerformerLinkId, v)
+			}
+			if r.PerformerLinkId == nil && !a.isNull {
+				r.PerformerLinkId = []string{}
+			}
+		case "_performerLinkId":
+			a := d.array()
+			r.PerformerLinkIdElement = nil
+			for a.next() {
+				r.PerformerLinkIdElement = append(r.PerformerLinkIdElement, d.element())
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "reason":
+			a := d.array()
+			r.Reason = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Reason = append(r.Reason, v)
+			}
+			if r.Reason == nil && !a.isNull {
+				r.Reason = []string{}
+			}
+		case "_reason":
+			a := d.array()
+			r.ReasonElement = nil
+			for a.next() {
+				r.ReasonElement = append(r.ReasonElement, d.element())
+			}
+		case "reasonLinkId":
+			a := d.array()
+			r.ReasonLinkId = nil
+			for a.next() {
+				v, _ := d.string()
+				r.ReasonLinkId = append(r.ReasonLinkId, v)
+			}
+			if r.ReasonLinkId == nil && !a.isNull {
+				r.ReasonLinkId = []string{}
+			}
+		case "_reasonLinkId":
+			a := d.array()
+			r.ReasonLinkIdElement = nil
+			for a.next() {
+				r.ReasonLinkIdElement = append(r.ReasonLinkIdElement, d.element())
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "securityLabelNumber":
+			a := d.array()
+			r.SecurityLabelNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.SecurityLabelNumber = append(r.SecurityLabelNumber, v)
+			}
+			if r.SecurityLabelNumber == nil && !a.isNull {
+				r.SecurityLabelNumber = []int{}
+			}
+		case "_securityLabelNumber":
+			a := d.array()
+			r.SecurityLabelNumberElement = nil
+			for a.next() {
+				r.SecurityLabelNumberElement = append(r.SecurityLabelNumberElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTermAction which shares no memory with the original
+func (r ContractTermAction) DeepCopy() ContractTermAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DoNotPerform != nil {
+		v := *r.DoNotPerform
+		out.DoNotPerform = &v
+	}
+	if r.DoNotPerformElement != nil {
+		v := r.DoNotPerformElement.DeepCopy()
+		out.DoNotPerformElement = &v
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Subject != nil {
+		out.Subject = make([]ContractTermActionSubject, len(r.Subject))
+		for i := range r.Subject {
+			out.Subject[i] = r.Subject[i].DeepCopy()
+		}
+	}
+	out.Intent = r.Intent.DeepCopy()
+	if r.LinkId != nil {
+		out.LinkId = make([]string, len(r.LinkId))
+		copy(out.LinkId, r.LinkId)
+	}
+	if r.LinkIdElement != nil {
+		out.LinkIdElement = make([]*Element, len(r.LinkIdElement))
+		for i, v := range r.LinkIdElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LinkIdElement[i] = &c
+			}
+		}
+	}
+	out.Status = r.Status.DeepCopy()
+	if r.Context != nil {
+		v := r.Context.DeepCopy()
+		out.Context = &v
+	}
+	if r.ContextLinkId != nil {
+		out.ContextLinkId = make([]string, len(r.ContextLinkId))
+		copy(out.ContextLinkId, r.ContextLinkId)
+	}
+	if r.ContextLinkIdElement != nil {
+		out.ContextLinkIdElement = make([]*Element, len(r.ContextLinkIdElement))
+		for i, v := range r.ContextLinkIdElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ContextLinkIdElement[i] = &c
+			}
+		}
+	}
+	if r.OccurrenceDateTime != nil {
+		v := *r.OccurrenceDateTime
+		out.OccurrenceDateTime = &v
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		v := r.OccurrenceDateTimeElement.DeepCopy()
+		out.OccurrenceDateTimeElement = &v
+	}
+	if r.OccurrencePeriod != nil {
+		v := r.OccurrencePeriod.DeepCopy()
+		out.OccurrencePeriod = &v
+	}
+	if r.OccurrenceTiming != nil {
+		v := r.OccurrenceTiming.DeepCopy()
+		out.OccurrenceTiming = &v
+	}
+	if r.Requester != nil {
+		out.Requester = make([]Reference, len(r.Requester))
+		for i := range r.Requester {
+			out.Requester[i] = r.Requester[i].DeepCopy()
+		}
+	}
+	if r.RequesterLinkId != nil {
+		out.RequesterLinkId = make([]string, len(r.RequesterLinkId))
+		copy(out.RequesterLinkId, r.RequesterLinkId)
+	}
+	if r.RequesterLinkIdElement != nil {
+		out.RequesterLinkIdElement = make([]*Element, len(r.RequesterLinkIdElement))
+		for i, v := range r.RequesterLinkIdElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.RequesterLinkIdElement[i] = &c
+			}
+		}
+	}
+	if r.PerformerType != nil {
+		out.PerformerType = make([]CodeableConcept, len(r.PerformerType))
+		for i := range r.PerformerType {
+			out.PerformerType[i] = r.PerformerType[i].DeepCopy()
+		}
+	}
+	if r.PerformerRole != nil {
+		v := r.PerformerRole.DeepCopy()
+		out.PerformerRole = &v
+	}
+	if r.Performer != nil {
+		v := r.Performer.DeepCopy()
+		out.Performer = &v
+	}
+	if r.PerformerLinkId != nil {
+		out.PerformerLinkId = make([]string, len(r.PerformerLinkId))
+		copy(out.PerformerLinkId, r.PerformerLinkId)
+	}
+	if r.PerformerLinkIdElement != nil {
+		out.PerformerLinkIdElement = make([]*Element, len(r.PerformerLinkIdElement))
+		for i, v := range r.PerformerLinkIdElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PerformerLinkIdElement[i] = &c
+			}
+		}
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Reason != nil {
+		out.Reason = make([]string, len(r.Reason))
+		copy(out.Reason, r.Reason)
+	}
+	if r.ReasonElement != nil {
+		out.ReasonElement = make([]*Element, len(r.ReasonElement))
+		for i, v := range r.ReasonElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ReasonElement[i] = &c
+			}
+		}
+	}
+	if r.ReasonLinkId != nil {
+		out.ReasonLinkId = make([]string, len(r.ReasonLinkId))
+		copy(out.ReasonLinkId, r.ReasonLinkId)
+	}
+	if r.ReasonLinkIdElement != nil {
+		out.ReasonLinkIdElement = make([]*Element, len(r.ReasonLinkIdElement))
+		for i, v := range r.ReasonLinkIdElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ReasonLinkIdElement[i] = &c
+			}
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.SecurityLabelNumber != nil {
+		out.SecurityLabelNumber = make([]int, len(r.SecurityLabelNumber))
+		copy(out.SecurityLabelNumber, r.SecurityLabelNumber)
+	}
+	if r.SecurityLabelNumberElement != nil {
+		out.SecurityLabelNumberElement = make([]*Element, len(r.SecurityLabelNumberElement))
+		for i, v := range r.SecurityLabelNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SecurityLabelNumberElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTermAction) Equal(other ContractTermAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.Equal(*other.DoNotPerformElement) {
+		return false
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.Subject) != len(other.Subject) {
+		return false
+	}
+	for i := range r.Subject {
+		if !r.Subject[i].Equal(other.Subject[i]) {
+			return false
+		}
+	}
+	if !r.Intent.Equal(other.Intent) {
+		return false
+	}
+	if len(r.LinkId) != len(other.LinkId) {
+		return false
+	}
+	for i := range r.LinkId {
+		if r.LinkId[i] != other.LinkId[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LinkIdElement, other.LinkIdElement, false) {
+		return false
+	}
+	if !r.Status.Equal(other.Status) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.Equal(*other.Context) {
+		return false
+	}
+	if len(r.ContextLinkId) != len(other.ContextLinkId) {
+		return false
+	}
+	for i := range r.ContextLinkId {
+		if r.ContextLinkId[i] != other.ContextLinkId[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ContextLinkIdElement, other.ContextLinkIdElement, false) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && *r.OccurrenceDateTime != *other.OccurrenceDateTime {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.Equal(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.Equal(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.Equal(*other.OccurrenceTiming) {
+		return false
+	}
+	if len(r.Requester) != len(other.Requester) {
+		return false
+	}
+	for i := range r.Requester {
+		if !r.Requester[i].Equal(other.Requester[i]) {
+			return false
+		}
+	}
+	if len(r.RequesterLinkId) != len(other.RequesterLinkId) {
+		return false
+	}
+	for i := range r.RequesterLinkId {
+		if r.RequesterLinkId[i] != other.RequesterLinkId[i] {
+			return false
+		}
+	}
+	if !equalElements(r.RequesterLinkIdElement, other.RequesterLinkIdElement, false) {
+		return false
+	}
+	if len(r.PerformerType) != len(other.PerformerType) {
+		return false
+	}
+	for i := range r.PerformerType {
+		if !r.PerformerType[i].Equal(other.PerformerType[i]) {
+			return false
+		}
+	}
+	if (r.PerformerRole == nil) != (other.PerformerRole == nil) || r.PerformerRole != nil && !r.PerformerRole.Equal(*other.PerformerRole) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.Equal(*other.Performer) {
+		return false
+	}
+	if len(r.PerformerLinkId) != len(other.PerformerLinkId) {
+		return false
+	}
+	for i := range r.PerformerLinkId {
+		if r.PerformerLinkId[i] != other.PerformerLinkId[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PerformerLinkIdElement, other.PerformerLinkIdElement, false) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Reason) != len(other.Reason) {
+		return false
+	}
+	for i := range r.Reason {
+		if r.Reason[i] != other.Reason[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ReasonElement, other.ReasonElement, false) {
+		return false
+	}
+	if len(r.ReasonLinkId) != len(other.ReasonLinkId) {
+		return false
+	}
+	for i := range r.ReasonLinkId {
+		if r.ReasonLinkId[i] != other.ReasonLinkId[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ReasonLinkIdElement, other.ReasonLinkIdElement, false) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.SecurityLabelNumber) != len(other.SecurityLabelNumber) {
+		return false
+	}
+	for i := range r.SecurityLabelNumber {
+		if r.SecurityLabelNumber[i] != other.SecurityLabelNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SecurityLabelNumberElement, other.SecurityLabelNumberElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTermAction) EqualsDeep(other ContractTermAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.EqualsDeep(*other.DoNotPerformElement) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Subject), len(other.Subject), func(i, j int) bool {
+		return r.Subject[i].EqualsDeep(other.Subject[j])
+	}) {
+		return false
+	}
+	if !r.Intent.EqualsDeep(other.Intent) {
+		return false
+	}
+	if !equivalentList(len(r.LinkId), len(other.LinkId), func(i, j int) bool {
+		return equivalentString(r.LinkId[i], other.LinkId[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LinkIdElement, other.LinkIdElement, true) {
+		return false
+	}
+	if !r.Status.EqualsDeep(other.Status) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.EqualsDeep(*other.Context) {
+		return false
+	}
+	if !equivalentList(len(r.ContextLinkId), len(other.ContextLinkId), func(i, j int) bool {
+		return equivalentString(r.ContextLinkId[i], other.ContextLinkId[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ContextLinkIdElement, other.ContextLinkIdElement, true) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.EqualsDeep(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.EqualsDeep(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.EqualsDeep(*other.OccurrenceTiming) {
+		return false
+	}
+	if !equivalentList(len(r.Requester), len(other.Requester), func(i, j int) bool {
+		return r.Requester[i].EqualsDeep(other.Requester[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RequesterLinkId), len(other.RequesterLinkId), func(i, j int) bool {
+		return equivalentString(r.RequesterLinkId[i], other.RequesterLinkId[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.RequesterLinkIdElement, other.RequesterLinkIdElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.PerformerType), len(other.PerformerType), func(i, j int) bool {
+		return r.PerformerType[i].EqualsDeep(other.PerformerType[j])
+	}) {
+		return false
+	}
+	if (r.PerformerRole == nil) != (other.PerformerRole == nil) || r.PerformerRole != nil && !r.PerformerRole.EqualsDeep(*other.PerformerRole) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.EqualsDeep(*other.Performer) {
+		return false
+	}
+	if !equivalentList(len(r.PerformerLinkId), len(other.PerformerLinkId), func(i, j int) bool {
+		return equivalentString(r.PerformerLinkId[i], other.PerformerLinkId[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PerformerLinkIdElement, other.PerformerLinkIdElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reason), len(other.Reason), func(i, j int) bool {
+		return equivalentString(r.Reason[i], other.Reason[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ReasonElement, other.ReasonElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonLinkId), len(other.ReasonLinkId), func(i, j int) bool {
+		return equivalentString(r.ReasonLinkId[i], other.ReasonLinkId[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ReasonLinkIdElement, other.ReasonLinkIdElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabelNumber), len(other.SecurityLabelNumber), func(i, j int) bool {
+		return r.SecurityLabelNumber[i] == other.SecurityLabelNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.SecurityLabelNumberElement, other.SecurityLabelNumberElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTermAction) EqualsShallow(other ContractTermAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if !equivalentList(len(r.LinkId), len(other.LinkId), func(i, j int) bool {
+		return equivalentString(r.LinkId[i], other.LinkId[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ContextLinkId), len(other.ContextLinkId), func(i, j int) bool {
+		return equivalentString(r.ContextLinkId[i], other.ContextLinkId[j])
+	}) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if !equivalentList(len(r.RequesterLinkId), len(other.RequesterLinkId), func(i, j int) bool {
+		return equivalentString(r.RequesterLinkId[i], other.RequesterLinkId[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PerformerLinkId), len(other.PerformerLinkId), func(i, j int) bool {
+		return equivalentString(r.PerformerLinkId[i], other.PerformerLinkId[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reason), len(other.Reason), func(i, j int) bool {
+		return equivalentString(r.Reason[i], other.Reason[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonLinkId), len(other.ReasonLinkId), func(i, j int) bool {
+		return equivalentString(r.ReasonLinkId[i], other.ReasonLinkId[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabelNumber), len(other.SecurityLabelNumber), func(i, j int) bool {
+		return r.SecurityLabelNumber[i] == other.SecurityLabelNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTermAction as FHIR XML
+func (r ContractTermAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "doNotPerform", r.DoNotPerform, r.DoNotPerformElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Subject {
+		if err := encodeXMLElement(e, "subject", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "intent", r.Intent); err != nil {
+		return err
+	}
+	for i, v := range r.LinkId {
+		if err := encodeXMLPrimitive(e, "linkId", v, elementAt(r.LinkIdElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "status", r.Status); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "context", r.Context); err != nil {
+		return err
+	}
+	for i, v := range r.ContextLinkId {
+		if err := encodeXMLPrimitive(e, "contextLinkId", v, elementAt(r.ContextLinkIdElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceDateTime", r.OccurrenceDateTime, r.OccurrenceDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrencePeriod", r.OccurrencePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrenceTiming", r.OccurrenceTiming); err != nil {
+		return err
+	}
+	for _, v := range r.Requester {
+		if err := encodeXMLElement(e, "requester", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.RequesterLinkId {
+		if err := encodeXMLPrimitive(e, "requesterLinkId", v, elementAt(r.RequesterLinkIdElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PerformerType {
+		if err := encodeXMLElement(e, "performerType", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "performerRole", r.PerformerRole); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performer", r.Performer); err != nil {
+		return err
+	}
+	for i, v := range r.PerformerLinkId {
+		if err := encodeXMLPrimitive(e, "performerLinkId", v, elementAt(r.PerformerLinkIdElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Reason {
+		if err := encodeXMLPrimitive(e, "reason", v, elementAt(r.ReasonElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ReasonLinkId {
+		if err := encodeXMLPrimitive(e, "reasonLinkId", v, elementAt(r.ReasonLinkIdElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.SecurityLabelNumber {
+		if err := encodeXMLPrimitive(e, "securityLabelNumber", v, elementAt(r.SecurityLabelNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTermAction from FHIR XML
+func (r *ContractTermAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "doNotPerform":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoNotPerform = &v
+				}
+				r.DoNotPerformElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "subject":
+				var v ContractTermActionSubject
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = append(r.Subject, v)
+			case "intent":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Intent = v
+			case "linkId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LinkIdElement = appendElement(r.LinkIdElement, len(r.LinkId), element)
+					r.LinkId = append(r.LinkId, v)
+				}
+			case "status":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Status = v
+			case "context":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Context = &v
+			case "contextLinkId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ContextLinkIdElement = appendElement(r.ContextLinkIdElement, len(r.ContextLinkId), element)
+					r.ContextLinkId = append(r.ContextLinkId, v)
+				}
+			case "occurrenceDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceDateTime = &v
+				}
+				r.OccurrenceDateTimeElement = element
+			case "occurrencePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrencePeriod = &v
+			case "occurrenceTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrenceTiming = &v
+			case "requester":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requester = append(r.Requester, v)
+			case "requesterLinkId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.RequesterLinkIdElement = appendElement(r.RequesterLinkIdElement, len(r.RequesterLinkId), element)
+					r.RequesterLinkId = append(r.RequesterLinkId, v)
+				}
+			case "performerType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformerType = append(r.PerformerType, v)
+			case "performerRole":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformerRole = &v
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = &v
+			case "performerLinkId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PerformerLinkIdElement = appendElement(r.PerformerLinkIdElement, len(r.PerformerLinkId), element)
+					r.PerformerLinkId = append(r.PerformerLinkId, v)
+				}
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "reason":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ReasonElement = appendElement(r.ReasonElement, len(r.Reason), element)
+					r.Reason = append(r.Reason, v)
+				}
+			case "reasonLinkId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ReasonLinkIdElement = appendElement(r.ReasonLinkIdElement, len(r.ReasonLinkId), element)
+					r.ReasonLinkId = append(r.ReasonLinkId, v)
+				}
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "securityLabelNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SecurityLabelNumberElement = appendElement(r.SecurityLabelNumberElement, len(r.SecurityLabelNumber), element)
+					r.SecurityLabelNumber = append(r.SecurityLabelNumber, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTermAction as properties to the RDF node n
+func (r ContractTermAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Contract.term.action.doNotPerform", -1, r.DoNotPerform, r.DoNotPerformElement, "boolean")
+	n.element("Contract.term.action.type", -1, r.Type)
+	for i, v := range r.Subject {
+		n.element("Contract.term.action.subject", i, v)
+	}
+	n.element("Contract.term.action.intent", -1, r.Intent)
+	for i, v := range r.LinkId {
+		n.primitive("Contract.term.action.linkId", i, v, elementAt(r.LinkIdElement, i), "string")
+	}
+	n.element("Contract.term.action.status", -1, r.Status)
+	n.element("Contract.term.action.context", -1, r.Context)
+	for i, v := range r.ContextLinkId {
+		n.primitive("Contract.term.action.contextLinkId", i, v, elementAt(r.ContextLinkIdElement, i), "string")
+	}
+	n.primitive("Contract.term.action.occurrenceDateTime", -1, r.OccurrenceDateTime, r.OccurrenceDateTimeElement, "dateTime")
+	n.element("Contract.term.action.occurrencePeriod", -1, r.OccurrencePeriod)
+	n.element("Contract.term.action.occurrenceTiming", -1, r.OccurrenceTiming)
+	for i, v := range r.Requester {
+		n.element("Contract.term.action.requester", i, v)
+	}
+	for i, v := range r.RequesterLinkId {
+		n.primitive("Contract.term.action.requesterLinkId", i, v, elementAt(r.RequesterLinkIdElement, i), "string")
+	}
+	for i, v := range r.PerformerType {
+		n.element("Contract.term.action.performerType", i, v)
+	}
+	n.element("Contract.term.action.performerRole", -1, r.PerformerRole)
+	n.element("Contract.term.action.performer", -1, r.Performer)
+	for i, v := range r.PerformerLinkId {
+		n.primitive("Contract.term.action.performerLinkId", i, v, elementAt(r.PerformerLinkIdElement, i), "string")
+	}
+	for i, v := range r.ReasonCode {
+		n.element("Contract.term.action.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("Contract.term.action.reasonReference", i, v)
+	}
+	for i, v := range r.Reason {
+		n.primitive("Contract.term.action.reason", i, v, elementAt(r.ReasonElement, i), "string")
+	}
+	for i, v := range r.ReasonLinkId {
+		n.primitive("Contract.term.action.reasonLinkId", i, v, elementAt(r.ReasonLinkIdElement, i), "string")
+	}
+	for i, v := range r.Note {
+		n.element("Contract.term.action.note", i, v)
+	}
+	for i, v := range r.SecurityLabelNumber {
+		n.primitive("Contract.term.action.securityLabelNumber", i, v, elementAt(r.SecurityLabelNumberElement, i), "unsignedInt")
+	}
+}
+
+// MarshalBSON marshals the given ContractTermAction as BSON document with the structure of its FHIR JSON
+func (r ContractTermAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTermAction from a BSON document with the structure of its FHIR JSON
+func (r *ContractTermAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTermAction as protobuf message ContractTermAction of fhir.proto
+func (r ContractTermAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTermAction to the encoded message
+func (r ContractTermAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.DoNotPerform != nil {
+		e.varint(4, protoBool(*r.DoNotPerform))
+	}
+	e.message(5, r.Type)
+	for _, v := range r.Subject {
+		e.message(6, v)
+	}
+	e.message(7, r.Intent)
+	for _, v := range r.LinkId {
+		e.string(8, v)
+	}
+	e.message(9, r.Status)
+	if r.Context != nil {
+		e.message(10, *r.Context)
+	}
+	for _, v := range r.ContextLinkId {
+		e.string(11, v)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.string(12, *r.OccurrenceDateTime)
+	}
+	if r.OccurrencePeriod != nil {
+		e.message(13, *r.OccurrencePeriod)
+	}
+	if r.OccurrenceTiming != nil {
+		e.message(14, *r.OccurrenceTiming)
+	}
+	for _, v := range r.Requester {
+		e.message(15, v)
+	}
+	for _, v := range r.RequesterLinkId {
+		e.string(16, v)
+	}
+	for _, v := range r.PerformerType {
+		e.message(17, v)
+	}
+	if r.PerformerRole != nil {
+		e.message(18, *r.PerformerRole)
+	}
+	if r.Performer != nil {
+		e.message(19, *r.Performer)
+	}
+	for _, v := range r.PerformerLinkId {
+		e.string(20, v)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(21, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(22, v)
+	}
+	for _, v := range r.Reason {
+		e.string(23, v)
+	}
+	for _, v := range r.ReasonLinkId {
+		e.string(24, v)
+	}
+	for _, v := range r.Note {
+		e.message(25, v)
+	}
+	if len(r.SecurityLabelNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.SecurityLabelNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(26, p.buf)
+	}
+	if r.DoNotPerformElement != nil {
+		e.message(27, *r.DoNotPerformElement)
+	}
+	for _, v := range r.LinkIdElement {
+		e.element(28, v)
+	}
+	for _, v := range r.ContextLinkIdElement {
+		e.element(29, v)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.message(30, *r.OccurrenceDateTimeElement)
+	}
+	for _, v := range r.RequesterLinkIdElement {
+		e.element(31, v)
+	}
+	for _, v := range r.PerformerLinkIdElement {
+		e.element(32, v)
+	}
+	for _, v := range r.ReasonElement {
+		e.element(33, v)
+	}
+	for _, v := range r.ReasonLinkIdElement {
+		e.element(34, v)
+	}
+	for _, v := range r.SecurityLabelNumberElement {
+		e.element(35, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractTermAction from protobuf message ContractTermAction of fhir.proto
+func (r *ContractTermAction) UnmarshalProto(b []byte) error {
+	*r = ContractTermAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.bool()
+			r.DoNotPerform = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 6:
+			var v ContractTermActionSubject
+			d.message(&v)
+			r.Subject = append(r.Subject, v)
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Intent = v
+		case 8:
+			r.LinkId = append(r.LinkId, d.string())
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Status = v
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Context = &v
+		case 11:
+			r.ContextLinkId = append(r.ContextLinkId, d.string())
+		case 12:
+			v := d.string()
+			r.OccurrenceDateTime = &v
+		case 13:
+			var v Period
+			d.message(&v)
+			r.OccurrencePeriod = &v
+		case 14:
+			var v Timing
+			d.message(&v)
+			r.OccurrenceTiming = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Requester = append(r.Requester, v)
+		case 16:
+			r.RequesterLinkId = append(r.RequesterLinkId, d.string())
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.PerformerType = append(r.PerformerType, v)
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.PerformerRole = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Performer = &v
+		case 20:
+			r.PerformerLinkId = append(r.PerformerLinkId, d.string())
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 23:
+			r.Reason = append(r.Reason, d.string())
+		case 24:
+			r.ReasonLinkId = append(r.ReasonLinkId, d.string())
+		case 25:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 26:
+			for _, v := range d.varints() {
+				r.SecurityLabelNumber = append(r.SecurityLabelNumber, int(int32(v)))
+			}
+		case 27:
+			var v Element
+			d.message(&v)
+			r.DoNotPerformElement = &v
+		case 28:
+			r.LinkIdElement = append(r.LinkIdElement, d.element())
+		case 29:
+			r.ContextLinkIdElement = append(r.ContextLinkIdElement, d.element())
+		case 30:
+			var v Element
+			d.message(&v)
+			r.OccurrenceDateTimeElement = &v
+		case 31:
+			r.RequesterLinkIdElement = append(r.RequesterLinkIdElement, d.element())
+		case 32:
+			r.PerformerLinkIdElement = append(r.PerformerLinkIdElement, d.element())
+		case 33:
+			r.ReasonElement = append(r.ReasonElement, d.element())
+		case 34:
+			r.ReasonLinkIdElement = append(r.ReasonLinkIdElement, d.element())
+		case 35:
+			r.SecurityLabelNumberElement = append(r.SecurityLabelNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractTermActionSubject as JSON into a byte slice
+func (r ContractTermActionSubject) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractTermActionSubject as JSON object with the members in the order of the fields
+func (r ContractTermActionSubject) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("reference")
+	if r.Reference == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Role != nil {
+		e.key("role")
+		r.Role.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractTermActionSubject from JSON. Unknown members are ignored.
+func (r *ContractTermActionSubject) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractTermActionSubject
+func (r *ContractTermActionSubject) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "reference":
+			a := d.array()
+			r.Reference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Reference = append(r.Reference, v)
+			}
+			if r.Reference == nil && !a.isNull {
+				r.Reference = []Reference{}
+			}
+		case "role":
+			if d.null() {
+				r.Role = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractTermActionSubject which shares no memory with the original
+func (r ContractTermActionSubject) DeepCopy() ContractTermActionSubject {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Reference != nil {
+		out.Reference = make([]Reference, len(r.Reference))
+		for i := range r.Reference {
+			out.Reference[i] = r.Reference[i].DeepCopy()
+		}
+	}
+	if r.Role != nil {
+		v := r.Role.DeepCopy()
+		out.Role = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractTermActionSubject) Equal(other ContractTermActionSubject) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Reference) != len(other.Reference) {
+		return false
+	}
+	for i := range r.Reference {
+		if !r.Reference[i].Equal(other.Reference[i]) {
+			return false
+		}
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.Equal(*other.Role) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractTermActionSubject) EqualsDeep(other ContractTermActionSubject) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reference), len(other.Reference), func(i, j int) bool {
+		return r.Reference[i].EqualsDeep(other.Reference[j])
+	}) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.EqualsDeep(*other.Role) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractTermActionSubject) EqualsShallow(other ContractTermActionSubject) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractTermActionSubject as FHIR XML
+func (r ContractTermActionSubject) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reference {
+		if err := encodeXMLElement(e, "reference", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractTermActionSubject from FHIR XML
+func (r *ContractTermActionSubject) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "reference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = append(r.Reference, v)
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractTermActionSubject as properties to the RDF node n
+func (r ContractTermActionSubject) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Reference {
+		n.element("Contract.term.action.subject.reference", i, v)
+	}
+	n.element("Contract.term.action.subject.role", -1, r.Role)
+}
+
+// MarshalBSON marshals the given ContractTermActionSubject as BSON document with the structure of its FHIR JSON
+func (r ContractTermActionSubject) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractTermActionSubject from a BSON document with the structure of its FHIR JSON
+func (r *ContractTermActionSubject) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractTermActionSubject as protobuf message ContractTermActionSubject of fhir.proto
+func (r ContractTermActionSubject) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractTermActionSubject to the encoded message
+func (r ContractTermActionSubject) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Reference {
+		e.message(4, v)
+	}
+	if r.Role != nil {
+		e.message(5, *r.Role)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractTermActionSubject from protobuf message ContractTermActionSubject of fhir.proto
+func (r *ContractTermActionSubject) UnmarshalProto(b []byte) error {
+	*r = ContractTermActionSubject{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Reference = append(r.Reference, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractSigner as JSON into a byte slice
+func (r ContractSigner) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractSigner as JSON object with the members in the order of the fields
+func (r ContractSigner) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	e.key("party")
+	r.Party.appendJSON(e)
+	e.key("signature")
+	if r.Signature == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Signature {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractSigner from JSON. Unknown members are ignored.
+func (r *ContractSigner) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractSigner
+func (r *ContractSigner) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "party":
+			r.Party.decodeJSON(d)
+		case "signature":
+			a := d.array()
+			r.Signature = nil
+			for a.next() {
+				var v Signature
+				v.decodeJSON(d)
+				r.Signature = append(r.Signature, v)
+			}
+			if r.Signature == nil && !a.isNull {
+				r.Signature = []Signature{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractSigner which shares no memory with the original
+func (r ContractSigner) DeepCopy() ContractSigner {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	out.Party = r.Party.DeepCopy()
+	if r.Signature != nil {
+		out.Signature = make([]Signature, len(r.Signature))
+		for i := range r.Signature {
+			out.Signature[i] = r.Signature[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractSigner) Equal(other ContractSigner) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if !r.Party.Equal(other.Party) {
+		return false
+	}
+	if len(r.Signature) != len(other.Signature) {
+		return false
+	}
+	for i := range r.Signature {
+		if !r.Signature[i].Equal(other.Signature[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractSigner) EqualsDeep(other ContractSigner) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !r.Party.EqualsDeep(other.Party) {
+		return false
+	}
+	if !equivalentList(len(r.Signature), len(other.Signature), func(i, j int) bool {
+		return r.Signature[i].EqualsDeep(other.Signature[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractSigner) EqualsShallow(other ContractSigner) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractSigner as FHIR XML
+func (r ContractSigner) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "party", r.Party); err != nil {
+		return err
+	}
+	for _, v := range r.Signature {
+		if err := encodeXMLElement(e, "signature", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractSigner from FHIR XML
+func (r *ContractSigner) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "party":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Party = v
+			case "signature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Signature = append(r.Signature, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractSigner as properties to the RDF node n
+func (r ContractSigner) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Contract.signer.type", -1, r.Type)
+	n.element("Contract.signer.party", -1, r.Party)
+	for i, v := range r.Signature {
+		n.element("Contract.signer.signature", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ContractSigner as BSON document with the structure of its FHIR JSON
+func (r ContractSigner) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractSigner from a BSON document with the structure of its FHIR JSON
+func (r *ContractSigner) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractSigner as protobuf message ContractSigner of fhir.proto
+func (r ContractSigner) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractSigner to the encoded message
+func (r ContractSigner) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	e.message(5, r.Party)
+	for _, v := range r.Signature {
+		e.message(6, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractSigner from protobuf message ContractSigner of fhir.proto
+func (r *ContractSigner) UnmarshalProto(b []byte) error {
+	*r = ContractSigner{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Coding
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Party = v
+		case 6:
+			var v Signature
+			d.message(&v)
+			r.Signature = append(r.Signature, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractFriendly as JSON into a byte slice
+func (r ContractFriendly) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractFriendly as JSON object with the members in the order of the fields
+func (r ContractFriendly) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ContentAttachment != nil {
+		e.key("contentAttachment")
+		r.ContentAttachment.appendJSON(e)
+	}
+	if r.ContentReference != nil {
+		e.key("contentReference")
+		r.ContentReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractFriendly from JSON. Unknown members are ignored.
+func (r *ContractFriendly) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractFriendly
+func (r *ContractFriendly) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "contentAttachment":
+			if d.null() {
+				r.ContentAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ContentAttachment = &v
+			}
+		case "contentReference":
+			if d.null() {
+				r.ContentReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ContentReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractFriendly which shares no memory with the original
+func (r ContractFriendly) DeepCopy() ContractFriendly {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ContentAttachment != nil {
+		v := r.ContentAttachment.DeepCopy()
+		out.ContentAttachment = &v
+	}
+	if r.ContentReference != nil {
+		v := r.ContentReference.DeepCopy()
+		out.ContentReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractFriendly) Equal(other ContractFriendly) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.Equal(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.Equal(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractFriendly) EqualsDeep(other ContractFriendly) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.EqualsDeep(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.EqualsDeep(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractFriendly) EqualsShallow(other ContractFriendly) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractFriendly as FHIR XML
+func (r ContractFriendly) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "contentAttachment", r.ContentAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "contentReference", r.ContentReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractFriendly from FHIR XML
+func (r *ContractFriendly) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "contentAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentAttachment = &v
+			case "contentReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractFriendly as properties to the RDF node n
+func (r ContractFriendly) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Contract.friendly.contentAttachment", -1, r.ContentAttachment)
+	n.element("Contract.friendly.contentReference", -1, r.ContentReference)
+}
+
+// MarshalBSON marshals the given ContractFriendly as BSON document with the structure of its FHIR JSON
+func (r ContractFriendly) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractFriendly from a BSON document with the structure of its FHIR JSON
+func (r *ContractFriendly) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractFriendly as protobuf message ContractFriendly of fhir.proto
+func (r ContractFriendly) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractFriendly to the encoded message
+func (r ContractFriendly) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ContentAttachment != nil {
+		e.message(4, *r.ContentAttachment)
+	}
+	if r.ContentReference != nil {
+		e.message(5, *r.ContentReference)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractFriendly from protobuf message ContractFriendly of fhir.proto
+func (r *ContractFriendly) UnmarshalProto(b []byte) error {
+	*r = ContractFriendly{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Attachment
+			d.message(&v)
+			r.ContentAttachment = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.ContentReference = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractLegal as JSON into a byte slice
+func (r ContractLegal) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractLegal as JSON object with the members in the order of the fields
+func (r ContractLegal) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ContentAttachment != nil {
+		e.key("contentAttachment")
+		r.ContentAttachment.appendJSON(e)
+	}
+	if r.ContentReference != nil {
+		e.key("contentReference")
+		r.ContentReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractLegal from JSON. Unknown members are ignored.
+func (r *ContractLegal) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractLegal
+func (r *ContractLegal) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "contentAttachment":
+			if d.null() {
+				r.ContentAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ContentAttachment = &v
+			}
+		case "contentReference":
+			if d.null() {
+				r.ContentReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ContentReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractLegal which shares no memory with the original
+func (r ContractLegal) DeepCopy() ContractLegal {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ContentAttachment != nil {
+		v := r.ContentAttachment.DeepCopy()
+		out.ContentAttachment = &v
+	}
+	if r.ContentReference != nil {
+		v := r.ContentReference.DeepCopy()
+		out.ContentReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractLegal) Equal(other ContractLegal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.Equal(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.Equal(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractLegal) EqualsDeep(other ContractLegal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.EqualsDeep(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.EqualsDeep(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractLegal) EqualsShallow(other ContractLegal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractLegal as FHIR XML
+func (r ContractLegal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "contentAttachment", r.ContentAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "contentReference", r.ContentReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractLegal from FHIR XML
+func (r *ContractLegal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "contentAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentAttachment = &v
+			case "contentReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractLegal as properties to the RDF node n
+func (r ContractLegal) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Contract.legal.contentAttachment", -1, r.ContentAttachment)
+	n.element("Contract.legal.contentReference", -1, r.ContentReference)
+}
+
+// MarshalBSON marshals the given ContractLegal as BSON document with the structure of its FHIR JSON
+func (r ContractLegal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractLegal from a BSON document with the structure of its FHIR JSON
+func (r *ContractLegal) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractLegal as protobuf message ContractLegal of fhir.proto
+func (r ContractLegal) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractLegal to the encoded message
+func (r ContractLegal) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ContentAttachment != nil {
+		e.message(4, *r.ContentAttachment)
+	}
+	if r.ContentReference != nil {
+		e.message(5, *r.ContentReference)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractLegal from protobuf message ContractLegal of fhir.proto
+func (r *ContractLegal) UnmarshalProto(b []byte) error {
+	*r = ContractLegal{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Attachment
+			d.message(&v)
+			r.ContentAttachment = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.ContentReference = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ContractRule as JSON into a byte slice
+func (r ContractRule) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ContractRule as JSON object with the members in the order of the fields
+func (r ContractRule) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ContentAttachment != nil {
+		e.key("contentAttachment")
+		r.ContentAttachment.appendJSON(e)
+	}
+	if r.ContentReference != nil {
+		e.key("contentReference")
+		r.ContentReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ContractRule from JSON. Unknown members are ignored.
+func (r *ContractRule) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ContractRule
+func (r *ContractRule) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "contentAttachment":
+			if d.null() {
+				r.ContentAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ContentAttachment = &v
+			}
+		case "contentReference":
+			if d.null() {
+				r.ContentReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ContentReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ContractRule which shares no memory with the original
+func (r ContractRule) DeepCopy() ContractRule {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ContentAttachment != nil {
+		v := r.ContentAttachment.DeepCopy()
+		out.ContentAttachment = &v
+	}
+	if r.ContentReference != nil {
+		v := r.ContentReference.DeepCopy()
+		out.ContentReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ContractRule) Equal(other ContractRule) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.Equal(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.Equal(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ContractRule) EqualsDeep(other ContractRule) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ContentAttachment == nil) != (other.ContentAttachment == nil) || r.ContentAttachment != nil && !r.ContentAttachment.EqualsDeep(*other.ContentAttachment) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !r.ContentReference.EqualsDeep(*other.ContentReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ContractRule) EqualsShallow(other ContractRule) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ContractRule as FHIR XML
+func (r ContractRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "contentAttachment", r.ContentAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "contentReference", r.ContentReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ContractRule from FHIR XML
+func (r *ContractRule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "contentAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentAttachment = &v
+			case "contentReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContentReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ContractRule as properties to the RDF node n
+func (r ContractRule) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Contract.rule.contentAttachment", -1, r.ContentAttachment)
+	n.element("Contract.rule.contentReference", -1, r.ContentReference)
+}
+
+// MarshalBSON marshals the given ContractRule as BSON document with the structure of its FHIR JSON
+func (r ContractRule) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ContractRule from a BSON document with the structure of its FHIR JSON
+func (r *ContractRule) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ContractRule as protobuf message ContractRule of fhir.proto
+func (r ContractRule) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ContractRule to the encoded message
+func (r ContractRule) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ContentAttachment != nil {
+		e.message(4, *r.ContentAttachment)
+	}
+	if r.ContentReference != nil {
+		e.message(5, *r.ContentReference)
+	}
+}
+
+// UnmarshalProto unmarshals the given ContractRule from protobuf message ContractRule of fhir.proto
+func (r *ContractRule) UnmarshalProto(b []byte) error {
+	*r = ContractRule{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Attachment
+			d.message(&v)
+			r.ContentAttachment = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.ContentReference = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalContract unmarshals a Contract.
 func UnmarshalContract(b []byte) (Contract, error) {
 	var contract Contract
-	if err := json.Unmarshal(b, &contract); err != nil {
+	if err := contract.UnmarshalJSON(b); err != nil {
 		return contract, err
 	}
 	return contract, nil
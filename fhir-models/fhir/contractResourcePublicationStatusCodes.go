@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -47,8 +46,13 @@ const (
 func (code ContractResourcePublicationStatusCodes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ContractResourcePublicationStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ContractResourcePublicationStatusCodes) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "amended":
 		*code = ContractResourcePublicationStatusCodesAmended
@@ -85,6 +89,12 @@ func (code *ContractResourcePublicationStatusCodes) UnmarshalJSON(json []byte) e
 	}
 	return nil
 }
+func (code ContractResourcePublicationStatusCodes) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ContractResourcePublicationStatusCodes) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ContractResourcePublicationStatusCodes) String() string {
 	return code.Code()
 }
@@ -193,3 +203,75 @@ func (code ContractResourcePublicationStatusCodes) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ContractResourcePublicationStatusCodes) protoNumber() uint64 {
+	switch code {
+	case ContractResourcePublicationStatusCodesAmended:
+		return 1
+	case ContractResourcePublicationStatusCodesAppended:
+		return 2
+	case ContractResourcePublicationStatusCodesCancelled:
+		return 3
+	case ContractResourcePublicationStatusCodesDisputed:
+		return 4
+	case ContractResourcePublicationStatusCodesEnteredInError:
+		return 5
+	case ContractResourcePublicationStatusCodesExecutable:
+		return 6
+	case ContractResourcePublicationStatusCodesExecuted:
+		return 7
+	case ContractResourcePublicationStatusCodesNegotiable:
+		return 8
+	case ContractResourcePublicationStatusCodesOffered:
+		return 9
+	case ContractResourcePublicationStatusCodesPolicy:
+		return 10
+	case ContractResourcePublicationStatusCodesRejected:
+		return 11
+	case ContractResourcePublicationStatusCodesRenewed:
+		return 12
+	case ContractResourcePublicationStatusCodesRevoked:
+		return 13
+	case ContractResourcePublicationStatusCodesResolved:
+		return 14
+	case ContractResourcePublicationStatusCodesTerminated:
+		return 15
+	}
+	return 0
+}
+func (code *ContractResourcePublicationStatusCodes) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ContractResourcePublicationStatusCodesAmended
+	case 2:
+		*code = ContractResourcePublicationStatusCodesAppended
+	case 3:
+		*code = ContractResourcePublicationStatusCodesCancelled
+	case 4:
+		*code = ContractResourcePublicationStatusCodesDisputed
+	case 5:
+		*code = ContractResourcePublicationStatusCodesEnteredInError
+	case 6:
+		*code = ContractResourcePublicationStatusCodesExecutable
+	case 7:
+		*code = ContractResourcePublicationStatusCodesExecuted
+	case 8:
+		*code = ContractResourcePublicationStatusCodesNegotiable
+	case 9:
+		*code = ContractResourcePublicationStatusCodesOffered
+	case 10:
+		*code = ContractResourcePublicationStatusCodesPolicy
+	case 11:
+		*code = ContractResourcePublicationStatusCodesRejected
+	case 12:
+		*code = ContractResourcePublicationStatusCodesRenewed
+	case 13:
+		*code = ContractResourcePublicationStatusCodesRevoked
+	case 14:
+		*code = ContractResourcePublicationStatusCodesResolved
+	case 15:
+		*code = ContractResourcePublicationStatusCodesTerminated
+	default:
+		return false
+	}
+	return true
+}
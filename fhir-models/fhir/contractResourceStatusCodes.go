@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -47,8 +46,13 @@ const (
 func (code ContractResourceStatusCodes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ContractResourceStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ContractResourceStatusCodes) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "amended":
 		*code = ContractResourceStatusCodesAmended
@@ -85,6 +89,12 @@ func (code *ContractResourceStatusCodes) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ContractResourceStatusCodes) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ContractResourceStatusCodes) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ContractResourceStatusCodes) String() string {
 	return code.Code()
 }
@@ -193,3 +203,75 @@ func (code ContractResourceStatusCodes) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ContractResourceStatusCodes) protoNumber() uint64 {
+	switch code {
+	case ContractResourceStatusCodesAmended:
+		return 1
+	case ContractResourceStatusCodesAppended:
+		return 2
+	case ContractResourceStatusCodesCancelled:
+		return 3
+	case ContractResourceStatusCodesDisputed:
+		return 4
+	case ContractResourceStatusCodesEnteredInError:
+		return 5
+	case ContractResourceStatusCodesExecutable:
+		return 6
+	case ContractResourceStatusCodesExecuted:
+		return 7
+	case ContractResourceStatusCodesNegotiable:
+		return 8
+	case ContractResourceStatusCodesOffered:
+		return 9
+	case ContractResourceStatusCodesPolicy:
+		return 10
+	case ContractResourceStatusCodesRejected:
+		return 11
+	case ContractResourceStatusCodesRenewed:
+		return 12
+	case ContractResourceStatusCodesRevoked:
+		return 13
+	case ContractResourceStatusCodesResolved:
+		return 14
+	case ContractResourceStatusCodesTerminated:
+		return 15
+	}
+	return 0
+}
+func (code *ContractResourceStatusCodes) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ContractResourceStatusCodesAmended
+	case 2:
+		*code = ContractResourceStatusCodesAppended
+	case 3:
+		*code = ContractResourceStatusCodesCancelled
+	case 4:
+		*code = ContractResourceStatusCodesDisputed
+	case 5:
+		*code = ContractResourceStatusCodesEnteredInError
+	case 6:
+		*code = ContractResourceStatusCodesExecutable
+	case 7:
+		*code = ContractResourceStatusCodesExecuted
+	case 8:
+		*code = ContractResourceStatusCodesNegotiable
+	case 9:
+		*code = ContractResourceStatusCodesOffered
+	case 10:
+		*code = ContractResourceStatusCodesPolicy
+	case 11:
+		*code = ContractResourceStatusCodesRejected
+	case 12:
+		*code = ContractResourceStatusCodesRenewed
+	case 13:
+		*code = ContractResourceStatusCodesRevoked
+	case 14:
+		*code = ContractResourceStatusCodesResolved
+	case 15:
+		*code = ContractResourceStatusCodesTerminated
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code ContributorType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ContributorType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ContributorType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "author":
 		*code = ContributorTypeAuthor
@@ -52,6 +56,12 @@ func (code *ContributorType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ContributorType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ContributorType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ContributorType) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code ContributorType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ContributorType) protoNumber() uint64 {
+	switch code {
+	case ContributorTypeAuthor:
+		return 1
+	case ContributorTypeEditor:
+		return 2
+	case ContributorTypeReviewer:
+		return 3
+	case ContributorTypeEndorser:
+		return 4
+	}
+	return 0
+}
+func (code *ContributorType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ContributorTypeAuthor
+	case 2:
+		*code = ContributorTypeEditor
+	case 3:
+		*code = ContributorTypeReviewer
+	case 4:
+		*code = ContributorTypeEndorser
+	default:
+		return false
+	}
+	return true
+}
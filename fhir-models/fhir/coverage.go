@@ -14,37 +14,50 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Coverage is documented here http://hl7.org/fhir/StructureDefinition/Coverage
 type Coverage struct {
-	Id                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes `bson:"status" json:"status"`
-	Type              *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
-	PolicyHolder      *Reference                   `bson:"policyHolder,omitempty" json:"policyHolder,omitempty"`
-	Subscriber        *Reference                   `bson:"subscriber,omitempty" json:"subscriber,omitempty"`
-	SubscriberId      *string                      `bson:"subscriberId,omitempty" json:"subscriberId,omitempty"`
-	Beneficiary       Reference                    `bson:"beneficiary" json:"beneficiary"`
-	Dependent         *string                      `bson:"dependent,omitempty" json:"dependent,omitempty"`
-	Relationship      *CodeableConcept             `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Period            *Period                      `bson:"period,omitempty" json:"period,omitempty"`
-	Payor             []Reference                  `bson:"payor" json:"payor"`
-	Class             []CoverageClass              `bson:"class,omitempty" json:"class,omitempty"`
-	Order             *int                         `bson:"order,omitempty" json:"order,omitempty"`
-	Network           *string                      `bson:"network,omitempty" json:"network,omitempty"`
-	CostToBeneficiary []CoverageCostToBeneficiary  `bson:"costToBeneficiary,omitempty" json:"costToBeneficiary,omitempty"`
-	Subrogation       *bool                        `bson:"subrogation,omitempty" json:"subrogation,omitempty"`
-	Contract          []Reference                  `bson:"contract,omitempty" json:"contract,omitempty"`
+	Id                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                     `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
+	PolicyHolder         *Reference                   `bson:"policyHolder,omitempty" json:"policyHolder,omitempty"`
+	Subscriber           *Reference                   `bson:"subscriber,omitempty" json:"subscriber,omitempty"`
+	SubscriberId         *string                      `bson:"subscriberId,omitempty" json:"subscriberId,omitempty"`
+	SubscriberIdElement  *Element                     `bson:"_subscriberId,omitempty" json:"_subscriberId,omitempty"`
+	Beneficiary          Reference                    `bson:"beneficiary" json:"beneficiary"`
+	Dependent            *string                      `bson:"dependent,omitempty" json:"dependent,omitempty"`
+	DependentElement     *Element                     `bson:"_dependent,omitempty" json:"_dependent,omitempty"`
+	Relationship         *CodeableConcept             `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Period               *Period                      `bson:"period,omitempty" json:"period,omitempty"`
+	Payor                []Reference                  `bson:"payor" json:"payor"`
+	Class                []CoverageClass              `bson:"class,omitempty" json:"class,omitempty"`
+	Order                *int                         `bson:"order,omitempty" json:"order,omitempty"`
+	OrderElement         *Element                     `bson:"_order,omitempty" json:"_order,omitempty"`
+	Network              *string                      `bson:"network,omitempty" json:"network,omitempty"`
+	NetworkElement       *Element                     `bson:"_network,omitempty" json:"_network,omitempty"`
+	CostToBeneficiary    []CoverageCostToBeneficiary  `bson:"costToBeneficiary,omitempty" json:"costToBeneficiary,omitempty"`
+	Subrogation          *bool                        `bson:"subrogation,omitempty" json:"subrogation,omitempty"`
+	SubrogationElement   *Element                     `bson:"_subrogation,omitempty" json:"_subrogation,omitempty"`
+	Contract             []Reference                  `bson:"contract,omitempty" json:"contract,omitempty"`
 }
 type CoverageClass struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
@@ -52,17 +65,55 @@ type CoverageClass struct {
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              CodeableConcept `bson:"type" json:"type"`
 	Value             string          `bson:"value" json:"value"`
+	ValueElement      *Element        `bson:"_value,omitempty" json:"_value,omitempty"`
 	Name              *string         `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element        `bson:"_name,omitempty" json:"_name,omitempty"`
 }
 type CoverageCostToBeneficiary struct {
 	Id                *string                              `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                          `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              *CodeableConcept                     `bson:"type,omitempty" json:"type,omitempty"`
-	ValueQuantity     Quantity                             `bson:"valueQuantity" json:"valueQuantity"`
-	ValueMoney        Money                                `bson:"valueMoney" json:"valueMoney"`
+	ValueQuantity     *Quantity                            `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueMoney        *Money                               `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
 	Exception         []CoverageCostToBeneficiaryException `bson:"exception,omitempty" json:"exception,omitempty"`
 }
+
+// CoverageCostToBeneficiaryValue is implemented by the types allowed for Coverage.costToBeneficiary.value[x]
+type CoverageCostToBeneficiaryValue interface {
+	isCoverageCostToBeneficiaryValue()
+}
+
+func (Quantity) isCoverageCostToBeneficiaryValue() {}
+func (Money) isCoverageCostToBeneficiaryValue()    {}
+
+// Value returns the value of Coverage.costToBeneficiary.value[x] together with its FHIR type code or nil if no value is set
+func (r CoverageCostToBeneficiary) Value() (CoverageCostToBeneficiaryValue, string) {
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueMoney != nil {
+		return *r.ValueMoney, "Money"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Coverage.costToBeneficiary.value[x], given as value or pointer, and clears all other types
+func (r *CoverageCostToBeneficiary) SetValue(value CoverageCostToBeneficiaryValue) {
+	r.ValueQuantity = nil
+	r.ValueMoney = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Money:
+		r.ValueMoney = &v
+	case *Money:
+		r.ValueMoney = v
+	}
+}
+
 type CoverageCostToBeneficiaryException struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -70,23 +121,2745 @@ type CoverageCostToBeneficiaryException struct {
 	Type              CodeableConcept `bson:"type" json:"type"`
 	Period            *Period         `bson:"period,omitempty" json:"period,omitempty"`
 }
-type OtherCoverage Coverage
 
 // MarshalJSON marshals the given Coverage as JSON into a byte slice
 func (r Coverage) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCoverage
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCoverage: OtherCoverage(r),
-		ResourceType:  "Coverage",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Coverage as JSON object with the members in the order of the fields
+func (r Coverage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Coverage\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.PolicyHolder != nil {
+		e.key("policyHolder")
+		r.PolicyHolder.appendJSON(e)
+	}
+	if r.Subscriber != nil {
+		e.key("subscriber")
+		r.Subscriber.appendJSON(e)
+	}
+	if r.SubscriberId != nil {
+		e.key("subscriberId")
+		e.string(*r.SubscriberId)
+	}
+	if r.SubscriberIdElement != nil {
+		e.key("_subscriberId")
+		r.SubscriberIdElement.appendJSON(e)
+	}
+	e.key("beneficiary")
+	r.Beneficiary.appendJSON(e)
+	if r.Dependent != nil {
+		e.key("dependent")
+		e.string(*r.Dependent)
+	}
+	if r.DependentElement != nil {
+		e.key("_dependent")
+		r.DependentElement.appendJSON(e)
+	}
+	if r.Relationship != nil {
+		e.key("relationship")
+		r.Relationship.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.key("payor")
+	if r.Payor == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Payor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Class) > 0 {
+		e.key("class")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Class {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Order != nil {
+		e.key("order")
+		e.int(*r.Order)
+	}
+	if r.OrderElement != nil {
+		e.key("_order")
+		r.OrderElement.appendJSON(e)
+	}
+	if r.Network != nil {
+		e.key("network")
+		e.string(*r.Network)
+	}
+	if r.NetworkElement != nil {
+		e.key("_network")
+		r.NetworkElement.appendJSON(e)
+	}
+	if len(r.CostToBeneficiary) > 0 {
+		e.key("costToBeneficiary")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CostToBeneficiary {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Subrogation != nil {
+		e.key("subrogation")
+		e.bool(*r.Subrogation)
+	}
+	if r.SubrogationElement != nil {
+		e.key("_subrogation")
+		r.SubrogationElement.appendJSON(e)
+	}
+	if len(r.Contract) > 0 {
+		e.key("contract")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contract {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Coverage from JSON. Unknown members are ignored.
+func (r *Coverage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Coverage
+func (r *Coverage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "policyHolder":
+			if d.null() {
+				r.PolicyHolder = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.PolicyHolder = &v
+			}
+		case "subscriber":
+			if d.null() {
+				r.Subscriber = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subscriber = &v
+			}
+		case "subscriberId":
+			if v, ok := d.string(); ok {
+				r.SubscriberId = &v
+			} else {
+				r.SubscriberId = nil
+			}
+		case "_subscriberId":
+			r.SubscriberIdElement = d.element()
+		case "beneficiary":
+			r.Beneficiary.decodeJSON(d)
+		case "dependent":
+			if v, ok := d.string(); ok {
+				r.Dependent = &v
+			} else {
+				r.Dependent = nil
+			}
+		case "_dependent":
+			r.DependentElement = d.element()
+		case "relationship":
+			if d.null() {
+				r.Relationship = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Relationship = &v
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "payor":
+			a := d.array()
+			r.Payor = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Payor = append(r.Payor, v)
+			}
+			if r.Payor == nil && !a.isNull {
+				r.Payor = []Reference{}
+			}
+		case "class":
+			a := d.array()
+			r.Class = nil
+			for a.next() {
+				var v CoverageClass
+				v.decodeJSON(d)
+				r.Class = append(r.Class, v)
+			}
+			if r.Class == nil && !a.isNull {
+				r.Class = []CoverageClass{}
+			}
+		case "order":
+			if v, ok := d.int(); ok {
+				r.Order = &v
+			} else {
+				r.Order = nil
+			}
+		case "_order":
+			r.OrderElement = d.element()
+		case "network":
+			if v, ok := d.string(); ok {
+				r.Network = &v
+			} else {
+				r.Network = nil
+			}
+		case "_network":
+			r.NetworkElement = d.element()
+		case "costToBeneficiary":
+			a := d.array()
+			r.CostToBeneficiary = nil
+			for a.next() {
+				var v CoverageCostToBeneficiary
+				v.decodeJSON(d)
+				r.CostToBeneficiary = append(r.CostToBeneficiary, v)
+			}
+			if r.CostToBeneficiary == nil && !a.isNull {
+				r.CostToBeneficiary = []CoverageCostToBeneficiary{}
+			}
+		case "subrogation":
+			if v, ok := d.bool(); ok {
+				r.Subrogation = &v
+			} else {
+				r.Subrogation = nil
+			}
+		case "_subrogation":
+			r.SubrogationElement = d.element()
+		case "contract":
+			a := d.array()
+			r.Contract = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Contract = append(r.Contract, v)
+			}
+			if r.Contract == nil && !a.isNull {
+				r.Contract = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Coverage which shares no memory with the original
+func (r Coverage) DeepCopy() Coverage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.PolicyHolder != nil {
+		v := r.PolicyHolder.DeepCopy()
+		out.PolicyHolder = &v
+	}
+	if r.Subscriber != nil {
+		v := r.Subscriber.DeepCopy()
+		out.Subscriber = &v
+	}
+	if r.SubscriberId != nil {
+		v := *r.SubscriberId
+		out.SubscriberId = &v
+	}
+	if r.SubscriberIdElement != nil {
+		v := r.SubscriberIdElement.DeepCopy()
+		out.SubscriberIdElement = &v
+	}
+	out.Beneficiary = r.Beneficiary.DeepCopy()
+	if r.Dependent != nil {
+		v := *r.Dependent
+		out.Dependent = &v
+	}
+	if r.DependentElement != nil {
+		v := r.DependentElement.DeepCopy()
+		out.DependentElement = &v
+	}
+	if r.Relationship != nil {
+		v := r.Relationship.DeepCopy()
+		out.Relationship = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Payor != nil {
+		out.Payor = make([]Reference, len(r.Payor))
+		for i := range r.Payor {
+			out.Payor[i] = r.Payor[i].DeepCopy()
+		}
+	}
+	if r.Class != nil {
+		out.Class = make([]CoverageClass, len(r.Class))
+		for i := range r.Class {
+			out.Class[i] = r.Class[i].DeepCopy()
+		}
+	}
+	if r.Order != nil {
+		v := *r.Order
+		out.Order = &v
+	}
+	if r.OrderElement != nil {
+		v := r.OrderElement.DeepCopy()
+		out.OrderElement = &v
+	}
+	if r.Network != nil {
+		v := *r.Network
+		out.Network = &v
+	}
+	if r.NetworkElement != nil {
+		v := r.NetworkElement.DeepCopy()
+		out.NetworkElement = &v
+	}
+	if r.CostToBeneficiary != nil {
+		out.CostToBeneficiary = make([]CoverageCostToBeneficiary, len(r.CostToBeneficiary))
+		for i := range r.CostToBeneficiary {
+			out.CostToBeneficiary[i] = r.CostToBeneficiary[i].DeepCopy()
+		}
+	}
+	if r.Subrogation != nil {
+		v := *r.Subrogation
+		out.Subrogation = &v
+	}
+	if r.SubrogationElement != nil {
+		v := r.SubrogationElement.DeepCopy()
+		out.SubrogationElement = &v
+	}
+	if r.Contract != nil {
+		out.Contract = make([]Reference, len(r.Contract))
+		for i := range r.Contract {
+			out.Contract[i] = r.Contract[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Coverage) Equal(other Coverage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.PolicyHolder == nil) != (other.PolicyHolder == nil) || r.PolicyHolder != nil && !r.PolicyHolder.Equal(*other.PolicyHolder) {
+		return false
+	}
+	if (r.Subscriber == nil) != (other.Subscriber == nil) || r.Subscriber != nil && !r.Subscriber.Equal(*other.Subscriber) {
+		return false
+	}
+	if (r.SubscriberId == nil) != (other.SubscriberId == nil) || r.SubscriberId != nil && *r.SubscriberId != *other.SubscriberId {
+		return false
+	}
+	if (r.SubscriberIdElement == nil) != (other.SubscriberIdElement == nil) || r.SubscriberIdElement != nil && !r.SubscriberIdElement.Equal(*other.SubscriberIdElement) {
+		return false
+	}
+	if !r.Beneficiary.Equal(other.Beneficiary) {
+		return false
+	}
+	if (r.Dependent == nil) != (other.Dependent == nil) || r.Dependent != nil && *r.Dependent != *other.Dependent {
+		return false
+	}
+	if (r.DependentElement == nil) != (other.DependentElement == nil) || r.DependentElement != nil && !r.DependentElement.Equal(*other.DependentElement) {
+		return false
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.Equal(*other.Relationship) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if len(r.Payor) != len(other.Payor) {
+		return false
+	}
+	for i := range r.Payor {
+		if !r.Payor[i].Equal(other.Payor[i]) {
+			return false
+		}
+	}
+	if len(r.Class) != len(other.Class) {
+		return false
+	}
+	for i := range r.Class {
+		if !r.Class[i].Equal(other.Class[i]) {
+			return false
+		}
+	}
+	if (r.Order == nil) != (other.Order == nil) || r.Order != nil && *r.Order != *other.Order {
+		return false
+	}
+	if (r.OrderElement == nil) != (other.OrderElement == nil) || r.OrderElement != nil && !r.OrderElement.Equal(*other.OrderElement) {
+		return false
+	}
+	if (r.Network == nil) != (other.Network == nil) || r.Network != nil && *r.Network != *other.Network {
+		return false
+	}
+	if (r.NetworkElement == nil) != (other.NetworkElement == nil) || r.NetworkElement != nil && !r.NetworkElement.Equal(*other.NetworkElement) {
+		return false
+	}
+	if len(r.CostToBeneficiary) != len(other.CostToBeneficiary) {
+		return false
+	}
+	for i := range r.CostToBeneficiary {
+		if !r.CostToBeneficiary[i].Equal(other.CostToBeneficiary[i]) {
+			return false
+		}
+	}
+	if (r.Subrogation == nil) != (other.Subrogation == nil) || r.Subrogation != nil && *r.Subrogation != *other.Subrogation {
+		return false
+	}
+	if (r.SubrogationElement == nil) != (other.SubrogationElement == nil) || r.SubrogationElement != nil && !r.SubrogationElement.Equal(*other.SubrogationElement) {
+		return false
+	}
+	if len(r.Contract) != len(other.Contract) {
+		return false
+	}
+	for i := range r.Contract {
+		if !r.Contract[i].Equal(other.Contract[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Coverage) EqualsDeep(other Coverage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.PolicyHolder == nil) != (other.PolicyHolder == nil) || r.PolicyHolder != nil && !r.PolicyHolder.EqualsDeep(*other.PolicyHolder) {
+		return false
+	}
+	if (r.Subscriber == nil) != (other.Subscriber == nil) || r.Subscriber != nil && !r.Subscriber.EqualsDeep(*other.Subscriber) {
+		return false
+	}
+	if (r.SubscriberId == nil) != (other.SubscriberId == nil) || r.SubscriberId != nil && !equivalentString(*r.SubscriberId, *other.SubscriberId) {
+		return false
+	}
+	if (r.SubscriberIdElement == nil) != (other.SubscriberIdElement == nil) || r.SubscriberIdElement != nil && !r.SubscriberIdElement.EqualsDeep(*other.SubscriberIdElement) {
+		return false
+	}
+	if !r.Beneficiary.EqualsDeep(other.Beneficiary) {
+		return false
+	}
+	if (r.Dependent == nil) != (other.Dependent == nil) || r.Dependent != nil && !equivalentString(*r.Dependent, *other.Dependent) {
+		return false
+	}
+	if (r.DependentElement == nil) != (other.DependentElement == nil) || r.DependentElement != nil && !r.DependentElement.EqualsDeep(*other.DependentElement) {
+		return false
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.EqualsDeep(*other.Relationship) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentList(len(r.Payor), len(other.Payor), func(i, j int) bool {
+		return r.Payor[i].EqualsDeep(other.Payor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Class), len(other.Class), func(i, j int) bool {
+		return r.Class[i].EqualsDeep(other.Class[j])
+	}) {
+		return false
+	}
+	if (r.Order == nil) != (other.Order == nil) || r.Order != nil && *r.Order != *other.Order {
+		return false
+	}
+	if (r.OrderElement == nil) != (other.OrderElement == nil) || r.OrderElement != nil && !r.OrderElement.EqualsDeep(*other.OrderElement) {
+		return false
+	}
+	if (r.Network == nil) != (other.Network == nil) || r.Network != nil && !equivalentString(*r.Network, *other.Network) {
+		return false
+	}
+	if (r.NetworkElement == nil) != (other.NetworkElement == nil) || r.NetworkElement != nil && !r.NetworkElement.EqualsDeep(*other.NetworkElement) {
+		return false
+	}
+	if !equivalentList(len(r.CostToBeneficiary), len(other.CostToBeneficiary), func(i, j int) bool {
+		return r.CostToBeneficiary[i].EqualsDeep(other.CostToBeneficiary[j])
+	}) {
+		return false
+	}
+	if (r.Subrogation == nil) != (other.Subrogation == nil) || r.Subrogation != nil && *r.Subrogation != *other.Subrogation {
+		return false
+	}
+	if (r.SubrogationElement == nil) != (other.SubrogationElement == nil) || r.SubrogationElement != nil && !r.SubrogationElement.EqualsDeep(*other.SubrogationElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contract), len(other.Contract), func(i, j int) bool {
+		return r.Contract[i].EqualsDeep(other.Contract[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Coverage) EqualsShallow(other Coverage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.SubscriberId == nil) != (other.SubscriberId == nil) || r.SubscriberId != nil && !equivalentString(*r.SubscriberId, *other.SubscriberId) {
+		return false
+	}
+	if (r.Dependent == nil) != (other.Dependent == nil) || r.Dependent != nil && !equivalentString(*r.Dependent, *other.Dependent) {
+		return false
+	}
+	if (r.Order == nil) != (other.Order == nil) || r.Order != nil && *r.Order != *other.Order {
+		return false
+	}
+	if (r.Network == nil) != (other.Network == nil) || r.Network != nil && !equivalentString(*r.Network, *other.Network) {
+		return false
+	}
+	if (r.Subrogation == nil) != (other.Subrogation == nil) || r.Subrogation != nil && *r.Subrogation != *other.Subrogation {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Coverage as FHIR XML
+func (r Coverage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Coverage",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "policyHolder", r.PolicyHolder); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subscriber", r.Subscriber); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "subscriberId", r.SubscriberId, r.SubscriberIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "beneficiary", r.Beneficiary); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "dependent", r.Dependent, r.DependentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "relationship", r.Relationship); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	for _, v := range r.Payor {
+		if err := encodeXMLElement(e, "payor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Class {
+		if err := encodeXMLElement(e, "class", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "order", r.Order, r.OrderElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "network", r.Network, r.NetworkElement); err != nil {
+		return err
+	}
+	for _, v := range r.CostToBeneficiary {
+		if err := encodeXMLElement(e, "costToBeneficiary", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "subrogation", r.Subrogation, r.SubrogationElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contract {
+		if err := encodeXMLElement(e, "contract", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Coverage from FHIR XML
+func (r *Coverage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "policyHolder":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PolicyHolder = &v
+			case "subscriber":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subscriber = &v
+			case "subscriberId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SubscriberId = &v
+				}
+				r.SubscriberIdElement = element
+			case "beneficiary":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Beneficiary = v
+			case "dependent":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Dependent = &v
+				}
+				r.DependentElement = element
+			case "relationship":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Relationship = &v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "payor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payor = append(r.Payor, v)
+			case "class":
+				var v CoverageClass
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Class = append(r.Class, v)
+			case "order":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Order = &v
+				}
+				r.OrderElement = element
+			case "network":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Network = &v
+				}
+				r.NetworkElement = element
+			case "costToBeneficiary":
+				var v CoverageCostToBeneficiary
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CostToBeneficiary = append(r.CostToBeneficiary, v)
+			case "subrogation":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subrogation = &v
+				}
+				r.SubrogationElement = element
+			case "contract":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contract = append(r.Contract, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Coverage as properties to the RDF node n
+func (r Coverage) turtle(n *turtleNode) {
+	n.resource("Coverage", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Coverage.identifier", i, v)
+	}
+	n.primitive("Coverage.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Coverage.type", -1, r.Type)
+	n.element("Coverage.policyHolder", -1, r.PolicyHolder)
+	n.element("Coverage.subscriber", -1, r.Subscriber)
+	n.primitive("Coverage.subscriberId", -1, r.SubscriberId, r.SubscriberIdElement, "string")
+	n.element("Coverage.beneficiary", -1, r.Beneficiary)
+	n.primitive("Coverage.dependent", -1, r.Dependent, r.DependentElement, "string")
+	n.element("Coverage.relationship", -1, r.Relationship)
+	n.element("Coverage.period", -1, r.Period)
+	for i, v := range r.Payor {
+		n.element("Coverage.payor", i, v)
+	}
+	for i, v := range r.Class {
+		n.element("Coverage.class", i, v)
+	}
+	n.primitive("Coverage.order", -1, r.Order, r.OrderElement, "positiveInt")
+	n.primitive("Coverage.network", -1, r.Network, r.NetworkElement, "string")
+	for i, v := range r.CostToBeneficiary {
+		n.element("Coverage.costToBeneficiary", i, v)
+	}
+	n.primitive("Coverage.subrogation", -1, r.Subrogation, r.SubrogationElement, "boolean")
+	for i, v := range r.Contract {
+		n.element("Coverage.contract", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Coverage as BSON document with the structure of its FHIR JSON
+func (r Coverage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Coverage from a BSON document with the structure of its FHIR JSON
+func (r *Coverage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Coverage as protobuf message Coverage of fhir.proto
+func (r Coverage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Coverage to the encoded message
+func (r Coverage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.Type != nil {
+		e.message(11, *r.Type)
+	}
+	if r.PolicyHolder != nil {
+		e.message(12, *r.PolicyHolder)
+	}
+	if r.Subscriber != nil {
+		e.message(13, *r.Subscriber)
+	}
+	if r.SubscriberId != nil {
+		e.string(14, *r.SubscriberId)
+	}
+	e.message(15, r.Beneficiary)
+	if r.Dependent != nil {
+		e.string(16, *r.Dependent)
+	}
+	if r.Relationship != nil {
+		e.message(17, *r.Relationship)
+	}
+	if r.Period != nil {
+		e.message(18, *r.Period)
+	}
+	for _, v := range r.Payor {
+		e.message(19, v)
+	}
+	for _, v := range r.Class {
+		e.message(20, v)
+	}
+	if r.Order != nil {
+		e.varint(21, uint64(int64(*r.Order)))
+	}
+	if r.Network != nil {
+		e.string(22, *r.Network)
+	}
+	for _, v := range r.CostToBeneficiary {
+		e.message(23, v)
+	}
+	if r.Subrogation != nil {
+		e.varint(24, protoBool(*r.Subrogation))
+	}
+	for _, v := range r.Contract {
+		e.message(25, v)
+	}
+	if r.IdElement != nil {
+		e.message(26, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(27, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(28, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(29, *r.StatusElement)
+	}
+	if r.SubscriberIdElement != nil {
+		e.message(30, *r.SubscriberIdElement)
+	}
+	if r.DependentElement != nil {
+		e.message(31, *r.DependentElement)
+	}
+	if r.OrderElement != nil {
+		e.message(32, *r.OrderElement)
+	}
+	if r.NetworkElement != nil {
+		e.message(33, *r.NetworkElement)
+	}
+	if r.SubrogationElement != nil {
+		e.message(34, *r.SubrogationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Coverage from protobuf message Coverage of fhir.proto
+func (r *Coverage) UnmarshalProto(b []byte) error {
+	*r = Coverage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.PolicyHolder = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Subscriber = &v
+		case 14:
+			v := d.string()
+			r.SubscriberId = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Beneficiary = v
+		case 16:
+			v := d.string()
+			r.Dependent = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.Relationship = &v
+		case 18:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Payor = append(r.Payor, v)
+		case 20:
+			var v CoverageClass
+			d.message(&v)
+			r.Class = append(r.Class, v)
+		case 21:
+			v := d.int()
+			r.Order = &v
+		case 22:
+			v := d.string()
+			r.Network = &v
+		case 23:
+			var v CoverageCostToBeneficiary
+			d.message(&v)
+			r.CostToBeneficiary = append(r.CostToBeneficiary, v)
+		case 24:
+			v := d.bool()
+			r.Subrogation = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Contract = append(r.Contract, v)
+		case 26:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.SubscriberIdElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.DependentElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.OrderElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.NetworkElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.SubrogationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageClass as JSON into a byte slice
+func (r CoverageClass) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageClass as JSON object with the members in the order of the fields
+func (r CoverageClass) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageClass from JSON. Unknown members are ignored.
+func (r *CoverageClass) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageClass
+func (r *CoverageClass) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageClass which shares no memory with the original
+func (r CoverageClass) DeepCopy() CoverageClass {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageClass) Equal(other CoverageClass) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageClass) EqualsDeep(other CoverageClass) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageClass) EqualsShallow(other CoverageClass) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageClass as FHIR XML
+func (r CoverageClass) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageClass from FHIR XML
+func (r *CoverageClass) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageClass as properties to the RDF node n
+func (r CoverageClass) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Coverage.class.type", -1, r.Type)
+	n.primitive("Coverage.class.value", -1, r.Value, r.ValueElement, "string")
+	n.primitive("Coverage.class.name", -1, r.Name, r.NameElement, "string")
+}
+
+// MarshalBSON marshals the given CoverageClass as BSON document with the structure of its FHIR JSON
+func (r CoverageClass) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageClass from a BSON document with the structure of its FHIR JSON
+func (r *CoverageClass) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageClass as protobuf message CoverageClass of fhir.proto
+func (r CoverageClass) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageClass to the encoded message
+func (r CoverageClass) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.Value != "" {
+		e.string(5, r.Value)
+	}
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.ValueElement != nil {
+		e.message(7, *r.ValueElement)
+	}
+	if r.NameElement != nil {
+		e.message(8, *r.NameElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageClass from protobuf message CoverageClass of fhir.proto
+func (r *CoverageClass) UnmarshalProto(b []byte) error {
+	*r = CoverageClass{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			r.Value = d.string()
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageCostToBeneficiary as JSON into a byte slice
+func (r CoverageCostToBeneficiary) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageCostToBeneficiary as JSON object with the members in the order of the fields
+func (r CoverageCostToBeneficiary) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueMoney != nil {
+		e.key("valueMoney")
+		r.ValueMoney.appendJSON(e)
+	}
+	if len(r.Exception) > 0 {
+		e.key("exception")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Exception {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageCostToBeneficiary from JSON. Unknown members are ignored.
+func (r *CoverageCostToBeneficiary) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageCostToBeneficiary
+func (r *CoverageCostToBeneficiary) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueMoney":
+			if d.null() {
+				r.ValueMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.ValueMoney = &v
+			}
+		case "exception":
+			a := d.array()
+			r.Exception = nil
+			for a.next() {
+				var v CoverageCostToBeneficiaryException
+				v.decodeJSON(d)
+				r.Exception = append(r.Exception, v)
+			}
+			if r.Exception == nil && !a.isNull {
+				r.Exception = []CoverageCostToBeneficiaryException{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageCostToBeneficiary which shares no memory with the original
+func (r CoverageCostToBeneficiary) DeepCopy() CoverageCostToBeneficiary {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueMoney != nil {
+		v := r.ValueMoney.DeepCopy()
+		out.ValueMoney = &v
+	}
+	if r.Exception != nil {
+		out.Exception = make([]CoverageCostToBeneficiaryException, len(r.Exception))
+		for i := range r.Exception {
+			out.Exception[i] = r.Exception[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageCostToBeneficiary) Equal(other CoverageCostToBeneficiary) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.Equal(*other.ValueMoney) {
+		return false
+	}
+	if len(r.Exception) != len(other.Exception) {
+		return false
+	}
+	for i := range r.Exception {
+		if !r.Exception[i].Equal(other.Exception[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageCostToBeneficiary) EqualsDeep(other CoverageCostToBeneficiary) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.EqualsDeep(*other.ValueMoney) {
+		return false
+	}
+	if !equivalentList(len(r.Exception), len(other.Exception), func(i, j int) bool {
+		return r.Exception[i].EqualsDeep(other.Exception[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageCostToBeneficiary) EqualsShallow(other CoverageCostToBeneficiary) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageCostToBeneficiary as FHIR XML
+func (r CoverageCostToBeneficiary) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueMoney", r.ValueMoney); err != nil {
+		return err
+	}
+	for _, v := range r.Exception {
+		if err := encodeXMLElement(e, "exception", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageCostToBeneficiary from FHIR XML
+func (r *CoverageCostToBeneficiary) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueMoney = &v
+			case "exception":
+				var v CoverageCostToBeneficiaryException
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Exception = append(r.Exception, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageCostToBeneficiary as properties to the RDF node n
+func (r CoverageCostToBeneficiary) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Coverage.costToBeneficiary.type", -1, r.Type)
+	n.element("Coverage.costToBeneficiary.valueQuantity", -1, r.ValueQuantity)
+	n.element("Coverage.costToBeneficiary.valueMoney", -1, r.ValueMoney)
+	for i, v := range r.Exception {
+		n.element("Coverage.costToBeneficiary.exception", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CoverageCostToBeneficiary as BSON document with the structure of its FHIR JSON
+func (r CoverageCostToBeneficiary) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageCostToBeneficiary from a BSON document with the structure of its FHIR JSON
+func (r *CoverageCostToBeneficiary) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageCostToBeneficiary as protobuf message CoverageCostToBeneficiary of fhir.proto
+func (r CoverageCostToBeneficiary) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageCostToBeneficiary to the encoded message
+func (r CoverageCostToBeneficiary) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.ValueQuantity != nil {
+		e.message(5, *r.ValueQuantity)
+	}
+	if r.ValueMoney != nil {
+		e.message(6, *r.ValueMoney)
+	}
+	for _, v := range r.Exception {
+		e.message(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageCostToBeneficiary from protobuf message CoverageCostToBeneficiary of fhir.proto
+func (r *CoverageCostToBeneficiary) UnmarshalProto(b []byte) error {
+	*r = CoverageCostToBeneficiary{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 6:
+			var v Money
+			d.message(&v)
+			r.ValueMoney = &v
+		case 7:
+			var v CoverageCostToBeneficiaryException
+			d.message(&v)
+			r.Exception = append(r.Exception, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageCostToBeneficiaryException as JSON into a byte slice
+func (r CoverageCostToBeneficiaryException) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageCostToBeneficiaryException as JSON object with the members in the order of the fields
+func (r CoverageCostToBeneficiaryException) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageCostToBeneficiaryException from JSON. Unknown members are ignored.
+func (r *CoverageCostToBeneficiaryException) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageCostToBeneficiaryException
+func (r *CoverageCostToBeneficiaryException) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageCostToBeneficiaryException which shares no memory with the original
+func (r CoverageCostToBeneficiaryException) DeepCopy() CoverageCostToBeneficiaryException {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageCostToBeneficiaryException) Equal(other CoverageCostToBeneficiaryException) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageCostToBeneficiaryException) EqualsDeep(other CoverageCostToBeneficiaryException) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageCostToBeneficiaryException) EqualsShallow(other CoverageCostToBeneficiaryException) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageCostToBeneficiaryException as FHIR XML
+func (r CoverageCostToBeneficiaryException) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageCostToBeneficiaryException from FHIR XML
+func (r *CoverageCostToBeneficiaryException) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageCostToBeneficiaryException as properties to the RDF node n
+func (r CoverageCostToBeneficiaryException) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Coverage.costToBeneficiary.exception.type", -1, r.Type)
+	n.element("Coverage.costToBeneficiary.exception.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given CoverageCostToBeneficiaryException as BSON document with the structure of its FHIR JSON
+func (r CoverageCostToBeneficiaryException) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageCostToBeneficiaryException from a BSON document with the structure of its FHIR JSON
+func (r *CoverageCostToBeneficiaryException) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageCostToBeneficiaryException as protobuf message CoverageCostToBeneficiaryException of fhir.proto
+func (r CoverageCostToBeneficiaryException) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageCostToBeneficiaryException to the encoded message
+func (r CoverageCostToBeneficiaryException) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.Period != nil {
+		e.message(5, *r.Period)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageCostToBeneficiaryException from protobuf message CoverageCostToBeneficiaryException of fhir.proto
+func (r *CoverageCostToBeneficiaryException) UnmarshalProto(b []byte) error {
+	*r = CoverageCostToBeneficiaryException{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCoverage unmarshals a Coverage.
 func UnmarshalCoverage(b []byte) (Coverage, error) {
 	var coverage Coverage
-	if err := json.Unmarshal(b, &coverage); err != nil {
+	if err := coverage.UnmarshalJSON(b); err != nil {
 		return coverage, err
 	}
 	return coverage, nil
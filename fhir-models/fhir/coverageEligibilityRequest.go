@@ -14,66 +14,119 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // CoverageEligibilityRequest is documented here http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest
 type CoverageEligibilityRequest struct {
-	Id                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes               `bson:"status" json:"status"`
-	Priority          *CodeableConcept                           `bson:"priority,omitempty" json:"priority,omitempty"`
-	Purpose           []EligibilityRequestPurpose                `bson:"purpose" json:"purpose"`
-	Patient           Reference                                  `bson:"patient" json:"patient"`
-	ServicedDate      *string                                    `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
-	ServicedPeriod    *Period                                    `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
-	Created           string                                     `bson:"created" json:"created"`
-	Enterer           *Reference                                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
-	Provider          *Reference                                 `bson:"provider,omitempty" json:"provider,omitempty"`
-	Insurer           Reference                                  `bson:"insurer" json:"insurer"`
-	Facility          *Reference                                 `bson:"facility,omitempty" json:"facility,omitempty"`
-	SupportingInfo    []CoverageEligibilityRequestSupportingInfo `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
-	Insurance         []CoverageEligibilityRequestInsurance      `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	Item              []CoverageEligibilityRequestItem           `bson:"item,omitempty" json:"item,omitempty"`
+	Id                   *string                                    `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                                   `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage                          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes               `bson:"status" json:"status"`
+	StatusElement        *Element                                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Priority             *CodeableConcept                           `bson:"priority,omitempty" json:"priority,omitempty"`
+	Purpose              []EligibilityRequestPurpose                `bson:"purpose" json:"purpose"`
+	PurposeElement       []*Element                                 `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Patient              Reference                                  `bson:"patient" json:"patient"`
+	ServicedDate         *string                                    `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement  *Element                                   `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod       *Period                                    `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	Created              string                                     `bson:"created" json:"created"`
+	CreatedElement       *Element                                   `bson:"_created,omitempty" json:"_created,omitempty"`
+	Enterer              *Reference                                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
+	Provider             *Reference                                 `bson:"provider,omitempty" json:"provider,omitempty"`
+	Insurer              Reference                                  `bson:"insurer" json:"insurer"`
+	Facility             *Reference                                 `bson:"facility,omitempty" json:"facility,omitempty"`
+	SupportingInfo       []CoverageEligibilityRequestSupportingInfo `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
+	Insurance            []CoverageEligibilityRequestInsurance      `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	Item                 []CoverageEligibilityRequestItem           `bson:"item,omitempty" json:"item,omitempty"`
 }
-type CoverageEligibilityRequestSupportingInfo struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int         `bson:"sequence" json:"sequence"`
-	Information       Reference   `bson:"information" json:"information"`
-	AppliesToAll      *bool       `bson:"appliesToAll,omitempty" json:"appliesToAll,omitempty"`
+
+// CoverageEligibilityRequestServiced is implemented by the types allowed for CoverageEligibilityRequest.serviced[x]
+type CoverageEligibilityRequestServiced interface {
+	isCoverageEligibilityRequestServiced()
 }
-type CoverageEligibilityRequestInsurance struct {
+
+func (Date) isCoverageEligibilityRequestServiced()   {}
+func (Period) isCoverageEligibilityRequestServiced() {}
+
+// Serviced returns the value of CoverageEligibilityRequest.serviced[x] together with its FHIR type code or nil if no value is set
+func (r CoverageEligibilityRequest) Serviced() (CoverageEligibilityRequestServiced, string) {
+	if r.ServicedDate != nil {
+		return Date(*r.ServicedDate), "date"
+	}
+	if r.ServicedPeriod != nil {
+		return *r.ServicedPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetServiced sets the value of CoverageEligibilityRequest.serviced[x], given as value or pointer, and clears all other types
+func (r *CoverageEligibilityRequest) SetServiced(value CoverageEligibilityRequestServiced) {
+	r.ServicedDate = nil
+	r.ServicedPeriod = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.ServicedDate = &primitive
+	case *Date:
+		r.ServicedDate = (*string)(v)
+	case Period:
+		r.ServicedPeriod = &v
+	case *Period:
+		r.ServicedPeriod = v
+	}
+}
+
+type CoverageEligibilityRequestSupportingInfo struct {
 	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Focal               *bool       `bson:"focal,omitempty" json:"focal,omitempty"`
-	Coverage            Reference   `bson:"coverage" json:"coverage"`
-	BusinessArrangement *string     `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
+	Sequence            int         `bson:"sequence" json:"sequence"`
+	SequenceElement     *Element    `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Information         Reference   `bson:"information" json:"information"`
+	AppliesToAll        *bool       `bson:"appliesToAll,omitempty" json:"appliesToAll,omitempty"`
+	AppliesToAllElement *Element    `bson:"_appliesToAll,omitempty" json:"_appliesToAll,omitempty"`
+}
+type CoverageEligibilityRequestInsurance struct {
+	Id                         *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Focal                      *bool       `bson:"focal,omitempty" json:"focal,omitempty"`
+	FocalElement               *Element    `bson:"_focal,omitempty" json:"_focal,omitempty"`
+	Coverage                   Reference   `bson:"coverage" json:"coverage"`
+	BusinessArrangement        *string     `bson:"businessArrangement,omitempty" json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *Element    `bson:"_businessArrangement,omitempty" json:"_businessArrangement,omitempty"`
 }
 type CoverageEligibilityRequestItem struct {
-	Id                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SupportingInfoSequence []int                                     `bson:"supportingInfoSequence,omitempty" json:"supportingInfoSequence,omitempty"`
-	Category               *CodeableConcept                          `bson:"category,omitempty" json:"category,omitempty"`
-	ProductOrService       *CodeableConcept                          `bson:"productOrService,omitempty" json:"productOrService,omitempty"`
-	Modifier               []CodeableConcept                         `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	Provider               *Reference                                `bson:"provider,omitempty" json:"provider,omitempty"`
-	Quantity               *Quantity                                 `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice              *Money                                    `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Facility               *Reference                                `bson:"facility,omitempty" json:"facility,omitempty"`
-	Diagnosis              []CoverageEligibilityRequestItemDiagnosis `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
-	Detail                 []Reference                               `bson:"detail,omitempty" json:"detail,omitempty"`
+	Id                            *string                                   `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SupportingInfoSequence        []int                                     `bson:"supportingInfoSequence,omitempty" json:"supportingInfoSequence,omitempty"`
+	SupportingInfoSequenceElement []*Element                                `bson:"_supportingInfoSequence,omitempty" json:"_supportingInfoSequence,omitempty"`
+	Category                      *CodeableConcept                          `bson:"category,omitempty" json:"category,omitempty"`
+	ProductOrService              *CodeableConcept                          `bson:"productOrService,omitempty" json:"productOrService,omitempty"`
+	Modifier                      []CodeableConcept                         `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	Provider                      *Reference                                `bson:"provider,omitempty" json:"provider,omitempty"`
+	Quantity                      *Quantity                                 `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                     *Money                                    `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Facility                      *Reference                                `bson:"facility,omitempty" json:"facility,omitempty"`
+	Diagnosis                     []CoverageEligibilityRequestItemDiagnosis `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
+	Detail                        []Reference                               `bson:"detail,omitempty" json:"detail,omitempty"`
 }
 type CoverageEligibilityRequestItemDiagnosis struct {
 	Id                       *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -82,23 +135,3435 @@ type CoverageEligibilityRequestItemDiagnosis struct {
 	DiagnosisCodeableConcept *CodeableConcept `bson:"diagnosisCodeableConcept,omitempty" json:"diagnosisCodeableConcept,omitempty"`
 	DiagnosisReference       *Reference       `bson:"diagnosisReference,omitempty" json:"diagnosisReference,omitempty"`
 }
-type OtherCoverageEligibilityRequest CoverageEligibilityRequest
+
+// CoverageEligibilityRequestItemDiagnosisDiagnosis is implemented by the types allowed for CoverageEligibilityRequest.item.diagnosis.diagnosis[x]
+type CoverageEligibilityRequestItemDiagnosisDiagnosis interface {
+	isCoverageEligibilityRequestItemDiagnosisDiagnosis()
+}
+
+func (CodeableConcept) isCoverageEligibilityRequestItemDiagnosisDiagnosis() {}
+func (Reference) isCoverageEligibilityRequestItemDiagnosisDiagnosis()       {}
+
+// Diagnosis returns the value of CoverageEligibilityRequest.item.diagnosis.diagnosis[x] together with its FHIR type code or nil if no value is set
+func (r CoverageEligibilityRequestItemDiagnosis) Diagnosis() (CoverageEligibilityRequestItemDiagnosisDiagnosis, string) {
+	if r.DiagnosisCodeableConcept != nil {
+		return *r.DiagnosisCodeableConcept, "CodeableConcept"
+	}
+	if r.DiagnosisReference != nil {
+		return *r.DiagnosisReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetDiagnosis sets the value of CoverageEligibilityRequest.item.diagnosis.diagnosis[x], given as value or pointer, and clears all other types
+func (r *CoverageEligibilityRequestItemDiagnosis) SetDiagnosis(value CoverageEligibilityRequestItemDiagnosisDiagnosis) {
+	r.DiagnosisCodeableConcept = nil
+	r.DiagnosisReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.DiagnosisCodeableConcept = &v
+	case *CodeableConcept:
+		r.DiagnosisCodeableConcept = v
+	case Reference:
+		r.DiagnosisReference = &v
+	case *Reference:
+		r.DiagnosisReference = v
+	}
+}
 
 // MarshalJSON marshals the given CoverageEligibilityRequest as JSON into a byte slice
 func (r CoverageEligibilityRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCoverageEligibilityRequest
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCoverageEligibilityRequest: OtherCoverageEligibilityRequest(r),
-		ResourceType:                    "CoverageEligibilityRequest",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityRequest as JSON object with the members in the order of the fields
+func (r CoverageEligibilityRequest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"CoverageEligibilityRequest\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		r.Priority.appendJSON(e)
+	}
+	e.key("purpose")
+	if r.Purpose == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Purpose {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PurposeElement) > 0 {
+		e.key("_purpose")
+		e.elements(r.PurposeElement, len(r.Purpose))
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.ServicedDate != nil {
+		e.key("servicedDate")
+		e.string(*r.ServicedDate)
+	}
+	if r.ServicedDateElement != nil {
+		e.key("_servicedDate")
+		r.ServicedDateElement.appendJSON(e)
+	}
+	if r.ServicedPeriod != nil {
+		e.key("servicedPeriod")
+		r.ServicedPeriod.appendJSON(e)
+	}
+	e.key("created")
+	e.string(r.Created)
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.Enterer != nil {
+		e.key("enterer")
+		r.Enterer.appendJSON(e)
+	}
+	if r.Provider != nil {
+		e.key("provider")
+		r.Provider.appendJSON(e)
+	}
+	e.key("insurer")
+	r.Insurer.appendJSON(e)
+	if r.Facility != nil {
+		e.key("facility")
+		r.Facility.appendJSON(e)
+	}
+	if len(r.SupportingInfo) > 0 {
+		e.key("supportingInfo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInfo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Insurance) > 0 {
+		e.key("insurance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Insurance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Item) > 0 {
+		e.key("item")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Item {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityRequest from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityRequest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityRequest
+func (r *CoverageEligibilityRequest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Priority = &v
+			}
+		case "purpose":
+			a := d.array()
+			r.Purpose = nil
+			for a.next() {
+				var v EligibilityRequestPurpose
+				d.unmarshal(&v)
+				r.Purpose = append(r.Purpose, v)
+			}
+			if r.Purpose == nil && !a.isNull {
+				r.Purpose = []EligibilityRequestPurpose{}
+			}
+		case "_purpose":
+			a := d.array()
+			r.PurposeElement = nil
+			for a.next() {
+				r.PurposeElement = append(r.PurposeElement, d.element())
+			}
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "servicedDate":
+			if v, ok := d.string(); ok {
+				r.ServicedDate = &v
+			} else {
+				r.ServicedDate = nil
+			}
+		case "_servicedDate":
+			r.ServicedDateElement = d.element()
+		case "servicedPeriod":
+			if d.null() {
+				r.ServicedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ServicedPeriod = &v
+			}
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = v
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "enterer":
+			if d.null() {
+				r.Enterer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Enterer = &v
+			}
+		case "provider":
+			if d.null() {
+				r.Provider = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Provider = &v
+			}
+		case "insurer":
+			r.Insurer.decodeJSON(d)
+		case "facility":
+			if d.null() {
+				r.Facility = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Facility = &v
+			}
+		case "supportingInfo":
+			a := d.array()
+			r.SupportingInfo = nil
+			for a.next() {
+				var v CoverageEligibilityRequestSupportingInfo
+				v.decodeJSON(d)
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			}
+			if r.SupportingInfo == nil && !a.isNull {
+				r.SupportingInfo = []CoverageEligibilityRequestSupportingInfo{}
+			}
+		case "insurance":
+			a := d.array()
+			r.Insurance = nil
+			for a.next() {
+				var v CoverageEligibilityRequestInsurance
+				v.decodeJSON(d)
+				r.Insurance = append(r.Insurance, v)
+			}
+			if r.Insurance == nil && !a.isNull {
+				r.Insurance = []CoverageEligibilityRequestInsurance{}
+			}
+		case "item":
+			a := d.array()
+			r.Item = nil
+			for a.next() {
+				var v CoverageEligibilityRequestItem
+				v.decodeJSON(d)
+				r.Item = append(r.Item, v)
+			}
+			if r.Item == nil && !a.isNull {
+				r.Item = []CoverageEligibilityRequestItem{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityRequest which shares no memory with the original
+func (r CoverageEligibilityRequest) DeepCopy() CoverageEligibilityRequest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Priority != nil {
+		v := r.Priority.DeepCopy()
+		out.Priority = &v
+	}
+	if r.Purpose != nil {
+		out.Purpose = make([]EligibilityRequestPurpose, len(r.Purpose))
+		copy(out.Purpose, r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		out.PurposeElement = make([]*Element, len(r.PurposeElement))
+		for i, v := range r.PurposeElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PurposeElement[i] = &c
+			}
+		}
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.ServicedDate != nil {
+		v := *r.ServicedDate
+		out.ServicedDate = &v
+	}
+	if r.ServicedDateElement != nil {
+		v := r.ServicedDateElement.DeepCopy()
+		out.ServicedDateElement = &v
+	}
+	if r.ServicedPeriod != nil {
+		v := r.ServicedPeriod.DeepCopy()
+		out.ServicedPeriod = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Enterer != nil {
+		v := r.Enterer.DeepCopy()
+		out.Enterer = &v
+	}
+	if r.Provider != nil {
+		v := r.Provider.DeepCopy()
+		out.Provider = &v
+	}
+	out.Insurer = r.Insurer.DeepCopy()
+	if r.Facility != nil {
+		v := r.Facility.DeepCopy()
+		out.Facility = &v
+	}
+	if r.SupportingInfo != nil {
+		out.SupportingInfo = make([]CoverageEligibilityRequestSupportingInfo, len(r.SupportingInfo))
+		for i := range r.SupportingInfo {
+			out.SupportingInfo[i] = r.SupportingInfo[i].DeepCopy()
+		}
+	}
+	if r.Insurance != nil {
+		out.Insurance = make([]CoverageEligibilityRequestInsurance, len(r.Insurance))
+		for i := range r.Insurance {
+			out.Insurance[i] = r.Insurance[i].DeepCopy()
+		}
+	}
+	if r.Item != nil {
+		out.Item = make([]CoverageEligibilityRequestItem, len(r.Item))
+		for i := range r.Item {
+			out.Item[i] = r.Item[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityRequest) Equal(other CoverageEligibilityRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.Equal(*other.Priority) {
+		return false
+	}
+	if len(r.Purpose) != len(other.Purpose) {
+		return false
+	}
+	for i := range r.Purpose {
+		if r.Purpose[i] != other.Purpose[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PurposeElement, other.PurposeElement, false) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && *r.ServicedDate != *other.ServicedDate {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.Equal(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.Equal(*other.ServicedPeriod) {
+		return false
+	}
+	if r.Created != other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.Equal(*other.Enterer) {
+		return false
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.Equal(*other.Provider) {
+		return false
+	}
+	if !r.Insurer.Equal(other.Insurer) {
+		return false
+	}
+	if (r.Facility == nil) != (other.Facility == nil) || r.Facility != nil && !r.Facility.Equal(*other.Facility) {
+		return false
+	}
+	if len(r.SupportingInfo) != len(other.SupportingInfo) {
+		return false
+	}
+	for i := range r.SupportingInfo {
+		if !r.SupportingInfo[i].Equal(other.SupportingInfo[i]) {
+			return false
+		}
+	}
+	if len(r.Insurance) != len(other.Insurance) {
+		return false
+	}
+	for i := range r.Insurance {
+		if !r.Insurance[i].Equal(other.Insurance[i]) {
+			return false
+		}
+	}
+	if len(r.Item) != len(other.Item) {
+		return false
+	}
+	for i := range r.Item {
+		if !r.Item[i].Equal(other.Item[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityRequest) EqualsDeep(other CoverageEligibilityRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.EqualsDeep(*other.Priority) {
+		return false
+	}
+	if !equivalentList(len(r.Purpose), len(other.Purpose), func(i, j int) bool {
+		return r.Purpose[i] == other.Purpose[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.PurposeElement, other.PurposeElement, true) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.EqualsDeep(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.EqualsDeep(*other.ServicedPeriod) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.EqualsDeep(*other.Enterer) {
+		return false
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.EqualsDeep(*other.Provider) {
+		return false
+	}
+	if !r.Insurer.EqualsDeep(other.Insurer) {
+		return false
+	}
+	if (r.Facility == nil) != (other.Facility == nil) || r.Facility != nil && !r.Facility.EqualsDeep(*other.Facility) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfo), len(other.SupportingInfo), func(i, j int) bool {
+		return r.SupportingInfo[i].EqualsDeep(other.SupportingInfo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Insurance), len(other.Insurance), func(i, j int) bool {
+		return r.Insurance[i].EqualsDeep(other.Insurance[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Item), len(other.Item), func(i, j int) bool {
+		return r.Item[i].EqualsDeep(other.Item[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityRequest) EqualsShallow(other CoverageEligibilityRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if !equivalentList(len(r.Purpose), len(other.Purpose), func(i, j int) bool {
+		return r.Purpose[i] == other.Purpose[j]
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityRequest as FHIR XML
+func (r CoverageEligibilityRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "CoverageEligibilityRequest",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "priority", r.Priority); err != nil {
+		return err
+	}
+	for i, v := range r.Purpose {
+		if err := encodeXMLPrimitive(e, "purpose", v, elementAt(r.PurposeElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "servicedDate", r.ServicedDate, r.ServicedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "servicedPeriod", r.ServicedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "enterer", r.Enterer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "provider", r.Provider); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "insurer", r.Insurer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "facility", r.Facility); err != nil {
+		return err
+	}
+	for _, v := range r.SupportingInfo {
+		if err := encodeXMLElement(e, "supportingInfo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Insurance {
+		if err := encodeXMLElement(e, "insurance", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Item {
+		if err := encodeXMLElement(e, "item", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityRequest from FHIR XML
+func (r *CoverageEligibilityRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "priority":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Priority = &v
+			case "purpose":
+				var v EligibilityRequestPurpose
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PurposeElement = appendElement(r.PurposeElement, len(r.Purpose), element)
+					r.Purpose = append(r.Purpose, v)
+				}
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "servicedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ServicedDate = &v
+				}
+				r.ServicedDateElement = element
+			case "servicedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServicedPeriod = &v
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = v
+				}
+				r.CreatedElement = element
+			case "enterer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Enterer = &v
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = &v
+			case "insurer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurer = v
+			case "facility":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Facility = &v
+			case "supportingInfo":
+				var v CoverageEligibilityRequestSupportingInfo
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			case "insurance":
+				var v CoverageEligibilityRequestInsurance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurance = append(r.Insurance, v)
+			case "item":
+				var v CoverageEligibilityRequestItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Item = append(r.Item, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityRequest as properties to the RDF node n
+func (r CoverageEligibilityRequest) turtle(n *turtleNode) {
+	n.resource("CoverageEligibilityRequest", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("CoverageEligibilityRequest.identifier", i, v)
+	}
+	n.primitive("CoverageEligibilityRequest.status", -1, r.Status, r.StatusElement, "code")
+	n.element("CoverageEligibilityRequest.priority", -1, r.Priority)
+	for i, v := range r.Purpose {
+		n.primitive("CoverageEligibilityRequest.purpose", i, v, elementAt(r.PurposeElement, i), "code")
+	}
+	n.element("CoverageEligibilityRequest.patient", -1, r.Patient)
+	n.primitive("CoverageEligibilityRequest.servicedDate", -1, r.ServicedDate, r.ServicedDateElement, "date")
+	n.element("CoverageEligibilityRequest.servicedPeriod", -1, r.ServicedPeriod)
+	n.primitive("CoverageEligibilityRequest.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("CoverageEligibilityRequest.enterer", -1, r.Enterer)
+	n.element("CoverageEligibilityRequest.provider", -1, r.Provider)
+	n.element("CoverageEligibilityRequest.insurer", -1, r.Insurer)
+	n.element("CoverageEligibilityRequest.facility", -1, r.Facility)
+	for i, v := range r.SupportingInfo {
+		n.element("CoverageEligibilityRequest.supportingInfo", i, v)
+	}
+	for i, v := range r.Insurance {
+		n.element("CoverageEligibilityRequest.insurance", i, v)
+	}
+	for i, v := range r.Item {
+		n.element("CoverageEligibilityRequest.item", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CoverageEligibilityRequest as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityRequest from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityRequest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityRequest as protobuf message CoverageEligibilityRequest of fhir.proto
+func (r CoverageEligibilityRequest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityRequest to the encoded message
+func (r CoverageEligibilityRequest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.Priority != nil {
+		e.message(11, *r.Priority)
+	}
+	if len(r.Purpose) > 0 {
+		var p protoEncoder
+		for _, v := range r.Purpose {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(12, p.buf)
+	}
+	e.message(13, r.Patient)
+	if r.ServicedDate != nil {
+		e.string(14, *r.ServicedDate)
+	}
+	if r.ServicedPeriod != nil {
+		e.message(15, *r.ServicedPeriod)
+	}
+	if r.Created != "" {
+		e.string(16, r.Created)
+	}
+	if r.Enterer != nil {
+		e.message(17, *r.Enterer)
+	}
+	if r.Provider != nil {
+		e.message(18, *r.Provider)
+	}
+	e.message(19, r.Insurer)
+	if r.Facility != nil {
+		e.message(20, *r.Facility)
+	}
+	for _, v := range r.SupportingInfo {
+		e.message(21, v)
+	}
+	for _, v := range r.Insurance {
+		e.message(22, v)
+	}
+	for _, v := range r.Item {
+		e.message(23, v)
+	}
+	if r.IdElement != nil {
+		e.message(24, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(25, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(26, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(27, *r.StatusElement)
+	}
+	for _, v := range r.PurposeElement {
+		e.element(28, v)
+	}
+	if r.ServicedDateElement != nil {
+		e.message(29, *r.ServicedDateElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(30, *r.CreatedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityRequest from protobuf message CoverageEligibilityRequest of fhir.proto
+func (r *CoverageEligibilityRequest) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityRequest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Priority = &v
+		case 12:
+			for _, n := range d.varints() {
+				var v EligibilityRequestPurpose
+				d.enum(n, v.setProtoNumber)
+				r.Purpose = append(r.Purpose, v)
+			}
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 14:
+			v := d.string()
+			r.ServicedDate = &v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.ServicedPeriod = &v
+		case 16:
+			r.Created = d.string()
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Enterer = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Provider = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Insurer = v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Facility = &v
+		case 21:
+			var v CoverageEligibilityRequestSupportingInfo
+			d.message(&v)
+			r.SupportingInfo = append(r.SupportingInfo, v)
+		case 22:
+			var v CoverageEligibilityRequestInsurance
+			d.message(&v)
+			r.Insurance = append(r.Insurance, v)
+		case 23:
+			var v CoverageEligibilityRequestItem
+			d.message(&v)
+			r.Item = append(r.Item, v)
+		case 24:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 28:
+			r.PurposeElement = append(r.PurposeElement, d.element())
+		case 29:
+			var v Element
+			d.message(&v)
+			r.ServicedDateElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageEligibilityRequestSupportingInfo as JSON into a byte slice
+func (r CoverageEligibilityRequestSupportingInfo) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityRequestSupportingInfo as JSON object with the members in the order of the fields
+func (r CoverageEligibilityRequestSupportingInfo) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	e.key("information")
+	r.Information.appendJSON(e)
+	if r.AppliesToAll != nil {
+		e.key("appliesToAll")
+		e.bool(*r.AppliesToAll)
+	}
+	if r.AppliesToAllElement != nil {
+		e.key("_appliesToAll")
+		r.AppliesToAllElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityRequestSupportingInfo from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityRequestSupportingInfo) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityRequestSupportingInfo
+func (r *CoverageEligibilityRequestSupportingInfo) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "information":
+			r.Information.decodeJSON(d)
+		case "appliesToAll":
+			if v, ok := d.bool(); ok {
+				r.AppliesToAll = &v
+			} else {
+				r.AppliesToAll = nil
+			}
+		case "_appliesToAll":
+			r.AppliesToAllElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityRequestSupportingInfo which shares no memory with the original
+func (r CoverageEligibilityRequestSupportingInfo) DeepCopy() CoverageEligibilityRequestSupportingInfo {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	out.Information = r.Information.DeepCopy()
+	if r.AppliesToAll != nil {
+		v := *r.AppliesToAll
+		out.AppliesToAll = &v
+	}
+	if r.AppliesToAllElement != nil {
+		v := r.AppliesToAllElement.DeepCopy()
+		out.AppliesToAllElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityRequestSupportingInfo) Equal(other CoverageEligibilityRequestSupportingInfo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if !r.Information.Equal(other.Information) {
+		return false
+	}
+	if (r.AppliesToAll == nil) != (other.AppliesToAll == nil) || r.AppliesToAll != nil && *r.AppliesToAll != *other.AppliesToAll {
+		return false
+	}
+	if (r.AppliesToAllElement == nil) != (other.AppliesToAllElement == nil) || r.AppliesToAllElement != nil && !r.AppliesToAllElement.Equal(*other.AppliesToAllElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityRequestSupportingInfo) EqualsDeep(other CoverageEligibilityRequestSupportingInfo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if !r.Information.EqualsDeep(other.Information) {
+		return false
+	}
+	if (r.AppliesToAll == nil) != (other.AppliesToAll == nil) || r.AppliesToAll != nil && *r.AppliesToAll != *other.AppliesToAll {
+		return false
+	}
+	if (r.AppliesToAllElement == nil) != (other.AppliesToAllElement == nil) || r.AppliesToAllElement != nil && !r.AppliesToAllElement.EqualsDeep(*other.AppliesToAllElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityRequestSupportingInfo) EqualsShallow(other CoverageEligibilityRequestSupportingInfo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.AppliesToAll == nil) != (other.AppliesToAll == nil) || r.AppliesToAll != nil && *r.AppliesToAll != *other.AppliesToAll {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityRequestSupportingInfo as FHIR XML
+func (r CoverageEligibilityRequestSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "information", r.Information); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "appliesToAll", r.AppliesToAll, r.AppliesToAllElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityRequestSupportingInfo from FHIR XML
+func (r *CoverageEligibilityRequestSupportingInfo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "information":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Information = v
+			case "appliesToAll":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AppliesToAll = &v
+				}
+				r.AppliesToAllElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityRequestSupportingInfo as properties to the RDF node n
+func (r CoverageEligibilityRequestSupportingInfo) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CoverageEligibilityRequest.supportingInfo.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("CoverageEligibilityRequest.supportingInfo.information", -1, r.Information)
+	n.primitive("CoverageEligibilityRequest.supportingInfo.appliesToAll", -1, r.AppliesToAll, r.AppliesToAllElement, "boolean")
+}
+
+// MarshalBSON marshals the given CoverageEligibilityRequestSupportingInfo as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityRequestSupportingInfo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityRequestSupportingInfo from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityRequestSupportingInfo) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityRequestSupportingInfo as protobuf message CoverageEligibilityRequestSupportingInfo of fhir.proto
+func (r CoverageEligibilityRequestSupportingInfo) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityRequestSupportingInfo to the encoded message
+func (r CoverageEligibilityRequestSupportingInfo) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	e.message(5, r.Information)
+	if r.AppliesToAll != nil {
+		e.varint(6, protoBool(*r.AppliesToAll))
+	}
+	if r.SequenceElement != nil {
+		e.message(7, *r.SequenceElement)
+	}
+	if r.AppliesToAllElement != nil {
+		e.message(8, *r.AppliesToAllElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityRequestSupportingInfo from protobuf message CoverageEligibilityRequestSupportingInfo of fhir.proto
+func (r *CoverageEligibilityRequestSupportingInfo) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityRequestSupportingInfo{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Information = v
+		case 6:
+			v := d.bool()
+			r.AppliesToAll = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.AppliesToAllElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageEligibilityRequestInsurance as JSON into a byte slice
+func (r CoverageEligibilityRequestInsurance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityRequestInsurance as JSON object with the members in the order of the fields
+func (r CoverageEligibilityRequestInsurance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Focal != nil {
+		e.key("focal")
+		e.bool(*r.Focal)
+	}
+	if r.FocalElement != nil {
+		e.key("_focal")
+		r.FocalElement.appendJSON(e)
+	}
+	e.key("coverage")
+	r.Coverage.appendJSON(e)
+	if r.BusinessArrangement != nil {
+		e.key("businessArrangement")
+		e.string(*r.BusinessArrangement)
+	}
+	if r.BusinessArrangementElement != nil {
+		e.key("_businessArrangement")
+		r.BusinessArrangementElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityRequestInsurance from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityRequestInsurance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityRequestInsurance
+func (r *CoverageEligibilityRequestInsurance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "focal":
+			if v, ok := d.bool(); ok {
+				r.Focal = &v
+			} else {
+				r.Focal = nil
+			}
+		case "_focal":
+			r.FocalElement = d.element()
+		case "coverage":
+			r.Coverage.decodeJSON(d)
+		case "businessArrangement":
+			if v, ok := d.string(); ok {
+				r.BusinessArrangement = &v
+			} else {
+				r.BusinessArrangement = nil
+			}
+		case "_businessArrangement":
+			r.BusinessArrangementElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityRequestInsurance which shares no memory with the original
+func (r CoverageEligibilityRequestInsurance) DeepCopy() CoverageEligibilityRequestInsurance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Focal != nil {
+		v := *r.Focal
+		out.Focal = &v
+	}
+	if r.FocalElement != nil {
+		v := r.FocalElement.DeepCopy()
+		out.FocalElement = &v
+	}
+	out.Coverage = r.Coverage.DeepCopy()
+	if r.BusinessArrangement != nil {
+		v := *r.BusinessArrangement
+		out.BusinessArrangement = &v
+	}
+	if r.BusinessArrangementElement != nil {
+		v := r.BusinessArrangementElement.DeepCopy()
+		out.BusinessArrangementElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityRequestInsurance) Equal(other CoverageEligibilityRequestInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Focal == nil) != (other.Focal == nil) || r.Focal != nil && *r.Focal != *other.Focal {
+		return false
+	}
+	if (r.FocalElement == nil) != (other.FocalElement == nil) || r.FocalElement != nil && !r.FocalElement.Equal(*other.FocalElement) {
+		return false
+	}
+	if !r.Coverage.Equal(other.Coverage) {
+		return false
+	}
+	if (r.BusinessArrangement == nil) != (other.BusinessArrangement == nil) || r.BusinessArrangement != nil && *r.BusinessArrangement != *other.BusinessArrangement {
+		return false
+	}
+	if (r.BusinessArrangementElement == nil) != (other.BusinessArrangementElement == nil) || r.BusinessArrangementElement != nil && !r.BusinessArrangementElement.Equal(*other.BusinessArrangementElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityRequestInsurance) EqualsDeep(other CoverageEligibilityRequestInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Focal == nil) != (other.Focal == nil) || r.Focal != nil && *r.Focal != *other.Focal {
+		return false
+	}
+	if (r.FocalElement == nil) != (other.FocalElement == nil) || r.FocalElement != nil && !r.FocalElement.EqualsDeep(*other.FocalElement) {
+		return false
+	}
+	if !r.Coverage.EqualsDeep(other.Coverage) {
+		return false
+	}
+	if (r.BusinessArrangement == nil) != (other.BusinessArrangement == nil) || r.BusinessArrangement != nil && !equivalentString(*r.BusinessArrangement, *other.BusinessArrangement) {
+		return false
+	}
+	if (r.BusinessArrangementElement == nil) != (other.BusinessArrangementElement == nil) || r.BusinessArrangementElement != nil && !r.BusinessArrangementElement.EqualsDeep(*other.BusinessArrangementElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityRequestInsurance) EqualsShallow(other CoverageEligibilityRequestInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Focal == nil) != (other.Focal == nil) || r.Focal != nil && *r.Focal != *other.Focal {
+		return false
+	}
+	if (r.BusinessArrangement == nil) != (other.BusinessArrangement == nil) || r.BusinessArrangement != nil && !equivalentString(*r.BusinessArrangement, *other.BusinessArrangement) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityRequestInsurance as FHIR XML
+func (r CoverageEligibilityRequestInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "focal", r.Focal, r.FocalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "coverage", r.Coverage); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "businessArrangement", r.BusinessArrangement, r.BusinessArrangementElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityRequestInsurance from FHIR XML
+func (r *CoverageEligibilityRequestInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "focal":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Focal = &v
+				}
+				r.FocalElement = element
+			case "coverage":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coverage = v
+			case "businessArrangement":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BusinessArrangement = &v
+				}
+				r.BusinessArrangementElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityRequestInsurance as properties to the RDF node n
+func (r CoverageEligibilityRequestInsurance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("CoverageEligibilityRequest.insurance.focal", -1, r.Focal, r.FocalElement, "boolean")
+	n.element("CoverageEligibilityRequest.insurance.coverage", -1, r.Coverage)
+	n.primitive("CoverageEligibilityRequest.insurance.businessArrangement", -1, r.BusinessArrangement, r.BusinessArrangementElement, "string")
+}
+
+// MarshalBSON marshals the given CoverageEligibilityRequestInsurance as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityRequestInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityRequestInsurance from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityRequestInsurance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityRequestInsurance as protobuf message CoverageEligibilityRequestInsurance of fhir.proto
+func (r CoverageEligibilityRequestInsurance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityRequestInsurance to the encoded message
+func (r CoverageEligibilityRequestInsurance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Focal != nil {
+		e.varint(4, protoBool(*r.Focal))
+	}
+	e.message(5, r.Coverage)
+	if r.BusinessArrangement != nil {
+		e.string(6, *r.BusinessArrangement)
+	}
+	if r.FocalElement != nil {
+		e.message(7, *r.FocalElement)
+	}
+	if r.BusinessArrangementElement != nil {
+		e.message(8, *r.BusinessArrangementElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityRequestInsurance from protobuf message CoverageEligibilityRequestInsurance of fhir.proto
+func (r *CoverageEligibilityRequestInsurance) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityRequestInsurance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.bool()
+			r.Focal = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Coverage = v
+		case 6:
+			v := d.string()
+			r.BusinessArrangement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.FocalElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.BusinessArrangementElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageEligibilityRequestItem as JSON into a byte slice
+func (r CoverageEligibilityRequestItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityRequestItem as JSON object with the members in the order of the fields
+func (r CoverageEligibilityRequestItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInfoSequence) > 0 {
+		e.key("supportingInfoSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInfoSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInfoSequenceElement) > 0 {
+		e.key("_supportingInfoSequence")
+		e.elements(r.SupportingInfoSequenceElement, len(r.SupportingInfoSequence))
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	if r.ProductOrService != nil {
+		e.key("productOrService")
+		r.ProductOrService.appendJSON(e)
+	}
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Provider != nil {
+		e.key("provider")
+		r.Provider.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Facility != nil {
+		e.key("facility")
+		r.Facility.appendJSON(e)
+	}
+	if len(r.Diagnosis) > 0 {
+		e.key("diagnosis")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Diagnosis {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityRequestItem from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityRequestItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityRequestItem
+func (r *CoverageEligibilityRequestItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "supportingInfoSequence":
+			a := d.array()
+			r.SupportingInfoSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.SupportingInfoSequence = append(r.SupportingInfoSequence, v)
+			}
+			if r.SupportingInfoSequence == nil && !a.isNull {
+				r.SupportingInfoSequence = []int{}
+			}
+		case "_supportingInfoSequence":
+			a := d.array()
+			r.SupportingInfoSequenceElement = nil
+			for a.next() {
+				r.SupportingInfoSequenceElement = append(r.SupportingInfoSequenceElement, d.element())
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "productOrService":
+			if d.null() {
+				r.ProductOrService = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProductOrService = &v
+			}
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "provider":
+			if d.null() {
+				r.Provider = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Provider = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "facility":
+			if d.null() {
+				r.Facility = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Facility = &v
+			}
+		case "diagnosis":
+			a := d.array()
+			r.Diagnosis = nil
+			for a.next() {
+				var v CoverageEligibilityRequestItemDiagnosis
+				v.decodeJSON(d)
+				r.Diagnosis = append(r.Diagnosis, v)
+			}
+			if r.Diagnosis == nil && !a.isNull {
+				r.Diagnosis = []CoverageEligibilityRequestItemDiagnosis{}
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityRequestItem which shares no memory with the original
+func (r CoverageEligibilityRequestItem) DeepCopy() CoverageEligibilityRequestItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SupportingInfoSequence != nil {
+		out.SupportingInfoSequence = make([]int, len(r.SupportingInfoSequence))
+		copy(out.SupportingInfoSequence, r.SupportingInfoSequence)
+	}
+	if r.SupportingInfoSequenceElement != nil {
+		out.SupportingInfoSequenceElement = make([]*Element, len(r.SupportingInfoSequenceElement))
+		for i, v := range r.SupportingInfoSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SupportingInfoSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	if r.ProductOrService != nil {
+		v := r.ProductOrService.DeepCopy()
+		out.ProductOrService = &v
+	}
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.Provider != nil {
+		v := r.Provider.DeepCopy()
+		out.Provider = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Facility != nil {
+		v := r.Facility.DeepCopy()
+		out.Facility = &v
+	}
+	if r.Diagnosis != nil {
+		out.Diagnosis = make([]CoverageEligibilityRequestItemDiagnosis, len(r.Diagnosis))
+		for i := range r.Diagnosis {
+			out.Diagnosis[i] = r.Diagnosis[i].DeepCopy()
+		}
+	}
+	if r.Detail != nil {
+		out.Detail = make([]Reference, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityRequestItem) Equal(other CoverageEligibilityRequestItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingInfoSequence) != len(other.SupportingInfoSequence) {
+		return false
+	}
+	for i := range r.SupportingInfoSequence {
+		if r.SupportingInfoSequence[i] != other.SupportingInfoSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SupportingInfoSequenceElement, other.SupportingInfoSequenceElement, false) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if (r.ProductOrService == nil) != (other.ProductOrService == nil) || r.ProductOrService != nil && !r.ProductOrService.Equal(*other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.Equal(*other.Provider) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Facility == nil) != (other.Facility == nil) || r.Facility != nil && !r.Facility.Equal(*other.Facility) {
+		return false
+	}
+	if len(r.Diagnosis) != len(other.Diagnosis) {
+		return false
+	}
+	for i := range r.Diagnosis {
+		if !r.Diagnosis[i].Equal(other.Diagnosis[i]) {
+			return false
+		}
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityRequestItem) EqualsDeep(other CoverageEligibilityRequestItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfoSequence), len(other.SupportingInfoSequence), func(i, j int) bool {
+		return r.SupportingInfoSequence[i] == other.SupportingInfoSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.SupportingInfoSequenceElement, other.SupportingInfoSequenceElement, true) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if (r.ProductOrService == nil) != (other.ProductOrService == nil) || r.ProductOrService != nil && !r.ProductOrService.EqualsDeep(*other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.EqualsDeep(*other.Provider) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Facility == nil) != (other.Facility == nil) || r.Facility != nil && !r.Facility.EqualsDeep(*other.Facility) {
+		return false
+	}
+	if !equivalentList(len(r.Diagnosis), len(other.Diagnosis), func(i, j int) bool {
+		return r.Diagnosis[i].EqualsDeep(other.Diagnosis[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityRequestItem) EqualsShallow(other CoverageEligibilityRequestItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfoSequence), len(other.SupportingInfoSequence), func(i, j int) bool {
+		return r.SupportingInfoSequence[i] == other.SupportingInfoSequence[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityRequestItem as FHIR XML
+func (r CoverageEligibilityRequestItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.SupportingInfoSequence {
+		if err := encodeXMLPrimitive(e, "supportingInfoSequence", v, elementAt(r.SupportingInfoSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "provider", r.Provider); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "facility", r.Facility); err != nil {
+		return err
+	}
+	for _, v := range r.Diagnosis {
+		if err := encodeXMLElement(e, "diagnosis", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityRequestItem from FHIR XML
+func (r *CoverageEligibilityRequestItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "supportingInfoSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SupportingInfoSequenceElement = appendElement(r.SupportingInfoSequenceElement, len(r.SupportingInfoSequence), element)
+					r.SupportingInfoSequence = append(r.SupportingInfoSequence, v)
+				}
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = &v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "facility":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Facility = &v
+			case "diagnosis":
+				var v CoverageEligibilityRequestItemDiagnosis
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Diagnosis = append(r.Diagnosis, v)
+			case "detail":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityRequestItem as properties to the RDF node n
+func (r CoverageEligibilityRequestItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.SupportingInfoSequence {
+		n.primitive("CoverageEligibilityRequest.item.supportingInfoSequence", i, v, elementAt(r.SupportingInfoSequenceElement, i), "positiveInt")
+	}
+	n.element("CoverageEligibilityRequest.item.category", -1, r.Category)
+	n.element("CoverageEligibilityRequest.item.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("CoverageEligibilityRequest.item.modifier", i, v)
+	}
+	n.element("CoverageEligibilityRequest.item.provider", -1, r.Provider)
+	n.element("CoverageEligibilityRequest.item.quantity", -1, r.Quantity)
+	n.element("CoverageEligibilityRequest.item.unitPrice", -1, r.UnitPrice)
+	n.element("CoverageEligibilityRequest.item.facility", -1, r.Facility)
+	for i, v := range r.Diagnosis {
+		n.element("CoverageEligibilityRequest.item.diagnosis", i, v)
+	}
+	for i, v := range r.Detail {
+		n.element("CoverageEligibilityRequest.item.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CoverageEligibilityRequestItem as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityRequestItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityRequestItem from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityRequestItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityRequestItem as protobuf message CoverageEligibilityRequestItem of fhir.proto
+func (r CoverageEligibilityRequestItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityRequestItem to the encoded message
+func (r CoverageEligibilityRequestItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if len(r.SupportingInfoSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.SupportingInfoSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(4, p.buf)
+	}
+	if r.Category != nil {
+		e.message(5, *r.Category)
+	}
+	if r.ProductOrService != nil {
+		e.message(6, *r.ProductOrService)
+	}
+	for _, v := range r.Modifier {
+		e.message(7, v)
+	}
+	if r.Provider != nil {
+		e.message(8, *r.Provider)
+	}
+	if r.Quantity != nil {
+		e.message(9, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(10, *r.UnitPrice)
+	}
+	if r.Facility != nil {
+		e.message(11, *r.Facility)
+	}
+	for _, v := range r.Diagnosis {
+		e.message(12, v)
+	}
+	for _, v := range r.Detail {
+		e.message(13, v)
+	}
+	for _, v := range r.SupportingInfoSequenceElement {
+		e.element(14, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityRequestItem from protobuf message CoverageEligibilityRequestItem of fhir.proto
+func (r *CoverageEligibilityRequestItem) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityRequestItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			for _, v := range d.varints() {
+				r.SupportingInfoSequence = append(r.SupportingInfoSequence, int(int32(v)))
+			}
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.Provider = &v
+		case 9:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 10:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Facility = &v
+		case 12:
+			var v CoverageEligibilityRequestItemDiagnosis
+			d.message(&v)
+			r.Diagnosis = append(r.Diagnosis, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		case 14:
+			r.SupportingInfoSequenceElement = append(r.SupportingInfoSequenceElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageEligibilityRequestItemDiagnosis as JSON into a byte slice
+func (r CoverageEligibilityRequestItemDiagnosis) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityRequestItemDiagnosis as JSON object with the members in the order of the fields
+func (r CoverageEligibilityRequestItemDiagnosis) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DiagnosisCodeableConcept != nil {
+		e.key("diagnosisCodeableConcept")
+		r.DiagnosisCodeableConcept.appendJSON(e)
+	}
+	if r.DiagnosisReference != nil {
+		e.key("diagnosisReference")
+		r.DiagnosisReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityRequestItemDiagnosis from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityRequestItemDiagnosis) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityRequestItemDiagnosis
+func (r *CoverageEligibilityRequestItemDiagnosis) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "diagnosisCodeableConcept":
+			if d.null() {
+				r.DiagnosisCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DiagnosisCodeableConcept = &v
+			}
+		case "diagnosisReference":
+			if d.null() {
+				r.DiagnosisReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.DiagnosisReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityRequestItemDiagnosis which shares no memory with the original
+func (r CoverageEligibilityRequestItemDiagnosis) DeepCopy() CoverageEligibilityRequestItemDiagnosis {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DiagnosisCodeableConcept != nil {
+		v := r.DiagnosisCodeableConcept.DeepCopy()
+		out.DiagnosisCodeableConcept = &v
+	}
+	if r.DiagnosisReference != nil {
+		v := r.DiagnosisReference.DeepCopy()
+		out.DiagnosisReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityRequestItemDiagnosis) Equal(other CoverageEligibilityRequestItemDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.DiagnosisCodeableConcept == nil) != (other.DiagnosisCodeableConcept == nil) || r.DiagnosisCodeableConcept != nil && !r.DiagnosisCodeableConcept.Equal(*other.DiagnosisCodeableConcept) {
+		return false
+	}
+	if (r.DiagnosisReference == nil) != (other.DiagnosisReference == nil) || r.DiagnosisReference != nil && !r.DiagnosisReference.Equal(*other.DiagnosisReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityRequestItemDiagnosis) EqualsDeep(other CoverageEligibilityRequestItemDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.DiagnosisCodeableConcept == nil) != (other.DiagnosisCodeableConcept == nil) || r.DiagnosisCodeableConcept != nil && !r.DiagnosisCodeableConcept.EqualsDeep(*other.DiagnosisCodeableConcept) {
+		return false
+	}
+	if (r.DiagnosisReference == nil) != (other.DiagnosisReference == nil) || r.DiagnosisReference != nil && !r.DiagnosisReference.EqualsDeep(*other.DiagnosisReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityRequestItemDiagnosis) EqualsShallow(other CoverageEligibilityRequestItemDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityRequestItemDiagnosis as FHIR XML
+func (r CoverageEligibilityRequestItemDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "diagnosisCodeableConcept", r.DiagnosisCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "diagnosisReference", r.DiagnosisReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityRequestItemDiagnosis from FHIR XML
+func (r *CoverageEligibilityRequestItemDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "diagnosisCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DiagnosisCodeableConcept = &v
+			case "diagnosisReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DiagnosisReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityRequestItemDiagnosis as properties to the RDF node n
+func (r CoverageEligibilityRequestItemDiagnosis) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("CoverageEligibilityRequest.item.diagnosis.diagnosisCodeableConcept", -1, r.DiagnosisCodeableConcept)
+	n.element("CoverageEligibilityRequest.item.diagnosis.diagnosisReference", -1, r.DiagnosisReference)
+}
+
+// MarshalBSON marshals the given CoverageEligibilityRequestItemDiagnosis as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityRequestItemDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityRequestItemDiagnosis from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityRequestItemDiagnosis) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityRequestItemDiagnosis as protobuf message CoverageEligibilityRequestItemDiagnosis of fhir.proto
+func (r CoverageEligibilityRequestItemDiagnosis) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityRequestItemDiagnosis to the encoded message
+func (r CoverageEligibilityRequestItemDiagnosis) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.DiagnosisCodeableConcept != nil {
+		e.message(4, *r.DiagnosisCodeableConcept)
+	}
+	if r.DiagnosisReference != nil {
+		e.message(5, *r.DiagnosisReference)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityRequestItemDiagnosis from protobuf message CoverageEligibilityRequestItemDiagnosis of fhir.proto
+func (r *CoverageEligibilityRequestItemDiagnosis) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityRequestItemDiagnosis{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.DiagnosisCodeableConcept = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.DiagnosisReference = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCoverageEligibilityRequest unmarshals a CoverageEligibilityRequest.
 func UnmarshalCoverageEligibilityRequest(b []byte) (CoverageEligibilityRequest, error) {
 	var coverageEligibilityRequest CoverageEligibilityRequest
-	if err := json.Unmarshal(b, &coverageEligibilityRequest); err != nil {
+	if err := coverageEligibilityRequest.UnmarshalJSON(b); err != nil {
 		return coverageEligibilityRequest, err
 	}
 	return coverageEligibilityRequest, nil
@@ -14,100 +14,4136 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // CoverageEligibilityResponse is documented here http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse
 type CoverageEligibilityResponse struct {
-	Id                *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes           `bson:"status" json:"status"`
-	Purpose           []EligibilityResponsePurpose           `bson:"purpose" json:"purpose"`
-	Patient           Reference                              `bson:"patient" json:"patient"`
-	ServicedDate      *string                                `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
-	ServicedPeriod    *Period                                `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
-	Created           string                                 `bson:"created" json:"created"`
-	Requestor         *Reference                             `bson:"requestor,omitempty" json:"requestor,omitempty"`
-	Request           Reference                              `bson:"request" json:"request"`
-	Outcome           ClaimProcessingCodes                   `bson:"outcome" json:"outcome"`
-	Disposition       *string                                `bson:"disposition,omitempty" json:"disposition,omitempty"`
-	Insurer           Reference                              `bson:"insurer" json:"insurer"`
-	Insurance         []CoverageEligibilityResponseInsurance `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	PreAuthRef        *string                                `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
-	Form              *CodeableConcept                       `bson:"form,omitempty" json:"form,omitempty"`
-	Error             []CoverageEligibilityResponseError     `bson:"error,omitempty" json:"error,omitempty"`
+	Id                   *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                               `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage                      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                           `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes           `bson:"status" json:"status"`
+	StatusElement        *Element                               `bson:"_status,omitempty" json:"_status,omitempty"`
+	Purpose              []EligibilityResponsePurpose           `bson:"purpose" json:"purpose"`
+	PurposeElement       []*Element                             `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Patient              Reference                              `bson:"patient" json:"patient"`
+	ServicedDate         *string                                `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement  *Element                               `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod       *Period                                `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	Created              string                                 `bson:"created" json:"created"`
+	CreatedElement       *Element                               `bson:"_created,omitempty" json:"_created,omitempty"`
+	Requestor            *Reference                             `bson:"requestor,omitempty" json:"requestor,omitempty"`
+	Request              Reference                              `bson:"request" json:"request"`
+	Outcome              ClaimProcessingCodes                   `bson:"outcome" json:"outcome"`
+	OutcomeElement       *Element                               `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
+	Disposition          *string                                `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	DispositionElement   *Element                               `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
+	Insurer              Reference                              `bson:"insurer" json:"insurer"`
+	Insurance            []CoverageEligibilityResponseInsurance `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	PreAuthRef           *string                                `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement    *Element                               `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
+	Form                 *CodeableConcept                       `bson:"form,omitempty" json:"form,omitempty"`
+	Error                []CoverageEligibilityResponseError     `bson:"error,omitempty" json:"error,omitempty"`
 }
+
+// CoverageEligibilityResponseServiced is implemented by the types allowed for CoverageEligibilityResponse.serviced[x]
+type CoverageEligibilityResponseServiced interface {
+	isCoverageEligibilityResponseServiced()
+}
+
+func (Date) isCoverageEligibilityResponseServiced()   {}
+func (Period) isCoverageEligibilityResponseServiced() {}
+
+// Serviced returns the value of CoverageEligibilityResponse.serviced[x] together with its FHIR type code or nil if no value is set
+func (r CoverageEligibilityResponse) Serviced() (CoverageEligibilityResponseServiced, string) {
+	if r.ServicedDate != nil {
+		return Date(*r.ServicedDate), "date"
+	}
+	if r.ServicedPeriod != nil {
+		return *r.ServicedPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetServiced sets the value of CoverageEligibilityResponse.serviced[x], given as value or pointer, and clears all other types
+func (r *CoverageEligibilityResponse) SetServiced(value CoverageEligibilityResponseServiced) {
+	r.ServicedDate = nil
+	r.ServicedPeriod = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.ServicedDate = &primitive
+	case *Date:
+		r.ServicedDate = (*string)(v)
+	case Period:
+		r.ServicedPeriod = &v
+	case *Period:
+		r.ServicedPeriod = v
+	}
+}
+
 type CoverageEligibilityResponseInsurance struct {
 	Id                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Coverage          Reference                                  `bson:"coverage" json:"coverage"`
 	Inforce           *bool                                      `bson:"inforce,omitempty" json:"inforce,omitempty"`
+	InforceElement    *Element                                   `bson:"_inforce,omitempty" json:"_inforce,omitempty"`
 	BenefitPeriod     *Period                                    `bson:"benefitPeriod,omitempty" json:"benefitPeriod,omitempty"`
 	Item              []CoverageEligibilityResponseInsuranceItem `bson:"item,omitempty" json:"item,omitempty"`
 }
 type CoverageEligibilityResponseInsuranceItem struct {
-	Id                      *string                                           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension                                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category                *CodeableConcept                                  `bson:"category,omitempty" json:"category,omitempty"`
-	ProductOrService        *CodeableConcept                                  `bson:"productOrService,omitempty" json:"productOrService,omitempty"`
-	Modifier                []CodeableConcept                                 `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	Provider                *Reference                                        `bson:"provider,omitempty" json:"provider,omitempty"`
-	Excluded                *bool                                             `bson:"excluded,omitempty" json:"excluded,omitempty"`
-	Name                    *string                                           `bson:"name,omitempty" json:"name,omitempty"`
-	Description             *string                                           `bson:"description,omitempty" json:"description,omitempty"`
-	Network                 *CodeableConcept                                  `bson:"network,omitempty" json:"network,omitempty"`
-	Unit                    *CodeableConcept                                  `bson:"unit,omitempty" json:"unit,omitempty"`
-	Term                    *CodeableConcept                                  `bson:"term,omitempty" json:"term,omitempty"`
-	Benefit                 []CoverageEligibilityResponseInsuranceItemBenefit `bson:"benefit,omitempty" json:"benefit,omitempty"`
-	AuthorizationRequired   *bool                                             `bson:"authorizationRequired,omitempty" json:"authorizationRequired,omitempty"`
-	AuthorizationSupporting []CodeableConcept                                 `bson:"authorizationSupporting,omitempty" json:"authorizationSupporting,omitempty"`
-	AuthorizationUrl        *string                                           `bson:"authorizationUrl,omitempty" json:"authorizationUrl,omitempty"`
+	Id                           *string                                           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension                                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category                     *CodeableConcept                                  `bson:"category,omitempty" json:"category,omitempty"`
+	ProductOrService             *CodeableConcept                                  `bson:"productOrService,omitempty" json:"productOrService,omitempty"`
+	Modifier                     []CodeableConcept                                 `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	Provider                     *Reference                                        `bson:"provider,omitempty" json:"provider,omitempty"`
+	Excluded                     *bool                                             `bson:"excluded,omitempty" json:"excluded,omitempty"`
+	ExcludedElement              *Element                                          `bson:"_excluded,omitempty" json:"_excluded,omitempty"`
+	Name                         *string                                           `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                  *Element                                          `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description                  *string                                           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element                                          `bson:"_description,omitempty" json:"_description,omitempty"`
+	Network                      *CodeableConcept                                  `bson:"network,omitempty" json:"network,omitempty"`
+	Unit                         *CodeableConcept                                  `bson:"unit,omitempty" json:"unit,omitempty"`
+	Term                         *CodeableConcept                                  `bson:"term,omitempty" json:"term,omitempty"`
+	Benefit                      []CoverageEligibilityResponseInsuranceItemBenefit `bson:"benefit,omitempty" json:"benefit,omitempty"`
+	AuthorizationRequired        *bool                                             `bson:"authorizationRequired,omitempty" json:"authorizationRequired,omitempty"`
+	AuthorizationRequiredElement *Element                                          `bson:"_authorizationRequired,omitempty" json:"_authorizationRequired,omitempty"`
+	AuthorizationSupporting      []CodeableConcept                                 `bson:"authorizationSupporting,omitempty" json:"authorizationSupporting,omitempty"`
+	AuthorizationUrl             *string                                           `bson:"authorizationUrl,omitempty" json:"authorizationUrl,omitempty"`
+	AuthorizationUrlElement      *Element                                          `bson:"_authorizationUrl,omitempty" json:"_authorizationUrl,omitempty"`
 }
 type CoverageEligibilityResponseInsuranceItemBenefit struct {
-	Id                 *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type               CodeableConcept `bson:"type" json:"type"`
-	AllowedUnsignedInt *int            `bson:"allowedUnsignedInt,omitempty" json:"allowedUnsignedInt,omitempty"`
-	AllowedString      *string         `bson:"allowedString,omitempty" json:"allowedString,omitempty"`
-	AllowedMoney       *Money          `bson:"allowedMoney,omitempty" json:"allowedMoney,omitempty"`
-	UsedUnsignedInt    *int            `bson:"usedUnsignedInt,omitempty" json:"usedUnsignedInt,omitempty"`
-	UsedString         *string         `bson:"usedString,omitempty" json:"usedString,omitempty"`
-	UsedMoney          *Money          `bson:"usedMoney,omitempty" json:"usedMoney,omitempty"`
+	Id                        *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                      CodeableConcept `bson:"type" json:"type"`
+	AllowedUnsignedInt        *int            `bson:"allowedUnsignedInt,omitempty" json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *Element        `bson:"_allowedUnsignedInt,omitempty" json:"_allowedUnsignedInt,omitempty"`
+	AllowedString             *string         `bson:"allowedString,omitempty" json:"allowedString,omitempty"`
+	AllowedStringElement      *Element        `bson:"_allowedString,omitempty" json:"_allowedString,omitempty"`
+	AllowedMoney              *Money          `bson:"allowedMoney,omitempty" json:"allowedMoney,omitempty"`
+	UsedUnsignedInt           *int            `bson:"usedUnsignedInt,omitempty" json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement    *Element        `bson:"_usedUnsignedInt,omitempty" json:"_usedUnsignedInt,omitempty"`
+	UsedString                *string         `bson:"usedString,omitempty" json:"usedString,omitempty"`
+	UsedStringElement         *Element        `bson:"_usedString,omitempty" json:"_usedString,omitempty"`
+	UsedMoney                 *Money          `bson:"usedMoney,omitempty" json:"usedMoney,omitempty"`
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitAllowed is implemented by the types allowed for CoverageEligibilityResponse.insurance.item.benefit.allowed[x]
+type CoverageEligibilityResponseInsuranceItemBenefitAllowed interface {
+	isCoverageEligibilityResponseInsuranceItemBenefitAllowed()
+}
+
+func (UnsignedInt) isCoverageEligibilityResponseInsuranceItemBenefitAllowed() {}
+func (String) isCoverageEligibilityResponseInsuranceItemBenefitAllowed()      {}
+func (Money) isCoverageEligibilityResponseInsuranceItemBenefitAllowed()       {}
+
+// Allowed returns the value of CoverageEligibilityResponse.insurance.item.benefit.allowed[x] together with its FHIR type code or nil if no value is set
+func (r CoverageEligibilityResponseInsuranceItemBenefit) Allowed() (CoverageEligibilityResponseInsuranceItemBenefitAllowed, string) {
+	if r.AllowedUnsignedInt != nil {
+		return UnsignedInt(*r.AllowedUnsignedInt), "unsignedInt"
+	}
+	if r.AllowedString != nil {
+		return String(*r.AllowedString), "string"
+	}
+	if r.AllowedMoney != nil {
+		return *r.AllowedMoney, "Money"
+	}
+	return nil, ""
+}
+
+// SetAllowed sets the value of CoverageEligibilityResponse.insurance.item.benefit.allowed[x], given as value or pointer, and clears all other types
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) SetAllowed(value CoverageEligibilityResponseInsuranceItemBenefitAllowed) {
+	r.AllowedUnsignedInt = nil
+	r.AllowedString = nil
+	r.AllowedMoney = nil
+	switch v := value.(type) {
+	case UnsignedInt:
+		primitive := int(v)
+		r.AllowedUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.AllowedUnsignedInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.AllowedString = &primitive
+	case *String:
+		r.AllowedString = (*string)(v)
+	case Money:
+		r.AllowedMoney = &v
+	case *Money:
+		r.AllowedMoney = v
+	}
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitUsed is implemented by the types allowed for CoverageEligibilityResponse.insurance.item.benefit.used[x]
+type CoverageEligibilityResponseInsuranceItemBenefitUsed interface {
+	isCoverageEligibilityResponseInsuranceItemBenefitUsed()
+}
+
+func (UnsignedInt) isCoverageEligibilityResponseInsuranceItemBenefitUsed() {}
+func (String) isCoverageEligibilityResponseInsuranceItemBenefitUsed()      {}
+func (Money) isCoverageEligibilityResponseInsuranceItemBenefitUsed()       {}
+
+// Used returns the value of CoverageEligibilityResponse.insurance.item.benefit.used[x] together with its FHIR type code or nil if no value is set
+func (r CoverageEligibilityResponseInsuranceItemBenefit) Used() (CoverageEligibilityResponseInsuranceItemBenefitUsed, string) {
+	if r.UsedUnsignedInt != nil {
+		return UnsignedInt(*r.UsedUnsignedInt), "unsignedInt"
+	}
+	if r.UsedString != nil {
+		return String(*r.UsedString), "string"
+	}
+	if r.UsedMoney != nil {
+		return *r.UsedMoney, "Money"
+	}
+	return nil, ""
+}
+
+// SetUsed sets the value of CoverageEligibilityResponse.insurance.item.benefit.used[x], given as value or pointer, and clears all other types
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) SetUsed(value CoverageEligibilityResponseInsuranceItemBenefitUsed) {
+	r.UsedUnsignedInt = nil
+	r.UsedString = nil
+	r.UsedMoney = nil
+	switch v := value.(type) {
+	case UnsignedInt:
+		primitive := int(v)
+		r.UsedUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.UsedUnsignedInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.UsedString = &primitive
+	case *String:
+		r.UsedString = (*string)(v)
+	case Money:
+		r.UsedMoney = &v
+	case *Money:
+		r.UsedMoney = v
+	}
 }
+
 type CoverageEligibilityResponseError struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              CodeableConcept `bson:"code" json:"code"`
 }
-type OtherCoverageEligibilityResponse CoverageEligibilityResponse
 
 // MarshalJSON marshals the given CoverageEligibilityResponse as JSON into a byte slice
 func (r CoverageEligibilityResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherCoverageEligibilityResponse
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherCoverageEligibilityResponse: OtherCoverageEligibilityResponse(r),
-		ResourceType:                     "CoverageEligibilityResponse",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityResponse as JSON object with the members in the order of the fields
+func (r CoverageEligibilityResponse) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"CoverageEligibilityResponse\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("purpose")
+	if r.Purpose == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Purpose {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PurposeElement) > 0 {
+		e.key("_purpose")
+		e.elements(r.PurposeElement, len(r.Purpose))
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.ServicedDate != nil {
+		e.key("servicedDate")
+		e.string(*r.ServicedDate)
+	}
+	if r.ServicedDateElement != nil {
+		e.key("_servicedDate")
+		r.ServicedDateElement.appendJSON(e)
+	}
+	if r.ServicedPeriod != nil {
+		e.key("servicedPeriod")
+		r.ServicedPeriod.appendJSON(e)
+	}
+	e.key("created")
+	e.string(r.Created)
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.Requestor != nil {
+		e.key("requestor")
+		r.Requestor.appendJSON(e)
+	}
+	e.key("request")
+	r.Request.appendJSON(e)
+	e.key("outcome")
+	e.string(r.Outcome.Code())
+	if r.OutcomeElement != nil {
+		e.key("_outcome")
+		r.OutcomeElement.appendJSON(e)
+	}
+	if r.Disposition != nil {
+		e.key("disposition")
+		e.string(*r.Disposition)
+	}
+	if r.DispositionElement != nil {
+		e.key("_disposition")
+		r.DispositionElement.appendJSON(e)
+	}
+	e.key("insurer")
+	r.Insurer.appendJSON(e)
+	if len(r.Insurance) > 0 {
+		e.key("insurance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Insurance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PreAuthRef != nil {
+		e.key("preAuthRef")
+		e.string(*r.PreAuthRef)
+	}
+	if r.PreAuthRefElement != nil {
+		e.key("_preAuthRef")
+		r.PreAuthRefElement.appendJSON(e)
+	}
+	if r.Form != nil {
+		e.key("form")
+		r.Form.appendJSON(e)
+	}
+	if len(r.Error) > 0 {
+		e.key("error")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Error {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityResponse from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityResponse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityResponse
+func (r *CoverageEligibilityResponse) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "purpose":
+			a := d.array()
+			r.Purpose = nil
+			for a.next() {
+				var v EligibilityResponsePurpose
+				d.unmarshal(&v)
+				r.Purpose = append(r.Purpose, v)
+			}
+			if r.Purpose == nil && !a.isNull {
+				r.Purpose = []EligibilityResponsePurpose{}
+			}
+		case "_purpose":
+			a := d.array()
+			r.PurposeElement = nil
+			for a.next() {
+				r.PurposeElement = append(r.PurposeElement, d.element())
+			}
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "servicedDate":
+			if v, ok := d.string(); ok {
+				r.ServicedDate = &v
+			} else {
+				r.ServicedDate = nil
+			}
+		case "_servicedDate":
+			r.ServicedDateElement = d.element()
+		case "servicedPeriod":
+			if d.null() {
+				r.ServicedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ServicedPeriod = &v
+			}
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = v
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "requestor":
+			if d.null() {
+				r.Requestor = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Requestor = &v
+			}
+		case "request":
+			r.Request.decodeJSON(d)
+		case "outcome":
+			d.unmarshal(&r.Outcome)
+		case "_outcome":
+			r.OutcomeElement = d.element()
+		case "disposition":
+			if v, ok := d.string(); ok {
+				r.Disposition = &v
+			} else {
+				r.Disposition = nil
+			}
+		case "_disposition":
+			r.DispositionElement = d.element()
+		case "insurer":
+			r.Insurer.decodeJSON(d)
+		case "insurance":
+			a := d.array()
+			r.Insurance = nil
+			for a.next() {
+				var v CoverageEligibilityResponseInsurance
+				v.decodeJSON(d)
+				r.Insurance = append(r.Insurance, v)
+			}
+			if r.Insurance == nil && !a.isNull {
+				r.Insurance = []CoverageEligibilityResponseInsurance{}
+			}
+		case "preAuthRef":
+			if v, ok := d.string(); ok {
+				r.PreAuthRef = &v
+			} else {
+				r.PreAuthRef = nil
+			}
+		case "_preAuthRef":
+			r.PreAuthRefElement = d.element()
+		case "form":
+			if d.null() {
+				r.Form = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Form = &v
+			}
+		case "error":
+			a := d.array()
+			r.Error = nil
+			for a.next() {
+				var v CoverageEligibilityResponseError
+				v.decodeJSON(d)
+				r.Error = append(r.Error, v)
+			}
+			if r.Error == nil && !a.isNull {
+				r.Error = []CoverageEligibilityResponseError{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityResponse which shares no memory with the original
+func (r CoverageEligibilityResponse) DeepCopy() CoverageEligibilityResponse {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Purpose != nil {
+		out.Purpose = make([]EligibilityResponsePurpose, len(r.Purpose))
+		copy(out.Purpose, r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		out.PurposeElement = make([]*Element, len(r.PurposeElement))
+		for i, v := range r.PurposeElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PurposeElement[i] = &c
+			}
+		}
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.ServicedDate != nil {
+		v := *r.ServicedDate
+		out.ServicedDate = &v
+	}
+	if r.ServicedDateElement != nil {
+		v := r.ServicedDateElement.DeepCopy()
+		out.ServicedDateElement = &v
+	}
+	if r.ServicedPeriod != nil {
+		v := r.ServicedPeriod.DeepCopy()
+		out.ServicedPeriod = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Requestor != nil {
+		v := r.Requestor.DeepCopy()
+		out.Requestor = &v
+	}
+	out.Request = r.Request.DeepCopy()
+	if r.OutcomeElement != nil {
+		v := r.OutcomeElement.DeepCopy()
+		out.OutcomeElement = &v
+	}
+	if r.Disposition != nil {
+		v := *r.Disposition
+		out.Disposition = &v
+	}
+	if r.DispositionElement != nil {
+		v := r.DispositionElement.DeepCopy()
+		out.DispositionElement = &v
+	}
+	out.Insurer = r.Insurer.DeepCopy()
+	if r.Insurance != nil {
+		out.Insurance = make([]CoverageEligibilityResponseInsurance, len(r.Insurance))
+		for i := range r.Insurance {
+			out.Insurance[i] = r.Insurance[i].DeepCopy()
+		}
+	}
+	if r.PreAuthRef != nil {
+		v := *r.PreAuthRef
+		out.PreAuthRef = &v
+	}
+	if r.PreAuthRefElement != nil {
+		v := r.PreAuthRefElement.DeepCopy()
+		out.PreAuthRefElement = &v
+	}
+	if r.Form != nil {
+		v := r.Form.DeepCopy()
+		out.Form = &v
+	}
+	if r.Error != nil {
+		out.Error = make([]CoverageEligibilityResponseError, len(r.Error))
+		for i := range r.Error {
+			out.Error[i] = r.Error[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityResponse) Equal(other CoverageEligibilityResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.Purpose) != len(other.Purpose) {
+		return false
+	}
+	for i := range r.Purpose {
+		if r.Purpose[i] != other.Purpose[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PurposeElement, other.PurposeElement, false) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && *r.ServicedDate != *other.ServicedDate {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.Equal(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.Equal(*other.ServicedPeriod) {
+		return false
+	}
+	if r.Created != other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.Requestor == nil) != (other.Requestor == nil) || r.Requestor != nil && !r.Requestor.Equal(*other.Requestor) {
+		return false
+	}
+	if !r.Request.Equal(other.Request) {
+		return false
+	}
+	if r.Outcome != other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.Equal(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && *r.Disposition != *other.Disposition {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.Equal(*other.DispositionElement) {
+		return false
+	}
+	if !r.Insurer.Equal(other.Insurer) {
+		return false
+	}
+	if len(r.Insurance) != len(other.Insurance) {
+		return false
+	}
+	for i := range r.Insurance {
+		if !r.Insurance[i].Equal(other.Insurance[i]) {
+			return false
+		}
+	}
+	if (r.PreAuthRef == nil) != (other.PreAuthRef == nil) || r.PreAuthRef != nil && *r.PreAuthRef != *other.PreAuthRef {
+		return false
+	}
+	if (r.PreAuthRefElement == nil) != (other.PreAuthRefElement == nil) || r.PreAuthRefElement != nil && !r.PreAuthRefElement.Equal(*other.PreAuthRefElement) {
+		return false
+	}
+	if (r.Form == nil) != (other.Form == nil) || r.Form != nil && !r.Form.Equal(*other.Form) {
+		return false
+	}
+	if len(r.Error) != len(other.Error) {
+		return false
+	}
+	for i := range r.Error {
+		if !r.Error[i].Equal(other.Error[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityResponse) EqualsDeep(other CoverageEligibilityResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.Purpose), len(other.Purpose), func(i, j int) bool {
+		return r.Purpose[i] == other.Purpose[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.PurposeElement, other.PurposeElement, true) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.EqualsDeep(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.EqualsDeep(*other.ServicedPeriod) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.Requestor == nil) != (other.Requestor == nil) || r.Requestor != nil && !r.Requestor.EqualsDeep(*other.Requestor) {
+		return false
+	}
+	if !r.Request.EqualsDeep(other.Request) {
+		return false
+	}
+	if r.Outcome != other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.EqualsDeep(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.EqualsDeep(*other.DispositionElement) {
+		return false
+	}
+	if !r.Insurer.EqualsDeep(other.Insurer) {
+		return false
+	}
+	if !equivalentList(len(r.Insurance), len(other.Insurance), func(i, j int) bool {
+		return r.Insurance[i].EqualsDeep(other.Insurance[j])
+	}) {
+		return false
+	}
+	if (r.PreAuthRef == nil) != (other.PreAuthRef == nil) || r.PreAuthRef != nil && !equivalentString(*r.PreAuthRef, *other.PreAuthRef) {
+		return false
+	}
+	if (r.PreAuthRefElement == nil) != (other.PreAuthRefElement == nil) || r.PreAuthRefElement != nil && !r.PreAuthRefElement.EqualsDeep(*other.PreAuthRefElement) {
+		return false
+	}
+	if (r.Form == nil) != (other.Form == nil) || r.Form != nil && !r.Form.EqualsDeep(*other.Form) {
+		return false
+	}
+	if !equivalentList(len(r.Error), len(other.Error), func(i, j int) bool {
+		return r.Error[i].EqualsDeep(other.Error[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityResponse) EqualsShallow(other CoverageEligibilityResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if !equivalentList(len(r.Purpose), len(other.Purpose), func(i, j int) bool {
+		return r.Purpose[i] == other.Purpose[j]
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if r.Outcome != other.Outcome {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if (r.PreAuthRef == nil) != (other.PreAuthRef == nil) || r.PreAuthRef != nil && !equivalentString(*r.PreAuthRef, *other.PreAuthRef) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityResponse as FHIR XML
+func (r CoverageEligibilityResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "CoverageEligibilityResponse",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for i, v := range r.Purpose {
+		if err := encodeXMLPrimitive(e, "purpose", v, elementAt(r.PurposeElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "servicedDate", r.ServicedDate, r.ServicedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "servicedPeriod", r.ServicedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requestor", r.Requestor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "request", r.Request); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "outcome", r.Outcome, r.OutcomeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "disposition", r.Disposition, r.DispositionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "insurer", r.Insurer); err != nil {
+		return err
+	}
+	for _, v := range r.Insurance {
+		if err := encodeXMLElement(e, "insurance", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "preAuthRef", r.PreAuthRef, r.PreAuthRefElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "form", r.Form); err != nil {
+		return err
+	}
+	for _, v := range r.Error {
+		if err := encodeXMLElement(e, "error", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityResponse from FHIR XML
+func (r *CoverageEligibilityResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "purpose":
+				var v EligibilityResponsePurpose
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PurposeElement = appendElement(r.PurposeElement, len(r.Purpose), element)
+					r.Purpose = append(r.Purpose, v)
+				}
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "servicedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ServicedDate = &v
+				}
+				r.ServicedDateElement = element
+			case "servicedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServicedPeriod = &v
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = v
+				}
+				r.CreatedElement = element
+			case "requestor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requestor = &v
+			case "request":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = v
+			case "outcome":
+				var v ClaimProcessingCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Outcome = v
+				}
+				r.OutcomeElement = element
+			case "disposition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Disposition = &v
+				}
+				r.DispositionElement = element
+			case "insurer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurer = v
+			case "insurance":
+				var v CoverageEligibilityResponseInsurance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurance = append(r.Insurance, v)
+			case "preAuthRef":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PreAuthRef = &v
+				}
+				r.PreAuthRefElement = element
+			case "form":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Form = &v
+			case "error":
+				var v CoverageEligibilityResponseError
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Error = append(r.Error, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityResponse as properties to the RDF node n
+func (r CoverageEligibilityResponse) turtle(n *turtleNode) {
+	n.resource("CoverageEligibilityResponse", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("CoverageEligibilityResponse.identifier", i, v)
+	}
+	n.primitive("CoverageEligibilityResponse.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.Purpose {
+		n.primitive("CoverageEligibilityResponse.purpose", i, v, elementAt(r.PurposeElement, i), "code")
+	}
+	n.element("CoverageEligibilityResponse.patient", -1, r.Patient)
+	n.primitive("CoverageEligibilityResponse.servicedDate", -1, r.ServicedDate, r.ServicedDateElement, "date")
+	n.element("CoverageEligibilityResponse.servicedPeriod", -1, r.ServicedPeriod)
+	n.primitive("CoverageEligibilityResponse.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("CoverageEligibilityResponse.requestor", -1, r.Requestor)
+	n.element("CoverageEligibilityResponse.request", -1, r.Request)
+	n.primitive("CoverageEligibilityResponse.outcome", -1, r.Outcome, r.OutcomeElement, "code")
+	n.primitive("CoverageEligibilityResponse.disposition", -1, r.Disposition, r.DispositionElement, "string")
+	n.element("CoverageEligibilityResponse.insurer", -1, r.Insurer)
+	for i, v := range r.Insurance {
+		n.element("CoverageEligibilityResponse.insurance", i, v)
+	}
+	n.primitive("CoverageEligibilityResponse.preAuthRef", -1, r.PreAuthRef, r.PreAuthRefElement, "string")
+	n.element("CoverageEligibilityResponse.form", -1, r.Form)
+	for i, v := range r.Error {
+		n.element("CoverageEligibilityResponse.error", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CoverageEligibilityResponse as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityResponse from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityResponse) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityResponse as protobuf message CoverageEligibilityResponse of fhir.proto
+func (r CoverageEligibilityResponse) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityResponse to the encoded message
+func (r CoverageEligibilityResponse) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if len(r.Purpose) > 0 {
+		var p protoEncoder
+		for _, v := range r.Purpose {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(11, p.buf)
+	}
+	e.message(12, r.Patient)
+	if r.ServicedDate != nil {
+		e.string(13, *r.ServicedDate)
+	}
+	if r.ServicedPeriod != nil {
+		e.message(14, *r.ServicedPeriod)
+	}
+	if r.Created != "" {
+		e.string(15, r.Created)
+	}
+	if r.Requestor != nil {
+		e.message(16, *r.Requestor)
+	}
+	e.message(17, r.Request)
+	e.varint(18, r.Outcome.protoNumber())
+	if r.Disposition != nil {
+		e.string(19, *r.Disposition)
+	}
+	e.message(20, r.Insurer)
+	for _, v := range r.Insurance {
+		e.message(21, v)
+	}
+	if r.PreAuthRef != nil {
+		e.string(22, *r.PreAuthRef)
+	}
+	if r.Form != nil {
+		e.message(23, *r.Form)
+	}
+	for _, v := range r.Error {
+		e.message(24, v)
+	}
+	if r.IdElement != nil {
+		e.message(25, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(26, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(27, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(28, *r.StatusElement)
+	}
+	for _, v := range r.PurposeElement {
+		e.element(29, v)
+	}
+	if r.ServicedDateElement != nil {
+		e.message(30, *r.ServicedDateElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(31, *r.CreatedElement)
+	}
+	if r.OutcomeElement != nil {
+		e.message(32, *r.OutcomeElement)
+	}
+	if r.DispositionElement != nil {
+		e.message(33, *r.DispositionElement)
+	}
+	if r.PreAuthRefElement != nil {
+		e.message(34, *r.PreAuthRefElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityResponse from protobuf message CoverageEligibilityResponse of fhir.proto
+func (r *CoverageEligibilityResponse) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityResponse{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			for _, n := range d.varints() {
+				var v EligibilityResponsePurpose
+				d.enum(n, v.setProtoNumber)
+				r.Purpose = append(r.Purpose, v)
+			}
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 13:
+			v := d.string()
+			r.ServicedDate = &v
+		case 14:
+			var v Period
+			d.message(&v)
+			r.ServicedPeriod = &v
+		case 15:
+			r.Created = d.string()
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Requestor = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Request = v
+		case 18:
+			var v ClaimProcessingCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Outcome = v
+		case 19:
+			v := d.string()
+			r.Disposition = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Insurer = v
+		case 21:
+			var v CoverageEligibilityResponseInsurance
+			d.message(&v)
+			r.Insurance = append(r.Insurance, v)
+		case 22:
+			v := d.string()
+			r.PreAuthRef = &v
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.Form = &v
+		case 24:
+			var v CoverageEligibilityResponseError
+			d.message(&v)
+			r.Error = append(r.Error, v)
+		case 25:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 29:
+			r.PurposeElement = append(r.PurposeElement, d.element())
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ServicedDateElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.OutcomeElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.DispositionElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.PreAuthRefElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageEligibilityResponseInsurance as JSON into a byte slice
+func (r CoverageEligibilityResponseInsurance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityResponseInsurance as JSON object with the members in the order of the fields
+func (r CoverageEligibilityResponseInsurance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("coverage")
+	r.Coverage.appendJSON(e)
+	if r.Inforce != nil {
+		e.key("inforce")
+		e.bool(*r.Inforce)
+	}
+	if r.InforceElement != nil {
+		e.key("_inforce")
+		r.InforceElement.appendJSON(e)
+	}
+	if r.BenefitPeriod != nil {
+		e.key("benefitPeriod")
+		r.BenefitPeriod.appendJSON(e)
+	}
+	if len(r.Item) > 0 {
+		e.key("item")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Item {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityResponseInsurance from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityResponseInsurance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityResponseInsurance
+func (r *CoverageEligibilityResponseInsurance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "coverage":
+			r.Coverage.decodeJSON(d)
+		case "inforce":
+			if v, ok := d.bool(); ok {
+				r.Inforce = &v
+			} else {
+				r.Inforce = nil
+			}
+		case "_inforce":
+			r.InforceElement = d.element()
+		case "benefitPeriod":
+			if d.null() {
+				r.BenefitPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.BenefitPeriod = &v
+			}
+		case "item":
+			a := d.array()
+			r.Item = nil
+			for a.next() {
+				var v CoverageEligibilityResponseInsuranceItem
+				v.decodeJSON(d)
+				r.Item = append(r.Item, v)
+			}
+			if r.Item == nil && !a.isNull {
+				r.Item = []CoverageEligibilityResponseInsuranceItem{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityResponseInsurance which shares no memory with the original
+func (r CoverageEligibilityResponseInsurance) DeepCopy() CoverageEligibilityResponseInsurance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Coverage = r.Coverage.DeepCopy()
+	if r.Inforce != nil {
+		v := *r.Inforce
+		out.Inforce = &v
+	}
+	if r.InforceElement != nil {
+		v := r.InforceElement.DeepCopy()
+		out.InforceElement = &v
+	}
+	if r.BenefitPeriod != nil {
+		v := r.BenefitPeriod.DeepCopy()
+		out.BenefitPeriod = &v
+	}
+	if r.Item != nil {
+		out.Item = make([]CoverageEligibilityResponseInsuranceItem, len(r.Item))
+		for i := range r.Item {
+			out.Item[i] = r.Item[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityResponseInsurance) Equal(other CoverageEligibilityResponseInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Coverage.Equal(other.Coverage) {
+		return false
+	}
+	if (r.Inforce == nil) != (other.Inforce == nil) || r.Inforce != nil && *r.Inforce != *other.Inforce {
+		return false
+	}
+	if (r.InforceElement == nil) != (other.InforceElement == nil) || r.InforceElement != nil && !r.InforceElement.Equal(*other.InforceElement) {
+		return false
+	}
+	if (r.BenefitPeriod == nil) != (other.BenefitPeriod == nil) || r.BenefitPeriod != nil && !r.BenefitPeriod.Equal(*other.BenefitPeriod) {
+		return false
+	}
+	if len(r.Item) != len(other.Item) {
+		return false
+	}
+	for i := range r.Item {
+		if !r.Item[i].Equal(other.Item[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityResponseInsurance) EqualsDeep(other CoverageEligibilityResponseInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Coverage.EqualsDeep(other.Coverage) {
+		return false
+	}
+	if (r.Inforce == nil) != (other.Inforce == nil) || r.Inforce != nil && *r.Inforce != *other.Inforce {
+		return false
+	}
+	if (r.InforceElement == nil) != (other.InforceElement == nil) || r.InforceElement != nil && !r.InforceElement.EqualsDeep(*other.InforceElement) {
+		return false
+	}
+	if (r.BenefitPeriod == nil) != (other.BenefitPeriod == nil) || r.BenefitPeriod != nil && !r.BenefitPeriod.EqualsDeep(*other.BenefitPeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Item), len(other.Item), func(i, j int) bool {
+		return r.Item[i].EqualsDeep(other.Item[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityResponseInsurance) EqualsShallow(other CoverageEligibilityResponseInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Inforce == nil) != (other.Inforce == nil) || r.Inforce != nil && *r.Inforce != *other.Inforce {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityResponseInsurance as FHIR XML
+func (r CoverageEligibilityResponseInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "coverage", r.Coverage); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "inforce", r.Inforce, r.InforceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "benefitPeriod", r.BenefitPeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Item {
+		if err := encodeXMLElement(e, "item", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityResponseInsurance from FHIR XML
+func (r *CoverageEligibilityResponseInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "coverage":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coverage = v
+			case "inforce":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Inforce = &v
+				}
+				r.InforceElement = element
+			case "benefitPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BenefitPeriod = &v
+			case "item":
+				var v CoverageEligibilityResponseInsuranceItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Item = append(r.Item, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityResponseInsurance as properties to the RDF node n
+func (r CoverageEligibilityResponseInsurance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("CoverageEligibilityResponse.insurance.coverage", -1, r.Coverage)
+	n.primitive("CoverageEligibilityResponse.insurance.inforce", -1, r.Inforce, r.InforceElement, "boolean")
+	n.element("CoverageEligibilityResponse.insurance.benefitPeriod", -1, r.BenefitPeriod)
+	for i, v := range r.Item {
+		n.element("CoverageEligibilityResponse.insurance.item", i, v)
+	}
+}
+
+// MarshalBSON marshals the given CoverageEligibilityResponseInsurance as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityResponseInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityResponseInsurance from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityResponseInsurance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityResponseInsurance as protobuf message CoverageEligibilityResponseInsurance of fhir.proto
+func (r CoverageEligibilityResponseInsurance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityResponseInsurance to the encoded message
+func (r CoverageEligibilityResponseInsurance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Coverage)
+	if r.Inforce != nil {
+		e.varint(5, protoBool(*r.Inforce))
+	}
+	if r.BenefitPeriod != nil {
+		e.message(6, *r.BenefitPeriod)
+	}
+	for _, v := range r.Item {
+		e.message(7, v)
+	}
+	if r.InforceElement != nil {
+		e.message(8, *r.InforceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityResponseInsurance from protobuf message CoverageEligibilityResponseInsurance of fhir.proto
+func (r *CoverageEligibilityResponseInsurance) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityResponseInsurance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Coverage = v
+		case 5:
+			v := d.bool()
+			r.Inforce = &v
+		case 6:
+			var v Period
+			d.message(&v)
+			r.BenefitPeriod = &v
+		case 7:
+			var v CoverageEligibilityResponseInsuranceItem
+			d.message(&v)
+			r.Item = append(r.Item, v)
+		case 8:
+			var v Element
+			d.message(&v)
+			r.InforceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageEligibilityResponseInsuranceItem as JSON into a byte slice
+func (r CoverageEligibilityResponseInsuranceItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityResponseInsuranceItem as JSON object with the members in the order of the fields
+func (r CoverageEligibilityResponseInsuranceItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	if r.ProductOrService != nil {
+		e.key("productOrService")
+		r.ProductOrService.appendJSON(e)
+	}
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Provider != nil {
+		e.key("provider")
+		r.Provider.appendJSON(e)
+	}
+	if r.Excluded != nil {
+		e.key("excluded")
+		e.bool(*r.Excluded)
+	}
+	if r.ExcludedElement != nil {
+		e.key("_excluded")
+		r.ExcludedElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Network != nil {
+		e.key("network")
+		r.Network.appendJSON(e)
+	}
+	if r.Unit != nil {
+		e.key("unit")
+		r.Unit.appendJSON(e)
+	}
+	if r.Term != nil {
+		e.key("term")
+		r.Term.appendJSON(e)
+	}
+	if len(r.Benefit) > 0 {
+		e.key("benefit")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Benefit {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AuthorizationRequired != nil {
+		e.key("authorizationRequired")
+		e.bool(*r.AuthorizationRequired)
+	}
+	if r.AuthorizationRequiredElement != nil {
+		e.key("_authorizationRequired")
+		r.AuthorizationRequiredElement.appendJSON(e)
+	}
+	if len(r.AuthorizationSupporting) > 0 {
+		e.key("authorizationSupporting")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AuthorizationSupporting {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AuthorizationUrl != nil {
+		e.key("authorizationUrl")
+		e.string(*r.AuthorizationUrl)
+	}
+	if r.AuthorizationUrlElement != nil {
+		e.key("_authorizationUrl")
+		r.AuthorizationUrlElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityResponseInsuranceItem from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityResponseInsuranceItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityResponseInsuranceItem
+func (r *CoverageEligibilityResponseInsuranceItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "productOrService":
+			if d.null() {
+				r.ProductOrService = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProductOrService = &v
+			}
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "provider":
+			if d.null() {
+				r.Provider = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Provider = &v
+			}
+		case "excluded":
+			if v, ok := d.bool(); ok {
+				r.Excluded = &v
+			} else {
+				r.Excluded = nil
+			}
+		case "_excluded":
+			r.ExcludedElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "network":
+			if d.null() {
+				r.Network = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Network = &v
+			}
+		case "unit":
+			if d.null() {
+				r.Unit = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Unit = &v
+			}
+		case "term":
+			if d.null() {
+				r.Term = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Term = &v
+			}
+		case "benefit":
+			a := d.array()
+			r.Benefit = nil
+			for a.next() {
+				var v CoverageEligibilityResponseInsuranceItemBenefit
+				v.decodeJSON(d)
+				r.Benefit = append(r.Benefit, v)
+			}
+			if r.Benefit == nil && !a.isNull {
+				r.Benefit = []CoverageEligibilityResponseInsuranceItemBenefit{}
+			}
+		case "authorizationRequired":
+			if v, ok := d.bool(); ok {
+				r.AuthorizationRequired = &v
+			} else {
+				r.AuthorizationRequired = nil
+			}
+		case "_authorizationRequired":
+			r.AuthorizationRequiredElement = d.element()
+		case "authorizationSupporting":
+			a := d.array()
+			r.AuthorizationSupporting = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AuthorizationSupporting = append(r.AuthorizationSupporting, v)
+			}
+			if r.AuthorizationSupporting == nil && !a.isNull {
+				r.AuthorizationSupporting = []CodeableConcept{}
+			}
+		case "authorizationUrl":
+			if v, ok := d.string(); ok {
+				r.AuthorizationUrl = &v
+			} else {
+				r.AuthorizationUrl = nil
+			}
+		case "_authorizationUrl":
+			r.AuthorizationUrlElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityResponseInsuranceItem which shares no memory with the original
+func (r CoverageEligibilityResponseInsuranceItem) DeepCopy() CoverageEligibilityResponseInsuranceItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	if r.ProductOrService != nil {
+		v := r.ProductOrService.DeepCopy()
+		out.ProductOrService = &v
+	}
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.Provider != nil {
+		v := r.Provider.DeepCopy()
+		out.Provider = &v
+	}
+	if r.Excluded != nil {
+		v := *r.Excluded
+		out.Excluded = &v
+	}
+	if r.ExcludedElement != nil {
+		v := r.ExcludedElement.DeepCopy()
+		out.ExcludedElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Network != nil {
+		v := r.Network.DeepCopy()
+		out.Network = &v
+	}
+	if r.Unit != nil {
+		v := r.Unit.DeepCopy()
+		out.Unit = &v
+	}
+	if r.Term != nil {
+		v := r.Term.DeepCopy()
+		out.Term = &v
+	}
+	if r.Benefit != nil {
+		out.Benefit = make([]CoverageEligibilityResponseInsuranceItemBenefit, len(r.Benefit))
+		for i := range r.Benefit {
+			out.Benefit[i] = r.Benefit[i].DeepCopy()
+		}
+	}
+	if r.AuthorizationRequired != nil {
+		v := *r.AuthorizationRequired
+		out.AuthorizationRequired = &v
+	}
+	if r.AuthorizationRequiredElement != nil {
+		v := r.AuthorizationRequiredElement.DeepCopy()
+		out.AuthorizationRequiredElement = &v
+	}
+	if r.AuthorizationSupporting != nil {
+		out.AuthorizationSupporting = make([]CodeableConcept, len(r.AuthorizationSupporting))
+		for i := range r.AuthorizationSupporting {
+			out.AuthorizationSupporting[i] = r.AuthorizationSupporting[i].DeepCopy()
+		}
+	}
+	if r.AuthorizationUrl != nil {
+		v := *r.AuthorizationUrl
+		out.AuthorizationUrl = &v
+	}
+	if r.AuthorizationUrlElement != nil {
+		v := r.AuthorizationUrlElement.DeepCopy()
+		out.AuthorizationUrlElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityResponseInsuranceItem) Equal(other CoverageEligibilityResponseInsuranceItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if (r.ProductOrService == nil) != (other.ProductOrService == nil) || r.ProductOrService != nil && !r.ProductOrService.Equal(*other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.Equal(*other.Provider) {
+		return false
+	}
+	if (r.Excluded == nil) != (other.Excluded == nil) || r.Excluded != nil && *r.Excluded != *other.Excluded {
+		return false
+	}
+	if (r.ExcludedElement == nil) != (other.ExcludedElement == nil) || r.ExcludedElement != nil && !r.ExcludedElement.Equal(*other.ExcludedElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Network == nil) != (other.Network == nil) || r.Network != nil && !r.Network.Equal(*other.Network) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !r.Unit.Equal(*other.Unit) {
+		return false
+	}
+	if (r.Term == nil) != (other.Term == nil) || r.Term != nil && !r.Term.Equal(*other.Term) {
+		return false
+	}
+	if len(r.Benefit) != len(other.Benefit) {
+		return false
+	}
+	for i := range r.Benefit {
+		if !r.Benefit[i].Equal(other.Benefit[i]) {
+			return false
+		}
+	}
+	if (r.AuthorizationRequired == nil) != (other.AuthorizationRequired == nil) || r.AuthorizationRequired != nil && *r.AuthorizationRequired != *other.AuthorizationRequired {
+		return false
+	}
+	if (r.AuthorizationRequiredElement == nil) != (other.AuthorizationRequiredElement == nil) || r.AuthorizationRequiredElement != nil && !r.AuthorizationRequiredElement.Equal(*other.AuthorizationRequiredElement) {
+		return false
+	}
+	if len(r.AuthorizationSupporting) != len(other.AuthorizationSupporting) {
+		return false
+	}
+	for i := range r.AuthorizationSupporting {
+		if !r.AuthorizationSupporting[i].Equal(other.AuthorizationSupporting[i]) {
+			return false
+		}
+	}
+	if (r.AuthorizationUrl == nil) != (other.AuthorizationUrl == nil) || r.AuthorizationUrl != nil && *r.AuthorizationUrl != *other.AuthorizationUrl {
+		return false
+	}
+	if (r.AuthorizationUrlElement == nil) != (other.AuthorizationUrlElement == nil) || r.AuthorizationUrlElement != nil && !r.AuthorizationUrlElement.Equal(*other.AuthorizationUrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityResponseInsuranceItem) EqualsDeep(other CoverageEligibilityResponseInsuranceItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if (r.ProductOrService == nil) != (other.ProductOrService == nil) || r.ProductOrService != nil && !r.ProductOrService.EqualsDeep(*other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.EqualsDeep(*other.Provider) {
+		return false
+	}
+	if (r.Excluded == nil) != (other.Excluded == nil) || r.Excluded != nil && *r.Excluded != *other.Excluded {
+		return false
+	}
+	if (r.ExcludedElement == nil) != (other.ExcludedElement == nil) || r.ExcludedElement != nil && !r.ExcludedElement.EqualsDeep(*other.ExcludedElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Network == nil) != (other.Network == nil) || r.Network != nil && !r.Network.EqualsDeep(*other.Network) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !r.Unit.EqualsDeep(*other.Unit) {
+		return false
+	}
+	if (r.Term == nil) != (other.Term == nil) || r.Term != nil && !r.Term.EqualsDeep(*other.Term) {
+		return false
+	}
+	if !equivalentList(len(r.Benefit), len(other.Benefit), func(i, j int) bool {
+		return r.Benefit[i].EqualsDeep(other.Benefit[j])
+	}) {
+		return false
+	}
+	if (r.AuthorizationRequired == nil) != (other.AuthorizationRequired == nil) || r.AuthorizationRequired != nil && *r.AuthorizationRequired != *other.AuthorizationRequired {
+		return false
+	}
+	if (r.AuthorizationRequiredElement == nil) != (other.AuthorizationRequiredElement == nil) || r.AuthorizationRequiredElement != nil && !r.AuthorizationRequiredElement.EqualsDeep(*other.AuthorizationRequiredElement) {
+		return false
+	}
+	if !equivalentList(len(r.AuthorizationSupporting), len(other.AuthorizationSupporting), func(i, j int) bool {
+		return r.AuthorizationSupporting[i].EqualsDeep(other.AuthorizationSupporting[j])
+	}) {
+		return false
+	}
+	if (r.AuthorizationUrl == nil) != (other.AuthorizationUrl == nil) || r.AuthorizationUrl != nil && !equivalentString(*r.AuthorizationUrl, *other.AuthorizationUrl) {
+		return false
+	}
+	if (r.AuthorizationUrlElement == nil) != (other.AuthorizationUrlElement == nil) || r.AuthorizationUrlElement != nil && !r.AuthorizationUrlElement.EqualsDeep(*other.AuthorizationUrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityResponseInsuranceItem) EqualsShallow(other CoverageEligibilityResponseInsuranceItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Excluded == nil) != (other.Excluded == nil) || r.Excluded != nil && *r.Excluded != *other.Excluded {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.AuthorizationRequired == nil) != (other.AuthorizationRequired == nil) || r.AuthorizationRequired != nil && *r.AuthorizationRequired != *other.AuthorizationRequired {
+		return false
+	}
+	if (r.AuthorizationUrl == nil) != (other.AuthorizationUrl == nil) || r.AuthorizationUrl != nil && !equivalentString(*r.AuthorizationUrl, *other.AuthorizationUrl) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityResponseInsuranceItem as FHIR XML
+func (r CoverageEligibilityResponseInsuranceItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "provider", r.Provider); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "excluded", r.Excluded, r.ExcludedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "network", r.Network); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unit", r.Unit); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "term", r.Term); err != nil {
+		return err
+	}
+	for _, v := range r.Benefit {
+		if err := encodeXMLElement(e, "benefit", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "authorizationRequired", r.AuthorizationRequired, r.AuthorizationRequiredElement); err != nil {
+		return err
+	}
+	for _, v := range r.AuthorizationSupporting {
+		if err := encodeXMLElement(e, "authorizationSupporting", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "authorizationUrl", r.AuthorizationUrl, r.AuthorizationUrlElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityResponseInsuranceItem from FHIR XML
+func (r *CoverageEligibilityResponseInsuranceItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = &v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = &v
+			case "excluded":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Excluded = &v
+				}
+				r.ExcludedElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "network":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Network = &v
+			case "unit":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Unit = &v
+			case "term":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Term = &v
+			case "benefit":
+				var v CoverageEligibilityResponseInsuranceItemBenefit
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Benefit = append(r.Benefit, v)
+			case "authorizationRequired":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthorizationRequired = &v
+				}
+				r.AuthorizationRequiredElement = element
+			case "authorizationSupporting":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AuthorizationSupporting = append(r.AuthorizationSupporting, v)
+			case "authorizationUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthorizationUrl = &v
+				}
+				r.AuthorizationUrlElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityResponseInsuranceItem as properties to the RDF node n
+func (r CoverageEligibilityResponseInsuranceItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("CoverageEligibilityResponse.insurance.item.category", -1, r.Category)
+	n.element("CoverageEligibilityResponse.insurance.item.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("CoverageEligibilityResponse.insurance.item.modifier", i, v)
+	}
+	n.element("CoverageEligibilityResponse.insurance.item.provider", -1, r.Provider)
+	n.primitive("CoverageEligibilityResponse.insurance.item.excluded", -1, r.Excluded, r.ExcludedElement, "boolean")
+	n.primitive("CoverageEligibilityResponse.insurance.item.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("CoverageEligibilityResponse.insurance.item.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("CoverageEligibilityResponse.insurance.item.network", -1, r.Network)
+	n.element("CoverageEligibilityResponse.insurance.item.unit", -1, r.Unit)
+	n.element("CoverageEligibilityResponse.insurance.item.term", -1, r.Term)
+	for i, v := range r.Benefit {
+		n.element("CoverageEligibilityResponse.insurance.item.benefit", i, v)
+	}
+	n.primitive("CoverageEligibilityResponse.insurance.item.authorizationRequired", -1, r.AuthorizationRequired, r.AuthorizationRequiredElement, "boolean")
+	for i, v := range r.AuthorizationSupporting {
+		n.element("CoverageEligibilityResponse.insurance.item.authorizationSupporting", i, v)
+	}
+	n.primitive("CoverageEligibilityResponse.insurance.item.authorizationUrl", -1, r.AuthorizationUrl, r.AuthorizationUrlElement, "string")
+}
+
+// MarshalBSON marshals the given CoverageEligibilityResponseInsuranceItem as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityResponseInsuranceItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityResponseInsuranceItem from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityResponseInsuranceItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityResponseInsuranceItem as protobuf message CoverageEligibilityResponseInsuranceItem of fhir.proto
+func (r CoverageEligibilityResponseInsuranceItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityResponseInsuranceItem to the encoded message
+func (r CoverageEligibilityResponseInsuranceItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Category != nil {
+		e.message(4, *r.Category)
+	}
+	if r.ProductOrService != nil {
+		e.message(5, *r.ProductOrService)
+	}
+	for _, v := range r.Modifier {
+		e.message(6, v)
+	}
+	if r.Provider != nil {
+		e.message(7, *r.Provider)
+	}
+	if r.Excluded != nil {
+		e.varint(8, protoBool(*r.Excluded))
+	}
+	if r.Name != nil {
+		e.string(9, *r.Name)
+	}
+	if r.Description != nil {
+		e.string(10, *r.Description)
+	}
+	if r.Network != nil {
+		e.message(11, *r.Network)
+	}
+	if r.Unit != nil {
+		e.message(12, *r.Unit)
+	}
+	if r.Term != nil {
+		e.message(13, *r.Term)
+	}
+	for _, v := range r.Benefit {
+		e.message(14, v)
+	}
+	if r.AuthorizationRequired != nil {
+		e.varint(15, protoBool(*r.AuthorizationRequired))
+	}
+	for _, v := range r.AuthorizationSupporting {
+		e.message(16, v)
+	}
+	if r.AuthorizationUrl != nil {
+		e.string(17, *r.AuthorizationUrl)
+	}
+	if r.ExcludedElement != nil {
+		e.message(18, *r.ExcludedElement)
+	}
+	if r.NameElement != nil {
+		e.message(19, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(20, *r.DescriptionElement)
+	}
+	if r.AuthorizationRequiredElement != nil {
+		e.message(21, *r.AuthorizationRequiredElement)
+	}
+	if r.AuthorizationUrlElement != nil {
+		e.message(22, *r.AuthorizationUrlElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityResponseInsuranceItem from protobuf message CoverageEligibilityResponseInsuranceItem of fhir.proto
+func (r *CoverageEligibilityResponseInsuranceItem) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityResponseInsuranceItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Provider = &v
+		case 8:
+			v := d.bool()
+			r.Excluded = &v
+		case 9:
+			v := d.string()
+			r.Name = &v
+		case 10:
+			v := d.string()
+			r.Description = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Network = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Unit = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Term = &v
+		case 14:
+			var v CoverageEligibilityResponseInsuranceItemBenefit
+			d.message(&v)
+			r.Benefit = append(r.Benefit, v)
+		case 15:
+			v := d.bool()
+			r.AuthorizationRequired = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.AuthorizationSupporting = append(r.AuthorizationSupporting, v)
+		case 17:
+			v := d.string()
+			r.AuthorizationUrl = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ExcludedElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.AuthorizationRequiredElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.AuthorizationUrlElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageEligibilityResponseInsuranceItemBenefit as JSON into a byte slice
+func (r CoverageEligibilityResponseInsuranceItemBenefit) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityResponseInsuranceItemBenefit as JSON object with the members in the order of the fields
+func (r CoverageEligibilityResponseInsuranceItemBenefit) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.AllowedUnsignedInt != nil {
+		e.key("allowedUnsignedInt")
+		e.int(*r.AllowedUnsignedInt)
+	}
+	if r.AllowedUnsignedIntElement != nil {
+		e.key("_allowedUnsignedInt")
+		r.AllowedUnsignedIntElement.appendJSON(e)
+	}
+	if r.AllowedString != nil {
+		e.key("allowedString")
+		e.string(*r.AllowedString)
+	}
+	if r.AllowedStringElement != nil {
+		e.key("_allowedString")
+		r.AllowedStringElement.appendJSON(e)
+	}
+	if r.AllowedMoney != nil {
+		e.key("allowedMoney")
+		r.AllowedMoney.appendJSON(e)
+	}
+	if r.UsedUnsignedInt != nil {
+		e.key("usedUnsignedInt")
+		e.int(*r.UsedUnsignedInt)
+	}
+	if r.UsedUnsignedIntElement != nil {
+		e.key("_usedUnsignedInt")
+		r.UsedUnsignedIntElement.appendJSON(e)
+	}
+	if r.UsedString != nil {
+		e.key("usedString")
+		e.string(*r.UsedString)
+	}
+	if r.UsedStringElement != nil {
+		e.key("_usedString")
+		r.UsedStringElement.appendJSON(e)
+	}
+	if r.UsedMoney != nil {
+		e.key("usedMoney")
+		r.UsedMoney.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityResponseInsuranceItemBenefit from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityResponseInsuranceItemBenefit
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "allowedUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.AllowedUnsignedInt = &v
+			} else {
+				r.AllowedUnsignedInt = nil
+			}
+		case "_allowedUnsignedInt":
+			r.AllowedUnsignedIntElement = d.element()
+		case "allowedString":
+			if v, ok := d.string(); ok {
+				r.AllowedString = &v
+			} else {
+				r.AllowedString = nil
+			}
+		case "_allowedString":
+			r.AllowedStringElement = d.element()
+		case "allowedMoney":
+			if d.null() {
+				r.AllowedMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.AllowedMoney = &v
+			}
+		case "usedUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.UsedUnsignedInt = &v
+			} else {
+				r.UsedUnsignedInt = nil
+			}
+		case "_usedUnsignedInt":
+			r.UsedUnsignedIntElement = d.element()
+		case "usedString":
+			if v, ok := d.string(); ok {
+				r.UsedString = &v
+			} else {
+				r.UsedString = nil
+			}
+		case "_usedString":
+			r.UsedStringElement = d.element()
+		case "usedMoney":
+			if d.null() {
+				r.UsedMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UsedMoney = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityResponseInsuranceItemBenefit which shares no memory with the original
+func (r CoverageEligibilityResponseInsuranceItemBenefit) DeepCopy() CoverageEligibilityResponseInsuranceItemBenefit {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.AllowedUnsignedInt != nil {
+		v := *r.AllowedUnsignedInt
+		out.AllowedUnsignedInt = &v
+	}
+	if r.AllowedUnsignedIntElement != nil {
+		v := r.AllowedUnsignedIntElement.DeepCopy()
+		out.AllowedUnsignedIntElement = &v
+	}
+	if r.AllowedString != nil {
+		v := *r.AllowedString
+		out.AllowedString = &v
+	}
+	if r.AllowedStringElement != nil {
+		v := r.AllowedStringElement.DeepCopy()
+		out.AllowedStringElement = &v
+	}
+	if r.AllowedMoney != nil {
+		v := r.AllowedMoney.DeepCopy()
+		out.AllowedMoney = &v
+	}
+	if r.UsedUnsignedInt != nil {
+		v := *r.UsedUnsignedInt
+		out.UsedUnsignedInt = &v
+	}
+	if r.UsedUnsignedIntElement != nil {
+		v := r.UsedUnsignedIntElement.DeepCopy()
+		out.UsedUnsignedIntElement = &v
+	}
+	if r.UsedString != nil {
+		v := *r.UsedString
+		out.UsedString = &v
+	}
+	if r.UsedStringElement != nil {
+		v := r.UsedStringElement.DeepCopy()
+		out.UsedStringElement = &v
+	}
+	if r.UsedMoney != nil {
+		v := r.UsedMoney.DeepCopy()
+		out.UsedMoney = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityResponseInsuranceItemBenefit) Equal(other CoverageEligibilityResponseInsuranceItemBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.AllowedUnsignedInt == nil) != (other.AllowedUnsignedInt == nil) || r.AllowedUnsignedInt != nil && *r.AllowedUnsignedInt != *other.AllowedUnsignedInt {
+		return false
+	}
+	if (r.AllowedUnsignedIntElement == nil) != (other.AllowedUnsignedIntElement == nil) || r.AllowedUnsignedIntElement != nil && !r.AllowedUnsignedIntElement.Equal(*other.AllowedUnsignedIntElement) {
+		return false
+	}
+	if (r.AllowedString == nil) != (other.AllowedString == nil) || r.AllowedString != nil && *r.AllowedString != *other.AllowedString {
+		return false
+	}
+	if (r.AllowedStringElement == nil) != (other.AllowedStringElement == nil) || r.AllowedStringElement != nil && !r.AllowedStringElement.Equal(*other.AllowedStringElement) {
+		return false
+	}
+	if (r.AllowedMoney == nil) != (other.AllowedMoney == nil) || r.AllowedMoney != nil && !r.AllowedMoney.Equal(*other.AllowedMoney) {
+		return false
+	}
+	if (r.UsedUnsignedInt == nil) != (other.UsedUnsignedInt == nil) || r.UsedUnsignedInt != nil && *r.UsedUnsignedInt != *other.UsedUnsignedInt {
+		return false
+	}
+	if (r.UsedUnsignedIntElement == nil) != (other.UsedUnsignedIntElement == nil) || r.UsedUnsignedIntElement != nil && !r.UsedUnsignedIntElement.Equal(*other.UsedUnsignedIntElement) {
+		return false
+	}
+	if (r.UsedString == nil) != (other.UsedString == nil) || r.UsedString != nil && *r.UsedString != *other.UsedString {
+		return false
+	}
+	if (r.UsedStringElement == nil) != (other.UsedStringElement == nil) || r.UsedStringElement != nil && !r.UsedStringElement.Equal(*other.UsedStringElement) {
+		return false
+	}
+	if (r.UsedMoney == nil) != (other.UsedMoney == nil) || r.UsedMoney != nil && !r.UsedMoney.Equal(*other.UsedMoney) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityResponseInsuranceItemBenefit) EqualsDeep(other CoverageEligibilityResponseInsuranceItemBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.AllowedUnsignedInt == nil) != (other.AllowedUnsignedInt == nil) || r.AllowedUnsignedInt != nil && *r.AllowedUnsignedInt != *other.AllowedUnsignedInt {
+		return false
+	}
+	if (r.AllowedUnsignedIntElement == nil) != (other.AllowedUnsignedIntElement == nil) || r.AllowedUnsignedIntElement != nil && !r.AllowedUnsignedIntElement.EqualsDeep(*other.AllowedUnsignedIntElement) {
+		return false
+	}
+	if (r.AllowedString == nil) != (other.AllowedString == nil) || r.AllowedString != nil && !equivalentString(*r.AllowedString, *other.AllowedString) {
+		return false
+	}
+	if (r.AllowedStringElement == nil) != (other.AllowedStringElement == nil) || r.AllowedStringElement != nil && !r.AllowedStringElement.EqualsDeep(*other.AllowedStringElement) {
+		return false
+	}
+	if (r.AllowedMoney == nil) != (other.AllowedMoney == nil) || r.AllowedMoney != nil && !r.AllowedMoney.EqualsDeep(*other.AllowedMoney) {
+		return false
+	}
+	if (r.UsedUnsignedInt == nil) != (other.UsedUnsignedInt == nil) || r.UsedUnsignedInt != nil && *r.UsedUnsignedInt != *other.UsedUnsignedInt {
+		return false
+	}
+	if (r.UsedUnsignedIntElement == nil) != (other.UsedUnsignedIntElement == nil) || r.UsedUnsignedIntElement != nil && !r.UsedUnsignedIntElement.EqualsDeep(*other.UsedUnsignedIntElement) {
+		return false
+	}
+	if (r.UsedString == nil) != (other.UsedString == nil) || r.UsedString != nil && !equivalentString(*r.UsedString, *other.UsedString) {
+		return false
+	}
+	if (r.UsedStringElement == nil) != (other.UsedStringElement == nil) || r.UsedStringElement != nil && !r.UsedStringElement.EqualsDeep(*other.UsedStringElement) {
+		return false
+	}
+	if (r.UsedMoney == nil) != (other.UsedMoney == nil) || r.UsedMoney != nil && !r.UsedMoney.EqualsDeep(*other.UsedMoney) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityResponseInsuranceItemBenefit) EqualsShallow(other CoverageEligibilityResponseInsuranceItemBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.AllowedUnsignedInt == nil) != (other.AllowedUnsignedInt == nil) || r.AllowedUnsignedInt != nil && *r.AllowedUnsignedInt != *other.AllowedUnsignedInt {
+		return false
+	}
+	if (r.AllowedString == nil) != (other.AllowedString == nil) || r.AllowedString != nil && !equivalentString(*r.AllowedString, *other.AllowedString) {
+		return false
+	}
+	if (r.UsedUnsignedInt == nil) != (other.UsedUnsignedInt == nil) || r.UsedUnsignedInt != nil && *r.UsedUnsignedInt != *other.UsedUnsignedInt {
+		return false
+	}
+	if (r.UsedString == nil) != (other.UsedString == nil) || r.UsedString != nil && !equivalentString(*r.UsedString, *other.UsedString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityResponseInsuranceItemBenefit as FHIR XML
+func (r CoverageEligibilityResponseInsuranceItemBenefit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "allowedUnsignedInt", r.AllowedUnsignedInt, r.AllowedUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "allowedString", r.AllowedString, r.AllowedStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "allowedMoney", r.AllowedMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "usedUnsignedInt", r.UsedUnsignedInt, r.UsedUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "usedString", r.UsedString, r.UsedStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "usedMoney", r.UsedMoney); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityResponseInsuranceItemBenefit from FHIR XML
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "allowedUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllowedUnsignedInt = &v
+				}
+				r.AllowedUnsignedIntElement = element
+			case "allowedString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllowedString = &v
+				}
+				r.AllowedStringElement = element
+			case "allowedMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AllowedMoney = &v
+			case "usedUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.UsedUnsignedInt = &v
+				}
+				r.UsedUnsignedIntElement = element
+			case "usedString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.UsedString = &v
+				}
+				r.UsedStringElement = element
+			case "usedMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UsedMoney = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityResponseInsuranceItemBenefit as properties to the RDF node n
+func (r CoverageEligibilityResponseInsuranceItemBenefit) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("CoverageEligibilityResponse.insurance.item.benefit.type", -1, r.Type)
+	n.primitive("CoverageEligibilityResponse.insurance.item.benefit.allowedUnsignedInt", -1, r.AllowedUnsignedInt, r.AllowedUnsignedIntElement, "unsignedInt")
+	n.primitive("CoverageEligibilityResponse.insurance.item.benefit.allowedString", -1, r.AllowedString, r.AllowedStringElement, "string")
+	n.element("CoverageEligibilityResponse.insurance.item.benefit.allowedMoney", -1, r.AllowedMoney)
+	n.primitive("CoverageEligibilityResponse.insurance.item.benefit.usedUnsignedInt", -1, r.UsedUnsignedInt, r.UsedUnsignedIntElement, "unsignedInt")
+	n.primitive("CoverageEligibilityResponse.insurance.item.benefit.usedString", -1, r.UsedString, r.UsedStringElement, "string")
+	n.element("CoverageEligibilityResponse.insurance.item.benefit.usedMoney", -1, r.UsedMoney)
+}
+
+// MarshalBSON marshals the given CoverageEligibilityResponseInsuranceItemBenefit as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityResponseInsuranceItemBenefit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityResponseInsuranceItemBenefit from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityResponseInsuranceItemBenefit as protobuf message CoverageEligibilityResponseInsuranceItemBenefit of fhir.proto
+func (r CoverageEligibilityResponseInsuranceItemBenefit) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityResponseInsuranceItemBenefit to the encoded message
+func (r CoverageEligibilityResponseInsuranceItemBenefit) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.AllowedUnsignedInt != nil {
+		e.varint(5, uint64(int64(*r.AllowedUnsignedInt)))
+	}
+	if r.AllowedString != nil {
+		e.string(6, *r.AllowedString)
+	}
+	if r.AllowedMoney != nil {
+		e.message(7, *r.AllowedMoney)
+	}
+	if r.UsedUnsignedInt != nil {
+		e.varint(8, uint64(int64(*r.UsedUnsignedInt)))
+	}
+	if r.UsedString != nil {
+		e.string(9, *r.UsedString)
+	}
+	if r.UsedMoney != nil {
+		e.message(10, *r.UsedMoney)
+	}
+	if r.AllowedUnsignedIntElement != nil {
+		e.message(11, *r.AllowedUnsignedIntElement)
+	}
+	if r.AllowedStringElement != nil {
+		e.message(12, *r.AllowedStringElement)
+	}
+	if r.UsedUnsignedIntElement != nil {
+		e.message(13, *r.UsedUnsignedIntElement)
+	}
+	if r.UsedStringElement != nil {
+		e.message(14, *r.UsedStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityResponseInsuranceItemBenefit from protobuf message CoverageEligibilityResponseInsuranceItemBenefit of fhir.proto
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityResponseInsuranceItemBenefit{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			v := d.int()
+			r.AllowedUnsignedInt = &v
+		case 6:
+			v := d.string()
+			r.AllowedString = &v
+		case 7:
+			var v Money
+			d.message(&v)
+			r.AllowedMoney = &v
+		case 8:
+			v := d.int()
+			r.UsedUnsignedInt = &v
+		case 9:
+			v := d.string()
+			r.UsedString = &v
+		case 10:
+			var v Money
+			d.message(&v)
+			r.UsedMoney = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.AllowedUnsignedIntElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.AllowedStringElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.UsedUnsignedIntElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.UsedStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given CoverageEligibilityResponseError as JSON into a byte slice
+func (r CoverageEligibilityResponseError) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the CoverageEligibilityResponseError as JSON object with the members in the order of the fields
+func (r CoverageEligibilityResponseError) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given CoverageEligibilityResponseError from JSON. Unknown members are ignored.
+func (r *CoverageEligibilityResponseError) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the CoverageEligibilityResponseError
+func (r *CoverageEligibilityResponseError) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the CoverageEligibilityResponseError which shares no memory with the original
+func (r CoverageEligibilityResponseError) DeepCopy() CoverageEligibilityResponseError {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r CoverageEligibilityResponseError) Equal(other CoverageEligibilityResponseError) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r CoverageEligibilityResponseError) EqualsDeep(other CoverageEligibilityResponseError) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r CoverageEligibilityResponseError) EqualsShallow(other CoverageEligibilityResponseError) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given CoverageEligibilityResponseError as FHIR XML
+func (r CoverageEligibilityResponseError) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given CoverageEligibilityResponseError from FHIR XML
+func (r *CoverageEligibilityResponseError) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the CoverageEligibilityResponseError as properties to the RDF node n
+func (r CoverageEligibilityResponseError) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("CoverageEligibilityResponse.error.code", -1, r.Code)
+}
+
+// MarshalBSON marshals the given CoverageEligibilityResponseError as BSON document with the structure of its FHIR JSON
+func (r CoverageEligibilityResponseError) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given CoverageEligibilityResponseError from a BSON document with the structure of its FHIR JSON
+func (r *CoverageEligibilityResponseError) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given CoverageEligibilityResponseError as protobuf message CoverageEligibilityResponseError of fhir.proto
+func (r CoverageEligibilityResponseError) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the CoverageEligibilityResponseError to the encoded message
+func (r CoverageEligibilityResponseError) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+}
+
+// UnmarshalProto unmarshals the given CoverageEligibilityResponseError from protobuf message CoverageEligibilityResponseError of fhir.proto
+func (r *CoverageEligibilityResponseError) UnmarshalProto(b []byte) error {
+	*r = CoverageEligibilityResponseError{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalCoverageEligibilityResponse unmarshals a CoverageEligibilityResponse.
 func UnmarshalCoverageEligibilityResponse(b []byte) (CoverageEligibilityResponse, error) {
 	var coverageEligibilityResponse CoverageEligibilityResponse
-	if err := json.Unmarshal(b, &coverageEligibilityResponse); err != nil {
+	if err := coverageEligibilityResponse.UnmarshalJSON(b); err != nil {
 		return coverageEligibilityResponse, err
 	}
 	return coverageEligibilityResponse, nil
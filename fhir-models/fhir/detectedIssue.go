@@ -14,34 +14,83 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // DetectedIssue is documented here http://hl7.org/fhir/StructureDefinition/DetectedIssue
 type DetectedIssue struct {
-	Id                 *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status             ObservationStatus         `bson:"status" json:"status"`
-	Code               *CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
-	Severity           *DetectedIssueSeverity    `bson:"severity,omitempty" json:"severity,omitempty"`
-	Patient            *Reference                `bson:"patient,omitempty" json:"patient,omitempty"`
-	IdentifiedDateTime *string                   `bson:"identifiedDateTime,omitempty" json:"identifiedDateTime,omitempty"`
-	IdentifiedPeriod   *Period                   `bson:"identifiedPeriod,omitempty" json:"identifiedPeriod,omitempty"`
-	Author             *Reference                `bson:"author,omitempty" json:"author,omitempty"`
-	Implicated         []Reference               `bson:"implicated,omitempty" json:"implicated,omitempty"`
-	Evidence           []DetectedIssueEvidence   `bson:"evidence,omitempty" json:"evidence,omitempty"`
-	Detail             *string                   `bson:"detail,omitempty" json:"detail,omitempty"`
-	Reference          *string                   `bson:"reference,omitempty" json:"reference,omitempty"`
-	Mitigation         []DetectedIssueMitigation `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
+	Id                        *string                   `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element                  `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                      *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                  `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                    ObservationStatus         `bson:"status" json:"status"`
+	StatusElement             *Element                  `bson:"_status,omitempty" json:"_status,omitempty"`
+	Code                      *CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
+	Severity                  *DetectedIssueSeverity    `bson:"severity,omitempty" json:"severity,omitempty"`
+	SeverityElement           *Element                  `bson:"_severity,omitempty" json:"_severity,omitempty"`
+	Patient                   *Reference                `bson:"patient,omitempty" json:"patient,omitempty"`
+	IdentifiedDateTime        *string                   `bson:"identifiedDateTime,omitempty" json:"identifiedDateTime,omitempty"`
+	IdentifiedDateTimeElement *Element                  `bson:"_identifiedDateTime,omitempty" json:"_identifiedDateTime,omitempty"`
+	IdentifiedPeriod          *Period                   `bson:"identifiedPeriod,omitempty" json:"identifiedPeriod,omitempty"`
+	Author                    *Reference                `bson:"author,omitempty" json:"author,omitempty"`
+	Implicated                []Reference               `bson:"implicated,omitempty" json:"implicated,omitempty"`
+	Evidence                  []DetectedIssueEvidence   `bson:"evidence,omitempty" json:"evidence,omitempty"`
+	Detail                    *string                   `bson:"detail,omitempty" json:"detail,omitempty"`
+	DetailElement             *Element                  `bson:"_detail,omitempty" json:"_detail,omitempty"`
+	Reference                 *string                   `bson:"reference,omitempty" json:"reference,omitempty"`
+	ReferenceElement          *Element                  `bson:"_reference,omitempty" json:"_reference,omitempty"`
+	Mitigation                []DetectedIssueMitigation `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
 }
+
+// DetectedIssueIdentified is implemented by the types allowed for DetectedIssue.identified[x]
+type DetectedIssueIdentified interface {
+	isDetectedIssueIdentified()
+}
+
+func (DateTime) isDetectedIssueIdentified() {}
+func (Period) isDetectedIssueIdentified()   {}
+
+// Identified returns the value of DetectedIssue.identified[x] together with its FHIR type code or nil if no value is set
+func (r DetectedIssue) Identified() (DetectedIssueIdentified, string) {
+	if r.IdentifiedDateTime != nil {
+		return DateTime(*r.IdentifiedDateTime), "dateTime"
+	}
+	if r.IdentifiedPeriod != nil {
+		return *r.IdentifiedPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetIdentified sets the value of DetectedIssue.identified[x], given as value or pointer, and clears all other types
+func (r *DetectedIssue) SetIdentified(value DetectedIssueIdentified) {
+	r.IdentifiedDateTime = nil
+	r.IdentifiedPeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.IdentifiedDateTime = &primitive
+	case *DateTime:
+		r.IdentifiedDateTime = (*string)(v)
+	case Period:
+		r.IdentifiedPeriod = &v
+	case *Period:
+		r.IdentifiedPeriod = v
+	}
+}
+
 type DetectedIssueEvidence struct {
 	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -55,25 +104,2133 @@ type DetectedIssueMitigation struct {
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Action            CodeableConcept `bson:"action" json:"action"`
 	Date              *string         `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element        `bson:"_date,omitempty" json:"_date,omitempty"`
 	Author            *Reference      `bson:"author,omitempty" json:"author,omitempty"`
 }
-type OtherDetectedIssue DetectedIssue
 
 // MarshalJSON marshals the given DetectedIssue as JSON into a byte slice
 func (r DetectedIssue) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherDetectedIssue
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherDetectedIssue: OtherDetectedIssue(r),
-		ResourceType:       "DetectedIssue",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DetectedIssue as JSON object with the members in the order of the fields
+func (r DetectedIssue) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"DetectedIssue\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Severity != nil {
+		e.key("severity")
+		e.string(r.Severity.Code())
+	}
+	if r.SeverityElement != nil {
+		e.key("_severity")
+		r.SeverityElement.appendJSON(e)
+	}
+	if r.Patient != nil {
+		e.key("patient")
+		r.Patient.appendJSON(e)
+	}
+	if r.IdentifiedDateTime != nil {
+		e.key("identifiedDateTime")
+		e.string(*r.IdentifiedDateTime)
+	}
+	if r.IdentifiedDateTimeElement != nil {
+		e.key("_identifiedDateTime")
+		r.IdentifiedDateTimeElement.appendJSON(e)
+	}
+	if r.IdentifiedPeriod != nil {
+		e.key("identifiedPeriod")
+		r.IdentifiedPeriod.appendJSON(e)
+	}
+	if r.Author != nil {
+		e.key("author")
+		r.Author.appendJSON(e)
+	}
+	if len(r.Implicated) > 0 {
+		e.key("implicated")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Implicated {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Evidence) > 0 {
+		e.key("evidence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Evidence {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Detail != nil {
+		e.key("detail")
+		e.string(*r.Detail)
+	}
+	if r.DetailElement != nil {
+		e.key("_detail")
+		r.DetailElement.appendJSON(e)
+	}
+	if r.Reference != nil {
+		e.key("reference")
+		e.string(*r.Reference)
+	}
+	if r.ReferenceElement != nil {
+		e.key("_reference")
+		r.ReferenceElement.appendJSON(e)
+	}
+	if len(r.Mitigation) > 0 {
+		e.key("mitigation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Mitigation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DetectedIssue from JSON. Unknown members are ignored.
+func (r *DetectedIssue) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DetectedIssue
+func (r *DetectedIssue) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "severity":
+			if d.null() {
+				r.Severity = nil
+			} else {
+				var v DetectedIssueSeverity
+				d.unmarshal(&v)
+				r.Severity = &v
+			}
+		case "_severity":
+			r.SeverityElement = d.element()
+		case "patient":
+			if d.null() {
+				r.Patient = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Patient = &v
+			}
+		case "identifiedDateTime":
+			if v, ok := d.string(); ok {
+				r.IdentifiedDateTime = &v
+			} else {
+				r.IdentifiedDateTime = nil
+			}
+		case "_identifiedDateTime":
+			r.IdentifiedDateTimeElement = d.element()
+		case "identifiedPeriod":
+			if d.null() {
+				r.IdentifiedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.IdentifiedPeriod = &v
+			}
+		case "author":
+			if d.null() {
+				r.Author = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = &v
+			}
+		case "implicated":
+			a := d.array()
+			r.Implicated = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Implicated = append(r.Implicated, v)
+			}
+			if r.Implicated == nil && !a.isNull {
+				r.Implicated = []Reference{}
+			}
+		case "evidence":
+			a := d.array()
+			r.Evidence = nil
+			for a.next() {
+				var v DetectedIssueEvidence
+				v.decodeJSON(d)
+				r.Evidence = append(r.Evidence, v)
+			}
+			if r.Evidence == nil && !a.isNull {
+				r.Evidence = []DetectedIssueEvidence{}
+			}
+		case "detail":
+			if v, ok := d.string(); ok {
+				r.Detail = &v
+			} else {
+				r.Detail = nil
+			}
+		case "_detail":
+			r.DetailElement = d.element()
+		case "reference":
+			if v, ok := d.string(); ok {
+				r.Reference = &v
+			} else {
+				r.Reference = nil
+			}
+		case "_reference":
+			r.ReferenceElement = d.element()
+		case "mitigation":
+			a := d.array()
+			r.Mitigation = nil
+			for a.next() {
+				var v DetectedIssueMitigation
+				v.decodeJSON(d)
+				r.Mitigation = append(r.Mitigation, v)
+			}
+			if r.Mitigation == nil && !a.isNull {
+				r.Mitigation = []DetectedIssueMitigation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DetectedIssue which shares no memory with the original
+func (r DetectedIssue) DeepCopy() DetectedIssue {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Severity != nil {
+		v := *r.Severity
+		out.Severity = &v
+	}
+	if r.SeverityElement != nil {
+		v := r.SeverityElement.DeepCopy()
+		out.SeverityElement = &v
+	}
+	if r.Patient != nil {
+		v := r.Patient.DeepCopy()
+		out.Patient = &v
+	}
+	if r.IdentifiedDateTime != nil {
+		v := *r.IdentifiedDateTime
+		out.IdentifiedDateTime = &v
+	}
+	if r.IdentifiedDateTimeElement != nil {
+		v := r.IdentifiedDateTimeElement.DeepCopy()
+		out.IdentifiedDateTimeElement = &v
+	}
+	if r.IdentifiedPeriod != nil {
+		v := r.IdentifiedPeriod.DeepCopy()
+		out.IdentifiedPeriod = &v
+	}
+	if r.Author != nil {
+		v := r.Author.DeepCopy()
+		out.Author = &v
+	}
+	if r.Implicated != nil {
+		out.Implicated = make([]Reference, len(r.Implicated))
+		for i := range r.Implicated {
+			out.Implicated[i] = r.Implicated[i].DeepCopy()
+		}
+	}
+	if r.Evidence != nil {
+		out.Evidence = make([]DetectedIssueEvidence, len(r.Evidence))
+		for i := range r.Evidence {
+			out.Evidence[i] = r.Evidence[i].DeepCopy()
+		}
+	}
+	if r.Detail != nil {
+		v := *r.Detail
+		out.Detail = &v
+	}
+	if r.DetailElement != nil {
+		v := r.DetailElement.DeepCopy()
+		out.DetailElement = &v
+	}
+	if r.Reference != nil {
+		v := *r.Reference
+		out.Reference = &v
+	}
+	if r.ReferenceElement != nil {
+		v := r.ReferenceElement.DeepCopy()
+		out.ReferenceElement = &v
+	}
+	if r.Mitigation != nil {
+		out.Mitigation = make([]DetectedIssueMitigation, len(r.Mitigation))
+		for i := range r.Mitigation {
+			out.Mitigation[i] = r.Mitigation[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DetectedIssue) Equal(other DetectedIssue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && *r.Severity != *other.Severity {
+		return false
+	}
+	if (r.SeverityElement == nil) != (other.SeverityElement == nil) || r.SeverityElement != nil && !r.SeverityElement.Equal(*other.SeverityElement) {
+		return false
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.Equal(*other.Patient) {
+		return false
+	}
+	if (r.IdentifiedDateTime == nil) != (other.IdentifiedDateTime == nil) || r.IdentifiedDateTime != nil && *r.IdentifiedDateTime != *other.IdentifiedDateTime {
+		return false
+	}
+	if (r.IdentifiedDateTimeElement == nil) != (other.IdentifiedDateTimeElement == nil) || r.IdentifiedDateTimeElement != nil && !r.IdentifiedDateTimeElement.Equal(*other.IdentifiedDateTimeElement) {
+		return false
+	}
+	if (r.IdentifiedPeriod == nil) != (other.IdentifiedPeriod == nil) || r.IdentifiedPeriod != nil && !r.IdentifiedPeriod.Equal(*other.IdentifiedPeriod) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.Equal(*other.Author) {
+		return false
+	}
+	if len(r.Implicated) != len(other.Implicated) {
+		return false
+	}
+	for i := range r.Implicated {
+		if !r.Implicated[i].Equal(other.Implicated[i]) {
+			return false
+		}
+	}
+	if len(r.Evidence) != len(other.Evidence) {
+		return false
+	}
+	for i := range r.Evidence {
+		if !r.Evidence[i].Equal(other.Evidence[i]) {
+			return false
+		}
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && *r.Detail != *other.Detail {
+		return false
+	}
+	if (r.DetailElement == nil) != (other.DetailElement == nil) || r.DetailElement != nil && !r.DetailElement.Equal(*other.DetailElement) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && *r.Reference != *other.Reference {
+		return false
+	}
+	if (r.ReferenceElement == nil) != (other.ReferenceElement == nil) || r.ReferenceElement != nil && !r.ReferenceElement.Equal(*other.ReferenceElement) {
+		return false
+	}
+	if len(r.Mitigation) != len(other.Mitigation) {
+		return false
+	}
+	for i := range r.Mitigation {
+		if !r.Mitigation[i].Equal(other.Mitigation[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DetectedIssue) EqualsDeep(other DetectedIssue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && *r.Severity != *other.Severity {
+		return false
+	}
+	if (r.SeverityElement == nil) != (other.SeverityElement == nil) || r.SeverityElement != nil && !r.SeverityElement.EqualsDeep(*other.SeverityElement) {
+		return false
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.EqualsDeep(*other.Patient) {
+		return false
+	}
+	if (r.IdentifiedDateTime == nil) != (other.IdentifiedDateTime == nil) || r.IdentifiedDateTime != nil && !equivalentString(*r.IdentifiedDateTime, *other.IdentifiedDateTime) {
+		return false
+	}
+	if (r.IdentifiedDateTimeElement == nil) != (other.IdentifiedDateTimeElement == nil) || r.IdentifiedDateTimeElement != nil && !r.IdentifiedDateTimeElement.EqualsDeep(*other.IdentifiedDateTimeElement) {
+		return false
+	}
+	if (r.IdentifiedPeriod == nil) != (other.IdentifiedPeriod == nil) || r.IdentifiedPeriod != nil && !r.IdentifiedPeriod.EqualsDeep(*other.IdentifiedPeriod) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.EqualsDeep(*other.Author) {
+		return false
+	}
+	if !equivalentList(len(r.Implicated), len(other.Implicated), func(i, j int) bool {
+		return r.Implicated[i].EqualsDeep(other.Implicated[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Evidence), len(other.Evidence), func(i, j int) bool {
+		return r.Evidence[i].EqualsDeep(other.Evidence[j])
+	}) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !equivalentString(*r.Detail, *other.Detail) {
+		return false
+	}
+	if (r.DetailElement == nil) != (other.DetailElement == nil) || r.DetailElement != nil && !r.DetailElement.EqualsDeep(*other.DetailElement) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !equivalentString(*r.Reference, *other.Reference) {
+		return false
+	}
+	if (r.ReferenceElement == nil) != (other.ReferenceElement == nil) || r.ReferenceElement != nil && !r.ReferenceElement.EqualsDeep(*other.ReferenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.Mitigation), len(other.Mitigation), func(i, j int) bool {
+		return r.Mitigation[i].EqualsDeep(other.Mitigation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DetectedIssue) EqualsShallow(other DetectedIssue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Severity == nil) != (other.Severity == nil) || r.Severity != nil && *r.Severity != *other.Severity {
+		return false
+	}
+	if (r.IdentifiedDateTime == nil) != (other.IdentifiedDateTime == nil) || r.IdentifiedDateTime != nil && !equivalentString(*r.IdentifiedDateTime, *other.IdentifiedDateTime) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !equivalentString(*r.Detail, *other.Detail) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !equivalentString(*r.Reference, *other.Reference) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DetectedIssue as FHIR XML
+func (r DetectedIssue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "DetectedIssue",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "severity", r.Severity, r.SeverityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "identifiedDateTime", r.IdentifiedDateTime, r.IdentifiedDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifiedPeriod", r.IdentifiedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "author", r.Author); err != nil {
+		return err
+	}
+	for _, v := range r.Implicated {
+		if err := encodeXMLElement(e, "implicated", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Evidence {
+		if err := encodeXMLElement(e, "evidence", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "detail", r.Detail, r.DetailElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "reference", r.Reference, r.ReferenceElement); err != nil {
+		return err
+	}
+	for _, v := range r.Mitigation {
+		if err := encodeXMLElement(e, "mitigation", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DetectedIssue from FHIR XML
+func (r *DetectedIssue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v ObservationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "severity":
+				var v DetectedIssueSeverity
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Severity = &v
+				}
+				r.SeverityElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = &v
+			case "identifiedDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IdentifiedDateTime = &v
+				}
+				r.IdentifiedDateTimeElement = element
+			case "identifiedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.IdentifiedPeriod = &v
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = &v
+			case "implicated":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Implicated = append(r.Implicated, v)
+			case "evidence":
+				var v DetectedIssueEvidence
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Evidence = append(r.Evidence, v)
+			case "detail":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Detail = &v
+				}
+				r.DetailElement = element
+			case "reference":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Reference = &v
+				}
+				r.ReferenceElement = element
+			case "mitigation":
+				var v DetectedIssueMitigation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Mitigation = append(r.Mitigation, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DetectedIssue as properties to the RDF node n
+func (r DetectedIssue) turtle(n *turtleNode) {
+	n.resource("DetectedIssue", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("DetectedIssue.identifier", i, v)
+	}
+	n.primitive("DetectedIssue.status", -1, r.Status, r.StatusElement, "code")
+	n.element("DetectedIssue.code", -1, r.Code)
+	n.primitive("DetectedIssue.severity", -1, r.Severity, r.SeverityElement, "code")
+	n.element("DetectedIssue.patient", -1, r.Patient)
+	n.primitive("DetectedIssue.identifiedDateTime", -1, r.IdentifiedDateTime, r.IdentifiedDateTimeElement, "dateTime")
+	n.element("DetectedIssue.identifiedPeriod", -1, r.IdentifiedPeriod)
+	n.element("DetectedIssue.author", -1, r.Author)
+	for i, v := range r.Implicated {
+		n.element("DetectedIssue.implicated", i, v)
+	}
+	for i, v := range r.Evidence {
+		n.element("DetectedIssue.evidence", i, v)
+	}
+	n.primitive("DetectedIssue.detail", -1, r.Detail, r.DetailElement, "string")
+	n.primitive("DetectedIssue.reference", -1, r.Reference, r.ReferenceElement, "string")
+	for i, v := range r.Mitigation {
+		n.element("DetectedIssue.mitigation", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DetectedIssue as BSON document with the structure of its FHIR JSON
+func (r DetectedIssue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DetectedIssue from a BSON document with the structure of its FHIR JSON
+func (r *DetectedIssue) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DetectedIssue as protobuf message DetectedIssue of fhir.proto
+func (r DetectedIssue) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DetectedIssue to the encoded message
+func (r DetectedIssue) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.Code != nil {
+		e.message(11, *r.Code)
+	}
+	if r.Severity != nil {
+		e.varint(12, r.Severity.protoNumber())
+	}
+	if r.Patient != nil {
+		e.message(13, *r.Patient)
+	}
+	if r.IdentifiedDateTime != nil {
+		e.string(14, *r.IdentifiedDateTime)
+	}
+	if r.IdentifiedPeriod != nil {
+		e.message(15, *r.IdentifiedPeriod)
+	}
+	if r.Author != nil {
+		e.message(16, *r.Author)
+	}
+	for _, v := range r.Implicated {
+		e.message(17, v)
+	}
+	for _, v := range r.Evidence {
+		e.message(18, v)
+	}
+	if r.Detail != nil {
+		e.string(19, *r.Detail)
+	}
+	if r.Reference != nil {
+		e.string(20, *r.Reference)
+	}
+	for _, v := range r.Mitigation {
+		e.message(21, v)
+	}
+	if r.IdElement != nil {
+		e.message(22, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(23, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(24, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(25, *r.StatusElement)
+	}
+	if r.SeverityElement != nil {
+		e.message(26, *r.SeverityElement)
+	}
+	if r.IdentifiedDateTimeElement != nil {
+		e.message(27, *r.IdentifiedDateTimeElement)
+	}
+	if r.DetailElement != nil {
+		e.message(28, *r.DetailElement)
+	}
+	if r.ReferenceElement != nil {
+		e.message(29, *r.ReferenceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DetectedIssue from protobuf message DetectedIssue of fhir.proto
+func (r *DetectedIssue) UnmarshalProto(b []byte) error {
+	*r = DetectedIssue{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v ObservationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 12:
+			var v DetectedIssueSeverity
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Severity = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Patient = &v
+		case 14:
+			v := d.string()
+			r.IdentifiedDateTime = &v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.IdentifiedPeriod = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Author = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Implicated = append(r.Implicated, v)
+		case 18:
+			var v DetectedIssueEvidence
+			d.message(&v)
+			r.Evidence = append(r.Evidence, v)
+		case 19:
+			v := d.string()
+			r.Detail = &v
+		case 20:
+			v := d.string()
+			r.Reference = &v
+		case 21:
+			var v DetectedIssueMitigation
+			d.message(&v)
+			r.Mitigation = append(r.Mitigation, v)
+		case 22:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.SeverityElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.IdentifiedDateTimeElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.DetailElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.ReferenceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DetectedIssueEvidence as JSON into a byte slice
+func (r DetectedIssueEvidence) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DetectedIssueEvidence as JSON object with the members in the order of the fields
+func (r DetectedIssueEvidence) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DetectedIssueEvidence from JSON. Unknown members are ignored.
+func (r *DetectedIssueEvidence) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DetectedIssueEvidence
+func (r *DetectedIssueEvidence) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DetectedIssueEvidence which shares no memory with the original
+func (r DetectedIssueEvidence) DeepCopy() DetectedIssueEvidence {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Detail != nil {
+		out.Detail = make([]Reference, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DetectedIssueEvidence) Equal(other DetectedIssueEvidence) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DetectedIssueEvidence) EqualsDeep(other DetectedIssueEvidence) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DetectedIssueEvidence) EqualsShallow(other DetectedIssueEvidence) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DetectedIssueEvidence as FHIR XML
+func (r DetectedIssueEvidence) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DetectedIssueEvidence from FHIR XML
+func (r *DetectedIssueEvidence) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "detail":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DetectedIssueEvidence as properties to the RDF node n
+func (r DetectedIssueEvidence) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Code {
+		n.element("DetectedIssue.evidence.code", i, v)
+	}
+	for i, v := range r.Detail {
+		n.element("DetectedIssue.evidence.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DetectedIssueEvidence as BSON document with the structure of its FHIR JSON
+func (r DetectedIssueEvidence) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DetectedIssueEvidence from a BSON document with the structure of its FHIR JSON
+func (r *DetectedIssueEvidence) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DetectedIssueEvidence as protobuf message DetectedIssueEvidence of fhir.proto
+func (r DetectedIssueEvidence) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DetectedIssueEvidence to the encoded message
+func (r DetectedIssueEvidence) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Code {
+		e.message(4, v)
+	}
+	for _, v := range r.Detail {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given DetectedIssueEvidence from protobuf message DetectedIssueEvidence of fhir.proto
+func (r *DetectedIssueEvidence) UnmarshalProto(b []byte) error {
+	*r = DetectedIssueEvidence{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DetectedIssueMitigation as JSON into a byte slice
+func (r DetectedIssueMitigation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DetectedIssueMitigation as JSON object with the members in the order of the fields
+func (r DetectedIssueMitigation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("action")
+	r.Action.appendJSON(e)
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Author != nil {
+		e.key("author")
+		r.Author.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DetectedIssueMitigation from JSON. Unknown members are ignored.
+func (r *DetectedIssueMitigation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DetectedIssueMitigation
+func (r *DetectedIssueMitigation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "action":
+			r.Action.decodeJSON(d)
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "author":
+			if d.null() {
+				r.Author = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DetectedIssueMitigation which shares no memory with the original
+func (r DetectedIssueMitigation) DeepCopy() DetectedIssueMitigation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Action = r.Action.DeepCopy()
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Author != nil {
+		v := r.Author.DeepCopy()
+		out.Author = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DetectedIssueMitigation) Equal(other DetectedIssueMitigation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Action.Equal(other.Action) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.Equal(*other.Author) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DetectedIssueMitigation) EqualsDeep(other DetectedIssueMitigation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Action.EqualsDeep(other.Action) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.EqualsDeep(*other.Author) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DetectedIssueMitigation) EqualsShallow(other DetectedIssueMitigation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DetectedIssueMitigation as FHIR XML
+func (r DetectedIssueMitigation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "action", r.Action); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "author", r.Author); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DetectedIssueMitigation from FHIR XML
+func (r *DetectedIssueMitigation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "action":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DetectedIssueMitigation as properties to the RDF node n
+func (r DetectedIssueMitigation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("DetectedIssue.mitigation.action", -1, r.Action)
+	n.primitive("DetectedIssue.mitigation.date", -1, r.Date, r.DateElement, "dateTime")
+	n.element("DetectedIssue.mitigation.author", -1, r.Author)
+}
+
+// MarshalBSON marshals the given DetectedIssueMitigation as BSON document with the structure of its FHIR JSON
+func (r DetectedIssueMitigation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DetectedIssueMitigation from a BSON document with the structure of its FHIR JSON
+func (r *DetectedIssueMitigation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DetectedIssueMitigation as protobuf message DetectedIssueMitigation of fhir.proto
+func (r DetectedIssueMitigation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DetectedIssueMitigation to the encoded message
+func (r DetectedIssueMitigation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Action)
+	if r.Date != nil {
+		e.string(5, *r.Date)
+	}
+	if r.Author != nil {
+		e.message(6, *r.Author)
+	}
+	if r.DateElement != nil {
+		e.message(7, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DetectedIssueMitigation from protobuf message DetectedIssueMitigation of fhir.proto
+func (r *DetectedIssueMitigation) UnmarshalProto(b []byte) error {
+	*r = DetectedIssueMitigation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Action = v
+		case 5:
+			v := d.string()
+			r.Date = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Author = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalDetectedIssue unmarshals a DetectedIssue.
 func UnmarshalDetectedIssue(b []byte) (DetectedIssue, error) {
 	var detectedIssue DetectedIssue
-	if err := json.Unmarshal(b, &detectedIssue); err != nil {
+	if err := detectedIssue.UnmarshalJSON(b); err != nil {
 		return detectedIssue, err
 	}
 	return detectedIssue, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code DetectedIssueSeverity) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DetectedIssueSeverity) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DetectedIssueSeverity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "high":
 		*code = DetectedIssueSeverityHigh
@@ -49,6 +53,12 @@ func (code *DetectedIssueSeverity) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DetectedIssueSeverity) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DetectedIssueSeverity) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DetectedIssueSeverity) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code DetectedIssueSeverity) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DetectedIssueSeverity) protoNumber() uint64 {
+	switch code {
+	case DetectedIssueSeverityHigh:
+		return 1
+	case DetectedIssueSeverityModerate:
+		return 2
+	case DetectedIssueSeverityLow:
+		return 3
+	}
+	return 0
+}
+func (code *DetectedIssueSeverity) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DetectedIssueSeverityHigh
+	case 2:
+		*code = DetectedIssueSeverityModerate
+	case 3:
+		*code = DetectedIssueSeverityLow
+	default:
+		return false
+	}
+	return true
+}
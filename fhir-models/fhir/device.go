@@ -14,64 +14,89 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Device is documented here http://hl7.org/fhir/StructureDefinition/Device
 type Device struct {
-	Id                 *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Definition         *Reference             `bson:"definition,omitempty" json:"definition,omitempty"`
-	UdiCarrier         []DeviceUdiCarrier     `bson:"udiCarrier,omitempty" json:"udiCarrier,omitempty"`
-	Status             *FHIRDeviceStatus      `bson:"status,omitempty" json:"status,omitempty"`
-	StatusReason       []CodeableConcept      `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	DistinctIdentifier *string                `bson:"distinctIdentifier,omitempty" json:"distinctIdentifier,omitempty"`
-	Manufacturer       *string                `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	ManufactureDate    *string                `bson:"manufactureDate,omitempty" json:"manufactureDate,omitempty"`
-	ExpirationDate     *string                `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
-	LotNumber          *string                `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
-	SerialNumber       *string                `bson:"serialNumber,omitempty" json:"serialNumber,omitempty"`
-	DeviceName         []DeviceDeviceName     `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
-	ModelNumber        *string                `bson:"modelNumber,omitempty" json:"modelNumber,omitempty"`
-	PartNumber         *string                `bson:"partNumber,omitempty" json:"partNumber,omitempty"`
-	Type               *CodeableConcept       `bson:"type,omitempty" json:"type,omitempty"`
-	Specialization     []DeviceSpecialization `bson:"specialization,omitempty" json:"specialization,omitempty"`
-	Version            []DeviceVersion        `bson:"version,omitempty" json:"version,omitempty"`
-	Property           []DeviceProperty       `bson:"property,omitempty" json:"property,omitempty"`
-	Patient            *Reference             `bson:"patient,omitempty" json:"patient,omitempty"`
-	Owner              *Reference             `bson:"owner,omitempty" json:"owner,omitempty"`
-	Contact            []ContactPoint         `bson:"contact,omitempty" json:"contact,omitempty"`
-	Location           *Reference             `bson:"location,omitempty" json:"location,omitempty"`
-	Url                *string                `bson:"url,omitempty" json:"url,omitempty"`
-	Note               []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
-	Safety             []CodeableConcept      `bson:"safety,omitempty" json:"safety,omitempty"`
-	Parent             *Reference             `bson:"parent,omitempty" json:"parent,omitempty"`
+	Id                        *string                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element               `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                      *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Definition                *Reference             `bson:"definition,omitempty" json:"definition,omitempty"`
+	UdiCarrier                []DeviceUdiCarrier     `bson:"udiCarrier,omitempty" json:"udiCarrier,omitempty"`
+	Status                    *FHIRDeviceStatus      `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement             *Element               `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason              []CodeableConcept      `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	DistinctIdentifier        *string                `bson:"distinctIdentifier,omitempty" json:"distinctIdentifier,omitempty"`
+	DistinctIdentifierElement *Element               `bson:"_distinctIdentifier,omitempty" json:"_distinctIdentifier,omitempty"`
+	Manufacturer              *string                `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	ManufacturerElement       *Element               `bson:"_manufacturer,omitempty" json:"_manufacturer,omitempty"`
+	ManufactureDate           *string                `bson:"manufactureDate,omitempty" json:"manufactureDate,omitempty"`
+	ManufactureDateElement    *Element               `bson:"_manufactureDate,omitempty" json:"_manufactureDate,omitempty"`
+	ExpirationDate            *string                `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+	ExpirationDateElement     *Element               `bson:"_expirationDate,omitempty" json:"_expirationDate,omitempty"`
+	LotNumber                 *string                `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
+	LotNumberElement          *Element               `bson:"_lotNumber,omitempty" json:"_lotNumber,omitempty"`
+	SerialNumber              *string                `bson:"serialNumber,omitempty" json:"serialNumber,omitempty"`
+	SerialNumberElement       *Element               `bson:"_serialNumber,omitempty" json:"_serialNumber,omitempty"`
+	DeviceName                []DeviceDeviceName     `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
+	ModelNumber               *string                `bson:"modelNumber,omitempty" json:"modelNumber,omitempty"`
+	ModelNumberElement        *Element               `bson:"_modelNumber,omitempty" json:"_modelNumber,omitempty"`
+	PartNumber                *string                `bson:"partNumber,omitempty" json:"partNumber,omitempty"`
+	PartNumberElement         *Element               `bson:"_partNumber,omitempty" json:"_partNumber,omitempty"`
+	Type                      *CodeableConcept       `bson:"type,omitempty" json:"type,omitempty"`
+	Specialization            []DeviceSpecialization `bson:"specialization,omitempty" json:"specialization,omitempty"`
+	Version                   []DeviceVersion        `bson:"version,omitempty" json:"version,omitempty"`
+	Property                  []DeviceProperty       `bson:"property,omitempty" json:"property,omitempty"`
+	Patient                   *Reference             `bson:"patient,omitempty" json:"patient,omitempty"`
+	Owner                     *Reference             `bson:"owner,omitempty" json:"owner,omitempty"`
+	Contact                   []ContactPoint         `bson:"contact,omitempty" json:"contact,omitempty"`
+	Location                  *Reference             `bson:"location,omitempty" json:"location,omitempty"`
+	Url                       *string                `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement                *Element               `bson:"_url,omitempty" json:"_url,omitempty"`
+	Note                      []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
+	Safety                    []CodeableConcept      `bson:"safety,omitempty" json:"safety,omitempty"`
+	Parent                    *Reference             `bson:"parent,omitempty" json:"parent,omitempty"`
 }
 type DeviceUdiCarrier struct {
-	Id                *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DeviceIdentifier  *string       `bson:"deviceIdentifier,omitempty" json:"deviceIdentifier,omitempty"`
-	Issuer            *string       `bson:"issuer,omitempty" json:"issuer,omitempty"`
-	Jurisdiction      *string       `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	CarrierAIDC       *string       `bson:"carrierAIDC,omitempty" json:"carrierAIDC,omitempty"`
-	CarrierHRF        *string       `bson:"carrierHRF,omitempty" json:"carrierHRF,omitempty"`
-	EntryType         *UDIEntryType `bson:"entryType,omitempty" json:"entryType,omitempty"`
+	Id                      *string       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DeviceIdentifier        *string       `bson:"deviceIdentifier,omitempty" json:"deviceIdentifier,omitempty"`
+	DeviceIdentifierElement *Element      `bson:"_deviceIdentifier,omitempty" json:"_deviceIdentifier,omitempty"`
+	Issuer                  *string       `bson:"issuer,omitempty" json:"issuer,omitempty"`
+	IssuerElement           *Element      `bson:"_issuer,omitempty" json:"_issuer,omitempty"`
+	Jurisdiction            *string       `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	JurisdictionElement     *Element      `bson:"_jurisdiction,omitempty" json:"_jurisdiction,omitempty"`
+	CarrierAIDC             *string       `bson:"carrierAIDC,omitempty" json:"carrierAIDC,omitempty"`
+	CarrierAIDCElement      *Element      `bson:"_carrierAIDC,omitempty" json:"_carrierAIDC,omitempty"`
+	CarrierHRF              *string       `bson:"carrierHRF,omitempty" json:"carrierHRF,omitempty"`
+	CarrierHRFElement       *Element      `bson:"_carrierHRF,omitempty" json:"_carrierHRF,omitempty"`
+	EntryType               *UDIEntryType `bson:"entryType,omitempty" json:"entryType,omitempty"`
+	EntryTypeElement        *Element      `bson:"_entryType,omitempty" json:"_entryType,omitempty"`
 }
 type DeviceDeviceName struct {
 	Id                *string        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              string         `bson:"name" json:"name"`
+	NameElement       *Element       `bson:"_name,omitempty" json:"_name,omitempty"`
 	Type              DeviceNameType `bson:"type" json:"type"`
+	TypeElement       *Element       `bson:"_type,omitempty" json:"_type,omitempty"`
 }
 type DeviceSpecialization struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
@@ -79,6 +104,7 @@ type DeviceSpecialization struct {
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	SystemType        CodeableConcept `bson:"systemType" json:"systemType"`
 	Version           *string         `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element        `bson:"_version,omitempty" json:"_version,omitempty"`
 }
 type DeviceVersion struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -87,6 +113,7 @@ type DeviceVersion struct {
 	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	Component         *Identifier      `bson:"component,omitempty" json:"component,omitempty"`
 	Value             string           `bson:"value" json:"value"`
+	ValueElement      *Element         `bson:"_value,omitempty" json:"_value,omitempty"`
 }
 type DeviceProperty struct {
 	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
@@ -96,23 +123,4397 @@ type DeviceProperty struct {
 	ValueQuantity     []Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
 	ValueCode         []CodeableConcept `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
 }
-type OtherDevice Device
 
 // MarshalJSON marshals the given Device as JSON into a byte slice
 func (r Device) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherDevice
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherDevice:  OtherDevice(r),
-		ResourceType: "Device",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Device as JSON object with the members in the order of the fields
+func (r Device) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Device\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Definition != nil {
+		e.key("definition")
+		r.Definition.appendJSON(e)
+	}
+	if len(r.UdiCarrier) > 0 {
+		e.key("udiCarrier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UdiCarrier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.StatusReason) > 0 {
+		e.key("statusReason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.StatusReason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DistinctIdentifier != nil {
+		e.key("distinctIdentifier")
+		e.string(*r.DistinctIdentifier)
+	}
+	if r.DistinctIdentifierElement != nil {
+		e.key("_distinctIdentifier")
+		r.DistinctIdentifierElement.appendJSON(e)
+	}
+	if r.Manufacturer != nil {
+		e.key("manufacturer")
+		e.string(*r.Manufacturer)
+	}
+	if r.ManufacturerElement != nil {
+		e.key("_manufacturer")
+		r.ManufacturerElement.appendJSON(e)
+	}
+	if r.ManufactureDate != nil {
+		e.key("manufactureDate")
+		e.string(*r.ManufactureDate)
+	}
+	if r.ManufactureDateElement != nil {
+		e.key("_manufactureDate")
+		r.ManufactureDateElement.appendJSON(e)
+	}
+	if r.ExpirationDate != nil {
+		e.key("expirationDate")
+		e.string(*r.ExpirationDate)
+	}
+	if r.ExpirationDateElement != nil {
+		e.key("_expirationDate")
+		r.ExpirationDateElement.appendJSON(e)
+	}
+	if r.LotNumber != nil {
+		e.key("lotNumber")
+		e.string(*r.LotNumber)
+	}
+	if r.LotNumberElement != nil {
+		e.key("_lotNumber")
+		r.LotNumberElement.appendJSON(e)
+	}
+	if r.SerialNumber != nil {
+		e.key("serialNumber")
+		e.string(*r.SerialNumber)
+	}
+	if r.SerialNumberElement != nil {
+		e.key("_serialNumber")
+		r.SerialNumberElement.appendJSON(e)
+	}
+	if len(r.DeviceName) > 0 {
+		e.key("deviceName")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DeviceName {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ModelNumber != nil {
+		e.key("modelNumber")
+		e.string(*r.ModelNumber)
+	}
+	if r.ModelNumberElement != nil {
+		e.key("_modelNumber")
+		r.ModelNumberElement.appendJSON(e)
+	}
+	if r.PartNumber != nil {
+		e.key("partNumber")
+		e.string(*r.PartNumber)
+	}
+	if r.PartNumberElement != nil {
+		e.key("_partNumber")
+		r.PartNumberElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.Specialization) > 0 {
+		e.key("specialization")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Specialization {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Version) > 0 {
+		e.key("version")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Version {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Property) > 0 {
+		e.key("property")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Property {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Patient != nil {
+		e.key("patient")
+		r.Patient.appendJSON(e)
+	}
+	if r.Owner != nil {
+		e.key("owner")
+		r.Owner.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Safety) > 0 {
+		e.key("safety")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Safety {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Parent != nil {
+		e.key("parent")
+		r.Parent.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Device from JSON. Unknown members are ignored.
+func (r *Device) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Device
+func (r *Device) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "definition":
+			if d.null() {
+				r.Definition = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Definition = &v
+			}
+		case "udiCarrier":
+			a := d.array()
+			r.UdiCarrier = nil
+			for a.next() {
+				var v DeviceUdiCarrier
+				v.decodeJSON(d)
+				r.UdiCarrier = append(r.UdiCarrier, v)
+			}
+			if r.UdiCarrier == nil && !a.isNull {
+				r.UdiCarrier = []DeviceUdiCarrier{}
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v FHIRDeviceStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			a := d.array()
+			r.StatusReason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = append(r.StatusReason, v)
+			}
+			if r.StatusReason == nil && !a.isNull {
+				r.StatusReason = []CodeableConcept{}
+			}
+		case "distinctIdentifier":
+			if v, ok := d.string(); ok {
+				r.DistinctIdentifier = &v
+			} else {
+				r.DistinctIdentifier = nil
+			}
+		case "_distinctIdentifier":
+			r.DistinctIdentifierElement = d.element()
+		case "manufacturer":
+			if v, ok := d.string(); ok {
+				r.Manufacturer = &v
+			} else {
+				r.Manufacturer = nil
+			}
+		case "_manufacturer":
+			r.ManufacturerElement = d.element()
+		case "manufactureDate":
+			if v, ok := d.string(); ok {
+				r.ManufactureDate = &v
+			} else {
+				r.ManufactureDate = nil
+			}
+		case "_manufactureDate":
+			r.ManufactureDateElement = d.element()
+		case "expirationDate":
+			if v, ok := d.string(); ok {
+				r.ExpirationDate = &v
+			} else {
+				r.ExpirationDate = nil
+			}
+		case "_expirationDate":
+			r.ExpirationDateElement = d.element()
+		case "lotNumber":
+			if v, ok := d.string(); ok {
+				r.LotNumber = &v
+			} else {
+				r.LotNumber = nil
+			}
+		case "_lotNumber":
+			r.LotNumberElement = d.element()
+		case "serialNumber":
+			if v, ok := d.string(); ok {
+				r.SerialNumber = &v
+			} else {
+				r.SerialNumber = nil
+			}
+		case "_serialNumber":
+			r.SerialNumberElement = d.element()
+		case "deviceName":
+			a := d.array()
+			r.DeviceName = nil
+			for a.next() {
+				var v DeviceDeviceName
+				v.decodeJSON(d)
+				r.DeviceName = append(r.DeviceName, v)
+			}
+			if r.DeviceName == nil && !a.isNull {
+				r.DeviceName = []DeviceDeviceName{}
+			}
+		case "modelNumber":
+			if v, ok := d.string(); ok {
+				r.ModelNumber = &v
+			} else {
+				r.ModelNumber = nil
+			}
+		case "_modelNumber":
+			r.ModelNumberElement = d.element()
+		case "partNumber":
+			if v, ok := d.string(); ok {
+				r.PartNumber = &v
+			} else {
+				r.PartNumber = nil
+			}
+		case "_partNumber":
+			r.PartNumberElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "specialization":
+			a := d.array()
+			r.Specialization = nil
+			for a.next() {
+				var v DeviceSpecialization
+				v.decodeJSON(d)
+				r.Specialization = append(r.Specialization, v)
+			}
+			if r.Specialization == nil && !a.isNull {
+				r.Specialization = []DeviceSpecialization{}
+			}
+		case "version":
+			a := d.array()
+			r.Version = nil
+			for a.next() {
+				var v DeviceVersion
+				v.decodeJSON(d)
+				r.Version = append(r.Version, v)
+			}
+			if r.Version == nil && !a.isNull {
+				r.Version = []DeviceVersion{}
+			}
+		case "property":
+			a := d.array()
+			r.Property = nil
+			for a.next() {
+				var v DeviceProperty
+				v.decodeJSON(d)
+				r.Property = append(r.Property, v)
+			}
+			if r.Property == nil && !a.isNull {
+				r.Property = []DeviceProperty{}
+			}
+		case "patient":
+			if d.null() {
+				r.Patient = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Patient = &v
+			}
+		case "owner":
+			if d.null() {
+				r.Owner = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Owner = &v
+			}
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactPoint{}
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "safety":
+			a := d.array()
+			r.Safety = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Safety = append(r.Safety, v)
+			}
+			if r.Safety == nil && !a.isNull {
+				r.Safety = []CodeableConcept{}
+			}
+		case "parent":
+			if d.null() {
+				r.Parent = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Parent = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Device which shares no memory with the original
+func (r Device) DeepCopy() Device {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Definition != nil {
+		v := r.Definition.DeepCopy()
+		out.Definition = &v
+	}
+	if r.UdiCarrier != nil {
+		out.UdiCarrier = make([]DeviceUdiCarrier, len(r.UdiCarrier))
+		for i := range r.UdiCarrier {
+			out.UdiCarrier[i] = r.UdiCarrier[i].DeepCopy()
+		}
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		out.StatusReason = make([]CodeableConcept, len(r.StatusReason))
+		for i := range r.StatusReason {
+			out.StatusReason[i] = r.StatusReason[i].DeepCopy()
+		}
+	}
+	if r.DistinctIdentifier != nil {
+		v := *r.DistinctIdentifier
+		out.DistinctIdentifier = &v
+	}
+	if r.DistinctIdentifierElement != nil {
+		v := r.DistinctIdentifierElement.DeepCopy()
+		out.DistinctIdentifierElement = &v
+	}
+	if r.Manufacturer != nil {
+		v := *r.Manufacturer
+		out.Manufacturer = &v
+	}
+	if r.ManufacturerElement != nil {
+		v := r.ManufacturerElement.DeepCopy()
+		out.ManufacturerElement = &v
+	}
+	if r.ManufactureDate != nil {
+		v := *r.ManufactureDate
+		out.ManufactureDate = &v
+	}
+	if r.ManufactureDateElement != nil {
+		v := r.ManufactureDateElement.DeepCopy()
+		out.ManufactureDateElement = &v
+	}
+	if r.ExpirationDate != nil {
+		v := *r.ExpirationDate
+		out.ExpirationDate = &v
+	}
+	if r.ExpirationDateElement != nil {
+		v := r.ExpirationDateElement.DeepCopy()
+		out.ExpirationDateElement = &v
+	}
+	if r.LotNumber != nil {
+		v := *r.LotNumber
+		out.LotNumber = &v
+	}
+	if r.LotNumberElement != nil {
+		v := r.LotNumberElement.DeepCopy()
+		out.LotNumberElement = &v
+	}
+	if r.SerialNumber != nil {
+		v := *r.SerialNumber
+		out.SerialNumber = &v
+	}
+	if r.SerialNumberElement != nil {
+		v := r.SerialNumberElement.DeepCopy()
+		out.SerialNumberElement = &v
+	}
+	if r.DeviceName != nil {
+		out.DeviceName = make([]DeviceDeviceName, len(r.DeviceName))
+		for i := range r.DeviceName {
+			out.DeviceName[i] = r.DeviceName[i].DeepCopy()
+		}
+	}
+	if r.ModelNumber != nil {
+		v := *r.ModelNumber
+		out.ModelNumber = &v
+	}
+	if r.ModelNumberElement != nil {
+		v := r.ModelNumberElement.DeepCopy()
+		out.ModelNumberElement = &v
+	}
+	if r.PartNumber != nil {
+		v := *r.PartNumber
+		out.PartNumber = &v
+	}
+	if r.PartNumberElement != nil {
+		v := r.PartNumberElement.DeepCopy()
+		out.PartNumberElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Specialization != nil {
+		out.Specialization = make([]DeviceSpecialization, len(r.Specialization))
+		for i := range r.Specialization {
+			out.Specialization[i] = r.Specialization[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		out.Version = make([]DeviceVersion, len(r.Version))
+		for i := range r.Version {
+			out.Version[i] = r.Version[i].DeepCopy()
+		}
+	}
+	if r.Property != nil {
+		out.Property = make([]DeviceProperty, len(r.Property))
+		for i := range r.Property {
+			out.Property[i] = r.Property[i].DeepCopy()
+		}
+	}
+	if r.Patient != nil {
+		v := r.Patient.DeepCopy()
+		out.Patient = &v
+	}
+	if r.Owner != nil {
+		v := r.Owner.DeepCopy()
+		out.Owner = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactPoint, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.Safety != nil {
+		out.Safety = make([]CodeableConcept, len(r.Safety))
+		for i := range r.Safety {
+			out.Safety[i] = r.Safety[i].DeepCopy()
+		}
+	}
+	if r.Parent != nil {
+		v := r.Parent.DeepCopy()
+		out.Parent = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Device) Equal(other Device) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !r.Definition.Equal(*other.Definition) {
+		return false
+	}
+	if len(r.UdiCarrier) != len(other.UdiCarrier) {
+		return false
+	}
+	for i := range r.UdiCarrier {
+		if !r.UdiCarrier[i].Equal(other.UdiCarrier[i]) {
+			return false
+		}
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.StatusReason) != len(other.StatusReason) {
+		return false
+	}
+	for i := range r.StatusReason {
+		if !r.StatusReason[i].Equal(other.StatusReason[i]) {
+			return false
+		}
+	}
+	if (r.DistinctIdentifier == nil) != (other.DistinctIdentifier == nil) || r.DistinctIdentifier != nil && *r.DistinctIdentifier != *other.DistinctIdentifier {
+		return false
+	}
+	if (r.DistinctIdentifierElement == nil) != (other.DistinctIdentifierElement == nil) || r.DistinctIdentifierElement != nil && !r.DistinctIdentifierElement.Equal(*other.DistinctIdentifierElement) {
+		return false
+	}
+	if (r.Manufacturer == nil) != (other.Manufacturer == nil) || r.Manufacturer != nil && *r.Manufacturer != *other.Manufacturer {
+		return false
+	}
+	if (r.ManufacturerElement == nil) != (other.ManufacturerElement == nil) || r.ManufacturerElement != nil && !r.ManufacturerElement.Equal(*other.ManufacturerElement) {
+		return false
+	}
+	if (r.ManufactureDate == nil) != (other.ManufactureDate == nil) || r.ManufactureDate != nil && *r.ManufactureDate != *other.ManufactureDate {
+		return false
+	}
+	if (r.ManufactureDateElement == nil) != (other.ManufactureDateElement == nil) || r.ManufactureDateElement != nil && !r.ManufactureDateElement.Equal(*other.ManufactureDateElement) {
+		return false
+	}
+	if (r.ExpirationDate == nil) != (other.ExpirationDate == nil) || r.ExpirationDate != nil && *r.ExpirationDate != *other.ExpirationDate {
+		return false
+	}
+	if (r.ExpirationDateElement == nil) != (other.ExpirationDateElement == nil) || r.ExpirationDateElement != nil && !r.ExpirationDateElement.Equal(*other.ExpirationDateElement) {
+		return false
+	}
+	if (r.LotNumber == nil) != (other.LotNumber == nil) || r.LotNumber != nil && *r.LotNumber != *other.LotNumber {
+		return false
+	}
+	if (r.LotNumberElement == nil) != (other.LotNumberElement == nil) || r.LotNumberElement != nil && !r.LotNumberElement.Equal(*other.LotNumberElement) {
+		return false
+	}
+	if (r.SerialNumber == nil) != (other.SerialNumber == nil) || r.SerialNumber != nil && *r.SerialNumber != *other.SerialNumber {
+		return false
+	}
+	if (r.SerialNumberElement == nil) != (other.SerialNumberElement == nil) || r.SerialNumberElement != nil && !r.SerialNumberElement.Equal(*other.SerialNumberElement) {
+		return false
+	}
+	if len(r.DeviceName) != len(other.DeviceName) {
+		return false
+	}
+	for i := range r.DeviceName {
+		if !r.DeviceName[i].Equal(other.DeviceName[i]) {
+			return false
+		}
+	}
+	if (r.ModelNumber == nil) != (other.ModelNumber == nil) || r.ModelNumber != nil && *r.ModelNumber != *other.ModelNumber {
+		return false
+	}
+	if (r.ModelNumberElement == nil) != (other.ModelNumberElement == nil) || r.ModelNumberElement != nil && !r.ModelNumberElement.Equal(*other.ModelNumberElement) {
+		return false
+	}
+	if (r.PartNumber == nil) != (other.PartNumber == nil) || r.PartNumber != nil && *r.PartNumber != *other.PartNumber {
+		return false
+	}
+	if (r.PartNumberElement == nil) != (other.PartNumberElement == nil) || r.PartNumberElement != nil && !r.PartNumberElement.Equal(*other.PartNumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.Specialization) != len(other.Specialization) {
+		return false
+	}
+	for i := range r.Specialization {
+		if !r.Specialization[i].Equal(other.Specialization[i]) {
+			return false
+		}
+	}
+	if len(r.Version) != len(other.Version) {
+		return false
+	}
+	for i := range r.Version {
+		if !r.Version[i].Equal(other.Version[i]) {
+			return false
+		}
+	}
+	if len(r.Property) != len(other.Property) {
+		return false
+	}
+	for i := range r.Property {
+		if !r.Property[i].Equal(other.Property[i]) {
+			return false
+		}
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.Equal(*other.Patient) {
+		return false
+	}
+	if (r.Owner == nil) != (other.Owner == nil) || r.Owner != nil && !r.Owner.Equal(*other.Owner) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.Safety) != len(other.Safety) {
+		return false
+	}
+	for i := range r.Safety {
+		if !r.Safety[i].Equal(other.Safety[i]) {
+			return false
+		}
+	}
+	if (r.Parent == nil) != (other.Parent == nil) || r.Parent != nil && !r.Parent.Equal(*other.Parent) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Device) EqualsDeep(other Device) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !r.Definition.EqualsDeep(*other.Definition) {
+		return false
+	}
+	if !equivalentList(len(r.UdiCarrier), len(other.UdiCarrier), func(i, j int) bool {
+		return r.UdiCarrier[i].EqualsDeep(other.UdiCarrier[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.StatusReason), len(other.StatusReason), func(i, j int) bool {
+		return r.StatusReason[i].EqualsDeep(other.StatusReason[j])
+	}) {
+		return false
+	}
+	if (r.DistinctIdentifier == nil) != (other.DistinctIdentifier == nil) || r.DistinctIdentifier != nil && !equivalentString(*r.DistinctIdentifier, *other.DistinctIdentifier) {
+		return false
+	}
+	if (r.DistinctIdentifierElement == nil) != (other.DistinctIdentifierElement == nil) || r.DistinctIdentifierElement != nil && !r.DistinctIdentifierElement.EqualsDeep(*other.DistinctIdentifierElement) {
+		return false
+	}
+	if (r.Manufacturer == nil) != (other.Manufacturer == nil) || r.Manufacturer != nil && !equivalentString(*r.Manufacturer, *other.Manufacturer) {
+		return false
+	}
+	if (r.ManufacturerElement == nil) != (other.ManufacturerElement == nil) || r.ManufacturerElement != nil && !r.ManufacturerElement.EqualsDeep(*other.ManufacturerElement) {
+		return false
+	}
+	if (r.ManufactureDate == nil) != (other.ManufactureDate == nil) || r.ManufactureDate != nil && !equivalentString(*r.ManufactureDate, *other.ManufactureDate) {
+		return false
+	}
+	if (r.ManufactureDateElement == nil) != (other.ManufactureDateElement == nil) || r.ManufactureDateElement != nil && !r.ManufactureDateElement.EqualsDeep(*other.ManufactureDateElement) {
+		return false
+	}
+	if (r.ExpirationDate == nil) != (other.ExpirationDate == nil) || r.ExpirationDate != nil && !equivalentString(*r.ExpirationDate, *other.ExpirationDate) {
+		return false
+	}
+	if (r.ExpirationDateElement == nil) != (other.ExpirationDateElement == nil) || r.ExpirationDateElement != nil && !r.ExpirationDateElement.EqualsDeep(*other.ExpirationDateElement) {
+		return false
+	}
+	if (r.LotNumber == nil) != (other.LotNumber == nil) || r.LotNumber != nil && !equivalentString(*r.LotNumber, *other.LotNumber) {
+		return false
+	}
+	if (r.LotNumberElement == nil) != (other.LotNumberElement == nil) || r.LotNumberElement != nil && !r.LotNumberElement.EqualsDeep(*other.LotNumberElement) {
+		return false
+	}
+	if (r.SerialNumber == nil) != (other.SerialNumber == nil) || r.SerialNumber != nil && !equivalentString(*r.SerialNumber, *other.SerialNumber) {
+		return false
+	}
+	if (r.SerialNumberElement == nil) != (other.SerialNumberElement == nil) || r.SerialNumberElement != nil && !r.SerialNumberElement.EqualsDeep(*other.SerialNumberElement) {
+		return false
+	}
+	if !equivalentList(len(r.DeviceName), len(other.DeviceName), func(i, j int) bool {
+		return r.DeviceName[i].EqualsDeep(other.DeviceName[j])
+	}) {
+		return false
+	}
+	if (r.ModelNumber == nil) != (other.ModelNumber == nil) || r.ModelNumber != nil && !equivalentString(*r.ModelNumber, *other.ModelNumber) {
+		return false
+	}
+	if (r.ModelNumberElement == nil) != (other.ModelNumberElement == nil) || r.ModelNumberElement != nil && !r.ModelNumberElement.EqualsDeep(*other.ModelNumberElement) {
+		return false
+	}
+	if (r.PartNumber == nil) != (other.PartNumber == nil) || r.PartNumber != nil && !equivalentString(*r.PartNumber, *other.PartNumber) {
+		return false
+	}
+	if (r.PartNumberElement == nil) != (other.PartNumberElement == nil) || r.PartNumberElement != nil && !r.PartNumberElement.EqualsDeep(*other.PartNumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Specialization), len(other.Specialization), func(i, j int) bool {
+		return r.Specialization[i].EqualsDeep(other.Specialization[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Version), len(other.Version), func(i, j int) bool {
+		return r.Version[i].EqualsDeep(other.Version[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Property), len(other.Property), func(i, j int) bool {
+		return r.Property[i].EqualsDeep(other.Property[j])
+	}) {
+		return false
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.EqualsDeep(*other.Patient) {
+		return false
+	}
+	if (r.Owner == nil) != (other.Owner == nil) || r.Owner != nil && !r.Owner.EqualsDeep(*other.Owner) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Safety), len(other.Safety), func(i, j int) bool {
+		return r.Safety[i].EqualsDeep(other.Safety[j])
+	}) {
+		return false
+	}
+	if (r.Parent == nil) != (other.Parent == nil) || r.Parent != nil && !r.Parent.EqualsDeep(*other.Parent) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Device) EqualsShallow(other Device) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.DistinctIdentifier == nil) != (other.DistinctIdentifier == nil) || r.DistinctIdentifier != nil && !equivalentString(*r.DistinctIdentifier, *other.DistinctIdentifier) {
+		return false
+	}
+	if (r.Manufacturer == nil) != (other.Manufacturer == nil) || r.Manufacturer != nil && !equivalentString(*r.Manufacturer, *other.Manufacturer) {
+		return false
+	}
+	if (r.ManufactureDate == nil) != (other.ManufactureDate == nil) || r.ManufactureDate != nil && !equivalentString(*r.ManufactureDate, *other.ManufactureDate) {
+		return false
+	}
+	if (r.ExpirationDate == nil) != (other.ExpirationDate == nil) || r.ExpirationDate != nil && !equivalentString(*r.ExpirationDate, *other.ExpirationDate) {
+		return false
+	}
+	if (r.LotNumber == nil) != (other.LotNumber == nil) || r.LotNumber != nil && !equivalentString(*r.LotNumber, *other.LotNumber) {
+		return false
+	}
+	if (r.SerialNumber == nil) != (other.SerialNumber == nil) || r.SerialNumber != nil && !equivalentString(*r.SerialNumber, *other.SerialNumber) {
+		return false
+	}
+	if (r.ModelNumber == nil) != (other.ModelNumber == nil) || r.ModelNumber != nil && !equivalentString(*r.ModelNumber, *other.ModelNumber) {
+		return false
+	}
+	if (r.PartNumber == nil) != (other.PartNumber == nil) || r.PartNumber != nil && !equivalentString(*r.PartNumber, *other.PartNumber) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Device as FHIR XML
+func (r Device) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Device",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "definition", r.Definition); err != nil {
+		return err
+	}
+	for _, v := range r.UdiCarrier {
+		if err := encodeXMLElement(e, "udiCarrier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.StatusReason {
+		if err := encodeXMLElement(e, "statusReason", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "distinctIdentifier", r.DistinctIdentifier, r.DistinctIdentifierElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "manufacturer", r.Manufacturer, r.ManufacturerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "manufactureDate", r.ManufactureDate, r.ManufactureDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expirationDate", r.ExpirationDate, r.ExpirationDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lotNumber", r.LotNumber, r.LotNumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "serialNumber", r.SerialNumber, r.SerialNumberElement); err != nil {
+		return err
+	}
+	for _, v := range r.DeviceName {
+		if err := encodeXMLElement(e, "deviceName", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "modelNumber", r.ModelNumber, r.ModelNumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "partNumber", r.PartNumber, r.PartNumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Specialization {
+		if err := encodeXMLElement(e, "specialization", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Version {
+		if err := encodeXMLElement(e, "version", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Property {
+		if err := encodeXMLElement(e, "property", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "owner", r.Owner); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Safety {
+		if err := encodeXMLElement(e, "safety", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "parent", r.Parent); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Device from FHIR XML
+func (r *Device) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "definition":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Definition = &v
+			case "udiCarrier":
+				var v DeviceUdiCarrier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UdiCarrier = append(r.UdiCarrier, v)
+			case "status":
+				var v FHIRDeviceStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = append(r.StatusReason, v)
+			case "distinctIdentifier":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DistinctIdentifier = &v
+				}
+				r.DistinctIdentifierElement = element
+			case "manufacturer":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Manufacturer = &v
+				}
+				r.ManufacturerElement = element
+			case "manufactureDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ManufactureDate = &v
+				}
+				r.ManufactureDateElement = element
+			case "expirationDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ExpirationDate = &v
+				}
+				r.ExpirationDateElement = element
+			case "lotNumber":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LotNumber = &v
+				}
+				r.LotNumberElement = element
+			case "serialNumber":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SerialNumber = &v
+				}
+				r.SerialNumberElement = element
+			case "deviceName":
+				var v DeviceDeviceName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DeviceName = append(r.DeviceName, v)
+			case "modelNumber":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ModelNumber = &v
+				}
+				r.ModelNumberElement = element
+			case "partNumber":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PartNumber = &v
+				}
+				r.PartNumberElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "specialization":
+				var v DeviceSpecialization
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specialization = append(r.Specialization, v)
+			case "version":
+				var v DeviceVersion
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Version = append(r.Version, v)
+			case "property":
+				var v DeviceProperty
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Property = append(r.Property, v)
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = &v
+			case "owner":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Owner = &v
+			case "contact":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "safety":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Safety = append(r.Safety, v)
+			case "parent":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parent = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Device as properties to the RDF node n
+func (r Device) turtle(n *turtleNode) {
+	n.resource("Device", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Device.identifier", i, v)
+	}
+	n.element("Device.definition", -1, r.Definition)
+	for i, v := range r.UdiCarrier {
+		n.element("Device.udiCarrier", i, v)
+	}
+	n.primitive("Device.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.StatusReason {
+		n.element("Device.statusReason", i, v)
+	}
+	n.primitive("Device.distinctIdentifier", -1, r.DistinctIdentifier, r.DistinctIdentifierElement, "string")
+	n.primitive("Device.manufacturer", -1, r.Manufacturer, r.ManufacturerElement, "string")
+	n.primitive("Device.manufactureDate", -1, r.ManufactureDate, r.ManufactureDateElement, "dateTime")
+	n.primitive("Device.expirationDate", -1, r.ExpirationDate, r.ExpirationDateElement, "dateTime")
+	n.primitive("Device.lotNumber", -1, r.LotNumber, r.LotNumberElement, "string")
+	n.primitive("Device.serialNumber", -1, r.SerialNumber, r.SerialNumberElement, "string")
+	for i, v := range r.DeviceName {
+		n.element("Device.deviceName", i, v)
+	}
+	n.primitive("Device.modelNumber", -1, r.ModelNumber, r.ModelNumberElement, "string")
+	n.primitive("Device.partNumber", -1, r.PartNumber, r.PartNumberElement, "string")
+	n.element("Device.type", -1, r.Type)
+	for i, v := range r.Specialization {
+		n.element("Device.specialization", i, v)
+	}
+	for i, v := range r.Version {
+		n.element("Device.version", i, v)
+	}
+	for i, v := range r.Property {
+		n.element("Device.property", i, v)
+	}
+	n.element("Device.patient", -1, r.Patient)
+	n.element("Device.owner", -1, r.Owner)
+	for i, v := range r.Contact {
+		n.element("Device.contact", i, v)
+	}
+	n.element("Device.location", -1, r.Location)
+	n.primitive("Device.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Note {
+		n.element("Device.note", i, v)
+	}
+	for i, v := range r.Safety {
+		n.element("Device.safety", i, v)
+	}
+	n.element("Device.parent", -1, r.Parent)
+}
+
+// MarshalBSON marshals the given Device as BSON document with the structure of its FHIR JSON
+func (r Device) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Device from a BSON document with the structure of its FHIR JSON
+func (r *Device) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Device as protobuf message Device of fhir.proto
+func (r Device) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Device to the encoded message
+func (r Device) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Definition != nil {
+		e.message(10, *r.Definition)
+	}
+	for _, v := range r.UdiCarrier {
+		e.message(11, v)
+	}
+	if r.Status != nil {
+		e.varint(12, r.Status.protoNumber())
+	}
+	for _, v := range r.StatusReason {
+		e.message(13, v)
+	}
+	if r.DistinctIdentifier != nil {
+		e.string(14, *r.DistinctIdentifier)
+	}
+	if r.Manufacturer != nil {
+		e.string(15, *r.Manufacturer)
+	}
+	if r.ManufactureDate != nil {
+		e.string(16, *r.ManufactureDate)
+	}
+	if r.ExpirationDate != nil {
+		e.string(17, *r.ExpirationDate)
+	}
+	if r.LotNumber != nil {
+		e.string(18, *r.LotNumber)
+	}
+	if r.SerialNumber != nil {
+		e.string(19, *r.SerialNumber)
+	}
+	for _, v := range r.DeviceName {
+		e.message(20, v)
+	}
+	if r.ModelNumber != nil {
+		e.string(21, *r.ModelNumber)
+	}
+	if r.PartNumber != nil {
+		e.string(22, *r.PartNumber)
+	}
+	if r.Type != nil {
+		e.message(23, *r.Type)
+	}
+	for _, v := range r.Specialization {
+		e.message(24, v)
+	}
+	for _, v := range r.Version {
+		e.message(25, v)
+	}
+	for _, v := range r.Property {
+		e.message(26, v)
+	}
+	if r.Patient != nil {
+		e.message(27, *r.Patient)
+	}
+	if r.Owner != nil {
+		e.message(28, *r.Owner)
+	}
+	for _, v := range r.Contact {
+		e.message(29, v)
+	}
+	if r.Location != nil {
+		e.message(30, *r.Location)
+	}
+	if r.Url != nil {
+		e.string(31, *r.Url)
+	}
+	for _, v := range r.Note {
+		e.message(32, v)
+	}
+	for _, v := range r.Safety {
+		e.message(33, v)
+	}
+	if r.Parent != nil {
+		e.message(34, *r.Parent)
+	}
+	if r.IdElement != nil {
+		e.message(35, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(36, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(37, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(38, *r.StatusElement)
+	}
+	if r.DistinctIdentifierElement != nil {
+		e.message(39, *r.DistinctIdentifierElement)
+	}
+	if r.ManufacturerElement != nil {
+		e.message(40, *r.ManufacturerElement)
+	}
+	if r.ManufactureDateElement != nil {
+		e.message(41, *r.ManufactureDateElement)
+	}
+	if r.ExpirationDateElement != nil {
+		e.message(42, *r.ExpirationDateElement)
+	}
+	if r.LotNumberElement != nil {
+		e.message(43, *r.LotNumberElement)
+	}
+	if r.SerialNumberElement != nil {
+		e.message(44, *r.SerialNumberElement)
+	}
+	if r.ModelNumberElement != nil {
+		e.message(45, *r.ModelNumberElement)
+	}
+	if r.PartNumberElement != nil {
+		e.message(46, *r.PartNumberElement)
+	}
+	if r.UrlElement != nil {
+		e.message(47, *r.UrlElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Device from protobuf message Device of fhir.proto
+func (r *Device) UnmarshalProto(b []byte) error {
+	*r = Device{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Definition = &v
+		case 11:
+			var v DeviceUdiCarrier
+			d.message(&v)
+			r.UdiCarrier = append(r.UdiCarrier, v)
+		case 12:
+			var v FHIRDeviceStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = append(r.StatusReason, v)
+		case 14:
+			v := d.string()
+			r.DistinctIdentifier = &v
+		case 15:
+			v := d.string()
+			r.Manufacturer = &v
+		case 16:
+			v := d.string()
+			r.ManufactureDate = &v
+		case 17:
+			v := d.string()
+			r.ExpirationDate = &v
+		case 18:
+			v := d.string()
+			r.LotNumber = &v
+		case 19:
+			v := d.string()
+			r.SerialNumber = &v
+		case 20:
+			var v DeviceDeviceName
+			d.message(&v)
+			r.DeviceName = append(r.DeviceName, v)
+		case 21:
+			v := d.string()
+			r.ModelNumber = &v
+		case 22:
+			v := d.string()
+			r.PartNumber = &v
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 24:
+			var v DeviceSpecialization
+			d.message(&v)
+			r.Specialization = append(r.Specialization, v)
+		case 25:
+			var v DeviceVersion
+			d.message(&v)
+			r.Version = append(r.Version, v)
+		case 26:
+			var v DeviceProperty
+			d.message(&v)
+			r.Property = append(r.Property, v)
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.Patient = &v
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Owner = &v
+		case 29:
+			var v ContactPoint
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 30:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 31:
+			v := d.string()
+			r.Url = &v
+		case 32:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 33:
+			var v CodeableConcept
+			d.message(&v)
+			r.Safety = append(r.Safety, v)
+		case 34:
+			var v Reference
+			d.message(&v)
+			r.Parent = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.DistinctIdentifierElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.ManufacturerElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.ManufactureDateElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.ExpirationDateElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.LotNumberElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.SerialNumberElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.ModelNumberElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.PartNumberElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceUdiCarrier as JSON into a byte slice
+func (r DeviceUdiCarrier) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceUdiCarrier as JSON object with the members in the order of the fields
+func (r DeviceUdiCarrier) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DeviceIdentifier != nil {
+		e.key("deviceIdentifier")
+		e.string(*r.DeviceIdentifier)
+	}
+	if r.DeviceIdentifierElement != nil {
+		e.key("_deviceIdentifier")
+		r.DeviceIdentifierElement.appendJSON(e)
+	}
+	if r.Issuer != nil {
+		e.key("issuer")
+		e.string(*r.Issuer)
+	}
+	if r.IssuerElement != nil {
+		e.key("_issuer")
+		r.IssuerElement.appendJSON(e)
+	}
+	if r.Jurisdiction != nil {
+		e.key("jurisdiction")
+		e.string(*r.Jurisdiction)
+	}
+	if r.JurisdictionElement != nil {
+		e.key("_jurisdiction")
+		r.JurisdictionElement.appendJSON(e)
+	}
+	if r.CarrierAIDC != nil {
+		e.key("carrierAIDC")
+		e.string(*r.CarrierAIDC)
+	}
+	if r.CarrierAIDCElement != nil {
+		e.key("_carrierAIDC")
+		r.CarrierAIDCElement.appendJSON(e)
+	}
+	if r.CarrierHRF != nil {
+		e.key("carrierHRF")
+		e.string(*r.CarrierHRF)
+	}
+	if r.CarrierHRFElement != nil {
+		e.key("_carrierHRF")
+		r.CarrierHRFElement.appendJSON(e)
+	}
+	if r.EntryType != nil {
+		e.key("entryType")
+		e.string(r.EntryType.Code())
+	}
+	if r.EntryTypeElement != nil {
+		e.key("_entryType")
+		r.EntryTypeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceUdiCarrier from JSON. Unknown members are ignored.
+func (r *DeviceUdiCarrier) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceUdiCarrier
+func (r *DeviceUdiCarrier) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "deviceIdentifier":
+			if v, ok := d.string(); ok {
+				r.DeviceIdentifier = &v
+			} else {
+				r.DeviceIdentifier = nil
+			}
+		case "_deviceIdentifier":
+			r.DeviceIdentifierElement = d.element()
+		case "issuer":
+			if v, ok := d.string(); ok {
+				r.Issuer = &v
+			} else {
+				r.Issuer = nil
+			}
+		case "_issuer":
+			r.IssuerElement = d.element()
+		case "jurisdiction":
+			if v, ok := d.string(); ok {
+				r.Jurisdiction = &v
+			} else {
+				r.Jurisdiction = nil
+			}
+		case "_jurisdiction":
+			r.JurisdictionElement = d.element()
+		case "carrierAIDC":
+			if v, ok := d.string(); ok {
+				r.CarrierAIDC = &v
+			} else {
+				r.CarrierAIDC = nil
+			}
+		case "_carrierAIDC":
+			r.CarrierAIDCElement = d.element()
+		case "carrierHRF":
+			if v, ok := d.string(); ok {
+				r.CarrierHRF = &v
+			} else {
+				r.CarrierHRF = nil
+			}
+		case "_carrierHRF":
+			r.CarrierHRFElement = d.element()
+		case "entryType":
+			if d.null() {
+				r.EntryType = nil
+			} else {
+				var v UDIEntryType
+				d.unmarshal(&v)
+				r.EntryType = &v
+			}
+		case "_entryType":
+			r.EntryTypeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceUdiCarrier which shares no memory with the original
+func (r DeviceUdiCarrier) DeepCopy() DeviceUdiCarrier {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DeviceIdentifier != nil {
+		v := *r.DeviceIdentifier
+		out.DeviceIdentifier = &v
+	}
+	if r.DeviceIdentifierElement != nil {
+		v := r.DeviceIdentifierElement.DeepCopy()
+		out.DeviceIdentifierElement = &v
+	}
+	if r.Issuer != nil {
+		v := *r.Issuer
+		out.Issuer = &v
+	}
+	if r.IssuerElement != nil {
+		v := r.IssuerElement.DeepCopy()
+		out.IssuerElement = &v
+	}
+	if r.Jurisdiction != nil {
+		v := *r.Jurisdiction
+		out.Jurisdiction = &v
+	}
+	if r.JurisdictionElement != nil {
+		v := r.JurisdictionElement.DeepCopy()
+		out.JurisdictionElement = &v
+	}
+	if r.CarrierAIDC != nil {
+		v := *r.CarrierAIDC
+		out.CarrierAIDC = &v
+	}
+	if r.CarrierAIDCElement != nil {
+		v := r.CarrierAIDCElement.DeepCopy()
+		out.CarrierAIDCElement = &v
+	}
+	if r.CarrierHRF != nil {
+		v := *r.CarrierHRF
+		out.CarrierHRF = &v
+	}
+	if r.CarrierHRFElement != nil {
+		v := r.CarrierHRFElement.DeepCopy()
+		out.CarrierHRFElement = &v
+	}
+	if r.EntryType != nil {
+		v := *r.EntryType
+		out.EntryType = &v
+	}
+	if r.EntryTypeElement != nil {
+		v := r.EntryTypeElement.DeepCopy()
+		out.EntryTypeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceUdiCarrier) Equal(other DeviceUdiCarrier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.DeviceIdentifier == nil) != (other.DeviceIdentifier == nil) || r.DeviceIdentifier != nil && *r.DeviceIdentifier != *other.DeviceIdentifier {
+		return false
+	}
+	if (r.DeviceIdentifierElement == nil) != (other.DeviceIdentifierElement == nil) || r.DeviceIdentifierElement != nil && !r.DeviceIdentifierElement.Equal(*other.DeviceIdentifierElement) {
+		return false
+	}
+	if (r.Issuer == nil) != (other.Issuer == nil) || r.Issuer != nil && *r.Issuer != *other.Issuer {
+		return false
+	}
+	if (r.IssuerElement == nil) != (other.IssuerElement == nil) || r.IssuerElement != nil && !r.IssuerElement.Equal(*other.IssuerElement) {
+		return false
+	}
+	if (r.Jurisdiction == nil) != (other.Jurisdiction == nil) || r.Jurisdiction != nil && *r.Jurisdiction != *other.Jurisdiction {
+		return false
+	}
+	if (r.JurisdictionElement == nil) != (other.JurisdictionElement == nil) || r.JurisdictionElement != nil && !r.JurisdictionElement.Equal(*other.JurisdictionElement) {
+		return false
+	}
+	if (r.CarrierAIDC == nil) != (other.CarrierAIDC == nil) || r.CarrierAIDC != nil && *r.CarrierAIDC != *other.CarrierAIDC {
+		return false
+	}
+	if (r.CarrierAIDCElement == nil) != (other.CarrierAIDCElement == nil) || r.CarrierAIDCElement != nil && !r.CarrierAIDCElement.Equal(*other.CarrierAIDCElement) {
+		return false
+	}
+	if (r.CarrierHRF == nil) != (other.CarrierHRF == nil) || r.CarrierHRF != nil && *r.CarrierHRF != *other.CarrierHRF {
+		return false
+	}
+	if (r.CarrierHRFElement == nil) != (other.CarrierHRFElement == nil) || r.CarrierHRFElement != nil && !r.CarrierHRFElement.Equal(*other.CarrierHRFElement) {
+		return false
+	}
+	if (r.EntryType == nil) != (other.EntryType == nil) || r.EntryType != nil && *r.EntryType != *other.EntryType {
+		return false
+	}
+	if (r.EntryTypeElement == nil) != (other.EntryTypeElement == nil) || r.EntryTypeElement != nil && !r.EntryTypeElement.Equal(*other.EntryTypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceUdiCarrier) EqualsDeep(other DeviceUdiCarrier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.DeviceIdentifier == nil) != (other.DeviceIdentifier == nil) || r.DeviceIdentifier != nil && !equivalentString(*r.DeviceIdentifier, *other.DeviceIdentifier) {
+		return false
+	}
+	if (r.DeviceIdentifierElement == nil) != (other.DeviceIdentifierElement == nil) || r.DeviceIdentifierElement != nil && !r.DeviceIdentifierElement.EqualsDeep(*other.DeviceIdentifierElement) {
+		return false
+	}
+	if (r.Issuer == nil) != (other.Issuer == nil) || r.Issuer != nil && !equivalentString(*r.Issuer, *other.Issuer) {
+		return false
+	}
+	if (r.IssuerElement == nil) != (other.IssuerElement == nil) || r.IssuerElement != nil && !r.IssuerElement.EqualsDeep(*other.IssuerElement) {
+		return false
+	}
+	if (r.Jurisdiction == nil) != (other.Jurisdiction == nil) || r.Jurisdiction != nil && !equivalentString(*r.Jurisdiction, *other.Jurisdiction) {
+		return false
+	}
+	if (r.JurisdictionElement == nil) != (other.JurisdictionElement == nil) || r.JurisdictionElement != nil && !r.JurisdictionElement.EqualsDeep(*other.JurisdictionElement) {
+		return false
+	}
+	if (r.CarrierAIDC == nil) != (other.CarrierAIDC == nil) || r.CarrierAIDC != nil && !equivalentString(*r.CarrierAIDC, *other.CarrierAIDC) {
+		return false
+	}
+	if (r.CarrierAIDCElement == nil) != (other.CarrierAIDCElement == nil) || r.CarrierAIDCElement != nil && !r.CarrierAIDCElement.EqualsDeep(*other.CarrierAIDCElement) {
+		return false
+	}
+	if (r.CarrierHRF == nil) != (other.CarrierHRF == nil) || r.CarrierHRF != nil && !equivalentString(*r.CarrierHRF, *other.CarrierHRF) {
+		return false
+	}
+	if (r.CarrierHRFElement == nil) != (other.CarrierHRFElement == nil) || r.CarrierHRFElement != nil && !r.CarrierHRFElement.EqualsDeep(*other.CarrierHRFElement) {
+		return false
+	}
+	if (r.EntryType == nil) != (other.EntryType == nil) || r.EntryType != nil && *r.EntryType != *other.EntryType {
+		return false
+	}
+	if (r.EntryTypeElement == nil) != (other.EntryTypeElement == nil) || r.EntryTypeElement != nil && !r.EntryTypeElement.EqualsDeep(*other.EntryTypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceUdiCarrier) EqualsShallow(other DeviceUdiCarrier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.DeviceIdentifier == nil) != (other.DeviceIdentifier == nil) || r.DeviceIdentifier != nil && !equivalentString(*r.DeviceIdentifier, *other.DeviceIdentifier) {
+		return false
+	}
+	if (r.Issuer == nil) != (other.Issuer == nil) || r.Issuer != nil && !equivalentString(*r.Issuer, *other.Issuer) {
+		return false
+	}
+	if (r.Jurisdiction == nil) != (other.Jurisdiction == nil) || r.Jurisdiction != nil && !equivalentString(*r.Jurisdiction, *other.Jurisdiction) {
+		return false
+	}
+	if (r.CarrierAIDC == nil) != (other.CarrierAIDC == nil) || r.CarrierAIDC != nil && !equivalentString(*r.CarrierAIDC, *other.CarrierAIDC) {
+		return false
+	}
+	if (r.CarrierHRF == nil) != (other.CarrierHRF == nil) || r.CarrierHRF != nil && !equivalentString(*r.CarrierHRF, *other.CarrierHRF) {
+		return false
+	}
+	if (r.EntryType == nil) != (other.EntryType == nil) || r.EntryType != nil && *r.EntryType != *other.EntryType {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceUdiCarrier as FHIR XML
+func (r DeviceUdiCarrier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "deviceIdentifier", r.DeviceIdentifier, r.DeviceIdentifierElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "issuer", r.Issuer, r.IssuerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "jurisdiction", r.Jurisdiction, r.JurisdictionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "carrierAIDC", r.CarrierAIDC, r.CarrierAIDCElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "carrierHRF", r.CarrierHRF, r.CarrierHRFElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "entryType", r.EntryType, r.EntryTypeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceUdiCarrier from FHIR XML
+func (r *DeviceUdiCarrier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "deviceIdentifier":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DeviceIdentifier = &v
+				}
+				r.DeviceIdentifierElement = element
+			case "issuer":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Issuer = &v
+				}
+				r.IssuerElement = element
+			case "jurisdiction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Jurisdiction = &v
+				}
+				r.JurisdictionElement = element
+			case "carrierAIDC":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CarrierAIDC = &v
+				}
+				r.CarrierAIDCElement = element
+			case "carrierHRF":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CarrierHRF = &v
+				}
+				r.CarrierHRFElement = element
+			case "entryType":
+				var v UDIEntryType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EntryType = &v
+				}
+				r.EntryTypeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceUdiCarrier as properties to the RDF node n
+func (r DeviceUdiCarrier) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Device.udiCarrier.deviceIdentifier", -1, r.DeviceIdentifier, r.DeviceIdentifierElement, "string")
+	n.primitive("Device.udiCarrier.issuer", -1, r.Issuer, r.IssuerElement, "string")
+	n.primitive("Device.udiCarrier.jurisdiction", -1, r.Jurisdiction, r.JurisdictionElement, "string")
+	n.primitive("Device.udiCarrier.carrierAIDC", -1, r.CarrierAIDC, r.CarrierAIDCElement, "base64Binary")
+	n.primitive("Device.udiCarrier.carrierHRF", -1, r.CarrierHRF, r.CarrierHRFElement, "string")
+	n.primitive("Device.udiCarrier.entryType", -1, r.EntryType, r.EntryTypeElement, "code")
+}
+
+// MarshalBSON marshals the given DeviceUdiCarrier as BSON document with the structure of its FHIR JSON
+func (r DeviceUdiCarrier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceUdiCarrier from a BSON document with the structure of its FHIR JSON
+func (r *DeviceUdiCarrier) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceUdiCarrier as protobuf message DeviceUdiCarrier of fhir.proto
+func (r DeviceUdiCarrier) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceUdiCarrier to the encoded message
+func (r DeviceUdiCarrier) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.DeviceIdentifier != nil {
+		e.string(4, *r.DeviceIdentifier)
+	}
+	if r.Issuer != nil {
+		e.string(5, *r.Issuer)
+	}
+	if r.Jurisdiction != nil {
+		e.string(6, *r.Jurisdiction)
+	}
+	if r.CarrierAIDC != nil {
+		e.string(7, *r.CarrierAIDC)
+	}
+	if r.CarrierHRF != nil {
+		e.string(8, *r.CarrierHRF)
+	}
+	if r.EntryType != nil {
+		e.varint(9, r.EntryType.protoNumber())
+	}
+	if r.DeviceIdentifierElement != nil {
+		e.message(10, *r.DeviceIdentifierElement)
+	}
+	if r.IssuerElement != nil {
+		e.message(11, *r.IssuerElement)
+	}
+	if r.JurisdictionElement != nil {
+		e.message(12, *r.JurisdictionElement)
+	}
+	if r.CarrierAIDCElement != nil {
+		e.message(13, *r.CarrierAIDCElement)
+	}
+	if r.CarrierHRFElement != nil {
+		e.message(14, *r.CarrierHRFElement)
+	}
+	if r.EntryTypeElement != nil {
+		e.message(15, *r.EntryTypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceUdiCarrier from protobuf message DeviceUdiCarrier of fhir.proto
+func (r *DeviceUdiCarrier) UnmarshalProto(b []byte) error {
+	*r = DeviceUdiCarrier{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.DeviceIdentifier = &v
+		case 5:
+			v := d.string()
+			r.Issuer = &v
+		case 6:
+			v := d.string()
+			r.Jurisdiction = &v
+		case 7:
+			v := d.string()
+			r.CarrierAIDC = &v
+		case 8:
+			v := d.string()
+			r.CarrierHRF = &v
+		case 9:
+			var v UDIEntryType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.EntryType = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.DeviceIdentifierElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.IssuerElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.JurisdictionElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.CarrierAIDCElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.CarrierHRFElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.EntryTypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceDeviceName as JSON into a byte slice
+func (r DeviceDeviceName) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceDeviceName as JSON object with the members in the order of the fields
+func (r DeviceDeviceName) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceDeviceName from JSON. Unknown members are ignored.
+func (r *DeviceDeviceName) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceDeviceName
+func (r *DeviceDeviceName) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceDeviceName which shares no memory with the original
+func (r DeviceDeviceName) DeepCopy() DeviceDeviceName {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceDeviceName) Equal(other DeviceDeviceName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceDeviceName) EqualsDeep(other DeviceDeviceName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceDeviceName) EqualsShallow(other DeviceDeviceName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceDeviceName as FHIR XML
+func (r DeviceDeviceName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceDeviceName from FHIR XML
+func (r *DeviceDeviceName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "type":
+				var v DeviceNameType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceDeviceName as properties to the RDF node n
+func (r DeviceDeviceName) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Device.deviceName.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("Device.deviceName.type", -1, r.Type, r.TypeElement, "code")
+}
+
+// MarshalBSON marshals the given DeviceDeviceName as BSON document with the structure of its FHIR JSON
+func (r DeviceDeviceName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceDeviceName from a BSON document with the structure of its FHIR JSON
+func (r *DeviceDeviceName) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceDeviceName as protobuf message DeviceDeviceName of fhir.proto
+func (r DeviceDeviceName) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceDeviceName to the encoded message
+func (r DeviceDeviceName) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	e.varint(5, r.Type.protoNumber())
+	if r.NameElement != nil {
+		e.message(6, *r.NameElement)
+	}
+	if r.TypeElement != nil {
+		e.message(7, *r.TypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceDeviceName from protobuf message DeviceDeviceName of fhir.proto
+func (r *DeviceDeviceName) UnmarshalProto(b []byte) error {
+	*r = DeviceDeviceName{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			var v DeviceNameType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceSpecialization as JSON into a byte slice
+func (r DeviceSpecialization) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceSpecialization as JSON object with the members in the order of the fields
+func (r DeviceSpecialization) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("systemType")
+	r.SystemType.appendJSON(e)
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceSpecialization from JSON. Unknown members are ignored.
+func (r *DeviceSpecialization) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceSpecialization
+func (r *DeviceSpecialization) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "systemType":
+			r.SystemType.decodeJSON(d)
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceSpecialization which shares no memory with the original
+func (r DeviceSpecialization) DeepCopy() DeviceSpecialization {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.SystemType = r.SystemType.DeepCopy()
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceSpecialization) Equal(other DeviceSpecialization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.SystemType.Equal(other.SystemType) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceSpecialization) EqualsDeep(other DeviceSpecialization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.SystemType.EqualsDeep(other.SystemType) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceSpecialization) EqualsShallow(other DeviceSpecialization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceSpecialization as FHIR XML
+func (r DeviceSpecialization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "systemType", r.SystemType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceSpecialization from FHIR XML
+func (r *DeviceSpecialization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "systemType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SystemType = v
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceSpecialization as properties to the RDF node n
+func (r DeviceSpecialization) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Device.specialization.systemType", -1, r.SystemType)
+	n.primitive("Device.specialization.version", -1, r.Version, r.VersionElement, "string")
+}
+
+// MarshalBSON marshals the given DeviceSpecialization as BSON document with the structure of its FHIR JSON
+func (r DeviceSpecialization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceSpecialization from a BSON document with the structure of its FHIR JSON
+func (r *DeviceSpecialization) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceSpecialization as protobuf message DeviceSpecialization of fhir.proto
+func (r DeviceSpecialization) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceSpecialization to the encoded message
+func (r DeviceSpecialization) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.SystemType)
+	if r.Version != nil {
+		e.string(5, *r.Version)
+	}
+	if r.VersionElement != nil {
+		e.message(6, *r.VersionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceSpecialization from protobuf message DeviceSpecialization of fhir.proto
+func (r *DeviceSpecialization) UnmarshalProto(b []byte) error {
+	*r = DeviceSpecialization{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.SystemType = v
+		case 5:
+			v := d.string()
+			r.Version = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceVersion as JSON into a byte slice
+func (r DeviceVersion) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceVersion as JSON object with the members in the order of the fields
+func (r DeviceVersion) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Component != nil {
+		e.key("component")
+		r.Component.appendJSON(e)
+	}
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceVersion from JSON. Unknown members are ignored.
+func (r *DeviceVersion) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceVersion
+func (r *DeviceVersion) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "component":
+			if d.null() {
+				r.Component = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Component = &v
+			}
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceVersion which shares no memory with the original
+func (r DeviceVersion) DeepCopy() DeviceVersion {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Component != nil {
+		v := r.Component.DeepCopy()
+		out.Component = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceVersion) Equal(other DeviceVersion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Component == nil) != (other.Component == nil) || r.Component != nil && !r.Component.Equal(*other.Component) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceVersion) EqualsDeep(other DeviceVersion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Component == nil) != (other.Component == nil) || r.Component != nil && !r.Component.EqualsDeep(*other.Component) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceVersion) EqualsShallow(other DeviceVersion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceVersion as FHIR XML
+func (r DeviceVersion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "component", r.Component); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceVersion from FHIR XML
+func (r *DeviceVersion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "component":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Component = &v
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceVersion as properties to the RDF node n
+func (r DeviceVersion) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Device.version.type", -1, r.Type)
+	n.element("Device.version.component", -1, r.Component)
+	n.primitive("Device.version.value", -1, r.Value, r.ValueElement, "string")
+}
+
+// MarshalBSON marshals the given DeviceVersion as BSON document with the structure of its FHIR JSON
+func (r DeviceVersion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceVersion from a BSON document with the structure of its FHIR JSON
+func (r *DeviceVersion) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceVersion as protobuf message DeviceVersion of fhir.proto
+func (r DeviceVersion) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceVersion to the encoded message
+func (r DeviceVersion) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Component != nil {
+		e.message(5, *r.Component)
+	}
+	if r.Value != "" {
+		e.string(6, r.Value)
+	}
+	if r.ValueElement != nil {
+		e.message(7, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceVersion from protobuf message DeviceVersion of fhir.proto
+func (r *DeviceVersion) UnmarshalProto(b []byte) error {
+	*r = DeviceVersion{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.Component = &v
+		case 6:
+			r.Value = d.string()
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceProperty as JSON into a byte slice
+func (r DeviceProperty) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceProperty as JSON object with the members in the order of the fields
+func (r DeviceProperty) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if len(r.ValueQuantity) > 0 {
+		e.key("valueQuantity")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ValueQuantity {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ValueCode) > 0 {
+		e.key("valueCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ValueCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceProperty from JSON. Unknown members are ignored.
+func (r *DeviceProperty) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceProperty
+func (r *DeviceProperty) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "valueQuantity":
+			a := d.array()
+			r.ValueQuantity = nil
+			for a.next() {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = append(r.ValueQuantity, v)
+			}
+			if r.ValueQuantity == nil && !a.isNull {
+				r.ValueQuantity = []Quantity{}
+			}
+		case "valueCode":
+			a := d.array()
+			r.ValueCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCode = append(r.ValueCode, v)
+			}
+			if r.ValueCode == nil && !a.isNull {
+				r.ValueCode = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceProperty which shares no memory with the original
+func (r DeviceProperty) DeepCopy() DeviceProperty {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.ValueQuantity != nil {
+		out.ValueQuantity = make([]Quantity, len(r.ValueQuantity))
+		for i := range r.ValueQuantity {
+			out.ValueQuantity[i] = r.ValueQuantity[i].DeepCopy()
+		}
+	}
+	if r.ValueCode != nil {
+		out.ValueCode = make([]CodeableConcept, len(r.ValueCode))
+		for i := range r.ValueCode {
+			out.ValueCode[i] = r.ValueCode[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceProperty) Equal(other DeviceProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.ValueQuantity) != len(other.ValueQuantity) {
+		return false
+	}
+	for i := range r.ValueQuantity {
+		if !r.ValueQuantity[i].Equal(other.ValueQuantity[i]) {
+			return false
+		}
+	}
+	if len(r.ValueCode) != len(other.ValueCode) {
+		return false
+	}
+	for i := range r.ValueCode {
+		if !r.ValueCode[i].Equal(other.ValueCode[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceProperty) EqualsDeep(other DeviceProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.ValueQuantity), len(other.ValueQuantity), func(i, j int) bool {
+		return r.ValueQuantity[i].EqualsDeep(other.ValueQuantity[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ValueCode), len(other.ValueCode), func(i, j int) bool {
+		return r.ValueCode[i].EqualsDeep(other.ValueCode[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceProperty) EqualsShallow(other DeviceProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceProperty as FHIR XML
+func (r DeviceProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.ValueQuantity {
+		if err := encodeXMLElement(e, "valueQuantity", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ValueCode {
+		if err := encodeXMLElement(e, "valueCode", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceProperty from FHIR XML
+func (r *DeviceProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = append(r.ValueQuantity, v)
+			case "valueCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCode = append(r.ValueCode, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceProperty as properties to the RDF node n
+func (r DeviceProperty) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Device.property.type", -1, r.Type)
+	for i, v := range r.ValueQuantity {
+		n.element("Device.property.valueQuantity", i, v)
+	}
+	for i, v := range r.ValueCode {
+		n.element("Device.property.valueCode", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DeviceProperty as BSON document with the structure of its FHIR JSON
+func (r DeviceProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceProperty from a BSON document with the structure of its FHIR JSON
+func (r *DeviceProperty) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceProperty as protobuf message DeviceProperty of fhir.proto
+func (r DeviceProperty) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceProperty to the encoded message
+func (r DeviceProperty) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	for _, v := range r.ValueQuantity {
+		e.message(5, v)
+	}
+	for _, v := range r.ValueCode {
+		e.message(6, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceProperty from protobuf message DeviceProperty of fhir.proto
+func (r *DeviceProperty) UnmarshalProto(b []byte) error {
+	*r = DeviceProperty{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = append(r.ValueQuantity, v)
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCode = append(r.ValueCode, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalDevice unmarshals a Device.
 func UnmarshalDevice(b []byte) (Device, error) {
 	var device Device
-	if err := json.Unmarshal(b, &device); err != nil {
+	if err := device.UnmarshalJSON(b); err != nil {
 		return device, err
 	}
 	return device, nil
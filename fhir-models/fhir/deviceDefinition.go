@@ -14,65 +14,121 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // DeviceDefinition is documented here http://hl7.org/fhir/StructureDefinition/DeviceDefinition
 type DeviceDefinition struct {
-	Id                      *string                               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                    *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules           *string                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                *string                               `bson:"language,omitempty" json:"language,omitempty"`
-	Text                    *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension               []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier              []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	UdiDeviceIdentifier     []DeviceDefinitionUdiDeviceIdentifier `bson:"udiDeviceIdentifier,omitempty" json:"udiDeviceIdentifier,omitempty"`
-	ManufacturerString      *string                               `bson:"manufacturerString,omitempty" json:"manufacturerString,omitempty"`
-	ManufacturerReference   *Reference                            `bson:"manufacturerReference,omitempty" json:"manufacturerReference,omitempty"`
-	DeviceName              []DeviceDefinitionDeviceName          `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
-	ModelNumber             *string                               `bson:"modelNumber,omitempty" json:"modelNumber,omitempty"`
-	Type                    *CodeableConcept                      `bson:"type,omitempty" json:"type,omitempty"`
-	Specialization          []DeviceDefinitionSpecialization      `bson:"specialization,omitempty" json:"specialization,omitempty"`
-	Version                 []string                              `bson:"version,omitempty" json:"version,omitempty"`
-	Safety                  []CodeableConcept                     `bson:"safety,omitempty" json:"safety,omitempty"`
-	ShelfLifeStorage        []ProductShelfLife                    `bson:"shelfLifeStorage,omitempty" json:"shelfLifeStorage,omitempty"`
-	PhysicalCharacteristics *ProdCharacteristic                   `bson:"physicalCharacteristics,omitempty" json:"physicalCharacteristics,omitempty"`
-	LanguageCode            []CodeableConcept                     `bson:"languageCode,omitempty" json:"languageCode,omitempty"`
-	Capability              []DeviceDefinitionCapability          `bson:"capability,omitempty" json:"capability,omitempty"`
-	Property                []DeviceDefinitionProperty            `bson:"property,omitempty" json:"property,omitempty"`
-	Owner                   *Reference                            `bson:"owner,omitempty" json:"owner,omitempty"`
-	Contact                 []ContactPoint                        `bson:"contact,omitempty" json:"contact,omitempty"`
-	Url                     *string                               `bson:"url,omitempty" json:"url,omitempty"`
-	OnlineInformation       *string                               `bson:"onlineInformation,omitempty" json:"onlineInformation,omitempty"`
-	Note                    []Annotation                          `bson:"note,omitempty" json:"note,omitempty"`
-	Quantity                *Quantity                             `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	ParentDevice            *Reference                            `bson:"parentDevice,omitempty" json:"parentDevice,omitempty"`
-	Material                []DeviceDefinitionMaterial            `bson:"material,omitempty" json:"material,omitempty"`
+	Id                        *string                               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element                              `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                      *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage                     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	UdiDeviceIdentifier       []DeviceDefinitionUdiDeviceIdentifier `bson:"udiDeviceIdentifier,omitempty" json:"udiDeviceIdentifier,omitempty"`
+	ManufacturerString        *string                               `bson:"manufacturerString,omitempty" json:"manufacturerString,omitempty"`
+	ManufacturerStringElement *Element                              `bson:"_manufacturerString,omitempty" json:"_manufacturerString,omitempty"`
+	ManufacturerReference     *Reference                            `bson:"manufacturerReference,omitempty" json:"manufacturerReference,omitempty"`
+	DeviceName                []DeviceDefinitionDeviceName          `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
+	ModelNumber               *string                               `bson:"modelNumber,omitempty" json:"modelNumber,omitempty"`
+	ModelNumberElement        *Element                              `bson:"_modelNumber,omitempty" json:"_modelNumber,omitempty"`
+	Type                      *CodeableConcept                      `bson:"type,omitempty" json:"type,omitempty"`
+	Specialization            []DeviceDefinitionSpecialization      `bson:"specialization,omitempty" json:"specialization,omitempty"`
+	Version                   []string                              `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement            []*Element                            `bson:"_version,omitempty" json:"_version,omitempty"`
+	Safety                    []CodeableConcept                     `bson:"safety,omitempty" json:"safety,omitempty"`
+	ShelfLifeStorage          []ProductShelfLife                    `bson:"shelfLifeStorage,omitempty" json:"shelfLifeStorage,omitempty"`
+	PhysicalCharacteristics   *ProdCharacteristic                   `bson:"physicalCharacteristics,omitempty" json:"physicalCharacteristics,omitempty"`
+	LanguageCode              []CodeableConcept                     `bson:"languageCode,omitempty" json:"languageCode,omitempty"`
+	Capability                []DeviceDefinitionCapability          `bson:"capability,omitempty" json:"capability,omitempty"`
+	Property                  []DeviceDefinitionProperty            `bson:"property,omitempty" json:"property,omitempty"`
+	Owner                     *Reference                            `bson:"owner,omitempty" json:"owner,omitempty"`
+	Contact                   []ContactPoint                        `bson:"contact,omitempty" json:"contact,omitempty"`
+	Url                       *string                               `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement                *Element                              `bson:"_url,omitempty" json:"_url,omitempty"`
+	OnlineInformation         *string                               `bson:"onlineInformation,omitempty" json:"onlineInformation,omitempty"`
+	OnlineInformationElement  *Element                              `bson:"_onlineInformation,omitempty" json:"_onlineInformation,omitempty"`
+	Note                      []Annotation                          `bson:"note,omitempty" json:"note,omitempty"`
+	Quantity                  *Quantity                             `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ParentDevice              *Reference                            `bson:"parentDevice,omitempty" json:"parentDevice,omitempty"`
+	Material                  []DeviceDefinitionMaterial            `bson:"material,omitempty" json:"material,omitempty"`
 }
 type DeviceDefinitionUdiDeviceIdentifier struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DeviceIdentifier  string      `bson:"deviceIdentifier" json:"deviceIdentifier"`
-	Issuer            string      `bson:"issuer" json:"issuer"`
-	Jurisdiction      string      `bson:"jurisdiction" json:"jurisdiction"`
+	Id                      *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DeviceIdentifier        string      `bson:"deviceIdentifier" json:"deviceIdentifier"`
+	DeviceIdentifierElement *Element    `bson:"_deviceIdentifier,omitempty" json:"_deviceIdentifier,omitempty"`
+	Issuer                  string      `bson:"issuer" json:"issuer"`
+	IssuerElement           *Element    `bson:"_issuer,omitempty" json:"_issuer,omitempty"`
+	Jurisdiction            string      `bson:"jurisdiction" json:"jurisdiction"`
+	JurisdictionElement     *Element    `bson:"_jurisdiction,omitempty" json:"_jurisdiction,omitempty"`
+}
+
+// DeviceDefinitionManufacturer is implemented by the types allowed for DeviceDefinition.manufacturer[x]
+type DeviceDefinitionManufacturer interface {
+	isDeviceDefinitionManufacturer()
+}
+
+func (String) isDeviceDefinitionManufacturer()    {}
+func (Reference) isDeviceDefinitionManufacturer() {}
+
+// Manufacturer returns the value of DeviceDefinition.manufacturer[x] together with its FHIR type code or nil if no value is set
+func (r DeviceDefinition) Manufacturer() (DeviceDefinitionManufacturer, string) {
+	if r.ManufacturerString != nil {
+		return String(*r.ManufacturerString), "string"
+	}
+	if r.ManufacturerReference != nil {
+		return *r.ManufacturerReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetManufacturer sets the value of DeviceDefinition.manufacturer[x], given as value or pointer, and clears all other types
+func (r *DeviceDefinition) SetManufacturer(value DeviceDefinitionManufacturer) {
+	r.ManufacturerString = nil
+	r.ManufacturerReference = nil
+	switch v := value.(type) {
+	case String:
+		primitive := string(v)
+		r.ManufacturerString = &primitive
+	case *String:
+		r.ManufacturerString = (*string)(v)
+	case Reference:
+		r.ManufacturerReference = &v
+	case *Reference:
+		r.ManufacturerReference = v
+	}
 }
+
 type DeviceDefinitionDeviceName struct {
 	Id                *string        `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension    `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              string         `bson:"name" json:"name"`
+	NameElement       *Element       `bson:"_name,omitempty" json:"_name,omitempty"`
 	Type              DeviceNameType `bson:"type" json:"type"`
+	TypeElement       *Element       `bson:"_type,omitempty" json:"_type,omitempty"`
 }
 type DeviceDefinitionSpecialization struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	SystemType        string      `bson:"systemType" json:"systemType"`
+	SystemTypeElement *Element    `bson:"_systemType,omitempty" json:"_systemType,omitempty"`
 	Version           *string     `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element    `bson:"_version,omitempty" json:"_version,omitempty"`
 }
 type DeviceDefinitionCapability struct {
 	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
@@ -90,30 +146,4418 @@ type DeviceDefinitionProperty struct {
 	ValueCode         []CodeableConcept `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
 }
 type DeviceDefinitionMaterial struct {
-	Id                  *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Substance           CodeableConcept `bson:"substance" json:"substance"`
-	Alternate           *bool           `bson:"alternate,omitempty" json:"alternate,omitempty"`
-	AllergenicIndicator *bool           `bson:"allergenicIndicator,omitempty" json:"allergenicIndicator,omitempty"`
+	Id                         *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Substance                  CodeableConcept `bson:"substance" json:"substance"`
+	Alternate                  *bool           `bson:"alternate,omitempty" json:"alternate,omitempty"`
+	AlternateElement           *Element        `bson:"_alternate,omitempty" json:"_alternate,omitempty"`
+	AllergenicIndicator        *bool           `bson:"allergenicIndicator,omitempty" json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *Element        `bson:"_allergenicIndicator,omitempty" json:"_allergenicIndicator,omitempty"`
 }
-type OtherDeviceDefinition DeviceDefinition
 
 // MarshalJSON marshals the given DeviceDefinition as JSON into a byte slice
 func (r DeviceDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherDeviceDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherDeviceDefinition: OtherDeviceDefinition(r),
-		ResourceType:          "DeviceDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceDefinition as JSON object with the members in the order of the fields
+func (r DeviceDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"DeviceDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.UdiDeviceIdentifier) > 0 {
+		e.key("udiDeviceIdentifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UdiDeviceIdentifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ManufacturerString != nil {
+		e.key("manufacturerString")
+		e.string(*r.ManufacturerString)
+	}
+	if r.ManufacturerStringElement != nil {
+		e.key("_manufacturerString")
+		r.ManufacturerStringElement.appendJSON(e)
+	}
+	if r.ManufacturerReference != nil {
+		e.key("manufacturerReference")
+		r.ManufacturerReference.appendJSON(e)
+	}
+	if len(r.DeviceName) > 0 {
+		e.key("deviceName")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DeviceName {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ModelNumber != nil {
+		e.key("modelNumber")
+		e.string(*r.ModelNumber)
+	}
+	if r.ModelNumberElement != nil {
+		e.key("_modelNumber")
+		r.ModelNumberElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.Specialization) > 0 {
+		e.key("specialization")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Specialization {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Version) > 0 {
+		e.key("version")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Version {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.VersionElement) > 0 {
+		e.key("_version")
+		e.elements(r.VersionElement, len(r.Version))
+	}
+	if len(r.Safety) > 0 {
+		e.key("safety")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Safety {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ShelfLifeStorage) > 0 {
+		e.key("shelfLifeStorage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ShelfLifeStorage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PhysicalCharacteristics != nil {
+		e.key("physicalCharacteristics")
+		r.PhysicalCharacteristics.appendJSON(e)
+	}
+	if len(r.LanguageCode) > 0 {
+		e.key("languageCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LanguageCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Capability) > 0 {
+		e.key("capability")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Capability {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Property) > 0 {
+		e.key("property")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Property {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Owner != nil {
+		e.key("owner")
+		r.Owner.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.OnlineInformation != nil {
+		e.key("onlineInformation")
+		e.string(*r.OnlineInformation)
+	}
+	if r.OnlineInformationElement != nil {
+		e.key("_onlineInformation")
+		r.OnlineInformationElement.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.ParentDevice != nil {
+		e.key("parentDevice")
+		r.ParentDevice.appendJSON(e)
+	}
+	if len(r.Material) > 0 {
+		e.key("material")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Material {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceDefinition from JSON. Unknown members are ignored.
+func (r *DeviceDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceDefinition
+func (r *DeviceDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "udiDeviceIdentifier":
+			a := d.array()
+			r.UdiDeviceIdentifier = nil
+			for a.next() {
+				var v DeviceDefinitionUdiDeviceIdentifier
+				v.decodeJSON(d)
+				r.UdiDeviceIdentifier = append(r.UdiDeviceIdentifier, v)
+			}
+			if r.UdiDeviceIdentifier == nil && !a.isNull {
+				r.UdiDeviceIdentifier = []DeviceDefinitionUdiDeviceIdentifier{}
+			}
+		case "manufacturerString":
+			if v, ok := d.string(); ok {
+				r.ManufacturerString = &v
+			} else {
+				r.ManufacturerString = nil
+			}
+		case "_manufacturerString":
+			r.ManufacturerStringElement = d.element()
+		case "manufacturerReference":
+			if d.null() {
+				r.ManufacturerReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ManufacturerReference = &v
+			}
+		case "deviceName":
+			a := d.array()
+			r.DeviceName = nil
+			for a.next() {
+				var v DeviceDefinitionDeviceName
+				v.decodeJSON(d)
+				r.DeviceName = append(r.DeviceName, v)
+			}
+			if r.DeviceName == nil && !a.isNull {
+				r.DeviceName = []DeviceDefinitionDeviceName{}
+			}
+		case "modelNumber":
+			if v, ok := d.string(); ok {
+				r.ModelNumber = &v
+			} else {
+				r.ModelNumber = nil
+			}
+		case "_modelNumber":
+			r.ModelNumberElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "specialization":
+			a := d.array()
+			r.Specialization = nil
+			for a.next() {
+				var v DeviceDefinitionSpecialization
+				v.decodeJSON(d)
+				r.Specialization = append(r.Specialization, v)
+			}
+			if r.Specialization == nil && !a.isNull {
+				r.Specialization = []DeviceDefinitionSpecialization{}
+			}
+		case "version":
+			a := d.array()
+			r.Version = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Version = append(r.Version, v)
+			}
+			if r.Version == nil && !a.isNull {
+				r.Version = []string{}
+			}
+		case "_version":
+			a := d.array()
+			r.VersionElement = nil
+			for a.next() {
+				r.VersionElement = append(r.VersionElement, d.element())
+			}
+		case "safety":
+			a := d.array()
+			r.Safety = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Safety = append(r.Safety, v)
+			}
+			if r.Safety == nil && !a.isNull {
+				r.Safety = []CodeableConcept{}
+			}
+		case "shelfLifeStorage":
+			a := d.array()
+			r.ShelfLifeStorage = nil
+			for a.next() {
+				var v ProductShelfLife
+				v.decodeJSON(d)
+				r.ShelfLifeStorage = append(r.ShelfLifeStorage, v)
+			}
+			if r.ShelfLifeStorage == nil && !a.isNull {
+				r.ShelfLifeStorage = []ProductShelfLife{}
+			}
+		case "physicalCharacteristics":
+			if d.null() {
+				r.PhysicalCharacteristics = nil
+			} else {
+				var v ProdCharacteristic
+				v.decodeJSON(d)
+				r.PhysicalCharacteristics = &v
+			}
+		case "languageCode":
+			a := d.array()
+			r.LanguageCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LanguageCode = append(r.LanguageCode, v)
+			}
+			if r.LanguageCode == nil && !a.isNull {
+				r.LanguageCode = []CodeableConcept{}
+			}
+		case "capability":
+			a := d.array()
+			r.Capability = nil
+			for a.next() {
+				var v DeviceDefinitionCapability
+				v.decodeJSON(d)
+				r.Capability = append(r.Capability, v)
+			}
+			if r.Capability == nil && !a.isNull {
+				r.Capability = []DeviceDefinitionCapability{}
+			}
+		case "property":
+			a := d.array()
+			r.Property = nil
+			for a.next() {
+				var v DeviceDefinitionProperty
+				v.decodeJSON(d)
+				r.Property = append(r.Property, v)
+			}
+			if r.Property == nil && !a.isNull {
+				r.Property = []DeviceDefinitionProperty{}
+			}
+		case "owner":
+			if d.null() {
+				r.Owner = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Owner = &v
+			}
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactPoint{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "onlineInformation":
+			if v, ok := d.string(); ok {
+				r.OnlineInformation = &v
+			} else {
+				r.OnlineInformation = nil
+			}
+		case "_onlineInformation":
+			r.OnlineInformationElement = d.element()
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "parentDevice":
+			if d.null() {
+				r.ParentDevice = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ParentDevice = &v
+			}
+		case "material":
+			a := d.array()
+			r.Material = nil
+			for a.next() {
+				var v DeviceDefinitionMaterial
+				v.decodeJSON(d)
+				r.Material = append(r.Material, v)
+			}
+			if r.Material == nil && !a.isNull {
+				r.Material = []DeviceDefinitionMaterial{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceDefinition which shares no memory with the original
+func (r DeviceDefinition) DeepCopy() DeviceDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.UdiDeviceIdentifier != nil {
+		out.UdiDeviceIdentifier = make([]DeviceDefinitionUdiDeviceIdentifier, len(r.UdiDeviceIdentifier))
+		for i := range r.UdiDeviceIdentifier {
+			out.UdiDeviceIdentifier[i] = r.UdiDeviceIdentifier[i].DeepCopy()
+		}
+	}
+	if r.ManufacturerString != nil {
+		v := *r.ManufacturerString
+		out.ManufacturerString = &v
+	}
+	if r.ManufacturerStringElement != nil {
+		v := r.ManufacturerStringElement.DeepCopy()
+		out.ManufacturerStringElement = &v
+	}
+	if r.ManufacturerReference != nil {
+		v := r.ManufacturerReference.DeepCopy()
+		out.ManufacturerReference = &v
+	}
+	if r.DeviceName != nil {
+		out.DeviceName = make([]DeviceDefinitionDeviceName, len(r.DeviceName))
+		for i := range r.DeviceName {
+			out.DeviceName[i] = r.DeviceName[i].DeepCopy()
+		}
+	}
+	if r.ModelNumber != nil {
+		v := *r.ModelNumber
+		out.ModelNumber = &v
+	}
+	if r.ModelNumberElement != nil {
+		v := r.ModelNumberElement.DeepCopy()
+		out.ModelNumberElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Specialization != nil {
+		out.Specialization = make([]DeviceDefinitionSpecialization, len(r.Specialization))
+		for i := range r.Specialization {
+			out.Specialization[i] = r.Specialization[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		out.Version = make([]string, len(r.Version))
+		copy(out.Version, r.Version)
+	}
+	if r.VersionElement != nil {
+		out.VersionElement = make([]*Element, len(r.VersionElement))
+		for i, v := range r.VersionElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.VersionElement[i] = &c
+			}
+		}
+	}
+	if r.Safety != nil {
+		out.Safety = make([]CodeableConcept, len(r.Safety))
+		for i := range r.Safety {
+			out.Safety[i] = r.Safety[i].DeepCopy()
+		}
+	}
+	if r.ShelfLifeStorage != nil {
+		out.ShelfLifeStorage = make([]ProductShelfLife, len(r.ShelfLifeStorage))
+		for i := range r.ShelfLifeStorage {
+			out.ShelfLifeStorage[i] = r.ShelfLifeStorage[i].DeepCopy()
+		}
+	}
+	if r.PhysicalCharacteristics != nil {
+		v := r.PhysicalCharacteristics.DeepCopy()
+		out.PhysicalCharacteristics = &v
+	}
+	if r.LanguageCode != nil {
+		out.LanguageCode = make([]CodeableConcept, len(r.LanguageCode))
+		for i := range r.LanguageCode {
+			out.LanguageCode[i] = r.LanguageCode[i].DeepCopy()
+		}
+	}
+	if r.Capability != nil {
+		out.Capability = make([]DeviceDefinitionCapability, len(r.Capability))
+		for i := range r.Capability {
+			out.Capability[i] = r.Capability[i].DeepCopy()
+		}
+	}
+	if r.Property != nil {
+		out.Property = make([]DeviceDefinitionProperty, len(r.Property))
+		for i := range r.Property {
+			out.Property[i] = r.Property[i].DeepCopy()
+		}
+	}
+	if r.Owner != nil {
+		v := r.Owner.DeepCopy()
+		out.Owner = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactPoint, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.OnlineInformation != nil {
+		v := *r.OnlineInformation
+		out.OnlineInformation = &v
+	}
+	if r.OnlineInformationElement != nil {
+		v := r.OnlineInformationElement.DeepCopy()
+		out.OnlineInformationElement = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.ParentDevice != nil {
+		v := r.ParentDevice.DeepCopy()
+		out.ParentDevice = &v
+	}
+	if r.Material != nil {
+		out.Material = make([]DeviceDefinitionMaterial, len(r.Material))
+		for i := range r.Material {
+			out.Material[i] = r.Material[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceDefinition) Equal(other DeviceDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.UdiDeviceIdentifier) != len(other.UdiDeviceIdentifier) {
+		return false
+	}
+	for i := range r.UdiDeviceIdentifier {
+		if !r.UdiDeviceIdentifier[i].Equal(other.UdiDeviceIdentifier[i]) {
+			return false
+		}
+	}
+	if (r.ManufacturerString == nil) != (other.ManufacturerString == nil) || r.ManufacturerString != nil && *r.ManufacturerString != *other.ManufacturerString {
+		return false
+	}
+	if (r.ManufacturerStringElement == nil) != (other.ManufacturerStringElement == nil) || r.ManufacturerStringElement != nil && !r.ManufacturerStringElement.Equal(*other.ManufacturerStringElement) {
+		return false
+	}
+	if (r.ManufacturerReference == nil) != (other.ManufacturerReference == nil) || r.ManufacturerReference != nil && !r.ManufacturerReference.Equal(*other.ManufacturerReference) {
+		return false
+	}
+	if len(r.DeviceName) != len(other.DeviceName) {
+		return false
+	}
+	for i := range r.DeviceName {
+		if !r.DeviceName[i].Equal(other.DeviceName[i]) {
+			return false
+		}
+	}
+	if (r.ModelNumber == nil) != (other.ModelNumber == nil) || r.ModelNumber != nil && *r.ModelNumber != *other.ModelNumber {
+		return false
+	}
+	if (r.ModelNumberElement == nil) != (other.ModelNumberElement == nil) || r.ModelNumberElement != nil && !r.ModelNumberElement.Equal(*other.ModelNumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.Specialization) != len(other.Specialization) {
+		return false
+	}
+	for i := range r.Specialization {
+		if !r.Specialization[i].Equal(other.Specialization[i]) {
+			return false
+		}
+	}
+	if len(r.Version) != len(other.Version) {
+		return false
+	}
+	for i := range r.Version {
+		if r.Version[i] != other.Version[i] {
+			return false
+		}
+	}
+	if !equalElements(r.VersionElement, other.VersionElement, false) {
+		return false
+	}
+	if len(r.Safety) != len(other.Safety) {
+		return false
+	}
+	for i := range r.Safety {
+		if !r.Safety[i].Equal(other.Safety[i]) {
+			return false
+		}
+	}
+	if len(r.ShelfLifeStorage) != len(other.ShelfLifeStorage) {
+		return false
+	}
+	for i := range r.ShelfLifeStorage {
+		if !r.ShelfLifeStorage[i].Equal(other.ShelfLifeStorage[i]) {
+			return false
+		}
+	}
+	if (r.PhysicalCharacteristics == nil) != (other.PhysicalCharacteristics == nil) || r.PhysicalCharacteristics != nil && !r.PhysicalCharacteristics.Equal(*other.PhysicalCharacteristics) {
+		return false
+	}
+	if len(r.LanguageCode) != len(other.LanguageCode) {
+		return false
+	}
+	for i := range r.LanguageCode {
+		if !r.LanguageCode[i].Equal(other.LanguageCode[i]) {
+			return false
+		}
+	}
+	if len(r.Capability) != len(other.Capability) {
+		return false
+	}
+	for i := range r.Capability {
+		if !r.Capability[i].Equal(other.Capability[i]) {
+			return false
+		}
+	}
+	if len(r.Property) != len(other.Property) {
+		return false
+	}
+	for i := range r.Property {
+		if !r.Property[i].Equal(other.Property[i]) {
+			return false
+		}
+	}
+	if (r.Owner == nil) != (other.Owner == nil) || r.Owner != nil && !r.Owner.Equal(*other.Owner) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.OnlineInformation == nil) != (other.OnlineInformation == nil) || r.OnlineInformation != nil && *r.OnlineInformation != *other.OnlineInformation {
+		return false
+	}
+	if (r.OnlineInformationElement == nil) != (other.OnlineInformationElement == nil) || r.OnlineInformationElement != nil && !r.OnlineInformationElement.Equal(*other.OnlineInformationElement) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.ParentDevice == nil) != (other.ParentDevice == nil) || r.ParentDevice != nil && !r.ParentDevice.Equal(*other.ParentDevice) {
+		return false
+	}
+	if len(r.Material) != len(other.Material) {
+		return false
+	}
+	for i := range r.Material {
+		if !r.Material[i].Equal(other.Material[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceDefinition) EqualsDeep(other DeviceDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.UdiDeviceIdentifier), len(other.UdiDeviceIdentifier), func(i, j int) bool {
+		return r.UdiDeviceIdentifier[i].EqualsDeep(other.UdiDeviceIdentifier[j])
+	}) {
+		return false
+	}
+	if (r.ManufacturerString == nil) != (other.ManufacturerString == nil) || r.ManufacturerString != nil && !equivalentString(*r.ManufacturerString, *other.ManufacturerString) {
+		return false
+	}
+	if (r.ManufacturerStringElement == nil) != (other.ManufacturerStringElement == nil) || r.ManufacturerStringElement != nil && !r.ManufacturerStringElement.EqualsDeep(*other.ManufacturerStringElement) {
+		return false
+	}
+	if (r.ManufacturerReference == nil) != (other.ManufacturerReference == nil) || r.ManufacturerReference != nil && !r.ManufacturerReference.EqualsDeep(*other.ManufacturerReference) {
+		return false
+	}
+	if !equivalentList(len(r.DeviceName), len(other.DeviceName), func(i, j int) bool {
+		return r.DeviceName[i].EqualsDeep(other.DeviceName[j])
+	}) {
+		return false
+	}
+	if (r.ModelNumber == nil) != (other.ModelNumber == nil) || r.ModelNumber != nil && !equivalentString(*r.ModelNumber, *other.ModelNumber) {
+		return false
+	}
+	if (r.ModelNumberElement == nil) != (other.ModelNumberElement == nil) || r.ModelNumberElement != nil && !r.ModelNumberElement.EqualsDeep(*other.ModelNumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Specialization), len(other.Specialization), func(i, j int) bool {
+		return r.Specialization[i].EqualsDeep(other.Specialization[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Version), len(other.Version), func(i, j int) bool {
+		return equivalentString(r.Version[i], other.Version[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.VersionElement, other.VersionElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Safety), len(other.Safety), func(i, j int) bool {
+		return r.Safety[i].EqualsDeep(other.Safety[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ShelfLifeStorage), len(other.ShelfLifeStorage), func(i, j int) bool {
+		return r.ShelfLifeStorage[i].EqualsDeep(other.ShelfLifeStorage[j])
+	}) {
+		return false
+	}
+	if (r.PhysicalCharacteristics == nil) != (other.PhysicalCharacteristics == nil) || r.PhysicalCharacteristics != nil && !r.PhysicalCharacteristics.EqualsDeep(*other.PhysicalCharacteristics) {
+		return false
+	}
+	if !equivalentList(len(r.LanguageCode), len(other.LanguageCode), func(i, j int) bool {
+		return r.LanguageCode[i].EqualsDeep(other.LanguageCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Capability), len(other.Capability), func(i, j int) bool {
+		return r.Capability[i].EqualsDeep(other.Capability[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Property), len(other.Property), func(i, j int) bool {
+		return r.Property[i].EqualsDeep(other.Property[j])
+	}) {
+		return false
+	}
+	if (r.Owner == nil) != (other.Owner == nil) || r.Owner != nil && !r.Owner.EqualsDeep(*other.Owner) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.OnlineInformation == nil) != (other.OnlineInformation == nil) || r.OnlineInformation != nil && !equivalentString(*r.OnlineInformation, *other.OnlineInformation) {
+		return false
+	}
+	if (r.OnlineInformationElement == nil) != (other.OnlineInformationElement == nil) || r.OnlineInformationElement != nil && !r.OnlineInformationElement.EqualsDeep(*other.OnlineInformationElement) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.ParentDevice == nil) != (other.ParentDevice == nil) || r.ParentDevice != nil && !r.ParentDevice.EqualsDeep(*other.ParentDevice) {
+		return false
+	}
+	if !equivalentList(len(r.Material), len(other.Material), func(i, j int) bool {
+		return r.Material[i].EqualsDeep(other.Material[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceDefinition) EqualsShallow(other DeviceDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.ManufacturerString == nil) != (other.ManufacturerString == nil) || r.ManufacturerString != nil && !equivalentString(*r.ManufacturerString, *other.ManufacturerString) {
+		return false
+	}
+	if (r.ModelNumber == nil) != (other.ModelNumber == nil) || r.ModelNumber != nil && !equivalentString(*r.ModelNumber, *other.ModelNumber) {
+		return false
+	}
+	if !equivalentList(len(r.Version), len(other.Version), func(i, j int) bool {
+		return equivalentString(r.Version[i], other.Version[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.OnlineInformation == nil) != (other.OnlineInformation == nil) || r.OnlineInformation != nil && !equivalentString(*r.OnlineInformation, *other.OnlineInformation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceDefinition as FHIR XML
+func (r DeviceDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "DeviceDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.UdiDeviceIdentifier {
+		if err := encodeXMLElement(e, "udiDeviceIdentifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "manufacturerString", r.ManufacturerString, r.ManufacturerStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "manufacturerReference", r.ManufacturerReference); err != nil {
+		return err
+	}
+	for _, v := range r.DeviceName {
+		if err := encodeXMLElement(e, "deviceName", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "modelNumber", r.ModelNumber, r.ModelNumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Specialization {
+		if err := encodeXMLElement(e, "specialization", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Version {
+		if err := encodeXMLPrimitive(e, "version", v, elementAt(r.VersionElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Safety {
+		if err := encodeXMLElement(e, "safety", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ShelfLifeStorage {
+		if err := encodeXMLElement(e, "shelfLifeStorage", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "physicalCharacteristics", r.PhysicalCharacteristics); err != nil {
+		return err
+	}
+	for _, v := range r.LanguageCode {
+		if err := encodeXMLElement(e, "languageCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Capability {
+		if err := encodeXMLElement(e, "capability", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Property {
+		if err := encodeXMLElement(e, "property", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "owner", r.Owner); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "onlineInformation", r.OnlineInformation, r.OnlineInformationElement); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "parentDevice", r.ParentDevice); err != nil {
+		return err
+	}
+	for _, v := range r.Material {
+		if err := encodeXMLElement(e, "material", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceDefinition from FHIR XML
+func (r *DeviceDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "udiDeviceIdentifier":
+				var v DeviceDefinitionUdiDeviceIdentifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UdiDeviceIdentifier = append(r.UdiDeviceIdentifier, v)
+			case "manufacturerString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ManufacturerString = &v
+				}
+				r.ManufacturerStringElement = element
+			case "manufacturerReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManufacturerReference = &v
+			case "deviceName":
+				var v DeviceDefinitionDeviceName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DeviceName = append(r.DeviceName, v)
+			case "modelNumber":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ModelNumber = &v
+				}
+				r.ModelNumberElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "specialization":
+				var v DeviceDefinitionSpecialization
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specialization = append(r.Specialization, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.VersionElement = appendElement(r.VersionElement, len(r.Version), element)
+					r.Version = append(r.Version, v)
+				}
+			case "safety":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Safety = append(r.Safety, v)
+			case "shelfLifeStorage":
+				var v ProductShelfLife
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ShelfLifeStorage = append(r.ShelfLifeStorage, v)
+			case "physicalCharacteristics":
+				var v ProdCharacteristic
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PhysicalCharacteristics = &v
+			case "languageCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LanguageCode = append(r.LanguageCode, v)
+			case "capability":
+				var v DeviceDefinitionCapability
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Capability = append(r.Capability, v)
+			case "property":
+				var v DeviceDefinitionProperty
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Property = append(r.Property, v)
+			case "owner":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Owner = &v
+			case "contact":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "onlineInformation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OnlineInformation = &v
+				}
+				r.OnlineInformationElement = element
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "parentDevice":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParentDevice = &v
+			case "material":
+				var v DeviceDefinitionMaterial
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Material = append(r.Material, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceDefinition as properties to the RDF node n
+func (r DeviceDefinition) turtle(n *turtleNode) {
+	n.resource("DeviceDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("DeviceDefinition.identifier", i, v)
+	}
+	for i, v := range r.UdiDeviceIdentifier {
+		n.element("DeviceDefinition.udiDeviceIdentifier", i, v)
+	}
+	n.primitive("DeviceDefinition.manufacturerString", -1, r.ManufacturerString, r.ManufacturerStringElement, "string")
+	n.element("DeviceDefinition.manufacturerReference", -1, r.ManufacturerReference)
+	for i, v := range r.DeviceName {
+		n.element("DeviceDefinition.deviceName", i, v)
+	}
+	n.primitive("DeviceDefinition.modelNumber", -1, r.ModelNumber, r.ModelNumberElement, "string")
+	n.element("DeviceDefinition.type", -1, r.Type)
+	for i, v := range r.Specialization {
+		n.element("DeviceDefinition.specialization", i, v)
+	}
+	for i, v := range r.Version {
+		n.primitive("DeviceDefinition.version", i, v, elementAt(r.VersionElement, i), "string")
+	}
+	for i, v := range r.Safety {
+		n.element("DeviceDefinition.safety", i, v)
+	}
+	for i, v := range r.ShelfLifeStorage {
+		n.element("DeviceDefinition.shelfLifeStorage", i, v)
+	}
+	n.element("DeviceDefinition.physicalCharacteristics", -1, r.PhysicalCharacteristics)
+	for i, v := range r.LanguageCode {
+		n.element("DeviceDefinition.languageCode", i, v)
+	}
+	for i, v := range r.Capability {
+		n.element("DeviceDefinition.capability", i, v)
+	}
+	for i, v := range r.Property {
+		n.element("DeviceDefinition.property", i, v)
+	}
+	n.element("DeviceDefinition.owner", -1, r.Owner)
+	for i, v := range r.Contact {
+		n.element("DeviceDefinition.contact", i, v)
+	}
+	n.primitive("DeviceDefinition.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("DeviceDefinition.onlineInformation", -1, r.OnlineInformation, r.OnlineInformationElement, "string")
+	for i, v := range r.Note {
+		n.element("DeviceDefinition.note", i, v)
+	}
+	n.element("DeviceDefinition.quantity", -1, r.Quantity)
+	n.element("DeviceDefinition.parentDevice", -1, r.ParentDevice)
+	for i, v := range r.Material {
+		n.element("DeviceDefinition.material", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DeviceDefinition as BSON document with the structure of its FHIR JSON
+func (r DeviceDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceDefinition from a BSON document with the structure of its FHIR JSON
+func (r *DeviceDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceDefinition as protobuf message DeviceDefinition of fhir.proto
+func (r DeviceDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceDefinition to the encoded message
+func (r DeviceDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.UdiDeviceIdentifier {
+		e.message(10, v)
+	}
+	if r.ManufacturerString != nil {
+		e.string(11, *r.ManufacturerString)
+	}
+	if r.ManufacturerReference != nil {
+		e.message(12, *r.ManufacturerReference)
+	}
+	for _, v := range r.DeviceName {
+		e.message(13, v)
+	}
+	if r.ModelNumber != nil {
+		e.string(14, *r.ModelNumber)
+	}
+	if r.Type != nil {
+		e.message(15, *r.Type)
+	}
+	for _, v := range r.Specialization {
+		e.message(16, v)
+	}
+	for _, v := range r.Version {
+		e.string(17, v)
+	}
+	for _, v := range r.Safety {
+		e.message(18, v)
+	}
+	for _, v := range r.ShelfLifeStorage {
+		e.message(19, v)
+	}
+	if r.PhysicalCharacteristics != nil {
+		e.message(20, *r.PhysicalCharacteristics)
+	}
+	for _, v := range r.LanguageCode {
+		e.message(21, v)
+	}
+	for _, v := range r.Capability {
+		e.message(22, v)
+	}
+	for _, v := range r.Property {
+		e.message(23, v)
+	}
+	if r.Owner != nil {
+		e.message(24, *r.Owner)
+	}
+	for _, v := range r.Contact {
+		e.message(25, v)
+	}
+	if r.Url != nil {
+		e.string(26, *r.Url)
+	}
+	if r.OnlineInformation != nil {
+		e.string(27, *r.OnlineInformation)
+	}
+	for _, v := range r.Note {
+		e.message(28, v)
+	}
+	if r.Quantity != nil {
+		e.message(29, *r.Quantity)
+	}
+	if r.ParentDevice != nil {
+		e.message(30, *r.ParentDevice)
+	}
+	for _, v := range r.Material {
+		e.message(31, v)
+	}
+	if r.IdElement != nil {
+		e.message(32, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(33, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(34, *r.LanguageElement)
+	}
+	if r.ManufacturerStringElement != nil {
+		e.message(35, *r.ManufacturerStringElement)
+	}
+	if r.ModelNumberElement != nil {
+		e.message(36, *r.ModelNumberElement)
+	}
+	for _, v := range r.VersionElement {
+		e.element(37, v)
+	}
+	if r.UrlElement != nil {
+		e.message(38, *r.UrlElement)
+	}
+	if r.OnlineInformationElement != nil {
+		e.message(39, *r.OnlineInformationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceDefinition from protobuf message DeviceDefinition of fhir.proto
+func (r *DeviceDefinition) UnmarshalProto(b []byte) error {
+	*r = DeviceDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v DeviceDefinitionUdiDeviceIdentifier
+			d.message(&v)
+			r.UdiDeviceIdentifier = append(r.UdiDeviceIdentifier, v)
+		case 11:
+			v := d.string()
+			r.ManufacturerString = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.ManufacturerReference = &v
+		case 13:
+			var v DeviceDefinitionDeviceName
+			d.message(&v)
+			r.DeviceName = append(r.DeviceName, v)
+		case 14:
+			v := d.string()
+			r.ModelNumber = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 16:
+			var v DeviceDefinitionSpecialization
+			d.message(&v)
+			r.Specialization = append(r.Specialization, v)
+		case 17:
+			r.Version = append(r.Version, d.string())
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.Safety = append(r.Safety, v)
+		case 19:
+			var v ProductShelfLife
+			d.message(&v)
+			r.ShelfLifeStorage = append(r.ShelfLifeStorage, v)
+		case 20:
+			var v ProdCharacteristic
+			d.message(&v)
+			r.PhysicalCharacteristics = &v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.LanguageCode = append(r.LanguageCode, v)
+		case 22:
+			var v DeviceDefinitionCapability
+			d.message(&v)
+			r.Capability = append(r.Capability, v)
+		case 23:
+			var v DeviceDefinitionProperty
+			d.message(&v)
+			r.Property = append(r.Property, v)
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Owner = &v
+		case 25:
+			var v ContactPoint
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 26:
+			v := d.string()
+			r.Url = &v
+		case 27:
+			v := d.string()
+			r.OnlineInformation = &v
+		case 28:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 29:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 30:
+			var v Reference
+			d.message(&v)
+			r.ParentDevice = &v
+		case 31:
+			var v DeviceDefinitionMaterial
+			d.message(&v)
+			r.Material = append(r.Material, v)
+		case 32:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.ManufacturerStringElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.ModelNumberElement = &v
+		case 37:
+			r.VersionElement = append(r.VersionElement, d.element())
+		case 38:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.OnlineInformationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceDefinitionUdiDeviceIdentifier as JSON into a byte slice
+func (r DeviceDefinitionUdiDeviceIdentifier) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceDefinitionUdiDeviceIdentifier as JSON object with the members in the order of the fields
+func (r DeviceDefinitionUdiDeviceIdentifier) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("deviceIdentifier")
+	e.string(r.DeviceIdentifier)
+	if r.DeviceIdentifierElement != nil {
+		e.key("_deviceIdentifier")
+		r.DeviceIdentifierElement.appendJSON(e)
+	}
+	e.key("issuer")
+	e.string(r.Issuer)
+	if r.IssuerElement != nil {
+		e.key("_issuer")
+		r.IssuerElement.appendJSON(e)
+	}
+	e.key("jurisdiction")
+	e.string(r.Jurisdiction)
+	if r.JurisdictionElement != nil {
+		e.key("_jurisdiction")
+		r.JurisdictionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceDefinitionUdiDeviceIdentifier from JSON. Unknown members are ignored.
+func (r *DeviceDefinitionUdiDeviceIdentifier) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceDefinitionUdiDeviceIdentifier
+func (r *DeviceDefinitionUdiDeviceIdentifier) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "deviceIdentifier":
+			if v, ok := d.string(); ok {
+				r.DeviceIdentifier = v
+			}
+		case "_deviceIdentifier":
+			r.DeviceIdentifierElement = d.element()
+		case "issuer":
+			if v, ok := d.string(); ok {
+				r.Issuer = v
+			}
+		case "_issuer":
+			r.IssuerElement = d.element()
+		case "jurisdiction":
+			if v, ok := d.string(); ok {
+				r.Jurisdiction = v
+			}
+		case "_jurisdiction":
+			r.JurisdictionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceDefinitionUdiDeviceIdentifier which shares no memory with the original
+func (r DeviceDefinitionUdiDeviceIdentifier) DeepCopy() DeviceDefinitionUdiDeviceIdentifier {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DeviceIdentifierElement != nil {
+		v := r.DeviceIdentifierElement.DeepCopy()
+		out.DeviceIdentifierElement = &v
+	}
+	if r.IssuerElement != nil {
+		v := r.IssuerElement.DeepCopy()
+		out.IssuerElement = &v
+	}
+	if r.JurisdictionElement != nil {
+		v := r.JurisdictionElement.DeepCopy()
+		out.JurisdictionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceDefinitionUdiDeviceIdentifier) Equal(other DeviceDefinitionUdiDeviceIdentifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.DeviceIdentifier != other.DeviceIdentifier {
+		return false
+	}
+	if (r.DeviceIdentifierElement == nil) != (other.DeviceIdentifierElement == nil) || r.DeviceIdentifierElement != nil && !r.DeviceIdentifierElement.Equal(*other.DeviceIdentifierElement) {
+		return false
+	}
+	if r.Issuer != other.Issuer {
+		return false
+	}
+	if (r.IssuerElement == nil) != (other.IssuerElement == nil) || r.IssuerElement != nil && !r.IssuerElement.Equal(*other.IssuerElement) {
+		return false
+	}
+	if r.Jurisdiction != other.Jurisdiction {
+		return false
+	}
+	if (r.JurisdictionElement == nil) != (other.JurisdictionElement == nil) || r.JurisdictionElement != nil && !r.JurisdictionElement.Equal(*other.JurisdictionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceDefinitionUdiDeviceIdentifier) EqualsDeep(other DeviceDefinitionUdiDeviceIdentifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.DeviceIdentifier, other.DeviceIdentifier) {
+		return false
+	}
+	if (r.DeviceIdentifierElement == nil) != (other.DeviceIdentifierElement == nil) || r.DeviceIdentifierElement != nil && !r.DeviceIdentifierElement.EqualsDeep(*other.DeviceIdentifierElement) {
+		return false
+	}
+	if !equivalentString(r.Issuer, other.Issuer) {
+		return false
+	}
+	if (r.IssuerElement == nil) != (other.IssuerElement == nil) || r.IssuerElement != nil && !r.IssuerElement.EqualsDeep(*other.IssuerElement) {
+		return false
+	}
+	if !equivalentString(r.Jurisdiction, other.Jurisdiction) {
+		return false
+	}
+	if (r.JurisdictionElement == nil) != (other.JurisdictionElement == nil) || r.JurisdictionElement != nil && !r.JurisdictionElement.EqualsDeep(*other.JurisdictionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceDefinitionUdiDeviceIdentifier) EqualsShallow(other DeviceDefinitionUdiDeviceIdentifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.DeviceIdentifier, other.DeviceIdentifier) {
+		return false
+	}
+	if !equivalentString(r.Issuer, other.Issuer) {
+		return false
+	}
+	if !equivalentString(r.Jurisdiction, other.Jurisdiction) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceDefinitionUdiDeviceIdentifier as FHIR XML
+func (r DeviceDefinitionUdiDeviceIdentifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "deviceIdentifier", r.DeviceIdentifier, r.DeviceIdentifierElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "issuer", r.Issuer, r.IssuerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "jurisdiction", r.Jurisdiction, r.JurisdictionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceDefinitionUdiDeviceIdentifier from FHIR XML
+func (r *DeviceDefinitionUdiDeviceIdentifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "deviceIdentifier":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DeviceIdentifier = v
+				}
+				r.DeviceIdentifierElement = element
+			case "issuer":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Issuer = v
+				}
+				r.IssuerElement = element
+			case "jurisdiction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Jurisdiction = v
+				}
+				r.JurisdictionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceDefinitionUdiDeviceIdentifier as properties to the RDF node n
+func (r DeviceDefinitionUdiDeviceIdentifier) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("DeviceDefinition.udiDeviceIdentifier.deviceIdentifier", -1, r.DeviceIdentifier, r.DeviceIdentifierElement, "string")
+	n.primitive("DeviceDefinition.udiDeviceIdentifier.issuer", -1, r.Issuer, r.IssuerElement, "string")
+	n.primitive("DeviceDefinition.udiDeviceIdentifier.jurisdiction", -1, r.Jurisdiction, r.JurisdictionElement, "string")
+}
+
+// MarshalBSON marshals the given DeviceDefinitionUdiDeviceIdentifier as BSON document with the structure of its FHIR JSON
+func (r DeviceDefinitionUdiDeviceIdentifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceDefinitionUdiDeviceIdentifier from a BSON document with the structure of its FHIR JSON
+func (r *DeviceDefinitionUdiDeviceIdentifier) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceDefinitionUdiDeviceIdentifier as protobuf message DeviceDefinitionUdiDeviceIdentifier of fhir.proto
+func (r DeviceDefinitionUdiDeviceIdentifier) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceDefinitionUdiDeviceIdentifier to the encoded message
+func (r DeviceDefinitionUdiDeviceIdentifier) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.DeviceIdentifier != "" {
+		e.string(4, r.DeviceIdentifier)
+	}
+	if r.Issuer != "" {
+		e.string(5, r.Issuer)
+	}
+	if r.Jurisdiction != "" {
+		e.string(6, r.Jurisdiction)
+	}
+	if r.DeviceIdentifierElement != nil {
+		e.message(7, *r.DeviceIdentifierElement)
+	}
+	if r.IssuerElement != nil {
+		e.message(8, *r.IssuerElement)
+	}
+	if r.JurisdictionElement != nil {
+		e.message(9, *r.JurisdictionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceDefinitionUdiDeviceIdentifier from protobuf message DeviceDefinitionUdiDeviceIdentifier of fhir.proto
+func (r *DeviceDefinitionUdiDeviceIdentifier) UnmarshalProto(b []byte) error {
+	*r = DeviceDefinitionUdiDeviceIdentifier{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.DeviceIdentifier = d.string()
+		case 5:
+			r.Issuer = d.string()
+		case 6:
+			r.Jurisdiction = d.string()
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DeviceIdentifierElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.IssuerElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.JurisdictionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceDefinitionDeviceName as JSON into a byte slice
+func (r DeviceDefinitionDeviceName) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceDefinitionDeviceName as JSON object with the members in the order of the fields
+func (r DeviceDefinitionDeviceName) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceDefinitionDeviceName from JSON. Unknown members are ignored.
+func (r *DeviceDefinitionDeviceName) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceDefinitionDeviceName
+func (r *DeviceDefinitionDeviceName) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceDefinitionDeviceName which shares no memory with the original
+func (r DeviceDefinitionDeviceName) DeepCopy() DeviceDefinitionDeviceName {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceDefinitionDeviceName) Equal(other DeviceDefinitionDeviceName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceDefinitionDeviceName) EqualsDeep(other DeviceDefinitionDeviceName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceDefinitionDeviceName) EqualsShallow(other DeviceDefinitionDeviceName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceDefinitionDeviceName as FHIR XML
+func (r DeviceDefinitionDeviceName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceDefinitionDeviceName from FHIR XML
+func (r *DeviceDefinitionDeviceName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "type":
+				var v DeviceNameType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceDefinitionDeviceName as properties to the RDF node n
+func (r DeviceDefinitionDeviceName) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("DeviceDefinition.deviceName.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("DeviceDefinition.deviceName.type", -1, r.Type, r.TypeElement, "code")
+}
+
+// MarshalBSON marshals the given DeviceDefinitionDeviceName as BSON document with the structure of its FHIR JSON
+func (r DeviceDefinitionDeviceName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceDefinitionDeviceName from a BSON document with the structure of its FHIR JSON
+func (r *DeviceDefinitionDeviceName) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceDefinitionDeviceName as protobuf message DeviceDefinitionDeviceName of fhir.proto
+func (r DeviceDefinitionDeviceName) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceDefinitionDeviceName to the encoded message
+func (r DeviceDefinitionDeviceName) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	e.varint(5, r.Type.protoNumber())
+	if r.NameElement != nil {
+		e.message(6, *r.NameElement)
+	}
+	if r.TypeElement != nil {
+		e.message(7, *r.TypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceDefinitionDeviceName from protobuf message DeviceDefinitionDeviceName of fhir.proto
+func (r *DeviceDefinitionDeviceName) UnmarshalProto(b []byte) error {
+	*r = DeviceDefinitionDeviceName{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			var v DeviceNameType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceDefinitionSpecialization as JSON into a byte slice
+func (r DeviceDefinitionSpecialization) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceDefinitionSpecialization as JSON object with the members in the order of the fields
+func (r DeviceDefinitionSpecialization) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("systemType")
+	e.string(r.SystemType)
+	if r.SystemTypeElement != nil {
+		e.key("_systemType")
+		r.SystemTypeElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceDefinitionSpecialization from JSON. Unknown members are ignored.
+func (r *DeviceDefinitionSpecialization) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceDefinitionSpecialization
+func (r *DeviceDefinitionSpecialization) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "systemType":
+			if v, ok := d.string(); ok {
+				r.SystemType = v
+			}
+		case "_systemType":
+			r.SystemTypeElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceDefinitionSpecialization which shares no memory with the original
+func (r DeviceDefinitionSpecialization) DeepCopy() DeviceDefinitionSpecialization {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SystemTypeElement != nil {
+		v := r.SystemTypeElement.DeepCopy()
+		out.SystemTypeElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceDefinitionSpecialization) Equal(other DeviceDefinitionSpecialization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.SystemType != other.SystemType {
+		return false
+	}
+	if (r.SystemTypeElement == nil) != (other.SystemTypeElement == nil) || r.SystemTypeElement != nil && !r.SystemTypeElement.Equal(*other.SystemTypeElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceDefinitionSpecialization) EqualsDeep(other DeviceDefinitionSpecialization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.SystemType, other.SystemType) {
+		return false
+	}
+	if (r.SystemTypeElement == nil) != (other.SystemTypeElement == nil) || r.SystemTypeElement != nil && !r.SystemTypeElement.EqualsDeep(*other.SystemTypeElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceDefinitionSpecialization) EqualsShallow(other DeviceDefinitionSpecialization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.SystemType, other.SystemType) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceDefinitionSpecialization as FHIR XML
+func (r DeviceDefinitionSpecialization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "systemType", r.SystemType, r.SystemTypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceDefinitionSpecialization from FHIR XML
+func (r *DeviceDefinitionSpecialization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "systemType":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SystemType = v
+				}
+				r.SystemTypeElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceDefinitionSpecialization as properties to the RDF node n
+func (r DeviceDefinitionSpecialization) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("DeviceDefinition.specialization.systemType", -1, r.SystemType, r.SystemTypeElement, "string")
+	n.primitive("DeviceDefinition.specialization.version", -1, r.Version, r.VersionElement, "string")
+}
+
+// MarshalBSON marshals the given DeviceDefinitionSpecialization as BSON document with the structure of its FHIR JSON
+func (r DeviceDefinitionSpecialization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceDefinitionSpecialization from a BSON document with the structure of its FHIR JSON
+func (r *DeviceDefinitionSpecialization) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceDefinitionSpecialization as protobuf message DeviceDefinitionSpecialization of fhir.proto
+func (r DeviceDefinitionSpecialization) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceDefinitionSpecialization to the encoded message
+func (r DeviceDefinitionSpecialization) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.SystemType != "" {
+		e.string(4, r.SystemType)
+	}
+	if r.Version != nil {
+		e.string(5, *r.Version)
+	}
+	if r.SystemTypeElement != nil {
+		e.message(6, *r.SystemTypeElement)
+	}
+	if r.VersionElement != nil {
+		e.message(7, *r.VersionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceDefinitionSpecialization from protobuf message DeviceDefinitionSpecialization of fhir.proto
+func (r *DeviceDefinitionSpecialization) UnmarshalProto(b []byte) error {
+	*r = DeviceDefinitionSpecialization{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.SystemType = d.string()
+		case 5:
+			v := d.string()
+			r.Version = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.SystemTypeElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceDefinitionCapability as JSON into a byte slice
+func (r DeviceDefinitionCapability) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceDefinitionCapability as JSON object with the members in the order of the fields
+func (r DeviceDefinitionCapability) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if len(r.Description) > 0 {
+		e.key("description")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Description {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceDefinitionCapability from JSON. Unknown members are ignored.
+func (r *DeviceDefinitionCapability) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceDefinitionCapability
+func (r *DeviceDefinitionCapability) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "description":
+			a := d.array()
+			r.Description = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Description = append(r.Description, v)
+			}
+			if r.Description == nil && !a.isNull {
+				r.Description = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceDefinitionCapability which shares no memory with the original
+func (r DeviceDefinitionCapability) DeepCopy() DeviceDefinitionCapability {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Description != nil {
+		out.Description = make([]CodeableConcept, len(r.Description))
+		for i := range r.Description {
+			out.Description[i] = r.Description[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceDefinitionCapability) Equal(other DeviceDefinitionCapability) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.Description) != len(other.Description) {
+		return false
+	}
+	for i := range r.Description {
+		if !r.Description[i].Equal(other.Description[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceDefinitionCapability) EqualsDeep(other DeviceDefinitionCapability) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Description), len(other.Description), func(i, j int) bool {
+		return r.Description[i].EqualsDeep(other.Description[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceDefinitionCapability) EqualsShallow(other DeviceDefinitionCapability) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceDefinitionCapability as FHIR XML
+func (r DeviceDefinitionCapability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Description {
+		if err := encodeXMLElement(e, "description", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceDefinitionCapability from FHIR XML
+func (r *DeviceDefinitionCapability) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "description":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Description = append(r.Description, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceDefinitionCapability as properties to the RDF node n
+func (r DeviceDefinitionCapability) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("DeviceDefinition.capability.type", -1, r.Type)
+	for i, v := range r.Description {
+		n.element("DeviceDefinition.capability.description", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DeviceDefinitionCapability as BSON document with the structure of its FHIR JSON
+func (r DeviceDefinitionCapability) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceDefinitionCapability from a BSON document with the structure of its FHIR JSON
+func (r *DeviceDefinitionCapability) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceDefinitionCapability as protobuf message DeviceDefinitionCapability of fhir.proto
+func (r DeviceDefinitionCapability) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceDefinitionCapability to the encoded message
+func (r DeviceDefinitionCapability) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	for _, v := range r.Description {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceDefinitionCapability from protobuf message DeviceDefinitionCapability of fhir.proto
+func (r *DeviceDefinitionCapability) UnmarshalProto(b []byte) error {
+	*r = DeviceDefinitionCapability{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Description = append(r.Description, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceDefinitionProperty as JSON into a byte slice
+func (r DeviceDefinitionProperty) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceDefinitionProperty as JSON object with the members in the order of the fields
+func (r DeviceDefinitionProperty) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if len(r.ValueQuantity) > 0 {
+		e.key("valueQuantity")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ValueQuantity {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ValueCode) > 0 {
+		e.key("valueCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ValueCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceDefinitionProperty from JSON. Unknown members are ignored.
+func (r *DeviceDefinitionProperty) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceDefinitionProperty
+func (r *DeviceDefinitionProperty) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "valueQuantity":
+			a := d.array()
+			r.ValueQuantity = nil
+			for a.next() {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = append(r.ValueQuantity, v)
+			}
+			if r.ValueQuantity == nil && !a.isNull {
+				r.ValueQuantity = []Quantity{}
+			}
+		case "valueCode":
+			a := d.array()
+			r.ValueCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCode = append(r.ValueCode, v)
+			}
+			if r.ValueCode == nil && !a.isNull {
+				r.ValueCode = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceDefinitionProperty which shares no memory with the original
+func (r DeviceDefinitionProperty) DeepCopy() DeviceDefinitionProperty {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.ValueQuantity != nil {
+		out.ValueQuantity = make([]Quantity, len(r.ValueQuantity))
+		for i := range r.ValueQuantity {
+			out.ValueQuantity[i] = r.ValueQuantity[i].DeepCopy()
+		}
+	}
+	if r.ValueCode != nil {
+		out.ValueCode = make([]CodeableConcept, len(r.ValueCode))
+		for i := range r.ValueCode {
+			out.ValueCode[i] = r.ValueCode[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceDefinitionProperty) Equal(other DeviceDefinitionProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.ValueQuantity) != len(other.ValueQuantity) {
+		return false
+	}
+	for i := range r.ValueQuantity {
+		if !r.ValueQuantity[i].Equal(other.ValueQuantity[i]) {
+			return false
+		}
+	}
+	if len(r.ValueCode) != len(other.ValueCode) {
+		return false
+	}
+	for i := range r.ValueCode {
+		if !r.ValueCode[i].Equal(other.ValueCode[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceDefinitionProperty) EqualsDeep(other DeviceDefinitionProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.ValueQuantity), len(other.ValueQuantity), func(i, j int) bool {
+		return r.ValueQuantity[i].EqualsDeep(other.ValueQuantity[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ValueCode), len(other.ValueCode), func(i, j int) bool {
+		return r.ValueCode[i].EqualsDeep(other.ValueCode[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceDefinitionProperty) EqualsShallow(other DeviceDefinitionProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceDefinitionProperty as FHIR XML
+func (r DeviceDefinitionProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.ValueQuantity {
+		if err := encodeXMLElement(e, "valueQuantity", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ValueCode {
+		if err := encodeXMLElement(e, "valueCode", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceDefinitionProperty from FHIR XML
+func (r *DeviceDefinitionProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = append(r.ValueQuantity, v)
+			case "valueCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCode = append(r.ValueCode, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceDefinitionProperty as properties to the RDF node n
+func (r DeviceDefinitionProperty) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("DeviceDefinition.property.type", -1, r.Type)
+	for i, v := range r.ValueQuantity {
+		n.element("DeviceDefinition.property.valueQuantity", i, v)
+	}
+	for i, v := range r.ValueCode {
+		n.element("DeviceDefinition.property.valueCode", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DeviceDefinitionProperty as BSON document with the structure of its FHIR JSON
+func (r DeviceDefinitionProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceDefinitionProperty from a BSON document with the structure of its FHIR JSON
+func (r *DeviceDefinitionProperty) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceDefinitionProperty as protobuf message DeviceDefinitionProperty of fhir.proto
+func (r DeviceDefinitionProperty) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceDefinitionProperty to the encoded message
+func (r DeviceDefinitionProperty) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	for _, v := range r.ValueQuantity {
+		e.message(5, v)
+	}
+	for _, v := range r.ValueCode {
+		e.message(6, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceDefinitionProperty from protobuf message DeviceDefinitionProperty of fhir.proto
+func (r *DeviceDefinitionProperty) UnmarshalProto(b []byte) error {
+	*r = DeviceDefinitionProperty{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = append(r.ValueQuantity, v)
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCode = append(r.ValueCode, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceDefinitionMaterial as JSON into a byte slice
+func (r DeviceDefinitionMaterial) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceDefinitionMaterial as JSON object with the members in the order of the fields
+func (r DeviceDefinitionMaterial) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("substance")
+	r.Substance.appendJSON(e)
+	if r.Alternate != nil {
+		e.key("alternate")
+		e.bool(*r.Alternate)
+	}
+	if r.AlternateElement != nil {
+		e.key("_alternate")
+		r.AlternateElement.appendJSON(e)
+	}
+	if r.AllergenicIndicator != nil {
+		e.key("allergenicIndicator")
+		e.bool(*r.AllergenicIndicator)
+	}
+	if r.AllergenicIndicatorElement != nil {
+		e.key("_allergenicIndicator")
+		r.AllergenicIndicatorElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceDefinitionMaterial from JSON. Unknown members are ignored.
+func (r *DeviceDefinitionMaterial) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceDefinitionMaterial
+func (r *DeviceDefinitionMaterial) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "substance":
+			r.Substance.decodeJSON(d)
+		case "alternate":
+			if v, ok := d.bool(); ok {
+				r.Alternate = &v
+			} else {
+				r.Alternate = nil
+			}
+		case "_alternate":
+			r.AlternateElement = d.element()
+		case "allergenicIndicator":
+			if v, ok := d.bool(); ok {
+				r.AllergenicIndicator = &v
+			} else {
+				r.AllergenicIndicator = nil
+			}
+		case "_allergenicIndicator":
+			r.AllergenicIndicatorElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceDefinitionMaterial which shares no memory with the original
+func (r DeviceDefinitionMaterial) DeepCopy() DeviceDefinitionMaterial {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Substance = r.Substance.DeepCopy()
+	if r.Alternate != nil {
+		v := *r.Alternate
+		out.Alternate = &v
+	}
+	if r.AlternateElement != nil {
+		v := r.AlternateElement.DeepCopy()
+		out.AlternateElement = &v
+	}
+	if r.AllergenicIndicator != nil {
+		v := *r.AllergenicIndicator
+		out.AllergenicIndicator = &v
+	}
+	if r.AllergenicIndicatorElement != nil {
+		v := r.AllergenicIndicatorElement.DeepCopy()
+		out.AllergenicIndicatorElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceDefinitionMaterial) Equal(other DeviceDefinitionMaterial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Substance.Equal(other.Substance) {
+		return false
+	}
+	if (r.Alternate == nil) != (other.Alternate == nil) || r.Alternate != nil && *r.Alternate != *other.Alternate {
+		return false
+	}
+	if (r.AlternateElement == nil) != (other.AlternateElement == nil) || r.AlternateElement != nil && !r.AlternateElement.Equal(*other.AlternateElement) {
+		return false
+	}
+	if (r.AllergenicIndicator == nil) != (other.AllergenicIndicator == nil) || r.AllergenicIndicator != nil && *r.AllergenicIndicator != *other.AllergenicIndicator {
+		return false
+	}
+	if (r.AllergenicIndicatorElement == nil) != (other.AllergenicIndicatorElement == nil) || r.AllergenicIndicatorElement != nil && !r.AllergenicIndicatorElement.Equal(*other.AllergenicIndicatorElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceDefinitionMaterial) EqualsDeep(other DeviceDefinitionMaterial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Substance.EqualsDeep(other.Substance) {
+		return false
+	}
+	if (r.Alternate == nil) != (other.Alternate == nil) || r.Alternate != nil && *r.Alternate != *other.Alternate {
+		return false
+	}
+	if (r.AlternateElement == nil) != (other.AlternateElement == nil) || r.AlternateElement != nil && !r.AlternateElement.EqualsDeep(*other.AlternateElement) {
+		return false
+	}
+	if (r.AllergenicIndicator == nil) != (other.AllergenicIndicator == nil) || r.AllergenicIndicator != nil && *r.AllergenicIndicator != *other.AllergenicIndicator {
+		return false
+	}
+	if (r.AllergenicIndicatorElement == nil) != (other.AllergenicIndicatorElement == nil) || r.AllergenicIndicatorElement != nil && !r.AllergenicIndicatorElement.EqualsDeep(*other.AllergenicIndicatorElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceDefinitionMaterial) EqualsShallow(other DeviceDefinitionMaterial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Alternate == nil) != (other.Alternate == nil) || r.Alternate != nil && *r.Alternate != *other.Alternate {
+		return false
+	}
+	if (r.AllergenicIndicator == nil) != (other.AllergenicIndicator == nil) || r.AllergenicIndicator != nil && *r.AllergenicIndicator != *other.AllergenicIndicator {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceDefinitionMaterial as FHIR XML
+func (r DeviceDefinitionMaterial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "substance", r.Substance); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "alternate", r.Alternate, r.AlternateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "allergenicIndicator", r.AllergenicIndicator, r.AllergenicIndicatorElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceDefinitionMaterial from FHIR XML
+func (r *DeviceDefinitionMaterial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "substance":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Substance = v
+			case "alternate":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Alternate = &v
+				}
+				r.AlternateElement = element
+			case "allergenicIndicator":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllergenicIndicator = &v
+				}
+				r.AllergenicIndicatorElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceDefinitionMaterial as properties to the RDF node n
+func (r DeviceDefinitionMaterial) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("DeviceDefinition.material.substance", -1, r.Substance)
+	n.primitive("DeviceDefinition.material.alternate", -1, r.Alternate, r.AlternateElement, "boolean")
+	n.primitive("DeviceDefinition.material.allergenicIndicator", -1, r.AllergenicIndicator, r.AllergenicIndicatorElement, "boolean")
+}
+
+// MarshalBSON marshals the given DeviceDefinitionMaterial as BSON document with the structure of its FHIR JSON
+func (r DeviceDefinitionMaterial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceDefinitionMaterial from a BSON document with the structure of its FHIR JSON
+func (r *DeviceDefinitionMaterial) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceDefinitionMaterial as protobuf message DeviceDefinitionMaterial of fhir.proto
+func (r DeviceDefinitionMaterial) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceDefinitionMaterial to the encoded message
+func (r DeviceDefinitionMaterial) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Substance)
+	if r.Alternate != nil {
+		e.varint(5, protoBool(*r.Alternate))
+	}
+	if r.AllergenicIndicator != nil {
+		e.varint(6, protoBool(*r.AllergenicIndicator))
+	}
+	if r.AlternateElement != nil {
+		e.message(7, *r.AlternateElement)
+	}
+	if r.AllergenicIndicatorElement != nil {
+		e.message(8, *r.AllergenicIndicatorElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceDefinitionMaterial from protobuf message DeviceDefinitionMaterial of fhir.proto
+func (r *DeviceDefinitionMaterial) UnmarshalProto(b []byte) error {
+	*r = DeviceDefinitionMaterial{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Substance = v
+		case 5:
+			v := d.bool()
+			r.Alternate = &v
+		case 6:
+			v := d.bool()
+			r.AllergenicIndicator = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.AlternateElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.AllergenicIndicatorElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalDeviceDefinition unmarshals a DeviceDefinition.
 func UnmarshalDeviceDefinition(b []byte) (DeviceDefinition, error) {
 	var deviceDefinition DeviceDefinition
-	if err := json.Unmarshal(b, &deviceDefinition); err != nil {
+	if err := deviceDefinition.UnmarshalJSON(b); err != nil {
 		return deviceDefinition, err
 	}
 	return deviceDefinition, nil
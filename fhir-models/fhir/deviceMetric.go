@@ -14,56 +14,1621 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // DeviceMetric is documented here http://hl7.org/fhir/StructureDefinition/DeviceMetric
 type DeviceMetric struct {
-	Id                *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type              CodeableConcept                `bson:"type" json:"type"`
-	Unit              *CodeableConcept               `bson:"unit,omitempty" json:"unit,omitempty"`
-	Source            *Reference                     `bson:"source,omitempty" json:"source,omitempty"`
-	Parent            *Reference                     `bson:"parent,omitempty" json:"parent,omitempty"`
-	OperationalStatus *DeviceMetricOperationalStatus `bson:"operationalStatus,omitempty" json:"operationalStatus,omitempty"`
-	Color             *DeviceMetricColor             `bson:"color,omitempty" json:"color,omitempty"`
-	Category          DeviceMetricCategory           `bson:"category" json:"category"`
-	MeasurementPeriod *Timing                        `bson:"measurementPeriod,omitempty" json:"measurementPeriod,omitempty"`
-	Calibration       []DeviceMetricCalibration      `bson:"calibration,omitempty" json:"calibration,omitempty"`
+	Id                       *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                *Element                       `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                     *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                []json.RawMessage              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type                     CodeableConcept                `bson:"type" json:"type"`
+	Unit                     *CodeableConcept               `bson:"unit,omitempty" json:"unit,omitempty"`
+	Source                   *Reference                     `bson:"source,omitempty" json:"source,omitempty"`
+	Parent                   *Reference                     `bson:"parent,omitempty" json:"parent,omitempty"`
+	OperationalStatus        *DeviceMetricOperationalStatus `bson:"operationalStatus,omitempty" json:"operationalStatus,omitempty"`
+	OperationalStatusElement *Element                       `bson:"_operationalStatus,omitempty" json:"_operationalStatus,omitempty"`
+	Color                    *DeviceMetricColor             `bson:"color,omitempty" json:"color,omitempty"`
+	ColorElement             *Element                       `bson:"_color,omitempty" json:"_color,omitempty"`
+	Category                 DeviceMetricCategory           `bson:"category" json:"category"`
+	CategoryElement          *Element                       `bson:"_category,omitempty" json:"_category,omitempty"`
+	MeasurementPeriod        *Timing                        `bson:"measurementPeriod,omitempty" json:"measurementPeriod,omitempty"`
+	Calibration              []DeviceMetricCalibration      `bson:"calibration,omitempty" json:"calibration,omitempty"`
 }
 type DeviceMetricCalibration struct {
 	Id                *string                       `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              *DeviceMetricCalibrationType  `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element                      `bson:"_type,omitempty" json:"_type,omitempty"`
 	State             *DeviceMetricCalibrationState `bson:"state,omitempty" json:"state,omitempty"`
+	StateElement      *Element                      `bson:"_state,omitempty" json:"_state,omitempty"`
 	Time              *string                       `bson:"time,omitempty" json:"time,omitempty"`
+	TimeElement       *Element                      `bson:"_time,omitempty" json:"_time,omitempty"`
 }
-type OtherDeviceMetric DeviceMetric
 
 // MarshalJSON marshals the given DeviceMetric as JSON into a byte slice
 func (r DeviceMetric) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherDeviceMetric
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherDeviceMetric: OtherDeviceMetric(r),
-		ResourceType:      "DeviceMetric",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceMetric as JSON object with the members in the order of the fields
+func (r DeviceMetric) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"DeviceMetric\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.Unit != nil {
+		e.key("unit")
+		r.Unit.appendJSON(e)
+	}
+	if r.Source != nil {
+		e.key("source")
+		r.Source.appendJSON(e)
+	}
+	if r.Parent != nil {
+		e.key("parent")
+		r.Parent.appendJSON(e)
+	}
+	if r.OperationalStatus != nil {
+		e.key("operationalStatus")
+		e.string(r.OperationalStatus.Code())
+	}
+	if r.OperationalStatusElement != nil {
+		e.key("_operationalStatus")
+		r.OperationalStatusElement.appendJSON(e)
+	}
+	if r.Color != nil {
+		e.key("color")
+		e.string(r.Color.Code())
+	}
+	if r.ColorElement != nil {
+		e.key("_color")
+		r.ColorElement.appendJSON(e)
+	}
+	e.key("category")
+	e.string(r.Category.Code())
+	if r.CategoryElement != nil {
+		e.key("_category")
+		r.CategoryElement.appendJSON(e)
+	}
+	if r.MeasurementPeriod != nil {
+		e.key("measurementPeriod")
+		r.MeasurementPeriod.appendJSON(e)
+	}
+	if len(r.Calibration) > 0 {
+		e.key("calibration")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Calibration {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceMetric from JSON. Unknown members are ignored.
+func (r *DeviceMetric) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceMetric
+func (r *DeviceMetric) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "unit":
+			if d.null() {
+				r.Unit = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Unit = &v
+			}
+		case "source":
+			if d.null() {
+				r.Source = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = &v
+			}
+		case "parent":
+			if d.null() {
+				r.Parent = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Parent = &v
+			}
+		case "operationalStatus":
+			if d.null() {
+				r.OperationalStatus = nil
+			} else {
+				var v DeviceMetricOperationalStatus
+				d.unmarshal(&v)
+				r.OperationalStatus = &v
+			}
+		case "_operationalStatus":
+			r.OperationalStatusElement = d.element()
+		case "color":
+			if d.null() {
+				r.Color = nil
+			} else {
+				var v DeviceMetricColor
+				d.unmarshal(&v)
+				r.Color = &v
+			}
+		case "_color":
+			r.ColorElement = d.element()
+		case "category":
+			d.unmarshal(&r.Category)
+		case "_category":
+			r.CategoryElement = d.element()
+		case "measurementPeriod":
+			if d.null() {
+				r.MeasurementPeriod = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.MeasurementPeriod = &v
+			}
+		case "calibration":
+			a := d.array()
+			r.Calibration = nil
+			for a.next() {
+				var v DeviceMetricCalibration
+				v.decodeJSON(d)
+				r.Calibration = append(r.Calibration, v)
+			}
+			if r.Calibration == nil && !a.isNull {
+				r.Calibration = []DeviceMetricCalibration{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceMetric which shares no memory with the original
+func (r DeviceMetric) DeepCopy() DeviceMetric {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Unit != nil {
+		v := r.Unit.DeepCopy()
+		out.Unit = &v
+	}
+	if r.Source != nil {
+		v := r.Source.DeepCopy()
+		out.Source = &v
+	}
+	if r.Parent != nil {
+		v := r.Parent.DeepCopy()
+		out.Parent = &v
+	}
+	if r.OperationalStatus != nil {
+		v := *r.OperationalStatus
+		out.OperationalStatus = &v
+	}
+	if r.OperationalStatusElement != nil {
+		v := r.OperationalStatusElement.DeepCopy()
+		out.OperationalStatusElement = &v
+	}
+	if r.Color != nil {
+		v := *r.Color
+		out.Color = &v
+	}
+	if r.ColorElement != nil {
+		v := r.ColorElement.DeepCopy()
+		out.ColorElement = &v
+	}
+	if r.CategoryElement != nil {
+		v := r.CategoryElement.DeepCopy()
+		out.CategoryElement = &v
+	}
+	if r.MeasurementPeriod != nil {
+		v := r.MeasurementPeriod.DeepCopy()
+		out.MeasurementPeriod = &v
+	}
+	if r.Calibration != nil {
+		out.Calibration = make([]DeviceMetricCalibration, len(r.Calibration))
+		for i := range r.Calibration {
+			out.Calibration[i] = r.Calibration[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceMetric) Equal(other DeviceMetric) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !r.Unit.Equal(*other.Unit) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !r.Source.Equal(*other.Source) {
+		return false
+	}
+	if (r.Parent == nil) != (other.Parent == nil) || r.Parent != nil && !r.Parent.Equal(*other.Parent) {
+		return false
+	}
+	if (r.OperationalStatus == nil) != (other.OperationalStatus == nil) || r.OperationalStatus != nil && *r.OperationalStatus != *other.OperationalStatus {
+		return false
+	}
+	if (r.OperationalStatusElement == nil) != (other.OperationalStatusElement == nil) || r.OperationalStatusElement != nil && !r.OperationalStatusElement.Equal(*other.OperationalStatusElement) {
+		return false
+	}
+	if (r.Color == nil) != (other.Color == nil) || r.Color != nil && *r.Color != *other.Color {
+		return false
+	}
+	if (r.ColorElement == nil) != (other.ColorElement == nil) || r.ColorElement != nil && !r.ColorElement.Equal(*other.ColorElement) {
+		return false
+	}
+	if r.Category != other.Category {
+		return false
+	}
+	if (r.CategoryElement == nil) != (other.CategoryElement == nil) || r.CategoryElement != nil && !r.CategoryElement.Equal(*other.CategoryElement) {
+		return false
+	}
+	if (r.MeasurementPeriod == nil) != (other.MeasurementPeriod == nil) || r.MeasurementPeriod != nil && !r.MeasurementPeriod.Equal(*other.MeasurementPeriod) {
+		return false
+	}
+	if len(r.Calibration) != len(other.Calibration) {
+		return false
+	}
+	for i := range r.Calibration {
+		if !r.Calibration[i].Equal(other.Calibration[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceMetric) EqualsDeep(other DeviceMetric) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !r.Unit.EqualsDeep(*other.Unit) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !r.Source.EqualsDeep(*other.Source) {
+		return false
+	}
+	if (r.Parent == nil) != (other.Parent == nil) || r.Parent != nil && !r.Parent.EqualsDeep(*other.Parent) {
+		return false
+	}
+	if (r.OperationalStatus == nil) != (other.OperationalStatus == nil) || r.OperationalStatus != nil && *r.OperationalStatus != *other.OperationalStatus {
+		return false
+	}
+	if (r.OperationalStatusElement == nil) != (other.OperationalStatusElement == nil) || r.OperationalStatusElement != nil && !r.OperationalStatusElement.EqualsDeep(*other.OperationalStatusElement) {
+		return false
+	}
+	if (r.Color == nil) != (other.Color == nil) || r.Color != nil && *r.Color != *other.Color {
+		return false
+	}
+	if (r.ColorElement == nil) != (other.ColorElement == nil) || r.ColorElement != nil && !r.ColorElement.EqualsDeep(*other.ColorElement) {
+		return false
+	}
+	if r.Category != other.Category {
+		return false
+	}
+	if (r.CategoryElement == nil) != (other.CategoryElement == nil) || r.CategoryElement != nil && !r.CategoryElement.EqualsDeep(*other.CategoryElement) {
+		return false
+	}
+	if (r.MeasurementPeriod == nil) != (other.MeasurementPeriod == nil) || r.MeasurementPeriod != nil && !r.MeasurementPeriod.EqualsDeep(*other.MeasurementPeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Calibration), len(other.Calibration), func(i, j int) bool {
+		return r.Calibration[i].EqualsDeep(other.Calibration[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceMetric) EqualsShallow(other DeviceMetric) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.OperationalStatus == nil) != (other.OperationalStatus == nil) || r.OperationalStatus != nil && *r.OperationalStatus != *other.OperationalStatus {
+		return false
+	}
+	if (r.Color == nil) != (other.Color == nil) || r.Color != nil && *r.Color != *other.Color {
+		return false
+	}
+	if r.Category != other.Category {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceMetric as FHIR XML
+func (r DeviceMetric) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "DeviceMetric",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unit", r.Unit); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "source", r.Source); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "parent", r.Parent); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "operationalStatus", r.OperationalStatus, r.OperationalStatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "color", r.Color, r.ColorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "category", r.Category, r.CategoryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "measurementPeriod", r.MeasurementPeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Calibration {
+		if err := encodeXMLElement(e, "calibration", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceMetric from FHIR XML
+func (r *DeviceMetric) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "unit":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Unit = &v
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = &v
+			case "parent":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parent = &v
+			case "operationalStatus":
+				var v DeviceMetricOperationalStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OperationalStatus = &v
+				}
+				r.OperationalStatusElement = element
+			case "color":
+				var v DeviceMetricColor
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Color = &v
+				}
+				r.ColorElement = element
+			case "category":
+				var v DeviceMetricCategory
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Category = v
+				}
+				r.CategoryElement = element
+			case "measurementPeriod":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MeasurementPeriod = &v
+			case "calibration":
+				var v DeviceMetricCalibration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Calibration = append(r.Calibration, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceMetric as properties to the RDF node n
+func (r DeviceMetric) turtle(n *turtleNode) {
+	n.resource("DeviceMetric", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("DeviceMetric.identifier", i, v)
+	}
+	n.element("DeviceMetric.type", -1, r.Type)
+	n.element("DeviceMetric.unit", -1, r.Unit)
+	n.element("DeviceMetric.source", -1, r.Source)
+	n.element("DeviceMetric.parent", -1, r.Parent)
+	n.primitive("DeviceMetric.operationalStatus", -1, r.OperationalStatus, r.OperationalStatusElement, "code")
+	n.primitive("DeviceMetric.color", -1, r.Color, r.ColorElement, "code")
+	n.primitive("DeviceMetric.category", -1, r.Category, r.CategoryElement, "code")
+	n.element("DeviceMetric.measurementPeriod", -1, r.MeasurementPeriod)
+	for i, v := range r.Calibration {
+		n.element("DeviceMetric.calibration", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DeviceMetric as BSON document with the structure of its FHIR JSON
+func (r DeviceMetric) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceMetric from a BSON document with the structure of its FHIR JSON
+func (r *DeviceMetric) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceMetric as protobuf message DeviceMetric of fhir.proto
+func (r DeviceMetric) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceMetric to the encoded message
+func (r DeviceMetric) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.message(10, r.Type)
+	if r.Unit != nil {
+		e.message(11, *r.Unit)
+	}
+	if r.Source != nil {
+		e.message(12, *r.Source)
+	}
+	if r.Parent != nil {
+		e.message(13, *r.Parent)
+	}
+	if r.OperationalStatus != nil {
+		e.varint(14, r.OperationalStatus.protoNumber())
+	}
+	if r.Color != nil {
+		e.varint(15, r.Color.protoNumber())
+	}
+	e.varint(16, r.Category.protoNumber())
+	if r.MeasurementPeriod != nil {
+		e.message(17, *r.MeasurementPeriod)
+	}
+	for _, v := range r.Calibration {
+		e.message(18, v)
+	}
+	if r.IdElement != nil {
+		e.message(19, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(20, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(21, *r.LanguageElement)
+	}
+	if r.OperationalStatusElement != nil {
+		e.message(22, *r.OperationalStatusElement)
+	}
+	if r.ColorElement != nil {
+		e.message(23, *r.ColorElement)
+	}
+	if r.CategoryElement != nil {
+		e.message(24, *r.CategoryElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceMetric from protobuf message DeviceMetric of fhir.proto
+func (r *DeviceMetric) UnmarshalProto(b []byte) error {
+	*r = DeviceMetric{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Unit = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Source = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Parent = &v
+		case 14:
+			var v DeviceMetricOperationalStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.OperationalStatus = &v
+		case 15:
+			var v DeviceMetricColor
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Color = &v
+		case 16:
+			var v DeviceMetricCategory
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Category = v
+		case 17:
+			var v Timing
+			d.message(&v)
+			r.MeasurementPeriod = &v
+		case 18:
+			var v DeviceMetricCalibration
+			d.message(&v)
+			r.Calibration = append(r.Calibration, v)
+		case 19:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.OperationalStatusElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ColorElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.CategoryElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceMetricCalibration as JSON into a byte slice
+func (r DeviceMetricCalibration) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceMetricCalibration as JSON object with the members in the order of the fields
+func (r DeviceMetricCalibration) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(r.Type.Code())
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.State != nil {
+		e.key("state")
+		e.string(r.State.Code())
+	}
+	if r.StateElement != nil {
+		e.key("_state")
+		r.StateElement.appendJSON(e)
+	}
+	if r.Time != nil {
+		e.key("time")
+		e.string(*r.Time)
+	}
+	if r.TimeElement != nil {
+		e.key("_time")
+		r.TimeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceMetricCalibration from JSON. Unknown members are ignored.
+func (r *DeviceMetricCalibration) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceMetricCalibration
+func (r *DeviceMetricCalibration) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v DeviceMetricCalibrationType
+				d.unmarshal(&v)
+				r.Type = &v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "state":
+			if d.null() {
+				r.State = nil
+			} else {
+				var v DeviceMetricCalibrationState
+				d.unmarshal(&v)
+				r.State = &v
+			}
+		case "_state":
+			r.StateElement = d.element()
+		case "time":
+			if v, ok := d.string(); ok {
+				r.Time = &v
+			} else {
+				r.Time = nil
+			}
+		case "_time":
+			r.TimeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceMetricCalibration which shares no memory with the original
+func (r DeviceMetricCalibration) DeepCopy() DeviceMetricCalibration {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.State != nil {
+		v := *r.State
+		out.State = &v
+	}
+	if r.StateElement != nil {
+		v := r.StateElement.DeepCopy()
+		out.StateElement = &v
+	}
+	if r.Time != nil {
+		v := *r.Time
+		out.Time = &v
+	}
+	if r.TimeElement != nil {
+		v := r.TimeElement.DeepCopy()
+		out.TimeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceMetricCalibration) Equal(other DeviceMetricCalibration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.State == nil) != (other.State == nil) || r.State != nil && *r.State != *other.State {
+		return false
+	}
+	if (r.StateElement == nil) != (other.StateElement == nil) || r.StateElement != nil && !r.StateElement.Equal(*other.StateElement) {
+		return false
+	}
+	if (r.Time == nil) != (other.Time == nil) || r.Time != nil && *r.Time != *other.Time {
+		return false
+	}
+	if (r.TimeElement == nil) != (other.TimeElement == nil) || r.TimeElement != nil && !r.TimeElement.Equal(*other.TimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceMetricCalibration) EqualsDeep(other DeviceMetricCalibration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.State == nil) != (other.State == nil) || r.State != nil && *r.State != *other.State {
+		return false
+	}
+	if (r.StateElement == nil) != (other.StateElement == nil) || r.StateElement != nil && !r.StateElement.EqualsDeep(*other.StateElement) {
+		return false
+	}
+	if (r.Time == nil) != (other.Time == nil) || r.Time != nil && !equivalentString(*r.Time, *other.Time) {
+		return false
+	}
+	if (r.TimeElement == nil) != (other.TimeElement == nil) || r.TimeElement != nil && !r.TimeElement.EqualsDeep(*other.TimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceMetricCalibration) EqualsShallow(other DeviceMetricCalibration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.State == nil) != (other.State == nil) || r.State != nil && *r.State != *other.State {
+		return false
+	}
+	if (r.Time == nil) != (other.Time == nil) || r.Time != nil && !equivalentString(*r.Time, *other.Time) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceMetricCalibration as FHIR XML
+func (r DeviceMetricCalibration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "state", r.State, r.StateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "time", r.Time, r.TimeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceMetricCalibration from FHIR XML
+func (r *DeviceMetricCalibration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v DeviceMetricCalibrationType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "state":
+				var v DeviceMetricCalibrationState
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.State = &v
+				}
+				r.StateElement = element
+			case "time":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Time = &v
+				}
+				r.TimeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceMetricCalibration as properties to the RDF node n
+func (r DeviceMetricCalibration) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("DeviceMetric.calibration.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("DeviceMetric.calibration.state", -1, r.State, r.StateElement, "code")
+	n.primitive("DeviceMetric.calibration.time", -1, r.Time, r.TimeElement, "instant")
+}
+
+// MarshalBSON marshals the given DeviceMetricCalibration as BSON document with the structure of its FHIR JSON
+func (r DeviceMetricCalibration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceMetricCalibration from a BSON document with the structure of its FHIR JSON
+func (r *DeviceMetricCalibration) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceMetricCalibration as protobuf message DeviceMetricCalibration of fhir.proto
+func (r DeviceMetricCalibration) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceMetricCalibration to the encoded message
+func (r DeviceMetricCalibration) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.varint(4, r.Type.protoNumber())
+	}
+	if r.State != nil {
+		e.varint(5, r.State.protoNumber())
+	}
+	if r.Time != nil {
+		e.string(6, *r.Time)
+	}
+	if r.TypeElement != nil {
+		e.message(7, *r.TypeElement)
+	}
+	if r.StateElement != nil {
+		e.message(8, *r.StateElement)
+	}
+	if r.TimeElement != nil {
+		e.message(9, *r.TimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceMetricCalibration from protobuf message DeviceMetricCalibration of fhir.proto
+func (r *DeviceMetricCalibration) UnmarshalProto(b []byte) error {
+	*r = DeviceMetricCalibration{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v DeviceMetricCalibrationType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = &v
+		case 5:
+			var v DeviceMetricCalibrationState
+			d.enum(d.varint(), v.setProtoNumber)
+			r.State = &v
+		case 6:
+			v := d.string()
+			r.Time = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.StateElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.TimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalDeviceMetric unmarshals a DeviceMetric.
 func UnmarshalDeviceMetric(b []byte) (DeviceMetric, error) {
 	var deviceMetric DeviceMetric
-	if err := json.Unmarshal(b, &deviceMetric); err != nil {
+	if err := deviceMetric.UnmarshalJSON(b); err != nil {
 		return deviceMetric, err
 	}
 	return deviceMetric, nil
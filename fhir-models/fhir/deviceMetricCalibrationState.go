@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code DeviceMetricCalibrationState) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricCalibrationState) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DeviceMetricCalibrationState) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "not-calibrated":
 		*code = DeviceMetricCalibrationStateNotCalibrated
@@ -52,6 +56,12 @@ func (code *DeviceMetricCalibrationState) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DeviceMetricCalibrationState) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DeviceMetricCalibrationState) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DeviceMetricCalibrationState) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code DeviceMetricCalibrationState) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DeviceMetricCalibrationState) protoNumber() uint64 {
+	switch code {
+	case DeviceMetricCalibrationStateNotCalibrated:
+		return 1
+	case DeviceMetricCalibrationStateCalibrationRequired:
+		return 2
+	case DeviceMetricCalibrationStateCalibrated:
+		return 3
+	case DeviceMetricCalibrationStateUnspecified:
+		return 4
+	}
+	return 0
+}
+func (code *DeviceMetricCalibrationState) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DeviceMetricCalibrationStateNotCalibrated
+	case 2:
+		*code = DeviceMetricCalibrationStateCalibrationRequired
+	case 3:
+		*code = DeviceMetricCalibrationStateCalibrated
+	case 4:
+		*code = DeviceMetricCalibrationStateUnspecified
+	default:
+		return false
+	}
+	return true
+}
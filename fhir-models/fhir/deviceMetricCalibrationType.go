@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code DeviceMetricCalibrationType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricCalibrationType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DeviceMetricCalibrationType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "unspecified":
 		*code = DeviceMetricCalibrationTypeUnspecified
@@ -52,6 +56,12 @@ func (code *DeviceMetricCalibrationType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DeviceMetricCalibrationType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DeviceMetricCalibrationType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DeviceMetricCalibrationType) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code DeviceMetricCalibrationType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DeviceMetricCalibrationType) protoNumber() uint64 {
+	switch code {
+	case DeviceMetricCalibrationTypeUnspecified:
+		return 1
+	case DeviceMetricCalibrationTypeOffset:
+		return 2
+	case DeviceMetricCalibrationTypeGain:
+		return 3
+	case DeviceMetricCalibrationTypeTwoPoint:
+		return 4
+	}
+	return 0
+}
+func (code *DeviceMetricCalibrationType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DeviceMetricCalibrationTypeUnspecified
+	case 2:
+		*code = DeviceMetricCalibrationTypeOffset
+	case 3:
+		*code = DeviceMetricCalibrationTypeGain
+	case 4:
+		*code = DeviceMetricCalibrationTypeTwoPoint
+	default:
+		return false
+	}
+	return true
+}
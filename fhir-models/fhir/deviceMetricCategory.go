@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code DeviceMetricCategory) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DeviceMetricCategory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "measurement":
 		*code = DeviceMetricCategoryMeasurement
@@ -52,6 +56,12 @@ func (code *DeviceMetricCategory) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DeviceMetricCategory) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DeviceMetricCategory) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DeviceMetricCategory) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code DeviceMetricCategory) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DeviceMetricCategory) protoNumber() uint64 {
+	switch code {
+	case DeviceMetricCategoryMeasurement:
+		return 1
+	case DeviceMetricCategorySetting:
+		return 2
+	case DeviceMetricCategoryCalculation:
+		return 3
+	case DeviceMetricCategoryUnspecified:
+		return 4
+	}
+	return 0
+}
+func (code *DeviceMetricCategory) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DeviceMetricCategoryMeasurement
+	case 2:
+		*code = DeviceMetricCategorySetting
+	case 3:
+		*code = DeviceMetricCategoryCalculation
+	case 4:
+		*code = DeviceMetricCategoryUnspecified
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -40,8 +39,13 @@ const (
 func (code DeviceMetricColor) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricColor) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DeviceMetricColor) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "black":
 		*code = DeviceMetricColorBlack
@@ -64,6 +68,12 @@ func (code *DeviceMetricColor) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DeviceMetricColor) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DeviceMetricColor) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DeviceMetricColor) String() string {
 	return code.Code()
 }
@@ -130,3 +140,47 @@ func (code DeviceMetricColor) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DeviceMetricColor) protoNumber() uint64 {
+	switch code {
+	case DeviceMetricColorBlack:
+		return 1
+	case DeviceMetricColorRed:
+		return 2
+	case DeviceMetricColorGreen:
+		return 3
+	case DeviceMetricColorYellow:
+		return 4
+	case DeviceMetricColorBlue:
+		return 5
+	case DeviceMetricColorMagenta:
+		return 6
+	case DeviceMetricColorCyan:
+		return 7
+	case DeviceMetricColorWhite:
+		return 8
+	}
+	return 0
+}
+func (code *DeviceMetricColor) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DeviceMetricColorBlack
+	case 2:
+		*code = DeviceMetricColorRed
+	case 3:
+		*code = DeviceMetricColorGreen
+	case 4:
+		*code = DeviceMetricColorYellow
+	case 5:
+		*code = DeviceMetricColorBlue
+	case 6:
+		*code = DeviceMetricColorMagenta
+	case 7:
+		*code = DeviceMetricColorCyan
+	case 8:
+		*code = DeviceMetricColorWhite
+	default:
+		return false
+	}
+	return true
+}
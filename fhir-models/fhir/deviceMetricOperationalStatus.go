@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code DeviceMetricOperationalStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DeviceMetricOperationalStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DeviceMetricOperationalStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "on":
 		*code = DeviceMetricOperationalStatusOn
@@ -52,6 +56,12 @@ func (code *DeviceMetricOperationalStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DeviceMetricOperationalStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DeviceMetricOperationalStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DeviceMetricOperationalStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code DeviceMetricOperationalStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DeviceMetricOperationalStatus) protoNumber() uint64 {
+	switch code {
+	case DeviceMetricOperationalStatusOn:
+		return 1
+	case DeviceMetricOperationalStatusOff:
+		return 2
+	case DeviceMetricOperationalStatusStandby:
+		return 3
+	case DeviceMetricOperationalStatusEnteredInError:
+		return 4
+	}
+	return 0
+}
+func (code *DeviceMetricOperationalStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DeviceMetricOperationalStatusOn
+	case 2:
+		*code = DeviceMetricOperationalStatusOff
+	case 3:
+		*code = DeviceMetricOperationalStatusStandby
+	case 4:
+		*code = DeviceMetricOperationalStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
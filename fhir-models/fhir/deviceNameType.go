@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code DeviceNameType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DeviceNameType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DeviceNameType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "udi-label-name":
 		*code = DeviceNameTypeUdiLabelName
@@ -58,6 +62,12 @@ func (code *DeviceNameType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DeviceNameType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DeviceNameType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DeviceNameType) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code DeviceNameType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DeviceNameType) protoNumber() uint64 {
+	switch code {
+	case DeviceNameTypeUdiLabelName:
+		return 1
+	case DeviceNameTypeUserFriendlyName:
+		return 2
+	case DeviceNameTypePatientReportedName:
+		return 3
+	case DeviceNameTypeManufacturerName:
+		return 4
+	case DeviceNameTypeModelName:
+		return 5
+	case DeviceNameTypeOther:
+		return 6
+	}
+	return 0
+}
+func (code *DeviceNameType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DeviceNameTypeUdiLabelName
+	case 2:
+		*code = DeviceNameTypeUserFriendlyName
+	case 3:
+		*code = DeviceNameTypePatientReportedName
+	case 4:
+		*code = DeviceNameTypeManufacturerName
+	case 5:
+		*code = DeviceNameTypeModelName
+	case 6:
+		*code = DeviceNameTypeOther
+	default:
+		return false
+	}
+	return true
+}
@@ -14,48 +14,98 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // DeviceRequest is documented here http://hl7.org/fhir/StructureDefinition/DeviceRequest
 type DeviceRequest struct {
-	Id                    *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string                 `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string                 `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference              `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PriorRequest          []Reference              `bson:"priorRequest,omitempty" json:"priorRequest,omitempty"`
-	GroupIdentifier       *Identifier              `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	Status                *RequestStatus           `bson:"status,omitempty" json:"status,omitempty"`
-	Intent                RequestIntent            `bson:"intent" json:"intent"`
-	Priority              *RequestPriority         `bson:"priority,omitempty" json:"priority,omitempty"`
-	CodeReference         Reference                `bson:"codeReference" json:"codeReference"`
-	CodeCodeableConcept   CodeableConcept          `bson:"codeCodeableConcept" json:"codeCodeableConcept"`
-	Parameter             []DeviceRequestParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
-	Subject               Reference                `bson:"subject" json:"subject"`
-	Encounter             *Reference               `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	OccurrenceDateTime    *string                  `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod      *Period                  `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming      *Timing                  `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
-	AuthoredOn            *string                  `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Requester             *Reference               `bson:"requester,omitempty" json:"requester,omitempty"`
-	PerformerType         *CodeableConcept         `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	Performer             *Reference               `bson:"performer,omitempty" json:"performer,omitempty"`
-	ReasonCode            []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Insurance             []Reference              `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	SupportingInfo        []Reference              `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
-	Note                  []Annotation             `bson:"note,omitempty" json:"note,omitempty"`
-	RelevantHistory       []Reference              `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
+	Id                           *string                  `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element                 `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                 `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                  `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                 `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage        `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []string                 `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element               `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string                 `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element               `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference              `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PriorRequest                 []Reference              `bson:"priorRequest,omitempty" json:"priorRequest,omitempty"`
+	GroupIdentifier              *Identifier              `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	Status                       *RequestStatus           `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement                *Element                 `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       RequestIntent            `bson:"intent" json:"intent"`
+	IntentElement                *Element                 `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Priority                     *RequestPriority         `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                 `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	CodeReference                *Reference               `bson:"codeReference,omitempty" json:"codeReference,omitempty"`
+	CodeCodeableConcept          *CodeableConcept         `bson:"codeCodeableConcept,omitempty" json:"codeCodeableConcept,omitempty"`
+	Parameter                    []DeviceRequestParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	Subject                      Reference                `bson:"subject" json:"subject"`
+	Encounter                    *Reference               `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime           *string                  `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement    *Element                 `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod             *Period                  `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming             *Timing                  `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	AuthoredOn                   *string                  `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element                 `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Requester                    *Reference               `bson:"requester,omitempty" json:"requester,omitempty"`
+	PerformerType                *CodeableConcept         `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	Performer                    *Reference               `bson:"performer,omitempty" json:"performer,omitempty"`
+	ReasonCode                   []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Insurance                    []Reference              `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	SupportingInfo               []Reference              `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
+	Note                         []Annotation             `bson:"note,omitempty" json:"note,omitempty"`
+	RelevantHistory              []Reference              `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
 }
+
+// DeviceRequestCode is implemented by the types allowed for DeviceRequest.code[x]
+type DeviceRequestCode interface {
+	isDeviceRequestCode()
+}
+
+func (Reference) isDeviceRequestCode()       {}
+func (CodeableConcept) isDeviceRequestCode() {}
+
+// Code returns the value of DeviceRequest.code[x] together with its FHIR type code or nil if no value is set
+func (r DeviceRequest) Code() (DeviceRequestCode, string) {
+	if r.CodeReference != nil {
+		return *r.CodeReference, "Reference"
+	}
+	if r.CodeCodeableConcept != nil {
+		return *r.CodeCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetCode sets the value of DeviceRequest.code[x], given as value or pointer, and clears all other types
+func (r *DeviceRequest) SetCode(value DeviceRequestCode) {
+	r.CodeReference = nil
+	r.CodeCodeableConcept = nil
+	switch v := value.(type) {
+	case Reference:
+		r.CodeReference = &v
+	case *Reference:
+		r.CodeReference = v
+	case CodeableConcept:
+		r.CodeCodeableConcept = &v
+	case *CodeableConcept:
+		r.CodeCodeableConcept = v
+	}
+}
+
 type DeviceRequestParameter struct {
 	Id                   *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension            []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -65,24 +115,2689 @@ type DeviceRequestParameter struct {
 	ValueQuantity        *Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
 	ValueRange           *Range           `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
 	ValueBoolean         *bool            `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element         `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+}
+
+// DeviceRequestParameterValue is implemented by the types allowed for DeviceRequest.parameter.value[x]
+type DeviceRequestParameterValue interface {
+	isDeviceRequestParameterValue()
+}
+
+func (CodeableConcept) isDeviceRequestParameterValue() {}
+func (Quantity) isDeviceRequestParameterValue()        {}
+func (Range) isDeviceRequestParameterValue()           {}
+func (Boolean) isDeviceRequestParameterValue()         {}
+
+// Value returns the value of DeviceRequest.parameter.value[x] together with its FHIR type code or nil if no value is set
+func (r DeviceRequestParameter) Value() (DeviceRequestParameterValue, string) {
+	if r.ValueCodeableConcept != nil {
+		return *r.ValueCodeableConcept, "CodeableConcept"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueRange != nil {
+		return *r.ValueRange, "Range"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of DeviceRequest.parameter.value[x], given as value or pointer, and clears all other types
+func (r *DeviceRequestParameter) SetValue(value DeviceRequestParameterValue) {
+	r.ValueCodeableConcept = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueBoolean = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.ValueCodeableConcept = v
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Range:
+		r.ValueRange = &v
+	case *Range:
+		r.ValueRange = v
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	}
+}
+
+// DeviceRequestOccurrence is implemented by the types allowed for DeviceRequest.occurrence[x]
+type DeviceRequestOccurrence interface {
+	isDeviceRequestOccurrence()
+}
+
+func (DateTime) isDeviceRequestOccurrence() {}
+func (Period) isDeviceRequestOccurrence()   {}
+func (Timing) isDeviceRequestOccurrence()   {}
+
+// Occurrence returns the value of DeviceRequest.occurrence[x] together with its FHIR type code or nil if no value is set
+func (r DeviceRequest) Occurrence() (DeviceRequestOccurrence, string) {
+	if r.OccurrenceDateTime != nil {
+		return DateTime(*r.OccurrenceDateTime), "dateTime"
+	}
+	if r.OccurrencePeriod != nil {
+		return *r.OccurrencePeriod, "Period"
+	}
+	if r.OccurrenceTiming != nil {
+		return *r.OccurrenceTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetOccurrence sets the value of DeviceRequest.occurrence[x], given as value or pointer, and clears all other types
+func (r *DeviceRequest) SetOccurrence(value DeviceRequestOccurrence) {
+	r.OccurrenceDateTime = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OccurrenceDateTime = &primitive
+	case *DateTime:
+		r.OccurrenceDateTime = (*string)(v)
+	case Period:
+		r.OccurrencePeriod = &v
+	case *Period:
+		r.OccurrencePeriod = v
+	case Timing:
+		r.OccurrenceTiming = &v
+	case *Timing:
+		r.OccurrenceTiming = v
+	}
 }
-type OtherDeviceRequest DeviceRequest
 
 // MarshalJSON marshals the given DeviceRequest as JSON into a byte slice
 func (r DeviceRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherDeviceRequest
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherDeviceRequest: OtherDeviceRequest(r),
-		ResourceType:       "DeviceRequest",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceRequest as JSON object with the members in the order of the fields
+func (r DeviceRequest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"DeviceRequest\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PriorRequest) > 0 {
+		e.key("priorRequest")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PriorRequest {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.GroupIdentifier != nil {
+		e.key("groupIdentifier")
+		r.GroupIdentifier.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("intent")
+	e.string(r.Intent.Code())
+	if r.IntentElement != nil {
+		e.key("_intent")
+		r.IntentElement.appendJSON(e)
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if r.CodeReference != nil {
+		e.key("codeReference")
+		r.CodeReference.appendJSON(e)
+	}
+	if r.CodeCodeableConcept != nil {
+		e.key("codeCodeableConcept")
+		r.CodeCodeableConcept.appendJSON(e)
+	}
+	if len(r.Parameter) > 0 {
+		e.key("parameter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parameter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.key("occurrenceDateTime")
+		e.string(*r.OccurrenceDateTime)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.key("_occurrenceDateTime")
+		r.OccurrenceDateTimeElement.appendJSON(e)
+	}
+	if r.OccurrencePeriod != nil {
+		e.key("occurrencePeriod")
+		r.OccurrencePeriod.appendJSON(e)
+	}
+	if r.OccurrenceTiming != nil {
+		e.key("occurrenceTiming")
+		r.OccurrenceTiming.appendJSON(e)
+	}
+	if r.AuthoredOn != nil {
+		e.key("authoredOn")
+		e.string(*r.AuthoredOn)
+	}
+	if r.AuthoredOnElement != nil {
+		e.key("_authoredOn")
+		r.AuthoredOnElement.appendJSON(e)
+	}
+	if r.Requester != nil {
+		e.key("requester")
+		r.Requester.appendJSON(e)
+	}
+	if r.PerformerType != nil {
+		e.key("performerType")
+		r.PerformerType.appendJSON(e)
+	}
+	if r.Performer != nil {
+		e.key("performer")
+		r.Performer.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Insurance) > 0 {
+		e.key("insurance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Insurance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInfo) > 0 {
+		e.key("supportingInfo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInfo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelevantHistory) > 0 {
+		e.key("relevantHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelevantHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceRequest from JSON. Unknown members are ignored.
+func (r *DeviceRequest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceRequest
+func (r *DeviceRequest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "priorRequest":
+			a := d.array()
+			r.PriorRequest = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PriorRequest = append(r.PriorRequest, v)
+			}
+			if r.PriorRequest == nil && !a.isNull {
+				r.PriorRequest = []Reference{}
+			}
+		case "groupIdentifier":
+			if d.null() {
+				r.GroupIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.GroupIdentifier = &v
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v RequestStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "intent":
+			d.unmarshal(&r.Intent)
+		case "_intent":
+			r.IntentElement = d.element()
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "codeReference":
+			if d.null() {
+				r.CodeReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.CodeReference = &v
+			}
+		case "codeCodeableConcept":
+			if d.null() {
+				r.CodeCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CodeCodeableConcept = &v
+			}
+		case "parameter":
+			a := d.array()
+			r.Parameter = nil
+			for a.next() {
+				var v DeviceRequestParameter
+				v.decodeJSON(d)
+				r.Parameter = append(r.Parameter, v)
+			}
+			if r.Parameter == nil && !a.isNull {
+				r.Parameter = []DeviceRequestParameter{}
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "occurrenceDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurrenceDateTime = &v
+			} else {
+				r.OccurrenceDateTime = nil
+			}
+		case "_occurrenceDateTime":
+			r.OccurrenceDateTimeElement = d.element()
+		case "occurrencePeriod":
+			if d.null() {
+				r.OccurrencePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OccurrencePeriod = &v
+			}
+		case "occurrenceTiming":
+			if d.null() {
+				r.OccurrenceTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.OccurrenceTiming = &v
+			}
+		case "authoredOn":
+			if v, ok := d.string(); ok {
+				r.AuthoredOn = &v
+			} else {
+				r.AuthoredOn = nil
+			}
+		case "_authoredOn":
+			r.AuthoredOnElement = d.element()
+		case "requester":
+			if d.null() {
+				r.Requester = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Requester = &v
+			}
+		case "performerType":
+			if d.null() {
+				r.PerformerType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PerformerType = &v
+			}
+		case "performer":
+			if d.null() {
+				r.Performer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "insurance":
+			a := d.array()
+			r.Insurance = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Insurance = append(r.Insurance, v)
+			}
+			if r.Insurance == nil && !a.isNull {
+				r.Insurance = []Reference{}
+			}
+		case "supportingInfo":
+			a := d.array()
+			r.SupportingInfo = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			}
+			if r.SupportingInfo == nil && !a.isNull {
+				r.SupportingInfo = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "relevantHistory":
+			a := d.array()
+			r.RelevantHistory = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.RelevantHistory = append(r.RelevantHistory, v)
+			}
+			if r.RelevantHistory == nil && !a.isNull {
+				r.RelevantHistory = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceRequest which shares no memory with the original
+func (r DeviceRequest) DeepCopy() DeviceRequest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.PriorRequest != nil {
+		out.PriorRequest = make([]Reference, len(r.PriorRequest))
+		for i := range r.PriorRequest {
+			out.PriorRequest[i] = r.PriorRequest[i].DeepCopy()
+		}
+	}
+	if r.GroupIdentifier != nil {
+		v := r.GroupIdentifier.DeepCopy()
+		out.GroupIdentifier = &v
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.IntentElement != nil {
+		v := r.IntentElement.DeepCopy()
+		out.IntentElement = &v
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.CodeReference != nil {
+		v := r.CodeReference.DeepCopy()
+		out.CodeReference = &v
+	}
+	if r.CodeCodeableConcept != nil {
+		v := r.CodeCodeableConcept.DeepCopy()
+		out.CodeCodeableConcept = &v
+	}
+	if r.Parameter != nil {
+		out.Parameter = make([]DeviceRequestParameter, len(r.Parameter))
+		for i := range r.Parameter {
+			out.Parameter[i] = r.Parameter[i].DeepCopy()
+		}
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.OccurrenceDateTime != nil {
+		v := *r.OccurrenceDateTime
+		out.OccurrenceDateTime = &v
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		v := r.OccurrenceDateTimeElement.DeepCopy()
+		out.OccurrenceDateTimeElement = &v
+	}
+	if r.OccurrencePeriod != nil {
+		v := r.OccurrencePeriod.DeepCopy()
+		out.OccurrencePeriod = &v
+	}
+	if r.OccurrenceTiming != nil {
+		v := r.OccurrenceTiming.DeepCopy()
+		out.OccurrenceTiming = &v
+	}
+	if r.AuthoredOn != nil {
+		v := *r.AuthoredOn
+		out.AuthoredOn = &v
+	}
+	if r.AuthoredOnElement != nil {
+		v := r.AuthoredOnElement.DeepCopy()
+		out.AuthoredOnElement = &v
+	}
+	if r.Requester != nil {
+		v := r.Requester.DeepCopy()
+		out.Requester = &v
+	}
+	if r.PerformerType != nil {
+		v := r.PerformerType.DeepCopy()
+		out.PerformerType = &v
+	}
+	if r.Performer != nil {
+		v := r.Performer.DeepCopy()
+		out.Performer = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Insurance != nil {
+		out.Insurance = make([]Reference, len(r.Insurance))
+		for i := range r.Insurance {
+			out.Insurance[i] = r.Insurance[i].DeepCopy()
+		}
+	}
+	if r.SupportingInfo != nil {
+		out.SupportingInfo = make([]Reference, len(r.SupportingInfo))
+		for i := range r.SupportingInfo {
+			out.SupportingInfo[i] = r.SupportingInfo[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.RelevantHistory != nil {
+		out.RelevantHistory = make([]Reference, len(r.RelevantHistory))
+		for i := range r.RelevantHistory {
+			out.RelevantHistory[i] = r.RelevantHistory[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceRequest) Equal(other DeviceRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.PriorRequest) != len(other.PriorRequest) {
+		return false
+	}
+	for i := range r.PriorRequest {
+		if !r.PriorRequest[i].Equal(other.PriorRequest[i]) {
+			return false
+		}
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.Equal(*other.GroupIdentifier) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.Equal(*other.IntentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if (r.CodeReference == nil) != (other.CodeReference == nil) || r.CodeReference != nil && !r.CodeReference.Equal(*other.CodeReference) {
+		return false
+	}
+	if (r.CodeCodeableConcept == nil) != (other.CodeCodeableConcept == nil) || r.CodeCodeableConcept != nil && !r.CodeCodeableConcept.Equal(*other.CodeCodeableConcept) {
+		return false
+	}
+	if len(r.Parameter) != len(other.Parameter) {
+		return false
+	}
+	for i := range r.Parameter {
+		if !r.Parameter[i].Equal(other.Parameter[i]) {
+			return false
+		}
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && *r.OccurrenceDateTime != *other.OccurrenceDateTime {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.Equal(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.Equal(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.Equal(*other.OccurrenceTiming) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && *r.AuthoredOn != *other.AuthoredOn {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.Equal(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.Equal(*other.Requester) {
+		return false
+	}
+	if (r.PerformerType == nil) != (other.PerformerType == nil) || r.PerformerType != nil && !r.PerformerType.Equal(*other.PerformerType) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.Equal(*other.Performer) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Insurance) != len(other.Insurance) {
+		return false
+	}
+	for i := range r.Insurance {
+		if !r.Insurance[i].Equal(other.Insurance[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingInfo) != len(other.SupportingInfo) {
+		return false
+	}
+	for i := range r.SupportingInfo {
+		if !r.SupportingInfo[i].Equal(other.SupportingInfo[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.RelevantHistory) != len(other.RelevantHistory) {
+		return false
+	}
+	for i := range r.RelevantHistory {
+		if !r.RelevantHistory[i].Equal(other.RelevantHistory[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceRequest) EqualsDeep(other DeviceRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PriorRequest), len(other.PriorRequest), func(i, j int) bool {
+		return r.PriorRequest[i].EqualsDeep(other.PriorRequest[j])
+	}) {
+		return false
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.EqualsDeep(*other.GroupIdentifier) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.EqualsDeep(*other.IntentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if (r.CodeReference == nil) != (other.CodeReference == nil) || r.CodeReference != nil && !r.CodeReference.EqualsDeep(*other.CodeReference) {
+		return false
+	}
+	if (r.CodeCodeableConcept == nil) != (other.CodeCodeableConcept == nil) || r.CodeCodeableConcept != nil && !r.CodeCodeableConcept.EqualsDeep(*other.CodeCodeableConcept) {
+		return false
+	}
+	if !equivalentList(len(r.Parameter), len(other.Parameter), func(i, j int) bool {
+		return r.Parameter[i].EqualsDeep(other.Parameter[j])
+	}) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.EqualsDeep(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.EqualsDeep(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.EqualsDeep(*other.OccurrenceTiming) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.EqualsDeep(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.EqualsDeep(*other.Requester) {
+		return false
+	}
+	if (r.PerformerType == nil) != (other.PerformerType == nil) || r.PerformerType != nil && !r.PerformerType.EqualsDeep(*other.PerformerType) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.EqualsDeep(*other.Performer) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Insurance), len(other.Insurance), func(i, j int) bool {
+		return r.Insurance[i].EqualsDeep(other.Insurance[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfo), len(other.SupportingInfo), func(i, j int) bool {
+		return r.SupportingInfo[i].EqualsDeep(other.SupportingInfo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelevantHistory), len(other.RelevantHistory), func(i, j int) bool {
+		return r.RelevantHistory[i].EqualsDeep(other.RelevantHistory[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceRequest) EqualsShallow(other DeviceRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceRequest as FHIR XML
+func (r DeviceRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "DeviceRequest",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PriorRequest {
+		if err := encodeXMLElement(e, "priorRequest", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "groupIdentifier", r.GroupIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "intent", r.Intent, r.IntentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "codeReference", r.CodeReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "codeCodeableConcept", r.CodeCodeableConcept); err != nil {
+		return err
+	}
+	for _, v := range r.Parameter {
+		if err := encodeXMLElement(e, "parameter", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceDateTime", r.OccurrenceDateTime, r.OccurrenceDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrencePeriod", r.OccurrencePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrenceTiming", r.OccurrenceTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "authoredOn", r.AuthoredOn, r.AuthoredOnElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requester", r.Requester); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performerType", r.PerformerType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performer", r.Performer); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Insurance {
+		if err := encodeXMLElement(e, "insurance", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SupportingInfo {
+		if err := encodeXMLElement(e, "supportingInfo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelevantHistory {
+		if err := encodeXMLElement(e, "relevantHistory", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceRequest from FHIR XML
+func (r *DeviceRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "priorRequest":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PriorRequest = append(r.PriorRequest, v)
+			case "groupIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GroupIdentifier = &v
+			case "status":
+				var v RequestStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "intent":
+				var v RequestIntent
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Intent = v
+				}
+				r.IntentElement = element
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "codeReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CodeReference = &v
+			case "codeCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CodeCodeableConcept = &v
+			case "parameter":
+				var v DeviceRequestParameter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parameter = append(r.Parameter, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "occurrenceDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceDateTime = &v
+				}
+				r.OccurrenceDateTimeElement = element
+			case "occurrencePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrencePeriod = &v
+			case "occurrenceTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrenceTiming = &v
+			case "authoredOn":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthoredOn = &v
+				}
+				r.AuthoredOnElement = element
+			case "requester":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requester = &v
+			case "performerType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformerType = &v
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "insurance":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurance = append(r.Insurance, v)
+			case "supportingInfo":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "relevantHistory":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelevantHistory = append(r.RelevantHistory, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceRequest as properties to the RDF node n
+func (r DeviceRequest) turtle(n *turtleNode) {
+	n.resource("DeviceRequest", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("DeviceRequest.identifier", i, v)
+	}
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("DeviceRequest.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("DeviceRequest.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	for i, v := range r.BasedOn {
+		n.element("DeviceRequest.basedOn", i, v)
+	}
+	for i, v := range r.PriorRequest {
+		n.element("DeviceRequest.priorRequest", i, v)
+	}
+	n.element("DeviceRequest.groupIdentifier", -1, r.GroupIdentifier)
+	n.primitive("DeviceRequest.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("DeviceRequest.intent", -1, r.Intent, r.IntentElement, "code")
+	n.primitive("DeviceRequest.priority", -1, r.Priority, r.PriorityElement, "code")
+	n.element("DeviceRequest.codeReference", -1, r.CodeReference)
+	n.element("DeviceRequest.codeCodeableConcept", -1, r.CodeCodeableConcept)
+	for i, v := range r.Parameter {
+		n.element("DeviceRequest.parameter", i, v)
+	}
+	n.element("DeviceRequest.subject", -1, r.Subject)
+	n.element("DeviceRequest.encounter", -1, r.Encounter)
+	n.primitive("DeviceRequest.occurrenceDateTime", -1, r.OccurrenceDateTime, r.OccurrenceDateTimeElement, "dateTime")
+	n.element("DeviceRequest.occurrencePeriod", -1, r.OccurrencePeriod)
+	n.element("DeviceRequest.occurrenceTiming", -1, r.OccurrenceTiming)
+	n.primitive("DeviceRequest.authoredOn", -1, r.AuthoredOn, r.AuthoredOnElement, "dateTime")
+	n.element("DeviceRequest.requester", -1, r.Requester)
+	n.element("DeviceRequest.performerType", -1, r.PerformerType)
+	n.element("DeviceRequest.performer", -1, r.Performer)
+	for i, v := range r.ReasonCode {
+		n.element("DeviceRequest.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("DeviceRequest.reasonReference", i, v)
+	}
+	for i, v := range r.Insurance {
+		n.element("DeviceRequest.insurance", i, v)
+	}
+	for i, v := range r.SupportingInfo {
+		n.element("DeviceRequest.supportingInfo", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("DeviceRequest.note", i, v)
+	}
+	for i, v := range r.RelevantHistory {
+		n.element("DeviceRequest.relevantHistory", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DeviceRequest as BSON document with the structure of its FHIR JSON
+func (r DeviceRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceRequest from a BSON document with the structure of its FHIR JSON
+func (r *DeviceRequest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceRequest as protobuf message DeviceRequest of fhir.proto
+func (r DeviceRequest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceRequest to the encoded message
+func (r DeviceRequest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(10, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(11, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(12, v)
+	}
+	for _, v := range r.PriorRequest {
+		e.message(13, v)
+	}
+	if r.GroupIdentifier != nil {
+		e.message(14, *r.GroupIdentifier)
+	}
+	if r.Status != nil {
+		e.varint(15, r.Status.protoNumber())
+	}
+	e.varint(16, r.Intent.protoNumber())
+	if r.Priority != nil {
+		e.varint(17, r.Priority.protoNumber())
+	}
+	if r.CodeReference != nil {
+		e.message(18, *r.CodeReference)
+	}
+	if r.CodeCodeableConcept != nil {
+		e.message(19, *r.CodeCodeableConcept)
+	}
+	for _, v := range r.Parameter {
+		e.message(20, v)
+	}
+	e.message(21, r.Subject)
+	if r.Encounter != nil {
+		e.message(22, *r.Encounter)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.string(23, *r.OccurrenceDateTime)
+	}
+	if r.OccurrencePeriod != nil {
+		e.message(24, *r.OccurrencePeriod)
+	}
+	if r.OccurrenceTiming != nil {
+		e.message(25, *r.OccurrenceTiming)
+	}
+	if r.AuthoredOn != nil {
+		e.string(26, *r.AuthoredOn)
+	}
+	if r.Requester != nil {
+		e.message(27, *r.Requester)
+	}
+	if r.PerformerType != nil {
+		e.message(28, *r.PerformerType)
+	}
+	if r.Performer != nil {
+		e.message(29, *r.Performer)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(30, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(31, v)
+	}
+	for _, v := range r.Insurance {
+		e.message(32, v)
+	}
+	for _, v := range r.SupportingInfo {
+		e.message(33, v)
+	}
+	for _, v := range r.Note {
+		e.message(34, v)
+	}
+	for _, v := range r.RelevantHistory {
+		e.message(35, v)
+	}
+	if r.IdElement != nil {
+		e.message(36, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(37, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(38, *r.LanguageElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(39, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(40, v)
+	}
+	if r.StatusElement != nil {
+		e.message(41, *r.StatusElement)
+	}
+	if r.IntentElement != nil {
+		e.message(42, *r.IntentElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(43, *r.PriorityElement)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.message(44, *r.OccurrenceDateTimeElement)
+	}
+	if r.AuthoredOnElement != nil {
+		e.message(45, *r.AuthoredOnElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceRequest from protobuf message DeviceRequest of fhir.proto
+func (r *DeviceRequest) UnmarshalProto(b []byte) error {
+	*r = DeviceRequest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 11:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.PriorRequest = append(r.PriorRequest, v)
+		case 14:
+			var v Identifier
+			d.message(&v)
+			r.GroupIdentifier = &v
+		case 15:
+			var v RequestStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 16:
+			var v RequestIntent
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Intent = v
+		case 17:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.CodeReference = &v
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.CodeCodeableConcept = &v
+		case 20:
+			var v DeviceRequestParameter
+			d.message(&v)
+			r.Parameter = append(r.Parameter, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 23:
+			v := d.string()
+			r.OccurrenceDateTime = &v
+		case 24:
+			var v Period
+			d.message(&v)
+			r.OccurrencePeriod = &v
+		case 25:
+			var v Timing
+			d.message(&v)
+			r.OccurrenceTiming = &v
+		case 26:
+			v := d.string()
+			r.AuthoredOn = &v
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.Requester = &v
+		case 28:
+			var v CodeableConcept
+			d.message(&v)
+			r.PerformerType = &v
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.Performer = &v
+		case 30:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 31:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 32:
+			var v Reference
+			d.message(&v)
+			r.Insurance = append(r.Insurance, v)
+		case 33:
+			var v Reference
+			d.message(&v)
+			r.SupportingInfo = append(r.SupportingInfo, v)
+		case 34:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 35:
+			var v Reference
+			d.message(&v)
+			r.RelevantHistory = append(r.RelevantHistory, v)
+		case 36:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 39:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 40:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		case 41:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.IntentElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.OccurrenceDateTimeElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.AuthoredOnElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DeviceRequestParameter as JSON into a byte slice
+func (r DeviceRequestParameter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceRequestParameter as JSON object with the members in the order of the fields
+func (r DeviceRequestParameter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.key("valueCodeableConcept")
+		r.ValueCodeableConcept.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueRange != nil {
+		e.key("valueRange")
+		r.ValueRange.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceRequestParameter from JSON. Unknown members are ignored.
+func (r *DeviceRequestParameter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceRequestParameter
+func (r *DeviceRequestParameter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "valueCodeableConcept":
+			if d.null() {
+				r.ValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCodeableConcept = &v
+			}
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueRange":
+			if d.null() {
+				r.ValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.ValueRange = &v
+			}
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceRequestParameter which shares no memory with the original
+func (r DeviceRequestParameter) DeepCopy() DeviceRequestParameter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.ValueCodeableConcept != nil {
+		v := r.ValueCodeableConcept.DeepCopy()
+		out.ValueCodeableConcept = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueRange != nil {
+		v := r.ValueRange.DeepCopy()
+		out.ValueRange = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceRequestParameter) Equal(other DeviceRequestParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.Equal(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.Equal(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceRequestParameter) EqualsDeep(other DeviceRequestParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.EqualsDeep(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.EqualsDeep(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceRequestParameter) EqualsShallow(other DeviceRequestParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceRequestParameter as FHIR XML
+func (r DeviceRequestParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCodeableConcept", r.ValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRange", r.ValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceRequestParameter from FHIR XML
+func (r *DeviceRequestParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "valueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCodeableConcept = &v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRange = &v
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceRequestParameter as properties to the RDF node n
+func (r DeviceRequestParameter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("DeviceRequest.parameter.code", -1, r.Code)
+	n.element("DeviceRequest.parameter.valueCodeableConcept", -1, r.ValueCodeableConcept)
+	n.element("DeviceRequest.parameter.valueQuantity", -1, r.ValueQuantity)
+	n.element("DeviceRequest.parameter.valueRange", -1, r.ValueRange)
+	n.primitive("DeviceRequest.parameter.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+}
+
+// MarshalBSON marshals the given DeviceRequestParameter as BSON document with the structure of its FHIR JSON
+func (r DeviceRequestParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceRequestParameter from a BSON document with the structure of its FHIR JSON
+func (r *DeviceRequestParameter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceRequestParameter as protobuf message DeviceRequestParameter of fhir.proto
+func (r DeviceRequestParameter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceRequestParameter to the encoded message
+func (r DeviceRequestParameter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != nil {
+		e.message(4, *r.Code)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.message(5, *r.ValueCodeableConcept)
+	}
+	if r.ValueQuantity != nil {
+		e.message(6, *r.ValueQuantity)
+	}
+	if r.ValueRange != nil {
+		e.message(7, *r.ValueRange)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(8, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(9, *r.ValueBooleanElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceRequestParameter from protobuf message DeviceRequestParameter of fhir.proto
+func (r *DeviceRequestParameter) UnmarshalProto(b []byte) error {
+	*r = DeviceRequestParameter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCodeableConcept = &v
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 7:
+			var v Range
+			d.message(&v)
+			r.ValueRange = &v
+		case 8:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalDeviceRequest unmarshals a DeviceRequest.
 func UnmarshalDeviceRequest(b []byte) (DeviceRequest, error) {
 	var deviceRequest DeviceRequest
-	if err := json.Unmarshal(b, &deviceRequest); err != nil {
+	if err := deviceRequest.UnmarshalJSON(b); err != nil {
 		return deviceRequest, err
 	}
 	return deviceRequest, nil
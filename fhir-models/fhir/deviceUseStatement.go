@@ -14,53 +14,1431 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // DeviceUseStatement is documented here http://hl7.org/fhir/StructureDefinition/DeviceUseStatement
 type DeviceUseStatement struct {
-	Id                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           []Reference              `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Status            DeviceUseStatementStatus `bson:"status" json:"status"`
-	Subject           Reference                `bson:"subject" json:"subject"`
-	DerivedFrom       []Reference              `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
-	TimingTiming      *Timing                  `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
-	TimingPeriod      *Period                  `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
-	TimingDateTime    *string                  `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
-	RecordedOn        *string                  `bson:"recordedOn,omitempty" json:"recordedOn,omitempty"`
-	Source            *Reference               `bson:"source,omitempty" json:"source,omitempty"`
-	Device            Reference                `bson:"device" json:"device"`
-	ReasonCode        []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference   []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	BodySite          *CodeableConcept         `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Note              []Annotation             `bson:"note,omitempty" json:"note,omitempty"`
-}
-type OtherDeviceUseStatement DeviceUseStatement
+	Id                    *string                  `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                 `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                  *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                 `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                  `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                 `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage        `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier            []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn               []Reference              `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Status                DeviceUseStatementStatus `bson:"status" json:"status"`
+	StatusElement         *Element                 `bson:"_status,omitempty" json:"_status,omitempty"`
+	Subject               Reference                `bson:"subject" json:"subject"`
+	DerivedFrom           []Reference              `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
+	TimingTiming          *Timing                  `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	TimingPeriod          *Period                  `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	TimingDateTime        *string                  `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *Element                 `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
+	RecordedOn            *string                  `bson:"recordedOn,omitempty" json:"recordedOn,omitempty"`
+	RecordedOnElement     *Element                 `bson:"_recordedOn,omitempty" json:"_recordedOn,omitempty"`
+	Source                *Reference               `bson:"source,omitempty" json:"source,omitempty"`
+	Device                Reference                `bson:"device" json:"device"`
+	ReasonCode            []CodeableConcept        `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference       []Reference              `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	BodySite              *CodeableConcept         `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Note                  []Annotation             `bson:"note,omitempty" json:"note,omitempty"`
+}
+
+// DeviceUseStatementTiming is implemented by the types allowed for DeviceUseStatement.timing[x]
+type DeviceUseStatementTiming interface {
+	isDeviceUseStatementTiming()
+}
+
+func (Timing) isDeviceUseStatementTiming()   {}
+func (Period) isDeviceUseStatementTiming()   {}
+func (DateTime) isDeviceUseStatementTiming() {}
+
+// Timing returns the value of DeviceUseStatement.timing[x] together with its FHIR type code or nil if no value is set
+func (r DeviceUseStatement) Timing() (DeviceUseStatementTiming, string) {
+	if r.TimingTiming != nil {
+		return *r.TimingTiming, "Timing"
+	}
+	if r.TimingPeriod != nil {
+		return *r.TimingPeriod, "Period"
+	}
+	if r.TimingDateTime != nil {
+		return DateTime(*r.TimingDateTime), "dateTime"
+	}
+	return nil, ""
+}
+
+// SetTiming sets the value of DeviceUseStatement.timing[x], given as value or pointer, and clears all other types
+func (r *DeviceUseStatement) SetTiming(value DeviceUseStatementTiming) {
+	r.TimingTiming = nil
+	r.TimingPeriod = nil
+	r.TimingDateTime = nil
+	switch v := value.(type) {
+	case Timing:
+		r.TimingTiming = &v
+	case *Timing:
+		r.TimingTiming = v
+	case Period:
+		r.TimingPeriod = &v
+	case *Period:
+		r.TimingPeriod = v
+	case DateTime:
+		primitive := string(v)
+		r.TimingDateTime = &primitive
+	case *DateTime:
+		r.TimingDateTime = (*string)(v)
+	}
+}
 
 // MarshalJSON marshals the given DeviceUseStatement as JSON into a byte slice
 func (r DeviceUseStatement) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherDeviceUseStatement
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherDeviceUseStatement: OtherDeviceUseStatement(r),
-		ResourceType:            "DeviceUseStatement",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DeviceUseStatement as JSON object with the members in the order of the fields
+func (r DeviceUseStatement) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"DeviceUseStatement\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if len(r.DerivedFrom) > 0 {
+		e.key("derivedFrom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DerivedFrom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.TimingTiming != nil {
+		e.key("timingTiming")
+		r.TimingTiming.appendJSON(e)
+	}
+	if r.TimingPeriod != nil {
+		e.key("timingPeriod")
+		r.TimingPeriod.appendJSON(e)
+	}
+	if r.TimingDateTime != nil {
+		e.key("timingDateTime")
+		e.string(*r.TimingDateTime)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.key("_timingDateTime")
+		r.TimingDateTimeElement.appendJSON(e)
+	}
+	if r.RecordedOn != nil {
+		e.key("recordedOn")
+		e.string(*r.RecordedOn)
+	}
+	if r.RecordedOnElement != nil {
+		e.key("_recordedOn")
+		r.RecordedOnElement.appendJSON(e)
+	}
+	if r.Source != nil {
+		e.key("source")
+		r.Source.appendJSON(e)
+	}
+	e.key("device")
+	r.Device.appendJSON(e)
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BodySite != nil {
+		e.key("bodySite")
+		r.BodySite.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DeviceUseStatement from JSON. Unknown members are ignored.
+func (r *DeviceUseStatement) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DeviceUseStatement
+func (r *DeviceUseStatement) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "derivedFrom":
+			a := d.array()
+			r.DerivedFrom = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.DerivedFrom = append(r.DerivedFrom, v)
+			}
+			if r.DerivedFrom == nil && !a.isNull {
+				r.DerivedFrom = []Reference{}
+			}
+		case "timingTiming":
+			if d.null() {
+				r.TimingTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.TimingTiming = &v
+			}
+		case "timingPeriod":
+			if d.null() {
+				r.TimingPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.TimingPeriod = &v
+			}
+		case "timingDateTime":
+			if v, ok := d.string(); ok {
+				r.TimingDateTime = &v
+			} else {
+				r.TimingDateTime = nil
+			}
+		case "_timingDateTime":
+			r.TimingDateTimeElement = d.element()
+		case "recordedOn":
+			if v, ok := d.string(); ok {
+				r.RecordedOn = &v
+			} else {
+				r.RecordedOn = nil
+			}
+		case "_recordedOn":
+			r.RecordedOnElement = d.element()
+		case "source":
+			if d.null() {
+				r.Source = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = &v
+			}
+		case "device":
+			r.Device.decodeJSON(d)
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "bodySite":
+			if d.null() {
+				r.BodySite = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = &v
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DeviceUseStatement which shares no memory with the original
+func (r DeviceUseStatement) DeepCopy() DeviceUseStatement {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.DerivedFrom != nil {
+		out.DerivedFrom = make([]Reference, len(r.DerivedFrom))
+		for i := range r.DerivedFrom {
+			out.DerivedFrom[i] = r.DerivedFrom[i].DeepCopy()
+		}
+	}
+	if r.TimingTiming != nil {
+		v := r.TimingTiming.DeepCopy()
+		out.TimingTiming = &v
+	}
+	if r.TimingPeriod != nil {
+		v := r.TimingPeriod.DeepCopy()
+		out.TimingPeriod = &v
+	}
+	if r.TimingDateTime != nil {
+		v := *r.TimingDateTime
+		out.TimingDateTime = &v
+	}
+	if r.TimingDateTimeElement != nil {
+		v := r.TimingDateTimeElement.DeepCopy()
+		out.TimingDateTimeElement = &v
+	}
+	if r.RecordedOn != nil {
+		v := *r.RecordedOn
+		out.RecordedOn = &v
+	}
+	if r.RecordedOnElement != nil {
+		v := r.RecordedOnElement.DeepCopy()
+		out.RecordedOnElement = &v
+	}
+	if r.Source != nil {
+		v := r.Source.DeepCopy()
+		out.Source = &v
+	}
+	out.Device = r.Device.DeepCopy()
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.BodySite != nil {
+		v := r.BodySite.DeepCopy()
+		out.BodySite = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DeviceUseStatement) Equal(other DeviceUseStatement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if len(r.DerivedFrom) != len(other.DerivedFrom) {
+		return false
+	}
+	for i := range r.DerivedFrom {
+		if !r.DerivedFrom[i].Equal(other.DerivedFrom[i]) {
+			return false
+		}
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.Equal(*other.TimingTiming) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.Equal(*other.TimingPeriod) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && *r.TimingDateTime != *other.TimingDateTime {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.Equal(*other.TimingDateTimeElement) {
+		return false
+	}
+	if (r.RecordedOn == nil) != (other.RecordedOn == nil) || r.RecordedOn != nil && *r.RecordedOn != *other.RecordedOn {
+		return false
+	}
+	if (r.RecordedOnElement == nil) != (other.RecordedOnElement == nil) || r.RecordedOnElement != nil && !r.RecordedOnElement.Equal(*other.RecordedOnElement) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !r.Source.Equal(*other.Source) {
+		return false
+	}
+	if !r.Device.Equal(other.Device) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.Equal(*other.BodySite) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DeviceUseStatement) EqualsDeep(other DeviceUseStatement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if !equivalentList(len(r.DerivedFrom), len(other.DerivedFrom), func(i, j int) bool {
+		return r.DerivedFrom[i].EqualsDeep(other.DerivedFrom[j])
+	}) {
+		return false
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.EqualsDeep(*other.TimingTiming) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.EqualsDeep(*other.TimingPeriod) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.EqualsDeep(*other.TimingDateTimeElement) {
+		return false
+	}
+	if (r.RecordedOn == nil) != (other.RecordedOn == nil) || r.RecordedOn != nil && !equivalentString(*r.RecordedOn, *other.RecordedOn) {
+		return false
+	}
+	if (r.RecordedOnElement == nil) != (other.RecordedOnElement == nil) || r.RecordedOnElement != nil && !r.RecordedOnElement.EqualsDeep(*other.RecordedOnElement) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !r.Source.EqualsDeep(*other.Source) {
+		return false
+	}
+	if !r.Device.EqualsDeep(other.Device) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.EqualsDeep(*other.BodySite) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DeviceUseStatement) EqualsShallow(other DeviceUseStatement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	if (r.RecordedOn == nil) != (other.RecordedOn == nil) || r.RecordedOn != nil && !equivalentString(*r.RecordedOn, *other.RecordedOn) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DeviceUseStatement as FHIR XML
+func (r DeviceUseStatement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "DeviceUseStatement",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	for _, v := range r.DerivedFrom {
+		if err := encodeXMLElement(e, "derivedFrom", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "timingTiming", r.TimingTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingPeriod", r.TimingPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timingDateTime", r.TimingDateTime, r.TimingDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "recordedOn", r.RecordedOn, r.RecordedOnElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "source", r.Source); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "device", r.Device); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "bodySite", r.BodySite); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DeviceUseStatement from FHIR XML
+func (r *DeviceUseStatement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "status":
+				var v DeviceUseStatementStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "derivedFrom":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DerivedFrom = append(r.DerivedFrom, v)
+			case "timingTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingTiming = &v
+			case "timingPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingPeriod = &v
+			case "timingDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimingDateTime = &v
+				}
+				r.TimingDateTimeElement = element
+			case "recordedOn":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RecordedOn = &v
+				}
+				r.RecordedOnElement = element
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = &v
+			case "device":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Device = v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = &v
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DeviceUseStatement as properties to the RDF node n
+func (r DeviceUseStatement) turtle(n *turtleNode) {
+	n.resource("DeviceUseStatement", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("DeviceUseStatement.identifier", i, v)
+	}
+	for i, v := range r.BasedOn {
+		n.element("DeviceUseStatement.basedOn", i, v)
+	}
+	n.primitive("DeviceUseStatement.status", -1, r.Status, r.StatusElement, "code")
+	n.element("DeviceUseStatement.subject", -1, r.Subject)
+	for i, v := range r.DerivedFrom {
+		n.element("DeviceUseStatement.derivedFrom", i, v)
+	}
+	n.element("DeviceUseStatement.timingTiming", -1, r.TimingTiming)
+	n.element("DeviceUseStatement.timingPeriod", -1, r.TimingPeriod)
+	n.primitive("DeviceUseStatement.timingDateTime", -1, r.TimingDateTime, r.TimingDateTimeElement, "dateTime")
+	n.primitive("DeviceUseStatement.recordedOn", -1, r.RecordedOn, r.RecordedOnElement, "dateTime")
+	n.element("DeviceUseStatement.source", -1, r.Source)
+	n.element("DeviceUseStatement.device", -1, r.Device)
+	for i, v := range r.ReasonCode {
+		n.element("DeviceUseStatement.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("DeviceUseStatement.reasonReference", i, v)
+	}
+	n.element("DeviceUseStatement.bodySite", -1, r.BodySite)
+	for i, v := range r.Note {
+		n.element("DeviceUseStatement.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DeviceUseStatement as BSON document with the structure of its FHIR JSON
+func (r DeviceUseStatement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DeviceUseStatement from a BSON document with the structure of its FHIR JSON
+func (r *DeviceUseStatement) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DeviceUseStatement as protobuf message DeviceUseStatement of fhir.proto
+func (r DeviceUseStatement) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DeviceUseStatement to the encoded message
+func (r DeviceUseStatement) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(10, v)
+	}
+	e.varint(11, r.Status.protoNumber())
+	e.message(12, r.Subject)
+	for _, v := range r.DerivedFrom {
+		e.message(13, v)
+	}
+	if r.TimingTiming != nil {
+		e.message(14, *r.TimingTiming)
+	}
+	if r.TimingPeriod != nil {
+		e.message(15, *r.TimingPeriod)
+	}
+	if r.TimingDateTime != nil {
+		e.string(16, *r.TimingDateTime)
+	}
+	if r.RecordedOn != nil {
+		e.string(17, *r.RecordedOn)
+	}
+	if r.Source != nil {
+		e.message(18, *r.Source)
+	}
+	e.message(19, r.Device)
+	for _, v := range r.ReasonCode {
+		e.message(20, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(21, v)
+	}
+	if r.BodySite != nil {
+		e.message(22, *r.BodySite)
+	}
+	for _, v := range r.Note {
+		e.message(23, v)
+	}
+	if r.IdElement != nil {
+		e.message(24, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(25, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(26, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(27, *r.StatusElement)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.message(28, *r.TimingDateTimeElement)
+	}
+	if r.RecordedOnElement != nil {
+		e.message(29, *r.RecordedOnElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DeviceUseStatement from protobuf message DeviceUseStatement of fhir.proto
+func (r *DeviceUseStatement) UnmarshalProto(b []byte) error {
+	*r = DeviceUseStatement{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 11:
+			var v DeviceUseStatementStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.DerivedFrom = append(r.DerivedFrom, v)
+		case 14:
+			var v Timing
+			d.message(&v)
+			r.TimingTiming = &v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.TimingPeriod = &v
+		case 16:
+			v := d.string()
+			r.TimingDateTime = &v
+		case 17:
+			v := d.string()
+			r.RecordedOn = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Source = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Device = v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = &v
+		case 23:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 24:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.TimingDateTimeElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.RecordedOnElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalDeviceUseStatement unmarshals a DeviceUseStatement.
 func UnmarshalDeviceUseStatement(b []byte) (DeviceUseStatement, error) {
 	var deviceUseStatement DeviceUseStatement
-	if err := json.Unmarshal(b, &deviceUseStatement); err != nil {
+	if err := deviceUseStatement.UnmarshalJSON(b); err != nil {
 		return deviceUseStatement, err
 	}
 	return deviceUseStatement, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code DeviceUseStatementStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DeviceUseStatementStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DeviceUseStatementStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = DeviceUseStatementStatusActive
@@ -58,6 +62,12 @@ func (code *DeviceUseStatementStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DeviceUseStatementStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DeviceUseStatementStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DeviceUseStatementStatus) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code DeviceUseStatementStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DeviceUseStatementStatus) protoNumber() uint64 {
+	switch code {
+	case DeviceUseStatementStatusActive:
+		return 1
+	case DeviceUseStatementStatusCompleted:
+		return 2
+	case DeviceUseStatementStatusEnteredInError:
+		return 3
+	case DeviceUseStatementStatusIntended:
+		return 4
+	case DeviceUseStatementStatusStopped:
+		return 5
+	case DeviceUseStatementStatusOnHold:
+		return 6
+	}
+	return 0
+}
+func (code *DeviceUseStatementStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DeviceUseStatementStatusActive
+	case 2:
+		*code = DeviceUseStatementStatusCompleted
+	case 3:
+		*code = DeviceUseStatementStatusEnteredInError
+	case 4:
+		*code = DeviceUseStatementStatusIntended
+	case 5:
+		*code = DeviceUseStatementStatusStopped
+	case 6:
+		*code = DeviceUseStatementStatusOnHold
+	default:
+		return false
+	}
+	return true
+}
@@ -14,64 +14,2114 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // DiagnosticReport is documented here http://hl7.org/fhir/StructureDefinition/DiagnosticReport
 type DiagnosticReport struct {
-	Id                 *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn            []Reference             `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Status             DiagnosticReportStatus  `bson:"status" json:"status"`
-	Category           []CodeableConcept       `bson:"category,omitempty" json:"category,omitempty"`
-	Code               CodeableConcept         `bson:"code" json:"code"`
-	Subject            *Reference              `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter          *Reference              `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	EffectiveDateTime  *string                 `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
-	EffectivePeriod    *Period                 `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Issued             *string                 `bson:"issued,omitempty" json:"issued,omitempty"`
-	Performer          []Reference             `bson:"performer,omitempty" json:"performer,omitempty"`
-	ResultsInterpreter []Reference             `bson:"resultsInterpreter,omitempty" json:"resultsInterpreter,omitempty"`
-	Specimen           []Reference             `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	Result             []Reference             `bson:"result,omitempty" json:"result,omitempty"`
-	ImagingStudy       []Reference             `bson:"imagingStudy,omitempty" json:"imagingStudy,omitempty"`
-	Media              []DiagnosticReportMedia `bson:"media,omitempty" json:"media,omitempty"`
-	Conclusion         *string                 `bson:"conclusion,omitempty" json:"conclusion,omitempty"`
-	ConclusionCode     []CodeableConcept       `bson:"conclusionCode,omitempty" json:"conclusionCode,omitempty"`
-	PresentedForm      []Attachment            `bson:"presentedForm,omitempty" json:"presentedForm,omitempty"`
+	Id                       *string                 `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                *Element                `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                     *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                []json.RawMessage       `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                  []Reference             `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Status                   DiagnosticReportStatus  `bson:"status" json:"status"`
+	StatusElement            *Element                `bson:"_status,omitempty" json:"_status,omitempty"`
+	Category                 []CodeableConcept       `bson:"category,omitempty" json:"category,omitempty"`
+	Code                     CodeableConcept         `bson:"code" json:"code"`
+	Subject                  *Reference              `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter                *Reference              `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	EffectiveDateTime        *string                 `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *Element                `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *Period                 `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Issued                   *string                 `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement            *Element                `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Performer                []Reference             `bson:"performer,omitempty" json:"performer,omitempty"`
+	ResultsInterpreter       []Reference             `bson:"resultsInterpreter,omitempty" json:"resultsInterpreter,omitempty"`
+	Specimen                 []Reference             `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	Result                   []Reference             `bson:"result,omitempty" json:"result,omitempty"`
+	ImagingStudy             []Reference             `bson:"imagingStudy,omitempty" json:"imagingStudy,omitempty"`
+	Media                    []DiagnosticReportMedia `bson:"media,omitempty" json:"media,omitempty"`
+	Conclusion               *string                 `bson:"conclusion,omitempty" json:"conclusion,omitempty"`
+	ConclusionElement        *Element                `bson:"_conclusion,omitempty" json:"_conclusion,omitempty"`
+	ConclusionCode           []CodeableConcept       `bson:"conclusionCode,omitempty" json:"conclusionCode,omitempty"`
+	PresentedForm            []Attachment            `bson:"presentedForm,omitempty" json:"presentedForm,omitempty"`
 }
+
+// DiagnosticReportEffective is implemented by the types allowed for DiagnosticReport.effective[x]
+type DiagnosticReportEffective interface {
+	isDiagnosticReportEffective()
+}
+
+func (DateTime) isDiagnosticReportEffective() {}
+func (Period) isDiagnosticReportEffective()   {}
+
+// Effective returns the value of DiagnosticReport.effective[x] together with its FHIR type code or nil if no value is set
+func (r DiagnosticReport) Effective() (DiagnosticReportEffective, string) {
+	if r.EffectiveDateTime != nil {
+		return DateTime(*r.EffectiveDateTime), "dateTime"
+	}
+	if r.EffectivePeriod != nil {
+		return *r.EffectivePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetEffective sets the value of DiagnosticReport.effective[x], given as value or pointer, and clears all other types
+func (r *DiagnosticReport) SetEffective(value DiagnosticReportEffective) {
+	r.EffectiveDateTime = nil
+	r.EffectivePeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.EffectiveDateTime = &primitive
+	case *DateTime:
+		r.EffectiveDateTime = (*string)(v)
+	case Period:
+		r.EffectivePeriod = &v
+	case *Period:
+		r.EffectivePeriod = v
+	}
+}
+
 type DiagnosticReportMedia struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Comment           *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element    `bson:"_comment,omitempty" json:"_comment,omitempty"`
 	Link              Reference   `bson:"link" json:"link"`
 }
-type OtherDiagnosticReport DiagnosticReport
 
 // MarshalJSON marshals the given DiagnosticReport as JSON into a byte slice
 func (r DiagnosticReport) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherDiagnosticReport
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherDiagnosticReport: OtherDiagnosticReport(r),
-		ResourceType:          "DiagnosticReport",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DiagnosticReport as JSON object with the members in the order of the fields
+func (r DiagnosticReport) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"DiagnosticReport\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.EffectiveDateTime != nil {
+		e.key("effectiveDateTime")
+		e.string(*r.EffectiveDateTime)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.key("_effectiveDateTime")
+		r.EffectiveDateTimeElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if r.Issued != nil {
+		e.key("issued")
+		e.string(*r.Issued)
+	}
+	if r.IssuedElement != nil {
+		e.key("_issued")
+		r.IssuedElement.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ResultsInterpreter) > 0 {
+		e.key("resultsInterpreter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ResultsInterpreter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Specimen) > 0 {
+		e.key("specimen")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Specimen {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Result) > 0 {
+		e.key("result")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Result {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ImagingStudy) > 0 {
+		e.key("imagingStudy")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ImagingStudy {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Media) > 0 {
+		e.key("media")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Media {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Conclusion != nil {
+		e.key("conclusion")
+		e.string(*r.Conclusion)
+	}
+	if r.ConclusionElement != nil {
+		e.key("_conclusion")
+		r.ConclusionElement.appendJSON(e)
+	}
+	if len(r.ConclusionCode) > 0 {
+		e.key("conclusionCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ConclusionCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PresentedForm) > 0 {
+		e.key("presentedForm")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PresentedForm {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DiagnosticReport from JSON. Unknown members are ignored.
+func (r *DiagnosticReport) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DiagnosticReport
+func (r *DiagnosticReport) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "effectiveDateTime":
+			if v, ok := d.string(); ok {
+				r.EffectiveDateTime = &v
+			} else {
+				r.EffectiveDateTime = nil
+			}
+		case "_effectiveDateTime":
+			r.EffectiveDateTimeElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "issued":
+			if v, ok := d.string(); ok {
+				r.Issued = &v
+			} else {
+				r.Issued = nil
+			}
+		case "_issued":
+			r.IssuedElement = d.element()
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []Reference{}
+			}
+		case "resultsInterpreter":
+			a := d.array()
+			r.ResultsInterpreter = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ResultsInterpreter = append(r.ResultsInterpreter, v)
+			}
+			if r.ResultsInterpreter == nil && !a.isNull {
+				r.ResultsInterpreter = []Reference{}
+			}
+		case "specimen":
+			a := d.array()
+			r.Specimen = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Specimen = append(r.Specimen, v)
+			}
+			if r.Specimen == nil && !a.isNull {
+				r.Specimen = []Reference{}
+			}
+		case "result":
+			a := d.array()
+			r.Result = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Result = append(r.Result, v)
+			}
+			if r.Result == nil && !a.isNull {
+				r.Result = []Reference{}
+			}
+		case "imagingStudy":
+			a := d.array()
+			r.ImagingStudy = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ImagingStudy = append(r.ImagingStudy, v)
+			}
+			if r.ImagingStudy == nil && !a.isNull {
+				r.ImagingStudy = []Reference{}
+			}
+		case "media":
+			a := d.array()
+			r.Media = nil
+			for a.next() {
+				var v DiagnosticReportMedia
+				v.decodeJSON(d)
+				r.Media = append(r.Media, v)
+			}
+			if r.Media == nil && !a.isNull {
+				r.Media = []DiagnosticReportMedia{}
+			}
+		case "conclusion":
+			if v, ok := d.string(); ok {
+				r.Conclusion = &v
+			} else {
+				r.Conclusion = nil
+			}
+		case "_conclusion":
+			r.ConclusionElement = d.element()
+		case "conclusionCode":
+			a := d.array()
+			r.ConclusionCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ConclusionCode = append(r.ConclusionCode, v)
+			}
+			if r.ConclusionCode == nil && !a.isNull {
+				r.ConclusionCode = []CodeableConcept{}
+			}
+		case "presentedForm":
+			a := d.array()
+			r.PresentedForm = nil
+			for a.next() {
+				var v Attachment
+				v.decodeJSON(d)
+				r.PresentedForm = append(r.PresentedForm, v)
+			}
+			if r.PresentedForm == nil && !a.isNull {
+				r.PresentedForm = []Attachment{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DiagnosticReport which shares no memory with the original
+func (r DiagnosticReport) DeepCopy() DiagnosticReport {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.EffectiveDateTime != nil {
+		v := *r.EffectiveDateTime
+		out.EffectiveDateTime = &v
+	}
+	if r.EffectiveDateTimeElement != nil {
+		v := r.EffectiveDateTimeElement.DeepCopy()
+		out.EffectiveDateTimeElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Issued != nil {
+		v := *r.Issued
+		out.Issued = &v
+	}
+	if r.IssuedElement != nil {
+		v := r.IssuedElement.DeepCopy()
+		out.IssuedElement = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]Reference, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.ResultsInterpreter != nil {
+		out.ResultsInterpreter = make([]Reference, len(r.ResultsInterpreter))
+		for i := range r.ResultsInterpreter {
+			out.ResultsInterpreter[i] = r.ResultsInterpreter[i].DeepCopy()
+		}
+	}
+	if r.Specimen != nil {
+		out.Specimen = make([]Reference, len(r.Specimen))
+		for i := range r.Specimen {
+			out.Specimen[i] = r.Specimen[i].DeepCopy()
+		}
+	}
+	if r.Result != nil {
+		out.Result = make([]Reference, len(r.Result))
+		for i := range r.Result {
+			out.Result[i] = r.Result[i].DeepCopy()
+		}
+	}
+	if r.ImagingStudy != nil {
+		out.ImagingStudy = make([]Reference, len(r.ImagingStudy))
+		for i := range r.ImagingStudy {
+			out.ImagingStudy[i] = r.ImagingStudy[i].DeepCopy()
+		}
+	}
+	if r.Media != nil {
+		out.Media = make([]DiagnosticReportMedia, len(r.Media))
+		for i := range r.Media {
+			out.Media[i] = r.Media[i].DeepCopy()
+		}
+	}
+	if r.Conclusion != nil {
+		v := *r.Conclusion
+		out.Conclusion = &v
+	}
+	if r.ConclusionElement != nil {
+		v := r.ConclusionElement.DeepCopy()
+		out.ConclusionElement = &v
+	}
+	if r.ConclusionCode != nil {
+		out.ConclusionCode = make([]CodeableConcept, len(r.ConclusionCode))
+		for i := range r.ConclusionCode {
+			out.ConclusionCode[i] = r.ConclusionCode[i].DeepCopy()
+		}
+	}
+	if r.PresentedForm != nil {
+		out.PresentedForm = make([]Attachment, len(r.PresentedForm))
+		for i := range r.PresentedForm {
+			out.PresentedForm[i] = r.PresentedForm[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DiagnosticReport) Equal(other DiagnosticReport) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && *r.EffectiveDateTime != *other.EffectiveDateTime {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.Equal(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && *r.Issued != *other.Issued {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.Equal(*other.IssuedElement) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if len(r.ResultsInterpreter) != len(other.ResultsInterpreter) {
+		return false
+	}
+	for i := range r.ResultsInterpreter {
+		if !r.ResultsInterpreter[i].Equal(other.ResultsInterpreter[i]) {
+			return false
+		}
+	}
+	if len(r.Specimen) != len(other.Specimen) {
+		return false
+	}
+	for i := range r.Specimen {
+		if !r.Specimen[i].Equal(other.Specimen[i]) {
+			return false
+		}
+	}
+	if len(r.Result) != len(other.Result) {
+		return false
+	}
+	for i := range r.Result {
+		if !r.Result[i].Equal(other.Result[i]) {
+			return false
+		}
+	}
+	if len(r.ImagingStudy) != len(other.ImagingStudy) {
+		return false
+	}
+	for i := range r.ImagingStudy {
+		if !r.ImagingStudy[i].Equal(other.ImagingStudy[i]) {
+			return false
+		}
+	}
+	if len(r.Media) != len(other.Media) {
+		return false
+	}
+	for i := range r.Media {
+		if !r.Media[i].Equal(other.Media[i]) {
+			return false
+		}
+	}
+	if (r.Conclusion == nil) != (other.Conclusion == nil) || r.Conclusion != nil && *r.Conclusion != *other.Conclusion {
+		return false
+	}
+	if (r.ConclusionElement == nil) != (other.ConclusionElement == nil) || r.ConclusionElement != nil && !r.ConclusionElement.Equal(*other.ConclusionElement) {
+		return false
+	}
+	if len(r.ConclusionCode) != len(other.ConclusionCode) {
+		return false
+	}
+	for i := range r.ConclusionCode {
+		if !r.ConclusionCode[i].Equal(other.ConclusionCode[i]) {
+			return false
+		}
+	}
+	if len(r.PresentedForm) != len(other.PresentedForm) {
+		return false
+	}
+	for i := range r.PresentedForm {
+		if !r.PresentedForm[i].Equal(other.PresentedForm[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DiagnosticReport) EqualsDeep(other DiagnosticReport) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.EqualsDeep(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.EqualsDeep(*other.IssuedElement) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ResultsInterpreter), len(other.ResultsInterpreter), func(i, j int) bool {
+		return r.ResultsInterpreter[i].EqualsDeep(other.ResultsInterpreter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Specimen), len(other.Specimen), func(i, j int) bool {
+		return r.Specimen[i].EqualsDeep(other.Specimen[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Result), len(other.Result), func(i, j int) bool {
+		return r.Result[i].EqualsDeep(other.Result[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ImagingStudy), len(other.ImagingStudy), func(i, j int) bool {
+		return r.ImagingStudy[i].EqualsDeep(other.ImagingStudy[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Media), len(other.Media), func(i, j int) bool {
+		return r.Media[i].EqualsDeep(other.Media[j])
+	}) {
+		return false
+	}
+	if (r.Conclusion == nil) != (other.Conclusion == nil) || r.Conclusion != nil && !equivalentString(*r.Conclusion, *other.Conclusion) {
+		return false
+	}
+	if (r.ConclusionElement == nil) != (other.ConclusionElement == nil) || r.ConclusionElement != nil && !r.ConclusionElement.EqualsDeep(*other.ConclusionElement) {
+		return false
+	}
+	if !equivalentList(len(r.ConclusionCode), len(other.ConclusionCode), func(i, j int) bool {
+		return r.ConclusionCode[i].EqualsDeep(other.ConclusionCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PresentedForm), len(other.PresentedForm), func(i, j int) bool {
+		return r.PresentedForm[i].EqualsDeep(other.PresentedForm[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DiagnosticReport) EqualsShallow(other DiagnosticReport) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.Conclusion == nil) != (other.Conclusion == nil) || r.Conclusion != nil && !equivalentString(*r.Conclusion, *other.Conclusion) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DiagnosticReport as FHIR XML
+func (r DiagnosticReport) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "DiagnosticReport",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "effectiveDateTime", r.EffectiveDateTime, r.EffectiveDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "issued", r.Issued, r.IssuedElement); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ResultsInterpreter {
+		if err := encodeXMLElement(e, "resultsInterpreter", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Specimen {
+		if err := encodeXMLElement(e, "specimen", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Result {
+		if err := encodeXMLElement(e, "result", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ImagingStudy {
+		if err := encodeXMLElement(e, "imagingStudy", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Media {
+		if err := encodeXMLElement(e, "media", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "conclusion", r.Conclusion, r.ConclusionElement); err != nil {
+		return err
+	}
+	for _, v := range r.ConclusionCode {
+		if err := encodeXMLElement(e, "conclusionCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PresentedForm {
+		if err := encodeXMLElement(e, "presentedForm", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DiagnosticReport from FHIR XML
+func (r *DiagnosticReport) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "status":
+				var v DiagnosticReportStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "effectiveDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EffectiveDateTime = &v
+				}
+				r.EffectiveDateTimeElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "issued":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Issued = &v
+				}
+				r.IssuedElement = element
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "resultsInterpreter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ResultsInterpreter = append(r.ResultsInterpreter, v)
+			case "specimen":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specimen = append(r.Specimen, v)
+			case "result":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Result = append(r.Result, v)
+			case "imagingStudy":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ImagingStudy = append(r.ImagingStudy, v)
+			case "media":
+				var v DiagnosticReportMedia
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Media = append(r.Media, v)
+			case "conclusion":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Conclusion = &v
+				}
+				r.ConclusionElement = element
+			case "conclusionCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ConclusionCode = append(r.ConclusionCode, v)
+			case "presentedForm":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PresentedForm = append(r.PresentedForm, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DiagnosticReport as properties to the RDF node n
+func (r DiagnosticReport) turtle(n *turtleNode) {
+	n.resource("DiagnosticReport", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("DiagnosticReport.identifier", i, v)
+	}
+	for i, v := range r.BasedOn {
+		n.element("DiagnosticReport.basedOn", i, v)
+	}
+	n.primitive("DiagnosticReport.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.Category {
+		n.element("DiagnosticReport.category", i, v)
+	}
+	n.element("DiagnosticReport.code", -1, r.Code)
+	n.element("DiagnosticReport.subject", -1, r.Subject)
+	n.element("DiagnosticReport.encounter", -1, r.Encounter)
+	n.primitive("DiagnosticReport.effectiveDateTime", -1, r.EffectiveDateTime, r.EffectiveDateTimeElement, "dateTime")
+	n.element("DiagnosticReport.effectivePeriod", -1, r.EffectivePeriod)
+	n.primitive("DiagnosticReport.issued", -1, r.Issued, r.IssuedElement, "instant")
+	for i, v := range r.Performer {
+		n.element("DiagnosticReport.performer", i, v)
+	}
+	for i, v := range r.ResultsInterpreter {
+		n.element("DiagnosticReport.resultsInterpreter", i, v)
+	}
+	for i, v := range r.Specimen {
+		n.element("DiagnosticReport.specimen", i, v)
+	}
+	for i, v := range r.Result {
+		n.element("DiagnosticReport.result", i, v)
+	}
+	for i, v := range r.ImagingStudy {
+		n.element("DiagnosticReport.imagingStudy", i, v)
+	}
+	for i, v := range r.Media {
+		n.element("DiagnosticReport.media", i, v)
+	}
+	n.primitive("DiagnosticReport.conclusion", -1, r.Conclusion, r.ConclusionElement, "string")
+	for i, v := range r.ConclusionCode {
+		n.element("DiagnosticReport.conclusionCode", i, v)
+	}
+	for i, v := range r.PresentedForm {
+		n.element("DiagnosticReport.presentedForm", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DiagnosticReport as BSON document with the structure of its FHIR JSON
+func (r DiagnosticReport) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DiagnosticReport from a BSON document with the structure of its FHIR JSON
+func (r *DiagnosticReport) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DiagnosticReport as protobuf message DiagnosticReport of fhir.proto
+func (r DiagnosticReport) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DiagnosticReport to the encoded message
+func (r DiagnosticReport) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(10, v)
+	}
+	e.varint(11, r.Status.protoNumber())
+	for _, v := range r.Category {
+		e.message(12, v)
+	}
+	e.message(13, r.Code)
+	if r.Subject != nil {
+		e.message(14, *r.Subject)
+	}
+	if r.Encounter != nil {
+		e.message(15, *r.Encounter)
+	}
+	if r.EffectiveDateTime != nil {
+		e.string(16, *r.EffectiveDateTime)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(17, *r.EffectivePeriod)
+	}
+	if r.Issued != nil {
+		e.string(18, *r.Issued)
+	}
+	for _, v := range r.Performer {
+		e.message(19, v)
+	}
+	for _, v := range r.ResultsInterpreter {
+		e.message(20, v)
+	}
+	for _, v := range r.Specimen {
+		e.message(21, v)
+	}
+	for _, v := range r.Result {
+		e.message(22, v)
+	}
+	for _, v := range r.ImagingStudy {
+		e.message(23, v)
+	}
+	for _, v := range r.Media {
+		e.message(24, v)
+	}
+	if r.Conclusion != nil {
+		e.string(25, *r.Conclusion)
+	}
+	for _, v := range r.ConclusionCode {
+		e.message(26, v)
+	}
+	for _, v := range r.PresentedForm {
+		e.message(27, v)
+	}
+	if r.IdElement != nil {
+		e.message(28, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(29, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(30, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(31, *r.StatusElement)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.message(32, *r.EffectiveDateTimeElement)
+	}
+	if r.IssuedElement != nil {
+		e.message(33, *r.IssuedElement)
+	}
+	if r.ConclusionElement != nil {
+		e.message(34, *r.ConclusionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DiagnosticReport from protobuf message DiagnosticReport of fhir.proto
+func (r *DiagnosticReport) UnmarshalProto(b []byte) error {
+	*r = DiagnosticReport{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 11:
+			var v DiagnosticReportStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 16:
+			v := d.string()
+			r.EffectiveDateTime = &v
+		case 17:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 18:
+			v := d.string()
+			r.Issued = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.ResultsInterpreter = append(r.ResultsInterpreter, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Specimen = append(r.Specimen, v)
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.Result = append(r.Result, v)
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.ImagingStudy = append(r.ImagingStudy, v)
+		case 24:
+			var v DiagnosticReportMedia
+			d.message(&v)
+			r.Media = append(r.Media, v)
+		case 25:
+			v := d.string()
+			r.Conclusion = &v
+		case 26:
+			var v CodeableConcept
+			d.message(&v)
+			r.ConclusionCode = append(r.ConclusionCode, v)
+		case 27:
+			var v Attachment
+			d.message(&v)
+			r.PresentedForm = append(r.PresentedForm, v)
+		case 28:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.EffectiveDateTimeElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.IssuedElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.ConclusionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DiagnosticReportMedia as JSON into a byte slice
+func (r DiagnosticReportMedia) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DiagnosticReportMedia as JSON object with the members in the order of the fields
+func (r DiagnosticReportMedia) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	e.key("link")
+	r.Link.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DiagnosticReportMedia from JSON. Unknown members are ignored.
+func (r *DiagnosticReportMedia) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DiagnosticReportMedia
+func (r *DiagnosticReportMedia) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		case "link":
+			r.Link.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DiagnosticReportMedia which shares no memory with the original
+func (r DiagnosticReportMedia) DeepCopy() DiagnosticReportMedia {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	out.Link = r.Link.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DiagnosticReportMedia) Equal(other DiagnosticReportMedia) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	if !r.Link.Equal(other.Link) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DiagnosticReportMedia) EqualsDeep(other DiagnosticReportMedia) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	if !r.Link.EqualsDeep(other.Link) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DiagnosticReportMedia) EqualsShallow(other DiagnosticReportMedia) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DiagnosticReportMedia as FHIR XML
+func (r DiagnosticReportMedia) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "link", r.Link); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DiagnosticReportMedia from FHIR XML
+func (r *DiagnosticReportMedia) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			case "link":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Link = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DiagnosticReportMedia as properties to the RDF node n
+func (r DiagnosticReportMedia) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("DiagnosticReport.media.comment", -1, r.Comment, r.CommentElement, "string")
+	n.element("DiagnosticReport.media.link", -1, r.Link)
+}
+
+// MarshalBSON marshals the given DiagnosticReportMedia as BSON document with the structure of its FHIR JSON
+func (r DiagnosticReportMedia) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DiagnosticReportMedia from a BSON document with the structure of its FHIR JSON
+func (r *DiagnosticReportMedia) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DiagnosticReportMedia as protobuf message DiagnosticReportMedia of fhir.proto
+func (r DiagnosticReportMedia) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DiagnosticReportMedia to the encoded message
+func (r DiagnosticReportMedia) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Comment != nil {
+		e.string(4, *r.Comment)
+	}
+	e.message(5, r.Link)
+	if r.CommentElement != nil {
+		e.message(6, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DiagnosticReportMedia from protobuf message DiagnosticReportMedia of fhir.proto
+func (r *DiagnosticReportMedia) UnmarshalProto(b []byte) error {
+	*r = DiagnosticReportMedia{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Comment = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Link = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalDiagnosticReport unmarshals a DiagnosticReport.
 func UnmarshalDiagnosticReport(b []byte) (DiagnosticReport, error) {
 	var diagnosticReport DiagnosticReport
-	if err := json.Unmarshal(b, &diagnosticReport); err != nil {
+	if err := diagnosticReport.UnmarshalJSON(b); err != nil {
 		return diagnosticReport, err
 	}
 	return diagnosticReport, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -42,8 +41,13 @@ const (
 func (code DiagnosticReportStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DiagnosticReportStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DiagnosticReportStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "registered":
 		*code = DiagnosticReportStatusRegistered
@@ -70,6 +74,12 @@ func (code *DiagnosticReportStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DiagnosticReportStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DiagnosticReportStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DiagnosticReportStatus) String() string {
 	return code.Code()
 }
@@ -148,3 +158,55 @@ func (code DiagnosticReportStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DiagnosticReportStatus) protoNumber() uint64 {
+	switch code {
+	case DiagnosticReportStatusRegistered:
+		return 1
+	case DiagnosticReportStatusPartial:
+		return 2
+	case DiagnosticReportStatusPreliminary:
+		return 3
+	case DiagnosticReportStatusFinal:
+		return 4
+	case DiagnosticReportStatusAmended:
+		return 5
+	case DiagnosticReportStatusCorrected:
+		return 6
+	case DiagnosticReportStatusAppended:
+		return 7
+	case DiagnosticReportStatusCancelled:
+		return 8
+	case DiagnosticReportStatusEnteredInError:
+		return 9
+	case DiagnosticReportStatusUnknown:
+		return 10
+	}
+	return 0
+}
+func (code *DiagnosticReportStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DiagnosticReportStatusRegistered
+	case 2:
+		*code = DiagnosticReportStatusPartial
+	case 3:
+		*code = DiagnosticReportStatusPreliminary
+	case 4:
+		*code = DiagnosticReportStatusFinal
+	case 5:
+		*code = DiagnosticReportStatusAmended
+	case 6:
+		*code = DiagnosticReportStatusCorrected
+	case 7:
+		*code = DiagnosticReportStatusAppended
+	case 8:
+		*code = DiagnosticReportStatusCancelled
+	case 9:
+		*code = DiagnosticReportStatusEnteredInError
+	case 10:
+		*code = DiagnosticReportStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
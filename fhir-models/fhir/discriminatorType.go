@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code DiscriminatorType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DiscriminatorType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DiscriminatorType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "value":
 		*code = DiscriminatorTypeValue
@@ -55,6 +59,12 @@ func (code *DiscriminatorType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DiscriminatorType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DiscriminatorType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DiscriminatorType) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code DiscriminatorType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DiscriminatorType) protoNumber() uint64 {
+	switch code {
+	case DiscriminatorTypeValue:
+		return 1
+	case DiscriminatorTypeExists:
+		return 2
+	case DiscriminatorTypePattern:
+		return 3
+	case DiscriminatorTypeType:
+		return 4
+	case DiscriminatorTypeProfile:
+		return 5
+	}
+	return 0
+}
+func (code *DiscriminatorType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DiscriminatorTypeValue
+	case 2:
+		*code = DiscriminatorTypeExists
+	case 3:
+		*code = DiscriminatorTypePattern
+	case 4:
+		*code = DiscriminatorTypeType
+	case 5:
+		*code = DiscriminatorTypeProfile
+	default:
+		return false
+	}
+	return true
+}
@@ -14,32 +14,43 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // DocumentManifest is documented here http://hl7.org/fhir/StructureDefinition/DocumentManifest
 type DocumentManifest struct {
-	Id                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	MasterIdentifier  *Identifier               `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
-	Identifier        []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            DocumentReferenceStatus   `bson:"status" json:"status"`
-	Type              *CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
-	Subject           *Reference                `bson:"subject,omitempty" json:"subject,omitempty"`
-	Created           *string                   `bson:"created,omitempty" json:"created,omitempty"`
-	Author            []Reference               `bson:"author,omitempty" json:"author,omitempty"`
-	Recipient         []Reference               `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Source            *string                   `bson:"source,omitempty" json:"source,omitempty"`
-	Description       *string                   `bson:"description,omitempty" json:"description,omitempty"`
-	Content           []Reference               `bson:"content" json:"content"`
-	Related           []DocumentManifestRelated `bson:"related,omitempty" json:"related,omitempty"`
+	Id                   *string                   `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                  `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                  `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	MasterIdentifier     *Identifier               `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
+	Identifier           []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               DocumentReferenceStatus   `bson:"status" json:"status"`
+	StatusElement        *Element                  `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 *CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Subject              *Reference                `bson:"subject,omitempty" json:"subject,omitempty"`
+	Created              *string                   `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement       *Element                  `bson:"_created,omitempty" json:"_created,omitempty"`
+	Author               []Reference               `bson:"author,omitempty" json:"author,omitempty"`
+	Recipient            []Reference               `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Source               *string                   `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement        *Element                  `bson:"_source,omitempty" json:"_source,omitempty"`
+	Description          *string                   `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                  `bson:"_description,omitempty" json:"_description,omitempty"`
+	Content              []Reference               `bson:"content" json:"content"`
+	Related              []DocumentManifestRelated `bson:"related,omitempty" json:"related,omitempty"`
 }
 type DocumentManifestRelated struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
@@ -48,23 +59,1626 @@ type DocumentManifestRelated struct {
 	Identifier        *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Ref               *Reference  `bson:"ref,omitempty" json:"ref,omitempty"`
 }
-type OtherDocumentManifest DocumentManifest
 
 // MarshalJSON marshals the given DocumentManifest as JSON into a byte slice
 func (r DocumentManifest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherDocumentManifest
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherDocumentManifest: OtherDocumentManifest(r),
-		ResourceType:          "DocumentManifest",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DocumentManifest as JSON object with the members in the order of the fields
+func (r DocumentManifest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"DocumentManifest\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MasterIdentifier != nil {
+		e.key("masterIdentifier")
+		r.MasterIdentifier.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Created != nil {
+		e.key("created")
+		e.string(*r.Created)
+	}
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Recipient) > 0 {
+		e.key("recipient")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Recipient {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Source != nil {
+		e.key("source")
+		e.string(*r.Source)
+	}
+	if r.SourceElement != nil {
+		e.key("_source")
+		r.SourceElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.key("content")
+	if r.Content == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Content {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Related) > 0 {
+		e.key("related")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Related {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DocumentManifest from JSON. Unknown members are ignored.
+func (r *DocumentManifest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DocumentManifest
+func (r *DocumentManifest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "masterIdentifier":
+			if d.null() {
+				r.MasterIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.MasterIdentifier = &v
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = &v
+			} else {
+				r.Created = nil
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []Reference{}
+			}
+		case "recipient":
+			a := d.array()
+			r.Recipient = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recipient = append(r.Recipient, v)
+			}
+			if r.Recipient == nil && !a.isNull {
+				r.Recipient = []Reference{}
+			}
+		case "source":
+			if v, ok := d.string(); ok {
+				r.Source = &v
+			} else {
+				r.Source = nil
+			}
+		case "_source":
+			r.SourceElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "content":
+			a := d.array()
+			r.Content = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Content = append(r.Content, v)
+			}
+			if r.Content == nil && !a.isNull {
+				r.Content = []Reference{}
+			}
+		case "related":
+			a := d.array()
+			r.Related = nil
+			for a.next() {
+				var v DocumentManifestRelated
+				v.decodeJSON(d)
+				r.Related = append(r.Related, v)
+			}
+			if r.Related == nil && !a.isNull {
+				r.Related = []DocumentManifestRelated{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DocumentManifest which shares no memory with the original
+func (r DocumentManifest) DeepCopy() DocumentManifest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.MasterIdentifier != nil {
+		v := r.MasterIdentifier.DeepCopy()
+		out.MasterIdentifier = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Created != nil {
+		v := *r.Created
+		out.Created = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Author != nil {
+		out.Author = make([]Reference, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Recipient != nil {
+		out.Recipient = make([]Reference, len(r.Recipient))
+		for i := range r.Recipient {
+			out.Recipient[i] = r.Recipient[i].DeepCopy()
+		}
+	}
+	if r.Source != nil {
+		v := *r.Source
+		out.Source = &v
+	}
+	if r.SourceElement != nil {
+		v := r.SourceElement.DeepCopy()
+		out.SourceElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Content != nil {
+		out.Content = make([]Reference, len(r.Content))
+		for i := range r.Content {
+			out.Content[i] = r.Content[i].DeepCopy()
+		}
+	}
+	if r.Related != nil {
+		out.Related = make([]DocumentManifestRelated, len(r.Related))
+		for i := range r.Related {
+			out.Related[i] = r.Related[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DocumentManifest) Equal(other DocumentManifest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.MasterIdentifier == nil) != (other.MasterIdentifier == nil) || r.MasterIdentifier != nil && !r.MasterIdentifier.Equal(*other.MasterIdentifier) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && *r.Created != *other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if len(r.Recipient) != len(other.Recipient) {
+		return false
+	}
+	for i := range r.Recipient {
+		if !r.Recipient[i].Equal(other.Recipient[i]) {
+			return false
+		}
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && *r.Source != *other.Source {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.Equal(*other.SourceElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Content) != len(other.Content) {
+		return false
+	}
+	for i := range r.Content {
+		if !r.Content[i].Equal(other.Content[i]) {
+			return false
+		}
+	}
+	if len(r.Related) != len(other.Related) {
+		return false
+	}
+	for i := range r.Related {
+		if !r.Related[i].Equal(other.Related[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DocumentManifest) EqualsDeep(other DocumentManifest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.MasterIdentifier == nil) != (other.MasterIdentifier == nil) || r.MasterIdentifier != nil && !r.MasterIdentifier.EqualsDeep(*other.MasterIdentifier) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Recipient), len(other.Recipient), func(i, j int) bool {
+		return r.Recipient[i].EqualsDeep(other.Recipient[j])
+	}) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.EqualsDeep(*other.SourceElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Content), len(other.Content), func(i, j int) bool {
+		return r.Content[i].EqualsDeep(other.Content[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Related), len(other.Related), func(i, j int) bool {
+		return r.Related[i].EqualsDeep(other.Related[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DocumentManifest) EqualsShallow(other DocumentManifest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DocumentManifest as FHIR XML
+func (r DocumentManifest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "DocumentManifest",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "masterIdentifier", r.MasterIdentifier); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Recipient {
+		if err := encodeXMLElement(e, "recipient", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "source", r.Source, r.SourceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Content {
+		if err := encodeXMLElement(e, "content", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Related {
+		if err := encodeXMLElement(e, "related", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DocumentManifest from FHIR XML
+func (r *DocumentManifest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "masterIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MasterIdentifier = &v
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v DocumentReferenceStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = &v
+				}
+				r.CreatedElement = element
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "recipient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recipient = append(r.Recipient, v)
+			case "source":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Source = &v
+				}
+				r.SourceElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "content":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Content = append(r.Content, v)
+			case "related":
+				var v DocumentManifestRelated
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Related = append(r.Related, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DocumentManifest as properties to the RDF node n
+func (r DocumentManifest) turtle(n *turtleNode) {
+	n.resource("DocumentManifest", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("DocumentManifest.masterIdentifier", -1, r.MasterIdentifier)
+	for i, v := range r.Identifier {
+		n.element("DocumentManifest.identifier", i, v)
+	}
+	n.primitive("DocumentManifest.status", -1, r.Status, r.StatusElement, "code")
+	n.element("DocumentManifest.type", -1, r.Type)
+	n.element("DocumentManifest.subject", -1, r.Subject)
+	n.primitive("DocumentManifest.created", -1, r.Created, r.CreatedElement, "dateTime")
+	for i, v := range r.Author {
+		n.element("DocumentManifest.author", i, v)
+	}
+	for i, v := range r.Recipient {
+		n.element("DocumentManifest.recipient", i, v)
+	}
+	n.primitive("DocumentManifest.source", -1, r.Source, r.SourceElement, "string")
+	n.primitive("DocumentManifest.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Content {
+		n.element("DocumentManifest.content", i, v)
+	}
+	for i, v := range r.Related {
+		n.element("DocumentManifest.related", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DocumentManifest as BSON document with the structure of its FHIR JSON
+func (r DocumentManifest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DocumentManifest from a BSON document with the structure of its FHIR JSON
+func (r *DocumentManifest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DocumentManifest as protobuf message DocumentManifest of fhir.proto
+func (r DocumentManifest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DocumentManifest to the encoded message
+func (r DocumentManifest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.MasterIdentifier != nil {
+		e.message(9, *r.MasterIdentifier)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	e.varint(11, r.Status.protoNumber())
+	if r.Type != nil {
+		e.message(12, *r.Type)
+	}
+	if r.Subject != nil {
+		e.message(13, *r.Subject)
+	}
+	if r.Created != nil {
+		e.string(14, *r.Created)
+	}
+	for _, v := range r.Author {
+		e.message(15, v)
+	}
+	for _, v := range r.Recipient {
+		e.message(16, v)
+	}
+	if r.Source != nil {
+		e.string(17, *r.Source)
+	}
+	if r.Description != nil {
+		e.string(18, *r.Description)
+	}
+	for _, v := range r.Content {
+		e.message(19, v)
+	}
+	for _, v := range r.Related {
+		e.message(20, v)
+	}
+	if r.IdElement != nil {
+		e.message(21, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(22, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(23, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(24, *r.StatusElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(25, *r.CreatedElement)
+	}
+	if r.SourceElement != nil {
+		e.message(26, *r.SourceElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(27, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DocumentManifest from protobuf message DocumentManifest of fhir.proto
+func (r *DocumentManifest) UnmarshalProto(b []byte) error {
+	*r = DocumentManifest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.MasterIdentifier = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			var v DocumentReferenceStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 14:
+			v := d.string()
+			r.Created = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Recipient = append(r.Recipient, v)
+		case 17:
+			v := d.string()
+			r.Source = &v
+		case 18:
+			v := d.string()
+			r.Description = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Content = append(r.Content, v)
+		case 20:
+			var v DocumentManifestRelated
+			d.message(&v)
+			r.Related = append(r.Related, v)
+		case 21:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.SourceElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DocumentManifestRelated as JSON into a byte slice
+func (r DocumentManifestRelated) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DocumentManifestRelated as JSON object with the members in the order of the fields
+func (r DocumentManifestRelated) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Ref != nil {
+		e.key("ref")
+		r.Ref.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DocumentManifestRelated from JSON. Unknown members are ignored.
+func (r *DocumentManifestRelated) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DocumentManifestRelated
+func (r *DocumentManifestRelated) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "ref":
+			if d.null() {
+				r.Ref = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Ref = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DocumentManifestRelated which shares no memory with the original
+func (r DocumentManifestRelated) DeepCopy() DocumentManifestRelated {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Ref != nil {
+		v := r.Ref.DeepCopy()
+		out.Ref = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DocumentManifestRelated) Equal(other DocumentManifestRelated) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Ref == nil) != (other.Ref == nil) || r.Ref != nil && !r.Ref.Equal(*other.Ref) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DocumentManifestRelated) EqualsDeep(other DocumentManifestRelated) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Ref == nil) != (other.Ref == nil) || r.Ref != nil && !r.Ref.EqualsDeep(*other.Ref) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DocumentManifestRelated) EqualsShallow(other DocumentManifestRelated) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DocumentManifestRelated as FHIR XML
+func (r DocumentManifestRelated) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "ref", r.Ref); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DocumentManifestRelated from FHIR XML
+func (r *DocumentManifestRelated) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "ref":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Ref = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DocumentManifestRelated as properties to the RDF node n
+func (r DocumentManifestRelated) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("DocumentManifest.related.identifier", -1, r.Identifier)
+	n.element("DocumentManifest.related.ref", -1, r.Ref)
+}
+
+// MarshalBSON marshals the given DocumentManifestRelated as BSON document with the structure of its FHIR JSON
+func (r DocumentManifestRelated) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DocumentManifestRelated from a BSON document with the structure of its FHIR JSON
+func (r *DocumentManifestRelated) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DocumentManifestRelated as protobuf message DocumentManifestRelated of fhir.proto
+func (r DocumentManifestRelated) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DocumentManifestRelated to the encoded message
+func (r DocumentManifestRelated) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identifier != nil {
+		e.message(4, *r.Identifier)
+	}
+	if r.Ref != nil {
+		e.message(5, *r.Ref)
+	}
+}
+
+// UnmarshalProto unmarshals the given DocumentManifestRelated from protobuf message DocumentManifestRelated of fhir.proto
+func (r *DocumentManifestRelated) UnmarshalProto(b []byte) error {
+	*r = DocumentManifestRelated{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Ref = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalDocumentManifest unmarshals a DocumentManifest.
 func UnmarshalDocumentManifest(b []byte) (DocumentManifest, error) {
 	var documentManifest DocumentManifest
-	if err := json.Unmarshal(b, &documentManifest); err != nil {
+	if err := documentManifest.UnmarshalJSON(b); err != nil {
 		return documentManifest, err
 	}
 	return documentManifest, nil
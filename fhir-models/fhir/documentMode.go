@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code DocumentMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DocumentMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DocumentMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "producer":
 		*code = DocumentModeProducer
@@ -46,6 +50,12 @@ func (code *DocumentMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DocumentMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DocumentMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DocumentMode) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code DocumentMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DocumentMode) protoNumber() uint64 {
+	switch code {
+	case DocumentModeProducer:
+		return 1
+	case DocumentModeConsumer:
+		return 2
+	}
+	return 0
+}
+func (code *DocumentMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DocumentModeProducer
+	case 2:
+		*code = DocumentModeConsumer
+	default:
+		return false
+	}
+	return true
+}
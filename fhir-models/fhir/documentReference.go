@@ -14,42 +14,54 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // DocumentReference is documented here http://hl7.org/fhir/StructureDefinition/DocumentReference
 type DocumentReference struct {
-	Id                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	MasterIdentifier  *Identifier                  `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
-	Identifier        []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            DocumentReferenceStatus      `bson:"status" json:"status"`
-	DocStatus         *CompositionStatus           `bson:"docStatus,omitempty" json:"docStatus,omitempty"`
-	Type              *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
-	Category          []CodeableConcept            `bson:"category,omitempty" json:"category,omitempty"`
-	Subject           *Reference                   `bson:"subject,omitempty" json:"subject,omitempty"`
-	Date              *string                      `bson:"date,omitempty" json:"date,omitempty"`
-	Author            []Reference                  `bson:"author,omitempty" json:"author,omitempty"`
-	Authenticator     *Reference                   `bson:"authenticator,omitempty" json:"authenticator,omitempty"`
-	Custodian         *Reference                   `bson:"custodian,omitempty" json:"custodian,omitempty"`
-	RelatesTo         []DocumentReferenceRelatesTo `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
-	Description       *string                      `bson:"description,omitempty" json:"description,omitempty"`
-	SecurityLabel     []CodeableConcept            `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
-	Content           []DocumentReferenceContent   `bson:"content" json:"content"`
-	Context           *DocumentReferenceContext    `bson:"context,omitempty" json:"context,omitempty"`
+	Id                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                     `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	MasterIdentifier     *Identifier                  `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
+	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               DocumentReferenceStatus      `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
+	DocStatus            *CompositionStatus           `bson:"docStatus,omitempty" json:"docStatus,omitempty"`
+	DocStatusElement     *Element                     `bson:"_docStatus,omitempty" json:"_docStatus,omitempty"`
+	Type                 *CodeableConcept             `bson:"type,omitempty" json:"type,omitempty"`
+	Category             []CodeableConcept            `bson:"category,omitempty" json:"category,omitempty"`
+	Subject              *Reference                   `bson:"subject,omitempty" json:"subject,omitempty"`
+	Date                 *string                      `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                     `bson:"_date,omitempty" json:"_date,omitempty"`
+	Author               []Reference                  `bson:"author,omitempty" json:"author,omitempty"`
+	Authenticator        *Reference                   `bson:"authenticator,omitempty" json:"authenticator,omitempty"`
+	Custodian            *Reference                   `bson:"custodian,omitempty" json:"custodian,omitempty"`
+	RelatesTo            []DocumentReferenceRelatesTo `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
+	Description          *string                      `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                     `bson:"_description,omitempty" json:"_description,omitempty"`
+	SecurityLabel        []CodeableConcept            `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
+	Content              []DocumentReferenceContent   `bson:"content" json:"content"`
+	Context              *DocumentReferenceContext    `bson:"context,omitempty" json:"context,omitempty"`
 }
 type DocumentReferenceRelatesTo struct {
 	Id                *string                  `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              DocumentRelationshipType `bson:"code" json:"code"`
+	CodeElement       *Element                 `bson:"_code,omitempty" json:"_code,omitempty"`
 	Target            Reference                `bson:"target" json:"target"`
 }
 type DocumentReferenceContent struct {
@@ -71,23 +83,2780 @@ type DocumentReferenceContext struct {
 	SourcePatientInfo *Reference        `bson:"sourcePatientInfo,omitempty" json:"sourcePatientInfo,omitempty"`
 	Related           []Reference       `bson:"related,omitempty" json:"related,omitempty"`
 }
-type OtherDocumentReference DocumentReference
 
 // MarshalJSON marshals the given DocumentReference as JSON into a byte slice
 func (r DocumentReference) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherDocumentReference
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherDocumentReference: OtherDocumentReference(r),
-		ResourceType:           "DocumentReference",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DocumentReference as JSON object with the members in the order of the fields
+func (r DocumentReference) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"DocumentReference\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MasterIdentifier != nil {
+		e.key("masterIdentifier")
+		r.MasterIdentifier.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.DocStatus != nil {
+		e.key("docStatus")
+		e.string(r.DocStatus.Code())
+	}
+	if r.DocStatusElement != nil {
+		e.key("_docStatus")
+		r.DocStatusElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Authenticator != nil {
+		e.key("authenticator")
+		r.Authenticator.appendJSON(e)
+	}
+	if r.Custodian != nil {
+		e.key("custodian")
+		r.Custodian.appendJSON(e)
+	}
+	if len(r.RelatesTo) > 0 {
+		e.key("relatesTo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatesTo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.SecurityLabel) > 0 {
+		e.key("securityLabel")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SecurityLabel {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("content")
+	if r.Content == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Content {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Context != nil {
+		e.key("context")
+		r.Context.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DocumentReference from JSON. Unknown members are ignored.
+func (r *DocumentReference) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DocumentReference
+func (r *DocumentReference) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "masterIdentifier":
+			if d.null() {
+				r.MasterIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.MasterIdentifier = &v
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "docStatus":
+			if d.null() {
+				r.DocStatus = nil
+			} else {
+				var v CompositionStatus
+				d.unmarshal(&v)
+				r.DocStatus = &v
+			}
+		case "_docStatus":
+			r.DocStatusElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []Reference{}
+			}
+		case "authenticator":
+			if d.null() {
+				r.Authenticator = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Authenticator = &v
+			}
+		case "custodian":
+			if d.null() {
+				r.Custodian = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Custodian = &v
+			}
+		case "relatesTo":
+			a := d.array()
+			r.RelatesTo = nil
+			for a.next() {
+				var v DocumentReferenceRelatesTo
+				v.decodeJSON(d)
+				r.RelatesTo = append(r.RelatesTo, v)
+			}
+			if r.RelatesTo == nil && !a.isNull {
+				r.RelatesTo = []DocumentReferenceRelatesTo{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "securityLabel":
+			a := d.array()
+			r.SecurityLabel = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SecurityLabel = append(r.SecurityLabel, v)
+			}
+			if r.SecurityLabel == nil && !a.isNull {
+				r.SecurityLabel = []CodeableConcept{}
+			}
+		case "content":
+			a := d.array()
+			r.Content = nil
+			for a.next() {
+				var v DocumentReferenceContent
+				v.decodeJSON(d)
+				r.Content = append(r.Content, v)
+			}
+			if r.Content == nil && !a.isNull {
+				r.Content = []DocumentReferenceContent{}
+			}
+		case "context":
+			if d.null() {
+				r.Context = nil
+			} else {
+				var v DocumentReferenceContext
+				v.decodeJSON(d)
+				r.Context = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DocumentReference which shares no memory with the original
+func (r DocumentReference) DeepCopy() DocumentReference {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.MasterIdentifier != nil {
+		v := r.MasterIdentifier.DeepCopy()
+		out.MasterIdentifier = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.DocStatus != nil {
+		v := *r.DocStatus
+		out.DocStatus = &v
+	}
+	if r.DocStatusElement != nil {
+		v := r.DocStatusElement.DeepCopy()
+		out.DocStatusElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Author != nil {
+		out.Author = make([]Reference, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Authenticator != nil {
+		v := r.Authenticator.DeepCopy()
+		out.Authenticator = &v
+	}
+	if r.Custodian != nil {
+		v := r.Custodian.DeepCopy()
+		out.Custodian = &v
+	}
+	if r.RelatesTo != nil {
+		out.RelatesTo = make([]DocumentReferenceRelatesTo, len(r.RelatesTo))
+		for i := range r.RelatesTo {
+			out.RelatesTo[i] = r.RelatesTo[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.SecurityLabel != nil {
+		out.SecurityLabel = make([]CodeableConcept, len(r.SecurityLabel))
+		for i := range r.SecurityLabel {
+			out.SecurityLabel[i] = r.SecurityLabel[i].DeepCopy()
+		}
+	}
+	if r.Content != nil {
+		out.Content = make([]DocumentReferenceContent, len(r.Content))
+		for i := range r.Content {
+			out.Content[i] = r.Content[i].DeepCopy()
+		}
+	}
+	if r.Context != nil {
+		v := r.Context.DeepCopy()
+		out.Context = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DocumentReference) Equal(other DocumentReference) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.MasterIdentifier == nil) != (other.MasterIdentifier == nil) || r.MasterIdentifier != nil && !r.MasterIdentifier.Equal(*other.MasterIdentifier) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.DocStatus == nil) != (other.DocStatus == nil) || r.DocStatus != nil && *r.DocStatus != *other.DocStatus {
+		return false
+	}
+	if (r.DocStatusElement == nil) != (other.DocStatusElement == nil) || r.DocStatusElement != nil && !r.DocStatusElement.Equal(*other.DocStatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if (r.Authenticator == nil) != (other.Authenticator == nil) || r.Authenticator != nil && !r.Authenticator.Equal(*other.Authenticator) {
+		return false
+	}
+	if (r.Custodian == nil) != (other.Custodian == nil) || r.Custodian != nil && !r.Custodian.Equal(*other.Custodian) {
+		return false
+	}
+	if len(r.RelatesTo) != len(other.RelatesTo) {
+		return false
+	}
+	for i := range r.RelatesTo {
+		if !r.RelatesTo[i].Equal(other.RelatesTo[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.SecurityLabel) != len(other.SecurityLabel) {
+		return false
+	}
+	for i := range r.SecurityLabel {
+		if !r.SecurityLabel[i].Equal(other.SecurityLabel[i]) {
+			return false
+		}
+	}
+	if len(r.Content) != len(other.Content) {
+		return false
+	}
+	for i := range r.Content {
+		if !r.Content[i].Equal(other.Content[i]) {
+			return false
+		}
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.Equal(*other.Context) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DocumentReference) EqualsDeep(other DocumentReference) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.MasterIdentifier == nil) != (other.MasterIdentifier == nil) || r.MasterIdentifier != nil && !r.MasterIdentifier.EqualsDeep(*other.MasterIdentifier) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.DocStatus == nil) != (other.DocStatus == nil) || r.DocStatus != nil && *r.DocStatus != *other.DocStatus {
+		return false
+	}
+	if (r.DocStatusElement == nil) != (other.DocStatusElement == nil) || r.DocStatusElement != nil && !r.DocStatusElement.EqualsDeep(*other.DocStatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if (r.Authenticator == nil) != (other.Authenticator == nil) || r.Authenticator != nil && !r.Authenticator.EqualsDeep(*other.Authenticator) {
+		return false
+	}
+	if (r.Custodian == nil) != (other.Custodian == nil) || r.Custodian != nil && !r.Custodian.EqualsDeep(*other.Custodian) {
+		return false
+	}
+	if !equivalentList(len(r.RelatesTo), len(other.RelatesTo), func(i, j int) bool {
+		return r.RelatesTo[i].EqualsDeep(other.RelatesTo[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.SecurityLabel), len(other.SecurityLabel), func(i, j int) bool {
+		return r.SecurityLabel[i].EqualsDeep(other.SecurityLabel[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Content), len(other.Content), func(i, j int) bool {
+		return r.Content[i].EqualsDeep(other.Content[j])
+	}) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.EqualsDeep(*other.Context) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DocumentReference) EqualsShallow(other DocumentReference) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.DocStatus == nil) != (other.DocStatus == nil) || r.DocStatus != nil && *r.DocStatus != *other.DocStatus {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DocumentReference as FHIR XML
+func (r DocumentReference) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "DocumentReference",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "masterIdentifier", r.MasterIdentifier); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "docStatus", r.DocStatus, r.DocStatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "authenticator", r.Authenticator); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "custodian", r.Custodian); err != nil {
+		return err
+	}
+	for _, v := range r.RelatesTo {
+		if err := encodeXMLElement(e, "relatesTo", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.SecurityLabel {
+		if err := encodeXMLElement(e, "securityLabel", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Content {
+		if err := encodeXMLElement(e, "content", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "context", r.Context); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DocumentReference from FHIR XML
+func (r *DocumentReference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "masterIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MasterIdentifier = &v
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v DocumentReferenceStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "docStatus":
+				var v CompositionStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DocStatus = &v
+				}
+				r.DocStatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "authenticator":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Authenticator = &v
+			case "custodian":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Custodian = &v
+			case "relatesTo":
+				var v DocumentReferenceRelatesTo
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatesTo = append(r.RelatesTo, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "securityLabel":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SecurityLabel = append(r.SecurityLabel, v)
+			case "content":
+				var v DocumentReferenceContent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Content = append(r.Content, v)
+			case "context":
+				var v DocumentReferenceContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Context = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DocumentReference as properties to the RDF node n
+func (r DocumentReference) turtle(n *turtleNode) {
+	n.resource("DocumentReference", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("DocumentReference.masterIdentifier", -1, r.MasterIdentifier)
+	for i, v := range r.Identifier {
+		n.element("DocumentReference.identifier", i, v)
+	}
+	n.primitive("DocumentReference.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("DocumentReference.docStatus", -1, r.DocStatus, r.DocStatusElement, "code")
+	n.element("DocumentReference.type", -1, r.Type)
+	for i, v := range r.Category {
+		n.element("DocumentReference.category", i, v)
+	}
+	n.element("DocumentReference.subject", -1, r.Subject)
+	n.primitive("DocumentReference.date", -1, r.Date, r.DateElement, "instant")
+	for i, v := range r.Author {
+		n.element("DocumentReference.author", i, v)
+	}
+	n.element("DocumentReference.authenticator", -1, r.Authenticator)
+	n.element("DocumentReference.custodian", -1, r.Custodian)
+	for i, v := range r.RelatesTo {
+		n.element("DocumentReference.relatesTo", i, v)
+	}
+	n.primitive("DocumentReference.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.SecurityLabel {
+		n.element("DocumentReference.securityLabel", i, v)
+	}
+	for i, v := range r.Content {
+		n.element("DocumentReference.content", i, v)
+	}
+	n.element("DocumentReference.context", -1, r.Context)
+}
+
+// MarshalBSON marshals the given DocumentReference as BSON document with the structure of its FHIR JSON
+func (r DocumentReference) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DocumentReference from a BSON document with the structure of its FHIR JSON
+func (r *DocumentReference) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DocumentReference as protobuf message DocumentReference of fhir.proto
+func (r DocumentReference) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DocumentReference to the encoded message
+func (r DocumentReference) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.MasterIdentifier != nil {
+		e.message(9, *r.MasterIdentifier)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	e.varint(11, r.Status.protoNumber())
+	if r.DocStatus != nil {
+		e.varint(12, r.DocStatus.protoNumber())
+	}
+	if r.Type != nil {
+		e.message(13, *r.Type)
+	}
+	for _, v := range r.Category {
+		e.message(14, v)
+	}
+	if r.Subject != nil {
+		e.message(15, *r.Subject)
+	}
+	if r.Date != nil {
+		e.string(16, *r.Date)
+	}
+	for _, v := range r.Author {
+		e.message(17, v)
+	}
+	if r.Authenticator != nil {
+		e.message(18, *r.Authenticator)
+	}
+	if r.Custodian != nil {
+		e.message(19, *r.Custodian)
+	}
+	for _, v := range r.RelatesTo {
+		e.message(20, v)
+	}
+	if r.Description != nil {
+		e.string(21, *r.Description)
+	}
+	for _, v := range r.SecurityLabel {
+		e.message(22, v)
+	}
+	for _, v := range r.Content {
+		e.message(23, v)
+	}
+	if r.Context != nil {
+		e.message(24, *r.Context)
+	}
+	if r.IdElement != nil {
+		e.message(25, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(26, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(27, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(28, *r.StatusElement)
+	}
+	if r.DocStatusElement != nil {
+		e.message(29, *r.DocStatusElement)
+	}
+	if r.DateElement != nil {
+		e.message(30, *r.DateElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(31, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DocumentReference from protobuf message DocumentReference of fhir.proto
+func (r *DocumentReference) UnmarshalProto(b []byte) error {
+	*r = DocumentReference{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.MasterIdentifier = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			var v DocumentReferenceStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 12:
+			var v CompositionStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.DocStatus = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 16:
+			v := d.string()
+			r.Date = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Authenticator = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Custodian = &v
+		case 20:
+			var v DocumentReferenceRelatesTo
+			d.message(&v)
+			r.RelatesTo = append(r.RelatesTo, v)
+		case 21:
+			v := d.string()
+			r.Description = &v
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.SecurityLabel = append(r.SecurityLabel, v)
+		case 23:
+			var v DocumentReferenceContent
+			d.message(&v)
+			r.Content = append(r.Content, v)
+		case 24:
+			var v DocumentReferenceContext
+			d.message(&v)
+			r.Context = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.DocStatusElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DocumentReferenceRelatesTo as JSON into a byte slice
+func (r DocumentReferenceRelatesTo) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DocumentReferenceRelatesTo as JSON object with the members in the order of the fields
+func (r DocumentReferenceRelatesTo) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	e.key("target")
+	r.Target.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DocumentReferenceRelatesTo from JSON. Unknown members are ignored.
+func (r *DocumentReferenceRelatesTo) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DocumentReferenceRelatesTo
+func (r *DocumentReferenceRelatesTo) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "target":
+			r.Target.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DocumentReferenceRelatesTo which shares no memory with the original
+func (r DocumentReferenceRelatesTo) DeepCopy() DocumentReferenceRelatesTo {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	out.Target = r.Target.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DocumentReferenceRelatesTo) Equal(other DocumentReferenceRelatesTo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if !r.Target.Equal(other.Target) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DocumentReferenceRelatesTo) EqualsDeep(other DocumentReferenceRelatesTo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if !r.Target.EqualsDeep(other.Target) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DocumentReferenceRelatesTo) EqualsShallow(other DocumentReferenceRelatesTo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DocumentReferenceRelatesTo as FHIR XML
+func (r DocumentReferenceRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "target", r.Target); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DocumentReferenceRelatesTo from FHIR XML
+func (r *DocumentReferenceRelatesTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v DocumentRelationshipType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "target":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DocumentReferenceRelatesTo as properties to the RDF node n
+func (r DocumentReferenceRelatesTo) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("DocumentReference.relatesTo.code", -1, r.Code, r.CodeElement, "code")
+	n.element("DocumentReference.relatesTo.target", -1, r.Target)
+}
+
+// MarshalBSON marshals the given DocumentReferenceRelatesTo as BSON document with the structure of its FHIR JSON
+func (r DocumentReferenceRelatesTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DocumentReferenceRelatesTo from a BSON document with the structure of its FHIR JSON
+func (r *DocumentReferenceRelatesTo) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DocumentReferenceRelatesTo as protobuf message DocumentReferenceRelatesTo of fhir.proto
+func (r DocumentReferenceRelatesTo) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DocumentReferenceRelatesTo to the encoded message
+func (r DocumentReferenceRelatesTo) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Code.protoNumber())
+	e.message(5, r.Target)
+	if r.CodeElement != nil {
+		e.message(6, *r.CodeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given DocumentReferenceRelatesTo from protobuf message DocumentReferenceRelatesTo of fhir.proto
+func (r *DocumentReferenceRelatesTo) UnmarshalProto(b []byte) error {
+	*r = DocumentReferenceRelatesTo{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v DocumentRelationshipType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Target = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DocumentReferenceContent as JSON into a byte slice
+func (r DocumentReferenceContent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DocumentReferenceContent as JSON object with the members in the order of the fields
+func (r DocumentReferenceContent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("attachment")
+	r.Attachment.appendJSON(e)
+	if r.Format != nil {
+		e.key("format")
+		r.Format.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DocumentReferenceContent from JSON. Unknown members are ignored.
+func (r *DocumentReferenceContent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DocumentReferenceContent
+func (r *DocumentReferenceContent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "attachment":
+			r.Attachment.decodeJSON(d)
+		case "format":
+			if d.null() {
+				r.Format = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Format = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DocumentReferenceContent which shares no memory with the original
+func (r DocumentReferenceContent) DeepCopy() DocumentReferenceContent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Attachment = r.Attachment.DeepCopy()
+	if r.Format != nil {
+		v := r.Format.DeepCopy()
+		out.Format = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DocumentReferenceContent) Equal(other DocumentReferenceContent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Attachment.Equal(other.Attachment) {
+		return false
+	}
+	if (r.Format == nil) != (other.Format == nil) || r.Format != nil && !r.Format.Equal(*other.Format) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DocumentReferenceContent) EqualsDeep(other DocumentReferenceContent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Attachment.EqualsDeep(other.Attachment) {
+		return false
+	}
+	if (r.Format == nil) != (other.Format == nil) || r.Format != nil && !r.Format.EqualsDeep(*other.Format) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DocumentReferenceContent) EqualsShallow(other DocumentReferenceContent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DocumentReferenceContent as FHIR XML
+func (r DocumentReferenceContent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "attachment", r.Attachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "format", r.Format); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DocumentReferenceContent from FHIR XML
+func (r *DocumentReferenceContent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "attachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Attachment = v
+			case "format":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Format = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DocumentReferenceContent as properties to the RDF node n
+func (r DocumentReferenceContent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("DocumentReference.content.attachment", -1, r.Attachment)
+	n.element("DocumentReference.content.format", -1, r.Format)
+}
+
+// MarshalBSON marshals the given DocumentReferenceContent as BSON document with the structure of its FHIR JSON
+func (r DocumentReferenceContent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DocumentReferenceContent from a BSON document with the structure of its FHIR JSON
+func (r *DocumentReferenceContent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DocumentReferenceContent as protobuf message DocumentReferenceContent of fhir.proto
+func (r DocumentReferenceContent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DocumentReferenceContent to the encoded message
+func (r DocumentReferenceContent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Attachment)
+	if r.Format != nil {
+		e.message(5, *r.Format)
+	}
+}
+
+// UnmarshalProto unmarshals the given DocumentReferenceContent from protobuf message DocumentReferenceContent of fhir.proto
+func (r *DocumentReferenceContent) UnmarshalProto(b []byte) error {
+	*r = DocumentReferenceContent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Attachment
+			d.message(&v)
+			r.Attachment = v
+		case 5:
+			var v Coding
+			d.message(&v)
+			r.Format = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given DocumentReferenceContext as JSON into a byte slice
+func (r DocumentReferenceContext) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the DocumentReferenceContext as JSON object with the members in the order of the fields
+func (r DocumentReferenceContext) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Encounter) > 0 {
+		e.key("encounter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Encounter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Event) > 0 {
+		e.key("event")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Event {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.FacilityType != nil {
+		e.key("facilityType")
+		r.FacilityType.appendJSON(e)
+	}
+	if r.PracticeSetting != nil {
+		e.key("practiceSetting")
+		r.PracticeSetting.appendJSON(e)
+	}
+	if r.SourcePatientInfo != nil {
+		e.key("sourcePatientInfo")
+		r.SourcePatientInfo.appendJSON(e)
+	}
+	if len(r.Related) > 0 {
+		e.key("related")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Related {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given DocumentReferenceContext from JSON. Unknown members are ignored.
+func (r *DocumentReferenceContext) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the DocumentReferenceContext
+func (r *DocumentReferenceContext) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "encounter":
+			a := d.array()
+			r.Encounter = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = append(r.Encounter, v)
+			}
+			if r.Encounter == nil && !a.isNull {
+				r.Encounter = []Reference{}
+			}
+		case "event":
+			a := d.array()
+			r.Event = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Event = append(r.Event, v)
+			}
+			if r.Event == nil && !a.isNull {
+				r.Event = []CodeableConcept{}
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "facilityType":
+			if d.null() {
+				r.FacilityType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FacilityType = &v
+			}
+		case "practiceSetting":
+			if d.null() {
+				r.PracticeSetting = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PracticeSetting = &v
+			}
+		case "sourcePatientInfo":
+			if d.null() {
+				r.SourcePatientInfo = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SourcePatientInfo = &v
+			}
+		case "related":
+			a := d.array()
+			r.Related = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Related = append(r.Related, v)
+			}
+			if r.Related == nil && !a.isNull {
+				r.Related = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the DocumentReferenceContext which shares no memory with the original
+func (r DocumentReferenceContext) DeepCopy() DocumentReferenceContext {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Encounter != nil {
+		out.Encounter = make([]Reference, len(r.Encounter))
+		for i := range r.Encounter {
+			out.Encounter[i] = r.Encounter[i].DeepCopy()
+		}
+	}
+	if r.Event != nil {
+		out.Event = make([]CodeableConcept, len(r.Event))
+		for i := range r.Event {
+			out.Event[i] = r.Event[i].DeepCopy()
+		}
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.FacilityType != nil {
+		v := r.FacilityType.DeepCopy()
+		out.FacilityType = &v
+	}
+	if r.PracticeSetting != nil {
+		v := r.PracticeSetting.DeepCopy()
+		out.PracticeSetting = &v
+	}
+	if r.SourcePatientInfo != nil {
+		v := r.SourcePatientInfo.DeepCopy()
+		out.SourcePatientInfo = &v
+	}
+	if r.Related != nil {
+		out.Related = make([]Reference, len(r.Related))
+		for i := range r.Related {
+			out.Related[i] = r.Related[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r DocumentReferenceContext) Equal(other DocumentReferenceContext) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Encounter) != len(other.Encounter) {
+		return false
+	}
+	for i := range r.Encounter {
+		if !r.Encounter[i].Equal(other.Encounter[i]) {
+			return false
+		}
+	}
+	if len(r.Event) != len(other.Event) {
+		return false
+	}
+	for i := range r.Event {
+		if !r.Event[i].Equal(other.Event[i]) {
+			return false
+		}
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.FacilityType == nil) != (other.FacilityType == nil) || r.FacilityType != nil && !r.FacilityType.Equal(*other.FacilityType) {
+		return false
+	}
+	if (r.PracticeSetting == nil) != (other.PracticeSetting == nil) || r.PracticeSetting != nil && !r.PracticeSetting.Equal(*other.PracticeSetting) {
+		return false
+	}
+	if (r.SourcePatientInfo == nil) != (other.SourcePatientInfo == nil) || r.SourcePatientInfo != nil && !r.SourcePatientInfo.Equal(*other.SourcePatientInfo) {
+		return false
+	}
+	if len(r.Related) != len(other.Related) {
+		return false
+	}
+	for i := range r.Related {
+		if !r.Related[i].Equal(other.Related[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r DocumentReferenceContext) EqualsDeep(other DocumentReferenceContext) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Encounter), len(other.Encounter), func(i, j int) bool {
+		return r.Encounter[i].EqualsDeep(other.Encounter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Event), len(other.Event), func(i, j int) bool {
+		return r.Event[i].EqualsDeep(other.Event[j])
+	}) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.FacilityType == nil) != (other.FacilityType == nil) || r.FacilityType != nil && !r.FacilityType.EqualsDeep(*other.FacilityType) {
+		return false
+	}
+	if (r.PracticeSetting == nil) != (other.PracticeSetting == nil) || r.PracticeSetting != nil && !r.PracticeSetting.EqualsDeep(*other.PracticeSetting) {
+		return false
+	}
+	if (r.SourcePatientInfo == nil) != (other.SourcePatientInfo == nil) || r.SourcePatientInfo != nil && !r.SourcePatientInfo.EqualsDeep(*other.SourcePatientInfo) {
+		return false
+	}
+	if !equivalentList(len(r.Related), len(other.Related), func(i, j int) bool {
+		return r.Related[i].EqualsDeep(other.Related[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r DocumentReferenceContext) EqualsShallow(other DocumentReferenceContext) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given DocumentReferenceContext as FHIR XML
+func (r DocumentReferenceContext) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Encounter {
+		if err := encodeXMLElement(e, "encounter", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Event {
+		if err := encodeXMLElement(e, "event", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "facilityType", r.FacilityType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "practiceSetting", r.PracticeSetting); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sourcePatientInfo", r.SourcePatientInfo); err != nil {
+		return err
+	}
+	for _, v := range r.Related {
+		if err := encodeXMLElement(e, "related", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given DocumentReferenceContext from FHIR XML
+func (r *DocumentReferenceContext) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = append(r.Encounter, v)
+			case "event":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Event = append(r.Event, v)
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "facilityType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FacilityType = &v
+			case "practiceSetting":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PracticeSetting = &v
+			case "sourcePatientInfo":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SourcePatientInfo = &v
+			case "related":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Related = append(r.Related, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the DocumentReferenceContext as properties to the RDF node n
+func (r DocumentReferenceContext) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Encounter {
+		n.element("DocumentReference.context.encounter", i, v)
+	}
+	for i, v := range r.Event {
+		n.element("DocumentReference.context.event", i, v)
+	}
+	n.element("DocumentReference.context.period", -1, r.Period)
+	n.element("DocumentReference.context.facilityType", -1, r.FacilityType)
+	n.element("DocumentReference.context.practiceSetting", -1, r.PracticeSetting)
+	n.element("DocumentReference.context.sourcePatientInfo", -1, r.SourcePatientInfo)
+	for i, v := range r.Related {
+		n.element("DocumentReference.context.related", i, v)
+	}
+}
+
+// MarshalBSON marshals the given DocumentReferenceContext as BSON document with the structure of its FHIR JSON
+func (r DocumentReferenceContext) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given DocumentReferenceContext from a BSON document with the structure of its FHIR JSON
+func (r *DocumentReferenceContext) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given DocumentReferenceContext as protobuf message DocumentReferenceContext of fhir.proto
+func (r DocumentReferenceContext) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the DocumentReferenceContext to the encoded message
+func (r DocumentReferenceContext) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Encounter {
+		e.message(4, v)
+	}
+	for _, v := range r.Event {
+		e.message(5, v)
+	}
+	if r.Period != nil {
+		e.message(6, *r.Period)
+	}
+	if r.FacilityType != nil {
+		e.message(7, *r.FacilityType)
+	}
+	if r.PracticeSetting != nil {
+		e.message(8, *r.PracticeSetting)
+	}
+	if r.SourcePatientInfo != nil {
+		e.message(9, *r.SourcePatientInfo)
+	}
+	for _, v := range r.Related {
+		e.message(10, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given DocumentReferenceContext from protobuf message DocumentReferenceContext of fhir.proto
+func (r *DocumentReferenceContext) UnmarshalProto(b []byte) error {
+	*r = DocumentReferenceContext{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Encounter = append(r.Encounter, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Event = append(r.Event, v)
+		case 6:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.FacilityType = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.PracticeSetting = &v
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.SourcePatientInfo = &v
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Related = append(r.Related, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalDocumentReference unmarshals a DocumentReference.
 func UnmarshalDocumentReference(b []byte) (DocumentReference, error) {
 	var documentReference DocumentReference
-	if err := json.Unmarshal(b, &documentReference); err != nil {
+	if err := documentReference.UnmarshalJSON(b); err != nil {
 		return documentReference, err
 	}
 	return documentReference, nil
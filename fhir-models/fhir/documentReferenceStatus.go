@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code DocumentReferenceStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DocumentReferenceStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DocumentReferenceStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "current":
 		*code = DocumentReferenceStatusCurrent
@@ -49,6 +53,12 @@ func (code *DocumentReferenceStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DocumentReferenceStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DocumentReferenceStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DocumentReferenceStatus) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code DocumentReferenceStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DocumentReferenceStatus) protoNumber() uint64 {
+	switch code {
+	case DocumentReferenceStatusCurrent:
+		return 1
+	case DocumentReferenceStatusSuperseded:
+		return 2
+	case DocumentReferenceStatusEnteredInError:
+		return 3
+	}
+	return 0
+}
+func (code *DocumentReferenceStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DocumentReferenceStatusCurrent
+	case 2:
+		*code = DocumentReferenceStatusSuperseded
+	case 3:
+		*code = DocumentReferenceStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code DocumentRelationshipType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *DocumentRelationshipType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *DocumentRelationshipType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "replaces":
 		*code = DocumentRelationshipTypeReplaces
@@ -52,6 +56,12 @@ func (code *DocumentRelationshipType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code DocumentRelationshipType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *DocumentRelationshipType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code DocumentRelationshipType) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code DocumentRelationshipType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code DocumentRelationshipType) protoNumber() uint64 {
+	switch code {
+	case DocumentRelationshipTypeReplaces:
+		return 1
+	case DocumentRelationshipTypeTransforms:
+		return 2
+	case DocumentRelationshipTypeSigns:
+		return 3
+	case DocumentRelationshipTypeAppends:
+		return 4
+	}
+	return 0
+}
+func (code *DocumentRelationshipType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = DocumentRelationshipTypeReplaces
+	case 2:
+		*code = DocumentRelationshipTypeTransforms
+	case 3:
+		*code = DocumentRelationshipTypeSigns
+	case 4:
+		*code = DocumentRelationshipTypeAppends
+	default:
+		return false
+	}
+	return true
+}
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -25,41 +28,73 @@ type ElementDefinition struct {
 	Extension                       []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension               []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Path                            string                        `bson:"path" json:"path"`
+	PathElement                     *Element                      `bson:"_path,omitempty" json:"_path,omitempty"`
 	Representation                  []PropertyRepresentation      `bson:"representation,omitempty" json:"representation,omitempty"`
+	RepresentationElement           []*Element                    `bson:"_representation,omitempty" json:"_representation,omitempty"`
 	SliceName                       *string                       `bson:"sliceName,omitempty" json:"sliceName,omitempty"`
+	SliceNameElement                *Element                      `bson:"_sliceName,omitempty" json:"_sliceName,omitempty"`
 	SliceIsConstraining             *bool                         `bson:"sliceIsConstraining,omitempty" json:"sliceIsConstraining,omitempty"`
+	SliceIsConstrainingElement      *Element                      `bson:"_sliceIsConstraining,omitempty" json:"_sliceIsConstraining,omitempty"`
 	Label                           *string                       `bson:"label,omitempty" json:"label,omitempty"`
+	LabelElement                    *Element                      `bson:"_label,omitempty" json:"_label,omitempty"`
 	Code                            []Coding                      `bson:"code,omitempty" json:"code,omitempty"`
 	Slicing                         *ElementDefinitionSlicing     `bson:"slicing,omitempty" json:"slicing,omitempty"`
 	Short                           *string                       `bson:"short,omitempty" json:"short,omitempty"`
+	ShortElement                    *Element                      `bson:"_short,omitempty" json:"_short,omitempty"`
 	Definition                      *string                       `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement               *Element                      `bson:"_definition,omitempty" json:"_definition,omitempty"`
 	Comment                         *string                       `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement                  *Element                      `bson:"_comment,omitempty" json:"_comment,omitempty"`
 	Requirements                    *string                       `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	RequirementsElement             *Element                      `bson:"_requirements,omitempty" json:"_requirements,omitempty"`
 	Alias                           []string                      `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement                    []*Element                    `bson:"_alias,omitempty" json:"_alias,omitempty"`
 	Min                             *int                          `bson:"min,omitempty" json:"min,omitempty"`
+	MinElement                      *Element                      `bson:"_min,omitempty" json:"_min,omitempty"`
 	Max                             *string                       `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement                      *Element                      `bson:"_max,omitempty" json:"_max,omitempty"`
 	Base                            *ElementDefinitionBase        `bson:"base,omitempty" json:"base,omitempty"`
 	ContentReference                *string                       `bson:"contentReference,omitempty" json:"contentReference,omitempty"`
+	ContentReferenceElement         *Element                      `bson:"_contentReference,omitempty" json:"_contentReference,omitempty"`
 	Type                            []ElementDefinitionType       `bson:"type,omitempty" json:"type,omitempty"`
 	DefaultValueBase64Binary        *string                       `bson:"defaultValueBase64Binary,omitempty" json:"defaultValueBase64Binary,omitempty"`
+	DefaultValueBase64BinaryElement *Element                      `bson:"_defaultValueBase64Binary,omitempty" json:"_defaultValueBase64Binary,omitempty"`
 	DefaultValueBoolean             *bool                         `bson:"defaultValueBoolean,omitempty" json:"defaultValueBoolean,omitempty"`
+	DefaultValueBooleanElement      *Element                      `bson:"_defaultValueBoolean,omitempty" json:"_defaultValueBoolean,omitempty"`
 	DefaultValueCanonical           *string                       `bson:"defaultValueCanonical,omitempty" json:"defaultValueCanonical,omitempty"`
+	DefaultValueCanonicalElement    *Element                      `bson:"_defaultValueCanonical,omitempty" json:"_defaultValueCanonical,omitempty"`
 	DefaultValueCode                *string                       `bson:"defaultValueCode,omitempty" json:"defaultValueCode,omitempty"`
+	DefaultValueCodeElement         *Element                      `bson:"_defaultValueCode,omitempty" json:"_defaultValueCode,omitempty"`
 	DefaultValueDate                *string                       `bson:"defaultValueDate,omitempty" json:"defaultValueDate,omitempty"`
+	DefaultValueDateElement         *Element                      `bson:"_defaultValueDate,omitempty" json:"_defaultValueDate,omitempty"`
 	DefaultValueDateTime            *string                       `bson:"defaultValueDateTime,omitempty" json:"defaultValueDateTime,omitempty"`
+	DefaultValueDateTimeElement     *Element                      `bson:"_defaultValueDateTime,omitempty" json:"_defaultValueDateTime,omitempty"`
 	DefaultValueDecimal             *json.Number                  `bson:"defaultValueDecimal,omitempty" json:"defaultValueDecimal,omitempty"`
+	DefaultValueDecimalElement      *Element                      `bson:"_defaultValueDecimal,omitempty" json:"_defaultValueDecimal,omitempty"`
 	DefaultValueId                  *string                       `bson:"defaultValueId,omitempty" json:"defaultValueId,omitempty"`
+	DefaultValueIdElement           *Element                      `bson:"_defaultValueId,omitempty" json:"_defaultValueId,omitempty"`
 	DefaultValueInstant             *string                       `bson:"defaultValueInstant,omitempty" json:"defaultValueInstant,omitempty"`
+	DefaultValueInstantElement      *Element                      `bson:"_defaultValueInstant,omitempty" json:"_defaultValueInstant,omitempty"`
 	DefaultValueInteger             *int                          `bson:"defaultValueInteger,omitempty" json:"defaultValueInteger,omitempty"`
+	DefaultValueIntegerElement      *Element                      `bson:"_defaultValueInteger,omitempty" json:"_defaultValueInteger,omitempty"`
 	DefaultValueMarkdown            *string                       `bson:"defaultValueMarkdown,omitempty" json:"defaultValueMarkdown,omitempty"`
+	DefaultValueMarkdownElement     *Element                      `bson:"_defaultValueMarkdown,omitempty" json:"_defaultValueMarkdown,omitempty"`
 	DefaultValueOid                 *string                       `bson:"defaultValueOid,omitempty" json:"defaultValueOid,omitempty"`
+	DefaultValueOidElement          *Element                      `bson:"_defaultValueOid,omitempty" json:"_defaultValueOid,omitempty"`
 	DefaultValuePositiveInt         *int                          `bson:"defaultValuePositiveInt,omitempty" json:"defaultValuePositiveInt,omitempty"`
+	DefaultValuePositiveIntElement  *Element                      `bson:"_defaultValuePositiveInt,omitempty" json:"_defaultValuePositiveInt,omitempty"`
 	DefaultValueString              *string                       `bson:"defaultValueString,omitempty" json:"defaultValueString,omitempty"`
+	DefaultValueStringElement       *Element                      `bson:"_defaultValueString,omitempty" json:"_defaultValueString,omitempty"`
 	DefaultValueTime                *string                       `bson:"defaultValueTime,omitempty" json:"defaultValueTime,omitempty"`
+	DefaultValueTimeElement         *Element                      `bson:"_defaultValueTime,omitempty" json:"_defaultValueTime,omitempty"`
 	DefaultValueUnsignedInt         *int                          `bson:"defaultValueUnsignedInt,omitempty" json:"defaultValueUnsignedInt,omitempty"`
+	DefaultValueUnsignedIntElement  *Element                      `bson:"_defaultValueUnsignedInt,omitempty" json:"_defaultValueUnsignedInt,omitempty"`
 	DefaultValueUri                 *string                       `bson:"defaultValueUri,omitempty" json:"defaultValueUri,omitempty"`
+	DefaultValueUriElement          *Element                      `bson:"_defaultValueUri,omitempty" json:"_defaultValueUri,omitempty"`
 	DefaultValueUrl                 *string                       `bson:"defaultValueUrl,omitempty" json:"defaultValueUrl,omitempty"`
+	DefaultValueUrlElement          *Element                      `bson:"_defaultValueUrl,omitempty" json:"_defaultValueUrl,omitempty"`
 	DefaultValueUuid                *string                       `bson:"defaultValueUuid,omitempty" json:"defaultValueUuid,omitempty"`
+	DefaultValueUuidElement         *Element                      `bson:"_defaultValueUuid,omitempty" json:"_defaultValueUuid,omitempty"`
 	DefaultValueAddress             *Address                      `bson:"defaultValueAddress,omitempty" json:"defaultValueAddress,omitempty"`
 	DefaultValueAge                 *Age                          `bson:"defaultValueAge,omitempty" json:"defaultValueAge,omitempty"`
 	DefaultValueAnnotation          *Annotation                   `bson:"defaultValueAnnotation,omitempty" json:"defaultValueAnnotation,omitempty"`
@@ -92,26 +127,47 @@ type ElementDefinition struct {
 	DefaultValueDosage              *Dosage                       `bson:"defaultValueDosage,omitempty" json:"defaultValueDosage,omitempty"`
 	DefaultValueMeta                *Meta                         `bson:"defaultValueMeta,omitempty" json:"defaultValueMeta,omitempty"`
 	MeaningWhenMissing              *string                       `bson:"meaningWhenMissing,omitempty" json:"meaningWhenMissing,omitempty"`
+	MeaningWhenMissingElement       *Element                      `bson:"_meaningWhenMissing,omitempty" json:"_meaningWhenMissing,omitempty"`
 	OrderMeaning                    *string                       `bson:"orderMeaning,omitempty" json:"orderMeaning,omitempty"`
+	OrderMeaningElement             *Element                      `bson:"_orderMeaning,omitempty" json:"_orderMeaning,omitempty"`
 	FixedBase64Binary               *string                       `bson:"fixedBase64Binary,omitempty" json:"fixedBase64Binary,omitempty"`
+	FixedBase64BinaryElement        *Element                      `bson:"_fixedBase64Binary,omitempty" json:"_fixedBase64Binary,omitempty"`
 	FixedBoolean                    *bool                         `bson:"fixedBoolean,omitempty" json:"fixedBoolean,omitempty"`
+	FixedBooleanElement             *Element                      `bson:"_fixedBoolean,omitempty" json:"_fixedBoolean,omitempty"`
 	FixedCanonical                  *string                       `bson:"fixedCanonical,omitempty" json:"fixedCanonical,omitempty"`
+	FixedCanonicalElement           *Element                      `bson:"_fixedCanonical,omitempty" json:"_fixedCanonical,omitempty"`
 	FixedCode                       *string                       `bson:"fixedCode,omitempty" json:"fixedCode,omitempty"`
+	FixedCodeElement                *Element                      `bson:"_fixedCode,omitempty" json:"_fixedCode,omitempty"`
 	FixedDate                       *string                       `bson:"fixedDate,omitempty" json:"fixedDate,omitempty"`
+	FixedDateElement                *Element                      `bson:"_fixedDate,omitempty" json:"_fixedDate,omitempty"`
 	FixedDateTime                   *string                       `bson:"fixedDateTime,omitempty" json:"fixedDateTime,omitempty"`
+	FixedDateTimeElement            *Element                      `bson:"_fixedDateTime,omitempty" json:"_fixedDateTime,omitempty"`
 	FixedDecimal                    *json.Number                  `bson:"fixedDecimal,omitempty" json:"fixedDecimal,omitempty"`
+	FixedDecimalElement             *Element                      `bson:"_fixedDecimal,omitempty" json:"_fixedDecimal,omitempty"`
 	FixedId                         *string                       `bson:"fixedId,omitempty" json:"fixedId,omitempty"`
+	FixedIdElement                  *Element                      `bson:"_fixedId,omitempty" json:"_fixedId,omitempty"`
 	FixedInstant                    *string                       `bson:"fixedInstant,omitempty" json:"fixedInstant,omitempty"`
+	FixedInstantElement             *Element                      `bson:"_fixedInstant,omitempty" json:"_fixedInstant,omitempty"`
 	FixedInteger                    *int                          `bson:"fixedInteger,omitempty" json:"fixedInteger,omitempty"`
+	FixedIntegerElement             *Element                      `bson:"_fixedInteger,omitempty" json:"_fixedInteger,omitempty"`
 	FixedMarkdown                   *string                       `bson:"fixedMarkdown,omitempty" json:"fixedMarkdown,omitempty"`
+	FixedMarkdownElement            *Element                      `bson:"_fixedMarkdown,omitempty" json:"_fixedMarkdown,omitempty"`
 	FixedOid                        *string                       `bson:"fixedOid,omitempty" json:"fixedOid,omitempty"`
+	FixedOidElement                 *Element                      `bson:"_fixedOid,omitempty" json:"_fixedOid,omitempty"`
 	FixedPositiveInt                *int                          `bson:"fixedPositiveInt,omitempty" json:"fixedPositiveInt,omitempty"`
+	FixedPositiveIntElement         *Element                      `bson:"_fixedPositiveInt,omitempty" json:"_fixedPositiveInt,omitempty"`
 	FixedString                     *string                       `bson:"fixedString,omitempty" json:"fixedString,omitempty"`
+	FixedStringElement              *Element                      `bson:"_fixedString,omitempty" json:"_fixedString,omitempty"`
 	FixedTime                       *string                       `bson:"fixedTime,omitempty" json:"fixedTime,omitempty"`
+	FixedTimeElement                *Element                      `bson:"_fixedTime,omitempty" json:"_fixedTime,omitempty"`
 	FixedUnsignedInt                *int                          `bson:"fixedUnsignedInt,omitempty" json:"fixedUnsignedInt,omitempty"`
+	FixedUnsignedIntElement         *Element                      `bson:"_fixedUnsignedInt,omitempty" json:"_fixedUnsignedInt,omitempty"`
 	FixedUri                        *string                       `bson:"fixedUri,omitempty" json:"fixedUri,omitempty"`
+	FixedUriElement                 *Element                      `bson:"_fixedUri,omitempty" json:"_fixedUri,omitempty"`
 	FixedUrl                        *string                       `bson:"fixedUrl,omitempty" json:"fixedUrl,omitempty"`
+	FixedUrlElement                 *Element                      `bson:"_fixedUrl,omitempty" json:"_fixedUrl,omitempty"`
 	FixedUuid                       *string                       `bson:"fixedUuid,omitempty" json:"fixedUuid,omitempty"`
+	FixedUuidElement                *Element                      `bson:"_fixedUuid,omitempty" json:"_fixedUuid,omitempty"`
 	FixedAddress                    *Address                      `bson:"fixedAddress,omitempty" json:"fixedAddress,omitempty"`
 	FixedAge                        *Age                          `bson:"fixedAge,omitempty" json:"fixedAge,omitempty"`
 	FixedAnnotation                 *Annotation                   `bson:"fixedAnnotation,omitempty" json:"fixedAnnotation,omitempty"`
@@ -144,24 +200,43 @@ type ElementDefinition struct {
 	FixedDosage                     *Dosage                       `bson:"fixedDosage,omitempty" json:"fixedDosage,omitempty"`
 	FixedMeta                       *Meta                         `bson:"fixedMeta,omitempty" json:"fixedMeta,omitempty"`
 	PatternBase64Binary             *string                       `bson:"patternBase64Binary,omitempty" json:"patternBase64Binary,omitempty"`
+	PatternBase64BinaryElement      *Element                      `bson:"_patternBase64Binary,omitempty" json:"_patternBase64Binary,omitempty"`
 	PatternBoolean                  *bool                         `bson:"patternBoolean,omitempty" json:"patternBoolean,omitempty"`
+	PatternBooleanElement           *Element                      `bson:"_patternBoolean,omitempty" json:"_patternBoolean,omitempty"`
 	PatternCanonical                *string                       `bson:"patternCanonical,omitempty" json:"patternCanonical,omitempty"`
+	PatternCanonicalElement         *Element                      `bson:"_patternCanonical,omitempty" json:"_patternCanonical,omitempty"`
 	PatternCode                     *string                       `bson:"patternCode,omitempty" json:"patternCode,omitempty"`
+	PatternCodeElement              *Element                      `bson:"_patternCode,omitempty" json:"_patternCode,omitempty"`
 	PatternDate                     *string                       `bson:"patternDate,omitempty" json:"patternDate,omitempty"`
+	PatternDateElement              *Element                      `bson:"_patternDate,omitempty" json:"_patternDate,omitempty"`
 	PatternDateTime                 *string                       `bson:"patternDateTime,omitempty" json:"patternDateTime,omitempty"`
+	PatternDateTimeElement          *Element                      `bson:"_patternDateTime,omitempty" json:"_patternDateTime,omitempty"`
 	PatternDecimal                  *json.Number                  `bson:"patternDecimal,omitempty" json:"patternDecimal,omitempty"`
+	PatternDecimalElement           *Element                      `bson:"_patternDecimal,omitempty" json:"_patternDecimal,omitempty"`
 	PatternId                       *string                       `bson:"patternId,omitempty" json:"patternId,omitempty"`
+	PatternIdElement                *Element                      `bson:"_patternId,omitempty" json:"_patternId,omitempty"`
 	PatternInstant                  *string                       `bson:"patternInstant,omitempty" json:"patternInstant,omitempty"`
+	PatternInstantElement           *Element                      `bson:"_patternInstant,omitempty" json:"_patternInstant,omitempty"`
 	PatternInteger                  *int                          `bson:"patternInteger,omitempty" json:"patternInteger,omitempty"`
+	PatternIntegerElement           *Element                      `bson:"_patternInteger,omitempty" json:"_patternInteger,omitempty"`
 	PatternMarkdown                 *string                       `bson:"patternMarkdown,omitempty" json:"patternMarkdown,omitempty"`
+	PatternMarkdownElement          *Element                      `bson:"_patternMarkdown,omitempty" json:"_patternMarkdown,omitempty"`
 	PatternOid                      *string                       `bson:"patternOid,omitempty" json:"patternOid,omitempty"`
+	PatternOidElement               *Element                      `bson:"_patternOid,omitempty" json:"_patternOid,omitempty"`
 	PatternPositiveInt              *int                          `bson:"patternPositiveInt,omitempty" json:"patternPositiveInt,omitempty"`
+	PatternPositiveIntElement       *Element                      `bson:"_patternPositiveInt,omitempty" json:"_patternPositiveInt,omitempty"`
 	PatternString                   *string                       `bson:"patternString,omitempty" json:"patternString,omitempty"`
+	PatternStringElement            *Element                      `bson:"_patternString,omitempty" json:"_patternString,omitempty"`
 	PatternTime                     *string                       `bson:"patternTime,omitempty" json:"patternTime,omitempty"`
+	PatternTimeElement              *Element                      `bson:"_patternTime,omitempty" json:"_patternTime,omitempty"`
 	PatternUnsignedInt              *int                          `bson:"patternUnsignedInt,omitempty" json:"patternUnsignedInt,omitempty"`
+	PatternUnsignedIntElement       *Element                      `bson:"_patternUnsignedInt,omitempty" json:"_patternUnsignedInt,omitempty"`
 	PatternUri                      *string                       `bson:"patternUri,omitempty" json:"patternUri,omitempty"`
+	PatternUriElement               *Element                      `bson:"_patternUri,omitempty" json:"_patternUri,omitempty"`
 	PatternUrl                      *string                       `bson:"patternUrl,omitempty" json:"patternUrl,omitempty"`
+	PatternUrlElement               *Element                      `bson:"_patternUrl,omitempty" json:"_patternUrl,omitempty"`
 	PatternUuid                     *string                       `bson:"patternUuid,omitempty" json:"patternUuid,omitempty"`
+	PatternUuidElement              *Element                      `bson:"_patternUuid,omitempty" json:"_patternUuid,omitempty"`
 	PatternAddress                  *Address                      `bson:"patternAddress,omitempty" json:"patternAddress,omitempty"`
 	PatternAge                      *Age                          `bson:"patternAge,omitempty" json:"patternAge,omitempty"`
 	PatternAnnotation               *Annotation                   `bson:"patternAnnotation,omitempty" json:"patternAnnotation,omitempty"`
@@ -195,141 +270,19196 @@ type ElementDefinition struct {
 	PatternMeta                     *Meta                         `bson:"patternMeta,omitempty" json:"patternMeta,omitempty"`
 	Example                         []ElementDefinitionExample    `bson:"example,omitempty" json:"example,omitempty"`
 	MinValueDate                    *string                       `bson:"minValueDate,omitempty" json:"minValueDate,omitempty"`
+	MinValueDateElement             *Element                      `bson:"_minValueDate,omitempty" json:"_minValueDate,omitempty"`
 	MinValueDateTime                *string                       `bson:"minValueDateTime,omitempty" json:"minValueDateTime,omitempty"`
+	MinValueDateTimeElement         *Element                      `bson:"_minValueDateTime,omitempty" json:"_minValueDateTime,omitempty"`
 	MinValueInstant                 *string                       `bson:"minValueInstant,omitempty" json:"minValueInstant,omitempty"`
+	MinValueInstantElement          *Element                      `bson:"_minValueInstant,omitempty" json:"_minValueInstant,omitempty"`
 	MinValueTime                    *string                       `bson:"minValueTime,omitempty" json:"minValueTime,omitempty"`
+	MinValueTimeElement             *Element                      `bson:"_minValueTime,omitempty" json:"_minValueTime,omitempty"`
 	MinValueDecimal                 *json.Number                  `bson:"minValueDecimal,omitempty" json:"minValueDecimal,omitempty"`
+	MinValueDecimalElement          *Element                      `bson:"_minValueDecimal,omitempty" json:"_minValueDecimal,omitempty"`
 	MinValueInteger                 *int                          `bson:"minValueInteger,omitempty" json:"minValueInteger,omitempty"`
+	MinValueIntegerElement          *Element                      `bson:"_minValueInteger,omitempty" json:"_minValueInteger,omitempty"`
 	MinValuePositiveInt             *int                          `bson:"minValuePositiveInt,omitempty" json:"minValuePositiveInt,omitempty"`
+	MinValuePositiveIntElement      *Element                      `bson:"_minValuePositiveInt,omitempty" json:"_minValuePositiveInt,omitempty"`
 	MinValueUnsignedInt             *int                          `bson:"minValueUnsignedInt,omitempty" json:"minValueUnsignedInt,omitempty"`
+	MinValueUnsignedIntElement      *Element                      `bson:"_minValueUnsignedInt,omitempty" json:"_minValueUnsignedInt,omitempty"`
 	MinValueQuantity                *Quantity                     `bson:"minValueQuantity,omitempty" json:"minValueQuantity,omitempty"`
 	MaxValueDate                    *string                       `bson:"maxValueDate,omitempty" json:"maxValueDate,omitempty"`
+	MaxValueDateElement             *Element                      `bson:"_maxValueDate,omitempty" json:"_maxValueDate,omitempty"`
 	MaxValueDateTime                *string                       `bson:"maxValueDateTime,omitempty" json:"maxValueDateTime,omitempty"`
+	MaxValueDateTimeElement         *Element                      `bson:"_maxValueDateTime,omitempty" json:"_maxValueDateTime,omitempty"`
 	MaxValueInstant                 *string                       `bson:"maxValueInstant,omitempty" json:"maxValueInstant,omitempty"`
+	MaxValueInstantElement          *Element                      `bson:"_maxValueInstant,omitempty" json:"_maxValueInstant,omitempty"`
 	MaxValueTime                    *string                       `bson:"maxValueTime,omitempty" json:"maxValueTime,omitempty"`
+	MaxValueTimeElement             *Element                      `bson:"_maxValueTime,omitempty" json:"_maxValueTime,omitempty"`
 	MaxValueDecimal                 *json.Number                  `bson:"maxValueDecimal,omitempty" json:"maxValueDecimal,omitempty"`
+	MaxValueDecimalElement          *Element                      `bson:"_maxValueDecimal,omitempty" json:"_maxValueDecimal,omitempty"`
 	MaxValueInteger                 *int                          `bson:"maxValueInteger,omitempty" json:"maxValueInteger,omitempty"`
+	MaxValueIntegerElement          *Element                      `bson:"_maxValueInteger,omitempty" json:"_maxValueInteger,omitempty"`
 	MaxValuePositiveInt             *int                          `bson:"maxValuePositiveInt,omitempty" json:"maxValuePositiveInt,omitempty"`
+	MaxValuePositiveIntElement      *Element                      `bson:"_maxValuePositiveInt,omitempty" json:"_maxValuePositiveInt,omitempty"`
 	MaxValueUnsignedInt             *int                          `bson:"maxValueUnsignedInt,omitempty" json:"maxValueUnsignedInt,omitempty"`
+	MaxValueUnsignedIntElement      *Element                      `bson:"_maxValueUnsignedInt,omitempty" json:"_maxValueUnsignedInt,omitempty"`
 	MaxValueQuantity                *Quantity                     `bson:"maxValueQuantity,omitempty" json:"maxValueQuantity,omitempty"`
 	MaxLength                       *int                          `bson:"maxLength,omitempty" json:"maxLength,omitempty"`
+	MaxLengthElement                *Element                      `bson:"_maxLength,omitempty" json:"_maxLength,omitempty"`
 	Condition                       []string                      `bson:"condition,omitempty" json:"condition,omitempty"`
+	ConditionElement                []*Element                    `bson:"_condition,omitempty" json:"_condition,omitempty"`
 	Constraint                      []ElementDefinitionConstraint `bson:"constraint,omitempty" json:"constraint,omitempty"`
 	MustSupport                     *bool                         `bson:"mustSupport,omitempty" json:"mustSupport,omitempty"`
+	MustSupportElement              *Element                      `bson:"_mustSupport,omitempty" json:"_mustSupport,omitempty"`
 	IsModifier                      *bool                         `bson:"isModifier,omitempty" json:"isModifier,omitempty"`
+	IsModifierElement               *Element                      `bson:"_isModifier,omitempty" json:"_isModifier,omitempty"`
 	IsModifierReason                *string                       `bson:"isModifierReason,omitempty" json:"isModifierReason,omitempty"`
+	IsModifierReasonElement         *Element                      `bson:"_isModifierReason,omitempty" json:"_isModifierReason,omitempty"`
 	IsSummary                       *bool                         `bson:"isSummary,omitempty" json:"isSummary,omitempty"`
+	IsSummaryElement                *Element                      `bson:"_isSummary,omitempty" json:"_isSummary,omitempty"`
 	Binding                         *ElementDefinitionBinding     `bson:"binding,omitempty" json:"binding,omitempty"`
 	Mapping                         []ElementDefinitionMapping    `bson:"mapping,omitempty" json:"mapping,omitempty"`
 }
 type ElementDefinitionSlicing struct {
-	Id            *string                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension     []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	Discriminator []ElementDefinitionSlicingDiscriminator `bson:"discriminator,omitempty" json:"discriminator,omitempty"`
-	Description   *string                                 `bson:"description,omitempty" json:"description,omitempty"`
-	Ordered       *bool                                   `bson:"ordered,omitempty" json:"ordered,omitempty"`
-	Rules         SlicingRules                            `bson:"rules" json:"rules"`
+	Id                 *string                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	Discriminator      []ElementDefinitionSlicingDiscriminator `bson:"discriminator,omitempty" json:"discriminator,omitempty"`
+	Description        *string                                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                                `bson:"_description,omitempty" json:"_description,omitempty"`
+	Ordered            *bool                                   `bson:"ordered,omitempty" json:"ordered,omitempty"`
+	OrderedElement     *Element                                `bson:"_ordered,omitempty" json:"_ordered,omitempty"`
+	Rules              SlicingRules                            `bson:"rules" json:"rules"`
+	RulesElement       *Element                                `bson:"_rules,omitempty" json:"_rules,omitempty"`
 }
 type ElementDefinitionSlicingDiscriminator struct {
-	Id        *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type      DiscriminatorType `bson:"type" json:"type"`
-	Path      string            `bson:"path" json:"path"`
+	Id          *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension   []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type        DiscriminatorType `bson:"type" json:"type"`
+	TypeElement *Element          `bson:"_type,omitempty" json:"_type,omitempty"`
+	Path        string            `bson:"path" json:"path"`
+	PathElement *Element          `bson:"_path,omitempty" json:"_path,omitempty"`
 }
 type ElementDefinitionBase struct {
-	Id        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Path      string      `bson:"path" json:"path"`
-	Min       int         `bson:"min" json:"min"`
-	Max       string      `bson:"max" json:"max"`
+	Id          *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Path        string      `bson:"path" json:"path"`
+	PathElement *Element    `bson:"_path,omitempty" json:"_path,omitempty"`
+	Min         int         `bson:"min" json:"min"`
+	MinElement  *Element    `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max         string      `bson:"max" json:"max"`
+	MaxElement  *Element    `bson:"_max,omitempty" json:"_max,omitempty"`
 }
 type ElementDefinitionType struct {
-	Id            *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension     []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	Code          string                 `bson:"code" json:"code"`
-	Profile       []string               `bson:"profile,omitempty" json:"profile,omitempty"`
-	TargetProfile []string               `bson:"targetProfile,omitempty" json:"targetProfile,omitempty"`
-	Aggregation   []AggregationMode      `bson:"aggregation,omitempty" json:"aggregation,omitempty"`
-	Versioning    *ReferenceVersionRules `bson:"versioning,omitempty" json:"versioning,omitempty"`
+	Id                   *string                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	Code                 string                 `bson:"code" json:"code"`
+	CodeElement          *Element               `bson:"_code,omitempty" json:"_code,omitempty"`
+	Profile              []string               `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement       []*Element             `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	TargetProfile        []string               `bson:"targetProfile,omitempty" json:"targetProfile,omitempty"`
+	TargetProfileElement []*Element             `bson:"_targetProfile,omitempty" json:"_targetProfile,omitempty"`
+	Aggregation          []AggregationMode      `bson:"aggregation,omitempty" json:"aggregation,omitempty"`
+	AggregationElement   []*Element             `bson:"_aggregation,omitempty" json:"_aggregation,omitempty"`
+	Versioning           *ReferenceVersionRules `bson:"versioning,omitempty" json:"versioning,omitempty"`
+	VersioningElement    *Element               `bson:"_versioning,omitempty" json:"_versioning,omitempty"`
 }
+
+// ElementDefinitionDefaultValue is implemented by the types allowed for ElementDefinition.defaultValue[x]
+type ElementDefinitionDefaultValue interface {
+	isElementDefinitionDefaultValue()
+}
+
+func (Base64Binary) isElementDefinitionDefaultValue()        {}
+func (Boolean) isElementDefinitionDefaultValue()             {}
+func (Canonical) isElementDefinitionDefaultValue()           {}
+func (Code) isElementDefinitionDefaultValue()                {}
+func (Date) isElementDefinitionDefaultValue()                {}
+func (DateTime) isElementDefinitionDefaultValue()            {}
+func (Decimal) isElementDefinitionDefaultValue()             {}
+func (Id) isElementDefinitionDefaultValue()                  {}
+func (Instant) isElementDefinitionDefaultValue()             {}
+func (Integer) isElementDefinitionDefaultValue()             {}
+func (Markdown) isElementDefinitionDefaultValue()            {}
+func (Oid) isElementDefinitionDefaultValue()                 {}
+func (PositiveInt) isElementDefinitionDefaultValue()         {}
+func (String) isElementDefinitionDefaultValue()              {}
+func (Time) isElementDefinitionDefaultValue()                {}
+func (UnsignedInt) isElementDefinitionDefaultValue()         {}
+func (Uri) isElementDefinitionDefaultValue()                 {}
+func (Url) isElementDefinitionDefaultValue()                 {}
+func (Uuid) isElementDefinitionDefaultValue()                {}
+func (Address) isElementDefinitionDefaultValue()             {}
+func (Age) isElementDefinitionDefaultValue()                 {}
+func (Annotation) isElementDefinitionDefaultValue()          {}
+func (Attachment) isElementDefinitionDefaultValue()          {}
+func (CodeableConcept) isElementDefinitionDefaultValue()     {}
+func (Coding) isElementDefinitionDefaultValue()              {}
+func (ContactPoint) isElementDefinitionDefaultValue()        {}
+func (Count) isElementDefinitionDefaultValue()               {}
+func (Distance) isElementDefinitionDefaultValue()            {}
+func (Duration) isElementDefinitionDefaultValue()            {}
+func (HumanName) isElementDefinitionDefaultValue()           {}
+func (Identifier) isElementDefinitionDefaultValue()          {}
+func (Money) isElementDefinitionDefaultValue()               {}
+func (Period) isElementDefinitionDefaultValue()              {}
+func (Quantity) isElementDefinitionDefaultValue()            {}
+func (Range) isElementDefinitionDefaultValue()               {}
+func (Ratio) isElementDefinitionDefaultValue()               {}
+func (Reference) isElementDefinitionDefaultValue()           {}
+func (SampledData) isElementDefinitionDefaultValue()         {}
+func (Signature) isElementDefinitionDefaultValue()           {}
+func (Timing) isElementDefinitionDefaultValue()              {}
+func (ContactDetail) isElementDefinitionDefaultValue()       {}
+func (Contributor) isElementDefinitionDefaultValue()         {}
+func (DataRequirement) isElementDefinitionDefaultValue()     {}
+func (Expression) isElementDefinitionDefaultValue()          {}
+func (ParameterDefinition) isElementDefinitionDefaultValue() {}
+func (RelatedArtifact) isElementDefinitionDefaultValue()     {}
+func (TriggerDefinition) isElementDefinitionDefaultValue()   {}
+func (UsageContext) isElementDefinitionDefaultValue()        {}
+func (Dosage) isElementDefinitionDefaultValue()              {}
+func (Meta) isElementDefinitionDefaultValue()                {}
+
+// DefaultValue returns the value of ElementDefinition.defaultValue[x] together with its FHIR type code or nil if no value is set
+func (r ElementDefinition) DefaultValue() (ElementDefinitionDefaultValue, string) {
+	if r.DefaultValueBase64Binary != nil {
+		return Base64Binary(*r.DefaultValueBase64Binary), "base64Binary"
+	}
+	if r.DefaultValueBoolean != nil {
+		return Boolean(*r.DefaultValueBoolean), "boolean"
+	}
+	if r.DefaultValueCanonical != nil {
+		return Canonical(*r.DefaultValueCanonical), "canonical"
+	}
+	if r.DefaultValueCode != nil {
+		return Code(*r.DefaultValueCode), "code"
+	}
+	if r.DefaultValueDate != nil {
+		return Date(*r.DefaultValueDate), "date"
+	}
+	if r.DefaultValueDateTime != nil {
+		return DateTime(*r.DefaultValueDateTime), "dateTime"
+	}
+	if r.DefaultValueDecimal != nil {
+		return Decimal(*r.DefaultValueDecimal), "decimal"
+	}
+	if r.DefaultValueId != nil {
+		return Id(*r.DefaultValueId), "id"
+	}
+	if r.DefaultValueInstant != nil {
+		return Instant(*r.DefaultValueInstant), "instant"
+	}
+	if r.DefaultValueInteger != nil {
+		return Integer(*r.DefaultValueInteger), "integer"
+	}
+	if r.DefaultValueMarkdown != nil {
+		return Markdown(*r.DefaultValueMarkdown), "markdown"
+	}
+	if r.DefaultValueOid != nil {
+		return Oid(*r.DefaultValueOid), "oid"
+	}
+	if r.DefaultValuePositiveInt != nil {
+		return PositiveInt(*r.DefaultValuePositiveInt), "positiveInt"
+	}
+	if r.DefaultValueString != nil {
+		return String(*r.DefaultValueString), "string"
+	}
+	if r.DefaultValueTime != nil {
+		return Time(*r.DefaultValueTime), "time"
+	}
+	if r.DefaultValueUnsignedInt != nil {
+		return UnsignedInt(*r.DefaultValueUnsignedInt), "unsignedInt"
+	}
+	if r.DefaultValueUri != nil {
+		return Uri(*r.DefaultValueUri), "uri"
+	}
+	if r.DefaultValueUrl != nil {
+		return Url(*r.DefaultValueUrl), "url"
+	}
+	if r.DefaultValueUuid != nil {
+		return Uuid(*r.DefaultValueUuid), "uuid"
+	}
+	if r.DefaultValueAddress != nil {
+		return *r.DefaultValueAddress, "Address"
+	}
+	if r.DefaultValueAge != nil {
+		return *r.DefaultValueAge, "Age"
+	}
+	if r.DefaultValueAnnotation != nil {
+		return *r.DefaultValueAnnotation, "Annotation"
+	}
+	if r.DefaultValueAttachment != nil {
+		return *r.DefaultValueAttachment, "Attachment"
+	}
+	if r.DefaultValueCodeableConcept != nil {
+		return *r.DefaultValueCodeableConcept, "CodeableConcept"
+	}
+	if r.DefaultValueCoding != nil {
+		return *r.DefaultValueCoding, "Coding"
+	}
+	if r.DefaultValueContactPoint != nil {
+		return *r.DefaultValueContactPoint, "ContactPoint"
+	}
+	if r.DefaultValueCount != nil {
+		return *r.DefaultValueCount, "Count"
+	}
+	if r.DefaultValueDistance != nil {
+		return *r.DefaultValueDistance, "Distance"
+	}
+	if r.DefaultValueDuration != nil {
+		return *r.DefaultValueDuration, "Duration"
+	}
+	if r.DefaultValueHumanName != nil {
+		return *r.DefaultValueHumanName, "HumanName"
+	}
+	if r.DefaultValueIdentifier != nil {
+		return *r.DefaultValueIdentifier, "Identifier"
+	}
+	if r.DefaultValueMoney != nil {
+		return *r.DefaultValueMoney, "Money"
+	}
+	if r.DefaultValuePeriod != nil {
+		return *r.DefaultValuePeriod, "Period"
+	}
+	if r.DefaultValueQuantity != nil {
+		return *r.DefaultValueQuantity, "Quantity"
+	}
+	if r.DefaultValueRange != nil {
+		return *r.DefaultValueRange, "Range"
+	}
+	if r.DefaultValueRatio != nil {
+		return *r.DefaultValueRatio, "Ratio"
+	}
+	if r.DefaultValueReference != nil {
+		return *r.DefaultValueReference, "Reference"
+	}
+	if r.DefaultValueSampledData != nil {
+		return *r.DefaultValueSampledData, "SampledData"
+	}
+	if r.DefaultValueSignature != nil {
+		return *r.DefaultValueSignature, "Signature"
+	}
+	if r.DefaultValueTiming != nil {
+		return *r.DefaultValueTiming, "Timing"
+	}
+	if r.DefaultValueContactDetail != nil {
+		return *r.DefaultValueContactDetail, "ContactDetail"
+	}
+	if r.DefaultValueContributor != nil {
+		return *r.DefaultValueContributor, "Contributor"
+	}
+	if r.DefaultValueDataRequirement != nil {
+		return *r.DefaultValueDataRequirement, "DataRequirement"
+	}
+	if r.DefaultValueExpression != nil {
+		return *r.DefaultValueExpression, "Expression"
+	}
+	if r.DefaultValueParameterDefinition != nil {
+		return *r.DefaultValueParameterDefinition, "ParameterDefinition"
+	}
+	if r.DefaultValueRelatedArtifact != nil {
+		return *r.DefaultValueRelatedArtifact, "RelatedArtifact"
+	}
+	if r.DefaultValueTriggerDefinition != nil {
+		return *r.DefaultValueTriggerDefinition, "TriggerDefinition"
+	}
+	if r.DefaultValueUsageContext != nil {
+		return *r.DefaultValueUsageContext, "UsageContext"
+	}
+	if r.DefaultValueDosage != nil {
+		return *r.DefaultValueDosage, "Dosage"
+	}
+	if r.DefaultValueMeta != nil {
+		return *r.DefaultValueMeta, "Meta"
+	}
+	return nil, ""
+}
+
+// SetDefaultValue sets the value of ElementDefinition.defaultValue[x], given as value or pointer, and clears all other types
+func (r *ElementDefinition) SetDefaultValue(value ElementDefinitionDefaultValue) {
+	r.DefaultValueBase64Binary = nil
+	r.DefaultValueBoolean = nil
+	r.DefaultValueCanonical = nil
+	r.DefaultValueCode = nil
+	r.DefaultValueDate = nil
+	r.DefaultValueDateTime = nil
+	r.DefaultValueDecimal = nil
+	r.DefaultValueId = nil
+	r.DefaultValueInstant = nil
+	r.DefaultValueInteger = nil
+	r.DefaultValueMarkdown = nil
+	r.DefaultValueOid = nil
+	r.DefaultValuePositiveInt = nil
+	r.DefaultValueString = nil
+	r.DefaultValueTime = nil
+	r.DefaultValueUnsignedInt = nil
+	r.DefaultValueUri = nil
+	r.DefaultValueUrl = nil
+	r.DefaultValueUuid = nil
+	r.DefaultValueAddress = nil
+	r.DefaultValueAge = nil
+	r.DefaultValueAnnotation = nil
+	r.DefaultValueAttachment = nil
+	r.DefaultValueCodeableConcept = nil
+	r.DefaultValueCoding = nil
+	r.DefaultValueContactPoint = nil
+	r.DefaultValueCount = nil
+	r.DefaultValueDistance = nil
+	r.DefaultValueDuration = nil
+	r.DefaultValueHumanName = nil
+	r.DefaultValueIdentifier = nil
+	r.DefaultValueMoney = nil
+	r.DefaultValuePeriod = nil
+	r.DefaultValueQuantity = nil
+	r.DefaultValueRange = nil
+	r.DefaultValueRatio = nil
+	r.DefaultValueReference = nil
+	r.DefaultValueSampledData = nil
+	r.DefaultValueSignature = nil
+	r.DefaultValueTiming = nil
+	r.DefaultValueContactDetail = nil
+	r.DefaultValueContributor = nil
+	r.DefaultValueDataRequirement = nil
+	r.DefaultValueExpression = nil
+	r.DefaultValueParameterDefinition = nil
+	r.DefaultValueRelatedArtifact = nil
+	r.DefaultValueTriggerDefinition = nil
+	r.DefaultValueUsageContext = nil
+	r.DefaultValueDosage = nil
+	r.DefaultValueMeta = nil
+	switch v := value.(type) {
+	case Base64Binary:
+		primitive := string(v)
+		r.DefaultValueBase64Binary = &primitive
+	case *Base64Binary:
+		r.DefaultValueBase64Binary = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.DefaultValueBoolean = &primitive
+	case *Boolean:
+		r.DefaultValueBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.DefaultValueCanonical = &primitive
+	case *Canonical:
+		r.DefaultValueCanonical = (*string)(v)
+	case Code:
+		primitive := string(v)
+		r.DefaultValueCode = &primitive
+	case *Code:
+		r.DefaultValueCode = (*string)(v)
+	case Date:
+		primitive := string(v)
+		r.DefaultValueDate = &primitive
+	case *Date:
+		r.DefaultValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.DefaultValueDateTime = &primitive
+	case *DateTime:
+		r.DefaultValueDateTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.DefaultValueDecimal = &primitive
+	case *Decimal:
+		r.DefaultValueDecimal = (*json.Number)(v)
+	case Id:
+		primitive := string(v)
+		r.DefaultValueId = &primitive
+	case *Id:
+		r.DefaultValueId = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.DefaultValueInstant = &primitive
+	case *Instant:
+		r.DefaultValueInstant = (*string)(v)
+	case Integer:
+		primitive := int(v)
+		r.DefaultValueInteger = &primitive
+	case *Integer:
+		r.DefaultValueInteger = (*int)(v)
+	case Markdown:
+		primitive := string(v)
+		r.DefaultValueMarkdown = &primitive
+	case *Markdown:
+		r.DefaultValueMarkdown = (*string)(v)
+	case Oid:
+		primitive := string(v)
+		r.DefaultValueOid = &primitive
+	case *Oid:
+		r.DefaultValueOid = (*string)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.DefaultValuePositiveInt = &primitive
+	case *PositiveInt:
+		r.DefaultValuePositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.DefaultValueString = &primitive
+	case *String:
+		r.DefaultValueString = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.DefaultValueTime = &primitive
+	case *Time:
+		r.DefaultValueTime = (*string)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.DefaultValueUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.DefaultValueUnsignedInt = (*int)(v)
+	case Uri:
+		primitive := string(v)
+		r.DefaultValueUri = &primitive
+	case *Uri:
+		r.DefaultValueUri = (*string)(v)
+	case Url:
+		primitive := string(v)
+		r.DefaultValueUrl = &primitive
+	case *Url:
+		r.DefaultValueUrl = (*string)(v)
+	case Uuid:
+		primitive := string(v)
+		r.DefaultValueUuid = &primitive
+	case *Uuid:
+		r.DefaultValueUuid = (*string)(v)
+	case Address:
+		r.DefaultValueAddress = &v
+	case *Address:
+		r.DefaultValueAddress = v
+	case Age:
+		r.DefaultValueAge = &v
+	case *Age:
+		r.DefaultValueAge = v
+	case Annotation:
+		r.DefaultValueAnnotation = &v
+	case *Annotation:
+		r.DefaultValueAnnotation = v
+	case Attachment:
+		r.DefaultValueAttachment = &v
+	case *Attachment:
+		r.DefaultValueAttachment = v
+	case CodeableConcept:
+		r.DefaultValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.DefaultValueCodeableConcept = v
+	case Coding:
+		r.DefaultValueCoding = &v
+	case *Coding:
+		r.DefaultValueCoding = v
+	case ContactPoint:
+		r.DefaultValueContactPoint = &v
+	case *ContactPoint:
+		r.DefaultValueContactPoint = v
+	case Count:
+		r.DefaultValueCount = &v
+	case *Count:
+		r.DefaultValueCount = v
+	case Distance:
+		r.DefaultValueDistance = &v
+	case *Distance:
+		r.DefaultValueDistance = v
+	case Duration:
+		r.DefaultValueDuration = &v
+	case *Duration:
+		r.DefaultValueDuration = v
+	case HumanName:
+		r.DefaultValueHumanName = &v
+	case *HumanName:
+		r.DefaultValueHumanName = v
+	case Identifier:
+		r.DefaultValueIdentifier = &v
+	case *Identifier:
+		r.DefaultValueIdentifier = v
+	case Money:
+		r.DefaultValueMoney = &v
+	case *Money:
+		r.DefaultValueMoney = v
+	case Period:
+		r.DefaultValuePeriod = &v
+	case *Period:
+		r.DefaultValuePeriod = v
+	case Quantity:
+		r.DefaultValueQuantity = &v
+	case *Quantity:
+		r.DefaultValueQuantity = v
+	case Range:
+		r.DefaultValueRange = &v
+	case *Range:
+		r.DefaultValueRange = v
+	case Ratio:
+		r.DefaultValueRatio = &v
+	case *Ratio:
+		r.DefaultValueRatio = v
+	case Reference:
+		r.DefaultValueReference = &v
+	case *Reference:
+		r.DefaultValueReference = v
+	case SampledData:
+		r.DefaultValueSampledData = &v
+	case *SampledData:
+		r.DefaultValueSampledData = v
+	case Signature:
+		r.DefaultValueSignature = &v
+	case *Signature:
+		r.DefaultValueSignature = v
+	case Timing:
+		r.DefaultValueTiming = &v
+	case *Timing:
+		r.DefaultValueTiming = v
+	case ContactDetail:
+		r.DefaultValueContactDetail = &v
+	case *ContactDetail:
+		r.DefaultValueContactDetail = v
+	case Contributor:
+		r.DefaultValueContributor = &v
+	case *Contributor:
+		r.DefaultValueContributor = v
+	case DataRequirement:
+		r.DefaultValueDataRequirement = &v
+	case *DataRequirement:
+		r.DefaultValueDataRequirement = v
+	case Expression:
+		r.DefaultValueExpression = &v
+	case *Expression:
+		r.DefaultValueExpression = v
+	case ParameterDefinition:
+		r.DefaultValueParameterDefinition = &v
+	case *ParameterDefinition:
+		r.DefaultValueParameterDefinition = v
+	case RelatedArtifact:
+		r.DefaultValueRelatedArtifact = &v
+	case *RelatedArtifact:
+		r.DefaultValueRelatedArtifact = v
+	case TriggerDefinition:
+		r.DefaultValueTriggerDefinition = &v
+	case *TriggerDefinition:
+		r.DefaultValueTriggerDefinition = v
+	case UsageContext:
+		r.DefaultValueUsageContext = &v
+	case *UsageContext:
+		r.DefaultValueUsageContext = v
+	case Dosage:
+		r.DefaultValueDosage = &v
+	case *Dosage:
+		r.DefaultValueDosage = v
+	case Meta:
+		r.DefaultValueMeta = &v
+	case *Meta:
+		r.DefaultValueMeta = v
+	}
+}
+
+// ElementDefinitionFixed is implemented by the types allowed for ElementDefinition.fixed[x]
+type ElementDefinitionFixed interface {
+	isElementDefinitionFixed()
+}
+
+func (Base64Binary) isElementDefinitionFixed()        {}
+func (Boolean) isElementDefinitionFixed()             {}
+func (Canonical) isElementDefinitionFixed()           {}
+func (Code) isElementDefinitionFixed()                {}
+func (Date) isElementDefinitionFixed()                {}
+func (DateTime) isElementDefinitionFixed()            {}
+func (Decimal) isElementDefinitionFixed()             {}
+func (Id) isElementDefinitionFixed()                  {}
+func (Instant) isElementDefinitionFixed()             {}
+func (Integer) isElementDefinitionFixed()             {}
+func (Markdown) isElementDefinitionFixed()            {}
+func (Oid) isElementDefinitionFixed()                 {}
+func (PositiveInt) isElementDefinitionFixed()         {}
+func (String) isElementDefinitionFixed()              {}
+func (Time) isElementDefinitionFixed()                {}
+func (UnsignedInt) isElementDefinitionFixed()         {}
+func (Uri) isElementDefinitionFixed()                 {}
+func (Url) isElementDefinitionFixed()                 {}
+func (Uuid) isElementDefinitionFixed()                {}
+func (Address) isElementDefinitionFixed()             {}
+func (Age) isElementDefinitionFixed()                 {}
+func (Annotation) isElementDefinitionFixed()          {}
+func (Attachment) isElementDefinitionFixed()          {}
+func (CodeableConcept) isElementDefinitionFixed()     {}
+func (Coding) isElementDefinitionFixed()              {}
+func (ContactPoint) isElementDefinitionFixed()        {}
+func (Count) isElementDefinitionFixed()               {}
+func (Distance) isElementDefinitionFixed()            {}
+func (Duration) isElementDefinitionFixed()            {}
+func (HumanName) isElementDefinitionFixed()           {}
+func (Identifier) isElementDefinitionFixed()          {}
+func (Money) isElementDefinitionFixed()               {}
+func (Period) isElementDefinitionFixed()              {}
+func (Quantity) isElementDefinitionFixed()            {}
+func (Range) isElementDefinitionFixed()               {}
+func (Ratio) isElementDefinitionFixed()               {}
+func (Reference) isElementDefinitionFixed()           {}
+func (SampledData) isElementDefinitionFixed()         {}
+func (Signature) isElementDefinitionFixed()           {}
+func (Timing) isElementDefinitionFixed()              {}
+func (ContactDetail) isElementDefinitionFixed()       {}
+func (Contributor) isElementDefinitionFixed()         {}
+func (DataRequirement) isElementDefinitionFixed()     {}
+func (Expression) isElementDefinitionFixed()          {}
+func (ParameterDefinition) isElementDefinitionFixed() {}
+func (RelatedArtifact) isElementDefinitionFixed()     {}
+func (TriggerDefinition) isElementDefinitionFixed()   {}
+func (UsageContext) isElementDefinitionFixed()        {}
+func (Dosage) isElementDefinitionFixed()              {}
+func (Meta) isElementDefinitionFixed()                {}
+
+// Fixed returns the value of ElementDefinition.fixed[x] together with its FHIR type code or nil if no value is set
+func (r ElementDefinition) Fixed() (ElementDefinitionFixed, string) {
+	if r.FixedBase64Binary != nil {
+		return Base64Binary(*r.FixedBase64Binary), "base64Binary"
+	}
+	if r.FixedBoolean != nil {
+		return Boolean(*r.FixedBoolean), "boolean"
+	}
+	if r.FixedCanonical != nil {
+		return Canonical(*r.FixedCanonical), "canonical"
+	}
+	if r.FixedCode != nil {
+		return Code(*r.FixedCode), "code"
+	}
+	if r.FixedDate != nil {
+		return Date(*r.FixedDate), "date"
+	}
+	if r.FixedDateTime != nil {
+		return DateTime(*r.FixedDateTime), "dateTime"
+	}
+	if r.FixedDecimal != nil {
+		return Decimal(*r.FixedDecimal), "decimal"
+	}
+	if r.FixedId != nil {
+		return Id(*r.FixedId), "id"
+	}
+	if r.FixedInstant != nil {
+		return Instant(*r.FixedInstant), "instant"
+	}
+	if r.FixedInteger != nil {
+		return Integer(*r.FixedInteger), "integer"
+	}
+	if r.FixedMarkdown != nil {
+		return Markdown(*r.FixedMarkdown), "markdown"
+	}
+	if r.FixedOid != nil {
+		return Oid(*r.FixedOid), "oid"
+	}
+	if r.FixedPositiveInt != nil {
+		return PositiveInt(*r.FixedPositiveInt), "positiveInt"
+	}
+	if r.FixedString != nil {
+		return String(*r.FixedString), "string"
+	}
+	if r.FixedTime != nil {
+		return Time(*r.FixedTime), "time"
+	}
+	if r.FixedUnsignedInt != nil {
+		return UnsignedInt(*r.FixedUnsignedInt), "unsignedInt"
+	}
+	if r.FixedUri != nil {
+		return Uri(*r.FixedUri), "uri"
+	}
+	if r.FixedUrl != nil {
+		return Url(*r.FixedUrl), "url"
+	}
+	if r.FixedUuid != nil {
+		return Uuid(*r.FixedUuid), "uuid"
+	}
+	if r.FixedAddress != nil {
+		return *r.FixedAddress, "Address"
+	}
+	if r.FixedAge != nil {
+		return *r.FixedAge, "Age"
+	}
+	if r.FixedAnnotation != nil {
+		return *r.FixedAnnotation, "Annotation"
+	}
+	if r.FixedAttachment != nil {
+		return *r.FixedAttachment, "Attachment"
+	}
+	if r.FixedCodeableConcept != nil {
+		return *r.FixedCodeableConcept, "CodeableConcept"
+	}
+	if r.FixedCoding != nil {
+		return *r.FixedCoding, "Coding"
+	}
+	if r.FixedContactPoint != nil {
+		return *r.FixedContactPoint, "ContactPoint"
+	}
+	if r.FixedCount != nil {
+		return *r.FixedCount, "Count"
+	}
+	if r.FixedDistance != nil {
+		return *r.FixedDistance, "Distance"
+	}
+	if r.FixedDuration != nil {
+		return *r.FixedDuration, "Duration"
+	}
+	if r.FixedHumanName != nil {
+		return *r.FixedHumanName, "HumanName"
+	}
+	if r.FixedIdentifier != nil {
+		return *r.FixedIdentifier, "Identifier"
+	}
+	if r.FixedMoney != nil {
+		return *r.FixedMoney, "Money"
+	}
+	if r.FixedPeriod != nil {
+		return *r.FixedPeriod, "Period"
+	}
+	if r.FixedQuantity != nil {
+		return *r.FixedQuantity, "Quantity"
+	}
+	if r.FixedRange != nil {
+		return *r.FixedRange, "Range"
+	}
+	if r.FixedRatio != nil {
+		return *r.FixedRatio, "Ratio"
+	}
+	if r.FixedReference != nil {
+		return *r.FixedReference, "Reference"
+	}
+	if r.FixedSampledData != nil {
+		return *r.FixedSampledData, "SampledData"
+	}
+	if r.FixedSignature != nil {
+		return *r.FixedSignature, "Signature"
+	}
+	if r.FixedTiming != nil {
+		return *r.FixedTiming, "Timing"
+	}
+	if r.FixedContactDetail != nil {
+		return *r.FixedContactDetail, "ContactDetail"
+	}
+	if r.FixedContributor != nil {
+		return *r.FixedContributor, "Contributor"
+	}
+	if r.FixedDataRequirement != nil {
+		return *r.FixedDataRequirement, "DataRequirement"
+	}
+	if r.FixedExpression != nil {
+		return *r.FixedExpression, "Expression"
+	}
+	if r.FixedParameterDefinition != nil {
+		return *r.FixedParameterDefinition, "ParameterDefinition"
+	}
+	if r.FixedRelatedArtifact != nil {
+		return *r.FixedRelatedArtifact, "RelatedArtifact"
+	}
+	if r.FixedTriggerDefinition != nil {
+		return *r.FixedTriggerDefinition, "TriggerDefinition"
+	}
+	if r.FixedUsageContext != nil {
+		return *r.FixedUsageContext, "UsageContext"
+	}
+	if r.FixedDosage != nil {
+		return *r.FixedDosage, "Dosage"
+	}
+	if r.FixedMeta != nil {
+		return *r.FixedMeta, "Meta"
+	}
+	return nil, ""
+}
+
+// SetFixed sets the value of ElementDefinition.fixed[x], given as value or pointer, and clears all other types
+func (r *ElementDefinition) SetFixed(value ElementDefinitionFixed) {
+	r.FixedBase64Binary = nil
+	r.FixedBoolean = nil
+	r.FixedCanonical = nil
+	r.FixedCode = nil
+	r.FixedDate = nil
+	r.FixedDateTime = nil
+	r.FixedDecimal = nil
+	r.FixedId = nil
+	r.FixedInstant = nil
+	r.FixedInteger = nil
+	r.FixedMarkdown = nil
+	r.FixedOid = nil
+	r.FixedPositiveInt = nil
+	r.FixedString = nil
+	r.FixedTime = nil
+	r.FixedUnsignedInt = nil
+	r.FixedUri = nil
+	r.FixedUrl = nil
+	r.FixedUuid = nil
+	r.FixedAddress = nil
+	r.FixedAge = nil
+	r.FixedAnnotation = nil
+	r.FixedAttachment = nil
+	r.FixedCodeableConcept = nil
+	r.FixedCoding = nil
+	r.FixedContactPoint = nil
+	r.FixedCount = nil
+	r.FixedDistance = nil
+	r.FixedDuration = nil
+	r.FixedHumanName = nil
+	r.FixedIdentifier = nil
+	r.FixedMoney = nil
+	r.FixedPeriod = nil
+	r.FixedQuantity = nil
+	r.FixedRange = nil
+	r.FixedRatio = nil
+	r.FixedReference = nil
+	r.FixedSampledData = nil
+	r.FixedSignature = nil
+	r.FixedTiming = nil
+	r.FixedContactDetail = nil
+	r.FixedContributor = nil
+	r.FixedDataRequirement = nil
+	r.FixedExpression = nil
+	r.FixedParameterDefinition = nil
+	r.FixedRelatedArtifact = nil
+	r.FixedTriggerDefinition = nil
+	r.FixedUsageContext = nil
+	r.FixedDosage = nil
+	r.FixedMeta = nil
+	switch v := value.(type) {
+	case Base64Binary:
+		primitive := string(v)
+		r.FixedBase64Binary = &primitive
+	case *Base64Binary:
+		r.FixedBase64Binary = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.FixedBoolean = &primitive
+	case *Boolean:
+		r.FixedBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.FixedCanonical = &primitive
+	case *Canonical:
+		r.FixedCanonical = (*string)(v)
+	case Code:
+		primitive := string(v)
+		r.FixedCode = &primitive
+	case *Code:
+		r.FixedCode = (*string)(v)
+	case Date:
+		primitive := string(v)
+		r.FixedDate = &primitive
+	case *Date:
+		r.FixedDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.FixedDateTime = &primitive
+	case *DateTime:
+		r.FixedDateTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.FixedDecimal = &primitive
+	case *Decimal:
+		r.FixedDecimal = (*json.Number)(v)
+	case Id:
+		primitive := string(v)
+		r.FixedId = &primitive
+	case *Id:
+		r.FixedId = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.FixedInstant = &primitive
+	case *Instant:
+		r.FixedInstant = (*string)(v)
+	case Integer:
+		primitive := int(v)
+		r.FixedInteger = &primitive
+	case *Integer:
+		r.FixedInteger = (*int)(v)
+	case Markdown:
+		primitive := string(v)
+		r.FixedMarkdown = &primitive
+	case *Markdown:
+		r.FixedMarkdown = (*string)(v)
+	case Oid:
+		primitive := string(v)
+		r.FixedOid = &primitive
+	case *Oid:
+		r.FixedOid = (*string)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.FixedPositiveInt = &primitive
+	case *PositiveInt:
+		r.FixedPositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.FixedString = &primitive
+	case *String:
+		r.FixedString = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.FixedTime = &primitive
+	case *Time:
+		r.FixedTime = (*string)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.FixedUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.FixedUnsignedInt = (*int)(v)
+	case Uri:
+		primitive := string(v)
+		r.FixedUri = &primitive
+	case *Uri:
+		r.FixedUri = (*string)(v)
+	case Url:
+		primitive := string(v)
+		r.FixedUrl = &primitive
+	case *Url:
+		r.FixedUrl = (*string)(v)
+	case Uuid:
+		primitive := string(v)
+		r.FixedUuid = &primitive
+	case *Uuid:
+		r.FixedUuid = (*string)(v)
+	case Address:
+		r.FixedAddress = &v
+	case *Address:
+		r.FixedAddress = v
+	case Age:
+		r.FixedAge = &v
+	case *Age:
+		r.FixedAge = v
+	case Annotation:
+		r.FixedAnnotation = &v
+	case *Annotation:
+		r.FixedAnnotation = v
+	case Attachment:
+		r.FixedAttachment = &v
+	case *Attachment:
+		r.FixedAttachment = v
+	case CodeableConcept:
+		r.FixedCodeableConcept = &v
+	case *CodeableConcept:
+		r.FixedCodeableConcept = v
+	case Coding:
+		r.FixedCoding = &v
+	case *Coding:
+		r.FixedCoding = v
+	case ContactPoint:
+		r.FixedContactPoint = &v
+	case *ContactPoint:
+		r.FixedContactPoint = v
+	case Count:
+		r.FixedCount = &v
+	case *Count:
+		r.FixedCount = v
+	case Distance:
+		r.FixedDistance = &v
+	case *Distance:
+		r.FixedDistance = v
+	case Duration:
+		r.FixedDuration = &v
+	case *Duration:
+		r.FixedDuration = v
+	case HumanName:
+		r.FixedHumanName = &v
+	case *HumanName:
+		r.FixedHumanName = v
+	case Identifier:
+		r.FixedIdentifier = &v
+	case *Identifier:
+		r.FixedIdentifier = v
+	case Money:
+		r.FixedMoney = &v
+	case *Money:
+		r.FixedMoney = v
+	case Period:
+		r.FixedPeriod = &v
+	case *Period:
+		r.FixedPeriod = v
+	case Quantity:
+		r.FixedQuantity = &v
+	case *Quantity:
+		r.FixedQuantity = v
+	case Range:
+		r.FixedRange = &v
+	case *Range:
+		r.FixedRange = v
+	case Ratio:
+		r.FixedRatio = &v
+	case *Ratio:
+		r.FixedRatio = v
+	case Reference:
+		r.FixedReference = &v
+	case *Reference:
+		r.FixedReference = v
+	case SampledData:
+		r.FixedSampledData = &v
+	case *SampledData:
+		r.FixedSampledData = v
+	case Signature:
+		r.FixedSignature = &v
+	case *Signature:
+		r.FixedSignature = v
+	case Timing:
+		r.FixedTiming = &v
+	case *Timing:
+		r.FixedTiming = v
+	case ContactDetail:
+		r.FixedContactDetail = &v
+	case *ContactDetail:
+		r.FixedContactDetail = v
+	case Contributor:
+		r.FixedContributor = &v
+	case *Contributor:
+		r.FixedContributor = v
+	case DataRequirement:
+		r.FixedDataRequirement = &v
+	case *DataRequirement:
+		r.FixedDataRequirement = v
+	case Expression:
+		r.FixedExpression = &v
+	case *Expression:
+		r.FixedExpression = v
+	case ParameterDefinition:
+		r.FixedParameterDefinition = &v
+	case *ParameterDefinition:
+		r.FixedParameterDefinition = v
+	case RelatedArtifact:
+		r.FixedRelatedArtifact = &v
+	case *RelatedArtifact:
+		r.FixedRelatedArtifact = v
+	case TriggerDefinition:
+		r.FixedTriggerDefinition = &v
+	case *TriggerDefinition:
+		r.FixedTriggerDefinition = v
+	case UsageContext:
+		r.FixedUsageContext = &v
+	case *UsageContext:
+		r.FixedUsageContext = v
+	case Dosage:
+		r.FixedDosage = &v
+	case *Dosage:
+		r.FixedDosage = v
+	case Meta:
+		r.FixedMeta = &v
+	case *Meta:
+		r.FixedMeta = v
+	}
+}
+
+// ElementDefinitionPattern is implemented by the types allowed for ElementDefinition.pattern[x]
+type ElementDefinitionPattern interface {
+	isElementDefinitionPattern()
+}
+
+func (Base64Binary) isElementDefinitionPattern()        {}
+func (Boolean) isElementDefinitionPattern()             {}
+func (Canonical) isElementDefinitionPattern()           {}
+func (Code) isElementDefinitionPattern()                {}
+func (Date) isElementDefinitionPattern()                {}
+func (DateTime) isElementDefinitionPattern()            {}
+func (Decimal) isElementDefinitionPattern()             {}
+func (Id) isElementDefinitionPattern()                  {}
+func (Instant) isElementDefinitionPattern()             {}
+func (Integer) isElementDefinitionPattern()             {}
+func (Markdown) isElementDefinitionPattern()            {}
+func (Oid) isElementDefinitionPattern()                 {}
+func (PositiveInt) isElementDefinitionPattern()         {}
+func (String) isElementDefinitionPattern()              {}
+func (Time) isElementDefinitionPattern()                {}
+func (UnsignedInt) isElementDefinitionPattern()         {}
+func (Uri) isElementDefinitionPattern()                 {}
+func (Url) isElementDefinitionPattern()                 {}
+func (Uuid) isElementDefinitionPattern()                {}
+func (Address) isElementDefinitionPattern()             {}
+func (Age) isElementDefinitionPattern()                 {}
+func (Annotation) isElementDefinitionPattern()          {}
+func (Attachment) isElementDefinitionPattern()          {}
+func (CodeableConcept) isElementDefinitionPattern()     {}
+func (Coding) isElementDefinitionPattern()              {}
+func (ContactPoint) isElementDefinitionPattern()        {}
+func (Count) isElementDefinitionPattern()               {}
+func (Distance) isElementDefinitionPattern()            {}
+func (Duration) isElementDefinitionPattern()            {}
+func (HumanName) isElementDefinitionPattern()           {}
+func (Identifier) isElementDefinitionPattern()          {}
+func (Money) isElementDefinitionPattern()               {}
+func (Period) isElementDefinitionPattern()              {}
+func (Quantity) isElementDefinitionPattern()            {}
+func (Range) isElementDefinitionPattern()               {}
+func (Ratio) isElementDefinitionPattern()               {}
+func (Reference) isElementDefinitionPattern()           {}
+func (SampledData) isElementDefinitionPattern()         {}
+func (Signature) isElementDefinitionPattern()           {}
+func (Timing) isElementDefinitionPattern()              {}
+func (ContactDetail) isElementDefinitionPattern()       {}
+func (Contributor) isElementDefinitionPattern()         {}
+func (DataRequirement) isElementDefinitionPattern()     {}
+func (Expression) isElementDefinitionPattern()          {}
+func (ParameterDefinition) isElementDefinitionPattern() {}
+func (RelatedArtifact) isElementDefinitionPattern()     {}
+func (TriggerDefinition) isElementDefinitionPattern()   {}
+func (UsageContext) isElementDefinitionPattern()        {}
+func (Dosage) isElementDefinitionPattern()              {}
+func (Meta) isElementDefinitionPattern()                {}
+
+// Pattern returns the value of ElementDefinition.pattern[x] together with its FHIR type code or nil if no value is set
+func (r ElementDefinition) Pattern() (ElementDefinitionPattern, string) {
+	if r.PatternBase64Binary != nil {
+		return Base64Binary(*r.PatternBase64Binary), "base64Binary"
+	}
+	if r.PatternBoolean != nil {
+		return Boolean(*r.PatternBoolean), "boolean"
+	}
+	if r.PatternCanonical != nil {
+		return Canonical(*r.PatternCanonical), "canonical"
+	}
+	if r.PatternCode != nil {
+		return Code(*r.PatternCode), "code"
+	}
+	if r.PatternDate != nil {
+		return Date(*r.PatternDate), "date"
+	}
+	if r.PatternDateTime != nil {
+		return DateTime(*r.PatternDateTime), "dateTime"
+	}
+	if r.PatternDecimal != nil {
+		return Decimal(*r.PatternDecimal), "decimal"
+	}
+	if r.PatternId != nil {
+		return Id(*r.PatternId), "id"
+	}
+	if r.PatternInstant != nil {
+		return Instant(*r.PatternInstant), "instant"
+	}
+	if r.PatternInteger != nil {
+		return Integer(*r.PatternInteger), "integer"
+	}
+	if r.PatternMarkdown != nil {
+		return Markdown(*r.PatternMarkdown), "markdown"
+	}
+	if r.PatternOid != nil {
+		return Oid(*r.PatternOid), "oid"
+	}
+	if r.PatternPositiveInt != nil {
+		return PositiveInt(*r.PatternPositiveInt), "positiveInt"
+	}
+	if r.PatternString != nil {
+		return String(*r.PatternString), "string"
+	}
+	if r.PatternTime != nil {
+		return Time(*r.PatternTime), "time"
+	}
+	if r.PatternUnsignedInt != nil {
+		return UnsignedInt(*r.PatternUnsignedInt), "unsignedInt"
+	}
+	if r.PatternUri != nil {
+		return Uri(*r.PatternUri), "uri"
+	}
+	if r.PatternUrl != nil {
+		return Url(*r.PatternUrl), "url"
+	}
+	if r.PatternUuid != nil {
+		return Uuid(*r.PatternUuid), "uuid"
+	}
+	if r.PatternAddress != nil {
+		return *r.PatternAddress, "Address"
+	}
+	if r.PatternAge != nil {
+		return *r.PatternAge, "Age"
+	}
+	if r.PatternAnnotation != nil {
+		return *r.PatternAnnotation, "Annotation"
+	}
+	if r.PatternAttachment != nil {
+		return *r.PatternAttachment, "Attachment"
+	}
+	if r.PatternCodeableConcept != nil {
+		return *r.PatternCodeableConcept, "CodeableConcept"
+	}
+	if r.PatternCoding != nil {
+		return *r.PatternCoding, "Coding"
+	}
+	if r.PatternContactPoint != nil {
+		return *r.PatternContactPoint, "ContactPoint"
+	}
+	if r.PatternCount != nil {
+		return *r.PatternCount, "Count"
+	}
+	if r.PatternDistance != nil {
+		return *r.PatternDistance, "Distance"
+	}
+	if r.PatternDuration != nil {
+		return *r.PatternDuration, "Duration"
+	}
+	if r.PatternHumanName != nil {
+		return *r.PatternHumanName, "HumanName"
+	}
+	if r.PatternIdentifier != nil {
+		return *r.PatternIdentifier, "Identifier"
+	}
+	if r.PatternMoney != nil {
+		return *r.PatternMoney, "Money"
+	}
+	if r.PatternPeriod != nil {
+		return *r.PatternPeriod, "Period"
+	}
+	if r.PatternQuantity != nil {
+		return *r.PatternQuantity, "Quantity"
+	}
+	if r.PatternRange != nil {
+		return *r.PatternRange, "Range"
+	}
+	if r.PatternRatio != nil {
+		return *r.PatternRatio, "Ratio"
+	}
+	if r.PatternReference != nil {
+		return *r.PatternReference, "Reference"
+	}
+	if r.PatternSampledData != nil {
+		return *r.PatternSampledData, "SampledData"
+	}
+	if r.PatternSignature != nil {
+		return *r.PatternSignature, "Signature"
+	}
+	if r.PatternTiming != nil {
+		return *r.PatternTiming, "Timing"
+	}
+	if r.PatternContactDetail != nil {
+		return *r.PatternContactDetail, "ContactDetail"
+	}
+	if r.PatternContributor != nil {
+		return *r.PatternContributor, "Contributor"
+	}
+	if r.PatternDataRequirement != nil {
+		return *r.PatternDataRequirement, "DataRequirement"
+	}
+	if r.PatternExpression != nil {
+		return *r.PatternExpression, "Expression"
+	}
+	if r.PatternParameterDefinition != nil {
+		return *r.PatternParameterDefinition, "ParameterDefinition"
+	}
+	if r.PatternRelatedArtifact != nil {
+		return *r.PatternRelatedArtifact, "RelatedArtifact"
+	}
+	if r.PatternTriggerDefinition != nil {
+		return *r.PatternTriggerDefinition, "TriggerDefinition"
+	}
+	if r.PatternUsageContext != nil {
+		return *r.PatternUsageContext, "UsageContext"
+	}
+	if r.PatternDosage != nil {
+		return *r.PatternDosage, "Dosage"
+	}
+	if r.PatternMeta != nil {
+		return *r.PatternMeta, "Meta"
+	}
+	return nil, ""
+}
+
+// SetPattern sets the value of ElementDefinition.pattern[x], given as value or pointer, and clears all other types
+func (r *ElementDefinition) SetPattern(value ElementDefinitionPattern) {
+	r.PatternBase64Binary = nil
+	r.PatternBoolean = nil
+	r.PatternCanonical = nil
+	r.PatternCode = nil
+	r.PatternDate = nil
+	r.PatternDateTime = nil
+	r.PatternDecimal = nil
+	r.PatternId = nil
+	r.PatternInstant = nil
+	r.PatternInteger = nil
+	r.PatternMarkdown = nil
+	r.PatternOid = nil
+	r.PatternPositiveInt = nil
+	r.PatternString = nil
+	r.PatternTime = nil
+	r.PatternUnsignedInt = nil
+	r.PatternUri = nil
+	r.PatternUrl = nil
+	r.PatternUuid = nil
+	r.PatternAddress = nil
+	r.PatternAge = nil
+	r.PatternAnnotation = nil
+	r.PatternAttachment = nil
+	r.PatternCodeableConcept = nil
+	r.PatternCoding = nil
+	r.PatternContactPoint = nil
+	r.PatternCount = nil
+	r.PatternDistance = nil
+	r.PatternDuration = nil
+	r.PatternHumanName = nil
+	r.PatternIdentifier = nil
+	r.PatternMoney = nil
+	r.PatternPeriod = nil
+	r.PatternQuantity = nil
+	r.PatternRange = nil
+	r.PatternRatio = nil
+	r.PatternReference = nil
+	r.PatternSampledData = nil
+	r.PatternSignature = nil
+	r.PatternTiming = nil
+	r.PatternContactDetail = nil
+	r.PatternContributor = nil
+	r.PatternDataRequirement = nil
+	r.PatternExpression = nil
+	r.PatternParameterDefinition = nil
+	r.PatternRelatedArtifact = nil
+	r.PatternTriggerDefinition = nil
+	r.PatternUsageContext = nil
+	r.PatternDosage = nil
+	r.PatternMeta = nil
+	switch v := value.(type) {
+	case Base64Binary:
+		primitive := string(v)
+		r.PatternBase64Binary = &primitive
+	case *Base64Binary:
+		r.PatternBase64Binary = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.PatternBoolean = &primitive
+	case *Boolean:
+		r.PatternBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.PatternCanonical = &primitive
+	case *Canonical:
+		r.PatternCanonical = (*string)(v)
+	case Code:
+		primitive := string(v)
+		r.PatternCode = &primitive
+	case *Code:
+		r.PatternCode = (*string)(v)
+	case Date:
+		primitive := string(v)
+		r.PatternDate = &primitive
+	case *Date:
+		r.PatternDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.PatternDateTime = &primitive
+	case *DateTime:
+		r.PatternDateTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.PatternDecimal = &primitive
+	case *Decimal:
+		r.PatternDecimal = (*json.Number)(v)
+	case Id:
+		primitive := string(v)
+		r.PatternId = &primitive
+	case *Id:
+		r.PatternId = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.PatternInstant = &primitive
+	case *Instant:
+		r.PatternInstant = (*string)(v)
+	case Integer:
+		primitive := int(v)
+		r.PatternInteger = &primitive
+	case *Integer:
+		r.PatternInteger = (*int)(v)
+	case Markdown:
+		primitive := string(v)
+		r.PatternMarkdown = &primitive
+	case *Markdown:
+		r.PatternMarkdown = (*string)(v)
+	case Oid:
+		primitive := string(v)
+		r.PatternOid = &primitive
+	case *Oid:
+		r.PatternOid = (*string)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.PatternPositiveInt = &primitive
+	case *PositiveInt:
+		r.PatternPositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.PatternString = &primitive
+	case *String:
+		r.PatternString = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.PatternTime = &primitive
+	case *Time:
+		r.PatternTime = (*string)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.PatternUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.PatternUnsignedInt = (*int)(v)
+	case Uri:
+		primitive := string(v)
+		r.PatternUri = &primitive
+	case *Uri:
+		r.PatternUri = (*string)(v)
+	case Url:
+		primitive := string(v)
+		r.PatternUrl = &primitive
+	case *Url:
+		r.PatternUrl = (*string)(v)
+	case Uuid:
+		primitive := string(v)
+		r.PatternUuid = &primitive
+	case *Uuid:
+		r.PatternUuid = (*string)(v)
+	case Address:
+		r.PatternAddress = &v
+	case *Address:
+		r.PatternAddress = v
+	case Age:
+		r.PatternAge = &v
+	case *Age:
+		r.PatternAge = v
+	case Annotation:
+		r.PatternAnnotation = &v
+	case *Annotation:
+		r.PatternAnnotation = v
+	case Attachment:
+		r.PatternAttachment = &v
+	case *Attachment:
+		r.PatternAttachment = v
+	case CodeableConcept:
+		r.PatternCodeableConcept = &v
+	case *CodeableConcept:
+		r.PatternCodeableConcept = v
+	case Coding:
+		r.PatternCoding = &v
+	case *Coding:
+		r.PatternCoding = v
+	case ContactPoint:
+		r.PatternContactPoint = &v
+	case *ContactPoint:
+		r.PatternContactPoint = v
+	case Count:
+		r.PatternCount = &v
+	case *Count:
+		r.PatternCount = v
+	case Distance:
+		r.PatternDistance = &v
+	case *Distance:
+		r.PatternDistance = v
+	case Duration:
+		r.PatternDuration = &v
+	case *Duration:
+		r.PatternDuration = v
+	case HumanName:
+		r.PatternHumanName = &v
+	case *HumanName:
+		r.PatternHumanName = v
+	case Identifier:
+		r.PatternIdentifier = &v
+	case *Identifier:
+		r.PatternIdentifier = v
+	case Money:
+		r.PatternMoney = &v
+	case *Money:
+		r.PatternMoney = v
+	case Period:
+		r.PatternPeriod = &v
+	case *Period:
+		r.PatternPeriod = v
+	case Quantity:
+		r.PatternQuantity = &v
+	case *Quantity:
+		r.PatternQuantity = v
+	case Range:
+		r.PatternRange = &v
+	case *Range:
+		r.PatternRange = v
+	case Ratio:
+		r.PatternRatio = &v
+	case *Ratio:
+		r.PatternRatio = v
+	case Reference:
+		r.PatternReference = &v
+	case *Reference:
+		r.PatternReference = v
+	case SampledData:
+		r.PatternSampledData = &v
+	case *SampledData:
+		r.PatternSampledData = v
+	case Signature:
+		r.PatternSignature = &v
+	case *Signature:
+		r.PatternSignature = v
+	case Timing:
+		r.PatternTiming = &v
+	case *Timing:
+		r.PatternTiming = v
+	case ContactDetail:
+		r.PatternContactDetail = &v
+	case *ContactDetail:
+		r.PatternContactDetail = v
+	case Contributor:
+		r.PatternContributor = &v
+	case *Contributor:
+		r.PatternContributor = v
+	case DataRequirement:
+		r.PatternDataRequirement = &v
+	case *DataRequirement:
+		r.PatternDataRequirement = v
+	case Expression:
+		r.PatternExpression = &v
+	case *Expression:
+		r.PatternExpression = v
+	case ParameterDefinition:
+		r.PatternParameterDefinition = &v
+	case *ParameterDefinition:
+		r.PatternParameterDefinition = v
+	case RelatedArtifact:
+		r.PatternRelatedArtifact = &v
+	case *RelatedArtifact:
+		r.PatternRelatedArtifact = v
+	case TriggerDefinition:
+		r.PatternTriggerDefinition = &v
+	case *TriggerDefinition:
+		r.PatternTriggerDefinition = v
+	case UsageContext:
+		r.PatternUsageContext = &v
+	case *UsageContext:
+		r.PatternUsageContext = v
+	case Dosage:
+		r.PatternDosage = &v
+	case *Dosage:
+		r.PatternDosage = v
+	case Meta:
+		r.PatternMeta = &v
+	case *Meta:
+		r.PatternMeta = v
+	}
+}
+
 type ElementDefinitionExample struct {
-	Id                       *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	Label                    string              `bson:"label" json:"label"`
-	ValueBase64Binary        string              `bson:"valueBase64Binary" json:"valueBase64Binary"`
-	ValueBoolean             bool                `bson:"valueBoolean" json:"valueBoolean"`
-	ValueCanonical           string              `bson:"valueCanonical" json:"valueCanonical"`
-	ValueCode                string              `bson:"valueCode" json:"valueCode"`
-	ValueDate                string              `bson:"valueDate" json:"valueDate"`
-	ValueDateTime            string              `bson:"valueDateTime" json:"valueDateTime"`
-	ValueDecimal             json.Number         `bson:"valueDecimal" json:"valueDecimal"`
-	ValueId                  string              `bson:"valueId" json:"valueId"`
-	ValueInstant             string              `bson:"valueInstant" json:"valueInstant"`
-	ValueInteger             int                 `bson:"valueInteger" json:"valueInteger"`
-	ValueMarkdown            string              `bson:"valueMarkdown" json:"valueMarkdown"`
-	ValueOid                 string              `bson:"valueOid" json:"valueOid"`
-	ValuePositiveInt         int                 `bson:"valuePositiveInt" json:"valuePositiveInt"`
-	ValueString              string              `bson:"valueString" json:"valueString"`
-	ValueTime                string              `bson:"valueTime" json:"valueTime"`
-	ValueUnsignedInt         int                 `bson:"valueUnsignedInt" json:"valueUnsignedInt"`
-	ValueUri                 string              `bson:"valueUri" json:"valueUri"`
-	ValueUrl                 string              `bson:"valueUrl" json:"valueUrl"`
-	ValueUuid                string              `bson:"valueUuid" json:"valueUuid"`
-	ValueAddress             Address             `bson:"valueAddress" json:"valueAddress"`
-	ValueAge                 Age                 `bson:"valueAge" json:"valueAge"`
-	ValueAnnotation          Annotation          `bson:"valueAnnotation" json:"valueAnnotation"`
-	ValueAttachment          Attachment          `bson:"valueAttachment" json:"valueAttachment"`
-	ValueCodeableConcept     CodeableConcept     `bson:"valueCodeableConcept" json:"valueCodeableConcept"`
-	ValueCoding              Coding              `bson:"valueCoding" json:"valueCoding"`
-	ValueContactPoint        ContactPoint        `bson:"valueContactPoint" json:"valueContactPoint"`
-	ValueCount               Count               `bson:"valueCount" json:"valueCount"`
-	ValueDistance            Distance            `bson:"valueDistance" json:"valueDistance"`
-	ValueDuration            Duration            `bson:"valueDuration" json:"valueDuration"`
-	ValueHumanName           HumanName           `bson:"valueHumanName" json:"valueHumanName"`
-	ValueIdentifier          Identifier          `bson:"valueIdentifier" json:"valueIdentifier"`
-	ValueMoney               Money               `bson:"valueMoney" json:"valueMoney"`
-	ValuePeriod              Period              `bson:"valuePeriod" json:"valuePeriod"`
-	ValueQuantity            Quantity            `bson:"valueQuantity" json:"valueQuantity"`
-	ValueRange               Range               `bson:"valueRange" json:"valueRange"`
-	ValueRatio               Ratio               `bson:"valueRatio" json:"valueRatio"`
-	ValueReference           Reference           `bson:"valueReference" json:"valueReference"`
-	ValueSampledData         SampledData         `bson:"valueSampledData" json:"valueSampledData"`
-	ValueSignature           Signature           `bson:"valueSignature" json:"valueSignature"`
-	ValueTiming              Timing              `bson:"valueTiming" json:"valueTiming"`
-	ValueContactDetail       ContactDetail       `bson:"valueContactDetail" json:"valueContactDetail"`
-	ValueContributor         Contributor         `bson:"valueContributor" json:"valueContributor"`
-	ValueDataRequirement     DataRequirement     `bson:"valueDataRequirement" json:"valueDataRequirement"`
-	ValueExpression          Expression          `bson:"valueExpression" json:"valueExpression"`
-	ValueParameterDefinition ParameterDefinition `bson:"valueParameterDefinition" json:"valueParameterDefinition"`
-	ValueRelatedArtifact     RelatedArtifact     `bson:"valueRelatedArtifact" json:"valueRelatedArtifact"`
-	ValueTriggerDefinition   TriggerDefinition   `bson:"valueTriggerDefinition" json:"valueTriggerDefinition"`
-	ValueUsageContext        UsageContext        `bson:"valueUsageContext" json:"valueUsageContext"`
-	ValueDosage              Dosage              `bson:"valueDosage" json:"valueDosage"`
-	ValueMeta                Meta                `bson:"valueMeta" json:"valueMeta"`
+	Id                       *string              `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	Label                    string               `bson:"label" json:"label"`
+	LabelElement             *Element             `bson:"_label,omitempty" json:"_label,omitempty"`
+	ValueBase64Binary        *string              `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element             `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element             `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string              `bson:"valueCanonical,omitempty" json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element             `bson:"_valueCanonical,omitempty" json:"_valueCanonical,omitempty"`
+	ValueCode                *string              `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement         *Element             `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDate                *string              `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement         *Element             `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime            *string              `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element             `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal             *json.Number         `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element             `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueId                  *string              `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement           *Element             `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueInstant             *string              `bson:"valueInstant,omitempty" json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element             `bson:"_valueInstant,omitempty" json:"_valueInstant,omitempty"`
+	ValueInteger             *int                 `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element             `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string              `bson:"valueMarkdown,omitempty" json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element             `bson:"_valueMarkdown,omitempty" json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string              `bson:"valueOid,omitempty" json:"valueOid,omitempty"`
+	ValueOidElement          *Element             `bson:"_valueOid,omitempty" json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                 `bson:"valuePositiveInt,omitempty" json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element             `bson:"_valuePositiveInt,omitempty" json:"_valuePositiveInt,omitempty"`
+	ValueString              *string              `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element             `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueTime                *string              `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element             `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                 `bson:"valueUnsignedInt,omitempty" json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element             `bson:"_valueUnsignedInt,omitempty" json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string              `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement          *Element             `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueUrl                 *string              `bson:"valueUrl,omitempty" json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element             `bson:"_valueUrl,omitempty" json:"_valueUrl,omitempty"`
+	ValueUuid                *string              `bson:"valueUuid,omitempty" json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element             `bson:"_valueUuid,omitempty" json:"_valueUuid,omitempty"`
+	ValueAddress             *Address             `bson:"valueAddress,omitempty" json:"valueAddress,omitempty"`
+	ValueAge                 *Age                 `bson:"valueAge,omitempty" json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation          `bson:"valueAnnotation,omitempty" json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment          `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *CodeableConcept     `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *Coding              `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint        `bson:"valueContactPoint,omitempty" json:"valueContactPoint,omitempty"`
+	ValueCount               *Count               `bson:"valueCount,omitempty" json:"valueCount,omitempty"`
+	ValueDistance            *Distance            `bson:"valueDistance,omitempty" json:"valueDistance,omitempty"`
+	ValueDuration            *Duration            `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName           `bson:"valueHumanName,omitempty" json:"valueHumanName,omitempty"`
+	ValueIdentifier          *Identifier          `bson:"valueIdentifier,omitempty" json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money               `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
+	ValuePeriod              *Period              `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueQuantity            *Quantity            `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange               *Range               `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio               `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueReference           *Reference           `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData         `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature           `bson:"valueSignature,omitempty" json:"valueSignature,omitempty"`
+	ValueTiming              *Timing              `bson:"valueTiming,omitempty" json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail       `bson:"valueContactDetail,omitempty" json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor         `bson:"valueContributor,omitempty" json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement     `bson:"valueDataRequirement,omitempty" json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression          `bson:"valueExpression,omitempty" json:"valueExpression,omitempty"`
+	ValueParameterDefinition *ParameterDefinition `bson:"valueParameterDefinition,omitempty" json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact     `bson:"valueRelatedArtifact,omitempty" json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition   `bson:"valueTriggerDefinition,omitempty" json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext        `bson:"valueUsageContext,omitempty" json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage              `bson:"valueDosage,omitempty" json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                `bson:"valueMeta,omitempty" json:"valueMeta,omitempty"`
+}
+
+// ElementDefinitionExampleValue is implemented by the types allowed for ElementDefinition.example.value[x]
+type ElementDefinitionExampleValue interface {
+	isElementDefinitionExampleValue()
+}
+
+func (Base64Binary) isElementDefinitionExampleValue()        {}
+func (Boolean) isElementDefinitionExampleValue()             {}
+func (Canonical) isElementDefinitionExampleValue()           {}
+func (Code) isElementDefinitionExampleValue()                {}
+func (Date) isElementDefinitionExampleValue()                {}
+func (DateTime) isElementDefinitionExampleValue()            {}
+func (Decimal) isElementDefinitionExampleValue()             {}
+func (Id) isElementDefinitionExampleValue()                  {}
+func (Instant) isElementDefinitionExampleValue()             {}
+func (Integer) isElementDefinitionExampleValue()             {}
+func (Markdown) isElementDefinitionExampleValue()            {}
+func (Oid) isElementDefinitionExampleValue()                 {}
+func (PositiveInt) isElementDefinitionExampleValue()         {}
+func (String) isElementDefinitionExampleValue()              {}
+func (Time) isElementDefinitionExampleValue()                {}
+func (UnsignedInt) isElementDefinitionExampleValue()         {}
+func (Uri) isElementDefinitionExampleValue()                 {}
+func (Url) isElementDefinitionExampleValue()                 {}
+func (Uuid) isElementDefinitionExampleValue()                {}
+func (Address) isElementDefinitionExampleValue()             {}
+func (Age) isElementDefinitionExampleValue()                 {}
+func (Annotation) isElementDefinitionExampleValue()          {}
+func (Attachment) isElementDefinitionExampleValue()          {}
+func (CodeableConcept) isElementDefinitionExampleValue()     {}
+func (Coding) isElementDefinitionExampleValue()              {}
+func (ContactPoint) isElementDefinitionExampleValue()        {}
+func (Count) isElementDefinitionExampleValue()               {}
+func (Distance) isElementDefinitionExampleValue()            {}
+func (Duration) isElementDefinitionExampleValue()            {}
+func (HumanName) isElementDefinitionExampleValue()           {}
+func (Identifier) isElementDefinitionExampleValue()          {}
+func (Money) isElementDefinitionExampleValue()               {}
+func (Period) isElementDefinitionExampleValue()              {}
+func (Quantity) isElementDefinitionExampleValue()            {}
+func (Range) isElementDefinitionExampleValue()               {}
+func (Ratio) isElementDefinitionExampleValue()               {}
+func (Reference) isElementDefinitionExampleValue()           {}
+func (SampledData) isElementDefinitionExampleValue()         {}
+func (Signature) isElementDefinitionExampleValue()           {}
+func (Timing) isElementDefinitionExampleValue()              {}
+func (ContactDetail) isElementDefinitionExampleValue()       {}
+func (Contributor) isElementDefinitionExampleValue()         {}
+func (DataRequirement) isElementDefinitionExampleValue()     {}
+func (Expression) isElementDefinitionExampleValue()          {}
+func (ParameterDefinition) isElementDefinitionExampleValue() {}
+func (RelatedArtifact) isElementDefinitionExampleValue()     {}
+func (TriggerDefinition) isElementDefinitionExampleValue()   {}
+func (UsageContext) isElementDefinitionExampleValue()        {}
+func (Dosage) isElementDefinitionExampleValue()              {}
+func (Meta) isElementDefinitionExampleValue()                {}
+
+// Value returns the value of ElementDefinition.example.value[x] together with its FHIR type code or nil if no value is set
+func (r ElementDefinitionExample) Value() (ElementDefinitionExampleValue, string) {
+	if r.ValueBase64Binary != nil {
+		return Base64Binary(*r.ValueBase64Binary), "base64Binary"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueCanonical != nil {
+		return Canonical(*r.ValueCanonical), "canonical"
+	}
+	if r.ValueCode != nil {
+		return Code(*r.ValueCode), "code"
+	}
+	if r.ValueDate != nil {
+		return Date(*r.ValueDate), "date"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValueDecimal != nil {
+		return Decimal(*r.ValueDecimal), "decimal"
+	}
+	if r.ValueId != nil {
+		return Id(*r.ValueId), "id"
+	}
+	if r.ValueInstant != nil {
+		return Instant(*r.ValueInstant), "instant"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueMarkdown != nil {
+		return Markdown(*r.ValueMarkdown), "markdown"
+	}
+	if r.ValueOid != nil {
+		return Oid(*r.ValueOid), "oid"
+	}
+	if r.ValuePositiveInt != nil {
+		return PositiveInt(*r.ValuePositiveInt), "positiveInt"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueTime != nil {
+		return Time(*r.ValueTime), "time"
+	}
+	if r.ValueUnsignedInt != nil {
+		return UnsignedInt(*r.ValueUnsignedInt), "unsignedInt"
+	}
+	if r.ValueUri != nil {
+		return Uri(*r.ValueUri), "uri"
+	}
+	if r.ValueUrl != nil {
+		return Url(*r.ValueUrl), "url"
+	}
+	if r.ValueUuid != nil {
+		return Uuid(*r.ValueUuid), "uuid"
+	}
+	if r.ValueAddress != nil {
+		return *r.ValueAddress, "Address"
+	}
+	if r.ValueAge != nil {
+		return *r.ValueAge, "Age"
+	}
+	if r.ValueAnnotation != nil {
+		return *r.ValueAnnotation, "Annotation"
+	}
+	if r.ValueAttachment != nil {
+		return *r.ValueAttachment, "Attachment"
+	}
+	if r.ValueCodeableConcept != nil {
+		return *r.ValueCodeableConcept, "CodeableConcept"
+	}
+	if r.ValueCoding != nil {
+		return *r.ValueCoding, "Coding"
+	}
+	if r.ValueContactPoint != nil {
+		return *r.ValueContactPoint, "ContactPoint"
+	}
+	if r.ValueCount != nil {
+		return *r.ValueCount, "Count"
+	}
+	if r.ValueDistance != nil {
+		return *r.ValueDistance, "Distance"
+	}
+	if r.ValueDuration != nil {
+		return *r.ValueDuration, "Duration"
+	}
+	if r.ValueHumanName != nil {
+		return *r.ValueHumanName, "HumanName"
+	}
+	if r.ValueIdentifier != nil {
+		return *r.ValueIdentifier, "Identifier"
+	}
+	if r.ValueMoney != nil {
+		return *r.ValueMoney, "Money"
+	}
+	if r.ValuePeriod != nil {
+		return *r.ValuePeriod, "Period"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueRange != nil {
+		return *r.ValueRange, "Range"
+	}
+	if r.ValueRatio != nil {
+		return *r.ValueRatio, "Ratio"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	if r.ValueSampledData != nil {
+		return *r.ValueSampledData, "SampledData"
+	}
+	if r.ValueSignature != nil {
+		return *r.ValueSignature, "Signature"
+	}
+	if r.ValueTiming != nil {
+		return *r.ValueTiming, "Timing"
+	}
+	if r.ValueContactDetail != nil {
+		return *r.ValueContactDetail, "ContactDetail"
+	}
+	if r.ValueContributor != nil {
+		return *r.ValueContributor, "Contributor"
+	}
+	if r.ValueDataRequirement != nil {
+		return *r.ValueDataRequirement, "DataRequirement"
+	}
+	if r.ValueExpression != nil {
+		return *r.ValueExpression, "Expression"
+	}
+	if r.ValueParameterDefinition != nil {
+		return *r.ValueParameterDefinition, "ParameterDefinition"
+	}
+	if r.ValueRelatedArtifact != nil {
+		return *r.ValueRelatedArtifact, "RelatedArtifact"
+	}
+	if r.ValueTriggerDefinition != nil {
+		return *r.ValueTriggerDefinition, "TriggerDefinition"
+	}
+	if r.ValueUsageContext != nil {
+		return *r.ValueUsageContext, "UsageContext"
+	}
+	if r.ValueDosage != nil {
+		return *r.ValueDosage, "Dosage"
+	}
+	if r.ValueMeta != nil {
+		return *r.ValueMeta, "Meta"
+	}
+	return nil, ""
 }
+
+// SetValue sets the value of ElementDefinition.example.value[x], given as value or pointer, and clears all other types
+func (r *ElementDefinitionExample) SetValue(value ElementDefinitionExampleValue) {
+	r.ValueBase64Binary = nil
+	r.ValueBoolean = nil
+	r.ValueCanonical = nil
+	r.ValueCode = nil
+	r.ValueDate = nil
+	r.ValueDateTime = nil
+	r.ValueDecimal = nil
+	r.ValueId = nil
+	r.ValueInstant = nil
+	r.ValueInteger = nil
+	r.ValueMarkdown = nil
+	r.ValueOid = nil
+	r.ValuePositiveInt = nil
+	r.ValueString = nil
+	r.ValueTime = nil
+	r.ValueUnsignedInt = nil
+	r.ValueUri = nil
+	r.ValueUrl = nil
+	r.ValueUuid = nil
+	r.ValueAddress = nil
+	r.ValueAge = nil
+	r.ValueAnnotation = nil
+	r.ValueAttachment = nil
+	r.ValueCodeableConcept = nil
+	r.ValueCoding = nil
+	r.ValueContactPoint = nil
+	r.ValueCount = nil
+	r.ValueDistance = nil
+	r.ValueDuration = nil
+	r.ValueHumanName = nil
+	r.ValueIdentifier = nil
+	r.ValueMoney = nil
+	r.ValuePeriod = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueReference = nil
+	r.ValueSampledData = nil
+	r.ValueSignature = nil
+	r.ValueTiming = nil
+	r.ValueContactDetail = nil
+	r.ValueContributor = nil
+	r.ValueDataRequirement = nil
+	r.ValueExpression = nil
+	r.ValueParameterDefinition = nil
+	r.ValueRelatedArtifact = nil
+	r.ValueTriggerDefinition = nil
+	r.ValueUsageContext = nil
+	r.ValueDosage = nil
+	r.ValueMeta = nil
+	switch v := value.(type) {
+	case Base64Binary:
+		primitive := string(v)
+		r.ValueBase64Binary = &primitive
+	case *Base64Binary:
+		r.ValueBase64Binary = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.ValueCanonical = &primitive
+	case *Canonical:
+		r.ValueCanonical = (*string)(v)
+	case Code:
+		primitive := string(v)
+		r.ValueCode = &primitive
+	case *Code:
+		r.ValueCode = (*string)(v)
+	case Date:
+		primitive := string(v)
+		r.ValueDate = &primitive
+	case *Date:
+		r.ValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.ValueDecimal = &primitive
+	case *Decimal:
+		r.ValueDecimal = (*json.Number)(v)
+	case Id:
+		primitive := string(v)
+		r.ValueId = &primitive
+	case *Id:
+		r.ValueId = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.ValueInstant = &primitive
+	case *Instant:
+		r.ValueInstant = (*string)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Markdown:
+		primitive := string(v)
+		r.ValueMarkdown = &primitive
+	case *Markdown:
+		r.ValueMarkdown = (*string)(v)
+	case Oid:
+		primitive := string(v)
+		r.ValueOid = &primitive
+	case *Oid:
+		r.ValueOid = (*string)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.ValuePositiveInt = &primitive
+	case *PositiveInt:
+		r.ValuePositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.ValueTime = &primitive
+	case *Time:
+		r.ValueTime = (*string)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.ValueUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.ValueUnsignedInt = (*int)(v)
+	case Uri:
+		primitive := string(v)
+		r.ValueUri = &primitive
+	case *Uri:
+		r.ValueUri = (*string)(v)
+	case Url:
+		primitive := string(v)
+		r.ValueUrl = &primitive
+	case *Url:
+		r.ValueUrl = (*string)(v)
+	case Uuid:
+		primitive := string(v)
+		r.ValueUuid = &primitive
+	case *Uuid:
+		r.ValueUuid = (*string)(v)
+	case Address:
+		r.ValueAddress = &v
+	case *Address:
+		r.ValueAddress = v
+	case Age:
+		r.ValueAge = &v
+	case *Age:
+		r.ValueAge = v
+	case Annotation:
+		r.ValueAnnotation = &v
+	case *Annotation:
+		r.ValueAnnotation = v
+	case Attachment:
+		r.ValueAttachment = &v
+	case *Attachment:
+		r.ValueAttachment = v
+	case CodeableConcept:
+		r.ValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.ValueCodeableConcept = v
+	case Coding:
+		r.ValueCoding = &v
+	case *Coding:
+		r.ValueCoding = v
+	case ContactPoint:
+		r.ValueContactPoint = &v
+	case *ContactPoint:
+		r.ValueContactPoint = v
+	case Count:
+		r.ValueCount = &v
+	case *Count:
+		r.ValueCount = v
+	case Distance:
+		r.ValueDistance = &v
+	case *Distance:
+		r.ValueDistance = v
+	case Duration:
+		r.ValueDuration = &v
+	case *Duration:
+		r.ValueDuration = v
+	case HumanName:
+		r.ValueHumanName = &v
+	case *HumanName:
+		r.ValueHumanName = v
+	case Identifier:
+		r.ValueIdentifier = &v
+	case *Identifier:
+		r.ValueIdentifier = v
+	case Money:
+		r.ValueMoney = &v
+	case *Money:
+		r.ValueMoney = v
+	case Period:
+		r.ValuePeriod = &v
+	case *Period:
+		r.ValuePeriod = v
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Range:
+		r.ValueRange = &v
+	case *Range:
+		r.ValueRange = v
+	case Ratio:
+		r.ValueRatio = &v
+	case *Ratio:
+		r.ValueRatio = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	case SampledData:
+		r.ValueSampledData = &v
+	case *SampledData:
+		r.ValueSampledData = v
+	case Signature:
+		r.ValueSignature = &v
+	case *Signature:
+		r.ValueSignature = v
+	case Timing:
+		r.ValueTiming = &v
+	case *Timing:
+		r.ValueTiming = v
+	case ContactDetail:
+		r.ValueContactDetail = &v
+	case *ContactDetail:
+		r.ValueContactDetail = v
+	case Contributor:
+		r.ValueContributor = &v
+	case *Contributor:
+		r.ValueContributor = v
+	case DataRequirement:
+		r.ValueDataRequirement = &v
+	case *DataRequirement:
+		r.ValueDataRequirement = v
+	case Expression:
+		r.ValueExpression = &v
+	case *Expression:
+		r.ValueExpression = v
+	case ParameterDefinition:
+		r.ValueParameterDefinition = &v
+	case *ParameterDefinition:
+		r.ValueParameterDefinition = v
+	case RelatedArtifact:
+		r.ValueRelatedArtifact = &v
+	case *RelatedArtifact:
+		r.ValueRelatedArtifact = v
+	case TriggerDefinition:
+		r.ValueTriggerDefinition = &v
+	case *TriggerDefinition:
+		r.ValueTriggerDefinition = v
+	case UsageContext:
+		r.ValueUsageContext = &v
+	case *UsageContext:
+		r.ValueUsageContext = v
+	case Dosage:
+		r.ValueDosage = &v
+	case *Dosage:
+		r.ValueDosage = v
+	case Meta:
+		r.ValueMeta = &v
+	case *Meta:
+		r.ValueMeta = v
+	}
+}
+
+// ElementDefinitionMinValue is implemented by the types allowed for ElementDefinition.minValue[x]
+type ElementDefinitionMinValue interface {
+	isElementDefinitionMinValue()
+}
+
+func (Date) isElementDefinitionMinValue()        {}
+func (DateTime) isElementDefinitionMinValue()    {}
+func (Instant) isElementDefinitionMinValue()     {}
+func (Time) isElementDefinitionMinValue()        {}
+func (Decimal) isElementDefinitionMinValue()     {}
+func (Integer) isElementDefinitionMinValue()     {}
+func (PositiveInt) isElementDefinitionMinValue() {}
+func (UnsignedInt) isElementDefinitionMinValue() {}
+func (Quantity) isElementDefinitionMinValue()    {}
+
+// MinValue returns the value of ElementDefinition.minValue[x] together with its FHIR type code or nil if no value is set
+func (r ElementDefinition) MinValue() (ElementDefinitionMinValue, string) {
+	if r.MinValueDate != nil {
+		return Date(*r.MinValueDate), "date"
+	}
+	if r.MinValueDateTime != nil {
+		return DateTime(*r.MinValueDateTime), "dateTime"
+	}
+	if r.MinValueInstant != nil {
+		return Instant(*r.MinValueInstant), "instant"
+	}
+	if r.MinValueTime != nil {
+		return Time(*r.MinValueTime), "time"
+	}
+	if r.MinValueDecimal != nil {
+		return Decimal(*r.MinValueDecimal), "decimal"
+	}
+	if r.MinValueInteger != nil {
+		return Integer(*r.MinValueInteger), "integer"
+	}
+	if r.MinValuePositiveInt != nil {
+		return PositiveInt(*r.MinValuePositiveInt), "positiveInt"
+	}
+	if r.MinValueUnsignedInt != nil {
+		return UnsignedInt(*r.MinValueUnsignedInt), "unsignedInt"
+	}
+	if r.MinValueQuantity != nil {
+		return *r.MinValueQuantity, "Quantity"
+	}
+	return nil, ""
+}
+
+// SetMinValue sets the value of ElementDefinition.minValue[x], given as value or pointer, and clears all other types
+func (r *ElementDefinition) SetMinValue(value ElementDefinitionMinValue) {
+	r.MinValueDate = nil
+	r.MinValueDateTime = nil
+	r.MinValueInstant = nil
+	r.MinValueTime = nil
+	r.MinValueDecimal = nil
+	r.MinValueInteger = nil
+	r.MinValuePositiveInt = nil
+	r.MinValueUnsignedInt = nil
+	r.MinValueQuantity = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.MinValueDate = &primitive
+	case *Date:
+		r.MinValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.MinValueDateTime = &primitive
+	case *DateTime:
+		r.MinValueDateTime = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.MinValueInstant = &primitive
+	case *Instant:
+		r.MinValueInstant = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.MinValueTime = &primitive
+	case *Time:
+		r.MinValueTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.MinValueDecimal = &primitive
+	case *Decimal:
+		r.MinValueDecimal = (*json.Number)(v)
+	case Integer:
+		primitive := int(v)
+		r.MinValueInteger = &primitive
+	case *Integer:
+		r.MinValueInteger = (*int)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.MinValuePositiveInt = &primitive
+	case *PositiveInt:
+		r.MinValuePositiveInt = (*int)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.MinValueUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.MinValueUnsignedInt = (*int)(v)
+	case Quantity:
+		r.MinValueQuantity = &v
+	case *Quantity:
+		r.MinValueQuantity = v
+	}
+}
+
+// ElementDefinitionMaxValue is implemented by the types allowed for ElementDefinition.maxValue[x]
+type ElementDefinitionMaxValue interface {
+	isElementDefinitionMaxValue()
+}
+
+func (Date) isElementDefinitionMaxValue()        {}
+func (DateTime) isElementDefinitionMaxValue()    {}
+func (Instant) isElementDefinitionMaxValue()     {}
+func (Time) isElementDefinitionMaxValue()        {}
+func (Decimal) isElementDefinitionMaxValue()     {}
+func (Integer) isElementDefinitionMaxValue()     {}
+func (PositiveInt) isElementDefinitionMaxValue() {}
+func (UnsignedInt) isElementDefinitionMaxValue() {}
+func (Quantity) isElementDefinitionMaxValue()    {}
+
+// MaxValue returns the value of ElementDefinition.maxValue[x] together with its FHIR type code or nil if no value is set
+func (r ElementDefinition) MaxValue() (ElementDefinitionMaxValue, string) {
+	if r.MaxValueDate != nil {
+		return Date(*r.MaxValueDate), "date"
+	}
+	if r.MaxValueDateTime != nil {
+		return DateTime(*r.MaxValueDateTime), "dateTime"
+	}
+	if r.MaxValueInstant != nil {
+		return Instant(*r.MaxValueInstant), "instant"
+	}
+	if r.MaxValueTime != nil {
+		return Time(*r.MaxValueTime), "time"
+	}
+	if r.MaxValueDecimal != nil {
+		return Decimal(*r.MaxValueDecimal), "decimal"
+	}
+	if r.MaxValueInteger != nil {
+		return Integer(*r.MaxValueInteger), "integer"
+	}
+	if r.MaxValuePositiveInt != nil {
+		return PositiveInt(*r.MaxValuePositiveInt), "positiveInt"
+	}
+	if r.MaxValueUnsignedInt != nil {
+		return UnsignedInt(*r.MaxValueUnsignedInt), "unsignedInt"
+	}
+	if r.MaxValueQuantity != nil {
+		return *r.MaxValueQuantity, "Quantity"
+	}
+	return nil, ""
+}
+
+// SetMaxValue sets the value of ElementDefinition.maxValue[x], given as value or pointer, and clears all other types
+func (r *ElementDefinition) SetMaxValue(value ElementDefinitionMaxValue) {
+	r.MaxValueDate = nil
+	r.MaxValueDateTime = nil
+	r.MaxValueInstant = nil
+	r.MaxValueTime = nil
+	r.MaxValueDecimal = nil
+	r.MaxValueInteger = nil
+	r.MaxValuePositiveInt = nil
+	r.MaxValueUnsignedInt = nil
+	r.MaxValueQuantity = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.MaxValueDate = &primitive
+	case *Date:
+		r.MaxValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.MaxValueDateTime = &primitive
+	case *DateTime:
+		r.MaxValueDateTime = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.MaxValueInstant = &primitive
+	case *Instant:
+		r.MaxValueInstant = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.MaxValueTime = &primitive
+	case *Time:
+		r.MaxValueTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.MaxValueDecimal = &primitive
+	case *Decimal:
+		r.MaxValueDecimal = (*json.Number)(v)
+	case Integer:
+		primitive := int(v)
+		r.MaxValueInteger = &primitive
+	case *Integer:
+		r.MaxValueInteger = (*int)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.MaxValuePositiveInt = &primitive
+	case *PositiveInt:
+		r.MaxValuePositiveInt = (*int)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.MaxValueUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.MaxValueUnsignedInt = (*int)(v)
+	case Quantity:
+		r.MaxValueQuantity = &v
+	case *Quantity:
+		r.MaxValueQuantity = v
+	}
+}
+
 type ElementDefinitionConstraint struct {
-	Id           *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Extension    []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	Key          string             `bson:"key" json:"key"`
-	Requirements *string            `bson:"requirements,omitempty" json:"requirements,omitempty"`
-	Severity     ConstraintSeverity `bson:"severity" json:"severity"`
-	Human        string             `bson:"human" json:"human"`
-	Expression   *string            `bson:"expression,omitempty" json:"expression,omitempty"`
-	Xpath        *string            `bson:"xpath,omitempty" json:"xpath,omitempty"`
-	Source       *string            `bson:"source,omitempty" json:"source,omitempty"`
+	Id                  *string            `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	Key                 string             `bson:"key" json:"key"`
+	KeyElement          *Element           `bson:"_key,omitempty" json:"_key,omitempty"`
+	Requirements        *string            `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	RequirementsElement *Element           `bson:"_requirements,omitempty" json:"_requirements,omitempty"`
+	Severity            ConstraintSeverity `bson:"severity" json:"severity"`
+	SeverityElement     *Element           `bson:"_severity,omitempty" json:"_severity,omitempty"`
+	Human               string             `bson:"human" json:"human"`
+	HumanElement        *Element           `bson:"_human,omitempty" json:"_human,omitempty"`
+	Expression          *string            `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement   *Element           `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	Xpath               *string            `bson:"xpath,omitempty" json:"xpath,omitempty"`
+	XpathElement        *Element           `bson:"_xpath,omitempty" json:"_xpath,omitempty"`
+	Source              *string            `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement       *Element           `bson:"_source,omitempty" json:"_source,omitempty"`
 }
 type ElementDefinitionBinding struct {
-	Id          *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	Strength    BindingStrength `bson:"strength" json:"strength"`
-	Description *string         `bson:"description,omitempty" json:"description,omitempty"`
-	ValueSet    *string         `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	Id                 *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	Strength           BindingStrength `bson:"strength" json:"strength"`
+	StrengthElement    *Element        `bson:"_strength,omitempty" json:"_strength,omitempty"`
+	Description        *string         `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element        `bson:"_description,omitempty" json:"_description,omitempty"`
+	ValueSet           *string         `bson:"valueSet,omitempty" json:"valueSet,omitempty"`
+	ValueSetElement    *Element        `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
 }
 type ElementDefinitionMapping struct {
-	Id        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Identity  string      `bson:"identity" json:"identity"`
-	Language  *string     `bson:"language,omitempty" json:"language,omitempty"`
-	Map       string      `bson:"map" json:"map"`
-	Comment   *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	Id              *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension       []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Identity        string      `bson:"identity" json:"identity"`
+	IdentityElement *Element    `bson:"_identity,omitempty" json:"_identity,omitempty"`
+	Language        *string     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement *Element    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Map             string      `bson:"map" json:"map"`
+	MapElement      *Element    `bson:"_map,omitempty" json:"_map,omitempty"`
+	Comment         *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement  *Element    `bson:"_comment,omitempty" json:"_comment,omitempty"`
+}
+
+// MarshalJSON marshals the given ElementDefinition as JSON into a byte slice
+func (r ElementDefinition) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ElementDefinition as JSON object with the members in the order of the fields
+func (r ElementDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("path")
+	e.string(r.Path)
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	if len(r.Representation) > 0 {
+		e.key("representation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Representation {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RepresentationElement) > 0 {
+		e.key("_representation")
+		e.elements(r.RepresentationElement, len(r.Representation))
+	}
+	if r.SliceName != nil {
+		e.key("sliceName")
+		e.string(*r.SliceName)
+	}
+	if r.SliceNameElement != nil {
+		e.key("_sliceName")
+		r.SliceNameElement.appendJSON(e)
+	}
+	if r.SliceIsConstraining != nil {
+		e.key("sliceIsConstraining")
+		e.bool(*r.SliceIsConstraining)
+	}
+	if r.SliceIsConstrainingElement != nil {
+		e.key("_sliceIsConstraining")
+		r.SliceIsConstrainingElement.appendJSON(e)
+	}
+	if r.Label != nil {
+		e.key("label")
+		e.string(*r.Label)
+	}
+	if r.LabelElement != nil {
+		e.key("_label")
+		r.LabelElement.appendJSON(e)
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Slicing != nil {
+		e.key("slicing")
+		r.Slicing.appendJSON(e)
+	}
+	if r.Short != nil {
+		e.key("short")
+		e.string(*r.Short)
+	}
+	if r.ShortElement != nil {
+		e.key("_short")
+		r.ShortElement.appendJSON(e)
+	}
+	if r.Definition != nil {
+		e.key("definition")
+		e.string(*r.Definition)
+	}
+	if r.DefinitionElement != nil {
+		e.key("_definition")
+		r.DefinitionElement.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	if r.Requirements != nil {
+		e.key("requirements")
+		e.string(*r.Requirements)
+	}
+	if r.RequirementsElement != nil {
+		e.key("_requirements")
+		r.RequirementsElement.appendJSON(e)
+	}
+	if len(r.Alias) > 0 {
+		e.key("alias")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Alias {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AliasElement) > 0 {
+		e.key("_alias")
+		e.elements(r.AliasElement, len(r.Alias))
+	}
+	if r.Min != nil {
+		e.key("min")
+		e.int(*r.Min)
+	}
+	if r.MinElement != nil {
+		e.key("_min")
+		r.MinElement.appendJSON(e)
+	}
+	if r.Max != nil {
+		e.key("max")
+		e.string(*r.Max)
+	}
+	if r.MaxElement != nil {
+		e.key("_max")
+		r.MaxElement.appendJSON(e)
+	}
+	if r.Base != nil {
+		e.key("base")
+		r.Base.appendJSON(e)
+	}
+	if r.ContentReference != nil {
+		e.key("contentReference")
+		e.string(*r.ContentReference)
+	}
+	if r.ContentReferenceElement != nil {
+		e.key("_contentReference")
+		r.ContentReferenceElement.appendJSON(e)
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DefaultValueBase64Binary != nil {
+		e.key("defaultValueBase64Binary")
+		e.string(*r.DefaultValueBase64Binary)
+	}
+	if r.DefaultValueBase64BinaryElement != nil {
+		e.key("_defaultValueBase64Binary")
+		r.DefaultValueBase64BinaryElement.appendJSON(e)
+	}
+	if r.DefaultValueBoolean != nil {
+		e.key("defaultValueBoolean")
+		e.bool(*r.DefaultValueBoolean)
+	}
+	if r.DefaultValueBooleanElement != nil {
+		e.key("_defaultValueBoolean")
+		r.DefaultValueBooleanElement.appendJSON(e)
+	}
+	if r.DefaultValueCanonical != nil {
+		e.key("defaultValueCanonical")
+		e.string(*r.DefaultValueCanonical)
+	}
+	if r.DefaultValueCanonicalElement != nil {
+		e.key("_defaultValueCanonical")
+		r.DefaultValueCanonicalElement.appendJSON(e)
+	}
+	if r.DefaultValueCode != nil {
+		e.key("defaultValueCode")
+		e.string(*r.DefaultValueCode)
+	}
+	if r.DefaultValueCodeElement != nil {
+		e.key("_defaultValueCode")
+		r.DefaultValueCodeElement.appendJSON(e)
+	}
+	if r.DefaultValueDate != nil {
+		e.key("defaultValueDate")
+		e.string(*r.DefaultValueDate)
+	}
+	if r.DefaultValueDateElement != nil {
+		e.key("_defaultValueDate")
+		r.DefaultValueDateElement.appendJSON(e)
+	}
+	if r.DefaultValueDateTime != nil {
+		e.key("defaultValueDateTime")
+		e.string(*r.DefaultValueDateTime)
+	}
+	if r.DefaultValueDateTimeElement != nil {
+		e.key("_defaultValueDateTime")
+		r.DefaultValueDateTimeElement.appendJSON(e)
+	}
+	if r.DefaultValueDecimal != nil {
+		e.key("defaultValueDecimal")
+		e.number(*r.DefaultValueDecimal)
+	}
+	if r.DefaultValueDecimalElement != nil {
+		e.key("_defaultValueDecimal")
+		r.DefaultValueDecimalElement.appendJSON(e)
+	}
+	if r.DefaultValueId != nil {
+		e.key("defaultValueId")
+		e.string(*r.DefaultValueId)
+	}
+	if r.DefaultValueIdElement != nil {
+		e.key("_defaultValueId")
+		r.DefaultValueIdElement.appendJSON(e)
+	}
+	if r.DefaultValueInstant != nil {
+		e.key("defaultValueInstant")
+		e.string(*r.DefaultValueInstant)
+	}
+	if r.DefaultValueInstantElement != nil {
+		e.key("_defaultValueInstant")
+		r.DefaultValueInstantElement.appendJSON(e)
+	}
+	if r.DefaultValueInteger != nil {
+		e.key("defaultValueInteger")
+		e.int(*r.DefaultValueInteger)
+	}
+	if r.DefaultValueIntegerElement != nil {
+		e.key("_defaultValueInteger")
+		r.DefaultValueIntegerElement.appendJSON(e)
+	}
+	if r.DefaultValueMarkdown != nil {
+		e.key("defaultValueMarkdown")
+		e.string(*r.DefaultValueMarkdown)
+	}
+	if r.DefaultValueMarkdownElement != nil {
+		e.key("_defaultValueMarkdown")
+		r.DefaultValueMarkdownElement.appendJSON(e)
+	}
+	if r.DefaultValueOid != nil {
+		e.key("defaultValueOid")
+		e.string(*r.DefaultValueOid)
+	}
+	if r.DefaultValueOidElement != nil {
+		e.key("_defaultValueOid")
+		r.DefaultValueOidElement.appendJSON(e)
+	}
+	if r.DefaultValuePositiveInt != nil {
+		e.key("defaultValuePositiveInt")
+		e.int(*r.DefaultValuePositiveInt)
+	}
+	if r.DefaultValuePositiveIntElement != nil {
+		e.key("_defaultValuePositiveInt")
+		r.DefaultValuePositiveIntElement.appendJSON(e)
+	}
+	if r.DefaultValueString != nil {
+		e.key("defaultValueString")
+		e.string(*r.DefaultValueString)
+	}
+	if r.DefaultValueStringElement != nil {
+		e.key("_defaultValueString")
+		r.DefaultValueStringElement.appendJSON(e)
+	}
+	if r.DefaultValueTime != nil {
+		e.key("defaultValueTime")
+		e.string(*r.DefaultValueTime)
+	}
+	if r.DefaultValueTimeElement != nil {
+		e.key("_defaultValueTime")
+		r.DefaultValueTimeElement.appendJSON(e)
+	}
+	if r.DefaultValueUnsignedInt != nil {
+		e.key("defaultValueUnsignedInt")
+		e.int(*r.DefaultValueUnsignedInt)
+	}
+	if r.DefaultValueUnsignedIntElement != nil {
+		e.key("_defaultValueUnsignedInt")
+		r.DefaultValueUnsignedIntElement.appendJSON(e)
+	}
+	if r.DefaultValueUri != nil {
+		e.key("defaultValueUri")
+		e.string(*r.DefaultValueUri)
+	}
+	if r.DefaultValueUriElement != nil {
+		e.key("_defaultValueUri")
+		r.DefaultValueUriElement.appendJSON(e)
+	}
+	if r.DefaultValueUrl != nil {
+		e.key("defaultValueUrl")
+		e.string(*r.DefaultValueUrl)
+	}
+	if r.DefaultValueUrlElement != nil {
+		e.key("_defaultValueUrl")
+		r.DefaultValueUrlElement.appendJSON(e)
+	}
+	if r.DefaultValueUuid != nil {
+		e.key("defaultValueUuid")
+		e.string(*r.DefaultValueUuid)
+	}
+	if r.DefaultValueUuidElement != nil {
+		e.key("_defaultValueUuid")
+		r.DefaultValueUuidElement.appendJSON(e)
+	}
+	if r.DefaultValueAddress != nil {
+		e.key("defaultValueAddress")
+		r.DefaultValueAddress.appendJSON(e)
+	}
+	if r.DefaultValueAge != nil {
+		e.key("defaultValueAge")
+		r.DefaultValueAge.appendJSON(e)
+	}
+	if r.DefaultValueAnnotation != nil {
+		e.key("defaultValueAnnotation")
+		r.DefaultValueAnnotation.appendJSON(e)
+	}
+	if r.DefaultValueAttachment != nil {
+		e.key("defaultValueAttachment")
+		r.DefaultValueAttachment.appendJSON(e)
+	}
+	if r.DefaultValueCodeableConcept != nil {
+		e.key("defaultValueCodeableConcept")
+		r.DefaultValueCodeableConcept.appendJSON(e)
+	}
+	if r.DefaultValueCoding != nil {
+		e.key("defaultValueCoding")
+		r.DefaultValueCoding.appendJSON(e)
+	}
+	if r.DefaultValueContactPoint != nil {
+		e.key("defaultValueContactPoint")
+		r.DefaultValueContactPoint.appendJSON(e)
+	}
+	if r.DefaultValueCount != nil {
+		e.key("defaultValueCount")
+		r.DefaultValueCount.appendJSON(e)
+	}
+	if r.DefaultValueDistance != nil {
+		e.key("defaultValueDistance")
+		r.DefaultValueDistance.appendJSON(e)
+	}
+	if r.DefaultValueDuration != nil {
+		e.key("defaultValueDuration")
+		r.DefaultValueDuration.appendJSON(e)
+	}
+	if r.DefaultValueHumanName != nil {
+		e.key("defaultValueHumanName")
+		r.DefaultValueHumanName.appendJSON(e)
+	}
+	if r.DefaultValueIdentifier != nil {
+		e.key("defaultValueIdentifier")
+		r.DefaultValueIdentifier.appendJSON(e)
+	}
+	if r.DefaultValueMoney != nil {
+		e.key("defaultValueMoney")
+		r.DefaultValueMoney.appendJSON(e)
+	}
+	if r.DefaultValuePeriod != nil {
+		e.key("defaultValuePeriod")
+		r.DefaultValuePeriod.appendJSON(e)
+	}
+	if r.DefaultValueQuantity != nil {
+		e.key("defaultValueQuantity")
+		r.DefaultValueQuantity.appendJSON(e)
+	}
+	if r.DefaultValueRange != nil {
+		e.key("defaultValueRange")
+		r.DefaultValueRange.appendJSON(e)
+	}
+	if r.DefaultValueRatio != nil {
+		e.key("defaultValueRatio")
+		r.DefaultValueRatio.appendJSON(e)
+	}
+	if r.DefaultValueReference != nil {
+		e.key("defaultValueReference")
+		r.DefaultValueReference.appendJSON(e)
+	}
+	if r.DefaultValueSampledData != nil {
+		e.key("defaultValueSampledData")
+		r.DefaultValueSampledData.appendJSON(e)
+	}
+	if r.DefaultValueSignature != nil {
+		e.key("defaultValueSignature")
+		r.DefaultValueSignature.appendJSON(e)
+	}
+	if r.DefaultValueTiming != nil {
+		e.key("defaultValueTiming")
+		r.DefaultValueTiming.appendJSON(e)
+	}
+	if r.DefaultValueContactDetail != nil {
+		e.key("defaultValueContactDetail")
+		r.DefaultValueContactDetail.appendJSON(e)
+	}
+	if r.DefaultValueContributor != nil {
+		e.key("defaultValueContributor")
+		r.DefaultValueContributor.appendJSON(e)
+	}
+	if r.DefaultValueDataRequirement != nil {
+		e.key("defaultValueDataRequirement")
+		r.DefaultValueDataRequirement.appendJSON(e)
+	}
+	if r.DefaultValueExpression != nil {
+		e.key("defaultValueExpression")
+		r.DefaultValueExpression.appendJSON(e)
+	}
+	if r.DefaultValueParameterDefinition != nil {
+		e.key("defaultValueParameterDefinition")
+		r.DefaultValueParameterDefinition.appendJSON(e)
+	}
+	if r.DefaultValueRelatedArtifact != nil {
+		e.key("defaultValueRelatedArtifact")
+		r.DefaultValueRelatedArtifact.appendJSON(e)
+	}
+	if r.DefaultValueTriggerDefinition != nil {
+		e.key("defaultValueTriggerDefinition")
+		r.DefaultValueTriggerDefinition.appendJSON(e)
+	}
+	if r.DefaultValueUsageContext != nil {
+		e.key("defaultValueUsageContext")
+		r.DefaultValueUsageContext.appendJSON(e)
+	}
+	if r.DefaultValueDosage != nil {
+		e.key("defaultValueDosage")
+		r.DefaultValueDosage.appendJSON(e)
+	}
+	if r.DefaultValueMeta != nil {
+		e.key("defaultValueMeta")
+		r.DefaultValueMeta.appendJSON(e)
+	}
+	if r.MeaningWhenMissing != nil {
+		e.key("meaningWhenMissing")
+		e.string(*r.MeaningWhenMissing)
+	}
+	if r.MeaningWhenMissingElement != nil {
+		e.key("_meaningWhenMissing")
+		r.MeaningWhenMissingElement.appendJSON(e)
+	}
+	if r.OrderMeaning != nil {
+		e.key("orderMeaning")
+		e.string(*r.OrderMeaning)
+	}
+	if r.OrderMeaningElement != nil {
+		e.key("_orderMeaning")
+		r.OrderMeaningElement.appendJSON(e)
+	}
+	if r.FixedBase64Binary != nil {
+		e.key("fixedBase64Binary")
+		e.string(*r.FixedBase64Binary)
+	}
+	if r.FixedBase64BinaryElement != nil {
+		e.key("_fixedBase64Binary")
+		r.FixedBase64BinaryElement.appendJSON(e)
+	}
+	if r.FixedBoolean != nil {
+		e.key("fixedBoolean")
+		e.bool(*r.FixedBoolean)
+	}
+	if r.FixedBooleanElement != nil {
+		e.key("_fixedBoolean")
+		r.FixedBooleanElement.appendJSON(e)
+	}
+	if r.FixedCanonical != nil {
+		e.key("fixedCanonical")
+		e.string(*r.FixedCanonical)
+	}
+	if r.FixedCanonicalElement != nil {
+		e.key("_fixedCanonical")
+		r.FixedCanonicalElement.appendJSON(e)
+	}
+	if r.FixedCode != nil {
+		e.key("fixedCode")
+		e.string(*r.FixedCode)
+	}
+	if r.FixedCodeElement != nil {
+		e.key("_fixedCode")
+		r.FixedCodeElement.appendJSON(e)
+	}
+	if r.FixedDate != nil {
+		e.key("fixedDate")
+		e.string(*r.FixedDate)
+	}
+	if r.FixedDateElement != nil {
+		e.key("_fixedDate")
+		r.FixedDateElement.appendJSON(e)
+	}
+	if r.FixedDateTime != nil {
+		e.key("fixedDateTime")
+		e.string(*r.FixedDateTime)
+	}
+	if r.FixedDateTimeElement != nil {
+		e.key("_fixedDateTime")
+		r.FixedDateTimeElement.appendJSON(e)
+	}
+	if r.FixedDecimal != nil {
+		e.key("fixedDecimal")
+		e.number(*r.FixedDecimal)
+	}
+	if r.FixedDecimalElement != nil {
+		e.key("_fixedDecimal")
+		r.FixedDecimalElement.appendJSON(e)
+	}
+	if r.FixedId != nil {
+		e.key("fixedId")
+		e.string(*r.FixedId)
+	}
+	if r.FixedIdElement != nil {
+		e.key("_fixedId")
+		r.FixedIdElement.appendJSON(e)
+	}
+	if r.FixedInstant != nil {
+		e.key("fixedInstant")
+		e.string(*r.FixedInstant)
+	}
+	if r.FixedInstantElement != nil {
+		e.key("_fixedInstant")
+		r.FixedInstantElement.appendJSON(e)
+	}
+	if r.FixedInteger != nil {
+		e.key("fixedInteger")
+		e.int(*r.FixedInteger)
+	}
+	if r.FixedIntegerElement != nil {
+		e.key("_fixedInteger")
+		r.FixedIntegerElement.appendJSON(e)
+	}
+	if r.FixedMarkdown != nil {
+		e.key("fixedMarkdown")
+		e.string(*r.FixedMarkdown)
+	}
+	if r.FixedMarkdownElement != nil {
+		e.key("_fixedMarkdown")
+		r.FixedMarkdownElement.appendJSON(e)
+	}
+	if r.FixedOid != nil {
+		e.key("fixedOid")
+		e.string(*r.FixedOid)
+	}
+	if r.FixedOidElement != nil {
+		e.key("_fixedOid")
+		r.FixedOidElement.appendJSON(e)
+	}
+	if r.FixedPositiveInt != nil {
+		e.key("fixedPositiveInt")
+		e.int(*r.FixedPositiveInt)
+	}
+	if r.FixedPositiveIntElement != nil {
+		e.key("_fixedPositiveInt")
+		r.FixedPositiveIntElement.appendJSON(e)
+	}
+	if r.FixedString != nil {
+		e.key("fixedString")
+		e.string(*r.FixedString)
+	}
+	if r.FixedStringElement != nil {
+		e.key("_fixedString")
+		r.FixedStringElement.appendJSON(e)
+	}
+	if r.FixedTime != nil {
+		e.key("fixedTime")
+		e.string(*r.FixedTime)
+	}
+	if r.FixedTimeElement != nil {
+		e.key("_fixedTime")
+		r.FixedTimeElement.appendJSON(e)
+	}
+	if r.FixedUnsignedInt != nil {
+		e.key("fixedUnsignedInt")
+		e.int(*r.FixedUnsignedInt)
+	}
+	if r.FixedUnsignedIntElement != nil {
+		e.key("_fixedUnsignedInt")
+		r.FixedUnsignedIntElement.appendJSON(e)
+	}
+	if r.FixedUri != nil {
+		e.key("fixedUri")
+		e.string(*r.FixedUri)
+	}
+	if r.FixedUriElement != nil {
+		e.key("_fixedUri")
+		r.FixedUriElement.appendJSON(e)
+	}
+	if r.FixedUrl != nil {
+		e.key("fixedUrl")
+		e.string(*r.FixedUrl)
+	}
+	if r.FixedUrlElement != nil {
+		e.key("_fixedUrl")
+		r.FixedUrlElement.appendJSON(e)
+	}
+	if r.FixedUuid != nil {
+		e.key("fixedUuid")
+		e.string(*r.FixedUuid)
+	}
+	if r.FixedUuidElement != nil {
+		e.key("_fixedUuid")
+		r.FixedUuidElement.appendJSON(e)
+	}
+	if r.FixedAddress != nil {
+		e.key("fixedAddress")
+		r.FixedAddress.appendJSON(e)
+	}
+	if r.FixedAge != nil {
+		e.key("fixedAge")
+		r.FixedAge.appendJSON(e)
+	}
+	if r.FixedAnnotation != nil {
+		e.key("fixedAnnotation")
+		r.FixedAnnotation.appendJSON(e)
+	}
+	if r.FixedAttachment != nil {
+		e.key("fixedAttachment")
+		r.FixedAttachment.appendJSON(e)
+	}
+	if r.FixedCodeableConcept != nil {
+		e.key("fixedCodeableConcept")
+		r.FixedCodeableConcept.appendJSON(e)
+	}
+	if r.FixedCoding != nil {
+		e.key("fixedCoding")
+		r.FixedCoding.appendJSON(e)
+	}
+	if r.FixedContactPoint != nil {
+		e.key("fixedContactPoint")
+		r.FixedContactPoint.appendJSON(e)
+	}
+	if r.FixedCount != nil {
+		e.key("fixedCount")
+		r.FixedCount.appendJSON(e)
+	}
+	if r.FixedDistance != nil {
+		e.key("fixedDistance")
+		r.FixedDistance.appendJSON(e)
+	}
+	if r.FixedDuration != nil {
+		e.key("fixedDuration")
+		r.FixedDuration.appendJSON(e)
+	}
+	if r.FixedHumanName != nil {
+		e.key("fixedHumanName")
+		r.FixedHumanName.appendJSON(e)
+	}
+	if r.FixedIdentifier != nil {
+		e.key("fixedIdentifier")
+		r.FixedIdentifier.appendJSON(e)
+	}
+	if r.FixedMoney != nil {
+		e.key("fixedMoney")
+		r.FixedMoney.appendJSON(e)
+	}
+	if r.FixedPeriod != nil {
+		e.key("fixedPeriod")
+		r.FixedPeriod.appendJSON(e)
+	}
+	if r.FixedQuantity != nil {
+		e.key("fixedQuantity")
+		r.FixedQuantity.appendJSON(e)
+	}
+	if r.FixedRange != nil {
+		e.key("fixedRange")
+		r.FixedRange.appendJSON(e)
+	}
+	if r.FixedRatio != nil {
+		e.key("fixedRatio")
+		r.FixedRatio.appendJSON(e)
+	}
+	if r.FixedReference != nil {
+		e.key("fixedReference")
+		r.FixedReference.appendJSON(e)
+	}
+	if r.FixedSampledData != nil {
+		e.key("fixedSampledData")
+		r.FixedSampledData.appendJSON(e)
+	}
+	if r.FixedSignature != nil {
+		e.key("fixedSignature")
+		r.FixedSignature.appendJSON(e)
+	}
+	if r.FixedTiming != nil {
+		e.key("fixedTiming")
+		r.FixedTiming.appendJSON(e)
+	}
+	if r.FixedContactDetail != nil {
+		e.key("fixedContactDetail")
+		r.FixedContactDetail.appendJSON(e)
+	}
+	if r.FixedContributor != nil {
+		e.key("fixedContributor")
+		r.FixedContributor.appendJSON(e)
+	}
+	if r.FixedDataRequirement != nil {
+		e.key("fixedDataRequirement")
+		r.FixedDataRequirement.appendJSON(e)
+	}
+	if r.FixedExpression != nil {
+		e.key("fixedExpression")
+		r.FixedExpression.appendJSON(e)
+	}
+	if r.FixedParameterDefinition != nil {
+		e.key("fixedParameterDefinition")
+		r.FixedParameterDefinition.appendJSON(e)
+	}
+	if r.FixedRelatedArtifact != nil {
+		e.key("fixedRelatedArtifact")
+		r.FixedRelatedArtifact.appendJSON(e)
+	}
+	if r.FixedTriggerDefinition != nil {
+		e.key("fixedTriggerDefinition")
+		r.FixedTriggerDefinition.appendJSON(e)
+	}
+	if r.FixedUsageContext != nil {
+		e.key("fixedUsageContext")
+		r.FixedUsageContext.appendJSON(e)
+	}
+	if r.FixedDosage != nil {
+		e.key("fixedDosage")
+		r.FixedDosage.appendJSON(e)
+	}
+	if r.FixedMeta != nil {
+		e.key("fixedMeta")
+		r.FixedMeta.appendJSON(e)
+	}
+	if r.PatternBase64Binary != nil {
+		e.key("patternBase64Binary")
+		e.string(*r.PatternBase64Binary)
+	}
+	if r.PatternBase64BinaryElement != nil {
+		e.key("_patternBase64Binary")
+		r.PatternBase64BinaryElement.appendJSON(e)
+	}
+	if r.PatternBoolean != nil {
+		e.key("patternBoolean")
+		e.bool(*r.PatternBoolean)
+	}
+	if r.PatternBooleanElement != nil {
+		e.key("_patternBoolean")
+		r.PatternBooleanElement.appendJSON(e)
+	}
+	if r.PatternCanonical != nil {
+		e.key("patternCanonical")
+		e.string(*r.PatternCanonical)
+	}
+	if r.PatternCanonicalElement != nil {
+		e.key("_patternCanonical")
+		r.PatternCanonicalElement.appendJSON(e)
+	}
+	if r.PatternCode != nil {
+		e.key("patternCode")
+		e.string(*r.PatternCode)
+	}
+	if r.PatternCodeElement != nil {
+		e.key("_patternCode")
+		r.PatternCodeElement.appendJSON(e)
+	}
+	if r.PatternDate != nil {
+		e.key("patternDate")
+		e.string(*r.PatternDate)
+	}
+	if r.PatternDateElement != nil {
+		e.key("_patternDate")
+		r.PatternDateElement.appendJSON(e)
+	}
+	if r.PatternDateTime != nil {
+		e.key("patternDateTime")
+		e.string(*r.PatternDateTime)
+	}
+	if r.PatternDateTimeElement != nil {
+		e.key("_patternDateTime")
+		r.PatternDateTimeElement.appendJSON(e)
+	}
+	if r.PatternDecimal != nil {
+		e.key("patternDecimal")
+		e.number(*r.PatternDecimal)
+	}
+	if r.PatternDecimalElement != nil {
+		e.key("_patternDecimal")
+		r.PatternDecimalElement.appendJSON(e)
+	}
+	if r.PatternId != nil {
+		e.key("patternId")
+		e.string(*r.PatternId)
+	}
+	if r.PatternIdElement != nil {
+		e.key("_patternId")
+		r.PatternIdElement.appendJSON(e)
+	}
+	if r.PatternInstant != nil {
+		e.key("patternInstant")
+		e.string(*r.PatternInstant)
+	}
+	if r.PatternInstantElement != nil {
+		e.key("_patternInstant")
+		r.PatternInstantElement.appendJSON(e)
+	}
+	if r.PatternInteger != nil {
+		e.key("patternInteger")
+		e.int(*r.PatternInteger)
+	}
+	if r.PatternIntegerElement != nil {
+		e.key("_patternInteger")
+		r.PatternIntegerElement.appendJSON(e)
+	}
+	if r.PatternMarkdown != nil {
+		e.key("patternMarkdown")
+		e.string(*r.PatternMarkdown)
+	}
+	if r.PatternMarkdownElement != nil {
+		e.key("_patternMarkdown")
+		r.PatternMarkdownElement.appendJSON(e)
+	}
+	if r.PatternOid != nil {
+		e.key("patternOid")
+		e.string(*r.PatternOid)
+	}
+	if r.PatternOidElement != nil {
+		e.key("_patternOid")
+		r.PatternOidElement.appendJSON(e)
+	}
+	if r.PatternPositiveInt != nil {
+		e.key("patternPositiveInt")
+		e.int(*r.PatternPositiveInt)
+	}
+	if r.PatternPositiveIntElement != nil {
+		e.key("_patternPositiveInt")
+		r.PatternPositiveIntElement.appendJSON(e)
+	}
+	if r.PatternString != nil {
+		e.key("patternString")
+		e.string(*r.PatternString)
+	}
+	if r.PatternStringElement != nil {
+		e.key("_patternString")
+		r.PatternStringElement.appendJSON(e)
+	}
+	if r.PatternTime != nil {
+		e.key("patternTime")
+		e.string(*r.PatternTime)
+	}
+	if r.PatternTimeElement != nil {
+		e.key("_patternTime")
+		r.PatternTimeElement.appendJSON(e)
+	}
+	if r.PatternUnsignedInt != nil {
+		e.key("patternUnsignedInt")
+		e.int(*r.PatternUnsignedInt)
+	}
+	if r.PatternUnsignedIntElement != nil {
+		e.key("_patternUnsignedInt")
+		r.PatternUnsignedIntElement.appendJSON(e)
+	}
+	if r.PatternUri != nil {
+		e.key("patternUri")
+		e.string(*r.PatternUri)
+	}
+	if r.PatternUriElement != nil {
+		e.key("_patternUri")
+		r.PatternUriElement.appendJSON(e)
+	}
+	if r.PatternUrl != nil {
+		e.key("patternUrl")
+		e.string(*r.PatternUrl)
+	}
+	if r.PatternUrlElement != nil {
+		e.key("_patternUrl")
+		r.PatternUrlElement.appendJSON(e)
+	}
+	if r.PatternUuid != nil {
+		e.key("patternUuid")
+		e.string(*r.PatternUuid)
+	}
+	if r.PatternUuidElement != nil {
+		e.key("_patternUuid")
+		r.PatternUuidElement.appendJSON(e)
+	}
+	if r.PatternAddress != nil {
+		e.key("patternAddress")
+		r.PatternAddress.appendJSON(e)
+	}
+	if r.PatternAge != nil {
+		e.key("patternAge")
+		r.PatternAge.appendJSON(e)
+	}
+	if r.PatternAnnotation != nil {
+		e.key("patternAnnotation")
+		r.PatternAnnotation.appendJSON(e)
+	}
+	if r.PatternAttachment != nil {
+		e.key("patternAttachment")
+		r.PatternAttachment.appendJSON(e)
+	}
+	if r.PatternCodeableConcept != nil {
+		e.key("patternCodeableConcept")
+		r.PatternCodeableConcept.appendJSON(e)
+	}
+	if r.PatternCoding != nil {
+		e.key("patternCoding")
+		r.PatternCoding.appendJSON(e)
+	}
+	if r.PatternContactPoint != nil {
+		e.key("patternContactPoint")
+		r.PatternContactPoint.appendJSON(e)
+	}
+	if r.PatternCount != nil {
+		e.key("patternCount")
+		r.PatternCount.appendJSON(e)
+	}
+	if r.PatternDistance != nil {
+		e.key("patternDistance")
+		r.PatternDistance.appendJSON(e)
+	}
+	if r.PatternDuration != nil {
+		e.key("patternDuration")
+		r.PatternDuration.appendJSON(e)
+	}
+	if r.PatternHumanName != nil {
+		e.key("patternHumanName")
+		r.PatternHumanName.appendJSON(e)
+	}
+	if r.PatternIdentifier != nil {
+		e.key("patternIdentifier")
+		r.PatternIdentifier.appendJSON(e)
+	}
+	if r.PatternMoney != nil {
+		e.key("patternMoney")
+		r.PatternMoney.appendJSON(e)
+	}
+	if r.PatternPeriod != nil {
+		e.key("patternPeriod")
+		r.PatternPeriod.appendJSON(e)
+	}
+	if r.PatternQuantity != nil {
+		e.key("patternQuantity")
+		r.PatternQuantity.appendJSON(e)
+	}
+	if r.PatternRange != nil {
+		e.key("patternRange")
+		r.PatternRange.appendJSON(e)
+	}
+	if r.PatternRatio != nil {
+		e.key("patternRatio")
+		r.PatternRatio.appendJSON(e)
+	}
+	if r.PatternReference != nil {
+		e.key("patternReference")
+		r.PatternReference.appendJSON(e)
+	}
+	if r.PatternSampledData != nil {
+		e.key("patternSampledData")
+		r.PatternSampledData.appendJSON(e)
+	}
+	if r.PatternSignature != nil {
+		e.key("patternSignature")
+		r.PatternSignature.appendJSON(e)
+	}
+	if r.PatternTiming != nil {
+		e.key("patternTiming")
+		r.PatternTiming.appendJSON(e)
+	}
+	if r.PatternContactDetail != nil {
+		e.key("patternContactDetail")
+		r.PatternContactDetail.appendJSON(e)
+	}
+	if r.PatternContributor != nil {
+		e.key("patternContributor")
+		r.PatternContributor.appendJSON(e)
+	}
+	if r.PatternDataRequirement != nil {
+		e.key("patternDataRequirement")
+		r.PatternDataRequirement.appendJSON(e)
+	}
+	if r.PatternExpression != nil {
+		e.key("patternExpression")
+		r.PatternExpression.appendJSON(e)
+	}
+	if r.PatternParameterDefinition != nil {
+		e.key("patternParameterDefinition")
+		r.PatternParameterDefinition.appendJSON(e)
+	}
+	if r.PatternRelatedArtifact != nil {
+		e.key("patternRelatedArtifact")
+		r.PatternRelatedArtifact.appendJSON(e)
+	}
+	if r.PatternTriggerDefinition != nil {
+		e.key("patternTriggerDefinition")
+		r.PatternTriggerDefinition.appendJSON(e)
+	}
+	if r.PatternUsageContext != nil {
+		e.key("patternUsageContext")
+		r.PatternUsageContext.appendJSON(e)
+	}
+	if r.PatternDosage != nil {
+		e.key("patternDosage")
+		r.PatternDosage.appendJSON(e)
+	}
+	if r.PatternMeta != nil {
+		e.key("patternMeta")
+		r.PatternMeta.appendJSON(e)
+	}
+	if len(r.Example) > 0 {
+		e.key("example")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Example {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MinValueDate != nil {
+		e.key("minValueDate")
+		e.string(*r.MinValueDate)
+	}
+	if r.MinValueDateElement != nil {
+		e.key("_minValueDate")
+		r.MinValueDateElement.appendJSON(e)
+	}
+	if r.MinValueDateTime != nil {
+		e.key("minValueDateTime")
+		e.string(*r.MinValueDateTime)
+	}
+	if r.MinValueDateTimeElement != nil {
+		e.key("_minValueDateTime")
+		r.MinValueDateTimeElement.appendJSON(e)
+	}
+	if r.MinValueInstant != nil {
+		e.key("minValueInstant")
+		e.string(*r.MinValueInstant)
+	}
+	if r.MinValueInstantElement != nil {
+		e.key("_minValueInstant")
+		r.MinValueInstantElement.appendJSON(e)
+	}
+	if r.MinValueTime != nil {
+		e.key("minValueTime")
+		e.string(*r.MinValueTime)
+	}
+	if r.MinValueTimeElement != nil {
+		e.key("_minValueTime")
+		r.MinValueTimeElement.appendJSON(e)
+	}
+	if r.MinValueDecimal != nil {
+		e.key("minValueDecimal")
+		e.number(*r.MinValueDecimal)
+	}
+	if r.MinValueDecimalElement != nil {
+		e.key("_minValueDecimal")
+		r.MinValueDecimalElement.appendJSON(e)
+	}
+	if r.MinValueInteger != nil {
+		e.key("minValueInteger")
+		e.int(*r.MinValueInteger)
+	}
+	if r.MinValueIntegerElement != nil {
+		e.key("_minValueInteger")
+		r.MinValueIntegerElement.appendJSON(e)
+	}
+	if r.MinValuePositiveInt != nil {
+		e.key("minValuePositiveInt")
+		e.int(*r.MinValuePositiveInt)
+	}
+	if r.MinValuePositiveIntElement != nil {
+		e.key("_minValuePositiveInt")
+		r.MinValuePositiveIntElement.appendJSON(e)
+	}
+	if r.MinValueUnsignedInt != nil {
+		e.key("minValueUnsignedInt")
+		e.int(*r.MinValueUnsignedInt)
+	}
+	if r.MinValueUnsignedIntElement != nil {
+		e.key("_minValueUnsignedInt")
+		r.MinValueUnsignedIntElement.appendJSON(e)
+	}
+	if r.MinValueQuantity != nil {
+		e.key("minValueQuantity")
+		r.MinValueQuantity.appendJSON(e)
+	}
+	if r.MaxValueDate != nil {
+		e.key("maxValueDate")
+		e.string(*r.MaxValueDate)
+	}
+	if r.MaxValueDateElement != nil {
+		e.key("_maxValueDate")
+		r.MaxValueDateElement.appendJSON(e)
+	}
+	if r.MaxValueDateTime != nil {
+		e.key("maxValueDateTime")
+		e.string(*r.MaxValueDateTime)
+	}
+	if r.MaxValueDateTimeElement != nil {
+		e.key("_maxValueDateTime")
+		r.MaxValueDateTimeElement.appendJSON(e)
+	}
+	if r.MaxValueInstant != nil {
+		e.key("maxValueInstant")
+		e.string(*r.MaxValueInstant)
+	}
+	if r.MaxValueInstantElement != nil {
+		e.key("_maxValueInstant")
+		r.MaxValueInstantElement.appendJSON(e)
+	}
+	if r.MaxValueTime != nil {
+		e.key("maxValueTime")
+		e.string(*r.MaxValueTime)
+	}
+	if r.MaxValueTimeElement != nil {
+		e.key("_maxValueTime")
+		r.MaxValueTimeElement.appendJSON(e)
+	}
+	if r.MaxValueDecimal != nil {
+		e.key("maxValueDecimal")
+		e.number(*r.MaxValueDecimal)
+	}
+	if r.MaxValueDecimalElement != nil {
+		e.key("_maxValueDecimal")
+		r.MaxValueDecimalElement.appendJSON(e)
+	}
+	if r.MaxValueInteger != nil {
+		e.key("maxValueInteger")
+		e.int(*r.MaxValueInteger)
+	}
+	if r.MaxValueIntegerElement != nil {
+		e.key("_maxValueInteger")
+		r.MaxValueIntegerElement.appendJSON(e)
+	}
+	if r.MaxValuePositiveInt != nil {
+		e.key("maxValuePositiveInt")
+		e.int(*r.MaxValuePositiveInt)
+	}
+	if r.MaxValuePositiveIntElement != nil {
+		e.key("_maxValuePositiveInt")
+		r.MaxValuePositiveIntElement.appendJSON(e)
+	}
+	if r.MaxValueUnsignedInt != nil {
+		e.key("maxValueUnsignedInt")
+		e.int(*r.MaxValueUnsignedInt)
+	}
+	if r.MaxValueUnsignedIntElement != nil {
+		e.key("_maxValueUnsignedInt")
+		r.MaxValueUnsignedIntElement.appendJSON(e)
+	}
+	if r.MaxValueQuantity != nil {
+		e.key("maxValueQuantity")
+		r.MaxValueQuantity.appendJSON(e)
+	}
+	if r.MaxLength != nil {
+		e.key("maxLength")
+		e.int(*r.MaxLength)
+	}
+	if r.MaxLengthElement != nil {
+		e.key("_maxLength")
+		r.MaxLengthElement.appendJSON(e)
+	}
+	if len(r.Condition) > 0 {
+		e.key("condition")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Condition {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ConditionElement) > 0 {
+		e.key("_condition")
+		e.elements(r.ConditionElement, len(r.Condition))
+	}
+	if len(r.Constraint) > 0 {
+		e.key("constraint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Constraint {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MustSupport != nil {
+		e.key("mustSupport")
+		e.bool(*r.MustSupport)
+	}
+	if r.MustSupportElement != nil {
+		e.key("_mustSupport")
+		r.MustSupportElement.appendJSON(e)
+	}
+	if r.IsModifier != nil {
+		e.key("isModifier")
+		e.bool(*r.IsModifier)
+	}
+	if r.IsModifierElement != nil {
+		e.key("_isModifier")
+		r.IsModifierElement.appendJSON(e)
+	}
+	if r.IsModifierReason != nil {
+		e.key("isModifierReason")
+		e.string(*r.IsModifierReason)
+	}
+	if r.IsModifierReasonElement != nil {
+		e.key("_isModifierReason")
+		r.IsModifierReasonElement.appendJSON(e)
+	}
+	if r.IsSummary != nil {
+		e.key("isSummary")
+		e.bool(*r.IsSummary)
+	}
+	if r.IsSummaryElement != nil {
+		e.key("_isSummary")
+		r.IsSummaryElement.appendJSON(e)
+	}
+	if r.Binding != nil {
+		e.key("binding")
+		r.Binding.appendJSON(e)
+	}
+	if len(r.Mapping) > 0 {
+		e.key("mapping")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Mapping {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ElementDefinition from JSON. Unknown members are ignored.
+func (r *ElementDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ElementDefinition
+func (r *ElementDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = v
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "representation":
+			a := d.array()
+			r.Representation = nil
+			for a.next() {
+				var v PropertyRepresentation
+				d.unmarshal(&v)
+				r.Representation = append(r.Representation, v)
+			}
+			if r.Representation == nil && !a.isNull {
+				r.Representation = []PropertyRepresentation{}
+			}
+		case "_representation":
+			a := d.array()
+			r.RepresentationElement = nil
+			for a.next() {
+				r.RepresentationElement = append(r.RepresentationElement, d.element())
+			}
+		case "sliceName":
+			if v, ok := d.string(); ok {
+				r.SliceName = &v
+			} else {
+				r.SliceName = nil
+			}
+		case "_sliceName":
+			r.SliceNameElement = d.element()
+		case "sliceIsConstraining":
+			if v, ok := d.bool(); ok {
+				r.SliceIsConstraining = &v
+			} else {
+				r.SliceIsConstraining = nil
+			}
+		case "_sliceIsConstraining":
+			r.SliceIsConstrainingElement = d.element()
+		case "label":
+			if v, ok := d.string(); ok {
+				r.Label = &v
+			} else {
+				r.Label = nil
+			}
+		case "_label":
+			r.LabelElement = d.element()
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []Coding{}
+			}
+		case "slicing":
+			if d.null() {
+				r.Slicing = nil
+			} else {
+				var v ElementDefinitionSlicing
+				v.decodeJSON(d)
+				r.Slicing = &v
+			}
+		case "short":
+			if v, ok := d.string(); ok {
+				r.Short = &v
+			} else {
+				r.Short = nil
+			}
+		case "_short":
+			r.ShortElement = d.element()
+		case "definition":
+			if v, ok := d.string(); ok {
+				r.Definition = &v
+			} else {
+				r.Definition = nil
+			}
+		case "_definition":
+			r.DefinitionElement = d.element()
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		case "requirements":
+			if v, ok := d.string(); ok {
+				r.Requirements = &v
+			} else {
+				r.Requirements = nil
+			}
+		case "_requirements":
+			r.RequirementsElement = d.element()
+		case "alias":
+			a := d.array()
+			r.Alias = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Alias = append(r.Alias, v)
+			}
+			if r.Alias == nil && !a.isNull {
+				r.Alias = []string{}
+			}
+		case "_alias":
+			a := d.array()
+			r.AliasElement = nil
+			for a.next() {
+				r.AliasElement = append(r.AliasElement, d.element())
+			}
+		case "min":
+			if v, ok := d.int(); ok {
+				r.Min = &v
+			} else {
+				r.Min = nil
+			}
+		case "_min":
+			r.MinElement = d.element()
+		case "max":
+			if v, ok := d.string(); ok {
+				r.Max = &v
+			} else {
+				r.Max = nil
+			}
+		case "_max":
+			r.MaxElement = d.element()
+		case "base":
+			if d.null() {
+				r.Base = nil
+			} else {
+				var v ElementDefinitionBase
+				v.decodeJSON(d)
+				r.Base = &v
+			}
+		case "contentReference":
+			if v, ok := d.string(); ok {
+				r.ContentReference = &v
+			} else {
+				r.ContentReference = nil
+			}
+		case "_contentReference":
+			r.ContentReferenceElement = d.element()
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v ElementDefinitionType
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []ElementDefinitionType{}
+			}
+		case "defaultValueBase64Binary":
+			if v, ok := d.string(); ok {
+				r.DefaultValueBase64Binary = &v
+			} else {
+				r.DefaultValueBase64Binary = nil
+			}
+		case "_defaultValueBase64Binary":
+			r.DefaultValueBase64BinaryElement = d.element()
+		case "defaultValueBoolean":
+			if v, ok := d.bool(); ok {
+				r.DefaultValueBoolean = &v
+			} else {
+				r.DefaultValueBoolean = nil
+			}
+		case "_defaultValueBoolean":
+			r.DefaultValueBooleanElement = d.element()
+		case "defaultValueCanonical":
+			if v, ok := d.string(); ok {
+				r.DefaultValueCanonical = &v
+			} else {
+				r.DefaultValueCanonical = nil
+			}
+		case "_defaultValueCanonical":
+			r.DefaultValueCanonicalElement = d.element()
+		case "defaultValueCode":
+			if v, ok := d.string(); ok {
+				r.DefaultValueCode = &v
+			} else {
+				r.DefaultValueCode = nil
+			}
+		case "_defaultValueCode":
+			r.DefaultValueCodeElement = d.element()
+		case "defaultValueDate":
+			if v, ok := d.string(); ok {
+				r.DefaultValueDate = &v
+			} else {
+				r.DefaultValueDate = nil
+			}
+		case "_defaultValueDate":
+			r.DefaultValueDateElement = d.element()
+		case "defaultValueDateTime":
+			if v, ok := d.string(); ok {
+				r.DefaultValueDateTime = &v
+			} else {
+				r.DefaultValueDateTime = nil
+			}
+		case "_defaultValueDateTime":
+			r.DefaultValueDateTimeElement = d.element()
+		case "defaultValueDecimal":
+			if v, ok := d.number(); ok {
+				r.DefaultValueDecimal = &v
+			} else {
+				r.DefaultValueDecimal = nil
+			}
+		case "_defaultValueDecimal":
+			r.DefaultValueDecimalElement = d.element()
+		case "defaultValueId":
+			if v, ok := d.string(); ok {
+				r.DefaultValueId = &v
+			} else {
+				r.DefaultValueId = nil
+			}
+		case "_defaultValueId":
+			r.DefaultValueIdElement = d.element()
+		case "defaultValueInstant":
+			if v, ok := d.string(); ok {
+				r.DefaultValueInstant = &v
+			} else {
+				r.DefaultValueInstant = nil
+			}
+		case "_defaultValueInstant":
+			r.DefaultValueInstantElement = d.element()
+		case "defaultValueInteger":
+			if v, ok := d.int(); ok {
+				r.DefaultValueInteger = &v
+			} else {
+				r.DefaultValueInteger = nil
+			}
+		case "_defaultValueInteger":
+			r.DefaultValueIntegerElement = d.element()
+		case "defaultValueMarkdown":
+			if v, ok := d.string(); ok {
+				r.DefaultValueMarkdown = &v
+			} else {
+				r.DefaultValueMarkdown = nil
+			}
+		case "_defaultValueMarkdown":
+			r.DefaultValueMarkdownElement = d.element()
+		case "defaultValueOid":
+			if v, ok := d.string(); ok {
+				r.DefaultValueOid = &v
+			} else {
+				r.DefaultValueOid = nil
+			}
+		case "_defaultValueOid":
+			r.DefaultValueOidElement = d.element()
+		case "defaultValuePositiveInt":
+			if v, ok := d.int(); ok {
+				r.DefaultValuePositiveInt = &v
+			} else {
+				r.DefaultValuePositiveInt = nil
+			}
+		case "_defaultValuePositiveInt":
+			r.DefaultValuePositiveIntElement = d.element()
+		case "defaultValueString":
+			if v, ok := d.string(); ok {
+				r.DefaultValueString = &v
+			} else {
+				r.DefaultValueString = nil
+			}
+		case "_defaultValueString":
+			r.DefaultValueStringElement = d.element()
+		case "defaultValueTime":
+			if v, ok := d.string(); ok {
+				r.DefaultValueTime = &v
+			} else {
+				r.DefaultValueTime = nil
+			}
+		case "_defaultValueTime":
+			r.DefaultValueTimeElement = d.element()
+		case "defaultValueUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.DefaultValueUnsignedInt = &v
+			} else {
+				r.DefaultValueUnsignedInt = nil
+			}
+		case "_defaultValueUnsignedInt":
+			r.DefaultValueUnsignedIntElement = d.element()
+		case "defaultValueUri":
+			if v, ok := d.string(); ok {
+				r.DefaultValueUri = &v
+			} else {
+				r.DefaultValueUri = nil
+			}
+		case "_defaultValueUri":
+			r.DefaultValueUriElement = d.element()
+		case "defaultValueUrl":
+			if v, ok := d.string(); ok {
+				r.DefaultValueUrl = &v
+			} else {
+				r.DefaultValueUrl = nil
+			}
+		case "_defaultValueUrl":
+			r.DefaultValueUrlElement = d.element()
+		case "defaultValueUuid":
+			if v, ok := d.string(); ok {
+				r.DefaultValueUuid = &v
+			} else {
+				r.DefaultValueUuid = nil
+			}
+		case "_defaultValueUuid":
+			r.DefaultValueUuidElement = d.element()
+		case "defaultValueAddress":
+			if d.null() {
+				r.DefaultValueAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.DefaultValueAddress = &v
+			}
+		case "defaultValueAge":
+			if d.null() {
+				r.DefaultValueAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.DefaultValueAge = &v
+			}
+		case "defaultValueAnnotation":
+			if d.null() {
+				r.DefaultValueAnnotation = nil
+			} else {
+				var v Annotation
+				v.decodeJSON(d)
+				r.DefaultValueAnnotation = &v
+			}
+		case "defaultValueAttachment":
+			if d.null() {
+				r.DefaultValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.DefaultValueAttachment = &v
+			}
+		case "defaultValueCodeableConcept":
+			if d.null() {
+				r.DefaultValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DefaultValueCodeableConcept = &v
+			}
+		case "defaultValueCoding":
+			if d.null() {
+				r.DefaultValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.DefaultValueCoding = &v
+			}
+		case "defaultValueContactPoint":
+			if d.null() {
+				r.DefaultValueContactPoint = nil
+			} else {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.DefaultValueContactPoint = &v
+			}
+		case "defaultValueCount":
+			if d.null() {
+				r.DefaultValueCount = nil
+			} else {
+				var v Count
+				v.decodeJSON(d)
+				r.DefaultValueCount = &v
+			}
+		case "defaultValueDistance":
+			if d.null() {
+				r.DefaultValueDistance = nil
+			} else {
+				var v Distance
+				v.decodeJSON(d)
+				r.DefaultValueDistance = &v
+			}
+		case "defaultValueDuration":
+			if d.null() {
+				r.DefaultValueDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.DefaultValueDuration = &v
+			}
+		case "defaultValueHumanName":
+			if d.null() {
+				r.DefaultValueHumanName = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.DefaultValueHumanName = &v
+			}
+		case "defaultValueIdentifier":
+			if d.null() {
+				r.DefaultValueIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.DefaultValueIdentifier = &v
+			}
+		case "defaultValueMoney":
+			if d.null() {
+				r.DefaultValueMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.DefaultValueMoney = &v
+			}
+		case "defaultValuePeriod":
+			if d.null() {
+				r.DefaultValuePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.DefaultValuePeriod = &v
+			}
+		case "defaultValueQuantity":
+			if d.null() {
+				r.DefaultValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.DefaultValueQuantity = &v
+			}
+		case "defaultValueRange":
+			if d.null() {
+				r.DefaultValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.DefaultValueRange = &v
+			}
+		case "defaultValueRatio":
+			if d.null() {
+				r.DefaultValueRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.DefaultValueRatio = &v
+			}
+		case "defaultValueReference":
+			if d.null() {
+				r.DefaultValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.DefaultValueReference = &v
+			}
+		case "defaultValueSampledData":
+			if d.null() {
+				r.DefaultValueSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.DefaultValueSampledData = &v
+			}
+		case "defaultValueSignature":
+			if d.null() {
+				r.DefaultValueSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.DefaultValueSignature = &v
+			}
+		case "defaultValueTiming":
+			if d.null() {
+				r.DefaultValueTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.DefaultValueTiming = &v
+			}
+		case "defaultValueContactDetail":
+			if d.null() {
+				r.DefaultValueContactDetail = nil
+			} else {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.DefaultValueContactDetail = &v
+			}
+		case "defaultValueContributor":
+			if d.null() {
+				r.DefaultValueContributor = nil
+			} else {
+				var v Contributor
+				v.decodeJSON(d)
+				r.DefaultValueContributor = &v
+			}
+		case "defaultValueDataRequirement":
+			if d.null() {
+				r.DefaultValueDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.DefaultValueDataRequirement = &v
+			}
+		case "defaultValueExpression":
+			if d.null() {
+				r.DefaultValueExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.DefaultValueExpression = &v
+			}
+		case "defaultValueParameterDefinition":
+			if d.null() {
+				r.DefaultValueParameterDefinition = nil
+			} else {
+				var v ParameterDefinition
+				v.decodeJSON(d)
+				r.DefaultValueParameterDefinition = &v
+			}
+		case "defaultValueRelatedArtifact":
+			if d.null() {
+				r.DefaultValueRelatedArtifact = nil
+			} else {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.DefaultValueRelatedArtifact = &v
+			}
+		case "defaultValueTriggerDefinition":
+			if d.null() {
+				r.DefaultValueTriggerDefinition = nil
+			} else {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.DefaultValueTriggerDefinition = &v
+			}
+		case "defaultValueUsageContext":
+			if d.null() {
+				r.DefaultValueUsageContext = nil
+			} else {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.DefaultValueUsageContext = &v
+			}
+		case "defaultValueDosage":
+			if d.null() {
+				r.DefaultValueDosage = nil
+			} else {
+				var v Dosage
+				v.decodeJSON(d)
+				r.DefaultValueDosage = &v
+			}
+		case "defaultValueMeta":
+			if d.null() {
+				r.DefaultValueMeta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.DefaultValueMeta = &v
+			}
+		case "meaningWhenMissing":
+			if v, ok := d.string(); ok {
+				r.MeaningWhenMissing = &v
+			} else {
+				r.MeaningWhenMissing = nil
+			}
+		case "_meaningWhenMissing":
+			r.MeaningWhenMissingElement = d.element()
+		case "orderMeaning":
+			if v, ok := d.string(); ok {
+				r.OrderMeaning = &v
+			} else {
+				r.OrderMeaning = nil
+			}
+		case "_orderMeaning":
+			r.OrderMeaningElement = d.element()
+		case "fixedBase64Binary":
+			if v, ok := d.string(); ok {
+				r.FixedBase64Binary = &v
+			} else {
+				r.FixedBase64Binary = nil
+			}
+		case "_fixedBase64Binary":
+			r.FixedBase64BinaryElement = d.element()
+		case "fixedBoolean":
+			if v, ok := d.bool(); ok {
+				r.FixedBoolean = &v
+			} else {
+				r.FixedBoolean = nil
+			}
+		case "_fixedBoolean":
+			r.FixedBooleanElement = d.element()
+		case "fixedCanonical":
+			if v, ok := d.string(); ok {
+				r.FixedCanonical = &v
+			} else {
+				r.FixedCanonical = nil
+			}
+		case "_fixedCanonical":
+			r.FixedCanonicalElement = d.element()
+		case "fixedCode":
+			if v, ok := d.string(); ok {
+				r.FixedCode = &v
+			} else {
+				r.FixedCode = nil
+			}
+		case "_fixedCode":
+			r.FixedCodeElement = d.element()
+		case "fixedDate":
+			if v, ok := d.string(); ok {
+				r.FixedDate = &v
+			} else {
+				r.FixedDate = nil
+			}
+		case "_fixedDate":
+			r.FixedDateElement = d.element()
+		case "fixedDateTime":
+			if v, ok := d.string(); ok {
+				r.FixedDateTime = &v
+			} else {
+				r.FixedDateTime = nil
+			}
+		case "_fixedDateTime":
+			r.FixedDateTimeElement = d.element()
+		case "fixedDecimal":
+			if v, ok := d.number(); ok {
+				r.FixedDecimal = &v
+			} else {
+				r.FixedDecimal = nil
+			}
+		case "_fixedDecimal":
+			r.FixedDecimalElement = d.element()
+		case "fixedId":
+			if v, ok := d.string(); ok {
+				r.FixedId = &v
+			} else {
+				r.FixedId = nil
+			}
+		case "_fixedId":
+			r.FixedIdElement = d.element()
+		case "fixedInstant":
+			if v, ok := d.string(); ok {
+				r.FixedInstant = &v
+			} else {
+				r.FixedInstant = nil
+			}
+		case "_fixedInstant":
+			r.FixedInstantElement = d.element()
+		case "fixedInteger":
+			if v, ok := d.int(); ok {
+				r.FixedInteger = &v
+			} else {
+				r.FixedInteger = nil
+			}
+		case "_fixedInteger":
+			r.FixedIntegerElement = d.element()
+		case "fixedMarkdown":
+			if v, ok := d.string(); ok {
+				r.FixedMarkdown = &v
+			} else {
+				r.FixedMarkdown = nil
+			}
+		case "_fixedMarkdown":
+			r.FixedMarkdownElement = d.element()
+		case "fixedOid":
+			if v, ok := d.string(); ok {
+				r.FixedOid = &v
+			} else {
+				r.FixedOid = nil
+			}
+		case "_fixedOid":
+			r.FixedOidElement = d.element()
+		case "fixedPositiveInt":
+			if v, ok := d.int(); ok {
+				r.FixedPositiveInt = &v
+			} else {
+				r.FixedPositiveInt = nil
+			}
+		case "_fixedPositiveInt":
+			r.FixedPositiveIntElement = d.element()
+		case "fixedString":
+			if v, ok := d.string(); ok {
+				r.FixedString = &v
+			} else {
+				r.FixedString = nil
+			}
+		case "_fixedString":
+			r.FixedStringElement = d.element()
+		case "fixedTime":
+			if v, ok := d.string(); ok {
+				r.FixedTime = &v
+			} else {
+				r.FixedTime = nil
+			}
+		case "_fixedTime":
+			r.FixedTimeElement = d.element()
+		case "fixedUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.FixedUnsignedInt = &v
+			} else {
+				r.FixedUnsignedInt = nil
+			}
+		case "_fixedUnsignedInt":
+			r.FixedUnsignedIntElement = d.element()
+		case "fixedUri":
+			if v, ok := d.string(); ok {
+				r.FixedUri = &v
+			} else {
+				r.FixedUri = nil
+			}
+		case "_fixedUri":
+			r.FixedUriElement = d.element()
+		case "fixedUrl":
+			if v, ok := d.string(); ok {
+				r.FixedUrl = &v
+			} else {
+				r.FixedUrl = nil
+			}
+		case "_fixedUrl":
+			r.FixedUrlElement = d.element()
+		case "fixedUuid":
+			if v, ok := d.string(); ok {
+				r.FixedUuid = &v
+			} else {
+				r.FixedUuid = nil
+			}
+		case "_fixedUuid":
+			r.FixedUuidElement = d.element()
+		case "fixedAddress":
+			if d.null() {
+				r.FixedAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.FixedAddress = &v
+			}
+		case "fixedAge":
+			if d.null() {
+				r.FixedAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.FixedAge = &v
+			}
+		case "fixedAnnotation":
+			if d.null() {
+				r.FixedAnnotation = nil
+			} else {
+				var v Annotation
+				v.decodeJSON(d)
+				r.FixedAnnotation = &v
+			}
+		case "fixedAttachment":
+			if d.null() {
+				r.FixedAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.FixedAttachment = &v
+			}
+		case "fixedCodeableConcept":
+			if d.null() {
+				r.FixedCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FixedCodeableConcept = &v
+			}
+		case "fixedCoding":
+			if d.null() {
+				r.FixedCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.FixedCoding = &v
+			}
+		case "fixedContactPoint":
+			if d.null() {
+				r.FixedContactPoint = nil
+			} else {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.FixedContactPoint = &v
+			}
+		case "fixedCount":
+			if d.null() {
+				r.FixedCount = nil
+			} else {
+				var v Count
+				v.decodeJSON(d)
+				r.FixedCount = &v
+			}
+		case "fixedDistance":
+			if d.null() {
+				r.FixedDistance = nil
+			} else {
+				var v Distance
+				v.decodeJSON(d)
+				r.FixedDistance = &v
+			}
+		case "fixedDuration":
+			if d.null() {
+				r.FixedDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.FixedDuration = &v
+			}
+		case "fixedHumanName":
+			if d.null() {
+				r.FixedHumanName = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.FixedHumanName = &v
+			}
+		case "fixedIdentifier":
+			if d.null() {
+				r.FixedIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.FixedIdentifier = &v
+			}
+		case "fixedMoney":
+			if d.null() {
+				r.FixedMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.FixedMoney = &v
+			}
+		case "fixedPeriod":
+			if d.null() {
+				r.FixedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.FixedPeriod = &v
+			}
+		case "fixedQuantity":
+			if d.null() {
+				r.FixedQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.FixedQuantity = &v
+			}
+		case "fixedRange":
+			if d.null() {
+				r.FixedRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.FixedRange = &v
+			}
+		case "fixedRatio":
+			if d.null() {
+				r.FixedRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.FixedRatio = &v
+			}
+		case "fixedReference":
+			if d.null() {
+				r.FixedReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.FixedReference = &v
+			}
+		case "fixedSampledData":
+			if d.null() {
+				r.FixedSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.FixedSampledData = &v
+			}
+		case "fixedSignature":
+			if d.null() {
+				r.FixedSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.FixedSignature = &v
+			}
+		case "fixedTiming":
+			if d.null() {
+				r.FixedTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.FixedTiming = &v
+			}
+		case "fixedContactDetail":
+			if d.null() {
+				r.FixedContactDetail = nil
+			} else {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.FixedContactDetail = &v
+			}
+		case "fixedContributor":
+			if d.null() {
+				r.FixedContributor = nil
+			} else {
+				var v Contributor
+				v.decodeJSON(d)
+				r.FixedContributor = &v
+			}
+		case "fixedDataRequirement":
+			if d.null() {
+				r.FixedDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.FixedDataRequirement = &v
+			}
+		case "fixedExpression":
+			if d.null() {
+				r.FixedExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.FixedExpression = &v
+			}
+		case "fixedParameterDefinition":
+			if d.null() {
+				r.FixedParameterDefinition = nil
+			} else {
+				var v ParameterDefinition
+				v.decodeJSON(d)
+				r.FixedParameterDefinition = &v
+			}
+		case "fixedRelatedArtifact":
+			if d.null() {
+				r.FixedRelatedArtifact = nil
+			} else {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.FixedRelatedArtifact = &v
+			}
+		case "fixedTriggerDefinition":
+			if d.null() {
+				r.FixedTriggerDefinition = nil
+			} else {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.FixedTriggerDefinition = &v
+			}
+		case "fixedUsageContext":
+			if d.null() {
+				r.FixedUsageContext = nil
+			} else {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.FixedUsageContext = &v
+			}
+		case "fixedDosage":
+			if d.null() {
+				r.FixedDosage = nil
+			} else {
+				var v Dosage
+				v.decodeJSON(d)
+				r.FixedDosage = &v
+			}
+		case "fixedMeta":
+			if d.null() {
+				r.FixedMeta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.FixedMeta = &v
+			}
+		case "patternBase64Binary":
+			if v, ok := d.string(); ok {
+				r.PatternBase64Binary = &v
+			} else {
+				r.PatternBase64Binary = nil
+			}
+		case "_patternBase64Binary":
+			r.PatternBase64BinaryElement = d.element()
+		case "patternBoolean":
+			if v, ok := d.bool(); ok {
+				r.PatternBoolean = &v
+			} else {
+				r.PatternBoolean = nil
+			}
+		case "_patternBoolean":
+			r.PatternBooleanElement = d.element()
+		case "patternCanonical":
+			if v, ok := d.string(); ok {
+				r.PatternCanonical = &v
+			} else {
+				r.PatternCanonical = nil
+			}
+		case "_patternCanonical":
+			r.PatternCanonicalElement = d.element()
+		case "patternCode":
+			if v, ok := d.string(); ok {
+				r.PatternCode = &v
+			} else {
+				r.PatternCode = nil
+			}
+		case "_patternCode":
+			r.PatternCodeElement = d.element()
+		case "patternDate":
+			if v, ok := d.string(); ok {
+				r.PatternDate = &v
+			} else {
+				r.PatternDate = nil
+			}
+		case "_patternDate":
+			r.PatternDateElement = d.element()
+		case "patternDateTime":
+			if v, ok := d.string(); ok {
+				r.PatternDateTime = &v
+			} else {
+				r.PatternDateTime = nil
+			}
+		case "_patternDateTime":
+			r.PatternDateTimeElement = d.element()
+		case "patternDecimal":
+			if v, ok := d.number(); ok {
+				r.PatternDecimal = &v
+			} else {
+				r.PatternDecimal = nil
+			}
+		case "_patternDecimal":
+			r.PatternDecimalElement = d.element()
+		case "patternId":
+			if v, ok := d.string(); ok {
+				r.PatternId = &v
+			} else {
+				r.PatternId = nil
+			}
+		case "_patternId":
+			r.PatternIdElement = d.element()
+		case "patternInstant":
+			if v, ok := d.string(); ok {
+				r.PatternInstant = &v
+			} else {
+				r.PatternInstant = nil
+			}
+		case "_patternInstant":
+			r.PatternInstantElement = d.element()
+		case "patternInteger":
+			if v, ok := d.int(); ok {
+				r.PatternInteger = &v
+			} else {
+				r.PatternInteger = nil
+			}
+		case "_patternInteger":
+			r.PatternIntegerElement = d.element()
+		case "patternMarkdown":
+			if v, ok := d.string(); ok {
+				r.PatternMarkdown = &v
+			} else {
+				r.PatternMarkdown = nil
+			}
+		case "_patternMarkdown":
+			r.PatternMarkdownElement = d.element()
+		case "patternOid":
+			if v, ok := d.string(); ok {
+				r.PatternOid = &v
+			} else {
+				r.PatternOid = nil
+			}
+		case "_patternOid":
+			r.PatternOidElement = d.element()
+		case "patternPositiveInt":
+			if v, ok := d.int(); ok {
+				r.PatternPositiveInt = &v
+			} else {
+				r.PatternPositiveInt = nil
+			}
+		case "_patternPositiveInt":
+			r.PatternPositiveIntElement = d.element()
+		case "patternString":
+			if v, ok := d.string(); ok {
+				r.PatternString = &v
+			} else {
+				r.PatternString = nil
+			}
+		case "_patternString":
+			r.PatternStringElement = d.element()
+		case "patternTime":
+			if v, ok := d.string(); ok {
+				r.PatternTime = &v
+			} else {
+				r.PatternTime = nil
+			}
+		case "_patternTime":
+			r.PatternTimeElement = d.element()
+		case "patternUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.PatternUnsignedInt = &v
+			} else {
+				r.PatternUnsignedInt = nil
+			}
+		case "_patternUnsignedInt":
+			r.PatternUnsignedIntElement = d.element()
+		case "patternUri":
+			if v, ok := d.string(); ok {
+				r.PatternUri = &v
+			} else {
+				r.PatternUri = nil
+			}
+		case "_patternUri":
+			r.PatternUriElement = d.element()
+		case "patternUrl":
+			if v, ok := d.string(); ok {
+				r.PatternUrl = &v
+			} else {
+				r.PatternUrl = nil
+			}
+		case "_patternUrl":
+			r.PatternUrlElement = d.element()
+		case "patternUuid":
+			if v, ok := d.string(); ok {
+				r.PatternUuid = &v
+			} else {
+				r.PatternUuid = nil
+			}
+		case "_patternUuid":
+			r.PatternUuidElement = d.element()
+		case "patternAddress":
+			if d.null() {
+				r.PatternAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.PatternAddress = &v
+			}
+		case "patternAge":
+			if d.null() {
+				r.PatternAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.PatternAge = &v
+			}
+		case "patternAnnotation":
+			if d.null() {
+				r.PatternAnnotation = nil
+			} else {
+				var v Annotation
+				v.decodeJSON(d)
+				r.PatternAnnotation = &v
+			}
+		case "patternAttachment":
+			if d.null() {
+				r.PatternAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.PatternAttachment = &v
+			}
+		case "patternCodeableConcept":
+			if d.null() {
+				r.PatternCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PatternCodeableConcept = &v
+			}
+		case "patternCoding":
+			if d.null() {
+				r.PatternCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.PatternCoding = &v
+			}
+		case "patternContactPoint":
+			if d.null() {
+				r.PatternContactPoint = nil
+			} else {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.PatternContactPoint = &v
+			}
+		case "patternCount":
+			if d.null() {
+				r.PatternCount = nil
+			} else {
+				var v Count
+				v.decodeJSON(d)
+				r.PatternCount = &v
+			}
+		case "patternDistance":
+			if d.null() {
+				r.PatternDistance = nil
+			} else {
+				var v Distance
+				v.decodeJSON(d)
+				r.PatternDistance = &v
+			}
+		case "patternDuration":
+			if d.null() {
+				r.PatternDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.PatternDuration = &v
+			}
+		case "patternHumanName":
+			if d.null() {
+				r.PatternHumanName = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.PatternHumanName = &v
+			}
+		case "patternIdentifier":
+			if d.null() {
+				r.PatternIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.PatternIdentifier = &v
+			}
+		case "patternMoney":
+			if d.null() {
+				r.PatternMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.PatternMoney = &v
+			}
+		case "patternPeriod":
+			if d.null() {
+				r.PatternPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.PatternPeriod = &v
+			}
+		case "patternQuantity":
+			if d.null() {
+				r.PatternQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.PatternQuantity = &v
+			}
+		case "patternRange":
+			if d.null() {
+				r.PatternRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.PatternRange = &v
+			}
+		case "patternRatio":
+			if d.null() {
+				r.PatternRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.PatternRatio = &v
+			}
+		case "patternReference":
+			if d.null() {
+				r.PatternReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.PatternReference = &v
+			}
+		case "patternSampledData":
+			if d.null() {
+				r.PatternSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.PatternSampledData = &v
+			}
+		case "patternSignature":
+			if d.null() {
+				r.PatternSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.PatternSignature = &v
+			}
+		case "patternTiming":
+			if d.null() {
+				r.PatternTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.PatternTiming = &v
+			}
+		case "patternContactDetail":
+			if d.null() {
+				r.PatternContactDetail = nil
+			} else {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.PatternContactDetail = &v
+			}
+		case "patternContributor":
+			if d.null() {
+				r.PatternContributor = nil
+			} else {
+				var v Contributor
+				v.decodeJSON(d)
+				r.PatternContributor = &v
+			}
+		case "patternDataRequirement":
+			if d.null() {
+				r.PatternDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.PatternDataRequirement = &v
+			}
+		case "patternExpression":
+			if d.null() {
+				r.PatternExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.PatternExpression = &v
+			}
+		case "patternParameterDefinition":
+			if d.null() {
+				r.PatternParameterDefinition = nil
+			} else {
+				var v ParameterDefinition
+				v.decodeJSON(d)
+				r.PatternParameterDefinition = &v
+			}
+		case "patternRelatedArtifact":
+			if d.null() {
+				r.PatternRelatedArtifact = nil
+			} else {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.PatternRelatedArtifact = &v
+			}
+		case "patternTriggerDefinition":
+			if d.null() {
+				r.PatternTriggerDefinition = nil
+			} else {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.PatternTriggerDefinition = &v
+			}
+		case "patternUsageContext":
+			if d.null() {
+				r.PatternUsageContext = nil
+			} else {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.PatternUsageContext = &v
+			}
+		case "patternDosage":
+			if d.null() {
+				r.PatternDosage = nil
+			} else {
+				var v Dosage
+				v.decodeJSON(d)
+				r.PatternDosage = &v
+			}
+		case "patternMeta":
+			if d.null() {
+				r.PatternMeta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.PatternMeta = &v
+			}
+		case "example":
+			a := d.array()
+			r.Example = nil
+			for a.next() {
+				var v ElementDefinitionExample
+				v.decodeJSON(d)
+				r.Example = append(r.Example, v)
+			}
+			if r.Example == nil && !a.isNull {
+				r.Example = []ElementDefinitionExample{}
+			}
+		case "minValueDate":
+			if v, ok := d.string(); ok {
+				r.MinValueDate = &v
+			} else {
+				r.MinValueDate = nil
+			}
+		case "_minValueDate":
+			r.MinValueDateElement = d.element()
+		case "minValueDateTime":
+			if v, ok := d.string(); ok {
+				r.MinValueDateTime = &v
+			} else {
+				r.MinValueDateTime = nil
+			}
+		case "_minValueDateTime":
+			r.MinValueDateTimeElement = d.element()
+		case "minValueInstant":
+			if v, ok := d.string(); ok {
+				r.MinValueInstant = &v
+			} else {
+				r.MinValueInstant = nil
+			}
+		case "_minValueInstant":
+			r.MinValueInstantElement = d.element()
+		case "minValueTime":
+			if v, ok := d.string(); ok {
+				r.MinValueTime = &v
+			} else {
+				r.MinValueTime = nil
+			}
+		case "_minValueTime":
+			r.MinValueTimeElement = d.element()
+		case "minValueDecimal":
+			if v, ok := d.number(); ok {
+				r.MinValueDecimal = &v
+			} else {
+				r.MinValueDecimal = nil
+			}
+		case "_minValueDecimal":
+			r.MinValueDecimalElement = d.element()
+		case "minValueInteger":
+			if v, ok := d.int(); ok {
+				r.MinValueInteger = &v
+			} else {
+				r.MinValueInteger = nil
+			}
+		case "_minValueInteger":
+			r.MinValueIntegerElement = d.element()
+		case "minValuePositiveInt":
+			if v, ok := d.int(); ok {
+				r.MinValuePositiveInt = &v
+			} else {
+				r.MinValuePositiveInt = nil
+			}
+		case "_minValuePositiveInt":
+			r.MinValuePositiveIntElement = d.element()
+		case "minValueUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.MinValueUnsignedInt = &v
+			} else {
+				r.MinValueUnsignedInt = nil
+			}
+		case "_minValueUnsignedInt":
+			r.MinValueUnsignedIntElement = d.element()
+		case "minValueQuantity":
+			if d.null() {
+				r.MinValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MinValueQuantity = &v
+			}
+		case "maxValueDate":
+			if v, ok := d.string(); ok {
+				r.MaxValueDate = &v
+			} else {
+				r.MaxValueDate = nil
+			}
+		case "_maxValueDate":
+			r.MaxValueDateElement = d.element()
+		case "maxValueDateTime":
+			if v, ok := d.string(); ok {
+				r.MaxValueDateTime = &v
+			} else {
+				r.MaxValueDateTime = nil
+			}
+		case "_maxValueDateTime":
+			r.MaxValueDateTimeElement = d.element()
+		case "maxValueInstant":
+			if v, ok := d.string(); ok {
+				r.MaxValueInstant = &v
+			} else {
+				r.MaxValueInstant = nil
+			}
+		case "_maxValueInstant":
+			r.MaxValueInstantElement = d.element()
+		case "maxValueTime":
+			if v, ok := d.string(); ok {
+				r.MaxValueTime = &v
+			} else {
+				r.MaxValueTime = nil
+			}
+		case "_maxValueTime":
+			r.MaxValueTimeElement = d.element()
+		case "maxValueDecimal":
+			if v, ok := d.number(); ok {
+				r.MaxValueDecimal = &v
+			} else {
+				r.MaxValueDecimal = nil
+			}
+		case "_maxValueDecimal":
+			r.MaxValueDecimalElement = d.element()
+		case "maxValueInteger":
+			if v, ok := d.int(); ok {
+				r.MaxValueInteger = &v
+			} else {
+				r.MaxValueInteger = nil
+			}
+		case "_maxValueInteger":
+			r.MaxValueIntegerElement = d.element()
+		case "maxValuePositiveInt":
+			if v, ok := d.int(); ok {
+				r.MaxValuePositiveInt = &v
+			} else {
+				r.MaxValuePositiveInt = nil
+			}
+		case "_maxValuePositiveInt":
+			r.MaxValuePositiveIntElement = d.element()
+		case "maxValueUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.MaxValueUnsignedInt = &v
+			} else {
+				r.MaxValueUnsignedInt = nil
+			}
+		case "_maxValueUnsignedInt":
+			r.MaxValueUnsignedIntElement = d.element()
+		case "maxValueQuantity":
+			if d.null() {
+				r.MaxValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MaxValueQuantity = &v
+			}
+		case "maxLength":
+			if v, ok := d.int(); ok {
+				r.MaxLength = &v
+			} else {
+				r.MaxLength = nil
+			}
+		case "_maxLength":
+			r.MaxLengthElement = d.element()
+		case "condition":
+			a := d.array()
+			r.Condition = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Condition = append(r.Condition, v)
+			}
+			if r.Condition == nil && !a.isNull {
+				r.Condition = []string{}
+			}
+		case "_condition":
+			a := d.array()
+			r.ConditionElement = nil
+			for a.next() {
+				r.ConditionElement = append(r.ConditionElement, d.element())
+			}
+		case "constraint":
+			a := d.array()
+			r.Constraint = nil
+			for a.next() {
+				var v ElementDefinitionConstraint
+				v.decodeJSON(d)
+				r.Constraint = append(r.Constraint, v)
+			}
+			if r.Constraint == nil && !a.isNull {
+				r.Constraint = []ElementDefinitionConstraint{}
+			}
+		case "mustSupport":
+			if v, ok := d.bool(); ok {
+				r.MustSupport = &v
+			} else {
+				r.MustSupport = nil
+			}
+		case "_mustSupport":
+			r.MustSupportElement = d.element()
+		case "isModifier":
+			if v, ok := d.bool(); ok {
+				r.IsModifier = &v
+			} else {
+				r.IsModifier = nil
+			}
+		case "_isModifier":
+			r.IsModifierElement = d.element()
+		case "isModifierReason":
+			if v, ok := d.string(); ok {
+				r.IsModifierReason = &v
+			} else {
+				r.IsModifierReason = nil
+			}
+		case "_isModifierReason":
+			r.IsModifierReasonElement = d.element()
+		case "isSummary":
+			if v, ok := d.bool(); ok {
+				r.IsSummary = &v
+			} else {
+				r.IsSummary = nil
+			}
+		case "_isSummary":
+			r.IsSummaryElement = d.element()
+		case "binding":
+			if d.null() {
+				r.Binding = nil
+			} else {
+				var v ElementDefinitionBinding
+				v.decodeJSON(d)
+				r.Binding = &v
+			}
+		case "mapping":
+			a := d.array()
+			r.Mapping = nil
+			for a.next() {
+				var v ElementDefinitionMapping
+				v.decodeJSON(d)
+				r.Mapping = append(r.Mapping, v)
+			}
+			if r.Mapping == nil && !a.isNull {
+				r.Mapping = []ElementDefinitionMapping{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ElementDefinition which shares no memory with the original
+func (r ElementDefinition) DeepCopy() ElementDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	if r.Representation != nil {
+		out.Representation = make([]PropertyRepresentation, len(r.Representation))
+		copy(out.Representation, r.Representation)
+	}
+	if r.RepresentationElement != nil {
+		out.RepresentationElement = make([]*Element, len(r.RepresentationElement))
+		for i, v := range r.RepresentationElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.RepresentationElement[i] = &c
+			}
+		}
+	}
+	if r.SliceName != nil {
+		v := *r.SliceName
+		out.SliceName = &v
+	}
+	if r.SliceNameElement != nil {
+		v := r.SliceNameElement.DeepCopy()
+		out.SliceNameElement = &v
+	}
+	if r.SliceIsConstraining != nil {
+		v := *r.SliceIsConstraining
+		out.SliceIsConstraining = &v
+	}
+	if r.SliceIsConstrainingElement != nil {
+		v := r.SliceIsConstrainingElement.DeepCopy()
+		out.SliceIsConstrainingElement = &v
+	}
+	if r.Label != nil {
+		v := *r.Label
+		out.Label = &v
+	}
+	if r.LabelElement != nil {
+		v := r.LabelElement.DeepCopy()
+		out.LabelElement = &v
+	}
+	if r.Code != nil {
+		out.Code = make([]Coding, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Slicing != nil {
+		v := r.Slicing.DeepCopy()
+		out.Slicing = &v
+	}
+	if r.Short != nil {
+		v := *r.Short
+		out.Short = &v
+	}
+	if r.ShortElement != nil {
+		v := r.ShortElement.DeepCopy()
+		out.ShortElement = &v
+	}
+	if r.Definition != nil {
+		v := *r.Definition
+		out.Definition = &v
+	}
+	if r.DefinitionElement != nil {
+		v := r.DefinitionElement.DeepCopy()
+		out.DefinitionElement = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	if r.Requirements != nil {
+		v := *r.Requirements
+		out.Requirements = &v
+	}
+	if r.RequirementsElement != nil {
+		v := r.RequirementsElement.DeepCopy()
+		out.RequirementsElement = &v
+	}
+	if r.Alias != nil {
+		out.Alias = make([]string, len(r.Alias))
+		copy(out.Alias, r.Alias)
+	}
+	if r.AliasElement != nil {
+		out.AliasElement = make([]*Element, len(r.AliasElement))
+		for i, v := range r.AliasElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.AliasElement[i] = &c
+			}
+		}
+	}
+	if r.Min != nil {
+		v := *r.Min
+		out.Min = &v
+	}
+	if r.MinElement != nil {
+		v := r.MinElement.DeepCopy()
+		out.MinElement = &v
+	}
+	if r.Max != nil {
+		v := *r.Max
+		out.Max = &v
+	}
+	if r.MaxElement != nil {
+		v := r.MaxElement.DeepCopy()
+		out.MaxElement = &v
+	}
+	if r.Base != nil {
+		v := r.Base.DeepCopy()
+		out.Base = &v
+	}
+	if r.ContentReference != nil {
+		v := *r.ContentReference
+		out.ContentReference = &v
+	}
+	if r.ContentReferenceElement != nil {
+		v := r.ContentReferenceElement.DeepCopy()
+		out.ContentReferenceElement = &v
+	}
+	if r.Type != nil {
+		out.Type = make([]ElementDefinitionType, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.DefaultValueBase64Binary != nil {
+		v := *r.DefaultValueBase64Binary
+		out.DefaultValueBase64Binary = &v
+	}
+	if r.DefaultValueBase64BinaryElement != nil {
+		v := r.DefaultValueBase64BinaryElement.DeepCopy()
+		out.DefaultValueBase64BinaryElement = &v
+	}
+	if r.DefaultValueBoolean != nil {
+		v := *r.DefaultValueBoolean
+		out.DefaultValueBoolean = &v
+	}
+	if r.DefaultValueBooleanElement != nil {
+		v := r.DefaultValueBooleanElement.DeepCopy()
+		out.DefaultValueBooleanElement = &v
+	}
+	if r.DefaultValueCanonical != nil {
+		v := *r.DefaultValueCanonical
+		out.DefaultValueCanonical = &v
+	}
+	if r.DefaultValueCanonicalElement != nil {
+		v := r.DefaultValueCanonicalElement.DeepCopy()
+		out.DefaultValueCanonicalElement = &v
+	}
+	if r.DefaultValueCode != nil {
+		v := *r.DefaultValueCode
+		out.DefaultValueCode = &v
+	}
+	if r.DefaultValueCodeElement != nil {
+		v := r.DefaultValueCodeElement.DeepCopy()
+		out.DefaultValueCodeElement = &v
+	}
+	if r.DefaultValueDate != nil {
+		v := *r.DefaultValueDate
+		out.DefaultValueDate = &v
+	}
+	if r.DefaultValueDateElement != nil {
+		v := r.DefaultValueDateElement.DeepCopy()
+		out.DefaultValueDateElement = &v
+	}
+	if r.DefaultValueDateTime != nil {
+		v := *r.DefaultValueDateTime
+		out.DefaultValueDateTime = &v
+	}
+	if r.DefaultValueDateTimeElement != nil {
+		v := r.DefaultValueDateTimeElement.DeepCopy()
+		out.DefaultValueDateTimeElement = &v
+	}
+	if r.DefaultValueDecimal != nil {
+		v := *r.DefaultValueDecimal
+		out.DefaultValueDecimal = &v
+	}
+	if r.DefaultValueDecimalElement != nil {
+		v := r.DefaultValueDecimalElement.DeepCopy()
+		out.DefaultValueDecimalElement = &v
+	}
+	if r.DefaultValueId != nil {
+		v := *r.DefaultValueId
+		out.DefaultValueId = &v
+	}
+	if r.DefaultValueIdElement != nil {
+		v := r.DefaultValueIdElement.DeepCopy()
+		out.DefaultValueIdElement = &v
+	}
+	if r.DefaultValueInstant != nil {
+		v := *r.DefaultValueInstant
+		out.DefaultValueInstant = &v
+	}
+	if r.DefaultValueInstantElement != nil {
+		v := r.DefaultValueInstantElement.DeepCopy()
+		out.DefaultValueInstantElement = &v
+	}
+	if r.DefaultValueInteger != nil {
+		v := *r.DefaultValueInteger
+		out.DefaultValueInteger = &v
+	}
+	if r.DefaultValueIntegerElement != nil {
+		v := r.DefaultValueIntegerElement.DeepCopy()
+		out.DefaultValueIntegerElement = &v
+	}
+	if r.DefaultValueMarkdown != nil {
+		v := *r.DefaultValueMarkdown
+		out.DefaultValueMarkdown = &v
+	}
+	if r.DefaultValueMarkdownElement != nil {
+		v := r.DefaultValueMarkdownElement.DeepCopy()
+		out.DefaultValueMarkdownElement = &v
+	}
+	if r.DefaultValueOid != nil {
+		v := *r.DefaultValueOid
+		out.DefaultValueOid = &v
+	}
+	if r.DefaultValueOidElement != nil {
+		v := r.DefaultValueOidElement.DeepCopy()
+		out.DefaultValueOidElement = &v
+	}
+	if r.DefaultValuePositiveInt != nil {
+		v := *r.DefaultValuePositiveInt
+		out.DefaultValuePositiveInt = &v
+	}
+	if r.DefaultValuePositiveIntElement != nil {
+		v := r.DefaultValuePositiveIntElement.DeepCopy()
+		out.DefaultValuePositiveIntElement = &v
+	}
+	if r.DefaultValueString != nil {
+		v := *r.DefaultValueString
+		out.DefaultValueString = &v
+	}
+	if r.DefaultValueStringElement != nil {
+		v := r.DefaultValueStringElement.DeepCopy()
+		out.DefaultValueStringElement = &v
+	}
+	if r.DefaultValueTime != nil {
+		v := *r.DefaultValueTime
+		out.DefaultValueTime = &v
+	}
+	if r.DefaultValueTimeElement != nil {
+		v := r.DefaultValueTimeElement.DeepCopy()
+		out.DefaultValueTimeElement = &v
+	}
+	if r.DefaultValueUnsignedInt != nil {
+		v := *r.DefaultValueUnsignedInt
+		out.DefaultValueUnsignedInt = &v
+	}
+	if r.DefaultValueUnsignedIntElement != nil {
+		v := r.DefaultValueUnsignedIntElement.DeepCopy()
+		out.DefaultValueUnsignedIntElement = &v
+	}
+	if r.DefaultValueUri != nil {
+		v := *r.DefaultValueUri
+		out.DefaultValueUri = &v
+	}
+	if r.DefaultValueUriElement != nil {
+		v := r.DefaultValueUriElement.DeepCopy()
+		out.DefaultValueUriElement = &v
+	}
+	if r.DefaultValueUrl != nil {
+		v := *r.DefaultValueUrl
+		out.DefaultValueUrl = &v
+	}
+	if r.DefaultValueUrlElement != nil {
+		v := r.DefaultValueUrlElement.DeepCopy()
+		out.DefaultValueUrlElement = &v
+	}
+	if r.DefaultValueUuid != nil {
+		v := *r.DefaultValueUuid
+		out.DefaultValueUuid = &v
+	}
+	if r.DefaultValueUuidElement != nil {
+		v := r.DefaultValueUuidElement.DeepCopy()
+		out.DefaultValueUuidElement = &v
+	}
+	if r.DefaultValueAddress != nil {
+		v := r.DefaultValueAddress.DeepCopy()
+		out.DefaultValueAddress = &v
+	}
+	if r.DefaultValueAge != nil {
+		v := r.DefaultValueAge.DeepCopy()
+		out.DefaultValueAge = &v
+	}
+	if r.DefaultValueAnnotation != nil {
+		v := r.DefaultValueAnnotation.DeepCopy()
+		out.DefaultValueAnnotation = &v
+	}
+	if r.DefaultValueAttachment != nil {
+		v := r.DefaultValueAttachment.DeepCopy()
+		out.DefaultValueAttachment = &v
+	}
+	if r.DefaultValueCodeableConcept != nil {
+		v := r.DefaultValueCodeableConcept.DeepCopy()
+		out.DefaultValueCodeableConcept = &v
+	}
+	if r.DefaultValueCoding != nil {
+		v := r.DefaultValueCoding.DeepCopy()
+		out.DefaultValueCoding = &v
+	}
+	if r.DefaultValueContactPoint != nil {
+		v := r.DefaultValueContactPoint.DeepCopy()
+		out.DefaultValueContactPoint = &v
+	}
+	if r.DefaultValueCount != nil {
+		v := r.DefaultValueCount.DeepCopy()
+		out.DefaultValueCount = &v
+	}
+	if r.DefaultValueDistance != nil {
+		v := r.DefaultValueDistance.DeepCopy()
+		out.DefaultValueDistance = &v
+	}
+	if r.DefaultValueDuration != nil {
+		v := r.DefaultValueDuration.DeepCopy()
+		out.DefaultValueDuration = &v
+	}
+	if r.DefaultValueHumanName != nil {
+		v := r.DefaultValueHumanName.DeepCopy()
+		out.DefaultValueHumanName = &v
+	}
+	if r.DefaultValueIdentifier != nil {
+		v := r.DefaultValueIdentifier.DeepCopy()
+		out.DefaultValueIdentifier = &v
+	}
+	if r.DefaultValueMoney != nil {
+		v := r.DefaultValueMoney.DeepCopy()
+		out.DefaultValueMoney = &v
+	}
+	if r.DefaultValuePeriod != nil {
+		v := r.DefaultValuePeriod.DeepCopy()
+		out.DefaultValuePeriod = &v
+	}
+	if r.DefaultValueQuantity != nil {
+		v := r.DefaultValueQuantity.DeepCopy()
+		out.DefaultValueQuantity = &v
+	}
+	if r.DefaultValueRange != nil {
+		v := r.DefaultValueRange.DeepCopy()
+		out.DefaultValueRange = &v
+	}
+	if r.DefaultValueRatio != nil {
+		v := r.DefaultValueRatio.DeepCopy()
+		out.DefaultValueRatio = &v
+	}
+	if r.DefaultValueReference != nil {
+		v := r.DefaultValueReference.DeepCopy()
+		out.DefaultValueReference = &v
+	}
+	if r.DefaultValueSampledData != nil {
+		v := r.DefaultValueSampledData.DeepCopy()
+		out.DefaultValueSampledData = &v
+	}
+	if r.DefaultValueSignature != nil {
+		v := r.DefaultValueSignature.DeepCopy()
+		out.DefaultValueSignature = &v
+	}
+	if r.DefaultValueTiming != nil {
+		v := r.DefaultValueTiming.DeepCopy()
+		out.DefaultValueTiming = &v
+	}
+	if r.DefaultValueContactDetail != nil {
+		v := r.DefaultValueContactDetail.DeepCopy()
+		out.DefaultValueContactDetail = &v
+	}
+	if r.DefaultValueContributor != nil {
+		v := r.DefaultValueContributor.DeepCopy()
+		out.DefaultValueContributor = &v
+	}
+	if r.DefaultValueDataRequirement != nil {
+		v := r.DefaultValueDataRequirement.DeepCopy()
+		out.DefaultValueDataRequirement = &v
+	}
+	if r.DefaultValueExpression != nil {
+		v := r.DefaultValueExpression.DeepCopy()
+		out.DefaultValueExpression = &v
+	}
+	if r.DefaultValueParameterDefinition != nil {
+		v := r.DefaultValueParameterDefinition.DeepCopy()
+		out.DefaultValueParameterDefinition = &v
+	}
+	if r.DefaultValueRelatedArtifact != nil {
+		v := r.DefaultValueRelatedArtifact.DeepCopy()
+		out.DefaultValueRelatedArtifact = &v
+	}
+	if r.DefaultValueTriggerDefinition != nil {
+		v := r.DefaultValueTriggerDefinition.DeepCopy()
+		out.DefaultValueTriggerDefinition = &v
+	}
+	if r.DefaultValueUsageContext != nil {
+		v := r.DefaultValueUsageContext.DeepCopy()
+		out.DefaultValueUsageContext = &v
+	}
+	if r.DefaultValueDosage != nil {
+		v := r.DefaultValueDosage.DeepCopy()
+		out.DefaultValueDosage = &v
+	}
+	if r.DefaultValueMeta != nil {
+		v := r.DefaultValueMeta.DeepCopy()
+		out.DefaultValueMeta = &v
+	}
+	if r.MeaningWhenMissing != nil {
+		v := *r.MeaningWhenMissing
+		out.MeaningWhenMissing = &v
+	}
+	if r.MeaningWhenMissingElement != nil {
+		v := r.MeaningWhenMissingElement.DeepCopy()
+		out.MeaningWhenMissingElement = &v
+	}
+	if r.OrderMeaning != nil {
+		v := *r.OrderMeaning
+		out.OrderMeaning = &v
+	}
+	if r.OrderMeaningElement != nil {
+		v := r.OrderMeaningElement.DeepCopy()
+		out.OrderMeaningElement = &v
+	}
+	if r.FixedBase64Binary != nil {
+		v := *r.FixedBase64Binary
+		out.FixedBase64Binary = &v
+	}
+	if r.FixedBase64BinaryElement != nil {
+		v := r.FixedBase64BinaryElement.DeepCopy()
+		out.FixedBase64BinaryElement = &v
+	}
+	if r.FixedBoolean != nil {
+		v := *r.FixedBoolean
+		out.FixedBoolean = &v
+	}
+	if r.FixedBooleanElement != nil {
+		v := r.FixedBooleanElement.DeepCopy()
+		out.FixedBooleanElement = &v
+	}
+	if r.FixedCanonical != nil {
+		v := *r.FixedCanonical
+		out.FixedCanonical = &v
+	}
+	if r.FixedCanonicalElement != nil {
+		v := r.FixedCanonicalElement.DeepCopy()
+		out.FixedCanonicalElement = &v
+	}
+	if r.FixedCode != nil {
+		v := *r.FixedCode
+		out.FixedCode = &v
+	}
+	if r.FixedCodeElement != nil {
+		v := r.FixedCodeElement.DeepCopy()
+		out.FixedCodeElement = &v
+	}
+	if r.FixedDate != nil {
+		v := *r.FixedDate
+		out.FixedDate = &v
+	}
+	if r.FixedDateElement != nil {
+		v := r.FixedDateElement.DeepCopy()
+		out.FixedDateElement = &v
+	}
+	if r.FixedDateTime != nil {
+		v := *r.FixedDateTime
+		out.FixedDateTime = &v
+	}
+	if r.FixedDateTimeElement != nil {
+		v := r.FixedDateTimeElement.DeepCopy()
+		out.FixedDateTimeElement = &v
+	}
+	if r.FixedDecimal != nil {
+		v := *r.FixedDecimal
+		out.FixedDecimal = &v
+	}
+	if r.FixedDecimalElement != nil {
+		v := r.FixedDecimalElement.DeepCopy()
+		out.FixedDecimalElement = &v
+	}
+	if r.FixedId != nil {
+		v := *r.FixedId
+		out.FixedId = &v
+	}
+	if r.FixedIdElement != nil {
+		v := r.FixedIdElement.DeepCopy()
+		out.FixedIdElement = &v
+	}
+	if r.FixedInstant != nil {
+		v := *r.FixedInstant
+		out.FixedInstant = &v
+	}
+	if r.FixedInstantElement != nil {
+		v := r.FixedInstantElement.DeepCopy()
+		out.FixedInstantElement = &v
+	}
+	if r.FixedInteger != nil {
+		v := *r.FixedInteger
+		out.FixedInteger = &v
+	}
+	if r.FixedIntegerElement != nil {
+		v := r.FixedIntegerElement.DeepCopy()
+		out.FixedIntegerElement = &v
+	}
+	if r.FixedMarkdown != nil {
+		v := *r.FixedMarkdown
+		out.FixedMarkdown = &v
+	}
+	if r.FixedMarkdownElement != nil {
+		v := r.FixedMarkdownElement.DeepCopy()
+		out.FixedMarkdownElement = &v
+	}
+	if r.FixedOid != nil {
+		v := *r.FixedOid
+		out.FixedOid = &v
+	}
+	if r.FixedOidElement != nil {
+		v := r.FixedOidElement.DeepCopy()
+		out.FixedOidElement = &v
+	}
+	if r.FixedPositiveInt != nil {
+		v := *r.FixedPositiveInt
+		out.FixedPositiveInt = &v
+	}
+	if r.FixedPositiveIntElement != nil {
+		v := r.FixedPositiveIntElement.DeepCopy()
+		out.FixedPositiveIntElement = &v
+	}
+	if r.FixedString != nil {
+		v := *r.FixedString
+		out.FixedString = &v
+	}
+	if r.FixedStringElement != nil {
+		v := r.FixedStringElement.DeepCopy()
+		out.FixedStringElement = &v
+	}
+	if r.FixedTime != nil {
+		v := *r.FixedTime
+		out.FixedTime = &v
+	}
+	if r.FixedTimeElement != nil {
+		v := r.FixedTimeElement.DeepCopy()
+		out.FixedTimeElement = &v
+	}
+	if r.FixedUnsignedInt != nil {
+		v := *r.FixedUnsignedInt
+		out.FixedUnsignedInt = &v
+	}
+	if r.FixedUnsignedIntElement != nil {
+		v := r.FixedUnsignedIntElement.DeepCopy()
+		out.FixedUnsignedIntElement = &v
+	}
+	if r.FixedUri != nil {
+		v := *r.FixedUri
+		out.FixedUri = &v
+	}
+	if r.FixedUriElement != nil {
+		v := r.FixedUriElement.DeepCopy()
+		out.FixedUriElement = &v
+	}
+	if r.FixedUrl != nil {
+		v := *r.FixedUrl
+		out.FixedUrl = &v
+	}
+	if r.FixedUrlElement != nil {
+		v := r.FixedUrlElement.DeepCopy()
+		out.FixedUrlElement = &v
+	}
+	if r.FixedUuid != nil {
+		v := *r.FixedUuid
+		out.FixedUuid = &v
+	}
+	if r.FixedUuidElement != nil {
+		v := r.FixedUuidElement.DeepCopy()
+		out.FixedUuidElement = &v
+	}
+	if r.FixedAddress != nil {
+		v := r.FixedAddress.DeepCopy()
+		out.FixedAddress = &v
+	}
+	if r.FixedAge != nil {
+		v := r.FixedAge.DeepCopy()
+		out.FixedAge = &v
+	}
+	if r.FixedAnnotation != nil {
+		v := r.FixedAnnotation.DeepCopy()
+		out.FixedAnnotation = &v
+	}
+	if r.FixedAttachment != nil {
+		v := r.FixedAttachment.DeepCopy()
+		out.FixedAttachment = &v
+	}
+	if r.FixedCodeableConcept != nil {
+		v := r.FixedCodeableConcept.DeepCopy()
+		out.FixedCodeableConcept = &v
+	}
+	if r.FixedCoding != nil {
+		v := r.FixedCoding.DeepCopy()
+		out.FixedCoding = &v
+	}
+	if r.FixedContactPoint != nil {
+		v := r.FixedContactPoint.DeepCopy()
+		out.FixedContactPoint = &v
+	}
+	if r.FixedCount != nil {
+		v := r.FixedCount.DeepCopy()
+		out.FixedCount = &v
+	}
+	if r.FixedDistance != nil {
+		v := r.FixedDistance.DeepCopy()
+		out.FixedDistance = &v
+	}
+	if r.FixedDuration != nil {
+		v := r.FixedDuration.DeepCopy()
+		out.FixedDuration = &v
+	}
+	if r.FixedHumanName != nil {
+		v := r.FixedHumanName.DeepCopy()
+		out.FixedHumanName = &v
+	}
+	if r.FixedIdentifier != nil {
+		v := r.FixedIdentifier.DeepCopy()
+		out.FixedIdentifier = &v
+	}
+	if r.FixedMoney != nil {
+		v := r.FixedMoney.DeepCopy()
+		out.FixedMoney = &v
+	}
+	if r.FixedPeriod != nil {
+		v := r.FixedPeriod.DeepCopy()
+		out.FixedPeriod = &v
+	}
+	if r.FixedQuantity != nil {
+		v := r.FixedQuantity.DeepCopy()
+		out.FixedQuantity = &v
+	}
+	if r.FixedRange != nil {
+		v := r.FixedRange.DeepCopy()
+		out.FixedRange = &v
+	}
+	if r.FixedRatio != nil {
+		v := r.FixedRatio.DeepCopy()
+		out.FixedRatio = &v
+	}
+	if r.FixedReference != nil {
+		v := r.FixedReference.DeepCopy()
+		out.FixedReference = &v
+	}
+	if r.FixedSampledData != nil {
+		v := r.FixedSampledData.DeepCopy()
+		out.FixedSampledData = &v
+	}
+	if r.FixedSignature != nil {
+		v := r.FixedSignature.DeepCopy()
+		out.FixedSignature = &v
+	}
+	if r.FixedTiming != nil {
+		v := r.FixedTiming.DeepCopy()
+		out.FixedTiming = &v
+	}
+	if r.FixedContactDetail != nil {
+		v := r.FixedContactDetail.DeepCopy()
+		out.FixedContactDetail = &v
+	}
+	if r.FixedContributor != nil {
+		v := r.FixedContributor.DeepCopy()
+		out.FixedContributor = &v
+	}
+	if r.FixedDataRequirement != nil {
+		v := r.FixedDataRequirement.DeepCopy()
+		out.FixedDataRequirement = &v
+	}
+	if r.FixedExpression != nil {
+		v := r.FixedExpression.DeepCopy()
+		out.FixedExpression = &v
+	}
+	if r.FixedParameterDefinition != nil {
+		v := r.FixedParameterDefinition.DeepCopy()
+		out.FixedParameterDefinition = &v
+	}
+	if r.FixedRelatedArtifact != nil {
+		v := r.FixedRelatedArtifact.DeepCopy()
+		out.FixedRelatedArtifact = &v
+	}
+	if r.FixedTriggerDefinition != nil {
+		v := r.FixedTriggerDefinition.DeepCopy()
+		out.FixedTriggerDefinition = &v
+	}
+	if r.FixedUsageContext != nil {
+		v := r.FixedUsageContext.DeepCopy()
+		out.FixedUsageContext = &v
+	}
+	if r.FixedDosage != nil {
+		v := r.FixedDosage.DeepCopy()
+		out.FixedDosage = &v
+	}
+	if r.FixedMeta != nil {
+		v := r.FixedMeta.DeepCopy()
+		out.FixedMeta = &v
+	}
+	if r.PatternBase64Binary != nil {
+		v := *r.PatternBase64Binary
+		out.PatternBase64Binary = &v
+	}
+	if r.PatternBase64BinaryElement != nil {
+		v := r.PatternBase64BinaryElement.DeepCopy()
+		out.PatternBase64BinaryElement = &v
+	}
+	if r.PatternBoolean != nil {
+		v := *r.PatternBoolean
+		out.PatternBoolean = &v
+	}
+	if r.PatternBooleanElement != nil {
+		v := r.PatternBooleanElement.DeepCopy()
+		out.PatternBooleanElement = &v
+	}
+	if r.PatternCanonical != nil {
+		v := *r.PatternCanonical
+		out.PatternCanonical = &v
+	}
+	if r.PatternCanonicalElement != nil {
+		v := r.PatternCanonicalElement.DeepCopy()
+		out.PatternCanonicalElement = &v
+	}
+	if r.PatternCode != nil {
+		v := *r.PatternCode
+		out.PatternCode = &v
+	}
+	if r.PatternCodeElement != nil {
+		v := r.PatternCodeElement.DeepCopy()
+		out.PatternCodeElement = &v
+	}
+	if r.PatternDate != nil {
+		v := *r.PatternDate
+		out.PatternDate = &v
+	}
+	if r.PatternDateElement != nil {
+		v := r.PatternDateElement.DeepCopy()
+		out.PatternDateElement = &v
+	}
+	if r.PatternDateTime != nil {
+		v := *r.PatternDateTime
+		out.PatternDateTime = &v
+	}
+	if r.PatternDateTimeElement != nil {
+		v := r.PatternDateTimeElement.DeepCopy()
+		out.PatternDateTimeElement = &v
+	}
+	if r.PatternDecimal != nil {
+		v := *r.PatternDecimal
+		out.PatternDecimal = &v
+	}
+	if r.PatternDecimalElement != nil {
+		v := r.PatternDecimalElement.DeepCopy()
+		out.PatternDecimalElement = &v
+	}
+	if r.PatternId != nil {
+		v := *r.PatternId
+		out.PatternId = &v
+	}
+	if r.PatternIdElement != nil {
+		v := r.PatternIdElement.DeepCopy()
+		out.PatternIdElement = &v
+	}
+	if r.PatternInstant != nil {
+		v := *r.PatternInstant
+		out.PatternInstant = &v
+	}
+	if r.PatternInstantElement != nil {
+		v := r.PatternInstantElement.DeepCopy()
+		out.PatternInstantElement = &v
+	}
+	if r.PatternInteger != nil {
+		v := *r.PatternInteger
+		out.PatternInteger = &v
+	}
+	if r.PatternIntegerElement != nil {
+		v := r.PatternIntegerElement.DeepCopy()
+		out.PatternIntegerElement = &v
+	}
+	if r.PatternMarkdown != nil {
+		v := *r.PatternMarkdown
+		out.PatternMarkdown = &v
+	}
+	if r.PatternMarkdownElement != nil {
+		v := r.PatternMarkdownElement.DeepCopy()
+		out.PatternMarkdownElement = &v
+	}
+	if r.PatternOid != nil {
+		v := *r.PatternOid
+		out.PatternOid = &v
+	}
+	if r.PatternOidElement != nil {
+		v := r.PatternOidElement.DeepCopy()
+		out.PatternOidElement = &v
+	}
+	if r.PatternPositiveInt != nil {
+		v := *r.PatternPositiveInt
+		out.PatternPositiveInt = &v
+	}
+	if r.PatternPositiveIntElement != nil {
+		v := r.PatternPositiveIntElement.DeepCopy()
+		out.PatternPositiveIntElement = &v
+	}
+	if r.PatternString != nil {
+		v := *r.PatternString
+		out.PatternString = &v
+	}
+	if r.PatternStringElement != nil {
+		v := r.PatternStringElement.DeepCopy()
+		out.PatternStringElement = &v
+	}
+	if r.PatternTime != nil {
+		v := *r.PatternTime
+		out.PatternTime = &v
+	}
+	if r.PatternTimeElement != nil {
+		v := r.PatternTimeElement.DeepCopy()
+		out.PatternTimeElement = &v
+	}
+	if r.PatternUnsignedInt != nil {
+		v := *r.PatternUnsignedInt
+		out.PatternUnsignedInt = &v
+	}
+	if r.PatternUnsignedIntElement != nil {
+		v := r.PatternUnsignedIntElement.DeepCopy()
+		out.PatternUnsignedIntElement = &v
+	}
+	if r.PatternUri != nil {
+		v := *r.PatternUri
+		out.PatternUri = &v
+	}
+	if r.PatternUriElement != nil {
+		v := r.PatternUriElement.DeepCopy()
+		out.PatternUriElement = &v
+	}
+	if r.PatternUrl != nil {
+		v := *r.PatternUrl
+		out.PatternUrl = &v
+	}
+	if r.PatternUrlElement != nil {
+		v := r.PatternUrlElement.DeepCopy()
+		out.PatternUrlElement = &v
+	}
+	if r.PatternUuid != nil {
+		v := *r.PatternUuid
+		out.PatternUuid = &v
+	}
+	if r.PatternUuidElement != nil {
+		v := r.PatternUuidElement.DeepCopy()
+		out.PatternUuidElement = &v
+	}
+	if r.PatternAddress != nil {
+		v := r.PatternAddress.DeepCopy()
+		out.PatternAddress = &v
+	}
+	if r.PatternAge != nil {
+		v := r.PatternAge.DeepCopy()
+		out.PatternAge = &v
+	}
+	if r.PatternAnnotation != nil {
+		v := r.PatternAnnotation.DeepCopy()
+		out.PatternAnnotation = &v
+	}
+	if r.PatternAttachment != nil {
+		v := r.PatternAttachment.DeepCopy()
+		out.PatternAttachment = &v
+	}
+	if r.PatternCodeableConcept != nil {
+		v := r.PatternCodeableConcept.DeepCopy()
+		out.PatternCodeableConcept = &v
+	}
+	if r.PatternCoding != nil {
+		v := r.PatternCoding.DeepCopy()
+		out.PatternCoding = &v
+	}
+	if r.PatternContactPoint != nil {
+		v := r.PatternContactPoint.DeepCopy()
+		out.PatternContactPoint = &v
+	}
+	if r.PatternCount != nil {
+		v := r.PatternCount.DeepCopy()
+		out.PatternCount = &v
+	}
+	if r.PatternDistance != nil {
+		v := r.PatternDistance.DeepCopy()
+		out.PatternDistance = &v
+	}
+	if r.PatternDuration != nil {
+		v := r.PatternDuration.DeepCopy()
+		out.PatternDuration = &v
+	}
+	if r.PatternHumanName != nil {
+		v := r.PatternHumanName.DeepCopy()
+		out.PatternHumanName = &v
+	}
+	if r.PatternIdentifier != nil {
+		v := r.PatternIdentifier.DeepCopy()
+		out.PatternIdentifier = &v
+	}
+	if r.PatternMoney != nil {
+		v := r.PatternMoney.DeepCopy()
+		out.PatternMoney = &v
+	}
+	if r.PatternPeriod != nil {
+		v := r.PatternPeriod.DeepCopy()
+		out.PatternPeriod = &v
+	}
+	if r.PatternQuantity != nil {
+		v := r.PatternQuantity.DeepCopy()
+		out.PatternQuantity = &v
+	}
+	if r.PatternRange != nil {
+		v := r.PatternRange.DeepCopy()
+		out.PatternRange = &v
+	}
+	if r.PatternRatio != nil {
+		v := r.PatternRatio.DeepCopy()
+		out.PatternRatio = &v
+	}
+	if r.PatternReference != nil {
+		v := r.PatternReference.DeepCopy()
+		out.PatternReference = &v
+	}
+	if r.PatternSampledData != nil {
+		v := r.PatternSampledData.DeepCopy()
+		out.PatternSampledData = &v
+	}
+	if r.PatternSignature != nil {
+		v := r.PatternSignature.DeepCopy()
+		out.PatternSignature = &v
+	}
+	if r.PatternTiming != nil {
+		v := r.PatternTiming.DeepCopy()
+		out.PatternTiming = &v
+	}
+	if r.PatternContactDetail != nil {
+		v := r.PatternContactDetail.DeepCopy()
+		out.PatternContactDetail = &v
+	}
+	if r.PatternContributor != nil {
+		v := r.PatternContributor.DeepCopy()
+		out.PatternContributor = &v
+	}
+	if r.PatternDataRequirement != nil {
+		v := r.PatternDataRequirement.DeepCopy()
+		out.PatternDataRequirement = &v
+	}
+	if r.PatternExpression != nil {
+		v := r.PatternExpression.DeepCopy()
+		out.PatternExpression = &v
+	}
+	if r.PatternParameterDefinition != nil {
+		v := r.PatternParameterDefinition.DeepCopy()
+		out.PatternParameterDefinition = &v
+	}
+	if r.PatternRelatedArtifact != nil {
+		v := r.PatternRelatedArtifact.DeepCopy()
+		out.PatternRelatedArtifact = &v
+	}
+	if r.PatternTriggerDefinition != nil {
+		v := r.PatternTriggerDefinition.DeepCopy()
+		out.PatternTriggerDefinition = &v
+	}
+	if r.PatternUsageContext != nil {
+		v := r.PatternUsageContext.DeepCopy()
+		out.PatternUsageContext = &v
+	}
+	if r.PatternDosage != nil {
+		v := r.PatternDosage.DeepCopy()
+		out.PatternDosage = &v
+	}
+	if r.PatternMeta != nil {
+		v := r.PatternMeta.DeepCopy()
+		out.PatternMeta = &v
+	}
+	if r.Example != nil {
+		out.Example = make([]ElementDefinitionExample, len(r.Example))
+		for i := range r.Example {
+			out.Example[i] = r.Example[i].DeepCopy()
+		}
+	}
+	if r.MinValueDate != nil {
+		v := *r.MinValueDate
+		out.MinValueDate = &v
+	}
+	if r.MinValueDateElement != nil {
+		v := r.MinValueDateElement.DeepCopy()
+		out.MinValueDateElement = &v
+	}
+	if r.MinValueDateTime != nil {
+		v := *r.MinValueDateTime
+		out.MinValueDateTime = &v
+	}
+	if r.MinValueDateTimeElement != nil {
+		v := r.MinValueDateTimeElement.DeepCopy()
+		out.MinValueDateTimeElement = &v
+	}
+	if r.MinValueInstant != nil {
+		v := *r.MinValueInstant
+		out.MinValueInstant = &v
+	}
+	if r.MinValueInstantElement != nil {
+		v := r.MinValueInstantElement.DeepCopy()
+		out.MinValueInstantElement = &v
+	}
+	if r.MinValueTime != nil {
+		v := *r.MinValueTime
+		out.MinValueTime = &v
+	}
+	if r.MinValueTimeElement != nil {
+		v := r.MinValueTimeElement.DeepCopy()
+		out.MinValueTimeElement = &v
+	}
+	if r.MinValueDecimal != nil {
+		v := *r.MinValueDecimal
+		out.MinValueDecimal = &v
+	}
+	if r.MinValueDecimalElement != nil {
+		v := r.MinValueDecimalElement.DeepCopy()
+		out.MinValueDecimalElement = &v
+	}
+	if r.MinValueInteger != nil {
+		v := *r.MinValueInteger
+		out.MinValueInteger = &v
+	}
+	if r.MinValueIntegerElement != nil {
+		v := r.MinValueIntegerElement.DeepCopy()
+		out.MinValueIntegerElement = &v
+	}
+	if r.MinValuePositiveInt != nil {
+		v := *r.MinValuePositiveInt
+		out.MinValuePositiveInt = &v
+	}
+	if r.MinValuePositiveIntElement != nil {
+		v := r.MinValuePositiveIntElement.DeepCopy()
+		out.MinValuePositiveIntElement = &v
+	}
+	if r.MinValueUnsignedInt != nil {
+		v := *r.MinValueUnsignedInt
+		out.MinValueUnsignedInt = &v
+	}
+	if r.MinValueUnsignedIntElement != nil {
+		v := r.MinValueUnsignedIntElement.DeepCopy()
+		out.MinValueUnsignedIntElement = &v
+	}
+	if r.MinValueQuantity != nil {
+		v := r.MinValueQuantity.DeepCopy()
+		out.MinValueQuantity = &v
+	}
+	if r.MaxValueDate != nil {
+		v := *r.MaxValueDate
+		out.MaxValueDate = &v
+	}
+	if r.MaxValueDateElement != nil {
+		v := r.MaxValueDateElement.DeepCopy()
+		out.MaxValueDateElement = &v
+	}
+	if r.MaxValueDateTime != nil {
+		v := *r.MaxValueDateTime
+		out.MaxValueDateTime = &v
+	}
+	if r.MaxValueDateTimeElement != nil {
+		v := r.MaxValueDateTimeElement.DeepCopy()
+		out.MaxValueDateTimeElement = &v
+	}
+	if r.MaxValueInstant != nil {
+		v := *r.MaxValueInstant
+		out.MaxValueInstant = &v
+	}
+	if r.MaxValueInstantElement != nil {
+		v := r.MaxValueInstantElement.DeepCopy()
+		out.MaxValueInstantElement = &v
+	}
+	if r.MaxValueTime != nil {
+		v := *r.MaxValueTime
+		out.MaxValueTime = &v
+	}
+	if r.MaxValueTimeElement != nil {
+		v := r.MaxValueTimeElement.DeepCopy()
+		out.MaxValueTimeElement = &v
+	}
+	if r.MaxValueDecimal != nil {
+		v := *r.MaxValueDecimal
+		out.MaxValueDecimal = &v
+	}
+	if r.MaxValueDecimalElement != nil {
+		v := r.MaxValueDecimalElement.DeepCopy()
+		out.MaxValueDecimalElement = &v
+	}
+	if r.MaxValueInteger != nil {
+		v := *r.MaxValueInteger
+		out.MaxValueInteger = &v
+	}
+	if r.MaxValueIntegerElement != nil {
+		v := r.MaxValueIntegerElement.DeepCopy()
+		out.MaxValueIntegerElement = &v
+	}
+	if r.MaxValuePositiveInt != nil {
+		v := *r.MaxValuePositiveInt
+		out.MaxValuePositiveInt = &v
+	}
+	if r.MaxValuePositiveIntElement != nil {
+		v := r.MaxValuePositiveIntElement.DeepCopy()
+		out.MaxValuePositiveIntElement = &v
+	}
+	if r.MaxValueUnsignedInt != nil {
+		v := *r.MaxValueUnsignedInt
+		out.MaxValueUnsignedInt = &v
+	}
+	if r.MaxValueUnsignedIntElement != nil {
+		v := r.MaxValueUnsignedIntElement.DeepCopy()
+		out.MaxValueUnsignedIntElement = &v
+	}
+	if r.MaxValueQuantity != nil {
+		v := r.MaxValueQuantity.DeepCopy()
+		out.MaxValueQuantity = &v
+	}
+	if r.MaxLength != nil {
+		v := *r.MaxLength
+		out.MaxLength = &v
+	}
+	if r.MaxLengthElement != nil {
+		v := r.MaxLengthElement.DeepCopy()
+		out.MaxLengthElement = &v
+	}
+	if r.Condition != nil {
+		out.Condition = make([]string, len(r.Condition))
+		copy(out.Condition, r.Condition)
+	}
+	if r.ConditionElement != nil {
+		out.ConditionElement = make([]*Element, len(r.ConditionElement))
+		for i, v := range r.ConditionElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ConditionElement[i] = &c
+			}
+		}
+	}
+	if r.Constraint != nil {
+		out.Constraint = make([]ElementDefinitionConstraint, len(r.Constraint))
+		for i := range r.Constraint {
+			out.Constraint[i] = r.Constraint[i].DeepCopy()
+		}
+	}
+	if r.MustSupport != nil {
+		v := *r.MustSupport
+		out.MustSupport = &v
+	}
+	if r.MustSupportElement != nil {
+		v := r.MustSupportElement.DeepCopy()
+		out.MustSupportElement = &v
+	}
+	if r.IsModifier != nil {
+		v := *r.IsModifier
+		out.IsModifier = &v
+	}
+	if r.IsModifierElement != nil {
+		v := r.IsModifierElement.DeepCopy()
+		out.IsModifierElement = &v
+	}
+	if r.IsModifierReason != nil {
+		v := *r.IsModifierReason
+		out.IsModifierReason = &v
+	}
+	if r.IsModifierReasonElement != nil {
+		v := r.IsModifierReasonElement.DeepCopy()
+		out.IsModifierReasonElement = &v
+	}
+	if r.IsSummary != nil {
+		v := *r.IsSummary
+		out.IsSummary = &v
+	}
+	if r.IsSummaryElement != nil {
+		v := r.IsSummaryElement.DeepCopy()
+		out.IsSummaryElement = &v
+	}
+	if r.Binding != nil {
+		v := r.Binding.DeepCopy()
+		out.Binding = &v
+	}
+	if r.Mapping != nil {
+		out.Mapping = make([]ElementDefinitionMapping, len(r.Mapping))
+		for i := range r.Mapping {
+			out.Mapping[i] = r.Mapping[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ElementDefinition) Equal(other ElementDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Path != other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if len(r.Representation) != len(other.Representation) {
+		return false
+	}
+	for i := range r.Representation {
+		if r.Representation[i] != other.Representation[i] {
+			return false
+		}
+	}
+	if !equalElements(r.RepresentationElement, other.RepresentationElement, false) {
+		return false
+	}
+	if (r.SliceName == nil) != (other.SliceName == nil) || r.SliceName != nil && *r.SliceName != *other.SliceName {
+		return false
+	}
+	if (r.SliceNameElement == nil) != (other.SliceNameElement == nil) || r.SliceNameElement != nil && !r.SliceNameElement.Equal(*other.SliceNameElement) {
+		return false
+	}
+	if (r.SliceIsConstraining == nil) != (other.SliceIsConstraining == nil) || r.SliceIsConstraining != nil && *r.SliceIsConstraining != *other.SliceIsConstraining {
+		return false
+	}
+	if (r.SliceIsConstrainingElement == nil) != (other.SliceIsConstrainingElement == nil) || r.SliceIsConstrainingElement != nil && !r.SliceIsConstrainingElement.Equal(*other.SliceIsConstrainingElement) {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && *r.Label != *other.Label {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.Equal(*other.LabelElement) {
+		return false
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if (r.Slicing == nil) != (other.Slicing == nil) || r.Slicing != nil && !r.Slicing.Equal(*other.Slicing) {
+		return false
+	}
+	if (r.Short == nil) != (other.Short == nil) || r.Short != nil && *r.Short != *other.Short {
+		return false
+	}
+	if (r.ShortElement == nil) != (other.ShortElement == nil) || r.ShortElement != nil && !r.ShortElement.Equal(*other.ShortElement) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && *r.Definition != *other.Definition {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.Equal(*other.DefinitionElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	if (r.Requirements == nil) != (other.Requirements == nil) || r.Requirements != nil && *r.Requirements != *other.Requirements {
+		return false
+	}
+	if (r.RequirementsElement == nil) != (other.RequirementsElement == nil) || r.RequirementsElement != nil && !r.RequirementsElement.Equal(*other.RequirementsElement) {
+		return false
+	}
+	if len(r.Alias) != len(other.Alias) {
+		return false
+	}
+	for i := range r.Alias {
+		if r.Alias[i] != other.Alias[i] {
+			return false
+		}
+	}
+	if !equalElements(r.AliasElement, other.AliasElement, false) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.Equal(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && *r.Max != *other.Max {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.Equal(*other.MaxElement) {
+		return false
+	}
+	if (r.Base == nil) != (other.Base == nil) || r.Base != nil && !r.Base.Equal(*other.Base) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && *r.ContentReference != *other.ContentReference {
+		return false
+	}
+	if (r.ContentReferenceElement == nil) != (other.ContentReferenceElement == nil) || r.ContentReferenceElement != nil && !r.ContentReferenceElement.Equal(*other.ContentReferenceElement) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if (r.DefaultValueBase64Binary == nil) != (other.DefaultValueBase64Binary == nil) || r.DefaultValueBase64Binary != nil && *r.DefaultValueBase64Binary != *other.DefaultValueBase64Binary {
+		return false
+	}
+	if (r.DefaultValueBase64BinaryElement == nil) != (other.DefaultValueBase64BinaryElement == nil) || r.DefaultValueBase64BinaryElement != nil && !r.DefaultValueBase64BinaryElement.Equal(*other.DefaultValueBase64BinaryElement) {
+		return false
+	}
+	if (r.DefaultValueBoolean == nil) != (other.DefaultValueBoolean == nil) || r.DefaultValueBoolean != nil && *r.DefaultValueBoolean != *other.DefaultValueBoolean {
+		return false
+	}
+	if (r.DefaultValueBooleanElement == nil) != (other.DefaultValueBooleanElement == nil) || r.DefaultValueBooleanElement != nil && !r.DefaultValueBooleanElement.Equal(*other.DefaultValueBooleanElement) {
+		return false
+	}
+	if (r.DefaultValueCanonical == nil) != (other.DefaultValueCanonical == nil) || r.DefaultValueCanonical != nil && *r.DefaultValueCanonical != *other.DefaultValueCanonical {
+		return false
+	}
+	if (r.DefaultValueCanonicalElement == nil) != (other.DefaultValueCanonicalElement == nil) || r.DefaultValueCanonicalElement != nil && !r.DefaultValueCanonicalElement.Equal(*other.DefaultValueCanonicalElement) {
+		return false
+	}
+	if (r.DefaultValueCode == nil) != (other.DefaultValueCode == nil) || r.DefaultValueCode != nil && *r.DefaultValueCode != *other.DefaultValueCode {
+		return false
+	}
+	if (r.DefaultValueCodeElement == nil) != (other.DefaultValueCodeElement == nil) || r.DefaultValueCodeElement != nil && !r.DefaultValueCodeElement.Equal(*other.DefaultValueCodeElement) {
+		return false
+	}
+	if (r.DefaultValueDate == nil) != (other.DefaultValueDate == nil) || r.DefaultValueDate != nil && *r.DefaultValueDate != *other.DefaultValueDate {
+		return false
+	}
+	if (r.DefaultValueDateElement == nil) != (other.DefaultValueDateElement == nil) || r.DefaultValueDateElement != nil && !r.DefaultValueDateElement.Equal(*other.DefaultValueDateElement) {
+		return false
+	}
+	if (r.DefaultValueDateTime == nil) != (other.DefaultValueDateTime == nil) || r.DefaultValueDateTime != nil && *r.DefaultValueDateTime != *other.DefaultValueDateTime {
+		return false
+	}
+	if (r.DefaultValueDateTimeElement == nil) != (other.DefaultValueDateTimeElement == nil) || r.DefaultValueDateTimeElement != nil && !r.DefaultValueDateTimeElement.Equal(*other.DefaultValueDateTimeElement) {
+		return false
+	}
+	if (r.DefaultValueDecimal == nil) != (other.DefaultValueDecimal == nil) || r.DefaultValueDecimal != nil && !EqualDecimal(*r.DefaultValueDecimal, *other.DefaultValueDecimal) {
+		return false
+	}
+	if (r.DefaultValueDecimalElement == nil) != (other.DefaultValueDecimalElement == nil) || r.DefaultValueDecimalElement != nil && !r.DefaultValueDecimalElement.Equal(*other.DefaultValueDecimalElement) {
+		return false
+	}
+	if (r.DefaultValueId == nil) != (other.DefaultValueId == nil) || r.DefaultValueId != nil && *r.DefaultValueId != *other.DefaultValueId {
+		return false
+	}
+	if (r.DefaultValueIdElement == nil) != (other.DefaultValueIdElement == nil) || r.DefaultValueIdElement != nil && !r.DefaultValueIdElement.Equal(*other.DefaultValueIdElement) {
+		return false
+	}
+	if (r.DefaultValueInstant == nil) != (other.DefaultValueInstant == nil) || r.DefaultValueInstant != nil && *r.DefaultValueInstant != *other.DefaultValueInstant {
+		return false
+	}
+	if (r.DefaultValueInstantElement == nil) != (other.DefaultValueInstantElement == nil) || r.DefaultValueInstantElement != nil && !r.DefaultValueInstantElement.Equal(*other.DefaultValueInstantElement) {
+		return false
+	}
+	if (r.DefaultValueInteger == nil) != (other.DefaultValueInteger == nil) || r.DefaultValueInteger != nil && *r.DefaultValueInteger != *other.DefaultValueInteger {
+		return false
+	}
+	if (r.DefaultValueIntegerElement == nil) != (other.DefaultValueIntegerElement == nil) || r.DefaultValueIntegerElement != nil && !r.DefaultValueIntegerElement.Equal(*other.DefaultValueIntegerElement) {
+		return false
+	}
+	if (r.DefaultValueMarkdown == nil) != (other.DefaultValueMarkdown == nil) || r.DefaultValueMarkdown != nil && *r.DefaultValueMarkdown != *other.DefaultValueMarkdown {
+		return false
+	}
+	if (r.DefaultValueMarkdownElement == nil) != (other.DefaultValueMarkdownElement == nil) || r.DefaultValueMarkdownElement != nil && !r.DefaultValueMarkdownElement.Equal(*other.DefaultValueMarkdownElement) {
+		return false
+	}
+	if (r.DefaultValueOid == nil) != (other.DefaultValueOid == nil) || r.DefaultValueOid != nil && *r.DefaultValueOid != *other.DefaultValueOid {
+		return false
+	}
+	if (r.DefaultValueOidElement == nil) != (other.DefaultValueOidElement == nil) || r.DefaultValueOidElement != nil && !r.DefaultValueOidElement.Equal(*other.DefaultValueOidElement) {
+		return false
+	}
+	if (r.DefaultValuePositiveInt == nil) != (other.DefaultValuePositiveInt == nil) || r.DefaultValuePositiveInt != nil && *r.DefaultValuePositiveInt != *other.DefaultValuePositiveInt {
+		return false
+	}
+	if (r.DefaultValuePositiveIntElement == nil) != (other.DefaultValuePositiveIntElement == nil) || r.DefaultValuePositiveIntElement != nil && !r.DefaultValuePositiveIntElement.Equal(*other.DefaultValuePositiveIntElement) {
+		return false
+	}
+	if (r.DefaultValueString == nil) != (other.DefaultValueString == nil) || r.DefaultValueString != nil && *r.DefaultValueString != *other.DefaultValueString {
+		return false
+	}
+	if (r.DefaultValueStringElement == nil) != (other.DefaultValueStringElement == nil) || r.DefaultValueStringElement != nil && !r.DefaultValueStringElement.Equal(*other.DefaultValueStringElement) {
+		return false
+	}
+	if (r.DefaultValueTime == nil) != (other.DefaultValueTime == nil) || r.DefaultValueTime != nil && *r.DefaultValueTime != *other.DefaultValueTime {
+		return false
+	}
+	if (r.DefaultValueTimeElement == nil) != (other.DefaultValueTimeElement == nil) || r.DefaultValueTimeElement != nil && !r.DefaultValueTimeElement.Equal(*other.DefaultValueTimeElement) {
+		return false
+	}
+	if (r.DefaultValueUnsignedInt == nil) != (other.DefaultValueUnsignedInt == nil) || r.DefaultValueUnsignedInt != nil && *r.DefaultValueUnsignedInt != *other.DefaultValueUnsignedInt {
+		return false
+	}
+	if (r.DefaultValueUnsignedIntElement == nil) != (other.DefaultValueUnsignedIntElement == nil) || r.DefaultValueUnsignedIntElement != nil && !r.DefaultValueUnsignedIntElement.Equal(*other.DefaultValueUnsignedIntElement) {
+		return false
+	}
+	if (r.DefaultValueUri == nil) != (other.DefaultValueUri == nil) || r.DefaultValueUri != nil && *r.DefaultValueUri != *other.DefaultValueUri {
+		return false
+	}
+	if (r.DefaultValueUriElement == nil) != (other.DefaultValueUriElement == nil) || r.DefaultValueUriElement != nil && !r.DefaultValueUriElement.Equal(*other.DefaultValueUriElement) {
+		return false
+	}
+	if (r.DefaultValueUrl == nil) != (other.DefaultValueUrl == nil) || r.DefaultValueUrl != nil && *r.DefaultValueUrl != *other.DefaultValueUrl {
+		return false
+	}
+	if (r.DefaultValueUrlElement == nil) != (other.DefaultValueUrlElement == nil) || r.DefaultValueUrlElement != nil && !r.DefaultValueUrlElement.Equal(*other.DefaultValueUrlElement) {
+		return false
+	}
+	if (r.DefaultValueUuid == nil) != (other.DefaultValueUuid == nil) || r.DefaultValueUuid != nil && *r.DefaultValueUuid != *other.DefaultValueUuid {
+		return false
+	}
+	if (r.DefaultValueUuidElement == nil) != (other.DefaultValueUuidElement == nil) || r.DefaultValueUuidElement != nil && !r.DefaultValueUuidElement.Equal(*other.DefaultValueUuidElement) {
+		return false
+	}
+	if (r.DefaultValueAddress == nil) != (other.DefaultValueAddress == nil) || r.DefaultValueAddress != nil && !r.DefaultValueAddress.Equal(*other.DefaultValueAddress) {
+		return false
+	}
+	if (r.DefaultValueAge == nil) != (other.DefaultValueAge == nil) || r.DefaultValueAge != nil && !r.DefaultValueAge.Equal(*other.DefaultValueAge) {
+		return false
+	}
+	if (r.DefaultValueAnnotation == nil) != (other.DefaultValueAnnotation == nil) || r.DefaultValueAnnotation != nil && !r.DefaultValueAnnotation.Equal(*other.DefaultValueAnnotation) {
+		return false
+	}
+	if (r.DefaultValueAttachment == nil) != (other.DefaultValueAttachment == nil) || r.DefaultValueAttachment != nil && !r.DefaultValueAttachment.Equal(*other.DefaultValueAttachment) {
+		return false
+	}
+	if (r.DefaultValueCodeableConcept == nil) != (other.DefaultValueCodeableConcept == nil) || r.DefaultValueCodeableConcept != nil && !r.DefaultValueCodeableConcept.Equal(*other.DefaultValueCodeableConcept) {
+		return false
+	}
+	if (r.DefaultValueCoding == nil) != (other.DefaultValueCoding == nil) || r.DefaultValueCoding != nil && !r.DefaultValueCoding.Equal(*other.DefaultValueCoding) {
+		return false
+	}
+	if (r.DefaultValueContactPoint == nil) != (other.DefaultValueContactPoint == nil) || r.DefaultValueContactPoint != nil && !r.DefaultValueContactPoint.Equal(*other.DefaultValueContactPoint) {
+		return false
+	}
+	if (r.DefaultValueCount == nil) != (other.DefaultValueCount == nil) || r.DefaultValueCount != nil && !r.DefaultValueCount.Equal(*other.DefaultValueCount) {
+		return false
+	}
+	if (r.DefaultValueDistance == nil) != (other.DefaultValueDistance == nil) || r.DefaultValueDistance != nil && !r.DefaultValueDistance.Equal(*other.DefaultValueDistance) {
+		return false
+	}
+	if (r.DefaultValueDuration == nil) != (other.DefaultValueDuration == nil) || r.DefaultValueDuration != nil && !r.DefaultValueDuration.Equal(*other.DefaultValueDuration) {
+		return false
+	}
+	if (r.DefaultValueHumanName == nil) != (other.DefaultValueHumanName == nil) || r.DefaultValueHumanName != nil && !r.DefaultValueHumanName.Equal(*other.DefaultValueHumanName) {
+		return false
+	}
+	if (r.DefaultValueIdentifier == nil) != (other.DefaultValueIdentifier == nil) || r.DefaultValueIdentifier != nil && !r.DefaultValueIdentifier.Equal(*other.DefaultValueIdentifier) {
+		return false
+	}
+	if (r.DefaultValueMoney == nil) != (other.DefaultValueMoney == nil) || r.DefaultValueMoney != nil && !r.DefaultValueMoney.Equal(*other.DefaultValueMoney) {
+		return false
+	}
+	if (r.DefaultValuePeriod == nil) != (other.DefaultValuePeriod == nil) || r.DefaultValuePeriod != nil && !r.DefaultValuePeriod.Equal(*other.DefaultValuePeriod) {
+		return false
+	}
+	if (r.DefaultValueQuantity == nil) != (other.DefaultValueQuantity == nil) || r.DefaultValueQuantity != nil && !r.DefaultValueQuantity.Equal(*other.DefaultValueQuantity) {
+		return false
+	}
+	if (r.DefaultValueRange == nil) != (other.DefaultValueRange == nil) || r.DefaultValueRange != nil && !r.DefaultValueRange.Equal(*other.DefaultValueRange) {
+		return false
+	}
+	if (r.DefaultValueRatio == nil) != (other.DefaultValueRatio == nil) || r.DefaultValueRatio != nil && !r.DefaultValueRatio.Equal(*other.DefaultValueRatio) {
+		return false
+	}
+	if (r.DefaultValueReference == nil) != (other.DefaultValueReference == nil) || r.DefaultValueReference != nil && !r.DefaultValueReference.Equal(*other.DefaultValueReference) {
+		return false
+	}
+	if (r.DefaultValueSampledData == nil) != (other.DefaultValueSampledData == nil) || r.DefaultValueSampledData != nil && !r.DefaultValueSampledData.Equal(*other.DefaultValueSampledData) {
+		return false
+	}
+	if (r.DefaultValueSignature == nil) != (other.DefaultValueSignature == nil) || r.DefaultValueSignature != nil && !r.DefaultValueSignature.Equal(*other.DefaultValueSignature) {
+		return false
+	}
+	if (r.DefaultValueTiming == nil) != (other.DefaultValueTiming == nil) || r.DefaultValueTiming != nil && !r.DefaultValueTiming.Equal(*other.DefaultValueTiming) {
+		return false
+	}
+	if (r.DefaultValueContactDetail == nil) != (other.DefaultValueContactDetail == nil) || r.DefaultValueContactDetail != nil && !r.DefaultValueContactDetail.Equal(*other.DefaultValueContactDetail) {
+		return false
+	}
+	if (r.DefaultValueContributor == nil) != (other.DefaultValueContributor == nil) || r.DefaultValueContributor != nil && !r.DefaultValueContributor.Equal(*other.DefaultValueContributor) {
+		return false
+	}
+	if (r.DefaultValueDataRequirement == nil) != (other.DefaultValueDataRequirement == nil) || r.DefaultValueDataRequirement != nil && !r.DefaultValueDataRequirement.Equal(*other.DefaultValueDataRequirement) {
+		return false
+	}
+	if (r.DefaultValueExpression == nil) != (other.DefaultValueExpression == nil) || r.DefaultValueExpression != nil && !r.DefaultValueExpression.Equal(*other.DefaultValueExpression) {
+		return false
+	}
+	if (r.DefaultValueParameterDefinition == nil) != (other.DefaultValueParameterDefinition == nil) || r.DefaultValueParameterDefinition != nil && !r.DefaultValueParameterDefinition.Equal(*other.DefaultValueParameterDefinition) {
+		return false
+	}
+	if (r.DefaultValueRelatedArtifact == nil) != (other.DefaultValueRelatedArtifact == nil) || r.DefaultValueRelatedArtifact != nil && !r.DefaultValueRelatedArtifact.Equal(*other.DefaultValueRelatedArtifact) {
+		return false
+	}
+	if (r.DefaultValueTriggerDefinition == nil) != (other.DefaultValueTriggerDefinition == nil) || r.DefaultValueTriggerDefinition != nil && !r.DefaultValueTriggerDefinition.Equal(*other.DefaultValueTriggerDefinition) {
+		return false
+	}
+	if (r.DefaultValueUsageContext == nil) != (other.DefaultValueUsageContext == nil) || r.DefaultValueUsageContext != nil && !r.DefaultValueUsageContext.Equal(*other.DefaultValueUsageContext) {
+		return false
+	}
+	if (r.DefaultValueDosage == nil) != (other.DefaultValueDosage == nil) || r.DefaultValueDosage != nil && !r.DefaultValueDosage.Equal(*other.DefaultValueDosage) {
+		return false
+	}
+	if (r.DefaultValueMeta == nil) != (other.DefaultValueMeta == nil) || r.DefaultValueMeta != nil && !r.DefaultValueMeta.Equal(*other.DefaultValueMeta) {
+		return false
+	}
+	if (r.MeaningWhenMissing == nil) != (other.MeaningWhenMissing == nil) || r.MeaningWhenMissing != nil && *r.MeaningWhenMissing != *other.MeaningWhenMissing {
+		return false
+	}
+	if (r.MeaningWhenMissingElement == nil) != (other.MeaningWhenMissingElement == nil) || r.MeaningWhenMissingElement != nil && !r.MeaningWhenMissingElement.Equal(*other.MeaningWhenMissingElement) {
+		return false
+	}
+	if (r.OrderMeaning == nil) != (other.OrderMeaning == nil) || r.OrderMeaning != nil && *r.OrderMeaning != *other.OrderMeaning {
+		return false
+	}
+	if (r.OrderMeaningElement == nil) != (other.OrderMeaningElement == nil) || r.OrderMeaningElement != nil && !r.OrderMeaningElement.Equal(*other.OrderMeaningElement) {
+		return false
+	}
+	if (r.FixedBase64Binary == nil) != (other.FixedBase64Binary == nil) || r.FixedBase64Binary != nil && *r.FixedBase64Binary != *other.FixedBase64Binary {
+		return false
+	}
+	if (r.FixedBase64BinaryElement == nil) != (other.FixedBase64BinaryElement == nil) || r.FixedBase64BinaryElement != nil && !r.FixedBase64BinaryElement.Equal(*other.FixedBase64BinaryElement) {
+		return false
+	}
+	if (r.FixedBoolean == nil) != (other.FixedBoolean == nil) || r.FixedBoolean != nil && *r.FixedBoolean != *other.FixedBoolean {
+		return false
+	}
+	if (r.FixedBooleanElement == nil) != (other.FixedBooleanElement == nil) || r.FixedBooleanElement != nil && !r.FixedBooleanElement.Equal(*other.FixedBooleanElement) {
+		return false
+	}
+	if (r.FixedCanonical == nil) != (other.FixedCanonical == nil) || r.FixedCanonical != nil && *r.FixedCanonical != *other.FixedCanonical {
+		return false
+	}
+	if (r.FixedCanonicalElement == nil) != (other.FixedCanonicalElement == nil) || r.FixedCanonicalElement != nil && !r.FixedCanonicalElement.Equal(*other.FixedCanonicalElement) {
+		return false
+	}
+	if (r.FixedCode == nil) != (other.FixedCode == nil) || r.FixedCode != nil && *r.FixedCode != *other.FixedCode {
+		return false
+	}
+	if (r.FixedCodeElement == nil) != (other.FixedCodeElement == nil) || r.FixedCodeElement != nil && !r.FixedCodeElement.Equal(*other.FixedCodeElement) {
+		return false
+	}
+	if (r.FixedDate == nil) != (other.FixedDate == nil) || r.FixedDate != nil && *r.FixedDate != *other.FixedDate {
+		return false
+	}
+	if (r.FixedDateElement == nil) != (other.FixedDateElement == nil) || r.FixedDateElement != nil && !r.FixedDateElement.Equal(*other.FixedDateElement) {
+		return false
+	}
+	if (r.FixedDateTime == nil) != (other.FixedDateTime == nil) || r.FixedDateTime != nil && *r.FixedDateTime != *other.FixedDateTime {
+		return false
+	}
+	if (r.FixedDateTimeElement == nil) != (other.FixedDateTimeElement == nil) || r.FixedDateTimeElement != nil && !r.FixedDateTimeElement.Equal(*other.FixedDateTimeElement) {
+		return false
+	}
+	if (r.FixedDecimal == nil) != (other.FixedDecimal == nil) || r.FixedDecimal != nil && !EqualDecimal(*r.FixedDecimal, *other.FixedDecimal) {
+		return false
+	}
+	if (r.FixedDecimalElement == nil) != (other.FixedDecimalElement == nil) || r.FixedDecimalElement != nil && !r.FixedDecimalElement.Equal(*other.FixedDecimalElement) {
+		return false
+	}
+	if (r.FixedId == nil) != (other.FixedId == nil) || r.FixedId != nil && *r.FixedId != *other.FixedId {
+		return false
+	}
+	if (r.FixedIdElement == nil) != (other.FixedIdElement == nil) || r.FixedIdElement != nil && !r.FixedIdElement.Equal(*other.FixedIdElement) {
+		return false
+	}
+	if (r.FixedInstant == nil) != (other.FixedInstant == nil) || r.FixedInstant != nil && *r.FixedInstant != *other.FixedInstant {
+		return false
+	}
+	if (r.FixedInstantElement == nil) != (other.FixedInstantElement == nil) || r.FixedInstantElement != nil && !r.FixedInstantElement.Equal(*other.FixedInstantElement) {
+		return false
+	}
+	if (r.FixedInteger == nil) != (other.FixedInteger == nil) || r.FixedInteger != nil && *r.FixedInteger != *other.FixedInteger {
+		return false
+	}
+	if (r.FixedIntegerElement == nil) != (other.FixedIntegerElement == nil) || r.FixedIntegerElement != nil && !r.FixedIntegerElement.Equal(*other.FixedIntegerElement) {
+		return false
+	}
+	if (r.FixedMarkdown == nil) != (other.FixedMarkdown == nil) || r.FixedMarkdown != nil && *r.FixedMarkdown != *other.FixedMarkdown {
+		return false
+	}
+	if (r.FixedMarkdownElement == nil) != (other.FixedMarkdownElement == nil) || r.FixedMarkdownElement != nil && !r.FixedMarkdownElement.Equal(*other.FixedMarkdownElement) {
+		return false
+	}
+	if (r.FixedOid == nil) != (other.FixedOid == nil) || r.FixedOid != nil && *r.FixedOid != *other.FixedOid {
+		return false
+	}
+	if (r.FixedOidElement == nil) != (other.FixedOidElement == nil) || r.FixedOidElement != nil && !r.FixedOidElement.Equal(*other.FixedOidElement) {
+		return false
+	}
+	if (r.FixedPositiveInt == nil) != (other.FixedPositiveInt == nil) || r.FixedPositiveInt != nil && *r.FixedPositiveInt != *other.FixedPositiveInt {
+		return false
+	}
+	if (r.FixedPositiveIntElement == nil) != (other.FixedPositiveIntElement == nil) || r.FixedPositiveIntElement != nil && !r.FixedPositiveIntElement.Equal(*other.FixedPositiveIntElement) {
+		return false
+	}
+	if (r.FixedString == nil) != (other.FixedString == nil) || r.FixedString != nil && *r.FixedString != *other.FixedString {
+		return false
+	}
+	if (r.FixedStringElement == nil) != (other.FixedStringElement == nil) || r.FixedStringElement != nil && !r.FixedStringElement.Equal(*other.FixedStringElement) {
+		return false
+	}
+	if (r.FixedTime == nil) != (other.FixedTime == nil) || r.FixedTime != nil && *r.FixedTime != *other.FixedTime {
+		return false
+	}
+	if (r.FixedTimeElement == nil) != (other.FixedTimeElement == nil) || r.FixedTimeElement != nil && !r.FixedTimeElement.Equal(*other.FixedTimeElement) {
+		return false
+	}
+	if (r.FixedUnsignedInt == nil) != (other.FixedUnsignedInt == nil) || r.FixedUnsignedInt != nil && *r.FixedUnsignedInt != *other.FixedUnsignedInt {
+		return false
+	}
+	if (r.FixedUnsignedIntElement == nil) != (other.FixedUnsignedIntElement == nil) || r.FixedUnsignedIntElement != nil && !r.FixedUnsignedIntElement.Equal(*other.FixedUnsignedIntElement) {
+		return false
+	}
+	if (r.FixedUri == nil) != (other.FixedUri == nil) || r.FixedUri != nil && *r.FixedUri != *other.FixedUri {
+		return false
+	}
+	if (r.FixedUriElement == nil) != (other.FixedUriElement == nil) || r.FixedUriElement != nil && !r.FixedUriElement.Equal(*other.FixedUriElement) {
+		return false
+	}
+	if (r.FixedUrl == nil) != (other.FixedUrl == nil) || r.FixedUrl != nil && *r.FixedUrl != *other.FixedUrl {
+		return false
+	}
+	if (r.FixedUrlElement == nil) != (other.FixedUrlElement == nil) || r.FixedUrlElement != nil && !r.FixedUrlElement.Equal(*other.FixedUrlElement) {
+		return false
+	}
+	if (r.FixedUuid == nil) != (other.FixedUuid == nil) || r.FixedUuid != nil && *r.FixedUuid != *other.FixedUuid {
+		return false
+	}
+	if (r.FixedUuidElement == nil) != (other.FixedUuidElement == nil) || r.FixedUuidElement != nil && !r.FixedUuidElement.Equal(*other.FixedUuidElement) {
+		return false
+	}
+	if (r.FixedAddress == nil) != (other.FixedAddress == nil) || r.FixedAddress != nil && !r.FixedAddress.Equal(*other.FixedAddress) {
+		return false
+	}
+	if (r.FixedAge == nil) != (other.FixedAge == nil) || r.FixedAge != nil && !r.FixedAge.Equal(*other.FixedAge) {
+		return false
+	}
+	if (r.FixedAnnotation == nil) != (other.FixedAnnotation == nil) || r.FixedAnnotation != nil && !r.FixedAnnotation.Equal(*other.FixedAnnotation) {
+		return false
+	}
+	if (r.FixedAttachment == nil) != (other.FixedAttachment == nil) || r.FixedAttachment != nil && !r.FixedAttachment.Equal(*other.FixedAttachment) {
+		return false
+	}
+	if (r.FixedCodeableConcept == nil) != (other.FixedCodeableConcept == nil) || r.FixedCodeableConcept != nil && !r.FixedCodeableConcept.Equal(*other.FixedCodeableConcept) {
+		return false
+	}
+	if (r.FixedCoding == nil) != (other.FixedCoding == nil) || r.FixedCoding != nil && !r.FixedCoding.Equal(*other.FixedCoding) {
+		return false
+	}
+	if (r.FixedContactPoint == nil) != (other.FixedContactPoint == nil) || r.FixedContactPoint != nil && !r.FixedContactPoint.Equal(*other.FixedContactPoint) {
+		return false
+	}
+	if (r.FixedCount == nil) != (other.FixedCount == nil) || r.FixedCount != nil && !r.FixedCount.Equal(*other.FixedCount) {
+		return false
+	}
+	if (r.FixedDistance == nil) != (other.FixedDistance == nil) || r.FixedDistance != nil && !r.FixedDistance.Equal(*other.FixedDistance) {
+		return false
+	}
+	if (r.FixedDuration == nil) != (other.FixedDuration == nil) || r.FixedDuration != nil && !r.FixedDuration.Equal(*other.FixedDuration) {
+		return false
+	}
+	if (r.FixedHumanName == nil) != (other.FixedHumanName == nil) || r.FixedHumanName != nil && !r.FixedHumanName.Equal(*other.FixedHumanName) {
+		return false
+	}
+	if (r.FixedIdentifier == nil) != (other.FixedIdentifier == nil) || r.FixedIdentifier != nil && !r.FixedIdentifier.Equal(*other.FixedIdentifier) {
+		return false
+	}
+	if (r.FixedMoney == nil) != (other.FixedMoney == nil) || r.FixedMoney != nil && !r.FixedMoney.Equal(*other.FixedMoney) {
+		return false
+	}
+	if (r.FixedPeriod == nil) != (other.FixedPeriod == nil) || r.FixedPeriod != nil && !r.FixedPeriod.Equal(*other.FixedPeriod) {
+		return false
+	}
+	if (r.FixedQuantity == nil) != (other.FixedQuantity == nil) || r.FixedQuantity != nil && !r.FixedQuantity.Equal(*other.FixedQuantity) {
+		return false
+	}
+	if (r.FixedRange == nil) != (other.FixedRange == nil) || r.FixedRange != nil && !r.FixedRange.Equal(*other.FixedRange) {
+		return false
+	}
+	if (r.FixedRatio == nil) != (other.FixedRatio == nil) || r.FixedRatio != nil && !r.FixedRatio.Equal(*other.FixedRatio) {
+		return false
+	}
+	if (r.FixedReference == nil) != (other.FixedReference == nil) || r.FixedReference != nil && !r.FixedReference.Equal(*other.FixedReference) {
+		return false
+	}
+	if (r.FixedSampledData == nil) != (other.FixedSampledData == nil) || r.FixedSampledData != nil && !r.FixedSampledData.Equal(*other.FixedSampledData) {
+		return false
+	}
+	if (r.FixedSignature == nil) != (other.FixedSignature == nil) || r.FixedSignature != nil && !r.FixedSignature.Equal(*other.FixedSignature) {
+		return false
+	}
+	if (r.FixedTiming == nil) != (other.FixedTiming == nil) || r.FixedTiming != nil && !r.FixedTiming.Equal(*other.FixedTiming) {
+		return false
+	}
+	if (r.FixedContactDetail == nil) != (other.FixedContactDetail == nil) || r.FixedContactDetail != nil && !r.FixedContactDetail.Equal(*other.FixedContactDetail) {
+		return false
+	}
+	if (r.FixedContributor == nil) != (other.FixedContributor == nil) || r.FixedContributor != nil && !r.FixedContributor.Equal(*other.FixedContributor) {
+		return false
+	}
+	if (r.FixedDataRequirement == nil) != (other.FixedDataRequirement == nil) || r.FixedDataRequirement != nil && !r.FixedDataRequirement.Equal(*other.FixedDataRequirement) {
+		return false
+	}
+	if (r.FixedExpression == nil) != (other.FixedExpression == nil) || r.FixedExpression != nil && !r.FixedExpression.Equal(*other.FixedExpression) {
+		return false
+	}
+	if (r.FixedParameterDefinition == nil) != (other.FixedParameterDefinition == nil) || r.FixedParameterDefinition != nil && !r.FixedParameterDefinition.Equal(*other.FixedParameterDefinition) {
+		return false
+	}
+	if (r.FixedRelatedArtifact == nil) != (other.FixedRelatedArtifact == nil) || r.FixedRelatedArtifact != nil && !r.FixedRelatedArtifact.Equal(*other.FixedRelatedArtifact) {
+		return false
+	}
+	if (r.FixedTriggerDefinition == nil) != (other.FixedTriggerDefinition == nil) || r.FixedTriggerDefinition != nil && !r.FixedTriggerDefinition.Equal(*other.FixedTriggerDefinition) {
+		return false
+	}
+	if (r.FixedUsageContext == nil) != (other.FixedUsageContext == nil) || r.FixedUsageContext != nil && !r.FixedUsageContext.Equal(*other.FixedUsageContext) {
+		return false
+	}
+	if (r.FixedDosage == nil) != (other.FixedDosage == nil) || r.FixedDosage != nil && !r.FixedDosage.Equal(*other.FixedDosage) {
+		return false
+	}
+	if (r.FixedMeta == nil) != (other.FixedMeta == nil) || r.FixedMeta != nil && !r.FixedMeta.Equal(*other.FixedMeta) {
+		return false
+	}
+	if (r.PatternBase64Binary == nil) != (other.PatternBase64Binary == nil) || r.PatternBase64Binary != nil && *r.PatternBase64Binary != *other.PatternBase64Binary {
+		return false
+	}
+	if (r.PatternBase64BinaryElement == nil) != (other.PatternBase64BinaryElement == nil) || r.PatternBase64BinaryElement != nil && !r.PatternBase64BinaryElement.Equal(*other.PatternBase64BinaryElement) {
+		return false
+	}
+	if (r.PatternBoolean == nil) != (other.PatternBoolean == nil) || r.PatternBoolean != nil && *r.PatternBoolean != *other.PatternBoolean {
+		return false
+	}
+	if (r.PatternBooleanElement == nil) != (other.PatternBooleanElement == nil) || r.PatternBooleanElement != nil && !r.PatternBooleanElement.Equal(*other.PatternBooleanElement) {
+		return false
+	}
+	if (r.PatternCanonical == nil) != (other.PatternCanonical == nil) || r.PatternCanonical != nil && *r.PatternCanonical != *other.PatternCanonical {
+		return false
+	}
+	if (r.PatternCanonicalElement == nil) != (other.PatternCanonicalElement == nil) || r.PatternCanonicalElement != nil && !r.PatternCanonicalElement.Equal(*other.PatternCanonicalElement) {
+		return false
+	}
+	if (r.PatternCode == nil) != (other.PatternCode == nil) || r.PatternCode != nil && *r.PatternCode != *other.PatternCode {
+		return false
+	}
+	if (r.PatternCodeElement == nil) != (other.PatternCodeElement == nil) || r.PatternCodeElement != nil && !r.PatternCodeElement.Equal(*other.PatternCodeElement) {
+		return false
+	}
+	if (r.PatternDate == nil) != (other.PatternDate == nil) || r.PatternDate != nil && *r.PatternDate != *other.PatternDate {
+		return false
+	}
+	if (r.PatternDateElement == nil) != (other.PatternDateElement == nil) || r.PatternDateElement != nil && !r.PatternDateElement.Equal(*other.PatternDateElement) {
+		return false
+	}
+	if (r.PatternDateTime == nil) != (other.PatternDateTime == nil) || r.PatternDateTime != nil && *r.PatternDateTime != *other.PatternDateTime {
+		return false
+	}
+	if (r.PatternDateTimeElement == nil) != (other.PatternDateTimeElement == nil) || r.PatternDateTimeElement != nil && !r.PatternDateTimeElement.Equal(*other.PatternDateTimeElement) {
+		return false
+	}
+	if (r.PatternDecimal == nil) != (other.PatternDecimal == nil) || r.PatternDecimal != nil && !EqualDecimal(*r.PatternDecimal, *other.PatternDecimal) {
+		return false
+	}
+	if (r.PatternDecimalElement == nil) != (other.PatternDecimalElement == nil) || r.PatternDecimalElement != nil && !r.PatternDecimalElement.Equal(*other.PatternDecimalElement) {
+		return false
+	}
+	if (r.PatternId == nil) != (other.PatternId == nil) || r.PatternId != nil && *r.PatternId != *other.PatternId {
+		return false
+	}
+	if (r.PatternIdElement == nil) != (other.PatternIdElement == nil) || r.PatternIdElement != nil && !r.PatternIdElement.Equal(*other.PatternIdElement) {
+		return false
+	}
+	if (r.PatternInstant == nil) != (other.PatternInstant == nil) || r.PatternInstant != nil && *r.PatternInstant != *other.PatternInstant {
+		return false
+	}
+	if (r.PatternInstantElement == nil) != (other.PatternInstantElement == nil) || r.PatternInstantElement != nil && !r.PatternInstantElement.Equal(*other.PatternInstantElement) {
+		return false
+	}
+	if (r.PatternInteger == nil) != (other.PatternInteger == nil) || r.PatternInteger != nil && *r.PatternInteger != *other.PatternInteger {
+		return false
+	}
+	if (r.PatternIntegerElement == nil) != (other.PatternIntegerElement == nil) || r.PatternIntegerElement != nil && !r.PatternIntegerElement.Equal(*other.PatternIntegerElement) {
+		return false
+	}
+	if (r.PatternMarkdown == nil) != (other.PatternMarkdown == nil) || r.PatternMarkdown != nil && *r.PatternMarkdown != *other.PatternMarkdown {
+		return false
+	}
+	if (r.PatternMarkdownElement == nil) != (other.PatternMarkdownElement == nil) || r.PatternMarkdownElement != nil && !r.PatternMarkdownElement.Equal(*other.PatternMarkdownElement) {
+		return false
+	}
+	if (r.PatternOid == nil) != (other.PatternOid == nil) || r.PatternOid != nil && *r.PatternOid != *other.PatternOid {
+		return false
+	}
+	if (r.PatternOidElement == nil) != (other.PatternOidElement == nil) || r.PatternOidElement != nil && !r.PatternOidElement.Equal(*other.PatternOidElement) {
+		return false
+	}
+	if (r.PatternPositiveInt == nil) != (other.PatternPositiveInt == nil) || r.PatternPositiveInt != nil && *r.PatternPositiveInt != *other.PatternPositiveInt {
+		return false
+	}
+	if (r.PatternPositiveIntElement == nil) != (other.PatternPositiveIntElement == nil) || r.PatternPositiveIntElement != nil && !r.PatternPositiveIntElement.Equal(*other.PatternPositiveIntElement) {
+		return false
+	}
+	if (r.PatternString == nil) != (other.PatternString == nil) || r.PatternString != nil && *r.PatternString != *other.PatternString {
+		return false
+	}
+	if (r.PatternStringElement == nil) != (other.PatternStringElement == nil) || r.PatternStringElement != nil && !r.PatternStringElement.Equal(*other.PatternStringElement) {
+		return false
+	}
+	if (r.PatternTime == nil) != (other.PatternTime == nil) || r.PatternTime != nil && *r.PatternTime != *other.PatternTime {
+		return false
+	}
+	if (r.PatternTimeElement == nil) != (other.PatternTimeElement == nil) || r.PatternTimeElement != nil && !r.PatternTimeElement.Equal(*other.PatternTimeElement) {
+		return false
+	}
+	if (r.PatternUnsignedInt == nil) != (other.PatternUnsignedInt == nil) || r.PatternUnsignedInt != nil && *r.PatternUnsignedInt != *other.PatternUnsignedInt {
+		return false
+	}
+	if (r.PatternUnsignedIntElement == nil) != (other.PatternUnsignedIntElement == nil) || r.PatternUnsignedIntElement != nil && !r.PatternUnsignedIntElement.Equal(*other.PatternUnsignedIntElement) {
+		return false
+	}
+	if (r.PatternUri == nil) != (other.PatternUri == nil) || r.PatternUri != nil && *r.PatternUri != *other.PatternUri {
+		return false
+	}
+	if (r.PatternUriElement == nil) != (other.PatternUriElement == nil) || r.PatternUriElement != nil && !r.PatternUriElement.Equal(*other.PatternUriElement) {
+		return false
+	}
+	if (r.PatternUrl == nil) != (other.PatternUrl == nil) || r.PatternUrl != nil && *r.PatternUrl != *other.PatternUrl {
+		return false
+	}
+	if (r.PatternUrlElement == nil) != (other.PatternUrlElement == nil) || r.PatternUrlElement != nil && !r.PatternUrlElement.Equal(*other.PatternUrlElement) {
+		return false
+	}
+	if (r.PatternUuid == nil) != (other.PatternUuid == nil) || r.PatternUuid != nil && *r.PatternUuid != *other.PatternUuid {
+		return false
+	}
+	if (r.PatternUuidElement == nil) != (other.PatternUuidElement == nil) || r.PatternUuidElement != nil && !r.PatternUuidElement.Equal(*other.PatternUuidElement) {
+		return false
+	}
+	if (r.PatternAddress == nil) != (other.PatternAddress == nil) || r.PatternAddress != nil && !r.PatternAddress.Equal(*other.PatternAddress) {
+		return false
+	}
+	if (r.PatternAge == nil) != (other.PatternAge == nil) || r.PatternAge != nil && !r.PatternAge.Equal(*other.PatternAge) {
+		return false
+	}
+	if (r.PatternAnnotation == nil) != (other.PatternAnnotation == nil) || r.PatternAnnotation != nil && !r.PatternAnnotation.Equal(*other.PatternAnnotation) {
+		return false
+	}
+	if (r.PatternAttachment == nil) != (other.PatternAttachment == nil) || r.PatternAttachment != nil && !r.PatternAttachment.Equal(*other.PatternAttachment) {
+		return false
+	}
+	if (r.PatternCodeableConcept == nil) != (other.PatternCodeableConcept == nil) || r.PatternCodeableConcept != nil && !r.PatternCodeableConcept.Equal(*other.PatternCodeableConcept) {
+		return false
+	}
+	if (r.PatternCoding == nil) != (other.PatternCoding == nil) || r.PatternCoding != nil && !r.PatternCoding.Equal(*other.PatternCoding) {
+		return false
+	}
+	if (r.PatternContactPoint == nil) != (other.PatternContactPoint == nil) || r.PatternContactPoint != nil && !r.PatternContactPoint.Equal(*other.PatternContactPoint) {
+		return false
+	}
+	if (r.PatternCount == nil) != (other.PatternCount == nil) || r.PatternCount != nil && !r.PatternCount.Equal(*other.PatternCount) {
+		return false
+	}
+	if (r.PatternDistance == nil) != (other.PatternDistance == nil) || r.PatternDistance != nil && !r.PatternDistance.Equal(*other.PatternDistance) {
+		return false
+	}
+	if (r.PatternDuration == nil) != (other.PatternDuration == nil) || r.PatternDuration != nil && !r.PatternDuration.Equal(*other.PatternDuration) {
+		return false
+	}
+	if (r.PatternHumanName == nil) != (other.PatternHumanName == nil) || r.PatternHumanName != nil && !r.PatternHumanName.Equal(*other.PatternHumanName) {
+		return false
+	}
+	if (r.PatternIdentifier == nil) != (other.PatternIdentifier == nil) || r.PatternIdentifier != nil && !r.PatternIdentifier.Equal(*other.PatternIdentifier) {
+		return false
+	}
+	if (r.PatternMoney == nil) != (other.PatternMoney == nil) || r.PatternMoney != nil && !r.PatternMoney.Equal(*other.PatternMoney) {
+		return false
+	}
+	if (r.PatternPeriod == nil) != (other.PatternPeriod == nil) || r.PatternPeriod != nil && !r.PatternPeriod.Equal(*other.PatternPeriod) {
+		return false
+	}
+	if (r.PatternQuantity == nil) != (other.PatternQuantity == nil) || r.PatternQuantity != nil && !r.PatternQuantity.Equal(*other.PatternQuantity) {
+		return false
+	}
+	if (r.PatternRange == nil) != (other.PatternRange == nil) || r.PatternRange != nil && !r.PatternRange.Equal(*other.PatternRange) {
+		return false
+	}
+	if (r.PatternRatio == nil) != (other.PatternRatio == nil) || r.PatternRatio != nil && !r.PatternRatio.Equal(*other.PatternRatio) {
+		return false
+	}
+	if (r.PatternReference == nil) != (other.PatternReference == nil) || r.PatternReference != nil && !r.PatternReference.Equal(*other.PatternReference) {
+		return false
+	}
+	if (r.PatternSampledData == nil) != (other.PatternSampledData == nil) || r.PatternSampledData != nil && !r.PatternSampledData.Equal(*other.PatternSampledData) {
+		return false
+	}
+	if (r.PatternSignature == nil) != (other.PatternSignature == nil) || r.PatternSignature != nil && !r.PatternSignature.Equal(*other.PatternSignature) {
+		return false
+	}
+	if (r.PatternTiming == nil) != (other.PatternTiming == nil) || r.PatternTiming != nil && !r.PatternTiming.Equal(*other.PatternTiming) {
+		return false
+	}
+	if (r.PatternContactDetail == nil) != (other.PatternContactDetail == nil) || r.PatternContactDetail != nil && !r.PatternContactDetail.Equal(*other.PatternContactDetail) {
+		return false
+	}
+	if (r.PatternContributor == nil) != (other.PatternContributor == nil) || r.PatternContributor != nil && !r.PatternContributor.Equal(*other.PatternContributor) {
+		return false
+	}
+	if (r.PatternDataRequirement == nil) != (other.PatternDataRequirement == nil) || r.PatternDataRequirement != nil && !r.PatternDataRequirement.Equal(*other.PatternDataRequirement) {
+		return false
+	}
+	if (r.PatternExpression == nil) != (other.PatternExpression == nil) || r.PatternExpression != nil && !r.PatternExpression.Equal(*other.PatternExpression) {
+		return false
+	}
+	if (r.PatternParameterDefinition == nil) != (other.PatternParameterDefinition == nil) || r.PatternParameterDefinition != nil && !r.PatternParameterDefinition.Equal(*other.PatternParameterDefinition) {
+		return false
+	}
+	if (r.PatternRelatedArtifact == nil) != (other.PatternRelatedArtifact == nil) || r.PatternRelatedArtifact != nil && !r.PatternRelatedArtifact.Equal(*other.PatternRelatedArtifact) {
+		return false
+	}
+	if (r.PatternTriggerDefinition == nil) != (other.PatternTriggerDefinition == nil) || r.PatternTriggerDefinition != nil && !r.PatternTriggerDefinition.Equal(*other.PatternTriggerDefinition) {
+		return false
+	}
+	if (r.PatternUsageContext == nil) != (other.PatternUsageContext == nil) || r.PatternUsageContext != nil && !r.PatternUsageContext.Equal(*other.PatternUsageContext) {
+		return false
+	}
+	if (r.PatternDosage == nil) != (other.PatternDosage == nil) || r.PatternDosage != nil && !r.PatternDosage.Equal(*other.PatternDosage) {
+		return false
+	}
+	if (r.PatternMeta == nil) != (other.PatternMeta == nil) || r.PatternMeta != nil && !r.PatternMeta.Equal(*other.PatternMeta) {
+		return false
+	}
+	if len(r.Example) != len(other.Example) {
+		return false
+	}
+	for i := range r.Example {
+		if !r.Example[i].Equal(other.Example[i]) {
+			return false
+		}
+	}
+	if (r.MinValueDate == nil) != (other.MinValueDate == nil) || r.MinValueDate != nil && *r.MinValueDate != *other.MinValueDate {
+		return false
+	}
+	if (r.MinValueDateElement == nil) != (other.MinValueDateElement == nil) || r.MinValueDateElement != nil && !r.MinValueDateElement.Equal(*other.MinValueDateElement) {
+		return false
+	}
+	if (r.MinValueDateTime == nil) != (other.MinValueDateTime == nil) || r.MinValueDateTime != nil && *r.MinValueDateTime != *other.MinValueDateTime {
+		return false
+	}
+	if (r.MinValueDateTimeElement == nil) != (other.MinValueDateTimeElement == nil) || r.MinValueDateTimeElement != nil && !r.MinValueDateTimeElement.Equal(*other.MinValueDateTimeElement) {
+		return false
+	}
+	if (r.MinValueInstant == nil) != (other.MinValueInstant == nil) || r.MinValueInstant != nil && *r.MinValueInstant != *other.MinValueInstant {
+		return false
+	}
+	if (r.MinValueInstantElement == nil) != (other.MinValueInstantElement == nil) || r.MinValueInstantElement != nil && !r.MinValueInstantElement.Equal(*other.MinValueInstantElement) {
+		return false
+	}
+	if (r.MinValueTime == nil) != (other.MinValueTime == nil) || r.MinValueTime != nil && *r.MinValueTime != *other.MinValueTime {
+		return false
+	}
+	if (r.MinValueTimeElement == nil) != (other.MinValueTimeElement == nil) || r.MinValueTimeElement != nil && !r.MinValueTimeElement.Equal(*other.MinValueTimeElement) {
+		return false
+	}
+	if (r.MinValueDecimal == nil) != (other.MinValueDecimal == nil) || r.MinValueDecimal != nil && !EqualDecimal(*r.MinValueDecimal, *other.MinValueDecimal) {
+		return false
+	}
+	if (r.MinValueDecimalElement == nil) != (other.MinValueDecimalElement == nil) || r.MinValueDecimalElement != nil && !r.MinValueDecimalElement.Equal(*other.MinValueDecimalElement) {
+		return false
+	}
+	if (r.MinValueInteger == nil) != (other.MinValueInteger == nil) || r.MinValueInteger != nil && *r.MinValueInteger != *other.MinValueInteger {
+		return false
+	}
+	if (r.MinValueIntegerElement == nil) != (other.MinValueIntegerElement == nil) || r.MinValueIntegerElement != nil && !r.MinValueIntegerElement.Equal(*other.MinValueIntegerElement) {
+		return false
+	}
+	if (r.MinValuePositiveInt == nil) != (other.MinValuePositiveInt == nil) || r.MinValuePositiveInt != nil && *r.MinValuePositiveInt != *other.MinValuePositiveInt {
+		return false
+	}
+	if (r.MinValuePositiveIntElement == nil) != (other.MinValuePositiveIntElement == nil) || r.MinValuePositiveIntElement != nil && !r.MinValuePositiveIntElement.Equal(*other.MinValuePositiveIntElement) {
+		return false
+	}
+	if (r.MinValueUnsignedInt == nil) != (other.MinValueUnsignedInt == nil) || r.MinValueUnsignedInt != nil && *r.MinValueUnsignedInt != *other.MinValueUnsignedInt {
+		return false
+	}
+	if (r.MinValueUnsignedIntElement == nil) != (other.MinValueUnsignedIntElement == nil) || r.MinValueUnsignedIntElement != nil && !r.MinValueUnsignedIntElement.Equal(*other.MinValueUnsignedIntElement) {
+		return false
+	}
+	if (r.MinValueQuantity == nil) != (other.MinValueQuantity == nil) || r.MinValueQuantity != nil && !r.MinValueQuantity.Equal(*other.MinValueQuantity) {
+		return false
+	}
+	if (r.MaxValueDate == nil) != (other.MaxValueDate == nil) || r.MaxValueDate != nil && *r.MaxValueDate != *other.MaxValueDate {
+		return false
+	}
+	if (r.MaxValueDateElement == nil) != (other.MaxValueDateElement == nil) || r.MaxValueDateElement != nil && !r.MaxValueDateElement.Equal(*other.MaxValueDateElement) {
+		return false
+	}
+	if (r.MaxValueDateTime == nil) != (other.MaxValueDateTime == nil) || r.MaxValueDateTime != nil && *r.MaxValueDateTime != *other.MaxValueDateTime {
+		return false
+	}
+	if (r.MaxValueDateTimeElement == nil) != (other.MaxValueDateTimeElement == nil) || r.MaxValueDateTimeElement != nil && !r.MaxValueDateTimeElement.Equal(*other.MaxValueDateTimeElement) {
+		return false
+	}
+	if (r.MaxValueInstant == nil) != (other.MaxValueInstant == nil) || r.MaxValueInstant != nil && *r.MaxValueInstant != *other.MaxValueInstant {
+		return false
+	}
+	if (r.MaxValueInstantElement == nil) != (other.MaxValueInstantElement == nil) || r.MaxValueInstantElement != nil && !r.MaxValueInstantElement.Equal(*other.MaxValueInstantElement) {
+		return false
+	}
+	if (r.MaxValueTime == nil) != (other.MaxValueTime == nil) || r.MaxValueTime != nil && *r.MaxValueTime != *other.MaxValueTime {
+		return false
+	}
+	if (r.MaxValueTimeElement == nil) != (other.MaxValueTimeElement == nil) || r.MaxValueTimeElement != nil && !r.MaxValueTimeElement.Equal(*other.MaxValueTimeElement) {
+		return false
+	}
+	if (r.MaxValueDecimal == nil) != (other.MaxValueDecimal == nil) || r.MaxValueDecimal != nil && !EqualDecimal(*r.MaxValueDecimal, *other.MaxValueDecimal) {
+		return false
+	}
+	if (r.MaxValueDecimalElement == nil) != (other.MaxValueDecimalElement == nil) || r.MaxValueDecimalElement != nil && !r.MaxValueDecimalElement.Equal(*other.MaxValueDecimalElement) {
+		return false
+	}
+	if (r.MaxValueInteger == nil) != (other.MaxValueInteger == nil) || r.MaxValueInteger != nil && *r.MaxValueInteger != *other.MaxValueInteger {
+		return false
+	}
+	if (r.MaxValueIntegerElement == nil) != (other.MaxValueIntegerElement == nil) || r.MaxValueIntegerElement != nil && !r.MaxValueIntegerElement.Equal(*other.MaxValueIntegerElement) {
+		return false
+	}
+	if (r.MaxValuePositiveInt == nil) != (other.MaxValuePositiveInt == nil) || r.MaxValuePositiveInt != nil && *r.MaxValuePositiveInt != *other.MaxValuePositiveInt {
+		return false
+	}
+	if (r.MaxValuePositiveIntElement == nil) != (other.MaxValuePositiveIntElement == nil) || r.MaxValuePositiveIntElement != nil && !r.MaxValuePositiveIntElement.Equal(*other.MaxValuePositiveIntElement) {
+		return false
+	}
+	if (r.MaxValueUnsignedInt == nil) != (other.MaxValueUnsignedInt == nil) || r.MaxValueUnsignedInt != nil && *r.MaxValueUnsignedInt != *other.MaxValueUnsignedInt {
+		return false
+	}
+	if (r.MaxValueUnsignedIntElement == nil) != (other.MaxValueUnsignedIntElement == nil) || r.MaxValueUnsignedIntElement != nil && !r.MaxValueUnsignedIntElement.Equal(*other.MaxValueUnsignedIntElement) {
+		return false
+	}
+	if (r.MaxValueQuantity == nil) != (other.MaxValueQuantity == nil) || r.MaxValueQuantity != nil && !r.MaxValueQuantity.Equal(*other.MaxValueQuantity) {
+		return false
+	}
+	if (r.MaxLength == nil) != (other.MaxLength == nil) || r.MaxLength != nil && *r.MaxLength != *other.MaxLength {
+		return false
+	}
+	if (r.MaxLengthElement == nil) != (other.MaxLengthElement == nil) || r.MaxLengthElement != nil && !r.MaxLengthElement.Equal(*other.MaxLengthElement) {
+		return false
+	}
+	if len(r.Condition) != len(other.Condition) {
+		return false
+	}
+	for i := range r.Condition {
+		if r.Condition[i] != other.Condition[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ConditionElement, other.ConditionElement, false) {
+		return false
+	}
+	if len(r.Constraint) != len(other.Constraint) {
+		return false
+	}
+	for i := range r.Constraint {
+		if !r.Constraint[i].Equal(other.Constraint[i]) {
+			return false
+		}
+	}
+	if (r.MustSupport == nil) != (other.MustSupport == nil) || r.MustSupport != nil && *r.MustSupport != *other.MustSupport {
+		return false
+	}
+	if (r.MustSupportElement == nil) != (other.MustSupportElement == nil) || r.MustSupportElement != nil && !r.MustSupportElement.Equal(*other.MustSupportElement) {
+		return false
+	}
+	if (r.IsModifier == nil) != (other.IsModifier == nil) || r.IsModifier != nil && *r.IsModifier != *other.IsModifier {
+		return false
+	}
+	if (r.IsModifierElement == nil) != (other.IsModifierElement == nil) || r.IsModifierElement != nil && !r.IsModifierElement.Equal(*other.IsModifierElement) {
+		return false
+	}
+	if (r.IsModifierReason == nil) != (other.IsModifierReason == nil) || r.IsModifierReason != nil && *r.IsModifierReason != *other.IsModifierReason {
+		return false
+	}
+	if (r.IsModifierReasonElement == nil) != (other.IsModifierReasonElement == nil) || r.IsModifierReasonElement != nil && !r.IsModifierReasonElement.Equal(*other.IsModifierReasonElement) {
+		return false
+	}
+	if (r.IsSummary == nil) != (other.IsSummary == nil) || r.IsSummary != nil && *r.IsSummary != *other.IsSummary {
+		return false
+	}
+	if (r.IsSummaryElement == nil) != (other.IsSummaryElement == nil) || r.IsSummaryElement != nil && !r.IsSummaryElement.Equal(*other.IsSummaryElement) {
+		return false
+	}
+	if (r.Binding == nil) != (other.Binding == nil) || r.Binding != nil && !r.Binding.Equal(*other.Binding) {
+		return false
+	}
+	if len(r.Mapping) != len(other.Mapping) {
+		return false
+	}
+	for i := range r.Mapping {
+		if !r.Mapping[i].Equal(other.Mapping[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ElementDefinition) EqualsDeep(other ElementDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if !equivalentList(len(r.Representation), len(other.Representation), func(i, j int) bool {
+		return r.Representation[i] == other.Representation[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.RepresentationElement, other.RepresentationElement, true) {
+		return false
+	}
+	if (r.SliceName == nil) != (other.SliceName == nil) || r.SliceName != nil && !equivalentString(*r.SliceName, *other.SliceName) {
+		return false
+	}
+	if (r.SliceNameElement == nil) != (other.SliceNameElement == nil) || r.SliceNameElement != nil && !r.SliceNameElement.EqualsDeep(*other.SliceNameElement) {
+		return false
+	}
+	if (r.SliceIsConstraining == nil) != (other.SliceIsConstraining == nil) || r.SliceIsConstraining != nil && *r.SliceIsConstraining != *other.SliceIsConstraining {
+		return false
+	}
+	if (r.SliceIsConstrainingElement == nil) != (other.SliceIsConstrainingElement == nil) || r.SliceIsConstrainingElement != nil && !r.SliceIsConstrainingElement.EqualsDeep(*other.SliceIsConstrainingElement) {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && !equivalentString(*r.Label, *other.Label) {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.EqualsDeep(*other.LabelElement) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if (r.Slicing == nil) != (other.Slicing == nil) || r.Slicing != nil && !r.Slicing.EqualsDeep(*other.Slicing) {
+		return false
+	}
+	if (r.Short == nil) != (other.Short == nil) || r.Short != nil && !equivalentString(*r.Short, *other.Short) {
+		return false
+	}
+	if (r.ShortElement == nil) != (other.ShortElement == nil) || r.ShortElement != nil && !r.ShortElement.EqualsDeep(*other.ShortElement) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.EqualsDeep(*other.DefinitionElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	if (r.Requirements == nil) != (other.Requirements == nil) || r.Requirements != nil && !equivalentString(*r.Requirements, *other.Requirements) {
+		return false
+	}
+	if (r.RequirementsElement == nil) != (other.RequirementsElement == nil) || r.RequirementsElement != nil && !r.RequirementsElement.EqualsDeep(*other.RequirementsElement) {
+		return false
+	}
+	if !equivalentList(len(r.Alias), len(other.Alias), func(i, j int) bool {
+		return equivalentString(r.Alias[i], other.Alias[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.AliasElement, other.AliasElement, true) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.EqualsDeep(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.EqualsDeep(*other.MaxElement) {
+		return false
+	}
+	if (r.Base == nil) != (other.Base == nil) || r.Base != nil && !r.Base.EqualsDeep(*other.Base) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !equivalentString(*r.ContentReference, *other.ContentReference) {
+		return false
+	}
+	if (r.ContentReferenceElement == nil) != (other.ContentReferenceElement == nil) || r.ContentReferenceElement != nil && !r.ContentReferenceElement.EqualsDeep(*other.ContentReferenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if (r.DefaultValueBase64Binary == nil) != (other.DefaultValueBase64Binary == nil) || r.DefaultValueBase64Binary != nil && !equivalentString(*r.DefaultValueBase64Binary, *other.DefaultValueBase64Binary) {
+		return false
+	}
+	if (r.DefaultValueBase64BinaryElement == nil) != (other.DefaultValueBase64BinaryElement == nil) || r.DefaultValueBase64BinaryElement != nil && !r.DefaultValueBase64BinaryElement.EqualsDeep(*other.DefaultValueBase64BinaryElement) {
+		return false
+	}
+	if (r.DefaultValueBoolean == nil) != (other.DefaultValueBoolean == nil) || r.DefaultValueBoolean != nil && *r.DefaultValueBoolean != *other.DefaultValueBoolean {
+		return false
+	}
+	if (r.DefaultValueBooleanElement == nil) != (other.DefaultValueBooleanElement == nil) || r.DefaultValueBooleanElement != nil && !r.DefaultValueBooleanElement.EqualsDeep(*other.DefaultValueBooleanElement) {
+		return false
+	}
+	if (r.DefaultValueCanonical == nil) != (other.DefaultValueCanonical == nil) || r.DefaultValueCanonical != nil && !equivalentString(*r.DefaultValueCanonical, *other.DefaultValueCanonical) {
+		return false
+	}
+	if (r.DefaultValueCanonicalElement == nil) != (other.DefaultValueCanonicalElement == nil) || r.DefaultValueCanonicalElement != nil && !r.DefaultValueCanonicalElement.EqualsDeep(*other.DefaultValueCanonicalElement) {
+		return false
+	}
+	if (r.DefaultValueCode == nil) != (other.DefaultValueCode == nil) || r.DefaultValueCode != nil && !equivalentString(*r.DefaultValueCode, *other.DefaultValueCode) {
+		return false
+	}
+	if (r.DefaultValueCodeElement == nil) != (other.DefaultValueCodeElement == nil) || r.DefaultValueCodeElement != nil && !r.DefaultValueCodeElement.EqualsDeep(*other.DefaultValueCodeElement) {
+		return false
+	}
+	if (r.DefaultValueDate == nil) != (other.DefaultValueDate == nil) || r.DefaultValueDate != nil && !equivalentString(*r.DefaultValueDate, *other.DefaultValueDate) {
+		return false
+	}
+	if (r.DefaultValueDateElement == nil) != (other.DefaultValueDateElement == nil) || r.DefaultValueDateElement != nil && !r.DefaultValueDateElement.EqualsDeep(*other.DefaultValueDateElement) {
+		return false
+	}
+	if (r.DefaultValueDateTime == nil) != (other.DefaultValueDateTime == nil) || r.DefaultValueDateTime != nil && !equivalentString(*r.DefaultValueDateTime, *other.DefaultValueDateTime) {
+		return false
+	}
+	if (r.DefaultValueDateTimeElement == nil) != (other.DefaultValueDateTimeElement == nil) || r.DefaultValueDateTimeElement != nil && !r.DefaultValueDateTimeElement.EqualsDeep(*other.DefaultValueDateTimeElement) {
+		return false
+	}
+	if (r.DefaultValueDecimal == nil) != (other.DefaultValueDecimal == nil) || r.DefaultValueDecimal != nil && !equivalentDecimal(*r.DefaultValueDecimal, *other.DefaultValueDecimal) {
+		return false
+	}
+	if (r.DefaultValueDecimalElement == nil) != (other.DefaultValueDecimalElement == nil) || r.DefaultValueDecimalElement != nil && !r.DefaultValueDecimalElement.EqualsDeep(*other.DefaultValueDecimalElement) {
+		return false
+	}
+	if (r.DefaultValueId == nil) != (other.DefaultValueId == nil) || r.DefaultValueId != nil && !equivalentString(*r.DefaultValueId, *other.DefaultValueId) {
+		return false
+	}
+	if (r.DefaultValueIdElement == nil) != (other.DefaultValueIdElement == nil) || r.DefaultValueIdElement != nil && !r.DefaultValueIdElement.EqualsDeep(*other.DefaultValueIdElement) {
+		return false
+	}
+	if (r.DefaultValueInstant == nil) != (other.DefaultValueInstant == nil) || r.DefaultValueInstant != nil && !equivalentString(*r.DefaultValueInstant, *other.DefaultValueInstant) {
+		return false
+	}
+	if (r.DefaultValueInstantElement == nil) != (other.DefaultValueInstantElement == nil) || r.DefaultValueInstantElement != nil && !r.DefaultValueInstantElement.EqualsDeep(*other.DefaultValueInstantElement) {
+		return false
+	}
+	if (r.DefaultValueInteger == nil) != (other.DefaultValueInteger == nil) || r.DefaultValueInteger != nil && *r.DefaultValueInteger != *other.DefaultValueInteger {
+		return false
+	}
+	if (r.DefaultValueIntegerElement == nil) != (other.DefaultValueIntegerElement == nil) || r.DefaultValueIntegerElement != nil && !r.DefaultValueIntegerElement.EqualsDeep(*other.DefaultValueIntegerElement) {
+		return false
+	}
+	if (r.DefaultValueMarkdown == nil) != (other.DefaultValueMarkdown == nil) || r.DefaultValueMarkdown != nil && !equivalentString(*r.DefaultValueMarkdown, *other.DefaultValueMarkdown) {
+		return false
+	}
+	if (r.DefaultValueMarkdownElement == nil) != (other.DefaultValueMarkdownElement == nil) || r.DefaultValueMarkdownElement != nil && !r.DefaultValueMarkdownElement.EqualsDeep(*other.DefaultValueMarkdownElement) {
+		return false
+	}
+	if (r.DefaultValueOid == nil) != (other.DefaultValueOid == nil) || r.DefaultValueOid != nil && !equivalentString(*r.DefaultValueOid, *other.DefaultValueOid) {
+		return false
+	}
+	if (r.DefaultValueOidElement == nil) != (other.DefaultValueOidElement == nil) || r.DefaultValueOidElement != nil && !r.DefaultValueOidElement.EqualsDeep(*other.DefaultValueOidElement) {
+		return false
+	}
+	if (r.DefaultValuePositiveInt == nil) != (other.DefaultValuePositiveInt == nil) || r.DefaultValuePositiveInt != nil && *r.DefaultValuePositiveInt != *other.DefaultValuePositiveInt {
+		return false
+	}
+	if (r.DefaultValuePositiveIntElement == nil) != (other.DefaultValuePositiveIntElement == nil) || r.DefaultValuePositiveIntElement != nil && !r.DefaultValuePositiveIntElement.EqualsDeep(*other.DefaultValuePositiveIntElement) {
+		return false
+	}
+	if (r.DefaultValueString == nil) != (other.DefaultValueString == nil) || r.DefaultValueString != nil && !equivalentString(*r.DefaultValueString, *other.DefaultValueString) {
+		return false
+	}
+	if (r.DefaultValueStringElement == nil) != (other.DefaultValueStringElement == nil) || r.DefaultValueStringElement != nil && !r.DefaultValueStringElement.EqualsDeep(*other.DefaultValueStringElement) {
+		return false
+	}
+	if (r.DefaultValueTime == nil) != (other.DefaultValueTime == nil) || r.DefaultValueTime != nil && !equivalentString(*r.DefaultValueTime, *other.DefaultValueTime) {
+		return false
+	}
+	if (r.DefaultValueTimeElement == nil) != (other.DefaultValueTimeElement == nil) || r.DefaultValueTimeElement != nil && !r.DefaultValueTimeElement.EqualsDeep(*other.DefaultValueTimeElement) {
+		return false
+	}
+	if (r.DefaultValueUnsignedInt == nil) != (other.DefaultValueUnsignedInt == nil) || r.DefaultValueUnsignedInt != nil && *r.DefaultValueUnsignedInt != *other.DefaultValueUnsignedInt {
+		return false
+	}
+	if (r.DefaultValueUnsignedIntElement == nil) != (other.DefaultValueUnsignedIntElement == nil) || r.DefaultValueUnsignedIntElement != nil && !r.DefaultValueUnsignedIntElement.EqualsDeep(*other.DefaultValueUnsignedIntElement) {
+		return false
+	}
+	if (r.DefaultValueUri == nil) != (other.DefaultValueUri == nil) || r.DefaultValueUri != nil && !equivalentString(*r.DefaultValueUri, *other.DefaultValueUri) {
+		return false
+	}
+	if (r.DefaultValueUriElement == nil) != (other.DefaultValueUriElement == nil) || r.DefaultValueUriElement != nil && !r.DefaultValueUriElement.EqualsDeep(*other.DefaultValueUriElement) {
+		return false
+	}
+	if (r.DefaultValueUrl == nil) != (other.DefaultValueUrl == nil) || r.DefaultValueUrl != nil && !equivalentString(*r.DefaultValueUrl, *other.DefaultValueUrl) {
+		return false
+	}
+	if (r.DefaultValueUrlElement == nil) != (other.DefaultValueUrlElement == nil) || r.DefaultValueUrlElement != nil && !r.DefaultValueUrlElement.EqualsDeep(*other.DefaultValueUrlElement) {
+		return false
+	}
+	if (r.DefaultValueUuid == nil) != (other.DefaultValueUuid == nil) || r.DefaultValueUuid != nil && !equivalentString(*r.DefaultValueUuid, *other.DefaultValueUuid) {
+		return false
+	}
+	if (r.DefaultValueUuidElement == nil) != (other.DefaultValueUuidElement == nil) || r.DefaultValueUuidElement != nil && !r.DefaultValueUuidElement.EqualsDeep(*other.DefaultValueUuidElement) {
+		return false
+	}
+	if (r.DefaultValueAddress == nil) != (other.DefaultValueAddress == nil) || r.DefaultValueAddress != nil && !r.DefaultValueAddress.EqualsDeep(*other.DefaultValueAddress) {
+		return false
+	}
+	if (r.DefaultValueAge == nil) != (other.DefaultValueAge == nil) || r.DefaultValueAge != nil && !r.DefaultValueAge.EqualsDeep(*other.DefaultValueAge) {
+		return false
+	}
+	if (r.DefaultValueAnnotation == nil) != (other.DefaultValueAnnotation == nil) || r.DefaultValueAnnotation != nil && !r.DefaultValueAnnotation.EqualsDeep(*other.DefaultValueAnnotation) {
+		return false
+	}
+	if (r.DefaultValueAttachment == nil) != (other.DefaultValueAttachment == nil) || r.DefaultValueAttachment != nil && !r.DefaultValueAttachment.EqualsDeep(*other.DefaultValueAttachment) {
+		return false
+	}
+	if (r.DefaultValueCodeableConcept == nil) != (other.DefaultValueCodeableConcept == nil) || r.DefaultValueCodeableConcept != nil && !r.DefaultValueCodeableConcept.EqualsDeep(*other.DefaultValueCodeableConcept) {
+		return false
+	}
+	if (r.DefaultValueCoding == nil) != (other.DefaultValueCoding == nil) || r.DefaultValueCoding != nil && !r.DefaultValueCoding.EqualsDeep(*other.DefaultValueCoding) {
+		return false
+	}
+	if (r.DefaultValueContactPoint == nil) != (other.DefaultValueContactPoint == nil) || r.DefaultValueContactPoint != nil && !r.DefaultValueContactPoint.EqualsDeep(*other.DefaultValueContactPoint) {
+		return false
+	}
+	if (r.DefaultValueCount == nil) != (other.DefaultValueCount == nil) || r.DefaultValueCount != nil && !r.DefaultValueCount.EqualsDeep(*other.DefaultValueCount) {
+		return false
+	}
+	if (r.DefaultValueDistance == nil) != (other.DefaultValueDistance == nil) || r.DefaultValueDistance != nil && !r.DefaultValueDistance.EqualsDeep(*other.DefaultValueDistance) {
+		return false
+	}
+	if (r.DefaultValueDuration == nil) != (other.DefaultValueDuration == nil) || r.DefaultValueDuration != nil && !r.DefaultValueDuration.EqualsDeep(*other.DefaultValueDuration) {
+		return false
+	}
+	if (r.DefaultValueHumanName == nil) != (other.DefaultValueHumanName == nil) || r.DefaultValueHumanName != nil && !r.DefaultValueHumanName.EqualsDeep(*other.DefaultValueHumanName) {
+		return false
+	}
+	if (r.DefaultValueIdentifier == nil) != (other.DefaultValueIdentifier == nil) || r.DefaultValueIdentifier != nil && !r.DefaultValueIdentifier.EqualsDeep(*other.DefaultValueIdentifier) {
+		return false
+	}
+	if (r.DefaultValueMoney == nil) != (other.DefaultValueMoney == nil) || r.DefaultValueMoney != nil && !r.DefaultValueMoney.EqualsDeep(*other.DefaultValueMoney) {
+		return false
+	}
+	if (r.DefaultValuePeriod == nil) != (other.DefaultValuePeriod == nil) || r.DefaultValuePeriod != nil && !r.DefaultValuePeriod.EqualsDeep(*other.DefaultValuePeriod) {
+		return false
+	}
+	if (r.DefaultValueQuantity == nil) != (other.DefaultValueQuantity == nil) || r.DefaultValueQuantity != nil && !r.DefaultValueQuantity.EqualsDeep(*other.DefaultValueQuantity) {
+		return false
+	}
+	if (r.DefaultValueRange == nil) != (other.DefaultValueRange == nil) || r.DefaultValueRange != nil && !r.DefaultValueRange.EqualsDeep(*other.DefaultValueRange) {
+		return false
+	}
+	if (r.DefaultValueRatio == nil) != (other.DefaultValueRatio == nil) || r.DefaultValueRatio != nil && !r.DefaultValueRatio.EqualsDeep(*other.DefaultValueRatio) {
+		return false
+	}
+	if (r.DefaultValueReference == nil) != (other.DefaultValueReference == nil) || r.DefaultValueReference != nil && !r.DefaultValueReference.EqualsDeep(*other.DefaultValueReference) {
+		return false
+	}
+	if (r.DefaultValueSampledData == nil) != (other.DefaultValueSampledData == nil) || r.DefaultValueSampledData != nil && !r.DefaultValueSampledData.EqualsDeep(*other.DefaultValueSampledData) {
+		return false
+	}
+	if (r.DefaultValueSignature == nil) != (other.DefaultValueSignature == nil) || r.DefaultValueSignature != nil && !r.DefaultValueSignature.EqualsDeep(*other.DefaultValueSignature) {
+		return false
+	}
+	if (r.DefaultValueTiming == nil) != (other.DefaultValueTiming == nil) || r.DefaultValueTiming != nil && !r.DefaultValueTiming.EqualsDeep(*other.DefaultValueTiming) {
+		return false
+	}
+	if (r.DefaultValueContactDetail == nil) != (other.DefaultValueContactDetail == nil) || r.DefaultValueContactDetail != nil && !r.DefaultValueContactDetail.EqualsDeep(*other.DefaultValueContactDetail) {
+		return false
+	}
+	if (r.DefaultValueContributor == nil) != (other.DefaultValueContributor == nil) || r.DefaultValueContributor != nil && !r.DefaultValueContributor.EqualsDeep(*other.DefaultValueContributor) {
+		return false
+	}
+	if (r.DefaultValueDataRequirement == nil) != (other.DefaultValueDataRequirement == nil) || r.DefaultValueDataRequirement != nil && !r.DefaultValueDataRequirement.EqualsDeep(*other.DefaultValueDataRequirement) {
+		return false
+	}
+	if (r.DefaultValueExpression == nil) != (other.DefaultValueExpression == nil) || r.DefaultValueExpression != nil && !r.DefaultValueExpression.EqualsDeep(*other.DefaultValueExpression) {
+		return false
+	}
+	if (r.DefaultValueParameterDefinition == nil) != (other.DefaultValueParameterDefinition == nil) || r.DefaultValueParameterDefinition != nil && !r.DefaultValueParameterDefinition.EqualsDeep(*other.DefaultValueParameterDefinition) {
+		return false
+	}
+	if (r.DefaultValueRelatedArtifact == nil) != (other.DefaultValueRelatedArtifact == nil) || r.DefaultValueRelatedArtifact != nil && !r.DefaultValueRelatedArtifact.EqualsDeep(*other.DefaultValueRelatedArtifact) {
+		return false
+	}
+	if (r.DefaultValueTriggerDefinition == nil) != (other.DefaultValueTriggerDefinition == nil) || r.DefaultValueTriggerDefinition != nil && !r.DefaultValueTriggerDefinition.EqualsDeep(*other.DefaultValueTriggerDefinition) {
+		return false
+	}
+	if (r.DefaultValueUsageContext == nil) != (other.DefaultValueUsageContext == nil) || r.DefaultValueUsageContext != nil && !r.DefaultValueUsageContext.EqualsDeep(*other.DefaultValueUsageContext) {
+		return false
+	}
+	if (r.DefaultValueDosage == nil) != (other.DefaultValueDosage == nil) || r.DefaultValueDosage != nil && !r.DefaultValueDosage.EqualsDeep(*other.DefaultValueDosage) {
+		return false
+	}
+	if (r.DefaultValueMeta == nil) != (other.DefaultValueMeta == nil) || r.DefaultValueMeta != nil && !r.DefaultValueMeta.EqualsDeep(*other.DefaultValueMeta) {
+		return false
+	}
+	if (r.MeaningWhenMissing == nil) != (other.MeaningWhenMissing == nil) || r.MeaningWhenMissing != nil && !equivalentString(*r.MeaningWhenMissing, *other.MeaningWhenMissing) {
+		return false
+	}
+	if (r.MeaningWhenMissingElement == nil) != (other.MeaningWhenMissingElement == nil) || r.MeaningWhenMissingElement != nil && !r.MeaningWhenMissingElement.EqualsDeep(*other.MeaningWhenMissingElement) {
+		return false
+	}
+	if (r.OrderMeaning == nil) != (other.OrderMeaning == nil) || r.OrderMeaning != nil && !equivalentString(*r.OrderMeaning, *other.OrderMeaning) {
+		return false
+	}
+	if (r.OrderMeaningElement == nil) != (other.OrderMeaningElement == nil) || r.OrderMeaningElement != nil && !r.OrderMeaningElement.EqualsDeep(*other.OrderMeaningElement) {
+		return false
+	}
+	if (r.FixedBase64Binary == nil) != (other.FixedBase64Binary == nil) || r.FixedBase64Binary != nil && !equivalentString(*r.FixedBase64Binary, *other.FixedBase64Binary) {
+		return false
+	}
+	if (r.FixedBase64BinaryElement == nil) != (other.FixedBase64BinaryElement == nil) || r.FixedBase64BinaryElement != nil && !r.FixedBase64BinaryElement.EqualsDeep(*other.FixedBase64BinaryElement) {
+		return false
+	}
+	if (r.FixedBoolean == nil) != (other.FixedBoolean == nil) || r.FixedBoolean != nil && *r.FixedBoolean != *other.FixedBoolean {
+		return false
+	}
+	if (r.FixedBooleanElement == nil) != (other.FixedBooleanElement == nil) || r.FixedBooleanElement != nil && !r.FixedBooleanElement.EqualsDeep(*other.FixedBooleanElement) {
+		return false
+	}
+	if (r.FixedCanonical == nil) != (other.FixedCanonical == nil) || r.FixedCanonical != nil && !equivalentString(*r.FixedCanonical, *other.FixedCanonical) {
+		return false
+	}
+	if (r.FixedCanonicalElement == nil) != (other.FixedCanonicalElement == nil) || r.FixedCanonicalElement != nil && !r.FixedCanonicalElement.EqualsDeep(*other.FixedCanonicalElement) {
+		return false
+	}
+	if (r.FixedCode == nil) != (other.FixedCode == nil) || r.FixedCode != nil && !equivalentString(*r.FixedCode, *other.FixedCode) {
+		return false
+	}
+	if (r.FixedCodeElement == nil) != (other.FixedCodeElement == nil) || r.FixedCodeElement != nil && !r.FixedCodeElement.EqualsDeep(*other.FixedCodeElement) {
+		return false
+	}
+	if (r.FixedDate == nil) != (other.FixedDate == nil) || r.FixedDate != nil && !equivalentString(*r.FixedDate, *other.FixedDate) {
+		return false
+	}
+	if (r.FixedDateElement == nil) != (other.FixedDateElement == nil) || r.FixedDateElement != nil && !r.FixedDateElement.EqualsDeep(*other.FixedDateElement) {
+		return false
+	}
+	if (r.FixedDateTime == nil) != (other.FixedDateTime == nil) || r.FixedDateTime != nil && !equivalentString(*r.FixedDateTime, *other.FixedDateTime) {
+		return false
+	}
+	if (r.FixedDateTimeElement == nil) != (other.FixedDateTimeElement == nil) || r.FixedDateTimeElement != nil && !r.FixedDateTimeElement.EqualsDeep(*other.FixedDateTimeElement) {
+		return false
+	}
+	if (r.FixedDecimal == nil) != (other.FixedDecimal == nil) || r.FixedDecimal != nil && !equivalentDecimal(*r.FixedDecimal, *other.FixedDecimal) {
+		return false
+	}
+	if (r.FixedDecimalElement == nil) != (other.FixedDecimalElement == nil) || r.FixedDecimalElement != nil && !r.FixedDecimalElement.EqualsDeep(*other.FixedDecimalElement) {
+		return false
+	}
+	if (r.FixedId == nil) != (other.FixedId == nil) || r.FixedId != nil && !equivalentString(*r.FixedId, *other.FixedId) {
+		return false
+	}
+	if (r.FixedIdElement == nil) != (other.FixedIdElement == nil) || r.FixedIdElement != nil && !r.FixedIdElement.EqualsDeep(*other.FixedIdElement) {
+		return false
+	}
+	if (r.FixedInstant == nil) != (other.FixedInstant == nil) || r.FixedInstant != nil && !equivalentString(*r.FixedInstant, *other.FixedInstant) {
+		return false
+	}
+	if (r.FixedInstantElement == nil) != (other.FixedInstantElement == nil) || r.FixedInstantElement != nil && !r.FixedInstantElement.EqualsDeep(*other.FixedInstantElement) {
+		return false
+	}
+	if (r.FixedInteger == nil) != (other.FixedInteger == nil) || r.FixedInteger != nil && *r.FixedInteger != *other.FixedInteger {
+		return false
+	}
+	if (r.FixedIntegerElement == nil) != (other.FixedIntegerElement == nil) || r.FixedIntegerElement != nil && !r.FixedIntegerElement.EqualsDeep(*other.FixedIntegerElement) {
+		return false
+	}
+	if (r.FixedMarkdown == nil) != (other.FixedMarkdown == nil) || r.FixedMarkdown != nil && !equivalentString(*r.FixedMarkdown, *other.FixedMarkdown) {
+		return false
+	}
+	if (r.FixedMarkdownElement == nil) != (other.FixedMarkdownElement == nil) || r.FixedMarkdownElement != nil && !r.FixedMarkdownElement.EqualsDeep(*other.FixedMarkdownElement) {
+		return false
+	}
+	if (r.FixedOid == nil) != (other.FixedOid == nil) || r.FixedOid != nil && !equivalentString(*r.FixedOid, *other.FixedOid) {
+		return false
+	}
+	if (r.FixedOidElement == nil) != (other.FixedOidElement == nil) || r.FixedOidElement != nil && !r.FixedOidElement.EqualsDeep(*other.FixedOidElement) {
+		return false
+	}
+	if (r.FixedPositiveInt == nil) != (other.FixedPositiveInt == nil) || r.FixedPositiveInt != nil && *r.FixedPositiveInt != *other.FixedPositiveInt {
+		return false
+	}
+	if (r.FixedPositiveIntElement == nil) != (other.FixedPositiveIntElement == nil) || r.FixedPositiveIntElement != nil && !r.FixedPositiveIntElement.EqualsDeep(*other.FixedPositiveIntElement) {
+		return false
+	}
+	if (r.FixedString == nil) != (other.FixedString == nil) || r.FixedString != nil && !equivalentString(*r.FixedString, *other.FixedString) {
+		return false
+	}
+	if (r.FixedStringElement == nil) != (other.FixedStringElement == nil) || r.FixedStringElement != nil && !r.FixedStringElement.EqualsDeep(*other.FixedStringElement) {
+		return false
+	}
+	if (r.FixedTime == nil) != (other.FixedTime == nil) || r.FixedTime != nil && !equivalentString(*r.FixedTime, *other.FixedTime) {
+		return false
+	}
+	if (r.FixedTimeElement == nil) != (other.FixedTimeElement == nil) || r.FixedTimeElement != nil && !r.FixedTimeElement.EqualsDeep(*other.FixedTimeElement) {
+		return false
+	}
+	if (r.FixedUnsignedInt == nil) != (other.FixedUnsignedInt == nil) || r.FixedUnsignedInt != nil && *r.FixedUnsignedInt != *other.FixedUnsignedInt {
+		return false
+	}
+	if (r.FixedUnsignedIntElement == nil) != (other.FixedUnsignedIntElement == nil) || r.FixedUnsignedIntElement != nil && !r.FixedUnsignedIntElement.EqualsDeep(*other.FixedUnsignedIntElement) {
+		return false
+	}
+	if (r.FixedUri == nil) != (other.FixedUri == nil) || r.FixedUri != nil && !equivalentString(*r.FixedUri, *other.FixedUri) {
+		return false
+	}
+	if (r.FixedUriElement == nil) != (other.FixedUriElement == nil) || r.FixedUriElement != nil && !r.FixedUriElement.EqualsDeep(*other.FixedUriElement) {
+		return false
+	}
+	if (r.FixedUrl == nil) != (other.FixedUrl == nil) || r.FixedUrl != nil && !equivalentString(*r.FixedUrl, *other.FixedUrl) {
+		return false
+	}
+	if (r.FixedUrlElement == nil) != (other.FixedUrlElement == nil) || r.FixedUrlElement != nil && !r.FixedUrlElement.EqualsDeep(*other.FixedUrlElement) {
+		return false
+	}
+	if (r.FixedUuid == nil) != (other.FixedUuid == nil) || r.FixedUuid != nil && !equivalentString(*r.FixedUuid, *other.FixedUuid) {
+		return false
+	}
+	if (r.FixedUuidElement == nil) != (other.FixedUuidElement == nil) || r.FixedUuidElement != nil && !r.FixedUuidElement.EqualsDeep(*other.FixedUuidElement) {
+		return false
+	}
+	if (r.FixedAddress == nil) != (other.FixedAddress == nil) || r.FixedAddress != nil && !r.FixedAddress.EqualsDeep(*other.FixedAddress) {
+		return false
+	}
+	if (r.FixedAge == nil) != (other.FixedAge == nil) || r.FixedAge != nil && !r.FixedAge.EqualsDeep(*other.FixedAge) {
+		return false
+	}
+	if (r.FixedAnnotation == nil) != (other.FixedAnnotation == nil) || r.FixedAnnotation != nil && !r.FixedAnnotation.EqualsDeep(*other.FixedAnnotation) {
+		return false
+	}
+	if (r.FixedAttachment == nil) != (other.FixedAttachment == nil) || r.FixedAttachment != nil && !r.FixedAttachment.EqualsDeep(*other.FixedAttachment) {
+		return false
+	}
+	if (r.FixedCodeableConcept == nil) != (other.FixedCodeableConcept == nil) || r.FixedCodeableConcept != nil && !r.FixedCodeableConcept.EqualsDeep(*other.FixedCodeableConcept) {
+		return false
+	}
+	if (r.FixedCoding == nil) != (other.FixedCoding == nil) || r.FixedCoding != nil && !r.FixedCoding.EqualsDeep(*other.FixedCoding) {
+		return false
+	}
+	if (r.FixedContactPoint == nil) != (other.FixedContactPoint == nil) || r.FixedContactPoint != nil && !r.FixedContactPoint.EqualsDeep(*other.FixedContactPoint) {
+		return false
+	}
+	if (r.FixedCount == nil) != (other.FixedCount == nil) || r.FixedCount != nil && !r.FixedCount.EqualsDeep(*other.FixedCount) {
+		return false
+	}
+	if (r.FixedDistance == nil) != (other.FixedDistance == nil) || r.FixedDistance != nil && !r.FixedDistance.EqualsDeep(*other.FixedDistance) {
+		return false
+	}
+	if (r.FixedDuration == nil) != (other.FixedDuration == nil) || r.FixedDuration != nil && !r.FixedDuration.EqualsDeep(*other.FixedDuration) {
+		return false
+	}
+	if (r.FixedHumanName == nil) != (other.FixedHumanName == nil) || r.FixedHumanName != nil && !r.FixedHumanName.EqualsDeep(*other.FixedHumanName) {
+		return false
+	}
+	if (r.FixedIdentifier == nil) != (other.FixedIdentifier == nil) || r.FixedIdentifier != nil && !r.FixedIdentifier.EqualsDeep(*other.FixedIdentifier) {
+		return false
+	}
+	if (r.FixedMoney == nil) != (other.FixedMoney == nil) || r.FixedMoney != nil && !r.FixedMoney.EqualsDeep(*other.FixedMoney) {
+		return false
+	}
+	if (r.FixedPeriod == nil) != (other.FixedPeriod == nil) || r.FixedPeriod != nil && !r.FixedPeriod.EqualsDeep(*other.FixedPeriod) {
+		return false
+	}
+	if (r.FixedQuantity == nil) != (other.FixedQuantity == nil) || r.FixedQuantity != nil && !r.FixedQuantity.EqualsDeep(*other.FixedQuantity) {
+		return false
+	}
+	if (r.FixedRange == nil) != (other.FixedRange == nil) || r.FixedRange != nil && !r.FixedRange.EqualsDeep(*other.FixedRange) {
+		return false
+	}
+	if (r.FixedRatio == nil) != (other.FixedRatio == nil) || r.FixedRatio != nil && !r.FixedRatio.EqualsDeep(*other.FixedRatio) {
+		return false
+	}
+	if (r.FixedReference == nil) != (other.FixedReference == nil) || r.FixedReference != nil && !r.FixedReference.EqualsDeep(*other.FixedReference) {
+		return false
+	}
+	if (r.FixedSampledData == nil) != (other.FixedSampledData == nil) || r.FixedSampledData != nil && !r.FixedSampledData.EqualsDeep(*other.FixedSampledData) {
+		return false
+	}
+	if (r.FixedSignature == nil) != (other.FixedSignature == nil) || r.FixedSignature != nil && !r.FixedSignature.EqualsDeep(*other.FixedSignature) {
+		return false
+	}
+	if (r.FixedTiming == nil) != (other.FixedTiming == nil) || r.FixedTiming != nil && !r.FixedTiming.EqualsDeep(*other.FixedTiming) {
+		return false
+	}
+	if (r.FixedContactDetail == nil) != (other.FixedContactDetail == nil) || r.FixedContactDetail != nil && !r.FixedContactDetail.EqualsDeep(*other.FixedContactDetail) {
+		return false
+	}
+	if (r.FixedContributor == nil) != (other.FixedContributor == nil) || r.FixedContributor != nil && !r.FixedContributor.EqualsDeep(*other.FixedContributor) {
+		return false
+	}
+	if (r.FixedDataRequirement == nil) != (other.FixedDataRequirement == nil) || r.FixedDataRequirement != nil && !r.FixedDataRequirement.EqualsDeep(*other.FixedDataRequirement) {
+		return false
+	}
+	if (r.FixedExpression == nil) != (other.FixedExpression == nil) || r.FixedExpression != nil && !r.FixedExpression.EqualsDeep(*other.FixedExpression) {
+		return false
+	}
+	if (r.FixedParameterDefinition == nil) != (other.FixedParameterDefinition == nil) || r.FixedParameterDefinition != nil && !r.FixedParameterDefinition.EqualsDeep(*other.FixedParameterDefinition) {
+		return false
+	}
+	if (r.FixedRelatedArtifact == nil) != (other.FixedRelatedArtifact == nil) || r.FixedRelatedArtifact != nil && !r.FixedRelatedArtifact.EqualsDeep(*other.FixedRelatedArtifact) {
+		return false
+	}
+	if (r.FixedTriggerDefinition == nil) != (other.FixedTriggerDefinition == nil) || r.FixedTriggerDefinition != nil && !r.FixedTriggerDefinition.EqualsDeep(*other.FixedTriggerDefinition) {
+		return false
+	}
+	if (r.FixedUsageContext == nil) != (other.FixedUsageContext == nil) || r.FixedUsageContext != nil && !r.FixedUsageContext.EqualsDeep(*other.FixedUsageContext) {
+		return false
+	}
+	if (r.FixedDosage == nil) != (other.FixedDosage == nil) || r.FixedDosage != nil && !r.FixedDosage.EqualsDeep(*other.FixedDosage) {
+		return false
+	}
+	if (r.FixedMeta == nil) != (other.FixedMeta == nil) || r.FixedMeta != nil && !r.FixedMeta.EqualsDeep(*other.FixedMeta) {
+		return false
+	}
+	if (r.PatternBase64Binary == nil) != (other.PatternBase64Binary == nil) || r.PatternBase64Binary != nil && !equivalentString(*r.PatternBase64Binary, *other.PatternBase64Binary) {
+		return false
+	}
+	if (r.PatternBase64BinaryElement == nil) != (other.PatternBase64BinaryElement == nil) || r.PatternBase64BinaryElement != nil && !r.PatternBase64BinaryElement.EqualsDeep(*other.PatternBase64BinaryElement) {
+		return false
+	}
+	if (r.PatternBoolean == nil) != (other.PatternBoolean == nil) || r.PatternBoolean != nil && *r.PatternBoolean != *other.PatternBoolean {
+		return false
+	}
+	if (r.PatternBooleanElement == nil) != (other.PatternBooleanElement == nil) || r.PatternBooleanElement != nil && !r.PatternBooleanElement.EqualsDeep(*other.PatternBooleanElement) {
+		return false
+	}
+	if (r.PatternCanonical == nil) != (other.PatternCanonical == nil) || r.PatternCanonical != nil && !equivalentString(*r.PatternCanonical, *other.PatternCanonical) {
+		return false
+	}
+	if (r.PatternCanonicalElement == nil) != (other.PatternCanonicalElement == nil) || r.PatternCanonicalElement != nil && !r.PatternCanonicalElement.EqualsDeep(*other.PatternCanonicalElement) {
+		return false
+	}
+	if (r.PatternCode == nil) != (other.PatternCode == nil) || r.PatternCode != nil && !equivalentString(*r.PatternCode, *other.PatternCode) {
+		return false
+	}
+	if (r.PatternCodeElement == nil) != (other.PatternCodeElement == nil) || r.PatternCodeElement != nil && !r.PatternCodeElement.EqualsDeep(*other.PatternCodeElement) {
+		return false
+	}
+	if (r.PatternDate == nil) != (other.PatternDate == nil) || r.PatternDate != nil && !equivalentString(*r.PatternDate, *other.PatternDate) {
+		return false
+	}
+	if (r.PatternDateElement == nil) != (other.PatternDateElement == nil) || r.PatternDateElement != nil && !r.PatternDateElement.EqualsDeep(*other.PatternDateElement) {
+		return false
+	}
+	if (r.PatternDateTime == nil) != (other.PatternDateTime == nil) || r.PatternDateTime != nil && !equivalentString(*r.PatternDateTime, *other.PatternDateTime) {
+		return false
+	}
+	if (r.PatternDateTimeElement == nil) != (other.PatternDateTimeElement == nil) || r.PatternDateTimeElement != nil && !r.PatternDateTimeElement.EqualsDeep(*other.PatternDateTimeElement) {
+		return false
+	}
+	if (r.PatternDecimal == nil) != (other.PatternDecimal == nil) || r.PatternDecimal != nil && !equivalentDecimal(*r.PatternDecimal, *other.PatternDecimal) {
+		return false
+	}
+	if (r.PatternDecimalElement == nil) != (other.PatternDecimalElement == nil) || r.PatternDecimalElement != nil && !r.PatternDecimalElement.EqualsDeep(*other.PatternDecimalElement) {
+		return false
+	}
+	if (r.PatternId == nil) != (other.PatternId == nil) || r.PatternId != nil && !equivalentString(*r.PatternId, *other.PatternId) {
+		return false
+	}
+	if (r.PatternIdElement == nil) != (other.PatternIdElement == nil) || r.PatternIdElement != nil && !r.PatternIdElement.EqualsDeep(*other.PatternIdElement) {
+		return false
+	}
+	if (r.PatternInstant == nil) != (other.PatternInstant == nil) || r.PatternInstant != nil && !equivalentString(*r.PatternInstant, *other.PatternInstant) {
+		return false
+	}
+	if (r.PatternInstantElement == nil) != (other.PatternInstantElement == nil) || r.PatternInstantElement != nil && !r.PatternInstantElement.EqualsDeep(*other.PatternInstantElement) {
+		return false
+	}
+	if (r.PatternInteger == nil) != (other.PatternInteger == nil) || r.PatternInteger != nil && *r.PatternInteger != *other.PatternInteger {
+		return false
+	}
+	if (r.PatternIntegerElement == nil) != (other.PatternIntegerElement == nil) || r.PatternIntegerElement != nil && !r.PatternIntegerElement.EqualsDeep(*other.PatternIntegerElement) {
+		return false
+	}
+	if (r.PatternMarkdown == nil) != (other.PatternMarkdown == nil) || r.PatternMarkdown != nil && !equivalentString(*r.PatternMarkdown, *other.PatternMarkdown) {
+		return false
+	}
+	if (r.PatternMarkdownElement == nil) != (other.PatternMarkdownElement == nil) || r.PatternMarkdownElement != nil && !r.PatternMarkdownElement.EqualsDeep(*other.PatternMarkdownElement) {
+		return false
+	}
+	if (r.PatternOid == nil) != (other.PatternOid == nil) || r.PatternOid != nil && !equivalentString(*r.PatternOid, *other.PatternOid) {
+		return false
+	}
+	if (r.PatternOidElement == nil) != (other.PatternOidElement == nil) || r.PatternOidElement != nil && !r.PatternOidElement.EqualsDeep(*other.PatternOidElement) {
+		return false
+	}
+	if (r.PatternPositiveInt == nil) != (other.PatternPositiveInt == nil) || r.PatternPositiveInt != nil && *r.PatternPositiveInt != *other.PatternPositiveInt {
+		return false
+	}
+	if (r.PatternPositiveIntElement == nil) != (other.PatternPositiveIntElement == nil) || r.PatternPositiveIntElement != nil && !r.PatternPositiveIntElement.EqualsDeep(*other.PatternPositiveIntElement) {
+		return false
+	}
+	if (r.PatternString == nil) != (other.PatternString == nil) || r.PatternString != nil && !equivalentString(*r.PatternString, *other.PatternString) {
+		return false
+	}
+	if (r.PatternStringElement == nil) != (other.PatternStringElement == nil) || r.PatternStringElement != nil && !r.PatternStringElement.EqualsDeep(*other.PatternStringElement) {
+		return false
+	}
+	if (r.PatternTime == nil) != (other.PatternTime == nil) || r.PatternTime != nil && !equivalentString(*r.PatternTime, *other.PatternTime) {
+		return false
+	}
+	if (r.PatternTimeElement == nil) != (other.PatternTimeElement == nil) || r.PatternTimeElement != nil && !r.PatternTimeElement.EqualsDeep(*other.PatternTimeElement) {
+		return false
+	}
+	if (r.PatternUnsignedInt == nil) != (other.PatternUnsignedInt == nil) || r.PatternUnsignedInt != nil && *r.PatternUnsignedInt != *other.PatternUnsignedInt {
+		return false
+	}
+	if (r.PatternUnsignedIntElement == nil) != (other.PatternUnsignedIntElement == nil) || r.PatternUnsignedIntElement != nil && !r.PatternUnsignedIntElement.EqualsDeep(*other.PatternUnsignedIntElement) {
+		return false
+	}
+	if (r.PatternUri == nil) != (other.PatternUri == nil) || r.PatternUri != nil && !equivalentString(*r.PatternUri, *other.PatternUri) {
+		return false
+	}
+	if (r.PatternUriElement == nil) != (other.PatternUriElement == nil) || r.PatternUriElement != nil && !r.PatternUriElement.EqualsDeep(*other.PatternUriElement) {
+		return false
+	}
+	if (r.PatternUrl == nil) != (other.PatternUrl == nil) || r.PatternUrl != nil && !equivalentString(*r.PatternUrl, *other.PatternUrl) {
+		return false
+	}
+	if (r.PatternUrlElement == nil) != (other.PatternUrlElement == nil) || r.PatternUrlElement != nil && !r.PatternUrlElement.EqualsDeep(*other.PatternUrlElement) {
+		return false
+	}
+	if (r.PatternUuid == nil) != (other.PatternUuid == nil) || r.PatternUuid != nil && !equivalentString(*r.PatternUuid, *other.PatternUuid) {
+		return false
+	}
+	if (r.PatternUuidElement == nil) != (other.PatternUuidElement == nil) || r.PatternUuidElement != nil && !r.PatternUuidElement.EqualsDeep(*other.PatternUuidElement) {
+		return false
+	}
+	if (r.PatternAddress == nil) != (other.PatternAddress == nil) || r.PatternAddress != nil && !r.PatternAddress.EqualsDeep(*other.PatternAddress) {
+		return false
+	}
+	if (r.PatternAge == nil) != (other.PatternAge == nil) || r.PatternAge != nil && !r.PatternAge.EqualsDeep(*other.PatternAge) {
+		return false
+	}
+	if (r.PatternAnnotation == nil) != (other.PatternAnnotation == nil) || r.PatternAnnotation != nil && !r.PatternAnnotation.EqualsDeep(*other.PatternAnnotation) {
+		return false
+	}
+	if (r.PatternAttachment == nil) != (other.PatternAttachment == nil) || r.PatternAttachment != nil && !r.PatternAttachment.EqualsDeep(*other.PatternAttachment) {
+		return false
+	}
+	if (r.PatternCodeableConcept == nil) != (other.PatternCodeableConcept == nil) || r.PatternCodeableConcept != nil && !r.PatternCodeableConcept.EqualsDeep(*other.PatternCodeableConcept) {
+		return false
+	}
+	if (r.PatternCoding == nil) != (other.PatternCoding == nil) || r.PatternCoding != nil && !r.PatternCoding.EqualsDeep(*other.PatternCoding) {
+		return false
+	}
+	if (r.PatternContactPoint == nil) != (other.PatternContactPoint == nil) || r.PatternContactPoint != nil && !r.PatternContactPoint.EqualsDeep(*other.PatternContactPoint) {
+		return false
+	}
+	if (r.PatternCount == nil) != (other.PatternCount == nil) || r.PatternCount != nil && !r.PatternCount.EqualsDeep(*other.PatternCount) {
+		return false
+	}
+	if (r.PatternDistance == nil) != (other.PatternDistance == nil) || r.PatternDistance != nil && !r.PatternDistance.EqualsDeep(*other.PatternDistance) {
+		return false
+	}
+	if (r.PatternDuration == nil) != (other.PatternDuration == nil) || r.PatternDuration != nil && !r.PatternDuration.EqualsDeep(*other.PatternDuration) {
+		return false
+	}
+	if (r.PatternHumanName == nil) != (other.PatternHumanName == nil) || r.PatternHumanName != nil && !r.PatternHumanName.EqualsDeep(*other.PatternHumanName) {
+		return false
+	}
+	if (r.PatternIdentifier == nil) != (other.PatternIdentifier == nil) || r.PatternIdentifier != nil && !r.PatternIdentifier.EqualsDeep(*other.PatternIdentifier) {
+		return false
+	}
+	if (r.PatternMoney == nil) != (other.PatternMoney == nil) || r.PatternMoney != nil && !r.PatternMoney.EqualsDeep(*other.PatternMoney) {
+		return false
+	}
+	if (r.PatternPeriod == nil) != (other.PatternPeriod == nil) || r.PatternPeriod != nil && !r.PatternPeriod.EqualsDeep(*other.PatternPeriod) {
+		return false
+	}
+	if (r.PatternQuantity == nil) != (other.PatternQuantity == nil) || r.PatternQuantity != nil && !r.PatternQuantity.EqualsDeep(*other.PatternQuantity) {
+		return false
+	}
+	if (r.PatternRange == nil) != (other.PatternRange == nil) || r.PatternRange != nil && !r.PatternRange.EqualsDeep(*other.PatternRange) {
+		return false
+	}
+	if (r.PatternRatio == nil) != (other.PatternRatio == nil) || r.PatternRatio != nil && !r.PatternRatio.EqualsDeep(*other.PatternRatio) {
+		return false
+	}
+	if (r.PatternReference == nil) != (other.PatternReference == nil) || r.PatternReference != nil && !r.PatternReference.EqualsDeep(*other.PatternReference) {
+		return false
+	}
+	if (r.PatternSampledData == nil) != (other.PatternSampledData == nil) || r.PatternSampledData != nil && !r.PatternSampledData.EqualsDeep(*other.PatternSampledData) {
+		return false
+	}
+	if (r.PatternSignature == nil) != (other.PatternSignature == nil) || r.PatternSignature != nil && !r.PatternSignature.EqualsDeep(*other.PatternSignature) {
+		return false
+	}
+	if (r.PatternTiming == nil) != (other.PatternTiming == nil) || r.PatternTiming != nil && !r.PatternTiming.EqualsDeep(*other.PatternTiming) {
+		return false
+	}
+	if (r.PatternContactDetail == nil) != (other.PatternContactDetail == nil) || r.PatternContactDetail != nil && !r.PatternContactDetail.EqualsDeep(*other.PatternContactDetail) {
+		return false
+	}
+	if (r.PatternContributor == nil) != (other.PatternContributor == nil) || r.PatternContributor != nil && !r.PatternContributor.EqualsDeep(*other.PatternContributor) {
+		return false
+	}
+	if (r.PatternDataRequirement == nil) != (other.PatternDataRequirement == nil) || r.PatternDataRequirement != nil && !r.PatternDataRequirement.EqualsDeep(*other.PatternDataRequirement) {
+		return false
+	}
+	if (r.PatternExpression == nil) != (other.PatternExpression == nil) || r.PatternExpression != nil && !r.PatternExpression.EqualsDeep(*other.PatternExpression) {
+		return false
+	}
+	if (r.PatternParameterDefinition == nil) != (other.PatternParameterDefinition == nil) || r.PatternParameterDefinition != nil && !r.PatternParameterDefinition.EqualsDeep(*other.PatternParameterDefinition) {
+		return false
+	}
+	if (r.PatternRelatedArtifact == nil) != (other.PatternRelatedArtifact == nil) || r.PatternRelatedArtifact != nil && !r.PatternRelatedArtifact.EqualsDeep(*other.PatternRelatedArtifact) {
+		return false
+	}
+	if (r.PatternTriggerDefinition == nil) != (other.PatternTriggerDefinition == nil) || r.PatternTriggerDefinition != nil && !r.PatternTriggerDefinition.EqualsDeep(*other.PatternTriggerDefinition) {
+		return false
+	}
+	if (r.PatternUsageContext == nil) != (other.PatternUsageContext == nil) || r.PatternUsageContext != nil && !r.PatternUsageContext.EqualsDeep(*other.PatternUsageContext) {
+		return false
+	}
+	if (r.PatternDosage == nil) != (other.PatternDosage == nil) || r.PatternDosage != nil && !r.PatternDosage.EqualsDeep(*other.PatternDosage) {
+		return false
+	}
+	if (r.PatternMeta == nil) != (other.PatternMeta == nil) || r.PatternMeta != nil && !r.PatternMeta.EqualsDeep(*other.PatternMeta) {
+		return false
+	}
+	if !equivalentList(len(r.Example), len(other.Example), func(i, j int) bool {
+		return r.Example[i].EqualsDeep(other.Example[j])
+	}) {
+		return false
+	}
+	if (r.MinValueDate == nil) != (other.MinValueDate == nil) || r.MinValueDate != nil && !equivalentString(*r.MinValueDate, *other.MinValueDate) {
+		return false
+	}
+	if (r.MinValueDateElement == nil) != (other.MinValueDateElement == nil) || r.MinValueDateElement != nil && !r.MinValueDateElement.EqualsDeep(*other.MinValueDateElement) {
+		return false
+	}
+	if (r.MinValueDateTime == nil) != (other.MinValueDateTime == nil) || r.MinValueDateTime != nil && !equivalentString(*r.MinValueDateTime, *other.MinValueDateTime) {
+		return false
+	}
+	if (r.MinValueDateTimeElement == nil) != (other.MinValueDateTimeElement == nil) || r.MinValueDateTimeElement != nil && !r.MinValueDateTimeElement.EqualsDeep(*other.MinValueDateTimeElement) {
+		return false
+	}
+	if (r.MinValueInstant == nil) != (other.MinValueInstant == nil) || r.MinValueInstant != nil && !equivalentString(*r.MinValueInstant, *other.MinValueInstant) {
+		return false
+	}
+	if (r.MinValueInstantElement == nil) != (other.MinValueInstantElement == nil) || r.MinValueInstantElement != nil && !r.MinValueInstantElement.EqualsDeep(*other.MinValueInstantElement) {
+		return false
+	}
+	if (r.MinValueTime == nil) != (other.MinValueTime == nil) || r.MinValueTime != nil && !equivalentString(*r.MinValueTime, *other.MinValueTime) {
+		return false
+	}
+	if (r.MinValueTimeElement == nil) != (other.MinValueTimeElement == nil) || r.MinValueTimeElement != nil && !r.MinValueTimeElement.EqualsDeep(*other.MinValueTimeElement) {
+		return false
+	}
+	if (r.MinValueDecimal == nil) != (other.MinValueDecimal == nil) || r.MinValueDecimal != nil && !equivalentDecimal(*r.MinValueDecimal, *other.MinValueDecimal) {
+		return false
+	}
+	if (r.MinValueDecimalElement == nil) != (other.MinValueDecimalElement == nil) || r.MinValueDecimalElement != nil && !r.MinValueDecimalElement.EqualsDeep(*other.MinValueDecimalElement) {
+		return false
+	}
+	if (r.MinValueInteger == nil) != (other.MinValueInteger == nil) || r.MinValueInteger != nil && *r.MinValueInteger != *other.MinValueInteger {
+		return false
+	}
+	if (r.MinValueIntegerElement == nil) != (other.MinValueIntegerElement == nil) || r.MinValueIntegerElement != nil && !r.MinValueIntegerElement.EqualsDeep(*other.MinValueIntegerElement) {
+		return false
+	}
+	if (r.MinValuePositiveInt == nil) != (other.MinValuePositiveInt == nil) || r.MinValuePositiveInt != nil && *r.MinValuePositiveInt != *other.MinValuePositiveInt {
+		return false
+	}
+	if (r.MinValuePositiveIntElement == nil) != (other.MinValuePositiveIntElement == nil) || r.MinValuePositiveIntElement != nil && !r.MinValuePositiveIntElement.EqualsDeep(*other.MinValuePositiveIntElement) {
+		return false
+	}
+	if (r.MinValueUnsignedInt == nil) != (other.MinValueUnsignedInt == nil) || r.MinValueUnsignedInt != nil && *r.MinValueUnsignedInt != *other.MinValueUnsignedInt {
+		return false
+	}
+	if (r.MinValueUnsignedIntElement == nil) != (other.MinValueUnsignedIntElement == nil) || r.MinValueUnsignedIntElement != nil && !r.MinValueUnsignedIntElement.EqualsDeep(*other.MinValueUnsignedIntElement) {
+		return false
+	}
+	if (r.MinValueQuantity == nil) != (other.MinValueQuantity == nil) || r.MinValueQuantity != nil && !r.MinValueQuantity.EqualsDeep(*other.MinValueQuantity) {
+		return false
+	}
+	if (r.MaxValueDate == nil) != (other.MaxValueDate == nil) || r.MaxValueDate != nil && !equivalentString(*r.MaxValueDate, *other.MaxValueDate) {
+		return false
+	}
+	if (r.MaxValueDateElement == nil) != (other.MaxValueDateElement == nil) || r.MaxValueDateElement != nil && !r.MaxValueDateElement.EqualsDeep(*other.MaxValueDateElement) {
+		return false
+	}
+	if (r.MaxValueDateTime == nil) != (other.MaxValueDateTime == nil) || r.MaxValueDateTime != nil && !equivalentString(*r.MaxValueDateTime, *other.MaxValueDateTime) {
+		return false
+	}
+	if (r.MaxValueDateTimeElement == nil) != (other.MaxValueDateTimeElement == nil) || r.MaxValueDateTimeElement != nil && !r.MaxValueDateTimeElement.EqualsDeep(*other.MaxValueDateTimeElement) {
+		return false
+	}
+	if (r.MaxValueInstant == nil) != (other.MaxValueInstant == nil) || r.MaxValueInstant != nil && !equivalentString(*r.MaxValueInstant, *other.MaxValueInstant) {
+		return false
+	}
+	if (r.MaxValueInstantElement == nil) != (other.MaxValueInstantElement == nil) || r.MaxValueInstantElement != nil && !r.MaxValueInstantElement.EqualsDeep(*other.MaxValueInstantElement) {
+		return false
+	}
+	if (r.MaxValueTime == nil) != (other.MaxValueTime == nil) || r.MaxValueTime != nil && !equivalentString(*r.MaxValueTime, *other.MaxValueTime) {
+		return false
+	}
+	if (r.MaxValueTimeElement == nil) != (other.MaxValueTimeElement == nil) || r.MaxValueTimeElement != nil && !r.MaxValueTimeElement.EqualsDeep(*other.MaxValueTimeElement) {
+		return false
+	}
+	if (r.MaxValueDecimal == nil) != (other.MaxValueDecimal == nil) || r.MaxValueDecimal != nil && !equivalentDecimal(*r.MaxValueDecimal, *other.MaxValueDecimal) {
+		return false
+	}
+	if (r.MaxValueDecimalElement == nil) != (other.MaxValueDecimalElement == nil) || r.MaxValueDecimalElement != nil && !r.MaxValueDecimalElement.EqualsDeep(*other.MaxValueDecimalElement) {
+		return false
+	}
+	if (r.MaxValueInteger == nil) != (other.MaxValueInteger == nil) || r.MaxValueInteger != nil && *r.MaxValueInteger != *other.MaxValueInteger {
+		return false
+	}
+	if (r.MaxValueIntegerElement == nil) != (other.MaxValueIntegerElement == nil) || r.MaxValueIntegerElement != nil && !r.MaxValueIntegerElement.EqualsDeep(*other.MaxValueIntegerElement) {
+		return false
+	}
+	if (r.MaxValuePositiveInt == nil) != (other.MaxValuePositiveInt == nil) || r.MaxValuePositiveInt != nil && *r.MaxValuePositiveInt != *other.MaxValuePositiveInt {
+		return false
+	}
+	if (r.MaxValuePositiveIntElement == nil) != (other.MaxValuePositiveIntElement == nil) || r.MaxValuePositiveIntElement != nil && !r.MaxValuePositiveIntElement.EqualsDeep(*other.MaxValuePositiveIntElement) {
+		return false
+	}
+	if (r.MaxValueUnsignedInt == nil) != (other.MaxValueUnsignedInt == nil) || r.MaxValueUnsignedInt != nil && *r.MaxValueUnsignedInt != *other.MaxValueUnsignedInt {
+		return false
+	}
+	if (r.MaxValueUnsignedIntElement == nil) != (other.MaxValueUnsignedIntElement == nil) || r.MaxValueUnsignedIntElement != nil && !r.MaxValueUnsignedIntElement.EqualsDeep(*other.MaxValueUnsignedIntElement) {
+		return false
+	}
+	if (r.MaxValueQuantity == nil) != (other.MaxValueQuantity == nil) || r.MaxValueQuantity != nil && !r.MaxValueQuantity.EqualsDeep(*other.MaxValueQuantity) {
+		return false
+	}
+	if (r.MaxLength == nil) != (other.MaxLength == nil) || r.MaxLength != nil && *r.MaxLength != *other.MaxLength {
+		return false
+	}
+	if (r.MaxLengthElement == nil) != (other.MaxLengthElement == nil) || r.MaxLengthElement != nil && !r.MaxLengthElement.EqualsDeep(*other.MaxLengthElement) {
+		return false
+	}
+	if !equivalentList(len(r.Condition), len(other.Condition), func(i, j int) bool {
+		return equivalentString(r.Condition[i], other.Condition[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ConditionElement, other.ConditionElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Constraint), len(other.Constraint), func(i, j int) bool {
+		return r.Constraint[i].EqualsDeep(other.Constraint[j])
+	}) {
+		return false
+	}
+	if (r.MustSupport == nil) != (other.MustSupport == nil) || r.MustSupport != nil && *r.MustSupport != *other.MustSupport {
+		return false
+	}
+	if (r.MustSupportElement == nil) != (other.MustSupportElement == nil) || r.MustSupportElement != nil && !r.MustSupportElement.EqualsDeep(*other.MustSupportElement) {
+		return false
+	}
+	if (r.IsModifier == nil) != (other.IsModifier == nil) || r.IsModifier != nil && *r.IsModifier != *other.IsModifier {
+		return false
+	}
+	if (r.IsModifierElement == nil) != (other.IsModifierElement == nil) || r.IsModifierElement != nil && !r.IsModifierElement.EqualsDeep(*other.IsModifierElement) {
+		return false
+	}
+	if (r.IsModifierReason == nil) != (other.IsModifierReason == nil) || r.IsModifierReason != nil && !equivalentString(*r.IsModifierReason, *other.IsModifierReason) {
+		return false
+	}
+	if (r.IsModifierReasonElement == nil) != (other.IsModifierReasonElement == nil) || r.IsModifierReasonElement != nil && !r.IsModifierReasonElement.EqualsDeep(*other.IsModifierReasonElement) {
+		return false
+	}
+	if (r.IsSummary == nil) != (other.IsSummary == nil) || r.IsSummary != nil && *r.IsSummary != *other.IsSummary {
+		return false
+	}
+	if (r.IsSummaryElement == nil) != (other.IsSummaryElement == nil) || r.IsSummaryElement != nil && !r.IsSummaryElement.EqualsDeep(*other.IsSummaryElement) {
+		return false
+	}
+	if (r.Binding == nil) != (other.Binding == nil) || r.Binding != nil && !r.Binding.EqualsDeep(*other.Binding) {
+		return false
+	}
+	if !equivalentList(len(r.Mapping), len(other.Mapping), func(i, j int) bool {
+		return r.Mapping[i].EqualsDeep(other.Mapping[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ElementDefinition) EqualsShallow(other ElementDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	if !equivalentList(len(r.Representation), len(other.Representation), func(i, j int) bool {
+		return r.Representation[i] == other.Representation[j]
+	}) {
+		return false
+	}
+	if (r.SliceName == nil) != (other.SliceName == nil) || r.SliceName != nil && !equivalentString(*r.SliceName, *other.SliceName) {
+		return false
+	}
+	if (r.SliceIsConstraining == nil) != (other.SliceIsConstraining == nil) || r.SliceIsConstraining != nil && *r.SliceIsConstraining != *other.SliceIsConstraining {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && !equivalentString(*r.Label, *other.Label) {
+		return false
+	}
+	if (r.Short == nil) != (other.Short == nil) || r.Short != nil && !equivalentString(*r.Short, *other.Short) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.Requirements == nil) != (other.Requirements == nil) || r.Requirements != nil && !equivalentString(*r.Requirements, *other.Requirements) {
+		return false
+	}
+	if !equivalentList(len(r.Alias), len(other.Alias), func(i, j int) bool {
+		return equivalentString(r.Alias[i], other.Alias[j])
+	}) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	if (r.ContentReference == nil) != (other.ContentReference == nil) || r.ContentReference != nil && !equivalentString(*r.ContentReference, *other.ContentReference) {
+		return false
+	}
+	if (r.DefaultValueBase64Binary == nil) != (other.DefaultValueBase64Binary == nil) || r.DefaultValueBase64Binary != nil && !equivalentString(*r.DefaultValueBase64Binary, *other.DefaultValueBase64Binary) {
+		return false
+	}
+	if (r.DefaultValueBoolean == nil) != (other.DefaultValueBoolean == nil) || r.DefaultValueBoolean != nil && *r.DefaultValueBoolean != *other.DefaultValueBoolean {
+		return false
+	}
+	if (r.DefaultValueCanonical == nil) != (other.DefaultValueCanonical == nil) || r.DefaultValueCanonical != nil && !equivalentString(*r.DefaultValueCanonical, *other.DefaultValueCanonical) {
+		return false
+	}
+	if (r.DefaultValueCode == nil) != (other.DefaultValueCode == nil) || r.DefaultValueCode != nil && !equivalentString(*r.DefaultValueCode, *other.DefaultValueCode) {
+		return false
+	}
+	if (r.DefaultValueDate == nil) != (other.DefaultValueDate == nil) || r.DefaultValueDate != nil && !equivalentString(*r.DefaultValueDate, *other.DefaultValueDate) {
+		return false
+	}
+	if (r.DefaultValueDateTime == nil) != (other.DefaultValueDateTime == nil) || r.DefaultValueDateTime != nil && !equivalentString(*r.DefaultValueDateTime, *other.DefaultValueDateTime) {
+		return false
+	}
+	if (r.DefaultValueDecimal == nil) != (other.DefaultValueDecimal == nil) || r.DefaultValueDecimal != nil && !equivalentDecimal(*r.DefaultValueDecimal, *other.DefaultValueDecimal) {
+		return false
+	}
+	if (r.DefaultValueId == nil) != (other.DefaultValueId == nil) || r.DefaultValueId != nil && !equivalentString(*r.DefaultValueId, *other.DefaultValueId) {
+		return false
+	}
+	if (r.DefaultValueInstant == nil) != (other.DefaultValueInstant == nil) || r.DefaultValueInstant != nil && !equivalentString(*r.DefaultValueInstant, *other.DefaultValueInstant) {
+		return false
+	}
+	if (r.DefaultValueInteger == nil) != (other.DefaultValueInteger == nil) || r.DefaultValueInteger != nil && *r.DefaultValueInteger != *other.DefaultValueInteger {
+		return false
+	}
+	if (r.DefaultValueMarkdown == nil) != (other.DefaultValueMarkdown == nil) || r.DefaultValueMarkdown != nil && !equivalentString(*r.DefaultValueMarkdown, *other.DefaultValueMarkdown) {
+		return false
+	}
+	if (r.DefaultValueOid == nil) != (other.DefaultValueOid == nil) || r.DefaultValueOid != nil && !equivalentString(*r.DefaultValueOid, *other.DefaultValueOid) {
+		return false
+	}
+	if (r.DefaultValuePositiveInt == nil) != (other.DefaultValuePositiveInt == nil) || r.DefaultValuePositiveInt != nil && *r.DefaultValuePositiveInt != *other.DefaultValuePositiveInt {
+		return false
+	}
+	if (r.DefaultValueString == nil) != (other.DefaultValueString == nil) || r.DefaultValueString != nil && !equivalentString(*r.DefaultValueString, *other.DefaultValueString) {
+		return false
+	}
+	if (r.DefaultValueTime == nil) != (other.DefaultValueTime == nil) || r.DefaultValueTime != nil && !equivalentString(*r.DefaultValueTime, *other.DefaultValueTime) {
+		return false
+	}
+	if (r.DefaultValueUnsignedInt == nil) != (other.DefaultValueUnsignedInt == nil) || r.DefaultValueUnsignedInt != nil && *r.DefaultValueUnsignedInt != *other.DefaultValueUnsignedInt {
+		return false
+	}
+	if (r.DefaultValueUri == nil) != (other.DefaultValueUri == nil) || r.DefaultValueUri != nil && !equivalentString(*r.DefaultValueUri, *other.DefaultValueUri) {
+		return false
+	}
+	if (r.DefaultValueUrl == nil) != (other.DefaultValueUrl == nil) || r.DefaultValueUrl != nil && !equivalentString(*r.DefaultValueUrl, *other.DefaultValueUrl) {
+		return false
+	}
+	if (r.DefaultValueUuid == nil) != (other.DefaultValueUuid == nil) || r.DefaultValueUuid != nil && !equivalentString(*r.DefaultValueUuid, *other.DefaultValueUuid) {
+		return false
+	}
+	if (r.MeaningWhenMissing == nil) != (other.MeaningWhenMissing == nil) || r.MeaningWhenMissing != nil && !equivalentString(*r.MeaningWhenMissing, *other.MeaningWhenMissing) {
+		return false
+	}
+	if (r.OrderMeaning == nil) != (other.OrderMeaning == nil) || r.OrderMeaning != nil && !equivalentString(*r.OrderMeaning, *other.OrderMeaning) {
+		return false
+	}
+	if (r.FixedBase64Binary == nil) != (other.FixedBase64Binary == nil) || r.FixedBase64Binary != nil && !equivalentString(*r.FixedBase64Binary, *other.FixedBase64Binary) {
+		return false
+	}
+	if (r.FixedBoolean == nil) != (other.FixedBoolean == nil) || r.FixedBoolean != nil && *r.FixedBoolean != *other.FixedBoolean {
+		return false
+	}
+	if (r.FixedCanonical == nil) != (other.FixedCanonical == nil) || r.FixedCanonical != nil && !equivalentString(*r.FixedCanonical, *other.FixedCanonical) {
+		return false
+	}
+	if (r.FixedCode == nil) != (other.FixedCode == nil) || r.FixedCode != nil && !equivalentString(*r.FixedCode, *other.FixedCode) {
+		return false
+	}
+	if (r.FixedDate == nil) != (other.FixedDate == nil) || r.FixedDate != nil && !equivalentString(*r.FixedDate, *other.FixedDate) {
+		return false
+	}
+	if (r.FixedDateTime == nil) != (other.FixedDateTime == nil) || r.FixedDateTime != nil && !equivalentString(*r.FixedDateTime, *other.FixedDateTime) {
+		return false
+	}
+	if (r.FixedDecimal == nil) != (other.FixedDecimal == nil) || r.FixedDecimal != nil && !equivalentDecimal(*r.FixedDecimal, *other.FixedDecimal) {
+		return false
+	}
+	if (r.FixedId == nil) != (other.FixedId == nil) || r.FixedId != nil && !equivalentString(*r.FixedId, *other.FixedId) {
+		return false
+	}
+	if (r.FixedInstant == nil) != (other.FixedInstant == nil) || r.FixedInstant != nil && !equivalentString(*r.FixedInstant, *other.FixedInstant) {
+		return false
+	}
+	if (r.FixedInteger == nil) != (other.FixedInteger == nil) || r.FixedInteger != nil && *r.FixedInteger != *other.FixedInteger {
+		return false
+	}
+	if (r.FixedMarkdown == nil) != (other.FixedMarkdown == nil) || r.FixedMarkdown != nil && !equivalentString(*r.FixedMarkdown, *other.FixedMarkdown) {
+		return false
+	}
+	if (r.FixedOid == nil) != (other.FixedOid == nil) || r.FixedOid != nil && !equivalentString(*r.FixedOid, *other.FixedOid) {
+		return false
+	}
+	if (r.FixedPositiveInt == nil) != (other.FixedPositiveInt == nil) || r.FixedPositiveInt != nil && *r.FixedPositiveInt != *other.FixedPositiveInt {
+		return false
+	}
+	if (r.FixedString == nil) != (other.FixedString == nil) || r.FixedString != nil && !equivalentString(*r.FixedString, *other.FixedString) {
+		return false
+	}
+	if (r.FixedTime == nil) != (other.FixedTime == nil) || r.FixedTime != nil && !equivalentString(*r.FixedTime, *other.FixedTime) {
+		return false
+	}
+	if (r.FixedUnsignedInt == nil) != (other.FixedUnsignedInt == nil) || r.FixedUnsignedInt != nil && *r.FixedUnsignedInt != *other.FixedUnsignedInt {
+		return false
+	}
+	if (r.FixedUri == nil) != (other.FixedUri == nil) || r.FixedUri != nil && !equivalentString(*r.FixedUri, *other.FixedUri) {
+		return false
+	}
+	if (r.FixedUrl == nil) != (other.FixedUrl == nil) || r.FixedUrl != nil && !equivalentString(*r.FixedUrl, *other.FixedUrl) {
+		return false
+	}
+	if (r.FixedUuid == nil) != (other.FixedUuid == nil) || r.FixedUuid != nil && !equivalentString(*r.FixedUuid, *other.FixedUuid) {
+		return false
+	}
+	if (r.PatternBase64Binary == nil) != (other.PatternBase64Binary == nil) || r.PatternBase64Binary != nil && !equivalentString(*r.PatternBase64Binary, *other.PatternBase64Binary) {
+		return false
+	}
+	if (r.PatternBoolean == nil) != (other.PatternBoolean == nil) || r.PatternBoolean != nil && *r.PatternBoolean != *other.PatternBoolean {
+		return false
+	}
+	if (r.PatternCanonical == nil) != (other.PatternCanonical == nil) || r.PatternCanonical != nil && !equivalentString(*r.PatternCanonical, *other.PatternCanonical) {
+		return false
+	}
+	if (r.PatternCode == nil) != (other.PatternCode == nil) || r.PatternCode != nil && !equivalentString(*r.PatternCode, *other.PatternCode) {
+		return false
+	}
+	if (r.PatternDate == nil) != (other.PatternDate == nil) || r.PatternDate != nil && !equivalentString(*r.PatternDate, *other.PatternDate) {
+		return false
+	}
+	if (r.PatternDateTime == nil) != (other.PatternDateTime == nil) || r.PatternDateTime != nil && !equivalentString(*r.PatternDateTime, *other.PatternDateTime) {
+		return false
+	}
+	if (r.PatternDecimal == nil) != (other.PatternDecimal == nil) || r.PatternDecimal != nil && !equivalentDecimal(*r.PatternDecimal, *other.PatternDecimal) {
+		return false
+	}
+	if (r.PatternId == nil) != (other.PatternId == nil) || r.PatternId != nil && !equivalentString(*r.PatternId, *other.PatternId) {
+		return false
+	}
+	if (r.PatternInstant == nil) != (other.PatternInstant == nil) || r.PatternInstant != nil && !equivalentString(*r.PatternInstant, *other.PatternInstant) {
+		return false
+	}
+	if (r.PatternInteger == nil) != (other.PatternInteger == nil) || r.PatternInteger != nil && *r.PatternInteger != *other.PatternInteger {
+		return false
+	}
+	if (r.PatternMarkdown == nil) != (other.PatternMarkdown == nil) || r.PatternMarkdown != nil && !equivalentString(*r.PatternMarkdown, *other.PatternMarkdown) {
+		return false
+	}
+	if (r.PatternOid == nil) != (other.PatternOid == nil) || r.PatternOid != nil && !equivalentString(*r.PatternOid, *other.PatternOid) {
+		return false
+	}
+	if (r.PatternPositiveInt == nil) != (other.PatternPositiveInt == nil) || r.PatternPositiveInt != nil && *r.PatternPositiveInt != *other.PatternPositiveInt {
+		return false
+	}
+	if (r.PatternString == nil) != (other.PatternString == nil) || r.PatternString != nil && !equivalentString(*r.PatternString, *other.PatternString) {
+		return false
+	}
+	if (r.PatternTime == nil) != (other.PatternTime == nil) || r.PatternTime != nil && !equivalentString(*r.PatternTime, *other.PatternTime) {
+		return false
+	}
+	if (r.PatternUnsignedInt == nil) != (other.PatternUnsignedInt == nil) || r.PatternUnsignedInt != nil && *r.PatternUnsignedInt != *other.PatternUnsignedInt {
+		return false
+	}
+	if (r.PatternUri == nil) != (other.PatternUri == nil) || r.PatternUri != nil && !equivalentString(*r.PatternUri, *other.PatternUri) {
+		return false
+	}
+	if (r.PatternUrl == nil) != (other.PatternUrl == nil) || r.PatternUrl != nil && !equivalentString(*r.PatternUrl, *other.PatternUrl) {
+		return false
+	}
+	if (r.PatternUuid == nil) != (other.PatternUuid == nil) || r.PatternUuid != nil && !equivalentString(*r.PatternUuid, *other.PatternUuid) {
+		return false
+	}
+	if (r.MinValueDate == nil) != (other.MinValueDate == nil) || r.MinValueDate != nil && !equivalentString(*r.MinValueDate, *other.MinValueDate) {
+		return false
+	}
+	if (r.MinValueDateTime == nil) != (other.MinValueDateTime == nil) || r.MinValueDateTime != nil && !equivalentString(*r.MinValueDateTime, *other.MinValueDateTime) {
+		return false
+	}
+	if (r.MinValueInstant == nil) != (other.MinValueInstant == nil) || r.MinValueInstant != nil && !equivalentString(*r.MinValueInstant, *other.MinValueInstant) {
+		return false
+	}
+	if (r.MinValueTime == nil) != (other.MinValueTime == nil) || r.MinValueTime != nil && !equivalentString(*r.MinValueTime, *other.MinValueTime) {
+		return false
+	}
+	if (r.MinValueDecimal == nil) != (other.MinValueDecimal == nil) || r.MinValueDecimal != nil && !equivalentDecimal(*r.MinValueDecimal, *other.MinValueDecimal) {
+		return false
+	}
+	if (r.MinValueInteger == nil) != (other.MinValueInteger == nil) || r.MinValueInteger != nil && *r.MinValueInteger != *other.MinValueInteger {
+		return false
+	}
+	if (r.MinValuePositiveInt == nil) != (other.MinValuePositiveInt == nil) || r.MinValuePositiveInt != nil && *r.MinValuePositiveInt != *other.MinValuePositiveInt {
+		return false
+	}
+	if (r.MinValueUnsignedInt == nil) != (other.MinValueUnsignedInt == nil) || r.MinValueUnsignedInt != nil && *r.MinValueUnsignedInt != *other.MinValueUnsignedInt {
+		return false
+	}
+	if (r.MaxValueDate == nil) != (other.MaxValueDate == nil) || r.MaxValueDate != nil && !equivalentString(*r.MaxValueDate, *other.MaxValueDate) {
+		return false
+	}
+	if (r.MaxValueDateTime == nil) != (other.MaxValueDateTime == nil) || r.MaxValueDateTime != nil && !equivalentString(*r.MaxValueDateTime, *other.MaxValueDateTime) {
+		return false
+	}
+	if (r.MaxValueInstant == nil) != (other.MaxValueInstant == nil) || r.MaxValueInstant != nil && !equivalentString(*r.MaxValueInstant, *other.MaxValueInstant) {
+		return false
+	}
+	if (r.MaxValueTime == nil) != (other.MaxValueTime == nil) || r.MaxValueTime != nil && !equivalentString(*r.MaxValueTime, *other.MaxValueTime) {
+		return false
+	}
+	if (r.MaxValueDecimal == nil) != (other.MaxValueDecimal == nil) || r.MaxValueDecimal != nil && !equivalentDecimal(*r.MaxValueDecimal, *other.MaxValueDecimal) {
+		return false
+	}
+	if (r.MaxValueInteger == nil) != (other.MaxValueInteger == nil) || r.MaxValueInteger != nil && *r.MaxValueInteger != *other.MaxValueInteger {
+		return false
+	}
+	if (r.MaxValuePositiveInt == nil) != (other.MaxValuePositiveInt == nil) || r.MaxValuePositiveInt != nil && *r.MaxValuePositiveInt != *other.MaxValuePositiveInt {
+		return false
+	}
+	if (r.MaxValueUnsignedInt == nil) != (other.MaxValueUnsignedInt == nil) || r.MaxValueUnsignedInt != nil && *r.MaxValueUnsignedInt != *other.MaxValueUnsignedInt {
+		return false
+	}
+	if (r.MaxLength == nil) != (other.MaxLength == nil) || r.MaxLength != nil && *r.MaxLength != *other.MaxLength {
+		return false
+	}
+	if !equivalentList(len(r.Condition), len(other.Condition), func(i, j int) bool {
+		return equivalentString(r.Condition[i], other.Condition[j])
+	}) {
+		return false
+	}
+	if (r.MustSupport == nil) != (other.MustSupport == nil) || r.MustSupport != nil && *r.MustSupport != *other.MustSupport {
+		return false
+	}
+	if (r.IsModifier == nil) != (other.IsModifier == nil) || r.IsModifier != nil && *r.IsModifier != *other.IsModifier {
+		return false
+	}
+	if (r.IsModifierReason == nil) != (other.IsModifierReason == nil) || r.IsModifierReason != nil && !equivalentString(*r.IsModifierReason, *other.IsModifierReason) {
+		return false
+	}
+	if (r.IsSummary == nil) != (other.IsSummary == nil) || r.IsSummary != nil && *r.IsSummary != *other.IsSummary {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ElementDefinition as FHIR XML
+func (r ElementDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	for i, v := range r.Representation {
+		if err := encodeXMLPrimitive(e, "representation", v, elementAt(r.RepresentationElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sliceName", r.SliceName, r.SliceNameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sliceIsConstraining", r.SliceIsConstraining, r.SliceIsConstrainingElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "label", r.Label, r.LabelElement); err != nil {
+		return err
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "slicing", r.Slicing); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "short", r.Short, r.ShortElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "requirements", r.Requirements, r.RequirementsElement); err != nil {
+		return err
+	}
+	for i, v := range r.Alias {
+		if err := encodeXMLPrimitive(e, "alias", v, elementAt(r.AliasElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "min", r.Min, r.MinElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "max", r.Max, r.MaxElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "base", r.Base); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "contentReference", r.ContentReference, r.ContentReferenceElement); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueBase64Binary", r.DefaultValueBase64Binary, r.DefaultValueBase64BinaryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueBoolean", r.DefaultValueBoolean, r.DefaultValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueCanonical", r.DefaultValueCanonical, r.DefaultValueCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueCode", r.DefaultValueCode, r.DefaultValueCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueDate", r.DefaultValueDate, r.DefaultValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueDateTime", r.DefaultValueDateTime, r.DefaultValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueDecimal", r.DefaultValueDecimal, r.DefaultValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueId", r.DefaultValueId, r.DefaultValueIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueInstant", r.DefaultValueInstant, r.DefaultValueInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueInteger", r.DefaultValueInteger, r.DefaultValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueMarkdown", r.DefaultValueMarkdown, r.DefaultValueMarkdownElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueOid", r.DefaultValueOid, r.DefaultValueOidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValuePositiveInt", r.DefaultValuePositiveInt, r.DefaultValuePositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueString", r.DefaultValueString, r.DefaultValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueTime", r.DefaultValueTime, r.DefaultValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueUnsignedInt", r.DefaultValueUnsignedInt, r.DefaultValueUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueUri", r.DefaultValueUri, r.DefaultValueUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueUrl", r.DefaultValueUrl, r.DefaultValueUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueUuid", r.DefaultValueUuid, r.DefaultValueUuidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueAddress", r.DefaultValueAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueAge", r.DefaultValueAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueAnnotation", r.DefaultValueAnnotation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueAttachment", r.DefaultValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueCodeableConcept", r.DefaultValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueCoding", r.DefaultValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueContactPoint", r.DefaultValueContactPoint); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueCount", r.DefaultValueCount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueDistance", r.DefaultValueDistance); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueDuration", r.DefaultValueDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueHumanName", r.DefaultValueHumanName); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueIdentifier", r.DefaultValueIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueMoney", r.DefaultValueMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValuePeriod", r.DefaultValuePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueQuantity", r.DefaultValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueRange", r.DefaultValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueRatio", r.DefaultValueRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueReference", r.DefaultValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueSampledData", r.DefaultValueSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueSignature", r.DefaultValueSignature); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueTiming", r.DefaultValueTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueContactDetail", r.DefaultValueContactDetail); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueContributor", r.DefaultValueContributor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueDataRequirement", r.DefaultValueDataRequirement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueExpression", r.DefaultValueExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueParameterDefinition", r.DefaultValueParameterDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueRelatedArtifact", r.DefaultValueRelatedArtifact); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueTriggerDefinition", r.DefaultValueTriggerDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueUsageContext", r.DefaultValueUsageContext); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueDosage", r.DefaultValueDosage); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueMeta", r.DefaultValueMeta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "meaningWhenMissing", r.MeaningWhenMissing, r.MeaningWhenMissingElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "orderMeaning", r.OrderMeaning, r.OrderMeaningElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedBase64Binary", r.FixedBase64Binary, r.FixedBase64BinaryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedBoolean", r.FixedBoolean, r.FixedBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedCanonical", r.FixedCanonical, r.FixedCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedCode", r.FixedCode, r.FixedCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedDate", r.FixedDate, r.FixedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedDateTime", r.FixedDateTime, r.FixedDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedDecimal", r.FixedDecimal, r.FixedDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedId", r.FixedId, r.FixedIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedInstant", r.FixedInstant, r.FixedInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedInteger", r.FixedInteger, r.FixedIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedMarkdown", r.FixedMarkdown, r.FixedMarkdownElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedOid", r.FixedOid, r.FixedOidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedPositiveInt", r.FixedPositiveInt, r.FixedPositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedString", r.FixedString, r.FixedStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedTime", r.FixedTime, r.FixedTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedUnsignedInt", r.FixedUnsignedInt, r.FixedUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedUri", r.FixedUri, r.FixedUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedUrl", r.FixedUrl, r.FixedUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fixedUuid", r.FixedUuid, r.FixedUuidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedAddress", r.FixedAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedAge", r.FixedAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedAnnotation", r.FixedAnnotation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedAttachment", r.FixedAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedCodeableConcept", r.FixedCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedCoding", r.FixedCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedContactPoint", r.FixedContactPoint); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedCount", r.FixedCount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedDistance", r.FixedDistance); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedDuration", r.FixedDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedHumanName", r.FixedHumanName); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedIdentifier", r.FixedIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedMoney", r.FixedMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedPeriod", r.FixedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedQuantity", r.FixedQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedRange", r.FixedRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedRatio", r.FixedRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedReference", r.FixedReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedSampledData", r.FixedSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedSignature", r.FixedSignature); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedTiming", r.FixedTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedContactDetail", r.FixedContactDetail); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedContributor", r.FixedContributor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedDataRequirement", r.FixedDataRequirement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedExpression", r.FixedExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedParameterDefinition", r.FixedParameterDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedRelatedArtifact", r.FixedRelatedArtifact); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedTriggerDefinition", r.FixedTriggerDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedUsageContext", r.FixedUsageContext); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedDosage", r.FixedDosage); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fixedMeta", r.FixedMeta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternBase64Binary", r.PatternBase64Binary, r.PatternBase64BinaryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternBoolean", r.PatternBoolean, r.PatternBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternCanonical", r.PatternCanonical, r.PatternCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternCode", r.PatternCode, r.PatternCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternDate", r.PatternDate, r.PatternDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternDateTime", r.PatternDateTime, r.PatternDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternDecimal", r.PatternDecimal, r.PatternDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternId", r.PatternId, r.PatternIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternInstant", r.PatternInstant, r.PatternInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternInteger", r.PatternInteger, r.PatternIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternMarkdown", r.PatternMarkdown, r.PatternMarkdownElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternOid", r.PatternOid, r.PatternOidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternPositiveInt", r.PatternPositiveInt, r.PatternPositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternString", r.PatternString, r.PatternStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternTime", r.PatternTime, r.PatternTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternUnsignedInt", r.PatternUnsignedInt, r.PatternUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternUri", r.PatternUri, r.PatternUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternUrl", r.PatternUrl, r.PatternUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "patternUuid", r.PatternUuid, r.PatternUuidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternAddress", r.PatternAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternAge", r.PatternAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternAnnotation", r.PatternAnnotation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternAttachment", r.PatternAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternCodeableConcept", r.PatternCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternCoding", r.PatternCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternContactPoint", r.PatternContactPoint); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternCount", r.PatternCount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternDistance", r.PatternDistance); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternDuration", r.PatternDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternHumanName", r.PatternHumanName); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternIdentifier", r.PatternIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternMoney", r.PatternMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternPeriod", r.PatternPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternQuantity", r.PatternQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternRange", r.PatternRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternRatio", r.PatternRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternReference", r.PatternReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternSampledData", r.PatternSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternSignature", r.PatternSignature); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternTiming", r.PatternTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternContactDetail", r.PatternContactDetail); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternContributor", r.PatternContributor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternDataRequirement", r.PatternDataRequirement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternExpression", r.PatternExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternParameterDefinition", r.PatternParameterDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternRelatedArtifact", r.PatternRelatedArtifact); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternTriggerDefinition", r.PatternTriggerDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternUsageContext", r.PatternUsageContext); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternDosage", r.PatternDosage); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patternMeta", r.PatternMeta); err != nil {
+		return err
+	}
+	for _, v := range r.Example {
+		if err := encodeXMLElement(e, "example", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "minValueDate", r.MinValueDate, r.MinValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minValueDateTime", r.MinValueDateTime, r.MinValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minValueInstant", r.MinValueInstant, r.MinValueInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minValueTime", r.MinValueTime, r.MinValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minValueDecimal", r.MinValueDecimal, r.MinValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minValueInteger", r.MinValueInteger, r.MinValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minValuePositiveInt", r.MinValuePositiveInt, r.MinValuePositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minValueUnsignedInt", r.MinValueUnsignedInt, r.MinValueUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "minValueQuantity", r.MinValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxValueDate", r.MaxValueDate, r.MaxValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxValueDateTime", r.MaxValueDateTime, r.MaxValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxValueInstant", r.MaxValueInstant, r.MaxValueInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxValueTime", r.MaxValueTime, r.MaxValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxValueDecimal", r.MaxValueDecimal, r.MaxValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxValueInteger", r.MaxValueInteger, r.MaxValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxValuePositiveInt", r.MaxValuePositiveInt, r.MaxValuePositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxValueUnsignedInt", r.MaxValueUnsignedInt, r.MaxValueUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "maxValueQuantity", r.MaxValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxLength", r.MaxLength, r.MaxLengthElement); err != nil {
+		return err
+	}
+	for i, v := range r.Condition {
+		if err := encodeXMLPrimitive(e, "condition", v, elementAt(r.ConditionElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Constraint {
+		if err := encodeXMLElement(e, "constraint", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "mustSupport", r.MustSupport, r.MustSupportElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "isModifier", r.IsModifier, r.IsModifierElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "isModifierReason", r.IsModifierReason, r.IsModifierReasonElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "isSummary", r.IsSummary, r.IsSummaryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "binding", r.Binding); err != nil {
+		return err
+	}
+	for _, v := range r.Mapping {
+		if err := encodeXMLElement(e, "mapping", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ElementDefinition from FHIR XML
+func (r *ElementDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = v
+				}
+				r.PathElement = element
+			case "representation":
+				var v PropertyRepresentation
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.RepresentationElement = appendElement(r.RepresentationElement, len(r.Representation), element)
+					r.Representation = append(r.Representation, v)
+				}
+			case "sliceName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SliceName = &v
+				}
+				r.SliceNameElement = element
+			case "sliceIsConstraining":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SliceIsConstraining = &v
+				}
+				r.SliceIsConstrainingElement = element
+			case "label":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Label = &v
+				}
+				r.LabelElement = element
+			case "code":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "slicing":
+				var v ElementDefinitionSlicing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Slicing = &v
+			case "short":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Short = &v
+				}
+				r.ShortElement = element
+			case "definition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Definition = &v
+				}
+				r.DefinitionElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			case "requirements":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Requirements = &v
+				}
+				r.RequirementsElement = element
+			case "alias":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.AliasElement = appendElement(r.AliasElement, len(r.Alias), element)
+					r.Alias = append(r.Alias, v)
+				}
+			case "min":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Min = &v
+				}
+				r.MinElement = element
+			case "max":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Max = &v
+				}
+				r.MaxElement = element
+			case "base":
+				var v ElementDefinitionBase
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Base = &v
+			case "contentReference":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ContentReference = &v
+				}
+				r.ContentReferenceElement = element
+			case "type":
+				var v ElementDefinitionType
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "defaultValueBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueBase64Binary = &v
+				}
+				r.DefaultValueBase64BinaryElement = element
+			case "defaultValueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueBoolean = &v
+				}
+				r.DefaultValueBooleanElement = element
+			case "defaultValueCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueCanonical = &v
+				}
+				r.DefaultValueCanonicalElement = element
+			case "defaultValueCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueCode = &v
+				}
+				r.DefaultValueCodeElement = element
+			case "defaultValueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueDate = &v
+				}
+				r.DefaultValueDateElement = element
+			case "defaultValueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueDateTime = &v
+				}
+				r.DefaultValueDateTimeElement = element
+			case "defaultValueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueDecimal = &v
+				}
+				r.DefaultValueDecimalElement = element
+			case "defaultValueId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueId = &v
+				}
+				r.DefaultValueIdElement = element
+			case "defaultValueInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueInstant = &v
+				}
+				r.DefaultValueInstantElement = element
+			case "defaultValueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueInteger = &v
+				}
+				r.DefaultValueIntegerElement = element
+			case "defaultValueMarkdown":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueMarkdown = &v
+				}
+				r.DefaultValueMarkdownElement = element
+			case "defaultValueOid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueOid = &v
+				}
+				r.DefaultValueOidElement = element
+			case "defaultValuePositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValuePositiveInt = &v
+				}
+				r.DefaultValuePositiveIntElement = element
+			case "defaultValueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueString = &v
+				}
+				r.DefaultValueStringElement = element
+			case "defaultValueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueTime = &v
+				}
+				r.DefaultValueTimeElement = element
+			case "defaultValueUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueUnsignedInt = &v
+				}
+				r.DefaultValueUnsignedIntElement = element
+			case "defaultValueUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueUri = &v
+				}
+				r.DefaultValueUriElement = element
+			case "defaultValueUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueUrl = &v
+				}
+				r.DefaultValueUrlElement = element
+			case "defaultValueUuid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueUuid = &v
+				}
+				r.DefaultValueUuidElement = element
+			case "defaultValueAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueAddress = &v
+			case "defaultValueAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueAge = &v
+			case "defaultValueAnnotation":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueAnnotation = &v
+			case "defaultValueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueAttachment = &v
+			case "defaultValueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueCodeableConcept = &v
+			case "defaultValueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueCoding = &v
+			case "defaultValueContactPoint":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueContactPoint = &v
+			case "defaultValueCount":
+				var v Count
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueCount = &v
+			case "defaultValueDistance":
+				var v Distance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueDistance = &v
+			case "defaultValueDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueDuration = &v
+			case "defaultValueHumanName":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueHumanName = &v
+			case "defaultValueIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueIdentifier = &v
+			case "defaultValueMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueMoney = &v
+			case "defaultValuePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValuePeriod = &v
+			case "defaultValueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueQuantity = &v
+			case "defaultValueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueRange = &v
+			case "defaultValueRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueRatio = &v
+			case "defaultValueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueReference = &v
+			case "defaultValueSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueSampledData = &v
+			case "defaultValueSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueSignature = &v
+			case "defaultValueTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueTiming = &v
+			case "defaultValueContactDetail":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueContactDetail = &v
+			case "defaultValueContributor":
+				var v Contributor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueContributor = &v
+			case "defaultValueDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueDataRequirement = &v
+			case "defaultValueExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueExpression = &v
+			case "defaultValueParameterDefinition":
+				var v ParameterDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueParameterDefinition = &v
+			case "defaultValueRelatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueRelatedArtifact = &v
+			case "defaultValueTriggerDefinition":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueTriggerDefinition = &v
+			case "defaultValueUsageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueUsageContext = &v
+			case "defaultValueDosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueDosage = &v
+			case "defaultValueMeta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueMeta = &v
+			case "meaningWhenMissing":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MeaningWhenMissing = &v
+				}
+				r.MeaningWhenMissingElement = element
+			case "orderMeaning":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OrderMeaning = &v
+				}
+				r.OrderMeaningElement = element
+			case "fixedBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedBase64Binary = &v
+				}
+				r.FixedBase64BinaryElement = element
+			case "fixedBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedBoolean = &v
+				}
+				r.FixedBooleanElement = element
+			case "fixedCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedCanonical = &v
+				}
+				r.FixedCanonicalElement = element
+			case "fixedCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedCode = &v
+				}
+				r.FixedCodeElement = element
+			case "fixedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedDate = &v
+				}
+				r.FixedDateElement = element
+			case "fixedDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedDateTime = &v
+				}
+				r.FixedDateTimeElement = element
+			case "fixedDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedDecimal = &v
+				}
+				r.FixedDecimalElement = element
+			case "fixedId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedId = &v
+				}
+				r.FixedIdElement = element
+			case "fixedInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedInstant = &v
+				}
+				r.FixedInstantElement = element
+			case "fixedInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedInteger = &v
+				}
+				r.FixedIntegerElement = element
+			case "fixedMarkdown":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedMarkdown = &v
+				}
+				r.FixedMarkdownElement = element
+			case "fixedOid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedOid = &v
+				}
+				r.FixedOidElement = element
+			case "fixedPositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedPositiveInt = &v
+				}
+				r.FixedPositiveIntElement = element
+			case "fixedString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedString = &v
+				}
+				r.FixedStringElement = element
+			case "fixedTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedTime = &v
+				}
+				r.FixedTimeElement = element
+			case "fixedUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedUnsignedInt = &v
+				}
+				r.FixedUnsignedIntElement = element
+			case "fixedUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedUri = &v
+				}
+				r.FixedUriElement = element
+			case "fixedUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedUrl = &v
+				}
+				r.FixedUrlElement = element
+			case "fixedUuid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FixedUuid = &v
+				}
+				r.FixedUuidElement = element
+			case "fixedAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedAddress = &v
+			case "fixedAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedAge = &v
+			case "fixedAnnotation":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedAnnotation = &v
+			case "fixedAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedAttachment = &v
+			case "fixedCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedCodeableConcept = &v
+			case "fixedCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedCoding = &v
+			case "fixedContactPoint":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedContactPoint = &v
+			case "fixedCount":
+				var v Count
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedCount = &v
+			case "fixedDistance":
+				var v Distance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedDistance = &v
+			case "fixedDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedDuration = &v
+			case "fixedHumanName":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedHumanName = &v
+			case "fixedIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedIdentifier = &v
+			case "fixedMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedMoney = &v
+			case "fixedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedPeriod = &v
+			case "fixedQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedQuantity = &v
+			case "fixedRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedRange = &v
+			case "fixedRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedRatio = &v
+			case "fixedReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedReference = &v
+			case "fixedSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedSampledData = &v
+			case "fixedSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedSignature = &v
+			case "fixedTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedTiming = &v
+			case "fixedContactDetail":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedContactDetail = &v
+			case "fixedContributor":
+				var v Contributor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedContributor = &v
+			case "fixedDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedDataRequirement = &v
+			case "fixedExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedExpression = &v
+			case "fixedParameterDefinition":
+				var v ParameterDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedParameterDefinition = &v
+			case "fixedRelatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedRelatedArtifact = &v
+			case "fixedTriggerDefinition":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedTriggerDefinition = &v
+			case "fixedUsageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedUsageContext = &v
+			case "fixedDosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedDosage = &v
+			case "fixedMeta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FixedMeta = &v
+			case "patternBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternBase64Binary = &v
+				}
+				r.PatternBase64BinaryElement = element
+			case "patternBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternBoolean = &v
+				}
+				r.PatternBooleanElement = element
+			case "patternCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternCanonical = &v
+				}
+				r.PatternCanonicalElement = element
+			case "patternCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternCode = &v
+				}
+				r.PatternCodeElement = element
+			case "patternDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternDate = &v
+				}
+				r.PatternDateElement = element
+			case "patternDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternDateTime = &v
+				}
+				r.PatternDateTimeElement = element
+			case "patternDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternDecimal = &v
+				}
+				r.PatternDecimalElement = element
+			case "patternId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternId = &v
+				}
+				r.PatternIdElement = element
+			case "patternInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternInstant = &v
+				}
+				r.PatternInstantElement = element
+			case "patternInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternInteger = &v
+				}
+				r.PatternIntegerElement = element
+			case "patternMarkdown":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternMarkdown = &v
+				}
+				r.PatternMarkdownElement = element
+			case "patternOid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternOid = &v
+				}
+				r.PatternOidElement = element
+			case "patternPositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternPositiveInt = &v
+				}
+				r.PatternPositiveIntElement = element
+			case "patternString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternString = &v
+				}
+				r.PatternStringElement = element
+			case "patternTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternTime = &v
+				}
+				r.PatternTimeElement = element
+			case "patternUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternUnsignedInt = &v
+				}
+				r.PatternUnsignedIntElement = element
+			case "patternUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternUri = &v
+				}
+				r.PatternUriElement = element
+			case "patternUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternUrl = &v
+				}
+				r.PatternUrlElement = element
+			case "patternUuid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatternUuid = &v
+				}
+				r.PatternUuidElement = element
+			case "patternAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternAddress = &v
+			case "patternAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternAge = &v
+			case "patternAnnotation":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternAnnotation = &v
+			case "patternAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternAttachment = &v
+			case "patternCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternCodeableConcept = &v
+			case "patternCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternCoding = &v
+			case "patternContactPoint":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternContactPoint = &v
+			case "patternCount":
+				var v Count
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternCount = &v
+			case "patternDistance":
+				var v Distance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternDistance = &v
+			case "patternDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternDuration = &v
+			case "patternHumanName":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternHumanName = &v
+			case "patternIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternIdentifier = &v
+			case "patternMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternMoney = &v
+			case "patternPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternPeriod = &v
+			case "patternQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternQuantity = &v
+			case "patternRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternRange = &v
+			case "patternRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternRatio = &v
+			case "patternReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternReference = &v
+			case "patternSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternSampledData = &v
+			case "patternSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternSignature = &v
+			case "patternTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternTiming = &v
+			case "patternContactDetail":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternContactDetail = &v
+			case "patternContributor":
+				var v Contributor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternContributor = &v
+			case "patternDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternDataRequirement = &v
+			case "patternExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternExpression = &v
+			case "patternParameterDefinition":
+				var v ParameterDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternParameterDefinition = &v
+			case "patternRelatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternRelatedArtifact = &v
+			case "patternTriggerDefinition":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternTriggerDefinition = &v
+			case "patternUsageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternUsageContext = &v
+			case "patternDosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternDosage = &v
+			case "patternMeta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatternMeta = &v
+			case "example":
+				var v ElementDefinitionExample
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Example = append(r.Example, v)
+			case "minValueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinValueDate = &v
+				}
+				r.MinValueDateElement = element
+			case "minValueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinValueDateTime = &v
+				}
+				r.MinValueDateTimeElement = element
+			case "minValueInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinValueInstant = &v
+				}
+				r.MinValueInstantElement = element
+			case "minValueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinValueTime = &v
+				}
+				r.MinValueTimeElement = element
+			case "minValueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinValueDecimal = &v
+				}
+				r.MinValueDecimalElement = element
+			case "minValueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinValueInteger = &v
+				}
+				r.MinValueIntegerElement = element
+			case "minValuePositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinValuePositiveInt = &v
+				}
+				r.MinValuePositiveIntElement = element
+			case "minValueUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinValueUnsignedInt = &v
+				}
+				r.MinValueUnsignedIntElement = element
+			case "minValueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MinValueQuantity = &v
+			case "maxValueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxValueDate = &v
+				}
+				r.MaxValueDateElement = element
+			case "maxValueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxValueDateTime = &v
+				}
+				r.MaxValueDateTimeElement = element
+			case "maxValueInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxValueInstant = &v
+				}
+				r.MaxValueInstantElement = element
+			case "maxValueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxValueTime = &v
+				}
+				r.MaxValueTimeElement = element
+			case "maxValueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxValueDecimal = &v
+				}
+				r.MaxValueDecimalElement = element
+			case "maxValueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxValueInteger = &v
+				}
+				r.MaxValueIntegerElement = element
+			case "maxValuePositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxValuePositiveInt = &v
+				}
+				r.MaxValuePositiveIntElement = element
+			case "maxValueUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxValueUnsignedInt = &v
+				}
+				r.MaxValueUnsignedIntElement = element
+			case "maxValueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxValueQuantity = &v
+			case "maxLength":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxLength = &v
+				}
+				r.MaxLengthElement = element
+			case "condition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ConditionElement = appendElement(r.ConditionElement, len(r.Condition), element)
+					r.Condition = append(r.Condition, v)
+				}
+			case "constraint":
+				var v ElementDefinitionConstraint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Constraint = append(r.Constraint, v)
+			case "mustSupport":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MustSupport = &v
+				}
+				r.MustSupportElement = element
+			case "isModifier":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsModifier = &v
+				}
+				r.IsModifierElement = element
+			case "isModifierReason":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsModifierReason = &v
+				}
+				r.IsModifierReasonElement = element
+			case "isSummary":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsSummary = &v
+				}
+				r.IsSummaryElement = element
+			case "binding":
+				var v ElementDefinitionBinding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Binding = &v
+			case "mapping":
+				var v ElementDefinitionMapping
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Mapping = append(r.Mapping, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ElementDefinition as properties to the RDF node n
+func (r ElementDefinition) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ElementDefinition.path", -1, r.Path, r.PathElement, "string")
+	for i, v := range r.Representation {
+		n.primitive("ElementDefinition.representation", i, v, elementAt(r.RepresentationElement, i), "code")
+	}
+	n.primitive("ElementDefinition.sliceName", -1, r.SliceName, r.SliceNameElement, "string")
+	n.primitive("ElementDefinition.sliceIsConstraining", -1, r.SliceIsConstraining, r.SliceIsConstrainingElement, "boolean")
+	n.primitive("ElementDefinition.label", -1, r.Label, r.LabelElement, "string")
+	for i, v := range r.Code {
+		n.element("ElementDefinition.code", i, v)
+	}
+	n.element("ElementDefinition.slicing", -1, r.Slicing)
+	n.primitive("ElementDefinition.short", -1, r.Short, r.ShortElement, "string")
+	n.primitive("ElementDefinition.definition", -1, r.Definition, r.DefinitionElement, "string")
+	n.primitive("ElementDefinition.comment", -1, r.Comment, r.CommentElement, "string")
+	n.primitive("ElementDefinition.requirements", -1, r.Requirements, r.RequirementsElement, "string")
+	for i, v := range r.Alias {
+		n.primitive("ElementDefinition.alias", i, v, elementAt(r.AliasElement, i), "string")
+	}
+	n.primitive("ElementDefinition.min", -1, r.Min, r.MinElement, "unsignedInt")
+	n.primitive("ElementDefinition.max", -1, r.Max, r.MaxElement, "string")
+	n.element("ElementDefinition.base", -1, r.Base)
+	n.primitive("ElementDefinition.contentReference", -1, r.ContentReference, r.ContentReferenceElement, "string")
+	for i, v := range r.Type {
+		n.element("ElementDefinition.type", i, v)
+	}
+	n.primitive("ElementDefinition.defaultValueBase64Binary", -1, r.DefaultValueBase64Binary, r.DefaultValueBase64BinaryElement, "base64Binary")
+	n.primitive("ElementDefinition.defaultValueBoolean", -1, r.DefaultValueBoolean, r.DefaultValueBooleanElement, "boolean")
+	n.primitive("ElementDefinition.defaultValueCanonical", -1, r.DefaultValueCanonical, r.DefaultValueCanonicalElement, "canonical")
+	n.primitive("ElementDefinition.defaultValueCode", -1, r.DefaultValueCode, r.DefaultValueCodeElement, "code")
+	n.primitive("ElementDefinition.defaultValueDate", -1, r.DefaultValueDate, r.DefaultValueDateElement, "date")
+	n.primitive("ElementDefinition.defaultValueDateTime", -1, r.DefaultValueDateTime, r.DefaultValueDateTimeElement, "dateTime")
+	n.primitive("ElementDefinition.defaultValueDecimal", -1, r.DefaultValueDecimal, r.DefaultValueDecimalElement, "decimal")
+	n.primitive("ElementDefinition.defaultValueId", -1, r.DefaultValueId, r.DefaultValueIdElement, "id")
+	n.primitive("ElementDefinition.defaultValueInstant", -1, r.DefaultValueInstant, r.DefaultValueInstantElement, "instant")
+	n.primitive("ElementDefinition.defaultValueInteger", -1, r.DefaultValueInteger, r.DefaultValueIntegerElement, "integer")
+	n.primitive("ElementDefinition.defaultValueMarkdown", -1, r.DefaultValueMarkdown, r.DefaultValueMarkdownElement, "markdown")
+	n.primitive("ElementDefinition.defaultValueOid", -1, r.DefaultValueOid, r.DefaultValueOidElement, "oid")
+	n.primitive("ElementDefinition.defaultValuePositiveInt", -1, r.DefaultValuePositiveInt, r.DefaultValuePositiveIntElement, "positiveInt")
+	n.primitive("ElementDefinition.defaultValueString", -1, r.DefaultValueString, r.DefaultValueStringElement, "string")
+	n.primitive("ElementDefinition.defaultValueTime", -1, r.DefaultValueTime, r.DefaultValueTimeElement, "time")
+	n.primitive("ElementDefinition.defaultValueUnsignedInt", -1, r.DefaultValueUnsignedInt, r.DefaultValueUnsignedIntElement, "unsignedInt")
+	n.primitive("ElementDefinition.defaultValueUri", -1, r.DefaultValueUri, r.DefaultValueUriElement, "uri")
+	n.primitive("ElementDefinition.defaultValueUrl", -1, r.DefaultValueUrl, r.DefaultValueUrlElement, "url")
+	n.primitive("ElementDefinition.defaultValueUuid", -1, r.DefaultValueUuid, r.DefaultValueUuidElement, "uuid")
+	n.element("ElementDefinition.defaultValueAddress", -1, r.DefaultValueAddress)
+	n.element("ElementDefinition.defaultValueAge", -1, r.DefaultValueAge)
+	n.element("ElementDefinition.defaultValueAnnotation", -1, r.DefaultValueAnnotation)
+	n.element("ElementDefinition.defaultValueAttachment", -1, r.DefaultValueAttachment)
+	n.element("ElementDefinition.defaultValueCodeableConcept", -1, r.DefaultValueCodeableConcept)
+	n.element("ElementDefinition.defaultValueCoding", -1, r.DefaultValueCoding)
+	n.element("ElementDefinition.defaultValueContactPoint", -1, r.DefaultValueContactPoint)
+	n.element("ElementDefinition.defaultValueCount", -1, r.DefaultValueCount)
+	n.element("ElementDefinition.defaultValueDistance", -1, r.DefaultValueDistance)
+	n.element("ElementDefinition.defaultValueDuration", -1, r.DefaultValueDuration)
+	n.element("ElementDefinition.defaultValueHumanName", -1, r.DefaultValueHumanName)
+	n.element("ElementDefinition.defaultValueIdentifier", -1, r.DefaultValueIdentifier)
+	n.element("ElementDefinition.defaultValueMoney", -1, r.DefaultValueMoney)
+	n.element("ElementDefinition.defaultValuePeriod", -1, r.DefaultValuePeriod)
+	n.element("ElementDefinition.defaultValueQuantity", -1, r.DefaultValueQuantity)
+	n.element("ElementDefinition.defaultValueRange", -1, r.DefaultValueRange)
+	n.element("ElementDefinition.defaultValueRatio", -1, r.DefaultValueRatio)
+	n.element("ElementDefinition.defaultValueReference", -1, r.DefaultValueReference)
+	n.element("ElementDefinition.defaultValueSampledData", -1, r.DefaultValueSampledData)
+	n.element("ElementDefinition.defaultValueSignature", -1, r.DefaultValueSignature)
+	n.element("ElementDefinition.defaultValueTiming", -1, r.DefaultValueTiming)
+	n.element("ElementDefinition.defaultValueContactDetail", -1, r.DefaultValueContactDetail)
+	n.element("ElementDefinition.defaultValueContributor", -1, r.DefaultValueContributor)
+	n.element("ElementDefinition.defaultValueDataRequirement", -1, r.DefaultValueDataRequirement)
+	n.element("ElementDefinition.defaultValueExpression", -1, r.DefaultValueExpression)
+	n.element("ElementDefinition.defaultValueParameterDefinition", -1, r.DefaultValueParameterDefinition)
+	n.element("ElementDefinition.defaultValueRelatedArtifact", -1, r.DefaultValueRelatedArtifact)
+	n.element("ElementDefinition.defaultValueTriggerDefinition", -1, r.DefaultValueTriggerDefinition)
+	n.element("ElementDefinition.defaultValueUsageContext", -1, r.DefaultValueUsageContext)
+	n.element("ElementDefinition.defaultValueDosage", -1, r.DefaultValueDosage)
+	n.element("ElementDefinition.defaultValueMeta", -1, r.DefaultValueMeta)
+	n.primitive("ElementDefinition.meaningWhenMissing", -1, r.MeaningWhenMissing, r.MeaningWhenMissingElement, "string")
+	n.primitive("ElementDefinition.orderMeaning", -1, r.OrderMeaning, r.OrderMeaningElement, "string")
+	n.primitive("ElementDefinition.fixedBase64Binary", -1, r.FixedBase64Binary, r.FixedBase64BinaryElement, "base64Binary")
+	n.primitive("ElementDefinition.fixedBoolean", -1, r.FixedBoolean, r.FixedBooleanElement, "boolean")
+	n.primitive("ElementDefinition.fixedCanonical", -1, r.FixedCanonical, r.FixedCanonicalElement, "canonical")
+	n.primitive("ElementDefinition.fixedCode", -1, r.FixedCode, r.FixedCodeElement, "code")
+	n.primitive("ElementDefinition.fixedDate", -1, r.FixedDate, r.FixedDateElement, "date")
+	n.primitive("ElementDefinition.fixedDateTime", -1, r.FixedDateTime, r.FixedDateTimeElement, "dateTime")
+	n.primitive("ElementDefinition.fixedDecimal", -1, r.FixedDecimal, r.FixedDecimalElement, "decimal")
+	n.primitive("ElementDefinition.fixedId", -1, r.FixedId, r.FixedIdElement, "id")
+	n.primitive("ElementDefinition.fixedInstant", -1, r.FixedInstant, r.FixedInstantElement, "instant")
+	n.primitive("ElementDefinition.fixedInteger", -1, r.FixedInteger, r.FixedIntegerElement, "integer")
+	n.primitive("ElementDefinition.fixedMarkdown", -1, r.FixedMarkdown, r.FixedMarkdownElement, "markdown")
+	n.primitive("ElementDefinition.fixedOid", -1, r.FixedOid, r.FixedOidElement, "oid")
+	n.primitive("ElementDefinition.fixedPositiveInt", -1, r.FixedPositiveInt, r.FixedPositiveIntElement, "positiveInt")
+	n.primitive("ElementDefinition.fixedString", -1, r.FixedString, r.FixedStringElement, "string")
+	n.primitive("ElementDefinition.fixedTime", -1, r.FixedTime, r.FixedTimeElement, "time")
+	n.primitive("ElementDefinition.fixedUnsignedInt", -1, r.FixedUnsignedInt, r.FixedUnsignedIntElement, "unsignedInt")
+	n.primitive("ElementDefinition.fixedUri", -1, r.FixedUri, r.FixedUriElement, "uri")
+	n.primitive("ElementDefinition.fixedUrl", -1, r.FixedUrl, r.FixedUrlElement, "url")
+	n.primitive("ElementDefinition.fixedUuid", -1, r.FixedUuid, r.FixedUuidElement, "uuid")
+	n.element("ElementDefinition.fixedAddress", -1, r.FixedAddress)
+	n.element("ElementDefinition.fixedAge", -1, r.FixedAge)
+	n.element("ElementDefinition.fixedAnnotation", -1, r.FixedAnnotation)
+	n.element("ElementDefinition.fixedAttachment", -1, r.FixedAttachment)
+	n.element("ElementDefinition.fixedCodeableConcept", -1, r.FixedCodeableConcept)
+	n.element("ElementDefinition.fixedCoding", -1, r.FixedCoding)
+	n.element("ElementDefinition.fixedContactPoint", -1, r.FixedContactPoint)
+	n.element("ElementDefinition.fixedCount", -1, r.FixedCount)
+	n.element("ElementDefinition.fixedDistance", -1, r.FixedDistance)
+	n.element("ElementDefinition.fixedDuration", -1, r.FixedDuration)
+	n.element("ElementDefinition.fixedHumanName", -1, r.FixedHumanName)
+	n.element("ElementDefinition.fixedIdentifier", -1, r.FixedIdentifier)
+	n.element("ElementDefinition.fixedMoney", -1, r.FixedMoney)
+	n.element("ElementDefinition.fixedPeriod", -1, r.FixedPeriod)
+	n.element("ElementDefinition.fixedQuantity", -1, r.FixedQuantity)
+	n.element("ElementDefinition.fixedRange", -1, r.FixedRange)
+	n.element("ElementDefinition.fixedRatio", -1, r.FixedRatio)
+	n.element("ElementDefinition.fixedReference", -1, r.FixedReference)
+	n.element("ElementDefinition.fixedSampledData", -1, r.FixedSampledData)
+	n.element("ElementDefinition.fixedSignature", -1, r.FixedSignature)
+	n.element("ElementDefinition.fixedTiming", -1, r.FixedTiming)
+	n.element("ElementDefinition.fixedContactDetail", -1, r.FixedContactDetail)
+	n.element("ElementDefinition.fixedContributor", -1, r.FixedContributor)
+	n.element("ElementDefinition.fixedDataRequirement", -1, r.FixedDataRequirement)
+	n.element("ElementDefinition.fixedExpression", -1, r.FixedExpression)
+	n.element("ElementDefinition.fixedParameterDefinition", -1, r.FixedParameterDefinition)
+	n.element("ElementDefinition.fixedRelatedArtifact", -1, r.FixedRelatedArtifact)
+	n.element("ElementDefinition.fixedTriggerDefinition", -1, r.FixedTriggerDefinition)
+	n.element("ElementDefinition.fixedUsageContext", -1, r.FixedUsageContext)
+	n.element("ElementDefinition.fixedDosage", -1, r.FixedDosage)
+	n.element("ElementDefinition.fixedMeta", -1, r.FixedMeta)
+	n.primitive("ElementDefinition.patternBase64Binary", -1, r.PatternBase64Binary, r.PatternBase64BinaryElement, "base64Binary")
+	n.primitive("ElementDefinition.patternBoolean", -1, r.PatternBoolean, r.PatternBooleanElement, "boolean")
+	n.primitive("ElementDefinition.patternCanonical", -1, r.PatternCanonical, r.PatternCanonicalElement, "canonical")
+	n.primitive("ElementDefinition.patternCode", -1, r.PatternCode, r.PatternCodeElement, "code")
+	n.primitive("ElementDefinition.patternDate", -1, r.PatternDate, r.PatternDateElement, "date")
+	n.primitive("ElementDefinition.patternDateTime", -1, r.PatternDateTime, r.PatternDateTimeElement, "dateTime")
+	n.primitive("ElementDefinition.patternDecimal", -1, r.PatternDecimal, r.PatternDecimalElement, "decimal")
+	n.primitive("ElementDefinition.patternId", -1, r.PatternId, r.PatternIdElement, "id")
+	n.primitive("ElementDefinition.patternInstant", -1, r.PatternInstant, r.PatternInstantElement, "instant")
+	n.primitive("ElementDefinition.patternInteger", -1, r.PatternInteger, r.PatternIntegerElement, "integer")
+	n.primitive("ElementDefinition.patternMarkdown", -1, r.PatternMarkdown, r.PatternMarkdownElement, "markdown")
+	n.primitive("ElementDefinition.patternOid", -1, r.PatternOid, r.PatternOidElement, "oid")
+	n.primitive("ElementDefinition.patternPositiveInt", -1, r.PatternPositiveInt, r.PatternPositiveIntElement, "positiveInt")
+	n.primitive("ElementDefinition.patternString", -1, r.PatternString, r.PatternStringElement, "string")
+	n.primitive("ElementDefinition.patternTime", -1, r.PatternTime, r.PatternTimeElement, "time")
+	n.primitive("ElementDefinition.patternUnsignedInt", -1, r.PatternUnsignedInt, r.PatternUnsignedIntElement, "unsignedInt")
+	n.primitive("ElementDefinition.patternUri", -1, r.PatternUri, r.PatternUriElement, "uri")
+	n.primitive("ElementDefinition.patternUrl", -1, r.PatternUrl, r.PatternUrlElement, "url")
+	n.primitive("ElementDefinition.patternUuid", -1, r.PatternUuid, r.PatternUuidElement, "uuid")
+	n.element("ElementDefinition.patternAddress", -1, r.PatternAddress)
+	n.element("ElementDefinition.patternAge", -1, r.PatternAge)
+	n.element("ElementDefinition.patternAnnotation", -1, r.PatternAnnotation)
+	n.element("ElementDefinition.patternAttachment", -1, r.PatternAttachment)
+	n.element("ElementDefinition.patternCodeableConcept", -1, r.PatternCodeableConcept)
+	n.element("ElementDefinition.patternCoding", -1, r.PatternCoding)
+	n.element("ElementDefinition.patternContactPoint", -1, r.PatternContactPoint)
+	n.element("ElementDefinition.patternCount", -1, r.PatternCount)
+	n.element("ElementDefinition.patternDistance", -1, r.PatternDistance)
+	n.element("ElementDefinition.patternDuration", -1, r.PatternDuration)
+	n.element("ElementDefinition.patternHumanName", -1, r.PatternHumanName)
+	n.element("ElementDefinition.patternIdentifier", -1, r.PatternIdentifier)
+	n.element("ElementDefinition.patternMoney", -1, r.PatternMoney)
+	n.element("ElementDefinition.patternPeriod", -1, r.PatternPeriod)
+	n.element("ElementDefinition.patternQuantity", -1, r.PatternQuantity)
+	n.element("ElementDefinition.patternRange", -1, r.PatternRange)
+	n.element("ElementDefinition.patternRatio", -1, r.PatternRatio)
+	n.element("ElementDefinition.patternReference", -1, r.PatternReference)
+	n.element("ElementDefinition.patternSampledData", -1, r.PatternSampledData)
+	n.element("ElementDefinition.patternSignature", -1, r.PatternSignature)
+	n.element("ElementDefinition.patternTiming", -1, r.PatternTiming)
+	n.element("ElementDefinition.patternContactDetail", -1, r.PatternContactDetail)
+	n.element("ElementDefinition.patternContributor", -1, r.PatternContributor)
+	n.element("ElementDefinition.patternDataRequirement", -1, r.PatternDataRequirement)
+	n.element("ElementDefinition.patternExpression", -1, r.PatternExpression)
+	n.element("ElementDefinition.patternParameterDefinition", -1, r.PatternParameterDefinition)
+	n.element("ElementDefinition.patternRelatedArtifact", -1, r.PatternRelatedArtifact)
+	n.element("ElementDefinition.patternTriggerDefinition", -1, r.PatternTriggerDefinition)
+	n.element("ElementDefinition.patternUsageContext", -1, r.PatternUsageContext)
+	n.element("ElementDefinition.patternDosage", -1, r.PatternDosage)
+	n.element("ElementDefinition.patternMeta", -1, r.PatternMeta)
+	for i, v := range r.Example {
+		n.element("ElementDefinition.example", i, v)
+	}
+	n.primitive("ElementDefinition.minValueDate", -1, r.MinValueDate, r.MinValueDateElement, "date")
+	n.primitive("ElementDefinition.minValueDateTime", -1, r.MinValueDateTime, r.MinValueDateTimeElement, "dateTime")
+	n.primitive("ElementDefinition.minValueInstant", -1, r.MinValueInstant, r.MinValueInstantElement, "instant")
+	n.primitive("ElementDefinition.minValueTime", -1, r.MinValueTime, r.MinValueTimeElement, "time")
+	n.primitive("ElementDefinition.minValueDecimal", -1, r.MinValueDecimal, r.MinValueDecimalElement, "decimal")
+	n.primitive("ElementDefinition.minValueInteger", -1, r.MinValueInteger, r.MinValueIntegerElement, "integer")
+	n.primitive("ElementDefinition.minValuePositiveInt", -1, r.MinValuePositiveInt, r.MinValuePositiveIntElement, "positiveInt")
+	n.primitive("ElementDefinition.minValueUnsignedInt", -1, r.MinValueUnsignedInt, r.MinValueUnsignedIntElement, "unsignedInt")
+	n.element("ElementDefinition.minValueQuantity", -1, r.MinValueQuantity)
+	n.primitive("ElementDefinition.maxValueDate", -1, r.MaxValueDate, r.MaxValueDateElement, "date")
+	n.primitive("ElementDefinition.maxValueDateTime", -1, r.MaxValueDateTime, r.MaxValueDateTimeElement, "dateTime")
+	n.primitive("ElementDefinition.maxValueInstant", -1, r.MaxValueInstant, r.MaxValueInstantElement, "instant")
+	n.primitive("ElementDefinition.maxValueTime", -1, r.MaxValueTime, r.MaxValueTimeElement, "time")
+	n.primitive("ElementDefinition.maxValueDecimal", -1, r.MaxValueDecimal, r.MaxValueDecimalElement, "decimal")
+	n.primitive("ElementDefinition.maxValueInteger", -1, r.MaxValueInteger, r.MaxValueIntegerElement, "integer")
+	n.primitive("ElementDefinition.maxValuePositiveInt", -1, r.MaxValuePositiveInt, r.MaxValuePositiveIntElement, "positiveInt")
+	n.primitive("ElementDefinition.maxValueUnsignedInt", -1, r.MaxValueUnsignedInt, r.MaxValueUnsignedIntElement, "unsignedInt")
+	n.element("ElementDefinition.maxValueQuantity", -1, r.MaxValueQuantity)
+	n.primitive("ElementDefinition.maxLength", -1, r.MaxLength, r.MaxLengthElement, "integer")
+	for i, v := range r.Condition {
+		n.primitive("ElementDefinition.condition", i, v, elementAt(r.ConditionElement, i), "string")
+	}
+	for i, v := range r.Constraint {
+		n.element("ElementDefinition.constraint", i, v)
+	}
+	n.primitive("ElementDefinition.mustSupport", -1, r.MustSupport, r.MustSupportElement, "boolean")
+	n.primitive("ElementDefinition.isModifier", -1, r.IsModifier, r.IsModifierElement, "boolean")
+	n.primitive("ElementDefinition.isModifierReason", -1, r.IsModifierReason, r.IsModifierReasonElement, "string")
+	n.primitive("ElementDefinition.isSummary", -1, r.IsSummary, r.IsSummaryElement, "boolean")
+	n.element("ElementDefinition.binding", -1, r.Binding)
+	for i, v := range r.Mapping {
+		n.element("ElementDefinition.mapping", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ElementDefinition as BSON document with the structure of its FHIR JSON
+func (r ElementDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ElementDefinition from a BSON document with the structure of its FHIR JSON
+func (r *ElementDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ElementDefinition as protobuf message ElementDefinition of fhir.proto
+func (r ElementDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ElementDefinition to the encoded message
+func (r ElementDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Path != "" {
+		e.string(4, r.Path)
+	}
+	if len(r.Representation) > 0 {
+		var p protoEncoder
+		for _, v := range r.Representation {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(5, p.buf)
+	}
+	if r.SliceName != nil {
+		e.string(6, *r.SliceName)
+	}
+	if r.SliceIsConstraining != nil {
+		e.varint(7, protoBool(*r.SliceIsConstraining))
+	}
+	if r.Label != nil {
+		e.string(8, *r.Label)
+	}
+	for _, v := range r.Code {
+		e.message(9, v)
+	}
+	if r.Slicing != nil {
+		e.message(10, *r.Slicing)
+	}
+	if r.Short != nil {
+		e.string(11, *r.Short)
+	}
+	if r.Definition != nil {
+		e.string(12, *r.Definition)
+	}
+	if r.Comment != nil {
+		e.string(13, *r.Comment)
+	}
+	if r.Requirements != nil {
+		e.string(14, *r.Requirements)
+	}
+	for _, v := range r.Alias {
+		e.string(15, v)
+	}
+	if r.Min != nil {
+		e.varint(16, uint64(int64(*r.Min)))
+	}
+	if r.Max != nil {
+		e.string(17, *r.Max)
+	}
+	if r.Base != nil {
+		e.message(18, *r.Base)
+	}
+	if r.ContentReference != nil {
+		e.string(19, *r.ContentReference)
+	}
+	for _, v := range r.Type {
+		e.message(20, v)
+	}
+	if r.DefaultValueBase64Binary != nil {
+		e.string(21, *r.DefaultValueBase64Binary)
+	}
+	if r.DefaultValueBoolean != nil {
+		e.varint(22, protoBool(*r.DefaultValueBoolean))
+	}
+	if r.DefaultValueCanonical != nil {
+		e.string(23, *r.DefaultValueCanonical)
+	}
+	if r.DefaultValueCode != nil {
+		e.string(24, *r.DefaultValueCode)
+	}
+	if r.DefaultValueDate != nil {
+		e.string(25, *r.DefaultValueDate)
+	}
+	if r.DefaultValueDateTime != nil {
+		e.string(26, *r.DefaultValueDateTime)
+	}
+	if r.DefaultValueDecimal != nil {
+		e.string(27, string(*r.DefaultValueDecimal))
+	}
+	if r.DefaultValueId != nil {
+		e.string(28, *r.DefaultValueId)
+	}
+	if r.DefaultValueInstant != nil {
+		e.string(29, *r.DefaultValueInstant)
+	}
+	if r.DefaultValueInteger != nil {
+		e.varint(30, uint64(int64(*r.DefaultValueInteger)))
+	}
+	if r.DefaultValueMarkdown != nil {
+		e.string(31, *r.DefaultValueMarkdown)
+	}
+	if r.DefaultValueOid != nil {
+		e.string(32, *r.DefaultValueOid)
+	}
+	if r.DefaultValuePositiveInt != nil {
+		e.varint(33, uint64(int64(*r.DefaultValuePositiveInt)))
+	}
+	if r.DefaultValueString != nil {
+		e.string(34, *r.DefaultValueString)
+	}
+	if r.DefaultValueTime != nil {
+		e.string(35, *r.DefaultValueTime)
+	}
+	if r.DefaultValueUnsignedInt != nil {
+		e.varint(36, uint64(int64(*r.DefaultValueUnsignedInt)))
+	}
+	if r.DefaultValueUri != nil {
+		e.string(37, *r.DefaultValueUri)
+	}
+	if r.DefaultValueUrl != nil {
+		e.string(38, *r.DefaultValueUrl)
+	}
+	if r.DefaultValueUuid != nil {
+		e.string(39, *r.DefaultValueUuid)
+	}
+	if r.DefaultValueAddress != nil {
+		e.message(40, *r.DefaultValueAddress)
+	}
+	if r.DefaultValueAge != nil {
+		e.message(41, *r.DefaultValueAge)
+	}
+	if r.DefaultValueAnnotation != nil {
+		e.message(42, *r.DefaultValueAnnotation)
+	}
+	if r.DefaultValueAttachment != nil {
+		e.message(43, *r.DefaultValueAttachment)
+	}
+	if r.DefaultValueCodeableConcept != nil {
+		e.message(44, *r.DefaultValueCodeableConcept)
+	}
+	if r.DefaultValueCoding != nil {
+		e.message(45, *r.DefaultValueCoding)
+	}
+	if r.DefaultValueContactPoint != nil {
+		e.message(46, *r.DefaultValueContactPoint)
+	}
+	if r.DefaultValueCount != nil {
+		e.message(47, *r.DefaultValueCount)
+	}
+	if r.DefaultValueDistance != nil {
+		e.message(48, *r.DefaultValueDistance)
+	}
+	if r.DefaultValueDuration != nil {
+		e.message(49, *r.DefaultValueDuration)
+	}
+	if r.DefaultValueHumanName != nil {
+		e.message(50, *r.DefaultValueHumanName)
+	}
+	if r.DefaultValueIdentifier != nil {
+		e.message(51, *r.DefaultValueIdentifier)
+	}
+	if r.DefaultValueMoney != nil {
+		e.message(52, *r.DefaultValueMoney)
+	}
+	if r.DefaultValuePeriod != nil {
+		e.message(53, *r.DefaultValuePeriod)
+	}
+	if r.DefaultValueQuantity != nil {
+		e.message(54, *r.DefaultValueQuantity)
+	}
+	if r.DefaultValueRange != nil {
+		e.message(55, *r.DefaultValueRange)
+	}
+	if r.DefaultValueRatio != nil {
+		e.message(56, *r.DefaultValueRatio)
+	}
+	if r.DefaultValueReference != nil {
+		e.message(57, *r.DefaultValueReference)
+	}
+	if r.DefaultValueSampledData != nil {
+		e.message(58, *r.DefaultValueSampledData)
+	}
+	if r.DefaultValueSignature != nil {
+		e.message(59, *r.DefaultValueSignature)
+	}
+	if r.DefaultValueTiming != nil {
+		e.message(60, *r.DefaultValueTiming)
+	}
+	if r.DefaultValueContactDetail != nil {
+		e.message(61, *r.DefaultValueContactDetail)
+	}
+	if r.DefaultValueContributor != nil {
+		e.message(62, *r.DefaultValueContributor)
+	}
+	if r.DefaultValueDataRequirement != nil {
+		e.message(63, *r.DefaultValueDataRequirement)
+	}
+	if r.DefaultValueExpression != nil {
+		e.message(64, *r.DefaultValueExpression)
+	}
+	if r.DefaultValueParameterDefinition != nil {
+		e.message(65, *r.DefaultValueParameterDefinition)
+	}
+	if r.DefaultValueRelatedArtifact != nil {
+		e.message(66, *r.DefaultValueRelatedArtifact)
+	}
+	if r.DefaultValueTriggerDefinition != nil {
+		e.message(67, *r.DefaultValueTriggerDefinition)
+	}
+	if r.DefaultValueUsageContext != nil {
+		e.message(68, *r.DefaultValueUsageContext)
+	}
+	if r.DefaultValueDosage != nil {
+		e.message(69, *r.DefaultValueDosage)
+	}
+	if r.DefaultValueMeta != nil {
+		e.message(70, *r.DefaultValueMeta)
+	}
+	if r.MeaningWhenMissing != nil {
+		e.string(71, *r.MeaningWhenMissing)
+	}
+	if r.OrderMeaning != nil {
+		e.string(72, *r.OrderMeaning)
+	}
+	if r.FixedBase64Binary != nil {
+		e.string(73, *r.FixedBase64Binary)
+	}
+	if r.FixedBoolean != nil {
+		e.varint(74, protoBool(*r.FixedBoolean))
+	}
+	if r.FixedCanonical != nil {
+		e.string(75, *r.FixedCanonical)
+	}
+	if r.FixedCode != nil {
+		e.string(76, *r.FixedCode)
+	}
+	if r.FixedDate != nil {
+		e.string(77, *r.FixedDate)
+	}
+	if r.FixedDateTime != nil {
+		e.string(78, *r.FixedDateTime)
+	}
+	if r.FixedDecimal != nil {
+		e.string(79, string(*r.FixedDecimal))
+	}
+	if r.FixedId != nil {
+		e.string(80, *r.FixedId)
+	}
+	if r.FixedInstant != nil {
+		e.string(81, *r.FixedInstant)
+	}
+	if r.FixedInteger != nil {
+		e.varint(82, uint64(int64(*r.FixedInteger)))
+	}
+	if r.FixedMarkdown != nil {
+		e.string(83, *r.FixedMarkdown)
+	}
+	if r.FixedOid != nil {
+		e.string(84, *r.FixedOid)
+	}
+	if r.FixedPositiveInt != nil {
+		e.varint(85, uint64(int64(*r.FixedPositiveInt)))
+	}
+	if r.FixedString != nil {
+		e.string(86, *r.FixedString)
+	}
+	if r.FixedTime != nil {
+		e.string(87, *r.FixedTime)
+	}
+	if r.FixedUnsignedInt != nil {
+		e.varint(88, uint64(int64(*r.FixedUnsignedInt)))
+	}
+	if r.FixedUri != nil {
+		e.string(89, *r.FixedUri)
+	}
+	if r.FixedUrl != nil {
+		e.string(90, *r.FixedUrl)
+	}
+	if r.FixedUuid != nil {
+		e.string(91, *r.FixedUuid)
+	}
+	if r.FixedAddress != nil {
+		e.message(92, *r.FixedAddress)
+	}
+	if r.FixedAge != nil {
+		e.message(93, *r.FixedAge)
+	}
+	if r.FixedAnnotation != nil {
+		e.message(94, *r.FixedAnnotation)
+	}
+	if r.FixedAttachment != nil {
+		e.message(95, *r.FixedAttachment)
+	}
+	if r.FixedCodeableConcept != nil {
+		e.message(96, *r.FixedCodeableConcept)
+	}
+	if r.FixedCoding != nil {
+		e.message(97, *r.FixedCoding)
+	}
+	if r.FixedContactPoint != nil {
+		e.message(98, *r.FixedContactPoint)
+	}
+	if r.FixedCount != nil {
+		e.message(99, *r.FixedCount)
+	}
+	if r.FixedDistance != nil {
+		e.message(100, *r.FixedDistance)
+	}
+	if r.FixedDuration != nil {
+		e.message(101, *r.FixedDuration)
+	}
+	if r.FixedHumanName != nil {
+		e.message(102, *r.FixedHumanName)
+	}
+	if r.FixedIdentifier != nil {
+		e.message(103, *r.FixedIdentifier)
+	}
+	if r.FixedMoney != nil {
+		e.message(104, *r.FixedMoney)
+	}
+	if r.FixedPeriod != nil {
+		e.message(105, *r.FixedPeriod)
+	}
+	if r.FixedQuantity != nil {
+		e.message(106, *r.FixedQuantity)
+	}
+	if r.FixedRange != nil {
+		e.message(107, *r.FixedRange)
+	}
+	if r.FixedRatio != nil {
+		e.message(108, *r.FixedRatio)
+	}
+	if r.FixedReference != nil {
+		e.message(109, *r.FixedReference)
+	}
+	if r.FixedSampledData != nil {
+		e.message(110, *r.FixedSampledData)
+	}
+	if r.FixedSignature != nil {
+		e.message(111, *r.FixedSignature)
+	}
+	if r.FixedTiming != nil {
+		e.message(112, *r.FixedTiming)
+	}
+	if r.FixedContactDetail != nil {
+		e.message(113, *r.FixedContactDetail)
+	}
+	if r.FixedContributor != nil {
+		e.message(114, *r.FixedContributor)
+	}
+	if r.FixedDataRequirement != nil {
+		e.message(115, *r.FixedDataRequirement)
+	}
+	if r.FixedExpression != nil {
+		e.message(116, *r.FixedExpression)
+	}
+	if r.FixedParameterDefinition != nil {
+		e.message(117, *r.FixedParameterDefinition)
+	}
+	if r.FixedRelatedArtifact != nil {
+		e.message(118, *r.FixedRelatedArtifact)
+	}
+	if r.FixedTriggerDefinition != nil {
+		e.message(119, *r.FixedTriggerDefinition)
+	}
+	if r.FixedUsageContext != nil {
+		e.message(120, *r.FixedUsageContext)
+	}
+	if r.FixedDosage != nil {
+		e.message(121, *r.FixedDosage)
+	}
+	if r.FixedMeta != nil {
+		e.message(122, *r.FixedMeta)
+	}
+	if r.PatternBase64Binary != nil {
+		e.string(123, *r.PatternBase64Binary)
+	}
+	if r.PatternBoolean != nil {
+		e.varint(124, protoBool(*r.PatternBoolean))
+	}
+	if r.PatternCanonical != nil {
+		e.string(125, *r.PatternCanonical)
+	}
+	if r.PatternCode != nil {
+		e.string(126, *r.PatternCode)
+	}
+	if r.PatternDate != nil {
+		e.string(127, *r.PatternDate)
+	}
+	if r.PatternDateTime != nil {
+		e.string(128, *r.PatternDateTime)
+	}
+	if r.PatternDecimal != nil {
+		e.string(129, string(*r.PatternDecimal))
+	}
+	if r.PatternId != nil {
+		e.string(130, *r.PatternId)
+	}
+	if r.PatternInstant != nil {
+		e.string(131, *r.PatternInstant)
+	}
+	if r.PatternInteger != nil {
+		e.varint(132, uint64(int64(*r.PatternInteger)))
+	}
+	if r.PatternMarkdown != nil {
+		e.string(133, *r.PatternMarkdown)
+	}
+	if r.PatternOid != nil {
+		e.string(134, *r.PatternOid)
+	}
+	if r.PatternPositiveInt != nil {
+		e.varint(135, uint64(int64(*r.PatternPositiveInt)))
+	}
+	if r.PatternString != nil {
+		e.string(136, *r.PatternString)
+	}
+	if r.PatternTime != nil {
+		e.string(137, *r.PatternTime)
+	}
+	if r.PatternUnsignedInt != nil {
+		e.varint(138, uint64(int64(*r.PatternUnsignedInt)))
+	}
+	if r.PatternUri != nil {
+		e.string(139, *r.PatternUri)
+	}
+	if r.PatternUrl != nil {
+		e.string(140, *r.PatternUrl)
+	}
+	if r.PatternUuid != nil {
+		e.string(141, *r.PatternUuid)
+	}
+	if r.PatternAddress != nil {
+		e.message(142, *r.PatternAddress)
+	}
+	if r.PatternAge != nil {
+		e.message(143, *r.PatternAge)
+	}
+	if r.PatternAnnotation != nil {
+		e.message(144, *r.PatternAnnotation)
+	}
+	if r.PatternAttachment != nil {
+		e.message(145, *r.PatternAttachment)
+	}
+	if r.PatternCodeableConcept != nil {
+		e.message(146, *r.PatternCodeableConcept)
+	}
+	if r.PatternCoding != nil {
+		e.message(147, *r.PatternCoding)
+	}
+	if r.PatternContactPoint != nil {
+		e.message(148, *r.PatternContactPoint)
+	}
+	if r.PatternCount != nil {
+		e.message(149, *r.PatternCount)
+	}
+	if r.PatternDistance != nil {
+		e.message(150, *r.PatternDistance)
+	}
+	if r.PatternDuration != nil {
+		e.message(151, *r.PatternDuration)
+	}
+	if r.PatternHumanName != nil {
+		e.message(152, *r.PatternHumanName)
+	}
+	if r.PatternIdentifier != nil {
+		e.message(153, *r.PatternIdentifier)
+	}
+	if r.PatternMoney != nil {
+		e.message(154, *r.PatternMoney)
+	}
+	if r.PatternPeriod != nil {
+		e.message(155, *r.PatternPeriod)
+	}
+	if r.PatternQuantity != nil {
+		e.message(156, *r.PatternQuantity)
+	}
+	if r.PatternRange != nil {
+		e.message(157, *r.PatternRange)
+	}
+	if r.PatternRatio != nil {
+		e.message(158, *r.PatternRatio)
+	}
+	if r.PatternReference != nil {
+		e.message(159, *r.PatternReference)
+	}
+	if r.PatternSampledData != nil {
+		e.message(160, *r.PatternSampledData)
+	}
+	if r.PatternSignature != nil {
+		e.message(161, *r.PatternSignature)
+	}
+	if r.PatternTiming != nil {
+		e.message(162, *r.PatternTiming)
+	}
+	if r.PatternContactDetail != nil {
+		e.message(163, *r.PatternContactDetail)
+	}
+	if r.PatternContributor != nil {
+		e.message(164, *r.PatternContributor)
+	}
+	if r.PatternDataRequirement != nil {
+		e.message(165, *r.PatternDataRequirement)
+	}
+	if r.PatternExpression != nil {
+		e.message(166, *r.PatternExpression)
+	}
+	if r.PatternParameterDefinition != nil {
+		e.message(167, *r.PatternParameterDefinition)
+	}
+	if r.PatternRelatedArtifact != nil {
+		e.message(168, *r.PatternRelatedArtifact)
+	}
+	if r.PatternTriggerDefinition != nil {
+		e.message(169, *r.PatternTriggerDefinition)
+	}
+	if r.PatternUsageContext != nil {
+		e.message(170, *r.PatternUsageContext)
+	}
+	if r.PatternDosage != nil {
+		e.message(171, *r.PatternDosage)
+	}
+	if r.PatternMeta != nil {
+		e.message(172, *r.PatternMeta)
+	}
+	for _, v := range r.Example {
+		e.message(173, v)
+	}
+	if r.MinValueDate != nil {
+		e.string(174, *r.MinValueDate)
+	}
+	if r.MinValueDateTime != nil {
+		e.string(175, *r.MinValueDateTime)
+	}
+	if r.MinValueInstant != nil {
+		e.string(176, *r.MinValueInstant)
+	}
+	if r.MinValueTime != nil {
+		e.string(177, *r.MinValueTime)
+	}
+	if r.MinValueDecimal != nil {
+		e.string(178, string(*r.MinValueDecimal))
+	}
+	if r.MinValueInteger != nil {
+		e.varint(179, uint64(int64(*r.MinValueInteger)))
+	}
+	if r.MinValuePositiveInt != nil {
+		e.varint(180, uint64(int64(*r.MinValuePositiveInt)))
+	}
+	if r.MinValueUnsignedInt != nil {
+		e.varint(181, uint64(int64(*r.MinValueUnsignedInt)))
+	}
+	if r.MinValueQuantity != nil {
+		e.message(182, *r.MinValueQuantity)
+	}
+	if r.MaxValueDate != nil {
+		e.string(183, *r.MaxValueDate)
+	}
+	if r.MaxValueDateTime != nil {
+		e.string(184, *r.MaxValueDateTime)
+	}
+	if r.MaxValueInstant != nil {
+		e.string(185, *r.MaxValueInstant)
+	}
+	if r.MaxValueTime != nil {
+		e.string(186, *r.MaxValueTime)
+	}
+	if r.MaxValueDecimal != nil {
+		e.string(187, string(*r.MaxValueDecimal))
+	}
+	if r.MaxValueInteger != nil {
+		e.varint(188, uint64(int64(*r.MaxValueInteger)))
+	}
+	if r.MaxValuePositiveInt != nil {
+		e.varint(189, uint64(int64(*r.MaxValuePositiveInt)))
+	}
+	if r.MaxValueUnsignedInt != nil {
+		e.varint(190, uint64(int64(*r.MaxValueUnsignedInt)))
+	}
+	if r.MaxValueQuantity != nil {
+		e.message(191, *r.MaxValueQuantity)
+	}
+	if r.MaxLength != nil {
+		e.varint(192, uint64(int64(*r.MaxLength)))
+	}
+	for _, v := range r.Condition {
+		e.string(193, v)
+	}
+	for _, v := range r.Constraint {
+		e.message(194, v)
+	}
+	if r.MustSupport != nil {
+		e.varint(195, protoBool(*r.MustSupport))
+	}
+	if r.IsModifier != nil {
+		e.varint(196, protoBool(*r.IsModifier))
+	}
+	if r.IsModifierReason != nil {
+		e.string(197, *r.IsModifierReason)
+	}
+	if r.IsSummary != nil {
+		e.varint(198, protoBool(*r.IsSummary))
+	}
+	if r.Binding != nil {
+		e.message(199, *r.Binding)
+	}
+	for _, v := range r.Mapping {
+		e.message(200, v)
+	}
+	if r.PathElement != nil {
+		e.message(201, *r.PathElement)
+	}
+	for _, v := range r.RepresentationElement {
+		e.element(202, v)
+	}
+	if r.SliceNameElement != nil {
+		e.message(203, *r.SliceNameElement)
+	}
+	if r.SliceIsConstrainingElement != nil {
+		e.message(204, *r.SliceIsConstrainingElement)
+	}
+	if r.LabelElement != nil {
+		e.message(205, *r.LabelElement)
+	}
+	if r.ShortElement != nil {
+		e.message(206, *r.ShortElement)
+	}
+	if r.DefinitionElement != nil {
+		e.message(207, *r.DefinitionElement)
+	}
+	if r.CommentElement != nil {
+		e.message(208, *r.CommentElement)
+	}
+	if r.RequirementsElement != nil {
+		e.message(209, *r.RequirementsElement)
+	}
+	for _, v := range r.AliasElement {
+		e.element(210, v)
+	}
+	if r.MinElement != nil {
+		e.message(211, *r.MinElement)
+	}
+	if r.MaxElement != nil {
+		e.message(212, *r.MaxElement)
+	}
+	if r.ContentReferenceElement != nil {
+		e.message(213, *r.ContentReferenceElement)
+	}
+	if r.DefaultValueBase64BinaryElement != nil {
+		e.message(214, *r.DefaultValueBase64BinaryElement)
+	}
+	if r.DefaultValueBooleanElement != nil {
+		e.message(215, *r.DefaultValueBooleanElement)
+	}
+	if r.DefaultValueCanonicalElement != nil {
+		e.message(216, *r.DefaultValueCanonicalElement)
+	}
+	if r.DefaultValueCodeElement != nil {
+		e.message(217, *r.DefaultValueCodeElement)
+	}
+	if r.DefaultValueDateElement != nil {
+		e.message(218, *r.DefaultValueDateElement)
+	}
+	if r.DefaultValueDateTimeElement != nil {
+		e.message(219, *r.DefaultValueDateTimeElement)
+	}
+	if r.DefaultValueDecimalElement != nil {
+		e.message(220, *r.DefaultValueDecimalElement)
+	}
+	if r.DefaultValueIdElement != nil {
+		e.message(221, *r.DefaultValueIdElement)
+	}
+	if r.DefaultValueInstantElement != nil {
+		e.message(222, *r.DefaultValueInstantElement)
+	}
+	if r.DefaultValueIntegerElement != nil {
+		e.message(223, *r.DefaultValueIntegerElement)
+	}
+	if r.DefaultValueMarkdownElement != nil {
+		e.message(224, *r.DefaultValueMarkdownElement)
+	}
+	if r.DefaultValueOidElement != nil {
+		e.message(225, *r.DefaultValueOidElement)
+	}
+	if r.DefaultValuePositiveIntElement != nil {
+		e.message(226, *r.DefaultValuePositiveIntElement)
+	}
+	if r.DefaultValueStringElement != nil {
+		e.message(227, *r.DefaultValueStringElement)
+	}
+	if r.DefaultValueTimeElement != nil {
+		e.message(228, *r.DefaultValueTimeElement)
+	}
+	if r.DefaultValueUnsignedIntElement != nil {
+		e.message(229, *r.DefaultValueUnsignedIntElement)
+	}
+	if r.DefaultValueUriElement != nil {
+		e.message(230, *r.DefaultValueUriElement)
+	}
+	if r.DefaultValueUrlElement != nil {
+		e.message(231, *r.DefaultValueUrlElement)
+	}
+	if r.DefaultValueUuidElement != nil {
+		e.message(232, *r.DefaultValueUuidElement)
+	}
+	if r.MeaningWhenMissingElement != nil {
+		e.message(233, *r.MeaningWhenMissingElement)
+	}
+	if r.OrderMeaningElement != nil {
+		e.message(234, *r.OrderMeaningElement)
+	}
+	if r.FixedBase64BinaryElement != nil {
+		e.message(235, *r.FixedBase64BinaryElement)
+	}
+	if r.FixedBooleanElement != nil {
+		e.message(236, *r.FixedBooleanElement)
+	}
+	if r.FixedCanonicalElement != nil {
+		e.message(237, *r.FixedCanonicalElement)
+	}
+	if r.FixedCodeElement != nil {
+		e.message(238, *r.FixedCodeElement)
+	}
+	if r.FixedDateElement != nil {
+		e.message(239, *r.FixedDateElement)
+	}
+	if r.FixedDateTimeElement != nil {
+		e.message(240, *r.FixedDateTimeElement)
+	}
+	if r.FixedDecimalElement != nil {
+		e.message(241, *r.FixedDecimalElement)
+	}
+	if r.FixedIdElement != nil {
+		e.message(242, *r.FixedIdElement)
+	}
+	if r.FixedInstantElement != nil {
+		e.message(243, *r.FixedInstantElement)
+	}
+	if r.FixedIntegerElement != nil {
+		e.message(244, *r.FixedIntegerElement)
+	}
+	if r.FixedMarkdownElement != nil {
+		e.message(245, *r.FixedMarkdownElement)
+	}
+	if r.FixedOidElement != nil {
+		e.message(246, *r.FixedOidElement)
+	}
+	if r.FixedPositiveIntElement != nil {
+		e.message(247, *r.FixedPositiveIntElement)
+	}
+	if r.FixedStringElement != nil {
+		e.message(248, *r.FixedStringElement)
+	}
+	if r.FixedTimeElement != nil {
+		e.message(249, *r.FixedTimeElement)
+	}
+	if r.FixedUnsignedIntElement != nil {
+		e.message(250, *r.FixedUnsignedIntElement)
+	}
+	if r.FixedUriElement != nil {
+		e.message(251, *r.FixedUriElement)
+	}
+	if r.FixedUrlElement != nil {
+		e.message(252, *r.FixedUrlElement)
+	}
+	if r.FixedUuidElement != nil {
+		e.message(253, *r.FixedUuidElement)
+	}
+	if r.PatternBase64BinaryElement != nil {
+		e.message(254, *r.PatternBase64BinaryElement)
+	}
+	if r.PatternBooleanElement != nil {
+		e.message(255, *r.PatternBooleanElement)
+	}
+	if r.PatternCanonicalElement != nil {
+		e.message(256, *r.PatternCanonicalElement)
+	}
+	if r.PatternCodeElement != nil {
+		e.message(257, *r.PatternCodeElement)
+	}
+	if r.PatternDateElement != nil {
+		e.message(258, *r.PatternDateElement)
+	}
+	if r.PatternDateTimeElement != nil {
+		e.message(259, *r.PatternDateTimeElement)
+	}
+	if r.PatternDecimalElement != nil {
+		e.message(260, *r.PatternDecimalElement)
+	}
+	if r.PatternIdElement != nil {
+		e.message(261, *r.PatternIdElement)
+	}
+	if r.PatternInstantElement != nil {
+		e.message(262, *r.PatternInstantElement)
+	}
+	if r.PatternIntegerElement != nil {
+		e.message(263, *r.PatternIntegerElement)
+	}
+	if r.PatternMarkdownElement != nil {
+		e.message(264, *r.PatternMarkdownElement)
+	}
+	if r.PatternOidElement != nil {
+		e.message(265, *r.PatternOidElement)
+	}
+	if r.PatternPositiveIntElement != nil {
+		e.message(266, *r.PatternPositiveIntElement)
+	}
+	if r.PatternStringElement != nil {
+		e.message(267, *r.PatternStringElement)
+	}
+	if r.PatternTimeElement != nil {
+		e.message(268, *r.PatternTimeElement)
+	}
+	if r.PatternUnsignedIntElement != nil {
+		e.message(269, *r.PatternUnsignedIntElement)
+	}
+	if r.PatternUriElement != nil {
+		e.message(270, *r.PatternUriElement)
+	}
+	if r.PatternUrlElement != nil {
+		e.message(271, *r.PatternUrlElement)
+	}
+	if r.PatternUuidElement != nil {
+		e.message(272, *r.PatternUuidElement)
+	}
+	if r.MinValueDateElement != nil {
+		e.message(273, *r.MinValueDateElement)
+	}
+	if r.MinValueDateTimeElement != nil {
+		e.message(274, *r.MinValueDateTimeElement)
+	}
+	if r.MinValueInstantElement != nil {
+		e.message(275, *r.MinValueInstantElement)
+	}
+	if r.MinValueTimeElement != nil {
+		e.message(276, *r.MinValueTimeElement)
+	}
+	if r.MinValueDecimalElement != nil {
+		e.message(277, *r.MinValueDecimalElement)
+	}
+	if r.MinValueIntegerElement != nil {
+		e.message(278, *r.MinValueIntegerElement)
+	}
+	if r.MinValuePositiveIntElement != nil {
+		e.message(279, *r.MinValuePositiveIntElement)
+	}
+	if r.MinValueUnsignedIntElement != nil {
+		e.message(280, *r.MinValueUnsignedIntElement)
+	}
+	if r.MaxValueDateElement != nil {
+		e.message(281, *r.MaxValueDateElement)
+	}
+	if r.MaxValueDateTimeElement != nil {
+		e.message(282, *r.MaxValueDateTimeElement)
+	}
+	if r.MaxValueInstantElement != nil {
+		e.message(283, *r.MaxValueInstantElement)
+	}
+	if r.MaxValueTimeElement != nil {
+		e.message(284, *r.MaxValueTimeElement)
+	}
+	if r.MaxValueDecimalElement != nil {
+		e.message(285, *r.MaxValueDecimalElement)
+	}
+	if r.MaxValueIntegerElement != nil {
+		e.message(286, *r.MaxValueIntegerElement)
+	}
+	if r.MaxValuePositiveIntElement != nil {
+		e.message(287, *r.MaxValuePositiveIntElement)
+	}
+	if r.MaxValueUnsignedIntElement != nil {
+		e.message(288, *r.MaxValueUnsignedIntElement)
+	}
+	if r.MaxLengthElement != nil {
+		e.message(289, *r.MaxLengthElement)
+	}
+	for _, v := range r.ConditionElement {
+		e.element(290, v)
+	}
+	if r.MustSupportElement != nil {
+		e.message(291, *r.MustSupportElement)
+	}
+	if r.IsModifierElement != nil {
+		e.message(292, *r.IsModifierElement)
+	}
+	if r.IsModifierReasonElement != nil {
+		e.message(293, *r.IsModifierReasonElement)
+	}
+	if r.IsSummaryElement != nil {
+		e.message(294, *r.IsSummaryElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ElementDefinition from protobuf message ElementDefinition of fhir.proto
+func (r *ElementDefinition) UnmarshalProto(b []byte) error {
+	*r = ElementDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Path = d.string()
+		case 5:
+			for _, n := range d.varints() {
+				var v PropertyRepresentation
+				d.enum(n, v.setProtoNumber)
+				r.Representation = append(r.Representation, v)
+			}
+		case 6:
+			v := d.string()
+			r.SliceName = &v
+		case 7:
+			v := d.bool()
+			r.SliceIsConstraining = &v
+		case 8:
+			v := d.string()
+			r.Label = &v
+		case 9:
+			var v Coding
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 10:
+			var v ElementDefinitionSlicing
+			d.message(&v)
+			r.Slicing = &v
+		case 11:
+			v := d.string()
+			r.Short = &v
+		case 12:
+			v := d.string()
+			r.Definition = &v
+		case 13:
+			v := d.string()
+			r.Comment = &v
+		case 14:
+			v := d.string()
+			r.Requirements = &v
+		case 15:
+			r.Alias = append(r.Alias, d.string())
+		case 16:
+			v := d.int()
+			r.Min = &v
+		case 17:
+			v := d.string()
+			r.Max = &v
+		case 18:
+			var v ElementDefinitionBase
+			d.message(&v)
+			r.Base = &v
+		case 19:
+			v := d.string()
+			r.ContentReference = &v
+		case 20:
+			var v ElementDefinitionType
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 21:
+			v := d.string()
+			r.DefaultValueBase64Binary = &v
+		case 22:
+			v := d.bool()
+			r.DefaultValueBoolean = &v
+		case 23:
+			v := d.string()
+			r.DefaultValueCanonical = &v
+		case 24:
+			v := d.string()
+			r.DefaultValueCode = &v
+		case 25:
+			v := d.string()
+			r.DefaultValueDate = &v
+		case 26:
+			v := d.string()
+			r.DefaultValueDateTime = &v
+		case 27:
+			v := json.Number(d.string())
+			r.DefaultValueDecimal = &v
+		case 28:
+			v := d.string()
+			r.DefaultValueId = &v
+		case 29:
+			v := d.string()
+			r.DefaultValueInstant = &v
+		case 30:
+			v := d.int()
+			r.DefaultValueInteger = &v
+		case 31:
+			v := d.string()
+			r.DefaultValueMarkdown = &v
+		case 32:
+			v := d.string()
+			r.DefaultValueOid = &v
+		case 33:
+			v := d.int()
+			r.DefaultValuePositiveInt = &v
+		case 34:
+			v := d.string()
+			r.DefaultValueString = &v
+		case 35:
+			v := d.string()
+			r.DefaultValueTime = &v
+		case 36:
+			v := d.int()
+			r.DefaultValueUnsignedInt = &v
+		case 37:
+			v := d.string()
+			r.DefaultValueUri = &v
+		case 38:
+			v := d.string()
+			r.DefaultValueUrl = &v
+		case 39:
+			v := d.string()
+			r.DefaultValueUuid = &v
+		case 40:
+			var v Address
+			d.message(&v)
+			r.DefaultValueAddress = &v
+		case 41:
+			var v Age
+			d.message(&v)
+			r.DefaultValueAge = &v
+		case 42:
+			var v Annotation
+			d.message(&v)
+			r.DefaultValueAnnotation = &v
+		case 43:
+			var v Attachment
+			d.message(&v)
+			r.DefaultValueAttachment = &v
+		case 44:
+			var v CodeableConcept
+			d.message(&v)
+			r.DefaultValueCodeableConcept = &v
+		case 45:
+			var v Coding
+			d.message(&v)
+			r.DefaultValueCoding = &v
+		case 46:
+			var v ContactPoint
+			d.message(&v)
+			r.DefaultValueContactPoint = &v
+		case 47:
+			var v Count
+			d.message(&v)
+			r.DefaultValueCount = &v
+		case 48:
+			var v Distance
+			d.message(&v)
+			r.DefaultValueDistance = &v
+		case 49:
+			var v Duration
+			d.message(&v)
+			r.DefaultValueDuration = &v
+		case 50:
+			var v HumanName
+			d.message(&v)
+			r.DefaultValueHumanName = &v
+		case 51:
+			var v Identifier
+			d.message(&v)
+			r.DefaultValueIdentifier = &v
+		case 52:
+			var v Money
+			d.message(&v)
+			r.DefaultValueMoney = &v
+		case 53:
+			var v Period
+			d.message(&v)
+			r.DefaultValuePeriod = &v
+		case 54:
+			var v Quantity
+			d.message(&v)
+			r.DefaultValueQuantity = &v
+		case 55:
+			var v Range
+			d.message(&v)
+			r.DefaultValueRange = &v
+		case 56:
+			var v Ratio
+			d.message(&v)
+			r.DefaultValueRatio = &v
+		case 57:
+			var v Reference
+			d.message(&v)
+			r.DefaultValueReference = &v
+		case 58:
+			var v SampledData
+			d.message(&v)
+			r.DefaultValueSampledData = &v
+		case 59:
+			var v Signature
+			d.message(&v)
+			r.DefaultValueSignature = &v
+		case 60:
+			var v Timing
+			d.message(&v)
+			r.DefaultValueTiming = &v
+		case 61:
+			var v ContactDetail
+			d.message(&v)
+			r.DefaultValueContactDetail = &v
+		case 62:
+			var v Contributor
+			d.message(&v)
+			r.DefaultValueContributor = &v
+		case 63:
+			var v DataRequirement
+			d.message(&v)
+			r.DefaultValueDataRequirement = &v
+		case 64:
+			var v Expression
+			d.message(&v)
+			r.DefaultValueExpression = &v
+		case 65:
+			var v ParameterDefinition
+			d.message(&v)
+			r.DefaultValueParameterDefinition = &v
+		case 66:
+			var v RelatedArtifact
+			d.message(&v)
+			r.DefaultValueRelatedArtifact = &v
+		case 67:
+			var v TriggerDefinition
+			d.message(&v)
+			r.DefaultValueTriggerDefinition = &v
+		case 68:
+			var v UsageContext
+			d.message(&v)
+			r.DefaultValueUsageContext = &v
+		case 69:
+			var v Dosage
+			d.message(&v)
+			r.DefaultValueDosage = &v
+		case 70:
+			var v Meta
+			d.message(&v)
+			r.DefaultValueMeta = &v
+		case 71:
+			v := d.string()
+			r.MeaningWhenMissing = &v
+		case 72:
+			v := d.string()
+			r.OrderMeaning = &v
+		case 73:
+			v := d.string()
+			r.FixedBase64Binary = &v
+		case 74:
+			v := d.bool()
+			r.FixedBoolean = &v
+		case 75:
+			v := d.string()
+			r.FixedCanonical = &v
+		case 76:
+			v := d.string()
+			r.FixedCode = &v
+		case 77:
+			v := d.string()
+			r.FixedDate = &v
+		case 78:
+			v := d.string()
+			r.FixedDateTime = &v
+		case 79:
+			v := json.Number(d.string())
+			r.FixedDecimal = &v
+		case 80:
+			v := d.string()
+			r.FixedId = &v
+		case 81:
+			v := d.string()
+			r.FixedInstant = &v
+		case 82:
+			v := d.int()
+			r.FixedInteger = &v
+		case 83:
+			v := d.string()
+			r.FixedMarkdown = &v
+		case 84:
+			v := d.string()
+			r.FixedOid = &v
+		case 85:
+			v := d.int()
+			r.FixedPositiveInt = &v
+		case 86:
+			v := d.string()
+			r.FixedString = &v
+		case 87:
+			v := d.string()
+			r.FixedTime = &v
+		case 88:
+			v := d.int()
+			r.FixedUnsignedInt = &v
+		case 89:
+			v := d.string()
+			r.FixedUri = &v
+		case 90:
+			v := d.string()
+			r.FixedUrl = &v
+		case 91:
+			v := d.string()
+			r.FixedUuid = &v
+		case 92:
+			var v Address
+			d.message(&v)
+			r.FixedAddress = &v
+		case 93:
+			var v Age
+			d.message(&v)
+			r.FixedAge = &v
+		case 94:
+			var v Annotation
+			d.message(&v)
+			r.FixedAnnotation = &v
+		case 95:
+			var v Attachment
+			d.message(&v)
+			r.FixedAttachment = &v
+		case 96:
+			var v CodeableConcept
+			d.message(&v)
+			r.FixedCodeableConcept = &v
+		case 97:
+			var v Coding
+			d.message(&v)
+			r.FixedCoding = &v
+		case 98:
+			var v ContactPoint
+			d.message(&v)
+			r.FixedContactPoint = &v
+		case 99:
+			var v Count
+			d.message(&v)
+			r.FixedCount = &v
+		case 100:
+			var v Distance
+			d.message(&v)
+			r.FixedDistance = &v
+		case 101:
+			var v Duration
+			d.message(&v)
+			r.FixedDuration = &v
+		case 102:
+			var v HumanName
+			d.message(&v)
+			r.FixedHumanName = &v
+		case 103:
+			var v Identifier
+			d.message(&v)
+			r.FixedIdentifier = &v
+		case 104:
+			var v Money
+			d.message(&v)
+			r.FixedMoney = &v
+		case 105:
+			var v Period
+			d.message(&v)
+			r.FixedPeriod = &v
+		case 106:
+			var v Quantity
+			d.message(&v)
+			r.FixedQuantity = &v
+		case 107:
+			var v Range
+			d.message(&v)
+			r.FixedRange = &v
+		case 108:
+			var v Ratio
+			d.message(&v)
+			r.FixedRatio = &v
+		case 109:
+			var v Reference
+			d.message(&v)
+			r.FixedReference = &v
+		case 110:
+			var v SampledData
+			d.message(&v)
+			r.FixedSampledData = &v
+		case 111:
+			var v Signature
+			d.message(&v)
+			r.FixedSignature = &v
+		case 112:
+			var v Timing
+			d.message(&v)
+			r.FixedTiming = &v
+		case 113:
+			var v ContactDetail
+			d.message(&v)
+			r.FixedContactDetail = &v
+		case 114:
+			var v Contributor
+			d.message(&v)
+			r.FixedContributor = &v
+		case 115:
+			var v DataRequirement
+			d.message(&v)
+			r.FixedDataRequirement = &v
+		case 116:
+			var v Expression
+			d.message(&v)
+			r.FixedExpression = &v
+		case 117:
+			var v ParameterDefinition
+			d.message(&v)
+			r.FixedParameterDefinition = &v
+		case 118:
+			var v RelatedArtifact
+			d.message(&v)
+			r.FixedRelatedArtifact = &v
+		case 119:
+			var v TriggerDefinition
+			d.message(&v)
+			r.FixedTriggerDefinition = &v
+		case 120:
+			var v UsageContext
+			d.message(&v)
+			r.FixedUsageContext = &v
+		case 121:
+			var v Dosage
+			d.message(&v)
+			r.FixedDosage = &v
+		case 122:
+			var v Meta
+			d.message(&v)
+			r.FixedMeta = &v
+		case 123:
+			v := d.string()
+			r.PatternBase64Binary = &v
+		case 124:
+			v := d.bool()
+			r.PatternBoolean = &v
+		case 125:
+			v := d.string()
+			r.PatternCanonical = &v
+		case 126:
+			v := d.string()
+			r.PatternCode = &v
+		case 127:
+			v := d.string()
+			r.PatternDate = &v
+		case 128:
+			v := d.string()
+			r.PatternDateTime = &v
+		case 129:
+			v := json.Number(d.string())
+			r.PatternDecimal = &v
+		case 130:
+			v := d.string()
+			r.PatternId = &v
+		case 131:
+			v := d.string()
+			r.PatternInstant = &v
+		case 132:
+			v := d.int()
+			r.PatternInteger = &v
+		case 133:
+			v := d.string()
+			r.PatternMarkdown = &v
+		case 134:
+			v := d.string()
+			r.PatternOid = &v
+		case 135:
+			v := d.int()
+			r.PatternPositiveInt = &v
+		case 136:
+			v := d.string()
+			r.PatternString = &v
+		case 137:
+			v := d.string()
+			r.PatternTime = &v
+		case 138:
+			v := d.int()
+			r.PatternUnsignedInt = &v
+		case 139:
+			v := d.string()
+			r.PatternUri = &v
+		case 140:
+			v := d.string()
+			r.PatternUrl = &v
+		case 141:
+			v := d.string()
+			r.PatternUuid = &v
+		case 142:
+			var v Address
+			d.message(&v)
+			r.PatternAddress = &v
+		case 143:
+			var v Age
+			d.message(&v)
+			r.PatternAge = &v
+		case 144:
+			var v Annotation
+			d.message(&v)
+			r.PatternAnnotation = &v
+		case 145:
+			var v Attachment
+			d.message(&v)
+			r.PatternAttachment = &v
+		case 146:
+			var v CodeableConcept
+			d.message(&v)
+			r.PatternCodeableConcept = &v
+		case 147:
+			var v Coding
+			d.message(&v)
+			r.PatternCoding = &v
+		case 148:
+			var v ContactPoint
+			d.message(&v)
+			r.PatternContactPoint = &v
+		case 149:
+			var v Count
+			d.message(&v)
+			r.PatternCount = &v
+		case 150:
+			var v Distance
+			d.message(&v)
+			r.PatternDistance = &v
+		case 151:
+			var v Duration
+			d.message(&v)
+			r.PatternDuration = &v
+		case 152:
+			var v HumanName
+			d.message(&v)
+			r.PatternHumanName = &v
+		case 153:
+			var v Identifier
+			d.message(&v)
+			r.PatternIdentifier = &v
+		case 154:
+			var v Money
+			d.message(&v)
+			r.PatternMoney = &v
+		case 155:
+			var v Period
+			d.message(&v)
+			r.PatternPeriod = &v
+		case 156:
+			var v Quantity
+			d.message(&v)
+			r.PatternQuantity = &v
+		case 157:
+			var v Range
+			d.message(&v)
+			r.PatternRange = &v
+		case 158:
+			var v Ratio
+			d.message(&v)
+			r.PatternRatio = &v
+		case 159:
+			var v Reference
+			d.message(&v)
+			r.PatternReference = &v
+		case 160:
+			var v SampledData
+			d.message(&v)
+			r.PatternSampledData = &v
+		case 161:
+			var v Signature
+			d.message(&v)
+			r.PatternSignature = &v
+		case 162:
+			var v Timing
+			d.message(&v)
+			r.PatternTiming = &v
+		case 163:
+			var v ContactDetail
+			d.message(&v)
+			r.PatternContactDetail = &v
+		case 164:
+			var v Contributor
+			d.message(&v)
+			r.PatternContributor = &v
+		case 165:
+			var v DataRequirement
+			d.message(&v)
+			r.PatternDataRequirement = &v
+		case 166:
+			var v Expression
+			d.message(&v)
+			r.PatternExpression = &v
+		case 167:
+			var v ParameterDefinition
+			d.message(&v)
+			r.PatternParameterDefinition = &v
+		case 168:
+			var v RelatedArtifact
+			d.message(&v)
+			r.PatternRelatedArtifact = &v
+		case 169:
+			var v TriggerDefinition
+			d.message(&v)
+			r.PatternTriggerDefinition = &v
+		case 170:
+			var v UsageContext
+			d.message(&v)
+			r.PatternUsageContext = &v
+		case 171:
+			var v Dosage
+			d.message(&v)
+			r.PatternDosage = &v
+		case 172:
+			var v Meta
+			d.message(&v)
+			r.PatternMeta = &v
+		case 173:
+			var v ElementDefinitionExample
+			d.message(&v)
+			r.Example = append(r.Example, v)
+		case 174:
+			v := d.string()
+			r.MinValueDate = &v
+		case 175:
+			v := d.string()
+			r.MinValueDateTime = &v
+		case 176:
+			v := d.string()
+			r.MinValueInstant = &v
+		case 177:
+			v := d.string()
+			r.MinValueTime = &v
+		case 178:
+			v := json.Number(d.string())
+			r.MinValueDecimal = &v
+		case 179:
+			v := d.int()
+			r.MinValueInteger = &v
+		case 180:
+			v := d.int()
+			r.MinValuePositiveInt = &v
+		case 181:
+			v := d.int()
+			r.MinValueUnsignedInt = &v
+		case 182:
+			var v Quantity
+			d.message(&v)
+			r.MinValueQuantity = &v
+		case 183:
+			v := d.string()
+			r.MaxValueDate = &v
+		case 184:
+			v := d.string()
+			r.MaxValueDateTime = &v
+		case 185:
+			v := d.string()
+			r.MaxValueInstant = &v
+		case 186:
+			v := d.string()
+			r.MaxValueTime = &v
+		case 187:
+			v := json.Number(d.string())
+			r.MaxValueDecimal = &v
+		case 188:
+			v := d.int()
+			r.MaxValueInteger = &v
+		case 189:
+			v := d.int()
+			r.MaxValuePositiveInt = &v
+		case 190:
+			v := d.int()
+			r.MaxValueUnsignedInt = &v
+		case 191:
+			var v Quantity
+			d.message(&v)
+			r.MaxValueQuantity = &v
+		case 192:
+			v := d.int()
+			r.MaxLength = &v
+		case 193:
+			r.Condition = append(r.Condition, d.string())
+		case 194:
+			var v ElementDefinitionConstraint
+			d.message(&v)
+			r.Constraint = append(r.Constraint, v)
+		case 195:
+			v := d.bool()
+			r.MustSupport = &v
+		case 196:
+			v := d.bool()
+			r.IsModifier = &v
+		case 197:
+			v := d.string()
+			r.IsModifierReason = &v
+		case 198:
+			v := d.bool()
+			r.IsSummary = &v
+		case 199:
+			var v ElementDefinitionBinding
+			d.message(&v)
+			r.Binding = &v
+		case 200:
+			var v ElementDefinitionMapping
+			d.message(&v)
+			r.Mapping = append(r.Mapping, v)
+		case 201:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		case 202:
+			r.RepresentationElement = append(r.RepresentationElement, d.element())
+		case 203:
+			var v Element
+			d.message(&v)
+			r.SliceNameElement = &v
+		case 204:
+			var v Element
+			d.message(&v)
+			r.SliceIsConstrainingElement = &v
+		case 205:
+			var v Element
+			d.message(&v)
+			r.LabelElement = &v
+		case 206:
+			var v Element
+			d.message(&v)
+			r.ShortElement = &v
+		case 207:
+			var v Element
+			d.message(&v)
+			r.DefinitionElement = &v
+		case 208:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		case 209:
+			var v Element
+			d.message(&v)
+			r.RequirementsElement = &v
+		case 210:
+			r.AliasElement = append(r.AliasElement, d.element())
+		case 211:
+			var v Element
+			d.message(&v)
+			r.MinElement = &v
+		case 212:
+			var v Element
+			d.message(&v)
+			r.MaxElement = &v
+		case 213:
+			var v Element
+			d.message(&v)
+			r.ContentReferenceElement = &v
+		case 214:
+			var v Element
+			d.message(&v)
+			r.DefaultValueBase64BinaryElement = &v
+		case 215:
+			var v Element
+			d.message(&v)
+			r.DefaultValueBooleanElement = &v
+		case 216:
+			var v Element
+			d.message(&v)
+			r.DefaultValueCanonicalElement = &v
+		case 217:
+			var v Element
+			d.message(&v)
+			r.DefaultValueCodeElement = &v
+		case 218:
+			var v Element
+			d.message(&v)
+			r.DefaultValueDateElement = &v
+		case 219:
+			var v Element
+			d.message(&v)
+			r.DefaultValueDateTimeElement = &v
+		case 220:
+			var v Element
+			d.message(&v)
+			r.DefaultValueDecimalElement = &v
+		case 221:
+			var v Element
+			d.message(&v)
+			r.DefaultValueIdElement = &v
+		case 222:
+			var v Element
+			d.message(&v)
+			r.DefaultValueInstantElement = &v
+		case 223:
+			var v Element
+			d.message(&v)
+			r.DefaultValueIntegerElement = &v
+		case 224:
+			var v Element
+			d.message(&v)
+			r.DefaultValueMarkdownElement = &v
+		case 225:
+			var v Element
+			d.message(&v)
+			r.DefaultValueOidElement = &v
+		case 226:
+			var v Element
+			d.message(&v)
+			r.DefaultValuePositiveIntElement = &v
+		case 227:
+			var v Element
+			d.message(&v)
+			r.DefaultValueStringElement = &v
+		case 228:
+			var v Element
+			d.message(&v)
+			r.DefaultValueTimeElement = &v
+		case 229:
+			var v Element
+			d.message(&v)
+			r.DefaultValueUnsignedIntElement = &v
+		case 230:
+			var v Element
+			d.message(&v)
+			r.DefaultValueUriElement = &v
+		case 231:
+			var v Element
+			d.message(&v)
+			r.DefaultValueUrlElement = &v
+		case 232:
+			var v Element
+			d.message(&v)
+			r.DefaultValueUuidElement = &v
+		case 233:
+			var v Element
+			d.message(&v)
+			r.MeaningWhenMissingElement = &v
+		case 234:
+			var v Element
+			d.message(&v)
+			r.OrderMeaningElement = &v
+		case 235:
+			var v Element
+			d.message(&v)
+			r.FixedBase64BinaryElement = &v
+		case 236:
+			var v Element
+			d.message(&v)
+			r.FixedBooleanElement = &v
+		case 237:
+			var v Element
+			d.message(&v)
+			r.FixedCanonicalElement = &v
+		case 238:
+			var v Element
+			d.message(&v)
+			r.FixedCodeElement = &v
+		case 239:
+			var v Element
+			d.message(&v)
+			r.FixedDateElement = &v
+		case 240:
+			var v Element
+			d.message(&v)
+			r.FixedDateTimeElement = &v
+		case 241:
+			var v Element
+			d.message(&v)
+			r.FixedDecimalElement = &v
+		case 242:
+			var v Element
+			d.message(&v)
+			r.FixedIdElement = &v
+		case 243:
+			var v Element
+			d.message(&v)
+			r.FixedInstantElement = &v
+		case 244:
+			var v Element
+			d.message(&v)
+			r.FixedIntegerElement = &v
+		case 245:
+			var v Element
+			d.message(&v)
+			r.FixedMarkdownElement = &v
+		case 246:
+			var v Element
+			d.message(&v)
+			r.FixedOidElement = &v
+		case 247:
+			var v Element
+			d.message(&v)
+			r.FixedPositiveIntElement = &v
+		case 248:
+			var v Element
+			d.message(&v)
+			r.FixedStringElement = &v
+		case 249:
+			var v Element
+			d.message(&v)
+			r.FixedTimeElement = &v
+		case 250:
+			var v Element
+			d.message(&v)
+			r.FixedUnsignedIntElement = &v
+		case 251:
+			var v Element
+			d.message(&v)
+			r.FixedUriElement = &v
+		case 252:
+			var v Element
+			d.message(&v)
+			r.FixedUrlElement = &v
+		case 253:
+			var v Element
+			d.message(&v)
+			r.FixedUuidElement = &v
+		case 254:
+			var v Element
+			d.message(&v)
+			r.PatternBase64BinaryElement = &v
+		case 255:
+			var v Element
+			d.message(&v)
+			r.PatternBooleanElement = &v
+		case 256:
+			var v Element
+			d.message(&v)
+			r.PatternCanonicalElement = &v
+		case 257:
+			var v Element
+			d.message(&v)
+			r.PatternCodeElement = &v
+		case 258:
+			var v Element
+			d.message(&v)
+			r.PatternDateElement = &v
+		case 259:
+			var v Element
+			d.message(&v)
+			r.PatternDateTimeElement = &v
+		case 260:
+			var v Element
+			d.message(&v)
+			r.PatternDecimalElement = &v
+		case 261:
+			var v Element
+			d.message(&v)
+			r.PatternIdElement = &v
+		case 262:
+			var v Element
+			d.message(&v)
+			r.PatternInstantElement = &v
+		case 263:
+			var v Element
+			d.message(&v)
+			r.PatternIntegerElement = &v
+		case 264:
+			var v Element
+			d.message(&v)
+			r.PatternMarkdownElement = &v
+		case 265:
+			var v Element
+			d.message(&v)
+			r.PatternOidElement = &v
+		case 266:
+			var v Element
+			d.message(&v)
+			r.PatternPositiveIntElement = &v
+		case 267:
+			var v Element
+			d.message(&v)
+			r.PatternStringElement = &v
+		case 268:
+			var v Element
+			d.message(&v)
+			r.PatternTimeElement = &v
+		case 269:
+			var v Element
+			d.message(&v)
+			r.PatternUnsignedIntElement = &v
+		case 270:
+			var v Element
+			d.message(&v)
+			r.PatternUriElement = &v
+		case 271:
+			var v Element
+			d.message(&v)
+			r.PatternUrlElement = &v
+		case 272:
+			var v Element
+			d.message(&v)
+			r.PatternUuidElement = &v
+		case 273:
+			var v Element
+			d.message(&v)
+			r.MinValueDateElement = &v
+		case 274:
+			var v Element
+			d.message(&v)
+			r.MinValueDateTimeElement = &v
+		case 275:
+			var v Element
+			d.message(&v)
+			r.MinValueInstantElement = &v
+		case 276:
+			var v Element
+			d.message(&v)
+			r.MinValueTimeElement = &v
+		case 277:
+			var v Element
+			d.message(&v)
+			r.MinValueDecimalElement = &v
+		case 278:
+			var v Element
+			d.message(&v)
+			r.MinValueIntegerElement = &v
+		case 279:
+			var v Element
+			d.message(&v)
+			r.MinValuePositiveIntElement = &v
+		case 280:
+			var v Element
+			d.message(&v)
+			r.MinValueUnsignedIntElement = &v
+		case 281:
+			var v Element
+			d.message(&v)
+			r.MaxValueDateElement = &v
+		case 282:
+			var v Element
+			d.message(&v)
+			r.MaxValueDateTimeElement = &v
+		case 283:
+			var v Element
+			d.message(&v)
+			r.MaxValueInstantElement = &v
+		case 284:
+			var v Element
+			d.message(&v)
+			r.MaxValueTimeElement = &v
+		case 285:
+			var v Element
+			d.message(&v)
+			r.MaxValueDecimalElement = &v
+		case 286:
+			var v Element
+			d.message(&v)
+			r.MaxValueIntegerElement = &v
+		case 287:
+			var v Element
+			d.message(&v)
+			r.MaxValuePositiveIntElement = &v
+		case 288:
+			var v Element
+			d.message(&v)
+			r.MaxValueUnsignedIntElement = &v
+		case 289:
+			var v Element
+			d.message(&v)
+			r.MaxLengthElement = &v
+		case 290:
+			r.ConditionElement = append(r.ConditionElement, d.element())
+		case 291:
+			var v Element
+			d.message(&v)
+			r.MustSupportElement = &v
+		case 292:
+			var v Element
+			d.message(&v)
+			r.IsModifierElement = &v
+		case 293:
+			var v Element
+			d.message(&v)
+			r.IsModifierReasonElement = &v
+		case 294:
+			var v Element
+			d.message(&v)
+			r.IsSummaryElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ElementDefinitionSlicing as JSON into a byte slice
+func (r ElementDefinitionSlicing) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ElementDefinitionSlicing as JSON object with the members in the order of the fields
+func (r ElementDefinitionSlicing) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Discriminator) > 0 {
+		e.key("discriminator")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Discriminator {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Ordered != nil {
+		e.key("ordered")
+		e.bool(*r.Ordered)
+	}
+	if r.OrderedElement != nil {
+		e.key("_ordered")
+		r.OrderedElement.appendJSON(e)
+	}
+	e.key("rules")
+	e.string(r.Rules.Code())
+	if r.RulesElement != nil {
+		e.key("_rules")
+		r.RulesElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ElementDefinitionSlicing from JSON. Unknown members are ignored.
+func (r *ElementDefinitionSlicing) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ElementDefinitionSlicing
+func (r *ElementDefinitionSlicing) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "discriminator":
+			a := d.array()
+			r.Discriminator = nil
+			for a.next() {
+				var v ElementDefinitionSlicingDiscriminator
+				v.decodeJSON(d)
+				r.Discriminator = append(r.Discriminator, v)
+			}
+			if r.Discriminator == nil && !a.isNull {
+				r.Discriminator = []ElementDefinitionSlicingDiscriminator{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "ordered":
+			if v, ok := d.bool(); ok {
+				r.Ordered = &v
+			} else {
+				r.Ordered = nil
+			}
+		case "_ordered":
+			r.OrderedElement = d.element()
+		case "rules":
+			d.unmarshal(&r.Rules)
+		case "_rules":
+			r.RulesElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ElementDefinitionSlicing which shares no memory with the original
+func (r ElementDefinitionSlicing) DeepCopy() ElementDefinitionSlicing {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Discriminator != nil {
+		out.Discriminator = make([]ElementDefinitionSlicingDiscriminator, len(r.Discriminator))
+		for i := range r.Discriminator {
+			out.Discriminator[i] = r.Discriminator[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Ordered != nil {
+		v := *r.Ordered
+		out.Ordered = &v
+	}
+	if r.OrderedElement != nil {
+		v := r.OrderedElement.DeepCopy()
+		out.OrderedElement = &v
+	}
+	if r.RulesElement != nil {
+		v := r.RulesElement.DeepCopy()
+		out.RulesElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ElementDefinitionSlicing) Equal(other ElementDefinitionSlicing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.Discriminator) != len(other.Discriminator) {
+		return false
+	}
+	for i := range r.Discriminator {
+		if !r.Discriminator[i].Equal(other.Discriminator[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Ordered == nil) != (other.Ordered == nil) || r.Ordered != nil && *r.Ordered != *other.Ordered {
+		return false
+	}
+	if (r.OrderedElement == nil) != (other.OrderedElement == nil) || r.OrderedElement != nil && !r.OrderedElement.Equal(*other.OrderedElement) {
+		return false
+	}
+	if r.Rules != other.Rules {
+		return false
+	}
+	if (r.RulesElement == nil) != (other.RulesElement == nil) || r.RulesElement != nil && !r.RulesElement.Equal(*other.RulesElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ElementDefinitionSlicing) EqualsDeep(other ElementDefinitionSlicing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Discriminator), len(other.Discriminator), func(i, j int) bool {
+		return r.Discriminator[i].EqualsDeep(other.Discriminator[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Ordered == nil) != (other.Ordered == nil) || r.Ordered != nil && *r.Ordered != *other.Ordered {
+		return false
+	}
+	if (r.OrderedElement == nil) != (other.OrderedElement == nil) || r.OrderedElement != nil && !r.OrderedElement.EqualsDeep(*other.OrderedElement) {
+		return false
+	}
+	if r.Rules != other.Rules {
+		return false
+	}
+	if (r.RulesElement == nil) != (other.RulesElement == nil) || r.RulesElement != nil && !r.RulesElement.EqualsDeep(*other.RulesElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ElementDefinitionSlicing) EqualsShallow(other ElementDefinitionSlicing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Ordered == nil) != (other.Ordered == nil) || r.Ordered != nil && *r.Ordered != *other.Ordered {
+		return false
+	}
+	if r.Rules != other.Rules {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ElementDefinitionSlicing as FHIR XML
+func (r ElementDefinitionSlicing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Discriminator {
+		if err := encodeXMLElement(e, "discriminator", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "ordered", r.Ordered, r.OrderedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "rules", r.Rules, r.RulesElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ElementDefinitionSlicing from FHIR XML
+func (r *ElementDefinitionSlicing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "discriminator":
+				var v ElementDefinitionSlicingDiscriminator
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Discriminator = append(r.Discriminator, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "ordered":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Ordered = &v
+				}
+				r.OrderedElement = element
+			case "rules":
+				var v SlicingRules
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Rules = v
+				}
+				r.RulesElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ElementDefinitionSlicing as properties to the RDF node n
+func (r ElementDefinitionSlicing) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.Discriminator {
+		n.element("ElementDefinition.slicing.discriminator", i, v)
+	}
+	n.primitive("ElementDefinition.slicing.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("ElementDefinition.slicing.ordered", -1, r.Ordered, r.OrderedElement, "boolean")
+	n.primitive("ElementDefinition.slicing.rules", -1, r.Rules, r.RulesElement, "code")
+}
+
+// MarshalBSON marshals the given ElementDefinitionSlicing as BSON document with the structure of its FHIR JSON
+func (r ElementDefinitionSlicing) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ElementDefinitionSlicing from a BSON document with the structure of its FHIR JSON
+func (r *ElementDefinitionSlicing) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ElementDefinitionSlicing as protobuf message ElementDefinitionSlicing of fhir.proto
+func (r ElementDefinitionSlicing) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ElementDefinitionSlicing to the encoded message
+func (r ElementDefinitionSlicing) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.Discriminator {
+		e.message(3, v)
+	}
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.Ordered != nil {
+		e.varint(5, protoBool(*r.Ordered))
+	}
+	e.varint(6, r.Rules.protoNumber())
+	if r.DescriptionElement != nil {
+		e.message(7, *r.DescriptionElement)
+	}
+	if r.OrderedElement != nil {
+		e.message(8, *r.OrderedElement)
+	}
+	if r.RulesElement != nil {
+		e.message(9, *r.RulesElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ElementDefinitionSlicing from protobuf message ElementDefinitionSlicing of fhir.proto
+func (r *ElementDefinitionSlicing) UnmarshalProto(b []byte) error {
+	*r = ElementDefinitionSlicing{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v ElementDefinitionSlicingDiscriminator
+			d.message(&v)
+			r.Discriminator = append(r.Discriminator, v)
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			v := d.bool()
+			r.Ordered = &v
+		case 6:
+			var v SlicingRules
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Rules = v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.OrderedElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.RulesElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ElementDefinitionSlicingDiscriminator as JSON into a byte slice
+func (r ElementDefinitionSlicingDiscriminator) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ElementDefinitionSlicingDiscriminator as JSON object with the members in the order of the fields
+func (r ElementDefinitionSlicingDiscriminator) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("path")
+	e.string(r.Path)
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ElementDefinitionSlicingDiscriminator from JSON. Unknown members are ignored.
+func (r *ElementDefinitionSlicingDiscriminator) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ElementDefinitionSlicingDiscriminator
+func (r *ElementDefinitionSlicingDiscriminator) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = v
+			}
+		case "_path":
+			r.PathElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ElementDefinitionSlicingDiscriminator which shares no memory with the original
+func (r ElementDefinitionSlicingDiscriminator) DeepCopy() ElementDefinitionSlicingDiscriminator {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ElementDefinitionSlicingDiscriminator) Equal(other ElementDefinitionSlicingDiscriminator) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.Path != other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ElementDefinitionSlicingDiscriminator) EqualsDeep(other ElementDefinitionSlicingDiscriminator) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ElementDefinitionSlicingDiscriminator) EqualsShallow(other ElementDefinitionSlicingDiscriminator) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ElementDefinitionSlicingDiscriminator as FHIR XML
+func (r ElementDefinitionSlicingDiscriminator) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ElementDefinitionSlicingDiscriminator from FHIR XML
+func (r *ElementDefinitionSlicingDiscriminator) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "type":
+				var v DiscriminatorType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = v
+				}
+				r.PathElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ElementDefinitionSlicingDiscriminator as properties to the RDF node n
+func (r ElementDefinitionSlicingDiscriminator) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("ElementDefinition.slicing.discriminator.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("ElementDefinition.slicing.discriminator.path", -1, r.Path, r.PathElement, "string")
+}
+
+// MarshalBSON marshals the given ElementDefinitionSlicingDiscriminator as BSON document with the structure of its FHIR JSON
+func (r ElementDefinitionSlicingDiscriminator) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ElementDefinitionSlicingDiscriminator from a BSON document with the structure of its FHIR JSON
+func (r *ElementDefinitionSlicingDiscriminator) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ElementDefinitionSlicingDiscriminator as protobuf message ElementDefinitionSlicingDiscriminator of fhir.proto
+func (r ElementDefinitionSlicingDiscriminator) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ElementDefinitionSlicingDiscriminator to the encoded message
+func (r ElementDefinitionSlicingDiscriminator) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	e.varint(3, r.Type.protoNumber())
+	if r.Path != "" {
+		e.string(4, r.Path)
+	}
+	if r.TypeElement != nil {
+		e.message(5, *r.TypeElement)
+	}
+	if r.PathElement != nil {
+		e.message(6, *r.PathElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ElementDefinitionSlicingDiscriminator from protobuf message ElementDefinitionSlicingDiscriminator of fhir.proto
+func (r *ElementDefinitionSlicingDiscriminator) UnmarshalProto(b []byte) error {
+	*r = ElementDefinitionSlicingDiscriminator{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v DiscriminatorType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 4:
+			r.Path = d.string()
+		case 5:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ElementDefinitionBase as JSON into a byte slice
+func (r ElementDefinitionBase) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ElementDefinitionBase as JSON object with the members in the order of the fields
+func (r ElementDefinitionBase) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("path")
+	e.string(r.Path)
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	e.key("min")
+	e.int(r.Min)
+	if r.MinElement != nil {
+		e.key("_min")
+		r.MinElement.appendJSON(e)
+	}
+	e.key("max")
+	e.string(r.Max)
+	if r.MaxElement != nil {
+		e.key("_max")
+		r.MaxElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ElementDefinitionBase from JSON. Unknown members are ignored.
+func (r *ElementDefinitionBase) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ElementDefinitionBase
+func (r *ElementDefinitionBase) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = v
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "min":
+			if v, ok := d.int(); ok {
+				r.Min = v
+			}
+		case "_min":
+			r.MinElement = d.element()
+		case "max":
+			if v, ok := d.string(); ok {
+				r.Max = v
+			}
+		case "_max":
+			r.MaxElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ElementDefinitionBase which shares no memory with the original
+func (r ElementDefinitionBase) DeepCopy() ElementDefinitionBase {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	if r.MinElement != nil {
+		v := r.MinElement.DeepCopy()
+		out.MinElement = &v
+	}
+	if r.MaxElement != nil {
+		v := r.MaxElement.DeepCopy()
+		out.MaxElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ElementDefinitionBase) Equal(other ElementDefinitionBase) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Path != other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if r.Min != other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.Equal(*other.MinElement) {
+		return false
+	}
+	if r.Max != other.Max {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.Equal(*other.MaxElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ElementDefinitionBase) EqualsDeep(other ElementDefinitionBase) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if r.Min != other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.EqualsDeep(*other.MinElement) {
+		return false
+	}
+	if !equivalentString(r.Max, other.Max) {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.EqualsDeep(*other.MaxElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ElementDefinitionBase) EqualsShallow(other ElementDefinitionBase) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Path, other.Path) {
+		return false
+	}
+	if r.Min != other.Min {
+		return false
+	}
+	if !equivalentString(r.Max, other.Max) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ElementDefinitionBase as FHIR XML
+func (r ElementDefinitionBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "min", r.Min, r.MinElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "max", r.Max, r.MaxElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ElementDefinitionBase from FHIR XML
+func (r *ElementDefinitionBase) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = v
+				}
+				r.PathElement = element
+			case "min":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Min = v
+				}
+				r.MinElement = element
+			case "max":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Max = v
+				}
+				r.MaxElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ElementDefinitionBase as properties to the RDF node n
+func (r ElementDefinitionBase) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("ElementDefinition.base.path", -1, r.Path, r.PathElement, "string")
+	n.primitive("ElementDefinition.base.min", -1, r.Min, r.MinElement, "unsignedInt")
+	n.primitive("ElementDefinition.base.max", -1, r.Max, r.MaxElement, "string")
+}
+
+// MarshalBSON marshals the given ElementDefinitionBase as BSON document with the structure of its FHIR JSON
+func (r ElementDefinitionBase) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ElementDefinitionBase from a BSON document with the structure of its FHIR JSON
+func (r *ElementDefinitionBase) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ElementDefinitionBase as protobuf message ElementDefinitionBase of fhir.proto
+func (r ElementDefinitionBase) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ElementDefinitionBase to the encoded message
+func (r ElementDefinitionBase) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Path != "" {
+		e.string(3, r.Path)
+	}
+	if r.Min != 0 {
+		e.varint(4, uint64(int64(r.Min)))
+	}
+	if r.Max != "" {
+		e.string(5, r.Max)
+	}
+	if r.PathElement != nil {
+		e.message(6, *r.PathElement)
+	}
+	if r.MinElement != nil {
+		e.message(7, *r.MinElement)
+	}
+	if r.MaxElement != nil {
+		e.message(8, *r.MaxElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ElementDefinitionBase from protobuf message ElementDefinitionBase of fhir.proto
+func (r *ElementDefinitionBase) UnmarshalProto(b []byte) error {
+	*r = ElementDefinitionBase{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			r.Path = d.string()
+		case 4:
+			r.Min = d.int()
+		case 5:
+			r.Max = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.MinElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.MaxElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ElementDefinitionType as JSON into a byte slice
+func (r ElementDefinitionType) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ElementDefinitionType as JSON object with the members in the order of the fields
+func (r ElementDefinitionType) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if len(r.Profile) > 0 {
+		e.key("profile")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Profile {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProfileElement) > 0 {
+		e.key("_profile")
+		e.elements(r.ProfileElement, len(r.Profile))
+	}
+	if len(r.TargetProfile) > 0 {
+		e.key("targetProfile")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.TargetProfile {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.TargetProfileElement) > 0 {
+		e.key("_targetProfile")
+		e.elements(r.TargetProfileElement, len(r.TargetProfile))
+	}
+	if len(r.Aggregation) > 0 {
+		e.key("aggregation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Aggregation {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AggregationElement) > 0 {
+		e.key("_aggregation")
+		e.elements(r.AggregationElement, len(r.Aggregation))
+	}
+	if r.Versioning != nil {
+		e.key("versioning")
+		e.string(r.Versioning.Code())
+	}
+	if r.VersioningElement != nil {
+		e.key("_versioning")
+		r.VersioningElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ElementDefinitionType from JSON. Unknown members are ignored.
+func (r *ElementDefinitionType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ElementDefinitionType
+func (r *ElementDefinitionType) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "profile":
+			a := d.array()
+			r.Profile = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Profile = append(r.Profile, v)
+			}
+			if r.Profile == nil && !a.isNull {
+				r.Profile = []string{}
+			}
+		case "_profile":
+			a := d.array()
+			r.ProfileElement = nil
+			for a.next() {
+				r.ProfileElement = append(r.ProfileElement, d.element())
+			}
+		case "targetProfile":
+			a := d.array()
+			r.TargetProfile = nil
+			for a.next() {
+				v, _ := d.string()
+				r.TargetProfile = append(r.TargetProfile, v)
+			}
+			if r.TargetProfile == nil && !a.isNull {
+				r.TargetProfile = []string{}
+			}
+		case "_targetProfile":
+			a := d.array()
+			r.TargetProfileElement = nil
+			for a.next() {
+				r.TargetProfileElement = append(r.TargetProfileElement, d.element())
+			}
+		case "aggregation":
+			a := d.array()
+			r.Aggregation = nil
+			for a.next() {
+				var v AggregationMode
+				d.unmarshal(&v)
+				r.Aggregation = append(r.Aggregation, v)
+			}
+			if r.Aggregation == nil && !a.isNull {
+				r.Aggregation = []AggregationMode{}
+			}
+		case "_aggregation":
+			a := d.array()
+			r.AggregationElement = nil
+			for a.next() {
+				r.AggregationElement = append(r.AggregationElement, d.element())
+			}
+		case "versioning":
+			if d.null() {
+				r.Versioning = nil
+			} else {
+				var v ReferenceVersionRules
+				d.unmarshal(&v)
+				r.Versioning = &v
+			}
+		case "_versioning":
+			r.VersioningElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ElementDefinitionType which shares no memory with the original
+func (r ElementDefinitionType) DeepCopy() ElementDefinitionType {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Profile != nil {
+		out.Profile = make([]string, len(r.Profile))
+		copy(out.Profile, r.Profile)
+	}
+	if r.ProfileElement != nil {
+		out.ProfileElement = make([]*Element, len(r.ProfileElement))
+		for i, v := range r.ProfileElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ProfileElement[i] = &c
+			}
+		}
+	}
+	if r.TargetProfile != nil {
+		out.TargetProfile = make([]string, len(r.TargetProfile))
+		copy(out.TargetProfile, r.TargetProfile)
+	}
+	if r.TargetProfileElement != nil {
+		out.TargetProfileElement = make([]*Element, len(r.TargetProfileElement))
+		for i, v := range r.TargetProfileElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.TargetProfileElement[i] = &c
+			}
+		}
+	}
+	if r.Aggregation != nil {
+		out.Aggregation = make([]AggregationMode, len(r.Aggregation))
+		copy(out.Aggregation, r.Aggregation)
+	}
+	if r.AggregationElement != nil {
+		out.AggregationElement = make([]*Element, len(r.AggregationElement))
+		for i, v := range r.AggregationElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.AggregationElement[i] = &c
+			}
+		}
+	}
+	if r.Versioning != nil {
+		v := *r.Versioning
+		out.Versioning = &v
+	}
+	if r.VersioningElement != nil {
+		v := r.VersioningElement.DeepCopy()
+		out.VersioningElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ElementDefinitionType) Equal(other ElementDefinitionType) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if len(r.Profile) != len(other.Profile) {
+		return false
+	}
+	for i := range r.Profile {
+		if r.Profile[i] != other.Profile[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ProfileElement, other.ProfileElement, false) {
+		return false
+	}
+	if len(r.TargetProfile) != len(other.TargetProfile) {
+		return false
+	}
+	for i := range r.TargetProfile {
+		if r.TargetProfile[i] != other.TargetProfile[i] {
+			return false
+		}
+	}
+	if !equalElements(r.TargetProfileElement, other.TargetProfileElement, false) {
+		return false
+	}
+	if len(r.Aggregation) != len(other.Aggregation) {
+		return false
+	}
+	for i := range r.Aggregation {
+		if r.Aggregation[i] != other.Aggregation[i] {
+			return false
+		}
+	}
+	if !equalElements(r.AggregationElement, other.AggregationElement, false) {
+		return false
+	}
+	if (r.Versioning == nil) != (other.Versioning == nil) || r.Versioning != nil && *r.Versioning != *other.Versioning {
+		return false
+	}
+	if (r.VersioningElement == nil) != (other.VersioningElement == nil) || r.VersioningElement != nil && !r.VersioningElement.Equal(*other.VersioningElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ElementDefinitionType) EqualsDeep(other ElementDefinitionType) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Profile), len(other.Profile), func(i, j int) bool {
+		return equivalentString(r.Profile[i], other.Profile[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ProfileElement, other.ProfileElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.TargetProfile), len(other.TargetProfile), func(i, j int) bool {
+		return equivalentString(r.TargetProfile[i], other.TargetProfile[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.TargetProfileElement, other.TargetProfileElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Aggregation), len(other.Aggregation), func(i, j int) bool {
+		return r.Aggregation[i] == other.Aggregation[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.AggregationElement, other.AggregationElement, true) {
+		return false
+	}
+	if (r.Versioning == nil) != (other.Versioning == nil) || r.Versioning != nil && *r.Versioning != *other.Versioning {
+		return false
+	}
+	if (r.VersioningElement == nil) != (other.VersioningElement == nil) || r.VersioningElement != nil && !r.VersioningElement.EqualsDeep(*other.VersioningElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ElementDefinitionType) EqualsShallow(other ElementDefinitionType) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.Profile), len(other.Profile), func(i, j int) bool {
+		return equivalentString(r.Profile[i], other.Profile[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.TargetProfile), len(other.TargetProfile), func(i, j int) bool {
+		return equivalentString(r.TargetProfile[i], other.TargetProfile[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Aggregation), len(other.Aggregation), func(i, j int) bool {
+		return r.Aggregation[i] == other.Aggregation[j]
+	}) {
+		return false
+	}
+	if (r.Versioning == nil) != (other.Versioning == nil) || r.Versioning != nil && *r.Versioning != *other.Versioning {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ElementDefinitionType as FHIR XML
+func (r ElementDefinitionType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	for i, v := range r.Profile {
+		if err := encodeXMLPrimitive(e, "profile", v, elementAt(r.ProfileElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.TargetProfile {
+		if err := encodeXMLPrimitive(e, "targetProfile", v, elementAt(r.TargetProfileElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Aggregation {
+		if err := encodeXMLPrimitive(e, "aggregation", v, elementAt(r.AggregationElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "versioning", r.Versioning, r.VersioningElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ElementDefinitionType from FHIR XML
+func (r *ElementDefinitionType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ProfileElement = appendElement(r.ProfileElement, len(r.Profile), element)
+					r.Profile = append(r.Profile, v)
+				}
+			case "targetProfile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.TargetProfileElement = appendElement(r.TargetProfileElement, len(r.TargetProfile), element)
+					r.TargetProfile = append(r.TargetProfile, v)
+				}
+			case "aggregation":
+				var v AggregationMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.AggregationElement = appendElement(r.AggregationElement, len(r.Aggregation), element)
+					r.Aggregation = append(r.Aggregation, v)
+				}
+			case "versioning":
+				var v ReferenceVersionRules
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Versioning = &v
+				}
+				r.VersioningElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ElementDefinitionType as properties to the RDF node n
+func (r ElementDefinitionType) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("ElementDefinition.type.code", -1, r.Code, r.CodeElement, "string")
+	for i, v := range r.Profile {
+		n.primitive("ElementDefinition.type.profile", i, v, elementAt(r.ProfileElement, i), "string")
+	}
+	for i, v := range r.TargetProfile {
+		n.primitive("ElementDefinition.type.targetProfile", i, v, elementAt(r.TargetProfileElement, i), "string")
+	}
+	for i, v := range r.Aggregation {
+		n.primitive("ElementDefinition.type.aggregation", i, v, elementAt(r.AggregationElement, i), "code")
+	}
+	n.primitive("ElementDefinition.type.versioning", -1, r.Versioning, r.VersioningElement, "code")
+}
+
+// MarshalBSON marshals the given ElementDefinitionType as BSON document with the structure of its FHIR JSON
+func (r ElementDefinitionType) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ElementDefinitionType from a BSON document with the structure of its FHIR JSON
+func (r *ElementDefinitionType) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ElementDefinitionType as protobuf message ElementDefinitionType of fhir.proto
+func (r ElementDefinitionType) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ElementDefinitionType to the encoded message
+func (r ElementDefinitionType) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Code != "" {
+		e.string(3, r.Code)
+	}
+	for _, v := range r.Profile {
+		e.string(4, v)
+	}
+	for _, v := range r.TargetProfile {
+		e.string(5, v)
+	}
+	if len(r.Aggregation) > 0 {
+		var p protoEncoder
+		for _, v := range r.Aggregation {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(6, p.buf)
+	}
+	if r.Versioning != nil {
+		e.varint(7, r.Versioning.protoNumber())
+	}
+	if r.CodeElement != nil {
+		e.message(8, *r.CodeElement)
+	}
+	for _, v := range r.ProfileElement {
+		e.element(9, v)
+	}
+	for _, v := range r.TargetProfileElement {
+		e.element(10, v)
+	}
+	for _, v := range r.AggregationElement {
+		e.element(11, v)
+	}
+	if r.VersioningElement != nil {
+		e.message(12, *r.VersioningElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ElementDefinitionType from protobuf message ElementDefinitionType of fhir.proto
+func (r *ElementDefinitionType) UnmarshalProto(b []byte) error {
+	*r = ElementDefinitionType{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			r.Code = d.string()
+		case 4:
+			r.Profile = append(r.Profile, d.string())
+		case 5:
+			r.TargetProfile = append(r.TargetProfile, d.string())
+		case 6:
+			for _, n := range d.varints() {
+				var v AggregationMode
+				d.enum(n, v.setProtoNumber)
+				r.Aggregation = append(r.Aggregation, v)
+			}
+		case 7:
+			var v ReferenceVersionRules
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Versioning = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 9:
+			r.ProfileElement = append(r.ProfileElement, d.element())
+		case 10:
+			r.TargetProfileElement = append(r.TargetProfileElement, d.element())
+		case 11:
+			r.AggregationElement = append(r.AggregationElement, d.element())
+		case 12:
+			var v Element
+			d.message(&v)
+			r.VersioningElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ElementDefinitionExample as JSON into a byte slice
+func (r ElementDefinitionExample) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ElementDefinitionExample as JSON object with the members in the order of the fields
+func (r ElementDefinitionExample) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("label")
+	e.string(r.Label)
+	if r.LabelElement != nil {
+		e.key("_label")
+		r.LabelElement.appendJSON(e)
+	}
+	if r.ValueBase64Binary != nil {
+		e.key("valueBase64Binary")
+		e.string(*r.ValueBase64Binary)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.key("_valueBase64Binary")
+		r.ValueBase64BinaryElement.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueCanonical != nil {
+		e.key("valueCanonical")
+		e.string(*r.ValueCanonical)
+	}
+	if r.ValueCanonicalElement != nil {
+		e.key("_valueCanonical")
+		r.ValueCanonicalElement.appendJSON(e)
+	}
+	if r.ValueCode != nil {
+		e.key("valueCode")
+		e.string(*r.ValueCode)
+	}
+	if r.ValueCodeElement != nil {
+		e.key("_valueCode")
+		r.ValueCodeElement.appendJSON(e)
+	}
+	if r.ValueDate != nil {
+		e.key("valueDate")
+		e.string(*r.ValueDate)
+	}
+	if r.ValueDateElement != nil {
+		e.key("_valueDate")
+		r.ValueDateElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValueDecimal != nil {
+		e.key("valueDecimal")
+		e.number(*r.ValueDecimal)
+	}
+	if r.ValueDecimalElement != nil {
+		e.key("_valueDecimal")
+		r.ValueDecimalElement.appendJSON(e)
+	}
+	if r.ValueId != nil {
+		e.key("valueId")
+		e.string(*r.ValueId)
+	}
+	if r.ValueIdElement != nil {
+		e.key("_valueId")
+		r.ValueIdElement.appendJSON(e)
+	}
+	if r.ValueInstant != nil {
+		e.key("valueInstant")
+		e.string(*r.ValueInstant)
+	}
+	if r.ValueInstantElement != nil {
+		e.key("_valueInstant")
+		r.ValueInstantElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueMarkdown != nil {
+		e.key("valueMarkdown")
+		e.string(*r.ValueMarkdown)
+	}
+	if r.ValueMarkdownElement != nil {
+		e.key("_valueMarkdown")
+		r.ValueMarkdownElement.appendJSON(e)
+	}
+	if r.ValueOid != nil {
+		e.key("valueOid")
+		e.string(*r.ValueOid)
+	}
+	if r.ValueOidElement != nil {
+		e.key("_valueOid")
+		r.ValueOidElement.appendJSON(e)
+	}
+	if r.ValuePositiveInt != nil {
+		e.key("valuePositiveInt")
+		e.int(*r.ValuePositiveInt)
+	}
+	if r.ValuePositiveIntElement != nil {
+		e.key("_valuePositiveInt")
+		r.ValuePositiveIntElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueTime != nil {
+		e.key("valueTime")
+		e.string(*r.ValueTime)
+	}
+	if r.ValueTimeElement != nil {
+		e.key("_valueTime")
+		r.ValueTimeElement.appendJSON(e)
+	}
+	if r.ValueUnsignedInt != nil {
+		e.key("valueUnsignedInt")
+		e.int(*r.ValueUnsignedInt)
+	}
+	if r.ValueUnsignedIntElement != nil {
+		e.key("_valueUnsignedInt")
+		r.ValueUnsignedIntElement.appendJSON(e)
+	}
+	if r.ValueUri != nil {
+		e.key("valueUri")
+		e.string(*r.ValueUri)
+	}
+	if r.ValueUriElement != nil {
+		e.key("_valueUri")
+		r.ValueUriElement.appendJSON(e)
+	}
+	if r.ValueUrl != nil {
+		e.key("valueUrl")
+		e.string(*r.ValueUrl)
+	}
+	if r.ValueUrlElement != nil {
+		e.key("_valueUrl")
+		r.ValueUrlElement.appendJSON(e)
+	}
+	if r.ValueUuid != nil {
+		e.key("valueUuid")
+		e.string(*r.ValueUuid)
+	}
+	if r.ValueUuidElement != nil {
+		e.key("_valueUuid")
+		r.ValueUuidElement.appendJSON(e)
+	}
+	if r.ValueAddress != nil {
+		e.key("valueAddress")
+		r.ValueAddress.appendJSON(e)
+	}
+	if r.ValueAge != nil {
+		e.key("valueAge")
+		r.ValueAge.appendJSON(e)
+	}
+	if r.ValueAnnotation != nil {
+		e.key("valueAnnotation")
+		r.ValueAnnotation.appendJSON(e)
+	}
+	if r.ValueAttachment != nil {
+		e.key("valueAttachment")
+		r.ValueAttachment.appendJSON(e)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.key("valueCodeableConcept")
+		r.ValueCodeableConcept.appendJSON(e)
+	}
+	if r.ValueCoding != nil {
+		e.key("valueCoding")
+		r.ValueCoding.appendJSON(e)
+	}
+	if r.ValueContactPoint != nil {
+		e.key("valueContactPoint")
+		r.ValueContactPoint.appendJSON(e)
+	}
+	if r.ValueCount != nil {
+		e.key("valueCount")
+		r.ValueCount.appendJSON(e)
+	}
+	if r.ValueDistance != nil {
+		e.key("valueDistance")
+		r.ValueDistance.appendJSON(e)
+	}
+	if r.ValueDuration != nil {
+		e.key("valueDuration")
+		r.ValueDuration.appendJSON(e)
+	}
+	if r.ValueHumanName != nil {
+		e.key("valueHumanName")
+		r.ValueHumanName.appendJSON(e)
+	}
+	if r.ValueIdentifier != nil {
+		e.key("valueIdentifier")
+		r.ValueIdentifier.appendJSON(e)
+	}
+	if r.ValueMoney != nil {
+		e.key("valueMoney")
+		r.ValueMoney.appendJSON(e)
+	}
+	if r.ValuePeriod != nil {
+		e.key("valuePeriod")
+		r.ValuePeriod.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueRange != nil {
+		e.key("valueRange")
+		r.ValueRange.appendJSON(e)
+	}
+	if r.ValueRatio != nil {
+		e.key("valueRatio")
+		r.ValueRatio.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	if r.ValueSampledData != nil {
+		e.key("valueSampledData")
+		r.ValueSampledData.appendJSON(e)
+	}
+	if r.ValueSignature != nil {
+		e.key("valueSignature")
+		r.ValueSignature.appendJSON(e)
+	}
+	if r.ValueTiming != nil {
+		e.key("valueTiming")
+		r.ValueTiming.appendJSON(e)
+	}
+	if r.ValueContactDetail != nil {
+		e.key("valueContactDetail")
+		r.ValueContactDetail.appendJSON(e)
+	}
+	if r.ValueContributor != nil {
+		e.key("valueContributor")
+		r.ValueContributor.appendJSON(e)
+	}
+	if r.ValueDataRequirement != nil {
+		e.key("valueDataRequirement")
+		r.ValueDataRequirement.appendJSON(e)
+	}
+	if r.ValueExpression != nil {
+		e.key("valueExpression")
+		r.ValueExpression.appendJSON(e)
+	}
+	if r.ValueParameterDefinition != nil {
+		e.key("valueParameterDefinition")
+		r.ValueParameterDefinition.appendJSON(e)
+	}
+	if r.ValueRelatedArtifact != nil {
+		e.key("valueRelatedArtifact")
+		r.ValueRelatedArtifact.appendJSON(e)
+	}
+	if r.ValueTriggerDefinition != nil {
+		e.key("valueTriggerDefinition")
+		r.ValueTriggerDefinition.appendJSON(e)
+	}
+	if r.ValueUsageContext != nil {
+		e.key("valueUsageContext")
+		r.ValueUsageContext.appendJSON(e)
+	}
+	if r.ValueDosage != nil {
+		e.key("valueDosage")
+		r.ValueDosage.appendJSON(e)
+	}
+	if r.ValueMeta != nil {
+		e.key("valueMeta")
+		r.ValueMeta.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ElementDefinitionExample from JSON. Unknown members are ignored.
+func (r *ElementDefinitionExample) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ElementDefinitionExample
+func (r *ElementDefinitionExample) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "label":
+			if v, ok := d.string(); ok {
+				r.Label = v
+			}
+		case "_label":
+			r.LabelElement = d.element()
+		case "valueBase64Binary":
+			if v, ok := d.string(); ok {
+				r.ValueBase64Binary = &v
+			} else {
+				r.ValueBase64Binary = nil
+			}
+		case "_valueBase64Binary":
+			r.ValueBase64BinaryElement = d.element()
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueCanonical":
+			if v, ok := d.string(); ok {
+				r.ValueCanonical = &v
+			} else {
+				r.ValueCanonical = nil
+			}
+		case "_valueCanonical":
+			r.ValueCanonicalElement = d.element()
+		case "valueCode":
+			if v, ok := d.string(); ok {
+				r.ValueCode = &v
+			} else {
+				r.ValueCode = nil
+			}
+		case "_valueCode":
+			r.ValueCodeElement = d.element()
+		case "valueDate":
+			if v, ok := d.string(); ok {
+				r.ValueDate = &v
+			} else {
+				r.ValueDate = nil
+			}
+		case "_valueDate":
+			r.ValueDateElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valueDecimal":
+			if v, ok := d.number(); ok {
+				r.ValueDecimal = &v
+			} else {
+				r.ValueDecimal = nil
+			}
+		case "_valueDecimal":
+			r.ValueDecimalElement = d.element()
+		case "valueId":
+			if v, ok := d.string(); ok {
+				r.ValueId = &v
+			} else {
+				r.ValueId = nil
+			}
+		case "_valueId":
+			r.ValueIdElement = d.element()
+		case "valueInstant":
+			if v, ok := d.string(); ok {
+				r.ValueInstant = &v
+			} else {
+				r.ValueInstant = nil
+			}
+		case "_valueInstant":
+			r.ValueInstantElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueMarkdown":
+			if v, ok := d.string(); ok {
+				r.ValueMarkdown = &v
+			} else {
+				r.ValueMarkdown = nil
+			}
+		case "_valueMarkdown":
+			r.ValueMarkdownElement = d.element()
+		case "valueOid":
+			if v, ok := d.string(); ok {
+				r.ValueOid = &v
+			} else {
+				r.ValueOid = nil
+			}
+		case "_valueOid":
+			r.ValueOidElement = d.element()
+		case "valuePositiveInt":
+			if v, ok := d.int(); ok {
+				r.ValuePositiveInt = &v
+			} else {
+				r.ValuePositiveInt = nil
+			}
+		case "_valuePositiveInt":
+			r.ValuePositiveIntElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueTime":
+			if v, ok := d.string(); ok {
+				r.ValueTime = &v
+			} else {
+				r.ValueTime = nil
+			}
+		case "_valueTime":
+			r.ValueTimeElement = d.element()
+		case "valueUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.ValueUnsignedInt = &v
+			} else {
+				r.ValueUnsignedInt = nil
+			}
+		case "_valueUnsignedInt":
+			r.ValueUnsignedIntElement = d.element()
+		case "valueUri":
+			if v, ok := d.string(); ok {
+				r.ValueUri = &v
+			} else {
+				r.ValueUri = nil
+			}
+		case "_valueUri":
+			r.ValueUriElement = d.element()
+		case "valueUrl":
+			if v, ok := d.string(); ok {
+				r.ValueUrl = &v
+			} else {
+				r.ValueUrl = nil
+			}
+		case "_valueUrl":
+			r.ValueUrlElement = d.element()
+		case "valueUuid":
+			if v, ok := d.string(); ok {
+				r.ValueUuid = &v
+			} else {
+				r.ValueUuid = nil
+			}
+		case "_valueUuid":
+			r.ValueUuidElement = d.element()
+		case "valueAddress":
+			if d.null() {
+				r.ValueAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.ValueAddress = &v
+			}
+		case "valueAge":
+			if d.null() {
+				r.ValueAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.ValueAge = &v
+			}
+		case "valueAnnotation":
+			if d.null() {
+				r.ValueAnnotation = nil
+			} else {
+				var v Annotation
+				v.decodeJSON(d)
+				r.ValueAnnotation = &v
+			}
+		case "valueAttachment":
+			if d.null() {
+				r.ValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ValueAttachment = &v
+			}
+		case "valueCodeableConcept":
+			if d.null() {
+				r.ValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCodeableConcept = &v
+			}
+		case "valueCoding":
+			if d.null() {
+				r.ValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.ValueCoding = &v
+			}
+		case "valueContactPoint":
+			if d.null() {
+				r.ValueContactPoint = nil
+			} else {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.ValueContactPoint = &v
+			}
+		case "valueCount":
+			if d.null() {
+				r.ValueCount = nil
+			} else {
+				var v Count
+				v.decodeJSON(d)
+				r.ValueCount = &v
+			}
+		case "valueDistance":
+			if d.null() {
+				r.ValueDistance = nil
+			} else {
+				var v Distance
+				v.decodeJSON(d)
+				r.ValueDistance = &v
+			}
+		case "valueDuration":
+			if d.null() {
+				r.ValueDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.ValueDuration = &v
+			}
+		case "valueHumanName":
+			if d.null() {
+				r.ValueHumanName = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.ValueHumanName = &v
+			}
+		case "valueIdentifier":
+			if d.null() {
+				r.ValueIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.ValueIdentifier = &v
+			}
+		case "valueMoney":
+			if d.null() {
+				r.ValueMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.ValueMoney = &v
+			}
+		case "valuePeriod":
+			if d.null() {
+				r.ValuePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValuePeriod = &v
+			}
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueRange":
+			if d.null() {
+				r.ValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.ValueRange = &v
+			}
+		case "valueRatio":
+			if d.null() {
+				r.ValueRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.ValueRatio = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		case "valueSampledData":
+			if d.null() {
+				r.ValueSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.ValueSampledData = &v
+			}
+		case "valueSignature":
+			if d.null() {
+				r.ValueSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.ValueSignature = &v
+			}
+		case "valueTiming":
+			if d.null() {
+				r.ValueTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.ValueTiming = &v
+			}
+		case "valueContactDetail":
+			if d.null() {
+				r.ValueContactDetail = nil
+			} else {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.ValueContactDetail = &v
+			}
+		case "valueContributor":
+			if d.null() {
+				r.ValueContributor = nil
+			} else {
+				var v Contributor
+				v.decodeJSON(d)
+				r.ValueContributor = &v
+			}
+		case "valueDataRequirement":
+			if d.null() {
+				r.ValueDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.ValueDataRequirement = &v
+			}
+		case "valueExpression":
+			if d.null() {
+				r.ValueExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.ValueExpression = &v
+			}
+		case "valueParameterDefinition":
+			if d.null() {
+				r.ValueParameterDefinition = nil
+			} else {
+				var v ParameterDefinition
+				v.decodeJSON(d)
+				r.ValueParameterDefinition = &v
+			}
+		case "valueRelatedArtifact":
+			if d.null() {
+				r.ValueRelatedArtifact = nil
+			} else {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.ValueRelatedArtifact = &v
+			}
+		case "valueTriggerDefinition":
+			if d.null() {
+				r.ValueTriggerDefinition = nil
+			} else {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.ValueTriggerDefinition = &v
+			}
+		case "valueUsageContext":
+			if d.null() {
+				r.ValueUsageContext = nil
+			} else {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.ValueUsageContext = &v
+			}
+		case "valueDosage":
+			if d.null() {
+				r.ValueDosage = nil
+			} else {
+				var v Dosage
+				v.decodeJSON(d)
+				r.ValueDosage = &v
+			}
+		case "valueMeta":
+			if d.null() {
+				r.ValueMeta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.ValueMeta = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ElementDefinitionExample which shares no memory with the original
+func (r ElementDefinitionExample) DeepCopy() ElementDefinitionExample {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.LabelElement != nil {
+		v := r.LabelElement.DeepCopy()
+		out.LabelElement = &v
+	}
+	if r.ValueBase64Binary != nil {
+		v := *r.ValueBase64Binary
+		out.ValueBase64Binary = &v
+	}
+	if r.ValueBase64BinaryElement != nil {
+		v := r.ValueBase64BinaryElement.DeepCopy()
+		out.ValueBase64BinaryElement = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueCanonical != nil {
+		v := *r.ValueCanonical
+		out.ValueCanonical = &v
+	}
+	if r.ValueCanonicalElement != nil {
+		v := r.ValueCanonicalElement.DeepCopy()
+		out.ValueCanonicalElement = &v
+	}
+	if r.ValueCode != nil {
+		v := *r.ValueCode
+		out.ValueCode = &v
+	}
+	if r.ValueCodeElement != nil {
+		v := r.ValueCodeElement.DeepCopy()
+		out.ValueCodeElement = &v
+	}
+	if r.ValueDate != nil {
+		v := *r.ValueDate
+		out.ValueDate = &v
+	}
+	if r.ValueDateElement != nil {
+		v := r.ValueDateElement.DeepCopy()
+		out.ValueDateElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValueDecimal != nil {
+		v := *r.ValueDecimal
+		out.ValueDecimal = &v
+	}
+	if r.ValueDecimalElement != nil {
+		v := r.ValueDecimalElement.DeepCopy()
+		out.ValueDecimalElement = &v
+	}
+	if r.ValueId != nil {
+		v := *r.ValueId
+		out.ValueId = &v
+	}
+	if r.ValueIdElement != nil {
+		v := r.ValueIdElement.DeepCopy()
+		out.ValueIdElement = &v
+	}
+	if r.ValueInstant != nil {
+		v := *r.ValueInstant
+		out.ValueInstant = &v
+	}
+	if r.ValueInstantElement != nil {
+		v := r.ValueInstantElement.DeepCopy()
+		out.ValueInstantElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueMarkdown != nil {
+		v := *r.ValueMarkdown
+		out.ValueMarkdown = &v
+	}
+	if r.ValueMarkdownElement != nil {
+		v := r.ValueMarkdownElement.DeepCopy()
+		out.ValueMarkdownElement = &v
+	}
+	if r.ValueOid != nil {
+		v := *r.ValueOid
+		out.ValueOid = &v
+	}
+	if r.ValueOidElement != nil {
+		v := r.ValueOidElement.DeepCopy()
+		out.ValueOidElement = &v
+	}
+	if r.ValuePositiveInt != nil {
+		v := *r.ValuePositiveInt
+		out.ValuePositiveInt = &v
+	}
+	if r.ValuePositiveIntElement != nil {
+		v := r.ValuePositiveIntElement.DeepCopy()
+		out.ValuePositiveIntElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueTime != nil {
+		v := *r.ValueTime
+		out.ValueTime = &v
+	}
+	if r.ValueTimeElement != nil {
+		v := r.ValueTimeElement.DeepCopy()
+		out.ValueTimeElement = &v
+	}
+	if r.ValueUnsignedInt != nil {
+		v := *r.ValueUnsignedInt
+		out.ValueUnsignedInt = &v
+	}
+	if r.ValueUnsignedIntElement != nil {
+		v := r.ValueUnsignedIntElement.DeepCopy()
+		out.ValueUnsignedIntElement = &v
+	}
+	if r.ValueUri != nil {
+		v := *r.ValueUri
+		out.ValueUri = &v
+	}
+	if r.ValueUriElement != nil {
+		v := r.ValueUriElement.DeepCopy()
+		out.ValueUriElement = &v
+	}
+	if r.ValueUrl != nil {
+		v := *r.ValueUrl
+		out.ValueUrl = &v
+	}
+	if r.ValueUrlElement != nil {
+		v := r.ValueUrlElement.DeepCopy()
+		out.ValueUrlElement = &v
+	}
+	if r.ValueUuid != nil {
+		v := *r.ValueUuid
+		out.ValueUuid = &v
+	}
+	if r.ValueUuidElement != nil {
+		v := r.ValueUuidElement.DeepCopy()
+		out.ValueUuidElement = &v
+	}
+	if r.ValueAddress != nil {
+		v := r.ValueAddress.DeepCopy()
+		out.ValueAddress = &v
+	}
+	if r.ValueAge != nil {
+		v := r.ValueAge.DeepCopy()
+		out.ValueAge = &v
+	}
+	if r.ValueAnnotation != nil {
+		v := r.ValueAnnotation.DeepCopy()
+		out.ValueAnnotation = &v
+	}
+	if r.ValueAttachment != nil {
+		v := r.ValueAttachment.DeepCopy()
+		out.ValueAttachment = &v
+	}
+	if r.ValueCodeableConcept != nil {
+		v := r.ValueCodeableConcept.DeepCopy()
+		out.ValueCodeableConcept = &v
+	}
+	if r.ValueCoding != nil {
+		v := r.ValueCoding.DeepCopy()
+		out.ValueCoding = &v
+	}
+	if r.ValueContactPoint != nil {
+		v := r.ValueContactPoint.DeepCopy()
+		out.ValueContactPoint = &v
+	}
+	if r.ValueCount != nil {
+		v := r.ValueCount.DeepCopy()
+		out.ValueCount = &v
+	}
+	if r.ValueDistance != nil {
+		v := r.ValueDistance.DeepCopy()
+		out.ValueDistance = &v
+	}
+	if r.ValueDuration != nil {
+		v := r.ValueDuration.DeepCopy()
+		out.ValueDuration = &v
+	}
+	if r.ValueHumanName != nil {
+		v := r.ValueHumanName.DeepCopy()
+		out.ValueHumanName = &v
+	}
+	if r.ValueIdentifier != nil {
+		v := r.ValueIdentifier.DeepCopy()
+		out.ValueIdentifier = &v
+	}
+	if r.ValueMoney != nil {
+		v := r.ValueMoney.DeepCopy()
+		out.ValueMoney = &v
+	}
+	if r.ValuePeriod != nil {
+		v := r.ValuePeriod.DeepCopy()
+		out.ValuePeriod = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueRange != nil {
+		v := r.ValueRange.DeepCopy()
+		out.ValueRange = &v
+	}
+	if r.ValueRatio != nil {
+		v := r.ValueRatio.DeepCopy()
+		out.ValueRatio = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	if r.ValueSampledData != nil {
+		v := r.ValueSampledData.DeepCopy()
+		out.ValueSampledData = &v
+	}
+	if r.ValueSignature != nil {
+		v := r.ValueSignature.DeepCopy()
+		out.ValueSignature = &v
+	}
+	if r.ValueTiming != nil {
+		v := r.ValueTiming.DeepCopy()
+		out.ValueTiming = &v
+	}
+	if r.ValueContactDetail != nil {
+		v := r.ValueContactDetail.DeepCopy()
+		out.ValueContactDetail = &v
+	}
+	if r.ValueContributor != nil {
+		v := r.ValueContributor.DeepCopy()
+		out.ValueContributor = &v
+	}
+	if r.ValueDataRequirement != nil {
+		v := r.ValueDataRequirement.DeepCopy()
+		out.ValueDataRequirement = &v
+	}
+	if r.ValueExpression != nil {
+		v := r.ValueExpression.DeepCopy()
+		out.ValueExpression = &v
+	}
+	if r.ValueParameterDefinition != nil {
+		v := r.ValueParameterDefinition.DeepCopy()
+		out.ValueParameterDefinition = &v
+	}
+	if r.ValueRelatedArtifact != nil {
+		v := r.ValueRelatedArtifact.DeepCopy()
+		out.ValueRelatedArtifact = &v
+	}
+	if r.ValueTriggerDefinition != nil {
+		v := r.ValueTriggerDefinition.DeepCopy()
+		out.ValueTriggerDefinition = &v
+	}
+	if r.ValueUsageContext != nil {
+		v := r.ValueUsageContext.DeepCopy()
+		out.ValueUsageContext = &v
+	}
+	if r.ValueDosage != nil {
+		v := r.ValueDosage.DeepCopy()
+		out.ValueDosage = &v
+	}
+	if r.ValueMeta != nil {
+		v := r.ValueMeta.DeepCopy()
+		out.ValueMeta = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ElementDefinitionExample) Equal(other ElementDefinitionExample) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Label != other.Label {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.Equal(*other.LabelElement) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && *r.ValueBase64Binary != *other.ValueBase64Binary {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.Equal(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && *r.ValueCanonical != *other.ValueCanonical {
+		return false
+	}
+	if (r.ValueCanonicalElement == nil) != (other.ValueCanonicalElement == nil) || r.ValueCanonicalElement != nil && !r.ValueCanonicalElement.Equal(*other.ValueCanonicalElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && *r.ValueCode != *other.ValueCode {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.Equal(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && *r.ValueDate != *other.ValueDate {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.Equal(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !EqualDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.Equal(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && *r.ValueId != *other.ValueId {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.Equal(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && *r.ValueInstant != *other.ValueInstant {
+		return false
+	}
+	if (r.ValueInstantElement == nil) != (other.ValueInstantElement == nil) || r.ValueInstantElement != nil && !r.ValueInstantElement.Equal(*other.ValueInstantElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && *r.ValueMarkdown != *other.ValueMarkdown {
+		return false
+	}
+	if (r.ValueMarkdownElement == nil) != (other.ValueMarkdownElement == nil) || r.ValueMarkdownElement != nil && !r.ValueMarkdownElement.Equal(*other.ValueMarkdownElement) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && *r.ValueOid != *other.ValueOid {
+		return false
+	}
+	if (r.ValueOidElement == nil) != (other.ValueOidElement == nil) || r.ValueOidElement != nil && !r.ValueOidElement.Equal(*other.ValueOidElement) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValuePositiveIntElement == nil) != (other.ValuePositiveIntElement == nil) || r.ValuePositiveIntElement != nil && !r.ValuePositiveIntElement.Equal(*other.ValuePositiveIntElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && *r.ValueTime != *other.ValueTime {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.Equal(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUnsignedIntElement == nil) != (other.ValueUnsignedIntElement == nil) || r.ValueUnsignedIntElement != nil && !r.ValueUnsignedIntElement.Equal(*other.ValueUnsignedIntElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && *r.ValueUri != *other.ValueUri {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.Equal(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && *r.ValueUrl != *other.ValueUrl {
+		return false
+	}
+	if (r.ValueUrlElement == nil) != (other.ValueUrlElement == nil) || r.ValueUrlElement != nil && !r.ValueUrlElement.Equal(*other.ValueUrlElement) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && *r.ValueUuid != *other.ValueUuid {
+		return false
+	}
+	if (r.ValueUuidElement == nil) != (other.ValueUuidElement == nil) || r.ValueUuidElement != nil && !r.ValueUuidElement.Equal(*other.ValueUuidElement) {
+		return false
+	}
+	if (r.ValueAddress == nil) != (other.ValueAddress == nil) || r.ValueAddress != nil && !r.ValueAddress.Equal(*other.ValueAddress) {
+		return false
+	}
+	if (r.ValueAge == nil) != (other.ValueAge == nil) || r.ValueAge != nil && !r.ValueAge.Equal(*other.ValueAge) {
+		return false
+	}
+	if (r.ValueAnnotation == nil) != (other.ValueAnnotation == nil) || r.ValueAnnotation != nil && !r.ValueAnnotation.Equal(*other.ValueAnnotation) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.Equal(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.Equal(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.Equal(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueContactPoint == nil) != (other.ValueContactPoint == nil) || r.ValueContactPoint != nil && !r.ValueContactPoint.Equal(*other.ValueContactPoint) {
+		return false
+	}
+	if (r.ValueCount == nil) != (other.ValueCount == nil) || r.ValueCount != nil && !r.ValueCount.Equal(*other.ValueCount) {
+		return false
+	}
+	if (r.ValueDistance == nil) != (other.ValueDistance == nil) || r.ValueDistance != nil && !r.ValueDistance.Equal(*other.ValueDistance) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.Equal(*other.ValueDuration) {
+		return false
+	}
+	if (r.ValueHumanName == nil) != (other.ValueHumanName == nil) || r.ValueHumanName != nil && !r.ValueHumanName.Equal(*other.ValueHumanName) {
+		return false
+	}
+	if (r.ValueIdentifier == nil) != (other.ValueIdentifier == nil) || r.ValueIdentifier != nil && !r.ValueIdentifier.Equal(*other.ValueIdentifier) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.Equal(*other.ValueMoney) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.Equal(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.Equal(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.Equal(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.Equal(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueSignature == nil) != (other.ValueSignature == nil) || r.ValueSignature != nil && !r.ValueSignature.Equal(*other.ValueSignature) {
+		return false
+	}
+	if (r.ValueTiming == nil) != (other.ValueTiming == nil) || r.ValueTiming != nil && !r.ValueTiming.Equal(*other.ValueTiming) {
+		return false
+	}
+	if (r.ValueContactDetail == nil) != (other.ValueContactDetail == nil) || r.ValueContactDetail != nil && !r.ValueContactDetail.Equal(*other.ValueContactDetail) {
+		return false
+	}
+	if (r.ValueContributor == nil) != (other.ValueContributor == nil) || r.ValueContributor != nil && !r.ValueContributor.Equal(*other.ValueContributor) {
+		return false
+	}
+	if (r.ValueDataRequirement == nil) != (other.ValueDataRequirement == nil) || r.ValueDataRequirement != nil && !r.ValueDataRequirement.Equal(*other.ValueDataRequirement) {
+		return false
+	}
+	if (r.ValueExpression == nil) != (other.ValueExpression == nil) || r.ValueExpression != nil && !r.ValueExpression.Equal(*other.ValueExpression) {
+		return false
+	}
+	if (r.ValueParameterDefinition == nil) != (other.ValueParameterDefinition == nil) || r.ValueParameterDefinition != nil && !r.ValueParameterDefinition.Equal(*other.ValueParameterDefinition) {
+		return false
+	}
+	if (r.ValueRelatedArtifact == nil) != (other.ValueRelatedArtifact == nil) || r.ValueRelatedArtifact != nil && !r.ValueRelatedArtifact.Equal(*other.ValueRelatedArtifact) {
+		return false
+	}
+	if (r.ValueTriggerDefinition == nil) != (other.ValueTriggerDefinition == nil) || r.ValueTriggerDefinition != nil && !r.ValueTriggerDefinition.Equal(*other.ValueTriggerDefinition) {
+		return false
+	}
+	if (r.ValueUsageContext == nil) != (other.ValueUsageContext == nil) || r.ValueUsageContext != nil && !r.ValueUsageContext.Equal(*other.ValueUsageContext) {
+		return false
+	}
+	if (r.ValueDosage == nil) != (other.ValueDosage == nil) || r.ValueDosage != nil && !r.ValueDosage.Equal(*other.ValueDosage) {
+		return false
+	}
+	if (r.ValueMeta == nil) != (other.ValueMeta == nil) || r.ValueMeta != nil && !r.ValueMeta.Equal(*other.ValueMeta) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ElementDefinitionExample) EqualsDeep(other ElementDefinitionExample) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Label, other.Label) {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.EqualsDeep(*other.LabelElement) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.EqualsDeep(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && !equivalentString(*r.ValueCanonical, *other.ValueCanonical) {
+		return false
+	}
+	if (r.ValueCanonicalElement == nil) != (other.ValueCanonicalElement == nil) || r.ValueCanonicalElement != nil && !r.ValueCanonicalElement.EqualsDeep(*other.ValueCanonicalElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.EqualsDeep(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.EqualsDeep(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.EqualsDeep(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.EqualsDeep(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && !equivalentString(*r.ValueInstant, *other.ValueInstant) {
+		return false
+	}
+	if (r.ValueInstantElement == nil) != (other.ValueInstantElement == nil) || r.ValueInstantElement != nil && !r.ValueInstantElement.EqualsDeep(*other.ValueInstantElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && !equivalentString(*r.ValueMarkdown, *other.ValueMarkdown) {
+		return false
+	}
+	if (r.ValueMarkdownElement == nil) != (other.ValueMarkdownElement == nil) || r.ValueMarkdownElement != nil && !r.ValueMarkdownElement.EqualsDeep(*other.ValueMarkdownElement) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && !equivalentString(*r.ValueOid, *other.ValueOid) {
+		return false
+	}
+	if (r.ValueOidElement == nil) != (other.ValueOidElement == nil) || r.ValueOidElement != nil && !r.ValueOidElement.EqualsDeep(*other.ValueOidElement) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValuePositiveIntElement == nil) != (other.ValuePositiveIntElement == nil) || r.ValuePositiveIntElement != nil && !r.ValuePositiveIntElement.EqualsDeep(*other.ValuePositiveIntElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.EqualsDeep(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUnsignedIntElement == nil) != (other.ValueUnsignedIntElement == nil) || r.ValueUnsignedIntElement != nil && !r.ValueUnsignedIntElement.EqualsDeep(*other.ValueUnsignedIntElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.EqualsDeep(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && !equivalentString(*r.ValueUrl, *other.ValueUrl) {
+		return false
+	}
+	if (r.ValueUrlElement == nil) != (other.ValueUrlElement == nil) || r.ValueUrlElement != nil && !r.ValueUrlElement.EqualsDeep(*other.ValueUrlElement) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && !equivalentString(*r.ValueUuid, *other.ValueUuid) {
+		return false
+	}
+	if (r.ValueUuidElement == nil) != (other.ValueUuidElement == nil) || r.ValueUuidElement != nil && !r.ValueUuidElement.EqualsDeep(*other.ValueUuidElement) {
+		return false
+	}
+	if (r.ValueAddress == nil) != (other.ValueAddress == nil) || r.ValueAddress != nil && !r.ValueAddress.EqualsDeep(*other.ValueAddress) {
+		return false
+	}
+	if (r.ValueAge == nil) != (other.ValueAge == nil) || r.ValueAge != nil && !r.ValueAge.EqualsDeep(*other.ValueAge) {
+		return false
+	}
+	if (r.ValueAnnotation == nil) != (other.ValueAnnotation == nil) || r.ValueAnnotation != nil && !r.ValueAnnotation.EqualsDeep(*other.ValueAnnotation) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.EqualsDeep(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.EqualsDeep(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.EqualsDeep(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueContactPoint == nil) != (other.ValueContactPoint == nil) || r.ValueContactPoint != nil && !r.ValueContactPoint.EqualsDeep(*other.ValueContactPoint) {
+		return false
+	}
+	if (r.ValueCount == nil) != (other.ValueCount == nil) || r.ValueCount != nil && !r.ValueCount.EqualsDeep(*other.ValueCount) {
+		return false
+	}
+	if (r.ValueDistance == nil) != (other.ValueDistance == nil) || r.ValueDistance != nil && !r.ValueDistance.EqualsDeep(*other.ValueDistance) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.EqualsDeep(*other.ValueDuration) {
+		return false
+	}
+	if (r.ValueHumanName == nil) != (other.ValueHumanName == nil) || r.ValueHumanName != nil && !r.ValueHumanName.EqualsDeep(*other.ValueHumanName) {
+		return false
+	}
+	if (r.ValueIdentifier == nil) != (other.ValueIdentifier == nil) || r.ValueIdentifier != nil && !r.ValueIdentifier.EqualsDeep(*other.ValueIdentifier) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.EqualsDeep(*other.ValueMoney) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.EqualsDeep(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.EqualsDeep(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.EqualsDeep(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.EqualsDeep(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueSignature == nil) != (other.ValueSignature == nil) || r.ValueSignature != nil && !r.ValueSignature.EqualsDeep(*other.ValueSignature) {
+		return false
+	}
+	if (r.ValueTiming == nil) != (other.ValueTiming == nil) || r.ValueTiming != nil && !r.ValueTiming.EqualsDeep(*other.ValueTiming) {
+		return false
+	}
+	if (r.ValueContactDetail == nil) != (other.ValueContactDetail == nil) || r.ValueContactDetail != nil && !r.ValueContactDetail.EqualsDeep(*other.ValueContactDetail) {
+		return false
+	}
+	if (r.ValueContributor == nil) != (other.ValueContributor == nil) || r.ValueContributor != nil && !r.ValueContributor.EqualsDeep(*other.ValueContributor) {
+		return false
+	}
+	if (r.ValueDataRequirement == nil) != (other.ValueDataRequirement == nil) || r.ValueDataRequirement != nil && !r.ValueDataRequirement.EqualsDeep(*other.ValueDataRequirement) {
+		return false
+	}
+	if (r.ValueExpression == nil) != (other.ValueExpression == nil) || r.ValueExpression != nil && !r.ValueExpression.EqualsDeep(*other.ValueExpression) {
+		return false
+	}
+	if (r.ValueParameterDefinition == nil) != (other.ValueParameterDefinition == nil) || r.ValueParameterDefinition != nil && !r.ValueParameterDefinition.EqualsDeep(*other.ValueParameterDefinition) {
+		return false
+	}
+	if (r.ValueRelatedArtifact == nil) != (other.ValueRelatedArtifact == nil) || r.ValueRelatedArtifact != nil && !r.ValueRelatedArtifact.EqualsDeep(*other.ValueRelatedArtifact) {
+		return false
+	}
+	if (r.ValueTriggerDefinition == nil) != (other.ValueTriggerDefinition == nil) || r.ValueTriggerDefinition != nil && !r.ValueTriggerDefinition.EqualsDeep(*other.ValueTriggerDefinition) {
+		return false
+	}
+	if (r.ValueUsageContext == nil) != (other.ValueUsageContext == nil) || r.ValueUsageContext != nil && !r.ValueUsageContext.EqualsDeep(*other.ValueUsageContext) {
+		return false
+	}
+	if (r.ValueDosage == nil) != (other.ValueDosage == nil) || r.ValueDosage != nil && !r.ValueDosage.EqualsDeep(*other.ValueDosage) {
+		return false
+	}
+	if (r.ValueMeta == nil) != (other.ValueMeta == nil) || r.ValueMeta != nil && !r.ValueMeta.EqualsDeep(*other.ValueMeta) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ElementDefinitionExample) EqualsShallow(other ElementDefinitionExample) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Label, other.Label) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && !equivalentString(*r.ValueCanonical, *other.ValueCanonical) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && !equivalentString(*r.ValueInstant, *other.ValueInstant) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && !equivalentString(*r.ValueMarkdown, *other.ValueMarkdown) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && !equivalentString(*r.ValueOid, *other.ValueOid) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && !equivalentString(*r.ValueUrl, *other.ValueUrl) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && !equivalentString(*r.ValueUuid, *other.ValueUuid) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ElementDefinitionExample as FHIR XML
+func (r ElementDefinitionExample) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "label", r.Label, r.LabelElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBase64Binary", r.ValueBase64Binary, r.ValueBase64BinaryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCanonical", r.ValueCanonical, r.ValueCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCode", r.ValueCode, r.ValueCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDate", r.ValueDate, r.ValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDecimal", r.ValueDecimal, r.ValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueId", r.ValueId, r.ValueIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInstant", r.ValueInstant, r.ValueInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueMarkdown", r.ValueMarkdown, r.ValueMarkdownElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueOid", r.ValueOid, r.ValueOidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valuePositiveInt", r.ValuePositiveInt, r.ValuePositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueTime", r.ValueTime, r.ValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUnsignedInt", r.ValueUnsignedInt, r.ValueUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUri", r.ValueUri, r.ValueUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUrl", r.ValueUrl, r.ValueUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUuid", r.ValueUuid, r.ValueUuidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAddress", r.ValueAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAge", r.ValueAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAnnotation", r.ValueAnnotation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAttachment", r.ValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCodeableConcept", r.ValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCoding", r.ValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContactPoint", r.ValueContactPoint); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCount", r.ValueCount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDistance", r.ValueDistance); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDuration", r.ValueDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueHumanName", r.ValueHumanName); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueIdentifier", r.ValueIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueMoney", r.ValueMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valuePeriod", r.ValuePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRange", r.ValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRatio", r.ValueRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSampledData", r.ValueSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSignature", r.ValueSignature); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueTiming", r.ValueTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContactDetail", r.ValueContactDetail); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContributor", r.ValueContributor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDataRequirement", r.ValueDataRequirement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueExpression", r.ValueExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueParameterDefinition", r.ValueParameterDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRelatedArtifact", r.ValueRelatedArtifact); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueTriggerDefinition", r.ValueTriggerDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueUsageContext", r.ValueUsageContext); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDosage", r.ValueDosage); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueMeta", r.ValueMeta); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ElementDefinitionExample from FHIR XML
+func (r *ElementDefinitionExample) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "label":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Label = v
+				}
+				r.LabelElement = element
+			case "valueBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBase64Binary = &v
+				}
+				r.ValueBase64BinaryElement = element
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCanonical = &v
+				}
+				r.ValueCanonicalElement = element
+			case "valueCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCode = &v
+				}
+				r.ValueCodeElement = element
+			case "valueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDate = &v
+				}
+				r.ValueDateElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDecimal = &v
+				}
+				r.ValueDecimalElement = element
+			case "valueId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueId = &v
+				}
+				r.ValueIdElement = element
+			case "valueInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInstant = &v
+				}
+				r.ValueInstantElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueMarkdown":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueMarkdown = &v
+				}
+				r.ValueMarkdownElement = element
+			case "valueOid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueOid = &v
+				}
+				r.ValueOidElement = element
+			case "valuePositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValuePositiveInt = &v
+				}
+				r.ValuePositiveIntElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueTime = &v
+				}
+				r.ValueTimeElement = element
+			case "valueUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUnsignedInt = &v
+				}
+				r.ValueUnsignedIntElement = element
+			case "valueUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUri = &v
+				}
+				r.ValueUriElement = element
+			case "valueUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUrl = &v
+				}
+				r.ValueUrlElement = element
+			case "valueUuid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUuid = &v
+				}
+				r.ValueUuidElement = element
+			case "valueAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAddress = &v
+			case "valueAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAge = &v
+			case "valueAnnotation":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAnnotation = &v
+			case "valueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAttachment = &v
+			case "valueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCodeableConcept = &v
+			case "valueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCoding = &v
+			case "valueContactPoint":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContactPoint = &v
+			case "valueCount":
+				var v Count
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCount = &v
+			case "valueDistance":
+				var v Distance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDistance = &v
+			case "valueDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDuration = &v
+			case "valueHumanName":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueHumanName = &v
+			case "valueIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueIdentifier = &v
+			case "valueMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueMoney = &v
+			case "valuePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValuePeriod = &v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRange = &v
+			case "valueRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRatio = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			case "valueSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSampledData = &v
+			case "valueSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSignature = &v
+			case "valueTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueTiming = &v
+			case "valueContactDetail":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContactDetail = &v
+			case "valueContributor":
+				var v Contributor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContributor = &v
+			case "valueDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDataRequirement = &v
+			case "valueExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueExpression = &v
+			case "valueParameterDefinition":
+				var v ParameterDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueParameterDefinition = &v
+			case "valueRelatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRelatedArtifact = &v
+			case "valueTriggerDefinition":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueTriggerDefinition = &v
+			case "valueUsageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueUsageContext = &v
+			case "valueDosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDosage = &v
+			case "valueMeta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueMeta = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ElementDefinitionExample as properties to the RDF node n
+func (r ElementDefinitionExample) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("ElementDefinition.example.label", -1, r.Label, r.LabelElement, "string")
+	n.primitive("ElementDefinition.example.valueBase64Binary", -1, r.ValueBase64Binary, r.ValueBase64BinaryElement, "base64Binary")
+	n.primitive("ElementDefinition.example.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("ElementDefinition.example.valueCanonical", -1, r.ValueCanonical, r.ValueCanonicalElement, "canonical")
+	n.primitive("ElementDefinition.example.valueCode", -1, r.ValueCode, r.ValueCodeElement, "code")
+	n.primitive("ElementDefinition.example.valueDate", -1, r.ValueDate, r.ValueDateElement, "date")
+	n.primitive("ElementDefinition.example.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.primitive("ElementDefinition.example.valueDecimal", -1, r.ValueDecimal, r.ValueDecimalElement, "decimal")
+	n.primitive("ElementDefinition.example.valueId", -1, r.ValueId, r.ValueIdElement, "id")
+	n.primitive("ElementDefinition.example.valueInstant", -1, r.ValueInstant, r.ValueInstantElement, "instant")
+	n.primitive("ElementDefinition.example.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("ElementDefinition.example.valueMarkdown", -1, r.ValueMarkdown, r.ValueMarkdownElement, "markdown")
+	n.primitive("ElementDefinition.example.valueOid", -1, r.ValueOid, r.ValueOidElement, "oid")
+	n.primitive("ElementDefinition.example.valuePositiveInt", -1, r.ValuePositiveInt, r.ValuePositiveIntElement, "positiveInt")
+	n.primitive("ElementDefinition.example.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("ElementDefinition.example.valueTime", -1, r.ValueTime, r.ValueTimeElement, "time")
+	n.primitive("ElementDefinition.example.valueUnsignedInt", -1, r.ValueUnsignedInt, r.ValueUnsignedIntElement, "unsignedInt")
+	n.primitive("ElementDefinition.example.valueUri", -1, r.ValueUri, r.ValueUriElement, "uri")
+	n.primitive("ElementDefinition.example.valueUrl", -1, r.ValueUrl, r.ValueUrlElement, "url")
+	n.primitive("ElementDefinition.example.valueUuid", -1, r.ValueUuid, r.ValueUuidElement, "uuid")
+	n.element("ElementDefinition.example.valueAddress", -1, r.ValueAddress)
+	n.element("ElementDefinition.example.valueAge", -1, r.ValueAge)
+	n.element("ElementDefinition.example.valueAnnotation", -1, r.ValueAnnotation)
+	n.element("ElementDefinition.example.valueAttachment", -1, r.ValueAttachment)
+	n.element("ElementDefinition.example.valueCodeableConcept", -1, r.ValueCodeableConcept)
+	n.element("ElementDefinition.example.valueCoding", -1, r.ValueCoding)
+	n.element("ElementDefinition.example.valueContactPoint", -1, r.ValueContactPoint)
+	n.element("ElementDefinition.example.valueCount", -1, r.ValueCount)
+	n.element("ElementDefinition.example.valueDistance", -1, r.ValueDistance)
+	n.element("ElementDefinition.example.valueDuration", -1, r.ValueDuration)
+	n.element("ElementDefinition.example.valueHumanName", -1, r.ValueHumanName)
+	n.element("ElementDefinition.example.valueIdentifier", -1, r.ValueIdentifier)
+	n.element("ElementDefinition.example.valueMoney", -1, r.ValueMoney)
+	n.element("ElementDefinition.example.valuePeriod", -1, r.ValuePeriod)
+	n.element("ElementDefinition.example.valueQuantity", -1, r.ValueQuantity)
+	n.element("ElementDefinition.example.valueRange", -1, r.ValueRange)
+	n.element("ElementDefinition.example.valueRatio", -1, r.ValueRatio)
+	n.element("ElementDefinition.example.valueReference", -1, r.ValueReference)
+	n.element("ElementDefinition.example.valueSampledData", -1, r.ValueSampledData)
+	n.element("ElementDefinition.example.valueSignature", -1, r.ValueSignature)
+	n.element("ElementDefinition.example.valueTiming", -1, r.ValueTiming)
+	n.element("ElementDefinition.example.valueContactDetail", -1, r.ValueContactDetail)
+	n.element("ElementDefinition.example.valueContributor", -1, r.ValueContributor)
+	n.element("ElementDefinition.example.valueDataRequirement", -1, r.ValueDataRequirement)
+	n.element("ElementDefinition.example.valueExpression", -1, r.ValueExpression)
+	n.element("ElementDefinition.example.valueParameterDefinition", -1, r.ValueParameterDefinition)
+	n.element("ElementDefinition.example.valueRelatedArtifact", -1, r.ValueRelatedArtifact)
+	n.element("ElementDefinition.example.valueTriggerDefinition", -1, r.ValueTriggerDefinition)
+	n.element("ElementDefinition.example.valueUsageContext", -1, r.ValueUsageContext)
+	n.element("ElementDefinition.example.valueDosage", -1, r.ValueDosage)
+	n.element("ElementDefinition.example.valueMeta", -1, r.ValueMeta)
+}
+
+// MarshalBSON marshals the given ElementDefinitionExample as BSON document with the structure of its FHIR JSON
+func (r ElementDefinitionExample) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ElementDefinitionExample from a BSON document with the structure of its FHIR JSON
+func (r *ElementDefinitionExample) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ElementDefinitionExample as protobuf message ElementDefinitionExample of fhir.proto
+func (r ElementDefinitionExample) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ElementDefinitionExample to the encoded message
+func (r ElementDefinitionExample) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Label != "" {
+		e.string(3, r.Label)
+	}
+	if r.ValueBase64Binary != nil {
+		e.string(4, *r.ValueBase64Binary)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(5, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueCanonical != nil {
+		e.string(6, *r.ValueCanonical)
+	}
+	if r.ValueCode != nil {
+		e.string(7, *r.ValueCode)
+	}
+	if r.ValueDate != nil {
+		e.string(8, *r.ValueDate)
+	}
+	if r.ValueDateTime != nil {
+		e.string(9, *r.ValueDateTime)
+	}
+	if r.ValueDecimal != nil {
+		e.string(10, string(*r.ValueDecimal))
+	}
+	if r.ValueId != nil {
+		e.string(11, *r.ValueId)
+	}
+	if r.ValueInstant != nil {
+		e.string(12, *r.ValueInstant)
+	}
+	if r.ValueInteger != nil {
+		e.varint(13, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueMarkdown != nil {
+		e.string(14, *r.ValueMarkdown)
+	}
+	if r.ValueOid != nil {
+		e.string(15, *r.ValueOid)
+	}
+	if r.ValuePositiveInt != nil {
+		e.varint(16, uint64(int64(*r.ValuePositiveInt)))
+	}
+	if r.ValueString != nil {
+		e.string(17, *r.ValueString)
+	}
+	if r.ValueTime != nil {
+		e.string(18, *r.ValueTime)
+	}
+	if r.ValueUnsignedInt != nil {
+		e.varint(19, uint64(int64(*r.ValueUnsignedInt)))
+	}
+	if r.ValueUri != nil {
+		e.string(20, *r.ValueUri)
+	}
+	if r.ValueUrl != nil {
+		e.string(21, *r.ValueUrl)
+	}
+	if r.ValueUuid != nil {
+		e.string(22, *r.ValueUuid)
+	}
+	if r.ValueAddress != nil {
+		e.message(23, *r.ValueAddress)
+	}
+	if r.ValueAge != nil {
+		e.message(24, *r.ValueAge)
+	}
+	if r.ValueAnnotation != nil {
+		e.message(25, *r.ValueAnnotation)
+	}
+	if r.ValueAttachment != nil {
+		e.message(26, *r.ValueAttachment)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.message(27, *r.ValueCodeableConcept)
+	}
+	if r.ValueCoding != nil {
+		e.message(28, *r.ValueCoding)
+	}
+	if r.ValueContactPoint != nil {
+		e.message(29, *r.ValueContactPoint)
+	}
+	if r.ValueCount != nil {
+		e.message(30, *r.ValueCount)
+	}
+	if r.ValueDistance != nil {
+		e.message(31, *r.ValueDistance)
+	}
+	if r.ValueDuration != nil {
+		e.message(32, *r.ValueDuration)
+	}
+	if r.ValueHumanName != nil {
+		e.message(33, *r.ValueHumanName)
+	}
+	if r.ValueIdentifier != nil {
+		e.message(34, *r.ValueIdentifier)
+	}
+	if r.ValueMoney != nil {
+		e.message(35, *r.ValueMoney)
+	}
+	if r.ValuePeriod != nil {
+		e.message(36, *r.ValuePeriod)
+	}
+	if r.ValueQuantity != nil {
+		e.message(37, *r.ValueQuantity)
+	}
+	if r.ValueRange != nil {
+		e.message(38, *r.ValueRange)
+	}
+	if r.ValueRatio != nil {
+		e.message(39, *r.ValueRatio)
+	}
+	if r.ValueReference != nil {
+		e.message(40, *r.ValueReference)
+	}
+	if r.ValueSampledData != nil {
+		e.message(41, *r.ValueSampledData)
+	}
+	if r.ValueSignature != nil {
+		e.message(42, *r.ValueSignature)
+	}
+	if r.ValueTiming != nil {
+		e.message(43, *r.ValueTiming)
+	}
+	if r.ValueContactDetail != nil {
+		e.message(44, *r.ValueContactDetail)
+	}
+	if r.ValueContributor != nil {
+		e.message(45, *r.ValueContributor)
+	}
+	if r.ValueDataRequirement != nil {
+		e.message(46, *r.ValueDataRequirement)
+	}
+	if r.ValueExpression != nil {
+		e.message(47, *r.ValueExpression)
+	}
+	if r.ValueParameterDefinition != nil {
+		e.message(48, *r.ValueParameterDefinition)
+	}
+	if r.ValueRelatedArtifact != nil {
+		e.message(49, *r.ValueRelatedArtifact)
+	}
+	if r.ValueTriggerDefinition != nil {
+		e.message(50, *r.ValueTriggerDefinition)
+	}
+	if r.ValueUsageContext != nil {
+		e.message(51, *r.ValueUsageContext)
+	}
+	if r.ValueDosage != nil {
+		e.message(52, *r.ValueDosage)
+	}
+	if r.ValueMeta != nil {
+		e.message(53, *r.ValueMeta)
+	}
+	if r.LabelElement != nil {
+		e.message(54, *r.LabelElement)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.message(55, *r.ValueBase64BinaryElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(56, *r.ValueBooleanElement)
+	}
+	if r.ValueCanonicalElement != nil {
+		e.message(57, *r.ValueCanonicalElement)
+	}
+	if r.ValueCodeElement != nil {
+		e.message(58, *r.ValueCodeElement)
+	}
+	if r.ValueDateElement != nil {
+		e.message(59, *r.ValueDateElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(60, *r.ValueDateTimeElement)
+	}
+	if r.ValueDecimalElement != nil {
+		e.message(61, *r.ValueDecimalElement)
+	}
+	if r.ValueIdElement != nil {
+		e.message(62, *r.ValueIdElement)
+	}
+	if r.ValueInstantElement != nil {
+		e.message(63, *r.ValueInstantElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(64, *r.ValueIntegerElement)
+	}
+	if r.ValueMarkdownElement != nil {
+		e.message(65, *r.ValueMarkdownElement)
+	}
+	if r.ValueOidElement != nil {
+		e.message(66, *r.ValueOidElement)
+	}
+	if r.ValuePositiveIntElement != nil {
+		e.message(67, *r.ValuePositiveIntElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(68, *r.ValueStringElement)
+	}
+	if r.ValueTimeElement != nil {
+		e.message(69, *r.ValueTimeElement)
+	}
+	if r.ValueUnsignedIntElement != nil {
+		e.message(70, *r.ValueUnsignedIntElement)
+	}
+	if r.ValueUriElement != nil {
+		e.message(71, *r.ValueUriElement)
+	}
+	if r.ValueUrlElement != nil {
+		e.message(72, *r.ValueUrlElement)
+	}
+	if r.ValueUuidElement != nil {
+		e.message(73, *r.ValueUuidElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ElementDefinitionExample from protobuf message ElementDefinitionExample of fhir.proto
+func (r *ElementDefinitionExample) UnmarshalProto(b []byte) error {
+	*r = ElementDefinitionExample{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			r.Label = d.string()
+		case 4:
+			v := d.string()
+			r.ValueBase64Binary = &v
+		case 5:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 6:
+			v := d.string()
+			r.ValueCanonical = &v
+		case 7:
+			v := d.string()
+			r.ValueCode = &v
+		case 8:
+			v := d.string()
+			r.ValueDate = &v
+		case 9:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 10:
+			v := json.Number(d.string())
+			r.ValueDecimal = &v
+		case 11:
+			v := d.string()
+			r.ValueId = &v
+		case 12:
+			v := d.string()
+			r.ValueInstant = &v
+		case 13:
+			v := d.int()
+			r.ValueInteger = &v
+		case 14:
+			v := d.string()
+			r.ValueMarkdown = &v
+		case 15:
+			v := d.string()
+			r.ValueOid = &v
+		case 16:
+			v := d.int()
+			r.ValuePositiveInt = &v
+		case 17:
+			v := d.string()
+			r.ValueString = &v
+		case 18:
+			v := d.string()
+			r.ValueTime = &v
+		case 19:
+			v := d.int()
+			r.ValueUnsignedInt = &v
+		case 20:
+			v := d.string()
+			r.ValueUri = &v
+		case 21:
+			v := d.string()
+			r.ValueUrl = &v
+		case 22:
+			v := d.string()
+			r.ValueUuid = &v
+		case 23:
+			var v Address
+			d.message(&v)
+			r.ValueAddress = &v
+		case 24:
+			var v Age
+			d.message(&v)
+			r.ValueAge = &v
+		case 25:
+			var v Annotation
+			d.message(&v)
+			r.ValueAnnotation = &v
+		case 26:
+			var v Attachment
+			d.message(&v)
+			r.ValueAttachment = &v
+		case 27:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCodeableConcept = &v
+		case 28:
+			var v Coding
+			d.message(&v)
+			r.ValueCoding = &v
+		case 29:
+			var v ContactPoint
+			d.message(&v)
+			r.ValueContactPoint = &v
+		case 30:
+			var v Count
+			d.message(&v)
+			r.ValueCount = &v
+		case 31:
+			var v Distance
+			d.message(&v)
+			r.ValueDistance = &v
+		case 32:
+			var v Duration
+			d.message(&v)
+			r.ValueDuration = &v
+		case 33:
+			var v HumanName
+			d.message(&v)
+			r.ValueHumanName = &v
+		case 34:
+			var v Identifier
+			d.message(&v)
+			r.ValueIdentifier = &v
+		case 35:
+			var v Money
+			d.message(&v)
+			r.ValueMoney = &v
+		case 36:
+			var v Period
+			d.message(&v)
+			r.ValuePeriod = &v
+		case 37:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 38:
+			var v Range
+			d.message(&v)
+			r.ValueRange = &v
+		case 39:
+			var v Ratio
+			d.message(&v)
+			r.ValueRatio = &v
+		case 40:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 41:
+			var v SampledData
+			d.message(&v)
+			r.ValueSampledData = &v
+		case 42:
+			var v Signature
+			d.message(&v)
+			r.ValueSignature = &v
+		case 43:
+			var v Timing
+			d.message(&v)
+			r.ValueTiming = &v
+		case 44:
+			var v ContactDetail
+			d.message(&v)
+			r.ValueContactDetail = &v
+		case 45:
+			var v Contributor
+			d.message(&v)
+			r.ValueContributor = &v
+		case 46:
+			var v DataRequirement
+			d.message(&v)
+			r.ValueDataRequirement = &v
+		case 47:
+			var v Expression
+			d.message(&v)
+			r.ValueExpression = &v
+		case 48:
+			var v ParameterDefinition
+			d.message(&v)
+			r.ValueParameterDefinition = &v
+		case 49:
+			var v RelatedArtifact
+			d.message(&v)
+			r.ValueRelatedArtifact = &v
+		case 50:
+			var v TriggerDefinition
+			d.message(&v)
+			r.ValueTriggerDefinition = &v
+		case 51:
+			var v UsageContext
+			d.message(&v)
+			r.ValueUsageContext = &v
+		case 52:
+			var v Dosage
+			d.message(&v)
+			r.ValueDosage = &v
+		case 53:
+			var v Meta
+			d.message(&v)
+			r.ValueMeta = &v
+		case 54:
+			var v Element
+			d.message(&v)
+			r.LabelElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.ValueBase64BinaryElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 57:
+			var v Element
+			d.message(&v)
+			r.ValueCanonicalElement = &v
+		case 58:
+			var v Element
+			d.message(&v)
+			r.ValueCodeElement = &v
+		case 59:
+			var v Element
+			d.message(&v)
+			r.ValueDateElement = &v
+		case 60:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		case 61:
+			var v Element
+			d.message(&v)
+			r.ValueDecimalElement = &v
+		case 62:
+			var v Element
+			d.message(&v)
+			r.ValueIdElement = &v
+		case 63:
+			var v Element
+			d.message(&v)
+			r.ValueInstantElement = &v
+		case 64:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 65:
+			var v Element
+			d.message(&v)
+			r.ValueMarkdownElement = &v
+		case 66:
+			var v Element
+			d.message(&v)
+			r.ValueOidElement = &v
+		case 67:
+			var v Element
+			d.message(&v)
+			r.ValuePositiveIntElement = &v
+		case 68:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 69:
+			var v Element
+			d.message(&v)
+			r.ValueTimeElement = &v
+		case 70:
+			var v Element
+			d.message(&v)
+			r.ValueUnsignedIntElement = &v
+		case 71:
+			var v Element
+			d.message(&v)
+			r.ValueUriElement = &v
+		case 72:
+			var v Element
+			d.message(&v)
+			r.ValueUrlElement = &v
+		case 73:
+			var v Element
+			d.message(&v)
+			r.ValueUuidElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ElementDefinitionConstraint as JSON into a byte slice
+func (r ElementDefinitionConstraint) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ElementDefinitionConstraint as JSON object with the members in the order of the fields
+func (r ElementDefinitionConstraint) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("key")
+	e.string(r.Key)
+	if r.KeyElement != nil {
+		e.key("_key")
+		r.KeyElement.appendJSON(e)
+	}
+	if r.Requirements != nil {
+		e.key("requirements")
+		e.string(*r.Requirements)
+	}
+	if r.RequirementsElement != nil {
+		e.key("_requirements")
+		r.RequirementsElement.appendJSON(e)
+	}
+	e.key("severity")
+	e.string(r.Severity.Code())
+	if r.SeverityElement != nil {
+		e.key("_severity")
+		r.SeverityElement.appendJSON(e)
+	}
+	e.key("human")
+	e.string(r.Human)
+	if r.HumanElement != nil {
+		e.key("_human")
+		r.HumanElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		e.string(*r.Expression)
+	}
+	if r.ExpressionElement != nil {
+		e.key("_expression")
+		r.ExpressionElement.appendJSON(e)
+	}
+	if r.Xpath != nil {
+		e.key("xpath")
+		e.string(*r.Xpath)
+	}
+	if r.XpathElement != nil {
+		e.key("_xpath")
+		r.XpathElement.appendJSON(e)
+	}
+	if r.Source != nil {
+		e.key("source")
+		e.string(*r.Source)
+	}
+	if r.SourceElement != nil {
+		e.key("_source")
+		r.SourceElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ElementDefinitionConstraint from JSON. Unknown members are ignored.
+func (r *ElementDefinitionConstraint) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ElementDefinitionConstraint
+func (r *ElementDefinitionConstraint) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "key":
+			if v, ok := d.string(); ok {
+				r.Key = v
+			}
+		case "_key":
+			r.KeyElement = d.element()
+		case "requirements":
+			if v, ok := d.string(); ok {
+				r.Requirements = &v
+			} else {
+				r.Requirements = nil
+			}
+		case "_requirements":
+			r.RequirementsElement = d.element()
+		case "severity":
+			d.unmarshal(&r.Severity)
+		case "_severity":
+			r.SeverityElement = d.element()
+		case "human":
+			if v, ok := d.string(); ok {
+				r.Human = v
+			}
+		case "_human":
+			r.HumanElement = d.element()
+		case "expression":
+			if v, ok := d.string(); ok {
+				r.Expression = &v
+			} else {
+				r.Expression = nil
+			}
+		case "_expression":
+			r.ExpressionElement = d.element()
+		case "xpath":
+			if v, ok := d.string(); ok {
+				r.Xpath = &v
+			} else {
+				r.Xpath = nil
+			}
+		case "_xpath":
+			r.XpathElement = d.element()
+		case "source":
+			if v, ok := d.string(); ok {
+				r.Source = &v
+			} else {
+				r.Source = nil
+			}
+		case "_source":
+			r.SourceElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ElementDefinitionConstraint which shares no memory with the original
+func (r ElementDefinitionConstraint) DeepCopy() ElementDefinitionConstraint {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.KeyElement != nil {
+		v := r.KeyElement.DeepCopy()
+		out.KeyElement = &v
+	}
+	if r.Requirements != nil {
+		v := *r.Requirements
+		out.Requirements = &v
+	}
+	if r.RequirementsElement != nil {
+		v := r.RequirementsElement.DeepCopy()
+		out.RequirementsElement = &v
+	}
+	if r.SeverityElement != nil {
+		v := r.SeverityElement.DeepCopy()
+		out.SeverityElement = &v
+	}
+	if r.HumanElement != nil {
+		v := r.HumanElement.DeepCopy()
+		out.HumanElement = &v
+	}
+	if r.Expression != nil {
+		v := *r.Expression
+		out.Expression = &v
+	}
+	if r.ExpressionElement != nil {
+		v := r.ExpressionElement.DeepCopy()
+		out.ExpressionElement = &v
+	}
+	if r.Xpath != nil {
+		v := *r.Xpath
+		out.Xpath = &v
+	}
+	if r.XpathElement != nil {
+		v := r.XpathElement.DeepCopy()
+		out.XpathElement = &v
+	}
+	if r.Source != nil {
+		v := *r.Source
+		out.Source = &v
+	}
+	if r.SourceElement != nil {
+		v := r.SourceElement.DeepCopy()
+		out.SourceElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ElementDefinitionConstraint) Equal(other ElementDefinitionConstraint) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Key != other.Key {
+		return false
+	}
+	if (r.KeyElement == nil) != (other.KeyElement == nil) || r.KeyElement != nil && !r.KeyElement.Equal(*other.KeyElement) {
+		return false
+	}
+	if (r.Requirements == nil) != (other.Requirements == nil) || r.Requirements != nil && *r.Requirements != *other.Requirements {
+		return false
+	}
+	if (r.RequirementsElement == nil) != (other.RequirementsElement == nil) || r.RequirementsElement != nil && !r.RequirementsElement.Equal(*other.RequirementsElement) {
+		return false
+	}
+	if r.Severity != other.Severity {
+		return false
+	}
+	if (r.SeverityElement == nil) != (other.SeverityElement == nil) || r.SeverityElement != nil && !r.SeverityElement.Equal(*other.SeverityElement) {
+		return false
+	}
+	if r.Human != other.Human {
+		return false
+	}
+	if (r.HumanElement == nil) != (other.HumanElement == nil) || r.HumanElement != nil && !r.HumanElement.Equal(*other.HumanElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && *r.Expression != *other.Expression {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.Equal(*other.ExpressionElement) {
+		return false
+	}
+	if (r.Xpath == nil) != (other.Xpath == nil) || r.Xpath != nil && *r.Xpath != *other.Xpath {
+		return false
+	}
+	if (r.XpathElement == nil) != (other.XpathElement == nil) || r.XpathElement != nil && !r.XpathElement.Equal(*other.XpathElement) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && *r.Source != *other.Source {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.Equal(*other.SourceElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ElementDefinitionConstraint) EqualsDeep(other ElementDefinitionConstraint) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Key, other.Key) {
+		return false
+	}
+	if (r.KeyElement == nil) != (other.KeyElement == nil) || r.KeyElement != nil && !r.KeyElement.EqualsDeep(*other.KeyElement) {
+		return false
+	}
+	if (r.Requirements == nil) != (other.Requirements == nil) || r.Requirements != nil && !equivalentString(*r.Requirements, *other.Requirements) {
+		return false
+	}
+	if (r.RequirementsElement == nil) != (other.RequirementsElement == nil) || r.RequirementsElement != nil && !r.RequirementsElement.EqualsDeep(*other.RequirementsElement) {
+		return false
+	}
+	if r.Severity != other.Severity {
+		return false
+	}
+	if (r.SeverityElement == nil) != (other.SeverityElement == nil) || r.SeverityElement != nil && !r.SeverityElement.EqualsDeep(*other.SeverityElement) {
+		return false
+	}
+	if !equivalentString(r.Human, other.Human) {
+		return false
+	}
+	if (r.HumanElement == nil) != (other.HumanElement == nil) || r.HumanElement != nil && !r.HumanElement.EqualsDeep(*other.HumanElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.EqualsDeep(*other.ExpressionElement) {
+		return false
+	}
+	if (r.Xpath == nil) != (other.Xpath == nil) || r.Xpath != nil && !equivalentString(*r.Xpath, *other.Xpath) {
+		return false
+	}
+	if (r.XpathElement == nil) != (other.XpathElement == nil) || r.XpathElement != nil && !r.XpathElement.EqualsDeep(*other.XpathElement) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.EqualsDeep(*other.SourceElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ElementDefinitionConstraint) EqualsShallow(other ElementDefinitionConstraint) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Key, other.Key) {
+		return false
+	}
+	if (r.Requirements == nil) != (other.Requirements == nil) || r.Requirements != nil && !equivalentString(*r.Requirements, *other.Requirements) {
+		return false
+	}
+	if r.Severity != other.Severity {
+		return false
+	}
+	if !equivalentString(r.Human, other.Human) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.Xpath == nil) != (other.Xpath == nil) || r.Xpath != nil && !equivalentString(*r.Xpath, *other.Xpath) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ElementDefinitionConstraint as FHIR XML
+func (r ElementDefinitionConstraint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "key", r.Key, r.KeyElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "requirements", r.Requirements, r.RequirementsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "severity", r.Severity, r.SeverityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "human", r.Human, r.HumanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expression", r.Expression, r.ExpressionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "xpath", r.Xpath, r.XpathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "source", r.Source, r.SourceElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ElementDefinitionConstraint from FHIR XML
+func (r *ElementDefinitionConstraint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "key":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Key = v
+				}
+				r.KeyElement = element
+			case "requirements":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Requirements = &v
+				}
+				r.RequirementsElement = element
+			case "severity":
+				var v ConstraintSeverity
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Severity = v
+				}
+				r.SeverityElement = element
+			case "human":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Human = v
+				}
+				r.HumanElement = element
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Expression = &v
+				}
+				r.ExpressionElement = element
+			case "xpath":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Xpath = &v
+				}
+				r.XpathElement = element
+			case "source":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Source = &v
+				}
+				r.SourceElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ElementDefinitionConstraint as properties to the RDF node n
+func (r ElementDefinitionConstraint) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("ElementDefinition.constraint.key", -1, r.Key, r.KeyElement, "string")
+	n.primitive("ElementDefinition.constraint.requirements", -1, r.Requirements, r.RequirementsElement, "string")
+	n.primitive("ElementDefinition.constraint.severity", -1, r.Severity, r.SeverityElement, "code")
+	n.primitive("ElementDefinition.constraint.human", -1, r.Human, r.HumanElement, "string")
+	n.primitive("ElementDefinition.constraint.expression", -1, r.Expression, r.ExpressionElement, "string")
+	n.primitive("ElementDefinition.constraint.xpath", -1, r.Xpath, r.XpathElement, "string")
+	n.primitive("ElementDefinition.constraint.source", -1, r.Source, r.SourceElement, "string")
+}
+
+// MarshalBSON marshals the given ElementDefinitionConstraint as BSON document with the structure of its FHIR JSON
+func (r ElementDefinitionConstraint) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ElementDefinitionConstraint from a BSON document with the structure of its FHIR JSON
+func (r *ElementDefinitionConstraint) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ElementDefinitionConstraint as protobuf message ElementDefinitionConstraint of fhir.proto
+func (r ElementDefinitionConstraint) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ElementDefinitionConstraint to the encoded message
+func (r ElementDefinitionConstraint) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Key != "" {
+		e.string(3, r.Key)
+	}
+	if r.Requirements != nil {
+		e.string(4, *r.Requirements)
+	}
+	e.varint(5, r.Severity.protoNumber())
+	if r.Human != "" {
+		e.string(6, r.Human)
+	}
+	if r.Expression != nil {
+		e.string(7, *r.Expression)
+	}
+	if r.Xpath != nil {
+		e.string(8, *r.Xpath)
+	}
+	if r.Source != nil {
+		e.string(9, *r.Source)
+	}
+	if r.KeyElement != nil {
+		e.message(10, *r.KeyElement)
+	}
+	if r.RequirementsElement != nil {
+		e.message(11, *r.RequirementsElement)
+	}
+	if r.SeverityElement != nil {
+		e.message(12, *r.SeverityElement)
+	}
+	if r.HumanElement != nil {
+		e.message(13, *r.HumanElement)
+	}
+	if r.ExpressionElement != nil {
+		e.message(14, *r.ExpressionElement)
+	}
+	if r.XpathElement != nil {
+		e.message(15, *r.XpathElement)
+	}
+	if r.SourceElement != nil {
+		e.message(16, *r.SourceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ElementDefinitionConstraint from protobuf message ElementDefinitionConstraint of fhir.proto
+func (r *ElementDefinitionConstraint) UnmarshalProto(b []byte) error {
+	*r = ElementDefinitionConstraint{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			r.Key = d.string()
+		case 4:
+			v := d.string()
+			r.Requirements = &v
+		case 5:
+			var v ConstraintSeverity
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Severity = v
+		case 6:
+			r.Human = d.string()
+		case 7:
+			v := d.string()
+			r.Expression = &v
+		case 8:
+			v := d.string()
+			r.Xpath = &v
+		case 9:
+			v := d.string()
+			r.Source = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.KeyElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.RequirementsElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.SeverityElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.HumanElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.ExpressionElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.XpathElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.SourceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ElementDefinitionBinding as JSON into a byte slice
+func (r ElementDefinitionBinding) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ElementDefinitionBinding as JSON object with the members in the order of the fields
+func (r ElementDefinitionBinding) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("strength")
+	e.string(r.Strength.Code())
+	if r.StrengthElement != nil {
+		e.key("_strength")
+		r.StrengthElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.ValueSet != nil {
+		e.key("valueSet")
+		e.string(*r.ValueSet)
+	}
+	if r.ValueSetElement != nil {
+		e.key("_valueSet")
+		r.ValueSetElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ElementDefinitionBinding from JSON. Unknown members are ignored.
+func (r *ElementDefinitionBinding) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ElementDefinitionBinding
+func (r *ElementDefinitionBinding) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "strength":
+			d.unmarshal(&r.Strength)
+		case "_strength":
+			r.StrengthElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "valueSet":
+			if v, ok := d.string(); ok {
+				r.ValueSet = &v
+			} else {
+				r.ValueSet = nil
+			}
+		case "_valueSet":
+			r.ValueSetElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ElementDefinitionBinding which shares no memory with the original
+func (r ElementDefinitionBinding) DeepCopy() ElementDefinitionBinding {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.StrengthElement != nil {
+		v := r.StrengthElement.DeepCopy()
+		out.StrengthElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.ValueSet != nil {
+		v := *r.ValueSet
+		out.ValueSet = &v
+	}
+	if r.ValueSetElement != nil {
+		v := r.ValueSetElement.DeepCopy()
+		out.ValueSetElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ElementDefinitionBinding) Equal(other ElementDefinitionBinding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Strength != other.Strength {
+		return false
+	}
+	if (r.StrengthElement == nil) != (other.StrengthElement == nil) || r.StrengthElement != nil && !r.StrengthElement.Equal(*other.StrengthElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.ValueSet == nil) != (other.ValueSet == nil) || r.ValueSet != nil && *r.ValueSet != *other.ValueSet {
+		return false
+	}
+	if (r.ValueSetElement == nil) != (other.ValueSetElement == nil) || r.ValueSetElement != nil && !r.ValueSetElement.Equal(*other.ValueSetElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ElementDefinitionBinding) EqualsDeep(other ElementDefinitionBinding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if r.Strength != other.Strength {
+		return false
+	}
+	if (r.StrengthElement == nil) != (other.StrengthElement == nil) || r.StrengthElement != nil && !r.StrengthElement.EqualsDeep(*other.StrengthElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.ValueSet == nil) != (other.ValueSet == nil) || r.ValueSet != nil && !equivalentString(*r.ValueSet, *other.ValueSet) {
+		return false
+	}
+	if (r.ValueSetElement == nil) != (other.ValueSetElement == nil) || r.ValueSetElement != nil && !r.ValueSetElement.EqualsDeep(*other.ValueSetElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ElementDefinitionBinding) EqualsShallow(other ElementDefinitionBinding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Strength != other.Strength {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.ValueSet == nil) != (other.ValueSet == nil) || r.ValueSet != nil && !equivalentString(*r.ValueSet, *other.ValueSet) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ElementDefinitionBinding as FHIR XML
+func (r ElementDefinitionBinding) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "strength", r.Strength, r.StrengthElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueSet", r.ValueSet, r.ValueSetElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ElementDefinitionBinding from FHIR XML
+func (r *ElementDefinitionBinding) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "strength":
+				var v BindingStrength
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Strength = v
+				}
+				r.StrengthElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "valueSet":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueSet = &v
+				}
+				r.ValueSetElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ElementDefinitionBinding as properties to the RDF node n
+func (r ElementDefinitionBinding) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("ElementDefinition.binding.strength", -1, r.Strength, r.StrengthElement, "code")
+	n.primitive("ElementDefinition.binding.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("ElementDefinition.binding.valueSet", -1, r.ValueSet, r.ValueSetElement, "string")
+}
+
+// MarshalBSON marshals the given ElementDefinitionBinding as BSON document with the structure of its FHIR JSON
+func (r ElementDefinitionBinding) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ElementDefinitionBinding from a BSON document with the structure of its FHIR JSON
+func (r *ElementDefinitionBinding) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ElementDefinitionBinding as protobuf message ElementDefinitionBinding of fhir.proto
+func (r ElementDefinitionBinding) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ElementDefinitionBinding to the encoded message
+func (r ElementDefinitionBinding) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	e.varint(3, r.Strength.protoNumber())
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.ValueSet != nil {
+		e.string(5, *r.ValueSet)
+	}
+	if r.StrengthElement != nil {
+		e.message(6, *r.StrengthElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(7, *r.DescriptionElement)
+	}
+	if r.ValueSetElement != nil {
+		e.message(8, *r.ValueSetElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ElementDefinitionBinding from protobuf message ElementDefinitionBinding of fhir.proto
+func (r *ElementDefinitionBinding) UnmarshalProto(b []byte) error {
+	*r = ElementDefinitionBinding{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v BindingStrength
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Strength = v
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			v := d.string()
+			r.ValueSet = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.StrengthElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ValueSetElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ElementDefinitionMapping as JSON into a byte slice
+func (r ElementDefinitionMapping) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ElementDefinitionMapping as JSON object with the members in the order of the fields
+func (r ElementDefinitionMapping) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("identity")
+	e.string(r.Identity)
+	if r.IdentityElement != nil {
+		e.key("_identity")
+		r.IdentityElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	e.key("map")
+	e.string(r.Map)
+	if r.MapElement != nil {
+		e.key("_map")
+		r.MapElement.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ElementDefinitionMapping from JSON. Unknown members are ignored.
+func (r *ElementDefinitionMapping) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ElementDefinitionMapping
+func (r *ElementDefinitionMapping) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "identity":
+			if v, ok := d.string(); ok {
+				r.Identity = v
+			}
+		case "_identity":
+			r.IdentityElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "map":
+			if v, ok := d.string(); ok {
+				r.Map = v
+			}
+		case "_map":
+			r.MapElement = d.element()
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ElementDefinitionMapping which shares no memory with the original
+func (r ElementDefinitionMapping) DeepCopy() ElementDefinitionMapping {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.IdentityElement != nil {
+		v := r.IdentityElement.DeepCopy()
+		out.IdentityElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.MapElement != nil {
+		v := r.MapElement.DeepCopy()
+		out.MapElement = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ElementDefinitionMapping) Equal(other ElementDefinitionMapping) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if r.Identity != other.Identity {
+		return false
+	}
+	if (r.IdentityElement == nil) != (other.IdentityElement == nil) || r.IdentityElement != nil && !r.IdentityElement.Equal(*other.IdentityElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if r.Map != other.Map {
+		return false
+	}
+	if (r.MapElement == nil) != (other.MapElement == nil) || r.MapElement != nil && !r.MapElement.Equal(*other.MapElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ElementDefinitionMapping) EqualsDeep(other ElementDefinitionMapping) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Identity, other.Identity) {
+		return false
+	}
+	if (r.IdentityElement == nil) != (other.IdentityElement == nil) || r.IdentityElement != nil && !r.IdentityElement.EqualsDeep(*other.IdentityElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if !equivalentString(r.Map, other.Map) {
+		return false
+	}
+	if (r.MapElement == nil) != (other.MapElement == nil) || r.MapElement != nil && !r.MapElement.EqualsDeep(*other.MapElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ElementDefinitionMapping) EqualsShallow(other ElementDefinitionMapping) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Identity, other.Identity) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Map, other.Map) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ElementDefinitionMapping as FHIR XML
+func (r ElementDefinitionMapping) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "identity", r.Identity, r.IdentityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "map", r.Map, r.MapElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ElementDefinitionMapping from FHIR XML
+func (r *ElementDefinitionMapping) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "identity":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Identity = v
+				}
+				r.IdentityElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "map":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Map = v
+				}
+				r.MapElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ElementDefinitionMapping as properties to the RDF node n
+func (r ElementDefinitionMapping) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("ElementDefinition.mapping.identity", -1, r.Identity, r.IdentityElement, "string")
+	n.primitive("ElementDefinition.mapping.language", -1, r.Language, r.LanguageElement, "string")
+	n.primitive("ElementDefinition.mapping.map", -1, r.Map, r.MapElement, "string")
+	n.primitive("ElementDefinition.mapping.comment", -1, r.Comment, r.CommentElement, "string")
+}
+
+// MarshalBSON marshals the given ElementDefinitionMapping as BSON document with the structure of its FHIR JSON
+func (r ElementDefinitionMapping) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ElementDefinitionMapping from a BSON document with the structure of its FHIR JSON
+func (r *ElementDefinitionMapping) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ElementDefinitionMapping as protobuf message ElementDefinitionMapping of fhir.proto
+func (r ElementDefinitionMapping) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ElementDefinitionMapping to the encoded message
+func (r ElementDefinitionMapping) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Identity != "" {
+		e.string(3, r.Identity)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Map != "" {
+		e.string(5, r.Map)
+	}
+	if r.Comment != nil {
+		e.string(6, *r.Comment)
+	}
+	if r.IdentityElement != nil {
+		e.message(7, *r.IdentityElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(8, *r.LanguageElement)
+	}
+	if r.MapElement != nil {
+		e.message(9, *r.MapElement)
+	}
+	if r.CommentElement != nil {
+		e.message(10, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ElementDefinitionMapping from protobuf message ElementDefinitionMapping of fhir.proto
+func (r *ElementDefinitionMapping) UnmarshalProto(b []byte) error {
+	*r = ElementDefinitionMapping{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			r.Identity = d.string()
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			r.Map = d.string()
+		case 6:
+			v := d.string()
+			r.Comment = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.IdentityElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.MapElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code EligibilityRequestPurpose) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EligibilityRequestPurpose) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EligibilityRequestPurpose) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "auth-requirements":
 		*code = EligibilityRequestPurposeAuthRequirements
@@ -52,6 +56,12 @@ func (code *EligibilityRequestPurpose) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EligibilityRequestPurpose) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EligibilityRequestPurpose) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EligibilityRequestPurpose) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code EligibilityRequestPurpose) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EligibilityRequestPurpose) protoNumber() uint64 {
+	switch code {
+	case EligibilityRequestPurposeAuthRequirements:
+		return 1
+	case EligibilityRequestPurposeBenefits:
+		return 2
+	case EligibilityRequestPurposeDiscovery:
+		return 3
+	case EligibilityRequestPurposeValidation:
+		return 4
+	}
+	return 0
+}
+func (code *EligibilityRequestPurpose) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EligibilityRequestPurposeAuthRequirements
+	case 2:
+		*code = EligibilityRequestPurposeBenefits
+	case 3:
+		*code = EligibilityRequestPurposeDiscovery
+	case 4:
+		*code = EligibilityRequestPurposeValidation
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code EligibilityResponsePurpose) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EligibilityResponsePurpose) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EligibilityResponsePurpose) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "auth-requirements":
 		*code = EligibilityResponsePurposeAuthRequirements
@@ -52,6 +56,12 @@ func (code *EligibilityResponsePurpose) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EligibilityResponsePurpose) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EligibilityResponsePurpose) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EligibilityResponsePurpose) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code EligibilityResponsePurpose) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EligibilityResponsePurpose) protoNumber() uint64 {
+	switch code {
+	case EligibilityResponsePurposeAuthRequirements:
+		return 1
+	case EligibilityResponsePurposeBenefits:
+		return 2
+	case EligibilityResponsePurposeDiscovery:
+		return 3
+	case EligibilityResponsePurposeValidation:
+		return 4
+	}
+	return 0
+}
+func (code *EligibilityResponsePurpose) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EligibilityResponsePurposeAuthRequirements
+	case 2:
+		*code = EligibilityResponsePurposeBenefits
+	case 3:
+		*code = EligibilityResponsePurposeDiscovery
+	case 4:
+		*code = EligibilityResponsePurposeValidation
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code EnableWhenBehavior) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EnableWhenBehavior) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EnableWhenBehavior) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "all":
 		*code = EnableWhenBehaviorAll
@@ -46,6 +50,12 @@ func (code *EnableWhenBehavior) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EnableWhenBehavior) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EnableWhenBehavior) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EnableWhenBehavior) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code EnableWhenBehavior) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EnableWhenBehavior) protoNumber() uint64 {
+	switch code {
+	case EnableWhenBehaviorAll:
+		return 1
+	case EnableWhenBehaviorAny:
+		return 2
+	}
+	return 0
+}
+func (code *EnableWhenBehavior) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EnableWhenBehaviorAll
+	case 2:
+		*code = EnableWhenBehaviorAny
+	default:
+		return false
+	}
+	return true
+}
@@ -14,49 +14,58 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Encounter is documented here http://hl7.org/fhir/StructureDefinition/Encounter
 type Encounter struct {
-	Id                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            EncounterStatus           `bson:"status" json:"status"`
-	StatusHistory     []EncounterStatusHistory  `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
-	Class             Coding                    `bson:"class" json:"class"`
-	ClassHistory      []EncounterClassHistory   `bson:"classHistory,omitempty" json:"classHistory,omitempty"`
-	Type              []CodeableConcept         `bson:"type,omitempty" json:"type,omitempty"`
-	ServiceType       *CodeableConcept          `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
-	Priority          *CodeableConcept          `bson:"priority,omitempty" json:"priority,omitempty"`
-	Subject           *Reference                `bson:"subject,omitempty" json:"subject,omitempty"`
-	EpisodeOfCare     []Reference               `bson:"episodeOfCare,omitempty" json:"episodeOfCare,omitempty"`
-	BasedOn           []Reference               `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Participant       []EncounterParticipant    `bson:"participant,omitempty" json:"participant,omitempty"`
-	Appointment       []Reference               `bson:"appointment,omitempty" json:"appointment,omitempty"`
-	Period            *Period                   `bson:"period,omitempty" json:"period,omitempty"`
-	Length            *Duration                 `bson:"length,omitempty" json:"length,omitempty"`
-	ReasonCode        []CodeableConcept         `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference   []Reference               `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Diagnosis         []EncounterDiagnosis      `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
-	Account           []Reference               `bson:"account,omitempty" json:"account,omitempty"`
-	Hospitalization   *EncounterHospitalization `bson:"hospitalization,omitempty" json:"hospitalization,omitempty"`
-	Location          []EncounterLocation       `bson:"location,omitempty" json:"location,omitempty"`
-	ServiceProvider   *Reference                `bson:"serviceProvider,omitempty" json:"serviceProvider,omitempty"`
-	PartOf            *Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Id                   *string                   `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                  `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                  `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               EncounterStatus           `bson:"status" json:"status"`
+	StatusElement        *Element                  `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusHistory        []EncounterStatusHistory  `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
+	Class                Coding                    `bson:"class" json:"class"`
+	ClassHistory         []EncounterClassHistory   `bson:"classHistory,omitempty" json:"classHistory,omitempty"`
+	Type                 []CodeableConcept         `bson:"type,omitempty" json:"type,omitempty"`
+	ServiceType          *CodeableConcept          `bson:"serviceType,omitempty" json:"serviceType,omitempty"`
+	Priority             *CodeableConcept          `bson:"priority,omitempty" json:"priority,omitempty"`
+	Subject              *Reference                `bson:"subject,omitempty" json:"subject,omitempty"`
+	EpisodeOfCare        []Reference               `bson:"episodeOfCare,omitempty" json:"episodeOfCare,omitempty"`
+	BasedOn              []Reference               `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Participant          []EncounterParticipant    `bson:"participant,omitempty" json:"participant,omitempty"`
+	Appointment          []Reference               `bson:"appointment,omitempty" json:"appointment,omitempty"`
+	Period               *Period                   `bson:"period,omitempty" json:"period,omitempty"`
+	Length               *Duration                 `bson:"length,omitempty" json:"length,omitempty"`
+	ReasonCode           []CodeableConcept         `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference      []Reference               `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Diagnosis            []EncounterDiagnosis      `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
+	Account              []Reference               `bson:"account,omitempty" json:"account,omitempty"`
+	Hospitalization      *EncounterHospitalization `bson:"hospitalization,omitempty" json:"hospitalization,omitempty"`
+	Location             []EncounterLocation       `bson:"location,omitempty" json:"location,omitempty"`
+	ServiceProvider      *Reference                `bson:"serviceProvider,omitempty" json:"serviceProvider,omitempty"`
+	PartOf               *Reference                `bson:"partOf,omitempty" json:"partOf,omitempty"`
 }
 type EncounterStatusHistory struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Status            EncounterStatus `bson:"status" json:"status"`
+	StatusElement     *Element        `bson:"_status,omitempty" json:"_status,omitempty"`
 	Period            Period          `bson:"period" json:"period"`
 }
 type EncounterClassHistory struct {
@@ -81,6 +90,7 @@ type EncounterDiagnosis struct {
 	Condition         Reference        `bson:"condition" json:"condition"`
 	Use               *CodeableConcept `bson:"use,omitempty" json:"use,omitempty"`
 	Rank              *int             `bson:"rank,omitempty" json:"rank,omitempty"`
+	RankElement       *Element         `bson:"_rank,omitempty" json:"_rank,omitempty"`
 }
 type EncounterHospitalization struct {
 	Id                     *string           `bson:"id,omitempty" json:"id,omitempty"`
@@ -102,26 +112,4467 @@ type EncounterLocation struct {
 	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Location          Reference                `bson:"location" json:"location"`
 	Status            *EncounterLocationStatus `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement     *Element                 `bson:"_status,omitempty" json:"_status,omitempty"`
 	PhysicalType      *CodeableConcept         `bson:"physicalType,omitempty" json:"physicalType,omitempty"`
 	Period            *Period                  `bson:"period,omitempty" json:"period,omitempty"`
 }
-type OtherEncounter Encounter
 
 // MarshalJSON marshals the given Encounter as JSON into a byte slice
 func (r Encounter) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherEncounter
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherEncounter: OtherEncounter(r),
-		ResourceType:   "Encounter",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Encounter as JSON object with the members in the order of the fields
+func (r Encounter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Encounter\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.StatusHistory) > 0 {
+		e.key("statusHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.StatusHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("class")
+	r.Class.appendJSON(e)
+	if len(r.ClassHistory) > 0 {
+		e.key("classHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ClassHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ServiceType != nil {
+		e.key("serviceType")
+		r.ServiceType.appendJSON(e)
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		r.Priority.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if len(r.EpisodeOfCare) > 0 {
+		e.key("episodeOfCare")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.EpisodeOfCare {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Participant) > 0 {
+		e.key("participant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Participant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Appointment) > 0 {
+		e.key("appointment")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Appointment {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.Length != nil {
+		e.key("length")
+		r.Length.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Diagnosis) > 0 {
+		e.key("diagnosis")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Diagnosis {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Account) > 0 {
+		e.key("account")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Account {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Hospitalization != nil {
+		e.key("hospitalization")
+		r.Hospitalization.appendJSON(e)
+	}
+	if len(r.Location) > 0 {
+		e.key("location")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Location {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ServiceProvider != nil {
+		e.key("serviceProvider")
+		r.ServiceProvider.appendJSON(e)
+	}
+	if r.PartOf != nil {
+		e.key("partOf")
+		r.PartOf.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Encounter from JSON. Unknown members are ignored.
+func (r *Encounter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Encounter
+func (r *Encounter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusHistory":
+			a := d.array()
+			r.StatusHistory = nil
+			for a.next() {
+				var v EncounterStatusHistory
+				v.decodeJSON(d)
+				r.StatusHistory = append(r.StatusHistory, v)
+			}
+			if r.StatusHistory == nil && !a.isNull {
+				r.StatusHistory = []EncounterStatusHistory{}
+			}
+		case "class":
+			r.Class.decodeJSON(d)
+		case "classHistory":
+			a := d.array()
+			r.ClassHistory = nil
+			for a.next() {
+				var v EncounterClassHistory
+				v.decodeJSON(d)
+				r.ClassHistory = append(r.ClassHistory, v)
+			}
+			if r.ClassHistory == nil && !a.isNull {
+				r.ClassHistory = []EncounterClassHistory{}
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "serviceType":
+			if d.null() {
+				r.ServiceType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ServiceType = &v
+			}
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Priority = &v
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "episodeOfCare":
+			a := d.array()
+			r.EpisodeOfCare = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.EpisodeOfCare = append(r.EpisodeOfCare, v)
+			}
+			if r.EpisodeOfCare == nil && !a.isNull {
+				r.EpisodeOfCare = []Reference{}
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "participant":
+			a := d.array()
+			r.Participant = nil
+			for a.next() {
+				var v EncounterParticipant
+				v.decodeJSON(d)
+				r.Participant = append(r.Participant, v)
+			}
+			if r.Participant == nil && !a.isNull {
+				r.Participant = []EncounterParticipant{}
+			}
+		case "appointment":
+			a := d.array()
+			r.Appointment = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Appointment = append(r.Appointment, v)
+			}
+			if r.Appointment == nil && !a.isNull {
+				r.Appointment = []Reference{}
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "length":
+			if d.null() {
+				r.Length = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.Length = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "diagnosis":
+			a := d.array()
+			r.Diagnosis = nil
+			for a.next() {
+				var v EncounterDiagnosis
+				v.decodeJSON(d)
+				r.Diagnosis = append(r.Diagnosis, v)
+			}
+			if r.Diagnosis == nil && !a.isNull {
+				r.Diagnosis = []EncounterDiagnosis{}
+			}
+		case "account":
+			a := d.array()
+			r.Account = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Account = append(r.Account, v)
+			}
+			if r.Account == nil && !a.isNull {
+				r.Account = []Reference{}
+			}
+		case "hospitalization":
+			if d.null() {
+				r.Hospitalization = nil
+			} else {
+				var v EncounterHospitalization
+				v.decodeJSON(d)
+				r.Hospitalization = &v
+			}
+		case "location":
+			a := d.array()
+			r.Location = nil
+			for a.next() {
+				var v EncounterLocation
+				v.decodeJSON(d)
+				r.Location = append(r.Location, v)
+			}
+			if r.Location == nil && !a.isNull {
+				r.Location = []EncounterLocation{}
+			}
+		case "serviceProvider":
+			if d.null() {
+				r.ServiceProvider = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ServiceProvider = &v
+			}
+		case "partOf":
+			if d.null() {
+				r.PartOf = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Encounter which shares no memory with the original
+func (r Encounter) DeepCopy() Encounter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusHistory != nil {
+		out.StatusHistory = make([]EncounterStatusHistory, len(r.StatusHistory))
+		for i := range r.StatusHistory {
+			out.StatusHistory[i] = r.StatusHistory[i].DeepCopy()
+		}
+	}
+	out.Class = r.Class.DeepCopy()
+	if r.ClassHistory != nil {
+		out.ClassHistory = make([]EncounterClassHistory, len(r.ClassHistory))
+		for i := range r.ClassHistory {
+			out.ClassHistory[i] = r.ClassHistory[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.ServiceType != nil {
+		v := r.ServiceType.DeepCopy()
+		out.ServiceType = &v
+	}
+	if r.Priority != nil {
+		v := r.Priority.DeepCopy()
+		out.Priority = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.EpisodeOfCare != nil {
+		out.EpisodeOfCare = make([]Reference, len(r.EpisodeOfCare))
+		for i := range r.EpisodeOfCare {
+			out.EpisodeOfCare[i] = r.EpisodeOfCare[i].DeepCopy()
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.Participant != nil {
+		out.Participant = make([]EncounterParticipant, len(r.Participant))
+		for i := range r.Participant {
+			out.Participant[i] = r.Participant[i].DeepCopy()
+		}
+	}
+	if r.Appointment != nil {
+		out.Appointment = make([]Reference, len(r.Appointment))
+		for i := range r.Appointment {
+			out.Appointment[i] = r.Appointment[i].DeepCopy()
+		}
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Length != nil {
+		v := r.Length.DeepCopy()
+		out.Length = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Diagnosis != nil {
+		out.Diagnosis = make([]EncounterDiagnosis, len(r.Diagnosis))
+		for i := range r.Diagnosis {
+			out.Diagnosis[i] = r.Diagnosis[i].DeepCopy()
+		}
+	}
+	if r.Account != nil {
+		out.Account = make([]Reference, len(r.Account))
+		for i := range r.Account {
+			out.Account[i] = r.Account[i].DeepCopy()
+		}
+	}
+	if r.Hospitalization != nil {
+		v := r.Hospitalization.DeepCopy()
+		out.Hospitalization = &v
+	}
+	if r.Location != nil {
+		out.Location = make([]EncounterLocation, len(r.Location))
+		for i := range r.Location {
+			out.Location[i] = r.Location[i].DeepCopy()
+		}
+	}
+	if r.ServiceProvider != nil {
+		v := r.ServiceProvider.DeepCopy()
+		out.ServiceProvider = &v
+	}
+	if r.PartOf != nil {
+		v := r.PartOf.DeepCopy()
+		out.PartOf = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Encounter) Equal(other Encounter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.StatusHistory) != len(other.StatusHistory) {
+		return false
+	}
+	for i := range r.StatusHistory {
+		if !r.StatusHistory[i].Equal(other.StatusHistory[i]) {
+			return false
+		}
+	}
+	if !r.Class.Equal(other.Class) {
+		return false
+	}
+	if len(r.ClassHistory) != len(other.ClassHistory) {
+		return false
+	}
+	for i := range r.ClassHistory {
+		if !r.ClassHistory[i].Equal(other.ClassHistory[i]) {
+			return false
+		}
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if (r.ServiceType == nil) != (other.ServiceType == nil) || r.ServiceType != nil && !r.ServiceType.Equal(*other.ServiceType) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.Equal(*other.Priority) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if len(r.EpisodeOfCare) != len(other.EpisodeOfCare) {
+		return false
+	}
+	for i := range r.EpisodeOfCare {
+		if !r.EpisodeOfCare[i].Equal(other.EpisodeOfCare[i]) {
+			return false
+		}
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.Participant) != len(other.Participant) {
+		return false
+	}
+	for i := range r.Participant {
+		if !r.Participant[i].Equal(other.Participant[i]) {
+			return false
+		}
+	}
+	if len(r.Appointment) != len(other.Appointment) {
+		return false
+	}
+	for i := range r.Appointment {
+		if !r.Appointment[i].Equal(other.Appointment[i]) {
+			return false
+		}
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && !r.Length.Equal(*other.Length) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Diagnosis) != len(other.Diagnosis) {
+		return false
+	}
+	for i := range r.Diagnosis {
+		if !r.Diagnosis[i].Equal(other.Diagnosis[i]) {
+			return false
+		}
+	}
+	if len(r.Account) != len(other.Account) {
+		return false
+	}
+	for i := range r.Account {
+		if !r.Account[i].Equal(other.Account[i]) {
+			return false
+		}
+	}
+	if (r.Hospitalization == nil) != (other.Hospitalization == nil) || r.Hospitalization != nil && !r.Hospitalization.Equal(*other.Hospitalization) {
+		return false
+	}
+	if len(r.Location) != len(other.Location) {
+		return false
+	}
+	for i := range r.Location {
+		if !r.Location[i].Equal(other.Location[i]) {
+			return false
+		}
+	}
+	if (r.ServiceProvider == nil) != (other.ServiceProvider == nil) || r.ServiceProvider != nil && !r.ServiceProvider.Equal(*other.ServiceProvider) {
+		return false
+	}
+	if (r.PartOf == nil) != (other.PartOf == nil) || r.PartOf != nil && !r.PartOf.Equal(*other.PartOf) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Encounter) EqualsDeep(other Encounter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.StatusHistory), len(other.StatusHistory), func(i, j int) bool {
+		return r.StatusHistory[i].EqualsDeep(other.StatusHistory[j])
+	}) {
+		return false
+	}
+	if !r.Class.EqualsDeep(other.Class) {
+		return false
+	}
+	if !equivalentList(len(r.ClassHistory), len(other.ClassHistory), func(i, j int) bool {
+		return r.ClassHistory[i].EqualsDeep(other.ClassHistory[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if (r.ServiceType == nil) != (other.ServiceType == nil) || r.ServiceType != nil && !r.ServiceType.EqualsDeep(*other.ServiceType) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.EqualsDeep(*other.Priority) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if !equivalentList(len(r.EpisodeOfCare), len(other.EpisodeOfCare), func(i, j int) bool {
+		return r.EpisodeOfCare[i].EqualsDeep(other.EpisodeOfCare[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Participant), len(other.Participant), func(i, j int) bool {
+		return r.Participant[i].EqualsDeep(other.Participant[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Appointment), len(other.Appointment), func(i, j int) bool {
+		return r.Appointment[i].EqualsDeep(other.Appointment[j])
+	}) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && !r.Length.EqualsDeep(*other.Length) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Diagnosis), len(other.Diagnosis), func(i, j int) bool {
+		return r.Diagnosis[i].EqualsDeep(other.Diagnosis[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Account), len(other.Account), func(i, j int) bool {
+		return r.Account[i].EqualsDeep(other.Account[j])
+	}) {
+		return false
+	}
+	if (r.Hospitalization == nil) != (other.Hospitalization == nil) || r.Hospitalization != nil && !r.Hospitalization.EqualsDeep(*other.Hospitalization) {
+		return false
+	}
+	if !equivalentList(len(r.Location), len(other.Location), func(i, j int) bool {
+		return r.Location[i].EqualsDeep(other.Location[j])
+	}) {
+		return false
+	}
+	if (r.ServiceProvider == nil) != (other.ServiceProvider == nil) || r.ServiceProvider != nil && !r.ServiceProvider.EqualsDeep(*other.ServiceProvider) {
+		return false
+	}
+	if (r.PartOf == nil) != (other.PartOf == nil) || r.PartOf != nil && !r.PartOf.EqualsDeep(*other.PartOf) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Encounter) EqualsShallow(other Encounter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Encounter as FHIR XML
+func (r Encounter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Encounter",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.StatusHistory {
+		if err := encodeXMLElement(e, "statusHistory", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "class", r.Class); err != nil {
+		return err
+	}
+	for _, v := range r.ClassHistory {
+		if err := encodeXMLElement(e, "classHistory", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "serviceType", r.ServiceType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "priority", r.Priority); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	for _, v := range r.EpisodeOfCare {
+		if err := encodeXMLElement(e, "episodeOfCare", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Participant {
+		if err := encodeXMLElement(e, "participant", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Appointment {
+		if err := encodeXMLElement(e, "appointment", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "length", r.Length); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Diagnosis {
+		if err := encodeXMLElement(e, "diagnosis", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Account {
+		if err := encodeXMLElement(e, "account", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "hospitalization", r.Hospitalization); err != nil {
+		return err
+	}
+	for _, v := range r.Location {
+		if err := encodeXMLElement(e, "location", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "serviceProvider", r.ServiceProvider); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "partOf", r.PartOf); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Encounter from FHIR XML
+func (r *Encounter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v EncounterStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusHistory":
+				var v EncounterStatusHistory
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusHistory = append(r.StatusHistory, v)
+			case "class":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Class = v
+			case "classHistory":
+				var v EncounterClassHistory
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ClassHistory = append(r.ClassHistory, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "serviceType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServiceType = &v
+			case "priority":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Priority = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "episodeOfCare":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EpisodeOfCare = append(r.EpisodeOfCare, v)
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "participant":
+				var v EncounterParticipant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Participant = append(r.Participant, v)
+			case "appointment":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Appointment = append(r.Appointment, v)
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "length":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Length = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "diagnosis":
+				var v EncounterDiagnosis
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Diagnosis = append(r.Diagnosis, v)
+			case "account":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Account = append(r.Account, v)
+			case "hospitalization":
+				var v EncounterHospitalization
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Hospitalization = &v
+			case "location":
+				var v EncounterLocation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = append(r.Location, v)
+			case "serviceProvider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServiceProvider = &v
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Encounter as properties to the RDF node n
+func (r Encounter) turtle(n *turtleNode) {
+	n.resource("Encounter", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Encounter.identifier", i, v)
+	}
+	n.primitive("Encounter.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.StatusHistory {
+		n.element("Encounter.statusHistory", i, v)
+	}
+	n.element("Encounter.class", -1, r.Class)
+	for i, v := range r.ClassHistory {
+		n.element("Encounter.classHistory", i, v)
+	}
+	for i, v := range r.Type {
+		n.element("Encounter.type", i, v)
+	}
+	n.element("Encounter.serviceType", -1, r.ServiceType)
+	n.element("Encounter.priority", -1, r.Priority)
+	n.element("Encounter.subject", -1, r.Subject)
+	for i, v := range r.EpisodeOfCare {
+		n.element("Encounter.episodeOfCare", i, v)
+	}
+	for i, v := range r.BasedOn {
+		n.element("Encounter.basedOn", i, v)
+	}
+	for i, v := range r.Participant {
+		n.element("Encounter.participant", i, v)
+	}
+	for i, v := range r.Appointment {
+		n.element("Encounter.appointment", i, v)
+	}
+	n.element("Encounter.period", -1, r.Period)
+	n.element("Encounter.length", -1, r.Length)
+	for i, v := range r.ReasonCode {
+		n.element("Encounter.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("Encounter.reasonReference", i, v)
+	}
+	for i, v := range r.Diagnosis {
+		n.element("Encounter.diagnosis", i, v)
+	}
+	for i, v := range r.Account {
+		n.element("Encounter.account", i, v)
+	}
+	n.element("Encounter.hospitalization", -1, r.Hospitalization)
+	for i, v := range r.Location {
+		n.element("Encounter.location", i, v)
+	}
+	n.element("Encounter.serviceProvider", -1, r.ServiceProvider)
+	n.element("Encounter.partOf", -1, r.PartOf)
+}
+
+// MarshalBSON marshals the given Encounter as BSON document with the structure of its FHIR JSON
+func (r Encounter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Encounter from a BSON document with the structure of its FHIR JSON
+func (r *Encounter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Encounter as protobuf message Encounter of fhir.proto
+func (r Encounter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Encounter to the encoded message
+func (r Encounter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	for _, v := range r.StatusHistory {
+		e.message(11, v)
+	}
+	e.message(12, r.Class)
+	for _, v := range r.ClassHistory {
+		e.message(13, v)
+	}
+	for _, v := range r.Type {
+		e.message(14, v)
+	}
+	if r.ServiceType != nil {
+		e.message(15, *r.ServiceType)
+	}
+	if r.Priority != nil {
+		e.message(16, *r.Priority)
+	}
+	if r.Subject != nil {
+		e.message(17, *r.Subject)
+	}
+	for _, v := range r.EpisodeOfCare {
+		e.message(18, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(19, v)
+	}
+	for _, v := range r.Participant {
+		e.message(20, v)
+	}
+	for _, v := range r.Appointment {
+		e.message(21, v)
+	}
+	if r.Period != nil {
+		e.message(22, *r.Period)
+	}
+	if r.Length != nil {
+		e.message(23, *r.Length)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(24, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(25, v)
+	}
+	for _, v := range r.Diagnosis {
+		e.message(26, v)
+	}
+	for _, v := range r.Account {
+		e.message(27, v)
+	}
+	if r.Hospitalization != nil {
+		e.message(28, *r.Hospitalization)
+	}
+	for _, v := range r.Location {
+		e.message(29, v)
+	}
+	if r.ServiceProvider != nil {
+		e.message(30, *r.ServiceProvider)
+	}
+	if r.PartOf != nil {
+		e.message(31, *r.PartOf)
+	}
+	if r.IdElement != nil {
+		e.message(32, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(33, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(34, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(35, *r.StatusElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Encounter from protobuf message Encounter of fhir.proto
+func (r *Encounter) UnmarshalProto(b []byte) error {
+	*r = Encounter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v EncounterStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v EncounterStatusHistory
+			d.message(&v)
+			r.StatusHistory = append(r.StatusHistory, v)
+		case 12:
+			var v Coding
+			d.message(&v)
+			r.Class = v
+		case 13:
+			var v EncounterClassHistory
+			d.message(&v)
+			r.ClassHistory = append(r.ClassHistory, v)
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.ServiceType = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.Priority = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.EpisodeOfCare = append(r.EpisodeOfCare, v)
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 20:
+			var v EncounterParticipant
+			d.message(&v)
+			r.Participant = append(r.Participant, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Appointment = append(r.Appointment, v)
+		case 22:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 23:
+			var v Duration
+			d.message(&v)
+			r.Length = &v
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 26:
+			var v EncounterDiagnosis
+			d.message(&v)
+			r.Diagnosis = append(r.Diagnosis, v)
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.Account = append(r.Account, v)
+		case 28:
+			var v EncounterHospitalization
+			d.message(&v)
+			r.Hospitalization = &v
+		case 29:
+			var v EncounterLocation
+			d.message(&v)
+			r.Location = append(r.Location, v)
+		case 30:
+			var v Reference
+			d.message(&v)
+			r.ServiceProvider = &v
+		case 31:
+			var v Reference
+			d.message(&v)
+			r.PartOf = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given EncounterStatusHistory as JSON into a byte slice
+func (r EncounterStatusHistory) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EncounterStatusHistory as JSON object with the members in the order of the fields
+func (r EncounterStatusHistory) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("period")
+	r.Period.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EncounterStatusHistory from JSON. Unknown members are ignored.
+func (r *EncounterStatusHistory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EncounterStatusHistory
+func (r *EncounterStatusHistory) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "period":
+			r.Period.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EncounterStatusHistory which shares no memory with the original
+func (r EncounterStatusHistory) DeepCopy() EncounterStatusHistory {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.Period = r.Period.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EncounterStatusHistory) Equal(other EncounterStatusHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.Period.Equal(other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EncounterStatusHistory) EqualsDeep(other EncounterStatusHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.Period.EqualsDeep(other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EncounterStatusHistory) EqualsShallow(other EncounterStatusHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EncounterStatusHistory as FHIR XML
+func (r EncounterStatusHistory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EncounterStatusHistory from FHIR XML
+func (r *EncounterStatusHistory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "status":
+				var v EncounterStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EncounterStatusHistory as properties to the RDF node n
+func (r EncounterStatusHistory) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Encounter.statusHistory.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Encounter.statusHistory.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given EncounterStatusHistory as BSON document with the structure of its FHIR JSON
+func (r EncounterStatusHistory) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EncounterStatusHistory from a BSON document with the structure of its FHIR JSON
+func (r *EncounterStatusHistory) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EncounterStatusHistory as protobuf message EncounterStatusHistory of fhir.proto
+func (r EncounterStatusHistory) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EncounterStatusHistory to the encoded message
+func (r EncounterStatusHistory) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Status.protoNumber())
+	e.message(5, r.Period)
+	if r.StatusElement != nil {
+		e.message(6, *r.StatusElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EncounterStatusHistory from protobuf message EncounterStatusHistory of fhir.proto
+func (r *EncounterStatusHistory) UnmarshalProto(b []byte) error {
+	*r = EncounterStatusHistory{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v EncounterStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 5:
+			var v Period
+			d.message(&v)
+			r.Period = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given EncounterClassHistory as JSON into a byte slice
+func (r EncounterClassHistory) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EncounterClassHistory as JSON object with the members in the order of the fields
+func (r EncounterClassHistory) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("class")
+	r.Class.appendJSON(e)
+	e.key("period")
+	r.Period.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EncounterClassHistory from JSON. Unknown members are ignored.
+func (r *EncounterClassHistory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EncounterClassHistory
+func (r *EncounterClassHistory) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "class":
+			r.Class.decodeJSON(d)
+		case "period":
+			r.Period.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EncounterClassHistory which shares no memory with the original
+func (r EncounterClassHistory) DeepCopy() EncounterClassHistory {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Class = r.Class.DeepCopy()
+	out.Period = r.Period.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EncounterClassHistory) Equal(other EncounterClassHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Class.Equal(other.Class) {
+		return false
+	}
+	if !r.Period.Equal(other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EncounterClassHistory) EqualsDeep(other EncounterClassHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Class.EqualsDeep(other.Class) {
+		return false
+	}
+	if !r.Period.EqualsDeep(other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EncounterClassHistory) EqualsShallow(other EncounterClassHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EncounterClassHistory as FHIR XML
+func (r EncounterClassHistory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "class", r.Class); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EncounterClassHistory from FHIR XML
+func (r *EncounterClassHistory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "class":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Class = v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EncounterClassHistory as properties to the RDF node n
+func (r EncounterClassHistory) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Encounter.classHistory.class", -1, r.Class)
+	n.element("Encounter.classHistory.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given EncounterClassHistory as BSON document with the structure of its FHIR JSON
+func (r EncounterClassHistory) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EncounterClassHistory from a BSON document with the structure of its FHIR JSON
+func (r *EncounterClassHistory) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EncounterClassHistory as protobuf message EncounterClassHistory of fhir.proto
+func (r EncounterClassHistory) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EncounterClassHistory to the encoded message
+func (r EncounterClassHistory) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Class)
+	e.message(5, r.Period)
+}
+
+// UnmarshalProto unmarshals the given EncounterClassHistory from protobuf message EncounterClassHistory of fhir.proto
+func (r *EncounterClassHistory) UnmarshalProto(b []byte) error {
+	*r = EncounterClassHistory{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Coding
+			d.message(&v)
+			r.Class = v
+		case 5:
+			var v Period
+			d.message(&v)
+			r.Period = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given EncounterParticipant as JSON into a byte slice
+func (r EncounterParticipant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EncounterParticipant as JSON object with the members in the order of the fields
+func (r EncounterParticipant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.Individual != nil {
+		e.key("individual")
+		r.Individual.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EncounterParticipant from JSON. Unknown members are ignored.
+func (r *EncounterParticipant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EncounterParticipant
+func (r *EncounterParticipant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "individual":
+			if d.null() {
+				r.Individual = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Individual = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EncounterParticipant which shares no memory with the original
+func (r EncounterParticipant) DeepCopy() EncounterParticipant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Individual != nil {
+		v := r.Individual.DeepCopy()
+		out.Individual = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EncounterParticipant) Equal(other EncounterParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.Individual == nil) != (other.Individual == nil) || r.Individual != nil && !r.Individual.Equal(*other.Individual) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EncounterParticipant) EqualsDeep(other EncounterParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.Individual == nil) != (other.Individual == nil) || r.Individual != nil && !r.Individual.EqualsDeep(*other.Individual) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EncounterParticipant) EqualsShallow(other EncounterParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EncounterParticipant as FHIR XML
+func (r EncounterParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "individual", r.Individual); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EncounterParticipant from FHIR XML
+func (r *EncounterParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "individual":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Individual = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EncounterParticipant as properties to the RDF node n
+func (r EncounterParticipant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Type {
+		n.element("Encounter.participant.type", i, v)
+	}
+	n.element("Encounter.participant.period", -1, r.Period)
+	n.element("Encounter.participant.individual", -1, r.Individual)
+}
+
+// MarshalBSON marshals the given EncounterParticipant as BSON document with the structure of its FHIR JSON
+func (r EncounterParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EncounterParticipant from a BSON document with the structure of its FHIR JSON
+func (r *EncounterParticipant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EncounterParticipant as protobuf message EncounterParticipant of fhir.proto
+func (r EncounterParticipant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EncounterParticipant to the encoded message
+func (r EncounterParticipant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Type {
+		e.message(4, v)
+	}
+	if r.Period != nil {
+		e.message(5, *r.Period)
+	}
+	if r.Individual != nil {
+		e.message(6, *r.Individual)
+	}
+}
+
+// UnmarshalProto unmarshals the given EncounterParticipant from protobuf message EncounterParticipant of fhir.proto
+func (r *EncounterParticipant) UnmarshalProto(b []byte) error {
+	*r = EncounterParticipant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 5:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Individual = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given EncounterDiagnosis as JSON into a byte slice
+func (r EncounterDiagnosis) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EncounterDiagnosis as JSON object with the members in the order of the fields
+func (r EncounterDiagnosis) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("condition")
+	r.Condition.appendJSON(e)
+	if r.Use != nil {
+		e.key("use")
+		r.Use.appendJSON(e)
+	}
+	if r.Rank != nil {
+		e.key("rank")
+		e.int(*r.Rank)
+	}
+	if r.RankElement != nil {
+		e.key("_rank")
+		r.RankElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EncounterDiagnosis from JSON. Unknown members are ignored.
+func (r *EncounterDiagnosis) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EncounterDiagnosis
+func (r *EncounterDiagnosis) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "condition":
+			r.Condition.decodeJSON(d)
+		case "use":
+			if d.null() {
+				r.Use = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Use = &v
+			}
+		case "rank":
+			if v, ok := d.int(); ok {
+				r.Rank = &v
+			} else {
+				r.Rank = nil
+			}
+		case "_rank":
+			r.RankElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EncounterDiagnosis which shares no memory with the original
+func (r EncounterDiagnosis) DeepCopy() EncounterDiagnosis {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Condition = r.Condition.DeepCopy()
+	if r.Use != nil {
+		v := r.Use.DeepCopy()
+		out.Use = &v
+	}
+	if r.Rank != nil {
+		v := *r.Rank
+		out.Rank = &v
+	}
+	if r.RankElement != nil {
+		v := r.RankElement.DeepCopy()
+		out.RankElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EncounterDiagnosis) Equal(other EncounterDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Condition.Equal(other.Condition) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && !r.Use.Equal(*other.Use) {
+		return false
+	}
+	if (r.Rank == nil) != (other.Rank == nil) || r.Rank != nil && *r.Rank != *other.Rank {
+		return false
+	}
+	if (r.RankElement == nil) != (other.RankElement == nil) || r.RankElement != nil && !r.RankElement.Equal(*other.RankElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EncounterDiagnosis) EqualsDeep(other EncounterDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Condition.EqualsDeep(other.Condition) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && !r.Use.EqualsDeep(*other.Use) {
+		return false
+	}
+	if (r.Rank == nil) != (other.Rank == nil) || r.Rank != nil && *r.Rank != *other.Rank {
+		return false
+	}
+	if (r.RankElement == nil) != (other.RankElement == nil) || r.RankElement != nil && !r.RankElement.EqualsDeep(*other.RankElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EncounterDiagnosis) EqualsShallow(other EncounterDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Rank == nil) != (other.Rank == nil) || r.Rank != nil && *r.Rank != *other.Rank {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EncounterDiagnosis as FHIR XML
+func (r EncounterDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "condition", r.Condition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "use", r.Use); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "rank", r.Rank, r.RankElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EncounterDiagnosis from FHIR XML
+func (r *EncounterDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "condition":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Condition = v
+			case "use":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Use = &v
+			case "rank":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Rank = &v
+				}
+				r.RankElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EncounterDiagnosis as properties to the RDF node n
+func (r EncounterDiagnosis) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Encounter.diagnosis.condition", -1, r.Condition)
+	n.element("Encounter.diagnosis.use", -1, r.Use)
+	n.primitive("Encounter.diagnosis.rank", -1, r.Rank, r.RankElement, "positiveInt")
+}
+
+// MarshalBSON marshals the given EncounterDiagnosis as BSON document with the structure of its FHIR JSON
+func (r EncounterDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EncounterDiagnosis from a BSON document with the structure of its FHIR JSON
+func (r *EncounterDiagnosis) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EncounterDiagnosis as protobuf message EncounterDiagnosis of fhir.proto
+func (r EncounterDiagnosis) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EncounterDiagnosis to the encoded message
+func (r EncounterDiagnosis) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Condition)
+	if r.Use != nil {
+		e.message(5, *r.Use)
+	}
+	if r.Rank != nil {
+		e.varint(6, uint64(int64(*r.Rank)))
+	}
+	if r.RankElement != nil {
+		e.message(7, *r.RankElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EncounterDiagnosis from protobuf message EncounterDiagnosis of fhir.proto
+func (r *EncounterDiagnosis) UnmarshalProto(b []byte) error {
+	*r = EncounterDiagnosis{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Condition = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Use = &v
+		case 6:
+			v := d.int()
+			r.Rank = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.RankElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given EncounterHospitalization as JSON into a byte slice
+func (r EncounterHospitalization) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EncounterHospitalization as JSON object with the members in the order of the fields
+func (r EncounterHospitalization) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PreAdmissionIdentifier != nil {
+		e.key("preAdmissionIdentifier")
+		r.PreAdmissionIdentifier.appendJSON(e)
+	}
+	if r.Origin != nil {
+		e.key("origin")
+		r.Origin.appendJSON(e)
+	}
+	if r.AdmitSource != nil {
+		e.key("admitSource")
+		r.AdmitSource.appendJSON(e)
+	}
+	if r.ReAdmission != nil {
+		e.key("reAdmission")
+		r.ReAdmission.appendJSON(e)
+	}
+	if len(r.DietPreference) > 0 {
+		e.key("dietPreference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DietPreference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SpecialCourtesy) > 0 {
+		e.key("specialCourtesy")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SpecialCourtesy {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SpecialArrangement) > 0 {
+		e.key("specialArrangement")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SpecialArrangement {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Destination != nil {
+		e.key("destination")
+		r.Destination.appendJSON(e)
+	}
+	if r.DischargeDisposition != nil {
+		e.key("dischargeDisposition")
+		r.DischargeDisposition.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EncounterHospitalization from JSON. Unknown members are ignored.
+func (r *EncounterHospitalization) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EncounterHospitalization
+func (r *EncounterHospitalization) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "preAdmissionIdentifier":
+			if d.null() {
+				r.PreAdmissionIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.PreAdmissionIdentifier = &v
+			}
+		case "origin":
+			if d.null() {
+				r.Origin = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Origin = &v
+			}
+		case "admitSource":
+			if d.null() {
+				r.AdmitSource = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdmitSource = &v
+			}
+		case "reAdmission":
+			if d.null() {
+				r.ReAdmission = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReAdmission = &v
+			}
+		case "dietPreference":
+			a := d.array()
+			r.DietPreference = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DietPreference = append(r.DietPreference, v)
+			}
+			if r.DietPreference == nil && !a.isNull {
+				r.DietPreference = []CodeableConcept{}
+			}
+		case "specialCourtesy":
+			a := d.array()
+			r.SpecialCourtesy = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SpecialCourtesy = append(r.SpecialCourtesy, v)
+			}
+			if r.SpecialCourtesy == nil && !a.isNull {
+				r.SpecialCourtesy = []CodeableConcept{}
+			}
+		case "specialArrangement":
+			a := d.array()
+			r.SpecialArrangement = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SpecialArrangement = append(r.SpecialArrangement, v)
+			}
+			if r.SpecialArrangement == nil && !a.isNull {
+				r.SpecialArrangement = []CodeableConcept{}
+			}
+		case "destination":
+			if d.null() {
+				r.Destination = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Destination = &v
+			}
+		case "dischargeDisposition":
+			if d.null() {
+				r.DischargeDisposition = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DischargeDisposition = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EncounterHospitalization which shares no memory with the original
+func (r EncounterHospitalization) DeepCopy() EncounterHospitalization {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.PreAdmissionIdentifier != nil {
+		v := r.PreAdmissionIdentifier.DeepCopy()
+		out.PreAdmissionIdentifier = &v
+	}
+	if r.Origin != nil {
+		v := r.Origin.DeepCopy()
+		out.Origin = &v
+	}
+	if r.AdmitSource != nil {
+		v := r.AdmitSource.DeepCopy()
+		out.AdmitSource = &v
+	}
+	if r.ReAdmission != nil {
+		v := r.ReAdmission.DeepCopy()
+		out.ReAdmission = &v
+	}
+	if r.DietPreference != nil {
+		out.DietPreference = make([]CodeableConcept, len(r.DietPreference))
+		for i := range r.DietPreference {
+			out.DietPreference[i] = r.DietPreference[i].DeepCopy()
+		}
+	}
+	if r.SpecialCourtesy != nil {
+		out.SpecialCourtesy = make([]CodeableConcept, len(r.SpecialCourtesy))
+		for i := range r.SpecialCourtesy {
+			out.SpecialCourtesy[i] = r.SpecialCourtesy[i].DeepCopy()
+		}
+	}
+	if r.SpecialArrangement != nil {
+		out.SpecialArrangement = make([]CodeableConcept, len(r.SpecialArrangement))
+		for i := range r.SpecialArrangement {
+			out.SpecialArrangement[i] = r.SpecialArrangement[i].DeepCopy()
+		}
+	}
+	if r.Destination != nil {
+		v := r.Destination.DeepCopy()
+		out.Destination = &v
+	}
+	if r.DischargeDisposition != nil {
+		v := r.DischargeDisposition.DeepCopy()
+		out.DischargeDisposition = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EncounterHospitalization) Equal(other EncounterHospitalization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.PreAdmissionIdentifier == nil) != (other.PreAdmissionIdentifier == nil) || r.PreAdmissionIdentifier != nil && !r.PreAdmissionIdentifier.Equal(*other.PreAdmissionIdentifier) {
+		return false
+	}
+	if (r.Origin == nil) != (other.Origin == nil) || r.Origin != nil && !r.Origin.Equal(*other.Origin) {
+		return false
+	}
+	if (r.AdmitSource == nil) != (other.AdmitSource == nil) || r.AdmitSource != nil && !r.AdmitSource.Equal(*other.AdmitSource) {
+		return false
+	}
+	if (r.ReAdmission == nil) != (other.ReAdmission == nil) || r.ReAdmission != nil && !r.ReAdmission.Equal(*other.ReAdmission) {
+		return false
+	}
+	if len(r.DietPreference) != len(other.DietPreference) {
+		return false
+	}
+	for i := range r.DietPreference {
+		if !r.DietPreference[i].Equal(other.DietPreference[i]) {
+			return false
+		}
+	}
+	if len(r.SpecialCourtesy) != len(other.SpecialCourtesy) {
+		return false
+	}
+	for i := range r.SpecialCourtesy {
+		if !r.SpecialCourtesy[i].Equal(other.SpecialCourtesy[i]) {
+			return false
+		}
+	}
+	if len(r.SpecialArrangement) != len(other.SpecialArrangement) {
+		return false
+	}
+	for i := range r.SpecialArrangement {
+		if !r.SpecialArrangement[i].Equal(other.SpecialArrangement[i]) {
+			return false
+		}
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && !r.Destination.Equal(*other.Destination) {
+		return false
+	}
+	if (r.DischargeDisposition == nil) != (other.DischargeDisposition == nil) || r.DischargeDisposition != nil && !r.DischargeDisposition.Equal(*other.DischargeDisposition) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EncounterHospitalization) EqualsDeep(other EncounterHospitalization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.PreAdmissionIdentifier == nil) != (other.PreAdmissionIdentifier == nil) || r.PreAdmissionIdentifier != nil && !r.PreAdmissionIdentifier.EqualsDeep(*other.PreAdmissionIdentifier) {
+		return false
+	}
+	if (r.Origin == nil) != (other.Origin == nil) || r.Origin != nil && !r.Origin.EqualsDeep(*other.Origin) {
+		return false
+	}
+	if (r.AdmitSource == nil) != (other.AdmitSource == nil) || r.AdmitSource != nil && !r.AdmitSource.EqualsDeep(*other.AdmitSource) {
+		return false
+	}
+	if (r.ReAdmission == nil) != (other.ReAdmission == nil) || r.ReAdmission != nil && !r.ReAdmission.EqualsDeep(*other.ReAdmission) {
+		return false
+	}
+	if !equivalentList(len(r.DietPreference), len(other.DietPreference), func(i, j int) bool {
+		return r.DietPreference[i].EqualsDeep(other.DietPreference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SpecialCourtesy), len(other.SpecialCourtesy), func(i, j int) bool {
+		return r.SpecialCourtesy[i].EqualsDeep(other.SpecialCourtesy[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SpecialArrangement), len(other.SpecialArrangement), func(i, j int) bool {
+		return r.SpecialArrangement[i].EqualsDeep(other.SpecialArrangement[j])
+	}) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && !r.Destination.EqualsDeep(*other.Destination) {
+		return false
+	}
+	if (r.DischargeDisposition == nil) != (other.DischargeDisposition == nil) || r.DischargeDisposition != nil && !r.DischargeDisposition.EqualsDeep(*other.DischargeDisposition) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EncounterHospitalization) EqualsShallow(other EncounterHospitalization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EncounterHospitalization as FHIR XML
+func (r EncounterHospitalization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "preAdmissionIdentifier", r.PreAdmissionIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "origin", r.Origin); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "admitSource", r.AdmitSource); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reAdmission", r.ReAdmission); err != nil {
+		return err
+	}
+	for _, v := range r.DietPreference {
+		if err := encodeXMLElement(e, "dietPreference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SpecialCourtesy {
+		if err := encodeXMLElement(e, "specialCourtesy", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SpecialArrangement {
+		if err := encodeXMLElement(e, "specialArrangement", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "destination", r.Destination); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dischargeDisposition", r.DischargeDisposition); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EncounterHospitalization from FHIR XML
+func (r *EncounterHospitalization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "preAdmissionIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PreAdmissionIdentifier = &v
+			case "origin":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Origin = &v
+			case "admitSource":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdmitSource = &v
+			case "reAdmission":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReAdmission = &v
+			case "dietPreference":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DietPreference = append(r.DietPreference, v)
+			case "specialCourtesy":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SpecialCourtesy = append(r.SpecialCourtesy, v)
+			case "specialArrangement":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SpecialArrangement = append(r.SpecialArrangement, v)
+			case "destination":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Destination = &v
+			case "dischargeDisposition":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DischargeDisposition = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EncounterHospitalization as properties to the RDF node n
+func (r EncounterHospitalization) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Encounter.hospitalization.preAdmissionIdentifier", -1, r.PreAdmissionIdentifier)
+	n.element("Encounter.hospitalization.origin", -1, r.Origin)
+	n.element("Encounter.hospitalization.admitSource", -1, r.AdmitSource)
+	n.element("Encounter.hospitalization.reAdmission", -1, r.ReAdmission)
+	for i, v := range r.DietPreference {
+		n.element("Encounter.hospitalization.dietPreference", i, v)
+	}
+	for i, v := range r.SpecialCourtesy {
+		n.element("Encounter.hospitalization.specialCourtesy", i, v)
+	}
+	for i, v := range r.SpecialArrangement {
+		n.element("Encounter.hospitalization.specialArrangement", i, v)
+	}
+	n.element("Encounter.hospitalization.destination", -1, r.Destination)
+	n.element("Encounter.hospitalization.dischargeDisposition", -1, r.DischargeDisposition)
+}
+
+// MarshalBSON marshals the given EncounterHospitalization as BSON document with the structure of its FHIR JSON
+func (r EncounterHospitalization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EncounterHospitalization from a BSON document with the structure of its FHIR JSON
+func (r *EncounterHospitalization) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EncounterHospitalization as protobuf message EncounterHospitalization of fhir.proto
+func (r EncounterHospitalization) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EncounterHospitalization to the encoded message
+func (r EncounterHospitalization) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.PreAdmissionIdentifier != nil {
+		e.message(4, *r.PreAdmissionIdentifier)
+	}
+	if r.Origin != nil {
+		e.message(5, *r.Origin)
+	}
+	if r.AdmitSource != nil {
+		e.message(6, *r.AdmitSource)
+	}
+	if r.ReAdmission != nil {
+		e.message(7, *r.ReAdmission)
+	}
+	for _, v := range r.DietPreference {
+		e.message(8, v)
+	}
+	for _, v := range r.SpecialCourtesy {
+		e.message(9, v)
+	}
+	for _, v := range r.SpecialArrangement {
+		e.message(10, v)
+	}
+	if r.Destination != nil {
+		e.message(11, *r.Destination)
+	}
+	if r.DischargeDisposition != nil {
+		e.message(12, *r.DischargeDisposition)
+	}
+}
+
+// UnmarshalProto unmarshals the given EncounterHospitalization from protobuf message EncounterHospitalization of fhir.proto
+func (r *EncounterHospitalization) UnmarshalProto(b []byte) error {
+	*r = EncounterHospitalization{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.PreAdmissionIdentifier = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Origin = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdmitSource = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReAdmission = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.DietPreference = append(r.DietPreference, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.SpecialCourtesy = append(r.SpecialCourtesy, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.SpecialArrangement = append(r.SpecialArrangement, v)
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Destination = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.DischargeDisposition = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given EncounterLocation as JSON into a byte slice
+func (r EncounterLocation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EncounterLocation as JSON object with the members in the order of the fields
+func (r EncounterLocation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("location")
+	r.Location.appendJSON(e)
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.PhysicalType != nil {
+		e.key("physicalType")
+		r.PhysicalType.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EncounterLocation from JSON. Unknown members are ignored.
+func (r *EncounterLocation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EncounterLocation
+func (r *EncounterLocation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "location":
+			r.Location.decodeJSON(d)
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v EncounterLocationStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "physicalType":
+			if d.null() {
+				r.PhysicalType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PhysicalType = &v
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EncounterLocation which shares no memory with the original
+func (r EncounterLocation) DeepCopy() EncounterLocation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Location = r.Location.DeepCopy()
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.PhysicalType != nil {
+		v := r.PhysicalType.DeepCopy()
+		out.PhysicalType = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EncounterLocation) Equal(other EncounterLocation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Location.Equal(other.Location) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.PhysicalType == nil) != (other.PhysicalType == nil) || r.PhysicalType != nil && !r.PhysicalType.Equal(*other.PhysicalType) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EncounterLocation) EqualsDeep(other EncounterLocation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Location.EqualsDeep(other.Location) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.PhysicalType == nil) != (other.PhysicalType == nil) || r.PhysicalType != nil && !r.PhysicalType.EqualsDeep(*other.PhysicalType) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EncounterLocation) EqualsShallow(other EncounterLocation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EncounterLocation as FHIR XML
+func (r EncounterLocation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "physicalType", r.PhysicalType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EncounterLocation from FHIR XML
+func (r *EncounterLocation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = v
+			case "status":
+				var v EncounterLocationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "physicalType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PhysicalType = &v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EncounterLocation as properties to the RDF node n
+func (r EncounterLocation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Encounter.location.location", -1, r.Location)
+	n.primitive("Encounter.location.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Encounter.location.physicalType", -1, r.PhysicalType)
+	n.element("Encounter.location.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given EncounterLocation as BSON document with the structure of its FHIR JSON
+func (r EncounterLocation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EncounterLocation from a BSON document with the structure of its FHIR JSON
+func (r *EncounterLocation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EncounterLocation as protobuf message EncounterLocation of fhir.proto
+func (r EncounterLocation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EncounterLocation to the encoded message
+func (r EncounterLocation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Location)
+	if r.Status != nil {
+		e.varint(5, r.Status.protoNumber())
+	}
+	if r.PhysicalType != nil {
+		e.message(6, *r.PhysicalType)
+	}
+	if r.Period != nil {
+		e.message(7, *r.Period)
+	}
+	if r.StatusElement != nil {
+		e.message(8, *r.StatusElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EncounterLocation from protobuf message EncounterLocation of fhir.proto
+func (r *EncounterLocation) UnmarshalProto(b []byte) error {
+	*r = EncounterLocation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Location = v
+		case 5:
+			var v EncounterLocationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.PhysicalType = &v
+		case 7:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalEncounter unmarshals a Encounter.
 func UnmarshalEncounter(b []byte) (Encounter, error) {
 	var encounter Encounter
-	if err := json.Unmarshal(b, &encounter); err != nil {
+	if err := encounter.UnmarshalJSON(b); err != nil {
 		return encounter, err
 	}
 	return encounter, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code EncounterLocationStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EncounterLocationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EncounterLocationStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "planned":
 		*code = EncounterLocationStatusPlanned
@@ -52,6 +56,12 @@ func (code *EncounterLocationStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EncounterLocationStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EncounterLocationStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EncounterLocationStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code EncounterLocationStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EncounterLocationStatus) protoNumber() uint64 {
+	switch code {
+	case EncounterLocationStatusPlanned:
+		return 1
+	case EncounterLocationStatusActive:
+		return 2
+	case EncounterLocationStatusReserved:
+		return 3
+	case EncounterLocationStatusCompleted:
+		return 4
+	}
+	return 0
+}
+func (code *EncounterLocationStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EncounterLocationStatusPlanned
+	case 2:
+		*code = EncounterLocationStatusActive
+	case 3:
+		*code = EncounterLocationStatusReserved
+	case 4:
+		*code = EncounterLocationStatusCompleted
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code EncounterStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EncounterStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EncounterStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "planned":
 		*code = EncounterStatusPlanned
@@ -67,6 +71,12 @@ func (code *EncounterStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EncounterStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EncounterStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EncounterStatus) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code EncounterStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EncounterStatus) protoNumber() uint64 {
+	switch code {
+	case EncounterStatusPlanned:
+		return 1
+	case EncounterStatusArrived:
+		return 2
+	case EncounterStatusTriaged:
+		return 3
+	case EncounterStatusInProgress:
+		return 4
+	case EncounterStatusOnleave:
+		return 5
+	case EncounterStatusFinished:
+		return 6
+	case EncounterStatusCancelled:
+		return 7
+	case EncounterStatusEnteredInError:
+		return 8
+	case EncounterStatusUnknown:
+		return 9
+	}
+	return 0
+}
+func (code *EncounterStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EncounterStatusPlanned
+	case 2:
+		*code = EncounterStatusArrived
+	case 3:
+		*code = EncounterStatusTriaged
+	case 4:
+		*code = EncounterStatusInProgress
+	case 5:
+		*code = EncounterStatusOnleave
+	case 6:
+		*code = EncounterStatusFinished
+	case 7:
+		*code = EncounterStatusCancelled
+	case 8:
+		*code = EncounterStatusEnteredInError
+	case 9:
+		*code = EncounterStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -14,49 +14,1281 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Endpoint is documented here http://hl7.org/fhir/StructureDefinition/Endpoint
 type Endpoint struct {
-	Id                   *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier           []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status               EndpointStatus    `bson:"status" json:"status"`
-	ConnectionType       Coding            `bson:"connectionType" json:"connectionType"`
-	Name                 *string           `bson:"name,omitempty" json:"name,omitempty"`
-	ManagingOrganization *Reference        `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
-	Contact              []ContactPoint    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Period               *Period           `bson:"period,omitempty" json:"period,omitempty"`
-	PayloadType          []CodeableConcept `bson:"payloadType" json:"payloadType"`
-	PayloadMimeType      []string          `bson:"payloadMimeType,omitempty" json:"payloadMimeType,omitempty"`
-	Address              string            `bson:"address" json:"address"`
-	Header               []string          `bson:"header,omitempty" json:"header,omitempty"`
-}
-type OtherEndpoint Endpoint
+	Id                     *string           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                   *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                 EndpointStatus    `bson:"status" json:"status"`
+	StatusElement          *Element          `bson:"_status,omitempty" json:"_status,omitempty"`
+	ConnectionType         Coding            `bson:"connectionType" json:"connectionType"`
+	Name                   *string           `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element          `bson:"_name,omitempty" json:"_name,omitempty"`
+	ManagingOrganization   *Reference        `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
+	Contact                []ContactPoint    `bson:"contact,omitempty" json:"contact,omitempty"`
+	Period                 *Period           `bson:"period,omitempty" json:"period,omitempty"`
+	PayloadType            []CodeableConcept `bson:"payloadType" json:"payloadType"`
+	PayloadMimeType        []string          `bson:"payloadMimeType,omitempty" json:"payloadMimeType,omitempty"`
+	PayloadMimeTypeElement []*Element        `bson:"_payloadMimeType,omitempty" json:"_payloadMimeType,omitempty"`
+	Address                string            `bson:"address" json:"address"`
+	AddressElement         *Element          `bson:"_address,omitempty" json:"_address,omitempty"`
+	Header                 []string          `bson:"header,omitempty" json:"header,omitempty"`
+	HeaderElement          []*Element        `bson:"_header,omitempty" json:"_header,omitempty"`
+}
 
 // MarshalJSON marshals the given Endpoint as JSON into a byte slice
 func (r Endpoint) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherEndpoint
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherEndpoint: OtherEndpoint(r),
-		ResourceType:  "Endpoint",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Endpoint as JSON object with the members in the order of the fields
+func (r Endpoint) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Endpoint\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("connectionType")
+	r.ConnectionType.appendJSON(e)
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.ManagingOrganization != nil {
+		e.key("managingOrganization")
+		r.ManagingOrganization.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.key("payloadType")
+	if r.PayloadType == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PayloadType {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PayloadMimeType) > 0 {
+		e.key("payloadMimeType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PayloadMimeType {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PayloadMimeTypeElement) > 0 {
+		e.key("_payloadMimeType")
+		e.elements(r.PayloadMimeTypeElement, len(r.PayloadMimeType))
+	}
+	e.key("address")
+	e.string(r.Address)
+	if r.AddressElement != nil {
+		e.key("_address")
+		r.AddressElement.appendJSON(e)
+	}
+	if len(r.Header) > 0 {
+		e.key("header")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Header {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.HeaderElement) > 0 {
+		e.key("_header")
+		e.elements(r.HeaderElement, len(r.Header))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Endpoint from JSON. Unknown members are ignored.
+func (r *Endpoint) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Endpoint
+func (r *Endpoint) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "connectionType":
+			r.ConnectionType.decodeJSON(d)
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "managingOrganization":
+			if d.null() {
+				r.ManagingOrganization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ManagingOrganization = &v
+			}
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactPoint{}
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "payloadType":
+			a := d.array()
+			r.PayloadType = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PayloadType = append(r.PayloadType, v)
+			}
+			if r.PayloadType == nil && !a.isNull {
+				r.PayloadType = []CodeableConcept{}
+			}
+		case "payloadMimeType":
+			a := d.array()
+			r.PayloadMimeType = nil
+			for a.next() {
+				v, _ := d.string()
+				r.PayloadMimeType = append(r.PayloadMimeType, v)
+			}
+			if r.PayloadMimeType == nil && !a.isNull {
+				r.PayloadMimeType = []string{}
+			}
+		case "_payloadMimeType":
+			a := d.array()
+			r.PayloadMimeTypeElement = nil
+			for a.next() {
+				r.PayloadMimeTypeElement = append(r.PayloadMimeTypeElement, d.element())
+			}
+		case "address":
+			if v, ok := d.string(); ok {
+				r.Address = v
+			}
+		case "_address":
+			r.AddressElement = d.element()
+		case "header":
+			a := d.array()
+			r.Header = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Header = append(r.Header, v)
+			}
+			if r.Header == nil && !a.isNull {
+				r.Header = []string{}
+			}
+		case "_header":
+			a := d.array()
+			r.HeaderElement = nil
+			for a.next() {
+				r.HeaderElement = append(r.HeaderElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Endpoint which shares no memory with the original
+func (r Endpoint) DeepCopy() Endpoint {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.ConnectionType = r.ConnectionType.DeepCopy()
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.ManagingOrganization != nil {
+		v := r.ManagingOrganization.DeepCopy()
+		out.ManagingOrganization = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactPoint, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.PayloadType != nil {
+		out.PayloadType = make([]CodeableConcept, len(r.PayloadType))
+		for i := range r.PayloadType {
+			out.PayloadType[i] = r.PayloadType[i].DeepCopy()
+		}
+	}
+	if r.PayloadMimeType != nil {
+		out.PayloadMimeType = make([]string, len(r.PayloadMimeType))
+		copy(out.PayloadMimeType, r.PayloadMimeType)
+	}
+	if r.PayloadMimeTypeElement != nil {
+		out.PayloadMimeTypeElement = make([]*Element, len(r.PayloadMimeTypeElement))
+		for i, v := range r.PayloadMimeTypeElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PayloadMimeTypeElement[i] = &c
+			}
+		}
+	}
+	if r.AddressElement != nil {
+		v := r.AddressElement.DeepCopy()
+		out.AddressElement = &v
+	}
+	if r.Header != nil {
+		out.Header = make([]string, len(r.Header))
+		copy(out.Header, r.Header)
+	}
+	if r.HeaderElement != nil {
+		out.HeaderElement = make([]*Element, len(r.HeaderElement))
+		for i, v := range r.HeaderElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.HeaderElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Endpoint) Equal(other Endpoint) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.ConnectionType.Equal(other.ConnectionType) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.Equal(*other.ManagingOrganization) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if len(r.PayloadType) != len(other.PayloadType) {
+		return false
+	}
+	for i := range r.PayloadType {
+		if !r.PayloadType[i].Equal(other.PayloadType[i]) {
+			return false
+		}
+	}
+	if len(r.PayloadMimeType) != len(other.PayloadMimeType) {
+		return false
+	}
+	for i := range r.PayloadMimeType {
+		if r.PayloadMimeType[i] != other.PayloadMimeType[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PayloadMimeTypeElement, other.PayloadMimeTypeElement, false) {
+		return false
+	}
+	if r.Address != other.Address {
+		return false
+	}
+	if (r.AddressElement == nil) != (other.AddressElement == nil) || r.AddressElement != nil && !r.AddressElement.Equal(*other.AddressElement) {
+		return false
+	}
+	if len(r.Header) != len(other.Header) {
+		return false
+	}
+	for i := range r.Header {
+		if r.Header[i] != other.Header[i] {
+			return false
+		}
+	}
+	if !equalElements(r.HeaderElement, other.HeaderElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Endpoint) EqualsDeep(other Endpoint) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.ConnectionType.EqualsDeep(other.ConnectionType) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.EqualsDeep(*other.ManagingOrganization) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentList(len(r.PayloadType), len(other.PayloadType), func(i, j int) bool {
+		return r.PayloadType[i].EqualsDeep(other.PayloadType[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PayloadMimeType), len(other.PayloadMimeType), func(i, j int) bool {
+		return equivalentString(r.PayloadMimeType[i], other.PayloadMimeType[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PayloadMimeTypeElement, other.PayloadMimeTypeElement, true) {
+		return false
+	}
+	if !equivalentString(r.Address, other.Address) {
+		return false
+	}
+	if (r.AddressElement == nil) != (other.AddressElement == nil) || r.AddressElement != nil && !r.AddressElement.EqualsDeep(*other.AddressElement) {
+		return false
+	}
+	if !equivalentList(len(r.Header), len(other.Header), func(i, j int) bool {
+		return equivalentString(r.Header[i], other.Header[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.HeaderElement, other.HeaderElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Endpoint) EqualsShallow(other Endpoint) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if !equivalentList(len(r.PayloadMimeType), len(other.PayloadMimeType), func(i, j int) bool {
+		return equivalentString(r.PayloadMimeType[i], other.PayloadMimeType[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Address, other.Address) {
+		return false
+	}
+	if !equivalentList(len(r.Header), len(other.Header), func(i, j int) bool {
+		return equivalentString(r.Header[i], other.Header[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Endpoint as FHIR XML
+func (r Endpoint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Endpoint",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "connectionType", r.ConnectionType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "managingOrganization", r.ManagingOrganization); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	for _, v := range r.PayloadType {
+		if err := encodeXMLElement(e, "payloadType", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.PayloadMimeType {
+		if err := encodeXMLPrimitive(e, "payloadMimeType", v, elementAt(r.PayloadMimeTypeElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "address", r.Address, r.AddressElement); err != nil {
+		return err
+	}
+	for i, v := range r.Header {
+		if err := encodeXMLPrimitive(e, "header", v, elementAt(r.HeaderElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Endpoint from FHIR XML
+func (r *Endpoint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v EndpointStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "connectionType":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ConnectionType = v
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "managingOrganization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManagingOrganization = &v
+			case "contact":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "payloadType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PayloadType = append(r.PayloadType, v)
+			case "payloadMimeType":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PayloadMimeTypeElement = appendElement(r.PayloadMimeTypeElement, len(r.PayloadMimeType), element)
+					r.PayloadMimeType = append(r.PayloadMimeType, v)
+				}
+			case "address":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Address = v
+				}
+				r.AddressElement = element
+			case "header":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.HeaderElement = appendElement(r.HeaderElement, len(r.Header), element)
+					r.Header = append(r.Header, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Endpoint as properties to the RDF node n
+func (r Endpoint) turtle(n *turtleNode) {
+	n.resource("Endpoint", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Endpoint.identifier", i, v)
+	}
+	n.primitive("Endpoint.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Endpoint.connectionType", -1, r.ConnectionType)
+	n.primitive("Endpoint.name", -1, r.Name, r.NameElement, "string")
+	n.element("Endpoint.managingOrganization", -1, r.ManagingOrganization)
+	for i, v := range r.Contact {
+		n.element("Endpoint.contact", i, v)
+	}
+	n.element("Endpoint.period", -1, r.Period)
+	for i, v := range r.PayloadType {
+		n.element("Endpoint.payloadType", i, v)
+	}
+	for i, v := range r.PayloadMimeType {
+		n.primitive("Endpoint.payloadMimeType", i, v, elementAt(r.PayloadMimeTypeElement, i), "string")
+	}
+	n.primitive("Endpoint.address", -1, r.Address, r.AddressElement, "string")
+	for i, v := range r.Header {
+		n.primitive("Endpoint.header", i, v, elementAt(r.HeaderElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given Endpoint as BSON document with the structure of its FHIR JSON
+func (r Endpoint) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Endpoint from a BSON document with the structure of its FHIR JSON
+func (r *Endpoint) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Endpoint as protobuf message Endpoint of fhir.proto
+func (r Endpoint) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Endpoint to the encoded message
+func (r Endpoint) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	e.message(11, r.ConnectionType)
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.ManagingOrganization != nil {
+		e.message(13, *r.ManagingOrganization)
+	}
+	for _, v := range r.Contact {
+		e.message(14, v)
+	}
+	if r.Period != nil {
+		e.message(15, *r.Period)
+	}
+	for _, v := range r.PayloadType {
+		e.message(16, v)
+	}
+	for _, v := range r.PayloadMimeType {
+		e.string(17, v)
+	}
+	if r.Address != "" {
+		e.string(18, r.Address)
+	}
+	for _, v := range r.Header {
+		e.string(19, v)
+	}
+	if r.IdElement != nil {
+		e.message(20, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(21, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(22, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(23, *r.StatusElement)
+	}
+	if r.NameElement != nil {
+		e.message(24, *r.NameElement)
+	}
+	for _, v := range r.PayloadMimeTypeElement {
+		e.element(25, v)
+	}
+	if r.AddressElement != nil {
+		e.message(26, *r.AddressElement)
+	}
+	for _, v := range r.HeaderElement {
+		e.element(27, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given Endpoint from protobuf message Endpoint of fhir.proto
+func (r *Endpoint) UnmarshalProto(b []byte) error {
+	*r = Endpoint{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v EndpointStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v Coding
+			d.message(&v)
+			r.ConnectionType = v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.ManagingOrganization = &v
+		case 14:
+			var v ContactPoint
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 15:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.PayloadType = append(r.PayloadType, v)
+		case 17:
+			r.PayloadMimeType = append(r.PayloadMimeType, d.string())
+		case 18:
+			r.Address = d.string()
+		case 19:
+			r.Header = append(r.Header, d.string())
+		case 20:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 25:
+			r.PayloadMimeTypeElement = append(r.PayloadMimeTypeElement, d.element())
+		case 26:
+			var v Element
+			d.message(&v)
+			r.AddressElement = &v
+		case 27:
+			r.HeaderElement = append(r.HeaderElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalEndpoint unmarshals a Endpoint.
 func UnmarshalEndpoint(b []byte) (Endpoint, error) {
 	var endpoint Endpoint
-	if err := json.Unmarshal(b, &endpoint); err != nil {
+	if err := endpoint.UnmarshalJSON(b); err != nil {
 		return endpoint, err
 	}
 	return endpoint, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code EndpointStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EndpointStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EndpointStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = EndpointStatusActive
@@ -58,6 +62,12 @@ func (code *EndpointStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EndpointStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EndpointStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EndpointStatus) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code EndpointStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EndpointStatus) protoNumber() uint64 {
+	switch code {
+	case EndpointStatusActive:
+		return 1
+	case EndpointStatusSuspended:
+		return 2
+	case EndpointStatusError:
+		return 3
+	case EndpointStatusOff:
+		return 4
+	case EndpointStatusEnteredInError:
+		return 5
+	case EndpointStatusTest:
+		return 6
+	}
+	return 0
+}
+func (code *EndpointStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EndpointStatusActive
+	case 2:
+		*code = EndpointStatusSuspended
+	case 3:
+		*code = EndpointStatusError
+	case 4:
+		*code = EndpointStatusOff
+	case 5:
+		*code = EndpointStatusEnteredInError
+	case 6:
+		*code = EndpointStatusTest
+	default:
+		return false
+	}
+	return true
+}
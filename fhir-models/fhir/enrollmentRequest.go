@@ -14,45 +14,973 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // EnrollmentRequest is documented here http://hl7.org/fhir/StructureDefinition/EnrollmentRequest
 type EnrollmentRequest struct {
-	Id                *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            *FinancialResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
-	Created           *string                       `bson:"created,omitempty" json:"created,omitempty"`
-	Insurer           *Reference                    `bson:"insurer,omitempty" json:"insurer,omitempty"`
-	Provider          *Reference                    `bson:"provider,omitempty" json:"provider,omitempty"`
-	Candidate         *Reference                    `bson:"candidate,omitempty" json:"candidate,omitempty"`
-	Coverage          *Reference                    `bson:"coverage,omitempty" json:"coverage,omitempty"`
-}
-type OtherEnrollmentRequest EnrollmentRequest
+	Id                   *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                      `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               *FinancialResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	Created              *string                       `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement       *Element                      `bson:"_created,omitempty" json:"_created,omitempty"`
+	Insurer              *Reference                    `bson:"insurer,omitempty" json:"insurer,omitempty"`
+	Provider             *Reference                    `bson:"provider,omitempty" json:"provider,omitempty"`
+	Candidate            *Reference                    `bson:"candidate,omitempty" json:"candidate,omitempty"`
+	Coverage             *Reference                    `bson:"coverage,omitempty" json:"coverage,omitempty"`
+}
 
 // MarshalJSON marshals the given EnrollmentRequest as JSON into a byte slice
 func (r EnrollmentRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherEnrollmentRequest
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherEnrollmentRequest: OtherEnrollmentRequest(r),
-		ResourceType:           "EnrollmentRequest",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EnrollmentRequest as JSON object with the members in the order of the fields
+func (r EnrollmentRequest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"EnrollmentRequest\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Created != nil {
+		e.key("created")
+		e.string(*r.Created)
+	}
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.Insurer != nil {
+		e.key("insurer")
+		r.Insurer.appendJSON(e)
+	}
+	if r.Provider != nil {
+		e.key("provider")
+		r.Provider.appendJSON(e)
+	}
+	if r.Candidate != nil {
+		e.key("candidate")
+		r.Candidate.appendJSON(e)
+	}
+	if r.Coverage != nil {
+		e.key("coverage")
+		r.Coverage.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EnrollmentRequest from JSON. Unknown members are ignored.
+func (r *EnrollmentRequest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EnrollmentRequest
+func (r *EnrollmentRequest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v FinancialResourceStatusCodes
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = &v
+			} else {
+				r.Created = nil
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "insurer":
+			if d.null() {
+				r.Insurer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Insurer = &v
+			}
+		case "provider":
+			if d.null() {
+				r.Provider = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Provider = &v
+			}
+		case "candidate":
+			if d.null() {
+				r.Candidate = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Candidate = &v
+			}
+		case "coverage":
+			if d.null() {
+				r.Coverage = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Coverage = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EnrollmentRequest which shares no memory with the original
+func (r EnrollmentRequest) DeepCopy() EnrollmentRequest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Created != nil {
+		v := *r.Created
+		out.Created = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Insurer != nil {
+		v := r.Insurer.DeepCopy()
+		out.Insurer = &v
+	}
+	if r.Provider != nil {
+		v := r.Provider.DeepCopy()
+		out.Provider = &v
+	}
+	if r.Candidate != nil {
+		v := r.Candidate.DeepCopy()
+		out.Candidate = &v
+	}
+	if r.Coverage != nil {
+		v := r.Coverage.DeepCopy()
+		out.Coverage = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EnrollmentRequest) Equal(other EnrollmentRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && *r.Created != *other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.Insurer == nil) != (other.Insurer == nil) || r.Insurer != nil && !r.Insurer.Equal(*other.Insurer) {
+		return false
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.Equal(*other.Provider) {
+		return false
+	}
+	if (r.Candidate == nil) != (other.Candidate == nil) || r.Candidate != nil && !r.Candidate.Equal(*other.Candidate) {
+		return false
+	}
+	if (r.Coverage == nil) != (other.Coverage == nil) || r.Coverage != nil && !r.Coverage.Equal(*other.Coverage) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EnrollmentRequest) EqualsDeep(other EnrollmentRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.Insurer == nil) != (other.Insurer == nil) || r.Insurer != nil && !r.Insurer.EqualsDeep(*other.Insurer) {
+		return false
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.EqualsDeep(*other.Provider) {
+		return false
+	}
+	if (r.Candidate == nil) != (other.Candidate == nil) || r.Candidate != nil && !r.Candidate.EqualsDeep(*other.Candidate) {
+		return false
+	}
+	if (r.Coverage == nil) != (other.Coverage == nil) || r.Coverage != nil && !r.Coverage.EqualsDeep(*other.Coverage) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EnrollmentRequest) EqualsShallow(other EnrollmentRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EnrollmentRequest as FHIR XML
+func (r EnrollmentRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "EnrollmentRequest",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "insurer", r.Insurer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "provider", r.Provider); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "candidate", r.Candidate); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "coverage", r.Coverage); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EnrollmentRequest from FHIR XML
+func (r *EnrollmentRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = &v
+				}
+				r.CreatedElement = element
+			case "insurer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurer = &v
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = &v
+			case "candidate":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Candidate = &v
+			case "coverage":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coverage = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EnrollmentRequest as properties to the RDF node n
+func (r EnrollmentRequest) turtle(n *turtleNode) {
+	n.resource("EnrollmentRequest", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("EnrollmentRequest.identifier", i, v)
+	}
+	n.primitive("EnrollmentRequest.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("EnrollmentRequest.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("EnrollmentRequest.insurer", -1, r.Insurer)
+	n.element("EnrollmentRequest.provider", -1, r.Provider)
+	n.element("EnrollmentRequest.candidate", -1, r.Candidate)
+	n.element("EnrollmentRequest.coverage", -1, r.Coverage)
+}
+
+// MarshalBSON marshals the given EnrollmentRequest as BSON document with the structure of its FHIR JSON
+func (r EnrollmentRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EnrollmentRequest from a BSON document with the structure of its FHIR JSON
+func (r *EnrollmentRequest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EnrollmentRequest as protobuf message EnrollmentRequest of fhir.proto
+func (r EnrollmentRequest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EnrollmentRequest to the encoded message
+func (r EnrollmentRequest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Status != nil {
+		e.varint(10, r.Status.protoNumber())
+	}
+	if r.Created != nil {
+		e.string(11, *r.Created)
+	}
+	if r.Insurer != nil {
+		e.message(12, *r.Insurer)
+	}
+	if r.Provider != nil {
+		e.message(13, *r.Provider)
+	}
+	if r.Candidate != nil {
+		e.message(14, *r.Candidate)
+	}
+	if r.Coverage != nil {
+		e.message(15, *r.Coverage)
+	}
+	if r.IdElement != nil {
+		e.message(16, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(17, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(18, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(19, *r.StatusElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(20, *r.CreatedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EnrollmentRequest from protobuf message EnrollmentRequest of fhir.proto
+func (r *EnrollmentRequest) UnmarshalProto(b []byte) error {
+	*r = EnrollmentRequest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 11:
+			v := d.string()
+			r.Created = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Insurer = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Provider = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Candidate = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Coverage = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalEnrollmentRequest unmarshals a EnrollmentRequest.
 func UnmarshalEnrollmentRequest(b []byte) (EnrollmentRequest, error) {
 	var enrollmentRequest EnrollmentRequest
-	if err := json.Unmarshal(b, &enrollmentRequest); err != nil {
+	if err := enrollmentRequest.UnmarshalJSON(b); err != nil {
 		return enrollmentRequest, err
 	}
 	return enrollmentRequest, nil
@@ -14,46 +14,1072 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // EnrollmentResponse is documented here http://hl7.org/fhir/StructureDefinition/EnrollmentResponse
 type EnrollmentResponse struct {
-	Id                *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            *FinancialResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
-	Request           *Reference                    `bson:"request,omitempty" json:"request,omitempty"`
-	Outcome           *ClaimProcessingCodes         `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	Disposition       *string                       `bson:"disposition,omitempty" json:"disposition,omitempty"`
-	Created           *string                       `bson:"created,omitempty" json:"created,omitempty"`
-	Organization      *Reference                    `bson:"organization,omitempty" json:"organization,omitempty"`
-	RequestProvider   *Reference                    `bson:"requestProvider,omitempty" json:"requestProvider,omitempty"`
-}
-type OtherEnrollmentResponse EnrollmentResponse
+	Id                   *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                      `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               *FinancialResourceStatusCodes `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	Request              *Reference                    `bson:"request,omitempty" json:"request,omitempty"`
+	Outcome              *ClaimProcessingCodes         `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	OutcomeElement       *Element                      `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
+	Disposition          *string                       `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	DispositionElement   *Element                      `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
+	Created              *string                       `bson:"created,omitempty" json:"created,omitempty"`
+	CreatedElement       *Element                      `bson:"_created,omitempty" json:"_created,omitempty"`
+	Organization         *Reference                    `bson:"organization,omitempty" json:"organization,omitempty"`
+	RequestProvider      *Reference                    `bson:"requestProvider,omitempty" json:"requestProvider,omitempty"`
+}
 
 // MarshalJSON marshals the given EnrollmentResponse as JSON into a byte slice
 func (r EnrollmentResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherEnrollmentResponse
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherEnrollmentResponse: OtherEnrollmentResponse(r),
-		ResourceType:            "EnrollmentResponse",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EnrollmentResponse as JSON object with the members in the order of the fields
+func (r EnrollmentResponse) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"EnrollmentResponse\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Request != nil {
+		e.key("request")
+		r.Request.appendJSON(e)
+	}
+	if r.Outcome != nil {
+		e.key("outcome")
+		e.string(r.Outcome.Code())
+	}
+	if r.OutcomeElement != nil {
+		e.key("_outcome")
+		r.OutcomeElement.appendJSON(e)
+	}
+	if r.Disposition != nil {
+		e.key("disposition")
+		e.string(*r.Disposition)
+	}
+	if r.DispositionElement != nil {
+		e.key("_disposition")
+		r.DispositionElement.appendJSON(e)
+	}
+	if r.Created != nil {
+		e.key("created")
+		e.string(*r.Created)
+	}
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.Organization != nil {
+		e.key("organization")
+		r.Organization.appendJSON(e)
+	}
+	if r.RequestProvider != nil {
+		e.key("requestProvider")
+		r.RequestProvider.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EnrollmentResponse from JSON. Unknown members are ignored.
+func (r *EnrollmentResponse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EnrollmentResponse
+func (r *EnrollmentResponse) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v FinancialResourceStatusCodes
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "request":
+			if d.null() {
+				r.Request = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Request = &v
+			}
+		case "outcome":
+			if d.null() {
+				r.Outcome = nil
+			} else {
+				var v ClaimProcessingCodes
+				d.unmarshal(&v)
+				r.Outcome = &v
+			}
+		case "_outcome":
+			r.OutcomeElement = d.element()
+		case "disposition":
+			if v, ok := d.string(); ok {
+				r.Disposition = &v
+			} else {
+				r.Disposition = nil
+			}
+		case "_disposition":
+			r.DispositionElement = d.element()
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = &v
+			} else {
+				r.Created = nil
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "organization":
+			if d.null() {
+				r.Organization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Organization = &v
+			}
+		case "requestProvider":
+			if d.null() {
+				r.RequestProvider = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.RequestProvider = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EnrollmentResponse which shares no memory with the original
+func (r EnrollmentResponse) DeepCopy() EnrollmentResponse {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Request != nil {
+		v := r.Request.DeepCopy()
+		out.Request = &v
+	}
+	if r.Outcome != nil {
+		v := *r.Outcome
+		out.Outcome = &v
+	}
+	if r.OutcomeElement != nil {
+		v := r.OutcomeElement.DeepCopy()
+		out.OutcomeElement = &v
+	}
+	if r.Disposition != nil {
+		v := *r.Disposition
+		out.Disposition = &v
+	}
+	if r.DispositionElement != nil {
+		v := r.DispositionElement.DeepCopy()
+		out.DispositionElement = &v
+	}
+	if r.Created != nil {
+		v := *r.Created
+		out.Created = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Organization != nil {
+		v := r.Organization.DeepCopy()
+		out.Organization = &v
+	}
+	if r.RequestProvider != nil {
+		v := r.RequestProvider.DeepCopy()
+		out.RequestProvider = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EnrollmentResponse) Equal(other EnrollmentResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.Equal(*other.Request) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && *r.Outcome != *other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.Equal(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && *r.Disposition != *other.Disposition {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.Equal(*other.DispositionElement) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && *r.Created != *other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.Organization == nil) != (other.Organization == nil) || r.Organization != nil && !r.Organization.Equal(*other.Organization) {
+		return false
+	}
+	if (r.RequestProvider == nil) != (other.RequestProvider == nil) || r.RequestProvider != nil && !r.RequestProvider.Equal(*other.RequestProvider) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EnrollmentResponse) EqualsDeep(other EnrollmentResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.EqualsDeep(*other.Request) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && *r.Outcome != *other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.EqualsDeep(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.EqualsDeep(*other.DispositionElement) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.Organization == nil) != (other.Organization == nil) || r.Organization != nil && !r.Organization.EqualsDeep(*other.Organization) {
+		return false
+	}
+	if (r.RequestProvider == nil) != (other.RequestProvider == nil) || r.RequestProvider != nil && !r.RequestProvider.EqualsDeep(*other.RequestProvider) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EnrollmentResponse) EqualsShallow(other EnrollmentResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && *r.Outcome != *other.Outcome {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if (r.Created == nil) != (other.Created == nil) || r.Created != nil && !equivalentString(*r.Created, *other.Created) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EnrollmentResponse as FHIR XML
+func (r EnrollmentResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "EnrollmentResponse",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "request", r.Request); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "outcome", r.Outcome, r.OutcomeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "disposition", r.Disposition, r.DispositionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "organization", r.Organization); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requestProvider", r.RequestProvider); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EnrollmentResponse from FHIR XML
+func (r *EnrollmentResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "request":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = &v
+			case "outcome":
+				var v ClaimProcessingCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Outcome = &v
+				}
+				r.OutcomeElement = element
+			case "disposition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Disposition = &v
+				}
+				r.DispositionElement = element
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = &v
+				}
+				r.CreatedElement = element
+			case "organization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Organization = &v
+			case "requestProvider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RequestProvider = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EnrollmentResponse as properties to the RDF node n
+func (r EnrollmentResponse) turtle(n *turtleNode) {
+	n.resource("EnrollmentResponse", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("EnrollmentResponse.identifier", i, v)
+	}
+	n.primitive("EnrollmentResponse.status", -1, r.Status, r.StatusElement, "code")
+	n.element("EnrollmentResponse.request", -1, r.Request)
+	n.primitive("EnrollmentResponse.outcome", -1, r.Outcome, r.OutcomeElement, "code")
+	n.primitive("EnrollmentResponse.disposition", -1, r.Disposition, r.DispositionElement, "string")
+	n.primitive("EnrollmentResponse.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("EnrollmentResponse.organization", -1, r.Organization)
+	n.element("EnrollmentResponse.requestProvider", -1, r.RequestProvider)
+}
+
+// MarshalBSON marshals the given EnrollmentResponse as BSON document with the structure of its FHIR JSON
+func (r EnrollmentResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EnrollmentResponse from a BSON document with the structure of its FHIR JSON
+func (r *EnrollmentResponse) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EnrollmentResponse as protobuf message EnrollmentResponse of fhir.proto
+func (r EnrollmentResponse) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EnrollmentResponse to the encoded message
+func (r EnrollmentResponse) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Status != nil {
+		e.varint(10, r.Status.protoNumber())
+	}
+	if r.Request != nil {
+		e.message(11, *r.Request)
+	}
+	if r.Outcome != nil {
+		e.varint(12, r.Outcome.protoNumber())
+	}
+	if r.Disposition != nil {
+		e.string(13, *r.Disposition)
+	}
+	if r.Created != nil {
+		e.string(14, *r.Created)
+	}
+	if r.Organization != nil {
+		e.message(15, *r.Organization)
+	}
+	if r.RequestProvider != nil {
+		e.message(16, *r.RequestProvider)
+	}
+	if r.IdElement != nil {
+		e.message(17, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(18, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(19, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(20, *r.StatusElement)
+	}
+	if r.OutcomeElement != nil {
+		e.message(21, *r.OutcomeElement)
+	}
+	if r.DispositionElement != nil {
+		e.message(22, *r.DispositionElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(23, *r.CreatedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EnrollmentResponse from protobuf message EnrollmentResponse of fhir.proto
+func (r *EnrollmentResponse) UnmarshalProto(b []byte) error {
+	*r = EnrollmentResponse{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Request = &v
+		case 12:
+			var v ClaimProcessingCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Outcome = &v
+		case 13:
+			v := d.string()
+			r.Disposition = &v
+		case 14:
+			v := d.string()
+			r.Created = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Organization = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.RequestProvider = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.OutcomeElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.DispositionElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalEnrollmentResponse unmarshals a EnrollmentResponse.
 func UnmarshalEnrollmentResponse(b []byte) (EnrollmentResponse, error) {
 	var enrollmentResponse EnrollmentResponse
-	if err := json.Unmarshal(b, &enrollmentResponse); err != nil {
+	if err := enrollmentResponse.UnmarshalJSON(b); err != nil {
 		return enrollmentResponse, err
 	}
 	return enrollmentResponse, nil
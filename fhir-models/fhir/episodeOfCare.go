@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,14 +25,19 @@ import "encoding/json"
 // EpisodeOfCare is documented here http://hl7.org/fhir/StructureDefinition/EpisodeOfCare
 type EpisodeOfCare struct {
 	Id                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                     `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage            `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status               EpisodeOfCareStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
 	StatusHistory        []EpisodeOfCareStatusHistory `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
 	Type                 []CodeableConcept            `bson:"type,omitempty" json:"type,omitempty"`
 	Diagnosis            []EpisodeOfCareDiagnosis     `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
@@ -46,6 +54,7 @@ type EpisodeOfCareStatusHistory struct {
 	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Status            EpisodeOfCareStatus `bson:"status" json:"status"`
+	StatusElement     *Element            `bson:"_status,omitempty" json:"_status,omitempty"`
 	Period            Period              `bson:"period" json:"period"`
 }
 type EpisodeOfCareDiagnosis struct {
@@ -55,24 +64,1993 @@ type EpisodeOfCareDiagnosis struct {
 	Condition         Reference        `bson:"condition" json:"condition"`
 	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
 	Rank              *int             `bson:"rank,omitempty" json:"rank,omitempty"`
+	RankElement       *Element         `bson:"_rank,omitempty" json:"_rank,omitempty"`
 }
-type OtherEpisodeOfCare EpisodeOfCare
 
 // MarshalJSON marshals the given EpisodeOfCare as JSON into a byte slice
 func (r EpisodeOfCare) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherEpisodeOfCare
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherEpisodeOfCare: OtherEpisodeOfCare(r),
-		ResourceType:       "EpisodeOfCare",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EpisodeOfCare as JSON object with the members in the order of the fields
+func (r EpisodeOfCare) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"EpisodeOfCare\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.StatusHistory) > 0 {
+		e.key("statusHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.StatusHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Diagnosis) > 0 {
+		e.key("diagnosis")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Diagnosis {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.ManagingOrganization != nil {
+		e.key("managingOrganization")
+		r.ManagingOrganization.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if len(r.ReferralRequest) > 0 {
+		e.key("referralRequest")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReferralRequest {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.CareManager != nil {
+		e.key("careManager")
+		r.CareManager.appendJSON(e)
+	}
+	if len(r.Team) > 0 {
+		e.key("team")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Team {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Account) > 0 {
+		e.key("account")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Account {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EpisodeOfCare from JSON. Unknown members are ignored.
+func (r *EpisodeOfCare) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EpisodeOfCare
+func (r *EpisodeOfCare) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusHistory":
+			a := d.array()
+			r.StatusHistory = nil
+			for a.next() {
+				var v EpisodeOfCareStatusHistory
+				v.decodeJSON(d)
+				r.StatusHistory = append(r.StatusHistory, v)
+			}
+			if r.StatusHistory == nil && !a.isNull {
+				r.StatusHistory = []EpisodeOfCareStatusHistory{}
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "diagnosis":
+			a := d.array()
+			r.Diagnosis = nil
+			for a.next() {
+				var v EpisodeOfCareDiagnosis
+				v.decodeJSON(d)
+				r.Diagnosis = append(r.Diagnosis, v)
+			}
+			if r.Diagnosis == nil && !a.isNull {
+				r.Diagnosis = []EpisodeOfCareDiagnosis{}
+			}
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "managingOrganization":
+			if d.null() {
+				r.ManagingOrganization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ManagingOrganization = &v
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "referralRequest":
+			a := d.array()
+			r.ReferralRequest = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReferralRequest = append(r.ReferralRequest, v)
+			}
+			if r.ReferralRequest == nil && !a.isNull {
+				r.ReferralRequest = []Reference{}
+			}
+		case "careManager":
+			if d.null() {
+				r.CareManager = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.CareManager = &v
+			}
+		case "team":
+			a := d.array()
+			r.Team = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Team = append(r.Team, v)
+			}
+			if r.Team == nil && !a.isNull {
+				r.Team = []Reference{}
+			}
+		case "account":
+			a := d.array()
+			r.Account = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Account = append(r.Account, v)
+			}
+			if r.Account == nil && !a.isNull {
+				r.Account = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EpisodeOfCare which shares no memory with the original
+func (r EpisodeOfCare) DeepCopy() EpisodeOfCare {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusHistory != nil {
+		out.StatusHistory = make([]EpisodeOfCareStatusHistory, len(r.StatusHistory))
+		for i := range r.StatusHistory {
+			out.StatusHistory[i] = r.StatusHistory[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.Diagnosis != nil {
+		out.Diagnosis = make([]EpisodeOfCareDiagnosis, len(r.Diagnosis))
+		for i := range r.Diagnosis {
+			out.Diagnosis[i] = r.Diagnosis[i].DeepCopy()
+		}
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.ManagingOrganization != nil {
+		v := r.ManagingOrganization.DeepCopy()
+		out.ManagingOrganization = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.ReferralRequest != nil {
+		out.ReferralRequest = make([]Reference, len(r.ReferralRequest))
+		for i := range r.ReferralRequest {
+			out.ReferralRequest[i] = r.ReferralRequest[i].DeepCopy()
+		}
+	}
+	if r.CareManager != nil {
+		v := r.CareManager.DeepCopy()
+		out.CareManager = &v
+	}
+	if r.Team != nil {
+		out.Team = make([]Reference, len(r.Team))
+		for i := range r.Team {
+			out.Team[i] = r.Team[i].DeepCopy()
+		}
+	}
+	if r.Account != nil {
+		out.Account = make([]Reference, len(r.Account))
+		for i := range r.Account {
+			out.Account[i] = r.Account[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EpisodeOfCare) Equal(other EpisodeOfCare) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.StatusHistory) != len(other.StatusHistory) {
+		return false
+	}
+	for i := range r.StatusHistory {
+		if !r.StatusHistory[i].Equal(other.StatusHistory[i]) {
+			return false
+		}
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if len(r.Diagnosis) != len(other.Diagnosis) {
+		return false
+	}
+	for i := range r.Diagnosis {
+		if !r.Diagnosis[i].Equal(other.Diagnosis[i]) {
+			return false
+		}
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.Equal(*other.ManagingOrganization) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if len(r.ReferralRequest) != len(other.ReferralRequest) {
+		return false
+	}
+	for i := range r.ReferralRequest {
+		if !r.ReferralRequest[i].Equal(other.ReferralRequest[i]) {
+			return false
+		}
+	}
+	if (r.CareManager == nil) != (other.CareManager == nil) || r.CareManager != nil && !r.CareManager.Equal(*other.CareManager) {
+		return false
+	}
+	if len(r.Team) != len(other.Team) {
+		return false
+	}
+	for i := range r.Team {
+		if !r.Team[i].Equal(other.Team[i]) {
+			return false
+		}
+	}
+	if len(r.Account) != len(other.Account) {
+		return false
+	}
+	for i := range r.Account {
+		if !r.Account[i].Equal(other.Account[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EpisodeOfCare) EqualsDeep(other EpisodeOfCare) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.StatusHistory), len(other.StatusHistory), func(i, j int) bool {
+		return r.StatusHistory[i].EqualsDeep(other.StatusHistory[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Diagnosis), len(other.Diagnosis), func(i, j int) bool {
+		return r.Diagnosis[i].EqualsDeep(other.Diagnosis[j])
+	}) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.EqualsDeep(*other.ManagingOrganization) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentList(len(r.ReferralRequest), len(other.ReferralRequest), func(i, j int) bool {
+		return r.ReferralRequest[i].EqualsDeep(other.ReferralRequest[j])
+	}) {
+		return false
+	}
+	if (r.CareManager == nil) != (other.CareManager == nil) || r.CareManager != nil && !r.CareManager.EqualsDeep(*other.CareManager) {
+		return false
+	}
+	if !equivalentList(len(r.Team), len(other.Team), func(i, j int) bool {
+		return r.Team[i].EqualsDeep(other.Team[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Account), len(other.Account), func(i, j int) bool {
+		return r.Account[i].EqualsDeep(other.Account[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EpisodeOfCare) EqualsShallow(other EpisodeOfCare) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EpisodeOfCare as FHIR XML
+func (r EpisodeOfCare) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "EpisodeOfCare",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.StatusHistory {
+		if err := encodeXMLElement(e, "statusHistory", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Diagnosis {
+		if err := encodeXMLElement(e, "diagnosis", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "managingOrganization", r.ManagingOrganization); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	for _, v := range r.ReferralRequest {
+		if err := encodeXMLElement(e, "referralRequest", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "careManager", r.CareManager); err != nil {
+		return err
+	}
+	for _, v := range r.Team {
+		if err := encodeXMLElement(e, "team", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Account {
+		if err := encodeXMLElement(e, "account", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EpisodeOfCare from FHIR XML
+func (r *EpisodeOfCare) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v EpisodeOfCareStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusHistory":
+				var v EpisodeOfCareStatusHistory
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusHistory = append(r.StatusHistory, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "diagnosis":
+				var v EpisodeOfCareDiagnosis
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Diagnosis = append(r.Diagnosis, v)
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "managingOrganization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManagingOrganization = &v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "referralRequest":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferralRequest = append(r.ReferralRequest, v)
+			case "careManager":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CareManager = &v
+			case "team":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Team = append(r.Team, v)
+			case "account":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Account = append(r.Account, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EpisodeOfCare as properties to the RDF node n
+func (r EpisodeOfCare) turtle(n *turtleNode) {
+	n.resource("EpisodeOfCare", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("EpisodeOfCare.identifier", i, v)
+	}
+	n.primitive("EpisodeOfCare.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.StatusHistory {
+		n.element("EpisodeOfCare.statusHistory", i, v)
+	}
+	for i, v := range r.Type {
+		n.element("EpisodeOfCare.type", i, v)
+	}
+	for i, v := range r.Diagnosis {
+		n.element("EpisodeOfCare.diagnosis", i, v)
+	}
+	n.element("EpisodeOfCare.patient", -1, r.Patient)
+	n.element("EpisodeOfCare.managingOrganization", -1, r.ManagingOrganization)
+	n.element("EpisodeOfCare.period", -1, r.Period)
+	for i, v := range r.ReferralRequest {
+		n.element("EpisodeOfCare.referralRequest", i, v)
+	}
+	n.element("EpisodeOfCare.careManager", -1, r.CareManager)
+	for i, v := range r.Team {
+		n.element("EpisodeOfCare.team", i, v)
+	}
+	for i, v := range r.Account {
+		n.element("EpisodeOfCare.account", i, v)
+	}
+}
+
+// MarshalBSON marshals the given EpisodeOfCare as BSON document with the structure of its FHIR JSON
+func (r EpisodeOfCare) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EpisodeOfCare from a BSON document with the structure of its FHIR JSON
+func (r *EpisodeOfCare) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EpisodeOfCare as protobuf message EpisodeOfCare of fhir.proto
+func (r EpisodeOfCare) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EpisodeOfCare to the encoded message
+func (r EpisodeOfCare) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	for _, v := range r.StatusHistory {
+		e.message(11, v)
+	}
+	for _, v := range r.Type {
+		e.message(12, v)
+	}
+	for _, v := range r.Diagnosis {
+		e.message(13, v)
+	}
+	e.message(14, r.Patient)
+	if r.ManagingOrganization != nil {
+		e.message(15, *r.ManagingOrganization)
+	}
+	if r.Period != nil {
+		e.message(16, *r.Period)
+	}
+	for _, v := range r.ReferralRequest {
+		e.message(17, v)
+	}
+	if r.CareManager != nil {
+		e.message(18, *r.CareManager)
+	}
+	for _, v := range r.Team {
+		e.message(19, v)
+	}
+	for _, v := range r.Account {
+		e.message(20, v)
+	}
+	if r.IdElement != nil {
+		e.message(21, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(22, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(23, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(24, *r.StatusElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EpisodeOfCare from protobuf message EpisodeOfCare of fhir.proto
+func (r *EpisodeOfCare) UnmarshalProto(b []byte) error {
+	*r = EpisodeOfCare{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v EpisodeOfCareStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v EpisodeOfCareStatusHistory
+			d.message(&v)
+			r.StatusHistory = append(r.StatusHistory, v)
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 13:
+			var v EpisodeOfCareDiagnosis
+			d.message(&v)
+			r.Diagnosis = append(r.Diagnosis, v)
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.ManagingOrganization = &v
+		case 16:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.ReferralRequest = append(r.ReferralRequest, v)
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.CareManager = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Team = append(r.Team, v)
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Account = append(r.Account, v)
+		case 21:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given EpisodeOfCareStatusHistory as JSON into a byte slice
+func (r EpisodeOfCareStatusHistory) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EpisodeOfCareStatusHistory as JSON object with the members in the order of the fields
+func (r EpisodeOfCareStatusHistory) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("period")
+	r.Period.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EpisodeOfCareStatusHistory from JSON. Unknown members are ignored.
+func (r *EpisodeOfCareStatusHistory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EpisodeOfCareStatusHistory
+func (r *EpisodeOfCareStatusHistory) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "period":
+			r.Period.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EpisodeOfCareStatusHistory which shares no memory with the original
+func (r EpisodeOfCareStatusHistory) DeepCopy() EpisodeOfCareStatusHistory {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.Period = r.Period.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EpisodeOfCareStatusHistory) Equal(other EpisodeOfCareStatusHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.Period.Equal(other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EpisodeOfCareStatusHistory) EqualsDeep(other EpisodeOfCareStatusHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.Period.EqualsDeep(other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EpisodeOfCareStatusHistory) EqualsShallow(other EpisodeOfCareStatusHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EpisodeOfCareStatusHistory as FHIR XML
+func (r EpisodeOfCareStatusHistory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EpisodeOfCareStatusHistory from FHIR XML
+func (r *EpisodeOfCareStatusHistory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "status":
+				var v EpisodeOfCareStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EpisodeOfCareStatusHistory as properties to the RDF node n
+func (r EpisodeOfCareStatusHistory) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("EpisodeOfCare.statusHistory.status", -1, r.Status, r.StatusElement, "code")
+	n.element("EpisodeOfCare.statusHistory.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given EpisodeOfCareStatusHistory as BSON document with the structure of its FHIR JSON
+func (r EpisodeOfCareStatusHistory) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EpisodeOfCareStatusHistory from a BSON document with the structure of its FHIR JSON
+func (r *EpisodeOfCareStatusHistory) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EpisodeOfCareStatusHistory as protobuf message EpisodeOfCareStatusHistory of fhir.proto
+func (r EpisodeOfCareStatusHistory) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EpisodeOfCareStatusHistory to the encoded message
+func (r EpisodeOfCareStatusHistory) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Status.protoNumber())
+	e.message(5, r.Period)
+	if r.StatusElement != nil {
+		e.message(6, *r.StatusElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EpisodeOfCareStatusHistory from protobuf message EpisodeOfCareStatusHistory of fhir.proto
+func (r *EpisodeOfCareStatusHistory) UnmarshalProto(b []byte) error {
+	*r = EpisodeOfCareStatusHistory{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v EpisodeOfCareStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 5:
+			var v Period
+			d.message(&v)
+			r.Period = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given EpisodeOfCareDiagnosis as JSON into a byte slice
+func (r EpisodeOfCareDiagnosis) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EpisodeOfCareDiagnosis as JSON object with the members in the order of the fields
+func (r EpisodeOfCareDiagnosis) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("condition")
+	r.Condition.appendJSON(e)
+	if r.Role != nil {
+		e.key("role")
+		r.Role.appendJSON(e)
+	}
+	if r.Rank != nil {
+		e.key("rank")
+		e.int(*r.Rank)
+	}
+	if r.RankElement != nil {
+		e.key("_rank")
+		r.RankElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EpisodeOfCareDiagnosis from JSON. Unknown members are ignored.
+func (r *EpisodeOfCareDiagnosis) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EpisodeOfCareDiagnosis
+func (r *EpisodeOfCareDiagnosis) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "condition":
+			r.Condition.decodeJSON(d)
+		case "role":
+			if d.null() {
+				r.Role = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = &v
+			}
+		case "rank":
+			if v, ok := d.int(); ok {
+				r.Rank = &v
+			} else {
+				r.Rank = nil
+			}
+		case "_rank":
+			r.RankElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EpisodeOfCareDiagnosis which shares no memory with the original
+func (r EpisodeOfCareDiagnosis) DeepCopy() EpisodeOfCareDiagnosis {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Condition = r.Condition.DeepCopy()
+	if r.Role != nil {
+		v := r.Role.DeepCopy()
+		out.Role = &v
+	}
+	if r.Rank != nil {
+		v := *r.Rank
+		out.Rank = &v
+	}
+	if r.RankElement != nil {
+		v := r.RankElement.DeepCopy()
+		out.RankElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EpisodeOfCareDiagnosis) Equal(other EpisodeOfCareDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Condition.Equal(other.Condition) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.Equal(*other.Role) {
+		return false
+	}
+	if (r.Rank == nil) != (other.Rank == nil) || r.Rank != nil && *r.Rank != *other.Rank {
+		return false
+	}
+	if (r.RankElement == nil) != (other.RankElement == nil) || r.RankElement != nil && !r.RankElement.Equal(*other.RankElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EpisodeOfCareDiagnosis) EqualsDeep(other EpisodeOfCareDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Condition.EqualsDeep(other.Condition) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.EqualsDeep(*other.Role) {
+		return false
+	}
+	if (r.Rank == nil) != (other.Rank == nil) || r.Rank != nil && *r.Rank != *other.Rank {
+		return false
+	}
+	if (r.RankElement == nil) != (other.RankElement == nil) || r.RankElement != nil && !r.RankElement.EqualsDeep(*other.RankElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EpisodeOfCareDiagnosis) EqualsShallow(other EpisodeOfCareDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Rank == nil) != (other.Rank == nil) || r.Rank != nil && *r.Rank != *other.Rank {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EpisodeOfCareDiagnosis as FHIR XML
+func (r EpisodeOfCareDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "condition", r.Condition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "rank", r.Rank, r.RankElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EpisodeOfCareDiagnosis from FHIR XML
+func (r *EpisodeOfCareDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "condition":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Condition = v
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = &v
+			case "rank":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Rank = &v
+				}
+				r.RankElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EpisodeOfCareDiagnosis as properties to the RDF node n
+func (r EpisodeOfCareDiagnosis) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("EpisodeOfCare.diagnosis.condition", -1, r.Condition)
+	n.element("EpisodeOfCare.diagnosis.role", -1, r.Role)
+	n.primitive("EpisodeOfCare.diagnosis.rank", -1, r.Rank, r.RankElement, "positiveInt")
+}
+
+// MarshalBSON marshals the given EpisodeOfCareDiagnosis as BSON document with the structure of its FHIR JSON
+func (r EpisodeOfCareDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EpisodeOfCareDiagnosis from a BSON document with the structure of its FHIR JSON
+func (r *EpisodeOfCareDiagnosis) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EpisodeOfCareDiagnosis as protobuf message EpisodeOfCareDiagnosis of fhir.proto
+func (r EpisodeOfCareDiagnosis) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EpisodeOfCareDiagnosis to the encoded message
+func (r EpisodeOfCareDiagnosis) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Condition)
+	if r.Role != nil {
+		e.message(5, *r.Role)
+	}
+	if r.Rank != nil {
+		e.varint(6, uint64(int64(*r.Rank)))
+	}
+	if r.RankElement != nil {
+		e.message(7, *r.RankElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EpisodeOfCareDiagnosis from protobuf message EpisodeOfCareDiagnosis of fhir.proto
+func (r *EpisodeOfCareDiagnosis) UnmarshalProto(b []byte) error {
+	*r = EpisodeOfCareDiagnosis{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Condition = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = &v
+		case 6:
+			v := d.int()
+			r.Rank = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.RankElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalEpisodeOfCare unmarshals a EpisodeOfCare.
 func UnmarshalEpisodeOfCare(b []byte) (EpisodeOfCare, error) {
 	var episodeOfCare EpisodeOfCare
-	if err := json.Unmarshal(b, &episodeOfCare); err != nil {
+	if err := episodeOfCare.UnmarshalJSON(b); err != nil {
 		return episodeOfCare, err
 	}
 	return episodeOfCare, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code EpisodeOfCareStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EpisodeOfCareStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EpisodeOfCareStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "planned":
 		*code = EpisodeOfCareStatusPlanned
@@ -61,6 +65,12 @@ func (code *EpisodeOfCareStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EpisodeOfCareStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EpisodeOfCareStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EpisodeOfCareStatus) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code EpisodeOfCareStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EpisodeOfCareStatus) protoNumber() uint64 {
+	switch code {
+	case EpisodeOfCareStatusPlanned:
+		return 1
+	case EpisodeOfCareStatusWaitlist:
+		return 2
+	case EpisodeOfCareStatusActive:
+		return 3
+	case EpisodeOfCareStatusOnhold:
+		return 4
+	case EpisodeOfCareStatusFinished:
+		return 5
+	case EpisodeOfCareStatusCancelled:
+		return 6
+	case EpisodeOfCareStatusEnteredInError:
+		return 7
+	}
+	return 0
+}
+func (code *EpisodeOfCareStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EpisodeOfCareStatusPlanned
+	case 2:
+		*code = EpisodeOfCareStatusWaitlist
+	case 3:
+		*code = EpisodeOfCareStatusActive
+	case 4:
+		*code = EpisodeOfCareStatusOnhold
+	case 5:
+		*code = EpisodeOfCareStatusFinished
+	case 6:
+		*code = EpisodeOfCareStatusCancelled
+	case 7:
+		*code = EpisodeOfCareStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// EqualDecimal reports whether both decimals have the same value, so that 1.0 equals 1.00.
+func EqualDecimal(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	x, okX := new(big.Rat).SetString(string(a))
+	y, okY := new(big.Rat).SetString(string(b))
+	return okX && okY && x.Cmp(y) == 0
+}
+
+// equivalentDecimal reports whether both decimals are equal after rounding them to the precision of the less precise
+// one.
+func equivalentDecimal(a, b json.Number) bool {
+	x, okX := new(big.Rat).SetString(string(a))
+	y, okY := new(big.Rat).SetString(string(b))
+	if !okX || !okY {
+		return a == b
+	}
+	precision := decimalPrecision(a)
+	if p := decimalPrecision(b); p < precision {
+		precision = p
+	}
+	return x.FloatString(precision) == y.FloatString(precision)
+}
+
+func decimalPrecision(d json.Number) int {
+	s := string(d)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+// equivalentString compares strings ignoring case and normalizing whitespace.
+func equivalentString(a, b string) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(a), " "), strings.Join(strings.Fields(b), " "))
+}
+
+// equalResource reports whether both raw resources encode the same JSON value.
+func equalResource(a, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	var x, y interface{}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// equivalentList reports whether every item of one list has an equivalent item in the other list regardless of
+// order.
+func equivalentList(n, m int, equivalent func(i, j int) bool) bool {
+	if n != m {
+		return false
+	}
+	used := make([]bool, m)
+outer:
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			if !used[j] && equivalent(i, j) {
+				used[j] = true
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// equalElements compares the ids and extensions of the values of a primitive list by index. Missing items at the end
+// equal nil items.
+func equalElements(a, b []*Element, equivalent bool) bool {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		x, y := elementAt(a, i), elementAt(b, i)
+		switch {
+		case x == nil || y == nil:
+			if x != y {
+				return false
+			}
+		case equivalent && !x.EqualsDeep(*y), !equivalent && !x.Equal(*y):
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testPatient() Patient {
+	id := "0"
+	family := "Doe"
+	active := true
+	gender := AdministrativeGenderFemale
+	return Patient{
+		Id:     &id,
+		Active: &active,
+		Gender: &gender,
+		Name: []HumanName{{
+			Family: &family,
+			Given:  []string{"Jane", "Mary"},
+		}},
+		Contained: []json.RawMessage{json.RawMessage(`{"resourceType":"Organization","id":"1"}`)},
+	}
+}
+
+func TestDeepCopyIsIndependent(t *testing.T) {
+	original := testPatient()
+	copied := original.DeepCopy()
+	if !copied.Equal(original) {
+		t.Fatal("copy differs from the original")
+	}
+
+	*copied.Id = "1"
+	*copied.Active = false
+	*copied.Name[0].Family = "Roe"
+	copied.Name[0].Given[0] = "Joan"
+	copied.Contained[0][2] = 'X'
+
+	if *original.Id != "0" || !*original.Active || *original.Name[0].Family != "Doe" ||
+		original.Name[0].Given[0] != "Jane" || original.Contained[0][2] != 'r' {
+		t.Errorf("changing the copy changed the original: %+v", original)
+	}
+	if copied.Equal(original) {
+		t.Error("changed copy equals the original")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  Quantity
+		equal bool
+	}{
+		{"same", Quantity{Value: numberPtr("1.5")}, Quantity{Value: numberPtr("1.5")}, true},
+		{"decimal by value", Quantity{Value: numberPtr("1.5")}, Quantity{Value: numberPtr("1.50")}, true},
+		{"different value", Quantity{Value: numberPtr("1.5")}, Quantity{Value: numberPtr("1.6")}, false},
+		{"absent value", Quantity{Value: numberPtr("1.5")}, Quantity{}, false},
+		{"empty and absent list", Quantity{Extension: []Extension{}}, Quantity{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.equal {
+				t.Errorf("Equal = %v, want %v", got, tt.equal)
+			}
+		})
+	}
+}
+
+func TestEqualContainedByValue(t *testing.T) {
+	a := testPatient()
+	b := testPatient()
+	b.Contained[0] = json.RawMessage(`{ "id": "1", "resourceType": "Organization" }`)
+	if !a.Equal(b) {
+		t.Error("contained resources differing only in member order and whitespace are not equal")
+	}
+}
+
+func TestEqualsDeepAndShallow(t *testing.T) {
+	a := testPatient()
+	b := testPatient()
+	*b.Name[0].Family = "  doe "
+	b.Name[0].Given = []string{"Mary", "Jane"}
+	if !a.EqualsDeep(b) {
+		t.Error("EqualsDeep ignores neither case, whitespace nor order")
+	}
+	if a.Equal(b) {
+		t.Error("Equal ignores case, whitespace or order")
+	}
+
+	c := testPatient()
+	c.Name = nil
+	if a.EqualsDeep(c) {
+		t.Error("EqualsDeep ignores the names")
+	}
+	if !a.EqualsShallow(c) {
+		t.Error("EqualsShallow compares the names")
+	}
+}
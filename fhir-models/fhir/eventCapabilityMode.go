@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code EventCapabilityMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EventCapabilityMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EventCapabilityMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "sender":
 		*code = EventCapabilityModeSender
@@ -46,6 +50,12 @@ func (code *EventCapabilityMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EventCapabilityMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EventCapabilityMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EventCapabilityMode) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code EventCapabilityMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EventCapabilityMode) protoNumber() uint64 {
+	switch code {
+	case EventCapabilityModeSender:
+		return 1
+	case EventCapabilityModeReceiver:
+		return 2
+	}
+	return 0
+}
+func (code *EventCapabilityMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EventCapabilityModeSender
+	case 2:
+		*code = EventCapabilityModeReceiver
+	default:
+		return false
+	}
+	return true
+}
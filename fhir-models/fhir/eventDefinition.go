@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,33 +25,52 @@ import "encoding/json"
 // EventDefinition is documented here http://hl7.org/fhir/StructureDefinition/EventDefinition
 type EventDefinition struct {
 	Id                     *string             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element            `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                   *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules          *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language               *string             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element            `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                   *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage   `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension              []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension      []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Url                    *string             `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement             *Element            `bson:"_url,omitempty" json:"_url,omitempty"`
 	Identifier             []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Version                *string             `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement         *Element            `bson:"_version,omitempty" json:"_version,omitempty"`
 	Name                   *string             `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element            `bson:"_name,omitempty" json:"_name,omitempty"`
 	Title                  *string             `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement           *Element            `bson:"_title,omitempty" json:"_title,omitempty"`
 	Subtitle               *string             `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement        *Element            `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
 	Status                 PublicationStatus   `bson:"status" json:"status"`
+	StatusElement          *Element            `bson:"_status,omitempty" json:"_status,omitempty"`
 	Experimental           *bool               `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement    *Element            `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
 	SubjectCodeableConcept *CodeableConcept    `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
 	SubjectReference       *Reference          `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
 	Date                   *string             `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element            `bson:"_date,omitempty" json:"_date,omitempty"`
 	Publisher              *string             `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement       *Element            `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
 	Contact                []ContactDetail     `bson:"contact,omitempty" json:"contact,omitempty"`
 	Description            *string             `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element            `bson:"_description,omitempty" json:"_description,omitempty"`
 	UseContext             []UsageContext      `bson:"useContext,omitempty" json:"useContext,omitempty"`
 	Jurisdiction           []CodeableConcept   `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
 	Purpose                *string             `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement         *Element            `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
 	Usage                  *string             `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement           *Element            `bson:"_usage,omitempty" json:"_usage,omitempty"`
 	Copyright              *string             `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement       *Element            `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
 	ApprovalDate           *string             `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement    *Element            `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
 	LastReviewDate         *string             `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement  *Element            `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
 	EffectivePeriod        *Period             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
 	Topic                  []CodeableConcept   `bson:"topic,omitempty" json:"topic,omitempty"`
 	Author                 []ContactDetail     `bson:"author,omitempty" json:"author,omitempty"`
@@ -58,23 +80,2384 @@ type EventDefinition struct {
 	RelatedArtifact        []RelatedArtifact   `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
 	Trigger                []TriggerDefinition `bson:"trigger" json:"trigger"`
 }
-type OtherEventDefinition EventDefinition
+
+// EventDefinitionSubject is implemented by the types allowed for EventDefinition.subject[x]
+type EventDefinitionSubject interface {
+	isEventDefinitionSubject()
+}
+
+func (CodeableConcept) isEventDefinitionSubject() {}
+func (Reference) isEventDefinitionSubject()       {}
+
+// Subject returns the value of EventDefinition.subject[x] together with its FHIR type code or nil if no value is set
+func (r EventDefinition) Subject() (EventDefinitionSubject, string) {
+	if r.SubjectCodeableConcept != nil {
+		return *r.SubjectCodeableConcept, "CodeableConcept"
+	}
+	if r.SubjectReference != nil {
+		return *r.SubjectReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetSubject sets the value of EventDefinition.subject[x], given as value or pointer, and clears all other types
+func (r *EventDefinition) SetSubject(value EventDefinitionSubject) {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.SubjectCodeableConcept = &v
+	case *CodeableConcept:
+		r.SubjectCodeableConcept = v
+	case Reference:
+		r.SubjectReference = &v
+	case *Reference:
+		r.SubjectReference = v
+	}
+}
 
 // MarshalJSON marshals the given EventDefinition as JSON into a byte slice
 func (r EventDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherEventDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherEventDefinition: OtherEventDefinition(r),
-		ResourceType:         "EventDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EventDefinition as JSON object with the members in the order of the fields
+func (r EventDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"EventDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Subtitle != nil {
+		e.key("subtitle")
+		e.string(*r.Subtitle)
+	}
+	if r.SubtitleElement != nil {
+		e.key("_subtitle")
+		r.SubtitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.key("subjectCodeableConcept")
+		r.SubjectCodeableConcept.appendJSON(e)
+	}
+	if r.SubjectReference != nil {
+		e.key("subjectReference")
+		r.SubjectReference.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Usage != nil {
+		e.key("usage")
+		e.string(*r.Usage)
+	}
+	if r.UsageElement != nil {
+		e.key("_usage")
+		r.UsageElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.ApprovalDate != nil {
+		e.key("approvalDate")
+		e.string(*r.ApprovalDate)
+	}
+	if r.ApprovalDateElement != nil {
+		e.key("_approvalDate")
+		r.ApprovalDateElement.appendJSON(e)
+	}
+	if r.LastReviewDate != nil {
+		e.key("lastReviewDate")
+		e.string(*r.LastReviewDate)
+	}
+	if r.LastReviewDateElement != nil {
+		e.key("_lastReviewDate")
+		r.LastReviewDateElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if len(r.Topic) > 0 {
+		e.key("topic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Topic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Editor) > 0 {
+		e.key("editor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Editor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Reviewer) > 0 {
+		e.key("reviewer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reviewer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endorser) > 0 {
+		e.key("endorser")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endorser {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedArtifact) > 0 {
+		e.key("relatedArtifact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedArtifact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("trigger")
+	if r.Trigger == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Trigger {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EventDefinition from JSON. Unknown members are ignored.
+func (r *EventDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EventDefinition
+func (r *EventDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "subtitle":
+			if v, ok := d.string(); ok {
+				r.Subtitle = &v
+			} else {
+				r.Subtitle = nil
+			}
+		case "_subtitle":
+			r.SubtitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "subjectCodeableConcept":
+			if d.null() {
+				r.SubjectCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubjectCodeableConcept = &v
+			}
+		case "subjectReference":
+			if d.null() {
+				r.SubjectReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubjectReference = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "usage":
+			if v, ok := d.string(); ok {
+				r.Usage = &v
+			} else {
+				r.Usage = nil
+			}
+		case "_usage":
+			r.UsageElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "approvalDate":
+			if v, ok := d.string(); ok {
+				r.ApprovalDate = &v
+			} else {
+				r.ApprovalDate = nil
+			}
+		case "_approvalDate":
+			r.ApprovalDateElement = d.element()
+		case "lastReviewDate":
+			if v, ok := d.string(); ok {
+				r.LastReviewDate = &v
+			} else {
+				r.LastReviewDate = nil
+			}
+		case "_lastReviewDate":
+			r.LastReviewDateElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "topic":
+			a := d.array()
+			r.Topic = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Topic = append(r.Topic, v)
+			}
+			if r.Topic == nil && !a.isNull {
+				r.Topic = []CodeableConcept{}
+			}
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []ContactDetail{}
+			}
+		case "editor":
+			a := d.array()
+			r.Editor = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Editor = append(r.Editor, v)
+			}
+			if r.Editor == nil && !a.isNull {
+				r.Editor = []ContactDetail{}
+			}
+		case "reviewer":
+			a := d.array()
+			r.Reviewer = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Reviewer = append(r.Reviewer, v)
+			}
+			if r.Reviewer == nil && !a.isNull {
+				r.Reviewer = []ContactDetail{}
+			}
+		case "endorser":
+			a := d.array()
+			r.Endorser = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Endorser = append(r.Endorser, v)
+			}
+			if r.Endorser == nil && !a.isNull {
+				r.Endorser = []ContactDetail{}
+			}
+		case "relatedArtifact":
+			a := d.array()
+			r.RelatedArtifact = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			}
+			if r.RelatedArtifact == nil && !a.isNull {
+				r.RelatedArtifact = []RelatedArtifact{}
+			}
+		case "trigger":
+			a := d.array()
+			r.Trigger = nil
+			for a.next() {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.Trigger = append(r.Trigger, v)
+			}
+			if r.Trigger == nil && !a.isNull {
+				r.Trigger = []TriggerDefinition{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EventDefinition which shares no memory with the original
+func (r EventDefinition) DeepCopy() EventDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Subtitle != nil {
+		v := *r.Subtitle
+		out.Subtitle = &v
+	}
+	if r.SubtitleElement != nil {
+		v := r.SubtitleElement.DeepCopy()
+		out.SubtitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.SubjectCodeableConcept != nil {
+		v := r.SubjectCodeableConcept.DeepCopy()
+		out.SubjectCodeableConcept = &v
+	}
+	if r.SubjectReference != nil {
+		v := r.SubjectReference.DeepCopy()
+		out.SubjectReference = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Usage != nil {
+		v := *r.Usage
+		out.Usage = &v
+	}
+	if r.UsageElement != nil {
+		v := r.UsageElement.DeepCopy()
+		out.UsageElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.ApprovalDate != nil {
+		v := *r.ApprovalDate
+		out.ApprovalDate = &v
+	}
+	if r.ApprovalDateElement != nil {
+		v := r.ApprovalDateElement.DeepCopy()
+		out.ApprovalDateElement = &v
+	}
+	if r.LastReviewDate != nil {
+		v := *r.LastReviewDate
+		out.LastReviewDate = &v
+	}
+	if r.LastReviewDateElement != nil {
+		v := r.LastReviewDateElement.DeepCopy()
+		out.LastReviewDateElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Topic != nil {
+		out.Topic = make([]CodeableConcept, len(r.Topic))
+		for i := range r.Topic {
+			out.Topic[i] = r.Topic[i].DeepCopy()
+		}
+	}
+	if r.Author != nil {
+		out.Author = make([]ContactDetail, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Editor != nil {
+		out.Editor = make([]ContactDetail, len(r.Editor))
+		for i := range r.Editor {
+			out.Editor[i] = r.Editor[i].DeepCopy()
+		}
+	}
+	if r.Reviewer != nil {
+		out.Reviewer = make([]ContactDetail, len(r.Reviewer))
+		for i := range r.Reviewer {
+			out.Reviewer[i] = r.Reviewer[i].DeepCopy()
+		}
+	}
+	if r.Endorser != nil {
+		out.Endorser = make([]ContactDetail, len(r.Endorser))
+		for i := range r.Endorser {
+			out.Endorser[i] = r.Endorser[i].DeepCopy()
+		}
+	}
+	if r.RelatedArtifact != nil {
+		out.RelatedArtifact = make([]RelatedArtifact, len(r.RelatedArtifact))
+		for i := range r.RelatedArtifact {
+			out.RelatedArtifact[i] = r.RelatedArtifact[i].DeepCopy()
+		}
+	}
+	if r.Trigger != nil {
+		out.Trigger = make([]TriggerDefinition, len(r.Trigger))
+		for i := range r.Trigger {
+			out.Trigger[i] = r.Trigger[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EventDefinition) Equal(other EventDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && *r.Subtitle != *other.Subtitle {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.Equal(*other.SubtitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.Equal(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.Equal(*other.SubjectReference) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && *r.Usage != *other.Usage {
+		return false
+	}
+	if (r.UsageElement == nil) != (other.UsageElement == nil) || r.UsageElement != nil && !r.UsageElement.Equal(*other.UsageElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && *r.ApprovalDate != *other.ApprovalDate {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.Equal(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && *r.LastReviewDate != *other.LastReviewDate {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.Equal(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if len(r.Topic) != len(other.Topic) {
+		return false
+	}
+	for i := range r.Topic {
+		if !r.Topic[i].Equal(other.Topic[i]) {
+			return false
+		}
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if len(r.Editor) != len(other.Editor) {
+		return false
+	}
+	for i := range r.Editor {
+		if !r.Editor[i].Equal(other.Editor[i]) {
+			return false
+		}
+	}
+	if len(r.Reviewer) != len(other.Reviewer) {
+		return false
+	}
+	for i := range r.Reviewer {
+		if !r.Reviewer[i].Equal(other.Reviewer[i]) {
+			return false
+		}
+	}
+	if len(r.Endorser) != len(other.Endorser) {
+		return false
+	}
+	for i := range r.Endorser {
+		if !r.Endorser[i].Equal(other.Endorser[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedArtifact) != len(other.RelatedArtifact) {
+		return false
+	}
+	for i := range r.RelatedArtifact {
+		if !r.RelatedArtifact[i].Equal(other.RelatedArtifact[i]) {
+			return false
+		}
+	}
+	if len(r.Trigger) != len(other.Trigger) {
+		return false
+	}
+	for i := range r.Trigger {
+		if !r.Trigger[i].Equal(other.Trigger[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EventDefinition) EqualsDeep(other EventDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.EqualsDeep(*other.SubtitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.EqualsDeep(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.EqualsDeep(*other.SubjectReference) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && !equivalentString(*r.Usage, *other.Usage) {
+		return false
+	}
+	if (r.UsageElement == nil) != (other.UsageElement == nil) || r.UsageElement != nil && !r.UsageElement.EqualsDeep(*other.UsageElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.EqualsDeep(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.EqualsDeep(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Topic), len(other.Topic), func(i, j int) bool {
+		return r.Topic[i].EqualsDeep(other.Topic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Editor), len(other.Editor), func(i, j int) bool {
+		return r.Editor[i].EqualsDeep(other.Editor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reviewer), len(other.Reviewer), func(i, j int) bool {
+		return r.Reviewer[i].EqualsDeep(other.Reviewer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endorser), len(other.Endorser), func(i, j int) bool {
+		return r.Endorser[i].EqualsDeep(other.Endorser[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedArtifact), len(other.RelatedArtifact), func(i, j int) bool {
+		return r.RelatedArtifact[i].EqualsDeep(other.RelatedArtifact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Trigger), len(other.Trigger), func(i, j int) bool {
+		return r.Trigger[i].EqualsDeep(other.Trigger[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EventDefinition) EqualsShallow(other EventDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && !equivalentString(*r.Usage, *other.Usage) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EventDefinition as FHIR XML
+func (r EventDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "EventDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "subtitle", r.Subtitle, r.SubtitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectCodeableConcept", r.SubjectCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectReference", r.SubjectReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "usage", r.Usage, r.UsageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "approvalDate", r.ApprovalDate, r.ApprovalDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastReviewDate", r.LastReviewDate, r.LastReviewDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Topic {
+		if err := encodeXMLElement(e, "topic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Editor {
+		if err := encodeXMLElement(e, "editor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reviewer {
+		if err := encodeXMLElement(e, "reviewer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endorser {
+		if err := encodeXMLElement(e, "endorser", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedArtifact {
+		if err := encodeXMLElement(e, "relatedArtifact", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Trigger {
+		if err := encodeXMLElement(e, "trigger", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EventDefinition from FHIR XML
+func (r *EventDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "subtitle":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subtitle = &v
+				}
+				r.SubtitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "subjectCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectCodeableConcept = &v
+			case "subjectReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectReference = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "usage":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Usage = &v
+				}
+				r.UsageElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "approvalDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ApprovalDate = &v
+				}
+				r.ApprovalDateElement = element
+			case "lastReviewDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastReviewDate = &v
+				}
+				r.LastReviewDateElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "topic":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Topic = append(r.Topic, v)
+			case "author":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "editor":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Editor = append(r.Editor, v)
+			case "reviewer":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reviewer = append(r.Reviewer, v)
+			case "endorser":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endorser = append(r.Endorser, v)
+			case "relatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			case "trigger":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Trigger = append(r.Trigger, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EventDefinition as properties to the RDF node n
+func (r EventDefinition) turtle(n *turtleNode) {
+	n.resource("EventDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("EventDefinition.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("EventDefinition.identifier", i, v)
+	}
+	n.primitive("EventDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("EventDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("EventDefinition.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("EventDefinition.subtitle", -1, r.Subtitle, r.SubtitleElement, "string")
+	n.primitive("EventDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("EventDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.element("EventDefinition.subjectCodeableConcept", -1, r.SubjectCodeableConcept)
+	n.element("EventDefinition.subjectReference", -1, r.SubjectReference)
+	n.primitive("EventDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("EventDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("EventDefinition.contact", i, v)
+	}
+	n.primitive("EventDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("EventDefinition.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("EventDefinition.jurisdiction", i, v)
+	}
+	n.primitive("EventDefinition.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("EventDefinition.usage", -1, r.Usage, r.UsageElement, "string")
+	n.primitive("EventDefinition.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("EventDefinition.approvalDate", -1, r.ApprovalDate, r.ApprovalDateElement, "date")
+	n.primitive("EventDefinition.lastReviewDate", -1, r.LastReviewDate, r.LastReviewDateElement, "date")
+	n.element("EventDefinition.effectivePeriod", -1, r.EffectivePeriod)
+	for i, v := range r.Topic {
+		n.element("EventDefinition.topic", i, v)
+	}
+	for i, v := range r.Author {
+		n.element("EventDefinition.author", i, v)
+	}
+	for i, v := range r.Editor {
+		n.element("EventDefinition.editor", i, v)
+	}
+	for i, v := range r.Reviewer {
+		n.element("EventDefinition.reviewer", i, v)
+	}
+	for i, v := range r.Endorser {
+		n.element("EventDefinition.endorser", i, v)
+	}
+	for i, v := range r.RelatedArtifact {
+		n.element("EventDefinition.relatedArtifact", i, v)
+	}
+	for i, v := range r.Trigger {
+		n.element("EventDefinition.trigger", i, v)
+	}
+}
+
+// MarshalBSON marshals the given EventDefinition as BSON document with the structure of its FHIR JSON
+func (r EventDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EventDefinition from a BSON document with the structure of its FHIR JSON
+func (r *EventDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EventDefinition as protobuf message EventDefinition of fhir.proto
+func (r EventDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EventDefinition to the encoded message
+func (r EventDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	if r.Subtitle != nil {
+		e.string(14, *r.Subtitle)
+	}
+	e.varint(15, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(16, protoBool(*r.Experimental))
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.message(17, *r.SubjectCodeableConcept)
+	}
+	if r.SubjectReference != nil {
+		e.message(18, *r.SubjectReference)
+	}
+	if r.Date != nil {
+		e.string(19, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(20, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(21, v)
+	}
+	if r.Description != nil {
+		e.string(22, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(23, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(24, v)
+	}
+	if r.Purpose != nil {
+		e.string(25, *r.Purpose)
+	}
+	if r.Usage != nil {
+		e.string(26, *r.Usage)
+	}
+	if r.Copyright != nil {
+		e.string(27, *r.Copyright)
+	}
+	if r.ApprovalDate != nil {
+		e.string(28, *r.ApprovalDate)
+	}
+	if r.LastReviewDate != nil {
+		e.string(29, *r.LastReviewDate)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(30, *r.EffectivePeriod)
+	}
+	for _, v := range r.Topic {
+		e.message(31, v)
+	}
+	for _, v := range r.Author {
+		e.message(32, v)
+	}
+	for _, v := range r.Editor {
+		e.message(33, v)
+	}
+	for _, v := range r.Reviewer {
+		e.message(34, v)
+	}
+	for _, v := range r.Endorser {
+		e.message(35, v)
+	}
+	for _, v := range r.RelatedArtifact {
+		e.message(36, v)
+	}
+	for _, v := range r.Trigger {
+		e.message(37, v)
+	}
+	if r.IdElement != nil {
+		e.message(38, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(39, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(40, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(41, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(42, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(43, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(44, *r.TitleElement)
+	}
+	if r.SubtitleElement != nil {
+		e.message(45, *r.SubtitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(46, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(47, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(48, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(49, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(50, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(51, *r.PurposeElement)
+	}
+	if r.UsageElement != nil {
+		e.message(52, *r.UsageElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(53, *r.CopyrightElement)
+	}
+	if r.ApprovalDateElement != nil {
+		e.message(54, *r.ApprovalDateElement)
+	}
+	if r.LastReviewDateElement != nil {
+		e.message(55, *r.LastReviewDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EventDefinition from protobuf message EventDefinition of fhir.proto
+func (r *EventDefinition) UnmarshalProto(b []byte) error {
+	*r = EventDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			v := d.string()
+			r.Subtitle = &v
+		case 15:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 16:
+			v := d.bool()
+			r.Experimental = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubjectCodeableConcept = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.SubjectReference = &v
+		case 19:
+			v := d.string()
+			r.Date = &v
+		case 20:
+			v := d.string()
+			r.Publisher = &v
+		case 21:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 22:
+			v := d.string()
+			r.Description = &v
+		case 23:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 25:
+			v := d.string()
+			r.Purpose = &v
+		case 26:
+			v := d.string()
+			r.Usage = &v
+		case 27:
+			v := d.string()
+			r.Copyright = &v
+		case 28:
+			v := d.string()
+			r.ApprovalDate = &v
+		case 29:
+			v := d.string()
+			r.LastReviewDate = &v
+		case 30:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 31:
+			var v CodeableConcept
+			d.message(&v)
+			r.Topic = append(r.Topic, v)
+		case 32:
+			var v ContactDetail
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 33:
+			var v ContactDetail
+			d.message(&v)
+			r.Editor = append(r.Editor, v)
+		case 34:
+			var v ContactDetail
+			d.message(&v)
+			r.Reviewer = append(r.Reviewer, v)
+		case 35:
+			var v ContactDetail
+			d.message(&v)
+			r.Endorser = append(r.Endorser, v)
+		case 36:
+			var v RelatedArtifact
+			d.message(&v)
+			r.RelatedArtifact = append(r.RelatedArtifact, v)
+		case 37:
+			var v TriggerDefinition
+			d.message(&v)
+			r.Trigger = append(r.Trigger, v)
+		case 38:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.SubtitleElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.UsageElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 54:
+			var v Element
+			d.message(&v)
+			r.ApprovalDateElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.LastReviewDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalEventDefinition unmarshals a EventDefinition.
 func UnmarshalEventDefinition(b []byte) (EventDefinition, error) {
 	var eventDefinition EventDefinition
-	if err := json.Unmarshal(b, &eventDefinition); err != nil {
+	if err := eventDefinition.UnmarshalJSON(b); err != nil {
 		return eventDefinition, err
 	}
 	return eventDefinition, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -40,8 +39,13 @@ const (
 func (code EventStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EventStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EventStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "preparation":
 		*code = EventStatusPreparation
@@ -64,6 +68,12 @@ func (code *EventStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EventStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EventStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EventStatus) String() string {
 	return code.Code()
 }
@@ -130,3 +140,47 @@ func (code EventStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EventStatus) protoNumber() uint64 {
+	switch code {
+	case EventStatusPreparation:
+		return 1
+	case EventStatusInProgress:
+		return 2
+	case EventStatusNotDone:
+		return 3
+	case EventStatusOnHold:
+		return 4
+	case EventStatusStopped:
+		return 5
+	case EventStatusCompleted:
+		return 6
+	case EventStatusEnteredInError:
+		return 7
+	case EventStatusUnknown:
+		return 8
+	}
+	return 0
+}
+func (code *EventStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EventStatusPreparation
+	case 2:
+		*code = EventStatusInProgress
+	case 3:
+		*code = EventStatusNotDone
+	case 4:
+		*code = EventStatusOnHold
+	case 5:
+		*code = EventStatusStopped
+	case 6:
+		*code = EventStatusCompleted
+	case 7:
+		*code = EventStatusEnteredInError
+	case 8:
+		*code = EventStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
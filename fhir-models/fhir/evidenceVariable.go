@@ -14,85 +14,3511 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // EvidenceVariable is documented here http://hl7.org/fhir/StructureDefinition/EvidenceVariable
 type EvidenceVariable struct {
-	Id                *string                          `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                          `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string                          `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                          `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                          `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                          `bson:"title,omitempty" json:"title,omitempty"`
-	ShortTitle        *string                          `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
-	Subtitle          *string                          `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
-	Status            PublicationStatus                `bson:"status" json:"status"`
-	Date              *string                          `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                          `bson:"description,omitempty" json:"description,omitempty"`
-	Note              []Annotation                     `bson:"note,omitempty" json:"note,omitempty"`
-	UseContext        []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *string                          `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *string                          `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period                          `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic             []CodeableConcept                `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author            []ContactDetail                  `bson:"author,omitempty" json:"author,omitempty"`
-	Editor            []ContactDetail                  `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer          []ContactDetail                  `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser          []ContactDetail                  `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact   []RelatedArtifact                `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	Type              *EvidenceVariableType            `bson:"type,omitempty" json:"type,omitempty"`
-	Characteristic    []EvidenceVariableCharacteristic `bson:"characteristic" json:"characteristic"`
+	Id                    *string                          `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                         `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                  *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                         `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage                `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                   *string                          `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement            *Element                         `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string                          `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element                         `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                  *string                          `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                 *string                          `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                         `bson:"_title,omitempty" json:"_title,omitempty"`
+	ShortTitle            *string                          `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
+	ShortTitleElement     *Element                         `bson:"_shortTitle,omitempty" json:"_shortTitle,omitempty"`
+	Subtitle              *string                          `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement       *Element                         `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
+	Status                PublicationStatus                `bson:"status" json:"status"`
+	StatusElement         *Element                         `bson:"_status,omitempty" json:"_status,omitempty"`
+	Date                  *string                          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                         `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element                         `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string                          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                         `bson:"_description,omitempty" json:"_description,omitempty"`
+	Note                  []Annotation                     `bson:"note,omitempty" json:"note,omitempty"`
+	UseContext            []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright             *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element                         `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *string                          `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element                         `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *string                          `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element                         `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period                          `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                 []CodeableConcept                `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                []ContactDetail                  `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                []ContactDetail                  `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer              []ContactDetail                  `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser              []ContactDetail                  `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact       []RelatedArtifact                `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	Type                  *EvidenceVariableType            `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement           *Element                         `bson:"_type,omitempty" json:"_type,omitempty"`
+	Characteristic        []EvidenceVariableCharacteristic `bson:"characteristic" json:"characteristic"`
 }
 type EvidenceVariableCharacteristic struct {
-	Id                           *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                    []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension            []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description                  *string           `bson:"description,omitempty" json:"description,omitempty"`
-	DefinitionReference          Reference         `bson:"definitionReference" json:"definitionReference"`
-	DefinitionCanonical          string            `bson:"definitionCanonical" json:"definitionCanonical"`
-	DefinitionCodeableConcept    CodeableConcept   `bson:"definitionCodeableConcept" json:"definitionCodeableConcept"`
-	DefinitionExpression         Expression        `bson:"definitionExpression" json:"definitionExpression"`
-	DefinitionDataRequirement    DataRequirement   `bson:"definitionDataRequirement" json:"definitionDataRequirement"`
-	DefinitionTriggerDefinition  TriggerDefinition `bson:"definitionTriggerDefinition" json:"definitionTriggerDefinition"`
-	UsageContext                 []UsageContext    `bson:"usageContext,omitempty" json:"usageContext,omitempty"`
-	Exclude                      *bool             `bson:"exclude,omitempty" json:"exclude,omitempty"`
-	ParticipantEffectiveDateTime *string           `bson:"participantEffectiveDateTime,omitempty" json:"participantEffectiveDateTime,omitempty"`
-	ParticipantEffectivePeriod   *Period           `bson:"participantEffectivePeriod,omitempty" json:"participantEffectivePeriod,omitempty"`
-	ParticipantEffectiveDuration *Duration         `bson:"participantEffectiveDuration,omitempty" json:"participantEffectiveDuration,omitempty"`
-	ParticipantEffectiveTiming   *Timing           `bson:"participantEffectiveTiming,omitempty" json:"participantEffectiveTiming,omitempty"`
-	TimeFromStart                *Duration         `bson:"timeFromStart,omitempty" json:"timeFromStart,omitempty"`
-	GroupMeasure                 *GroupMeasure     `bson:"groupMeasure,omitempty" json:"groupMeasure,omitempty"`
-}
-type OtherEvidenceVariable EvidenceVariable
+	Id                                  *string            `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                           []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                   []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description                         *string            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement                  *Element           `bson:"_description,omitempty" json:"_description,omitempty"`
+	DefinitionReference                 *Reference         `bson:"definitionReference,omitempty" json:"definitionReference,omitempty"`
+	DefinitionCanonical                 *string            `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement          *Element           `bson:"_definitionCanonical,omitempty" json:"_definitionCanonical,omitempty"`
+	DefinitionCodeableConcept           *CodeableConcept   `bson:"definitionCodeableConcept,omitempty" json:"definitionCodeableConcept,omitempty"`
+	DefinitionExpression                *Expression        `bson:"definitionExpression,omitempty" json:"definitionExpression,omitempty"`
+	DefinitionDataRequirement           *DataRequirement   `bson:"definitionDataRequirement,omitempty" json:"definitionDataRequirement,omitempty"`
+	DefinitionTriggerDefinition         *TriggerDefinition `bson:"definitionTriggerDefinition,omitempty" json:"definitionTriggerDefinition,omitempty"`
+	UsageContext                        []UsageContext     `bson:"usageContext,omitempty" json:"usageContext,omitempty"`
+	Exclude                             *bool              `bson:"exclude,omitempty" json:"exclude,omitempty"`
+	ExcludeElement                      *Element           `bson:"_exclude,omitempty" json:"_exclude,omitempty"`
+	ParticipantEffectiveDateTime        *string            `bson:"participantEffectiveDateTime,omitempty" json:"participantEffectiveDateTime,omitempty"`
+	ParticipantEffectiveDateTimeElement *Element           `bson:"_participantEffectiveDateTime,omitempty" json:"_participantEffectiveDateTime,omitempty"`
+	ParticipantEffectivePeriod          *Period            `bson:"participantEffectivePeriod,omitempty" json:"participantEffectivePeriod,omitempty"`
+	ParticipantEffectiveDuration        *Duration          `bson:"participantEffectiveDuration,omitempty" json:"participantEffectiveDuration,omitempty"`
+	ParticipantEffectiveTiming          *Timing            `bson:"participantEffectiveTiming,omitempty" json:"participantEffectiveTiming,omitempty"`
+	TimeFromStart                       *Duration          `bson:"timeFromStart,omitempty" json:"timeFromStart,omitempty"`
+	GroupMeasure                        *GroupMeasure      `bson:"groupMeasure,omitempty" json:"groupMeasure,omitempty"`
+	GroupMeasureElement                 *Element           `bson:"_groupMeasure,omitempty" json:"_groupMeasure,omitempty"`
+}
+
+// EvidenceVariableCharacteristicDefinition is implemented by the types allowed for EvidenceVariable.characteristic.definition[x]
+type EvidenceVariableCharacteristicDefinition interface {
+	isEvidenceVariableCharacteristicDefinition()
+}
+
+func (Reference) isEvidenceVariableCharacteristicDefinition()         {}
+func (Canonical) isEvidenceVariableCharacteristicDefinition()         {}
+func (CodeableConcept) isEvidenceVariableCharacteristicDefinition()   {}
+func (Expression) isEvidenceVariableCharacteristicDefinition()        {}
+func (DataRequirement) isEvidenceVariableCharacteristicDefinition()   {}
+func (TriggerDefinition) isEvidenceVariableCharacteristicDefinition() {}
+
+// Definition returns the value of EvidenceVariable.characteristic.definition[x] together with its FHIR type code or nil if no value is set
+func (r EvidenceVariableCharacteristic) Definition() (EvidenceVariableCharacteristicDefinition, string) {
+	if r.DefinitionReference != nil {
+		return *r.DefinitionReference, "Reference"
+	}
+	if r.DefinitionCanonical != nil {
+		return Canonical(*r.DefinitionCanonical), "canonical"
+	}
+	if r.DefinitionCodeableConcept != nil {
+		return *r.DefinitionCodeableConcept, "CodeableConcept"
+	}
+	if r.DefinitionExpression != nil {
+		return *r.DefinitionExpression, "Expression"
+	}
+	if r.DefinitionDataRequirement != nil {
+		return *r.DefinitionDataRequirement, "DataRequirement"
+	}
+	if r.DefinitionTriggerDefinition != nil {
+		return *r.DefinitionTriggerDefinition, "TriggerDefinition"
+	}
+	return nil, ""
+}
+
+// SetDefinition sets the value of EvidenceVariable.characteristic.definition[x], given as value or pointer, and clears all other types
+func (r *EvidenceVariableCharacteristic) SetDefinition(value EvidenceVariableCharacteristicDefinition) {
+	r.DefinitionReference = nil
+	r.DefinitionCanonical = nil
+	r.DefinitionCodeableConcept = nil
+	r.DefinitionExpression = nil
+	r.DefinitionDataRequirement = nil
+	r.DefinitionTriggerDefinition = nil
+	switch v := value.(type) {
+	case Reference:
+		r.DefinitionReference = &v
+	case *Reference:
+		r.DefinitionReference = v
+	case Canonical:
+		primitive := string(v)
+		r.DefinitionCanonical = &primitive
+	case *Canonical:
+		r.DefinitionCanonical = (*string)(v)
+	case CodeableConcept:
+		r.DefinitionCodeableConcept = &v
+	case *CodeableConcept:
+		r.DefinitionCodeableConcept = v
+	case Expression:
+		r.DefinitionExpression = &v
+	case *Expression:
+		r.DefinitionExpression = v
+	case DataRequirement:
+		r.DefinitionDataRequirement = &v
+	case *DataRequirement:
+		r.DefinitionDataRequirement = v
+	case TriggerDefinition:
+		r.DefinitionTriggerDefinition = &v
+	case *TriggerDefinition:
+		r.DefinitionTriggerDefinition = v
+	}
+}
+
+// EvidenceVariableCharacteristicParticipantEffective is implemented by the types allowed for EvidenceVariable.characteristic.participantEffective[x]
+type EvidenceVariableCharacteristicParticipantEffective interface {
+	isEvidenceVariableCharacteristicParticipantEffective()
+}
+
+func (DateTime) isEvidenceVariableCharacteristicParticipantEffective() {}
+func (Period) isEvidenceVariableCharacteristicParticipantEffective()   {}
+func (Duration) isEvidenceVariableCharacteristicParticipantEffective() {}
+func (Timing) isEvidenceVariableCharacteristicParticipantEffective()   {}
+
+// ParticipantEffective returns the value of EvidenceVariable.characteristic.participantEffective[x] together with its FHIR type code or nil if no value is set
+func (r EvidenceVariableCharacteristic) ParticipantEffective() (EvidenceVariableCharacteristicParticipantEffective, string) {
+	if r.ParticipantEffectiveDateTime != nil {
+		return DateTime(*r.ParticipantEffectiveDateTime), "dateTime"
+	}
+	if r.ParticipantEffectivePeriod != nil {
+		return *r.ParticipantEffectivePeriod, "Period"
+	}
+	if r.ParticipantEffectiveDuration != nil {
+		return *r.ParticipantEffectiveDuration, "Duration"
+	}
+	if r.ParticipantEffectiveTiming != nil {
+		return *r.ParticipantEffectiveTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetParticipantEffective sets the value of EvidenceVariable.characteristic.participantEffective[x], given as value or pointer, and clears all other types
+func (r *EvidenceVariableCharacteristic) SetParticipantEffective(value EvidenceVariableCharacteristicParticipantEffective) {
+	r.ParticipantEffectiveDateTime = nil
+	r.ParticipantEffectivePeriod = nil
+	r.ParticipantEffectiveDuration = nil
+	r.ParticipantEffectiveTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.ParticipantEffectiveDateTime = &primitive
+	case *DateTime:
+		r.ParticipantEffectiveDateTime = (*string)(v)
+	case Period:
+		r.ParticipantEffectivePeriod = &v
+	case *Period:
+		r.ParticipantEffectivePeriod = v
+	case Duration:
+		r.ParticipantEffectiveDuration = &v
+	case *Duration:
+		r.ParticipantEffectiveDuration = v
+	case Timing:
+		r.ParticipantEffectiveTiming = &v
+	case *Timing:
+		r.ParticipantEffectiveTiming = v
+	}
+}
 
 // MarshalJSON marshals the given EvidenceVariable as JSON into a byte slice
 func (r EvidenceVariable) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherEvidenceVariable
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherEvidenceVariable: OtherEvidenceVariable(r),
-		ResourceType:          "EvidenceVariable",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EvidenceVariable as JSON object with the members in the order of the fields
+func (r EvidenceVariable) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"EvidenceVariable\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.ShortTitle != nil {
+		e.key("shortTitle")
+		e.string(*r.ShortTitle)
+	}
+	if r.ShortTitleElement != nil {
+		e.key("_shortTitle")
+		r.ShortTitleElement.appendJSON(e)
+	}
+	if r.Subtitle != nil {
+		e.key("subtitle")
+		e.string(*r.Subtitle)
+	}
+	if r.SubtitleElement != nil {
+		e.key("_subtitle")
+		r.SubtitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.ApprovalDate != nil {
+		e.key("approvalDate")
+		e.string(*r.ApprovalDate)
+	}
+	if r.ApprovalDateElement != nil {
+		e.key("_approvalDate")
+		r.ApprovalDateElement.appendJSON(e)
+	}
+	if r.LastReviewDate != nil {
+		e.key("lastReviewDate")
+		e.string(*r.LastReviewDate)
+	}
+	if r.LastReviewDateElement != nil {
+		e.key("_lastReviewDate")
+		r.LastReviewDateElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if len(r.Topic) > 0 {
+		e.key("topic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Topic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Editor) > 0 {
+		e.key("editor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Editor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Reviewer) > 0 {
+		e.key("reviewer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reviewer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endorser) > 0 {
+		e.key("endorser")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endorser {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedArtifact) > 0 {
+		e.key("relatedArtifact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedArtifact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(r.Type.Code())
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("characteristic")
+	if r.Characteristic == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Characteristic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EvidenceVariable from JSON. Unknown members are ignored.
+func (r *EvidenceVariable) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EvidenceVariable
+func (r *EvidenceVariable) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "shortTitle":
+			if v, ok := d.string(); ok {
+				r.ShortTitle = &v
+			} else {
+				r.ShortTitle = nil
+			}
+		case "_shortTitle":
+			r.ShortTitleElement = d.element()
+		case "subtitle":
+			if v, ok := d.string(); ok {
+				r.Subtitle = &v
+			} else {
+				r.Subtitle = nil
+			}
+		case "_subtitle":
+			r.SubtitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "approvalDate":
+			if v, ok := d.string(); ok {
+				r.ApprovalDate = &v
+			} else {
+				r.ApprovalDate = nil
+			}
+		case "_approvalDate":
+			r.ApprovalDateElement = d.element()
+		case "lastReviewDate":
+			if v, ok := d.string(); ok {
+				r.LastReviewDate = &v
+			} else {
+				r.LastReviewDate = nil
+			}
+		case "_lastReviewDate":
+			r.LastReviewDateElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "topic":
+			a := d.array()
+			r.Topic = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Topic = append(r.Topic, v)
+			}
+			if r.Topic == nil && !a.isNull {
+				r.Topic = []CodeableConcept{}
+			}
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []ContactDetail{}
+			}
+		case "editor":
+			a := d.array()
+			r.Editor = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Editor = append(r.Editor, v)
+			}
+			if r.Editor == nil && !a.isNull {
+				r.Editor = []ContactDetail{}
+			}
+		case "reviewer":
+			a := d.array()
+			r.Reviewer = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Reviewer = append(r.Reviewer, v)
+			}
+			if r.Reviewer == nil && !a.isNull {
+				r.Reviewer = []ContactDetail{}
+			}
+		case "endorser":
+			a := d.array()
+			r.Endorser = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Endorser = append(r.Endorser, v)
+			}
+			if r.Endorser == nil && !a.isNull {
+				r.Endorser = []ContactDetail{}
+			}
+		case "relatedArtifact":
+			a := d.array()
+			r.RelatedArtifact = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			}
+			if r.RelatedArtifact == nil && !a.isNull {
+				r.RelatedArtifact = []RelatedArtifact{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v EvidenceVariableType
+				d.unmarshal(&v)
+				r.Type = &v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "characteristic":
+			a := d.array()
+			r.Characteristic = nil
+			for a.next() {
+				var v EvidenceVariableCharacteristic
+				v.decodeJSON(d)
+				r.Characteristic = append(r.Characteristic, v)
+			}
+			if r.Characteristic == nil && !a.isNull {
+				r.Characteristic = []EvidenceVariableCharacteristic{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EvidenceVariable which shares no memory with the original
+func (r EvidenceVariable) DeepCopy() EvidenceVariable {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.ShortTitle != nil {
+		v := *r.ShortTitle
+		out.ShortTitle = &v
+	}
+	if r.ShortTitleElement != nil {
+		v := r.ShortTitleElement.DeepCopy()
+		out.ShortTitleElement = &v
+	}
+	if r.Subtitle != nil {
+		v := *r.Subtitle
+		out.Subtitle = &v
+	}
+	if r.SubtitleElement != nil {
+		v := r.SubtitleElement.DeepCopy()
+		out.SubtitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.ApprovalDate != nil {
+		v := *r.ApprovalDate
+		out.ApprovalDate = &v
+	}
+	if r.ApprovalDateElement != nil {
+		v := r.ApprovalDateElement.DeepCopy()
+		out.ApprovalDateElement = &v
+	}
+	if r.LastReviewDate != nil {
+		v := *r.LastReviewDate
+		out.LastReviewDate = &v
+	}
+	if r.LastReviewDateElement != nil {
+		v := r.LastReviewDateElement.DeepCopy()
+		out.LastReviewDateElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Topic != nil {
+		out.Topic = make([]CodeableConcept, len(r.Topic))
+		for i := range r.Topic {
+			out.Topic[i] = r.Topic[i].DeepCopy()
+		}
+	}
+	if r.Author != nil {
+		out.Author = make([]ContactDetail, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Editor != nil {
+		out.Editor = make([]ContactDetail, len(r.Editor))
+		for i := range r.Editor {
+			out.Editor[i] = r.Editor[i].DeepCopy()
+		}
+	}
+	if r.Reviewer != nil {
+		out.Reviewer = make([]ContactDetail, len(r.Reviewer))
+		for i := range r.Reviewer {
+			out.Reviewer[i] = r.Reviewer[i].DeepCopy()
+		}
+	}
+	if r.Endorser != nil {
+		out.Endorser = make([]ContactDetail, len(r.Endorser))
+		for i := range r.Endorser {
+			out.Endorser[i] = r.Endorser[i].DeepCopy()
+		}
+	}
+	if r.RelatedArtifact != nil {
+		out.RelatedArtifact = make([]RelatedArtifact, len(r.RelatedArtifact))
+		for i := range r.RelatedArtifact {
+			out.RelatedArtifact[i] = r.RelatedArtifact[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Characteristic != nil {
+		out.Characteristic = make([]EvidenceVariableCharacteristic, len(r.Characteristic))
+		for i := range r.Characteristic {
+			out.Characteristic[i] = r.Characteristic[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EvidenceVariable) Equal(other EvidenceVariable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.ShortTitle == nil) != (other.ShortTitle == nil) || r.ShortTitle != nil && *r.ShortTitle != *other.ShortTitle {
+		return false
+	}
+	if (r.ShortTitleElement == nil) != (other.ShortTitleElement == nil) || r.ShortTitleElement != nil && !r.ShortTitleElement.Equal(*other.ShortTitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && *r.Subtitle != *other.Subtitle {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.Equal(*other.SubtitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && *r.ApprovalDate != *other.ApprovalDate {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.Equal(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && *r.LastReviewDate != *other.LastReviewDate {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.Equal(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if len(r.Topic) != len(other.Topic) {
+		return false
+	}
+	for i := range r.Topic {
+		if !r.Topic[i].Equal(other.Topic[i]) {
+			return false
+		}
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if len(r.Editor) != len(other.Editor) {
+		return false
+	}
+	for i := range r.Editor {
+		if !r.Editor[i].Equal(other.Editor[i]) {
+			return false
+		}
+	}
+	if len(r.Reviewer) != len(other.Reviewer) {
+		return false
+	}
+	for i := range r.Reviewer {
+		if !r.Reviewer[i].Equal(other.Reviewer[i]) {
+			return false
+		}
+	}
+	if len(r.Endorser) != len(other.Endorser) {
+		return false
+	}
+	for i := range r.Endorser {
+		if !r.Endorser[i].Equal(other.Endorser[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedArtifact) != len(other.RelatedArtifact) {
+		return false
+	}
+	for i := range r.RelatedArtifact {
+		if !r.RelatedArtifact[i].Equal(other.RelatedArtifact[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if len(r.Characteristic) != len(other.Characteristic) {
+		return false
+	}
+	for i := range r.Characteristic {
+		if !r.Characteristic[i].Equal(other.Characteristic[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EvidenceVariable) EqualsDeep(other EvidenceVariable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.ShortTitle == nil) != (other.ShortTitle == nil) || r.ShortTitle != nil && !equivalentString(*r.ShortTitle, *other.ShortTitle) {
+		return false
+	}
+	if (r.ShortTitleElement == nil) != (other.ShortTitleElement == nil) || r.ShortTitleElement != nil && !r.ShortTitleElement.EqualsDeep(*other.ShortTitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.EqualsDeep(*other.SubtitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.EqualsDeep(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.EqualsDeep(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Topic), len(other.Topic), func(i, j int) bool {
+		return r.Topic[i].EqualsDeep(other.Topic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Editor), len(other.Editor), func(i, j int) bool {
+		return r.Editor[i].EqualsDeep(other.Editor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reviewer), len(other.Reviewer), func(i, j int) bool {
+		return r.Reviewer[i].EqualsDeep(other.Reviewer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endorser), len(other.Endorser), func(i, j int) bool {
+		return r.Endorser[i].EqualsDeep(other.Endorser[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedArtifact), len(other.RelatedArtifact), func(i, j int) bool {
+		return r.RelatedArtifact[i].EqualsDeep(other.RelatedArtifact[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Characteristic), len(other.Characteristic), func(i, j int) bool {
+		return r.Characteristic[i].EqualsDeep(other.Characteristic[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EvidenceVariable) EqualsShallow(other EvidenceVariable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.ShortTitle == nil) != (other.ShortTitle == nil) || r.ShortTitle != nil && !equivalentString(*r.ShortTitle, *other.ShortTitle) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EvidenceVariable as FHIR XML
+func (r EvidenceVariable) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "EvidenceVariable",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "shortTitle", r.ShortTitle, r.ShortTitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "subtitle", r.Subtitle, r.SubtitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "approvalDate", r.ApprovalDate, r.ApprovalDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastReviewDate", r.LastReviewDate, r.LastReviewDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Topic {
+		if err := encodeXMLElement(e, "topic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Editor {
+		if err := encodeXMLElement(e, "editor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reviewer {
+		if err := encodeXMLElement(e, "reviewer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endorser {
+		if err := encodeXMLElement(e, "endorser", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedArtifact {
+		if err := encodeXMLElement(e, "relatedArtifact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	for _, v := range r.Characteristic {
+		if err := encodeXMLElement(e, "characteristic", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EvidenceVariable from FHIR XML
+func (r *EvidenceVariable) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "shortTitle":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ShortTitle = &v
+				}
+				r.ShortTitleElement = element
+			case "subtitle":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subtitle = &v
+				}
+				r.SubtitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "approvalDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ApprovalDate = &v
+				}
+				r.ApprovalDateElement = element
+			case "lastReviewDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastReviewDate = &v
+				}
+				r.LastReviewDateElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "topic":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Topic = append(r.Topic, v)
+			case "author":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "editor":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Editor = append(r.Editor, v)
+			case "reviewer":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reviewer = append(r.Reviewer, v)
+			case "endorser":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endorser = append(r.Endorser, v)
+			case "relatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			case "type":
+				var v EvidenceVariableType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "characteristic":
+				var v EvidenceVariableCharacteristic
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Characteristic = append(r.Characteristic, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EvidenceVariable as properties to the RDF node n
+func (r EvidenceVariable) turtle(n *turtleNode) {
+	n.resource("EvidenceVariable", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("EvidenceVariable.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("EvidenceVariable.identifier", i, v)
+	}
+	n.primitive("EvidenceVariable.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("EvidenceVariable.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("EvidenceVariable.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("EvidenceVariable.shortTitle", -1, r.ShortTitle, r.ShortTitleElement, "string")
+	n.primitive("EvidenceVariable.subtitle", -1, r.Subtitle, r.SubtitleElement, "string")
+	n.primitive("EvidenceVariable.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("EvidenceVariable.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("EvidenceVariable.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("EvidenceVariable.contact", i, v)
+	}
+	n.primitive("EvidenceVariable.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Note {
+		n.element("EvidenceVariable.note", i, v)
+	}
+	for i, v := range r.UseContext {
+		n.element("EvidenceVariable.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("EvidenceVariable.jurisdiction", i, v)
+	}
+	n.primitive("EvidenceVariable.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("EvidenceVariable.approvalDate", -1, r.ApprovalDate, r.ApprovalDateElement, "date")
+	n.primitive("EvidenceVariable.lastReviewDate", -1, r.LastReviewDate, r.LastReviewDateElement, "date")
+	n.element("EvidenceVariable.effectivePeriod", -1, r.EffectivePeriod)
+	for i, v := range r.Topic {
+		n.element("EvidenceVariable.topic", i, v)
+	}
+	for i, v := range r.Author {
+		n.element("EvidenceVariable.author", i, v)
+	}
+	for i, v := range r.Editor {
+		n.element("EvidenceVariable.editor", i, v)
+	}
+	for i, v := range r.Reviewer {
+		n.element("EvidenceVariable.reviewer", i, v)
+	}
+	for i, v := range r.Endorser {
+		n.element("EvidenceVariable.endorser", i, v)
+	}
+	for i, v := range r.RelatedArtifact {
+		n.element("EvidenceVariable.relatedArtifact", i, v)
+	}
+	n.primitive("EvidenceVariable.type", -1, r.Type, r.TypeElement, "code")
+	for i, v := range r.Characteristic {
+		n.element("EvidenceVariable.characteristic", i, v)
+	}
+}
+
+// MarshalBSON marshals the given EvidenceVariable as BSON document with the structure of its FHIR JSON
+func (r EvidenceVariable) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EvidenceVariable from a BSON document with the structure of its FHIR JSON
+func (r *EvidenceVariable) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EvidenceVariable as protobuf message EvidenceVariable of fhir.proto
+func (r EvidenceVariable) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EvidenceVariable to the encoded message
+func (r EvidenceVariable) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	if r.ShortTitle != nil {
+		e.string(14, *r.ShortTitle)
+	}
+	if r.Subtitle != nil {
+		e.string(15, *r.Subtitle)
+	}
+	e.varint(16, r.Status.protoNumber())
+	if r.Date != nil {
+		e.string(17, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(18, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(19, v)
+	}
+	if r.Description != nil {
+		e.string(20, *r.Description)
+	}
+	for _, v := range r.Note {
+		e.message(21, v)
+	}
+	for _, v := range r.UseContext {
+		e.message(22, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(23, v)
+	}
+	if r.Copyright != nil {
+		e.string(24, *r.Copyright)
+	}
+	if r.ApprovalDate != nil {
+		e.string(25, *r.ApprovalDate)
+	}
+	if r.LastReviewDate != nil {
+		e.string(26, *r.LastReviewDate)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(27, *r.EffectivePeriod)
+	}
+	for _, v := range r.Topic {
+		e.message(28, v)
+	}
+	for _, v := range r.Author {
+		e.message(29, v)
+	}
+	for _, v := range r.Editor {
+		e.message(30, v)
+	}
+	for _, v := range r.Reviewer {
+		e.message(31, v)
+	}
+	for _, v := range r.Endorser {
+		e.message(32, v)
+	}
+	for _, v := range r.RelatedArtifact {
+		e.message(33, v)
+	}
+	if r.Type != nil {
+		e.varint(34, r.Type.protoNumber())
+	}
+	for _, v := range r.Characteristic {
+		e.message(35, v)
+	}
+	if r.IdElement != nil {
+		e.message(36, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(37, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(38, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(39, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(40, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(41, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(42, *r.TitleElement)
+	}
+	if r.ShortTitleElement != nil {
+		e.message(43, *r.ShortTitleElement)
+	}
+	if r.SubtitleElement != nil {
+		e.message(44, *r.SubtitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(45, *r.StatusElement)
+	}
+	if r.DateElement != nil {
+		e.message(46, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(47, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(48, *r.DescriptionElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(49, *r.CopyrightElement)
+	}
+	if r.ApprovalDateElement != nil {
+		e.message(50, *r.ApprovalDateElement)
+	}
+	if r.LastReviewDateElement != nil {
+		e.message(51, *r.LastReviewDateElement)
+	}
+	if r.TypeElement != nil {
+		e.message(52, *r.TypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EvidenceVariable from protobuf message EvidenceVariable of fhir.proto
+func (r *EvidenceVariable) UnmarshalProto(b []byte) error {
+	*r = EvidenceVariable{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			v := d.string()
+			r.ShortTitle = &v
+		case 15:
+			v := d.string()
+			r.Subtitle = &v
+		case 16:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 17:
+			v := d.string()
+			r.Date = &v
+		case 18:
+			v := d.string()
+			r.Publisher = &v
+		case 19:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 20:
+			v := d.string()
+			r.Description = &v
+		case 21:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 22:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 24:
+			v := d.string()
+			r.Copyright = &v
+		case 25:
+			v := d.string()
+			r.ApprovalDate = &v
+		case 26:
+			v := d.string()
+			r.LastReviewDate = &v
+		case 27:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 28:
+			var v CodeableConcept
+			d.message(&v)
+			r.Topic = append(r.Topic, v)
+		case 29:
+			var v ContactDetail
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 30:
+			var v ContactDetail
+			d.message(&v)
+			r.Editor = append(r.Editor, v)
+		case 31:
+			var v ContactDetail
+			d.message(&v)
+			r.Reviewer = append(r.Reviewer, v)
+		case 32:
+			var v ContactDetail
+			d.message(&v)
+			r.Endorser = append(r.Endorser, v)
+		case 33:
+			var v RelatedArtifact
+			d.message(&v)
+			r.RelatedArtifact = append(r.RelatedArtifact, v)
+		case 34:
+			var v EvidenceVariableType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = &v
+		case 35:
+			var v EvidenceVariableCharacteristic
+			d.message(&v)
+			r.Characteristic = append(r.Characteristic, v)
+		case 36:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.ShortTitleElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.SubtitleElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.ApprovalDateElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.LastReviewDateElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given EvidenceVariableCharacteristic as JSON into a byte slice
+func (r EvidenceVariableCharacteristic) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the EvidenceVariableCharacteristic as JSON object with the members in the order of the fields
+func (r EvidenceVariableCharacteristic) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.DefinitionReference != nil {
+		e.key("definitionReference")
+		r.DefinitionReference.appendJSON(e)
+	}
+	if r.DefinitionCanonical != nil {
+		e.key("definitionCanonical")
+		e.string(*r.DefinitionCanonical)
+	}
+	if r.DefinitionCanonicalElement != nil {
+		e.key("_definitionCanonical")
+		r.DefinitionCanonicalElement.appendJSON(e)
+	}
+	if r.DefinitionCodeableConcept != nil {
+		e.key("definitionCodeableConcept")
+		r.DefinitionCodeableConcept.appendJSON(e)
+	}
+	if r.DefinitionExpression != nil {
+		e.key("definitionExpression")
+		r.DefinitionExpression.appendJSON(e)
+	}
+	if r.DefinitionDataRequirement != nil {
+		e.key("definitionDataRequirement")
+		r.DefinitionDataRequirement.appendJSON(e)
+	}
+	if r.DefinitionTriggerDefinition != nil {
+		e.key("definitionTriggerDefinition")
+		r.DefinitionTriggerDefinition.appendJSON(e)
+	}
+	if len(r.UsageContext) > 0 {
+		e.key("usageContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UsageContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Exclude != nil {
+		e.key("exclude")
+		e.bool(*r.Exclude)
+	}
+	if r.ExcludeElement != nil {
+		e.key("_exclude")
+		r.ExcludeElement.appendJSON(e)
+	}
+	if r.ParticipantEffectiveDateTime != nil {
+		e.key("participantEffectiveDateTime")
+		e.string(*r.ParticipantEffectiveDateTime)
+	}
+	if r.ParticipantEffectiveDateTimeElement != nil {
+		e.key("_participantEffectiveDateTime")
+		r.ParticipantEffectiveDateTimeElement.appendJSON(e)
+	}
+	if r.ParticipantEffectivePeriod != nil {
+		e.key("participantEffectivePeriod")
+		r.ParticipantEffectivePeriod.appendJSON(e)
+	}
+	if r.ParticipantEffectiveDuration != nil {
+		e.key("participantEffectiveDuration")
+		r.ParticipantEffectiveDuration.appendJSON(e)
+	}
+	if r.ParticipantEffectiveTiming != nil {
+		e.key("participantEffectiveTiming")
+		r.ParticipantEffectiveTiming.appendJSON(e)
+	}
+	if r.TimeFromStart != nil {
+		e.key("timeFromStart")
+		r.TimeFromStart.appendJSON(e)
+	}
+	if r.GroupMeasure != nil {
+		e.key("groupMeasure")
+		e.string(r.GroupMeasure.Code())
+	}
+	if r.GroupMeasureElement != nil {
+		e.key("_groupMeasure")
+		r.GroupMeasureElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given EvidenceVariableCharacteristic from JSON. Unknown members are ignored.
+func (r *EvidenceVariableCharacteristic) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the EvidenceVariableCharacteristic
+func (r *EvidenceVariableCharacteristic) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "definitionReference":
+			if d.null() {
+				r.DefinitionReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.DefinitionReference = &v
+			}
+		case "definitionCanonical":
+			if v, ok := d.string(); ok {
+				r.DefinitionCanonical = &v
+			} else {
+				r.DefinitionCanonical = nil
+			}
+		case "_definitionCanonical":
+			r.DefinitionCanonicalElement = d.element()
+		case "definitionCodeableConcept":
+			if d.null() {
+				r.DefinitionCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DefinitionCodeableConcept = &v
+			}
+		case "definitionExpression":
+			if d.null() {
+				r.DefinitionExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.DefinitionExpression = &v
+			}
+		case "definitionDataRequirement":
+			if d.null() {
+				r.DefinitionDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.DefinitionDataRequirement = &v
+			}
+		case "definitionTriggerDefinition":
+			if d.null() {
+				r.DefinitionTriggerDefinition = nil
+			} else {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.DefinitionTriggerDefinition = &v
+			}
+		case "usageContext":
+			a := d.array()
+			r.UsageContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UsageContext = append(r.UsageContext, v)
+			}
+			if r.UsageContext == nil && !a.isNull {
+				r.UsageContext = []UsageContext{}
+			}
+		case "exclude":
+			if v, ok := d.bool(); ok {
+				r.Exclude = &v
+			} else {
+				r.Exclude = nil
+			}
+		case "_exclude":
+			r.ExcludeElement = d.element()
+		case "participantEffectiveDateTime":
+			if v, ok := d.string(); ok {
+				r.ParticipantEffectiveDateTime = &v
+			} else {
+				r.ParticipantEffectiveDateTime = nil
+			}
+		case "_participantEffectiveDateTime":
+			r.ParticipantEffectiveDateTimeElement = d.element()
+		case "participantEffectivePeriod":
+			if d.null() {
+				r.ParticipantEffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ParticipantEffectivePeriod = &v
+			}
+		case "participantEffectiveDuration":
+			if d.null() {
+				r.ParticipantEffectiveDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.ParticipantEffectiveDuration = &v
+			}
+		case "participantEffectiveTiming":
+			if d.null() {
+				r.ParticipantEffectiveTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.ParticipantEffectiveTiming = &v
+			}
+		case "timeFromStart":
+			if d.null() {
+				r.TimeFromStart = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.TimeFromStart = &v
+			}
+		case "groupMeasure":
+			if d.null() {
+				r.GroupMeasure = nil
+			} else {
+				var v GroupMeasure
+				d.unmarshal(&v)
+				r.GroupMeasure = &v
+			}
+		case "_groupMeasure":
+			r.GroupMeasureElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the EvidenceVariableCharacteristic which shares no memory with the original
+func (r EvidenceVariableCharacteristic) DeepCopy() EvidenceVariableCharacteristic {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.DefinitionReference != nil {
+		v := r.DefinitionReference.DeepCopy()
+		out.DefinitionReference = &v
+	}
+	if r.DefinitionCanonical != nil {
+		v := *r.DefinitionCanonical
+		out.DefinitionCanonical = &v
+	}
+	if r.DefinitionCanonicalElement != nil {
+		v := r.DefinitionCanonicalElement.DeepCopy()
+		out.DefinitionCanonicalElement = &v
+	}
+	if r.DefinitionCodeableConcept != nil {
+		v := r.DefinitionCodeableConcept.DeepCopy()
+		out.DefinitionCodeableConcept = &v
+	}
+	if r.DefinitionExpression != nil {
+		v := r.DefinitionExpression.DeepCopy()
+		out.DefinitionExpression = &v
+	}
+	if r.DefinitionDataRequirement != nil {
+		v := r.DefinitionDataRequirement.DeepCopy()
+		out.DefinitionDataRequirement = &v
+	}
+	if r.DefinitionTriggerDefinition != nil {
+		v := r.DefinitionTriggerDefinition.DeepCopy()
+		out.DefinitionTriggerDefinition = &v
+	}
+	if r.UsageContext != nil {
+		out.UsageContext = make([]UsageContext, len(r.UsageContext))
+		for i := range r.UsageContext {
+			out.UsageContext[i] = r.UsageContext[i].DeepCopy()
+		}
+	}
+	if r.Exclude != nil {
+		v := *r.Exclude
+		out.Exclude = &v
+	}
+	if r.ExcludeElement != nil {
+		v := r.ExcludeElement.DeepCopy()
+		out.ExcludeElement = &v
+	}
+	if r.ParticipantEffectiveDateTime != nil {
+		v := *r.ParticipantEffectiveDateTime
+		out.ParticipantEffectiveDateTime = &v
+	}
+	if r.ParticipantEffectiveDateTimeElement != nil {
+		v := r.ParticipantEffectiveDateTimeElement.DeepCopy()
+		out.ParticipantEffectiveDateTimeElement = &v
+	}
+	if r.ParticipantEffectivePeriod != nil {
+		v := r.ParticipantEffectivePeriod.DeepCopy()
+		out.ParticipantEffectivePeriod = &v
+	}
+	if r.ParticipantEffectiveDuration != nil {
+		v := r.ParticipantEffectiveDuration.DeepCopy()
+		out.ParticipantEffectiveDuration = &v
+	}
+	if r.ParticipantEffectiveTiming != nil {
+		v := r.ParticipantEffectiveTiming.DeepCopy()
+		out.ParticipantEffectiveTiming = &v
+	}
+	if r.TimeFromStart != nil {
+		v := r.TimeFromStart.DeepCopy()
+		out.TimeFromStart = &v
+	}
+	if r.GroupMeasure != nil {
+		v := *r.GroupMeasure
+		out.GroupMeasure = &v
+	}
+	if r.GroupMeasureElement != nil {
+		v := r.GroupMeasureElement.DeepCopy()
+		out.GroupMeasureElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r EvidenceVariableCharacteristic) Equal(other EvidenceVariableCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.DefinitionReference == nil) != (other.DefinitionReference == nil) || r.DefinitionReference != nil && !r.DefinitionReference.Equal(*other.DefinitionReference) {
+		return false
+	}
+	if (r.DefinitionCanonical == nil) != (other.DefinitionCanonical == nil) || r.DefinitionCanonical != nil && *r.DefinitionCanonical != *other.DefinitionCanonical {
+		return false
+	}
+	if (r.DefinitionCanonicalElement == nil) != (other.DefinitionCanonicalElement == nil) || r.DefinitionCanonicalElement != nil && !r.DefinitionCanonicalElement.Equal(*other.DefinitionCanonicalElement) {
+		return false
+	}
+	if (r.DefinitionCodeableConcept == nil) != (other.DefinitionCodeableConcept == nil) || r.DefinitionCodeableConcept != nil && !r.DefinitionCodeableConcept.Equal(*other.DefinitionCodeableConcept) {
+		return false
+	}
+	if (r.DefinitionExpression == nil) != (other.DefinitionExpression == nil) || r.DefinitionExpression != nil && !r.DefinitionExpression.Equal(*other.DefinitionExpression) {
+		return false
+	}
+	if (r.DefinitionDataRequirement == nil) != (other.DefinitionDataRequirement == nil) || r.DefinitionDataRequirement != nil && !r.DefinitionDataRequirement.Equal(*other.DefinitionDataRequirement) {
+		return false
+	}
+	if (r.DefinitionTriggerDefinition == nil) != (other.DefinitionTriggerDefinition == nil) || r.DefinitionTriggerDefinition != nil && !r.DefinitionTriggerDefinition.Equal(*other.DefinitionTriggerDefinition) {
+		return false
+	}
+	if len(r.UsageContext) != len(other.UsageContext) {
+		return false
+	}
+	for i := range r.UsageContext {
+		if !r.UsageContext[i].Equal(other.UsageContext[i]) {
+			return false
+		}
+	}
+	if (r.Exclude == nil) != (other.Exclude == nil) || r.Exclude != nil && *r.Exclude != *other.Exclude {
+		return false
+	}
+	if (r.ExcludeElement == nil) != (other.ExcludeElement == nil) || r.ExcludeElement != nil && !r.ExcludeElement.Equal(*other.ExcludeElement) {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTime == nil) != (other.ParticipantEffectiveDateTime == nil) || r.ParticipantEffectiveDateTime != nil && *r.ParticipantEffectiveDateTime != *other.ParticipantEffectiveDateTime {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTimeElement == nil) != (other.ParticipantEffectiveDateTimeElement == nil) || r.ParticipantEffectiveDateTimeElement != nil && !r.ParticipantEffectiveDateTimeElement.Equal(*other.ParticipantEffectiveDateTimeElement) {
+		return false
+	}
+	if (r.ParticipantEffectivePeriod == nil) != (other.ParticipantEffectivePeriod == nil) || r.ParticipantEffectivePeriod != nil && !r.ParticipantEffectivePeriod.Equal(*other.ParticipantEffectivePeriod) {
+		return false
+	}
+	if (r.ParticipantEffectiveDuration == nil) != (other.ParticipantEffectiveDuration == nil) || r.ParticipantEffectiveDuration != nil && !r.ParticipantEffectiveDuration.Equal(*other.ParticipantEffectiveDuration) {
+		return false
+	}
+	if (r.ParticipantEffectiveTiming == nil) != (other.ParticipantEffectiveTiming == nil) || r.ParticipantEffectiveTiming != nil && !r.ParticipantEffectiveTiming.Equal(*other.ParticipantEffectiveTiming) {
+		return false
+	}
+	if (r.TimeFromStart == nil) != (other.TimeFromStart == nil) || r.TimeFromStart != nil && !r.TimeFromStart.Equal(*other.TimeFromStart) {
+		return false
+	}
+	if (r.GroupMeasure == nil) != (other.GroupMeasure == nil) || r.GroupMeasure != nil && *r.GroupMeasure != *other.GroupMeasure {
+		return false
+	}
+	if (r.GroupMeasureElement == nil) != (other.GroupMeasureElement == nil) || r.GroupMeasureElement != nil && !r.GroupMeasureElement.Equal(*other.GroupMeasureElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r EvidenceVariableCharacteristic) EqualsDeep(other EvidenceVariableCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.DefinitionReference == nil) != (other.DefinitionReference == nil) || r.DefinitionReference != nil && !r.DefinitionReference.EqualsDeep(*other.DefinitionReference) {
+		return false
+	}
+	if (r.DefinitionCanonical == nil) != (other.DefinitionCanonical == nil) || r.DefinitionCanonical != nil && !equivalentString(*r.DefinitionCanonical, *other.DefinitionCanonical) {
+		return false
+	}
+	if (r.DefinitionCanonicalElement == nil) != (other.DefinitionCanonicalElement == nil) || r.DefinitionCanonicalElement != nil && !r.DefinitionCanonicalElement.EqualsDeep(*other.DefinitionCanonicalElement) {
+		return false
+	}
+	if (r.DefinitionCodeableConcept == nil) != (other.DefinitionCodeableConcept == nil) || r.DefinitionCodeableConcept != nil && !r.DefinitionCodeableConcept.EqualsDeep(*other.DefinitionCodeableConcept) {
+		return false
+	}
+	if (r.DefinitionExpression == nil) != (other.DefinitionExpression == nil) || r.DefinitionExpression != nil && !r.DefinitionExpression.EqualsDeep(*other.DefinitionExpression) {
+		return false
+	}
+	if (r.DefinitionDataRequirement == nil) != (other.DefinitionDataRequirement == nil) || r.DefinitionDataRequirement != nil && !r.DefinitionDataRequirement.EqualsDeep(*other.DefinitionDataRequirement) {
+		return false
+	}
+	if (r.DefinitionTriggerDefinition == nil) != (other.DefinitionTriggerDefinition == nil) || r.DefinitionTriggerDefinition != nil && !r.DefinitionTriggerDefinition.EqualsDeep(*other.DefinitionTriggerDefinition) {
+		return false
+	}
+	if !equivalentList(len(r.UsageContext), len(other.UsageContext), func(i, j int) bool {
+		return r.UsageContext[i].EqualsDeep(other.UsageContext[j])
+	}) {
+		return false
+	}
+	if (r.Exclude == nil) != (other.Exclude == nil) || r.Exclude != nil && *r.Exclude != *other.Exclude {
+		return false
+	}
+	if (r.ExcludeElement == nil) != (other.ExcludeElement == nil) || r.ExcludeElement != nil && !r.ExcludeElement.EqualsDeep(*other.ExcludeElement) {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTime == nil) != (other.ParticipantEffectiveDateTime == nil) || r.ParticipantEffectiveDateTime != nil && !equivalentString(*r.ParticipantEffectiveDateTime, *other.ParticipantEffectiveDateTime) {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTimeElement == nil) != (other.ParticipantEffectiveDateTimeElement == nil) || r.ParticipantEffectiveDateTimeElement != nil && !r.ParticipantEffectiveDateTimeElement.EqualsDeep(*other.ParticipantEffectiveDateTimeElement) {
+		return false
+	}
+	if (r.ParticipantEffectivePeriod == nil) != (other.ParticipantEffectivePeriod == nil) || r.ParticipantEffectivePeriod != nil && !r.ParticipantEffectivePeriod.EqualsDeep(*other.ParticipantEffectivePeriod) {
+		return false
+	}
+	if (r.ParticipantEffectiveDuration == nil) != (other.ParticipantEffectiveDuration == nil) || r.ParticipantEffectiveDuration != nil && !r.ParticipantEffectiveDuration.EqualsDeep(*other.ParticipantEffectiveDuration) {
+		return false
+	}
+	if (r.ParticipantEffectiveTiming == nil) != (other.ParticipantEffectiveTiming == nil) || r.ParticipantEffectiveTiming != nil && !r.ParticipantEffectiveTiming.EqualsDeep(*other.ParticipantEffectiveTiming) {
+		return false
+	}
+	if (r.TimeFromStart == nil) != (other.TimeFromStart == nil) || r.TimeFromStart != nil && !r.TimeFromStart.EqualsDeep(*other.TimeFromStart) {
+		return false
+	}
+	if (r.GroupMeasure == nil) != (other.GroupMeasure == nil) || r.GroupMeasure != nil && *r.GroupMeasure != *other.GroupMeasure {
+		return false
+	}
+	if (r.GroupMeasureElement == nil) != (other.GroupMeasureElement == nil) || r.GroupMeasureElement != nil && !r.GroupMeasureElement.EqualsDeep(*other.GroupMeasureElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r EvidenceVariableCharacteristic) EqualsShallow(other EvidenceVariableCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DefinitionCanonical == nil) != (other.DefinitionCanonical == nil) || r.DefinitionCanonical != nil && !equivalentString(*r.DefinitionCanonical, *other.DefinitionCanonical) {
+		return false
+	}
+	if (r.Exclude == nil) != (other.Exclude == nil) || r.Exclude != nil && *r.Exclude != *other.Exclude {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTime == nil) != (other.ParticipantEffectiveDateTime == nil) || r.ParticipantEffectiveDateTime != nil && !equivalentString(*r.ParticipantEffectiveDateTime, *other.ParticipantEffectiveDateTime) {
+		return false
+	}
+	if (r.GroupMeasure == nil) != (other.GroupMeasure == nil) || r.GroupMeasure != nil && *r.GroupMeasure != *other.GroupMeasure {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given EvidenceVariableCharacteristic as FHIR XML
+func (r EvidenceVariableCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "definitionReference", r.DefinitionReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definitionCanonical", r.DefinitionCanonical, r.DefinitionCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "definitionCodeableConcept", r.DefinitionCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "definitionExpression", r.DefinitionExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "definitionDataRequirement", r.DefinitionDataRequirement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "definitionTriggerDefinition", r.DefinitionTriggerDefinition); err != nil {
+		return err
+	}
+	for _, v := range r.UsageContext {
+		if err := encodeXMLElement(e, "usageContext", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "exclude", r.Exclude, r.ExcludeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "participantEffectiveDateTime", r.ParticipantEffectiveDateTime, r.ParticipantEffectiveDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "participantEffectivePeriod", r.ParticipantEffectivePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "participantEffectiveDuration", r.ParticipantEffectiveDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "participantEffectiveTiming", r.ParticipantEffectiveTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timeFromStart", r.TimeFromStart); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "groupMeasure", r.GroupMeasure, r.GroupMeasureElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given EvidenceVariableCharacteristic from FHIR XML
+func (r *EvidenceVariableCharacteristic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "definitionReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefinitionReference = &v
+			case "definitionCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefinitionCanonical = &v
+				}
+				r.DefinitionCanonicalElement = element
+			case "definitionCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefinitionCodeableConcept = &v
+			case "definitionExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefinitionExpression = &v
+			case "definitionDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefinitionDataRequirement = &v
+			case "definitionTriggerDefinition":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefinitionTriggerDefinition = &v
+			case "usageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UsageContext = append(r.UsageContext, v)
+			case "exclude":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Exclude = &v
+				}
+				r.ExcludeElement = element
+			case "participantEffectiveDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ParticipantEffectiveDateTime = &v
+				}
+				r.ParticipantEffectiveDateTimeElement = element
+			case "participantEffectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParticipantEffectivePeriod = &v
+			case "participantEffectiveDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParticipantEffectiveDuration = &v
+			case "participantEffectiveTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParticipantEffectiveTiming = &v
+			case "timeFromStart":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimeFromStart = &v
+			case "groupMeasure":
+				var v GroupMeasure
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.GroupMeasure = &v
+				}
+				r.GroupMeasureElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the EvidenceVariableCharacteristic as properties to the RDF node n
+func (r EvidenceVariableCharacteristic) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("EvidenceVariable.characteristic.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("EvidenceVariable.characteristic.definitionReference", -1, r.DefinitionReference)
+	n.primitive("EvidenceVariable.characteristic.definitionCanonical", -1, r.DefinitionCanonical, r.DefinitionCanonicalElement, "canonical")
+	n.element("EvidenceVariable.characteristic.definitionCodeableConcept", -1, r.DefinitionCodeableConcept)
+	n.element("EvidenceVariable.characteristic.definitionExpression", -1, r.DefinitionExpression)
+	n.element("EvidenceVariable.characteristic.definitionDataRequirement", -1, r.DefinitionDataRequirement)
+	n.element("EvidenceVariable.characteristic.definitionTriggerDefinition", -1, r.DefinitionTriggerDefinition)
+	for i, v := range r.UsageContext {
+		n.element("EvidenceVariable.characteristic.usageContext", i, v)
+	}
+	n.primitive("EvidenceVariable.characteristic.exclude", -1, r.Exclude, r.ExcludeElement, "boolean")
+	n.primitive("EvidenceVariable.characteristic.participantEffectiveDateTime", -1, r.ParticipantEffectiveDateTime, r.ParticipantEffectiveDateTimeElement, "dateTime")
+	n.element("EvidenceVariable.characteristic.participantEffectivePeriod", -1, r.ParticipantEffectivePeriod)
+	n.element("EvidenceVariable.characteristic.participantEffectiveDuration", -1, r.ParticipantEffectiveDuration)
+	n.element("EvidenceVariable.characteristic.participantEffectiveTiming", -1, r.ParticipantEffectiveTiming)
+	n.element("EvidenceVariable.characteristic.timeFromStart", -1, r.TimeFromStart)
+	n.primitive("EvidenceVariable.characteristic.groupMeasure", -1, r.GroupMeasure, r.GroupMeasureElement, "code")
+}
+
+// MarshalBSON marshals the given EvidenceVariableCharacteristic as BSON document with the structure of its FHIR JSON
+func (r EvidenceVariableCharacteristic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given EvidenceVariableCharacteristic from a BSON document with the structure of its FHIR JSON
+func (r *EvidenceVariableCharacteristic) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given EvidenceVariableCharacteristic as protobuf message EvidenceVariableCharacteristic of fhir.proto
+func (r EvidenceVariableCharacteristic) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the EvidenceVariableCharacteristic to the encoded message
+func (r EvidenceVariableCharacteristic) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.DefinitionReference != nil {
+		e.message(5, *r.DefinitionReference)
+	}
+	if r.DefinitionCanonical != nil {
+		e.string(6, *r.DefinitionCanonical)
+	}
+	if r.DefinitionCodeableConcept != nil {
+		e.message(7, *r.DefinitionCodeableConcept)
+	}
+	if r.DefinitionExpression != nil {
+		e.message(8, *r.DefinitionExpression)
+	}
+	if r.DefinitionDataRequirement != nil {
+		e.message(9, *r.DefinitionDataRequirement)
+	}
+	if r.DefinitionTriggerDefinition != nil {
+		e.message(10, *r.DefinitionTriggerDefinition)
+	}
+	for _, v := range r.UsageContext {
+		e.message(11, v)
+	}
+	if r.Exclude != nil {
+		e.varint(12, protoBool(*r.Exclude))
+	}
+	if r.ParticipantEffectiveDateTime != nil {
+		e.string(13, *r.ParticipantEffectiveDateTime)
+	}
+	if r.ParticipantEffectivePeriod != nil {
+		e.message(14, *r.ParticipantEffectivePeriod)
+	}
+	if r.ParticipantEffectiveDuration != nil {
+		e.message(15, *r.ParticipantEffectiveDuration)
+	}
+	if r.ParticipantEffectiveTiming != nil {
+		e.message(16, *r.ParticipantEffectiveTiming)
+	}
+	if r.TimeFromStart != nil {
+		e.message(17, *r.TimeFromStart)
+	}
+	if r.GroupMeasure != nil {
+		e.varint(18, r.GroupMeasure.protoNumber())
+	}
+	if r.DescriptionElement != nil {
+		e.message(19, *r.DescriptionElement)
+	}
+	if r.DefinitionCanonicalElement != nil {
+		e.message(20, *r.DefinitionCanonicalElement)
+	}
+	if r.ExcludeElement != nil {
+		e.message(21, *r.ExcludeElement)
+	}
+	if r.ParticipantEffectiveDateTimeElement != nil {
+		e.message(22, *r.ParticipantEffectiveDateTimeElement)
+	}
+	if r.GroupMeasureElement != nil {
+		e.message(23, *r.GroupMeasureElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given EvidenceVariableCharacteristic from protobuf message EvidenceVariableCharacteristic of fhir.proto
+func (r *EvidenceVariableCharacteristic) UnmarshalProto(b []byte) error {
+	*r = EvidenceVariableCharacteristic{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.DefinitionReference = &v
+		case 6:
+			v := d.string()
+			r.DefinitionCanonical = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.DefinitionCodeableConcept = &v
+		case 8:
+			var v Expression
+			d.message(&v)
+			r.DefinitionExpression = &v
+		case 9:
+			var v DataRequirement
+			d.message(&v)
+			r.DefinitionDataRequirement = &v
+		case 10:
+			var v TriggerDefinition
+			d.message(&v)
+			r.DefinitionTriggerDefinition = &v
+		case 11:
+			var v UsageContext
+			d.message(&v)
+			r.UsageContext = append(r.UsageContext, v)
+		case 12:
+			v := d.bool()
+			r.Exclude = &v
+		case 13:
+			v := d.string()
+			r.ParticipantEffectiveDateTime = &v
+		case 14:
+			var v Period
+			d.message(&v)
+			r.ParticipantEffectivePeriod = &v
+		case 15:
+			var v Duration
+			d.message(&v)
+			r.ParticipantEffectiveDuration = &v
+		case 16:
+			var v Timing
+			d.message(&v)
+			r.ParticipantEffectiveTiming = &v
+		case 17:
+			var v Duration
+			d.message(&v)
+			r.TimeFromStart = &v
+		case 18:
+			var v GroupMeasure
+			d.enum(d.varint(), v.setProtoNumber)
+			r.GroupMeasure = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.DefinitionCanonicalElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ExcludeElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ParticipantEffectiveDateTimeElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.GroupMeasureElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalEvidenceVariable unmarshals a EvidenceVariable.
 func UnmarshalEvidenceVariable(b []byte) (EvidenceVariable, error) {
 	var evidenceVariable EvidenceVariable
-	if err := json.Unmarshal(b, &evidenceVariable); err != nil {
+	if err := evidenceVariable.UnmarshalJSON(b); err != nil {
 		return evidenceVariable, err
 	}
 	return evidenceVariable, nil
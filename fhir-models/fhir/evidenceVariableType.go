@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code EvidenceVariableType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *EvidenceVariableType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *EvidenceVariableType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "dichotomous":
 		*code = EvidenceVariableTypeDichotomous
@@ -49,6 +53,12 @@ func (code *EvidenceVariableType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code EvidenceVariableType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *EvidenceVariableType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code EvidenceVariableType) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code EvidenceVariableType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code EvidenceVariableType) protoNumber() uint64 {
+	switch code {
+	case EvidenceVariableTypeDichotomous:
+		return 1
+	case EvidenceVariableTypeContinuous:
+		return 2
+	case EvidenceVariableTypeDescriptive:
+		return 3
+	}
+	return 0
+}
+func (code *EvidenceVariableType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = EvidenceVariableTypeDichotomous
+	case 2:
+		*code = EvidenceVariableTypeContinuous
+	case 3:
+		*code = EvidenceVariableTypeDescriptive
+	default:
+		return false
+	}
+	return true
+}
@@ -14,81 +14,114 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ExampleScenario is documented here http://hl7.org/fhir/StructureDefinition/ExampleScenario
 type ExampleScenario struct {
-	Id                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string                   `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                   `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                   `bson:"name,omitempty" json:"name,omitempty"`
-	Status            PublicationStatus         `bson:"status" json:"status"`
-	Experimental      *bool                     `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                   `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                   `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail           `bson:"contact,omitempty" json:"contact,omitempty"`
-	UseContext        []UsageContext            `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept         `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                   `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Purpose           *string                   `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Actor             []ExampleScenarioActor    `bson:"actor,omitempty" json:"actor,omitempty"`
-	Instance          []ExampleScenarioInstance `bson:"instance,omitempty" json:"instance,omitempty"`
-	Process           []ExampleScenarioProcess  `bson:"process,omitempty" json:"process,omitempty"`
-	Workflow          []string                  `bson:"workflow,omitempty" json:"workflow,omitempty"`
+	Id                   *string                   `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                  `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                  `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  *string                   `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement           *Element                  `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier           []Identifier              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version              *string                   `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                  `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 *string                   `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                  `bson:"_name,omitempty" json:"_name,omitempty"`
+	Status               PublicationStatus         `bson:"status" json:"status"`
+	StatusElement        *Element                  `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                     `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                  `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *string                   `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                  `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                   `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                  `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail           `bson:"contact,omitempty" json:"contact,omitempty"`
+	UseContext           []UsageContext            `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept         `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright            *string                   `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                  `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Purpose              *string                   `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                  `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Actor                []ExampleScenarioActor    `bson:"actor,omitempty" json:"actor,omitempty"`
+	Instance             []ExampleScenarioInstance `bson:"instance,omitempty" json:"instance,omitempty"`
+	Process              []ExampleScenarioProcess  `bson:"process,omitempty" json:"process,omitempty"`
+	Workflow             []string                  `bson:"workflow,omitempty" json:"workflow,omitempty"`
+	WorkflowElement      []*Element                `bson:"_workflow,omitempty" json:"_workflow,omitempty"`
 }
 type ExampleScenarioActor struct {
-	Id                *string                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ActorId           string                   `bson:"actorId" json:"actorId"`
-	Type              ExampleScenarioActorType `bson:"type" json:"type"`
-	Name              *string                  `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                  `bson:"description,omitempty" json:"description,omitempty"`
+	Id                 *string                  `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ActorId            string                   `bson:"actorId" json:"actorId"`
+	ActorIdElement     *Element                 `bson:"_actorId,omitempty" json:"_actorId,omitempty"`
+	Type               ExampleScenarioActorType `bson:"type" json:"type"`
+	TypeElement        *Element                 `bson:"_type,omitempty" json:"_type,omitempty"`
+	Name               *string                  `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element                 `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                  `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                 `bson:"_description,omitempty" json:"_description,omitempty"`
 }
 type ExampleScenarioInstance struct {
-	Id                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ResourceId        string                                     `bson:"resourceId" json:"resourceId"`
-	ResourceType      ResourceType                               `bson:"resourceType" json:"resourceType"`
-	Name              *string                                    `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                                    `bson:"description,omitempty" json:"description,omitempty"`
-	Version           []ExampleScenarioInstanceVersion           `bson:"version,omitempty" json:"version,omitempty"`
-	ContainedInstance []ExampleScenarioInstanceContainedInstance `bson:"containedInstance,omitempty" json:"containedInstance,omitempty"`
+	Id                  *string                                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ResourceId          string                                     `bson:"resourceId" json:"resourceId"`
+	ResourceIdElement   *Element                                   `bson:"_resourceId,omitempty" json:"_resourceId,omitempty"`
+	ResourceType        ResourceType                               `bson:"resourceType" json:"resourceType"`
+	ResourceTypeElement *Element                                   `bson:"_resourceType,omitempty" json:"_resourceType,omitempty"`
+	Name                *string                                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement         *Element                                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description         *string                                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element                                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Version             []ExampleScenarioInstanceVersion           `bson:"version,omitempty" json:"version,omitempty"`
+	ContainedInstance   []ExampleScenarioInstanceContainedInstance `bson:"containedInstance,omitempty" json:"containedInstance,omitempty"`
 }
 type ExampleScenarioInstanceVersion struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	VersionId         string      `bson:"versionId" json:"versionId"`
-	Description       string      `bson:"description" json:"description"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	VersionId          string      `bson:"versionId" json:"versionId"`
+	VersionIdElement   *Element    `bson:"_versionId,omitempty" json:"_versionId,omitempty"`
+	Description        string      `bson:"description" json:"description"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
 }
 type ExampleScenarioInstanceContainedInstance struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	ResourceId        string      `bson:"resourceId" json:"resourceId"`
+	ResourceIdElement *Element    `bson:"_resourceId,omitempty" json:"_resourceId,omitempty"`
 	VersionId         *string     `bson:"versionId,omitempty" json:"versionId,omitempty"`
+	VersionIdElement  *Element    `bson:"_versionId,omitempty" json:"_versionId,omitempty"`
 }
 type ExampleScenarioProcess struct {
-	Id                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Title             string                       `bson:"title" json:"title"`
-	Description       *string                      `bson:"description,omitempty" json:"description,omitempty"`
-	PreConditions     *string                      `bson:"preConditions,omitempty" json:"preConditions,omitempty"`
-	PostConditions    *string                      `bson:"postConditions,omitempty" json:"postConditions,omitempty"`
-	Step              []ExampleScenarioProcessStep `bson:"step,omitempty" json:"step,omitempty"`
+	Id                    *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Title                 string                       `bson:"title" json:"title"`
+	TitleElement          *Element                     `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description           *string                      `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                     `bson:"_description,omitempty" json:"_description,omitempty"`
+	PreConditions         *string                      `bson:"preConditions,omitempty" json:"preConditions,omitempty"`
+	PreConditionsElement  *Element                     `bson:"_preConditions,omitempty" json:"_preConditions,omitempty"`
+	PostConditions        *string                      `bson:"postConditions,omitempty" json:"postConditions,omitempty"`
+	PostConditionsElement *Element                     `bson:"_postConditions,omitempty" json:"_postConditions,omitempty"`
+	Step                  []ExampleScenarioProcessStep `bson:"step,omitempty" json:"step,omitempty"`
 }
 type ExampleScenarioProcessStep struct {
 	Id                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
@@ -96,49 +129,6170 @@ type ExampleScenarioProcessStep struct {
 	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Process           []ExampleScenarioProcess                `bson:"process,omitempty" json:"process,omitempty"`
 	Pause             *bool                                   `bson:"pause,omitempty" json:"pause,omitempty"`
+	PauseElement      *Element                                `bson:"_pause,omitempty" json:"_pause,omitempty"`
 	Operation         *ExampleScenarioProcessStepOperation    `bson:"operation,omitempty" json:"operation,omitempty"`
 	Alternative       []ExampleScenarioProcessStepAlternative `bson:"alternative,omitempty" json:"alternative,omitempty"`
 }
 type ExampleScenarioProcessStepOperation struct {
-	Id                *string                                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Number            string                                    `bson:"number" json:"number"`
-	Type              *string                                   `bson:"type,omitempty" json:"type,omitempty"`
-	Name              *string                                   `bson:"name,omitempty" json:"name,omitempty"`
-	Initiator         *string                                   `bson:"initiator,omitempty" json:"initiator,omitempty"`
-	Receiver          *string                                   `bson:"receiver,omitempty" json:"receiver,omitempty"`
-	Description       *string                                   `bson:"description,omitempty" json:"description,omitempty"`
-	InitiatorActive   *bool                                     `bson:"initiatorActive,omitempty" json:"initiatorActive,omitempty"`
-	ReceiverActive    *bool                                     `bson:"receiverActive,omitempty" json:"receiverActive,omitempty"`
-	Request           *ExampleScenarioInstanceContainedInstance `bson:"request,omitempty" json:"request,omitempty"`
-	Response          *ExampleScenarioInstanceContainedInstance `bson:"response,omitempty" json:"response,omitempty"`
+	Id                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Number                 string                                    `bson:"number" json:"number"`
+	NumberElement          *Element                                  `bson:"_number,omitempty" json:"_number,omitempty"`
+	Type                   *string                                   `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement            *Element                                  `bson:"_type,omitempty" json:"_type,omitempty"`
+	Name                   *string                                   `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element                                  `bson:"_name,omitempty" json:"_name,omitempty"`
+	Initiator              *string                                   `bson:"initiator,omitempty" json:"initiator,omitempty"`
+	InitiatorElement       *Element                                  `bson:"_initiator,omitempty" json:"_initiator,omitempty"`
+	Receiver               *string                                   `bson:"receiver,omitempty" json:"receiver,omitempty"`
+	ReceiverElement        *Element                                  `bson:"_receiver,omitempty" json:"_receiver,omitempty"`
+	Description            *string                                   `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                                  `bson:"_description,omitempty" json:"_description,omitempty"`
+	InitiatorActive        *bool                                     `bson:"initiatorActive,omitempty" json:"initiatorActive,omitempty"`
+	InitiatorActiveElement *Element                                  `bson:"_initiatorActive,omitempty" json:"_initiatorActive,omitempty"`
+	ReceiverActive         *bool                                     `bson:"receiverActive,omitempty" json:"receiverActive,omitempty"`
+	ReceiverActiveElement  *Element                                  `bson:"_receiverActive,omitempty" json:"_receiverActive,omitempty"`
+	Request                *ExampleScenarioInstanceContainedInstance `bson:"request,omitempty" json:"request,omitempty"`
+	Response               *ExampleScenarioInstanceContainedInstance `bson:"response,omitempty" json:"response,omitempty"`
 }
 type ExampleScenarioProcessStepAlternative struct {
-	Id                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Title             string                       `bson:"title" json:"title"`
-	Description       *string                      `bson:"description,omitempty" json:"description,omitempty"`
-	Step              []ExampleScenarioProcessStep `bson:"step,omitempty" json:"step,omitempty"`
+	Id                 *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Title              string                       `bson:"title" json:"title"`
+	TitleElement       *Element                     `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description        *string                      `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                     `bson:"_description,omitempty" json:"_description,omitempty"`
+	Step               []ExampleScenarioProcessStep `bson:"step,omitempty" json:"step,omitempty"`
 }
-type OtherExampleScenario ExampleScenario
 
 // MarshalJSON marshals the given ExampleScenario as JSON into a byte slice
 func (r ExampleScenario) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherExampleScenario
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherExampleScenario: OtherExampleScenario(r),
-		ResourceType:         "ExampleScenario",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExampleScenario as JSON object with the members in the order of the fields
+func (r ExampleScenario) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ExampleScenario\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if len(r.Actor) > 0 {
+		e.key("actor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Actor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Instance) > 0 {
+		e.key("instance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Instance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Process) > 0 {
+		e.key("process")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Process {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Workflow) > 0 {
+		e.key("workflow")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Workflow {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.WorkflowElement) > 0 {
+		e.key("_workflow")
+		e.elements(r.WorkflowElement, len(r.Workflow))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExampleScenario from JSON. Unknown members are ignored.
+func (r *ExampleScenario) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExampleScenario
+func (r *ExampleScenario) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "actor":
+			a := d.array()
+			r.Actor = nil
+			for a.next() {
+				var v ExampleScenarioActor
+				v.decodeJSON(d)
+				r.Actor = append(r.Actor, v)
+			}
+			if r.Actor == nil && !a.isNull {
+				r.Actor = []ExampleScenarioActor{}
+			}
+		case "instance":
+			a := d.array()
+			r.Instance = nil
+			for a.next() {
+				var v ExampleScenarioInstance
+				v.decodeJSON(d)
+				r.Instance = append(r.Instance, v)
+			}
+			if r.Instance == nil && !a.isNull {
+				r.Instance = []ExampleScenarioInstance{}
+			}
+		case "process":
+			a := d.array()
+			r.Process = nil
+			for a.next() {
+				var v ExampleScenarioProcess
+				v.decodeJSON(d)
+				r.Process = append(r.Process, v)
+			}
+			if r.Process == nil && !a.isNull {
+				r.Process = []ExampleScenarioProcess{}
+			}
+		case "workflow":
+			a := d.array()
+			r.Workflow = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Workflow = append(r.Workflow, v)
+			}
+			if r.Workflow == nil && !a.isNull {
+				r.Workflow = []string{}
+			}
+		case "_workflow":
+			a := d.array()
+			r.WorkflowElement = nil
+			for a.next() {
+				r.WorkflowElement = append(r.WorkflowElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExampleScenario which shares no memory with the original
+func (r ExampleScenario) DeepCopy() ExampleScenario {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Actor != nil {
+		out.Actor = make([]ExampleScenarioActor, len(r.Actor))
+		for i := range r.Actor {
+			out.Actor[i] = r.Actor[i].DeepCopy()
+		}
+	}
+	if r.Instance != nil {
+		out.Instance = make([]ExampleScenarioInstance, len(r.Instance))
+		for i := range r.Instance {
+			out.Instance[i] = r.Instance[i].DeepCopy()
+		}
+	}
+	if r.Process != nil {
+		out.Process = make([]ExampleScenarioProcess, len(r.Process))
+		for i := range r.Process {
+			out.Process[i] = r.Process[i].DeepCopy()
+		}
+	}
+	if r.Workflow != nil {
+		out.Workflow = make([]string, len(r.Workflow))
+		copy(out.Workflow, r.Workflow)
+	}
+	if r.WorkflowElement != nil {
+		out.WorkflowElement = make([]*Element, len(r.WorkflowElement))
+		for i, v := range r.WorkflowElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.WorkflowElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExampleScenario) Equal(other ExampleScenario) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if len(r.Actor) != len(other.Actor) {
+		return false
+	}
+	for i := range r.Actor {
+		if !r.Actor[i].Equal(other.Actor[i]) {
+			return false
+		}
+	}
+	if len(r.Instance) != len(other.Instance) {
+		return false
+	}
+	for i := range r.Instance {
+		if !r.Instance[i].Equal(other.Instance[i]) {
+			return false
+		}
+	}
+	if len(r.Process) != len(other.Process) {
+		return false
+	}
+	for i := range r.Process {
+		if !r.Process[i].Equal(other.Process[i]) {
+			return false
+		}
+	}
+	if len(r.Workflow) != len(other.Workflow) {
+		return false
+	}
+	for i := range r.Workflow {
+		if r.Workflow[i] != other.Workflow[i] {
+			return false
+		}
+	}
+	if !equalElements(r.WorkflowElement, other.WorkflowElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExampleScenario) EqualsDeep(other ExampleScenario) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Actor), len(other.Actor), func(i, j int) bool {
+		return r.Actor[i].EqualsDeep(other.Actor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Instance), len(other.Instance), func(i, j int) bool {
+		return r.Instance[i].EqualsDeep(other.Instance[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Process), len(other.Process), func(i, j int) bool {
+		return r.Process[i].EqualsDeep(other.Process[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Workflow), len(other.Workflow), func(i, j int) bool {
+		return equivalentString(r.Workflow[i], other.Workflow[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.WorkflowElement, other.WorkflowElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExampleScenario) EqualsShallow(other ExampleScenario) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if !equivalentList(len(r.Workflow), len(other.Workflow), func(i, j int) bool {
+		return equivalentString(r.Workflow[i], other.Workflow[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExampleScenario as FHIR XML
+func (r ExampleScenario) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ExampleScenario",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	for _, v := range r.Actor {
+		if err := encodeXMLElement(e, "actor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Instance {
+		if err := encodeXMLElement(e, "instance", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Process {
+		if err := encodeXMLElement(e, "process", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Workflow {
+		if err := encodeXMLPrimitive(e, "workflow", v, elementAt(r.WorkflowElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExampleScenario from FHIR XML
+func (r *ExampleScenario) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "actor":
+				var v ExampleScenarioActor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = append(r.Actor, v)
+			case "instance":
+				var v ExampleScenarioInstance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Instance = append(r.Instance, v)
+			case "process":
+				var v ExampleScenarioProcess
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Process = append(r.Process, v)
+			case "workflow":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.WorkflowElement = appendElement(r.WorkflowElement, len(r.Workflow), element)
+					r.Workflow = append(r.Workflow, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExampleScenario as properties to the RDF node n
+func (r ExampleScenario) turtle(n *turtleNode) {
+	n.resource("ExampleScenario", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("ExampleScenario.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("ExampleScenario.identifier", i, v)
+	}
+	n.primitive("ExampleScenario.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("ExampleScenario.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ExampleScenario.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("ExampleScenario.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("ExampleScenario.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("ExampleScenario.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("ExampleScenario.contact", i, v)
+	}
+	for i, v := range r.UseContext {
+		n.element("ExampleScenario.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("ExampleScenario.jurisdiction", i, v)
+	}
+	n.primitive("ExampleScenario.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("ExampleScenario.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	for i, v := range r.Actor {
+		n.element("ExampleScenario.actor", i, v)
+	}
+	for i, v := range r.Instance {
+		n.element("ExampleScenario.instance", i, v)
+	}
+	for i, v := range r.Process {
+		n.element("ExampleScenario.process", i, v)
+	}
+	for i, v := range r.Workflow {
+		n.primitive("ExampleScenario.workflow", i, v, elementAt(r.WorkflowElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given ExampleScenario as BSON document with the structure of its FHIR JSON
+func (r ExampleScenario) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExampleScenario from a BSON document with the structure of its FHIR JSON
+func (r *ExampleScenario) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExampleScenario as protobuf message ExampleScenario of fhir.proto
+func (r ExampleScenario) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExampleScenario to the encoded message
+func (r ExampleScenario) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	e.varint(13, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(14, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(15, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(16, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(17, v)
+	}
+	for _, v := range r.UseContext {
+		e.message(18, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(19, v)
+	}
+	if r.Copyright != nil {
+		e.string(20, *r.Copyright)
+	}
+	if r.Purpose != nil {
+		e.string(21, *r.Purpose)
+	}
+	for _, v := range r.Actor {
+		e.message(22, v)
+	}
+	for _, v := range r.Instance {
+		e.message(23, v)
+	}
+	for _, v := range r.Process {
+		e.message(24, v)
+	}
+	for _, v := range r.Workflow {
+		e.string(25, v)
+	}
+	if r.IdElement != nil {
+		e.message(26, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(27, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(28, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(29, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(30, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(31, *r.NameElement)
+	}
+	if r.StatusElement != nil {
+		e.message(32, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(33, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(34, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(35, *r.PublisherElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(36, *r.CopyrightElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(37, *r.PurposeElement)
+	}
+	for _, v := range r.WorkflowElement {
+		e.element(38, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExampleScenario from protobuf message ExampleScenario of fhir.proto
+func (r *ExampleScenario) UnmarshalProto(b []byte) error {
+	*r = ExampleScenario{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 14:
+			v := d.bool()
+			r.Experimental = &v
+		case 15:
+			v := d.string()
+			r.Date = &v
+		case 16:
+			v := d.string()
+			r.Publisher = &v
+		case 17:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 18:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 20:
+			v := d.string()
+			r.Copyright = &v
+		case 21:
+			v := d.string()
+			r.Purpose = &v
+		case 22:
+			var v ExampleScenarioActor
+			d.message(&v)
+			r.Actor = append(r.Actor, v)
+		case 23:
+			var v ExampleScenarioInstance
+			d.message(&v)
+			r.Instance = append(r.Instance, v)
+		case 24:
+			var v ExampleScenarioProcess
+			d.message(&v)
+			r.Process = append(r.Process, v)
+		case 25:
+			r.Workflow = append(r.Workflow, d.string())
+		case 26:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 38:
+			r.WorkflowElement = append(r.WorkflowElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExampleScenarioActor as JSON into a byte slice
+func (r ExampleScenarioActor) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExampleScenarioActor as JSON object with the members in the order of the fields
+func (r ExampleScenarioActor) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("actorId")
+	e.string(r.ActorId)
+	if r.ActorIdElement != nil {
+		e.key("_actorId")
+		r.ActorIdElement.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExampleScenarioActor from JSON. Unknown members are ignored.
+func (r *ExampleScenarioActor) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExampleScenarioActor
+func (r *ExampleScenarioActor) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "actorId":
+			if v, ok := d.string(); ok {
+				r.ActorId = v
+			}
+		case "_actorId":
+			r.ActorIdElement = d.element()
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExampleScenarioActor which shares no memory with the original
+func (r ExampleScenarioActor) DeepCopy() ExampleScenarioActor {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ActorIdElement != nil {
+		v := r.ActorIdElement.DeepCopy()
+		out.ActorIdElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExampleScenarioActor) Equal(other ExampleScenarioActor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.ActorId != other.ActorId {
+		return false
+	}
+	if (r.ActorIdElement == nil) != (other.ActorIdElement == nil) || r.ActorIdElement != nil && !r.ActorIdElement.Equal(*other.ActorIdElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExampleScenarioActor) EqualsDeep(other ExampleScenarioActor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.ActorId, other.ActorId) {
+		return false
+	}
+	if (r.ActorIdElement == nil) != (other.ActorIdElement == nil) || r.ActorIdElement != nil && !r.ActorIdElement.EqualsDeep(*other.ActorIdElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExampleScenarioActor) EqualsShallow(other ExampleScenarioActor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.ActorId, other.ActorId) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExampleScenarioActor as FHIR XML
+func (r ExampleScenarioActor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "actorId", r.ActorId, r.ActorIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExampleScenarioActor from FHIR XML
+func (r *ExampleScenarioActor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "actorId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ActorId = v
+				}
+				r.ActorIdElement = element
+			case "type":
+				var v ExampleScenarioActorType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExampleScenarioActor as properties to the RDF node n
+func (r ExampleScenarioActor) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExampleScenario.actor.actorId", -1, r.ActorId, r.ActorIdElement, "string")
+	n.primitive("ExampleScenario.actor.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("ExampleScenario.actor.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ExampleScenario.actor.description", -1, r.Description, r.DescriptionElement, "string")
+}
+
+// MarshalBSON marshals the given ExampleScenarioActor as BSON document with the structure of its FHIR JSON
+func (r ExampleScenarioActor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExampleScenarioActor from a BSON document with the structure of its FHIR JSON
+func (r *ExampleScenarioActor) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExampleScenarioActor as protobuf message ExampleScenarioActor of fhir.proto
+func (r ExampleScenarioActor) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExampleScenarioActor to the encoded message
+func (r ExampleScenarioActor) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ActorId != "" {
+		e.string(4, r.ActorId)
+	}
+	e.varint(5, r.Type.protoNumber())
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.Description != nil {
+		e.string(7, *r.Description)
+	}
+	if r.ActorIdElement != nil {
+		e.message(8, *r.ActorIdElement)
+	}
+	if r.TypeElement != nil {
+		e.message(9, *r.TypeElement)
+	}
+	if r.NameElement != nil {
+		e.message(10, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(11, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExampleScenarioActor from protobuf message ExampleScenarioActor of fhir.proto
+func (r *ExampleScenarioActor) UnmarshalProto(b []byte) error {
+	*r = ExampleScenarioActor{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.ActorId = d.string()
+		case 5:
+			var v ExampleScenarioActorType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			v := d.string()
+			r.Description = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ActorIdElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExampleScenarioInstance as JSON into a byte slice
+func (r ExampleScenarioInstance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExampleScenarioInstance as JSON object with the members in the order of the fields
+func (r ExampleScenarioInstance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("resourceId")
+	e.string(r.ResourceId)
+	if r.ResourceIdElement != nil {
+		e.key("_resourceId")
+		r.ResourceIdElement.appendJSON(e)
+	}
+	e.key("resourceType")
+	e.string(r.ResourceType.Code())
+	if r.ResourceTypeElement != nil {
+		e.key("_resourceType")
+		r.ResourceTypeElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Version) > 0 {
+		e.key("version")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Version {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ContainedInstance) > 0 {
+		e.key("containedInstance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ContainedInstance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExampleScenarioInstance from JSON. Unknown members are ignored.
+func (r *ExampleScenarioInstance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExampleScenarioInstance
+func (r *ExampleScenarioInstance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "resourceId":
+			if v, ok := d.string(); ok {
+				r.ResourceId = v
+			}
+		case "_resourceId":
+			r.ResourceIdElement = d.element()
+		case "resourceType":
+			d.unmarshal(&r.ResourceType)
+		case "_resourceType":
+			r.ResourceTypeElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "version":
+			a := d.array()
+			r.Version = nil
+			for a.next() {
+				var v ExampleScenarioInstanceVersion
+				v.decodeJSON(d)
+				r.Version = append(r.Version, v)
+			}
+			if r.Version == nil && !a.isNull {
+				r.Version = []ExampleScenarioInstanceVersion{}
+			}
+		case "containedInstance":
+			a := d.array()
+			r.ContainedInstance = nil
+			for a.next() {
+				var v ExampleScenarioInstanceContainedInstance
+				v.decodeJSON(d)
+				r.ContainedInstance = append(r.ContainedInstance, v)
+			}
+			if r.ContainedInstance == nil && !a.isNull {
+				r.ContainedInstance = []ExampleScenarioInstanceContainedInstance{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExampleScenarioInstance which shares no memory with the original
+func (r ExampleScenarioInstance) DeepCopy() ExampleScenarioInstance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ResourceIdElement != nil {
+		v := r.ResourceIdElement.DeepCopy()
+		out.ResourceIdElement = &v
+	}
+	if r.ResourceTypeElement != nil {
+		v := r.ResourceTypeElement.DeepCopy()
+		out.ResourceTypeElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Version != nil {
+		out.Version = make([]ExampleScenarioInstanceVersion, len(r.Version))
+		for i := range r.Version {
+			out.Version[i] = r.Version[i].DeepCopy()
+		}
+	}
+	if r.ContainedInstance != nil {
+		out.ContainedInstance = make([]ExampleScenarioInstanceContainedInstance, len(r.ContainedInstance))
+		for i := range r.ContainedInstance {
+			out.ContainedInstance[i] = r.ContainedInstance[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExampleScenarioInstance) Equal(other ExampleScenarioInstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.ResourceId != other.ResourceId {
+		return false
+	}
+	if (r.ResourceIdElement == nil) != (other.ResourceIdElement == nil) || r.ResourceIdElement != nil && !r.ResourceIdElement.Equal(*other.ResourceIdElement) {
+		return false
+	}
+	if r.ResourceType != other.ResourceType {
+		return false
+	}
+	if (r.ResourceTypeElement == nil) != (other.ResourceTypeElement == nil) || r.ResourceTypeElement != nil && !r.ResourceTypeElement.Equal(*other.ResourceTypeElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Version) != len(other.Version) {
+		return false
+	}
+	for i := range r.Version {
+		if !r.Version[i].Equal(other.Version[i]) {
+			return false
+		}
+	}
+	if len(r.ContainedInstance) != len(other.ContainedInstance) {
+		return false
+	}
+	for i := range r.ContainedInstance {
+		if !r.ContainedInstance[i].Equal(other.ContainedInstance[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExampleScenarioInstance) EqualsDeep(other ExampleScenarioInstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.ResourceId, other.ResourceId) {
+		return false
+	}
+	if (r.ResourceIdElement == nil) != (other.ResourceIdElement == nil) || r.ResourceIdElement != nil && !r.ResourceIdElement.EqualsDeep(*other.ResourceIdElement) {
+		return false
+	}
+	if r.ResourceType != other.ResourceType {
+		return false
+	}
+	if (r.ResourceTypeElement == nil) != (other.ResourceTypeElement == nil) || r.ResourceTypeElement != nil && !r.ResourceTypeElement.EqualsDeep(*other.ResourceTypeElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Version), len(other.Version), func(i, j int) bool {
+		return r.Version[i].EqualsDeep(other.Version[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ContainedInstance), len(other.ContainedInstance), func(i, j int) bool {
+		return r.ContainedInstance[i].EqualsDeep(other.ContainedInstance[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExampleScenarioInstance) EqualsShallow(other ExampleScenarioInstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.ResourceId, other.ResourceId) {
+		return false
+	}
+	if r.ResourceType != other.ResourceType {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExampleScenarioInstance as FHIR XML
+func (r ExampleScenarioInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "resourceId", r.ResourceId, r.ResourceIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "resourceType", r.ResourceType, r.ResourceTypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Version {
+		if err := encodeXMLElement(e, "version", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ContainedInstance {
+		if err := encodeXMLElement(e, "containedInstance", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExampleScenarioInstance from FHIR XML
+func (r *ExampleScenarioInstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "resourceId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ResourceId = v
+				}
+				r.ResourceIdElement = element
+			case "resourceType":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ResourceType = v
+				}
+				r.ResourceTypeElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "version":
+				var v ExampleScenarioInstanceVersion
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Version = append(r.Version, v)
+			case "containedInstance":
+				var v ExampleScenarioInstanceContainedInstance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContainedInstance = append(r.ContainedInstance, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExampleScenarioInstance as properties to the RDF node n
+func (r ExampleScenarioInstance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExampleScenario.instance.resourceId", -1, r.ResourceId, r.ResourceIdElement, "string")
+	n.primitive("ExampleScenario.instance.resourceType", -1, r.ResourceType, r.ResourceTypeElement, "code")
+	n.primitive("ExampleScenario.instance.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ExampleScenario.instance.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Version {
+		n.element("ExampleScenario.instance.version", i, v)
+	}
+	for i, v := range r.ContainedInstance {
+		n.element("ExampleScenario.instance.containedInstance", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExampleScenarioInstance as BSON document with the structure of its FHIR JSON
+func (r ExampleScenarioInstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExampleScenarioInstance from a BSON document with the structure of its FHIR JSON
+func (r *ExampleScenarioInstance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExampleScenarioInstance as protobuf message ExampleScenarioInstance of fhir.proto
+func (r ExampleScenarioInstance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExampleScenarioInstance to the encoded message
+func (r ExampleScenarioInstance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ResourceId != "" {
+		e.string(4, r.ResourceId)
+	}
+	e.varint(5, r.ResourceType.protoNumber())
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.Description != nil {
+		e.string(7, *r.Description)
+	}
+	for _, v := range r.Version {
+		e.message(8, v)
+	}
+	for _, v := range r.ContainedInstance {
+		e.message(9, v)
+	}
+	if r.ResourceIdElement != nil {
+		e.message(10, *r.ResourceIdElement)
+	}
+	if r.ResourceTypeElement != nil {
+		e.message(11, *r.ResourceTypeElement)
+	}
+	if r.NameElement != nil {
+		e.message(12, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(13, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExampleScenarioInstance from protobuf message ExampleScenarioInstance of fhir.proto
+func (r *ExampleScenarioInstance) UnmarshalProto(b []byte) error {
+	*r = ExampleScenarioInstance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.ResourceId = d.string()
+		case 5:
+			var v ResourceType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.ResourceType = v
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			v := d.string()
+			r.Description = &v
+		case 8:
+			var v ExampleScenarioInstanceVersion
+			d.message(&v)
+			r.Version = append(r.Version, v)
+		case 9:
+			var v ExampleScenarioInstanceContainedInstance
+			d.message(&v)
+			r.ContainedInstance = append(r.ContainedInstance, v)
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ResourceIdElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ResourceTypeElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExampleScenarioInstanceVersion as JSON into a byte slice
+func (r ExampleScenarioInstanceVersion) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExampleScenarioInstanceVersion as JSON object with the members in the order of the fields
+func (r ExampleScenarioInstanceVersion) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("versionId")
+	e.string(r.VersionId)
+	if r.VersionIdElement != nil {
+		e.key("_versionId")
+		r.VersionIdElement.appendJSON(e)
+	}
+	e.key("description")
+	e.string(r.Description)
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExampleScenarioInstanceVersion from JSON. Unknown members are ignored.
+func (r *ExampleScenarioInstanceVersion) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExampleScenarioInstanceVersion
+func (r *ExampleScenarioInstanceVersion) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "versionId":
+			if v, ok := d.string(); ok {
+				r.VersionId = v
+			}
+		case "_versionId":
+			r.VersionIdElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = v
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExampleScenarioInstanceVersion which shares no memory with the original
+func (r ExampleScenarioInstanceVersion) DeepCopy() ExampleScenarioInstanceVersion {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.VersionIdElement != nil {
+		v := r.VersionIdElement.DeepCopy()
+		out.VersionIdElement = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExampleScenarioInstanceVersion) Equal(other ExampleScenarioInstanceVersion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.VersionId != other.VersionId {
+		return false
+	}
+	if (r.VersionIdElement == nil) != (other.VersionIdElement == nil) || r.VersionIdElement != nil && !r.VersionIdElement.Equal(*other.VersionIdElement) {
+		return false
+	}
+	if r.Description != other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExampleScenarioInstanceVersion) EqualsDeep(other ExampleScenarioInstanceVersion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.VersionId, other.VersionId) {
+		return false
+	}
+	if (r.VersionIdElement == nil) != (other.VersionIdElement == nil) || r.VersionIdElement != nil && !r.VersionIdElement.EqualsDeep(*other.VersionIdElement) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExampleScenarioInstanceVersion) EqualsShallow(other ExampleScenarioInstanceVersion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.VersionId, other.VersionId) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExampleScenarioInstanceVersion as FHIR XML
+func (r ExampleScenarioInstanceVersion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "versionId", r.VersionId, r.VersionIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExampleScenarioInstanceVersion from FHIR XML
+func (r *ExampleScenarioInstanceVersion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "versionId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.VersionId = v
+				}
+				r.VersionIdElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = v
+				}
+				r.DescriptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExampleScenarioInstanceVersion as properties to the RDF node n
+func (r ExampleScenarioInstanceVersion) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExampleScenario.instance.version.versionId", -1, r.VersionId, r.VersionIdElement, "string")
+	n.primitive("ExampleScenario.instance.version.description", -1, r.Description, r.DescriptionElement, "string")
+}
+
+// MarshalBSON marshals the given ExampleScenarioInstanceVersion as BSON document with the structure of its FHIR JSON
+func (r ExampleScenarioInstanceVersion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExampleScenarioInstanceVersion from a BSON document with the structure of its FHIR JSON
+func (r *ExampleScenarioInstanceVersion) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExampleScenarioInstanceVersion as protobuf message ExampleScenarioInstanceVersion of fhir.proto
+func (r ExampleScenarioInstanceVersion) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExampleScenarioInstanceVersion to the encoded message
+func (r ExampleScenarioInstanceVersion) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.VersionId != "" {
+		e.string(4, r.VersionId)
+	}
+	if r.Description != "" {
+		e.string(5, r.Description)
+	}
+	if r.VersionIdElement != nil {
+		e.message(6, *r.VersionIdElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(7, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExampleScenarioInstanceVersion from protobuf message ExampleScenarioInstanceVersion of fhir.proto
+func (r *ExampleScenarioInstanceVersion) UnmarshalProto(b []byte) error {
+	*r = ExampleScenarioInstanceVersion{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.VersionId = d.string()
+		case 5:
+			r.Description = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.VersionIdElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExampleScenarioInstanceContainedInstance as JSON into a byte slice
+func (r ExampleScenarioInstanceContainedInstance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExampleScenarioInstanceContainedInstance as JSON object with the members in the order of the fields
+func (r ExampleScenarioInstanceContainedInstance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("resourceId")
+	e.string(r.ResourceId)
+	if r.ResourceIdElement != nil {
+		e.key("_resourceId")
+		r.ResourceIdElement.appendJSON(e)
+	}
+	if r.VersionId != nil {
+		e.key("versionId")
+		e.string(*r.VersionId)
+	}
+	if r.VersionIdElement != nil {
+		e.key("_versionId")
+		r.VersionIdElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExampleScenarioInstanceContainedInstance from JSON. Unknown members are ignored.
+func (r *ExampleScenarioInstanceContainedInstance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExampleScenarioInstanceContainedInstance
+func (r *ExampleScenarioInstanceContainedInstance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "resourceId":
+			if v, ok := d.string(); ok {
+				r.ResourceId = v
+			}
+		case "_resourceId":
+			r.ResourceIdElement = d.element()
+		case "versionId":
+			if v, ok := d.string(); ok {
+				r.VersionId = &v
+			} else {
+				r.VersionId = nil
+			}
+		case "_versionId":
+			r.VersionIdElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExampleScenarioInstanceContainedInstance which shares no memory with the original
+func (r ExampleScenarioInstanceContainedInstance) DeepCopy() ExampleScenarioInstanceContainedInstance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ResourceIdElement != nil {
+		v := r.ResourceIdElement.DeepCopy()
+		out.ResourceIdElement = &v
+	}
+	if r.VersionId != nil {
+		v := *r.VersionId
+		out.VersionId = &v
+	}
+	if r.VersionIdElement != nil {
+		v := r.VersionIdElement.DeepCopy()
+		out.VersionIdElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExampleScenarioInstanceContainedInstance) Equal(other ExampleScenarioInstanceContainedInstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.ResourceId != other.ResourceId {
+		return false
+	}
+	if (r.ResourceIdElement == nil) != (other.ResourceIdElement == nil) || r.ResourceIdElement != nil && !r.ResourceIdElement.Equal(*other.ResourceIdElement) {
+		return false
+	}
+	if (r.VersionId == nil) != (other.VersionId == nil) || r.VersionId != nil && *r.VersionId != *other.VersionId {
+		return false
+	}
+	if (r.VersionIdElement == nil) != (other.VersionIdElement == nil) || r.VersionIdElement != nil && !r.VersionIdElement.Equal(*other.VersionIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExampleScenarioInstanceContainedInstance) EqualsDeep(other ExampleScenarioInstanceContainedInstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.ResourceId, other.ResourceId) {
+		return false
+	}
+	if (r.ResourceIdElement == nil) != (other.ResourceIdElement == nil) || r.ResourceIdElement != nil && !r.ResourceIdElement.EqualsDeep(*other.ResourceIdElement) {
+		return false
+	}
+	if (r.VersionId == nil) != (other.VersionId == nil) || r.VersionId != nil && !equivalentString(*r.VersionId, *other.VersionId) {
+		return false
+	}
+	if (r.VersionIdElement == nil) != (other.VersionIdElement == nil) || r.VersionIdElement != nil && !r.VersionIdElement.EqualsDeep(*other.VersionIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExampleScenarioInstanceContainedInstance) EqualsShallow(other ExampleScenarioInstanceContainedInstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.ResourceId, other.ResourceId) {
+		return false
+	}
+	if (r.VersionId == nil) != (other.VersionId == nil) || r.VersionId != nil && !equivalentString(*r.VersionId, *other.VersionId) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExampleScenarioInstanceContainedInstance as FHIR XML
+func (r ExampleScenarioInstanceContainedInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "resourceId", r.ResourceId, r.ResourceIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "versionId", r.VersionId, r.VersionIdElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExampleScenarioInstanceContainedInstance from FHIR XML
+func (r *ExampleScenarioInstanceContainedInstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "resourceId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ResourceId = v
+				}
+				r.ResourceIdElement = element
+			case "versionId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.VersionId = &v
+				}
+				r.VersionIdElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExampleScenarioInstanceContainedInstance as properties to the RDF node n
+func (r ExampleScenarioInstanceContainedInstance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExampleScenario.instance.containedInstance.resourceId", -1, r.ResourceId, r.ResourceIdElement, "string")
+	n.primitive("ExampleScenario.instance.containedInstance.versionId", -1, r.VersionId, r.VersionIdElement, "string")
+}
+
+// MarshalBSON marshals the given ExampleScenarioInstanceContainedInstance as BSON document with the structure of its FHIR JSON
+func (r ExampleScenarioInstanceContainedInstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExampleScenarioInstanceContainedInstance from a BSON document with the structure of its FHIR JSON
+func (r *ExampleScenarioInstanceContainedInstance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExampleScenarioInstanceContainedInstance as protobuf message ExampleScenarioInstanceContainedInstance of fhir.proto
+func (r ExampleScenarioInstanceContainedInstance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExampleScenarioInstanceContainedInstance to the encoded message
+func (r ExampleScenarioInstanceContainedInstance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ResourceId != "" {
+		e.string(4, r.ResourceId)
+	}
+	if r.VersionId != nil {
+		e.string(5, *r.VersionId)
+	}
+	if r.ResourceIdElement != nil {
+		e.message(6, *r.ResourceIdElement)
+	}
+	if r.VersionIdElement != nil {
+		e.message(7, *r.VersionIdElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExampleScenarioInstanceContainedInstance from protobuf message ExampleScenarioInstanceContainedInstance of fhir.proto
+func (r *ExampleScenarioInstanceContainedInstance) UnmarshalProto(b []byte) error {
+	*r = ExampleScenarioInstanceContainedInstance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.ResourceId = d.string()
+		case 5:
+			v := d.string()
+			r.VersionId = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.ResourceIdElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.VersionIdElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExampleScenarioProcess as JSON into a byte slice
+func (r ExampleScenarioProcess) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExampleScenarioProcess as JSON object with the members in the order of the fields
+func (r ExampleScenarioProcess) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("title")
+	e.string(r.Title)
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.PreConditions != nil {
+		e.key("preConditions")
+		e.string(*r.PreConditions)
+	}
+	if r.PreConditionsElement != nil {
+		e.key("_preConditions")
+		r.PreConditionsElement.appendJSON(e)
+	}
+	if r.PostConditions != nil {
+		e.key("postConditions")
+		e.string(*r.PostConditions)
+	}
+	if r.PostConditionsElement != nil {
+		e.key("_postConditions")
+		r.PostConditionsElement.appendJSON(e)
+	}
+	if len(r.Step) > 0 {
+		e.key("step")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Step {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExampleScenarioProcess from JSON. Unknown members are ignored.
+func (r *ExampleScenarioProcess) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExampleScenarioProcess
+func (r *ExampleScenarioProcess) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = v
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "preConditions":
+			if v, ok := d.string(); ok {
+				r.PreConditions = &v
+			} else {
+				r.PreConditions = nil
+			}
+		case "_preConditions":
+			r.PreConditionsElement = d.element()
+		case "postConditions":
+			if v, ok := d.string(); ok {
+				r.PostConditions = &v
+			} else {
+				r.PostConditions = nil
+			}
+		case "_postConditions":
+			r.PostConditionsElement = d.element()
+		case "step":
+			a := d.array()
+			r.Step = nil
+			for a.next() {
+				var v ExampleScenarioProcessStep
+				v.decodeJSON(d)
+				r.Step = append(r.Step, v)
+			}
+			if r.Step == nil && !a.isNull {
+				r.Step = []ExampleScenarioProcessStep{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExampleScenarioProcess which shares no memory with the original
+func (r ExampleScenarioProcess) DeepCopy() ExampleScenarioProcess {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.PreConditions != nil {
+		v := *r.PreConditions
+		out.PreConditions = &v
+	}
+	if r.PreConditionsElement != nil {
+		v := r.PreConditionsElement.DeepCopy()
+		out.PreConditionsElement = &v
+	}
+	if r.PostConditions != nil {
+		v := *r.PostConditions
+		out.PostConditions = &v
+	}
+	if r.PostConditionsElement != nil {
+		v := r.PostConditionsElement.DeepCopy()
+		out.PostConditionsElement = &v
+	}
+	if r.Step != nil {
+		out.Step = make([]ExampleScenarioProcessStep, len(r.Step))
+		for i := range r.Step {
+			out.Step[i] = r.Step[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExampleScenarioProcess) Equal(other ExampleScenarioProcess) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Title != other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.PreConditions == nil) != (other.PreConditions == nil) || r.PreConditions != nil && *r.PreConditions != *other.PreConditions {
+		return false
+	}
+	if (r.PreConditionsElement == nil) != (other.PreConditionsElement == nil) || r.PreConditionsElement != nil && !r.PreConditionsElement.Equal(*other.PreConditionsElement) {
+		return false
+	}
+	if (r.PostConditions == nil) != (other.PostConditions == nil) || r.PostConditions != nil && *r.PostConditions != *other.PostConditions {
+		return false
+	}
+	if (r.PostConditionsElement == nil) != (other.PostConditionsElement == nil) || r.PostConditionsElement != nil && !r.PostConditionsElement.Equal(*other.PostConditionsElement) {
+		return false
+	}
+	if len(r.Step) != len(other.Step) {
+		return false
+	}
+	for i := range r.Step {
+		if !r.Step[i].Equal(other.Step[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExampleScenarioProcess) EqualsDeep(other ExampleScenarioProcess) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Title, other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.PreConditions == nil) != (other.PreConditions == nil) || r.PreConditions != nil && !equivalentString(*r.PreConditions, *other.PreConditions) {
+		return false
+	}
+	if (r.PreConditionsElement == nil) != (other.PreConditionsElement == nil) || r.PreConditionsElement != nil && !r.PreConditionsElement.EqualsDeep(*other.PreConditionsElement) {
+		return false
+	}
+	if (r.PostConditions == nil) != (other.PostConditions == nil) || r.PostConditions != nil && !equivalentString(*r.PostConditions, *other.PostConditions) {
+		return false
+	}
+	if (r.PostConditionsElement == nil) != (other.PostConditionsElement == nil) || r.PostConditionsElement != nil && !r.PostConditionsElement.EqualsDeep(*other.PostConditionsElement) {
+		return false
+	}
+	if !equivalentList(len(r.Step), len(other.Step), func(i, j int) bool {
+		return r.Step[i].EqualsDeep(other.Step[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExampleScenarioProcess) EqualsShallow(other ExampleScenarioProcess) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Title, other.Title) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.PreConditions == nil) != (other.PreConditions == nil) || r.PreConditions != nil && !equivalentString(*r.PreConditions, *other.PreConditions) {
+		return false
+	}
+	if (r.PostConditions == nil) != (other.PostConditions == nil) || r.PostConditions != nil && !equivalentString(*r.PostConditions, *other.PostConditions) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExampleScenarioProcess as FHIR XML
+func (r ExampleScenarioProcess) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "preConditions", r.PreConditions, r.PreConditionsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "postConditions", r.PostConditions, r.PostConditionsElement); err != nil {
+		return err
+	}
+	for _, v := range r.Step {
+		if err := encodeXMLElement(e, "step", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExampleScenarioProcess from FHIR XML
+func (r *ExampleScenarioProcess) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = v
+				}
+				r.TitleElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "preConditions":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PreConditions = &v
+				}
+				r.PreConditionsElement = element
+			case "postConditions":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PostConditions = &v
+				}
+				r.PostConditionsElement = element
+			case "step":
+				var v ExampleScenarioProcessStep
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Step = append(r.Step, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExampleScenarioProcess as properties to the RDF node n
+func (r ExampleScenarioProcess) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExampleScenario.process.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("ExampleScenario.process.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("ExampleScenario.process.preConditions", -1, r.PreConditions, r.PreConditionsElement, "string")
+	n.primitive("ExampleScenario.process.postConditions", -1, r.PostConditions, r.PostConditionsElement, "string")
+	for i, v := range r.Step {
+		n.element("ExampleScenario.process.step", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExampleScenarioProcess as BSON document with the structure of its FHIR JSON
+func (r ExampleScenarioProcess) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExampleScenarioProcess from a BSON document with the structure of its FHIR JSON
+func (r *ExampleScenarioProcess) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExampleScenarioProcess as protobuf message ExampleScenarioProcess of fhir.proto
+func (r ExampleScenarioProcess) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExampleScenarioProcess to the encoded message
+func (r ExampleScenarioProcess) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Title != "" {
+		e.string(4, r.Title)
+	}
+	if r.Description != nil {
+		e.string(5, *r.Description)
+	}
+	if r.PreConditions != nil {
+		e.string(6, *r.PreConditions)
+	}
+	if r.PostConditions != nil {
+		e.string(7, *r.PostConditions)
+	}
+	for _, v := range r.Step {
+		e.message(8, v)
+	}
+	if r.TitleElement != nil {
+		e.message(9, *r.TitleElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(10, *r.DescriptionElement)
+	}
+	if r.PreConditionsElement != nil {
+		e.message(11, *r.PreConditionsElement)
+	}
+	if r.PostConditionsElement != nil {
+		e.message(12, *r.PostConditionsElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExampleScenarioProcess from protobuf message ExampleScenarioProcess of fhir.proto
+func (r *ExampleScenarioProcess) UnmarshalProto(b []byte) error {
+	*r = ExampleScenarioProcess{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Title = d.string()
+		case 5:
+			v := d.string()
+			r.Description = &v
+		case 6:
+			v := d.string()
+			r.PreConditions = &v
+		case 7:
+			v := d.string()
+			r.PostConditions = &v
+		case 8:
+			var v ExampleScenarioProcessStep
+			d.message(&v)
+			r.Step = append(r.Step, v)
+		case 9:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.PreConditionsElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.PostConditionsElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExampleScenarioProcessStep as JSON into a byte slice
+func (r ExampleScenarioProcessStep) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExampleScenarioProcessStep as JSON object with the members in the order of the fields
+func (r ExampleScenarioProcessStep) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Process) > 0 {
+		e.key("process")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Process {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Pause != nil {
+		e.key("pause")
+		e.bool(*r.Pause)
+	}
+	if r.PauseElement != nil {
+		e.key("_pause")
+		r.PauseElement.appendJSON(e)
+	}
+	if r.Operation != nil {
+		e.key("operation")
+		r.Operation.appendJSON(e)
+	}
+	if len(r.Alternative) > 0 {
+		e.key("alternative")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Alternative {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExampleScenarioProcessStep from JSON. Unknown members are ignored.
+func (r *ExampleScenarioProcessStep) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExampleScenarioProcessStep
+func (r *ExampleScenarioProcessStep) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "process":
+			a := d.array()
+			r.Process = nil
+			for a.next() {
+				var v ExampleScenarioProcess
+				v.decodeJSON(d)
+				r.Process = append(r.Process, v)
+			}
+			if r.Process == nil && !a.isNull {
+				r.Process = []ExampleScenarioProcess{}
+			}
+		case "pause":
+			if v, ok := d.bool(); ok {
+				r.Pause = &v
+			} else {
+				r.Pause = nil
+			}
+		case "_pause":
+			r.PauseElement = d.element()
+		case "operation":
+			if d.null() {
+				r.Operation = nil
+			} else {
+				var v ExampleScenarioProcessStepOperation
+				v.decodeJSON(d)
+				r.Operation = &v
+			}
+		case "alternative":
+			a := d.array()
+			r.Alternative = nil
+			for a.next() {
+				var v ExampleScenarioProcessStepAlternative
+				v.decodeJSON(d)
+				r.Alternative = append(r.Alternative, v)
+			}
+			if r.Alternative == nil && !a.isNull {
+				r.Alternative = []ExampleScenarioProcessStepAlternative{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExampleScenarioProcessStep which shares no memory with the original
+func (r ExampleScenarioProcessStep) DeepCopy() ExampleScenarioProcessStep {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Process != nil {
+		out.Process = make([]ExampleScenarioProcess, len(r.Process))
+		for i := range r.Process {
+			out.Process[i] = r.Process[i].DeepCopy()
+		}
+	}
+	if r.Pause != nil {
+		v := *r.Pause
+		out.Pause = &v
+	}
+	if r.PauseElement != nil {
+		v := r.PauseElement.DeepCopy()
+		out.PauseElement = &v
+	}
+	if r.Operation != nil {
+		v := r.Operation.DeepCopy()
+		out.Operation = &v
+	}
+	if r.Alternative != nil {
+		out.Alternative = make([]ExampleScenarioProcessStepAlternative, len(r.Alternative))
+		for i := range r.Alternative {
+			out.Alternative[i] = r.Alternative[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExampleScenarioProcessStep) Equal(other ExampleScenarioProcessStep) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Process) != len(other.Process) {
+		return false
+	}
+	for i := range r.Process {
+		if !r.Process[i].Equal(other.Process[i]) {
+			return false
+		}
+	}
+	if (r.Pause == nil) != (other.Pause == nil) || r.Pause != nil && *r.Pause != *other.Pause {
+		return false
+	}
+	if (r.PauseElement == nil) != (other.PauseElement == nil) || r.PauseElement != nil && !r.PauseElement.Equal(*other.PauseElement) {
+		return false
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.Equal(*other.Operation) {
+		return false
+	}
+	if len(r.Alternative) != len(other.Alternative) {
+		return false
+	}
+	for i := range r.Alternative {
+		if !r.Alternative[i].Equal(other.Alternative[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExampleScenarioProcessStep) EqualsDeep(other ExampleScenarioProcessStep) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Process), len(other.Process), func(i, j int) bool {
+		return r.Process[i].EqualsDeep(other.Process[j])
+	}) {
+		return false
+	}
+	if (r.Pause == nil) != (other.Pause == nil) || r.Pause != nil && *r.Pause != *other.Pause {
+		return false
+	}
+	if (r.PauseElement == nil) != (other.PauseElement == nil) || r.PauseElement != nil && !r.PauseElement.EqualsDeep(*other.PauseElement) {
+		return false
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.EqualsDeep(*other.Operation) {
+		return false
+	}
+	if !equivalentList(len(r.Alternative), len(other.Alternative), func(i, j int) bool {
+		return r.Alternative[i].EqualsDeep(other.Alternative[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExampleScenarioProcessStep) EqualsShallow(other ExampleScenarioProcessStep) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Pause == nil) != (other.Pause == nil) || r.Pause != nil && *r.Pause != *other.Pause {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExampleScenarioProcessStep as FHIR XML
+func (r ExampleScenarioProcessStep) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Process {
+		if err := encodeXMLElement(e, "process", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "pause", r.Pause, r.PauseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "operation", r.Operation); err != nil {
+		return err
+	}
+	for _, v := range r.Alternative {
+		if err := encodeXMLElement(e, "alternative", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExampleScenarioProcessStep from FHIR XML
+func (r *ExampleScenarioProcessStep) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "process":
+				var v ExampleScenarioProcess
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Process = append(r.Process, v)
+			case "pause":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Pause = &v
+				}
+				r.PauseElement = element
+			case "operation":
+				var v ExampleScenarioProcessStepOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operation = &v
+			case "alternative":
+				var v ExampleScenarioProcessStepAlternative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Alternative = append(r.Alternative, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExampleScenarioProcessStep as properties to the RDF node n
+func (r ExampleScenarioProcessStep) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Process {
+		n.element("ExampleScenario.process.step.process", i, v)
+	}
+	n.primitive("ExampleScenario.process.step.pause", -1, r.Pause, r.PauseElement, "boolean")
+	n.element("ExampleScenario.process.step.operation", -1, r.Operation)
+	for i, v := range r.Alternative {
+		n.element("ExampleScenario.process.step.alternative", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExampleScenarioProcessStep as BSON document with the structure of its FHIR JSON
+func (r ExampleScenarioProcessStep) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExampleScenarioProcessStep from a BSON document with the structure of its FHIR JSON
+func (r *ExampleScenarioProcessStep) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExampleScenarioProcessStep as protobuf message ExampleScenarioProcessStep of fhir.proto
+func (r ExampleScenarioProcessStep) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExampleScenarioProcessStep to the encoded message
+func (r ExampleScenarioProcessStep) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Process {
+		e.message(4, v)
+	}
+	if r.Pause != nil {
+		e.varint(5, protoBool(*r.Pause))
+	}
+	if r.Operation != nil {
+		e.message(6, *r.Operation)
+	}
+	for _, v := range r.Alternative {
+		e.message(7, v)
+	}
+	if r.PauseElement != nil {
+		e.message(8, *r.PauseElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExampleScenarioProcessStep from protobuf message ExampleScenarioProcessStep of fhir.proto
+func (r *ExampleScenarioProcessStep) UnmarshalProto(b []byte) error {
+	*r = ExampleScenarioProcessStep{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ExampleScenarioProcess
+			d.message(&v)
+			r.Process = append(r.Process, v)
+		case 5:
+			v := d.bool()
+			r.Pause = &v
+		case 6:
+			var v ExampleScenarioProcessStepOperation
+			d.message(&v)
+			r.Operation = &v
+		case 7:
+			var v ExampleScenarioProcessStepAlternative
+			d.message(&v)
+			r.Alternative = append(r.Alternative, v)
+		case 8:
+			var v Element
+			d.message(&v)
+			r.PauseElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExampleScenarioProcessStepOperation as JSON into a byte slice
+func (r ExampleScenarioProcessStepOperation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExampleScenarioProcessStepOperation as JSON object with the members in the order of the fields
+func (r ExampleScenarioProcessStepOperation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("number")
+	e.string(r.Number)
+	if r.NumberElement != nil {
+		e.key("_number")
+		r.NumberElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(*r.Type)
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Initiator != nil {
+		e.key("initiator")
+		e.string(*r.Initiator)
+	}
+	if r.InitiatorElement != nil {
+		e.key("_initiator")
+		r.InitiatorElement.appendJSON(e)
+	}
+	if r.Receiver != nil {
+		e.key("receiver")
+		e.string(*r.Receiver)
+	}
+	if r.ReceiverElement != nil {
+		e.key("_receiver")
+		r.ReceiverElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.InitiatorActive != nil {
+		e.key("initiatorActive")
+		e.bool(*r.InitiatorActive)
+	}
+	if r.InitiatorActiveElement != nil {
+		e.key("_initiatorActive")
+		r.InitiatorActiveElement.appendJSON(e)
+	}
+	if r.ReceiverActive != nil {
+		e.key("receiverActive")
+		e.bool(*r.ReceiverActive)
+	}
+	if r.ReceiverActiveElement != nil {
+		e.key("_receiverActive")
+		r.ReceiverActiveElement.appendJSON(e)
+	}
+	if r.Request != nil {
+		e.key("request")
+		r.Request.appendJSON(e)
+	}
+	if r.Response != nil {
+		e.key("response")
+		r.Response.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExampleScenarioProcessStepOperation from JSON. Unknown members are ignored.
+func (r *ExampleScenarioProcessStepOperation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExampleScenarioProcessStepOperation
+func (r *ExampleScenarioProcessStepOperation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "number":
+			if v, ok := d.string(); ok {
+				r.Number = v
+			}
+		case "_number":
+			r.NumberElement = d.element()
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = &v
+			} else {
+				r.Type = nil
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "initiator":
+			if v, ok := d.string(); ok {
+				r.Initiator = &v
+			} else {
+				r.Initiator = nil
+			}
+		case "_initiator":
+			r.InitiatorElement = d.element()
+		case "receiver":
+			if v, ok := d.string(); ok {
+				r.Receiver = &v
+			} else {
+				r.Receiver = nil
+			}
+		case "_receiver":
+			r.ReceiverElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "initiatorActive":
+			if v, ok := d.bool(); ok {
+				r.InitiatorActive = &v
+			} else {
+				r.InitiatorActive = nil
+			}
+		case "_initiatorActive":
+			r.InitiatorActiveElement = d.element()
+		case "receiverActive":
+			if v, ok := d.bool(); ok {
+				r.ReceiverActive = &v
+			} else {
+				r.ReceiverActive = nil
+			}
+		case "_receiverActive":
+			r.ReceiverActiveElement = d.element()
+		case "request":
+			if d.null() {
+				r.Request = nil
+			} else {
+				var v ExampleScenarioInstanceContainedInstance
+				v.decodeJSON(d)
+				r.Request = &v
+			}
+		case "response":
+			if d.null() {
+				r.Response = nil
+			} else {
+				var v ExampleScenarioInstanceContainedInstance
+				v.decodeJSON(d)
+				r.Response = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExampleScenarioProcessStepOperation which shares no memory with the original
+func (r ExampleScenarioProcessStepOperation) DeepCopy() ExampleScenarioProcessStepOperation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NumberElement != nil {
+		v := r.NumberElement.DeepCopy()
+		out.NumberElement = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Initiator != nil {
+		v := *r.Initiator
+		out.Initiator = &v
+	}
+	if r.InitiatorElement != nil {
+		v := r.InitiatorElement.DeepCopy()
+		out.InitiatorElement = &v
+	}
+	if r.Receiver != nil {
+		v := *r.Receiver
+		out.Receiver = &v
+	}
+	if r.ReceiverElement != nil {
+		v := r.ReceiverElement.DeepCopy()
+		out.ReceiverElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.InitiatorActive != nil {
+		v := *r.InitiatorActive
+		out.InitiatorActive = &v
+	}
+	if r.InitiatorActiveElement != nil {
+		v := r.InitiatorActiveElement.DeepCopy()
+		out.InitiatorActiveElement = &v
+	}
+	if r.ReceiverActive != nil {
+		v := *r.ReceiverActive
+		out.ReceiverActive = &v
+	}
+	if r.ReceiverActiveElement != nil {
+		v := r.ReceiverActiveElement.DeepCopy()
+		out.ReceiverActiveElement = &v
+	}
+	if r.Request != nil {
+		v := r.Request.DeepCopy()
+		out.Request = &v
+	}
+	if r.Response != nil {
+		v := r.Response.DeepCopy()
+		out.Response = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExampleScenarioProcessStepOperation) Equal(other ExampleScenarioProcessStepOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Number != other.Number {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.Equal(*other.NumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Initiator == nil) != (other.Initiator == nil) || r.Initiator != nil && *r.Initiator != *other.Initiator {
+		return false
+	}
+	if (r.InitiatorElement == nil) != (other.InitiatorElement == nil) || r.InitiatorElement != nil && !r.InitiatorElement.Equal(*other.InitiatorElement) {
+		return false
+	}
+	if (r.Receiver == nil) != (other.Receiver == nil) || r.Receiver != nil && *r.Receiver != *other.Receiver {
+		return false
+	}
+	if (r.ReceiverElement == nil) != (other.ReceiverElement == nil) || r.ReceiverElement != nil && !r.ReceiverElement.Equal(*other.ReceiverElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.InitiatorActive == nil) != (other.InitiatorActive == nil) || r.InitiatorActive != nil && *r.InitiatorActive != *other.InitiatorActive {
+		return false
+	}
+	if (r.InitiatorActiveElement == nil) != (other.InitiatorActiveElement == nil) || r.InitiatorActiveElement != nil && !r.InitiatorActiveElement.Equal(*other.InitiatorActiveElement) {
+		return false
+	}
+	if (r.ReceiverActive == nil) != (other.ReceiverActive == nil) || r.ReceiverActive != nil && *r.ReceiverActive != *other.ReceiverActive {
+		return false
+	}
+	if (r.ReceiverActiveElement == nil) != (other.ReceiverActiveElement == nil) || r.ReceiverActiveElement != nil && !r.ReceiverActiveElement.Equal(*other.ReceiverActiveElement) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.Equal(*other.Request) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.Equal(*other.Response) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExampleScenarioProcessStepOperation) EqualsDeep(other ExampleScenarioProcessStepOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Number, other.Number) {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.EqualsDeep(*other.NumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Initiator == nil) != (other.Initiator == nil) || r.Initiator != nil && !equivalentString(*r.Initiator, *other.Initiator) {
+		return false
+	}
+	if (r.InitiatorElement == nil) != (other.InitiatorElement == nil) || r.InitiatorElement != nil && !r.InitiatorElement.EqualsDeep(*other.InitiatorElement) {
+		return false
+	}
+	if (r.Receiver == nil) != (other.Receiver == nil) || r.Receiver != nil && !equivalentString(*r.Receiver, *other.Receiver) {
+		return false
+	}
+	if (r.ReceiverElement == nil) != (other.ReceiverElement == nil) || r.ReceiverElement != nil && !r.ReceiverElement.EqualsDeep(*other.ReceiverElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.InitiatorActive == nil) != (other.InitiatorActive == nil) || r.InitiatorActive != nil && *r.InitiatorActive != *other.InitiatorActive {
+		return false
+	}
+	if (r.InitiatorActiveElement == nil) != (other.InitiatorActiveElement == nil) || r.InitiatorActiveElement != nil && !r.InitiatorActiveElement.EqualsDeep(*other.InitiatorActiveElement) {
+		return false
+	}
+	if (r.ReceiverActive == nil) != (other.ReceiverActive == nil) || r.ReceiverActive != nil && *r.ReceiverActive != *other.ReceiverActive {
+		return false
+	}
+	if (r.ReceiverActiveElement == nil) != (other.ReceiverActiveElement == nil) || r.ReceiverActiveElement != nil && !r.ReceiverActiveElement.EqualsDeep(*other.ReceiverActiveElement) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.EqualsDeep(*other.Request) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.EqualsDeep(*other.Response) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExampleScenarioProcessStepOperation) EqualsShallow(other ExampleScenarioProcessStepOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Number, other.Number) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Initiator == nil) != (other.Initiator == nil) || r.Initiator != nil && !equivalentString(*r.Initiator, *other.Initiator) {
+		return false
+	}
+	if (r.Receiver == nil) != (other.Receiver == nil) || r.Receiver != nil && !equivalentString(*r.Receiver, *other.Receiver) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.InitiatorActive == nil) != (other.InitiatorActive == nil) || r.InitiatorActive != nil && *r.InitiatorActive != *other.InitiatorActive {
+		return false
+	}
+	if (r.ReceiverActive == nil) != (other.ReceiverActive == nil) || r.ReceiverActive != nil && *r.ReceiverActive != *other.ReceiverActive {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExampleScenarioProcessStepOperation as FHIR XML
+func (r ExampleScenarioProcessStepOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "number", r.Number, r.NumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "initiator", r.Initiator, r.InitiatorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "receiver", r.Receiver, r.ReceiverElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "initiatorActive", r.InitiatorActive, r.InitiatorActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "receiverActive", r.ReceiverActive, r.ReceiverActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "request", r.Request); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "response", r.Response); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExampleScenarioProcessStepOperation from FHIR XML
+func (r *ExampleScenarioProcessStepOperation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "number":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Number = v
+				}
+				r.NumberElement = element
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "initiator":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Initiator = &v
+				}
+				r.InitiatorElement = element
+			case "receiver":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Receiver = &v
+				}
+				r.ReceiverElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "initiatorActive":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.InitiatorActive = &v
+				}
+				r.InitiatorActiveElement = element
+			case "receiverActive":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReceiverActive = &v
+				}
+				r.ReceiverActiveElement = element
+			case "request":
+				var v ExampleScenarioInstanceContainedInstance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = &v
+			case "response":
+				var v ExampleScenarioInstanceContainedInstance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Response = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExampleScenarioProcessStepOperation as properties to the RDF node n
+func (r ExampleScenarioProcessStepOperation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExampleScenario.process.step.operation.number", -1, r.Number, r.NumberElement, "string")
+	n.primitive("ExampleScenario.process.step.operation.type", -1, r.Type, r.TypeElement, "string")
+	n.primitive("ExampleScenario.process.step.operation.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ExampleScenario.process.step.operation.initiator", -1, r.Initiator, r.InitiatorElement, "string")
+	n.primitive("ExampleScenario.process.step.operation.receiver", -1, r.Receiver, r.ReceiverElement, "string")
+	n.primitive("ExampleScenario.process.step.operation.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("ExampleScenario.process.step.operation.initiatorActive", -1, r.InitiatorActive, r.InitiatorActiveElement, "boolean")
+	n.primitive("ExampleScenario.process.step.operation.receiverActive", -1, r.ReceiverActive, r.ReceiverActiveElement, "boolean")
+	n.element("ExampleScenario.process.step.operation.request", -1, r.Request)
+	n.element("ExampleScenario.process.step.operation.response", -1, r.Response)
+}
+
+// MarshalBSON marshals the given ExampleScenarioProcessStepOperation as BSON document with the structure of its FHIR JSON
+func (r ExampleScenarioProcessStepOperation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExampleScenarioProcessStepOperation from a BSON document with the structure of its FHIR JSON
+func (r *ExampleScenarioProcessStepOperation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExampleScenarioProcessStepOperation as protobuf message ExampleScenarioProcessStepOperation of fhir.proto
+func (r ExampleScenarioProcessStepOperation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExampleScenarioProcessStepOperation to the encoded message
+func (r ExampleScenarioProcessStepOperation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Number != "" {
+		e.string(4, r.Number)
+	}
+	if r.Type != nil {
+		e.string(5, *r.Type)
+	}
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.Initiator != nil {
+		e.string(7, *r.Initiator)
+	}
+	if r.Receiver != nil {
+		e.string(8, *r.Receiver)
+	}
+	if r.Description != nil {
+		e.string(9, *r.Description)
+	}
+	if r.InitiatorActive != nil {
+		e.varint(10, protoBool(*r.InitiatorActive))
+	}
+	if r.ReceiverActive != nil {
+		e.varint(11, protoBool(*r.ReceiverActive))
+	}
+	if r.Request != nil {
+		e.message(12, *r.Request)
+	}
+	if r.Response != nil {
+		e.message(13, *r.Response)
+	}
+	if r.NumberElement != nil {
+		e.message(14, *r.NumberElement)
+	}
+	if r.TypeElement != nil {
+		e.message(15, *r.TypeElement)
+	}
+	if r.NameElement != nil {
+		e.message(16, *r.NameElement)
+	}
+	if r.InitiatorElement != nil {
+		e.message(17, *r.InitiatorElement)
+	}
+	if r.ReceiverElement != nil {
+		e.message(18, *r.ReceiverElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(19, *r.DescriptionElement)
+	}
+	if r.InitiatorActiveElement != nil {
+		e.message(20, *r.InitiatorActiveElement)
+	}
+	if r.ReceiverActiveElement != nil {
+		e.message(21, *r.ReceiverActiveElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExampleScenarioProcessStepOperation from protobuf message ExampleScenarioProcessStepOperation of fhir.proto
+func (r *ExampleScenarioProcessStepOperation) UnmarshalProto(b []byte) error {
+	*r = ExampleScenarioProcessStepOperation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Number = d.string()
+		case 5:
+			v := d.string()
+			r.Type = &v
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			v := d.string()
+			r.Initiator = &v
+		case 8:
+			v := d.string()
+			r.Receiver = &v
+		case 9:
+			v := d.string()
+			r.Description = &v
+		case 10:
+			v := d.bool()
+			r.InitiatorActive = &v
+		case 11:
+			v := d.bool()
+			r.ReceiverActive = &v
+		case 12:
+			var v ExampleScenarioInstanceContainedInstance
+			d.message(&v)
+			r.Request = &v
+		case 13:
+			var v ExampleScenarioInstanceContainedInstance
+			d.message(&v)
+			r.Response = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.NumberElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.InitiatorElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ReceiverElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.InitiatorActiveElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ReceiverActiveElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExampleScenarioProcessStepAlternative as JSON into a byte slice
+func (r ExampleScenarioProcessStepAlternative) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExampleScenarioProcessStepAlternative as JSON object with the members in the order of the fields
+func (r ExampleScenarioProcessStepAlternative) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("title")
+	e.string(r.Title)
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Step) > 0 {
+		e.key("step")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Step {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExampleScenarioProcessStepAlternative from JSON. Unknown members are ignored.
+func (r *ExampleScenarioProcessStepAlternative) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExampleScenarioProcessStepAlternative
+func (r *ExampleScenarioProcessStepAlternative) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = v
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "step":
+			a := d.array()
+			r.Step = nil
+			for a.next() {
+				var v ExampleScenarioProcessStep
+				v.decodeJSON(d)
+				r.Step = append(r.Step, v)
+			}
+			if r.Step == nil && !a.isNull {
+				r.Step = []ExampleScenarioProcessStep{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExampleScenarioProcessStepAlternative which shares no memory with the original
+func (r ExampleScenarioProcessStepAlternative) DeepCopy() ExampleScenarioProcessStepAlternative {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Step != nil {
+		out.Step = make([]ExampleScenarioProcessStep, len(r.Step))
+		for i := range r.Step {
+			out.Step[i] = r.Step[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExampleScenarioProcessStepAlternative) Equal(other ExampleScenarioProcessStepAlternative) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Title != other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Step) != len(other.Step) {
+		return false
+	}
+	for i := range r.Step {
+		if !r.Step[i].Equal(other.Step[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExampleScenarioProcessStepAlternative) EqualsDeep(other ExampleScenarioProcessStepAlternative) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Title, other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Step), len(other.Step), func(i, j int) bool {
+		return r.Step[i].EqualsDeep(other.Step[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExampleScenarioProcessStepAlternative) EqualsShallow(other ExampleScenarioProcessStepAlternative) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Title, other.Title) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExampleScenarioProcessStepAlternative as FHIR XML
+func (r ExampleScenarioProcessStepAlternative) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Step {
+		if err := encodeXMLElement(e, "step", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExampleScenarioProcessStepAlternative from FHIR XML
+func (r *ExampleScenarioProcessStepAlternative) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = v
+				}
+				r.TitleElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "step":
+				var v ExampleScenarioProcessStep
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Step = append(r.Step, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExampleScenarioProcessStepAlternative as properties to the RDF node n
+func (r ExampleScenarioProcessStepAlternative) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExampleScenario.process.step.alternative.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("ExampleScenario.process.step.alternative.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Step {
+		n.element("ExampleScenario.process.step.alternative.step", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExampleScenarioProcessStepAlternative as BSON document with the structure of its FHIR JSON
+func (r ExampleScenarioProcessStepAlternative) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExampleScenarioProcessStepAlternative from a BSON document with the structure of its FHIR JSON
+func (r *ExampleScenarioProcessStepAlternative) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExampleScenarioProcessStepAlternative as protobuf message ExampleScenarioProcessStepAlternative of fhir.proto
+func (r ExampleScenarioProcessStepAlternative) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExampleScenarioProcessStepAlternative to the encoded message
+func (r ExampleScenarioProcessStepAlternative) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Title != "" {
+		e.string(4, r.Title)
+	}
+	if r.Description != nil {
+		e.string(5, *r.Description)
+	}
+	for _, v := range r.Step {
+		e.message(6, v)
+	}
+	if r.TitleElement != nil {
+		e.message(7, *r.TitleElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(8, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExampleScenarioProcessStepAlternative from protobuf message ExampleScenarioProcessStepAlternative of fhir.proto
+func (r *ExampleScenarioProcessStepAlternative) UnmarshalProto(b []byte) error {
+	*r = ExampleScenarioProcessStepAlternative{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Title = d.string()
+		case 5:
+			v := d.string()
+			r.Description = &v
+		case 6:
+			var v ExampleScenarioProcessStep
+			d.message(&v)
+			r.Step = append(r.Step, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalExampleScenario unmarshals a ExampleScenario.
 func UnmarshalExampleScenario(b []byte) (ExampleScenario, error) {
 	var exampleScenario ExampleScenario
-	if err := json.Unmarshal(b, &exampleScenario); err != nil {
+	if err := exampleScenario.UnmarshalJSON(b); err != nil {
 		return exampleScenario, err
 	}
 	return exampleScenario, nil
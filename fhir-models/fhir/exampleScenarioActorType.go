@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code ExampleScenarioActorType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ExampleScenarioActorType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ExampleScenarioActorType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "person":
 		*code = ExampleScenarioActorTypePerson
@@ -46,6 +50,12 @@ func (code *ExampleScenarioActorType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ExampleScenarioActorType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ExampleScenarioActorType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ExampleScenarioActorType) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code ExampleScenarioActorType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ExampleScenarioActorType) protoNumber() uint64 {
+	switch code {
+	case ExampleScenarioActorTypePerson:
+		return 1
+	case ExampleScenarioActorTypeEntity:
+		return 2
+	}
+	return 0
+}
+func (code *ExampleScenarioActorType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ExampleScenarioActorTypePerson
+	case 2:
+		*code = ExampleScenarioActorTypeEntity
+	default:
+		return false
+	}
+	return true
+}
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,20 +25,27 @@ import "encoding/json"
 // ExplanationOfBenefit is documented here http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit
 type ExplanationOfBenefit struct {
 	Id                    *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                               `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                  *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                               `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage                      `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier            []Identifier                           `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status                ExplanationOfBenefitStatus             `bson:"status" json:"status"`
+	StatusElement         *Element                               `bson:"_status,omitempty" json:"_status,omitempty"`
 	Type                  CodeableConcept                        `bson:"type" json:"type"`
 	SubType               *CodeableConcept                       `bson:"subType,omitempty" json:"subType,omitempty"`
 	Use                   Use                                    `bson:"use" json:"use"`
+	UseElement            *Element                               `bson:"_use,omitempty" json:"_use,omitempty"`
 	Patient               Reference                              `bson:"patient" json:"patient"`
 	BillablePeriod        *Period                                `bson:"billablePeriod,omitempty" json:"billablePeriod,omitempty"`
 	Created               string                                 `bson:"created" json:"created"`
+	CreatedElement        *Element                               `bson:"_created,omitempty" json:"_created,omitempty"`
 	Enterer               *Reference                             `bson:"enterer,omitempty" json:"enterer,omitempty"`
 	Insurer               Reference                              `bson:"insurer" json:"insurer"`
 	Provider              Reference                              `bson:"provider" json:"provider"`
@@ -51,14 +61,18 @@ type ExplanationOfBenefit struct {
 	Claim                 *Reference                             `bson:"claim,omitempty" json:"claim,omitempty"`
 	ClaimResponse         *Reference                             `bson:"claimResponse,omitempty" json:"claimResponse,omitempty"`
 	Outcome               ClaimProcessingCodes                   `bson:"outcome" json:"outcome"`
+	OutcomeElement        *Element                               `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
 	Disposition           *string                                `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	DispositionElement    *Element                               `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
 	PreAuthRef            []string                               `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement     []*Element                             `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
 	PreAuthRefPeriod      []Period                               `bson:"preAuthRefPeriod,omitempty" json:"preAuthRefPeriod,omitempty"`
 	CareTeam              []ExplanationOfBenefitCareTeam         `bson:"careTeam,omitempty" json:"careTeam,omitempty"`
 	SupportingInfo        []ExplanationOfBenefitSupportingInfo   `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
 	Diagnosis             []ExplanationOfBenefitDiagnosis        `bson:"diagnosis,omitempty" json:"diagnosis,omitempty"`
 	Procedure             []ExplanationOfBenefitProcedure        `bson:"procedure,omitempty" json:"procedure,omitempty"`
 	Precedence            *int                                   `bson:"precedence,omitempty" json:"precedence,omitempty"`
+	PrecedenceElement     *Element                               `bson:"_precedence,omitempty" json:"_precedence,omitempty"`
 	Insurance             []ExplanationOfBenefitInsurance        `bson:"insurance" json:"insurance"`
 	Accident              *ExplanationOfBenefitAccident          `bson:"accident,omitempty" json:"accident,omitempty"`
 	Item                  []ExplanationOfBenefitItem             `bson:"item,omitempty" json:"item,omitempty"`
@@ -88,101 +102,411 @@ type ExplanationOfBenefitPayee struct {
 	Party             *Reference       `bson:"party,omitempty" json:"party,omitempty"`
 }
 type ExplanationOfBenefitCareTeam struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int              `bson:"sequence" json:"sequence"`
-	Provider          Reference        `bson:"provider" json:"provider"`
-	Responsible       *bool            `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Qualification     *CodeableConcept `bson:"qualification,omitempty" json:"qualification,omitempty"`
+	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence           int              `bson:"sequence" json:"sequence"`
+	SequenceElement    *Element         `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Provider           Reference        `bson:"provider" json:"provider"`
+	Responsible        *bool            `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	ResponsibleElement *Element         `bson:"_responsible,omitempty" json:"_responsible,omitempty"`
+	Role               *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
+	Qualification      *CodeableConcept `bson:"qualification,omitempty" json:"qualification,omitempty"`
 }
 type ExplanationOfBenefitSupportingInfo struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence          int              `bson:"sequence" json:"sequence"`
-	Category          CodeableConcept  `bson:"category" json:"category"`
-	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	TimingDate        *string          `bson:"timingDate,omitempty" json:"timingDate,omitempty"`
-	TimingPeriod      *Period          `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
-	ValueBoolean      *bool            `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
-	ValueString       *string          `bson:"valueString,omitempty" json:"valueString,omitempty"`
-	ValueQuantity     *Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
-	ValueAttachment   *Attachment      `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
-	ValueReference    *Reference       `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
-	Reason            *Coding          `bson:"reason,omitempty" json:"reason,omitempty"`
+	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence            int              `bson:"sequence" json:"sequence"`
+	SequenceElement     *Element         `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Category            CodeableConcept  `bson:"category" json:"category"`
+	Code                *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
+	TimingDate          *string          `bson:"timingDate,omitempty" json:"timingDate,omitempty"`
+	TimingDateElement   *Element         `bson:"_timingDate,omitempty" json:"_timingDate,omitempty"`
+	TimingPeriod        *Period          `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	ValueBoolean        *bool            `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement *Element         `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueString         *string          `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement  *Element         `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueQuantity       *Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueAttachment     *Attachment      `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueReference      *Reference       `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	Reason              *Coding          `bson:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// ExplanationOfBenefitSupportingInfoTiming is implemented by the types allowed for ExplanationOfBenefit.supportingInfo.timing[x]
+type ExplanationOfBenefitSupportingInfoTiming interface {
+	isExplanationOfBenefitSupportingInfoTiming()
+}
+
+func (Date) isExplanationOfBenefitSupportingInfoTiming()   {}
+func (Period) isExplanationOfBenefitSupportingInfoTiming() {}
+
+// Timing returns the value of ExplanationOfBenefit.supportingInfo.timing[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitSupportingInfo) Timing() (ExplanationOfBenefitSupportingInfoTiming, string) {
+	if r.TimingDate != nil {
+		return Date(*r.TimingDate), "date"
+	}
+	if r.TimingPeriod != nil {
+		return *r.TimingPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetTiming sets the value of ExplanationOfBenefit.supportingInfo.timing[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitSupportingInfo) SetTiming(value ExplanationOfBenefitSupportingInfoTiming) {
+	r.TimingDate = nil
+	r.TimingPeriod = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.TimingDate = &primitive
+	case *Date:
+		r.TimingDate = (*string)(v)
+	case Period:
+		r.TimingPeriod = &v
+	case *Period:
+		r.TimingPeriod = v
+	}
+}
+
+// ExplanationOfBenefitSupportingInfoValue is implemented by the types allowed for ExplanationOfBenefit.supportingInfo.value[x]
+type ExplanationOfBenefitSupportingInfoValue interface {
+	isExplanationOfBenefitSupportingInfoValue()
+}
+
+func (Boolean) isExplanationOfBenefitSupportingInfoValue()    {}
+func (String) isExplanationOfBenefitSupportingInfoValue()     {}
+func (Quantity) isExplanationOfBenefitSupportingInfoValue()   {}
+func (Attachment) isExplanationOfBenefitSupportingInfoValue() {}
+func (Reference) isExplanationOfBenefitSupportingInfoValue()  {}
+
+// Value returns the value of ExplanationOfBenefit.supportingInfo.value[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitSupportingInfo) Value() (ExplanationOfBenefitSupportingInfoValue, string) {
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueAttachment != nil {
+		return *r.ValueAttachment, "Attachment"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of ExplanationOfBenefit.supportingInfo.value[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitSupportingInfo) SetValue(value ExplanationOfBenefitSupportingInfoValue) {
+	r.ValueBoolean = nil
+	r.ValueString = nil
+	r.ValueQuantity = nil
+	r.ValueAttachment = nil
+	r.ValueReference = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Attachment:
+		r.ValueAttachment = &v
+	case *Attachment:
+		r.ValueAttachment = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	}
 }
+
 type ExplanationOfBenefitDiagnosis struct {
 	Id                       *string           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension                []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence                 int               `bson:"sequence" json:"sequence"`
-	DiagnosisCodeableConcept CodeableConcept   `bson:"diagnosisCodeableConcept" json:"diagnosisCodeableConcept"`
-	DiagnosisReference       Reference         `bson:"diagnosisReference" json:"diagnosisReference"`
+	SequenceElement          *Element          `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	DiagnosisCodeableConcept *CodeableConcept  `bson:"diagnosisCodeableConcept,omitempty" json:"diagnosisCodeableConcept,omitempty"`
+	DiagnosisReference       *Reference        `bson:"diagnosisReference,omitempty" json:"diagnosisReference,omitempty"`
 	Type                     []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	OnAdmission              *CodeableConcept  `bson:"onAdmission,omitempty" json:"onAdmission,omitempty"`
 	PackageCode              *CodeableConcept  `bson:"packageCode,omitempty" json:"packageCode,omitempty"`
 }
+
+// ExplanationOfBenefitDiagnosisDiagnosis is implemented by the types allowed for ExplanationOfBenefit.diagnosis.diagnosis[x]
+type ExplanationOfBenefitDiagnosisDiagnosis interface {
+	isExplanationOfBenefitDiagnosisDiagnosis()
+}
+
+func (CodeableConcept) isExplanationOfBenefitDiagnosisDiagnosis() {}
+func (Reference) isExplanationOfBenefitDiagnosisDiagnosis()       {}
+
+// Diagnosis returns the value of ExplanationOfBenefit.diagnosis.diagnosis[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitDiagnosis) Diagnosis() (ExplanationOfBenefitDiagnosisDiagnosis, string) {
+	if r.DiagnosisCodeableConcept != nil {
+		return *r.DiagnosisCodeableConcept, "CodeableConcept"
+	}
+	if r.DiagnosisReference != nil {
+		return *r.DiagnosisReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetDiagnosis sets the value of ExplanationOfBenefit.diagnosis.diagnosis[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitDiagnosis) SetDiagnosis(value ExplanationOfBenefitDiagnosisDiagnosis) {
+	r.DiagnosisCodeableConcept = nil
+	r.DiagnosisReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.DiagnosisCodeableConcept = &v
+	case *CodeableConcept:
+		r.DiagnosisCodeableConcept = v
+	case Reference:
+		r.DiagnosisReference = &v
+	case *Reference:
+		r.DiagnosisReference = v
+	}
+}
+
 type ExplanationOfBenefitProcedure struct {
 	Id                       *string           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension                []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence                 int               `bson:"sequence" json:"sequence"`
+	SequenceElement          *Element          `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Type                     []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	Date                     *string           `bson:"date,omitempty" json:"date,omitempty"`
-	ProcedureCodeableConcept CodeableConcept   `bson:"procedureCodeableConcept" json:"procedureCodeableConcept"`
-	ProcedureReference       Reference         `bson:"procedureReference" json:"procedureReference"`
+	DateElement              *Element          `bson:"_date,omitempty" json:"_date,omitempty"`
+	ProcedureCodeableConcept *CodeableConcept  `bson:"procedureCodeableConcept,omitempty" json:"procedureCodeableConcept,omitempty"`
+	ProcedureReference       *Reference        `bson:"procedureReference,omitempty" json:"procedureReference,omitempty"`
 	Udi                      []Reference       `bson:"udi,omitempty" json:"udi,omitempty"`
 }
+
+// ExplanationOfBenefitProcedureProcedure is implemented by the types allowed for ExplanationOfBenefit.procedure.procedure[x]
+type ExplanationOfBenefitProcedureProcedure interface {
+	isExplanationOfBenefitProcedureProcedure()
+}
+
+func (CodeableConcept) isExplanationOfBenefitProcedureProcedure() {}
+func (Reference) isExplanationOfBenefitProcedureProcedure()       {}
+
+// Procedure returns the value of ExplanationOfBenefit.procedure.procedure[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitProcedure) Procedure() (ExplanationOfBenefitProcedureProcedure, string) {
+	if r.ProcedureCodeableConcept != nil {
+		return *r.ProcedureCodeableConcept, "CodeableConcept"
+	}
+	if r.ProcedureReference != nil {
+		return *r.ProcedureReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetProcedure sets the value of ExplanationOfBenefit.procedure.procedure[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitProcedure) SetProcedure(value ExplanationOfBenefitProcedureProcedure) {
+	r.ProcedureCodeableConcept = nil
+	r.ProcedureReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ProcedureCodeableConcept = &v
+	case *CodeableConcept:
+		r.ProcedureCodeableConcept = v
+	case Reference:
+		r.ProcedureReference = &v
+	case *Reference:
+		r.ProcedureReference = v
+	}
+}
+
 type ExplanationOfBenefitInsurance struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Focal             bool        `bson:"focal" json:"focal"`
+	FocalElement      *Element    `bson:"_focal,omitempty" json:"_focal,omitempty"`
 	Coverage          Reference   `bson:"coverage" json:"coverage"`
 	PreAuthRef        []string    `bson:"preAuthRef,omitempty" json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*Element  `bson:"_preAuthRef,omitempty" json:"_preAuthRef,omitempty"`
 }
 type ExplanationOfBenefitAccident struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Date              *string          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element         `bson:"_date,omitempty" json:"_date,omitempty"`
 	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	LocationAddress   *Address         `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
 	LocationReference *Reference       `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
 }
+
+// ExplanationOfBenefitAccidentLocation is implemented by the types allowed for ExplanationOfBenefit.accident.location[x]
+type ExplanationOfBenefitAccidentLocation interface {
+	isExplanationOfBenefitAccidentLocation()
+}
+
+func (Address) isExplanationOfBenefitAccidentLocation()   {}
+func (Reference) isExplanationOfBenefitAccidentLocation() {}
+
+// Location returns the value of ExplanationOfBenefit.accident.location[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitAccident) Location() (ExplanationOfBenefitAccidentLocation, string) {
+	if r.LocationAddress != nil {
+		return *r.LocationAddress, "Address"
+	}
+	if r.LocationReference != nil {
+		return *r.LocationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetLocation sets the value of ExplanationOfBenefit.accident.location[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitAccident) SetLocation(value ExplanationOfBenefitAccidentLocation) {
+	r.LocationAddress = nil
+	r.LocationReference = nil
+	switch v := value.(type) {
+	case Address:
+		r.LocationAddress = &v
+	case *Address:
+		r.LocationAddress = v
+	case Reference:
+		r.LocationReference = &v
+	case *Reference:
+		r.LocationReference = v
+	}
+}
+
 type ExplanationOfBenefitItem struct {
-	Id                      *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Sequence                int                                    `bson:"sequence" json:"sequence"`
-	CareTeamSequence        []int                                  `bson:"careTeamSequence,omitempty" json:"careTeamSequence,omitempty"`
-	DiagnosisSequence       []int                                  `bson:"diagnosisSequence,omitempty" json:"diagnosisSequence,omitempty"`
-	ProcedureSequence       []int                                  `bson:"procedureSequence,omitempty" json:"procedureSequence,omitempty"`
-	InformationSequence     []int                                  `bson:"informationSequence,omitempty" json:"informationSequence,omitempty"`
-	Revenue                 *CodeableConcept                       `bson:"revenue,omitempty" json:"revenue,omitempty"`
-	Category                *CodeableConcept                       `bson:"category,omitempty" json:"category,omitempty"`
-	ProductOrService        CodeableConcept                        `bson:"productOrService" json:"productOrService"`
-	Modifier                []CodeableConcept                      `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	ProgramCode             []CodeableConcept                      `bson:"programCode,omitempty" json:"programCode,omitempty"`
-	ServicedDate            *string                                `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
-	ServicedPeriod          *Period                                `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
-	LocationCodeableConcept *CodeableConcept                       `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
-	LocationAddress         *Address                               `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
-	LocationReference       *Reference                             `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
-	Quantity                *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice               *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor                  *json.Number                           `bson:"factor,omitempty" json:"factor,omitempty"`
-	Net                     *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
-	Udi                     []Reference                            `bson:"udi,omitempty" json:"udi,omitempty"`
-	BodySite                *CodeableConcept                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	SubSite                 []CodeableConcept                      `bson:"subSite,omitempty" json:"subSite,omitempty"`
-	Encounter               []Reference                            `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	NoteNumber              []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication            []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
-	Detail                  []ExplanationOfBenefitItemDetail       `bson:"detail,omitempty" json:"detail,omitempty"`
+	Id                         *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Sequence                   int                                    `bson:"sequence" json:"sequence"`
+	SequenceElement            *Element                               `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	CareTeamSequence           []int                                  `bson:"careTeamSequence,omitempty" json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement    []*Element                             `bson:"_careTeamSequence,omitempty" json:"_careTeamSequence,omitempty"`
+	DiagnosisSequence          []int                                  `bson:"diagnosisSequence,omitempty" json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement   []*Element                             `bson:"_diagnosisSequence,omitempty" json:"_diagnosisSequence,omitempty"`
+	ProcedureSequence          []int                                  `bson:"procedureSequence,omitempty" json:"procedureSequence,omitempty"`
+	ProcedureSequenceElement   []*Element                             `bson:"_procedureSequence,omitempty" json:"_procedureSequence,omitempty"`
+	InformationSequence        []int                                  `bson:"informationSequence,omitempty" json:"informationSequence,omitempty"`
+	InformationSequenceElement []*Element                             `bson:"_informationSequence,omitempty" json:"_informationSequence,omitempty"`
+	Revenue                    *CodeableConcept                       `bson:"revenue,omitempty" json:"revenue,omitempty"`
+	Category                   *CodeableConcept                       `bson:"category,omitempty" json:"category,omitempty"`
+	ProductOrService           CodeableConcept                        `bson:"productOrService" json:"productOrService"`
+	Modifier                   []CodeableConcept                      `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ProgramCode                []CodeableConcept                      `bson:"programCode,omitempty" json:"programCode,omitempty"`
+	ServicedDate               *string                                `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement        *Element                               `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod             *Period                                `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept    *CodeableConcept                       `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
+	LocationAddress            *Address                               `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference          *Reference                             `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	Quantity                   *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                  *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                     *json.Number                           `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement              *Element                               `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Net                        *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
+	Udi                        []Reference                            `bson:"udi,omitempty" json:"udi,omitempty"`
+	BodySite                   *CodeableConcept                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	SubSite                    []CodeableConcept                      `bson:"subSite,omitempty" json:"subSite,omitempty"`
+	Encounter                  []Reference                            `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	NoteNumber                 []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement          []*Element                             `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication               []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	Detail                     []ExplanationOfBenefitItemDetail       `bson:"detail,omitempty" json:"detail,omitempty"`
 }
+
+// ExplanationOfBenefitItemServiced is implemented by the types allowed for ExplanationOfBenefit.item.serviced[x]
+type ExplanationOfBenefitItemServiced interface {
+	isExplanationOfBenefitItemServiced()
+}
+
+func (Date) isExplanationOfBenefitItemServiced()   {}
+func (Period) isExplanationOfBenefitItemServiced() {}
+
+// Serviced returns the value of ExplanationOfBenefit.item.serviced[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitItem) Serviced() (ExplanationOfBenefitItemServiced, string) {
+	if r.ServicedDate != nil {
+		return Date(*r.ServicedDate), "date"
+	}
+	if r.ServicedPeriod != nil {
+		return *r.ServicedPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetServiced sets the value of ExplanationOfBenefit.item.serviced[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitItem) SetServiced(value ExplanationOfBenefitItemServiced) {
+	r.ServicedDate = nil
+	r.ServicedPeriod = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.ServicedDate = &primitive
+	case *Date:
+		r.ServicedDate = (*string)(v)
+	case Period:
+		r.ServicedPeriod = &v
+	case *Period:
+		r.ServicedPeriod = v
+	}
+}
+
+// ExplanationOfBenefitItemLocation is implemented by the types allowed for ExplanationOfBenefit.item.location[x]
+type ExplanationOfBenefitItemLocation interface {
+	isExplanationOfBenefitItemLocation()
+}
+
+func (CodeableConcept) isExplanationOfBenefitItemLocation() {}
+func (Address) isExplanationOfBenefitItemLocation()         {}
+func (Reference) isExplanationOfBenefitItemLocation()       {}
+
+// Location returns the value of ExplanationOfBenefit.item.location[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitItem) Location() (ExplanationOfBenefitItemLocation, string) {
+	if r.LocationCodeableConcept != nil {
+		return *r.LocationCodeableConcept, "CodeableConcept"
+	}
+	if r.LocationAddress != nil {
+		return *r.LocationAddress, "Address"
+	}
+	if r.LocationReference != nil {
+		return *r.LocationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetLocation sets the value of ExplanationOfBenefit.item.location[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitItem) SetLocation(value ExplanationOfBenefitItemLocation) {
+	r.LocationCodeableConcept = nil
+	r.LocationAddress = nil
+	r.LocationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.LocationCodeableConcept = &v
+	case *CodeableConcept:
+		r.LocationCodeableConcept = v
+	case Address:
+		r.LocationAddress = &v
+	case *Address:
+		r.LocationAddress = v
+	case Reference:
+		r.LocationReference = &v
+	case *Reference:
+		r.LocationReference = v
+	}
+}
+
 type ExplanationOfBenefitItemAdjudication struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -191,12 +515,14 @@ type ExplanationOfBenefitItemAdjudication struct {
 	Reason            *CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
 	Amount            *Money           `bson:"amount,omitempty" json:"amount,omitempty"`
 	Value             *json.Number     `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement      *Element         `bson:"_value,omitempty" json:"_value,omitempty"`
 }
 type ExplanationOfBenefitItemDetail struct {
 	Id                *string                                   `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence          int                                       `bson:"sequence" json:"sequence"`
+	SequenceElement   *Element                                  `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Revenue           *CodeableConcept                          `bson:"revenue,omitempty" json:"revenue,omitempty"`
 	Category          *CodeableConcept                          `bson:"category,omitempty" json:"category,omitempty"`
 	ProductOrService  CodeableConcept                           `bson:"productOrService" json:"productOrService"`
@@ -205,9 +531,11 @@ type ExplanationOfBenefitItemDetail struct {
 	Quantity          *Quantity                                 `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                    `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *json.Number                              `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                                  `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                    `bson:"net,omitempty" json:"net,omitempty"`
 	Udi               []Reference                               `bson:"udi,omitempty" json:"udi,omitempty"`
 	NoteNumber        []int                                     `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                                `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ExplanationOfBenefitItemAdjudication    `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 	SubDetail         []ExplanationOfBenefitItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
 }
@@ -216,6 +544,7 @@ type ExplanationOfBenefitItemDetailSubDetail struct {
 	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence          int                                    `bson:"sequence" json:"sequence"`
+	SequenceElement   *Element                               `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Revenue           *CodeableConcept                       `bson:"revenue,omitempty" json:"revenue,omitempty"`
 	Category          *CodeableConcept                       `bson:"category,omitempty" json:"category,omitempty"`
 	ProductOrService  CodeableConcept                        `bson:"productOrService" json:"productOrService"`
@@ -224,37 +553,126 @@ type ExplanationOfBenefitItemDetailSubDetail struct {
 	Quantity          *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *json.Number                           `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                               `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
 	Udi               []Reference                            `bson:"udi,omitempty" json:"udi,omitempty"`
 	NoteNumber        []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                             `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 }
 type ExplanationOfBenefitAddItem struct {
-	Id                      *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemSequence            []int                                  `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
-	DetailSequence          []int                                  `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
-	SubDetailSequence       []int                                  `bson:"subDetailSequence,omitempty" json:"subDetailSequence,omitempty"`
-	Provider                []Reference                            `bson:"provider,omitempty" json:"provider,omitempty"`
-	ProductOrService        CodeableConcept                        `bson:"productOrService" json:"productOrService"`
-	Modifier                []CodeableConcept                      `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	ProgramCode             []CodeableConcept                      `bson:"programCode,omitempty" json:"programCode,omitempty"`
-	ServicedDate            *string                                `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
-	ServicedPeriod          *Period                                `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
-	LocationCodeableConcept *CodeableConcept                       `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
-	LocationAddress         *Address                               `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
-	LocationReference       *Reference                             `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
-	Quantity                *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	UnitPrice               *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
-	Factor                  *json.Number                           `bson:"factor,omitempty" json:"factor,omitempty"`
-	Net                     *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
-	BodySite                *CodeableConcept                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	SubSite                 []CodeableConcept                      `bson:"subSite,omitempty" json:"subSite,omitempty"`
-	NoteNumber              []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
-	Adjudication            []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
-	Detail                  []ExplanationOfBenefitAddItemDetail    `bson:"detail,omitempty" json:"detail,omitempty"`
+	Id                       *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemSequence             []int                                  `bson:"itemSequence,omitempty" json:"itemSequence,omitempty"`
+	ItemSequenceElement      []*Element                             `bson:"_itemSequence,omitempty" json:"_itemSequence,omitempty"`
+	DetailSequence           []int                                  `bson:"detailSequence,omitempty" json:"detailSequence,omitempty"`
+	DetailSequenceElement    []*Element                             `bson:"_detailSequence,omitempty" json:"_detailSequence,omitempty"`
+	SubDetailSequence        []int                                  `bson:"subDetailSequence,omitempty" json:"subDetailSequence,omitempty"`
+	SubDetailSequenceElement []*Element                             `bson:"_subDetailSequence,omitempty" json:"_subDetailSequence,omitempty"`
+	Provider                 []Reference                            `bson:"provider,omitempty" json:"provider,omitempty"`
+	ProductOrService         CodeableConcept                        `bson:"productOrService" json:"productOrService"`
+	Modifier                 []CodeableConcept                      `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ProgramCode              []CodeableConcept                      `bson:"programCode,omitempty" json:"programCode,omitempty"`
+	ServicedDate             *string                                `bson:"servicedDate,omitempty" json:"servicedDate,omitempty"`
+	ServicedDateElement      *Element                               `bson:"_servicedDate,omitempty" json:"_servicedDate,omitempty"`
+	ServicedPeriod           *Period                                `bson:"servicedPeriod,omitempty" json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept  *CodeableConcept                       `bson:"locationCodeableConcept,omitempty" json:"locationCodeableConcept,omitempty"`
+	LocationAddress          *Address                               `bson:"locationAddress,omitempty" json:"locationAddress,omitempty"`
+	LocationReference        *Reference                             `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	Quantity                 *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	UnitPrice                *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+	Factor                   *json.Number                           `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement            *Element                               `bson:"_factor,omitempty" json:"_factor,omitempty"`
+	Net                      *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
+	BodySite                 *CodeableConcept                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	SubSite                  []CodeableConcept                      `bson:"subSite,omitempty" json:"subSite,omitempty"`
+	NoteNumber               []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement        []*Element                             `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
+	Adjudication             []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
+	Detail                   []ExplanationOfBenefitAddItemDetail    `bson:"detail,omitempty" json:"detail,omitempty"`
+}
+
+// ExplanationOfBenefitAddItemServiced is implemented by the types allowed for ExplanationOfBenefit.addItem.serviced[x]
+type ExplanationOfBenefitAddItemServiced interface {
+	isExplanationOfBenefitAddItemServiced()
+}
+
+func (Date) isExplanationOfBenefitAddItemServiced()   {}
+func (Period) isExplanationOfBenefitAddItemServiced() {}
+
+// Serviced returns the value of ExplanationOfBenefit.addItem.serviced[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitAddItem) Serviced() (ExplanationOfBenefitAddItemServiced, string) {
+	if r.ServicedDate != nil {
+		return Date(*r.ServicedDate), "date"
+	}
+	if r.ServicedPeriod != nil {
+		return *r.ServicedPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetServiced sets the value of ExplanationOfBenefit.addItem.serviced[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitAddItem) SetServiced(value ExplanationOfBenefitAddItemServiced) {
+	r.ServicedDate = nil
+	r.ServicedPeriod = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.ServicedDate = &primitive
+	case *Date:
+		r.ServicedDate = (*string)(v)
+	case Period:
+		r.ServicedPeriod = &v
+	case *Period:
+		r.ServicedPeriod = v
+	}
 }
+
+// ExplanationOfBenefitAddItemLocation is implemented by the types allowed for ExplanationOfBenefit.addItem.location[x]
+type ExplanationOfBenefitAddItemLocation interface {
+	isExplanationOfBenefitAddItemLocation()
+}
+
+func (CodeableConcept) isExplanationOfBenefitAddItemLocation() {}
+func (Address) isExplanationOfBenefitAddItemLocation()         {}
+func (Reference) isExplanationOfBenefitAddItemLocation()       {}
+
+// Location returns the value of ExplanationOfBenefit.addItem.location[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitAddItem) Location() (ExplanationOfBenefitAddItemLocation, string) {
+	if r.LocationCodeableConcept != nil {
+		return *r.LocationCodeableConcept, "CodeableConcept"
+	}
+	if r.LocationAddress != nil {
+		return *r.LocationAddress, "Address"
+	}
+	if r.LocationReference != nil {
+		return *r.LocationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetLocation sets the value of ExplanationOfBenefit.addItem.location[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitAddItem) SetLocation(value ExplanationOfBenefitAddItemLocation) {
+	r.LocationCodeableConcept = nil
+	r.LocationAddress = nil
+	r.LocationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.LocationCodeableConcept = &v
+	case *CodeableConcept:
+		r.LocationCodeableConcept = v
+	case Address:
+		r.LocationAddress = &v
+	case *Address:
+		r.LocationAddress = v
+	case Reference:
+		r.LocationReference = &v
+	case *Reference:
+		r.LocationReference = v
+	}
+}
+
 type ExplanationOfBenefitAddItemDetail struct {
 	Id                *string                                      `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -264,8 +682,10 @@ type ExplanationOfBenefitAddItemDetail struct {
 	Quantity          *Quantity                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                       `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *json.Number                                 `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                                     `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                       `bson:"net,omitempty" json:"net,omitempty"`
 	NoteNumber        []int                                        `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                                   `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ExplanationOfBenefitItemAdjudication       `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 	SubDetail         []ExplanationOfBenefitAddItemDetailSubDetail `bson:"subDetail,omitempty" json:"subDetail,omitempty"`
 }
@@ -278,8 +698,10 @@ type ExplanationOfBenefitAddItemDetailSubDetail struct {
 	Quantity          *Quantity                              `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	UnitPrice         *Money                                 `bson:"unitPrice,omitempty" json:"unitPrice,omitempty"`
 	Factor            *json.Number                           `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                               `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Net               *Money                                 `bson:"net,omitempty" json:"net,omitempty"`
 	NoteNumber        []int                                  `bson:"noteNumber,omitempty" json:"noteNumber,omitempty"`
+	NoteNumberElement []*Element                             `bson:"_noteNumber,omitempty" json:"_noteNumber,omitempty"`
 	Adjudication      []ExplanationOfBenefitItemAdjudication `bson:"adjudication,omitempty" json:"adjudication,omitempty"`
 }
 type ExplanationOfBenefitTotal struct {
@@ -297,6 +719,7 @@ type ExplanationOfBenefitPayment struct {
 	Adjustment        *Money           `bson:"adjustment,omitempty" json:"adjustment,omitempty"`
 	AdjustmentReason  *CodeableConcept `bson:"adjustmentReason,omitempty" json:"adjustmentReason,omitempty"`
 	Date              *string          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element         `bson:"_date,omitempty" json:"_date,omitempty"`
 	Amount            *Money           `bson:"amount,omitempty" json:"amount,omitempty"`
 	Identifier        *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
 }
@@ -305,51 +728,16924 @@ type ExplanationOfBenefitProcessNote struct {
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Number            *int             `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement     *Element         `bson:"_number,omitempty" json:"_number,omitempty"`
 	Type              *NoteType        `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element         `bson:"_type,omitempty" json:"_type,omitempty"`
 	Text              *string          `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element         `bson:"_text,omitempty" json:"_text,omitempty"`
 	Language          *CodeableConcept `bson:"language,omitempty" json:"language,omitempty"`
 }
 type ExplanationOfBenefitBenefitBalance struct {
-	Id                *string                                       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category          CodeableConcept                               `bson:"category" json:"category"`
-	Excluded          *bool                                         `bson:"excluded,omitempty" json:"excluded,omitempty"`
-	Name              *string                                       `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                                       `bson:"description,omitempty" json:"description,omitempty"`
-	Network           *CodeableConcept                              `bson:"network,omitempty" json:"network,omitempty"`
-	Unit              *CodeableConcept                              `bson:"unit,omitempty" json:"unit,omitempty"`
-	Term              *CodeableConcept                              `bson:"term,omitempty" json:"term,omitempty"`
-	Financial         []ExplanationOfBenefitBenefitBalanceFinancial `bson:"financial,omitempty" json:"financial,omitempty"`
+	Id                 *string                                       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category           CodeableConcept                               `bson:"category" json:"category"`
+	Excluded           *bool                                         `bson:"excluded,omitempty" json:"excluded,omitempty"`
+	ExcludedElement    *Element                                      `bson:"_excluded,omitempty" json:"_excluded,omitempty"`
+	Name               *string                                       `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element                                      `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                                       `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                                      `bson:"_description,omitempty" json:"_description,omitempty"`
+	Network            *CodeableConcept                              `bson:"network,omitempty" json:"network,omitempty"`
+	Unit               *CodeableConcept                              `bson:"unit,omitempty" json:"unit,omitempty"`
+	Term               *CodeableConcept                              `bson:"term,omitempty" json:"term,omitempty"`
+	Financial          []ExplanationOfBenefitBenefitBalanceFinancial `bson:"financial,omitempty" json:"financial,omitempty"`
 }
 type ExplanationOfBenefitBenefitBalanceFinancial struct {
-	Id                 *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type               CodeableConcept `bson:"type" json:"type"`
-	AllowedUnsignedInt *int            `bson:"allowedUnsignedInt,omitempty" json:"allowedUnsignedInt,omitempty"`
-	AllowedString      *string         `bson:"allowedString,omitempty" json:"allowedString,omitempty"`
-	AllowedMoney       *Money          `bson:"allowedMoney,omitempty" json:"allowedMoney,omitempty"`
-	UsedUnsignedInt    *int            `bson:"usedUnsignedInt,omitempty" json:"usedUnsignedInt,omitempty"`
-	UsedMoney          *Money          `bson:"usedMoney,omitempty" json:"usedMoney,omitempty"`
-}
-type OtherExplanationOfBenefit ExplanationOfBenefit
+	Id                        *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                      CodeableConcept `bson:"type" json:"type"`
+	AllowedUnsignedInt        *int            `bson:"allowedUnsignedInt,omitempty" json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *Element        `bson:"_allowedUnsignedInt,omitempty" json:"_allowedUnsignedInt,omitempty"`
+	AllowedString             *string         `bson:"allowedString,omitempty" json:"allowedString,omitempty"`
+	AllowedStringElement      *Element        `bson:"_allowedString,omitempty" json:"_allowedString,omitempty"`
+	AllowedMoney              *Money          `bson:"allowedMoney,omitempty" json:"allowedMoney,omitempty"`
+	UsedUnsignedInt           *int            `bson:"usedUnsignedInt,omitempty" json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement    *Element        `bson:"_usedUnsignedInt,omitempty" json:"_usedUnsignedInt,omitempty"`
+	UsedMoney                 *Money          `bson:"usedMoney,omitempty" json:"usedMoney,omitempty"`
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancialAllowed is implemented by the types allowed for ExplanationOfBenefit.benefitBalance.financial.allowed[x]
+type ExplanationOfBenefitBenefitBalanceFinancialAllowed interface {
+	isExplanationOfBenefitBenefitBalanceFinancialAllowed()
+}
+
+func (UnsignedInt) isExplanationOfBenefitBenefitBalanceFinancialAllowed() {}
+func (String) isExplanationOfBenefitBenefitBalanceFinancialAllowed()      {}
+func (Money) isExplanationOfBenefitBenefitBalanceFinancialAllowed()       {}
+
+// Allowed returns the value of ExplanationOfBenefit.benefitBalance.financial.allowed[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitBenefitBalanceFinancial) Allowed() (ExplanationOfBenefitBenefitBalanceFinancialAllowed, string) {
+	if r.AllowedUnsignedInt != nil {
+		return UnsignedInt(*r.AllowedUnsignedInt), "unsignedInt"
+	}
+	if r.AllowedString != nil {
+		return String(*r.AllowedString), "string"
+	}
+	if r.AllowedMoney != nil {
+		return *r.AllowedMoney, "Money"
+	}
+	return nil, ""
+}
+
+// SetAllowed sets the value of ExplanationOfBenefit.benefitBalance.financial.allowed[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) SetAllowed(value ExplanationOfBenefitBenefitBalanceFinancialAllowed) {
+	r.AllowedUnsignedInt = nil
+	r.AllowedString = nil
+	r.AllowedMoney = nil
+	switch v := value.(type) {
+	case UnsignedInt:
+		primitive := int(v)
+		r.AllowedUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.AllowedUnsignedInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.AllowedString = &primitive
+	case *String:
+		r.AllowedString = (*string)(v)
+	case Money:
+		r.AllowedMoney = &v
+	case *Money:
+		r.AllowedMoney = v
+	}
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancialUsed is implemented by the types allowed for ExplanationOfBenefit.benefitBalance.financial.used[x]
+type ExplanationOfBenefitBenefitBalanceFinancialUsed interface {
+	isExplanationOfBenefitBenefitBalanceFinancialUsed()
+}
+
+func (UnsignedInt) isExplanationOfBenefitBenefitBalanceFinancialUsed() {}
+func (Money) isExplanationOfBenefitBenefitBalanceFinancialUsed()       {}
+
+// Used returns the value of ExplanationOfBenefit.benefitBalance.financial.used[x] together with its FHIR type code or nil if no value is set
+func (r ExplanationOfBenefitBenefitBalanceFinancial) Used() (ExplanationOfBenefitBenefitBalanceFinancialUsed, string) {
+	if r.UsedUnsignedInt != nil {
+		return UnsignedInt(*r.UsedUnsignedInt), "unsignedInt"
+	}
+	if r.UsedMoney != nil {
+		return *r.UsedMoney, "Money"
+	}
+	return nil, ""
+}
+
+// SetUsed sets the value of ExplanationOfBenefit.benefitBalance.financial.used[x], given as value or pointer, and clears all other types
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) SetUsed(value ExplanationOfBenefitBenefitBalanceFinancialUsed) {
+	r.UsedUnsignedInt = nil
+	r.UsedMoney = nil
+	switch v := value.(type) {
+	case UnsignedInt:
+		primitive := int(v)
+		r.UsedUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.UsedUnsignedInt = (*int)(v)
+	case Money:
+		r.UsedMoney = &v
+	case *Money:
+		r.UsedMoney = v
+	}
+}
 
 // MarshalJSON marshals the given ExplanationOfBenefit as JSON into a byte slice
 func (r ExplanationOfBenefit) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherExplanationOfBenefit
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherExplanationOfBenefit: OtherExplanationOfBenefit(r),
-		ResourceType:              "ExplanationOfBenefit",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefit as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefit) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ExplanationOfBenefit\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.SubType != nil {
+		e.key("subType")
+		r.SubType.appendJSON(e)
+	}
+	e.key("use")
+	e.string(r.Use.Code())
+	if r.UseElement != nil {
+		e.key("_use")
+		r.UseElement.appendJSON(e)
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.BillablePeriod != nil {
+		e.key("billablePeriod")
+		r.BillablePeriod.appendJSON(e)
+	}
+	e.key("created")
+	e.string(r.Created)
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.Enterer != nil {
+		e.key("enterer")
+		r.Enterer.appendJSON(e)
+	}
+	e.key("insurer")
+	r.Insurer.appendJSON(e)
+	e.key("provider")
+	r.Provider.appendJSON(e)
+	if r.Priority != nil {
+		e.key("priority")
+		r.Priority.appendJSON(e)
+	}
+	if r.FundsReserveRequested != nil {
+		e.key("fundsReserveRequested")
+		r.FundsReserveRequested.appendJSON(e)
+	}
+	if r.FundsReserve != nil {
+		e.key("fundsReserve")
+		r.FundsReserve.appendJSON(e)
+	}
+	if len(r.Related) > 0 {
+		e.key("related")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Related {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Prescription != nil {
+		e.key("prescription")
+		r.Prescription.appendJSON(e)
+	}
+	if r.OriginalPrescription != nil {
+		e.key("originalPrescription")
+		r.OriginalPrescription.appendJSON(e)
+	}
+	if r.Payee != nil {
+		e.key("payee")
+		r.Payee.appendJSON(e)
+	}
+	if r.Referral != nil {
+		e.key("referral")
+		r.Referral.appendJSON(e)
+	}
+	if r.Facility != nil {
+		e.key("facility")
+		r.Facility.appendJSON(e)
+	}
+	if r.Claim != nil {
+		e.key("claim")
+		r.Claim.appendJSON(e)
+	}
+	if r.ClaimResponse != nil {
+		e.key("claimResponse")
+		r.ClaimResponse.appendJSON(e)
+	}
+	e.key("outcome")
+	e.string(r.Outcome.Code())
+	if r.OutcomeElement != nil {
+		e.key("_outcome")
+		r.OutcomeElement.appendJSON(e)
+	}
+	if r.Disposition != nil {
+		e.key("disposition")
+		e.string(*r.Disposition)
+	}
+	if r.DispositionElement != nil {
+		e.key("_disposition")
+		r.DispositionElement.appendJSON(e)
+	}
+	if len(r.PreAuthRef) > 0 {
+		e.key("preAuthRef")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PreAuthRef {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PreAuthRefElement) > 0 {
+		e.key("_preAuthRef")
+		e.elements(r.PreAuthRefElement, len(r.PreAuthRef))
+	}
+	if len(r.PreAuthRefPeriod) > 0 {
+		e.key("preAuthRefPeriod")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PreAuthRefPeriod {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CareTeam) > 0 {
+		e.key("careTeam")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CareTeam {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInfo) > 0 {
+		e.key("supportingInfo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInfo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Diagnosis) > 0 {
+		e.key("diagnosis")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Diagnosis {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Procedure) > 0 {
+		e.key("procedure")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Procedure {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Precedence != nil {
+		e.key("precedence")
+		e.int(*r.Precedence)
+	}
+	if r.PrecedenceElement != nil {
+		e.key("_precedence")
+		r.PrecedenceElement.appendJSON(e)
+	}
+	e.key("insurance")
+	if r.Insurance == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Insurance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Accident != nil {
+		e.key("accident")
+		r.Accident.appendJSON(e)
+	}
+	if len(r.Item) > 0 {
+		e.key("item")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Item {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AddItem) > 0 {
+		e.key("addItem")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AddItem {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Total) > 0 {
+		e.key("total")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Total {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Payment != nil {
+		e.key("payment")
+		r.Payment.appendJSON(e)
+	}
+	if r.FormCode != nil {
+		e.key("formCode")
+		r.FormCode.appendJSON(e)
+	}
+	if r.Form != nil {
+		e.key("form")
+		r.Form.appendJSON(e)
+	}
+	if len(r.ProcessNote) > 0 {
+		e.key("processNote")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProcessNote {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BenefitPeriod != nil {
+		e.key("benefitPeriod")
+		r.BenefitPeriod.appendJSON(e)
+	}
+	if len(r.BenefitBalance) > 0 {
+		e.key("benefitBalance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BenefitBalance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefit from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefit) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefit
+func (r *ExplanationOfBenefit) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			r.Type.decodeJSON(d)
+		case "subType":
+			if d.null() {
+				r.SubType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubType = &v
+			}
+		case "use":
+			d.unmarshal(&r.Use)
+		case "_use":
+			r.UseElement = d.element()
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "billablePeriod":
+			if d.null() {
+				r.BillablePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.BillablePeriod = &v
+			}
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = v
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "enterer":
+			if d.null() {
+				r.Enterer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Enterer = &v
+			}
+		case "insurer":
+			r.Insurer.decodeJSON(d)
+		case "provider":
+			r.Provider.decodeJSON(d)
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Priority = &v
+			}
+		case "fundsReserveRequested":
+			if d.null() {
+				r.FundsReserveRequested = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FundsReserveRequested = &v
+			}
+		case "fundsReserve":
+			if d.null() {
+				r.FundsReserve = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FundsReserve = &v
+			}
+		case "related":
+			a := d.array()
+			r.Related = nil
+			for a.next() {
+				var v ExplanationOfBenefitRelated
+				v.decodeJSON(d)
+				r.Related = append(r.Related, v)
+			}
+			if r.Related == nil && !a.isNull {
+				r.Related = []ExplanationOfBenefitRelated{}
+			}
+		case "prescription":
+			if d.null() {
+				r.Prescription = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Prescription = &v
+			}
+		case "originalPrescription":
+			if d.null() {
+				r.OriginalPrescription = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.OriginalPrescription = &v
+			}
+		case "payee":
+			if d.null() {
+				r.Payee = nil
+			} else {
+				var v ExplanationOfBenefitPayee
+				v.decodeJSON(d)
+				r.Payee = &v
+			}
+		case "referral":
+			if d.null() {
+				r.Referral = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Referral = &v
+			}
+		case "facility":
+			if d.null() {
+				r.Facility = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Facility = &v
+			}
+		case "claim":
+			if d.null() {
+				r.Claim = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Claim = &v
+			}
+		case "claimResponse":
+			if d.null() {
+				r.ClaimResponse = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ClaimResponse = &v
+			}
+		case "outcome":
+			d.unmarshal(&r.Outcome)
+		case "_outcome":
+			r.OutcomeElement = d.element()
+		case "disposition":
+			if v, ok := d.string(); ok {
+				r.Disposition = &v
+			} else {
+				r.Disposition = nil
+			}
+		case "_disposition":
+			r.DispositionElement = d.element()
+		case "preAuthRef":
+			a := d.array()
+			r.PreAuthRef = nil
+			for a.next() {
+				v, _ := d.string()
+				r.PreAuthRef = append(r.PreAuthRef, v)
+			}
+			if r.PreAuthRef == nil && !a.isNull {
+				r.PreAuthRef = []string{}
+			}
+		case "_preAuthRef":
+			a := d.array()
+			r.PreAuthRefElement = nil
+			for a.next() {
+				r.PreAuthRefElement = append(r.PreAuthRefElement, d.element())
+			}
+		case "preAuthRefPeriod":
+			a := d.array()
+			r.PreAuthRefPeriod = nil
+			for a.next() {
+				var v Period
+				v.decodeJSON(d)
+				r.PreAuthRefPeriod = append(r.PreAuthRefPeriod, v)
+			}
+			if r.PreAuthRefPeriod == nil && !a.isNull {
+				r.PreAuthRefPeriod = []Period{}
+			}
+		case "careTeam":
+			a := d.array()
+			r.CareTeam = nil
+			for a.next() {
+				var v ExplanationOfBenefitCareTeam
+				v.decodeJSON(d)
+				r.CareTeam = append(r.CareTeam, v)
+			}
+			if r.CareTeam == nil && !a.isNull {
+				r.CareTeam = []ExplanationOfBenefitCareTeam{}
+			}
+		case "supportingInfo":
+			a := d.array()
+			r.SupportingInfo = nil
+			for a.next() {
+				var v ExplanationOfBenefitSupportingInfo
+				v.decodeJSON(d)
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			}
+			if r.SupportingInfo == nil && !a.isNull {
+				r.SupportingInfo = []ExplanationOfBenefitSupportingInfo{}
+			}
+		case "diagnosis":
+			a := d.array()
+			r.Diagnosis = nil
+			for a.next() {
+				var v ExplanationOfBenefitDiagnosis
+				v.decodeJSON(d)
+				r.Diagnosis = append(r.Diagnosis, v)
+			}
+			if r.Diagnosis == nil && !a.isNull {
+				r.Diagnosis = []ExplanationOfBenefitDiagnosis{}
+			}
+		case "procedure":
+			a := d.array()
+			r.Procedure = nil
+			for a.next() {
+				var v ExplanationOfBenefitProcedure
+				v.decodeJSON(d)
+				r.Procedure = append(r.Procedure, v)
+			}
+			if r.Procedure == nil && !a.isNull {
+				r.Procedure = []ExplanationOfBenefitProcedure{}
+			}
+		case "precedence":
+			if v, ok := d.int(); ok {
+				r.Precedence = &v
+			} else {
+				r.Precedence = nil
+			}
+		case "_precedence":
+			r.PrecedenceElement = d.element()
+		case "insurance":
+			a := d.array()
+			r.Insurance = nil
+			for a.next() {
+				var v ExplanationOfBenefitInsurance
+				v.decodeJSON(d)
+				r.Insurance = append(r.Insurance, v)
+			}
+			if r.Insurance == nil && !a.isNull {
+				r.Insurance = []ExplanationOfBenefitInsurance{}
+			}
+		case "accident":
+			if d.null() {
+				r.Accident = nil
+			} else {
+				var v ExplanationOfBenefitAccident
+				v.decodeJSON(d)
+				r.Accident = &v
+			}
+		case "item":
+			a := d.array()
+			r.Item = nil
+			for a.next() {
+				var v ExplanationOfBenefitItem
+				v.decodeJSON(d)
+				r.Item = append(r.Item, v)
+			}
+			if r.Item == nil && !a.isNull {
+				r.Item = []ExplanationOfBenefitItem{}
+			}
+		case "addItem":
+			a := d.array()
+			r.AddItem = nil
+			for a.next() {
+				var v ExplanationOfBenefitAddItem
+				v.decodeJSON(d)
+				r.AddItem = append(r.AddItem, v)
+			}
+			if r.AddItem == nil && !a.isNull {
+				r.AddItem = []ExplanationOfBenefitAddItem{}
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ExplanationOfBenefitItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ExplanationOfBenefitItemAdjudication{}
+			}
+		case "total":
+			a := d.array()
+			r.Total = nil
+			for a.next() {
+				var v ExplanationOfBenefitTotal
+				v.decodeJSON(d)
+				r.Total = append(r.Total, v)
+			}
+			if r.Total == nil && !a.isNull {
+				r.Total = []ExplanationOfBenefitTotal{}
+			}
+		case "payment":
+			if d.null() {
+				r.Payment = nil
+			} else {
+				var v ExplanationOfBenefitPayment
+				v.decodeJSON(d)
+				r.Payment = &v
+			}
+		case "formCode":
+			if d.null() {
+				r.FormCode = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FormCode = &v
+			}
+		case "form":
+			if d.null() {
+				r.Form = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Form = &v
+			}
+		case "processNote":
+			a := d.array()
+			r.ProcessNote = nil
+			for a.next() {
+				var v ExplanationOfBenefitProcessNote
+				v.decodeJSON(d)
+				r.ProcessNote = append(r.ProcessNote, v)
+			}
+			if r.ProcessNote == nil && !a.isNull {
+				r.ProcessNote = []ExplanationOfBenefitProcessNote{}
+			}
+		case "benefitPeriod":
+			if d.null() {
+				r.BenefitPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.BenefitPeriod = &v
+			}
+		case "benefitBalance":
+			a := d.array()
+			r.BenefitBalance = nil
+			for a.next() {
+				var v ExplanationOfBenefitBenefitBalance
+				v.decodeJSON(d)
+				r.BenefitBalance = append(r.BenefitBalance, v)
+			}
+			if r.BenefitBalance == nil && !a.isNull {
+				r.BenefitBalance = []ExplanationOfBenefitBenefitBalance{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefit which shares no memory with the original
+func (r ExplanationOfBenefit) DeepCopy() ExplanationOfBenefit {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.SubType != nil {
+		v := r.SubType.DeepCopy()
+		out.SubType = &v
+	}
+	if r.UseElement != nil {
+		v := r.UseElement.DeepCopy()
+		out.UseElement = &v
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.BillablePeriod != nil {
+		v := r.BillablePeriod.DeepCopy()
+		out.BillablePeriod = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Enterer != nil {
+		v := r.Enterer.DeepCopy()
+		out.Enterer = &v
+	}
+	out.Insurer = r.Insurer.DeepCopy()
+	out.Provider = r.Provider.DeepCopy()
+	if r.Priority != nil {
+		v := r.Priority.DeepCopy()
+		out.Priority = &v
+	}
+	if r.FundsReserveRequested != nil {
+		v := r.FundsReserveRequested.DeepCopy()
+		out.FundsReserveRequested = &v
+	}
+	if r.FundsReserve != nil {
+		v := r.FundsReserve.DeepCopy()
+		out.FundsReserve = &v
+	}
+	if r.Related != nil {
+		out.Related = make([]ExplanationOfBenefitRelated, len(r.Related))
+		for i := range r.Related {
+			out.Related[i] = r.Related[i].DeepCopy()
+		}
+	}
+	if r.Prescription != nil {
+		v := r.Prescription.DeepCopy()
+		out.Prescription = &v
+	}
+	if r.OriginalPrescription != nil {
+		v := r.OriginalPrescription.DeepCopy()
+		out.OriginalPrescription = &v
+	}
+	if r.Payee != nil {
+		v := r.Payee.DeepCopy()
+		out.Payee = &v
+	}
+	if r.Referral != nil {
+		v := r.Referral.DeepCopy()
+		out.Referral = &v
+	}
+	if r.Facility != nil {
+		v := r.Facility.DeepCopy()
+		out.Facility = &v
+	}
+	if r.Claim != nil {
+		v := r.Claim.DeepCopy()
+		out.Claim = &v
+	}
+	if r.ClaimResponse != nil {
+		v := r.ClaimResponse.DeepCopy()
+		out.ClaimResponse = &v
+	}
+	if r.OutcomeElement != nil {
+		v := r.OutcomeElement.DeepCopy()
+		out.OutcomeElement = &v
+	}
+	if r.Disposition != nil {
+		v := *r.Disposition
+		out.Disposition = &v
+	}
+	if r.DispositionElement != nil {
+		v := r.DispositionElement.DeepCopy()
+		out.DispositionElement = &v
+	}
+	if r.PreAuthRef != nil {
+		out.PreAuthRef = make([]string, len(r.PreAuthRef))
+		copy(out.PreAuthRef, r.PreAuthRef)
+	}
+	if r.PreAuthRefElement != nil {
+		out.PreAuthRefElement = make([]*Element, len(r.PreAuthRefElement))
+		for i, v := range r.PreAuthRefElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PreAuthRefElement[i] = &c
+			}
+		}
+	}
+	if r.PreAuthRefPeriod != nil {
+		out.PreAuthRefPeriod = make([]Period, len(r.PreAuthRefPeriod))
+		for i := range r.PreAuthRefPeriod {
+			out.PreAuthRefPeriod[i] = r.PreAuthRefPeriod[i].DeepCopy()
+		}
+	}
+	if r.CareTeam != nil {
+		out.CareTeam = make([]ExplanationOfBenefitCareTeam, len(r.CareTeam))
+		for i := range r.CareTeam {
+			out.CareTeam[i] = r.CareTeam[i].DeepCopy()
+		}
+	}
+	if r.SupportingInfo != nil {
+		out.SupportingInfo = make([]ExplanationOfBenefitSupportingInfo, len(r.SupportingInfo))
+		for i := range r.SupportingInfo {
+			out.SupportingInfo[i] = r.SupportingInfo[i].DeepCopy()
+		}
+	}
+	if r.Diagnosis != nil {
+		out.Diagnosis = make([]ExplanationOfBenefitDiagnosis, len(r.Diagnosis))
+		for i := range r.Diagnosis {
+			out.Diagnosis[i] = r.Diagnosis[i].DeepCopy()
+		}
+	}
+	if r.Procedure != nil {
+		out.Procedure = make([]ExplanationOfBenefitProcedure, len(r.Procedure))
+		for i := range r.Procedure {
+			out.Procedure[i] = r.Procedure[i].DeepCopy()
+		}
+	}
+	if r.Precedence != nil {
+		v := *r.Precedence
+		out.Precedence = &v
+	}
+	if r.PrecedenceElement != nil {
+		v := r.PrecedenceElement.DeepCopy()
+		out.PrecedenceElement = &v
+	}
+	if r.Insurance != nil {
+		out.Insurance = make([]ExplanationOfBenefitInsurance, len(r.Insurance))
+		for i := range r.Insurance {
+			out.Insurance[i] = r.Insurance[i].DeepCopy()
+		}
+	}
+	if r.Accident != nil {
+		v := r.Accident.DeepCopy()
+		out.Accident = &v
+	}
+	if r.Item != nil {
+		out.Item = make([]ExplanationOfBenefitItem, len(r.Item))
+		for i := range r.Item {
+			out.Item[i] = r.Item[i].DeepCopy()
+		}
+	}
+	if r.AddItem != nil {
+		out.AddItem = make([]ExplanationOfBenefitAddItem, len(r.AddItem))
+		for i := range r.AddItem {
+			out.AddItem[i] = r.AddItem[i].DeepCopy()
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ExplanationOfBenefitItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.Total != nil {
+		out.Total = make([]ExplanationOfBenefitTotal, len(r.Total))
+		for i := range r.Total {
+			out.Total[i] = r.Total[i].DeepCopy()
+		}
+	}
+	if r.Payment != nil {
+		v := r.Payment.DeepCopy()
+		out.Payment = &v
+	}
+	if r.FormCode != nil {
+		v := r.FormCode.DeepCopy()
+		out.FormCode = &v
+	}
+	if r.Form != nil {
+		v := r.Form.DeepCopy()
+		out.Form = &v
+	}
+	if r.ProcessNote != nil {
+		out.ProcessNote = make([]ExplanationOfBenefitProcessNote, len(r.ProcessNote))
+		for i := range r.ProcessNote {
+			out.ProcessNote[i] = r.ProcessNote[i].DeepCopy()
+		}
+	}
+	if r.BenefitPeriod != nil {
+		v := r.BenefitPeriod.DeepCopy()
+		out.BenefitPeriod = &v
+	}
+	if r.BenefitBalance != nil {
+		out.BenefitBalance = make([]ExplanationOfBenefitBenefitBalance, len(r.BenefitBalance))
+		for i := range r.BenefitBalance {
+			out.BenefitBalance[i] = r.BenefitBalance[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefit) Equal(other ExplanationOfBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.Equal(*other.SubType) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.Equal(*other.UseElement) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.BillablePeriod == nil) != (other.BillablePeriod == nil) || r.BillablePeriod != nil && !r.BillablePeriod.Equal(*other.BillablePeriod) {
+		return false
+	}
+	if r.Created != other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.Equal(*other.Enterer) {
+		return false
+	}
+	if !r.Insurer.Equal(other.Insurer) {
+		return false
+	}
+	if !r.Provider.Equal(other.Provider) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.Equal(*other.Priority) {
+		return false
+	}
+	if (r.FundsReserveRequested == nil) != (other.FundsReserveRequested == nil) || r.FundsReserveRequested != nil && !r.FundsReserveRequested.Equal(*other.FundsReserveRequested) {
+		return false
+	}
+	if (r.FundsReserve == nil) != (other.FundsReserve == nil) || r.FundsReserve != nil && !r.FundsReserve.Equal(*other.FundsReserve) {
+		return false
+	}
+	if len(r.Related) != len(other.Related) {
+		return false
+	}
+	for i := range r.Related {
+		if !r.Related[i].Equal(other.Related[i]) {
+			return false
+		}
+	}
+	if (r.Prescription == nil) != (other.Prescription == nil) || r.Prescription != nil && !r.Prescription.Equal(*other.Prescription) {
+		return false
+	}
+	if (r.OriginalPrescription == nil) != (other.OriginalPrescription == nil) || r.OriginalPrescription != nil && !r.OriginalPrescription.Equal(*other.OriginalPrescription) {
+		return false
+	}
+	if (r.Payee == nil) != (other.Payee == nil) || r.Payee != nil && !r.Payee.Equal(*other.Payee) {
+		return false
+	}
+	if (r.Referral == nil) != (other.Referral == nil) || r.Referral != nil && !r.Referral.Equal(*other.Referral) {
+		return false
+	}
+	if (r.Facility == nil) != (other.Facility == nil) || r.Facility != nil && !r.Facility.Equal(*other.Facility) {
+		return false
+	}
+	if (r.Claim == nil) != (other.Claim == nil) || r.Claim != nil && !r.Claim.Equal(*other.Claim) {
+		return false
+	}
+	if (r.ClaimResponse == nil) != (other.ClaimResponse == nil) || r.ClaimResponse != nil && !r.ClaimResponse.Equal(*other.ClaimResponse) {
+		return false
+	}
+	if r.Outcome != other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.Equal(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && *r.Disposition != *other.Disposition {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.Equal(*other.DispositionElement) {
+		return false
+	}
+	if len(r.PreAuthRef) != len(other.PreAuthRef) {
+		return false
+	}
+	for i := range r.PreAuthRef {
+		if r.PreAuthRef[i] != other.PreAuthRef[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PreAuthRefElement, other.PreAuthRefElement, false) {
+		return false
+	}
+	if len(r.PreAuthRefPeriod) != len(other.PreAuthRefPeriod) {
+		return false
+	}
+	for i := range r.PreAuthRefPeriod {
+		if !r.PreAuthRefPeriod[i].Equal(other.PreAuthRefPeriod[i]) {
+			return false
+		}
+	}
+	if len(r.CareTeam) != len(other.CareTeam) {
+		return false
+	}
+	for i := range r.CareTeam {
+		if !r.CareTeam[i].Equal(other.CareTeam[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingInfo) != len(other.SupportingInfo) {
+		return false
+	}
+	for i := range r.SupportingInfo {
+		if !r.SupportingInfo[i].Equal(other.SupportingInfo[i]) {
+			return false
+		}
+	}
+	if len(r.Diagnosis) != len(other.Diagnosis) {
+		return false
+	}
+	for i := range r.Diagnosis {
+		if !r.Diagnosis[i].Equal(other.Diagnosis[i]) {
+			return false
+		}
+	}
+	if len(r.Procedure) != len(other.Procedure) {
+		return false
+	}
+	for i := range r.Procedure {
+		if !r.Procedure[i].Equal(other.Procedure[i]) {
+			return false
+		}
+	}
+	if (r.Precedence == nil) != (other.Precedence == nil) || r.Precedence != nil && *r.Precedence != *other.Precedence {
+		return false
+	}
+	if (r.PrecedenceElement == nil) != (other.PrecedenceElement == nil) || r.PrecedenceElement != nil && !r.PrecedenceElement.Equal(*other.PrecedenceElement) {
+		return false
+	}
+	if len(r.Insurance) != len(other.Insurance) {
+		return false
+	}
+	for i := range r.Insurance {
+		if !r.Insurance[i].Equal(other.Insurance[i]) {
+			return false
+		}
+	}
+	if (r.Accident == nil) != (other.Accident == nil) || r.Accident != nil && !r.Accident.Equal(*other.Accident) {
+		return false
+	}
+	if len(r.Item) != len(other.Item) {
+		return false
+	}
+	for i := range r.Item {
+		if !r.Item[i].Equal(other.Item[i]) {
+			return false
+		}
+	}
+	if len(r.AddItem) != len(other.AddItem) {
+		return false
+	}
+	for i := range r.AddItem {
+		if !r.AddItem[i].Equal(other.AddItem[i]) {
+			return false
+		}
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.Total) != len(other.Total) {
+		return false
+	}
+	for i := range r.Total {
+		if !r.Total[i].Equal(other.Total[i]) {
+			return false
+		}
+	}
+	if (r.Payment == nil) != (other.Payment == nil) || r.Payment != nil && !r.Payment.Equal(*other.Payment) {
+		return false
+	}
+	if (r.FormCode == nil) != (other.FormCode == nil) || r.FormCode != nil && !r.FormCode.Equal(*other.FormCode) {
+		return false
+	}
+	if (r.Form == nil) != (other.Form == nil) || r.Form != nil && !r.Form.Equal(*other.Form) {
+		return false
+	}
+	if len(r.ProcessNote) != len(other.ProcessNote) {
+		return false
+	}
+	for i := range r.ProcessNote {
+		if !r.ProcessNote[i].Equal(other.ProcessNote[i]) {
+			return false
+		}
+	}
+	if (r.BenefitPeriod == nil) != (other.BenefitPeriod == nil) || r.BenefitPeriod != nil && !r.BenefitPeriod.Equal(*other.BenefitPeriod) {
+		return false
+	}
+	if len(r.BenefitBalance) != len(other.BenefitBalance) {
+		return false
+	}
+	for i := range r.BenefitBalance {
+		if !r.BenefitBalance[i].Equal(other.BenefitBalance[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefit) EqualsDeep(other ExplanationOfBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.SubType == nil) != (other.SubType == nil) || r.SubType != nil && !r.SubType.EqualsDeep(*other.SubType) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.EqualsDeep(*other.UseElement) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.BillablePeriod == nil) != (other.BillablePeriod == nil) || r.BillablePeriod != nil && !r.BillablePeriod.EqualsDeep(*other.BillablePeriod) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.EqualsDeep(*other.Enterer) {
+		return false
+	}
+	if !r.Insurer.EqualsDeep(other.Insurer) {
+		return false
+	}
+	if !r.Provider.EqualsDeep(other.Provider) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.EqualsDeep(*other.Priority) {
+		return false
+	}
+	if (r.FundsReserveRequested == nil) != (other.FundsReserveRequested == nil) || r.FundsReserveRequested != nil && !r.FundsReserveRequested.EqualsDeep(*other.FundsReserveRequested) {
+		return false
+	}
+	if (r.FundsReserve == nil) != (other.FundsReserve == nil) || r.FundsReserve != nil && !r.FundsReserve.EqualsDeep(*other.FundsReserve) {
+		return false
+	}
+	if !equivalentList(len(r.Related), len(other.Related), func(i, j int) bool {
+		return r.Related[i].EqualsDeep(other.Related[j])
+	}) {
+		return false
+	}
+	if (r.Prescription == nil) != (other.Prescription == nil) || r.Prescription != nil && !r.Prescription.EqualsDeep(*other.Prescription) {
+		return false
+	}
+	if (r.OriginalPrescription == nil) != (other.OriginalPrescription == nil) || r.OriginalPrescription != nil && !r.OriginalPrescription.EqualsDeep(*other.OriginalPrescription) {
+		return false
+	}
+	if (r.Payee == nil) != (other.Payee == nil) || r.Payee != nil && !r.Payee.EqualsDeep(*other.Payee) {
+		return false
+	}
+	if (r.Referral == nil) != (other.Referral == nil) || r.Referral != nil && !r.Referral.EqualsDeep(*other.Referral) {
+		return false
+	}
+	if (r.Facility == nil) != (other.Facility == nil) || r.Facility != nil && !r.Facility.EqualsDeep(*other.Facility) {
+		return false
+	}
+	if (r.Claim == nil) != (other.Claim == nil) || r.Claim != nil && !r.Claim.EqualsDeep(*other.Claim) {
+		return false
+	}
+	if (r.ClaimResponse == nil) != (other.ClaimResponse == nil) || r.ClaimResponse != nil && !r.ClaimResponse.EqualsDeep(*other.ClaimResponse) {
+		return false
+	}
+	if r.Outcome != other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.EqualsDeep(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.EqualsDeep(*other.DispositionElement) {
+		return false
+	}
+	if !equivalentList(len(r.PreAuthRef), len(other.PreAuthRef), func(i, j int) bool {
+		return equivalentString(r.PreAuthRef[i], other.PreAuthRef[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PreAuthRefElement, other.PreAuthRefElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.PreAuthRefPeriod), len(other.PreAuthRefPeriod), func(i, j int) bool {
+		return r.PreAuthRefPeriod[i].EqualsDeep(other.PreAuthRefPeriod[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.CareTeam), len(other.CareTeam), func(i, j int) bool {
+		return r.CareTeam[i].EqualsDeep(other.CareTeam[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfo), len(other.SupportingInfo), func(i, j int) bool {
+		return r.SupportingInfo[i].EqualsDeep(other.SupportingInfo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Diagnosis), len(other.Diagnosis), func(i, j int) bool {
+		return r.Diagnosis[i].EqualsDeep(other.Diagnosis[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Procedure), len(other.Procedure), func(i, j int) bool {
+		return r.Procedure[i].EqualsDeep(other.Procedure[j])
+	}) {
+		return false
+	}
+	if (r.Precedence == nil) != (other.Precedence == nil) || r.Precedence != nil && *r.Precedence != *other.Precedence {
+		return false
+	}
+	if (r.PrecedenceElement == nil) != (other.PrecedenceElement == nil) || r.PrecedenceElement != nil && !r.PrecedenceElement.EqualsDeep(*other.PrecedenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.Insurance), len(other.Insurance), func(i, j int) bool {
+		return r.Insurance[i].EqualsDeep(other.Insurance[j])
+	}) {
+		return false
+	}
+	if (r.Accident == nil) != (other.Accident == nil) || r.Accident != nil && !r.Accident.EqualsDeep(*other.Accident) {
+		return false
+	}
+	if !equivalentList(len(r.Item), len(other.Item), func(i, j int) bool {
+		return r.Item[i].EqualsDeep(other.Item[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.AddItem), len(other.AddItem), func(i, j int) bool {
+		return r.AddItem[i].EqualsDeep(other.AddItem[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Total), len(other.Total), func(i, j int) bool {
+		return r.Total[i].EqualsDeep(other.Total[j])
+	}) {
+		return false
+	}
+	if (r.Payment == nil) != (other.Payment == nil) || r.Payment != nil && !r.Payment.EqualsDeep(*other.Payment) {
+		return false
+	}
+	if (r.FormCode == nil) != (other.FormCode == nil) || r.FormCode != nil && !r.FormCode.EqualsDeep(*other.FormCode) {
+		return false
+	}
+	if (r.Form == nil) != (other.Form == nil) || r.Form != nil && !r.Form.EqualsDeep(*other.Form) {
+		return false
+	}
+	if !equivalentList(len(r.ProcessNote), len(other.ProcessNote), func(i, j int) bool {
+		return r.ProcessNote[i].EqualsDeep(other.ProcessNote[j])
+	}) {
+		return false
+	}
+	if (r.BenefitPeriod == nil) != (other.BenefitPeriod == nil) || r.BenefitPeriod != nil && !r.BenefitPeriod.EqualsDeep(*other.BenefitPeriod) {
+		return false
+	}
+	if !equivalentList(len(r.BenefitBalance), len(other.BenefitBalance), func(i, j int) bool {
+		return r.BenefitBalance[i].EqualsDeep(other.BenefitBalance[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefit) EqualsShallow(other ExplanationOfBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if r.Outcome != other.Outcome {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if !equivalentList(len(r.PreAuthRef), len(other.PreAuthRef), func(i, j int) bool {
+		return equivalentString(r.PreAuthRef[i], other.PreAuthRef[j])
+	}) {
+		return false
+	}
+	if (r.Precedence == nil) != (other.Precedence == nil) || r.Precedence != nil && *r.Precedence != *other.Precedence {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefit as FHIR XML
+func (r ExplanationOfBenefit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ExplanationOfBenefit",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subType", r.SubType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "use", r.Use, r.UseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "billablePeriod", r.BillablePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "enterer", r.Enterer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "insurer", r.Insurer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "provider", r.Provider); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "priority", r.Priority); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fundsReserveRequested", r.FundsReserveRequested); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fundsReserve", r.FundsReserve); err != nil {
+		return err
+	}
+	for _, v := range r.Related {
+		if err := encodeXMLElement(e, "related", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "prescription", r.Prescription); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "originalPrescription", r.OriginalPrescription); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "payee", r.Payee); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "referral", r.Referral); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "facility", r.Facility); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "claim", r.Claim); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "claimResponse", r.ClaimResponse); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "outcome", r.Outcome, r.OutcomeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "disposition", r.Disposition, r.DispositionElement); err != nil {
+		return err
+	}
+	for i, v := range r.PreAuthRef {
+		if err := encodeXMLPrimitive(e, "preAuthRef", v, elementAt(r.PreAuthRefElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PreAuthRefPeriod {
+		if err := encodeXMLElement(e, "preAuthRefPeriod", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CareTeam {
+		if err := encodeXMLElement(e, "careTeam", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SupportingInfo {
+		if err := encodeXMLElement(e, "supportingInfo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Diagnosis {
+		if err := encodeXMLElement(e, "diagnosis", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Procedure {
+		if err := encodeXMLElement(e, "procedure", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "precedence", r.Precedence, r.PrecedenceElement); err != nil {
+		return err
+	}
+	for _, v := range r.Insurance {
+		if err := encodeXMLElement(e, "insurance", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "accident", r.Accident); err != nil {
+		return err
+	}
+	for _, v := range r.Item {
+		if err := encodeXMLElement(e, "item", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.AddItem {
+		if err := encodeXMLElement(e, "addItem", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Total {
+		if err := encodeXMLElement(e, "total", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "payment", r.Payment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "formCode", r.FormCode); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "form", r.Form); err != nil {
+		return err
+	}
+	for _, v := range r.ProcessNote {
+		if err := encodeXMLElement(e, "processNote", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "benefitPeriod", r.BenefitPeriod); err != nil {
+		return err
+	}
+	for _, v := range r.BenefitBalance {
+		if err := encodeXMLElement(e, "benefitBalance", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefit from FHIR XML
+func (r *ExplanationOfBenefit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v ExplanationOfBenefitStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "subType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubType = &v
+			case "use":
+				var v Use
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Use = v
+				}
+				r.UseElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "billablePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BillablePeriod = &v
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = v
+				}
+				r.CreatedElement = element
+			case "enterer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Enterer = &v
+			case "insurer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurer = v
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = v
+			case "priority":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Priority = &v
+			case "fundsReserveRequested":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FundsReserveRequested = &v
+			case "fundsReserve":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FundsReserve = &v
+			case "related":
+				var v ExplanationOfBenefitRelated
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Related = append(r.Related, v)
+			case "prescription":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Prescription = &v
+			case "originalPrescription":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OriginalPrescription = &v
+			case "payee":
+				var v ExplanationOfBenefitPayee
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payee = &v
+			case "referral":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Referral = &v
+			case "facility":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Facility = &v
+			case "claim":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Claim = &v
+			case "claimResponse":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ClaimResponse = &v
+			case "outcome":
+				var v ClaimProcessingCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Outcome = v
+				}
+				r.OutcomeElement = element
+			case "disposition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Disposition = &v
+				}
+				r.DispositionElement = element
+			case "preAuthRef":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PreAuthRefElement = appendElement(r.PreAuthRefElement, len(r.PreAuthRef), element)
+					r.PreAuthRef = append(r.PreAuthRef, v)
+				}
+			case "preAuthRefPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PreAuthRefPeriod = append(r.PreAuthRefPeriod, v)
+			case "careTeam":
+				var v ExplanationOfBenefitCareTeam
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CareTeam = append(r.CareTeam, v)
+			case "supportingInfo":
+				var v ExplanationOfBenefitSupportingInfo
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			case "diagnosis":
+				var v ExplanationOfBenefitDiagnosis
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Diagnosis = append(r.Diagnosis, v)
+			case "procedure":
+				var v ExplanationOfBenefitProcedure
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Procedure = append(r.Procedure, v)
+			case "precedence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Precedence = &v
+				}
+				r.PrecedenceElement = element
+			case "insurance":
+				var v ExplanationOfBenefitInsurance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurance = append(r.Insurance, v)
+			case "accident":
+				var v ExplanationOfBenefitAccident
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Accident = &v
+			case "item":
+				var v ExplanationOfBenefitItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Item = append(r.Item, v)
+			case "addItem":
+				var v ExplanationOfBenefitAddItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AddItem = append(r.AddItem, v)
+			case "adjudication":
+				var v ExplanationOfBenefitItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "total":
+				var v ExplanationOfBenefitTotal
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Total = append(r.Total, v)
+			case "payment":
+				var v ExplanationOfBenefitPayment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payment = &v
+			case "formCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FormCode = &v
+			case "form":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Form = &v
+			case "processNote":
+				var v ExplanationOfBenefitProcessNote
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProcessNote = append(r.ProcessNote, v)
+			case "benefitPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BenefitPeriod = &v
+			case "benefitBalance":
+				var v ExplanationOfBenefitBenefitBalance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BenefitBalance = append(r.BenefitBalance, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefit as properties to the RDF node n
+func (r ExplanationOfBenefit) turtle(n *turtleNode) {
+	n.resource("ExplanationOfBenefit", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ExplanationOfBenefit.identifier", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.status", -1, r.Status, r.StatusElement, "code")
+	n.element("ExplanationOfBenefit.type", -1, r.Type)
+	n.element("ExplanationOfBenefit.subType", -1, r.SubType)
+	n.primitive("ExplanationOfBenefit.use", -1, r.Use, r.UseElement, "code")
+	n.element("ExplanationOfBenefit.patient", -1, r.Patient)
+	n.element("ExplanationOfBenefit.billablePeriod", -1, r.BillablePeriod)
+	n.primitive("ExplanationOfBenefit.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("ExplanationOfBenefit.enterer", -1, r.Enterer)
+	n.element("ExplanationOfBenefit.insurer", -1, r.Insurer)
+	n.element("ExplanationOfBenefit.provider", -1, r.Provider)
+	n.element("ExplanationOfBenefit.priority", -1, r.Priority)
+	n.element("ExplanationOfBenefit.fundsReserveRequested", -1, r.FundsReserveRequested)
+	n.element("ExplanationOfBenefit.fundsReserve", -1, r.FundsReserve)
+	for i, v := range r.Related {
+		n.element("ExplanationOfBenefit.related", i, v)
+	}
+	n.element("ExplanationOfBenefit.prescription", -1, r.Prescription)
+	n.element("ExplanationOfBenefit.originalPrescription", -1, r.OriginalPrescription)
+	n.element("ExplanationOfBenefit.payee", -1, r.Payee)
+	n.element("ExplanationOfBenefit.referral", -1, r.Referral)
+	n.element("ExplanationOfBenefit.facility", -1, r.Facility)
+	n.element("ExplanationOfBenefit.claim", -1, r.Claim)
+	n.element("ExplanationOfBenefit.claimResponse", -1, r.ClaimResponse)
+	n.primitive("ExplanationOfBenefit.outcome", -1, r.Outcome, r.OutcomeElement, "code")
+	n.primitive("ExplanationOfBenefit.disposition", -1, r.Disposition, r.DispositionElement, "string")
+	for i, v := range r.PreAuthRef {
+		n.primitive("ExplanationOfBenefit.preAuthRef", i, v, elementAt(r.PreAuthRefElement, i), "string")
+	}
+	for i, v := range r.PreAuthRefPeriod {
+		n.element("ExplanationOfBenefit.preAuthRefPeriod", i, v)
+	}
+	for i, v := range r.CareTeam {
+		n.element("ExplanationOfBenefit.careTeam", i, v)
+	}
+	for i, v := range r.SupportingInfo {
+		n.element("ExplanationOfBenefit.supportingInfo", i, v)
+	}
+	for i, v := range r.Diagnosis {
+		n.element("ExplanationOfBenefit.diagnosis", i, v)
+	}
+	for i, v := range r.Procedure {
+		n.element("ExplanationOfBenefit.procedure", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.precedence", -1, r.Precedence, r.PrecedenceElement, "positiveInt")
+	for i, v := range r.Insurance {
+		n.element("ExplanationOfBenefit.insurance", i, v)
+	}
+	n.element("ExplanationOfBenefit.accident", -1, r.Accident)
+	for i, v := range r.Item {
+		n.element("ExplanationOfBenefit.item", i, v)
+	}
+	for i, v := range r.AddItem {
+		n.element("ExplanationOfBenefit.addItem", i, v)
+	}
+	for i, v := range r.Adjudication {
+		n.element("ExplanationOfBenefit.adjudication", i, v)
+	}
+	for i, v := range r.Total {
+		n.element("ExplanationOfBenefit.total", i, v)
+	}
+	n.element("ExplanationOfBenefit.payment", -1, r.Payment)
+	n.element("ExplanationOfBenefit.formCode", -1, r.FormCode)
+	n.element("ExplanationOfBenefit.form", -1, r.Form)
+	for i, v := range r.ProcessNote {
+		n.element("ExplanationOfBenefit.processNote", i, v)
+	}
+	n.element("ExplanationOfBenefit.benefitPeriod", -1, r.BenefitPeriod)
+	for i, v := range r.BenefitBalance {
+		n.element("ExplanationOfBenefit.benefitBalance", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefit as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefit from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefit) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefit as protobuf message ExplanationOfBenefit of fhir.proto
+func (r ExplanationOfBenefit) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefit to the encoded message
+func (r ExplanationOfBenefit) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	e.message(11, r.Type)
+	if r.SubType != nil {
+		e.message(12, *r.SubType)
+	}
+	e.varint(13, r.Use.protoNumber())
+	e.message(14, r.Patient)
+	if r.BillablePeriod != nil {
+		e.message(15, *r.BillablePeriod)
+	}
+	if r.Created != "" {
+		e.string(16, r.Created)
+	}
+	if r.Enterer != nil {
+		e.message(17, *r.Enterer)
+	}
+	e.message(18, r.Insurer)
+	e.message(19, r.Provider)
+	if r.Priority != nil {
+		e.message(20, *r.Priority)
+	}
+	if r.FundsReserveRequested != nil {
+		e.message(21, *r.FundsReserveRequested)
+	}
+	if r.FundsReserve != nil {
+		e.message(22, *r.FundsReserve)
+	}
+	for _, v := range r.Related {
+		e.message(23, v)
+	}
+	if r.Prescription != nil {
+		e.message(24, *r.Prescription)
+	}
+	if r.OriginalPrescription != nil {
+		e.message(25, *r.OriginalPrescription)
+	}
+	if r.Payee != nil {
+		e.message(26, *r.Payee)
+	}
+	if r.Referral != nil {
+		e.message(27, *r.Referral)
+	}
+	if r.Facility != nil {
+		e.message(28, *r.Facility)
+	}
+	if r.Claim != nil {
+		e.message(29, *r.Claim)
+	}
+	if r.ClaimResponse != nil {
+		e.message(30, *r.ClaimResponse)
+	}
+	e.varint(31, r.Outcome.protoNumber())
+	if r.Disposition != nil {
+		e.string(32, *r.Disposition)
+	}
+	for _, v := range r.PreAuthRef {
+		e.string(33, v)
+	}
+	for _, v := range r.PreAuthRefPeriod {
+		e.message(34, v)
+	}
+	for _, v := range r.CareTeam {
+		e.message(35, v)
+	}
+	for _, v := range r.SupportingInfo {
+		e.message(36, v)
+	}
+	for _, v := range r.Diagnosis {
+		e.message(37, v)
+	}
+	for _, v := range r.Procedure {
+		e.message(38, v)
+	}
+	if r.Precedence != nil {
+		e.varint(39, uint64(int64(*r.Precedence)))
+	}
+	for _, v := range r.Insurance {
+		e.message(40, v)
+	}
+	if r.Accident != nil {
+		e.message(41, *r.Accident)
+	}
+	for _, v := range r.Item {
+		e.message(42, v)
+	}
+	for _, v := range r.AddItem {
+		e.message(43, v)
+	}
+	for _, v := range r.Adjudication {
+		e.message(44, v)
+	}
+	for _, v := range r.Total {
+		e.message(45, v)
+	}
+	if r.Payment != nil {
+		e.message(46, *r.Payment)
+	}
+	if r.FormCode != nil {
+		e.message(47, *r.FormCode)
+	}
+	if r.Form != nil {
+		e.message(48, *r.Form)
+	}
+	for _, v := range r.ProcessNote {
+		e.message(49, v)
+	}
+	if r.BenefitPeriod != nil {
+		e.message(50, *r.BenefitPeriod)
+	}
+	for _, v := range r.BenefitBalance {
+		e.message(51, v)
+	}
+	if r.IdElement != nil {
+		e.message(52, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(53, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(54, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(55, *r.StatusElement)
+	}
+	if r.UseElement != nil {
+		e.message(56, *r.UseElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(57, *r.CreatedElement)
+	}
+	if r.OutcomeElement != nil {
+		e.message(58, *r.OutcomeElement)
+	}
+	if r.DispositionElement != nil {
+		e.message(59, *r.DispositionElement)
+	}
+	for _, v := range r.PreAuthRefElement {
+		e.element(60, v)
+	}
+	if r.PrecedenceElement != nil {
+		e.message(61, *r.PrecedenceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefit from protobuf message ExplanationOfBenefit of fhir.proto
+func (r *ExplanationOfBenefit) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefit{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v ExplanationOfBenefitStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubType = &v
+		case 13:
+			var v Use
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Use = v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.BillablePeriod = &v
+		case 16:
+			r.Created = d.string()
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Enterer = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Insurer = v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Provider = v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Priority = &v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.FundsReserveRequested = &v
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.FundsReserve = &v
+		case 23:
+			var v ExplanationOfBenefitRelated
+			d.message(&v)
+			r.Related = append(r.Related, v)
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Prescription = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.OriginalPrescription = &v
+		case 26:
+			var v ExplanationOfBenefitPayee
+			d.message(&v)
+			r.Payee = &v
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.Referral = &v
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Facility = &v
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.Claim = &v
+		case 30:
+			var v Reference
+			d.message(&v)
+			r.ClaimResponse = &v
+		case 31:
+			var v ClaimProcessingCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Outcome = v
+		case 32:
+			v := d.string()
+			r.Disposition = &v
+		case 33:
+			r.PreAuthRef = append(r.PreAuthRef, d.string())
+		case 34:
+			var v Period
+			d.message(&v)
+			r.PreAuthRefPeriod = append(r.PreAuthRefPeriod, v)
+		case 35:
+			var v ExplanationOfBenefitCareTeam
+			d.message(&v)
+			r.CareTeam = append(r.CareTeam, v)
+		case 36:
+			var v ExplanationOfBenefitSupportingInfo
+			d.message(&v)
+			r.SupportingInfo = append(r.SupportingInfo, v)
+		case 37:
+			var v ExplanationOfBenefitDiagnosis
+			d.message(&v)
+			r.Diagnosis = append(r.Diagnosis, v)
+		case 38:
+			var v ExplanationOfBenefitProcedure
+			d.message(&v)
+			r.Procedure = append(r.Procedure, v)
+		case 39:
+			v := d.int()
+			r.Precedence = &v
+		case 40:
+			var v ExplanationOfBenefitInsurance
+			d.message(&v)
+			r.Insurance = append(r.Insurance, v)
+		case 41:
+			var v ExplanationOfBenefitAccident
+			d.message(&v)
+			r.Accident = &v
+		case 42:
+			var v ExplanationOfBenefitItem
+			d.message(&v)
+			r.Item = append(r.Item, v)
+		case 43:
+			var v ExplanationOfBenefitAddItem
+			d.message(&v)
+			r.AddItem = append(r.AddItem, v)
+		case 44:
+			var v ExplanationOfBenefitItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 45:
+			var v ExplanationOfBenefitTotal
+			d.message(&v)
+			r.Total = append(r.Total, v)
+		case 46:
+			var v ExplanationOfBenefitPayment
+			d.message(&v)
+			r.Payment = &v
+		case 47:
+			var v CodeableConcept
+			d.message(&v)
+			r.FormCode = &v
+		case 48:
+			var v Attachment
+			d.message(&v)
+			r.Form = &v
+		case 49:
+			var v ExplanationOfBenefitProcessNote
+			d.message(&v)
+			r.ProcessNote = append(r.ProcessNote, v)
+		case 50:
+			var v Period
+			d.message(&v)
+			r.BenefitPeriod = &v
+		case 51:
+			var v ExplanationOfBenefitBenefitBalance
+			d.message(&v)
+			r.BenefitBalance = append(r.BenefitBalance, v)
+		case 52:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 54:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.UseElement = &v
+		case 57:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		case 58:
+			var v Element
+			d.message(&v)
+			r.OutcomeElement = &v
+		case 59:
+			var v Element
+			d.message(&v)
+			r.DispositionElement = &v
+		case 60:
+			r.PreAuthRefElement = append(r.PreAuthRefElement, d.element())
+		case 61:
+			var v Element
+			d.message(&v)
+			r.PrecedenceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitRelated as JSON into a byte slice
+func (r ExplanationOfBenefitRelated) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitRelated as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitRelated) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Claim != nil {
+		e.key("claim")
+		r.Claim.appendJSON(e)
+	}
+	if r.Relationship != nil {
+		e.key("relationship")
+		r.Relationship.appendJSON(e)
+	}
+	if r.Reference != nil {
+		e.key("reference")
+		r.Reference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitRelated from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitRelated) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitRelated
+func (r *ExplanationOfBenefitRelated) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "claim":
+			if d.null() {
+				r.Claim = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Claim = &v
+			}
+		case "relationship":
+			if d.null() {
+				r.Relationship = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Relationship = &v
+			}
+		case "reference":
+			if d.null() {
+				r.Reference = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Reference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitRelated which shares no memory with the original
+func (r ExplanationOfBenefitRelated) DeepCopy() ExplanationOfBenefitRelated {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Claim != nil {
+		v := r.Claim.DeepCopy()
+		out.Claim = &v
+	}
+	if r.Relationship != nil {
+		v := r.Relationship.DeepCopy()
+		out.Relationship = &v
+	}
+	if r.Reference != nil {
+		v := r.Reference.DeepCopy()
+		out.Reference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitRelated) Equal(other ExplanationOfBenefitRelated) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Claim == nil) != (other.Claim == nil) || r.Claim != nil && !r.Claim.Equal(*other.Claim) {
+		return false
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.Equal(*other.Relationship) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !r.Reference.Equal(*other.Reference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitRelated) EqualsDeep(other ExplanationOfBenefitRelated) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Claim == nil) != (other.Claim == nil) || r.Claim != nil && !r.Claim.EqualsDeep(*other.Claim) {
+		return false
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.EqualsDeep(*other.Relationship) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !r.Reference.EqualsDeep(*other.Reference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitRelated) EqualsShallow(other ExplanationOfBenefitRelated) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitRelated as FHIR XML
+func (r ExplanationOfBenefitRelated) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "claim", r.Claim); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "relationship", r.Relationship); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reference", r.Reference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitRelated from FHIR XML
+func (r *ExplanationOfBenefitRelated) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "claim":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Claim = &v
+			case "relationship":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Relationship = &v
+			case "reference":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitRelated as properties to the RDF node n
+func (r ExplanationOfBenefitRelated) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ExplanationOfBenefit.related.claim", -1, r.Claim)
+	n.element("ExplanationOfBenefit.related.relationship", -1, r.Relationship)
+	n.element("ExplanationOfBenefit.related.reference", -1, r.Reference)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitRelated as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitRelated) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitRelated from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitRelated) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitRelated as protobuf message ExplanationOfBenefitRelated of fhir.proto
+func (r ExplanationOfBenefitRelated) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitRelated to the encoded message
+func (r ExplanationOfBenefitRelated) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Claim != nil {
+		e.message(4, *r.Claim)
+	}
+	if r.Relationship != nil {
+		e.message(5, *r.Relationship)
+	}
+	if r.Reference != nil {
+		e.message(6, *r.Reference)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitRelated from protobuf message ExplanationOfBenefitRelated of fhir.proto
+func (r *ExplanationOfBenefitRelated) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitRelated{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Claim = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Relationship = &v
+		case 6:
+			var v Identifier
+			d.message(&v)
+			r.Reference = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitPayee as JSON into a byte slice
+func (r ExplanationOfBenefitPayee) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitPayee as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitPayee) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Party != nil {
+		e.key("party")
+		r.Party.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitPayee from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitPayee) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitPayee
+func (r *ExplanationOfBenefitPayee) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "party":
+			if d.null() {
+				r.Party = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Party = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitPayee which shares no memory with the original
+func (r ExplanationOfBenefitPayee) DeepCopy() ExplanationOfBenefitPayee {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Party != nil {
+		v := r.Party.DeepCopy()
+		out.Party = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitPayee) Equal(other ExplanationOfBenefitPayee) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Party == nil) != (other.Party == nil) || r.Party != nil && !r.Party.Equal(*other.Party) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitPayee) EqualsDeep(other ExplanationOfBenefitPayee) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Party == nil) != (other.Party == nil) || r.Party != nil && !r.Party.EqualsDeep(*other.Party) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitPayee) EqualsShallow(other ExplanationOfBenefitPayee) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitPayee as FHIR XML
+func (r ExplanationOfBenefitPayee) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "party", r.Party); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitPayee from FHIR XML
+func (r *ExplanationOfBenefitPayee) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "party":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Party = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitPayee as properties to the RDF node n
+func (r ExplanationOfBenefitPayee) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ExplanationOfBenefit.payee.type", -1, r.Type)
+	n.element("ExplanationOfBenefit.payee.party", -1, r.Party)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitPayee as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitPayee) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitPayee from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitPayee) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitPayee as protobuf message ExplanationOfBenefitPayee of fhir.proto
+func (r ExplanationOfBenefitPayee) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitPayee to the encoded message
+func (r ExplanationOfBenefitPayee) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Party != nil {
+		e.message(5, *r.Party)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitPayee from protobuf message ExplanationOfBenefitPayee of fhir.proto
+func (r *ExplanationOfBenefitPayee) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitPayee{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Party = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitCareTeam as JSON into a byte slice
+func (r ExplanationOfBenefitCareTeam) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitCareTeam as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitCareTeam) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	e.key("provider")
+	r.Provider.appendJSON(e)
+	if r.Responsible != nil {
+		e.key("responsible")
+		e.bool(*r.Responsible)
+	}
+	if r.ResponsibleElement != nil {
+		e.key("_responsible")
+		r.ResponsibleElement.appendJSON(e)
+	}
+	if r.Role != nil {
+		e.key("role")
+		r.Role.appendJSON(e)
+	}
+	if r.Qualification != nil {
+		e.key("qualification")
+		r.Qualification.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitCareTeam from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitCareTeam) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitCareTeam
+func (r *ExplanationOfBenefitCareTeam) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "provider":
+			r.Provider.decodeJSON(d)
+		case "responsible":
+			if v, ok := d.bool(); ok {
+				r.Responsible = &v
+			} else {
+				r.Responsible = nil
+			}
+		case "_responsible":
+			r.ResponsibleElement = d.element()
+		case "role":
+			if d.null() {
+				r.Role = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = &v
+			}
+		case "qualification":
+			if d.null() {
+				r.Qualification = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Qualification = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitCareTeam which shares no memory with the original
+func (r ExplanationOfBenefitCareTeam) DeepCopy() ExplanationOfBenefitCareTeam {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	out.Provider = r.Provider.DeepCopy()
+	if r.Responsible != nil {
+		v := *r.Responsible
+		out.Responsible = &v
+	}
+	if r.ResponsibleElement != nil {
+		v := r.ResponsibleElement.DeepCopy()
+		out.ResponsibleElement = &v
+	}
+	if r.Role != nil {
+		v := r.Role.DeepCopy()
+		out.Role = &v
+	}
+	if r.Qualification != nil {
+		v := r.Qualification.DeepCopy()
+		out.Qualification = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitCareTeam) Equal(other ExplanationOfBenefitCareTeam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if !r.Provider.Equal(other.Provider) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && *r.Responsible != *other.Responsible {
+		return false
+	}
+	if (r.ResponsibleElement == nil) != (other.ResponsibleElement == nil) || r.ResponsibleElement != nil && !r.ResponsibleElement.Equal(*other.ResponsibleElement) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.Equal(*other.Role) {
+		return false
+	}
+	if (r.Qualification == nil) != (other.Qualification == nil) || r.Qualification != nil && !r.Qualification.Equal(*other.Qualification) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitCareTeam) EqualsDeep(other ExplanationOfBenefitCareTeam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if !r.Provider.EqualsDeep(other.Provider) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && *r.Responsible != *other.Responsible {
+		return false
+	}
+	if (r.ResponsibleElement == nil) != (other.ResponsibleElement == nil) || r.ResponsibleElement != nil && !r.ResponsibleElement.EqualsDeep(*other.ResponsibleElement) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.EqualsDeep(*other.Role) {
+		return false
+	}
+	if (r.Qualification == nil) != (other.Qualification == nil) || r.Qualification != nil && !r.Qualification.EqualsDeep(*other.Qualification) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitCareTeam) EqualsShallow(other ExplanationOfBenefitCareTeam) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && *r.Responsible != *other.Responsible {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitCareTeam as FHIR XML
+func (r ExplanationOfBenefitCareTeam) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "provider", r.Provider); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "responsible", r.Responsible, r.ResponsibleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "qualification", r.Qualification); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitCareTeam from FHIR XML
+func (r *ExplanationOfBenefitCareTeam) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = v
+			case "responsible":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Responsible = &v
+				}
+				r.ResponsibleElement = element
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = &v
+			case "qualification":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Qualification = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitCareTeam as properties to the RDF node n
+func (r ExplanationOfBenefitCareTeam) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.careTeam.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("ExplanationOfBenefit.careTeam.provider", -1, r.Provider)
+	n.primitive("ExplanationOfBenefit.careTeam.responsible", -1, r.Responsible, r.ResponsibleElement, "boolean")
+	n.element("ExplanationOfBenefit.careTeam.role", -1, r.Role)
+	n.element("ExplanationOfBenefit.careTeam.qualification", -1, r.Qualification)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitCareTeam as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitCareTeam) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitCareTeam from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitCareTeam) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitCareTeam as protobuf message ExplanationOfBenefitCareTeam of fhir.proto
+func (r ExplanationOfBenefitCareTeam) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitCareTeam to the encoded message
+func (r ExplanationOfBenefitCareTeam) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	e.message(5, r.Provider)
+	if r.Responsible != nil {
+		e.varint(6, protoBool(*r.Responsible))
+	}
+	if r.Role != nil {
+		e.message(7, *r.Role)
+	}
+	if r.Qualification != nil {
+		e.message(8, *r.Qualification)
+	}
+	if r.SequenceElement != nil {
+		e.message(9, *r.SequenceElement)
+	}
+	if r.ResponsibleElement != nil {
+		e.message(10, *r.ResponsibleElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitCareTeam from protobuf message ExplanationOfBenefitCareTeam of fhir.proto
+func (r *ExplanationOfBenefitCareTeam) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitCareTeam{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Provider = v
+		case 6:
+			v := d.bool()
+			r.Responsible = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Qualification = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ResponsibleElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitSupportingInfo as JSON into a byte slice
+func (r ExplanationOfBenefitSupportingInfo) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitSupportingInfo as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitSupportingInfo) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	e.key("category")
+	r.Category.appendJSON(e)
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.TimingDate != nil {
+		e.key("timingDate")
+		e.string(*r.TimingDate)
+	}
+	if r.TimingDateElement != nil {
+		e.key("_timingDate")
+		r.TimingDateElement.appendJSON(e)
+	}
+	if r.TimingPeriod != nil {
+		e.key("timingPeriod")
+		r.TimingPeriod.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueAttachment != nil {
+		e.key("valueAttachment")
+		r.ValueAttachment.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	if r.Reason != nil {
+		e.key("reason")
+		r.Reason.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitSupportingInfo from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitSupportingInfo) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitSupportingInfo
+func (r *ExplanationOfBenefitSupportingInfo) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "category":
+			r.Category.decodeJSON(d)
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "timingDate":
+			if v, ok := d.string(); ok {
+				r.TimingDate = &v
+			} else {
+				r.TimingDate = nil
+			}
+		case "_timingDate":
+			r.TimingDateElement = d.element()
+		case "timingPeriod":
+			if d.null() {
+				r.TimingPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.TimingPeriod = &v
+			}
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueAttachment":
+			if d.null() {
+				r.ValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ValueAttachment = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		case "reason":
+			if d.null() {
+				r.Reason = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Reason = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitSupportingInfo which shares no memory with the original
+func (r ExplanationOfBenefitSupportingInfo) DeepCopy() ExplanationOfBenefitSupportingInfo {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	out.Category = r.Category.DeepCopy()
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.TimingDate != nil {
+		v := *r.TimingDate
+		out.TimingDate = &v
+	}
+	if r.TimingDateElement != nil {
+		v := r.TimingDateElement.DeepCopy()
+		out.TimingDateElement = &v
+	}
+	if r.TimingPeriod != nil {
+		v := r.TimingPeriod.DeepCopy()
+		out.TimingPeriod = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueAttachment != nil {
+		v := r.ValueAttachment.DeepCopy()
+		out.ValueAttachment = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	if r.Reason != nil {
+		v := r.Reason.DeepCopy()
+		out.Reason = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitSupportingInfo) Equal(other ExplanationOfBenefitSupportingInfo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if !r.Category.Equal(other.Category) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.TimingDate == nil) != (other.TimingDate == nil) || r.TimingDate != nil && *r.TimingDate != *other.TimingDate {
+		return false
+	}
+	if (r.TimingDateElement == nil) != (other.TimingDateElement == nil) || r.TimingDateElement != nil && !r.TimingDateElement.Equal(*other.TimingDateElement) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.Equal(*other.TimingPeriod) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.Equal(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.Equal(*other.Reason) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitSupportingInfo) EqualsDeep(other ExplanationOfBenefitSupportingInfo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if !r.Category.EqualsDeep(other.Category) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.TimingDate == nil) != (other.TimingDate == nil) || r.TimingDate != nil && !equivalentString(*r.TimingDate, *other.TimingDate) {
+		return false
+	}
+	if (r.TimingDateElement == nil) != (other.TimingDateElement == nil) || r.TimingDateElement != nil && !r.TimingDateElement.EqualsDeep(*other.TimingDateElement) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.EqualsDeep(*other.TimingPeriod) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.EqualsDeep(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.EqualsDeep(*other.Reason) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitSupportingInfo) EqualsShallow(other ExplanationOfBenefitSupportingInfo) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.TimingDate == nil) != (other.TimingDate == nil) || r.TimingDate != nil && !equivalentString(*r.TimingDate, *other.TimingDate) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitSupportingInfo as FHIR XML
+func (r ExplanationOfBenefitSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "timingDate", r.TimingDate, r.TimingDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingPeriod", r.TimingPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAttachment", r.ValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reason", r.Reason); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitSupportingInfo from FHIR XML
+func (r *ExplanationOfBenefitSupportingInfo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "timingDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimingDate = &v
+				}
+				r.TimingDateElement = element
+			case "timingPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingPeriod = &v
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAttachment = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			case "reason":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitSupportingInfo as properties to the RDF node n
+func (r ExplanationOfBenefitSupportingInfo) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.supportingInfo.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("ExplanationOfBenefit.supportingInfo.category", -1, r.Category)
+	n.element("ExplanationOfBenefit.supportingInfo.code", -1, r.Code)
+	n.primitive("ExplanationOfBenefit.supportingInfo.timingDate", -1, r.TimingDate, r.TimingDateElement, "date")
+	n.element("ExplanationOfBenefit.supportingInfo.timingPeriod", -1, r.TimingPeriod)
+	n.primitive("ExplanationOfBenefit.supportingInfo.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("ExplanationOfBenefit.supportingInfo.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.element("ExplanationOfBenefit.supportingInfo.valueQuantity", -1, r.ValueQuantity)
+	n.element("ExplanationOfBenefit.supportingInfo.valueAttachment", -1, r.ValueAttachment)
+	n.element("ExplanationOfBenefit.supportingInfo.valueReference", -1, r.ValueReference)
+	n.element("ExplanationOfBenefit.supportingInfo.reason", -1, r.Reason)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitSupportingInfo as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitSupportingInfo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitSupportingInfo from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitSupportingInfo) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitSupportingInfo as protobuf message ExplanationOfBenefitSupportingInfo of fhir.proto
+func (r ExplanationOfBenefitSupportingInfo) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitSupportingInfo to the encoded message
+func (r ExplanationOfBenefitSupportingInfo) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	e.message(5, r.Category)
+	if r.Code != nil {
+		e.message(6, *r.Code)
+	}
+	if r.TimingDate != nil {
+		e.string(7, *r.TimingDate)
+	}
+	if r.TimingPeriod != nil {
+		e.message(8, *r.TimingPeriod)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(9, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueString != nil {
+		e.string(10, *r.ValueString)
+	}
+	if r.ValueQuantity != nil {
+		e.message(11, *r.ValueQuantity)
+	}
+	if r.ValueAttachment != nil {
+		e.message(12, *r.ValueAttachment)
+	}
+	if r.ValueReference != nil {
+		e.message(13, *r.ValueReference)
+	}
+	if r.Reason != nil {
+		e.message(14, *r.Reason)
+	}
+	if r.SequenceElement != nil {
+		e.message(15, *r.SequenceElement)
+	}
+	if r.TimingDateElement != nil {
+		e.message(16, *r.TimingDateElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(17, *r.ValueBooleanElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(18, *r.ValueStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitSupportingInfo from protobuf message ExplanationOfBenefitSupportingInfo of fhir.proto
+func (r *ExplanationOfBenefitSupportingInfo) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitSupportingInfo{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 7:
+			v := d.string()
+			r.TimingDate = &v
+		case 8:
+			var v Period
+			d.message(&v)
+			r.TimingPeriod = &v
+		case 9:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 10:
+			v := d.string()
+			r.ValueString = &v
+		case 11:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 12:
+			var v Attachment
+			d.message(&v)
+			r.ValueAttachment = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 14:
+			var v Coding
+			d.message(&v)
+			r.Reason = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.TimingDateElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitDiagnosis as JSON into a byte slice
+func (r ExplanationOfBenefitDiagnosis) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitDiagnosis as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitDiagnosis) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.DiagnosisCodeableConcept != nil {
+		e.key("diagnosisCodeableConcept")
+		r.DiagnosisCodeableConcept.appendJSON(e)
+	}
+	if r.DiagnosisReference != nil {
+		e.key("diagnosisReference")
+		r.DiagnosisReference.appendJSON(e)
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.OnAdmission != nil {
+		e.key("onAdmission")
+		r.OnAdmission.appendJSON(e)
+	}
+	if r.PackageCode != nil {
+		e.key("packageCode")
+		r.PackageCode.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitDiagnosis from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitDiagnosis) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitDiagnosis
+func (r *ExplanationOfBenefitDiagnosis) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "diagnosisCodeableConcept":
+			if d.null() {
+				r.DiagnosisCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DiagnosisCodeableConcept = &v
+			}
+		case "diagnosisReference":
+			if d.null() {
+				r.DiagnosisReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.DiagnosisReference = &v
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "onAdmission":
+			if d.null() {
+				r.OnAdmission = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OnAdmission = &v
+			}
+		case "packageCode":
+			if d.null() {
+				r.PackageCode = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PackageCode = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitDiagnosis which shares no memory with the original
+func (r ExplanationOfBenefitDiagnosis) DeepCopy() ExplanationOfBenefitDiagnosis {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.DiagnosisCodeableConcept != nil {
+		v := r.DiagnosisCodeableConcept.DeepCopy()
+		out.DiagnosisCodeableConcept = &v
+	}
+	if r.DiagnosisReference != nil {
+		v := r.DiagnosisReference.DeepCopy()
+		out.DiagnosisReference = &v
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.OnAdmission != nil {
+		v := r.OnAdmission.DeepCopy()
+		out.OnAdmission = &v
+	}
+	if r.PackageCode != nil {
+		v := r.PackageCode.DeepCopy()
+		out.PackageCode = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitDiagnosis) Equal(other ExplanationOfBenefitDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.DiagnosisCodeableConcept == nil) != (other.DiagnosisCodeableConcept == nil) || r.DiagnosisCodeableConcept != nil && !r.DiagnosisCodeableConcept.Equal(*other.DiagnosisCodeableConcept) {
+		return false
+	}
+	if (r.DiagnosisReference == nil) != (other.DiagnosisReference == nil) || r.DiagnosisReference != nil && !r.DiagnosisReference.Equal(*other.DiagnosisReference) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if (r.OnAdmission == nil) != (other.OnAdmission == nil) || r.OnAdmission != nil && !r.OnAdmission.Equal(*other.OnAdmission) {
+		return false
+	}
+	if (r.PackageCode == nil) != (other.PackageCode == nil) || r.PackageCode != nil && !r.PackageCode.Equal(*other.PackageCode) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitDiagnosis) EqualsDeep(other ExplanationOfBenefitDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.DiagnosisCodeableConcept == nil) != (other.DiagnosisCodeableConcept == nil) || r.DiagnosisCodeableConcept != nil && !r.DiagnosisCodeableConcept.EqualsDeep(*other.DiagnosisCodeableConcept) {
+		return false
+	}
+	if (r.DiagnosisReference == nil) != (other.DiagnosisReference == nil) || r.DiagnosisReference != nil && !r.DiagnosisReference.EqualsDeep(*other.DiagnosisReference) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if (r.OnAdmission == nil) != (other.OnAdmission == nil) || r.OnAdmission != nil && !r.OnAdmission.EqualsDeep(*other.OnAdmission) {
+		return false
+	}
+	if (r.PackageCode == nil) != (other.PackageCode == nil) || r.PackageCode != nil && !r.PackageCode.EqualsDeep(*other.PackageCode) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitDiagnosis) EqualsShallow(other ExplanationOfBenefitDiagnosis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitDiagnosis as FHIR XML
+func (r ExplanationOfBenefitDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "diagnosisCodeableConcept", r.DiagnosisCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "diagnosisReference", r.DiagnosisReference); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "onAdmission", r.OnAdmission); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "packageCode", r.PackageCode); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitDiagnosis from FHIR XML
+func (r *ExplanationOfBenefitDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "diagnosisCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DiagnosisCodeableConcept = &v
+			case "diagnosisReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DiagnosisReference = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "onAdmission":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnAdmission = &v
+			case "packageCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PackageCode = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitDiagnosis as properties to the RDF node n
+func (r ExplanationOfBenefitDiagnosis) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.diagnosis.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("ExplanationOfBenefit.diagnosis.diagnosisCodeableConcept", -1, r.DiagnosisCodeableConcept)
+	n.element("ExplanationOfBenefit.diagnosis.diagnosisReference", -1, r.DiagnosisReference)
+	for i, v := range r.Type {
+		n.element("ExplanationOfBenefit.diagnosis.type", i, v)
+	}
+	n.element("ExplanationOfBenefit.diagnosis.onAdmission", -1, r.OnAdmission)
+	n.element("ExplanationOfBenefit.diagnosis.packageCode", -1, r.PackageCode)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitDiagnosis as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitDiagnosis from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitDiagnosis) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitDiagnosis as protobuf message ExplanationOfBenefitDiagnosis of fhir.proto
+func (r ExplanationOfBenefitDiagnosis) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitDiagnosis to the encoded message
+func (r ExplanationOfBenefitDiagnosis) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if r.DiagnosisCodeableConcept != nil {
+		e.message(5, *r.DiagnosisCodeableConcept)
+	}
+	if r.DiagnosisReference != nil {
+		e.message(6, *r.DiagnosisReference)
+	}
+	for _, v := range r.Type {
+		e.message(7, v)
+	}
+	if r.OnAdmission != nil {
+		e.message(8, *r.OnAdmission)
+	}
+	if r.PackageCode != nil {
+		e.message(9, *r.PackageCode)
+	}
+	if r.SequenceElement != nil {
+		e.message(10, *r.SequenceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitDiagnosis from protobuf message ExplanationOfBenefitDiagnosis of fhir.proto
+func (r *ExplanationOfBenefitDiagnosis) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitDiagnosis{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.DiagnosisCodeableConcept = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.DiagnosisReference = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.OnAdmission = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.PackageCode = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitProcedure as JSON into a byte slice
+func (r ExplanationOfBenefitProcedure) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitProcedure as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitProcedure) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.ProcedureCodeableConcept != nil {
+		e.key("procedureCodeableConcept")
+		r.ProcedureCodeableConcept.appendJSON(e)
+	}
+	if r.ProcedureReference != nil {
+		e.key("procedureReference")
+		r.ProcedureReference.appendJSON(e)
+	}
+	if len(r.Udi) > 0 {
+		e.key("udi")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Udi {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitProcedure from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitProcedure) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitProcedure
+func (r *ExplanationOfBenefitProcedure) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "procedureCodeableConcept":
+			if d.null() {
+				r.ProcedureCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProcedureCodeableConcept = &v
+			}
+		case "procedureReference":
+			if d.null() {
+				r.ProcedureReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ProcedureReference = &v
+			}
+		case "udi":
+			a := d.array()
+			r.Udi = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Udi = append(r.Udi, v)
+			}
+			if r.Udi == nil && !a.isNull {
+				r.Udi = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitProcedure which shares no memory with the original
+func (r ExplanationOfBenefitProcedure) DeepCopy() ExplanationOfBenefitProcedure {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.ProcedureCodeableConcept != nil {
+		v := r.ProcedureCodeableConcept.DeepCopy()
+		out.ProcedureCodeableConcept = &v
+	}
+	if r.ProcedureReference != nil {
+		v := r.ProcedureReference.DeepCopy()
+		out.ProcedureReference = &v
+	}
+	if r.Udi != nil {
+		out.Udi = make([]Reference, len(r.Udi))
+		for i := range r.Udi {
+			out.Udi[i] = r.Udi[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitProcedure) Equal(other ExplanationOfBenefitProcedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.ProcedureCodeableConcept == nil) != (other.ProcedureCodeableConcept == nil) || r.ProcedureCodeableConcept != nil && !r.ProcedureCodeableConcept.Equal(*other.ProcedureCodeableConcept) {
+		return false
+	}
+	if (r.ProcedureReference == nil) != (other.ProcedureReference == nil) || r.ProcedureReference != nil && !r.ProcedureReference.Equal(*other.ProcedureReference) {
+		return false
+	}
+	if len(r.Udi) != len(other.Udi) {
+		return false
+	}
+	for i := range r.Udi {
+		if !r.Udi[i].Equal(other.Udi[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitProcedure) EqualsDeep(other ExplanationOfBenefitProcedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.ProcedureCodeableConcept == nil) != (other.ProcedureCodeableConcept == nil) || r.ProcedureCodeableConcept != nil && !r.ProcedureCodeableConcept.EqualsDeep(*other.ProcedureCodeableConcept) {
+		return false
+	}
+	if (r.ProcedureReference == nil) != (other.ProcedureReference == nil) || r.ProcedureReference != nil && !r.ProcedureReference.EqualsDeep(*other.ProcedureReference) {
+		return false
+	}
+	if !equivalentList(len(r.Udi), len(other.Udi), func(i, j int) bool {
+		return r.Udi[i].EqualsDeep(other.Udi[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitProcedure) EqualsShallow(other ExplanationOfBenefitProcedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitProcedure as FHIR XML
+func (r ExplanationOfBenefitProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "procedureCodeableConcept", r.ProcedureCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "procedureReference", r.ProcedureReference); err != nil {
+		return err
+	}
+	for _, v := range r.Udi {
+		if err := encodeXMLElement(e, "udi", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitProcedure from FHIR XML
+func (r *ExplanationOfBenefitProcedure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "procedureCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProcedureCodeableConcept = &v
+			case "procedureReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProcedureReference = &v
+			case "udi":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Udi = append(r.Udi, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitProcedure as properties to the RDF node n
+func (r ExplanationOfBenefitProcedure) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.procedure.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	for i, v := range r.Type {
+		n.element("ExplanationOfBenefit.procedure.type", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.procedure.date", -1, r.Date, r.DateElement, "dateTime")
+	n.element("ExplanationOfBenefit.procedure.procedureCodeableConcept", -1, r.ProcedureCodeableConcept)
+	n.element("ExplanationOfBenefit.procedure.procedureReference", -1, r.ProcedureReference)
+	for i, v := range r.Udi {
+		n.element("ExplanationOfBenefit.procedure.udi", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitProcedure as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitProcedure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitProcedure from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitProcedure) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitProcedure as protobuf message ExplanationOfBenefitProcedure of fhir.proto
+func (r ExplanationOfBenefitProcedure) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitProcedure to the encoded message
+func (r ExplanationOfBenefitProcedure) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	for _, v := range r.Type {
+		e.message(5, v)
+	}
+	if r.Date != nil {
+		e.string(6, *r.Date)
+	}
+	if r.ProcedureCodeableConcept != nil {
+		e.message(7, *r.ProcedureCodeableConcept)
+	}
+	if r.ProcedureReference != nil {
+		e.message(8, *r.ProcedureReference)
+	}
+	for _, v := range r.Udi {
+		e.message(9, v)
+	}
+	if r.SequenceElement != nil {
+		e.message(10, *r.SequenceElement)
+	}
+	if r.DateElement != nil {
+		e.message(11, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitProcedure from protobuf message ExplanationOfBenefitProcedure of fhir.proto
+func (r *ExplanationOfBenefitProcedure) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitProcedure{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 6:
+			v := d.string()
+			r.Date = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProcedureCodeableConcept = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.ProcedureReference = &v
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Udi = append(r.Udi, v)
+		case 10:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitInsurance as JSON into a byte slice
+func (r ExplanationOfBenefitInsurance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitInsurance as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitInsurance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("focal")
+	e.bool(r.Focal)
+	if r.FocalElement != nil {
+		e.key("_focal")
+		r.FocalElement.appendJSON(e)
+	}
+	e.key("coverage")
+	r.Coverage.appendJSON(e)
+	if len(r.PreAuthRef) > 0 {
+		e.key("preAuthRef")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PreAuthRef {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PreAuthRefElement) > 0 {
+		e.key("_preAuthRef")
+		e.elements(r.PreAuthRefElement, len(r.PreAuthRef))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitInsurance from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitInsurance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitInsurance
+func (r *ExplanationOfBenefitInsurance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "focal":
+			if v, ok := d.bool(); ok {
+				r.Focal = v
+			}
+		case "_focal":
+			r.FocalElement = d.element()
+		case "coverage":
+			r.Coverage.decodeJSON(d)
+		case "preAuthRef":
+			a := d.array()
+			r.PreAuthRef = nil
+			for a.next() {
+				v, _ := d.string()
+				r.PreAuthRef = append(r.PreAuthRef, v)
+			}
+			if r.PreAuthRef == nil && !a.isNull {
+				r.PreAuthRef = []string{}
+			}
+		case "_preAuthRef":
+			a := d.array()
+			r.PreAuthRefElement = nil
+			for a.next() {
+				r.PreAuthRefElement = append(r.PreAuthRefElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitInsurance which shares no memory with the original
+func (r ExplanationOfBenefitInsurance) DeepCopy() ExplanationOfBenefitInsurance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.FocalElement != nil {
+		v := r.FocalElement.DeepCopy()
+		out.FocalElement = &v
+	}
+	out.Coverage = r.Coverage.DeepCopy()
+	if r.PreAuthRef != nil {
+		out.PreAuthRef = make([]string, len(r.PreAuthRef))
+		copy(out.PreAuthRef, r.PreAuthRef)
+	}
+	if r.PreAuthRefElement != nil {
+		out.PreAuthRefElement = make([]*Element, len(r.PreAuthRefElement))
+		for i, v := range r.PreAuthRefElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PreAuthRefElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitInsurance) Equal(other ExplanationOfBenefitInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Focal != other.Focal {
+		return false
+	}
+	if (r.FocalElement == nil) != (other.FocalElement == nil) || r.FocalElement != nil && !r.FocalElement.Equal(*other.FocalElement) {
+		return false
+	}
+	if !r.Coverage.Equal(other.Coverage) {
+		return false
+	}
+	if len(r.PreAuthRef) != len(other.PreAuthRef) {
+		return false
+	}
+	for i := range r.PreAuthRef {
+		if r.PreAuthRef[i] != other.PreAuthRef[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PreAuthRefElement, other.PreAuthRefElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitInsurance) EqualsDeep(other ExplanationOfBenefitInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Focal != other.Focal {
+		return false
+	}
+	if (r.FocalElement == nil) != (other.FocalElement == nil) || r.FocalElement != nil && !r.FocalElement.EqualsDeep(*other.FocalElement) {
+		return false
+	}
+	if !r.Coverage.EqualsDeep(other.Coverage) {
+		return false
+	}
+	if !equivalentList(len(r.PreAuthRef), len(other.PreAuthRef), func(i, j int) bool {
+		return equivalentString(r.PreAuthRef[i], other.PreAuthRef[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PreAuthRefElement, other.PreAuthRefElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitInsurance) EqualsShallow(other ExplanationOfBenefitInsurance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Focal != other.Focal {
+		return false
+	}
+	if !equivalentList(len(r.PreAuthRef), len(other.PreAuthRef), func(i, j int) bool {
+		return equivalentString(r.PreAuthRef[i], other.PreAuthRef[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitInsurance as FHIR XML
+func (r ExplanationOfBenefitInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "focal", r.Focal, r.FocalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "coverage", r.Coverage); err != nil {
+		return err
+	}
+	for i, v := range r.PreAuthRef {
+		if err := encodeXMLPrimitive(e, "preAuthRef", v, elementAt(r.PreAuthRefElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitInsurance from FHIR XML
+func (r *ExplanationOfBenefitInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "focal":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Focal = v
+				}
+				r.FocalElement = element
+			case "coverage":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coverage = v
+			case "preAuthRef":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PreAuthRefElement = appendElement(r.PreAuthRefElement, len(r.PreAuthRef), element)
+					r.PreAuthRef = append(r.PreAuthRef, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitInsurance as properties to the RDF node n
+func (r ExplanationOfBenefitInsurance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.insurance.focal", -1, r.Focal, r.FocalElement, "boolean")
+	n.element("ExplanationOfBenefit.insurance.coverage", -1, r.Coverage)
+	for i, v := range r.PreAuthRef {
+		n.primitive("ExplanationOfBenefit.insurance.preAuthRef", i, v, elementAt(r.PreAuthRefElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitInsurance as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitInsurance from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitInsurance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitInsurance as protobuf message ExplanationOfBenefitInsurance of fhir.proto
+func (r ExplanationOfBenefitInsurance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitInsurance to the encoded message
+func (r ExplanationOfBenefitInsurance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Focal {
+		e.varint(4, protoBool(r.Focal))
+	}
+	e.message(5, r.Coverage)
+	for _, v := range r.PreAuthRef {
+		e.string(6, v)
+	}
+	if r.FocalElement != nil {
+		e.message(7, *r.FocalElement)
+	}
+	for _, v := range r.PreAuthRefElement {
+		e.element(8, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitInsurance from protobuf message ExplanationOfBenefitInsurance of fhir.proto
+func (r *ExplanationOfBenefitInsurance) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitInsurance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Focal = d.bool()
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Coverage = v
+		case 6:
+			r.PreAuthRef = append(r.PreAuthRef, d.string())
+		case 7:
+			var v Element
+			d.message(&v)
+			r.FocalElement = &v
+		case 8:
+			r.PreAuthRefElement = append(r.PreAuthRefElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitAccident as JSON into a byte slice
+func (r ExplanationOfBenefitAccident) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitAccident as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitAccident) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.LocationAddress != nil {
+		e.key("locationAddress")
+		r.LocationAddress.appendJSON(e)
+	}
+	if r.LocationReference != nil {
+		e.key("locationReference")
+		r.LocationReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitAccident from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitAccident) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitAccident
+func (r *ExplanationOfBenefitAccident) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "locationAddress":
+			if d.null() {
+				r.LocationAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.LocationAddress = &v
+			}
+		case "locationReference":
+			if d.null() {
+				r.LocationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.LocationReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitAccident which shares no memory with the original
+func (r ExplanationOfBenefitAccident) DeepCopy() ExplanationOfBenefitAccident {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.LocationAddress != nil {
+		v := r.LocationAddress.DeepCopy()
+		out.LocationAddress = &v
+	}
+	if r.LocationReference != nil {
+		v := r.LocationReference.DeepCopy()
+		out.LocationReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitAccident) Equal(other ExplanationOfBenefitAccident) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.Equal(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.Equal(*other.LocationReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitAccident) EqualsDeep(other ExplanationOfBenefitAccident) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.EqualsDeep(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.EqualsDeep(*other.LocationReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitAccident) EqualsShallow(other ExplanationOfBenefitAccident) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitAccident as FHIR XML
+func (r ExplanationOfBenefitAccident) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationAddress", r.LocationAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationReference", r.LocationReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitAccident from FHIR XML
+func (r *ExplanationOfBenefitAccident) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "locationAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationAddress = &v
+			case "locationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitAccident as properties to the RDF node n
+func (r ExplanationOfBenefitAccident) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.accident.date", -1, r.Date, r.DateElement, "date")
+	n.element("ExplanationOfBenefit.accident.type", -1, r.Type)
+	n.element("ExplanationOfBenefit.accident.locationAddress", -1, r.LocationAddress)
+	n.element("ExplanationOfBenefit.accident.locationReference", -1, r.LocationReference)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitAccident as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitAccident) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitAccident from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitAccident) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitAccident as protobuf message ExplanationOfBenefitAccident of fhir.proto
+func (r ExplanationOfBenefitAccident) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitAccident to the encoded message
+func (r ExplanationOfBenefitAccident) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Date != nil {
+		e.string(4, *r.Date)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.LocationAddress != nil {
+		e.message(6, *r.LocationAddress)
+	}
+	if r.LocationReference != nil {
+		e.message(7, *r.LocationReference)
+	}
+	if r.DateElement != nil {
+		e.message(8, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitAccident from protobuf message ExplanationOfBenefitAccident of fhir.proto
+func (r *ExplanationOfBenefitAccident) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitAccident{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Date = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v Address
+			d.message(&v)
+			r.LocationAddress = &v
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.LocationReference = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitItem as JSON into a byte slice
+func (r ExplanationOfBenefitItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitItem as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if len(r.CareTeamSequence) > 0 {
+		e.key("careTeamSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CareTeamSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CareTeamSequenceElement) > 0 {
+		e.key("_careTeamSequence")
+		e.elements(r.CareTeamSequenceElement, len(r.CareTeamSequence))
+	}
+	if len(r.DiagnosisSequence) > 0 {
+		e.key("diagnosisSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DiagnosisSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DiagnosisSequenceElement) > 0 {
+		e.key("_diagnosisSequence")
+		e.elements(r.DiagnosisSequenceElement, len(r.DiagnosisSequence))
+	}
+	if len(r.ProcedureSequence) > 0 {
+		e.key("procedureSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProcedureSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProcedureSequenceElement) > 0 {
+		e.key("_procedureSequence")
+		e.elements(r.ProcedureSequenceElement, len(r.ProcedureSequence))
+	}
+	if len(r.InformationSequence) > 0 {
+		e.key("informationSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InformationSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InformationSequenceElement) > 0 {
+		e.key("_informationSequence")
+		e.elements(r.InformationSequenceElement, len(r.InformationSequence))
+	}
+	if r.Revenue != nil {
+		e.key("revenue")
+		r.Revenue.appendJSON(e)
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProgramCode) > 0 {
+		e.key("programCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProgramCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ServicedDate != nil {
+		e.key("servicedDate")
+		e.string(*r.ServicedDate)
+	}
+	if r.ServicedDateElement != nil {
+		e.key("_servicedDate")
+		r.ServicedDateElement.appendJSON(e)
+	}
+	if r.ServicedPeriod != nil {
+		e.key("servicedPeriod")
+		r.ServicedPeriod.appendJSON(e)
+	}
+	if r.LocationCodeableConcept != nil {
+		e.key("locationCodeableConcept")
+		r.LocationCodeableConcept.appendJSON(e)
+	}
+	if r.LocationAddress != nil {
+		e.key("locationAddress")
+		r.LocationAddress.appendJSON(e)
+	}
+	if r.LocationReference != nil {
+		e.key("locationReference")
+		r.LocationReference.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.Udi) > 0 {
+		e.key("udi")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Udi {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BodySite != nil {
+		e.key("bodySite")
+		r.BodySite.appendJSON(e)
+	}
+	if len(r.SubSite) > 0 {
+		e.key("subSite")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubSite {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Encounter) > 0 {
+		e.key("encounter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Encounter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitItem from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitItem
+func (r *ExplanationOfBenefitItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "careTeamSequence":
+			a := d.array()
+			r.CareTeamSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.CareTeamSequence = append(r.CareTeamSequence, v)
+			}
+			if r.CareTeamSequence == nil && !a.isNull {
+				r.CareTeamSequence = []int{}
+			}
+		case "_careTeamSequence":
+			a := d.array()
+			r.CareTeamSequenceElement = nil
+			for a.next() {
+				r.CareTeamSequenceElement = append(r.CareTeamSequenceElement, d.element())
+			}
+		case "diagnosisSequence":
+			a := d.array()
+			r.DiagnosisSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.DiagnosisSequence = append(r.DiagnosisSequence, v)
+			}
+			if r.DiagnosisSequence == nil && !a.isNull {
+				r.DiagnosisSequence = []int{}
+			}
+		case "_diagnosisSequence":
+			a := d.array()
+			r.DiagnosisSequenceElement = nil
+			for a.next() {
+				r.DiagnosisSequenceElement = append(r.DiagnosisSequenceElement, d.element())
+			}
+		case "procedureSequence":
+			a := d.array()
+			r.ProcedureSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.ProcedureSequence = append(r.ProcedureSequence, v)
+			}
+			if r.ProcedureSequence == nil && !a.isNull {
+				r.ProcedureSequence = []int{}
+			}
+		case "_procedureSequence":
+			a := d.array()
+			r.ProcedureSequenceElement = nil
+			for a.next() {
+				r.ProcedureSequenceElement = append(r.ProcedureSequenceElement, d.element())
+			}
+		case "informationSequence":
+			a := d.array()
+			r.InformationSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.InformationSequence = append(r.InformationSequence, v)
+			}
+			if r.InformationSequence == nil && !a.isNull {
+				r.InformationSequence = []int{}
+			}
+		case "_informationSequence":
+			a := d.array()
+			r.InformationSequenceElement = nil
+			for a.next() {
+				r.InformationSequenceElement = append(r.InformationSequenceElement, d.element())
+			}
+		case "revenue":
+			if d.null() {
+				r.Revenue = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Revenue = &v
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "programCode":
+			a := d.array()
+			r.ProgramCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProgramCode = append(r.ProgramCode, v)
+			}
+			if r.ProgramCode == nil && !a.isNull {
+				r.ProgramCode = []CodeableConcept{}
+			}
+		case "servicedDate":
+			if v, ok := d.string(); ok {
+				r.ServicedDate = &v
+			} else {
+				r.ServicedDate = nil
+			}
+		case "_servicedDate":
+			r.ServicedDateElement = d.element()
+		case "servicedPeriod":
+			if d.null() {
+				r.ServicedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ServicedPeriod = &v
+			}
+		case "locationCodeableConcept":
+			if d.null() {
+				r.LocationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LocationCodeableConcept = &v
+			}
+		case "locationAddress":
+			if d.null() {
+				r.LocationAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.LocationAddress = &v
+			}
+		case "locationReference":
+			if d.null() {
+				r.LocationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.LocationReference = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "udi":
+			a := d.array()
+			r.Udi = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Udi = append(r.Udi, v)
+			}
+			if r.Udi == nil && !a.isNull {
+				r.Udi = []Reference{}
+			}
+		case "bodySite":
+			if d.null() {
+				r.BodySite = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = &v
+			}
+		case "subSite":
+			a := d.array()
+			r.SubSite = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubSite = append(r.SubSite, v)
+			}
+			if r.SubSite == nil && !a.isNull {
+				r.SubSite = []CodeableConcept{}
+			}
+		case "encounter":
+			a := d.array()
+			r.Encounter = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = append(r.Encounter, v)
+			}
+			if r.Encounter == nil && !a.isNull {
+				r.Encounter = []Reference{}
+			}
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ExplanationOfBenefitItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ExplanationOfBenefitItemAdjudication{}
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v ExplanationOfBenefitItemDetail
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []ExplanationOfBenefitItemDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitItem which shares no memory with the original
+func (r ExplanationOfBenefitItem) DeepCopy() ExplanationOfBenefitItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.CareTeamSequence != nil {
+		out.CareTeamSequence = make([]int, len(r.CareTeamSequence))
+		copy(out.CareTeamSequence, r.CareTeamSequence)
+	}
+	if r.CareTeamSequenceElement != nil {
+		out.CareTeamSequenceElement = make([]*Element, len(r.CareTeamSequenceElement))
+		for i, v := range r.CareTeamSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.CareTeamSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.DiagnosisSequence != nil {
+		out.DiagnosisSequence = make([]int, len(r.DiagnosisSequence))
+		copy(out.DiagnosisSequence, r.DiagnosisSequence)
+	}
+	if r.DiagnosisSequenceElement != nil {
+		out.DiagnosisSequenceElement = make([]*Element, len(r.DiagnosisSequenceElement))
+		for i, v := range r.DiagnosisSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DiagnosisSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.ProcedureSequence != nil {
+		out.ProcedureSequence = make([]int, len(r.ProcedureSequence))
+		copy(out.ProcedureSequence, r.ProcedureSequence)
+	}
+	if r.ProcedureSequenceElement != nil {
+		out.ProcedureSequenceElement = make([]*Element, len(r.ProcedureSequenceElement))
+		for i, v := range r.ProcedureSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ProcedureSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.InformationSequence != nil {
+		out.InformationSequence = make([]int, len(r.InformationSequence))
+		copy(out.InformationSequence, r.InformationSequence)
+	}
+	if r.InformationSequenceElement != nil {
+		out.InformationSequenceElement = make([]*Element, len(r.InformationSequenceElement))
+		for i, v := range r.InformationSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InformationSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.Revenue != nil {
+		v := r.Revenue.DeepCopy()
+		out.Revenue = &v
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.ProgramCode != nil {
+		out.ProgramCode = make([]CodeableConcept, len(r.ProgramCode))
+		for i := range r.ProgramCode {
+			out.ProgramCode[i] = r.ProgramCode[i].DeepCopy()
+		}
+	}
+	if r.ServicedDate != nil {
+		v := *r.ServicedDate
+		out.ServicedDate = &v
+	}
+	if r.ServicedDateElement != nil {
+		v := r.ServicedDateElement.DeepCopy()
+		out.ServicedDateElement = &v
+	}
+	if r.ServicedPeriod != nil {
+		v := r.ServicedPeriod.DeepCopy()
+		out.ServicedPeriod = &v
+	}
+	if r.LocationCodeableConcept != nil {
+		v := r.LocationCodeableConcept.DeepCopy()
+		out.LocationCodeableConcept = &v
+	}
+	if r.LocationAddress != nil {
+		v := r.LocationAddress.DeepCopy()
+		out.LocationAddress = &v
+	}
+	if r.LocationReference != nil {
+		v := r.LocationReference.DeepCopy()
+		out.LocationReference = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.Udi != nil {
+		out.Udi = make([]Reference, len(r.Udi))
+		for i := range r.Udi {
+			out.Udi[i] = r.Udi[i].DeepCopy()
+		}
+	}
+	if r.BodySite != nil {
+		v := r.BodySite.DeepCopy()
+		out.BodySite = &v
+	}
+	if r.SubSite != nil {
+		out.SubSite = make([]CodeableConcept, len(r.SubSite))
+		for i := range r.SubSite {
+			out.SubSite[i] = r.SubSite[i].DeepCopy()
+		}
+	}
+	if r.Encounter != nil {
+		out.Encounter = make([]Reference, len(r.Encounter))
+		for i := range r.Encounter {
+			out.Encounter[i] = r.Encounter[i].DeepCopy()
+		}
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ExplanationOfBenefitItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.Detail != nil {
+		out.Detail = make([]ExplanationOfBenefitItemDetail, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitItem) Equal(other ExplanationOfBenefitItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if len(r.CareTeamSequence) != len(other.CareTeamSequence) {
+		return false
+	}
+	for i := range r.CareTeamSequence {
+		if r.CareTeamSequence[i] != other.CareTeamSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.CareTeamSequenceElement, other.CareTeamSequenceElement, false) {
+		return false
+	}
+	if len(r.DiagnosisSequence) != len(other.DiagnosisSequence) {
+		return false
+	}
+	for i := range r.DiagnosisSequence {
+		if r.DiagnosisSequence[i] != other.DiagnosisSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DiagnosisSequenceElement, other.DiagnosisSequenceElement, false) {
+		return false
+	}
+	if len(r.ProcedureSequence) != len(other.ProcedureSequence) {
+		return false
+	}
+	for i := range r.ProcedureSequence {
+		if r.ProcedureSequence[i] != other.ProcedureSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ProcedureSequenceElement, other.ProcedureSequenceElement, false) {
+		return false
+	}
+	if len(r.InformationSequence) != len(other.InformationSequence) {
+		return false
+	}
+	for i := range r.InformationSequence {
+		if r.InformationSequence[i] != other.InformationSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InformationSequenceElement, other.InformationSequenceElement, false) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.Equal(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if len(r.ProgramCode) != len(other.ProgramCode) {
+		return false
+	}
+	for i := range r.ProgramCode {
+		if !r.ProgramCode[i].Equal(other.ProgramCode[i]) {
+			return false
+		}
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && *r.ServicedDate != *other.ServicedDate {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.Equal(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.Equal(*other.ServicedPeriod) {
+		return false
+	}
+	if (r.LocationCodeableConcept == nil) != (other.LocationCodeableConcept == nil) || r.LocationCodeableConcept != nil && !r.LocationCodeableConcept.Equal(*other.LocationCodeableConcept) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.Equal(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.Equal(*other.LocationReference) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.Udi) != len(other.Udi) {
+		return false
+	}
+	for i := range r.Udi {
+		if !r.Udi[i].Equal(other.Udi[i]) {
+			return false
+		}
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.Equal(*other.BodySite) {
+		return false
+	}
+	if len(r.SubSite) != len(other.SubSite) {
+		return false
+	}
+	for i := range r.SubSite {
+		if !r.SubSite[i].Equal(other.SubSite[i]) {
+			return false
+		}
+	}
+	if len(r.Encounter) != len(other.Encounter) {
+		return false
+	}
+	for i := range r.Encounter {
+		if !r.Encounter[i].Equal(other.Encounter[i]) {
+			return false
+		}
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitItem) EqualsDeep(other ExplanationOfBenefitItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if !equivalentList(len(r.CareTeamSequence), len(other.CareTeamSequence), func(i, j int) bool {
+		return r.CareTeamSequence[i] == other.CareTeamSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.CareTeamSequenceElement, other.CareTeamSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.DiagnosisSequence), len(other.DiagnosisSequence), func(i, j int) bool {
+		return r.DiagnosisSequence[i] == other.DiagnosisSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.DiagnosisSequenceElement, other.DiagnosisSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.ProcedureSequence), len(other.ProcedureSequence), func(i, j int) bool {
+		return r.ProcedureSequence[i] == other.ProcedureSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ProcedureSequenceElement, other.ProcedureSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InformationSequence), len(other.InformationSequence), func(i, j int) bool {
+		return r.InformationSequence[i] == other.InformationSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.InformationSequenceElement, other.InformationSequenceElement, true) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.EqualsDeep(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProgramCode), len(other.ProgramCode), func(i, j int) bool {
+		return r.ProgramCode[i].EqualsDeep(other.ProgramCode[j])
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.EqualsDeep(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.EqualsDeep(*other.ServicedPeriod) {
+		return false
+	}
+	if (r.LocationCodeableConcept == nil) != (other.LocationCodeableConcept == nil) || r.LocationCodeableConcept != nil && !r.LocationCodeableConcept.EqualsDeep(*other.LocationCodeableConcept) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.EqualsDeep(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.EqualsDeep(*other.LocationReference) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.Udi), len(other.Udi), func(i, j int) bool {
+		return r.Udi[i].EqualsDeep(other.Udi[j])
+	}) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.EqualsDeep(*other.BodySite) {
+		return false
+	}
+	if !equivalentList(len(r.SubSite), len(other.SubSite), func(i, j int) bool {
+		return r.SubSite[i].EqualsDeep(other.SubSite[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Encounter), len(other.Encounter), func(i, j int) bool {
+		return r.Encounter[i].EqualsDeep(other.Encounter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitItem) EqualsShallow(other ExplanationOfBenefitItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if !equivalentList(len(r.CareTeamSequence), len(other.CareTeamSequence), func(i, j int) bool {
+		return r.CareTeamSequence[i] == other.CareTeamSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DiagnosisSequence), len(other.DiagnosisSequence), func(i, j int) bool {
+		return r.DiagnosisSequence[i] == other.DiagnosisSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProcedureSequence), len(other.ProcedureSequence), func(i, j int) bool {
+		return r.ProcedureSequence[i] == other.ProcedureSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InformationSequence), len(other.InformationSequence), func(i, j int) bool {
+		return r.InformationSequence[i] == other.InformationSequence[j]
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitItem as FHIR XML
+func (r ExplanationOfBenefitItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	for i, v := range r.CareTeamSequence {
+		if err := encodeXMLPrimitive(e, "careTeamSequence", v, elementAt(r.CareTeamSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.DiagnosisSequence {
+		if err := encodeXMLPrimitive(e, "diagnosisSequence", v, elementAt(r.DiagnosisSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ProcedureSequence {
+		if err := encodeXMLPrimitive(e, "procedureSequence", v, elementAt(r.ProcedureSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InformationSequence {
+		if err := encodeXMLPrimitive(e, "informationSequence", v, elementAt(r.InformationSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "revenue", r.Revenue); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProgramCode {
+		if err := encodeXMLElement(e, "programCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "servicedDate", r.ServicedDate, r.ServicedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "servicedPeriod", r.ServicedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationCodeableConcept", r.LocationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationAddress", r.LocationAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationReference", r.LocationReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for _, v := range r.Udi {
+		if err := encodeXMLElement(e, "udi", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "bodySite", r.BodySite); err != nil {
+		return err
+	}
+	for _, v := range r.SubSite {
+		if err := encodeXMLElement(e, "subSite", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Encounter {
+		if err := encodeXMLElement(e, "encounter", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitItem from FHIR XML
+func (r *ExplanationOfBenefitItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "careTeamSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.CareTeamSequenceElement = appendElement(r.CareTeamSequenceElement, len(r.CareTeamSequence), element)
+					r.CareTeamSequence = append(r.CareTeamSequence, v)
+				}
+			case "diagnosisSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DiagnosisSequenceElement = appendElement(r.DiagnosisSequenceElement, len(r.DiagnosisSequence), element)
+					r.DiagnosisSequence = append(r.DiagnosisSequence, v)
+				}
+			case "procedureSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ProcedureSequenceElement = appendElement(r.ProcedureSequenceElement, len(r.ProcedureSequence), element)
+					r.ProcedureSequence = append(r.ProcedureSequence, v)
+				}
+			case "informationSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InformationSequenceElement = appendElement(r.InformationSequenceElement, len(r.InformationSequence), element)
+					r.InformationSequence = append(r.InformationSequence, v)
+				}
+			case "revenue":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Revenue = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "programCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProgramCode = append(r.ProgramCode, v)
+			case "servicedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ServicedDate = &v
+				}
+				r.ServicedDateElement = element
+			case "servicedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServicedPeriod = &v
+			case "locationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationCodeableConcept = &v
+			case "locationAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationAddress = &v
+			case "locationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationReference = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "udi":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Udi = append(r.Udi, v)
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = &v
+			case "subSite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubSite = append(r.SubSite, v)
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = append(r.Encounter, v)
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ExplanationOfBenefitItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "detail":
+				var v ExplanationOfBenefitItemDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitItem as properties to the RDF node n
+func (r ExplanationOfBenefitItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.item.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	for i, v := range r.CareTeamSequence {
+		n.primitive("ExplanationOfBenefit.item.careTeamSequence", i, v, elementAt(r.CareTeamSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.DiagnosisSequence {
+		n.primitive("ExplanationOfBenefit.item.diagnosisSequence", i, v, elementAt(r.DiagnosisSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.ProcedureSequence {
+		n.primitive("ExplanationOfBenefit.item.procedureSequence", i, v, elementAt(r.ProcedureSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.InformationSequence {
+		n.primitive("ExplanationOfBenefit.item.informationSequence", i, v, elementAt(r.InformationSequenceElement, i), "positiveInt")
+	}
+	n.element("ExplanationOfBenefit.item.revenue", -1, r.Revenue)
+	n.element("ExplanationOfBenefit.item.category", -1, r.Category)
+	n.element("ExplanationOfBenefit.item.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("ExplanationOfBenefit.item.modifier", i, v)
+	}
+	for i, v := range r.ProgramCode {
+		n.element("ExplanationOfBenefit.item.programCode", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.item.servicedDate", -1, r.ServicedDate, r.ServicedDateElement, "date")
+	n.element("ExplanationOfBenefit.item.servicedPeriod", -1, r.ServicedPeriod)
+	n.element("ExplanationOfBenefit.item.locationCodeableConcept", -1, r.LocationCodeableConcept)
+	n.element("ExplanationOfBenefit.item.locationAddress", -1, r.LocationAddress)
+	n.element("ExplanationOfBenefit.item.locationReference", -1, r.LocationReference)
+	n.element("ExplanationOfBenefit.item.quantity", -1, r.Quantity)
+	n.element("ExplanationOfBenefit.item.unitPrice", -1, r.UnitPrice)
+	n.primitive("ExplanationOfBenefit.item.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ExplanationOfBenefit.item.net", -1, r.Net)
+	for i, v := range r.Udi {
+		n.element("ExplanationOfBenefit.item.udi", i, v)
+	}
+	n.element("ExplanationOfBenefit.item.bodySite", -1, r.BodySite)
+	for i, v := range r.SubSite {
+		n.element("ExplanationOfBenefit.item.subSite", i, v)
+	}
+	for i, v := range r.Encounter {
+		n.element("ExplanationOfBenefit.item.encounter", i, v)
+	}
+	for i, v := range r.NoteNumber {
+		n.primitive("ExplanationOfBenefit.item.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ExplanationOfBenefit.item.adjudication", i, v)
+	}
+	for i, v := range r.Detail {
+		n.element("ExplanationOfBenefit.item.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitItem as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitItem from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitItem as protobuf message ExplanationOfBenefitItem of fhir.proto
+func (r ExplanationOfBenefitItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitItem to the encoded message
+func (r ExplanationOfBenefitItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if len(r.CareTeamSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.CareTeamSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(5, p.buf)
+	}
+	if len(r.DiagnosisSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.DiagnosisSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(6, p.buf)
+	}
+	if len(r.ProcedureSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.ProcedureSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(7, p.buf)
+	}
+	if len(r.InformationSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.InformationSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(8, p.buf)
+	}
+	if r.Revenue != nil {
+		e.message(9, *r.Revenue)
+	}
+	if r.Category != nil {
+		e.message(10, *r.Category)
+	}
+	e.message(11, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(12, v)
+	}
+	for _, v := range r.ProgramCode {
+		e.message(13, v)
+	}
+	if r.ServicedDate != nil {
+		e.string(14, *r.ServicedDate)
+	}
+	if r.ServicedPeriod != nil {
+		e.message(15, *r.ServicedPeriod)
+	}
+	if r.LocationCodeableConcept != nil {
+		e.message(16, *r.LocationCodeableConcept)
+	}
+	if r.LocationAddress != nil {
+		e.message(17, *r.LocationAddress)
+	}
+	if r.LocationReference != nil {
+		e.message(18, *r.LocationReference)
+	}
+	if r.Quantity != nil {
+		e.message(19, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(20, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(21, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(22, *r.Net)
+	}
+	for _, v := range r.Udi {
+		e.message(23, v)
+	}
+	if r.BodySite != nil {
+		e.message(24, *r.BodySite)
+	}
+	for _, v := range r.SubSite {
+		e.message(25, v)
+	}
+	for _, v := range r.Encounter {
+		e.message(26, v)
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(27, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(28, v)
+	}
+	for _, v := range r.Detail {
+		e.message(29, v)
+	}
+	if r.SequenceElement != nil {
+		e.message(30, *r.SequenceElement)
+	}
+	for _, v := range r.CareTeamSequenceElement {
+		e.element(31, v)
+	}
+	for _, v := range r.DiagnosisSequenceElement {
+		e.element(32, v)
+	}
+	for _, v := range r.ProcedureSequenceElement {
+		e.element(33, v)
+	}
+	for _, v := range r.InformationSequenceElement {
+		e.element(34, v)
+	}
+	if r.ServicedDateElement != nil {
+		e.message(35, *r.ServicedDateElement)
+	}
+	if r.FactorElement != nil {
+		e.message(36, *r.FactorElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(37, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitItem from protobuf message ExplanationOfBenefitItem of fhir.proto
+func (r *ExplanationOfBenefitItem) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			for _, v := range d.varints() {
+				r.CareTeamSequence = append(r.CareTeamSequence, int(int32(v)))
+			}
+		case 6:
+			for _, v := range d.varints() {
+				r.DiagnosisSequence = append(r.DiagnosisSequence, int(int32(v)))
+			}
+		case 7:
+			for _, v := range d.varints() {
+				r.ProcedureSequence = append(r.ProcedureSequence, int(int32(v)))
+			}
+		case 8:
+			for _, v := range d.varints() {
+				r.InformationSequence = append(r.InformationSequence, int(int32(v)))
+			}
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Revenue = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProgramCode = append(r.ProgramCode, v)
+		case 14:
+			v := d.string()
+			r.ServicedDate = &v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.ServicedPeriod = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.LocationCodeableConcept = &v
+		case 17:
+			var v Address
+			d.message(&v)
+			r.LocationAddress = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.LocationReference = &v
+		case 19:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 20:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 21:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 22:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Udi = append(r.Udi, v)
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = &v
+		case 25:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubSite = append(r.SubSite, v)
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Encounter = append(r.Encounter, v)
+		case 27:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 28:
+			var v ExplanationOfBenefitItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 29:
+			var v ExplanationOfBenefitItemDetail
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		case 30:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 31:
+			r.CareTeamSequenceElement = append(r.CareTeamSequenceElement, d.element())
+		case 32:
+			r.DiagnosisSequenceElement = append(r.DiagnosisSequenceElement, d.element())
+		case 33:
+			r.ProcedureSequenceElement = append(r.ProcedureSequenceElement, d.element())
+		case 34:
+			r.InformationSequenceElement = append(r.InformationSequenceElement, d.element())
+		case 35:
+			var v Element
+			d.message(&v)
+			r.ServicedDateElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 37:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitItemAdjudication as JSON into a byte slice
+func (r ExplanationOfBenefitItemAdjudication) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitItemAdjudication as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitItemAdjudication) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("category")
+	r.Category.appendJSON(e)
+	if r.Reason != nil {
+		e.key("reason")
+		r.Reason.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	if r.Value != nil {
+		e.key("value")
+		e.number(*r.Value)
+	}
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitItemAdjudication from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitItemAdjudication) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitItemAdjudication
+func (r *ExplanationOfBenefitItemAdjudication) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			r.Category.decodeJSON(d)
+		case "reason":
+			if d.null() {
+				r.Reason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Reason = &v
+			}
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		case "value":
+			if v, ok := d.number(); ok {
+				r.Value = &v
+			} else {
+				r.Value = nil
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitItemAdjudication which shares no memory with the original
+func (r ExplanationOfBenefitItemAdjudication) DeepCopy() ExplanationOfBenefitItemAdjudication {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Category = r.Category.DeepCopy()
+	if r.Reason != nil {
+		v := r.Reason.DeepCopy()
+		out.Reason = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	if r.Value != nil {
+		v := *r.Value
+		out.Value = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitItemAdjudication) Equal(other ExplanationOfBenefitItemAdjudication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Category.Equal(other.Category) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.Equal(*other.Reason) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !EqualDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitItemAdjudication) EqualsDeep(other ExplanationOfBenefitItemAdjudication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Category.EqualsDeep(other.Category) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.EqualsDeep(*other.Reason) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitItemAdjudication) EqualsShallow(other ExplanationOfBenefitItemAdjudication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitItemAdjudication as FHIR XML
+func (r ExplanationOfBenefitItemAdjudication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reason", r.Reason); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitItemAdjudication from FHIR XML
+func (r *ExplanationOfBenefitItemAdjudication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = v
+			case "reason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = &v
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			case "value":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = &v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitItemAdjudication as properties to the RDF node n
+func (r ExplanationOfBenefitItemAdjudication) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ExplanationOfBenefit.item.adjudication.category", -1, r.Category)
+	n.element("ExplanationOfBenefit.item.adjudication.reason", -1, r.Reason)
+	n.element("ExplanationOfBenefit.item.adjudication.amount", -1, r.Amount)
+	n.primitive("ExplanationOfBenefit.item.adjudication.value", -1, r.Value, r.ValueElement, "decimal")
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitItemAdjudication as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitItemAdjudication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitItemAdjudication from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitItemAdjudication) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitItemAdjudication as protobuf message ExplanationOfBenefitItemAdjudication of fhir.proto
+func (r ExplanationOfBenefitItemAdjudication) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitItemAdjudication to the encoded message
+func (r ExplanationOfBenefitItemAdjudication) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Category)
+	if r.Reason != nil {
+		e.message(5, *r.Reason)
+	}
+	if r.Amount != nil {
+		e.message(6, *r.Amount)
+	}
+	if r.Value != nil {
+		e.string(7, string(*r.Value))
+	}
+	if r.ValueElement != nil {
+		e.message(8, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitItemAdjudication from protobuf message ExplanationOfBenefitItemAdjudication of fhir.proto
+func (r *ExplanationOfBenefitItemAdjudication) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitItemAdjudication{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Reason = &v
+		case 6:
+			var v Money
+			d.message(&v)
+			r.Amount = &v
+		case 7:
+			v := json.Number(d.string())
+			r.Value = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitItemDetail as JSON into a byte slice
+func (r ExplanationOfBenefitItemDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitItemDetail as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitItemDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.Revenue != nil {
+		e.key("revenue")
+		r.Revenue.appendJSON(e)
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProgramCode) > 0 {
+		e.key("programCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProgramCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.Udi) > 0 {
+		e.key("udi")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Udi {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SubDetail) > 0 {
+		e.key("subDetail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubDetail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitItemDetail from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitItemDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitItemDetail
+func (r *ExplanationOfBenefitItemDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "revenue":
+			if d.null() {
+				r.Revenue = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Revenue = &v
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "programCode":
+			a := d.array()
+			r.ProgramCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProgramCode = append(r.ProgramCode, v)
+			}
+			if r.ProgramCode == nil && !a.isNull {
+				r.ProgramCode = []CodeableConcept{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "udi":
+			a := d.array()
+			r.Udi = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Udi = append(r.Udi, v)
+			}
+			if r.Udi == nil && !a.isNull {
+				r.Udi = []Reference{}
+			}
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ExplanationOfBenefitItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ExplanationOfBenefitItemAdjudication{}
+			}
+		case "subDetail":
+			a := d.array()
+			r.SubDetail = nil
+			for a.next() {
+				var v ExplanationOfBenefitItemDetailSubDetail
+				v.decodeJSON(d)
+				r.SubDetail = append(r.SubDetail, v)
+			}
+			if r.SubDetail == nil && !a.isNull {
+				r.SubDetail = []ExplanationOfBenefitItemDetailSubDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitItemDetail which shares no memory with the original
+func (r ExplanationOfBenefitItemDetail) DeepCopy() ExplanationOfBenefitItemDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.Revenue != nil {
+		v := r.Revenue.DeepCopy()
+		out.Revenue = &v
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.ProgramCode != nil {
+		out.ProgramCode = make([]CodeableConcept, len(r.ProgramCode))
+		for i := range r.ProgramCode {
+			out.ProgramCode[i] = r.ProgramCode[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.Udi != nil {
+		out.Udi = make([]Reference, len(r.Udi))
+		for i := range r.Udi {
+			out.Udi[i] = r.Udi[i].DeepCopy()
+		}
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ExplanationOfBenefitItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.SubDetail != nil {
+		out.SubDetail = make([]ExplanationOfBenefitItemDetailSubDetail, len(r.SubDetail))
+		for i := range r.SubDetail {
+			out.SubDetail[i] = r.SubDetail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitItemDetail) Equal(other ExplanationOfBenefitItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.Equal(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if len(r.ProgramCode) != len(other.ProgramCode) {
+		return false
+	}
+	for i := range r.ProgramCode {
+		if !r.ProgramCode[i].Equal(other.ProgramCode[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.Udi) != len(other.Udi) {
+		return false
+	}
+	for i := range r.Udi {
+		if !r.Udi[i].Equal(other.Udi[i]) {
+			return false
+		}
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.SubDetail) != len(other.SubDetail) {
+		return false
+	}
+	for i := range r.SubDetail {
+		if !r.SubDetail[i].Equal(other.SubDetail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitItemDetail) EqualsDeep(other ExplanationOfBenefitItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.EqualsDeep(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProgramCode), len(other.ProgramCode), func(i, j int) bool {
+		return r.ProgramCode[i].EqualsDeep(other.ProgramCode[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.Udi), len(other.Udi), func(i, j int) bool {
+		return r.Udi[i].EqualsDeep(other.Udi[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SubDetail), len(other.SubDetail), func(i, j int) bool {
+		return r.SubDetail[i].EqualsDeep(other.SubDetail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitItemDetail) EqualsShallow(other ExplanationOfBenefitItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitItemDetail as FHIR XML
+func (r ExplanationOfBenefitItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "revenue", r.Revenue); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProgramCode {
+		if err := encodeXMLElement(e, "programCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for _, v := range r.Udi {
+		if err := encodeXMLElement(e, "udi", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SubDetail {
+		if err := encodeXMLElement(e, "subDetail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitItemDetail from FHIR XML
+func (r *ExplanationOfBenefitItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "revenue":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Revenue = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "programCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProgramCode = append(r.ProgramCode, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "udi":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Udi = append(r.Udi, v)
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ExplanationOfBenefitItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "subDetail":
+				var v ExplanationOfBenefitItemDetailSubDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubDetail = append(r.SubDetail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitItemDetail as properties to the RDF node n
+func (r ExplanationOfBenefitItemDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.item.detail.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("ExplanationOfBenefit.item.detail.revenue", -1, r.Revenue)
+	n.element("ExplanationOfBenefit.item.detail.category", -1, r.Category)
+	n.element("ExplanationOfBenefit.item.detail.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("ExplanationOfBenefit.item.detail.modifier", i, v)
+	}
+	for i, v := range r.ProgramCode {
+		n.element("ExplanationOfBenefit.item.detail.programCode", i, v)
+	}
+	n.element("ExplanationOfBenefit.item.detail.quantity", -1, r.Quantity)
+	n.element("ExplanationOfBenefit.item.detail.unitPrice", -1, r.UnitPrice)
+	n.primitive("ExplanationOfBenefit.item.detail.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ExplanationOfBenefit.item.detail.net", -1, r.Net)
+	for i, v := range r.Udi {
+		n.element("ExplanationOfBenefit.item.detail.udi", i, v)
+	}
+	for i, v := range r.NoteNumber {
+		n.primitive("ExplanationOfBenefit.item.detail.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ExplanationOfBenefit.item.detail.adjudication", i, v)
+	}
+	for i, v := range r.SubDetail {
+		n.element("ExplanationOfBenefit.item.detail.subDetail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitItemDetail as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitItemDetail from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitItemDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitItemDetail as protobuf message ExplanationOfBenefitItemDetail of fhir.proto
+func (r ExplanationOfBenefitItemDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitItemDetail to the encoded message
+func (r ExplanationOfBenefitItemDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if r.Revenue != nil {
+		e.message(5, *r.Revenue)
+	}
+	if r.Category != nil {
+		e.message(6, *r.Category)
+	}
+	e.message(7, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(8, v)
+	}
+	for _, v := range r.ProgramCode {
+		e.message(9, v)
+	}
+	if r.Quantity != nil {
+		e.message(10, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(11, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(12, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(13, *r.Net)
+	}
+	for _, v := range r.Udi {
+		e.message(14, v)
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(15, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(16, v)
+	}
+	for _, v := range r.SubDetail {
+		e.message(17, v)
+	}
+	if r.SequenceElement != nil {
+		e.message(18, *r.SequenceElement)
+	}
+	if r.FactorElement != nil {
+		e.message(19, *r.FactorElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(20, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitItemDetail from protobuf message ExplanationOfBenefitItemDetail of fhir.proto
+func (r *ExplanationOfBenefitItemDetail) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitItemDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Revenue = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProgramCode = append(r.ProgramCode, v)
+		case 10:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 11:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 12:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 13:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Udi = append(r.Udi, v)
+		case 15:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 16:
+			var v ExplanationOfBenefitItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 17:
+			var v ExplanationOfBenefitItemDetailSubDetail
+			d.message(&v)
+			r.SubDetail = append(r.SubDetail, v)
+		case 18:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 20:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitItemDetailSubDetail as JSON into a byte slice
+func (r ExplanationOfBenefitItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitItemDetailSubDetail as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitItemDetailSubDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("sequence")
+	e.int(r.Sequence)
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.Revenue != nil {
+		e.key("revenue")
+		r.Revenue.appendJSON(e)
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProgramCode) > 0 {
+		e.key("programCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProgramCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.Udi) > 0 {
+		e.key("udi")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Udi {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitItemDetailSubDetail from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitItemDetailSubDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitItemDetailSubDetail
+func (r *ExplanationOfBenefitItemDetailSubDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = v
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "revenue":
+			if d.null() {
+				r.Revenue = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Revenue = &v
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "programCode":
+			a := d.array()
+			r.ProgramCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProgramCode = append(r.ProgramCode, v)
+			}
+			if r.ProgramCode == nil && !a.isNull {
+				r.ProgramCode = []CodeableConcept{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "udi":
+			a := d.array()
+			r.Udi = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Udi = append(r.Udi, v)
+			}
+			if r.Udi == nil && !a.isNull {
+				r.Udi = []Reference{}
+			}
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ExplanationOfBenefitItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ExplanationOfBenefitItemAdjudication{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitItemDetailSubDetail which shares no memory with the original
+func (r ExplanationOfBenefitItemDetailSubDetail) DeepCopy() ExplanationOfBenefitItemDetailSubDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.Revenue != nil {
+		v := r.Revenue.DeepCopy()
+		out.Revenue = &v
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.ProgramCode != nil {
+		out.ProgramCode = make([]CodeableConcept, len(r.ProgramCode))
+		for i := range r.ProgramCode {
+			out.ProgramCode[i] = r.ProgramCode[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.Udi != nil {
+		out.Udi = make([]Reference, len(r.Udi))
+		for i := range r.Udi {
+			out.Udi[i] = r.Udi[i].DeepCopy()
+		}
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ExplanationOfBenefitItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitItemDetailSubDetail) Equal(other ExplanationOfBenefitItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.Equal(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if len(r.ProgramCode) != len(other.ProgramCode) {
+		return false
+	}
+	for i := range r.ProgramCode {
+		if !r.ProgramCode[i].Equal(other.ProgramCode[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.Udi) != len(other.Udi) {
+		return false
+	}
+	for i := range r.Udi {
+		if !r.Udi[i].Equal(other.Udi[i]) {
+			return false
+		}
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitItemDetailSubDetail) EqualsDeep(other ExplanationOfBenefitItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.Revenue == nil) != (other.Revenue == nil) || r.Revenue != nil && !r.Revenue.EqualsDeep(*other.Revenue) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProgramCode), len(other.ProgramCode), func(i, j int) bool {
+		return r.ProgramCode[i].EqualsDeep(other.ProgramCode[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.Udi), len(other.Udi), func(i, j int) bool {
+		return r.Udi[i].EqualsDeep(other.Udi[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitItemDetailSubDetail) EqualsShallow(other ExplanationOfBenefitItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Sequence != other.Sequence {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitItemDetailSubDetail as FHIR XML
+func (r ExplanationOfBenefitItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "revenue", r.Revenue); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProgramCode {
+		if err := encodeXMLElement(e, "programCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for _, v := range r.Udi {
+		if err := encodeXMLElement(e, "udi", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitItemDetailSubDetail from FHIR XML
+func (r *ExplanationOfBenefitItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = v
+				}
+				r.SequenceElement = element
+			case "revenue":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Revenue = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "programCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProgramCode = append(r.ProgramCode, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "udi":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Udi = append(r.Udi, v)
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ExplanationOfBenefitItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitItemDetailSubDetail as properties to the RDF node n
+func (r ExplanationOfBenefitItemDetailSubDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.item.detail.subDetail.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("ExplanationOfBenefit.item.detail.subDetail.revenue", -1, r.Revenue)
+	n.element("ExplanationOfBenefit.item.detail.subDetail.category", -1, r.Category)
+	n.element("ExplanationOfBenefit.item.detail.subDetail.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("ExplanationOfBenefit.item.detail.subDetail.modifier", i, v)
+	}
+	for i, v := range r.ProgramCode {
+		n.element("ExplanationOfBenefit.item.detail.subDetail.programCode", i, v)
+	}
+	n.element("ExplanationOfBenefit.item.detail.subDetail.quantity", -1, r.Quantity)
+	n.element("ExplanationOfBenefit.item.detail.subDetail.unitPrice", -1, r.UnitPrice)
+	n.primitive("ExplanationOfBenefit.item.detail.subDetail.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ExplanationOfBenefit.item.detail.subDetail.net", -1, r.Net)
+	for i, v := range r.Udi {
+		n.element("ExplanationOfBenefit.item.detail.subDetail.udi", i, v)
+	}
+	for i, v := range r.NoteNumber {
+		n.primitive("ExplanationOfBenefit.item.detail.subDetail.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ExplanationOfBenefit.item.detail.subDetail.adjudication", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitItemDetailSubDetail as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitItemDetailSubDetail from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitItemDetailSubDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitItemDetailSubDetail as protobuf message ExplanationOfBenefitItemDetailSubDetail of fhir.proto
+func (r ExplanationOfBenefitItemDetailSubDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitItemDetailSubDetail to the encoded message
+func (r ExplanationOfBenefitItemDetailSubDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != 0 {
+		e.varint(4, uint64(int64(r.Sequence)))
+	}
+	if r.Revenue != nil {
+		e.message(5, *r.Revenue)
+	}
+	if r.Category != nil {
+		e.message(6, *r.Category)
+	}
+	e.message(7, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(8, v)
+	}
+	for _, v := range r.ProgramCode {
+		e.message(9, v)
+	}
+	if r.Quantity != nil {
+		e.message(10, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(11, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(12, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(13, *r.Net)
+	}
+	for _, v := range r.Udi {
+		e.message(14, v)
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(15, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(16, v)
+	}
+	if r.SequenceElement != nil {
+		e.message(17, *r.SequenceElement)
+	}
+	if r.FactorElement != nil {
+		e.message(18, *r.FactorElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(19, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitItemDetailSubDetail from protobuf message ExplanationOfBenefitItemDetailSubDetail of fhir.proto
+func (r *ExplanationOfBenefitItemDetailSubDetail) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitItemDetailSubDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Sequence = d.int()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Revenue = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProgramCode = append(r.ProgramCode, v)
+		case 10:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 11:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 12:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 13:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Udi = append(r.Udi, v)
+		case 15:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 16:
+			var v ExplanationOfBenefitItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 17:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 19:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitAddItem as JSON into a byte slice
+func (r ExplanationOfBenefitAddItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitAddItem as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitAddItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ItemSequence) > 0 {
+		e.key("itemSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ItemSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ItemSequenceElement) > 0 {
+		e.key("_itemSequence")
+		e.elements(r.ItemSequenceElement, len(r.ItemSequence))
+	}
+	if len(r.DetailSequence) > 0 {
+		e.key("detailSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DetailSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DetailSequenceElement) > 0 {
+		e.key("_detailSequence")
+		e.elements(r.DetailSequenceElement, len(r.DetailSequence))
+	}
+	if len(r.SubDetailSequence) > 0 {
+		e.key("subDetailSequence")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubDetailSequence {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SubDetailSequenceElement) > 0 {
+		e.key("_subDetailSequence")
+		e.elements(r.SubDetailSequenceElement, len(r.SubDetailSequence))
+	}
+	if len(r.Provider) > 0 {
+		e.key("provider")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Provider {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProgramCode) > 0 {
+		e.key("programCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProgramCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ServicedDate != nil {
+		e.key("servicedDate")
+		e.string(*r.ServicedDate)
+	}
+	if r.ServicedDateElement != nil {
+		e.key("_servicedDate")
+		r.ServicedDateElement.appendJSON(e)
+	}
+	if r.ServicedPeriod != nil {
+		e.key("servicedPeriod")
+		r.ServicedPeriod.appendJSON(e)
+	}
+	if r.LocationCodeableConcept != nil {
+		e.key("locationCodeableConcept")
+		r.LocationCodeableConcept.appendJSON(e)
+	}
+	if r.LocationAddress != nil {
+		e.key("locationAddress")
+		r.LocationAddress.appendJSON(e)
+	}
+	if r.LocationReference != nil {
+		e.key("locationReference")
+		r.LocationReference.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if r.BodySite != nil {
+		e.key("bodySite")
+		r.BodySite.appendJSON(e)
+	}
+	if len(r.SubSite) > 0 {
+		e.key("subSite")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubSite {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitAddItem from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitAddItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitAddItem
+func (r *ExplanationOfBenefitAddItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "itemSequence":
+			a := d.array()
+			r.ItemSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.ItemSequence = append(r.ItemSequence, v)
+			}
+			if r.ItemSequence == nil && !a.isNull {
+				r.ItemSequence = []int{}
+			}
+		case "_itemSequence":
+			a := d.array()
+			r.ItemSequenceElement = nil
+			for a.next() {
+				r.ItemSequenceElement = append(r.ItemSequenceElement, d.element())
+			}
+		case "detailSequence":
+			a := d.array()
+			r.DetailSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.DetailSequence = append(r.DetailSequence, v)
+			}
+			if r.DetailSequence == nil && !a.isNull {
+				r.DetailSequence = []int{}
+			}
+		case "_detailSequence":
+			a := d.array()
+			r.DetailSequenceElement = nil
+			for a.next() {
+				r.DetailSequenceElement = append(r.DetailSequenceElement, d.element())
+			}
+		case "subDetailSequence":
+			a := d.array()
+			r.SubDetailSequence = nil
+			for a.next() {
+				v, _ := d.int()
+				r.SubDetailSequence = append(r.SubDetailSequence, v)
+			}
+			if r.SubDetailSequence == nil && !a.isNull {
+				r.SubDetailSequence = []int{}
+			}
+		case "_subDetailSequence":
+			a := d.array()
+			r.SubDetailSequenceElement = nil
+			for a.next() {
+				r.SubDetailSequenceElement = append(r.SubDetailSequenceElement, d.element())
+			}
+		case "provider":
+			a := d.array()
+			r.Provider = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Provider = append(r.Provider, v)
+			}
+			if r.Provider == nil && !a.isNull {
+				r.Provider = []Reference{}
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "programCode":
+			a := d.array()
+			r.ProgramCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProgramCode = append(r.ProgramCode, v)
+			}
+			if r.ProgramCode == nil && !a.isNull {
+				r.ProgramCode = []CodeableConcept{}
+			}
+		case "servicedDate":
+			if v, ok := d.string(); ok {
+				r.ServicedDate = &v
+			} else {
+				r.ServicedDate = nil
+			}
+		case "_servicedDate":
+			r.ServicedDateElement = d.element()
+		case "servicedPeriod":
+			if d.null() {
+				r.ServicedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ServicedPeriod = &v
+			}
+		case "locationCodeableConcept":
+			if d.null() {
+				r.LocationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LocationCodeableConcept = &v
+			}
+		case "locationAddress":
+			if d.null() {
+				r.LocationAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.LocationAddress = &v
+			}
+		case "locationReference":
+			if d.null() {
+				r.LocationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.LocationReference = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "bodySite":
+			if d.null() {
+				r.BodySite = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = &v
+			}
+		case "subSite":
+			a := d.array()
+			r.SubSite = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubSite = append(r.SubSite, v)
+			}
+			if r.SubSite == nil && !a.isNull {
+				r.SubSite = []CodeableConcept{}
+			}
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ExplanationOfBenefitItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ExplanationOfBenefitItemAdjudication{}
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v ExplanationOfBenefitAddItemDetail
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []ExplanationOfBenefitAddItemDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitAddItem which shares no memory with the original
+func (r ExplanationOfBenefitAddItem) DeepCopy() ExplanationOfBenefitAddItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ItemSequence != nil {
+		out.ItemSequence = make([]int, len(r.ItemSequence))
+		copy(out.ItemSequence, r.ItemSequence)
+	}
+	if r.ItemSequenceElement != nil {
+		out.ItemSequenceElement = make([]*Element, len(r.ItemSequenceElement))
+		for i, v := range r.ItemSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ItemSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.DetailSequence != nil {
+		out.DetailSequence = make([]int, len(r.DetailSequence))
+		copy(out.DetailSequence, r.DetailSequence)
+	}
+	if r.DetailSequenceElement != nil {
+		out.DetailSequenceElement = make([]*Element, len(r.DetailSequenceElement))
+		for i, v := range r.DetailSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DetailSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.SubDetailSequence != nil {
+		out.SubDetailSequence = make([]int, len(r.SubDetailSequence))
+		copy(out.SubDetailSequence, r.SubDetailSequence)
+	}
+	if r.SubDetailSequenceElement != nil {
+		out.SubDetailSequenceElement = make([]*Element, len(r.SubDetailSequenceElement))
+		for i, v := range r.SubDetailSequenceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SubDetailSequenceElement[i] = &c
+			}
+		}
+	}
+	if r.Provider != nil {
+		out.Provider = make([]Reference, len(r.Provider))
+		for i := range r.Provider {
+			out.Provider[i] = r.Provider[i].DeepCopy()
+		}
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.ProgramCode != nil {
+		out.ProgramCode = make([]CodeableConcept, len(r.ProgramCode))
+		for i := range r.ProgramCode {
+			out.ProgramCode[i] = r.ProgramCode[i].DeepCopy()
+		}
+	}
+	if r.ServicedDate != nil {
+		v := *r.ServicedDate
+		out.ServicedDate = &v
+	}
+	if r.ServicedDateElement != nil {
+		v := r.ServicedDateElement.DeepCopy()
+		out.ServicedDateElement = &v
+	}
+	if r.ServicedPeriod != nil {
+		v := r.ServicedPeriod.DeepCopy()
+		out.ServicedPeriod = &v
+	}
+	if r.LocationCodeableConcept != nil {
+		v := r.LocationCodeableConcept.DeepCopy()
+		out.LocationCodeableConcept = &v
+	}
+	if r.LocationAddress != nil {
+		v := r.LocationAddress.DeepCopy()
+		out.LocationAddress = &v
+	}
+	if r.LocationReference != nil {
+		v := r.LocationReference.DeepCopy()
+		out.LocationReference = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.BodySite != nil {
+		v := r.BodySite.DeepCopy()
+		out.BodySite = &v
+	}
+	if r.SubSite != nil {
+		out.SubSite = make([]CodeableConcept, len(r.SubSite))
+		for i := range r.SubSite {
+			out.SubSite[i] = r.SubSite[i].DeepCopy()
+		}
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ExplanationOfBenefitItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.Detail != nil {
+		out.Detail = make([]ExplanationOfBenefitAddItemDetail, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitAddItem) Equal(other ExplanationOfBenefitAddItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.ItemSequence) != len(other.ItemSequence) {
+		return false
+	}
+	for i := range r.ItemSequence {
+		if r.ItemSequence[i] != other.ItemSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ItemSequenceElement, other.ItemSequenceElement, false) {
+		return false
+	}
+	if len(r.DetailSequence) != len(other.DetailSequence) {
+		return false
+	}
+	for i := range r.DetailSequence {
+		if r.DetailSequence[i] != other.DetailSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DetailSequenceElement, other.DetailSequenceElement, false) {
+		return false
+	}
+	if len(r.SubDetailSequence) != len(other.SubDetailSequence) {
+		return false
+	}
+	for i := range r.SubDetailSequence {
+		if r.SubDetailSequence[i] != other.SubDetailSequence[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SubDetailSequenceElement, other.SubDetailSequenceElement, false) {
+		return false
+	}
+	if len(r.Provider) != len(other.Provider) {
+		return false
+	}
+	for i := range r.Provider {
+		if !r.Provider[i].Equal(other.Provider[i]) {
+			return false
+		}
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if len(r.ProgramCode) != len(other.ProgramCode) {
+		return false
+	}
+	for i := range r.ProgramCode {
+		if !r.ProgramCode[i].Equal(other.ProgramCode[i]) {
+			return false
+		}
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && *r.ServicedDate != *other.ServicedDate {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.Equal(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.Equal(*other.ServicedPeriod) {
+		return false
+	}
+	if (r.LocationCodeableConcept == nil) != (other.LocationCodeableConcept == nil) || r.LocationCodeableConcept != nil && !r.LocationCodeableConcept.Equal(*other.LocationCodeableConcept) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.Equal(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.Equal(*other.LocationReference) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.Equal(*other.BodySite) {
+		return false
+	}
+	if len(r.SubSite) != len(other.SubSite) {
+		return false
+	}
+	for i := range r.SubSite {
+		if !r.SubSite[i].Equal(other.SubSite[i]) {
+			return false
+		}
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitAddItem) EqualsDeep(other ExplanationOfBenefitAddItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ItemSequence), len(other.ItemSequence), func(i, j int) bool {
+		return r.ItemSequence[i] == other.ItemSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ItemSequenceElement, other.ItemSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.DetailSequence), len(other.DetailSequence), func(i, j int) bool {
+		return r.DetailSequence[i] == other.DetailSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.DetailSequenceElement, other.DetailSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.SubDetailSequence), len(other.SubDetailSequence), func(i, j int) bool {
+		return r.SubDetailSequence[i] == other.SubDetailSequence[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.SubDetailSequenceElement, other.SubDetailSequenceElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Provider), len(other.Provider), func(i, j int) bool {
+		return r.Provider[i].EqualsDeep(other.Provider[j])
+	}) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProgramCode), len(other.ProgramCode), func(i, j int) bool {
+		return r.ProgramCode[i].EqualsDeep(other.ProgramCode[j])
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.ServicedDateElement == nil) != (other.ServicedDateElement == nil) || r.ServicedDateElement != nil && !r.ServicedDateElement.EqualsDeep(*other.ServicedDateElement) {
+		return false
+	}
+	if (r.ServicedPeriod == nil) != (other.ServicedPeriod == nil) || r.ServicedPeriod != nil && !r.ServicedPeriod.EqualsDeep(*other.ServicedPeriod) {
+		return false
+	}
+	if (r.LocationCodeableConcept == nil) != (other.LocationCodeableConcept == nil) || r.LocationCodeableConcept != nil && !r.LocationCodeableConcept.EqualsDeep(*other.LocationCodeableConcept) {
+		return false
+	}
+	if (r.LocationAddress == nil) != (other.LocationAddress == nil) || r.LocationAddress != nil && !r.LocationAddress.EqualsDeep(*other.LocationAddress) {
+		return false
+	}
+	if (r.LocationReference == nil) != (other.LocationReference == nil) || r.LocationReference != nil && !r.LocationReference.EqualsDeep(*other.LocationReference) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.EqualsDeep(*other.BodySite) {
+		return false
+	}
+	if !equivalentList(len(r.SubSite), len(other.SubSite), func(i, j int) bool {
+		return r.SubSite[i].EqualsDeep(other.SubSite[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitAddItem) EqualsShallow(other ExplanationOfBenefitAddItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.ItemSequence), len(other.ItemSequence), func(i, j int) bool {
+		return r.ItemSequence[i] == other.ItemSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DetailSequence), len(other.DetailSequence), func(i, j int) bool {
+		return r.DetailSequence[i] == other.DetailSequence[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SubDetailSequence), len(other.SubDetailSequence), func(i, j int) bool {
+		return r.SubDetailSequence[i] == other.SubDetailSequence[j]
+	}) {
+		return false
+	}
+	if (r.ServicedDate == nil) != (other.ServicedDate == nil) || r.ServicedDate != nil && !equivalentString(*r.ServicedDate, *other.ServicedDate) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitAddItem as FHIR XML
+func (r ExplanationOfBenefitAddItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ItemSequence {
+		if err := encodeXMLPrimitive(e, "itemSequence", v, elementAt(r.ItemSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.DetailSequence {
+		if err := encodeXMLPrimitive(e, "detailSequence", v, elementAt(r.DetailSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.SubDetailSequence {
+		if err := encodeXMLPrimitive(e, "subDetailSequence", v, elementAt(r.SubDetailSequenceElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Provider {
+		if err := encodeXMLElement(e, "provider", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProgramCode {
+		if err := encodeXMLElement(e, "programCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "servicedDate", r.ServicedDate, r.ServicedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "servicedPeriod", r.ServicedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationCodeableConcept", r.LocationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationAddress", r.LocationAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "locationReference", r.LocationReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "bodySite", r.BodySite); err != nil {
+		return err
+	}
+	for _, v := range r.SubSite {
+		if err := encodeXMLElement(e, "subSite", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitAddItem from FHIR XML
+func (r *ExplanationOfBenefitAddItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "itemSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ItemSequenceElement = appendElement(r.ItemSequenceElement, len(r.ItemSequence), element)
+					r.ItemSequence = append(r.ItemSequence, v)
+				}
+			case "detailSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DetailSequenceElement = appendElement(r.DetailSequenceElement, len(r.DetailSequence), element)
+					r.DetailSequence = append(r.DetailSequence, v)
+				}
+			case "subDetailSequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SubDetailSequenceElement = appendElement(r.SubDetailSequenceElement, len(r.SubDetailSequence), element)
+					r.SubDetailSequence = append(r.SubDetailSequence, v)
+				}
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = append(r.Provider, v)
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "programCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProgramCode = append(r.ProgramCode, v)
+			case "servicedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ServicedDate = &v
+				}
+				r.ServicedDateElement = element
+			case "servicedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServicedPeriod = &v
+			case "locationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationCodeableConcept = &v
+			case "locationAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationAddress = &v
+			case "locationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationReference = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = &v
+			case "subSite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubSite = append(r.SubSite, v)
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ExplanationOfBenefitItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "detail":
+				var v ExplanationOfBenefitAddItemDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitAddItem as properties to the RDF node n
+func (r ExplanationOfBenefitAddItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.ItemSequence {
+		n.primitive("ExplanationOfBenefit.addItem.itemSequence", i, v, elementAt(r.ItemSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.DetailSequence {
+		n.primitive("ExplanationOfBenefit.addItem.detailSequence", i, v, elementAt(r.DetailSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.SubDetailSequence {
+		n.primitive("ExplanationOfBenefit.addItem.subDetailSequence", i, v, elementAt(r.SubDetailSequenceElement, i), "positiveInt")
+	}
+	for i, v := range r.Provider {
+		n.element("ExplanationOfBenefit.addItem.provider", i, v)
+	}
+	n.element("ExplanationOfBenefit.addItem.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("ExplanationOfBenefit.addItem.modifier", i, v)
+	}
+	for i, v := range r.ProgramCode {
+		n.element("ExplanationOfBenefit.addItem.programCode", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.addItem.servicedDate", -1, r.ServicedDate, r.ServicedDateElement, "date")
+	n.element("ExplanationOfBenefit.addItem.servicedPeriod", -1, r.ServicedPeriod)
+	n.element("ExplanationOfBenefit.addItem.locationCodeableConcept", -1, r.LocationCodeableConcept)
+	n.element("ExplanationOfBenefit.addItem.locationAddress", -1, r.LocationAddress)
+	n.element("ExplanationOfBenefit.addItem.locationReference", -1, r.LocationReference)
+	n.element("ExplanationOfBenefit.addItem.quantity", -1, r.Quantity)
+	n.element("ExplanationOfBenefit.addItem.unitPrice", -1, r.UnitPrice)
+	n.primitive("ExplanationOfBenefit.addItem.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ExplanationOfBenefit.addItem.net", -1, r.Net)
+	n.element("ExplanationOfBenefit.addItem.bodySite", -1, r.BodySite)
+	for i, v := range r.SubSite {
+		n.element("ExplanationOfBenefit.addItem.subSite", i, v)
+	}
+	for i, v := range r.NoteNumber {
+		n.primitive("ExplanationOfBenefit.addItem.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ExplanationOfBenefit.addItem.adjudication", i, v)
+	}
+	for i, v := range r.Detail {
+		n.element("ExplanationOfBenefit.addItem.detail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitAddItem as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitAddItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitAddItem from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitAddItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitAddItem as protobuf message ExplanationOfBenefitAddItem of fhir.proto
+func (r ExplanationOfBenefitAddItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitAddItem to the encoded message
+func (r ExplanationOfBenefitAddItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if len(r.ItemSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.ItemSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(4, p.buf)
+	}
+	if len(r.DetailSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.DetailSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(5, p.buf)
+	}
+	if len(r.SubDetailSequence) > 0 {
+		var p protoEncoder
+		for _, v := range r.SubDetailSequence {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(6, p.buf)
+	}
+	for _, v := range r.Provider {
+		e.message(7, v)
+	}
+	e.message(8, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(9, v)
+	}
+	for _, v := range r.ProgramCode {
+		e.message(10, v)
+	}
+	if r.ServicedDate != nil {
+		e.string(11, *r.ServicedDate)
+	}
+	if r.ServicedPeriod != nil {
+		e.message(12, *r.ServicedPeriod)
+	}
+	if r.LocationCodeableConcept != nil {
+		e.message(13, *r.LocationCodeableConcept)
+	}
+	if r.LocationAddress != nil {
+		e.message(14, *r.LocationAddress)
+	}
+	if r.LocationReference != nil {
+		e.message(15, *r.LocationReference)
+	}
+	if r.Quantity != nil {
+		e.message(16, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(17, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(18, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(19, *r.Net)
+	}
+	if r.BodySite != nil {
+		e.message(20, *r.BodySite)
+	}
+	for _, v := range r.SubSite {
+		e.message(21, v)
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(22, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(23, v)
+	}
+	for _, v := range r.Detail {
+		e.message(24, v)
+	}
+	for _, v := range r.ItemSequenceElement {
+		e.element(25, v)
+	}
+	for _, v := range r.DetailSequenceElement {
+		e.element(26, v)
+	}
+	for _, v := range r.SubDetailSequenceElement {
+		e.element(27, v)
+	}
+	if r.ServicedDateElement != nil {
+		e.message(28, *r.ServicedDateElement)
+	}
+	if r.FactorElement != nil {
+		e.message(29, *r.FactorElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(30, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitAddItem from protobuf message ExplanationOfBenefitAddItem of fhir.proto
+func (r *ExplanationOfBenefitAddItem) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitAddItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			for _, v := range d.varints() {
+				r.ItemSequence = append(r.ItemSequence, int(int32(v)))
+			}
+		case 5:
+			for _, v := range d.varints() {
+				r.DetailSequence = append(r.DetailSequence, int(int32(v)))
+			}
+		case 6:
+			for _, v := range d.varints() {
+				r.SubDetailSequence = append(r.SubDetailSequence, int(int32(v)))
+			}
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Provider = append(r.Provider, v)
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProgramCode = append(r.ProgramCode, v)
+		case 11:
+			v := d.string()
+			r.ServicedDate = &v
+		case 12:
+			var v Period
+			d.message(&v)
+			r.ServicedPeriod = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.LocationCodeableConcept = &v
+		case 14:
+			var v Address
+			d.message(&v)
+			r.LocationAddress = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.LocationReference = &v
+		case 16:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 17:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 18:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 19:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = &v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubSite = append(r.SubSite, v)
+		case 22:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 23:
+			var v ExplanationOfBenefitItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 24:
+			var v ExplanationOfBenefitAddItemDetail
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		case 25:
+			r.ItemSequenceElement = append(r.ItemSequenceElement, d.element())
+		case 26:
+			r.DetailSequenceElement = append(r.DetailSequenceElement, d.element())
+		case 27:
+			r.SubDetailSequenceElement = append(r.SubDetailSequenceElement, d.element())
+		case 28:
+			var v Element
+			d.message(&v)
+			r.ServicedDateElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 30:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitAddItemDetail as JSON into a byte slice
+func (r ExplanationOfBenefitAddItemDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitAddItemDetail as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitAddItemDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SubDetail) > 0 {
+		e.key("subDetail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubDetail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitAddItemDetail from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitAddItemDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitAddItemDetail
+func (r *ExplanationOfBenefitAddItemDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ExplanationOfBenefitItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ExplanationOfBenefitItemAdjudication{}
+			}
+		case "subDetail":
+			a := d.array()
+			r.SubDetail = nil
+			for a.next() {
+				var v ExplanationOfBenefitAddItemDetailSubDetail
+				v.decodeJSON(d)
+				r.SubDetail = append(r.SubDetail, v)
+			}
+			if r.SubDetail == nil && !a.isNull {
+				r.SubDetail = []ExplanationOfBenefitAddItemDetailSubDetail{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitAddItemDetail which shares no memory with the original
+func (r ExplanationOfBenefitAddItemDetail) DeepCopy() ExplanationOfBenefitAddItemDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ExplanationOfBenefitItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	if r.SubDetail != nil {
+		out.SubDetail = make([]ExplanationOfBenefitAddItemDetailSubDetail, len(r.SubDetail))
+		for i := range r.SubDetail {
+			out.SubDetail[i] = r.SubDetail[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitAddItemDetail) Equal(other ExplanationOfBenefitAddItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	if len(r.SubDetail) != len(other.SubDetail) {
+		return false
+	}
+	for i := range r.SubDetail {
+		if !r.SubDetail[i].Equal(other.SubDetail[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitAddItemDetail) EqualsDeep(other ExplanationOfBenefitAddItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SubDetail), len(other.SubDetail), func(i, j int) bool {
+		return r.SubDetail[i].EqualsDeep(other.SubDetail[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitAddItemDetail) EqualsShallow(other ExplanationOfBenefitAddItemDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitAddItemDetail as FHIR XML
+func (r ExplanationOfBenefitAddItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SubDetail {
+		if err := encodeXMLElement(e, "subDetail", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitAddItemDetail from FHIR XML
+func (r *ExplanationOfBenefitAddItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ExplanationOfBenefitItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			case "subDetail":
+				var v ExplanationOfBenefitAddItemDetailSubDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubDetail = append(r.SubDetail, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitAddItemDetail as properties to the RDF node n
+func (r ExplanationOfBenefitAddItemDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ExplanationOfBenefit.addItem.detail.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("ExplanationOfBenefit.addItem.detail.modifier", i, v)
+	}
+	n.element("ExplanationOfBenefit.addItem.detail.quantity", -1, r.Quantity)
+	n.element("ExplanationOfBenefit.addItem.detail.unitPrice", -1, r.UnitPrice)
+	n.primitive("ExplanationOfBenefit.addItem.detail.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ExplanationOfBenefit.addItem.detail.net", -1, r.Net)
+	for i, v := range r.NoteNumber {
+		n.primitive("ExplanationOfBenefit.addItem.detail.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ExplanationOfBenefit.addItem.detail.adjudication", i, v)
+	}
+	for i, v := range r.SubDetail {
+		n.element("ExplanationOfBenefit.addItem.detail.subDetail", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitAddItemDetail as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitAddItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitAddItemDetail from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitAddItemDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitAddItemDetail as protobuf message ExplanationOfBenefitAddItemDetail of fhir.proto
+func (r ExplanationOfBenefitAddItemDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitAddItemDetail to the encoded message
+func (r ExplanationOfBenefitAddItemDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(5, v)
+	}
+	if r.Quantity != nil {
+		e.message(6, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(7, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(8, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(9, *r.Net)
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(10, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(11, v)
+	}
+	for _, v := range r.SubDetail {
+		e.message(12, v)
+	}
+	if r.FactorElement != nil {
+		e.message(13, *r.FactorElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(14, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitAddItemDetail from protobuf message ExplanationOfBenefitAddItemDetail of fhir.proto
+func (r *ExplanationOfBenefitAddItemDetail) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitAddItemDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 7:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 8:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 9:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 10:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 11:
+			var v ExplanationOfBenefitItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 12:
+			var v ExplanationOfBenefitAddItemDetailSubDetail
+			d.message(&v)
+			r.SubDetail = append(r.SubDetail, v)
+		case 13:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 14:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitAddItemDetailSubDetail as JSON into a byte slice
+func (r ExplanationOfBenefitAddItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitAddItemDetailSubDetail as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitAddItemDetailSubDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("productOrService")
+	r.ProductOrService.appendJSON(e)
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.UnitPrice != nil {
+		e.key("unitPrice")
+		r.UnitPrice.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Net != nil {
+		e.key("net")
+		r.Net.appendJSON(e)
+	}
+	if len(r.NoteNumber) > 0 {
+		e.key("noteNumber")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NoteNumber {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NoteNumberElement) > 0 {
+		e.key("_noteNumber")
+		e.elements(r.NoteNumberElement, len(r.NoteNumber))
+	}
+	if len(r.Adjudication) > 0 {
+		e.key("adjudication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Adjudication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitAddItemDetailSubDetail from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitAddItemDetailSubDetail
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "productOrService":
+			r.ProductOrService.decodeJSON(d)
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []CodeableConcept{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "unitPrice":
+			if d.null() {
+				r.UnitPrice = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UnitPrice = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "net":
+			if d.null() {
+				r.Net = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Net = &v
+			}
+		case "noteNumber":
+			a := d.array()
+			r.NoteNumber = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NoteNumber = append(r.NoteNumber, v)
+			}
+			if r.NoteNumber == nil && !a.isNull {
+				r.NoteNumber = []int{}
+			}
+		case "_noteNumber":
+			a := d.array()
+			r.NoteNumberElement = nil
+			for a.next() {
+				r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+			}
+		case "adjudication":
+			a := d.array()
+			r.Adjudication = nil
+			for a.next() {
+				var v ExplanationOfBenefitItemAdjudication
+				v.decodeJSON(d)
+				r.Adjudication = append(r.Adjudication, v)
+			}
+			if r.Adjudication == nil && !a.isNull {
+				r.Adjudication = []ExplanationOfBenefitItemAdjudication{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitAddItemDetailSubDetail which shares no memory with the original
+func (r ExplanationOfBenefitAddItemDetailSubDetail) DeepCopy() ExplanationOfBenefitAddItemDetailSubDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.ProductOrService = r.ProductOrService.DeepCopy()
+	if r.Modifier != nil {
+		out.Modifier = make([]CodeableConcept, len(r.Modifier))
+		for i := range r.Modifier {
+			out.Modifier[i] = r.Modifier[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.UnitPrice != nil {
+		v := r.UnitPrice.DeepCopy()
+		out.UnitPrice = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Net != nil {
+		v := r.Net.DeepCopy()
+		out.Net = &v
+	}
+	if r.NoteNumber != nil {
+		out.NoteNumber = make([]int, len(r.NoteNumber))
+		copy(out.NoteNumber, r.NoteNumber)
+	}
+	if r.NoteNumberElement != nil {
+		out.NoteNumberElement = make([]*Element, len(r.NoteNumberElement))
+		for i, v := range r.NoteNumberElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NoteNumberElement[i] = &c
+			}
+		}
+	}
+	if r.Adjudication != nil {
+		out.Adjudication = make([]ExplanationOfBenefitItemAdjudication, len(r.Adjudication))
+		for i := range r.Adjudication {
+			out.Adjudication[i] = r.Adjudication[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitAddItemDetailSubDetail) Equal(other ExplanationOfBenefitAddItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.ProductOrService.Equal(other.ProductOrService) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if !r.Modifier[i].Equal(other.Modifier[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.Equal(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.Equal(*other.Net) {
+		return false
+	}
+	if len(r.NoteNumber) != len(other.NoteNumber) {
+		return false
+	}
+	for i := range r.NoteNumber {
+		if r.NoteNumber[i] != other.NoteNumber[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, false) {
+		return false
+	}
+	if len(r.Adjudication) != len(other.Adjudication) {
+		return false
+	}
+	for i := range r.Adjudication {
+		if !r.Adjudication[i].Equal(other.Adjudication[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitAddItemDetailSubDetail) EqualsDeep(other ExplanationOfBenefitAddItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.ProductOrService.EqualsDeep(other.ProductOrService) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i].EqualsDeep(other.Modifier[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.UnitPrice == nil) != (other.UnitPrice == nil) || r.UnitPrice != nil && !r.UnitPrice.EqualsDeep(*other.UnitPrice) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Net == nil) != (other.Net == nil) || r.Net != nil && !r.Net.EqualsDeep(*other.Net) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NoteNumberElement, other.NoteNumberElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Adjudication), len(other.Adjudication), func(i, j int) bool {
+		return r.Adjudication[i].EqualsDeep(other.Adjudication[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitAddItemDetailSubDetail) EqualsShallow(other ExplanationOfBenefitAddItemDetailSubDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if !equivalentList(len(r.NoteNumber), len(other.NoteNumber), func(i, j int) bool {
+		return r.NoteNumber[i] == other.NoteNumber[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitAddItemDetailSubDetail as FHIR XML
+func (r ExplanationOfBenefitAddItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "productOrService", r.ProductOrService); err != nil {
+		return err
+	}
+	for _, v := range r.Modifier {
+		if err := encodeXMLElement(e, "modifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitPrice", r.UnitPrice); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "net", r.Net); err != nil {
+		return err
+	}
+	for i, v := range r.NoteNumber {
+		if err := encodeXMLPrimitive(e, "noteNumber", v, elementAt(r.NoteNumberElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Adjudication {
+		if err := encodeXMLElement(e, "adjudication", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitAddItemDetailSubDetail from FHIR XML
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "productOrService":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductOrService = v
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = append(r.Modifier, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "unitPrice":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitPrice = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "net":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Net = &v
+			case "noteNumber":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NoteNumberElement = appendElement(r.NoteNumberElement, len(r.NoteNumber), element)
+					r.NoteNumber = append(r.NoteNumber, v)
+				}
+			case "adjudication":
+				var v ExplanationOfBenefitItemAdjudication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjudication = append(r.Adjudication, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitAddItemDetailSubDetail as properties to the RDF node n
+func (r ExplanationOfBenefitAddItemDetailSubDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ExplanationOfBenefit.addItem.detail.subDetail.productOrService", -1, r.ProductOrService)
+	for i, v := range r.Modifier {
+		n.element("ExplanationOfBenefit.addItem.detail.subDetail.modifier", i, v)
+	}
+	n.element("ExplanationOfBenefit.addItem.detail.subDetail.quantity", -1, r.Quantity)
+	n.element("ExplanationOfBenefit.addItem.detail.subDetail.unitPrice", -1, r.UnitPrice)
+	n.primitive("ExplanationOfBenefit.addItem.detail.subDetail.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("ExplanationOfBenefit.addItem.detail.subDetail.net", -1, r.Net)
+	for i, v := range r.NoteNumber {
+		n.primitive("ExplanationOfBenefit.addItem.detail.subDetail.noteNumber", i, v, elementAt(r.NoteNumberElement, i), "positiveInt")
+	}
+	for i, v := range r.Adjudication {
+		n.element("ExplanationOfBenefit.addItem.detail.subDetail.adjudication", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitAddItemDetailSubDetail as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitAddItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitAddItemDetailSubDetail from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitAddItemDetailSubDetail as protobuf message ExplanationOfBenefitAddItemDetailSubDetail of fhir.proto
+func (r ExplanationOfBenefitAddItemDetailSubDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitAddItemDetailSubDetail to the encoded message
+func (r ExplanationOfBenefitAddItemDetailSubDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.ProductOrService)
+	for _, v := range r.Modifier {
+		e.message(5, v)
+	}
+	if r.Quantity != nil {
+		e.message(6, *r.Quantity)
+	}
+	if r.UnitPrice != nil {
+		e.message(7, *r.UnitPrice)
+	}
+	if r.Factor != nil {
+		e.string(8, string(*r.Factor))
+	}
+	if r.Net != nil {
+		e.message(9, *r.Net)
+	}
+	if len(r.NoteNumber) > 0 {
+		var p protoEncoder
+		for _, v := range r.NoteNumber {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(10, p.buf)
+	}
+	for _, v := range r.Adjudication {
+		e.message(11, v)
+	}
+	if r.FactorElement != nil {
+		e.message(12, *r.FactorElement)
+	}
+	for _, v := range r.NoteNumberElement {
+		e.element(13, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitAddItemDetailSubDetail from protobuf message ExplanationOfBenefitAddItemDetailSubDetail of fhir.proto
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitAddItemDetailSubDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductOrService = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = append(r.Modifier, v)
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 7:
+			var v Money
+			d.message(&v)
+			r.UnitPrice = &v
+		case 8:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 9:
+			var v Money
+			d.message(&v)
+			r.Net = &v
+		case 10:
+			for _, v := range d.varints() {
+				r.NoteNumber = append(r.NoteNumber, int(int32(v)))
+			}
+		case 11:
+			var v ExplanationOfBenefitItemAdjudication
+			d.message(&v)
+			r.Adjudication = append(r.Adjudication, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		case 13:
+			r.NoteNumberElement = append(r.NoteNumberElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitTotal as JSON into a byte slice
+func (r ExplanationOfBenefitTotal) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitTotal as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitTotal) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("category")
+	r.Category.appendJSON(e)
+	e.key("amount")
+	r.Amount.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitTotal from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitTotal) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitTotal
+func (r *ExplanationOfBenefitTotal) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			r.Category.decodeJSON(d)
+		case "amount":
+			r.Amount.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitTotal which shares no memory with the original
+func (r ExplanationOfBenefitTotal) DeepCopy() ExplanationOfBenefitTotal {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Category = r.Category.DeepCopy()
+	out.Amount = r.Amount.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitTotal) Equal(other ExplanationOfBenefitTotal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Category.Equal(other.Category) {
+		return false
+	}
+	if !r.Amount.Equal(other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitTotal) EqualsDeep(other ExplanationOfBenefitTotal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Category.EqualsDeep(other.Category) {
+		return false
+	}
+	if !r.Amount.EqualsDeep(other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitTotal) EqualsShallow(other ExplanationOfBenefitTotal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitTotal as FHIR XML
+func (r ExplanationOfBenefitTotal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitTotal from FHIR XML
+func (r *ExplanationOfBenefitTotal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = v
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitTotal as properties to the RDF node n
+func (r ExplanationOfBenefitTotal) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ExplanationOfBenefit.total.category", -1, r.Category)
+	n.element("ExplanationOfBenefit.total.amount", -1, r.Amount)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitTotal as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitTotal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitTotal from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitTotal) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitTotal as protobuf message ExplanationOfBenefitTotal of fhir.proto
+func (r ExplanationOfBenefitTotal) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitTotal to the encoded message
+func (r ExplanationOfBenefitTotal) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Category)
+	e.message(5, r.Amount)
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitTotal from protobuf message ExplanationOfBenefitTotal of fhir.proto
+func (r *ExplanationOfBenefitTotal) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitTotal{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = v
+		case 5:
+			var v Money
+			d.message(&v)
+			r.Amount = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitPayment as JSON into a byte slice
+func (r ExplanationOfBenefitPayment) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitPayment as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitPayment) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Adjustment != nil {
+		e.key("adjustment")
+		r.Adjustment.appendJSON(e)
+	}
+	if r.AdjustmentReason != nil {
+		e.key("adjustmentReason")
+		r.AdjustmentReason.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitPayment from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitPayment) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitPayment
+func (r *ExplanationOfBenefitPayment) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "adjustment":
+			if d.null() {
+				r.Adjustment = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Adjustment = &v
+			}
+		case "adjustmentReason":
+			if d.null() {
+				r.AdjustmentReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdjustmentReason = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitPayment which shares no memory with the original
+func (r ExplanationOfBenefitPayment) DeepCopy() ExplanationOfBenefitPayment {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Adjustment != nil {
+		v := r.Adjustment.DeepCopy()
+		out.Adjustment = &v
+	}
+	if r.AdjustmentReason != nil {
+		v := r.AdjustmentReason.DeepCopy()
+		out.AdjustmentReason = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitPayment) Equal(other ExplanationOfBenefitPayment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Adjustment == nil) != (other.Adjustment == nil) || r.Adjustment != nil && !r.Adjustment.Equal(*other.Adjustment) {
+		return false
+	}
+	if (r.AdjustmentReason == nil) != (other.AdjustmentReason == nil) || r.AdjustmentReason != nil && !r.AdjustmentReason.Equal(*other.AdjustmentReason) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitPayment) EqualsDeep(other ExplanationOfBenefitPayment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Adjustment == nil) != (other.Adjustment == nil) || r.Adjustment != nil && !r.Adjustment.EqualsDeep(*other.Adjustment) {
+		return false
+	}
+	if (r.AdjustmentReason == nil) != (other.AdjustmentReason == nil) || r.AdjustmentReason != nil && !r.AdjustmentReason.EqualsDeep(*other.AdjustmentReason) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitPayment) EqualsShallow(other ExplanationOfBenefitPayment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitPayment as FHIR XML
+func (r ExplanationOfBenefitPayment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "adjustment", r.Adjustment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "adjustmentReason", r.AdjustmentReason); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitPayment from FHIR XML
+func (r *ExplanationOfBenefitPayment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "adjustment":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Adjustment = &v
+			case "adjustmentReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdjustmentReason = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitPayment as properties to the RDF node n
+func (r ExplanationOfBenefitPayment) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ExplanationOfBenefit.payment.type", -1, r.Type)
+	n.element("ExplanationOfBenefit.payment.adjustment", -1, r.Adjustment)
+	n.element("ExplanationOfBenefit.payment.adjustmentReason", -1, r.AdjustmentReason)
+	n.primitive("ExplanationOfBenefit.payment.date", -1, r.Date, r.DateElement, "date")
+	n.element("ExplanationOfBenefit.payment.amount", -1, r.Amount)
+	n.element("ExplanationOfBenefit.payment.identifier", -1, r.Identifier)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitPayment as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitPayment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitPayment from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitPayment) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitPayment as protobuf message ExplanationOfBenefitPayment of fhir.proto
+func (r ExplanationOfBenefitPayment) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitPayment to the encoded message
+func (r ExplanationOfBenefitPayment) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Adjustment != nil {
+		e.message(5, *r.Adjustment)
+	}
+	if r.AdjustmentReason != nil {
+		e.message(6, *r.AdjustmentReason)
+	}
+	if r.Date != nil {
+		e.string(7, *r.Date)
+	}
+	if r.Amount != nil {
+		e.message(8, *r.Amount)
+	}
+	if r.Identifier != nil {
+		e.message(9, *r.Identifier)
+	}
+	if r.DateElement != nil {
+		e.message(10, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitPayment from protobuf message ExplanationOfBenefitPayment of fhir.proto
+func (r *ExplanationOfBenefitPayment) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitPayment{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v Money
+			d.message(&v)
+			r.Adjustment = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdjustmentReason = &v
+		case 7:
+			v := d.string()
+			r.Date = &v
+		case 8:
+			var v Money
+			d.message(&v)
+			r.Amount = &v
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitProcessNote as JSON into a byte slice
+func (r ExplanationOfBenefitProcessNote) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitProcessNote as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitProcessNote) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Number != nil {
+		e.key("number")
+		e.int(*r.Number)
+	}
+	if r.NumberElement != nil {
+		e.key("_number")
+		r.NumberElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(r.Type.Code())
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		r.Language.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitProcessNote from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitProcessNote) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitProcessNote
+func (r *ExplanationOfBenefitProcessNote) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "number":
+			if v, ok := d.int(); ok {
+				r.Number = &v
+			} else {
+				r.Number = nil
+			}
+		case "_number":
+			r.NumberElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v NoteType
+				d.unmarshal(&v)
+				r.Type = &v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "language":
+			if d.null() {
+				r.Language = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Language = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitProcessNote which shares no memory with the original
+func (r ExplanationOfBenefitProcessNote) DeepCopy() ExplanationOfBenefitProcessNote {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Number != nil {
+		v := *r.Number
+		out.Number = &v
+	}
+	if r.NumberElement != nil {
+		v := r.NumberElement.DeepCopy()
+		out.NumberElement = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.Language != nil {
+		v := r.Language.DeepCopy()
+		out.Language = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitProcessNote) Equal(other ExplanationOfBenefitProcessNote) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.Equal(*other.NumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !r.Language.Equal(*other.Language) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitProcessNote) EqualsDeep(other ExplanationOfBenefitProcessNote) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.EqualsDeep(*other.NumberElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !r.Language.EqualsDeep(*other.Language) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitProcessNote) EqualsShallow(other ExplanationOfBenefitProcessNote) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitProcessNote as FHIR XML
+func (r ExplanationOfBenefitProcessNote) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "number", r.Number, r.NumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "language", r.Language); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitProcessNote from FHIR XML
+func (r *ExplanationOfBenefitProcessNote) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "number":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Number = &v
+				}
+				r.NumberElement = element
+			case "type":
+				var v NoteType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			case "language":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Language = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitProcessNote as properties to the RDF node n
+func (r ExplanationOfBenefitProcessNote) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ExplanationOfBenefit.processNote.number", -1, r.Number, r.NumberElement, "positiveInt")
+	n.primitive("ExplanationOfBenefit.processNote.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("ExplanationOfBenefit.processNote.text", -1, r.Text, r.TextElement, "string")
+	n.element("ExplanationOfBenefit.processNote.language", -1, r.Language)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitProcessNote as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitProcessNote) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitProcessNote from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitProcessNote) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitProcessNote as protobuf message ExplanationOfBenefitProcessNote of fhir.proto
+func (r ExplanationOfBenefitProcessNote) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitProcessNote to the encoded message
+func (r ExplanationOfBenefitProcessNote) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Number != nil {
+		e.varint(4, uint64(int64(*r.Number)))
+	}
+	if r.Type != nil {
+		e.varint(5, r.Type.protoNumber())
+	}
+	if r.Text != nil {
+		e.string(6, *r.Text)
+	}
+	if r.Language != nil {
+		e.message(7, *r.Language)
+	}
+	if r.NumberElement != nil {
+		e.message(8, *r.NumberElement)
+	}
+	if r.TypeElement != nil {
+		e.message(9, *r.TypeElement)
+	}
+	if r.TextElement != nil {
+		e.message(10, *r.TextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitProcessNote from protobuf message ExplanationOfBenefitProcessNote of fhir.proto
+func (r *ExplanationOfBenefitProcessNote) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitProcessNote{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Number = &v
+		case 5:
+			var v NoteType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = &v
+		case 6:
+			v := d.string()
+			r.Text = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Language = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.NumberElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitBenefitBalance as JSON into a byte slice
+func (r ExplanationOfBenefitBenefitBalance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitBenefitBalance as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitBenefitBalance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("category")
+	r.Category.appendJSON(e)
+	if r.Excluded != nil {
+		e.key("excluded")
+		e.bool(*r.Excluded)
+	}
+	if r.ExcludedElement != nil {
+		e.key("_excluded")
+		r.ExcludedElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Network != nil {
+		e.key("network")
+		r.Network.appendJSON(e)
+	}
+	if r.Unit != nil {
+		e.key("unit")
+		r.Unit.appendJSON(e)
+	}
+	if r.Term != nil {
+		e.key("term")
+		r.Term.appendJSON(e)
+	}
+	if len(r.Financial) > 0 {
+		e.key("financial")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Financial {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitBenefitBalance from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitBenefitBalance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitBenefitBalance
+func (r *ExplanationOfBenefitBenefitBalance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			r.Category.decodeJSON(d)
+		case "excluded":
+			if v, ok := d.bool(); ok {
+				r.Excluded = &v
+			} else {
+				r.Excluded = nil
+			}
+		case "_excluded":
+			r.ExcludedElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "network":
+			if d.null() {
+				r.Network = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Network = &v
+			}
+		case "unit":
+			if d.null() {
+				r.Unit = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Unit = &v
+			}
+		case "term":
+			if d.null() {
+				r.Term = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Term = &v
+			}
+		case "financial":
+			a := d.array()
+			r.Financial = nil
+			for a.next() {
+				var v ExplanationOfBenefitBenefitBalanceFinancial
+				v.decodeJSON(d)
+				r.Financial = append(r.Financial, v)
+			}
+			if r.Financial == nil && !a.isNull {
+				r.Financial = []ExplanationOfBenefitBenefitBalanceFinancial{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitBenefitBalance which shares no memory with the original
+func (r ExplanationOfBenefitBenefitBalance) DeepCopy() ExplanationOfBenefitBenefitBalance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Category = r.Category.DeepCopy()
+	if r.Excluded != nil {
+		v := *r.Excluded
+		out.Excluded = &v
+	}
+	if r.ExcludedElement != nil {
+		v := r.ExcludedElement.DeepCopy()
+		out.ExcludedElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Network != nil {
+		v := r.Network.DeepCopy()
+		out.Network = &v
+	}
+	if r.Unit != nil {
+		v := r.Unit.DeepCopy()
+		out.Unit = &v
+	}
+	if r.Term != nil {
+		v := r.Term.DeepCopy()
+		out.Term = &v
+	}
+	if r.Financial != nil {
+		out.Financial = make([]ExplanationOfBenefitBenefitBalanceFinancial, len(r.Financial))
+		for i := range r.Financial {
+			out.Financial[i] = r.Financial[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitBenefitBalance) Equal(other ExplanationOfBenefitBenefitBalance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Category.Equal(other.Category) {
+		return false
+	}
+	if (r.Excluded == nil) != (other.Excluded == nil) || r.Excluded != nil && *r.Excluded != *other.Excluded {
+		return false
+	}
+	if (r.ExcludedElement == nil) != (other.ExcludedElement == nil) || r.ExcludedElement != nil && !r.ExcludedElement.Equal(*other.ExcludedElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Network == nil) != (other.Network == nil) || r.Network != nil && !r.Network.Equal(*other.Network) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !r.Unit.Equal(*other.Unit) {
+		return false
+	}
+	if (r.Term == nil) != (other.Term == nil) || r.Term != nil && !r.Term.Equal(*other.Term) {
+		return false
+	}
+	if len(r.Financial) != len(other.Financial) {
+		return false
+	}
+	for i := range r.Financial {
+		if !r.Financial[i].Equal(other.Financial[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitBenefitBalance) EqualsDeep(other ExplanationOfBenefitBenefitBalance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Category.EqualsDeep(other.Category) {
+		return false
+	}
+	if (r.Excluded == nil) != (other.Excluded == nil) || r.Excluded != nil && *r.Excluded != *other.Excluded {
+		return false
+	}
+	if (r.ExcludedElement == nil) != (other.ExcludedElement == nil) || r.ExcludedElement != nil && !r.ExcludedElement.EqualsDeep(*other.ExcludedElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Network == nil) != (other.Network == nil) || r.Network != nil && !r.Network.EqualsDeep(*other.Network) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !r.Unit.EqualsDeep(*other.Unit) {
+		return false
+	}
+	if (r.Term == nil) != (other.Term == nil) || r.Term != nil && !r.Term.EqualsDeep(*other.Term) {
+		return false
+	}
+	if !equivalentList(len(r.Financial), len(other.Financial), func(i, j int) bool {
+		return r.Financial[i].EqualsDeep(other.Financial[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitBenefitBalance) EqualsShallow(other ExplanationOfBenefitBenefitBalance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Excluded == nil) != (other.Excluded == nil) || r.Excluded != nil && *r.Excluded != *other.Excluded {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitBenefitBalance as FHIR XML
+func (r ExplanationOfBenefitBenefitBalance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "excluded", r.Excluded, r.ExcludedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "network", r.Network); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unit", r.Unit); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "term", r.Term); err != nil {
+		return err
+	}
+	for _, v := range r.Financial {
+		if err := encodeXMLElement(e, "financial", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitBenefitBalance from FHIR XML
+func (r *ExplanationOfBenefitBenefitBalance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = v
+			case "excluded":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Excluded = &v
+				}
+				r.ExcludedElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "network":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Network = &v
+			case "unit":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Unit = &v
+			case "term":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Term = &v
+			case "financial":
+				var v ExplanationOfBenefitBenefitBalanceFinancial
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Financial = append(r.Financial, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitBenefitBalance as properties to the RDF node n
+func (r ExplanationOfBenefitBenefitBalance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ExplanationOfBenefit.benefitBalance.category", -1, r.Category)
+	n.primitive("ExplanationOfBenefit.benefitBalance.excluded", -1, r.Excluded, r.ExcludedElement, "boolean")
+	n.primitive("ExplanationOfBenefit.benefitBalance.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ExplanationOfBenefit.benefitBalance.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("ExplanationOfBenefit.benefitBalance.network", -1, r.Network)
+	n.element("ExplanationOfBenefit.benefitBalance.unit", -1, r.Unit)
+	n.element("ExplanationOfBenefit.benefitBalance.term", -1, r.Term)
+	for i, v := range r.Financial {
+		n.element("ExplanationOfBenefit.benefitBalance.financial", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitBenefitBalance as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitBenefitBalance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitBenefitBalance from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitBenefitBalance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitBenefitBalance as protobuf message ExplanationOfBenefitBenefitBalance of fhir.proto
+func (r ExplanationOfBenefitBenefitBalance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitBenefitBalance to the encoded message
+func (r ExplanationOfBenefitBenefitBalance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Category)
+	if r.Excluded != nil {
+		e.varint(5, protoBool(*r.Excluded))
+	}
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.Description != nil {
+		e.string(7, *r.Description)
+	}
+	if r.Network != nil {
+		e.message(8, *r.Network)
+	}
+	if r.Unit != nil {
+		e.message(9, *r.Unit)
+	}
+	if r.Term != nil {
+		e.message(10, *r.Term)
+	}
+	for _, v := range r.Financial {
+		e.message(11, v)
+	}
+	if r.ExcludedElement != nil {
+		e.message(12, *r.ExcludedElement)
+	}
+	if r.NameElement != nil {
+		e.message(13, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(14, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitBenefitBalance from protobuf message ExplanationOfBenefitBenefitBalance of fhir.proto
+func (r *ExplanationOfBenefitBenefitBalance) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitBenefitBalance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = v
+		case 5:
+			v := d.bool()
+			r.Excluded = &v
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			v := d.string()
+			r.Description = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Network = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Unit = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Term = &v
+		case 11:
+			var v ExplanationOfBenefitBenefitBalanceFinancial
+			d.message(&v)
+			r.Financial = append(r.Financial, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ExcludedElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ExplanationOfBenefitBenefitBalanceFinancial as JSON into a byte slice
+func (r ExplanationOfBenefitBenefitBalanceFinancial) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ExplanationOfBenefitBenefitBalanceFinancial as JSON object with the members in the order of the fields
+func (r ExplanationOfBenefitBenefitBalanceFinancial) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.AllowedUnsignedInt != nil {
+		e.key("allowedUnsignedInt")
+		e.int(*r.AllowedUnsignedInt)
+	}
+	if r.AllowedUnsignedIntElement != nil {
+		e.key("_allowedUnsignedInt")
+		r.AllowedUnsignedIntElement.appendJSON(e)
+	}
+	if r.AllowedString != nil {
+		e.key("allowedString")
+		e.string(*r.AllowedString)
+	}
+	if r.AllowedStringElement != nil {
+		e.key("_allowedString")
+		r.AllowedStringElement.appendJSON(e)
+	}
+	if r.AllowedMoney != nil {
+		e.key("allowedMoney")
+		r.AllowedMoney.appendJSON(e)
+	}
+	if r.UsedUnsignedInt != nil {
+		e.key("usedUnsignedInt")
+		e.int(*r.UsedUnsignedInt)
+	}
+	if r.UsedUnsignedIntElement != nil {
+		e.key("_usedUnsignedInt")
+		r.UsedUnsignedIntElement.appendJSON(e)
+	}
+	if r.UsedMoney != nil {
+		e.key("usedMoney")
+		r.UsedMoney.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ExplanationOfBenefitBenefitBalanceFinancial from JSON. Unknown members are ignored.
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ExplanationOfBenefitBenefitBalanceFinancial
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "allowedUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.AllowedUnsignedInt = &v
+			} else {
+				r.AllowedUnsignedInt = nil
+			}
+		case "_allowedUnsignedInt":
+			r.AllowedUnsignedIntElement = d.element()
+		case "allowedString":
+			if v, ok := d.string(); ok {
+				r.AllowedString = &v
+			} else {
+				r.AllowedString = nil
+			}
+		case "_allowedString":
+			r.AllowedStringElement = d.element()
+		case "allowedMoney":
+			if d.null() {
+				r.AllowedMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.AllowedMoney = &v
+			}
+		case "usedUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.UsedUnsignedInt = &v
+			} else {
+				r.UsedUnsignedInt = nil
+			}
+		case "_usedUnsignedInt":
+			r.UsedUnsignedIntElement = d.element()
+		case "usedMoney":
+			if d.null() {
+				r.UsedMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.UsedMoney = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ExplanationOfBenefitBenefitBalanceFinancial which shares no memory with the original
+func (r ExplanationOfBenefitBenefitBalanceFinancial) DeepCopy() ExplanationOfBenefitBenefitBalanceFinancial {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.AllowedUnsignedInt != nil {
+		v := *r.AllowedUnsignedInt
+		out.AllowedUnsignedInt = &v
+	}
+	if r.AllowedUnsignedIntElement != nil {
+		v := r.AllowedUnsignedIntElement.DeepCopy()
+		out.AllowedUnsignedIntElement = &v
+	}
+	if r.AllowedString != nil {
+		v := *r.AllowedString
+		out.AllowedString = &v
+	}
+	if r.AllowedStringElement != nil {
+		v := r.AllowedStringElement.DeepCopy()
+		out.AllowedStringElement = &v
+	}
+	if r.AllowedMoney != nil {
+		v := r.AllowedMoney.DeepCopy()
+		out.AllowedMoney = &v
+	}
+	if r.UsedUnsignedInt != nil {
+		v := *r.UsedUnsignedInt
+		out.UsedUnsignedInt = &v
+	}
+	if r.UsedUnsignedIntElement != nil {
+		v := r.UsedUnsignedIntElement.DeepCopy()
+		out.UsedUnsignedIntElement = &v
+	}
+	if r.UsedMoney != nil {
+		v := r.UsedMoney.DeepCopy()
+		out.UsedMoney = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ExplanationOfBenefitBenefitBalanceFinancial) Equal(other ExplanationOfBenefitBenefitBalanceFinancial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.AllowedUnsignedInt == nil) != (other.AllowedUnsignedInt == nil) || r.AllowedUnsignedInt != nil && *r.AllowedUnsignedInt != *other.AllowedUnsignedInt {
+		return false
+	}
+	if (r.AllowedUnsignedIntElement == nil) != (other.AllowedUnsignedIntElement == nil) || r.AllowedUnsignedIntElement != nil && !r.AllowedUnsignedIntElement.Equal(*other.AllowedUnsignedIntElement) {
+		return false
+	}
+	if (r.AllowedString == nil) != (other.AllowedString == nil) || r.AllowedString != nil && *r.AllowedString != *other.AllowedString {
+		return false
+	}
+	if (r.AllowedStringElement == nil) != (other.AllowedStringElement == nil) || r.AllowedStringElement != nil && !r.AllowedStringElement.Equal(*other.AllowedStringElement) {
+		return false
+	}
+	if (r.AllowedMoney == nil) != (other.AllowedMoney == nil) || r.AllowedMoney != nil && !r.AllowedMoney.Equal(*other.AllowedMoney) {
+		return false
+	}
+	if (r.UsedUnsignedInt == nil) != (other.UsedUnsignedInt == nil) || r.UsedUnsignedInt != nil && *r.UsedUnsignedInt != *other.UsedUnsignedInt {
+		return false
+	}
+	if (r.UsedUnsignedIntElement == nil) != (other.UsedUnsignedIntElement == nil) || r.UsedUnsignedIntElement != nil && !r.UsedUnsignedIntElement.Equal(*other.UsedUnsignedIntElement) {
+		return false
+	}
+	if (r.UsedMoney == nil) != (other.UsedMoney == nil) || r.UsedMoney != nil && !r.UsedMoney.Equal(*other.UsedMoney) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ExplanationOfBenefitBenefitBalanceFinancial) EqualsDeep(other ExplanationOfBenefitBenefitBalanceFinancial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.AllowedUnsignedInt == nil) != (other.AllowedUnsignedInt == nil) || r.AllowedUnsignedInt != nil && *r.AllowedUnsignedInt != *other.AllowedUnsignedInt {
+		return false
+	}
+	if (r.AllowedUnsignedIntElement == nil) != (other.AllowedUnsignedIntElement == nil) || r.AllowedUnsignedIntElement != nil && !r.AllowedUnsignedIntElement.EqualsDeep(*other.AllowedUnsignedIntElement) {
+		return false
+	}
+	if (r.AllowedString == nil) != (other.AllowedString == nil) || r.AllowedString != nil && !equivalentString(*r.AllowedString, *other.AllowedString) {
+		return false
+	}
+	if (r.AllowedStringElement == nil) != (other.AllowedStringElement == nil) || r.AllowedStringElement != nil && !r.AllowedStringElement.EqualsDeep(*other.AllowedStringElement) {
+		return false
+	}
+	if (r.AllowedMoney == nil) != (other.AllowedMoney == nil) || r.AllowedMoney != nil && !r.AllowedMoney.EqualsDeep(*other.AllowedMoney) {
+		return false
+	}
+	if (r.UsedUnsignedInt == nil) != (other.UsedUnsignedInt == nil) || r.UsedUnsignedInt != nil && *r.UsedUnsignedInt != *other.UsedUnsignedInt {
+		return false
+	}
+	if (r.UsedUnsignedIntElement == nil) != (other.UsedUnsignedIntElement == nil) || r.UsedUnsignedIntElement != nil && !r.UsedUnsignedIntElement.EqualsDeep(*other.UsedUnsignedIntElement) {
+		return false
+	}
+	if (r.UsedMoney == nil) != (other.UsedMoney == nil) || r.UsedMoney != nil && !r.UsedMoney.EqualsDeep(*other.UsedMoney) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ExplanationOfBenefitBenefitBalanceFinancial) EqualsShallow(other ExplanationOfBenefitBenefitBalanceFinancial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.AllowedUnsignedInt == nil) != (other.AllowedUnsignedInt == nil) || r.AllowedUnsignedInt != nil && *r.AllowedUnsignedInt != *other.AllowedUnsignedInt {
+		return false
+	}
+	if (r.AllowedString == nil) != (other.AllowedString == nil) || r.AllowedString != nil && !equivalentString(*r.AllowedString, *other.AllowedString) {
+		return false
+	}
+	if (r.UsedUnsignedInt == nil) != (other.UsedUnsignedInt == nil) || r.UsedUnsignedInt != nil && *r.UsedUnsignedInt != *other.UsedUnsignedInt {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ExplanationOfBenefitBenefitBalanceFinancial as FHIR XML
+func (r ExplanationOfBenefitBenefitBalanceFinancial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "allowedUnsignedInt", r.AllowedUnsignedInt, r.AllowedUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "allowedString", r.AllowedString, r.AllowedStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "allowedMoney", r.AllowedMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "usedUnsignedInt", r.UsedUnsignedInt, r.UsedUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "usedMoney", r.UsedMoney); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ExplanationOfBenefitBenefitBalanceFinancial from FHIR XML
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "allowedUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllowedUnsignedInt = &v
+				}
+				r.AllowedUnsignedIntElement = element
+			case "allowedString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllowedString = &v
+				}
+				r.AllowedStringElement = element
+			case "allowedMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AllowedMoney = &v
+			case "usedUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.UsedUnsignedInt = &v
+				}
+				r.UsedUnsignedIntElement = element
+			case "usedMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UsedMoney = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ExplanationOfBenefitBenefitBalanceFinancial as properties to the RDF node n
+func (r ExplanationOfBenefitBenefitBalanceFinancial) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ExplanationOfBenefit.benefitBalance.financial.type", -1, r.Type)
+	n.primitive("ExplanationOfBenefit.benefitBalance.financial.allowedUnsignedInt", -1, r.AllowedUnsignedInt, r.AllowedUnsignedIntElement, "unsignedInt")
+	n.primitive("ExplanationOfBenefit.benefitBalance.financial.allowedString", -1, r.AllowedString, r.AllowedStringElement, "string")
+	n.element("ExplanationOfBenefit.benefitBalance.financial.allowedMoney", -1, r.AllowedMoney)
+	n.primitive("ExplanationOfBenefit.benefitBalance.financial.usedUnsignedInt", -1, r.UsedUnsignedInt, r.UsedUnsignedIntElement, "unsignedInt")
+	n.element("ExplanationOfBenefit.benefitBalance.financial.usedMoney", -1, r.UsedMoney)
+}
+
+// MarshalBSON marshals the given ExplanationOfBenefitBenefitBalanceFinancial as BSON document with the structure of its FHIR JSON
+func (r ExplanationOfBenefitBenefitBalanceFinancial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ExplanationOfBenefitBenefitBalanceFinancial from a BSON document with the structure of its FHIR JSON
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ExplanationOfBenefitBenefitBalanceFinancial as protobuf message ExplanationOfBenefitBenefitBalanceFinancial of fhir.proto
+func (r ExplanationOfBenefitBenefitBalanceFinancial) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ExplanationOfBenefitBenefitBalanceFinancial to the encoded message
+func (r ExplanationOfBenefitBenefitBalanceFinancial) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.AllowedUnsignedInt != nil {
+		e.varint(5, uint64(int64(*r.AllowedUnsignedInt)))
+	}
+	if r.AllowedString != nil {
+		e.string(6, *r.AllowedString)
+	}
+	if r.AllowedMoney != nil {
+		e.message(7, *r.AllowedMoney)
+	}
+	if r.UsedUnsignedInt != nil {
+		e.varint(8, uint64(int64(*r.UsedUnsignedInt)))
+	}
+	if r.UsedMoney != nil {
+		e.message(9, *r.UsedMoney)
+	}
+	if r.AllowedUnsignedIntElement != nil {
+		e.message(10, *r.AllowedUnsignedIntElement)
+	}
+	if r.AllowedStringElement != nil {
+		e.message(11, *r.AllowedStringElement)
+	}
+	if r.UsedUnsignedIntElement != nil {
+		e.message(12, *r.UsedUnsignedIntElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ExplanationOfBenefitBenefitBalanceFinancial from protobuf message ExplanationOfBenefitBenefitBalanceFinancial of fhir.proto
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) UnmarshalProto(b []byte) error {
+	*r = ExplanationOfBenefitBenefitBalanceFinancial{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			v := d.int()
+			r.AllowedUnsignedInt = &v
+		case 6:
+			v := d.string()
+			r.AllowedString = &v
+		case 7:
+			var v Money
+			d.message(&v)
+			r.AllowedMoney = &v
+		case 8:
+			v := d.int()
+			r.UsedUnsignedInt = &v
+		case 9:
+			var v Money
+			d.message(&v)
+			r.UsedMoney = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.AllowedUnsignedIntElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.AllowedStringElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.UsedUnsignedIntElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalExplanationOfBenefit unmarshals a ExplanationOfBenefit.
 func UnmarshalExplanationOfBenefit(b []byte) (ExplanationOfBenefit, error) {
 	var explanationOfBenefit ExplanationOfBenefit
-	if err := json.Unmarshal(b, &explanationOfBenefit); err != nil {
+	if err := explanationOfBenefit.UnmarshalJSON(b); err != nil {
 		return explanationOfBenefit, err
 	}
 	return explanationOfBenefit, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code ExplanationOfBenefitStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ExplanationOfBenefitStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ExplanationOfBenefitStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = ExplanationOfBenefitStatusActive
@@ -52,6 +56,12 @@ func (code *ExplanationOfBenefitStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ExplanationOfBenefitStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ExplanationOfBenefitStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ExplanationOfBenefitStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code ExplanationOfBenefitStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ExplanationOfBenefitStatus) protoNumber() uint64 {
+	switch code {
+	case ExplanationOfBenefitStatusActive:
+		return 1
+	case ExplanationOfBenefitStatusCancelled:
+		return 2
+	case ExplanationOfBenefitStatusDraft:
+		return 3
+	case ExplanationOfBenefitStatusEnteredInError:
+		return 4
+	}
+	return 0
+}
+func (code *ExplanationOfBenefitStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ExplanationOfBenefitStatusActive
+	case 2:
+		*code = ExplanationOfBenefitStatusCancelled
+	case 3:
+		*code = ExplanationOfBenefitStatusDraft
+	case 4:
+		*code = ExplanationOfBenefitStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
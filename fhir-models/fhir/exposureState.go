@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code ExposureState) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ExposureState) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ExposureState) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "exposure":
 		*code = ExposureStateExposure
@@ -46,6 +50,12 @@ func (code *ExposureState) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ExposureState) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ExposureState) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ExposureState) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code ExposureState) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ExposureState) protoNumber() uint64 {
+	switch code {
+	case ExposureStateExposure:
+		return 1
+	case ExposureStateExposureAlternative:
+		return 2
+	}
+	return 0
+}
+func (code *ExposureState) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ExposureStateExposure
+	case 2:
+		*code = ExposureStateExposureAlternative
+	default:
+		return false
+	}
+	return true
+}
@@ -14,16 +14,569 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Expression is documented here http://hl7.org/fhir/StructureDefinition/Expression
 type Expression struct {
-	Id          *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Description *string     `bson:"description,omitempty" json:"description,omitempty"`
-	Name        *string     `bson:"name,omitempty" json:"name,omitempty"`
-	Language    string      `bson:"language" json:"language"`
-	Expression  *string     `bson:"expression,omitempty" json:"expression,omitempty"`
-	Reference   *string     `bson:"reference,omitempty" json:"reference,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Description        *string     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Name               *string     `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	Language           string      `bson:"language" json:"language"`
+	LanguageElement    *Element    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Expression         *string     `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement  *Element    `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	Reference          *string     `bson:"reference,omitempty" json:"reference,omitempty"`
+	ReferenceElement   *Element    `bson:"_reference,omitempty" json:"_reference,omitempty"`
+}
+
+// MarshalJSON marshals the given Expression as JSON into a byte slice
+func (r Expression) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Expression as JSON object with the members in the order of the fields
+func (r Expression) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("language")
+	e.string(r.Language)
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		e.string(*r.Expression)
+	}
+	if r.ExpressionElement != nil {
+		e.key("_expression")
+		r.ExpressionElement.appendJSON(e)
+	}
+	if r.Reference != nil {
+		e.key("reference")
+		e.string(*r.Reference)
+	}
+	if r.ReferenceElement != nil {
+		e.key("_reference")
+		r.ReferenceElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Expression from JSON. Unknown members are ignored.
+func (r *Expression) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Expression
+func (r *Expression) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = v
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "expression":
+			if v, ok := d.string(); ok {
+				r.Expression = &v
+			} else {
+				r.Expression = nil
+			}
+		case "_expression":
+			r.ExpressionElement = d.element()
+		case "reference":
+			if v, ok := d.string(); ok {
+				r.Reference = &v
+			} else {
+				r.Reference = nil
+			}
+		case "_reference":
+			r.ReferenceElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Expression which shares no memory with the original
+func (r Expression) DeepCopy() Expression {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Expression != nil {
+		v := *r.Expression
+		out.Expression = &v
+	}
+	if r.ExpressionElement != nil {
+		v := r.ExpressionElement.DeepCopy()
+		out.ExpressionElement = &v
+	}
+	if r.Reference != nil {
+		v := *r.Reference
+		out.Reference = &v
+	}
+	if r.ReferenceElement != nil {
+		v := r.ReferenceElement.DeepCopy()
+		out.ReferenceElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Expression) Equal(other Expression) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Language != other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && *r.Expression != *other.Expression {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.Equal(*other.ExpressionElement) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && *r.Reference != *other.Reference {
+		return false
+	}
+	if (r.ReferenceElement == nil) != (other.ReferenceElement == nil) || r.ReferenceElement != nil && !r.ReferenceElement.Equal(*other.ReferenceElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Expression) EqualsDeep(other Expression) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if !equivalentString(r.Language, other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.EqualsDeep(*other.ExpressionElement) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !equivalentString(*r.Reference, *other.Reference) {
+		return false
+	}
+	if (r.ReferenceElement == nil) != (other.ReferenceElement == nil) || r.ReferenceElement != nil && !r.ReferenceElement.EqualsDeep(*other.ReferenceElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Expression) EqualsShallow(other Expression) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if !equivalentString(r.Language, other.Language) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !equivalentString(*r.Reference, *other.Reference) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Expression as FHIR XML
+func (r Expression) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expression", r.Expression, r.ExpressionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "reference", r.Reference, r.ReferenceElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Expression from FHIR XML
+func (r *Expression) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = v
+				}
+				r.LanguageElement = element
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Expression = &v
+				}
+				r.ExpressionElement = element
+			case "reference":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Reference = &v
+				}
+				r.ReferenceElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Expression as properties to the RDF node n
+func (r Expression) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Expression.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("Expression.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("Expression.language", -1, r.Language, r.LanguageElement, "string")
+	n.primitive("Expression.expression", -1, r.Expression, r.ExpressionElement, "string")
+	n.primitive("Expression.reference", -1, r.Reference, r.ReferenceElement, "string")
+}
+
+// MarshalBSON marshals the given Expression as BSON document with the structure of its FHIR JSON
+func (r Expression) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Expression from a BSON document with the structure of its FHIR JSON
+func (r *Expression) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Expression as protobuf message Expression of fhir.proto
+func (r Expression) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Expression to the encoded message
+func (r Expression) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Description != nil {
+		e.string(3, *r.Description)
+	}
+	if r.Name != nil {
+		e.string(4, *r.Name)
+	}
+	if r.Language != "" {
+		e.string(5, r.Language)
+	}
+	if r.Expression != nil {
+		e.string(6, *r.Expression)
+	}
+	if r.Reference != nil {
+		e.string(7, *r.Reference)
+	}
+	if r.DescriptionElement != nil {
+		e.message(8, *r.DescriptionElement)
+	}
+	if r.NameElement != nil {
+		e.message(9, *r.NameElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(10, *r.LanguageElement)
+	}
+	if r.ExpressionElement != nil {
+		e.message(11, *r.ExpressionElement)
+	}
+	if r.ReferenceElement != nil {
+		e.message(12, *r.ReferenceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Expression from protobuf message Expression of fhir.proto
+func (r *Expression) UnmarshalProto(b []byte) error {
+	*r = Expression{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.Description = &v
+		case 4:
+			v := d.string()
+			r.Name = &v
+		case 5:
+			r.Language = d.string()
+		case 6:
+			v := d.string()
+			r.Expression = &v
+		case 7:
+			v := d.string()
+			r.Reference = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ExpressionElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ReferenceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
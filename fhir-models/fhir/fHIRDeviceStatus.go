@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code FHIRDeviceStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *FHIRDeviceStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *FHIRDeviceStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = FHIRDeviceStatusActive
@@ -52,6 +56,12 @@ func (code *FHIRDeviceStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code FHIRDeviceStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *FHIRDeviceStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code FHIRDeviceStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code FHIRDeviceStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code FHIRDeviceStatus) protoNumber() uint64 {
+	switch code {
+	case FHIRDeviceStatusActive:
+		return 1
+	case FHIRDeviceStatusInactive:
+		return 2
+	case FHIRDeviceStatusEnteredInError:
+		return 3
+	case FHIRDeviceStatusUnknown:
+		return 4
+	}
+	return 0
+}
+func (code *FHIRDeviceStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = FHIRDeviceStatusActive
+	case 2:
+		*code = FHIRDeviceStatusInactive
+	case 3:
+		*code = FHIRDeviceStatusEnteredInError
+	case 4:
+		*code = FHIRDeviceStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code FHIRSubstanceStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *FHIRSubstanceStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *FHIRSubstanceStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = FHIRSubstanceStatusActive
@@ -49,6 +53,12 @@ func (code *FHIRSubstanceStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code FHIRSubstanceStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *FHIRSubstanceStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code FHIRSubstanceStatus) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code FHIRSubstanceStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code FHIRSubstanceStatus) protoNumber() uint64 {
+	switch code {
+	case FHIRSubstanceStatusActive:
+		return 1
+	case FHIRSubstanceStatusInactive:
+		return 2
+	case FHIRSubstanceStatusEnteredInError:
+		return 3
+	}
+	return 0
+}
+func (code *FHIRSubstanceStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = FHIRSubstanceStatusActive
+	case 2:
+		*code = FHIRSubstanceStatusInactive
+	case 3:
+		*code = FHIRSubstanceStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code FamilyHistoryStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *FamilyHistoryStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *FamilyHistoryStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "partial":
 		*code = FamilyHistoryStatusPartial
@@ -52,6 +56,12 @@ func (code *FamilyHistoryStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code FamilyHistoryStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *FamilyHistoryStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code FamilyHistoryStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code FamilyHistoryStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code FamilyHistoryStatus) protoNumber() uint64 {
+	switch code {
+	case FamilyHistoryStatusPartial:
+		return 1
+	case FamilyHistoryStatusCompleted:
+		return 2
+	case FamilyHistoryStatusEnteredInError:
+		return 3
+	case FamilyHistoryStatusHealthUnknown:
+		return 4
+	}
+	return 0
+}
+func (code *FamilyHistoryStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = FamilyHistoryStatusPartial
+	case 2:
+		*code = FamilyHistoryStatusCompleted
+	case 3:
+		*code = FamilyHistoryStatusEnteredInError
+	case 4:
+		*code = FamilyHistoryStatusHealthUnknown
+	default:
+		return false
+	}
+	return true
+}
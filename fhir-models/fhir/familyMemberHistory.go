@@ -14,77 +14,2998 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // FamilyMemberHistory is documented here http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory
 type FamilyMemberHistory struct {
-	Id                    *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string                       `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string                       `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	Status                FamilyHistoryStatus            `bson:"status" json:"status"`
-	DataAbsentReason      *CodeableConcept               `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
-	Patient               Reference                      `bson:"patient" json:"patient"`
-	Date                  *string                        `bson:"date,omitempty" json:"date,omitempty"`
-	Name                  *string                        `bson:"name,omitempty" json:"name,omitempty"`
-	Relationship          CodeableConcept                `bson:"relationship" json:"relationship"`
-	Sex                   *CodeableConcept               `bson:"sex,omitempty" json:"sex,omitempty"`
-	BornPeriod            *Period                        `bson:"bornPeriod,omitempty" json:"bornPeriod,omitempty"`
-	BornDate              *string                        `bson:"bornDate,omitempty" json:"bornDate,omitempty"`
-	BornString            *string                        `bson:"bornString,omitempty" json:"bornString,omitempty"`
-	AgeAge                *Age                           `bson:"ageAge,omitempty" json:"ageAge,omitempty"`
-	AgeRange              *Range                         `bson:"ageRange,omitempty" json:"ageRange,omitempty"`
-	AgeString             *string                        `bson:"ageString,omitempty" json:"ageString,omitempty"`
-	EstimatedAge          *bool                          `bson:"estimatedAge,omitempty" json:"estimatedAge,omitempty"`
-	DeceasedBoolean       *bool                          `bson:"deceasedBoolean,omitempty" json:"deceasedBoolean,omitempty"`
-	DeceasedAge           *Age                           `bson:"deceasedAge,omitempty" json:"deceasedAge,omitempty"`
-	DeceasedRange         *Range                         `bson:"deceasedRange,omitempty" json:"deceasedRange,omitempty"`
-	DeceasedDate          *string                        `bson:"deceasedDate,omitempty" json:"deceasedDate,omitempty"`
-	DeceasedString        *string                        `bson:"deceasedString,omitempty" json:"deceasedString,omitempty"`
-	ReasonCode            []CodeableConcept              `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference                    `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note                  []Annotation                   `bson:"note,omitempty" json:"note,omitempty"`
-	Condition             []FamilyMemberHistoryCondition `bson:"condition,omitempty" json:"condition,omitempty"`
+	Id                           *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element                       `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []string                       `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                     `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string                       `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                     `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	Status                       FamilyHistoryStatus            `bson:"status" json:"status"`
+	StatusElement                *Element                       `bson:"_status,omitempty" json:"_status,omitempty"`
+	DataAbsentReason             *CodeableConcept               `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
+	Patient                      Reference                      `bson:"patient" json:"patient"`
+	Date                         *string                        `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement                  *Element                       `bson:"_date,omitempty" json:"_date,omitempty"`
+	Name                         *string                        `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                  *Element                       `bson:"_name,omitempty" json:"_name,omitempty"`
+	Relationship                 CodeableConcept                `bson:"relationship" json:"relationship"`
+	Sex                          *CodeableConcept               `bson:"sex,omitempty" json:"sex,omitempty"`
+	BornPeriod                   *Period                        `bson:"bornPeriod,omitempty" json:"bornPeriod,omitempty"`
+	BornDate                     *string                        `bson:"bornDate,omitempty" json:"bornDate,omitempty"`
+	BornDateElement              *Element                       `bson:"_bornDate,omitempty" json:"_bornDate,omitempty"`
+	BornString                   *string                        `bson:"bornString,omitempty" json:"bornString,omitempty"`
+	BornStringElement            *Element                       `bson:"_bornString,omitempty" json:"_bornString,omitempty"`
+	AgeAge                       *Age                           `bson:"ageAge,omitempty" json:"ageAge,omitempty"`
+	AgeRange                     *Range                         `bson:"ageRange,omitempty" json:"ageRange,omitempty"`
+	AgeString                    *string                        `bson:"ageString,omitempty" json:"ageString,omitempty"`
+	AgeStringElement             *Element                       `bson:"_ageString,omitempty" json:"_ageString,omitempty"`
+	EstimatedAge                 *bool                          `bson:"estimatedAge,omitempty" json:"estimatedAge,omitempty"`
+	EstimatedAgeElement          *Element                       `bson:"_estimatedAge,omitempty" json:"_estimatedAge,omitempty"`
+	DeceasedBoolean              *bool                          `bson:"deceasedBoolean,omitempty" json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement       *Element                       `bson:"_deceasedBoolean,omitempty" json:"_deceasedBoolean,omitempty"`
+	DeceasedAge                  *Age                           `bson:"deceasedAge,omitempty" json:"deceasedAge,omitempty"`
+	DeceasedRange                *Range                         `bson:"deceasedRange,omitempty" json:"deceasedRange,omitempty"`
+	DeceasedDate                 *string                        `bson:"deceasedDate,omitempty" json:"deceasedDate,omitempty"`
+	DeceasedDateElement          *Element                       `bson:"_deceasedDate,omitempty" json:"_deceasedDate,omitempty"`
+	DeceasedString               *string                        `bson:"deceasedString,omitempty" json:"deceasedString,omitempty"`
+	DeceasedStringElement        *Element                       `bson:"_deceasedString,omitempty" json:"_deceasedString,omitempty"`
+	ReasonCode                   []CodeableConcept              `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                    `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                         []Annotation                   `bson:"note,omitempty" json:"note,omitempty"`
+	Condition                    []FamilyMemberHistoryCondition `bson:"condition,omitempty" json:"condition,omitempty"`
 }
+
+// FamilyMemberHistoryBorn is implemented by the types allowed for FamilyMemberHistory.born[x]
+type FamilyMemberHistoryBorn interface {
+	isFamilyMemberHistoryBorn()
+}
+
+func (Period) isFamilyMemberHistoryBorn() {}
+func (Date) isFamilyMemberHistoryBorn()   {}
+func (String) isFamilyMemberHistoryBorn() {}
+
+// Born returns the value of FamilyMemberHistory.born[x] together with its FHIR type code or nil if no value is set
+func (r FamilyMemberHistory) Born() (FamilyMemberHistoryBorn, string) {
+	if r.BornPeriod != nil {
+		return *r.BornPeriod, "Period"
+	}
+	if r.BornDate != nil {
+		return Date(*r.BornDate), "date"
+	}
+	if r.BornString != nil {
+		return String(*r.BornString), "string"
+	}
+	return nil, ""
+}
+
+// SetBorn sets the value of FamilyMemberHistory.born[x], given as value or pointer, and clears all other types
+func (r *FamilyMemberHistory) SetBorn(value FamilyMemberHistoryBorn) {
+	r.BornPeriod = nil
+	r.BornDate = nil
+	r.BornString = nil
+	switch v := value.(type) {
+	case Period:
+		r.BornPeriod = &v
+	case *Period:
+		r.BornPeriod = v
+	case Date:
+		primitive := string(v)
+		r.BornDate = &primitive
+	case *Date:
+		r.BornDate = (*string)(v)
+	case String:
+		primitive := string(v)
+		r.BornString = &primitive
+	case *String:
+		r.BornString = (*string)(v)
+	}
+}
+
+// FamilyMemberHistoryAge is implemented by the types allowed for FamilyMemberHistory.age[x]
+type FamilyMemberHistoryAge interface {
+	isFamilyMemberHistoryAge()
+}
+
+func (Age) isFamilyMemberHistoryAge()    {}
+func (Range) isFamilyMemberHistoryAge()  {}
+func (String) isFamilyMemberHistoryAge() {}
+
+// Age returns the value of FamilyMemberHistory.age[x] together with its FHIR type code or nil if no value is set
+func (r FamilyMemberHistory) Age() (FamilyMemberHistoryAge, string) {
+	if r.AgeAge != nil {
+		return *r.AgeAge, "Age"
+	}
+	if r.AgeRange != nil {
+		return *r.AgeRange, "Range"
+	}
+	if r.AgeString != nil {
+		return String(*r.AgeString), "string"
+	}
+	return nil, ""
+}
+
+// SetAge sets the value of FamilyMemberHistory.age[x], given as value or pointer, and clears all other types
+func (r *FamilyMemberHistory) SetAge(value FamilyMemberHistoryAge) {
+	r.AgeAge = nil
+	r.AgeRange = nil
+	r.AgeString = nil
+	switch v := value.(type) {
+	case Age:
+		r.AgeAge = &v
+	case *Age:
+		r.AgeAge = v
+	case Range:
+		r.AgeRange = &v
+	case *Range:
+		r.AgeRange = v
+	case String:
+		primitive := string(v)
+		r.AgeString = &primitive
+	case *String:
+		r.AgeString = (*string)(v)
+	}
+}
+
+// FamilyMemberHistoryDeceased is implemented by the types allowed for FamilyMemberHistory.deceased[x]
+type FamilyMemberHistoryDeceased interface {
+	isFamilyMemberHistoryDeceased()
+}
+
+func (Boolean) isFamilyMemberHistoryDeceased() {}
+func (Age) isFamilyMemberHistoryDeceased()     {}
+func (Range) isFamilyMemberHistoryDeceased()   {}
+func (Date) isFamilyMemberHistoryDeceased()    {}
+func (String) isFamilyMemberHistoryDeceased()  {}
+
+// Deceased returns the value of FamilyMemberHistory.deceased[x] together with its FHIR type code or nil if no value is set
+func (r FamilyMemberHistory) Deceased() (FamilyMemberHistoryDeceased, string) {
+	if r.DeceasedBoolean != nil {
+		return Boolean(*r.DeceasedBoolean), "boolean"
+	}
+	if r.DeceasedAge != nil {
+		return *r.DeceasedAge, "Age"
+	}
+	if r.DeceasedRange != nil {
+		return *r.DeceasedRange, "Range"
+	}
+	if r.DeceasedDate != nil {
+		return Date(*r.DeceasedDate), "date"
+	}
+	if r.DeceasedString != nil {
+		return String(*r.DeceasedString), "string"
+	}
+	return nil, ""
+}
+
+// SetDeceased sets the value of FamilyMemberHistory.deceased[x], given as value or pointer, and clears all other types
+func (r *FamilyMemberHistory) SetDeceased(value FamilyMemberHistoryDeceased) {
+	r.DeceasedBoolean = nil
+	r.DeceasedAge = nil
+	r.DeceasedRange = nil
+	r.DeceasedDate = nil
+	r.DeceasedString = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.DeceasedBoolean = &primitive
+	case *Boolean:
+		r.DeceasedBoolean = (*bool)(v)
+	case Age:
+		r.DeceasedAge = &v
+	case *Age:
+		r.DeceasedAge = v
+	case Range:
+		r.DeceasedRange = &v
+	case *Range:
+		r.DeceasedRange = v
+	case Date:
+		primitive := string(v)
+		r.DeceasedDate = &primitive
+	case *Date:
+		r.DeceasedDate = (*string)(v)
+	case String:
+		primitive := string(v)
+		r.DeceasedString = &primitive
+	case *String:
+		r.DeceasedString = (*string)(v)
+	}
+}
+
 type FamilyMemberHistoryCondition struct {
-	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code               CodeableConcept  `bson:"code" json:"code"`
-	Outcome            *CodeableConcept `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	ContributedToDeath *bool            `bson:"contributedToDeath,omitempty" json:"contributedToDeath,omitempty"`
-	OnsetAge           *Age             `bson:"onsetAge,omitempty" json:"onsetAge,omitempty"`
-	OnsetRange         *Range           `bson:"onsetRange,omitempty" json:"onsetRange,omitempty"`
-	OnsetPeriod        *Period          `bson:"onsetPeriod,omitempty" json:"onsetPeriod,omitempty"`
-	OnsetString        *string          `bson:"onsetString,omitempty" json:"onsetString,omitempty"`
-	Note               []Annotation     `bson:"note,omitempty" json:"note,omitempty"`
-}
-type OtherFamilyMemberHistory FamilyMemberHistory
+	Id                        *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                      CodeableConcept  `bson:"code" json:"code"`
+	Outcome                   *CodeableConcept `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	ContributedToDeath        *bool            `bson:"contributedToDeath,omitempty" json:"contributedToDeath,omitempty"`
+	ContributedToDeathElement *Element         `bson:"_contributedToDeath,omitempty" json:"_contributedToDeath,omitempty"`
+	OnsetAge                  *Age             `bson:"onsetAge,omitempty" json:"onsetAge,omitempty"`
+	OnsetRange                *Range           `bson:"onsetRange,omitempty" json:"onsetRange,omitempty"`
+	OnsetPeriod               *Period          `bson:"onsetPeriod,omitempty" json:"onsetPeriod,omitempty"`
+	OnsetString               *string          `bson:"onsetString,omitempty" json:"onsetString,omitempty"`
+	OnsetStringElement        *Element         `bson:"_onsetString,omitempty" json:"_onsetString,omitempty"`
+	Note                      []Annotation     `bson:"note,omitempty" json:"note,omitempty"`
+}
+
+// FamilyMemberHistoryConditionOnset is implemented by the types allowed for FamilyMemberHistory.condition.onset[x]
+type FamilyMemberHistoryConditionOnset interface {
+	isFamilyMemberHistoryConditionOnset()
+}
+
+func (Age) isFamilyMemberHistoryConditionOnset()    {}
+func (Range) isFamilyMemberHistoryConditionOnset()  {}
+func (Period) isFamilyMemberHistoryConditionOnset() {}
+func (String) isFamilyMemberHistoryConditionOnset() {}
+
+// Onset returns the value of FamilyMemberHistory.condition.onset[x] together with its FHIR type code or nil if no value is set
+func (r FamilyMemberHistoryCondition) Onset() (FamilyMemberHistoryConditionOnset, string) {
+	if r.OnsetAge != nil {
+		return *r.OnsetAge, "Age"
+	}
+	if r.OnsetRange != nil {
+		return *r.OnsetRange, "Range"
+	}
+	if r.OnsetPeriod != nil {
+		return *r.OnsetPeriod, "Period"
+	}
+	if r.OnsetString != nil {
+		return String(*r.OnsetString), "string"
+	}
+	return nil, ""
+}
+
+// SetOnset sets the value of FamilyMemberHistory.condition.onset[x], given as value or pointer, and clears all other types
+func (r *FamilyMemberHistoryCondition) SetOnset(value FamilyMemberHistoryConditionOnset) {
+	r.OnsetAge = nil
+	r.OnsetRange = nil
+	r.OnsetPeriod = nil
+	r.OnsetString = nil
+	switch v := value.(type) {
+	case Age:
+		r.OnsetAge = &v
+	case *Age:
+		r.OnsetAge = v
+	case Range:
+		r.OnsetRange = &v
+	case *Range:
+		r.OnsetRange = v
+	case Period:
+		r.OnsetPeriod = &v
+	case *Period:
+		r.OnsetPeriod = v
+	case String:
+		primitive := string(v)
+		r.OnsetString = &primitive
+	case *String:
+		r.OnsetString = (*string)(v)
+	}
+}
 
 // MarshalJSON marshals the given FamilyMemberHistory as JSON into a byte slice
 func (r FamilyMemberHistory) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherFamilyMemberHistory
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherFamilyMemberHistory: OtherFamilyMemberHistory(r),
-		ResourceType:             "FamilyMemberHistory",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the FamilyMemberHistory as JSON object with the members in the order of the fields
+func (r FamilyMemberHistory) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"FamilyMemberHistory\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.DataAbsentReason != nil {
+		e.key("dataAbsentReason")
+		r.DataAbsentReason.appendJSON(e)
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("relationship")
+	r.Relationship.appendJSON(e)
+	if r.Sex != nil {
+		e.key("sex")
+		r.Sex.appendJSON(e)
+	}
+	if r.BornPeriod != nil {
+		e.key("bornPeriod")
+		r.BornPeriod.appendJSON(e)
+	}
+	if r.BornDate != nil {
+		e.key("bornDate")
+		e.string(*r.BornDate)
+	}
+	if r.BornDateElement != nil {
+		e.key("_bornDate")
+		r.BornDateElement.appendJSON(e)
+	}
+	if r.BornString != nil {
+		e.key("bornString")
+		e.string(*r.BornString)
+	}
+	if r.BornStringElement != nil {
+		e.key("_bornString")
+		r.BornStringElement.appendJSON(e)
+	}
+	if r.AgeAge != nil {
+		e.key("ageAge")
+		r.AgeAge.appendJSON(e)
+	}
+	if r.AgeRange != nil {
+		e.key("ageRange")
+		r.AgeRange.appendJSON(e)
+	}
+	if r.AgeString != nil {
+		e.key("ageString")
+		e.string(*r.AgeString)
+	}
+	if r.AgeStringElement != nil {
+		e.key("_ageString")
+		r.AgeStringElement.appendJSON(e)
+	}
+	if r.EstimatedAge != nil {
+		e.key("estimatedAge")
+		e.bool(*r.EstimatedAge)
+	}
+	if r.EstimatedAgeElement != nil {
+		e.key("_estimatedAge")
+		r.EstimatedAgeElement.appendJSON(e)
+	}
+	if r.DeceasedBoolean != nil {
+		e.key("deceasedBoolean")
+		e.bool(*r.DeceasedBoolean)
+	}
+	if r.DeceasedBooleanElement != nil {
+		e.key("_deceasedBoolean")
+		r.DeceasedBooleanElement.appendJSON(e)
+	}
+	if r.DeceasedAge != nil {
+		e.key("deceasedAge")
+		r.DeceasedAge.appendJSON(e)
+	}
+	if r.DeceasedRange != nil {
+		e.key("deceasedRange")
+		r.DeceasedRange.appendJSON(e)
+	}
+	if r.DeceasedDate != nil {
+		e.key("deceasedDate")
+		e.string(*r.DeceasedDate)
+	}
+	if r.DeceasedDateElement != nil {
+		e.key("_deceasedDate")
+		r.DeceasedDateElement.appendJSON(e)
+	}
+	if r.DeceasedString != nil {
+		e.key("deceasedString")
+		e.string(*r.DeceasedString)
+	}
+	if r.DeceasedStringElement != nil {
+		e.key("_deceasedString")
+		r.DeceasedStringElement.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Condition) > 0 {
+		e.key("condition")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Condition {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given FamilyMemberHistory from JSON. Unknown members are ignored.
+func (r *FamilyMemberHistory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the FamilyMemberHistory
+func (r *FamilyMemberHistory) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "dataAbsentReason":
+			if d.null() {
+				r.DataAbsentReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DataAbsentReason = &v
+			}
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "relationship":
+			r.Relationship.decodeJSON(d)
+		case "sex":
+			if d.null() {
+				r.Sex = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Sex = &v
+			}
+		case "bornPeriod":
+			if d.null() {
+				r.BornPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.BornPeriod = &v
+			}
+		case "bornDate":
+			if v, ok := d.string(); ok {
+				r.BornDate = &v
+			} else {
+				r.BornDate = nil
+			}
+		case "_bornDate":
+			r.BornDateElement = d.element()
+		case "bornString":
+			if v, ok := d.string(); ok {
+				r.BornString = &v
+			} else {
+				r.BornString = nil
+			}
+		case "_bornString":
+			r.BornStringElement = d.element()
+		case "ageAge":
+			if d.null() {
+				r.AgeAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.AgeAge = &v
+			}
+		case "ageRange":
+			if d.null() {
+				r.AgeRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.AgeRange = &v
+			}
+		case "ageString":
+			if v, ok := d.string(); ok {
+				r.AgeString = &v
+			} else {
+				r.AgeString = nil
+			}
+		case "_ageString":
+			r.AgeStringElement = d.element()
+		case "estimatedAge":
+			if v, ok := d.bool(); ok {
+				r.EstimatedAge = &v
+			} else {
+				r.EstimatedAge = nil
+			}
+		case "_estimatedAge":
+			r.EstimatedAgeElement = d.element()
+		case "deceasedBoolean":
+			if v, ok := d.bool(); ok {
+				r.DeceasedBoolean = &v
+			} else {
+				r.DeceasedBoolean = nil
+			}
+		case "_deceasedBoolean":
+			r.DeceasedBooleanElement = d.element()
+		case "deceasedAge":
+			if d.null() {
+				r.DeceasedAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.DeceasedAge = &v
+			}
+		case "deceasedRange":
+			if d.null() {
+				r.DeceasedRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.DeceasedRange = &v
+			}
+		case "deceasedDate":
+			if v, ok := d.string(); ok {
+				r.DeceasedDate = &v
+			} else {
+				r.DeceasedDate = nil
+			}
+		case "_deceasedDate":
+			r.DeceasedDateElement = d.element()
+		case "deceasedString":
+			if v, ok := d.string(); ok {
+				r.DeceasedString = &v
+			} else {
+				r.DeceasedString = nil
+			}
+		case "_deceasedString":
+			r.DeceasedStringElement = d.element()
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "condition":
+			a := d.array()
+			r.Condition = nil
+			for a.next() {
+				var v FamilyMemberHistoryCondition
+				v.decodeJSON(d)
+				r.Condition = append(r.Condition, v)
+			}
+			if r.Condition == nil && !a.isNull {
+				r.Condition = []FamilyMemberHistoryCondition{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the FamilyMemberHistory which shares no memory with the original
+func (r FamilyMemberHistory) DeepCopy() FamilyMemberHistory {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.DataAbsentReason != nil {
+		v := r.DataAbsentReason.DeepCopy()
+		out.DataAbsentReason = &v
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	out.Relationship = r.Relationship.DeepCopy()
+	if r.Sex != nil {
+		v := r.Sex.DeepCopy()
+		out.Sex = &v
+	}
+	if r.BornPeriod != nil {
+		v := r.BornPeriod.DeepCopy()
+		out.BornPeriod = &v
+	}
+	if r.BornDate != nil {
+		v := *r.BornDate
+		out.BornDate = &v
+	}
+	if r.BornDateElement != nil {
+		v := r.BornDateElement.DeepCopy()
+		out.BornDateElement = &v
+	}
+	if r.BornString != nil {
+		v := *r.BornString
+		out.BornString = &v
+	}
+	if r.BornStringElement != nil {
+		v := r.BornStringElement.DeepCopy()
+		out.BornStringElement = &v
+	}
+	if r.AgeAge != nil {
+		v := r.AgeAge.DeepCopy()
+		out.AgeAge = &v
+	}
+	if r.AgeRange != nil {
+		v := r.AgeRange.DeepCopy()
+		out.AgeRange = &v
+	}
+	if r.AgeString != nil {
+		v := *r.AgeString
+		out.AgeString = &v
+	}
+	if r.AgeStringElement != nil {
+		v := r.AgeStringElement.DeepCopy()
+		out.AgeStringElement = &v
+	}
+	if r.EstimatedAge != nil {
+		v := *r.EstimatedAge
+		out.EstimatedAge = &v
+	}
+	if r.EstimatedAgeElement != nil {
+		v := r.EstimatedAgeElement.DeepCopy()
+		out.EstimatedAgeElement = &v
+	}
+	if r.DeceasedBoolean != nil {
+		v := *r.DeceasedBoolean
+		out.DeceasedBoolean = &v
+	}
+	if r.DeceasedBooleanElement != nil {
+		v := r.DeceasedBooleanElement.DeepCopy()
+		out.DeceasedBooleanElement = &v
+	}
+	if r.DeceasedAge != nil {
+		v := r.DeceasedAge.DeepCopy()
+		out.DeceasedAge = &v
+	}
+	if r.DeceasedRange != nil {
+		v := r.DeceasedRange.DeepCopy()
+		out.DeceasedRange = &v
+	}
+	if r.DeceasedDate != nil {
+		v := *r.DeceasedDate
+		out.DeceasedDate = &v
+	}
+	if r.DeceasedDateElement != nil {
+		v := r.DeceasedDateElement.DeepCopy()
+		out.DeceasedDateElement = &v
+	}
+	if r.DeceasedString != nil {
+		v := *r.DeceasedString
+		out.DeceasedString = &v
+	}
+	if r.DeceasedStringElement != nil {
+		v := r.DeceasedStringElement.DeepCopy()
+		out.DeceasedStringElement = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.Condition != nil {
+		out.Condition = make([]FamilyMemberHistoryCondition, len(r.Condition))
+		for i := range r.Condition {
+			out.Condition[i] = r.Condition[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r FamilyMemberHistory) Equal(other FamilyMemberHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.DataAbsentReason == nil) != (other.DataAbsentReason == nil) || r.DataAbsentReason != nil && !r.DataAbsentReason.Equal(*other.DataAbsentReason) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if !r.Relationship.Equal(other.Relationship) {
+		return false
+	}
+	if (r.Sex == nil) != (other.Sex == nil) || r.Sex != nil && !r.Sex.Equal(*other.Sex) {
+		return false
+	}
+	if (r.BornPeriod == nil) != (other.BornPeriod == nil) || r.BornPeriod != nil && !r.BornPeriod.Equal(*other.BornPeriod) {
+		return false
+	}
+	if (r.BornDate == nil) != (other.BornDate == nil) || r.BornDate != nil && *r.BornDate != *other.BornDate {
+		return false
+	}
+	if (r.BornDateElement == nil) != (other.BornDateElement == nil) || r.BornDateElement != nil && !r.BornDateElement.Equal(*other.BornDateElement) {
+		return false
+	}
+	if (r.BornString == nil) != (other.BornString == nil) || r.BornString != nil && *r.BornString != *other.BornString {
+		return false
+	}
+	if (r.BornStringElement == nil) != (other.BornStringElement == nil) || r.BornStringElement != nil && !r.BornStringElement.Equal(*other.BornStringElement) {
+		return false
+	}
+	if (r.AgeAge == nil) != (other.AgeAge == nil) || r.AgeAge != nil && !r.AgeAge.Equal(*other.AgeAge) {
+		return false
+	}
+	if (r.AgeRange == nil) != (other.AgeRange == nil) || r.AgeRange != nil && !r.AgeRange.Equal(*other.AgeRange) {
+		return false
+	}
+	if (r.AgeString == nil) != (other.AgeString == nil) || r.AgeString != nil && *r.AgeString != *other.AgeString {
+		return false
+	}
+	if (r.AgeStringElement == nil) != (other.AgeStringElement == nil) || r.AgeStringElement != nil && !r.AgeStringElement.Equal(*other.AgeStringElement) {
+		return false
+	}
+	if (r.EstimatedAge == nil) != (other.EstimatedAge == nil) || r.EstimatedAge != nil && *r.EstimatedAge != *other.EstimatedAge {
+		return false
+	}
+	if (r.EstimatedAgeElement == nil) != (other.EstimatedAgeElement == nil) || r.EstimatedAgeElement != nil && !r.EstimatedAgeElement.Equal(*other.EstimatedAgeElement) {
+		return false
+	}
+	if (r.DeceasedBoolean == nil) != (other.DeceasedBoolean == nil) || r.DeceasedBoolean != nil && *r.DeceasedBoolean != *other.DeceasedBoolean {
+		return false
+	}
+	if (r.DeceasedBooleanElement == nil) != (other.DeceasedBooleanElement == nil) || r.DeceasedBooleanElement != nil && !r.DeceasedBooleanElement.Equal(*other.DeceasedBooleanElement) {
+		return false
+	}
+	if (r.DeceasedAge == nil) != (other.DeceasedAge == nil) || r.DeceasedAge != nil && !r.DeceasedAge.Equal(*other.DeceasedAge) {
+		return false
+	}
+	if (r.DeceasedRange == nil) != (other.DeceasedRange == nil) || r.DeceasedRange != nil && !r.DeceasedRange.Equal(*other.DeceasedRange) {
+		return false
+	}
+	if (r.DeceasedDate == nil) != (other.DeceasedDate == nil) || r.DeceasedDate != nil && *r.DeceasedDate != *other.DeceasedDate {
+		return false
+	}
+	if (r.DeceasedDateElement == nil) != (other.DeceasedDateElement == nil) || r.DeceasedDateElement != nil && !r.DeceasedDateElement.Equal(*other.DeceasedDateElement) {
+		return false
+	}
+	if (r.DeceasedString == nil) != (other.DeceasedString == nil) || r.DeceasedString != nil && *r.DeceasedString != *other.DeceasedString {
+		return false
+	}
+	if (r.DeceasedStringElement == nil) != (other.DeceasedStringElement == nil) || r.DeceasedStringElement != nil && !r.DeceasedStringElement.Equal(*other.DeceasedStringElement) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.Condition) != len(other.Condition) {
+		return false
+	}
+	for i := range r.Condition {
+		if !r.Condition[i].Equal(other.Condition[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r FamilyMemberHistory) EqualsDeep(other FamilyMemberHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.DataAbsentReason == nil) != (other.DataAbsentReason == nil) || r.DataAbsentReason != nil && !r.DataAbsentReason.EqualsDeep(*other.DataAbsentReason) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if !r.Relationship.EqualsDeep(other.Relationship) {
+		return false
+	}
+	if (r.Sex == nil) != (other.Sex == nil) || r.Sex != nil && !r.Sex.EqualsDeep(*other.Sex) {
+		return false
+	}
+	if (r.BornPeriod == nil) != (other.BornPeriod == nil) || r.BornPeriod != nil && !r.BornPeriod.EqualsDeep(*other.BornPeriod) {
+		return false
+	}
+	if (r.BornDate == nil) != (other.BornDate == nil) || r.BornDate != nil && !equivalentString(*r.BornDate, *other.BornDate) {
+		return false
+	}
+	if (r.BornDateElement == nil) != (other.BornDateElement == nil) || r.BornDateElement != nil && !r.BornDateElement.EqualsDeep(*other.BornDateElement) {
+		return false
+	}
+	if (r.BornString == nil) != (other.BornString == nil) || r.BornString != nil && !equivalentString(*r.BornString, *other.BornString) {
+		return false
+	}
+	if (r.BornStringElement == nil) != (other.BornStringElement == nil) || r.BornStringElement != nil && !r.BornStringElement.EqualsDeep(*other.BornStringElement) {
+		return false
+	}
+	if (r.AgeAge == nil) != (other.AgeAge == nil) || r.AgeAge != nil && !r.AgeAge.EqualsDeep(*other.AgeAge) {
+		return false
+	}
+	if (r.AgeRange == nil) != (other.AgeRange == nil) || r.AgeRange != nil && !r.AgeRange.EqualsDeep(*other.AgeRange) {
+		return false
+	}
+	if (r.AgeString == nil) != (other.AgeString == nil) || r.AgeString != nil && !equivalentString(*r.AgeString, *other.AgeString) {
+		return false
+	}
+	if (r.AgeStringElement == nil) != (other.AgeStringElement == nil) || r.AgeStringElement != nil && !r.AgeStringElement.EqualsDeep(*other.AgeStringElement) {
+		return false
+	}
+	if (r.EstimatedAge == nil) != (other.EstimatedAge == nil) || r.EstimatedAge != nil && *r.EstimatedAge != *other.EstimatedAge {
+		return false
+	}
+	if (r.EstimatedAgeElement == nil) != (other.EstimatedAgeElement == nil) || r.EstimatedAgeElement != nil && !r.EstimatedAgeElement.EqualsDeep(*other.EstimatedAgeElement) {
+		return false
+	}
+	if (r.DeceasedBoolean == nil) != (other.DeceasedBoolean == nil) || r.DeceasedBoolean != nil && *r.DeceasedBoolean != *other.DeceasedBoolean {
+		return false
+	}
+	if (r.DeceasedBooleanElement == nil) != (other.DeceasedBooleanElement == nil) || r.DeceasedBooleanElement != nil && !r.DeceasedBooleanElement.EqualsDeep(*other.DeceasedBooleanElement) {
+		return false
+	}
+	if (r.DeceasedAge == nil) != (other.DeceasedAge == nil) || r.DeceasedAge != nil && !r.DeceasedAge.EqualsDeep(*other.DeceasedAge) {
+		return false
+	}
+	if (r.DeceasedRange == nil) != (other.DeceasedRange == nil) || r.DeceasedRange != nil && !r.DeceasedRange.EqualsDeep(*other.DeceasedRange) {
+		return false
+	}
+	if (r.DeceasedDate == nil) != (other.DeceasedDate == nil) || r.DeceasedDate != nil && !equivalentString(*r.DeceasedDate, *other.DeceasedDate) {
+		return false
+	}
+	if (r.DeceasedDateElement == nil) != (other.DeceasedDateElement == nil) || r.DeceasedDateElement != nil && !r.DeceasedDateElement.EqualsDeep(*other.DeceasedDateElement) {
+		return false
+	}
+	if (r.DeceasedString == nil) != (other.DeceasedString == nil) || r.DeceasedString != nil && !equivalentString(*r.DeceasedString, *other.DeceasedString) {
+		return false
+	}
+	if (r.DeceasedStringElement == nil) != (other.DeceasedStringElement == nil) || r.DeceasedStringElement != nil && !r.DeceasedStringElement.EqualsDeep(*other.DeceasedStringElement) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Condition), len(other.Condition), func(i, j int) bool {
+		return r.Condition[i].EqualsDeep(other.Condition[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r FamilyMemberHistory) EqualsShallow(other FamilyMemberHistory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.BornDate == nil) != (other.BornDate == nil) || r.BornDate != nil && !equivalentString(*r.BornDate, *other.BornDate) {
+		return false
+	}
+	if (r.BornString == nil) != (other.BornString == nil) || r.BornString != nil && !equivalentString(*r.BornString, *other.BornString) {
+		return false
+	}
+	if (r.AgeString == nil) != (other.AgeString == nil) || r.AgeString != nil && !equivalentString(*r.AgeString, *other.AgeString) {
+		return false
+	}
+	if (r.EstimatedAge == nil) != (other.EstimatedAge == nil) || r.EstimatedAge != nil && *r.EstimatedAge != *other.EstimatedAge {
+		return false
+	}
+	if (r.DeceasedBoolean == nil) != (other.DeceasedBoolean == nil) || r.DeceasedBoolean != nil && *r.DeceasedBoolean != *other.DeceasedBoolean {
+		return false
+	}
+	if (r.DeceasedDate == nil) != (other.DeceasedDate == nil) || r.DeceasedDate != nil && !equivalentString(*r.DeceasedDate, *other.DeceasedDate) {
+		return false
+	}
+	if (r.DeceasedString == nil) != (other.DeceasedString == nil) || r.DeceasedString != nil && !equivalentString(*r.DeceasedString, *other.DeceasedString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given FamilyMemberHistory as FHIR XML
+func (r FamilyMemberHistory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "FamilyMemberHistory",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dataAbsentReason", r.DataAbsentReason); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "relationship", r.Relationship); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sex", r.Sex); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "bornPeriod", r.BornPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "bornDate", r.BornDate, r.BornDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "bornString", r.BornString, r.BornStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "ageAge", r.AgeAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "ageRange", r.AgeRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "ageString", r.AgeString, r.AgeStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "estimatedAge", r.EstimatedAge, r.EstimatedAgeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "deceasedBoolean", r.DeceasedBoolean, r.DeceasedBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "deceasedAge", r.DeceasedAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "deceasedRange", r.DeceasedRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "deceasedDate", r.DeceasedDate, r.DeceasedDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "deceasedString", r.DeceasedString, r.DeceasedStringElement); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Condition {
+		if err := encodeXMLElement(e, "condition", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given FamilyMemberHistory from FHIR XML
+func (r *FamilyMemberHistory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "status":
+				var v FamilyHistoryStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "dataAbsentReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DataAbsentReason = &v
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "relationship":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Relationship = v
+			case "sex":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Sex = &v
+			case "bornPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BornPeriod = &v
+			case "bornDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BornDate = &v
+				}
+				r.BornDateElement = element
+			case "bornString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BornString = &v
+				}
+				r.BornStringElement = element
+			case "ageAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AgeAge = &v
+			case "ageRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AgeRange = &v
+			case "ageString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AgeString = &v
+				}
+				r.AgeStringElement = element
+			case "estimatedAge":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EstimatedAge = &v
+				}
+				r.EstimatedAgeElement = element
+			case "deceasedBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DeceasedBoolean = &v
+				}
+				r.DeceasedBooleanElement = element
+			case "deceasedAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DeceasedAge = &v
+			case "deceasedRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DeceasedRange = &v
+			case "deceasedDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DeceasedDate = &v
+				}
+				r.DeceasedDateElement = element
+			case "deceasedString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DeceasedString = &v
+				}
+				r.DeceasedStringElement = element
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "condition":
+				var v FamilyMemberHistoryCondition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Condition = append(r.Condition, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the FamilyMemberHistory as properties to the RDF node n
+func (r FamilyMemberHistory) turtle(n *turtleNode) {
+	n.resource("FamilyMemberHistory", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("FamilyMemberHistory.identifier", i, v)
+	}
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("FamilyMemberHistory.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("FamilyMemberHistory.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	n.primitive("FamilyMemberHistory.status", -1, r.Status, r.StatusElement, "code")
+	n.element("FamilyMemberHistory.dataAbsentReason", -1, r.DataAbsentReason)
+	n.element("FamilyMemberHistory.patient", -1, r.Patient)
+	n.primitive("FamilyMemberHistory.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("FamilyMemberHistory.name", -1, r.Name, r.NameElement, "string")
+	n.element("FamilyMemberHistory.relationship", -1, r.Relationship)
+	n.element("FamilyMemberHistory.sex", -1, r.Sex)
+	n.element("FamilyMemberHistory.bornPeriod", -1, r.BornPeriod)
+	n.primitive("FamilyMemberHistory.bornDate", -1, r.BornDate, r.BornDateElement, "date")
+	n.primitive("FamilyMemberHistory.bornString", -1, r.BornString, r.BornStringElement, "string")
+	n.element("FamilyMemberHistory.ageAge", -1, r.AgeAge)
+	n.element("FamilyMemberHistory.ageRange", -1, r.AgeRange)
+	n.primitive("FamilyMemberHistory.ageString", -1, r.AgeString, r.AgeStringElement, "string")
+	n.primitive("FamilyMemberHistory.estimatedAge", -1, r.EstimatedAge, r.EstimatedAgeElement, "boolean")
+	n.primitive("FamilyMemberHistory.deceasedBoolean", -1, r.DeceasedBoolean, r.DeceasedBooleanElement, "boolean")
+	n.element("FamilyMemberHistory.deceasedAge", -1, r.DeceasedAge)
+	n.element("FamilyMemberHistory.deceasedRange", -1, r.DeceasedRange)
+	n.primitive("FamilyMemberHistory.deceasedDate", -1, r.DeceasedDate, r.DeceasedDateElement, "date")
+	n.primitive("FamilyMemberHistory.deceasedString", -1, r.DeceasedString, r.DeceasedStringElement, "string")
+	for i, v := range r.ReasonCode {
+		n.element("FamilyMemberHistory.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("FamilyMemberHistory.reasonReference", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("FamilyMemberHistory.note", i, v)
+	}
+	for i, v := range r.Condition {
+		n.element("FamilyMemberHistory.condition", i, v)
+	}
+}
+
+// MarshalBSON marshals the given FamilyMemberHistory as BSON document with the structure of its FHIR JSON
+func (r FamilyMemberHistory) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given FamilyMemberHistory from a BSON document with the structure of its FHIR JSON
+func (r *FamilyMemberHistory) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given FamilyMemberHistory as protobuf message FamilyMemberHistory of fhir.proto
+func (r FamilyMemberHistory) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the FamilyMemberHistory to the encoded message
+func (r FamilyMemberHistory) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(10, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(11, v)
+	}
+	e.varint(12, r.Status.protoNumber())
+	if r.DataAbsentReason != nil {
+		e.message(13, *r.DataAbsentReason)
+	}
+	e.message(14, r.Patient)
+	if r.Date != nil {
+		e.string(15, *r.Date)
+	}
+	if r.Name != nil {
+		e.string(16, *r.Name)
+	}
+	e.message(17, r.Relationship)
+	if r.Sex != nil {
+		e.message(18, *r.Sex)
+	}
+	if r.BornPeriod != nil {
+		e.message(19, *r.BornPeriod)
+	}
+	if r.BornDate != nil {
+		e.string(20, *r.BornDate)
+	}
+	if r.BornString != nil {
+		e.string(21, *r.BornString)
+	}
+	if r.AgeAge != nil {
+		e.message(22, *r.AgeAge)
+	}
+	if r.AgeRange != nil {
+		e.message(23, *r.AgeRange)
+	}
+	if r.AgeString != nil {
+		e.string(24, *r.AgeString)
+	}
+	if r.EstimatedAge != nil {
+		e.varint(25, protoBool(*r.EstimatedAge))
+	}
+	if r.DeceasedBoolean != nil {
+		e.varint(26, protoBool(*r.DeceasedBoolean))
+	}
+	if r.DeceasedAge != nil {
+		e.message(27, *r.DeceasedAge)
+	}
+	if r.DeceasedRange != nil {
+		e.message(28, *r.DeceasedRange)
+	}
+	if r.DeceasedDate != nil {
+		e.string(29, *r.DeceasedDate)
+	}
+	if r.DeceasedString != nil {
+		e.string(30, *r.DeceasedString)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(31, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(32, v)
+	}
+	for _, v := range r.Note {
+		e.message(33, v)
+	}
+	for _, v := range r.Condition {
+		e.message(34, v)
+	}
+	if r.IdElement != nil {
+		e.message(35, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(36, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(37, *r.LanguageElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(38, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(39, v)
+	}
+	if r.StatusElement != nil {
+		e.message(40, *r.StatusElement)
+	}
+	if r.DateElement != nil {
+		e.message(41, *r.DateElement)
+	}
+	if r.NameElement != nil {
+		e.message(42, *r.NameElement)
+	}
+	if r.BornDateElement != nil {
+		e.message(43, *r.BornDateElement)
+	}
+	if r.BornStringElement != nil {
+		e.message(44, *r.BornStringElement)
+	}
+	if r.AgeStringElement != nil {
+		e.message(45, *r.AgeStringElement)
+	}
+	if r.EstimatedAgeElement != nil {
+		e.message(46, *r.EstimatedAgeElement)
+	}
+	if r.DeceasedBooleanElement != nil {
+		e.message(47, *r.DeceasedBooleanElement)
+	}
+	if r.DeceasedDateElement != nil {
+		e.message(48, *r.DeceasedDateElement)
+	}
+	if r.DeceasedStringElement != nil {
+		e.message(49, *r.DeceasedStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given FamilyMemberHistory from protobuf message FamilyMemberHistory of fhir.proto
+func (r *FamilyMemberHistory) UnmarshalProto(b []byte) error {
+	*r = FamilyMemberHistory{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 11:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 12:
+			var v FamilyHistoryStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.DataAbsentReason = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 15:
+			v := d.string()
+			r.Date = &v
+		case 16:
+			v := d.string()
+			r.Name = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.Relationship = v
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.Sex = &v
+		case 19:
+			var v Period
+			d.message(&v)
+			r.BornPeriod = &v
+		case 20:
+			v := d.string()
+			r.BornDate = &v
+		case 21:
+			v := d.string()
+			r.BornString = &v
+		case 22:
+			var v Age
+			d.message(&v)
+			r.AgeAge = &v
+		case 23:
+			var v Range
+			d.message(&v)
+			r.AgeRange = &v
+		case 24:
+			v := d.string()
+			r.AgeString = &v
+		case 25:
+			v := d.bool()
+			r.EstimatedAge = &v
+		case 26:
+			v := d.bool()
+			r.DeceasedBoolean = &v
+		case 27:
+			var v Age
+			d.message(&v)
+			r.DeceasedAge = &v
+		case 28:
+			var v Range
+			d.message(&v)
+			r.DeceasedRange = &v
+		case 29:
+			v := d.string()
+			r.DeceasedDate = &v
+		case 30:
+			v := d.string()
+			r.DeceasedString = &v
+		case 31:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 32:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 33:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 34:
+			var v FamilyMemberHistoryCondition
+			d.message(&v)
+			r.Condition = append(r.Condition, v)
+		case 35:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 38:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 39:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		case 40:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.BornDateElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.BornStringElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.AgeStringElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.EstimatedAgeElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.DeceasedBooleanElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.DeceasedDateElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.DeceasedStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given FamilyMemberHistoryCondition as JSON into a byte slice
+func (r FamilyMemberHistoryCondition) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the FamilyMemberHistoryCondition as JSON object with the members in the order of the fields
+func (r FamilyMemberHistoryCondition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if r.Outcome != nil {
+		e.key("outcome")
+		r.Outcome.appendJSON(e)
+	}
+	if r.ContributedToDeath != nil {
+		e.key("contributedToDeath")
+		e.bool(*r.ContributedToDeath)
+	}
+	if r.ContributedToDeathElement != nil {
+		e.key("_contributedToDeath")
+		r.ContributedToDeathElement.appendJSON(e)
+	}
+	if r.OnsetAge != nil {
+		e.key("onsetAge")
+		r.OnsetAge.appendJSON(e)
+	}
+	if r.OnsetRange != nil {
+		e.key("onsetRange")
+		r.OnsetRange.appendJSON(e)
+	}
+	if r.OnsetPeriod != nil {
+		e.key("onsetPeriod")
+		r.OnsetPeriod.appendJSON(e)
+	}
+	if r.OnsetString != nil {
+		e.key("onsetString")
+		e.string(*r.OnsetString)
+	}
+	if r.OnsetStringElement != nil {
+		e.key("_onsetString")
+		r.OnsetStringElement.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given FamilyMemberHistoryCondition from JSON. Unknown members are ignored.
+func (r *FamilyMemberHistoryCondition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the FamilyMemberHistoryCondition
+func (r *FamilyMemberHistoryCondition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "outcome":
+			if d.null() {
+				r.Outcome = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Outcome = &v
+			}
+		case "contributedToDeath":
+			if v, ok := d.bool(); ok {
+				r.ContributedToDeath = &v
+			} else {
+				r.ContributedToDeath = nil
+			}
+		case "_contributedToDeath":
+			r.ContributedToDeathElement = d.element()
+		case "onsetAge":
+			if d.null() {
+				r.OnsetAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.OnsetAge = &v
+			}
+		case "onsetRange":
+			if d.null() {
+				r.OnsetRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.OnsetRange = &v
+			}
+		case "onsetPeriod":
+			if d.null() {
+				r.OnsetPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OnsetPeriod = &v
+			}
+		case "onsetString":
+			if v, ok := d.string(); ok {
+				r.OnsetString = &v
+			} else {
+				r.OnsetString = nil
+			}
+		case "_onsetString":
+			r.OnsetStringElement = d.element()
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the FamilyMemberHistoryCondition which shares no memory with the original
+func (r FamilyMemberHistoryCondition) DeepCopy() FamilyMemberHistoryCondition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.Outcome != nil {
+		v := r.Outcome.DeepCopy()
+		out.Outcome = &v
+	}
+	if r.ContributedToDeath != nil {
+		v := *r.ContributedToDeath
+		out.ContributedToDeath = &v
+	}
+	if r.ContributedToDeathElement != nil {
+		v := r.ContributedToDeathElement.DeepCopy()
+		out.ContributedToDeathElement = &v
+	}
+	if r.OnsetAge != nil {
+		v := r.OnsetAge.DeepCopy()
+		out.OnsetAge = &v
+	}
+	if r.OnsetRange != nil {
+		v := r.OnsetRange.DeepCopy()
+		out.OnsetRange = &v
+	}
+	if r.OnsetPeriod != nil {
+		v := r.OnsetPeriod.DeepCopy()
+		out.OnsetPeriod = &v
+	}
+	if r.OnsetString != nil {
+		v := *r.OnsetString
+		out.OnsetString = &v
+	}
+	if r.OnsetStringElement != nil {
+		v := r.OnsetStringElement.DeepCopy()
+		out.OnsetStringElement = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r FamilyMemberHistoryCondition) Equal(other FamilyMemberHistoryCondition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && !r.Outcome.Equal(*other.Outcome) {
+		return false
+	}
+	if (r.ContributedToDeath == nil) != (other.ContributedToDeath == nil) || r.ContributedToDeath != nil && *r.ContributedToDeath != *other.ContributedToDeath {
+		return false
+	}
+	if (r.ContributedToDeathElement == nil) != (other.ContributedToDeathElement == nil) || r.ContributedToDeathElement != nil && !r.ContributedToDeathElement.Equal(*other.ContributedToDeathElement) {
+		return false
+	}
+	if (r.OnsetAge == nil) != (other.OnsetAge == nil) || r.OnsetAge != nil && !r.OnsetAge.Equal(*other.OnsetAge) {
+		return false
+	}
+	if (r.OnsetRange == nil) != (other.OnsetRange == nil) || r.OnsetRange != nil && !r.OnsetRange.Equal(*other.OnsetRange) {
+		return false
+	}
+	if (r.OnsetPeriod == nil) != (other.OnsetPeriod == nil) || r.OnsetPeriod != nil && !r.OnsetPeriod.Equal(*other.OnsetPeriod) {
+		return false
+	}
+	if (r.OnsetString == nil) != (other.OnsetString == nil) || r.OnsetString != nil && *r.OnsetString != *other.OnsetString {
+		return false
+	}
+	if (r.OnsetStringElement == nil) != (other.OnsetStringElement == nil) || r.OnsetStringElement != nil && !r.OnsetStringElement.Equal(*other.OnsetStringElement) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r FamilyMemberHistoryCondition) EqualsDeep(other FamilyMemberHistoryCondition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && !r.Outcome.EqualsDeep(*other.Outcome) {
+		return false
+	}
+	if (r.ContributedToDeath == nil) != (other.ContributedToDeath == nil) || r.ContributedToDeath != nil && *r.ContributedToDeath != *other.ContributedToDeath {
+		return false
+	}
+	if (r.ContributedToDeathElement == nil) != (other.ContributedToDeathElement == nil) || r.ContributedToDeathElement != nil && !r.ContributedToDeathElement.EqualsDeep(*other.ContributedToDeathElement) {
+		return false
+	}
+	if (r.OnsetAge == nil) != (other.OnsetAge == nil) || r.OnsetAge != nil && !r.OnsetAge.EqualsDeep(*other.OnsetAge) {
+		return false
+	}
+	if (r.OnsetRange == nil) != (other.OnsetRange == nil) || r.OnsetRange != nil && !r.OnsetRange.EqualsDeep(*other.OnsetRange) {
+		return false
+	}
+	if (r.OnsetPeriod == nil) != (other.OnsetPeriod == nil) || r.OnsetPeriod != nil && !r.OnsetPeriod.EqualsDeep(*other.OnsetPeriod) {
+		return false
+	}
+	if (r.OnsetString == nil) != (other.OnsetString == nil) || r.OnsetString != nil && !equivalentString(*r.OnsetString, *other.OnsetString) {
+		return false
+	}
+	if (r.OnsetStringElement == nil) != (other.OnsetStringElement == nil) || r.OnsetStringElement != nil && !r.OnsetStringElement.EqualsDeep(*other.OnsetStringElement) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r FamilyMemberHistoryCondition) EqualsShallow(other FamilyMemberHistoryCondition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ContributedToDeath == nil) != (other.ContributedToDeath == nil) || r.ContributedToDeath != nil && *r.ContributedToDeath != *other.ContributedToDeath {
+		return false
+	}
+	if (r.OnsetString == nil) != (other.OnsetString == nil) || r.OnsetString != nil && !equivalentString(*r.OnsetString, *other.OnsetString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given FamilyMemberHistoryCondition as FHIR XML
+func (r FamilyMemberHistoryCondition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "outcome", r.Outcome); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "contributedToDeath", r.ContributedToDeath, r.ContributedToDeathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onsetAge", r.OnsetAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onsetRange", r.OnsetRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onsetPeriod", r.OnsetPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "onsetString", r.OnsetString, r.OnsetStringElement); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given FamilyMemberHistoryCondition from FHIR XML
+func (r *FamilyMemberHistoryCondition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "outcome":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Outcome = &v
+			case "contributedToDeath":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ContributedToDeath = &v
+				}
+				r.ContributedToDeathElement = element
+			case "onsetAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnsetAge = &v
+			case "onsetRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnsetRange = &v
+			case "onsetPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnsetPeriod = &v
+			case "onsetString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OnsetString = &v
+				}
+				r.OnsetStringElement = element
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the FamilyMemberHistoryCondition as properties to the RDF node n
+func (r FamilyMemberHistoryCondition) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("FamilyMemberHistory.condition.code", -1, r.Code)
+	n.element("FamilyMemberHistory.condition.outcome", -1, r.Outcome)
+	n.primitive("FamilyMemberHistory.condition.contributedToDeath", -1, r.ContributedToDeath, r.ContributedToDeathElement, "boolean")
+	n.element("FamilyMemberHistory.condition.onsetAge", -1, r.OnsetAge)
+	n.element("FamilyMemberHistory.condition.onsetRange", -1, r.OnsetRange)
+	n.element("FamilyMemberHistory.condition.onsetPeriod", -1, r.OnsetPeriod)
+	n.primitive("FamilyMemberHistory.condition.onsetString", -1, r.OnsetString, r.OnsetStringElement, "string")
+	for i, v := range r.Note {
+		n.element("FamilyMemberHistory.condition.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given FamilyMemberHistoryCondition as BSON document with the structure of its FHIR JSON
+func (r FamilyMemberHistoryCondition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given FamilyMemberHistoryCondition from a BSON document with the structure of its FHIR JSON
+func (r *FamilyMemberHistoryCondition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given FamilyMemberHistoryCondition as protobuf message FamilyMemberHistoryCondition of fhir.proto
+func (r FamilyMemberHistoryCondition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the FamilyMemberHistoryCondition to the encoded message
+func (r FamilyMemberHistoryCondition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	if r.Outcome != nil {
+		e.message(5, *r.Outcome)
+	}
+	if r.ContributedToDeath != nil {
+		e.varint(6, protoBool(*r.ContributedToDeath))
+	}
+	if r.OnsetAge != nil {
+		e.message(7, *r.OnsetAge)
+	}
+	if r.OnsetRange != nil {
+		e.message(8, *r.OnsetRange)
+	}
+	if r.OnsetPeriod != nil {
+		e.message(9, *r.OnsetPeriod)
+	}
+	if r.OnsetString != nil {
+		e.string(10, *r.OnsetString)
+	}
+	for _, v := range r.Note {
+		e.message(11, v)
+	}
+	if r.ContributedToDeathElement != nil {
+		e.message(12, *r.ContributedToDeathElement)
+	}
+	if r.OnsetStringElement != nil {
+		e.message(13, *r.OnsetStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given FamilyMemberHistoryCondition from protobuf message FamilyMemberHistoryCondition of fhir.proto
+func (r *FamilyMemberHistoryCondition) UnmarshalProto(b []byte) error {
+	*r = FamilyMemberHistoryCondition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Outcome = &v
+		case 6:
+			v := d.bool()
+			r.ContributedToDeath = &v
+		case 7:
+			var v Age
+			d.message(&v)
+			r.OnsetAge = &v
+		case 8:
+			var v Range
+			d.message(&v)
+			r.OnsetRange = &v
+		case 9:
+			var v Period
+			d.message(&v)
+			r.OnsetPeriod = &v
+		case 10:
+			v := d.string()
+			r.OnsetString = &v
+		case 11:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ContributedToDeathElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.OnsetStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalFamilyMemberHistory unmarshals a FamilyMemberHistory.
 func UnmarshalFamilyMemberHistory(b []byte) (FamilyMemberHistory, error) {
 	var familyMemberHistory FamilyMemberHistory
-	if err := json.Unmarshal(b, &familyMemberHistory); err != nil {
+	if err := familyMemberHistory.UnmarshalJSON(b); err != nil {
 		return familyMemberHistory, err
 	}
 	return familyMemberHistory, nil
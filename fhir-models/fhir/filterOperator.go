@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code FilterOperator) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *FilterOperator) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *FilterOperator) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "=":
 		*code = FilterOperatorEquals
@@ -67,6 +71,12 @@ func (code *FilterOperator) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code FilterOperator) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *FilterOperator) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code FilterOperator) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code FilterOperator) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code FilterOperator) protoNumber() uint64 {
+	switch code {
+	case FilterOperatorEquals:
+		return 1
+	case FilterOperatorIsA:
+		return 2
+	case FilterOperatorDescendentOf:
+		return 3
+	case FilterOperatorIsNotA:
+		return 4
+	case FilterOperatorRegex:
+		return 5
+	case FilterOperatorIn:
+		return 6
+	case FilterOperatorNotIn:
+		return 7
+	case FilterOperatorGeneralizes:
+		return 8
+	case FilterOperatorExists:
+		return 9
+	}
+	return 0
+}
+func (code *FilterOperator) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = FilterOperatorEquals
+	case 2:
+		*code = FilterOperatorIsA
+	case 3:
+		*code = FilterOperatorDescendentOf
+	case 4:
+		*code = FilterOperatorIsNotA
+	case 5:
+		*code = FilterOperatorRegex
+	case 6:
+		*code = FilterOperatorIn
+	case 7:
+		*code = FilterOperatorNotIn
+	case 8:
+		*code = FilterOperatorGeneralizes
+	case 9:
+		*code = FilterOperatorExists
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code FinancialResourceStatusCodes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *FinancialResourceStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *FinancialResourceStatusCodes) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = FinancialResourceStatusCodesActive
@@ -52,6 +56,12 @@ func (code *FinancialResourceStatusCodes) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code FinancialResourceStatusCodes) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *FinancialResourceStatusCodes) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code FinancialResourceStatusCodes) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code FinancialResourceStatusCodes) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code FinancialResourceStatusCodes) protoNumber() uint64 {
+	switch code {
+	case FinancialResourceStatusCodesActive:
+		return 1
+	case FinancialResourceStatusCodesCancelled:
+		return 2
+	case FinancialResourceStatusCodesDraft:
+		return 3
+	case FinancialResourceStatusCodesEnteredInError:
+		return 4
+	}
+	return 0
+}
+func (code *FinancialResourceStatusCodes) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = FinancialResourceStatusCodesActive
+	case 2:
+		*code = FinancialResourceStatusCodesCancelled
+	case 3:
+		*code = FinancialResourceStatusCodesDraft
+	case 4:
+		*code = FinancialResourceStatusCodesEnteredInError
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code FlagStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *FlagStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *FlagStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = FlagStatusActive
@@ -49,6 +53,12 @@ func (code *FlagStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code FlagStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *FlagStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code FlagStatus) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code FlagStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code FlagStatus) protoNumber() uint64 {
+	switch code {
+	case FlagStatusActive:
+		return 1
+	case FlagStatusInactive:
+		return 2
+	case FlagStatusEnteredInError:
+		return 3
+	}
+	return 0
+}
+func (code *FlagStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = FlagStatusActive
+	case 2:
+		*code = FlagStatusInactive
+	case 3:
+		*code = FlagStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
@@ -14,38 +14,86 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Goal is documented here http://hl7.org/fhir/StructureDefinition/Goal
 type Goal struct {
-	Id                   *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier           []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	LifecycleStatus      GoalLifecycleStatus `bson:"lifecycleStatus" json:"lifecycleStatus"`
-	AchievementStatus    *CodeableConcept    `bson:"achievementStatus,omitempty" json:"achievementStatus,omitempty"`
-	Category             []CodeableConcept   `bson:"category,omitempty" json:"category,omitempty"`
-	Priority             *CodeableConcept    `bson:"priority,omitempty" json:"priority,omitempty"`
-	Description          CodeableConcept     `bson:"description" json:"description"`
-	Subject              Reference           `bson:"subject" json:"subject"`
-	StartDate            *string             `bson:"startDate,omitempty" json:"startDate,omitempty"`
-	StartCodeableConcept *CodeableConcept    `bson:"startCodeableConcept,omitempty" json:"startCodeableConcept,omitempty"`
-	Target               []GoalTarget        `bson:"target,omitempty" json:"target,omitempty"`
-	StatusDate           *string             `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
-	StatusReason         *string             `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	ExpressedBy          *Reference          `bson:"expressedBy,omitempty" json:"expressedBy,omitempty"`
-	Addresses            []Reference         `bson:"addresses,omitempty" json:"addresses,omitempty"`
-	Note                 []Annotation        `bson:"note,omitempty" json:"note,omitempty"`
-	OutcomeCode          []CodeableConcept   `bson:"outcomeCode,omitempty" json:"outcomeCode,omitempty"`
-	OutcomeReference     []Reference         `bson:"outcomeReference,omitempty" json:"outcomeReference,omitempty"`
+	Id                     *string             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element            `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                   *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage   `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	LifecycleStatus        GoalLifecycleStatus `bson:"lifecycleStatus" json:"lifecycleStatus"`
+	LifecycleStatusElement *Element            `bson:"_lifecycleStatus,omitempty" json:"_lifecycleStatus,omitempty"`
+	AchievementStatus      *CodeableConcept    `bson:"achievementStatus,omitempty" json:"achievementStatus,omitempty"`
+	Category               []CodeableConcept   `bson:"category,omitempty" json:"category,omitempty"`
+	Priority               *CodeableConcept    `bson:"priority,omitempty" json:"priority,omitempty"`
+	Description            CodeableConcept     `bson:"description" json:"description"`
+	Subject                Reference           `bson:"subject" json:"subject"`
+	StartDate              *string             `bson:"startDate,omitempty" json:"startDate,omitempty"`
+	StartDateElement       *Element            `bson:"_startDate,omitempty" json:"_startDate,omitempty"`
+	StartCodeableConcept   *CodeableConcept    `bson:"startCodeableConcept,omitempty" json:"startCodeableConcept,omitempty"`
+	Target                 []GoalTarget        `bson:"target,omitempty" json:"target,omitempty"`
+	StatusDate             *string             `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
+	StatusDateElement      *Element            `bson:"_statusDate,omitempty" json:"_statusDate,omitempty"`
+	StatusReason           *string             `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	StatusReasonElement    *Element            `bson:"_statusReason,omitempty" json:"_statusReason,omitempty"`
+	ExpressedBy            *Reference          `bson:"expressedBy,omitempty" json:"expressedBy,omitempty"`
+	Addresses              []Reference         `bson:"addresses,omitempty" json:"addresses,omitempty"`
+	Note                   []Annotation        `bson:"note,omitempty" json:"note,omitempty"`
+	OutcomeCode            []CodeableConcept   `bson:"outcomeCode,omitempty" json:"outcomeCode,omitempty"`
+	OutcomeReference       []Reference         `bson:"outcomeReference,omitempty" json:"outcomeReference,omitempty"`
 }
+
+// GoalStart is implemented by the types allowed for Goal.start[x]
+type GoalStart interface {
+	isGoalStart()
+}
+
+func (Date) isGoalStart()            {}
+func (CodeableConcept) isGoalStart() {}
+
+// Start returns the value of Goal.start[x] together with its FHIR type code or nil if no value is set
+func (r Goal) Start() (GoalStart, string) {
+	if r.StartDate != nil {
+		return Date(*r.StartDate), "date"
+	}
+	if r.StartCodeableConcept != nil {
+		return *r.StartCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetStart sets the value of Goal.start[x], given as value or pointer, and clears all other types
+func (r *Goal) SetStart(value GoalStart) {
+	r.StartDate = nil
+	r.StartCodeableConcept = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.StartDate = &primitive
+	case *Date:
+		r.StartDate = (*string)(v)
+	case CodeableConcept:
+		r.StartCodeableConcept = &v
+	case *CodeableConcept:
+		r.StartCodeableConcept = v
+	}
+}
+
 type GoalTarget struct {
 	Id                    *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension             []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -55,29 +103,2384 @@ type GoalTarget struct {
 	DetailRange           *Range           `bson:"detailRange,omitempty" json:"detailRange,omitempty"`
 	DetailCodeableConcept *CodeableConcept `bson:"detailCodeableConcept,omitempty" json:"detailCodeableConcept,omitempty"`
 	DetailString          *string          `bson:"detailString,omitempty" json:"detailString,omitempty"`
+	DetailStringElement   *Element         `bson:"_detailString,omitempty" json:"_detailString,omitempty"`
 	DetailBoolean         *bool            `bson:"detailBoolean,omitempty" json:"detailBoolean,omitempty"`
+	DetailBooleanElement  *Element         `bson:"_detailBoolean,omitempty" json:"_detailBoolean,omitempty"`
 	DetailInteger         *int             `bson:"detailInteger,omitempty" json:"detailInteger,omitempty"`
+	DetailIntegerElement  *Element         `bson:"_detailInteger,omitempty" json:"_detailInteger,omitempty"`
 	DetailRatio           *Ratio           `bson:"detailRatio,omitempty" json:"detailRatio,omitempty"`
 	DueDate               *string          `bson:"dueDate,omitempty" json:"dueDate,omitempty"`
+	DueDateElement        *Element         `bson:"_dueDate,omitempty" json:"_dueDate,omitempty"`
 	DueDuration           *Duration        `bson:"dueDuration,omitempty" json:"dueDuration,omitempty"`
 }
-type OtherGoal Goal
+
+// GoalTargetDetail is implemented by the types allowed for Goal.target.detail[x]
+type GoalTargetDetail interface {
+	isGoalTargetDetail()
+}
+
+func (Quantity) isGoalTargetDetail()        {}
+func (Range) isGoalTargetDetail()           {}
+func (CodeableConcept) isGoalTargetDetail() {}
+func (String) isGoalTargetDetail()          {}
+func (Boolean) isGoalTargetDetail()         {}
+func (Integer) isGoalTargetDetail()         {}
+func (Ratio) isGoalTargetDetail()           {}
+
+// Detail returns the value of Goal.target.detail[x] together with its FHIR type code or nil if no value is set
+func (r GoalTarget) Detail() (GoalTargetDetail, string) {
+	if r.DetailQuantity != nil {
+		return *r.DetailQuantity, "Quantity"
+	}
+	if r.DetailRange != nil {
+		return *r.DetailRange, "Range"
+	}
+	if r.DetailCodeableConcept != nil {
+		return *r.DetailCodeableConcept, "CodeableConcept"
+	}
+	if r.DetailString != nil {
+		return String(*r.DetailString), "string"
+	}
+	if r.DetailBoolean != nil {
+		return Boolean(*r.DetailBoolean), "boolean"
+	}
+	if r.DetailInteger != nil {
+		return Integer(*r.DetailInteger), "integer"
+	}
+	if r.DetailRatio != nil {
+		return *r.DetailRatio, "Ratio"
+	}
+	return nil, ""
+}
+
+// SetDetail sets the value of Goal.target.detail[x], given as value or pointer, and clears all other types
+func (r *GoalTarget) SetDetail(value GoalTargetDetail) {
+	r.DetailQuantity = nil
+	r.DetailRange = nil
+	r.DetailCodeableConcept = nil
+	r.DetailString = nil
+	r.DetailBoolean = nil
+	r.DetailInteger = nil
+	r.DetailRatio = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.DetailQuantity = &v
+	case *Quantity:
+		r.DetailQuantity = v
+	case Range:
+		r.DetailRange = &v
+	case *Range:
+		r.DetailRange = v
+	case CodeableConcept:
+		r.DetailCodeableConcept = &v
+	case *CodeableConcept:
+		r.DetailCodeableConcept = v
+	case String:
+		primitive := string(v)
+		r.DetailString = &primitive
+	case *String:
+		r.DetailString = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.DetailBoolean = &primitive
+	case *Boolean:
+		r.DetailBoolean = (*bool)(v)
+	case Integer:
+		primitive := int(v)
+		r.DetailInteger = &primitive
+	case *Integer:
+		r.DetailInteger = (*int)(v)
+	case Ratio:
+		r.DetailRatio = &v
+	case *Ratio:
+		r.DetailRatio = v
+	}
+}
+
+// GoalTargetDue is implemented by the types allowed for Goal.target.due[x]
+type GoalTargetDue interface {
+	isGoalTargetDue()
+}
+
+func (Date) isGoalTargetDue()     {}
+func (Duration) isGoalTargetDue() {}
+
+// Due returns the value of Goal.target.due[x] together with its FHIR type code or nil if no value is set
+func (r GoalTarget) Due() (GoalTargetDue, string) {
+	if r.DueDate != nil {
+		return Date(*r.DueDate), "date"
+	}
+	if r.DueDuration != nil {
+		return *r.DueDuration, "Duration"
+	}
+	return nil, ""
+}
+
+// SetDue sets the value of Goal.target.due[x], given as value or pointer, and clears all other types
+func (r *GoalTarget) SetDue(value GoalTargetDue) {
+	r.DueDate = nil
+	r.DueDuration = nil
+	switch v := value.(type) {
+	case Date:
+		primitive := string(v)
+		r.DueDate = &primitive
+	case *Date:
+		r.DueDate = (*string)(v)
+	case Duration:
+		r.DueDuration = &v
+	case *Duration:
+		r.DueDuration = v
+	}
+}
 
 // MarshalJSON marshals the given Goal as JSON into a byte slice
 func (r Goal) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherGoal
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherGoal:    OtherGoal(r),
-		ResourceType: "Goal",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Goal as JSON object with the members in the order of the fields
+func (r Goal) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Goal\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("lifecycleStatus")
+	e.string(r.LifecycleStatus.Code())
+	if r.LifecycleStatusElement != nil {
+		e.key("_lifecycleStatus")
+		r.LifecycleStatusElement.appendJSON(e)
+	}
+	if r.AchievementStatus != nil {
+		e.key("achievementStatus")
+		r.AchievementStatus.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		r.Priority.appendJSON(e)
+	}
+	e.key("description")
+	r.Description.appendJSON(e)
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.StartDate != nil {
+		e.key("startDate")
+		e.string(*r.StartDate)
+	}
+	if r.StartDateElement != nil {
+		e.key("_startDate")
+		r.StartDateElement.appendJSON(e)
+	}
+	if r.StartCodeableConcept != nil {
+		e.key("startCodeableConcept")
+		r.StartCodeableConcept.appendJSON(e)
+	}
+	if len(r.Target) > 0 {
+		e.key("target")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Target {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.StatusDate != nil {
+		e.key("statusDate")
+		e.string(*r.StatusDate)
+	}
+	if r.StatusDateElement != nil {
+		e.key("_statusDate")
+		r.StatusDateElement.appendJSON(e)
+	}
+	if r.StatusReason != nil {
+		e.key("statusReason")
+		e.string(*r.StatusReason)
+	}
+	if r.StatusReasonElement != nil {
+		e.key("_statusReason")
+		r.StatusReasonElement.appendJSON(e)
+	}
+	if r.ExpressedBy != nil {
+		e.key("expressedBy")
+		r.ExpressedBy.appendJSON(e)
+	}
+	if len(r.Addresses) > 0 {
+		e.key("addresses")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Addresses {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.OutcomeCode) > 0 {
+		e.key("outcomeCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.OutcomeCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.OutcomeReference) > 0 {
+		e.key("outcomeReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.OutcomeReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Goal from JSON. Unknown members are ignored.
+func (r *Goal) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Goal
+func (r *Goal) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "lifecycleStatus":
+			d.unmarshal(&r.LifecycleStatus)
+		case "_lifecycleStatus":
+			r.LifecycleStatusElement = d.element()
+		case "achievementStatus":
+			if d.null() {
+				r.AchievementStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AchievementStatus = &v
+			}
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Priority = &v
+			}
+		case "description":
+			r.Description.decodeJSON(d)
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "startDate":
+			if v, ok := d.string(); ok {
+				r.StartDate = &v
+			} else {
+				r.StartDate = nil
+			}
+		case "_startDate":
+			r.StartDateElement = d.element()
+		case "startCodeableConcept":
+			if d.null() {
+				r.StartCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StartCodeableConcept = &v
+			}
+		case "target":
+			a := d.array()
+			r.Target = nil
+			for a.next() {
+				var v GoalTarget
+				v.decodeJSON(d)
+				r.Target = append(r.Target, v)
+			}
+			if r.Target == nil && !a.isNull {
+				r.Target = []GoalTarget{}
+			}
+		case "statusDate":
+			if v, ok := d.string(); ok {
+				r.StatusDate = &v
+			} else {
+				r.StatusDate = nil
+			}
+		case "_statusDate":
+			r.StatusDateElement = d.element()
+		case "statusReason":
+			if v, ok := d.string(); ok {
+				r.StatusReason = &v
+			} else {
+				r.StatusReason = nil
+			}
+		case "_statusReason":
+			r.StatusReasonElement = d.element()
+		case "expressedBy":
+			if d.null() {
+				r.ExpressedBy = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ExpressedBy = &v
+			}
+		case "addresses":
+			a := d.array()
+			r.Addresses = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Addresses = append(r.Addresses, v)
+			}
+			if r.Addresses == nil && !a.isNull {
+				r.Addresses = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "outcomeCode":
+			a := d.array()
+			r.OutcomeCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OutcomeCode = append(r.OutcomeCode, v)
+			}
+			if r.OutcomeCode == nil && !a.isNull {
+				r.OutcomeCode = []CodeableConcept{}
+			}
+		case "outcomeReference":
+			a := d.array()
+			r.OutcomeReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.OutcomeReference = append(r.OutcomeReference, v)
+			}
+			if r.OutcomeReference == nil && !a.isNull {
+				r.OutcomeReference = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Goal which shares no memory with the original
+func (r Goal) DeepCopy() Goal {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.LifecycleStatusElement != nil {
+		v := r.LifecycleStatusElement.DeepCopy()
+		out.LifecycleStatusElement = &v
+	}
+	if r.AchievementStatus != nil {
+		v := r.AchievementStatus.DeepCopy()
+		out.AchievementStatus = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Priority != nil {
+		v := r.Priority.DeepCopy()
+		out.Priority = &v
+	}
+	out.Description = r.Description.DeepCopy()
+	out.Subject = r.Subject.DeepCopy()
+	if r.StartDate != nil {
+		v := *r.StartDate
+		out.StartDate = &v
+	}
+	if r.StartDateElement != nil {
+		v := r.StartDateElement.DeepCopy()
+		out.StartDateElement = &v
+	}
+	if r.StartCodeableConcept != nil {
+		v := r.StartCodeableConcept.DeepCopy()
+		out.StartCodeableConcept = &v
+	}
+	if r.Target != nil {
+		out.Target = make([]GoalTarget, len(r.Target))
+		for i := range r.Target {
+			out.Target[i] = r.Target[i].DeepCopy()
+		}
+	}
+	if r.StatusDate != nil {
+		v := *r.StatusDate
+		out.StatusDate = &v
+	}
+	if r.StatusDateElement != nil {
+		v := r.StatusDateElement.DeepCopy()
+		out.StatusDateElement = &v
+	}
+	if r.StatusReason != nil {
+		v := *r.StatusReason
+		out.StatusReason = &v
+	}
+	if r.StatusReasonElement != nil {
+		v := r.StatusReasonElement.DeepCopy()
+		out.StatusReasonElement = &v
+	}
+	if r.ExpressedBy != nil {
+		v := r.ExpressedBy.DeepCopy()
+		out.ExpressedBy = &v
+	}
+	if r.Addresses != nil {
+		out.Addresses = make([]Reference, len(r.Addresses))
+		for i := range r.Addresses {
+			out.Addresses[i] = r.Addresses[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.OutcomeCode != nil {
+		out.OutcomeCode = make([]CodeableConcept, len(r.OutcomeCode))
+		for i := range r.OutcomeCode {
+			out.OutcomeCode[i] = r.OutcomeCode[i].DeepCopy()
+		}
+	}
+	if r.OutcomeReference != nil {
+		out.OutcomeReference = make([]Reference, len(r.OutcomeReference))
+		for i := range r.OutcomeReference {
+			out.OutcomeReference[i] = r.OutcomeReference[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Goal) Equal(other Goal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.LifecycleStatus != other.LifecycleStatus {
+		return false
+	}
+	if (r.LifecycleStatusElement == nil) != (other.LifecycleStatusElement == nil) || r.LifecycleStatusElement != nil && !r.LifecycleStatusElement.Equal(*other.LifecycleStatusElement) {
+		return false
+	}
+	if (r.AchievementStatus == nil) != (other.AchievementStatus == nil) || r.AchievementStatus != nil && !r.AchievementStatus.Equal(*other.AchievementStatus) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.Equal(*other.Priority) {
+		return false
+	}
+	if !r.Description.Equal(other.Description) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.StartDate == nil) != (other.StartDate == nil) || r.StartDate != nil && *r.StartDate != *other.StartDate {
+		return false
+	}
+	if (r.StartDateElement == nil) != (other.StartDateElement == nil) || r.StartDateElement != nil && !r.StartDateElement.Equal(*other.StartDateElement) {
+		return false
+	}
+	if (r.StartCodeableConcept == nil) != (other.StartCodeableConcept == nil) || r.StartCodeableConcept != nil && !r.StartCodeableConcept.Equal(*other.StartCodeableConcept) {
+		return false
+	}
+	if len(r.Target) != len(other.Target) {
+		return false
+	}
+	for i := range r.Target {
+		if !r.Target[i].Equal(other.Target[i]) {
+			return false
+		}
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && *r.StatusDate != *other.StatusDate {
+		return false
+	}
+	if (r.StatusDateElement == nil) != (other.StatusDateElement == nil) || r.StatusDateElement != nil && !r.StatusDateElement.Equal(*other.StatusDateElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && *r.StatusReason != *other.StatusReason {
+		return false
+	}
+	if (r.StatusReasonElement == nil) != (other.StatusReasonElement == nil) || r.StatusReasonElement != nil && !r.StatusReasonElement.Equal(*other.StatusReasonElement) {
+		return false
+	}
+	if (r.ExpressedBy == nil) != (other.ExpressedBy == nil) || r.ExpressedBy != nil && !r.ExpressedBy.Equal(*other.ExpressedBy) {
+		return false
+	}
+	if len(r.Addresses) != len(other.Addresses) {
+		return false
+	}
+	for i := range r.Addresses {
+		if !r.Addresses[i].Equal(other.Addresses[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.OutcomeCode) != len(other.OutcomeCode) {
+		return false
+	}
+	for i := range r.OutcomeCode {
+		if !r.OutcomeCode[i].Equal(other.OutcomeCode[i]) {
+			return false
+		}
+	}
+	if len(r.OutcomeReference) != len(other.OutcomeReference) {
+		return false
+	}
+	for i := range r.OutcomeReference {
+		if !r.OutcomeReference[i].Equal(other.OutcomeReference[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Goal) EqualsDeep(other Goal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.LifecycleStatus != other.LifecycleStatus {
+		return false
+	}
+	if (r.LifecycleStatusElement == nil) != (other.LifecycleStatusElement == nil) || r.LifecycleStatusElement != nil && !r.LifecycleStatusElement.EqualsDeep(*other.LifecycleStatusElement) {
+		return false
+	}
+	if (r.AchievementStatus == nil) != (other.AchievementStatus == nil) || r.AchievementStatus != nil && !r.AchievementStatus.EqualsDeep(*other.AchievementStatus) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.EqualsDeep(*other.Priority) {
+		return false
+	}
+	if !r.Description.EqualsDeep(other.Description) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.StartDate == nil) != (other.StartDate == nil) || r.StartDate != nil && !equivalentString(*r.StartDate, *other.StartDate) {
+		return false
+	}
+	if (r.StartDateElement == nil) != (other.StartDateElement == nil) || r.StartDateElement != nil && !r.StartDateElement.EqualsDeep(*other.StartDateElement) {
+		return false
+	}
+	if (r.StartCodeableConcept == nil) != (other.StartCodeableConcept == nil) || r.StartCodeableConcept != nil && !r.StartCodeableConcept.EqualsDeep(*other.StartCodeableConcept) {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i].EqualsDeep(other.Target[j])
+	}) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && !equivalentString(*r.StatusDate, *other.StatusDate) {
+		return false
+	}
+	if (r.StatusDateElement == nil) != (other.StatusDateElement == nil) || r.StatusDateElement != nil && !r.StatusDateElement.EqualsDeep(*other.StatusDateElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !equivalentString(*r.StatusReason, *other.StatusReason) {
+		return false
+	}
+	if (r.StatusReasonElement == nil) != (other.StatusReasonElement == nil) || r.StatusReasonElement != nil && !r.StatusReasonElement.EqualsDeep(*other.StatusReasonElement) {
+		return false
+	}
+	if (r.ExpressedBy == nil) != (other.ExpressedBy == nil) || r.ExpressedBy != nil && !r.ExpressedBy.EqualsDeep(*other.ExpressedBy) {
+		return false
+	}
+	if !equivalentList(len(r.Addresses), len(other.Addresses), func(i, j int) bool {
+		return r.Addresses[i].EqualsDeep(other.Addresses[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.OutcomeCode), len(other.OutcomeCode), func(i, j int) bool {
+		return r.OutcomeCode[i].EqualsDeep(other.OutcomeCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.OutcomeReference), len(other.OutcomeReference), func(i, j int) bool {
+		return r.OutcomeReference[i].EqualsDeep(other.OutcomeReference[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Goal) EqualsShallow(other Goal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.LifecycleStatus != other.LifecycleStatus {
+		return false
+	}
+	if (r.StartDate == nil) != (other.StartDate == nil) || r.StartDate != nil && !equivalentString(*r.StartDate, *other.StartDate) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && !equivalentString(*r.StatusDate, *other.StatusDate) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !equivalentString(*r.StatusReason, *other.StatusReason) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Goal as FHIR XML
+func (r Goal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Goal",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "lifecycleStatus", r.LifecycleStatus, r.LifecycleStatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "achievementStatus", r.AchievementStatus); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "priority", r.Priority); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "description", r.Description); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "startDate", r.StartDate, r.StartDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "startCodeableConcept", r.StartCodeableConcept); err != nil {
+		return err
+	}
+	for _, v := range r.Target {
+		if err := encodeXMLElement(e, "target", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "statusDate", r.StatusDate, r.StatusDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "statusReason", r.StatusReason, r.StatusReasonElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "expressedBy", r.ExpressedBy); err != nil {
+		return err
+	}
+	for _, v := range r.Addresses {
+		if err := encodeXMLElement(e, "addresses", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.OutcomeCode {
+		if err := encodeXMLElement(e, "outcomeCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.OutcomeReference {
+		if err := encodeXMLElement(e, "outcomeReference", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Goal from FHIR XML
+func (r *Goal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "lifecycleStatus":
+				var v GoalLifecycleStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LifecycleStatus = v
+				}
+				r.LifecycleStatusElement = element
+			case "achievementStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AchievementStatus = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "priority":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Priority = &v
+			case "description":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Description = v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "startDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.StartDate = &v
+				}
+				r.StartDateElement = element
+			case "startCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StartCodeableConcept = &v
+			case "target":
+				var v GoalTarget
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = append(r.Target, v)
+			case "statusDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.StatusDate = &v
+				}
+				r.StatusDateElement = element
+			case "statusReason":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.StatusReason = &v
+				}
+				r.StatusReasonElement = element
+			case "expressedBy":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ExpressedBy = &v
+			case "addresses":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Addresses = append(r.Addresses, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "outcomeCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OutcomeCode = append(r.OutcomeCode, v)
+			case "outcomeReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OutcomeReference = append(r.OutcomeReference, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Goal as properties to the RDF node n
+func (r Goal) turtle(n *turtleNode) {
+	n.resource("Goal", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Goal.identifier", i, v)
+	}
+	n.primitive("Goal.lifecycleStatus", -1, r.LifecycleStatus, r.LifecycleStatusElement, "code")
+	n.element("Goal.achievementStatus", -1, r.AchievementStatus)
+	for i, v := range r.Category {
+		n.element("Goal.category", i, v)
+	}
+	n.element("Goal.priority", -1, r.Priority)
+	n.element("Goal.description", -1, r.Description)
+	n.element("Goal.subject", -1, r.Subject)
+	n.primitive("Goal.startDate", -1, r.StartDate, r.StartDateElement, "date")
+	n.element("Goal.startCodeableConcept", -1, r.StartCodeableConcept)
+	for i, v := range r.Target {
+		n.element("Goal.target", i, v)
+	}
+	n.primitive("Goal.statusDate", -1, r.StatusDate, r.StatusDateElement, "date")
+	n.primitive("Goal.statusReason", -1, r.StatusReason, r.StatusReasonElement, "string")
+	n.element("Goal.expressedBy", -1, r.ExpressedBy)
+	for i, v := range r.Addresses {
+		n.element("Goal.addresses", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("Goal.note", i, v)
+	}
+	for i, v := range r.OutcomeCode {
+		n.element("Goal.outcomeCode", i, v)
+	}
+	for i, v := range r.OutcomeReference {
+		n.element("Goal.outcomeReference", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Goal as BSON document with the structure of its FHIR JSON
+func (r Goal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Goal from a BSON document with the structure of its FHIR JSON
+func (r *Goal) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Goal as protobuf message Goal of fhir.proto
+func (r Goal) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Goal to the encoded message
+func (r Goal) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.LifecycleStatus.protoNumber())
+	if r.AchievementStatus != nil {
+		e.message(11, *r.AchievementStatus)
+	}
+	for _, v := range r.Category {
+		e.message(12, v)
+	}
+	if r.Priority != nil {
+		e.message(13, *r.Priority)
+	}
+	e.message(14, r.Description)
+	e.message(15, r.Subject)
+	if r.StartDate != nil {
+		e.string(16, *r.StartDate)
+	}
+	if r.StartCodeableConcept != nil {
+		e.message(17, *r.StartCodeableConcept)
+	}
+	for _, v := range r.Target {
+		e.message(18, v)
+	}
+	if r.StatusDate != nil {
+		e.string(19, *r.StatusDate)
+	}
+	if r.StatusReason != nil {
+		e.string(20, *r.StatusReason)
+	}
+	if r.ExpressedBy != nil {
+		e.message(21, *r.ExpressedBy)
+	}
+	for _, v := range r.Addresses {
+		e.message(22, v)
+	}
+	for _, v := range r.Note {
+		e.message(23, v)
+	}
+	for _, v := range r.OutcomeCode {
+		e.message(24, v)
+	}
+	for _, v := range r.OutcomeReference {
+		e.message(25, v)
+	}
+	if r.IdElement != nil {
+		e.message(26, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(27, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(28, *r.LanguageElement)
+	}
+	if r.LifecycleStatusElement != nil {
+		e.message(29, *r.LifecycleStatusElement)
+	}
+	if r.StartDateElement != nil {
+		e.message(30, *r.StartDateElement)
+	}
+	if r.StatusDateElement != nil {
+		e.message(31, *r.StatusDateElement)
+	}
+	if r.StatusReasonElement != nil {
+		e.message(32, *r.StatusReasonElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Goal from protobuf message Goal of fhir.proto
+func (r *Goal) UnmarshalProto(b []byte) error {
+	*r = Goal{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v GoalLifecycleStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.LifecycleStatus = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.AchievementStatus = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Priority = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Description = v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 16:
+			v := d.string()
+			r.StartDate = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.StartCodeableConcept = &v
+		case 18:
+			var v GoalTarget
+			d.message(&v)
+			r.Target = append(r.Target, v)
+		case 19:
+			v := d.string()
+			r.StatusDate = &v
+		case 20:
+			v := d.string()
+			r.StatusReason = &v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.ExpressedBy = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.Addresses = append(r.Addresses, v)
+		case 23:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.OutcomeCode = append(r.OutcomeCode, v)
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.OutcomeReference = append(r.OutcomeReference, v)
+		case 26:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.LifecycleStatusElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.StartDateElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.StatusDateElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.StatusReasonElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given GoalTarget as JSON into a byte slice
+func (r GoalTarget) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the GoalTarget as JSON object with the members in the order of the fields
+func (r GoalTarget) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Measure != nil {
+		e.key("measure")
+		r.Measure.appendJSON(e)
+	}
+	if r.DetailQuantity != nil {
+		e.key("detailQuantity")
+		r.DetailQuantity.appendJSON(e)
+	}
+	if r.DetailRange != nil {
+		e.key("detailRange")
+		r.DetailRange.appendJSON(e)
+	}
+	if r.DetailCodeableConcept != nil {
+		e.key("detailCodeableConcept")
+		r.DetailCodeableConcept.appendJSON(e)
+	}
+	if r.DetailString != nil {
+		e.key("detailString")
+		e.string(*r.DetailString)
+	}
+	if r.DetailStringElement != nil {
+		e.key("_detailString")
+		r.DetailStringElement.appendJSON(e)
+	}
+	if r.DetailBoolean != nil {
+		e.key("detailBoolean")
+		e.bool(*r.DetailBoolean)
+	}
+	if r.DetailBooleanElement != nil {
+		e.key("_detailBoolean")
+		r.DetailBooleanElement.appendJSON(e)
+	}
+	if r.DetailInteger != nil {
+		e.key("detailInteger")
+		e.int(*r.DetailInteger)
+	}
+	if r.DetailIntegerElement != nil {
+		e.key("_detailInteger")
+		r.DetailIntegerElement.appendJSON(e)
+	}
+	if r.DetailRatio != nil {
+		e.key("detailRatio")
+		r.DetailRatio.appendJSON(e)
+	}
+	if r.DueDate != nil {
+		e.key("dueDate")
+		e.string(*r.DueDate)
+	}
+	if r.DueDateElement != nil {
+		e.key("_dueDate")
+		r.DueDateElement.appendJSON(e)
+	}
+	if r.DueDuration != nil {
+		e.key("dueDuration")
+		r.DueDuration.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given GoalTarget from JSON. Unknown members are ignored.
+func (r *GoalTarget) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the GoalTarget
+func (r *GoalTarget) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "measure":
+			if d.null() {
+				r.Measure = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Measure = &v
+			}
+		case "detailQuantity":
+			if d.null() {
+				r.DetailQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.DetailQuantity = &v
+			}
+		case "detailRange":
+			if d.null() {
+				r.DetailRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.DetailRange = &v
+			}
+		case "detailCodeableConcept":
+			if d.null() {
+				r.DetailCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DetailCodeableConcept = &v
+			}
+		case "detailString":
+			if v, ok := d.string(); ok {
+				r.DetailString = &v
+			} else {
+				r.DetailString = nil
+			}
+		case "_detailString":
+			r.DetailStringElement = d.element()
+		case "detailBoolean":
+			if v, ok := d.bool(); ok {
+				r.DetailBoolean = &v
+			} else {
+				r.DetailBoolean = nil
+			}
+		case "_detailBoolean":
+			r.DetailBooleanElement = d.element()
+		case "detailInteger":
+			if v, ok := d.int(); ok {
+				r.DetailInteger = &v
+			} else {
+				r.DetailInteger = nil
+			}
+		case "_detailInteger":
+			r.DetailIntegerElement = d.element()
+		case "detailRatio":
+			if d.null() {
+				r.DetailRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.DetailRatio = &v
+			}
+		case "dueDate":
+			if v, ok := d.string(); ok {
+				r.DueDate = &v
+			} else {
+				r.DueDate = nil
+			}
+		case "_dueDate":
+			r.DueDateElement = d.element()
+		case "dueDuration":
+			if d.null() {
+				r.DueDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.DueDuration = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the GoalTarget which shares no memory with the original
+func (r GoalTarget) DeepCopy() GoalTarget {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Measure != nil {
+		v := r.Measure.DeepCopy()
+		out.Measure = &v
+	}
+	if r.DetailQuantity != nil {
+		v := r.DetailQuantity.DeepCopy()
+		out.DetailQuantity = &v
+	}
+	if r.DetailRange != nil {
+		v := r.DetailRange.DeepCopy()
+		out.DetailRange = &v
+	}
+	if r.DetailCodeableConcept != nil {
+		v := r.DetailCodeableConcept.DeepCopy()
+		out.DetailCodeableConcept = &v
+	}
+	if r.DetailString != nil {
+		v := *r.DetailString
+		out.DetailString = &v
+	}
+	if r.DetailStringElement != nil {
+		v := r.DetailStringElement.DeepCopy()
+		out.DetailStringElement = &v
+	}
+	if r.DetailBoolean != nil {
+		v := *r.DetailBoolean
+		out.DetailBoolean = &v
+	}
+	if r.DetailBooleanElement != nil {
+		v := r.DetailBooleanElement.DeepCopy()
+		out.DetailBooleanElement = &v
+	}
+	if r.DetailInteger != nil {
+		v := *r.DetailInteger
+		out.DetailInteger = &v
+	}
+	if r.DetailIntegerElement != nil {
+		v := r.DetailIntegerElement.DeepCopy()
+		out.DetailIntegerElement = &v
+	}
+	if r.DetailRatio != nil {
+		v := r.DetailRatio.DeepCopy()
+		out.DetailRatio = &v
+	}
+	if r.DueDate != nil {
+		v := *r.DueDate
+		out.DueDate = &v
+	}
+	if r.DueDateElement != nil {
+		v := r.DueDateElement.DeepCopy()
+		out.DueDateElement = &v
+	}
+	if r.DueDuration != nil {
+		v := r.DueDuration.DeepCopy()
+		out.DueDuration = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r GoalTarget) Equal(other GoalTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Measure == nil) != (other.Measure == nil) || r.Measure != nil && !r.Measure.Equal(*other.Measure) {
+		return false
+	}
+	if (r.DetailQuantity == nil) != (other.DetailQuantity == nil) || r.DetailQuantity != nil && !r.DetailQuantity.Equal(*other.DetailQuantity) {
+		return false
+	}
+	if (r.DetailRange == nil) != (other.DetailRange == nil) || r.DetailRange != nil && !r.DetailRange.Equal(*other.DetailRange) {
+		return false
+	}
+	if (r.DetailCodeableConcept == nil) != (other.DetailCodeableConcept == nil) || r.DetailCodeableConcept != nil && !r.DetailCodeableConcept.Equal(*other.DetailCodeableConcept) {
+		return false
+	}
+	if (r.DetailString == nil) != (other.DetailString == nil) || r.DetailString != nil && *r.DetailString != *other.DetailString {
+		return false
+	}
+	if (r.DetailStringElement == nil) != (other.DetailStringElement == nil) || r.DetailStringElement != nil && !r.DetailStringElement.Equal(*other.DetailStringElement) {
+		return false
+	}
+	if (r.DetailBoolean == nil) != (other.DetailBoolean == nil) || r.DetailBoolean != nil && *r.DetailBoolean != *other.DetailBoolean {
+		return false
+	}
+	if (r.DetailBooleanElement == nil) != (other.DetailBooleanElement == nil) || r.DetailBooleanElement != nil && !r.DetailBooleanElement.Equal(*other.DetailBooleanElement) {
+		return false
+	}
+	if (r.DetailInteger == nil) != (other.DetailInteger == nil) || r.DetailInteger != nil && *r.DetailInteger != *other.DetailInteger {
+		return false
+	}
+	if (r.DetailIntegerElement == nil) != (other.DetailIntegerElement == nil) || r.DetailIntegerElement != nil && !r.DetailIntegerElement.Equal(*other.DetailIntegerElement) {
+		return false
+	}
+	if (r.DetailRatio == nil) != (other.DetailRatio == nil) || r.DetailRatio != nil && !r.DetailRatio.Equal(*other.DetailRatio) {
+		return false
+	}
+	if (r.DueDate == nil) != (other.DueDate == nil) || r.DueDate != nil && *r.DueDate != *other.DueDate {
+		return false
+	}
+	if (r.DueDateElement == nil) != (other.DueDateElement == nil) || r.DueDateElement != nil && !r.DueDateElement.Equal(*other.DueDateElement) {
+		return false
+	}
+	if (r.DueDuration == nil) != (other.DueDuration == nil) || r.DueDuration != nil && !r.DueDuration.Equal(*other.DueDuration) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r GoalTarget) EqualsDeep(other GoalTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Measure == nil) != (other.Measure == nil) || r.Measure != nil && !r.Measure.EqualsDeep(*other.Measure) {
+		return false
+	}
+	if (r.DetailQuantity == nil) != (other.DetailQuantity == nil) || r.DetailQuantity != nil && !r.DetailQuantity.EqualsDeep(*other.DetailQuantity) {
+		return false
+	}
+	if (r.DetailRange == nil) != (other.DetailRange == nil) || r.DetailRange != nil && !r.DetailRange.EqualsDeep(*other.DetailRange) {
+		return false
+	}
+	if (r.DetailCodeableConcept == nil) != (other.DetailCodeableConcept == nil) || r.DetailCodeableConcept != nil && !r.DetailCodeableConcept.EqualsDeep(*other.DetailCodeableConcept) {
+		return false
+	}
+	if (r.DetailString == nil) != (other.DetailString == nil) || r.DetailString != nil && !equivalentString(*r.DetailString, *other.DetailString) {
+		return false
+	}
+	if (r.DetailStringElement == nil) != (other.DetailStringElement == nil) || r.DetailStringElement != nil && !r.DetailStringElement.EqualsDeep(*other.DetailStringElement) {
+		return false
+	}
+	if (r.DetailBoolean == nil) != (other.DetailBoolean == nil) || r.DetailBoolean != nil && *r.DetailBoolean != *other.DetailBoolean {
+		return false
+	}
+	if (r.DetailBooleanElement == nil) != (other.DetailBooleanElement == nil) || r.DetailBooleanElement != nil && !r.DetailBooleanElement.EqualsDeep(*other.DetailBooleanElement) {
+		return false
+	}
+	if (r.DetailInteger == nil) != (other.DetailInteger == nil) || r.DetailInteger != nil && *r.DetailInteger != *other.DetailInteger {
+		return false
+	}
+	if (r.DetailIntegerElement == nil) != (other.DetailIntegerElement == nil) || r.DetailIntegerElement != nil && !r.DetailIntegerElement.EqualsDeep(*other.DetailIntegerElement) {
+		return false
+	}
+	if (r.DetailRatio == nil) != (other.DetailRatio == nil) || r.DetailRatio != nil && !r.DetailRatio.EqualsDeep(*other.DetailRatio) {
+		return false
+	}
+	if (r.DueDate == nil) != (other.DueDate == nil) || r.DueDate != nil && !equivalentString(*r.DueDate, *other.DueDate) {
+		return false
+	}
+	if (r.DueDateElement == nil) != (other.DueDateElement == nil) || r.DueDateElement != nil && !r.DueDateElement.EqualsDeep(*other.DueDateElement) {
+		return false
+	}
+	if (r.DueDuration == nil) != (other.DueDuration == nil) || r.DueDuration != nil && !r.DueDuration.EqualsDeep(*other.DueDuration) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r GoalTarget) EqualsShallow(other GoalTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.DetailString == nil) != (other.DetailString == nil) || r.DetailString != nil && !equivalentString(*r.DetailString, *other.DetailString) {
+		return false
+	}
+	if (r.DetailBoolean == nil) != (other.DetailBoolean == nil) || r.DetailBoolean != nil && *r.DetailBoolean != *other.DetailBoolean {
+		return false
+	}
+	if (r.DetailInteger == nil) != (other.DetailInteger == nil) || r.DetailInteger != nil && *r.DetailInteger != *other.DetailInteger {
+		return false
+	}
+	if (r.DueDate == nil) != (other.DueDate == nil) || r.DueDate != nil && !equivalentString(*r.DueDate, *other.DueDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given GoalTarget as FHIR XML
+func (r GoalTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "measure", r.Measure); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "detailQuantity", r.DetailQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "detailRange", r.DetailRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "detailCodeableConcept", r.DetailCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "detailString", r.DetailString, r.DetailStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "detailBoolean", r.DetailBoolean, r.DetailBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "detailInteger", r.DetailInteger, r.DetailIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "detailRatio", r.DetailRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "dueDate", r.DueDate, r.DueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dueDuration", r.DueDuration); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given GoalTarget from FHIR XML
+func (r *GoalTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "measure":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Measure = &v
+			case "detailQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DetailQuantity = &v
+			case "detailRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DetailRange = &v
+			case "detailCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DetailCodeableConcept = &v
+			case "detailString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DetailString = &v
+				}
+				r.DetailStringElement = element
+			case "detailBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DetailBoolean = &v
+				}
+				r.DetailBooleanElement = element
+			case "detailInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DetailInteger = &v
+				}
+				r.DetailIntegerElement = element
+			case "detailRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DetailRatio = &v
+			case "dueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DueDate = &v
+				}
+				r.DueDateElement = element
+			case "dueDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DueDuration = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the GoalTarget as properties to the RDF node n
+func (r GoalTarget) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Goal.target.measure", -1, r.Measure)
+	n.element("Goal.target.detailQuantity", -1, r.DetailQuantity)
+	n.element("Goal.target.detailRange", -1, r.DetailRange)
+	n.element("Goal.target.detailCodeableConcept", -1, r.DetailCodeableConcept)
+	n.primitive("Goal.target.detailString", -1, r.DetailString, r.DetailStringElement, "string")
+	n.primitive("Goal.target.detailBoolean", -1, r.DetailBoolean, r.DetailBooleanElement, "boolean")
+	n.primitive("Goal.target.detailInteger", -1, r.DetailInteger, r.DetailIntegerElement, "integer")
+	n.element("Goal.target.detailRatio", -1, r.DetailRatio)
+	n.primitive("Goal.target.dueDate", -1, r.DueDate, r.DueDateElement, "date")
+	n.element("Goal.target.dueDuration", -1, r.DueDuration)
+}
+
+// MarshalBSON marshals the given GoalTarget as BSON document with the structure of its FHIR JSON
+func (r GoalTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given GoalTarget from a BSON document with the structure of its FHIR JSON
+func (r *GoalTarget) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given GoalTarget as protobuf message GoalTarget of fhir.proto
+func (r GoalTarget) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the GoalTarget to the encoded message
+func (r GoalTarget) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Measure != nil {
+		e.message(4, *r.Measure)
+	}
+	if r.DetailQuantity != nil {
+		e.message(5, *r.DetailQuantity)
+	}
+	if r.DetailRange != nil {
+		e.message(6, *r.DetailRange)
+	}
+	if r.DetailCodeableConcept != nil {
+		e.message(7, *r.DetailCodeableConcept)
+	}
+	if r.DetailString != nil {
+		e.string(8, *r.DetailString)
+	}
+	if r.DetailBoolean != nil {
+		e.varint(9, protoBool(*r.DetailBoolean))
+	}
+	if r.DetailInteger != nil {
+		e.varint(10, uint64(int64(*r.DetailInteger)))
+	}
+	if r.DetailRatio != nil {
+		e.message(11, *r.DetailRatio)
+	}
+	if r.DueDate != nil {
+		e.string(12, *r.DueDate)
+	}
+	if r.DueDuration != nil {
+		e.message(13, *r.DueDuration)
+	}
+	if r.DetailStringElement != nil {
+		e.message(14, *r.DetailStringElement)
+	}
+	if r.DetailBooleanElement != nil {
+		e.message(15, *r.DetailBooleanElement)
+	}
+	if r.DetailIntegerElement != nil {
+		e.message(16, *r.DetailIntegerElement)
+	}
+	if r.DueDateElement != nil {
+		e.message(17, *r.DueDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given GoalTarget from protobuf message GoalTarget of fhir.proto
+func (r *GoalTarget) UnmarshalProto(b []byte) error {
+	*r = GoalTarget{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Measure = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.DetailQuantity = &v
+		case 6:
+			var v Range
+			d.message(&v)
+			r.DetailRange = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.DetailCodeableConcept = &v
+		case 8:
+			v := d.string()
+			r.DetailString = &v
+		case 9:
+			v := d.bool()
+			r.DetailBoolean = &v
+		case 10:
+			v := d.int()
+			r.DetailInteger = &v
+		case 11:
+			var v Ratio
+			d.message(&v)
+			r.DetailRatio = &v
+		case 12:
+			v := d.string()
+			r.DueDate = &v
+		case 13:
+			var v Duration
+			d.message(&v)
+			r.DueDuration = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.DetailStringElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.DetailBooleanElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.DetailIntegerElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.DueDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalGoal unmarshals a Goal.
 func UnmarshalGoal(b []byte) (Goal, error) {
 	var goal Goal
-	if err := json.Unmarshal(b, &goal); err != nil {
+	if err := goal.UnmarshalJSON(b); err != nil {
 		return goal, err
 	}
 	return goal, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code GoalLifecycleStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *GoalLifecycleStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *GoalLifecycleStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "proposed":
 		*code = GoalLifecycleStatusProposed
@@ -67,6 +71,12 @@ func (code *GoalLifecycleStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code GoalLifecycleStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *GoalLifecycleStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code GoalLifecycleStatus) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code GoalLifecycleStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code GoalLifecycleStatus) protoNumber() uint64 {
+	switch code {
+	case GoalLifecycleStatusProposed:
+		return 1
+	case GoalLifecycleStatusPlanned:
+		return 2
+	case GoalLifecycleStatusAccepted:
+		return 3
+	case GoalLifecycleStatusActive:
+		return 4
+	case GoalLifecycleStatusOnHold:
+		return 5
+	case GoalLifecycleStatusCompleted:
+		return 6
+	case GoalLifecycleStatusCancelled:
+		return 7
+	case GoalLifecycleStatusEnteredInError:
+		return 8
+	case GoalLifecycleStatusRejected:
+		return 9
+	}
+	return 0
+}
+func (code *GoalLifecycleStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = GoalLifecycleStatusProposed
+	case 2:
+		*code = GoalLifecycleStatusPlanned
+	case 3:
+		*code = GoalLifecycleStatusAccepted
+	case 4:
+		*code = GoalLifecycleStatusActive
+	case 5:
+		*code = GoalLifecycleStatusOnHold
+	case 6:
+		*code = GoalLifecycleStatusCompleted
+	case 7:
+		*code = GoalLifecycleStatusCancelled
+	case 8:
+		*code = GoalLifecycleStatusEnteredInError
+	case 9:
+		*code = GoalLifecycleStatusRejected
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code GraphCompartmentRule) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *GraphCompartmentRule) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *GraphCompartmentRule) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "identical":
 		*code = GraphCompartmentRuleIdentical
@@ -52,6 +56,12 @@ func (code *GraphCompartmentRule) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code GraphCompartmentRule) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *GraphCompartmentRule) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code GraphCompartmentRule) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code GraphCompartmentRule) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code GraphCompartmentRule) protoNumber() uint64 {
+	switch code {
+	case GraphCompartmentRuleIdentical:
+		return 1
+	case GraphCompartmentRuleMatching:
+		return 2
+	case GraphCompartmentRuleDifferent:
+		return 3
+	case GraphCompartmentRuleCustom:
+		return 4
+	}
+	return 0
+}
+func (code *GraphCompartmentRule) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = GraphCompartmentRuleIdentical
+	case 2:
+		*code = GraphCompartmentRuleMatching
+	case 3:
+		*code = GraphCompartmentRuleDifferent
+	case 4:
+		*code = GraphCompartmentRuleCustom
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code GraphCompartmentUse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *GraphCompartmentUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *GraphCompartmentUse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "condition":
 		*code = GraphCompartmentUseCondition
@@ -46,6 +50,12 @@ func (code *GraphCompartmentUse) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code GraphCompartmentUse) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *GraphCompartmentUse) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code GraphCompartmentUse) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code GraphCompartmentUse) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code GraphCompartmentUse) protoNumber() uint64 {
+	switch code {
+	case GraphCompartmentUseCondition:
+		return 1
+	case GraphCompartmentUseRequirement:
+		return 2
+	}
+	return 0
+}
+func (code *GraphCompartmentUse) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = GraphCompartmentUseCondition
+	case 2:
+		*code = GraphCompartmentUseRequirement
+	default:
+		return false
+	}
+	return true
+}
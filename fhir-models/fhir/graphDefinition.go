@@ -14,84 +14,3467 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // GraphDefinition is documented here http://hl7.org/fhir/StructureDefinition/GraphDefinition
 type GraphDefinition struct {
-	Id                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string               `bson:"url,omitempty" json:"url,omitempty"`
-	Version           *string               `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                `bson:"name" json:"name"`
-	Status            PublicationStatus     `bson:"status" json:"status"`
-	Experimental      *bool                 `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string               `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string               `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail       `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string               `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext        `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept     `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string               `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Start             ResourceType          `bson:"start" json:"start"`
-	Profile           *string               `bson:"profile,omitempty" json:"profile,omitempty"`
-	Link              []GraphDefinitionLink `bson:"link,omitempty" json:"link,omitempty"`
+	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element              `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  *string               `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement           *Element              `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string               `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element              `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                `bson:"name" json:"name"`
+	NameElement          *Element              `bson:"_name,omitempty" json:"_name,omitempty"`
+	Status               PublicationStatus     `bson:"status" json:"status"`
+	StatusElement        *Element              `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                 `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element              `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *string               `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element              `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string               `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element              `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail       `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string               `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element              `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext        `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept     `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string               `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element              `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Start                ResourceType          `bson:"start" json:"start"`
+	StartElement         *Element              `bson:"_start,omitempty" json:"_start,omitempty"`
+	Profile              *string               `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement       *Element              `bson:"_profile,omitempty" json:"_profile,omitempty"`
+	Link                 []GraphDefinitionLink `bson:"link,omitempty" json:"link,omitempty"`
 }
 type GraphDefinitionLink struct {
-	Id                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Path              *string                     `bson:"path,omitempty" json:"path,omitempty"`
-	SliceName         *string                     `bson:"sliceName,omitempty" json:"sliceName,omitempty"`
-	Min               *int                        `bson:"min,omitempty" json:"min,omitempty"`
-	Max               *string                     `bson:"max,omitempty" json:"max,omitempty"`
-	Description       *string                     `bson:"description,omitempty" json:"description,omitempty"`
-	Target            []GraphDefinitionLinkTarget `bson:"target,omitempty" json:"target,omitempty"`
+	Id                 *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Path               *string                     `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement        *Element                    `bson:"_path,omitempty" json:"_path,omitempty"`
+	SliceName          *string                     `bson:"sliceName,omitempty" json:"sliceName,omitempty"`
+	SliceNameElement   *Element                    `bson:"_sliceName,omitempty" json:"_sliceName,omitempty"`
+	Min                *int                        `bson:"min,omitempty" json:"min,omitempty"`
+	MinElement         *Element                    `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max                *string                     `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement         *Element                    `bson:"_max,omitempty" json:"_max,omitempty"`
+	Description        *string                     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element                    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Target             []GraphDefinitionLinkTarget `bson:"target,omitempty" json:"target,omitempty"`
 }
 type GraphDefinitionLinkTarget struct {
 	Id                *string                                `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              ResourceType                           `bson:"type" json:"type"`
+	TypeElement       *Element                               `bson:"_type,omitempty" json:"_type,omitempty"`
 	Params            *string                                `bson:"params,omitempty" json:"params,omitempty"`
+	ParamsElement     *Element                               `bson:"_params,omitempty" json:"_params,omitempty"`
 	Profile           *string                                `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement    *Element                               `bson:"_profile,omitempty" json:"_profile,omitempty"`
 	Compartment       []GraphDefinitionLinkTargetCompartment `bson:"compartment,omitempty" json:"compartment,omitempty"`
 	Link              []GraphDefinitionLink                  `bson:"link,omitempty" json:"link,omitempty"`
 }
 type GraphDefinitionLinkTargetCompartment struct {
-	Id                *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Use               GraphCompartmentUse  `bson:"use" json:"use"`
-	Code              CompartmentType      `bson:"code" json:"code"`
-	Rule              GraphCompartmentRule `bson:"rule" json:"rule"`
-	Expression        *string              `bson:"expression,omitempty" json:"expression,omitempty"`
-	Description       *string              `bson:"description,omitempty" json:"description,omitempty"`
+	Id                 *string              `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Use                GraphCompartmentUse  `bson:"use" json:"use"`
+	UseElement         *Element             `bson:"_use,omitempty" json:"_use,omitempty"`
+	Code               CompartmentType      `bson:"code" json:"code"`
+	CodeElement        *Element             `bson:"_code,omitempty" json:"_code,omitempty"`
+	Rule               GraphCompartmentRule `bson:"rule" json:"rule"`
+	RuleElement        *Element             `bson:"_rule,omitempty" json:"_rule,omitempty"`
+	Expression         *string              `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement  *Element             `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	Description        *string              `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element             `bson:"_description,omitempty" json:"_description,omitempty"`
 }
-type OtherGraphDefinition GraphDefinition
 
 // MarshalJSON marshals the given GraphDefinition as JSON into a byte slice
 func (r GraphDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherGraphDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherGraphDefinition: OtherGraphDefinition(r),
-		ResourceType:         "GraphDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the GraphDefinition as JSON object with the members in the order of the fields
+func (r GraphDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"GraphDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	e.key("start")
+	e.string(r.Start.Code())
+	if r.StartElement != nil {
+		e.key("_start")
+		r.StartElement.appendJSON(e)
+	}
+	if r.Profile != nil {
+		e.key("profile")
+		e.string(*r.Profile)
+	}
+	if r.ProfileElement != nil {
+		e.key("_profile")
+		r.ProfileElement.appendJSON(e)
+	}
+	if len(r.Link) > 0 {
+		e.key("link")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Link {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given GraphDefinition from JSON. Unknown members are ignored.
+func (r *GraphDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the GraphDefinition
+func (r *GraphDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "start":
+			d.unmarshal(&r.Start)
+		case "_start":
+			r.StartElement = d.element()
+		case "profile":
+			if v, ok := d.string(); ok {
+				r.Profile = &v
+			} else {
+				r.Profile = nil
+			}
+		case "_profile":
+			r.ProfileElement = d.element()
+		case "link":
+			a := d.array()
+			r.Link = nil
+			for a.next() {
+				var v GraphDefinitionLink
+				v.decodeJSON(d)
+				r.Link = append(r.Link, v)
+			}
+			if r.Link == nil && !a.isNull {
+				r.Link = []GraphDefinitionLink{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the GraphDefinition which shares no memory with the original
+func (r GraphDefinition) DeepCopy() GraphDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.StartElement != nil {
+		v := r.StartElement.DeepCopy()
+		out.StartElement = &v
+	}
+	if r.Profile != nil {
+		v := *r.Profile
+		out.Profile = &v
+	}
+	if r.ProfileElement != nil {
+		v := r.ProfileElement.DeepCopy()
+		out.ProfileElement = &v
+	}
+	if r.Link != nil {
+		out.Link = make([]GraphDefinitionLink, len(r.Link))
+		for i := range r.Link {
+			out.Link[i] = r.Link[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r GraphDefinition) Equal(other GraphDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if r.Start != other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.Equal(*other.StartElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && *r.Profile != *other.Profile {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.Equal(*other.ProfileElement) {
+		return false
+	}
+	if len(r.Link) != len(other.Link) {
+		return false
+	}
+	for i := range r.Link {
+		if !r.Link[i].Equal(other.Link[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r GraphDefinition) EqualsDeep(other GraphDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if r.Start != other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.EqualsDeep(*other.StartElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.EqualsDeep(*other.ProfileElement) {
+		return false
+	}
+	if !equivalentList(len(r.Link), len(other.Link), func(i, j int) bool {
+		return r.Link[i].EqualsDeep(other.Link[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r GraphDefinition) EqualsShallow(other GraphDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if r.Start != other.Start {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given GraphDefinition as FHIR XML
+func (r GraphDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "GraphDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "start", r.Start, r.StartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "profile", r.Profile, r.ProfileElement); err != nil {
+		return err
+	}
+	for _, v := range r.Link {
+		if err := encodeXMLElement(e, "link", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given GraphDefinition from FHIR XML
+func (r *GraphDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "start":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Start = v
+				}
+				r.StartElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Profile = &v
+				}
+				r.ProfileElement = element
+			case "link":
+				var v GraphDefinitionLink
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Link = append(r.Link, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the GraphDefinition as properties to the RDF node n
+func (r GraphDefinition) turtle(n *turtleNode) {
+	n.resource("GraphDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("GraphDefinition.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("GraphDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("GraphDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("GraphDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("GraphDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("GraphDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("GraphDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("GraphDefinition.contact", i, v)
+	}
+	n.primitive("GraphDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("GraphDefinition.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("GraphDefinition.jurisdiction", i, v)
+	}
+	n.primitive("GraphDefinition.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("GraphDefinition.start", -1, r.Start, r.StartElement, "code")
+	n.primitive("GraphDefinition.profile", -1, r.Profile, r.ProfileElement, "string")
+	for i, v := range r.Link {
+		n.element("GraphDefinition.link", i, v)
+	}
+}
+
+// MarshalBSON marshals the given GraphDefinition as BSON document with the structure of its FHIR JSON
+func (r GraphDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given GraphDefinition from a BSON document with the structure of its FHIR JSON
+func (r *GraphDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given GraphDefinition as protobuf message GraphDefinition of fhir.proto
+func (r GraphDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the GraphDefinition to the encoded message
+func (r GraphDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	if r.Version != nil {
+		e.string(10, *r.Version)
+	}
+	if r.Name != "" {
+		e.string(11, r.Name)
+	}
+	e.varint(12, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(13, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(14, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(15, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(16, v)
+	}
+	if r.Description != nil {
+		e.string(17, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(18, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(19, v)
+	}
+	if r.Purpose != nil {
+		e.string(20, *r.Purpose)
+	}
+	e.varint(21, r.Start.protoNumber())
+	if r.Profile != nil {
+		e.string(22, *r.Profile)
+	}
+	for _, v := range r.Link {
+		e.message(23, v)
+	}
+	if r.IdElement != nil {
+		e.message(24, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(25, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(26, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(27, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(28, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(29, *r.NameElement)
+	}
+	if r.StatusElement != nil {
+		e.message(30, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(31, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(32, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(33, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(34, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(35, *r.PurposeElement)
+	}
+	if r.StartElement != nil {
+		e.message(36, *r.StartElement)
+	}
+	if r.ProfileElement != nil {
+		e.message(37, *r.ProfileElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given GraphDefinition from protobuf message GraphDefinition of fhir.proto
+func (r *GraphDefinition) UnmarshalProto(b []byte) error {
+	*r = GraphDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			v := d.string()
+			r.Version = &v
+		case 11:
+			r.Name = d.string()
+		case 12:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 13:
+			v := d.bool()
+			r.Experimental = &v
+		case 14:
+			v := d.string()
+			r.Date = &v
+		case 15:
+			v := d.string()
+			r.Publisher = &v
+		case 16:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 17:
+			v := d.string()
+			r.Description = &v
+		case 18:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 20:
+			v := d.string()
+			r.Purpose = &v
+		case 21:
+			var v ResourceType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Start = v
+		case 22:
+			v := d.string()
+			r.Profile = &v
+		case 23:
+			var v GraphDefinitionLink
+			d.message(&v)
+			r.Link = append(r.Link, v)
+		case 24:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.StartElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.ProfileElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given GraphDefinitionLink as JSON into a byte slice
+func (r GraphDefinitionLink) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the GraphDefinitionLink as JSON object with the members in the order of the fields
+func (r GraphDefinitionLink) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Path != nil {
+		e.key("path")
+		e.string(*r.Path)
+	}
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	if r.SliceName != nil {
+		e.key("sliceName")
+		e.string(*r.SliceName)
+	}
+	if r.SliceNameElement != nil {
+		e.key("_sliceName")
+		r.SliceNameElement.appendJSON(e)
+	}
+	if r.Min != nil {
+		e.key("min")
+		e.int(*r.Min)
+	}
+	if r.MinElement != nil {
+		e.key("_min")
+		r.MinElement.appendJSON(e)
+	}
+	if r.Max != nil {
+		e.key("max")
+		e.string(*r.Max)
+	}
+	if r.MaxElement != nil {
+		e.key("_max")
+		r.MaxElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Target) > 0 {
+		e.key("target")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Target {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given GraphDefinitionLink from JSON. Unknown members are ignored.
+func (r *GraphDefinitionLink) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the GraphDefinitionLink
+func (r *GraphDefinitionLink) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = &v
+			} else {
+				r.Path = nil
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "sliceName":
+			if v, ok := d.string(); ok {
+				r.SliceName = &v
+			} else {
+				r.SliceName = nil
+			}
+		case "_sliceName":
+			r.SliceNameElement = d.element()
+		case "min":
+			if v, ok := d.int(); ok {
+				r.Min = &v
+			} else {
+				r.Min = nil
+			}
+		case "_min":
+			r.MinElement = d.element()
+		case "max":
+			if v, ok := d.string(); ok {
+				r.Max = &v
+			} else {
+				r.Max = nil
+			}
+		case "_max":
+			r.MaxElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "target":
+			a := d.array()
+			r.Target = nil
+			for a.next() {
+				var v GraphDefinitionLinkTarget
+				v.decodeJSON(d)
+				r.Target = append(r.Target, v)
+			}
+			if r.Target == nil && !a.isNull {
+				r.Target = []GraphDefinitionLinkTarget{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the GraphDefinitionLink which shares no memory with the original
+func (r GraphDefinitionLink) DeepCopy() GraphDefinitionLink {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Path != nil {
+		v := *r.Path
+		out.Path = &v
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	if r.SliceName != nil {
+		v := *r.SliceName
+		out.SliceName = &v
+	}
+	if r.SliceNameElement != nil {
+		v := r.SliceNameElement.DeepCopy()
+		out.SliceNameElement = &v
+	}
+	if r.Min != nil {
+		v := *r.Min
+		out.Min = &v
+	}
+	if r.MinElement != nil {
+		v := r.MinElement.DeepCopy()
+		out.MinElement = &v
+	}
+	if r.Max != nil {
+		v := *r.Max
+		out.Max = &v
+	}
+	if r.MaxElement != nil {
+		v := r.MaxElement.DeepCopy()
+		out.MaxElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Target != nil {
+		out.Target = make([]GraphDefinitionLinkTarget, len(r.Target))
+		for i := range r.Target {
+			out.Target[i] = r.Target[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r GraphDefinitionLink) Equal(other GraphDefinitionLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && *r.Path != *other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if (r.SliceName == nil) != (other.SliceName == nil) || r.SliceName != nil && *r.SliceName != *other.SliceName {
+		return false
+	}
+	if (r.SliceNameElement == nil) != (other.SliceNameElement == nil) || r.SliceNameElement != nil && !r.SliceNameElement.Equal(*other.SliceNameElement) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.Equal(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && *r.Max != *other.Max {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.Equal(*other.MaxElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Target) != len(other.Target) {
+		return false
+	}
+	for i := range r.Target {
+		if !r.Target[i].Equal(other.Target[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r GraphDefinitionLink) EqualsDeep(other GraphDefinitionLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if (r.SliceName == nil) != (other.SliceName == nil) || r.SliceName != nil && !equivalentString(*r.SliceName, *other.SliceName) {
+		return false
+	}
+	if (r.SliceNameElement == nil) != (other.SliceNameElement == nil) || r.SliceNameElement != nil && !r.SliceNameElement.EqualsDeep(*other.SliceNameElement) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.EqualsDeep(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.EqualsDeep(*other.MaxElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i].EqualsDeep(other.Target[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r GraphDefinitionLink) EqualsShallow(other GraphDefinitionLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.SliceName == nil) != (other.SliceName == nil) || r.SliceName != nil && !equivalentString(*r.SliceName, *other.SliceName) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given GraphDefinitionLink as FHIR XML
+func (r GraphDefinitionLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sliceName", r.SliceName, r.SliceNameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "min", r.Min, r.MinElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "max", r.Max, r.MaxElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Target {
+		if err := encodeXMLElement(e, "target", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given GraphDefinitionLink from FHIR XML
+func (r *GraphDefinitionLink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = &v
+				}
+				r.PathElement = element
+			case "sliceName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SliceName = &v
+				}
+				r.SliceNameElement = element
+			case "min":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Min = &v
+				}
+				r.MinElement = element
+			case "max":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Max = &v
+				}
+				r.MaxElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "target":
+				var v GraphDefinitionLinkTarget
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = append(r.Target, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the GraphDefinitionLink as properties to the RDF node n
+func (r GraphDefinitionLink) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("GraphDefinition.link.path", -1, r.Path, r.PathElement, "string")
+	n.primitive("GraphDefinition.link.sliceName", -1, r.SliceName, r.SliceNameElement, "string")
+	n.primitive("GraphDefinition.link.min", -1, r.Min, r.MinElement, "integer")
+	n.primitive("GraphDefinition.link.max", -1, r.Max, r.MaxElement, "string")
+	n.primitive("GraphDefinition.link.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Target {
+		n.element("GraphDefinition.link.target", i, v)
+	}
+}
+
+// MarshalBSON marshals the given GraphDefinitionLink as BSON document with the structure of its FHIR JSON
+func (r GraphDefinitionLink) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given GraphDefinitionLink from a BSON document with the structure of its FHIR JSON
+func (r *GraphDefinitionLink) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given GraphDefinitionLink as protobuf message GraphDefinitionLink of fhir.proto
+func (r GraphDefinitionLink) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the GraphDefinitionLink to the encoded message
+func (r GraphDefinitionLink) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Path != nil {
+		e.string(4, *r.Path)
+	}
+	if r.SliceName != nil {
+		e.string(5, *r.SliceName)
+	}
+	if r.Min != nil {
+		e.varint(6, uint64(int64(*r.Min)))
+	}
+	if r.Max != nil {
+		e.string(7, *r.Max)
+	}
+	if r.Description != nil {
+		e.string(8, *r.Description)
+	}
+	for _, v := range r.Target {
+		e.message(9, v)
+	}
+	if r.PathElement != nil {
+		e.message(10, *r.PathElement)
+	}
+	if r.SliceNameElement != nil {
+		e.message(11, *r.SliceNameElement)
+	}
+	if r.MinElement != nil {
+		e.message(12, *r.MinElement)
+	}
+	if r.MaxElement != nil {
+		e.message(13, *r.MaxElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(14, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given GraphDefinitionLink from protobuf message GraphDefinitionLink of fhir.proto
+func (r *GraphDefinitionLink) UnmarshalProto(b []byte) error {
+	*r = GraphDefinitionLink{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Path = &v
+		case 5:
+			v := d.string()
+			r.SliceName = &v
+		case 6:
+			v := d.int()
+			r.Min = &v
+		case 7:
+			v := d.string()
+			r.Max = &v
+		case 8:
+			v := d.string()
+			r.Description = &v
+		case 9:
+			var v GraphDefinitionLinkTarget
+			d.message(&v)
+			r.Target = append(r.Target, v)
+		case 10:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.SliceNameElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.MinElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.MaxElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given GraphDefinitionLinkTarget as JSON into a byte slice
+func (r GraphDefinitionLinkTarget) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the GraphDefinitionLinkTarget as JSON object with the members in the order of the fields
+func (r GraphDefinitionLinkTarget) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Params != nil {
+		e.key("params")
+		e.string(*r.Params)
+	}
+	if r.ParamsElement != nil {
+		e.key("_params")
+		r.ParamsElement.appendJSON(e)
+	}
+	if r.Profile != nil {
+		e.key("profile")
+		e.string(*r.Profile)
+	}
+	if r.ProfileElement != nil {
+		e.key("_profile")
+		r.ProfileElement.appendJSON(e)
+	}
+	if len(r.Compartment) > 0 {
+		e.key("compartment")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Compartment {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Link) > 0 {
+		e.key("link")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Link {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given GraphDefinitionLinkTarget from JSON. Unknown members are ignored.
+func (r *GraphDefinitionLinkTarget) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the GraphDefinitionLinkTarget
+func (r *GraphDefinitionLinkTarget) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "params":
+			if v, ok := d.string(); ok {
+				r.Params = &v
+			} else {
+				r.Params = nil
+			}
+		case "_params":
+			r.ParamsElement = d.element()
+		case "profile":
+			if v, ok := d.string(); ok {
+				r.Profile = &v
+			} else {
+				r.Profile = nil
+			}
+		case "_profile":
+			r.ProfileElement = d.element()
+		case "compartment":
+			a := d.array()
+			r.Compartment = nil
+			for a.next() {
+				var v GraphDefinitionLinkTargetCompartment
+				v.decodeJSON(d)
+				r.Compartment = append(r.Compartment, v)
+			}
+			if r.Compartment == nil && !a.isNull {
+				r.Compartment = []GraphDefinitionLinkTargetCompartment{}
+			}
+		case "link":
+			a := d.array()
+			r.Link = nil
+			for a.next() {
+				var v GraphDefinitionLink
+				v.decodeJSON(d)
+				r.Link = append(r.Link, v)
+			}
+			if r.Link == nil && !a.isNull {
+				r.Link = []GraphDefinitionLink{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the GraphDefinitionLinkTarget which shares no memory with the original
+func (r GraphDefinitionLinkTarget) DeepCopy() GraphDefinitionLinkTarget {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Params != nil {
+		v := *r.Params
+		out.Params = &v
+	}
+	if r.ParamsElement != nil {
+		v := r.ParamsElement.DeepCopy()
+		out.ParamsElement = &v
+	}
+	if r.Profile != nil {
+		v := *r.Profile
+		out.Profile = &v
+	}
+	if r.ProfileElement != nil {
+		v := r.ProfileElement.DeepCopy()
+		out.ProfileElement = &v
+	}
+	if r.Compartment != nil {
+		out.Compartment = make([]GraphDefinitionLinkTargetCompartment, len(r.Compartment))
+		for i := range r.Compartment {
+			out.Compartment[i] = r.Compartment[i].DeepCopy()
+		}
+	}
+	if r.Link != nil {
+		out.Link = make([]GraphDefinitionLink, len(r.Link))
+		for i := range r.Link {
+			out.Link[i] = r.Link[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r GraphDefinitionLinkTarget) Equal(other GraphDefinitionLinkTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Params == nil) != (other.Params == nil) || r.Params != nil && *r.Params != *other.Params {
+		return false
+	}
+	if (r.ParamsElement == nil) != (other.ParamsElement == nil) || r.ParamsElement != nil && !r.ParamsElement.Equal(*other.ParamsElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && *r.Profile != *other.Profile {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.Equal(*other.ProfileElement) {
+		return false
+	}
+	if len(r.Compartment) != len(other.Compartment) {
+		return false
+	}
+	for i := range r.Compartment {
+		if !r.Compartment[i].Equal(other.Compartment[i]) {
+			return false
+		}
+	}
+	if len(r.Link) != len(other.Link) {
+		return false
+	}
+	for i := range r.Link {
+		if !r.Link[i].Equal(other.Link[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r GraphDefinitionLinkTarget) EqualsDeep(other GraphDefinitionLinkTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Params == nil) != (other.Params == nil) || r.Params != nil && !equivalentString(*r.Params, *other.Params) {
+		return false
+	}
+	if (r.ParamsElement == nil) != (other.ParamsElement == nil) || r.ParamsElement != nil && !r.ParamsElement.EqualsDeep(*other.ParamsElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.EqualsDeep(*other.ProfileElement) {
+		return false
+	}
+	if !equivalentList(len(r.Compartment), len(other.Compartment), func(i, j int) bool {
+		return r.Compartment[i].EqualsDeep(other.Compartment[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Link), len(other.Link), func(i, j int) bool {
+		return r.Link[i].EqualsDeep(other.Link[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r GraphDefinitionLinkTarget) EqualsShallow(other GraphDefinitionLinkTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Params == nil) != (other.Params == nil) || r.Params != nil && !equivalentString(*r.Params, *other.Params) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given GraphDefinitionLinkTarget as FHIR XML
+func (r GraphDefinitionLinkTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "params", r.Params, r.ParamsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "profile", r.Profile, r.ProfileElement); err != nil {
+		return err
+	}
+	for _, v := range r.Compartment {
+		if err := encodeXMLElement(e, "compartment", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Link {
+		if err := encodeXMLElement(e, "link", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given GraphDefinitionLinkTarget from FHIR XML
+func (r *GraphDefinitionLinkTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "params":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Params = &v
+				}
+				r.ParamsElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Profile = &v
+				}
+				r.ProfileElement = element
+			case "compartment":
+				var v GraphDefinitionLinkTargetCompartment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Compartment = append(r.Compartment, v)
+			case "link":
+				var v GraphDefinitionLink
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Link = append(r.Link, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the GraphDefinitionLinkTarget as properties to the RDF node n
+func (r GraphDefinitionLinkTarget) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("GraphDefinition.link.target.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("GraphDefinition.link.target.params", -1, r.Params, r.ParamsElement, "string")
+	n.primitive("GraphDefinition.link.target.profile", -1, r.Profile, r.ProfileElement, "string")
+	for i, v := range r.Compartment {
+		n.element("GraphDefinition.link.target.compartment", i, v)
+	}
+	for i, v := range r.Link {
+		n.element("GraphDefinition.link.target.link", i, v)
+	}
+}
+
+// MarshalBSON marshals the given GraphDefinitionLinkTarget as BSON document with the structure of its FHIR JSON
+func (r GraphDefinitionLinkTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given GraphDefinitionLinkTarget from a BSON document with the structure of its FHIR JSON
+func (r *GraphDefinitionLinkTarget) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given GraphDefinitionLinkTarget as protobuf message GraphDefinitionLinkTarget of fhir.proto
+func (r GraphDefinitionLinkTarget) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the GraphDefinitionLinkTarget to the encoded message
+func (r GraphDefinitionLinkTarget) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Params != nil {
+		e.string(5, *r.Params)
+	}
+	if r.Profile != nil {
+		e.string(6, *r.Profile)
+	}
+	for _, v := range r.Compartment {
+		e.message(7, v)
+	}
+	for _, v := range r.Link {
+		e.message(8, v)
+	}
+	if r.TypeElement != nil {
+		e.message(9, *r.TypeElement)
+	}
+	if r.ParamsElement != nil {
+		e.message(10, *r.ParamsElement)
+	}
+	if r.ProfileElement != nil {
+		e.message(11, *r.ProfileElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given GraphDefinitionLinkTarget from protobuf message GraphDefinitionLinkTarget of fhir.proto
+func (r *GraphDefinitionLinkTarget) UnmarshalProto(b []byte) error {
+	*r = GraphDefinitionLinkTarget{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ResourceType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			v := d.string()
+			r.Params = &v
+		case 6:
+			v := d.string()
+			r.Profile = &v
+		case 7:
+			var v GraphDefinitionLinkTargetCompartment
+			d.message(&v)
+			r.Compartment = append(r.Compartment, v)
+		case 8:
+			var v GraphDefinitionLink
+			d.message(&v)
+			r.Link = append(r.Link, v)
+		case 9:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ParamsElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ProfileElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given GraphDefinitionLinkTargetCompartment as JSON into a byte slice
+func (r GraphDefinitionLinkTargetCompartment) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the GraphDefinitionLinkTargetCompartment as JSON object with the members in the order of the fields
+func (r GraphDefinitionLinkTargetCompartment) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("use")
+	e.string(r.Use.Code())
+	if r.UseElement != nil {
+		e.key("_use")
+		r.UseElement.appendJSON(e)
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	e.key("rule")
+	e.string(r.Rule.Code())
+	if r.RuleElement != nil {
+		e.key("_rule")
+		r.RuleElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		e.string(*r.Expression)
+	}
+	if r.ExpressionElement != nil {
+		e.key("_expression")
+		r.ExpressionElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given GraphDefinitionLinkTargetCompartment from JSON. Unknown members are ignored.
+func (r *GraphDefinitionLinkTargetCompartment) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the GraphDefinitionLinkTargetCompartment
+func (r *GraphDefinitionLinkTargetCompartment) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "use":
+			d.unmarshal(&r.Use)
+		case "_use":
+			r.UseElement = d.element()
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "rule":
+			d.unmarshal(&r.Rule)
+		case "_rule":
+			r.RuleElement = d.element()
+		case "expression":
+			if v, ok := d.string(); ok {
+				r.Expression = &v
+			} else {
+				r.Expression = nil
+			}
+		case "_expression":
+			r.ExpressionElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the GraphDefinitionLinkTargetCompartment which shares no memory with the original
+func (r GraphDefinitionLinkTargetCompartment) DeepCopy() GraphDefinitionLinkTargetCompartment {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UseElement != nil {
+		v := r.UseElement.DeepCopy()
+		out.UseElement = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.RuleElement != nil {
+		v := r.RuleElement.DeepCopy()
+		out.RuleElement = &v
+	}
+	if r.Expression != nil {
+		v := *r.Expression
+		out.Expression = &v
+	}
+	if r.ExpressionElement != nil {
+		v := r.ExpressionElement.DeepCopy()
+		out.ExpressionElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r GraphDefinitionLinkTargetCompartment) Equal(other GraphDefinitionLinkTargetCompartment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.Equal(*other.UseElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if r.Rule != other.Rule {
+		return false
+	}
+	if (r.RuleElement == nil) != (other.RuleElement == nil) || r.RuleElement != nil && !r.RuleElement.Equal(*other.RuleElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && *r.Expression != *other.Expression {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.Equal(*other.ExpressionElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r GraphDefinitionLinkTargetCompartment) EqualsDeep(other GraphDefinitionLinkTargetCompartment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.EqualsDeep(*other.UseElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if r.Rule != other.Rule {
+		return false
+	}
+	if (r.RuleElement == nil) != (other.RuleElement == nil) || r.RuleElement != nil && !r.RuleElement.EqualsDeep(*other.RuleElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.EqualsDeep(*other.ExpressionElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r GraphDefinitionLinkTargetCompartment) EqualsShallow(other GraphDefinitionLinkTargetCompartment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if r.Rule != other.Rule {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given GraphDefinitionLinkTargetCompartment as FHIR XML
+func (r GraphDefinitionLinkTargetCompartment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "use", r.Use, r.UseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "rule", r.Rule, r.RuleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expression", r.Expression, r.ExpressionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given GraphDefinitionLinkTargetCompartment from FHIR XML
+func (r *GraphDefinitionLinkTargetCompartment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "use":
+				var v GraphCompartmentUse
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Use = v
+				}
+				r.UseElement = element
+			case "code":
+				var v CompartmentType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "rule":
+				var v GraphCompartmentRule
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Rule = v
+				}
+				r.RuleElement = element
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Expression = &v
+				}
+				r.ExpressionElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the GraphDefinitionLinkTargetCompartment as properties to the RDF node n
+func (r GraphDefinitionLinkTargetCompartment) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("GraphDefinition.link.target.compartment.use", -1, r.Use, r.UseElement, "code")
+	n.primitive("GraphDefinition.link.target.compartment.code", -1, r.Code, r.CodeElement, "code")
+	n.primitive("GraphDefinition.link.target.compartment.rule", -1, r.Rule, r.RuleElement, "code")
+	n.primitive("GraphDefinition.link.target.compartment.expression", -1, r.Expression, r.ExpressionElement, "string")
+	n.primitive("GraphDefinition.link.target.compartment.description", -1, r.Description, r.DescriptionElement, "string")
+}
+
+// MarshalBSON marshals the given GraphDefinitionLinkTargetCompartment as BSON document with the structure of its FHIR JSON
+func (r GraphDefinitionLinkTargetCompartment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given GraphDefinitionLinkTargetCompartment from a BSON document with the structure of its FHIR JSON
+func (r *GraphDefinitionLinkTargetCompartment) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given GraphDefinitionLinkTargetCompartment as protobuf message GraphDefinitionLinkTargetCompartment of fhir.proto
+func (r GraphDefinitionLinkTargetCompartment) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the GraphDefinitionLinkTargetCompartment to the encoded message
+func (r GraphDefinitionLinkTargetCompartment) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Use.protoNumber())
+	e.varint(5, r.Code.protoNumber())
+	e.varint(6, r.Rule.protoNumber())
+	if r.Expression != nil {
+		e.string(7, *r.Expression)
+	}
+	if r.Description != nil {
+		e.string(8, *r.Description)
+	}
+	if r.UseElement != nil {
+		e.message(9, *r.UseElement)
+	}
+	if r.CodeElement != nil {
+		e.message(10, *r.CodeElement)
+	}
+	if r.RuleElement != nil {
+		e.message(11, *r.RuleElement)
+	}
+	if r.ExpressionElement != nil {
+		e.message(12, *r.ExpressionElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(13, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given GraphDefinitionLinkTargetCompartment from protobuf message GraphDefinitionLinkTargetCompartment of fhir.proto
+func (r *GraphDefinitionLinkTargetCompartment) UnmarshalProto(b []byte) error {
+	*r = GraphDefinitionLinkTargetCompartment{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v GraphCompartmentUse
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Use = v
+		case 5:
+			var v CompartmentType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 6:
+			var v GraphCompartmentRule
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Rule = v
+		case 7:
+			v := d.string()
+			r.Expression = &v
+		case 8:
+			v := d.string()
+			r.Description = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.UseElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.RuleElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ExpressionElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalGraphDefinition unmarshals a GraphDefinition.
 func UnmarshalGraphDefinition(b []byte) (GraphDefinition, error) {
 	var graphDefinition GraphDefinition
-	if err := json.Unmarshal(b, &graphDefinition); err != nil {
+	if err := graphDefinition.UnmarshalJSON(b); err != nil {
 		return graphDefinition, err
 	}
 	return graphDefinition, nil
@@ -14,44 +14,122 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Group is documented here http://hl7.org/fhir/StructureDefinition/Group
 type Group struct {
-	Id                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool                 `bson:"active,omitempty" json:"active,omitempty"`
-	Type              GroupType             `bson:"type" json:"type"`
-	Actual            bool                  `bson:"actual" json:"actual"`
-	Code              *CodeableConcept      `bson:"code,omitempty" json:"code,omitempty"`
-	Name              *string               `bson:"name,omitempty" json:"name,omitempty"`
-	Quantity          *int                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	ManagingEntity    *Reference            `bson:"managingEntity,omitempty" json:"managingEntity,omitempty"`
-	Characteristic    []GroupCharacteristic `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
-	Member            []GroupMember         `bson:"member,omitempty" json:"member,omitempty"`
+	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element              `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool                 `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element              `bson:"_active,omitempty" json:"_active,omitempty"`
+	Type                 GroupType             `bson:"type" json:"type"`
+	TypeElement          *Element              `bson:"_type,omitempty" json:"_type,omitempty"`
+	Actual               bool                  `bson:"actual" json:"actual"`
+	ActualElement        *Element              `bson:"_actual,omitempty" json:"_actual,omitempty"`
+	Code                 *CodeableConcept      `bson:"code,omitempty" json:"code,omitempty"`
+	Name                 *string               `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element              `bson:"_name,omitempty" json:"_name,omitempty"`
+	Quantity             *int                  `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	QuantityElement      *Element              `bson:"_quantity,omitempty" json:"_quantity,omitempty"`
+	ManagingEntity       *Reference            `bson:"managingEntity,omitempty" json:"managingEntity,omitempty"`
+	Characteristic       []GroupCharacteristic `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
+	Member               []GroupMember         `bson:"member,omitempty" json:"member,omitempty"`
 }
 type GroupCharacteristic struct {
-	Id                   *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code                 CodeableConcept `bson:"code" json:"code"`
-	ValueCodeableConcept CodeableConcept `bson:"valueCodeableConcept" json:"valueCodeableConcept"`
-	ValueBoolean         bool            `bson:"valueBoolean" json:"valueBoolean"`
-	ValueQuantity        Quantity        `bson:"valueQuantity" json:"valueQuantity"`
-	ValueRange           Range           `bson:"valueRange" json:"valueRange"`
-	ValueReference       Reference       `bson:"valueReference" json:"valueReference"`
-	Exclude              bool            `bson:"exclude" json:"exclude"`
-	Period               *Period         `bson:"period,omitempty" json:"period,omitempty"`
+	Id                   *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 CodeableConcept  `bson:"code" json:"code"`
+	ValueCodeableConcept *CodeableConcept `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueBoolean         *bool            `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element         `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueQuantity        *Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange           *Range           `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueReference       *Reference       `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	Exclude              bool             `bson:"exclude" json:"exclude"`
+	ExcludeElement       *Element         `bson:"_exclude,omitempty" json:"_exclude,omitempty"`
+	Period               *Period          `bson:"period,omitempty" json:"period,omitempty"`
 }
+
+// GroupCharacteristicValue is implemented by the types allowed for Group.characteristic.value[x]
+type GroupCharacteristicValue interface {
+	isGroupCharacteristicValue()
+}
+
+func (CodeableConcept) isGroupCharacteristicValue() {}
+func (Boolean) isGroupCharacteristicValue()         {}
+func (Quantity) isGroupCharacteristicValue()        {}
+func (Range) isGroupCharacteristicValue()           {}
+func (Reference) isGroupCharacteristicValue()       {}
+
+// Value returns the value of Group.characteristic.value[x] together with its FHIR type code or nil if no value is set
+func (r GroupCharacteristic) Value() (GroupCharacteristicValue, string) {
+	if r.ValueCodeableConcept != nil {
+		return *r.ValueCodeableConcept, "CodeableConcept"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueRange != nil {
+		return *r.ValueRange, "Range"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Group.characteristic.value[x], given as value or pointer, and clears all other types
+func (r *GroupCharacteristic) SetValue(value GroupCharacteristicValue) {
+	r.ValueCodeableConcept = nil
+	r.ValueBoolean = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.ValueCodeableConcept = v
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Range:
+		r.ValueRange = &v
+	case *Range:
+		r.ValueRange = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	}
+}
+
 type GroupMember struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -59,24 +137,2206 @@ type GroupMember struct {
 	Entity            Reference   `bson:"entity" json:"entity"`
 	Period            *Period     `bson:"period,omitempty" json:"period,omitempty"`
 	Inactive          *bool       `bson:"inactive,omitempty" json:"inactive,omitempty"`
+	InactiveElement   *Element    `bson:"_inactive,omitempty" json:"_inactive,omitempty"`
 }
-type OtherGroup Group
 
 // MarshalJSON marshals the given Group as JSON into a byte slice
 func (r Group) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherGroup
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherGroup:   OtherGroup(r),
-		ResourceType: "Group",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Group as JSON object with the members in the order of the fields
+func (r Group) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Group\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Active != nil {
+		e.key("active")
+		e.bool(*r.Active)
+	}
+	if r.ActiveElement != nil {
+		e.key("_active")
+		r.ActiveElement.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("actual")
+	e.bool(r.Actual)
+	if r.ActualElement != nil {
+		e.key("_actual")
+		r.ActualElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		e.int(*r.Quantity)
+	}
+	if r.QuantityElement != nil {
+		e.key("_quantity")
+		r.QuantityElement.appendJSON(e)
+	}
+	if r.ManagingEntity != nil {
+		e.key("managingEntity")
+		r.ManagingEntity.appendJSON(e)
+	}
+	if len(r.Characteristic) > 0 {
+		e.key("characteristic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Characteristic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Member) > 0 {
+		e.key("member")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Member {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Group from JSON. Unknown members are ignored.
+func (r *Group) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Group
+func (r *Group) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "active":
+			if v, ok := d.bool(); ok {
+				r.Active = &v
+			} else {
+				r.Active = nil
+			}
+		case "_active":
+			r.ActiveElement = d.element()
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "actual":
+			if v, ok := d.bool(); ok {
+				r.Actual = v
+			}
+		case "_actual":
+			r.ActualElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "quantity":
+			if v, ok := d.int(); ok {
+				r.Quantity = &v
+			} else {
+				r.Quantity = nil
+			}
+		case "_quantity":
+			r.QuantityElement = d.element()
+		case "managingEntity":
+			if d.null() {
+				r.ManagingEntity = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ManagingEntity = &v
+			}
+		case "characteristic":
+			a := d.array()
+			r.Characteristic = nil
+			for a.next() {
+				var v GroupCharacteristic
+				v.decodeJSON(d)
+				r.Characteristic = append(r.Characteristic, v)
+			}
+			if r.Characteristic == nil && !a.isNull {
+				r.Characteristic = []GroupCharacteristic{}
+			}
+		case "member":
+			a := d.array()
+			r.Member = nil
+			for a.next() {
+				var v GroupMember
+				v.decodeJSON(d)
+				r.Member = append(r.Member, v)
+			}
+			if r.Member == nil && !a.isNull {
+				r.Member = []GroupMember{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Group which shares no memory with the original
+func (r Group) DeepCopy() Group {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Active != nil {
+		v := *r.Active
+		out.Active = &v
+	}
+	if r.ActiveElement != nil {
+		v := r.ActiveElement.DeepCopy()
+		out.ActiveElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.ActualElement != nil {
+		v := r.ActualElement.DeepCopy()
+		out.ActualElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Quantity != nil {
+		v := *r.Quantity
+		out.Quantity = &v
+	}
+	if r.QuantityElement != nil {
+		v := r.QuantityElement.DeepCopy()
+		out.QuantityElement = &v
+	}
+	if r.ManagingEntity != nil {
+		v := r.ManagingEntity.DeepCopy()
+		out.ManagingEntity = &v
+	}
+	if r.Characteristic != nil {
+		out.Characteristic = make([]GroupCharacteristic, len(r.Characteristic))
+		for i := range r.Characteristic {
+			out.Characteristic[i] = r.Characteristic[i].DeepCopy()
+		}
+	}
+	if r.Member != nil {
+		out.Member = make([]GroupMember, len(r.Member))
+		for i := range r.Member {
+			out.Member[i] = r.Member[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Group) Equal(other Group) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.Equal(*other.ActiveElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.Actual != other.Actual {
+		return false
+	}
+	if (r.ActualElement == nil) != (other.ActualElement == nil) || r.ActualElement != nil && !r.ActualElement.Equal(*other.ActualElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && *r.Quantity != *other.Quantity {
+		return false
+	}
+	if (r.QuantityElement == nil) != (other.QuantityElement == nil) || r.QuantityElement != nil && !r.QuantityElement.Equal(*other.QuantityElement) {
+		return false
+	}
+	if (r.ManagingEntity == nil) != (other.ManagingEntity == nil) || r.ManagingEntity != nil && !r.ManagingEntity.Equal(*other.ManagingEntity) {
+		return false
+	}
+	if len(r.Characteristic) != len(other.Characteristic) {
+		return false
+	}
+	for i := range r.Characteristic {
+		if !r.Characteristic[i].Equal(other.Characteristic[i]) {
+			return false
+		}
+	}
+	if len(r.Member) != len(other.Member) {
+		return false
+	}
+	for i := range r.Member {
+		if !r.Member[i].Equal(other.Member[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Group) EqualsDeep(other Group) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.EqualsDeep(*other.ActiveElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if r.Actual != other.Actual {
+		return false
+	}
+	if (r.ActualElement == nil) != (other.ActualElement == nil) || r.ActualElement != nil && !r.ActualElement.EqualsDeep(*other.ActualElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && *r.Quantity != *other.Quantity {
+		return false
+	}
+	if (r.QuantityElement == nil) != (other.QuantityElement == nil) || r.QuantityElement != nil && !r.QuantityElement.EqualsDeep(*other.QuantityElement) {
+		return false
+	}
+	if (r.ManagingEntity == nil) != (other.ManagingEntity == nil) || r.ManagingEntity != nil && !r.ManagingEntity.EqualsDeep(*other.ManagingEntity) {
+		return false
+	}
+	if !equivalentList(len(r.Characteristic), len(other.Characteristic), func(i, j int) bool {
+		return r.Characteristic[i].EqualsDeep(other.Characteristic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Member), len(other.Member), func(i, j int) bool {
+		return r.Member[i].EqualsDeep(other.Member[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Group) EqualsShallow(other Group) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if r.Actual != other.Actual {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && *r.Quantity != *other.Quantity {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Group as FHIR XML
+func (r Group) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Group",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "active", r.Active, r.ActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "actual", r.Actual, r.ActualElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "quantity", r.Quantity, r.QuantityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "managingEntity", r.ManagingEntity); err != nil {
+		return err
+	}
+	for _, v := range r.Characteristic {
+		if err := encodeXMLElement(e, "characteristic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Member {
+		if err := encodeXMLElement(e, "member", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Group from FHIR XML
+func (r *Group) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "active":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Active = &v
+				}
+				r.ActiveElement = element
+			case "type":
+				var v GroupType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "actual":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Actual = v
+				}
+				r.ActualElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "quantity":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Quantity = &v
+				}
+				r.QuantityElement = element
+			case "managingEntity":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManagingEntity = &v
+			case "characteristic":
+				var v GroupCharacteristic
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Characteristic = append(r.Characteristic, v)
+			case "member":
+				var v GroupMember
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Member = append(r.Member, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Group as properties to the RDF node n
+func (r Group) turtle(n *turtleNode) {
+	n.resource("Group", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Group.identifier", i, v)
+	}
+	n.primitive("Group.active", -1, r.Active, r.ActiveElement, "boolean")
+	n.primitive("Group.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("Group.actual", -1, r.Actual, r.ActualElement, "boolean")
+	n.element("Group.code", -1, r.Code)
+	n.primitive("Group.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("Group.quantity", -1, r.Quantity, r.QuantityElement, "unsignedInt")
+	n.element("Group.managingEntity", -1, r.ManagingEntity)
+	for i, v := range r.Characteristic {
+		n.element("Group.characteristic", i, v)
+	}
+	for i, v := range r.Member {
+		n.element("Group.member", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Group as BSON document with the structure of its FHIR JSON
+func (r Group) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Group from a BSON document with the structure of its FHIR JSON
+func (r *Group) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Group as protobuf message Group of fhir.proto
+func (r Group) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Group to the encoded message
+func (r Group) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Active != nil {
+		e.varint(10, protoBool(*r.Active))
+	}
+	e.varint(11, r.Type.protoNumber())
+	if r.Actual {
+		e.varint(12, protoBool(r.Actual))
+	}
+	if r.Code != nil {
+		e.message(13, *r.Code)
+	}
+	if r.Name != nil {
+		e.string(14, *r.Name)
+	}
+	if r.Quantity != nil {
+		e.varint(15, uint64(int64(*r.Quantity)))
+	}
+	if r.ManagingEntity != nil {
+		e.message(16, *r.ManagingEntity)
+	}
+	for _, v := range r.Characteristic {
+		e.message(17, v)
+	}
+	for _, v := range r.Member {
+		e.message(18, v)
+	}
+	if r.IdElement != nil {
+		e.message(19, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(20, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(21, *r.LanguageElement)
+	}
+	if r.ActiveElement != nil {
+		e.message(22, *r.ActiveElement)
+	}
+	if r.TypeElement != nil {
+		e.message(23, *r.TypeElement)
+	}
+	if r.ActualElement != nil {
+		e.message(24, *r.ActualElement)
+	}
+	if r.NameElement != nil {
+		e.message(25, *r.NameElement)
+	}
+	if r.QuantityElement != nil {
+		e.message(26, *r.QuantityElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Group from protobuf message Group of fhir.proto
+func (r *Group) UnmarshalProto(b []byte) error {
+	*r = Group{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.bool()
+			r.Active = &v
+		case 11:
+			var v GroupType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 12:
+			r.Actual = d.bool()
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 14:
+			v := d.string()
+			r.Name = &v
+		case 15:
+			v := d.int()
+			r.Quantity = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.ManagingEntity = &v
+		case 17:
+			var v GroupCharacteristic
+			d.message(&v)
+			r.Characteristic = append(r.Characteristic, v)
+		case 18:
+			var v GroupMember
+			d.message(&v)
+			r.Member = append(r.Member, v)
+		case 19:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ActiveElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.ActualElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.QuantityElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given GroupCharacteristic as JSON into a byte slice
+func (r GroupCharacteristic) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the GroupCharacteristic as JSON object with the members in the order of the fields
+func (r GroupCharacteristic) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if r.ValueCodeableConcept != nil {
+		e.key("valueCodeableConcept")
+		r.ValueCodeableConcept.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueRange != nil {
+		e.key("valueRange")
+		r.ValueRange.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	e.key("exclude")
+	e.bool(r.Exclude)
+	if r.ExcludeElement != nil {
+		e.key("_exclude")
+		r.ExcludeElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given GroupCharacteristic from JSON. Unknown members are ignored.
+func (r *GroupCharacteristic) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the GroupCharacteristic
+func (r *GroupCharacteristic) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "valueCodeableConcept":
+			if d.null() {
+				r.ValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCodeableConcept = &v
+			}
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueRange":
+			if d.null() {
+				r.ValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.ValueRange = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		case "exclude":
+			if v, ok := d.bool(); ok {
+				r.Exclude = v
+			}
+		case "_exclude":
+			r.ExcludeElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the GroupCharacteristic which shares no memory with the original
+func (r GroupCharacteristic) DeepCopy() GroupCharacteristic {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.ValueCodeableConcept != nil {
+		v := r.ValueCodeableConcept.DeepCopy()
+		out.ValueCodeableConcept = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueRange != nil {
+		v := r.ValueRange.DeepCopy()
+		out.ValueRange = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	if r.ExcludeElement != nil {
+		v := r.ExcludeElement.DeepCopy()
+		out.ExcludeElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r GroupCharacteristic) Equal(other GroupCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.Equal(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.Equal(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	if r.Exclude != other.Exclude {
+		return false
+	}
+	if (r.ExcludeElement == nil) != (other.ExcludeElement == nil) || r.ExcludeElement != nil && !r.ExcludeElement.Equal(*other.ExcludeElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r GroupCharacteristic) EqualsDeep(other GroupCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.EqualsDeep(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.EqualsDeep(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	if r.Exclude != other.Exclude {
+		return false
+	}
+	if (r.ExcludeElement == nil) != (other.ExcludeElement == nil) || r.ExcludeElement != nil && !r.ExcludeElement.EqualsDeep(*other.ExcludeElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r GroupCharacteristic) EqualsShallow(other GroupCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if r.Exclude != other.Exclude {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given GroupCharacteristic as FHIR XML
+func (r GroupCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCodeableConcept", r.ValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRange", r.ValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "exclude", r.Exclude, r.ExcludeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given GroupCharacteristic from FHIR XML
+func (r *GroupCharacteristic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "valueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCodeableConcept = &v
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRange = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			case "exclude":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Exclude = v
+				}
+				r.ExcludeElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the GroupCharacteristic as properties to the RDF node n
+func (r GroupCharacteristic) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Group.characteristic.code", -1, r.Code)
+	n.element("Group.characteristic.valueCodeableConcept", -1, r.ValueCodeableConcept)
+	n.primitive("Group.characteristic.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.element("Group.characteristic.valueQuantity", -1, r.ValueQuantity)
+	n.element("Group.characteristic.valueRange", -1, r.ValueRange)
+	n.element("Group.characteristic.valueReference", -1, r.ValueReference)
+	n.primitive("Group.characteristic.exclude", -1, r.Exclude, r.ExcludeElement, "boolean")
+	n.element("Group.characteristic.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given GroupCharacteristic as BSON document with the structure of its FHIR JSON
+func (r GroupCharacteristic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given GroupCharacteristic from a BSON document with the structure of its FHIR JSON
+func (r *GroupCharacteristic) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given GroupCharacteristic as protobuf message GroupCharacteristic of fhir.proto
+func (r GroupCharacteristic) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the GroupCharacteristic to the encoded message
+func (r GroupCharacteristic) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	if r.ValueCodeableConcept != nil {
+		e.message(5, *r.ValueCodeableConcept)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(6, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueQuantity != nil {
+		e.message(7, *r.ValueQuantity)
+	}
+	if r.ValueRange != nil {
+		e.message(8, *r.ValueRange)
+	}
+	if r.ValueReference != nil {
+		e.message(9, *r.ValueReference)
+	}
+	if r.Exclude {
+		e.varint(10, protoBool(r.Exclude))
+	}
+	if r.Period != nil {
+		e.message(11, *r.Period)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(12, *r.ValueBooleanElement)
+	}
+	if r.ExcludeElement != nil {
+		e.message(13, *r.ExcludeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given GroupCharacteristic from protobuf message GroupCharacteristic of fhir.proto
+func (r *GroupCharacteristic) UnmarshalProto(b []byte) error {
+	*r = GroupCharacteristic{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCodeableConcept = &v
+		case 6:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 7:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 8:
+			var v Range
+			d.message(&v)
+			r.ValueRange = &v
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 10:
+			r.Exclude = d.bool()
+		case 11:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.ExcludeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given GroupMember as JSON into a byte slice
+func (r GroupMember) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the GroupMember as JSON object with the members in the order of the fields
+func (r GroupMember) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("entity")
+	r.Entity.appendJSON(e)
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.Inactive != nil {
+		e.key("inactive")
+		e.bool(*r.Inactive)
+	}
+	if r.InactiveElement != nil {
+		e.key("_inactive")
+		r.InactiveElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given GroupMember from JSON. Unknown members are ignored.
+func (r *GroupMember) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the GroupMember
+func (r *GroupMember) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "entity":
+			r.Entity.decodeJSON(d)
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "inactive":
+			if v, ok := d.bool(); ok {
+				r.Inactive = &v
+			} else {
+				r.Inactive = nil
+			}
+		case "_inactive":
+			r.InactiveElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the GroupMember which shares no memory with the original
+func (r GroupMember) DeepCopy() GroupMember {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Entity = r.Entity.DeepCopy()
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Inactive != nil {
+		v := *r.Inactive
+		out.Inactive = &v
+	}
+	if r.InactiveElement != nil {
+		v := r.InactiveElement.DeepCopy()
+		out.InactiveElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r GroupMember) Equal(other GroupMember) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Entity.Equal(other.Entity) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.Inactive == nil) != (other.Inactive == nil) || r.Inactive != nil && *r.Inactive != *other.Inactive {
+		return false
+	}
+	if (r.InactiveElement == nil) != (other.InactiveElement == nil) || r.InactiveElement != nil && !r.InactiveElement.Equal(*other.InactiveElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r GroupMember) EqualsDeep(other GroupMember) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Entity.EqualsDeep(other.Entity) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.Inactive == nil) != (other.Inactive == nil) || r.Inactive != nil && *r.Inactive != *other.Inactive {
+		return false
+	}
+	if (r.InactiveElement == nil) != (other.InactiveElement == nil) || r.InactiveElement != nil && !r.InactiveElement.EqualsDeep(*other.InactiveElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r GroupMember) EqualsShallow(other GroupMember) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Inactive == nil) != (other.Inactive == nil) || r.Inactive != nil && *r.Inactive != *other.Inactive {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given GroupMember as FHIR XML
+func (r GroupMember) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "entity", r.Entity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "inactive", r.Inactive, r.InactiveElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given GroupMember from FHIR XML
+func (r *GroupMember) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "entity":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Entity = v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "inactive":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Inactive = &v
+				}
+				r.InactiveElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the GroupMember as properties to the RDF node n
+func (r GroupMember) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Group.member.entity", -1, r.Entity)
+	n.element("Group.member.period", -1, r.Period)
+	n.primitive("Group.member.inactive", -1, r.Inactive, r.InactiveElement, "boolean")
+}
+
+// MarshalBSON marshals the given GroupMember as BSON document with the structure of its FHIR JSON
+func (r GroupMember) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given GroupMember from a BSON document with the structure of its FHIR JSON
+func (r *GroupMember) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given GroupMember as protobuf message GroupMember of fhir.proto
+func (r GroupMember) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the GroupMember to the encoded message
+func (r GroupMember) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Entity)
+	if r.Period != nil {
+		e.message(5, *r.Period)
+	}
+	if r.Inactive != nil {
+		e.varint(6, protoBool(*r.Inactive))
+	}
+	if r.InactiveElement != nil {
+		e.message(7, *r.InactiveElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given GroupMember from protobuf message GroupMember of fhir.proto
+func (r *GroupMember) UnmarshalProto(b []byte) error {
+	*r = GroupMember{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Entity = v
+		case 5:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 6:
+			v := d.bool()
+			r.Inactive = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.InactiveElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalGroup unmarshals a Group.
 func UnmarshalGroup(b []byte) (Group, error) {
 	var group Group
-	if err := json.Unmarshal(b, &group); err != nil {
+	if err := group.UnmarshalJSON(b); err != nil {
 		return group, err
 	}
 	return group, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code GroupMeasure) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *GroupMeasure) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *GroupMeasure) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "mean":
 		*code = GroupMeasureMean
@@ -58,6 +62,12 @@ func (code *GroupMeasure) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code GroupMeasure) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *GroupMeasure) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code GroupMeasure) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code GroupMeasure) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code GroupMeasure) protoNumber() uint64 {
+	switch code {
+	case GroupMeasureMean:
+		return 1
+	case GroupMeasureMedian:
+		return 2
+	case GroupMeasureMeanOfMean:
+		return 3
+	case GroupMeasureMeanOfMedian:
+		return 4
+	case GroupMeasureMedianOfMean:
+		return 5
+	case GroupMeasureMedianOfMedian:
+		return 6
+	}
+	return 0
+}
+func (code *GroupMeasure) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = GroupMeasureMean
+	case 2:
+		*code = GroupMeasureMedian
+	case 3:
+		*code = GroupMeasureMeanOfMean
+	case 4:
+		*code = GroupMeasureMeanOfMedian
+	case 5:
+		*code = GroupMeasureMedianOfMean
+	case 6:
+		*code = GroupMeasureMedianOfMedian
+	default:
+		return false
+	}
+	return true
+}
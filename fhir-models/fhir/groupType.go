@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code GroupType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *GroupType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *GroupType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "person":
 		*code = GroupTypePerson
@@ -58,6 +62,12 @@ func (code *GroupType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code GroupType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *GroupType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code GroupType) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code GroupType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code GroupType) protoNumber() uint64 {
+	switch code {
+	case GroupTypePerson:
+		return 1
+	case GroupTypeAnimal:
+		return 2
+	case GroupTypePractitioner:
+		return 3
+	case GroupTypeDevice:
+		return 4
+	case GroupTypeMedication:
+		return 5
+	case GroupTypeSubstance:
+		return 6
+	}
+	return 0
+}
+func (code *GroupType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = GroupTypePerson
+	case 2:
+		*code = GroupTypeAnimal
+	case 3:
+		*code = GroupTypePractitioner
+	case 4:
+		*code = GroupTypeDevice
+	case 5:
+		*code = GroupTypeMedication
+	case 6:
+		*code = GroupTypeSubstance
+	default:
+		return false
+	}
+	return true
+}
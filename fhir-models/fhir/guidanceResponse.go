@@ -14,55 +14,1566 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // GuidanceResponse is documented here http://hl7.org/fhir/StructureDefinition/GuidanceResponse
 type GuidanceResponse struct {
-	Id                    *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	RequestIdentifier     *Identifier            `bson:"requestIdentifier,omitempty" json:"requestIdentifier,omitempty"`
-	Identifier            []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	ModuleUri             string                 `bson:"moduleUri" json:"moduleUri"`
-	ModuleCanonical       string                 `bson:"moduleCanonical" json:"moduleCanonical"`
-	ModuleCodeableConcept CodeableConcept        `bson:"moduleCodeableConcept" json:"moduleCodeableConcept"`
-	Status                GuidanceResponseStatus `bson:"status" json:"status"`
-	Subject               *Reference             `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter             *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	OccurrenceDateTime    *string                `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
-	Performer             *Reference             `bson:"performer,omitempty" json:"performer,omitempty"`
-	ReasonCode            []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note                  []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
-	EvaluationMessage     []Reference            `bson:"evaluationMessage,omitempty" json:"evaluationMessage,omitempty"`
-	OutputParameters      *Reference             `bson:"outputParameters,omitempty" json:"outputParameters,omitempty"`
-	Result                *Reference             `bson:"result,omitempty" json:"result,omitempty"`
-	DataRequirement       []DataRequirement      `bson:"dataRequirement,omitempty" json:"dataRequirement,omitempty"`
-}
-type OtherGuidanceResponse GuidanceResponse
+	Id                        *string                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element               `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                      *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	RequestIdentifier         *Identifier            `bson:"requestIdentifier,omitempty" json:"requestIdentifier,omitempty"`
+	Identifier                []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	ModuleUri                 *string                `bson:"moduleUri,omitempty" json:"moduleUri,omitempty"`
+	ModuleUriElement          *Element               `bson:"_moduleUri,omitempty" json:"_moduleUri,omitempty"`
+	ModuleCanonical           *string                `bson:"moduleCanonical,omitempty" json:"moduleCanonical,omitempty"`
+	ModuleCanonicalElement    *Element               `bson:"_moduleCanonical,omitempty" json:"_moduleCanonical,omitempty"`
+	ModuleCodeableConcept     *CodeableConcept       `bson:"moduleCodeableConcept,omitempty" json:"moduleCodeableConcept,omitempty"`
+	Status                    GuidanceResponseStatus `bson:"status" json:"status"`
+	StatusElement             *Element               `bson:"_status,omitempty" json:"_status,omitempty"`
+	Subject                   *Reference             `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter                 *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime        *string                `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element               `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	Performer                 *Reference             `bson:"performer,omitempty" json:"performer,omitempty"`
+	ReasonCode                []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                      []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
+	EvaluationMessage         []Reference            `bson:"evaluationMessage,omitempty" json:"evaluationMessage,omitempty"`
+	OutputParameters          *Reference             `bson:"outputParameters,omitempty" json:"outputParameters,omitempty"`
+	Result                    *Reference             `bson:"result,omitempty" json:"result,omitempty"`
+	DataRequirement           []DataRequirement      `bson:"dataRequirement,omitempty" json:"dataRequirement,omitempty"`
+}
+
+// GuidanceResponseModule is implemented by the types allowed for GuidanceResponse.module[x]
+type GuidanceResponseModule interface {
+	isGuidanceResponseModule()
+}
+
+func (Uri) isGuidanceResponseModule()             {}
+func (Canonical) isGuidanceResponseModule()       {}
+func (CodeableConcept) isGuidanceResponseModule() {}
+
+// Module returns the value of GuidanceResponse.module[x] together with its FHIR type code or nil if no value is set
+func (r GuidanceResponse) Module() (GuidanceResponseModule, string) {
+	if r.ModuleUri != nil {
+		return Uri(*r.ModuleUri), "uri"
+	}
+	if r.ModuleCanonical != nil {
+		return Canonical(*r.ModuleCanonical), "canonical"
+	}
+	if r.ModuleCodeableConcept != nil {
+		return *r.ModuleCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetModule sets the value of GuidanceResponse.module[x], given as value or pointer, and clears all other types
+func (r *GuidanceResponse) SetModule(value GuidanceResponseModule) {
+	r.ModuleUri = nil
+	r.ModuleCanonical = nil
+	r.ModuleCodeableConcept = nil
+	switch v := value.(type) {
+	case Uri:
+		primitive := string(v)
+		r.ModuleUri = &primitive
+	case *Uri:
+		r.ModuleUri = (*string)(v)
+	case Canonical:
+		primitive := string(v)
+		r.ModuleCanonical = &primitive
+	case *Canonical:
+		r.ModuleCanonical = (*string)(v)
+	case CodeableConcept:
+		r.ModuleCodeableConcept = &v
+	case *CodeableConcept:
+		r.ModuleCodeableConcept = v
+	}
+}
 
 // MarshalJSON marshals the given GuidanceResponse as JSON into a byte slice
 func (r GuidanceResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherGuidanceResponse
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherGuidanceResponse: OtherGuidanceResponse(r),
-		ResourceType:          "GuidanceResponse",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the GuidanceResponse as JSON object with the members in the order of the fields
+func (r GuidanceResponse) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"GuidanceResponse\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.RequestIdentifier != nil {
+		e.key("requestIdentifier")
+		r.RequestIdentifier.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ModuleUri != nil {
+		e.key("moduleUri")
+		e.string(*r.ModuleUri)
+	}
+	if r.ModuleUriElement != nil {
+		e.key("_moduleUri")
+		r.ModuleUriElement.appendJSON(e)
+	}
+	if r.ModuleCanonical != nil {
+		e.key("moduleCanonical")
+		e.string(*r.ModuleCanonical)
+	}
+	if r.ModuleCanonicalElement != nil {
+		e.key("_moduleCanonical")
+		r.ModuleCanonicalElement.appendJSON(e)
+	}
+	if r.ModuleCodeableConcept != nil {
+		e.key("moduleCodeableConcept")
+		r.ModuleCodeableConcept.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.key("occurrenceDateTime")
+		e.string(*r.OccurrenceDateTime)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.key("_occurrenceDateTime")
+		r.OccurrenceDateTimeElement.appendJSON(e)
+	}
+	if r.Performer != nil {
+		e.key("performer")
+		r.Performer.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.EvaluationMessage) > 0 {
+		e.key("evaluationMessage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.EvaluationMessage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.OutputParameters != nil {
+		e.key("outputParameters")
+		r.OutputParameters.appendJSON(e)
+	}
+	if r.Result != nil {
+		e.key("result")
+		r.Result.appendJSON(e)
+	}
+	if len(r.DataRequirement) > 0 {
+		e.key("dataRequirement")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DataRequirement {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given GuidanceResponse from JSON. Unknown members are ignored.
+func (r *GuidanceResponse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the GuidanceResponse
+func (r *GuidanceResponse) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "requestIdentifier":
+			if d.null() {
+				r.RequestIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.RequestIdentifier = &v
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "moduleUri":
+			if v, ok := d.string(); ok {
+				r.ModuleUri = &v
+			} else {
+				r.ModuleUri = nil
+			}
+		case "_moduleUri":
+			r.ModuleUriElement = d.element()
+		case "moduleCanonical":
+			if v, ok := d.string(); ok {
+				r.ModuleCanonical = &v
+			} else {
+				r.ModuleCanonical = nil
+			}
+		case "_moduleCanonical":
+			r.ModuleCanonicalElement = d.element()
+		case "moduleCodeableConcept":
+			if d.null() {
+				r.ModuleCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ModuleCodeableConcept = &v
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "occurrenceDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurrenceDateTime = &v
+			} else {
+				r.OccurrenceDateTime = nil
+			}
+		case "_occurrenceDateTime":
+			r.OccurrenceDateTimeElement = d.element()
+		case "performer":
+			if d.null() {
+				r.Performer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "evaluationMessage":
+			a := d.array()
+			r.EvaluationMessage = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.EvaluationMessage = append(r.EvaluationMessage, v)
+			}
+			if r.EvaluationMessage == nil && !a.isNull {
+				r.EvaluationMessage = []Reference{}
+			}
+		case "outputParameters":
+			if d.null() {
+				r.OutputParameters = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.OutputParameters = &v
+			}
+		case "result":
+			if d.null() {
+				r.Result = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Result = &v
+			}
+		case "dataRequirement":
+			a := d.array()
+			r.DataRequirement = nil
+			for a.next() {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.DataRequirement = append(r.DataRequirement, v)
+			}
+			if r.DataRequirement == nil && !a.isNull {
+				r.DataRequirement = []DataRequirement{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the GuidanceResponse which shares no memory with the original
+func (r GuidanceResponse) DeepCopy() GuidanceResponse {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.RequestIdentifier != nil {
+		v := r.RequestIdentifier.DeepCopy()
+		out.RequestIdentifier = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.ModuleUri != nil {
+		v := *r.ModuleUri
+		out.ModuleUri = &v
+	}
+	if r.ModuleUriElement != nil {
+		v := r.ModuleUriElement.DeepCopy()
+		out.ModuleUriElement = &v
+	}
+	if r.ModuleCanonical != nil {
+		v := *r.ModuleCanonical
+		out.ModuleCanonical = &v
+	}
+	if r.ModuleCanonicalElement != nil {
+		v := r.ModuleCanonicalElement.DeepCopy()
+		out.ModuleCanonicalElement = &v
+	}
+	if r.ModuleCodeableConcept != nil {
+		v := r.ModuleCodeableConcept.DeepCopy()
+		out.ModuleCodeableConcept = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.OccurrenceDateTime != nil {
+		v := *r.OccurrenceDateTime
+		out.OccurrenceDateTime = &v
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		v := r.OccurrenceDateTimeElement.DeepCopy()
+		out.OccurrenceDateTimeElement = &v
+	}
+	if r.Performer != nil {
+		v := r.Performer.DeepCopy()
+		out.Performer = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.EvaluationMessage != nil {
+		out.EvaluationMessage = make([]Reference, len(r.EvaluationMessage))
+		for i := range r.EvaluationMessage {
+			out.EvaluationMessage[i] = r.EvaluationMessage[i].DeepCopy()
+		}
+	}
+	if r.OutputParameters != nil {
+		v := r.OutputParameters.DeepCopy()
+		out.OutputParameters = &v
+	}
+	if r.Result != nil {
+		v := r.Result.DeepCopy()
+		out.Result = &v
+	}
+	if r.DataRequirement != nil {
+		out.DataRequirement = make([]DataRequirement, len(r.DataRequirement))
+		for i := range r.DataRequirement {
+			out.DataRequirement[i] = r.DataRequirement[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r GuidanceResponse) Equal(other GuidanceResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.RequestIdentifier == nil) != (other.RequestIdentifier == nil) || r.RequestIdentifier != nil && !r.RequestIdentifier.Equal(*other.RequestIdentifier) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.ModuleUri == nil) != (other.ModuleUri == nil) || r.ModuleUri != nil && *r.ModuleUri != *other.ModuleUri {
+		return false
+	}
+	if (r.ModuleUriElement == nil) != (other.ModuleUriElement == nil) || r.ModuleUriElement != nil && !r.ModuleUriElement.Equal(*other.ModuleUriElement) {
+		return false
+	}
+	if (r.ModuleCanonical == nil) != (other.ModuleCanonical == nil) || r.ModuleCanonical != nil && *r.ModuleCanonical != *other.ModuleCanonical {
+		return false
+	}
+	if (r.ModuleCanonicalElement == nil) != (other.ModuleCanonicalElement == nil) || r.ModuleCanonicalElement != nil && !r.ModuleCanonicalElement.Equal(*other.ModuleCanonicalElement) {
+		return false
+	}
+	if (r.ModuleCodeableConcept == nil) != (other.ModuleCodeableConcept == nil) || r.ModuleCodeableConcept != nil && !r.ModuleCodeableConcept.Equal(*other.ModuleCodeableConcept) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && *r.OccurrenceDateTime != *other.OccurrenceDateTime {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.Equal(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.Equal(*other.Performer) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.EvaluationMessage) != len(other.EvaluationMessage) {
+		return false
+	}
+	for i := range r.EvaluationMessage {
+		if !r.EvaluationMessage[i].Equal(other.EvaluationMessage[i]) {
+			return false
+		}
+	}
+	if (r.OutputParameters == nil) != (other.OutputParameters == nil) || r.OutputParameters != nil && !r.OutputParameters.Equal(*other.OutputParameters) {
+		return false
+	}
+	if (r.Result == nil) != (other.Result == nil) || r.Result != nil && !r.Result.Equal(*other.Result) {
+		return false
+	}
+	if len(r.DataRequirement) != len(other.DataRequirement) {
+		return false
+	}
+	for i := range r.DataRequirement {
+		if !r.DataRequirement[i].Equal(other.DataRequirement[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r GuidanceResponse) EqualsDeep(other GuidanceResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.RequestIdentifier == nil) != (other.RequestIdentifier == nil) || r.RequestIdentifier != nil && !r.RequestIdentifier.EqualsDeep(*other.RequestIdentifier) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.ModuleUri == nil) != (other.ModuleUri == nil) || r.ModuleUri != nil && !equivalentString(*r.ModuleUri, *other.ModuleUri) {
+		return false
+	}
+	if (r.ModuleUriElement == nil) != (other.ModuleUriElement == nil) || r.ModuleUriElement != nil && !r.ModuleUriElement.EqualsDeep(*other.ModuleUriElement) {
+		return false
+	}
+	if (r.ModuleCanonical == nil) != (other.ModuleCanonical == nil) || r.ModuleCanonical != nil && !equivalentString(*r.ModuleCanonical, *other.ModuleCanonical) {
+		return false
+	}
+	if (r.ModuleCanonicalElement == nil) != (other.ModuleCanonicalElement == nil) || r.ModuleCanonicalElement != nil && !r.ModuleCanonicalElement.EqualsDeep(*other.ModuleCanonicalElement) {
+		return false
+	}
+	if (r.ModuleCodeableConcept == nil) != (other.ModuleCodeableConcept == nil) || r.ModuleCodeableConcept != nil && !r.ModuleCodeableConcept.EqualsDeep(*other.ModuleCodeableConcept) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.EqualsDeep(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.EqualsDeep(*other.Performer) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.EvaluationMessage), len(other.EvaluationMessage), func(i, j int) bool {
+		return r.EvaluationMessage[i].EqualsDeep(other.EvaluationMessage[j])
+	}) {
+		return false
+	}
+	if (r.OutputParameters == nil) != (other.OutputParameters == nil) || r.OutputParameters != nil && !r.OutputParameters.EqualsDeep(*other.OutputParameters) {
+		return false
+	}
+	if (r.Result == nil) != (other.Result == nil) || r.Result != nil && !r.Result.EqualsDeep(*other.Result) {
+		return false
+	}
+	if !equivalentList(len(r.DataRequirement), len(other.DataRequirement), func(i, j int) bool {
+		return r.DataRequirement[i].EqualsDeep(other.DataRequirement[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r GuidanceResponse) EqualsShallow(other GuidanceResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.ModuleUri == nil) != (other.ModuleUri == nil) || r.ModuleUri != nil && !equivalentString(*r.ModuleUri, *other.ModuleUri) {
+		return false
+	}
+	if (r.ModuleCanonical == nil) != (other.ModuleCanonical == nil) || r.ModuleCanonical != nil && !equivalentString(*r.ModuleCanonical, *other.ModuleCanonical) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given GuidanceResponse as FHIR XML
+func (r GuidanceResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "GuidanceResponse",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "requestIdentifier", r.RequestIdentifier); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "moduleUri", r.ModuleUri, r.ModuleUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "moduleCanonical", r.ModuleCanonical, r.ModuleCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "moduleCodeableConcept", r.ModuleCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceDateTime", r.OccurrenceDateTime, r.OccurrenceDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performer", r.Performer); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.EvaluationMessage {
+		if err := encodeXMLElement(e, "evaluationMessage", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "outputParameters", r.OutputParameters); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "result", r.Result); err != nil {
+		return err
+	}
+	for _, v := range r.DataRequirement {
+		if err := encodeXMLElement(e, "dataRequirement", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given GuidanceResponse from FHIR XML
+func (r *GuidanceResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "requestIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RequestIdentifier = &v
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "moduleUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ModuleUri = &v
+				}
+				r.ModuleUriElement = element
+			case "moduleCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ModuleCanonical = &v
+				}
+				r.ModuleCanonicalElement = element
+			case "moduleCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModuleCodeableConcept = &v
+			case "status":
+				var v GuidanceResponseStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "occurrenceDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceDateTime = &v
+				}
+				r.OccurrenceDateTimeElement = element
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "evaluationMessage":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EvaluationMessage = append(r.EvaluationMessage, v)
+			case "outputParameters":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OutputParameters = &v
+			case "result":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Result = &v
+			case "dataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DataRequirement = append(r.DataRequirement, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the GuidanceResponse as properties to the RDF node n
+func (r GuidanceResponse) turtle(n *turtleNode) {
+	n.resource("GuidanceResponse", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("GuidanceResponse.requestIdentifier", -1, r.RequestIdentifier)
+	for i, v := range r.Identifier {
+		n.element("GuidanceResponse.identifier", i, v)
+	}
+	n.primitive("GuidanceResponse.moduleUri", -1, r.ModuleUri, r.ModuleUriElement, "uri")
+	n.primitive("GuidanceResponse.moduleCanonical", -1, r.ModuleCanonical, r.ModuleCanonicalElement, "canonical")
+	n.element("GuidanceResponse.moduleCodeableConcept", -1, r.ModuleCodeableConcept)
+	n.primitive("GuidanceResponse.status", -1, r.Status, r.StatusElement, "code")
+	n.element("GuidanceResponse.subject", -1, r.Subject)
+	n.element("GuidanceResponse.encounter", -1, r.Encounter)
+	n.primitive("GuidanceResponse.occurrenceDateTime", -1, r.OccurrenceDateTime, r.OccurrenceDateTimeElement, "dateTime")
+	n.element("GuidanceResponse.performer", -1, r.Performer)
+	for i, v := range r.ReasonCode {
+		n.element("GuidanceResponse.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("GuidanceResponse.reasonReference", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("GuidanceResponse.note", i, v)
+	}
+	for i, v := range r.EvaluationMessage {
+		n.element("GuidanceResponse.evaluationMessage", i, v)
+	}
+	n.element("GuidanceResponse.outputParameters", -1, r.OutputParameters)
+	n.element("GuidanceResponse.result", -1, r.Result)
+	for i, v := range r.DataRequirement {
+		n.element("GuidanceResponse.dataRequirement", i, v)
+	}
+}
+
+// MarshalBSON marshals the given GuidanceResponse as BSON document with the structure of its FHIR JSON
+func (r GuidanceResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given GuidanceResponse from a BSON document with the structure of its FHIR JSON
+func (r *GuidanceResponse) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given GuidanceResponse as protobuf message GuidanceResponse of fhir.proto
+func (r GuidanceResponse) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the GuidanceResponse to the encoded message
+func (r GuidanceResponse) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.RequestIdentifier != nil {
+		e.message(9, *r.RequestIdentifier)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.ModuleUri != nil {
+		e.string(11, *r.ModuleUri)
+	}
+	if r.ModuleCanonical != nil {
+		e.string(12, *r.ModuleCanonical)
+	}
+	if r.ModuleCodeableConcept != nil {
+		e.message(13, *r.ModuleCodeableConcept)
+	}
+	e.varint(14, r.Status.protoNumber())
+	if r.Subject != nil {
+		e.message(15, *r.Subject)
+	}
+	if r.Encounter != nil {
+		e.message(16, *r.Encounter)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.string(17, *r.OccurrenceDateTime)
+	}
+	if r.Performer != nil {
+		e.message(18, *r.Performer)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(19, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(20, v)
+	}
+	for _, v := range r.Note {
+		e.message(21, v)
+	}
+	for _, v := range r.EvaluationMessage {
+		e.message(22, v)
+	}
+	if r.OutputParameters != nil {
+		e.message(23, *r.OutputParameters)
+	}
+	if r.Result != nil {
+		e.message(24, *r.Result)
+	}
+	for _, v := range r.DataRequirement {
+		e.message(25, v)
+	}
+	if r.IdElement != nil {
+		e.message(26, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(27, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(28, *r.LanguageElement)
+	}
+	if r.ModuleUriElement != nil {
+		e.message(29, *r.ModuleUriElement)
+	}
+	if r.ModuleCanonicalElement != nil {
+		e.message(30, *r.ModuleCanonicalElement)
+	}
+	if r.StatusElement != nil {
+		e.message(31, *r.StatusElement)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.message(32, *r.OccurrenceDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given GuidanceResponse from protobuf message GuidanceResponse of fhir.proto
+func (r *GuidanceResponse) UnmarshalProto(b []byte) error {
+	*r = GuidanceResponse{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.RequestIdentifier = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.ModuleUri = &v
+		case 12:
+			v := d.string()
+			r.ModuleCanonical = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.ModuleCodeableConcept = &v
+		case 14:
+			var v GuidanceResponseStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 17:
+			v := d.string()
+			r.OccurrenceDateTime = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Performer = &v
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 21:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.EvaluationMessage = append(r.EvaluationMessage, v)
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.OutputParameters = &v
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Result = &v
+		case 25:
+			var v DataRequirement
+			d.message(&v)
+			r.DataRequirement = append(r.DataRequirement, v)
+		case 26:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.ModuleUriElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ModuleCanonicalElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.OccurrenceDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalGuidanceResponse unmarshals a GuidanceResponse.
 func UnmarshalGuidanceResponse(b []byte) (GuidanceResponse, error) {
 	var guidanceResponse GuidanceResponse
-	if err := json.Unmarshal(b, &guidanceResponse); err != nil {
+	if err := guidanceResponse.UnmarshalJSON(b); err != nil {
 		return guidanceResponse, err
 	}
 	return guidanceResponse, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code GuidanceResponseStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *GuidanceResponseStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *GuidanceResponseStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "success":
 		*code = GuidanceResponseStatusSuccess
@@ -58,6 +62,12 @@ func (code *GuidanceResponseStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code GuidanceResponseStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *GuidanceResponseStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code GuidanceResponseStatus) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code GuidanceResponseStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code GuidanceResponseStatus) protoNumber() uint64 {
+	switch code {
+	case GuidanceResponseStatusSuccess:
+		return 1
+	case GuidanceResponseStatusDataRequested:
+		return 2
+	case GuidanceResponseStatusDataRequired:
+		return 3
+	case GuidanceResponseStatusInProgress:
+		return 4
+	case GuidanceResponseStatusFailure:
+		return 5
+	case GuidanceResponseStatusEnteredInError:
+		return 6
+	}
+	return 0
+}
+func (code *GuidanceResponseStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = GuidanceResponseStatusSuccess
+	case 2:
+		*code = GuidanceResponseStatusDataRequested
+	case 3:
+		*code = GuidanceResponseStatusDataRequired
+	case 4:
+		*code = GuidanceResponseStatusInProgress
+	case 5:
+		*code = GuidanceResponseStatusFailure
+	case 6:
+		*code = GuidanceResponseStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
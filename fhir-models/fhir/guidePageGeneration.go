@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code GuidePageGeneration) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *GuidePageGeneration) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *GuidePageGeneration) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "html":
 		*code = GuidePageGenerationHtml
@@ -52,6 +56,12 @@ func (code *GuidePageGeneration) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code GuidePageGeneration) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *GuidePageGeneration) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code GuidePageGeneration) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code GuidePageGeneration) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code GuidePageGeneration) protoNumber() uint64 {
+	switch code {
+	case GuidePageGenerationHtml:
+		return 1
+	case GuidePageGenerationMarkdown:
+		return 2
+	case GuidePageGenerationXml:
+		return 3
+	case GuidePageGenerationGenerated:
+		return 4
+	}
+	return 0
+}
+func (code *GuidePageGeneration) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = GuidePageGenerationHtml
+	case 2:
+		*code = GuidePageGenerationMarkdown
+	case 3:
+		*code = GuidePageGenerationXml
+	case 4:
+		*code = GuidePageGenerationGenerated
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -42,8 +41,13 @@ const (
 func (code GuideParameterCode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *GuideParameterCode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *GuideParameterCode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "apply":
 		*code = GuideParameterCodeApply
@@ -70,6 +74,12 @@ func (code *GuideParameterCode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code GuideParameterCode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *GuideParameterCode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code GuideParameterCode) String() string {
 	return code.Code()
 }
@@ -148,3 +158,55 @@ func (code GuideParameterCode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code GuideParameterCode) protoNumber() uint64 {
+	switch code {
+	case GuideParameterCodeApply:
+		return 1
+	case GuideParameterCodePathResource:
+		return 2
+	case GuideParameterCodePathPages:
+		return 3
+	case GuideParameterCodePathTxCache:
+		return 4
+	case GuideParameterCodeExpansionParameter:
+		return 5
+	case GuideParameterCodeRuleBrokenLinks:
+		return 6
+	case GuideParameterCodeGenerateXml:
+		return 7
+	case GuideParameterCodeGenerateJson:
+		return 8
+	case GuideParameterCodeGenerateTurtle:
+		return 9
+	case GuideParameterCodeHtmlTemplate:
+		return 10
+	}
+	return 0
+}
+func (code *GuideParameterCode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = GuideParameterCodeApply
+	case 2:
+		*code = GuideParameterCodePathResource
+	case 3:
+		*code = GuideParameterCodePathPages
+	case 4:
+		*code = GuideParameterCodePathTxCache
+	case 5:
+		*code = GuideParameterCodeExpansionParameter
+	case 6:
+		*code = GuideParameterCodeRuleBrokenLinks
+	case 7:
+		*code = GuideParameterCodeGenerateXml
+	case 8:
+		*code = GuideParameterCodeGenerateJson
+	case 9:
+		*code = GuideParameterCodeGenerateTurtle
+	case 10:
+		*code = GuideParameterCodeHtmlTemplate
+	default:
+		return false
+	}
+	return true
+}
@@ -14,44 +14,57 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // HealthcareService is documented here http://hl7.org/fhir/StructureDefinition/HealthcareService
 type HealthcareService struct {
-	Id                     *string                          `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                          `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active                 *bool                            `bson:"active,omitempty" json:"active,omitempty"`
-	ProvidedBy             *Reference                       `bson:"providedBy,omitempty" json:"providedBy,omitempty"`
-	Category               []CodeableConcept                `bson:"category,omitempty" json:"category,omitempty"`
-	Type                   []CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
-	Specialty              []CodeableConcept                `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	Location               []Reference                      `bson:"location,omitempty" json:"location,omitempty"`
-	Name                   *string                          `bson:"name,omitempty" json:"name,omitempty"`
-	Comment                *string                          `bson:"comment,omitempty" json:"comment,omitempty"`
-	ExtraDetails           *string                          `bson:"extraDetails,omitempty" json:"extraDetails,omitempty"`
-	Photo                  *Attachment                      `bson:"photo,omitempty" json:"photo,omitempty"`
-	Telecom                []ContactPoint                   `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	CoverageArea           []Reference                      `bson:"coverageArea,omitempty" json:"coverageArea,omitempty"`
-	ServiceProvisionCode   []CodeableConcept                `bson:"serviceProvisionCode,omitempty" json:"serviceProvisionCode,omitempty"`
-	Eligibility            []HealthcareServiceEligibility   `bson:"eligibility,omitempty" json:"eligibility,omitempty"`
-	Program                []CodeableConcept                `bson:"program,omitempty" json:"program,omitempty"`
-	Characteristic         []CodeableConcept                `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
-	Communication          []CodeableConcept                `bson:"communication,omitempty" json:"communication,omitempty"`
-	ReferralMethod         []CodeableConcept                `bson:"referralMethod,omitempty" json:"referralMethod,omitempty"`
-	AppointmentRequired    *bool                            `bson:"appointmentRequired,omitempty" json:"appointmentRequired,omitempty"`
-	AvailableTime          []HealthcareServiceAvailableTime `bson:"availableTime,omitempty" json:"availableTime,omitempty"`
-	NotAvailable           []HealthcareServiceNotAvailable  `bson:"notAvailable,omitempty" json:"notAvailable,omitempty"`
-	AvailabilityExceptions *string                          `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
-	Endpoint               []Reference                      `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	Id                            *string                          `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                     *Element                         `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                          *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                         `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     []json.RawMessage                `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                    []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active                        *bool                            `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement                 *Element                         `bson:"_active,omitempty" json:"_active,omitempty"`
+	ProvidedBy                    *Reference                       `bson:"providedBy,omitempty" json:"providedBy,omitempty"`
+	Category                      []CodeableConcept                `bson:"category,omitempty" json:"category,omitempty"`
+	Type                          []CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
+	Specialty                     []CodeableConcept                `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	Location                      []Reference                      `bson:"location,omitempty" json:"location,omitempty"`
+	Name                          *string                          `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                   *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Comment                       *string                          `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement                *Element                         `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	ExtraDetails                  *string                          `bson:"extraDetails,omitempty" json:"extraDetails,omitempty"`
+	ExtraDetailsElement           *Element                         `bson:"_extraDetails,omitempty" json:"_extraDetails,omitempty"`
+	Photo                         *Attachment                      `bson:"photo,omitempty" json:"photo,omitempty"`
+	Telecom                       []ContactPoint                   `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	CoverageArea                  []Reference                      `bson:"coverageArea,omitempty" json:"coverageArea,omitempty"`
+	ServiceProvisionCode          []CodeableConcept                `bson:"serviceProvisionCode,omitempty" json:"serviceProvisionCode,omitempty"`
+	Eligibility                   []HealthcareServiceEligibility   `bson:"eligibility,omitempty" json:"eligibility,omitempty"`
+	Program                       []CodeableConcept                `bson:"program,omitempty" json:"program,omitempty"`
+	Characteristic                []CodeableConcept                `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
+	Communication                 []CodeableConcept                `bson:"communication,omitempty" json:"communication,omitempty"`
+	ReferralMethod                []CodeableConcept                `bson:"referralMethod,omitempty" json:"referralMethod,omitempty"`
+	AppointmentRequired           *bool                            `bson:"appointmentRequired,omitempty" json:"appointmentRequired,omitempty"`
+	AppointmentRequiredElement    *Element                         `bson:"_appointmentRequired,omitempty" json:"_appointmentRequired,omitempty"`
+	AvailableTime                 []HealthcareServiceAvailableTime `bson:"availableTime,omitempty" json:"availableTime,omitempty"`
+	NotAvailable                  []HealthcareServiceNotAvailable  `bson:"notAvailable,omitempty" json:"notAvailable,omitempty"`
+	AvailabilityExceptions        *string                          `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *Element                         `bson:"_availabilityExceptions,omitempty" json:"_availabilityExceptions,omitempty"`
+	Endpoint                      []Reference                      `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
 }
 type HealthcareServiceEligibility struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -59,40 +72,3405 @@ type HealthcareServiceEligibility struct {
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
 	Comment           *string          `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element         `bson:"_comment,omitempty" json:"_comment,omitempty"`
 }
 type HealthcareServiceAvailableTime struct {
-	Id                 *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DaysOfWeek         []DaysOfWeek `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
-	AllDay             *bool        `bson:"allDay,omitempty" json:"allDay,omitempty"`
-	AvailableStartTime *string      `bson:"availableStartTime,omitempty" json:"availableStartTime,omitempty"`
-	AvailableEndTime   *string      `bson:"availableEndTime,omitempty" json:"availableEndTime,omitempty"`
+	Id                        *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DaysOfWeek                []DaysOfWeek `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement         []*Element   `bson:"_daysOfWeek,omitempty" json:"_daysOfWeek,omitempty"`
+	AllDay                    *bool        `bson:"allDay,omitempty" json:"allDay,omitempty"`
+	AllDayElement             *Element     `bson:"_allDay,omitempty" json:"_allDay,omitempty"`
+	AvailableStartTime        *string      `bson:"availableStartTime,omitempty" json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *Element     `bson:"_availableStartTime,omitempty" json:"_availableStartTime,omitempty"`
+	AvailableEndTime          *string      `bson:"availableEndTime,omitempty" json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement   *Element     `bson:"_availableEndTime,omitempty" json:"_availableEndTime,omitempty"`
 }
 type HealthcareServiceNotAvailable struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       string      `bson:"description" json:"description"`
-	During            *Period     `bson:"during,omitempty" json:"during,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        string      `bson:"description" json:"description"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	During             *Period     `bson:"during,omitempty" json:"during,omitempty"`
 }
-type OtherHealthcareService HealthcareService
 
 // MarshalJSON marshals the given HealthcareService as JSON into a byte slice
 func (r HealthcareService) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherHealthcareService
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherHealthcareService: OtherHealthcareService(r),
-		ResourceType:           "HealthcareService",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the HealthcareService as JSON object with the members in the order of the fields
+func (r HealthcareService) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"HealthcareService\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Active != nil {
+		e.key("active")
+		e.bool(*r.Active)
+	}
+	if r.ActiveElement != nil {
+		e.key("_active")
+		r.ActiveElement.appendJSON(e)
+	}
+	if r.ProvidedBy != nil {
+		e.key("providedBy")
+		r.ProvidedBy.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Specialty) > 0 {
+		e.key("specialty")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Specialty {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Location) > 0 {
+		e.key("location")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Location {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	if r.ExtraDetails != nil {
+		e.key("extraDetails")
+		e.string(*r.ExtraDetails)
+	}
+	if r.ExtraDetailsElement != nil {
+		e.key("_extraDetails")
+		r.ExtraDetailsElement.appendJSON(e)
+	}
+	if r.Photo != nil {
+		e.key("photo")
+		r.Photo.appendJSON(e)
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CoverageArea) > 0 {
+		e.key("coverageArea")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CoverageArea {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ServiceProvisionCode) > 0 {
+		e.key("serviceProvisionCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ServiceProvisionCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Eligibility) > 0 {
+		e.key("eligibility")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Eligibility {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Program) > 0 {
+		e.key("program")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Program {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Characteristic) > 0 {
+		e.key("characteristic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Characteristic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Communication) > 0 {
+		e.key("communication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Communication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReferralMethod) > 0 {
+		e.key("referralMethod")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReferralMethod {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AppointmentRequired != nil {
+		e.key("appointmentRequired")
+		e.bool(*r.AppointmentRequired)
+	}
+	if r.AppointmentRequiredElement != nil {
+		e.key("_appointmentRequired")
+		r.AppointmentRequiredElement.appendJSON(e)
+	}
+	if len(r.AvailableTime) > 0 {
+		e.key("availableTime")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AvailableTime {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NotAvailable) > 0 {
+		e.key("notAvailable")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NotAvailable {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AvailabilityExceptions != nil {
+		e.key("availabilityExceptions")
+		e.string(*r.AvailabilityExceptions)
+	}
+	if r.AvailabilityExceptionsElement != nil {
+		e.key("_availabilityExceptions")
+		r.AvailabilityExceptionsElement.appendJSON(e)
+	}
+	if len(r.Endpoint) > 0 {
+		e.key("endpoint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endpoint {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given HealthcareService from JSON. Unknown members are ignored.
+func (r *HealthcareService) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the HealthcareService
+func (r *HealthcareService) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "active":
+			if v, ok := d.bool(); ok {
+				r.Active = &v
+			} else {
+				r.Active = nil
+			}
+		case "_active":
+			r.ActiveElement = d.element()
+		case "providedBy":
+			if d.null() {
+				r.ProvidedBy = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ProvidedBy = &v
+			}
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "specialty":
+			a := d.array()
+			r.Specialty = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Specialty = append(r.Specialty, v)
+			}
+			if r.Specialty == nil && !a.isNull {
+				r.Specialty = []CodeableConcept{}
+			}
+		case "location":
+			a := d.array()
+			r.Location = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = append(r.Location, v)
+			}
+			if r.Location == nil && !a.isNull {
+				r.Location = []Reference{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		case "extraDetails":
+			if v, ok := d.string(); ok {
+				r.ExtraDetails = &v
+			} else {
+				r.ExtraDetails = nil
+			}
+		case "_extraDetails":
+			r.ExtraDetailsElement = d.element()
+		case "photo":
+			if d.null() {
+				r.Photo = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Photo = &v
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "coverageArea":
+			a := d.array()
+			r.CoverageArea = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.CoverageArea = append(r.CoverageArea, v)
+			}
+			if r.CoverageArea == nil && !a.isNull {
+				r.CoverageArea = []Reference{}
+			}
+		case "serviceProvisionCode":
+			a := d.array()
+			r.ServiceProvisionCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ServiceProvisionCode = append(r.ServiceProvisionCode, v)
+			}
+			if r.ServiceProvisionCode == nil && !a.isNull {
+				r.ServiceProvisionCode = []CodeableConcept{}
+			}
+		case "eligibility":
+			a := d.array()
+			r.Eligibility = nil
+			for a.next() {
+				var v HealthcareServiceEligibility
+				v.decodeJSON(d)
+				r.Eligibility = append(r.Eligibility, v)
+			}
+			if r.Eligibility == nil && !a.isNull {
+				r.Eligibility = []HealthcareServiceEligibility{}
+			}
+		case "program":
+			a := d.array()
+			r.Program = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Program = append(r.Program, v)
+			}
+			if r.Program == nil && !a.isNull {
+				r.Program = []CodeableConcept{}
+			}
+		case "characteristic":
+			a := d.array()
+			r.Characteristic = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Characteristic = append(r.Characteristic, v)
+			}
+			if r.Characteristic == nil && !a.isNull {
+				r.Characteristic = []CodeableConcept{}
+			}
+		case "communication":
+			a := d.array()
+			r.Communication = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Communication = append(r.Communication, v)
+			}
+			if r.Communication == nil && !a.isNull {
+				r.Communication = []CodeableConcept{}
+			}
+		case "referralMethod":
+			a := d.array()
+			r.ReferralMethod = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReferralMethod = append(r.ReferralMethod, v)
+			}
+			if r.ReferralMethod == nil && !a.isNull {
+				r.ReferralMethod = []CodeableConcept{}
+			}
+		case "appointmentRequired":
+			if v, ok := d.bool(); ok {
+				r.AppointmentRequired = &v
+			} else {
+				r.AppointmentRequired = nil
+			}
+		case "_appointmentRequired":
+			r.AppointmentRequiredElement = d.element()
+		case "availableTime":
+			a := d.array()
+			r.AvailableTime = nil
+			for a.next() {
+				var v HealthcareServiceAvailableTime
+				v.decodeJSON(d)
+				r.AvailableTime = append(r.AvailableTime, v)
+			}
+			if r.AvailableTime == nil && !a.isNull {
+				r.AvailableTime = []HealthcareServiceAvailableTime{}
+			}
+		case "notAvailable":
+			a := d.array()
+			r.NotAvailable = nil
+			for a.next() {
+				var v HealthcareServiceNotAvailable
+				v.decodeJSON(d)
+				r.NotAvailable = append(r.NotAvailable, v)
+			}
+			if r.NotAvailable == nil && !a.isNull {
+				r.NotAvailable = []HealthcareServiceNotAvailable{}
+			}
+		case "availabilityExceptions":
+			if v, ok := d.string(); ok {
+				r.AvailabilityExceptions = &v
+			} else {
+				r.AvailabilityExceptions = nil
+			}
+		case "_availabilityExceptions":
+			r.AvailabilityExceptionsElement = d.element()
+		case "endpoint":
+			a := d.array()
+			r.Endpoint = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Endpoint = append(r.Endpoint, v)
+			}
+			if r.Endpoint == nil && !a.isNull {
+				r.Endpoint = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the HealthcareService which shares no memory with the original
+func (r HealthcareService) DeepCopy() HealthcareService {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Active != nil {
+		v := *r.Active
+		out.Active = &v
+	}
+	if r.ActiveElement != nil {
+		v := r.ActiveElement.DeepCopy()
+		out.ActiveElement = &v
+	}
+	if r.ProvidedBy != nil {
+		v := r.ProvidedBy.DeepCopy()
+		out.ProvidedBy = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.Specialty != nil {
+		out.Specialty = make([]CodeableConcept, len(r.Specialty))
+		for i := range r.Specialty {
+			out.Specialty[i] = r.Specialty[i].DeepCopy()
+		}
+	}
+	if r.Location != nil {
+		out.Location = make([]Reference, len(r.Location))
+		for i := range r.Location {
+			out.Location[i] = r.Location[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	if r.ExtraDetails != nil {
+		v := *r.ExtraDetails
+		out.ExtraDetails = &v
+	}
+	if r.ExtraDetailsElement != nil {
+		v := r.ExtraDetailsElement.DeepCopy()
+		out.ExtraDetailsElement = &v
+	}
+	if r.Photo != nil {
+		v := r.Photo.DeepCopy()
+		out.Photo = &v
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.CoverageArea != nil {
+		out.CoverageArea = make([]Reference, len(r.CoverageArea))
+		for i := range r.CoverageArea {
+			out.CoverageArea[i] = r.CoverageArea[i].DeepCopy()
+		}
+	}
+	if r.ServiceProvisionCode != nil {
+		out.ServiceProvisionCode = make([]CodeableConcept, len(r.ServiceProvisionCode))
+		for i := range r.ServiceProvisionCode {
+			out.ServiceProvisionCode[i] = r.ServiceProvisionCode[i].DeepCopy()
+		}
+	}
+	if r.Eligibility != nil {
+		out.Eligibility = make([]HealthcareServiceEligibility, len(r.Eligibility))
+		for i := range r.Eligibility {
+			out.Eligibility[i] = r.Eligibility[i].DeepCopy()
+		}
+	}
+	if r.Program != nil {
+		out.Program = make([]CodeableConcept, len(r.Program))
+		for i := range r.Program {
+			out.Program[i] = r.Program[i].DeepCopy()
+		}
+	}
+	if r.Characteristic != nil {
+		out.Characteristic = make([]CodeableConcept, len(r.Characteristic))
+		for i := range r.Characteristic {
+			out.Characteristic[i] = r.Characteristic[i].DeepCopy()
+		}
+	}
+	if r.Communication != nil {
+		out.Communication = make([]CodeableConcept, len(r.Communication))
+		for i := range r.Communication {
+			out.Communication[i] = r.Communication[i].DeepCopy()
+		}
+	}
+	if r.ReferralMethod != nil {
+		out.ReferralMethod = make([]CodeableConcept, len(r.ReferralMethod))
+		for i := range r.ReferralMethod {
+			out.ReferralMethod[i] = r.ReferralMethod[i].DeepCopy()
+		}
+	}
+	if r.AppointmentRequired != nil {
+		v := *r.AppointmentRequired
+		out.AppointmentRequired = &v
+	}
+	if r.AppointmentRequiredElement != nil {
+		v := r.AppointmentRequiredElement.DeepCopy()
+		out.AppointmentRequiredElement = &v
+	}
+	if r.AvailableTime != nil {
+		out.AvailableTime = make([]HealthcareServiceAvailableTime, len(r.AvailableTime))
+		for i := range r.AvailableTime {
+			out.AvailableTime[i] = r.AvailableTime[i].DeepCopy()
+		}
+	}
+	if r.NotAvailable != nil {
+		out.NotAvailable = make([]HealthcareServiceNotAvailable, len(r.NotAvailable))
+		for i := range r.NotAvailable {
+			out.NotAvailable[i] = r.NotAvailable[i].DeepCopy()
+		}
+	}
+	if r.AvailabilityExceptions != nil {
+		v := *r.AvailabilityExceptions
+		out.AvailabilityExceptions = &v
+	}
+	if r.AvailabilityExceptionsElement != nil {
+		v := r.AvailabilityExceptionsElement.DeepCopy()
+		out.AvailabilityExceptionsElement = &v
+	}
+	if r.Endpoint != nil {
+		out.Endpoint = make([]Reference, len(r.Endpoint))
+		for i := range r.Endpoint {
+			out.Endpoint[i] = r.Endpoint[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r HealthcareService) Equal(other HealthcareService) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.Equal(*other.ActiveElement) {
+		return false
+	}
+	if (r.ProvidedBy == nil) != (other.ProvidedBy == nil) || r.ProvidedBy != nil && !r.ProvidedBy.Equal(*other.ProvidedBy) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if len(r.Specialty) != len(other.Specialty) {
+		return false
+	}
+	for i := range r.Specialty {
+		if !r.Specialty[i].Equal(other.Specialty[i]) {
+			return false
+		}
+	}
+	if len(r.Location) != len(other.Location) {
+		return false
+	}
+	for i := range r.Location {
+		if !r.Location[i].Equal(other.Location[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	if (r.ExtraDetails == nil) != (other.ExtraDetails == nil) || r.ExtraDetails != nil && *r.ExtraDetails != *other.ExtraDetails {
+		return false
+	}
+	if (r.ExtraDetailsElement == nil) != (other.ExtraDetailsElement == nil) || r.ExtraDetailsElement != nil && !r.ExtraDetailsElement.Equal(*other.ExtraDetailsElement) {
+		return false
+	}
+	if (r.Photo == nil) != (other.Photo == nil) || r.Photo != nil && !r.Photo.Equal(*other.Photo) {
+		return false
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if len(r.CoverageArea) != len(other.CoverageArea) {
+		return false
+	}
+	for i := range r.CoverageArea {
+		if !r.CoverageArea[i].Equal(other.CoverageArea[i]) {
+			return false
+		}
+	}
+	if len(r.ServiceProvisionCode) != len(other.ServiceProvisionCode) {
+		return false
+	}
+	for i := range r.ServiceProvisionCode {
+		if !r.ServiceProvisionCode[i].Equal(other.ServiceProvisionCode[i]) {
+			return false
+		}
+	}
+	if len(r.Eligibility) != len(other.Eligibility) {
+		return false
+	}
+	for i := range r.Eligibility {
+		if !r.Eligibility[i].Equal(other.Eligibility[i]) {
+			return false
+		}
+	}
+	if len(r.Program) != len(other.Program) {
+		return false
+	}
+	for i := range r.Program {
+		if !r.Program[i].Equal(other.Program[i]) {
+			return false
+		}
+	}
+	if len(r.Characteristic) != len(other.Characteristic) {
+		return false
+	}
+	for i := range r.Characteristic {
+		if !r.Characteristic[i].Equal(other.Characteristic[i]) {
+			return false
+		}
+	}
+	if len(r.Communication) != len(other.Communication) {
+		return false
+	}
+	for i := range r.Communication {
+		if !r.Communication[i].Equal(other.Communication[i]) {
+			return false
+		}
+	}
+	if len(r.ReferralMethod) != len(other.ReferralMethod) {
+		return false
+	}
+	for i := range r.ReferralMethod {
+		if !r.ReferralMethod[i].Equal(other.ReferralMethod[i]) {
+			return false
+		}
+	}
+	if (r.AppointmentRequired == nil) != (other.AppointmentRequired == nil) || r.AppointmentRequired != nil && *r.AppointmentRequired != *other.AppointmentRequired {
+		return false
+	}
+	if (r.AppointmentRequiredElement == nil) != (other.AppointmentRequiredElement == nil) || r.AppointmentRequiredElement != nil && !r.AppointmentRequiredElement.Equal(*other.AppointmentRequiredElement) {
+		return false
+	}
+	if len(r.AvailableTime) != len(other.AvailableTime) {
+		return false
+	}
+	for i := range r.AvailableTime {
+		if !r.AvailableTime[i].Equal(other.AvailableTime[i]) {
+			return false
+		}
+	}
+	if len(r.NotAvailable) != len(other.NotAvailable) {
+		return false
+	}
+	for i := range r.NotAvailable {
+		if !r.NotAvailable[i].Equal(other.NotAvailable[i]) {
+			return false
+		}
+	}
+	if (r.AvailabilityExceptions == nil) != (other.AvailabilityExceptions == nil) || r.AvailabilityExceptions != nil && *r.AvailabilityExceptions != *other.AvailabilityExceptions {
+		return false
+	}
+	if (r.AvailabilityExceptionsElement == nil) != (other.AvailabilityExceptionsElement == nil) || r.AvailabilityExceptionsElement != nil && !r.AvailabilityExceptionsElement.Equal(*other.AvailabilityExceptionsElement) {
+		return false
+	}
+	if len(r.Endpoint) != len(other.Endpoint) {
+		return false
+	}
+	for i := range r.Endpoint {
+		if !r.Endpoint[i].Equal(other.Endpoint[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r HealthcareService) EqualsDeep(other HealthcareService) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.EqualsDeep(*other.ActiveElement) {
+		return false
+	}
+	if (r.ProvidedBy == nil) != (other.ProvidedBy == nil) || r.ProvidedBy != nil && !r.ProvidedBy.EqualsDeep(*other.ProvidedBy) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Specialty), len(other.Specialty), func(i, j int) bool {
+		return r.Specialty[i].EqualsDeep(other.Specialty[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Location), len(other.Location), func(i, j int) bool {
+		return r.Location[i].EqualsDeep(other.Location[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	if (r.ExtraDetails == nil) != (other.ExtraDetails == nil) || r.ExtraDetails != nil && !equivalentString(*r.ExtraDetails, *other.ExtraDetails) {
+		return false
+	}
+	if (r.ExtraDetailsElement == nil) != (other.ExtraDetailsElement == nil) || r.ExtraDetailsElement != nil && !r.ExtraDetailsElement.EqualsDeep(*other.ExtraDetailsElement) {
+		return false
+	}
+	if (r.Photo == nil) != (other.Photo == nil) || r.Photo != nil && !r.Photo.EqualsDeep(*other.Photo) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.CoverageArea), len(other.CoverageArea), func(i, j int) bool {
+		return r.CoverageArea[i].EqualsDeep(other.CoverageArea[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ServiceProvisionCode), len(other.ServiceProvisionCode), func(i, j int) bool {
+		return r.ServiceProvisionCode[i].EqualsDeep(other.ServiceProvisionCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Eligibility), len(other.Eligibility), func(i, j int) bool {
+		return r.Eligibility[i].EqualsDeep(other.Eligibility[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Program), len(other.Program), func(i, j int) bool {
+		return r.Program[i].EqualsDeep(other.Program[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Characteristic), len(other.Characteristic), func(i, j int) bool {
+		return r.Characteristic[i].EqualsDeep(other.Characteristic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Communication), len(other.Communication), func(i, j int) bool {
+		return r.Communication[i].EqualsDeep(other.Communication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReferralMethod), len(other.ReferralMethod), func(i, j int) bool {
+		return r.ReferralMethod[i].EqualsDeep(other.ReferralMethod[j])
+	}) {
+		return false
+	}
+	if (r.AppointmentRequired == nil) != (other.AppointmentRequired == nil) || r.AppointmentRequired != nil && *r.AppointmentRequired != *other.AppointmentRequired {
+		return false
+	}
+	if (r.AppointmentRequiredElement == nil) != (other.AppointmentRequiredElement == nil) || r.AppointmentRequiredElement != nil && !r.AppointmentRequiredElement.EqualsDeep(*other.AppointmentRequiredElement) {
+		return false
+	}
+	if !equivalentList(len(r.AvailableTime), len(other.AvailableTime), func(i, j int) bool {
+		return r.AvailableTime[i].EqualsDeep(other.AvailableTime[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NotAvailable), len(other.NotAvailable), func(i, j int) bool {
+		return r.NotAvailable[i].EqualsDeep(other.NotAvailable[j])
+	}) {
+		return false
+	}
+	if (r.AvailabilityExceptions == nil) != (other.AvailabilityExceptions == nil) || r.AvailabilityExceptions != nil && !equivalentString(*r.AvailabilityExceptions, *other.AvailabilityExceptions) {
+		return false
+	}
+	if (r.AvailabilityExceptionsElement == nil) != (other.AvailabilityExceptionsElement == nil) || r.AvailabilityExceptionsElement != nil && !r.AvailabilityExceptionsElement.EqualsDeep(*other.AvailabilityExceptionsElement) {
+		return false
+	}
+	if !equivalentList(len(r.Endpoint), len(other.Endpoint), func(i, j int) bool {
+		return r.Endpoint[i].EqualsDeep(other.Endpoint[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r HealthcareService) EqualsShallow(other HealthcareService) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.ExtraDetails == nil) != (other.ExtraDetails == nil) || r.ExtraDetails != nil && !equivalentString(*r.ExtraDetails, *other.ExtraDetails) {
+		return false
+	}
+	if (r.AppointmentRequired == nil) != (other.AppointmentRequired == nil) || r.AppointmentRequired != nil && *r.AppointmentRequired != *other.AppointmentRequired {
+		return false
+	}
+	if (r.AvailabilityExceptions == nil) != (other.AvailabilityExceptions == nil) || r.AvailabilityExceptions != nil && !equivalentString(*r.AvailabilityExceptions, *other.AvailabilityExceptions) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given HealthcareService as FHIR XML
+func (r HealthcareService) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "HealthcareService",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "active", r.Active, r.ActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "providedBy", r.ProvidedBy); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Specialty {
+		if err := encodeXMLElement(e, "specialty", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Location {
+		if err := encodeXMLElement(e, "location", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "extraDetails", r.ExtraDetails, r.ExtraDetailsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "photo", r.Photo); err != nil {
+		return err
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CoverageArea {
+		if err := encodeXMLElement(e, "coverageArea", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ServiceProvisionCode {
+		if err := encodeXMLElement(e, "serviceProvisionCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Eligibility {
+		if err := encodeXMLElement(e, "eligibility", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Program {
+		if err := encodeXMLElement(e, "program", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Characteristic {
+		if err := encodeXMLElement(e, "characteristic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Communication {
+		if err := encodeXMLElement(e, "communication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReferralMethod {
+		if err := encodeXMLElement(e, "referralMethod", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "appointmentRequired", r.AppointmentRequired, r.AppointmentRequiredElement); err != nil {
+		return err
+	}
+	for _, v := range r.AvailableTime {
+		if err := encodeXMLElement(e, "availableTime", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.NotAvailable {
+		if err := encodeXMLElement(e, "notAvailable", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "availabilityExceptions", r.AvailabilityExceptions, r.AvailabilityExceptionsElement); err != nil {
+		return err
+	}
+	for _, v := range r.Endpoint {
+		if err := encodeXMLElement(e, "endpoint", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given HealthcareService from FHIR XML
+func (r *HealthcareService) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "active":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Active = &v
+				}
+				r.ActiveElement = element
+			case "providedBy":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProvidedBy = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "specialty":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specialty = append(r.Specialty, v)
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = append(r.Location, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			case "extraDetails":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ExtraDetails = &v
+				}
+				r.ExtraDetailsElement = element
+			case "photo":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Photo = &v
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "coverageArea":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CoverageArea = append(r.CoverageArea, v)
+			case "serviceProvisionCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ServiceProvisionCode = append(r.ServiceProvisionCode, v)
+			case "eligibility":
+				var v HealthcareServiceEligibility
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Eligibility = append(r.Eligibility, v)
+			case "program":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Program = append(r.Program, v)
+			case "characteristic":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Characteristic = append(r.Characteristic, v)
+			case "communication":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Communication = append(r.Communication, v)
+			case "referralMethod":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferralMethod = append(r.ReferralMethod, v)
+			case "appointmentRequired":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AppointmentRequired = &v
+				}
+				r.AppointmentRequiredElement = element
+			case "availableTime":
+				var v HealthcareServiceAvailableTime
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AvailableTime = append(r.AvailableTime, v)
+			case "notAvailable":
+				var v HealthcareServiceNotAvailable
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.NotAvailable = append(r.NotAvailable, v)
+			case "availabilityExceptions":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AvailabilityExceptions = &v
+				}
+				r.AvailabilityExceptionsElement = element
+			case "endpoint":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endpoint = append(r.Endpoint, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the HealthcareService as properties to the RDF node n
+func (r HealthcareService) turtle(n *turtleNode) {
+	n.resource("HealthcareService", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("HealthcareService.identifier", i, v)
+	}
+	n.primitive("HealthcareService.active", -1, r.Active, r.ActiveElement, "boolean")
+	n.element("HealthcareService.providedBy", -1, r.ProvidedBy)
+	for i, v := range r.Category {
+		n.element("HealthcareService.category", i, v)
+	}
+	for i, v := range r.Type {
+		n.element("HealthcareService.type", i, v)
+	}
+	for i, v := range r.Specialty {
+		n.element("HealthcareService.specialty", i, v)
+	}
+	for i, v := range r.Location {
+		n.element("HealthcareService.location", i, v)
+	}
+	n.primitive("HealthcareService.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("HealthcareService.comment", -1, r.Comment, r.CommentElement, "string")
+	n.primitive("HealthcareService.extraDetails", -1, r.ExtraDetails, r.ExtraDetailsElement, "string")
+	n.element("HealthcareService.photo", -1, r.Photo)
+	for i, v := range r.Telecom {
+		n.element("HealthcareService.telecom", i, v)
+	}
+	for i, v := range r.CoverageArea {
+		n.element("HealthcareService.coverageArea", i, v)
+	}
+	for i, v := range r.ServiceProvisionCode {
+		n.element("HealthcareService.serviceProvisionCode", i, v)
+	}
+	for i, v := range r.Eligibility {
+		n.element("HealthcareService.eligibility", i, v)
+	}
+	for i, v := range r.Program {
+		n.element("HealthcareService.program", i, v)
+	}
+	for i, v := range r.Characteristic {
+		n.element("HealthcareService.characteristic", i, v)
+	}
+	for i, v := range r.Communication {
+		n.element("HealthcareService.communication", i, v)
+	}
+	for i, v := range r.ReferralMethod {
+		n.element("HealthcareService.referralMethod", i, v)
+	}
+	n.primitive("HealthcareService.appointmentRequired", -1, r.AppointmentRequired, r.AppointmentRequiredElement, "boolean")
+	for i, v := range r.AvailableTime {
+		n.element("HealthcareService.availableTime", i, v)
+	}
+	for i, v := range r.NotAvailable {
+		n.element("HealthcareService.notAvailable", i, v)
+	}
+	n.primitive("HealthcareService.availabilityExceptions", -1, r.AvailabilityExceptions, r.AvailabilityExceptionsElement, "string")
+	for i, v := range r.Endpoint {
+		n.element("HealthcareService.endpoint", i, v)
+	}
+}
+
+// MarshalBSON marshals the given HealthcareService as BSON document with the structure of its FHIR JSON
+func (r HealthcareService) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given HealthcareService from a BSON document with the structure of its FHIR JSON
+func (r *HealthcareService) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given HealthcareService as protobuf message HealthcareService of fhir.proto
+func (r HealthcareService) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the HealthcareService to the encoded message
+func (r HealthcareService) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Active != nil {
+		e.varint(10, protoBool(*r.Active))
+	}
+	if r.ProvidedBy != nil {
+		e.message(11, *r.ProvidedBy)
+	}
+	for _, v := range r.Category {
+		e.message(12, v)
+	}
+	for _, v := range r.Type {
+		e.message(13, v)
+	}
+	for _, v := range r.Specialty {
+		e.message(14, v)
+	}
+	for _, v := range r.Location {
+		e.message(15, v)
+	}
+	if r.Name != nil {
+		e.string(16, *r.Name)
+	}
+	if r.Comment != nil {
+		e.string(17, *r.Comment)
+	}
+	if r.ExtraDetails != nil {
+		e.string(18, *r.ExtraDetails)
+	}
+	if r.Photo != nil {
+		e.message(19, *r.Photo)
+	}
+	for _, v := range r.Telecom {
+		e.message(20, v)
+	}
+	for _, v := range r.CoverageArea {
+		e.message(21, v)
+	}
+	for _, v := range r.ServiceProvisionCode {
+		e.message(22, v)
+	}
+	for _, v := range r.Eligibility {
+		e.message(23, v)
+	}
+	for _, v := range r.Program {
+		e.message(24, v)
+	}
+	for _, v := range r.Characteristic {
+		e.message(25, v)
+	}
+	for _, v := range r.Communication {
+		e.message(26, v)
+	}
+	for _, v := range r.ReferralMethod {
+		e.message(27, v)
+	}
+	if r.AppointmentRequired != nil {
+		e.varint(28, protoBool(*r.AppointmentRequired))
+	}
+	for _, v := range r.AvailableTime {
+		e.message(29, v)
+	}
+	for _, v := range r.NotAvailable {
+		e.message(30, v)
+	}
+	if r.AvailabilityExceptions != nil {
+		e.string(31, *r.AvailabilityExceptions)
+	}
+	for _, v := range r.Endpoint {
+		e.message(32, v)
+	}
+	if r.IdElement != nil {
+		e.message(33, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(34, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(35, *r.LanguageElement)
+	}
+	if r.ActiveElement != nil {
+		e.message(36, *r.ActiveElement)
+	}
+	if r.NameElement != nil {
+		e.message(37, *r.NameElement)
+	}
+	if r.CommentElement != nil {
+		e.message(38, *r.CommentElement)
+	}
+	if r.ExtraDetailsElement != nil {
+		e.message(39, *r.ExtraDetailsElement)
+	}
+	if r.AppointmentRequiredElement != nil {
+		e.message(40, *r.AppointmentRequiredElement)
+	}
+	if r.AvailabilityExceptionsElement != nil {
+		e.message(41, *r.AvailabilityExceptionsElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given HealthcareService from protobuf message HealthcareService of fhir.proto
+func (r *HealthcareService) UnmarshalProto(b []byte) error {
+	*r = HealthcareService{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.bool()
+			r.Active = &v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.ProvidedBy = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Specialty = append(r.Specialty, v)
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Location = append(r.Location, v)
+		case 16:
+			v := d.string()
+			r.Name = &v
+		case 17:
+			v := d.string()
+			r.Comment = &v
+		case 18:
+			v := d.string()
+			r.ExtraDetails = &v
+		case 19:
+			var v Attachment
+			d.message(&v)
+			r.Photo = &v
+		case 20:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.CoverageArea = append(r.CoverageArea, v)
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.ServiceProvisionCode = append(r.ServiceProvisionCode, v)
+		case 23:
+			var v HealthcareServiceEligibility
+			d.message(&v)
+			r.Eligibility = append(r.Eligibility, v)
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.Program = append(r.Program, v)
+		case 25:
+			var v CodeableConcept
+			d.message(&v)
+			r.Characteristic = append(r.Characteristic, v)
+		case 26:
+			var v CodeableConcept
+			d.message(&v)
+			r.Communication = append(r.Communication, v)
+		case 27:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReferralMethod = append(r.ReferralMethod, v)
+		case 28:
+			v := d.bool()
+			r.AppointmentRequired = &v
+		case 29:
+			var v HealthcareServiceAvailableTime
+			d.message(&v)
+			r.AvailableTime = append(r.AvailableTime, v)
+		case 30:
+			var v HealthcareServiceNotAvailable
+			d.message(&v)
+			r.NotAvailable = append(r.NotAvailable, v)
+		case 31:
+			v := d.string()
+			r.AvailabilityExceptions = &v
+		case 32:
+			var v Reference
+			d.message(&v)
+			r.Endpoint = append(r.Endpoint, v)
+		case 33:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.ActiveElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.ExtraDetailsElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.AppointmentRequiredElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.AvailabilityExceptionsElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given HealthcareServiceEligibility as JSON into a byte slice
+func (r HealthcareServiceEligibility) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the HealthcareServiceEligibility as JSON object with the members in the order of the fields
+func (r HealthcareServiceEligibility) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given HealthcareServiceEligibility from JSON. Unknown members are ignored.
+func (r *HealthcareServiceEligibility) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the HealthcareServiceEligibility
+func (r *HealthcareServiceEligibility) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the HealthcareServiceEligibility which shares no memory with the original
+func (r HealthcareServiceEligibility) DeepCopy() HealthcareServiceEligibility {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r HealthcareServiceEligibility) Equal(other HealthcareServiceEligibility) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r HealthcareServiceEligibility) EqualsDeep(other HealthcareServiceEligibility) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r HealthcareServiceEligibility) EqualsShallow(other HealthcareServiceEligibility) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given HealthcareServiceEligibility as FHIR XML
+func (r HealthcareServiceEligibility) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given HealthcareServiceEligibility from FHIR XML
+func (r *HealthcareServiceEligibility) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the HealthcareServiceEligibility as properties to the RDF node n
+func (r HealthcareServiceEligibility) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("HealthcareService.eligibility.code", -1, r.Code)
+	n.primitive("HealthcareService.eligibility.comment", -1, r.Comment, r.CommentElement, "string")
+}
+
+// MarshalBSON marshals the given HealthcareServiceEligibility as BSON document with the structure of its FHIR JSON
+func (r HealthcareServiceEligibility) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given HealthcareServiceEligibility from a BSON document with the structure of its FHIR JSON
+func (r *HealthcareServiceEligibility) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given HealthcareServiceEligibility as protobuf message HealthcareServiceEligibility of fhir.proto
+func (r HealthcareServiceEligibility) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the HealthcareServiceEligibility to the encoded message
+func (r HealthcareServiceEligibility) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != nil {
+		e.message(4, *r.Code)
+	}
+	if r.Comment != nil {
+		e.string(5, *r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.message(6, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given HealthcareServiceEligibility from protobuf message HealthcareServiceEligibility of fhir.proto
+func (r *HealthcareServiceEligibility) UnmarshalProto(b []byte) error {
+	*r = HealthcareServiceEligibility{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 5:
+			v := d.string()
+			r.Comment = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given HealthcareServiceAvailableTime as JSON into a byte slice
+func (r HealthcareServiceAvailableTime) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the HealthcareServiceAvailableTime as JSON object with the members in the order of the fields
+func (r HealthcareServiceAvailableTime) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DaysOfWeek) > 0 {
+		e.key("daysOfWeek")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DaysOfWeek {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DaysOfWeekElement) > 0 {
+		e.key("_daysOfWeek")
+		e.elements(r.DaysOfWeekElement, len(r.DaysOfWeek))
+	}
+	if r.AllDay != nil {
+		e.key("allDay")
+		e.bool(*r.AllDay)
+	}
+	if r.AllDayElement != nil {
+		e.key("_allDay")
+		r.AllDayElement.appendJSON(e)
+	}
+	if r.AvailableStartTime != nil {
+		e.key("availableStartTime")
+		e.string(*r.AvailableStartTime)
+	}
+	if r.AvailableStartTimeElement != nil {
+		e.key("_availableStartTime")
+		r.AvailableStartTimeElement.appendJSON(e)
+	}
+	if r.AvailableEndTime != nil {
+		e.key("availableEndTime")
+		e.string(*r.AvailableEndTime)
+	}
+	if r.AvailableEndTimeElement != nil {
+		e.key("_availableEndTime")
+		r.AvailableEndTimeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given HealthcareServiceAvailableTime from JSON. Unknown members are ignored.
+func (r *HealthcareServiceAvailableTime) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the HealthcareServiceAvailableTime
+func (r *HealthcareServiceAvailableTime) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "daysOfWeek":
+			a := d.array()
+			r.DaysOfWeek = nil
+			for a.next() {
+				var v DaysOfWeek
+				d.unmarshal(&v)
+				r.DaysOfWeek = append(r.DaysOfWeek, v)
+			}
+			if r.DaysOfWeek == nil && !a.isNull {
+				r.DaysOfWeek = []DaysOfWeek{}
+			}
+		case "_daysOfWeek":
+			a := d.array()
+			r.DaysOfWeekElement = nil
+			for a.next() {
+				r.DaysOfWeekElement = append(r.DaysOfWeekElement, d.element())
+			}
+		case "allDay":
+			if v, ok := d.bool(); ok {
+				r.AllDay = &v
+			} else {
+				r.AllDay = nil
+			}
+		case "_allDay":
+			r.AllDayElement = d.element()
+		case "availableStartTime":
+			if v, ok := d.string(); ok {
+				r.AvailableStartTime = &v
+			} else {
+				r.AvailableStartTime = nil
+			}
+		case "_availableStartTime":
+			r.AvailableStartTimeElement = d.element()
+		case "availableEndTime":
+			if v, ok := d.string(); ok {
+				r.AvailableEndTime = &v
+			} else {
+				r.AvailableEndTime = nil
+			}
+		case "_availableEndTime":
+			r.AvailableEndTimeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the HealthcareServiceAvailableTime which shares no memory with the original
+func (r HealthcareServiceAvailableTime) DeepCopy() HealthcareServiceAvailableTime {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DaysOfWeek != nil {
+		out.DaysOfWeek = make([]DaysOfWeek, len(r.DaysOfWeek))
+		copy(out.DaysOfWeek, r.DaysOfWeek)
+	}
+	if r.DaysOfWeekElement != nil {
+		out.DaysOfWeekElement = make([]*Element, len(r.DaysOfWeekElement))
+		for i, v := range r.DaysOfWeekElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DaysOfWeekElement[i] = &c
+			}
+		}
+	}
+	if r.AllDay != nil {
+		v := *r.AllDay
+		out.AllDay = &v
+	}
+	if r.AllDayElement != nil {
+		v := r.AllDayElement.DeepCopy()
+		out.AllDayElement = &v
+	}
+	if r.AvailableStartTime != nil {
+		v := *r.AvailableStartTime
+		out.AvailableStartTime = &v
+	}
+	if r.AvailableStartTimeElement != nil {
+		v := r.AvailableStartTimeElement.DeepCopy()
+		out.AvailableStartTimeElement = &v
+	}
+	if r.AvailableEndTime != nil {
+		v := *r.AvailableEndTime
+		out.AvailableEndTime = &v
+	}
+	if r.AvailableEndTimeElement != nil {
+		v := r.AvailableEndTimeElement.DeepCopy()
+		out.AvailableEndTimeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r HealthcareServiceAvailableTime) Equal(other HealthcareServiceAvailableTime) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.DaysOfWeek) != len(other.DaysOfWeek) {
+		return false
+	}
+	for i := range r.DaysOfWeek {
+		if r.DaysOfWeek[i] != other.DaysOfWeek[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DaysOfWeekElement, other.DaysOfWeekElement, false) {
+		return false
+	}
+	if (r.AllDay == nil) != (other.AllDay == nil) || r.AllDay != nil && *r.AllDay != *other.AllDay {
+		return false
+	}
+	if (r.AllDayElement == nil) != (other.AllDayElement == nil) || r.AllDayElement != nil && !r.AllDayElement.Equal(*other.AllDayElement) {
+		return false
+	}
+	if (r.AvailableStartTime == nil) != (other.AvailableStartTime == nil) || r.AvailableStartTime != nil && *r.AvailableStartTime != *other.AvailableStartTime {
+		return false
+	}
+	if (r.AvailableStartTimeElement == nil) != (other.AvailableStartTimeElement == nil) || r.AvailableStartTimeElement != nil && !r.AvailableStartTimeElement.Equal(*other.AvailableStartTimeElement) {
+		return false
+	}
+	if (r.AvailableEndTime == nil) != (other.AvailableEndTime == nil) || r.AvailableEndTime != nil && *r.AvailableEndTime != *other.AvailableEndTime {
+		return false
+	}
+	if (r.AvailableEndTimeElement == nil) != (other.AvailableEndTimeElement == nil) || r.AvailableEndTimeElement != nil && !r.AvailableEndTimeElement.Equal(*other.AvailableEndTimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r HealthcareServiceAvailableTime) EqualsDeep(other HealthcareServiceAvailableTime) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DaysOfWeek), len(other.DaysOfWeek), func(i, j int) bool {
+		return r.DaysOfWeek[i] == other.DaysOfWeek[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.DaysOfWeekElement, other.DaysOfWeekElement, true) {
+		return false
+	}
+	if (r.AllDay == nil) != (other.AllDay == nil) || r.AllDay != nil && *r.AllDay != *other.AllDay {
+		return false
+	}
+	if (r.AllDayElement == nil) != (other.AllDayElement == nil) || r.AllDayElement != nil && !r.AllDayElement.EqualsDeep(*other.AllDayElement) {
+		return false
+	}
+	if (r.AvailableStartTime == nil) != (other.AvailableStartTime == nil) || r.AvailableStartTime != nil && !equivalentString(*r.AvailableStartTime, *other.AvailableStartTime) {
+		return false
+	}
+	if (r.AvailableStartTimeElement == nil) != (other.AvailableStartTimeElement == nil) || r.AvailableStartTimeElement != nil && !r.AvailableStartTimeElement.EqualsDeep(*other.AvailableStartTimeElement) {
+		return false
+	}
+	if (r.AvailableEndTime == nil) != (other.AvailableEndTime == nil) || r.AvailableEndTime != nil && !equivalentString(*r.AvailableEndTime, *other.AvailableEndTime) {
+		return false
+	}
+	if (r.AvailableEndTimeElement == nil) != (other.AvailableEndTimeElement == nil) || r.AvailableEndTimeElement != nil && !r.AvailableEndTimeElement.EqualsDeep(*other.AvailableEndTimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r HealthcareServiceAvailableTime) EqualsShallow(other HealthcareServiceAvailableTime) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.DaysOfWeek), len(other.DaysOfWeek), func(i, j int) bool {
+		return r.DaysOfWeek[i] == other.DaysOfWeek[j]
+	}) {
+		return false
+	}
+	if (r.AllDay == nil) != (other.AllDay == nil) || r.AllDay != nil && *r.AllDay != *other.AllDay {
+		return false
+	}
+	if (r.AvailableStartTime == nil) != (other.AvailableStartTime == nil) || r.AvailableStartTime != nil && !equivalentString(*r.AvailableStartTime, *other.AvailableStartTime) {
+		return false
+	}
+	if (r.AvailableEndTime == nil) != (other.AvailableEndTime == nil) || r.AvailableEndTime != nil && !equivalentString(*r.AvailableEndTime, *other.AvailableEndTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given HealthcareServiceAvailableTime as FHIR XML
+func (r HealthcareServiceAvailableTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.DaysOfWeek {
+		if err := encodeXMLPrimitive(e, "daysOfWeek", v, elementAt(r.DaysOfWeekElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "allDay", r.AllDay, r.AllDayElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "availableStartTime", r.AvailableStartTime, r.AvailableStartTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "availableEndTime", r.AvailableEndTime, r.AvailableEndTimeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given HealthcareServiceAvailableTime from FHIR XML
+func (r *HealthcareServiceAvailableTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "daysOfWeek":
+				var v DaysOfWeek
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DaysOfWeekElement = appendElement(r.DaysOfWeekElement, len(r.DaysOfWeek), element)
+					r.DaysOfWeek = append(r.DaysOfWeek, v)
+				}
+			case "allDay":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllDay = &v
+				}
+				r.AllDayElement = element
+			case "availableStartTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AvailableStartTime = &v
+				}
+				r.AvailableStartTimeElement = element
+			case "availableEndTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AvailableEndTime = &v
+				}
+				r.AvailableEndTimeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the HealthcareServiceAvailableTime as properties to the RDF node n
+func (r HealthcareServiceAvailableTime) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.DaysOfWeek {
+		n.primitive("HealthcareService.availableTime.daysOfWeek", i, v, elementAt(r.DaysOfWeekElement, i), "code")
+	}
+	n.primitive("HealthcareService.availableTime.allDay", -1, r.AllDay, r.AllDayElement, "boolean")
+	n.primitive("HealthcareService.availableTime.availableStartTime", -1, r.AvailableStartTime, r.AvailableStartTimeElement, "time")
+	n.primitive("HealthcareService.availableTime.availableEndTime", -1, r.AvailableEndTime, r.AvailableEndTimeElement, "time")
+}
+
+// MarshalBSON marshals the given HealthcareServiceAvailableTime as BSON document with the structure of its FHIR JSON
+func (r HealthcareServiceAvailableTime) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given HealthcareServiceAvailableTime from a BSON document with the structure of its FHIR JSON
+func (r *HealthcareServiceAvailableTime) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given HealthcareServiceAvailableTime as protobuf message HealthcareServiceAvailableTime of fhir.proto
+func (r HealthcareServiceAvailableTime) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the HealthcareServiceAvailableTime to the encoded message
+func (r HealthcareServiceAvailableTime) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if len(r.DaysOfWeek) > 0 {
+		var p protoEncoder
+		for _, v := range r.DaysOfWeek {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(4, p.buf)
+	}
+	if r.AllDay != nil {
+		e.varint(5, protoBool(*r.AllDay))
+	}
+	if r.AvailableStartTime != nil {
+		e.string(6, *r.AvailableStartTime)
+	}
+	if r.AvailableEndTime != nil {
+		e.string(7, *r.AvailableEndTime)
+	}
+	for _, v := range r.DaysOfWeekElement {
+		e.element(8, v)
+	}
+	if r.AllDayElement != nil {
+		e.message(9, *r.AllDayElement)
+	}
+	if r.AvailableStartTimeElement != nil {
+		e.message(10, *r.AvailableStartTimeElement)
+	}
+	if r.AvailableEndTimeElement != nil {
+		e.message(11, *r.AvailableEndTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given HealthcareServiceAvailableTime from protobuf message HealthcareServiceAvailableTime of fhir.proto
+func (r *HealthcareServiceAvailableTime) UnmarshalProto(b []byte) error {
+	*r = HealthcareServiceAvailableTime{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			for _, n := range d.varints() {
+				var v DaysOfWeek
+				d.enum(n, v.setProtoNumber)
+				r.DaysOfWeek = append(r.DaysOfWeek, v)
+			}
+		case 5:
+			v := d.bool()
+			r.AllDay = &v
+		case 6:
+			v := d.string()
+			r.AvailableStartTime = &v
+		case 7:
+			v := d.string()
+			r.AvailableEndTime = &v
+		case 8:
+			r.DaysOfWeekElement = append(r.DaysOfWeekElement, d.element())
+		case 9:
+			var v Element
+			d.message(&v)
+			r.AllDayElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.AvailableStartTimeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.AvailableEndTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given HealthcareServiceNotAvailable as JSON into a byte slice
+func (r HealthcareServiceNotAvailable) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the HealthcareServiceNotAvailable as JSON object with the members in the order of the fields
+func (r HealthcareServiceNotAvailable) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("description")
+	e.string(r.Description)
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.During != nil {
+		e.key("during")
+		r.During.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given HealthcareServiceNotAvailable from JSON. Unknown members are ignored.
+func (r *HealthcareServiceNotAvailable) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the HealthcareServiceNotAvailable
+func (r *HealthcareServiceNotAvailable) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = v
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "during":
+			if d.null() {
+				r.During = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.During = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the HealthcareServiceNotAvailable which shares no memory with the original
+func (r HealthcareServiceNotAvailable) DeepCopy() HealthcareServiceNotAvailable {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.During != nil {
+		v := r.During.DeepCopy()
+		out.During = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r HealthcareServiceNotAvailable) Equal(other HealthcareServiceNotAvailable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Description != other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.During == nil) != (other.During == nil) || r.During != nil && !r.During.Equal(*other.During) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r HealthcareServiceNotAvailable) EqualsDeep(other HealthcareServiceNotAvailable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.During == nil) != (other.During == nil) || r.During != nil && !r.During.EqualsDeep(*other.During) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r HealthcareServiceNotAvailable) EqualsShallow(other HealthcareServiceNotAvailable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given HealthcareServiceNotAvailable as FHIR XML
+func (r HealthcareServiceNotAvailable) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "during", r.During); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given HealthcareServiceNotAvailable from FHIR XML
+func (r *HealthcareServiceNotAvailable) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = v
+				}
+				r.DescriptionElement = element
+			case "during":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.During = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the HealthcareServiceNotAvailable as properties to the RDF node n
+func (r HealthcareServiceNotAvailable) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("HealthcareService.notAvailable.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("HealthcareService.notAvailable.during", -1, r.During)
+}
+
+// MarshalBSON marshals the given HealthcareServiceNotAvailable as BSON document with the structure of its FHIR JSON
+func (r HealthcareServiceNotAvailable) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given HealthcareServiceNotAvailable from a BSON document with the structure of its FHIR JSON
+func (r *HealthcareServiceNotAvailable) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given HealthcareServiceNotAvailable as protobuf message HealthcareServiceNotAvailable of fhir.proto
+func (r HealthcareServiceNotAvailable) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the HealthcareServiceNotAvailable to the encoded message
+func (r HealthcareServiceNotAvailable) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != "" {
+		e.string(4, r.Description)
+	}
+	if r.During != nil {
+		e.message(5, *r.During)
+	}
+	if r.DescriptionElement != nil {
+		e.message(6, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given HealthcareServiceNotAvailable from protobuf message HealthcareServiceNotAvailable of fhir.proto
+func (r *HealthcareServiceNotAvailable) UnmarshalProto(b []byte) error {
+	*r = HealthcareServiceNotAvailable{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Description = d.string()
+		case 5:
+			var v Period
+			d.message(&v)
+			r.During = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalHealthcareService unmarshals a HealthcareService.
 func UnmarshalHealthcareService(b []byte) (HealthcareService, error) {
 	var healthcareService HealthcareService
-	if err := json.Unmarshal(b, &healthcareService); err != nil {
+	if err := healthcareService.UnmarshalJSON(b); err != nil {
 		return healthcareService, err
 	}
 	return healthcareService, nil
@@ -14,18 +14,773 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // HumanName is documented here http://hl7.org/fhir/StructureDefinition/HumanName
 type HumanName struct {
-	Id        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Use       *NameUse    `bson:"use,omitempty" json:"use,omitempty"`
-	Text      *string     `bson:"text,omitempty" json:"text,omitempty"`
-	Family    *string     `bson:"family,omitempty" json:"family,omitempty"`
-	Given     []string    `bson:"given,omitempty" json:"given,omitempty"`
-	Prefix    []string    `bson:"prefix,omitempty" json:"prefix,omitempty"`
-	Suffix    []string    `bson:"suffix,omitempty" json:"suffix,omitempty"`
-	Period    *Period     `bson:"period,omitempty" json:"period,omitempty"`
+	Id            *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension     []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Use           *NameUse    `bson:"use,omitempty" json:"use,omitempty"`
+	UseElement    *Element    `bson:"_use,omitempty" json:"_use,omitempty"`
+	Text          *string     `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement   *Element    `bson:"_text,omitempty" json:"_text,omitempty"`
+	Family        *string     `bson:"family,omitempty" json:"family,omitempty"`
+	FamilyElement *Element    `bson:"_family,omitempty" json:"_family,omitempty"`
+	Given         []string    `bson:"given,omitempty" json:"given,omitempty"`
+	GivenElement  []*Element  `bson:"_given,omitempty" json:"_given,omitempty"`
+	Prefix        []string    `bson:"prefix,omitempty" json:"prefix,omitempty"`
+	PrefixElement []*Element  `bson:"_prefix,omitempty" json:"_prefix,omitempty"`
+	Suffix        []string    `bson:"suffix,omitempty" json:"suffix,omitempty"`
+	SuffixElement []*Element  `bson:"_suffix,omitempty" json:"_suffix,omitempty"`
+	Period        *Period     `bson:"period,omitempty" json:"period,omitempty"`
+}
+
+// MarshalJSON marshals the given HumanName as JSON into a byte slice
+func (r HumanName) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the HumanName as JSON object with the members in the order of the fields
+func (r HumanName) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Use != nil {
+		e.key("use")
+		e.string(r.Use.Code())
+	}
+	if r.UseElement != nil {
+		e.key("_use")
+		r.UseElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	if r.Family != nil {
+		e.key("family")
+		e.string(*r.Family)
+	}
+	if r.FamilyElement != nil {
+		e.key("_family")
+		r.FamilyElement.appendJSON(e)
+	}
+	if len(r.Given) > 0 {
+		e.key("given")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Given {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.GivenElement) > 0 {
+		e.key("_given")
+		e.elements(r.GivenElement, len(r.Given))
+	}
+	if len(r.Prefix) > 0 {
+		e.key("prefix")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Prefix {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PrefixElement) > 0 {
+		e.key("_prefix")
+		e.elements(r.PrefixElement, len(r.Prefix))
+	}
+	if len(r.Suffix) > 0 {
+		e.key("suffix")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Suffix {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SuffixElement) > 0 {
+		e.key("_suffix")
+		e.elements(r.SuffixElement, len(r.Suffix))
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given HumanName from JSON. Unknown members are ignored.
+func (r *HumanName) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the HumanName
+func (r *HumanName) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "use":
+			if d.null() {
+				r.Use = nil
+			} else {
+				var v NameUse
+				d.unmarshal(&v)
+				r.Use = &v
+			}
+		case "_use":
+			r.UseElement = d.element()
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "family":
+			if v, ok := d.string(); ok {
+				r.Family = &v
+			} else {
+				r.Family = nil
+			}
+		case "_family":
+			r.FamilyElement = d.element()
+		case "given":
+			a := d.array()
+			r.Given = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Given = append(r.Given, v)
+			}
+			if r.Given == nil && !a.isNull {
+				r.Given = []string{}
+			}
+		case "_given":
+			a := d.array()
+			r.GivenElement = nil
+			for a.next() {
+				r.GivenElement = append(r.GivenElement, d.element())
+			}
+		case "prefix":
+			a := d.array()
+			r.Prefix = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Prefix = append(r.Prefix, v)
+			}
+			if r.Prefix == nil && !a.isNull {
+				r.Prefix = []string{}
+			}
+		case "_prefix":
+			a := d.array()
+			r.PrefixElement = nil
+			for a.next() {
+				r.PrefixElement = append(r.PrefixElement, d.element())
+			}
+		case "suffix":
+			a := d.array()
+			r.Suffix = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Suffix = append(r.Suffix, v)
+			}
+			if r.Suffix == nil && !a.isNull {
+				r.Suffix = []string{}
+			}
+		case "_suffix":
+			a := d.array()
+			r.SuffixElement = nil
+			for a.next() {
+				r.SuffixElement = append(r.SuffixElement, d.element())
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the HumanName which shares no memory with the original
+func (r HumanName) DeepCopy() HumanName {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Use != nil {
+		v := *r.Use
+		out.Use = &v
+	}
+	if r.UseElement != nil {
+		v := r.UseElement.DeepCopy()
+		out.UseElement = &v
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.Family != nil {
+		v := *r.Family
+		out.Family = &v
+	}
+	if r.FamilyElement != nil {
+		v := r.FamilyElement.DeepCopy()
+		out.FamilyElement = &v
+	}
+	if r.Given != nil {
+		out.Given = make([]string, len(r.Given))
+		copy(out.Given, r.Given)
+	}
+	if r.GivenElement != nil {
+		out.GivenElement = make([]*Element, len(r.GivenElement))
+		for i, v := range r.GivenElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.GivenElement[i] = &c
+			}
+		}
+	}
+	if r.Prefix != nil {
+		out.Prefix = make([]string, len(r.Prefix))
+		copy(out.Prefix, r.Prefix)
+	}
+	if r.PrefixElement != nil {
+		out.PrefixElement = make([]*Element, len(r.PrefixElement))
+		for i, v := range r.PrefixElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PrefixElement[i] = &c
+			}
+		}
+	}
+	if r.Suffix != nil {
+		out.Suffix = make([]string, len(r.Suffix))
+		copy(out.Suffix, r.Suffix)
+	}
+	if r.SuffixElement != nil {
+		out.SuffixElement = make([]*Element, len(r.SuffixElement))
+		for i, v := range r.SuffixElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SuffixElement[i] = &c
+			}
+		}
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r HumanName) Equal(other HumanName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && *r.Use != *other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.Equal(*other.UseElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if (r.Family == nil) != (other.Family == nil) || r.Family != nil && *r.Family != *other.Family {
+		return false
+	}
+	if (r.FamilyElement == nil) != (other.FamilyElement == nil) || r.FamilyElement != nil && !r.FamilyElement.Equal(*other.FamilyElement) {
+		return false
+	}
+	if len(r.Given) != len(other.Given) {
+		return false
+	}
+	for i := range r.Given {
+		if r.Given[i] != other.Given[i] {
+			return false
+		}
+	}
+	if !equalElements(r.GivenElement, other.GivenElement, false) {
+		return false
+	}
+	if len(r.Prefix) != len(other.Prefix) {
+		return false
+	}
+	for i := range r.Prefix {
+		if r.Prefix[i] != other.Prefix[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PrefixElement, other.PrefixElement, false) {
+		return false
+	}
+	if len(r.Suffix) != len(other.Suffix) {
+		return false
+	}
+	for i := range r.Suffix {
+		if r.Suffix[i] != other.Suffix[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SuffixElement, other.SuffixElement, false) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r HumanName) EqualsDeep(other HumanName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && *r.Use != *other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.EqualsDeep(*other.UseElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if (r.Family == nil) != (other.Family == nil) || r.Family != nil && !equivalentString(*r.Family, *other.Family) {
+		return false
+	}
+	if (r.FamilyElement == nil) != (other.FamilyElement == nil) || r.FamilyElement != nil && !r.FamilyElement.EqualsDeep(*other.FamilyElement) {
+		return false
+	}
+	if !equivalentList(len(r.Given), len(other.Given), func(i, j int) bool {
+		return equivalentString(r.Given[i], other.Given[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.GivenElement, other.GivenElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Prefix), len(other.Prefix), func(i, j int) bool {
+		return equivalentString(r.Prefix[i], other.Prefix[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PrefixElement, other.PrefixElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Suffix), len(other.Suffix), func(i, j int) bool {
+		return equivalentString(r.Suffix[i], other.Suffix[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.SuffixElement, other.SuffixElement, true) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r HumanName) EqualsShallow(other HumanName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && *r.Use != *other.Use {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.Family == nil) != (other.Family == nil) || r.Family != nil && !equivalentString(*r.Family, *other.Family) {
+		return false
+	}
+	if !equivalentList(len(r.Given), len(other.Given), func(i, j int) bool {
+		return equivalentString(r.Given[i], other.Given[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Prefix), len(other.Prefix), func(i, j int) bool {
+		return equivalentString(r.Prefix[i], other.Prefix[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Suffix), len(other.Suffix), func(i, j int) bool {
+		return equivalentString(r.Suffix[i], other.Suffix[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given HumanName as FHIR XML
+func (r HumanName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "use", r.Use, r.UseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "family", r.Family, r.FamilyElement); err != nil {
+		return err
+	}
+	for i, v := range r.Given {
+		if err := encodeXMLPrimitive(e, "given", v, elementAt(r.GivenElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Prefix {
+		if err := encodeXMLPrimitive(e, "prefix", v, elementAt(r.PrefixElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Suffix {
+		if err := encodeXMLPrimitive(e, "suffix", v, elementAt(r.SuffixElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given HumanName from FHIR XML
+func (r *HumanName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "use":
+				var v NameUse
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Use = &v
+				}
+				r.UseElement = element
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			case "family":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Family = &v
+				}
+				r.FamilyElement = element
+			case "given":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.GivenElement = appendElement(r.GivenElement, len(r.Given), element)
+					r.Given = append(r.Given, v)
+				}
+			case "prefix":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PrefixElement = appendElement(r.PrefixElement, len(r.Prefix), element)
+					r.Prefix = append(r.Prefix, v)
+				}
+			case "suffix":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SuffixElement = appendElement(r.SuffixElement, len(r.Suffix), element)
+					r.Suffix = append(r.Suffix, v)
+				}
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the HumanName as properties to the RDF node n
+func (r HumanName) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("HumanName.use", -1, r.Use, r.UseElement, "code")
+	n.primitive("HumanName.text", -1, r.Text, r.TextElement, "string")
+	n.primitive("HumanName.family", -1, r.Family, r.FamilyElement, "string")
+	for i, v := range r.Given {
+		n.primitive("HumanName.given", i, v, elementAt(r.GivenElement, i), "string")
+	}
+	for i, v := range r.Prefix {
+		n.primitive("HumanName.prefix", i, v, elementAt(r.PrefixElement, i), "string")
+	}
+	for i, v := range r.Suffix {
+		n.primitive("HumanName.suffix", i, v, elementAt(r.SuffixElement, i), "string")
+	}
+	n.element("HumanName.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given HumanName as BSON document with the structure of its FHIR JSON
+func (r HumanName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given HumanName from a BSON document with the structure of its FHIR JSON
+func (r *HumanName) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given HumanName as protobuf message HumanName of fhir.proto
+func (r HumanName) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the HumanName to the encoded message
+func (r HumanName) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Use != nil {
+		e.varint(3, r.Use.protoNumber())
+	}
+	if r.Text != nil {
+		e.string(4, *r.Text)
+	}
+	if r.Family != nil {
+		e.string(5, *r.Family)
+	}
+	for _, v := range r.Given {
+		e.string(6, v)
+	}
+	for _, v := range r.Prefix {
+		e.string(7, v)
+	}
+	for _, v := range r.Suffix {
+		e.string(8, v)
+	}
+	if r.Period != nil {
+		e.message(9, *r.Period)
+	}
+	if r.UseElement != nil {
+		e.message(10, *r.UseElement)
+	}
+	if r.TextElement != nil {
+		e.message(11, *r.TextElement)
+	}
+	if r.FamilyElement != nil {
+		e.message(12, *r.FamilyElement)
+	}
+	for _, v := range r.GivenElement {
+		e.element(13, v)
+	}
+	for _, v := range r.PrefixElement {
+		e.element(14, v)
+	}
+	for _, v := range r.SuffixElement {
+		e.element(15, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given HumanName from protobuf message HumanName of fhir.proto
+func (r *HumanName) UnmarshalProto(b []byte) error {
+	*r = HumanName{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v NameUse
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Use = &v
+		case 4:
+			v := d.string()
+			r.Text = &v
+		case 5:
+			v := d.string()
+			r.Family = &v
+		case 6:
+			r.Given = append(r.Given, d.string())
+		case 7:
+			r.Prefix = append(r.Prefix, d.string())
+		case 8:
+			r.Suffix = append(r.Suffix, d.string())
+		case 9:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.UseElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.FamilyElement = &v
+		case 13:
+			r.GivenElement = append(r.GivenElement, d.element())
+		case 14:
+			r.PrefixElement = append(r.PrefixElement, d.element())
+		case 15:
+			r.SuffixElement = append(r.SuffixElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
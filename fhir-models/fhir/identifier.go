@@ -14,17 +14,565 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Identifier is documented here http://hl7.org/fhir/StructureDefinition/Identifier
 type Identifier struct {
-	Id        *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	Use       *IdentifierUse   `bson:"use,omitempty" json:"use,omitempty"`
-	Type      *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	System    *string          `bson:"system,omitempty" json:"system,omitempty"`
-	Value     *string          `bson:"value,omitempty" json:"value,omitempty"`
-	Period    *Period          `bson:"period,omitempty" json:"period,omitempty"`
-	Assigner  *Reference       `bson:"assigner,omitempty" json:"assigner,omitempty"`
+	Id            *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension     []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	Use           *IdentifierUse   `bson:"use,omitempty" json:"use,omitempty"`
+	UseElement    *Element         `bson:"_use,omitempty" json:"_use,omitempty"`
+	Type          *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	System        *string          `bson:"system,omitempty" json:"system,omitempty"`
+	SystemElement *Element         `bson:"_system,omitempty" json:"_system,omitempty"`
+	Value         *string          `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement  *Element         `bson:"_value,omitempty" json:"_value,omitempty"`
+	Period        *Period          `bson:"period,omitempty" json:"period,omitempty"`
+	Assigner      *Reference       `bson:"assigner,omitempty" json:"assigner,omitempty"`
+}
+
+// MarshalJSON marshals the given Identifier as JSON into a byte slice
+func (r Identifier) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Identifier as JSON object with the members in the order of the fields
+func (r Identifier) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Use != nil {
+		e.key("use")
+		e.string(r.Use.Code())
+	}
+	if r.UseElement != nil {
+		e.key("_use")
+		r.UseElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.System != nil {
+		e.key("system")
+		e.string(*r.System)
+	}
+	if r.SystemElement != nil {
+		e.key("_system")
+		r.SystemElement.appendJSON(e)
+	}
+	if r.Value != nil {
+		e.key("value")
+		e.string(*r.Value)
+	}
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.Assigner != nil {
+		e.key("assigner")
+		r.Assigner.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Identifier from JSON. Unknown members are ignored.
+func (r *Identifier) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Identifier
+func (r *Identifier) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "use":
+			if d.null() {
+				r.Use = nil
+			} else {
+				var v IdentifierUse
+				d.unmarshal(&v)
+				r.Use = &v
+			}
+		case "_use":
+			r.UseElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "system":
+			if v, ok := d.string(); ok {
+				r.System = &v
+			} else {
+				r.System = nil
+			}
+		case "_system":
+			r.SystemElement = d.element()
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = &v
+			} else {
+				r.Value = nil
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "assigner":
+			if d.null() {
+				r.Assigner = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Assigner = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Identifier which shares no memory with the original
+func (r Identifier) DeepCopy() Identifier {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Use != nil {
+		v := *r.Use
+		out.Use = &v
+	}
+	if r.UseElement != nil {
+		v := r.UseElement.DeepCopy()
+		out.UseElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.System != nil {
+		v := *r.System
+		out.System = &v
+	}
+	if r.SystemElement != nil {
+		v := r.SystemElement.DeepCopy()
+		out.SystemElement = &v
+	}
+	if r.Value != nil {
+		v := *r.Value
+		out.Value = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Assigner != nil {
+		v := r.Assigner.DeepCopy()
+		out.Assigner = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Identifier) Equal(other Identifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && *r.Use != *other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.Equal(*other.UseElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && *r.System != *other.System {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.Equal(*other.SystemElement) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && *r.Value != *other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.Assigner == nil) != (other.Assigner == nil) || r.Assigner != nil && !r.Assigner.Equal(*other.Assigner) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Identifier) EqualsDeep(other Identifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && *r.Use != *other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.EqualsDeep(*other.UseElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.EqualsDeep(*other.SystemElement) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentString(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.Assigner == nil) != (other.Assigner == nil) || r.Assigner != nil && !r.Assigner.EqualsDeep(*other.Assigner) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Identifier) EqualsShallow(other Identifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Use == nil) != (other.Use == nil) || r.Use != nil && *r.Use != *other.Use {
+		return false
+	}
+	if (r.System == nil) != (other.System == nil) || r.System != nil && !equivalentString(*r.System, *other.System) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentString(*r.Value, *other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Identifier as FHIR XML
+func (r Identifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "use", r.Use, r.UseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "system", r.System, r.SystemElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "assigner", r.Assigner); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Identifier from FHIR XML
+func (r *Identifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "use":
+				var v IdentifierUse
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Use = &v
+				}
+				r.UseElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "system":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.System = &v
+				}
+				r.SystemElement = element
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = &v
+				}
+				r.ValueElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "assigner":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Assigner = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Identifier as properties to the RDF node n
+func (r Identifier) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Identifier.use", -1, r.Use, r.UseElement, "code")
+	n.element("Identifier.type", -1, r.Type)
+	n.primitive("Identifier.system", -1, r.System, r.SystemElement, "string")
+	n.primitive("Identifier.value", -1, r.Value, r.ValueElement, "string")
+	n.element("Identifier.period", -1, r.Period)
+	n.element("Identifier.assigner", -1, r.Assigner)
+}
+
+// MarshalBSON marshals the given Identifier as BSON document with the structure of its FHIR JSON
+func (r Identifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Identifier from a BSON document with the structure of its FHIR JSON
+func (r *Identifier) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Identifier as protobuf message Identifier of fhir.proto
+func (r Identifier) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Identifier to the encoded message
+func (r Identifier) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Use != nil {
+		e.varint(3, r.Use.protoNumber())
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.System != nil {
+		e.string(5, *r.System)
+	}
+	if r.Value != nil {
+		e.string(6, *r.Value)
+	}
+	if r.Period != nil {
+		e.message(7, *r.Period)
+	}
+	if r.Assigner != nil {
+		e.message(8, *r.Assigner)
+	}
+	if r.UseElement != nil {
+		e.message(9, *r.UseElement)
+	}
+	if r.SystemElement != nil {
+		e.message(10, *r.SystemElement)
+	}
+	if r.ValueElement != nil {
+		e.message(11, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Identifier from protobuf message Identifier of fhir.proto
+func (r *Identifier) UnmarshalProto(b []byte) error {
+	*r = Identifier{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v IdentifierUse
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Use = &v
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			v := d.string()
+			r.System = &v
+		case 6:
+			v := d.string()
+			r.Value = &v
+		case 7:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.Assigner = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.UseElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.SystemElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
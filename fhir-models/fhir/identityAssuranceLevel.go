@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code IdentityAssuranceLevel) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *IdentityAssuranceLevel) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *IdentityAssuranceLevel) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "level1":
 		*code = IdentityAssuranceLevelLevel1
@@ -52,6 +56,12 @@ func (code *IdentityAssuranceLevel) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code IdentityAssuranceLevel) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *IdentityAssuranceLevel) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code IdentityAssuranceLevel) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code IdentityAssuranceLevel) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code IdentityAssuranceLevel) protoNumber() uint64 {
+	switch code {
+	case IdentityAssuranceLevelLevel1:
+		return 1
+	case IdentityAssuranceLevelLevel2:
+		return 2
+	case IdentityAssuranceLevelLevel3:
+		return 3
+	case IdentityAssuranceLevelLevel4:
+		return 4
+	}
+	return 0
+}
+func (code *IdentityAssuranceLevel) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = IdentityAssuranceLevelLevel1
+	case 2:
+		*code = IdentityAssuranceLevelLevel2
+	case 3:
+		*code = IdentityAssuranceLevelLevel3
+	case 4:
+		*code = IdentityAssuranceLevelLevel4
+	default:
+		return false
+	}
+	return true
+}
@@ -14,57 +14,74 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ImagingStudy is documented here http://hl7.org/fhir/StructureDefinition/ImagingStudy
 type ImagingStudy struct {
-	Id                 *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string              `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status             ImagingStudyStatus   `bson:"status" json:"status"`
-	Modality           []Coding             `bson:"modality,omitempty" json:"modality,omitempty"`
-	Subject            Reference            `bson:"subject" json:"subject"`
-	Encounter          *Reference           `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Started            *string              `bson:"started,omitempty" json:"started,omitempty"`
-	BasedOn            []Reference          `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Referrer           *Reference           `bson:"referrer,omitempty" json:"referrer,omitempty"`
-	Interpreter        []Reference          `bson:"interpreter,omitempty" json:"interpreter,omitempty"`
-	Endpoint           []Reference          `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
-	NumberOfSeries     *int                 `bson:"numberOfSeries,omitempty" json:"numberOfSeries,omitempty"`
-	NumberOfInstances  *int                 `bson:"numberOfInstances,omitempty" json:"numberOfInstances,omitempty"`
-	ProcedureReference *Reference           `bson:"procedureReference,omitempty" json:"procedureReference,omitempty"`
-	ProcedureCode      []CodeableConcept    `bson:"procedureCode,omitempty" json:"procedureCode,omitempty"`
-	Location           *Reference           `bson:"location,omitempty" json:"location,omitempty"`
-	ReasonCode         []CodeableConcept    `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference    []Reference          `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note               []Annotation         `bson:"note,omitempty" json:"note,omitempty"`
-	Description        *string              `bson:"description,omitempty" json:"description,omitempty"`
-	Series             []ImagingStudySeries `bson:"series,omitempty" json:"series,omitempty"`
+	Id                       *string              `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                *Element             `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                     *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element             `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string              `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element             `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                []json.RawMessage    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                   ImagingStudyStatus   `bson:"status" json:"status"`
+	StatusElement            *Element             `bson:"_status,omitempty" json:"_status,omitempty"`
+	Modality                 []Coding             `bson:"modality,omitempty" json:"modality,omitempty"`
+	Subject                  Reference            `bson:"subject" json:"subject"`
+	Encounter                *Reference           `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Started                  *string              `bson:"started,omitempty" json:"started,omitempty"`
+	StartedElement           *Element             `bson:"_started,omitempty" json:"_started,omitempty"`
+	BasedOn                  []Reference          `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Referrer                 *Reference           `bson:"referrer,omitempty" json:"referrer,omitempty"`
+	Interpreter              []Reference          `bson:"interpreter,omitempty" json:"interpreter,omitempty"`
+	Endpoint                 []Reference          `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	NumberOfSeries           *int                 `bson:"numberOfSeries,omitempty" json:"numberOfSeries,omitempty"`
+	NumberOfSeriesElement    *Element             `bson:"_numberOfSeries,omitempty" json:"_numberOfSeries,omitempty"`
+	NumberOfInstances        *int                 `bson:"numberOfInstances,omitempty" json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *Element             `bson:"_numberOfInstances,omitempty" json:"_numberOfInstances,omitempty"`
+	ProcedureReference       *Reference           `bson:"procedureReference,omitempty" json:"procedureReference,omitempty"`
+	ProcedureCode            []CodeableConcept    `bson:"procedureCode,omitempty" json:"procedureCode,omitempty"`
+	Location                 *Reference           `bson:"location,omitempty" json:"location,omitempty"`
+	ReasonCode               []CodeableConcept    `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference          []Reference          `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                     []Annotation         `bson:"note,omitempty" json:"note,omitempty"`
+	Description              *string              `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement       *Element             `bson:"_description,omitempty" json:"_description,omitempty"`
+	Series                   []ImagingStudySeries `bson:"series,omitempty" json:"series,omitempty"`
 }
 type ImagingStudySeries struct {
-	Id                *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Uid               string                        `bson:"uid" json:"uid"`
-	Number            *int                          `bson:"number,omitempty" json:"number,omitempty"`
-	Modality          Coding                        `bson:"modality" json:"modality"`
-	Description       *string                       `bson:"description,omitempty" json:"description,omitempty"`
-	NumberOfInstances *int                          `bson:"numberOfInstances,omitempty" json:"numberOfInstances,omitempty"`
-	Endpoint          []Reference                   `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
-	BodySite          *Coding                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Laterality        *Coding                       `bson:"laterality,omitempty" json:"laterality,omitempty"`
-	Specimen          []Reference                   `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	Started           *string                       `bson:"started,omitempty" json:"started,omitempty"`
-	Performer         []ImagingStudySeriesPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
-	Instance          []ImagingStudySeriesInstance  `bson:"instance,omitempty" json:"instance,omitempty"`
+	Id                       *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Uid                      string                        `bson:"uid" json:"uid"`
+	UidElement               *Element                      `bson:"_uid,omitempty" json:"_uid,omitempty"`
+	Number                   *int                          `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement            *Element                      `bson:"_number,omitempty" json:"_number,omitempty"`
+	Modality                 Coding                        `bson:"modality" json:"modality"`
+	Description              *string                       `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement       *Element                      `bson:"_description,omitempty" json:"_description,omitempty"`
+	NumberOfInstances        *int                          `bson:"numberOfInstances,omitempty" json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *Element                      `bson:"_numberOfInstances,omitempty" json:"_numberOfInstances,omitempty"`
+	Endpoint                 []Reference                   `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	BodySite                 *Coding                       `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Laterality               *Coding                       `bson:"laterality,omitempty" json:"laterality,omitempty"`
+	Specimen                 []Reference                   `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	Started                  *string                       `bson:"started,omitempty" json:"started,omitempty"`
+	StartedElement           *Element                      `bson:"_started,omitempty" json:"_started,omitempty"`
+	Performer                []ImagingStudySeriesPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
+	Instance                 []ImagingStudySeriesInstance  `bson:"instance,omitempty" json:"instance,omitempty"`
 }
 type ImagingStudySeriesPerformer struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -78,27 +95,3499 @@ type ImagingStudySeriesInstance struct {
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Uid               string      `bson:"uid" json:"uid"`
+	UidElement        *Element    `bson:"_uid,omitempty" json:"_uid,omitempty"`
 	SopClass          Coding      `bson:"sopClass" json:"sopClass"`
 	Number            *int        `bson:"number,omitempty" json:"number,omitempty"`
+	NumberElement     *Element    `bson:"_number,omitempty" json:"_number,omitempty"`
 	Title             *string     `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement      *Element    `bson:"_title,omitempty" json:"_title,omitempty"`
 }
-type OtherImagingStudy ImagingStudy
 
 // MarshalJSON marshals the given ImagingStudy as JSON into a byte slice
 func (r ImagingStudy) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherImagingStudy
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherImagingStudy: OtherImagingStudy(r),
-		ResourceType:      "ImagingStudy",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImagingStudy as JSON object with the members in the order of the fields
+func (r ImagingStudy) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ImagingStudy\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.Modality) > 0 {
+		e.key("modality")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modality {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.Started != nil {
+		e.key("started")
+		e.string(*r.Started)
+	}
+	if r.StartedElement != nil {
+		e.key("_started")
+		r.StartedElement.appendJSON(e)
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Referrer != nil {
+		e.key("referrer")
+		r.Referrer.appendJSON(e)
+	}
+	if len(r.Interpreter) > 0 {
+		e.key("interpreter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Interpreter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endpoint) > 0 {
+		e.key("endpoint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endpoint {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.NumberOfSeries != nil {
+		e.key("numberOfSeries")
+		e.int(*r.NumberOfSeries)
+	}
+	if r.NumberOfSeriesElement != nil {
+		e.key("_numberOfSeries")
+		r.NumberOfSeriesElement.appendJSON(e)
+	}
+	if r.NumberOfInstances != nil {
+		e.key("numberOfInstances")
+		e.int(*r.NumberOfInstances)
+	}
+	if r.NumberOfInstancesElement != nil {
+		e.key("_numberOfInstances")
+		r.NumberOfInstancesElement.appendJSON(e)
+	}
+	if r.ProcedureReference != nil {
+		e.key("procedureReference")
+		r.ProcedureReference.appendJSON(e)
+	}
+	if len(r.ProcedureCode) > 0 {
+		e.key("procedureCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProcedureCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Series) > 0 {
+		e.key("series")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Series {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImagingStudy from JSON. Unknown members are ignored.
+func (r *ImagingStudy) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImagingStudy
+func (r *ImagingStudy) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "modality":
+			a := d.array()
+			r.Modality = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Modality = append(r.Modality, v)
+			}
+			if r.Modality == nil && !a.isNull {
+				r.Modality = []Coding{}
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "started":
+			if v, ok := d.string(); ok {
+				r.Started = &v
+			} else {
+				r.Started = nil
+			}
+		case "_started":
+			r.StartedElement = d.element()
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "referrer":
+			if d.null() {
+				r.Referrer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Referrer = &v
+			}
+		case "interpreter":
+			a := d.array()
+			r.Interpreter = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Interpreter = append(r.Interpreter, v)
+			}
+			if r.Interpreter == nil && !a.isNull {
+				r.Interpreter = []Reference{}
+			}
+		case "endpoint":
+			a := d.array()
+			r.Endpoint = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Endpoint = append(r.Endpoint, v)
+			}
+			if r.Endpoint == nil && !a.isNull {
+				r.Endpoint = []Reference{}
+			}
+		case "numberOfSeries":
+			if v, ok := d.int(); ok {
+				r.NumberOfSeries = &v
+			} else {
+				r.NumberOfSeries = nil
+			}
+		case "_numberOfSeries":
+			r.NumberOfSeriesElement = d.element()
+		case "numberOfInstances":
+			if v, ok := d.int(); ok {
+				r.NumberOfInstances = &v
+			} else {
+				r.NumberOfInstances = nil
+			}
+		case "_numberOfInstances":
+			r.NumberOfInstancesElement = d.element()
+		case "procedureReference":
+			if d.null() {
+				r.ProcedureReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ProcedureReference = &v
+			}
+		case "procedureCode":
+			a := d.array()
+			r.ProcedureCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProcedureCode = append(r.ProcedureCode, v)
+			}
+			if r.ProcedureCode == nil && !a.isNull {
+				r.ProcedureCode = []CodeableConcept{}
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "series":
+			a := d.array()
+			r.Series = nil
+			for a.next() {
+				var v ImagingStudySeries
+				v.decodeJSON(d)
+				r.Series = append(r.Series, v)
+			}
+			if r.Series == nil && !a.isNull {
+				r.Series = []ImagingStudySeries{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImagingStudy which shares no memory with the original
+func (r ImagingStudy) DeepCopy() ImagingStudy {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Modality != nil {
+		out.Modality = make([]Coding, len(r.Modality))
+		for i := range r.Modality {
+			out.Modality[i] = r.Modality[i].DeepCopy()
+		}
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.Started != nil {
+		v := *r.Started
+		out.Started = &v
+	}
+	if r.StartedElement != nil {
+		v := r.StartedElement.DeepCopy()
+		out.StartedElement = &v
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.Referrer != nil {
+		v := r.Referrer.DeepCopy()
+		out.Referrer = &v
+	}
+	if r.Interpreter != nil {
+		out.Interpreter = make([]Reference, len(r.Interpreter))
+		for i := range r.Interpreter {
+			out.Interpreter[i] = r.Interpreter[i].DeepCopy()
+		}
+	}
+	if r.Endpoint != nil {
+		out.Endpoint = make([]Reference, len(r.Endpoint))
+		for i := range r.Endpoint {
+			out.Endpoint[i] = r.Endpoint[i].DeepCopy()
+		}
+	}
+	if r.NumberOfSeries != nil {
+		v := *r.NumberOfSeries
+		out.NumberOfSeries = &v
+	}
+	if r.NumberOfSeriesElement != nil {
+		v := r.NumberOfSeriesElement.DeepCopy()
+		out.NumberOfSeriesElement = &v
+	}
+	if r.NumberOfInstances != nil {
+		v := *r.NumberOfInstances
+		out.NumberOfInstances = &v
+	}
+	if r.NumberOfInstancesElement != nil {
+		v := r.NumberOfInstancesElement.DeepCopy()
+		out.NumberOfInstancesElement = &v
+	}
+	if r.ProcedureReference != nil {
+		v := r.ProcedureReference.DeepCopy()
+		out.ProcedureReference = &v
+	}
+	if r.ProcedureCode != nil {
+		out.ProcedureCode = make([]CodeableConcept, len(r.ProcedureCode))
+		for i := range r.ProcedureCode {
+			out.ProcedureCode[i] = r.ProcedureCode[i].DeepCopy()
+		}
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Series != nil {
+		out.Series = make([]ImagingStudySeries, len(r.Series))
+		for i := range r.Series {
+			out.Series[i] = r.Series[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImagingStudy) Equal(other ImagingStudy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.Modality) != len(other.Modality) {
+		return false
+	}
+	for i := range r.Modality {
+		if !r.Modality[i].Equal(other.Modality[i]) {
+			return false
+		}
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.Started == nil) != (other.Started == nil) || r.Started != nil && *r.Started != *other.Started {
+		return false
+	}
+	if (r.StartedElement == nil) != (other.StartedElement == nil) || r.StartedElement != nil && !r.StartedElement.Equal(*other.StartedElement) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if (r.Referrer == nil) != (other.Referrer == nil) || r.Referrer != nil && !r.Referrer.Equal(*other.Referrer) {
+		return false
+	}
+	if len(r.Interpreter) != len(other.Interpreter) {
+		return false
+	}
+	for i := range r.Interpreter {
+		if !r.Interpreter[i].Equal(other.Interpreter[i]) {
+			return false
+		}
+	}
+	if len(r.Endpoint) != len(other.Endpoint) {
+		return false
+	}
+	for i := range r.Endpoint {
+		if !r.Endpoint[i].Equal(other.Endpoint[i]) {
+			return false
+		}
+	}
+	if (r.NumberOfSeries == nil) != (other.NumberOfSeries == nil) || r.NumberOfSeries != nil && *r.NumberOfSeries != *other.NumberOfSeries {
+		return false
+	}
+	if (r.NumberOfSeriesElement == nil) != (other.NumberOfSeriesElement == nil) || r.NumberOfSeriesElement != nil && !r.NumberOfSeriesElement.Equal(*other.NumberOfSeriesElement) {
+		return false
+	}
+	if (r.NumberOfInstances == nil) != (other.NumberOfInstances == nil) || r.NumberOfInstances != nil && *r.NumberOfInstances != *other.NumberOfInstances {
+		return false
+	}
+	if (r.NumberOfInstancesElement == nil) != (other.NumberOfInstancesElement == nil) || r.NumberOfInstancesElement != nil && !r.NumberOfInstancesElement.Equal(*other.NumberOfInstancesElement) {
+		return false
+	}
+	if (r.ProcedureReference == nil) != (other.ProcedureReference == nil) || r.ProcedureReference != nil && !r.ProcedureReference.Equal(*other.ProcedureReference) {
+		return false
+	}
+	if len(r.ProcedureCode) != len(other.ProcedureCode) {
+		return false
+	}
+	for i := range r.ProcedureCode {
+		if !r.ProcedureCode[i].Equal(other.ProcedureCode[i]) {
+			return false
+		}
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Series) != len(other.Series) {
+		return false
+	}
+	for i := range r.Series {
+		if !r.Series[i].Equal(other.Series[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImagingStudy) EqualsDeep(other ImagingStudy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.Modality), len(other.Modality), func(i, j int) bool {
+		return r.Modality[i].EqualsDeep(other.Modality[j])
+	}) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.Started == nil) != (other.Started == nil) || r.Started != nil && !equivalentString(*r.Started, *other.Started) {
+		return false
+	}
+	if (r.StartedElement == nil) != (other.StartedElement == nil) || r.StartedElement != nil && !r.StartedElement.EqualsDeep(*other.StartedElement) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if (r.Referrer == nil) != (other.Referrer == nil) || r.Referrer != nil && !r.Referrer.EqualsDeep(*other.Referrer) {
+		return false
+	}
+	if !equivalentList(len(r.Interpreter), len(other.Interpreter), func(i, j int) bool {
+		return r.Interpreter[i].EqualsDeep(other.Interpreter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endpoint), len(other.Endpoint), func(i, j int) bool {
+		return r.Endpoint[i].EqualsDeep(other.Endpoint[j])
+	}) {
+		return false
+	}
+	if (r.NumberOfSeries == nil) != (other.NumberOfSeries == nil) || r.NumberOfSeries != nil && *r.NumberOfSeries != *other.NumberOfSeries {
+		return false
+	}
+	if (r.NumberOfSeriesElement == nil) != (other.NumberOfSeriesElement == nil) || r.NumberOfSeriesElement != nil && !r.NumberOfSeriesElement.EqualsDeep(*other.NumberOfSeriesElement) {
+		return false
+	}
+	if (r.NumberOfInstances == nil) != (other.NumberOfInstances == nil) || r.NumberOfInstances != nil && *r.NumberOfInstances != *other.NumberOfInstances {
+		return false
+	}
+	if (r.NumberOfInstancesElement == nil) != (other.NumberOfInstancesElement == nil) || r.NumberOfInstancesElement != nil && !r.NumberOfInstancesElement.EqualsDeep(*other.NumberOfInstancesElement) {
+		return false
+	}
+	if (r.ProcedureReference == nil) != (other.ProcedureReference == nil) || r.ProcedureReference != nil && !r.ProcedureReference.EqualsDeep(*other.ProcedureReference) {
+		return false
+	}
+	if !equivalentList(len(r.ProcedureCode), len(other.ProcedureCode), func(i, j int) bool {
+		return r.ProcedureCode[i].EqualsDeep(other.ProcedureCode[j])
+	}) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Series), len(other.Series), func(i, j int) bool {
+		return r.Series[i].EqualsDeep(other.Series[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImagingStudy) EqualsShallow(other ImagingStudy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Started == nil) != (other.Started == nil) || r.Started != nil && !equivalentString(*r.Started, *other.Started) {
+		return false
+	}
+	if (r.NumberOfSeries == nil) != (other.NumberOfSeries == nil) || r.NumberOfSeries != nil && *r.NumberOfSeries != *other.NumberOfSeries {
+		return false
+	}
+	if (r.NumberOfInstances == nil) != (other.NumberOfInstances == nil) || r.NumberOfInstances != nil && *r.NumberOfInstances != *other.NumberOfInstances {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImagingStudy as FHIR XML
+func (r ImagingStudy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ImagingStudy",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.Modality {
+		if err := encodeXMLElement(e, "modality", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "started", r.Started, r.StartedElement); err != nil {
+		return err
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "referrer", r.Referrer); err != nil {
+		return err
+	}
+	for _, v := range r.Interpreter {
+		if err := encodeXMLElement(e, "interpreter", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endpoint {
+		if err := encodeXMLElement(e, "endpoint", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "numberOfSeries", r.NumberOfSeries, r.NumberOfSeriesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "numberOfInstances", r.NumberOfInstances, r.NumberOfInstancesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "procedureReference", r.ProcedureReference); err != nil {
+		return err
+	}
+	for _, v := range r.ProcedureCode {
+		if err := encodeXMLElement(e, "procedureCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Series {
+		if err := encodeXMLElement(e, "series", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImagingStudy from FHIR XML
+func (r *ImagingStudy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v ImagingStudyStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "modality":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modality = append(r.Modality, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "started":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Started = &v
+				}
+				r.StartedElement = element
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "referrer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Referrer = &v
+			case "interpreter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Interpreter = append(r.Interpreter, v)
+			case "endpoint":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endpoint = append(r.Endpoint, v)
+			case "numberOfSeries":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumberOfSeries = &v
+				}
+				r.NumberOfSeriesElement = element
+			case "numberOfInstances":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumberOfInstances = &v
+				}
+				r.NumberOfInstancesElement = element
+			case "procedureReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProcedureReference = &v
+			case "procedureCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProcedureCode = append(r.ProcedureCode, v)
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "series":
+				var v ImagingStudySeries
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Series = append(r.Series, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImagingStudy as properties to the RDF node n
+func (r ImagingStudy) turtle(n *turtleNode) {
+	n.resource("ImagingStudy", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ImagingStudy.identifier", i, v)
+	}
+	n.primitive("ImagingStudy.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.Modality {
+		n.element("ImagingStudy.modality", i, v)
+	}
+	n.element("ImagingStudy.subject", -1, r.Subject)
+	n.element("ImagingStudy.encounter", -1, r.Encounter)
+	n.primitive("ImagingStudy.started", -1, r.Started, r.StartedElement, "dateTime")
+	for i, v := range r.BasedOn {
+		n.element("ImagingStudy.basedOn", i, v)
+	}
+	n.element("ImagingStudy.referrer", -1, r.Referrer)
+	for i, v := range r.Interpreter {
+		n.element("ImagingStudy.interpreter", i, v)
+	}
+	for i, v := range r.Endpoint {
+		n.element("ImagingStudy.endpoint", i, v)
+	}
+	n.primitive("ImagingStudy.numberOfSeries", -1, r.NumberOfSeries, r.NumberOfSeriesElement, "unsignedInt")
+	n.primitive("ImagingStudy.numberOfInstances", -1, r.NumberOfInstances, r.NumberOfInstancesElement, "unsignedInt")
+	n.element("ImagingStudy.procedureReference", -1, r.ProcedureReference)
+	for i, v := range r.ProcedureCode {
+		n.element("ImagingStudy.procedureCode", i, v)
+	}
+	n.element("ImagingStudy.location", -1, r.Location)
+	for i, v := range r.ReasonCode {
+		n.element("ImagingStudy.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("ImagingStudy.reasonReference", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("ImagingStudy.note", i, v)
+	}
+	n.primitive("ImagingStudy.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Series {
+		n.element("ImagingStudy.series", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ImagingStudy as BSON document with the structure of its FHIR JSON
+func (r ImagingStudy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImagingStudy from a BSON document with the structure of its FHIR JSON
+func (r *ImagingStudy) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImagingStudy as protobuf message ImagingStudy of fhir.proto
+func (r ImagingStudy) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImagingStudy to the encoded message
+func (r ImagingStudy) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	for _, v := range r.Modality {
+		e.message(11, v)
+	}
+	e.message(12, r.Subject)
+	if r.Encounter != nil {
+		e.message(13, *r.Encounter)
+	}
+	if r.Started != nil {
+		e.string(14, *r.Started)
+	}
+	for _, v := range r.BasedOn {
+		e.message(15, v)
+	}
+	if r.Referrer != nil {
+		e.message(16, *r.Referrer)
+	}
+	for _, v := range r.Interpreter {
+		e.message(17, v)
+	}
+	for _, v := range r.Endpoint {
+		e.message(18, v)
+	}
+	if r.NumberOfSeries != nil {
+		e.varint(19, uint64(int64(*r.NumberOfSeries)))
+	}
+	if r.NumberOfInstances != nil {
+		e.varint(20, uint64(int64(*r.NumberOfInstances)))
+	}
+	if r.ProcedureReference != nil {
+		e.message(21, *r.ProcedureReference)
+	}
+	for _, v := range r.ProcedureCode {
+		e.message(22, v)
+	}
+	if r.Location != nil {
+		e.message(23, *r.Location)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(24, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(25, v)
+	}
+	for _, v := range r.Note {
+		e.message(26, v)
+	}
+	if r.Description != nil {
+		e.string(27, *r.Description)
+	}
+	for _, v := range r.Series {
+		e.message(28, v)
+	}
+	if r.IdElement != nil {
+		e.message(29, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(30, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(31, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(32, *r.StatusElement)
+	}
+	if r.StartedElement != nil {
+		e.message(33, *r.StartedElement)
+	}
+	if r.NumberOfSeriesElement != nil {
+		e.message(34, *r.NumberOfSeriesElement)
+	}
+	if r.NumberOfInstancesElement != nil {
+		e.message(35, *r.NumberOfInstancesElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(36, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImagingStudy from protobuf message ImagingStudy of fhir.proto
+func (r *ImagingStudy) UnmarshalProto(b []byte) error {
+	*r = ImagingStudy{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v ImagingStudyStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v Coding
+			d.message(&v)
+			r.Modality = append(r.Modality, v)
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 14:
+			v := d.string()
+			r.Started = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Referrer = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Interpreter = append(r.Interpreter, v)
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Endpoint = append(r.Endpoint, v)
+		case 19:
+			v := d.int()
+			r.NumberOfSeries = &v
+		case 20:
+			v := d.int()
+			r.NumberOfInstances = &v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.ProcedureReference = &v
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProcedureCode = append(r.ProcedureCode, v)
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 26:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 27:
+			v := d.string()
+			r.Description = &v
+		case 28:
+			var v ImagingStudySeries
+			d.message(&v)
+			r.Series = append(r.Series, v)
+		case 29:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.StartedElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.NumberOfSeriesElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.NumberOfInstancesElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImagingStudySeries as JSON into a byte slice
+func (r ImagingStudySeries) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImagingStudySeries as JSON object with the members in the order of the fields
+func (r ImagingStudySeries) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("uid")
+	e.string(r.Uid)
+	if r.UidElement != nil {
+		e.key("_uid")
+		r.UidElement.appendJSON(e)
+	}
+	if r.Number != nil {
+		e.key("number")
+		e.int(*r.Number)
+	}
+	if r.NumberElement != nil {
+		e.key("_number")
+		r.NumberElement.appendJSON(e)
+	}
+	e.key("modality")
+	r.Modality.appendJSON(e)
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.NumberOfInstances != nil {
+		e.key("numberOfInstances")
+		e.int(*r.NumberOfInstances)
+	}
+	if r.NumberOfInstancesElement != nil {
+		e.key("_numberOfInstances")
+		r.NumberOfInstancesElement.appendJSON(e)
+	}
+	if len(r.Endpoint) > 0 {
+		e.key("endpoint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endpoint {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BodySite != nil {
+		e.key("bodySite")
+		r.BodySite.appendJSON(e)
+	}
+	if r.Laterality != nil {
+		e.key("laterality")
+		r.Laterality.appendJSON(e)
+	}
+	if len(r.Specimen) > 0 {
+		e.key("specimen")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Specimen {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Started != nil {
+		e.key("started")
+		e.string(*r.Started)
+	}
+	if r.StartedElement != nil {
+		e.key("_started")
+		r.StartedElement.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Instance) > 0 {
+		e.key("instance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Instance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImagingStudySeries from JSON. Unknown members are ignored.
+func (r *ImagingStudySeries) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImagingStudySeries
+func (r *ImagingStudySeries) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "uid":
+			if v, ok := d.string(); ok {
+				r.Uid = v
+			}
+		case "_uid":
+			r.UidElement = d.element()
+		case "number":
+			if v, ok := d.int(); ok {
+				r.Number = &v
+			} else {
+				r.Number = nil
+			}
+		case "_number":
+			r.NumberElement = d.element()
+		case "modality":
+			r.Modality.decodeJSON(d)
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "numberOfInstances":
+			if v, ok := d.int(); ok {
+				r.NumberOfInstances = &v
+			} else {
+				r.NumberOfInstances = nil
+			}
+		case "_numberOfInstances":
+			r.NumberOfInstancesElement = d.element()
+		case "endpoint":
+			a := d.array()
+			r.Endpoint = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Endpoint = append(r.Endpoint, v)
+			}
+			if r.Endpoint == nil && !a.isNull {
+				r.Endpoint = []Reference{}
+			}
+		case "bodySite":
+			if d.null() {
+				r.BodySite = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.BodySite = &v
+			}
+		case "laterality":
+			if d.null() {
+				r.Laterality = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Laterality = &v
+			}
+		case "specimen":
+			a := d.array()
+			r.Specimen = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Specimen = append(r.Specimen, v)
+			}
+			if r.Specimen == nil && !a.isNull {
+				r.Specimen = []Reference{}
+			}
+		case "started":
+			if v, ok := d.string(); ok {
+				r.Started = &v
+			} else {
+				r.Started = nil
+			}
+		case "_started":
+			r.StartedElement = d.element()
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v ImagingStudySeriesPerformer
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []ImagingStudySeriesPerformer{}
+			}
+		case "instance":
+			a := d.array()
+			r.Instance = nil
+			for a.next() {
+				var v ImagingStudySeriesInstance
+				v.decodeJSON(d)
+				r.Instance = append(r.Instance, v)
+			}
+			if r.Instance == nil && !a.isNull {
+				r.Instance = []ImagingStudySeriesInstance{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImagingStudySeries which shares no memory with the original
+func (r ImagingStudySeries) DeepCopy() ImagingStudySeries {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UidElement != nil {
+		v := r.UidElement.DeepCopy()
+		out.UidElement = &v
+	}
+	if r.Number != nil {
+		v := *r.Number
+		out.Number = &v
+	}
+	if r.NumberElement != nil {
+		v := r.NumberElement.DeepCopy()
+		out.NumberElement = &v
+	}
+	out.Modality = r.Modality.DeepCopy()
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.NumberOfInstances != nil {
+		v := *r.NumberOfInstances
+		out.NumberOfInstances = &v
+	}
+	if r.NumberOfInstancesElement != nil {
+		v := r.NumberOfInstancesElement.DeepCopy()
+		out.NumberOfInstancesElement = &v
+	}
+	if r.Endpoint != nil {
+		out.Endpoint = make([]Reference, len(r.Endpoint))
+		for i := range r.Endpoint {
+			out.Endpoint[i] = r.Endpoint[i].DeepCopy()
+		}
+	}
+	if r.BodySite != nil {
+		v := r.BodySite.DeepCopy()
+		out.BodySite = &v
+	}
+	if r.Laterality != nil {
+		v := r.Laterality.DeepCopy()
+		out.Laterality = &v
+	}
+	if r.Specimen != nil {
+		out.Specimen = make([]Reference, len(r.Specimen))
+		for i := range r.Specimen {
+			out.Specimen[i] = r.Specimen[i].DeepCopy()
+		}
+	}
+	if r.Started != nil {
+		v := *r.Started
+		out.Started = &v
+	}
+	if r.StartedElement != nil {
+		v := r.StartedElement.DeepCopy()
+		out.StartedElement = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]ImagingStudySeriesPerformer, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.Instance != nil {
+		out.Instance = make([]ImagingStudySeriesInstance, len(r.Instance))
+		for i := range r.Instance {
+			out.Instance[i] = r.Instance[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImagingStudySeries) Equal(other ImagingStudySeries) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Uid != other.Uid {
+		return false
+	}
+	if (r.UidElement == nil) != (other.UidElement == nil) || r.UidElement != nil && !r.UidElement.Equal(*other.UidElement) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.Equal(*other.NumberElement) {
+		return false
+	}
+	if !r.Modality.Equal(other.Modality) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.NumberOfInstances == nil) != (other.NumberOfInstances == nil) || r.NumberOfInstances != nil && *r.NumberOfInstances != *other.NumberOfInstances {
+		return false
+	}
+	if (r.NumberOfInstancesElement == nil) != (other.NumberOfInstancesElement == nil) || r.NumberOfInstancesElement != nil && !r.NumberOfInstancesElement.Equal(*other.NumberOfInstancesElement) {
+		return false
+	}
+	if len(r.Endpoint) != len(other.Endpoint) {
+		return false
+	}
+	for i := range r.Endpoint {
+		if !r.Endpoint[i].Equal(other.Endpoint[i]) {
+			return false
+		}
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.Equal(*other.BodySite) {
+		return false
+	}
+	if (r.Laterality == nil) != (other.Laterality == nil) || r.Laterality != nil && !r.Laterality.Equal(*other.Laterality) {
+		return false
+	}
+	if len(r.Specimen) != len(other.Specimen) {
+		return false
+	}
+	for i := range r.Specimen {
+		if !r.Specimen[i].Equal(other.Specimen[i]) {
+			return false
+		}
+	}
+	if (r.Started == nil) != (other.Started == nil) || r.Started != nil && *r.Started != *other.Started {
+		return false
+	}
+	if (r.StartedElement == nil) != (other.StartedElement == nil) || r.StartedElement != nil && !r.StartedElement.Equal(*other.StartedElement) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if len(r.Instance) != len(other.Instance) {
+		return false
+	}
+	for i := range r.Instance {
+		if !r.Instance[i].Equal(other.Instance[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImagingStudySeries) EqualsDeep(other ImagingStudySeries) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Uid, other.Uid) {
+		return false
+	}
+	if (r.UidElement == nil) != (other.UidElement == nil) || r.UidElement != nil && !r.UidElement.EqualsDeep(*other.UidElement) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.EqualsDeep(*other.NumberElement) {
+		return false
+	}
+	if !r.Modality.EqualsDeep(other.Modality) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.NumberOfInstances == nil) != (other.NumberOfInstances == nil) || r.NumberOfInstances != nil && *r.NumberOfInstances != *other.NumberOfInstances {
+		return false
+	}
+	if (r.NumberOfInstancesElement == nil) != (other.NumberOfInstancesElement == nil) || r.NumberOfInstancesElement != nil && !r.NumberOfInstancesElement.EqualsDeep(*other.NumberOfInstancesElement) {
+		return false
+	}
+	if !equivalentList(len(r.Endpoint), len(other.Endpoint), func(i, j int) bool {
+		return r.Endpoint[i].EqualsDeep(other.Endpoint[j])
+	}) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.EqualsDeep(*other.BodySite) {
+		return false
+	}
+	if (r.Laterality == nil) != (other.Laterality == nil) || r.Laterality != nil && !r.Laterality.EqualsDeep(*other.Laterality) {
+		return false
+	}
+	if !equivalentList(len(r.Specimen), len(other.Specimen), func(i, j int) bool {
+		return r.Specimen[i].EqualsDeep(other.Specimen[j])
+	}) {
+		return false
+	}
+	if (r.Started == nil) != (other.Started == nil) || r.Started != nil && !equivalentString(*r.Started, *other.Started) {
+		return false
+	}
+	if (r.StartedElement == nil) != (other.StartedElement == nil) || r.StartedElement != nil && !r.StartedElement.EqualsDeep(*other.StartedElement) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Instance), len(other.Instance), func(i, j int) bool {
+		return r.Instance[i].EqualsDeep(other.Instance[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImagingStudySeries) EqualsShallow(other ImagingStudySeries) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Uid, other.Uid) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.NumberOfInstances == nil) != (other.NumberOfInstances == nil) || r.NumberOfInstances != nil && *r.NumberOfInstances != *other.NumberOfInstances {
+		return false
+	}
+	if (r.Started == nil) != (other.Started == nil) || r.Started != nil && !equivalentString(*r.Started, *other.Started) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImagingStudySeries as FHIR XML
+func (r ImagingStudySeries) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "uid", r.Uid, r.UidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "number", r.Number, r.NumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "modality", r.Modality); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "numberOfInstances", r.NumberOfInstances, r.NumberOfInstancesElement); err != nil {
+		return err
+	}
+	for _, v := range r.Endpoint {
+		if err := encodeXMLElement(e, "endpoint", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "bodySite", r.BodySite); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "laterality", r.Laterality); err != nil {
+		return err
+	}
+	for _, v := range r.Specimen {
+		if err := encodeXMLElement(e, "specimen", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "started", r.Started, r.StartedElement); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Instance {
+		if err := encodeXMLElement(e, "instance", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImagingStudySeries from FHIR XML
+func (r *ImagingStudySeries) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "uid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Uid = v
+				}
+				r.UidElement = element
+			case "number":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Number = &v
+				}
+				r.NumberElement = element
+			case "modality":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modality = v
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "numberOfInstances":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumberOfInstances = &v
+				}
+				r.NumberOfInstancesElement = element
+			case "endpoint":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endpoint = append(r.Endpoint, v)
+			case "bodySite":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = &v
+			case "laterality":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Laterality = &v
+			case "specimen":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specimen = append(r.Specimen, v)
+			case "started":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Started = &v
+				}
+				r.StartedElement = element
+			case "performer":
+				var v ImagingStudySeriesPerformer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "instance":
+				var v ImagingStudySeriesInstance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Instance = append(r.Instance, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImagingStudySeries as properties to the RDF node n
+func (r ImagingStudySeries) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImagingStudy.series.uid", -1, r.Uid, r.UidElement, "string")
+	n.primitive("ImagingStudy.series.number", -1, r.Number, r.NumberElement, "unsignedInt")
+	n.element("ImagingStudy.series.modality", -1, r.Modality)
+	n.primitive("ImagingStudy.series.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("ImagingStudy.series.numberOfInstances", -1, r.NumberOfInstances, r.NumberOfInstancesElement, "unsignedInt")
+	for i, v := range r.Endpoint {
+		n.element("ImagingStudy.series.endpoint", i, v)
+	}
+	n.element("ImagingStudy.series.bodySite", -1, r.BodySite)
+	n.element("ImagingStudy.series.laterality", -1, r.Laterality)
+	for i, v := range r.Specimen {
+		n.element("ImagingStudy.series.specimen", i, v)
+	}
+	n.primitive("ImagingStudy.series.started", -1, r.Started, r.StartedElement, "dateTime")
+	for i, v := range r.Performer {
+		n.element("ImagingStudy.series.performer", i, v)
+	}
+	for i, v := range r.Instance {
+		n.element("ImagingStudy.series.instance", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ImagingStudySeries as BSON document with the structure of its FHIR JSON
+func (r ImagingStudySeries) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImagingStudySeries from a BSON document with the structure of its FHIR JSON
+func (r *ImagingStudySeries) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImagingStudySeries as protobuf message ImagingStudySeries of fhir.proto
+func (r ImagingStudySeries) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImagingStudySeries to the encoded message
+func (r ImagingStudySeries) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Uid != "" {
+		e.string(4, r.Uid)
+	}
+	if r.Number != nil {
+		e.varint(5, uint64(int64(*r.Number)))
+	}
+	e.message(6, r.Modality)
+	if r.Description != nil {
+		e.string(7, *r.Description)
+	}
+	if r.NumberOfInstances != nil {
+		e.varint(8, uint64(int64(*r.NumberOfInstances)))
+	}
+	for _, v := range r.Endpoint {
+		e.message(9, v)
+	}
+	if r.BodySite != nil {
+		e.message(10, *r.BodySite)
+	}
+	if r.Laterality != nil {
+		e.message(11, *r.Laterality)
+	}
+	for _, v := range r.Specimen {
+		e.message(12, v)
+	}
+	if r.Started != nil {
+		e.string(13, *r.Started)
+	}
+	for _, v := range r.Performer {
+		e.message(14, v)
+	}
+	for _, v := range r.Instance {
+		e.message(15, v)
+	}
+	if r.UidElement != nil {
+		e.message(16, *r.UidElement)
+	}
+	if r.NumberElement != nil {
+		e.message(17, *r.NumberElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(18, *r.DescriptionElement)
+	}
+	if r.NumberOfInstancesElement != nil {
+		e.message(19, *r.NumberOfInstancesElement)
+	}
+	if r.StartedElement != nil {
+		e.message(20, *r.StartedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImagingStudySeries from protobuf message ImagingStudySeries of fhir.proto
+func (r *ImagingStudySeries) UnmarshalProto(b []byte) error {
+	*r = ImagingStudySeries{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Uid = d.string()
+		case 5:
+			v := d.int()
+			r.Number = &v
+		case 6:
+			var v Coding
+			d.message(&v)
+			r.Modality = v
+		case 7:
+			v := d.string()
+			r.Description = &v
+		case 8:
+			v := d.int()
+			r.NumberOfInstances = &v
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Endpoint = append(r.Endpoint, v)
+		case 10:
+			var v Coding
+			d.message(&v)
+			r.BodySite = &v
+		case 11:
+			var v Coding
+			d.message(&v)
+			r.Laterality = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Specimen = append(r.Specimen, v)
+		case 13:
+			v := d.string()
+			r.Started = &v
+		case 14:
+			var v ImagingStudySeriesPerformer
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 15:
+			var v ImagingStudySeriesInstance
+			d.message(&v)
+			r.Instance = append(r.Instance, v)
+		case 16:
+			var v Element
+			d.message(&v)
+			r.UidElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.NumberElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.NumberOfInstancesElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.StartedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImagingStudySeriesPerformer as JSON into a byte slice
+func (r ImagingStudySeriesPerformer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImagingStudySeriesPerformer as JSON object with the members in the order of the fields
+func (r ImagingStudySeriesPerformer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Function != nil {
+		e.key("function")
+		r.Function.appendJSON(e)
+	}
+	e.key("actor")
+	r.Actor.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImagingStudySeriesPerformer from JSON. Unknown members are ignored.
+func (r *ImagingStudySeriesPerformer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImagingStudySeriesPerformer
+func (r *ImagingStudySeriesPerformer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "function":
+			if d.null() {
+				r.Function = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Function = &v
+			}
+		case "actor":
+			r.Actor.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImagingStudySeriesPerformer which shares no memory with the original
+func (r ImagingStudySeriesPerformer) DeepCopy() ImagingStudySeriesPerformer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Function != nil {
+		v := r.Function.DeepCopy()
+		out.Function = &v
+	}
+	out.Actor = r.Actor.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImagingStudySeriesPerformer) Equal(other ImagingStudySeriesPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.Equal(*other.Function) {
+		return false
+	}
+	if !r.Actor.Equal(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImagingStudySeriesPerformer) EqualsDeep(other ImagingStudySeriesPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.EqualsDeep(*other.Function) {
+		return false
+	}
+	if !r.Actor.EqualsDeep(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImagingStudySeriesPerformer) EqualsShallow(other ImagingStudySeriesPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImagingStudySeriesPerformer as FHIR XML
+func (r ImagingStudySeriesPerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "function", r.Function); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "actor", r.Actor); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImagingStudySeriesPerformer from FHIR XML
+func (r *ImagingStudySeriesPerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "function":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Function = &v
+			case "actor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImagingStudySeriesPerformer as properties to the RDF node n
+func (r ImagingStudySeriesPerformer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ImagingStudy.series.performer.function", -1, r.Function)
+	n.element("ImagingStudy.series.performer.actor", -1, r.Actor)
+}
+
+// MarshalBSON marshals the given ImagingStudySeriesPerformer as BSON document with the structure of its FHIR JSON
+func (r ImagingStudySeriesPerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImagingStudySeriesPerformer from a BSON document with the structure of its FHIR JSON
+func (r *ImagingStudySeriesPerformer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImagingStudySeriesPerformer as protobuf message ImagingStudySeriesPerformer of fhir.proto
+func (r ImagingStudySeriesPerformer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImagingStudySeriesPerformer to the encoded message
+func (r ImagingStudySeriesPerformer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Function != nil {
+		e.message(4, *r.Function)
+	}
+	e.message(5, r.Actor)
+}
+
+// UnmarshalProto unmarshals the given ImagingStudySeriesPerformer from protobuf message ImagingStudySeriesPerformer of fhir.proto
+func (r *ImagingStudySeriesPerformer) UnmarshalProto(b []byte) error {
+	*r = ImagingStudySeriesPerformer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Function = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Actor = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImagingStudySeriesInstance as JSON into a byte slice
+func (r ImagingStudySeriesInstance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImagingStudySeriesInstance as JSON object with the members in the order of the fields
+func (r ImagingStudySeriesInstance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("uid")
+	e.string(r.Uid)
+	if r.UidElement != nil {
+		e.key("_uid")
+		r.UidElement.appendJSON(e)
+	}
+	e.key("sopClass")
+	r.SopClass.appendJSON(e)
+	if r.Number != nil {
+		e.key("number")
+		e.int(*r.Number)
+	}
+	if r.NumberElement != nil {
+		e.key("_number")
+		r.NumberElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImagingStudySeriesInstance from JSON. Unknown members are ignored.
+func (r *ImagingStudySeriesInstance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImagingStudySeriesInstance
+func (r *ImagingStudySeriesInstance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "uid":
+			if v, ok := d.string(); ok {
+				r.Uid = v
+			}
+		case "_uid":
+			r.UidElement = d.element()
+		case "sopClass":
+			r.SopClass.decodeJSON(d)
+		case "number":
+			if v, ok := d.int(); ok {
+				r.Number = &v
+			} else {
+				r.Number = nil
+			}
+		case "_number":
+			r.NumberElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImagingStudySeriesInstance which shares no memory with the original
+func (r ImagingStudySeriesInstance) DeepCopy() ImagingStudySeriesInstance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UidElement != nil {
+		v := r.UidElement.DeepCopy()
+		out.UidElement = &v
+	}
+	out.SopClass = r.SopClass.DeepCopy()
+	if r.Number != nil {
+		v := *r.Number
+		out.Number = &v
+	}
+	if r.NumberElement != nil {
+		v := r.NumberElement.DeepCopy()
+		out.NumberElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImagingStudySeriesInstance) Equal(other ImagingStudySeriesInstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Uid != other.Uid {
+		return false
+	}
+	if (r.UidElement == nil) != (other.UidElement == nil) || r.UidElement != nil && !r.UidElement.Equal(*other.UidElement) {
+		return false
+	}
+	if !r.SopClass.Equal(other.SopClass) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.Equal(*other.NumberElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImagingStudySeriesInstance) EqualsDeep(other ImagingStudySeriesInstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Uid, other.Uid) {
+		return false
+	}
+	if (r.UidElement == nil) != (other.UidElement == nil) || r.UidElement != nil && !r.UidElement.EqualsDeep(*other.UidElement) {
+		return false
+	}
+	if !r.SopClass.EqualsDeep(other.SopClass) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.NumberElement == nil) != (other.NumberElement == nil) || r.NumberElement != nil && !r.NumberElement.EqualsDeep(*other.NumberElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImagingStudySeriesInstance) EqualsShallow(other ImagingStudySeriesInstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Uid, other.Uid) {
+		return false
+	}
+	if (r.Number == nil) != (other.Number == nil) || r.Number != nil && *r.Number != *other.Number {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImagingStudySeriesInstance as FHIR XML
+func (r ImagingStudySeriesInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "uid", r.Uid, r.UidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sopClass", r.SopClass); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "number", r.Number, r.NumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImagingStudySeriesInstance from FHIR XML
+func (r *ImagingStudySeriesInstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "uid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Uid = v
+				}
+				r.UidElement = element
+			case "sopClass":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SopClass = v
+			case "number":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Number = &v
+				}
+				r.NumberElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImagingStudySeriesInstance as properties to the RDF node n
+func (r ImagingStudySeriesInstance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImagingStudy.series.instance.uid", -1, r.Uid, r.UidElement, "string")
+	n.element("ImagingStudy.series.instance.sopClass", -1, r.SopClass)
+	n.primitive("ImagingStudy.series.instance.number", -1, r.Number, r.NumberElement, "unsignedInt")
+	n.primitive("ImagingStudy.series.instance.title", -1, r.Title, r.TitleElement, "string")
+}
+
+// MarshalBSON marshals the given ImagingStudySeriesInstance as BSON document with the structure of its FHIR JSON
+func (r ImagingStudySeriesInstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImagingStudySeriesInstance from a BSON document with the structure of its FHIR JSON
+func (r *ImagingStudySeriesInstance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImagingStudySeriesInstance as protobuf message ImagingStudySeriesInstance of fhir.proto
+func (r ImagingStudySeriesInstance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImagingStudySeriesInstance to the encoded message
+func (r ImagingStudySeriesInstance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Uid != "" {
+		e.string(4, r.Uid)
+	}
+	e.message(5, r.SopClass)
+	if r.Number != nil {
+		e.varint(6, uint64(int64(*r.Number)))
+	}
+	if r.Title != nil {
+		e.string(7, *r.Title)
+	}
+	if r.UidElement != nil {
+		e.message(8, *r.UidElement)
+	}
+	if r.NumberElement != nil {
+		e.message(9, *r.NumberElement)
+	}
+	if r.TitleElement != nil {
+		e.message(10, *r.TitleElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImagingStudySeriesInstance from protobuf message ImagingStudySeriesInstance of fhir.proto
+func (r *ImagingStudySeriesInstance) UnmarshalProto(b []byte) error {
+	*r = ImagingStudySeriesInstance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Uid = d.string()
+		case 5:
+			var v Coding
+			d.message(&v)
+			r.SopClass = v
+		case 6:
+			v := d.int()
+			r.Number = &v
+		case 7:
+			v := d.string()
+			r.Title = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.UidElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.NumberElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalImagingStudy unmarshals a ImagingStudy.
 func UnmarshalImagingStudy(b []byte) (ImagingStudy, error) {
 	var imagingStudy ImagingStudy
-	if err := json.Unmarshal(b, &imagingStudy); err != nil {
+	if err := imagingStudy.UnmarshalJSON(b); err != nil {
 		return imagingStudy, err
 	}
 	return imagingStudy, nil
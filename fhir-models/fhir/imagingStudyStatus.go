@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code ImagingStudyStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ImagingStudyStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ImagingStudyStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "registered":
 		*code = ImagingStudyStatusRegistered
@@ -55,6 +59,12 @@ func (code *ImagingStudyStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ImagingStudyStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ImagingStudyStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ImagingStudyStatus) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code ImagingStudyStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ImagingStudyStatus) protoNumber() uint64 {
+	switch code {
+	case ImagingStudyStatusRegistered:
+		return 1
+	case ImagingStudyStatusAvailable:
+		return 2
+	case ImagingStudyStatusCancelled:
+		return 3
+	case ImagingStudyStatusEnteredInError:
+		return 4
+	case ImagingStudyStatusUnknown:
+		return 5
+	}
+	return 0
+}
+func (code *ImagingStudyStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ImagingStudyStatusRegistered
+	case 2:
+		*code = ImagingStudyStatusAvailable
+	case 3:
+		*code = ImagingStudyStatusCancelled
+	case 4:
+		*code = ImagingStudyStatusEnteredInError
+	case 5:
+		*code = ImagingStudyStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -14,50 +14,103 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Immunization is documented here http://hl7.org/fhir/StructureDefinition/Immunization
 type Immunization struct {
-	Id                 *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status             ImmunizationStatusCodes       `bson:"status" json:"status"`
-	StatusReason       *CodeableConcept              `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	VaccineCode        CodeableConcept               `bson:"vaccineCode" json:"vaccineCode"`
-	Patient            Reference                     `bson:"patient" json:"patient"`
-	Encounter          *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	OccurrenceDateTime string                        `bson:"occurrenceDateTime" json:"occurrenceDateTime"`
-	OccurrenceString   string                        `bson:"occurrenceString" json:"occurrenceString"`
-	Recorded           *string                       `bson:"recorded,omitempty" json:"recorded,omitempty"`
-	PrimarySource      *bool                         `bson:"primarySource,omitempty" json:"primarySource,omitempty"`
-	ReportOrigin       *CodeableConcept              `bson:"reportOrigin,omitempty" json:"reportOrigin,omitempty"`
-	Location           *Reference                    `bson:"location,omitempty" json:"location,omitempty"`
-	Manufacturer       *Reference                    `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	LotNumber          *string                       `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
-	ExpirationDate     *string                       `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
-	Site               *CodeableConcept              `bson:"site,omitempty" json:"site,omitempty"`
-	Route              *CodeableConcept              `bson:"route,omitempty" json:"route,omitempty"`
-	DoseQuantity       *Quantity                     `bson:"doseQuantity,omitempty" json:"doseQuantity,omitempty"`
-	Performer          []ImmunizationPerformer       `bson:"performer,omitempty" json:"performer,omitempty"`
-	Note               []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
-	ReasonCode         []CodeableConcept             `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference    []Reference                   `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	IsSubpotent        *bool                         `bson:"isSubpotent,omitempty" json:"isSubpotent,omitempty"`
-	SubpotentReason    []CodeableConcept             `bson:"subpotentReason,omitempty" json:"subpotentReason,omitempty"`
-	Education          []ImmunizationEducation       `bson:"education,omitempty" json:"education,omitempty"`
-	ProgramEligibility []CodeableConcept             `bson:"programEligibility,omitempty" json:"programEligibility,omitempty"`
-	FundingSource      *CodeableConcept              `bson:"fundingSource,omitempty" json:"fundingSource,omitempty"`
-	Reaction           []ImmunizationReaction        `bson:"reaction,omitempty" json:"reaction,omitempty"`
-	ProtocolApplied    []ImmunizationProtocolApplied `bson:"protocolApplied,omitempty" json:"protocolApplied,omitempty"`
+	Id                        *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element                      `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                      *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                    ImmunizationStatusCodes       `bson:"status" json:"status"`
+	StatusElement             *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason              *CodeableConcept              `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	VaccineCode               CodeableConcept               `bson:"vaccineCode" json:"vaccineCode"`
+	Patient                   Reference                     `bson:"patient" json:"patient"`
+	Encounter                 *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime        *string                       `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                      `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrenceString          *string                       `bson:"occurrenceString,omitempty" json:"occurrenceString,omitempty"`
+	OccurrenceStringElement   *Element                      `bson:"_occurrenceString,omitempty" json:"_occurrenceString,omitempty"`
+	Recorded                  *string                       `bson:"recorded,omitempty" json:"recorded,omitempty"`
+	RecordedElement           *Element                      `bson:"_recorded,omitempty" json:"_recorded,omitempty"`
+	PrimarySource             *bool                         `bson:"primarySource,omitempty" json:"primarySource,omitempty"`
+	PrimarySourceElement      *Element                      `bson:"_primarySource,omitempty" json:"_primarySource,omitempty"`
+	ReportOrigin              *CodeableConcept              `bson:"reportOrigin,omitempty" json:"reportOrigin,omitempty"`
+	Location                  *Reference                    `bson:"location,omitempty" json:"location,omitempty"`
+	Manufacturer              *Reference                    `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	LotNumber                 *string                       `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
+	LotNumberElement          *Element                      `bson:"_lotNumber,omitempty" json:"_lotNumber,omitempty"`
+	ExpirationDate            *string                       `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+	ExpirationDateElement     *Element                      `bson:"_expirationDate,omitempty" json:"_expirationDate,omitempty"`
+	Site                      *CodeableConcept              `bson:"site,omitempty" json:"site,omitempty"`
+	Route                     *CodeableConcept              `bson:"route,omitempty" json:"route,omitempty"`
+	DoseQuantity              *Quantity                     `bson:"doseQuantity,omitempty" json:"doseQuantity,omitempty"`
+	Performer                 []ImmunizationPerformer       `bson:"performer,omitempty" json:"performer,omitempty"`
+	Note                      []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
+	ReasonCode                []CodeableConcept             `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                   `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	IsSubpotent               *bool                         `bson:"isSubpotent,omitempty" json:"isSubpotent,omitempty"`
+	IsSubpotentElement        *Element                      `bson:"_isSubpotent,omitempty" json:"_isSubpotent,omitempty"`
+	SubpotentReason           []CodeableConcept             `bson:"subpotentReason,omitempty" json:"subpotentReason,omitempty"`
+	Education                 []ImmunizationEducation       `bson:"education,omitempty" json:"education,omitempty"`
+	ProgramEligibility        []CodeableConcept             `bson:"programEligibility,omitempty" json:"programEligibility,omitempty"`
+	FundingSource             *CodeableConcept              `bson:"fundingSource,omitempty" json:"fundingSource,omitempty"`
+	Reaction                  []ImmunizationReaction        `bson:"reaction,omitempty" json:"reaction,omitempty"`
+	ProtocolApplied           []ImmunizationProtocolApplied `bson:"protocolApplied,omitempty" json:"protocolApplied,omitempty"`
 }
+
+// ImmunizationOccurrence is implemented by the types allowed for Immunization.occurrence[x]
+type ImmunizationOccurrence interface {
+	isImmunizationOccurrence()
+}
+
+func (DateTime) isImmunizationOccurrence() {}
+func (String) isImmunizationOccurrence()   {}
+
+// Occurrence returns the value of Immunization.occurrence[x] together with its FHIR type code or nil if no value is set
+func (r Immunization) Occurrence() (ImmunizationOccurrence, string) {
+	if r.OccurrenceDateTime != nil {
+		return DateTime(*r.OccurrenceDateTime), "dateTime"
+	}
+	if r.OccurrenceString != nil {
+		return String(*r.OccurrenceString), "string"
+	}
+	return nil, ""
+}
+
+// SetOccurrence sets the value of Immunization.occurrence[x], given as value or pointer, and clears all other types
+func (r *Immunization) SetOccurrence(value ImmunizationOccurrence) {
+	r.OccurrenceDateTime = nil
+	r.OccurrenceString = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OccurrenceDateTime = &primitive
+	case *DateTime:
+		r.OccurrenceDateTime = (*string)(v)
+	case String:
+		primitive := string(v)
+		r.OccurrenceString = &primitive
+	case *String:
+		r.OccurrenceString = (*string)(v)
+	}
+}
+
 type ImmunizationPerformer struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -66,51 +119,4293 @@ type ImmunizationPerformer struct {
 	Actor             Reference        `bson:"actor" json:"actor"`
 }
 type ImmunizationEducation struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DocumentType      *string     `bson:"documentType,omitempty" json:"documentType,omitempty"`
-	Reference         *string     `bson:"reference,omitempty" json:"reference,omitempty"`
-	PublicationDate   *string     `bson:"publicationDate,omitempty" json:"publicationDate,omitempty"`
-	PresentationDate  *string     `bson:"presentationDate,omitempty" json:"presentationDate,omitempty"`
+	Id                      *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DocumentType            *string     `bson:"documentType,omitempty" json:"documentType,omitempty"`
+	DocumentTypeElement     *Element    `bson:"_documentType,omitempty" json:"_documentType,omitempty"`
+	Reference               *string     `bson:"reference,omitempty" json:"reference,omitempty"`
+	ReferenceElement        *Element    `bson:"_reference,omitempty" json:"_reference,omitempty"`
+	PublicationDate         *string     `bson:"publicationDate,omitempty" json:"publicationDate,omitempty"`
+	PublicationDateElement  *Element    `bson:"_publicationDate,omitempty" json:"_publicationDate,omitempty"`
+	PresentationDate        *string     `bson:"presentationDate,omitempty" json:"presentationDate,omitempty"`
+	PresentationDateElement *Element    `bson:"_presentationDate,omitempty" json:"_presentationDate,omitempty"`
 }
 type ImmunizationReaction struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Date              *string     `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element    `bson:"_date,omitempty" json:"_date,omitempty"`
 	Detail            *Reference  `bson:"detail,omitempty" json:"detail,omitempty"`
 	Reported          *bool       `bson:"reported,omitempty" json:"reported,omitempty"`
+	ReportedElement   *Element    `bson:"_reported,omitempty" json:"_reported,omitempty"`
 }
 type ImmunizationProtocolApplied struct {
-	Id                     *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Series                 *string           `bson:"series,omitempty" json:"series,omitempty"`
-	Authority              *Reference        `bson:"authority,omitempty" json:"authority,omitempty"`
-	TargetDisease          []CodeableConcept `bson:"targetDisease,omitempty" json:"targetDisease,omitempty"`
-	DoseNumberPositiveInt  int               `bson:"doseNumberPositiveInt" json:"doseNumberPositiveInt"`
-	DoseNumberString       string            `bson:"doseNumberString" json:"doseNumberString"`
-	SeriesDosesPositiveInt *int              `bson:"seriesDosesPositiveInt,omitempty" json:"seriesDosesPositiveInt,omitempty"`
-	SeriesDosesString      *string           `bson:"seriesDosesString,omitempty" json:"seriesDosesString,omitempty"`
-}
-type OtherImmunization Immunization
+	Id                            *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Series                        *string           `bson:"series,omitempty" json:"series,omitempty"`
+	SeriesElement                 *Element          `bson:"_series,omitempty" json:"_series,omitempty"`
+	Authority                     *Reference        `bson:"authority,omitempty" json:"authority,omitempty"`
+	TargetDisease                 []CodeableConcept `bson:"targetDisease,omitempty" json:"targetDisease,omitempty"`
+	DoseNumberPositiveInt         *int              `bson:"doseNumberPositiveInt,omitempty" json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement  *Element          `bson:"_doseNumberPositiveInt,omitempty" json:"_doseNumberPositiveInt,omitempty"`
+	DoseNumberString              *string           `bson:"doseNumberString,omitempty" json:"doseNumberString,omitempty"`
+	DoseNumberStringElement       *Element          `bson:"_doseNumberString,omitempty" json:"_doseNumberString,omitempty"`
+	SeriesDosesPositiveInt        *int              `bson:"seriesDosesPositiveInt,omitempty" json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *Element          `bson:"_seriesDosesPositiveInt,omitempty" json:"_seriesDosesPositiveInt,omitempty"`
+	SeriesDosesString             *string           `bson:"seriesDosesString,omitempty" json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement      *Element          `bson:"_seriesDosesString,omitempty" json:"_seriesDosesString,omitempty"`
+}
+
+// ImmunizationProtocolAppliedDoseNumber is implemented by the types allowed for Immunization.protocolApplied.doseNumber[x]
+type ImmunizationProtocolAppliedDoseNumber interface {
+	isImmunizationProtocolAppliedDoseNumber()
+}
+
+func (PositiveInt) isImmunizationProtocolAppliedDoseNumber() {}
+func (String) isImmunizationProtocolAppliedDoseNumber()      {}
+
+// DoseNumber returns the value of Immunization.protocolApplied.doseNumber[x] together with its FHIR type code or nil if no value is set
+func (r ImmunizationProtocolApplied) DoseNumber() (ImmunizationProtocolAppliedDoseNumber, string) {
+	if r.DoseNumberPositiveInt != nil {
+		return PositiveInt(*r.DoseNumberPositiveInt), "positiveInt"
+	}
+	if r.DoseNumberString != nil {
+		return String(*r.DoseNumberString), "string"
+	}
+	return nil, ""
+}
+
+// SetDoseNumber sets the value of Immunization.protocolApplied.doseNumber[x], given as value or pointer, and clears all other types
+func (r *ImmunizationProtocolApplied) SetDoseNumber(value ImmunizationProtocolAppliedDoseNumber) {
+	r.DoseNumberPositiveInt = nil
+	r.DoseNumberString = nil
+	switch v := value.(type) {
+	case PositiveInt:
+		primitive := int(v)
+		r.DoseNumberPositiveInt = &primitive
+	case *PositiveInt:
+		r.DoseNumberPositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.DoseNumberString = &primitive
+	case *String:
+		r.DoseNumberString = (*string)(v)
+	}
+}
+
+// ImmunizationProtocolAppliedSeriesDoses is implemented by the types allowed for Immunization.protocolApplied.seriesDoses[x]
+type ImmunizationProtocolAppliedSeriesDoses interface {
+	isImmunizationProtocolAppliedSeriesDoses()
+}
+
+func (PositiveInt) isImmunizationProtocolAppliedSeriesDoses() {}
+func (String) isImmunizationProtocolAppliedSeriesDoses()      {}
+
+// SeriesDoses returns the value of Immunization.protocolApplied.seriesDoses[x] together with its FHIR type code or nil if no value is set
+func (r ImmunizationProtocolApplied) SeriesDoses() (ImmunizationProtocolAppliedSeriesDoses, string) {
+	if r.SeriesDosesPositiveInt != nil {
+		return PositiveInt(*r.SeriesDosesPositiveInt), "positiveInt"
+	}
+	if r.SeriesDosesString != nil {
+		return String(*r.SeriesDosesString), "string"
+	}
+	return nil, ""
+}
+
+// SetSeriesDoses sets the value of Immunization.protocolApplied.seriesDoses[x], given as value or pointer, and clears all other types
+func (r *ImmunizationProtocolApplied) SetSeriesDoses(value ImmunizationProtocolAppliedSeriesDoses) {
+	r.SeriesDosesPositiveInt = nil
+	r.SeriesDosesString = nil
+	switch v := value.(type) {
+	case PositiveInt:
+		primitive := int(v)
+		r.SeriesDosesPositiveInt = &primitive
+	case *PositiveInt:
+		r.SeriesDosesPositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.SeriesDosesString = &primitive
+	case *String:
+		r.SeriesDosesString = (*string)(v)
+	}
+}
 
 // MarshalJSON marshals the given Immunization as JSON into a byte slice
 func (r Immunization) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherImmunization
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherImmunization: OtherImmunization(r),
-		ResourceType:      "Immunization",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Immunization as JSON object with the members in the order of the fields
+func (r Immunization) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Immunization\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusReason != nil {
+		e.key("statusReason")
+		r.StatusReason.appendJSON(e)
+	}
+	e.key("vaccineCode")
+	r.VaccineCode.appendJSON(e)
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.key("occurrenceDateTime")
+		e.string(*r.OccurrenceDateTime)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.key("_occurrenceDateTime")
+		r.OccurrenceDateTimeElement.appendJSON(e)
+	}
+	if r.OccurrenceString != nil {
+		e.key("occurrenceString")
+		e.string(*r.OccurrenceString)
+	}
+	if r.OccurrenceStringElement != nil {
+		e.key("_occurrenceString")
+		r.OccurrenceStringElement.appendJSON(e)
+	}
+	if r.Recorded != nil {
+		e.key("recorded")
+		e.string(*r.Recorded)
+	}
+	if r.RecordedElement != nil {
+		e.key("_recorded")
+		r.RecordedElement.appendJSON(e)
+	}
+	if r.PrimarySource != nil {
+		e.key("primarySource")
+		e.bool(*r.PrimarySource)
+	}
+	if r.PrimarySourceElement != nil {
+		e.key("_primarySource")
+		r.PrimarySourceElement.appendJSON(e)
+	}
+	if r.ReportOrigin != nil {
+		e.key("reportOrigin")
+		r.ReportOrigin.appendJSON(e)
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if r.Manufacturer != nil {
+		e.key("manufacturer")
+		r.Manufacturer.appendJSON(e)
+	}
+	if r.LotNumber != nil {
+		e.key("lotNumber")
+		e.string(*r.LotNumber)
+	}
+	if r.LotNumberElement != nil {
+		e.key("_lotNumber")
+		r.LotNumberElement.appendJSON(e)
+	}
+	if r.ExpirationDate != nil {
+		e.key("expirationDate")
+		e.string(*r.ExpirationDate)
+	}
+	if r.ExpirationDateElement != nil {
+		e.key("_expirationDate")
+		r.ExpirationDateElement.appendJSON(e)
+	}
+	if r.Site != nil {
+		e.key("site")
+		r.Site.appendJSON(e)
+	}
+	if r.Route != nil {
+		e.key("route")
+		r.Route.appendJSON(e)
+	}
+	if r.DoseQuantity != nil {
+		e.key("doseQuantity")
+		r.DoseQuantity.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.IsSubpotent != nil {
+		e.key("isSubpotent")
+		e.bool(*r.IsSubpotent)
+	}
+	if r.IsSubpotentElement != nil {
+		e.key("_isSubpotent")
+		r.IsSubpotentElement.appendJSON(e)
+	}
+	if len(r.SubpotentReason) > 0 {
+		e.key("subpotentReason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubpotentReason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Education) > 0 {
+		e.key("education")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Education {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProgramEligibility) > 0 {
+		e.key("programEligibility")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProgramEligibility {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.FundingSource != nil {
+		e.key("fundingSource")
+		r.FundingSource.appendJSON(e)
+	}
+	if len(r.Reaction) > 0 {
+		e.key("reaction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reaction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProtocolApplied) > 0 {
+		e.key("protocolApplied")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProtocolApplied {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Immunization from JSON. Unknown members are ignored.
+func (r *Immunization) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Immunization
+func (r *Immunization) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			if d.null() {
+				r.StatusReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = &v
+			}
+		case "vaccineCode":
+			r.VaccineCode.decodeJSON(d)
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "occurrenceDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurrenceDateTime = &v
+			} else {
+				r.OccurrenceDateTime = nil
+			}
+		case "_occurrenceDateTime":
+			r.OccurrenceDateTimeElement = d.element()
+		case "occurrenceString":
+			if v, ok := d.string(); ok {
+				r.OccurrenceString = &v
+			} else {
+				r.OccurrenceString = nil
+			}
+		case "_occurrenceString":
+			r.OccurrenceStringElement = d.element()
+		case "recorded":
+			if v, ok := d.string(); ok {
+				r.Recorded = &v
+			} else {
+				r.Recorded = nil
+			}
+		case "_recorded":
+			r.RecordedElement = d.element()
+		case "primarySource":
+			if v, ok := d.bool(); ok {
+				r.PrimarySource = &v
+			} else {
+				r.PrimarySource = nil
+			}
+		case "_primarySource":
+			r.PrimarySourceElement = d.element()
+		case "reportOrigin":
+			if d.null() {
+				r.ReportOrigin = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReportOrigin = &v
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "manufacturer":
+			if d.null() {
+				r.Manufacturer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Manufacturer = &v
+			}
+		case "lotNumber":
+			if v, ok := d.string(); ok {
+				r.LotNumber = &v
+			} else {
+				r.LotNumber = nil
+			}
+		case "_lotNumber":
+			r.LotNumberElement = d.element()
+		case "expirationDate":
+			if v, ok := d.string(); ok {
+				r.ExpirationDate = &v
+			} else {
+				r.ExpirationDate = nil
+			}
+		case "_expirationDate":
+			r.ExpirationDateElement = d.element()
+		case "site":
+			if d.null() {
+				r.Site = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Site = &v
+			}
+		case "route":
+			if d.null() {
+				r.Route = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Route = &v
+			}
+		case "doseQuantity":
+			if d.null() {
+				r.DoseQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.DoseQuantity = &v
+			}
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v ImmunizationPerformer
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []ImmunizationPerformer{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "isSubpotent":
+			if v, ok := d.bool(); ok {
+				r.IsSubpotent = &v
+			} else {
+				r.IsSubpotent = nil
+			}
+		case "_isSubpotent":
+			r.IsSubpotentElement = d.element()
+		case "subpotentReason":
+			a := d.array()
+			r.SubpotentReason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubpotentReason = append(r.SubpotentReason, v)
+			}
+			if r.SubpotentReason == nil && !a.isNull {
+				r.SubpotentReason = []CodeableConcept{}
+			}
+		case "education":
+			a := d.array()
+			r.Education = nil
+			for a.next() {
+				var v ImmunizationEducation
+				v.decodeJSON(d)
+				r.Education = append(r.Education, v)
+			}
+			if r.Education == nil && !a.isNull {
+				r.Education = []ImmunizationEducation{}
+			}
+		case "programEligibility":
+			a := d.array()
+			r.ProgramEligibility = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProgramEligibility = append(r.ProgramEligibility, v)
+			}
+			if r.ProgramEligibility == nil && !a.isNull {
+				r.ProgramEligibility = []CodeableConcept{}
+			}
+		case "fundingSource":
+			if d.null() {
+				r.FundingSource = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FundingSource = &v
+			}
+		case "reaction":
+			a := d.array()
+			r.Reaction = nil
+			for a.next() {
+				var v ImmunizationReaction
+				v.decodeJSON(d)
+				r.Reaction = append(r.Reaction, v)
+			}
+			if r.Reaction == nil && !a.isNull {
+				r.Reaction = []ImmunizationReaction{}
+			}
+		case "protocolApplied":
+			a := d.array()
+			r.ProtocolApplied = nil
+			for a.next() {
+				var v ImmunizationProtocolApplied
+				v.decodeJSON(d)
+				r.ProtocolApplied = append(r.ProtocolApplied, v)
+			}
+			if r.ProtocolApplied == nil && !a.isNull {
+				r.ProtocolApplied = []ImmunizationProtocolApplied{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Immunization which shares no memory with the original
+func (r Immunization) DeepCopy() Immunization {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		v := r.StatusReason.DeepCopy()
+		out.StatusReason = &v
+	}
+	out.VaccineCode = r.VaccineCode.DeepCopy()
+	out.Patient = r.Patient.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.OccurrenceDateTime != nil {
+		v := *r.OccurrenceDateTime
+		out.OccurrenceDateTime = &v
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		v := r.OccurrenceDateTimeElement.DeepCopy()
+		out.OccurrenceDateTimeElement = &v
+	}
+	if r.OccurrenceString != nil {
+		v := *r.OccurrenceString
+		out.OccurrenceString = &v
+	}
+	if r.OccurrenceStringElement != nil {
+		v := r.OccurrenceStringElement.DeepCopy()
+		out.OccurrenceStringElement = &v
+	}
+	if r.Recorded != nil {
+		v := *r.Recorded
+		out.Recorded = &v
+	}
+	if r.RecordedElement != nil {
+		v := r.RecordedElement.DeepCopy()
+		out.RecordedElement = &v
+	}
+	if r.PrimarySource != nil {
+		v := *r.PrimarySource
+		out.PrimarySource = &v
+	}
+	if r.PrimarySourceElement != nil {
+		v := r.PrimarySourceElement.DeepCopy()
+		out.PrimarySourceElement = &v
+	}
+	if r.ReportOrigin != nil {
+		v := r.ReportOrigin.DeepCopy()
+		out.ReportOrigin = &v
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.Manufacturer != nil {
+		v := r.Manufacturer.DeepCopy()
+		out.Manufacturer = &v
+	}
+	if r.LotNumber != nil {
+		v := *r.LotNumber
+		out.LotNumber = &v
+	}
+	if r.LotNumberElement != nil {
+		v := r.LotNumberElement.DeepCopy()
+		out.LotNumberElement = &v
+	}
+	if r.ExpirationDate != nil {
+		v := *r.ExpirationDate
+		out.ExpirationDate = &v
+	}
+	if r.ExpirationDateElement != nil {
+		v := r.ExpirationDateElement.DeepCopy()
+		out.ExpirationDateElement = &v
+	}
+	if r.Site != nil {
+		v := r.Site.DeepCopy()
+		out.Site = &v
+	}
+	if r.Route != nil {
+		v := r.Route.DeepCopy()
+		out.Route = &v
+	}
+	if r.DoseQuantity != nil {
+		v := r.DoseQuantity.DeepCopy()
+		out.DoseQuantity = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]ImmunizationPerformer, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.IsSubpotent != nil {
+		v := *r.IsSubpotent
+		out.IsSubpotent = &v
+	}
+	if r.IsSubpotentElement != nil {
+		v := r.IsSubpotentElement.DeepCopy()
+		out.IsSubpotentElement = &v
+	}
+	if r.SubpotentReason != nil {
+		out.SubpotentReason = make([]CodeableConcept, len(r.SubpotentReason))
+		for i := range r.SubpotentReason {
+			out.SubpotentReason[i] = r.SubpotentReason[i].DeepCopy()
+		}
+	}
+	if r.Education != nil {
+		out.Education = make([]ImmunizationEducation, len(r.Education))
+		for i := range r.Education {
+			out.Education[i] = r.Education[i].DeepCopy()
+		}
+	}
+	if r.ProgramEligibility != nil {
+		out.ProgramEligibility = make([]CodeableConcept, len(r.ProgramEligibility))
+		for i := range r.ProgramEligibility {
+			out.ProgramEligibility[i] = r.ProgramEligibility[i].DeepCopy()
+		}
+	}
+	if r.FundingSource != nil {
+		v := r.FundingSource.DeepCopy()
+		out.FundingSource = &v
+	}
+	if r.Reaction != nil {
+		out.Reaction = make([]ImmunizationReaction, len(r.Reaction))
+		for i := range r.Reaction {
+			out.Reaction[i] = r.Reaction[i].DeepCopy()
+		}
+	}
+	if r.ProtocolApplied != nil {
+		out.ProtocolApplied = make([]ImmunizationProtocolApplied, len(r.ProtocolApplied))
+		for i := range r.ProtocolApplied {
+			out.ProtocolApplied[i] = r.ProtocolApplied[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Immunization) Equal(other Immunization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.Equal(*other.StatusReason) {
+		return false
+	}
+	if !r.VaccineCode.Equal(other.VaccineCode) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && *r.OccurrenceDateTime != *other.OccurrenceDateTime {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.Equal(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrenceString == nil) != (other.OccurrenceString == nil) || r.OccurrenceString != nil && *r.OccurrenceString != *other.OccurrenceString {
+		return false
+	}
+	if (r.OccurrenceStringElement == nil) != (other.OccurrenceStringElement == nil) || r.OccurrenceStringElement != nil && !r.OccurrenceStringElement.Equal(*other.OccurrenceStringElement) {
+		return false
+	}
+	if (r.Recorded == nil) != (other.Recorded == nil) || r.Recorded != nil && *r.Recorded != *other.Recorded {
+		return false
+	}
+	if (r.RecordedElement == nil) != (other.RecordedElement == nil) || r.RecordedElement != nil && !r.RecordedElement.Equal(*other.RecordedElement) {
+		return false
+	}
+	if (r.PrimarySource == nil) != (other.PrimarySource == nil) || r.PrimarySource != nil && *r.PrimarySource != *other.PrimarySource {
+		return false
+	}
+	if (r.PrimarySourceElement == nil) != (other.PrimarySourceElement == nil) || r.PrimarySourceElement != nil && !r.PrimarySourceElement.Equal(*other.PrimarySourceElement) {
+		return false
+	}
+	if (r.ReportOrigin == nil) != (other.ReportOrigin == nil) || r.ReportOrigin != nil && !r.ReportOrigin.Equal(*other.ReportOrigin) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if (r.Manufacturer == nil) != (other.Manufacturer == nil) || r.Manufacturer != nil && !r.Manufacturer.Equal(*other.Manufacturer) {
+		return false
+	}
+	if (r.LotNumber == nil) != (other.LotNumber == nil) || r.LotNumber != nil && *r.LotNumber != *other.LotNumber {
+		return false
+	}
+	if (r.LotNumberElement == nil) != (other.LotNumberElement == nil) || r.LotNumberElement != nil && !r.LotNumberElement.Equal(*other.LotNumberElement) {
+		return false
+	}
+	if (r.ExpirationDate == nil) != (other.ExpirationDate == nil) || r.ExpirationDate != nil && *r.ExpirationDate != *other.ExpirationDate {
+		return false
+	}
+	if (r.ExpirationDateElement == nil) != (other.ExpirationDateElement == nil) || r.ExpirationDateElement != nil && !r.ExpirationDateElement.Equal(*other.ExpirationDateElement) {
+		return false
+	}
+	if (r.Site == nil) != (other.Site == nil) || r.Site != nil && !r.Site.Equal(*other.Site) {
+		return false
+	}
+	if (r.Route == nil) != (other.Route == nil) || r.Route != nil && !r.Route.Equal(*other.Route) {
+		return false
+	}
+	if (r.DoseQuantity == nil) != (other.DoseQuantity == nil) || r.DoseQuantity != nil && !r.DoseQuantity.Equal(*other.DoseQuantity) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if (r.IsSubpotent == nil) != (other.IsSubpotent == nil) || r.IsSubpotent != nil && *r.IsSubpotent != *other.IsSubpotent {
+		return false
+	}
+	if (r.IsSubpotentElement == nil) != (other.IsSubpotentElement == nil) || r.IsSubpotentElement != nil && !r.IsSubpotentElement.Equal(*other.IsSubpotentElement) {
+		return false
+	}
+	if len(r.SubpotentReason) != len(other.SubpotentReason) {
+		return false
+	}
+	for i := range r.SubpotentReason {
+		if !r.SubpotentReason[i].Equal(other.SubpotentReason[i]) {
+			return false
+		}
+	}
+	if len(r.Education) != len(other.Education) {
+		return false
+	}
+	for i := range r.Education {
+		if !r.Education[i].Equal(other.Education[i]) {
+			return false
+		}
+	}
+	if len(r.ProgramEligibility) != len(other.ProgramEligibility) {
+		return false
+	}
+	for i := range r.ProgramEligibility {
+		if !r.ProgramEligibility[i].Equal(other.ProgramEligibility[i]) {
+			return false
+		}
+	}
+	if (r.FundingSource == nil) != (other.FundingSource == nil) || r.FundingSource != nil && !r.FundingSource.Equal(*other.FundingSource) {
+		return false
+	}
+	if len(r.Reaction) != len(other.Reaction) {
+		return false
+	}
+	for i := range r.Reaction {
+		if !r.Reaction[i].Equal(other.Reaction[i]) {
+			return false
+		}
+	}
+	if len(r.ProtocolApplied) != len(other.ProtocolApplied) {
+		return false
+	}
+	for i := range r.ProtocolApplied {
+		if !r.ProtocolApplied[i].Equal(other.ProtocolApplied[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Immunization) EqualsDeep(other Immunization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.EqualsDeep(*other.StatusReason) {
+		return false
+	}
+	if !r.VaccineCode.EqualsDeep(other.VaccineCode) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.EqualsDeep(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrenceString == nil) != (other.OccurrenceString == nil) || r.OccurrenceString != nil && !equivalentString(*r.OccurrenceString, *other.OccurrenceString) {
+		return false
+	}
+	if (r.OccurrenceStringElement == nil) != (other.OccurrenceStringElement == nil) || r.OccurrenceStringElement != nil && !r.OccurrenceStringElement.EqualsDeep(*other.OccurrenceStringElement) {
+		return false
+	}
+	if (r.Recorded == nil) != (other.Recorded == nil) || r.Recorded != nil && !equivalentString(*r.Recorded, *other.Recorded) {
+		return false
+	}
+	if (r.RecordedElement == nil) != (other.RecordedElement == nil) || r.RecordedElement != nil && !r.RecordedElement.EqualsDeep(*other.RecordedElement) {
+		return false
+	}
+	if (r.PrimarySource == nil) != (other.PrimarySource == nil) || r.PrimarySource != nil && *r.PrimarySource != *other.PrimarySource {
+		return false
+	}
+	if (r.PrimarySourceElement == nil) != (other.PrimarySourceElement == nil) || r.PrimarySourceElement != nil && !r.PrimarySourceElement.EqualsDeep(*other.PrimarySourceElement) {
+		return false
+	}
+	if (r.ReportOrigin == nil) != (other.ReportOrigin == nil) || r.ReportOrigin != nil && !r.ReportOrigin.EqualsDeep(*other.ReportOrigin) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if (r.Manufacturer == nil) != (other.Manufacturer == nil) || r.Manufacturer != nil && !r.Manufacturer.EqualsDeep(*other.Manufacturer) {
+		return false
+	}
+	if (r.LotNumber == nil) != (other.LotNumber == nil) || r.LotNumber != nil && !equivalentString(*r.LotNumber, *other.LotNumber) {
+		return false
+	}
+	if (r.LotNumberElement == nil) != (other.LotNumberElement == nil) || r.LotNumberElement != nil && !r.LotNumberElement.EqualsDeep(*other.LotNumberElement) {
+		return false
+	}
+	if (r.ExpirationDate == nil) != (other.ExpirationDate == nil) || r.ExpirationDate != nil && !equivalentString(*r.ExpirationDate, *other.ExpirationDate) {
+		return false
+	}
+	if (r.ExpirationDateElement == nil) != (other.ExpirationDateElement == nil) || r.ExpirationDateElement != nil && !r.ExpirationDateElement.EqualsDeep(*other.ExpirationDateElement) {
+		return false
+	}
+	if (r.Site == nil) != (other.Site == nil) || r.Site != nil && !r.Site.EqualsDeep(*other.Site) {
+		return false
+	}
+	if (r.Route == nil) != (other.Route == nil) || r.Route != nil && !r.Route.EqualsDeep(*other.Route) {
+		return false
+	}
+	if (r.DoseQuantity == nil) != (other.DoseQuantity == nil) || r.DoseQuantity != nil && !r.DoseQuantity.EqualsDeep(*other.DoseQuantity) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if (r.IsSubpotent == nil) != (other.IsSubpotent == nil) || r.IsSubpotent != nil && *r.IsSubpotent != *other.IsSubpotent {
+		return false
+	}
+	if (r.IsSubpotentElement == nil) != (other.IsSubpotentElement == nil) || r.IsSubpotentElement != nil && !r.IsSubpotentElement.EqualsDeep(*other.IsSubpotentElement) {
+		return false
+	}
+	if !equivalentList(len(r.SubpotentReason), len(other.SubpotentReason), func(i, j int) bool {
+		return r.SubpotentReason[i].EqualsDeep(other.SubpotentReason[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Education), len(other.Education), func(i, j int) bool {
+		return r.Education[i].EqualsDeep(other.Education[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProgramEligibility), len(other.ProgramEligibility), func(i, j int) bool {
+		return r.ProgramEligibility[i].EqualsDeep(other.ProgramEligibility[j])
+	}) {
+		return false
+	}
+	if (r.FundingSource == nil) != (other.FundingSource == nil) || r.FundingSource != nil && !r.FundingSource.EqualsDeep(*other.FundingSource) {
+		return false
+	}
+	if !equivalentList(len(r.Reaction), len(other.Reaction), func(i, j int) bool {
+		return r.Reaction[i].EqualsDeep(other.Reaction[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProtocolApplied), len(other.ProtocolApplied), func(i, j int) bool {
+		return r.ProtocolApplied[i].EqualsDeep(other.ProtocolApplied[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Immunization) EqualsShallow(other Immunization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceString == nil) != (other.OccurrenceString == nil) || r.OccurrenceString != nil && !equivalentString(*r.OccurrenceString, *other.OccurrenceString) {
+		return false
+	}
+	if (r.Recorded == nil) != (other.Recorded == nil) || r.Recorded != nil && !equivalentString(*r.Recorded, *other.Recorded) {
+		return false
+	}
+	if (r.PrimarySource == nil) != (other.PrimarySource == nil) || r.PrimarySource != nil && *r.PrimarySource != *other.PrimarySource {
+		return false
+	}
+	if (r.LotNumber == nil) != (other.LotNumber == nil) || r.LotNumber != nil && !equivalentString(*r.LotNumber, *other.LotNumber) {
+		return false
+	}
+	if (r.ExpirationDate == nil) != (other.ExpirationDate == nil) || r.ExpirationDate != nil && !equivalentString(*r.ExpirationDate, *other.ExpirationDate) {
+		return false
+	}
+	if (r.IsSubpotent == nil) != (other.IsSubpotent == nil) || r.IsSubpotent != nil && *r.IsSubpotent != *other.IsSubpotent {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Immunization as FHIR XML
+func (r Immunization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Immunization",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReason", r.StatusReason); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "vaccineCode", r.VaccineCode); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceDateTime", r.OccurrenceDateTime, r.OccurrenceDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceString", r.OccurrenceString, r.OccurrenceStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "recorded", r.Recorded, r.RecordedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "primarySource", r.PrimarySource, r.PrimarySourceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reportOrigin", r.ReportOrigin); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "manufacturer", r.Manufacturer); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lotNumber", r.LotNumber, r.LotNumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expirationDate", r.ExpirationDate, r.ExpirationDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "site", r.Site); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "route", r.Route); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "doseQuantity", r.DoseQuantity); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "isSubpotent", r.IsSubpotent, r.IsSubpotentElement); err != nil {
+		return err
+	}
+	for _, v := range r.SubpotentReason {
+		if err := encodeXMLElement(e, "subpotentReason", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Education {
+		if err := encodeXMLElement(e, "education", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProgramEligibility {
+		if err := encodeXMLElement(e, "programEligibility", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "fundingSource", r.FundingSource); err != nil {
+		return err
+	}
+	for _, v := range r.Reaction {
+		if err := encodeXMLElement(e, "reaction", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProtocolApplied {
+		if err := encodeXMLElement(e, "protocolApplied", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Immunization from FHIR XML
+func (r *Immunization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v ImmunizationStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = &v
+			case "vaccineCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.VaccineCode = v
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "occurrenceDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceDateTime = &v
+				}
+				r.OccurrenceDateTimeElement = element
+			case "occurrenceString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceString = &v
+				}
+				r.OccurrenceStringElement = element
+			case "recorded":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Recorded = &v
+				}
+				r.RecordedElement = element
+			case "primarySource":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PrimarySource = &v
+				}
+				r.PrimarySourceElement = element
+			case "reportOrigin":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReportOrigin = &v
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "manufacturer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manufacturer = &v
+			case "lotNumber":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LotNumber = &v
+				}
+				r.LotNumberElement = element
+			case "expirationDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ExpirationDate = &v
+				}
+				r.ExpirationDateElement = element
+			case "site":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Site = &v
+			case "route":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Route = &v
+			case "doseQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DoseQuantity = &v
+			case "performer":
+				var v ImmunizationPerformer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "isSubpotent":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsSubpotent = &v
+				}
+				r.IsSubpotentElement = element
+			case "subpotentReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubpotentReason = append(r.SubpotentReason, v)
+			case "education":
+				var v ImmunizationEducation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Education = append(r.Education, v)
+			case "programEligibility":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProgramEligibility = append(r.ProgramEligibility, v)
+			case "fundingSource":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FundingSource = &v
+			case "reaction":
+				var v ImmunizationReaction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reaction = append(r.Reaction, v)
+			case "protocolApplied":
+				var v ImmunizationProtocolApplied
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProtocolApplied = append(r.ProtocolApplied, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Immunization as properties to the RDF node n
+func (r Immunization) turtle(n *turtleNode) {
+	n.resource("Immunization", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Immunization.identifier", i, v)
+	}
+	n.primitive("Immunization.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Immunization.statusReason", -1, r.StatusReason)
+	n.element("Immunization.vaccineCode", -1, r.VaccineCode)
+	n.element("Immunization.patient", -1, r.Patient)
+	n.element("Immunization.encounter", -1, r.Encounter)
+	n.primitive("Immunization.occurrenceDateTime", -1, r.OccurrenceDateTime, r.OccurrenceDateTimeElement, "dateTime")
+	n.primitive("Immunization.occurrenceString", -1, r.OccurrenceString, r.OccurrenceStringElement, "string")
+	n.primitive("Immunization.recorded", -1, r.Recorded, r.RecordedElement, "dateTime")
+	n.primitive("Immunization.primarySource", -1, r.PrimarySource, r.PrimarySourceElement, "boolean")
+	n.element("Immunization.reportOrigin", -1, r.ReportOrigin)
+	n.element("Immunization.location", -1, r.Location)
+	n.element("Immunization.manufacturer", -1, r.Manufacturer)
+	n.primitive("Immunization.lotNumber", -1, r.LotNumber, r.LotNumberElement, "string")
+	n.primitive("Immunization.expirationDate", -1, r.ExpirationDate, r.ExpirationDateElement, "date")
+	n.element("Immunization.site", -1, r.Site)
+	n.element("Immunization.route", -1, r.Route)
+	n.element("Immunization.doseQuantity", -1, r.DoseQuantity)
+	for i, v := range r.Performer {
+		n.element("Immunization.performer", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("Immunization.note", i, v)
+	}
+	for i, v := range r.ReasonCode {
+		n.element("Immunization.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("Immunization.reasonReference", i, v)
+	}
+	n.primitive("Immunization.isSubpotent", -1, r.IsSubpotent, r.IsSubpotentElement, "boolean")
+	for i, v := range r.SubpotentReason {
+		n.element("Immunization.subpotentReason", i, v)
+	}
+	for i, v := range r.Education {
+		n.element("Immunization.education", i, v)
+	}
+	for i, v := range r.ProgramEligibility {
+		n.element("Immunization.programEligibility", i, v)
+	}
+	n.element("Immunization.fundingSource", -1, r.FundingSource)
+	for i, v := range r.Reaction {
+		n.element("Immunization.reaction", i, v)
+	}
+	for i, v := range r.ProtocolApplied {
+		n.element("Immunization.protocolApplied", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Immunization as BSON document with the structure of its FHIR JSON
+func (r Immunization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Immunization from a BSON document with the structure of its FHIR JSON
+func (r *Immunization) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Immunization as protobuf message Immunization of fhir.proto
+func (r Immunization) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Immunization to the encoded message
+func (r Immunization) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.StatusReason != nil {
+		e.message(11, *r.StatusReason)
+	}
+	e.message(12, r.VaccineCode)
+	e.message(13, r.Patient)
+	if r.Encounter != nil {
+		e.message(14, *r.Encounter)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.string(15, *r.OccurrenceDateTime)
+	}
+	if r.OccurrenceString != nil {
+		e.string(16, *r.OccurrenceString)
+	}
+	if r.Recorded != nil {
+		e.string(17, *r.Recorded)
+	}
+	if r.PrimarySource != nil {
+		e.varint(18, protoBool(*r.PrimarySource))
+	}
+	if r.ReportOrigin != nil {
+		e.message(19, *r.ReportOrigin)
+	}
+	if r.Location != nil {
+		e.message(20, *r.Location)
+	}
+	if r.Manufacturer != nil {
+		e.message(21, *r.Manufacturer)
+	}
+	if r.LotNumber != nil {
+		e.string(22, *r.LotNumber)
+	}
+	if r.ExpirationDate != nil {
+		e.string(23, *r.ExpirationDate)
+	}
+	if r.Site != nil {
+		e.message(24, *r.Site)
+	}
+	if r.Route != nil {
+		e.message(25, *r.Route)
+	}
+	if r.DoseQuantity != nil {
+		e.message(26, *r.DoseQuantity)
+	}
+	for _, v := range r.Performer {
+		e.message(27, v)
+	}
+	for _, v := range r.Note {
+		e.message(28, v)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(29, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(30, v)
+	}
+	if r.IsSubpotent != nil {
+		e.varint(31, protoBool(*r.IsSubpotent))
+	}
+	for _, v := range r.SubpotentReason {
+		e.message(32, v)
+	}
+	for _, v := range r.Education {
+		e.message(33, v)
+	}
+	for _, v := range r.ProgramEligibility {
+		e.message(34, v)
+	}
+	if r.FundingSource != nil {
+		e.message(35, *r.FundingSource)
+	}
+	for _, v := range r.Reaction {
+		e.message(36, v)
+	}
+	for _, v := range r.ProtocolApplied {
+		e.message(37, v)
+	}
+	if r.IdElement != nil {
+		e.message(38, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(39, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(40, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(41, *r.StatusElement)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.message(42, *r.OccurrenceDateTimeElement)
+	}
+	if r.OccurrenceStringElement != nil {
+		e.message(43, *r.OccurrenceStringElement)
+	}
+	if r.RecordedElement != nil {
+		e.message(44, *r.RecordedElement)
+	}
+	if r.PrimarySourceElement != nil {
+		e.message(45, *r.PrimarySourceElement)
+	}
+	if r.LotNumberElement != nil {
+		e.message(46, *r.LotNumberElement)
+	}
+	if r.ExpirationDateElement != nil {
+		e.message(47, *r.ExpirationDateElement)
+	}
+	if r.IsSubpotentElement != nil {
+		e.message(48, *r.IsSubpotentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Immunization from protobuf message Immunization of fhir.proto
+func (r *Immunization) UnmarshalProto(b []byte) error {
+	*r = Immunization{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v ImmunizationStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.VaccineCode = v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 15:
+			v := d.string()
+			r.OccurrenceDateTime = &v
+		case 16:
+			v := d.string()
+			r.OccurrenceString = &v
+		case 17:
+			v := d.string()
+			r.Recorded = &v
+		case 18:
+			v := d.bool()
+			r.PrimarySource = &v
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReportOrigin = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Manufacturer = &v
+		case 22:
+			v := d.string()
+			r.LotNumber = &v
+		case 23:
+			v := d.string()
+			r.ExpirationDate = &v
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.Site = &v
+		case 25:
+			var v CodeableConcept
+			d.message(&v)
+			r.Route = &v
+		case 26:
+			var v Quantity
+			d.message(&v)
+			r.DoseQuantity = &v
+		case 27:
+			var v ImmunizationPerformer
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 28:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 29:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 30:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 31:
+			v := d.bool()
+			r.IsSubpotent = &v
+		case 32:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubpotentReason = append(r.SubpotentReason, v)
+		case 33:
+			var v ImmunizationEducation
+			d.message(&v)
+			r.Education = append(r.Education, v)
+		case 34:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProgramEligibility = append(r.ProgramEligibility, v)
+		case 35:
+			var v CodeableConcept
+			d.message(&v)
+			r.FundingSource = &v
+		case 36:
+			var v ImmunizationReaction
+			d.message(&v)
+			r.Reaction = append(r.Reaction, v)
+		case 37:
+			var v ImmunizationProtocolApplied
+			d.message(&v)
+			r.ProtocolApplied = append(r.ProtocolApplied, v)
+		case 38:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.OccurrenceDateTimeElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.OccurrenceStringElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.RecordedElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.PrimarySourceElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.LotNumberElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.ExpirationDateElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.IsSubpotentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImmunizationPerformer as JSON into a byte slice
+func (r ImmunizationPerformer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImmunizationPerformer as JSON object with the members in the order of the fields
+func (r ImmunizationPerformer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Function != nil {
+		e.key("function")
+		r.Function.appendJSON(e)
+	}
+	e.key("actor")
+	r.Actor.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImmunizationPerformer from JSON. Unknown members are ignored.
+func (r *ImmunizationPerformer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImmunizationPerformer
+func (r *ImmunizationPerformer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "function":
+			if d.null() {
+				r.Function = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Function = &v
+			}
+		case "actor":
+			r.Actor.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImmunizationPerformer which shares no memory with the original
+func (r ImmunizationPerformer) DeepCopy() ImmunizationPerformer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Function != nil {
+		v := r.Function.DeepCopy()
+		out.Function = &v
+	}
+	out.Actor = r.Actor.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImmunizationPerformer) Equal(other ImmunizationPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.Equal(*other.Function) {
+		return false
+	}
+	if !r.Actor.Equal(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImmunizationPerformer) EqualsDeep(other ImmunizationPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.EqualsDeep(*other.Function) {
+		return false
+	}
+	if !r.Actor.EqualsDeep(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImmunizationPerformer) EqualsShallow(other ImmunizationPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImmunizationPerformer as FHIR XML
+func (r ImmunizationPerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "function", r.Function); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "actor", r.Actor); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImmunizationPerformer from FHIR XML
+func (r *ImmunizationPerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "function":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Function = &v
+			case "actor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImmunizationPerformer as properties to the RDF node n
+func (r ImmunizationPerformer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Immunization.performer.function", -1, r.Function)
+	n.element("Immunization.performer.actor", -1, r.Actor)
+}
+
+// MarshalBSON marshals the given ImmunizationPerformer as BSON document with the structure of its FHIR JSON
+func (r ImmunizationPerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImmunizationPerformer from a BSON document with the structure of its FHIR JSON
+func (r *ImmunizationPerformer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImmunizationPerformer as protobuf message ImmunizationPerformer of fhir.proto
+func (r ImmunizationPerformer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImmunizationPerformer to the encoded message
+func (r ImmunizationPerformer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Function != nil {
+		e.message(4, *r.Function)
+	}
+	e.message(5, r.Actor)
+}
+
+// UnmarshalProto unmarshals the given ImmunizationPerformer from protobuf message ImmunizationPerformer of fhir.proto
+func (r *ImmunizationPerformer) UnmarshalProto(b []byte) error {
+	*r = ImmunizationPerformer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Function = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Actor = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImmunizationEducation as JSON into a byte slice
+func (r ImmunizationEducation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImmunizationEducation as JSON object with the members in the order of the fields
+func (r ImmunizationEducation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DocumentType != nil {
+		e.key("documentType")
+		e.string(*r.DocumentType)
+	}
+	if r.DocumentTypeElement != nil {
+		e.key("_documentType")
+		r.DocumentTypeElement.appendJSON(e)
+	}
+	if r.Reference != nil {
+		e.key("reference")
+		e.string(*r.Reference)
+	}
+	if r.ReferenceElement != nil {
+		e.key("_reference")
+		r.ReferenceElement.appendJSON(e)
+	}
+	if r.PublicationDate != nil {
+		e.key("publicationDate")
+		e.string(*r.PublicationDate)
+	}
+	if r.PublicationDateElement != nil {
+		e.key("_publicationDate")
+		r.PublicationDateElement.appendJSON(e)
+	}
+	if r.PresentationDate != nil {
+		e.key("presentationDate")
+		e.string(*r.PresentationDate)
+	}
+	if r.PresentationDateElement != nil {
+		e.key("_presentationDate")
+		r.PresentationDateElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImmunizationEducation from JSON. Unknown members are ignored.
+func (r *ImmunizationEducation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImmunizationEducation
+func (r *ImmunizationEducation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "documentType":
+			if v, ok := d.string(); ok {
+				r.DocumentType = &v
+			} else {
+				r.DocumentType = nil
+			}
+		case "_documentType":
+			r.DocumentTypeElement = d.element()
+		case "reference":
+			if v, ok := d.string(); ok {
+				r.Reference = &v
+			} else {
+				r.Reference = nil
+			}
+		case "_reference":
+			r.ReferenceElement = d.element()
+		case "publicationDate":
+			if v, ok := d.string(); ok {
+				r.PublicationDate = &v
+			} else {
+				r.PublicationDate = nil
+			}
+		case "_publicationDate":
+			r.PublicationDateElement = d.element()
+		case "presentationDate":
+			if v, ok := d.string(); ok {
+				r.PresentationDate = &v
+			} else {
+				r.PresentationDate = nil
+			}
+		case "_presentationDate":
+			r.PresentationDateElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImmunizationEducation which shares no memory with the original
+func (r ImmunizationEducation) DeepCopy() ImmunizationEducation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DocumentType != nil {
+		v := *r.DocumentType
+		out.DocumentType = &v
+	}
+	if r.DocumentTypeElement != nil {
+		v := r.DocumentTypeElement.DeepCopy()
+		out.DocumentTypeElement = &v
+	}
+	if r.Reference != nil {
+		v := *r.Reference
+		out.Reference = &v
+	}
+	if r.ReferenceElement != nil {
+		v := r.ReferenceElement.DeepCopy()
+		out.ReferenceElement = &v
+	}
+	if r.PublicationDate != nil {
+		v := *r.PublicationDate
+		out.PublicationDate = &v
+	}
+	if r.PublicationDateElement != nil {
+		v := r.PublicationDateElement.DeepCopy()
+		out.PublicationDateElement = &v
+	}
+	if r.PresentationDate != nil {
+		v := *r.PresentationDate
+		out.PresentationDate = &v
+	}
+	if r.PresentationDateElement != nil {
+		v := r.PresentationDateElement.DeepCopy()
+		out.PresentationDateElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImmunizationEducation) Equal(other ImmunizationEducation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.DocumentType == nil) != (other.DocumentType == nil) || r.DocumentType != nil && *r.DocumentType != *other.DocumentType {
+		return false
+	}
+	if (r.DocumentTypeElement == nil) != (other.DocumentTypeElement == nil) || r.DocumentTypeElement != nil && !r.DocumentTypeElement.Equal(*other.DocumentTypeElement) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && *r.Reference != *other.Reference {
+		return false
+	}
+	if (r.ReferenceElement == nil) != (other.ReferenceElement == nil) || r.ReferenceElement != nil && !r.ReferenceElement.Equal(*other.ReferenceElement) {
+		return false
+	}
+	if (r.PublicationDate == nil) != (other.PublicationDate == nil) || r.PublicationDate != nil && *r.PublicationDate != *other.PublicationDate {
+		return false
+	}
+	if (r.PublicationDateElement == nil) != (other.PublicationDateElement == nil) || r.PublicationDateElement != nil && !r.PublicationDateElement.Equal(*other.PublicationDateElement) {
+		return false
+	}
+	if (r.PresentationDate == nil) != (other.PresentationDate == nil) || r.PresentationDate != nil && *r.PresentationDate != *other.PresentationDate {
+		return false
+	}
+	if (r.PresentationDateElement == nil) != (other.PresentationDateElement == nil) || r.PresentationDateElement != nil && !r.PresentationDateElement.Equal(*other.PresentationDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImmunizationEducation) EqualsDeep(other ImmunizationEducation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.DocumentType == nil) != (other.DocumentType == nil) || r.DocumentType != nil && !equivalentString(*r.DocumentType, *other.DocumentType) {
+		return false
+	}
+	if (r.DocumentTypeElement == nil) != (other.DocumentTypeElement == nil) || r.DocumentTypeElement != nil && !r.DocumentTypeElement.EqualsDeep(*other.DocumentTypeElement) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !equivalentString(*r.Reference, *other.Reference) {
+		return false
+	}
+	if (r.ReferenceElement == nil) != (other.ReferenceElement == nil) || r.ReferenceElement != nil && !r.ReferenceElement.EqualsDeep(*other.ReferenceElement) {
+		return false
+	}
+	if (r.PublicationDate == nil) != (other.PublicationDate == nil) || r.PublicationDate != nil && !equivalentString(*r.PublicationDate, *other.PublicationDate) {
+		return false
+	}
+	if (r.PublicationDateElement == nil) != (other.PublicationDateElement == nil) || r.PublicationDateElement != nil && !r.PublicationDateElement.EqualsDeep(*other.PublicationDateElement) {
+		return false
+	}
+	if (r.PresentationDate == nil) != (other.PresentationDate == nil) || r.PresentationDate != nil && !equivalentString(*r.PresentationDate, *other.PresentationDate) {
+		return false
+	}
+	if (r.PresentationDateElement == nil) != (other.PresentationDateElement == nil) || r.PresentationDateElement != nil && !r.PresentationDateElement.EqualsDeep(*other.PresentationDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImmunizationEducation) EqualsShallow(other ImmunizationEducation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.DocumentType == nil) != (other.DocumentType == nil) || r.DocumentType != nil && !equivalentString(*r.DocumentType, *other.DocumentType) {
+		return false
+	}
+	if (r.Reference == nil) != (other.Reference == nil) || r.Reference != nil && !equivalentString(*r.Reference, *other.Reference) {
+		return false
+	}
+	if (r.PublicationDate == nil) != (other.PublicationDate == nil) || r.PublicationDate != nil && !equivalentString(*r.PublicationDate, *other.PublicationDate) {
+		return false
+	}
+	if (r.PresentationDate == nil) != (other.PresentationDate == nil) || r.PresentationDate != nil && !equivalentString(*r.PresentationDate, *other.PresentationDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImmunizationEducation as FHIR XML
+func (r ImmunizationEducation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "documentType", r.DocumentType, r.DocumentTypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "reference", r.Reference, r.ReferenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publicationDate", r.PublicationDate, r.PublicationDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "presentationDate", r.PresentationDate, r.PresentationDateElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImmunizationEducation from FHIR XML
+func (r *ImmunizationEducation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "documentType":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DocumentType = &v
+				}
+				r.DocumentTypeElement = element
+			case "reference":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Reference = &v
+				}
+				r.ReferenceElement = element
+			case "publicationDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PublicationDate = &v
+				}
+				r.PublicationDateElement = element
+			case "presentationDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PresentationDate = &v
+				}
+				r.PresentationDateElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImmunizationEducation as properties to the RDF node n
+func (r ImmunizationEducation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Immunization.education.documentType", -1, r.DocumentType, r.DocumentTypeElement, "string")
+	n.primitive("Immunization.education.reference", -1, r.Reference, r.ReferenceElement, "string")
+	n.primitive("Immunization.education.publicationDate", -1, r.PublicationDate, r.PublicationDateElement, "dateTime")
+	n.primitive("Immunization.education.presentationDate", -1, r.PresentationDate, r.PresentationDateElement, "dateTime")
+}
+
+// MarshalBSON marshals the given ImmunizationEducation as BSON document with the structure of its FHIR JSON
+func (r ImmunizationEducation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImmunizationEducation from a BSON document with the structure of its FHIR JSON
+func (r *ImmunizationEducation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImmunizationEducation as protobuf message ImmunizationEducation of fhir.proto
+func (r ImmunizationEducation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImmunizationEducation to the encoded message
+func (r ImmunizationEducation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.DocumentType != nil {
+		e.string(4, *r.DocumentType)
+	}
+	if r.Reference != nil {
+		e.string(5, *r.Reference)
+	}
+	if r.PublicationDate != nil {
+		e.string(6, *r.PublicationDate)
+	}
+	if r.PresentationDate != nil {
+		e.string(7, *r.PresentationDate)
+	}
+	if r.DocumentTypeElement != nil {
+		e.message(8, *r.DocumentTypeElement)
+	}
+	if r.ReferenceElement != nil {
+		e.message(9, *r.ReferenceElement)
+	}
+	if r.PublicationDateElement != nil {
+		e.message(10, *r.PublicationDateElement)
+	}
+	if r.PresentationDateElement != nil {
+		e.message(11, *r.PresentationDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImmunizationEducation from protobuf message ImmunizationEducation of fhir.proto
+func (r *ImmunizationEducation) UnmarshalProto(b []byte) error {
+	*r = ImmunizationEducation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.DocumentType = &v
+		case 5:
+			v := d.string()
+			r.Reference = &v
+		case 6:
+			v := d.string()
+			r.PublicationDate = &v
+		case 7:
+			v := d.string()
+			r.PresentationDate = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DocumentTypeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ReferenceElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.PublicationDateElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.PresentationDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImmunizationReaction as JSON into a byte slice
+func (r ImmunizationReaction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImmunizationReaction as JSON object with the members in the order of the fields
+func (r ImmunizationReaction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Detail != nil {
+		e.key("detail")
+		r.Detail.appendJSON(e)
+	}
+	if r.Reported != nil {
+		e.key("reported")
+		e.bool(*r.Reported)
+	}
+	if r.ReportedElement != nil {
+		e.key("_reported")
+		r.ReportedElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImmunizationReaction from JSON. Unknown members are ignored.
+func (r *ImmunizationReaction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImmunizationReaction
+func (r *ImmunizationReaction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "detail":
+			if d.null() {
+				r.Detail = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Detail = &v
+			}
+		case "reported":
+			if v, ok := d.bool(); ok {
+				r.Reported = &v
+			} else {
+				r.Reported = nil
+			}
+		case "_reported":
+			r.ReportedElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImmunizationReaction which shares no memory with the original
+func (r ImmunizationReaction) DeepCopy() ImmunizationReaction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Detail != nil {
+		v := r.Detail.DeepCopy()
+		out.Detail = &v
+	}
+	if r.Reported != nil {
+		v := *r.Reported
+		out.Reported = &v
+	}
+	if r.ReportedElement != nil {
+		v := r.ReportedElement.DeepCopy()
+		out.ReportedElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImmunizationReaction) Equal(other ImmunizationReaction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !r.Detail.Equal(*other.Detail) {
+		return false
+	}
+	if (r.Reported == nil) != (other.Reported == nil) || r.Reported != nil && *r.Reported != *other.Reported {
+		return false
+	}
+	if (r.ReportedElement == nil) != (other.ReportedElement == nil) || r.ReportedElement != nil && !r.ReportedElement.Equal(*other.ReportedElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImmunizationReaction) EqualsDeep(other ImmunizationReaction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !r.Detail.EqualsDeep(*other.Detail) {
+		return false
+	}
+	if (r.Reported == nil) != (other.Reported == nil) || r.Reported != nil && *r.Reported != *other.Reported {
+		return false
+	}
+	if (r.ReportedElement == nil) != (other.ReportedElement == nil) || r.ReportedElement != nil && !r.ReportedElement.EqualsDeep(*other.ReportedElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImmunizationReaction) EqualsShallow(other ImmunizationReaction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Reported == nil) != (other.Reported == nil) || r.Reported != nil && *r.Reported != *other.Reported {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImmunizationReaction as FHIR XML
+func (r ImmunizationReaction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "detail", r.Detail); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "reported", r.Reported, r.ReportedElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImmunizationReaction from FHIR XML
+func (r *ImmunizationReaction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "detail":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = &v
+			case "reported":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Reported = &v
+				}
+				r.ReportedElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImmunizationReaction as properties to the RDF node n
+func (r ImmunizationReaction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Immunization.reaction.date", -1, r.Date, r.DateElement, "dateTime")
+	n.element("Immunization.reaction.detail", -1, r.Detail)
+	n.primitive("Immunization.reaction.reported", -1, r.Reported, r.ReportedElement, "boolean")
+}
+
+// MarshalBSON marshals the given ImmunizationReaction as BSON document with the structure of its FHIR JSON
+func (r ImmunizationReaction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImmunizationReaction from a BSON document with the structure of its FHIR JSON
+func (r *ImmunizationReaction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImmunizationReaction as protobuf message ImmunizationReaction of fhir.proto
+func (r ImmunizationReaction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImmunizationReaction to the encoded message
+func (r ImmunizationReaction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Date != nil {
+		e.string(4, *r.Date)
+	}
+	if r.Detail != nil {
+		e.message(5, *r.Detail)
+	}
+	if r.Reported != nil {
+		e.varint(6, protoBool(*r.Reported))
+	}
+	if r.DateElement != nil {
+		e.message(7, *r.DateElement)
+	}
+	if r.ReportedElement != nil {
+		e.message(8, *r.ReportedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImmunizationReaction from protobuf message ImmunizationReaction of fhir.proto
+func (r *ImmunizationReaction) UnmarshalProto(b []byte) error {
+	*r = ImmunizationReaction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Date = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Detail = &v
+		case 6:
+			v := d.bool()
+			r.Reported = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ReportedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImmunizationProtocolApplied as JSON into a byte slice
+func (r ImmunizationProtocolApplied) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImmunizationProtocolApplied as JSON object with the members in the order of the fields
+func (r ImmunizationProtocolApplied) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Series != nil {
+		e.key("series")
+		e.string(*r.Series)
+	}
+	if r.SeriesElement != nil {
+		e.key("_series")
+		r.SeriesElement.appendJSON(e)
+	}
+	if r.Authority != nil {
+		e.key("authority")
+		r.Authority.appendJSON(e)
+	}
+	if len(r.TargetDisease) > 0 {
+		e.key("targetDisease")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.TargetDisease {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DoseNumberPositiveInt != nil {
+		e.key("doseNumberPositiveInt")
+		e.int(*r.DoseNumberPositiveInt)
+	}
+	if r.DoseNumberPositiveIntElement != nil {
+		e.key("_doseNumberPositiveInt")
+		r.DoseNumberPositiveIntElement.appendJSON(e)
+	}
+	if r.DoseNumberString != nil {
+		e.key("doseNumberString")
+		e.string(*r.DoseNumberString)
+	}
+	if r.DoseNumberStringElement != nil {
+		e.key("_doseNumberString")
+		r.DoseNumberStringElement.appendJSON(e)
+	}
+	if r.SeriesDosesPositiveInt != nil {
+		e.key("seriesDosesPositiveInt")
+		e.int(*r.SeriesDosesPositiveInt)
+	}
+	if r.SeriesDosesPositiveIntElement != nil {
+		e.key("_seriesDosesPositiveInt")
+		r.SeriesDosesPositiveIntElement.appendJSON(e)
+	}
+	if r.SeriesDosesString != nil {
+		e.key("seriesDosesString")
+		e.string(*r.SeriesDosesString)
+	}
+	if r.SeriesDosesStringElement != nil {
+		e.key("_seriesDosesString")
+		r.SeriesDosesStringElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImmunizationProtocolApplied from JSON. Unknown members are ignored.
+func (r *ImmunizationProtocolApplied) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImmunizationProtocolApplied
+func (r *ImmunizationProtocolApplied) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "series":
+			if v, ok := d.string(); ok {
+				r.Series = &v
+			} else {
+				r.Series = nil
+			}
+		case "_series":
+			r.SeriesElement = d.element()
+		case "authority":
+			if d.null() {
+				r.Authority = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Authority = &v
+			}
+		case "targetDisease":
+			a := d.array()
+			r.TargetDisease = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.TargetDisease = append(r.TargetDisease, v)
+			}
+			if r.TargetDisease == nil && !a.isNull {
+				r.TargetDisease = []CodeableConcept{}
+			}
+		case "doseNumberPositiveInt":
+			if v, ok := d.int(); ok {
+				r.DoseNumberPositiveInt = &v
+			} else {
+				r.DoseNumberPositiveInt = nil
+			}
+		case "_doseNumberPositiveInt":
+			r.DoseNumberPositiveIntElement = d.element()
+		case "doseNumberString":
+			if v, ok := d.string(); ok {
+				r.DoseNumberString = &v
+			} else {
+				r.DoseNumberString = nil
+			}
+		case "_doseNumberString":
+			r.DoseNumberStringElement = d.element()
+		case "seriesDosesPositiveInt":
+			if v, ok := d.int(); ok {
+				r.SeriesDosesPositiveInt = &v
+			} else {
+				r.SeriesDosesPositiveInt = nil
+			}
+		case "_seriesDosesPositiveInt":
+			r.SeriesDosesPositiveIntElement = d.element()
+		case "seriesDosesString":
+			if v, ok := d.string(); ok {
+				r.SeriesDosesString = &v
+			} else {
+				r.SeriesDosesString = nil
+			}
+		case "_seriesDosesString":
+			r.SeriesDosesStringElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImmunizationProtocolApplied which shares no memory with the original
+func (r ImmunizationProtocolApplied) DeepCopy() ImmunizationProtocolApplied {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Series != nil {
+		v := *r.Series
+		out.Series = &v
+	}
+	if r.SeriesElement != nil {
+		v := r.SeriesElement.DeepCopy()
+		out.SeriesElement = &v
+	}
+	if r.Authority != nil {
+		v := r.Authority.DeepCopy()
+		out.Authority = &v
+	}
+	if r.TargetDisease != nil {
+		out.TargetDisease = make([]CodeableConcept, len(r.TargetDisease))
+		for i := range r.TargetDisease {
+			out.TargetDisease[i] = r.TargetDisease[i].DeepCopy()
+		}
+	}
+	if r.DoseNumberPositiveInt != nil {
+		v := *r.DoseNumberPositiveInt
+		out.DoseNumberPositiveInt = &v
+	}
+	if r.DoseNumberPositiveIntElement != nil {
+		v := r.DoseNumberPositiveIntElement.DeepCopy()
+		out.DoseNumberPositiveIntElement = &v
+	}
+	if r.DoseNumberString != nil {
+		v := *r.DoseNumberString
+		out.DoseNumberString = &v
+	}
+	if r.DoseNumberStringElement != nil {
+		v := r.DoseNumberStringElement.DeepCopy()
+		out.DoseNumberStringElement = &v
+	}
+	if r.SeriesDosesPositiveInt != nil {
+		v := *r.SeriesDosesPositiveInt
+		out.SeriesDosesPositiveInt = &v
+	}
+	if r.SeriesDosesPositiveIntElement != nil {
+		v := r.SeriesDosesPositiveIntElement.DeepCopy()
+		out.SeriesDosesPositiveIntElement = &v
+	}
+	if r.SeriesDosesString != nil {
+		v := *r.SeriesDosesString
+		out.SeriesDosesString = &v
+	}
+	if r.SeriesDosesStringElement != nil {
+		v := r.SeriesDosesStringElement.DeepCopy()
+		out.SeriesDosesStringElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImmunizationProtocolApplied) Equal(other ImmunizationProtocolApplied) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Series == nil) != (other.Series == nil) || r.Series != nil && *r.Series != *other.Series {
+		return false
+	}
+	if (r.SeriesElement == nil) != (other.SeriesElement == nil) || r.SeriesElement != nil && !r.SeriesElement.Equal(*other.SeriesElement) {
+		return false
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !r.Authority.Equal(*other.Authority) {
+		return false
+	}
+	if len(r.TargetDisease) != len(other.TargetDisease) {
+		return false
+	}
+	for i := range r.TargetDisease {
+		if !r.TargetDisease[i].Equal(other.TargetDisease[i]) {
+			return false
+		}
+	}
+	if (r.DoseNumberPositiveInt == nil) != (other.DoseNumberPositiveInt == nil) || r.DoseNumberPositiveInt != nil && *r.DoseNumberPositiveInt != *other.DoseNumberPositiveInt {
+		return false
+	}
+	if (r.DoseNumberPositiveIntElement == nil) != (other.DoseNumberPositiveIntElement == nil) || r.DoseNumberPositiveIntElement != nil && !r.DoseNumberPositiveIntElement.Equal(*other.DoseNumberPositiveIntElement) {
+		return false
+	}
+	if (r.DoseNumberString == nil) != (other.DoseNumberString == nil) || r.DoseNumberString != nil && *r.DoseNumberString != *other.DoseNumberString {
+		return false
+	}
+	if (r.DoseNumberStringElement == nil) != (other.DoseNumberStringElement == nil) || r.DoseNumberStringElement != nil && !r.DoseNumberStringElement.Equal(*other.DoseNumberStringElement) {
+		return false
+	}
+	if (r.SeriesDosesPositiveInt == nil) != (other.SeriesDosesPositiveInt == nil) || r.SeriesDosesPositiveInt != nil && *r.SeriesDosesPositiveInt != *other.SeriesDosesPositiveInt {
+		return false
+	}
+	if (r.SeriesDosesPositiveIntElement == nil) != (other.SeriesDosesPositiveIntElement == nil) || r.SeriesDosesPositiveIntElement != nil && !r.SeriesDosesPositiveIntElement.Equal(*other.SeriesDosesPositiveIntElement) {
+		return false
+	}
+	if (r.SeriesDosesString == nil) != (other.SeriesDosesString == nil) || r.SeriesDosesString != nil && *r.SeriesDosesString != *other.SeriesDosesString {
+		return false
+	}
+	if (r.SeriesDosesStringElement == nil) != (other.SeriesDosesStringElement == nil) || r.SeriesDosesStringElement != nil && !r.SeriesDosesStringElement.Equal(*other.SeriesDosesStringElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImmunizationProtocolApplied) EqualsDeep(other ImmunizationProtocolApplied) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Series == nil) != (other.Series == nil) || r.Series != nil && !equivalentString(*r.Series, *other.Series) {
+		return false
+	}
+	if (r.SeriesElement == nil) != (other.SeriesElement == nil) || r.SeriesElement != nil && !r.SeriesElement.EqualsDeep(*other.SeriesElement) {
+		return false
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !r.Authority.EqualsDeep(*other.Authority) {
+		return false
+	}
+	if !equivalentList(len(r.TargetDisease), len(other.TargetDisease), func(i, j int) bool {
+		return r.TargetDisease[i].EqualsDeep(other.TargetDisease[j])
+	}) {
+		return false
+	}
+	if (r.DoseNumberPositiveInt == nil) != (other.DoseNumberPositiveInt == nil) || r.DoseNumberPositiveInt != nil && *r.DoseNumberPositiveInt != *other.DoseNumberPositiveInt {
+		return false
+	}
+	if (r.DoseNumberPositiveIntElement == nil) != (other.DoseNumberPositiveIntElement == nil) || r.DoseNumberPositiveIntElement != nil && !r.DoseNumberPositiveIntElement.EqualsDeep(*other.DoseNumberPositiveIntElement) {
+		return false
+	}
+	if (r.DoseNumberString == nil) != (other.DoseNumberString == nil) || r.DoseNumberString != nil && !equivalentString(*r.DoseNumberString, *other.DoseNumberString) {
+		return false
+	}
+	if (r.DoseNumberStringElement == nil) != (other.DoseNumberStringElement == nil) || r.DoseNumberStringElement != nil && !r.DoseNumberStringElement.EqualsDeep(*other.DoseNumberStringElement) {
+		return false
+	}
+	if (r.SeriesDosesPositiveInt == nil) != (other.SeriesDosesPositiveInt == nil) || r.SeriesDosesPositiveInt != nil && *r.SeriesDosesPositiveInt != *other.SeriesDosesPositiveInt {
+		return false
+	}
+	if (r.SeriesDosesPositiveIntElement == nil) != (other.SeriesDosesPositiveIntElement == nil) || r.SeriesDosesPositiveIntElement != nil && !r.SeriesDosesPositiveIntElement.EqualsDeep(*other.SeriesDosesPositiveIntElement) {
+		return false
+	}
+	if (r.SeriesDosesString == nil) != (other.SeriesDosesString == nil) || r.SeriesDosesString != nil && !equivalentString(*r.SeriesDosesString, *other.SeriesDosesString) {
+		return false
+	}
+	if (r.SeriesDosesStringElement == nil) != (other.SeriesDosesStringElement == nil) || r.SeriesDosesStringElement != nil && !r.SeriesDosesStringElement.EqualsDeep(*other.SeriesDosesStringElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImmunizationProtocolApplied) EqualsShallow(other ImmunizationProtocolApplied) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Series == nil) != (other.Series == nil) || r.Series != nil && !equivalentString(*r.Series, *other.Series) {
+		return false
+	}
+	if (r.DoseNumberPositiveInt == nil) != (other.DoseNumberPositiveInt == nil) || r.DoseNumberPositiveInt != nil && *r.DoseNumberPositiveInt != *other.DoseNumberPositiveInt {
+		return false
+	}
+	if (r.DoseNumberString == nil) != (other.DoseNumberString == nil) || r.DoseNumberString != nil && !equivalentString(*r.DoseNumberString, *other.DoseNumberString) {
+		return false
+	}
+	if (r.SeriesDosesPositiveInt == nil) != (other.SeriesDosesPositiveInt == nil) || r.SeriesDosesPositiveInt != nil && *r.SeriesDosesPositiveInt != *other.SeriesDosesPositiveInt {
+		return false
+	}
+	if (r.SeriesDosesString == nil) != (other.SeriesDosesString == nil) || r.SeriesDosesString != nil && !equivalentString(*r.SeriesDosesString, *other.SeriesDosesString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImmunizationProtocolApplied as FHIR XML
+func (r ImmunizationProtocolApplied) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "series", r.Series, r.SeriesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "authority", r.Authority); err != nil {
+		return err
+	}
+	for _, v := range r.TargetDisease {
+		if err := encodeXMLElement(e, "targetDisease", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "doseNumberPositiveInt", r.DoseNumberPositiveInt, r.DoseNumberPositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doseNumberString", r.DoseNumberString, r.DoseNumberStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "seriesDosesPositiveInt", r.SeriesDosesPositiveInt, r.SeriesDosesPositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "seriesDosesString", r.SeriesDosesString, r.SeriesDosesStringElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImmunizationProtocolApplied from FHIR XML
+func (r *ImmunizationProtocolApplied) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "series":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Series = &v
+				}
+				r.SeriesElement = element
+			case "authority":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Authority = &v
+			case "targetDisease":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TargetDisease = append(r.TargetDisease, v)
+			case "doseNumberPositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoseNumberPositiveInt = &v
+				}
+				r.DoseNumberPositiveIntElement = element
+			case "doseNumberString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoseNumberString = &v
+				}
+				r.DoseNumberStringElement = element
+			case "seriesDosesPositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SeriesDosesPositiveInt = &v
+				}
+				r.SeriesDosesPositiveIntElement = element
+			case "seriesDosesString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SeriesDosesString = &v
+				}
+				r.SeriesDosesStringElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImmunizationProtocolApplied as properties to the RDF node n
+func (r ImmunizationProtocolApplied) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Immunization.protocolApplied.series", -1, r.Series, r.SeriesElement, "string")
+	n.element("Immunization.protocolApplied.authority", -1, r.Authority)
+	for i, v := range r.TargetDisease {
+		n.element("Immunization.protocolApplied.targetDisease", i, v)
+	}
+	n.primitive("Immunization.protocolApplied.doseNumberPositiveInt", -1, r.DoseNumberPositiveInt, r.DoseNumberPositiveIntElement, "positiveInt")
+	n.primitive("Immunization.protocolApplied.doseNumberString", -1, r.DoseNumberString, r.DoseNumberStringElement, "string")
+	n.primitive("Immunization.protocolApplied.seriesDosesPositiveInt", -1, r.SeriesDosesPositiveInt, r.SeriesDosesPositiveIntElement, "positiveInt")
+	n.primitive("Immunization.protocolApplied.seriesDosesString", -1, r.SeriesDosesString, r.SeriesDosesStringElement, "string")
+}
+
+// MarshalBSON marshals the given ImmunizationProtocolApplied as BSON document with the structure of its FHIR JSON
+func (r ImmunizationProtocolApplied) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImmunizationProtocolApplied from a BSON document with the structure of its FHIR JSON
+func (r *ImmunizationProtocolApplied) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImmunizationProtocolApplied as protobuf message ImmunizationProtocolApplied of fhir.proto
+func (r ImmunizationProtocolApplied) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImmunizationProtocolApplied to the encoded message
+func (r ImmunizationProtocolApplied) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Series != nil {
+		e.string(4, *r.Series)
+	}
+	if r.Authority != nil {
+		e.message(5, *r.Authority)
+	}
+	for _, v := range r.TargetDisease {
+		e.message(6, v)
+	}
+	if r.DoseNumberPositiveInt != nil {
+		e.varint(7, uint64(int64(*r.DoseNumberPositiveInt)))
+	}
+	if r.DoseNumberString != nil {
+		e.string(8, *r.DoseNumberString)
+	}
+	if r.SeriesDosesPositiveInt != nil {
+		e.varint(9, uint64(int64(*r.SeriesDosesPositiveInt)))
+	}
+	if r.SeriesDosesString != nil {
+		e.string(10, *r.SeriesDosesString)
+	}
+	if r.SeriesElement != nil {
+		e.message(11, *r.SeriesElement)
+	}
+	if r.DoseNumberPositiveIntElement != nil {
+		e.message(12, *r.DoseNumberPositiveIntElement)
+	}
+	if r.DoseNumberStringElement != nil {
+		e.message(13, *r.DoseNumberStringElement)
+	}
+	if r.SeriesDosesPositiveIntElement != nil {
+		e.message(14, *r.SeriesDosesPositiveIntElement)
+	}
+	if r.SeriesDosesStringElement != nil {
+		e.message(15, *r.SeriesDosesStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImmunizationProtocolApplied from protobuf message ImmunizationProtocolApplied of fhir.proto
+func (r *ImmunizationProtocolApplied) UnmarshalProto(b []byte) error {
+	*r = ImmunizationProtocolApplied{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Series = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Authority = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.TargetDisease = append(r.TargetDisease, v)
+		case 7:
+			v := d.int()
+			r.DoseNumberPositiveInt = &v
+		case 8:
+			v := d.string()
+			r.DoseNumberString = &v
+		case 9:
+			v := d.int()
+			r.SeriesDosesPositiveInt = &v
+		case 10:
+			v := d.string()
+			r.SeriesDosesString = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.SeriesElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.DoseNumberPositiveIntElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DoseNumberStringElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.SeriesDosesPositiveIntElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.SeriesDosesStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalImmunization unmarshals a Immunization.
 func UnmarshalImmunization(b []byte) (Immunization, error) {
 	var immunization Immunization
-	if err := json.Unmarshal(b, &immunization); err != nil {
+	if err := immunization.UnmarshalJSON(b); err != nil {
 		return immunization, err
 	}
 	return immunization, nil
@@ -14,53 +14,1490 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ImmunizationEvaluation is documented here http://hl7.org/fhir/StructureDefinition/ImmunizationEvaluation
 type ImmunizationEvaluation struct {
-	Id                     *string                           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status                 ImmunizationEvaluationStatusCodes `bson:"status" json:"status"`
-	Patient                Reference                         `bson:"patient" json:"patient"`
-	Date                   *string                           `bson:"date,omitempty" json:"date,omitempty"`
-	Authority              *Reference                        `bson:"authority,omitempty" json:"authority,omitempty"`
-	TargetDisease          CodeableConcept                   `bson:"targetDisease" json:"targetDisease"`
-	ImmunizationEvent      Reference                         `bson:"immunizationEvent" json:"immunizationEvent"`
-	DoseStatus             CodeableConcept                   `bson:"doseStatus" json:"doseStatus"`
-	DoseStatusReason       []CodeableConcept                 `bson:"doseStatusReason,omitempty" json:"doseStatusReason,omitempty"`
-	Description            *string                           `bson:"description,omitempty" json:"description,omitempty"`
-	Series                 *string                           `bson:"series,omitempty" json:"series,omitempty"`
-	DoseNumberPositiveInt  *int                              `bson:"doseNumberPositiveInt,omitempty" json:"doseNumberPositiveInt,omitempty"`
-	DoseNumberString       *string                           `bson:"doseNumberString,omitempty" json:"doseNumberString,omitempty"`
-	SeriesDosesPositiveInt *int                              `bson:"seriesDosesPositiveInt,omitempty" json:"seriesDosesPositiveInt,omitempty"`
-	SeriesDosesString      *string                           `bson:"seriesDosesString,omitempty" json:"seriesDosesString,omitempty"`
-}
-type OtherImmunizationEvaluation ImmunizationEvaluation
+	Id                            *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                     *Element                          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                          *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     []json.RawMessage                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                    []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                        ImmunizationEvaluationStatusCodes `bson:"status" json:"status"`
+	StatusElement                 *Element                          `bson:"_status,omitempty" json:"_status,omitempty"`
+	Patient                       Reference                         `bson:"patient" json:"patient"`
+	Date                          *string                           `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement                   *Element                          `bson:"_date,omitempty" json:"_date,omitempty"`
+	Authority                     *Reference                        `bson:"authority,omitempty" json:"authority,omitempty"`
+	TargetDisease                 CodeableConcept                   `bson:"targetDisease" json:"targetDisease"`
+	ImmunizationEvent             Reference                         `bson:"immunizationEvent" json:"immunizationEvent"`
+	DoseStatus                    CodeableConcept                   `bson:"doseStatus" json:"doseStatus"`
+	DoseStatusReason              []CodeableConcept                 `bson:"doseStatusReason,omitempty" json:"doseStatusReason,omitempty"`
+	Description                   *string                           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement            *Element                          `bson:"_description,omitempty" json:"_description,omitempty"`
+	Series                        *string                           `bson:"series,omitempty" json:"series,omitempty"`
+	SeriesElement                 *Element                          `bson:"_series,omitempty" json:"_series,omitempty"`
+	DoseNumberPositiveInt         *int                              `bson:"doseNumberPositiveInt,omitempty" json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement  *Element                          `bson:"_doseNumberPositiveInt,omitempty" json:"_doseNumberPositiveInt,omitempty"`
+	DoseNumberString              *string                           `bson:"doseNumberString,omitempty" json:"doseNumberString,omitempty"`
+	DoseNumberStringElement       *Element                          `bson:"_doseNumberString,omitempty" json:"_doseNumberString,omitempty"`
+	SeriesDosesPositiveInt        *int                              `bson:"seriesDosesPositiveInt,omitempty" json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *Element                          `bson:"_seriesDosesPositiveInt,omitempty" json:"_seriesDosesPositiveInt,omitempty"`
+	SeriesDosesString             *string                           `bson:"seriesDosesString,omitempty" json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement      *Element                          `bson:"_seriesDosesString,omitempty" json:"_seriesDosesString,omitempty"`
+}
+
+// ImmunizationEvaluationDoseNumber is implemented by the types allowed for ImmunizationEvaluation.doseNumber[x]
+type ImmunizationEvaluationDoseNumber interface {
+	isImmunizationEvaluationDoseNumber()
+}
+
+func (PositiveInt) isImmunizationEvaluationDoseNumber() {}
+func (String) isImmunizationEvaluationDoseNumber()      {}
+
+// DoseNumber returns the value of ImmunizationEvaluation.doseNumber[x] together with its FHIR type code or nil if no value is set
+func (r ImmunizationEvaluation) DoseNumber() (ImmunizationEvaluationDoseNumber, string) {
+	if r.DoseNumberPositiveInt != nil {
+		return PositiveInt(*r.DoseNumberPositiveInt), "positiveInt"
+	}
+	if r.DoseNumberString != nil {
+		return String(*r.DoseNumberString), "string"
+	}
+	return nil, ""
+}
+
+// SetDoseNumber sets the value of ImmunizationEvaluation.doseNumber[x], given as value or pointer, and clears all other types
+func (r *ImmunizationEvaluation) SetDoseNumber(value ImmunizationEvaluationDoseNumber) {
+	r.DoseNumberPositiveInt = nil
+	r.DoseNumberString = nil
+	switch v := value.(type) {
+	case PositiveInt:
+		primitive := int(v)
+		r.DoseNumberPositiveInt = &primitive
+	case *PositiveInt:
+		r.DoseNumberPositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.DoseNumberString = &primitive
+	case *String:
+		r.DoseNumberString = (*string)(v)
+	}
+}
+
+// ImmunizationEvaluationSeriesDoses is implemented by the types allowed for ImmunizationEvaluation.seriesDoses[x]
+type ImmunizationEvaluationSeriesDoses interface {
+	isImmunizationEvaluationSeriesDoses()
+}
+
+func (PositiveInt) isImmunizationEvaluationSeriesDoses() {}
+func (String) isImmunizationEvaluationSeriesDoses()      {}
+
+// SeriesDoses returns the value of ImmunizationEvaluation.seriesDoses[x] together with its FHIR type code or nil if no value is set
+func (r ImmunizationEvaluation) SeriesDoses() (ImmunizationEvaluationSeriesDoses, string) {
+	if r.SeriesDosesPositiveInt != nil {
+		return PositiveInt(*r.SeriesDosesPositiveInt), "positiveInt"
+	}
+	if r.SeriesDosesString != nil {
+		return String(*r.SeriesDosesString), "string"
+	}
+	return nil, ""
+}
+
+// SetSeriesDoses sets the value of ImmunizationEvaluation.seriesDoses[x], given as value or pointer, and clears all other types
+func (r *ImmunizationEvaluation) SetSeriesDoses(value ImmunizationEvaluationSeriesDoses) {
+	r.SeriesDosesPositiveInt = nil
+	r.SeriesDosesString = nil
+	switch v := value.(type) {
+	case PositiveInt:
+		primitive := int(v)
+		r.SeriesDosesPositiveInt = &primitive
+	case *PositiveInt:
+		r.SeriesDosesPositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.SeriesDosesString = &primitive
+	case *String:
+		r.SeriesDosesString = (*string)(v)
+	}
+}
 
 // MarshalJSON marshals the given ImmunizationEvaluation as JSON into a byte slice
 func (r ImmunizationEvaluation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherImmunizationEvaluation
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherImmunizationEvaluation: OtherImmunizationEvaluation(r),
-		ResourceType:                "ImmunizationEvaluation",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImmunizationEvaluation as JSON object with the members in the order of the fields
+func (r ImmunizationEvaluation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ImmunizationEvaluation\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Authority != nil {
+		e.key("authority")
+		r.Authority.appendJSON(e)
+	}
+	e.key("targetDisease")
+	r.TargetDisease.appendJSON(e)
+	e.key("immunizationEvent")
+	r.ImmunizationEvent.appendJSON(e)
+	e.key("doseStatus")
+	r.DoseStatus.appendJSON(e)
+	if len(r.DoseStatusReason) > 0 {
+		e.key("doseStatusReason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DoseStatusReason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Series != nil {
+		e.key("series")
+		e.string(*r.Series)
+	}
+	if r.SeriesElement != nil {
+		e.key("_series")
+		r.SeriesElement.appendJSON(e)
+	}
+	if r.DoseNumberPositiveInt != nil {
+		e.key("doseNumberPositiveInt")
+		e.int(*r.DoseNumberPositiveInt)
+	}
+	if r.DoseNumberPositiveIntElement != nil {
+		e.key("_doseNumberPositiveInt")
+		r.DoseNumberPositiveIntElement.appendJSON(e)
+	}
+	if r.DoseNumberString != nil {
+		e.key("doseNumberString")
+		e.string(*r.DoseNumberString)
+	}
+	if r.DoseNumberStringElement != nil {
+		e.key("_doseNumberString")
+		r.DoseNumberStringElement.appendJSON(e)
+	}
+	if r.SeriesDosesPositiveInt != nil {
+		e.key("seriesDosesPositiveInt")
+		e.int(*r.SeriesDosesPositiveInt)
+	}
+	if r.SeriesDosesPositiveIntElement != nil {
+		e.key("_seriesDosesPositiveInt")
+		r.SeriesDosesPositiveIntElement.appendJSON(e)
+	}
+	if r.SeriesDosesString != nil {
+		e.key("seriesDosesString")
+		e.string(*r.SeriesDosesString)
+	}
+	if r.SeriesDosesStringElement != nil {
+		e.key("_seriesDosesString")
+		r.SeriesDosesStringElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImmunizationEvaluation from JSON. Unknown members are ignored.
+func (r *ImmunizationEvaluation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImmunizationEvaluation
+func (r *ImmunizationEvaluation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "authority":
+			if d.null() {
+				r.Authority = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Authority = &v
+			}
+		case "targetDisease":
+			r.TargetDisease.decodeJSON(d)
+		case "immunizationEvent":
+			r.ImmunizationEvent.decodeJSON(d)
+		case "doseStatus":
+			r.DoseStatus.decodeJSON(d)
+		case "doseStatusReason":
+			a := d.array()
+			r.DoseStatusReason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DoseStatusReason = append(r.DoseStatusReason, v)
+			}
+			if r.DoseStatusReason == nil && !a.isNull {
+				r.DoseStatusReason = []CodeableConcept{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "series":
+			if v, ok := d.string(); ok {
+				r.Series = &v
+			} else {
+				r.Series = nil
+			}
+		case "_series":
+			r.SeriesElement = d.element()
+		case "doseNumberPositiveInt":
+			if v, ok := d.int(); ok {
+				r.DoseNumberPositiveInt = &v
+			} else {
+				r.DoseNumberPositiveInt = nil
+			}
+		case "_doseNumberPositiveInt":
+			r.DoseNumberPositiveIntElement = d.element()
+		case "doseNumberString":
+			if v, ok := d.string(); ok {
+				r.DoseNumberString = &v
+			} else {
+				r.DoseNumberString = nil
+			}
+		case "_doseNumberString":
+			r.DoseNumberStringElement = d.element()
+		case "seriesDosesPositiveInt":
+			if v, ok := d.int(); ok {
+				r.SeriesDosesPositiveInt = &v
+			} else {
+				r.SeriesDosesPositiveInt = nil
+			}
+		case "_seriesDosesPositiveInt":
+			r.SeriesDosesPositiveIntElement = d.element()
+		case "seriesDosesString":
+			if v, ok := d.string(); ok {
+				r.SeriesDosesString = &v
+			} else {
+				r.SeriesDosesString = nil
+			}
+		case "_seriesDosesString":
+			r.SeriesDosesStringElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImmunizationEvaluation which shares no memory with the original
+func (r ImmunizationEvaluation) DeepCopy() ImmunizationEvaluation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Authority != nil {
+		v := r.Authority.DeepCopy()
+		out.Authority = &v
+	}
+	out.TargetDisease = r.TargetDisease.DeepCopy()
+	out.ImmunizationEvent = r.ImmunizationEvent.DeepCopy()
+	out.DoseStatus = r.DoseStatus.DeepCopy()
+	if r.DoseStatusReason != nil {
+		out.DoseStatusReason = make([]CodeableConcept, len(r.DoseStatusReason))
+		for i := range r.DoseStatusReason {
+			out.DoseStatusReason[i] = r.DoseStatusReason[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Series != nil {
+		v := *r.Series
+		out.Series = &v
+	}
+	if r.SeriesElement != nil {
+		v := r.SeriesElement.DeepCopy()
+		out.SeriesElement = &v
+	}
+	if r.DoseNumberPositiveInt != nil {
+		v := *r.DoseNumberPositiveInt
+		out.DoseNumberPositiveInt = &v
+	}
+	if r.DoseNumberPositiveIntElement != nil {
+		v := r.DoseNumberPositiveIntElement.DeepCopy()
+		out.DoseNumberPositiveIntElement = &v
+	}
+	if r.DoseNumberString != nil {
+		v := *r.DoseNumberString
+		out.DoseNumberString = &v
+	}
+	if r.DoseNumberStringElement != nil {
+		v := r.DoseNumberStringElement.DeepCopy()
+		out.DoseNumberStringElement = &v
+	}
+	if r.SeriesDosesPositiveInt != nil {
+		v := *r.SeriesDosesPositiveInt
+		out.SeriesDosesPositiveInt = &v
+	}
+	if r.SeriesDosesPositiveIntElement != nil {
+		v := r.SeriesDosesPositiveIntElement.DeepCopy()
+		out.SeriesDosesPositiveIntElement = &v
+	}
+	if r.SeriesDosesString != nil {
+		v := *r.SeriesDosesString
+		out.SeriesDosesString = &v
+	}
+	if r.SeriesDosesStringElement != nil {
+		v := r.SeriesDosesStringElement.DeepCopy()
+		out.SeriesDosesStringElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImmunizationEvaluation) Equal(other ImmunizationEvaluation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !r.Authority.Equal(*other.Authority) {
+		return false
+	}
+	if !r.TargetDisease.Equal(other.TargetDisease) {
+		return false
+	}
+	if !r.ImmunizationEvent.Equal(other.ImmunizationEvent) {
+		return false
+	}
+	if !r.DoseStatus.Equal(other.DoseStatus) {
+		return false
+	}
+	if len(r.DoseStatusReason) != len(other.DoseStatusReason) {
+		return false
+	}
+	for i := range r.DoseStatusReason {
+		if !r.DoseStatusReason[i].Equal(other.DoseStatusReason[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Series == nil) != (other.Series == nil) || r.Series != nil && *r.Series != *other.Series {
+		return false
+	}
+	if (r.SeriesElement == nil) != (other.SeriesElement == nil) || r.SeriesElement != nil && !r.SeriesElement.Equal(*other.SeriesElement) {
+		return false
+	}
+	if (r.DoseNumberPositiveInt == nil) != (other.DoseNumberPositiveInt == nil) || r.DoseNumberPositiveInt != nil && *r.DoseNumberPositiveInt != *other.DoseNumberPositiveInt {
+		return false
+	}
+	if (r.DoseNumberPositiveIntElement == nil) != (other.DoseNumberPositiveIntElement == nil) || r.DoseNumberPositiveIntElement != nil && !r.DoseNumberPositiveIntElement.Equal(*other.DoseNumberPositiveIntElement) {
+		return false
+	}
+	if (r.DoseNumberString == nil) != (other.DoseNumberString == nil) || r.DoseNumberString != nil && *r.DoseNumberString != *other.DoseNumberString {
+		return false
+	}
+	if (r.DoseNumberStringElement == nil) != (other.DoseNumberStringElement == nil) || r.DoseNumberStringElement != nil && !r.DoseNumberStringElement.Equal(*other.DoseNumberStringElement) {
+		return false
+	}
+	if (r.SeriesDosesPositiveInt == nil) != (other.SeriesDosesPositiveInt == nil) || r.SeriesDosesPositiveInt != nil && *r.SeriesDosesPositiveInt != *other.SeriesDosesPositiveInt {
+		return false
+	}
+	if (r.SeriesDosesPositiveIntElement == nil) != (other.SeriesDosesPositiveIntElement == nil) || r.SeriesDosesPositiveIntElement != nil && !r.SeriesDosesPositiveIntElement.Equal(*other.SeriesDosesPositiveIntElement) {
+		return false
+	}
+	if (r.SeriesDosesString == nil) != (other.SeriesDosesString == nil) || r.SeriesDosesString != nil && *r.SeriesDosesString != *other.SeriesDosesString {
+		return false
+	}
+	if (r.SeriesDosesStringElement == nil) != (other.SeriesDosesStringElement == nil) || r.SeriesDosesStringElement != nil && !r.SeriesDosesStringElement.Equal(*other.SeriesDosesStringElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImmunizationEvaluation) EqualsDeep(other ImmunizationEvaluation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !r.Authority.EqualsDeep(*other.Authority) {
+		return false
+	}
+	if !r.TargetDisease.EqualsDeep(other.TargetDisease) {
+		return false
+	}
+	if !r.ImmunizationEvent.EqualsDeep(other.ImmunizationEvent) {
+		return false
+	}
+	if !r.DoseStatus.EqualsDeep(other.DoseStatus) {
+		return false
+	}
+	if !equivalentList(len(r.DoseStatusReason), len(other.DoseStatusReason), func(i, j int) bool {
+		return r.DoseStatusReason[i].EqualsDeep(other.DoseStatusReason[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Series == nil) != (other.Series == nil) || r.Series != nil && !equivalentString(*r.Series, *other.Series) {
+		return false
+	}
+	if (r.SeriesElement == nil) != (other.SeriesElement == nil) || r.SeriesElement != nil && !r.SeriesElement.EqualsDeep(*other.SeriesElement) {
+		return false
+	}
+	if (r.DoseNumberPositiveInt == nil) != (other.DoseNumberPositiveInt == nil) || r.DoseNumberPositiveInt != nil && *r.DoseNumberPositiveInt != *other.DoseNumberPositiveInt {
+		return false
+	}
+	if (r.DoseNumberPositiveIntElement == nil) != (other.DoseNumberPositiveIntElement == nil) || r.DoseNumberPositiveIntElement != nil && !r.DoseNumberPositiveIntElement.EqualsDeep(*other.DoseNumberPositiveIntElement) {
+		return false
+	}
+	if (r.DoseNumberString == nil) != (other.DoseNumberString == nil) || r.DoseNumberString != nil && !equivalentString(*r.DoseNumberString, *other.DoseNumberString) {
+		return false
+	}
+	if (r.DoseNumberStringElement == nil) != (other.DoseNumberStringElement == nil) || r.DoseNumberStringElement != nil && !r.DoseNumberStringElement.EqualsDeep(*other.DoseNumberStringElement) {
+		return false
+	}
+	if (r.SeriesDosesPositiveInt == nil) != (other.SeriesDosesPositiveInt == nil) || r.SeriesDosesPositiveInt != nil && *r.SeriesDosesPositiveInt != *other.SeriesDosesPositiveInt {
+		return false
+	}
+	if (r.SeriesDosesPositiveIntElement == nil) != (other.SeriesDosesPositiveIntElement == nil) || r.SeriesDosesPositiveIntElement != nil && !r.SeriesDosesPositiveIntElement.EqualsDeep(*other.SeriesDosesPositiveIntElement) {
+		return false
+	}
+	if (r.SeriesDosesString == nil) != (other.SeriesDosesString == nil) || r.SeriesDosesString != nil && !equivalentString(*r.SeriesDosesString, *other.SeriesDosesString) {
+		return false
+	}
+	if (r.SeriesDosesStringElement == nil) != (other.SeriesDosesStringElement == nil) || r.SeriesDosesStringElement != nil && !r.SeriesDosesStringElement.EqualsDeep(*other.SeriesDosesStringElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImmunizationEvaluation) EqualsShallow(other ImmunizationEvaluation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Series == nil) != (other.Series == nil) || r.Series != nil && !equivalentString(*r.Series, *other.Series) {
+		return false
+	}
+	if (r.DoseNumberPositiveInt == nil) != (other.DoseNumberPositiveInt == nil) || r.DoseNumberPositiveInt != nil && *r.DoseNumberPositiveInt != *other.DoseNumberPositiveInt {
+		return false
+	}
+	if (r.DoseNumberString == nil) != (other.DoseNumberString == nil) || r.DoseNumberString != nil && !equivalentString(*r.DoseNumberString, *other.DoseNumberString) {
+		return false
+	}
+	if (r.SeriesDosesPositiveInt == nil) != (other.SeriesDosesPositiveInt == nil) || r.SeriesDosesPositiveInt != nil && *r.SeriesDosesPositiveInt != *other.SeriesDosesPositiveInt {
+		return false
+	}
+	if (r.SeriesDosesString == nil) != (other.SeriesDosesString == nil) || r.SeriesDosesString != nil && !equivalentString(*r.SeriesDosesString, *other.SeriesDosesString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImmunizationEvaluation as FHIR XML
+func (r ImmunizationEvaluation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ImmunizationEvaluation",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "authority", r.Authority); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "targetDisease", r.TargetDisease); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "immunizationEvent", r.ImmunizationEvent); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "doseStatus", r.DoseStatus); err != nil {
+		return err
+	}
+	for _, v := range r.DoseStatusReason {
+		if err := encodeXMLElement(e, "doseStatusReason", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "series", r.Series, r.SeriesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doseNumberPositiveInt", r.DoseNumberPositiveInt, r.DoseNumberPositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doseNumberString", r.DoseNumberString, r.DoseNumberStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "seriesDosesPositiveInt", r.SeriesDosesPositiveInt, r.SeriesDosesPositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "seriesDosesString", r.SeriesDosesString, r.SeriesDosesStringElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImmunizationEvaluation from FHIR XML
+func (r *ImmunizationEvaluation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v ImmunizationEvaluationStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "authority":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Authority = &v
+			case "targetDisease":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TargetDisease = v
+			case "immunizationEvent":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ImmunizationEvent = v
+			case "doseStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DoseStatus = v
+			case "doseStatusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DoseStatusReason = append(r.DoseStatusReason, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "series":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Series = &v
+				}
+				r.SeriesElement = element
+			case "doseNumberPositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoseNumberPositiveInt = &v
+				}
+				r.DoseNumberPositiveIntElement = element
+			case "doseNumberString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoseNumberString = &v
+				}
+				r.DoseNumberStringElement = element
+			case "seriesDosesPositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SeriesDosesPositiveInt = &v
+				}
+				r.SeriesDosesPositiveIntElement = element
+			case "seriesDosesString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SeriesDosesString = &v
+				}
+				r.SeriesDosesStringElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImmunizationEvaluation as properties to the RDF node n
+func (r ImmunizationEvaluation) turtle(n *turtleNode) {
+	n.resource("ImmunizationEvaluation", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ImmunizationEvaluation.identifier", i, v)
+	}
+	n.primitive("ImmunizationEvaluation.status", -1, r.Status, r.StatusElement, "code")
+	n.element("ImmunizationEvaluation.patient", -1, r.Patient)
+	n.primitive("ImmunizationEvaluation.date", -1, r.Date, r.DateElement, "dateTime")
+	n.element("ImmunizationEvaluation.authority", -1, r.Authority)
+	n.element("ImmunizationEvaluation.targetDisease", -1, r.TargetDisease)
+	n.element("ImmunizationEvaluation.immunizationEvent", -1, r.ImmunizationEvent)
+	n.element("ImmunizationEvaluation.doseStatus", -1, r.DoseStatus)
+	for i, v := range r.DoseStatusReason {
+		n.element("ImmunizationEvaluation.doseStatusReason", i, v)
+	}
+	n.primitive("ImmunizationEvaluation.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("ImmunizationEvaluation.series", -1, r.Series, r.SeriesElement, "string")
+	n.primitive("ImmunizationEvaluation.doseNumberPositiveInt", -1, r.DoseNumberPositiveInt, r.DoseNumberPositiveIntElement, "positiveInt")
+	n.primitive("ImmunizationEvaluation.doseNumberString", -1, r.DoseNumberString, r.DoseNumberStringElement, "string")
+	n.primitive("ImmunizationEvaluation.seriesDosesPositiveInt", -1, r.SeriesDosesPositiveInt, r.SeriesDosesPositiveIntElement, "positiveInt")
+	n.primitive("ImmunizationEvaluation.seriesDosesString", -1, r.SeriesDosesString, r.SeriesDosesStringElement, "string")
+}
+
+// MarshalBSON marshals the given ImmunizationEvaluation as BSON document with the structure of its FHIR JSON
+func (r ImmunizationEvaluation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImmunizationEvaluation from a BSON document with the structure of its FHIR JSON
+func (r *ImmunizationEvaluation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImmunizationEvaluation as protobuf message ImmunizationEvaluation of fhir.proto
+func (r ImmunizationEvaluation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImmunizationEvaluation to the encoded message
+func (r ImmunizationEvaluation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	e.message(11, r.Patient)
+	if r.Date != nil {
+		e.string(12, *r.Date)
+	}
+	if r.Authority != nil {
+		e.message(13, *r.Authority)
+	}
+	e.message(14, r.TargetDisease)
+	e.message(15, r.ImmunizationEvent)
+	e.message(16, r.DoseStatus)
+	for _, v := range r.DoseStatusReason {
+		e.message(17, v)
+	}
+	if r.Description != nil {
+		e.string(18, *r.Description)
+	}
+	if r.Series != nil {
+		e.string(19, *r.Series)
+	}
+	if r.DoseNumberPositiveInt != nil {
+		e.varint(20, uint64(int64(*r.DoseNumberPositiveInt)))
+	}
+	if r.DoseNumberString != nil {
+		e.string(21, *r.DoseNumberString)
+	}
+	if r.SeriesDosesPositiveInt != nil {
+		e.varint(22, uint64(int64(*r.SeriesDosesPositiveInt)))
+	}
+	if r.SeriesDosesString != nil {
+		e.string(23, *r.SeriesDosesString)
+	}
+	if r.IdElement != nil {
+		e.message(24, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(25, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(26, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(27, *r.StatusElement)
+	}
+	if r.DateElement != nil {
+		e.message(28, *r.DateElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(29, *r.DescriptionElement)
+	}
+	if r.SeriesElement != nil {
+		e.message(30, *r.SeriesElement)
+	}
+	if r.DoseNumberPositiveIntElement != nil {
+		e.message(31, *r.DoseNumberPositiveIntElement)
+	}
+	if r.DoseNumberStringElement != nil {
+		e.message(32, *r.DoseNumberStringElement)
+	}
+	if r.SeriesDosesPositiveIntElement != nil {
+		e.message(33, *r.SeriesDosesPositiveIntElement)
+	}
+	if r.SeriesDosesStringElement != nil {
+		e.message(34, *r.SeriesDosesStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImmunizationEvaluation from protobuf message ImmunizationEvaluation of fhir.proto
+func (r *ImmunizationEvaluation) UnmarshalProto(b []byte) error {
+	*r = ImmunizationEvaluation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v ImmunizationEvaluationStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 12:
+			v := d.string()
+			r.Date = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Authority = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.TargetDisease = v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.ImmunizationEvent = v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.DoseStatus = v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.DoseStatusReason = append(r.DoseStatusReason, v)
+		case 18:
+			v := d.string()
+			r.Description = &v
+		case 19:
+			v := d.string()
+			r.Series = &v
+		case 20:
+			v := d.int()
+			r.DoseNumberPositiveInt = &v
+		case 21:
+			v := d.string()
+			r.DoseNumberString = &v
+		case 22:
+			v := d.int()
+			r.SeriesDosesPositiveInt = &v
+		case 23:
+			v := d.string()
+			r.SeriesDosesString = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.SeriesElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.DoseNumberPositiveIntElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.DoseNumberStringElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.SeriesDosesPositiveIntElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.SeriesDosesStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalImmunizationEvaluation unmarshals a ImmunizationEvaluation.
 func UnmarshalImmunizationEvaluation(b []byte) (ImmunizationEvaluation, error) {
 	var immunizationEvaluation ImmunizationEvaluation
-	if err := json.Unmarshal(b, &immunizationEvaluation); err != nil {
+	if err := immunizationEvaluation.UnmarshalJSON(b); err != nil {
 		return immunizationEvaluation, err
 	}
 	return immunizationEvaluation, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code ImmunizationEvaluationStatusCodes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ImmunizationEvaluationStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ImmunizationEvaluationStatusCodes) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "in-progress":
 		*code = ImmunizationEvaluationStatusCodesInProgress
@@ -61,6 +65,12 @@ func (code *ImmunizationEvaluationStatusCodes) UnmarshalJSON(json []byte) error
 	}
 	return nil
 }
+func (code ImmunizationEvaluationStatusCodes) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ImmunizationEvaluationStatusCodes) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ImmunizationEvaluationStatusCodes) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code ImmunizationEvaluationStatusCodes) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ImmunizationEvaluationStatusCodes) protoNumber() uint64 {
+	switch code {
+	case ImmunizationEvaluationStatusCodesInProgress:
+		return 1
+	case ImmunizationEvaluationStatusCodesNotDone:
+		return 2
+	case ImmunizationEvaluationStatusCodesOnHold:
+		return 3
+	case ImmunizationEvaluationStatusCodesCompleted:
+		return 4
+	case ImmunizationEvaluationStatusCodesEnteredInError:
+		return 5
+	case ImmunizationEvaluationStatusCodesStopped:
+		return 6
+	case ImmunizationEvaluationStatusCodesUnknown:
+		return 7
+	}
+	return 0
+}
+func (code *ImmunizationEvaluationStatusCodes) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ImmunizationEvaluationStatusCodesInProgress
+	case 2:
+		*code = ImmunizationEvaluationStatusCodesNotDone
+	case 3:
+		*code = ImmunizationEvaluationStatusCodesOnHold
+	case 4:
+		*code = ImmunizationEvaluationStatusCodesCompleted
+	case 5:
+		*code = ImmunizationEvaluationStatusCodesEnteredInError
+	case 6:
+		*code = ImmunizationEvaluationStatusCodesStopped
+	case 7:
+		*code = ImmunizationEvaluationStatusCodesUnknown
+	default:
+		return false
+	}
+	return true
+}
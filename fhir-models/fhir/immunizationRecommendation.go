@@ -14,44 +14,58 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ImmunizationRecommendation is documented here http://hl7.org/fhir/StructureDefinition/ImmunizationRecommendation
 type ImmunizationRecommendation struct {
-	Id                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Patient           Reference                                  `bson:"patient" json:"patient"`
-	Date              string                                     `bson:"date" json:"date"`
-	Authority         *Reference                                 `bson:"authority,omitempty" json:"authority,omitempty"`
-	Recommendation    []ImmunizationRecommendationRecommendation `bson:"recommendation" json:"recommendation"`
+	Id                   *string                                    `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                                   `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage                          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Patient              Reference                                  `bson:"patient" json:"patient"`
+	Date                 string                                     `bson:"date" json:"date"`
+	DateElement          *Element                                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Authority            *Reference                                 `bson:"authority,omitempty" json:"authority,omitempty"`
+	Recommendation       []ImmunizationRecommendationRecommendation `bson:"recommendation" json:"recommendation"`
 }
 type ImmunizationRecommendationRecommendation struct {
-	Id                           *string                                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                    []Extension                                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension            []Extension                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	VaccineCode                  []CodeableConcept                                       `bson:"vaccineCode,omitempty" json:"vaccineCode,omitempty"`
-	TargetDisease                *CodeableConcept                                        `bson:"targetDisease,omitempty" json:"targetDisease,omitempty"`
-	ContraindicatedVaccineCode   []CodeableConcept                                       `bson:"contraindicatedVaccineCode,omitempty" json:"contraindicatedVaccineCode,omitempty"`
-	ForecastStatus               CodeableConcept                                         `bson:"forecastStatus" json:"forecastStatus"`
-	ForecastReason               []CodeableConcept                                       `bson:"forecastReason,omitempty" json:"forecastReason,omitempty"`
-	DateCriterion                []ImmunizationRecommendationRecommendationDateCriterion `bson:"dateCriterion,omitempty" json:"dateCriterion,omitempty"`
-	Description                  *string                                                 `bson:"description,omitempty" json:"description,omitempty"`
-	Series                       *string                                                 `bson:"series,omitempty" json:"series,omitempty"`
-	DoseNumberPositiveInt        *int                                                    `bson:"doseNumberPositiveInt,omitempty" json:"doseNumberPositiveInt,omitempty"`
-	DoseNumberString             *string                                                 `bson:"doseNumberString,omitempty" json:"doseNumberString,omitempty"`
-	SeriesDosesPositiveInt       *int                                                    `bson:"seriesDosesPositiveInt,omitempty" json:"seriesDosesPositiveInt,omitempty"`
-	SeriesDosesString            *string                                                 `bson:"seriesDosesString,omitempty" json:"seriesDosesString,omitempty"`
-	SupportingImmunization       []Reference                                             `bson:"supportingImmunization,omitempty" json:"supportingImmunization,omitempty"`
-	SupportingPatientInformation []Reference                                             `bson:"supportingPatientInformation,omitempty" json:"supportingPatientInformation,omitempty"`
+	Id                            *string                                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension                                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	VaccineCode                   []CodeableConcept                                       `bson:"vaccineCode,omitempty" json:"vaccineCode,omitempty"`
+	TargetDisease                 *CodeableConcept                                        `bson:"targetDisease,omitempty" json:"targetDisease,omitempty"`
+	ContraindicatedVaccineCode    []CodeableConcept                                       `bson:"contraindicatedVaccineCode,omitempty" json:"contraindicatedVaccineCode,omitempty"`
+	ForecastStatus                CodeableConcept                                         `bson:"forecastStatus" json:"forecastStatus"`
+	ForecastReason                []CodeableConcept                                       `bson:"forecastReason,omitempty" json:"forecastReason,omitempty"`
+	DateCriterion                 []ImmunizationRecommendationRecommendationDateCriterion `bson:"dateCriterion,omitempty" json:"dateCriterion,omitempty"`
+	Description                   *string                                                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement            *Element                                                `bson:"_description,omitempty" json:"_description,omitempty"`
+	Series                        *string                                                 `bson:"series,omitempty" json:"series,omitempty"`
+	SeriesElement                 *Element                                                `bson:"_series,omitempty" json:"_series,omitempty"`
+	DoseNumberPositiveInt         *int                                                    `bson:"doseNumberPositiveInt,omitempty" json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement  *Element                                                `bson:"_doseNumberPositiveInt,omitempty" json:"_doseNumberPositiveInt,omitempty"`
+	DoseNumberString              *string                                                 `bson:"doseNumberString,omitempty" json:"doseNumberString,omitempty"`
+	DoseNumberStringElement       *Element                                                `bson:"_doseNumberString,omitempty" json:"_doseNumberString,omitempty"`
+	SeriesDosesPositiveInt        *int                                                    `bson:"seriesDosesPositiveInt,omitempty" json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *Element                                                `bson:"_seriesDosesPositiveInt,omitempty" json:"_seriesDosesPositiveInt,omitempty"`
+	SeriesDosesString             *string                                                 `bson:"seriesDosesString,omitempty" json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement      *Element                                                `bson:"_seriesDosesString,omitempty" json:"_seriesDosesString,omitempty"`
+	SupportingImmunization        []Reference                                             `bson:"supportingImmunization,omitempty" json:"supportingImmunization,omitempty"`
+	SupportingPatientInformation  []Reference                                             `bson:"supportingPatientInformation,omitempty" json:"supportingPatientInformation,omitempty"`
 }
 type ImmunizationRecommendationRecommendationDateCriterion struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
@@ -59,24 +73,2385 @@ type ImmunizationRecommendationRecommendationDateCriterion struct {
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              CodeableConcept `bson:"code" json:"code"`
 	Value             string          `bson:"value" json:"value"`
+	ValueElement      *Element        `bson:"_value,omitempty" json:"_value,omitempty"`
+}
+
+// ImmunizationRecommendationRecommendationDoseNumber is implemented by the types allowed for ImmunizationRecommendation.recommendation.doseNumber[x]
+type ImmunizationRecommendationRecommendationDoseNumber interface {
+	isImmunizationRecommendationRecommendationDoseNumber()
+}
+
+func (PositiveInt) isImmunizationRecommendationRecommendationDoseNumber() {}
+func (String) isImmunizationRecommendationRecommendationDoseNumber()      {}
+
+// DoseNumber returns the value of ImmunizationRecommendation.recommendation.doseNumber[x] together with its FHIR type code or nil if no value is set
+func (r ImmunizationRecommendationRecommendation) DoseNumber() (ImmunizationRecommendationRecommendationDoseNumber, string) {
+	if r.DoseNumberPositiveInt != nil {
+		return PositiveInt(*r.DoseNumberPositiveInt), "positiveInt"
+	}
+	if r.DoseNumberString != nil {
+		return String(*r.DoseNumberString), "string"
+	}
+	return nil, ""
+}
+
+// SetDoseNumber sets the value of ImmunizationRecommendation.recommendation.doseNumber[x], given as value or pointer, and clears all other types
+func (r *ImmunizationRecommendationRecommendation) SetDoseNumber(value ImmunizationRecommendationRecommendationDoseNumber) {
+	r.DoseNumberPositiveInt = nil
+	r.DoseNumberString = nil
+	switch v := value.(type) {
+	case PositiveInt:
+		primitive := int(v)
+		r.DoseNumberPositiveInt = &primitive
+	case *PositiveInt:
+		r.DoseNumberPositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.DoseNumberString = &primitive
+	case *String:
+		r.DoseNumberString = (*string)(v)
+	}
+}
+
+// ImmunizationRecommendationRecommendationSeriesDoses is implemented by the types allowed for ImmunizationRecommendation.recommendation.seriesDoses[x]
+type ImmunizationRecommendationRecommendationSeriesDoses interface {
+	isImmunizationRecommendationRecommendationSeriesDoses()
+}
+
+func (PositiveInt) isImmunizationRecommendationRecommendationSeriesDoses() {}
+func (String) isImmunizationRecommendationRecommendationSeriesDoses()      {}
+
+// SeriesDoses returns the value of ImmunizationRecommendation.recommendation.seriesDoses[x] together with its FHIR type code or nil if no value is set
+func (r ImmunizationRecommendationRecommendation) SeriesDoses() (ImmunizationRecommendationRecommendationSeriesDoses, string) {
+	if r.SeriesDosesPositiveInt != nil {
+		return PositiveInt(*r.SeriesDosesPositiveInt), "positiveInt"
+	}
+	if r.SeriesDosesString != nil {
+		return String(*r.SeriesDosesString), "string"
+	}
+	return nil, ""
+}
+
+// SetSeriesDoses sets the value of ImmunizationRecommendation.recommendation.seriesDoses[x], given as value or pointer, and clears all other types
+func (r *ImmunizationRecommendationRecommendation) SetSeriesDoses(value ImmunizationRecommendationRecommendationSeriesDoses) {
+	r.SeriesDosesPositiveInt = nil
+	r.SeriesDosesString = nil
+	switch v := value.(type) {
+	case PositiveInt:
+		primitive := int(v)
+		r.SeriesDosesPositiveInt = &primitive
+	case *PositiveInt:
+		r.SeriesDosesPositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.SeriesDosesString = &primitive
+	case *String:
+		r.SeriesDosesString = (*string)(v)
+	}
 }
-type OtherImmunizationRecommendation ImmunizationRecommendation
 
 // MarshalJSON marshals the given ImmunizationRecommendation as JSON into a byte slice
 func (r ImmunizationRecommendation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherImmunizationRecommendation
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherImmunizationRecommendation: OtherImmunizationRecommendation(r),
-		ResourceType:                    "ImmunizationRecommendation",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImmunizationRecommendation as JSON object with the members in the order of the fields
+func (r ImmunizationRecommendation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ImmunizationRecommendation\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	e.key("date")
+	e.string(r.Date)
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Authority != nil {
+		e.key("authority")
+		r.Authority.appendJSON(e)
+	}
+	e.key("recommendation")
+	if r.Recommendation == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Recommendation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImmunizationRecommendation from JSON. Unknown members are ignored.
+func (r *ImmunizationRecommendation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImmunizationRecommendation
+func (r *ImmunizationRecommendation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = v
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "authority":
+			if d.null() {
+				r.Authority = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Authority = &v
+			}
+		case "recommendation":
+			a := d.array()
+			r.Recommendation = nil
+			for a.next() {
+				var v ImmunizationRecommendationRecommendation
+				v.decodeJSON(d)
+				r.Recommendation = append(r.Recommendation, v)
+			}
+			if r.Recommendation == nil && !a.isNull {
+				r.Recommendation = []ImmunizationRecommendationRecommendation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImmunizationRecommendation which shares no memory with the original
+func (r ImmunizationRecommendation) DeepCopy() ImmunizationRecommendation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Authority != nil {
+		v := r.Authority.DeepCopy()
+		out.Authority = &v
+	}
+	if r.Recommendation != nil {
+		out.Recommendation = make([]ImmunizationRecommendationRecommendation, len(r.Recommendation))
+		for i := range r.Recommendation {
+			out.Recommendation[i] = r.Recommendation[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImmunizationRecommendation) Equal(other ImmunizationRecommendation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if r.Date != other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !r.Authority.Equal(*other.Authority) {
+		return false
+	}
+	if len(r.Recommendation) != len(other.Recommendation) {
+		return false
+	}
+	for i := range r.Recommendation {
+		if !r.Recommendation[i].Equal(other.Recommendation[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImmunizationRecommendation) EqualsDeep(other ImmunizationRecommendation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !r.Authority.EqualsDeep(*other.Authority) {
+		return false
+	}
+	if !equivalentList(len(r.Recommendation), len(other.Recommendation), func(i, j int) bool {
+		return r.Recommendation[i].EqualsDeep(other.Recommendation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImmunizationRecommendation) EqualsShallow(other ImmunizationRecommendation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImmunizationRecommendation as FHIR XML
+func (r ImmunizationRecommendation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ImmunizationRecommendation",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "authority", r.Authority); err != nil {
+		return err
+	}
+	for _, v := range r.Recommendation {
+		if err := encodeXMLElement(e, "recommendation", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImmunizationRecommendation from FHIR XML
+func (r *ImmunizationRecommendation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = v
+				}
+				r.DateElement = element
+			case "authority":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Authority = &v
+			case "recommendation":
+				var v ImmunizationRecommendationRecommendation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recommendation = append(r.Recommendation, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImmunizationRecommendation as properties to the RDF node n
+func (r ImmunizationRecommendation) turtle(n *turtleNode) {
+	n.resource("ImmunizationRecommendation", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ImmunizationRecommendation.identifier", i, v)
+	}
+	n.element("ImmunizationRecommendation.patient", -1, r.Patient)
+	n.primitive("ImmunizationRecommendation.date", -1, r.Date, r.DateElement, "dateTime")
+	n.element("ImmunizationRecommendation.authority", -1, r.Authority)
+	for i, v := range r.Recommendation {
+		n.element("ImmunizationRecommendation.recommendation", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ImmunizationRecommendation as BSON document with the structure of its FHIR JSON
+func (r ImmunizationRecommendation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImmunizationRecommendation from a BSON document with the structure of its FHIR JSON
+func (r *ImmunizationRecommendation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImmunizationRecommendation as protobuf message ImmunizationRecommendation of fhir.proto
+func (r ImmunizationRecommendation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImmunizationRecommendation to the encoded message
+func (r ImmunizationRecommendation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.message(10, r.Patient)
+	if r.Date != "" {
+		e.string(11, r.Date)
+	}
+	if r.Authority != nil {
+		e.message(12, *r.Authority)
+	}
+	for _, v := range r.Recommendation {
+		e.message(13, v)
+	}
+	if r.IdElement != nil {
+		e.message(14, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(15, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(16, *r.LanguageElement)
+	}
+	if r.DateElement != nil {
+		e.message(17, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImmunizationRecommendation from protobuf message ImmunizationRecommendation of fhir.proto
+func (r *ImmunizationRecommendation) UnmarshalProto(b []byte) error {
+	*r = ImmunizationRecommendation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 11:
+			r.Date = d.string()
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Authority = &v
+		case 13:
+			var v ImmunizationRecommendationRecommendation
+			d.message(&v)
+			r.Recommendation = append(r.Recommendation, v)
+		case 14:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImmunizationRecommendationRecommendation as JSON into a byte slice
+func (r ImmunizationRecommendationRecommendation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImmunizationRecommendationRecommendation as JSON object with the members in the order of the fields
+func (r ImmunizationRecommendationRecommendation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.VaccineCode) > 0 {
+		e.key("vaccineCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.VaccineCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.TargetDisease != nil {
+		e.key("targetDisease")
+		r.TargetDisease.appendJSON(e)
+	}
+	if len(r.ContraindicatedVaccineCode) > 0 {
+		e.key("contraindicatedVaccineCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ContraindicatedVaccineCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("forecastStatus")
+	r.ForecastStatus.appendJSON(e)
+	if len(r.ForecastReason) > 0 {
+		e.key("forecastReason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ForecastReason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DateCriterion) > 0 {
+		e.key("dateCriterion")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DateCriterion {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Series != nil {
+		e.key("series")
+		e.string(*r.Series)
+	}
+	if r.SeriesElement != nil {
+		e.key("_series")
+		r.SeriesElement.appendJSON(e)
+	}
+	if r.DoseNumberPositiveInt != nil {
+		e.key("doseNumberPositiveInt")
+		e.int(*r.DoseNumberPositiveInt)
+	}
+	if r.DoseNumberPositiveIntElement != nil {
+		e.key("_doseNumberPositiveInt")
+		r.DoseNumberPositiveIntElement.appendJSON(e)
+	}
+	if r.DoseNumberString != nil {
+		e.key("doseNumberString")
+		e.string(*r.DoseNumberString)
+	}
+	if r.DoseNumberStringElement != nil {
+		e.key("_doseNumberString")
+		r.DoseNumberStringElement.appendJSON(e)
+	}
+	if r.SeriesDosesPositiveInt != nil {
+		e.key("seriesDosesPositiveInt")
+		e.int(*r.SeriesDosesPositiveInt)
+	}
+	if r.SeriesDosesPositiveIntElement != nil {
+		e.key("_seriesDosesPositiveInt")
+		r.SeriesDosesPositiveIntElement.appendJSON(e)
+	}
+	if r.SeriesDosesString != nil {
+		e.key("seriesDosesString")
+		e.string(*r.SeriesDosesString)
+	}
+	if r.SeriesDosesStringElement != nil {
+		e.key("_seriesDosesString")
+		r.SeriesDosesStringElement.appendJSON(e)
+	}
+	if len(r.SupportingImmunization) > 0 {
+		e.key("supportingImmunization")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingImmunization {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingPatientInformation) > 0 {
+		e.key("supportingPatientInformation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingPatientInformation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImmunizationRecommendationRecommendation from JSON. Unknown members are ignored.
+func (r *ImmunizationRecommendationRecommendation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImmunizationRecommendationRecommendation
+func (r *ImmunizationRecommendationRecommendation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "vaccineCode":
+			a := d.array()
+			r.VaccineCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.VaccineCode = append(r.VaccineCode, v)
+			}
+			if r.VaccineCode == nil && !a.isNull {
+				r.VaccineCode = []CodeableConcept{}
+			}
+		case "targetDisease":
+			if d.null() {
+				r.TargetDisease = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.TargetDisease = &v
+			}
+		case "contraindicatedVaccineCode":
+			a := d.array()
+			r.ContraindicatedVaccineCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ContraindicatedVaccineCode = append(r.ContraindicatedVaccineCode, v)
+			}
+			if r.ContraindicatedVaccineCode == nil && !a.isNull {
+				r.ContraindicatedVaccineCode = []CodeableConcept{}
+			}
+		case "forecastStatus":
+			r.ForecastStatus.decodeJSON(d)
+		case "forecastReason":
+			a := d.array()
+			r.ForecastReason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ForecastReason = append(r.ForecastReason, v)
+			}
+			if r.ForecastReason == nil && !a.isNull {
+				r.ForecastReason = []CodeableConcept{}
+			}
+		case "dateCriterion":
+			a := d.array()
+			r.DateCriterion = nil
+			for a.next() {
+				var v ImmunizationRecommendationRecommendationDateCriterion
+				v.decodeJSON(d)
+				r.DateCriterion = append(r.DateCriterion, v)
+			}
+			if r.DateCriterion == nil && !a.isNull {
+				r.DateCriterion = []ImmunizationRecommendationRecommendationDateCriterion{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "series":
+			if v, ok := d.string(); ok {
+				r.Series = &v
+			} else {
+				r.Series = nil
+			}
+		case "_series":
+			r.SeriesElement = d.element()
+		case "doseNumberPositiveInt":
+			if v, ok := d.int(); ok {
+				r.DoseNumberPositiveInt = &v
+			} else {
+				r.DoseNumberPositiveInt = nil
+			}
+		case "_doseNumberPositiveInt":
+			r.DoseNumberPositiveIntElement = d.element()
+		case "doseNumberString":
+			if v, ok := d.string(); ok {
+				r.DoseNumberString = &v
+			} else {
+				r.DoseNumberString = nil
+			}
+		case "_doseNumberString":
+			r.DoseNumberStringElement = d.element()
+		case "seriesDosesPositiveInt":
+			if v, ok := d.int(); ok {
+				r.SeriesDosesPositiveInt = &v
+			} else {
+				r.SeriesDosesPositiveInt = nil
+			}
+		case "_seriesDosesPositiveInt":
+			r.SeriesDosesPositiveIntElement = d.element()
+		case "seriesDosesString":
+			if v, ok := d.string(); ok {
+				r.SeriesDosesString = &v
+			} else {
+				r.SeriesDosesString = nil
+			}
+		case "_seriesDosesString":
+			r.SeriesDosesStringElement = d.element()
+		case "supportingImmunization":
+			a := d.array()
+			r.SupportingImmunization = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingImmunization = append(r.SupportingImmunization, v)
+			}
+			if r.SupportingImmunization == nil && !a.isNull {
+				r.SupportingImmunization = []Reference{}
+			}
+		case "supportingPatientInformation":
+			a := d.array()
+			r.SupportingPatientInformation = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingPatientInformation = append(r.SupportingPatientInformation, v)
+			}
+			if r.SupportingPatientInformation == nil && !a.isNull {
+				r.SupportingPatientInformation = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImmunizationRecommendationRecommendation which shares no memory with the original
+func (r ImmunizationRecommendationRecommendation) DeepCopy() ImmunizationRecommendationRecommendation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.VaccineCode != nil {
+		out.VaccineCode = make([]CodeableConcept, len(r.VaccineCode))
+		for i := range r.VaccineCode {
+			out.VaccineCode[i] = r.VaccineCode[i].DeepCopy()
+		}
+	}
+	if r.TargetDisease != nil {
+		v := r.TargetDisease.DeepCopy()
+		out.TargetDisease = &v
+	}
+	if r.ContraindicatedVaccineCode != nil {
+		out.ContraindicatedVaccineCode = make([]CodeableConcept, len(r.ContraindicatedVaccineCode))
+		for i := range r.ContraindicatedVaccineCode {
+			out.ContraindicatedVaccineCode[i] = r.ContraindicatedVaccineCode[i].DeepCopy()
+		}
+	}
+	out.ForecastStatus = r.ForecastStatus.DeepCopy()
+	if r.ForecastReason != nil {
+		out.ForecastReason = make([]CodeableConcept, len(r.ForecastReason))
+		for i := range r.ForecastReason {
+			out.ForecastReason[i] = r.ForecastReason[i].DeepCopy()
+		}
+	}
+	if r.DateCriterion != nil {
+		out.DateCriterion = make([]ImmunizationRecommendationRecommendationDateCriterion, len(r.DateCriterion))
+		for i := range r.DateCriterion {
+			out.DateCriterion[i] = r.DateCriterion[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Series != nil {
+		v := *r.Series
+		out.Series = &v
+	}
+	if r.SeriesElement != nil {
+		v := r.SeriesElement.DeepCopy()
+		out.SeriesElement = &v
+	}
+	if r.DoseNumberPositiveInt != nil {
+		v := *r.DoseNumberPositiveInt
+		out.DoseNumberPositiveInt = &v
+	}
+	if r.DoseNumberPositiveIntElement != nil {
+		v := r.DoseNumberPositiveIntElement.DeepCopy()
+		out.DoseNumberPositiveIntElement = &v
+	}
+	if r.DoseNumberString != nil {
+		v := *r.DoseNumberString
+		out.DoseNumberString = &v
+	}
+	if r.DoseNumberStringElement != nil {
+		v := r.DoseNumberStringElement.DeepCopy()
+		out.DoseNumberStringElement = &v
+	}
+	if r.SeriesDosesPositiveInt != nil {
+		v := *r.SeriesDosesPositiveInt
+		out.SeriesDosesPositiveInt = &v
+	}
+	if r.SeriesDosesPositiveIntElement != nil {
+		v := r.SeriesDosesPositiveIntElement.DeepCopy()
+		out.SeriesDosesPositiveIntElement = &v
+	}
+	if r.SeriesDosesString != nil {
+		v := *r.SeriesDosesString
+		out.SeriesDosesString = &v
+	}
+	if r.SeriesDosesStringElement != nil {
+		v := r.SeriesDosesStringElement.DeepCopy()
+		out.SeriesDosesStringElement = &v
+	}
+	if r.SupportingImmunization != nil {
+		out.SupportingImmunization = make([]Reference, len(r.SupportingImmunization))
+		for i := range r.SupportingImmunization {
+			out.SupportingImmunization[i] = r.SupportingImmunization[i].DeepCopy()
+		}
+	}
+	if r.SupportingPatientInformation != nil {
+		out.SupportingPatientInformation = make([]Reference, len(r.SupportingPatientInformation))
+		for i := range r.SupportingPatientInformation {
+			out.SupportingPatientInformation[i] = r.SupportingPatientInformation[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImmunizationRecommendationRecommendation) Equal(other ImmunizationRecommendationRecommendation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.VaccineCode) != len(other.VaccineCode) {
+		return false
+	}
+	for i := range r.VaccineCode {
+		if !r.VaccineCode[i].Equal(other.VaccineCode[i]) {
+			return false
+		}
+	}
+	if (r.TargetDisease == nil) != (other.TargetDisease == nil) || r.TargetDisease != nil && !r.TargetDisease.Equal(*other.TargetDisease) {
+		return false
+	}
+	if len(r.ContraindicatedVaccineCode) != len(other.ContraindicatedVaccineCode) {
+		return false
+	}
+	for i := range r.ContraindicatedVaccineCode {
+		if !r.ContraindicatedVaccineCode[i].Equal(other.ContraindicatedVaccineCode[i]) {
+			return false
+		}
+	}
+	if !r.ForecastStatus.Equal(other.ForecastStatus) {
+		return false
+	}
+	if len(r.ForecastReason) != len(other.ForecastReason) {
+		return false
+	}
+	for i := range r.ForecastReason {
+		if !r.ForecastReason[i].Equal(other.ForecastReason[i]) {
+			return false
+		}
+	}
+	if len(r.DateCriterion) != len(other.DateCriterion) {
+		return false
+	}
+	for i := range r.DateCriterion {
+		if !r.DateCriterion[i].Equal(other.DateCriterion[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Series == nil) != (other.Series == nil) || r.Series != nil && *r.Series != *other.Series {
+		return false
+	}
+	if (r.SeriesElement == nil) != (other.SeriesElement == nil) || r.SeriesElement != nil && !r.SeriesElement.Equal(*other.SeriesElement) {
+		return false
+	}
+	if (r.DoseNumberPositiveInt == nil) != (other.DoseNumberPositiveInt == nil) || r.DoseNumberPositiveInt != nil && *r.DoseNumberPositiveInt != *other.DoseNumberPositiveInt {
+		return false
+	}
+	if (r.DoseNumberPositiveIntElement == nil) != (other.DoseNumberPositiveIntElement == nil) || r.DoseNumberPositiveIntElement != nil && !r.DoseNumberPositiveIntElement.Equal(*other.DoseNumberPositiveIntElement) {
+		return false
+	}
+	if (r.DoseNumberString == nil) != (other.DoseNumberString == nil) || r.DoseNumberString != nil && *r.DoseNumberString != *other.DoseNumberString {
+		return false
+	}
+	if (r.DoseNumberStringElement == nil) != (other.DoseNumberStringElement == nil) || r.DoseNumberStringElement != nil && !r.DoseNumberStringElement.Equal(*other.DoseNumberStringElement) {
+		return false
+	}
+	if (r.SeriesDosesPositiveInt == nil) != (other.SeriesDosesPositiveInt == nil) || r.SeriesDosesPositiveInt != nil && *r.SeriesDosesPositiveInt != *other.SeriesDosesPositiveInt {
+		return false
+	}
+	if (r.SeriesDosesPositiveIntElement == nil) != (other.SeriesDosesPositiveIntElement == nil) || r.SeriesDosesPositiveIntElement != nil && !r.SeriesDosesPositiveIntElement.Equal(*other.SeriesDosesPositiveIntElement) {
+		return false
+	}
+	if (r.SeriesDosesString == nil) != (other.SeriesDosesString == nil) || r.SeriesDosesString != nil && *r.SeriesDosesString != *other.SeriesDosesString {
+		return false
+	}
+	if (r.SeriesDosesStringElement == nil) != (other.SeriesDosesStringElement == nil) || r.SeriesDosesStringElement != nil && !r.SeriesDosesStringElement.Equal(*other.SeriesDosesStringElement) {
+		return false
+	}
+	if len(r.SupportingImmunization) != len(other.SupportingImmunization) {
+		return false
+	}
+	for i := range r.SupportingImmunization {
+		if !r.SupportingImmunization[i].Equal(other.SupportingImmunization[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingPatientInformation) != len(other.SupportingPatientInformation) {
+		return false
+	}
+	for i := range r.SupportingPatientInformation {
+		if !r.SupportingPatientInformation[i].Equal(other.SupportingPatientInformation[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImmunizationRecommendationRecommendation) EqualsDeep(other ImmunizationRecommendationRecommendation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.VaccineCode), len(other.VaccineCode), func(i, j int) bool {
+		return r.VaccineCode[i].EqualsDeep(other.VaccineCode[j])
+	}) {
+		return false
+	}
+	if (r.TargetDisease == nil) != (other.TargetDisease == nil) || r.TargetDisease != nil && !r.TargetDisease.EqualsDeep(*other.TargetDisease) {
+		return false
+	}
+	if !equivalentList(len(r.ContraindicatedVaccineCode), len(other.ContraindicatedVaccineCode), func(i, j int) bool {
+		return r.ContraindicatedVaccineCode[i].EqualsDeep(other.ContraindicatedVaccineCode[j])
+	}) {
+		return false
+	}
+	if !r.ForecastStatus.EqualsDeep(other.ForecastStatus) {
+		return false
+	}
+	if !equivalentList(len(r.ForecastReason), len(other.ForecastReason), func(i, j int) bool {
+		return r.ForecastReason[i].EqualsDeep(other.ForecastReason[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DateCriterion), len(other.DateCriterion), func(i, j int) bool {
+		return r.DateCriterion[i].EqualsDeep(other.DateCriterion[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Series == nil) != (other.Series == nil) || r.Series != nil && !equivalentString(*r.Series, *other.Series) {
+		return false
+	}
+	if (r.SeriesElement == nil) != (other.SeriesElement == nil) || r.SeriesElement != nil && !r.SeriesElement.EqualsDeep(*other.SeriesElement) {
+		return false
+	}
+	if (r.DoseNumberPositiveInt == nil) != (other.DoseNumberPositiveInt == nil) || r.DoseNumberPositiveInt != nil && *r.DoseNumberPositiveInt != *other.DoseNumberPositiveInt {
+		return false
+	}
+	if (r.DoseNumberPositiveIntElement == nil) != (other.DoseNumberPositiveIntElement == nil) || r.DoseNumberPositiveIntElement != nil && !r.DoseNumberPositiveIntElement.EqualsDeep(*other.DoseNumberPositiveIntElement) {
+		return false
+	}
+	if (r.DoseNumberString == nil) != (other.DoseNumberString == nil) || r.DoseNumberString != nil && !equivalentString(*r.DoseNumberString, *other.DoseNumberString) {
+		return false
+	}
+	if (r.DoseNumberStringElement == nil) != (other.DoseNumberStringElement == nil) || r.DoseNumberStringElement != nil && !r.DoseNumberStringElement.EqualsDeep(*other.DoseNumberStringElement) {
+		return false
+	}
+	if (r.SeriesDosesPositiveInt == nil) != (other.SeriesDosesPositiveInt == nil) || r.SeriesDosesPositiveInt != nil && *r.SeriesDosesPositiveInt != *other.SeriesDosesPositiveInt {
+		return false
+	}
+	if (r.SeriesDosesPositiveIntElement == nil) != (other.SeriesDosesPositiveIntElement == nil) || r.SeriesDosesPositiveIntElement != nil && !r.SeriesDosesPositiveIntElement.EqualsDeep(*other.SeriesDosesPositiveIntElement) {
+		return false
+	}
+	if (r.SeriesDosesString == nil) != (other.SeriesDosesString == nil) || r.SeriesDosesString != nil && !equivalentString(*r.SeriesDosesString, *other.SeriesDosesString) {
+		return false
+	}
+	if (r.SeriesDosesStringElement == nil) != (other.SeriesDosesStringElement == nil) || r.SeriesDosesStringElement != nil && !r.SeriesDosesStringElement.EqualsDeep(*other.SeriesDosesStringElement) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingImmunization), len(other.SupportingImmunization), func(i, j int) bool {
+		return r.SupportingImmunization[i].EqualsDeep(other.SupportingImmunization[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingPatientInformation), len(other.SupportingPatientInformation), func(i, j int) bool {
+		return r.SupportingPatientInformation[i].EqualsDeep(other.SupportingPatientInformation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImmunizationRecommendationRecommendation) EqualsShallow(other ImmunizationRecommendationRecommendation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Series == nil) != (other.Series == nil) || r.Series != nil && !equivalentString(*r.Series, *other.Series) {
+		return false
+	}
+	if (r.DoseNumberPositiveInt == nil) != (other.DoseNumberPositiveInt == nil) || r.DoseNumberPositiveInt != nil && *r.DoseNumberPositiveInt != *other.DoseNumberPositiveInt {
+		return false
+	}
+	if (r.DoseNumberString == nil) != (other.DoseNumberString == nil) || r.DoseNumberString != nil && !equivalentString(*r.DoseNumberString, *other.DoseNumberString) {
+		return false
+	}
+	if (r.SeriesDosesPositiveInt == nil) != (other.SeriesDosesPositiveInt == nil) || r.SeriesDosesPositiveInt != nil && *r.SeriesDosesPositiveInt != *other.SeriesDosesPositiveInt {
+		return false
+	}
+	if (r.SeriesDosesString == nil) != (other.SeriesDosesString == nil) || r.SeriesDosesString != nil && !equivalentString(*r.SeriesDosesString, *other.SeriesDosesString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImmunizationRecommendationRecommendation as FHIR XML
+func (r ImmunizationRecommendationRecommendation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.VaccineCode {
+		if err := encodeXMLElement(e, "vaccineCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "targetDisease", r.TargetDisease); err != nil {
+		return err
+	}
+	for _, v := range r.ContraindicatedVaccineCode {
+		if err := encodeXMLElement(e, "contraindicatedVaccineCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "forecastStatus", r.ForecastStatus); err != nil {
+		return err
+	}
+	for _, v := range r.ForecastReason {
+		if err := encodeXMLElement(e, "forecastReason", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.DateCriterion {
+		if err := encodeXMLElement(e, "dateCriterion", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "series", r.Series, r.SeriesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doseNumberPositiveInt", r.DoseNumberPositiveInt, r.DoseNumberPositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doseNumberString", r.DoseNumberString, r.DoseNumberStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "seriesDosesPositiveInt", r.SeriesDosesPositiveInt, r.SeriesDosesPositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "seriesDosesString", r.SeriesDosesString, r.SeriesDosesStringElement); err != nil {
+		return err
+	}
+	for _, v := range r.SupportingImmunization {
+		if err := encodeXMLElement(e, "supportingImmunization", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SupportingPatientInformation {
+		if err := encodeXMLElement(e, "supportingPatientInformation", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImmunizationRecommendationRecommendation from FHIR XML
+func (r *ImmunizationRecommendationRecommendation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "vaccineCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.VaccineCode = append(r.VaccineCode, v)
+			case "targetDisease":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TargetDisease = &v
+			case "contraindicatedVaccineCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ContraindicatedVaccineCode = append(r.ContraindicatedVaccineCode, v)
+			case "forecastStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ForecastStatus = v
+			case "forecastReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ForecastReason = append(r.ForecastReason, v)
+			case "dateCriterion":
+				var v ImmunizationRecommendationRecommendationDateCriterion
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DateCriterion = append(r.DateCriterion, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "series":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Series = &v
+				}
+				r.SeriesElement = element
+			case "doseNumberPositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoseNumberPositiveInt = &v
+				}
+				r.DoseNumberPositiveIntElement = element
+			case "doseNumberString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoseNumberString = &v
+				}
+				r.DoseNumberStringElement = element
+			case "seriesDosesPositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SeriesDosesPositiveInt = &v
+				}
+				r.SeriesDosesPositiveIntElement = element
+			case "seriesDosesString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SeriesDosesString = &v
+				}
+				r.SeriesDosesStringElement = element
+			case "supportingImmunization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingImmunization = append(r.SupportingImmunization, v)
+			case "supportingPatientInformation":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingPatientInformation = append(r.SupportingPatientInformation, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImmunizationRecommendationRecommendation as properties to the RDF node n
+func (r ImmunizationRecommendationRecommendation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.VaccineCode {
+		n.element("ImmunizationRecommendation.recommendation.vaccineCode", i, v)
+	}
+	n.element("ImmunizationRecommendation.recommendation.targetDisease", -1, r.TargetDisease)
+	for i, v := range r.ContraindicatedVaccineCode {
+		n.element("ImmunizationRecommendation.recommendation.contraindicatedVaccineCode", i, v)
+	}
+	n.element("ImmunizationRecommendation.recommendation.forecastStatus", -1, r.ForecastStatus)
+	for i, v := range r.ForecastReason {
+		n.element("ImmunizationRecommendation.recommendation.forecastReason", i, v)
+	}
+	for i, v := range r.DateCriterion {
+		n.element("ImmunizationRecommendation.recommendation.dateCriterion", i, v)
+	}
+	n.primitive("ImmunizationRecommendation.recommendation.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("ImmunizationRecommendation.recommendation.series", -1, r.Series, r.SeriesElement, "string")
+	n.primitive("ImmunizationRecommendation.recommendation.doseNumberPositiveInt", -1, r.DoseNumberPositiveInt, r.DoseNumberPositiveIntElement, "positiveInt")
+	n.primitive("ImmunizationRecommendation.recommendation.doseNumberString", -1, r.DoseNumberString, r.DoseNumberStringElement, "string")
+	n.primitive("ImmunizationRecommendation.recommendation.seriesDosesPositiveInt", -1, r.SeriesDosesPositiveInt, r.SeriesDosesPositiveIntElement, "positiveInt")
+	n.primitive("ImmunizationRecommendation.recommendation.seriesDosesString", -1, r.SeriesDosesString, r.SeriesDosesStringElement, "string")
+	for i, v := range r.SupportingImmunization {
+		n.element("ImmunizationRecommendation.recommendation.supportingImmunization", i, v)
+	}
+	for i, v := range r.SupportingPatientInformation {
+		n.element("ImmunizationRecommendation.recommendation.supportingPatientInformation", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ImmunizationRecommendationRecommendation as BSON document with the structure of its FHIR JSON
+func (r ImmunizationRecommendationRecommendation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImmunizationRecommendationRecommendation from a BSON document with the structure of its FHIR JSON
+func (r *ImmunizationRecommendationRecommendation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImmunizationRecommendationRecommendation as protobuf message ImmunizationRecommendationRecommendation of fhir.proto
+func (r ImmunizationRecommendationRecommendation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImmunizationRecommendationRecommendation to the encoded message
+func (r ImmunizationRecommendationRecommendation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.VaccineCode {
+		e.message(4, v)
+	}
+	if r.TargetDisease != nil {
+		e.message(5, *r.TargetDisease)
+	}
+	for _, v := range r.ContraindicatedVaccineCode {
+		e.message(6, v)
+	}
+	e.message(7, r.ForecastStatus)
+	for _, v := range r.ForecastReason {
+		e.message(8, v)
+	}
+	for _, v := range r.DateCriterion {
+		e.message(9, v)
+	}
+	if r.Description != nil {
+		e.string(10, *r.Description)
+	}
+	if r.Series != nil {
+		e.string(11, *r.Series)
+	}
+	if r.DoseNumberPositiveInt != nil {
+		e.varint(12, uint64(int64(*r.DoseNumberPositiveInt)))
+	}
+	if r.DoseNumberString != nil {
+		e.string(13, *r.DoseNumberString)
+	}
+	if r.SeriesDosesPositiveInt != nil {
+		e.varint(14, uint64(int64(*r.SeriesDosesPositiveInt)))
+	}
+	if r.SeriesDosesString != nil {
+		e.string(15, *r.SeriesDosesString)
+	}
+	for _, v := range r.SupportingImmunization {
+		e.message(16, v)
+	}
+	for _, v := range r.SupportingPatientInformation {
+		e.message(17, v)
+	}
+	if r.DescriptionElement != nil {
+		e.message(18, *r.DescriptionElement)
+	}
+	if r.SeriesElement != nil {
+		e.message(19, *r.SeriesElement)
+	}
+	if r.DoseNumberPositiveIntElement != nil {
+		e.message(20, *r.DoseNumberPositiveIntElement)
+	}
+	if r.DoseNumberStringElement != nil {
+		e.message(21, *r.DoseNumberStringElement)
+	}
+	if r.SeriesDosesPositiveIntElement != nil {
+		e.message(22, *r.SeriesDosesPositiveIntElement)
+	}
+	if r.SeriesDosesStringElement != nil {
+		e.message(23, *r.SeriesDosesStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImmunizationRecommendationRecommendation from protobuf message ImmunizationRecommendationRecommendation of fhir.proto
+func (r *ImmunizationRecommendationRecommendation) UnmarshalProto(b []byte) error {
+	*r = ImmunizationRecommendationRecommendation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.VaccineCode = append(r.VaccineCode, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.TargetDisease = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.ContraindicatedVaccineCode = append(r.ContraindicatedVaccineCode, v)
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ForecastStatus = v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.ForecastReason = append(r.ForecastReason, v)
+		case 9:
+			var v ImmunizationRecommendationRecommendationDateCriterion
+			d.message(&v)
+			r.DateCriterion = append(r.DateCriterion, v)
+		case 10:
+			v := d.string()
+			r.Description = &v
+		case 11:
+			v := d.string()
+			r.Series = &v
+		case 12:
+			v := d.int()
+			r.DoseNumberPositiveInt = &v
+		case 13:
+			v := d.string()
+			r.DoseNumberString = &v
+		case 14:
+			v := d.int()
+			r.SeriesDosesPositiveInt = &v
+		case 15:
+			v := d.string()
+			r.SeriesDosesString = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.SupportingImmunization = append(r.SupportingImmunization, v)
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.SupportingPatientInformation = append(r.SupportingPatientInformation, v)
+		case 18:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.SeriesElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.DoseNumberPositiveIntElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.DoseNumberStringElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.SeriesDosesPositiveIntElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.SeriesDosesStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImmunizationRecommendationRecommendationDateCriterion as JSON into a byte slice
+func (r ImmunizationRecommendationRecommendationDateCriterion) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImmunizationRecommendationRecommendationDateCriterion as JSON object with the members in the order of the fields
+func (r ImmunizationRecommendationRecommendationDateCriterion) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImmunizationRecommendationRecommendationDateCriterion from JSON. Unknown members are ignored.
+func (r *ImmunizationRecommendationRecommendationDateCriterion) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImmunizationRecommendationRecommendationDateCriterion
+func (r *ImmunizationRecommendationRecommendationDateCriterion) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImmunizationRecommendationRecommendationDateCriterion which shares no memory with the original
+func (r ImmunizationRecommendationRecommendationDateCriterion) DeepCopy() ImmunizationRecommendationRecommendationDateCriterion {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImmunizationRecommendationRecommendationDateCriterion) Equal(other ImmunizationRecommendationRecommendationDateCriterion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImmunizationRecommendationRecommendationDateCriterion) EqualsDeep(other ImmunizationRecommendationRecommendationDateCriterion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImmunizationRecommendationRecommendationDateCriterion) EqualsShallow(other ImmunizationRecommendationRecommendationDateCriterion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImmunizationRecommendationRecommendationDateCriterion as FHIR XML
+func (r ImmunizationRecommendationRecommendationDateCriterion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImmunizationRecommendationRecommendationDateCriterion from FHIR XML
+func (r *ImmunizationRecommendationRecommendationDateCriterion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImmunizationRecommendationRecommendationDateCriterion as properties to the RDF node n
+func (r ImmunizationRecommendationRecommendationDateCriterion) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ImmunizationRecommendation.recommendation.dateCriterion.code", -1, r.Code)
+	n.primitive("ImmunizationRecommendation.recommendation.dateCriterion.value", -1, r.Value, r.ValueElement, "dateTime")
+}
+
+// MarshalBSON marshals the given ImmunizationRecommendationRecommendationDateCriterion as BSON document with the structure of its FHIR JSON
+func (r ImmunizationRecommendationRecommendationDateCriterion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImmunizationRecommendationRecommendationDateCriterion from a BSON document with the structure of its FHIR JSON
+func (r *ImmunizationRecommendationRecommendationDateCriterion) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImmunizationRecommendationRecommendationDateCriterion as protobuf message ImmunizationRecommendationRecommendationDateCriterion of fhir.proto
+func (r ImmunizationRecommendationRecommendationDateCriterion) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImmunizationRecommendationRecommendationDateCriterion to the encoded message
+func (r ImmunizationRecommendationRecommendationDateCriterion) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	if r.Value != "" {
+		e.string(5, r.Value)
+	}
+	if r.ValueElement != nil {
+		e.message(6, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImmunizationRecommendationRecommendationDateCriterion from protobuf message ImmunizationRecommendationRecommendationDateCriterion of fhir.proto
+func (r *ImmunizationRecommendationRecommendationDateCriterion) UnmarshalProto(b []byte) error {
+	*r = ImmunizationRecommendationRecommendationDateCriterion{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			r.Value = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalImmunizationRecommendation unmarshals a ImmunizationRecommendation.
 func UnmarshalImmunizationRecommendation(b []byte) (ImmunizationRecommendation, error) {
 	var immunizationRecommendation ImmunizationRecommendation
-	if err := json.Unmarshal(b, &immunizationRecommendation); err != nil {
+	if err := immunizationRecommendation.UnmarshalJSON(b); err != nil {
 		return immunizationRecommendation, err
 	}
 	return immunizationRecommendation, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -40,8 +39,13 @@ const (
 func (code ImmunizationStatusCodes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ImmunizationStatusCodes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ImmunizationStatusCodes) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "preparation":
 		*code = ImmunizationStatusCodesPreparation
@@ -64,6 +68,12 @@ func (code *ImmunizationStatusCodes) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ImmunizationStatusCodes) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ImmunizationStatusCodes) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ImmunizationStatusCodes) String() string {
 	return code.Code()
 }
@@ -130,3 +140,47 @@ func (code ImmunizationStatusCodes) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ImmunizationStatusCodes) protoNumber() uint64 {
+	switch code {
+	case ImmunizationStatusCodesPreparation:
+		return 1
+	case ImmunizationStatusCodesInProgress:
+		return 2
+	case ImmunizationStatusCodesNotDone:
+		return 3
+	case ImmunizationStatusCodesOnHold:
+		return 4
+	case ImmunizationStatusCodesStopped:
+		return 5
+	case ImmunizationStatusCodesCompleted:
+		return 6
+	case ImmunizationStatusCodesEnteredInError:
+		return 7
+	case ImmunizationStatusCodesUnknown:
+		return 8
+	}
+	return 0
+}
+func (code *ImmunizationStatusCodes) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ImmunizationStatusCodesPreparation
+	case 2:
+		*code = ImmunizationStatusCodesInProgress
+	case 3:
+		*code = ImmunizationStatusCodesNotDone
+	case 4:
+		*code = ImmunizationStatusCodesOnHold
+	case 5:
+		*code = ImmunizationStatusCodesStopped
+	case 6:
+		*code = ImmunizationStatusCodesCompleted
+	case 7:
+		*code = ImmunizationStatusCodesEnteredInError
+	case 8:
+		*code = ImmunizationStatusCodesUnknown
+	default:
+		return false
+	}
+	return true
+}
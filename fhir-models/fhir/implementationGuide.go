@@ -14,55 +14,80 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ImplementationGuide is documented here http://hl7.org/fhir/StructureDefinition/ImplementationGuide
 type ImplementationGuide struct {
-	Id                *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               string                         `bson:"url" json:"url"`
-	Version           *string                        `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                         `bson:"name" json:"name"`
-	Title             *string                        `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus              `bson:"status" json:"status"`
-	Experimental      *bool                          `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                        `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                        `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                 `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept              `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                        `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	PackageId         string                         `bson:"packageId" json:"packageId"`
-	License           *SPDXLicense                   `bson:"license,omitempty" json:"license,omitempty"`
-	FhirVersion       []FHIRVersion                  `bson:"fhirVersion" json:"fhirVersion"`
-	DependsOn         []ImplementationGuideDependsOn `bson:"dependsOn,omitempty" json:"dependsOn,omitempty"`
-	Global            []ImplementationGuideGlobal    `bson:"global,omitempty" json:"global,omitempty"`
-	Definition        *ImplementationGuideDefinition `bson:"definition,omitempty" json:"definition,omitempty"`
-	Manifest          *ImplementationGuideManifest   `bson:"manifest,omitempty" json:"manifest,omitempty"`
+	Id                   *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                       `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  string                         `bson:"url" json:"url"`
+	UrlElement           *Element                       `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                        `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                       `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                         `bson:"name" json:"name"`
+	NameElement          *Element                       `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                        `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                       `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus              `bson:"status" json:"status"`
+	StatusElement        *Element                       `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                          `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                       `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *string                        `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                       `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                       `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail                `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                        `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                       `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext                 `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept              `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright            *string                        `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                       `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	PackageId            string                         `bson:"packageId" json:"packageId"`
+	PackageIdElement     *Element                       `bson:"_packageId,omitempty" json:"_packageId,omitempty"`
+	License              *SPDXLicense                   `bson:"license,omitempty" json:"license,omitempty"`
+	LicenseElement       *Element                       `bson:"_license,omitempty" json:"_license,omitempty"`
+	FhirVersion          []FHIRVersion                  `bson:"fhirVersion" json:"fhirVersion"`
+	FhirVersionElement   []*Element                     `bson:"_fhirVersion,omitempty" json:"_fhirVersion,omitempty"`
+	DependsOn            []ImplementationGuideDependsOn `bson:"dependsOn,omitempty" json:"dependsOn,omitempty"`
+	Global               []ImplementationGuideGlobal    `bson:"global,omitempty" json:"global,omitempty"`
+	Definition           *ImplementationGuideDefinition `bson:"definition,omitempty" json:"definition,omitempty"`
+	Manifest             *ImplementationGuideManifest   `bson:"manifest,omitempty" json:"manifest,omitempty"`
 }
 type ImplementationGuideDependsOn struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Uri               string      `bson:"uri" json:"uri"`
+	UriElement        *Element    `bson:"_uri,omitempty" json:"_uri,omitempty"`
 	PackageId         *string     `bson:"packageId,omitempty" json:"packageId,omitempty"`
+	PackageIdElement  *Element    `bson:"_packageId,omitempty" json:"_packageId,omitempty"`
 	Version           *string     `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element    `bson:"_version,omitempty" json:"_version,omitempty"`
 }
 type ImplementationGuideGlobal struct {
 	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              ResourceType `bson:"type" json:"type"`
+	TypeElement       *Element     `bson:"_type,omitempty" json:"_type,omitempty"`
 	Profile           string       `bson:"profile" json:"profile"`
+	ProfileElement    *Element     `bson:"_profile,omitempty" json:"_profile,omitempty"`
 }
 type ImplementationGuideDefinition struct {
 	Id                *string                                  `bson:"id,omitempty" json:"id,omitempty"`
@@ -75,93 +100,7724 @@ type ImplementationGuideDefinition struct {
 	Template          []ImplementationGuideDefinitionTemplate  `bson:"template,omitempty" json:"template,omitempty"`
 }
 type ImplementationGuideDefinitionGrouping struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               string      `bson:"name" json:"name"`
+	NameElement        *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
 }
 type ImplementationGuideDefinitionResource struct {
-	Id                *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Reference         Reference     `bson:"reference" json:"reference"`
-	FhirVersion       []FHIRVersion `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
-	Name              *string       `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string       `bson:"description,omitempty" json:"description,omitempty"`
-	ExampleBoolean    *bool         `bson:"exampleBoolean,omitempty" json:"exampleBoolean,omitempty"`
-	ExampleCanonical  *string       `bson:"exampleCanonical,omitempty" json:"exampleCanonical,omitempty"`
-	GroupingId        *string       `bson:"groupingId,omitempty" json:"groupingId,omitempty"`
+	Id                      *string       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Reference               Reference     `bson:"reference" json:"reference"`
+	FhirVersion             []FHIRVersion `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
+	FhirVersionElement      []*Element    `bson:"_fhirVersion,omitempty" json:"_fhirVersion,omitempty"`
+	Name                    *string       `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement             *Element      `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description             *string       `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element      `bson:"_description,omitempty" json:"_description,omitempty"`
+	ExampleBoolean          *bool         `bson:"exampleBoolean,omitempty" json:"exampleBoolean,omitempty"`
+	ExampleBooleanElement   *Element      `bson:"_exampleBoolean,omitempty" json:"_exampleBoolean,omitempty"`
+	ExampleCanonical        *string       `bson:"exampleCanonical,omitempty" json:"exampleCanonical,omitempty"`
+	ExampleCanonicalElement *Element      `bson:"_exampleCanonical,omitempty" json:"_exampleCanonical,omitempty"`
+	GroupingId              *string       `bson:"groupingId,omitempty" json:"groupingId,omitempty"`
+	GroupingIdElement       *Element      `bson:"_groupingId,omitempty" json:"_groupingId,omitempty"`
+}
+
+// ImplementationGuideDefinitionResourceExample is implemented by the types allowed for ImplementationGuide.definition.resource.example[x]
+type ImplementationGuideDefinitionResourceExample interface {
+	isImplementationGuideDefinitionResourceExample()
+}
+
+func (Boolean) isImplementationGuideDefinitionResourceExample()   {}
+func (Canonical) isImplementationGuideDefinitionResourceExample() {}
+
+// Example returns the value of ImplementationGuide.definition.resource.example[x] together with its FHIR type code or nil if no value is set
+func (r ImplementationGuideDefinitionResource) Example() (ImplementationGuideDefinitionResourceExample, string) {
+	if r.ExampleBoolean != nil {
+		return Boolean(*r.ExampleBoolean), "boolean"
+	}
+	if r.ExampleCanonical != nil {
+		return Canonical(*r.ExampleCanonical), "canonical"
+	}
+	return nil, ""
+}
+
+// SetExample sets the value of ImplementationGuide.definition.resource.example[x], given as value or pointer, and clears all other types
+func (r *ImplementationGuideDefinitionResource) SetExample(value ImplementationGuideDefinitionResourceExample) {
+	r.ExampleBoolean = nil
+	r.ExampleCanonical = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.ExampleBoolean = &primitive
+	case *Boolean:
+		r.ExampleBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.ExampleCanonical = &primitive
+	case *Canonical:
+		r.ExampleCanonical = (*string)(v)
+	}
 }
+
 type ImplementationGuideDefinitionPage struct {
 	Id                *string                             `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	NameUrl           string                              `bson:"nameUrl" json:"nameUrl"`
-	NameReference     Reference                           `bson:"nameReference" json:"nameReference"`
+	NameUrl           *string                             `bson:"nameUrl,omitempty" json:"nameUrl,omitempty"`
+	NameUrlElement    *Element                            `bson:"_nameUrl,omitempty" json:"_nameUrl,omitempty"`
+	NameReference     *Reference                          `bson:"nameReference,omitempty" json:"nameReference,omitempty"`
 	Title             string                              `bson:"title" json:"title"`
+	TitleElement      *Element                            `bson:"_title,omitempty" json:"_title,omitempty"`
 	Generation        GuidePageGeneration                 `bson:"generation" json:"generation"`
+	GenerationElement *Element                            `bson:"_generation,omitempty" json:"_generation,omitempty"`
 	Page              []ImplementationGuideDefinitionPage `bson:"page,omitempty" json:"page,omitempty"`
 }
+
+// ImplementationGuideDefinitionPageName is implemented by the types allowed for ImplementationGuide.definition.page.name[x]
+type ImplementationGuideDefinitionPageName interface {
+	isImplementationGuideDefinitionPageName()
+}
+
+func (Url) isImplementationGuideDefinitionPageName()       {}
+func (Reference) isImplementationGuideDefinitionPageName() {}
+
+// Name returns the value of ImplementationGuide.definition.page.name[x] together with its FHIR type code or nil if no value is set
+func (r ImplementationGuideDefinitionPage) Name() (ImplementationGuideDefinitionPageName, string) {
+	if r.NameUrl != nil {
+		return Url(*r.NameUrl), "url"
+	}
+	if r.NameReference != nil {
+		return *r.NameReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetName sets the value of ImplementationGuide.definition.page.name[x], given as value or pointer, and clears all other types
+func (r *ImplementationGuideDefinitionPage) SetName(value ImplementationGuideDefinitionPageName) {
+	r.NameUrl = nil
+	r.NameReference = nil
+	switch v := value.(type) {
+	case Url:
+		primitive := string(v)
+		r.NameUrl = &primitive
+	case *Url:
+		r.NameUrl = (*string)(v)
+	case Reference:
+		r.NameReference = &v
+	case *Reference:
+		r.NameReference = v
+	}
+}
+
 type ImplementationGuideDefinitionParameter struct {
 	Id                *string            `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              GuideParameterCode `bson:"code" json:"code"`
+	CodeElement       *Element           `bson:"_code,omitempty" json:"_code,omitempty"`
 	Value             string             `bson:"value" json:"value"`
+	ValueElement      *Element           `bson:"_value,omitempty" json:"_value,omitempty"`
 }
 type ImplementationGuideDefinitionTemplate struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              string      `bson:"code" json:"code"`
+	CodeElement       *Element    `bson:"_code,omitempty" json:"_code,omitempty"`
 	Source            string      `bson:"source" json:"source"`
+	SourceElement     *Element    `bson:"_source,omitempty" json:"_source,omitempty"`
 	Scope             *string     `bson:"scope,omitempty" json:"scope,omitempty"`
+	ScopeElement      *Element    `bson:"_scope,omitempty" json:"_scope,omitempty"`
 }
 type ImplementationGuideManifest struct {
 	Id                *string                               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Rendering         *string                               `bson:"rendering,omitempty" json:"rendering,omitempty"`
+	RenderingElement  *Element                              `bson:"_rendering,omitempty" json:"_rendering,omitempty"`
 	Resource          []ImplementationGuideManifestResource `bson:"resource" json:"resource"`
 	Page              []ImplementationGuideManifestPage     `bson:"page,omitempty" json:"page,omitempty"`
 	Image             []string                              `bson:"image,omitempty" json:"image,omitempty"`
+	ImageElement      []*Element                            `bson:"_image,omitempty" json:"_image,omitempty"`
 	Other             []string                              `bson:"other,omitempty" json:"other,omitempty"`
+	OtherElement      []*Element                            `bson:"_other,omitempty" json:"_other,omitempty"`
 }
 type ImplementationGuideManifestResource struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Reference         Reference   `bson:"reference" json:"reference"`
-	ExampleBoolean    *bool       `bson:"exampleBoolean,omitempty" json:"exampleBoolean,omitempty"`
-	ExampleCanonical  *string     `bson:"exampleCanonical,omitempty" json:"exampleCanonical,omitempty"`
-	RelativePath      *string     `bson:"relativePath,omitempty" json:"relativePath,omitempty"`
+	Id                      *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Reference               Reference   `bson:"reference" json:"reference"`
+	ExampleBoolean          *bool       `bson:"exampleBoolean,omitempty" json:"exampleBoolean,omitempty"`
+	ExampleBooleanElement   *Element    `bson:"_exampleBoolean,omitempty" json:"_exampleBoolean,omitempty"`
+	ExampleCanonical        *string     `bson:"exampleCanonical,omitempty" json:"exampleCanonical,omitempty"`
+	ExampleCanonicalElement *Element    `bson:"_exampleCanonical,omitempty" json:"_exampleCanonical,omitempty"`
+	RelativePath            *string     `bson:"relativePath,omitempty" json:"relativePath,omitempty"`
+	RelativePathElement     *Element    `bson:"_relativePath,omitempty" json:"_relativePath,omitempty"`
+}
+
+// ImplementationGuideManifestResourceExample is implemented by the types allowed for ImplementationGuide.manifest.resource.example[x]
+type ImplementationGuideManifestResourceExample interface {
+	isImplementationGuideManifestResourceExample()
+}
+
+func (Boolean) isImplementationGuideManifestResourceExample()   {}
+func (Canonical) isImplementationGuideManifestResourceExample() {}
+
+// Example returns the value of ImplementationGuide.manifest.resource.example[x] together with its FHIR type code or nil if no value is set
+func (r ImplementationGuideManifestResource) Example() (ImplementationGuideManifestResourceExample, string) {
+	if r.ExampleBoolean != nil {
+		return Boolean(*r.ExampleBoolean), "boolean"
+	}
+	if r.ExampleCanonical != nil {
+		return Canonical(*r.ExampleCanonical), "canonical"
+	}
+	return nil, ""
 }
+
+// SetExample sets the value of ImplementationGuide.manifest.resource.example[x], given as value or pointer, and clears all other types
+func (r *ImplementationGuideManifestResource) SetExample(value ImplementationGuideManifestResourceExample) {
+	r.ExampleBoolean = nil
+	r.ExampleCanonical = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.ExampleBoolean = &primitive
+	case *Boolean:
+		r.ExampleBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.ExampleCanonical = &primitive
+	case *Canonical:
+		r.ExampleCanonical = (*string)(v)
+	}
+}
+
 type ImplementationGuideManifestPage struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              string      `bson:"name" json:"name"`
+	NameElement       *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
 	Title             *string     `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement      *Element    `bson:"_title,omitempty" json:"_title,omitempty"`
 	Anchor            []string    `bson:"anchor,omitempty" json:"anchor,omitempty"`
+	AnchorElement     []*Element  `bson:"_anchor,omitempty" json:"_anchor,omitempty"`
 }
-type OtherImplementationGuide ImplementationGuide
 
 // MarshalJSON marshals the given ImplementationGuide as JSON into a byte slice
 func (r ImplementationGuide) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherImplementationGuide
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherImplementationGuide: OtherImplementationGuide(r),
-		ResourceType:             "ImplementationGuide",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuide as JSON object with the members in the order of the fields
+func (r ImplementationGuide) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ImplementationGuide\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	e.key("packageId")
+	e.string(r.PackageId)
+	if r.PackageIdElement != nil {
+		e.key("_packageId")
+		r.PackageIdElement.appendJSON(e)
+	}
+	if r.License != nil {
+		e.key("license")
+		e.string(r.License.Code())
+	}
+	if r.LicenseElement != nil {
+		e.key("_license")
+		r.LicenseElement.appendJSON(e)
+	}
+	e.key("fhirVersion")
+	if r.FhirVersion == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.FhirVersion {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.FhirVersionElement) > 0 {
+		e.key("_fhirVersion")
+		e.elements(r.FhirVersionElement, len(r.FhirVersion))
+	}
+	if len(r.DependsOn) > 0 {
+		e.key("dependsOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DependsOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Global) > 0 {
+		e.key("global")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Global {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Definition != nil {
+		e.key("definition")
+		r.Definition.appendJSON(e)
+	}
+	if r.Manifest != nil {
+		e.key("manifest")
+		r.Manifest.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuide from JSON. Unknown members are ignored.
+func (r *ImplementationGuide) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuide
+func (r *ImplementationGuide) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "packageId":
+			if v, ok := d.string(); ok {
+				r.PackageId = v
+			}
+		case "_packageId":
+			r.PackageIdElement = d.element()
+		case "license":
+			if d.null() {
+				r.License = nil
+			} else {
+				var v SPDXLicense
+				d.unmarshal(&v)
+				r.License = &v
+			}
+		case "_license":
+			r.LicenseElement = d.element()
+		case "fhirVersion":
+			a := d.array()
+			r.FhirVersion = nil
+			for a.next() {
+				var v FHIRVersion
+				d.unmarshal(&v)
+				r.FhirVersion = append(r.FhirVersion, v)
+			}
+			if r.FhirVersion == nil && !a.isNull {
+				r.FhirVersion = []FHIRVersion{}
+			}
+		case "_fhirVersion":
+			a := d.array()
+			r.FhirVersionElement = nil
+			for a.next() {
+				r.FhirVersionElement = append(r.FhirVersionElement, d.element())
+			}
+		case "dependsOn":
+			a := d.array()
+			r.DependsOn = nil
+			for a.next() {
+				var v ImplementationGuideDependsOn
+				v.decodeJSON(d)
+				r.DependsOn = append(r.DependsOn, v)
+			}
+			if r.DependsOn == nil && !a.isNull {
+				r.DependsOn = []ImplementationGuideDependsOn{}
+			}
+		case "global":
+			a := d.array()
+			r.Global = nil
+			for a.next() {
+				var v ImplementationGuideGlobal
+				v.decodeJSON(d)
+				r.Global = append(r.Global, v)
+			}
+			if r.Global == nil && !a.isNull {
+				r.Global = []ImplementationGuideGlobal{}
+			}
+		case "definition":
+			if d.null() {
+				r.Definition = nil
+			} else {
+				var v ImplementationGuideDefinition
+				v.decodeJSON(d)
+				r.Definition = &v
+			}
+		case "manifest":
+			if d.null() {
+				r.Manifest = nil
+			} else {
+				var v ImplementationGuideManifest
+				v.decodeJSON(d)
+				r.Manifest = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuide which shares no memory with the original
+func (r ImplementationGuide) DeepCopy() ImplementationGuide {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.PackageIdElement != nil {
+		v := r.PackageIdElement.DeepCopy()
+		out.PackageIdElement = &v
+	}
+	if r.License != nil {
+		v := *r.License
+		out.License = &v
+	}
+	if r.LicenseElement != nil {
+		v := r.LicenseElement.DeepCopy()
+		out.LicenseElement = &v
+	}
+	if r.FhirVersion != nil {
+		out.FhirVersion = make([]FHIRVersion, len(r.FhirVersion))
+		copy(out.FhirVersion, r.FhirVersion)
+	}
+	if r.FhirVersionElement != nil {
+		out.FhirVersionElement = make([]*Element, len(r.FhirVersionElement))
+		for i, v := range r.FhirVersionElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.FhirVersionElement[i] = &c
+			}
+		}
+	}
+	if r.DependsOn != nil {
+		out.DependsOn = make([]ImplementationGuideDependsOn, len(r.DependsOn))
+		for i := range r.DependsOn {
+			out.DependsOn[i] = r.DependsOn[i].DeepCopy()
+		}
+	}
+	if r.Global != nil {
+		out.Global = make([]ImplementationGuideGlobal, len(r.Global))
+		for i := range r.Global {
+			out.Global[i] = r.Global[i].DeepCopy()
+		}
+	}
+	if r.Definition != nil {
+		v := r.Definition.DeepCopy()
+		out.Definition = &v
+	}
+	if r.Manifest != nil {
+		v := r.Manifest.DeepCopy()
+		out.Manifest = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuide) Equal(other ImplementationGuide) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if r.PackageId != other.PackageId {
+		return false
+	}
+	if (r.PackageIdElement == nil) != (other.PackageIdElement == nil) || r.PackageIdElement != nil && !r.PackageIdElement.Equal(*other.PackageIdElement) {
+		return false
+	}
+	if (r.License == nil) != (other.License == nil) || r.License != nil && *r.License != *other.License {
+		return false
+	}
+	if (r.LicenseElement == nil) != (other.LicenseElement == nil) || r.LicenseElement != nil && !r.LicenseElement.Equal(*other.LicenseElement) {
+		return false
+	}
+	if len(r.FhirVersion) != len(other.FhirVersion) {
+		return false
+	}
+	for i := range r.FhirVersion {
+		if r.FhirVersion[i] != other.FhirVersion[i] {
+			return false
+		}
+	}
+	if !equalElements(r.FhirVersionElement, other.FhirVersionElement, false) {
+		return false
+	}
+	if len(r.DependsOn) != len(other.DependsOn) {
+		return false
+	}
+	for i := range r.DependsOn {
+		if !r.DependsOn[i].Equal(other.DependsOn[i]) {
+			return false
+		}
+	}
+	if len(r.Global) != len(other.Global) {
+		return false
+	}
+	for i := range r.Global {
+		if !r.Global[i].Equal(other.Global[i]) {
+			return false
+		}
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !r.Definition.Equal(*other.Definition) {
+		return false
+	}
+	if (r.Manifest == nil) != (other.Manifest == nil) || r.Manifest != nil && !r.Manifest.Equal(*other.Manifest) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuide) EqualsDeep(other ImplementationGuide) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if !equivalentString(r.PackageId, other.PackageId) {
+		return false
+	}
+	if (r.PackageIdElement == nil) != (other.PackageIdElement == nil) || r.PackageIdElement != nil && !r.PackageIdElement.EqualsDeep(*other.PackageIdElement) {
+		return false
+	}
+	if (r.License == nil) != (other.License == nil) || r.License != nil && *r.License != *other.License {
+		return false
+	}
+	if (r.LicenseElement == nil) != (other.LicenseElement == nil) || r.LicenseElement != nil && !r.LicenseElement.EqualsDeep(*other.LicenseElement) {
+		return false
+	}
+	if !equivalentList(len(r.FhirVersion), len(other.FhirVersion), func(i, j int) bool {
+		return r.FhirVersion[i] == other.FhirVersion[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.FhirVersionElement, other.FhirVersionElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.DependsOn), len(other.DependsOn), func(i, j int) bool {
+		return r.DependsOn[i].EqualsDeep(other.DependsOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Global), len(other.Global), func(i, j int) bool {
+		return r.Global[i].EqualsDeep(other.Global[j])
+	}) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !r.Definition.EqualsDeep(*other.Definition) {
+		return false
+	}
+	if (r.Manifest == nil) != (other.Manifest == nil) || r.Manifest != nil && !r.Manifest.EqualsDeep(*other.Manifest) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuide) EqualsShallow(other ImplementationGuide) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if !equivalentString(r.PackageId, other.PackageId) {
+		return false
+	}
+	if (r.License == nil) != (other.License == nil) || r.License != nil && *r.License != *other.License {
+		return false
+	}
+	if !equivalentList(len(r.FhirVersion), len(other.FhirVersion), func(i, j int) bool {
+		return r.FhirVersion[i] == other.FhirVersion[j]
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuide as FHIR XML
+func (r ImplementationGuide) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ImplementationGuide",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "packageId", r.PackageId, r.PackageIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "license", r.License, r.LicenseElement); err != nil {
+		return err
+	}
+	for i, v := range r.FhirVersion {
+		if err := encodeXMLPrimitive(e, "fhirVersion", v, elementAt(r.FhirVersionElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.DependsOn {
+		if err := encodeXMLElement(e, "dependsOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Global {
+		if err := encodeXMLElement(e, "global", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "definition", r.Definition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "manifest", r.Manifest); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuide from FHIR XML
+func (r *ImplementationGuide) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "packageId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PackageId = v
+				}
+				r.PackageIdElement = element
+			case "license":
+				var v SPDXLicense
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.License = &v
+				}
+				r.LicenseElement = element
+			case "fhirVersion":
+				var v FHIRVersion
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.FhirVersionElement = appendElement(r.FhirVersionElement, len(r.FhirVersion), element)
+					r.FhirVersion = append(r.FhirVersion, v)
+				}
+			case "dependsOn":
+				var v ImplementationGuideDependsOn
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DependsOn = append(r.DependsOn, v)
+			case "global":
+				var v ImplementationGuideGlobal
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Global = append(r.Global, v)
+			case "definition":
+				var v ImplementationGuideDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Definition = &v
+			case "manifest":
+				var v ImplementationGuideManifest
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manifest = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuide as properties to the RDF node n
+func (r ImplementationGuide) turtle(n *turtleNode) {
+	n.resource("ImplementationGuide", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("ImplementationGuide.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("ImplementationGuide.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("ImplementationGuide.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ImplementationGuide.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("ImplementationGuide.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("ImplementationGuide.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("ImplementationGuide.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("ImplementationGuide.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("ImplementationGuide.contact", i, v)
+	}
+	n.primitive("ImplementationGuide.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("ImplementationGuide.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("ImplementationGuide.jurisdiction", i, v)
+	}
+	n.primitive("ImplementationGuide.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("ImplementationGuide.packageId", -1, r.PackageId, r.PackageIdElement, "string")
+	n.primitive("ImplementationGuide.license", -1, r.License, r.LicenseElement, "code")
+	for i, v := range r.FhirVersion {
+		n.primitive("ImplementationGuide.fhirVersion", i, v, elementAt(r.FhirVersionElement, i), "code")
+	}
+	for i, v := range r.DependsOn {
+		n.element("ImplementationGuide.dependsOn", i, v)
+	}
+	for i, v := range r.Global {
+		n.element("ImplementationGuide.global", i, v)
+	}
+	n.element("ImplementationGuide.definition", -1, r.Definition)
+	n.element("ImplementationGuide.manifest", -1, r.Manifest)
+}
+
+// MarshalBSON marshals the given ImplementationGuide as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuide) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuide from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuide) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuide as protobuf message ImplementationGuide of fhir.proto
+func (r ImplementationGuide) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuide to the encoded message
+func (r ImplementationGuide) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != "" {
+		e.string(9, r.Url)
+	}
+	if r.Version != nil {
+		e.string(10, *r.Version)
+	}
+	if r.Name != "" {
+		e.string(11, r.Name)
+	}
+	if r.Title != nil {
+		e.string(12, *r.Title)
+	}
+	e.varint(13, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(14, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(15, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(16, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(17, v)
+	}
+	if r.Description != nil {
+		e.string(18, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(19, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(20, v)
+	}
+	if r.Copyright != nil {
+		e.string(21, *r.Copyright)
+	}
+	if r.PackageId != "" {
+		e.string(22, r.PackageId)
+	}
+	if r.License != nil {
+		e.varint(23, r.License.protoNumber())
+	}
+	if len(r.FhirVersion) > 0 {
+		var p protoEncoder
+		for _, v := range r.FhirVersion {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(24, p.buf)
+	}
+	for _, v := range r.DependsOn {
+		e.message(25, v)
+	}
+	for _, v := range r.Global {
+		e.message(26, v)
+	}
+	if r.Definition != nil {
+		e.message(27, *r.Definition)
+	}
+	if r.Manifest != nil {
+		e.message(28, *r.Manifest)
+	}
+	if r.IdElement != nil {
+		e.message(29, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(30, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(31, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(32, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(33, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(34, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(35, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(36, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(37, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(38, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(39, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(40, *r.DescriptionElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(41, *r.CopyrightElement)
+	}
+	if r.PackageIdElement != nil {
+		e.message(42, *r.PackageIdElement)
+	}
+	if r.LicenseElement != nil {
+		e.message(43, *r.LicenseElement)
+	}
+	for _, v := range r.FhirVersionElement {
+		e.element(44, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuide from protobuf message ImplementationGuide of fhir.proto
+func (r *ImplementationGuide) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuide{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			r.Url = d.string()
+		case 10:
+			v := d.string()
+			r.Version = &v
+		case 11:
+			r.Name = d.string()
+		case 12:
+			v := d.string()
+			r.Title = &v
+		case 13:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 14:
+			v := d.bool()
+			r.Experimental = &v
+		case 15:
+			v := d.string()
+			r.Date = &v
+		case 16:
+			v := d.string()
+			r.Publisher = &v
+		case 17:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 18:
+			v := d.string()
+			r.Description = &v
+		case 19:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 21:
+			v := d.string()
+			r.Copyright = &v
+		case 22:
+			r.PackageId = d.string()
+		case 23:
+			var v SPDXLicense
+			d.enum(d.varint(), v.setProtoNumber)
+			r.License = &v
+		case 24:
+			for _, n := range d.varints() {
+				var v FHIRVersion
+				d.enum(n, v.setProtoNumber)
+				r.FhirVersion = append(r.FhirVersion, v)
+			}
+		case 25:
+			var v ImplementationGuideDependsOn
+			d.message(&v)
+			r.DependsOn = append(r.DependsOn, v)
+		case 26:
+			var v ImplementationGuideGlobal
+			d.message(&v)
+			r.Global = append(r.Global, v)
+		case 27:
+			var v ImplementationGuideDefinition
+			d.message(&v)
+			r.Definition = &v
+		case 28:
+			var v ImplementationGuideManifest
+			d.message(&v)
+			r.Manifest = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.PackageIdElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.LicenseElement = &v
+		case 44:
+			r.FhirVersionElement = append(r.FhirVersionElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideDependsOn as JSON into a byte slice
+func (r ImplementationGuideDependsOn) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideDependsOn as JSON object with the members in the order of the fields
+func (r ImplementationGuideDependsOn) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("uri")
+	e.string(r.Uri)
+	if r.UriElement != nil {
+		e.key("_uri")
+		r.UriElement.appendJSON(e)
+	}
+	if r.PackageId != nil {
+		e.key("packageId")
+		e.string(*r.PackageId)
+	}
+	if r.PackageIdElement != nil {
+		e.key("_packageId")
+		r.PackageIdElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideDependsOn from JSON. Unknown members are ignored.
+func (r *ImplementationGuideDependsOn) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideDependsOn
+func (r *ImplementationGuideDependsOn) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "uri":
+			if v, ok := d.string(); ok {
+				r.Uri = v
+			}
+		case "_uri":
+			r.UriElement = d.element()
+		case "packageId":
+			if v, ok := d.string(); ok {
+				r.PackageId = &v
+			} else {
+				r.PackageId = nil
+			}
+		case "_packageId":
+			r.PackageIdElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideDependsOn which shares no memory with the original
+func (r ImplementationGuideDependsOn) DeepCopy() ImplementationGuideDependsOn {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UriElement != nil {
+		v := r.UriElement.DeepCopy()
+		out.UriElement = &v
+	}
+	if r.PackageId != nil {
+		v := *r.PackageId
+		out.PackageId = &v
+	}
+	if r.PackageIdElement != nil {
+		v := r.PackageIdElement.DeepCopy()
+		out.PackageIdElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideDependsOn) Equal(other ImplementationGuideDependsOn) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Uri != other.Uri {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.Equal(*other.UriElement) {
+		return false
+	}
+	if (r.PackageId == nil) != (other.PackageId == nil) || r.PackageId != nil && *r.PackageId != *other.PackageId {
+		return false
+	}
+	if (r.PackageIdElement == nil) != (other.PackageIdElement == nil) || r.PackageIdElement != nil && !r.PackageIdElement.Equal(*other.PackageIdElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideDependsOn) EqualsDeep(other ImplementationGuideDependsOn) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Uri, other.Uri) {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.EqualsDeep(*other.UriElement) {
+		return false
+	}
+	if (r.PackageId == nil) != (other.PackageId == nil) || r.PackageId != nil && !equivalentString(*r.PackageId, *other.PackageId) {
+		return false
+	}
+	if (r.PackageIdElement == nil) != (other.PackageIdElement == nil) || r.PackageIdElement != nil && !r.PackageIdElement.EqualsDeep(*other.PackageIdElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideDependsOn) EqualsShallow(other ImplementationGuideDependsOn) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Uri, other.Uri) {
+		return false
+	}
+	if (r.PackageId == nil) != (other.PackageId == nil) || r.PackageId != nil && !equivalentString(*r.PackageId, *other.PackageId) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideDependsOn as FHIR XML
+func (r ImplementationGuideDependsOn) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "uri", r.Uri, r.UriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "packageId", r.PackageId, r.PackageIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideDependsOn from FHIR XML
+func (r *ImplementationGuideDependsOn) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "uri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Uri = v
+				}
+				r.UriElement = element
+			case "packageId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PackageId = &v
+				}
+				r.PackageIdElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideDependsOn as properties to the RDF node n
+func (r ImplementationGuideDependsOn) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImplementationGuide.dependsOn.uri", -1, r.Uri, r.UriElement, "string")
+	n.primitive("ImplementationGuide.dependsOn.packageId", -1, r.PackageId, r.PackageIdElement, "string")
+	n.primitive("ImplementationGuide.dependsOn.version", -1, r.Version, r.VersionElement, "string")
+}
+
+// MarshalBSON marshals the given ImplementationGuideDependsOn as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideDependsOn) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideDependsOn from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideDependsOn) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideDependsOn as protobuf message ImplementationGuideDependsOn of fhir.proto
+func (r ImplementationGuideDependsOn) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideDependsOn to the encoded message
+func (r ImplementationGuideDependsOn) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Uri != "" {
+		e.string(4, r.Uri)
+	}
+	if r.PackageId != nil {
+		e.string(5, *r.PackageId)
+	}
+	if r.Version != nil {
+		e.string(6, *r.Version)
+	}
+	if r.UriElement != nil {
+		e.message(7, *r.UriElement)
+	}
+	if r.PackageIdElement != nil {
+		e.message(8, *r.PackageIdElement)
+	}
+	if r.VersionElement != nil {
+		e.message(9, *r.VersionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideDependsOn from protobuf message ImplementationGuideDependsOn of fhir.proto
+func (r *ImplementationGuideDependsOn) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideDependsOn{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Uri = d.string()
+		case 5:
+			v := d.string()
+			r.PackageId = &v
+		case 6:
+			v := d.string()
+			r.Version = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.UriElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.PackageIdElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideGlobal as JSON into a byte slice
+func (r ImplementationGuideGlobal) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideGlobal as JSON object with the members in the order of the fields
+func (r ImplementationGuideGlobal) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("profile")
+	e.string(r.Profile)
+	if r.ProfileElement != nil {
+		e.key("_profile")
+		r.ProfileElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideGlobal from JSON. Unknown members are ignored.
+func (r *ImplementationGuideGlobal) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideGlobal
+func (r *ImplementationGuideGlobal) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "profile":
+			if v, ok := d.string(); ok {
+				r.Profile = v
+			}
+		case "_profile":
+			r.ProfileElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideGlobal which shares no memory with the original
+func (r ImplementationGuideGlobal) DeepCopy() ImplementationGuideGlobal {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.ProfileElement != nil {
+		v := r.ProfileElement.DeepCopy()
+		out.ProfileElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideGlobal) Equal(other ImplementationGuideGlobal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.Profile != other.Profile {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.Equal(*other.ProfileElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideGlobal) EqualsDeep(other ImplementationGuideGlobal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentString(r.Profile, other.Profile) {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.EqualsDeep(*other.ProfileElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideGlobal) EqualsShallow(other ImplementationGuideGlobal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if !equivalentString(r.Profile, other.Profile) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideGlobal as FHIR XML
+func (r ImplementationGuideGlobal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "profile", r.Profile, r.ProfileElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideGlobal from FHIR XML
+func (r *ImplementationGuideGlobal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Profile = v
+				}
+				r.ProfileElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideGlobal as properties to the RDF node n
+func (r ImplementationGuideGlobal) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImplementationGuide.global.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("ImplementationGuide.global.profile", -1, r.Profile, r.ProfileElement, "string")
+}
+
+// MarshalBSON marshals the given ImplementationGuideGlobal as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideGlobal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideGlobal from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideGlobal) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideGlobal as protobuf message ImplementationGuideGlobal of fhir.proto
+func (r ImplementationGuideGlobal) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideGlobal to the encoded message
+func (r ImplementationGuideGlobal) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Profile != "" {
+		e.string(5, r.Profile)
+	}
+	if r.TypeElement != nil {
+		e.message(6, *r.TypeElement)
+	}
+	if r.ProfileElement != nil {
+		e.message(7, *r.ProfileElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideGlobal from protobuf message ImplementationGuideGlobal of fhir.proto
+func (r *ImplementationGuideGlobal) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideGlobal{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ResourceType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			r.Profile = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ProfileElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideDefinition as JSON into a byte slice
+func (r ImplementationGuideDefinition) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideDefinition as JSON object with the members in the order of the fields
+func (r ImplementationGuideDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Grouping) > 0 {
+		e.key("grouping")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Grouping {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("resource")
+	if r.Resource == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Resource {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Page != nil {
+		e.key("page")
+		r.Page.appendJSON(e)
+	}
+	if len(r.Parameter) > 0 {
+		e.key("parameter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parameter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Template) > 0 {
+		e.key("template")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Template {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideDefinition from JSON. Unknown members are ignored.
+func (r *ImplementationGuideDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideDefinition
+func (r *ImplementationGuideDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "grouping":
+			a := d.array()
+			r.Grouping = nil
+			for a.next() {
+				var v ImplementationGuideDefinitionGrouping
+				v.decodeJSON(d)
+				r.Grouping = append(r.Grouping, v)
+			}
+			if r.Grouping == nil && !a.isNull {
+				r.Grouping = []ImplementationGuideDefinitionGrouping{}
+			}
+		case "resource":
+			a := d.array()
+			r.Resource = nil
+			for a.next() {
+				var v ImplementationGuideDefinitionResource
+				v.decodeJSON(d)
+				r.Resource = append(r.Resource, v)
+			}
+			if r.Resource == nil && !a.isNull {
+				r.Resource = []ImplementationGuideDefinitionResource{}
+			}
+		case "page":
+			if d.null() {
+				r.Page = nil
+			} else {
+				var v ImplementationGuideDefinitionPage
+				v.decodeJSON(d)
+				r.Page = &v
+			}
+		case "parameter":
+			a := d.array()
+			r.Parameter = nil
+			for a.next() {
+				var v ImplementationGuideDefinitionParameter
+				v.decodeJSON(d)
+				r.Parameter = append(r.Parameter, v)
+			}
+			if r.Parameter == nil && !a.isNull {
+				r.Parameter = []ImplementationGuideDefinitionParameter{}
+			}
+		case "template":
+			a := d.array()
+			r.Template = nil
+			for a.next() {
+				var v ImplementationGuideDefinitionTemplate
+				v.decodeJSON(d)
+				r.Template = append(r.Template, v)
+			}
+			if r.Template == nil && !a.isNull {
+				r.Template = []ImplementationGuideDefinitionTemplate{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideDefinition which shares no memory with the original
+func (r ImplementationGuideDefinition) DeepCopy() ImplementationGuideDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Grouping != nil {
+		out.Grouping = make([]ImplementationGuideDefinitionGrouping, len(r.Grouping))
+		for i := range r.Grouping {
+			out.Grouping[i] = r.Grouping[i].DeepCopy()
+		}
+	}
+	if r.Resource != nil {
+		out.Resource = make([]ImplementationGuideDefinitionResource, len(r.Resource))
+		for i := range r.Resource {
+			out.Resource[i] = r.Resource[i].DeepCopy()
+		}
+	}
+	if r.Page != nil {
+		v := r.Page.DeepCopy()
+		out.Page = &v
+	}
+	if r.Parameter != nil {
+		out.Parameter = make([]ImplementationGuideDefinitionParameter, len(r.Parameter))
+		for i := range r.Parameter {
+			out.Parameter[i] = r.Parameter[i].DeepCopy()
+		}
+	}
+	if r.Template != nil {
+		out.Template = make([]ImplementationGuideDefinitionTemplate, len(r.Template))
+		for i := range r.Template {
+			out.Template[i] = r.Template[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideDefinition) Equal(other ImplementationGuideDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Grouping) != len(other.Grouping) {
+		return false
+	}
+	for i := range r.Grouping {
+		if !r.Grouping[i].Equal(other.Grouping[i]) {
+			return false
+		}
+	}
+	if len(r.Resource) != len(other.Resource) {
+		return false
+	}
+	for i := range r.Resource {
+		if !r.Resource[i].Equal(other.Resource[i]) {
+			return false
+		}
+	}
+	if (r.Page == nil) != (other.Page == nil) || r.Page != nil && !r.Page.Equal(*other.Page) {
+		return false
+	}
+	if len(r.Parameter) != len(other.Parameter) {
+		return false
+	}
+	for i := range r.Parameter {
+		if !r.Parameter[i].Equal(other.Parameter[i]) {
+			return false
+		}
+	}
+	if len(r.Template) != len(other.Template) {
+		return false
+	}
+	for i := range r.Template {
+		if !r.Template[i].Equal(other.Template[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideDefinition) EqualsDeep(other ImplementationGuideDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Grouping), len(other.Grouping), func(i, j int) bool {
+		return r.Grouping[i].EqualsDeep(other.Grouping[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Resource), len(other.Resource), func(i, j int) bool {
+		return r.Resource[i].EqualsDeep(other.Resource[j])
+	}) {
+		return false
+	}
+	if (r.Page == nil) != (other.Page == nil) || r.Page != nil && !r.Page.EqualsDeep(*other.Page) {
+		return false
+	}
+	if !equivalentList(len(r.Parameter), len(other.Parameter), func(i, j int) bool {
+		return r.Parameter[i].EqualsDeep(other.Parameter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Template), len(other.Template), func(i, j int) bool {
+		return r.Template[i].EqualsDeep(other.Template[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideDefinition) EqualsShallow(other ImplementationGuideDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideDefinition as FHIR XML
+func (r ImplementationGuideDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Grouping {
+		if err := encodeXMLElement(e, "grouping", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Resource {
+		if err := encodeXMLElement(e, "resource", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "page", r.Page); err != nil {
+		return err
+	}
+	for _, v := range r.Parameter {
+		if err := encodeXMLElement(e, "parameter", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Template {
+		if err := encodeXMLElement(e, "template", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideDefinition from FHIR XML
+func (r *ImplementationGuideDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "grouping":
+				var v ImplementationGuideDefinitionGrouping
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Grouping = append(r.Grouping, v)
+			case "resource":
+				var v ImplementationGuideDefinitionResource
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Resource = append(r.Resource, v)
+			case "page":
+				var v ImplementationGuideDefinitionPage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Page = &v
+			case "parameter":
+				var v ImplementationGuideDefinitionParameter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parameter = append(r.Parameter, v)
+			case "template":
+				var v ImplementationGuideDefinitionTemplate
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Template = append(r.Template, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideDefinition as properties to the RDF node n
+func (r ImplementationGuideDefinition) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Grouping {
+		n.element("ImplementationGuide.definition.grouping", i, v)
+	}
+	for i, v := range r.Resource {
+		n.element("ImplementationGuide.definition.resource", i, v)
+	}
+	n.element("ImplementationGuide.definition.page", -1, r.Page)
+	for i, v := range r.Parameter {
+		n.element("ImplementationGuide.definition.parameter", i, v)
+	}
+	for i, v := range r.Template {
+		n.element("ImplementationGuide.definition.template", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ImplementationGuideDefinition as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideDefinition from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideDefinition as protobuf message ImplementationGuideDefinition of fhir.proto
+func (r ImplementationGuideDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideDefinition to the encoded message
+func (r ImplementationGuideDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Grouping {
+		e.message(4, v)
+	}
+	for _, v := range r.Resource {
+		e.message(5, v)
+	}
+	if r.Page != nil {
+		e.message(6, *r.Page)
+	}
+	for _, v := range r.Parameter {
+		e.message(7, v)
+	}
+	for _, v := range r.Template {
+		e.message(8, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideDefinition from protobuf message ImplementationGuideDefinition of fhir.proto
+func (r *ImplementationGuideDefinition) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ImplementationGuideDefinitionGrouping
+			d.message(&v)
+			r.Grouping = append(r.Grouping, v)
+		case 5:
+			var v ImplementationGuideDefinitionResource
+			d.message(&v)
+			r.Resource = append(r.Resource, v)
+		case 6:
+			var v ImplementationGuideDefinitionPage
+			d.message(&v)
+			r.Page = &v
+		case 7:
+			var v ImplementationGuideDefinitionParameter
+			d.message(&v)
+			r.Parameter = append(r.Parameter, v)
+		case 8:
+			var v ImplementationGuideDefinitionTemplate
+			d.message(&v)
+			r.Template = append(r.Template, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideDefinitionGrouping as JSON into a byte slice
+func (r ImplementationGuideDefinitionGrouping) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideDefinitionGrouping as JSON object with the members in the order of the fields
+func (r ImplementationGuideDefinitionGrouping) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideDefinitionGrouping from JSON. Unknown members are ignored.
+func (r *ImplementationGuideDefinitionGrouping) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideDefinitionGrouping
+func (r *ImplementationGuideDefinitionGrouping) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideDefinitionGrouping which shares no memory with the original
+func (r ImplementationGuideDefinitionGrouping) DeepCopy() ImplementationGuideDefinitionGrouping {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideDefinitionGrouping) Equal(other ImplementationGuideDefinitionGrouping) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideDefinitionGrouping) EqualsDeep(other ImplementationGuideDefinitionGrouping) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideDefinitionGrouping) EqualsShallow(other ImplementationGuideDefinitionGrouping) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideDefinitionGrouping as FHIR XML
+func (r ImplementationGuideDefinitionGrouping) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideDefinitionGrouping from FHIR XML
+func (r *ImplementationGuideDefinitionGrouping) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideDefinitionGrouping as properties to the RDF node n
+func (r ImplementationGuideDefinitionGrouping) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImplementationGuide.definition.grouping.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ImplementationGuide.definition.grouping.description", -1, r.Description, r.DescriptionElement, "string")
+}
+
+// MarshalBSON marshals the given ImplementationGuideDefinitionGrouping as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideDefinitionGrouping) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideDefinitionGrouping from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideDefinitionGrouping) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideDefinitionGrouping as protobuf message ImplementationGuideDefinitionGrouping of fhir.proto
+func (r ImplementationGuideDefinitionGrouping) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideDefinitionGrouping to the encoded message
+func (r ImplementationGuideDefinitionGrouping) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Description != nil {
+		e.string(5, *r.Description)
+	}
+	if r.NameElement != nil {
+		e.message(6, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(7, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideDefinitionGrouping from protobuf message ImplementationGuideDefinitionGrouping of fhir.proto
+func (r *ImplementationGuideDefinitionGrouping) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideDefinitionGrouping{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.Description = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideDefinitionResource as JSON into a byte slice
+func (r ImplementationGuideDefinitionResource) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideDefinitionResource as JSON object with the members in the order of the fields
+func (r ImplementationGuideDefinitionResource) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("reference")
+	r.Reference.appendJSON(e)
+	if len(r.FhirVersion) > 0 {
+		e.key("fhirVersion")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.FhirVersion {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.FhirVersionElement) > 0 {
+		e.key("_fhirVersion")
+		e.elements(r.FhirVersionElement, len(r.FhirVersion))
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.ExampleBoolean != nil {
+		e.key("exampleBoolean")
+		e.bool(*r.ExampleBoolean)
+	}
+	if r.ExampleBooleanElement != nil {
+		e.key("_exampleBoolean")
+		r.ExampleBooleanElement.appendJSON(e)
+	}
+	if r.ExampleCanonical != nil {
+		e.key("exampleCanonical")
+		e.string(*r.ExampleCanonical)
+	}
+	if r.ExampleCanonicalElement != nil {
+		e.key("_exampleCanonical")
+		r.ExampleCanonicalElement.appendJSON(e)
+	}
+	if r.GroupingId != nil {
+		e.key("groupingId")
+		e.string(*r.GroupingId)
+	}
+	if r.GroupingIdElement != nil {
+		e.key("_groupingId")
+		r.GroupingIdElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideDefinitionResource from JSON. Unknown members are ignored.
+func (r *ImplementationGuideDefinitionResource) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideDefinitionResource
+func (r *ImplementationGuideDefinitionResource) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "reference":
+			r.Reference.decodeJSON(d)
+		case "fhirVersion":
+			a := d.array()
+			r.FhirVersion = nil
+			for a.next() {
+				var v FHIRVersion
+				d.unmarshal(&v)
+				r.FhirVersion = append(r.FhirVersion, v)
+			}
+			if r.FhirVersion == nil && !a.isNull {
+				r.FhirVersion = []FHIRVersion{}
+			}
+		case "_fhirVersion":
+			a := d.array()
+			r.FhirVersionElement = nil
+			for a.next() {
+				r.FhirVersionElement = append(r.FhirVersionElement, d.element())
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "exampleBoolean":
+			if v, ok := d.bool(); ok {
+				r.ExampleBoolean = &v
+			} else {
+				r.ExampleBoolean = nil
+			}
+		case "_exampleBoolean":
+			r.ExampleBooleanElement = d.element()
+		case "exampleCanonical":
+			if v, ok := d.string(); ok {
+				r.ExampleCanonical = &v
+			} else {
+				r.ExampleCanonical = nil
+			}
+		case "_exampleCanonical":
+			r.ExampleCanonicalElement = d.element()
+		case "groupingId":
+			if v, ok := d.string(); ok {
+				r.GroupingId = &v
+			} else {
+				r.GroupingId = nil
+			}
+		case "_groupingId":
+			r.GroupingIdElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideDefinitionResource which shares no memory with the original
+func (r ImplementationGuideDefinitionResource) DeepCopy() ImplementationGuideDefinitionResource {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Reference = r.Reference.DeepCopy()
+	if r.FhirVersion != nil {
+		out.FhirVersion = make([]FHIRVersion, len(r.FhirVersion))
+		copy(out.FhirVersion, r.FhirVersion)
+	}
+	if r.FhirVersionElement != nil {
+		out.FhirVersionElement = make([]*Element, len(r.FhirVersionElement))
+		for i, v := range r.FhirVersionElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.FhirVersionElement[i] = &c
+			}
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.ExampleBoolean != nil {
+		v := *r.ExampleBoolean
+		out.ExampleBoolean = &v
+	}
+	if r.ExampleBooleanElement != nil {
+		v := r.ExampleBooleanElement.DeepCopy()
+		out.ExampleBooleanElement = &v
+	}
+	if r.ExampleCanonical != nil {
+		v := *r.ExampleCanonical
+		out.ExampleCanonical = &v
+	}
+	if r.ExampleCanonicalElement != nil {
+		v := r.ExampleCanonicalElement.DeepCopy()
+		out.ExampleCanonicalElement = &v
+	}
+	if r.GroupingId != nil {
+		v := *r.GroupingId
+		out.GroupingId = &v
+	}
+	if r.GroupingIdElement != nil {
+		v := r.GroupingIdElement.DeepCopy()
+		out.GroupingIdElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideDefinitionResource) Equal(other ImplementationGuideDefinitionResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Reference.Equal(other.Reference) {
+		return false
+	}
+	if len(r.FhirVersion) != len(other.FhirVersion) {
+		return false
+	}
+	for i := range r.FhirVersion {
+		if r.FhirVersion[i] != other.FhirVersion[i] {
+			return false
+		}
+	}
+	if !equalElements(r.FhirVersionElement, other.FhirVersionElement, false) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.ExampleBoolean == nil) != (other.ExampleBoolean == nil) || r.ExampleBoolean != nil && *r.ExampleBoolean != *other.ExampleBoolean {
+		return false
+	}
+	if (r.ExampleBooleanElement == nil) != (other.ExampleBooleanElement == nil) || r.ExampleBooleanElement != nil && !r.ExampleBooleanElement.Equal(*other.ExampleBooleanElement) {
+		return false
+	}
+	if (r.ExampleCanonical == nil) != (other.ExampleCanonical == nil) || r.ExampleCanonical != nil && *r.ExampleCanonical != *other.ExampleCanonical {
+		return false
+	}
+	if (r.ExampleCanonicalElement == nil) != (other.ExampleCanonicalElement == nil) || r.ExampleCanonicalElement != nil && !r.ExampleCanonicalElement.Equal(*other.ExampleCanonicalElement) {
+		return false
+	}
+	if (r.GroupingId == nil) != (other.GroupingId == nil) || r.GroupingId != nil && *r.GroupingId != *other.GroupingId {
+		return false
+	}
+	if (r.GroupingIdElement == nil) != (other.GroupingIdElement == nil) || r.GroupingIdElement != nil && !r.GroupingIdElement.Equal(*other.GroupingIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideDefinitionResource) EqualsDeep(other ImplementationGuideDefinitionResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Reference.EqualsDeep(other.Reference) {
+		return false
+	}
+	if !equivalentList(len(r.FhirVersion), len(other.FhirVersion), func(i, j int) bool {
+		return r.FhirVersion[i] == other.FhirVersion[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.FhirVersionElement, other.FhirVersionElement, true) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.ExampleBoolean == nil) != (other.ExampleBoolean == nil) || r.ExampleBoolean != nil && *r.ExampleBoolean != *other.ExampleBoolean {
+		return false
+	}
+	if (r.ExampleBooleanElement == nil) != (other.ExampleBooleanElement == nil) || r.ExampleBooleanElement != nil && !r.ExampleBooleanElement.EqualsDeep(*other.ExampleBooleanElement) {
+		return false
+	}
+	if (r.ExampleCanonical == nil) != (other.ExampleCanonical == nil) || r.ExampleCanonical != nil && !equivalentString(*r.ExampleCanonical, *other.ExampleCanonical) {
+		return false
+	}
+	if (r.ExampleCanonicalElement == nil) != (other.ExampleCanonicalElement == nil) || r.ExampleCanonicalElement != nil && !r.ExampleCanonicalElement.EqualsDeep(*other.ExampleCanonicalElement) {
+		return false
+	}
+	if (r.GroupingId == nil) != (other.GroupingId == nil) || r.GroupingId != nil && !equivalentString(*r.GroupingId, *other.GroupingId) {
+		return false
+	}
+	if (r.GroupingIdElement == nil) != (other.GroupingIdElement == nil) || r.GroupingIdElement != nil && !r.GroupingIdElement.EqualsDeep(*other.GroupingIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideDefinitionResource) EqualsShallow(other ImplementationGuideDefinitionResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.FhirVersion), len(other.FhirVersion), func(i, j int) bool {
+		return r.FhirVersion[i] == other.FhirVersion[j]
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.ExampleBoolean == nil) != (other.ExampleBoolean == nil) || r.ExampleBoolean != nil && *r.ExampleBoolean != *other.ExampleBoolean {
+		return false
+	}
+	if (r.ExampleCanonical == nil) != (other.ExampleCanonical == nil) || r.ExampleCanonical != nil && !equivalentString(*r.ExampleCanonical, *other.ExampleCanonical) {
+		return false
+	}
+	if (r.GroupingId == nil) != (other.GroupingId == nil) || r.GroupingId != nil && !equivalentString(*r.GroupingId, *other.GroupingId) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideDefinitionResource as FHIR XML
+func (r ImplementationGuideDefinitionResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "reference", r.Reference); err != nil {
+		return err
+	}
+	for i, v := range r.FhirVersion {
+		if err := encodeXMLPrimitive(e, "fhirVersion", v, elementAt(r.FhirVersionElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "exampleBoolean", r.ExampleBoolean, r.ExampleBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "exampleCanonical", r.ExampleCanonical, r.ExampleCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "groupingId", r.GroupingId, r.GroupingIdElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideDefinitionResource from FHIR XML
+func (r *ImplementationGuideDefinitionResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "reference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = v
+			case "fhirVersion":
+				var v FHIRVersion
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.FhirVersionElement = appendElement(r.FhirVersionElement, len(r.FhirVersion), element)
+					r.FhirVersion = append(r.FhirVersion, v)
+				}
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "exampleBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ExampleBoolean = &v
+				}
+				r.ExampleBooleanElement = element
+			case "exampleCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ExampleCanonical = &v
+				}
+				r.ExampleCanonicalElement = element
+			case "groupingId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.GroupingId = &v
+				}
+				r.GroupingIdElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideDefinitionResource as properties to the RDF node n
+func (r ImplementationGuideDefinitionResource) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ImplementationGuide.definition.resource.reference", -1, r.Reference)
+	for i, v := range r.FhirVersion {
+		n.primitive("ImplementationGuide.definition.resource.fhirVersion", i, v, elementAt(r.FhirVersionElement, i), "code")
+	}
+	n.primitive("ImplementationGuide.definition.resource.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ImplementationGuide.definition.resource.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("ImplementationGuide.definition.resource.exampleBoolean", -1, r.ExampleBoolean, r.ExampleBooleanElement, "boolean")
+	n.primitive("ImplementationGuide.definition.resource.exampleCanonical", -1, r.ExampleCanonical, r.ExampleCanonicalElement, "canonical")
+	n.primitive("ImplementationGuide.definition.resource.groupingId", -1, r.GroupingId, r.GroupingIdElement, "string")
+}
+
+// MarshalBSON marshals the given ImplementationGuideDefinitionResource as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideDefinitionResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideDefinitionResource from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideDefinitionResource) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideDefinitionResource as protobuf message ImplementationGuideDefinitionResource of fhir.proto
+func (r ImplementationGuideDefinitionResource) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideDefinitionResource to the encoded message
+func (r ImplementationGuideDefinitionResource) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Reference)
+	if len(r.FhirVersion) > 0 {
+		var p protoEncoder
+		for _, v := range r.FhirVersion {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(5, p.buf)
+	}
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.Description != nil {
+		e.string(7, *r.Description)
+	}
+	if r.ExampleBoolean != nil {
+		e.varint(8, protoBool(*r.ExampleBoolean))
+	}
+	if r.ExampleCanonical != nil {
+		e.string(9, *r.ExampleCanonical)
+	}
+	if r.GroupingId != nil {
+		e.string(10, *r.GroupingId)
+	}
+	for _, v := range r.FhirVersionElement {
+		e.element(11, v)
+	}
+	if r.NameElement != nil {
+		e.message(12, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(13, *r.DescriptionElement)
+	}
+	if r.ExampleBooleanElement != nil {
+		e.message(14, *r.ExampleBooleanElement)
+	}
+	if r.ExampleCanonicalElement != nil {
+		e.message(15, *r.ExampleCanonicalElement)
+	}
+	if r.GroupingIdElement != nil {
+		e.message(16, *r.GroupingIdElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideDefinitionResource from protobuf message ImplementationGuideDefinitionResource of fhir.proto
+func (r *ImplementationGuideDefinitionResource) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideDefinitionResource{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Reference = v
+		case 5:
+			for _, n := range d.varints() {
+				var v FHIRVersion
+				d.enum(n, v.setProtoNumber)
+				r.FhirVersion = append(r.FhirVersion, v)
+			}
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			v := d.string()
+			r.Description = &v
+		case 8:
+			v := d.bool()
+			r.ExampleBoolean = &v
+		case 9:
+			v := d.string()
+			r.ExampleCanonical = &v
+		case 10:
+			v := d.string()
+			r.GroupingId = &v
+		case 11:
+			r.FhirVersionElement = append(r.FhirVersionElement, d.element())
+		case 12:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.ExampleBooleanElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ExampleCanonicalElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.GroupingIdElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideDefinitionPage as JSON into a byte slice
+func (r ImplementationGuideDefinitionPage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideDefinitionPage as JSON object with the members in the order of the fields
+func (r ImplementationGuideDefinitionPage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.NameUrl != nil {
+		e.key("nameUrl")
+		e.string(*r.NameUrl)
+	}
+	if r.NameUrlElement != nil {
+		e.key("_nameUrl")
+		r.NameUrlElement.appendJSON(e)
+	}
+	if r.NameReference != nil {
+		e.key("nameReference")
+		r.NameReference.appendJSON(e)
+	}
+	e.key("title")
+	e.string(r.Title)
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("generation")
+	e.string(r.Generation.Code())
+	if r.GenerationElement != nil {
+		e.key("_generation")
+		r.GenerationElement.appendJSON(e)
+	}
+	if len(r.Page) > 0 {
+		e.key("page")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Page {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideDefinitionPage from JSON. Unknown members are ignored.
+func (r *ImplementationGuideDefinitionPage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideDefinitionPage
+func (r *ImplementationGuideDefinitionPage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "nameUrl":
+			if v, ok := d.string(); ok {
+				r.NameUrl = &v
+			} else {
+				r.NameUrl = nil
+			}
+		case "_nameUrl":
+			r.NameUrlElement = d.element()
+		case "nameReference":
+			if d.null() {
+				r.NameReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.NameReference = &v
+			}
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = v
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "generation":
+			d.unmarshal(&r.Generation)
+		case "_generation":
+			r.GenerationElement = d.element()
+		case "page":
+			a := d.array()
+			r.Page = nil
+			for a.next() {
+				var v ImplementationGuideDefinitionPage
+				v.decodeJSON(d)
+				r.Page = append(r.Page, v)
+			}
+			if r.Page == nil && !a.isNull {
+				r.Page = []ImplementationGuideDefinitionPage{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideDefinitionPage which shares no memory with the original
+func (r ImplementationGuideDefinitionPage) DeepCopy() ImplementationGuideDefinitionPage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameUrl != nil {
+		v := *r.NameUrl
+		out.NameUrl = &v
+	}
+	if r.NameUrlElement != nil {
+		v := r.NameUrlElement.DeepCopy()
+		out.NameUrlElement = &v
+	}
+	if r.NameReference != nil {
+		v := r.NameReference.DeepCopy()
+		out.NameReference = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.GenerationElement != nil {
+		v := r.GenerationElement.DeepCopy()
+		out.GenerationElement = &v
+	}
+	if r.Page != nil {
+		out.Page = make([]ImplementationGuideDefinitionPage, len(r.Page))
+		for i := range r.Page {
+			out.Page[i] = r.Page[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideDefinitionPage) Equal(other ImplementationGuideDefinitionPage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.NameUrl == nil) != (other.NameUrl == nil) || r.NameUrl != nil && *r.NameUrl != *other.NameUrl {
+		return false
+	}
+	if (r.NameUrlElement == nil) != (other.NameUrlElement == nil) || r.NameUrlElement != nil && !r.NameUrlElement.Equal(*other.NameUrlElement) {
+		return false
+	}
+	if (r.NameReference == nil) != (other.NameReference == nil) || r.NameReference != nil && !r.NameReference.Equal(*other.NameReference) {
+		return false
+	}
+	if r.Title != other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Generation != other.Generation {
+		return false
+	}
+	if (r.GenerationElement == nil) != (other.GenerationElement == nil) || r.GenerationElement != nil && !r.GenerationElement.Equal(*other.GenerationElement) {
+		return false
+	}
+	if len(r.Page) != len(other.Page) {
+		return false
+	}
+	for i := range r.Page {
+		if !r.Page[i].Equal(other.Page[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideDefinitionPage) EqualsDeep(other ImplementationGuideDefinitionPage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.NameUrl == nil) != (other.NameUrl == nil) || r.NameUrl != nil && !equivalentString(*r.NameUrl, *other.NameUrl) {
+		return false
+	}
+	if (r.NameUrlElement == nil) != (other.NameUrlElement == nil) || r.NameUrlElement != nil && !r.NameUrlElement.EqualsDeep(*other.NameUrlElement) {
+		return false
+	}
+	if (r.NameReference == nil) != (other.NameReference == nil) || r.NameReference != nil && !r.NameReference.EqualsDeep(*other.NameReference) {
+		return false
+	}
+	if !equivalentString(r.Title, other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Generation != other.Generation {
+		return false
+	}
+	if (r.GenerationElement == nil) != (other.GenerationElement == nil) || r.GenerationElement != nil && !r.GenerationElement.EqualsDeep(*other.GenerationElement) {
+		return false
+	}
+	if !equivalentList(len(r.Page), len(other.Page), func(i, j int) bool {
+		return r.Page[i].EqualsDeep(other.Page[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideDefinitionPage) EqualsShallow(other ImplementationGuideDefinitionPage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.NameUrl == nil) != (other.NameUrl == nil) || r.NameUrl != nil && !equivalentString(*r.NameUrl, *other.NameUrl) {
+		return false
+	}
+	if !equivalentString(r.Title, other.Title) {
+		return false
+	}
+	if r.Generation != other.Generation {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideDefinitionPage as FHIR XML
+func (r ImplementationGuideDefinitionPage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "nameUrl", r.NameUrl, r.NameUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "nameReference", r.NameReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "generation", r.Generation, r.GenerationElement); err != nil {
+		return err
+	}
+	for _, v := range r.Page {
+		if err := encodeXMLElement(e, "page", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideDefinitionPage from FHIR XML
+func (r *ImplementationGuideDefinitionPage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "nameUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NameUrl = &v
+				}
+				r.NameUrlElement = element
+			case "nameReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.NameReference = &v
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = v
+				}
+				r.TitleElement = element
+			case "generation":
+				var v GuidePageGeneration
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Generation = v
+				}
+				r.GenerationElement = element
+			case "page":
+				var v ImplementationGuideDefinitionPage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Page = append(r.Page, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideDefinitionPage as properties to the RDF node n
+func (r ImplementationGuideDefinitionPage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImplementationGuide.definition.page.nameUrl", -1, r.NameUrl, r.NameUrlElement, "url")
+	n.element("ImplementationGuide.definition.page.nameReference", -1, r.NameReference)
+	n.primitive("ImplementationGuide.definition.page.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("ImplementationGuide.definition.page.generation", -1, r.Generation, r.GenerationElement, "code")
+	for i, v := range r.Page {
+		n.element("ImplementationGuide.definition.page.page", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ImplementationGuideDefinitionPage as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideDefinitionPage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideDefinitionPage from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideDefinitionPage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideDefinitionPage as protobuf message ImplementationGuideDefinitionPage of fhir.proto
+func (r ImplementationGuideDefinitionPage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideDefinitionPage to the encoded message
+func (r ImplementationGuideDefinitionPage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.NameUrl != nil {
+		e.string(4, *r.NameUrl)
+	}
+	if r.NameReference != nil {
+		e.message(5, *r.NameReference)
+	}
+	if r.Title != "" {
+		e.string(6, r.Title)
+	}
+	e.varint(7, r.Generation.protoNumber())
+	for _, v := range r.Page {
+		e.message(8, v)
+	}
+	if r.NameUrlElement != nil {
+		e.message(9, *r.NameUrlElement)
+	}
+	if r.TitleElement != nil {
+		e.message(10, *r.TitleElement)
+	}
+	if r.GenerationElement != nil {
+		e.message(11, *r.GenerationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideDefinitionPage from protobuf message ImplementationGuideDefinitionPage of fhir.proto
+func (r *ImplementationGuideDefinitionPage) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideDefinitionPage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.NameUrl = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.NameReference = &v
+		case 6:
+			r.Title = d.string()
+		case 7:
+			var v GuidePageGeneration
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Generation = v
+		case 8:
+			var v ImplementationGuideDefinitionPage
+			d.message(&v)
+			r.Page = append(r.Page, v)
+		case 9:
+			var v Element
+			d.message(&v)
+			r.NameUrlElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.GenerationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideDefinitionParameter as JSON into a byte slice
+func (r ImplementationGuideDefinitionParameter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideDefinitionParameter as JSON object with the members in the order of the fields
+func (r ImplementationGuideDefinitionParameter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideDefinitionParameter from JSON. Unknown members are ignored.
+func (r *ImplementationGuideDefinitionParameter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideDefinitionParameter
+func (r *ImplementationGuideDefinitionParameter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideDefinitionParameter which shares no memory with the original
+func (r ImplementationGuideDefinitionParameter) DeepCopy() ImplementationGuideDefinitionParameter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideDefinitionParameter) Equal(other ImplementationGuideDefinitionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideDefinitionParameter) EqualsDeep(other ImplementationGuideDefinitionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideDefinitionParameter) EqualsShallow(other ImplementationGuideDefinitionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideDefinitionParameter as FHIR XML
+func (r ImplementationGuideDefinitionParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideDefinitionParameter from FHIR XML
+func (r *ImplementationGuideDefinitionParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v GuideParameterCode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideDefinitionParameter as properties to the RDF node n
+func (r ImplementationGuideDefinitionParameter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImplementationGuide.definition.parameter.code", -1, r.Code, r.CodeElement, "code")
+	n.primitive("ImplementationGuide.definition.parameter.value", -1, r.Value, r.ValueElement, "string")
+}
+
+// MarshalBSON marshals the given ImplementationGuideDefinitionParameter as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideDefinitionParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideDefinitionParameter from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideDefinitionParameter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideDefinitionParameter as protobuf message ImplementationGuideDefinitionParameter of fhir.proto
+func (r ImplementationGuideDefinitionParameter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideDefinitionParameter to the encoded message
+func (r ImplementationGuideDefinitionParameter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Code.protoNumber())
+	if r.Value != "" {
+		e.string(5, r.Value)
+	}
+	if r.CodeElement != nil {
+		e.message(6, *r.CodeElement)
+	}
+	if r.ValueElement != nil {
+		e.message(7, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideDefinitionParameter from protobuf message ImplementationGuideDefinitionParameter of fhir.proto
+func (r *ImplementationGuideDefinitionParameter) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideDefinitionParameter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v GuideParameterCode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 5:
+			r.Value = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideDefinitionTemplate as JSON into a byte slice
+func (r ImplementationGuideDefinitionTemplate) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideDefinitionTemplate as JSON object with the members in the order of the fields
+func (r ImplementationGuideDefinitionTemplate) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	e.key("source")
+	e.string(r.Source)
+	if r.SourceElement != nil {
+		e.key("_source")
+		r.SourceElement.appendJSON(e)
+	}
+	if r.Scope != nil {
+		e.key("scope")
+		e.string(*r.Scope)
+	}
+	if r.ScopeElement != nil {
+		e.key("_scope")
+		r.ScopeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideDefinitionTemplate from JSON. Unknown members are ignored.
+func (r *ImplementationGuideDefinitionTemplate) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideDefinitionTemplate
+func (r *ImplementationGuideDefinitionTemplate) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "source":
+			if v, ok := d.string(); ok {
+				r.Source = v
+			}
+		case "_source":
+			r.SourceElement = d.element()
+		case "scope":
+			if v, ok := d.string(); ok {
+				r.Scope = &v
+			} else {
+				r.Scope = nil
+			}
+		case "_scope":
+			r.ScopeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideDefinitionTemplate which shares no memory with the original
+func (r ImplementationGuideDefinitionTemplate) DeepCopy() ImplementationGuideDefinitionTemplate {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.SourceElement != nil {
+		v := r.SourceElement.DeepCopy()
+		out.SourceElement = &v
+	}
+	if r.Scope != nil {
+		v := *r.Scope
+		out.Scope = &v
+	}
+	if r.ScopeElement != nil {
+		v := r.ScopeElement.DeepCopy()
+		out.ScopeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideDefinitionTemplate) Equal(other ImplementationGuideDefinitionTemplate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if r.Source != other.Source {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.Equal(*other.SourceElement) {
+		return false
+	}
+	if (r.Scope == nil) != (other.Scope == nil) || r.Scope != nil && *r.Scope != *other.Scope {
+		return false
+	}
+	if (r.ScopeElement == nil) != (other.ScopeElement == nil) || r.ScopeElement != nil && !r.ScopeElement.Equal(*other.ScopeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideDefinitionTemplate) EqualsDeep(other ImplementationGuideDefinitionTemplate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if !equivalentString(r.Source, other.Source) {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.EqualsDeep(*other.SourceElement) {
+		return false
+	}
+	if (r.Scope == nil) != (other.Scope == nil) || r.Scope != nil && !equivalentString(*r.Scope, *other.Scope) {
+		return false
+	}
+	if (r.ScopeElement == nil) != (other.ScopeElement == nil) || r.ScopeElement != nil && !r.ScopeElement.EqualsDeep(*other.ScopeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideDefinitionTemplate) EqualsShallow(other ImplementationGuideDefinitionTemplate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if !equivalentString(r.Source, other.Source) {
+		return false
+	}
+	if (r.Scope == nil) != (other.Scope == nil) || r.Scope != nil && !equivalentString(*r.Scope, *other.Scope) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideDefinitionTemplate as FHIR XML
+func (r ImplementationGuideDefinitionTemplate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "source", r.Source, r.SourceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "scope", r.Scope, r.ScopeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideDefinitionTemplate from FHIR XML
+func (r *ImplementationGuideDefinitionTemplate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "source":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Source = v
+				}
+				r.SourceElement = element
+			case "scope":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Scope = &v
+				}
+				r.ScopeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideDefinitionTemplate as properties to the RDF node n
+func (r ImplementationGuideDefinitionTemplate) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImplementationGuide.definition.template.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("ImplementationGuide.definition.template.source", -1, r.Source, r.SourceElement, "string")
+	n.primitive("ImplementationGuide.definition.template.scope", -1, r.Scope, r.ScopeElement, "string")
+}
+
+// MarshalBSON marshals the given ImplementationGuideDefinitionTemplate as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideDefinitionTemplate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideDefinitionTemplate from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideDefinitionTemplate) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideDefinitionTemplate as protobuf message ImplementationGuideDefinitionTemplate of fhir.proto
+func (r ImplementationGuideDefinitionTemplate) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideDefinitionTemplate to the encoded message
+func (r ImplementationGuideDefinitionTemplate) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != "" {
+		e.string(4, r.Code)
+	}
+	if r.Source != "" {
+		e.string(5, r.Source)
+	}
+	if r.Scope != nil {
+		e.string(6, *r.Scope)
+	}
+	if r.CodeElement != nil {
+		e.message(7, *r.CodeElement)
+	}
+	if r.SourceElement != nil {
+		e.message(8, *r.SourceElement)
+	}
+	if r.ScopeElement != nil {
+		e.message(9, *r.ScopeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideDefinitionTemplate from protobuf message ImplementationGuideDefinitionTemplate of fhir.proto
+func (r *ImplementationGuideDefinitionTemplate) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideDefinitionTemplate{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Code = d.string()
+		case 5:
+			r.Source = d.string()
+		case 6:
+			v := d.string()
+			r.Scope = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.SourceElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ScopeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideManifest as JSON into a byte slice
+func (r ImplementationGuideManifest) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideManifest as JSON object with the members in the order of the fields
+func (r ImplementationGuideManifest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Rendering != nil {
+		e.key("rendering")
+		e.string(*r.Rendering)
+	}
+	if r.RenderingElement != nil {
+		e.key("_rendering")
+		r.RenderingElement.appendJSON(e)
+	}
+	e.key("resource")
+	if r.Resource == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Resource {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Page) > 0 {
+		e.key("page")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Page {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Image) > 0 {
+		e.key("image")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Image {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ImageElement) > 0 {
+		e.key("_image")
+		e.elements(r.ImageElement, len(r.Image))
+	}
+	if len(r.Other) > 0 {
+		e.key("other")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Other {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.OtherElement) > 0 {
+		e.key("_other")
+		e.elements(r.OtherElement, len(r.Other))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideManifest from JSON. Unknown members are ignored.
+func (r *ImplementationGuideManifest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideManifest
+func (r *ImplementationGuideManifest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "rendering":
+			if v, ok := d.string(); ok {
+				r.Rendering = &v
+			} else {
+				r.Rendering = nil
+			}
+		case "_rendering":
+			r.RenderingElement = d.element()
+		case "resource":
+			a := d.array()
+			r.Resource = nil
+			for a.next() {
+				var v ImplementationGuideManifestResource
+				v.decodeJSON(d)
+				r.Resource = append(r.Resource, v)
+			}
+			if r.Resource == nil && !a.isNull {
+				r.Resource = []ImplementationGuideManifestResource{}
+			}
+		case "page":
+			a := d.array()
+			r.Page = nil
+			for a.next() {
+				var v ImplementationGuideManifestPage
+				v.decodeJSON(d)
+				r.Page = append(r.Page, v)
+			}
+			if r.Page == nil && !a.isNull {
+				r.Page = []ImplementationGuideManifestPage{}
+			}
+		case "image":
+			a := d.array()
+			r.Image = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Image = append(r.Image, v)
+			}
+			if r.Image == nil && !a.isNull {
+				r.Image = []string{}
+			}
+		case "_image":
+			a := d.array()
+			r.ImageElement = nil
+			for a.next() {
+				r.ImageElement = append(r.ImageElement, d.element())
+			}
+		case "other":
+			a := d.array()
+			r.Other = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Other = append(r.Other, v)
+			}
+			if r.Other == nil && !a.isNull {
+				r.Other = []string{}
+			}
+		case "_other":
+			a := d.array()
+			r.OtherElement = nil
+			for a.next() {
+				r.OtherElement = append(r.OtherElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideManifest which shares no memory with the original
+func (r ImplementationGuideManifest) DeepCopy() ImplementationGuideManifest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Rendering != nil {
+		v := *r.Rendering
+		out.Rendering = &v
+	}
+	if r.RenderingElement != nil {
+		v := r.RenderingElement.DeepCopy()
+		out.RenderingElement = &v
+	}
+	if r.Resource != nil {
+		out.Resource = make([]ImplementationGuideManifestResource, len(r.Resource))
+		for i := range r.Resource {
+			out.Resource[i] = r.Resource[i].DeepCopy()
+		}
+	}
+	if r.Page != nil {
+		out.Page = make([]ImplementationGuideManifestPage, len(r.Page))
+		for i := range r.Page {
+			out.Page[i] = r.Page[i].DeepCopy()
+		}
+	}
+	if r.Image != nil {
+		out.Image = make([]string, len(r.Image))
+		copy(out.Image, r.Image)
+	}
+	if r.ImageElement != nil {
+		out.ImageElement = make([]*Element, len(r.ImageElement))
+		for i, v := range r.ImageElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ImageElement[i] = &c
+			}
+		}
+	}
+	if r.Other != nil {
+		out.Other = make([]string, len(r.Other))
+		copy(out.Other, r.Other)
+	}
+	if r.OtherElement != nil {
+		out.OtherElement = make([]*Element, len(r.OtherElement))
+		for i, v := range r.OtherElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.OtherElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideManifest) Equal(other ImplementationGuideManifest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Rendering == nil) != (other.Rendering == nil) || r.Rendering != nil && *r.Rendering != *other.Rendering {
+		return false
+	}
+	if (r.RenderingElement == nil) != (other.RenderingElement == nil) || r.RenderingElement != nil && !r.RenderingElement.Equal(*other.RenderingElement) {
+		return false
+	}
+	if len(r.Resource) != len(other.Resource) {
+		return false
+	}
+	for i := range r.Resource {
+		if !r.Resource[i].Equal(other.Resource[i]) {
+			return false
+		}
+	}
+	if len(r.Page) != len(other.Page) {
+		return false
+	}
+	for i := range r.Page {
+		if !r.Page[i].Equal(other.Page[i]) {
+			return false
+		}
+	}
+	if len(r.Image) != len(other.Image) {
+		return false
+	}
+	for i := range r.Image {
+		if r.Image[i] != other.Image[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ImageElement, other.ImageElement, false) {
+		return false
+	}
+	if len(r.Other) != len(other.Other) {
+		return false
+	}
+	for i := range r.Other {
+		if r.Other[i] != other.Other[i] {
+			return false
+		}
+	}
+	if !equalElements(r.OtherElement, other.OtherElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideManifest) EqualsDeep(other ImplementationGuideManifest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Rendering == nil) != (other.Rendering == nil) || r.Rendering != nil && !equivalentString(*r.Rendering, *other.Rendering) {
+		return false
+	}
+	if (r.RenderingElement == nil) != (other.RenderingElement == nil) || r.RenderingElement != nil && !r.RenderingElement.EqualsDeep(*other.RenderingElement) {
+		return false
+	}
+	if !equivalentList(len(r.Resource), len(other.Resource), func(i, j int) bool {
+		return r.Resource[i].EqualsDeep(other.Resource[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Page), len(other.Page), func(i, j int) bool {
+		return r.Page[i].EqualsDeep(other.Page[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Image), len(other.Image), func(i, j int) bool {
+		return equivalentString(r.Image[i], other.Image[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ImageElement, other.ImageElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Other), len(other.Other), func(i, j int) bool {
+		return equivalentString(r.Other[i], other.Other[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.OtherElement, other.OtherElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideManifest) EqualsShallow(other ImplementationGuideManifest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Rendering == nil) != (other.Rendering == nil) || r.Rendering != nil && !equivalentString(*r.Rendering, *other.Rendering) {
+		return false
+	}
+	if !equivalentList(len(r.Image), len(other.Image), func(i, j int) bool {
+		return equivalentString(r.Image[i], other.Image[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Other), len(other.Other), func(i, j int) bool {
+		return equivalentString(r.Other[i], other.Other[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideManifest as FHIR XML
+func (r ImplementationGuideManifest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "rendering", r.Rendering, r.RenderingElement); err != nil {
+		return err
+	}
+	for _, v := range r.Resource {
+		if err := encodeXMLElement(e, "resource", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Page {
+		if err := encodeXMLElement(e, "page", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Image {
+		if err := encodeXMLPrimitive(e, "image", v, elementAt(r.ImageElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Other {
+		if err := encodeXMLPrimitive(e, "other", v, elementAt(r.OtherElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideManifest from FHIR XML
+func (r *ImplementationGuideManifest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "rendering":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Rendering = &v
+				}
+				r.RenderingElement = element
+			case "resource":
+				var v ImplementationGuideManifestResource
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Resource = append(r.Resource, v)
+			case "page":
+				var v ImplementationGuideManifestPage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Page = append(r.Page, v)
+			case "image":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ImageElement = appendElement(r.ImageElement, len(r.Image), element)
+					r.Image = append(r.Image, v)
+				}
+			case "other":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.OtherElement = appendElement(r.OtherElement, len(r.Other), element)
+					r.Other = append(r.Other, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideManifest as properties to the RDF node n
+func (r ImplementationGuideManifest) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImplementationGuide.manifest.rendering", -1, r.Rendering, r.RenderingElement, "string")
+	for i, v := range r.Resource {
+		n.element("ImplementationGuide.manifest.resource", i, v)
+	}
+	for i, v := range r.Page {
+		n.element("ImplementationGuide.manifest.page", i, v)
+	}
+	for i, v := range r.Image {
+		n.primitive("ImplementationGuide.manifest.image", i, v, elementAt(r.ImageElement, i), "string")
+	}
+	for i, v := range r.Other {
+		n.primitive("ImplementationGuide.manifest.other", i, v, elementAt(r.OtherElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given ImplementationGuideManifest as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideManifest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideManifest from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideManifest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideManifest as protobuf message ImplementationGuideManifest of fhir.proto
+func (r ImplementationGuideManifest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideManifest to the encoded message
+func (r ImplementationGuideManifest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Rendering != nil {
+		e.string(4, *r.Rendering)
+	}
+	for _, v := range r.Resource {
+		e.message(5, v)
+	}
+	for _, v := range r.Page {
+		e.message(6, v)
+	}
+	for _, v := range r.Image {
+		e.string(7, v)
+	}
+	for _, v := range r.Other {
+		e.string(8, v)
+	}
+	if r.RenderingElement != nil {
+		e.message(9, *r.RenderingElement)
+	}
+	for _, v := range r.ImageElement {
+		e.element(10, v)
+	}
+	for _, v := range r.OtherElement {
+		e.element(11, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideManifest from protobuf message ImplementationGuideManifest of fhir.proto
+func (r *ImplementationGuideManifest) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideManifest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Rendering = &v
+		case 5:
+			var v ImplementationGuideManifestResource
+			d.message(&v)
+			r.Resource = append(r.Resource, v)
+		case 6:
+			var v ImplementationGuideManifestPage
+			d.message(&v)
+			r.Page = append(r.Page, v)
+		case 7:
+			r.Image = append(r.Image, d.string())
+		case 8:
+			r.Other = append(r.Other, d.string())
+		case 9:
+			var v Element
+			d.message(&v)
+			r.RenderingElement = &v
+		case 10:
+			r.ImageElement = append(r.ImageElement, d.element())
+		case 11:
+			r.OtherElement = append(r.OtherElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideManifestResource as JSON into a byte slice
+func (r ImplementationGuideManifestResource) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideManifestResource as JSON object with the members in the order of the fields
+func (r ImplementationGuideManifestResource) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("reference")
+	r.Reference.appendJSON(e)
+	if r.ExampleBoolean != nil {
+		e.key("exampleBoolean")
+		e.bool(*r.ExampleBoolean)
+	}
+	if r.ExampleBooleanElement != nil {
+		e.key("_exampleBoolean")
+		r.ExampleBooleanElement.appendJSON(e)
+	}
+	if r.ExampleCanonical != nil {
+		e.key("exampleCanonical")
+		e.string(*r.ExampleCanonical)
+	}
+	if r.ExampleCanonicalElement != nil {
+		e.key("_exampleCanonical")
+		r.ExampleCanonicalElement.appendJSON(e)
+	}
+	if r.RelativePath != nil {
+		e.key("relativePath")
+		e.string(*r.RelativePath)
+	}
+	if r.RelativePathElement != nil {
+		e.key("_relativePath")
+		r.RelativePathElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideManifestResource from JSON. Unknown members are ignored.
+func (r *ImplementationGuideManifestResource) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideManifestResource
+func (r *ImplementationGuideManifestResource) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "reference":
+			r.Reference.decodeJSON(d)
+		case "exampleBoolean":
+			if v, ok := d.bool(); ok {
+				r.ExampleBoolean = &v
+			} else {
+				r.ExampleBoolean = nil
+			}
+		case "_exampleBoolean":
+			r.ExampleBooleanElement = d.element()
+		case "exampleCanonical":
+			if v, ok := d.string(); ok {
+				r.ExampleCanonical = &v
+			} else {
+				r.ExampleCanonical = nil
+			}
+		case "_exampleCanonical":
+			r.ExampleCanonicalElement = d.element()
+		case "relativePath":
+			if v, ok := d.string(); ok {
+				r.RelativePath = &v
+			} else {
+				r.RelativePath = nil
+			}
+		case "_relativePath":
+			r.RelativePathElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideManifestResource which shares no memory with the original
+func (r ImplementationGuideManifestResource) DeepCopy() ImplementationGuideManifestResource {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Reference = r.Reference.DeepCopy()
+	if r.ExampleBoolean != nil {
+		v := *r.ExampleBoolean
+		out.ExampleBoolean = &v
+	}
+	if r.ExampleBooleanElement != nil {
+		v := r.ExampleBooleanElement.DeepCopy()
+		out.ExampleBooleanElement = &v
+	}
+	if r.ExampleCanonical != nil {
+		v := *r.ExampleCanonical
+		out.ExampleCanonical = &v
+	}
+	if r.ExampleCanonicalElement != nil {
+		v := r.ExampleCanonicalElement.DeepCopy()
+		out.ExampleCanonicalElement = &v
+	}
+	if r.RelativePath != nil {
+		v := *r.RelativePath
+		out.RelativePath = &v
+	}
+	if r.RelativePathElement != nil {
+		v := r.RelativePathElement.DeepCopy()
+		out.RelativePathElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideManifestResource) Equal(other ImplementationGuideManifestResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Reference.Equal(other.Reference) {
+		return false
+	}
+	if (r.ExampleBoolean == nil) != (other.ExampleBoolean == nil) || r.ExampleBoolean != nil && *r.ExampleBoolean != *other.ExampleBoolean {
+		return false
+	}
+	if (r.ExampleBooleanElement == nil) != (other.ExampleBooleanElement == nil) || r.ExampleBooleanElement != nil && !r.ExampleBooleanElement.Equal(*other.ExampleBooleanElement) {
+		return false
+	}
+	if (r.ExampleCanonical == nil) != (other.ExampleCanonical == nil) || r.ExampleCanonical != nil && *r.ExampleCanonical != *other.ExampleCanonical {
+		return false
+	}
+	if (r.ExampleCanonicalElement == nil) != (other.ExampleCanonicalElement == nil) || r.ExampleCanonicalElement != nil && !r.ExampleCanonicalElement.Equal(*other.ExampleCanonicalElement) {
+		return false
+	}
+	if (r.RelativePath == nil) != (other.RelativePath == nil) || r.RelativePath != nil && *r.RelativePath != *other.RelativePath {
+		return false
+	}
+	if (r.RelativePathElement == nil) != (other.RelativePathElement == nil) || r.RelativePathElement != nil && !r.RelativePathElement.Equal(*other.RelativePathElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideManifestResource) EqualsDeep(other ImplementationGuideManifestResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Reference.EqualsDeep(other.Reference) {
+		return false
+	}
+	if (r.ExampleBoolean == nil) != (other.ExampleBoolean == nil) || r.ExampleBoolean != nil && *r.ExampleBoolean != *other.ExampleBoolean {
+		return false
+	}
+	if (r.ExampleBooleanElement == nil) != (other.ExampleBooleanElement == nil) || r.ExampleBooleanElement != nil && !r.ExampleBooleanElement.EqualsDeep(*other.ExampleBooleanElement) {
+		return false
+	}
+	if (r.ExampleCanonical == nil) != (other.ExampleCanonical == nil) || r.ExampleCanonical != nil && !equivalentString(*r.ExampleCanonical, *other.ExampleCanonical) {
+		return false
+	}
+	if (r.ExampleCanonicalElement == nil) != (other.ExampleCanonicalElement == nil) || r.ExampleCanonicalElement != nil && !r.ExampleCanonicalElement.EqualsDeep(*other.ExampleCanonicalElement) {
+		return false
+	}
+	if (r.RelativePath == nil) != (other.RelativePath == nil) || r.RelativePath != nil && !equivalentString(*r.RelativePath, *other.RelativePath) {
+		return false
+	}
+	if (r.RelativePathElement == nil) != (other.RelativePathElement == nil) || r.RelativePathElement != nil && !r.RelativePathElement.EqualsDeep(*other.RelativePathElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideManifestResource) EqualsShallow(other ImplementationGuideManifestResource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ExampleBoolean == nil) != (other.ExampleBoolean == nil) || r.ExampleBoolean != nil && *r.ExampleBoolean != *other.ExampleBoolean {
+		return false
+	}
+	if (r.ExampleCanonical == nil) != (other.ExampleCanonical == nil) || r.ExampleCanonical != nil && !equivalentString(*r.ExampleCanonical, *other.ExampleCanonical) {
+		return false
+	}
+	if (r.RelativePath == nil) != (other.RelativePath == nil) || r.RelativePath != nil && !equivalentString(*r.RelativePath, *other.RelativePath) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideManifestResource as FHIR XML
+func (r ImplementationGuideManifestResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "reference", r.Reference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "exampleBoolean", r.ExampleBoolean, r.ExampleBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "exampleCanonical", r.ExampleCanonical, r.ExampleCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "relativePath", r.RelativePath, r.RelativePathElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideManifestResource from FHIR XML
+func (r *ImplementationGuideManifestResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "reference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = v
+			case "exampleBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ExampleBoolean = &v
+				}
+				r.ExampleBooleanElement = element
+			case "exampleCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ExampleCanonical = &v
+				}
+				r.ExampleCanonicalElement = element
+			case "relativePath":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RelativePath = &v
+				}
+				r.RelativePathElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideManifestResource as properties to the RDF node n
+func (r ImplementationGuideManifestResource) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ImplementationGuide.manifest.resource.reference", -1, r.Reference)
+	n.primitive("ImplementationGuide.manifest.resource.exampleBoolean", -1, r.ExampleBoolean, r.ExampleBooleanElement, "boolean")
+	n.primitive("ImplementationGuide.manifest.resource.exampleCanonical", -1, r.ExampleCanonical, r.ExampleCanonicalElement, "canonical")
+	n.primitive("ImplementationGuide.manifest.resource.relativePath", -1, r.RelativePath, r.RelativePathElement, "string")
+}
+
+// MarshalBSON marshals the given ImplementationGuideManifestResource as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideManifestResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideManifestResource from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideManifestResource) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideManifestResource as protobuf message ImplementationGuideManifestResource of fhir.proto
+func (r ImplementationGuideManifestResource) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideManifestResource to the encoded message
+func (r ImplementationGuideManifestResource) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Reference)
+	if r.ExampleBoolean != nil {
+		e.varint(5, protoBool(*r.ExampleBoolean))
+	}
+	if r.ExampleCanonical != nil {
+		e.string(6, *r.ExampleCanonical)
+	}
+	if r.RelativePath != nil {
+		e.string(7, *r.RelativePath)
+	}
+	if r.ExampleBooleanElement != nil {
+		e.message(8, *r.ExampleBooleanElement)
+	}
+	if r.ExampleCanonicalElement != nil {
+		e.message(9, *r.ExampleCanonicalElement)
+	}
+	if r.RelativePathElement != nil {
+		e.message(10, *r.RelativePathElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideManifestResource from protobuf message ImplementationGuideManifestResource of fhir.proto
+func (r *ImplementationGuideManifestResource) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideManifestResource{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Reference = v
+		case 5:
+			v := d.bool()
+			r.ExampleBoolean = &v
+		case 6:
+			v := d.string()
+			r.ExampleCanonical = &v
+		case 7:
+			v := d.string()
+			r.RelativePath = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ExampleBooleanElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ExampleCanonicalElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.RelativePathElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ImplementationGuideManifestPage as JSON into a byte slice
+func (r ImplementationGuideManifestPage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ImplementationGuideManifestPage as JSON object with the members in the order of the fields
+func (r ImplementationGuideManifestPage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if len(r.Anchor) > 0 {
+		e.key("anchor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Anchor {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AnchorElement) > 0 {
+		e.key("_anchor")
+		e.elements(r.AnchorElement, len(r.Anchor))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ImplementationGuideManifestPage from JSON. Unknown members are ignored.
+func (r *ImplementationGuideManifestPage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ImplementationGuideManifestPage
+func (r *ImplementationGuideManifestPage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "anchor":
+			a := d.array()
+			r.Anchor = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Anchor = append(r.Anchor, v)
+			}
+			if r.Anchor == nil && !a.isNull {
+				r.Anchor = []string{}
+			}
+		case "_anchor":
+			a := d.array()
+			r.AnchorElement = nil
+			for a.next() {
+				r.AnchorElement = append(r.AnchorElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ImplementationGuideManifestPage which shares no memory with the original
+func (r ImplementationGuideManifestPage) DeepCopy() ImplementationGuideManifestPage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Anchor != nil {
+		out.Anchor = make([]string, len(r.Anchor))
+		copy(out.Anchor, r.Anchor)
+	}
+	if r.AnchorElement != nil {
+		out.AnchorElement = make([]*Element, len(r.AnchorElement))
+		for i, v := range r.AnchorElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.AnchorElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ImplementationGuideManifestPage) Equal(other ImplementationGuideManifestPage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if len(r.Anchor) != len(other.Anchor) {
+		return false
+	}
+	for i := range r.Anchor {
+		if r.Anchor[i] != other.Anchor[i] {
+			return false
+		}
+	}
+	if !equalElements(r.AnchorElement, other.AnchorElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ImplementationGuideManifestPage) EqualsDeep(other ImplementationGuideManifestPage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if !equivalentList(len(r.Anchor), len(other.Anchor), func(i, j int) bool {
+		return equivalentString(r.Anchor[i], other.Anchor[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.AnchorElement, other.AnchorElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ImplementationGuideManifestPage) EqualsShallow(other ImplementationGuideManifestPage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if !equivalentList(len(r.Anchor), len(other.Anchor), func(i, j int) bool {
+		return equivalentString(r.Anchor[i], other.Anchor[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ImplementationGuideManifestPage as FHIR XML
+func (r ImplementationGuideManifestPage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	for i, v := range r.Anchor {
+		if err := encodeXMLPrimitive(e, "anchor", v, elementAt(r.AnchorElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ImplementationGuideManifestPage from FHIR XML
+func (r *ImplementationGuideManifestPage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "anchor":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.AnchorElement = appendElement(r.AnchorElement, len(r.Anchor), element)
+					r.Anchor = append(r.Anchor, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ImplementationGuideManifestPage as properties to the RDF node n
+func (r ImplementationGuideManifestPage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ImplementationGuide.manifest.page.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ImplementationGuide.manifest.page.title", -1, r.Title, r.TitleElement, "string")
+	for i, v := range r.Anchor {
+		n.primitive("ImplementationGuide.manifest.page.anchor", i, v, elementAt(r.AnchorElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given ImplementationGuideManifestPage as BSON document with the structure of its FHIR JSON
+func (r ImplementationGuideManifestPage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ImplementationGuideManifestPage from a BSON document with the structure of its FHIR JSON
+func (r *ImplementationGuideManifestPage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ImplementationGuideManifestPage as protobuf message ImplementationGuideManifestPage of fhir.proto
+func (r ImplementationGuideManifestPage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ImplementationGuideManifestPage to the encoded message
+func (r ImplementationGuideManifestPage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Title != nil {
+		e.string(5, *r.Title)
+	}
+	for _, v := range r.Anchor {
+		e.string(6, v)
+	}
+	if r.NameElement != nil {
+		e.message(7, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(8, *r.TitleElement)
+	}
+	for _, v := range r.AnchorElement {
+		e.element(9, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ImplementationGuideManifestPage from protobuf message ImplementationGuideManifestPage of fhir.proto
+func (r *ImplementationGuideManifestPage) UnmarshalProto(b []byte) error {
+	*r = ImplementationGuideManifestPage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.Title = &v
+		case 6:
+			r.Anchor = append(r.Anchor, d.string())
+		case 7:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 9:
+			r.AnchorElement = append(r.AnchorElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalImplementationGuide unmarshals a ImplementationGuide.
 func UnmarshalImplementationGuide(b []byte) (ImplementationGuide, error) {
 	var implementationGuide ImplementationGuide
-	if err := json.Unmarshal(b, &implementationGuide); err != nil {
+	if err := implementationGuide.UnmarshalJSON(b); err != nil {
 		return implementationGuide, err
 	}
 	return implementationGuide, nil
@@ -14,34 +14,44 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // InsurancePlan is documented here http://hl7.org/fhir/StructureDefinition/InsurancePlan
 type InsurancePlan struct {
-	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            *PublicationStatus      `bson:"status,omitempty" json:"status,omitempty"`
-	Type              []CodeableConcept       `bson:"type,omitempty" json:"type,omitempty"`
-	Name              *string                 `bson:"name,omitempty" json:"name,omitempty"`
-	Alias             []string                `bson:"alias,omitempty" json:"alias,omitempty"`
-	Period            *Period                 `bson:"period,omitempty" json:"period,omitempty"`
-	OwnedBy           *Reference              `bson:"ownedBy,omitempty" json:"ownedBy,omitempty"`
-	AdministeredBy    *Reference              `bson:"administeredBy,omitempty" json:"administeredBy,omitempty"`
-	CoverageArea      []Reference             `bson:"coverageArea,omitempty" json:"coverageArea,omitempty"`
-	Contact           []InsurancePlanContact  `bson:"contact,omitempty" json:"contact,omitempty"`
-	Endpoint          []Reference             `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
-	Network           []Reference             `bson:"network,omitempty" json:"network,omitempty"`
-	Coverage          []InsurancePlanCoverage `bson:"coverage,omitempty" json:"coverage,omitempty"`
-	Plan              []InsurancePlanPlan     `bson:"plan,omitempty" json:"plan,omitempty"`
+	Id                   *string                 `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage       `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               *PublicationStatus      `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element                `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 []CodeableConcept       `bson:"type,omitempty" json:"type,omitempty"`
+	Name                 *string                 `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                `bson:"_name,omitempty" json:"_name,omitempty"`
+	Alias                []string                `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement         []*Element              `bson:"_alias,omitempty" json:"_alias,omitempty"`
+	Period               *Period                 `bson:"period,omitempty" json:"period,omitempty"`
+	OwnedBy              *Reference              `bson:"ownedBy,omitempty" json:"ownedBy,omitempty"`
+	AdministeredBy       *Reference              `bson:"administeredBy,omitempty" json:"administeredBy,omitempty"`
+	CoverageArea         []Reference             `bson:"coverageArea,omitempty" json:"coverageArea,omitempty"`
+	Contact              []InsurancePlanContact  `bson:"contact,omitempty" json:"contact,omitempty"`
+	Endpoint             []Reference             `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	Network              []Reference             `bson:"network,omitempty" json:"network,omitempty"`
+	Coverage             []InsurancePlanCoverage `bson:"coverage,omitempty" json:"coverage,omitempty"`
+	Plan                 []InsurancePlanPlan     `bson:"plan,omitempty" json:"plan,omitempty"`
 }
 type InsurancePlanContact struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -61,12 +71,13 @@ type InsurancePlanCoverage struct {
 	Benefit           []InsurancePlanCoverageBenefit `bson:"benefit" json:"benefit"`
 }
 type InsurancePlanCoverageBenefit struct {
-	Id                *string                             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              CodeableConcept                     `bson:"type" json:"type"`
-	Requirement       *string                             `bson:"requirement,omitempty" json:"requirement,omitempty"`
-	Limit             []InsurancePlanCoverageBenefitLimit `bson:"limit,omitempty" json:"limit,omitempty"`
+	Id                 *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type               CodeableConcept                     `bson:"type" json:"type"`
+	Requirement        *string                             `bson:"requirement,omitempty" json:"requirement,omitempty"`
+	RequirementElement *Element                            `bson:"_requirement,omitempty" json:"_requirement,omitempty"`
+	Limit              []InsurancePlanCoverageBenefitLimit `bson:"limit,omitempty" json:"limit,omitempty"`
 }
 type InsurancePlanCoverageBenefitLimit struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -92,8 +103,10 @@ type InsurancePlanPlanGeneralCost struct {
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	GroupSize         *int             `bson:"groupSize,omitempty" json:"groupSize,omitempty"`
+	GroupSizeElement  *Element         `bson:"_groupSize,omitempty" json:"_groupSize,omitempty"`
 	Cost              *Money           `bson:"cost,omitempty" json:"cost,omitempty"`
 	Comment           *string          `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element         `bson:"_comment,omitempty" json:"_comment,omitempty"`
 }
 type InsurancePlanPlanSpecificCost struct {
 	Id                *string                                `bson:"id,omitempty" json:"id,omitempty"`
@@ -118,23 +131,5422 @@ type InsurancePlanPlanSpecificCostBenefitCost struct {
 	Qualifiers        []CodeableConcept `bson:"qualifiers,omitempty" json:"qualifiers,omitempty"`
 	Value             *Quantity         `bson:"value,omitempty" json:"value,omitempty"`
 }
-type OtherInsurancePlan InsurancePlan
 
 // MarshalJSON marshals the given InsurancePlan as JSON into a byte slice
 func (r InsurancePlan) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherInsurancePlan
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherInsurancePlan: OtherInsurancePlan(r),
-		ResourceType:       "InsurancePlan",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlan as JSON object with the members in the order of the fields
+func (r InsurancePlan) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"InsurancePlan\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if len(r.Alias) > 0 {
+		e.key("alias")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Alias {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AliasElement) > 0 {
+		e.key("_alias")
+		e.elements(r.AliasElement, len(r.Alias))
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.OwnedBy != nil {
+		e.key("ownedBy")
+		r.OwnedBy.appendJSON(e)
+	}
+	if r.AdministeredBy != nil {
+		e.key("administeredBy")
+		r.AdministeredBy.appendJSON(e)
+	}
+	if len(r.CoverageArea) > 0 {
+		e.key("coverageArea")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CoverageArea {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endpoint) > 0 {
+		e.key("endpoint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endpoint {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Network) > 0 {
+		e.key("network")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Network {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Coverage) > 0 {
+		e.key("coverage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Coverage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Plan) > 0 {
+		e.key("plan")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Plan {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlan from JSON. Unknown members are ignored.
+func (r *InsurancePlan) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlan
+func (r *InsurancePlan) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v PublicationStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "alias":
+			a := d.array()
+			r.Alias = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Alias = append(r.Alias, v)
+			}
+			if r.Alias == nil && !a.isNull {
+				r.Alias = []string{}
+			}
+		case "_alias":
+			a := d.array()
+			r.AliasElement = nil
+			for a.next() {
+				r.AliasElement = append(r.AliasElement, d.element())
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "ownedBy":
+			if d.null() {
+				r.OwnedBy = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.OwnedBy = &v
+			}
+		case "administeredBy":
+			if d.null() {
+				r.AdministeredBy = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.AdministeredBy = &v
+			}
+		case "coverageArea":
+			a := d.array()
+			r.CoverageArea = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.CoverageArea = append(r.CoverageArea, v)
+			}
+			if r.CoverageArea == nil && !a.isNull {
+				r.CoverageArea = []Reference{}
+			}
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v InsurancePlanContact
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []InsurancePlanContact{}
+			}
+		case "endpoint":
+			a := d.array()
+			r.Endpoint = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Endpoint = append(r.Endpoint, v)
+			}
+			if r.Endpoint == nil && !a.isNull {
+				r.Endpoint = []Reference{}
+			}
+		case "network":
+			a := d.array()
+			r.Network = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Network = append(r.Network, v)
+			}
+			if r.Network == nil && !a.isNull {
+				r.Network = []Reference{}
+			}
+		case "coverage":
+			a := d.array()
+			r.Coverage = nil
+			for a.next() {
+				var v InsurancePlanCoverage
+				v.decodeJSON(d)
+				r.Coverage = append(r.Coverage, v)
+			}
+			if r.Coverage == nil && !a.isNull {
+				r.Coverage = []InsurancePlanCoverage{}
+			}
+		case "plan":
+			a := d.array()
+			r.Plan = nil
+			for a.next() {
+				var v InsurancePlanPlan
+				v.decodeJSON(d)
+				r.Plan = append(r.Plan, v)
+			}
+			if r.Plan == nil && !a.isNull {
+				r.Plan = []InsurancePlanPlan{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlan which shares no memory with the original
+func (r InsurancePlan) DeepCopy() InsurancePlan {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Alias != nil {
+		out.Alias = make([]string, len(r.Alias))
+		copy(out.Alias, r.Alias)
+	}
+	if r.AliasElement != nil {
+		out.AliasElement = make([]*Element, len(r.AliasElement))
+		for i, v := range r.AliasElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.AliasElement[i] = &c
+			}
+		}
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.OwnedBy != nil {
+		v := r.OwnedBy.DeepCopy()
+		out.OwnedBy = &v
+	}
+	if r.AdministeredBy != nil {
+		v := r.AdministeredBy.DeepCopy()
+		out.AdministeredBy = &v
+	}
+	if r.CoverageArea != nil {
+		out.CoverageArea = make([]Reference, len(r.CoverageArea))
+		for i := range r.CoverageArea {
+			out.CoverageArea[i] = r.CoverageArea[i].DeepCopy()
+		}
+	}
+	if r.Contact != nil {
+		out.Contact = make([]InsurancePlanContact, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Endpoint != nil {
+		out.Endpoint = make([]Reference, len(r.Endpoint))
+		for i := range r.Endpoint {
+			out.Endpoint[i] = r.Endpoint[i].DeepCopy()
+		}
+	}
+	if r.Network != nil {
+		out.Network = make([]Reference, len(r.Network))
+		for i := range r.Network {
+			out.Network[i] = r.Network[i].DeepCopy()
+		}
+	}
+	if r.Coverage != nil {
+		out.Coverage = make([]InsurancePlanCoverage, len(r.Coverage))
+		for i := range r.Coverage {
+			out.Coverage[i] = r.Coverage[i].DeepCopy()
+		}
+	}
+	if r.Plan != nil {
+		out.Plan = make([]InsurancePlanPlan, len(r.Plan))
+		for i := range r.Plan {
+			out.Plan[i] = r.Plan[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlan) Equal(other InsurancePlan) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if len(r.Alias) != len(other.Alias) {
+		return false
+	}
+	for i := range r.Alias {
+		if r.Alias[i] != other.Alias[i] {
+			return false
+		}
+	}
+	if !equalElements(r.AliasElement, other.AliasElement, false) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.OwnedBy == nil) != (other.OwnedBy == nil) || r.OwnedBy != nil && !r.OwnedBy.Equal(*other.OwnedBy) {
+		return false
+	}
+	if (r.AdministeredBy == nil) != (other.AdministeredBy == nil) || r.AdministeredBy != nil && !r.AdministeredBy.Equal(*other.AdministeredBy) {
+		return false
+	}
+	if len(r.CoverageArea) != len(other.CoverageArea) {
+		return false
+	}
+	for i := range r.CoverageArea {
+		if !r.CoverageArea[i].Equal(other.CoverageArea[i]) {
+			return false
+		}
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if len(r.Endpoint) != len(other.Endpoint) {
+		return false
+	}
+	for i := range r.Endpoint {
+		if !r.Endpoint[i].Equal(other.Endpoint[i]) {
+			return false
+		}
+	}
+	if len(r.Network) != len(other.Network) {
+		return false
+	}
+	for i := range r.Network {
+		if !r.Network[i].Equal(other.Network[i]) {
+			return false
+		}
+	}
+	if len(r.Coverage) != len(other.Coverage) {
+		return false
+	}
+	for i := range r.Coverage {
+		if !r.Coverage[i].Equal(other.Coverage[i]) {
+			return false
+		}
+	}
+	if len(r.Plan) != len(other.Plan) {
+		return false
+	}
+	for i := range r.Plan {
+		if !r.Plan[i].Equal(other.Plan[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlan) EqualsDeep(other InsurancePlan) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if !equivalentList(len(r.Alias), len(other.Alias), func(i, j int) bool {
+		return equivalentString(r.Alias[i], other.Alias[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.AliasElement, other.AliasElement, true) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.OwnedBy == nil) != (other.OwnedBy == nil) || r.OwnedBy != nil && !r.OwnedBy.EqualsDeep(*other.OwnedBy) {
+		return false
+	}
+	if (r.AdministeredBy == nil) != (other.AdministeredBy == nil) || r.AdministeredBy != nil && !r.AdministeredBy.EqualsDeep(*other.AdministeredBy) {
+		return false
+	}
+	if !equivalentList(len(r.CoverageArea), len(other.CoverageArea), func(i, j int) bool {
+		return r.CoverageArea[i].EqualsDeep(other.CoverageArea[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endpoint), len(other.Endpoint), func(i, j int) bool {
+		return r.Endpoint[i].EqualsDeep(other.Endpoint[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Network), len(other.Network), func(i, j int) bool {
+		return r.Network[i].EqualsDeep(other.Network[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Coverage), len(other.Coverage), func(i, j int) bool {
+		return r.Coverage[i].EqualsDeep(other.Coverage[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Plan), len(other.Plan), func(i, j int) bool {
+		return r.Plan[i].EqualsDeep(other.Plan[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlan) EqualsShallow(other InsurancePlan) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if !equivalentList(len(r.Alias), len(other.Alias), func(i, j int) bool {
+		return equivalentString(r.Alias[i], other.Alias[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlan as FHIR XML
+func (r InsurancePlan) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "InsurancePlan",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	for i, v := range r.Alias {
+		if err := encodeXMLPrimitive(e, "alias", v, elementAt(r.AliasElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "ownedBy", r.OwnedBy); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "administeredBy", r.AdministeredBy); err != nil {
+		return err
+	}
+	for _, v := range r.CoverageArea {
+		if err := encodeXMLElement(e, "coverageArea", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endpoint {
+		if err := encodeXMLElement(e, "endpoint", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Network {
+		if err := encodeXMLElement(e, "network", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Coverage {
+		if err := encodeXMLElement(e, "coverage", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Plan {
+		if err := encodeXMLElement(e, "plan", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlan from FHIR XML
+func (r *InsurancePlan) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "alias":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.AliasElement = appendElement(r.AliasElement, len(r.Alias), element)
+					r.Alias = append(r.Alias, v)
+				}
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "ownedBy":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OwnedBy = &v
+			case "administeredBy":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdministeredBy = &v
+			case "coverageArea":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CoverageArea = append(r.CoverageArea, v)
+			case "contact":
+				var v InsurancePlanContact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "endpoint":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endpoint = append(r.Endpoint, v)
+			case "network":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Network = append(r.Network, v)
+			case "coverage":
+				var v InsurancePlanCoverage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Coverage = append(r.Coverage, v)
+			case "plan":
+				var v InsurancePlanPlan
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Plan = append(r.Plan, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlan as properties to the RDF node n
+func (r InsurancePlan) turtle(n *turtleNode) {
+	n.resource("InsurancePlan", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("InsurancePlan.identifier", i, v)
+	}
+	n.primitive("InsurancePlan.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.Type {
+		n.element("InsurancePlan.type", i, v)
+	}
+	n.primitive("InsurancePlan.name", -1, r.Name, r.NameElement, "string")
+	for i, v := range r.Alias {
+		n.primitive("InsurancePlan.alias", i, v, elementAt(r.AliasElement, i), "string")
+	}
+	n.element("InsurancePlan.period", -1, r.Period)
+	n.element("InsurancePlan.ownedBy", -1, r.OwnedBy)
+	n.element("InsurancePlan.administeredBy", -1, r.AdministeredBy)
+	for i, v := range r.CoverageArea {
+		n.element("InsurancePlan.coverageArea", i, v)
+	}
+	for i, v := range r.Contact {
+		n.element("InsurancePlan.contact", i, v)
+	}
+	for i, v := range r.Endpoint {
+		n.element("InsurancePlan.endpoint", i, v)
+	}
+	for i, v := range r.Network {
+		n.element("InsurancePlan.network", i, v)
+	}
+	for i, v := range r.Coverage {
+		n.element("InsurancePlan.coverage", i, v)
+	}
+	for i, v := range r.Plan {
+		n.element("InsurancePlan.plan", i, v)
+	}
+}
+
+// MarshalBSON marshals the given InsurancePlan as BSON document with the structure of its FHIR JSON
+func (r InsurancePlan) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlan from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlan) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlan as protobuf message InsurancePlan of fhir.proto
+func (r InsurancePlan) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlan to the encoded message
+func (r InsurancePlan) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Status != nil {
+		e.varint(10, r.Status.protoNumber())
+	}
+	for _, v := range r.Type {
+		e.message(11, v)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	for _, v := range r.Alias {
+		e.string(13, v)
+	}
+	if r.Period != nil {
+		e.message(14, *r.Period)
+	}
+	if r.OwnedBy != nil {
+		e.message(15, *r.OwnedBy)
+	}
+	if r.AdministeredBy != nil {
+		e.message(16, *r.AdministeredBy)
+	}
+	for _, v := range r.CoverageArea {
+		e.message(17, v)
+	}
+	for _, v := range r.Contact {
+		e.message(18, v)
+	}
+	for _, v := range r.Endpoint {
+		e.message(19, v)
+	}
+	for _, v := range r.Network {
+		e.message(20, v)
+	}
+	for _, v := range r.Coverage {
+		e.message(21, v)
+	}
+	for _, v := range r.Plan {
+		e.message(22, v)
+	}
+	if r.IdElement != nil {
+		e.message(23, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(24, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(25, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(26, *r.StatusElement)
+	}
+	if r.NameElement != nil {
+		e.message(27, *r.NameElement)
+	}
+	for _, v := range r.AliasElement {
+		e.element(28, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlan from protobuf message InsurancePlan of fhir.proto
+func (r *InsurancePlan) UnmarshalProto(b []byte) error {
+	*r = InsurancePlan{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			r.Alias = append(r.Alias, d.string())
+		case 14:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.OwnedBy = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.AdministeredBy = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.CoverageArea = append(r.CoverageArea, v)
+		case 18:
+			var v InsurancePlanContact
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Endpoint = append(r.Endpoint, v)
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Network = append(r.Network, v)
+		case 21:
+			var v InsurancePlanCoverage
+			d.message(&v)
+			r.Coverage = append(r.Coverage, v)
+		case 22:
+			var v InsurancePlanPlan
+			d.message(&v)
+			r.Plan = append(r.Plan, v)
+		case 23:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 28:
+			r.AliasElement = append(r.AliasElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InsurancePlanContact as JSON into a byte slice
+func (r InsurancePlanContact) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlanContact as JSON object with the members in the order of the fields
+func (r InsurancePlanContact) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		r.Purpose.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		r.Name.appendJSON(e)
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Address != nil {
+		e.key("address")
+		r.Address.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlanContact from JSON. Unknown members are ignored.
+func (r *InsurancePlanContact) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlanContact
+func (r *InsurancePlanContact) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "purpose":
+			if d.null() {
+				r.Purpose = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Purpose = &v
+			}
+		case "name":
+			if d.null() {
+				r.Name = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.Name = &v
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "address":
+			if d.null() {
+				r.Address = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.Address = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlanContact which shares no memory with the original
+func (r InsurancePlanContact) DeepCopy() InsurancePlanContact {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := r.Purpose.DeepCopy()
+		out.Purpose = &v
+	}
+	if r.Name != nil {
+		v := r.Name.DeepCopy()
+		out.Name = &v
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.Address != nil {
+		v := r.Address.DeepCopy()
+		out.Address = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlanContact) Equal(other InsurancePlanContact) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !r.Purpose.Equal(*other.Purpose) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !r.Name.Equal(*other.Name) {
+		return false
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if (r.Address == nil) != (other.Address == nil) || r.Address != nil && !r.Address.Equal(*other.Address) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlanContact) EqualsDeep(other InsurancePlanContact) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !r.Purpose.EqualsDeep(*other.Purpose) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !r.Name.EqualsDeep(*other.Name) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if (r.Address == nil) != (other.Address == nil) || r.Address != nil && !r.Address.EqualsDeep(*other.Address) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlanContact) EqualsShallow(other InsurancePlanContact) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlanContact as FHIR XML
+func (r InsurancePlanContact) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "purpose", r.Purpose); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "name", r.Name); err != nil {
+		return err
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "address", r.Address); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlanContact from FHIR XML
+func (r *InsurancePlanContact) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "purpose":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Purpose = &v
+			case "name":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Name = &v
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "address":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Address = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlanContact as properties to the RDF node n
+func (r InsurancePlanContact) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("InsurancePlan.contact.purpose", -1, r.Purpose)
+	n.element("InsurancePlan.contact.name", -1, r.Name)
+	for i, v := range r.Telecom {
+		n.element("InsurancePlan.contact.telecom", i, v)
+	}
+	n.element("InsurancePlan.contact.address", -1, r.Address)
+}
+
+// MarshalBSON marshals the given InsurancePlanContact as BSON document with the structure of its FHIR JSON
+func (r InsurancePlanContact) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlanContact from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlanContact) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlanContact as protobuf message InsurancePlanContact of fhir.proto
+func (r InsurancePlanContact) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlanContact to the encoded message
+func (r InsurancePlanContact) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Purpose != nil {
+		e.message(4, *r.Purpose)
+	}
+	if r.Name != nil {
+		e.message(5, *r.Name)
+	}
+	for _, v := range r.Telecom {
+		e.message(6, v)
+	}
+	if r.Address != nil {
+		e.message(7, *r.Address)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlanContact from protobuf message InsurancePlanContact of fhir.proto
+func (r *InsurancePlanContact) UnmarshalProto(b []byte) error {
+	*r = InsurancePlanContact{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Purpose = &v
+		case 5:
+			var v HumanName
+			d.message(&v)
+			r.Name = &v
+		case 6:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 7:
+			var v Address
+			d.message(&v)
+			r.Address = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InsurancePlanCoverage as JSON into a byte slice
+func (r InsurancePlanCoverage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlanCoverage as JSON object with the members in the order of the fields
+func (r InsurancePlanCoverage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if len(r.Network) > 0 {
+		e.key("network")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Network {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("benefit")
+	if r.Benefit == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Benefit {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlanCoverage from JSON. Unknown members are ignored.
+func (r *InsurancePlanCoverage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlanCoverage
+func (r *InsurancePlanCoverage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "network":
+			a := d.array()
+			r.Network = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Network = append(r.Network, v)
+			}
+			if r.Network == nil && !a.isNull {
+				r.Network = []Reference{}
+			}
+		case "benefit":
+			a := d.array()
+			r.Benefit = nil
+			for a.next() {
+				var v InsurancePlanCoverageBenefit
+				v.decodeJSON(d)
+				r.Benefit = append(r.Benefit, v)
+			}
+			if r.Benefit == nil && !a.isNull {
+				r.Benefit = []InsurancePlanCoverageBenefit{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlanCoverage which shares no memory with the original
+func (r InsurancePlanCoverage) DeepCopy() InsurancePlanCoverage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Network != nil {
+		out.Network = make([]Reference, len(r.Network))
+		for i := range r.Network {
+			out.Network[i] = r.Network[i].DeepCopy()
+		}
+	}
+	if r.Benefit != nil {
+		out.Benefit = make([]InsurancePlanCoverageBenefit, len(r.Benefit))
+		for i := range r.Benefit {
+			out.Benefit[i] = r.Benefit[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlanCoverage) Equal(other InsurancePlanCoverage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.Network) != len(other.Network) {
+		return false
+	}
+	for i := range r.Network {
+		if !r.Network[i].Equal(other.Network[i]) {
+			return false
+		}
+	}
+	if len(r.Benefit) != len(other.Benefit) {
+		return false
+	}
+	for i := range r.Benefit {
+		if !r.Benefit[i].Equal(other.Benefit[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlanCoverage) EqualsDeep(other InsurancePlanCoverage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Network), len(other.Network), func(i, j int) bool {
+		return r.Network[i].EqualsDeep(other.Network[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Benefit), len(other.Benefit), func(i, j int) bool {
+		return r.Benefit[i].EqualsDeep(other.Benefit[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlanCoverage) EqualsShallow(other InsurancePlanCoverage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlanCoverage as FHIR XML
+func (r InsurancePlanCoverage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Network {
+		if err := encodeXMLElement(e, "network", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Benefit {
+		if err := encodeXMLElement(e, "benefit", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlanCoverage from FHIR XML
+func (r *InsurancePlanCoverage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "network":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Network = append(r.Network, v)
+			case "benefit":
+				var v InsurancePlanCoverageBenefit
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Benefit = append(r.Benefit, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlanCoverage as properties to the RDF node n
+func (r InsurancePlanCoverage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("InsurancePlan.coverage.type", -1, r.Type)
+	for i, v := range r.Network {
+		n.element("InsurancePlan.coverage.network", i, v)
+	}
+	for i, v := range r.Benefit {
+		n.element("InsurancePlan.coverage.benefit", i, v)
+	}
+}
+
+// MarshalBSON marshals the given InsurancePlanCoverage as BSON document with the structure of its FHIR JSON
+func (r InsurancePlanCoverage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlanCoverage from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlanCoverage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlanCoverage as protobuf message InsurancePlanCoverage of fhir.proto
+func (r InsurancePlanCoverage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlanCoverage to the encoded message
+func (r InsurancePlanCoverage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	for _, v := range r.Network {
+		e.message(5, v)
+	}
+	for _, v := range r.Benefit {
+		e.message(6, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlanCoverage from protobuf message InsurancePlanCoverage of fhir.proto
+func (r *InsurancePlanCoverage) UnmarshalProto(b []byte) error {
+	*r = InsurancePlanCoverage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Network = append(r.Network, v)
+		case 6:
+			var v InsurancePlanCoverageBenefit
+			d.message(&v)
+			r.Benefit = append(r.Benefit, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InsurancePlanCoverageBenefit as JSON into a byte slice
+func (r InsurancePlanCoverageBenefit) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlanCoverageBenefit as JSON object with the members in the order of the fields
+func (r InsurancePlanCoverageBenefit) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.Requirement != nil {
+		e.key("requirement")
+		e.string(*r.Requirement)
+	}
+	if r.RequirementElement != nil {
+		e.key("_requirement")
+		r.RequirementElement.appendJSON(e)
+	}
+	if len(r.Limit) > 0 {
+		e.key("limit")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Limit {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlanCoverageBenefit from JSON. Unknown members are ignored.
+func (r *InsurancePlanCoverageBenefit) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlanCoverageBenefit
+func (r *InsurancePlanCoverageBenefit) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "requirement":
+			if v, ok := d.string(); ok {
+				r.Requirement = &v
+			} else {
+				r.Requirement = nil
+			}
+		case "_requirement":
+			r.RequirementElement = d.element()
+		case "limit":
+			a := d.array()
+			r.Limit = nil
+			for a.next() {
+				var v InsurancePlanCoverageBenefitLimit
+				v.decodeJSON(d)
+				r.Limit = append(r.Limit, v)
+			}
+			if r.Limit == nil && !a.isNull {
+				r.Limit = []InsurancePlanCoverageBenefitLimit{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlanCoverageBenefit which shares no memory with the original
+func (r InsurancePlanCoverageBenefit) DeepCopy() InsurancePlanCoverageBenefit {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Requirement != nil {
+		v := *r.Requirement
+		out.Requirement = &v
+	}
+	if r.RequirementElement != nil {
+		v := r.RequirementElement.DeepCopy()
+		out.RequirementElement = &v
+	}
+	if r.Limit != nil {
+		out.Limit = make([]InsurancePlanCoverageBenefitLimit, len(r.Limit))
+		for i := range r.Limit {
+			out.Limit[i] = r.Limit[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlanCoverageBenefit) Equal(other InsurancePlanCoverageBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.Requirement == nil) != (other.Requirement == nil) || r.Requirement != nil && *r.Requirement != *other.Requirement {
+		return false
+	}
+	if (r.RequirementElement == nil) != (other.RequirementElement == nil) || r.RequirementElement != nil && !r.RequirementElement.Equal(*other.RequirementElement) {
+		return false
+	}
+	if len(r.Limit) != len(other.Limit) {
+		return false
+	}
+	for i := range r.Limit {
+		if !r.Limit[i].Equal(other.Limit[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlanCoverageBenefit) EqualsDeep(other InsurancePlanCoverageBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.Requirement == nil) != (other.Requirement == nil) || r.Requirement != nil && !equivalentString(*r.Requirement, *other.Requirement) {
+		return false
+	}
+	if (r.RequirementElement == nil) != (other.RequirementElement == nil) || r.RequirementElement != nil && !r.RequirementElement.EqualsDeep(*other.RequirementElement) {
+		return false
+	}
+	if !equivalentList(len(r.Limit), len(other.Limit), func(i, j int) bool {
+		return r.Limit[i].EqualsDeep(other.Limit[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlanCoverageBenefit) EqualsShallow(other InsurancePlanCoverageBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Requirement == nil) != (other.Requirement == nil) || r.Requirement != nil && !equivalentString(*r.Requirement, *other.Requirement) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlanCoverageBenefit as FHIR XML
+func (r InsurancePlanCoverageBenefit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "requirement", r.Requirement, r.RequirementElement); err != nil {
+		return err
+	}
+	for _, v := range r.Limit {
+		if err := encodeXMLElement(e, "limit", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlanCoverageBenefit from FHIR XML
+func (r *InsurancePlanCoverageBenefit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "requirement":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Requirement = &v
+				}
+				r.RequirementElement = element
+			case "limit":
+				var v InsurancePlanCoverageBenefitLimit
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Limit = append(r.Limit, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlanCoverageBenefit as properties to the RDF node n
+func (r InsurancePlanCoverageBenefit) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("InsurancePlan.coverage.benefit.type", -1, r.Type)
+	n.primitive("InsurancePlan.coverage.benefit.requirement", -1, r.Requirement, r.RequirementElement, "string")
+	for i, v := range r.Limit {
+		n.element("InsurancePlan.coverage.benefit.limit", i, v)
+	}
+}
+
+// MarshalBSON marshals the given InsurancePlanCoverageBenefit as BSON document with the structure of its FHIR JSON
+func (r InsurancePlanCoverageBenefit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlanCoverageBenefit from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlanCoverageBenefit) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlanCoverageBenefit as protobuf message InsurancePlanCoverageBenefit of fhir.proto
+func (r InsurancePlanCoverageBenefit) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlanCoverageBenefit to the encoded message
+func (r InsurancePlanCoverageBenefit) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.Requirement != nil {
+		e.string(5, *r.Requirement)
+	}
+	for _, v := range r.Limit {
+		e.message(6, v)
+	}
+	if r.RequirementElement != nil {
+		e.message(7, *r.RequirementElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlanCoverageBenefit from protobuf message InsurancePlanCoverageBenefit of fhir.proto
+func (r *InsurancePlanCoverageBenefit) UnmarshalProto(b []byte) error {
+	*r = InsurancePlanCoverageBenefit{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			v := d.string()
+			r.Requirement = &v
+		case 6:
+			var v InsurancePlanCoverageBenefitLimit
+			d.message(&v)
+			r.Limit = append(r.Limit, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.RequirementElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InsurancePlanCoverageBenefitLimit as JSON into a byte slice
+func (r InsurancePlanCoverageBenefitLimit) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlanCoverageBenefitLimit as JSON object with the members in the order of the fields
+func (r InsurancePlanCoverageBenefitLimit) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Value != nil {
+		e.key("value")
+		r.Value.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlanCoverageBenefitLimit from JSON. Unknown members are ignored.
+func (r *InsurancePlanCoverageBenefitLimit) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlanCoverageBenefitLimit
+func (r *InsurancePlanCoverageBenefitLimit) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "value":
+			if d.null() {
+				r.Value = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Value = &v
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlanCoverageBenefitLimit which shares no memory with the original
+func (r InsurancePlanCoverageBenefitLimit) DeepCopy() InsurancePlanCoverageBenefitLimit {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Value != nil {
+		v := r.Value.DeepCopy()
+		out.Value = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlanCoverageBenefitLimit) Equal(other InsurancePlanCoverageBenefitLimit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !r.Value.Equal(*other.Value) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlanCoverageBenefitLimit) EqualsDeep(other InsurancePlanCoverageBenefitLimit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !r.Value.EqualsDeep(*other.Value) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlanCoverageBenefitLimit) EqualsShallow(other InsurancePlanCoverageBenefitLimit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlanCoverageBenefitLimit as FHIR XML
+func (r InsurancePlanCoverageBenefitLimit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "value", r.Value); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlanCoverageBenefitLimit from FHIR XML
+func (r *InsurancePlanCoverageBenefitLimit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "value":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Value = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlanCoverageBenefitLimit as properties to the RDF node n
+func (r InsurancePlanCoverageBenefitLimit) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("InsurancePlan.coverage.benefit.limit.value", -1, r.Value)
+	n.element("InsurancePlan.coverage.benefit.limit.code", -1, r.Code)
+}
+
+// MarshalBSON marshals the given InsurancePlanCoverageBenefitLimit as BSON document with the structure of its FHIR JSON
+func (r InsurancePlanCoverageBenefitLimit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlanCoverageBenefitLimit from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlanCoverageBenefitLimit) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlanCoverageBenefitLimit as protobuf message InsurancePlanCoverageBenefitLimit of fhir.proto
+func (r InsurancePlanCoverageBenefitLimit) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlanCoverageBenefitLimit to the encoded message
+func (r InsurancePlanCoverageBenefitLimit) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Value != nil {
+		e.message(4, *r.Value)
+	}
+	if r.Code != nil {
+		e.message(5, *r.Code)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlanCoverageBenefitLimit from protobuf message InsurancePlanCoverageBenefitLimit of fhir.proto
+func (r *InsurancePlanCoverageBenefitLimit) UnmarshalProto(b []byte) error {
+	*r = InsurancePlanCoverageBenefitLimit{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.Value = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InsurancePlanPlan as JSON into a byte slice
+func (r InsurancePlanPlan) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlanPlan as JSON object with the members in the order of the fields
+func (r InsurancePlanPlan) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.CoverageArea) > 0 {
+		e.key("coverageArea")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CoverageArea {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Network) > 0 {
+		e.key("network")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Network {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.GeneralCost) > 0 {
+		e.key("generalCost")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.GeneralCost {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SpecificCost) > 0 {
+		e.key("specificCost")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SpecificCost {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlanPlan from JSON. Unknown members are ignored.
+func (r *InsurancePlanPlan) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlanPlan
+func (r *InsurancePlanPlan) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "coverageArea":
+			a := d.array()
+			r.CoverageArea = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.CoverageArea = append(r.CoverageArea, v)
+			}
+			if r.CoverageArea == nil && !a.isNull {
+				r.CoverageArea = []Reference{}
+			}
+		case "network":
+			a := d.array()
+			r.Network = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Network = append(r.Network, v)
+			}
+			if r.Network == nil && !a.isNull {
+				r.Network = []Reference{}
+			}
+		case "generalCost":
+			a := d.array()
+			r.GeneralCost = nil
+			for a.next() {
+				var v InsurancePlanPlanGeneralCost
+				v.decodeJSON(d)
+				r.GeneralCost = append(r.GeneralCost, v)
+			}
+			if r.GeneralCost == nil && !a.isNull {
+				r.GeneralCost = []InsurancePlanPlanGeneralCost{}
+			}
+		case "specificCost":
+			a := d.array()
+			r.SpecificCost = nil
+			for a.next() {
+				var v InsurancePlanPlanSpecificCost
+				v.decodeJSON(d)
+				r.SpecificCost = append(r.SpecificCost, v)
+			}
+			if r.SpecificCost == nil && !a.isNull {
+				r.SpecificCost = []InsurancePlanPlanSpecificCost{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlanPlan which shares no memory with the original
+func (r InsurancePlanPlan) DeepCopy() InsurancePlanPlan {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.CoverageArea != nil {
+		out.CoverageArea = make([]Reference, len(r.CoverageArea))
+		for i := range r.CoverageArea {
+			out.CoverageArea[i] = r.CoverageArea[i].DeepCopy()
+		}
+	}
+	if r.Network != nil {
+		out.Network = make([]Reference, len(r.Network))
+		for i := range r.Network {
+			out.Network[i] = r.Network[i].DeepCopy()
+		}
+	}
+	if r.GeneralCost != nil {
+		out.GeneralCost = make([]InsurancePlanPlanGeneralCost, len(r.GeneralCost))
+		for i := range r.GeneralCost {
+			out.GeneralCost[i] = r.GeneralCost[i].DeepCopy()
+		}
+	}
+	if r.SpecificCost != nil {
+		out.SpecificCost = make([]InsurancePlanPlanSpecificCost, len(r.SpecificCost))
+		for i := range r.SpecificCost {
+			out.SpecificCost[i] = r.SpecificCost[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlanPlan) Equal(other InsurancePlanPlan) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.CoverageArea) != len(other.CoverageArea) {
+		return false
+	}
+	for i := range r.CoverageArea {
+		if !r.CoverageArea[i].Equal(other.CoverageArea[i]) {
+			return false
+		}
+	}
+	if len(r.Network) != len(other.Network) {
+		return false
+	}
+	for i := range r.Network {
+		if !r.Network[i].Equal(other.Network[i]) {
+			return false
+		}
+	}
+	if len(r.GeneralCost) != len(other.GeneralCost) {
+		return false
+	}
+	for i := range r.GeneralCost {
+		if !r.GeneralCost[i].Equal(other.GeneralCost[i]) {
+			return false
+		}
+	}
+	if len(r.SpecificCost) != len(other.SpecificCost) {
+		return false
+	}
+	for i := range r.SpecificCost {
+		if !r.SpecificCost[i].Equal(other.SpecificCost[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlanPlan) EqualsDeep(other InsurancePlanPlan) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.CoverageArea), len(other.CoverageArea), func(i, j int) bool {
+		return r.CoverageArea[i].EqualsDeep(other.CoverageArea[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Network), len(other.Network), func(i, j int) bool {
+		return r.Network[i].EqualsDeep(other.Network[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.GeneralCost), len(other.GeneralCost), func(i, j int) bool {
+		return r.GeneralCost[i].EqualsDeep(other.GeneralCost[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SpecificCost), len(other.SpecificCost), func(i, j int) bool {
+		return r.SpecificCost[i].EqualsDeep(other.SpecificCost[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlanPlan) EqualsShallow(other InsurancePlanPlan) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlanPlan as FHIR XML
+func (r InsurancePlanPlan) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.CoverageArea {
+		if err := encodeXMLElement(e, "coverageArea", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Network {
+		if err := encodeXMLElement(e, "network", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.GeneralCost {
+		if err := encodeXMLElement(e, "generalCost", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SpecificCost {
+		if err := encodeXMLElement(e, "specificCost", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlanPlan from FHIR XML
+func (r *InsurancePlanPlan) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "coverageArea":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CoverageArea = append(r.CoverageArea, v)
+			case "network":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Network = append(r.Network, v)
+			case "generalCost":
+				var v InsurancePlanPlanGeneralCost
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GeneralCost = append(r.GeneralCost, v)
+			case "specificCost":
+				var v InsurancePlanPlanSpecificCost
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SpecificCost = append(r.SpecificCost, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlanPlan as properties to the RDF node n
+func (r InsurancePlanPlan) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("InsurancePlan.plan.identifier", i, v)
+	}
+	n.element("InsurancePlan.plan.type", -1, r.Type)
+	for i, v := range r.CoverageArea {
+		n.element("InsurancePlan.plan.coverageArea", i, v)
+	}
+	for i, v := range r.Network {
+		n.element("InsurancePlan.plan.network", i, v)
+	}
+	for i, v := range r.GeneralCost {
+		n.element("InsurancePlan.plan.generalCost", i, v)
+	}
+	for i, v := range r.SpecificCost {
+		n.element("InsurancePlan.plan.specificCost", i, v)
+	}
+}
+
+// MarshalBSON marshals the given InsurancePlanPlan as BSON document with the structure of its FHIR JSON
+func (r InsurancePlanPlan) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlanPlan from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlanPlan) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlanPlan as protobuf message InsurancePlanPlan of fhir.proto
+func (r InsurancePlanPlan) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlanPlan to the encoded message
+func (r InsurancePlanPlan) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(4, v)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	for _, v := range r.CoverageArea {
+		e.message(6, v)
+	}
+	for _, v := range r.Network {
+		e.message(7, v)
+	}
+	for _, v := range r.GeneralCost {
+		e.message(8, v)
+	}
+	for _, v := range r.SpecificCost {
+		e.message(9, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlanPlan from protobuf message InsurancePlanPlan of fhir.proto
+func (r *InsurancePlanPlan) UnmarshalProto(b []byte) error {
+	*r = InsurancePlanPlan{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.CoverageArea = append(r.CoverageArea, v)
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Network = append(r.Network, v)
+		case 8:
+			var v InsurancePlanPlanGeneralCost
+			d.message(&v)
+			r.GeneralCost = append(r.GeneralCost, v)
+		case 9:
+			var v InsurancePlanPlanSpecificCost
+			d.message(&v)
+			r.SpecificCost = append(r.SpecificCost, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InsurancePlanPlanGeneralCost as JSON into a byte slice
+func (r InsurancePlanPlanGeneralCost) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlanPlanGeneralCost as JSON object with the members in the order of the fields
+func (r InsurancePlanPlanGeneralCost) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.GroupSize != nil {
+		e.key("groupSize")
+		e.int(*r.GroupSize)
+	}
+	if r.GroupSizeElement != nil {
+		e.key("_groupSize")
+		r.GroupSizeElement.appendJSON(e)
+	}
+	if r.Cost != nil {
+		e.key("cost")
+		r.Cost.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlanPlanGeneralCost from JSON. Unknown members are ignored.
+func (r *InsurancePlanPlanGeneralCost) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlanPlanGeneralCost
+func (r *InsurancePlanPlanGeneralCost) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "groupSize":
+			if v, ok := d.int(); ok {
+				r.GroupSize = &v
+			} else {
+				r.GroupSize = nil
+			}
+		case "_groupSize":
+			r.GroupSizeElement = d.element()
+		case "cost":
+			if d.null() {
+				r.Cost = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Cost = &v
+			}
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlanPlanGeneralCost which shares no memory with the original
+func (r InsurancePlanPlanGeneralCost) DeepCopy() InsurancePlanPlanGeneralCost {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.GroupSize != nil {
+		v := *r.GroupSize
+		out.GroupSize = &v
+	}
+	if r.GroupSizeElement != nil {
+		v := r.GroupSizeElement.DeepCopy()
+		out.GroupSizeElement = &v
+	}
+	if r.Cost != nil {
+		v := r.Cost.DeepCopy()
+		out.Cost = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlanPlanGeneralCost) Equal(other InsurancePlanPlanGeneralCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.GroupSize == nil) != (other.GroupSize == nil) || r.GroupSize != nil && *r.GroupSize != *other.GroupSize {
+		return false
+	}
+	if (r.GroupSizeElement == nil) != (other.GroupSizeElement == nil) || r.GroupSizeElement != nil && !r.GroupSizeElement.Equal(*other.GroupSizeElement) {
+		return false
+	}
+	if (r.Cost == nil) != (other.Cost == nil) || r.Cost != nil && !r.Cost.Equal(*other.Cost) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlanPlanGeneralCost) EqualsDeep(other InsurancePlanPlanGeneralCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.GroupSize == nil) != (other.GroupSize == nil) || r.GroupSize != nil && *r.GroupSize != *other.GroupSize {
+		return false
+	}
+	if (r.GroupSizeElement == nil) != (other.GroupSizeElement == nil) || r.GroupSizeElement != nil && !r.GroupSizeElement.EqualsDeep(*other.GroupSizeElement) {
+		return false
+	}
+	if (r.Cost == nil) != (other.Cost == nil) || r.Cost != nil && !r.Cost.EqualsDeep(*other.Cost) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlanPlanGeneralCost) EqualsShallow(other InsurancePlanPlanGeneralCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.GroupSize == nil) != (other.GroupSize == nil) || r.GroupSize != nil && *r.GroupSize != *other.GroupSize {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlanPlanGeneralCost as FHIR XML
+func (r InsurancePlanPlanGeneralCost) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "groupSize", r.GroupSize, r.GroupSizeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "cost", r.Cost); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlanPlanGeneralCost from FHIR XML
+func (r *InsurancePlanPlanGeneralCost) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "groupSize":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.GroupSize = &v
+				}
+				r.GroupSizeElement = element
+			case "cost":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Cost = &v
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlanPlanGeneralCost as properties to the RDF node n
+func (r InsurancePlanPlanGeneralCost) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("InsurancePlan.plan.generalCost.type", -1, r.Type)
+	n.primitive("InsurancePlan.plan.generalCost.groupSize", -1, r.GroupSize, r.GroupSizeElement, "positiveInt")
+	n.element("InsurancePlan.plan.generalCost.cost", -1, r.Cost)
+	n.primitive("InsurancePlan.plan.generalCost.comment", -1, r.Comment, r.CommentElement, "string")
+}
+
+// MarshalBSON marshals the given InsurancePlanPlanGeneralCost as BSON document with the structure of its FHIR JSON
+func (r InsurancePlanPlanGeneralCost) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlanPlanGeneralCost from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlanPlanGeneralCost) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlanPlanGeneralCost as protobuf message InsurancePlanPlanGeneralCost of fhir.proto
+func (r InsurancePlanPlanGeneralCost) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlanPlanGeneralCost to the encoded message
+func (r InsurancePlanPlanGeneralCost) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.GroupSize != nil {
+		e.varint(5, uint64(int64(*r.GroupSize)))
+	}
+	if r.Cost != nil {
+		e.message(6, *r.Cost)
+	}
+	if r.Comment != nil {
+		e.string(7, *r.Comment)
+	}
+	if r.GroupSizeElement != nil {
+		e.message(8, *r.GroupSizeElement)
+	}
+	if r.CommentElement != nil {
+		e.message(9, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlanPlanGeneralCost from protobuf message InsurancePlanPlanGeneralCost of fhir.proto
+func (r *InsurancePlanPlanGeneralCost) UnmarshalProto(b []byte) error {
+	*r = InsurancePlanPlanGeneralCost{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			v := d.int()
+			r.GroupSize = &v
+		case 6:
+			var v Money
+			d.message(&v)
+			r.Cost = &v
+		case 7:
+			v := d.string()
+			r.Comment = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.GroupSizeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InsurancePlanPlanSpecificCost as JSON into a byte slice
+func (r InsurancePlanPlanSpecificCost) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlanPlanSpecificCost as JSON object with the members in the order of the fields
+func (r InsurancePlanPlanSpecificCost) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("category")
+	r.Category.appendJSON(e)
+	if len(r.Benefit) > 0 {
+		e.key("benefit")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Benefit {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlanPlanSpecificCost from JSON. Unknown members are ignored.
+func (r *InsurancePlanPlanSpecificCost) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlanPlanSpecificCost
+func (r *InsurancePlanPlanSpecificCost) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			r.Category.decodeJSON(d)
+		case "benefit":
+			a := d.array()
+			r.Benefit = nil
+			for a.next() {
+				var v InsurancePlanPlanSpecificCostBenefit
+				v.decodeJSON(d)
+				r.Benefit = append(r.Benefit, v)
+			}
+			if r.Benefit == nil && !a.isNull {
+				r.Benefit = []InsurancePlanPlanSpecificCostBenefit{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlanPlanSpecificCost which shares no memory with the original
+func (r InsurancePlanPlanSpecificCost) DeepCopy() InsurancePlanPlanSpecificCost {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Category = r.Category.DeepCopy()
+	if r.Benefit != nil {
+		out.Benefit = make([]InsurancePlanPlanSpecificCostBenefit, len(r.Benefit))
+		for i := range r.Benefit {
+			out.Benefit[i] = r.Benefit[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlanPlanSpecificCost) Equal(other InsurancePlanPlanSpecificCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Category.Equal(other.Category) {
+		return false
+	}
+	if len(r.Benefit) != len(other.Benefit) {
+		return false
+	}
+	for i := range r.Benefit {
+		if !r.Benefit[i].Equal(other.Benefit[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlanPlanSpecificCost) EqualsDeep(other InsurancePlanPlanSpecificCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Category.EqualsDeep(other.Category) {
+		return false
+	}
+	if !equivalentList(len(r.Benefit), len(other.Benefit), func(i, j int) bool {
+		return r.Benefit[i].EqualsDeep(other.Benefit[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlanPlanSpecificCost) EqualsShallow(other InsurancePlanPlanSpecificCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlanPlanSpecificCost as FHIR XML
+func (r InsurancePlanPlanSpecificCost) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	for _, v := range r.Benefit {
+		if err := encodeXMLElement(e, "benefit", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlanPlanSpecificCost from FHIR XML
+func (r *InsurancePlanPlanSpecificCost) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = v
+			case "benefit":
+				var v InsurancePlanPlanSpecificCostBenefit
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Benefit = append(r.Benefit, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlanPlanSpecificCost as properties to the RDF node n
+func (r InsurancePlanPlanSpecificCost) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("InsurancePlan.plan.specificCost.category", -1, r.Category)
+	for i, v := range r.Benefit {
+		n.element("InsurancePlan.plan.specificCost.benefit", i, v)
+	}
+}
+
+// MarshalBSON marshals the given InsurancePlanPlanSpecificCost as BSON document with the structure of its FHIR JSON
+func (r InsurancePlanPlanSpecificCost) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlanPlanSpecificCost from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlanPlanSpecificCost) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlanPlanSpecificCost as protobuf message InsurancePlanPlanSpecificCost of fhir.proto
+func (r InsurancePlanPlanSpecificCost) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlanPlanSpecificCost to the encoded message
+func (r InsurancePlanPlanSpecificCost) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Category)
+	for _, v := range r.Benefit {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlanPlanSpecificCost from protobuf message InsurancePlanPlanSpecificCost of fhir.proto
+func (r *InsurancePlanPlanSpecificCost) UnmarshalProto(b []byte) error {
+	*r = InsurancePlanPlanSpecificCost{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = v
+		case 5:
+			var v InsurancePlanPlanSpecificCostBenefit
+			d.message(&v)
+			r.Benefit = append(r.Benefit, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InsurancePlanPlanSpecificCostBenefit as JSON into a byte slice
+func (r InsurancePlanPlanSpecificCostBenefit) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlanPlanSpecificCostBenefit as JSON object with the members in the order of the fields
+func (r InsurancePlanPlanSpecificCostBenefit) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if len(r.Cost) > 0 {
+		e.key("cost")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Cost {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlanPlanSpecificCostBenefit from JSON. Unknown members are ignored.
+func (r *InsurancePlanPlanSpecificCostBenefit) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlanPlanSpecificCostBenefit
+func (r *InsurancePlanPlanSpecificCostBenefit) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "cost":
+			a := d.array()
+			r.Cost = nil
+			for a.next() {
+				var v InsurancePlanPlanSpecificCostBenefitCost
+				v.decodeJSON(d)
+				r.Cost = append(r.Cost, v)
+			}
+			if r.Cost == nil && !a.isNull {
+				r.Cost = []InsurancePlanPlanSpecificCostBenefitCost{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlanPlanSpecificCostBenefit which shares no memory with the original
+func (r InsurancePlanPlanSpecificCostBenefit) DeepCopy() InsurancePlanPlanSpecificCostBenefit {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Cost != nil {
+		out.Cost = make([]InsurancePlanPlanSpecificCostBenefitCost, len(r.Cost))
+		for i := range r.Cost {
+			out.Cost[i] = r.Cost[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlanPlanSpecificCostBenefit) Equal(other InsurancePlanPlanSpecificCostBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.Cost) != len(other.Cost) {
+		return false
+	}
+	for i := range r.Cost {
+		if !r.Cost[i].Equal(other.Cost[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlanPlanSpecificCostBenefit) EqualsDeep(other InsurancePlanPlanSpecificCostBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Cost), len(other.Cost), func(i, j int) bool {
+		return r.Cost[i].EqualsDeep(other.Cost[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlanPlanSpecificCostBenefit) EqualsShallow(other InsurancePlanPlanSpecificCostBenefit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlanPlanSpecificCostBenefit as FHIR XML
+func (r InsurancePlanPlanSpecificCostBenefit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Cost {
+		if err := encodeXMLElement(e, "cost", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlanPlanSpecificCostBenefit from FHIR XML
+func (r *InsurancePlanPlanSpecificCostBenefit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "cost":
+				var v InsurancePlanPlanSpecificCostBenefitCost
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Cost = append(r.Cost, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlanPlanSpecificCostBenefit as properties to the RDF node n
+func (r InsurancePlanPlanSpecificCostBenefit) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("InsurancePlan.plan.specificCost.benefit.type", -1, r.Type)
+	for i, v := range r.Cost {
+		n.element("InsurancePlan.plan.specificCost.benefit.cost", i, v)
+	}
+}
+
+// MarshalBSON marshals the given InsurancePlanPlanSpecificCostBenefit as BSON document with the structure of its FHIR JSON
+func (r InsurancePlanPlanSpecificCostBenefit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlanPlanSpecificCostBenefit from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlanPlanSpecificCostBenefit) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlanPlanSpecificCostBenefit as protobuf message InsurancePlanPlanSpecificCostBenefit of fhir.proto
+func (r InsurancePlanPlanSpecificCostBenefit) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlanPlanSpecificCostBenefit to the encoded message
+func (r InsurancePlanPlanSpecificCostBenefit) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	for _, v := range r.Cost {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlanPlanSpecificCostBenefit from protobuf message InsurancePlanPlanSpecificCostBenefit of fhir.proto
+func (r *InsurancePlanPlanSpecificCostBenefit) UnmarshalProto(b []byte) error {
+	*r = InsurancePlanPlanSpecificCostBenefit{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v InsurancePlanPlanSpecificCostBenefitCost
+			d.message(&v)
+			r.Cost = append(r.Cost, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InsurancePlanPlanSpecificCostBenefitCost as JSON into a byte slice
+func (r InsurancePlanPlanSpecificCostBenefitCost) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InsurancePlanPlanSpecificCostBenefitCost as JSON object with the members in the order of the fields
+func (r InsurancePlanPlanSpecificCostBenefitCost) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.Applicability != nil {
+		e.key("applicability")
+		r.Applicability.appendJSON(e)
+	}
+	if len(r.Qualifiers) > 0 {
+		e.key("qualifiers")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Qualifiers {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Value != nil {
+		e.key("value")
+		r.Value.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InsurancePlanPlanSpecificCostBenefitCost from JSON. Unknown members are ignored.
+func (r *InsurancePlanPlanSpecificCostBenefitCost) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InsurancePlanPlanSpecificCostBenefitCost
+func (r *InsurancePlanPlanSpecificCostBenefitCost) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "applicability":
+			if d.null() {
+				r.Applicability = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Applicability = &v
+			}
+		case "qualifiers":
+			a := d.array()
+			r.Qualifiers = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Qualifiers = append(r.Qualifiers, v)
+			}
+			if r.Qualifiers == nil && !a.isNull {
+				r.Qualifiers = []CodeableConcept{}
+			}
+		case "value":
+			if d.null() {
+				r.Value = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Value = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InsurancePlanPlanSpecificCostBenefitCost which shares no memory with the original
+func (r InsurancePlanPlanSpecificCostBenefitCost) DeepCopy() InsurancePlanPlanSpecificCostBenefitCost {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Applicability != nil {
+		v := r.Applicability.DeepCopy()
+		out.Applicability = &v
+	}
+	if r.Qualifiers != nil {
+		out.Qualifiers = make([]CodeableConcept, len(r.Qualifiers))
+		for i := range r.Qualifiers {
+			out.Qualifiers[i] = r.Qualifiers[i].DeepCopy()
+		}
+	}
+	if r.Value != nil {
+		v := r.Value.DeepCopy()
+		out.Value = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InsurancePlanPlanSpecificCostBenefitCost) Equal(other InsurancePlanPlanSpecificCostBenefitCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.Applicability == nil) != (other.Applicability == nil) || r.Applicability != nil && !r.Applicability.Equal(*other.Applicability) {
+		return false
+	}
+	if len(r.Qualifiers) != len(other.Qualifiers) {
+		return false
+	}
+	for i := range r.Qualifiers {
+		if !r.Qualifiers[i].Equal(other.Qualifiers[i]) {
+			return false
+		}
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !r.Value.Equal(*other.Value) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InsurancePlanPlanSpecificCostBenefitCost) EqualsDeep(other InsurancePlanPlanSpecificCostBenefitCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.Applicability == nil) != (other.Applicability == nil) || r.Applicability != nil && !r.Applicability.EqualsDeep(*other.Applicability) {
+		return false
+	}
+	if !equivalentList(len(r.Qualifiers), len(other.Qualifiers), func(i, j int) bool {
+		return r.Qualifiers[i].EqualsDeep(other.Qualifiers[j])
+	}) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !r.Value.EqualsDeep(*other.Value) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InsurancePlanPlanSpecificCostBenefitCost) EqualsShallow(other InsurancePlanPlanSpecificCostBenefitCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InsurancePlanPlanSpecificCostBenefitCost as FHIR XML
+func (r InsurancePlanPlanSpecificCostBenefitCost) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "applicability", r.Applicability); err != nil {
+		return err
+	}
+	for _, v := range r.Qualifiers {
+		if err := encodeXMLElement(e, "qualifiers", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "value", r.Value); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InsurancePlanPlanSpecificCostBenefitCost from FHIR XML
+func (r *InsurancePlanPlanSpecificCostBenefitCost) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "applicability":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Applicability = &v
+			case "qualifiers":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Qualifiers = append(r.Qualifiers, v)
+			case "value":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Value = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InsurancePlanPlanSpecificCostBenefitCost as properties to the RDF node n
+func (r InsurancePlanPlanSpecificCostBenefitCost) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("InsurancePlan.plan.specificCost.benefit.cost.type", -1, r.Type)
+	n.element("InsurancePlan.plan.specificCost.benefit.cost.applicability", -1, r.Applicability)
+	for i, v := range r.Qualifiers {
+		n.element("InsurancePlan.plan.specificCost.benefit.cost.qualifiers", i, v)
+	}
+	n.element("InsurancePlan.plan.specificCost.benefit.cost.value", -1, r.Value)
+}
+
+// MarshalBSON marshals the given InsurancePlanPlanSpecificCostBenefitCost as BSON document with the structure of its FHIR JSON
+func (r InsurancePlanPlanSpecificCostBenefitCost) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InsurancePlanPlanSpecificCostBenefitCost from a BSON document with the structure of its FHIR JSON
+func (r *InsurancePlanPlanSpecificCostBenefitCost) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InsurancePlanPlanSpecificCostBenefitCost as protobuf message InsurancePlanPlanSpecificCostBenefitCost of fhir.proto
+func (r InsurancePlanPlanSpecificCostBenefitCost) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InsurancePlanPlanSpecificCostBenefitCost to the encoded message
+func (r InsurancePlanPlanSpecificCostBenefitCost) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.Applicability != nil {
+		e.message(5, *r.Applicability)
+	}
+	for _, v := range r.Qualifiers {
+		e.message(6, v)
+	}
+	if r.Value != nil {
+		e.message(7, *r.Value)
+	}
+}
+
+// UnmarshalProto unmarshals the given InsurancePlanPlanSpecificCostBenefitCost from protobuf message InsurancePlanPlanSpecificCostBenefitCost of fhir.proto
+func (r *InsurancePlanPlanSpecificCostBenefitCost) UnmarshalProto(b []byte) error {
+	*r = InsurancePlanPlanSpecificCostBenefitCost{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Applicability = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Qualifiers = append(r.Qualifiers, v)
+		case 7:
+			var v Quantity
+			d.message(&v)
+			r.Value = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalInsurancePlan unmarshals a InsurancePlan.
 func UnmarshalInsurancePlan(b []byte) (InsurancePlan, error) {
 	var insurancePlan InsurancePlan
-	if err := json.Unmarshal(b, &insurancePlan); err != nil {
+	if err := insurancePlan.UnmarshalJSON(b); err != nil {
 		return insurancePlan, err
 	}
 	return insurancePlan, nil
@@ -14,36 +14,47 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Invoice is documented here http://hl7.org/fhir/StructureDefinition/Invoice
 type Invoice struct {
-	Id                  *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string                         `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status              InvoiceStatus                   `bson:"status" json:"status"`
-	CancelledReason     *string                         `bson:"cancelledReason,omitempty" json:"cancelledReason,omitempty"`
-	Type                *CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
-	Subject             *Reference                      `bson:"subject,omitempty" json:"subject,omitempty"`
-	Recipient           *Reference                      `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Date                *string                         `bson:"date,omitempty" json:"date,omitempty"`
-	Participant         []InvoiceParticipant            `bson:"participant,omitempty" json:"participant,omitempty"`
-	Issuer              *Reference                      `bson:"issuer,omitempty" json:"issuer,omitempty"`
-	Account             *Reference                      `bson:"account,omitempty" json:"account,omitempty"`
-	LineItem            []InvoiceLineItem               `bson:"lineItem,omitempty" json:"lineItem,omitempty"`
-	TotalPriceComponent []InvoiceLineItemPriceComponent `bson:"totalPriceComponent,omitempty" json:"totalPriceComponent,omitempty"`
-	TotalNet            *Money                          `bson:"totalNet,omitempty" json:"totalNet,omitempty"`
-	TotalGross          *Money                          `bson:"totalGross,omitempty" json:"totalGross,omitempty"`
-	PaymentTerms        *string                         `bson:"paymentTerms,omitempty" json:"paymentTerms,omitempty"`
-	Note                []Annotation                    `bson:"note,omitempty" json:"note,omitempty"`
+	Id                     *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element                        `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                   *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage               `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                 InvoiceStatus                   `bson:"status" json:"status"`
+	StatusElement          *Element                        `bson:"_status,omitempty" json:"_status,omitempty"`
+	CancelledReason        *string                         `bson:"cancelledReason,omitempty" json:"cancelledReason,omitempty"`
+	CancelledReasonElement *Element                        `bson:"_cancelledReason,omitempty" json:"_cancelledReason,omitempty"`
+	Type                   *CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
+	Subject                *Reference                      `bson:"subject,omitempty" json:"subject,omitempty"`
+	Recipient              *Reference                      `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Date                   *string                         `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element                        `bson:"_date,omitempty" json:"_date,omitempty"`
+	Participant            []InvoiceParticipant            `bson:"participant,omitempty" json:"participant,omitempty"`
+	Issuer                 *Reference                      `bson:"issuer,omitempty" json:"issuer,omitempty"`
+	Account                *Reference                      `bson:"account,omitempty" json:"account,omitempty"`
+	LineItem               []InvoiceLineItem               `bson:"lineItem,omitempty" json:"lineItem,omitempty"`
+	TotalPriceComponent    []InvoiceLineItemPriceComponent `bson:"totalPriceComponent,omitempty" json:"totalPriceComponent,omitempty"`
+	TotalNet               *Money                          `bson:"totalNet,omitempty" json:"totalNet,omitempty"`
+	TotalGross             *Money                          `bson:"totalGross,omitempty" json:"totalGross,omitempty"`
+	PaymentTerms           *string                         `bson:"paymentTerms,omitempty" json:"paymentTerms,omitempty"`
+	PaymentTermsElement    *Element                        `bson:"_paymentTerms,omitempty" json:"_paymentTerms,omitempty"`
+	Note                   []Annotation                    `bson:"note,omitempty" json:"note,omitempty"`
 }
 type InvoiceParticipant struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -57,36 +68,2792 @@ type InvoiceLineItem struct {
 	Extension                 []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension         []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Sequence                  *int                            `bson:"sequence,omitempty" json:"sequence,omitempty"`
-	ChargeItemReference       Reference                       `bson:"chargeItemReference" json:"chargeItemReference"`
-	ChargeItemCodeableConcept CodeableConcept                 `bson:"chargeItemCodeableConcept" json:"chargeItemCodeableConcept"`
+	SequenceElement           *Element                        `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	ChargeItemReference       *Reference                      `bson:"chargeItemReference,omitempty" json:"chargeItemReference,omitempty"`
+	ChargeItemCodeableConcept *CodeableConcept                `bson:"chargeItemCodeableConcept,omitempty" json:"chargeItemCodeableConcept,omitempty"`
 	PriceComponent            []InvoiceLineItemPriceComponent `bson:"priceComponent,omitempty" json:"priceComponent,omitempty"`
 }
+
+// InvoiceLineItemChargeItem is implemented by the types allowed for Invoice.lineItem.chargeItem[x]
+type InvoiceLineItemChargeItem interface {
+	isInvoiceLineItemChargeItem()
+}
+
+func (Reference) isInvoiceLineItemChargeItem()       {}
+func (CodeableConcept) isInvoiceLineItemChargeItem() {}
+
+// ChargeItem returns the value of Invoice.lineItem.chargeItem[x] together with its FHIR type code or nil if no value is set
+func (r InvoiceLineItem) ChargeItem() (InvoiceLineItemChargeItem, string) {
+	if r.ChargeItemReference != nil {
+		return *r.ChargeItemReference, "Reference"
+	}
+	if r.ChargeItemCodeableConcept != nil {
+		return *r.ChargeItemCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetChargeItem sets the value of Invoice.lineItem.chargeItem[x], given as value or pointer, and clears all other types
+func (r *InvoiceLineItem) SetChargeItem(value InvoiceLineItemChargeItem) {
+	r.ChargeItemReference = nil
+	r.ChargeItemCodeableConcept = nil
+	switch v := value.(type) {
+	case Reference:
+		r.ChargeItemReference = &v
+	case *Reference:
+		r.ChargeItemReference = v
+	case CodeableConcept:
+		r.ChargeItemCodeableConcept = &v
+	case *CodeableConcept:
+		r.ChargeItemCodeableConcept = v
+	}
+}
+
 type InvoiceLineItemPriceComponent struct {
 	Id                *string                   `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              InvoicePriceComponentType `bson:"type" json:"type"`
+	TypeElement       *Element                  `bson:"_type,omitempty" json:"_type,omitempty"`
 	Code              *CodeableConcept          `bson:"code,omitempty" json:"code,omitempty"`
 	Factor            *json.Number              `bson:"factor,omitempty" json:"factor,omitempty"`
+	FactorElement     *Element                  `bson:"_factor,omitempty" json:"_factor,omitempty"`
 	Amount            *Money                    `bson:"amount,omitempty" json:"amount,omitempty"`
 }
-type OtherInvoice Invoice
 
 // MarshalJSON marshals the given Invoice as JSON into a byte slice
 func (r Invoice) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherInvoice
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherInvoice: OtherInvoice(r),
-		ResourceType: "Invoice",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Invoice as JSON object with the members in the order of the fields
+func (r Invoice) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Invoice\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.CancelledReason != nil {
+		e.key("cancelledReason")
+		e.string(*r.CancelledReason)
+	}
+	if r.CancelledReasonElement != nil {
+		e.key("_cancelledReason")
+		r.CancelledReasonElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Recipient != nil {
+		e.key("recipient")
+		r.Recipient.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if len(r.Participant) > 0 {
+		e.key("participant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Participant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Issuer != nil {
+		e.key("issuer")
+		r.Issuer.appendJSON(e)
+	}
+	if r.Account != nil {
+		e.key("account")
+		r.Account.appendJSON(e)
+	}
+	if len(r.LineItem) > 0 {
+		e.key("lineItem")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LineItem {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.TotalPriceComponent) > 0 {
+		e.key("totalPriceComponent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.TotalPriceComponent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.TotalNet != nil {
+		e.key("totalNet")
+		r.TotalNet.appendJSON(e)
+	}
+	if r.TotalGross != nil {
+		e.key("totalGross")
+		r.TotalGross.appendJSON(e)
+	}
+	if r.PaymentTerms != nil {
+		e.key("paymentTerms")
+		e.string(*r.PaymentTerms)
+	}
+	if r.PaymentTermsElement != nil {
+		e.key("_paymentTerms")
+		r.PaymentTermsElement.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Invoice from JSON. Unknown members are ignored.
+func (r *Invoice) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Invoice
+func (r *Invoice) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "cancelledReason":
+			if v, ok := d.string(); ok {
+				r.CancelledReason = &v
+			} else {
+				r.CancelledReason = nil
+			}
+		case "_cancelledReason":
+			r.CancelledReasonElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "recipient":
+			if d.null() {
+				r.Recipient = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recipient = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "participant":
+			a := d.array()
+			r.Participant = nil
+			for a.next() {
+				var v InvoiceParticipant
+				v.decodeJSON(d)
+				r.Participant = append(r.Participant, v)
+			}
+			if r.Participant == nil && !a.isNull {
+				r.Participant = []InvoiceParticipant{}
+			}
+		case "issuer":
+			if d.null() {
+				r.Issuer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Issuer = &v
+			}
+		case "account":
+			if d.null() {
+				r.Account = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Account = &v
+			}
+		case "lineItem":
+			a := d.array()
+			r.LineItem = nil
+			for a.next() {
+				var v InvoiceLineItem
+				v.decodeJSON(d)
+				r.LineItem = append(r.LineItem, v)
+			}
+			if r.LineItem == nil && !a.isNull {
+				r.LineItem = []InvoiceLineItem{}
+			}
+		case "totalPriceComponent":
+			a := d.array()
+			r.TotalPriceComponent = nil
+			for a.next() {
+				var v InvoiceLineItemPriceComponent
+				v.decodeJSON(d)
+				r.TotalPriceComponent = append(r.TotalPriceComponent, v)
+			}
+			if r.TotalPriceComponent == nil && !a.isNull {
+				r.TotalPriceComponent = []InvoiceLineItemPriceComponent{}
+			}
+		case "totalNet":
+			if d.null() {
+				r.TotalNet = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.TotalNet = &v
+			}
+		case "totalGross":
+			if d.null() {
+				r.TotalGross = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.TotalGross = &v
+			}
+		case "paymentTerms":
+			if v, ok := d.string(); ok {
+				r.PaymentTerms = &v
+			} else {
+				r.PaymentTerms = nil
+			}
+		case "_paymentTerms":
+			r.PaymentTermsElement = d.element()
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Invoice which shares no memory with the original
+func (r Invoice) DeepCopy() Invoice {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.CancelledReason != nil {
+		v := *r.CancelledReason
+		out.CancelledReason = &v
+	}
+	if r.CancelledReasonElement != nil {
+		v := r.CancelledReasonElement.DeepCopy()
+		out.CancelledReasonElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Recipient != nil {
+		v := r.Recipient.DeepCopy()
+		out.Recipient = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Participant != nil {
+		out.Participant = make([]InvoiceParticipant, len(r.Participant))
+		for i := range r.Participant {
+			out.Participant[i] = r.Participant[i].DeepCopy()
+		}
+	}
+	if r.Issuer != nil {
+		v := r.Issuer.DeepCopy()
+		out.Issuer = &v
+	}
+	if r.Account != nil {
+		v := r.Account.DeepCopy()
+		out.Account = &v
+	}
+	if r.LineItem != nil {
+		out.LineItem = make([]InvoiceLineItem, len(r.LineItem))
+		for i := range r.LineItem {
+			out.LineItem[i] = r.LineItem[i].DeepCopy()
+		}
+	}
+	if r.TotalPriceComponent != nil {
+		out.TotalPriceComponent = make([]InvoiceLineItemPriceComponent, len(r.TotalPriceComponent))
+		for i := range r.TotalPriceComponent {
+			out.TotalPriceComponent[i] = r.TotalPriceComponent[i].DeepCopy()
+		}
+	}
+	if r.TotalNet != nil {
+		v := r.TotalNet.DeepCopy()
+		out.TotalNet = &v
+	}
+	if r.TotalGross != nil {
+		v := r.TotalGross.DeepCopy()
+		out.TotalGross = &v
+	}
+	if r.PaymentTerms != nil {
+		v := *r.PaymentTerms
+		out.PaymentTerms = &v
+	}
+	if r.PaymentTermsElement != nil {
+		v := r.PaymentTermsElement.DeepCopy()
+		out.PaymentTermsElement = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Invoice) Equal(other Invoice) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.CancelledReason == nil) != (other.CancelledReason == nil) || r.CancelledReason != nil && *r.CancelledReason != *other.CancelledReason {
+		return false
+	}
+	if (r.CancelledReasonElement == nil) != (other.CancelledReasonElement == nil) || r.CancelledReasonElement != nil && !r.CancelledReasonElement.Equal(*other.CancelledReasonElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Recipient == nil) != (other.Recipient == nil) || r.Recipient != nil && !r.Recipient.Equal(*other.Recipient) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if len(r.Participant) != len(other.Participant) {
+		return false
+	}
+	for i := range r.Participant {
+		if !r.Participant[i].Equal(other.Participant[i]) {
+			return false
+		}
+	}
+	if (r.Issuer == nil) != (other.Issuer == nil) || r.Issuer != nil && !r.Issuer.Equal(*other.Issuer) {
+		return false
+	}
+	if (r.Account == nil) != (other.Account == nil) || r.Account != nil && !r.Account.Equal(*other.Account) {
+		return false
+	}
+	if len(r.LineItem) != len(other.LineItem) {
+		return false
+	}
+	for i := range r.LineItem {
+		if !r.LineItem[i].Equal(other.LineItem[i]) {
+			return false
+		}
+	}
+	if len(r.TotalPriceComponent) != len(other.TotalPriceComponent) {
+		return false
+	}
+	for i := range r.TotalPriceComponent {
+		if !r.TotalPriceComponent[i].Equal(other.TotalPriceComponent[i]) {
+			return false
+		}
+	}
+	if (r.TotalNet == nil) != (other.TotalNet == nil) || r.TotalNet != nil && !r.TotalNet.Equal(*other.TotalNet) {
+		return false
+	}
+	if (r.TotalGross == nil) != (other.TotalGross == nil) || r.TotalGross != nil && !r.TotalGross.Equal(*other.TotalGross) {
+		return false
+	}
+	if (r.PaymentTerms == nil) != (other.PaymentTerms == nil) || r.PaymentTerms != nil && *r.PaymentTerms != *other.PaymentTerms {
+		return false
+	}
+	if (r.PaymentTermsElement == nil) != (other.PaymentTermsElement == nil) || r.PaymentTermsElement != nil && !r.PaymentTermsElement.Equal(*other.PaymentTermsElement) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Invoice) EqualsDeep(other Invoice) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.CancelledReason == nil) != (other.CancelledReason == nil) || r.CancelledReason != nil && !equivalentString(*r.CancelledReason, *other.CancelledReason) {
+		return false
+	}
+	if (r.CancelledReasonElement == nil) != (other.CancelledReasonElement == nil) || r.CancelledReasonElement != nil && !r.CancelledReasonElement.EqualsDeep(*other.CancelledReasonElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Recipient == nil) != (other.Recipient == nil) || r.Recipient != nil && !r.Recipient.EqualsDeep(*other.Recipient) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if !equivalentList(len(r.Participant), len(other.Participant), func(i, j int) bool {
+		return r.Participant[i].EqualsDeep(other.Participant[j])
+	}) {
+		return false
+	}
+	if (r.Issuer == nil) != (other.Issuer == nil) || r.Issuer != nil && !r.Issuer.EqualsDeep(*other.Issuer) {
+		return false
+	}
+	if (r.Account == nil) != (other.Account == nil) || r.Account != nil && !r.Account.EqualsDeep(*other.Account) {
+		return false
+	}
+	if !equivalentList(len(r.LineItem), len(other.LineItem), func(i, j int) bool {
+		return r.LineItem[i].EqualsDeep(other.LineItem[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.TotalPriceComponent), len(other.TotalPriceComponent), func(i, j int) bool {
+		return r.TotalPriceComponent[i].EqualsDeep(other.TotalPriceComponent[j])
+	}) {
+		return false
+	}
+	if (r.TotalNet == nil) != (other.TotalNet == nil) || r.TotalNet != nil && !r.TotalNet.EqualsDeep(*other.TotalNet) {
+		return false
+	}
+	if (r.TotalGross == nil) != (other.TotalGross == nil) || r.TotalGross != nil && !r.TotalGross.EqualsDeep(*other.TotalGross) {
+		return false
+	}
+	if (r.PaymentTerms == nil) != (other.PaymentTerms == nil) || r.PaymentTerms != nil && !equivalentString(*r.PaymentTerms, *other.PaymentTerms) {
+		return false
+	}
+	if (r.PaymentTermsElement == nil) != (other.PaymentTermsElement == nil) || r.PaymentTermsElement != nil && !r.PaymentTermsElement.EqualsDeep(*other.PaymentTermsElement) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Invoice) EqualsShallow(other Invoice) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.CancelledReason == nil) != (other.CancelledReason == nil) || r.CancelledReason != nil && !equivalentString(*r.CancelledReason, *other.CancelledReason) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.PaymentTerms == nil) != (other.PaymentTerms == nil) || r.PaymentTerms != nil && !equivalentString(*r.PaymentTerms, *other.PaymentTerms) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Invoice as FHIR XML
+func (r Invoice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Invoice",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "cancelledReason", r.CancelledReason, r.CancelledReasonElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "recipient", r.Recipient); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	for _, v := range r.Participant {
+		if err := encodeXMLElement(e, "participant", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "issuer", r.Issuer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "account", r.Account); err != nil {
+		return err
+	}
+	for _, v := range r.LineItem {
+		if err := encodeXMLElement(e, "lineItem", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.TotalPriceComponent {
+		if err := encodeXMLElement(e, "totalPriceComponent", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "totalNet", r.TotalNet); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "totalGross", r.TotalGross); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "paymentTerms", r.PaymentTerms, r.PaymentTermsElement); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Invoice from FHIR XML
+func (r *Invoice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v InvoiceStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "cancelledReason":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CancelledReason = &v
+				}
+				r.CancelledReasonElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "recipient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recipient = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "participant":
+				var v InvoiceParticipant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Participant = append(r.Participant, v)
+			case "issuer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Issuer = &v
+			case "account":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Account = &v
+			case "lineItem":
+				var v InvoiceLineItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LineItem = append(r.LineItem, v)
+			case "totalPriceComponent":
+				var v InvoiceLineItemPriceComponent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TotalPriceComponent = append(r.TotalPriceComponent, v)
+			case "totalNet":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TotalNet = &v
+			case "totalGross":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TotalGross = &v
+			case "paymentTerms":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PaymentTerms = &v
+				}
+				r.PaymentTermsElement = element
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Invoice as properties to the RDF node n
+func (r Invoice) turtle(n *turtleNode) {
+	n.resource("Invoice", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Invoice.identifier", i, v)
+	}
+	n.primitive("Invoice.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("Invoice.cancelledReason", -1, r.CancelledReason, r.CancelledReasonElement, "string")
+	n.element("Invoice.type", -1, r.Type)
+	n.element("Invoice.subject", -1, r.Subject)
+	n.element("Invoice.recipient", -1, r.Recipient)
+	n.primitive("Invoice.date", -1, r.Date, r.DateElement, "dateTime")
+	for i, v := range r.Participant {
+		n.element("Invoice.participant", i, v)
+	}
+	n.element("Invoice.issuer", -1, r.Issuer)
+	n.element("Invoice.account", -1, r.Account)
+	for i, v := range r.LineItem {
+		n.element("Invoice.lineItem", i, v)
+	}
+	for i, v := range r.TotalPriceComponent {
+		n.element("Invoice.totalPriceComponent", i, v)
+	}
+	n.element("Invoice.totalNet", -1, r.TotalNet)
+	n.element("Invoice.totalGross", -1, r.TotalGross)
+	n.primitive("Invoice.paymentTerms", -1, r.PaymentTerms, r.PaymentTermsElement, "string")
+	for i, v := range r.Note {
+		n.element("Invoice.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Invoice as BSON document with the structure of its FHIR JSON
+func (r Invoice) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Invoice from a BSON document with the structure of its FHIR JSON
+func (r *Invoice) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Invoice as protobuf message Invoice of fhir.proto
+func (r Invoice) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Invoice to the encoded message
+func (r Invoice) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.CancelledReason != nil {
+		e.string(11, *r.CancelledReason)
+	}
+	if r.Type != nil {
+		e.message(12, *r.Type)
+	}
+	if r.Subject != nil {
+		e.message(13, *r.Subject)
+	}
+	if r.Recipient != nil {
+		e.message(14, *r.Recipient)
+	}
+	if r.Date != nil {
+		e.string(15, *r.Date)
+	}
+	for _, v := range r.Participant {
+		e.message(16, v)
+	}
+	if r.Issuer != nil {
+		e.message(17, *r.Issuer)
+	}
+	if r.Account != nil {
+		e.message(18, *r.Account)
+	}
+	for _, v := range r.LineItem {
+		e.message(19, v)
+	}
+	for _, v := range r.TotalPriceComponent {
+		e.message(20, v)
+	}
+	if r.TotalNet != nil {
+		e.message(21, *r.TotalNet)
+	}
+	if r.TotalGross != nil {
+		e.message(22, *r.TotalGross)
+	}
+	if r.PaymentTerms != nil {
+		e.string(23, *r.PaymentTerms)
+	}
+	for _, v := range r.Note {
+		e.message(24, v)
+	}
+	if r.IdElement != nil {
+		e.message(25, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(26, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(27, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(28, *r.StatusElement)
+	}
+	if r.CancelledReasonElement != nil {
+		e.message(29, *r.CancelledReasonElement)
+	}
+	if r.DateElement != nil {
+		e.message(30, *r.DateElement)
+	}
+	if r.PaymentTermsElement != nil {
+		e.message(31, *r.PaymentTermsElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Invoice from protobuf message Invoice of fhir.proto
+func (r *Invoice) UnmarshalProto(b []byte) error {
+	*r = Invoice{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v InvoiceStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			v := d.string()
+			r.CancelledReason = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Recipient = &v
+		case 15:
+			v := d.string()
+			r.Date = &v
+		case 16:
+			var v InvoiceParticipant
+			d.message(&v)
+			r.Participant = append(r.Participant, v)
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Issuer = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Account = &v
+		case 19:
+			var v InvoiceLineItem
+			d.message(&v)
+			r.LineItem = append(r.LineItem, v)
+		case 20:
+			var v InvoiceLineItemPriceComponent
+			d.message(&v)
+			r.TotalPriceComponent = append(r.TotalPriceComponent, v)
+		case 21:
+			var v Money
+			d.message(&v)
+			r.TotalNet = &v
+		case 22:
+			var v Money
+			d.message(&v)
+			r.TotalGross = &v
+		case 23:
+			v := d.string()
+			r.PaymentTerms = &v
+		case 24:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 25:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.CancelledReasonElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.PaymentTermsElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InvoiceParticipant as JSON into a byte slice
+func (r InvoiceParticipant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InvoiceParticipant as JSON object with the members in the order of the fields
+func (r InvoiceParticipant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Role != nil {
+		e.key("role")
+		r.Role.appendJSON(e)
+	}
+	e.key("actor")
+	r.Actor.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InvoiceParticipant from JSON. Unknown members are ignored.
+func (r *InvoiceParticipant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InvoiceParticipant
+func (r *InvoiceParticipant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "role":
+			if d.null() {
+				r.Role = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = &v
+			}
+		case "actor":
+			r.Actor.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InvoiceParticipant which shares no memory with the original
+func (r InvoiceParticipant) DeepCopy() InvoiceParticipant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Role != nil {
+		v := r.Role.DeepCopy()
+		out.Role = &v
+	}
+	out.Actor = r.Actor.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InvoiceParticipant) Equal(other InvoiceParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.Equal(*other.Role) {
+		return false
+	}
+	if !r.Actor.Equal(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InvoiceParticipant) EqualsDeep(other InvoiceParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.EqualsDeep(*other.Role) {
+		return false
+	}
+	if !r.Actor.EqualsDeep(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InvoiceParticipant) EqualsShallow(other InvoiceParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InvoiceParticipant as FHIR XML
+func (r InvoiceParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "actor", r.Actor); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InvoiceParticipant from FHIR XML
+func (r *InvoiceParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = &v
+			case "actor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InvoiceParticipant as properties to the RDF node n
+func (r InvoiceParticipant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Invoice.participant.role", -1, r.Role)
+	n.element("Invoice.participant.actor", -1, r.Actor)
+}
+
+// MarshalBSON marshals the given InvoiceParticipant as BSON document with the structure of its FHIR JSON
+func (r InvoiceParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InvoiceParticipant from a BSON document with the structure of its FHIR JSON
+func (r *InvoiceParticipant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InvoiceParticipant as protobuf message InvoiceParticipant of fhir.proto
+func (r InvoiceParticipant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InvoiceParticipant to the encoded message
+func (r InvoiceParticipant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Role != nil {
+		e.message(4, *r.Role)
+	}
+	e.message(5, r.Actor)
+}
+
+// UnmarshalProto unmarshals the given InvoiceParticipant from protobuf message InvoiceParticipant of fhir.proto
+func (r *InvoiceParticipant) UnmarshalProto(b []byte) error {
+	*r = InvoiceParticipant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Actor = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InvoiceLineItem as JSON into a byte slice
+func (r InvoiceLineItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InvoiceLineItem as JSON object with the members in the order of the fields
+func (r InvoiceLineItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Sequence != nil {
+		e.key("sequence")
+		e.int(*r.Sequence)
+	}
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.ChargeItemReference != nil {
+		e.key("chargeItemReference")
+		r.ChargeItemReference.appendJSON(e)
+	}
+	if r.ChargeItemCodeableConcept != nil {
+		e.key("chargeItemCodeableConcept")
+		r.ChargeItemCodeableConcept.appendJSON(e)
+	}
+	if len(r.PriceComponent) > 0 {
+		e.key("priceComponent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PriceComponent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InvoiceLineItem from JSON. Unknown members are ignored.
+func (r *InvoiceLineItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InvoiceLineItem
+func (r *InvoiceLineItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequence":
+			if v, ok := d.int(); ok {
+				r.Sequence = &v
+			} else {
+				r.Sequence = nil
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "chargeItemReference":
+			if d.null() {
+				r.ChargeItemReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ChargeItemReference = &v
+			}
+		case "chargeItemCodeableConcept":
+			if d.null() {
+				r.ChargeItemCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ChargeItemCodeableConcept = &v
+			}
+		case "priceComponent":
+			a := d.array()
+			r.PriceComponent = nil
+			for a.next() {
+				var v InvoiceLineItemPriceComponent
+				v.decodeJSON(d)
+				r.PriceComponent = append(r.PriceComponent, v)
+			}
+			if r.PriceComponent == nil && !a.isNull {
+				r.PriceComponent = []InvoiceLineItemPriceComponent{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InvoiceLineItem which shares no memory with the original
+func (r InvoiceLineItem) DeepCopy() InvoiceLineItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Sequence != nil {
+		v := *r.Sequence
+		out.Sequence = &v
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.ChargeItemReference != nil {
+		v := r.ChargeItemReference.DeepCopy()
+		out.ChargeItemReference = &v
+	}
+	if r.ChargeItemCodeableConcept != nil {
+		v := r.ChargeItemCodeableConcept.DeepCopy()
+		out.ChargeItemCodeableConcept = &v
+	}
+	if r.PriceComponent != nil {
+		out.PriceComponent = make([]InvoiceLineItemPriceComponent, len(r.PriceComponent))
+		for i := range r.PriceComponent {
+			out.PriceComponent[i] = r.PriceComponent[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InvoiceLineItem) Equal(other InvoiceLineItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && *r.Sequence != *other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.ChargeItemReference == nil) != (other.ChargeItemReference == nil) || r.ChargeItemReference != nil && !r.ChargeItemReference.Equal(*other.ChargeItemReference) {
+		return false
+	}
+	if (r.ChargeItemCodeableConcept == nil) != (other.ChargeItemCodeableConcept == nil) || r.ChargeItemCodeableConcept != nil && !r.ChargeItemCodeableConcept.Equal(*other.ChargeItemCodeableConcept) {
+		return false
+	}
+	if len(r.PriceComponent) != len(other.PriceComponent) {
+		return false
+	}
+	for i := range r.PriceComponent {
+		if !r.PriceComponent[i].Equal(other.PriceComponent[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InvoiceLineItem) EqualsDeep(other InvoiceLineItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && *r.Sequence != *other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.ChargeItemReference == nil) != (other.ChargeItemReference == nil) || r.ChargeItemReference != nil && !r.ChargeItemReference.EqualsDeep(*other.ChargeItemReference) {
+		return false
+	}
+	if (r.ChargeItemCodeableConcept == nil) != (other.ChargeItemCodeableConcept == nil) || r.ChargeItemCodeableConcept != nil && !r.ChargeItemCodeableConcept.EqualsDeep(*other.ChargeItemCodeableConcept) {
+		return false
+	}
+	if !equivalentList(len(r.PriceComponent), len(other.PriceComponent), func(i, j int) bool {
+		return r.PriceComponent[i].EqualsDeep(other.PriceComponent[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InvoiceLineItem) EqualsShallow(other InvoiceLineItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && *r.Sequence != *other.Sequence {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InvoiceLineItem as FHIR XML
+func (r InvoiceLineItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "chargeItemReference", r.ChargeItemReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "chargeItemCodeableConcept", r.ChargeItemCodeableConcept); err != nil {
+		return err
+	}
+	for _, v := range r.PriceComponent {
+		if err := encodeXMLElement(e, "priceComponent", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InvoiceLineItem from FHIR XML
+func (r *InvoiceLineItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequence":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = &v
+				}
+				r.SequenceElement = element
+			case "chargeItemReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ChargeItemReference = &v
+			case "chargeItemCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ChargeItemCodeableConcept = &v
+			case "priceComponent":
+				var v InvoiceLineItemPriceComponent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PriceComponent = append(r.PriceComponent, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InvoiceLineItem as properties to the RDF node n
+func (r InvoiceLineItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Invoice.lineItem.sequence", -1, r.Sequence, r.SequenceElement, "positiveInt")
+	n.element("Invoice.lineItem.chargeItemReference", -1, r.ChargeItemReference)
+	n.element("Invoice.lineItem.chargeItemCodeableConcept", -1, r.ChargeItemCodeableConcept)
+	for i, v := range r.PriceComponent {
+		n.element("Invoice.lineItem.priceComponent", i, v)
+	}
+}
+
+// MarshalBSON marshals the given InvoiceLineItem as BSON document with the structure of its FHIR JSON
+func (r InvoiceLineItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InvoiceLineItem from a BSON document with the structure of its FHIR JSON
+func (r *InvoiceLineItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InvoiceLineItem as protobuf message InvoiceLineItem of fhir.proto
+func (r InvoiceLineItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InvoiceLineItem to the encoded message
+func (r InvoiceLineItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Sequence != nil {
+		e.varint(4, uint64(int64(*r.Sequence)))
+	}
+	if r.ChargeItemReference != nil {
+		e.message(5, *r.ChargeItemReference)
+	}
+	if r.ChargeItemCodeableConcept != nil {
+		e.message(6, *r.ChargeItemCodeableConcept)
+	}
+	for _, v := range r.PriceComponent {
+		e.message(7, v)
+	}
+	if r.SequenceElement != nil {
+		e.message(8, *r.SequenceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given InvoiceLineItem from protobuf message InvoiceLineItem of fhir.proto
+func (r *InvoiceLineItem) UnmarshalProto(b []byte) error {
+	*r = InvoiceLineItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Sequence = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.ChargeItemReference = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.ChargeItemCodeableConcept = &v
+		case 7:
+			var v InvoiceLineItemPriceComponent
+			d.message(&v)
+			r.PriceComponent = append(r.PriceComponent, v)
+		case 8:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given InvoiceLineItemPriceComponent as JSON into a byte slice
+func (r InvoiceLineItemPriceComponent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the InvoiceLineItemPriceComponent as JSON object with the members in the order of the fields
+func (r InvoiceLineItemPriceComponent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Factor != nil {
+		e.key("factor")
+		e.number(*r.Factor)
+	}
+	if r.FactorElement != nil {
+		e.key("_factor")
+		r.FactorElement.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given InvoiceLineItemPriceComponent from JSON. Unknown members are ignored.
+func (r *InvoiceLineItemPriceComponent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the InvoiceLineItemPriceComponent
+func (r *InvoiceLineItemPriceComponent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "factor":
+			if v, ok := d.number(); ok {
+				r.Factor = &v
+			} else {
+				r.Factor = nil
+			}
+		case "_factor":
+			r.FactorElement = d.element()
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the InvoiceLineItemPriceComponent which shares no memory with the original
+func (r InvoiceLineItemPriceComponent) DeepCopy() InvoiceLineItemPriceComponent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Factor != nil {
+		v := *r.Factor
+		out.Factor = &v
+	}
+	if r.FactorElement != nil {
+		v := r.FactorElement.DeepCopy()
+		out.FactorElement = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r InvoiceLineItemPriceComponent) Equal(other InvoiceLineItemPriceComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !EqualDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.Equal(*other.FactorElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r InvoiceLineItemPriceComponent) EqualsDeep(other InvoiceLineItemPriceComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	if (r.FactorElement == nil) != (other.FactorElement == nil) || r.FactorElement != nil && !r.FactorElement.EqualsDeep(*other.FactorElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r InvoiceLineItemPriceComponent) EqualsShallow(other InvoiceLineItemPriceComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Factor == nil) != (other.Factor == nil) || r.Factor != nil && !equivalentDecimal(*r.Factor, *other.Factor) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given InvoiceLineItemPriceComponent as FHIR XML
+func (r InvoiceLineItemPriceComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "factor", r.Factor, r.FactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given InvoiceLineItemPriceComponent from FHIR XML
+func (r *InvoiceLineItemPriceComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v InvoicePriceComponentType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "factor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Factor = &v
+				}
+				r.FactorElement = element
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the InvoiceLineItemPriceComponent as properties to the RDF node n
+func (r InvoiceLineItemPriceComponent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Invoice.lineItem.priceComponent.type", -1, r.Type, r.TypeElement, "code")
+	n.element("Invoice.lineItem.priceComponent.code", -1, r.Code)
+	n.primitive("Invoice.lineItem.priceComponent.factor", -1, r.Factor, r.FactorElement, "decimal")
+	n.element("Invoice.lineItem.priceComponent.amount", -1, r.Amount)
+}
+
+// MarshalBSON marshals the given InvoiceLineItemPriceComponent as BSON document with the structure of its FHIR JSON
+func (r InvoiceLineItemPriceComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given InvoiceLineItemPriceComponent from a BSON document with the structure of its FHIR JSON
+func (r *InvoiceLineItemPriceComponent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given InvoiceLineItemPriceComponent as protobuf message InvoiceLineItemPriceComponent of fhir.proto
+func (r InvoiceLineItemPriceComponent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the InvoiceLineItemPriceComponent to the encoded message
+func (r InvoiceLineItemPriceComponent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Code != nil {
+		e.message(5, *r.Code)
+	}
+	if r.Factor != nil {
+		e.string(6, string(*r.Factor))
+	}
+	if r.Amount != nil {
+		e.message(7, *r.Amount)
+	}
+	if r.TypeElement != nil {
+		e.message(8, *r.TypeElement)
+	}
+	if r.FactorElement != nil {
+		e.message(9, *r.FactorElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given InvoiceLineItemPriceComponent from protobuf message InvoiceLineItemPriceComponent of fhir.proto
+func (r *InvoiceLineItemPriceComponent) UnmarshalProto(b []byte) error {
+	*r = InvoiceLineItemPriceComponent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v InvoicePriceComponentType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 6:
+			v := json.Number(d.string())
+			r.Factor = &v
+		case 7:
+			var v Money
+			d.message(&v)
+			r.Amount = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.FactorElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalInvoice unmarshals a Invoice.
 func UnmarshalInvoice(b []byte) (Invoice, error) {
 	var invoice Invoice
-	if err := json.Unmarshal(b, &invoice); err != nil {
+	if err := invoice.UnmarshalJSON(b); err != nil {
 		return invoice, err
 	}
 	return invoice, nil
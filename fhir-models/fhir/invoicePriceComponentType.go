@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code InvoicePriceComponentType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *InvoicePriceComponentType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *InvoicePriceComponentType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "base":
 		*code = InvoicePriceComponentTypeBase
@@ -58,6 +62,12 @@ func (code *InvoicePriceComponentType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code InvoicePriceComponentType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *InvoicePriceComponentType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code InvoicePriceComponentType) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code InvoicePriceComponentType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code InvoicePriceComponentType) protoNumber() uint64 {
+	switch code {
+	case InvoicePriceComponentTypeBase:
+		return 1
+	case InvoicePriceComponentTypeSurcharge:
+		return 2
+	case InvoicePriceComponentTypeDeduction:
+		return 3
+	case InvoicePriceComponentTypeDiscount:
+		return 4
+	case InvoicePriceComponentTypeTax:
+		return 5
+	case InvoicePriceComponentTypeInformational:
+		return 6
+	}
+	return 0
+}
+func (code *InvoicePriceComponentType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = InvoicePriceComponentTypeBase
+	case 2:
+		*code = InvoicePriceComponentTypeSurcharge
+	case 3:
+		*code = InvoicePriceComponentTypeDeduction
+	case 4:
+		*code = InvoicePriceComponentTypeDiscount
+	case 5:
+		*code = InvoicePriceComponentTypeTax
+	case 6:
+		*code = InvoicePriceComponentTypeInformational
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code InvoiceStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *InvoiceStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *InvoiceStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "draft":
 		*code = InvoiceStatusDraft
@@ -55,6 +59,12 @@ func (code *InvoiceStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code InvoiceStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *InvoiceStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code InvoiceStatus) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code InvoiceStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code InvoiceStatus) protoNumber() uint64 {
+	switch code {
+	case InvoiceStatusDraft:
+		return 1
+	case InvoiceStatusIssued:
+		return 2
+	case InvoiceStatusBalanced:
+		return 3
+	case InvoiceStatusCancelled:
+		return 4
+	case InvoiceStatusEnteredInError:
+		return 5
+	}
+	return 0
+}
+func (code *InvoiceStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = InvoiceStatusDraft
+	case 2:
+		*code = InvoiceStatusIssued
+	case 3:
+		*code = InvoiceStatusBalanced
+	case 4:
+		*code = InvoiceStatusCancelled
+	case 5:
+		*code = InvoiceStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code IssueSeverity) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *IssueSeverity) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *IssueSeverity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "fatal":
 		*code = IssueSeverityFatal
@@ -52,6 +56,12 @@ func (code *IssueSeverity) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code IssueSeverity) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *IssueSeverity) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code IssueSeverity) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code IssueSeverity) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code IssueSeverity) protoNumber() uint64 {
+	switch code {
+	case IssueSeverityFatal:
+		return 1
+	case IssueSeverityError:
+		return 2
+	case IssueSeverityWarning:
+		return 3
+	case IssueSeverityInformation:
+		return 4
+	}
+	return 0
+}
+func (code *IssueSeverity) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = IssueSeverityFatal
+	case 2:
+		*code = IssueSeverityError
+	case 3:
+		*code = IssueSeverityWarning
+	case 4:
+		*code = IssueSeverityInformation
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -63,8 +62,13 @@ const (
 func (code IssueType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *IssueType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *IssueType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "invalid":
 		*code = IssueTypeInvalid
@@ -133,6 +137,12 @@ func (code *IssueType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code IssueType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *IssueType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code IssueType) String() string {
 	return code.Code()
 }
@@ -337,3 +347,139 @@ func (code IssueType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code IssueType) protoNumber() uint64 {
+	switch code {
+	case IssueTypeInvalid:
+		return 1
+	case IssueTypeStructure:
+		return 2
+	case IssueTypeRequired:
+		return 3
+	case IssueTypeValue:
+		return 4
+	case IssueTypeInvariant:
+		return 5
+	case IssueTypeSecurity:
+		return 6
+	case IssueTypeLogin:
+		return 7
+	case IssueTypeUnknown:
+		return 8
+	case IssueTypeExpired:
+		return 9
+	case IssueTypeForbidden:
+		return 10
+	case IssueTypeSuppressed:
+		return 11
+	case IssueTypeProcessing:
+		return 12
+	case IssueTypeNotSupported:
+		return 13
+	case IssueTypeDuplicate:
+		return 14
+	case IssueTypeMultipleMatches:
+		return 15
+	case IssueTypeNotFound:
+		return 16
+	case IssueTypeDeleted:
+		return 17
+	case IssueTypeTooLong:
+		return 18
+	case IssueTypeCodeInvalid:
+		return 19
+	case IssueTypeExtension:
+		return 20
+	case IssueTypeTooCostly:
+		return 21
+	case IssueTypeBusinessRule:
+		return 22
+	case IssueTypeConflict:
+		return 23
+	case IssueTypeTransient:
+		return 24
+	case IssueTypeLockError:
+		return 25
+	case IssueTypeNoStore:
+		return 26
+	case IssueTypeException:
+		return 27
+	case IssueTypeTimeout:
+		return 28
+	case IssueTypeIncomplete:
+		return 29
+	case IssueTypeThrottled:
+		return 30
+	case IssueTypeInformational:
+		return 31
+	}
+	return 0
+}
+func (code *IssueType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = IssueTypeInvalid
+	case 2:
+		*code = IssueTypeStructure
+	case 3:
+		*code = IssueTypeRequired
+	case 4:
+		*code = IssueTypeValue
+	case 5:
+		*code = IssueTypeInvariant
+	case 6:
+		*code = IssueTypeSecurity
+	case 7:
+		*code = IssueTypeLogin
+	case 8:
+		*code = IssueTypeUnknown
+	case 9:
+		*code = IssueTypeExpired
+	case 10:
+		*code = IssueTypeForbidden
+	case 11:
+		*code = IssueTypeSuppressed
+	case 12:
+		*code = IssueTypeProcessing
+	case 13:
+		*code = IssueTypeNotSupported
+	case 14:
+		*code = IssueTypeDuplicate
+	case 15:
+		*code = IssueTypeMultipleMatches
+	case 16:
+		*code = IssueTypeNotFound
+	case 17:
+		*code = IssueTypeDeleted
+	case 18:
+		*code = IssueTypeTooLong
+	case 19:
+		*code = IssueTypeCodeInvalid
+	case 20:
+		*code = IssueTypeExtension
+	case 21:
+		*code = IssueTypeTooCostly
+	case 22:
+		*code = IssueTypeBusinessRule
+	case 23:
+		*code = IssueTypeConflict
+	case 24:
+		*code = IssueTypeTransient
+	case 25:
+		*code = IssueTypeLockError
+	case 26:
+		*code = IssueTypeNoStore
+	case 27:
+		*code = IssueTypeException
+	case 28:
+		*code = IssueTypeTimeout
+	case 29:
+		*code = IssueTypeIncomplete
+	case 30:
+		*code = IssueTypeThrottled
+	case 31:
+		*code = IssueTypeInformational
+	default:
+		return false
+	}
+	return true
+}
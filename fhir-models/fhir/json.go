@@ -0,0 +1,599 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// jsonEncoder appends JSON to buf the same way encoding/json does, including the escaping of HTML characters, and
+// keeps the first error.
+type jsonEncoder struct {
+	buf []byte
+	err error
+}
+
+// key appends the name of an object member, preceded by a comma unless it is the first member.
+func (e *jsonEncoder) key(name string) {
+	if e.buf[len(e.buf)-1] != '{' {
+		e.buf = append(e.buf, ',')
+	}
+	e.buf = append(e.buf, '"')
+	e.buf = append(e.buf, name...)
+	e.buf = append(e.buf, '"', ':')
+}
+
+// item appends the comma preceding an array item unless it is the first item.
+func (e *jsonEncoder) item() {
+	if e.buf[len(e.buf)-1] != '[' {
+		e.buf = append(e.buf, ',')
+	}
+}
+
+func (e *jsonEncoder) null() {
+	e.buf = append(e.buf, "null"...)
+}
+
+func (e *jsonEncoder) bool(b bool) {
+	e.buf = strconv.AppendBool(e.buf, b)
+}
+
+func (e *jsonEncoder) int(i int) {
+	e.buf = strconv.AppendInt(e.buf, int64(i), 10)
+}
+
+// number appends a decimal, where the empty number is zero like in encoding/json.
+func (e *jsonEncoder) number(n json.Number) {
+	if n == "" {
+		n = "0"
+	}
+	if !validJSONNumber(string(n)) {
+		if e.err == nil {
+			e.err = fmt.Errorf("json: invalid number literal %q", n)
+		}
+		return
+	}
+	e.buf = append(e.buf, n...)
+}
+
+// elements appends the ids and extensions of the n values of a primitive list. Values without them are null.
+func (e *jsonEncoder) elements(elements []*Element, n int) {
+	e.buf = append(e.buf, '[')
+	for i := 0; i < n || i < len(elements); i++ {
+		e.item()
+		if v := elementAt(elements, i); v != nil {
+			v.appendJSON(e)
+		} else {
+			e.null()
+		}
+	}
+	e.buf = append(e.buf, ']')
+}
+
+// elementAt returns the id and extensions of the primitive value at index i of a list or nil if it has none.
+func elementAt(elements []*Element, i int) *Element {
+	if i < len(elements) {
+		return elements[i]
+	}
+	return nil
+}
+
+// raw appends a contained or inline resource compacted and with escaped HTML characters.
+func (e *jsonEncoder) raw(m json.RawMessage) {
+	if len(m) == 0 {
+		e.null()
+		return
+	}
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, m); err != nil {
+		if e.err == nil {
+			e.err = err
+		}
+		return
+	}
+	out := bytes.NewBuffer(e.buf)
+	json.HTMLEscape(out, compacted.Bytes())
+	e.buf = out.Bytes()
+}
+
+const jsonHex = "0123456789abcdef"
+
+func (e *jsonEncoder) string(s string) {
+	b := append(e.buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', jsonHex[c>>4], jsonHex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', jsonHex[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	e.buf = append(b, '"')
+}
+
+// jsonDecoder reads JSON values from buf. After an error, all further reads fail, so that loops end.
+type jsonDecoder struct {
+	buf []byte
+	pos int
+	err error
+}
+
+func (d *jsonDecoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf("json: "+format, args...)
+	}
+	d.pos = len(d.buf)
+}
+
+// unexpected reports the character at the current position, which doesn't start a value of the expected kind.
+func (d *jsonDecoder) unexpected(expected string) {
+	if d.pos >= len(d.buf) {
+		d.fail("unexpected end of JSON input")
+		return
+	}
+	d.fail("invalid character %q at offset %d, expected %s", d.buf[d.pos], d.pos, expected)
+}
+
+// peek skips whitespace and returns the next character or zero at the end of the input.
+func (d *jsonDecoder) peek() byte {
+	for d.pos < len(d.buf) {
+		switch c := d.buf[d.pos]; c {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return c
+		}
+	}
+	return 0
+}
+
+// end checks that only whitespace follows the top-level value.
+func (d *jsonDecoder) end() {
+	if d.peek() != 0 {
+		d.fail("invalid character %q after top-level value", d.buf[d.pos])
+	}
+}
+
+func (d *jsonDecoder) literal(word string) bool {
+	if d.peek() == word[0] && bytes.HasPrefix(d.buf[d.pos:], []byte(word)) {
+		d.pos += len(word)
+		return true
+	}
+	return false
+}
+
+// null consumes a null literal if one follows.
+func (d *jsonDecoder) null() bool {
+	return d.literal("null")
+}
+
+// element decodes the id and extensions of a primitive value, which are null for values without them.
+func (d *jsonDecoder) element() *Element {
+	if d.null() {
+		return nil
+	}
+	var v Element
+	v.decodeJSON(d)
+	return &v
+}
+
+// jsonObject iterates over the members of an object. The value of each member has to be read or skipped.
+type jsonObject struct {
+	d       *jsonDecoder
+	started bool
+	key     []byte // only valid until the next read
+}
+
+func (d *jsonDecoder) object() jsonObject {
+	return jsonObject{d: d}
+}
+
+// next reads the key of the next member and returns false at the end of the object. A null object has no
+// members.
+func (o *jsonObject) next() bool {
+	d := o.d
+	if !o.started {
+		o.started = true
+		if d.null() {
+			return false
+		}
+		if d.peek() != '{' {
+			d.unexpected("object")
+			return false
+		}
+		d.pos++
+		if d.peek() == '}' {
+			d.pos++
+			return false
+		}
+	} else {
+		switch d.peek() {
+		case ',':
+			d.pos++
+		case '}':
+			d.pos++
+			return false
+		default:
+			d.unexpected("',' or '}'")
+			return false
+		}
+	}
+	if d.peek() != '"' {
+		d.unexpected("object key")
+		return false
+	}
+	o.key = d.readStringBytes()
+	if d.peek() != ':' {
+		d.unexpected("':'")
+		return false
+	}
+	d.pos++
+	return d.err == nil
+}
+
+// jsonArray iterates over the items of an array. Each item has to be read or skipped.
+type jsonArray struct {
+	d       *jsonDecoder
+	started bool
+	isNull  bool
+}
+
+func (d *jsonDecoder) array() jsonArray {
+	return jsonArray{d: d}
+}
+
+// next returns false at the end of the array. A null array has no items.
+func (a *jsonArray) next() bool {
+	d := a.d
+	if !a.started {
+		a.started = true
+		if d.null() {
+			a.isNull = true
+			return false
+		}
+		if d.peek() != '[' {
+			d.unexpected("array")
+			return false
+		}
+		d.pos++
+		if d.peek() == ']' {
+			d.pos++
+			return false
+		}
+		return d.err == nil
+	}
+	switch d.peek() {
+	case ',':
+		d.pos++
+		return d.err == nil
+	case ']':
+		d.pos++
+	default:
+		d.unexpected("',' or ']'")
+	}
+	return false
+}
+
+func (d *jsonDecoder) string() (string, bool) {
+	if d.null() {
+		return "", false
+	}
+	if d.peek() != '"' {
+		d.unexpected("string")
+		return "", false
+	}
+	return d.readString(), d.err == nil
+}
+
+func (d *jsonDecoder) bool() (bool, bool) {
+	switch {
+	case d.null():
+		return false, false
+	case d.literal("true"):
+		return true, true
+	case d.literal("false"):
+		return false, true
+	}
+	d.unexpected("boolean")
+	return false, false
+}
+
+func (d *jsonDecoder) int() (int, bool) {
+	if d.null() {
+		return 0, false
+	}
+	s, ok := d.readNumber()
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.ParseInt(s, 10, 0)
+	if err != nil {
+		d.fail("cannot unmarshal number %s into int", s)
+		return 0, false
+	}
+	return int(i), true
+}
+
+// number reads a decimal, which may be given as string like in encoding/json.
+func (d *jsonDecoder) number() (json.Number, bool) {
+	if d.null() {
+		return "", false
+	}
+	if d.peek() == '"' {
+		s := d.readString()
+		if !validJSONNumber(s) {
+			d.fail("invalid number literal %q", s)
+			return "", false
+		}
+		return json.Number(s), d.err == nil
+	}
+	s, ok := d.readNumber()
+	return json.Number(s), ok
+}
+
+// raw returns a copy of the next value.
+func (d *jsonDecoder) raw() json.RawMessage {
+	d.peek()
+	start := d.pos
+	d.skip()
+	if d.err != nil {
+		return nil
+	}
+	return append(json.RawMessage(nil), d.buf[start:d.pos]...)
+}
+
+// unmarshal passes the next value to the UnmarshalJSON method of v.
+func (d *jsonDecoder) unmarshal(v json.Unmarshaler) {
+	d.peek()
+	start := d.pos
+	d.skip()
+	if d.err != nil {
+		return
+	}
+	if err := v.UnmarshalJSON(d.buf[start:d.pos]); err != nil {
+		d.err = err
+		d.pos = len(d.buf)
+	}
+}
+
+// skip reads and validates the next value without keeping it.
+func (d *jsonDecoder) skip() {
+	switch c := d.peek(); {
+	case c == '{':
+		for o := d.object(); o.next(); {
+			d.skip()
+		}
+	case c == '[':
+		for a := d.array(); a.next(); {
+			d.skip()
+		}
+	case c == '"':
+		d.readString()
+	case c == '-' || c >= '0' && c <= '9':
+		d.readNumber()
+	case d.literal("true"), d.literal("false"), d.null():
+	default:
+		d.unexpected("value")
+	}
+}
+
+// readNumber reads a number literal.
+func (d *jsonDecoder) readNumber() (string, bool) {
+	d.peek()
+	start := d.pos
+	for d.pos < len(d.buf) {
+		c := d.buf[d.pos]
+		if c != '-' && c != '+' && c != '.' && c != 'e' && c != 'E' && (c < '0' || c > '9') {
+			break
+		}
+		d.pos++
+	}
+	s := string(d.buf[start:d.pos])
+	if !validJSONNumber(s) {
+		d.pos = start
+		d.unexpected("number")
+		return "", false
+	}
+	return s, true
+}
+
+// readString reads a string literal, replacing invalid UTF-8 and lone surrogates with U+FFFD.
+func (d *jsonDecoder) readString() string {
+	return string(d.readStringBytes())
+}
+
+// readStringBytes reads a string literal. Without escapes, the result refers to the input.
+func (d *jsonDecoder) readStringBytes() []byte {
+	d.pos++
+	start := d.pos
+	// fast path without escapes
+	for d.pos < len(d.buf) {
+		c := d.buf[d.pos]
+		if c == '"' {
+			s := d.buf[start:d.pos]
+			d.pos++
+			return s
+		}
+		if c == '\\' || c < ' ' || c >= utf8.RuneSelf {
+			break
+		}
+		d.pos++
+	}
+	b := append([]byte(nil), d.buf[start:d.pos]...)
+	for d.pos < len(d.buf) {
+		c := d.buf[d.pos]
+		switch {
+		case c == '"':
+			d.pos++
+			return b
+		case c < ' ':
+			d.fail("invalid character %q in string literal", c)
+			return nil
+		case c == '\\':
+			if d.pos+1 >= len(d.buf) {
+				d.fail("unexpected end of JSON input")
+				return nil
+			}
+			d.pos += 2
+			switch e := d.buf[d.pos-1]; e {
+			case '"', '\\', '/':
+				b = append(b, e)
+			case 'b':
+				b = append(b, '\b')
+			case 'f':
+				b = append(b, '\f')
+			case 'n':
+				b = append(b, '\n')
+			case 'r':
+				b = append(b, '\r')
+			case 't':
+				b = append(b, '\t')
+			case 'u':
+				r := d.readHex()
+				if utf16.IsSurrogate(r) {
+					r2 := rune(-1)
+					if bytes.HasPrefix(d.buf[d.pos:], []byte(`\u`)) {
+						pos := d.pos
+						d.pos += 2
+						if r2 = d.readHex(); utf16.DecodeRune(r, r2) == utf8.RuneError {
+							d.pos, r2 = pos, -1
+						}
+					}
+					r = utf16.DecodeRune(r, r2)
+				}
+				if r < 0 {
+					return nil
+				}
+				b = utf8.AppendRune(b, r)
+			default:
+				d.fail("invalid escape sequence \\%c in string literal", e)
+				return nil
+			}
+		case c < utf8.RuneSelf:
+			b = append(b, c)
+			d.pos++
+		default:
+			r, size := utf8.DecodeRune(d.buf[d.pos:])
+			b = utf8.AppendRune(b, r)
+			d.pos += size
+		}
+	}
+	d.fail("unexpected end of JSON input")
+	return nil
+}
+
+// readHex reads the four hex digits of a \u escape. It returns -1 on error.
+func (d *jsonDecoder) readHex() rune {
+	if d.pos+4 > len(d.buf) {
+		d.fail("unexpected end of JSON input")
+		return -1
+	}
+	r, err := strconv.ParseUint(string(d.buf[d.pos:d.pos+4]), 16, 32)
+	if err != nil {
+		d.fail("invalid escape sequence in string literal")
+		return -1
+	}
+	d.pos += 4
+	return rune(r)
+}
+
+// validJSONNumber reports whether s is a valid JSON number literal.
+func validJSONNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	if s[0] == '-' {
+		s = s[1:]
+		if s == "" {
+			return false
+		}
+	}
+	switch {
+	case s[0] == '0':
+		s = s[1:]
+	case '1' <= s[0] && s[0] <= '9':
+		s = s[1:]
+		for len(s) > 0 && '0' <= s[0] && s[0] <= '9' {
+			s = s[1:]
+		}
+	default:
+		return false
+	}
+	if len(s) >= 2 && s[0] == '.' && '0' <= s[1] && s[1] <= '9' {
+		s = s[2:]
+		for len(s) > 0 && '0' <= s[0] && s[0] <= '9' {
+			s = s[1:]
+		}
+	}
+	if len(s) >= 2 && (s[0] == 'e' || s[0] == 'E') {
+		s = s[1:]
+		if s[0] == '+' || s[0] == '-' {
+			s = s[1:]
+			if s == "" {
+				return false
+			}
+		}
+		for len(s) > 0 && '0' <= s[0] && s[0] <= '9' {
+			s = s[1:]
+		}
+	}
+	return s == ""
+}
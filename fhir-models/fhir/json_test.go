@@ -0,0 +1,272 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// TestMarshalJSONExample checks that the generated encoder writes the compact form of a document which starts with
+// resourceType and has its members in the order of the definitions.
+func TestMarshalJSONExample(t *testing.T) {
+	input, err := os.ReadFile("testdata/patient-example.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	patient, err := UnmarshalPatient(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := patient.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := json.Marshal(json.RawMessage(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestMarshalJSONLikeEncodingJSON checks that the generated encoder writes the same bytes as encoding/json did with
+// the former MarshalJSON methods, which copied resources into a struct with the field ResourceType added last, so
+// encoding/json wrote resourceType as last member. The generated encoder writes it first instead, which is the only
+// difference.
+//
+// Every struct value in the documents is compared to encoding/json encoding it as a type without methods, so its
+// fields of struct types are encoded by their generated methods, which are compared to encoding/json on their own.
+func TestMarshalJSONLikeEncodingJSON(t *testing.T) {
+	var documents []interface{}
+	for _, name := range []string{"patient-example.json", "observation-example.json"} {
+		input, err := os.ReadFile("testdata/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resource, err := DecodeResource(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		documents = append(documents, resource)
+	}
+	family, given, unit := "Doe", "John", "mg"
+	female, lessThan := AdministrativeGenderFemale, QuantityComparatorLessThan
+	decimals := []json.Number{"1.50", "-0", "-0.0", "1e3", "1.0E-7", "123456789012345678901234567890.000"}
+	var values []Quantity
+	for i := range decimals {
+		values = append(values, Quantity{Value: &decimals[i], Comparator: &lessThan, Unit: &unit})
+	}
+	documents = append(documents,
+		Patient{},
+		Patient{
+			Gender:        &female,
+			GenderElement: &Element{Id: stringPtr("g")},
+			Identifier:    []Identifier{},
+			Name: []HumanName{
+				{Family: &family, Given: []string{given, given}, GivenElement: []*Element{nil, {Id: stringPtr("e")}}},
+				{},
+			},
+			Telecom:   []ContactPoint{},
+			Contained: []json.RawMessage{json.RawMessage(`{"resourceType":"Organization","id":"o"}`)},
+		},
+		Observation{Status: ObservationStatusFinal, Code: CodeableConcept{Coding: []Coding{}}, ValueQuantity: &values[0]},
+		Observation{Status: ObservationStatusAmended, Component: []ObservationComponent{
+			{Code: CodeableConcept{Text: &unit}, ValueQuantity: &values[1]},
+		}},
+		values,
+	)
+
+	type plainBundle Bundle
+	baselines := map[reflect.Type]func(v interface{}) interface{}{
+		reflect.TypeOf(Patient{}): func(v interface{}) interface{} {
+			type plain Patient
+			return struct {
+				plain
+				ResourceType string `json:"resourceType"`
+			}{plain(v.(Patient)), "Patient"}
+		},
+		reflect.TypeOf(Observation{}): func(v interface{}) interface{} {
+			type plain Observation
+			return struct {
+				plain
+				ResourceType string `json:"resourceType"`
+			}{plain(v.(Observation)), "Observation"}
+		},
+		reflect.TypeOf(Address{}):         func(v interface{}) interface{} { type plain Address; return plain(v.(Address)) },
+		reflect.TypeOf(CodeableConcept{}): func(v interface{}) interface{} { type plain CodeableConcept; return plain(v.(CodeableConcept)) },
+		reflect.TypeOf(Coding{}):          func(v interface{}) interface{} { type plain Coding; return plain(v.(Coding)) },
+		reflect.TypeOf(ContactPoint{}):    func(v interface{}) interface{} { type plain ContactPoint; return plain(v.(ContactPoint)) },
+		reflect.TypeOf(Element{}):         func(v interface{}) interface{} { type plain Element; return plain(v.(Element)) },
+		reflect.TypeOf(Extension{}):       func(v interface{}) interface{} { type plain Extension; return plain(v.(Extension)) },
+		reflect.TypeOf(HumanName{}):       func(v interface{}) interface{} { type plain HumanName; return plain(v.(HumanName)) },
+		reflect.TypeOf(Identifier{}):      func(v interface{}) interface{} { type plain Identifier; return plain(v.(Identifier)) },
+		reflect.TypeOf(Meta{}):            func(v interface{}) interface{} { type plain Meta; return plain(v.(Meta)) },
+		reflect.TypeOf(Narrative{}):       func(v interface{}) interface{} { type plain Narrative; return plain(v.(Narrative)) },
+		reflect.TypeOf(Period{}):          func(v interface{}) interface{} { type plain Period; return plain(v.(Period)) },
+		reflect.TypeOf(Quantity{}):        func(v interface{}) interface{} { type plain Quantity; return plain(v.(Quantity)) },
+		reflect.TypeOf(Reference{}):       func(v interface{}) interface{} { type plain Reference; return plain(v.(Reference)) },
+		reflect.TypeOf(ObservationComponent{}): func(v interface{}) interface{} {
+			type plain ObservationComponent
+			return plain(v.(ObservationComponent))
+		},
+		reflect.TypeOf(ObservationReferenceRange{}): func(v interface{}) interface{} {
+			type plain ObservationReferenceRange
+			return plain(v.(ObservationReferenceRange))
+		},
+		reflect.TypeOf(PatientContact{}): func(v interface{}) interface{} { type plain PatientContact; return plain(v.(PatientContact)) },
+		reflect.TypeOf(PatientLink{}):    func(v interface{}) interface{} { type plain PatientLink; return plain(v.(PatientLink)) },
+	}
+
+	for _, document := range documents {
+		visitStructs(reflect.ValueOf(document), func(v reflect.Value) {
+			baseline, ok := baselines[v.Type()]
+			if !ok {
+				t.Errorf("no baseline for %s", v.Type())
+				return
+			}
+			got, err := v.Interface().(json.Marshaler).MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := json.Marshal(baseline(v.Interface()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := v.Interface().(Patient); ok {
+				want = resourceTypeFirst(t, want)
+			} else if _, ok := v.Interface().(Observation); ok {
+				want = resourceTypeFirst(t, want)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s: got\n%s\nwant\n%s", v.Type(), got, want)
+			}
+		})
+	}
+}
+
+// visitStructs calls visit for the value and every value nested in it which is a struct.
+func visitStructs(v reflect.Value, visit func(reflect.Value)) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			visitStructs(v.Elem(), visit)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			visitStructs(v.Index(i), visit)
+		}
+	case reflect.Struct:
+		visit(v)
+		for i := 0; i < v.NumField(); i++ {
+			visitStructs(v.Field(i), visit)
+		}
+	}
+}
+
+// resourceTypeFirst moves the resourceType member, which encoding/json wrote last, to the front of the document.
+func resourceTypeFirst(t *testing.T, document []byte) []byte {
+	t.Helper()
+	i := bytes.LastIndex(document, []byte(`"resourceType":`))
+	if i < 0 || !bytes.HasSuffix(document, []byte("}")) {
+		t.Fatalf("%s has no resourceType", document)
+	}
+	member := document[i : len(document)-1]
+	rest := bytes.TrimSuffix(document[1:i], []byte(","))
+	if len(rest) == 0 {
+		return []byte("{" + string(member) + "}")
+	}
+	return []byte("{" + string(member) + "," + string(rest) + "}")
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	input, err := os.ReadFile("testdata/observation-example.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	observation, err := UnmarshalObservation(input)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := observation.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("encoding/json", func(b *testing.B) {
+		// reflection on the struct without the generated methods, its fields still use theirs
+		type plainObservation Observation
+		plain := plainObservation(observation)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := json.Marshal(plain); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("encoding/json generic", func(b *testing.B) {
+		var generic map[string]interface{}
+		if err := json.Unmarshal(input, &generic); err != nil {
+			b.Fatal(err)
+		}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := json.Marshal(generic); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	input, err := os.ReadFile("testdata/observation-example.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := UnmarshalObservation(input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("encoding/json", func(b *testing.B) {
+		type plainObservation Observation
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var plain plainObservation
+			if err := json.Unmarshal(input, &plain); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("encoding/json generic", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var generic map[string]interface{}
+			if err := json.Unmarshal(input, &generic); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
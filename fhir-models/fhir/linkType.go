@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code LinkType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *LinkType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *LinkType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "replaced-by":
 		*code = LinkTypeReplacedBy
@@ -52,6 +56,12 @@ func (code *LinkType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code LinkType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *LinkType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code LinkType) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code LinkType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code LinkType) protoNumber() uint64 {
+	switch code {
+	case LinkTypeReplacedBy:
+		return 1
+	case LinkTypeReplaces:
+		return 2
+	case LinkTypeRefer:
+		return 3
+	case LinkTypeSeealso:
+		return 4
+	}
+	return 0
+}
+func (code *LinkType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = LinkTypeReplacedBy
+	case 2:
+		*code = LinkTypeReplaces
+	case 3:
+		*code = LinkTypeRefer
+	case 4:
+		*code = LinkTypeSeealso
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code LinkageType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *LinkageType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *LinkageType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "source":
 		*code = LinkageTypeSource
@@ -49,6 +53,12 @@ func (code *LinkageType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code LinkageType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *LinkageType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code LinkageType) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code LinkageType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code LinkageType) protoNumber() uint64 {
+	switch code {
+	case LinkageTypeSource:
+		return 1
+	case LinkageTypeAlternate:
+		return 2
+	case LinkageTypeHistorical:
+		return 3
+	}
+	return 0
+}
+func (code *LinkageType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = LinkageTypeSource
+	case 2:
+		*code = LinkageTypeAlternate
+	case 3:
+		*code = LinkageTypeHistorical
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ListMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ListMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ListMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "working":
 		*code = ListModeWorking
@@ -49,6 +53,12 @@ func (code *ListMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ListMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ListMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ListMode) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ListMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ListMode) protoNumber() uint64 {
+	switch code {
+	case ListModeWorking:
+		return 1
+	case ListModeSnapshot:
+		return 2
+	case ListModeChanges:
+		return 3
+	}
+	return 0
+}
+func (code *ListMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ListModeWorking
+	case 2:
+		*code = ListModeSnapshot
+	case 3:
+		*code = ListModeChanges
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ListStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ListStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ListStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "current":
 		*code = ListStatusCurrent
@@ -49,6 +53,12 @@ func (code *ListStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ListStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ListStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ListStatus) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ListStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ListStatus) protoNumber() uint64 {
+	switch code {
+	case ListStatusCurrent:
+		return 1
+	case ListStatusRetired:
+		return 2
+	case ListStatusEnteredInError:
+		return 3
+	}
+	return 0
+}
+func (code *ListStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ListStatusCurrent
+	case 2:
+		*code = ListStatusRetired
+	case 3:
+		*code = ListStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
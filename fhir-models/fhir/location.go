@@ -14,72 +14,2675 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Location is documented here http://hl7.org/fhir/StructureDefinition/Location
 type Location struct {
-	Id                     *string                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status                 *LocationStatus            `bson:"status,omitempty" json:"status,omitempty"`
-	OperationalStatus      *Coding                    `bson:"operationalStatus,omitempty" json:"operationalStatus,omitempty"`
-	Name                   *string                    `bson:"name,omitempty" json:"name,omitempty"`
-	Alias                  []string                   `bson:"alias,omitempty" json:"alias,omitempty"`
-	Description            *string                    `bson:"description,omitempty" json:"description,omitempty"`
-	Mode                   *LocationMode              `bson:"mode,omitempty" json:"mode,omitempty"`
-	Type                   []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
-	Telecom                []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address                *Address                   `bson:"address,omitempty" json:"address,omitempty"`
-	PhysicalType           *CodeableConcept           `bson:"physicalType,omitempty" json:"physicalType,omitempty"`
-	Position               *LocationPosition          `bson:"position,omitempty" json:"position,omitempty"`
-	ManagingOrganization   *Reference                 `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
-	PartOf                 *Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	HoursOfOperation       []LocationHoursOfOperation `bson:"hoursOfOperation,omitempty" json:"hoursOfOperation,omitempty"`
-	AvailabilityExceptions *string                    `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
-	Endpoint               []Reference                `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	Id                            *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                     *Element                   `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                          *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     []json.RawMessage          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                    []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                        *LocationStatus            `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement                 *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	OperationalStatus             *Coding                    `bson:"operationalStatus,omitempty" json:"operationalStatus,omitempty"`
+	Name                          *string                    `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement                   *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	Alias                         []string                   `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement                  []*Element                 `bson:"_alias,omitempty" json:"_alias,omitempty"`
+	Description                   *string                    `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement            *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	Mode                          *LocationMode              `bson:"mode,omitempty" json:"mode,omitempty"`
+	ModeElement                   *Element                   `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Type                          []CodeableConcept          `bson:"type,omitempty" json:"type,omitempty"`
+	Telecom                       []ContactPoint             `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address                       *Address                   `bson:"address,omitempty" json:"address,omitempty"`
+	PhysicalType                  *CodeableConcept           `bson:"physicalType,omitempty" json:"physicalType,omitempty"`
+	Position                      *LocationPosition          `bson:"position,omitempty" json:"position,omitempty"`
+	ManagingOrganization          *Reference                 `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
+	PartOf                        *Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	HoursOfOperation              []LocationHoursOfOperation `bson:"hoursOfOperation,omitempty" json:"hoursOfOperation,omitempty"`
+	AvailabilityExceptions        *string                    `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *Element                   `bson:"_availabilityExceptions,omitempty" json:"_availabilityExceptions,omitempty"`
+	Endpoint                      []Reference                `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
 }
 type LocationPosition struct {
 	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Longitude         json.Number  `bson:"longitude" json:"longitude"`
+	LongitudeElement  *Element     `bson:"_longitude,omitempty" json:"_longitude,omitempty"`
 	Latitude          json.Number  `bson:"latitude" json:"latitude"`
+	LatitudeElement   *Element     `bson:"_latitude,omitempty" json:"_latitude,omitempty"`
 	Altitude          *json.Number `bson:"altitude,omitempty" json:"altitude,omitempty"`
+	AltitudeElement   *Element     `bson:"_altitude,omitempty" json:"_altitude,omitempty"`
 }
 type LocationHoursOfOperation struct {
-	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DaysOfWeek        []DaysOfWeek `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
-	AllDay            *bool        `bson:"allDay,omitempty" json:"allDay,omitempty"`
-	OpeningTime       *string      `bson:"openingTime,omitempty" json:"openingTime,omitempty"`
-	ClosingTime       *string      `bson:"closingTime,omitempty" json:"closingTime,omitempty"`
+	Id                 *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DaysOfWeek         []DaysOfWeek `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement  []*Element   `bson:"_daysOfWeek,omitempty" json:"_daysOfWeek,omitempty"`
+	AllDay             *bool        `bson:"allDay,omitempty" json:"allDay,omitempty"`
+	AllDayElement      *Element     `bson:"_allDay,omitempty" json:"_allDay,omitempty"`
+	OpeningTime        *string      `bson:"openingTime,omitempty" json:"openingTime,omitempty"`
+	OpeningTimeElement *Element     `bson:"_openingTime,omitempty" json:"_openingTime,omitempty"`
+	ClosingTime        *string      `bson:"closingTime,omitempty" json:"closingTime,omitempty"`
+	ClosingTimeElement *Element     `bson:"_closingTime,omitempty" json:"_closingTime,omitempty"`
 }
-type OtherLocation Location
 
 // MarshalJSON marshals the given Location as JSON into a byte slice
 func (r Location) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherLocation
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherLocation: OtherLocation(r),
-		ResourceType:  "Location",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Location as JSON object with the members in the order of the fields
+func (r Location) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Location\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.OperationalStatus != nil {
+		e.key("operationalStatus")
+		r.OperationalStatus.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if len(r.Alias) > 0 {
+		e.key("alias")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Alias {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AliasElement) > 0 {
+		e.key("_alias")
+		e.elements(r.AliasElement, len(r.Alias))
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Mode != nil {
+		e.key("mode")
+		e.string(r.Mode.Code())
+	}
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Address != nil {
+		e.key("address")
+		r.Address.appendJSON(e)
+	}
+	if r.PhysicalType != nil {
+		e.key("physicalType")
+		r.PhysicalType.appendJSON(e)
+	}
+	if r.Position != nil {
+		e.key("position")
+		r.Position.appendJSON(e)
+	}
+	if r.ManagingOrganization != nil {
+		e.key("managingOrganization")
+		r.ManagingOrganization.appendJSON(e)
+	}
+	if r.PartOf != nil {
+		e.key("partOf")
+		r.PartOf.appendJSON(e)
+	}
+	if len(r.HoursOfOperation) > 0 {
+		e.key("hoursOfOperation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.HoursOfOperation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AvailabilityExceptions != nil {
+		e.key("availabilityExceptions")
+		e.string(*r.AvailabilityExceptions)
+	}
+	if r.AvailabilityExceptionsElement != nil {
+		e.key("_availabilityExceptions")
+		r.AvailabilityExceptionsElement.appendJSON(e)
+	}
+	if len(r.Endpoint) > 0 {
+		e.key("endpoint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endpoint {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Location from JSON. Unknown members are ignored.
+func (r *Location) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Location
+func (r *Location) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v LocationStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "operationalStatus":
+			if d.null() {
+				r.OperationalStatus = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.OperationalStatus = &v
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "alias":
+			a := d.array()
+			r.Alias = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Alias = append(r.Alias, v)
+			}
+			if r.Alias == nil && !a.isNull {
+				r.Alias = []string{}
+			}
+		case "_alias":
+			a := d.array()
+			r.AliasElement = nil
+			for a.next() {
+				r.AliasElement = append(r.AliasElement, d.element())
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "mode":
+			if d.null() {
+				r.Mode = nil
+			} else {
+				var v LocationMode
+				d.unmarshal(&v)
+				r.Mode = &v
+			}
+		case "_mode":
+			r.ModeElement = d.element()
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "address":
+			if d.null() {
+				r.Address = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.Address = &v
+			}
+		case "physicalType":
+			if d.null() {
+				r.PhysicalType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PhysicalType = &v
+			}
+		case "position":
+			if d.null() {
+				r.Position = nil
+			} else {
+				var v LocationPosition
+				v.decodeJSON(d)
+				r.Position = &v
+			}
+		case "managingOrganization":
+			if d.null() {
+				r.ManagingOrganization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ManagingOrganization = &v
+			}
+		case "partOf":
+			if d.null() {
+				r.PartOf = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = &v
+			}
+		case "hoursOfOperation":
+			a := d.array()
+			r.HoursOfOperation = nil
+			for a.next() {
+				var v LocationHoursOfOperation
+				v.decodeJSON(d)
+				r.HoursOfOperation = append(r.HoursOfOperation, v)
+			}
+			if r.HoursOfOperation == nil && !a.isNull {
+				r.HoursOfOperation = []LocationHoursOfOperation{}
+			}
+		case "availabilityExceptions":
+			if v, ok := d.string(); ok {
+				r.AvailabilityExceptions = &v
+			} else {
+				r.AvailabilityExceptions = nil
+			}
+		case "_availabilityExceptions":
+			r.AvailabilityExceptionsElement = d.element()
+		case "endpoint":
+			a := d.array()
+			r.Endpoint = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Endpoint = append(r.Endpoint, v)
+			}
+			if r.Endpoint == nil && !a.isNull {
+				r.Endpoint = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Location which shares no memory with the original
+func (r Location) DeepCopy() Location {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.OperationalStatus != nil {
+		v := r.OperationalStatus.DeepCopy()
+		out.OperationalStatus = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Alias != nil {
+		out.Alias = make([]string, len(r.Alias))
+		copy(out.Alias, r.Alias)
+	}
+	if r.AliasElement != nil {
+		out.AliasElement = make([]*Element, len(r.AliasElement))
+		for i, v := range r.AliasElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.AliasElement[i] = &c
+			}
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Mode != nil {
+		v := *r.Mode
+		out.Mode = &v
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.Address != nil {
+		v := r.Address.DeepCopy()
+		out.Address = &v
+	}
+	if r.PhysicalType != nil {
+		v := r.PhysicalType.DeepCopy()
+		out.PhysicalType = &v
+	}
+	if r.Position != nil {
+		v := r.Position.DeepCopy()
+		out.Position = &v
+	}
+	if r.ManagingOrganization != nil {
+		v := r.ManagingOrganization.DeepCopy()
+		out.ManagingOrganization = &v
+	}
+	if r.PartOf != nil {
+		v := r.PartOf.DeepCopy()
+		out.PartOf = &v
+	}
+	if r.HoursOfOperation != nil {
+		out.HoursOfOperation = make([]LocationHoursOfOperation, len(r.HoursOfOperation))
+		for i := range r.HoursOfOperation {
+			out.HoursOfOperation[i] = r.HoursOfOperation[i].DeepCopy()
+		}
+	}
+	if r.AvailabilityExceptions != nil {
+		v := *r.AvailabilityExceptions
+		out.AvailabilityExceptions = &v
+	}
+	if r.AvailabilityExceptionsElement != nil {
+		v := r.AvailabilityExceptionsElement.DeepCopy()
+		out.AvailabilityExceptionsElement = &v
+	}
+	if r.Endpoint != nil {
+		out.Endpoint = make([]Reference, len(r.Endpoint))
+		for i := range r.Endpoint {
+			out.Endpoint[i] = r.Endpoint[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Location) Equal(other Location) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.OperationalStatus == nil) != (other.OperationalStatus == nil) || r.OperationalStatus != nil && !r.OperationalStatus.Equal(*other.OperationalStatus) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if len(r.Alias) != len(other.Alias) {
+		return false
+	}
+	for i := range r.Alias {
+		if r.Alias[i] != other.Alias[i] {
+			return false
+		}
+	}
+	if !equalElements(r.AliasElement, other.AliasElement, false) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Mode == nil) != (other.Mode == nil) || r.Mode != nil && *r.Mode != *other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if (r.Address == nil) != (other.Address == nil) || r.Address != nil && !r.Address.Equal(*other.Address) {
+		return false
+	}
+	if (r.PhysicalType == nil) != (other.PhysicalType == nil) || r.PhysicalType != nil && !r.PhysicalType.Equal(*other.PhysicalType) {
+		return false
+	}
+	if (r.Position == nil) != (other.Position == nil) || r.Position != nil && !r.Position.Equal(*other.Position) {
+		return false
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.Equal(*other.ManagingOrganization) {
+		return false
+	}
+	if (r.PartOf == nil) != (other.PartOf == nil) || r.PartOf != nil && !r.PartOf.Equal(*other.PartOf) {
+		return false
+	}
+	if len(r.HoursOfOperation) != len(other.HoursOfOperation) {
+		return false
+	}
+	for i := range r.HoursOfOperation {
+		if !r.HoursOfOperation[i].Equal(other.HoursOfOperation[i]) {
+			return false
+		}
+	}
+	if (r.AvailabilityExceptions == nil) != (other.AvailabilityExceptions == nil) || r.AvailabilityExceptions != nil && *r.AvailabilityExceptions != *other.AvailabilityExceptions {
+		return false
+	}
+	if (r.AvailabilityExceptionsElement == nil) != (other.AvailabilityExceptionsElement == nil) || r.AvailabilityExceptionsElement != nil && !r.AvailabilityExceptionsElement.Equal(*other.AvailabilityExceptionsElement) {
+		return false
+	}
+	if len(r.Endpoint) != len(other.Endpoint) {
+		return false
+	}
+	for i := range r.Endpoint {
+		if !r.Endpoint[i].Equal(other.Endpoint[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Location) EqualsDeep(other Location) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.OperationalStatus == nil) != (other.OperationalStatus == nil) || r.OperationalStatus != nil && !r.OperationalStatus.EqualsDeep(*other.OperationalStatus) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if !equivalentList(len(r.Alias), len(other.Alias), func(i, j int) bool {
+		return equivalentString(r.Alias[i], other.Alias[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.AliasElement, other.AliasElement, true) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Mode == nil) != (other.Mode == nil) || r.Mode != nil && *r.Mode != *other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if (r.Address == nil) != (other.Address == nil) || r.Address != nil && !r.Address.EqualsDeep(*other.Address) {
+		return false
+	}
+	if (r.PhysicalType == nil) != (other.PhysicalType == nil) || r.PhysicalType != nil && !r.PhysicalType.EqualsDeep(*other.PhysicalType) {
+		return false
+	}
+	if (r.Position == nil) != (other.Position == nil) || r.Position != nil && !r.Position.EqualsDeep(*other.Position) {
+		return false
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.EqualsDeep(*other.ManagingOrganization) {
+		return false
+	}
+	if (r.PartOf == nil) != (other.PartOf == nil) || r.PartOf != nil && !r.PartOf.EqualsDeep(*other.PartOf) {
+		return false
+	}
+	if !equivalentList(len(r.HoursOfOperation), len(other.HoursOfOperation), func(i, j int) bool {
+		return r.HoursOfOperation[i].EqualsDeep(other.HoursOfOperation[j])
+	}) {
+		return false
+	}
+	if (r.AvailabilityExceptions == nil) != (other.AvailabilityExceptions == nil) || r.AvailabilityExceptions != nil && !equivalentString(*r.AvailabilityExceptions, *other.AvailabilityExceptions) {
+		return false
+	}
+	if (r.AvailabilityExceptionsElement == nil) != (other.AvailabilityExceptionsElement == nil) || r.AvailabilityExceptionsElement != nil && !r.AvailabilityExceptionsElement.EqualsDeep(*other.AvailabilityExceptionsElement) {
+		return false
+	}
+	if !equivalentList(len(r.Endpoint), len(other.Endpoint), func(i, j int) bool {
+		return r.Endpoint[i].EqualsDeep(other.Endpoint[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Location) EqualsShallow(other Location) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if !equivalentList(len(r.Alias), len(other.Alias), func(i, j int) bool {
+		return equivalentString(r.Alias[i], other.Alias[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Mode == nil) != (other.Mode == nil) || r.Mode != nil && *r.Mode != *other.Mode {
+		return false
+	}
+	if (r.AvailabilityExceptions == nil) != (other.AvailabilityExceptions == nil) || r.AvailabilityExceptions != nil && !equivalentString(*r.AvailabilityExceptions, *other.AvailabilityExceptions) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Location as FHIR XML
+func (r Location) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Location",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "operationalStatus", r.OperationalStatus); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	for i, v := range r.Alias {
+		if err := encodeXMLPrimitive(e, "alias", v, elementAt(r.AliasElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "address", r.Address); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "physicalType", r.PhysicalType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "position", r.Position); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "managingOrganization", r.ManagingOrganization); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "partOf", r.PartOf); err != nil {
+		return err
+	}
+	for _, v := range r.HoursOfOperation {
+		if err := encodeXMLElement(e, "hoursOfOperation", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "availabilityExceptions", r.AvailabilityExceptions, r.AvailabilityExceptionsElement); err != nil {
+		return err
+	}
+	for _, v := range r.Endpoint {
+		if err := encodeXMLElement(e, "endpoint", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Location from FHIR XML
+func (r *Location) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v LocationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "operationalStatus":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OperationalStatus = &v
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "alias":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.AliasElement = appendElement(r.AliasElement, len(r.Alias), element)
+					r.Alias = append(r.Alias, v)
+				}
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "mode":
+				var v LocationMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = &v
+				}
+				r.ModeElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "address":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Address = &v
+			case "physicalType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PhysicalType = &v
+			case "position":
+				var v LocationPosition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Position = &v
+			case "managingOrganization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManagingOrganization = &v
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = &v
+			case "hoursOfOperation":
+				var v LocationHoursOfOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.HoursOfOperation = append(r.HoursOfOperation, v)
+			case "availabilityExceptions":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AvailabilityExceptions = &v
+				}
+				r.AvailabilityExceptionsElement = element
+			case "endpoint":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endpoint = append(r.Endpoint, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Location as properties to the RDF node n
+func (r Location) turtle(n *turtleNode) {
+	n.resource("Location", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Location.identifier", i, v)
+	}
+	n.primitive("Location.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Location.operationalStatus", -1, r.OperationalStatus)
+	n.primitive("Location.name", -1, r.Name, r.NameElement, "string")
+	for i, v := range r.Alias {
+		n.primitive("Location.alias", i, v, elementAt(r.AliasElement, i), "string")
+	}
+	n.primitive("Location.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("Location.mode", -1, r.Mode, r.ModeElement, "code")
+	for i, v := range r.Type {
+		n.element("Location.type", i, v)
+	}
+	for i, v := range r.Telecom {
+		n.element("Location.telecom", i, v)
+	}
+	n.element("Location.address", -1, r.Address)
+	n.element("Location.physicalType", -1, r.PhysicalType)
+	n.element("Location.position", -1, r.Position)
+	n.element("Location.managingOrganization", -1, r.ManagingOrganization)
+	n.element("Location.partOf", -1, r.PartOf)
+	for i, v := range r.HoursOfOperation {
+		n.element("Location.hoursOfOperation", i, v)
+	}
+	n.primitive("Location.availabilityExceptions", -1, r.AvailabilityExceptions, r.AvailabilityExceptionsElement, "string")
+	for i, v := range r.Endpoint {
+		n.element("Location.endpoint", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Location as BSON document with the structure of its FHIR JSON
+func (r Location) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Location from a BSON document with the structure of its FHIR JSON
+func (r *Location) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Location as protobuf message Location of fhir.proto
+func (r Location) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Location to the encoded message
+func (r Location) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Status != nil {
+		e.varint(10, r.Status.protoNumber())
+	}
+	if r.OperationalStatus != nil {
+		e.message(11, *r.OperationalStatus)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	for _, v := range r.Alias {
+		e.string(13, v)
+	}
+	if r.Description != nil {
+		e.string(14, *r.Description)
+	}
+	if r.Mode != nil {
+		e.varint(15, r.Mode.protoNumber())
+	}
+	for _, v := range r.Type {
+		e.message(16, v)
+	}
+	for _, v := range r.Telecom {
+		e.message(17, v)
+	}
+	if r.Address != nil {
+		e.message(18, *r.Address)
+	}
+	if r.PhysicalType != nil {
+		e.message(19, *r.PhysicalType)
+	}
+	if r.Position != nil {
+		e.message(20, *r.Position)
+	}
+	if r.ManagingOrganization != nil {
+		e.message(21, *r.ManagingOrganization)
+	}
+	if r.PartOf != nil {
+		e.message(22, *r.PartOf)
+	}
+	for _, v := range r.HoursOfOperation {
+		e.message(23, v)
+	}
+	if r.AvailabilityExceptions != nil {
+		e.string(24, *r.AvailabilityExceptions)
+	}
+	for _, v := range r.Endpoint {
+		e.message(25, v)
+	}
+	if r.IdElement != nil {
+		e.message(26, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(27, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(28, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(29, *r.StatusElement)
+	}
+	if r.NameElement != nil {
+		e.message(30, *r.NameElement)
+	}
+	for _, v := range r.AliasElement {
+		e.element(31, v)
+	}
+	if r.DescriptionElement != nil {
+		e.message(32, *r.DescriptionElement)
+	}
+	if r.ModeElement != nil {
+		e.message(33, *r.ModeElement)
+	}
+	if r.AvailabilityExceptionsElement != nil {
+		e.message(34, *r.AvailabilityExceptionsElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Location from protobuf message Location of fhir.proto
+func (r *Location) UnmarshalProto(b []byte) error {
+	*r = Location{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v LocationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 11:
+			var v Coding
+			d.message(&v)
+			r.OperationalStatus = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			r.Alias = append(r.Alias, d.string())
+		case 14:
+			v := d.string()
+			r.Description = &v
+		case 15:
+			var v LocationMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 17:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 18:
+			var v Address
+			d.message(&v)
+			r.Address = &v
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.PhysicalType = &v
+		case 20:
+			var v LocationPosition
+			d.message(&v)
+			r.Position = &v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.ManagingOrganization = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.PartOf = &v
+		case 23:
+			var v LocationHoursOfOperation
+			d.message(&v)
+			r.HoursOfOperation = append(r.HoursOfOperation, v)
+		case 24:
+			v := d.string()
+			r.AvailabilityExceptions = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Endpoint = append(r.Endpoint, v)
+		case 26:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 31:
+			r.AliasElement = append(r.AliasElement, d.element())
+		case 32:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.AvailabilityExceptionsElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given LocationPosition as JSON into a byte slice
+func (r LocationPosition) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the LocationPosition as JSON object with the members in the order of the fields
+func (r LocationPosition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("longitude")
+	e.number(r.Longitude)
+	if r.LongitudeElement != nil {
+		e.key("_longitude")
+		r.LongitudeElement.appendJSON(e)
+	}
+	e.key("latitude")
+	e.number(r.Latitude)
+	if r.LatitudeElement != nil {
+		e.key("_latitude")
+		r.LatitudeElement.appendJSON(e)
+	}
+	if r.Altitude != nil {
+		e.key("altitude")
+		e.number(*r.Altitude)
+	}
+	if r.AltitudeElement != nil {
+		e.key("_altitude")
+		r.AltitudeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given LocationPosition from JSON. Unknown members are ignored.
+func (r *LocationPosition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the LocationPosition
+func (r *LocationPosition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "longitude":
+			if v, ok := d.number(); ok {
+				r.Longitude = v
+			}
+		case "_longitude":
+			r.LongitudeElement = d.element()
+		case "latitude":
+			if v, ok := d.number(); ok {
+				r.Latitude = v
+			}
+		case "_latitude":
+			r.LatitudeElement = d.element()
+		case "altitude":
+			if v, ok := d.number(); ok {
+				r.Altitude = &v
+			} else {
+				r.Altitude = nil
+			}
+		case "_altitude":
+			r.AltitudeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the LocationPosition which shares no memory with the original
+func (r LocationPosition) DeepCopy() LocationPosition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.LongitudeElement != nil {
+		v := r.LongitudeElement.DeepCopy()
+		out.LongitudeElement = &v
+	}
+	if r.LatitudeElement != nil {
+		v := r.LatitudeElement.DeepCopy()
+		out.LatitudeElement = &v
+	}
+	if r.Altitude != nil {
+		v := *r.Altitude
+		out.Altitude = &v
+	}
+	if r.AltitudeElement != nil {
+		v := r.AltitudeElement.DeepCopy()
+		out.AltitudeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r LocationPosition) Equal(other LocationPosition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !EqualDecimal(r.Longitude, other.Longitude) {
+		return false
+	}
+	if (r.LongitudeElement == nil) != (other.LongitudeElement == nil) || r.LongitudeElement != nil && !r.LongitudeElement.Equal(*other.LongitudeElement) {
+		return false
+	}
+	if !EqualDecimal(r.Latitude, other.Latitude) {
+		return false
+	}
+	if (r.LatitudeElement == nil) != (other.LatitudeElement == nil) || r.LatitudeElement != nil && !r.LatitudeElement.Equal(*other.LatitudeElement) {
+		return false
+	}
+	if (r.Altitude == nil) != (other.Altitude == nil) || r.Altitude != nil && !EqualDecimal(*r.Altitude, *other.Altitude) {
+		return false
+	}
+	if (r.AltitudeElement == nil) != (other.AltitudeElement == nil) || r.AltitudeElement != nil && !r.AltitudeElement.Equal(*other.AltitudeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r LocationPosition) EqualsDeep(other LocationPosition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentDecimal(r.Longitude, other.Longitude) {
+		return false
+	}
+	if (r.LongitudeElement == nil) != (other.LongitudeElement == nil) || r.LongitudeElement != nil && !r.LongitudeElement.EqualsDeep(*other.LongitudeElement) {
+		return false
+	}
+	if !equivalentDecimal(r.Latitude, other.Latitude) {
+		return false
+	}
+	if (r.LatitudeElement == nil) != (other.LatitudeElement == nil) || r.LatitudeElement != nil && !r.LatitudeElement.EqualsDeep(*other.LatitudeElement) {
+		return false
+	}
+	if (r.Altitude == nil) != (other.Altitude == nil) || r.Altitude != nil && !equivalentDecimal(*r.Altitude, *other.Altitude) {
+		return false
+	}
+	if (r.AltitudeElement == nil) != (other.AltitudeElement == nil) || r.AltitudeElement != nil && !r.AltitudeElement.EqualsDeep(*other.AltitudeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r LocationPosition) EqualsShallow(other LocationPosition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentDecimal(r.Longitude, other.Longitude) {
+		return false
+	}
+	if !equivalentDecimal(r.Latitude, other.Latitude) {
+		return false
+	}
+	if (r.Altitude == nil) != (other.Altitude == nil) || r.Altitude != nil && !equivalentDecimal(*r.Altitude, *other.Altitude) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given LocationPosition as FHIR XML
+func (r LocationPosition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "longitude", r.Longitude, r.LongitudeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "latitude", r.Latitude, r.LatitudeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "altitude", r.Altitude, r.AltitudeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given LocationPosition from FHIR XML
+func (r *LocationPosition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "longitude":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Longitude = v
+				}
+				r.LongitudeElement = element
+			case "latitude":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Latitude = v
+				}
+				r.LatitudeElement = element
+			case "altitude":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Altitude = &v
+				}
+				r.AltitudeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the LocationPosition as properties to the RDF node n
+func (r LocationPosition) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Location.position.longitude", -1, r.Longitude, r.LongitudeElement, "decimal")
+	n.primitive("Location.position.latitude", -1, r.Latitude, r.LatitudeElement, "decimal")
+	n.primitive("Location.position.altitude", -1, r.Altitude, r.AltitudeElement, "decimal")
+}
+
+// MarshalBSON marshals the given LocationPosition as BSON document with the structure of its FHIR JSON
+func (r LocationPosition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given LocationPosition from a BSON document with the structure of its FHIR JSON
+func (r *LocationPosition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given LocationPosition as protobuf message LocationPosition of fhir.proto
+func (r LocationPosition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the LocationPosition to the encoded message
+func (r LocationPosition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Longitude != "" {
+		e.string(4, string(r.Longitude))
+	}
+	if r.Latitude != "" {
+		e.string(5, string(r.Latitude))
+	}
+	if r.Altitude != nil {
+		e.string(6, string(*r.Altitude))
+	}
+	if r.LongitudeElement != nil {
+		e.message(7, *r.LongitudeElement)
+	}
+	if r.LatitudeElement != nil {
+		e.message(8, *r.LatitudeElement)
+	}
+	if r.AltitudeElement != nil {
+		e.message(9, *r.AltitudeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given LocationPosition from protobuf message LocationPosition of fhir.proto
+func (r *LocationPosition) UnmarshalProto(b []byte) error {
+	*r = LocationPosition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Longitude = json.Number(d.string())
+		case 5:
+			r.Latitude = json.Number(d.string())
+		case 6:
+			v := json.Number(d.string())
+			r.Altitude = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.LongitudeElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.LatitudeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.AltitudeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given LocationHoursOfOperation as JSON into a byte slice
+func (r LocationHoursOfOperation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the LocationHoursOfOperation as JSON object with the members in the order of the fields
+func (r LocationHoursOfOperation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DaysOfWeek) > 0 {
+		e.key("daysOfWeek")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DaysOfWeek {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DaysOfWeekElement) > 0 {
+		e.key("_daysOfWeek")
+		e.elements(r.DaysOfWeekElement, len(r.DaysOfWeek))
+	}
+	if r.AllDay != nil {
+		e.key("allDay")
+		e.bool(*r.AllDay)
+	}
+	if r.AllDayElement != nil {
+		e.key("_allDay")
+		r.AllDayElement.appendJSON(e)
+	}
+	if r.OpeningTime != nil {
+		e.key("openingTime")
+		e.string(*r.OpeningTime)
+	}
+	if r.OpeningTimeElement != nil {
+		e.key("_openingTime")
+		r.OpeningTimeElement.appendJSON(e)
+	}
+	if r.ClosingTime != nil {
+		e.key("closingTime")
+		e.string(*r.ClosingTime)
+	}
+	if r.ClosingTimeElement != nil {
+		e.key("_closingTime")
+		r.ClosingTimeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given LocationHoursOfOperation from JSON. Unknown members are ignored.
+func (r *LocationHoursOfOperation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the LocationHoursOfOperation
+func (r *LocationHoursOfOperation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "daysOfWeek":
+			a := d.array()
+			r.DaysOfWeek = nil
+			for a.next() {
+				var v DaysOfWeek
+				d.unmarshal(&v)
+				r.DaysOfWeek = append(r.DaysOfWeek, v)
+			}
+			if r.DaysOfWeek == nil && !a.isNull {
+				r.DaysOfWeek = []DaysOfWeek{}
+			}
+		case "_daysOfWeek":
+			a := d.array()
+			r.DaysOfWeekElement = nil
+			for a.next() {
+				r.DaysOfWeekElement = append(r.DaysOfWeekElement, d.element())
+			}
+		case "allDay":
+			if v, ok := d.bool(); ok {
+				r.AllDay = &v
+			} else {
+				r.AllDay = nil
+			}
+		case "_allDay":
+			r.AllDayElement = d.element()
+		case "openingTime":
+			if v, ok := d.string(); ok {
+				r.OpeningTime = &v
+			} else {
+				r.OpeningTime = nil
+			}
+		case "_openingTime":
+			r.OpeningTimeElement = d.element()
+		case "closingTime":
+			if v, ok := d.string(); ok {
+				r.ClosingTime = &v
+			} else {
+				r.ClosingTime = nil
+			}
+		case "_closingTime":
+			r.ClosingTimeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the LocationHoursOfOperation which shares no memory with the original
+func (r LocationHoursOfOperation) DeepCopy() LocationHoursOfOperation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DaysOfWeek != nil {
+		out.DaysOfWeek = make([]DaysOfWeek, len(r.DaysOfWeek))
+		copy(out.DaysOfWeek, r.DaysOfWeek)
+	}
+	if r.DaysOfWeekElement != nil {
+		out.DaysOfWeekElement = make([]*Element, len(r.DaysOfWeekElement))
+		for i, v := range r.DaysOfWeekElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DaysOfWeekElement[i] = &c
+			}
+		}
+	}
+	if r.AllDay != nil {
+		v := *r.AllDay
+		out.AllDay = &v
+	}
+	if r.AllDayElement != nil {
+		v := r.AllDayElement.DeepCopy()
+		out.AllDayElement = &v
+	}
+	if r.OpeningTime != nil {
+		v := *r.OpeningTime
+		out.OpeningTime = &v
+	}
+	if r.OpeningTimeElement != nil {
+		v := r.OpeningTimeElement.DeepCopy()
+		out.OpeningTimeElement = &v
+	}
+	if r.ClosingTime != nil {
+		v := *r.ClosingTime
+		out.ClosingTime = &v
+	}
+	if r.ClosingTimeElement != nil {
+		v := r.ClosingTimeElement.DeepCopy()
+		out.ClosingTimeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r LocationHoursOfOperation) Equal(other LocationHoursOfOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.DaysOfWeek) != len(other.DaysOfWeek) {
+		return false
+	}
+	for i := range r.DaysOfWeek {
+		if r.DaysOfWeek[i] != other.DaysOfWeek[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DaysOfWeekElement, other.DaysOfWeekElement, false) {
+		return false
+	}
+	if (r.AllDay == nil) != (other.AllDay == nil) || r.AllDay != nil && *r.AllDay != *other.AllDay {
+		return false
+	}
+	if (r.AllDayElement == nil) != (other.AllDayElement == nil) || r.AllDayElement != nil && !r.AllDayElement.Equal(*other.AllDayElement) {
+		return false
+	}
+	if (r.OpeningTime == nil) != (other.OpeningTime == nil) || r.OpeningTime != nil && *r.OpeningTime != *other.OpeningTime {
+		return false
+	}
+	if (r.OpeningTimeElement == nil) != (other.OpeningTimeElement == nil) || r.OpeningTimeElement != nil && !r.OpeningTimeElement.Equal(*other.OpeningTimeElement) {
+		return false
+	}
+	if (r.ClosingTime == nil) != (other.ClosingTime == nil) || r.ClosingTime != nil && *r.ClosingTime != *other.ClosingTime {
+		return false
+	}
+	if (r.ClosingTimeElement == nil) != (other.ClosingTimeElement == nil) || r.ClosingTimeElement != nil && !r.ClosingTimeElement.Equal(*other.ClosingTimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r LocationHoursOfOperation) EqualsDeep(other LocationHoursOfOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DaysOfWeek), len(other.DaysOfWeek), func(i, j int) bool {
+		return r.DaysOfWeek[i] == other.DaysOfWeek[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.DaysOfWeekElement, other.DaysOfWeekElement, true) {
+		return false
+	}
+	if (r.AllDay == nil) != (other.AllDay == nil) || r.AllDay != nil && *r.AllDay != *other.AllDay {
+		return false
+	}
+	if (r.AllDayElement == nil) != (other.AllDayElement == nil) || r.AllDayElement != nil && !r.AllDayElement.EqualsDeep(*other.AllDayElement) {
+		return false
+	}
+	if (r.OpeningTime == nil) != (other.OpeningTime == nil) || r.OpeningTime != nil && !equivalentString(*r.OpeningTime, *other.OpeningTime) {
+		return false
+	}
+	if (r.OpeningTimeElement == nil) != (other.OpeningTimeElement == nil) || r.OpeningTimeElement != nil && !r.OpeningTimeElement.EqualsDeep(*other.OpeningTimeElement) {
+		return false
+	}
+	if (r.ClosingTime == nil) != (other.ClosingTime == nil) || r.ClosingTime != nil && !equivalentString(*r.ClosingTime, *other.ClosingTime) {
+		return false
+	}
+	if (r.ClosingTimeElement == nil) != (other.ClosingTimeElement == nil) || r.ClosingTimeElement != nil && !r.ClosingTimeElement.EqualsDeep(*other.ClosingTimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r LocationHoursOfOperation) EqualsShallow(other LocationHoursOfOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.DaysOfWeek), len(other.DaysOfWeek), func(i, j int) bool {
+		return r.DaysOfWeek[i] == other.DaysOfWeek[j]
+	}) {
+		return false
+	}
+	if (r.AllDay == nil) != (other.AllDay == nil) || r.AllDay != nil && *r.AllDay != *other.AllDay {
+		return false
+	}
+	if (r.OpeningTime == nil) != (other.OpeningTime == nil) || r.OpeningTime != nil && !equivalentString(*r.OpeningTime, *other.OpeningTime) {
+		return false
+	}
+	if (r.ClosingTime == nil) != (other.ClosingTime == nil) || r.ClosingTime != nil && !equivalentString(*r.ClosingTime, *other.ClosingTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given LocationHoursOfOperation as FHIR XML
+func (r LocationHoursOfOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.DaysOfWeek {
+		if err := encodeXMLPrimitive(e, "daysOfWeek", v, elementAt(r.DaysOfWeekElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "allDay", r.AllDay, r.AllDayElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "openingTime", r.OpeningTime, r.OpeningTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "closingTime", r.ClosingTime, r.ClosingTimeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given LocationHoursOfOperation from FHIR XML
+func (r *LocationHoursOfOperation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "daysOfWeek":
+				var v DaysOfWeek
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DaysOfWeekElement = appendElement(r.DaysOfWeekElement, len(r.DaysOfWeek), element)
+					r.DaysOfWeek = append(r.DaysOfWeek, v)
+				}
+			case "allDay":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllDay = &v
+				}
+				r.AllDayElement = element
+			case "openingTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OpeningTime = &v
+				}
+				r.OpeningTimeElement = element
+			case "closingTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ClosingTime = &v
+				}
+				r.ClosingTimeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the LocationHoursOfOperation as properties to the RDF node n
+func (r LocationHoursOfOperation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.DaysOfWeek {
+		n.primitive("Location.hoursOfOperation.daysOfWeek", i, v, elementAt(r.DaysOfWeekElement, i), "code")
+	}
+	n.primitive("Location.hoursOfOperation.allDay", -1, r.AllDay, r.AllDayElement, "boolean")
+	n.primitive("Location.hoursOfOperation.openingTime", -1, r.OpeningTime, r.OpeningTimeElement, "time")
+	n.primitive("Location.hoursOfOperation.closingTime", -1, r.ClosingTime, r.ClosingTimeElement, "time")
+}
+
+// MarshalBSON marshals the given LocationHoursOfOperation as BSON document with the structure of its FHIR JSON
+func (r LocationHoursOfOperation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given LocationHoursOfOperation from a BSON document with the structure of its FHIR JSON
+func (r *LocationHoursOfOperation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given LocationHoursOfOperation as protobuf message LocationHoursOfOperation of fhir.proto
+func (r LocationHoursOfOperation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the LocationHoursOfOperation to the encoded message
+func (r LocationHoursOfOperation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if len(r.DaysOfWeek) > 0 {
+		var p protoEncoder
+		for _, v := range r.DaysOfWeek {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(4, p.buf)
+	}
+	if r.AllDay != nil {
+		e.varint(5, protoBool(*r.AllDay))
+	}
+	if r.OpeningTime != nil {
+		e.string(6, *r.OpeningTime)
+	}
+	if r.ClosingTime != nil {
+		e.string(7, *r.ClosingTime)
+	}
+	for _, v := range r.DaysOfWeekElement {
+		e.element(8, v)
+	}
+	if r.AllDayElement != nil {
+		e.message(9, *r.AllDayElement)
+	}
+	if r.OpeningTimeElement != nil {
+		e.message(10, *r.OpeningTimeElement)
+	}
+	if r.ClosingTimeElement != nil {
+		e.message(11, *r.ClosingTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given LocationHoursOfOperation from protobuf message LocationHoursOfOperation of fhir.proto
+func (r *LocationHoursOfOperation) UnmarshalProto(b []byte) error {
+	*r = LocationHoursOfOperation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			for _, n := range d.varints() {
+				var v DaysOfWeek
+				d.enum(n, v.setProtoNumber)
+				r.DaysOfWeek = append(r.DaysOfWeek, v)
+			}
+		case 5:
+			v := d.bool()
+			r.AllDay = &v
+		case 6:
+			v := d.string()
+			r.OpeningTime = &v
+		case 7:
+			v := d.string()
+			r.ClosingTime = &v
+		case 8:
+			r.DaysOfWeekElement = append(r.DaysOfWeekElement, d.element())
+		case 9:
+			var v Element
+			d.message(&v)
+			r.AllDayElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.OpeningTimeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ClosingTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalLocation unmarshals a Location.
 func UnmarshalLocation(b []byte) (Location, error) {
 	var location Location
-	if err := json.Unmarshal(b, &location); err != nil {
+	if err := location.UnmarshalJSON(b); err != nil {
 		return location, err
 	}
 	return location, nil
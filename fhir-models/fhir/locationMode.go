@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code LocationMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *LocationMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *LocationMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "instance":
 		*code = LocationModeInstance
@@ -46,6 +50,12 @@ func (code *LocationMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code LocationMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *LocationMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code LocationMode) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code LocationMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code LocationMode) protoNumber() uint64 {
+	switch code {
+	case LocationModeInstance:
+		return 1
+	case LocationModeKind:
+		return 2
+	}
+	return 0
+}
+func (code *LocationMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = LocationModeInstance
+	case 2:
+		*code = LocationModeKind
+	default:
+		return false
+	}
+	return true
+}
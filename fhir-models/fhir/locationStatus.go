@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code LocationStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *LocationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *LocationStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = LocationStatusActive
@@ -49,6 +53,12 @@ func (code *LocationStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code LocationStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *LocationStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code LocationStatus) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code LocationStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code LocationStatus) protoNumber() uint64 {
+	switch code {
+	case LocationStatusActive:
+		return 1
+	case LocationStatusSuspended:
+		return 2
+	case LocationStatusInactive:
+		return 3
+	}
+	return 0
+}
+func (code *LocationStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = LocationStatusActive
+	case 2:
+		*code = LocationStatusSuspended
+	case 3:
+		*code = LocationStatusInactive
+	default:
+		return false
+	}
+	return true
+}
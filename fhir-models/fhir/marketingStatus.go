@@ -14,17 +14,488 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MarketingStatus is documented here http://hl7.org/fhir/StructureDefinition/MarketingStatus
 type MarketingStatus struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Country           CodeableConcept  `bson:"country" json:"country"`
-	Jurisdiction      *CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Status            CodeableConcept  `bson:"status" json:"status"`
-	DateRange         Period           `bson:"dateRange" json:"dateRange"`
-	RestoreDate       *string          `bson:"restoreDate,omitempty" json:"restoreDate,omitempty"`
+	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Country            CodeableConcept  `bson:"country" json:"country"`
+	Jurisdiction       *CodeableConcept `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Status             CodeableConcept  `bson:"status" json:"status"`
+	DateRange          Period           `bson:"dateRange" json:"dateRange"`
+	RestoreDate        *string          `bson:"restoreDate,omitempty" json:"restoreDate,omitempty"`
+	RestoreDateElement *Element         `bson:"_restoreDate,omitempty" json:"_restoreDate,omitempty"`
+}
+
+// MarshalJSON marshals the given MarketingStatus as JSON into a byte slice
+func (r MarketingStatus) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MarketingStatus as JSON object with the members in the order of the fields
+func (r MarketingStatus) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("country")
+	r.Country.appendJSON(e)
+	if r.Jurisdiction != nil {
+		e.key("jurisdiction")
+		r.Jurisdiction.appendJSON(e)
+	}
+	e.key("status")
+	r.Status.appendJSON(e)
+	e.key("dateRange")
+	r.DateRange.appendJSON(e)
+	if r.RestoreDate != nil {
+		e.key("restoreDate")
+		e.string(*r.RestoreDate)
+	}
+	if r.RestoreDateElement != nil {
+		e.key("_restoreDate")
+		r.RestoreDateElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MarketingStatus from JSON. Unknown members are ignored.
+func (r *MarketingStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MarketingStatus
+func (r *MarketingStatus) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "country":
+			r.Country.decodeJSON(d)
+		case "jurisdiction":
+			if d.null() {
+				r.Jurisdiction = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = &v
+			}
+		case "status":
+			r.Status.decodeJSON(d)
+		case "dateRange":
+			r.DateRange.decodeJSON(d)
+		case "restoreDate":
+			if v, ok := d.string(); ok {
+				r.RestoreDate = &v
+			} else {
+				r.RestoreDate = nil
+			}
+		case "_restoreDate":
+			r.RestoreDateElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MarketingStatus which shares no memory with the original
+func (r MarketingStatus) DeepCopy() MarketingStatus {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Country = r.Country.DeepCopy()
+	if r.Jurisdiction != nil {
+		v := r.Jurisdiction.DeepCopy()
+		out.Jurisdiction = &v
+	}
+	out.Status = r.Status.DeepCopy()
+	out.DateRange = r.DateRange.DeepCopy()
+	if r.RestoreDate != nil {
+		v := *r.RestoreDate
+		out.RestoreDate = &v
+	}
+	if r.RestoreDateElement != nil {
+		v := r.RestoreDateElement.DeepCopy()
+		out.RestoreDateElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MarketingStatus) Equal(other MarketingStatus) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Country.Equal(other.Country) {
+		return false
+	}
+	if (r.Jurisdiction == nil) != (other.Jurisdiction == nil) || r.Jurisdiction != nil && !r.Jurisdiction.Equal(*other.Jurisdiction) {
+		return false
+	}
+	if !r.Status.Equal(other.Status) {
+		return false
+	}
+	if !r.DateRange.Equal(other.DateRange) {
+		return false
+	}
+	if (r.RestoreDate == nil) != (other.RestoreDate == nil) || r.RestoreDate != nil && *r.RestoreDate != *other.RestoreDate {
+		return false
+	}
+	if (r.RestoreDateElement == nil) != (other.RestoreDateElement == nil) || r.RestoreDateElement != nil && !r.RestoreDateElement.Equal(*other.RestoreDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MarketingStatus) EqualsDeep(other MarketingStatus) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Country.EqualsDeep(other.Country) {
+		return false
+	}
+	if (r.Jurisdiction == nil) != (other.Jurisdiction == nil) || r.Jurisdiction != nil && !r.Jurisdiction.EqualsDeep(*other.Jurisdiction) {
+		return false
+	}
+	if !r.Status.EqualsDeep(other.Status) {
+		return false
+	}
+	if !r.DateRange.EqualsDeep(other.DateRange) {
+		return false
+	}
+	if (r.RestoreDate == nil) != (other.RestoreDate == nil) || r.RestoreDate != nil && !equivalentString(*r.RestoreDate, *other.RestoreDate) {
+		return false
+	}
+	if (r.RestoreDateElement == nil) != (other.RestoreDateElement == nil) || r.RestoreDateElement != nil && !r.RestoreDateElement.EqualsDeep(*other.RestoreDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MarketingStatus) EqualsShallow(other MarketingStatus) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.RestoreDate == nil) != (other.RestoreDate == nil) || r.RestoreDate != nil && !equivalentString(*r.RestoreDate, *other.RestoreDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MarketingStatus as FHIR XML
+func (r MarketingStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "country", r.Country); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "jurisdiction", r.Jurisdiction); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "status", r.Status); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dateRange", r.DateRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "restoreDate", r.RestoreDate, r.RestoreDateElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MarketingStatus from FHIR XML
+func (r *MarketingStatus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "country":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Country = v
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = &v
+			case "status":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Status = v
+			case "dateRange":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DateRange = v
+			case "restoreDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RestoreDate = &v
+				}
+				r.RestoreDateElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MarketingStatus as properties to the RDF node n
+func (r MarketingStatus) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MarketingStatus.country", -1, r.Country)
+	n.element("MarketingStatus.jurisdiction", -1, r.Jurisdiction)
+	n.element("MarketingStatus.status", -1, r.Status)
+	n.element("MarketingStatus.dateRange", -1, r.DateRange)
+	n.primitive("MarketingStatus.restoreDate", -1, r.RestoreDate, r.RestoreDateElement, "dateTime")
+}
+
+// MarshalBSON marshals the given MarketingStatus as BSON document with the structure of its FHIR JSON
+func (r MarketingStatus) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MarketingStatus from a BSON document with the structure of its FHIR JSON
+func (r *MarketingStatus) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MarketingStatus as protobuf message MarketingStatus of fhir.proto
+func (r MarketingStatus) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MarketingStatus to the encoded message
+func (r MarketingStatus) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Country)
+	if r.Jurisdiction != nil {
+		e.message(5, *r.Jurisdiction)
+	}
+	e.message(6, r.Status)
+	e.message(7, r.DateRange)
+	if r.RestoreDate != nil {
+		e.string(8, *r.RestoreDate)
+	}
+	if r.RestoreDateElement != nil {
+		e.message(9, *r.RestoreDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MarketingStatus from protobuf message MarketingStatus of fhir.proto
+func (r *MarketingStatus) UnmarshalProto(b []byte) error {
+	*r = MarketingStatus{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Country = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Status = v
+		case 7:
+			var v Period
+			d.message(&v)
+			r.DateRange = v
+		case 8:
+			v := d.string()
+			r.RestoreDate = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.RestoreDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -14,31 +14,42 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MeasureReport is documented here http://hl7.org/fhir/StructureDefinition/MeasureReport
 type MeasureReport struct {
-	Id                  *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string              `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status              MeasureReportStatus  `bson:"status" json:"status"`
-	Type                MeasureReportType    `bson:"type" json:"type"`
-	Measure             string               `bson:"measure" json:"measure"`
-	Subject             *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
-	Date                *string              `bson:"date,omitempty" json:"date,omitempty"`
-	Reporter            *Reference           `bson:"reporter,omitempty" json:"reporter,omitempty"`
-	Period              Period               `bson:"period" json:"period"`
-	ImprovementNotation *CodeableConcept     `bson:"improvementNotation,omitempty" json:"improvementNotation,omitempty"`
-	Group               []MeasureReportGroup `bson:"group,omitempty" json:"group,omitempty"`
-	EvaluatedResource   []Reference          `bson:"evaluatedResource,omitempty" json:"evaluatedResource,omitempty"`
+	Id                   *string              `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element             `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element             `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string              `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element             `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               MeasureReportStatus  `bson:"status" json:"status"`
+	StatusElement        *Element             `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 MeasureReportType    `bson:"type" json:"type"`
+	TypeElement          *Element             `bson:"_type,omitempty" json:"_type,omitempty"`
+	Measure              string               `bson:"measure" json:"measure"`
+	MeasureElement       *Element             `bson:"_measure,omitempty" json:"_measure,omitempty"`
+	Subject              *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
+	Date                 *string              `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element             `bson:"_date,omitempty" json:"_date,omitempty"`
+	Reporter             *Reference           `bson:"reporter,omitempty" json:"reporter,omitempty"`
+	Period               Period               `bson:"period" json:"period"`
+	ImprovementNotation  *CodeableConcept     `bson:"improvementNotation,omitempty" json:"improvementNotation,omitempty"`
+	Group                []MeasureReportGroup `bson:"group,omitempty" json:"group,omitempty"`
+	EvaluatedResource    []Reference          `bson:"evaluatedResource,omitempty" json:"evaluatedResource,omitempty"`
 }
 type MeasureReportGroup struct {
 	Id                *string                        `bson:"id,omitempty" json:"id,omitempty"`
@@ -55,6 +66,7 @@ type MeasureReportGroupPopulation struct {
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
 	Count             *int             `bson:"count,omitempty" json:"count,omitempty"`
+	CountElement      *Element         `bson:"_count,omitempty" json:"_count,omitempty"`
 	SubjectResults    *Reference       `bson:"subjectResults,omitempty" json:"subjectResults,omitempty"`
 }
 type MeasureReportGroupStratifier struct {
@@ -86,25 +98,3716 @@ type MeasureReportGroupStratifierStratumPopulation struct {
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
 	Count             *int             `bson:"count,omitempty" json:"count,omitempty"`
+	CountElement      *Element         `bson:"_count,omitempty" json:"_count,omitempty"`
 	SubjectResults    *Reference       `bson:"subjectResults,omitempty" json:"subjectResults,omitempty"`
 }
-type OtherMeasureReport MeasureReport
 
 // MarshalJSON marshals the given MeasureReport as JSON into a byte slice
 func (r MeasureReport) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMeasureReport
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMeasureReport: OtherMeasureReport(r),
-		ResourceType:       "MeasureReport",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MeasureReport as JSON object with the members in the order of the fields
+func (r MeasureReport) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MeasureReport\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("measure")
+	e.string(r.Measure)
+	if r.MeasureElement != nil {
+		e.key("_measure")
+		r.MeasureElement.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Reporter != nil {
+		e.key("reporter")
+		r.Reporter.appendJSON(e)
+	}
+	e.key("period")
+	r.Period.appendJSON(e)
+	if r.ImprovementNotation != nil {
+		e.key("improvementNotation")
+		r.ImprovementNotation.appendJSON(e)
+	}
+	if len(r.Group) > 0 {
+		e.key("group")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Group {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.EvaluatedResource) > 0 {
+		e.key("evaluatedResource")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.EvaluatedResource {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MeasureReport from JSON. Unknown members are ignored.
+func (r *MeasureReport) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MeasureReport
+func (r *MeasureReport) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "measure":
+			if v, ok := d.string(); ok {
+				r.Measure = v
+			}
+		case "_measure":
+			r.MeasureElement = d.element()
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "reporter":
+			if d.null() {
+				r.Reporter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Reporter = &v
+			}
+		case "period":
+			r.Period.decodeJSON(d)
+		case "improvementNotation":
+			if d.null() {
+				r.ImprovementNotation = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ImprovementNotation = &v
+			}
+		case "group":
+			a := d.array()
+			r.Group = nil
+			for a.next() {
+				var v MeasureReportGroup
+				v.decodeJSON(d)
+				r.Group = append(r.Group, v)
+			}
+			if r.Group == nil && !a.isNull {
+				r.Group = []MeasureReportGroup{}
+			}
+		case "evaluatedResource":
+			a := d.array()
+			r.EvaluatedResource = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.EvaluatedResource = append(r.EvaluatedResource, v)
+			}
+			if r.EvaluatedResource == nil && !a.isNull {
+				r.EvaluatedResource = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MeasureReport which shares no memory with the original
+func (r MeasureReport) DeepCopy() MeasureReport {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.MeasureElement != nil {
+		v := r.MeasureElement.DeepCopy()
+		out.MeasureElement = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Reporter != nil {
+		v := r.Reporter.DeepCopy()
+		out.Reporter = &v
+	}
+	out.Period = r.Period.DeepCopy()
+	if r.ImprovementNotation != nil {
+		v := r.ImprovementNotation.DeepCopy()
+		out.ImprovementNotation = &v
+	}
+	if r.Group != nil {
+		out.Group = make([]MeasureReportGroup, len(r.Group))
+		for i := range r.Group {
+			out.Group[i] = r.Group[i].DeepCopy()
+		}
+	}
+	if r.EvaluatedResource != nil {
+		out.EvaluatedResource = make([]Reference, len(r.EvaluatedResource))
+		for i := range r.EvaluatedResource {
+			out.EvaluatedResource[i] = r.EvaluatedResource[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MeasureReport) Equal(other MeasureReport) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.Measure != other.Measure {
+		return false
+	}
+	if (r.MeasureElement == nil) != (other.MeasureElement == nil) || r.MeasureElement != nil && !r.MeasureElement.Equal(*other.MeasureElement) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Reporter == nil) != (other.Reporter == nil) || r.Reporter != nil && !r.Reporter.Equal(*other.Reporter) {
+		return false
+	}
+	if !r.Period.Equal(other.Period) {
+		return false
+	}
+	if (r.ImprovementNotation == nil) != (other.ImprovementNotation == nil) || r.ImprovementNotation != nil && !r.ImprovementNotation.Equal(*other.ImprovementNotation) {
+		return false
+	}
+	if len(r.Group) != len(other.Group) {
+		return false
+	}
+	for i := range r.Group {
+		if !r.Group[i].Equal(other.Group[i]) {
+			return false
+		}
+	}
+	if len(r.EvaluatedResource) != len(other.EvaluatedResource) {
+		return false
+	}
+	for i := range r.EvaluatedResource {
+		if !r.EvaluatedResource[i].Equal(other.EvaluatedResource[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MeasureReport) EqualsDeep(other MeasureReport) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentString(r.Measure, other.Measure) {
+		return false
+	}
+	if (r.MeasureElement == nil) != (other.MeasureElement == nil) || r.MeasureElement != nil && !r.MeasureElement.EqualsDeep(*other.MeasureElement) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Reporter == nil) != (other.Reporter == nil) || r.Reporter != nil && !r.Reporter.EqualsDeep(*other.Reporter) {
+		return false
+	}
+	if !r.Period.EqualsDeep(other.Period) {
+		return false
+	}
+	if (r.ImprovementNotation == nil) != (other.ImprovementNotation == nil) || r.ImprovementNotation != nil && !r.ImprovementNotation.EqualsDeep(*other.ImprovementNotation) {
+		return false
+	}
+	if !equivalentList(len(r.Group), len(other.Group), func(i, j int) bool {
+		return r.Group[i].EqualsDeep(other.Group[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.EvaluatedResource), len(other.EvaluatedResource), func(i, j int) bool {
+		return r.EvaluatedResource[i].EqualsDeep(other.EvaluatedResource[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MeasureReport) EqualsShallow(other MeasureReport) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if !equivalentString(r.Measure, other.Measure) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MeasureReport as FHIR XML
+func (r MeasureReport) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MeasureReport",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "measure", r.Measure, r.MeasureElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reporter", r.Reporter); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "improvementNotation", r.ImprovementNotation); err != nil {
+		return err
+	}
+	for _, v := range r.Group {
+		if err := encodeXMLElement(e, "group", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.EvaluatedResource {
+		if err := encodeXMLElement(e, "evaluatedResource", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MeasureReport from FHIR XML
+func (r *MeasureReport) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v MeasureReportStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "type":
+				var v MeasureReportType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "measure":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Measure = v
+				}
+				r.MeasureElement = element
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "reporter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reporter = &v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = v
+			case "improvementNotation":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ImprovementNotation = &v
+			case "group":
+				var v MeasureReportGroup
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Group = append(r.Group, v)
+			case "evaluatedResource":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EvaluatedResource = append(r.EvaluatedResource, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MeasureReport as properties to the RDF node n
+func (r MeasureReport) turtle(n *turtleNode) {
+	n.resource("MeasureReport", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MeasureReport.identifier", i, v)
+	}
+	n.primitive("MeasureReport.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("MeasureReport.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("MeasureReport.measure", -1, r.Measure, r.MeasureElement, "string")
+	n.element("MeasureReport.subject", -1, r.Subject)
+	n.primitive("MeasureReport.date", -1, r.Date, r.DateElement, "dateTime")
+	n.element("MeasureReport.reporter", -1, r.Reporter)
+	n.element("MeasureReport.period", -1, r.Period)
+	n.element("MeasureReport.improvementNotation", -1, r.ImprovementNotation)
+	for i, v := range r.Group {
+		n.element("MeasureReport.group", i, v)
+	}
+	for i, v := range r.EvaluatedResource {
+		n.element("MeasureReport.evaluatedResource", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MeasureReport as BSON document with the structure of its FHIR JSON
+func (r MeasureReport) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MeasureReport from a BSON document with the structure of its FHIR JSON
+func (r *MeasureReport) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MeasureReport as protobuf message MeasureReport of fhir.proto
+func (r MeasureReport) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MeasureReport to the encoded message
+func (r MeasureReport) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	e.varint(11, r.Type.protoNumber())
+	if r.Measure != "" {
+		e.string(12, r.Measure)
+	}
+	if r.Subject != nil {
+		e.message(13, *r.Subject)
+	}
+	if r.Date != nil {
+		e.string(14, *r.Date)
+	}
+	if r.Reporter != nil {
+		e.message(15, *r.Reporter)
+	}
+	e.message(16, r.Period)
+	if r.ImprovementNotation != nil {
+		e.message(17, *r.ImprovementNotation)
+	}
+	for _, v := range r.Group {
+		e.message(18, v)
+	}
+	for _, v := range r.EvaluatedResource {
+		e.message(19, v)
+	}
+	if r.IdElement != nil {
+		e.message(20, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(21, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(22, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(23, *r.StatusElement)
+	}
+	if r.TypeElement != nil {
+		e.message(24, *r.TypeElement)
+	}
+	if r.MeasureElement != nil {
+		e.message(25, *r.MeasureElement)
+	}
+	if r.DateElement != nil {
+		e.message(26, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MeasureReport from protobuf message MeasureReport of fhir.proto
+func (r *MeasureReport) UnmarshalProto(b []byte) error {
+	*r = MeasureReport{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v MeasureReportStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v MeasureReportType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 12:
+			r.Measure = d.string()
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 14:
+			v := d.string()
+			r.Date = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Reporter = &v
+		case 16:
+			var v Period
+			d.message(&v)
+			r.Period = v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.ImprovementNotation = &v
+		case 18:
+			var v MeasureReportGroup
+			d.message(&v)
+			r.Group = append(r.Group, v)
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.EvaluatedResource = append(r.EvaluatedResource, v)
+		case 20:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.MeasureElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MeasureReportGroup as JSON into a byte slice
+func (r MeasureReportGroup) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MeasureReportGroup as JSON object with the members in the order of the fields
+func (r MeasureReportGroup) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if len(r.Population) > 0 {
+		e.key("population")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Population {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MeasureScore != nil {
+		e.key("measureScore")
+		r.MeasureScore.appendJSON(e)
+	}
+	if len(r.Stratifier) > 0 {
+		e.key("stratifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Stratifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MeasureReportGroup from JSON. Unknown members are ignored.
+func (r *MeasureReportGroup) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MeasureReportGroup
+func (r *MeasureReportGroup) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "population":
+			a := d.array()
+			r.Population = nil
+			for a.next() {
+				var v MeasureReportGroupPopulation
+				v.decodeJSON(d)
+				r.Population = append(r.Population, v)
+			}
+			if r.Population == nil && !a.isNull {
+				r.Population = []MeasureReportGroupPopulation{}
+			}
+		case "measureScore":
+			if d.null() {
+				r.MeasureScore = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MeasureScore = &v
+			}
+		case "stratifier":
+			a := d.array()
+			r.Stratifier = nil
+			for a.next() {
+				var v MeasureReportGroupStratifier
+				v.decodeJSON(d)
+				r.Stratifier = append(r.Stratifier, v)
+			}
+			if r.Stratifier == nil && !a.isNull {
+				r.Stratifier = []MeasureReportGroupStratifier{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MeasureReportGroup which shares no memory with the original
+func (r MeasureReportGroup) DeepCopy() MeasureReportGroup {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Population != nil {
+		out.Population = make([]MeasureReportGroupPopulation, len(r.Population))
+		for i := range r.Population {
+			out.Population[i] = r.Population[i].DeepCopy()
+		}
+	}
+	if r.MeasureScore != nil {
+		v := r.MeasureScore.DeepCopy()
+		out.MeasureScore = &v
+	}
+	if r.Stratifier != nil {
+		out.Stratifier = make([]MeasureReportGroupStratifier, len(r.Stratifier))
+		for i := range r.Stratifier {
+			out.Stratifier[i] = r.Stratifier[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MeasureReportGroup) Equal(other MeasureReportGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if len(r.Population) != len(other.Population) {
+		return false
+	}
+	for i := range r.Population {
+		if !r.Population[i].Equal(other.Population[i]) {
+			return false
+		}
+	}
+	if (r.MeasureScore == nil) != (other.MeasureScore == nil) || r.MeasureScore != nil && !r.MeasureScore.Equal(*other.MeasureScore) {
+		return false
+	}
+	if len(r.Stratifier) != len(other.Stratifier) {
+		return false
+	}
+	for i := range r.Stratifier {
+		if !r.Stratifier[i].Equal(other.Stratifier[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MeasureReportGroup) EqualsDeep(other MeasureReportGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.Population), len(other.Population), func(i, j int) bool {
+		return r.Population[i].EqualsDeep(other.Population[j])
+	}) {
+		return false
+	}
+	if (r.MeasureScore == nil) != (other.MeasureScore == nil) || r.MeasureScore != nil && !r.MeasureScore.EqualsDeep(*other.MeasureScore) {
+		return false
+	}
+	if !equivalentList(len(r.Stratifier), len(other.Stratifier), func(i, j int) bool {
+		return r.Stratifier[i].EqualsDeep(other.Stratifier[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MeasureReportGroup) EqualsShallow(other MeasureReportGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MeasureReportGroup as FHIR XML
+func (r MeasureReportGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.Population {
+		if err := encodeXMLElement(e, "population", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "measureScore", r.MeasureScore); err != nil {
+		return err
+	}
+	for _, v := range r.Stratifier {
+		if err := encodeXMLElement(e, "stratifier", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MeasureReportGroup from FHIR XML
+func (r *MeasureReportGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "population":
+				var v MeasureReportGroupPopulation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Population = append(r.Population, v)
+			case "measureScore":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MeasureScore = &v
+			case "stratifier":
+				var v MeasureReportGroupStratifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Stratifier = append(r.Stratifier, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MeasureReportGroup as properties to the RDF node n
+func (r MeasureReportGroup) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MeasureReport.group.code", -1, r.Code)
+	for i, v := range r.Population {
+		n.element("MeasureReport.group.population", i, v)
+	}
+	n.element("MeasureReport.group.measureScore", -1, r.MeasureScore)
+	for i, v := range r.Stratifier {
+		n.element("MeasureReport.group.stratifier", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MeasureReportGroup as BSON document with the structure of its FHIR JSON
+func (r MeasureReportGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MeasureReportGroup from a BSON document with the structure of its FHIR JSON
+func (r *MeasureReportGroup) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MeasureReportGroup as protobuf message MeasureReportGroup of fhir.proto
+func (r MeasureReportGroup) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MeasureReportGroup to the encoded message
+func (r MeasureReportGroup) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != nil {
+		e.message(4, *r.Code)
+	}
+	for _, v := range r.Population {
+		e.message(5, v)
+	}
+	if r.MeasureScore != nil {
+		e.message(6, *r.MeasureScore)
+	}
+	for _, v := range r.Stratifier {
+		e.message(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MeasureReportGroup from protobuf message MeasureReportGroup of fhir.proto
+func (r *MeasureReportGroup) UnmarshalProto(b []byte) error {
+	*r = MeasureReportGroup{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 5:
+			var v MeasureReportGroupPopulation
+			d.message(&v)
+			r.Population = append(r.Population, v)
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.MeasureScore = &v
+		case 7:
+			var v MeasureReportGroupStratifier
+			d.message(&v)
+			r.Stratifier = append(r.Stratifier, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MeasureReportGroupPopulation as JSON into a byte slice
+func (r MeasureReportGroupPopulation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MeasureReportGroupPopulation as JSON object with the members in the order of the fields
+func (r MeasureReportGroupPopulation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Count != nil {
+		e.key("count")
+		e.int(*r.Count)
+	}
+	if r.CountElement != nil {
+		e.key("_count")
+		r.CountElement.appendJSON(e)
+	}
+	if r.SubjectResults != nil {
+		e.key("subjectResults")
+		r.SubjectResults.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MeasureReportGroupPopulation from JSON. Unknown members are ignored.
+func (r *MeasureReportGroupPopulation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MeasureReportGroupPopulation
+func (r *MeasureReportGroupPopulation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "count":
+			if v, ok := d.int(); ok {
+				r.Count = &v
+			} else {
+				r.Count = nil
+			}
+		case "_count":
+			r.CountElement = d.element()
+		case "subjectResults":
+			if d.null() {
+				r.SubjectResults = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubjectResults = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MeasureReportGroupPopulation which shares no memory with the original
+func (r MeasureReportGroupPopulation) DeepCopy() MeasureReportGroupPopulation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Count != nil {
+		v := *r.Count
+		out.Count = &v
+	}
+	if r.CountElement != nil {
+		v := r.CountElement.DeepCopy()
+		out.CountElement = &v
+	}
+	if r.SubjectResults != nil {
+		v := r.SubjectResults.DeepCopy()
+		out.SubjectResults = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MeasureReportGroupPopulation) Equal(other MeasureReportGroupPopulation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	if (r.CountElement == nil) != (other.CountElement == nil) || r.CountElement != nil && !r.CountElement.Equal(*other.CountElement) {
+		return false
+	}
+	if (r.SubjectResults == nil) != (other.SubjectResults == nil) || r.SubjectResults != nil && !r.SubjectResults.Equal(*other.SubjectResults) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MeasureReportGroupPopulation) EqualsDeep(other MeasureReportGroupPopulation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	if (r.CountElement == nil) != (other.CountElement == nil) || r.CountElement != nil && !r.CountElement.EqualsDeep(*other.CountElement) {
+		return false
+	}
+	if (r.SubjectResults == nil) != (other.SubjectResults == nil) || r.SubjectResults != nil && !r.SubjectResults.EqualsDeep(*other.SubjectResults) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MeasureReportGroupPopulation) EqualsShallow(other MeasureReportGroupPopulation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MeasureReportGroupPopulation as FHIR XML
+func (r MeasureReportGroupPopulation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "count", r.Count, r.CountElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectResults", r.SubjectResults); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MeasureReportGroupPopulation from FHIR XML
+func (r *MeasureReportGroupPopulation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "count":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Count = &v
+				}
+				r.CountElement = element
+			case "subjectResults":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectResults = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MeasureReportGroupPopulation as properties to the RDF node n
+func (r MeasureReportGroupPopulation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MeasureReport.group.population.code", -1, r.Code)
+	n.primitive("MeasureReport.group.population.count", -1, r.Count, r.CountElement, "integer")
+	n.element("MeasureReport.group.population.subjectResults", -1, r.SubjectResults)
+}
+
+// MarshalBSON marshals the given MeasureReportGroupPopulation as BSON document with the structure of its FHIR JSON
+func (r MeasureReportGroupPopulation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MeasureReportGroupPopulation from a BSON document with the structure of its FHIR JSON
+func (r *MeasureReportGroupPopulation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MeasureReportGroupPopulation as protobuf message MeasureReportGroupPopulation of fhir.proto
+func (r MeasureReportGroupPopulation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MeasureReportGroupPopulation to the encoded message
+func (r MeasureReportGroupPopulation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != nil {
+		e.message(4, *r.Code)
+	}
+	if r.Count != nil {
+		e.varint(5, uint64(int64(*r.Count)))
+	}
+	if r.SubjectResults != nil {
+		e.message(6, *r.SubjectResults)
+	}
+	if r.CountElement != nil {
+		e.message(7, *r.CountElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MeasureReportGroupPopulation from protobuf message MeasureReportGroupPopulation of fhir.proto
+func (r *MeasureReportGroupPopulation) UnmarshalProto(b []byte) error {
+	*r = MeasureReportGroupPopulation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 5:
+			v := d.int()
+			r.Count = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.SubjectResults = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.CountElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MeasureReportGroupStratifier as JSON into a byte slice
+func (r MeasureReportGroupStratifier) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MeasureReportGroupStratifier as JSON object with the members in the order of the fields
+func (r MeasureReportGroupStratifier) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Stratum) > 0 {
+		e.key("stratum")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Stratum {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MeasureReportGroupStratifier from JSON. Unknown members are ignored.
+func (r *MeasureReportGroupStratifier) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MeasureReportGroupStratifier
+func (r *MeasureReportGroupStratifier) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "stratum":
+			a := d.array()
+			r.Stratum = nil
+			for a.next() {
+				var v MeasureReportGroupStratifierStratum
+				v.decodeJSON(d)
+				r.Stratum = append(r.Stratum, v)
+			}
+			if r.Stratum == nil && !a.isNull {
+				r.Stratum = []MeasureReportGroupStratifierStratum{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MeasureReportGroupStratifier which shares no memory with the original
+func (r MeasureReportGroupStratifier) DeepCopy() MeasureReportGroupStratifier {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Stratum != nil {
+		out.Stratum = make([]MeasureReportGroupStratifierStratum, len(r.Stratum))
+		for i := range r.Stratum {
+			out.Stratum[i] = r.Stratum[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MeasureReportGroupStratifier) Equal(other MeasureReportGroupStratifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if len(r.Stratum) != len(other.Stratum) {
+		return false
+	}
+	for i := range r.Stratum {
+		if !r.Stratum[i].Equal(other.Stratum[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MeasureReportGroupStratifier) EqualsDeep(other MeasureReportGroupStratifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Stratum), len(other.Stratum), func(i, j int) bool {
+		return r.Stratum[i].EqualsDeep(other.Stratum[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MeasureReportGroupStratifier) EqualsShallow(other MeasureReportGroupStratifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MeasureReportGroupStratifier as FHIR XML
+func (r MeasureReportGroupStratifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Stratum {
+		if err := encodeXMLElement(e, "stratum", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MeasureReportGroupStratifier from FHIR XML
+func (r *MeasureReportGroupStratifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "stratum":
+				var v MeasureReportGroupStratifierStratum
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Stratum = append(r.Stratum, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MeasureReportGroupStratifier as properties to the RDF node n
+func (r MeasureReportGroupStratifier) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Code {
+		n.element("MeasureReport.group.stratifier.code", i, v)
+	}
+	for i, v := range r.Stratum {
+		n.element("MeasureReport.group.stratifier.stratum", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MeasureReportGroupStratifier as BSON document with the structure of its FHIR JSON
+func (r MeasureReportGroupStratifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MeasureReportGroupStratifier from a BSON document with the structure of its FHIR JSON
+func (r *MeasureReportGroupStratifier) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MeasureReportGroupStratifier as protobuf message MeasureReportGroupStratifier of fhir.proto
+func (r MeasureReportGroupStratifier) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MeasureReportGroupStratifier to the encoded message
+func (r MeasureReportGroupStratifier) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Code {
+		e.message(4, v)
+	}
+	for _, v := range r.Stratum {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MeasureReportGroupStratifier from protobuf message MeasureReportGroupStratifier of fhir.proto
+func (r *MeasureReportGroupStratifier) UnmarshalProto(b []byte) error {
+	*r = MeasureReportGroupStratifier{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 5:
+			var v MeasureReportGroupStratifierStratum
+			d.message(&v)
+			r.Stratum = append(r.Stratum, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MeasureReportGroupStratifierStratum as JSON into a byte slice
+func (r MeasureReportGroupStratifierStratum) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MeasureReportGroupStratifierStratum as JSON object with the members in the order of the fields
+func (r MeasureReportGroupStratifierStratum) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Value != nil {
+		e.key("value")
+		r.Value.appendJSON(e)
+	}
+	if len(r.Component) > 0 {
+		e.key("component")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Component {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Population) > 0 {
+		e.key("population")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Population {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MeasureScore != nil {
+		e.key("measureScore")
+		r.MeasureScore.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MeasureReportGroupStratifierStratum from JSON. Unknown members are ignored.
+func (r *MeasureReportGroupStratifierStratum) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MeasureReportGroupStratifierStratum
+func (r *MeasureReportGroupStratifierStratum) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "value":
+			if d.null() {
+				r.Value = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Value = &v
+			}
+		case "component":
+			a := d.array()
+			r.Component = nil
+			for a.next() {
+				var v MeasureReportGroupStratifierStratumComponent
+				v.decodeJSON(d)
+				r.Component = append(r.Component, v)
+			}
+			if r.Component == nil && !a.isNull {
+				r.Component = []MeasureReportGroupStratifierStratumComponent{}
+			}
+		case "population":
+			a := d.array()
+			r.Population = nil
+			for a.next() {
+				var v MeasureReportGroupStratifierStratumPopulation
+				v.decodeJSON(d)
+				r.Population = append(r.Population, v)
+			}
+			if r.Population == nil && !a.isNull {
+				r.Population = []MeasureReportGroupStratifierStratumPopulation{}
+			}
+		case "measureScore":
+			if d.null() {
+				r.MeasureScore = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MeasureScore = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MeasureReportGroupStratifierStratum which shares no memory with the original
+func (r MeasureReportGroupStratifierStratum) DeepCopy() MeasureReportGroupStratifierStratum {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Value != nil {
+		v := r.Value.DeepCopy()
+		out.Value = &v
+	}
+	if r.Component != nil {
+		out.Component = make([]MeasureReportGroupStratifierStratumComponent, len(r.Component))
+		for i := range r.Component {
+			out.Component[i] = r.Component[i].DeepCopy()
+		}
+	}
+	if r.Population != nil {
+		out.Population = make([]MeasureReportGroupStratifierStratumPopulation, len(r.Population))
+		for i := range r.Population {
+			out.Population[i] = r.Population[i].DeepCopy()
+		}
+	}
+	if r.MeasureScore != nil {
+		v := r.MeasureScore.DeepCopy()
+		out.MeasureScore = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MeasureReportGroupStratifierStratum) Equal(other MeasureReportGroupStratifierStratum) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !r.Value.Equal(*other.Value) {
+		return false
+	}
+	if len(r.Component) != len(other.Component) {
+		return false
+	}
+	for i := range r.Component {
+		if !r.Component[i].Equal(other.Component[i]) {
+			return false
+		}
+	}
+	if len(r.Population) != len(other.Population) {
+		return false
+	}
+	for i := range r.Population {
+		if !r.Population[i].Equal(other.Population[i]) {
+			return false
+		}
+	}
+	if (r.MeasureScore == nil) != (other.MeasureScore == nil) || r.MeasureScore != nil && !r.MeasureScore.Equal(*other.MeasureScore) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MeasureReportGroupStratifierStratum) EqualsDeep(other MeasureReportGroupStratifierStratum) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !r.Value.EqualsDeep(*other.Value) {
+		return false
+	}
+	if !equivalentList(len(r.Component), len(other.Component), func(i, j int) bool {
+		return r.Component[i].EqualsDeep(other.Component[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Population), len(other.Population), func(i, j int) bool {
+		return r.Population[i].EqualsDeep(other.Population[j])
+	}) {
+		return false
+	}
+	if (r.MeasureScore == nil) != (other.MeasureScore == nil) || r.MeasureScore != nil && !r.MeasureScore.EqualsDeep(*other.MeasureScore) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MeasureReportGroupStratifierStratum) EqualsShallow(other MeasureReportGroupStratifierStratum) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MeasureReportGroupStratifierStratum as FHIR XML
+func (r MeasureReportGroupStratifierStratum) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "value", r.Value); err != nil {
+		return err
+	}
+	for _, v := range r.Component {
+		if err := encodeXMLElement(e, "component", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Population {
+		if err := encodeXMLElement(e, "population", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "measureScore", r.MeasureScore); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MeasureReportGroupStratifierStratum from FHIR XML
+func (r *MeasureReportGroupStratifierStratum) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "value":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Value = &v
+			case "component":
+				var v MeasureReportGroupStratifierStratumComponent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Component = append(r.Component, v)
+			case "population":
+				var v MeasureReportGroupStratifierStratumPopulation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Population = append(r.Population, v)
+			case "measureScore":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MeasureScore = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MeasureReportGroupStratifierStratum as properties to the RDF node n
+func (r MeasureReportGroupStratifierStratum) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MeasureReport.group.stratifier.stratum.value", -1, r.Value)
+	for i, v := range r.Component {
+		n.element("MeasureReport.group.stratifier.stratum.component", i, v)
+	}
+	for i, v := range r.Population {
+		n.element("MeasureReport.group.stratifier.stratum.population", i, v)
+	}
+	n.element("MeasureReport.group.stratifier.stratum.measureScore", -1, r.MeasureScore)
+}
+
+// MarshalBSON marshals the given MeasureReportGroupStratifierStratum as BSON document with the structure of its FHIR JSON
+func (r MeasureReportGroupStratifierStratum) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MeasureReportGroupStratifierStratum from a BSON document with the structure of its FHIR JSON
+func (r *MeasureReportGroupStratifierStratum) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MeasureReportGroupStratifierStratum as protobuf message MeasureReportGroupStratifierStratum of fhir.proto
+func (r MeasureReportGroupStratifierStratum) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MeasureReportGroupStratifierStratum to the encoded message
+func (r MeasureReportGroupStratifierStratum) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Value != nil {
+		e.message(4, *r.Value)
+	}
+	for _, v := range r.Component {
+		e.message(5, v)
+	}
+	for _, v := range r.Population {
+		e.message(6, v)
+	}
+	if r.MeasureScore != nil {
+		e.message(7, *r.MeasureScore)
+	}
+}
+
+// UnmarshalProto unmarshals the given MeasureReportGroupStratifierStratum from protobuf message MeasureReportGroupStratifierStratum of fhir.proto
+func (r *MeasureReportGroupStratifierStratum) UnmarshalProto(b []byte) error {
+	*r = MeasureReportGroupStratifierStratum{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Value = &v
+		case 5:
+			var v MeasureReportGroupStratifierStratumComponent
+			d.message(&v)
+			r.Component = append(r.Component, v)
+		case 6:
+			var v MeasureReportGroupStratifierStratumPopulation
+			d.message(&v)
+			r.Population = append(r.Population, v)
+		case 7:
+			var v Quantity
+			d.message(&v)
+			r.MeasureScore = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MeasureReportGroupStratifierStratumComponent as JSON into a byte slice
+func (r MeasureReportGroupStratifierStratumComponent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MeasureReportGroupStratifierStratumComponent as JSON object with the members in the order of the fields
+func (r MeasureReportGroupStratifierStratumComponent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	e.key("value")
+	r.Value.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MeasureReportGroupStratifierStratumComponent from JSON. Unknown members are ignored.
+func (r *MeasureReportGroupStratifierStratumComponent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MeasureReportGroupStratifierStratumComponent
+func (r *MeasureReportGroupStratifierStratumComponent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "value":
+			r.Value.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MeasureReportGroupStratifierStratumComponent which shares no memory with the original
+func (r MeasureReportGroupStratifierStratumComponent) DeepCopy() MeasureReportGroupStratifierStratumComponent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	out.Value = r.Value.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MeasureReportGroupStratifierStratumComponent) Equal(other MeasureReportGroupStratifierStratumComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if !r.Value.Equal(other.Value) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MeasureReportGroupStratifierStratumComponent) EqualsDeep(other MeasureReportGroupStratifierStratumComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if !r.Value.EqualsDeep(other.Value) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MeasureReportGroupStratifierStratumComponent) EqualsShallow(other MeasureReportGroupStratifierStratumComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MeasureReportGroupStratifierStratumComponent as FHIR XML
+func (r MeasureReportGroupStratifierStratumComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "value", r.Value); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MeasureReportGroupStratifierStratumComponent from FHIR XML
+func (r *MeasureReportGroupStratifierStratumComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "value":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Value = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MeasureReportGroupStratifierStratumComponent as properties to the RDF node n
+func (r MeasureReportGroupStratifierStratumComponent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MeasureReport.group.stratifier.stratum.component.code", -1, r.Code)
+	n.element("MeasureReport.group.stratifier.stratum.component.value", -1, r.Value)
+}
+
+// MarshalBSON marshals the given MeasureReportGroupStratifierStratumComponent as BSON document with the structure of its FHIR JSON
+func (r MeasureReportGroupStratifierStratumComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MeasureReportGroupStratifierStratumComponent from a BSON document with the structure of its FHIR JSON
+func (r *MeasureReportGroupStratifierStratumComponent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MeasureReportGroupStratifierStratumComponent as protobuf message MeasureReportGroupStratifierStratumComponent of fhir.proto
+func (r MeasureReportGroupStratifierStratumComponent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MeasureReportGroupStratifierStratumComponent to the encoded message
+func (r MeasureReportGroupStratifierStratumComponent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	e.message(5, r.Value)
+}
+
+// UnmarshalProto unmarshals the given MeasureReportGroupStratifierStratumComponent from protobuf message MeasureReportGroupStratifierStratumComponent of fhir.proto
+func (r *MeasureReportGroupStratifierStratumComponent) UnmarshalProto(b []byte) error {
+	*r = MeasureReportGroupStratifierStratumComponent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Value = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MeasureReportGroupStratifierStratumPopulation as JSON into a byte slice
+func (r MeasureReportGroupStratifierStratumPopulation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MeasureReportGroupStratifierStratumPopulation as JSON object with the members in the order of the fields
+func (r MeasureReportGroupStratifierStratumPopulation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Count != nil {
+		e.key("count")
+		e.int(*r.Count)
+	}
+	if r.CountElement != nil {
+		e.key("_count")
+		r.CountElement.appendJSON(e)
+	}
+	if r.SubjectResults != nil {
+		e.key("subjectResults")
+		r.SubjectResults.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MeasureReportGroupStratifierStratumPopulation from JSON. Unknown members are ignored.
+func (r *MeasureReportGroupStratifierStratumPopulation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MeasureReportGroupStratifierStratumPopulation
+func (r *MeasureReportGroupStratifierStratumPopulation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "count":
+			if v, ok := d.int(); ok {
+				r.Count = &v
+			} else {
+				r.Count = nil
+			}
+		case "_count":
+			r.CountElement = d.element()
+		case "subjectResults":
+			if d.null() {
+				r.SubjectResults = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubjectResults = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MeasureReportGroupStratifierStratumPopulation which shares no memory with the original
+func (r MeasureReportGroupStratifierStratumPopulation) DeepCopy() MeasureReportGroupStratifierStratumPopulation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Count != nil {
+		v := *r.Count
+		out.Count = &v
+	}
+	if r.CountElement != nil {
+		v := r.CountElement.DeepCopy()
+		out.CountElement = &v
+	}
+	if r.SubjectResults != nil {
+		v := r.SubjectResults.DeepCopy()
+		out.SubjectResults = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MeasureReportGroupStratifierStratumPopulation) Equal(other MeasureReportGroupStratifierStratumPopulation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	if (r.CountElement == nil) != (other.CountElement == nil) || r.CountElement != nil && !r.CountElement.Equal(*other.CountElement) {
+		return false
+	}
+	if (r.SubjectResults == nil) != (other.SubjectResults == nil) || r.SubjectResults != nil && !r.SubjectResults.Equal(*other.SubjectResults) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MeasureReportGroupStratifierStratumPopulation) EqualsDeep(other MeasureReportGroupStratifierStratumPopulation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	if (r.CountElement == nil) != (other.CountElement == nil) || r.CountElement != nil && !r.CountElement.EqualsDeep(*other.CountElement) {
+		return false
+	}
+	if (r.SubjectResults == nil) != (other.SubjectResults == nil) || r.SubjectResults != nil && !r.SubjectResults.EqualsDeep(*other.SubjectResults) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MeasureReportGroupStratifierStratumPopulation) EqualsShallow(other MeasureReportGroupStratifierStratumPopulation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Count == nil) != (other.Count == nil) || r.Count != nil && *r.Count != *other.Count {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MeasureReportGroupStratifierStratumPopulation as FHIR XML
+func (r MeasureReportGroupStratifierStratumPopulation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "count", r.Count, r.CountElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectResults", r.SubjectResults); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MeasureReportGroupStratifierStratumPopulation from FHIR XML
+func (r *MeasureReportGroupStratifierStratumPopulation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "count":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Count = &v
+				}
+				r.CountElement = element
+			case "subjectResults":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectResults = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MeasureReportGroupStratifierStratumPopulation as properties to the RDF node n
+func (r MeasureReportGroupStratifierStratumPopulation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MeasureReport.group.stratifier.stratum.population.code", -1, r.Code)
+	n.primitive("MeasureReport.group.stratifier.stratum.population.count", -1, r.Count, r.CountElement, "integer")
+	n.element("MeasureReport.group.stratifier.stratum.population.subjectResults", -1, r.SubjectResults)
+}
+
+// MarshalBSON marshals the given MeasureReportGroupStratifierStratumPopulation as BSON document with the structure of its FHIR JSON
+func (r MeasureReportGroupStratifierStratumPopulation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MeasureReportGroupStratifierStratumPopulation from a BSON document with the structure of its FHIR JSON
+func (r *MeasureReportGroupStratifierStratumPopulation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MeasureReportGroupStratifierStratumPopulation as protobuf message MeasureReportGroupStratifierStratumPopulation of fhir.proto
+func (r MeasureReportGroupStratifierStratumPopulation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MeasureReportGroupStratifierStratumPopulation to the encoded message
+func (r MeasureReportGroupStratifierStratumPopulation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != nil {
+		e.message(4, *r.Code)
+	}
+	if r.Count != nil {
+		e.varint(5, uint64(int64(*r.Count)))
+	}
+	if r.SubjectResults != nil {
+		e.message(6, *r.SubjectResults)
+	}
+	if r.CountElement != nil {
+		e.message(7, *r.CountElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MeasureReportGroupStratifierStratumPopulation from protobuf message MeasureReportGroupStratifierStratumPopulation of fhir.proto
+func (r *MeasureReportGroupStratifierStratumPopulation) UnmarshalProto(b []byte) error {
+	*r = MeasureReportGroupStratifierStratumPopulation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 5:
+			v := d.int()
+			r.Count = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.SubjectResults = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.CountElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMeasureReport unmarshals a MeasureReport.
 func UnmarshalMeasureReport(b []byte) (MeasureReport, error) {
 	var measureReport MeasureReport
-	if err := json.Unmarshal(b, &measureReport); err != nil {
+	if err := measureReport.UnmarshalJSON(b); err != nil {
 		return measureReport, err
 	}
 	return measureReport, nil
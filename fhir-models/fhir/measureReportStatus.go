@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code MeasureReportStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *MeasureReportStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *MeasureReportStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "complete":
 		*code = MeasureReportStatusComplete
@@ -49,6 +53,12 @@ func (code *MeasureReportStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code MeasureReportStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *MeasureReportStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code MeasureReportStatus) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code MeasureReportStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code MeasureReportStatus) protoNumber() uint64 {
+	switch code {
+	case MeasureReportStatusComplete:
+		return 1
+	case MeasureReportStatusPending:
+		return 2
+	case MeasureReportStatusError:
+		return 3
+	}
+	return 0
+}
+func (code *MeasureReportStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = MeasureReportStatusComplete
+	case 2:
+		*code = MeasureReportStatusPending
+	case 3:
+		*code = MeasureReportStatusError
+	default:
+		return false
+	}
+	return true
+}
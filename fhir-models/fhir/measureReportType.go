@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code MeasureReportType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *MeasureReportType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *MeasureReportType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "individual":
 		*code = MeasureReportTypeIndividual
@@ -52,6 +56,12 @@ func (code *MeasureReportType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code MeasureReportType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *MeasureReportType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code MeasureReportType) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code MeasureReportType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code MeasureReportType) protoNumber() uint64 {
+	switch code {
+	case MeasureReportTypeIndividual:
+		return 1
+	case MeasureReportTypeSubjectList:
+		return 2
+	case MeasureReportTypeSummary:
+		return 3
+	case MeasureReportTypeDataCollection:
+		return 4
+	}
+	return 0
+}
+func (code *MeasureReportType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = MeasureReportTypeIndividual
+	case 2:
+		*code = MeasureReportTypeSubjectList
+	case 3:
+		*code = MeasureReportTypeSummary
+	case 4:
+		*code = MeasureReportTypeDataCollection
+	default:
+		return false
+	}
+	return true
+}
@@ -14,61 +14,1874 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Media is documented here http://hl7.org/fhir/StructureDefinition/Media
 type Media struct {
-	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn           []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf            []Reference       `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status            EventStatus       `bson:"status" json:"status"`
-	Type              *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
-	Modality          *CodeableConcept  `bson:"modality,omitempty" json:"modality,omitempty"`
-	View              *CodeableConcept  `bson:"view,omitempty" json:"view,omitempty"`
-	Subject           *Reference        `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter         *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	CreatedDateTime   *string           `bson:"createdDateTime,omitempty" json:"createdDateTime,omitempty"`
-	CreatedPeriod     *Period           `bson:"createdPeriod,omitempty" json:"createdPeriod,omitempty"`
-	Issued            *string           `bson:"issued,omitempty" json:"issued,omitempty"`
-	Operator          *Reference        `bson:"operator,omitempty" json:"operator,omitempty"`
-	ReasonCode        []CodeableConcept `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	BodySite          *CodeableConcept  `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	DeviceName        *string           `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
-	Device            *Reference        `bson:"device,omitempty" json:"device,omitempty"`
-	Height            *int              `bson:"height,omitempty" json:"height,omitempty"`
-	Width             *int              `bson:"width,omitempty" json:"width,omitempty"`
-	Frames            *int              `bson:"frames,omitempty" json:"frames,omitempty"`
-	Duration          *json.Number      `bson:"duration,omitempty" json:"duration,omitempty"`
-	Content           Attachment        `bson:"content" json:"content"`
-	Note              []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
-}
-type OtherMedia Media
+	Id                     *string           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                   *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier             []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                 []Reference       `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                 EventStatus       `bson:"status" json:"status"`
+	StatusElement          *Element          `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                   *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
+	Modality               *CodeableConcept  `bson:"modality,omitempty" json:"modality,omitempty"`
+	View                   *CodeableConcept  `bson:"view,omitempty" json:"view,omitempty"`
+	Subject                *Reference        `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter              *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	CreatedDateTime        *string           `bson:"createdDateTime,omitempty" json:"createdDateTime,omitempty"`
+	CreatedDateTimeElement *Element          `bson:"_createdDateTime,omitempty" json:"_createdDateTime,omitempty"`
+	CreatedPeriod          *Period           `bson:"createdPeriod,omitempty" json:"createdPeriod,omitempty"`
+	Issued                 *string           `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement          *Element          `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Operator               *Reference        `bson:"operator,omitempty" json:"operator,omitempty"`
+	ReasonCode             []CodeableConcept `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	BodySite               *CodeableConcept  `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	DeviceName             *string           `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
+	DeviceNameElement      *Element          `bson:"_deviceName,omitempty" json:"_deviceName,omitempty"`
+	Device                 *Reference        `bson:"device,omitempty" json:"device,omitempty"`
+	Height                 *int              `bson:"height,omitempty" json:"height,omitempty"`
+	HeightElement          *Element          `bson:"_height,omitempty" json:"_height,omitempty"`
+	Width                  *int              `bson:"width,omitempty" json:"width,omitempty"`
+	WidthElement           *Element          `bson:"_width,omitempty" json:"_width,omitempty"`
+	Frames                 *int              `bson:"frames,omitempty" json:"frames,omitempty"`
+	FramesElement          *Element          `bson:"_frames,omitempty" json:"_frames,omitempty"`
+	Duration               *json.Number      `bson:"duration,omitempty" json:"duration,omitempty"`
+	DurationElement        *Element          `bson:"_duration,omitempty" json:"_duration,omitempty"`
+	Content                Attachment        `bson:"content" json:"content"`
+	Note                   []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
+}
+
+// MediaCreated is implemented by the types allowed for Media.created[x]
+type MediaCreated interface {
+	isMediaCreated()
+}
+
+func (DateTime) isMediaCreated() {}
+func (Period) isMediaCreated()   {}
+
+// Created returns the value of Media.created[x] together with its FHIR type code or nil if no value is set
+func (r Media) Created() (MediaCreated, string) {
+	if r.CreatedDateTime != nil {
+		return DateTime(*r.CreatedDateTime), "dateTime"
+	}
+	if r.CreatedPeriod != nil {
+		return *r.CreatedPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetCreated sets the value of Media.created[x], given as value or pointer, and clears all other types
+func (r *Media) SetCreated(value MediaCreated) {
+	r.CreatedDateTime = nil
+	r.CreatedPeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.CreatedDateTime = &primitive
+	case *DateTime:
+		r.CreatedDateTime = (*string)(v)
+	case Period:
+		r.CreatedPeriod = &v
+	case *Period:
+		r.CreatedPeriod = v
+	}
+}
 
 // MarshalJSON marshals the given Media as JSON into a byte slice
 func (r Media) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedia
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedia:   OtherMedia(r),
-		ResourceType: "Media",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Media as JSON object with the members in the order of the fields
+func (r Media) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Media\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Modality != nil {
+		e.key("modality")
+		r.Modality.appendJSON(e)
+	}
+	if r.View != nil {
+		e.key("view")
+		r.View.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.CreatedDateTime != nil {
+		e.key("createdDateTime")
+		e.string(*r.CreatedDateTime)
+	}
+	if r.CreatedDateTimeElement != nil {
+		e.key("_createdDateTime")
+		r.CreatedDateTimeElement.appendJSON(e)
+	}
+	if r.CreatedPeriod != nil {
+		e.key("createdPeriod")
+		r.CreatedPeriod.appendJSON(e)
+	}
+	if r.Issued != nil {
+		e.key("issued")
+		e.string(*r.Issued)
+	}
+	if r.IssuedElement != nil {
+		e.key("_issued")
+		r.IssuedElement.appendJSON(e)
+	}
+	if r.Operator != nil {
+		e.key("operator")
+		r.Operator.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BodySite != nil {
+		e.key("bodySite")
+		r.BodySite.appendJSON(e)
+	}
+	if r.DeviceName != nil {
+		e.key("deviceName")
+		e.string(*r.DeviceName)
+	}
+	if r.DeviceNameElement != nil {
+		e.key("_deviceName")
+		r.DeviceNameElement.appendJSON(e)
+	}
+	if r.Device != nil {
+		e.key("device")
+		r.Device.appendJSON(e)
+	}
+	if r.Height != nil {
+		e.key("height")
+		e.int(*r.Height)
+	}
+	if r.HeightElement != nil {
+		e.key("_height")
+		r.HeightElement.appendJSON(e)
+	}
+	if r.Width != nil {
+		e.key("width")
+		e.int(*r.Width)
+	}
+	if r.WidthElement != nil {
+		e.key("_width")
+		r.WidthElement.appendJSON(e)
+	}
+	if r.Frames != nil {
+		e.key("frames")
+		e.int(*r.Frames)
+	}
+	if r.FramesElement != nil {
+		e.key("_frames")
+		r.FramesElement.appendJSON(e)
+	}
+	if r.Duration != nil {
+		e.key("duration")
+		e.number(*r.Duration)
+	}
+	if r.DurationElement != nil {
+		e.key("_duration")
+		r.DurationElement.appendJSON(e)
+	}
+	e.key("content")
+	r.Content.appendJSON(e)
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Media from JSON. Unknown members are ignored.
+func (r *Media) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Media
+func (r *Media) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "modality":
+			if d.null() {
+				r.Modality = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modality = &v
+			}
+		case "view":
+			if d.null() {
+				r.View = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.View = &v
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "createdDateTime":
+			if v, ok := d.string(); ok {
+				r.CreatedDateTime = &v
+			} else {
+				r.CreatedDateTime = nil
+			}
+		case "_createdDateTime":
+			r.CreatedDateTimeElement = d.element()
+		case "createdPeriod":
+			if d.null() {
+				r.CreatedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.CreatedPeriod = &v
+			}
+		case "issued":
+			if v, ok := d.string(); ok {
+				r.Issued = &v
+			} else {
+				r.Issued = nil
+			}
+		case "_issued":
+			r.IssuedElement = d.element()
+		case "operator":
+			if d.null() {
+				r.Operator = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Operator = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "bodySite":
+			if d.null() {
+				r.BodySite = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = &v
+			}
+		case "deviceName":
+			if v, ok := d.string(); ok {
+				r.DeviceName = &v
+			} else {
+				r.DeviceName = nil
+			}
+		case "_deviceName":
+			r.DeviceNameElement = d.element()
+		case "device":
+			if d.null() {
+				r.Device = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Device = &v
+			}
+		case "height":
+			if v, ok := d.int(); ok {
+				r.Height = &v
+			} else {
+				r.Height = nil
+			}
+		case "_height":
+			r.HeightElement = d.element()
+		case "width":
+			if v, ok := d.int(); ok {
+				r.Width = &v
+			} else {
+				r.Width = nil
+			}
+		case "_width":
+			r.WidthElement = d.element()
+		case "frames":
+			if v, ok := d.int(); ok {
+				r.Frames = &v
+			} else {
+				r.Frames = nil
+			}
+		case "_frames":
+			r.FramesElement = d.element()
+		case "duration":
+			if v, ok := d.number(); ok {
+				r.Duration = &v
+			} else {
+				r.Duration = nil
+			}
+		case "_duration":
+			r.DurationElement = d.element()
+		case "content":
+			r.Content.decodeJSON(d)
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Media which shares no memory with the original
+func (r Media) DeepCopy() Media {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Modality != nil {
+		v := r.Modality.DeepCopy()
+		out.Modality = &v
+	}
+	if r.View != nil {
+		v := r.View.DeepCopy()
+		out.View = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.CreatedDateTime != nil {
+		v := *r.CreatedDateTime
+		out.CreatedDateTime = &v
+	}
+	if r.CreatedDateTimeElement != nil {
+		v := r.CreatedDateTimeElement.DeepCopy()
+		out.CreatedDateTimeElement = &v
+	}
+	if r.CreatedPeriod != nil {
+		v := r.CreatedPeriod.DeepCopy()
+		out.CreatedPeriod = &v
+	}
+	if r.Issued != nil {
+		v := *r.Issued
+		out.Issued = &v
+	}
+	if r.IssuedElement != nil {
+		v := r.IssuedElement.DeepCopy()
+		out.IssuedElement = &v
+	}
+	if r.Operator != nil {
+		v := r.Operator.DeepCopy()
+		out.Operator = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.BodySite != nil {
+		v := r.BodySite.DeepCopy()
+		out.BodySite = &v
+	}
+	if r.DeviceName != nil {
+		v := *r.DeviceName
+		out.DeviceName = &v
+	}
+	if r.DeviceNameElement != nil {
+		v := r.DeviceNameElement.DeepCopy()
+		out.DeviceNameElement = &v
+	}
+	if r.Device != nil {
+		v := r.Device.DeepCopy()
+		out.Device = &v
+	}
+	if r.Height != nil {
+		v := *r.Height
+		out.Height = &v
+	}
+	if r.HeightElement != nil {
+		v := r.HeightElement.DeepCopy()
+		out.HeightElement = &v
+	}
+	if r.Width != nil {
+		v := *r.Width
+		out.Width = &v
+	}
+	if r.WidthElement != nil {
+		v := r.WidthElement.DeepCopy()
+		out.WidthElement = &v
+	}
+	if r.Frames != nil {
+		v := *r.Frames
+		out.Frames = &v
+	}
+	if r.FramesElement != nil {
+		v := r.FramesElement.DeepCopy()
+		out.FramesElement = &v
+	}
+	if r.Duration != nil {
+		v := *r.Duration
+		out.Duration = &v
+	}
+	if r.DurationElement != nil {
+		v := r.DurationElement.DeepCopy()
+		out.DurationElement = &v
+	}
+	out.Content = r.Content.DeepCopy()
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Media) Equal(other Media) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Modality == nil) != (other.Modality == nil) || r.Modality != nil && !r.Modality.Equal(*other.Modality) {
+		return false
+	}
+	if (r.View == nil) != (other.View == nil) || r.View != nil && !r.View.Equal(*other.View) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.CreatedDateTime == nil) != (other.CreatedDateTime == nil) || r.CreatedDateTime != nil && *r.CreatedDateTime != *other.CreatedDateTime {
+		return false
+	}
+	if (r.CreatedDateTimeElement == nil) != (other.CreatedDateTimeElement == nil) || r.CreatedDateTimeElement != nil && !r.CreatedDateTimeElement.Equal(*other.CreatedDateTimeElement) {
+		return false
+	}
+	if (r.CreatedPeriod == nil) != (other.CreatedPeriod == nil) || r.CreatedPeriod != nil && !r.CreatedPeriod.Equal(*other.CreatedPeriod) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && *r.Issued != *other.Issued {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.Equal(*other.IssuedElement) {
+		return false
+	}
+	if (r.Operator == nil) != (other.Operator == nil) || r.Operator != nil && !r.Operator.Equal(*other.Operator) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.Equal(*other.BodySite) {
+		return false
+	}
+	if (r.DeviceName == nil) != (other.DeviceName == nil) || r.DeviceName != nil && *r.DeviceName != *other.DeviceName {
+		return false
+	}
+	if (r.DeviceNameElement == nil) != (other.DeviceNameElement == nil) || r.DeviceNameElement != nil && !r.DeviceNameElement.Equal(*other.DeviceNameElement) {
+		return false
+	}
+	if (r.Device == nil) != (other.Device == nil) || r.Device != nil && !r.Device.Equal(*other.Device) {
+		return false
+	}
+	if (r.Height == nil) != (other.Height == nil) || r.Height != nil && *r.Height != *other.Height {
+		return false
+	}
+	if (r.HeightElement == nil) != (other.HeightElement == nil) || r.HeightElement != nil && !r.HeightElement.Equal(*other.HeightElement) {
+		return false
+	}
+	if (r.Width == nil) != (other.Width == nil) || r.Width != nil && *r.Width != *other.Width {
+		return false
+	}
+	if (r.WidthElement == nil) != (other.WidthElement == nil) || r.WidthElement != nil && !r.WidthElement.Equal(*other.WidthElement) {
+		return false
+	}
+	if (r.Frames == nil) != (other.Frames == nil) || r.Frames != nil && *r.Frames != *other.Frames {
+		return false
+	}
+	if (r.FramesElement == nil) != (other.FramesElement == nil) || r.FramesElement != nil && !r.FramesElement.Equal(*other.FramesElement) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !EqualDecimal(*r.Duration, *other.Duration) {
+		return false
+	}
+	if (r.DurationElement == nil) != (other.DurationElement == nil) || r.DurationElement != nil && !r.DurationElement.Equal(*other.DurationElement) {
+		return false
+	}
+	if !r.Content.Equal(other.Content) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Media) EqualsDeep(other Media) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Modality == nil) != (other.Modality == nil) || r.Modality != nil && !r.Modality.EqualsDeep(*other.Modality) {
+		return false
+	}
+	if (r.View == nil) != (other.View == nil) || r.View != nil && !r.View.EqualsDeep(*other.View) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.CreatedDateTime == nil) != (other.CreatedDateTime == nil) || r.CreatedDateTime != nil && !equivalentString(*r.CreatedDateTime, *other.CreatedDateTime) {
+		return false
+	}
+	if (r.CreatedDateTimeElement == nil) != (other.CreatedDateTimeElement == nil) || r.CreatedDateTimeElement != nil && !r.CreatedDateTimeElement.EqualsDeep(*other.CreatedDateTimeElement) {
+		return false
+	}
+	if (r.CreatedPeriod == nil) != (other.CreatedPeriod == nil) || r.CreatedPeriod != nil && !r.CreatedPeriod.EqualsDeep(*other.CreatedPeriod) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.EqualsDeep(*other.IssuedElement) {
+		return false
+	}
+	if (r.Operator == nil) != (other.Operator == nil) || r.Operator != nil && !r.Operator.EqualsDeep(*other.Operator) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.EqualsDeep(*other.BodySite) {
+		return false
+	}
+	if (r.DeviceName == nil) != (other.DeviceName == nil) || r.DeviceName != nil && !equivalentString(*r.DeviceName, *other.DeviceName) {
+		return false
+	}
+	if (r.DeviceNameElement == nil) != (other.DeviceNameElement == nil) || r.DeviceNameElement != nil && !r.DeviceNameElement.EqualsDeep(*other.DeviceNameElement) {
+		return false
+	}
+	if (r.Device == nil) != (other.Device == nil) || r.Device != nil && !r.Device.EqualsDeep(*other.Device) {
+		return false
+	}
+	if (r.Height == nil) != (other.Height == nil) || r.Height != nil && *r.Height != *other.Height {
+		return false
+	}
+	if (r.HeightElement == nil) != (other.HeightElement == nil) || r.HeightElement != nil && !r.HeightElement.EqualsDeep(*other.HeightElement) {
+		return false
+	}
+	if (r.Width == nil) != (other.Width == nil) || r.Width != nil && *r.Width != *other.Width {
+		return false
+	}
+	if (r.WidthElement == nil) != (other.WidthElement == nil) || r.WidthElement != nil && !r.WidthElement.EqualsDeep(*other.WidthElement) {
+		return false
+	}
+	if (r.Frames == nil) != (other.Frames == nil) || r.Frames != nil && *r.Frames != *other.Frames {
+		return false
+	}
+	if (r.FramesElement == nil) != (other.FramesElement == nil) || r.FramesElement != nil && !r.FramesElement.EqualsDeep(*other.FramesElement) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !equivalentDecimal(*r.Duration, *other.Duration) {
+		return false
+	}
+	if (r.DurationElement == nil) != (other.DurationElement == nil) || r.DurationElement != nil && !r.DurationElement.EqualsDeep(*other.DurationElement) {
+		return false
+	}
+	if !r.Content.EqualsDeep(other.Content) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Media) EqualsShallow(other Media) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.CreatedDateTime == nil) != (other.CreatedDateTime == nil) || r.CreatedDateTime != nil && !equivalentString(*r.CreatedDateTime, *other.CreatedDateTime) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.DeviceName == nil) != (other.DeviceName == nil) || r.DeviceName != nil && !equivalentString(*r.DeviceName, *other.DeviceName) {
+		return false
+	}
+	if (r.Height == nil) != (other.Height == nil) || r.Height != nil && *r.Height != *other.Height {
+		return false
+	}
+	if (r.Width == nil) != (other.Width == nil) || r.Width != nil && *r.Width != *other.Width {
+		return false
+	}
+	if (r.Frames == nil) != (other.Frames == nil) || r.Frames != nil && *r.Frames != *other.Frames {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !equivalentDecimal(*r.Duration, *other.Duration) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Media as FHIR XML
+func (r Media) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Media",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "modality", r.Modality); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "view", r.View); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "createdDateTime", r.CreatedDateTime, r.CreatedDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "createdPeriod", r.CreatedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "issued", r.Issued, r.IssuedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "operator", r.Operator); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "bodySite", r.BodySite); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "deviceName", r.DeviceName, r.DeviceNameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "device", r.Device); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "height", r.Height, r.HeightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "width", r.Width, r.WidthElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "frames", r.Frames, r.FramesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "duration", r.Duration, r.DurationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "content", r.Content); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Media from FHIR XML
+func (r *Media) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v EventStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "modality":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modality = &v
+			case "view":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.View = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "createdDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CreatedDateTime = &v
+				}
+				r.CreatedDateTimeElement = element
+			case "createdPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CreatedPeriod = &v
+			case "issued":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Issued = &v
+				}
+				r.IssuedElement = element
+			case "operator":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operator = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = &v
+			case "deviceName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DeviceName = &v
+				}
+				r.DeviceNameElement = element
+			case "device":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Device = &v
+			case "height":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Height = &v
+				}
+				r.HeightElement = element
+			case "width":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Width = &v
+				}
+				r.WidthElement = element
+			case "frames":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Frames = &v
+				}
+				r.FramesElement = element
+			case "duration":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Duration = &v
+				}
+				r.DurationElement = element
+			case "content":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Content = v
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Media as properties to the RDF node n
+func (r Media) turtle(n *turtleNode) {
+	n.resource("Media", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Media.identifier", i, v)
+	}
+	for i, v := range r.BasedOn {
+		n.element("Media.basedOn", i, v)
+	}
+	for i, v := range r.PartOf {
+		n.element("Media.partOf", i, v)
+	}
+	n.primitive("Media.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Media.type", -1, r.Type)
+	n.element("Media.modality", -1, r.Modality)
+	n.element("Media.view", -1, r.View)
+	n.element("Media.subject", -1, r.Subject)
+	n.element("Media.encounter", -1, r.Encounter)
+	n.primitive("Media.createdDateTime", -1, r.CreatedDateTime, r.CreatedDateTimeElement, "dateTime")
+	n.element("Media.createdPeriod", -1, r.CreatedPeriod)
+	n.primitive("Media.issued", -1, r.Issued, r.IssuedElement, "instant")
+	n.element("Media.operator", -1, r.Operator)
+	for i, v := range r.ReasonCode {
+		n.element("Media.reasonCode", i, v)
+	}
+	n.element("Media.bodySite", -1, r.BodySite)
+	n.primitive("Media.deviceName", -1, r.DeviceName, r.DeviceNameElement, "string")
+	n.element("Media.device", -1, r.Device)
+	n.primitive("Media.height", -1, r.Height, r.HeightElement, "positiveInt")
+	n.primitive("Media.width", -1, r.Width, r.WidthElement, "positiveInt")
+	n.primitive("Media.frames", -1, r.Frames, r.FramesElement, "positiveInt")
+	n.primitive("Media.duration", -1, r.Duration, r.DurationElement, "decimal")
+	n.element("Media.content", -1, r.Content)
+	for i, v := range r.Note {
+		n.element("Media.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Media as BSON document with the structure of its FHIR JSON
+func (r Media) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Media from a BSON document with the structure of its FHIR JSON
+func (r *Media) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Media as protobuf message Media of fhir.proto
+func (r Media) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Media to the encoded message
+func (r Media) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(10, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(11, v)
+	}
+	e.varint(12, r.Status.protoNumber())
+	if r.Type != nil {
+		e.message(13, *r.Type)
+	}
+	if r.Modality != nil {
+		e.message(14, *r.Modality)
+	}
+	if r.View != nil {
+		e.message(15, *r.View)
+	}
+	if r.Subject != nil {
+		e.message(16, *r.Subject)
+	}
+	if r.Encounter != nil {
+		e.message(17, *r.Encounter)
+	}
+	if r.CreatedDateTime != nil {
+		e.string(18, *r.CreatedDateTime)
+	}
+	if r.CreatedPeriod != nil {
+		e.message(19, *r.CreatedPeriod)
+	}
+	if r.Issued != nil {
+		e.string(20, *r.Issued)
+	}
+	if r.Operator != nil {
+		e.message(21, *r.Operator)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(22, v)
+	}
+	if r.BodySite != nil {
+		e.message(23, *r.BodySite)
+	}
+	if r.DeviceName != nil {
+		e.string(24, *r.DeviceName)
+	}
+	if r.Device != nil {
+		e.message(25, *r.Device)
+	}
+	if r.Height != nil {
+		e.varint(26, uint64(int64(*r.Height)))
+	}
+	if r.Width != nil {
+		e.varint(27, uint64(int64(*r.Width)))
+	}
+	if r.Frames != nil {
+		e.varint(28, uint64(int64(*r.Frames)))
+	}
+	if r.Duration != nil {
+		e.string(29, string(*r.Duration))
+	}
+	e.message(30, r.Content)
+	for _, v := range r.Note {
+		e.message(31, v)
+	}
+	if r.IdElement != nil {
+		e.message(32, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(33, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(34, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(35, *r.StatusElement)
+	}
+	if r.CreatedDateTimeElement != nil {
+		e.message(36, *r.CreatedDateTimeElement)
+	}
+	if r.IssuedElement != nil {
+		e.message(37, *r.IssuedElement)
+	}
+	if r.DeviceNameElement != nil {
+		e.message(38, *r.DeviceNameElement)
+	}
+	if r.HeightElement != nil {
+		e.message(39, *r.HeightElement)
+	}
+	if r.WidthElement != nil {
+		e.message(40, *r.WidthElement)
+	}
+	if r.FramesElement != nil {
+		e.message(41, *r.FramesElement)
+	}
+	if r.DurationElement != nil {
+		e.message(42, *r.DurationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Media from protobuf message Media of fhir.proto
+func (r *Media) UnmarshalProto(b []byte) error {
+	*r = Media{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 12:
+			var v EventStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modality = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.View = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 18:
+			v := d.string()
+			r.CreatedDateTime = &v
+		case 19:
+			var v Period
+			d.message(&v)
+			r.CreatedPeriod = &v
+		case 20:
+			v := d.string()
+			r.Issued = &v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Operator = &v
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = &v
+		case 24:
+			v := d.string()
+			r.DeviceName = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Device = &v
+		case 26:
+			v := d.int()
+			r.Height = &v
+		case 27:
+			v := d.int()
+			r.Width = &v
+		case 28:
+			v := d.int()
+			r.Frames = &v
+		case 29:
+			v := json.Number(d.string())
+			r.Duration = &v
+		case 30:
+			var v Attachment
+			d.message(&v)
+			r.Content = v
+		case 31:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 32:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.CreatedDateTimeElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.IssuedElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.DeviceNameElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.HeightElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.WidthElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.FramesElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.DurationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedia unmarshals a Media.
 func UnmarshalMedia(b []byte) (Media, error) {
 	var media Media
-	if err := json.Unmarshal(b, &media); err != nil {
+	if err := media.UnmarshalJSON(b); err != nil {
 		return media, err
 	}
 	return media, nil
@@ -14,62 +14,1943 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Medication is documented here http://hl7.org/fhir/StructureDefinition/Medication
 type Medication struct {
-	Id                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Code              *CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
-	Status            *string                `bson:"status,omitempty" json:"status,omitempty"`
-	Manufacturer      *Reference             `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	Form              *CodeableConcept       `bson:"form,omitempty" json:"form,omitempty"`
-	Amount            *Ratio                 `bson:"amount,omitempty" json:"amount,omitempty"`
-	Ingredient        []MedicationIngredient `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
-	Batch             *MedicationBatch       `bson:"batch,omitempty" json:"batch,omitempty"`
+	Id                   *string                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element               `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Code                 *CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
+	Status               *string                `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element               `bson:"_status,omitempty" json:"_status,omitempty"`
+	Manufacturer         *Reference             `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	Form                 *CodeableConcept       `bson:"form,omitempty" json:"form,omitempty"`
+	Amount               *Ratio                 `bson:"amount,omitempty" json:"amount,omitempty"`
+	Ingredient           []MedicationIngredient `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
+	Batch                *MedicationBatch       `bson:"batch,omitempty" json:"batch,omitempty"`
 }
 type MedicationIngredient struct {
-	Id                  *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemCodeableConcept CodeableConcept `bson:"itemCodeableConcept" json:"itemCodeableConcept"`
-	ItemReference       Reference       `bson:"itemReference" json:"itemReference"`
-	IsActive            *bool           `bson:"isActive,omitempty" json:"isActive,omitempty"`
-	Strength            *Ratio          `bson:"strength,omitempty" json:"strength,omitempty"`
+	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemCodeableConcept *CodeableConcept `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
+	ItemReference       *Reference       `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
+	IsActive            *bool            `bson:"isActive,omitempty" json:"isActive,omitempty"`
+	IsActiveElement     *Element         `bson:"_isActive,omitempty" json:"_isActive,omitempty"`
+	Strength            *Ratio           `bson:"strength,omitempty" json:"strength,omitempty"`
 }
+
+// MedicationIngredientItem is implemented by the types allowed for Medication.ingredient.item[x]
+type MedicationIngredientItem interface {
+	isMedicationIngredientItem()
+}
+
+func (CodeableConcept) isMedicationIngredientItem() {}
+func (Reference) isMedicationIngredientItem()       {}
+
+// Item returns the value of Medication.ingredient.item[x] together with its FHIR type code or nil if no value is set
+func (r MedicationIngredient) Item() (MedicationIngredientItem, string) {
+	if r.ItemCodeableConcept != nil {
+		return *r.ItemCodeableConcept, "CodeableConcept"
+	}
+	if r.ItemReference != nil {
+		return *r.ItemReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetItem sets the value of Medication.ingredient.item[x], given as value or pointer, and clears all other types
+func (r *MedicationIngredient) SetItem(value MedicationIngredientItem) {
+	r.ItemCodeableConcept = nil
+	r.ItemReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ItemCodeableConcept = &v
+	case *CodeableConcept:
+		r.ItemCodeableConcept = v
+	case Reference:
+		r.ItemReference = &v
+	case *Reference:
+		r.ItemReference = v
+	}
+}
+
 type MedicationBatch struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	LotNumber         *string     `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
-	ExpirationDate    *string     `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+	Id                    *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	LotNumber             *string     `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
+	LotNumberElement      *Element    `bson:"_lotNumber,omitempty" json:"_lotNumber,omitempty"`
+	ExpirationDate        *string     `bson:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+	ExpirationDateElement *Element    `bson:"_expirationDate,omitempty" json:"_expirationDate,omitempty"`
 }
-type OtherMedication Medication
 
 // MarshalJSON marshals the given Medication as JSON into a byte slice
 func (r Medication) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedication
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedication: OtherMedication(r),
-		ResourceType:    "Medication",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Medication as JSON object with the members in the order of the fields
+func (r Medication) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Medication\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(*r.Status)
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Manufacturer != nil {
+		e.key("manufacturer")
+		r.Manufacturer.appendJSON(e)
+	}
+	if r.Form != nil {
+		e.key("form")
+		r.Form.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	if len(r.Ingredient) > 0 {
+		e.key("ingredient")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Ingredient {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Batch != nil {
+		e.key("batch")
+		r.Batch.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Medication from JSON. Unknown members are ignored.
+func (r *Medication) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Medication
+func (r *Medication) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "status":
+			if v, ok := d.string(); ok {
+				r.Status = &v
+			} else {
+				r.Status = nil
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "manufacturer":
+			if d.null() {
+				r.Manufacturer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Manufacturer = &v
+			}
+		case "form":
+			if d.null() {
+				r.Form = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Form = &v
+			}
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		case "ingredient":
+			a := d.array()
+			r.Ingredient = nil
+			for a.next() {
+				var v MedicationIngredient
+				v.decodeJSON(d)
+				r.Ingredient = append(r.Ingredient, v)
+			}
+			if r.Ingredient == nil && !a.isNull {
+				r.Ingredient = []MedicationIngredient{}
+			}
+		case "batch":
+			if d.null() {
+				r.Batch = nil
+			} else {
+				var v MedicationBatch
+				v.decodeJSON(d)
+				r.Batch = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Medication which shares no memory with the original
+func (r Medication) DeepCopy() Medication {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Manufacturer != nil {
+		v := r.Manufacturer.DeepCopy()
+		out.Manufacturer = &v
+	}
+	if r.Form != nil {
+		v := r.Form.DeepCopy()
+		out.Form = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	if r.Ingredient != nil {
+		out.Ingredient = make([]MedicationIngredient, len(r.Ingredient))
+		for i := range r.Ingredient {
+			out.Ingredient[i] = r.Ingredient[i].DeepCopy()
+		}
+	}
+	if r.Batch != nil {
+		v := r.Batch.DeepCopy()
+		out.Batch = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Medication) Equal(other Medication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Manufacturer == nil) != (other.Manufacturer == nil) || r.Manufacturer != nil && !r.Manufacturer.Equal(*other.Manufacturer) {
+		return false
+	}
+	if (r.Form == nil) != (other.Form == nil) || r.Form != nil && !r.Form.Equal(*other.Form) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	if len(r.Ingredient) != len(other.Ingredient) {
+		return false
+	}
+	for i := range r.Ingredient {
+		if !r.Ingredient[i].Equal(other.Ingredient[i]) {
+			return false
+		}
+	}
+	if (r.Batch == nil) != (other.Batch == nil) || r.Batch != nil && !r.Batch.Equal(*other.Batch) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Medication) EqualsDeep(other Medication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !equivalentString(*r.Status, *other.Status) {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Manufacturer == nil) != (other.Manufacturer == nil) || r.Manufacturer != nil && !r.Manufacturer.EqualsDeep(*other.Manufacturer) {
+		return false
+	}
+	if (r.Form == nil) != (other.Form == nil) || r.Form != nil && !r.Form.EqualsDeep(*other.Form) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	if !equivalentList(len(r.Ingredient), len(other.Ingredient), func(i, j int) bool {
+		return r.Ingredient[i].EqualsDeep(other.Ingredient[j])
+	}) {
+		return false
+	}
+	if (r.Batch == nil) != (other.Batch == nil) || r.Batch != nil && !r.Batch.EqualsDeep(*other.Batch) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Medication) EqualsShallow(other Medication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !equivalentString(*r.Status, *other.Status) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Medication as FHIR XML
+func (r Medication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Medication",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "manufacturer", r.Manufacturer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "form", r.Form); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	for _, v := range r.Ingredient {
+		if err := encodeXMLElement(e, "ingredient", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "batch", r.Batch); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Medication from FHIR XML
+func (r *Medication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "status":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "manufacturer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manufacturer = &v
+			case "form":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Form = &v
+			case "amount":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			case "ingredient":
+				var v MedicationIngredient
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Ingredient = append(r.Ingredient, v)
+			case "batch":
+				var v MedicationBatch
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Batch = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Medication as properties to the RDF node n
+func (r Medication) turtle(n *turtleNode) {
+	n.resource("Medication", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Medication.identifier", i, v)
+	}
+	n.element("Medication.code", -1, r.Code)
+	n.primitive("Medication.status", -1, r.Status, r.StatusElement, "string")
+	n.element("Medication.manufacturer", -1, r.Manufacturer)
+	n.element("Medication.form", -1, r.Form)
+	n.element("Medication.amount", -1, r.Amount)
+	for i, v := range r.Ingredient {
+		n.element("Medication.ingredient", i, v)
+	}
+	n.element("Medication.batch", -1, r.Batch)
+}
+
+// MarshalBSON marshals the given Medication as BSON document with the structure of its FHIR JSON
+func (r Medication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Medication from a BSON document with the structure of its FHIR JSON
+func (r *Medication) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Medication as protobuf message Medication of fhir.proto
+func (r Medication) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Medication to the encoded message
+func (r Medication) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Code != nil {
+		e.message(10, *r.Code)
+	}
+	if r.Status != nil {
+		e.string(11, *r.Status)
+	}
+	if r.Manufacturer != nil {
+		e.message(12, *r.Manufacturer)
+	}
+	if r.Form != nil {
+		e.message(13, *r.Form)
+	}
+	if r.Amount != nil {
+		e.message(14, *r.Amount)
+	}
+	for _, v := range r.Ingredient {
+		e.message(15, v)
+	}
+	if r.Batch != nil {
+		e.message(16, *r.Batch)
+	}
+	if r.IdElement != nil {
+		e.message(17, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(18, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(19, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(20, *r.StatusElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Medication from protobuf message Medication of fhir.proto
+func (r *Medication) UnmarshalProto(b []byte) error {
+	*r = Medication{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 11:
+			v := d.string()
+			r.Status = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Manufacturer = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Form = &v
+		case 14:
+			var v Ratio
+			d.message(&v)
+			r.Amount = &v
+		case 15:
+			var v MedicationIngredient
+			d.message(&v)
+			r.Ingredient = append(r.Ingredient, v)
+		case 16:
+			var v MedicationBatch
+			d.message(&v)
+			r.Batch = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationIngredient as JSON into a byte slice
+func (r MedicationIngredient) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationIngredient as JSON object with the members in the order of the fields
+func (r MedicationIngredient) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ItemCodeableConcept != nil {
+		e.key("itemCodeableConcept")
+		r.ItemCodeableConcept.appendJSON(e)
+	}
+	if r.ItemReference != nil {
+		e.key("itemReference")
+		r.ItemReference.appendJSON(e)
+	}
+	if r.IsActive != nil {
+		e.key("isActive")
+		e.bool(*r.IsActive)
+	}
+	if r.IsActiveElement != nil {
+		e.key("_isActive")
+		r.IsActiveElement.appendJSON(e)
+	}
+	if r.Strength != nil {
+		e.key("strength")
+		r.Strength.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationIngredient from JSON. Unknown members are ignored.
+func (r *MedicationIngredient) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationIngredient
+func (r *MedicationIngredient) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "itemCodeableConcept":
+			if d.null() {
+				r.ItemCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ItemCodeableConcept = &v
+			}
+		case "itemReference":
+			if d.null() {
+				r.ItemReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ItemReference = &v
+			}
+		case "isActive":
+			if v, ok := d.bool(); ok {
+				r.IsActive = &v
+			} else {
+				r.IsActive = nil
+			}
+		case "_isActive":
+			r.IsActiveElement = d.element()
+		case "strength":
+			if d.null() {
+				r.Strength = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.Strength = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationIngredient which shares no memory with the original
+func (r MedicationIngredient) DeepCopy() MedicationIngredient {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ItemCodeableConcept != nil {
+		v := r.ItemCodeableConcept.DeepCopy()
+		out.ItemCodeableConcept = &v
+	}
+	if r.ItemReference != nil {
+		v := r.ItemReference.DeepCopy()
+		out.ItemReference = &v
+	}
+	if r.IsActive != nil {
+		v := *r.IsActive
+		out.IsActive = &v
+	}
+	if r.IsActiveElement != nil {
+		v := r.IsActiveElement.DeepCopy()
+		out.IsActiveElement = &v
+	}
+	if r.Strength != nil {
+		v := r.Strength.DeepCopy()
+		out.Strength = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationIngredient) Equal(other MedicationIngredient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.Equal(*other.ItemCodeableConcept) {
+		return false
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.Equal(*other.ItemReference) {
+		return false
+	}
+	if (r.IsActive == nil) != (other.IsActive == nil) || r.IsActive != nil && *r.IsActive != *other.IsActive {
+		return false
+	}
+	if (r.IsActiveElement == nil) != (other.IsActiveElement == nil) || r.IsActiveElement != nil && !r.IsActiveElement.Equal(*other.IsActiveElement) {
+		return false
+	}
+	if (r.Strength == nil) != (other.Strength == nil) || r.Strength != nil && !r.Strength.Equal(*other.Strength) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationIngredient) EqualsDeep(other MedicationIngredient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.EqualsDeep(*other.ItemCodeableConcept) {
+		return false
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.EqualsDeep(*other.ItemReference) {
+		return false
+	}
+	if (r.IsActive == nil) != (other.IsActive == nil) || r.IsActive != nil && *r.IsActive != *other.IsActive {
+		return false
+	}
+	if (r.IsActiveElement == nil) != (other.IsActiveElement == nil) || r.IsActiveElement != nil && !r.IsActiveElement.EqualsDeep(*other.IsActiveElement) {
+		return false
+	}
+	if (r.Strength == nil) != (other.Strength == nil) || r.Strength != nil && !r.Strength.EqualsDeep(*other.Strength) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationIngredient) EqualsShallow(other MedicationIngredient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IsActive == nil) != (other.IsActive == nil) || r.IsActive != nil && *r.IsActive != *other.IsActive {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationIngredient as FHIR XML
+func (r MedicationIngredient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "itemCodeableConcept", r.ItemCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "itemReference", r.ItemReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "isActive", r.IsActive, r.IsActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "strength", r.Strength); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationIngredient from FHIR XML
+func (r *MedicationIngredient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "itemCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemCodeableConcept = &v
+			case "itemReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemReference = &v
+			case "isActive":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsActive = &v
+				}
+				r.IsActiveElement = element
+			case "strength":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Strength = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationIngredient as properties to the RDF node n
+func (r MedicationIngredient) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Medication.ingredient.itemCodeableConcept", -1, r.ItemCodeableConcept)
+	n.element("Medication.ingredient.itemReference", -1, r.ItemReference)
+	n.primitive("Medication.ingredient.isActive", -1, r.IsActive, r.IsActiveElement, "boolean")
+	n.element("Medication.ingredient.strength", -1, r.Strength)
+}
+
+// MarshalBSON marshals the given MedicationIngredient as BSON document with the structure of its FHIR JSON
+func (r MedicationIngredient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationIngredient from a BSON document with the structure of its FHIR JSON
+func (r *MedicationIngredient) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationIngredient as protobuf message MedicationIngredient of fhir.proto
+func (r MedicationIngredient) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationIngredient to the encoded message
+func (r MedicationIngredient) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ItemCodeableConcept != nil {
+		e.message(4, *r.ItemCodeableConcept)
+	}
+	if r.ItemReference != nil {
+		e.message(5, *r.ItemReference)
+	}
+	if r.IsActive != nil {
+		e.varint(6, protoBool(*r.IsActive))
+	}
+	if r.Strength != nil {
+		e.message(7, *r.Strength)
+	}
+	if r.IsActiveElement != nil {
+		e.message(8, *r.IsActiveElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationIngredient from protobuf message MedicationIngredient of fhir.proto
+func (r *MedicationIngredient) UnmarshalProto(b []byte) error {
+	*r = MedicationIngredient{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.ItemCodeableConcept = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.ItemReference = &v
+		case 6:
+			v := d.bool()
+			r.IsActive = &v
+		case 7:
+			var v Ratio
+			d.message(&v)
+			r.Strength = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.IsActiveElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationBatch as JSON into a byte slice
+func (r MedicationBatch) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationBatch as JSON object with the members in the order of the fields
+func (r MedicationBatch) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.LotNumber != nil {
+		e.key("lotNumber")
+		e.string(*r.LotNumber)
+	}
+	if r.LotNumberElement != nil {
+		e.key("_lotNumber")
+		r.LotNumberElement.appendJSON(e)
+	}
+	if r.ExpirationDate != nil {
+		e.key("expirationDate")
+		e.string(*r.ExpirationDate)
+	}
+	if r.ExpirationDateElement != nil {
+		e.key("_expirationDate")
+		r.ExpirationDateElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationBatch from JSON. Unknown members are ignored.
+func (r *MedicationBatch) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationBatch
+func (r *MedicationBatch) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "lotNumber":
+			if v, ok := d.string(); ok {
+				r.LotNumber = &v
+			} else {
+				r.LotNumber = nil
+			}
+		case "_lotNumber":
+			r.LotNumberElement = d.element()
+		case "expirationDate":
+			if v, ok := d.string(); ok {
+				r.ExpirationDate = &v
+			} else {
+				r.ExpirationDate = nil
+			}
+		case "_expirationDate":
+			r.ExpirationDateElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationBatch which shares no memory with the original
+func (r MedicationBatch) DeepCopy() MedicationBatch {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.LotNumber != nil {
+		v := *r.LotNumber
+		out.LotNumber = &v
+	}
+	if r.LotNumberElement != nil {
+		v := r.LotNumberElement.DeepCopy()
+		out.LotNumberElement = &v
+	}
+	if r.ExpirationDate != nil {
+		v := *r.ExpirationDate
+		out.ExpirationDate = &v
+	}
+	if r.ExpirationDateElement != nil {
+		v := r.ExpirationDateElement.DeepCopy()
+		out.ExpirationDateElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationBatch) Equal(other MedicationBatch) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.LotNumber == nil) != (other.LotNumber == nil) || r.LotNumber != nil && *r.LotNumber != *other.LotNumber {
+		return false
+	}
+	if (r.LotNumberElement == nil) != (other.LotNumberElement == nil) || r.LotNumberElement != nil && !r.LotNumberElement.Equal(*other.LotNumberElement) {
+		return false
+	}
+	if (r.ExpirationDate == nil) != (other.ExpirationDate == nil) || r.ExpirationDate != nil && *r.ExpirationDate != *other.ExpirationDate {
+		return false
+	}
+	if (r.ExpirationDateElement == nil) != (other.ExpirationDateElement == nil) || r.ExpirationDateElement != nil && !r.ExpirationDateElement.Equal(*other.ExpirationDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationBatch) EqualsDeep(other MedicationBatch) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.LotNumber == nil) != (other.LotNumber == nil) || r.LotNumber != nil && !equivalentString(*r.LotNumber, *other.LotNumber) {
+		return false
+	}
+	if (r.LotNumberElement == nil) != (other.LotNumberElement == nil) || r.LotNumberElement != nil && !r.LotNumberElement.EqualsDeep(*other.LotNumberElement) {
+		return false
+	}
+	if (r.ExpirationDate == nil) != (other.ExpirationDate == nil) || r.ExpirationDate != nil && !equivalentString(*r.ExpirationDate, *other.ExpirationDate) {
+		return false
+	}
+	if (r.ExpirationDateElement == nil) != (other.ExpirationDateElement == nil) || r.ExpirationDateElement != nil && !r.ExpirationDateElement.EqualsDeep(*other.ExpirationDateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationBatch) EqualsShallow(other MedicationBatch) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.LotNumber == nil) != (other.LotNumber == nil) || r.LotNumber != nil && !equivalentString(*r.LotNumber, *other.LotNumber) {
+		return false
+	}
+	if (r.ExpirationDate == nil) != (other.ExpirationDate == nil) || r.ExpirationDate != nil && !equivalentString(*r.ExpirationDate, *other.ExpirationDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationBatch as FHIR XML
+func (r MedicationBatch) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "lotNumber", r.LotNumber, r.LotNumberElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expirationDate", r.ExpirationDate, r.ExpirationDateElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationBatch from FHIR XML
+func (r *MedicationBatch) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "lotNumber":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LotNumber = &v
+				}
+				r.LotNumberElement = element
+			case "expirationDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ExpirationDate = &v
+				}
+				r.ExpirationDateElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationBatch as properties to the RDF node n
+func (r MedicationBatch) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Medication.batch.lotNumber", -1, r.LotNumber, r.LotNumberElement, "string")
+	n.primitive("Medication.batch.expirationDate", -1, r.ExpirationDate, r.ExpirationDateElement, "dateTime")
+}
+
+// MarshalBSON marshals the given MedicationBatch as BSON document with the structure of its FHIR JSON
+func (r MedicationBatch) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationBatch from a BSON document with the structure of its FHIR JSON
+func (r *MedicationBatch) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationBatch as protobuf message MedicationBatch of fhir.proto
+func (r MedicationBatch) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationBatch to the encoded message
+func (r MedicationBatch) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.LotNumber != nil {
+		e.string(4, *r.LotNumber)
+	}
+	if r.ExpirationDate != nil {
+		e.string(5, *r.ExpirationDate)
+	}
+	if r.LotNumberElement != nil {
+		e.message(6, *r.LotNumberElement)
+	}
+	if r.ExpirationDateElement != nil {
+		e.message(7, *r.ExpirationDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationBatch from protobuf message MedicationBatch of fhir.proto
+func (r *MedicationBatch) UnmarshalProto(b []byte) error {
+	*r = MedicationBatch{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.LotNumber = &v
+		case 5:
+			v := d.string()
+			r.ExpirationDate = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.LotNumberElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ExpirationDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedication unmarshals a Medication.
 func UnmarshalMedication(b []byte) (Medication, error) {
 	var medication Medication
-	if err := json.Unmarshal(b, &medication); err != nil {
+	if err := medication.UnmarshalJSON(b); err != nil {
 		return medication, err
 	}
 	return medication, nil
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,25 +25,32 @@ import "encoding/json"
 // MedicationAdministration is documented here http://hl7.org/fhir/StructureDefinition/MedicationAdministration
 type MedicationAdministration struct {
 	Id                        *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element                            `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                      *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules             *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                  *string                             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                            `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                      *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage                   `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                 []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension         []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier                []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Instantiates              []string                            `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
+	InstantiatesElement       []*Element                          `bson:"_instantiates,omitempty" json:"_instantiates,omitempty"`
 	PartOf                    []Reference                         `bson:"partOf,omitempty" json:"partOf,omitempty"`
 	Status                    string                              `bson:"status" json:"status"`
+	StatusElement             *Element                            `bson:"_status,omitempty" json:"_status,omitempty"`
 	StatusReason              []CodeableConcept                   `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
 	Category                  *CodeableConcept                    `bson:"category,omitempty" json:"category,omitempty"`
-	MedicationCodeableConcept CodeableConcept                     `bson:"medicationCodeableConcept" json:"medicationCodeableConcept"`
-	MedicationReference       Reference                           `bson:"medicationReference" json:"medicationReference"`
+	MedicationCodeableConcept *CodeableConcept                    `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *Reference                          `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
 	Subject                   Reference                           `bson:"subject" json:"subject"`
 	Context                   *Reference                          `bson:"context,omitempty" json:"context,omitempty"`
 	SupportingInformation     []Reference                         `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
-	EffectiveDateTime         string                              `bson:"effectiveDateTime" json:"effectiveDateTime"`
-	EffectivePeriod           Period                              `bson:"effectivePeriod" json:"effectivePeriod"`
+	EffectiveDateTime         *string                             `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement  *Element                            `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod           *Period                             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
 	Performer                 []MedicationAdministrationPerformer `bson:"performer,omitempty" json:"performer,omitempty"`
 	ReasonCode                []CodeableConcept                   `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
 	ReasonReference           []Reference                         `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
@@ -50,6 +60,78 @@ type MedicationAdministration struct {
 	Dosage                    *MedicationAdministrationDosage     `bson:"dosage,omitempty" json:"dosage,omitempty"`
 	EventHistory              []Reference                         `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
 }
+
+// MedicationAdministrationMedication is implemented by the types allowed for MedicationAdministration.medication[x]
+type MedicationAdministrationMedication interface {
+	isMedicationAdministrationMedication()
+}
+
+func (CodeableConcept) isMedicationAdministrationMedication() {}
+func (Reference) isMedicationAdministrationMedication()       {}
+
+// Medication returns the value of MedicationAdministration.medication[x] together with its FHIR type code or nil if no value is set
+func (r MedicationAdministration) Medication() (MedicationAdministrationMedication, string) {
+	if r.MedicationCodeableConcept != nil {
+		return *r.MedicationCodeableConcept, "CodeableConcept"
+	}
+	if r.MedicationReference != nil {
+		return *r.MedicationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetMedication sets the value of MedicationAdministration.medication[x], given as value or pointer, and clears all other types
+func (r *MedicationAdministration) SetMedication(value MedicationAdministrationMedication) {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.MedicationCodeableConcept = &v
+	case *CodeableConcept:
+		r.MedicationCodeableConcept = v
+	case Reference:
+		r.MedicationReference = &v
+	case *Reference:
+		r.MedicationReference = v
+	}
+}
+
+// MedicationAdministrationEffective is implemented by the types allowed for MedicationAdministration.effective[x]
+type MedicationAdministrationEffective interface {
+	isMedicationAdministrationEffective()
+}
+
+func (DateTime) isMedicationAdministrationEffective() {}
+func (Period) isMedicationAdministrationEffective()   {}
+
+// Effective returns the value of MedicationAdministration.effective[x] together with its FHIR type code or nil if no value is set
+func (r MedicationAdministration) Effective() (MedicationAdministrationEffective, string) {
+	if r.EffectiveDateTime != nil {
+		return DateTime(*r.EffectiveDateTime), "dateTime"
+	}
+	if r.EffectivePeriod != nil {
+		return *r.EffectivePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetEffective sets the value of MedicationAdministration.effective[x], given as value or pointer, and clears all other types
+func (r *MedicationAdministration) SetEffective(value MedicationAdministrationEffective) {
+	r.EffectiveDateTime = nil
+	r.EffectivePeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.EffectiveDateTime = &primitive
+	case *DateTime:
+		r.EffectiveDateTime = (*string)(v)
+	case Period:
+		r.EffectivePeriod = &v
+	case *Period:
+		r.EffectivePeriod = v
+	}
+}
+
 type MedicationAdministrationPerformer struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -62,6 +144,7 @@ type MedicationAdministrationDosage struct {
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Text              *string          `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element         `bson:"_text,omitempty" json:"_text,omitempty"`
 	Site              *CodeableConcept `bson:"site,omitempty" json:"site,omitempty"`
 	Route             *CodeableConcept `bson:"route,omitempty" json:"route,omitempty"`
 	Method            *CodeableConcept `bson:"method,omitempty" json:"method,omitempty"`
@@ -69,23 +152,2677 @@ type MedicationAdministrationDosage struct {
 	RateRatio         *Ratio           `bson:"rateRatio,omitempty" json:"rateRatio,omitempty"`
 	RateQuantity      *Quantity        `bson:"rateQuantity,omitempty" json:"rateQuantity,omitempty"`
 }
-type OtherMedicationAdministration MedicationAdministration
+
+// MedicationAdministrationDosageRate is implemented by the types allowed for MedicationAdministration.dosage.rate[x]
+type MedicationAdministrationDosageRate interface {
+	isMedicationAdministrationDosageRate()
+}
+
+func (Ratio) isMedicationAdministrationDosageRate()    {}
+func (Quantity) isMedicationAdministrationDosageRate() {}
+
+// Rate returns the value of MedicationAdministration.dosage.rate[x] together with its FHIR type code or nil if no value is set
+func (r MedicationAdministrationDosage) Rate() (MedicationAdministrationDosageRate, string) {
+	if r.RateRatio != nil {
+		return *r.RateRatio, "Ratio"
+	}
+	if r.RateQuantity != nil {
+		return *r.RateQuantity, "Quantity"
+	}
+	return nil, ""
+}
+
+// SetRate sets the value of MedicationAdministration.dosage.rate[x], given as value or pointer, and clears all other types
+func (r *MedicationAdministrationDosage) SetRate(value MedicationAdministrationDosageRate) {
+	r.RateRatio = nil
+	r.RateQuantity = nil
+	switch v := value.(type) {
+	case Ratio:
+		r.RateRatio = &v
+	case *Ratio:
+		r.RateRatio = v
+	case Quantity:
+		r.RateQuantity = &v
+	case *Quantity:
+		r.RateQuantity = v
+	}
+}
 
 // MarshalJSON marshals the given MedicationAdministration as JSON into a byte slice
 func (r MedicationAdministration) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicationAdministration
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicationAdministration: OtherMedicationAdministration(r),
-		ResourceType:                  "MedicationAdministration",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationAdministration as JSON object with the members in the order of the fields
+func (r MedicationAdministration) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicationAdministration\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Instantiates) > 0 {
+		e.key("instantiates")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Instantiates {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesElement) > 0 {
+		e.key("_instantiates")
+		e.elements(r.InstantiatesElement, len(r.Instantiates))
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status)
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.StatusReason) > 0 {
+		e.key("statusReason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.StatusReason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	if r.MedicationCodeableConcept != nil {
+		e.key("medicationCodeableConcept")
+		r.MedicationCodeableConcept.appendJSON(e)
+	}
+	if r.MedicationReference != nil {
+		e.key("medicationReference")
+		r.MedicationReference.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Context != nil {
+		e.key("context")
+		r.Context.appendJSON(e)
+	}
+	if len(r.SupportingInformation) > 0 {
+		e.key("supportingInformation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInformation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.EffectiveDateTime != nil {
+		e.key("effectiveDateTime")
+		e.string(*r.EffectiveDateTime)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.key("_effectiveDateTime")
+		r.EffectiveDateTimeElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Request != nil {
+		e.key("request")
+		r.Request.appendJSON(e)
+	}
+	if len(r.Device) > 0 {
+		e.key("device")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Device {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Dosage != nil {
+		e.key("dosage")
+		r.Dosage.appendJSON(e)
+	}
+	if len(r.EventHistory) > 0 {
+		e.key("eventHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.EventHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationAdministration from JSON. Unknown members are ignored.
+func (r *MedicationAdministration) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationAdministration
+func (r *MedicationAdministration) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiates":
+			a := d.array()
+			r.Instantiates = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Instantiates = append(r.Instantiates, v)
+			}
+			if r.Instantiates == nil && !a.isNull {
+				r.Instantiates = []string{}
+			}
+		case "_instantiates":
+			a := d.array()
+			r.InstantiatesElement = nil
+			for a.next() {
+				r.InstantiatesElement = append(r.InstantiatesElement, d.element())
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			if v, ok := d.string(); ok {
+				r.Status = v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			a := d.array()
+			r.StatusReason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = append(r.StatusReason, v)
+			}
+			if r.StatusReason == nil && !a.isNull {
+				r.StatusReason = []CodeableConcept{}
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "medicationCodeableConcept":
+			if d.null() {
+				r.MedicationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.MedicationCodeableConcept = &v
+			}
+		case "medicationReference":
+			if d.null() {
+				r.MedicationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.MedicationReference = &v
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "context":
+			if d.null() {
+				r.Context = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Context = &v
+			}
+		case "supportingInformation":
+			a := d.array()
+			r.SupportingInformation = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			}
+			if r.SupportingInformation == nil && !a.isNull {
+				r.SupportingInformation = []Reference{}
+			}
+		case "effectiveDateTime":
+			if v, ok := d.string(); ok {
+				r.EffectiveDateTime = &v
+			} else {
+				r.EffectiveDateTime = nil
+			}
+		case "_effectiveDateTime":
+			r.EffectiveDateTimeElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v MedicationAdministrationPerformer
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []MedicationAdministrationPerformer{}
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "request":
+			if d.null() {
+				r.Request = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Request = &v
+			}
+		case "device":
+			a := d.array()
+			r.Device = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Device = append(r.Device, v)
+			}
+			if r.Device == nil && !a.isNull {
+				r.Device = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "dosage":
+			if d.null() {
+				r.Dosage = nil
+			} else {
+				var v MedicationAdministrationDosage
+				v.decodeJSON(d)
+				r.Dosage = &v
+			}
+		case "eventHistory":
+			a := d.array()
+			r.EventHistory = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.EventHistory = append(r.EventHistory, v)
+			}
+			if r.EventHistory == nil && !a.isNull {
+				r.EventHistory = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationAdministration which shares no memory with the original
+func (r MedicationAdministration) DeepCopy() MedicationAdministration {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Instantiates != nil {
+		out.Instantiates = make([]string, len(r.Instantiates))
+		copy(out.Instantiates, r.Instantiates)
+	}
+	if r.InstantiatesElement != nil {
+		out.InstantiatesElement = make([]*Element, len(r.InstantiatesElement))
+		for i, v := range r.InstantiatesElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesElement[i] = &c
+			}
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		out.StatusReason = make([]CodeableConcept, len(r.StatusReason))
+		for i := range r.StatusReason {
+			out.StatusReason[i] = r.StatusReason[i].DeepCopy()
+		}
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	if r.MedicationCodeableConcept != nil {
+		v := r.MedicationCodeableConcept.DeepCopy()
+		out.MedicationCodeableConcept = &v
+	}
+	if r.MedicationReference != nil {
+		v := r.MedicationReference.DeepCopy()
+		out.MedicationReference = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Context != nil {
+		v := r.Context.DeepCopy()
+		out.Context = &v
+	}
+	if r.SupportingInformation != nil {
+		out.SupportingInformation = make([]Reference, len(r.SupportingInformation))
+		for i := range r.SupportingInformation {
+			out.SupportingInformation[i] = r.SupportingInformation[i].DeepCopy()
+		}
+	}
+	if r.EffectiveDateTime != nil {
+		v := *r.EffectiveDateTime
+		out.EffectiveDateTime = &v
+	}
+	if r.EffectiveDateTimeElement != nil {
+		v := r.EffectiveDateTimeElement.DeepCopy()
+		out.EffectiveDateTimeElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]MedicationAdministrationPerformer, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Request != nil {
+		v := r.Request.DeepCopy()
+		out.Request = &v
+	}
+	if r.Device != nil {
+		out.Device = make([]Reference, len(r.Device))
+		for i := range r.Device {
+			out.Device[i] = r.Device[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.Dosage != nil {
+		v := r.Dosage.DeepCopy()
+		out.Dosage = &v
+	}
+	if r.EventHistory != nil {
+		out.EventHistory = make([]Reference, len(r.EventHistory))
+		for i := range r.EventHistory {
+			out.EventHistory[i] = r.EventHistory[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationAdministration) Equal(other MedicationAdministration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.Instantiates) != len(other.Instantiates) {
+		return false
+	}
+	for i := range r.Instantiates {
+		if r.Instantiates[i] != other.Instantiates[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesElement, other.InstantiatesElement, false) {
+		return false
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.StatusReason) != len(other.StatusReason) {
+		return false
+	}
+	for i := range r.StatusReason {
+		if !r.StatusReason[i].Equal(other.StatusReason[i]) {
+			return false
+		}
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.Equal(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.Equal(*other.MedicationReference) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.Equal(*other.Context) {
+		return false
+	}
+	if len(r.SupportingInformation) != len(other.SupportingInformation) {
+		return false
+	}
+	for i := range r.SupportingInformation {
+		if !r.SupportingInformation[i].Equal(other.SupportingInformation[i]) {
+			return false
+		}
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && *r.EffectiveDateTime != *other.EffectiveDateTime {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.Equal(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.Equal(*other.Request) {
+		return false
+	}
+	if len(r.Device) != len(other.Device) {
+		return false
+	}
+	for i := range r.Device {
+		if !r.Device[i].Equal(other.Device[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if (r.Dosage == nil) != (other.Dosage == nil) || r.Dosage != nil && !r.Dosage.Equal(*other.Dosage) {
+		return false
+	}
+	if len(r.EventHistory) != len(other.EventHistory) {
+		return false
+	}
+	for i := range r.EventHistory {
+		if !r.EventHistory[i].Equal(other.EventHistory[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationAdministration) EqualsDeep(other MedicationAdministration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Instantiates), len(other.Instantiates), func(i, j int) bool {
+		return equivalentString(r.Instantiates[i], other.Instantiates[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesElement, other.InstantiatesElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.StatusReason), len(other.StatusReason), func(i, j int) bool {
+		return r.StatusReason[i].EqualsDeep(other.StatusReason[j])
+	}) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.EqualsDeep(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.EqualsDeep(*other.MedicationReference) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.EqualsDeep(*other.Context) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInformation), len(other.SupportingInformation), func(i, j int) bool {
+		return r.SupportingInformation[i].EqualsDeep(other.SupportingInformation[j])
+	}) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.EqualsDeep(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.EqualsDeep(*other.Request) {
+		return false
+	}
+	if !equivalentList(len(r.Device), len(other.Device), func(i, j int) bool {
+		return r.Device[i].EqualsDeep(other.Device[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if (r.Dosage == nil) != (other.Dosage == nil) || r.Dosage != nil && !r.Dosage.EqualsDeep(*other.Dosage) {
+		return false
+	}
+	if !equivalentList(len(r.EventHistory), len(other.EventHistory), func(i, j int) bool {
+		return r.EventHistory[i].EqualsDeep(other.EventHistory[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationAdministration) EqualsShallow(other MedicationAdministration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.Instantiates), len(other.Instantiates), func(i, j int) bool {
+		return equivalentString(r.Instantiates[i], other.Instantiates[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationAdministration as FHIR XML
+func (r MedicationAdministration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicationAdministration",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Instantiates {
+		if err := encodeXMLPrimitive(e, "instantiates", v, elementAt(r.InstantiatesElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.StatusReason {
+		if err := encodeXMLElement(e, "statusReason", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationCodeableConcept", r.MedicationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationReference", r.MedicationReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "context", r.Context); err != nil {
+		return err
+	}
+	for _, v := range r.SupportingInformation {
+		if err := encodeXMLElement(e, "supportingInformation", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "effectiveDateTime", r.EffectiveDateTime, r.EffectiveDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "request", r.Request); err != nil {
+		return err
+	}
+	for _, v := range r.Device {
+		if err := encodeXMLElement(e, "device", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "dosage", r.Dosage); err != nil {
+		return err
+	}
+	for _, v := range r.EventHistory {
+		if err := encodeXMLElement(e, "eventHistory", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationAdministration from FHIR XML
+func (r *MedicationAdministration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiates":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesElement = appendElement(r.InstantiatesElement, len(r.Instantiates), element)
+					r.Instantiates = append(r.Instantiates, v)
+				}
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = append(r.StatusReason, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "medicationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationCodeableConcept = &v
+			case "medicationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationReference = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "context":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Context = &v
+			case "supportingInformation":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			case "effectiveDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EffectiveDateTime = &v
+				}
+				r.EffectiveDateTimeElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "performer":
+				var v MedicationAdministrationPerformer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "request":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = &v
+			case "device":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Device = append(r.Device, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "dosage":
+				var v MedicationAdministrationDosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Dosage = &v
+			case "eventHistory":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EventHistory = append(r.EventHistory, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationAdministration as properties to the RDF node n
+func (r MedicationAdministration) turtle(n *turtleNode) {
+	n.resource("MedicationAdministration", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicationAdministration.identifier", i, v)
+	}
+	for i, v := range r.Instantiates {
+		n.primitive("MedicationAdministration.instantiates", i, v, elementAt(r.InstantiatesElement, i), "string")
+	}
+	for i, v := range r.PartOf {
+		n.element("MedicationAdministration.partOf", i, v)
+	}
+	n.primitive("MedicationAdministration.status", -1, r.Status, r.StatusElement, "string")
+	for i, v := range r.StatusReason {
+		n.element("MedicationAdministration.statusReason", i, v)
+	}
+	n.element("MedicationAdministration.category", -1, r.Category)
+	n.element("MedicationAdministration.medicationCodeableConcept", -1, r.MedicationCodeableConcept)
+	n.element("MedicationAdministration.medicationReference", -1, r.MedicationReference)
+	n.element("MedicationAdministration.subject", -1, r.Subject)
+	n.element("MedicationAdministration.context", -1, r.Context)
+	for i, v := range r.SupportingInformation {
+		n.element("MedicationAdministration.supportingInformation", i, v)
+	}
+	n.primitive("MedicationAdministration.effectiveDateTime", -1, r.EffectiveDateTime, r.EffectiveDateTimeElement, "dateTime")
+	n.element("MedicationAdministration.effectivePeriod", -1, r.EffectivePeriod)
+	for i, v := range r.Performer {
+		n.element("MedicationAdministration.performer", i, v)
+	}
+	for i, v := range r.ReasonCode {
+		n.element("MedicationAdministration.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("MedicationAdministration.reasonReference", i, v)
+	}
+	n.element("MedicationAdministration.request", -1, r.Request)
+	for i, v := range r.Device {
+		n.element("MedicationAdministration.device", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("MedicationAdministration.note", i, v)
+	}
+	n.element("MedicationAdministration.dosage", -1, r.Dosage)
+	for i, v := range r.EventHistory {
+		n.element("MedicationAdministration.eventHistory", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationAdministration as BSON document with the structure of its FHIR JSON
+func (r MedicationAdministration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationAdministration from a BSON document with the structure of its FHIR JSON
+func (r *MedicationAdministration) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationAdministration as protobuf message MedicationAdministration of fhir.proto
+func (r MedicationAdministration) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationAdministration to the encoded message
+func (r MedicationAdministration) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.Instantiates {
+		e.string(10, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(11, v)
+	}
+	if r.Status != "" {
+		e.string(12, r.Status)
+	}
+	for _, v := range r.StatusReason {
+		e.message(13, v)
+	}
+	if r.Category != nil {
+		e.message(14, *r.Category)
+	}
+	if r.MedicationCodeableConcept != nil {
+		e.message(15, *r.MedicationCodeableConcept)
+	}
+	if r.MedicationReference != nil {
+		e.message(16, *r.MedicationReference)
+	}
+	e.message(17, r.Subject)
+	if r.Context != nil {
+		e.message(18, *r.Context)
+	}
+	for _, v := range r.SupportingInformation {
+		e.message(19, v)
+	}
+	if r.EffectiveDateTime != nil {
+		e.string(20, *r.EffectiveDateTime)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(21, *r.EffectivePeriod)
+	}
+	for _, v := range r.Performer {
+		e.message(22, v)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(23, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(24, v)
+	}
+	if r.Request != nil {
+		e.message(25, *r.Request)
+	}
+	for _, v := range r.Device {
+		e.message(26, v)
+	}
+	for _, v := range r.Note {
+		e.message(27, v)
+	}
+	if r.Dosage != nil {
+		e.message(28, *r.Dosage)
+	}
+	for _, v := range r.EventHistory {
+		e.message(29, v)
+	}
+	if r.IdElement != nil {
+		e.message(30, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(31, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(32, *r.LanguageElement)
+	}
+	for _, v := range r.InstantiatesElement {
+		e.element(33, v)
+	}
+	if r.StatusElement != nil {
+		e.message(34, *r.StatusElement)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.message(35, *r.EffectiveDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationAdministration from protobuf message MedicationAdministration of fhir.proto
+func (r *MedicationAdministration) UnmarshalProto(b []byte) error {
+	*r = MedicationAdministration{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.Instantiates = append(r.Instantiates, d.string())
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 12:
+			r.Status = d.string()
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = append(r.StatusReason, v)
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.MedicationCodeableConcept = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.MedicationReference = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Context = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.SupportingInformation = append(r.SupportingInformation, v)
+		case 20:
+			v := d.string()
+			r.EffectiveDateTime = &v
+		case 21:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 22:
+			var v MedicationAdministrationPerformer
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Request = &v
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Device = append(r.Device, v)
+		case 27:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 28:
+			var v MedicationAdministrationDosage
+			d.message(&v)
+			r.Dosage = &v
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.EventHistory = append(r.EventHistory, v)
+		case 30:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 33:
+			r.InstantiatesElement = append(r.InstantiatesElement, d.element())
+		case 34:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.EffectiveDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationAdministrationPerformer as JSON into a byte slice
+func (r MedicationAdministrationPerformer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationAdministrationPerformer as JSON object with the members in the order of the fields
+func (r MedicationAdministrationPerformer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Function != nil {
+		e.key("function")
+		r.Function.appendJSON(e)
+	}
+	e.key("actor")
+	r.Actor.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationAdministrationPerformer from JSON. Unknown members are ignored.
+func (r *MedicationAdministrationPerformer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationAdministrationPerformer
+func (r *MedicationAdministrationPerformer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "function":
+			if d.null() {
+				r.Function = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Function = &v
+			}
+		case "actor":
+			r.Actor.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationAdministrationPerformer which shares no memory with the original
+func (r MedicationAdministrationPerformer) DeepCopy() MedicationAdministrationPerformer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Function != nil {
+		v := r.Function.DeepCopy()
+		out.Function = &v
+	}
+	out.Actor = r.Actor.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationAdministrationPerformer) Equal(other MedicationAdministrationPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.Equal(*other.Function) {
+		return false
+	}
+	if !r.Actor.Equal(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationAdministrationPerformer) EqualsDeep(other MedicationAdministrationPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.EqualsDeep(*other.Function) {
+		return false
+	}
+	if !r.Actor.EqualsDeep(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationAdministrationPerformer) EqualsShallow(other MedicationAdministrationPerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationAdministrationPerformer as FHIR XML
+func (r MedicationAdministrationPerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "function", r.Function); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "actor", r.Actor); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationAdministrationPerformer from FHIR XML
+func (r *MedicationAdministrationPerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "function":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Function = &v
+			case "actor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationAdministrationPerformer as properties to the RDF node n
+func (r MedicationAdministrationPerformer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationAdministration.performer.function", -1, r.Function)
+	n.element("MedicationAdministration.performer.actor", -1, r.Actor)
+}
+
+// MarshalBSON marshals the given MedicationAdministrationPerformer as BSON document with the structure of its FHIR JSON
+func (r MedicationAdministrationPerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationAdministrationPerformer from a BSON document with the structure of its FHIR JSON
+func (r *MedicationAdministrationPerformer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationAdministrationPerformer as protobuf message MedicationAdministrationPerformer of fhir.proto
+func (r MedicationAdministrationPerformer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationAdministrationPerformer to the encoded message
+func (r MedicationAdministrationPerformer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Function != nil {
+		e.message(4, *r.Function)
+	}
+	e.message(5, r.Actor)
+}
+
+// UnmarshalProto unmarshals the given MedicationAdministrationPerformer from protobuf message MedicationAdministrationPerformer of fhir.proto
+func (r *MedicationAdministrationPerformer) UnmarshalProto(b []byte) error {
+	*r = MedicationAdministrationPerformer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Function = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Actor = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationAdministrationDosage as JSON into a byte slice
+func (r MedicationAdministrationDosage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationAdministrationDosage as JSON object with the members in the order of the fields
+func (r MedicationAdministrationDosage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	if r.Site != nil {
+		e.key("site")
+		r.Site.appendJSON(e)
+	}
+	if r.Route != nil {
+		e.key("route")
+		r.Route.appendJSON(e)
+	}
+	if r.Method != nil {
+		e.key("method")
+		r.Method.appendJSON(e)
+	}
+	if r.Dose != nil {
+		e.key("dose")
+		r.Dose.appendJSON(e)
+	}
+	if r.RateRatio != nil {
+		e.key("rateRatio")
+		r.RateRatio.appendJSON(e)
+	}
+	if r.RateQuantity != nil {
+		e.key("rateQuantity")
+		r.RateQuantity.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationAdministrationDosage from JSON. Unknown members are ignored.
+func (r *MedicationAdministrationDosage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationAdministrationDosage
+func (r *MedicationAdministrationDosage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "site":
+			if d.null() {
+				r.Site = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Site = &v
+			}
+		case "route":
+			if d.null() {
+				r.Route = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Route = &v
+			}
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Method = &v
+			}
+		case "dose":
+			if d.null() {
+				r.Dose = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Dose = &v
+			}
+		case "rateRatio":
+			if d.null() {
+				r.RateRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.RateRatio = &v
+			}
+		case "rateQuantity":
+			if d.null() {
+				r.RateQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.RateQuantity = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationAdministrationDosage which shares no memory with the original
+func (r MedicationAdministrationDosage) DeepCopy() MedicationAdministrationDosage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.Site != nil {
+		v := r.Site.DeepCopy()
+		out.Site = &v
+	}
+	if r.Route != nil {
+		v := r.Route.DeepCopy()
+		out.Route = &v
+	}
+	if r.Method != nil {
+		v := r.Method.DeepCopy()
+		out.Method = &v
+	}
+	if r.Dose != nil {
+		v := r.Dose.DeepCopy()
+		out.Dose = &v
+	}
+	if r.RateRatio != nil {
+		v := r.RateRatio.DeepCopy()
+		out.RateRatio = &v
+	}
+	if r.RateQuantity != nil {
+		v := r.RateQuantity.DeepCopy()
+		out.RateQuantity = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationAdministrationDosage) Equal(other MedicationAdministrationDosage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if (r.Site == nil) != (other.Site == nil) || r.Site != nil && !r.Site.Equal(*other.Site) {
+		return false
+	}
+	if (r.Route == nil) != (other.Route == nil) || r.Route != nil && !r.Route.Equal(*other.Route) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.Equal(*other.Method) {
+		return false
+	}
+	if (r.Dose == nil) != (other.Dose == nil) || r.Dose != nil && !r.Dose.Equal(*other.Dose) {
+		return false
+	}
+	if (r.RateRatio == nil) != (other.RateRatio == nil) || r.RateRatio != nil && !r.RateRatio.Equal(*other.RateRatio) {
+		return false
+	}
+	if (r.RateQuantity == nil) != (other.RateQuantity == nil) || r.RateQuantity != nil && !r.RateQuantity.Equal(*other.RateQuantity) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationAdministrationDosage) EqualsDeep(other MedicationAdministrationDosage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if (r.Site == nil) != (other.Site == nil) || r.Site != nil && !r.Site.EqualsDeep(*other.Site) {
+		return false
+	}
+	if (r.Route == nil) != (other.Route == nil) || r.Route != nil && !r.Route.EqualsDeep(*other.Route) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.EqualsDeep(*other.Method) {
+		return false
+	}
+	if (r.Dose == nil) != (other.Dose == nil) || r.Dose != nil && !r.Dose.EqualsDeep(*other.Dose) {
+		return false
+	}
+	if (r.RateRatio == nil) != (other.RateRatio == nil) || r.RateRatio != nil && !r.RateRatio.EqualsDeep(*other.RateRatio) {
+		return false
+	}
+	if (r.RateQuantity == nil) != (other.RateQuantity == nil) || r.RateQuantity != nil && !r.RateQuantity.EqualsDeep(*other.RateQuantity) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationAdministrationDosage) EqualsShallow(other MedicationAdministrationDosage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationAdministrationDosage as FHIR XML
+func (r MedicationAdministrationDosage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "site", r.Site); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "route", r.Route); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "method", r.Method); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dose", r.Dose); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "rateRatio", r.RateRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "rateQuantity", r.RateQuantity); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationAdministrationDosage from FHIR XML
+func (r *MedicationAdministrationDosage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			case "site":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Site = &v
+			case "route":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Route = &v
+			case "method":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Method = &v
+			case "dose":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Dose = &v
+			case "rateRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RateRatio = &v
+			case "rateQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RateQuantity = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationAdministrationDosage as properties to the RDF node n
+func (r MedicationAdministrationDosage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MedicationAdministration.dosage.text", -1, r.Text, r.TextElement, "string")
+	n.element("MedicationAdministration.dosage.site", -1, r.Site)
+	n.element("MedicationAdministration.dosage.route", -1, r.Route)
+	n.element("MedicationAdministration.dosage.method", -1, r.Method)
+	n.element("MedicationAdministration.dosage.dose", -1, r.Dose)
+	n.element("MedicationAdministration.dosage.rateRatio", -1, r.RateRatio)
+	n.element("MedicationAdministration.dosage.rateQuantity", -1, r.RateQuantity)
+}
+
+// MarshalBSON marshals the given MedicationAdministrationDosage as BSON document with the structure of its FHIR JSON
+func (r MedicationAdministrationDosage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationAdministrationDosage from a BSON document with the structure of its FHIR JSON
+func (r *MedicationAdministrationDosage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationAdministrationDosage as protobuf message MedicationAdministrationDosage of fhir.proto
+func (r MedicationAdministrationDosage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationAdministrationDosage to the encoded message
+func (r MedicationAdministrationDosage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Text != nil {
+		e.string(4, *r.Text)
+	}
+	if r.Site != nil {
+		e.message(5, *r.Site)
+	}
+	if r.Route != nil {
+		e.message(6, *r.Route)
+	}
+	if r.Method != nil {
+		e.message(7, *r.Method)
+	}
+	if r.Dose != nil {
+		e.message(8, *r.Dose)
+	}
+	if r.RateRatio != nil {
+		e.message(9, *r.RateRatio)
+	}
+	if r.RateQuantity != nil {
+		e.message(10, *r.RateQuantity)
+	}
+	if r.TextElement != nil {
+		e.message(11, *r.TextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationAdministrationDosage from protobuf message MedicationAdministrationDosage of fhir.proto
+func (r *MedicationAdministrationDosage) UnmarshalProto(b []byte) error {
+	*r = MedicationAdministrationDosage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Text = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Site = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Route = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Method = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.Dose = &v
+		case 9:
+			var v Ratio
+			d.message(&v)
+			r.RateRatio = &v
+		case 10:
+			var v Quantity
+			d.message(&v)
+			r.RateQuantity = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicationAdministration unmarshals a MedicationAdministration.
 func UnmarshalMedicationAdministration(b []byte) (MedicationAdministration, error) {
 	var medicationAdministration MedicationAdministration
-	if err := json.Unmarshal(b, &medicationAdministration); err != nil {
+	if err := medicationAdministration.UnmarshalJSON(b); err != nil {
 		return medicationAdministration, err
 	}
 	return medicationAdministration, nil
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,20 +25,25 @@ import "encoding/json"
 // MedicationDispense is documented here http://hl7.org/fhir/StructureDefinition/MedicationDispense
 type MedicationDispense struct {
 	Id                          *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                   *Element                        `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                        *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules               *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement        *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                    *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement             *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                        *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                   []json.RawMessage               `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                   []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension           []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier                  []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	PartOf                      []Reference                     `bson:"partOf,omitempty" json:"partOf,omitempty"`
 	Status                      string                          `bson:"status" json:"status"`
+	StatusElement               *Element                        `bson:"_status,omitempty" json:"_status,omitempty"`
 	StatusReasonCodeableConcept *CodeableConcept                `bson:"statusReasonCodeableConcept,omitempty" json:"statusReasonCodeableConcept,omitempty"`
 	StatusReasonReference       *Reference                      `bson:"statusReasonReference,omitempty" json:"statusReasonReference,omitempty"`
 	Category                    *CodeableConcept                `bson:"category,omitempty" json:"category,omitempty"`
-	MedicationCodeableConcept   CodeableConcept                 `bson:"medicationCodeableConcept" json:"medicationCodeableConcept"`
-	MedicationReference         Reference                       `bson:"medicationReference" json:"medicationReference"`
+	MedicationCodeableConcept   *CodeableConcept                `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference         *Reference                      `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
 	Subject                     *Reference                      `bson:"subject,omitempty" json:"subject,omitempty"`
 	Context                     *Reference                      `bson:"context,omitempty" json:"context,omitempty"`
 	SupportingInformation       []Reference                     `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
@@ -46,7 +54,9 @@ type MedicationDispense struct {
 	Quantity                    *Quantity                       `bson:"quantity,omitempty" json:"quantity,omitempty"`
 	DaysSupply                  *Quantity                       `bson:"daysSupply,omitempty" json:"daysSupply,omitempty"`
 	WhenPrepared                *string                         `bson:"whenPrepared,omitempty" json:"whenPrepared,omitempty"`
+	WhenPreparedElement         *Element                        `bson:"_whenPrepared,omitempty" json:"_whenPrepared,omitempty"`
 	WhenHandedOver              *string                         `bson:"whenHandedOver,omitempty" json:"whenHandedOver,omitempty"`
+	WhenHandedOverElement       *Element                        `bson:"_whenHandedOver,omitempty" json:"_whenHandedOver,omitempty"`
 	Destination                 *Reference                      `bson:"destination,omitempty" json:"destination,omitempty"`
 	Receiver                    []Reference                     `bson:"receiver,omitempty" json:"receiver,omitempty"`
 	Note                        []Annotation                    `bson:"note,omitempty" json:"note,omitempty"`
@@ -55,6 +65,77 @@ type MedicationDispense struct {
 	DetectedIssue               []Reference                     `bson:"detectedIssue,omitempty" json:"detectedIssue,omitempty"`
 	EventHistory                []Reference                     `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
 }
+
+// MedicationDispenseStatusReason is implemented by the types allowed for MedicationDispense.statusReason[x]
+type MedicationDispenseStatusReason interface {
+	isMedicationDispenseStatusReason()
+}
+
+func (CodeableConcept) isMedicationDispenseStatusReason() {}
+func (Reference) isMedicationDispenseStatusReason()       {}
+
+// StatusReason returns the value of MedicationDispense.statusReason[x] together with its FHIR type code or nil if no value is set
+func (r MedicationDispense) StatusReason() (MedicationDispenseStatusReason, string) {
+	if r.StatusReasonCodeableConcept != nil {
+		return *r.StatusReasonCodeableConcept, "CodeableConcept"
+	}
+	if r.StatusReasonReference != nil {
+		return *r.StatusReasonReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetStatusReason sets the value of MedicationDispense.statusReason[x], given as value or pointer, and clears all other types
+func (r *MedicationDispense) SetStatusReason(value MedicationDispenseStatusReason) {
+	r.StatusReasonCodeableConcept = nil
+	r.StatusReasonReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.StatusReasonCodeableConcept = &v
+	case *CodeableConcept:
+		r.StatusReasonCodeableConcept = v
+	case Reference:
+		r.StatusReasonReference = &v
+	case *Reference:
+		r.StatusReasonReference = v
+	}
+}
+
+// MedicationDispenseMedication is implemented by the types allowed for MedicationDispense.medication[x]
+type MedicationDispenseMedication interface {
+	isMedicationDispenseMedication()
+}
+
+func (CodeableConcept) isMedicationDispenseMedication() {}
+func (Reference) isMedicationDispenseMedication()       {}
+
+// Medication returns the value of MedicationDispense.medication[x] together with its FHIR type code or nil if no value is set
+func (r MedicationDispense) Medication() (MedicationDispenseMedication, string) {
+	if r.MedicationCodeableConcept != nil {
+		return *r.MedicationCodeableConcept, "CodeableConcept"
+	}
+	if r.MedicationReference != nil {
+		return *r.MedicationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetMedication sets the value of MedicationDispense.medication[x], given as value or pointer, and clears all other types
+func (r *MedicationDispense) SetMedication(value MedicationDispenseMedication) {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.MedicationCodeableConcept = &v
+	case *CodeableConcept:
+		r.MedicationCodeableConcept = v
+	case Reference:
+		r.MedicationReference = &v
+	case *Reference:
+		r.MedicationReference = v
+	}
+}
+
 type MedicationDispensePerformer struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -63,31 +144,2747 @@ type MedicationDispensePerformer struct {
 	Actor             Reference        `bson:"actor" json:"actor"`
 }
 type MedicationDispenseSubstitution struct {
-	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	WasSubstituted    bool              `bson:"wasSubstituted" json:"wasSubstituted"`
-	Type              *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
-	Reason            []CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
-	ResponsibleParty  []Reference       `bson:"responsibleParty,omitempty" json:"responsibleParty,omitempty"`
+	Id                    *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	WasSubstituted        bool              `bson:"wasSubstituted" json:"wasSubstituted"`
+	WasSubstitutedElement *Element          `bson:"_wasSubstituted,omitempty" json:"_wasSubstituted,omitempty"`
+	Type                  *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
+	Reason                []CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
+	ResponsibleParty      []Reference       `bson:"responsibleParty,omitempty" json:"responsibleParty,omitempty"`
 }
-type OtherMedicationDispense MedicationDispense
 
 // MarshalJSON marshals the given MedicationDispense as JSON into a byte slice
 func (r MedicationDispense) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicationDispense
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicationDispense: OtherMedicationDispense(r),
-		ResourceType:            "MedicationDispense",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationDispense as JSON object with the members in the order of the fields
+func (r MedicationDispense) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicationDispense\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status)
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusReasonCodeableConcept != nil {
+		e.key("statusReasonCodeableConcept")
+		r.StatusReasonCodeableConcept.appendJSON(e)
+	}
+	if r.StatusReasonReference != nil {
+		e.key("statusReasonReference")
+		r.StatusReasonReference.appendJSON(e)
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	if r.MedicationCodeableConcept != nil {
+		e.key("medicationCodeableConcept")
+		r.MedicationCodeableConcept.appendJSON(e)
+	}
+	if r.MedicationReference != nil {
+		e.key("medicationReference")
+		r.MedicationReference.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Context != nil {
+		e.key("context")
+		r.Context.appendJSON(e)
+	}
+	if len(r.SupportingInformation) > 0 {
+		e.key("supportingInformation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInformation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if len(r.AuthorizingPrescription) > 0 {
+		e.key("authorizingPrescription")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AuthorizingPrescription {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.DaysSupply != nil {
+		e.key("daysSupply")
+		r.DaysSupply.appendJSON(e)
+	}
+	if r.WhenPrepared != nil {
+		e.key("whenPrepared")
+		e.string(*r.WhenPrepared)
+	}
+	if r.WhenPreparedElement != nil {
+		e.key("_whenPrepared")
+		r.WhenPreparedElement.appendJSON(e)
+	}
+	if r.WhenHandedOver != nil {
+		e.key("whenHandedOver")
+		e.string(*r.WhenHandedOver)
+	}
+	if r.WhenHandedOverElement != nil {
+		e.key("_whenHandedOver")
+		r.WhenHandedOverElement.appendJSON(e)
+	}
+	if r.Destination != nil {
+		e.key("destination")
+		r.Destination.appendJSON(e)
+	}
+	if len(r.Receiver) > 0 {
+		e.key("receiver")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Receiver {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DosageInstruction) > 0 {
+		e.key("dosageInstruction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DosageInstruction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Substitution != nil {
+		e.key("substitution")
+		r.Substitution.appendJSON(e)
+	}
+	if len(r.DetectedIssue) > 0 {
+		e.key("detectedIssue")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DetectedIssue {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.EventHistory) > 0 {
+		e.key("eventHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.EventHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationDispense from JSON. Unknown members are ignored.
+func (r *MedicationDispense) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationDispense
+func (r *MedicationDispense) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			if v, ok := d.string(); ok {
+				r.Status = v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReasonCodeableConcept":
+			if d.null() {
+				r.StatusReasonCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReasonCodeableConcept = &v
+			}
+		case "statusReasonReference":
+			if d.null() {
+				r.StatusReasonReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.StatusReasonReference = &v
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "medicationCodeableConcept":
+			if d.null() {
+				r.MedicationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.MedicationCodeableConcept = &v
+			}
+		case "medicationReference":
+			if d.null() {
+				r.MedicationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.MedicationReference = &v
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "context":
+			if d.null() {
+				r.Context = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Context = &v
+			}
+		case "supportingInformation":
+			a := d.array()
+			r.SupportingInformation = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			}
+			if r.SupportingInformation == nil && !a.isNull {
+				r.SupportingInformation = []Reference{}
+			}
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v MedicationDispensePerformer
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []MedicationDispensePerformer{}
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "authorizingPrescription":
+			a := d.array()
+			r.AuthorizingPrescription = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.AuthorizingPrescription = append(r.AuthorizingPrescription, v)
+			}
+			if r.AuthorizingPrescription == nil && !a.isNull {
+				r.AuthorizingPrescription = []Reference{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "daysSupply":
+			if d.null() {
+				r.DaysSupply = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.DaysSupply = &v
+			}
+		case "whenPrepared":
+			if v, ok := d.string(); ok {
+				r.WhenPrepared = &v
+			} else {
+				r.WhenPrepared = nil
+			}
+		case "_whenPrepared":
+			r.WhenPreparedElement = d.element()
+		case "whenHandedOver":
+			if v, ok := d.string(); ok {
+				r.WhenHandedOver = &v
+			} else {
+				r.WhenHandedOver = nil
+			}
+		case "_whenHandedOver":
+			r.WhenHandedOverElement = d.element()
+		case "destination":
+			if d.null() {
+				r.Destination = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Destination = &v
+			}
+		case "receiver":
+			a := d.array()
+			r.Receiver = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Receiver = append(r.Receiver, v)
+			}
+			if r.Receiver == nil && !a.isNull {
+				r.Receiver = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "dosageInstruction":
+			a := d.array()
+			r.DosageInstruction = nil
+			for a.next() {
+				var v Dosage
+				v.decodeJSON(d)
+				r.DosageInstruction = append(r.DosageInstruction, v)
+			}
+			if r.DosageInstruction == nil && !a.isNull {
+				r.DosageInstruction = []Dosage{}
+			}
+		case "substitution":
+			if d.null() {
+				r.Substitution = nil
+			} else {
+				var v MedicationDispenseSubstitution
+				v.decodeJSON(d)
+				r.Substitution = &v
+			}
+		case "detectedIssue":
+			a := d.array()
+			r.DetectedIssue = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.DetectedIssue = append(r.DetectedIssue, v)
+			}
+			if r.DetectedIssue == nil && !a.isNull {
+				r.DetectedIssue = []Reference{}
+			}
+		case "eventHistory":
+			a := d.array()
+			r.EventHistory = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.EventHistory = append(r.EventHistory, v)
+			}
+			if r.EventHistory == nil && !a.isNull {
+				r.EventHistory = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationDispense which shares no memory with the original
+func (r MedicationDispense) DeepCopy() MedicationDispense {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReasonCodeableConcept != nil {
+		v := r.StatusReasonCodeableConcept.DeepCopy()
+		out.StatusReasonCodeableConcept = &v
+	}
+	if r.StatusReasonReference != nil {
+		v := r.StatusReasonReference.DeepCopy()
+		out.StatusReasonReference = &v
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	if r.MedicationCodeableConcept != nil {
+		v := r.MedicationCodeableConcept.DeepCopy()
+		out.MedicationCodeableConcept = &v
+	}
+	if r.MedicationReference != nil {
+		v := r.MedicationReference.DeepCopy()
+		out.MedicationReference = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Context != nil {
+		v := r.Context.DeepCopy()
+		out.Context = &v
+	}
+	if r.SupportingInformation != nil {
+		out.SupportingInformation = make([]Reference, len(r.SupportingInformation))
+		for i := range r.SupportingInformation {
+			out.SupportingInformation[i] = r.SupportingInformation[i].DeepCopy()
+		}
+	}
+	if r.Performer != nil {
+		out.Performer = make([]MedicationDispensePerformer, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.AuthorizingPrescription != nil {
+		out.AuthorizingPrescription = make([]Reference, len(r.AuthorizingPrescription))
+		for i := range r.AuthorizingPrescription {
+			out.AuthorizingPrescription[i] = r.AuthorizingPrescription[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.DaysSupply != nil {
+		v := r.DaysSupply.DeepCopy()
+		out.DaysSupply = &v
+	}
+	if r.WhenPrepared != nil {
+		v := *r.WhenPrepared
+		out.WhenPrepared = &v
+	}
+	if r.WhenPreparedElement != nil {
+		v := r.WhenPreparedElement.DeepCopy()
+		out.WhenPreparedElement = &v
+	}
+	if r.WhenHandedOver != nil {
+		v := *r.WhenHandedOver
+		out.WhenHandedOver = &v
+	}
+	if r.WhenHandedOverElement != nil {
+		v := r.WhenHandedOverElement.DeepCopy()
+		out.WhenHandedOverElement = &v
+	}
+	if r.Destination != nil {
+		v := r.Destination.DeepCopy()
+		out.Destination = &v
+	}
+	if r.Receiver != nil {
+		out.Receiver = make([]Reference, len(r.Receiver))
+		for i := range r.Receiver {
+			out.Receiver[i] = r.Receiver[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.DosageInstruction != nil {
+		out.DosageInstruction = make([]Dosage, len(r.DosageInstruction))
+		for i := range r.DosageInstruction {
+			out.DosageInstruction[i] = r.DosageInstruction[i].DeepCopy()
+		}
+	}
+	if r.Substitution != nil {
+		v := r.Substitution.DeepCopy()
+		out.Substitution = &v
+	}
+	if r.DetectedIssue != nil {
+		out.DetectedIssue = make([]Reference, len(r.DetectedIssue))
+		for i := range r.DetectedIssue {
+			out.DetectedIssue[i] = r.DetectedIssue[i].DeepCopy()
+		}
+	}
+	if r.EventHistory != nil {
+		out.EventHistory = make([]Reference, len(r.EventHistory))
+		for i := range r.EventHistory {
+			out.EventHistory[i] = r.EventHistory[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationDispense) Equal(other MedicationDispense) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReasonCodeableConcept == nil) != (other.StatusReasonCodeableConcept == nil) || r.StatusReasonCodeableConcept != nil && !r.StatusReasonCodeableConcept.Equal(*other.StatusReasonCodeableConcept) {
+		return false
+	}
+	if (r.StatusReasonReference == nil) != (other.StatusReasonReference == nil) || r.StatusReasonReference != nil && !r.StatusReasonReference.Equal(*other.StatusReasonReference) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.Equal(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.Equal(*other.MedicationReference) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.Equal(*other.Context) {
+		return false
+	}
+	if len(r.SupportingInformation) != len(other.SupportingInformation) {
+		return false
+	}
+	for i := range r.SupportingInformation {
+		if !r.SupportingInformation[i].Equal(other.SupportingInformation[i]) {
+			return false
+		}
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if len(r.AuthorizingPrescription) != len(other.AuthorizingPrescription) {
+		return false
+	}
+	for i := range r.AuthorizingPrescription {
+		if !r.AuthorizingPrescription[i].Equal(other.AuthorizingPrescription[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.DaysSupply == nil) != (other.DaysSupply == nil) || r.DaysSupply != nil && !r.DaysSupply.Equal(*other.DaysSupply) {
+		return false
+	}
+	if (r.WhenPrepared == nil) != (other.WhenPrepared == nil) || r.WhenPrepared != nil && *r.WhenPrepared != *other.WhenPrepared {
+		return false
+	}
+	if (r.WhenPreparedElement == nil) != (other.WhenPreparedElement == nil) || r.WhenPreparedElement != nil && !r.WhenPreparedElement.Equal(*other.WhenPreparedElement) {
+		return false
+	}
+	if (r.WhenHandedOver == nil) != (other.WhenHandedOver == nil) || r.WhenHandedOver != nil && *r.WhenHandedOver != *other.WhenHandedOver {
+		return false
+	}
+	if (r.WhenHandedOverElement == nil) != (other.WhenHandedOverElement == nil) || r.WhenHandedOverElement != nil && !r.WhenHandedOverElement.Equal(*other.WhenHandedOverElement) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && !r.Destination.Equal(*other.Destination) {
+		return false
+	}
+	if len(r.Receiver) != len(other.Receiver) {
+		return false
+	}
+	for i := range r.Receiver {
+		if !r.Receiver[i].Equal(other.Receiver[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.DosageInstruction) != len(other.DosageInstruction) {
+		return false
+	}
+	for i := range r.DosageInstruction {
+		if !r.DosageInstruction[i].Equal(other.DosageInstruction[i]) {
+			return false
+		}
+	}
+	if (r.Substitution == nil) != (other.Substitution == nil) || r.Substitution != nil && !r.Substitution.Equal(*other.Substitution) {
+		return false
+	}
+	if len(r.DetectedIssue) != len(other.DetectedIssue) {
+		return false
+	}
+	for i := range r.DetectedIssue {
+		if !r.DetectedIssue[i].Equal(other.DetectedIssue[i]) {
+			return false
+		}
+	}
+	if len(r.EventHistory) != len(other.EventHistory) {
+		return false
+	}
+	for i := range r.EventHistory {
+		if !r.EventHistory[i].Equal(other.EventHistory[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationDispense) EqualsDeep(other MedicationDispense) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReasonCodeableConcept == nil) != (other.StatusReasonCodeableConcept == nil) || r.StatusReasonCodeableConcept != nil && !r.StatusReasonCodeableConcept.EqualsDeep(*other.StatusReasonCodeableConcept) {
+		return false
+	}
+	if (r.StatusReasonReference == nil) != (other.StatusReasonReference == nil) || r.StatusReasonReference != nil && !r.StatusReasonReference.EqualsDeep(*other.StatusReasonReference) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.EqualsDeep(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.EqualsDeep(*other.MedicationReference) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.EqualsDeep(*other.Context) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInformation), len(other.SupportingInformation), func(i, j int) bool {
+		return r.SupportingInformation[i].EqualsDeep(other.SupportingInformation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if !equivalentList(len(r.AuthorizingPrescription), len(other.AuthorizingPrescription), func(i, j int) bool {
+		return r.AuthorizingPrescription[i].EqualsDeep(other.AuthorizingPrescription[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.DaysSupply == nil) != (other.DaysSupply == nil) || r.DaysSupply != nil && !r.DaysSupply.EqualsDeep(*other.DaysSupply) {
+		return false
+	}
+	if (r.WhenPrepared == nil) != (other.WhenPrepared == nil) || r.WhenPrepared != nil && !equivalentString(*r.WhenPrepared, *other.WhenPrepared) {
+		return false
+	}
+	if (r.WhenPreparedElement == nil) != (other.WhenPreparedElement == nil) || r.WhenPreparedElement != nil && !r.WhenPreparedElement.EqualsDeep(*other.WhenPreparedElement) {
+		return false
+	}
+	if (r.WhenHandedOver == nil) != (other.WhenHandedOver == nil) || r.WhenHandedOver != nil && !equivalentString(*r.WhenHandedOver, *other.WhenHandedOver) {
+		return false
+	}
+	if (r.WhenHandedOverElement == nil) != (other.WhenHandedOverElement == nil) || r.WhenHandedOverElement != nil && !r.WhenHandedOverElement.EqualsDeep(*other.WhenHandedOverElement) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && !r.Destination.EqualsDeep(*other.Destination) {
+		return false
+	}
+	if !equivalentList(len(r.Receiver), len(other.Receiver), func(i, j int) bool {
+		return r.Receiver[i].EqualsDeep(other.Receiver[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DosageInstruction), len(other.DosageInstruction), func(i, j int) bool {
+		return r.DosageInstruction[i].EqualsDeep(other.DosageInstruction[j])
+	}) {
+		return false
+	}
+	if (r.Substitution == nil) != (other.Substitution == nil) || r.Substitution != nil && !r.Substitution.EqualsDeep(*other.Substitution) {
+		return false
+	}
+	if !equivalentList(len(r.DetectedIssue), len(other.DetectedIssue), func(i, j int) bool {
+		return r.DetectedIssue[i].EqualsDeep(other.DetectedIssue[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.EventHistory), len(other.EventHistory), func(i, j int) bool {
+		return r.EventHistory[i].EqualsDeep(other.EventHistory[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationDispense) EqualsShallow(other MedicationDispense) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.WhenPrepared == nil) != (other.WhenPrepared == nil) || r.WhenPrepared != nil && !equivalentString(*r.WhenPrepared, *other.WhenPrepared) {
+		return false
+	}
+	if (r.WhenHandedOver == nil) != (other.WhenHandedOver == nil) || r.WhenHandedOver != nil && !equivalentString(*r.WhenHandedOver, *other.WhenHandedOver) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationDispense as FHIR XML
+func (r MedicationDispense) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicationDispense",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReasonCodeableConcept", r.StatusReasonCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReasonReference", r.StatusReasonReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationCodeableConcept", r.MedicationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationReference", r.MedicationReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "context", r.Context); err != nil {
+		return err
+	}
+	for _, v := range r.SupportingInformation {
+		if err := encodeXMLElement(e, "supportingInformation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	for _, v := range r.AuthorizingPrescription {
+		if err := encodeXMLElement(e, "authorizingPrescription", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "daysSupply", r.DaysSupply); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "whenPrepared", r.WhenPrepared, r.WhenPreparedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "whenHandedOver", r.WhenHandedOver, r.WhenHandedOverElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "destination", r.Destination); err != nil {
+		return err
+	}
+	for _, v := range r.Receiver {
+		if err := encodeXMLElement(e, "receiver", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.DosageInstruction {
+		if err := encodeXMLElement(e, "dosageInstruction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "substitution", r.Substitution); err != nil {
+		return err
+	}
+	for _, v := range r.DetectedIssue {
+		if err := encodeXMLElement(e, "detectedIssue", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.EventHistory {
+		if err := encodeXMLElement(e, "eventHistory", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationDispense from FHIR XML
+func (r *MedicationDispense) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReasonCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReasonCodeableConcept = &v
+			case "statusReasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReasonReference = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "medicationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationCodeableConcept = &v
+			case "medicationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationReference = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "context":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Context = &v
+			case "supportingInformation":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			case "performer":
+				var v MedicationDispensePerformer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "authorizingPrescription":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AuthorizingPrescription = append(r.AuthorizingPrescription, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "daysSupply":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DaysSupply = &v
+			case "whenPrepared":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.WhenPrepared = &v
+				}
+				r.WhenPreparedElement = element
+			case "whenHandedOver":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.WhenHandedOver = &v
+				}
+				r.WhenHandedOverElement = element
+			case "destination":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Destination = &v
+			case "receiver":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Receiver = append(r.Receiver, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "dosageInstruction":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DosageInstruction = append(r.DosageInstruction, v)
+			case "substitution":
+				var v MedicationDispenseSubstitution
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Substitution = &v
+			case "detectedIssue":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DetectedIssue = append(r.DetectedIssue, v)
+			case "eventHistory":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EventHistory = append(r.EventHistory, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationDispense as properties to the RDF node n
+func (r MedicationDispense) turtle(n *turtleNode) {
+	n.resource("MedicationDispense", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicationDispense.identifier", i, v)
+	}
+	for i, v := range r.PartOf {
+		n.element("MedicationDispense.partOf", i, v)
+	}
+	n.primitive("MedicationDispense.status", -1, r.Status, r.StatusElement, "string")
+	n.element("MedicationDispense.statusReasonCodeableConcept", -1, r.StatusReasonCodeableConcept)
+	n.element("MedicationDispense.statusReasonReference", -1, r.StatusReasonReference)
+	n.element("MedicationDispense.category", -1, r.Category)
+	n.element("MedicationDispense.medicationCodeableConcept", -1, r.MedicationCodeableConcept)
+	n.element("MedicationDispense.medicationReference", -1, r.MedicationReference)
+	n.element("MedicationDispense.subject", -1, r.Subject)
+	n.element("MedicationDispense.context", -1, r.Context)
+	for i, v := range r.SupportingInformation {
+		n.element("MedicationDispense.supportingInformation", i, v)
+	}
+	for i, v := range r.Performer {
+		n.element("MedicationDispense.performer", i, v)
+	}
+	n.element("MedicationDispense.location", -1, r.Location)
+	for i, v := range r.AuthorizingPrescription {
+		n.element("MedicationDispense.authorizingPrescription", i, v)
+	}
+	n.element("MedicationDispense.type", -1, r.Type)
+	n.element("MedicationDispense.quantity", -1, r.Quantity)
+	n.element("MedicationDispense.daysSupply", -1, r.DaysSupply)
+	n.primitive("MedicationDispense.whenPrepared", -1, r.WhenPrepared, r.WhenPreparedElement, "dateTime")
+	n.primitive("MedicationDispense.whenHandedOver", -1, r.WhenHandedOver, r.WhenHandedOverElement, "dateTime")
+	n.element("MedicationDispense.destination", -1, r.Destination)
+	for i, v := range r.Receiver {
+		n.element("MedicationDispense.receiver", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("MedicationDispense.note", i, v)
+	}
+	for i, v := range r.DosageInstruction {
+		n.element("MedicationDispense.dosageInstruction", i, v)
+	}
+	n.element("MedicationDispense.substitution", -1, r.Substitution)
+	for i, v := range r.DetectedIssue {
+		n.element("MedicationDispense.detectedIssue", i, v)
+	}
+	for i, v := range r.EventHistory {
+		n.element("MedicationDispense.eventHistory", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationDispense as BSON document with the structure of its FHIR JSON
+func (r MedicationDispense) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationDispense from a BSON document with the structure of its FHIR JSON
+func (r *MedicationDispense) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationDispense as protobuf message MedicationDispense of fhir.proto
+func (r MedicationDispense) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationDispense to the encoded message
+func (r MedicationDispense) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(10, v)
+	}
+	if r.Status != "" {
+		e.string(11, r.Status)
+	}
+	if r.StatusReasonCodeableConcept != nil {
+		e.message(12, *r.StatusReasonCodeableConcept)
+	}
+	if r.StatusReasonReference != nil {
+		e.message(13, *r.StatusReasonReference)
+	}
+	if r.Category != nil {
+		e.message(14, *r.Category)
+	}
+	if r.MedicationCodeableConcept != nil {
+		e.message(15, *r.MedicationCodeableConcept)
+	}
+	if r.MedicationReference != nil {
+		e.message(16, *r.MedicationReference)
+	}
+	if r.Subject != nil {
+		e.message(17, *r.Subject)
+	}
+	if r.Context != nil {
+		e.message(18, *r.Context)
+	}
+	for _, v := range r.SupportingInformation {
+		e.message(19, v)
+	}
+	for _, v := range r.Performer {
+		e.message(20, v)
+	}
+	if r.Location != nil {
+		e.message(21, *r.Location)
+	}
+	for _, v := range r.AuthorizingPrescription {
+		e.message(22, v)
+	}
+	if r.Type != nil {
+		e.message(23, *r.Type)
+	}
+	if r.Quantity != nil {
+		e.message(24, *r.Quantity)
+	}
+	if r.DaysSupply != nil {
+		e.message(25, *r.DaysSupply)
+	}
+	if r.WhenPrepared != nil {
+		e.string(26, *r.WhenPrepared)
+	}
+	if r.WhenHandedOver != nil {
+		e.string(27, *r.WhenHandedOver)
+	}
+	if r.Destination != nil {
+		e.message(28, *r.Destination)
+	}
+	for _, v := range r.Receiver {
+		e.message(29, v)
+	}
+	for _, v := range r.Note {
+		e.message(30, v)
+	}
+	for _, v := range r.DosageInstruction {
+		e.message(31, v)
+	}
+	if r.Substitution != nil {
+		e.message(32, *r.Substitution)
+	}
+	for _, v := range r.DetectedIssue {
+		e.message(33, v)
+	}
+	for _, v := range r.EventHistory {
+		e.message(34, v)
+	}
+	if r.IdElement != nil {
+		e.message(35, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(36, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(37, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(38, *r.StatusElement)
+	}
+	if r.WhenPreparedElement != nil {
+		e.message(39, *r.WhenPreparedElement)
+	}
+	if r.WhenHandedOverElement != nil {
+		e.message(40, *r.WhenHandedOverElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationDispense from protobuf message MedicationDispense of fhir.proto
+func (r *MedicationDispense) UnmarshalProto(b []byte) error {
+	*r = MedicationDispense{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 11:
+			r.Status = d.string()
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReasonCodeableConcept = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.StatusReasonReference = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.MedicationCodeableConcept = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.MedicationReference = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Context = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.SupportingInformation = append(r.SupportingInformation, v)
+		case 20:
+			var v MedicationDispensePerformer
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.AuthorizingPrescription = append(r.AuthorizingPrescription, v)
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 24:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 25:
+			var v Quantity
+			d.message(&v)
+			r.DaysSupply = &v
+		case 26:
+			v := d.string()
+			r.WhenPrepared = &v
+		case 27:
+			v := d.string()
+			r.WhenHandedOver = &v
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Destination = &v
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.Receiver = append(r.Receiver, v)
+		case 30:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 31:
+			var v Dosage
+			d.message(&v)
+			r.DosageInstruction = append(r.DosageInstruction, v)
+		case 32:
+			var v MedicationDispenseSubstitution
+			d.message(&v)
+			r.Substitution = &v
+		case 33:
+			var v Reference
+			d.message(&v)
+			r.DetectedIssue = append(r.DetectedIssue, v)
+		case 34:
+			var v Reference
+			d.message(&v)
+			r.EventHistory = append(r.EventHistory, v)
+		case 35:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.WhenPreparedElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.WhenHandedOverElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationDispensePerformer as JSON into a byte slice
+func (r MedicationDispensePerformer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationDispensePerformer as JSON object with the members in the order of the fields
+func (r MedicationDispensePerformer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Function != nil {
+		e.key("function")
+		r.Function.appendJSON(e)
+	}
+	e.key("actor")
+	r.Actor.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationDispensePerformer from JSON. Unknown members are ignored.
+func (r *MedicationDispensePerformer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationDispensePerformer
+func (r *MedicationDispensePerformer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "function":
+			if d.null() {
+				r.Function = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Function = &v
+			}
+		case "actor":
+			r.Actor.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationDispensePerformer which shares no memory with the original
+func (r MedicationDispensePerformer) DeepCopy() MedicationDispensePerformer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Function != nil {
+		v := r.Function.DeepCopy()
+		out.Function = &v
+	}
+	out.Actor = r.Actor.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationDispensePerformer) Equal(other MedicationDispensePerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.Equal(*other.Function) {
+		return false
+	}
+	if !r.Actor.Equal(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationDispensePerformer) EqualsDeep(other MedicationDispensePerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.EqualsDeep(*other.Function) {
+		return false
+	}
+	if !r.Actor.EqualsDeep(other.Actor) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationDispensePerformer) EqualsShallow(other MedicationDispensePerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationDispensePerformer as FHIR XML
+func (r MedicationDispensePerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "function", r.Function); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "actor", r.Actor); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationDispensePerformer from FHIR XML
+func (r *MedicationDispensePerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "function":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Function = &v
+			case "actor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationDispensePerformer as properties to the RDF node n
+func (r MedicationDispensePerformer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationDispense.performer.function", -1, r.Function)
+	n.element("MedicationDispense.performer.actor", -1, r.Actor)
+}
+
+// MarshalBSON marshals the given MedicationDispensePerformer as BSON document with the structure of its FHIR JSON
+func (r MedicationDispensePerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationDispensePerformer from a BSON document with the structure of its FHIR JSON
+func (r *MedicationDispensePerformer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationDispensePerformer as protobuf message MedicationDispensePerformer of fhir.proto
+func (r MedicationDispensePerformer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationDispensePerformer to the encoded message
+func (r MedicationDispensePerformer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Function != nil {
+		e.message(4, *r.Function)
+	}
+	e.message(5, r.Actor)
+}
+
+// UnmarshalProto unmarshals the given MedicationDispensePerformer from protobuf message MedicationDispensePerformer of fhir.proto
+func (r *MedicationDispensePerformer) UnmarshalProto(b []byte) error {
+	*r = MedicationDispensePerformer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Function = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Actor = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationDispenseSubstitution as JSON into a byte slice
+func (r MedicationDispenseSubstitution) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationDispenseSubstitution as JSON object with the members in the order of the fields
+func (r MedicationDispenseSubstitution) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("wasSubstituted")
+	e.bool(r.WasSubstituted)
+	if r.WasSubstitutedElement != nil {
+		e.key("_wasSubstituted")
+		r.WasSubstitutedElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.Reason) > 0 {
+		e.key("reason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ResponsibleParty) > 0 {
+		e.key("responsibleParty")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ResponsibleParty {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationDispenseSubstitution from JSON. Unknown members are ignored.
+func (r *MedicationDispenseSubstitution) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationDispenseSubstitution
+func (r *MedicationDispenseSubstitution) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "wasSubstituted":
+			if v, ok := d.bool(); ok {
+				r.WasSubstituted = v
+			}
+		case "_wasSubstituted":
+			r.WasSubstitutedElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "reason":
+			a := d.array()
+			r.Reason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Reason = append(r.Reason, v)
+			}
+			if r.Reason == nil && !a.isNull {
+				r.Reason = []CodeableConcept{}
+			}
+		case "responsibleParty":
+			a := d.array()
+			r.ResponsibleParty = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ResponsibleParty = append(r.ResponsibleParty, v)
+			}
+			if r.ResponsibleParty == nil && !a.isNull {
+				r.ResponsibleParty = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationDispenseSubstitution which shares no memory with the original
+func (r MedicationDispenseSubstitution) DeepCopy() MedicationDispenseSubstitution {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.WasSubstitutedElement != nil {
+		v := r.WasSubstitutedElement.DeepCopy()
+		out.WasSubstitutedElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Reason != nil {
+		out.Reason = make([]CodeableConcept, len(r.Reason))
+		for i := range r.Reason {
+			out.Reason[i] = r.Reason[i].DeepCopy()
+		}
+	}
+	if r.ResponsibleParty != nil {
+		out.ResponsibleParty = make([]Reference, len(r.ResponsibleParty))
+		for i := range r.ResponsibleParty {
+			out.ResponsibleParty[i] = r.ResponsibleParty[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationDispenseSubstitution) Equal(other MedicationDispenseSubstitution) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.WasSubstituted != other.WasSubstituted {
+		return false
+	}
+	if (r.WasSubstitutedElement == nil) != (other.WasSubstitutedElement == nil) || r.WasSubstitutedElement != nil && !r.WasSubstitutedElement.Equal(*other.WasSubstitutedElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.Reason) != len(other.Reason) {
+		return false
+	}
+	for i := range r.Reason {
+		if !r.Reason[i].Equal(other.Reason[i]) {
+			return false
+		}
+	}
+	if len(r.ResponsibleParty) != len(other.ResponsibleParty) {
+		return false
+	}
+	for i := range r.ResponsibleParty {
+		if !r.ResponsibleParty[i].Equal(other.ResponsibleParty[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationDispenseSubstitution) EqualsDeep(other MedicationDispenseSubstitution) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.WasSubstituted != other.WasSubstituted {
+		return false
+	}
+	if (r.WasSubstitutedElement == nil) != (other.WasSubstitutedElement == nil) || r.WasSubstitutedElement != nil && !r.WasSubstitutedElement.EqualsDeep(*other.WasSubstitutedElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Reason), len(other.Reason), func(i, j int) bool {
+		return r.Reason[i].EqualsDeep(other.Reason[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ResponsibleParty), len(other.ResponsibleParty), func(i, j int) bool {
+		return r.ResponsibleParty[i].EqualsDeep(other.ResponsibleParty[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationDispenseSubstitution) EqualsShallow(other MedicationDispenseSubstitution) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.WasSubstituted != other.WasSubstituted {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationDispenseSubstitution as FHIR XML
+func (r MedicationDispenseSubstitution) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "wasSubstituted", r.WasSubstituted, r.WasSubstitutedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Reason {
+		if err := encodeXMLElement(e, "reason", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ResponsibleParty {
+		if err := encodeXMLElement(e, "responsibleParty", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationDispenseSubstitution from FHIR XML
+func (r *MedicationDispenseSubstitution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "wasSubstituted":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.WasSubstituted = v
+				}
+				r.WasSubstitutedElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "reason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = append(r.Reason, v)
+			case "responsibleParty":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ResponsibleParty = append(r.ResponsibleParty, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationDispenseSubstitution as properties to the RDF node n
+func (r MedicationDispenseSubstitution) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MedicationDispense.substitution.wasSubstituted", -1, r.WasSubstituted, r.WasSubstitutedElement, "boolean")
+	n.element("MedicationDispense.substitution.type", -1, r.Type)
+	for i, v := range r.Reason {
+		n.element("MedicationDispense.substitution.reason", i, v)
+	}
+	for i, v := range r.ResponsibleParty {
+		n.element("MedicationDispense.substitution.responsibleParty", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationDispenseSubstitution as BSON document with the structure of its FHIR JSON
+func (r MedicationDispenseSubstitution) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationDispenseSubstitution from a BSON document with the structure of its FHIR JSON
+func (r *MedicationDispenseSubstitution) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationDispenseSubstitution as protobuf message MedicationDispenseSubstitution of fhir.proto
+func (r MedicationDispenseSubstitution) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationDispenseSubstitution to the encoded message
+func (r MedicationDispenseSubstitution) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.WasSubstituted {
+		e.varint(4, protoBool(r.WasSubstituted))
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	for _, v := range r.Reason {
+		e.message(6, v)
+	}
+	for _, v := range r.ResponsibleParty {
+		e.message(7, v)
+	}
+	if r.WasSubstitutedElement != nil {
+		e.message(8, *r.WasSubstitutedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationDispenseSubstitution from protobuf message MedicationDispenseSubstitution of fhir.proto
+func (r *MedicationDispenseSubstitution) UnmarshalProto(b []byte) error {
+	*r = MedicationDispenseSubstitution{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.WasSubstituted = d.bool()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Reason = append(r.Reason, v)
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.ResponsibleParty = append(r.ResponsibleParty, v)
+		case 8:
+			var v Element
+			d.message(&v)
+			r.WasSubstitutedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicationDispense unmarshals a MedicationDispense.
 func UnmarshalMedicationDispense(b []byte) (MedicationDispense, error) {
 	var medicationDispense MedicationDispense
-	if err := json.Unmarshal(b, &medicationDispense); err != nil {
+	if err := medicationDispense.UnmarshalJSON(b); err != nil {
 		return medicationDispense, err
 	}
 	return medicationDispense, nil
@@ -14,42 +14,52 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MedicationKnowledge is documented here http://hl7.org/fhir/StructureDefinition/MedicationKnowledge
 type MedicationKnowledge struct {
-	Id                         *string                                         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                       *Meta                                           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules              *string                                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                   *string                                         `bson:"language,omitempty" json:"language,omitempty"`
-	Text                       *Narrative                                      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension                  []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension          []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code                       *CodeableConcept                                `bson:"code,omitempty" json:"code,omitempty"`
-	Status                     *string                                         `bson:"status,omitempty" json:"status,omitempty"`
-	Manufacturer               *Reference                                      `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	DoseForm                   *CodeableConcept                                `bson:"doseForm,omitempty" json:"doseForm,omitempty"`
-	Amount                     *Quantity                                       `bson:"amount,omitempty" json:"amount,omitempty"`
-	Synonym                    []string                                        `bson:"synonym,omitempty" json:"synonym,omitempty"`
-	RelatedMedicationKnowledge []MedicationKnowledgeRelatedMedicationKnowledge `bson:"relatedMedicationKnowledge,omitempty" json:"relatedMedicationKnowledge,omitempty"`
-	AssociatedMedication       []Reference                                     `bson:"associatedMedication,omitempty" json:"associatedMedication,omitempty"`
-	ProductType                []CodeableConcept                               `bson:"productType,omitempty" json:"productType,omitempty"`
-	Monograph                  []MedicationKnowledgeMonograph                  `bson:"monograph,omitempty" json:"monograph,omitempty"`
-	Ingredient                 []MedicationKnowledgeIngredient                 `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
-	PreparationInstruction     *string                                         `bson:"preparationInstruction,omitempty" json:"preparationInstruction,omitempty"`
-	IntendedRoute              []CodeableConcept                               `bson:"intendedRoute,omitempty" json:"intendedRoute,omitempty"`
-	Cost                       []MedicationKnowledgeCost                       `bson:"cost,omitempty" json:"cost,omitempty"`
-	MonitoringProgram          []MedicationKnowledgeMonitoringProgram          `bson:"monitoringProgram,omitempty" json:"monitoringProgram,omitempty"`
-	AdministrationGuidelines   []MedicationKnowledgeAdministrationGuidelines   `bson:"administrationGuidelines,omitempty" json:"administrationGuidelines,omitempty"`
-	MedicineClassification     []MedicationKnowledgeMedicineClassification     `bson:"medicineClassification,omitempty" json:"medicineClassification,omitempty"`
-	Packaging                  *MedicationKnowledgePackaging                   `bson:"packaging,omitempty" json:"packaging,omitempty"`
-	DrugCharacteristic         []MedicationKnowledgeDrugCharacteristic         `bson:"drugCharacteristic,omitempty" json:"drugCharacteristic,omitempty"`
-	Contraindication           []Reference                                     `bson:"contraindication,omitempty" json:"contraindication,omitempty"`
-	Regulatory                 []MedicationKnowledgeRegulatory                 `bson:"regulatory,omitempty" json:"regulatory,omitempty"`
-	Kinetics                   []MedicationKnowledgeKinetics                   `bson:"kinetics,omitempty" json:"kinetics,omitempty"`
+	Id                            *string                                         `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                     *Element                                        `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                          *Meta                                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                                        `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     []json.RawMessage                               `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                          *CodeableConcept                                `bson:"code,omitempty" json:"code,omitempty"`
+	Status                        *string                                         `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement                 *Element                                        `bson:"_status,omitempty" json:"_status,omitempty"`
+	Manufacturer                  *Reference                                      `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	DoseForm                      *CodeableConcept                                `bson:"doseForm,omitempty" json:"doseForm,omitempty"`
+	Amount                        *Quantity                                       `bson:"amount,omitempty" json:"amount,omitempty"`
+	Synonym                       []string                                        `bson:"synonym,omitempty" json:"synonym,omitempty"`
+	SynonymElement                []*Element                                      `bson:"_synonym,omitempty" json:"_synonym,omitempty"`
+	RelatedMedicationKnowledge    []MedicationKnowledgeRelatedMedicationKnowledge `bson:"relatedMedicationKnowledge,omitempty" json:"relatedMedicationKnowledge,omitempty"`
+	AssociatedMedication          []Reference                                     `bson:"associatedMedication,omitempty" json:"associatedMedication,omitempty"`
+	ProductType                   []CodeableConcept                               `bson:"productType,omitempty" json:"productType,omitempty"`
+	Monograph                     []MedicationKnowledgeMonograph                  `bson:"monograph,omitempty" json:"monograph,omitempty"`
+	Ingredient                    []MedicationKnowledgeIngredient                 `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
+	PreparationInstruction        *string                                         `bson:"preparationInstruction,omitempty" json:"preparationInstruction,omitempty"`
+	PreparationInstructionElement *Element                                        `bson:"_preparationInstruction,omitempty" json:"_preparationInstruction,omitempty"`
+	IntendedRoute                 []CodeableConcept                               `bson:"intendedRoute,omitempty" json:"intendedRoute,omitempty"`
+	Cost                          []MedicationKnowledgeCost                       `bson:"cost,omitempty" json:"cost,omitempty"`
+	MonitoringProgram             []MedicationKnowledgeMonitoringProgram          `bson:"monitoringProgram,omitempty" json:"monitoringProgram,omitempty"`
+	AdministrationGuidelines      []MedicationKnowledgeAdministrationGuidelines   `bson:"administrationGuidelines,omitempty" json:"administrationGuidelines,omitempty"`
+	MedicineClassification        []MedicationKnowledgeMedicineClassification     `bson:"medicineClassification,omitempty" json:"medicineClassification,omitempty"`
+	Packaging                     *MedicationKnowledgePackaging                   `bson:"packaging,omitempty" json:"packaging,omitempty"`
+	DrugCharacteristic            []MedicationKnowledgeDrugCharacteristic         `bson:"drugCharacteristic,omitempty" json:"drugCharacteristic,omitempty"`
+	Contraindication              []Reference                                     `bson:"contraindication,omitempty" json:"contraindication,omitempty"`
+	Regulatory                    []MedicationKnowledgeRegulatory                 `bson:"regulatory,omitempty" json:"regulatory,omitempty"`
+	Kinetics                      []MedicationKnowledgeKinetics                   `bson:"kinetics,omitempty" json:"kinetics,omitempty"`
 }
 type MedicationKnowledgeRelatedMedicationKnowledge struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
@@ -66,20 +76,58 @@ type MedicationKnowledgeMonograph struct {
 	Source            *Reference       `bson:"source,omitempty" json:"source,omitempty"`
 }
 type MedicationKnowledgeIngredient struct {
-	Id                  *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemCodeableConcept CodeableConcept `bson:"itemCodeableConcept" json:"itemCodeableConcept"`
-	ItemReference       Reference       `bson:"itemReference" json:"itemReference"`
-	IsActive            *bool           `bson:"isActive,omitempty" json:"isActive,omitempty"`
-	Strength            *Ratio          `bson:"strength,omitempty" json:"strength,omitempty"`
+	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemCodeableConcept *CodeableConcept `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
+	ItemReference       *Reference       `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
+	IsActive            *bool            `bson:"isActive,omitempty" json:"isActive,omitempty"`
+	IsActiveElement     *Element         `bson:"_isActive,omitempty" json:"_isActive,omitempty"`
+	Strength            *Ratio           `bson:"strength,omitempty" json:"strength,omitempty"`
 }
+
+// MedicationKnowledgeIngredientItem is implemented by the types allowed for MedicationKnowledge.ingredient.item[x]
+type MedicationKnowledgeIngredientItem interface {
+	isMedicationKnowledgeIngredientItem()
+}
+
+func (CodeableConcept) isMedicationKnowledgeIngredientItem() {}
+func (Reference) isMedicationKnowledgeIngredientItem()       {}
+
+// Item returns the value of MedicationKnowledge.ingredient.item[x] together with its FHIR type code or nil if no value is set
+func (r MedicationKnowledgeIngredient) Item() (MedicationKnowledgeIngredientItem, string) {
+	if r.ItemCodeableConcept != nil {
+		return *r.ItemCodeableConcept, "CodeableConcept"
+	}
+	if r.ItemReference != nil {
+		return *r.ItemReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetItem sets the value of MedicationKnowledge.ingredient.item[x], given as value or pointer, and clears all other types
+func (r *MedicationKnowledgeIngredient) SetItem(value MedicationKnowledgeIngredientItem) {
+	r.ItemCodeableConcept = nil
+	r.ItemReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ItemCodeableConcept = &v
+	case *CodeableConcept:
+		r.ItemCodeableConcept = v
+	case Reference:
+		r.ItemReference = &v
+	case *Reference:
+		r.ItemReference = v
+	}
+}
+
 type MedicationKnowledgeCost struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              CodeableConcept `bson:"type" json:"type"`
 	Source            *string         `bson:"source,omitempty" json:"source,omitempty"`
+	SourceElement     *Element        `bson:"_source,omitempty" json:"_source,omitempty"`
 	Cost              Money           `bson:"cost" json:"cost"`
 }
 type MedicationKnowledgeMonitoringProgram struct {
@@ -88,6 +136,7 @@ type MedicationKnowledgeMonitoringProgram struct {
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	Name              *string          `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element         `bson:"_name,omitempty" json:"_name,omitempty"`
 }
 type MedicationKnowledgeAdministrationGuidelines struct {
 	Id                        *string                                                             `bson:"id,omitempty" json:"id,omitempty"`
@@ -105,14 +154,88 @@ type MedicationKnowledgeAdministrationGuidelinesDosage struct {
 	Type              CodeableConcept `bson:"type" json:"type"`
 	Dosage            []Dosage        `bson:"dosage" json:"dosage"`
 }
+
+// MedicationKnowledgeAdministrationGuidelinesIndication is implemented by the types allowed for MedicationKnowledge.administrationGuidelines.indication[x]
+type MedicationKnowledgeAdministrationGuidelinesIndication interface {
+	isMedicationKnowledgeAdministrationGuidelinesIndication()
+}
+
+func (CodeableConcept) isMedicationKnowledgeAdministrationGuidelinesIndication() {}
+func (Reference) isMedicationKnowledgeAdministrationGuidelinesIndication()       {}
+
+// Indication returns the value of MedicationKnowledge.administrationGuidelines.indication[x] together with its FHIR type code or nil if no value is set
+func (r MedicationKnowledgeAdministrationGuidelines) Indication() (MedicationKnowledgeAdministrationGuidelinesIndication, string) {
+	if r.IndicationCodeableConcept != nil {
+		return *r.IndicationCodeableConcept, "CodeableConcept"
+	}
+	if r.IndicationReference != nil {
+		return *r.IndicationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetIndication sets the value of MedicationKnowledge.administrationGuidelines.indication[x], given as value or pointer, and clears all other types
+func (r *MedicationKnowledgeAdministrationGuidelines) SetIndication(value MedicationKnowledgeAdministrationGuidelinesIndication) {
+	r.IndicationCodeableConcept = nil
+	r.IndicationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.IndicationCodeableConcept = &v
+	case *CodeableConcept:
+		r.IndicationCodeableConcept = v
+	case Reference:
+		r.IndicationReference = &v
+	case *Reference:
+		r.IndicationReference = v
+	}
+}
+
 type MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics struct {
-	Id                            *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                     []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension             []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	CharacteristicCodeableConcept CodeableConcept `bson:"characteristicCodeableConcept" json:"characteristicCodeableConcept"`
-	CharacteristicQuantity        Quantity        `bson:"characteristicQuantity" json:"characteristicQuantity"`
-	Value                         []string        `bson:"value,omitempty" json:"value,omitempty"`
+	Id                            *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	CharacteristicCodeableConcept *CodeableConcept `bson:"characteristicCodeableConcept,omitempty" json:"characteristicCodeableConcept,omitempty"`
+	CharacteristicQuantity        *Quantity        `bson:"characteristicQuantity,omitempty" json:"characteristicQuantity,omitempty"`
+	Value                         []string         `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement                  []*Element       `bson:"_value,omitempty" json:"_value,omitempty"`
+}
+
+// MedicationKnowledgeAdministrationGuidelinesPatientCharacteristicsCharacteristic is implemented by the types allowed for MedicationKnowledge.administrationGuidelines.patientCharacteristics.characteristic[x]
+type MedicationKnowledgeAdministrationGuidelinesPatientCharacteristicsCharacteristic interface {
+	isMedicationKnowledgeAdministrationGuidelinesPatientCharacteristicsCharacteristic()
+}
+
+func (CodeableConcept) isMedicationKnowledgeAdministrationGuidelinesPatientCharacteristicsCharacteristic() {
+}
+func (Quantity) isMedicationKnowledgeAdministrationGuidelinesPatientCharacteristicsCharacteristic() {}
+
+// Characteristic returns the value of MedicationKnowledge.administrationGuidelines.patientCharacteristics.characteristic[x] together with its FHIR type code or nil if no value is set
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) Characteristic() (MedicationKnowledgeAdministrationGuidelinesPatientCharacteristicsCharacteristic, string) {
+	if r.CharacteristicCodeableConcept != nil {
+		return *r.CharacteristicCodeableConcept, "CodeableConcept"
+	}
+	if r.CharacteristicQuantity != nil {
+		return *r.CharacteristicQuantity, "Quantity"
+	}
+	return nil, ""
+}
+
+// SetCharacteristic sets the value of MedicationKnowledge.administrationGuidelines.patientCharacteristics.characteristic[x], given as value or pointer, and clears all other types
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) SetCharacteristic(value MedicationKnowledgeAdministrationGuidelinesPatientCharacteristicsCharacteristic) {
+	r.CharacteristicCodeableConcept = nil
+	r.CharacteristicQuantity = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.CharacteristicCodeableConcept = &v
+	case *CodeableConcept:
+		r.CharacteristicCodeableConcept = v
+	case Quantity:
+		r.CharacteristicQuantity = &v
+	case *Quantity:
+		r.CharacteristicQuantity = v
+	}
 }
+
 type MedicationKnowledgeMedicineClassification struct {
 	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -128,15 +251,73 @@ type MedicationKnowledgePackaging struct {
 	Quantity          *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
 }
 type MedicationKnowledgeDrugCharacteristic struct {
-	Id                   *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type                 *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	ValueCodeableConcept *CodeableConcept `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
-	ValueString          *string          `bson:"valueString,omitempty" json:"valueString,omitempty"`
-	ValueQuantity        *Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
-	ValueBase64Binary    *string          `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	Id                       *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	ValueCodeableConcept     *CodeableConcept `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueString              *string          `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element         `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueQuantity            *Quantity        `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueBase64Binary        *string          `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element         `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+}
+
+// MedicationKnowledgeDrugCharacteristicValue is implemented by the types allowed for MedicationKnowledge.drugCharacteristic.value[x]
+type MedicationKnowledgeDrugCharacteristicValue interface {
+	isMedicationKnowledgeDrugCharacteristicValue()
+}
+
+func (CodeableConcept) isMedicationKnowledgeDrugCharacteristicValue() {}
+func (String) isMedicationKnowledgeDrugCharacteristicValue()          {}
+func (Quantity) isMedicationKnowledgeDrugCharacteristicValue()        {}
+func (Base64Binary) isMedicationKnowledgeDrugCharacteristicValue()    {}
+
+// Value returns the value of MedicationKnowledge.drugCharacteristic.value[x] together with its FHIR type code or nil if no value is set
+func (r MedicationKnowledgeDrugCharacteristic) Value() (MedicationKnowledgeDrugCharacteristicValue, string) {
+	if r.ValueCodeableConcept != nil {
+		return *r.ValueCodeableConcept, "CodeableConcept"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueBase64Binary != nil {
+		return Base64Binary(*r.ValueBase64Binary), "base64Binary"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of MedicationKnowledge.drugCharacteristic.value[x], given as value or pointer, and clears all other types
+func (r *MedicationKnowledgeDrugCharacteristic) SetValue(value MedicationKnowledgeDrugCharacteristicValue) {
+	r.ValueCodeableConcept = nil
+	r.ValueString = nil
+	r.ValueQuantity = nil
+	r.ValueBase64Binary = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.ValueCodeableConcept = v
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Base64Binary:
+		primitive := string(v)
+		r.ValueBase64Binary = &primitive
+	case *Base64Binary:
+		r.ValueBase64Binary = (*string)(v)
+	}
 }
+
 type MedicationKnowledgeRegulatory struct {
 	Id                  *string                                     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension           []Extension                                 `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -152,6 +333,7 @@ type MedicationKnowledgeRegulatorySubstitution struct {
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              CodeableConcept `bson:"type" json:"type"`
 	Allowed           bool            `bson:"allowed" json:"allowed"`
+	AllowedElement    *Element        `bson:"_allowed,omitempty" json:"_allowed,omitempty"`
 }
 type MedicationKnowledgeRegulatorySchedule struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
@@ -174,23 +356,8373 @@ type MedicationKnowledgeKinetics struct {
 	LethalDose50      []Quantity  `bson:"lethalDose50,omitempty" json:"lethalDose50,omitempty"`
 	HalfLifePeriod    *Duration   `bson:"halfLifePeriod,omitempty" json:"halfLifePeriod,omitempty"`
 }
-type OtherMedicationKnowledge MedicationKnowledge
 
 // MarshalJSON marshals the given MedicationKnowledge as JSON into a byte slice
 func (r MedicationKnowledge) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicationKnowledge
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicationKnowledge: OtherMedicationKnowledge(r),
-		ResourceType:             "MedicationKnowledge",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledge as JSON object with the members in the order of the fields
+func (r MedicationKnowledge) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicationKnowledge\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(*r.Status)
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Manufacturer != nil {
+		e.key("manufacturer")
+		r.Manufacturer.appendJSON(e)
+	}
+	if r.DoseForm != nil {
+		e.key("doseForm")
+		r.DoseForm.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	if len(r.Synonym) > 0 {
+		e.key("synonym")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Synonym {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SynonymElement) > 0 {
+		e.key("_synonym")
+		e.elements(r.SynonymElement, len(r.Synonym))
+	}
+	if len(r.RelatedMedicationKnowledge) > 0 {
+		e.key("relatedMedicationKnowledge")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedMedicationKnowledge {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AssociatedMedication) > 0 {
+		e.key("associatedMedication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AssociatedMedication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ProductType) > 0 {
+		e.key("productType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProductType {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Monograph) > 0 {
+		e.key("monograph")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Monograph {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Ingredient) > 0 {
+		e.key("ingredient")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Ingredient {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PreparationInstruction != nil {
+		e.key("preparationInstruction")
+		e.string(*r.PreparationInstruction)
+	}
+	if r.PreparationInstructionElement != nil {
+		e.key("_preparationInstruction")
+		r.PreparationInstructionElement.appendJSON(e)
+	}
+	if len(r.IntendedRoute) > 0 {
+		e.key("intendedRoute")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.IntendedRoute {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Cost) > 0 {
+		e.key("cost")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Cost {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.MonitoringProgram) > 0 {
+		e.key("monitoringProgram")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.MonitoringProgram {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AdministrationGuidelines) > 0 {
+		e.key("administrationGuidelines")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AdministrationGuidelines {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.MedicineClassification) > 0 {
+		e.key("medicineClassification")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.MedicineClassification {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Packaging != nil {
+		e.key("packaging")
+		r.Packaging.appendJSON(e)
+	}
+	if len(r.DrugCharacteristic) > 0 {
+		e.key("drugCharacteristic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DrugCharacteristic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Contraindication) > 0 {
+		e.key("contraindication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contraindication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Regulatory) > 0 {
+		e.key("regulatory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Regulatory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Kinetics) > 0 {
+		e.key("kinetics")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Kinetics {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledge from JSON. Unknown members are ignored.
+func (r *MedicationKnowledge) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledge
+func (r *MedicationKnowledge) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "status":
+			if v, ok := d.string(); ok {
+				r.Status = &v
+			} else {
+				r.Status = nil
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "manufacturer":
+			if d.null() {
+				r.Manufacturer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Manufacturer = &v
+			}
+		case "doseForm":
+			if d.null() {
+				r.DoseForm = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DoseForm = &v
+			}
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		case "synonym":
+			a := d.array()
+			r.Synonym = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Synonym = append(r.Synonym, v)
+			}
+			if r.Synonym == nil && !a.isNull {
+				r.Synonym = []string{}
+			}
+		case "_synonym":
+			a := d.array()
+			r.SynonymElement = nil
+			for a.next() {
+				r.SynonymElement = append(r.SynonymElement, d.element())
+			}
+		case "relatedMedicationKnowledge":
+			a := d.array()
+			r.RelatedMedicationKnowledge = nil
+			for a.next() {
+				var v MedicationKnowledgeRelatedMedicationKnowledge
+				v.decodeJSON(d)
+				r.RelatedMedicationKnowledge = append(r.RelatedMedicationKnowledge, v)
+			}
+			if r.RelatedMedicationKnowledge == nil && !a.isNull {
+				r.RelatedMedicationKnowledge = []MedicationKnowledgeRelatedMedicationKnowledge{}
+			}
+		case "associatedMedication":
+			a := d.array()
+			r.AssociatedMedication = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.AssociatedMedication = append(r.AssociatedMedication, v)
+			}
+			if r.AssociatedMedication == nil && !a.isNull {
+				r.AssociatedMedication = []Reference{}
+			}
+		case "productType":
+			a := d.array()
+			r.ProductType = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProductType = append(r.ProductType, v)
+			}
+			if r.ProductType == nil && !a.isNull {
+				r.ProductType = []CodeableConcept{}
+			}
+		case "monograph":
+			a := d.array()
+			r.Monograph = nil
+			for a.next() {
+				var v MedicationKnowledgeMonograph
+				v.decodeJSON(d)
+				r.Monograph = append(r.Monograph, v)
+			}
+			if r.Monograph == nil && !a.isNull {
+				r.Monograph = []MedicationKnowledgeMonograph{}
+			}
+		case "ingredient":
+			a := d.array()
+			r.Ingredient = nil
+			for a.next() {
+				var v MedicationKnowledgeIngredient
+				v.decodeJSON(d)
+				r.Ingredient = append(r.Ingredient, v)
+			}
+			if r.Ingredient == nil && !a.isNull {
+				r.Ingredient = []MedicationKnowledgeIngredient{}
+			}
+		case "preparationInstruction":
+			if v, ok := d.string(); ok {
+				r.PreparationInstruction = &v
+			} else {
+				r.PreparationInstruction = nil
+			}
+		case "_preparationInstruction":
+			r.PreparationInstructionElement = d.element()
+		case "intendedRoute":
+			a := d.array()
+			r.IntendedRoute = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.IntendedRoute = append(r.IntendedRoute, v)
+			}
+			if r.IntendedRoute == nil && !a.isNull {
+				r.IntendedRoute = []CodeableConcept{}
+			}
+		case "cost":
+			a := d.array()
+			r.Cost = nil
+			for a.next() {
+				var v MedicationKnowledgeCost
+				v.decodeJSON(d)
+				r.Cost = append(r.Cost, v)
+			}
+			if r.Cost == nil && !a.isNull {
+				r.Cost = []MedicationKnowledgeCost{}
+			}
+		case "monitoringProgram":
+			a := d.array()
+			r.MonitoringProgram = nil
+			for a.next() {
+				var v MedicationKnowledgeMonitoringProgram
+				v.decodeJSON(d)
+				r.MonitoringProgram = append(r.MonitoringProgram, v)
+			}
+			if r.MonitoringProgram == nil && !a.isNull {
+				r.MonitoringProgram = []MedicationKnowledgeMonitoringProgram{}
+			}
+		case "administrationGuidelines":
+			a := d.array()
+			r.AdministrationGuidelines = nil
+			for a.next() {
+				var v MedicationKnowledgeAdministrationGuidelines
+				v.decodeJSON(d)
+				r.AdministrationGuidelines = append(r.AdministrationGuidelines, v)
+			}
+			if r.AdministrationGuidelines == nil && !a.isNull {
+				r.AdministrationGuidelines = []MedicationKnowledgeAdministrationGuidelines{}
+			}
+		case "medicineClassification":
+			a := d.array()
+			r.MedicineClassification = nil
+			for a.next() {
+				var v MedicationKnowledgeMedicineClassification
+				v.decodeJSON(d)
+				r.MedicineClassification = append(r.MedicineClassification, v)
+			}
+			if r.MedicineClassification == nil && !a.isNull {
+				r.MedicineClassification = []MedicationKnowledgeMedicineClassification{}
+			}
+		case "packaging":
+			if d.null() {
+				r.Packaging = nil
+			} else {
+				var v MedicationKnowledgePackaging
+				v.decodeJSON(d)
+				r.Packaging = &v
+			}
+		case "drugCharacteristic":
+			a := d.array()
+			r.DrugCharacteristic = nil
+			for a.next() {
+				var v MedicationKnowledgeDrugCharacteristic
+				v.decodeJSON(d)
+				r.DrugCharacteristic = append(r.DrugCharacteristic, v)
+			}
+			if r.DrugCharacteristic == nil && !a.isNull {
+				r.DrugCharacteristic = []MedicationKnowledgeDrugCharacteristic{}
+			}
+		case "contraindication":
+			a := d.array()
+			r.Contraindication = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Contraindication = append(r.Contraindication, v)
+			}
+			if r.Contraindication == nil && !a.isNull {
+				r.Contraindication = []Reference{}
+			}
+		case "regulatory":
+			a := d.array()
+			r.Regulatory = nil
+			for a.next() {
+				var v MedicationKnowledgeRegulatory
+				v.decodeJSON(d)
+				r.Regulatory = append(r.Regulatory, v)
+			}
+			if r.Regulatory == nil && !a.isNull {
+				r.Regulatory = []MedicationKnowledgeRegulatory{}
+			}
+		case "kinetics":
+			a := d.array()
+			r.Kinetics = nil
+			for a.next() {
+				var v MedicationKnowledgeKinetics
+				v.decodeJSON(d)
+				r.Kinetics = append(r.Kinetics, v)
+			}
+			if r.Kinetics == nil && !a.isNull {
+				r.Kinetics = []MedicationKnowledgeKinetics{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledge which shares no memory with the original
+func (r MedicationKnowledge) DeepCopy() MedicationKnowledge {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Manufacturer != nil {
+		v := r.Manufacturer.DeepCopy()
+		out.Manufacturer = &v
+	}
+	if r.DoseForm != nil {
+		v := r.DoseForm.DeepCopy()
+		out.DoseForm = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	if r.Synonym != nil {
+		out.Synonym = make([]string, len(r.Synonym))
+		copy(out.Synonym, r.Synonym)
+	}
+	if r.SynonymElement != nil {
+		out.SynonymElement = make([]*Element, len(r.SynonymElement))
+		for i, v := range r.SynonymElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SynonymElement[i] = &c
+			}
+		}
+	}
+	if r.RelatedMedicationKnowledge != nil {
+		out.RelatedMedicationKnowledge = make([]MedicationKnowledgeRelatedMedicationKnowledge, len(r.RelatedMedicationKnowledge))
+		for i := range r.RelatedMedicationKnowledge {
+			out.RelatedMedicationKnowledge[i] = r.RelatedMedicationKnowledge[i].DeepCopy()
+		}
+	}
+	if r.AssociatedMedication != nil {
+		out.AssociatedMedication = make([]Reference, len(r.AssociatedMedication))
+		for i := range r.AssociatedMedication {
+			out.AssociatedMedication[i] = r.AssociatedMedication[i].DeepCopy()
+		}
+	}
+	if r.ProductType != nil {
+		out.ProductType = make([]CodeableConcept, len(r.ProductType))
+		for i := range r.ProductType {
+			out.ProductType[i] = r.ProductType[i].DeepCopy()
+		}
+	}
+	if r.Monograph != nil {
+		out.Monograph = make([]MedicationKnowledgeMonograph, len(r.Monograph))
+		for i := range r.Monograph {
+			out.Monograph[i] = r.Monograph[i].DeepCopy()
+		}
+	}
+	if r.Ingredient != nil {
+		out.Ingredient = make([]MedicationKnowledgeIngredient, len(r.Ingredient))
+		for i := range r.Ingredient {
+			out.Ingredient[i] = r.Ingredient[i].DeepCopy()
+		}
+	}
+	if r.PreparationInstruction != nil {
+		v := *r.PreparationInstruction
+		out.PreparationInstruction = &v
+	}
+	if r.PreparationInstructionElement != nil {
+		v := r.PreparationInstructionElement.DeepCopy()
+		out.PreparationInstructionElement = &v
+	}
+	if r.IntendedRoute != nil {
+		out.IntendedRoute = make([]CodeableConcept, len(r.IntendedRoute))
+		for i := range r.IntendedRoute {
+			out.IntendedRoute[i] = r.IntendedRoute[i].DeepCopy()
+		}
+	}
+	if r.Cost != nil {
+		out.Cost = make([]MedicationKnowledgeCost, len(r.Cost))
+		for i := range r.Cost {
+			out.Cost[i] = r.Cost[i].DeepCopy()
+		}
+	}
+	if r.MonitoringProgram != nil {
+		out.MonitoringProgram = make([]MedicationKnowledgeMonitoringProgram, len(r.MonitoringProgram))
+		for i := range r.MonitoringProgram {
+			out.MonitoringProgram[i] = r.MonitoringProgram[i].DeepCopy()
+		}
+	}
+	if r.AdministrationGuidelines != nil {
+		out.AdministrationGuidelines = make([]MedicationKnowledgeAdministrationGuidelines, len(r.AdministrationGuidelines))
+		for i := range r.AdministrationGuidelines {
+			out.AdministrationGuidelines[i] = r.AdministrationGuidelines[i].DeepCopy()
+		}
+	}
+	if r.MedicineClassification != nil {
+		out.MedicineClassification = make([]MedicationKnowledgeMedicineClassification, len(r.MedicineClassification))
+		for i := range r.MedicineClassification {
+			out.MedicineClassification[i] = r.MedicineClassification[i].DeepCopy()
+		}
+	}
+	if r.Packaging != nil {
+		v := r.Packaging.DeepCopy()
+		out.Packaging = &v
+	}
+	if r.DrugCharacteristic != nil {
+		out.DrugCharacteristic = make([]MedicationKnowledgeDrugCharacteristic, len(r.DrugCharacteristic))
+		for i := range r.DrugCharacteristic {
+			out.DrugCharacteristic[i] = r.DrugCharacteristic[i].DeepCopy()
+		}
+	}
+	if r.Contraindication != nil {
+		out.Contraindication = make([]Reference, len(r.Contraindication))
+		for i := range r.Contraindication {
+			out.Contraindication[i] = r.Contraindication[i].DeepCopy()
+		}
+	}
+	if r.Regulatory != nil {
+		out.Regulatory = make([]MedicationKnowledgeRegulatory, len(r.Regulatory))
+		for i := range r.Regulatory {
+			out.Regulatory[i] = r.Regulatory[i].DeepCopy()
+		}
+	}
+	if r.Kinetics != nil {
+		out.Kinetics = make([]MedicationKnowledgeKinetics, len(r.Kinetics))
+		for i := range r.Kinetics {
+			out.Kinetics[i] = r.Kinetics[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledge) Equal(other MedicationKnowledge) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Manufacturer == nil) != (other.Manufacturer == nil) || r.Manufacturer != nil && !r.Manufacturer.Equal(*other.Manufacturer) {
+		return false
+	}
+	if (r.DoseForm == nil) != (other.DoseForm == nil) || r.DoseForm != nil && !r.DoseForm.Equal(*other.DoseForm) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	if len(r.Synonym) != len(other.Synonym) {
+		return false
+	}
+	for i := range r.Synonym {
+		if r.Synonym[i] != other.Synonym[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SynonymElement, other.SynonymElement, false) {
+		return false
+	}
+	if len(r.RelatedMedicationKnowledge) != len(other.RelatedMedicationKnowledge) {
+		return false
+	}
+	for i := range r.RelatedMedicationKnowledge {
+		if !r.RelatedMedicationKnowledge[i].Equal(other.RelatedMedicationKnowledge[i]) {
+			return false
+		}
+	}
+	if len(r.AssociatedMedication) != len(other.AssociatedMedication) {
+		return false
+	}
+	for i := range r.AssociatedMedication {
+		if !r.AssociatedMedication[i].Equal(other.AssociatedMedication[i]) {
+			return false
+		}
+	}
+	if len(r.ProductType) != len(other.ProductType) {
+		return false
+	}
+	for i := range r.ProductType {
+		if !r.ProductType[i].Equal(other.ProductType[i]) {
+			return false
+		}
+	}
+	if len(r.Monograph) != len(other.Monograph) {
+		return false
+	}
+	for i := range r.Monograph {
+		if !r.Monograph[i].Equal(other.Monograph[i]) {
+			return false
+		}
+	}
+	if len(r.Ingredient) != len(other.Ingredient) {
+		return false
+	}
+	for i := range r.Ingredient {
+		if !r.Ingredient[i].Equal(other.Ingredient[i]) {
+			return false
+		}
+	}
+	if (r.PreparationInstruction == nil) != (other.PreparationInstruction == nil) || r.PreparationInstruction != nil && *r.PreparationInstruction != *other.PreparationInstruction {
+		return false
+	}
+	if (r.PreparationInstructionElement == nil) != (other.PreparationInstructionElement == nil) || r.PreparationInstructionElement != nil && !r.PreparationInstructionElement.Equal(*other.PreparationInstructionElement) {
+		return false
+	}
+	if len(r.IntendedRoute) != len(other.IntendedRoute) {
+		return false
+	}
+	for i := range r.IntendedRoute {
+		if !r.IntendedRoute[i].Equal(other.IntendedRoute[i]) {
+			return false
+		}
+	}
+	if len(r.Cost) != len(other.Cost) {
+		return false
+	}
+	for i := range r.Cost {
+		if !r.Cost[i].Equal(other.Cost[i]) {
+			return false
+		}
+	}
+	if len(r.MonitoringProgram) != len(other.MonitoringProgram) {
+		return false
+	}
+	for i := range r.MonitoringProgram {
+		if !r.MonitoringProgram[i].Equal(other.MonitoringProgram[i]) {
+			return false
+		}
+	}
+	if len(r.AdministrationGuidelines) != len(other.AdministrationGuidelines) {
+		return false
+	}
+	for i := range r.AdministrationGuidelines {
+		if !r.AdministrationGuidelines[i].Equal(other.AdministrationGuidelines[i]) {
+			return false
+		}
+	}
+	if len(r.MedicineClassification) != len(other.MedicineClassification) {
+		return false
+	}
+	for i := range r.MedicineClassification {
+		if !r.MedicineClassification[i].Equal(other.MedicineClassification[i]) {
+			return false
+		}
+	}
+	if (r.Packaging == nil) != (other.Packaging == nil) || r.Packaging != nil && !r.Packaging.Equal(*other.Packaging) {
+		return false
+	}
+	if len(r.DrugCharacteristic) != len(other.DrugCharacteristic) {
+		return false
+	}
+	for i := range r.DrugCharacteristic {
+		if !r.DrugCharacteristic[i].Equal(other.DrugCharacteristic[i]) {
+			return false
+		}
+	}
+	if len(r.Contraindication) != len(other.Contraindication) {
+		return false
+	}
+	for i := range r.Contraindication {
+		if !r.Contraindication[i].Equal(other.Contraindication[i]) {
+			return false
+		}
+	}
+	if len(r.Regulatory) != len(other.Regulatory) {
+		return false
+	}
+	for i := range r.Regulatory {
+		if !r.Regulatory[i].Equal(other.Regulatory[i]) {
+			return false
+		}
+	}
+	if len(r.Kinetics) != len(other.Kinetics) {
+		return false
+	}
+	for i := range r.Kinetics {
+		if !r.Kinetics[i].Equal(other.Kinetics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledge) EqualsDeep(other MedicationKnowledge) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !equivalentString(*r.Status, *other.Status) {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Manufacturer == nil) != (other.Manufacturer == nil) || r.Manufacturer != nil && !r.Manufacturer.EqualsDeep(*other.Manufacturer) {
+		return false
+	}
+	if (r.DoseForm == nil) != (other.DoseForm == nil) || r.DoseForm != nil && !r.DoseForm.EqualsDeep(*other.DoseForm) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	if !equivalentList(len(r.Synonym), len(other.Synonym), func(i, j int) bool {
+		return equivalentString(r.Synonym[i], other.Synonym[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.SynonymElement, other.SynonymElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedMedicationKnowledge), len(other.RelatedMedicationKnowledge), func(i, j int) bool {
+		return r.RelatedMedicationKnowledge[i].EqualsDeep(other.RelatedMedicationKnowledge[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.AssociatedMedication), len(other.AssociatedMedication), func(i, j int) bool {
+		return r.AssociatedMedication[i].EqualsDeep(other.AssociatedMedication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ProductType), len(other.ProductType), func(i, j int) bool {
+		return r.ProductType[i].EqualsDeep(other.ProductType[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Monograph), len(other.Monograph), func(i, j int) bool {
+		return r.Monograph[i].EqualsDeep(other.Monograph[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Ingredient), len(other.Ingredient), func(i, j int) bool {
+		return r.Ingredient[i].EqualsDeep(other.Ingredient[j])
+	}) {
+		return false
+	}
+	if (r.PreparationInstruction == nil) != (other.PreparationInstruction == nil) || r.PreparationInstruction != nil && !equivalentString(*r.PreparationInstruction, *other.PreparationInstruction) {
+		return false
+	}
+	if (r.PreparationInstructionElement == nil) != (other.PreparationInstructionElement == nil) || r.PreparationInstructionElement != nil && !r.PreparationInstructionElement.EqualsDeep(*other.PreparationInstructionElement) {
+		return false
+	}
+	if !equivalentList(len(r.IntendedRoute), len(other.IntendedRoute), func(i, j int) bool {
+		return r.IntendedRoute[i].EqualsDeep(other.IntendedRoute[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Cost), len(other.Cost), func(i, j int) bool {
+		return r.Cost[i].EqualsDeep(other.Cost[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.MonitoringProgram), len(other.MonitoringProgram), func(i, j int) bool {
+		return r.MonitoringProgram[i].EqualsDeep(other.MonitoringProgram[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.AdministrationGuidelines), len(other.AdministrationGuidelines), func(i, j int) bool {
+		return r.AdministrationGuidelines[i].EqualsDeep(other.AdministrationGuidelines[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.MedicineClassification), len(other.MedicineClassification), func(i, j int) bool {
+		return r.MedicineClassification[i].EqualsDeep(other.MedicineClassification[j])
+	}) {
+		return false
+	}
+	if (r.Packaging == nil) != (other.Packaging == nil) || r.Packaging != nil && !r.Packaging.EqualsDeep(*other.Packaging) {
+		return false
+	}
+	if !equivalentList(len(r.DrugCharacteristic), len(other.DrugCharacteristic), func(i, j int) bool {
+		return r.DrugCharacteristic[i].EqualsDeep(other.DrugCharacteristic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Contraindication), len(other.Contraindication), func(i, j int) bool {
+		return r.Contraindication[i].EqualsDeep(other.Contraindication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Regulatory), len(other.Regulatory), func(i, j int) bool {
+		return r.Regulatory[i].EqualsDeep(other.Regulatory[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Kinetics), len(other.Kinetics), func(i, j int) bool {
+		return r.Kinetics[i].EqualsDeep(other.Kinetics[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledge) EqualsShallow(other MedicationKnowledge) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !equivalentString(*r.Status, *other.Status) {
+		return false
+	}
+	if !equivalentList(len(r.Synonym), len(other.Synonym), func(i, j int) bool {
+		return equivalentString(r.Synonym[i], other.Synonym[j])
+	}) {
+		return false
+	}
+	if (r.PreparationInstruction == nil) != (other.PreparationInstruction == nil) || r.PreparationInstruction != nil && !equivalentString(*r.PreparationInstruction, *other.PreparationInstruction) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledge as FHIR XML
+func (r MedicationKnowledge) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicationKnowledge",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "manufacturer", r.Manufacturer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "doseForm", r.DoseForm); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	for i, v := range r.Synonym {
+		if err := encodeXMLPrimitive(e, "synonym", v, elementAt(r.SynonymElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedMedicationKnowledge {
+		if err := encodeXMLElement(e, "relatedMedicationKnowledge", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.AssociatedMedication {
+		if err := encodeXMLElement(e, "associatedMedication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ProductType {
+		if err := encodeXMLElement(e, "productType", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Monograph {
+		if err := encodeXMLElement(e, "monograph", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Ingredient {
+		if err := encodeXMLElement(e, "ingredient", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "preparationInstruction", r.PreparationInstruction, r.PreparationInstructionElement); err != nil {
+		return err
+	}
+	for _, v := range r.IntendedRoute {
+		if err := encodeXMLElement(e, "intendedRoute", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Cost {
+		if err := encodeXMLElement(e, "cost", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.MonitoringProgram {
+		if err := encodeXMLElement(e, "monitoringProgram", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.AdministrationGuidelines {
+		if err := encodeXMLElement(e, "administrationGuidelines", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.MedicineClassification {
+		if err := encodeXMLElement(e, "medicineClassification", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "packaging", r.Packaging); err != nil {
+		return err
+	}
+	for _, v := range r.DrugCharacteristic {
+		if err := encodeXMLElement(e, "drugCharacteristic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Contraindication {
+		if err := encodeXMLElement(e, "contraindication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Regulatory {
+		if err := encodeXMLElement(e, "regulatory", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Kinetics {
+		if err := encodeXMLElement(e, "kinetics", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledge from FHIR XML
+func (r *MedicationKnowledge) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "status":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "manufacturer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manufacturer = &v
+			case "doseForm":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DoseForm = &v
+			case "amount":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			case "synonym":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SynonymElement = appendElement(r.SynonymElement, len(r.Synonym), element)
+					r.Synonym = append(r.Synonym, v)
+				}
+			case "relatedMedicationKnowledge":
+				var v MedicationKnowledgeRelatedMedicationKnowledge
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedMedicationKnowledge = append(r.RelatedMedicationKnowledge, v)
+			case "associatedMedication":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AssociatedMedication = append(r.AssociatedMedication, v)
+			case "productType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductType = append(r.ProductType, v)
+			case "monograph":
+				var v MedicationKnowledgeMonograph
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Monograph = append(r.Monograph, v)
+			case "ingredient":
+				var v MedicationKnowledgeIngredient
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Ingredient = append(r.Ingredient, v)
+			case "preparationInstruction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PreparationInstruction = &v
+				}
+				r.PreparationInstructionElement = element
+			case "intendedRoute":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.IntendedRoute = append(r.IntendedRoute, v)
+			case "cost":
+				var v MedicationKnowledgeCost
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Cost = append(r.Cost, v)
+			case "monitoringProgram":
+				var v MedicationKnowledgeMonitoringProgram
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MonitoringProgram = append(r.MonitoringProgram, v)
+			case "administrationGuidelines":
+				var v MedicationKnowledgeAdministrationGuidelines
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdministrationGuidelines = append(r.AdministrationGuidelines, v)
+			case "medicineClassification":
+				var v MedicationKnowledgeMedicineClassification
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicineClassification = append(r.MedicineClassification, v)
+			case "packaging":
+				var v MedicationKnowledgePackaging
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Packaging = &v
+			case "drugCharacteristic":
+				var v MedicationKnowledgeDrugCharacteristic
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DrugCharacteristic = append(r.DrugCharacteristic, v)
+			case "contraindication":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contraindication = append(r.Contraindication, v)
+			case "regulatory":
+				var v MedicationKnowledgeRegulatory
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Regulatory = append(r.Regulatory, v)
+			case "kinetics":
+				var v MedicationKnowledgeKinetics
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Kinetics = append(r.Kinetics, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledge as properties to the RDF node n
+func (r MedicationKnowledge) turtle(n *turtleNode) {
+	n.resource("MedicationKnowledge", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.code", -1, r.Code)
+	n.primitive("MedicationKnowledge.status", -1, r.Status, r.StatusElement, "string")
+	n.element("MedicationKnowledge.manufacturer", -1, r.Manufacturer)
+	n.element("MedicationKnowledge.doseForm", -1, r.DoseForm)
+	n.element("MedicationKnowledge.amount", -1, r.Amount)
+	for i, v := range r.Synonym {
+		n.primitive("MedicationKnowledge.synonym", i, v, elementAt(r.SynonymElement, i), "string")
+	}
+	for i, v := range r.RelatedMedicationKnowledge {
+		n.element("MedicationKnowledge.relatedMedicationKnowledge", i, v)
+	}
+	for i, v := range r.AssociatedMedication {
+		n.element("MedicationKnowledge.associatedMedication", i, v)
+	}
+	for i, v := range r.ProductType {
+		n.element("MedicationKnowledge.productType", i, v)
+	}
+	for i, v := range r.Monograph {
+		n.element("MedicationKnowledge.monograph", i, v)
+	}
+	for i, v := range r.Ingredient {
+		n.element("MedicationKnowledge.ingredient", i, v)
+	}
+	n.primitive("MedicationKnowledge.preparationInstruction", -1, r.PreparationInstruction, r.PreparationInstructionElement, "string")
+	for i, v := range r.IntendedRoute {
+		n.element("MedicationKnowledge.intendedRoute", i, v)
+	}
+	for i, v := range r.Cost {
+		n.element("MedicationKnowledge.cost", i, v)
+	}
+	for i, v := range r.MonitoringProgram {
+		n.element("MedicationKnowledge.monitoringProgram", i, v)
+	}
+	for i, v := range r.AdministrationGuidelines {
+		n.element("MedicationKnowledge.administrationGuidelines", i, v)
+	}
+	for i, v := range r.MedicineClassification {
+		n.element("MedicationKnowledge.medicineClassification", i, v)
+	}
+	n.element("MedicationKnowledge.packaging", -1, r.Packaging)
+	for i, v := range r.DrugCharacteristic {
+		n.element("MedicationKnowledge.drugCharacteristic", i, v)
+	}
+	for i, v := range r.Contraindication {
+		n.element("MedicationKnowledge.contraindication", i, v)
+	}
+	for i, v := range r.Regulatory {
+		n.element("MedicationKnowledge.regulatory", i, v)
+	}
+	for i, v := range r.Kinetics {
+		n.element("MedicationKnowledge.kinetics", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationKnowledge as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledge) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledge from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledge) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledge as protobuf message MedicationKnowledge of fhir.proto
+func (r MedicationKnowledge) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledge to the encoded message
+func (r MedicationKnowledge) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Code != nil {
+		e.message(9, *r.Code)
+	}
+	if r.Status != nil {
+		e.string(10, *r.Status)
+	}
+	if r.Manufacturer != nil {
+		e.message(11, *r.Manufacturer)
+	}
+	if r.DoseForm != nil {
+		e.message(12, *r.DoseForm)
+	}
+	if r.Amount != nil {
+		e.message(13, *r.Amount)
+	}
+	for _, v := range r.Synonym {
+		e.string(14, v)
+	}
+	for _, v := range r.RelatedMedicationKnowledge {
+		e.message(15, v)
+	}
+	for _, v := range r.AssociatedMedication {
+		e.message(16, v)
+	}
+	for _, v := range r.ProductType {
+		e.message(17, v)
+	}
+	for _, v := range r.Monograph {
+		e.message(18, v)
+	}
+	for _, v := range r.Ingredient {
+		e.message(19, v)
+	}
+	if r.PreparationInstruction != nil {
+		e.string(20, *r.PreparationInstruction)
+	}
+	for _, v := range r.IntendedRoute {
+		e.message(21, v)
+	}
+	for _, v := range r.Cost {
+		e.message(22, v)
+	}
+	for _, v := range r.MonitoringProgram {
+		e.message(23, v)
+	}
+	for _, v := range r.AdministrationGuidelines {
+		e.message(24, v)
+	}
+	for _, v := range r.MedicineClassification {
+		e.message(25, v)
+	}
+	if r.Packaging != nil {
+		e.message(26, *r.Packaging)
+	}
+	for _, v := range r.DrugCharacteristic {
+		e.message(27, v)
+	}
+	for _, v := range r.Contraindication {
+		e.message(28, v)
+	}
+	for _, v := range r.Regulatory {
+		e.message(29, v)
+	}
+	for _, v := range r.Kinetics {
+		e.message(30, v)
+	}
+	if r.IdElement != nil {
+		e.message(31, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(32, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(33, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(34, *r.StatusElement)
+	}
+	for _, v := range r.SynonymElement {
+		e.element(35, v)
+	}
+	if r.PreparationInstructionElement != nil {
+		e.message(36, *r.PreparationInstructionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledge from protobuf message MedicationKnowledge of fhir.proto
+func (r *MedicationKnowledge) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledge{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 10:
+			v := d.string()
+			r.Status = &v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Manufacturer = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.DoseForm = &v
+		case 13:
+			var v Quantity
+			d.message(&v)
+			r.Amount = &v
+		case 14:
+			r.Synonym = append(r.Synonym, d.string())
+		case 15:
+			var v MedicationKnowledgeRelatedMedicationKnowledge
+			d.message(&v)
+			r.RelatedMedicationKnowledge = append(r.RelatedMedicationKnowledge, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.AssociatedMedication = append(r.AssociatedMedication, v)
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductType = append(r.ProductType, v)
+		case 18:
+			var v MedicationKnowledgeMonograph
+			d.message(&v)
+			r.Monograph = append(r.Monograph, v)
+		case 19:
+			var v MedicationKnowledgeIngredient
+			d.message(&v)
+			r.Ingredient = append(r.Ingredient, v)
+		case 20:
+			v := d.string()
+			r.PreparationInstruction = &v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.IntendedRoute = append(r.IntendedRoute, v)
+		case 22:
+			var v MedicationKnowledgeCost
+			d.message(&v)
+			r.Cost = append(r.Cost, v)
+		case 23:
+			var v MedicationKnowledgeMonitoringProgram
+			d.message(&v)
+			r.MonitoringProgram = append(r.MonitoringProgram, v)
+		case 24:
+			var v MedicationKnowledgeAdministrationGuidelines
+			d.message(&v)
+			r.AdministrationGuidelines = append(r.AdministrationGuidelines, v)
+		case 25:
+			var v MedicationKnowledgeMedicineClassification
+			d.message(&v)
+			r.MedicineClassification = append(r.MedicineClassification, v)
+		case 26:
+			var v MedicationKnowledgePackaging
+			d.message(&v)
+			r.Packaging = &v
+		case 27:
+			var v MedicationKnowledgeDrugCharacteristic
+			d.message(&v)
+			r.DrugCharacteristic = append(r.DrugCharacteristic, v)
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Contraindication = append(r.Contraindication, v)
+		case 29:
+			var v MedicationKnowledgeRegulatory
+			d.message(&v)
+			r.Regulatory = append(r.Regulatory, v)
+		case 30:
+			var v MedicationKnowledgeKinetics
+			d.message(&v)
+			r.Kinetics = append(r.Kinetics, v)
+		case 31:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 35:
+			r.SynonymElement = append(r.SynonymElement, d.element())
+		case 36:
+			var v Element
+			d.message(&v)
+			r.PreparationInstructionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeRelatedMedicationKnowledge as JSON into a byte slice
+func (r MedicationKnowledgeRelatedMedicationKnowledge) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeRelatedMedicationKnowledge as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeRelatedMedicationKnowledge) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	e.key("reference")
+	if r.Reference == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeRelatedMedicationKnowledge from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeRelatedMedicationKnowledge) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeRelatedMedicationKnowledge
+func (r *MedicationKnowledgeRelatedMedicationKnowledge) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "reference":
+			a := d.array()
+			r.Reference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Reference = append(r.Reference, v)
+			}
+			if r.Reference == nil && !a.isNull {
+				r.Reference = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeRelatedMedicationKnowledge which shares no memory with the original
+func (r MedicationKnowledgeRelatedMedicationKnowledge) DeepCopy() MedicationKnowledgeRelatedMedicationKnowledge {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Reference != nil {
+		out.Reference = make([]Reference, len(r.Reference))
+		for i := range r.Reference {
+			out.Reference[i] = r.Reference[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeRelatedMedicationKnowledge) Equal(other MedicationKnowledgeRelatedMedicationKnowledge) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.Reference) != len(other.Reference) {
+		return false
+	}
+	for i := range r.Reference {
+		if !r.Reference[i].Equal(other.Reference[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeRelatedMedicationKnowledge) EqualsDeep(other MedicationKnowledgeRelatedMedicationKnowledge) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Reference), len(other.Reference), func(i, j int) bool {
+		return r.Reference[i].EqualsDeep(other.Reference[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeRelatedMedicationKnowledge) EqualsShallow(other MedicationKnowledgeRelatedMedicationKnowledge) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeRelatedMedicationKnowledge as FHIR XML
+func (r MedicationKnowledgeRelatedMedicationKnowledge) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Reference {
+		if err := encodeXMLElement(e, "reference", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeRelatedMedicationKnowledge from FHIR XML
+func (r *MedicationKnowledgeRelatedMedicationKnowledge) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "reference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reference = append(r.Reference, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeRelatedMedicationKnowledge as properties to the RDF node n
+func (r MedicationKnowledgeRelatedMedicationKnowledge) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.relatedMedicationKnowledge.type", -1, r.Type)
+	for i, v := range r.Reference {
+		n.element("MedicationKnowledge.relatedMedicationKnowledge.reference", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeRelatedMedicationKnowledge as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeRelatedMedicationKnowledge) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeRelatedMedicationKnowledge from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeRelatedMedicationKnowledge) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeRelatedMedicationKnowledge as protobuf message MedicationKnowledgeRelatedMedicationKnowledge of fhir.proto
+func (r MedicationKnowledgeRelatedMedicationKnowledge) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeRelatedMedicationKnowledge to the encoded message
+func (r MedicationKnowledgeRelatedMedicationKnowledge) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	for _, v := range r.Reference {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeRelatedMedicationKnowledge from protobuf message MedicationKnowledgeRelatedMedicationKnowledge of fhir.proto
+func (r *MedicationKnowledgeRelatedMedicationKnowledge) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeRelatedMedicationKnowledge{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Reference = append(r.Reference, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeMonograph as JSON into a byte slice
+func (r MedicationKnowledgeMonograph) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeMonograph as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeMonograph) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Source != nil {
+		e.key("source")
+		r.Source.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeMonograph from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeMonograph) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeMonograph
+func (r *MedicationKnowledgeMonograph) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "source":
+			if d.null() {
+				r.Source = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeMonograph which shares no memory with the original
+func (r MedicationKnowledgeMonograph) DeepCopy() MedicationKnowledgeMonograph {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Source != nil {
+		v := r.Source.DeepCopy()
+		out.Source = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeMonograph) Equal(other MedicationKnowledgeMonograph) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !r.Source.Equal(*other.Source) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeMonograph) EqualsDeep(other MedicationKnowledgeMonograph) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !r.Source.EqualsDeep(*other.Source) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeMonograph) EqualsShallow(other MedicationKnowledgeMonograph) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeMonograph as FHIR XML
+func (r MedicationKnowledgeMonograph) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "source", r.Source); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeMonograph from FHIR XML
+func (r *MedicationKnowledgeMonograph) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeMonograph as properties to the RDF node n
+func (r MedicationKnowledgeMonograph) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.monograph.type", -1, r.Type)
+	n.element("MedicationKnowledge.monograph.source", -1, r.Source)
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeMonograph as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeMonograph) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeMonograph from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeMonograph) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeMonograph as protobuf message MedicationKnowledgeMonograph of fhir.proto
+func (r MedicationKnowledgeMonograph) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeMonograph to the encoded message
+func (r MedicationKnowledgeMonograph) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Source != nil {
+		e.message(5, *r.Source)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeMonograph from protobuf message MedicationKnowledgeMonograph of fhir.proto
+func (r *MedicationKnowledgeMonograph) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeMonograph{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Source = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeIngredient as JSON into a byte slice
+func (r MedicationKnowledgeIngredient) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeIngredient as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeIngredient) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ItemCodeableConcept != nil {
+		e.key("itemCodeableConcept")
+		r.ItemCodeableConcept.appendJSON(e)
+	}
+	if r.ItemReference != nil {
+		e.key("itemReference")
+		r.ItemReference.appendJSON(e)
+	}
+	if r.IsActive != nil {
+		e.key("isActive")
+		e.bool(*r.IsActive)
+	}
+	if r.IsActiveElement != nil {
+		e.key("_isActive")
+		r.IsActiveElement.appendJSON(e)
+	}
+	if r.Strength != nil {
+		e.key("strength")
+		r.Strength.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeIngredient from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeIngredient) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeIngredient
+func (r *MedicationKnowledgeIngredient) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "itemCodeableConcept":
+			if d.null() {
+				r.ItemCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ItemCodeableConcept = &v
+			}
+		case "itemReference":
+			if d.null() {
+				r.ItemReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ItemReference = &v
+			}
+		case "isActive":
+			if v, ok := d.bool(); ok {
+				r.IsActive = &v
+			} else {
+				r.IsActive = nil
+			}
+		case "_isActive":
+			r.IsActiveElement = d.element()
+		case "strength":
+			if d.null() {
+				r.Strength = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.Strength = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeIngredient which shares no memory with the original
+func (r MedicationKnowledgeIngredient) DeepCopy() MedicationKnowledgeIngredient {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ItemCodeableConcept != nil {
+		v := r.ItemCodeableConcept.DeepCopy()
+		out.ItemCodeableConcept = &v
+	}
+	if r.ItemReference != nil {
+		v := r.ItemReference.DeepCopy()
+		out.ItemReference = &v
+	}
+	if r.IsActive != nil {
+		v := *r.IsActive
+		out.IsActive = &v
+	}
+	if r.IsActiveElement != nil {
+		v := r.IsActiveElement.DeepCopy()
+		out.IsActiveElement = &v
+	}
+	if r.Strength != nil {
+		v := r.Strength.DeepCopy()
+		out.Strength = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeIngredient) Equal(other MedicationKnowledgeIngredient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.Equal(*other.ItemCodeableConcept) {
+		return false
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.Equal(*other.ItemReference) {
+		return false
+	}
+	if (r.IsActive == nil) != (other.IsActive == nil) || r.IsActive != nil && *r.IsActive != *other.IsActive {
+		return false
+	}
+	if (r.IsActiveElement == nil) != (other.IsActiveElement == nil) || r.IsActiveElement != nil && !r.IsActiveElement.Equal(*other.IsActiveElement) {
+		return false
+	}
+	if (r.Strength == nil) != (other.Strength == nil) || r.Strength != nil && !r.Strength.Equal(*other.Strength) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeIngredient) EqualsDeep(other MedicationKnowledgeIngredient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.EqualsDeep(*other.ItemCodeableConcept) {
+		return false
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.EqualsDeep(*other.ItemReference) {
+		return false
+	}
+	if (r.IsActive == nil) != (other.IsActive == nil) || r.IsActive != nil && *r.IsActive != *other.IsActive {
+		return false
+	}
+	if (r.IsActiveElement == nil) != (other.IsActiveElement == nil) || r.IsActiveElement != nil && !r.IsActiveElement.EqualsDeep(*other.IsActiveElement) {
+		return false
+	}
+	if (r.Strength == nil) != (other.Strength == nil) || r.Strength != nil && !r.Strength.EqualsDeep(*other.Strength) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeIngredient) EqualsShallow(other MedicationKnowledgeIngredient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IsActive == nil) != (other.IsActive == nil) || r.IsActive != nil && *r.IsActive != *other.IsActive {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeIngredient as FHIR XML
+func (r MedicationKnowledgeIngredient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "itemCodeableConcept", r.ItemCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "itemReference", r.ItemReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "isActive", r.IsActive, r.IsActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "strength", r.Strength); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeIngredient from FHIR XML
+func (r *MedicationKnowledgeIngredient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "itemCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemCodeableConcept = &v
+			case "itemReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemReference = &v
+			case "isActive":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsActive = &v
+				}
+				r.IsActiveElement = element
+			case "strength":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Strength = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeIngredient as properties to the RDF node n
+func (r MedicationKnowledgeIngredient) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.ingredient.itemCodeableConcept", -1, r.ItemCodeableConcept)
+	n.element("MedicationKnowledge.ingredient.itemReference", -1, r.ItemReference)
+	n.primitive("MedicationKnowledge.ingredient.isActive", -1, r.IsActive, r.IsActiveElement, "boolean")
+	n.element("MedicationKnowledge.ingredient.strength", -1, r.Strength)
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeIngredient as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeIngredient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeIngredient from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeIngredient) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeIngredient as protobuf message MedicationKnowledgeIngredient of fhir.proto
+func (r MedicationKnowledgeIngredient) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeIngredient to the encoded message
+func (r MedicationKnowledgeIngredient) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ItemCodeableConcept != nil {
+		e.message(4, *r.ItemCodeableConcept)
+	}
+	if r.ItemReference != nil {
+		e.message(5, *r.ItemReference)
+	}
+	if r.IsActive != nil {
+		e.varint(6, protoBool(*r.IsActive))
+	}
+	if r.Strength != nil {
+		e.message(7, *r.Strength)
+	}
+	if r.IsActiveElement != nil {
+		e.message(8, *r.IsActiveElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeIngredient from protobuf message MedicationKnowledgeIngredient of fhir.proto
+func (r *MedicationKnowledgeIngredient) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeIngredient{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.ItemCodeableConcept = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.ItemReference = &v
+		case 6:
+			v := d.bool()
+			r.IsActive = &v
+		case 7:
+			var v Ratio
+			d.message(&v)
+			r.Strength = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.IsActiveElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeCost as JSON into a byte slice
+func (r MedicationKnowledgeCost) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeCost as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeCost) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.Source != nil {
+		e.key("source")
+		e.string(*r.Source)
+	}
+	if r.SourceElement != nil {
+		e.key("_source")
+		r.SourceElement.appendJSON(e)
+	}
+	e.key("cost")
+	r.Cost.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeCost from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeCost) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeCost
+func (r *MedicationKnowledgeCost) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "source":
+			if v, ok := d.string(); ok {
+				r.Source = &v
+			} else {
+				r.Source = nil
+			}
+		case "_source":
+			r.SourceElement = d.element()
+		case "cost":
+			r.Cost.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeCost which shares no memory with the original
+func (r MedicationKnowledgeCost) DeepCopy() MedicationKnowledgeCost {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Source != nil {
+		v := *r.Source
+		out.Source = &v
+	}
+	if r.SourceElement != nil {
+		v := r.SourceElement.DeepCopy()
+		out.SourceElement = &v
+	}
+	out.Cost = r.Cost.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeCost) Equal(other MedicationKnowledgeCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && *r.Source != *other.Source {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.Equal(*other.SourceElement) {
+		return false
+	}
+	if !r.Cost.Equal(other.Cost) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeCost) EqualsDeep(other MedicationKnowledgeCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.EqualsDeep(*other.SourceElement) {
+		return false
+	}
+	if !r.Cost.EqualsDeep(other.Cost) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeCost) EqualsShallow(other MedicationKnowledgeCost) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Source == nil) != (other.Source == nil) || r.Source != nil && !equivalentString(*r.Source, *other.Source) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeCost as FHIR XML
+func (r MedicationKnowledgeCost) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "source", r.Source, r.SourceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "cost", r.Cost); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeCost from FHIR XML
+func (r *MedicationKnowledgeCost) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "source":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Source = &v
+				}
+				r.SourceElement = element
+			case "cost":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Cost = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeCost as properties to the RDF node n
+func (r MedicationKnowledgeCost) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.cost.type", -1, r.Type)
+	n.primitive("MedicationKnowledge.cost.source", -1, r.Source, r.SourceElement, "string")
+	n.element("MedicationKnowledge.cost.cost", -1, r.Cost)
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeCost as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeCost) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeCost from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeCost) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeCost as protobuf message MedicationKnowledgeCost of fhir.proto
+func (r MedicationKnowledgeCost) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeCost to the encoded message
+func (r MedicationKnowledgeCost) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.Source != nil {
+		e.string(5, *r.Source)
+	}
+	e.message(6, r.Cost)
+	if r.SourceElement != nil {
+		e.message(7, *r.SourceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeCost from protobuf message MedicationKnowledgeCost of fhir.proto
+func (r *MedicationKnowledgeCost) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeCost{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			v := d.string()
+			r.Source = &v
+		case 6:
+			var v Money
+			d.message(&v)
+			r.Cost = v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.SourceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeMonitoringProgram as JSON into a byte slice
+func (r MedicationKnowledgeMonitoringProgram) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeMonitoringProgram as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeMonitoringProgram) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeMonitoringProgram from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeMonitoringProgram) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeMonitoringProgram
+func (r *MedicationKnowledgeMonitoringProgram) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeMonitoringProgram which shares no memory with the original
+func (r MedicationKnowledgeMonitoringProgram) DeepCopy() MedicationKnowledgeMonitoringProgram {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeMonitoringProgram) Equal(other MedicationKnowledgeMonitoringProgram) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeMonitoringProgram) EqualsDeep(other MedicationKnowledgeMonitoringProgram) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeMonitoringProgram) EqualsShallow(other MedicationKnowledgeMonitoringProgram) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeMonitoringProgram as FHIR XML
+func (r MedicationKnowledgeMonitoringProgram) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeMonitoringProgram from FHIR XML
+func (r *MedicationKnowledgeMonitoringProgram) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeMonitoringProgram as properties to the RDF node n
+func (r MedicationKnowledgeMonitoringProgram) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.monitoringProgram.type", -1, r.Type)
+	n.primitive("MedicationKnowledge.monitoringProgram.name", -1, r.Name, r.NameElement, "string")
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeMonitoringProgram as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeMonitoringProgram) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeMonitoringProgram from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeMonitoringProgram) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeMonitoringProgram as protobuf message MedicationKnowledgeMonitoringProgram of fhir.proto
+func (r MedicationKnowledgeMonitoringProgram) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeMonitoringProgram to the encoded message
+func (r MedicationKnowledgeMonitoringProgram) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Name != nil {
+		e.string(5, *r.Name)
+	}
+	if r.NameElement != nil {
+		e.message(6, *r.NameElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeMonitoringProgram from protobuf message MedicationKnowledgeMonitoringProgram of fhir.proto
+func (r *MedicationKnowledgeMonitoringProgram) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeMonitoringProgram{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			v := d.string()
+			r.Name = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeAdministrationGuidelines as JSON into a byte slice
+func (r MedicationKnowledgeAdministrationGuidelines) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeAdministrationGuidelines as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeAdministrationGuidelines) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Dosage) > 0 {
+		e.key("dosage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Dosage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.IndicationCodeableConcept != nil {
+		e.key("indicationCodeableConcept")
+		r.IndicationCodeableConcept.appendJSON(e)
+	}
+	if r.IndicationReference != nil {
+		e.key("indicationReference")
+		r.IndicationReference.appendJSON(e)
+	}
+	if len(r.PatientCharacteristics) > 0 {
+		e.key("patientCharacteristics")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PatientCharacteristics {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeAdministrationGuidelines from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeAdministrationGuidelines) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeAdministrationGuidelines
+func (r *MedicationKnowledgeAdministrationGuidelines) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "dosage":
+			a := d.array()
+			r.Dosage = nil
+			for a.next() {
+				var v MedicationKnowledgeAdministrationGuidelinesDosage
+				v.decodeJSON(d)
+				r.Dosage = append(r.Dosage, v)
+			}
+			if r.Dosage == nil && !a.isNull {
+				r.Dosage = []MedicationKnowledgeAdministrationGuidelinesDosage{}
+			}
+		case "indicationCodeableConcept":
+			if d.null() {
+				r.IndicationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.IndicationCodeableConcept = &v
+			}
+		case "indicationReference":
+			if d.null() {
+				r.IndicationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.IndicationReference = &v
+			}
+		case "patientCharacteristics":
+			a := d.array()
+			r.PatientCharacteristics = nil
+			for a.next() {
+				var v MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics
+				v.decodeJSON(d)
+				r.PatientCharacteristics = append(r.PatientCharacteristics, v)
+			}
+			if r.PatientCharacteristics == nil && !a.isNull {
+				r.PatientCharacteristics = []MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeAdministrationGuidelines which shares no memory with the original
+func (r MedicationKnowledgeAdministrationGuidelines) DeepCopy() MedicationKnowledgeAdministrationGuidelines {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Dosage != nil {
+		out.Dosage = make([]MedicationKnowledgeAdministrationGuidelinesDosage, len(r.Dosage))
+		for i := range r.Dosage {
+			out.Dosage[i] = r.Dosage[i].DeepCopy()
+		}
+	}
+	if r.IndicationCodeableConcept != nil {
+		v := r.IndicationCodeableConcept.DeepCopy()
+		out.IndicationCodeableConcept = &v
+	}
+	if r.IndicationReference != nil {
+		v := r.IndicationReference.DeepCopy()
+		out.IndicationReference = &v
+	}
+	if r.PatientCharacteristics != nil {
+		out.PatientCharacteristics = make([]MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics, len(r.PatientCharacteristics))
+		for i := range r.PatientCharacteristics {
+			out.PatientCharacteristics[i] = r.PatientCharacteristics[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeAdministrationGuidelines) Equal(other MedicationKnowledgeAdministrationGuidelines) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Dosage) != len(other.Dosage) {
+		return false
+	}
+	for i := range r.Dosage {
+		if !r.Dosage[i].Equal(other.Dosage[i]) {
+			return false
+		}
+	}
+	if (r.IndicationCodeableConcept == nil) != (other.IndicationCodeableConcept == nil) || r.IndicationCodeableConcept != nil && !r.IndicationCodeableConcept.Equal(*other.IndicationCodeableConcept) {
+		return false
+	}
+	if (r.IndicationReference == nil) != (other.IndicationReference == nil) || r.IndicationReference != nil && !r.IndicationReference.Equal(*other.IndicationReference) {
+		return false
+	}
+	if len(r.PatientCharacteristics) != len(other.PatientCharacteristics) {
+		return false
+	}
+	for i := range r.PatientCharacteristics {
+		if !r.PatientCharacteristics[i].Equal(other.PatientCharacteristics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeAdministrationGuidelines) EqualsDeep(other MedicationKnowledgeAdministrationGuidelines) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Dosage), len(other.Dosage), func(i, j int) bool {
+		return r.Dosage[i].EqualsDeep(other.Dosage[j])
+	}) {
+		return false
+	}
+	if (r.IndicationCodeableConcept == nil) != (other.IndicationCodeableConcept == nil) || r.IndicationCodeableConcept != nil && !r.IndicationCodeableConcept.EqualsDeep(*other.IndicationCodeableConcept) {
+		return false
+	}
+	if (r.IndicationReference == nil) != (other.IndicationReference == nil) || r.IndicationReference != nil && !r.IndicationReference.EqualsDeep(*other.IndicationReference) {
+		return false
+	}
+	if !equivalentList(len(r.PatientCharacteristics), len(other.PatientCharacteristics), func(i, j int) bool {
+		return r.PatientCharacteristics[i].EqualsDeep(other.PatientCharacteristics[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeAdministrationGuidelines) EqualsShallow(other MedicationKnowledgeAdministrationGuidelines) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeAdministrationGuidelines as FHIR XML
+func (r MedicationKnowledgeAdministrationGuidelines) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Dosage {
+		if err := encodeXMLElement(e, "dosage", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "indicationCodeableConcept", r.IndicationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "indicationReference", r.IndicationReference); err != nil {
+		return err
+	}
+	for _, v := range r.PatientCharacteristics {
+		if err := encodeXMLElement(e, "patientCharacteristics", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeAdministrationGuidelines from FHIR XML
+func (r *MedicationKnowledgeAdministrationGuidelines) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "dosage":
+				var v MedicationKnowledgeAdministrationGuidelinesDosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Dosage = append(r.Dosage, v)
+			case "indicationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.IndicationCodeableConcept = &v
+			case "indicationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.IndicationReference = &v
+			case "patientCharacteristics":
+				var v MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatientCharacteristics = append(r.PatientCharacteristics, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeAdministrationGuidelines as properties to the RDF node n
+func (r MedicationKnowledgeAdministrationGuidelines) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Dosage {
+		n.element("MedicationKnowledge.administrationGuidelines.dosage", i, v)
+	}
+	n.element("MedicationKnowledge.administrationGuidelines.indicationCodeableConcept", -1, r.IndicationCodeableConcept)
+	n.element("MedicationKnowledge.administrationGuidelines.indicationReference", -1, r.IndicationReference)
+	for i, v := range r.PatientCharacteristics {
+		n.element("MedicationKnowledge.administrationGuidelines.patientCharacteristics", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeAdministrationGuidelines as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeAdministrationGuidelines) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeAdministrationGuidelines from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeAdministrationGuidelines) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeAdministrationGuidelines as protobuf message MedicationKnowledgeAdministrationGuidelines of fhir.proto
+func (r MedicationKnowledgeAdministrationGuidelines) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeAdministrationGuidelines to the encoded message
+func (r MedicationKnowledgeAdministrationGuidelines) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Dosage {
+		e.message(4, v)
+	}
+	if r.IndicationCodeableConcept != nil {
+		e.message(5, *r.IndicationCodeableConcept)
+	}
+	if r.IndicationReference != nil {
+		e.message(6, *r.IndicationReference)
+	}
+	for _, v := range r.PatientCharacteristics {
+		e.message(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeAdministrationGuidelines from protobuf message MedicationKnowledgeAdministrationGuidelines of fhir.proto
+func (r *MedicationKnowledgeAdministrationGuidelines) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeAdministrationGuidelines{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v MedicationKnowledgeAdministrationGuidelinesDosage
+			d.message(&v)
+			r.Dosage = append(r.Dosage, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.IndicationCodeableConcept = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.IndicationReference = &v
+		case 7:
+			var v MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics
+			d.message(&v)
+			r.PatientCharacteristics = append(r.PatientCharacteristics, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeAdministrationGuidelinesDosage as JSON into a byte slice
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeAdministrationGuidelinesDosage as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	e.key("dosage")
+	if r.Dosage == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Dosage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeAdministrationGuidelinesDosage from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeAdministrationGuidelinesDosage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeAdministrationGuidelinesDosage
+func (r *MedicationKnowledgeAdministrationGuidelinesDosage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "dosage":
+			a := d.array()
+			r.Dosage = nil
+			for a.next() {
+				var v Dosage
+				v.decodeJSON(d)
+				r.Dosage = append(r.Dosage, v)
+			}
+			if r.Dosage == nil && !a.isNull {
+				r.Dosage = []Dosage{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeAdministrationGuidelinesDosage which shares no memory with the original
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) DeepCopy() MedicationKnowledgeAdministrationGuidelinesDosage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Dosage != nil {
+		out.Dosage = make([]Dosage, len(r.Dosage))
+		for i := range r.Dosage {
+			out.Dosage[i] = r.Dosage[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) Equal(other MedicationKnowledgeAdministrationGuidelinesDosage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.Dosage) != len(other.Dosage) {
+		return false
+	}
+	for i := range r.Dosage {
+		if !r.Dosage[i].Equal(other.Dosage[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) EqualsDeep(other MedicationKnowledgeAdministrationGuidelinesDosage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Dosage), len(other.Dosage), func(i, j int) bool {
+		return r.Dosage[i].EqualsDeep(other.Dosage[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) EqualsShallow(other MedicationKnowledgeAdministrationGuidelinesDosage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeAdministrationGuidelinesDosage as FHIR XML
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Dosage {
+		if err := encodeXMLElement(e, "dosage", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeAdministrationGuidelinesDosage from FHIR XML
+func (r *MedicationKnowledgeAdministrationGuidelinesDosage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "dosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Dosage = append(r.Dosage, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeAdministrationGuidelinesDosage as properties to the RDF node n
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.administrationGuidelines.dosage.type", -1, r.Type)
+	for i, v := range r.Dosage {
+		n.element("MedicationKnowledge.administrationGuidelines.dosage.dosage", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeAdministrationGuidelinesDosage as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeAdministrationGuidelinesDosage from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeAdministrationGuidelinesDosage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeAdministrationGuidelinesDosage as protobuf message MedicationKnowledgeAdministrationGuidelinesDosage of fhir.proto
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeAdministrationGuidelinesDosage to the encoded message
+func (r MedicationKnowledgeAdministrationGuidelinesDosage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	for _, v := range r.Dosage {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeAdministrationGuidelinesDosage from protobuf message MedicationKnowledgeAdministrationGuidelinesDosage of fhir.proto
+func (r *MedicationKnowledgeAdministrationGuidelinesDosage) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeAdministrationGuidelinesDosage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v Dosage
+			d.message(&v)
+			r.Dosage = append(r.Dosage, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics as JSON into a byte slice
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.CharacteristicCodeableConcept != nil {
+		e.key("characteristicCodeableConcept")
+		r.CharacteristicCodeableConcept.appendJSON(e)
+	}
+	if r.CharacteristicQuantity != nil {
+		e.key("characteristicQuantity")
+		r.CharacteristicQuantity.appendJSON(e)
+	}
+	if len(r.Value) > 0 {
+		e.key("value")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Value {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ValueElement) > 0 {
+		e.key("_value")
+		e.elements(r.ValueElement, len(r.Value))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "characteristicCodeableConcept":
+			if d.null() {
+				r.CharacteristicCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CharacteristicCodeableConcept = &v
+			}
+		case "characteristicQuantity":
+			if d.null() {
+				r.CharacteristicQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.CharacteristicQuantity = &v
+			}
+		case "value":
+			a := d.array()
+			r.Value = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Value = append(r.Value, v)
+			}
+			if r.Value == nil && !a.isNull {
+				r.Value = []string{}
+			}
+		case "_value":
+			a := d.array()
+			r.ValueElement = nil
+			for a.next() {
+				r.ValueElement = append(r.ValueElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics which shares no memory with the original
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) DeepCopy() MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CharacteristicCodeableConcept != nil {
+		v := r.CharacteristicCodeableConcept.DeepCopy()
+		out.CharacteristicCodeableConcept = &v
+	}
+	if r.CharacteristicQuantity != nil {
+		v := r.CharacteristicQuantity.DeepCopy()
+		out.CharacteristicQuantity = &v
+	}
+	if r.Value != nil {
+		out.Value = make([]string, len(r.Value))
+		copy(out.Value, r.Value)
+	}
+	if r.ValueElement != nil {
+		out.ValueElement = make([]*Element, len(r.ValueElement))
+		for i, v := range r.ValueElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ValueElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) Equal(other MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.CharacteristicCodeableConcept == nil) != (other.CharacteristicCodeableConcept == nil) || r.CharacteristicCodeableConcept != nil && !r.CharacteristicCodeableConcept.Equal(*other.CharacteristicCodeableConcept) {
+		return false
+	}
+	if (r.CharacteristicQuantity == nil) != (other.CharacteristicQuantity == nil) || r.CharacteristicQuantity != nil && !r.CharacteristicQuantity.Equal(*other.CharacteristicQuantity) {
+		return false
+	}
+	if len(r.Value) != len(other.Value) {
+		return false
+	}
+	for i := range r.Value {
+		if r.Value[i] != other.Value[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ValueElement, other.ValueElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) EqualsDeep(other MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.CharacteristicCodeableConcept == nil) != (other.CharacteristicCodeableConcept == nil) || r.CharacteristicCodeableConcept != nil && !r.CharacteristicCodeableConcept.EqualsDeep(*other.CharacteristicCodeableConcept) {
+		return false
+	}
+	if (r.CharacteristicQuantity == nil) != (other.CharacteristicQuantity == nil) || r.CharacteristicQuantity != nil && !r.CharacteristicQuantity.EqualsDeep(*other.CharacteristicQuantity) {
+		return false
+	}
+	if !equivalentList(len(r.Value), len(other.Value), func(i, j int) bool {
+		return equivalentString(r.Value[i], other.Value[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ValueElement, other.ValueElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) EqualsShallow(other MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Value), len(other.Value), func(i, j int) bool {
+		return equivalentString(r.Value[i], other.Value[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics as FHIR XML
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "characteristicCodeableConcept", r.CharacteristicCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "characteristicQuantity", r.CharacteristicQuantity); err != nil {
+		return err
+	}
+	for i, v := range r.Value {
+		if err := encodeXMLPrimitive(e, "value", v, elementAt(r.ValueElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics from FHIR XML
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "characteristicCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CharacteristicCodeableConcept = &v
+			case "characteristicQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CharacteristicQuantity = &v
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ValueElement = appendElement(r.ValueElement, len(r.Value), element)
+					r.Value = append(r.Value, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics as properties to the RDF node n
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.administrationGuidelines.patientCharacteristics.characteristicCodeableConcept", -1, r.CharacteristicCodeableConcept)
+	n.element("MedicationKnowledge.administrationGuidelines.patientCharacteristics.characteristicQuantity", -1, r.CharacteristicQuantity)
+	for i, v := range r.Value {
+		n.primitive("MedicationKnowledge.administrationGuidelines.patientCharacteristics.value", i, v, elementAt(r.ValueElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics as protobuf message MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics of fhir.proto
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics to the encoded message
+func (r MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.CharacteristicCodeableConcept != nil {
+		e.message(4, *r.CharacteristicCodeableConcept)
+	}
+	if r.CharacteristicQuantity != nil {
+		e.message(5, *r.CharacteristicQuantity)
+	}
+	for _, v := range r.Value {
+		e.string(6, v)
+	}
+	for _, v := range r.ValueElement {
+		e.element(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics from protobuf message MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics of fhir.proto
+func (r *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.CharacteristicCodeableConcept = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.CharacteristicQuantity = &v
+		case 6:
+			r.Value = append(r.Value, d.string())
+		case 7:
+			r.ValueElement = append(r.ValueElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeMedicineClassification as JSON into a byte slice
+func (r MedicationKnowledgeMedicineClassification) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeMedicineClassification as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeMedicineClassification) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if len(r.Classification) > 0 {
+		e.key("classification")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Classification {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeMedicineClassification from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeMedicineClassification) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeMedicineClassification
+func (r *MedicationKnowledgeMedicineClassification) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "classification":
+			a := d.array()
+			r.Classification = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Classification = append(r.Classification, v)
+			}
+			if r.Classification == nil && !a.isNull {
+				r.Classification = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeMedicineClassification which shares no memory with the original
+func (r MedicationKnowledgeMedicineClassification) DeepCopy() MedicationKnowledgeMedicineClassification {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Classification != nil {
+		out.Classification = make([]CodeableConcept, len(r.Classification))
+		for i := range r.Classification {
+			out.Classification[i] = r.Classification[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeMedicineClassification) Equal(other MedicationKnowledgeMedicineClassification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if len(r.Classification) != len(other.Classification) {
+		return false
+	}
+	for i := range r.Classification {
+		if !r.Classification[i].Equal(other.Classification[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeMedicineClassification) EqualsDeep(other MedicationKnowledgeMedicineClassification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Classification), len(other.Classification), func(i, j int) bool {
+		return r.Classification[i].EqualsDeep(other.Classification[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeMedicineClassification) EqualsShallow(other MedicationKnowledgeMedicineClassification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeMedicineClassification as FHIR XML
+func (r MedicationKnowledgeMedicineClassification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Classification {
+		if err := encodeXMLElement(e, "classification", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeMedicineClassification from FHIR XML
+func (r *MedicationKnowledgeMedicineClassification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "classification":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Classification = append(r.Classification, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeMedicineClassification as properties to the RDF node n
+func (r MedicationKnowledgeMedicineClassification) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.medicineClassification.type", -1, r.Type)
+	for i, v := range r.Classification {
+		n.element("MedicationKnowledge.medicineClassification.classification", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeMedicineClassification as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeMedicineClassification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeMedicineClassification from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeMedicineClassification) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeMedicineClassification as protobuf message MedicationKnowledgeMedicineClassification of fhir.proto
+func (r MedicationKnowledgeMedicineClassification) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeMedicineClassification to the encoded message
+func (r MedicationKnowledgeMedicineClassification) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	for _, v := range r.Classification {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeMedicineClassification from protobuf message MedicationKnowledgeMedicineClassification of fhir.proto
+func (r *MedicationKnowledgeMedicineClassification) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeMedicineClassification{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Classification = append(r.Classification, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgePackaging as JSON into a byte slice
+func (r MedicationKnowledgePackaging) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgePackaging as JSON object with the members in the order of the fields
+func (r MedicationKnowledgePackaging) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgePackaging from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgePackaging) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgePackaging
+func (r *MedicationKnowledgePackaging) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgePackaging which shares no memory with the original
+func (r MedicationKnowledgePackaging) DeepCopy() MedicationKnowledgePackaging {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgePackaging) Equal(other MedicationKnowledgePackaging) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgePackaging) EqualsDeep(other MedicationKnowledgePackaging) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgePackaging) EqualsShallow(other MedicationKnowledgePackaging) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgePackaging as FHIR XML
+func (r MedicationKnowledgePackaging) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgePackaging from FHIR XML
+func (r *MedicationKnowledgePackaging) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgePackaging as properties to the RDF node n
+func (r MedicationKnowledgePackaging) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.packaging.type", -1, r.Type)
+	n.element("MedicationKnowledge.packaging.quantity", -1, r.Quantity)
+}
+
+// MarshalBSON marshals the given MedicationKnowledgePackaging as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgePackaging) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgePackaging from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgePackaging) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgePackaging as protobuf message MedicationKnowledgePackaging of fhir.proto
+func (r MedicationKnowledgePackaging) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgePackaging to the encoded message
+func (r MedicationKnowledgePackaging) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Quantity != nil {
+		e.message(5, *r.Quantity)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgePackaging from protobuf message MedicationKnowledgePackaging of fhir.proto
+func (r *MedicationKnowledgePackaging) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgePackaging{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeDrugCharacteristic as JSON into a byte slice
+func (r MedicationKnowledgeDrugCharacteristic) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeDrugCharacteristic as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeDrugCharacteristic) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.key("valueCodeableConcept")
+		r.ValueCodeableConcept.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueBase64Binary != nil {
+		e.key("valueBase64Binary")
+		e.string(*r.ValueBase64Binary)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.key("_valueBase64Binary")
+		r.ValueBase64BinaryElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeDrugCharacteristic from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeDrugCharacteristic) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeDrugCharacteristic
+func (r *MedicationKnowledgeDrugCharacteristic) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "valueCodeableConcept":
+			if d.null() {
+				r.ValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCodeableConcept = &v
+			}
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueBase64Binary":
+			if v, ok := d.string(); ok {
+				r.ValueBase64Binary = &v
+			} else {
+				r.ValueBase64Binary = nil
+			}
+		case "_valueBase64Binary":
+			r.ValueBase64BinaryElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeDrugCharacteristic which shares no memory with the original
+func (r MedicationKnowledgeDrugCharacteristic) DeepCopy() MedicationKnowledgeDrugCharacteristic {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.ValueCodeableConcept != nil {
+		v := r.ValueCodeableConcept.DeepCopy()
+		out.ValueCodeableConcept = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueBase64Binary != nil {
+		v := *r.ValueBase64Binary
+		out.ValueBase64Binary = &v
+	}
+	if r.ValueBase64BinaryElement != nil {
+		v := r.ValueBase64BinaryElement.DeepCopy()
+		out.ValueBase64BinaryElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeDrugCharacteristic) Equal(other MedicationKnowledgeDrugCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.Equal(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && *r.ValueBase64Binary != *other.ValueBase64Binary {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.Equal(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeDrugCharacteristic) EqualsDeep(other MedicationKnowledgeDrugCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.EqualsDeep(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.EqualsDeep(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeDrugCharacteristic) EqualsShallow(other MedicationKnowledgeDrugCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeDrugCharacteristic as FHIR XML
+func (r MedicationKnowledgeDrugCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCodeableConcept", r.ValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBase64Binary", r.ValueBase64Binary, r.ValueBase64BinaryElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeDrugCharacteristic from FHIR XML
+func (r *MedicationKnowledgeDrugCharacteristic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "valueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCodeableConcept = &v
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBase64Binary = &v
+				}
+				r.ValueBase64BinaryElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeDrugCharacteristic as properties to the RDF node n
+func (r MedicationKnowledgeDrugCharacteristic) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.drugCharacteristic.type", -1, r.Type)
+	n.element("MedicationKnowledge.drugCharacteristic.valueCodeableConcept", -1, r.ValueCodeableConcept)
+	n.primitive("MedicationKnowledge.drugCharacteristic.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.element("MedicationKnowledge.drugCharacteristic.valueQuantity", -1, r.ValueQuantity)
+	n.primitive("MedicationKnowledge.drugCharacteristic.valueBase64Binary", -1, r.ValueBase64Binary, r.ValueBase64BinaryElement, "base64Binary")
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeDrugCharacteristic as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeDrugCharacteristic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeDrugCharacteristic from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeDrugCharacteristic) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeDrugCharacteristic as protobuf message MedicationKnowledgeDrugCharacteristic of fhir.proto
+func (r MedicationKnowledgeDrugCharacteristic) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeDrugCharacteristic to the encoded message
+func (r MedicationKnowledgeDrugCharacteristic) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.message(5, *r.ValueCodeableConcept)
+	}
+	if r.ValueString != nil {
+		e.string(6, *r.ValueString)
+	}
+	if r.ValueQuantity != nil {
+		e.message(7, *r.ValueQuantity)
+	}
+	if r.ValueBase64Binary != nil {
+		e.string(8, *r.ValueBase64Binary)
+	}
+	if r.ValueStringElement != nil {
+		e.message(9, *r.ValueStringElement)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.message(10, *r.ValueBase64BinaryElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeDrugCharacteristic from protobuf message MedicationKnowledgeDrugCharacteristic of fhir.proto
+func (r *MedicationKnowledgeDrugCharacteristic) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeDrugCharacteristic{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCodeableConcept = &v
+		case 6:
+			v := d.string()
+			r.ValueString = &v
+		case 7:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 8:
+			v := d.string()
+			r.ValueBase64Binary = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ValueBase64BinaryElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeRegulatory as JSON into a byte slice
+func (r MedicationKnowledgeRegulatory) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeRegulatory as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeRegulatory) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("regulatoryAuthority")
+	r.RegulatoryAuthority.appendJSON(e)
+	if len(r.Substitution) > 0 {
+		e.key("substitution")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Substitution {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Schedule) > 0 {
+		e.key("schedule")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Schedule {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MaxDispense != nil {
+		e.key("maxDispense")
+		r.MaxDispense.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeRegulatory from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeRegulatory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeRegulatory
+func (r *MedicationKnowledgeRegulatory) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "regulatoryAuthority":
+			r.RegulatoryAuthority.decodeJSON(d)
+		case "substitution":
+			a := d.array()
+			r.Substitution = nil
+			for a.next() {
+				var v MedicationKnowledgeRegulatorySubstitution
+				v.decodeJSON(d)
+				r.Substitution = append(r.Substitution, v)
+			}
+			if r.Substitution == nil && !a.isNull {
+				r.Substitution = []MedicationKnowledgeRegulatorySubstitution{}
+			}
+		case "schedule":
+			a := d.array()
+			r.Schedule = nil
+			for a.next() {
+				var v MedicationKnowledgeRegulatorySchedule
+				v.decodeJSON(d)
+				r.Schedule = append(r.Schedule, v)
+			}
+			if r.Schedule == nil && !a.isNull {
+				r.Schedule = []MedicationKnowledgeRegulatorySchedule{}
+			}
+		case "maxDispense":
+			if d.null() {
+				r.MaxDispense = nil
+			} else {
+				var v MedicationKnowledgeRegulatoryMaxDispense
+				v.decodeJSON(d)
+				r.MaxDispense = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeRegulatory which shares no memory with the original
+func (r MedicationKnowledgeRegulatory) DeepCopy() MedicationKnowledgeRegulatory {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.RegulatoryAuthority = r.RegulatoryAuthority.DeepCopy()
+	if r.Substitution != nil {
+		out.Substitution = make([]MedicationKnowledgeRegulatorySubstitution, len(r.Substitution))
+		for i := range r.Substitution {
+			out.Substitution[i] = r.Substitution[i].DeepCopy()
+		}
+	}
+	if r.Schedule != nil {
+		out.Schedule = make([]MedicationKnowledgeRegulatorySchedule, len(r.Schedule))
+		for i := range r.Schedule {
+			out.Schedule[i] = r.Schedule[i].DeepCopy()
+		}
+	}
+	if r.MaxDispense != nil {
+		v := r.MaxDispense.DeepCopy()
+		out.MaxDispense = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeRegulatory) Equal(other MedicationKnowledgeRegulatory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.RegulatoryAuthority.Equal(other.RegulatoryAuthority) {
+		return false
+	}
+	if len(r.Substitution) != len(other.Substitution) {
+		return false
+	}
+	for i := range r.Substitution {
+		if !r.Substitution[i].Equal(other.Substitution[i]) {
+			return false
+		}
+	}
+	if len(r.Schedule) != len(other.Schedule) {
+		return false
+	}
+	for i := range r.Schedule {
+		if !r.Schedule[i].Equal(other.Schedule[i]) {
+			return false
+		}
+	}
+	if (r.MaxDispense == nil) != (other.MaxDispense == nil) || r.MaxDispense != nil && !r.MaxDispense.Equal(*other.MaxDispense) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeRegulatory) EqualsDeep(other MedicationKnowledgeRegulatory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.RegulatoryAuthority.EqualsDeep(other.RegulatoryAuthority) {
+		return false
+	}
+	if !equivalentList(len(r.Substitution), len(other.Substitution), func(i, j int) bool {
+		return r.Substitution[i].EqualsDeep(other.Substitution[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Schedule), len(other.Schedule), func(i, j int) bool {
+		return r.Schedule[i].EqualsDeep(other.Schedule[j])
+	}) {
+		return false
+	}
+	if (r.MaxDispense == nil) != (other.MaxDispense == nil) || r.MaxDispense != nil && !r.MaxDispense.EqualsDeep(*other.MaxDispense) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeRegulatory) EqualsShallow(other MedicationKnowledgeRegulatory) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeRegulatory as FHIR XML
+func (r MedicationKnowledgeRegulatory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "regulatoryAuthority", r.RegulatoryAuthority); err != nil {
+		return err
+	}
+	for _, v := range r.Substitution {
+		if err := encodeXMLElement(e, "substitution", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Schedule {
+		if err := encodeXMLElement(e, "schedule", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "maxDispense", r.MaxDispense); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeRegulatory from FHIR XML
+func (r *MedicationKnowledgeRegulatory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "regulatoryAuthority":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RegulatoryAuthority = v
+			case "substitution":
+				var v MedicationKnowledgeRegulatorySubstitution
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Substitution = append(r.Substitution, v)
+			case "schedule":
+				var v MedicationKnowledgeRegulatorySchedule
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Schedule = append(r.Schedule, v)
+			case "maxDispense":
+				var v MedicationKnowledgeRegulatoryMaxDispense
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxDispense = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeRegulatory as properties to the RDF node n
+func (r MedicationKnowledgeRegulatory) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.regulatory.regulatoryAuthority", -1, r.RegulatoryAuthority)
+	for i, v := range r.Substitution {
+		n.element("MedicationKnowledge.regulatory.substitution", i, v)
+	}
+	for i, v := range r.Schedule {
+		n.element("MedicationKnowledge.regulatory.schedule", i, v)
+	}
+	n.element("MedicationKnowledge.regulatory.maxDispense", -1, r.MaxDispense)
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeRegulatory as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeRegulatory) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeRegulatory from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeRegulatory) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeRegulatory as protobuf message MedicationKnowledgeRegulatory of fhir.proto
+func (r MedicationKnowledgeRegulatory) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeRegulatory to the encoded message
+func (r MedicationKnowledgeRegulatory) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.RegulatoryAuthority)
+	for _, v := range r.Substitution {
+		e.message(5, v)
+	}
+	for _, v := range r.Schedule {
+		e.message(6, v)
+	}
+	if r.MaxDispense != nil {
+		e.message(7, *r.MaxDispense)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeRegulatory from protobuf message MedicationKnowledgeRegulatory of fhir.proto
+func (r *MedicationKnowledgeRegulatory) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeRegulatory{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.RegulatoryAuthority = v
+		case 5:
+			var v MedicationKnowledgeRegulatorySubstitution
+			d.message(&v)
+			r.Substitution = append(r.Substitution, v)
+		case 6:
+			var v MedicationKnowledgeRegulatorySchedule
+			d.message(&v)
+			r.Schedule = append(r.Schedule, v)
+		case 7:
+			var v MedicationKnowledgeRegulatoryMaxDispense
+			d.message(&v)
+			r.MaxDispense = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeRegulatorySubstitution as JSON into a byte slice
+func (r MedicationKnowledgeRegulatorySubstitution) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeRegulatorySubstitution as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeRegulatorySubstitution) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	e.key("allowed")
+	e.bool(r.Allowed)
+	if r.AllowedElement != nil {
+		e.key("_allowed")
+		r.AllowedElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeRegulatorySubstitution from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeRegulatorySubstitution) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeRegulatorySubstitution
+func (r *MedicationKnowledgeRegulatorySubstitution) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "allowed":
+			if v, ok := d.bool(); ok {
+				r.Allowed = v
+			}
+		case "_allowed":
+			r.AllowedElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeRegulatorySubstitution which shares no memory with the original
+func (r MedicationKnowledgeRegulatorySubstitution) DeepCopy() MedicationKnowledgeRegulatorySubstitution {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.AllowedElement != nil {
+		v := r.AllowedElement.DeepCopy()
+		out.AllowedElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeRegulatorySubstitution) Equal(other MedicationKnowledgeRegulatorySubstitution) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if r.Allowed != other.Allowed {
+		return false
+	}
+	if (r.AllowedElement == nil) != (other.AllowedElement == nil) || r.AllowedElement != nil && !r.AllowedElement.Equal(*other.AllowedElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeRegulatorySubstitution) EqualsDeep(other MedicationKnowledgeRegulatorySubstitution) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if r.Allowed != other.Allowed {
+		return false
+	}
+	if (r.AllowedElement == nil) != (other.AllowedElement == nil) || r.AllowedElement != nil && !r.AllowedElement.EqualsDeep(*other.AllowedElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeRegulatorySubstitution) EqualsShallow(other MedicationKnowledgeRegulatorySubstitution) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Allowed != other.Allowed {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeRegulatorySubstitution as FHIR XML
+func (r MedicationKnowledgeRegulatorySubstitution) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "allowed", r.Allowed, r.AllowedElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeRegulatorySubstitution from FHIR XML
+func (r *MedicationKnowledgeRegulatorySubstitution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "allowed":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Allowed = v
+				}
+				r.AllowedElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeRegulatorySubstitution as properties to the RDF node n
+func (r MedicationKnowledgeRegulatorySubstitution) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.regulatory.substitution.type", -1, r.Type)
+	n.primitive("MedicationKnowledge.regulatory.substitution.allowed", -1, r.Allowed, r.AllowedElement, "boolean")
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeRegulatorySubstitution as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeRegulatorySubstitution) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeRegulatorySubstitution from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeRegulatorySubstitution) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeRegulatorySubstitution as protobuf message MedicationKnowledgeRegulatorySubstitution of fhir.proto
+func (r MedicationKnowledgeRegulatorySubstitution) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeRegulatorySubstitution to the encoded message
+func (r MedicationKnowledgeRegulatorySubstitution) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.Allowed {
+		e.varint(5, protoBool(r.Allowed))
+	}
+	if r.AllowedElement != nil {
+		e.message(6, *r.AllowedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeRegulatorySubstitution from protobuf message MedicationKnowledgeRegulatorySubstitution of fhir.proto
+func (r *MedicationKnowledgeRegulatorySubstitution) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeRegulatorySubstitution{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			r.Allowed = d.bool()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.AllowedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeRegulatorySchedule as JSON into a byte slice
+func (r MedicationKnowledgeRegulatorySchedule) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeRegulatorySchedule as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeRegulatorySchedule) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("schedule")
+	r.Schedule.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeRegulatorySchedule from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeRegulatorySchedule) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeRegulatorySchedule
+func (r *MedicationKnowledgeRegulatorySchedule) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "schedule":
+			r.Schedule.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeRegulatorySchedule which shares no memory with the original
+func (r MedicationKnowledgeRegulatorySchedule) DeepCopy() MedicationKnowledgeRegulatorySchedule {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Schedule = r.Schedule.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeRegulatorySchedule) Equal(other MedicationKnowledgeRegulatorySchedule) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Schedule.Equal(other.Schedule) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeRegulatorySchedule) EqualsDeep(other MedicationKnowledgeRegulatorySchedule) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Schedule.EqualsDeep(other.Schedule) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeRegulatorySchedule) EqualsShallow(other MedicationKnowledgeRegulatorySchedule) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeRegulatorySchedule as FHIR XML
+func (r MedicationKnowledgeRegulatorySchedule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "schedule", r.Schedule); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeRegulatorySchedule from FHIR XML
+func (r *MedicationKnowledgeRegulatorySchedule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "schedule":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Schedule = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeRegulatorySchedule as properties to the RDF node n
+func (r MedicationKnowledgeRegulatorySchedule) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.regulatory.schedule.schedule", -1, r.Schedule)
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeRegulatorySchedule as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeRegulatorySchedule) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeRegulatorySchedule from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeRegulatorySchedule) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeRegulatorySchedule as protobuf message MedicationKnowledgeRegulatorySchedule of fhir.proto
+func (r MedicationKnowledgeRegulatorySchedule) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeRegulatorySchedule to the encoded message
+func (r MedicationKnowledgeRegulatorySchedule) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Schedule)
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeRegulatorySchedule from protobuf message MedicationKnowledgeRegulatorySchedule of fhir.proto
+func (r *MedicationKnowledgeRegulatorySchedule) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeRegulatorySchedule{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Schedule = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeRegulatoryMaxDispense as JSON into a byte slice
+func (r MedicationKnowledgeRegulatoryMaxDispense) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeRegulatoryMaxDispense as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeRegulatoryMaxDispense) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("quantity")
+	r.Quantity.appendJSON(e)
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeRegulatoryMaxDispense from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeRegulatoryMaxDispense) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeRegulatoryMaxDispense
+func (r *MedicationKnowledgeRegulatoryMaxDispense) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "quantity":
+			r.Quantity.decodeJSON(d)
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeRegulatoryMaxDispense which shares no memory with the original
+func (r MedicationKnowledgeRegulatoryMaxDispense) DeepCopy() MedicationKnowledgeRegulatoryMaxDispense {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Quantity = r.Quantity.DeepCopy()
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeRegulatoryMaxDispense) Equal(other MedicationKnowledgeRegulatoryMaxDispense) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Quantity.Equal(other.Quantity) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeRegulatoryMaxDispense) EqualsDeep(other MedicationKnowledgeRegulatoryMaxDispense) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Quantity.EqualsDeep(other.Quantity) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeRegulatoryMaxDispense) EqualsShallow(other MedicationKnowledgeRegulatoryMaxDispense) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeRegulatoryMaxDispense as FHIR XML
+func (r MedicationKnowledgeRegulatoryMaxDispense) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeRegulatoryMaxDispense from FHIR XML
+func (r *MedicationKnowledgeRegulatoryMaxDispense) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = v
+			case "period":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeRegulatoryMaxDispense as properties to the RDF node n
+func (r MedicationKnowledgeRegulatoryMaxDispense) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationKnowledge.regulatory.maxDispense.quantity", -1, r.Quantity)
+	n.element("MedicationKnowledge.regulatory.maxDispense.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeRegulatoryMaxDispense as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeRegulatoryMaxDispense) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeRegulatoryMaxDispense from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeRegulatoryMaxDispense) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeRegulatoryMaxDispense as protobuf message MedicationKnowledgeRegulatoryMaxDispense of fhir.proto
+func (r MedicationKnowledgeRegulatoryMaxDispense) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeRegulatoryMaxDispense to the encoded message
+func (r MedicationKnowledgeRegulatoryMaxDispense) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Quantity)
+	if r.Period != nil {
+		e.message(5, *r.Period)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeRegulatoryMaxDispense from protobuf message MedicationKnowledgeRegulatoryMaxDispense of fhir.proto
+func (r *MedicationKnowledgeRegulatoryMaxDispense) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeRegulatoryMaxDispense{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = v
+		case 5:
+			var v Duration
+			d.message(&v)
+			r.Period = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationKnowledgeKinetics as JSON into a byte slice
+func (r MedicationKnowledgeKinetics) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationKnowledgeKinetics as JSON object with the members in the order of the fields
+func (r MedicationKnowledgeKinetics) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AreaUnderCurve) > 0 {
+		e.key("areaUnderCurve")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AreaUnderCurve {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LethalDose50) > 0 {
+		e.key("lethalDose50")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LethalDose50 {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.HalfLifePeriod != nil {
+		e.key("halfLifePeriod")
+		r.HalfLifePeriod.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationKnowledgeKinetics from JSON. Unknown members are ignored.
+func (r *MedicationKnowledgeKinetics) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationKnowledgeKinetics
+func (r *MedicationKnowledgeKinetics) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "areaUnderCurve":
+			a := d.array()
+			r.AreaUnderCurve = nil
+			for a.next() {
+				var v Quantity
+				v.decodeJSON(d)
+				r.AreaUnderCurve = append(r.AreaUnderCurve, v)
+			}
+			if r.AreaUnderCurve == nil && !a.isNull {
+				r.AreaUnderCurve = []Quantity{}
+			}
+		case "lethalDose50":
+			a := d.array()
+			r.LethalDose50 = nil
+			for a.next() {
+				var v Quantity
+				v.decodeJSON(d)
+				r.LethalDose50 = append(r.LethalDose50, v)
+			}
+			if r.LethalDose50 == nil && !a.isNull {
+				r.LethalDose50 = []Quantity{}
+			}
+		case "halfLifePeriod":
+			if d.null() {
+				r.HalfLifePeriod = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.HalfLifePeriod = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationKnowledgeKinetics which shares no memory with the original
+func (r MedicationKnowledgeKinetics) DeepCopy() MedicationKnowledgeKinetics {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.AreaUnderCurve != nil {
+		out.AreaUnderCurve = make([]Quantity, len(r.AreaUnderCurve))
+		for i := range r.AreaUnderCurve {
+			out.AreaUnderCurve[i] = r.AreaUnderCurve[i].DeepCopy()
+		}
+	}
+	if r.LethalDose50 != nil {
+		out.LethalDose50 = make([]Quantity, len(r.LethalDose50))
+		for i := range r.LethalDose50 {
+			out.LethalDose50[i] = r.LethalDose50[i].DeepCopy()
+		}
+	}
+	if r.HalfLifePeriod != nil {
+		v := r.HalfLifePeriod.DeepCopy()
+		out.HalfLifePeriod = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationKnowledgeKinetics) Equal(other MedicationKnowledgeKinetics) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.AreaUnderCurve) != len(other.AreaUnderCurve) {
+		return false
+	}
+	for i := range r.AreaUnderCurve {
+		if !r.AreaUnderCurve[i].Equal(other.AreaUnderCurve[i]) {
+			return false
+		}
+	}
+	if len(r.LethalDose50) != len(other.LethalDose50) {
+		return false
+	}
+	for i := range r.LethalDose50 {
+		if !r.LethalDose50[i].Equal(other.LethalDose50[i]) {
+			return false
+		}
+	}
+	if (r.HalfLifePeriod == nil) != (other.HalfLifePeriod == nil) || r.HalfLifePeriod != nil && !r.HalfLifePeriod.Equal(*other.HalfLifePeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationKnowledgeKinetics) EqualsDeep(other MedicationKnowledgeKinetics) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.AreaUnderCurve), len(other.AreaUnderCurve), func(i, j int) bool {
+		return r.AreaUnderCurve[i].EqualsDeep(other.AreaUnderCurve[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.LethalDose50), len(other.LethalDose50), func(i, j int) bool {
+		return r.LethalDose50[i].EqualsDeep(other.LethalDose50[j])
+	}) {
+		return false
+	}
+	if (r.HalfLifePeriod == nil) != (other.HalfLifePeriod == nil) || r.HalfLifePeriod != nil && !r.HalfLifePeriod.EqualsDeep(*other.HalfLifePeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationKnowledgeKinetics) EqualsShallow(other MedicationKnowledgeKinetics) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationKnowledgeKinetics as FHIR XML
+func (r MedicationKnowledgeKinetics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.AreaUnderCurve {
+		if err := encodeXMLElement(e, "areaUnderCurve", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.LethalDose50 {
+		if err := encodeXMLElement(e, "lethalDose50", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "halfLifePeriod", r.HalfLifePeriod); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationKnowledgeKinetics from FHIR XML
+func (r *MedicationKnowledgeKinetics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "areaUnderCurve":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AreaUnderCurve = append(r.AreaUnderCurve, v)
+			case "lethalDose50":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LethalDose50 = append(r.LethalDose50, v)
+			case "halfLifePeriod":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.HalfLifePeriod = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationKnowledgeKinetics as properties to the RDF node n
+func (r MedicationKnowledgeKinetics) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.AreaUnderCurve {
+		n.element("MedicationKnowledge.kinetics.areaUnderCurve", i, v)
+	}
+	for i, v := range r.LethalDose50 {
+		n.element("MedicationKnowledge.kinetics.lethalDose50", i, v)
+	}
+	n.element("MedicationKnowledge.kinetics.halfLifePeriod", -1, r.HalfLifePeriod)
+}
+
+// MarshalBSON marshals the given MedicationKnowledgeKinetics as BSON document with the structure of its FHIR JSON
+func (r MedicationKnowledgeKinetics) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationKnowledgeKinetics from a BSON document with the structure of its FHIR JSON
+func (r *MedicationKnowledgeKinetics) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationKnowledgeKinetics as protobuf message MedicationKnowledgeKinetics of fhir.proto
+func (r MedicationKnowledgeKinetics) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationKnowledgeKinetics to the encoded message
+func (r MedicationKnowledgeKinetics) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.AreaUnderCurve {
+		e.message(4, v)
+	}
+	for _, v := range r.LethalDose50 {
+		e.message(5, v)
+	}
+	if r.HalfLifePeriod != nil {
+		e.message(6, *r.HalfLifePeriod)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationKnowledgeKinetics from protobuf message MedicationKnowledgeKinetics of fhir.proto
+func (r *MedicationKnowledgeKinetics) UnmarshalProto(b []byte) error {
+	*r = MedicationKnowledgeKinetics{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.AreaUnderCurve = append(r.AreaUnderCurve, v)
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.LethalDose50 = append(r.LethalDose50, v)
+		case 6:
+			var v Duration
+			d.message(&v)
+			r.HalfLifePeriod = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicationKnowledge unmarshals a MedicationKnowledge.
 func UnmarshalMedicationKnowledge(b []byte) (MedicationKnowledge, error) {
 	var medicationKnowledge MedicationKnowledge
-	if err := json.Unmarshal(b, &medicationKnowledge); err != nil {
+	if err := medicationKnowledge.UnmarshalJSON(b); err != nil {
 		return medicationKnowledge, err
 	}
 	return medicationKnowledge, nil
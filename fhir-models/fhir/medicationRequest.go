@@ -14,66 +14,154 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MedicationRequest is documented here http://hl7.org/fhir/StructureDefinition/MedicationRequest
 type MedicationRequest struct {
-	Id                        *string                           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                      *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules             *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                  *string                           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                      *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension                 []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension         []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier                []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status                    string                            `bson:"status" json:"status"`
-	StatusReason              *CodeableConcept                  `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Intent                    string                            `bson:"intent" json:"intent"`
-	Category                  []CodeableConcept                 `bson:"category,omitempty" json:"category,omitempty"`
-	Priority                  *RequestPriority                  `bson:"priority,omitempty" json:"priority,omitempty"`
-	DoNotPerform              *bool                             `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	ReportedBoolean           *bool                             `bson:"reportedBoolean,omitempty" json:"reportedBoolean,omitempty"`
-	ReportedReference         *Reference                        `bson:"reportedReference,omitempty" json:"reportedReference,omitempty"`
-	MedicationCodeableConcept CodeableConcept                   `bson:"medicationCodeableConcept" json:"medicationCodeableConcept"`
-	MedicationReference       Reference                         `bson:"medicationReference" json:"medicationReference"`
-	Subject                   Reference                         `bson:"subject" json:"subject"`
-	Encounter                 *Reference                        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	SupportingInformation     []Reference                       `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
-	AuthoredOn                *string                           `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Requester                 *Reference                        `bson:"requester,omitempty" json:"requester,omitempty"`
-	Performer                 *Reference                        `bson:"performer,omitempty" json:"performer,omitempty"`
-	PerformerType             *CodeableConcept                  `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	Recorder                  *Reference                        `bson:"recorder,omitempty" json:"recorder,omitempty"`
-	ReasonCode                []CodeableConcept                 `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference           []Reference                       `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	InstantiatesCanonical     []string                          `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri           []string                          `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn                   []Reference                       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	GroupIdentifier           *Identifier                       `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	CourseOfTherapyType       *CodeableConcept                  `bson:"courseOfTherapyType,omitempty" json:"courseOfTherapyType,omitempty"`
-	Insurance                 []Reference                       `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	Note                      []Annotation                      `bson:"note,omitempty" json:"note,omitempty"`
-	DosageInstruction         []Dosage                          `bson:"dosageInstruction,omitempty" json:"dosageInstruction,omitempty"`
-	DispenseRequest           *MedicationRequestDispenseRequest `bson:"dispenseRequest,omitempty" json:"dispenseRequest,omitempty"`
-	Substitution              *MedicationRequestSubstitution    `bson:"substitution,omitempty" json:"substitution,omitempty"`
-	PriorPrescription         *Reference                        `bson:"priorPrescription,omitempty" json:"priorPrescription,omitempty"`
-	DetectedIssue             []Reference                       `bson:"detectedIssue,omitempty" json:"detectedIssue,omitempty"`
-	EventHistory              []Reference                       `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
+	Id                           *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element                          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status                       string                            `bson:"status" json:"status"`
+	StatusElement                *Element                          `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept                  `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Intent                       string                            `bson:"intent" json:"intent"`
+	IntentElement                *Element                          `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Category                     []CodeableConcept                 `bson:"category,omitempty" json:"category,omitempty"`
+	Priority                     *RequestPriority                  `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element                          `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	DoNotPerform                 *bool                             `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement          *Element                          `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	ReportedBoolean              *bool                             `bson:"reportedBoolean,omitempty" json:"reportedBoolean,omitempty"`
+	ReportedBooleanElement       *Element                          `bson:"_reportedBoolean,omitempty" json:"_reportedBoolean,omitempty"`
+	ReportedReference            *Reference                        `bson:"reportedReference,omitempty" json:"reportedReference,omitempty"`
+	MedicationCodeableConcept    *CodeableConcept                  `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference          *Reference                        `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
+	Subject                      Reference                         `bson:"subject" json:"subject"`
+	Encounter                    *Reference                        `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	SupportingInformation        []Reference                       `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	AuthoredOn                   *string                           `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element                          `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Requester                    *Reference                        `bson:"requester,omitempty" json:"requester,omitempty"`
+	Performer                    *Reference                        `bson:"performer,omitempty" json:"performer,omitempty"`
+	PerformerType                *CodeableConcept                  `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	Recorder                     *Reference                        `bson:"recorder,omitempty" json:"recorder,omitempty"`
+	ReasonCode                   []CodeableConcept                 `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference                       `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	InstantiatesCanonical        []string                          `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                        `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string                          `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                        `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference                       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	GroupIdentifier              *Identifier                       `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	CourseOfTherapyType          *CodeableConcept                  `bson:"courseOfTherapyType,omitempty" json:"courseOfTherapyType,omitempty"`
+	Insurance                    []Reference                       `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	Note                         []Annotation                      `bson:"note,omitempty" json:"note,omitempty"`
+	DosageInstruction            []Dosage                          `bson:"dosageInstruction,omitempty" json:"dosageInstruction,omitempty"`
+	DispenseRequest              *MedicationRequestDispenseRequest `bson:"dispenseRequest,omitempty" json:"dispenseRequest,omitempty"`
+	Substitution                 *MedicationRequestSubstitution    `bson:"substitution,omitempty" json:"substitution,omitempty"`
+	PriorPrescription            *Reference                        `bson:"priorPrescription,omitempty" json:"priorPrescription,omitempty"`
+	DetectedIssue                []Reference                       `bson:"detectedIssue,omitempty" json:"detectedIssue,omitempty"`
+	EventHistory                 []Reference                       `bson:"eventHistory,omitempty" json:"eventHistory,omitempty"`
 }
+
+// MedicationRequestReported is implemented by the types allowed for MedicationRequest.reported[x]
+type MedicationRequestReported interface {
+	isMedicationRequestReported()
+}
+
+func (Boolean) isMedicationRequestReported()   {}
+func (Reference) isMedicationRequestReported() {}
+
+// Reported returns the value of MedicationRequest.reported[x] together with its FHIR type code or nil if no value is set
+func (r MedicationRequest) Reported() (MedicationRequestReported, string) {
+	if r.ReportedBoolean != nil {
+		return Boolean(*r.ReportedBoolean), "boolean"
+	}
+	if r.ReportedReference != nil {
+		return *r.ReportedReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetReported sets the value of MedicationRequest.reported[x], given as value or pointer, and clears all other types
+func (r *MedicationRequest) SetReported(value MedicationRequestReported) {
+	r.ReportedBoolean = nil
+	r.ReportedReference = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.ReportedBoolean = &primitive
+	case *Boolean:
+		r.ReportedBoolean = (*bool)(v)
+	case Reference:
+		r.ReportedReference = &v
+	case *Reference:
+		r.ReportedReference = v
+	}
+}
+
+// MedicationRequestMedication is implemented by the types allowed for MedicationRequest.medication[x]
+type MedicationRequestMedication interface {
+	isMedicationRequestMedication()
+}
+
+func (CodeableConcept) isMedicationRequestMedication() {}
+func (Reference) isMedicationRequestMedication()       {}
+
+// Medication returns the value of MedicationRequest.medication[x] together with its FHIR type code or nil if no value is set
+func (r MedicationRequest) Medication() (MedicationRequestMedication, string) {
+	if r.MedicationCodeableConcept != nil {
+		return *r.MedicationCodeableConcept, "CodeableConcept"
+	}
+	if r.MedicationReference != nil {
+		return *r.MedicationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetMedication sets the value of MedicationRequest.medication[x], given as value or pointer, and clears all other types
+func (r *MedicationRequest) SetMedication(value MedicationRequestMedication) {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.MedicationCodeableConcept = &v
+	case *CodeableConcept:
+		r.MedicationCodeableConcept = v
+	case Reference:
+		r.MedicationReference = &v
+	case *Reference:
+		r.MedicationReference = v
+	}
+}
+
 type MedicationRequestDispenseRequest struct {
-	Id                     *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	InitialFill            *MedicationRequestDispenseRequestInitialFill `bson:"initialFill,omitempty" json:"initialFill,omitempty"`
-	DispenseInterval       *Duration                                    `bson:"dispenseInterval,omitempty" json:"dispenseInterval,omitempty"`
-	ValidityPeriod         *Period                                      `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
-	NumberOfRepeatsAllowed *int                                         `bson:"numberOfRepeatsAllowed,omitempty" json:"numberOfRepeatsAllowed,omitempty"`
-	Quantity               *Quantity                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	ExpectedSupplyDuration *Duration                                    `bson:"expectedSupplyDuration,omitempty" json:"expectedSupplyDuration,omitempty"`
-	Performer              *Reference                                   `bson:"performer,omitempty" json:"performer,omitempty"`
+	Id                            *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                     []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	InitialFill                   *MedicationRequestDispenseRequestInitialFill `bson:"initialFill,omitempty" json:"initialFill,omitempty"`
+	DispenseInterval              *Duration                                    `bson:"dispenseInterval,omitempty" json:"dispenseInterval,omitempty"`
+	ValidityPeriod                *Period                                      `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
+	NumberOfRepeatsAllowed        *int                                         `bson:"numberOfRepeatsAllowed,omitempty" json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowedElement *Element                                     `bson:"_numberOfRepeatsAllowed,omitempty" json:"_numberOfRepeatsAllowed,omitempty"`
+	Quantity                      *Quantity                                    `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ExpectedSupplyDuration        *Duration                                    `bson:"expectedSupplyDuration,omitempty" json:"expectedSupplyDuration,omitempty"`
+	Performer                     *Reference                                   `bson:"performer,omitempty" json:"performer,omitempty"`
 }
 type MedicationRequestDispenseRequestInitialFill struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
@@ -86,27 +174,3810 @@ type MedicationRequestSubstitution struct {
 	Id                     *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension              []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension      []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	AllowedBoolean         bool             `bson:"allowedBoolean" json:"allowedBoolean"`
-	AllowedCodeableConcept CodeableConcept  `bson:"allowedCodeableConcept" json:"allowedCodeableConcept"`
+	AllowedBoolean         *bool            `bson:"allowedBoolean,omitempty" json:"allowedBoolean,omitempty"`
+	AllowedBooleanElement  *Element         `bson:"_allowedBoolean,omitempty" json:"_allowedBoolean,omitempty"`
+	AllowedCodeableConcept *CodeableConcept `bson:"allowedCodeableConcept,omitempty" json:"allowedCodeableConcept,omitempty"`
 	Reason                 *CodeableConcept `bson:"reason,omitempty" json:"reason,omitempty"`
 }
-type OtherMedicationRequest MedicationRequest
+
+// MedicationRequestSubstitutionAllowed is implemented by the types allowed for MedicationRequest.substitution.allowed[x]
+type MedicationRequestSubstitutionAllowed interface {
+	isMedicationRequestSubstitutionAllowed()
+}
+
+func (Boolean) isMedicationRequestSubstitutionAllowed()         {}
+func (CodeableConcept) isMedicationRequestSubstitutionAllowed() {}
+
+// Allowed returns the value of MedicationRequest.substitution.allowed[x] together with its FHIR type code or nil if no value is set
+func (r MedicationRequestSubstitution) Allowed() (MedicationRequestSubstitutionAllowed, string) {
+	if r.AllowedBoolean != nil {
+		return Boolean(*r.AllowedBoolean), "boolean"
+	}
+	if r.AllowedCodeableConcept != nil {
+		return *r.AllowedCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetAllowed sets the value of MedicationRequest.substitution.allowed[x], given as value or pointer, and clears all other types
+func (r *MedicationRequestSubstitution) SetAllowed(value MedicationRequestSubstitutionAllowed) {
+	r.AllowedBoolean = nil
+	r.AllowedCodeableConcept = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.AllowedBoolean = &primitive
+	case *Boolean:
+		r.AllowedBoolean = (*bool)(v)
+	case CodeableConcept:
+		r.AllowedCodeableConcept = &v
+	case *CodeableConcept:
+		r.AllowedCodeableConcept = v
+	}
+}
 
 // MarshalJSON marshals the given MedicationRequest as JSON into a byte slice
 func (r MedicationRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicationRequest
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicationRequest: OtherMedicationRequest(r),
-		ResourceType:           "MedicationRequest",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationRequest as JSON object with the members in the order of the fields
+func (r MedicationRequest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicationRequest\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status)
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusReason != nil {
+		e.key("statusReason")
+		r.StatusReason.appendJSON(e)
+	}
+	e.key("intent")
+	e.string(r.Intent)
+	if r.IntentElement != nil {
+		e.key("_intent")
+		r.IntentElement.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if r.DoNotPerform != nil {
+		e.key("doNotPerform")
+		e.bool(*r.DoNotPerform)
+	}
+	if r.DoNotPerformElement != nil {
+		e.key("_doNotPerform")
+		r.DoNotPerformElement.appendJSON(e)
+	}
+	if r.ReportedBoolean != nil {
+		e.key("reportedBoolean")
+		e.bool(*r.ReportedBoolean)
+	}
+	if r.ReportedBooleanElement != nil {
+		e.key("_reportedBoolean")
+		r.ReportedBooleanElement.appendJSON(e)
+	}
+	if r.ReportedReference != nil {
+		e.key("reportedReference")
+		r.ReportedReference.appendJSON(e)
+	}
+	if r.MedicationCodeableConcept != nil {
+		e.key("medicationCodeableConcept")
+		r.MedicationCodeableConcept.appendJSON(e)
+	}
+	if r.MedicationReference != nil {
+		e.key("medicationReference")
+		r.MedicationReference.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if len(r.SupportingInformation) > 0 {
+		e.key("supportingInformation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInformation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AuthoredOn != nil {
+		e.key("authoredOn")
+		e.string(*r.AuthoredOn)
+	}
+	if r.AuthoredOnElement != nil {
+		e.key("_authoredOn")
+		r.AuthoredOnElement.appendJSON(e)
+	}
+	if r.Requester != nil {
+		e.key("requester")
+		r.Requester.appendJSON(e)
+	}
+	if r.Performer != nil {
+		e.key("performer")
+		r.Performer.appendJSON(e)
+	}
+	if r.PerformerType != nil {
+		e.key("performerType")
+		r.PerformerType.appendJSON(e)
+	}
+	if r.Recorder != nil {
+		e.key("recorder")
+		r.Recorder.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.GroupIdentifier != nil {
+		e.key("groupIdentifier")
+		r.GroupIdentifier.appendJSON(e)
+	}
+	if r.CourseOfTherapyType != nil {
+		e.key("courseOfTherapyType")
+		r.CourseOfTherapyType.appendJSON(e)
+	}
+	if len(r.Insurance) > 0 {
+		e.key("insurance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Insurance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DosageInstruction) > 0 {
+		e.key("dosageInstruction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DosageInstruction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DispenseRequest != nil {
+		e.key("dispenseRequest")
+		r.DispenseRequest.appendJSON(e)
+	}
+	if r.Substitution != nil {
+		e.key("substitution")
+		r.Substitution.appendJSON(e)
+	}
+	if r.PriorPrescription != nil {
+		e.key("priorPrescription")
+		r.PriorPrescription.appendJSON(e)
+	}
+	if len(r.DetectedIssue) > 0 {
+		e.key("detectedIssue")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DetectedIssue {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.EventHistory) > 0 {
+		e.key("eventHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.EventHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationRequest from JSON. Unknown members are ignored.
+func (r *MedicationRequest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationRequest
+func (r *MedicationRequest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			if v, ok := d.string(); ok {
+				r.Status = v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			if d.null() {
+				r.StatusReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = &v
+			}
+		case "intent":
+			if v, ok := d.string(); ok {
+				r.Intent = v
+			}
+		case "_intent":
+			r.IntentElement = d.element()
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "doNotPerform":
+			if v, ok := d.bool(); ok {
+				r.DoNotPerform = &v
+			} else {
+				r.DoNotPerform = nil
+			}
+		case "_doNotPerform":
+			r.DoNotPerformElement = d.element()
+		case "reportedBoolean":
+			if v, ok := d.bool(); ok {
+				r.ReportedBoolean = &v
+			} else {
+				r.ReportedBoolean = nil
+			}
+		case "_reportedBoolean":
+			r.ReportedBooleanElement = d.element()
+		case "reportedReference":
+			if d.null() {
+				r.ReportedReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReportedReference = &v
+			}
+		case "medicationCodeableConcept":
+			if d.null() {
+				r.MedicationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.MedicationCodeableConcept = &v
+			}
+		case "medicationReference":
+			if d.null() {
+				r.MedicationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.MedicationReference = &v
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "supportingInformation":
+			a := d.array()
+			r.SupportingInformation = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			}
+			if r.SupportingInformation == nil && !a.isNull {
+				r.SupportingInformation = []Reference{}
+			}
+		case "authoredOn":
+			if v, ok := d.string(); ok {
+				r.AuthoredOn = &v
+			} else {
+				r.AuthoredOn = nil
+			}
+		case "_authoredOn":
+			r.AuthoredOnElement = d.element()
+		case "requester":
+			if d.null() {
+				r.Requester = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Requester = &v
+			}
+		case "performer":
+			if d.null() {
+				r.Performer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = &v
+			}
+		case "performerType":
+			if d.null() {
+				r.PerformerType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PerformerType = &v
+			}
+		case "recorder":
+			if d.null() {
+				r.Recorder = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recorder = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "groupIdentifier":
+			if d.null() {
+				r.GroupIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.GroupIdentifier = &v
+			}
+		case "courseOfTherapyType":
+			if d.null() {
+				r.CourseOfTherapyType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CourseOfTherapyType = &v
+			}
+		case "insurance":
+			a := d.array()
+			r.Insurance = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Insurance = append(r.Insurance, v)
+			}
+			if r.Insurance == nil && !a.isNull {
+				r.Insurance = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "dosageInstruction":
+			a := d.array()
+			r.DosageInstruction = nil
+			for a.next() {
+				var v Dosage
+				v.decodeJSON(d)
+				r.DosageInstruction = append(r.DosageInstruction, v)
+			}
+			if r.DosageInstruction == nil && !a.isNull {
+				r.DosageInstruction = []Dosage{}
+			}
+		case "dispenseRequest":
+			if d.null() {
+				r.DispenseRequest = nil
+			} else {
+				var v MedicationRequestDispenseRequest
+				v.decodeJSON(d)
+				r.DispenseRequest = &v
+			}
+		case "substitution":
+			if d.null() {
+				r.Substitution = nil
+			} else {
+				var v MedicationRequestSubstitution
+				v.decodeJSON(d)
+				r.Substitution = &v
+			}
+		case "priorPrescription":
+			if d.null() {
+				r.PriorPrescription = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.PriorPrescription = &v
+			}
+		case "detectedIssue":
+			a := d.array()
+			r.DetectedIssue = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.DetectedIssue = append(r.DetectedIssue, v)
+			}
+			if r.DetectedIssue == nil && !a.isNull {
+				r.DetectedIssue = []Reference{}
+			}
+		case "eventHistory":
+			a := d.array()
+			r.EventHistory = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.EventHistory = append(r.EventHistory, v)
+			}
+			if r.EventHistory == nil && !a.isNull {
+				r.EventHistory = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationRequest which shares no memory with the original
+func (r MedicationRequest) DeepCopy() MedicationRequest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		v := r.StatusReason.DeepCopy()
+		out.StatusReason = &v
+	}
+	if r.IntentElement != nil {
+		v := r.IntentElement.DeepCopy()
+		out.IntentElement = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.DoNotPerform != nil {
+		v := *r.DoNotPerform
+		out.DoNotPerform = &v
+	}
+	if r.DoNotPerformElement != nil {
+		v := r.DoNotPerformElement.DeepCopy()
+		out.DoNotPerformElement = &v
+	}
+	if r.ReportedBoolean != nil {
+		v := *r.ReportedBoolean
+		out.ReportedBoolean = &v
+	}
+	if r.ReportedBooleanElement != nil {
+		v := r.ReportedBooleanElement.DeepCopy()
+		out.ReportedBooleanElement = &v
+	}
+	if r.ReportedReference != nil {
+		v := r.ReportedReference.DeepCopy()
+		out.ReportedReference = &v
+	}
+	if r.MedicationCodeableConcept != nil {
+		v := r.MedicationCodeableConcept.DeepCopy()
+		out.MedicationCodeableConcept = &v
+	}
+	if r.MedicationReference != nil {
+		v := r.MedicationReference.DeepCopy()
+		out.MedicationReference = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.SupportingInformation != nil {
+		out.SupportingInformation = make([]Reference, len(r.SupportingInformation))
+		for i := range r.SupportingInformation {
+			out.SupportingInformation[i] = r.SupportingInformation[i].DeepCopy()
+		}
+	}
+	if r.AuthoredOn != nil {
+		v := *r.AuthoredOn
+		out.AuthoredOn = &v
+	}
+	if r.AuthoredOnElement != nil {
+		v := r.AuthoredOnElement.DeepCopy()
+		out.AuthoredOnElement = &v
+	}
+	if r.Requester != nil {
+		v := r.Requester.DeepCopy()
+		out.Requester = &v
+	}
+	if r.Performer != nil {
+		v := r.Performer.DeepCopy()
+		out.Performer = &v
+	}
+	if r.PerformerType != nil {
+		v := r.PerformerType.DeepCopy()
+		out.PerformerType = &v
+	}
+	if r.Recorder != nil {
+		v := r.Recorder.DeepCopy()
+		out.Recorder = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.GroupIdentifier != nil {
+		v := r.GroupIdentifier.DeepCopy()
+		out.GroupIdentifier = &v
+	}
+	if r.CourseOfTherapyType != nil {
+		v := r.CourseOfTherapyType.DeepCopy()
+		out.CourseOfTherapyType = &v
+	}
+	if r.Insurance != nil {
+		out.Insurance = make([]Reference, len(r.Insurance))
+		for i := range r.Insurance {
+			out.Insurance[i] = r.Insurance[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.DosageInstruction != nil {
+		out.DosageInstruction = make([]Dosage, len(r.DosageInstruction))
+		for i := range r.DosageInstruction {
+			out.DosageInstruction[i] = r.DosageInstruction[i].DeepCopy()
+		}
+	}
+	if r.DispenseRequest != nil {
+		v := r.DispenseRequest.DeepCopy()
+		out.DispenseRequest = &v
+	}
+	if r.Substitution != nil {
+		v := r.Substitution.DeepCopy()
+		out.Substitution = &v
+	}
+	if r.PriorPrescription != nil {
+		v := r.PriorPrescription.DeepCopy()
+		out.PriorPrescription = &v
+	}
+	if r.DetectedIssue != nil {
+		out.DetectedIssue = make([]Reference, len(r.DetectedIssue))
+		for i := range r.DetectedIssue {
+			out.DetectedIssue[i] = r.DetectedIssue[i].DeepCopy()
+		}
+	}
+	if r.EventHistory != nil {
+		out.EventHistory = make([]Reference, len(r.EventHistory))
+		for i := range r.EventHistory {
+			out.EventHistory[i] = r.EventHistory[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationRequest) Equal(other MedicationRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.Equal(*other.StatusReason) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.Equal(*other.IntentElement) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.Equal(*other.DoNotPerformElement) {
+		return false
+	}
+	if (r.ReportedBoolean == nil) != (other.ReportedBoolean == nil) || r.ReportedBoolean != nil && *r.ReportedBoolean != *other.ReportedBoolean {
+		return false
+	}
+	if (r.ReportedBooleanElement == nil) != (other.ReportedBooleanElement == nil) || r.ReportedBooleanElement != nil && !r.ReportedBooleanElement.Equal(*other.ReportedBooleanElement) {
+		return false
+	}
+	if (r.ReportedReference == nil) != (other.ReportedReference == nil) || r.ReportedReference != nil && !r.ReportedReference.Equal(*other.ReportedReference) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.Equal(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.Equal(*other.MedicationReference) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if len(r.SupportingInformation) != len(other.SupportingInformation) {
+		return false
+	}
+	for i := range r.SupportingInformation {
+		if !r.SupportingInformation[i].Equal(other.SupportingInformation[i]) {
+			return false
+		}
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && *r.AuthoredOn != *other.AuthoredOn {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.Equal(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.Equal(*other.Requester) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.Equal(*other.Performer) {
+		return false
+	}
+	if (r.PerformerType == nil) != (other.PerformerType == nil) || r.PerformerType != nil && !r.PerformerType.Equal(*other.PerformerType) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.Equal(*other.Recorder) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.Equal(*other.GroupIdentifier) {
+		return false
+	}
+	if (r.CourseOfTherapyType == nil) != (other.CourseOfTherapyType == nil) || r.CourseOfTherapyType != nil && !r.CourseOfTherapyType.Equal(*other.CourseOfTherapyType) {
+		return false
+	}
+	if len(r.Insurance) != len(other.Insurance) {
+		return false
+	}
+	for i := range r.Insurance {
+		if !r.Insurance[i].Equal(other.Insurance[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.DosageInstruction) != len(other.DosageInstruction) {
+		return false
+	}
+	for i := range r.DosageInstruction {
+		if !r.DosageInstruction[i].Equal(other.DosageInstruction[i]) {
+			return false
+		}
+	}
+	if (r.DispenseRequest == nil) != (other.DispenseRequest == nil) || r.DispenseRequest != nil && !r.DispenseRequest.Equal(*other.DispenseRequest) {
+		return false
+	}
+	if (r.Substitution == nil) != (other.Substitution == nil) || r.Substitution != nil && !r.Substitution.Equal(*other.Substitution) {
+		return false
+	}
+	if (r.PriorPrescription == nil) != (other.PriorPrescription == nil) || r.PriorPrescription != nil && !r.PriorPrescription.Equal(*other.PriorPrescription) {
+		return false
+	}
+	if len(r.DetectedIssue) != len(other.DetectedIssue) {
+		return false
+	}
+	for i := range r.DetectedIssue {
+		if !r.DetectedIssue[i].Equal(other.DetectedIssue[i]) {
+			return false
+		}
+	}
+	if len(r.EventHistory) != len(other.EventHistory) {
+		return false
+	}
+	for i := range r.EventHistory {
+		if !r.EventHistory[i].Equal(other.EventHistory[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationRequest) EqualsDeep(other MedicationRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.EqualsDeep(*other.StatusReason) {
+		return false
+	}
+	if !equivalentString(r.Intent, other.Intent) {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.EqualsDeep(*other.IntentElement) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.EqualsDeep(*other.DoNotPerformElement) {
+		return false
+	}
+	if (r.ReportedBoolean == nil) != (other.ReportedBoolean == nil) || r.ReportedBoolean != nil && *r.ReportedBoolean != *other.ReportedBoolean {
+		return false
+	}
+	if (r.ReportedBooleanElement == nil) != (other.ReportedBooleanElement == nil) || r.ReportedBooleanElement != nil && !r.ReportedBooleanElement.EqualsDeep(*other.ReportedBooleanElement) {
+		return false
+	}
+	if (r.ReportedReference == nil) != (other.ReportedReference == nil) || r.ReportedReference != nil && !r.ReportedReference.EqualsDeep(*other.ReportedReference) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.EqualsDeep(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.EqualsDeep(*other.MedicationReference) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInformation), len(other.SupportingInformation), func(i, j int) bool {
+		return r.SupportingInformation[i].EqualsDeep(other.SupportingInformation[j])
+	}) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.EqualsDeep(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.EqualsDeep(*other.Requester) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.EqualsDeep(*other.Performer) {
+		return false
+	}
+	if (r.PerformerType == nil) != (other.PerformerType == nil) || r.PerformerType != nil && !r.PerformerType.EqualsDeep(*other.PerformerType) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.EqualsDeep(*other.Recorder) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.EqualsDeep(*other.GroupIdentifier) {
+		return false
+	}
+	if (r.CourseOfTherapyType == nil) != (other.CourseOfTherapyType == nil) || r.CourseOfTherapyType != nil && !r.CourseOfTherapyType.EqualsDeep(*other.CourseOfTherapyType) {
+		return false
+	}
+	if !equivalentList(len(r.Insurance), len(other.Insurance), func(i, j int) bool {
+		return r.Insurance[i].EqualsDeep(other.Insurance[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DosageInstruction), len(other.DosageInstruction), func(i, j int) bool {
+		return r.DosageInstruction[i].EqualsDeep(other.DosageInstruction[j])
+	}) {
+		return false
+	}
+	if (r.DispenseRequest == nil) != (other.DispenseRequest == nil) || r.DispenseRequest != nil && !r.DispenseRequest.EqualsDeep(*other.DispenseRequest) {
+		return false
+	}
+	if (r.Substitution == nil) != (other.Substitution == nil) || r.Substitution != nil && !r.Substitution.EqualsDeep(*other.Substitution) {
+		return false
+	}
+	if (r.PriorPrescription == nil) != (other.PriorPrescription == nil) || r.PriorPrescription != nil && !r.PriorPrescription.EqualsDeep(*other.PriorPrescription) {
+		return false
+	}
+	if !equivalentList(len(r.DetectedIssue), len(other.DetectedIssue), func(i, j int) bool {
+		return r.DetectedIssue[i].EqualsDeep(other.DetectedIssue[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.EventHistory), len(other.EventHistory), func(i, j int) bool {
+		return r.EventHistory[i].EqualsDeep(other.EventHistory[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationRequest) EqualsShallow(other MedicationRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if !equivalentString(r.Intent, other.Intent) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.ReportedBoolean == nil) != (other.ReportedBoolean == nil) || r.ReportedBoolean != nil && *r.ReportedBoolean != *other.ReportedBoolean {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationRequest as FHIR XML
+func (r MedicationRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicationRequest",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReason", r.StatusReason); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "intent", r.Intent, r.IntentElement); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doNotPerform", r.DoNotPerform, r.DoNotPerformElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "reportedBoolean", r.ReportedBoolean, r.ReportedBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reportedReference", r.ReportedReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationCodeableConcept", r.MedicationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationReference", r.MedicationReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	for _, v := range r.SupportingInformation {
+		if err := encodeXMLElement(e, "supportingInformation", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "authoredOn", r.AuthoredOn, r.AuthoredOnElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requester", r.Requester); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performer", r.Performer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performerType", r.PerformerType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "recorder", r.Recorder); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "groupIdentifier", r.GroupIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "courseOfTherapyType", r.CourseOfTherapyType); err != nil {
+		return err
+	}
+	for _, v := range r.Insurance {
+		if err := encodeXMLElement(e, "insurance", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.DosageInstruction {
+		if err := encodeXMLElement(e, "dosageInstruction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "dispenseRequest", r.DispenseRequest); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "substitution", r.Substitution); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "priorPrescription", r.PriorPrescription); err != nil {
+		return err
+	}
+	for _, v := range r.DetectedIssue {
+		if err := encodeXMLElement(e, "detectedIssue", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.EventHistory {
+		if err := encodeXMLElement(e, "eventHistory", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationRequest from FHIR XML
+func (r *MedicationRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = &v
+			case "intent":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Intent = v
+				}
+				r.IntentElement = element
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "doNotPerform":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoNotPerform = &v
+				}
+				r.DoNotPerformElement = element
+			case "reportedBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReportedBoolean = &v
+				}
+				r.ReportedBooleanElement = element
+			case "reportedReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReportedReference = &v
+			case "medicationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationCodeableConcept = &v
+			case "medicationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationReference = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "supportingInformation":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInformation = append(r.SupportingInformation, v)
+			case "authoredOn":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthoredOn = &v
+				}
+				r.AuthoredOnElement = element
+			case "requester":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requester = &v
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = &v
+			case "performerType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformerType = &v
+			case "recorder":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recorder = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "groupIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GroupIdentifier = &v
+			case "courseOfTherapyType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CourseOfTherapyType = &v
+			case "insurance":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurance = append(r.Insurance, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "dosageInstruction":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DosageInstruction = append(r.DosageInstruction, v)
+			case "dispenseRequest":
+				var v MedicationRequestDispenseRequest
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DispenseRequest = &v
+			case "substitution":
+				var v MedicationRequestSubstitution
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Substitution = &v
+			case "priorPrescription":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PriorPrescription = &v
+			case "detectedIssue":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DetectedIssue = append(r.DetectedIssue, v)
+			case "eventHistory":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EventHistory = append(r.EventHistory, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationRequest as properties to the RDF node n
+func (r MedicationRequest) turtle(n *turtleNode) {
+	n.resource("MedicationRequest", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicationRequest.identifier", i, v)
+	}
+	n.primitive("MedicationRequest.status", -1, r.Status, r.StatusElement, "string")
+	n.element("MedicationRequest.statusReason", -1, r.StatusReason)
+	n.primitive("MedicationRequest.intent", -1, r.Intent, r.IntentElement, "string")
+	for i, v := range r.Category {
+		n.element("MedicationRequest.category", i, v)
+	}
+	n.primitive("MedicationRequest.priority", -1, r.Priority, r.PriorityElement, "code")
+	n.primitive("MedicationRequest.doNotPerform", -1, r.DoNotPerform, r.DoNotPerformElement, "boolean")
+	n.primitive("MedicationRequest.reportedBoolean", -1, r.ReportedBoolean, r.ReportedBooleanElement, "boolean")
+	n.element("MedicationRequest.reportedReference", -1, r.ReportedReference)
+	n.element("MedicationRequest.medicationCodeableConcept", -1, r.MedicationCodeableConcept)
+	n.element("MedicationRequest.medicationReference", -1, r.MedicationReference)
+	n.element("MedicationRequest.subject", -1, r.Subject)
+	n.element("MedicationRequest.encounter", -1, r.Encounter)
+	for i, v := range r.SupportingInformation {
+		n.element("MedicationRequest.supportingInformation", i, v)
+	}
+	n.primitive("MedicationRequest.authoredOn", -1, r.AuthoredOn, r.AuthoredOnElement, "dateTime")
+	n.element("MedicationRequest.requester", -1, r.Requester)
+	n.element("MedicationRequest.performer", -1, r.Performer)
+	n.element("MedicationRequest.performerType", -1, r.PerformerType)
+	n.element("MedicationRequest.recorder", -1, r.Recorder)
+	for i, v := range r.ReasonCode {
+		n.element("MedicationRequest.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("MedicationRequest.reasonReference", i, v)
+	}
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("MedicationRequest.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("MedicationRequest.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	for i, v := range r.BasedOn {
+		n.element("MedicationRequest.basedOn", i, v)
+	}
+	n.element("MedicationRequest.groupIdentifier", -1, r.GroupIdentifier)
+	n.element("MedicationRequest.courseOfTherapyType", -1, r.CourseOfTherapyType)
+	for i, v := range r.Insurance {
+		n.element("MedicationRequest.insurance", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("MedicationRequest.note", i, v)
+	}
+	for i, v := range r.DosageInstruction {
+		n.element("MedicationRequest.dosageInstruction", i, v)
+	}
+	n.element("MedicationRequest.dispenseRequest", -1, r.DispenseRequest)
+	n.element("MedicationRequest.substitution", -1, r.Substitution)
+	n.element("MedicationRequest.priorPrescription", -1, r.PriorPrescription)
+	for i, v := range r.DetectedIssue {
+		n.element("MedicationRequest.detectedIssue", i, v)
+	}
+	for i, v := range r.EventHistory {
+		n.element("MedicationRequest.eventHistory", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationRequest as BSON document with the structure of its FHIR JSON
+func (r MedicationRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationRequest from a BSON document with the structure of its FHIR JSON
+func (r *MedicationRequest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationRequest as protobuf message MedicationRequest of fhir.proto
+func (r MedicationRequest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationRequest to the encoded message
+func (r MedicationRequest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Status != "" {
+		e.string(10, r.Status)
+	}
+	if r.StatusReason != nil {
+		e.message(11, *r.StatusReason)
+	}
+	if r.Intent != "" {
+		e.string(12, r.Intent)
+	}
+	for _, v := range r.Category {
+		e.message(13, v)
+	}
+	if r.Priority != nil {
+		e.varint(14, r.Priority.protoNumber())
+	}
+	if r.DoNotPerform != nil {
+		e.varint(15, protoBool(*r.DoNotPerform))
+	}
+	if r.ReportedBoolean != nil {
+		e.varint(16, protoBool(*r.ReportedBoolean))
+	}
+	if r.ReportedReference != nil {
+		e.message(17, *r.ReportedReference)
+	}
+	if r.MedicationCodeableConcept != nil {
+		e.message(18, *r.MedicationCodeableConcept)
+	}
+	if r.MedicationReference != nil {
+		e.message(19, *r.MedicationReference)
+	}
+	e.message(20, r.Subject)
+	if r.Encounter != nil {
+		e.message(21, *r.Encounter)
+	}
+	for _, v := range r.SupportingInformation {
+		e.message(22, v)
+	}
+	if r.AuthoredOn != nil {
+		e.string(23, *r.AuthoredOn)
+	}
+	if r.Requester != nil {
+		e.message(24, *r.Requester)
+	}
+	if r.Performer != nil {
+		e.message(25, *r.Performer)
+	}
+	if r.PerformerType != nil {
+		e.message(26, *r.PerformerType)
+	}
+	if r.Recorder != nil {
+		e.message(27, *r.Recorder)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(28, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(29, v)
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(30, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(31, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(32, v)
+	}
+	if r.GroupIdentifier != nil {
+		e.message(33, *r.GroupIdentifier)
+	}
+	if r.CourseOfTherapyType != nil {
+		e.message(34, *r.CourseOfTherapyType)
+	}
+	for _, v := range r.Insurance {
+		e.message(35, v)
+	}
+	for _, v := range r.Note {
+		e.message(36, v)
+	}
+	for _, v := range r.DosageInstruction {
+		e.message(37, v)
+	}
+	if r.DispenseRequest != nil {
+		e.message(38, *r.DispenseRequest)
+	}
+	if r.Substitution != nil {
+		e.message(39, *r.Substitution)
+	}
+	if r.PriorPrescription != nil {
+		e.message(40, *r.PriorPrescription)
+	}
+	for _, v := range r.DetectedIssue {
+		e.message(41, v)
+	}
+	for _, v := range r.EventHistory {
+		e.message(42, v)
+	}
+	if r.IdElement != nil {
+		e.message(43, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(44, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(45, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(46, *r.StatusElement)
+	}
+	if r.IntentElement != nil {
+		e.message(47, *r.IntentElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(48, *r.PriorityElement)
+	}
+	if r.DoNotPerformElement != nil {
+		e.message(49, *r.DoNotPerformElement)
+	}
+	if r.ReportedBooleanElement != nil {
+		e.message(50, *r.ReportedBooleanElement)
+	}
+	if r.AuthoredOnElement != nil {
+		e.message(51, *r.AuthoredOnElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(52, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(53, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationRequest from protobuf message MedicationRequest of fhir.proto
+func (r *MedicationRequest) UnmarshalProto(b []byte) error {
+	*r = MedicationRequest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.Status = d.string()
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = &v
+		case 12:
+			r.Intent = d.string()
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 14:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 15:
+			v := d.bool()
+			r.DoNotPerform = &v
+		case 16:
+			v := d.bool()
+			r.ReportedBoolean = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.ReportedReference = &v
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.MedicationCodeableConcept = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.MedicationReference = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.SupportingInformation = append(r.SupportingInformation, v)
+		case 23:
+			v := d.string()
+			r.AuthoredOn = &v
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Requester = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Performer = &v
+		case 26:
+			var v CodeableConcept
+			d.message(&v)
+			r.PerformerType = &v
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.Recorder = &v
+		case 28:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 29:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 30:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 31:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 32:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 33:
+			var v Identifier
+			d.message(&v)
+			r.GroupIdentifier = &v
+		case 34:
+			var v CodeableConcept
+			d.message(&v)
+			r.CourseOfTherapyType = &v
+		case 35:
+			var v Reference
+			d.message(&v)
+			r.Insurance = append(r.Insurance, v)
+		case 36:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 37:
+			var v Dosage
+			d.message(&v)
+			r.DosageInstruction = append(r.DosageInstruction, v)
+		case 38:
+			var v MedicationRequestDispenseRequest
+			d.message(&v)
+			r.DispenseRequest = &v
+		case 39:
+			var v MedicationRequestSubstitution
+			d.message(&v)
+			r.Substitution = &v
+		case 40:
+			var v Reference
+			d.message(&v)
+			r.PriorPrescription = &v
+		case 41:
+			var v Reference
+			d.message(&v)
+			r.DetectedIssue = append(r.DetectedIssue, v)
+		case 42:
+			var v Reference
+			d.message(&v)
+			r.EventHistory = append(r.EventHistory, v)
+		case 43:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.IntentElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.DoNotPerformElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.ReportedBooleanElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.AuthoredOnElement = &v
+		case 52:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 53:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationRequestDispenseRequest as JSON into a byte slice
+func (r MedicationRequestDispenseRequest) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationRequestDispenseRequest as JSON object with the members in the order of the fields
+func (r MedicationRequestDispenseRequest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.InitialFill != nil {
+		e.key("initialFill")
+		r.InitialFill.appendJSON(e)
+	}
+	if r.DispenseInterval != nil {
+		e.key("dispenseInterval")
+		r.DispenseInterval.appendJSON(e)
+	}
+	if r.ValidityPeriod != nil {
+		e.key("validityPeriod")
+		r.ValidityPeriod.appendJSON(e)
+	}
+	if r.NumberOfRepeatsAllowed != nil {
+		e.key("numberOfRepeatsAllowed")
+		e.int(*r.NumberOfRepeatsAllowed)
+	}
+	if r.NumberOfRepeatsAllowedElement != nil {
+		e.key("_numberOfRepeatsAllowed")
+		r.NumberOfRepeatsAllowedElement.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.ExpectedSupplyDuration != nil {
+		e.key("expectedSupplyDuration")
+		r.ExpectedSupplyDuration.appendJSON(e)
+	}
+	if r.Performer != nil {
+		e.key("performer")
+		r.Performer.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationRequestDispenseRequest from JSON. Unknown members are ignored.
+func (r *MedicationRequestDispenseRequest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationRequestDispenseRequest
+func (r *MedicationRequestDispenseRequest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "initialFill":
+			if d.null() {
+				r.InitialFill = nil
+			} else {
+				var v MedicationRequestDispenseRequestInitialFill
+				v.decodeJSON(d)
+				r.InitialFill = &v
+			}
+		case "dispenseInterval":
+			if d.null() {
+				r.DispenseInterval = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.DispenseInterval = &v
+			}
+		case "validityPeriod":
+			if d.null() {
+				r.ValidityPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValidityPeriod = &v
+			}
+		case "numberOfRepeatsAllowed":
+			if v, ok := d.int(); ok {
+				r.NumberOfRepeatsAllowed = &v
+			} else {
+				r.NumberOfRepeatsAllowed = nil
+			}
+		case "_numberOfRepeatsAllowed":
+			r.NumberOfRepeatsAllowedElement = d.element()
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "expectedSupplyDuration":
+			if d.null() {
+				r.ExpectedSupplyDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.ExpectedSupplyDuration = &v
+			}
+		case "performer":
+			if d.null() {
+				r.Performer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationRequestDispenseRequest which shares no memory with the original
+func (r MedicationRequestDispenseRequest) DeepCopy() MedicationRequestDispenseRequest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.InitialFill != nil {
+		v := r.InitialFill.DeepCopy()
+		out.InitialFill = &v
+	}
+	if r.DispenseInterval != nil {
+		v := r.DispenseInterval.DeepCopy()
+		out.DispenseInterval = &v
+	}
+	if r.ValidityPeriod != nil {
+		v := r.ValidityPeriod.DeepCopy()
+		out.ValidityPeriod = &v
+	}
+	if r.NumberOfRepeatsAllowed != nil {
+		v := *r.NumberOfRepeatsAllowed
+		out.NumberOfRepeatsAllowed = &v
+	}
+	if r.NumberOfRepeatsAllowedElement != nil {
+		v := r.NumberOfRepeatsAllowedElement.DeepCopy()
+		out.NumberOfRepeatsAllowedElement = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.ExpectedSupplyDuration != nil {
+		v := r.ExpectedSupplyDuration.DeepCopy()
+		out.ExpectedSupplyDuration = &v
+	}
+	if r.Performer != nil {
+		v := r.Performer.DeepCopy()
+		out.Performer = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationRequestDispenseRequest) Equal(other MedicationRequestDispenseRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.InitialFill == nil) != (other.InitialFill == nil) || r.InitialFill != nil && !r.InitialFill.Equal(*other.InitialFill) {
+		return false
+	}
+	if (r.DispenseInterval == nil) != (other.DispenseInterval == nil) || r.DispenseInterval != nil && !r.DispenseInterval.Equal(*other.DispenseInterval) {
+		return false
+	}
+	if (r.ValidityPeriod == nil) != (other.ValidityPeriod == nil) || r.ValidityPeriod != nil && !r.ValidityPeriod.Equal(*other.ValidityPeriod) {
+		return false
+	}
+	if (r.NumberOfRepeatsAllowed == nil) != (other.NumberOfRepeatsAllowed == nil) || r.NumberOfRepeatsAllowed != nil && *r.NumberOfRepeatsAllowed != *other.NumberOfRepeatsAllowed {
+		return false
+	}
+	if (r.NumberOfRepeatsAllowedElement == nil) != (other.NumberOfRepeatsAllowedElement == nil) || r.NumberOfRepeatsAllowedElement != nil && !r.NumberOfRepeatsAllowedElement.Equal(*other.NumberOfRepeatsAllowedElement) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.ExpectedSupplyDuration == nil) != (other.ExpectedSupplyDuration == nil) || r.ExpectedSupplyDuration != nil && !r.ExpectedSupplyDuration.Equal(*other.ExpectedSupplyDuration) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.Equal(*other.Performer) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationRequestDispenseRequest) EqualsDeep(other MedicationRequestDispenseRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.InitialFill == nil) != (other.InitialFill == nil) || r.InitialFill != nil && !r.InitialFill.EqualsDeep(*other.InitialFill) {
+		return false
+	}
+	if (r.DispenseInterval == nil) != (other.DispenseInterval == nil) || r.DispenseInterval != nil && !r.DispenseInterval.EqualsDeep(*other.DispenseInterval) {
+		return false
+	}
+	if (r.ValidityPeriod == nil) != (other.ValidityPeriod == nil) || r.ValidityPeriod != nil && !r.ValidityPeriod.EqualsDeep(*other.ValidityPeriod) {
+		return false
+	}
+	if (r.NumberOfRepeatsAllowed == nil) != (other.NumberOfRepeatsAllowed == nil) || r.NumberOfRepeatsAllowed != nil && *r.NumberOfRepeatsAllowed != *other.NumberOfRepeatsAllowed {
+		return false
+	}
+	if (r.NumberOfRepeatsAllowedElement == nil) != (other.NumberOfRepeatsAllowedElement == nil) || r.NumberOfRepeatsAllowedElement != nil && !r.NumberOfRepeatsAllowedElement.EqualsDeep(*other.NumberOfRepeatsAllowedElement) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.ExpectedSupplyDuration == nil) != (other.ExpectedSupplyDuration == nil) || r.ExpectedSupplyDuration != nil && !r.ExpectedSupplyDuration.EqualsDeep(*other.ExpectedSupplyDuration) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.EqualsDeep(*other.Performer) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationRequestDispenseRequest) EqualsShallow(other MedicationRequestDispenseRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.NumberOfRepeatsAllowed == nil) != (other.NumberOfRepeatsAllowed == nil) || r.NumberOfRepeatsAllowed != nil && *r.NumberOfRepeatsAllowed != *other.NumberOfRepeatsAllowed {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationRequestDispenseRequest as FHIR XML
+func (r MedicationRequestDispenseRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "initialFill", r.InitialFill); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dispenseInterval", r.DispenseInterval); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "validityPeriod", r.ValidityPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "numberOfRepeatsAllowed", r.NumberOfRepeatsAllowed, r.NumberOfRepeatsAllowedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "expectedSupplyDuration", r.ExpectedSupplyDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performer", r.Performer); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationRequestDispenseRequest from FHIR XML
+func (r *MedicationRequestDispenseRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "initialFill":
+				var v MedicationRequestDispenseRequestInitialFill
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.InitialFill = &v
+			case "dispenseInterval":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DispenseInterval = &v
+			case "validityPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValidityPeriod = &v
+			case "numberOfRepeatsAllowed":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumberOfRepeatsAllowed = &v
+				}
+				r.NumberOfRepeatsAllowedElement = element
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "expectedSupplyDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ExpectedSupplyDuration = &v
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationRequestDispenseRequest as properties to the RDF node n
+func (r MedicationRequestDispenseRequest) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationRequest.dispenseRequest.initialFill", -1, r.InitialFill)
+	n.element("MedicationRequest.dispenseRequest.dispenseInterval", -1, r.DispenseInterval)
+	n.element("MedicationRequest.dispenseRequest.validityPeriod", -1, r.ValidityPeriod)
+	n.primitive("MedicationRequest.dispenseRequest.numberOfRepeatsAllowed", -1, r.NumberOfRepeatsAllowed, r.NumberOfRepeatsAllowedElement, "unsignedInt")
+	n.element("MedicationRequest.dispenseRequest.quantity", -1, r.Quantity)
+	n.element("MedicationRequest.dispenseRequest.expectedSupplyDuration", -1, r.ExpectedSupplyDuration)
+	n.element("MedicationRequest.dispenseRequest.performer", -1, r.Performer)
+}
+
+// MarshalBSON marshals the given MedicationRequestDispenseRequest as BSON document with the structure of its FHIR JSON
+func (r MedicationRequestDispenseRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationRequestDispenseRequest from a BSON document with the structure of its FHIR JSON
+func (r *MedicationRequestDispenseRequest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationRequestDispenseRequest as protobuf message MedicationRequestDispenseRequest of fhir.proto
+func (r MedicationRequestDispenseRequest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationRequestDispenseRequest to the encoded message
+func (r MedicationRequestDispenseRequest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.InitialFill != nil {
+		e.message(4, *r.InitialFill)
+	}
+	if r.DispenseInterval != nil {
+		e.message(5, *r.DispenseInterval)
+	}
+	if r.ValidityPeriod != nil {
+		e.message(6, *r.ValidityPeriod)
+	}
+	if r.NumberOfRepeatsAllowed != nil {
+		e.varint(7, uint64(int64(*r.NumberOfRepeatsAllowed)))
+	}
+	if r.Quantity != nil {
+		e.message(8, *r.Quantity)
+	}
+	if r.ExpectedSupplyDuration != nil {
+		e.message(9, *r.ExpectedSupplyDuration)
+	}
+	if r.Performer != nil {
+		e.message(10, *r.Performer)
+	}
+	if r.NumberOfRepeatsAllowedElement != nil {
+		e.message(11, *r.NumberOfRepeatsAllowedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationRequestDispenseRequest from protobuf message MedicationRequestDispenseRequest of fhir.proto
+func (r *MedicationRequestDispenseRequest) UnmarshalProto(b []byte) error {
+	*r = MedicationRequestDispenseRequest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v MedicationRequestDispenseRequestInitialFill
+			d.message(&v)
+			r.InitialFill = &v
+		case 5:
+			var v Duration
+			d.message(&v)
+			r.DispenseInterval = &v
+		case 6:
+			var v Period
+			d.message(&v)
+			r.ValidityPeriod = &v
+		case 7:
+			v := d.int()
+			r.NumberOfRepeatsAllowed = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 9:
+			var v Duration
+			d.message(&v)
+			r.ExpectedSupplyDuration = &v
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Performer = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.NumberOfRepeatsAllowedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationRequestDispenseRequestInitialFill as JSON into a byte slice
+func (r MedicationRequestDispenseRequestInitialFill) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationRequestDispenseRequestInitialFill as JSON object with the members in the order of the fields
+func (r MedicationRequestDispenseRequestInitialFill) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.Duration != nil {
+		e.key("duration")
+		r.Duration.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationRequestDispenseRequestInitialFill from JSON. Unknown members are ignored.
+func (r *MedicationRequestDispenseRequestInitialFill) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationRequestDispenseRequestInitialFill
+func (r *MedicationRequestDispenseRequestInitialFill) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "duration":
+			if d.null() {
+				r.Duration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.Duration = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationRequestDispenseRequestInitialFill which shares no memory with the original
+func (r MedicationRequestDispenseRequestInitialFill) DeepCopy() MedicationRequestDispenseRequestInitialFill {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.Duration != nil {
+		v := r.Duration.DeepCopy()
+		out.Duration = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationRequestDispenseRequestInitialFill) Equal(other MedicationRequestDispenseRequestInitialFill) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.Equal(*other.Duration) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationRequestDispenseRequestInitialFill) EqualsDeep(other MedicationRequestDispenseRequestInitialFill) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.EqualsDeep(*other.Duration) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationRequestDispenseRequestInitialFill) EqualsShallow(other MedicationRequestDispenseRequestInitialFill) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationRequestDispenseRequestInitialFill as FHIR XML
+func (r MedicationRequestDispenseRequestInitialFill) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "duration", r.Duration); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationRequestDispenseRequestInitialFill from FHIR XML
+func (r *MedicationRequestDispenseRequestInitialFill) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "duration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Duration = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationRequestDispenseRequestInitialFill as properties to the RDF node n
+func (r MedicationRequestDispenseRequestInitialFill) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicationRequest.dispenseRequest.initialFill.quantity", -1, r.Quantity)
+	n.element("MedicationRequest.dispenseRequest.initialFill.duration", -1, r.Duration)
+}
+
+// MarshalBSON marshals the given MedicationRequestDispenseRequestInitialFill as BSON document with the structure of its FHIR JSON
+func (r MedicationRequestDispenseRequestInitialFill) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationRequestDispenseRequestInitialFill from a BSON document with the structure of its FHIR JSON
+func (r *MedicationRequestDispenseRequestInitialFill) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationRequestDispenseRequestInitialFill as protobuf message MedicationRequestDispenseRequestInitialFill of fhir.proto
+func (r MedicationRequestDispenseRequestInitialFill) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationRequestDispenseRequestInitialFill to the encoded message
+func (r MedicationRequestDispenseRequestInitialFill) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Quantity != nil {
+		e.message(4, *r.Quantity)
+	}
+	if r.Duration != nil {
+		e.message(5, *r.Duration)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationRequestDispenseRequestInitialFill from protobuf message MedicationRequestDispenseRequestInitialFill of fhir.proto
+func (r *MedicationRequestDispenseRequestInitialFill) UnmarshalProto(b []byte) error {
+	*r = MedicationRequestDispenseRequestInitialFill{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 5:
+			var v Duration
+			d.message(&v)
+			r.Duration = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicationRequestSubstitution as JSON into a byte slice
+func (r MedicationRequestSubstitution) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationRequestSubstitution as JSON object with the members in the order of the fields
+func (r MedicationRequestSubstitution) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AllowedBoolean != nil {
+		e.key("allowedBoolean")
+		e.bool(*r.AllowedBoolean)
+	}
+	if r.AllowedBooleanElement != nil {
+		e.key("_allowedBoolean")
+		r.AllowedBooleanElement.appendJSON(e)
+	}
+	if r.AllowedCodeableConcept != nil {
+		e.key("allowedCodeableConcept")
+		r.AllowedCodeableConcept.appendJSON(e)
+	}
+	if r.Reason != nil {
+		e.key("reason")
+		r.Reason.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationRequestSubstitution from JSON. Unknown members are ignored.
+func (r *MedicationRequestSubstitution) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationRequestSubstitution
+func (r *MedicationRequestSubstitution) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "allowedBoolean":
+			if v, ok := d.bool(); ok {
+				r.AllowedBoolean = &v
+			} else {
+				r.AllowedBoolean = nil
+			}
+		case "_allowedBoolean":
+			r.AllowedBooleanElement = d.element()
+		case "allowedCodeableConcept":
+			if d.null() {
+				r.AllowedCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AllowedCodeableConcept = &v
+			}
+		case "reason":
+			if d.null() {
+				r.Reason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Reason = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationRequestSubstitution which shares no memory with the original
+func (r MedicationRequestSubstitution) DeepCopy() MedicationRequestSubstitution {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.AllowedBoolean != nil {
+		v := *r.AllowedBoolean
+		out.AllowedBoolean = &v
+	}
+	if r.AllowedBooleanElement != nil {
+		v := r.AllowedBooleanElement.DeepCopy()
+		out.AllowedBooleanElement = &v
+	}
+	if r.AllowedCodeableConcept != nil {
+		v := r.AllowedCodeableConcept.DeepCopy()
+		out.AllowedCodeableConcept = &v
+	}
+	if r.Reason != nil {
+		v := r.Reason.DeepCopy()
+		out.Reason = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationRequestSubstitution) Equal(other MedicationRequestSubstitution) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.AllowedBoolean == nil) != (other.AllowedBoolean == nil) || r.AllowedBoolean != nil && *r.AllowedBoolean != *other.AllowedBoolean {
+		return false
+	}
+	if (r.AllowedBooleanElement == nil) != (other.AllowedBooleanElement == nil) || r.AllowedBooleanElement != nil && !r.AllowedBooleanElement.Equal(*other.AllowedBooleanElement) {
+		return false
+	}
+	if (r.AllowedCodeableConcept == nil) != (other.AllowedCodeableConcept == nil) || r.AllowedCodeableConcept != nil && !r.AllowedCodeableConcept.Equal(*other.AllowedCodeableConcept) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.Equal(*other.Reason) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationRequestSubstitution) EqualsDeep(other MedicationRequestSubstitution) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.AllowedBoolean == nil) != (other.AllowedBoolean == nil) || r.AllowedBoolean != nil && *r.AllowedBoolean != *other.AllowedBoolean {
+		return false
+	}
+	if (r.AllowedBooleanElement == nil) != (other.AllowedBooleanElement == nil) || r.AllowedBooleanElement != nil && !r.AllowedBooleanElement.EqualsDeep(*other.AllowedBooleanElement) {
+		return false
+	}
+	if (r.AllowedCodeableConcept == nil) != (other.AllowedCodeableConcept == nil) || r.AllowedCodeableConcept != nil && !r.AllowedCodeableConcept.EqualsDeep(*other.AllowedCodeableConcept) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.EqualsDeep(*other.Reason) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationRequestSubstitution) EqualsShallow(other MedicationRequestSubstitution) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.AllowedBoolean == nil) != (other.AllowedBoolean == nil) || r.AllowedBoolean != nil && *r.AllowedBoolean != *other.AllowedBoolean {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationRequestSubstitution as FHIR XML
+func (r MedicationRequestSubstitution) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "allowedBoolean", r.AllowedBoolean, r.AllowedBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "allowedCodeableConcept", r.AllowedCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reason", r.Reason); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationRequestSubstitution from FHIR XML
+func (r *MedicationRequestSubstitution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "allowedBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllowedBoolean = &v
+				}
+				r.AllowedBooleanElement = element
+			case "allowedCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AllowedCodeableConcept = &v
+			case "reason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationRequestSubstitution as properties to the RDF node n
+func (r MedicationRequestSubstitution) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MedicationRequest.substitution.allowedBoolean", -1, r.AllowedBoolean, r.AllowedBooleanElement, "boolean")
+	n.element("MedicationRequest.substitution.allowedCodeableConcept", -1, r.AllowedCodeableConcept)
+	n.element("MedicationRequest.substitution.reason", -1, r.Reason)
+}
+
+// MarshalBSON marshals the given MedicationRequestSubstitution as BSON document with the structure of its FHIR JSON
+func (r MedicationRequestSubstitution) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationRequestSubstitution from a BSON document with the structure of its FHIR JSON
+func (r *MedicationRequestSubstitution) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationRequestSubstitution as protobuf message MedicationRequestSubstitution of fhir.proto
+func (r MedicationRequestSubstitution) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationRequestSubstitution to the encoded message
+func (r MedicationRequestSubstitution) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.AllowedBoolean != nil {
+		e.varint(4, protoBool(*r.AllowedBoolean))
+	}
+	if r.AllowedCodeableConcept != nil {
+		e.message(5, *r.AllowedCodeableConcept)
+	}
+	if r.Reason != nil {
+		e.message(6, *r.Reason)
+	}
+	if r.AllowedBooleanElement != nil {
+		e.message(7, *r.AllowedBooleanElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationRequestSubstitution from protobuf message MedicationRequestSubstitution of fhir.proto
+func (r *MedicationRequestSubstitution) UnmarshalProto(b []byte) error {
+	*r = MedicationRequestSubstitution{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.bool()
+			r.AllowedBoolean = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.AllowedCodeableConcept = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Reason = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.AllowedBooleanElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicationRequest unmarshals a MedicationRequest.
 func UnmarshalMedicationRequest(b []byte) (MedicationRequest, error) {
 	var medicationRequest MedicationRequest
-	if err := json.Unmarshal(b, &medicationRequest); err != nil {
+	if err := medicationRequest.UnmarshalJSON(b); err != nil {
 		return medicationRequest, err
 	}
 	return medicationRequest, nil
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,25 +25,32 @@ import "encoding/json"
 // MedicationStatement is documented here http://hl7.org/fhir/StructureDefinition/MedicationStatement
 type MedicationStatement struct {
 	Id                        *string           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element          `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                      *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules             *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                  *string           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element          `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                      *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                 []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension         []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier                []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	BasedOn                   []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
 	PartOf                    []Reference       `bson:"partOf,omitempty" json:"partOf,omitempty"`
 	Status                    string            `bson:"status" json:"status"`
+	StatusElement             *Element          `bson:"_status,omitempty" json:"_status,omitempty"`
 	StatusReason              []CodeableConcept `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
 	Category                  *CodeableConcept  `bson:"category,omitempty" json:"category,omitempty"`
-	MedicationCodeableConcept CodeableConcept   `bson:"medicationCodeableConcept" json:"medicationCodeableConcept"`
-	MedicationReference       Reference         `bson:"medicationReference" json:"medicationReference"`
+	MedicationCodeableConcept *CodeableConcept  `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *Reference        `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
 	Subject                   Reference         `bson:"subject" json:"subject"`
 	Context                   *Reference        `bson:"context,omitempty" json:"context,omitempty"`
 	EffectiveDateTime         *string           `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement  *Element          `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
 	EffectivePeriod           *Period           `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
 	DateAsserted              *string           `bson:"dateAsserted,omitempty" json:"dateAsserted,omitempty"`
+	DateAssertedElement       *Element          `bson:"_dateAsserted,omitempty" json:"_dateAsserted,omitempty"`
 	InformationSource         *Reference        `bson:"informationSource,omitempty" json:"informationSource,omitempty"`
 	DerivedFrom               []Reference       `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
 	ReasonCode                []CodeableConcept `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
@@ -48,23 +58,1651 @@ type MedicationStatement struct {
 	Note                      []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
 	Dosage                    []Dosage          `bson:"dosage,omitempty" json:"dosage,omitempty"`
 }
-type OtherMedicationStatement MedicationStatement
+
+// MedicationStatementMedication is implemented by the types allowed for MedicationStatement.medication[x]
+type MedicationStatementMedication interface {
+	isMedicationStatementMedication()
+}
+
+func (CodeableConcept) isMedicationStatementMedication() {}
+func (Reference) isMedicationStatementMedication()       {}
+
+// Medication returns the value of MedicationStatement.medication[x] together with its FHIR type code or nil if no value is set
+func (r MedicationStatement) Medication() (MedicationStatementMedication, string) {
+	if r.MedicationCodeableConcept != nil {
+		return *r.MedicationCodeableConcept, "CodeableConcept"
+	}
+	if r.MedicationReference != nil {
+		return *r.MedicationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetMedication sets the value of MedicationStatement.medication[x], given as value or pointer, and clears all other types
+func (r *MedicationStatement) SetMedication(value MedicationStatementMedication) {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.MedicationCodeableConcept = &v
+	case *CodeableConcept:
+		r.MedicationCodeableConcept = v
+	case Reference:
+		r.MedicationReference = &v
+	case *Reference:
+		r.MedicationReference = v
+	}
+}
+
+// MedicationStatementEffective is implemented by the types allowed for MedicationStatement.effective[x]
+type MedicationStatementEffective interface {
+	isMedicationStatementEffective()
+}
+
+func (DateTime) isMedicationStatementEffective() {}
+func (Period) isMedicationStatementEffective()   {}
+
+// Effective returns the value of MedicationStatement.effective[x] together with its FHIR type code or nil if no value is set
+func (r MedicationStatement) Effective() (MedicationStatementEffective, string) {
+	if r.EffectiveDateTime != nil {
+		return DateTime(*r.EffectiveDateTime), "dateTime"
+	}
+	if r.EffectivePeriod != nil {
+		return *r.EffectivePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetEffective sets the value of MedicationStatement.effective[x], given as value or pointer, and clears all other types
+func (r *MedicationStatement) SetEffective(value MedicationStatementEffective) {
+	r.EffectiveDateTime = nil
+	r.EffectivePeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.EffectiveDateTime = &primitive
+	case *DateTime:
+		r.EffectiveDateTime = (*string)(v)
+	case Period:
+		r.EffectivePeriod = &v
+	case *Period:
+		r.EffectivePeriod = v
+	}
+}
 
 // MarshalJSON marshals the given MedicationStatement as JSON into a byte slice
 func (r MedicationStatement) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicationStatement
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicationStatement: OtherMedicationStatement(r),
-		ResourceType:             "MedicationStatement",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicationStatement as JSON object with the members in the order of the fields
+func (r MedicationStatement) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicationStatement\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status)
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.StatusReason) > 0 {
+		e.key("statusReason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.StatusReason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	if r.MedicationCodeableConcept != nil {
+		e.key("medicationCodeableConcept")
+		r.MedicationCodeableConcept.appendJSON(e)
+	}
+	if r.MedicationReference != nil {
+		e.key("medicationReference")
+		r.MedicationReference.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Context != nil {
+		e.key("context")
+		r.Context.appendJSON(e)
+	}
+	if r.EffectiveDateTime != nil {
+		e.key("effectiveDateTime")
+		e.string(*r.EffectiveDateTime)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.key("_effectiveDateTime")
+		r.EffectiveDateTimeElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if r.DateAsserted != nil {
+		e.key("dateAsserted")
+		e.string(*r.DateAsserted)
+	}
+	if r.DateAssertedElement != nil {
+		e.key("_dateAsserted")
+		r.DateAssertedElement.appendJSON(e)
+	}
+	if r.InformationSource != nil {
+		e.key("informationSource")
+		r.InformationSource.appendJSON(e)
+	}
+	if len(r.DerivedFrom) > 0 {
+		e.key("derivedFrom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DerivedFrom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Dosage) > 0 {
+		e.key("dosage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Dosage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicationStatement from JSON. Unknown members are ignored.
+func (r *MedicationStatement) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicationStatement
+func (r *MedicationStatement) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			if v, ok := d.string(); ok {
+				r.Status = v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			a := d.array()
+			r.StatusReason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = append(r.StatusReason, v)
+			}
+			if r.StatusReason == nil && !a.isNull {
+				r.StatusReason = []CodeableConcept{}
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "medicationCodeableConcept":
+			if d.null() {
+				r.MedicationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.MedicationCodeableConcept = &v
+			}
+		case "medicationReference":
+			if d.null() {
+				r.MedicationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.MedicationReference = &v
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "context":
+			if d.null() {
+				r.Context = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Context = &v
+			}
+		case "effectiveDateTime":
+			if v, ok := d.string(); ok {
+				r.EffectiveDateTime = &v
+			} else {
+				r.EffectiveDateTime = nil
+			}
+		case "_effectiveDateTime":
+			r.EffectiveDateTimeElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "dateAsserted":
+			if v, ok := d.string(); ok {
+				r.DateAsserted = &v
+			} else {
+				r.DateAsserted = nil
+			}
+		case "_dateAsserted":
+			r.DateAssertedElement = d.element()
+		case "informationSource":
+			if d.null() {
+				r.InformationSource = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.InformationSource = &v
+			}
+		case "derivedFrom":
+			a := d.array()
+			r.DerivedFrom = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.DerivedFrom = append(r.DerivedFrom, v)
+			}
+			if r.DerivedFrom == nil && !a.isNull {
+				r.DerivedFrom = []Reference{}
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "dosage":
+			a := d.array()
+			r.Dosage = nil
+			for a.next() {
+				var v Dosage
+				v.decodeJSON(d)
+				r.Dosage = append(r.Dosage, v)
+			}
+			if r.Dosage == nil && !a.isNull {
+				r.Dosage = []Dosage{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicationStatement which shares no memory with the original
+func (r MedicationStatement) DeepCopy() MedicationStatement {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		out.StatusReason = make([]CodeableConcept, len(r.StatusReason))
+		for i := range r.StatusReason {
+			out.StatusReason[i] = r.StatusReason[i].DeepCopy()
+		}
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	if r.MedicationCodeableConcept != nil {
+		v := r.MedicationCodeableConcept.DeepCopy()
+		out.MedicationCodeableConcept = &v
+	}
+	if r.MedicationReference != nil {
+		v := r.MedicationReference.DeepCopy()
+		out.MedicationReference = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Context != nil {
+		v := r.Context.DeepCopy()
+		out.Context = &v
+	}
+	if r.EffectiveDateTime != nil {
+		v := *r.EffectiveDateTime
+		out.EffectiveDateTime = &v
+	}
+	if r.EffectiveDateTimeElement != nil {
+		v := r.EffectiveDateTimeElement.DeepCopy()
+		out.EffectiveDateTimeElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.DateAsserted != nil {
+		v := *r.DateAsserted
+		out.DateAsserted = &v
+	}
+	if r.DateAssertedElement != nil {
+		v := r.DateAssertedElement.DeepCopy()
+		out.DateAssertedElement = &v
+	}
+	if r.InformationSource != nil {
+		v := r.InformationSource.DeepCopy()
+		out.InformationSource = &v
+	}
+	if r.DerivedFrom != nil {
+		out.DerivedFrom = make([]Reference, len(r.DerivedFrom))
+		for i := range r.DerivedFrom {
+			out.DerivedFrom[i] = r.DerivedFrom[i].DeepCopy()
+		}
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.Dosage != nil {
+		out.Dosage = make([]Dosage, len(r.Dosage))
+		for i := range r.Dosage {
+			out.Dosage[i] = r.Dosage[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicationStatement) Equal(other MedicationStatement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.StatusReason) != len(other.StatusReason) {
+		return false
+	}
+	for i := range r.StatusReason {
+		if !r.StatusReason[i].Equal(other.StatusReason[i]) {
+			return false
+		}
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.Equal(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.Equal(*other.MedicationReference) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.Equal(*other.Context) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && *r.EffectiveDateTime != *other.EffectiveDateTime {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.Equal(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.DateAsserted == nil) != (other.DateAsserted == nil) || r.DateAsserted != nil && *r.DateAsserted != *other.DateAsserted {
+		return false
+	}
+	if (r.DateAssertedElement == nil) != (other.DateAssertedElement == nil) || r.DateAssertedElement != nil && !r.DateAssertedElement.Equal(*other.DateAssertedElement) {
+		return false
+	}
+	if (r.InformationSource == nil) != (other.InformationSource == nil) || r.InformationSource != nil && !r.InformationSource.Equal(*other.InformationSource) {
+		return false
+	}
+	if len(r.DerivedFrom) != len(other.DerivedFrom) {
+		return false
+	}
+	for i := range r.DerivedFrom {
+		if !r.DerivedFrom[i].Equal(other.DerivedFrom[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.Dosage) != len(other.Dosage) {
+		return false
+	}
+	for i := range r.Dosage {
+		if !r.Dosage[i].Equal(other.Dosage[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicationStatement) EqualsDeep(other MedicationStatement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.StatusReason), len(other.StatusReason), func(i, j int) bool {
+		return r.StatusReason[i].EqualsDeep(other.StatusReason[j])
+	}) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.EqualsDeep(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.EqualsDeep(*other.MedicationReference) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.EqualsDeep(*other.Context) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.EqualsDeep(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.DateAsserted == nil) != (other.DateAsserted == nil) || r.DateAsserted != nil && !equivalentString(*r.DateAsserted, *other.DateAsserted) {
+		return false
+	}
+	if (r.DateAssertedElement == nil) != (other.DateAssertedElement == nil) || r.DateAssertedElement != nil && !r.DateAssertedElement.EqualsDeep(*other.DateAssertedElement) {
+		return false
+	}
+	if (r.InformationSource == nil) != (other.InformationSource == nil) || r.InformationSource != nil && !r.InformationSource.EqualsDeep(*other.InformationSource) {
+		return false
+	}
+	if !equivalentList(len(r.DerivedFrom), len(other.DerivedFrom), func(i, j int) bool {
+		return r.DerivedFrom[i].EqualsDeep(other.DerivedFrom[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Dosage), len(other.Dosage), func(i, j int) bool {
+		return r.Dosage[i].EqualsDeep(other.Dosage[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicationStatement) EqualsShallow(other MedicationStatement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	if (r.DateAsserted == nil) != (other.DateAsserted == nil) || r.DateAsserted != nil && !equivalentString(*r.DateAsserted, *other.DateAsserted) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicationStatement as FHIR XML
+func (r MedicationStatement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicationStatement",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.StatusReason {
+		if err := encodeXMLElement(e, "statusReason", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationCodeableConcept", r.MedicationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationReference", r.MedicationReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "context", r.Context); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "effectiveDateTime", r.EffectiveDateTime, r.EffectiveDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "dateAsserted", r.DateAsserted, r.DateAssertedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "informationSource", r.InformationSource); err != nil {
+		return err
+	}
+	for _, v := range r.DerivedFrom {
+		if err := encodeXMLElement(e, "derivedFrom", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Dosage {
+		if err := encodeXMLElement(e, "dosage", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicationStatement from FHIR XML
+func (r *MedicationStatement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = append(r.StatusReason, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "medicationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationCodeableConcept = &v
+			case "medicationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationReference = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "context":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Context = &v
+			case "effectiveDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EffectiveDateTime = &v
+				}
+				r.EffectiveDateTimeElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "dateAsserted":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DateAsserted = &v
+				}
+				r.DateAssertedElement = element
+			case "informationSource":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.InformationSource = &v
+			case "derivedFrom":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DerivedFrom = append(r.DerivedFrom, v)
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "dosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Dosage = append(r.Dosage, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicationStatement as properties to the RDF node n
+func (r MedicationStatement) turtle(n *turtleNode) {
+	n.resource("MedicationStatement", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicationStatement.identifier", i, v)
+	}
+	for i, v := range r.BasedOn {
+		n.element("MedicationStatement.basedOn", i, v)
+	}
+	for i, v := range r.PartOf {
+		n.element("MedicationStatement.partOf", i, v)
+	}
+	n.primitive("MedicationStatement.status", -1, r.Status, r.StatusElement, "string")
+	for i, v := range r.StatusReason {
+		n.element("MedicationStatement.statusReason", i, v)
+	}
+	n.element("MedicationStatement.category", -1, r.Category)
+	n.element("MedicationStatement.medicationCodeableConcept", -1, r.MedicationCodeableConcept)
+	n.element("MedicationStatement.medicationReference", -1, r.MedicationReference)
+	n.element("MedicationStatement.subject", -1, r.Subject)
+	n.element("MedicationStatement.context", -1, r.Context)
+	n.primitive("MedicationStatement.effectiveDateTime", -1, r.EffectiveDateTime, r.EffectiveDateTimeElement, "dateTime")
+	n.element("MedicationStatement.effectivePeriod", -1, r.EffectivePeriod)
+	n.primitive("MedicationStatement.dateAsserted", -1, r.DateAsserted, r.DateAssertedElement, "dateTime")
+	n.element("MedicationStatement.informationSource", -1, r.InformationSource)
+	for i, v := range r.DerivedFrom {
+		n.element("MedicationStatement.derivedFrom", i, v)
+	}
+	for i, v := range r.ReasonCode {
+		n.element("MedicationStatement.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("MedicationStatement.reasonReference", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("MedicationStatement.note", i, v)
+	}
+	for i, v := range r.Dosage {
+		n.element("MedicationStatement.dosage", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicationStatement as BSON document with the structure of its FHIR JSON
+func (r MedicationStatement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicationStatement from a BSON document with the structure of its FHIR JSON
+func (r *MedicationStatement) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicationStatement as protobuf message MedicationStatement of fhir.proto
+func (r MedicationStatement) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicationStatement to the encoded message
+func (r MedicationStatement) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(10, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(11, v)
+	}
+	if r.Status != "" {
+		e.string(12, r.Status)
+	}
+	for _, v := range r.StatusReason {
+		e.message(13, v)
+	}
+	if r.Category != nil {
+		e.message(14, *r.Category)
+	}
+	if r.MedicationCodeableConcept != nil {
+		e.message(15, *r.MedicationCodeableConcept)
+	}
+	if r.MedicationReference != nil {
+		e.message(16, *r.MedicationReference)
+	}
+	e.message(17, r.Subject)
+	if r.Context != nil {
+		e.message(18, *r.Context)
+	}
+	if r.EffectiveDateTime != nil {
+		e.string(19, *r.EffectiveDateTime)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(20, *r.EffectivePeriod)
+	}
+	if r.DateAsserted != nil {
+		e.string(21, *r.DateAsserted)
+	}
+	if r.InformationSource != nil {
+		e.message(22, *r.InformationSource)
+	}
+	for _, v := range r.DerivedFrom {
+		e.message(23, v)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(24, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(25, v)
+	}
+	for _, v := range r.Note {
+		e.message(26, v)
+	}
+	for _, v := range r.Dosage {
+		e.message(27, v)
+	}
+	if r.IdElement != nil {
+		e.message(28, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(29, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(30, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(31, *r.StatusElement)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.message(32, *r.EffectiveDateTimeElement)
+	}
+	if r.DateAssertedElement != nil {
+		e.message(33, *r.DateAssertedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicationStatement from protobuf message MedicationStatement of fhir.proto
+func (r *MedicationStatement) UnmarshalProto(b []byte) error {
+	*r = MedicationStatement{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 12:
+			r.Status = d.string()
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = append(r.StatusReason, v)
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.MedicationCodeableConcept = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.MedicationReference = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Context = &v
+		case 19:
+			v := d.string()
+			r.EffectiveDateTime = &v
+		case 20:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 21:
+			v := d.string()
+			r.DateAsserted = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.InformationSource = &v
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.DerivedFrom = append(r.DerivedFrom, v)
+		case 24:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 26:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 27:
+			var v Dosage
+			d.message(&v)
+			r.Dosage = append(r.Dosage, v)
+		case 28:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.EffectiveDateTimeElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.DateAssertedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicationStatement unmarshals a MedicationStatement.
 func UnmarshalMedicationStatement(b []byte) (MedicationStatement, error) {
 	var medicationStatement MedicationStatement
-	if err := json.Unmarshal(b, &medicationStatement); err != nil {
+	if err := medicationStatement.UnmarshalJSON(b); err != nil {
 		return medicationStatement, err
 	}
 	return medicationStatement, nil
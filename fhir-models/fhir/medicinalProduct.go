@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,10 +25,14 @@ import "encoding/json"
 // MedicinalProduct is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProduct
 type MedicinalProduct struct {
 	Id                             *string                                          `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                      *Element                                         `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                           *Meta                                            `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules                  *string                                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement           *Element                                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                       *string                                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement                *Element                                         `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                           *Narrative                                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                      []json.RawMessage                                `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                      []Extension                                      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension              []Extension                                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier                     []Identifier                                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
@@ -35,6 +42,7 @@ type MedicinalProduct struct {
 	LegalStatusOfSupply            *CodeableConcept                                 `bson:"legalStatusOfSupply,omitempty" json:"legalStatusOfSupply,omitempty"`
 	AdditionalMonitoringIndicator  *CodeableConcept                                 `bson:"additionalMonitoringIndicator,omitempty" json:"additionalMonitoringIndicator,omitempty"`
 	SpecialMeasures                []string                                         `bson:"specialMeasures,omitempty" json:"specialMeasures,omitempty"`
+	SpecialMeasuresElement         []*Element                                       `bson:"_specialMeasures,omitempty" json:"_specialMeasures,omitempty"`
 	PaediatricUseIndicator         *CodeableConcept                                 `bson:"paediatricUseIndicator,omitempty" json:"paediatricUseIndicator,omitempty"`
 	ProductClassification          []CodeableConcept                                `bson:"productClassification,omitempty" json:"productClassification,omitempty"`
 	MarketingStatus                []MarketingStatus                                `bson:"marketingStatus,omitempty" json:"marketingStatus,omitempty"`
@@ -50,18 +58,20 @@ type MedicinalProduct struct {
 	SpecialDesignation             []MedicinalProductSpecialDesignation             `bson:"specialDesignation,omitempty" json:"specialDesignation,omitempty"`
 }
 type MedicinalProductName struct {
-	Id                *string                               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ProductName       string                                `bson:"productName" json:"productName"`
-	NamePart          []MedicinalProductNameNamePart        `bson:"namePart,omitempty" json:"namePart,omitempty"`
-	CountryLanguage   []MedicinalProductNameCountryLanguage `bson:"countryLanguage,omitempty" json:"countryLanguage,omitempty"`
+	Id                 *string                               `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ProductName        string                                `bson:"productName" json:"productName"`
+	ProductNameElement *Element                              `bson:"_productName,omitempty" json:"_productName,omitempty"`
+	NamePart           []MedicinalProductNameNamePart        `bson:"namePart,omitempty" json:"namePart,omitempty"`
+	CountryLanguage    []MedicinalProductNameCountryLanguage `bson:"countryLanguage,omitempty" json:"countryLanguage,omitempty"`
 }
 type MedicinalProductNameNamePart struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Part              string      `bson:"part" json:"part"`
+	PartElement       *Element    `bson:"_part,omitempty" json:"_part,omitempty"`
 	Type              Coding      `bson:"type" json:"type"`
 }
 type MedicinalProductNameCountryLanguage struct {
@@ -79,6 +89,7 @@ type MedicinalProductManufacturingBusinessOperation struct {
 	OperationType                *CodeableConcept `bson:"operationType,omitempty" json:"operationType,omitempty"`
 	AuthorisationReferenceNumber *Identifier      `bson:"authorisationReferenceNumber,omitempty" json:"authorisationReferenceNumber,omitempty"`
 	EffectiveDate                *string          `bson:"effectiveDate,omitempty" json:"effectiveDate,omitempty"`
+	EffectiveDateElement         *Element         `bson:"_effectiveDate,omitempty" json:"_effectiveDate,omitempty"`
 	ConfidentialityIndicator     *CodeableConcept `bson:"confidentialityIndicator,omitempty" json:"confidentialityIndicator,omitempty"`
 	Manufacturer                 []Reference      `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
 	Regulator                    *Reference       `bson:"regulator,omitempty" json:"regulator,omitempty"`
@@ -94,25 +105,4157 @@ type MedicinalProductSpecialDesignation struct {
 	IndicationReference       *Reference       `bson:"indicationReference,omitempty" json:"indicationReference,omitempty"`
 	Status                    *CodeableConcept `bson:"status,omitempty" json:"status,omitempty"`
 	Date                      *string          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement               *Element         `bson:"_date,omitempty" json:"_date,omitempty"`
 	Species                   *CodeableConcept `bson:"species,omitempty" json:"species,omitempty"`
 }
-type OtherMedicinalProduct MedicinalProduct
+
+// MedicinalProductSpecialDesignationIndication is implemented by the types allowed for MedicinalProduct.specialDesignation.indication[x]
+type MedicinalProductSpecialDesignationIndication interface {
+	isMedicinalProductSpecialDesignationIndication()
+}
+
+func (CodeableConcept) isMedicinalProductSpecialDesignationIndication() {}
+func (Reference) isMedicinalProductSpecialDesignationIndication()       {}
+
+// Indication returns the value of MedicinalProduct.specialDesignation.indication[x] together with its FHIR type code or nil if no value is set
+func (r MedicinalProductSpecialDesignation) Indication() (MedicinalProductSpecialDesignationIndication, string) {
+	if r.IndicationCodeableConcept != nil {
+		return *r.IndicationCodeableConcept, "CodeableConcept"
+	}
+	if r.IndicationReference != nil {
+		return *r.IndicationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetIndication sets the value of MedicinalProduct.specialDesignation.indication[x], given as value or pointer, and clears all other types
+func (r *MedicinalProductSpecialDesignation) SetIndication(value MedicinalProductSpecialDesignationIndication) {
+	r.IndicationCodeableConcept = nil
+	r.IndicationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.IndicationCodeableConcept = &v
+	case *CodeableConcept:
+		r.IndicationCodeableConcept = v
+	case Reference:
+		r.IndicationReference = &v
+	case *Reference:
+		r.IndicationReference = v
+	}
+}
 
 // MarshalJSON marshals the given MedicinalProduct as JSON into a byte slice
 func (r MedicinalProduct) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicinalProduct
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicinalProduct: OtherMedicinalProduct(r),
-		ResourceType:          "MedicinalProduct",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProduct as JSON object with the members in the order of the fields
+func (r MedicinalProduct) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicinalProduct\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Domain != nil {
+		e.key("domain")
+		r.Domain.appendJSON(e)
+	}
+	if r.CombinedPharmaceuticalDoseForm != nil {
+		e.key("combinedPharmaceuticalDoseForm")
+		r.CombinedPharmaceuticalDoseForm.appendJSON(e)
+	}
+	if r.LegalStatusOfSupply != nil {
+		e.key("legalStatusOfSupply")
+		r.LegalStatusOfSupply.appendJSON(e)
+	}
+	if r.AdditionalMonitoringIndicator != nil {
+		e.key("additionalMonitoringIndicator")
+		r.AdditionalMonitoringIndicator.appendJSON(e)
+	}
+	if len(r.SpecialMeasures) > 0 {
+		e.key("specialMeasures")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SpecialMeasures {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SpecialMeasuresElement) > 0 {
+		e.key("_specialMeasures")
+		e.elements(r.SpecialMeasuresElement, len(r.SpecialMeasures))
+	}
+	if r.PaediatricUseIndicator != nil {
+		e.key("paediatricUseIndicator")
+		r.PaediatricUseIndicator.appendJSON(e)
+	}
+	if len(r.ProductClassification) > 0 {
+		e.key("productClassification")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProductClassification {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.MarketingStatus) > 0 {
+		e.key("marketingStatus")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.MarketingStatus {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PharmaceuticalProduct) > 0 {
+		e.key("pharmaceuticalProduct")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PharmaceuticalProduct {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PackagedMedicinalProduct) > 0 {
+		e.key("packagedMedicinalProduct")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PackagedMedicinalProduct {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AttachedDocument) > 0 {
+		e.key("attachedDocument")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AttachedDocument {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.MasterFile) > 0 {
+		e.key("masterFile")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.MasterFile {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ClinicalTrial) > 0 {
+		e.key("clinicalTrial")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ClinicalTrial {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	if r.Name == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Name {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CrossReference) > 0 {
+		e.key("crossReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CrossReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ManufacturingBusinessOperation) > 0 {
+		e.key("manufacturingBusinessOperation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ManufacturingBusinessOperation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SpecialDesignation) > 0 {
+		e.key("specialDesignation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SpecialDesignation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProduct from JSON. Unknown members are ignored.
+func (r *MedicinalProduct) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProduct
+func (r *MedicinalProduct) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "domain":
+			if d.null() {
+				r.Domain = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Domain = &v
+			}
+		case "combinedPharmaceuticalDoseForm":
+			if d.null() {
+				r.CombinedPharmaceuticalDoseForm = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CombinedPharmaceuticalDoseForm = &v
+			}
+		case "legalStatusOfSupply":
+			if d.null() {
+				r.LegalStatusOfSupply = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LegalStatusOfSupply = &v
+			}
+		case "additionalMonitoringIndicator":
+			if d.null() {
+				r.AdditionalMonitoringIndicator = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdditionalMonitoringIndicator = &v
+			}
+		case "specialMeasures":
+			a := d.array()
+			r.SpecialMeasures = nil
+			for a.next() {
+				v, _ := d.string()
+				r.SpecialMeasures = append(r.SpecialMeasures, v)
+			}
+			if r.SpecialMeasures == nil && !a.isNull {
+				r.SpecialMeasures = []string{}
+			}
+		case "_specialMeasures":
+			a := d.array()
+			r.SpecialMeasuresElement = nil
+			for a.next() {
+				r.SpecialMeasuresElement = append(r.SpecialMeasuresElement, d.element())
+			}
+		case "paediatricUseIndicator":
+			if d.null() {
+				r.PaediatricUseIndicator = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PaediatricUseIndicator = &v
+			}
+		case "productClassification":
+			a := d.array()
+			r.ProductClassification = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ProductClassification = append(r.ProductClassification, v)
+			}
+			if r.ProductClassification == nil && !a.isNull {
+				r.ProductClassification = []CodeableConcept{}
+			}
+		case "marketingStatus":
+			a := d.array()
+			r.MarketingStatus = nil
+			for a.next() {
+				var v MarketingStatus
+				v.decodeJSON(d)
+				r.MarketingStatus = append(r.MarketingStatus, v)
+			}
+			if r.MarketingStatus == nil && !a.isNull {
+				r.MarketingStatus = []MarketingStatus{}
+			}
+		case "pharmaceuticalProduct":
+			a := d.array()
+			r.PharmaceuticalProduct = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PharmaceuticalProduct = append(r.PharmaceuticalProduct, v)
+			}
+			if r.PharmaceuticalProduct == nil && !a.isNull {
+				r.PharmaceuticalProduct = []Reference{}
+			}
+		case "packagedMedicinalProduct":
+			a := d.array()
+			r.PackagedMedicinalProduct = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PackagedMedicinalProduct = append(r.PackagedMedicinalProduct, v)
+			}
+			if r.PackagedMedicinalProduct == nil && !a.isNull {
+				r.PackagedMedicinalProduct = []Reference{}
+			}
+		case "attachedDocument":
+			a := d.array()
+			r.AttachedDocument = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.AttachedDocument = append(r.AttachedDocument, v)
+			}
+			if r.AttachedDocument == nil && !a.isNull {
+				r.AttachedDocument = []Reference{}
+			}
+		case "masterFile":
+			a := d.array()
+			r.MasterFile = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.MasterFile = append(r.MasterFile, v)
+			}
+			if r.MasterFile == nil && !a.isNull {
+				r.MasterFile = []Reference{}
+			}
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []Reference{}
+			}
+		case "clinicalTrial":
+			a := d.array()
+			r.ClinicalTrial = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ClinicalTrial = append(r.ClinicalTrial, v)
+			}
+			if r.ClinicalTrial == nil && !a.isNull {
+				r.ClinicalTrial = []Reference{}
+			}
+		case "name":
+			a := d.array()
+			r.Name = nil
+			for a.next() {
+				var v MedicinalProductName
+				v.decodeJSON(d)
+				r.Name = append(r.Name, v)
+			}
+			if r.Name == nil && !a.isNull {
+				r.Name = []MedicinalProductName{}
+			}
+		case "crossReference":
+			a := d.array()
+			r.CrossReference = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.CrossReference = append(r.CrossReference, v)
+			}
+			if r.CrossReference == nil && !a.isNull {
+				r.CrossReference = []Identifier{}
+			}
+		case "manufacturingBusinessOperation":
+			a := d.array()
+			r.ManufacturingBusinessOperation = nil
+			for a.next() {
+				var v MedicinalProductManufacturingBusinessOperation
+				v.decodeJSON(d)
+				r.ManufacturingBusinessOperation = append(r.ManufacturingBusinessOperation, v)
+			}
+			if r.ManufacturingBusinessOperation == nil && !a.isNull {
+				r.ManufacturingBusinessOperation = []MedicinalProductManufacturingBusinessOperation{}
+			}
+		case "specialDesignation":
+			a := d.array()
+			r.SpecialDesignation = nil
+			for a.next() {
+				var v MedicinalProductSpecialDesignation
+				v.decodeJSON(d)
+				r.SpecialDesignation = append(r.SpecialDesignation, v)
+			}
+			if r.SpecialDesignation == nil && !a.isNull {
+				r.SpecialDesignation = []MedicinalProductSpecialDesignation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProduct which shares no memory with the original
+func (r MedicinalProduct) DeepCopy() MedicinalProduct {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Domain != nil {
+		v := r.Domain.DeepCopy()
+		out.Domain = &v
+	}
+	if r.CombinedPharmaceuticalDoseForm != nil {
+		v := r.CombinedPharmaceuticalDoseForm.DeepCopy()
+		out.CombinedPharmaceuticalDoseForm = &v
+	}
+	if r.LegalStatusOfSupply != nil {
+		v := r.LegalStatusOfSupply.DeepCopy()
+		out.LegalStatusOfSupply = &v
+	}
+	if r.AdditionalMonitoringIndicator != nil {
+		v := r.AdditionalMonitoringIndicator.DeepCopy()
+		out.AdditionalMonitoringIndicator = &v
+	}
+	if r.SpecialMeasures != nil {
+		out.SpecialMeasures = make([]string, len(r.SpecialMeasures))
+		copy(out.SpecialMeasures, r.SpecialMeasures)
+	}
+	if r.SpecialMeasuresElement != nil {
+		out.SpecialMeasuresElement = make([]*Element, len(r.SpecialMeasuresElement))
+		for i, v := range r.SpecialMeasuresElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SpecialMeasuresElement[i] = &c
+			}
+		}
+	}
+	if r.PaediatricUseIndicator != nil {
+		v := r.PaediatricUseIndicator.DeepCopy()
+		out.PaediatricUseIndicator = &v
+	}
+	if r.ProductClassification != nil {
+		out.ProductClassification = make([]CodeableConcept, len(r.ProductClassification))
+		for i := range r.ProductClassification {
+			out.ProductClassification[i] = r.ProductClassification[i].DeepCopy()
+		}
+	}
+	if r.MarketingStatus != nil {
+		out.MarketingStatus = make([]MarketingStatus, len(r.MarketingStatus))
+		for i := range r.MarketingStatus {
+			out.MarketingStatus[i] = r.MarketingStatus[i].DeepCopy()
+		}
+	}
+	if r.PharmaceuticalProduct != nil {
+		out.PharmaceuticalProduct = make([]Reference, len(r.PharmaceuticalProduct))
+		for i := range r.PharmaceuticalProduct {
+			out.PharmaceuticalProduct[i] = r.PharmaceuticalProduct[i].DeepCopy()
+		}
+	}
+	if r.PackagedMedicinalProduct != nil {
+		out.PackagedMedicinalProduct = make([]Reference, len(r.PackagedMedicinalProduct))
+		for i := range r.PackagedMedicinalProduct {
+			out.PackagedMedicinalProduct[i] = r.PackagedMedicinalProduct[i].DeepCopy()
+		}
+	}
+	if r.AttachedDocument != nil {
+		out.AttachedDocument = make([]Reference, len(r.AttachedDocument))
+		for i := range r.AttachedDocument {
+			out.AttachedDocument[i] = r.AttachedDocument[i].DeepCopy()
+		}
+	}
+	if r.MasterFile != nil {
+		out.MasterFile = make([]Reference, len(r.MasterFile))
+		for i := range r.MasterFile {
+			out.MasterFile[i] = r.MasterFile[i].DeepCopy()
+		}
+	}
+	if r.Contact != nil {
+		out.Contact = make([]Reference, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.ClinicalTrial != nil {
+		out.ClinicalTrial = make([]Reference, len(r.ClinicalTrial))
+		for i := range r.ClinicalTrial {
+			out.ClinicalTrial[i] = r.ClinicalTrial[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		out.Name = make([]MedicinalProductName, len(r.Name))
+		for i := range r.Name {
+			out.Name[i] = r.Name[i].DeepCopy()
+		}
+	}
+	if r.CrossReference != nil {
+		out.CrossReference = make([]Identifier, len(r.CrossReference))
+		for i := range r.CrossReference {
+			out.CrossReference[i] = r.CrossReference[i].DeepCopy()
+		}
+	}
+	if r.ManufacturingBusinessOperation != nil {
+		out.ManufacturingBusinessOperation = make([]MedicinalProductManufacturingBusinessOperation, len(r.ManufacturingBusinessOperation))
+		for i := range r.ManufacturingBusinessOperation {
+			out.ManufacturingBusinessOperation[i] = r.ManufacturingBusinessOperation[i].DeepCopy()
+		}
+	}
+	if r.SpecialDesignation != nil {
+		out.SpecialDesignation = make([]MedicinalProductSpecialDesignation, len(r.SpecialDesignation))
+		for i := range r.SpecialDesignation {
+			out.SpecialDesignation[i] = r.SpecialDesignation[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProduct) Equal(other MedicinalProduct) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Domain == nil) != (other.Domain == nil) || r.Domain != nil && !r.Domain.Equal(*other.Domain) {
+		return false
+	}
+	if (r.CombinedPharmaceuticalDoseForm == nil) != (other.CombinedPharmaceuticalDoseForm == nil) || r.CombinedPharmaceuticalDoseForm != nil && !r.CombinedPharmaceuticalDoseForm.Equal(*other.CombinedPharmaceuticalDoseForm) {
+		return false
+	}
+	if (r.LegalStatusOfSupply == nil) != (other.LegalStatusOfSupply == nil) || r.LegalStatusOfSupply != nil && !r.LegalStatusOfSupply.Equal(*other.LegalStatusOfSupply) {
+		return false
+	}
+	if (r.AdditionalMonitoringIndicator == nil) != (other.AdditionalMonitoringIndicator == nil) || r.AdditionalMonitoringIndicator != nil && !r.AdditionalMonitoringIndicator.Equal(*other.AdditionalMonitoringIndicator) {
+		return false
+	}
+	if len(r.SpecialMeasures) != len(other.SpecialMeasures) {
+		return false
+	}
+	for i := range r.SpecialMeasures {
+		if r.SpecialMeasures[i] != other.SpecialMeasures[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SpecialMeasuresElement, other.SpecialMeasuresElement, false) {
+		return false
+	}
+	if (r.PaediatricUseIndicator == nil) != (other.PaediatricUseIndicator == nil) || r.PaediatricUseIndicator != nil && !r.PaediatricUseIndicator.Equal(*other.PaediatricUseIndicator) {
+		return false
+	}
+	if len(r.ProductClassification) != len(other.ProductClassification) {
+		return false
+	}
+	for i := range r.ProductClassification {
+		if !r.ProductClassification[i].Equal(other.ProductClassification[i]) {
+			return false
+		}
+	}
+	if len(r.MarketingStatus) != len(other.MarketingStatus) {
+		return false
+	}
+	for i := range r.MarketingStatus {
+		if !r.MarketingStatus[i].Equal(other.MarketingStatus[i]) {
+			return false
+		}
+	}
+	if len(r.PharmaceuticalProduct) != len(other.PharmaceuticalProduct) {
+		return false
+	}
+	for i := range r.PharmaceuticalProduct {
+		if !r.PharmaceuticalProduct[i].Equal(other.PharmaceuticalProduct[i]) {
+			return false
+		}
+	}
+	if len(r.PackagedMedicinalProduct) != len(other.PackagedMedicinalProduct) {
+		return false
+	}
+	for i := range r.PackagedMedicinalProduct {
+		if !r.PackagedMedicinalProduct[i].Equal(other.PackagedMedicinalProduct[i]) {
+			return false
+		}
+	}
+	if len(r.AttachedDocument) != len(other.AttachedDocument) {
+		return false
+	}
+	for i := range r.AttachedDocument {
+		if !r.AttachedDocument[i].Equal(other.AttachedDocument[i]) {
+			return false
+		}
+	}
+	if len(r.MasterFile) != len(other.MasterFile) {
+		return false
+	}
+	for i := range r.MasterFile {
+		if !r.MasterFile[i].Equal(other.MasterFile[i]) {
+			return false
+		}
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if len(r.ClinicalTrial) != len(other.ClinicalTrial) {
+		return false
+	}
+	for i := range r.ClinicalTrial {
+		if !r.ClinicalTrial[i].Equal(other.ClinicalTrial[i]) {
+			return false
+		}
+	}
+	if len(r.Name) != len(other.Name) {
+		return false
+	}
+	for i := range r.Name {
+		if !r.Name[i].Equal(other.Name[i]) {
+			return false
+		}
+	}
+	if len(r.CrossReference) != len(other.CrossReference) {
+		return false
+	}
+	for i := range r.CrossReference {
+		if !r.CrossReference[i].Equal(other.CrossReference[i]) {
+			return false
+		}
+	}
+	if len(r.ManufacturingBusinessOperation) != len(other.ManufacturingBusinessOperation) {
+		return false
+	}
+	for i := range r.ManufacturingBusinessOperation {
+		if !r.ManufacturingBusinessOperation[i].Equal(other.ManufacturingBusinessOperation[i]) {
+			return false
+		}
+	}
+	if len(r.SpecialDesignation) != len(other.SpecialDesignation) {
+		return false
+	}
+	for i := range r.SpecialDesignation {
+		if !r.SpecialDesignation[i].Equal(other.SpecialDesignation[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProduct) EqualsDeep(other MedicinalProduct) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Domain == nil) != (other.Domain == nil) || r.Domain != nil && !r.Domain.EqualsDeep(*other.Domain) {
+		return false
+	}
+	if (r.CombinedPharmaceuticalDoseForm == nil) != (other.CombinedPharmaceuticalDoseForm == nil) || r.CombinedPharmaceuticalDoseForm != nil && !r.CombinedPharmaceuticalDoseForm.EqualsDeep(*other.CombinedPharmaceuticalDoseForm) {
+		return false
+	}
+	if (r.LegalStatusOfSupply == nil) != (other.LegalStatusOfSupply == nil) || r.LegalStatusOfSupply != nil && !r.LegalStatusOfSupply.EqualsDeep(*other.LegalStatusOfSupply) {
+		return false
+	}
+	if (r.AdditionalMonitoringIndicator == nil) != (other.AdditionalMonitoringIndicator == nil) || r.AdditionalMonitoringIndicator != nil && !r.AdditionalMonitoringIndicator.EqualsDeep(*other.AdditionalMonitoringIndicator) {
+		return false
+	}
+	if !equivalentList(len(r.SpecialMeasures), len(other.SpecialMeasures), func(i, j int) bool {
+		return equivalentString(r.SpecialMeasures[i], other.SpecialMeasures[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.SpecialMeasuresElement, other.SpecialMeasuresElement, true) {
+		return false
+	}
+	if (r.PaediatricUseIndicator == nil) != (other.PaediatricUseIndicator == nil) || r.PaediatricUseIndicator != nil && !r.PaediatricUseIndicator.EqualsDeep(*other.PaediatricUseIndicator) {
+		return false
+	}
+	if !equivalentList(len(r.ProductClassification), len(other.ProductClassification), func(i, j int) bool {
+		return r.ProductClassification[i].EqualsDeep(other.ProductClassification[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.MarketingStatus), len(other.MarketingStatus), func(i, j int) bool {
+		return r.MarketingStatus[i].EqualsDeep(other.MarketingStatus[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PharmaceuticalProduct), len(other.PharmaceuticalProduct), func(i, j int) bool {
+		return r.PharmaceuticalProduct[i].EqualsDeep(other.PharmaceuticalProduct[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PackagedMedicinalProduct), len(other.PackagedMedicinalProduct), func(i, j int) bool {
+		return r.PackagedMedicinalProduct[i].EqualsDeep(other.PackagedMedicinalProduct[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.AttachedDocument), len(other.AttachedDocument), func(i, j int) bool {
+		return r.AttachedDocument[i].EqualsDeep(other.AttachedDocument[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.MasterFile), len(other.MasterFile), func(i, j int) bool {
+		return r.MasterFile[i].EqualsDeep(other.MasterFile[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ClinicalTrial), len(other.ClinicalTrial), func(i, j int) bool {
+		return r.ClinicalTrial[i].EqualsDeep(other.ClinicalTrial[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Name), len(other.Name), func(i, j int) bool {
+		return r.Name[i].EqualsDeep(other.Name[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.CrossReference), len(other.CrossReference), func(i, j int) bool {
+		return r.CrossReference[i].EqualsDeep(other.CrossReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ManufacturingBusinessOperation), len(other.ManufacturingBusinessOperation), func(i, j int) bool {
+		return r.ManufacturingBusinessOperation[i].EqualsDeep(other.ManufacturingBusinessOperation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SpecialDesignation), len(other.SpecialDesignation), func(i, j int) bool {
+		return r.SpecialDesignation[i].EqualsDeep(other.SpecialDesignation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProduct) EqualsShallow(other MedicinalProduct) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.SpecialMeasures), len(other.SpecialMeasures), func(i, j int) bool {
+		return equivalentString(r.SpecialMeasures[i], other.SpecialMeasures[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProduct as FHIR XML
+func (r MedicinalProduct) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicinalProduct",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "domain", r.Domain); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "combinedPharmaceuticalDoseForm", r.CombinedPharmaceuticalDoseForm); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "legalStatusOfSupply", r.LegalStatusOfSupply); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "additionalMonitoringIndicator", r.AdditionalMonitoringIndicator); err != nil {
+		return err
+	}
+	for i, v := range r.SpecialMeasures {
+		if err := encodeXMLPrimitive(e, "specialMeasures", v, elementAt(r.SpecialMeasuresElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "paediatricUseIndicator", r.PaediatricUseIndicator); err != nil {
+		return err
+	}
+	for _, v := range r.ProductClassification {
+		if err := encodeXMLElement(e, "productClassification", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.MarketingStatus {
+		if err := encodeXMLElement(e, "marketingStatus", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PharmaceuticalProduct {
+		if err := encodeXMLElement(e, "pharmaceuticalProduct", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PackagedMedicinalProduct {
+		if err := encodeXMLElement(e, "packagedMedicinalProduct", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.AttachedDocument {
+		if err := encodeXMLElement(e, "attachedDocument", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.MasterFile {
+		if err := encodeXMLElement(e, "masterFile", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ClinicalTrial {
+		if err := encodeXMLElement(e, "clinicalTrial", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Name {
+		if err := encodeXMLElement(e, "name", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CrossReference {
+		if err := encodeXMLElement(e, "crossReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ManufacturingBusinessOperation {
+		if err := encodeXMLElement(e, "manufacturingBusinessOperation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SpecialDesignation {
+		if err := encodeXMLElement(e, "specialDesignation", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProduct from FHIR XML
+func (r *MedicinalProduct) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "domain":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Domain = &v
+			case "combinedPharmaceuticalDoseForm":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CombinedPharmaceuticalDoseForm = &v
+			case "legalStatusOfSupply":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LegalStatusOfSupply = &v
+			case "additionalMonitoringIndicator":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditionalMonitoringIndicator = &v
+			case "specialMeasures":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SpecialMeasuresElement = appendElement(r.SpecialMeasuresElement, len(r.SpecialMeasures), element)
+					r.SpecialMeasures = append(r.SpecialMeasures, v)
+				}
+			case "paediatricUseIndicator":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PaediatricUseIndicator = &v
+			case "productClassification":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProductClassification = append(r.ProductClassification, v)
+			case "marketingStatus":
+				var v MarketingStatus
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MarketingStatus = append(r.MarketingStatus, v)
+			case "pharmaceuticalProduct":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PharmaceuticalProduct = append(r.PharmaceuticalProduct, v)
+			case "packagedMedicinalProduct":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PackagedMedicinalProduct = append(r.PackagedMedicinalProduct, v)
+			case "attachedDocument":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AttachedDocument = append(r.AttachedDocument, v)
+			case "masterFile":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MasterFile = append(r.MasterFile, v)
+			case "contact":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "clinicalTrial":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ClinicalTrial = append(r.ClinicalTrial, v)
+			case "name":
+				var v MedicinalProductName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Name = append(r.Name, v)
+			case "crossReference":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CrossReference = append(r.CrossReference, v)
+			case "manufacturingBusinessOperation":
+				var v MedicinalProductManufacturingBusinessOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManufacturingBusinessOperation = append(r.ManufacturingBusinessOperation, v)
+			case "specialDesignation":
+				var v MedicinalProductSpecialDesignation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SpecialDesignation = append(r.SpecialDesignation, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProduct as properties to the RDF node n
+func (r MedicinalProduct) turtle(n *turtleNode) {
+	n.resource("MedicinalProduct", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicinalProduct.identifier", i, v)
+	}
+	n.element("MedicinalProduct.type", -1, r.Type)
+	n.element("MedicinalProduct.domain", -1, r.Domain)
+	n.element("MedicinalProduct.combinedPharmaceuticalDoseForm", -1, r.CombinedPharmaceuticalDoseForm)
+	n.element("MedicinalProduct.legalStatusOfSupply", -1, r.LegalStatusOfSupply)
+	n.element("MedicinalProduct.additionalMonitoringIndicator", -1, r.AdditionalMonitoringIndicator)
+	for i, v := range r.SpecialMeasures {
+		n.primitive("MedicinalProduct.specialMeasures", i, v, elementAt(r.SpecialMeasuresElement, i), "string")
+	}
+	n.element("MedicinalProduct.paediatricUseIndicator", -1, r.PaediatricUseIndicator)
+	for i, v := range r.ProductClassification {
+		n.element("MedicinalProduct.productClassification", i, v)
+	}
+	for i, v := range r.MarketingStatus {
+		n.element("MedicinalProduct.marketingStatus", i, v)
+	}
+	for i, v := range r.PharmaceuticalProduct {
+		n.element("MedicinalProduct.pharmaceuticalProduct", i, v)
+	}
+	for i, v := range r.PackagedMedicinalProduct {
+		n.element("MedicinalProduct.packagedMedicinalProduct", i, v)
+	}
+	for i, v := range r.AttachedDocument {
+		n.element("MedicinalProduct.attachedDocument", i, v)
+	}
+	for i, v := range r.MasterFile {
+		n.element("MedicinalProduct.masterFile", i, v)
+	}
+	for i, v := range r.Contact {
+		n.element("MedicinalProduct.contact", i, v)
+	}
+	for i, v := range r.ClinicalTrial {
+		n.element("MedicinalProduct.clinicalTrial", i, v)
+	}
+	for i, v := range r.Name {
+		n.element("MedicinalProduct.name", i, v)
+	}
+	for i, v := range r.CrossReference {
+		n.element("MedicinalProduct.crossReference", i, v)
+	}
+	for i, v := range r.ManufacturingBusinessOperation {
+		n.element("MedicinalProduct.manufacturingBusinessOperation", i, v)
+	}
+	for i, v := range r.SpecialDesignation {
+		n.element("MedicinalProduct.specialDesignation", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProduct as BSON document with the structure of its FHIR JSON
+func (r MedicinalProduct) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProduct from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProduct) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProduct as protobuf message MedicinalProduct of fhir.proto
+func (r MedicinalProduct) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProduct to the encoded message
+func (r MedicinalProduct) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Type != nil {
+		e.message(10, *r.Type)
+	}
+	if r.Domain != nil {
+		e.message(11, *r.Domain)
+	}
+	if r.CombinedPharmaceuticalDoseForm != nil {
+		e.message(12, *r.CombinedPharmaceuticalDoseForm)
+	}
+	if r.LegalStatusOfSupply != nil {
+		e.message(13, *r.LegalStatusOfSupply)
+	}
+	if r.AdditionalMonitoringIndicator != nil {
+		e.message(14, *r.AdditionalMonitoringIndicator)
+	}
+	for _, v := range r.SpecialMeasures {
+		e.string(15, v)
+	}
+	if r.PaediatricUseIndicator != nil {
+		e.message(16, *r.PaediatricUseIndicator)
+	}
+	for _, v := range r.ProductClassification {
+		e.message(17, v)
+	}
+	for _, v := range r.MarketingStatus {
+		e.message(18, v)
+	}
+	for _, v := range r.PharmaceuticalProduct {
+		e.message(19, v)
+	}
+	for _, v := range r.PackagedMedicinalProduct {
+		e.message(20, v)
+	}
+	for _, v := range r.AttachedDocument {
+		e.message(21, v)
+	}
+	for _, v := range r.MasterFile {
+		e.message(22, v)
+	}
+	for _, v := range r.Contact {
+		e.message(23, v)
+	}
+	for _, v := range r.ClinicalTrial {
+		e.message(24, v)
+	}
+	for _, v := range r.Name {
+		e.message(25, v)
+	}
+	for _, v := range r.CrossReference {
+		e.message(26, v)
+	}
+	for _, v := range r.ManufacturingBusinessOperation {
+		e.message(27, v)
+	}
+	for _, v := range r.SpecialDesignation {
+		e.message(28, v)
+	}
+	if r.IdElement != nil {
+		e.message(29, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(30, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(31, *r.LanguageElement)
+	}
+	for _, v := range r.SpecialMeasuresElement {
+		e.element(32, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProduct from protobuf message MedicinalProduct of fhir.proto
+func (r *MedicinalProduct) UnmarshalProto(b []byte) error {
+	*r = MedicinalProduct{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 11:
+			var v Coding
+			d.message(&v)
+			r.Domain = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.CombinedPharmaceuticalDoseForm = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.LegalStatusOfSupply = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdditionalMonitoringIndicator = &v
+		case 15:
+			r.SpecialMeasures = append(r.SpecialMeasures, d.string())
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.PaediatricUseIndicator = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.ProductClassification = append(r.ProductClassification, v)
+		case 18:
+			var v MarketingStatus
+			d.message(&v)
+			r.MarketingStatus = append(r.MarketingStatus, v)
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.PharmaceuticalProduct = append(r.PharmaceuticalProduct, v)
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.PackagedMedicinalProduct = append(r.PackagedMedicinalProduct, v)
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.AttachedDocument = append(r.AttachedDocument, v)
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.MasterFile = append(r.MasterFile, v)
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.ClinicalTrial = append(r.ClinicalTrial, v)
+		case 25:
+			var v MedicinalProductName
+			d.message(&v)
+			r.Name = append(r.Name, v)
+		case 26:
+			var v Identifier
+			d.message(&v)
+			r.CrossReference = append(r.CrossReference, v)
+		case 27:
+			var v MedicinalProductManufacturingBusinessOperation
+			d.message(&v)
+			r.ManufacturingBusinessOperation = append(r.ManufacturingBusinessOperation, v)
+		case 28:
+			var v MedicinalProductSpecialDesignation
+			d.message(&v)
+			r.SpecialDesignation = append(r.SpecialDesignation, v)
+		case 29:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 32:
+			r.SpecialMeasuresElement = append(r.SpecialMeasuresElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductName as JSON into a byte slice
+func (r MedicinalProductName) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductName as JSON object with the members in the order of the fields
+func (r MedicinalProductName) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("productName")
+	e.string(r.ProductName)
+	if r.ProductNameElement != nil {
+		e.key("_productName")
+		r.ProductNameElement.appendJSON(e)
+	}
+	if len(r.NamePart) > 0 {
+		e.key("namePart")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NamePart {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CountryLanguage) > 0 {
+		e.key("countryLanguage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CountryLanguage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductName from JSON. Unknown members are ignored.
+func (r *MedicinalProductName) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductName
+func (r *MedicinalProductName) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "productName":
+			if v, ok := d.string(); ok {
+				r.ProductName = v
+			}
+		case "_productName":
+			r.ProductNameElement = d.element()
+		case "namePart":
+			a := d.array()
+			r.NamePart = nil
+			for a.next() {
+				var v MedicinalProductNameNamePart
+				v.decodeJSON(d)
+				r.NamePart = append(r.NamePart, v)
+			}
+			if r.NamePart == nil && !a.isNull {
+				r.NamePart = []MedicinalProductNameNamePart{}
+			}
+		case "countryLanguage":
+			a := d.array()
+			r.CountryLanguage = nil
+			for a.next() {
+				var v MedicinalProductNameCountryLanguage
+				v.decodeJSON(d)
+				r.CountryLanguage = append(r.CountryLanguage, v)
+			}
+			if r.CountryLanguage == nil && !a.isNull {
+				r.CountryLanguage = []MedicinalProductNameCountryLanguage{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductName which shares no memory with the original
+func (r MedicinalProductName) DeepCopy() MedicinalProductName {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ProductNameElement != nil {
+		v := r.ProductNameElement.DeepCopy()
+		out.ProductNameElement = &v
+	}
+	if r.NamePart != nil {
+		out.NamePart = make([]MedicinalProductNameNamePart, len(r.NamePart))
+		for i := range r.NamePart {
+			out.NamePart[i] = r.NamePart[i].DeepCopy()
+		}
+	}
+	if r.CountryLanguage != nil {
+		out.CountryLanguage = make([]MedicinalProductNameCountryLanguage, len(r.CountryLanguage))
+		for i := range r.CountryLanguage {
+			out.CountryLanguage[i] = r.CountryLanguage[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductName) Equal(other MedicinalProductName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.ProductName != other.ProductName {
+		return false
+	}
+	if (r.ProductNameElement == nil) != (other.ProductNameElement == nil) || r.ProductNameElement != nil && !r.ProductNameElement.Equal(*other.ProductNameElement) {
+		return false
+	}
+	if len(r.NamePart) != len(other.NamePart) {
+		return false
+	}
+	for i := range r.NamePart {
+		if !r.NamePart[i].Equal(other.NamePart[i]) {
+			return false
+		}
+	}
+	if len(r.CountryLanguage) != len(other.CountryLanguage) {
+		return false
+	}
+	for i := range r.CountryLanguage {
+		if !r.CountryLanguage[i].Equal(other.CountryLanguage[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductName) EqualsDeep(other MedicinalProductName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.ProductName, other.ProductName) {
+		return false
+	}
+	if (r.ProductNameElement == nil) != (other.ProductNameElement == nil) || r.ProductNameElement != nil && !r.ProductNameElement.EqualsDeep(*other.ProductNameElement) {
+		return false
+	}
+	if !equivalentList(len(r.NamePart), len(other.NamePart), func(i, j int) bool {
+		return r.NamePart[i].EqualsDeep(other.NamePart[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.CountryLanguage), len(other.CountryLanguage), func(i, j int) bool {
+		return r.CountryLanguage[i].EqualsDeep(other.CountryLanguage[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductName) EqualsShallow(other MedicinalProductName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.ProductName, other.ProductName) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductName as FHIR XML
+func (r MedicinalProductName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "productName", r.ProductName, r.ProductNameElement); err != nil {
+		return err
+	}
+	for _, v := range r.NamePart {
+		if err := encodeXMLElement(e, "namePart", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CountryLanguage {
+		if err := encodeXMLElement(e, "countryLanguage", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductName from FHIR XML
+func (r *MedicinalProductName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "productName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ProductName = v
+				}
+				r.ProductNameElement = element
+			case "namePart":
+				var v MedicinalProductNameNamePart
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.NamePart = append(r.NamePart, v)
+			case "countryLanguage":
+				var v MedicinalProductNameCountryLanguage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CountryLanguage = append(r.CountryLanguage, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductName as properties to the RDF node n
+func (r MedicinalProductName) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MedicinalProduct.name.productName", -1, r.ProductName, r.ProductNameElement, "string")
+	for i, v := range r.NamePart {
+		n.element("MedicinalProduct.name.namePart", i, v)
+	}
+	for i, v := range r.CountryLanguage {
+		n.element("MedicinalProduct.name.countryLanguage", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductName as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductName from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductName) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductName as protobuf message MedicinalProductName of fhir.proto
+func (r MedicinalProductName) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductName to the encoded message
+func (r MedicinalProductName) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ProductName != "" {
+		e.string(4, r.ProductName)
+	}
+	for _, v := range r.NamePart {
+		e.message(5, v)
+	}
+	for _, v := range r.CountryLanguage {
+		e.message(6, v)
+	}
+	if r.ProductNameElement != nil {
+		e.message(7, *r.ProductNameElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductName from protobuf message MedicinalProductName of fhir.proto
+func (r *MedicinalProductName) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductName{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.ProductName = d.string()
+		case 5:
+			var v MedicinalProductNameNamePart
+			d.message(&v)
+			r.NamePart = append(r.NamePart, v)
+		case 6:
+			var v MedicinalProductNameCountryLanguage
+			d.message(&v)
+			r.CountryLanguage = append(r.CountryLanguage, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ProductNameElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductNameNamePart as JSON into a byte slice
+func (r MedicinalProductNameNamePart) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductNameNamePart as JSON object with the members in the order of the fields
+func (r MedicinalProductNameNamePart) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("part")
+	e.string(r.Part)
+	if r.PartElement != nil {
+		e.key("_part")
+		r.PartElement.appendJSON(e)
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductNameNamePart from JSON. Unknown members are ignored.
+func (r *MedicinalProductNameNamePart) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductNameNamePart
+func (r *MedicinalProductNameNamePart) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "part":
+			if v, ok := d.string(); ok {
+				r.Part = v
+			}
+		case "_part":
+			r.PartElement = d.element()
+		case "type":
+			r.Type.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductNameNamePart which shares no memory with the original
+func (r MedicinalProductNameNamePart) DeepCopy() MedicinalProductNameNamePart {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.PartElement != nil {
+		v := r.PartElement.DeepCopy()
+		out.PartElement = &v
+	}
+	out.Type = r.Type.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductNameNamePart) Equal(other MedicinalProductNameNamePart) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Part != other.Part {
+		return false
+	}
+	if (r.PartElement == nil) != (other.PartElement == nil) || r.PartElement != nil && !r.PartElement.Equal(*other.PartElement) {
+		return false
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductNameNamePart) EqualsDeep(other MedicinalProductNameNamePart) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Part, other.Part) {
+		return false
+	}
+	if (r.PartElement == nil) != (other.PartElement == nil) || r.PartElement != nil && !r.PartElement.EqualsDeep(*other.PartElement) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductNameNamePart) EqualsShallow(other MedicinalProductNameNamePart) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Part, other.Part) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductNameNamePart as FHIR XML
+func (r MedicinalProductNameNamePart) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "part", r.Part, r.PartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductNameNamePart from FHIR XML
+func (r *MedicinalProductNameNamePart) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "part":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Part = v
+				}
+				r.PartElement = element
+			case "type":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductNameNamePart as properties to the RDF node n
+func (r MedicinalProductNameNamePart) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MedicinalProduct.name.namePart.part", -1, r.Part, r.PartElement, "string")
+	n.element("MedicinalProduct.name.namePart.type", -1, r.Type)
+}
+
+// MarshalBSON marshals the given MedicinalProductNameNamePart as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductNameNamePart) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductNameNamePart from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductNameNamePart) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductNameNamePart as protobuf message MedicinalProductNameNamePart of fhir.proto
+func (r MedicinalProductNameNamePart) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductNameNamePart to the encoded message
+func (r MedicinalProductNameNamePart) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Part != "" {
+		e.string(4, r.Part)
+	}
+	e.message(5, r.Type)
+	if r.PartElement != nil {
+		e.message(6, *r.PartElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductNameNamePart from protobuf message MedicinalProductNameNamePart of fhir.proto
+func (r *MedicinalProductNameNamePart) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductNameNamePart{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Part = d.string()
+		case 5:
+			var v Coding
+			d.message(&v)
+			r.Type = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.PartElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductNameCountryLanguage as JSON into a byte slice
+func (r MedicinalProductNameCountryLanguage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductNameCountryLanguage as JSON object with the members in the order of the fields
+func (r MedicinalProductNameCountryLanguage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("country")
+	r.Country.appendJSON(e)
+	if r.Jurisdiction != nil {
+		e.key("jurisdiction")
+		r.Jurisdiction.appendJSON(e)
+	}
+	e.key("language")
+	r.Language.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductNameCountryLanguage from JSON. Unknown members are ignored.
+func (r *MedicinalProductNameCountryLanguage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductNameCountryLanguage
+func (r *MedicinalProductNameCountryLanguage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "country":
+			r.Country.decodeJSON(d)
+		case "jurisdiction":
+			if d.null() {
+				r.Jurisdiction = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = &v
+			}
+		case "language":
+			r.Language.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductNameCountryLanguage which shares no memory with the original
+func (r MedicinalProductNameCountryLanguage) DeepCopy() MedicinalProductNameCountryLanguage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Country = r.Country.DeepCopy()
+	if r.Jurisdiction != nil {
+		v := r.Jurisdiction.DeepCopy()
+		out.Jurisdiction = &v
+	}
+	out.Language = r.Language.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductNameCountryLanguage) Equal(other MedicinalProductNameCountryLanguage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Country.Equal(other.Country) {
+		return false
+	}
+	if (r.Jurisdiction == nil) != (other.Jurisdiction == nil) || r.Jurisdiction != nil && !r.Jurisdiction.Equal(*other.Jurisdiction) {
+		return false
+	}
+	if !r.Language.Equal(other.Language) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductNameCountryLanguage) EqualsDeep(other MedicinalProductNameCountryLanguage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Country.EqualsDeep(other.Country) {
+		return false
+	}
+	if (r.Jurisdiction == nil) != (other.Jurisdiction == nil) || r.Jurisdiction != nil && !r.Jurisdiction.EqualsDeep(*other.Jurisdiction) {
+		return false
+	}
+	if !r.Language.EqualsDeep(other.Language) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductNameCountryLanguage) EqualsShallow(other MedicinalProductNameCountryLanguage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductNameCountryLanguage as FHIR XML
+func (r MedicinalProductNameCountryLanguage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "country", r.Country); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "jurisdiction", r.Jurisdiction); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "language", r.Language); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductNameCountryLanguage from FHIR XML
+func (r *MedicinalProductNameCountryLanguage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "country":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Country = v
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = &v
+			case "language":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Language = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductNameCountryLanguage as properties to the RDF node n
+func (r MedicinalProductNameCountryLanguage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProduct.name.countryLanguage.country", -1, r.Country)
+	n.element("MedicinalProduct.name.countryLanguage.jurisdiction", -1, r.Jurisdiction)
+	n.element("MedicinalProduct.name.countryLanguage.language", -1, r.Language)
+}
+
+// MarshalBSON marshals the given MedicinalProductNameCountryLanguage as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductNameCountryLanguage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductNameCountryLanguage from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductNameCountryLanguage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductNameCountryLanguage as protobuf message MedicinalProductNameCountryLanguage of fhir.proto
+func (r MedicinalProductNameCountryLanguage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductNameCountryLanguage to the encoded message
+func (r MedicinalProductNameCountryLanguage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Country)
+	if r.Jurisdiction != nil {
+		e.message(5, *r.Jurisdiction)
+	}
+	e.message(6, r.Language)
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductNameCountryLanguage from protobuf message MedicinalProductNameCountryLanguage of fhir.proto
+func (r *MedicinalProductNameCountryLanguage) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductNameCountryLanguage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Country = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Language = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductManufacturingBusinessOperation as JSON into a byte slice
+func (r MedicinalProductManufacturingBusinessOperation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductManufacturingBusinessOperation as JSON object with the members in the order of the fields
+func (r MedicinalProductManufacturingBusinessOperation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.OperationType != nil {
+		e.key("operationType")
+		r.OperationType.appendJSON(e)
+	}
+	if r.AuthorisationReferenceNumber != nil {
+		e.key("authorisationReferenceNumber")
+		r.AuthorisationReferenceNumber.appendJSON(e)
+	}
+	if r.EffectiveDate != nil {
+		e.key("effectiveDate")
+		e.string(*r.EffectiveDate)
+	}
+	if r.EffectiveDateElement != nil {
+		e.key("_effectiveDate")
+		r.EffectiveDateElement.appendJSON(e)
+	}
+	if r.ConfidentialityIndicator != nil {
+		e.key("confidentialityIndicator")
+		r.ConfidentialityIndicator.appendJSON(e)
+	}
+	if len(r.Manufacturer) > 0 {
+		e.key("manufacturer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Manufacturer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Regulator != nil {
+		e.key("regulator")
+		r.Regulator.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductManufacturingBusinessOperation from JSON. Unknown members are ignored.
+func (r *MedicinalProductManufacturingBusinessOperation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductManufacturingBusinessOperation
+func (r *MedicinalProductManufacturingBusinessOperation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "operationType":
+			if d.null() {
+				r.OperationType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OperationType = &v
+			}
+		case "authorisationReferenceNumber":
+			if d.null() {
+				r.AuthorisationReferenceNumber = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.AuthorisationReferenceNumber = &v
+			}
+		case "effectiveDate":
+			if v, ok := d.string(); ok {
+				r.EffectiveDate = &v
+			} else {
+				r.EffectiveDate = nil
+			}
+		case "_effectiveDate":
+			r.EffectiveDateElement = d.element()
+		case "confidentialityIndicator":
+			if d.null() {
+				r.ConfidentialityIndicator = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ConfidentialityIndicator = &v
+			}
+		case "manufacturer":
+			a := d.array()
+			r.Manufacturer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Manufacturer = append(r.Manufacturer, v)
+			}
+			if r.Manufacturer == nil && !a.isNull {
+				r.Manufacturer = []Reference{}
+			}
+		case "regulator":
+			if d.null() {
+				r.Regulator = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Regulator = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductManufacturingBusinessOperation which shares no memory with the original
+func (r MedicinalProductManufacturingBusinessOperation) DeepCopy() MedicinalProductManufacturingBusinessOperation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.OperationType != nil {
+		v := r.OperationType.DeepCopy()
+		out.OperationType = &v
+	}
+	if r.AuthorisationReferenceNumber != nil {
+		v := r.AuthorisationReferenceNumber.DeepCopy()
+		out.AuthorisationReferenceNumber = &v
+	}
+	if r.EffectiveDate != nil {
+		v := *r.EffectiveDate
+		out.EffectiveDate = &v
+	}
+	if r.EffectiveDateElement != nil {
+		v := r.EffectiveDateElement.DeepCopy()
+		out.EffectiveDateElement = &v
+	}
+	if r.ConfidentialityIndicator != nil {
+		v := r.ConfidentialityIndicator.DeepCopy()
+		out.ConfidentialityIndicator = &v
+	}
+	if r.Manufacturer != nil {
+		out.Manufacturer = make([]Reference, len(r.Manufacturer))
+		for i := range r.Manufacturer {
+			out.Manufacturer[i] = r.Manufacturer[i].DeepCopy()
+		}
+	}
+	if r.Regulator != nil {
+		v := r.Regulator.DeepCopy()
+		out.Regulator = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductManufacturingBusinessOperation) Equal(other MedicinalProductManufacturingBusinessOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.OperationType == nil) != (other.OperationType == nil) || r.OperationType != nil && !r.OperationType.Equal(*other.OperationType) {
+		return false
+	}
+	if (r.AuthorisationReferenceNumber == nil) != (other.AuthorisationReferenceNumber == nil) || r.AuthorisationReferenceNumber != nil && !r.AuthorisationReferenceNumber.Equal(*other.AuthorisationReferenceNumber) {
+		return false
+	}
+	if (r.EffectiveDate == nil) != (other.EffectiveDate == nil) || r.EffectiveDate != nil && *r.EffectiveDate != *other.EffectiveDate {
+		return false
+	}
+	if (r.EffectiveDateElement == nil) != (other.EffectiveDateElement == nil) || r.EffectiveDateElement != nil && !r.EffectiveDateElement.Equal(*other.EffectiveDateElement) {
+		return false
+	}
+	if (r.ConfidentialityIndicator == nil) != (other.ConfidentialityIndicator == nil) || r.ConfidentialityIndicator != nil && !r.ConfidentialityIndicator.Equal(*other.ConfidentialityIndicator) {
+		return false
+	}
+	if len(r.Manufacturer) != len(other.Manufacturer) {
+		return false
+	}
+	for i := range r.Manufacturer {
+		if !r.Manufacturer[i].Equal(other.Manufacturer[i]) {
+			return false
+		}
+	}
+	if (r.Regulator == nil) != (other.Regulator == nil) || r.Regulator != nil && !r.Regulator.Equal(*other.Regulator) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductManufacturingBusinessOperation) EqualsDeep(other MedicinalProductManufacturingBusinessOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.OperationType == nil) != (other.OperationType == nil) || r.OperationType != nil && !r.OperationType.EqualsDeep(*other.OperationType) {
+		return false
+	}
+	if (r.AuthorisationReferenceNumber == nil) != (other.AuthorisationReferenceNumber == nil) || r.AuthorisationReferenceNumber != nil && !r.AuthorisationReferenceNumber.EqualsDeep(*other.AuthorisationReferenceNumber) {
+		return false
+	}
+	if (r.EffectiveDate == nil) != (other.EffectiveDate == nil) || r.EffectiveDate != nil && !equivalentString(*r.EffectiveDate, *other.EffectiveDate) {
+		return false
+	}
+	if (r.EffectiveDateElement == nil) != (other.EffectiveDateElement == nil) || r.EffectiveDateElement != nil && !r.EffectiveDateElement.EqualsDeep(*other.EffectiveDateElement) {
+		return false
+	}
+	if (r.ConfidentialityIndicator == nil) != (other.ConfidentialityIndicator == nil) || r.ConfidentialityIndicator != nil && !r.ConfidentialityIndicator.EqualsDeep(*other.ConfidentialityIndicator) {
+		return false
+	}
+	if !equivalentList(len(r.Manufacturer), len(other.Manufacturer), func(i, j int) bool {
+		return r.Manufacturer[i].EqualsDeep(other.Manufacturer[j])
+	}) {
+		return false
+	}
+	if (r.Regulator == nil) != (other.Regulator == nil) || r.Regulator != nil && !r.Regulator.EqualsDeep(*other.Regulator) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductManufacturingBusinessOperation) EqualsShallow(other MedicinalProductManufacturingBusinessOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.EffectiveDate == nil) != (other.EffectiveDate == nil) || r.EffectiveDate != nil && !equivalentString(*r.EffectiveDate, *other.EffectiveDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductManufacturingBusinessOperation as FHIR XML
+func (r MedicinalProductManufacturingBusinessOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "operationType", r.OperationType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "authorisationReferenceNumber", r.AuthorisationReferenceNumber); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "effectiveDate", r.EffectiveDate, r.EffectiveDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "confidentialityIndicator", r.ConfidentialityIndicator); err != nil {
+		return err
+	}
+	for _, v := range r.Manufacturer {
+		if err := encodeXMLElement(e, "manufacturer", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "regulator", r.Regulator); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductManufacturingBusinessOperation from FHIR XML
+func (r *MedicinalProductManufacturingBusinessOperation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "operationType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OperationType = &v
+			case "authorisationReferenceNumber":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AuthorisationReferenceNumber = &v
+			case "effectiveDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EffectiveDate = &v
+				}
+				r.EffectiveDateElement = element
+			case "confidentialityIndicator":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ConfidentialityIndicator = &v
+			case "manufacturer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manufacturer = append(r.Manufacturer, v)
+			case "regulator":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Regulator = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductManufacturingBusinessOperation as properties to the RDF node n
+func (r MedicinalProductManufacturingBusinessOperation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProduct.manufacturingBusinessOperation.operationType", -1, r.OperationType)
+	n.element("MedicinalProduct.manufacturingBusinessOperation.authorisationReferenceNumber", -1, r.AuthorisationReferenceNumber)
+	n.primitive("MedicinalProduct.manufacturingBusinessOperation.effectiveDate", -1, r.EffectiveDate, r.EffectiveDateElement, "dateTime")
+	n.element("MedicinalProduct.manufacturingBusinessOperation.confidentialityIndicator", -1, r.ConfidentialityIndicator)
+	for i, v := range r.Manufacturer {
+		n.element("MedicinalProduct.manufacturingBusinessOperation.manufacturer", i, v)
+	}
+	n.element("MedicinalProduct.manufacturingBusinessOperation.regulator", -1, r.Regulator)
+}
+
+// MarshalBSON marshals the given MedicinalProductManufacturingBusinessOperation as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductManufacturingBusinessOperation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductManufacturingBusinessOperation from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductManufacturingBusinessOperation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductManufacturingBusinessOperation as protobuf message MedicinalProductManufacturingBusinessOperation of fhir.proto
+func (r MedicinalProductManufacturingBusinessOperation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductManufacturingBusinessOperation to the encoded message
+func (r MedicinalProductManufacturingBusinessOperation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.OperationType != nil {
+		e.message(4, *r.OperationType)
+	}
+	if r.AuthorisationReferenceNumber != nil {
+		e.message(5, *r.AuthorisationReferenceNumber)
+	}
+	if r.EffectiveDate != nil {
+		e.string(6, *r.EffectiveDate)
+	}
+	if r.ConfidentialityIndicator != nil {
+		e.message(7, *r.ConfidentialityIndicator)
+	}
+	for _, v := range r.Manufacturer {
+		e.message(8, v)
+	}
+	if r.Regulator != nil {
+		e.message(9, *r.Regulator)
+	}
+	if r.EffectiveDateElement != nil {
+		e.message(10, *r.EffectiveDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductManufacturingBusinessOperation from protobuf message MedicinalProductManufacturingBusinessOperation of fhir.proto
+func (r *MedicinalProductManufacturingBusinessOperation) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductManufacturingBusinessOperation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.OperationType = &v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.AuthorisationReferenceNumber = &v
+		case 6:
+			v := d.string()
+			r.EffectiveDate = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ConfidentialityIndicator = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.Manufacturer = append(r.Manufacturer, v)
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Regulator = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.EffectiveDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductSpecialDesignation as JSON into a byte slice
+func (r MedicinalProductSpecialDesignation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductSpecialDesignation as JSON object with the members in the order of the fields
+func (r MedicinalProductSpecialDesignation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.IntendedUse != nil {
+		e.key("intendedUse")
+		r.IntendedUse.appendJSON(e)
+	}
+	if r.IndicationCodeableConcept != nil {
+		e.key("indicationCodeableConcept")
+		r.IndicationCodeableConcept.appendJSON(e)
+	}
+	if r.IndicationReference != nil {
+		e.key("indicationReference")
+		r.IndicationReference.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		r.Status.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Species != nil {
+		e.key("species")
+		r.Species.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductSpecialDesignation from JSON. Unknown members are ignored.
+func (r *MedicinalProductSpecialDesignation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductSpecialDesignation
+func (r *MedicinalProductSpecialDesignation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "intendedUse":
+			if d.null() {
+				r.IntendedUse = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.IntendedUse = &v
+			}
+		case "indicationCodeableConcept":
+			if d.null() {
+				r.IndicationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.IndicationCodeableConcept = &v
+			}
+		case "indicationReference":
+			if d.null() {
+				r.IndicationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.IndicationReference = &v
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Status = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "species":
+			if d.null() {
+				r.Species = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Species = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductSpecialDesignation which shares no memory with the original
+func (r MedicinalProductSpecialDesignation) DeepCopy() MedicinalProductSpecialDesignation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.IntendedUse != nil {
+		v := r.IntendedUse.DeepCopy()
+		out.IntendedUse = &v
+	}
+	if r.IndicationCodeableConcept != nil {
+		v := r.IndicationCodeableConcept.DeepCopy()
+		out.IndicationCodeableConcept = &v
+	}
+	if r.IndicationReference != nil {
+		v := r.IndicationReference.DeepCopy()
+		out.IndicationReference = &v
+	}
+	if r.Status != nil {
+		v := r.Status.DeepCopy()
+		out.Status = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Species != nil {
+		v := r.Species.DeepCopy()
+		out.Species = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductSpecialDesignation) Equal(other MedicinalProductSpecialDesignation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.IntendedUse == nil) != (other.IntendedUse == nil) || r.IntendedUse != nil && !r.IntendedUse.Equal(*other.IntendedUse) {
+		return false
+	}
+	if (r.IndicationCodeableConcept == nil) != (other.IndicationCodeableConcept == nil) || r.IndicationCodeableConcept != nil && !r.IndicationCodeableConcept.Equal(*other.IndicationCodeableConcept) {
+		return false
+	}
+	if (r.IndicationReference == nil) != (other.IndicationReference == nil) || r.IndicationReference != nil && !r.IndicationReference.Equal(*other.IndicationReference) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.Equal(*other.Status) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Species == nil) != (other.Species == nil) || r.Species != nil && !r.Species.Equal(*other.Species) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductSpecialDesignation) EqualsDeep(other MedicinalProductSpecialDesignation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.IntendedUse == nil) != (other.IntendedUse == nil) || r.IntendedUse != nil && !r.IntendedUse.EqualsDeep(*other.IntendedUse) {
+		return false
+	}
+	if (r.IndicationCodeableConcept == nil) != (other.IndicationCodeableConcept == nil) || r.IndicationCodeableConcept != nil && !r.IndicationCodeableConcept.EqualsDeep(*other.IndicationCodeableConcept) {
+		return false
+	}
+	if (r.IndicationReference == nil) != (other.IndicationReference == nil) || r.IndicationReference != nil && !r.IndicationReference.EqualsDeep(*other.IndicationReference) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.EqualsDeep(*other.Status) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Species == nil) != (other.Species == nil) || r.Species != nil && !r.Species.EqualsDeep(*other.Species) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductSpecialDesignation) EqualsShallow(other MedicinalProductSpecialDesignation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductSpecialDesignation as FHIR XML
+func (r MedicinalProductSpecialDesignation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "intendedUse", r.IntendedUse); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "indicationCodeableConcept", r.IndicationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "indicationReference", r.IndicationReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "status", r.Status); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "species", r.Species); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductSpecialDesignation from FHIR XML
+func (r *MedicinalProductSpecialDesignation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "intendedUse":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.IntendedUse = &v
+			case "indicationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.IndicationCodeableConcept = &v
+			case "indicationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.IndicationReference = &v
+			case "status":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Status = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "species":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Species = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductSpecialDesignation as properties to the RDF node n
+func (r MedicinalProductSpecialDesignation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicinalProduct.specialDesignation.identifier", i, v)
+	}
+	n.element("MedicinalProduct.specialDesignation.type", -1, r.Type)
+	n.element("MedicinalProduct.specialDesignation.intendedUse", -1, r.IntendedUse)
+	n.element("MedicinalProduct.specialDesignation.indicationCodeableConcept", -1, r.IndicationCodeableConcept)
+	n.element("MedicinalProduct.specialDesignation.indicationReference", -1, r.IndicationReference)
+	n.element("MedicinalProduct.specialDesignation.status", -1, r.Status)
+	n.primitive("MedicinalProduct.specialDesignation.date", -1, r.Date, r.DateElement, "dateTime")
+	n.element("MedicinalProduct.specialDesignation.species", -1, r.Species)
+}
+
+// MarshalBSON marshals the given MedicinalProductSpecialDesignation as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductSpecialDesignation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductSpecialDesignation from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductSpecialDesignation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductSpecialDesignation as protobuf message MedicinalProductSpecialDesignation of fhir.proto
+func (r MedicinalProductSpecialDesignation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductSpecialDesignation to the encoded message
+func (r MedicinalProductSpecialDesignation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(4, v)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.IntendedUse != nil {
+		e.message(6, *r.IntendedUse)
+	}
+	if r.IndicationCodeableConcept != nil {
+		e.message(7, *r.IndicationCodeableConcept)
+	}
+	if r.IndicationReference != nil {
+		e.message(8, *r.IndicationReference)
+	}
+	if r.Status != nil {
+		e.message(9, *r.Status)
+	}
+	if r.Date != nil {
+		e.string(10, *r.Date)
+	}
+	if r.Species != nil {
+		e.message(11, *r.Species)
+	}
+	if r.DateElement != nil {
+		e.message(12, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductSpecialDesignation from protobuf message MedicinalProductSpecialDesignation of fhir.proto
+func (r *MedicinalProductSpecialDesignation) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductSpecialDesignation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.IntendedUse = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.IndicationCodeableConcept = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.IndicationReference = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Status = &v
+		case 10:
+			v := d.string()
+			r.Date = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Species = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicinalProduct unmarshals a MedicinalProduct.
 func UnmarshalMedicinalProduct(b []byte) (MedicinalProduct, error) {
 	var medicinalProduct MedicinalProduct
-	if err := json.Unmarshal(b, &medicinalProduct); err != nil {
+	if err := medicinalProduct.UnmarshalJSON(b); err != nil {
 		return medicinalProduct, err
 	}
 	return medicinalProduct, nil
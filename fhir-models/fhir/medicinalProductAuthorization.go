@@ -14,36 +14,47 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MedicinalProductAuthorization is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductAuthorization
 type MedicinalProductAuthorization struct {
-	Id                          *string                                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                        *Meta                                                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules               *string                                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                    *string                                                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text                        *Narrative                                                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension                   []Extension                                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension           []Extension                                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier                  []Identifier                                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Subject                     *Reference                                                 `bson:"subject,omitempty" json:"subject,omitempty"`
-	Country                     []CodeableConcept                                          `bson:"country,omitempty" json:"country,omitempty"`
-	Jurisdiction                []CodeableConcept                                          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Status                      *CodeableConcept                                           `bson:"status,omitempty" json:"status,omitempty"`
-	StatusDate                  *string                                                    `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
-	RestoreDate                 *string                                                    `bson:"restoreDate,omitempty" json:"restoreDate,omitempty"`
-	ValidityPeriod              *Period                                                    `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
-	DataExclusivityPeriod       *Period                                                    `bson:"dataExclusivityPeriod,omitempty" json:"dataExclusivityPeriod,omitempty"`
-	DateOfFirstAuthorization    *string                                                    `bson:"dateOfFirstAuthorization,omitempty" json:"dateOfFirstAuthorization,omitempty"`
-	InternationalBirthDate      *string                                                    `bson:"internationalBirthDate,omitempty" json:"internationalBirthDate,omitempty"`
-	LegalBasis                  *CodeableConcept                                           `bson:"legalBasis,omitempty" json:"legalBasis,omitempty"`
-	JurisdictionalAuthorization []MedicinalProductAuthorizationJurisdictionalAuthorization `bson:"jurisdictionalAuthorization,omitempty" json:"jurisdictionalAuthorization,omitempty"`
-	Holder                      *Reference                                                 `bson:"holder,omitempty" json:"holder,omitempty"`
-	Regulator                   *Reference                                                 `bson:"regulator,omitempty" json:"regulator,omitempty"`
-	Procedure                   *MedicinalProductAuthorizationProcedure                    `bson:"procedure,omitempty" json:"procedure,omitempty"`
+	Id                              *string                                                    `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                       *Element                                                   `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                            *Meta                                                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                   *string                                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement            *Element                                                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                        *string                                                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement                 *Element                                                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                            *Narrative                                                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                       []json.RawMessage                                          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                       []Extension                                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension               []Extension                                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                      []Identifier                                               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Subject                         *Reference                                                 `bson:"subject,omitempty" json:"subject,omitempty"`
+	Country                         []CodeableConcept                                          `bson:"country,omitempty" json:"country,omitempty"`
+	Jurisdiction                    []CodeableConcept                                          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Status                          *CodeableConcept                                           `bson:"status,omitempty" json:"status,omitempty"`
+	StatusDate                      *string                                                    `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
+	StatusDateElement               *Element                                                   `bson:"_statusDate,omitempty" json:"_statusDate,omitempty"`
+	RestoreDate                     *string                                                    `bson:"restoreDate,omitempty" json:"restoreDate,omitempty"`
+	RestoreDateElement              *Element                                                   `bson:"_restoreDate,omitempty" json:"_restoreDate,omitempty"`
+	ValidityPeriod                  *Period                                                    `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
+	DataExclusivityPeriod           *Period                                                    `bson:"dataExclusivityPeriod,omitempty" json:"dataExclusivityPeriod,omitempty"`
+	DateOfFirstAuthorization        *string                                                    `bson:"dateOfFirstAuthorization,omitempty" json:"dateOfFirstAuthorization,omitempty"`
+	DateOfFirstAuthorizationElement *Element                                                   `bson:"_dateOfFirstAuthorization,omitempty" json:"_dateOfFirstAuthorization,omitempty"`
+	InternationalBirthDate          *string                                                    `bson:"internationalBirthDate,omitempty" json:"internationalBirthDate,omitempty"`
+	InternationalBirthDateElement   *Element                                                   `bson:"_internationalBirthDate,omitempty" json:"_internationalBirthDate,omitempty"`
+	LegalBasis                      *CodeableConcept                                           `bson:"legalBasis,omitempty" json:"legalBasis,omitempty"`
+	JurisdictionalAuthorization     []MedicinalProductAuthorizationJurisdictionalAuthorization `bson:"jurisdictionalAuthorization,omitempty" json:"jurisdictionalAuthorization,omitempty"`
+	Holder                          *Reference                                                 `bson:"holder,omitempty" json:"holder,omitempty"`
+	Regulator                       *Reference                                                 `bson:"regulator,omitempty" json:"regulator,omitempty"`
+	Procedure                       *MedicinalProductAuthorizationProcedure                    `bson:"procedure,omitempty" json:"procedure,omitempty"`
 }
 type MedicinalProductAuthorizationJurisdictionalAuthorization struct {
 	Id                  *string           `bson:"id,omitempty" json:"id,omitempty"`
@@ -56,32 +67,2490 @@ type MedicinalProductAuthorizationJurisdictionalAuthorization struct {
 	ValidityPeriod      *Period           `bson:"validityPeriod,omitempty" json:"validityPeriod,omitempty"`
 }
 type MedicinalProductAuthorizationProcedure struct {
-	Id                *string                                  `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type              CodeableConcept                          `bson:"type" json:"type"`
-	DatePeriod        *Period                                  `bson:"datePeriod,omitempty" json:"datePeriod,omitempty"`
-	DateDateTime      *string                                  `bson:"dateDateTime,omitempty" json:"dateDateTime,omitempty"`
-	Application       []MedicinalProductAuthorizationProcedure `bson:"application,omitempty" json:"application,omitempty"`
+	Id                  *string                                  `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier          *Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type                CodeableConcept                          `bson:"type" json:"type"`
+	DatePeriod          *Period                                  `bson:"datePeriod,omitempty" json:"datePeriod,omitempty"`
+	DateDateTime        *string                                  `bson:"dateDateTime,omitempty" json:"dateDateTime,omitempty"`
+	DateDateTimeElement *Element                                 `bson:"_dateDateTime,omitempty" json:"_dateDateTime,omitempty"`
+	Application         []MedicinalProductAuthorizationProcedure `bson:"application,omitempty" json:"application,omitempty"`
+}
+
+// MedicinalProductAuthorizationProcedureDate is implemented by the types allowed for MedicinalProductAuthorization.procedure.date[x]
+type MedicinalProductAuthorizationProcedureDate interface {
+	isMedicinalProductAuthorizationProcedureDate()
+}
+
+func (Period) isMedicinalProductAuthorizationProcedureDate()   {}
+func (DateTime) isMedicinalProductAuthorizationProcedureDate() {}
+
+// Date returns the value of MedicinalProductAuthorization.procedure.date[x] together with its FHIR type code or nil if no value is set
+func (r MedicinalProductAuthorizationProcedure) Date() (MedicinalProductAuthorizationProcedureDate, string) {
+	if r.DatePeriod != nil {
+		return *r.DatePeriod, "Period"
+	}
+	if r.DateDateTime != nil {
+		return DateTime(*r.DateDateTime), "dateTime"
+	}
+	return nil, ""
+}
+
+// SetDate sets the value of MedicinalProductAuthorization.procedure.date[x], given as value or pointer, and clears all other types
+func (r *MedicinalProductAuthorizationProcedure) SetDate(value MedicinalProductAuthorizationProcedureDate) {
+	r.DatePeriod = nil
+	r.DateDateTime = nil
+	switch v := value.(type) {
+	case Period:
+		r.DatePeriod = &v
+	case *Period:
+		r.DatePeriod = v
+	case DateTime:
+		primitive := string(v)
+		r.DateDateTime = &primitive
+	case *DateTime:
+		r.DateDateTime = (*string)(v)
+	}
 }
-type OtherMedicinalProductAuthorization MedicinalProductAuthorization
 
 // MarshalJSON marshals the given MedicinalProductAuthorization as JSON into a byte slice
 func (r MedicinalProductAuthorization) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicinalProductAuthorization
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicinalProductAuthorization: OtherMedicinalProductAuthorization(r),
-		ResourceType:                       "MedicinalProductAuthorization",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductAuthorization as JSON object with the members in the order of the fields
+func (r MedicinalProductAuthorization) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicinalProductAuthorization\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if len(r.Country) > 0 {
+		e.key("country")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Country {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Status != nil {
+		e.key("status")
+		r.Status.appendJSON(e)
+	}
+	if r.StatusDate != nil {
+		e.key("statusDate")
+		e.string(*r.StatusDate)
+	}
+	if r.StatusDateElement != nil {
+		e.key("_statusDate")
+		r.StatusDateElement.appendJSON(e)
+	}
+	if r.RestoreDate != nil {
+		e.key("restoreDate")
+		e.string(*r.RestoreDate)
+	}
+	if r.RestoreDateElement != nil {
+		e.key("_restoreDate")
+		r.RestoreDateElement.appendJSON(e)
+	}
+	if r.ValidityPeriod != nil {
+		e.key("validityPeriod")
+		r.ValidityPeriod.appendJSON(e)
+	}
+	if r.DataExclusivityPeriod != nil {
+		e.key("dataExclusivityPeriod")
+		r.DataExclusivityPeriod.appendJSON(e)
+	}
+	if r.DateOfFirstAuthorization != nil {
+		e.key("dateOfFirstAuthorization")
+		e.string(*r.DateOfFirstAuthorization)
+	}
+	if r.DateOfFirstAuthorizationElement != nil {
+		e.key("_dateOfFirstAuthorization")
+		r.DateOfFirstAuthorizationElement.appendJSON(e)
+	}
+	if r.InternationalBirthDate != nil {
+		e.key("internationalBirthDate")
+		e.string(*r.InternationalBirthDate)
+	}
+	if r.InternationalBirthDateElement != nil {
+		e.key("_internationalBirthDate")
+		r.InternationalBirthDateElement.appendJSON(e)
+	}
+	if r.LegalBasis != nil {
+		e.key("legalBasis")
+		r.LegalBasis.appendJSON(e)
+	}
+	if len(r.JurisdictionalAuthorization) > 0 {
+		e.key("jurisdictionalAuthorization")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.JurisdictionalAuthorization {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Holder != nil {
+		e.key("holder")
+		r.Holder.appendJSON(e)
+	}
+	if r.Regulator != nil {
+		e.key("regulator")
+		r.Regulator.appendJSON(e)
+	}
+	if r.Procedure != nil {
+		e.key("procedure")
+		r.Procedure.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductAuthorization from JSON. Unknown members are ignored.
+func (r *MedicinalProductAuthorization) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductAuthorization
+func (r *MedicinalProductAuthorization) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "country":
+			a := d.array()
+			r.Country = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Country = append(r.Country, v)
+			}
+			if r.Country == nil && !a.isNull {
+				r.Country = []CodeableConcept{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Status = &v
+			}
+		case "statusDate":
+			if v, ok := d.string(); ok {
+				r.StatusDate = &v
+			} else {
+				r.StatusDate = nil
+			}
+		case "_statusDate":
+			r.StatusDateElement = d.element()
+		case "restoreDate":
+			if v, ok := d.string(); ok {
+				r.RestoreDate = &v
+			} else {
+				r.RestoreDate = nil
+			}
+		case "_restoreDate":
+			r.RestoreDateElement = d.element()
+		case "validityPeriod":
+			if d.null() {
+				r.ValidityPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValidityPeriod = &v
+			}
+		case "dataExclusivityPeriod":
+			if d.null() {
+				r.DataExclusivityPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.DataExclusivityPeriod = &v
+			}
+		case "dateOfFirstAuthorization":
+			if v, ok := d.string(); ok {
+				r.DateOfFirstAuthorization = &v
+			} else {
+				r.DateOfFirstAuthorization = nil
+			}
+		case "_dateOfFirstAuthorization":
+			r.DateOfFirstAuthorizationElement = d.element()
+		case "internationalBirthDate":
+			if v, ok := d.string(); ok {
+				r.InternationalBirthDate = &v
+			} else {
+				r.InternationalBirthDate = nil
+			}
+		case "_internationalBirthDate":
+			r.InternationalBirthDateElement = d.element()
+		case "legalBasis":
+			if d.null() {
+				r.LegalBasis = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LegalBasis = &v
+			}
+		case "jurisdictionalAuthorization":
+			a := d.array()
+			r.JurisdictionalAuthorization = nil
+			for a.next() {
+				var v MedicinalProductAuthorizationJurisdictionalAuthorization
+				v.decodeJSON(d)
+				r.JurisdictionalAuthorization = append(r.JurisdictionalAuthorization, v)
+			}
+			if r.JurisdictionalAuthorization == nil && !a.isNull {
+				r.JurisdictionalAuthorization = []MedicinalProductAuthorizationJurisdictionalAuthorization{}
+			}
+		case "holder":
+			if d.null() {
+				r.Holder = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Holder = &v
+			}
+		case "regulator":
+			if d.null() {
+				r.Regulator = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Regulator = &v
+			}
+		case "procedure":
+			if d.null() {
+				r.Procedure = nil
+			} else {
+				var v MedicinalProductAuthorizationProcedure
+				v.decodeJSON(d)
+				r.Procedure = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductAuthorization which shares no memory with the original
+func (r MedicinalProductAuthorization) DeepCopy() MedicinalProductAuthorization {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Country != nil {
+		out.Country = make([]CodeableConcept, len(r.Country))
+		for i := range r.Country {
+			out.Country[i] = r.Country[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Status != nil {
+		v := r.Status.DeepCopy()
+		out.Status = &v
+	}
+	if r.StatusDate != nil {
+		v := *r.StatusDate
+		out.StatusDate = &v
+	}
+	if r.StatusDateElement != nil {
+		v := r.StatusDateElement.DeepCopy()
+		out.StatusDateElement = &v
+	}
+	if r.RestoreDate != nil {
+		v := *r.RestoreDate
+		out.RestoreDate = &v
+	}
+	if r.RestoreDateElement != nil {
+		v := r.RestoreDateElement.DeepCopy()
+		out.RestoreDateElement = &v
+	}
+	if r.ValidityPeriod != nil {
+		v := r.ValidityPeriod.DeepCopy()
+		out.ValidityPeriod = &v
+	}
+	if r.DataExclusivityPeriod != nil {
+		v := r.DataExclusivityPeriod.DeepCopy()
+		out.DataExclusivityPeriod = &v
+	}
+	if r.DateOfFirstAuthorization != nil {
+		v := *r.DateOfFirstAuthorization
+		out.DateOfFirstAuthorization = &v
+	}
+	if r.DateOfFirstAuthorizationElement != nil {
+		v := r.DateOfFirstAuthorizationElement.DeepCopy()
+		out.DateOfFirstAuthorizationElement = &v
+	}
+	if r.InternationalBirthDate != nil {
+		v := *r.InternationalBirthDate
+		out.InternationalBirthDate = &v
+	}
+	if r.InternationalBirthDateElement != nil {
+		v := r.InternationalBirthDateElement.DeepCopy()
+		out.InternationalBirthDateElement = &v
+	}
+	if r.LegalBasis != nil {
+		v := r.LegalBasis.DeepCopy()
+		out.LegalBasis = &v
+	}
+	if r.JurisdictionalAuthorization != nil {
+		out.JurisdictionalAuthorization = make([]MedicinalProductAuthorizationJurisdictionalAuthorization, len(r.JurisdictionalAuthorization))
+		for i := range r.JurisdictionalAuthorization {
+			out.JurisdictionalAuthorization[i] = r.JurisdictionalAuthorization[i].DeepCopy()
+		}
+	}
+	if r.Holder != nil {
+		v := r.Holder.DeepCopy()
+		out.Holder = &v
+	}
+	if r.Regulator != nil {
+		v := r.Regulator.DeepCopy()
+		out.Regulator = &v
+	}
+	if r.Procedure != nil {
+		v := r.Procedure.DeepCopy()
+		out.Procedure = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductAuthorization) Equal(other MedicinalProductAuthorization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if len(r.Country) != len(other.Country) {
+		return false
+	}
+	for i := range r.Country {
+		if !r.Country[i].Equal(other.Country[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.Equal(*other.Status) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && *r.StatusDate != *other.StatusDate {
+		return false
+	}
+	if (r.StatusDateElement == nil) != (other.StatusDateElement == nil) || r.StatusDateElement != nil && !r.StatusDateElement.Equal(*other.StatusDateElement) {
+		return false
+	}
+	if (r.RestoreDate == nil) != (other.RestoreDate == nil) || r.RestoreDate != nil && *r.RestoreDate != *other.RestoreDate {
+		return false
+	}
+	if (r.RestoreDateElement == nil) != (other.RestoreDateElement == nil) || r.RestoreDateElement != nil && !r.RestoreDateElement.Equal(*other.RestoreDateElement) {
+		return false
+	}
+	if (r.ValidityPeriod == nil) != (other.ValidityPeriod == nil) || r.ValidityPeriod != nil && !r.ValidityPeriod.Equal(*other.ValidityPeriod) {
+		return false
+	}
+	if (r.DataExclusivityPeriod == nil) != (other.DataExclusivityPeriod == nil) || r.DataExclusivityPeriod != nil && !r.DataExclusivityPeriod.Equal(*other.DataExclusivityPeriod) {
+		return false
+	}
+	if (r.DateOfFirstAuthorization == nil) != (other.DateOfFirstAuthorization == nil) || r.DateOfFirstAuthorization != nil && *r.DateOfFirstAuthorization != *other.DateOfFirstAuthorization {
+		return false
+	}
+	if (r.DateOfFirstAuthorizationElement == nil) != (other.DateOfFirstAuthorizationElement == nil) || r.DateOfFirstAuthorizationElement != nil && !r.DateOfFirstAuthorizationElement.Equal(*other.DateOfFirstAuthorizationElement) {
+		return false
+	}
+	if (r.InternationalBirthDate == nil) != (other.InternationalBirthDate == nil) || r.InternationalBirthDate != nil && *r.InternationalBirthDate != *other.InternationalBirthDate {
+		return false
+	}
+	if (r.InternationalBirthDateElement == nil) != (other.InternationalBirthDateElement == nil) || r.InternationalBirthDateElement != nil && !r.InternationalBirthDateElement.Equal(*other.InternationalBirthDateElement) {
+		return false
+	}
+	if (r.LegalBasis == nil) != (other.LegalBasis == nil) || r.LegalBasis != nil && !r.LegalBasis.Equal(*other.LegalBasis) {
+		return false
+	}
+	if len(r.JurisdictionalAuthorization) != len(other.JurisdictionalAuthorization) {
+		return false
+	}
+	for i := range r.JurisdictionalAuthorization {
+		if !r.JurisdictionalAuthorization[i].Equal(other.JurisdictionalAuthorization[i]) {
+			return false
+		}
+	}
+	if (r.Holder == nil) != (other.Holder == nil) || r.Holder != nil && !r.Holder.Equal(*other.Holder) {
+		return false
+	}
+	if (r.Regulator == nil) != (other.Regulator == nil) || r.Regulator != nil && !r.Regulator.Equal(*other.Regulator) {
+		return false
+	}
+	if (r.Procedure == nil) != (other.Procedure == nil) || r.Procedure != nil && !r.Procedure.Equal(*other.Procedure) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductAuthorization) EqualsDeep(other MedicinalProductAuthorization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if !equivalentList(len(r.Country), len(other.Country), func(i, j int) bool {
+		return r.Country[i].EqualsDeep(other.Country[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.EqualsDeep(*other.Status) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && !equivalentString(*r.StatusDate, *other.StatusDate) {
+		return false
+	}
+	if (r.StatusDateElement == nil) != (other.StatusDateElement == nil) || r.StatusDateElement != nil && !r.StatusDateElement.EqualsDeep(*other.StatusDateElement) {
+		return false
+	}
+	if (r.RestoreDate == nil) != (other.RestoreDate == nil) || r.RestoreDate != nil && !equivalentString(*r.RestoreDate, *other.RestoreDate) {
+		return false
+	}
+	if (r.RestoreDateElement == nil) != (other.RestoreDateElement == nil) || r.RestoreDateElement != nil && !r.RestoreDateElement.EqualsDeep(*other.RestoreDateElement) {
+		return false
+	}
+	if (r.ValidityPeriod == nil) != (other.ValidityPeriod == nil) || r.ValidityPeriod != nil && !r.ValidityPeriod.EqualsDeep(*other.ValidityPeriod) {
+		return false
+	}
+	if (r.DataExclusivityPeriod == nil) != (other.DataExclusivityPeriod == nil) || r.DataExclusivityPeriod != nil && !r.DataExclusivityPeriod.EqualsDeep(*other.DataExclusivityPeriod) {
+		return false
+	}
+	if (r.DateOfFirstAuthorization == nil) != (other.DateOfFirstAuthorization == nil) || r.DateOfFirstAuthorization != nil && !equivalentString(*r.DateOfFirstAuthorization, *other.DateOfFirstAuthorization) {
+		return false
+	}
+	if (r.DateOfFirstAuthorizationElement == nil) != (other.DateOfFirstAuthorizationElement == nil) || r.DateOfFirstAuthorizationElement != nil && !r.DateOfFirstAuthorizationElement.EqualsDeep(*other.DateOfFirstAuthorizationElement) {
+		return false
+	}
+	if (r.InternationalBirthDate == nil) != (other.InternationalBirthDate == nil) || r.InternationalBirthDate != nil && !equivalentString(*r.InternationalBirthDate, *other.InternationalBirthDate) {
+		return false
+	}
+	if (r.InternationalBirthDateElement == nil) != (other.InternationalBirthDateElement == nil) || r.InternationalBirthDateElement != nil && !r.InternationalBirthDateElement.EqualsDeep(*other.InternationalBirthDateElement) {
+		return false
+	}
+	if (r.LegalBasis == nil) != (other.LegalBasis == nil) || r.LegalBasis != nil && !r.LegalBasis.EqualsDeep(*other.LegalBasis) {
+		return false
+	}
+	if !equivalentList(len(r.JurisdictionalAuthorization), len(other.JurisdictionalAuthorization), func(i, j int) bool {
+		return r.JurisdictionalAuthorization[i].EqualsDeep(other.JurisdictionalAuthorization[j])
+	}) {
+		return false
+	}
+	if (r.Holder == nil) != (other.Holder == nil) || r.Holder != nil && !r.Holder.EqualsDeep(*other.Holder) {
+		return false
+	}
+	if (r.Regulator == nil) != (other.Regulator == nil) || r.Regulator != nil && !r.Regulator.EqualsDeep(*other.Regulator) {
+		return false
+	}
+	if (r.Procedure == nil) != (other.Procedure == nil) || r.Procedure != nil && !r.Procedure.EqualsDeep(*other.Procedure) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductAuthorization) EqualsShallow(other MedicinalProductAuthorization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && !equivalentString(*r.StatusDate, *other.StatusDate) {
+		return false
+	}
+	if (r.RestoreDate == nil) != (other.RestoreDate == nil) || r.RestoreDate != nil && !equivalentString(*r.RestoreDate, *other.RestoreDate) {
+		return false
+	}
+	if (r.DateOfFirstAuthorization == nil) != (other.DateOfFirstAuthorization == nil) || r.DateOfFirstAuthorization != nil && !equivalentString(*r.DateOfFirstAuthorization, *other.DateOfFirstAuthorization) {
+		return false
+	}
+	if (r.InternationalBirthDate == nil) != (other.InternationalBirthDate == nil) || r.InternationalBirthDate != nil && !equivalentString(*r.InternationalBirthDate, *other.InternationalBirthDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductAuthorization as FHIR XML
+func (r MedicinalProductAuthorization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicinalProductAuthorization",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	for _, v := range r.Country {
+		if err := encodeXMLElement(e, "country", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "status", r.Status); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "statusDate", r.StatusDate, r.StatusDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "restoreDate", r.RestoreDate, r.RestoreDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "validityPeriod", r.ValidityPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dataExclusivityPeriod", r.DataExclusivityPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "dateOfFirstAuthorization", r.DateOfFirstAuthorization, r.DateOfFirstAuthorizationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "internationalBirthDate", r.InternationalBirthDate, r.InternationalBirthDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "legalBasis", r.LegalBasis); err != nil {
+		return err
+	}
+	for _, v := range r.JurisdictionalAuthorization {
+		if err := encodeXMLElement(e, "jurisdictionalAuthorization", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "holder", r.Holder); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "regulator", r.Regulator); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "procedure", r.Procedure); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductAuthorization from FHIR XML
+func (r *MedicinalProductAuthorization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "country":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Country = append(r.Country, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "status":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Status = &v
+			case "statusDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.StatusDate = &v
+				}
+				r.StatusDateElement = element
+			case "restoreDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RestoreDate = &v
+				}
+				r.RestoreDateElement = element
+			case "validityPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValidityPeriod = &v
+			case "dataExclusivityPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DataExclusivityPeriod = &v
+			case "dateOfFirstAuthorization":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DateOfFirstAuthorization = &v
+				}
+				r.DateOfFirstAuthorizationElement = element
+			case "internationalBirthDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.InternationalBirthDate = &v
+				}
+				r.InternationalBirthDateElement = element
+			case "legalBasis":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LegalBasis = &v
+			case "jurisdictionalAuthorization":
+				var v MedicinalProductAuthorizationJurisdictionalAuthorization
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.JurisdictionalAuthorization = append(r.JurisdictionalAuthorization, v)
+			case "holder":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Holder = &v
+			case "regulator":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Regulator = &v
+			case "procedure":
+				var v MedicinalProductAuthorizationProcedure
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Procedure = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductAuthorization as properties to the RDF node n
+func (r MedicinalProductAuthorization) turtle(n *turtleNode) {
+	n.resource("MedicinalProductAuthorization", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicinalProductAuthorization.identifier", i, v)
+	}
+	n.element("MedicinalProductAuthorization.subject", -1, r.Subject)
+	for i, v := range r.Country {
+		n.element("MedicinalProductAuthorization.country", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("MedicinalProductAuthorization.jurisdiction", i, v)
+	}
+	n.element("MedicinalProductAuthorization.status", -1, r.Status)
+	n.primitive("MedicinalProductAuthorization.statusDate", -1, r.StatusDate, r.StatusDateElement, "dateTime")
+	n.primitive("MedicinalProductAuthorization.restoreDate", -1, r.RestoreDate, r.RestoreDateElement, "dateTime")
+	n.element("MedicinalProductAuthorization.validityPeriod", -1, r.ValidityPeriod)
+	n.element("MedicinalProductAuthorization.dataExclusivityPeriod", -1, r.DataExclusivityPeriod)
+	n.primitive("MedicinalProductAuthorization.dateOfFirstAuthorization", -1, r.DateOfFirstAuthorization, r.DateOfFirstAuthorizationElement, "dateTime")
+	n.primitive("MedicinalProductAuthorization.internationalBirthDate", -1, r.InternationalBirthDate, r.InternationalBirthDateElement, "dateTime")
+	n.element("MedicinalProductAuthorization.legalBasis", -1, r.LegalBasis)
+	for i, v := range r.JurisdictionalAuthorization {
+		n.element("MedicinalProductAuthorization.jurisdictionalAuthorization", i, v)
+	}
+	n.element("MedicinalProductAuthorization.holder", -1, r.Holder)
+	n.element("MedicinalProductAuthorization.regulator", -1, r.Regulator)
+	n.element("MedicinalProductAuthorization.procedure", -1, r.Procedure)
+}
+
+// MarshalBSON marshals the given MedicinalProductAuthorization as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductAuthorization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductAuthorization from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductAuthorization) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductAuthorization as protobuf message MedicinalProductAuthorization of fhir.proto
+func (r MedicinalProductAuthorization) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductAuthorization to the encoded message
+func (r MedicinalProductAuthorization) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Subject != nil {
+		e.message(10, *r.Subject)
+	}
+	for _, v := range r.Country {
+		e.message(11, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(12, v)
+	}
+	if r.Status != nil {
+		e.message(13, *r.Status)
+	}
+	if r.StatusDate != nil {
+		e.string(14, *r.StatusDate)
+	}
+	if r.RestoreDate != nil {
+		e.string(15, *r.RestoreDate)
+	}
+	if r.ValidityPeriod != nil {
+		e.message(16, *r.ValidityPeriod)
+	}
+	if r.DataExclusivityPeriod != nil {
+		e.message(17, *r.DataExclusivityPeriod)
+	}
+	if r.DateOfFirstAuthorization != nil {
+		e.string(18, *r.DateOfFirstAuthorization)
+	}
+	if r.InternationalBirthDate != nil {
+		e.string(19, *r.InternationalBirthDate)
+	}
+	if r.LegalBasis != nil {
+		e.message(20, *r.LegalBasis)
+	}
+	for _, v := range r.JurisdictionalAuthorization {
+		e.message(21, v)
+	}
+	if r.Holder != nil {
+		e.message(22, *r.Holder)
+	}
+	if r.Regulator != nil {
+		e.message(23, *r.Regulator)
+	}
+	if r.Procedure != nil {
+		e.message(24, *r.Procedure)
+	}
+	if r.IdElement != nil {
+		e.message(25, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(26, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(27, *r.LanguageElement)
+	}
+	if r.StatusDateElement != nil {
+		e.message(28, *r.StatusDateElement)
+	}
+	if r.RestoreDateElement != nil {
+		e.message(29, *r.RestoreDateElement)
+	}
+	if r.DateOfFirstAuthorizationElement != nil {
+		e.message(30, *r.DateOfFirstAuthorizationElement)
+	}
+	if r.InternationalBirthDateElement != nil {
+		e.message(31, *r.InternationalBirthDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductAuthorization from protobuf message MedicinalProductAuthorization of fhir.proto
+func (r *MedicinalProductAuthorization) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductAuthorization{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Country = append(r.Country, v)
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Status = &v
+		case 14:
+			v := d.string()
+			r.StatusDate = &v
+		case 15:
+			v := d.string()
+			r.RestoreDate = &v
+		case 16:
+			var v Period
+			d.message(&v)
+			r.ValidityPeriod = &v
+		case 17:
+			var v Period
+			d.message(&v)
+			r.DataExclusivityPeriod = &v
+		case 18:
+			v := d.string()
+			r.DateOfFirstAuthorization = &v
+		case 19:
+			v := d.string()
+			r.InternationalBirthDate = &v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.LegalBasis = &v
+		case 21:
+			var v MedicinalProductAuthorizationJurisdictionalAuthorization
+			d.message(&v)
+			r.JurisdictionalAuthorization = append(r.JurisdictionalAuthorization, v)
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.Holder = &v
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Regulator = &v
+		case 24:
+			var v MedicinalProductAuthorizationProcedure
+			d.message(&v)
+			r.Procedure = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.StatusDateElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.RestoreDateElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.DateOfFirstAuthorizationElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.InternationalBirthDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductAuthorizationJurisdictionalAuthorization as JSON into a byte slice
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductAuthorizationJurisdictionalAuthorization as JSON object with the members in the order of the fields
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Country != nil {
+		e.key("country")
+		r.Country.appendJSON(e)
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.LegalStatusOfSupply != nil {
+		e.key("legalStatusOfSupply")
+		r.LegalStatusOfSupply.appendJSON(e)
+	}
+	if r.ValidityPeriod != nil {
+		e.key("validityPeriod")
+		r.ValidityPeriod.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductAuthorizationJurisdictionalAuthorization from JSON. Unknown members are ignored.
+func (r *MedicinalProductAuthorizationJurisdictionalAuthorization) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductAuthorizationJurisdictionalAuthorization
+func (r *MedicinalProductAuthorizationJurisdictionalAuthorization) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "country":
+			if d.null() {
+				r.Country = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Country = &v
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "legalStatusOfSupply":
+			if d.null() {
+				r.LegalStatusOfSupply = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LegalStatusOfSupply = &v
+			}
+		case "validityPeriod":
+			if d.null() {
+				r.ValidityPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValidityPeriod = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductAuthorizationJurisdictionalAuthorization which shares no memory with the original
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) DeepCopy() MedicinalProductAuthorizationJurisdictionalAuthorization {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Country != nil {
+		v := r.Country.DeepCopy()
+		out.Country = &v
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.LegalStatusOfSupply != nil {
+		v := r.LegalStatusOfSupply.DeepCopy()
+		out.LegalStatusOfSupply = &v
+	}
+	if r.ValidityPeriod != nil {
+		v := r.ValidityPeriod.DeepCopy()
+		out.ValidityPeriod = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) Equal(other MedicinalProductAuthorizationJurisdictionalAuthorization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Country == nil) != (other.Country == nil) || r.Country != nil && !r.Country.Equal(*other.Country) {
+		return false
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.LegalStatusOfSupply == nil) != (other.LegalStatusOfSupply == nil) || r.LegalStatusOfSupply != nil && !r.LegalStatusOfSupply.Equal(*other.LegalStatusOfSupply) {
+		return false
+	}
+	if (r.ValidityPeriod == nil) != (other.ValidityPeriod == nil) || r.ValidityPeriod != nil && !r.ValidityPeriod.Equal(*other.ValidityPeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) EqualsDeep(other MedicinalProductAuthorizationJurisdictionalAuthorization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Country == nil) != (other.Country == nil) || r.Country != nil && !r.Country.EqualsDeep(*other.Country) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.LegalStatusOfSupply == nil) != (other.LegalStatusOfSupply == nil) || r.LegalStatusOfSupply != nil && !r.LegalStatusOfSupply.EqualsDeep(*other.LegalStatusOfSupply) {
+		return false
+	}
+	if (r.ValidityPeriod == nil) != (other.ValidityPeriod == nil) || r.ValidityPeriod != nil && !r.ValidityPeriod.EqualsDeep(*other.ValidityPeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) EqualsShallow(other MedicinalProductAuthorizationJurisdictionalAuthorization) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductAuthorizationJurisdictionalAuthorization as FHIR XML
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "country", r.Country); err != nil {
+		return err
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "legalStatusOfSupply", r.LegalStatusOfSupply); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "validityPeriod", r.ValidityPeriod); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductAuthorizationJurisdictionalAuthorization from FHIR XML
+func (r *MedicinalProductAuthorizationJurisdictionalAuthorization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "country":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Country = &v
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "legalStatusOfSupply":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LegalStatusOfSupply = &v
+			case "validityPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValidityPeriod = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductAuthorizationJurisdictionalAuthorization as properties to the RDF node n
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicinalProductAuthorization.jurisdictionalAuthorization.identifier", i, v)
+	}
+	n.element("MedicinalProductAuthorization.jurisdictionalAuthorization.country", -1, r.Country)
+	for i, v := range r.Jurisdiction {
+		n.element("MedicinalProductAuthorization.jurisdictionalAuthorization.jurisdiction", i, v)
+	}
+	n.element("MedicinalProductAuthorization.jurisdictionalAuthorization.legalStatusOfSupply", -1, r.LegalStatusOfSupply)
+	n.element("MedicinalProductAuthorization.jurisdictionalAuthorization.validityPeriod", -1, r.ValidityPeriod)
+}
+
+// MarshalBSON marshals the given MedicinalProductAuthorizationJurisdictionalAuthorization as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductAuthorizationJurisdictionalAuthorization from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductAuthorizationJurisdictionalAuthorization) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductAuthorizationJurisdictionalAuthorization as protobuf message MedicinalProductAuthorizationJurisdictionalAuthorization of fhir.proto
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductAuthorizationJurisdictionalAuthorization to the encoded message
+func (r MedicinalProductAuthorizationJurisdictionalAuthorization) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(4, v)
+	}
+	if r.Country != nil {
+		e.message(5, *r.Country)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(6, v)
+	}
+	if r.LegalStatusOfSupply != nil {
+		e.message(7, *r.LegalStatusOfSupply)
+	}
+	if r.ValidityPeriod != nil {
+		e.message(8, *r.ValidityPeriod)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductAuthorizationJurisdictionalAuthorization from protobuf message MedicinalProductAuthorizationJurisdictionalAuthorization of fhir.proto
+func (r *MedicinalProductAuthorizationJurisdictionalAuthorization) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductAuthorizationJurisdictionalAuthorization{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Country = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.LegalStatusOfSupply = &v
+		case 8:
+			var v Period
+			d.message(&v)
+			r.ValidityPeriod = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductAuthorizationProcedure as JSON into a byte slice
+func (r MedicinalProductAuthorizationProcedure) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductAuthorizationProcedure as JSON object with the members in the order of the fields
+func (r MedicinalProductAuthorizationProcedure) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.DatePeriod != nil {
+		e.key("datePeriod")
+		r.DatePeriod.appendJSON(e)
+	}
+	if r.DateDateTime != nil {
+		e.key("dateDateTime")
+		e.string(*r.DateDateTime)
+	}
+	if r.DateDateTimeElement != nil {
+		e.key("_dateDateTime")
+		r.DateDateTimeElement.appendJSON(e)
+	}
+	if len(r.Application) > 0 {
+		e.key("application")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Application {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductAuthorizationProcedure from JSON. Unknown members are ignored.
+func (r *MedicinalProductAuthorizationProcedure) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductAuthorizationProcedure
+func (r *MedicinalProductAuthorizationProcedure) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "datePeriod":
+			if d.null() {
+				r.DatePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.DatePeriod = &v
+			}
+		case "dateDateTime":
+			if v, ok := d.string(); ok {
+				r.DateDateTime = &v
+			} else {
+				r.DateDateTime = nil
+			}
+		case "_dateDateTime":
+			r.DateDateTimeElement = d.element()
+		case "application":
+			a := d.array()
+			r.Application = nil
+			for a.next() {
+				var v MedicinalProductAuthorizationProcedure
+				v.decodeJSON(d)
+				r.Application = append(r.Application, v)
+			}
+			if r.Application == nil && !a.isNull {
+				r.Application = []MedicinalProductAuthorizationProcedure{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductAuthorizationProcedure which shares no memory with the original
+func (r MedicinalProductAuthorizationProcedure) DeepCopy() MedicinalProductAuthorizationProcedure {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.DatePeriod != nil {
+		v := r.DatePeriod.DeepCopy()
+		out.DatePeriod = &v
+	}
+	if r.DateDateTime != nil {
+		v := *r.DateDateTime
+		out.DateDateTime = &v
+	}
+	if r.DateDateTimeElement != nil {
+		v := r.DateDateTimeElement.DeepCopy()
+		out.DateDateTimeElement = &v
+	}
+	if r.Application != nil {
+		out.Application = make([]MedicinalProductAuthorizationProcedure, len(r.Application))
+		for i := range r.Application {
+			out.Application[i] = r.Application[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductAuthorizationProcedure) Equal(other MedicinalProductAuthorizationProcedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.DatePeriod == nil) != (other.DatePeriod == nil) || r.DatePeriod != nil && !r.DatePeriod.Equal(*other.DatePeriod) {
+		return false
+	}
+	if (r.DateDateTime == nil) != (other.DateDateTime == nil) || r.DateDateTime != nil && *r.DateDateTime != *other.DateDateTime {
+		return false
+	}
+	if (r.DateDateTimeElement == nil) != (other.DateDateTimeElement == nil) || r.DateDateTimeElement != nil && !r.DateDateTimeElement.Equal(*other.DateDateTimeElement) {
+		return false
+	}
+	if len(r.Application) != len(other.Application) {
+		return false
+	}
+	for i := range r.Application {
+		if !r.Application[i].Equal(other.Application[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductAuthorizationProcedure) EqualsDeep(other MedicinalProductAuthorizationProcedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.DatePeriod == nil) != (other.DatePeriod == nil) || r.DatePeriod != nil && !r.DatePeriod.EqualsDeep(*other.DatePeriod) {
+		return false
+	}
+	if (r.DateDateTime == nil) != (other.DateDateTime == nil) || r.DateDateTime != nil && !equivalentString(*r.DateDateTime, *other.DateDateTime) {
+		return false
+	}
+	if (r.DateDateTimeElement == nil) != (other.DateDateTimeElement == nil) || r.DateDateTimeElement != nil && !r.DateDateTimeElement.EqualsDeep(*other.DateDateTimeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Application), len(other.Application), func(i, j int) bool {
+		return r.Application[i].EqualsDeep(other.Application[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductAuthorizationProcedure) EqualsShallow(other MedicinalProductAuthorizationProcedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.DateDateTime == nil) != (other.DateDateTime == nil) || r.DateDateTime != nil && !equivalentString(*r.DateDateTime, *other.DateDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductAuthorizationProcedure as FHIR XML
+func (r MedicinalProductAuthorizationProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "datePeriod", r.DatePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "dateDateTime", r.DateDateTime, r.DateDateTimeElement); err != nil {
+		return err
+	}
+	for _, v := range r.Application {
+		if err := encodeXMLElement(e, "application", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductAuthorizationProcedure from FHIR XML
+func (r *MedicinalProductAuthorizationProcedure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "datePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DatePeriod = &v
+			case "dateDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DateDateTime = &v
+				}
+				r.DateDateTimeElement = element
+			case "application":
+				var v MedicinalProductAuthorizationProcedure
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Application = append(r.Application, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductAuthorizationProcedure as properties to the RDF node n
+func (r MedicinalProductAuthorizationProcedure) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductAuthorization.procedure.identifier", -1, r.Identifier)
+	n.element("MedicinalProductAuthorization.procedure.type", -1, r.Type)
+	n.element("MedicinalProductAuthorization.procedure.datePeriod", -1, r.DatePeriod)
+	n.primitive("MedicinalProductAuthorization.procedure.dateDateTime", -1, r.DateDateTime, r.DateDateTimeElement, "dateTime")
+	for i, v := range r.Application {
+		n.element("MedicinalProductAuthorization.procedure.application", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductAuthorizationProcedure as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductAuthorizationProcedure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductAuthorizationProcedure from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductAuthorizationProcedure) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductAuthorizationProcedure as protobuf message MedicinalProductAuthorizationProcedure of fhir.proto
+func (r MedicinalProductAuthorizationProcedure) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductAuthorizationProcedure to the encoded message
+func (r MedicinalProductAuthorizationProcedure) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identifier != nil {
+		e.message(4, *r.Identifier)
+	}
+	e.message(5, r.Type)
+	if r.DatePeriod != nil {
+		e.message(6, *r.DatePeriod)
+	}
+	if r.DateDateTime != nil {
+		e.string(7, *r.DateDateTime)
+	}
+	for _, v := range r.Application {
+		e.message(8, v)
+	}
+	if r.DateDateTimeElement != nil {
+		e.message(9, *r.DateDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductAuthorizationProcedure from protobuf message MedicinalProductAuthorizationProcedure of fhir.proto
+func (r *MedicinalProductAuthorizationProcedure) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductAuthorizationProcedure{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 6:
+			var v Period
+			d.message(&v)
+			r.DatePeriod = &v
+		case 7:
+			v := d.string()
+			r.DateDateTime = &v
+		case 8:
+			var v MedicinalProductAuthorizationProcedure
+			d.message(&v)
+			r.Application = append(r.Application, v)
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DateDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicinalProductAuthorization unmarshals a MedicinalProductAuthorization.
 func UnmarshalMedicinalProductAuthorization(b []byte) (MedicinalProductAuthorization, error) {
 	var medicinalProductAuthorization MedicinalProductAuthorization
-	if err := json.Unmarshal(b, &medicinalProductAuthorization); err != nil {
+	if err := medicinalProductAuthorization.UnmarshalJSON(b); err != nil {
 		return medicinalProductAuthorization, err
 	}
 	return medicinalProductAuthorization, nil
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,10 +25,14 @@ import "encoding/json"
 // MedicinalProductIndication is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductIndication
 type MedicinalProductIndication struct {
 	Id                      *string                                  `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement               *Element                                 `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                    *Meta                                    `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules           *string                                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                                 `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                *string                                  `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                                 `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                    *Narrative                               `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               []json.RawMessage                        `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension               []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension       []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Subject                 []Reference                              `bson:"subject,omitempty" json:"subject,omitempty"`
@@ -39,30 +46,1478 @@ type MedicinalProductIndication struct {
 	Population              []Population                             `bson:"population,omitempty" json:"population,omitempty"`
 }
 type MedicinalProductIndicationOtherTherapy struct {
-	Id                        *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                 []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension         []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	TherapyRelationshipType   CodeableConcept `bson:"therapyRelationshipType" json:"therapyRelationshipType"`
-	MedicationCodeableConcept CodeableConcept `bson:"medicationCodeableConcept" json:"medicationCodeableConcept"`
-	MedicationReference       Reference       `bson:"medicationReference" json:"medicationReference"`
+	Id                        *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	TherapyRelationshipType   CodeableConcept  `bson:"therapyRelationshipType" json:"therapyRelationshipType"`
+	MedicationCodeableConcept *CodeableConcept `bson:"medicationCodeableConcept,omitempty" json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *Reference       `bson:"medicationReference,omitempty" json:"medicationReference,omitempty"`
+}
+
+// MedicinalProductIndicationOtherTherapyMedication is implemented by the types allowed for MedicinalProductIndication.otherTherapy.medication[x]
+type MedicinalProductIndicationOtherTherapyMedication interface {
+	isMedicinalProductIndicationOtherTherapyMedication()
+}
+
+func (CodeableConcept) isMedicinalProductIndicationOtherTherapyMedication() {}
+func (Reference) isMedicinalProductIndicationOtherTherapyMedication()       {}
+
+// Medication returns the value of MedicinalProductIndication.otherTherapy.medication[x] together with its FHIR type code or nil if no value is set
+func (r MedicinalProductIndicationOtherTherapy) Medication() (MedicinalProductIndicationOtherTherapyMedication, string) {
+	if r.MedicationCodeableConcept != nil {
+		return *r.MedicationCodeableConcept, "CodeableConcept"
+	}
+	if r.MedicationReference != nil {
+		return *r.MedicationReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetMedication sets the value of MedicinalProductIndication.otherTherapy.medication[x], given as value or pointer, and clears all other types
+func (r *MedicinalProductIndicationOtherTherapy) SetMedication(value MedicinalProductIndicationOtherTherapyMedication) {
+	r.MedicationCodeableConcept = nil
+	r.MedicationReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.MedicationCodeableConcept = &v
+	case *CodeableConcept:
+		r.MedicationCodeableConcept = v
+	case Reference:
+		r.MedicationReference = &v
+	case *Reference:
+		r.MedicationReference = v
+	}
 }
-type OtherMedicinalProductIndication MedicinalProductIndication
 
 // MarshalJSON marshals the given MedicinalProductIndication as JSON into a byte slice
 func (r MedicinalProductIndication) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicinalProductIndication
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicinalProductIndication: OtherMedicinalProductIndication(r),
-		ResourceType:                    "MedicinalProductIndication",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductIndication as JSON object with the members in the order of the fields
+func (r MedicinalProductIndication) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicinalProductIndication\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Subject) > 0 {
+		e.key("subject")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subject {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DiseaseSymptomProcedure != nil {
+		e.key("diseaseSymptomProcedure")
+		r.DiseaseSymptomProcedure.appendJSON(e)
+	}
+	if r.DiseaseStatus != nil {
+		e.key("diseaseStatus")
+		r.DiseaseStatus.appendJSON(e)
+	}
+	if len(r.Comorbidity) > 0 {
+		e.key("comorbidity")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Comorbidity {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.IntendedEffect != nil {
+		e.key("intendedEffect")
+		r.IntendedEffect.appendJSON(e)
+	}
+	if r.Duration != nil {
+		e.key("duration")
+		r.Duration.appendJSON(e)
+	}
+	if len(r.OtherTherapy) > 0 {
+		e.key("otherTherapy")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.OtherTherapy {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.UndesirableEffect) > 0 {
+		e.key("undesirableEffect")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UndesirableEffect {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Population) > 0 {
+		e.key("population")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Population {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductIndication from JSON. Unknown members are ignored.
+func (r *MedicinalProductIndication) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductIndication
+func (r *MedicinalProductIndication) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "subject":
+			a := d.array()
+			r.Subject = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = append(r.Subject, v)
+			}
+			if r.Subject == nil && !a.isNull {
+				r.Subject = []Reference{}
+			}
+		case "diseaseSymptomProcedure":
+			if d.null() {
+				r.DiseaseSymptomProcedure = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DiseaseSymptomProcedure = &v
+			}
+		case "diseaseStatus":
+			if d.null() {
+				r.DiseaseStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DiseaseStatus = &v
+			}
+		case "comorbidity":
+			a := d.array()
+			r.Comorbidity = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Comorbidity = append(r.Comorbidity, v)
+			}
+			if r.Comorbidity == nil && !a.isNull {
+				r.Comorbidity = []CodeableConcept{}
+			}
+		case "intendedEffect":
+			if d.null() {
+				r.IntendedEffect = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.IntendedEffect = &v
+			}
+		case "duration":
+			if d.null() {
+				r.Duration = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Duration = &v
+			}
+		case "otherTherapy":
+			a := d.array()
+			r.OtherTherapy = nil
+			for a.next() {
+				var v MedicinalProductIndicationOtherTherapy
+				v.decodeJSON(d)
+				r.OtherTherapy = append(r.OtherTherapy, v)
+			}
+			if r.OtherTherapy == nil && !a.isNull {
+				r.OtherTherapy = []MedicinalProductIndicationOtherTherapy{}
+			}
+		case "undesirableEffect":
+			a := d.array()
+			r.UndesirableEffect = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.UndesirableEffect = append(r.UndesirableEffect, v)
+			}
+			if r.UndesirableEffect == nil && !a.isNull {
+				r.UndesirableEffect = []Reference{}
+			}
+		case "population":
+			a := d.array()
+			r.Population = nil
+			for a.next() {
+				var v Population
+				v.decodeJSON(d)
+				r.Population = append(r.Population, v)
+			}
+			if r.Population == nil && !a.isNull {
+				r.Population = []Population{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductIndication which shares no memory with the original
+func (r MedicinalProductIndication) DeepCopy() MedicinalProductIndication {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Subject != nil {
+		out.Subject = make([]Reference, len(r.Subject))
+		for i := range r.Subject {
+			out.Subject[i] = r.Subject[i].DeepCopy()
+		}
+	}
+	if r.DiseaseSymptomProcedure != nil {
+		v := r.DiseaseSymptomProcedure.DeepCopy()
+		out.DiseaseSymptomProcedure = &v
+	}
+	if r.DiseaseStatus != nil {
+		v := r.DiseaseStatus.DeepCopy()
+		out.DiseaseStatus = &v
+	}
+	if r.Comorbidity != nil {
+		out.Comorbidity = make([]CodeableConcept, len(r.Comorbidity))
+		for i := range r.Comorbidity {
+			out.Comorbidity[i] = r.Comorbidity[i].DeepCopy()
+		}
+	}
+	if r.IntendedEffect != nil {
+		v := r.IntendedEffect.DeepCopy()
+		out.IntendedEffect = &v
+	}
+	if r.Duration != nil {
+		v := r.Duration.DeepCopy()
+		out.Duration = &v
+	}
+	if r.OtherTherapy != nil {
+		out.OtherTherapy = make([]MedicinalProductIndicationOtherTherapy, len(r.OtherTherapy))
+		for i := range r.OtherTherapy {
+			out.OtherTherapy[i] = r.OtherTherapy[i].DeepCopy()
+		}
+	}
+	if r.UndesirableEffect != nil {
+		out.UndesirableEffect = make([]Reference, len(r.UndesirableEffect))
+		for i := range r.UndesirableEffect {
+			out.UndesirableEffect[i] = r.UndesirableEffect[i].DeepCopy()
+		}
+	}
+	if r.Population != nil {
+		out.Population = make([]Population, len(r.Population))
+		for i := range r.Population {
+			out.Population[i] = r.Population[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductIndication) Equal(other MedicinalProductIndication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Subject) != len(other.Subject) {
+		return false
+	}
+	for i := range r.Subject {
+		if !r.Subject[i].Equal(other.Subject[i]) {
+			return false
+		}
+	}
+	if (r.DiseaseSymptomProcedure == nil) != (other.DiseaseSymptomProcedure == nil) || r.DiseaseSymptomProcedure != nil && !r.DiseaseSymptomProcedure.Equal(*other.DiseaseSymptomProcedure) {
+		return false
+	}
+	if (r.DiseaseStatus == nil) != (other.DiseaseStatus == nil) || r.DiseaseStatus != nil && !r.DiseaseStatus.Equal(*other.DiseaseStatus) {
+		return false
+	}
+	if len(r.Comorbidity) != len(other.Comorbidity) {
+		return false
+	}
+	for i := range r.Comorbidity {
+		if !r.Comorbidity[i].Equal(other.Comorbidity[i]) {
+			return false
+		}
+	}
+	if (r.IntendedEffect == nil) != (other.IntendedEffect == nil) || r.IntendedEffect != nil && !r.IntendedEffect.Equal(*other.IntendedEffect) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.Equal(*other.Duration) {
+		return false
+	}
+	if len(r.OtherTherapy) != len(other.OtherTherapy) {
+		return false
+	}
+	for i := range r.OtherTherapy {
+		if !r.OtherTherapy[i].Equal(other.OtherTherapy[i]) {
+			return false
+		}
+	}
+	if len(r.UndesirableEffect) != len(other.UndesirableEffect) {
+		return false
+	}
+	for i := range r.UndesirableEffect {
+		if !r.UndesirableEffect[i].Equal(other.UndesirableEffect[i]) {
+			return false
+		}
+	}
+	if len(r.Population) != len(other.Population) {
+		return false
+	}
+	for i := range r.Population {
+		if !r.Population[i].Equal(other.Population[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductIndication) EqualsDeep(other MedicinalProductIndication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Subject), len(other.Subject), func(i, j int) bool {
+		return r.Subject[i].EqualsDeep(other.Subject[j])
+	}) {
+		return false
+	}
+	if (r.DiseaseSymptomProcedure == nil) != (other.DiseaseSymptomProcedure == nil) || r.DiseaseSymptomProcedure != nil && !r.DiseaseSymptomProcedure.EqualsDeep(*other.DiseaseSymptomProcedure) {
+		return false
+	}
+	if (r.DiseaseStatus == nil) != (other.DiseaseStatus == nil) || r.DiseaseStatus != nil && !r.DiseaseStatus.EqualsDeep(*other.DiseaseStatus) {
+		return false
+	}
+	if !equivalentList(len(r.Comorbidity), len(other.Comorbidity), func(i, j int) bool {
+		return r.Comorbidity[i].EqualsDeep(other.Comorbidity[j])
+	}) {
+		return false
+	}
+	if (r.IntendedEffect == nil) != (other.IntendedEffect == nil) || r.IntendedEffect != nil && !r.IntendedEffect.EqualsDeep(*other.IntendedEffect) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.EqualsDeep(*other.Duration) {
+		return false
+	}
+	if !equivalentList(len(r.OtherTherapy), len(other.OtherTherapy), func(i, j int) bool {
+		return r.OtherTherapy[i].EqualsDeep(other.OtherTherapy[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.UndesirableEffect), len(other.UndesirableEffect), func(i, j int) bool {
+		return r.UndesirableEffect[i].EqualsDeep(other.UndesirableEffect[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Population), len(other.Population), func(i, j int) bool {
+		return r.Population[i].EqualsDeep(other.Population[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductIndication) EqualsShallow(other MedicinalProductIndication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductIndication as FHIR XML
+func (r MedicinalProductIndication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicinalProductIndication",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Subject {
+		if err := encodeXMLElement(e, "subject", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "diseaseSymptomProcedure", r.DiseaseSymptomProcedure); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "diseaseStatus", r.DiseaseStatus); err != nil {
+		return err
+	}
+	for _, v := range r.Comorbidity {
+		if err := encodeXMLElement(e, "comorbidity", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "intendedEffect", r.IntendedEffect); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "duration", r.Duration); err != nil {
+		return err
+	}
+	for _, v := range r.OtherTherapy {
+		if err := encodeXMLElement(e, "otherTherapy", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.UndesirableEffect {
+		if err := encodeXMLElement(e, "undesirableEffect", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Population {
+		if err := encodeXMLElement(e, "population", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductIndication from FHIR XML
+func (r *MedicinalProductIndication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = append(r.Subject, v)
+			case "diseaseSymptomProcedure":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DiseaseSymptomProcedure = &v
+			case "diseaseStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DiseaseStatus = &v
+			case "comorbidity":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Comorbidity = append(r.Comorbidity, v)
+			case "intendedEffect":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.IntendedEffect = &v
+			case "duration":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Duration = &v
+			case "otherTherapy":
+				var v MedicinalProductIndicationOtherTherapy
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OtherTherapy = append(r.OtherTherapy, v)
+			case "undesirableEffect":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UndesirableEffect = append(r.UndesirableEffect, v)
+			case "population":
+				var v Population
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Population = append(r.Population, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductIndication as properties to the RDF node n
+func (r MedicinalProductIndication) turtle(n *turtleNode) {
+	n.resource("MedicinalProductIndication", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Subject {
+		n.element("MedicinalProductIndication.subject", i, v)
+	}
+	n.element("MedicinalProductIndication.diseaseSymptomProcedure", -1, r.DiseaseSymptomProcedure)
+	n.element("MedicinalProductIndication.diseaseStatus", -1, r.DiseaseStatus)
+	for i, v := range r.Comorbidity {
+		n.element("MedicinalProductIndication.comorbidity", i, v)
+	}
+	n.element("MedicinalProductIndication.intendedEffect", -1, r.IntendedEffect)
+	n.element("MedicinalProductIndication.duration", -1, r.Duration)
+	for i, v := range r.OtherTherapy {
+		n.element("MedicinalProductIndication.otherTherapy", i, v)
+	}
+	for i, v := range r.UndesirableEffect {
+		n.element("MedicinalProductIndication.undesirableEffect", i, v)
+	}
+	for i, v := range r.Population {
+		n.element("MedicinalProductIndication.population", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductIndication as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductIndication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductIndication from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductIndication) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductIndication as protobuf message MedicinalProductIndication of fhir.proto
+func (r MedicinalProductIndication) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductIndication to the encoded message
+func (r MedicinalProductIndication) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Subject {
+		e.message(9, v)
+	}
+	if r.DiseaseSymptomProcedure != nil {
+		e.message(10, *r.DiseaseSymptomProcedure)
+	}
+	if r.DiseaseStatus != nil {
+		e.message(11, *r.DiseaseStatus)
+	}
+	for _, v := range r.Comorbidity {
+		e.message(12, v)
+	}
+	if r.IntendedEffect != nil {
+		e.message(13, *r.IntendedEffect)
+	}
+	if r.Duration != nil {
+		e.message(14, *r.Duration)
+	}
+	for _, v := range r.OtherTherapy {
+		e.message(15, v)
+	}
+	for _, v := range r.UndesirableEffect {
+		e.message(16, v)
+	}
+	for _, v := range r.Population {
+		e.message(17, v)
+	}
+	if r.IdElement != nil {
+		e.message(18, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(19, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(20, *r.LanguageElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductIndication from protobuf message MedicinalProductIndication of fhir.proto
+func (r *MedicinalProductIndication) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductIndication{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Subject = append(r.Subject, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.DiseaseSymptomProcedure = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.DiseaseStatus = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Comorbidity = append(r.Comorbidity, v)
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.IntendedEffect = &v
+		case 14:
+			var v Quantity
+			d.message(&v)
+			r.Duration = &v
+		case 15:
+			var v MedicinalProductIndicationOtherTherapy
+			d.message(&v)
+			r.OtherTherapy = append(r.OtherTherapy, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.UndesirableEffect = append(r.UndesirableEffect, v)
+		case 17:
+			var v Population
+			d.message(&v)
+			r.Population = append(r.Population, v)
+		case 18:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductIndicationOtherTherapy as JSON into a byte slice
+func (r MedicinalProductIndicationOtherTherapy) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductIndicationOtherTherapy as JSON object with the members in the order of the fields
+func (r MedicinalProductIndicationOtherTherapy) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("therapyRelationshipType")
+	r.TherapyRelationshipType.appendJSON(e)
+	if r.MedicationCodeableConcept != nil {
+		e.key("medicationCodeableConcept")
+		r.MedicationCodeableConcept.appendJSON(e)
+	}
+	if r.MedicationReference != nil {
+		e.key("medicationReference")
+		r.MedicationReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductIndicationOtherTherapy from JSON. Unknown members are ignored.
+func (r *MedicinalProductIndicationOtherTherapy) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductIndicationOtherTherapy
+func (r *MedicinalProductIndicationOtherTherapy) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "therapyRelationshipType":
+			r.TherapyRelationshipType.decodeJSON(d)
+		case "medicationCodeableConcept":
+			if d.null() {
+				r.MedicationCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.MedicationCodeableConcept = &v
+			}
+		case "medicationReference":
+			if d.null() {
+				r.MedicationReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.MedicationReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductIndicationOtherTherapy which shares no memory with the original
+func (r MedicinalProductIndicationOtherTherapy) DeepCopy() MedicinalProductIndicationOtherTherapy {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.TherapyRelationshipType = r.TherapyRelationshipType.DeepCopy()
+	if r.MedicationCodeableConcept != nil {
+		v := r.MedicationCodeableConcept.DeepCopy()
+		out.MedicationCodeableConcept = &v
+	}
+	if r.MedicationReference != nil {
+		v := r.MedicationReference.DeepCopy()
+		out.MedicationReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductIndicationOtherTherapy) Equal(other MedicinalProductIndicationOtherTherapy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.TherapyRelationshipType.Equal(other.TherapyRelationshipType) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.Equal(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.Equal(*other.MedicationReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductIndicationOtherTherapy) EqualsDeep(other MedicinalProductIndicationOtherTherapy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.TherapyRelationshipType.EqualsDeep(other.TherapyRelationshipType) {
+		return false
+	}
+	if (r.MedicationCodeableConcept == nil) != (other.MedicationCodeableConcept == nil) || r.MedicationCodeableConcept != nil && !r.MedicationCodeableConcept.EqualsDeep(*other.MedicationCodeableConcept) {
+		return false
+	}
+	if (r.MedicationReference == nil) != (other.MedicationReference == nil) || r.MedicationReference != nil && !r.MedicationReference.EqualsDeep(*other.MedicationReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductIndicationOtherTherapy) EqualsShallow(other MedicinalProductIndicationOtherTherapy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductIndicationOtherTherapy as FHIR XML
+func (r MedicinalProductIndicationOtherTherapy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "therapyRelationshipType", r.TherapyRelationshipType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationCodeableConcept", r.MedicationCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "medicationReference", r.MedicationReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductIndicationOtherTherapy from FHIR XML
+func (r *MedicinalProductIndicationOtherTherapy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "therapyRelationshipType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TherapyRelationshipType = v
+			case "medicationCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationCodeableConcept = &v
+			case "medicationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MedicationReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductIndicationOtherTherapy as properties to the RDF node n
+func (r MedicinalProductIndicationOtherTherapy) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductIndication.otherTherapy.therapyRelationshipType", -1, r.TherapyRelationshipType)
+	n.element("MedicinalProductIndication.otherTherapy.medicationCodeableConcept", -1, r.MedicationCodeableConcept)
+	n.element("MedicinalProductIndication.otherTherapy.medicationReference", -1, r.MedicationReference)
+}
+
+// MarshalBSON marshals the given MedicinalProductIndicationOtherTherapy as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductIndicationOtherTherapy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductIndicationOtherTherapy from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductIndicationOtherTherapy) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductIndicationOtherTherapy as protobuf message MedicinalProductIndicationOtherTherapy of fhir.proto
+func (r MedicinalProductIndicationOtherTherapy) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductIndicationOtherTherapy to the encoded message
+func (r MedicinalProductIndicationOtherTherapy) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.TherapyRelationshipType)
+	if r.MedicationCodeableConcept != nil {
+		e.message(5, *r.MedicationCodeableConcept)
+	}
+	if r.MedicationReference != nil {
+		e.message(6, *r.MedicationReference)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductIndicationOtherTherapy from protobuf message MedicinalProductIndicationOtherTherapy of fhir.proto
+func (r *MedicinalProductIndicationOtherTherapy) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductIndicationOtherTherapy{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.TherapyRelationshipType = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.MedicationCodeableConcept = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.MedicationReference = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicinalProductIndication unmarshals a MedicinalProductIndication.
 func UnmarshalMedicinalProductIndication(b []byte) (MedicinalProductIndication, error) {
 	var medicinalProductIndication MedicinalProductIndication
-	if err := json.Unmarshal(b, &medicinalProductIndication); err != nil {
+	if err := medicinalProductIndication.UnmarshalJSON(b); err != nil {
 		return medicinalProductIndication, err
 	}
 	return medicinalProductIndication, nil
@@ -14,26 +14,34 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MedicinalProductIngredient is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductIngredient
 type MedicinalProductIngredient struct {
-	Id                  *string                                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string                                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string                                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative                                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension                                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          *Identifier                                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Role                CodeableConcept                                `bson:"role" json:"role"`
-	AllergenicIndicator *bool                                          `bson:"allergenicIndicator,omitempty" json:"allergenicIndicator,omitempty"`
-	Manufacturer        []Reference                                    `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	SpecifiedSubstance  []MedicinalProductIngredientSpecifiedSubstance `bson:"specifiedSubstance,omitempty" json:"specifiedSubstance,omitempty"`
-	Substance           *MedicinalProductIngredientSubstance           `bson:"substance,omitempty" json:"substance,omitempty"`
+	Id                         *string                                        `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                  *Element                                       `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                       *Meta                                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules              *string                                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement       *Element                                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                   *string                                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement            *Element                                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                       *Narrative                                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                  []json.RawMessage                              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                  []Extension                                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                 *Identifier                                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Role                       CodeableConcept                                `bson:"role" json:"role"`
+	AllergenicIndicator        *bool                                          `bson:"allergenicIndicator,omitempty" json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *Element                                       `bson:"_allergenicIndicator,omitempty" json:"_allergenicIndicator,omitempty"`
+	Manufacturer               []Reference                                    `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	SpecifiedSubstance         []MedicinalProductIngredientSpecifiedSubstance `bson:"specifiedSubstance,omitempty" json:"specifiedSubstance,omitempty"`
+	Substance                  *MedicinalProductIngredientSubstance           `bson:"substance,omitempty" json:"substance,omitempty"`
 }
 type MedicinalProductIngredientSpecifiedSubstance struct {
 	Id                *string                                                `bson:"id,omitempty" json:"id,omitempty"`
@@ -45,26 +53,28 @@ type MedicinalProductIngredientSpecifiedSubstance struct {
 	Strength          []MedicinalProductIngredientSpecifiedSubstanceStrength `bson:"strength,omitempty" json:"strength,omitempty"`
 }
 type MedicinalProductIngredientSpecifiedSubstanceStrength struct {
-	Id                    *string                                                                 `bson:"id,omitempty" json:"id,omitempty"`
-	Extension             []Extension                                                             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension                                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Presentation          Ratio                                                                   `bson:"presentation" json:"presentation"`
-	PresentationLowLimit  *Ratio                                                                  `bson:"presentationLowLimit,omitempty" json:"presentationLowLimit,omitempty"`
-	Concentration         *Ratio                                                                  `bson:"concentration,omitempty" json:"concentration,omitempty"`
-	ConcentrationLowLimit *Ratio                                                                  `bson:"concentrationLowLimit,omitempty" json:"concentrationLowLimit,omitempty"`
-	MeasurementPoint      *string                                                                 `bson:"measurementPoint,omitempty" json:"measurementPoint,omitempty"`
-	Country               []CodeableConcept                                                       `bson:"country,omitempty" json:"country,omitempty"`
-	ReferenceStrength     []MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength `bson:"referenceStrength,omitempty" json:"referenceStrength,omitempty"`
+	Id                      *string                                                                 `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                                                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Presentation            Ratio                                                                   `bson:"presentation" json:"presentation"`
+	PresentationLowLimit    *Ratio                                                                  `bson:"presentationLowLimit,omitempty" json:"presentationLowLimit,omitempty"`
+	Concentration           *Ratio                                                                  `bson:"concentration,omitempty" json:"concentration,omitempty"`
+	ConcentrationLowLimit   *Ratio                                                                  `bson:"concentrationLowLimit,omitempty" json:"concentrationLowLimit,omitempty"`
+	MeasurementPoint        *string                                                                 `bson:"measurementPoint,omitempty" json:"measurementPoint,omitempty"`
+	MeasurementPointElement *Element                                                                `bson:"_measurementPoint,omitempty" json:"_measurementPoint,omitempty"`
+	Country                 []CodeableConcept                                                       `bson:"country,omitempty" json:"country,omitempty"`
+	ReferenceStrength       []MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength `bson:"referenceStrength,omitempty" json:"referenceStrength,omitempty"`
 }
 type MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength struct {
-	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Substance         *CodeableConcept  `bson:"substance,omitempty" json:"substance,omitempty"`
-	Strength          Ratio             `bson:"strength" json:"strength"`
-	StrengthLowLimit  *Ratio            `bson:"strengthLowLimit,omitempty" json:"strengthLowLimit,omitempty"`
-	MeasurementPoint  *string           `bson:"measurementPoint,omitempty" json:"measurementPoint,omitempty"`
-	Country           []CodeableConcept `bson:"country,omitempty" json:"country,omitempty"`
+	Id                      *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Substance               *CodeableConcept  `bson:"substance,omitempty" json:"substance,omitempty"`
+	Strength                Ratio             `bson:"strength" json:"strength"`
+	StrengthLowLimit        *Ratio            `bson:"strengthLowLimit,omitempty" json:"strengthLowLimit,omitempty"`
+	MeasurementPoint        *string           `bson:"measurementPoint,omitempty" json:"measurementPoint,omitempty"`
+	MeasurementPointElement *Element          `bson:"_measurementPoint,omitempty" json:"_measurementPoint,omitempty"`
+	Country                 []CodeableConcept `bson:"country,omitempty" json:"country,omitempty"`
 }
 type MedicinalProductIngredientSubstance struct {
 	Id                *string                                                `bson:"id,omitempty" json:"id,omitempty"`
@@ -73,23 +83,2817 @@ type MedicinalProductIngredientSubstance struct {
 	Code              CodeableConcept                                        `bson:"code" json:"code"`
 	Strength          []MedicinalProductIngredientSpecifiedSubstanceStrength `bson:"strength,omitempty" json:"strength,omitempty"`
 }
-type OtherMedicinalProductIngredient MedicinalProductIngredient
 
 // MarshalJSON marshals the given MedicinalProductIngredient as JSON into a byte slice
 func (r MedicinalProductIngredient) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicinalProductIngredient
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicinalProductIngredient: OtherMedicinalProductIngredient(r),
-		ResourceType:                    "MedicinalProductIngredient",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductIngredient as JSON object with the members in the order of the fields
+func (r MedicinalProductIngredient) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicinalProductIngredient\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	e.key("role")
+	r.Role.appendJSON(e)
+	if r.AllergenicIndicator != nil {
+		e.key("allergenicIndicator")
+		e.bool(*r.AllergenicIndicator)
+	}
+	if r.AllergenicIndicatorElement != nil {
+		e.key("_allergenicIndicator")
+		r.AllergenicIndicatorElement.appendJSON(e)
+	}
+	if len(r.Manufacturer) > 0 {
+		e.key("manufacturer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Manufacturer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SpecifiedSubstance) > 0 {
+		e.key("specifiedSubstance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SpecifiedSubstance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Substance != nil {
+		e.key("substance")
+		r.Substance.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductIngredient from JSON. Unknown members are ignored.
+func (r *MedicinalProductIngredient) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductIngredient
+func (r *MedicinalProductIngredient) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "role":
+			r.Role.decodeJSON(d)
+		case "allergenicIndicator":
+			if v, ok := d.bool(); ok {
+				r.AllergenicIndicator = &v
+			} else {
+				r.AllergenicIndicator = nil
+			}
+		case "_allergenicIndicator":
+			r.AllergenicIndicatorElement = d.element()
+		case "manufacturer":
+			a := d.array()
+			r.Manufacturer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Manufacturer = append(r.Manufacturer, v)
+			}
+			if r.Manufacturer == nil && !a.isNull {
+				r.Manufacturer = []Reference{}
+			}
+		case "specifiedSubstance":
+			a := d.array()
+			r.SpecifiedSubstance = nil
+			for a.next() {
+				var v MedicinalProductIngredientSpecifiedSubstance
+				v.decodeJSON(d)
+				r.SpecifiedSubstance = append(r.SpecifiedSubstance, v)
+			}
+			if r.SpecifiedSubstance == nil && !a.isNull {
+				r.SpecifiedSubstance = []MedicinalProductIngredientSpecifiedSubstance{}
+			}
+		case "substance":
+			if d.null() {
+				r.Substance = nil
+			} else {
+				var v MedicinalProductIngredientSubstance
+				v.decodeJSON(d)
+				r.Substance = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductIngredient which shares no memory with the original
+func (r MedicinalProductIngredient) DeepCopy() MedicinalProductIngredient {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	out.Role = r.Role.DeepCopy()
+	if r.AllergenicIndicator != nil {
+		v := *r.AllergenicIndicator
+		out.AllergenicIndicator = &v
+	}
+	if r.AllergenicIndicatorElement != nil {
+		v := r.AllergenicIndicatorElement.DeepCopy()
+		out.AllergenicIndicatorElement = &v
+	}
+	if r.Manufacturer != nil {
+		out.Manufacturer = make([]Reference, len(r.Manufacturer))
+		for i := range r.Manufacturer {
+			out.Manufacturer[i] = r.Manufacturer[i].DeepCopy()
+		}
+	}
+	if r.SpecifiedSubstance != nil {
+		out.SpecifiedSubstance = make([]MedicinalProductIngredientSpecifiedSubstance, len(r.SpecifiedSubstance))
+		for i := range r.SpecifiedSubstance {
+			out.SpecifiedSubstance[i] = r.SpecifiedSubstance[i].DeepCopy()
+		}
+	}
+	if r.Substance != nil {
+		v := r.Substance.DeepCopy()
+		out.Substance = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductIngredient) Equal(other MedicinalProductIngredient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if !r.Role.Equal(other.Role) {
+		return false
+	}
+	if (r.AllergenicIndicator == nil) != (other.AllergenicIndicator == nil) || r.AllergenicIndicator != nil && *r.AllergenicIndicator != *other.AllergenicIndicator {
+		return false
+	}
+	if (r.AllergenicIndicatorElement == nil) != (other.AllergenicIndicatorElement == nil) || r.AllergenicIndicatorElement != nil && !r.AllergenicIndicatorElement.Equal(*other.AllergenicIndicatorElement) {
+		return false
+	}
+	if len(r.Manufacturer) != len(other.Manufacturer) {
+		return false
+	}
+	for i := range r.Manufacturer {
+		if !r.Manufacturer[i].Equal(other.Manufacturer[i]) {
+			return false
+		}
+	}
+	if len(r.SpecifiedSubstance) != len(other.SpecifiedSubstance) {
+		return false
+	}
+	for i := range r.SpecifiedSubstance {
+		if !r.SpecifiedSubstance[i].Equal(other.SpecifiedSubstance[i]) {
+			return false
+		}
+	}
+	if (r.Substance == nil) != (other.Substance == nil) || r.Substance != nil && !r.Substance.Equal(*other.Substance) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductIngredient) EqualsDeep(other MedicinalProductIngredient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if !r.Role.EqualsDeep(other.Role) {
+		return false
+	}
+	if (r.AllergenicIndicator == nil) != (other.AllergenicIndicator == nil) || r.AllergenicIndicator != nil && *r.AllergenicIndicator != *other.AllergenicIndicator {
+		return false
+	}
+	if (r.AllergenicIndicatorElement == nil) != (other.AllergenicIndicatorElement == nil) || r.AllergenicIndicatorElement != nil && !r.AllergenicIndicatorElement.EqualsDeep(*other.AllergenicIndicatorElement) {
+		return false
+	}
+	if !equivalentList(len(r.Manufacturer), len(other.Manufacturer), func(i, j int) bool {
+		return r.Manufacturer[i].EqualsDeep(other.Manufacturer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SpecifiedSubstance), len(other.SpecifiedSubstance), func(i, j int) bool {
+		return r.SpecifiedSubstance[i].EqualsDeep(other.SpecifiedSubstance[j])
+	}) {
+		return false
+	}
+	if (r.Substance == nil) != (other.Substance == nil) || r.Substance != nil && !r.Substance.EqualsDeep(*other.Substance) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductIngredient) EqualsShallow(other MedicinalProductIngredient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.AllergenicIndicator == nil) != (other.AllergenicIndicator == nil) || r.AllergenicIndicator != nil && *r.AllergenicIndicator != *other.AllergenicIndicator {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductIngredient as FHIR XML
+func (r MedicinalProductIngredient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicinalProductIngredient",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "allergenicIndicator", r.AllergenicIndicator, r.AllergenicIndicatorElement); err != nil {
+		return err
+	}
+	for _, v := range r.Manufacturer {
+		if err := encodeXMLElement(e, "manufacturer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SpecifiedSubstance {
+		if err := encodeXMLElement(e, "specifiedSubstance", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "substance", r.Substance); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductIngredient from FHIR XML
+func (r *MedicinalProductIngredient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = v
+			case "allergenicIndicator":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllergenicIndicator = &v
+				}
+				r.AllergenicIndicatorElement = element
+			case "manufacturer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manufacturer = append(r.Manufacturer, v)
+			case "specifiedSubstance":
+				var v MedicinalProductIngredientSpecifiedSubstance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SpecifiedSubstance = append(r.SpecifiedSubstance, v)
+			case "substance":
+				var v MedicinalProductIngredientSubstance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Substance = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductIngredient as properties to the RDF node n
+func (r MedicinalProductIngredient) turtle(n *turtleNode) {
+	n.resource("MedicinalProductIngredient", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductIngredient.identifier", -1, r.Identifier)
+	n.element("MedicinalProductIngredient.role", -1, r.Role)
+	n.primitive("MedicinalProductIngredient.allergenicIndicator", -1, r.AllergenicIndicator, r.AllergenicIndicatorElement, "boolean")
+	for i, v := range r.Manufacturer {
+		n.element("MedicinalProductIngredient.manufacturer", i, v)
+	}
+	for i, v := range r.SpecifiedSubstance {
+		n.element("MedicinalProductIngredient.specifiedSubstance", i, v)
+	}
+	n.element("MedicinalProductIngredient.substance", -1, r.Substance)
+}
+
+// MarshalBSON marshals the given MedicinalProductIngredient as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductIngredient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductIngredient from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductIngredient) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductIngredient as protobuf message MedicinalProductIngredient of fhir.proto
+func (r MedicinalProductIngredient) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductIngredient to the encoded message
+func (r MedicinalProductIngredient) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Identifier != nil {
+		e.message(9, *r.Identifier)
+	}
+	e.message(10, r.Role)
+	if r.AllergenicIndicator != nil {
+		e.varint(11, protoBool(*r.AllergenicIndicator))
+	}
+	for _, v := range r.Manufacturer {
+		e.message(12, v)
+	}
+	for _, v := range r.SpecifiedSubstance {
+		e.message(13, v)
+	}
+	if r.Substance != nil {
+		e.message(14, *r.Substance)
+	}
+	if r.IdElement != nil {
+		e.message(15, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(16, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(17, *r.LanguageElement)
+	}
+	if r.AllergenicIndicatorElement != nil {
+		e.message(18, *r.AllergenicIndicatorElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductIngredient from protobuf message MedicinalProductIngredient of fhir.proto
+func (r *MedicinalProductIngredient) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductIngredient{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = v
+		case 11:
+			v := d.bool()
+			r.AllergenicIndicator = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Manufacturer = append(r.Manufacturer, v)
+		case 13:
+			var v MedicinalProductIngredientSpecifiedSubstance
+			d.message(&v)
+			r.SpecifiedSubstance = append(r.SpecifiedSubstance, v)
+		case 14:
+			var v MedicinalProductIngredientSubstance
+			d.message(&v)
+			r.Substance = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.AllergenicIndicatorElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductIngredientSpecifiedSubstance as JSON into a byte slice
+func (r MedicinalProductIngredientSpecifiedSubstance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductIngredientSpecifiedSubstance as JSON object with the members in the order of the fields
+func (r MedicinalProductIngredientSpecifiedSubstance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	e.key("group")
+	r.Group.appendJSON(e)
+	if r.Confidentiality != nil {
+		e.key("confidentiality")
+		r.Confidentiality.appendJSON(e)
+	}
+	if len(r.Strength) > 0 {
+		e.key("strength")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Strength {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductIngredientSpecifiedSubstance from JSON. Unknown members are ignored.
+func (r *MedicinalProductIngredientSpecifiedSubstance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductIngredientSpecifiedSubstance
+func (r *MedicinalProductIngredientSpecifiedSubstance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "group":
+			r.Group.decodeJSON(d)
+		case "confidentiality":
+			if d.null() {
+				r.Confidentiality = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Confidentiality = &v
+			}
+		case "strength":
+			a := d.array()
+			r.Strength = nil
+			for a.next() {
+				var v MedicinalProductIngredientSpecifiedSubstanceStrength
+				v.decodeJSON(d)
+				r.Strength = append(r.Strength, v)
+			}
+			if r.Strength == nil && !a.isNull {
+				r.Strength = []MedicinalProductIngredientSpecifiedSubstanceStrength{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductIngredientSpecifiedSubstance which shares no memory with the original
+func (r MedicinalProductIngredientSpecifiedSubstance) DeepCopy() MedicinalProductIngredientSpecifiedSubstance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	out.Group = r.Group.DeepCopy()
+	if r.Confidentiality != nil {
+		v := r.Confidentiality.DeepCopy()
+		out.Confidentiality = &v
+	}
+	if r.Strength != nil {
+		out.Strength = make([]MedicinalProductIngredientSpecifiedSubstanceStrength, len(r.Strength))
+		for i := range r.Strength {
+			out.Strength[i] = r.Strength[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductIngredientSpecifiedSubstance) Equal(other MedicinalProductIngredientSpecifiedSubstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if !r.Group.Equal(other.Group) {
+		return false
+	}
+	if (r.Confidentiality == nil) != (other.Confidentiality == nil) || r.Confidentiality != nil && !r.Confidentiality.Equal(*other.Confidentiality) {
+		return false
+	}
+	if len(r.Strength) != len(other.Strength) {
+		return false
+	}
+	for i := range r.Strength {
+		if !r.Strength[i].Equal(other.Strength[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductIngredientSpecifiedSubstance) EqualsDeep(other MedicinalProductIngredientSpecifiedSubstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if !r.Group.EqualsDeep(other.Group) {
+		return false
+	}
+	if (r.Confidentiality == nil) != (other.Confidentiality == nil) || r.Confidentiality != nil && !r.Confidentiality.EqualsDeep(*other.Confidentiality) {
+		return false
+	}
+	if !equivalentList(len(r.Strength), len(other.Strength), func(i, j int) bool {
+		return r.Strength[i].EqualsDeep(other.Strength[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductIngredientSpecifiedSubstance) EqualsShallow(other MedicinalProductIngredientSpecifiedSubstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductIngredientSpecifiedSubstance as FHIR XML
+func (r MedicinalProductIngredientSpecifiedSubstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "group", r.Group); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "confidentiality", r.Confidentiality); err != nil {
+		return err
+	}
+	for _, v := range r.Strength {
+		if err := encodeXMLElement(e, "strength", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductIngredientSpecifiedSubstance from FHIR XML
+func (r *MedicinalProductIngredientSpecifiedSubstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "group":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Group = v
+			case "confidentiality":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Confidentiality = &v
+			case "strength":
+				var v MedicinalProductIngredientSpecifiedSubstanceStrength
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Strength = append(r.Strength, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductIngredientSpecifiedSubstance as properties to the RDF node n
+func (r MedicinalProductIngredientSpecifiedSubstance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductIngredient.specifiedSubstance.code", -1, r.Code)
+	n.element("MedicinalProductIngredient.specifiedSubstance.group", -1, r.Group)
+	n.element("MedicinalProductIngredient.specifiedSubstance.confidentiality", -1, r.Confidentiality)
+	for i, v := range r.Strength {
+		n.element("MedicinalProductIngredient.specifiedSubstance.strength", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductIngredientSpecifiedSubstance as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductIngredientSpecifiedSubstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductIngredientSpecifiedSubstance from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductIngredientSpecifiedSubstance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductIngredientSpecifiedSubstance as protobuf message MedicinalProductIngredientSpecifiedSubstance of fhir.proto
+func (r MedicinalProductIngredientSpecifiedSubstance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductIngredientSpecifiedSubstance to the encoded message
+func (r MedicinalProductIngredientSpecifiedSubstance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	e.message(5, r.Group)
+	if r.Confidentiality != nil {
+		e.message(6, *r.Confidentiality)
+	}
+	for _, v := range r.Strength {
+		e.message(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductIngredientSpecifiedSubstance from protobuf message MedicinalProductIngredientSpecifiedSubstance of fhir.proto
+func (r *MedicinalProductIngredientSpecifiedSubstance) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductIngredientSpecifiedSubstance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Group = v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Confidentiality = &v
+		case 7:
+			var v MedicinalProductIngredientSpecifiedSubstanceStrength
+			d.message(&v)
+			r.Strength = append(r.Strength, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductIngredientSpecifiedSubstanceStrength as JSON into a byte slice
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductIngredientSpecifiedSubstanceStrength as JSON object with the members in the order of the fields
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("presentation")
+	r.Presentation.appendJSON(e)
+	if r.PresentationLowLimit != nil {
+		e.key("presentationLowLimit")
+		r.PresentationLowLimit.appendJSON(e)
+	}
+	if r.Concentration != nil {
+		e.key("concentration")
+		r.Concentration.appendJSON(e)
+	}
+	if r.ConcentrationLowLimit != nil {
+		e.key("concentrationLowLimit")
+		r.ConcentrationLowLimit.appendJSON(e)
+	}
+	if r.MeasurementPoint != nil {
+		e.key("measurementPoint")
+		e.string(*r.MeasurementPoint)
+	}
+	if r.MeasurementPointElement != nil {
+		e.key("_measurementPoint")
+		r.MeasurementPointElement.appendJSON(e)
+	}
+	if len(r.Country) > 0 {
+		e.key("country")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Country {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReferenceStrength) > 0 {
+		e.key("referenceStrength")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReferenceStrength {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductIngredientSpecifiedSubstanceStrength from JSON. Unknown members are ignored.
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrength) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductIngredientSpecifiedSubstanceStrength
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrength) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "presentation":
+			r.Presentation.decodeJSON(d)
+		case "presentationLowLimit":
+			if d.null() {
+				r.PresentationLowLimit = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.PresentationLowLimit = &v
+			}
+		case "concentration":
+			if d.null() {
+				r.Concentration = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.Concentration = &v
+			}
+		case "concentrationLowLimit":
+			if d.null() {
+				r.ConcentrationLowLimit = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.ConcentrationLowLimit = &v
+			}
+		case "measurementPoint":
+			if v, ok := d.string(); ok {
+				r.MeasurementPoint = &v
+			} else {
+				r.MeasurementPoint = nil
+			}
+		case "_measurementPoint":
+			r.MeasurementPointElement = d.element()
+		case "country":
+			a := d.array()
+			r.Country = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Country = append(r.Country, v)
+			}
+			if r.Country == nil && !a.isNull {
+				r.Country = []CodeableConcept{}
+			}
+		case "referenceStrength":
+			a := d.array()
+			r.ReferenceStrength = nil
+			for a.next() {
+				var v MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength
+				v.decodeJSON(d)
+				r.ReferenceStrength = append(r.ReferenceStrength, v)
+			}
+			if r.ReferenceStrength == nil && !a.isNull {
+				r.ReferenceStrength = []MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductIngredientSpecifiedSubstanceStrength which shares no memory with the original
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) DeepCopy() MedicinalProductIngredientSpecifiedSubstanceStrength {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Presentation = r.Presentation.DeepCopy()
+	if r.PresentationLowLimit != nil {
+		v := r.PresentationLowLimit.DeepCopy()
+		out.PresentationLowLimit = &v
+	}
+	if r.Concentration != nil {
+		v := r.Concentration.DeepCopy()
+		out.Concentration = &v
+	}
+	if r.ConcentrationLowLimit != nil {
+		v := r.ConcentrationLowLimit.DeepCopy()
+		out.ConcentrationLowLimit = &v
+	}
+	if r.MeasurementPoint != nil {
+		v := *r.MeasurementPoint
+		out.MeasurementPoint = &v
+	}
+	if r.MeasurementPointElement != nil {
+		v := r.MeasurementPointElement.DeepCopy()
+		out.MeasurementPointElement = &v
+	}
+	if r.Country != nil {
+		out.Country = make([]CodeableConcept, len(r.Country))
+		for i := range r.Country {
+			out.Country[i] = r.Country[i].DeepCopy()
+		}
+	}
+	if r.ReferenceStrength != nil {
+		out.ReferenceStrength = make([]MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength, len(r.ReferenceStrength))
+		for i := range r.ReferenceStrength {
+			out.ReferenceStrength[i] = r.ReferenceStrength[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) Equal(other MedicinalProductIngredientSpecifiedSubstanceStrength) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Presentation.Equal(other.Presentation) {
+		return false
+	}
+	if (r.PresentationLowLimit == nil) != (other.PresentationLowLimit == nil) || r.PresentationLowLimit != nil && !r.PresentationLowLimit.Equal(*other.PresentationLowLimit) {
+		return false
+	}
+	if (r.Concentration == nil) != (other.Concentration == nil) || r.Concentration != nil && !r.Concentration.Equal(*other.Concentration) {
+		return false
+	}
+	if (r.ConcentrationLowLimit == nil) != (other.ConcentrationLowLimit == nil) || r.ConcentrationLowLimit != nil && !r.ConcentrationLowLimit.Equal(*other.ConcentrationLowLimit) {
+		return false
+	}
+	if (r.MeasurementPoint == nil) != (other.MeasurementPoint == nil) || r.MeasurementPoint != nil && *r.MeasurementPoint != *other.MeasurementPoint {
+		return false
+	}
+	if (r.MeasurementPointElement == nil) != (other.MeasurementPointElement == nil) || r.MeasurementPointElement != nil && !r.MeasurementPointElement.Equal(*other.MeasurementPointElement) {
+		return false
+	}
+	if len(r.Country) != len(other.Country) {
+		return false
+	}
+	for i := range r.Country {
+		if !r.Country[i].Equal(other.Country[i]) {
+			return false
+		}
+	}
+	if len(r.ReferenceStrength) != len(other.ReferenceStrength) {
+		return false
+	}
+	for i := range r.ReferenceStrength {
+		if !r.ReferenceStrength[i].Equal(other.ReferenceStrength[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) EqualsDeep(other MedicinalProductIngredientSpecifiedSubstanceStrength) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Presentation.EqualsDeep(other.Presentation) {
+		return false
+	}
+	if (r.PresentationLowLimit == nil) != (other.PresentationLowLimit == nil) || r.PresentationLowLimit != nil && !r.PresentationLowLimit.EqualsDeep(*other.PresentationLowLimit) {
+		return false
+	}
+	if (r.Concentration == nil) != (other.Concentration == nil) || r.Concentration != nil && !r.Concentration.EqualsDeep(*other.Concentration) {
+		return false
+	}
+	if (r.ConcentrationLowLimit == nil) != (other.ConcentrationLowLimit == nil) || r.ConcentrationLowLimit != nil && !r.ConcentrationLowLimit.EqualsDeep(*other.ConcentrationLowLimit) {
+		return false
+	}
+	if (r.MeasurementPoint == nil) != (other.MeasurementPoint == nil) || r.MeasurementPoint != nil && !equivalentString(*r.MeasurementPoint, *other.MeasurementPoint) {
+		return false
+	}
+	if (r.MeasurementPointElement == nil) != (other.MeasurementPointElement == nil) || r.MeasurementPointElement != nil && !r.MeasurementPointElement.EqualsDeep(*other.MeasurementPointElement) {
+		return false
+	}
+	if !equivalentList(len(r.Country), len(other.Country), func(i, j int) bool {
+		return r.Country[i].EqualsDeep(other.Country[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReferenceStrength), len(other.ReferenceStrength), func(i, j int) bool {
+		return r.ReferenceStrength[i].EqualsDeep(other.ReferenceStrength[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) EqualsShallow(other MedicinalProductIngredientSpecifiedSubstanceStrength) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.MeasurementPoint == nil) != (other.MeasurementPoint == nil) || r.MeasurementPoint != nil && !equivalentString(*r.MeasurementPoint, *other.MeasurementPoint) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductIngredientSpecifiedSubstanceStrength as FHIR XML
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "presentation", r.Presentation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "presentationLowLimit", r.PresentationLowLimit); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "concentration", r.Concentration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "concentrationLowLimit", r.ConcentrationLowLimit); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "measurementPoint", r.MeasurementPoint, r.MeasurementPointElement); err != nil {
+		return err
+	}
+	for _, v := range r.Country {
+		if err := encodeXMLElement(e, "country", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReferenceStrength {
+		if err := encodeXMLElement(e, "referenceStrength", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductIngredientSpecifiedSubstanceStrength from FHIR XML
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrength) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "presentation":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Presentation = v
+			case "presentationLowLimit":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PresentationLowLimit = &v
+			case "concentration":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Concentration = &v
+			case "concentrationLowLimit":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ConcentrationLowLimit = &v
+			case "measurementPoint":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MeasurementPoint = &v
+				}
+				r.MeasurementPointElement = element
+			case "country":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Country = append(r.Country, v)
+			case "referenceStrength":
+				var v MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferenceStrength = append(r.ReferenceStrength, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductIngredientSpecifiedSubstanceStrength as properties to the RDF node n
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductIngredient.specifiedSubstance.strength.presentation", -1, r.Presentation)
+	n.element("MedicinalProductIngredient.specifiedSubstance.strength.presentationLowLimit", -1, r.PresentationLowLimit)
+	n.element("MedicinalProductIngredient.specifiedSubstance.strength.concentration", -1, r.Concentration)
+	n.element("MedicinalProductIngredient.specifiedSubstance.strength.concentrationLowLimit", -1, r.ConcentrationLowLimit)
+	n.primitive("MedicinalProductIngredient.specifiedSubstance.strength.measurementPoint", -1, r.MeasurementPoint, r.MeasurementPointElement, "string")
+	for i, v := range r.Country {
+		n.element("MedicinalProductIngredient.specifiedSubstance.strength.country", i, v)
+	}
+	for i, v := range r.ReferenceStrength {
+		n.element("MedicinalProductIngredient.specifiedSubstance.strength.referenceStrength", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductIngredientSpecifiedSubstanceStrength as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductIngredientSpecifiedSubstanceStrength from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrength) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductIngredientSpecifiedSubstanceStrength as protobuf message MedicinalProductIngredientSpecifiedSubstanceStrength of fhir.proto
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductIngredientSpecifiedSubstanceStrength to the encoded message
+func (r MedicinalProductIngredientSpecifiedSubstanceStrength) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Presentation)
+	if r.PresentationLowLimit != nil {
+		e.message(5, *r.PresentationLowLimit)
+	}
+	if r.Concentration != nil {
+		e.message(6, *r.Concentration)
+	}
+	if r.ConcentrationLowLimit != nil {
+		e.message(7, *r.ConcentrationLowLimit)
+	}
+	if r.MeasurementPoint != nil {
+		e.string(8, *r.MeasurementPoint)
+	}
+	for _, v := range r.Country {
+		e.message(9, v)
+	}
+	for _, v := range r.ReferenceStrength {
+		e.message(10, v)
+	}
+	if r.MeasurementPointElement != nil {
+		e.message(11, *r.MeasurementPointElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductIngredientSpecifiedSubstanceStrength from protobuf message MedicinalProductIngredientSpecifiedSubstanceStrength of fhir.proto
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrength) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductIngredientSpecifiedSubstanceStrength{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Ratio
+			d.message(&v)
+			r.Presentation = v
+		case 5:
+			var v Ratio
+			d.message(&v)
+			r.PresentationLowLimit = &v
+		case 6:
+			var v Ratio
+			d.message(&v)
+			r.Concentration = &v
+		case 7:
+			var v Ratio
+			d.message(&v)
+			r.ConcentrationLowLimit = &v
+		case 8:
+			v := d.string()
+			r.MeasurementPoint = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Country = append(r.Country, v)
+		case 10:
+			var v MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength
+			d.message(&v)
+			r.ReferenceStrength = append(r.ReferenceStrength, v)
+		case 11:
+			var v Element
+			d.message(&v)
+			r.MeasurementPointElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength as JSON into a byte slice
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength as JSON object with the members in the order of the fields
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Substance != nil {
+		e.key("substance")
+		r.Substance.appendJSON(e)
+	}
+	e.key("strength")
+	r.Strength.appendJSON(e)
+	if r.StrengthLowLimit != nil {
+		e.key("strengthLowLimit")
+		r.StrengthLowLimit.appendJSON(e)
+	}
+	if r.MeasurementPoint != nil {
+		e.key("measurementPoint")
+		e.string(*r.MeasurementPoint)
+	}
+	if r.MeasurementPointElement != nil {
+		e.key("_measurementPoint")
+		r.MeasurementPointElement.appendJSON(e)
+	}
+	if len(r.Country) > 0 {
+		e.key("country")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Country {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength from JSON. Unknown members are ignored.
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "substance":
+			if d.null() {
+				r.Substance = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Substance = &v
+			}
+		case "strength":
+			r.Strength.decodeJSON(d)
+		case "strengthLowLimit":
+			if d.null() {
+				r.StrengthLowLimit = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.StrengthLowLimit = &v
+			}
+		case "measurementPoint":
+			if v, ok := d.string(); ok {
+				r.MeasurementPoint = &v
+			} else {
+				r.MeasurementPoint = nil
+			}
+		case "_measurementPoint":
+			r.MeasurementPointElement = d.element()
+		case "country":
+			a := d.array()
+			r.Country = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Country = append(r.Country, v)
+			}
+			if r.Country == nil && !a.isNull {
+				r.Country = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength which shares no memory with the original
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) DeepCopy() MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Substance != nil {
+		v := r.Substance.DeepCopy()
+		out.Substance = &v
+	}
+	out.Strength = r.Strength.DeepCopy()
+	if r.StrengthLowLimit != nil {
+		v := r.StrengthLowLimit.DeepCopy()
+		out.StrengthLowLimit = &v
+	}
+	if r.MeasurementPoint != nil {
+		v := *r.MeasurementPoint
+		out.MeasurementPoint = &v
+	}
+	if r.MeasurementPointElement != nil {
+		v := r.MeasurementPointElement.DeepCopy()
+		out.MeasurementPointElement = &v
+	}
+	if r.Country != nil {
+		out.Country = make([]CodeableConcept, len(r.Country))
+		for i := range r.Country {
+			out.Country[i] = r.Country[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) Equal(other MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Substance == nil) != (other.Substance == nil) || r.Substance != nil && !r.Substance.Equal(*other.Substance) {
+		return false
+	}
+	if !r.Strength.Equal(other.Strength) {
+		return false
+	}
+	if (r.StrengthLowLimit == nil) != (other.StrengthLowLimit == nil) || r.StrengthLowLimit != nil && !r.StrengthLowLimit.Equal(*other.StrengthLowLimit) {
+		return false
+	}
+	if (r.MeasurementPoint == nil) != (other.MeasurementPoint == nil) || r.MeasurementPoint != nil && *r.MeasurementPoint != *other.MeasurementPoint {
+		return false
+	}
+	if (r.MeasurementPointElement == nil) != (other.MeasurementPointElement == nil) || r.MeasurementPointElement != nil && !r.MeasurementPointElement.Equal(*other.MeasurementPointElement) {
+		return false
+	}
+	if len(r.Country) != len(other.Country) {
+		return false
+	}
+	for i := range r.Country {
+		if !r.Country[i].Equal(other.Country[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) EqualsDeep(other MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Substance == nil) != (other.Substance == nil) || r.Substance != nil && !r.Substance.EqualsDeep(*other.Substance) {
+		return false
+	}
+	if !r.Strength.EqualsDeep(other.Strength) {
+		return false
+	}
+	if (r.StrengthLowLimit == nil) != (other.StrengthLowLimit == nil) || r.StrengthLowLimit != nil && !r.StrengthLowLimit.EqualsDeep(*other.StrengthLowLimit) {
+		return false
+	}
+	if (r.MeasurementPoint == nil) != (other.MeasurementPoint == nil) || r.MeasurementPoint != nil && !equivalentString(*r.MeasurementPoint, *other.MeasurementPoint) {
+		return false
+	}
+	if (r.MeasurementPointElement == nil) != (other.MeasurementPointElement == nil) || r.MeasurementPointElement != nil && !r.MeasurementPointElement.EqualsDeep(*other.MeasurementPointElement) {
+		return false
+	}
+	if !equivalentList(len(r.Country), len(other.Country), func(i, j int) bool {
+		return r.Country[i].EqualsDeep(other.Country[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) EqualsShallow(other MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.MeasurementPoint == nil) != (other.MeasurementPoint == nil) || r.MeasurementPoint != nil && !equivalentString(*r.MeasurementPoint, *other.MeasurementPoint) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength as FHIR XML
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "substance", r.Substance); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "strength", r.Strength); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "strengthLowLimit", r.StrengthLowLimit); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "measurementPoint", r.MeasurementPoint, r.MeasurementPointElement); err != nil {
+		return err
+	}
+	for _, v := range r.Country {
+		if err := encodeXMLElement(e, "country", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength from FHIR XML
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "substance":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Substance = &v
+			case "strength":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Strength = v
+			case "strengthLowLimit":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StrengthLowLimit = &v
+			case "measurementPoint":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MeasurementPoint = &v
+				}
+				r.MeasurementPointElement = element
+			case "country":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Country = append(r.Country, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength as properties to the RDF node n
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductIngredient.specifiedSubstance.strength.referenceStrength.substance", -1, r.Substance)
+	n.element("MedicinalProductIngredient.specifiedSubstance.strength.referenceStrength.strength", -1, r.Strength)
+	n.element("MedicinalProductIngredient.specifiedSubstance.strength.referenceStrength.strengthLowLimit", -1, r.StrengthLowLimit)
+	n.primitive("MedicinalProductIngredient.specifiedSubstance.strength.referenceStrength.measurementPoint", -1, r.MeasurementPoint, r.MeasurementPointElement, "string")
+	for i, v := range r.Country {
+		n.element("MedicinalProductIngredient.specifiedSubstance.strength.referenceStrength.country", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength as protobuf message MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength of fhir.proto
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength to the encoded message
+func (r MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Substance != nil {
+		e.message(4, *r.Substance)
+	}
+	e.message(5, r.Strength)
+	if r.StrengthLowLimit != nil {
+		e.message(6, *r.StrengthLowLimit)
+	}
+	if r.MeasurementPoint != nil {
+		e.string(7, *r.MeasurementPoint)
+	}
+	for _, v := range r.Country {
+		e.message(8, v)
+	}
+	if r.MeasurementPointElement != nil {
+		e.message(9, *r.MeasurementPointElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength from protobuf message MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength of fhir.proto
+func (r *MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Substance = &v
+		case 5:
+			var v Ratio
+			d.message(&v)
+			r.Strength = v
+		case 6:
+			var v Ratio
+			d.message(&v)
+			r.StrengthLowLimit = &v
+		case 7:
+			v := d.string()
+			r.MeasurementPoint = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Country = append(r.Country, v)
+		case 9:
+			var v Element
+			d.message(&v)
+			r.MeasurementPointElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductIngredientSubstance as JSON into a byte slice
+func (r MedicinalProductIngredientSubstance) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductIngredientSubstance as JSON object with the members in the order of the fields
+func (r MedicinalProductIngredientSubstance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if len(r.Strength) > 0 {
+		e.key("strength")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Strength {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductIngredientSubstance from JSON. Unknown members are ignored.
+func (r *MedicinalProductIngredientSubstance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductIngredientSubstance
+func (r *MedicinalProductIngredientSubstance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "strength":
+			a := d.array()
+			r.Strength = nil
+			for a.next() {
+				var v MedicinalProductIngredientSpecifiedSubstanceStrength
+				v.decodeJSON(d)
+				r.Strength = append(r.Strength, v)
+			}
+			if r.Strength == nil && !a.isNull {
+				r.Strength = []MedicinalProductIngredientSpecifiedSubstanceStrength{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductIngredientSubstance which shares no memory with the original
+func (r MedicinalProductIngredientSubstance) DeepCopy() MedicinalProductIngredientSubstance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.Strength != nil {
+		out.Strength = make([]MedicinalProductIngredientSpecifiedSubstanceStrength, len(r.Strength))
+		for i := range r.Strength {
+			out.Strength[i] = r.Strength[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductIngredientSubstance) Equal(other MedicinalProductIngredientSubstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if len(r.Strength) != len(other.Strength) {
+		return false
+	}
+	for i := range r.Strength {
+		if !r.Strength[i].Equal(other.Strength[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductIngredientSubstance) EqualsDeep(other MedicinalProductIngredientSubstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.Strength), len(other.Strength), func(i, j int) bool {
+		return r.Strength[i].EqualsDeep(other.Strength[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductIngredientSubstance) EqualsShallow(other MedicinalProductIngredientSubstance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductIngredientSubstance as FHIR XML
+func (r MedicinalProductIngredientSubstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.Strength {
+		if err := encodeXMLElement(e, "strength", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductIngredientSubstance from FHIR XML
+func (r *MedicinalProductIngredientSubstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "strength":
+				var v MedicinalProductIngredientSpecifiedSubstanceStrength
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Strength = append(r.Strength, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductIngredientSubstance as properties to the RDF node n
+func (r MedicinalProductIngredientSubstance) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductIngredient.substance.code", -1, r.Code)
+	for i, v := range r.Strength {
+		n.element("MedicinalProductIngredient.substance.strength", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductIngredientSubstance as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductIngredientSubstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductIngredientSubstance from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductIngredientSubstance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductIngredientSubstance as protobuf message MedicinalProductIngredientSubstance of fhir.proto
+func (r MedicinalProductIngredientSubstance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductIngredientSubstance to the encoded message
+func (r MedicinalProductIngredientSubstance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	for _, v := range r.Strength {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductIngredientSubstance from protobuf message MedicinalProductIngredientSubstance of fhir.proto
+func (r *MedicinalProductIngredientSubstance) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductIngredientSubstance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v MedicinalProductIngredientSpecifiedSubstanceStrength
+			d.message(&v)
+			r.Strength = append(r.Strength, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicinalProductIngredient unmarshals a MedicinalProductIngredient.
 func UnmarshalMedicinalProductIngredient(b []byte) (MedicinalProductIngredient, error) {
 	var medicinalProductIngredient MedicinalProductIngredient
-	if err := json.Unmarshal(b, &medicinalProductIngredient); err != nil {
+	if err := medicinalProductIngredient.UnmarshalJSON(b); err != nil {
 		return medicinalProductIngredient, err
 	}
 	return medicinalProductIngredient, nil
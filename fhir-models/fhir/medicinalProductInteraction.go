@@ -14,52 +14,1368 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MedicinalProductInteraction is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductInteraction
 type MedicinalProductInteraction struct {
-	Id                *string                                  `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                    `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                  `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                               `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Subject           []Reference                              `bson:"subject,omitempty" json:"subject,omitempty"`
-	Description       *string                                  `bson:"description,omitempty" json:"description,omitempty"`
-	Interactant       []MedicinalProductInteractionInteractant `bson:"interactant,omitempty" json:"interactant,omitempty"`
-	Type              *CodeableConcept                         `bson:"type,omitempty" json:"type,omitempty"`
-	Effect            *CodeableConcept                         `bson:"effect,omitempty" json:"effect,omitempty"`
-	Incidence         *CodeableConcept                         `bson:"incidence,omitempty" json:"incidence,omitempty"`
-	Management        *CodeableConcept                         `bson:"management,omitempty" json:"management,omitempty"`
+	Id                   *string                                  `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                                 `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                                    `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                 `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                  `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                 `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                               `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage                        `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Subject              []Reference                              `bson:"subject,omitempty" json:"subject,omitempty"`
+	Description          *string                                  `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                                 `bson:"_description,omitempty" json:"_description,omitempty"`
+	Interactant          []MedicinalProductInteractionInteractant `bson:"interactant,omitempty" json:"interactant,omitempty"`
+	Type                 *CodeableConcept                         `bson:"type,omitempty" json:"type,omitempty"`
+	Effect               *CodeableConcept                         `bson:"effect,omitempty" json:"effect,omitempty"`
+	Incidence            *CodeableConcept                         `bson:"incidence,omitempty" json:"incidence,omitempty"`
+	Management           *CodeableConcept                         `bson:"management,omitempty" json:"management,omitempty"`
 }
 type MedicinalProductInteractionInteractant struct {
-	Id                  *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ItemReference       Reference       `bson:"itemReference" json:"itemReference"`
-	ItemCodeableConcept CodeableConcept `bson:"itemCodeableConcept" json:"itemCodeableConcept"`
+	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ItemReference       *Reference       `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
+	ItemCodeableConcept *CodeableConcept `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
+}
+
+// MedicinalProductInteractionInteractantItem is implemented by the types allowed for MedicinalProductInteraction.interactant.item[x]
+type MedicinalProductInteractionInteractantItem interface {
+	isMedicinalProductInteractionInteractantItem()
+}
+
+func (Reference) isMedicinalProductInteractionInteractantItem()       {}
+func (CodeableConcept) isMedicinalProductInteractionInteractantItem() {}
+
+// Item returns the value of MedicinalProductInteraction.interactant.item[x] together with its FHIR type code or nil if no value is set
+func (r MedicinalProductInteractionInteractant) Item() (MedicinalProductInteractionInteractantItem, string) {
+	if r.ItemReference != nil {
+		return *r.ItemReference, "Reference"
+	}
+	if r.ItemCodeableConcept != nil {
+		return *r.ItemCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetItem sets the value of MedicinalProductInteraction.interactant.item[x], given as value or pointer, and clears all other types
+func (r *MedicinalProductInteractionInteractant) SetItem(value MedicinalProductInteractionInteractantItem) {
+	r.ItemReference = nil
+	r.ItemCodeableConcept = nil
+	switch v := value.(type) {
+	case Reference:
+		r.ItemReference = &v
+	case *Reference:
+		r.ItemReference = v
+	case CodeableConcept:
+		r.ItemCodeableConcept = &v
+	case *CodeableConcept:
+		r.ItemCodeableConcept = v
+	}
 }
-type OtherMedicinalProductInteraction MedicinalProductInteraction
 
 // MarshalJSON marshals the given MedicinalProductInteraction as JSON into a byte slice
 func (r MedicinalProductInteraction) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicinalProductInteraction
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicinalProductInteraction: OtherMedicinalProductInteraction(r),
-		ResourceType:                     "MedicinalProductInteraction",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductInteraction as JSON object with the members in the order of the fields
+func (r MedicinalProductInteraction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicinalProductInteraction\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Subject) > 0 {
+		e.key("subject")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subject {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Interactant) > 0 {
+		e.key("interactant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Interactant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Effect != nil {
+		e.key("effect")
+		r.Effect.appendJSON(e)
+	}
+	if r.Incidence != nil {
+		e.key("incidence")
+		r.Incidence.appendJSON(e)
+	}
+	if r.Management != nil {
+		e.key("management")
+		r.Management.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductInteraction from JSON. Unknown members are ignored.
+func (r *MedicinalProductInteraction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductInteraction
+func (r *MedicinalProductInteraction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "subject":
+			a := d.array()
+			r.Subject = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = append(r.Subject, v)
+			}
+			if r.Subject == nil && !a.isNull {
+				r.Subject = []Reference{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "interactant":
+			a := d.array()
+			r.Interactant = nil
+			for a.next() {
+				var v MedicinalProductInteractionInteractant
+				v.decodeJSON(d)
+				r.Interactant = append(r.Interactant, v)
+			}
+			if r.Interactant == nil && !a.isNull {
+				r.Interactant = []MedicinalProductInteractionInteractant{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "effect":
+			if d.null() {
+				r.Effect = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Effect = &v
+			}
+		case "incidence":
+			if d.null() {
+				r.Incidence = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Incidence = &v
+			}
+		case "management":
+			if d.null() {
+				r.Management = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Management = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductInteraction which shares no memory with the original
+func (r MedicinalProductInteraction) DeepCopy() MedicinalProductInteraction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Subject != nil {
+		out.Subject = make([]Reference, len(r.Subject))
+		for i := range r.Subject {
+			out.Subject[i] = r.Subject[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Interactant != nil {
+		out.Interactant = make([]MedicinalProductInteractionInteractant, len(r.Interactant))
+		for i := range r.Interactant {
+			out.Interactant[i] = r.Interactant[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Effect != nil {
+		v := r.Effect.DeepCopy()
+		out.Effect = &v
+	}
+	if r.Incidence != nil {
+		v := r.Incidence.DeepCopy()
+		out.Incidence = &v
+	}
+	if r.Management != nil {
+		v := r.Management.DeepCopy()
+		out.Management = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductInteraction) Equal(other MedicinalProductInteraction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Subject) != len(other.Subject) {
+		return false
+	}
+	for i := range r.Subject {
+		if !r.Subject[i].Equal(other.Subject[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Interactant) != len(other.Interactant) {
+		return false
+	}
+	for i := range r.Interactant {
+		if !r.Interactant[i].Equal(other.Interactant[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Effect == nil) != (other.Effect == nil) || r.Effect != nil && !r.Effect.Equal(*other.Effect) {
+		return false
+	}
+	if (r.Incidence == nil) != (other.Incidence == nil) || r.Incidence != nil && !r.Incidence.Equal(*other.Incidence) {
+		return false
+	}
+	if (r.Management == nil) != (other.Management == nil) || r.Management != nil && !r.Management.Equal(*other.Management) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductInteraction) EqualsDeep(other MedicinalProductInteraction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Subject), len(other.Subject), func(i, j int) bool {
+		return r.Subject[i].EqualsDeep(other.Subject[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Interactant), len(other.Interactant), func(i, j int) bool {
+		return r.Interactant[i].EqualsDeep(other.Interactant[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Effect == nil) != (other.Effect == nil) || r.Effect != nil && !r.Effect.EqualsDeep(*other.Effect) {
+		return false
+	}
+	if (r.Incidence == nil) != (other.Incidence == nil) || r.Incidence != nil && !r.Incidence.EqualsDeep(*other.Incidence) {
+		return false
+	}
+	if (r.Management == nil) != (other.Management == nil) || r.Management != nil && !r.Management.EqualsDeep(*other.Management) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductInteraction) EqualsShallow(other MedicinalProductInteraction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductInteraction as FHIR XML
+func (r MedicinalProductInteraction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicinalProductInteraction",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Subject {
+		if err := encodeXMLElement(e, "subject", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Interactant {
+		if err := encodeXMLElement(e, "interactant", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effect", r.Effect); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "incidence", r.Incidence); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "management", r.Management); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductInteraction from FHIR XML
+func (r *MedicinalProductInteraction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = append(r.Subject, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "interactant":
+				var v MedicinalProductInteractionInteractant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Interactant = append(r.Interactant, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "effect":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Effect = &v
+			case "incidence":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Incidence = &v
+			case "management":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Management = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductInteraction as properties to the RDF node n
+func (r MedicinalProductInteraction) turtle(n *turtleNode) {
+	n.resource("MedicinalProductInteraction", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Subject {
+		n.element("MedicinalProductInteraction.subject", i, v)
+	}
+	n.primitive("MedicinalProductInteraction.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Interactant {
+		n.element("MedicinalProductInteraction.interactant", i, v)
+	}
+	n.element("MedicinalProductInteraction.type", -1, r.Type)
+	n.element("MedicinalProductInteraction.effect", -1, r.Effect)
+	n.element("MedicinalProductInteraction.incidence", -1, r.Incidence)
+	n.element("MedicinalProductInteraction.management", -1, r.Management)
+}
+
+// MarshalBSON marshals the given MedicinalProductInteraction as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductInteraction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductInteraction from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductInteraction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductInteraction as protobuf message MedicinalProductInteraction of fhir.proto
+func (r MedicinalProductInteraction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductInteraction to the encoded message
+func (r MedicinalProductInteraction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Subject {
+		e.message(9, v)
+	}
+	if r.Description != nil {
+		e.string(10, *r.Description)
+	}
+	for _, v := range r.Interactant {
+		e.message(11, v)
+	}
+	if r.Type != nil {
+		e.message(12, *r.Type)
+	}
+	if r.Effect != nil {
+		e.message(13, *r.Effect)
+	}
+	if r.Incidence != nil {
+		e.message(14, *r.Incidence)
+	}
+	if r.Management != nil {
+		e.message(15, *r.Management)
+	}
+	if r.IdElement != nil {
+		e.message(16, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(17, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(18, *r.LanguageElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(19, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductInteraction from protobuf message MedicinalProductInteraction of fhir.proto
+func (r *MedicinalProductInteraction) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductInteraction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Subject = append(r.Subject, v)
+		case 10:
+			v := d.string()
+			r.Description = &v
+		case 11:
+			var v MedicinalProductInteractionInteractant
+			d.message(&v)
+			r.Interactant = append(r.Interactant, v)
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Effect = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Incidence = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.Management = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductInteractionInteractant as JSON into a byte slice
+func (r MedicinalProductInteractionInteractant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductInteractionInteractant as JSON object with the members in the order of the fields
+func (r MedicinalProductInteractionInteractant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ItemReference != nil {
+		e.key("itemReference")
+		r.ItemReference.appendJSON(e)
+	}
+	if r.ItemCodeableConcept != nil {
+		e.key("itemCodeableConcept")
+		r.ItemCodeableConcept.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductInteractionInteractant from JSON. Unknown members are ignored.
+func (r *MedicinalProductInteractionInteractant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductInteractionInteractant
+func (r *MedicinalProductInteractionInteractant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "itemReference":
+			if d.null() {
+				r.ItemReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ItemReference = &v
+			}
+		case "itemCodeableConcept":
+			if d.null() {
+				r.ItemCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ItemCodeableConcept = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductInteractionInteractant which shares no memory with the original
+func (r MedicinalProductInteractionInteractant) DeepCopy() MedicinalProductInteractionInteractant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ItemReference != nil {
+		v := r.ItemReference.DeepCopy()
+		out.ItemReference = &v
+	}
+	if r.ItemCodeableConcept != nil {
+		v := r.ItemCodeableConcept.DeepCopy()
+		out.ItemCodeableConcept = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductInteractionInteractant) Equal(other MedicinalProductInteractionInteractant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.Equal(*other.ItemReference) {
+		return false
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.Equal(*other.ItemCodeableConcept) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductInteractionInteractant) EqualsDeep(other MedicinalProductInteractionInteractant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.EqualsDeep(*other.ItemReference) {
+		return false
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.EqualsDeep(*other.ItemCodeableConcept) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductInteractionInteractant) EqualsShallow(other MedicinalProductInteractionInteractant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductInteractionInteractant as FHIR XML
+func (r MedicinalProductInteractionInteractant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "itemReference", r.ItemReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "itemCodeableConcept", r.ItemCodeableConcept); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductInteractionInteractant from FHIR XML
+func (r *MedicinalProductInteractionInteractant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "itemReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemReference = &v
+			case "itemCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemCodeableConcept = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductInteractionInteractant as properties to the RDF node n
+func (r MedicinalProductInteractionInteractant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductInteraction.interactant.itemReference", -1, r.ItemReference)
+	n.element("MedicinalProductInteraction.interactant.itemCodeableConcept", -1, r.ItemCodeableConcept)
+}
+
+// MarshalBSON marshals the given MedicinalProductInteractionInteractant as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductInteractionInteractant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductInteractionInteractant from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductInteractionInteractant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductInteractionInteractant as protobuf message MedicinalProductInteractionInteractant of fhir.proto
+func (r MedicinalProductInteractionInteractant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductInteractionInteractant to the encoded message
+func (r MedicinalProductInteractionInteractant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ItemReference != nil {
+		e.message(4, *r.ItemReference)
+	}
+	if r.ItemCodeableConcept != nil {
+		e.message(5, *r.ItemCodeableConcept)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductInteractionInteractant from protobuf message MedicinalProductInteractionInteractant of fhir.proto
+func (r *MedicinalProductInteractionInteractant) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductInteractionInteractant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.ItemReference = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.ItemCodeableConcept = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicinalProductInteraction unmarshals a MedicinalProductInteraction.
 func UnmarshalMedicinalProductInteraction(b []byte) (MedicinalProductInteraction, error) {
 	var medicinalProductInteraction MedicinalProductInteraction
-	if err := json.Unmarshal(b, &medicinalProductInteraction); err != nil {
+	if err := medicinalProductInteraction.UnmarshalJSON(b); err != nil {
 		return medicinalProductInteraction, err
 	}
 	return medicinalProductInteraction, nil
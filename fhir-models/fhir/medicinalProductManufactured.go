@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,10 +25,14 @@ import "encoding/json"
 // MedicinalProductManufactured is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductManufactured
 type MedicinalProductManufactured struct {
 	Id                      *string             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement               *Element            `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                    *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules           *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                *string             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element            `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                    *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               []json.RawMessage   `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension               []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension       []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	ManufacturedDoseForm    CodeableConcept     `bson:"manufacturedDoseForm" json:"manufacturedDoseForm"`
@@ -36,23 +43,901 @@ type MedicinalProductManufactured struct {
 	PhysicalCharacteristics *ProdCharacteristic `bson:"physicalCharacteristics,omitempty" json:"physicalCharacteristics,omitempty"`
 	OtherCharacteristics    []CodeableConcept   `bson:"otherCharacteristics,omitempty" json:"otherCharacteristics,omitempty"`
 }
-type OtherMedicinalProductManufactured MedicinalProductManufactured
 
 // MarshalJSON marshals the given MedicinalProductManufactured as JSON into a byte slice
 func (r MedicinalProductManufactured) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicinalProductManufactured
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicinalProductManufactured: OtherMedicinalProductManufactured(r),
-		ResourceType:                      "MedicinalProductManufactured",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductManufactured as JSON object with the members in the order of the fields
+func (r MedicinalProductManufactured) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicinalProductManufactured\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("manufacturedDoseForm")
+	r.ManufacturedDoseForm.appendJSON(e)
+	if r.UnitOfPresentation != nil {
+		e.key("unitOfPresentation")
+		r.UnitOfPresentation.appendJSON(e)
+	}
+	e.key("quantity")
+	r.Quantity.appendJSON(e)
+	if len(r.Manufacturer) > 0 {
+		e.key("manufacturer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Manufacturer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Ingredient) > 0 {
+		e.key("ingredient")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Ingredient {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PhysicalCharacteristics != nil {
+		e.key("physicalCharacteristics")
+		r.PhysicalCharacteristics.appendJSON(e)
+	}
+	if len(r.OtherCharacteristics) > 0 {
+		e.key("otherCharacteristics")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.OtherCharacteristics {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductManufactured from JSON. Unknown members are ignored.
+func (r *MedicinalProductManufactured) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductManufactured
+func (r *MedicinalProductManufactured) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "manufacturedDoseForm":
+			r.ManufacturedDoseForm.decodeJSON(d)
+		case "unitOfPresentation":
+			if d.null() {
+				r.UnitOfPresentation = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.UnitOfPresentation = &v
+			}
+		case "quantity":
+			r.Quantity.decodeJSON(d)
+		case "manufacturer":
+			a := d.array()
+			r.Manufacturer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Manufacturer = append(r.Manufacturer, v)
+			}
+			if r.Manufacturer == nil && !a.isNull {
+				r.Manufacturer = []Reference{}
+			}
+		case "ingredient":
+			a := d.array()
+			r.Ingredient = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Ingredient = append(r.Ingredient, v)
+			}
+			if r.Ingredient == nil && !a.isNull {
+				r.Ingredient = []Reference{}
+			}
+		case "physicalCharacteristics":
+			if d.null() {
+				r.PhysicalCharacteristics = nil
+			} else {
+				var v ProdCharacteristic
+				v.decodeJSON(d)
+				r.PhysicalCharacteristics = &v
+			}
+		case "otherCharacteristics":
+			a := d.array()
+			r.OtherCharacteristics = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OtherCharacteristics = append(r.OtherCharacteristics, v)
+			}
+			if r.OtherCharacteristics == nil && !a.isNull {
+				r.OtherCharacteristics = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductManufactured which shares no memory with the original
+func (r MedicinalProductManufactured) DeepCopy() MedicinalProductManufactured {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.ManufacturedDoseForm = r.ManufacturedDoseForm.DeepCopy()
+	if r.UnitOfPresentation != nil {
+		v := r.UnitOfPresentation.DeepCopy()
+		out.UnitOfPresentation = &v
+	}
+	out.Quantity = r.Quantity.DeepCopy()
+	if r.Manufacturer != nil {
+		out.Manufacturer = make([]Reference, len(r.Manufacturer))
+		for i := range r.Manufacturer {
+			out.Manufacturer[i] = r.Manufacturer[i].DeepCopy()
+		}
+	}
+	if r.Ingredient != nil {
+		out.Ingredient = make([]Reference, len(r.Ingredient))
+		for i := range r.Ingredient {
+			out.Ingredient[i] = r.Ingredient[i].DeepCopy()
+		}
+	}
+	if r.PhysicalCharacteristics != nil {
+		v := r.PhysicalCharacteristics.DeepCopy()
+		out.PhysicalCharacteristics = &v
+	}
+	if r.OtherCharacteristics != nil {
+		out.OtherCharacteristics = make([]CodeableConcept, len(r.OtherCharacteristics))
+		for i := range r.OtherCharacteristics {
+			out.OtherCharacteristics[i] = r.OtherCharacteristics[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductManufactured) Equal(other MedicinalProductManufactured) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.ManufacturedDoseForm.Equal(other.ManufacturedDoseForm) {
+		return false
+	}
+	if (r.UnitOfPresentation == nil) != (other.UnitOfPresentation == nil) || r.UnitOfPresentation != nil && !r.UnitOfPresentation.Equal(*other.UnitOfPresentation) {
+		return false
+	}
+	if !r.Quantity.Equal(other.Quantity) {
+		return false
+	}
+	if len(r.Manufacturer) != len(other.Manufacturer) {
+		return false
+	}
+	for i := range r.Manufacturer {
+		if !r.Manufacturer[i].Equal(other.Manufacturer[i]) {
+			return false
+		}
+	}
+	if len(r.Ingredient) != len(other.Ingredient) {
+		return false
+	}
+	for i := range r.Ingredient {
+		if !r.Ingredient[i].Equal(other.Ingredient[i]) {
+			return false
+		}
+	}
+	if (r.PhysicalCharacteristics == nil) != (other.PhysicalCharacteristics == nil) || r.PhysicalCharacteristics != nil && !r.PhysicalCharacteristics.Equal(*other.PhysicalCharacteristics) {
+		return false
+	}
+	if len(r.OtherCharacteristics) != len(other.OtherCharacteristics) {
+		return false
+	}
+	for i := range r.OtherCharacteristics {
+		if !r.OtherCharacteristics[i].Equal(other.OtherCharacteristics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductManufactured) EqualsDeep(other MedicinalProductManufactured) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.ManufacturedDoseForm.EqualsDeep(other.ManufacturedDoseForm) {
+		return false
+	}
+	if (r.UnitOfPresentation == nil) != (other.UnitOfPresentation == nil) || r.UnitOfPresentation != nil && !r.UnitOfPresentation.EqualsDeep(*other.UnitOfPresentation) {
+		return false
+	}
+	if !r.Quantity.EqualsDeep(other.Quantity) {
+		return false
+	}
+	if !equivalentList(len(r.Manufacturer), len(other.Manufacturer), func(i, j int) bool {
+		return r.Manufacturer[i].EqualsDeep(other.Manufacturer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Ingredient), len(other.Ingredient), func(i, j int) bool {
+		return r.Ingredient[i].EqualsDeep(other.Ingredient[j])
+	}) {
+		return false
+	}
+	if (r.PhysicalCharacteristics == nil) != (other.PhysicalCharacteristics == nil) || r.PhysicalCharacteristics != nil && !r.PhysicalCharacteristics.EqualsDeep(*other.PhysicalCharacteristics) {
+		return false
+	}
+	if !equivalentList(len(r.OtherCharacteristics), len(other.OtherCharacteristics), func(i, j int) bool {
+		return r.OtherCharacteristics[i].EqualsDeep(other.OtherCharacteristics[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductManufactured) EqualsShallow(other MedicinalProductManufactured) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductManufactured as FHIR XML
+func (r MedicinalProductManufactured) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicinalProductManufactured",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "manufacturedDoseForm", r.ManufacturedDoseForm); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitOfPresentation", r.UnitOfPresentation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	for _, v := range r.Manufacturer {
+		if err := encodeXMLElement(e, "manufacturer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Ingredient {
+		if err := encodeXMLElement(e, "ingredient", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "physicalCharacteristics", r.PhysicalCharacteristics); err != nil {
+		return err
+	}
+	for _, v := range r.OtherCharacteristics {
+		if err := encodeXMLElement(e, "otherCharacteristics", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductManufactured from FHIR XML
+func (r *MedicinalProductManufactured) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "manufacturedDoseForm":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManufacturedDoseForm = v
+			case "unitOfPresentation":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitOfPresentation = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = v
+			case "manufacturer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manufacturer = append(r.Manufacturer, v)
+			case "ingredient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Ingredient = append(r.Ingredient, v)
+			case "physicalCharacteristics":
+				var v ProdCharacteristic
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PhysicalCharacteristics = &v
+			case "otherCharacteristics":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OtherCharacteristics = append(r.OtherCharacteristics, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductManufactured as properties to the RDF node n
+func (r MedicinalProductManufactured) turtle(n *turtleNode) {
+	n.resource("MedicinalProductManufactured", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductManufactured.manufacturedDoseForm", -1, r.ManufacturedDoseForm)
+	n.element("MedicinalProductManufactured.unitOfPresentation", -1, r.UnitOfPresentation)
+	n.element("MedicinalProductManufactured.quantity", -1, r.Quantity)
+	for i, v := range r.Manufacturer {
+		n.element("MedicinalProductManufactured.manufacturer", i, v)
+	}
+	for i, v := range r.Ingredient {
+		n.element("MedicinalProductManufactured.ingredient", i, v)
+	}
+	n.element("MedicinalProductManufactured.physicalCharacteristics", -1, r.PhysicalCharacteristics)
+	for i, v := range r.OtherCharacteristics {
+		n.element("MedicinalProductManufactured.otherCharacteristics", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductManufactured as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductManufactured) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductManufactured from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductManufactured) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductManufactured as protobuf message MedicinalProductManufactured of fhir.proto
+func (r MedicinalProductManufactured) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductManufactured to the encoded message
+func (r MedicinalProductManufactured) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	e.message(9, r.ManufacturedDoseForm)
+	if r.UnitOfPresentation != nil {
+		e.message(10, *r.UnitOfPresentation)
+	}
+	e.message(11, r.Quantity)
+	for _, v := range r.Manufacturer {
+		e.message(12, v)
+	}
+	for _, v := range r.Ingredient {
+		e.message(13, v)
+	}
+	if r.PhysicalCharacteristics != nil {
+		e.message(14, *r.PhysicalCharacteristics)
+	}
+	for _, v := range r.OtherCharacteristics {
+		e.message(15, v)
+	}
+	if r.IdElement != nil {
+		e.message(16, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(17, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(18, *r.LanguageElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductManufactured from protobuf message MedicinalProductManufactured of fhir.proto
+func (r *MedicinalProductManufactured) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductManufactured{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.ManufacturedDoseForm = v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.UnitOfPresentation = &v
+		case 11:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Manufacturer = append(r.Manufacturer, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Ingredient = append(r.Ingredient, v)
+		case 14:
+			var v ProdCharacteristic
+			d.message(&v)
+			r.PhysicalCharacteristics = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.OtherCharacteristics = append(r.OtherCharacteristics, v)
+		case 16:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicinalProductManufactured unmarshals a MedicinalProductManufactured.
 func UnmarshalMedicinalProductManufactured(b []byte) (MedicinalProductManufactured, error) {
 	var medicinalProductManufactured MedicinalProductManufactured
-	if err := json.Unmarshal(b, &medicinalProductManufactured); err != nil {
+	if err := medicinalProductManufactured.UnmarshalJSON(b); err != nil {
 		return medicinalProductManufactured, err
 	}
 	return medicinalProductManufactured, nil
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,15 +25,20 @@ import "encoding/json"
 // MedicinalProductPackaged is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductPackaged
 type MedicinalProductPackaged struct {
 	Id                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element                                  `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                   *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules          *string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language               *string                                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                                  `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                   *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage                         `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier             []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Subject                []Reference                               `bson:"subject,omitempty" json:"subject,omitempty"`
 	Description            *string                                   `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                                  `bson:"_description,omitempty" json:"_description,omitempty"`
 	LegalStatusOfSupply    *CodeableConcept                          `bson:"legalStatusOfSupply,omitempty" json:"legalStatusOfSupply,omitempty"`
 	MarketingStatus        []MarketingStatus                         `bson:"marketingStatus,omitempty" json:"marketingStatus,omitempty"`
 	MarketingAuthorization *Reference                                `bson:"marketingAuthorization,omitempty" json:"marketingAuthorization,omitempty"`
@@ -62,23 +70,2363 @@ type MedicinalProductPackagedPackageItem struct {
 	ShelfLifeStorage        []ProductShelfLife                    `bson:"shelfLifeStorage,omitempty" json:"shelfLifeStorage,omitempty"`
 	Manufacturer            []Reference                           `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
 }
-type OtherMedicinalProductPackaged MedicinalProductPackaged
 
 // MarshalJSON marshals the given MedicinalProductPackaged as JSON into a byte slice
 func (r MedicinalProductPackaged) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicinalProductPackaged
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicinalProductPackaged: OtherMedicinalProductPackaged(r),
-		ResourceType:                  "MedicinalProductPackaged",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductPackaged as JSON object with the members in the order of the fields
+func (r MedicinalProductPackaged) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicinalProductPackaged\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Subject) > 0 {
+		e.key("subject")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subject {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.LegalStatusOfSupply != nil {
+		e.key("legalStatusOfSupply")
+		r.LegalStatusOfSupply.appendJSON(e)
+	}
+	if len(r.MarketingStatus) > 0 {
+		e.key("marketingStatus")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.MarketingStatus {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MarketingAuthorization != nil {
+		e.key("marketingAuthorization")
+		r.MarketingAuthorization.appendJSON(e)
+	}
+	if len(r.Manufacturer) > 0 {
+		e.key("manufacturer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Manufacturer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BatchIdentifier) > 0 {
+		e.key("batchIdentifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BatchIdentifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("packageItem")
+	if r.PackageItem == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PackageItem {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductPackaged from JSON. Unknown members are ignored.
+func (r *MedicinalProductPackaged) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductPackaged
+func (r *MedicinalProductPackaged) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "subject":
+			a := d.array()
+			r.Subject = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = append(r.Subject, v)
+			}
+			if r.Subject == nil && !a.isNull {
+				r.Subject = []Reference{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "legalStatusOfSupply":
+			if d.null() {
+				r.LegalStatusOfSupply = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LegalStatusOfSupply = &v
+			}
+		case "marketingStatus":
+			a := d.array()
+			r.MarketingStatus = nil
+			for a.next() {
+				var v MarketingStatus
+				v.decodeJSON(d)
+				r.MarketingStatus = append(r.MarketingStatus, v)
+			}
+			if r.MarketingStatus == nil && !a.isNull {
+				r.MarketingStatus = []MarketingStatus{}
+			}
+		case "marketingAuthorization":
+			if d.null() {
+				r.MarketingAuthorization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.MarketingAuthorization = &v
+			}
+		case "manufacturer":
+			a := d.array()
+			r.Manufacturer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Manufacturer = append(r.Manufacturer, v)
+			}
+			if r.Manufacturer == nil && !a.isNull {
+				r.Manufacturer = []Reference{}
+			}
+		case "batchIdentifier":
+			a := d.array()
+			r.BatchIdentifier = nil
+			for a.next() {
+				var v MedicinalProductPackagedBatchIdentifier
+				v.decodeJSON(d)
+				r.BatchIdentifier = append(r.BatchIdentifier, v)
+			}
+			if r.BatchIdentifier == nil && !a.isNull {
+				r.BatchIdentifier = []MedicinalProductPackagedBatchIdentifier{}
+			}
+		case "packageItem":
+			a := d.array()
+			r.PackageItem = nil
+			for a.next() {
+				var v MedicinalProductPackagedPackageItem
+				v.decodeJSON(d)
+				r.PackageItem = append(r.PackageItem, v)
+			}
+			if r.PackageItem == nil && !a.isNull {
+				r.PackageItem = []MedicinalProductPackagedPackageItem{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductPackaged which shares no memory with the original
+func (r MedicinalProductPackaged) DeepCopy() MedicinalProductPackaged {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Subject != nil {
+		out.Subject = make([]Reference, len(r.Subject))
+		for i := range r.Subject {
+			out.Subject[i] = r.Subject[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.LegalStatusOfSupply != nil {
+		v := r.LegalStatusOfSupply.DeepCopy()
+		out.LegalStatusOfSupply = &v
+	}
+	if r.MarketingStatus != nil {
+		out.MarketingStatus = make([]MarketingStatus, len(r.MarketingStatus))
+		for i := range r.MarketingStatus {
+			out.MarketingStatus[i] = r.MarketingStatus[i].DeepCopy()
+		}
+	}
+	if r.MarketingAuthorization != nil {
+		v := r.MarketingAuthorization.DeepCopy()
+		out.MarketingAuthorization = &v
+	}
+	if r.Manufacturer != nil {
+		out.Manufacturer = make([]Reference, len(r.Manufacturer))
+		for i := range r.Manufacturer {
+			out.Manufacturer[i] = r.Manufacturer[i].DeepCopy()
+		}
+	}
+	if r.BatchIdentifier != nil {
+		out.BatchIdentifier = make([]MedicinalProductPackagedBatchIdentifier, len(r.BatchIdentifier))
+		for i := range r.BatchIdentifier {
+			out.BatchIdentifier[i] = r.BatchIdentifier[i].DeepCopy()
+		}
+	}
+	if r.PackageItem != nil {
+		out.PackageItem = make([]MedicinalProductPackagedPackageItem, len(r.PackageItem))
+		for i := range r.PackageItem {
+			out.PackageItem[i] = r.PackageItem[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductPackaged) Equal(other MedicinalProductPackaged) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.Subject) != len(other.Subject) {
+		return false
+	}
+	for i := range r.Subject {
+		if !r.Subject[i].Equal(other.Subject[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.LegalStatusOfSupply == nil) != (other.LegalStatusOfSupply == nil) || r.LegalStatusOfSupply != nil && !r.LegalStatusOfSupply.Equal(*other.LegalStatusOfSupply) {
+		return false
+	}
+	if len(r.MarketingStatus) != len(other.MarketingStatus) {
+		return false
+	}
+	for i := range r.MarketingStatus {
+		if !r.MarketingStatus[i].Equal(other.MarketingStatus[i]) {
+			return false
+		}
+	}
+	if (r.MarketingAuthorization == nil) != (other.MarketingAuthorization == nil) || r.MarketingAuthorization != nil && !r.MarketingAuthorization.Equal(*other.MarketingAuthorization) {
+		return false
+	}
+	if len(r.Manufacturer) != len(other.Manufacturer) {
+		return false
+	}
+	for i := range r.Manufacturer {
+		if !r.Manufacturer[i].Equal(other.Manufacturer[i]) {
+			return false
+		}
+	}
+	if len(r.BatchIdentifier) != len(other.BatchIdentifier) {
+		return false
+	}
+	for i := range r.BatchIdentifier {
+		if !r.BatchIdentifier[i].Equal(other.BatchIdentifier[i]) {
+			return false
+		}
+	}
+	if len(r.PackageItem) != len(other.PackageItem) {
+		return false
+	}
+	for i := range r.PackageItem {
+		if !r.PackageItem[i].Equal(other.PackageItem[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductPackaged) EqualsDeep(other MedicinalProductPackaged) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Subject), len(other.Subject), func(i, j int) bool {
+		return r.Subject[i].EqualsDeep(other.Subject[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.LegalStatusOfSupply == nil) != (other.LegalStatusOfSupply == nil) || r.LegalStatusOfSupply != nil && !r.LegalStatusOfSupply.EqualsDeep(*other.LegalStatusOfSupply) {
+		return false
+	}
+	if !equivalentList(len(r.MarketingStatus), len(other.MarketingStatus), func(i, j int) bool {
+		return r.MarketingStatus[i].EqualsDeep(other.MarketingStatus[j])
+	}) {
+		return false
+	}
+	if (r.MarketingAuthorization == nil) != (other.MarketingAuthorization == nil) || r.MarketingAuthorization != nil && !r.MarketingAuthorization.EqualsDeep(*other.MarketingAuthorization) {
+		return false
+	}
+	if !equivalentList(len(r.Manufacturer), len(other.Manufacturer), func(i, j int) bool {
+		return r.Manufacturer[i].EqualsDeep(other.Manufacturer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BatchIdentifier), len(other.BatchIdentifier), func(i, j int) bool {
+		return r.BatchIdentifier[i].EqualsDeep(other.BatchIdentifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PackageItem), len(other.PackageItem), func(i, j int) bool {
+		return r.PackageItem[i].EqualsDeep(other.PackageItem[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductPackaged) EqualsShallow(other MedicinalProductPackaged) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductPackaged as FHIR XML
+func (r MedicinalProductPackaged) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicinalProductPackaged",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Subject {
+		if err := encodeXMLElement(e, "subject", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "legalStatusOfSupply", r.LegalStatusOfSupply); err != nil {
+		return err
+	}
+	for _, v := range r.MarketingStatus {
+		if err := encodeXMLElement(e, "marketingStatus", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "marketingAuthorization", r.MarketingAuthorization); err != nil {
+		return err
+	}
+	for _, v := range r.Manufacturer {
+		if err := encodeXMLElement(e, "manufacturer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BatchIdentifier {
+		if err := encodeXMLElement(e, "batchIdentifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PackageItem {
+		if err := encodeXMLElement(e, "packageItem", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductPackaged from FHIR XML
+func (r *MedicinalProductPackaged) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = append(r.Subject, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "legalStatusOfSupply":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LegalStatusOfSupply = &v
+			case "marketingStatus":
+				var v MarketingStatus
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MarketingStatus = append(r.MarketingStatus, v)
+			case "marketingAuthorization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MarketingAuthorization = &v
+			case "manufacturer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manufacturer = append(r.Manufacturer, v)
+			case "batchIdentifier":
+				var v MedicinalProductPackagedBatchIdentifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BatchIdentifier = append(r.BatchIdentifier, v)
+			case "packageItem":
+				var v MedicinalProductPackagedPackageItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PackageItem = append(r.PackageItem, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductPackaged as properties to the RDF node n
+func (r MedicinalProductPackaged) turtle(n *turtleNode) {
+	n.resource("MedicinalProductPackaged", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicinalProductPackaged.identifier", i, v)
+	}
+	for i, v := range r.Subject {
+		n.element("MedicinalProductPackaged.subject", i, v)
+	}
+	n.primitive("MedicinalProductPackaged.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("MedicinalProductPackaged.legalStatusOfSupply", -1, r.LegalStatusOfSupply)
+	for i, v := range r.MarketingStatus {
+		n.element("MedicinalProductPackaged.marketingStatus", i, v)
+	}
+	n.element("MedicinalProductPackaged.marketingAuthorization", -1, r.MarketingAuthorization)
+	for i, v := range r.Manufacturer {
+		n.element("MedicinalProductPackaged.manufacturer", i, v)
+	}
+	for i, v := range r.BatchIdentifier {
+		n.element("MedicinalProductPackaged.batchIdentifier", i, v)
+	}
+	for i, v := range r.PackageItem {
+		n.element("MedicinalProductPackaged.packageItem", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductPackaged as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductPackaged) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductPackaged from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductPackaged) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductPackaged as protobuf message MedicinalProductPackaged of fhir.proto
+func (r MedicinalProductPackaged) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductPackaged to the encoded message
+func (r MedicinalProductPackaged) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.Subject {
+		e.message(10, v)
+	}
+	if r.Description != nil {
+		e.string(11, *r.Description)
+	}
+	if r.LegalStatusOfSupply != nil {
+		e.message(12, *r.LegalStatusOfSupply)
+	}
+	for _, v := range r.MarketingStatus {
+		e.message(13, v)
+	}
+	if r.MarketingAuthorization != nil {
+		e.message(14, *r.MarketingAuthorization)
+	}
+	for _, v := range r.Manufacturer {
+		e.message(15, v)
+	}
+	for _, v := range r.BatchIdentifier {
+		e.message(16, v)
+	}
+	for _, v := range r.PackageItem {
+		e.message(17, v)
+	}
+	if r.IdElement != nil {
+		e.message(18, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(19, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(20, *r.LanguageElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(21, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductPackaged from protobuf message MedicinalProductPackaged of fhir.proto
+func (r *MedicinalProductPackaged) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductPackaged{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Subject = append(r.Subject, v)
+		case 11:
+			v := d.string()
+			r.Description = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.LegalStatusOfSupply = &v
+		case 13:
+			var v MarketingStatus
+			d.message(&v)
+			r.MarketingStatus = append(r.MarketingStatus, v)
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.MarketingAuthorization = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Manufacturer = append(r.Manufacturer, v)
+		case 16:
+			var v MedicinalProductPackagedBatchIdentifier
+			d.message(&v)
+			r.BatchIdentifier = append(r.BatchIdentifier, v)
+		case 17:
+			var v MedicinalProductPackagedPackageItem
+			d.message(&v)
+			r.PackageItem = append(r.PackageItem, v)
+		case 18:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductPackagedBatchIdentifier as JSON into a byte slice
+func (r MedicinalProductPackagedBatchIdentifier) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductPackagedBatchIdentifier as JSON object with the members in the order of the fields
+func (r MedicinalProductPackagedBatchIdentifier) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("outerPackaging")
+	r.OuterPackaging.appendJSON(e)
+	if r.ImmediatePackaging != nil {
+		e.key("immediatePackaging")
+		r.ImmediatePackaging.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductPackagedBatchIdentifier from JSON. Unknown members are ignored.
+func (r *MedicinalProductPackagedBatchIdentifier) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductPackagedBatchIdentifier
+func (r *MedicinalProductPackagedBatchIdentifier) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "outerPackaging":
+			r.OuterPackaging.decodeJSON(d)
+		case "immediatePackaging":
+			if d.null() {
+				r.ImmediatePackaging = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.ImmediatePackaging = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductPackagedBatchIdentifier which shares no memory with the original
+func (r MedicinalProductPackagedBatchIdentifier) DeepCopy() MedicinalProductPackagedBatchIdentifier {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.OuterPackaging = r.OuterPackaging.DeepCopy()
+	if r.ImmediatePackaging != nil {
+		v := r.ImmediatePackaging.DeepCopy()
+		out.ImmediatePackaging = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductPackagedBatchIdentifier) Equal(other MedicinalProductPackagedBatchIdentifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.OuterPackaging.Equal(other.OuterPackaging) {
+		return false
+	}
+	if (r.ImmediatePackaging == nil) != (other.ImmediatePackaging == nil) || r.ImmediatePackaging != nil && !r.ImmediatePackaging.Equal(*other.ImmediatePackaging) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductPackagedBatchIdentifier) EqualsDeep(other MedicinalProductPackagedBatchIdentifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.OuterPackaging.EqualsDeep(other.OuterPackaging) {
+		return false
+	}
+	if (r.ImmediatePackaging == nil) != (other.ImmediatePackaging == nil) || r.ImmediatePackaging != nil && !r.ImmediatePackaging.EqualsDeep(*other.ImmediatePackaging) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductPackagedBatchIdentifier) EqualsShallow(other MedicinalProductPackagedBatchIdentifier) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductPackagedBatchIdentifier as FHIR XML
+func (r MedicinalProductPackagedBatchIdentifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "outerPackaging", r.OuterPackaging); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "immediatePackaging", r.ImmediatePackaging); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductPackagedBatchIdentifier from FHIR XML
+func (r *MedicinalProductPackagedBatchIdentifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "outerPackaging":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OuterPackaging = v
+			case "immediatePackaging":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ImmediatePackaging = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductPackagedBatchIdentifier as properties to the RDF node n
+func (r MedicinalProductPackagedBatchIdentifier) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductPackaged.batchIdentifier.outerPackaging", -1, r.OuterPackaging)
+	n.element("MedicinalProductPackaged.batchIdentifier.immediatePackaging", -1, r.ImmediatePackaging)
+}
+
+// MarshalBSON marshals the given MedicinalProductPackagedBatchIdentifier as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductPackagedBatchIdentifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductPackagedBatchIdentifier from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductPackagedBatchIdentifier) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductPackagedBatchIdentifier as protobuf message MedicinalProductPackagedBatchIdentifier of fhir.proto
+func (r MedicinalProductPackagedBatchIdentifier) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductPackagedBatchIdentifier to the encoded message
+func (r MedicinalProductPackagedBatchIdentifier) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.OuterPackaging)
+	if r.ImmediatePackaging != nil {
+		e.message(5, *r.ImmediatePackaging)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductPackagedBatchIdentifier from protobuf message MedicinalProductPackagedBatchIdentifier of fhir.proto
+func (r *MedicinalProductPackagedBatchIdentifier) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductPackagedBatchIdentifier{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.OuterPackaging = v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.ImmediatePackaging = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductPackagedPackageItem as JSON into a byte slice
+func (r MedicinalProductPackagedPackageItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductPackagedPackageItem as JSON object with the members in the order of the fields
+func (r MedicinalProductPackagedPackageItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	e.key("quantity")
+	r.Quantity.appendJSON(e)
+	if len(r.Material) > 0 {
+		e.key("material")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Material {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AlternateMaterial) > 0 {
+		e.key("alternateMaterial")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AlternateMaterial {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Device) > 0 {
+		e.key("device")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Device {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ManufacturedItem) > 0 {
+		e.key("manufacturedItem")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ManufacturedItem {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PackageItem) > 0 {
+		e.key("packageItem")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PackageItem {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PhysicalCharacteristics != nil {
+		e.key("physicalCharacteristics")
+		r.PhysicalCharacteristics.appendJSON(e)
+	}
+	if len(r.OtherCharacteristics) > 0 {
+		e.key("otherCharacteristics")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.OtherCharacteristics {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ShelfLifeStorage) > 0 {
+		e.key("shelfLifeStorage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ShelfLifeStorage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Manufacturer) > 0 {
+		e.key("manufacturer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Manufacturer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductPackagedPackageItem from JSON. Unknown members are ignored.
+func (r *MedicinalProductPackagedPackageItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductPackagedPackageItem
+func (r *MedicinalProductPackagedPackageItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "quantity":
+			r.Quantity.decodeJSON(d)
+		case "material":
+			a := d.array()
+			r.Material = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Material = append(r.Material, v)
+			}
+			if r.Material == nil && !a.isNull {
+				r.Material = []CodeableConcept{}
+			}
+		case "alternateMaterial":
+			a := d.array()
+			r.AlternateMaterial = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AlternateMaterial = append(r.AlternateMaterial, v)
+			}
+			if r.AlternateMaterial == nil && !a.isNull {
+				r.AlternateMaterial = []CodeableConcept{}
+			}
+		case "device":
+			a := d.array()
+			r.Device = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Device = append(r.Device, v)
+			}
+			if r.Device == nil && !a.isNull {
+				r.Device = []Reference{}
+			}
+		case "manufacturedItem":
+			a := d.array()
+			r.ManufacturedItem = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ManufacturedItem = append(r.ManufacturedItem, v)
+			}
+			if r.ManufacturedItem == nil && !a.isNull {
+				r.ManufacturedItem = []Reference{}
+			}
+		case "packageItem":
+			a := d.array()
+			r.PackageItem = nil
+			for a.next() {
+				var v MedicinalProductPackagedPackageItem
+				v.decodeJSON(d)
+				r.PackageItem = append(r.PackageItem, v)
+			}
+			if r.PackageItem == nil && !a.isNull {
+				r.PackageItem = []MedicinalProductPackagedPackageItem{}
+			}
+		case "physicalCharacteristics":
+			if d.null() {
+				r.PhysicalCharacteristics = nil
+			} else {
+				var v ProdCharacteristic
+				v.decodeJSON(d)
+				r.PhysicalCharacteristics = &v
+			}
+		case "otherCharacteristics":
+			a := d.array()
+			r.OtherCharacteristics = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OtherCharacteristics = append(r.OtherCharacteristics, v)
+			}
+			if r.OtherCharacteristics == nil && !a.isNull {
+				r.OtherCharacteristics = []CodeableConcept{}
+			}
+		case "shelfLifeStorage":
+			a := d.array()
+			r.ShelfLifeStorage = nil
+			for a.next() {
+				var v ProductShelfLife
+				v.decodeJSON(d)
+				r.ShelfLifeStorage = append(r.ShelfLifeStorage, v)
+			}
+			if r.ShelfLifeStorage == nil && !a.isNull {
+				r.ShelfLifeStorage = []ProductShelfLife{}
+			}
+		case "manufacturer":
+			a := d.array()
+			r.Manufacturer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Manufacturer = append(r.Manufacturer, v)
+			}
+			if r.Manufacturer == nil && !a.isNull {
+				r.Manufacturer = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductPackagedPackageItem which shares no memory with the original
+func (r MedicinalProductPackagedPackageItem) DeepCopy() MedicinalProductPackagedPackageItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	out.Quantity = r.Quantity.DeepCopy()
+	if r.Material != nil {
+		out.Material = make([]CodeableConcept, len(r.Material))
+		for i := range r.Material {
+			out.Material[i] = r.Material[i].DeepCopy()
+		}
+	}
+	if r.AlternateMaterial != nil {
+		out.AlternateMaterial = make([]CodeableConcept, len(r.AlternateMaterial))
+		for i := range r.AlternateMaterial {
+			out.AlternateMaterial[i] = r.AlternateMaterial[i].DeepCopy()
+		}
+	}
+	if r.Device != nil {
+		out.Device = make([]Reference, len(r.Device))
+		for i := range r.Device {
+			out.Device[i] = r.Device[i].DeepCopy()
+		}
+	}
+	if r.ManufacturedItem != nil {
+		out.ManufacturedItem = make([]Reference, len(r.ManufacturedItem))
+		for i := range r.ManufacturedItem {
+			out.ManufacturedItem[i] = r.ManufacturedItem[i].DeepCopy()
+		}
+	}
+	if r.PackageItem != nil {
+		out.PackageItem = make([]MedicinalProductPackagedPackageItem, len(r.PackageItem))
+		for i := range r.PackageItem {
+			out.PackageItem[i] = r.PackageItem[i].DeepCopy()
+		}
+	}
+	if r.PhysicalCharacteristics != nil {
+		v := r.PhysicalCharacteristics.DeepCopy()
+		out.PhysicalCharacteristics = &v
+	}
+	if r.OtherCharacteristics != nil {
+		out.OtherCharacteristics = make([]CodeableConcept, len(r.OtherCharacteristics))
+		for i := range r.OtherCharacteristics {
+			out.OtherCharacteristics[i] = r.OtherCharacteristics[i].DeepCopy()
+		}
+	}
+	if r.ShelfLifeStorage != nil {
+		out.ShelfLifeStorage = make([]ProductShelfLife, len(r.ShelfLifeStorage))
+		for i := range r.ShelfLifeStorage {
+			out.ShelfLifeStorage[i] = r.ShelfLifeStorage[i].DeepCopy()
+		}
+	}
+	if r.Manufacturer != nil {
+		out.Manufacturer = make([]Reference, len(r.Manufacturer))
+		for i := range r.Manufacturer {
+			out.Manufacturer[i] = r.Manufacturer[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductPackagedPackageItem) Equal(other MedicinalProductPackagedPackageItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if !r.Quantity.Equal(other.Quantity) {
+		return false
+	}
+	if len(r.Material) != len(other.Material) {
+		return false
+	}
+	for i := range r.Material {
+		if !r.Material[i].Equal(other.Material[i]) {
+			return false
+		}
+	}
+	if len(r.AlternateMaterial) != len(other.AlternateMaterial) {
+		return false
+	}
+	for i := range r.AlternateMaterial {
+		if !r.AlternateMaterial[i].Equal(other.AlternateMaterial[i]) {
+			return false
+		}
+	}
+	if len(r.Device) != len(other.Device) {
+		return false
+	}
+	for i := range r.Device {
+		if !r.Device[i].Equal(other.Device[i]) {
+			return false
+		}
+	}
+	if len(r.ManufacturedItem) != len(other.ManufacturedItem) {
+		return false
+	}
+	for i := range r.ManufacturedItem {
+		if !r.ManufacturedItem[i].Equal(other.ManufacturedItem[i]) {
+			return false
+		}
+	}
+	if len(r.PackageItem) != len(other.PackageItem) {
+		return false
+	}
+	for i := range r.PackageItem {
+		if !r.PackageItem[i].Equal(other.PackageItem[i]) {
+			return false
+		}
+	}
+	if (r.PhysicalCharacteristics == nil) != (other.PhysicalCharacteristics == nil) || r.PhysicalCharacteristics != nil && !r.PhysicalCharacteristics.Equal(*other.PhysicalCharacteristics) {
+		return false
+	}
+	if len(r.OtherCharacteristics) != len(other.OtherCharacteristics) {
+		return false
+	}
+	for i := range r.OtherCharacteristics {
+		if !r.OtherCharacteristics[i].Equal(other.OtherCharacteristics[i]) {
+			return false
+		}
+	}
+	if len(r.ShelfLifeStorage) != len(other.ShelfLifeStorage) {
+		return false
+	}
+	for i := range r.ShelfLifeStorage {
+		if !r.ShelfLifeStorage[i].Equal(other.ShelfLifeStorage[i]) {
+			return false
+		}
+	}
+	if len(r.Manufacturer) != len(other.Manufacturer) {
+		return false
+	}
+	for i := range r.Manufacturer {
+		if !r.Manufacturer[i].Equal(other.Manufacturer[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductPackagedPackageItem) EqualsDeep(other MedicinalProductPackagedPackageItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !r.Quantity.EqualsDeep(other.Quantity) {
+		return false
+	}
+	if !equivalentList(len(r.Material), len(other.Material), func(i, j int) bool {
+		return r.Material[i].EqualsDeep(other.Material[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.AlternateMaterial), len(other.AlternateMaterial), func(i, j int) bool {
+		return r.AlternateMaterial[i].EqualsDeep(other.AlternateMaterial[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Device), len(other.Device), func(i, j int) bool {
+		return r.Device[i].EqualsDeep(other.Device[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ManufacturedItem), len(other.ManufacturedItem), func(i, j int) bool {
+		return r.ManufacturedItem[i].EqualsDeep(other.ManufacturedItem[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PackageItem), len(other.PackageItem), func(i, j int) bool {
+		return r.PackageItem[i].EqualsDeep(other.PackageItem[j])
+	}) {
+		return false
+	}
+	if (r.PhysicalCharacteristics == nil) != (other.PhysicalCharacteristics == nil) || r.PhysicalCharacteristics != nil && !r.PhysicalCharacteristics.EqualsDeep(*other.PhysicalCharacteristics) {
+		return false
+	}
+	if !equivalentList(len(r.OtherCharacteristics), len(other.OtherCharacteristics), func(i, j int) bool {
+		return r.OtherCharacteristics[i].EqualsDeep(other.OtherCharacteristics[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ShelfLifeStorage), len(other.ShelfLifeStorage), func(i, j int) bool {
+		return r.ShelfLifeStorage[i].EqualsDeep(other.ShelfLifeStorage[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Manufacturer), len(other.Manufacturer), func(i, j int) bool {
+		return r.Manufacturer[i].EqualsDeep(other.Manufacturer[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductPackagedPackageItem) EqualsShallow(other MedicinalProductPackagedPackageItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductPackagedPackageItem as FHIR XML
+func (r MedicinalProductPackagedPackageItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	for _, v := range r.Material {
+		if err := encodeXMLElement(e, "material", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.AlternateMaterial {
+		if err := encodeXMLElement(e, "alternateMaterial", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Device {
+		if err := encodeXMLElement(e, "device", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ManufacturedItem {
+		if err := encodeXMLElement(e, "manufacturedItem", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PackageItem {
+		if err := encodeXMLElement(e, "packageItem", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "physicalCharacteristics", r.PhysicalCharacteristics); err != nil {
+		return err
+	}
+	for _, v := range r.OtherCharacteristics {
+		if err := encodeXMLElement(e, "otherCharacteristics", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ShelfLifeStorage {
+		if err := encodeXMLElement(e, "shelfLifeStorage", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Manufacturer {
+		if err := encodeXMLElement(e, "manufacturer", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductPackagedPackageItem from FHIR XML
+func (r *MedicinalProductPackagedPackageItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = v
+			case "material":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Material = append(r.Material, v)
+			case "alternateMaterial":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AlternateMaterial = append(r.AlternateMaterial, v)
+			case "device":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Device = append(r.Device, v)
+			case "manufacturedItem":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManufacturedItem = append(r.ManufacturedItem, v)
+			case "packageItem":
+				var v MedicinalProductPackagedPackageItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PackageItem = append(r.PackageItem, v)
+			case "physicalCharacteristics":
+				var v ProdCharacteristic
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PhysicalCharacteristics = &v
+			case "otherCharacteristics":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OtherCharacteristics = append(r.OtherCharacteristics, v)
+			case "shelfLifeStorage":
+				var v ProductShelfLife
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ShelfLifeStorage = append(r.ShelfLifeStorage, v)
+			case "manufacturer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manufacturer = append(r.Manufacturer, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductPackagedPackageItem as properties to the RDF node n
+func (r MedicinalProductPackagedPackageItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicinalProductPackaged.packageItem.identifier", i, v)
+	}
+	n.element("MedicinalProductPackaged.packageItem.type", -1, r.Type)
+	n.element("MedicinalProductPackaged.packageItem.quantity", -1, r.Quantity)
+	for i, v := range r.Material {
+		n.element("MedicinalProductPackaged.packageItem.material", i, v)
+	}
+	for i, v := range r.AlternateMaterial {
+		n.element("MedicinalProductPackaged.packageItem.alternateMaterial", i, v)
+	}
+	for i, v := range r.Device {
+		n.element("MedicinalProductPackaged.packageItem.device", i, v)
+	}
+	for i, v := range r.ManufacturedItem {
+		n.element("MedicinalProductPackaged.packageItem.manufacturedItem", i, v)
+	}
+	for i, v := range r.PackageItem {
+		n.element("MedicinalProductPackaged.packageItem.packageItem", i, v)
+	}
+	n.element("MedicinalProductPackaged.packageItem.physicalCharacteristics", -1, r.PhysicalCharacteristics)
+	for i, v := range r.OtherCharacteristics {
+		n.element("MedicinalProductPackaged.packageItem.otherCharacteristics", i, v)
+	}
+	for i, v := range r.ShelfLifeStorage {
+		n.element("MedicinalProductPackaged.packageItem.shelfLifeStorage", i, v)
+	}
+	for i, v := range r.Manufacturer {
+		n.element("MedicinalProductPackaged.packageItem.manufacturer", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductPackagedPackageItem as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductPackagedPackageItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductPackagedPackageItem from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductPackagedPackageItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductPackagedPackageItem as protobuf message MedicinalProductPackagedPackageItem of fhir.proto
+func (r MedicinalProductPackagedPackageItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductPackagedPackageItem to the encoded message
+func (r MedicinalProductPackagedPackageItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(4, v)
+	}
+	e.message(5, r.Type)
+	e.message(6, r.Quantity)
+	for _, v := range r.Material {
+		e.message(7, v)
+	}
+	for _, v := range r.AlternateMaterial {
+		e.message(8, v)
+	}
+	for _, v := range r.Device {
+		e.message(9, v)
+	}
+	for _, v := range r.ManufacturedItem {
+		e.message(10, v)
+	}
+	for _, v := range r.PackageItem {
+		e.message(11, v)
+	}
+	if r.PhysicalCharacteristics != nil {
+		e.message(12, *r.PhysicalCharacteristics)
+	}
+	for _, v := range r.OtherCharacteristics {
+		e.message(13, v)
+	}
+	for _, v := range r.ShelfLifeStorage {
+		e.message(14, v)
+	}
+	for _, v := range r.Manufacturer {
+		e.message(15, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductPackagedPackageItem from protobuf message MedicinalProductPackagedPackageItem of fhir.proto
+func (r *MedicinalProductPackagedPackageItem) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductPackagedPackageItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Material = append(r.Material, v)
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.AlternateMaterial = append(r.AlternateMaterial, v)
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Device = append(r.Device, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.ManufacturedItem = append(r.ManufacturedItem, v)
+		case 11:
+			var v MedicinalProductPackagedPackageItem
+			d.message(&v)
+			r.PackageItem = append(r.PackageItem, v)
+		case 12:
+			var v ProdCharacteristic
+			d.message(&v)
+			r.PhysicalCharacteristics = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.OtherCharacteristics = append(r.OtherCharacteristics, v)
+		case 14:
+			var v ProductShelfLife
+			d.message(&v)
+			r.ShelfLifeStorage = append(r.ShelfLifeStorage, v)
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Manufacturer = append(r.Manufacturer, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicinalProductPackaged unmarshals a MedicinalProductPackaged.
 func UnmarshalMedicinalProductPackaged(b []byte) (MedicinalProductPackaged, error) {
 	var medicinalProductPackaged MedicinalProductPackaged
-	if err := json.Unmarshal(b, &medicinalProductPackaged); err != nil {
+	if err := medicinalProductPackaged.UnmarshalJSON(b); err != nil {
 		return medicinalProductPackaged, err
 	}
 	return medicinalProductPackaged, nil
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,10 +25,14 @@ import "encoding/json"
 // MedicinalProductPharmaceutical is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductPharmaceutical
 type MedicinalProductPharmaceutical struct {
 	Id                    *string                                               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                                              `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                  *Meta                                                 `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                                              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                                               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                                              `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative                                            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage                                     `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension                                           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension                                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier            []Identifier                                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
@@ -63,30 +70,2656 @@ type MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies struct {
 	WithdrawalPeriod  []MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod `bson:"withdrawalPeriod,omitempty" json:"withdrawalPeriod,omitempty"`
 }
 type MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod struct {
-	Id                    *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension             []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Tissue                CodeableConcept `bson:"tissue" json:"tissue"`
-	Value                 Quantity        `bson:"value" json:"value"`
-	SupportingInformation *string         `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	Id                           *string         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Tissue                       CodeableConcept `bson:"tissue" json:"tissue"`
+	Value                        Quantity        `bson:"value" json:"value"`
+	SupportingInformation        *string         `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	SupportingInformationElement *Element        `bson:"_supportingInformation,omitempty" json:"_supportingInformation,omitempty"`
 }
-type OtherMedicinalProductPharmaceutical MedicinalProductPharmaceutical
 
 // MarshalJSON marshals the given MedicinalProductPharmaceutical as JSON into a byte slice
 func (r MedicinalProductPharmaceutical) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicinalProductPharmaceutical
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicinalProductPharmaceutical: OtherMedicinalProductPharmaceutical(r),
-		ResourceType:                        "MedicinalProductPharmaceutical",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductPharmaceutical as JSON object with the members in the order of the fields
+func (r MedicinalProductPharmaceutical) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicinalProductPharmaceutical\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("administrableDoseForm")
+	r.AdministrableDoseForm.appendJSON(e)
+	if r.UnitOfPresentation != nil {
+		e.key("unitOfPresentation")
+		r.UnitOfPresentation.appendJSON(e)
+	}
+	if len(r.Ingredient) > 0 {
+		e.key("ingredient")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Ingredient {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Device) > 0 {
+		e.key("device")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Device {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Characteristics) > 0 {
+		e.key("characteristics")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Characteristics {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("routeOfAdministration")
+	if r.RouteOfAdministration == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RouteOfAdministration {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductPharmaceutical from JSON. Unknown members are ignored.
+func (r *MedicinalProductPharmaceutical) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductPharmaceutical
+func (r *MedicinalProductPharmaceutical) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "administrableDoseForm":
+			r.AdministrableDoseForm.decodeJSON(d)
+		case "unitOfPresentation":
+			if d.null() {
+				r.UnitOfPresentation = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.UnitOfPresentation = &v
+			}
+		case "ingredient":
+			a := d.array()
+			r.Ingredient = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Ingredient = append(r.Ingredient, v)
+			}
+			if r.Ingredient == nil && !a.isNull {
+				r.Ingredient = []Reference{}
+			}
+		case "device":
+			a := d.array()
+			r.Device = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Device = append(r.Device, v)
+			}
+			if r.Device == nil && !a.isNull {
+				r.Device = []Reference{}
+			}
+		case "characteristics":
+			a := d.array()
+			r.Characteristics = nil
+			for a.next() {
+				var v MedicinalProductPharmaceuticalCharacteristics
+				v.decodeJSON(d)
+				r.Characteristics = append(r.Characteristics, v)
+			}
+			if r.Characteristics == nil && !a.isNull {
+				r.Characteristics = []MedicinalProductPharmaceuticalCharacteristics{}
+			}
+		case "routeOfAdministration":
+			a := d.array()
+			r.RouteOfAdministration = nil
+			for a.next() {
+				var v MedicinalProductPharmaceuticalRouteOfAdministration
+				v.decodeJSON(d)
+				r.RouteOfAdministration = append(r.RouteOfAdministration, v)
+			}
+			if r.RouteOfAdministration == nil && !a.isNull {
+				r.RouteOfAdministration = []MedicinalProductPharmaceuticalRouteOfAdministration{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductPharmaceutical which shares no memory with the original
+func (r MedicinalProductPharmaceutical) DeepCopy() MedicinalProductPharmaceutical {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	out.AdministrableDoseForm = r.AdministrableDoseForm.DeepCopy()
+	if r.UnitOfPresentation != nil {
+		v := r.UnitOfPresentation.DeepCopy()
+		out.UnitOfPresentation = &v
+	}
+	if r.Ingredient != nil {
+		out.Ingredient = make([]Reference, len(r.Ingredient))
+		for i := range r.Ingredient {
+			out.Ingredient[i] = r.Ingredient[i].DeepCopy()
+		}
+	}
+	if r.Device != nil {
+		out.Device = make([]Reference, len(r.Device))
+		for i := range r.Device {
+			out.Device[i] = r.Device[i].DeepCopy()
+		}
+	}
+	if r.Characteristics != nil {
+		out.Characteristics = make([]MedicinalProductPharmaceuticalCharacteristics, len(r.Characteristics))
+		for i := range r.Characteristics {
+			out.Characteristics[i] = r.Characteristics[i].DeepCopy()
+		}
+	}
+	if r.RouteOfAdministration != nil {
+		out.RouteOfAdministration = make([]MedicinalProductPharmaceuticalRouteOfAdministration, len(r.RouteOfAdministration))
+		for i := range r.RouteOfAdministration {
+			out.RouteOfAdministration[i] = r.RouteOfAdministration[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductPharmaceutical) Equal(other MedicinalProductPharmaceutical) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if !r.AdministrableDoseForm.Equal(other.AdministrableDoseForm) {
+		return false
+	}
+	if (r.UnitOfPresentation == nil) != (other.UnitOfPresentation == nil) || r.UnitOfPresentation != nil && !r.UnitOfPresentation.Equal(*other.UnitOfPresentation) {
+		return false
+	}
+	if len(r.Ingredient) != len(other.Ingredient) {
+		return false
+	}
+	for i := range r.Ingredient {
+		if !r.Ingredient[i].Equal(other.Ingredient[i]) {
+			return false
+		}
+	}
+	if len(r.Device) != len(other.Device) {
+		return false
+	}
+	for i := range r.Device {
+		if !r.Device[i].Equal(other.Device[i]) {
+			return false
+		}
+	}
+	if len(r.Characteristics) != len(other.Characteristics) {
+		return false
+	}
+	for i := range r.Characteristics {
+		if !r.Characteristics[i].Equal(other.Characteristics[i]) {
+			return false
+		}
+	}
+	if len(r.RouteOfAdministration) != len(other.RouteOfAdministration) {
+		return false
+	}
+	for i := range r.RouteOfAdministration {
+		if !r.RouteOfAdministration[i].Equal(other.RouteOfAdministration[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductPharmaceutical) EqualsDeep(other MedicinalProductPharmaceutical) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !r.AdministrableDoseForm.EqualsDeep(other.AdministrableDoseForm) {
+		return false
+	}
+	if (r.UnitOfPresentation == nil) != (other.UnitOfPresentation == nil) || r.UnitOfPresentation != nil && !r.UnitOfPresentation.EqualsDeep(*other.UnitOfPresentation) {
+		return false
+	}
+	if !equivalentList(len(r.Ingredient), len(other.Ingredient), func(i, j int) bool {
+		return r.Ingredient[i].EqualsDeep(other.Ingredient[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Device), len(other.Device), func(i, j int) bool {
+		return r.Device[i].EqualsDeep(other.Device[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Characteristics), len(other.Characteristics), func(i, j int) bool {
+		return r.Characteristics[i].EqualsDeep(other.Characteristics[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RouteOfAdministration), len(other.RouteOfAdministration), func(i, j int) bool {
+		return r.RouteOfAdministration[i].EqualsDeep(other.RouteOfAdministration[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductPharmaceutical) EqualsShallow(other MedicinalProductPharmaceutical) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductPharmaceutical as FHIR XML
+func (r MedicinalProductPharmaceutical) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicinalProductPharmaceutical",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "administrableDoseForm", r.AdministrableDoseForm); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitOfPresentation", r.UnitOfPresentation); err != nil {
+		return err
+	}
+	for _, v := range r.Ingredient {
+		if err := encodeXMLElement(e, "ingredient", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Device {
+		if err := encodeXMLElement(e, "device", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Characteristics {
+		if err := encodeXMLElement(e, "characteristics", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RouteOfAdministration {
+		if err := encodeXMLElement(e, "routeOfAdministration", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductPharmaceutical from FHIR XML
+func (r *MedicinalProductPharmaceutical) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "administrableDoseForm":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdministrableDoseForm = v
+			case "unitOfPresentation":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitOfPresentation = &v
+			case "ingredient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Ingredient = append(r.Ingredient, v)
+			case "device":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Device = append(r.Device, v)
+			case "characteristics":
+				var v MedicinalProductPharmaceuticalCharacteristics
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Characteristics = append(r.Characteristics, v)
+			case "routeOfAdministration":
+				var v MedicinalProductPharmaceuticalRouteOfAdministration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RouteOfAdministration = append(r.RouteOfAdministration, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductPharmaceutical as properties to the RDF node n
+func (r MedicinalProductPharmaceutical) turtle(n *turtleNode) {
+	n.resource("MedicinalProductPharmaceutical", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MedicinalProductPharmaceutical.identifier", i, v)
+	}
+	n.element("MedicinalProductPharmaceutical.administrableDoseForm", -1, r.AdministrableDoseForm)
+	n.element("MedicinalProductPharmaceutical.unitOfPresentation", -1, r.UnitOfPresentation)
+	for i, v := range r.Ingredient {
+		n.element("MedicinalProductPharmaceutical.ingredient", i, v)
+	}
+	for i, v := range r.Device {
+		n.element("MedicinalProductPharmaceutical.device", i, v)
+	}
+	for i, v := range r.Characteristics {
+		n.element("MedicinalProductPharmaceutical.characteristics", i, v)
+	}
+	for i, v := range r.RouteOfAdministration {
+		n.element("MedicinalProductPharmaceutical.routeOfAdministration", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductPharmaceutical as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductPharmaceutical) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductPharmaceutical from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductPharmaceutical) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductPharmaceutical as protobuf message MedicinalProductPharmaceutical of fhir.proto
+func (r MedicinalProductPharmaceutical) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductPharmaceutical to the encoded message
+func (r MedicinalProductPharmaceutical) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.message(10, r.AdministrableDoseForm)
+	if r.UnitOfPresentation != nil {
+		e.message(11, *r.UnitOfPresentation)
+	}
+	for _, v := range r.Ingredient {
+		e.message(12, v)
+	}
+	for _, v := range r.Device {
+		e.message(13, v)
+	}
+	for _, v := range r.Characteristics {
+		e.message(14, v)
+	}
+	for _, v := range r.RouteOfAdministration {
+		e.message(15, v)
+	}
+	if r.IdElement != nil {
+		e.message(16, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(17, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(18, *r.LanguageElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductPharmaceutical from protobuf message MedicinalProductPharmaceutical of fhir.proto
+func (r *MedicinalProductPharmaceutical) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductPharmaceutical{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdministrableDoseForm = v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.UnitOfPresentation = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Ingredient = append(r.Ingredient, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Device = append(r.Device, v)
+		case 14:
+			var v MedicinalProductPharmaceuticalCharacteristics
+			d.message(&v)
+			r.Characteristics = append(r.Characteristics, v)
+		case 15:
+			var v MedicinalProductPharmaceuticalRouteOfAdministration
+			d.message(&v)
+			r.RouteOfAdministration = append(r.RouteOfAdministration, v)
+		case 16:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductPharmaceuticalCharacteristics as JSON into a byte slice
+func (r MedicinalProductPharmaceuticalCharacteristics) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductPharmaceuticalCharacteristics as JSON object with the members in the order of the fields
+func (r MedicinalProductPharmaceuticalCharacteristics) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if r.Status != nil {
+		e.key("status")
+		r.Status.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductPharmaceuticalCharacteristics from JSON. Unknown members are ignored.
+func (r *MedicinalProductPharmaceuticalCharacteristics) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductPharmaceuticalCharacteristics
+func (r *MedicinalProductPharmaceuticalCharacteristics) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Status = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductPharmaceuticalCharacteristics which shares no memory with the original
+func (r MedicinalProductPharmaceuticalCharacteristics) DeepCopy() MedicinalProductPharmaceuticalCharacteristics {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.Status != nil {
+		v := r.Status.DeepCopy()
+		out.Status = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductPharmaceuticalCharacteristics) Equal(other MedicinalProductPharmaceuticalCharacteristics) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.Equal(*other.Status) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductPharmaceuticalCharacteristics) EqualsDeep(other MedicinalProductPharmaceuticalCharacteristics) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.EqualsDeep(*other.Status) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductPharmaceuticalCharacteristics) EqualsShallow(other MedicinalProductPharmaceuticalCharacteristics) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductPharmaceuticalCharacteristics as FHIR XML
+func (r MedicinalProductPharmaceuticalCharacteristics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "status", r.Status); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductPharmaceuticalCharacteristics from FHIR XML
+func (r *MedicinalProductPharmaceuticalCharacteristics) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "status":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Status = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductPharmaceuticalCharacteristics as properties to the RDF node n
+func (r MedicinalProductPharmaceuticalCharacteristics) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductPharmaceutical.characteristics.code", -1, r.Code)
+	n.element("MedicinalProductPharmaceutical.characteristics.status", -1, r.Status)
+}
+
+// MarshalBSON marshals the given MedicinalProductPharmaceuticalCharacteristics as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductPharmaceuticalCharacteristics) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductPharmaceuticalCharacteristics from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductPharmaceuticalCharacteristics) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductPharmaceuticalCharacteristics as protobuf message MedicinalProductPharmaceuticalCharacteristics of fhir.proto
+func (r MedicinalProductPharmaceuticalCharacteristics) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductPharmaceuticalCharacteristics to the encoded message
+func (r MedicinalProductPharmaceuticalCharacteristics) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	if r.Status != nil {
+		e.message(5, *r.Status)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductPharmaceuticalCharacteristics from protobuf message MedicinalProductPharmaceuticalCharacteristics of fhir.proto
+func (r *MedicinalProductPharmaceuticalCharacteristics) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductPharmaceuticalCharacteristics{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Status = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductPharmaceuticalRouteOfAdministration as JSON into a byte slice
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductPharmaceuticalRouteOfAdministration as JSON object with the members in the order of the fields
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if r.FirstDose != nil {
+		e.key("firstDose")
+		r.FirstDose.appendJSON(e)
+	}
+	if r.MaxSingleDose != nil {
+		e.key("maxSingleDose")
+		r.MaxSingleDose.appendJSON(e)
+	}
+	if r.MaxDosePerDay != nil {
+		e.key("maxDosePerDay")
+		r.MaxDosePerDay.appendJSON(e)
+	}
+	if r.MaxDosePerTreatmentPeriod != nil {
+		e.key("maxDosePerTreatmentPeriod")
+		r.MaxDosePerTreatmentPeriod.appendJSON(e)
+	}
+	if r.MaxTreatmentPeriod != nil {
+		e.key("maxTreatmentPeriod")
+		r.MaxTreatmentPeriod.appendJSON(e)
+	}
+	if len(r.TargetSpecies) > 0 {
+		e.key("targetSpecies")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.TargetSpecies {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministration from JSON. Unknown members are ignored.
+func (r *MedicinalProductPharmaceuticalRouteOfAdministration) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductPharmaceuticalRouteOfAdministration
+func (r *MedicinalProductPharmaceuticalRouteOfAdministration) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "firstDose":
+			if d.null() {
+				r.FirstDose = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.FirstDose = &v
+			}
+		case "maxSingleDose":
+			if d.null() {
+				r.MaxSingleDose = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MaxSingleDose = &v
+			}
+		case "maxDosePerDay":
+			if d.null() {
+				r.MaxDosePerDay = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MaxDosePerDay = &v
+			}
+		case "maxDosePerTreatmentPeriod":
+			if d.null() {
+				r.MaxDosePerTreatmentPeriod = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.MaxDosePerTreatmentPeriod = &v
+			}
+		case "maxTreatmentPeriod":
+			if d.null() {
+				r.MaxTreatmentPeriod = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.MaxTreatmentPeriod = &v
+			}
+		case "targetSpecies":
+			a := d.array()
+			r.TargetSpecies = nil
+			for a.next() {
+				var v MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies
+				v.decodeJSON(d)
+				r.TargetSpecies = append(r.TargetSpecies, v)
+			}
+			if r.TargetSpecies == nil && !a.isNull {
+				r.TargetSpecies = []MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductPharmaceuticalRouteOfAdministration which shares no memory with the original
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) DeepCopy() MedicinalProductPharmaceuticalRouteOfAdministration {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.FirstDose != nil {
+		v := r.FirstDose.DeepCopy()
+		out.FirstDose = &v
+	}
+	if r.MaxSingleDose != nil {
+		v := r.MaxSingleDose.DeepCopy()
+		out.MaxSingleDose = &v
+	}
+	if r.MaxDosePerDay != nil {
+		v := r.MaxDosePerDay.DeepCopy()
+		out.MaxDosePerDay = &v
+	}
+	if r.MaxDosePerTreatmentPeriod != nil {
+		v := r.MaxDosePerTreatmentPeriod.DeepCopy()
+		out.MaxDosePerTreatmentPeriod = &v
+	}
+	if r.MaxTreatmentPeriod != nil {
+		v := r.MaxTreatmentPeriod.DeepCopy()
+		out.MaxTreatmentPeriod = &v
+	}
+	if r.TargetSpecies != nil {
+		out.TargetSpecies = make([]MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies, len(r.TargetSpecies))
+		for i := range r.TargetSpecies {
+			out.TargetSpecies[i] = r.TargetSpecies[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) Equal(other MedicinalProductPharmaceuticalRouteOfAdministration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if (r.FirstDose == nil) != (other.FirstDose == nil) || r.FirstDose != nil && !r.FirstDose.Equal(*other.FirstDose) {
+		return false
+	}
+	if (r.MaxSingleDose == nil) != (other.MaxSingleDose == nil) || r.MaxSingleDose != nil && !r.MaxSingleDose.Equal(*other.MaxSingleDose) {
+		return false
+	}
+	if (r.MaxDosePerDay == nil) != (other.MaxDosePerDay == nil) || r.MaxDosePerDay != nil && !r.MaxDosePerDay.Equal(*other.MaxDosePerDay) {
+		return false
+	}
+	if (r.MaxDosePerTreatmentPeriod == nil) != (other.MaxDosePerTreatmentPeriod == nil) || r.MaxDosePerTreatmentPeriod != nil && !r.MaxDosePerTreatmentPeriod.Equal(*other.MaxDosePerTreatmentPeriod) {
+		return false
+	}
+	if (r.MaxTreatmentPeriod == nil) != (other.MaxTreatmentPeriod == nil) || r.MaxTreatmentPeriod != nil && !r.MaxTreatmentPeriod.Equal(*other.MaxTreatmentPeriod) {
+		return false
+	}
+	if len(r.TargetSpecies) != len(other.TargetSpecies) {
+		return false
+	}
+	for i := range r.TargetSpecies {
+		if !r.TargetSpecies[i].Equal(other.TargetSpecies[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) EqualsDeep(other MedicinalProductPharmaceuticalRouteOfAdministration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if (r.FirstDose == nil) != (other.FirstDose == nil) || r.FirstDose != nil && !r.FirstDose.EqualsDeep(*other.FirstDose) {
+		return false
+	}
+	if (r.MaxSingleDose == nil) != (other.MaxSingleDose == nil) || r.MaxSingleDose != nil && !r.MaxSingleDose.EqualsDeep(*other.MaxSingleDose) {
+		return false
+	}
+	if (r.MaxDosePerDay == nil) != (other.MaxDosePerDay == nil) || r.MaxDosePerDay != nil && !r.MaxDosePerDay.EqualsDeep(*other.MaxDosePerDay) {
+		return false
+	}
+	if (r.MaxDosePerTreatmentPeriod == nil) != (other.MaxDosePerTreatmentPeriod == nil) || r.MaxDosePerTreatmentPeriod != nil && !r.MaxDosePerTreatmentPeriod.EqualsDeep(*other.MaxDosePerTreatmentPeriod) {
+		return false
+	}
+	if (r.MaxTreatmentPeriod == nil) != (other.MaxTreatmentPeriod == nil) || r.MaxTreatmentPeriod != nil && !r.MaxTreatmentPeriod.EqualsDeep(*other.MaxTreatmentPeriod) {
+		return false
+	}
+	if !equivalentList(len(r.TargetSpecies), len(other.TargetSpecies), func(i, j int) bool {
+		return r.TargetSpecies[i].EqualsDeep(other.TargetSpecies[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) EqualsShallow(other MedicinalProductPharmaceuticalRouteOfAdministration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductPharmaceuticalRouteOfAdministration as FHIR XML
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "firstDose", r.FirstDose); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "maxSingleDose", r.MaxSingleDose); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "maxDosePerDay", r.MaxDosePerDay); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "maxDosePerTreatmentPeriod", r.MaxDosePerTreatmentPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "maxTreatmentPeriod", r.MaxTreatmentPeriod); err != nil {
+		return err
+	}
+	for _, v := range r.TargetSpecies {
+		if err := encodeXMLElement(e, "targetSpecies", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministration from FHIR XML
+func (r *MedicinalProductPharmaceuticalRouteOfAdministration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "firstDose":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FirstDose = &v
+			case "maxSingleDose":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxSingleDose = &v
+			case "maxDosePerDay":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxDosePerDay = &v
+			case "maxDosePerTreatmentPeriod":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxDosePerTreatmentPeriod = &v
+			case "maxTreatmentPeriod":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxTreatmentPeriod = &v
+			case "targetSpecies":
+				var v MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TargetSpecies = append(r.TargetSpecies, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductPharmaceuticalRouteOfAdministration as properties to the RDF node n
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductPharmaceutical.routeOfAdministration.code", -1, r.Code)
+	n.element("MedicinalProductPharmaceutical.routeOfAdministration.firstDose", -1, r.FirstDose)
+	n.element("MedicinalProductPharmaceutical.routeOfAdministration.maxSingleDose", -1, r.MaxSingleDose)
+	n.element("MedicinalProductPharmaceutical.routeOfAdministration.maxDosePerDay", -1, r.MaxDosePerDay)
+	n.element("MedicinalProductPharmaceutical.routeOfAdministration.maxDosePerTreatmentPeriod", -1, r.MaxDosePerTreatmentPeriod)
+	n.element("MedicinalProductPharmaceutical.routeOfAdministration.maxTreatmentPeriod", -1, r.MaxTreatmentPeriod)
+	for i, v := range r.TargetSpecies {
+		n.element("MedicinalProductPharmaceutical.routeOfAdministration.targetSpecies", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductPharmaceuticalRouteOfAdministration as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministration from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductPharmaceuticalRouteOfAdministration) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductPharmaceuticalRouteOfAdministration as protobuf message MedicinalProductPharmaceuticalRouteOfAdministration of fhir.proto
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductPharmaceuticalRouteOfAdministration to the encoded message
+func (r MedicinalProductPharmaceuticalRouteOfAdministration) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	if r.FirstDose != nil {
+		e.message(5, *r.FirstDose)
+	}
+	if r.MaxSingleDose != nil {
+		e.message(6, *r.MaxSingleDose)
+	}
+	if r.MaxDosePerDay != nil {
+		e.message(7, *r.MaxDosePerDay)
+	}
+	if r.MaxDosePerTreatmentPeriod != nil {
+		e.message(8, *r.MaxDosePerTreatmentPeriod)
+	}
+	if r.MaxTreatmentPeriod != nil {
+		e.message(9, *r.MaxTreatmentPeriod)
+	}
+	for _, v := range r.TargetSpecies {
+		e.message(10, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministration from protobuf message MedicinalProductPharmaceuticalRouteOfAdministration of fhir.proto
+func (r *MedicinalProductPharmaceuticalRouteOfAdministration) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductPharmaceuticalRouteOfAdministration{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.FirstDose = &v
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.MaxSingleDose = &v
+		case 7:
+			var v Quantity
+			d.message(&v)
+			r.MaxDosePerDay = &v
+		case 8:
+			var v Ratio
+			d.message(&v)
+			r.MaxDosePerTreatmentPeriod = &v
+		case 9:
+			var v Duration
+			d.message(&v)
+			r.MaxTreatmentPeriod = &v
+		case 10:
+			var v MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies
+			d.message(&v)
+			r.TargetSpecies = append(r.TargetSpecies, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies as JSON into a byte slice
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies as JSON object with the members in the order of the fields
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if len(r.WithdrawalPeriod) > 0 {
+		e.key("withdrawalPeriod")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.WithdrawalPeriod {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies from JSON. Unknown members are ignored.
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "withdrawalPeriod":
+			a := d.array()
+			r.WithdrawalPeriod = nil
+			for a.next() {
+				var v MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod
+				v.decodeJSON(d)
+				r.WithdrawalPeriod = append(r.WithdrawalPeriod, v)
+			}
+			if r.WithdrawalPeriod == nil && !a.isNull {
+				r.WithdrawalPeriod = []MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies which shares no memory with the original
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) DeepCopy() MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.WithdrawalPeriod != nil {
+		out.WithdrawalPeriod = make([]MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod, len(r.WithdrawalPeriod))
+		for i := range r.WithdrawalPeriod {
+			out.WithdrawalPeriod[i] = r.WithdrawalPeriod[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) Equal(other MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if len(r.WithdrawalPeriod) != len(other.WithdrawalPeriod) {
+		return false
+	}
+	for i := range r.WithdrawalPeriod {
+		if !r.WithdrawalPeriod[i].Equal(other.WithdrawalPeriod[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) EqualsDeep(other MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.WithdrawalPeriod), len(other.WithdrawalPeriod), func(i, j int) bool {
+		return r.WithdrawalPeriod[i].EqualsDeep(other.WithdrawalPeriod[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) EqualsShallow(other MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies as FHIR XML
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.WithdrawalPeriod {
+		if err := encodeXMLElement(e, "withdrawalPeriod", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies from FHIR XML
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "withdrawalPeriod":
+				var v MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.WithdrawalPeriod = append(r.WithdrawalPeriod, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies as properties to the RDF node n
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductPharmaceutical.routeOfAdministration.targetSpecies.code", -1, r.Code)
+	for i, v := range r.WithdrawalPeriod {
+		n.element("MedicinalProductPharmaceutical.routeOfAdministration.targetSpecies.withdrawalPeriod", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies as protobuf message MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies of fhir.proto
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies to the encoded message
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	for _, v := range r.WithdrawalPeriod {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies from protobuf message MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies of fhir.proto
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod
+			d.message(&v)
+			r.WithdrawalPeriod = append(r.WithdrawalPeriod, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod as JSON into a byte slice
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod as JSON object with the members in the order of the fields
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("tissue")
+	r.Tissue.appendJSON(e)
+	e.key("value")
+	r.Value.appendJSON(e)
+	if r.SupportingInformation != nil {
+		e.key("supportingInformation")
+		e.string(*r.SupportingInformation)
+	}
+	if r.SupportingInformationElement != nil {
+		e.key("_supportingInformation")
+		r.SupportingInformationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod from JSON. Unknown members are ignored.
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "tissue":
+			r.Tissue.decodeJSON(d)
+		case "value":
+			r.Value.decodeJSON(d)
+		case "supportingInformation":
+			if v, ok := d.string(); ok {
+				r.SupportingInformation = &v
+			} else {
+				r.SupportingInformation = nil
+			}
+		case "_supportingInformation":
+			r.SupportingInformationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod which shares no memory with the original
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) DeepCopy() MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Tissue = r.Tissue.DeepCopy()
+	out.Value = r.Value.DeepCopy()
+	if r.SupportingInformation != nil {
+		v := *r.SupportingInformation
+		out.SupportingInformation = &v
+	}
+	if r.SupportingInformationElement != nil {
+		v := r.SupportingInformationElement.DeepCopy()
+		out.SupportingInformationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) Equal(other MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Tissue.Equal(other.Tissue) {
+		return false
+	}
+	if !r.Value.Equal(other.Value) {
+		return false
+	}
+	if (r.SupportingInformation == nil) != (other.SupportingInformation == nil) || r.SupportingInformation != nil && *r.SupportingInformation != *other.SupportingInformation {
+		return false
+	}
+	if (r.SupportingInformationElement == nil) != (other.SupportingInformationElement == nil) || r.SupportingInformationElement != nil && !r.SupportingInformationElement.Equal(*other.SupportingInformationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) EqualsDeep(other MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Tissue.EqualsDeep(other.Tissue) {
+		return false
+	}
+	if !r.Value.EqualsDeep(other.Value) {
+		return false
+	}
+	if (r.SupportingInformation == nil) != (other.SupportingInformation == nil) || r.SupportingInformation != nil && !equivalentString(*r.SupportingInformation, *other.SupportingInformation) {
+		return false
+	}
+	if (r.SupportingInformationElement == nil) != (other.SupportingInformationElement == nil) || r.SupportingInformationElement != nil && !r.SupportingInformationElement.EqualsDeep(*other.SupportingInformationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) EqualsShallow(other MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.SupportingInformation == nil) != (other.SupportingInformation == nil) || r.SupportingInformation != nil && !equivalentString(*r.SupportingInformation, *other.SupportingInformation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod as FHIR XML
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "tissue", r.Tissue); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "value", r.Value); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "supportingInformation", r.SupportingInformation, r.SupportingInformationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod from FHIR XML
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "tissue":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Tissue = v
+			case "value":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Value = v
+			case "supportingInformation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SupportingInformation = &v
+				}
+				r.SupportingInformationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod as properties to the RDF node n
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MedicinalProductPharmaceutical.routeOfAdministration.targetSpecies.withdrawalPeriod.tissue", -1, r.Tissue)
+	n.element("MedicinalProductPharmaceutical.routeOfAdministration.targetSpecies.withdrawalPeriod.value", -1, r.Value)
+	n.primitive("MedicinalProductPharmaceutical.routeOfAdministration.targetSpecies.withdrawalPeriod.supportingInformation", -1, r.SupportingInformation, r.SupportingInformationElement, "string")
+}
+
+// MarshalBSON marshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod as protobuf message MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod of fhir.proto
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod to the encoded message
+func (r MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Tissue)
+	e.message(5, r.Value)
+	if r.SupportingInformation != nil {
+		e.string(6, *r.SupportingInformation)
+	}
+	if r.SupportingInformationElement != nil {
+		e.message(7, *r.SupportingInformationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod from protobuf message MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod of fhir.proto
+func (r *MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Tissue = v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.Value = v
+		case 6:
+			v := d.string()
+			r.SupportingInformation = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.SupportingInformationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicinalProductPharmaceutical unmarshals a MedicinalProductPharmaceutical.
 func UnmarshalMedicinalProductPharmaceutical(b []byte) (MedicinalProductPharmaceutical, error) {
 	var medicinalProductPharmaceutical MedicinalProductPharmaceutical
-	if err := json.Unmarshal(b, &medicinalProductPharmaceutical); err != nil {
+	if err := medicinalProductPharmaceutical.UnmarshalJSON(b); err != nil {
 		return medicinalProductPharmaceutical, err
 	}
 	return medicinalProductPharmaceutical, nil
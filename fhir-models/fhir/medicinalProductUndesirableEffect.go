@@ -14,43 +14,855 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MedicinalProductUndesirableEffect is documented here http://hl7.org/fhir/StructureDefinition/MedicinalProductUndesirableEffect
 type MedicinalProductUndesirableEffect struct {
-	Id                     *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta            `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string          `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative       `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Subject                []Reference      `bson:"subject,omitempty" json:"subject,omitempty"`
-	SymptomConditionEffect *CodeableConcept `bson:"symptomConditionEffect,omitempty" json:"symptomConditionEffect,omitempty"`
-	Classification         *CodeableConcept `bson:"classification,omitempty" json:"classification,omitempty"`
-	FrequencyOfOccurrence  *CodeableConcept `bson:"frequencyOfOccurrence,omitempty" json:"frequencyOfOccurrence,omitempty"`
-	Population             []Population     `bson:"population,omitempty" json:"population,omitempty"`
-}
-type OtherMedicinalProductUndesirableEffect MedicinalProductUndesirableEffect
+	Id                     *string           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                   *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules          *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language               *string           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                   *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension              []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Subject                []Reference       `bson:"subject,omitempty" json:"subject,omitempty"`
+	SymptomConditionEffect *CodeableConcept  `bson:"symptomConditionEffect,omitempty" json:"symptomConditionEffect,omitempty"`
+	Classification         *CodeableConcept  `bson:"classification,omitempty" json:"classification,omitempty"`
+	FrequencyOfOccurrence  *CodeableConcept  `bson:"frequencyOfOccurrence,omitempty" json:"frequencyOfOccurrence,omitempty"`
+	Population             []Population      `bson:"population,omitempty" json:"population,omitempty"`
+}
 
 // MarshalJSON marshals the given MedicinalProductUndesirableEffect as JSON into a byte slice
 func (r MedicinalProductUndesirableEffect) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMedicinalProductUndesirableEffect
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMedicinalProductUndesirableEffect: OtherMedicinalProductUndesirableEffect(r),
-		ResourceType:                           "MedicinalProductUndesirableEffect",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MedicinalProductUndesirableEffect as JSON object with the members in the order of the fields
+func (r MedicinalProductUndesirableEffect) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MedicinalProductUndesirableEffect\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Subject) > 0 {
+		e.key("subject")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subject {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.SymptomConditionEffect != nil {
+		e.key("symptomConditionEffect")
+		r.SymptomConditionEffect.appendJSON(e)
+	}
+	if r.Classification != nil {
+		e.key("classification")
+		r.Classification.appendJSON(e)
+	}
+	if r.FrequencyOfOccurrence != nil {
+		e.key("frequencyOfOccurrence")
+		r.FrequencyOfOccurrence.appendJSON(e)
+	}
+	if len(r.Population) > 0 {
+		e.key("population")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Population {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MedicinalProductUndesirableEffect from JSON. Unknown members are ignored.
+func (r *MedicinalProductUndesirableEffect) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MedicinalProductUndesirableEffect
+func (r *MedicinalProductUndesirableEffect) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "subject":
+			a := d.array()
+			r.Subject = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = append(r.Subject, v)
+			}
+			if r.Subject == nil && !a.isNull {
+				r.Subject = []Reference{}
+			}
+		case "symptomConditionEffect":
+			if d.null() {
+				r.SymptomConditionEffect = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SymptomConditionEffect = &v
+			}
+		case "classification":
+			if d.null() {
+				r.Classification = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Classification = &v
+			}
+		case "frequencyOfOccurrence":
+			if d.null() {
+				r.FrequencyOfOccurrence = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FrequencyOfOccurrence = &v
+			}
+		case "population":
+			a := d.array()
+			r.Population = nil
+			for a.next() {
+				var v Population
+				v.decodeJSON(d)
+				r.Population = append(r.Population, v)
+			}
+			if r.Population == nil && !a.isNull {
+				r.Population = []Population{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MedicinalProductUndesirableEffect which shares no memory with the original
+func (r MedicinalProductUndesirableEffect) DeepCopy() MedicinalProductUndesirableEffect {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Subject != nil {
+		out.Subject = make([]Reference, len(r.Subject))
+		for i := range r.Subject {
+			out.Subject[i] = r.Subject[i].DeepCopy()
+		}
+	}
+	if r.SymptomConditionEffect != nil {
+		v := r.SymptomConditionEffect.DeepCopy()
+		out.SymptomConditionEffect = &v
+	}
+	if r.Classification != nil {
+		v := r.Classification.DeepCopy()
+		out.Classification = &v
+	}
+	if r.FrequencyOfOccurrence != nil {
+		v := r.FrequencyOfOccurrence.DeepCopy()
+		out.FrequencyOfOccurrence = &v
+	}
+	if r.Population != nil {
+		out.Population = make([]Population, len(r.Population))
+		for i := range r.Population {
+			out.Population[i] = r.Population[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MedicinalProductUndesirableEffect) Equal(other MedicinalProductUndesirableEffect) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Subject) != len(other.Subject) {
+		return false
+	}
+	for i := range r.Subject {
+		if !r.Subject[i].Equal(other.Subject[i]) {
+			return false
+		}
+	}
+	if (r.SymptomConditionEffect == nil) != (other.SymptomConditionEffect == nil) || r.SymptomConditionEffect != nil && !r.SymptomConditionEffect.Equal(*other.SymptomConditionEffect) {
+		return false
+	}
+	if (r.Classification == nil) != (other.Classification == nil) || r.Classification != nil && !r.Classification.Equal(*other.Classification) {
+		return false
+	}
+	if (r.FrequencyOfOccurrence == nil) != (other.FrequencyOfOccurrence == nil) || r.FrequencyOfOccurrence != nil && !r.FrequencyOfOccurrence.Equal(*other.FrequencyOfOccurrence) {
+		return false
+	}
+	if len(r.Population) != len(other.Population) {
+		return false
+	}
+	for i := range r.Population {
+		if !r.Population[i].Equal(other.Population[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MedicinalProductUndesirableEffect) EqualsDeep(other MedicinalProductUndesirableEffect) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Subject), len(other.Subject), func(i, j int) bool {
+		return r.Subject[i].EqualsDeep(other.Subject[j])
+	}) {
+		return false
+	}
+	if (r.SymptomConditionEffect == nil) != (other.SymptomConditionEffect == nil) || r.SymptomConditionEffect != nil && !r.SymptomConditionEffect.EqualsDeep(*other.SymptomConditionEffect) {
+		return false
+	}
+	if (r.Classification == nil) != (other.Classification == nil) || r.Classification != nil && !r.Classification.EqualsDeep(*other.Classification) {
+		return false
+	}
+	if (r.FrequencyOfOccurrence == nil) != (other.FrequencyOfOccurrence == nil) || r.FrequencyOfOccurrence != nil && !r.FrequencyOfOccurrence.EqualsDeep(*other.FrequencyOfOccurrence) {
+		return false
+	}
+	if !equivalentList(len(r.Population), len(other.Population), func(i, j int) bool {
+		return r.Population[i].EqualsDeep(other.Population[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MedicinalProductUndesirableEffect) EqualsShallow(other MedicinalProductUndesirableEffect) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MedicinalProductUndesirableEffect as FHIR XML
+func (r MedicinalProductUndesirableEffect) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MedicinalProductUndesirableEffect",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Subject {
+		if err := encodeXMLElement(e, "subject", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "symptomConditionEffect", r.SymptomConditionEffect); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "classification", r.Classification); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "frequencyOfOccurrence", r.FrequencyOfOccurrence); err != nil {
+		return err
+	}
+	for _, v := range r.Population {
+		if err := encodeXMLElement(e, "population", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MedicinalProductUndesirableEffect from FHIR XML
+func (r *MedicinalProductUndesirableEffect) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = append(r.Subject, v)
+			case "symptomConditionEffect":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SymptomConditionEffect = &v
+			case "classification":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Classification = &v
+			case "frequencyOfOccurrence":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FrequencyOfOccurrence = &v
+			case "population":
+				var v Population
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Population = append(r.Population, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MedicinalProductUndesirableEffect as properties to the RDF node n
+func (r MedicinalProductUndesirableEffect) turtle(n *turtleNode) {
+	n.resource("MedicinalProductUndesirableEffect", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Subject {
+		n.element("MedicinalProductUndesirableEffect.subject", i, v)
+	}
+	n.element("MedicinalProductUndesirableEffect.symptomConditionEffect", -1, r.SymptomConditionEffect)
+	n.element("MedicinalProductUndesirableEffect.classification", -1, r.Classification)
+	n.element("MedicinalProductUndesirableEffect.frequencyOfOccurrence", -1, r.FrequencyOfOccurrence)
+	for i, v := range r.Population {
+		n.element("MedicinalProductUndesirableEffect.population", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MedicinalProductUndesirableEffect as BSON document with the structure of its FHIR JSON
+func (r MedicinalProductUndesirableEffect) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MedicinalProductUndesirableEffect from a BSON document with the structure of its FHIR JSON
+func (r *MedicinalProductUndesirableEffect) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MedicinalProductUndesirableEffect as protobuf message MedicinalProductUndesirableEffect of fhir.proto
+func (r MedicinalProductUndesirableEffect) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MedicinalProductUndesirableEffect to the encoded message
+func (r MedicinalProductUndesirableEffect) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Subject {
+		e.message(9, v)
+	}
+	if r.SymptomConditionEffect != nil {
+		e.message(10, *r.SymptomConditionEffect)
+	}
+	if r.Classification != nil {
+		e.message(11, *r.Classification)
+	}
+	if r.FrequencyOfOccurrence != nil {
+		e.message(12, *r.FrequencyOfOccurrence)
+	}
+	for _, v := range r.Population {
+		e.message(13, v)
+	}
+	if r.IdElement != nil {
+		e.message(14, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(15, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(16, *r.LanguageElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MedicinalProductUndesirableEffect from protobuf message MedicinalProductUndesirableEffect of fhir.proto
+func (r *MedicinalProductUndesirableEffect) UnmarshalProto(b []byte) error {
+	*r = MedicinalProductUndesirableEffect{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Subject = append(r.Subject, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.SymptomConditionEffect = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Classification = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.FrequencyOfOccurrence = &v
+		case 13:
+			var v Population
+			d.message(&v)
+			r.Population = append(r.Population, v)
+		case 14:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMedicinalProductUndesirableEffect unmarshals a MedicinalProductUndesirableEffect.
 func UnmarshalMedicinalProductUndesirableEffect(b []byte) (MedicinalProductUndesirableEffect, error) {
 	var medicinalProductUndesirableEffect MedicinalProductUndesirableEffect
-	if err := json.Unmarshal(b, &medicinalProductUndesirableEffect); err != nil {
+	if err := medicinalProductUndesirableEffect.UnmarshalJSON(b); err != nil {
 		return medicinalProductUndesirableEffect, err
 	}
 	return medicinalProductUndesirableEffect, nil
@@ -14,79 +14,3306 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MessageDefinition is documented here http://hl7.org/fhir/StructureDefinition/MessageDefinition
 type MessageDefinition struct {
-	Id                *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string                            `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                            `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                            `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                            `bson:"title,omitempty" json:"title,omitempty"`
-	Replaces          []string                           `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	Status            PublicationStatus                  `bson:"status" json:"status"`
-	Experimental      *bool                              `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              string                             `bson:"date" json:"date"`
-	Publisher         *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                            `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                            `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Base              *string                            `bson:"base,omitempty" json:"base,omitempty"`
-	Parent            []string                           `bson:"parent,omitempty" json:"parent,omitempty"`
-	EventCoding       Coding                             `bson:"eventCoding" json:"eventCoding"`
-	EventUri          string                             `bson:"eventUri" json:"eventUri"`
-	Category          *MessageSignificanceCategory       `bson:"category,omitempty" json:"category,omitempty"`
-	Focus             []MessageDefinitionFocus           `bson:"focus,omitempty" json:"focus,omitempty"`
-	ResponseRequired  *string                            `bson:"responseRequired,omitempty" json:"responseRequired,omitempty"`
-	AllowedResponse   []MessageDefinitionAllowedResponse `bson:"allowedResponse,omitempty" json:"allowedResponse,omitempty"`
-	Graph             []string                           `bson:"graph,omitempty" json:"graph,omitempty"`
+	Id                      *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement               *Element                           `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                    *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               []json.RawMessage                  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                     *string                            `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement              *Element                           `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier              []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                 *string                            `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement          *Element                           `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                    *string                            `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement             *Element                           `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                   *string                            `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement            *Element                           `bson:"_title,omitempty" json:"_title,omitempty"`
+	Replaces                []string                           `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	ReplacesElement         []*Element                         `bson:"_replaces,omitempty" json:"_replaces,omitempty"`
+	Status                  PublicationStatus                  `bson:"status" json:"status"`
+	StatusElement           *Element                           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental            *bool                              `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement     *Element                           `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                    string                             `bson:"date" json:"date"`
+	DateElement             *Element                           `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher               *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement        *Element                           `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                 []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description             *string                            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                           `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext              []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction            []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                 *string                            `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement          *Element                           `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright               *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement        *Element                           `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Base                    *string                            `bson:"base,omitempty" json:"base,omitempty"`
+	BaseElement             *Element                           `bson:"_base,omitempty" json:"_base,omitempty"`
+	Parent                  []string                           `bson:"parent,omitempty" json:"parent,omitempty"`
+	ParentElement           []*Element                         `bson:"_parent,omitempty" json:"_parent,omitempty"`
+	EventCoding             *Coding                            `bson:"eventCoding,omitempty" json:"eventCoding,omitempty"`
+	EventUri                *string                            `bson:"eventUri,omitempty" json:"eventUri,omitempty"`
+	EventUriElement         *Element                           `bson:"_eventUri,omitempty" json:"_eventUri,omitempty"`
+	Category                *MessageSignificanceCategory       `bson:"category,omitempty" json:"category,omitempty"`
+	CategoryElement         *Element                           `bson:"_category,omitempty" json:"_category,omitempty"`
+	Focus                   []MessageDefinitionFocus           `bson:"focus,omitempty" json:"focus,omitempty"`
+	ResponseRequired        *string                            `bson:"responseRequired,omitempty" json:"responseRequired,omitempty"`
+	ResponseRequiredElement *Element                           `bson:"_responseRequired,omitempty" json:"_responseRequired,omitempty"`
+	AllowedResponse         []MessageDefinitionAllowedResponse `bson:"allowedResponse,omitempty" json:"allowedResponse,omitempty"`
+	Graph                   []string                           `bson:"graph,omitempty" json:"graph,omitempty"`
+	GraphElement            []*Element                         `bson:"_graph,omitempty" json:"_graph,omitempty"`
 }
+
+// MessageDefinitionEvent is implemented by the types allowed for MessageDefinition.event[x]
+type MessageDefinitionEvent interface {
+	isMessageDefinitionEvent()
+}
+
+func (Coding) isMessageDefinitionEvent() {}
+func (Uri) isMessageDefinitionEvent()    {}
+
+// Event returns the value of MessageDefinition.event[x] together with its FHIR type code or nil if no value is set
+func (r MessageDefinition) Event() (MessageDefinitionEvent, string) {
+	if r.EventCoding != nil {
+		return *r.EventCoding, "Coding"
+	}
+	if r.EventUri != nil {
+		return Uri(*r.EventUri), "uri"
+	}
+	return nil, ""
+}
+
+// SetEvent sets the value of MessageDefinition.event[x], given as value or pointer, and clears all other types
+func (r *MessageDefinition) SetEvent(value MessageDefinitionEvent) {
+	r.EventCoding = nil
+	r.EventUri = nil
+	switch v := value.(type) {
+	case Coding:
+		r.EventCoding = &v
+	case *Coding:
+		r.EventCoding = v
+	case Uri:
+		primitive := string(v)
+		r.EventUri = &primitive
+	case *Uri:
+		r.EventUri = (*string)(v)
+	}
+}
+
 type MessageDefinitionFocus struct {
 	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              ResourceType `bson:"code" json:"code"`
+	CodeElement       *Element     `bson:"_code,omitempty" json:"_code,omitempty"`
 	Profile           *string      `bson:"profile,omitempty" json:"profile,omitempty"`
+	ProfileElement    *Element     `bson:"_profile,omitempty" json:"_profile,omitempty"`
 	Min               int          `bson:"min" json:"min"`
+	MinElement        *Element     `bson:"_min,omitempty" json:"_min,omitempty"`
 	Max               *string      `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement        *Element     `bson:"_max,omitempty" json:"_max,omitempty"`
 }
 type MessageDefinitionAllowedResponse struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Message           string      `bson:"message" json:"message"`
+	MessageElement    *Element    `bson:"_message,omitempty" json:"_message,omitempty"`
 	Situation         *string     `bson:"situation,omitempty" json:"situation,omitempty"`
+	SituationElement  *Element    `bson:"_situation,omitempty" json:"_situation,omitempty"`
 }
-type OtherMessageDefinition MessageDefinition
 
 // MarshalJSON marshals the given MessageDefinition as JSON into a byte slice
 func (r MessageDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMessageDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMessageDefinition: OtherMessageDefinition(r),
-		ResourceType:           "MessageDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MessageDefinition as JSON object with the members in the order of the fields
+func (r MessageDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MessageDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if len(r.Replaces) > 0 {
+		e.key("replaces")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Replaces {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReplacesElement) > 0 {
+		e.key("_replaces")
+		e.elements(r.ReplacesElement, len(r.Replaces))
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	e.key("date")
+	e.string(r.Date)
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.Base != nil {
+		e.key("base")
+		e.string(*r.Base)
+	}
+	if r.BaseElement != nil {
+		e.key("_base")
+		r.BaseElement.appendJSON(e)
+	}
+	if len(r.Parent) > 0 {
+		e.key("parent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parent {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ParentElement) > 0 {
+		e.key("_parent")
+		e.elements(r.ParentElement, len(r.Parent))
+	}
+	if r.EventCoding != nil {
+		e.key("eventCoding")
+		r.EventCoding.appendJSON(e)
+	}
+	if r.EventUri != nil {
+		e.key("eventUri")
+		e.string(*r.EventUri)
+	}
+	if r.EventUriElement != nil {
+		e.key("_eventUri")
+		r.EventUriElement.appendJSON(e)
+	}
+	if r.Category != nil {
+		e.key("category")
+		e.string(r.Category.Code())
+	}
+	if r.CategoryElement != nil {
+		e.key("_category")
+		r.CategoryElement.appendJSON(e)
+	}
+	if len(r.Focus) > 0 {
+		e.key("focus")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Focus {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ResponseRequired != nil {
+		e.key("responseRequired")
+		e.string(*r.ResponseRequired)
+	}
+	if r.ResponseRequiredElement != nil {
+		e.key("_responseRequired")
+		r.ResponseRequiredElement.appendJSON(e)
+	}
+	if len(r.AllowedResponse) > 0 {
+		e.key("allowedResponse")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AllowedResponse {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Graph) > 0 {
+		e.key("graph")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Graph {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.GraphElement) > 0 {
+		e.key("_graph")
+		e.elements(r.GraphElement, len(r.Graph))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MessageDefinition from JSON. Unknown members are ignored.
+func (r *MessageDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MessageDefinition
+func (r *MessageDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "replaces":
+			a := d.array()
+			r.Replaces = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Replaces = append(r.Replaces, v)
+			}
+			if r.Replaces == nil && !a.isNull {
+				r.Replaces = []string{}
+			}
+		case "_replaces":
+			a := d.array()
+			r.ReplacesElement = nil
+			for a.next() {
+				r.ReplacesElement = append(r.ReplacesElement, d.element())
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = v
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "base":
+			if v, ok := d.string(); ok {
+				r.Base = &v
+			} else {
+				r.Base = nil
+			}
+		case "_base":
+			r.BaseElement = d.element()
+		case "parent":
+			a := d.array()
+			r.Parent = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Parent = append(r.Parent, v)
+			}
+			if r.Parent == nil && !a.isNull {
+				r.Parent = []string{}
+			}
+		case "_parent":
+			a := d.array()
+			r.ParentElement = nil
+			for a.next() {
+				r.ParentElement = append(r.ParentElement, d.element())
+			}
+		case "eventCoding":
+			if d.null() {
+				r.EventCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.EventCoding = &v
+			}
+		case "eventUri":
+			if v, ok := d.string(); ok {
+				r.EventUri = &v
+			} else {
+				r.EventUri = nil
+			}
+		case "_eventUri":
+			r.EventUriElement = d.element()
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v MessageSignificanceCategory
+				d.unmarshal(&v)
+				r.Category = &v
+			}
+		case "_category":
+			r.CategoryElement = d.element()
+		case "focus":
+			a := d.array()
+			r.Focus = nil
+			for a.next() {
+				var v MessageDefinitionFocus
+				v.decodeJSON(d)
+				r.Focus = append(r.Focus, v)
+			}
+			if r.Focus == nil && !a.isNull {
+				r.Focus = []MessageDefinitionFocus{}
+			}
+		case "responseRequired":
+			if v, ok := d.string(); ok {
+				r.ResponseRequired = &v
+			} else {
+				r.ResponseRequired = nil
+			}
+		case "_responseRequired":
+			r.ResponseRequiredElement = d.element()
+		case "allowedResponse":
+			a := d.array()
+			r.AllowedResponse = nil
+			for a.next() {
+				var v MessageDefinitionAllowedResponse
+				v.decodeJSON(d)
+				r.AllowedResponse = append(r.AllowedResponse, v)
+			}
+			if r.AllowedResponse == nil && !a.isNull {
+				r.AllowedResponse = []MessageDefinitionAllowedResponse{}
+			}
+		case "graph":
+			a := d.array()
+			r.Graph = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Graph = append(r.Graph, v)
+			}
+			if r.Graph == nil && !a.isNull {
+				r.Graph = []string{}
+			}
+		case "_graph":
+			a := d.array()
+			r.GraphElement = nil
+			for a.next() {
+				r.GraphElement = append(r.GraphElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MessageDefinition which shares no memory with the original
+func (r MessageDefinition) DeepCopy() MessageDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Replaces != nil {
+		out.Replaces = make([]string, len(r.Replaces))
+		copy(out.Replaces, r.Replaces)
+	}
+	if r.ReplacesElement != nil {
+		out.ReplacesElement = make([]*Element, len(r.ReplacesElement))
+		for i, v := range r.ReplacesElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ReplacesElement[i] = &c
+			}
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.Base != nil {
+		v := *r.Base
+		out.Base = &v
+	}
+	if r.BaseElement != nil {
+		v := r.BaseElement.DeepCopy()
+		out.BaseElement = &v
+	}
+	if r.Parent != nil {
+		out.Parent = make([]string, len(r.Parent))
+		copy(out.Parent, r.Parent)
+	}
+	if r.ParentElement != nil {
+		out.ParentElement = make([]*Element, len(r.ParentElement))
+		for i, v := range r.ParentElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ParentElement[i] = &c
+			}
+		}
+	}
+	if r.EventCoding != nil {
+		v := r.EventCoding.DeepCopy()
+		out.EventCoding = &v
+	}
+	if r.EventUri != nil {
+		v := *r.EventUri
+		out.EventUri = &v
+	}
+	if r.EventUriElement != nil {
+		v := r.EventUriElement.DeepCopy()
+		out.EventUriElement = &v
+	}
+	if r.Category != nil {
+		v := *r.Category
+		out.Category = &v
+	}
+	if r.CategoryElement != nil {
+		v := r.CategoryElement.DeepCopy()
+		out.CategoryElement = &v
+	}
+	if r.Focus != nil {
+		out.Focus = make([]MessageDefinitionFocus, len(r.Focus))
+		for i := range r.Focus {
+			out.Focus[i] = r.Focus[i].DeepCopy()
+		}
+	}
+	if r.ResponseRequired != nil {
+		v := *r.ResponseRequired
+		out.ResponseRequired = &v
+	}
+	if r.ResponseRequiredElement != nil {
+		v := r.ResponseRequiredElement.DeepCopy()
+		out.ResponseRequiredElement = &v
+	}
+	if r.AllowedResponse != nil {
+		out.AllowedResponse = make([]MessageDefinitionAllowedResponse, len(r.AllowedResponse))
+		for i := range r.AllowedResponse {
+			out.AllowedResponse[i] = r.AllowedResponse[i].DeepCopy()
+		}
+	}
+	if r.Graph != nil {
+		out.Graph = make([]string, len(r.Graph))
+		copy(out.Graph, r.Graph)
+	}
+	if r.GraphElement != nil {
+		out.GraphElement = make([]*Element, len(r.GraphElement))
+		for i, v := range r.GraphElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.GraphElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MessageDefinition) Equal(other MessageDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if len(r.Replaces) != len(other.Replaces) {
+		return false
+	}
+	for i := range r.Replaces {
+		if r.Replaces[i] != other.Replaces[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ReplacesElement, other.ReplacesElement, false) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if r.Date != other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.Base == nil) != (other.Base == nil) || r.Base != nil && *r.Base != *other.Base {
+		return false
+	}
+	if (r.BaseElement == nil) != (other.BaseElement == nil) || r.BaseElement != nil && !r.BaseElement.Equal(*other.BaseElement) {
+		return false
+	}
+	if len(r.Parent) != len(other.Parent) {
+		return false
+	}
+	for i := range r.Parent {
+		if r.Parent[i] != other.Parent[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ParentElement, other.ParentElement, false) {
+		return false
+	}
+	if (r.EventCoding == nil) != (other.EventCoding == nil) || r.EventCoding != nil && !r.EventCoding.Equal(*other.EventCoding) {
+		return false
+	}
+	if (r.EventUri == nil) != (other.EventUri == nil) || r.EventUri != nil && *r.EventUri != *other.EventUri {
+		return false
+	}
+	if (r.EventUriElement == nil) != (other.EventUriElement == nil) || r.EventUriElement != nil && !r.EventUriElement.Equal(*other.EventUriElement) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && *r.Category != *other.Category {
+		return false
+	}
+	if (r.CategoryElement == nil) != (other.CategoryElement == nil) || r.CategoryElement != nil && !r.CategoryElement.Equal(*other.CategoryElement) {
+		return false
+	}
+	if len(r.Focus) != len(other.Focus) {
+		return false
+	}
+	for i := range r.Focus {
+		if !r.Focus[i].Equal(other.Focus[i]) {
+			return false
+		}
+	}
+	if (r.ResponseRequired == nil) != (other.ResponseRequired == nil) || r.ResponseRequired != nil && *r.ResponseRequired != *other.ResponseRequired {
+		return false
+	}
+	if (r.ResponseRequiredElement == nil) != (other.ResponseRequiredElement == nil) || r.ResponseRequiredElement != nil && !r.ResponseRequiredElement.Equal(*other.ResponseRequiredElement) {
+		return false
+	}
+	if len(r.AllowedResponse) != len(other.AllowedResponse) {
+		return false
+	}
+	for i := range r.AllowedResponse {
+		if !r.AllowedResponse[i].Equal(other.AllowedResponse[i]) {
+			return false
+		}
+	}
+	if len(r.Graph) != len(other.Graph) {
+		return false
+	}
+	for i := range r.Graph {
+		if r.Graph[i] != other.Graph[i] {
+			return false
+		}
+	}
+	if !equalElements(r.GraphElement, other.GraphElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MessageDefinition) EqualsDeep(other MessageDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if !equivalentList(len(r.Replaces), len(other.Replaces), func(i, j int) bool {
+		return equivalentString(r.Replaces[i], other.Replaces[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ReplacesElement, other.ReplacesElement, true) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.Base == nil) != (other.Base == nil) || r.Base != nil && !equivalentString(*r.Base, *other.Base) {
+		return false
+	}
+	if (r.BaseElement == nil) != (other.BaseElement == nil) || r.BaseElement != nil && !r.BaseElement.EqualsDeep(*other.BaseElement) {
+		return false
+	}
+	if !equivalentList(len(r.Parent), len(other.Parent), func(i, j int) bool {
+		return equivalentString(r.Parent[i], other.Parent[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ParentElement, other.ParentElement, true) {
+		return false
+	}
+	if (r.EventCoding == nil) != (other.EventCoding == nil) || r.EventCoding != nil && !r.EventCoding.EqualsDeep(*other.EventCoding) {
+		return false
+	}
+	if (r.EventUri == nil) != (other.EventUri == nil) || r.EventUri != nil && !equivalentString(*r.EventUri, *other.EventUri) {
+		return false
+	}
+	if (r.EventUriElement == nil) != (other.EventUriElement == nil) || r.EventUriElement != nil && !r.EventUriElement.EqualsDeep(*other.EventUriElement) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && *r.Category != *other.Category {
+		return false
+	}
+	if (r.CategoryElement == nil) != (other.CategoryElement == nil) || r.CategoryElement != nil && !r.CategoryElement.EqualsDeep(*other.CategoryElement) {
+		return false
+	}
+	if !equivalentList(len(r.Focus), len(other.Focus), func(i, j int) bool {
+		return r.Focus[i].EqualsDeep(other.Focus[j])
+	}) {
+		return false
+	}
+	if (r.ResponseRequired == nil) != (other.ResponseRequired == nil) || r.ResponseRequired != nil && !equivalentString(*r.ResponseRequired, *other.ResponseRequired) {
+		return false
+	}
+	if (r.ResponseRequiredElement == nil) != (other.ResponseRequiredElement == nil) || r.ResponseRequiredElement != nil && !r.ResponseRequiredElement.EqualsDeep(*other.ResponseRequiredElement) {
+		return false
+	}
+	if !equivalentList(len(r.AllowedResponse), len(other.AllowedResponse), func(i, j int) bool {
+		return r.AllowedResponse[i].EqualsDeep(other.AllowedResponse[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Graph), len(other.Graph), func(i, j int) bool {
+		return equivalentString(r.Graph[i], other.Graph[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.GraphElement, other.GraphElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MessageDefinition) EqualsShallow(other MessageDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if !equivalentList(len(r.Replaces), len(other.Replaces), func(i, j int) bool {
+		return equivalentString(r.Replaces[i], other.Replaces[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.Base == nil) != (other.Base == nil) || r.Base != nil && !equivalentString(*r.Base, *other.Base) {
+		return false
+	}
+	if !equivalentList(len(r.Parent), len(other.Parent), func(i, j int) bool {
+		return equivalentString(r.Parent[i], other.Parent[j])
+	}) {
+		return false
+	}
+	if (r.EventUri == nil) != (other.EventUri == nil) || r.EventUri != nil && !equivalentString(*r.EventUri, *other.EventUri) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && *r.Category != *other.Category {
+		return false
+	}
+	if (r.ResponseRequired == nil) != (other.ResponseRequired == nil) || r.ResponseRequired != nil && !equivalentString(*r.ResponseRequired, *other.ResponseRequired) {
+		return false
+	}
+	if !equivalentList(len(r.Graph), len(other.Graph), func(i, j int) bool {
+		return equivalentString(r.Graph[i], other.Graph[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MessageDefinition as FHIR XML
+func (r MessageDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MessageDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	for i, v := range r.Replaces {
+		if err := encodeXMLPrimitive(e, "replaces", v, elementAt(r.ReplacesElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "base", r.Base, r.BaseElement); err != nil {
+		return err
+	}
+	for i, v := range r.Parent {
+		if err := encodeXMLPrimitive(e, "parent", v, elementAt(r.ParentElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "eventCoding", r.EventCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "eventUri", r.EventUri, r.EventUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "category", r.Category, r.CategoryElement); err != nil {
+		return err
+	}
+	for _, v := range r.Focus {
+		if err := encodeXMLElement(e, "focus", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "responseRequired", r.ResponseRequired, r.ResponseRequiredElement); err != nil {
+		return err
+	}
+	for _, v := range r.AllowedResponse {
+		if err := encodeXMLElement(e, "allowedResponse", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Graph {
+		if err := encodeXMLPrimitive(e, "graph", v, elementAt(r.GraphElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MessageDefinition from FHIR XML
+func (r *MessageDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "replaces":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ReplacesElement = appendElement(r.ReplacesElement, len(r.Replaces), element)
+					r.Replaces = append(r.Replaces, v)
+				}
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "base":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Base = &v
+				}
+				r.BaseElement = element
+			case "parent":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ParentElement = appendElement(r.ParentElement, len(r.Parent), element)
+					r.Parent = append(r.Parent, v)
+				}
+			case "eventCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EventCoding = &v
+			case "eventUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EventUri = &v
+				}
+				r.EventUriElement = element
+			case "category":
+				var v MessageSignificanceCategory
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Category = &v
+				}
+				r.CategoryElement = element
+			case "focus":
+				var v MessageDefinitionFocus
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Focus = append(r.Focus, v)
+			case "responseRequired":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ResponseRequired = &v
+				}
+				r.ResponseRequiredElement = element
+			case "allowedResponse":
+				var v MessageDefinitionAllowedResponse
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AllowedResponse = append(r.AllowedResponse, v)
+			case "graph":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.GraphElement = appendElement(r.GraphElement, len(r.Graph), element)
+					r.Graph = append(r.Graph, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MessageDefinition as properties to the RDF node n
+func (r MessageDefinition) turtle(n *turtleNode) {
+	n.resource("MessageDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("MessageDefinition.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("MessageDefinition.identifier", i, v)
+	}
+	n.primitive("MessageDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("MessageDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("MessageDefinition.title", -1, r.Title, r.TitleElement, "string")
+	for i, v := range r.Replaces {
+		n.primitive("MessageDefinition.replaces", i, v, elementAt(r.ReplacesElement, i), "string")
+	}
+	n.primitive("MessageDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("MessageDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("MessageDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("MessageDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("MessageDefinition.contact", i, v)
+	}
+	n.primitive("MessageDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("MessageDefinition.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("MessageDefinition.jurisdiction", i, v)
+	}
+	n.primitive("MessageDefinition.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("MessageDefinition.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("MessageDefinition.base", -1, r.Base, r.BaseElement, "string")
+	for i, v := range r.Parent {
+		n.primitive("MessageDefinition.parent", i, v, elementAt(r.ParentElement, i), "string")
+	}
+	n.element("MessageDefinition.eventCoding", -1, r.EventCoding)
+	n.primitive("MessageDefinition.eventUri", -1, r.EventUri, r.EventUriElement, "uri")
+	n.primitive("MessageDefinition.category", -1, r.Category, r.CategoryElement, "code")
+	for i, v := range r.Focus {
+		n.element("MessageDefinition.focus", i, v)
+	}
+	n.primitive("MessageDefinition.responseRequired", -1, r.ResponseRequired, r.ResponseRequiredElement, "string")
+	for i, v := range r.AllowedResponse {
+		n.element("MessageDefinition.allowedResponse", i, v)
+	}
+	for i, v := range r.Graph {
+		n.primitive("MessageDefinition.graph", i, v, elementAt(r.GraphElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given MessageDefinition as BSON document with the structure of its FHIR JSON
+func (r MessageDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MessageDefinition from a BSON document with the structure of its FHIR JSON
+func (r *MessageDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MessageDefinition as protobuf message MessageDefinition of fhir.proto
+func (r MessageDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MessageDefinition to the encoded message
+func (r MessageDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	for _, v := range r.Replaces {
+		e.string(14, v)
+	}
+	e.varint(15, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(16, protoBool(*r.Experimental))
+	}
+	if r.Date != "" {
+		e.string(17, r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(18, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(19, v)
+	}
+	if r.Description != nil {
+		e.string(20, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(21, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(22, v)
+	}
+	if r.Purpose != nil {
+		e.string(23, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(24, *r.Copyright)
+	}
+	if r.Base != nil {
+		e.string(25, *r.Base)
+	}
+	for _, v := range r.Parent {
+		e.string(26, v)
+	}
+	if r.EventCoding != nil {
+		e.message(27, *r.EventCoding)
+	}
+	if r.EventUri != nil {
+		e.string(28, *r.EventUri)
+	}
+	if r.Category != nil {
+		e.varint(29, r.Category.protoNumber())
+	}
+	for _, v := range r.Focus {
+		e.message(30, v)
+	}
+	if r.ResponseRequired != nil {
+		e.string(31, *r.ResponseRequired)
+	}
+	for _, v := range r.AllowedResponse {
+		e.message(32, v)
+	}
+	for _, v := range r.Graph {
+		e.string(33, v)
+	}
+	if r.IdElement != nil {
+		e.message(34, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(35, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(36, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(37, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(38, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(39, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(40, *r.TitleElement)
+	}
+	for _, v := range r.ReplacesElement {
+		e.element(41, v)
+	}
+	if r.StatusElement != nil {
+		e.message(42, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(43, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(44, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(45, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(46, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(47, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(48, *r.CopyrightElement)
+	}
+	if r.BaseElement != nil {
+		e.message(49, *r.BaseElement)
+	}
+	for _, v := range r.ParentElement {
+		e.element(50, v)
+	}
+	if r.EventUriElement != nil {
+		e.message(51, *r.EventUriElement)
+	}
+	if r.CategoryElement != nil {
+		e.message(52, *r.CategoryElement)
+	}
+	if r.ResponseRequiredElement != nil {
+		e.message(53, *r.ResponseRequiredElement)
+	}
+	for _, v := range r.GraphElement {
+		e.element(54, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MessageDefinition from protobuf message MessageDefinition of fhir.proto
+func (r *MessageDefinition) UnmarshalProto(b []byte) error {
+	*r = MessageDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			r.Replaces = append(r.Replaces, d.string())
+		case 15:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 16:
+			v := d.bool()
+			r.Experimental = &v
+		case 17:
+			r.Date = d.string()
+		case 18:
+			v := d.string()
+			r.Publisher = &v
+		case 19:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 20:
+			v := d.string()
+			r.Description = &v
+		case 21:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 23:
+			v := d.string()
+			r.Purpose = &v
+		case 24:
+			v := d.string()
+			r.Copyright = &v
+		case 25:
+			v := d.string()
+			r.Base = &v
+		case 26:
+			r.Parent = append(r.Parent, d.string())
+		case 27:
+			var v Coding
+			d.message(&v)
+			r.EventCoding = &v
+		case 28:
+			v := d.string()
+			r.EventUri = &v
+		case 29:
+			var v MessageSignificanceCategory
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Category = &v
+		case 30:
+			var v MessageDefinitionFocus
+			d.message(&v)
+			r.Focus = append(r.Focus, v)
+		case 31:
+			v := d.string()
+			r.ResponseRequired = &v
+		case 32:
+			var v MessageDefinitionAllowedResponse
+			d.message(&v)
+			r.AllowedResponse = append(r.AllowedResponse, v)
+		case 33:
+			r.Graph = append(r.Graph, d.string())
+		case 34:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 41:
+			r.ReplacesElement = append(r.ReplacesElement, d.element())
+		case 42:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.BaseElement = &v
+		case 50:
+			r.ParentElement = append(r.ParentElement, d.element())
+		case 51:
+			var v Element
+			d.message(&v)
+			r.EventUriElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.CategoryElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.ResponseRequiredElement = &v
+		case 54:
+			r.GraphElement = append(r.GraphElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MessageDefinitionFocus as JSON into a byte slice
+func (r MessageDefinitionFocus) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MessageDefinitionFocus as JSON object with the members in the order of the fields
+func (r MessageDefinitionFocus) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Profile != nil {
+		e.key("profile")
+		e.string(*r.Profile)
+	}
+	if r.ProfileElement != nil {
+		e.key("_profile")
+		r.ProfileElement.appendJSON(e)
+	}
+	e.key("min")
+	e.int(r.Min)
+	if r.MinElement != nil {
+		e.key("_min")
+		r.MinElement.appendJSON(e)
+	}
+	if r.Max != nil {
+		e.key("max")
+		e.string(*r.Max)
+	}
+	if r.MaxElement != nil {
+		e.key("_max")
+		r.MaxElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MessageDefinitionFocus from JSON. Unknown members are ignored.
+func (r *MessageDefinitionFocus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MessageDefinitionFocus
+func (r *MessageDefinitionFocus) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "profile":
+			if v, ok := d.string(); ok {
+				r.Profile = &v
+			} else {
+				r.Profile = nil
+			}
+		case "_profile":
+			r.ProfileElement = d.element()
+		case "min":
+			if v, ok := d.int(); ok {
+				r.Min = v
+			}
+		case "_min":
+			r.MinElement = d.element()
+		case "max":
+			if v, ok := d.string(); ok {
+				r.Max = &v
+			} else {
+				r.Max = nil
+			}
+		case "_max":
+			r.MaxElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MessageDefinitionFocus which shares no memory with the original
+func (r MessageDefinitionFocus) DeepCopy() MessageDefinitionFocus {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Profile != nil {
+		v := *r.Profile
+		out.Profile = &v
+	}
+	if r.ProfileElement != nil {
+		v := r.ProfileElement.DeepCopy()
+		out.ProfileElement = &v
+	}
+	if r.MinElement != nil {
+		v := r.MinElement.DeepCopy()
+		out.MinElement = &v
+	}
+	if r.Max != nil {
+		v := *r.Max
+		out.Max = &v
+	}
+	if r.MaxElement != nil {
+		v := r.MaxElement.DeepCopy()
+		out.MaxElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MessageDefinitionFocus) Equal(other MessageDefinitionFocus) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && *r.Profile != *other.Profile {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.Equal(*other.ProfileElement) {
+		return false
+	}
+	if r.Min != other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.Equal(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && *r.Max != *other.Max {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.Equal(*other.MaxElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MessageDefinitionFocus) EqualsDeep(other MessageDefinitionFocus) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	if (r.ProfileElement == nil) != (other.ProfileElement == nil) || r.ProfileElement != nil && !r.ProfileElement.EqualsDeep(*other.ProfileElement) {
+		return false
+	}
+	if r.Min != other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.EqualsDeep(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.EqualsDeep(*other.MaxElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MessageDefinitionFocus) EqualsShallow(other MessageDefinitionFocus) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.Profile == nil) != (other.Profile == nil) || r.Profile != nil && !equivalentString(*r.Profile, *other.Profile) {
+		return false
+	}
+	if r.Min != other.Min {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MessageDefinitionFocus as FHIR XML
+func (r MessageDefinitionFocus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "profile", r.Profile, r.ProfileElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "min", r.Min, r.MinElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "max", r.Max, r.MaxElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MessageDefinitionFocus from FHIR XML
+func (r *MessageDefinitionFocus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "profile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Profile = &v
+				}
+				r.ProfileElement = element
+			case "min":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Min = v
+				}
+				r.MinElement = element
+			case "max":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Max = &v
+				}
+				r.MaxElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MessageDefinitionFocus as properties to the RDF node n
+func (r MessageDefinitionFocus) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MessageDefinition.focus.code", -1, r.Code, r.CodeElement, "code")
+	n.primitive("MessageDefinition.focus.profile", -1, r.Profile, r.ProfileElement, "string")
+	n.primitive("MessageDefinition.focus.min", -1, r.Min, r.MinElement, "unsignedInt")
+	n.primitive("MessageDefinition.focus.max", -1, r.Max, r.MaxElement, "string")
+}
+
+// MarshalBSON marshals the given MessageDefinitionFocus as BSON document with the structure of its FHIR JSON
+func (r MessageDefinitionFocus) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MessageDefinitionFocus from a BSON document with the structure of its FHIR JSON
+func (r *MessageDefinitionFocus) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MessageDefinitionFocus as protobuf message MessageDefinitionFocus of fhir.proto
+func (r MessageDefinitionFocus) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MessageDefinitionFocus to the encoded message
+func (r MessageDefinitionFocus) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Code.protoNumber())
+	if r.Profile != nil {
+		e.string(5, *r.Profile)
+	}
+	if r.Min != 0 {
+		e.varint(6, uint64(int64(r.Min)))
+	}
+	if r.Max != nil {
+		e.string(7, *r.Max)
+	}
+	if r.CodeElement != nil {
+		e.message(8, *r.CodeElement)
+	}
+	if r.ProfileElement != nil {
+		e.message(9, *r.ProfileElement)
+	}
+	if r.MinElement != nil {
+		e.message(10, *r.MinElement)
+	}
+	if r.MaxElement != nil {
+		e.message(11, *r.MaxElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MessageDefinitionFocus from protobuf message MessageDefinitionFocus of fhir.proto
+func (r *MessageDefinitionFocus) UnmarshalProto(b []byte) error {
+	*r = MessageDefinitionFocus{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ResourceType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 5:
+			v := d.string()
+			r.Profile = &v
+		case 6:
+			r.Min = d.int()
+		case 7:
+			v := d.string()
+			r.Max = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ProfileElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.MinElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.MaxElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MessageDefinitionAllowedResponse as JSON into a byte slice
+func (r MessageDefinitionAllowedResponse) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MessageDefinitionAllowedResponse as JSON object with the members in the order of the fields
+func (r MessageDefinitionAllowedResponse) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("message")
+	e.string(r.Message)
+	if r.MessageElement != nil {
+		e.key("_message")
+		r.MessageElement.appendJSON(e)
+	}
+	if r.Situation != nil {
+		e.key("situation")
+		e.string(*r.Situation)
+	}
+	if r.SituationElement != nil {
+		e.key("_situation")
+		r.SituationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MessageDefinitionAllowedResponse from JSON. Unknown members are ignored.
+func (r *MessageDefinitionAllowedResponse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MessageDefinitionAllowedResponse
+func (r *MessageDefinitionAllowedResponse) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "message":
+			if v, ok := d.string(); ok {
+				r.Message = v
+			}
+		case "_message":
+			r.MessageElement = d.element()
+		case "situation":
+			if v, ok := d.string(); ok {
+				r.Situation = &v
+			} else {
+				r.Situation = nil
+			}
+		case "_situation":
+			r.SituationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MessageDefinitionAllowedResponse which shares no memory with the original
+func (r MessageDefinitionAllowedResponse) DeepCopy() MessageDefinitionAllowedResponse {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.MessageElement != nil {
+		v := r.MessageElement.DeepCopy()
+		out.MessageElement = &v
+	}
+	if r.Situation != nil {
+		v := *r.Situation
+		out.Situation = &v
+	}
+	if r.SituationElement != nil {
+		v := r.SituationElement.DeepCopy()
+		out.SituationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MessageDefinitionAllowedResponse) Equal(other MessageDefinitionAllowedResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Message != other.Message {
+		return false
+	}
+	if (r.MessageElement == nil) != (other.MessageElement == nil) || r.MessageElement != nil && !r.MessageElement.Equal(*other.MessageElement) {
+		return false
+	}
+	if (r.Situation == nil) != (other.Situation == nil) || r.Situation != nil && *r.Situation != *other.Situation {
+		return false
+	}
+	if (r.SituationElement == nil) != (other.SituationElement == nil) || r.SituationElement != nil && !r.SituationElement.Equal(*other.SituationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MessageDefinitionAllowedResponse) EqualsDeep(other MessageDefinitionAllowedResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Message, other.Message) {
+		return false
+	}
+	if (r.MessageElement == nil) != (other.MessageElement == nil) || r.MessageElement != nil && !r.MessageElement.EqualsDeep(*other.MessageElement) {
+		return false
+	}
+	if (r.Situation == nil) != (other.Situation == nil) || r.Situation != nil && !equivalentString(*r.Situation, *other.Situation) {
+		return false
+	}
+	if (r.SituationElement == nil) != (other.SituationElement == nil) || r.SituationElement != nil && !r.SituationElement.EqualsDeep(*other.SituationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MessageDefinitionAllowedResponse) EqualsShallow(other MessageDefinitionAllowedResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Message, other.Message) {
+		return false
+	}
+	if (r.Situation == nil) != (other.Situation == nil) || r.Situation != nil && !equivalentString(*r.Situation, *other.Situation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MessageDefinitionAllowedResponse as FHIR XML
+func (r MessageDefinitionAllowedResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "message", r.Message, r.MessageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "situation", r.Situation, r.SituationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MessageDefinitionAllowedResponse from FHIR XML
+func (r *MessageDefinitionAllowedResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "message":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Message = v
+				}
+				r.MessageElement = element
+			case "situation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Situation = &v
+				}
+				r.SituationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MessageDefinitionAllowedResponse as properties to the RDF node n
+func (r MessageDefinitionAllowedResponse) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MessageDefinition.allowedResponse.message", -1, r.Message, r.MessageElement, "string")
+	n.primitive("MessageDefinition.allowedResponse.situation", -1, r.Situation, r.SituationElement, "string")
+}
+
+// MarshalBSON marshals the given MessageDefinitionAllowedResponse as BSON document with the structure of its FHIR JSON
+func (r MessageDefinitionAllowedResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MessageDefinitionAllowedResponse from a BSON document with the structure of its FHIR JSON
+func (r *MessageDefinitionAllowedResponse) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MessageDefinitionAllowedResponse as protobuf message MessageDefinitionAllowedResponse of fhir.proto
+func (r MessageDefinitionAllowedResponse) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MessageDefinitionAllowedResponse to the encoded message
+func (r MessageDefinitionAllowedResponse) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Message != "" {
+		e.string(4, r.Message)
+	}
+	if r.Situation != nil {
+		e.string(5, *r.Situation)
+	}
+	if r.MessageElement != nil {
+		e.message(6, *r.MessageElement)
+	}
+	if r.SituationElement != nil {
+		e.message(7, *r.SituationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MessageDefinitionAllowedResponse from protobuf message MessageDefinitionAllowedResponse of fhir.proto
+func (r *MessageDefinitionAllowedResponse) UnmarshalProto(b []byte) error {
+	*r = MessageDefinitionAllowedResponse{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Message = d.string()
+		case 5:
+			v := d.string()
+			r.Situation = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.MessageElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.SituationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMessageDefinition unmarshals a MessageDefinition.
 func UnmarshalMessageDefinition(b []byte) (MessageDefinition, error) {
 	var messageDefinition MessageDefinition
-	if err := json.Unmarshal(b, &messageDefinition); err != nil {
+	if err := messageDefinition.UnmarshalJSON(b); err != nil {
 		return messageDefinition, err
 	}
 	return messageDefinition, nil
@@ -14,40 +14,88 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MessageHeader is documented here http://hl7.org/fhir/StructureDefinition/MessageHeader
 type MessageHeader struct {
-	Id                *string                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	EventCoding       Coding                     `bson:"eventCoding" json:"eventCoding"`
-	EventUri          string                     `bson:"eventUri" json:"eventUri"`
-	Destination       []MessageHeaderDestination `bson:"destination,omitempty" json:"destination,omitempty"`
-	Sender            *Reference                 `bson:"sender,omitempty" json:"sender,omitempty"`
-	Enterer           *Reference                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
-	Author            *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
-	Source            MessageHeaderSource        `bson:"source" json:"source"`
-	Responsible       *Reference                 `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Reason            *CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
-	Response          *MessageHeaderResponse     `bson:"response,omitempty" json:"response,omitempty"`
-	Focus             []Reference                `bson:"focus,omitempty" json:"focus,omitempty"`
-	Definition        *string                    `bson:"definition,omitempty" json:"definition,omitempty"`
+	Id                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                   `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	EventCoding          *Coding                    `bson:"eventCoding,omitempty" json:"eventCoding,omitempty"`
+	EventUri             *string                    `bson:"eventUri,omitempty" json:"eventUri,omitempty"`
+	EventUriElement      *Element                   `bson:"_eventUri,omitempty" json:"_eventUri,omitempty"`
+	Destination          []MessageHeaderDestination `bson:"destination,omitempty" json:"destination,omitempty"`
+	Sender               *Reference                 `bson:"sender,omitempty" json:"sender,omitempty"`
+	Enterer              *Reference                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
+	Author               *Reference                 `bson:"author,omitempty" json:"author,omitempty"`
+	Source               MessageHeaderSource        `bson:"source" json:"source"`
+	Responsible          *Reference                 `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	Reason               *CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
+	Response             *MessageHeaderResponse     `bson:"response,omitempty" json:"response,omitempty"`
+	Focus                []Reference                `bson:"focus,omitempty" json:"focus,omitempty"`
+	Definition           *string                    `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement    *Element                   `bson:"_definition,omitempty" json:"_definition,omitempty"`
 }
+
+// MessageHeaderEvent is implemented by the types allowed for MessageHeader.event[x]
+type MessageHeaderEvent interface {
+	isMessageHeaderEvent()
+}
+
+func (Coding) isMessageHeaderEvent() {}
+func (Uri) isMessageHeaderEvent()    {}
+
+// Event returns the value of MessageHeader.event[x] together with its FHIR type code or nil if no value is set
+func (r MessageHeader) Event() (MessageHeaderEvent, string) {
+	if r.EventCoding != nil {
+		return *r.EventCoding, "Coding"
+	}
+	if r.EventUri != nil {
+		return Uri(*r.EventUri), "uri"
+	}
+	return nil, ""
+}
+
+// SetEvent sets the value of MessageHeader.event[x], given as value or pointer, and clears all other types
+func (r *MessageHeader) SetEvent(value MessageHeaderEvent) {
+	r.EventCoding = nil
+	r.EventUri = nil
+	switch v := value.(type) {
+	case Coding:
+		r.EventCoding = &v
+	case *Coding:
+		r.EventCoding = v
+	case Uri:
+		primitive := string(v)
+		r.EventUri = &primitive
+	case *Uri:
+		r.EventUri = (*string)(v)
+	}
+}
+
 type MessageHeaderDestination struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
 	Target            *Reference  `bson:"target,omitempty" json:"target,omitempty"`
 	Endpoint          string      `bson:"endpoint" json:"endpoint"`
+	EndpointElement   *Element    `bson:"_endpoint,omitempty" json:"_endpoint,omitempty"`
 	Receiver          *Reference  `bson:"receiver,omitempty" json:"receiver,omitempty"`
 }
 type MessageHeaderSource struct {
@@ -55,36 +103,2662 @@ type MessageHeaderSource struct {
 	Extension         []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              *string       `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element      `bson:"_name,omitempty" json:"_name,omitempty"`
 	Software          *string       `bson:"software,omitempty" json:"software,omitempty"`
+	SoftwareElement   *Element      `bson:"_software,omitempty" json:"_software,omitempty"`
 	Version           *string       `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element      `bson:"_version,omitempty" json:"_version,omitempty"`
 	Contact           *ContactPoint `bson:"contact,omitempty" json:"contact,omitempty"`
 	Endpoint          string        `bson:"endpoint" json:"endpoint"`
+	EndpointElement   *Element      `bson:"_endpoint,omitempty" json:"_endpoint,omitempty"`
 }
 type MessageHeaderResponse struct {
 	Id                *string      `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier        string       `bson:"identifier" json:"identifier"`
+	IdentifierElement *Element     `bson:"_identifier,omitempty" json:"_identifier,omitempty"`
 	Code              ResponseType `bson:"code" json:"code"`
+	CodeElement       *Element     `bson:"_code,omitempty" json:"_code,omitempty"`
 	Details           *Reference   `bson:"details,omitempty" json:"details,omitempty"`
 }
-type OtherMessageHeader MessageHeader
 
 // MarshalJSON marshals the given MessageHeader as JSON into a byte slice
 func (r MessageHeader) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMessageHeader
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMessageHeader: OtherMessageHeader(r),
-		ResourceType:       "MessageHeader",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MessageHeader as JSON object with the members in the order of the fields
+func (r MessageHeader) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MessageHeader\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.EventCoding != nil {
+		e.key("eventCoding")
+		r.EventCoding.appendJSON(e)
+	}
+	if r.EventUri != nil {
+		e.key("eventUri")
+		e.string(*r.EventUri)
+	}
+	if r.EventUriElement != nil {
+		e.key("_eventUri")
+		r.EventUriElement.appendJSON(e)
+	}
+	if len(r.Destination) > 0 {
+		e.key("destination")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Destination {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Sender != nil {
+		e.key("sender")
+		r.Sender.appendJSON(e)
+	}
+	if r.Enterer != nil {
+		e.key("enterer")
+		r.Enterer.appendJSON(e)
+	}
+	if r.Author != nil {
+		e.key("author")
+		r.Author.appendJSON(e)
+	}
+	e.key("source")
+	r.Source.appendJSON(e)
+	if r.Responsible != nil {
+		e.key("responsible")
+		r.Responsible.appendJSON(e)
+	}
+	if r.Reason != nil {
+		e.key("reason")
+		r.Reason.appendJSON(e)
+	}
+	if r.Response != nil {
+		e.key("response")
+		r.Response.appendJSON(e)
+	}
+	if len(r.Focus) > 0 {
+		e.key("focus")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Focus {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Definition != nil {
+		e.key("definition")
+		e.string(*r.Definition)
+	}
+	if r.DefinitionElement != nil {
+		e.key("_definition")
+		r.DefinitionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MessageHeader from JSON. Unknown members are ignored.
+func (r *MessageHeader) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MessageHeader
+func (r *MessageHeader) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "eventCoding":
+			if d.null() {
+				r.EventCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.EventCoding = &v
+			}
+		case "eventUri":
+			if v, ok := d.string(); ok {
+				r.EventUri = &v
+			} else {
+				r.EventUri = nil
+			}
+		case "_eventUri":
+			r.EventUriElement = d.element()
+		case "destination":
+			a := d.array()
+			r.Destination = nil
+			for a.next() {
+				var v MessageHeaderDestination
+				v.decodeJSON(d)
+				r.Destination = append(r.Destination, v)
+			}
+			if r.Destination == nil && !a.isNull {
+				r.Destination = []MessageHeaderDestination{}
+			}
+		case "sender":
+			if d.null() {
+				r.Sender = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Sender = &v
+			}
+		case "enterer":
+			if d.null() {
+				r.Enterer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Enterer = &v
+			}
+		case "author":
+			if d.null() {
+				r.Author = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = &v
+			}
+		case "source":
+			r.Source.decodeJSON(d)
+		case "responsible":
+			if d.null() {
+				r.Responsible = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Responsible = &v
+			}
+		case "reason":
+			if d.null() {
+				r.Reason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Reason = &v
+			}
+		case "response":
+			if d.null() {
+				r.Response = nil
+			} else {
+				var v MessageHeaderResponse
+				v.decodeJSON(d)
+				r.Response = &v
+			}
+		case "focus":
+			a := d.array()
+			r.Focus = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Focus = append(r.Focus, v)
+			}
+			if r.Focus == nil && !a.isNull {
+				r.Focus = []Reference{}
+			}
+		case "definition":
+			if v, ok := d.string(); ok {
+				r.Definition = &v
+			} else {
+				r.Definition = nil
+			}
+		case "_definition":
+			r.DefinitionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MessageHeader which shares no memory with the original
+func (r MessageHeader) DeepCopy() MessageHeader {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.EventCoding != nil {
+		v := r.EventCoding.DeepCopy()
+		out.EventCoding = &v
+	}
+	if r.EventUri != nil {
+		v := *r.EventUri
+		out.EventUri = &v
+	}
+	if r.EventUriElement != nil {
+		v := r.EventUriElement.DeepCopy()
+		out.EventUriElement = &v
+	}
+	if r.Destination != nil {
+		out.Destination = make([]MessageHeaderDestination, len(r.Destination))
+		for i := range r.Destination {
+			out.Destination[i] = r.Destination[i].DeepCopy()
+		}
+	}
+	if r.Sender != nil {
+		v := r.Sender.DeepCopy()
+		out.Sender = &v
+	}
+	if r.Enterer != nil {
+		v := r.Enterer.DeepCopy()
+		out.Enterer = &v
+	}
+	if r.Author != nil {
+		v := r.Author.DeepCopy()
+		out.Author = &v
+	}
+	out.Source = r.Source.DeepCopy()
+	if r.Responsible != nil {
+		v := r.Responsible.DeepCopy()
+		out.Responsible = &v
+	}
+	if r.Reason != nil {
+		v := r.Reason.DeepCopy()
+		out.Reason = &v
+	}
+	if r.Response != nil {
+		v := r.Response.DeepCopy()
+		out.Response = &v
+	}
+	if r.Focus != nil {
+		out.Focus = make([]Reference, len(r.Focus))
+		for i := range r.Focus {
+			out.Focus[i] = r.Focus[i].DeepCopy()
+		}
+	}
+	if r.Definition != nil {
+		v := *r.Definition
+		out.Definition = &v
+	}
+	if r.DefinitionElement != nil {
+		v := r.DefinitionElement.DeepCopy()
+		out.DefinitionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MessageHeader) Equal(other MessageHeader) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.EventCoding == nil) != (other.EventCoding == nil) || r.EventCoding != nil && !r.EventCoding.Equal(*other.EventCoding) {
+		return false
+	}
+	if (r.EventUri == nil) != (other.EventUri == nil) || r.EventUri != nil && *r.EventUri != *other.EventUri {
+		return false
+	}
+	if (r.EventUriElement == nil) != (other.EventUriElement == nil) || r.EventUriElement != nil && !r.EventUriElement.Equal(*other.EventUriElement) {
+		return false
+	}
+	if len(r.Destination) != len(other.Destination) {
+		return false
+	}
+	for i := range r.Destination {
+		if !r.Destination[i].Equal(other.Destination[i]) {
+			return false
+		}
+	}
+	if (r.Sender == nil) != (other.Sender == nil) || r.Sender != nil && !r.Sender.Equal(*other.Sender) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.Equal(*other.Enterer) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.Equal(*other.Author) {
+		return false
+	}
+	if !r.Source.Equal(other.Source) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && !r.Responsible.Equal(*other.Responsible) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.Equal(*other.Reason) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.Equal(*other.Response) {
+		return false
+	}
+	if len(r.Focus) != len(other.Focus) {
+		return false
+	}
+	for i := range r.Focus {
+		if !r.Focus[i].Equal(other.Focus[i]) {
+			return false
+		}
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && *r.Definition != *other.Definition {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.Equal(*other.DefinitionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MessageHeader) EqualsDeep(other MessageHeader) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.EventCoding == nil) != (other.EventCoding == nil) || r.EventCoding != nil && !r.EventCoding.EqualsDeep(*other.EventCoding) {
+		return false
+	}
+	if (r.EventUri == nil) != (other.EventUri == nil) || r.EventUri != nil && !equivalentString(*r.EventUri, *other.EventUri) {
+		return false
+	}
+	if (r.EventUriElement == nil) != (other.EventUriElement == nil) || r.EventUriElement != nil && !r.EventUriElement.EqualsDeep(*other.EventUriElement) {
+		return false
+	}
+	if !equivalentList(len(r.Destination), len(other.Destination), func(i, j int) bool {
+		return r.Destination[i].EqualsDeep(other.Destination[j])
+	}) {
+		return false
+	}
+	if (r.Sender == nil) != (other.Sender == nil) || r.Sender != nil && !r.Sender.EqualsDeep(*other.Sender) {
+		return false
+	}
+	if (r.Enterer == nil) != (other.Enterer == nil) || r.Enterer != nil && !r.Enterer.EqualsDeep(*other.Enterer) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.EqualsDeep(*other.Author) {
+		return false
+	}
+	if !r.Source.EqualsDeep(other.Source) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && !r.Responsible.EqualsDeep(*other.Responsible) {
+		return false
+	}
+	if (r.Reason == nil) != (other.Reason == nil) || r.Reason != nil && !r.Reason.EqualsDeep(*other.Reason) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.EqualsDeep(*other.Response) {
+		return false
+	}
+	if !equivalentList(len(r.Focus), len(other.Focus), func(i, j int) bool {
+		return r.Focus[i].EqualsDeep(other.Focus[j])
+	}) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.EqualsDeep(*other.DefinitionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MessageHeader) EqualsShallow(other MessageHeader) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.EventUri == nil) != (other.EventUri == nil) || r.EventUri != nil && !equivalentString(*r.EventUri, *other.EventUri) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MessageHeader as FHIR XML
+func (r MessageHeader) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MessageHeader",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "eventCoding", r.EventCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "eventUri", r.EventUri, r.EventUriElement); err != nil {
+		return err
+	}
+	for _, v := range r.Destination {
+		if err := encodeXMLElement(e, "destination", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "sender", r.Sender); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "enterer", r.Enterer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "author", r.Author); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "source", r.Source); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "responsible", r.Responsible); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reason", r.Reason); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "response", r.Response); err != nil {
+		return err
+	}
+	for _, v := range r.Focus {
+		if err := encodeXMLElement(e, "focus", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MessageHeader from FHIR XML
+func (r *MessageHeader) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "eventCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EventCoding = &v
+			case "eventUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EventUri = &v
+				}
+				r.EventUriElement = element
+			case "destination":
+				var v MessageHeaderDestination
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Destination = append(r.Destination, v)
+			case "sender":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Sender = &v
+			case "enterer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Enterer = &v
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = &v
+			case "source":
+				var v MessageHeaderSource
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = v
+			case "responsible":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Responsible = &v
+			case "reason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = &v
+			case "response":
+				var v MessageHeaderResponse
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Response = &v
+			case "focus":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Focus = append(r.Focus, v)
+			case "definition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Definition = &v
+				}
+				r.DefinitionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MessageHeader as properties to the RDF node n
+func (r MessageHeader) turtle(n *turtleNode) {
+	n.resource("MessageHeader", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("MessageHeader.eventCoding", -1, r.EventCoding)
+	n.primitive("MessageHeader.eventUri", -1, r.EventUri, r.EventUriElement, "uri")
+	for i, v := range r.Destination {
+		n.element("MessageHeader.destination", i, v)
+	}
+	n.element("MessageHeader.sender", -1, r.Sender)
+	n.element("MessageHeader.enterer", -1, r.Enterer)
+	n.element("MessageHeader.author", -1, r.Author)
+	n.element("MessageHeader.source", -1, r.Source)
+	n.element("MessageHeader.responsible", -1, r.Responsible)
+	n.element("MessageHeader.reason", -1, r.Reason)
+	n.element("MessageHeader.response", -1, r.Response)
+	for i, v := range r.Focus {
+		n.element("MessageHeader.focus", i, v)
+	}
+	n.primitive("MessageHeader.definition", -1, r.Definition, r.DefinitionElement, "string")
+}
+
+// MarshalBSON marshals the given MessageHeader as BSON document with the structure of its FHIR JSON
+func (r MessageHeader) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MessageHeader from a BSON document with the structure of its FHIR JSON
+func (r *MessageHeader) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MessageHeader as protobuf message MessageHeader of fhir.proto
+func (r MessageHeader) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MessageHeader to the encoded message
+func (r MessageHeader) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.EventCoding != nil {
+		e.message(9, *r.EventCoding)
+	}
+	if r.EventUri != nil {
+		e.string(10, *r.EventUri)
+	}
+	for _, v := range r.Destination {
+		e.message(11, v)
+	}
+	if r.Sender != nil {
+		e.message(12, *r.Sender)
+	}
+	if r.Enterer != nil {
+		e.message(13, *r.Enterer)
+	}
+	if r.Author != nil {
+		e.message(14, *r.Author)
+	}
+	e.message(15, r.Source)
+	if r.Responsible != nil {
+		e.message(16, *r.Responsible)
+	}
+	if r.Reason != nil {
+		e.message(17, *r.Reason)
+	}
+	if r.Response != nil {
+		e.message(18, *r.Response)
+	}
+	for _, v := range r.Focus {
+		e.message(19, v)
+	}
+	if r.Definition != nil {
+		e.string(20, *r.Definition)
+	}
+	if r.IdElement != nil {
+		e.message(21, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(22, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(23, *r.LanguageElement)
+	}
+	if r.EventUriElement != nil {
+		e.message(24, *r.EventUriElement)
+	}
+	if r.DefinitionElement != nil {
+		e.message(25, *r.DefinitionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MessageHeader from protobuf message MessageHeader of fhir.proto
+func (r *MessageHeader) UnmarshalProto(b []byte) error {
+	*r = MessageHeader{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Coding
+			d.message(&v)
+			r.EventCoding = &v
+		case 10:
+			v := d.string()
+			r.EventUri = &v
+		case 11:
+			var v MessageHeaderDestination
+			d.message(&v)
+			r.Destination = append(r.Destination, v)
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Sender = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Enterer = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Author = &v
+		case 15:
+			var v MessageHeaderSource
+			d.message(&v)
+			r.Source = v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Responsible = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.Reason = &v
+		case 18:
+			var v MessageHeaderResponse
+			d.message(&v)
+			r.Response = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Focus = append(r.Focus, v)
+		case 20:
+			v := d.string()
+			r.Definition = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.EventUriElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.DefinitionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MessageHeaderDestination as JSON into a byte slice
+func (r MessageHeaderDestination) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MessageHeaderDestination as JSON object with the members in the order of the fields
+func (r MessageHeaderDestination) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Target != nil {
+		e.key("target")
+		r.Target.appendJSON(e)
+	}
+	e.key("endpoint")
+	e.string(r.Endpoint)
+	if r.EndpointElement != nil {
+		e.key("_endpoint")
+		r.EndpointElement.appendJSON(e)
+	}
+	if r.Receiver != nil {
+		e.key("receiver")
+		r.Receiver.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MessageHeaderDestination from JSON. Unknown members are ignored.
+func (r *MessageHeaderDestination) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MessageHeaderDestination
+func (r *MessageHeaderDestination) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "target":
+			if d.null() {
+				r.Target = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Target = &v
+			}
+		case "endpoint":
+			if v, ok := d.string(); ok {
+				r.Endpoint = v
+			}
+		case "_endpoint":
+			r.EndpointElement = d.element()
+		case "receiver":
+			if d.null() {
+				r.Receiver = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Receiver = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MessageHeaderDestination which shares no memory with the original
+func (r MessageHeaderDestination) DeepCopy() MessageHeaderDestination {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Target != nil {
+		v := r.Target.DeepCopy()
+		out.Target = &v
+	}
+	if r.EndpointElement != nil {
+		v := r.EndpointElement.DeepCopy()
+		out.EndpointElement = &v
+	}
+	if r.Receiver != nil {
+		v := r.Receiver.DeepCopy()
+		out.Receiver = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MessageHeaderDestination) Equal(other MessageHeaderDestination) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Target == nil) != (other.Target == nil) || r.Target != nil && !r.Target.Equal(*other.Target) {
+		return false
+	}
+	if r.Endpoint != other.Endpoint {
+		return false
+	}
+	if (r.EndpointElement == nil) != (other.EndpointElement == nil) || r.EndpointElement != nil && !r.EndpointElement.Equal(*other.EndpointElement) {
+		return false
+	}
+	if (r.Receiver == nil) != (other.Receiver == nil) || r.Receiver != nil && !r.Receiver.Equal(*other.Receiver) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MessageHeaderDestination) EqualsDeep(other MessageHeaderDestination) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Target == nil) != (other.Target == nil) || r.Target != nil && !r.Target.EqualsDeep(*other.Target) {
+		return false
+	}
+	if !equivalentString(r.Endpoint, other.Endpoint) {
+		return false
+	}
+	if (r.EndpointElement == nil) != (other.EndpointElement == nil) || r.EndpointElement != nil && !r.EndpointElement.EqualsDeep(*other.EndpointElement) {
+		return false
+	}
+	if (r.Receiver == nil) != (other.Receiver == nil) || r.Receiver != nil && !r.Receiver.EqualsDeep(*other.Receiver) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MessageHeaderDestination) EqualsShallow(other MessageHeaderDestination) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if !equivalentString(r.Endpoint, other.Endpoint) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MessageHeaderDestination as FHIR XML
+func (r MessageHeaderDestination) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "target", r.Target); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "endpoint", r.Endpoint, r.EndpointElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "receiver", r.Receiver); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MessageHeaderDestination from FHIR XML
+func (r *MessageHeaderDestination) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "target":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = &v
+			case "endpoint":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Endpoint = v
+				}
+				r.EndpointElement = element
+			case "receiver":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Receiver = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MessageHeaderDestination as properties to the RDF node n
+func (r MessageHeaderDestination) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MessageHeader.destination.name", -1, r.Name, r.NameElement, "string")
+	n.element("MessageHeader.destination.target", -1, r.Target)
+	n.primitive("MessageHeader.destination.endpoint", -1, r.Endpoint, r.EndpointElement, "string")
+	n.element("MessageHeader.destination.receiver", -1, r.Receiver)
+}
+
+// MarshalBSON marshals the given MessageHeaderDestination as BSON document with the structure of its FHIR JSON
+func (r MessageHeaderDestination) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MessageHeaderDestination from a BSON document with the structure of its FHIR JSON
+func (r *MessageHeaderDestination) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MessageHeaderDestination as protobuf message MessageHeaderDestination of fhir.proto
+func (r MessageHeaderDestination) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MessageHeaderDestination to the encoded message
+func (r MessageHeaderDestination) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != nil {
+		e.string(4, *r.Name)
+	}
+	if r.Target != nil {
+		e.message(5, *r.Target)
+	}
+	if r.Endpoint != "" {
+		e.string(6, r.Endpoint)
+	}
+	if r.Receiver != nil {
+		e.message(7, *r.Receiver)
+	}
+	if r.NameElement != nil {
+		e.message(8, *r.NameElement)
+	}
+	if r.EndpointElement != nil {
+		e.message(9, *r.EndpointElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MessageHeaderDestination from protobuf message MessageHeaderDestination of fhir.proto
+func (r *MessageHeaderDestination) UnmarshalProto(b []byte) error {
+	*r = MessageHeaderDestination{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Name = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Target = &v
+		case 6:
+			r.Endpoint = d.string()
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Receiver = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.EndpointElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MessageHeaderSource as JSON into a byte slice
+func (r MessageHeaderSource) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MessageHeaderSource as JSON object with the members in the order of the fields
+func (r MessageHeaderSource) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Software != nil {
+		e.key("software")
+		e.string(*r.Software)
+	}
+	if r.SoftwareElement != nil {
+		e.key("_software")
+		r.SoftwareElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Contact != nil {
+		e.key("contact")
+		r.Contact.appendJSON(e)
+	}
+	e.key("endpoint")
+	e.string(r.Endpoint)
+	if r.EndpointElement != nil {
+		e.key("_endpoint")
+		r.EndpointElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MessageHeaderSource from JSON. Unknown members are ignored.
+func (r *MessageHeaderSource) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MessageHeaderSource
+func (r *MessageHeaderSource) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "software":
+			if v, ok := d.string(); ok {
+				r.Software = &v
+			} else {
+				r.Software = nil
+			}
+		case "_software":
+			r.SoftwareElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "contact":
+			if d.null() {
+				r.Contact = nil
+			} else {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Contact = &v
+			}
+		case "endpoint":
+			if v, ok := d.string(); ok {
+				r.Endpoint = v
+			}
+		case "_endpoint":
+			r.EndpointElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MessageHeaderSource which shares no memory with the original
+func (r MessageHeaderSource) DeepCopy() MessageHeaderSource {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Software != nil {
+		v := *r.Software
+		out.Software = &v
+	}
+	if r.SoftwareElement != nil {
+		v := r.SoftwareElement.DeepCopy()
+		out.SoftwareElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Contact != nil {
+		v := r.Contact.DeepCopy()
+		out.Contact = &v
+	}
+	if r.EndpointElement != nil {
+		v := r.EndpointElement.DeepCopy()
+		out.EndpointElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MessageHeaderSource) Equal(other MessageHeaderSource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Software == nil) != (other.Software == nil) || r.Software != nil && *r.Software != *other.Software {
+		return false
+	}
+	if (r.SoftwareElement == nil) != (other.SoftwareElement == nil) || r.SoftwareElement != nil && !r.SoftwareElement.Equal(*other.SoftwareElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Contact == nil) != (other.Contact == nil) || r.Contact != nil && !r.Contact.Equal(*other.Contact) {
+		return false
+	}
+	if r.Endpoint != other.Endpoint {
+		return false
+	}
+	if (r.EndpointElement == nil) != (other.EndpointElement == nil) || r.EndpointElement != nil && !r.EndpointElement.Equal(*other.EndpointElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MessageHeaderSource) EqualsDeep(other MessageHeaderSource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Software == nil) != (other.Software == nil) || r.Software != nil && !equivalentString(*r.Software, *other.Software) {
+		return false
+	}
+	if (r.SoftwareElement == nil) != (other.SoftwareElement == nil) || r.SoftwareElement != nil && !r.SoftwareElement.EqualsDeep(*other.SoftwareElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Contact == nil) != (other.Contact == nil) || r.Contact != nil && !r.Contact.EqualsDeep(*other.Contact) {
+		return false
+	}
+	if !equivalentString(r.Endpoint, other.Endpoint) {
+		return false
+	}
+	if (r.EndpointElement == nil) != (other.EndpointElement == nil) || r.EndpointElement != nil && !r.EndpointElement.EqualsDeep(*other.EndpointElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MessageHeaderSource) EqualsShallow(other MessageHeaderSource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Software == nil) != (other.Software == nil) || r.Software != nil && !equivalentString(*r.Software, *other.Software) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentString(r.Endpoint, other.Endpoint) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MessageHeaderSource as FHIR XML
+func (r MessageHeaderSource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "software", r.Software, r.SoftwareElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "contact", r.Contact); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "endpoint", r.Endpoint, r.EndpointElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MessageHeaderSource from FHIR XML
+func (r *MessageHeaderSource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "software":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Software = &v
+				}
+				r.SoftwareElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "contact":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = &v
+			case "endpoint":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Endpoint = v
+				}
+				r.EndpointElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MessageHeaderSource as properties to the RDF node n
+func (r MessageHeaderSource) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MessageHeader.source.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("MessageHeader.source.software", -1, r.Software, r.SoftwareElement, "string")
+	n.primitive("MessageHeader.source.version", -1, r.Version, r.VersionElement, "string")
+	n.element("MessageHeader.source.contact", -1, r.Contact)
+	n.primitive("MessageHeader.source.endpoint", -1, r.Endpoint, r.EndpointElement, "string")
+}
+
+// MarshalBSON marshals the given MessageHeaderSource as BSON document with the structure of its FHIR JSON
+func (r MessageHeaderSource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MessageHeaderSource from a BSON document with the structure of its FHIR JSON
+func (r *MessageHeaderSource) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MessageHeaderSource as protobuf message MessageHeaderSource of fhir.proto
+func (r MessageHeaderSource) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MessageHeaderSource to the encoded message
+func (r MessageHeaderSource) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != nil {
+		e.string(4, *r.Name)
+	}
+	if r.Software != nil {
+		e.string(5, *r.Software)
+	}
+	if r.Version != nil {
+		e.string(6, *r.Version)
+	}
+	if r.Contact != nil {
+		e.message(7, *r.Contact)
+	}
+	if r.Endpoint != "" {
+		e.string(8, r.Endpoint)
+	}
+	if r.NameElement != nil {
+		e.message(9, *r.NameElement)
+	}
+	if r.SoftwareElement != nil {
+		e.message(10, *r.SoftwareElement)
+	}
+	if r.VersionElement != nil {
+		e.message(11, *r.VersionElement)
+	}
+	if r.EndpointElement != nil {
+		e.message(12, *r.EndpointElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MessageHeaderSource from protobuf message MessageHeaderSource of fhir.proto
+func (r *MessageHeaderSource) UnmarshalProto(b []byte) error {
+	*r = MessageHeaderSource{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Name = &v
+		case 5:
+			v := d.string()
+			r.Software = &v
+		case 6:
+			v := d.string()
+			r.Version = &v
+		case 7:
+			var v ContactPoint
+			d.message(&v)
+			r.Contact = &v
+		case 8:
+			r.Endpoint = d.string()
+		case 9:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.SoftwareElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.EndpointElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MessageHeaderResponse as JSON into a byte slice
+func (r MessageHeaderResponse) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MessageHeaderResponse as JSON object with the members in the order of the fields
+func (r MessageHeaderResponse) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("identifier")
+	e.string(r.Identifier)
+	if r.IdentifierElement != nil {
+		e.key("_identifier")
+		r.IdentifierElement.appendJSON(e)
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Details != nil {
+		e.key("details")
+		r.Details.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MessageHeaderResponse from JSON. Unknown members are ignored.
+func (r *MessageHeaderResponse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MessageHeaderResponse
+func (r *MessageHeaderResponse) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if v, ok := d.string(); ok {
+				r.Identifier = v
+			}
+		case "_identifier":
+			r.IdentifierElement = d.element()
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "details":
+			if d.null() {
+				r.Details = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Details = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MessageHeaderResponse which shares no memory with the original
+func (r MessageHeaderResponse) DeepCopy() MessageHeaderResponse {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.IdentifierElement != nil {
+		v := r.IdentifierElement.DeepCopy()
+		out.IdentifierElement = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Details != nil {
+		v := r.Details.DeepCopy()
+		out.Details = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MessageHeaderResponse) Equal(other MessageHeaderResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Identifier != other.Identifier {
+		return false
+	}
+	if (r.IdentifierElement == nil) != (other.IdentifierElement == nil) || r.IdentifierElement != nil && !r.IdentifierElement.Equal(*other.IdentifierElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Details == nil) != (other.Details == nil) || r.Details != nil && !r.Details.Equal(*other.Details) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MessageHeaderResponse) EqualsDeep(other MessageHeaderResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Identifier, other.Identifier) {
+		return false
+	}
+	if (r.IdentifierElement == nil) != (other.IdentifierElement == nil) || r.IdentifierElement != nil && !r.IdentifierElement.EqualsDeep(*other.IdentifierElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Details == nil) != (other.Details == nil) || r.Details != nil && !r.Details.EqualsDeep(*other.Details) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MessageHeaderResponse) EqualsShallow(other MessageHeaderResponse) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Identifier, other.Identifier) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MessageHeaderResponse as FHIR XML
+func (r MessageHeaderResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "identifier", r.Identifier, r.IdentifierElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "details", r.Details); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MessageHeaderResponse from FHIR XML
+func (r *MessageHeaderResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Identifier = v
+				}
+				r.IdentifierElement = element
+			case "code":
+				var v ResponseType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "details":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Details = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MessageHeaderResponse as properties to the RDF node n
+func (r MessageHeaderResponse) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MessageHeader.response.identifier", -1, r.Identifier, r.IdentifierElement, "string")
+	n.primitive("MessageHeader.response.code", -1, r.Code, r.CodeElement, "code")
+	n.element("MessageHeader.response.details", -1, r.Details)
+}
+
+// MarshalBSON marshals the given MessageHeaderResponse as BSON document with the structure of its FHIR JSON
+func (r MessageHeaderResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MessageHeaderResponse from a BSON document with the structure of its FHIR JSON
+func (r *MessageHeaderResponse) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MessageHeaderResponse as protobuf message MessageHeaderResponse of fhir.proto
+func (r MessageHeaderResponse) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MessageHeaderResponse to the encoded message
+func (r MessageHeaderResponse) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identifier != "" {
+		e.string(4, r.Identifier)
+	}
+	e.varint(5, r.Code.protoNumber())
+	if r.Details != nil {
+		e.message(6, *r.Details)
+	}
+	if r.IdentifierElement != nil {
+		e.message(7, *r.IdentifierElement)
+	}
+	if r.CodeElement != nil {
+		e.message(8, *r.CodeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MessageHeaderResponse from protobuf message MessageHeaderResponse of fhir.proto
+func (r *MessageHeaderResponse) UnmarshalProto(b []byte) error {
+	*r = MessageHeaderResponse{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Identifier = d.string()
+		case 5:
+			var v ResponseType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Details = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.IdentifierElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMessageHeader unmarshals a MessageHeader.
 func UnmarshalMessageHeader(b []byte) (MessageHeader, error) {
 	var messageHeader MessageHeader
-	if err := json.Unmarshal(b, &messageHeader); err != nil {
+	if err := messageHeader.UnmarshalJSON(b); err != nil {
 		return messageHeader, err
 	}
 	return messageHeader, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code MessageSignificanceCategory) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *MessageSignificanceCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *MessageSignificanceCategory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "consequence":
 		*code = MessageSignificanceCategoryConsequence
@@ -49,6 +53,12 @@ func (code *MessageSignificanceCategory) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code MessageSignificanceCategory) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *MessageSignificanceCategory) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code MessageSignificanceCategory) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code MessageSignificanceCategory) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code MessageSignificanceCategory) protoNumber() uint64 {
+	switch code {
+	case MessageSignificanceCategoryConsequence:
+		return 1
+	case MessageSignificanceCategoryCurrency:
+		return 2
+	case MessageSignificanceCategoryNotification:
+		return 3
+	}
+	return 0
+}
+func (code *MessageSignificanceCategory) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = MessageSignificanceCategoryConsequence
+	case 2:
+		*code = MessageSignificanceCategoryCurrency
+	case 3:
+		*code = MessageSignificanceCategoryNotification
+	default:
+		return false
+	}
+	return true
+}
@@ -14,104 +14,150 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // MolecularSequence is documented here http://hl7.org/fhir/StructureDefinition/MolecularSequence
 type MolecularSequence struct {
-	Id                *string                             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type              *string                             `bson:"type,omitempty" json:"type,omitempty"`
-	CoordinateSystem  int                                 `bson:"coordinateSystem" json:"coordinateSystem"`
-	Patient           *Reference                          `bson:"patient,omitempty" json:"patient,omitempty"`
-	Specimen          *Reference                          `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	Device            *Reference                          `bson:"device,omitempty" json:"device,omitempty"`
-	Performer         *Reference                          `bson:"performer,omitempty" json:"performer,omitempty"`
-	Quantity          *Quantity                           `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	ReferenceSeq      *MolecularSequenceReferenceSeq      `bson:"referenceSeq,omitempty" json:"referenceSeq,omitempty"`
-	Variant           []MolecularSequenceVariant          `bson:"variant,omitempty" json:"variant,omitempty"`
-	ObservedSeq       *string                             `bson:"observedSeq,omitempty" json:"observedSeq,omitempty"`
-	Quality           []MolecularSequenceQuality          `bson:"quality,omitempty" json:"quality,omitempty"`
-	ReadCoverage      *int                                `bson:"readCoverage,omitempty" json:"readCoverage,omitempty"`
-	Repository        []MolecularSequenceRepository       `bson:"repository,omitempty" json:"repository,omitempty"`
-	Pointer           []Reference                         `bson:"pointer,omitempty" json:"pointer,omitempty"`
-	StructureVariant  []MolecularSequenceStructureVariant `bson:"structureVariant,omitempty" json:"structureVariant,omitempty"`
+	Id                      *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement               *Element                            `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                    *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               []json.RawMessage                   `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier              []Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type                    *string                             `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement             *Element                            `bson:"_type,omitempty" json:"_type,omitempty"`
+	CoordinateSystem        int                                 `bson:"coordinateSystem" json:"coordinateSystem"`
+	CoordinateSystemElement *Element                            `bson:"_coordinateSystem,omitempty" json:"_coordinateSystem,omitempty"`
+	Patient                 *Reference                          `bson:"patient,omitempty" json:"patient,omitempty"`
+	Specimen                *Reference                          `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	Device                  *Reference                          `bson:"device,omitempty" json:"device,omitempty"`
+	Performer               *Reference                          `bson:"performer,omitempty" json:"performer,omitempty"`
+	Quantity                *Quantity                           `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	ReferenceSeq            *MolecularSequenceReferenceSeq      `bson:"referenceSeq,omitempty" json:"referenceSeq,omitempty"`
+	Variant                 []MolecularSequenceVariant          `bson:"variant,omitempty" json:"variant,omitempty"`
+	ObservedSeq             *string                             `bson:"observedSeq,omitempty" json:"observedSeq,omitempty"`
+	ObservedSeqElement      *Element                            `bson:"_observedSeq,omitempty" json:"_observedSeq,omitempty"`
+	Quality                 []MolecularSequenceQuality          `bson:"quality,omitempty" json:"quality,omitempty"`
+	ReadCoverage            *int                                `bson:"readCoverage,omitempty" json:"readCoverage,omitempty"`
+	ReadCoverageElement     *Element                            `bson:"_readCoverage,omitempty" json:"_readCoverage,omitempty"`
+	Repository              []MolecularSequenceRepository       `bson:"repository,omitempty" json:"repository,omitempty"`
+	Pointer                 []Reference                         `bson:"pointer,omitempty" json:"pointer,omitempty"`
+	StructureVariant        []MolecularSequenceStructureVariant `bson:"structureVariant,omitempty" json:"structureVariant,omitempty"`
 }
 type MolecularSequenceReferenceSeq struct {
-	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Chromosome          *CodeableConcept `bson:"chromosome,omitempty" json:"chromosome,omitempty"`
-	GenomeBuild         *string          `bson:"genomeBuild,omitempty" json:"genomeBuild,omitempty"`
-	Orientation         *string          `bson:"orientation,omitempty" json:"orientation,omitempty"`
-	ReferenceSeqId      *CodeableConcept `bson:"referenceSeqId,omitempty" json:"referenceSeqId,omitempty"`
-	ReferenceSeqPointer *Reference       `bson:"referenceSeqPointer,omitempty" json:"referenceSeqPointer,omitempty"`
-	ReferenceSeqString  *string          `bson:"referenceSeqString,omitempty" json:"referenceSeqString,omitempty"`
-	Strand              *string          `bson:"strand,omitempty" json:"strand,omitempty"`
-	WindowStart         *int             `bson:"windowStart,omitempty" json:"windowStart,omitempty"`
-	WindowEnd           *int             `bson:"windowEnd,omitempty" json:"windowEnd,omitempty"`
+	Id                        *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Chromosome                *CodeableConcept `bson:"chromosome,omitempty" json:"chromosome,omitempty"`
+	GenomeBuild               *string          `bson:"genomeBuild,omitempty" json:"genomeBuild,omitempty"`
+	GenomeBuildElement        *Element         `bson:"_genomeBuild,omitempty" json:"_genomeBuild,omitempty"`
+	Orientation               *string          `bson:"orientation,omitempty" json:"orientation,omitempty"`
+	OrientationElement        *Element         `bson:"_orientation,omitempty" json:"_orientation,omitempty"`
+	ReferenceSeqId            *CodeableConcept `bson:"referenceSeqId,omitempty" json:"referenceSeqId,omitempty"`
+	ReferenceSeqPointer       *Reference       `bson:"referenceSeqPointer,omitempty" json:"referenceSeqPointer,omitempty"`
+	ReferenceSeqString        *string          `bson:"referenceSeqString,omitempty" json:"referenceSeqString,omitempty"`
+	ReferenceSeqStringElement *Element         `bson:"_referenceSeqString,omitempty" json:"_referenceSeqString,omitempty"`
+	Strand                    *string          `bson:"strand,omitempty" json:"strand,omitempty"`
+	StrandElement             *Element         `bson:"_strand,omitempty" json:"_strand,omitempty"`
+	WindowStart               *int             `bson:"windowStart,omitempty" json:"windowStart,omitempty"`
+	WindowStartElement        *Element         `bson:"_windowStart,omitempty" json:"_windowStart,omitempty"`
+	WindowEnd                 *int             `bson:"windowEnd,omitempty" json:"windowEnd,omitempty"`
+	WindowEndElement          *Element         `bson:"_windowEnd,omitempty" json:"_windowEnd,omitempty"`
 }
 type MolecularSequenceVariant struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Start             *int        `bson:"start,omitempty" json:"start,omitempty"`
-	End               *int        `bson:"end,omitempty" json:"end,omitempty"`
-	ObservedAllele    *string     `bson:"observedAllele,omitempty" json:"observedAllele,omitempty"`
-	ReferenceAllele   *string     `bson:"referenceAllele,omitempty" json:"referenceAllele,omitempty"`
-	Cigar             *string     `bson:"cigar,omitempty" json:"cigar,omitempty"`
-	VariantPointer    *Reference  `bson:"variantPointer,omitempty" json:"variantPointer,omitempty"`
+	Id                     *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Start                  *int        `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement           *Element    `bson:"_start,omitempty" json:"_start,omitempty"`
+	End                    *int        `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement             *Element    `bson:"_end,omitempty" json:"_end,omitempty"`
+	ObservedAllele         *string     `bson:"observedAllele,omitempty" json:"observedAllele,omitempty"`
+	ObservedAlleleElement  *Element    `bson:"_observedAllele,omitempty" json:"_observedAllele,omitempty"`
+	ReferenceAllele        *string     `bson:"referenceAllele,omitempty" json:"referenceAllele,omitempty"`
+	ReferenceAlleleElement *Element    `bson:"_referenceAllele,omitempty" json:"_referenceAllele,omitempty"`
+	Cigar                  *string     `bson:"cigar,omitempty" json:"cigar,omitempty"`
+	CigarElement           *Element    `bson:"_cigar,omitempty" json:"_cigar,omitempty"`
+	VariantPointer         *Reference  `bson:"variantPointer,omitempty" json:"variantPointer,omitempty"`
 }
 type MolecularSequenceQuality struct {
 	Id                *string                      `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              string                       `bson:"type" json:"type"`
+	TypeElement       *Element                     `bson:"_type,omitempty" json:"_type,omitempty"`
 	StandardSequence  *CodeableConcept             `bson:"standardSequence,omitempty" json:"standardSequence,omitempty"`
 	Start             *int                         `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement      *Element                     `bson:"_start,omitempty" json:"_start,omitempty"`
 	End               *int                         `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement        *Element                     `bson:"_end,omitempty" json:"_end,omitempty"`
 	Score             *Quantity                    `bson:"score,omitempty" json:"score,omitempty"`
 	Method            *CodeableConcept             `bson:"method,omitempty" json:"method,omitempty"`
 	TruthTP           *json.Number                 `bson:"truthTP,omitempty" json:"truthTP,omitempty"`
+	TruthTPElement    *Element                     `bson:"_truthTP,omitempty" json:"_truthTP,omitempty"`
 	QueryTP           *json.Number                 `bson:"queryTP,omitempty" json:"queryTP,omitempty"`
+	QueryTPElement    *Element                     `bson:"_queryTP,omitempty" json:"_queryTP,omitempty"`
 	TruthFN           *json.Number                 `bson:"truthFN,omitempty" json:"truthFN,omitempty"`
+	TruthFNElement    *Element                     `bson:"_truthFN,omitempty" json:"_truthFN,omitempty"`
 	QueryFP           *json.Number                 `bson:"queryFP,omitempty" json:"queryFP,omitempty"`
+	QueryFPElement    *Element                     `bson:"_queryFP,omitempty" json:"_queryFP,omitempty"`
 	GtFP              *json.Number                 `bson:"gtFP,omitempty" json:"gtFP,omitempty"`
+	GtFPElement       *Element                     `bson:"_gtFP,omitempty" json:"_gtFP,omitempty"`
 	Precision         *json.Number                 `bson:"precision,omitempty" json:"precision,omitempty"`
+	PrecisionElement  *Element                     `bson:"_precision,omitempty" json:"_precision,omitempty"`
 	Recall            *json.Number                 `bson:"recall,omitempty" json:"recall,omitempty"`
+	RecallElement     *Element                     `bson:"_recall,omitempty" json:"_recall,omitempty"`
 	FScore            *json.Number                 `bson:"fScore,omitempty" json:"fScore,omitempty"`
+	FScoreElement     *Element                     `bson:"_fScore,omitempty" json:"_fScore,omitempty"`
 	Roc               *MolecularSequenceQualityRoc `bson:"roc,omitempty" json:"roc,omitempty"`
 }
 type MolecularSequenceQualityRoc struct {
-	Id                *string       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Score             []int         `bson:"score,omitempty" json:"score,omitempty"`
-	NumTP             []int         `bson:"numTP,omitempty" json:"numTP,omitempty"`
-	NumFP             []int         `bson:"numFP,omitempty" json:"numFP,omitempty"`
-	NumFN             []int         `bson:"numFN,omitempty" json:"numFN,omitempty"`
-	Precision         []json.Number `bson:"precision,omitempty" json:"precision,omitempty"`
-	Sensitivity       []json.Number `bson:"sensitivity,omitempty" json:"sensitivity,omitempty"`
-	FMeasure          []json.Number `bson:"fMeasure,omitempty" json:"fMeasure,omitempty"`
+	Id                 *string       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Score              []int         `bson:"score,omitempty" json:"score,omitempty"`
+	ScoreElement       []*Element    `bson:"_score,omitempty" json:"_score,omitempty"`
+	NumTP              []int         `bson:"numTP,omitempty" json:"numTP,omitempty"`
+	NumTPElement       []*Element    `bson:"_numTP,omitempty" json:"_numTP,omitempty"`
+	NumFP              []int         `bson:"numFP,omitempty" json:"numFP,omitempty"`
+	NumFPElement       []*Element    `bson:"_numFP,omitempty" json:"_numFP,omitempty"`
+	NumFN              []int         `bson:"numFN,omitempty" json:"numFN,omitempty"`
+	NumFNElement       []*Element    `bson:"_numFN,omitempty" json:"_numFN,omitempty"`
+	Precision          []json.Number `bson:"precision,omitempty" json:"precision,omitempty"`
+	PrecisionElement   []*Element    `bson:"_precision,omitempty" json:"_precision,omitempty"`
+	Sensitivity        []json.Number `bson:"sensitivity,omitempty" json:"sensitivity,omitempty"`
+	SensitivityElement []*Element    `bson:"_sensitivity,omitempty" json:"_sensitivity,omitempty"`
+	FMeasure           []json.Number `bson:"fMeasure,omitempty" json:"fMeasure,omitempty"`
+	FMeasureElement    []*Element    `bson:"_fMeasure,omitempty" json:"_fMeasure,omitempty"`
 }
 type MolecularSequenceRepository struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              string      `bson:"type" json:"type"`
-	Url               *string     `bson:"url,omitempty" json:"url,omitempty"`
-	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
-	DatasetId         *string     `bson:"datasetId,omitempty" json:"datasetId,omitempty"`
-	VariantsetId      *string     `bson:"variantsetId,omitempty" json:"variantsetId,omitempty"`
-	ReadsetId         *string     `bson:"readsetId,omitempty" json:"readsetId,omitempty"`
+	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                string      `bson:"type" json:"type"`
+	TypeElement         *Element    `bson:"_type,omitempty" json:"_type,omitempty"`
+	Url                 *string     `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement          *Element    `bson:"_url,omitempty" json:"_url,omitempty"`
+	Name                *string     `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement         *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	DatasetId           *string     `bson:"datasetId,omitempty" json:"datasetId,omitempty"`
+	DatasetIdElement    *Element    `bson:"_datasetId,omitempty" json:"_datasetId,omitempty"`
+	VariantsetId        *string     `bson:"variantsetId,omitempty" json:"variantsetId,omitempty"`
+	VariantsetIdElement *Element    `bson:"_variantsetId,omitempty" json:"_variantsetId,omitempty"`
+	ReadsetId           *string     `bson:"readsetId,omitempty" json:"readsetId,omitempty"`
+	ReadsetIdElement    *Element    `bson:"_readsetId,omitempty" json:"_readsetId,omitempty"`
 }
 type MolecularSequenceStructureVariant struct {
 	Id                *string                                 `bson:"id,omitempty" json:"id,omitempty"`
@@ -119,7 +165,9 @@ type MolecularSequenceStructureVariant struct {
 	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	VariantType       *CodeableConcept                        `bson:"variantType,omitempty" json:"variantType,omitempty"`
 	Exact             *bool                                   `bson:"exact,omitempty" json:"exact,omitempty"`
+	ExactElement      *Element                                `bson:"_exact,omitempty" json:"_exact,omitempty"`
 	Length            *int                                    `bson:"length,omitempty" json:"length,omitempty"`
+	LengthElement     *Element                                `bson:"_length,omitempty" json:"_length,omitempty"`
 	Outer             *MolecularSequenceStructureVariantOuter `bson:"outer,omitempty" json:"outer,omitempty"`
 	Inner             *MolecularSequenceStructureVariantInner `bson:"inner,omitempty" json:"inner,omitempty"`
 }
@@ -128,32 +176,7070 @@ type MolecularSequenceStructureVariantOuter struct {
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Start             *int        `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement      *Element    `bson:"_start,omitempty" json:"_start,omitempty"`
 	End               *int        `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement        *Element    `bson:"_end,omitempty" json:"_end,omitempty"`
 }
 type MolecularSequenceStructureVariantInner struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Start             *int        `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement      *Element    `bson:"_start,omitempty" json:"_start,omitempty"`
 	End               *int        `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement        *Element    `bson:"_end,omitempty" json:"_end,omitempty"`
 }
-type OtherMolecularSequence MolecularSequence
 
 // MarshalJSON marshals the given MolecularSequence as JSON into a byte slice
 func (r MolecularSequence) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherMolecularSequence
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherMolecularSequence: OtherMolecularSequence(r),
-		ResourceType:           "MolecularSequence",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MolecularSequence as JSON object with the members in the order of the fields
+func (r MolecularSequence) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"MolecularSequence\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(*r.Type)
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("coordinateSystem")
+	e.int(r.CoordinateSystem)
+	if r.CoordinateSystemElement != nil {
+		e.key("_coordinateSystem")
+		r.CoordinateSystemElement.appendJSON(e)
+	}
+	if r.Patient != nil {
+		e.key("patient")
+		r.Patient.appendJSON(e)
+	}
+	if r.Specimen != nil {
+		e.key("specimen")
+		r.Specimen.appendJSON(e)
+	}
+	if r.Device != nil {
+		e.key("device")
+		r.Device.appendJSON(e)
+	}
+	if r.Performer != nil {
+		e.key("performer")
+		r.Performer.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.ReferenceSeq != nil {
+		e.key("referenceSeq")
+		r.ReferenceSeq.appendJSON(e)
+	}
+	if len(r.Variant) > 0 {
+		e.key("variant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Variant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ObservedSeq != nil {
+		e.key("observedSeq")
+		e.string(*r.ObservedSeq)
+	}
+	if r.ObservedSeqElement != nil {
+		e.key("_observedSeq")
+		r.ObservedSeqElement.appendJSON(e)
+	}
+	if len(r.Quality) > 0 {
+		e.key("quality")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Quality {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ReadCoverage != nil {
+		e.key("readCoverage")
+		e.int(*r.ReadCoverage)
+	}
+	if r.ReadCoverageElement != nil {
+		e.key("_readCoverage")
+		r.ReadCoverageElement.appendJSON(e)
+	}
+	if len(r.Repository) > 0 {
+		e.key("repository")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Repository {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Pointer) > 0 {
+		e.key("pointer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Pointer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.StructureVariant) > 0 {
+		e.key("structureVariant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.StructureVariant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MolecularSequence from JSON. Unknown members are ignored.
+func (r *MolecularSequence) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MolecularSequence
+func (r *MolecularSequence) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = &v
+			} else {
+				r.Type = nil
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "coordinateSystem":
+			if v, ok := d.int(); ok {
+				r.CoordinateSystem = v
+			}
+		case "_coordinateSystem":
+			r.CoordinateSystemElement = d.element()
+		case "patient":
+			if d.null() {
+				r.Patient = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Patient = &v
+			}
+		case "specimen":
+			if d.null() {
+				r.Specimen = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Specimen = &v
+			}
+		case "device":
+			if d.null() {
+				r.Device = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Device = &v
+			}
+		case "performer":
+			if d.null() {
+				r.Performer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "referenceSeq":
+			if d.null() {
+				r.ReferenceSeq = nil
+			} else {
+				var v MolecularSequenceReferenceSeq
+				v.decodeJSON(d)
+				r.ReferenceSeq = &v
+			}
+		case "variant":
+			a := d.array()
+			r.Variant = nil
+			for a.next() {
+				var v MolecularSequenceVariant
+				v.decodeJSON(d)
+				r.Variant = append(r.Variant, v)
+			}
+			if r.Variant == nil && !a.isNull {
+				r.Variant = []MolecularSequenceVariant{}
+			}
+		case "observedSeq":
+			if v, ok := d.string(); ok {
+				r.ObservedSeq = &v
+			} else {
+				r.ObservedSeq = nil
+			}
+		case "_observedSeq":
+			r.ObservedSeqElement = d.element()
+		case "quality":
+			a := d.array()
+			r.Quality = nil
+			for a.next() {
+				var v MolecularSequenceQuality
+				v.decodeJSON(d)
+				r.Quality = append(r.Quality, v)
+			}
+			if r.Quality == nil && !a.isNull {
+				r.Quality = []MolecularSequenceQuality{}
+			}
+		case "readCoverage":
+			if v, ok := d.int(); ok {
+				r.ReadCoverage = &v
+			} else {
+				r.ReadCoverage = nil
+			}
+		case "_readCoverage":
+			r.ReadCoverageElement = d.element()
+		case "repository":
+			a := d.array()
+			r.Repository = nil
+			for a.next() {
+				var v MolecularSequenceRepository
+				v.decodeJSON(d)
+				r.Repository = append(r.Repository, v)
+			}
+			if r.Repository == nil && !a.isNull {
+				r.Repository = []MolecularSequenceRepository{}
+			}
+		case "pointer":
+			a := d.array()
+			r.Pointer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Pointer = append(r.Pointer, v)
+			}
+			if r.Pointer == nil && !a.isNull {
+				r.Pointer = []Reference{}
+			}
+		case "structureVariant":
+			a := d.array()
+			r.StructureVariant = nil
+			for a.next() {
+				var v MolecularSequenceStructureVariant
+				v.decodeJSON(d)
+				r.StructureVariant = append(r.StructureVariant, v)
+			}
+			if r.StructureVariant == nil && !a.isNull {
+				r.StructureVariant = []MolecularSequenceStructureVariant{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MolecularSequence which shares no memory with the original
+func (r MolecularSequence) DeepCopy() MolecularSequence {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.CoordinateSystemElement != nil {
+		v := r.CoordinateSystemElement.DeepCopy()
+		out.CoordinateSystemElement = &v
+	}
+	if r.Patient != nil {
+		v := r.Patient.DeepCopy()
+		out.Patient = &v
+	}
+	if r.Specimen != nil {
+		v := r.Specimen.DeepCopy()
+		out.Specimen = &v
+	}
+	if r.Device != nil {
+		v := r.Device.DeepCopy()
+		out.Device = &v
+	}
+	if r.Performer != nil {
+		v := r.Performer.DeepCopy()
+		out.Performer = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.ReferenceSeq != nil {
+		v := r.ReferenceSeq.DeepCopy()
+		out.ReferenceSeq = &v
+	}
+	if r.Variant != nil {
+		out.Variant = make([]MolecularSequenceVariant, len(r.Variant))
+		for i := range r.Variant {
+			out.Variant[i] = r.Variant[i].DeepCopy()
+		}
+	}
+	if r.ObservedSeq != nil {
+		v := *r.ObservedSeq
+		out.ObservedSeq = &v
+	}
+	if r.ObservedSeqElement != nil {
+		v := r.ObservedSeqElement.DeepCopy()
+		out.ObservedSeqElement = &v
+	}
+	if r.Quality != nil {
+		out.Quality = make([]MolecularSequenceQuality, len(r.Quality))
+		for i := range r.Quality {
+			out.Quality[i] = r.Quality[i].DeepCopy()
+		}
+	}
+	if r.ReadCoverage != nil {
+		v := *r.ReadCoverage
+		out.ReadCoverage = &v
+	}
+	if r.ReadCoverageElement != nil {
+		v := r.ReadCoverageElement.DeepCopy()
+		out.ReadCoverageElement = &v
+	}
+	if r.Repository != nil {
+		out.Repository = make([]MolecularSequenceRepository, len(r.Repository))
+		for i := range r.Repository {
+			out.Repository[i] = r.Repository[i].DeepCopy()
+		}
+	}
+	if r.Pointer != nil {
+		out.Pointer = make([]Reference, len(r.Pointer))
+		for i := range r.Pointer {
+			out.Pointer[i] = r.Pointer[i].DeepCopy()
+		}
+	}
+	if r.StructureVariant != nil {
+		out.StructureVariant = make([]MolecularSequenceStructureVariant, len(r.StructureVariant))
+		for i := range r.StructureVariant {
+			out.StructureVariant[i] = r.StructureVariant[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MolecularSequence) Equal(other MolecularSequence) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.CoordinateSystem != other.CoordinateSystem {
+		return false
+	}
+	if (r.CoordinateSystemElement == nil) != (other.CoordinateSystemElement == nil) || r.CoordinateSystemElement != nil && !r.CoordinateSystemElement.Equal(*other.CoordinateSystemElement) {
+		return false
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.Equal(*other.Patient) {
+		return false
+	}
+	if (r.Specimen == nil) != (other.Specimen == nil) || r.Specimen != nil && !r.Specimen.Equal(*other.Specimen) {
+		return false
+	}
+	if (r.Device == nil) != (other.Device == nil) || r.Device != nil && !r.Device.Equal(*other.Device) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.Equal(*other.Performer) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.ReferenceSeq == nil) != (other.ReferenceSeq == nil) || r.ReferenceSeq != nil && !r.ReferenceSeq.Equal(*other.ReferenceSeq) {
+		return false
+	}
+	if len(r.Variant) != len(other.Variant) {
+		return false
+	}
+	for i := range r.Variant {
+		if !r.Variant[i].Equal(other.Variant[i]) {
+			return false
+		}
+	}
+	if (r.ObservedSeq == nil) != (other.ObservedSeq == nil) || r.ObservedSeq != nil && *r.ObservedSeq != *other.ObservedSeq {
+		return false
+	}
+	if (r.ObservedSeqElement == nil) != (other.ObservedSeqElement == nil) || r.ObservedSeqElement != nil && !r.ObservedSeqElement.Equal(*other.ObservedSeqElement) {
+		return false
+	}
+	if len(r.Quality) != len(other.Quality) {
+		return false
+	}
+	for i := range r.Quality {
+		if !r.Quality[i].Equal(other.Quality[i]) {
+			return false
+		}
+	}
+	if (r.ReadCoverage == nil) != (other.ReadCoverage == nil) || r.ReadCoverage != nil && *r.ReadCoverage != *other.ReadCoverage {
+		return false
+	}
+	if (r.ReadCoverageElement == nil) != (other.ReadCoverageElement == nil) || r.ReadCoverageElement != nil && !r.ReadCoverageElement.Equal(*other.ReadCoverageElement) {
+		return false
+	}
+	if len(r.Repository) != len(other.Repository) {
+		return false
+	}
+	for i := range r.Repository {
+		if !r.Repository[i].Equal(other.Repository[i]) {
+			return false
+		}
+	}
+	if len(r.Pointer) != len(other.Pointer) {
+		return false
+	}
+	for i := range r.Pointer {
+		if !r.Pointer[i].Equal(other.Pointer[i]) {
+			return false
+		}
+	}
+	if len(r.StructureVariant) != len(other.StructureVariant) {
+		return false
+	}
+	for i := range r.StructureVariant {
+		if !r.StructureVariant[i].Equal(other.StructureVariant[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MolecularSequence) EqualsDeep(other MolecularSequence) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if r.CoordinateSystem != other.CoordinateSystem {
+		return false
+	}
+	if (r.CoordinateSystemElement == nil) != (other.CoordinateSystemElement == nil) || r.CoordinateSystemElement != nil && !r.CoordinateSystemElement.EqualsDeep(*other.CoordinateSystemElement) {
+		return false
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.EqualsDeep(*other.Patient) {
+		return false
+	}
+	if (r.Specimen == nil) != (other.Specimen == nil) || r.Specimen != nil && !r.Specimen.EqualsDeep(*other.Specimen) {
+		return false
+	}
+	if (r.Device == nil) != (other.Device == nil) || r.Device != nil && !r.Device.EqualsDeep(*other.Device) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.EqualsDeep(*other.Performer) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.ReferenceSeq == nil) != (other.ReferenceSeq == nil) || r.ReferenceSeq != nil && !r.ReferenceSeq.EqualsDeep(*other.ReferenceSeq) {
+		return false
+	}
+	if !equivalentList(len(r.Variant), len(other.Variant), func(i, j int) bool {
+		return r.Variant[i].EqualsDeep(other.Variant[j])
+	}) {
+		return false
+	}
+	if (r.ObservedSeq == nil) != (other.ObservedSeq == nil) || r.ObservedSeq != nil && !equivalentString(*r.ObservedSeq, *other.ObservedSeq) {
+		return false
+	}
+	if (r.ObservedSeqElement == nil) != (other.ObservedSeqElement == nil) || r.ObservedSeqElement != nil && !r.ObservedSeqElement.EqualsDeep(*other.ObservedSeqElement) {
+		return false
+	}
+	if !equivalentList(len(r.Quality), len(other.Quality), func(i, j int) bool {
+		return r.Quality[i].EqualsDeep(other.Quality[j])
+	}) {
+		return false
+	}
+	if (r.ReadCoverage == nil) != (other.ReadCoverage == nil) || r.ReadCoverage != nil && *r.ReadCoverage != *other.ReadCoverage {
+		return false
+	}
+	if (r.ReadCoverageElement == nil) != (other.ReadCoverageElement == nil) || r.ReadCoverageElement != nil && !r.ReadCoverageElement.EqualsDeep(*other.ReadCoverageElement) {
+		return false
+	}
+	if !equivalentList(len(r.Repository), len(other.Repository), func(i, j int) bool {
+		return r.Repository[i].EqualsDeep(other.Repository[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Pointer), len(other.Pointer), func(i, j int) bool {
+		return r.Pointer[i].EqualsDeep(other.Pointer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.StructureVariant), len(other.StructureVariant), func(i, j int) bool {
+		return r.StructureVariant[i].EqualsDeep(other.StructureVariant[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MolecularSequence) EqualsShallow(other MolecularSequence) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if r.CoordinateSystem != other.CoordinateSystem {
+		return false
+	}
+	if (r.ObservedSeq == nil) != (other.ObservedSeq == nil) || r.ObservedSeq != nil && !equivalentString(*r.ObservedSeq, *other.ObservedSeq) {
+		return false
+	}
+	if (r.ReadCoverage == nil) != (other.ReadCoverage == nil) || r.ReadCoverage != nil && *r.ReadCoverage != *other.ReadCoverage {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MolecularSequence as FHIR XML
+func (r MolecularSequence) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "MolecularSequence",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "coordinateSystem", r.CoordinateSystem, r.CoordinateSystemElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "specimen", r.Specimen); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "device", r.Device); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performer", r.Performer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "referenceSeq", r.ReferenceSeq); err != nil {
+		return err
+	}
+	for _, v := range r.Variant {
+		if err := encodeXMLElement(e, "variant", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "observedSeq", r.ObservedSeq, r.ObservedSeqElement); err != nil {
+		return err
+	}
+	for _, v := range r.Quality {
+		if err := encodeXMLElement(e, "quality", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "readCoverage", r.ReadCoverage, r.ReadCoverageElement); err != nil {
+		return err
+	}
+	for _, v := range r.Repository {
+		if err := encodeXMLElement(e, "repository", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Pointer {
+		if err := encodeXMLElement(e, "pointer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.StructureVariant {
+		if err := encodeXMLElement(e, "structureVariant", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MolecularSequence from FHIR XML
+func (r *MolecularSequence) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "coordinateSystem":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CoordinateSystem = v
+				}
+				r.CoordinateSystemElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = &v
+			case "specimen":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specimen = &v
+			case "device":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Device = &v
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "referenceSeq":
+				var v MolecularSequenceReferenceSeq
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferenceSeq = &v
+			case "variant":
+				var v MolecularSequenceVariant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Variant = append(r.Variant, v)
+			case "observedSeq":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ObservedSeq = &v
+				}
+				r.ObservedSeqElement = element
+			case "quality":
+				var v MolecularSequenceQuality
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quality = append(r.Quality, v)
+			case "readCoverage":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReadCoverage = &v
+				}
+				r.ReadCoverageElement = element
+			case "repository":
+				var v MolecularSequenceRepository
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Repository = append(r.Repository, v)
+			case "pointer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Pointer = append(r.Pointer, v)
+			case "structureVariant":
+				var v MolecularSequenceStructureVariant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StructureVariant = append(r.StructureVariant, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MolecularSequence as properties to the RDF node n
+func (r MolecularSequence) turtle(n *turtleNode) {
+	n.resource("MolecularSequence", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("MolecularSequence.identifier", i, v)
+	}
+	n.primitive("MolecularSequence.type", -1, r.Type, r.TypeElement, "string")
+	n.primitive("MolecularSequence.coordinateSystem", -1, r.CoordinateSystem, r.CoordinateSystemElement, "integer")
+	n.element("MolecularSequence.patient", -1, r.Patient)
+	n.element("MolecularSequence.specimen", -1, r.Specimen)
+	n.element("MolecularSequence.device", -1, r.Device)
+	n.element("MolecularSequence.performer", -1, r.Performer)
+	n.element("MolecularSequence.quantity", -1, r.Quantity)
+	n.element("MolecularSequence.referenceSeq", -1, r.ReferenceSeq)
+	for i, v := range r.Variant {
+		n.element("MolecularSequence.variant", i, v)
+	}
+	n.primitive("MolecularSequence.observedSeq", -1, r.ObservedSeq, r.ObservedSeqElement, "string")
+	for i, v := range r.Quality {
+		n.element("MolecularSequence.quality", i, v)
+	}
+	n.primitive("MolecularSequence.readCoverage", -1, r.ReadCoverage, r.ReadCoverageElement, "integer")
+	for i, v := range r.Repository {
+		n.element("MolecularSequence.repository", i, v)
+	}
+	for i, v := range r.Pointer {
+		n.element("MolecularSequence.pointer", i, v)
+	}
+	for i, v := range r.StructureVariant {
+		n.element("MolecularSequence.structureVariant", i, v)
+	}
+}
+
+// MarshalBSON marshals the given MolecularSequence as BSON document with the structure of its FHIR JSON
+func (r MolecularSequence) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MolecularSequence from a BSON document with the structure of its FHIR JSON
+func (r *MolecularSequence) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MolecularSequence as protobuf message MolecularSequence of fhir.proto
+func (r MolecularSequence) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MolecularSequence to the encoded message
+func (r MolecularSequence) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Type != nil {
+		e.string(10, *r.Type)
+	}
+	if r.CoordinateSystem != 0 {
+		e.varint(11, uint64(int64(r.CoordinateSystem)))
+	}
+	if r.Patient != nil {
+		e.message(12, *r.Patient)
+	}
+	if r.Specimen != nil {
+		e.message(13, *r.Specimen)
+	}
+	if r.Device != nil {
+		e.message(14, *r.Device)
+	}
+	if r.Performer != nil {
+		e.message(15, *r.Performer)
+	}
+	if r.Quantity != nil {
+		e.message(16, *r.Quantity)
+	}
+	if r.ReferenceSeq != nil {
+		e.message(17, *r.ReferenceSeq)
+	}
+	for _, v := range r.Variant {
+		e.message(18, v)
+	}
+	if r.ObservedSeq != nil {
+		e.string(19, *r.ObservedSeq)
+	}
+	for _, v := range r.Quality {
+		e.message(20, v)
+	}
+	if r.ReadCoverage != nil {
+		e.varint(21, uint64(int64(*r.ReadCoverage)))
+	}
+	for _, v := range r.Repository {
+		e.message(22, v)
+	}
+	for _, v := range r.Pointer {
+		e.message(23, v)
+	}
+	for _, v := range r.StructureVariant {
+		e.message(24, v)
+	}
+	if r.IdElement != nil {
+		e.message(25, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(26, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(27, *r.LanguageElement)
+	}
+	if r.TypeElement != nil {
+		e.message(28, *r.TypeElement)
+	}
+	if r.CoordinateSystemElement != nil {
+		e.message(29, *r.CoordinateSystemElement)
+	}
+	if r.ObservedSeqElement != nil {
+		e.message(30, *r.ObservedSeqElement)
+	}
+	if r.ReadCoverageElement != nil {
+		e.message(31, *r.ReadCoverageElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MolecularSequence from protobuf message MolecularSequence of fhir.proto
+func (r *MolecularSequence) UnmarshalProto(b []byte) error {
+	*r = MolecularSequence{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.string()
+			r.Type = &v
+		case 11:
+			r.CoordinateSystem = d.int()
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Patient = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Specimen = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Device = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Performer = &v
+		case 16:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 17:
+			var v MolecularSequenceReferenceSeq
+			d.message(&v)
+			r.ReferenceSeq = &v
+		case 18:
+			var v MolecularSequenceVariant
+			d.message(&v)
+			r.Variant = append(r.Variant, v)
+		case 19:
+			v := d.string()
+			r.ObservedSeq = &v
+		case 20:
+			var v MolecularSequenceQuality
+			d.message(&v)
+			r.Quality = append(r.Quality, v)
+		case 21:
+			v := d.int()
+			r.ReadCoverage = &v
+		case 22:
+			var v MolecularSequenceRepository
+			d.message(&v)
+			r.Repository = append(r.Repository, v)
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Pointer = append(r.Pointer, v)
+		case 24:
+			var v MolecularSequenceStructureVariant
+			d.message(&v)
+			r.StructureVariant = append(r.StructureVariant, v)
+		case 25:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.CoordinateSystemElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ObservedSeqElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.ReadCoverageElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MolecularSequenceReferenceSeq as JSON into a byte slice
+func (r MolecularSequenceReferenceSeq) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MolecularSequenceReferenceSeq as JSON object with the members in the order of the fields
+func (r MolecularSequenceReferenceSeq) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Chromosome != nil {
+		e.key("chromosome")
+		r.Chromosome.appendJSON(e)
+	}
+	if r.GenomeBuild != nil {
+		e.key("genomeBuild")
+		e.string(*r.GenomeBuild)
+	}
+	if r.GenomeBuildElement != nil {
+		e.key("_genomeBuild")
+		r.GenomeBuildElement.appendJSON(e)
+	}
+	if r.Orientation != nil {
+		e.key("orientation")
+		e.string(*r.Orientation)
+	}
+	if r.OrientationElement != nil {
+		e.key("_orientation")
+		r.OrientationElement.appendJSON(e)
+	}
+	if r.ReferenceSeqId != nil {
+		e.key("referenceSeqId")
+		r.ReferenceSeqId.appendJSON(e)
+	}
+	if r.ReferenceSeqPointer != nil {
+		e.key("referenceSeqPointer")
+		r.ReferenceSeqPointer.appendJSON(e)
+	}
+	if r.ReferenceSeqString != nil {
+		e.key("referenceSeqString")
+		e.string(*r.ReferenceSeqString)
+	}
+	if r.ReferenceSeqStringElement != nil {
+		e.key("_referenceSeqString")
+		r.ReferenceSeqStringElement.appendJSON(e)
+	}
+	if r.Strand != nil {
+		e.key("strand")
+		e.string(*r.Strand)
+	}
+	if r.StrandElement != nil {
+		e.key("_strand")
+		r.StrandElement.appendJSON(e)
+	}
+	if r.WindowStart != nil {
+		e.key("windowStart")
+		e.int(*r.WindowStart)
+	}
+	if r.WindowStartElement != nil {
+		e.key("_windowStart")
+		r.WindowStartElement.appendJSON(e)
+	}
+	if r.WindowEnd != nil {
+		e.key("windowEnd")
+		e.int(*r.WindowEnd)
+	}
+	if r.WindowEndElement != nil {
+		e.key("_windowEnd")
+		r.WindowEndElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MolecularSequenceReferenceSeq from JSON. Unknown members are ignored.
+func (r *MolecularSequenceReferenceSeq) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MolecularSequenceReferenceSeq
+func (r *MolecularSequenceReferenceSeq) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "chromosome":
+			if d.null() {
+				r.Chromosome = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Chromosome = &v
+			}
+		case "genomeBuild":
+			if v, ok := d.string(); ok {
+				r.GenomeBuild = &v
+			} else {
+				r.GenomeBuild = nil
+			}
+		case "_genomeBuild":
+			r.GenomeBuildElement = d.element()
+		case "orientation":
+			if v, ok := d.string(); ok {
+				r.Orientation = &v
+			} else {
+				r.Orientation = nil
+			}
+		case "_orientation":
+			r.OrientationElement = d.element()
+		case "referenceSeqId":
+			if d.null() {
+				r.ReferenceSeqId = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReferenceSeqId = &v
+			}
+		case "referenceSeqPointer":
+			if d.null() {
+				r.ReferenceSeqPointer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReferenceSeqPointer = &v
+			}
+		case "referenceSeqString":
+			if v, ok := d.string(); ok {
+				r.ReferenceSeqString = &v
+			} else {
+				r.ReferenceSeqString = nil
+			}
+		case "_referenceSeqString":
+			r.ReferenceSeqStringElement = d.element()
+		case "strand":
+			if v, ok := d.string(); ok {
+				r.Strand = &v
+			} else {
+				r.Strand = nil
+			}
+		case "_strand":
+			r.StrandElement = d.element()
+		case "windowStart":
+			if v, ok := d.int(); ok {
+				r.WindowStart = &v
+			} else {
+				r.WindowStart = nil
+			}
+		case "_windowStart":
+			r.WindowStartElement = d.element()
+		case "windowEnd":
+			if v, ok := d.int(); ok {
+				r.WindowEnd = &v
+			} else {
+				r.WindowEnd = nil
+			}
+		case "_windowEnd":
+			r.WindowEndElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MolecularSequenceReferenceSeq which shares no memory with the original
+func (r MolecularSequenceReferenceSeq) DeepCopy() MolecularSequenceReferenceSeq {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Chromosome != nil {
+		v := r.Chromosome.DeepCopy()
+		out.Chromosome = &v
+	}
+	if r.GenomeBuild != nil {
+		v := *r.GenomeBuild
+		out.GenomeBuild = &v
+	}
+	if r.GenomeBuildElement != nil {
+		v := r.GenomeBuildElement.DeepCopy()
+		out.GenomeBuildElement = &v
+	}
+	if r.Orientation != nil {
+		v := *r.Orientation
+		out.Orientation = &v
+	}
+	if r.OrientationElement != nil {
+		v := r.OrientationElement.DeepCopy()
+		out.OrientationElement = &v
+	}
+	if r.ReferenceSeqId != nil {
+		v := r.ReferenceSeqId.DeepCopy()
+		out.ReferenceSeqId = &v
+	}
+	if r.ReferenceSeqPointer != nil {
+		v := r.ReferenceSeqPointer.DeepCopy()
+		out.ReferenceSeqPointer = &v
+	}
+	if r.ReferenceSeqString != nil {
+		v := *r.ReferenceSeqString
+		out.ReferenceSeqString = &v
+	}
+	if r.ReferenceSeqStringElement != nil {
+		v := r.ReferenceSeqStringElement.DeepCopy()
+		out.ReferenceSeqStringElement = &v
+	}
+	if r.Strand != nil {
+		v := *r.Strand
+		out.Strand = &v
+	}
+	if r.StrandElement != nil {
+		v := r.StrandElement.DeepCopy()
+		out.StrandElement = &v
+	}
+	if r.WindowStart != nil {
+		v := *r.WindowStart
+		out.WindowStart = &v
+	}
+	if r.WindowStartElement != nil {
+		v := r.WindowStartElement.DeepCopy()
+		out.WindowStartElement = &v
+	}
+	if r.WindowEnd != nil {
+		v := *r.WindowEnd
+		out.WindowEnd = &v
+	}
+	if r.WindowEndElement != nil {
+		v := r.WindowEndElement.DeepCopy()
+		out.WindowEndElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MolecularSequenceReferenceSeq) Equal(other MolecularSequenceReferenceSeq) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Chromosome == nil) != (other.Chromosome == nil) || r.Chromosome != nil && !r.Chromosome.Equal(*other.Chromosome) {
+		return false
+	}
+	if (r.GenomeBuild == nil) != (other.GenomeBuild == nil) || r.GenomeBuild != nil && *r.GenomeBuild != *other.GenomeBuild {
+		return false
+	}
+	if (r.GenomeBuildElement == nil) != (other.GenomeBuildElement == nil) || r.GenomeBuildElement != nil && !r.GenomeBuildElement.Equal(*other.GenomeBuildElement) {
+		return false
+	}
+	if (r.Orientation == nil) != (other.Orientation == nil) || r.Orientation != nil && *r.Orientation != *other.Orientation {
+		return false
+	}
+	if (r.OrientationElement == nil) != (other.OrientationElement == nil) || r.OrientationElement != nil && !r.OrientationElement.Equal(*other.OrientationElement) {
+		return false
+	}
+	if (r.ReferenceSeqId == nil) != (other.ReferenceSeqId == nil) || r.ReferenceSeqId != nil && !r.ReferenceSeqId.Equal(*other.ReferenceSeqId) {
+		return false
+	}
+	if (r.ReferenceSeqPointer == nil) != (other.ReferenceSeqPointer == nil) || r.ReferenceSeqPointer != nil && !r.ReferenceSeqPointer.Equal(*other.ReferenceSeqPointer) {
+		return false
+	}
+	if (r.ReferenceSeqString == nil) != (other.ReferenceSeqString == nil) || r.ReferenceSeqString != nil && *r.ReferenceSeqString != *other.ReferenceSeqString {
+		return false
+	}
+	if (r.ReferenceSeqStringElement == nil) != (other.ReferenceSeqStringElement == nil) || r.ReferenceSeqStringElement != nil && !r.ReferenceSeqStringElement.Equal(*other.ReferenceSeqStringElement) {
+		return false
+	}
+	if (r.Strand == nil) != (other.Strand == nil) || r.Strand != nil && *r.Strand != *other.Strand {
+		return false
+	}
+	if (r.StrandElement == nil) != (other.StrandElement == nil) || r.StrandElement != nil && !r.StrandElement.Equal(*other.StrandElement) {
+		return false
+	}
+	if (r.WindowStart == nil) != (other.WindowStart == nil) || r.WindowStart != nil && *r.WindowStart != *other.WindowStart {
+		return false
+	}
+	if (r.WindowStartElement == nil) != (other.WindowStartElement == nil) || r.WindowStartElement != nil && !r.WindowStartElement.Equal(*other.WindowStartElement) {
+		return false
+	}
+	if (r.WindowEnd == nil) != (other.WindowEnd == nil) || r.WindowEnd != nil && *r.WindowEnd != *other.WindowEnd {
+		return false
+	}
+	if (r.WindowEndElement == nil) != (other.WindowEndElement == nil) || r.WindowEndElement != nil && !r.WindowEndElement.Equal(*other.WindowEndElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MolecularSequenceReferenceSeq) EqualsDeep(other MolecularSequenceReferenceSeq) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Chromosome == nil) != (other.Chromosome == nil) || r.Chromosome != nil && !r.Chromosome.EqualsDeep(*other.Chromosome) {
+		return false
+	}
+	if (r.GenomeBuild == nil) != (other.GenomeBuild == nil) || r.GenomeBuild != nil && !equivalentString(*r.GenomeBuild, *other.GenomeBuild) {
+		return false
+	}
+	if (r.GenomeBuildElement == nil) != (other.GenomeBuildElement == nil) || r.GenomeBuildElement != nil && !r.GenomeBuildElement.EqualsDeep(*other.GenomeBuildElement) {
+		return false
+	}
+	if (r.Orientation == nil) != (other.Orientation == nil) || r.Orientation != nil && !equivalentString(*r.Orientation, *other.Orientation) {
+		return false
+	}
+	if (r.OrientationElement == nil) != (other.OrientationElement == nil) || r.OrientationElement != nil && !r.OrientationElement.EqualsDeep(*other.OrientationElement) {
+		return false
+	}
+	if (r.ReferenceSeqId == nil) != (other.ReferenceSeqId == nil) || r.ReferenceSeqId != nil && !r.ReferenceSeqId.EqualsDeep(*other.ReferenceSeqId) {
+		return false
+	}
+	if (r.ReferenceSeqPointer == nil) != (other.ReferenceSeqPointer == nil) || r.ReferenceSeqPointer != nil && !r.ReferenceSeqPointer.EqualsDeep(*other.ReferenceSeqPointer) {
+		return false
+	}
+	if (r.ReferenceSeqString == nil) != (other.ReferenceSeqString == nil) || r.ReferenceSeqString != nil && !equivalentString(*r.ReferenceSeqString, *other.ReferenceSeqString) {
+		return false
+	}
+	if (r.ReferenceSeqStringElement == nil) != (other.ReferenceSeqStringElement == nil) || r.ReferenceSeqStringElement != nil && !r.ReferenceSeqStringElement.EqualsDeep(*other.ReferenceSeqStringElement) {
+		return false
+	}
+	if (r.Strand == nil) != (other.Strand == nil) || r.Strand != nil && !equivalentString(*r.Strand, *other.Strand) {
+		return false
+	}
+	if (r.StrandElement == nil) != (other.StrandElement == nil) || r.StrandElement != nil && !r.StrandElement.EqualsDeep(*other.StrandElement) {
+		return false
+	}
+	if (r.WindowStart == nil) != (other.WindowStart == nil) || r.WindowStart != nil && *r.WindowStart != *other.WindowStart {
+		return false
+	}
+	if (r.WindowStartElement == nil) != (other.WindowStartElement == nil) || r.WindowStartElement != nil && !r.WindowStartElement.EqualsDeep(*other.WindowStartElement) {
+		return false
+	}
+	if (r.WindowEnd == nil) != (other.WindowEnd == nil) || r.WindowEnd != nil && *r.WindowEnd != *other.WindowEnd {
+		return false
+	}
+	if (r.WindowEndElement == nil) != (other.WindowEndElement == nil) || r.WindowEndElement != nil && !r.WindowEndElement.EqualsDeep(*other.WindowEndElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MolecularSequenceReferenceSeq) EqualsShallow(other MolecularSequenceReferenceSeq) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.GenomeBuild == nil) != (other.GenomeBuild == nil) || r.GenomeBuild != nil && !equivalentString(*r.GenomeBuild, *other.GenomeBuild) {
+		return false
+	}
+	if (r.Orientation == nil) != (other.Orientation == nil) || r.Orientation != nil && !equivalentString(*r.Orientation, *other.Orientation) {
+		return false
+	}
+	if (r.ReferenceSeqString == nil) != (other.ReferenceSeqString == nil) || r.ReferenceSeqString != nil && !equivalentString(*r.ReferenceSeqString, *other.ReferenceSeqString) {
+		return false
+	}
+	if (r.Strand == nil) != (other.Strand == nil) || r.Strand != nil && !equivalentString(*r.Strand, *other.Strand) {
+		return false
+	}
+	if (r.WindowStart == nil) != (other.WindowStart == nil) || r.WindowStart != nil && *r.WindowStart != *other.WindowStart {
+		return false
+	}
+	if (r.WindowEnd == nil) != (other.WindowEnd == nil) || r.WindowEnd != nil && *r.WindowEnd != *other.WindowEnd {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MolecularSequenceReferenceSeq as FHIR XML
+func (r MolecularSequenceReferenceSeq) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "chromosome", r.Chromosome); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "genomeBuild", r.GenomeBuild, r.GenomeBuildElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "orientation", r.Orientation, r.OrientationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "referenceSeqId", r.ReferenceSeqId); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "referenceSeqPointer", r.ReferenceSeqPointer); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "referenceSeqString", r.ReferenceSeqString, r.ReferenceSeqStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "strand", r.Strand, r.StrandElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "windowStart", r.WindowStart, r.WindowStartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "windowEnd", r.WindowEnd, r.WindowEndElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MolecularSequenceReferenceSeq from FHIR XML
+func (r *MolecularSequenceReferenceSeq) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "chromosome":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Chromosome = &v
+			case "genomeBuild":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.GenomeBuild = &v
+				}
+				r.GenomeBuildElement = element
+			case "orientation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Orientation = &v
+				}
+				r.OrientationElement = element
+			case "referenceSeqId":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferenceSeqId = &v
+			case "referenceSeqPointer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferenceSeqPointer = &v
+			case "referenceSeqString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReferenceSeqString = &v
+				}
+				r.ReferenceSeqStringElement = element
+			case "strand":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Strand = &v
+				}
+				r.StrandElement = element
+			case "windowStart":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.WindowStart = &v
+				}
+				r.WindowStartElement = element
+			case "windowEnd":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.WindowEnd = &v
+				}
+				r.WindowEndElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MolecularSequenceReferenceSeq as properties to the RDF node n
+func (r MolecularSequenceReferenceSeq) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MolecularSequence.referenceSeq.chromosome", -1, r.Chromosome)
+	n.primitive("MolecularSequence.referenceSeq.genomeBuild", -1, r.GenomeBuild, r.GenomeBuildElement, "string")
+	n.primitive("MolecularSequence.referenceSeq.orientation", -1, r.Orientation, r.OrientationElement, "string")
+	n.element("MolecularSequence.referenceSeq.referenceSeqId", -1, r.ReferenceSeqId)
+	n.element("MolecularSequence.referenceSeq.referenceSeqPointer", -1, r.ReferenceSeqPointer)
+	n.primitive("MolecularSequence.referenceSeq.referenceSeqString", -1, r.ReferenceSeqString, r.ReferenceSeqStringElement, "string")
+	n.primitive("MolecularSequence.referenceSeq.strand", -1, r.Strand, r.StrandElement, "string")
+	n.primitive("MolecularSequence.referenceSeq.windowStart", -1, r.WindowStart, r.WindowStartElement, "integer")
+	n.primitive("MolecularSequence.referenceSeq.windowEnd", -1, r.WindowEnd, r.WindowEndElement, "integer")
+}
+
+// MarshalBSON marshals the given MolecularSequenceReferenceSeq as BSON document with the structure of its FHIR JSON
+func (r MolecularSequenceReferenceSeq) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MolecularSequenceReferenceSeq from a BSON document with the structure of its FHIR JSON
+func (r *MolecularSequenceReferenceSeq) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MolecularSequenceReferenceSeq as protobuf message MolecularSequenceReferenceSeq of fhir.proto
+func (r MolecularSequenceReferenceSeq) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MolecularSequenceReferenceSeq to the encoded message
+func (r MolecularSequenceReferenceSeq) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Chromosome != nil {
+		e.message(4, *r.Chromosome)
+	}
+	if r.GenomeBuild != nil {
+		e.string(5, *r.GenomeBuild)
+	}
+	if r.Orientation != nil {
+		e.string(6, *r.Orientation)
+	}
+	if r.ReferenceSeqId != nil {
+		e.message(7, *r.ReferenceSeqId)
+	}
+	if r.ReferenceSeqPointer != nil {
+		e.message(8, *r.ReferenceSeqPointer)
+	}
+	if r.ReferenceSeqString != nil {
+		e.string(9, *r.ReferenceSeqString)
+	}
+	if r.Strand != nil {
+		e.string(10, *r.Strand)
+	}
+	if r.WindowStart != nil {
+		e.varint(11, uint64(int64(*r.WindowStart)))
+	}
+	if r.WindowEnd != nil {
+		e.varint(12, uint64(int64(*r.WindowEnd)))
+	}
+	if r.GenomeBuildElement != nil {
+		e.message(13, *r.GenomeBuildElement)
+	}
+	if r.OrientationElement != nil {
+		e.message(14, *r.OrientationElement)
+	}
+	if r.ReferenceSeqStringElement != nil {
+		e.message(15, *r.ReferenceSeqStringElement)
+	}
+	if r.StrandElement != nil {
+		e.message(16, *r.StrandElement)
+	}
+	if r.WindowStartElement != nil {
+		e.message(17, *r.WindowStartElement)
+	}
+	if r.WindowEndElement != nil {
+		e.message(18, *r.WindowEndElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MolecularSequenceReferenceSeq from protobuf message MolecularSequenceReferenceSeq of fhir.proto
+func (r *MolecularSequenceReferenceSeq) UnmarshalProto(b []byte) error {
+	*r = MolecularSequenceReferenceSeq{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Chromosome = &v
+		case 5:
+			v := d.string()
+			r.GenomeBuild = &v
+		case 6:
+			v := d.string()
+			r.Orientation = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReferenceSeqId = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.ReferenceSeqPointer = &v
+		case 9:
+			v := d.string()
+			r.ReferenceSeqString = &v
+		case 10:
+			v := d.string()
+			r.Strand = &v
+		case 11:
+			v := d.int()
+			r.WindowStart = &v
+		case 12:
+			v := d.int()
+			r.WindowEnd = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.GenomeBuildElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.OrientationElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ReferenceSeqStringElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.StrandElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.WindowStartElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.WindowEndElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MolecularSequenceVariant as JSON into a byte slice
+func (r MolecularSequenceVariant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MolecularSequenceVariant as JSON object with the members in the order of the fields
+func (r MolecularSequenceVariant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Start != nil {
+		e.key("start")
+		e.int(*r.Start)
+	}
+	if r.StartElement != nil {
+		e.key("_start")
+		r.StartElement.appendJSON(e)
+	}
+	if r.End != nil {
+		e.key("end")
+		e.int(*r.End)
+	}
+	if r.EndElement != nil {
+		e.key("_end")
+		r.EndElement.appendJSON(e)
+	}
+	if r.ObservedAllele != nil {
+		e.key("observedAllele")
+		e.string(*r.ObservedAllele)
+	}
+	if r.ObservedAlleleElement != nil {
+		e.key("_observedAllele")
+		r.ObservedAlleleElement.appendJSON(e)
+	}
+	if r.ReferenceAllele != nil {
+		e.key("referenceAllele")
+		e.string(*r.ReferenceAllele)
+	}
+	if r.ReferenceAlleleElement != nil {
+		e.key("_referenceAllele")
+		r.ReferenceAlleleElement.appendJSON(e)
+	}
+	if r.Cigar != nil {
+		e.key("cigar")
+		e.string(*r.Cigar)
+	}
+	if r.CigarElement != nil {
+		e.key("_cigar")
+		r.CigarElement.appendJSON(e)
+	}
+	if r.VariantPointer != nil {
+		e.key("variantPointer")
+		r.VariantPointer.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MolecularSequenceVariant from JSON. Unknown members are ignored.
+func (r *MolecularSequenceVariant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MolecularSequenceVariant
+func (r *MolecularSequenceVariant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "start":
+			if v, ok := d.int(); ok {
+				r.Start = &v
+			} else {
+				r.Start = nil
+			}
+		case "_start":
+			r.StartElement = d.element()
+		case "end":
+			if v, ok := d.int(); ok {
+				r.End = &v
+			} else {
+				r.End = nil
+			}
+		case "_end":
+			r.EndElement = d.element()
+		case "observedAllele":
+			if v, ok := d.string(); ok {
+				r.ObservedAllele = &v
+			} else {
+				r.ObservedAllele = nil
+			}
+		case "_observedAllele":
+			r.ObservedAlleleElement = d.element()
+		case "referenceAllele":
+			if v, ok := d.string(); ok {
+				r.ReferenceAllele = &v
+			} else {
+				r.ReferenceAllele = nil
+			}
+		case "_referenceAllele":
+			r.ReferenceAlleleElement = d.element()
+		case "cigar":
+			if v, ok := d.string(); ok {
+				r.Cigar = &v
+			} else {
+				r.Cigar = nil
+			}
+		case "_cigar":
+			r.CigarElement = d.element()
+		case "variantPointer":
+			if d.null() {
+				r.VariantPointer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.VariantPointer = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MolecularSequenceVariant which shares no memory with the original
+func (r MolecularSequenceVariant) DeepCopy() MolecularSequenceVariant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Start != nil {
+		v := *r.Start
+		out.Start = &v
+	}
+	if r.StartElement != nil {
+		v := r.StartElement.DeepCopy()
+		out.StartElement = &v
+	}
+	if r.End != nil {
+		v := *r.End
+		out.End = &v
+	}
+	if r.EndElement != nil {
+		v := r.EndElement.DeepCopy()
+		out.EndElement = &v
+	}
+	if r.ObservedAllele != nil {
+		v := *r.ObservedAllele
+		out.ObservedAllele = &v
+	}
+	if r.ObservedAlleleElement != nil {
+		v := r.ObservedAlleleElement.DeepCopy()
+		out.ObservedAlleleElement = &v
+	}
+	if r.ReferenceAllele != nil {
+		v := *r.ReferenceAllele
+		out.ReferenceAllele = &v
+	}
+	if r.ReferenceAlleleElement != nil {
+		v := r.ReferenceAlleleElement.DeepCopy()
+		out.ReferenceAlleleElement = &v
+	}
+	if r.Cigar != nil {
+		v := *r.Cigar
+		out.Cigar = &v
+	}
+	if r.CigarElement != nil {
+		v := r.CigarElement.DeepCopy()
+		out.CigarElement = &v
+	}
+	if r.VariantPointer != nil {
+		v := r.VariantPointer.DeepCopy()
+		out.VariantPointer = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MolecularSequenceVariant) Equal(other MolecularSequenceVariant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.Equal(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.Equal(*other.EndElement) {
+		return false
+	}
+	if (r.ObservedAllele == nil) != (other.ObservedAllele == nil) || r.ObservedAllele != nil && *r.ObservedAllele != *other.ObservedAllele {
+		return false
+	}
+	if (r.ObservedAlleleElement == nil) != (other.ObservedAlleleElement == nil) || r.ObservedAlleleElement != nil && !r.ObservedAlleleElement.Equal(*other.ObservedAlleleElement) {
+		return false
+	}
+	if (r.ReferenceAllele == nil) != (other.ReferenceAllele == nil) || r.ReferenceAllele != nil && *r.ReferenceAllele != *other.ReferenceAllele {
+		return false
+	}
+	if (r.ReferenceAlleleElement == nil) != (other.ReferenceAlleleElement == nil) || r.ReferenceAlleleElement != nil && !r.ReferenceAlleleElement.Equal(*other.ReferenceAlleleElement) {
+		return false
+	}
+	if (r.Cigar == nil) != (other.Cigar == nil) || r.Cigar != nil && *r.Cigar != *other.Cigar {
+		return false
+	}
+	if (r.CigarElement == nil) != (other.CigarElement == nil) || r.CigarElement != nil && !r.CigarElement.Equal(*other.CigarElement) {
+		return false
+	}
+	if (r.VariantPointer == nil) != (other.VariantPointer == nil) || r.VariantPointer != nil && !r.VariantPointer.Equal(*other.VariantPointer) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MolecularSequenceVariant) EqualsDeep(other MolecularSequenceVariant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.EqualsDeep(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.EqualsDeep(*other.EndElement) {
+		return false
+	}
+	if (r.ObservedAllele == nil) != (other.ObservedAllele == nil) || r.ObservedAllele != nil && !equivalentString(*r.ObservedAllele, *other.ObservedAllele) {
+		return false
+	}
+	if (r.ObservedAlleleElement == nil) != (other.ObservedAlleleElement == nil) || r.ObservedAlleleElement != nil && !r.ObservedAlleleElement.EqualsDeep(*other.ObservedAlleleElement) {
+		return false
+	}
+	if (r.ReferenceAllele == nil) != (other.ReferenceAllele == nil) || r.ReferenceAllele != nil && !equivalentString(*r.ReferenceAllele, *other.ReferenceAllele) {
+		return false
+	}
+	if (r.ReferenceAlleleElement == nil) != (other.ReferenceAlleleElement == nil) || r.ReferenceAlleleElement != nil && !r.ReferenceAlleleElement.EqualsDeep(*other.ReferenceAlleleElement) {
+		return false
+	}
+	if (r.Cigar == nil) != (other.Cigar == nil) || r.Cigar != nil && !equivalentString(*r.Cigar, *other.Cigar) {
+		return false
+	}
+	if (r.CigarElement == nil) != (other.CigarElement == nil) || r.CigarElement != nil && !r.CigarElement.EqualsDeep(*other.CigarElement) {
+		return false
+	}
+	if (r.VariantPointer == nil) != (other.VariantPointer == nil) || r.VariantPointer != nil && !r.VariantPointer.EqualsDeep(*other.VariantPointer) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MolecularSequenceVariant) EqualsShallow(other MolecularSequenceVariant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.ObservedAllele == nil) != (other.ObservedAllele == nil) || r.ObservedAllele != nil && !equivalentString(*r.ObservedAllele, *other.ObservedAllele) {
+		return false
+	}
+	if (r.ReferenceAllele == nil) != (other.ReferenceAllele == nil) || r.ReferenceAllele != nil && !equivalentString(*r.ReferenceAllele, *other.ReferenceAllele) {
+		return false
+	}
+	if (r.Cigar == nil) != (other.Cigar == nil) || r.Cigar != nil && !equivalentString(*r.Cigar, *other.Cigar) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MolecularSequenceVariant as FHIR XML
+func (r MolecularSequenceVariant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "start", r.Start, r.StartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "end", r.End, r.EndElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "observedAllele", r.ObservedAllele, r.ObservedAlleleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "referenceAllele", r.ReferenceAllele, r.ReferenceAlleleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "cigar", r.Cigar, r.CigarElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "variantPointer", r.VariantPointer); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MolecularSequenceVariant from FHIR XML
+func (r *MolecularSequenceVariant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "start":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Start = &v
+				}
+				r.StartElement = element
+			case "end":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.End = &v
+				}
+				r.EndElement = element
+			case "observedAllele":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ObservedAllele = &v
+				}
+				r.ObservedAlleleElement = element
+			case "referenceAllele":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReferenceAllele = &v
+				}
+				r.ReferenceAlleleElement = element
+			case "cigar":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Cigar = &v
+				}
+				r.CigarElement = element
+			case "variantPointer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.VariantPointer = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MolecularSequenceVariant as properties to the RDF node n
+func (r MolecularSequenceVariant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MolecularSequence.variant.start", -1, r.Start, r.StartElement, "integer")
+	n.primitive("MolecularSequence.variant.end", -1, r.End, r.EndElement, "integer")
+	n.primitive("MolecularSequence.variant.observedAllele", -1, r.ObservedAllele, r.ObservedAlleleElement, "string")
+	n.primitive("MolecularSequence.variant.referenceAllele", -1, r.ReferenceAllele, r.ReferenceAlleleElement, "string")
+	n.primitive("MolecularSequence.variant.cigar", -1, r.Cigar, r.CigarElement, "string")
+	n.element("MolecularSequence.variant.variantPointer", -1, r.VariantPointer)
+}
+
+// MarshalBSON marshals the given MolecularSequenceVariant as BSON document with the structure of its FHIR JSON
+func (r MolecularSequenceVariant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MolecularSequenceVariant from a BSON document with the structure of its FHIR JSON
+func (r *MolecularSequenceVariant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MolecularSequenceVariant as protobuf message MolecularSequenceVariant of fhir.proto
+func (r MolecularSequenceVariant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MolecularSequenceVariant to the encoded message
+func (r MolecularSequenceVariant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Start != nil {
+		e.varint(4, uint64(int64(*r.Start)))
+	}
+	if r.End != nil {
+		e.varint(5, uint64(int64(*r.End)))
+	}
+	if r.ObservedAllele != nil {
+		e.string(6, *r.ObservedAllele)
+	}
+	if r.ReferenceAllele != nil {
+		e.string(7, *r.ReferenceAllele)
+	}
+	if r.Cigar != nil {
+		e.string(8, *r.Cigar)
+	}
+	if r.VariantPointer != nil {
+		e.message(9, *r.VariantPointer)
+	}
+	if r.StartElement != nil {
+		e.message(10, *r.StartElement)
+	}
+	if r.EndElement != nil {
+		e.message(11, *r.EndElement)
+	}
+	if r.ObservedAlleleElement != nil {
+		e.message(12, *r.ObservedAlleleElement)
+	}
+	if r.ReferenceAlleleElement != nil {
+		e.message(13, *r.ReferenceAlleleElement)
+	}
+	if r.CigarElement != nil {
+		e.message(14, *r.CigarElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MolecularSequenceVariant from protobuf message MolecularSequenceVariant of fhir.proto
+func (r *MolecularSequenceVariant) UnmarshalProto(b []byte) error {
+	*r = MolecularSequenceVariant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Start = &v
+		case 5:
+			v := d.int()
+			r.End = &v
+		case 6:
+			v := d.string()
+			r.ObservedAllele = &v
+		case 7:
+			v := d.string()
+			r.ReferenceAllele = &v
+		case 8:
+			v := d.string()
+			r.Cigar = &v
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.VariantPointer = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.StartElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.EndElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ObservedAlleleElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.ReferenceAlleleElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.CigarElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MolecularSequenceQuality as JSON into a byte slice
+func (r MolecularSequenceQuality) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MolecularSequenceQuality as JSON object with the members in the order of the fields
+func (r MolecularSequenceQuality) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type)
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.StandardSequence != nil {
+		e.key("standardSequence")
+		r.StandardSequence.appendJSON(e)
+	}
+	if r.Start != nil {
+		e.key("start")
+		e.int(*r.Start)
+	}
+	if r.StartElement != nil {
+		e.key("_start")
+		r.StartElement.appendJSON(e)
+	}
+	if r.End != nil {
+		e.key("end")
+		e.int(*r.End)
+	}
+	if r.EndElement != nil {
+		e.key("_end")
+		r.EndElement.appendJSON(e)
+	}
+	if r.Score != nil {
+		e.key("score")
+		r.Score.appendJSON(e)
+	}
+	if r.Method != nil {
+		e.key("method")
+		r.Method.appendJSON(e)
+	}
+	if r.TruthTP != nil {
+		e.key("truthTP")
+		e.number(*r.TruthTP)
+	}
+	if r.TruthTPElement != nil {
+		e.key("_truthTP")
+		r.TruthTPElement.appendJSON(e)
+	}
+	if r.QueryTP != nil {
+		e.key("queryTP")
+		e.number(*r.QueryTP)
+	}
+	if r.QueryTPElement != nil {
+		e.key("_queryTP")
+		r.QueryTPElement.appendJSON(e)
+	}
+	if r.TruthFN != nil {
+		e.key("truthFN")
+		e.number(*r.TruthFN)
+	}
+	if r.TruthFNElement != nil {
+		e.key("_truthFN")
+		r.TruthFNElement.appendJSON(e)
+	}
+	if r.QueryFP != nil {
+		e.key("queryFP")
+		e.number(*r.QueryFP)
+	}
+	if r.QueryFPElement != nil {
+		e.key("_queryFP")
+		r.QueryFPElement.appendJSON(e)
+	}
+	if r.GtFP != nil {
+		e.key("gtFP")
+		e.number(*r.GtFP)
+	}
+	if r.GtFPElement != nil {
+		e.key("_gtFP")
+		r.GtFPElement.appendJSON(e)
+	}
+	if r.Precision != nil {
+		e.key("precision")
+		e.number(*r.Precision)
+	}
+	if r.PrecisionElement != nil {
+		e.key("_precision")
+		r.PrecisionElement.appendJSON(e)
+	}
+	if r.Recall != nil {
+		e.key("recall")
+		e.number(*r.Recall)
+	}
+	if r.RecallElement != nil {
+		e.key("_recall")
+		r.RecallElement.appendJSON(e)
+	}
+	if r.FScore != nil {
+		e.key("fScore")
+		e.number(*r.FScore)
+	}
+	if r.FScoreElement != nil {
+		e.key("_fScore")
+		r.FScoreElement.appendJSON(e)
+	}
+	if r.Roc != nil {
+		e.key("roc")
+		r.Roc.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MolecularSequenceQuality from JSON. Unknown members are ignored.
+func (r *MolecularSequenceQuality) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MolecularSequenceQuality
+func (r *MolecularSequenceQuality) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "standardSequence":
+			if d.null() {
+				r.StandardSequence = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StandardSequence = &v
+			}
+		case "start":
+			if v, ok := d.int(); ok {
+				r.Start = &v
+			} else {
+				r.Start = nil
+			}
+		case "_start":
+			r.StartElement = d.element()
+		case "end":
+			if v, ok := d.int(); ok {
+				r.End = &v
+			} else {
+				r.End = nil
+			}
+		case "_end":
+			r.EndElement = d.element()
+		case "score":
+			if d.null() {
+				r.Score = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Score = &v
+			}
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Method = &v
+			}
+		case "truthTP":
+			if v, ok := d.number(); ok {
+				r.TruthTP = &v
+			} else {
+				r.TruthTP = nil
+			}
+		case "_truthTP":
+			r.TruthTPElement = d.element()
+		case "queryTP":
+			if v, ok := d.number(); ok {
+				r.QueryTP = &v
+			} else {
+				r.QueryTP = nil
+			}
+		case "_queryTP":
+			r.QueryTPElement = d.element()
+		case "truthFN":
+			if v, ok := d.number(); ok {
+				r.TruthFN = &v
+			} else {
+				r.TruthFN = nil
+			}
+		case "_truthFN":
+			r.TruthFNElement = d.element()
+		case "queryFP":
+			if v, ok := d.number(); ok {
+				r.QueryFP = &v
+			} else {
+				r.QueryFP = nil
+			}
+		case "_queryFP":
+			r.QueryFPElement = d.element()
+		case "gtFP":
+			if v, ok := d.number(); ok {
+				r.GtFP = &v
+			} else {
+				r.GtFP = nil
+			}
+		case "_gtFP":
+			r.GtFPElement = d.element()
+		case "precision":
+			if v, ok := d.number(); ok {
+				r.Precision = &v
+			} else {
+				r.Precision = nil
+			}
+		case "_precision":
+			r.PrecisionElement = d.element()
+		case "recall":
+			if v, ok := d.number(); ok {
+				r.Recall = &v
+			} else {
+				r.Recall = nil
+			}
+		case "_recall":
+			r.RecallElement = d.element()
+		case "fScore":
+			if v, ok := d.number(); ok {
+				r.FScore = &v
+			} else {
+				r.FScore = nil
+			}
+		case "_fScore":
+			r.FScoreElement = d.element()
+		case "roc":
+			if d.null() {
+				r.Roc = nil
+			} else {
+				var v MolecularSequenceQualityRoc
+				v.decodeJSON(d)
+				r.Roc = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MolecularSequenceQuality which shares no memory with the original
+func (r MolecularSequenceQuality) DeepCopy() MolecularSequenceQuality {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.StandardSequence != nil {
+		v := r.StandardSequence.DeepCopy()
+		out.StandardSequence = &v
+	}
+	if r.Start != nil {
+		v := *r.Start
+		out.Start = &v
+	}
+	if r.StartElement != nil {
+		v := r.StartElement.DeepCopy()
+		out.StartElement = &v
+	}
+	if r.End != nil {
+		v := *r.End
+		out.End = &v
+	}
+	if r.EndElement != nil {
+		v := r.EndElement.DeepCopy()
+		out.EndElement = &v
+	}
+	if r.Score != nil {
+		v := r.Score.DeepCopy()
+		out.Score = &v
+	}
+	if r.Method != nil {
+		v := r.Method.DeepCopy()
+		out.Method = &v
+	}
+	if r.TruthTP != nil {
+		v := *r.TruthTP
+		out.TruthTP = &v
+	}
+	if r.TruthTPElement != nil {
+		v := r.TruthTPElement.DeepCopy()
+		out.TruthTPElement = &v
+	}
+	if r.QueryTP != nil {
+		v := *r.QueryTP
+		out.QueryTP = &v
+	}
+	if r.QueryTPElement != nil {
+		v := r.QueryTPElement.DeepCopy()
+		out.QueryTPElement = &v
+	}
+	if r.TruthFN != nil {
+		v := *r.TruthFN
+		out.TruthFN = &v
+	}
+	if r.TruthFNElement != nil {
+		v := r.TruthFNElement.DeepCopy()
+		out.TruthFNElement = &v
+	}
+	if r.QueryFP != nil {
+		v := *r.QueryFP
+		out.QueryFP = &v
+	}
+	if r.QueryFPElement != nil {
+		v := r.QueryFPElement.DeepCopy()
+		out.QueryFPElement = &v
+	}
+	if r.GtFP != nil {
+		v := *r.GtFP
+		out.GtFP = &v
+	}
+	if r.GtFPElement != nil {
+		v := r.GtFPElement.DeepCopy()
+		out.GtFPElement = &v
+	}
+	if r.Precision != nil {
+		v := *r.Precision
+		out.Precision = &v
+	}
+	if r.PrecisionElement != nil {
+		v := r.PrecisionElement.DeepCopy()
+		out.PrecisionElement = &v
+	}
+	if r.Recall != nil {
+		v := *r.Recall
+		out.Recall = &v
+	}
+	if r.RecallElement != nil {
+		v := r.RecallElement.DeepCopy()
+		out.RecallElement = &v
+	}
+	if r.FScore != nil {
+		v := *r.FScore
+		out.FScore = &v
+	}
+	if r.FScoreElement != nil {
+		v := r.FScoreElement.DeepCopy()
+		out.FScoreElement = &v
+	}
+	if r.Roc != nil {
+		v := r.Roc.DeepCopy()
+		out.Roc = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MolecularSequenceQuality) Equal(other MolecularSequenceQuality) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.StandardSequence == nil) != (other.StandardSequence == nil) || r.StandardSequence != nil && !r.StandardSequence.Equal(*other.StandardSequence) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.Equal(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.Equal(*other.EndElement) {
+		return false
+	}
+	if (r.Score == nil) != (other.Score == nil) || r.Score != nil && !r.Score.Equal(*other.Score) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.Equal(*other.Method) {
+		return false
+	}
+	if (r.TruthTP == nil) != (other.TruthTP == nil) || r.TruthTP != nil && !EqualDecimal(*r.TruthTP, *other.TruthTP) {
+		return false
+	}
+	if (r.TruthTPElement == nil) != (other.TruthTPElement == nil) || r.TruthTPElement != nil && !r.TruthTPElement.Equal(*other.TruthTPElement) {
+		return false
+	}
+	if (r.QueryTP == nil) != (other.QueryTP == nil) || r.QueryTP != nil && !EqualDecimal(*r.QueryTP, *other.QueryTP) {
+		return false
+	}
+	if (r.QueryTPElement == nil) != (other.QueryTPElement == nil) || r.QueryTPElement != nil && !r.QueryTPElement.Equal(*other.QueryTPElement) {
+		return false
+	}
+	if (r.TruthFN == nil) != (other.TruthFN == nil) || r.TruthFN != nil && !EqualDecimal(*r.TruthFN, *other.TruthFN) {
+		return false
+	}
+	if (r.TruthFNElement == nil) != (other.TruthFNElement == nil) || r.TruthFNElement != nil && !r.TruthFNElement.Equal(*other.TruthFNElement) {
+		return false
+	}
+	if (r.QueryFP == nil) != (other.QueryFP == nil) || r.QueryFP != nil && !EqualDecimal(*r.QueryFP, *other.QueryFP) {
+		return false
+	}
+	if (r.QueryFPElement == nil) != (other.QueryFPElement == nil) || r.QueryFPElement != nil && !r.QueryFPElement.Equal(*other.QueryFPElement) {
+		return false
+	}
+	if (r.GtFP == nil) != (other.GtFP == nil) || r.GtFP != nil && !EqualDecimal(*r.GtFP, *other.GtFP) {
+		return false
+	}
+	if (r.GtFPElement == nil) != (other.GtFPElement == nil) || r.GtFPElement != nil && !r.GtFPElement.Equal(*other.GtFPElement) {
+		return false
+	}
+	if (r.Precision == nil) != (other.Precision == nil) || r.Precision != nil && !EqualDecimal(*r.Precision, *other.Precision) {
+		return false
+	}
+	if (r.PrecisionElement == nil) != (other.PrecisionElement == nil) || r.PrecisionElement != nil && !r.PrecisionElement.Equal(*other.PrecisionElement) {
+		return false
+	}
+	if (r.Recall == nil) != (other.Recall == nil) || r.Recall != nil && !EqualDecimal(*r.Recall, *other.Recall) {
+		return false
+	}
+	if (r.RecallElement == nil) != (other.RecallElement == nil) || r.RecallElement != nil && !r.RecallElement.Equal(*other.RecallElement) {
+		return false
+	}
+	if (r.FScore == nil) != (other.FScore == nil) || r.FScore != nil && !EqualDecimal(*r.FScore, *other.FScore) {
+		return false
+	}
+	if (r.FScoreElement == nil) != (other.FScoreElement == nil) || r.FScoreElement != nil && !r.FScoreElement.Equal(*other.FScoreElement) {
+		return false
+	}
+	if (r.Roc == nil) != (other.Roc == nil) || r.Roc != nil && !r.Roc.Equal(*other.Roc) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MolecularSequenceQuality) EqualsDeep(other MolecularSequenceQuality) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.StandardSequence == nil) != (other.StandardSequence == nil) || r.StandardSequence != nil && !r.StandardSequence.EqualsDeep(*other.StandardSequence) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.EqualsDeep(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.EqualsDeep(*other.EndElement) {
+		return false
+	}
+	if (r.Score == nil) != (other.Score == nil) || r.Score != nil && !r.Score.EqualsDeep(*other.Score) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.EqualsDeep(*other.Method) {
+		return false
+	}
+	if (r.TruthTP == nil) != (other.TruthTP == nil) || r.TruthTP != nil && !equivalentDecimal(*r.TruthTP, *other.TruthTP) {
+		return false
+	}
+	if (r.TruthTPElement == nil) != (other.TruthTPElement == nil) || r.TruthTPElement != nil && !r.TruthTPElement.EqualsDeep(*other.TruthTPElement) {
+		return false
+	}
+	if (r.QueryTP == nil) != (other.QueryTP == nil) || r.QueryTP != nil && !equivalentDecimal(*r.QueryTP, *other.QueryTP) {
+		return false
+	}
+	if (r.QueryTPElement == nil) != (other.QueryTPElement == nil) || r.QueryTPElement != nil && !r.QueryTPElement.EqualsDeep(*other.QueryTPElement) {
+		return false
+	}
+	if (r.TruthFN == nil) != (other.TruthFN == nil) || r.TruthFN != nil && !equivalentDecimal(*r.TruthFN, *other.TruthFN) {
+		return false
+	}
+	if (r.TruthFNElement == nil) != (other.TruthFNElement == nil) || r.TruthFNElement != nil && !r.TruthFNElement.EqualsDeep(*other.TruthFNElement) {
+		return false
+	}
+	if (r.QueryFP == nil) != (other.QueryFP == nil) || r.QueryFP != nil && !equivalentDecimal(*r.QueryFP, *other.QueryFP) {
+		return false
+	}
+	if (r.QueryFPElement == nil) != (other.QueryFPElement == nil) || r.QueryFPElement != nil && !r.QueryFPElement.EqualsDeep(*other.QueryFPElement) {
+		return false
+	}
+	if (r.GtFP == nil) != (other.GtFP == nil) || r.GtFP != nil && !equivalentDecimal(*r.GtFP, *other.GtFP) {
+		return false
+	}
+	if (r.GtFPElement == nil) != (other.GtFPElement == nil) || r.GtFPElement != nil && !r.GtFPElement.EqualsDeep(*other.GtFPElement) {
+		return false
+	}
+	if (r.Precision == nil) != (other.Precision == nil) || r.Precision != nil && !equivalentDecimal(*r.Precision, *other.Precision) {
+		return false
+	}
+	if (r.PrecisionElement == nil) != (other.PrecisionElement == nil) || r.PrecisionElement != nil && !r.PrecisionElement.EqualsDeep(*other.PrecisionElement) {
+		return false
+	}
+	if (r.Recall == nil) != (other.Recall == nil) || r.Recall != nil && !equivalentDecimal(*r.Recall, *other.Recall) {
+		return false
+	}
+	if (r.RecallElement == nil) != (other.RecallElement == nil) || r.RecallElement != nil && !r.RecallElement.EqualsDeep(*other.RecallElement) {
+		return false
+	}
+	if (r.FScore == nil) != (other.FScore == nil) || r.FScore != nil && !equivalentDecimal(*r.FScore, *other.FScore) {
+		return false
+	}
+	if (r.FScoreElement == nil) != (other.FScoreElement == nil) || r.FScoreElement != nil && !r.FScoreElement.EqualsDeep(*other.FScoreElement) {
+		return false
+	}
+	if (r.Roc == nil) != (other.Roc == nil) || r.Roc != nil && !r.Roc.EqualsDeep(*other.Roc) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MolecularSequenceQuality) EqualsShallow(other MolecularSequenceQuality) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.TruthTP == nil) != (other.TruthTP == nil) || r.TruthTP != nil && !equivalentDecimal(*r.TruthTP, *other.TruthTP) {
+		return false
+	}
+	if (r.QueryTP == nil) != (other.QueryTP == nil) || r.QueryTP != nil && !equivalentDecimal(*r.QueryTP, *other.QueryTP) {
+		return false
+	}
+	if (r.TruthFN == nil) != (other.TruthFN == nil) || r.TruthFN != nil && !equivalentDecimal(*r.TruthFN, *other.TruthFN) {
+		return false
+	}
+	if (r.QueryFP == nil) != (other.QueryFP == nil) || r.QueryFP != nil && !equivalentDecimal(*r.QueryFP, *other.QueryFP) {
+		return false
+	}
+	if (r.GtFP == nil) != (other.GtFP == nil) || r.GtFP != nil && !equivalentDecimal(*r.GtFP, *other.GtFP) {
+		return false
+	}
+	if (r.Precision == nil) != (other.Precision == nil) || r.Precision != nil && !equivalentDecimal(*r.Precision, *other.Precision) {
+		return false
+	}
+	if (r.Recall == nil) != (other.Recall == nil) || r.Recall != nil && !equivalentDecimal(*r.Recall, *other.Recall) {
+		return false
+	}
+	if (r.FScore == nil) != (other.FScore == nil) || r.FScore != nil && !equivalentDecimal(*r.FScore, *other.FScore) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MolecularSequenceQuality as FHIR XML
+func (r MolecularSequenceQuality) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "standardSequence", r.StandardSequence); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "start", r.Start, r.StartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "end", r.End, r.EndElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "score", r.Score); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "method", r.Method); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "truthTP", r.TruthTP, r.TruthTPElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "queryTP", r.QueryTP, r.QueryTPElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "truthFN", r.TruthFN, r.TruthFNElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "queryFP", r.QueryFP, r.QueryFPElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "gtFP", r.GtFP, r.GtFPElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "precision", r.Precision, r.PrecisionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "recall", r.Recall, r.RecallElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "fScore", r.FScore, r.FScoreElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "roc", r.Roc); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MolecularSequenceQuality from FHIR XML
+func (r *MolecularSequenceQuality) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "standardSequence":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StandardSequence = &v
+			case "start":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Start = &v
+				}
+				r.StartElement = element
+			case "end":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.End = &v
+				}
+				r.EndElement = element
+			case "score":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Score = &v
+			case "method":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Method = &v
+			case "truthTP":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TruthTP = &v
+				}
+				r.TruthTPElement = element
+			case "queryTP":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.QueryTP = &v
+				}
+				r.QueryTPElement = element
+			case "truthFN":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TruthFN = &v
+				}
+				r.TruthFNElement = element
+			case "queryFP":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.QueryFP = &v
+				}
+				r.QueryFPElement = element
+			case "gtFP":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.GtFP = &v
+				}
+				r.GtFPElement = element
+			case "precision":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Precision = &v
+				}
+				r.PrecisionElement = element
+			case "recall":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Recall = &v
+				}
+				r.RecallElement = element
+			case "fScore":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FScore = &v
+				}
+				r.FScoreElement = element
+			case "roc":
+				var v MolecularSequenceQualityRoc
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Roc = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MolecularSequenceQuality as properties to the RDF node n
+func (r MolecularSequenceQuality) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MolecularSequence.quality.type", -1, r.Type, r.TypeElement, "string")
+	n.element("MolecularSequence.quality.standardSequence", -1, r.StandardSequence)
+	n.primitive("MolecularSequence.quality.start", -1, r.Start, r.StartElement, "integer")
+	n.primitive("MolecularSequence.quality.end", -1, r.End, r.EndElement, "integer")
+	n.element("MolecularSequence.quality.score", -1, r.Score)
+	n.element("MolecularSequence.quality.method", -1, r.Method)
+	n.primitive("MolecularSequence.quality.truthTP", -1, r.TruthTP, r.TruthTPElement, "decimal")
+	n.primitive("MolecularSequence.quality.queryTP", -1, r.QueryTP, r.QueryTPElement, "decimal")
+	n.primitive("MolecularSequence.quality.truthFN", -1, r.TruthFN, r.TruthFNElement, "decimal")
+	n.primitive("MolecularSequence.quality.queryFP", -1, r.QueryFP, r.QueryFPElement, "decimal")
+	n.primitive("MolecularSequence.quality.gtFP", -1, r.GtFP, r.GtFPElement, "decimal")
+	n.primitive("MolecularSequence.quality.precision", -1, r.Precision, r.PrecisionElement, "decimal")
+	n.primitive("MolecularSequence.quality.recall", -1, r.Recall, r.RecallElement, "decimal")
+	n.primitive("MolecularSequence.quality.fScore", -1, r.FScore, r.FScoreElement, "decimal")
+	n.element("MolecularSequence.quality.roc", -1, r.Roc)
+}
+
+// MarshalBSON marshals the given MolecularSequenceQuality as BSON document with the structure of its FHIR JSON
+func (r MolecularSequenceQuality) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MolecularSequenceQuality from a BSON document with the structure of its FHIR JSON
+func (r *MolecularSequenceQuality) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MolecularSequenceQuality as protobuf message MolecularSequenceQuality of fhir.proto
+func (r MolecularSequenceQuality) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MolecularSequenceQuality to the encoded message
+func (r MolecularSequenceQuality) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != "" {
+		e.string(4, r.Type)
+	}
+	if r.StandardSequence != nil {
+		e.message(5, *r.StandardSequence)
+	}
+	if r.Start != nil {
+		e.varint(6, uint64(int64(*r.Start)))
+	}
+	if r.End != nil {
+		e.varint(7, uint64(int64(*r.End)))
+	}
+	if r.Score != nil {
+		e.message(8, *r.Score)
+	}
+	if r.Method != nil {
+		e.message(9, *r.Method)
+	}
+	if r.TruthTP != nil {
+		e.string(10, string(*r.TruthTP))
+	}
+	if r.QueryTP != nil {
+		e.string(11, string(*r.QueryTP))
+	}
+	if r.TruthFN != nil {
+		e.string(12, string(*r.TruthFN))
+	}
+	if r.QueryFP != nil {
+		e.string(13, string(*r.QueryFP))
+	}
+	if r.GtFP != nil {
+		e.string(14, string(*r.GtFP))
+	}
+	if r.Precision != nil {
+		e.string(15, string(*r.Precision))
+	}
+	if r.Recall != nil {
+		e.string(16, string(*r.Recall))
+	}
+	if r.FScore != nil {
+		e.string(17, string(*r.FScore))
+	}
+	if r.Roc != nil {
+		e.message(18, *r.Roc)
+	}
+	if r.TypeElement != nil {
+		e.message(19, *r.TypeElement)
+	}
+	if r.StartElement != nil {
+		e.message(20, *r.StartElement)
+	}
+	if r.EndElement != nil {
+		e.message(21, *r.EndElement)
+	}
+	if r.TruthTPElement != nil {
+		e.message(22, *r.TruthTPElement)
+	}
+	if r.QueryTPElement != nil {
+		e.message(23, *r.QueryTPElement)
+	}
+	if r.TruthFNElement != nil {
+		e.message(24, *r.TruthFNElement)
+	}
+	if r.QueryFPElement != nil {
+		e.message(25, *r.QueryFPElement)
+	}
+	if r.GtFPElement != nil {
+		e.message(26, *r.GtFPElement)
+	}
+	if r.PrecisionElement != nil {
+		e.message(27, *r.PrecisionElement)
+	}
+	if r.RecallElement != nil {
+		e.message(28, *r.RecallElement)
+	}
+	if r.FScoreElement != nil {
+		e.message(29, *r.FScoreElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MolecularSequenceQuality from protobuf message MolecularSequenceQuality of fhir.proto
+func (r *MolecularSequenceQuality) UnmarshalProto(b []byte) error {
+	*r = MolecularSequenceQuality{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Type = d.string()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.StandardSequence = &v
+		case 6:
+			v := d.int()
+			r.Start = &v
+		case 7:
+			v := d.int()
+			r.End = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.Score = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Method = &v
+		case 10:
+			v := json.Number(d.string())
+			r.TruthTP = &v
+		case 11:
+			v := json.Number(d.string())
+			r.QueryTP = &v
+		case 12:
+			v := json.Number(d.string())
+			r.TruthFN = &v
+		case 13:
+			v := json.Number(d.string())
+			r.QueryFP = &v
+		case 14:
+			v := json.Number(d.string())
+			r.GtFP = &v
+		case 15:
+			v := json.Number(d.string())
+			r.Precision = &v
+		case 16:
+			v := json.Number(d.string())
+			r.Recall = &v
+		case 17:
+			v := json.Number(d.string())
+			r.FScore = &v
+		case 18:
+			var v MolecularSequenceQualityRoc
+			d.message(&v)
+			r.Roc = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.StartElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.EndElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.TruthTPElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.QueryTPElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.TruthFNElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.QueryFPElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.GtFPElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.PrecisionElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.RecallElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.FScoreElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MolecularSequenceQualityRoc as JSON into a byte slice
+func (r MolecularSequenceQualityRoc) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MolecularSequenceQualityRoc as JSON object with the members in the order of the fields
+func (r MolecularSequenceQualityRoc) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Score) > 0 {
+		e.key("score")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Score {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ScoreElement) > 0 {
+		e.key("_score")
+		e.elements(r.ScoreElement, len(r.Score))
+	}
+	if len(r.NumTP) > 0 {
+		e.key("numTP")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NumTP {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NumTPElement) > 0 {
+		e.key("_numTP")
+		e.elements(r.NumTPElement, len(r.NumTP))
+	}
+	if len(r.NumFP) > 0 {
+		e.key("numFP")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NumFP {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NumFPElement) > 0 {
+		e.key("_numFP")
+		e.elements(r.NumFPElement, len(r.NumFP))
+	}
+	if len(r.NumFN) > 0 {
+		e.key("numFN")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NumFN {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NumFNElement) > 0 {
+		e.key("_numFN")
+		e.elements(r.NumFNElement, len(r.NumFN))
+	}
+	if len(r.Precision) > 0 {
+		e.key("precision")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Precision {
+			e.item()
+			e.number(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PrecisionElement) > 0 {
+		e.key("_precision")
+		e.elements(r.PrecisionElement, len(r.Precision))
+	}
+	if len(r.Sensitivity) > 0 {
+		e.key("sensitivity")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Sensitivity {
+			e.item()
+			e.number(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SensitivityElement) > 0 {
+		e.key("_sensitivity")
+		e.elements(r.SensitivityElement, len(r.Sensitivity))
+	}
+	if len(r.FMeasure) > 0 {
+		e.key("fMeasure")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.FMeasure {
+			e.item()
+			e.number(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.FMeasureElement) > 0 {
+		e.key("_fMeasure")
+		e.elements(r.FMeasureElement, len(r.FMeasure))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MolecularSequenceQualityRoc from JSON. Unknown members are ignored.
+func (r *MolecularSequenceQualityRoc) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MolecularSequenceQualityRoc
+func (r *MolecularSequenceQualityRoc) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "score":
+			a := d.array()
+			r.Score = nil
+			for a.next() {
+				v, _ := d.int()
+				r.Score = append(r.Score, v)
+			}
+			if r.Score == nil && !a.isNull {
+				r.Score = []int{}
+			}
+		case "_score":
+			a := d.array()
+			r.ScoreElement = nil
+			for a.next() {
+				r.ScoreElement = append(r.ScoreElement, d.element())
+			}
+		case "numTP":
+			a := d.array()
+			r.NumTP = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NumTP = append(r.NumTP, v)
+			}
+			if r.NumTP == nil && !a.isNull {
+				r.NumTP = []int{}
+			}
+		case "_numTP":
+			a := d.array()
+			r.NumTPElement = nil
+			for a.next() {
+				r.NumTPElement = append(r.NumTPElement, d.element())
+			}
+		case "numFP":
+			a := d.array()
+			r.NumFP = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NumFP = append(r.NumFP, v)
+			}
+			if r.NumFP == nil && !a.isNull {
+				r.NumFP = []int{}
+			}
+		case "_numFP":
+			a := d.array()
+			r.NumFPElement = nil
+			for a.next() {
+				r.NumFPElement = append(r.NumFPElement, d.element())
+			}
+		case "numFN":
+			a := d.array()
+			r.NumFN = nil
+			for a.next() {
+				v, _ := d.int()
+				r.NumFN = append(r.NumFN, v)
+			}
+			if r.NumFN == nil && !a.isNull {
+				r.NumFN = []int{}
+			}
+		case "_numFN":
+			a := d.array()
+			r.NumFNElement = nil
+			for a.next() {
+				r.NumFNElement = append(r.NumFNElement, d.element())
+			}
+		case "precision":
+			a := d.array()
+			r.Precision = nil
+			for a.next() {
+				v, _ := d.number()
+				r.Precision = append(r.Precision, v)
+			}
+			if r.Precision == nil && !a.isNull {
+				r.Precision = []json.Number{}
+			}
+		case "_precision":
+			a := d.array()
+			r.PrecisionElement = nil
+			for a.next() {
+				r.PrecisionElement = append(r.PrecisionElement, d.element())
+			}
+		case "sensitivity":
+			a := d.array()
+			r.Sensitivity = nil
+			for a.next() {
+				v, _ := d.number()
+				r.Sensitivity = append(r.Sensitivity, v)
+			}
+			if r.Sensitivity == nil && !a.isNull {
+				r.Sensitivity = []json.Number{}
+			}
+		case "_sensitivity":
+			a := d.array()
+			r.SensitivityElement = nil
+			for a.next() {
+				r.SensitivityElement = append(r.SensitivityElement, d.element())
+			}
+		case "fMeasure":
+			a := d.array()
+			r.FMeasure = nil
+			for a.next() {
+				v, _ := d.number()
+				r.FMeasure = append(r.FMeasure, v)
+			}
+			if r.FMeasure == nil && !a.isNull {
+				r.FMeasure = []json.Number{}
+			}
+		case "_fMeasure":
+			a := d.array()
+			r.FMeasureElement = nil
+			for a.next() {
+				r.FMeasureElement = append(r.FMeasureElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MolecularSequenceQualityRoc which shares no memory with the original
+func (r MolecularSequenceQualityRoc) DeepCopy() MolecularSequenceQualityRoc {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Score != nil {
+		out.Score = make([]int, len(r.Score))
+		copy(out.Score, r.Score)
+	}
+	if r.ScoreElement != nil {
+		out.ScoreElement = make([]*Element, len(r.ScoreElement))
+		for i, v := range r.ScoreElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ScoreElement[i] = &c
+			}
+		}
+	}
+	if r.NumTP != nil {
+		out.NumTP = make([]int, len(r.NumTP))
+		copy(out.NumTP, r.NumTP)
+	}
+	if r.NumTPElement != nil {
+		out.NumTPElement = make([]*Element, len(r.NumTPElement))
+		for i, v := range r.NumTPElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NumTPElement[i] = &c
+			}
+		}
+	}
+	if r.NumFP != nil {
+		out.NumFP = make([]int, len(r.NumFP))
+		copy(out.NumFP, r.NumFP)
+	}
+	if r.NumFPElement != nil {
+		out.NumFPElement = make([]*Element, len(r.NumFPElement))
+		for i, v := range r.NumFPElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NumFPElement[i] = &c
+			}
+		}
+	}
+	if r.NumFN != nil {
+		out.NumFN = make([]int, len(r.NumFN))
+		copy(out.NumFN, r.NumFN)
+	}
+	if r.NumFNElement != nil {
+		out.NumFNElement = make([]*Element, len(r.NumFNElement))
+		for i, v := range r.NumFNElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.NumFNElement[i] = &c
+			}
+		}
+	}
+	if r.Precision != nil {
+		out.Precision = make([]json.Number, len(r.Precision))
+		copy(out.Precision, r.Precision)
+	}
+	if r.PrecisionElement != nil {
+		out.PrecisionElement = make([]*Element, len(r.PrecisionElement))
+		for i, v := range r.PrecisionElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PrecisionElement[i] = &c
+			}
+		}
+	}
+	if r.Sensitivity != nil {
+		out.Sensitivity = make([]json.Number, len(r.Sensitivity))
+		copy(out.Sensitivity, r.Sensitivity)
+	}
+	if r.SensitivityElement != nil {
+		out.SensitivityElement = make([]*Element, len(r.SensitivityElement))
+		for i, v := range r.SensitivityElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SensitivityElement[i] = &c
+			}
+		}
+	}
+	if r.FMeasure != nil {
+		out.FMeasure = make([]json.Number, len(r.FMeasure))
+		copy(out.FMeasure, r.FMeasure)
+	}
+	if r.FMeasureElement != nil {
+		out.FMeasureElement = make([]*Element, len(r.FMeasureElement))
+		for i, v := range r.FMeasureElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.FMeasureElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MolecularSequenceQualityRoc) Equal(other MolecularSequenceQualityRoc) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Score) != len(other.Score) {
+		return false
+	}
+	for i := range r.Score {
+		if r.Score[i] != other.Score[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ScoreElement, other.ScoreElement, false) {
+		return false
+	}
+	if len(r.NumTP) != len(other.NumTP) {
+		return false
+	}
+	for i := range r.NumTP {
+		if r.NumTP[i] != other.NumTP[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NumTPElement, other.NumTPElement, false) {
+		return false
+	}
+	if len(r.NumFP) != len(other.NumFP) {
+		return false
+	}
+	for i := range r.NumFP {
+		if r.NumFP[i] != other.NumFP[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NumFPElement, other.NumFPElement, false) {
+		return false
+	}
+	if len(r.NumFN) != len(other.NumFN) {
+		return false
+	}
+	for i := range r.NumFN {
+		if r.NumFN[i] != other.NumFN[i] {
+			return false
+		}
+	}
+	if !equalElements(r.NumFNElement, other.NumFNElement, false) {
+		return false
+	}
+	if len(r.Precision) != len(other.Precision) {
+		return false
+	}
+	for i := range r.Precision {
+		if !EqualDecimal(r.Precision[i], other.Precision[i]) {
+			return false
+		}
+	}
+	if !equalElements(r.PrecisionElement, other.PrecisionElement, false) {
+		return false
+	}
+	if len(r.Sensitivity) != len(other.Sensitivity) {
+		return false
+	}
+	for i := range r.Sensitivity {
+		if !EqualDecimal(r.Sensitivity[i], other.Sensitivity[i]) {
+			return false
+		}
+	}
+	if !equalElements(r.SensitivityElement, other.SensitivityElement, false) {
+		return false
+	}
+	if len(r.FMeasure) != len(other.FMeasure) {
+		return false
+	}
+	for i := range r.FMeasure {
+		if !EqualDecimal(r.FMeasure[i], other.FMeasure[i]) {
+			return false
+		}
+	}
+	if !equalElements(r.FMeasureElement, other.FMeasureElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MolecularSequenceQualityRoc) EqualsDeep(other MolecularSequenceQualityRoc) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Score), len(other.Score), func(i, j int) bool {
+		return r.Score[i] == other.Score[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ScoreElement, other.ScoreElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.NumTP), len(other.NumTP), func(i, j int) bool {
+		return r.NumTP[i] == other.NumTP[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NumTPElement, other.NumTPElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.NumFP), len(other.NumFP), func(i, j int) bool {
+		return r.NumFP[i] == other.NumFP[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NumFPElement, other.NumFPElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.NumFN), len(other.NumFN), func(i, j int) bool {
+		return r.NumFN[i] == other.NumFN[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.NumFNElement, other.NumFNElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Precision), len(other.Precision), func(i, j int) bool {
+		return equivalentDecimal(r.Precision[i], other.Precision[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PrecisionElement, other.PrecisionElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Sensitivity), len(other.Sensitivity), func(i, j int) bool {
+		return equivalentDecimal(r.Sensitivity[i], other.Sensitivity[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.SensitivityElement, other.SensitivityElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.FMeasure), len(other.FMeasure), func(i, j int) bool {
+		return equivalentDecimal(r.FMeasure[i], other.FMeasure[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.FMeasureElement, other.FMeasureElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MolecularSequenceQualityRoc) EqualsShallow(other MolecularSequenceQualityRoc) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Score), len(other.Score), func(i, j int) bool {
+		return r.Score[i] == other.Score[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NumTP), len(other.NumTP), func(i, j int) bool {
+		return r.NumTP[i] == other.NumTP[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NumFP), len(other.NumFP), func(i, j int) bool {
+		return r.NumFP[i] == other.NumFP[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NumFN), len(other.NumFN), func(i, j int) bool {
+		return r.NumFN[i] == other.NumFN[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Precision), len(other.Precision), func(i, j int) bool {
+		return equivalentDecimal(r.Precision[i], other.Precision[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Sensitivity), len(other.Sensitivity), func(i, j int) bool {
+		return equivalentDecimal(r.Sensitivity[i], other.Sensitivity[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.FMeasure), len(other.FMeasure), func(i, j int) bool {
+		return equivalentDecimal(r.FMeasure[i], other.FMeasure[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MolecularSequenceQualityRoc as FHIR XML
+func (r MolecularSequenceQualityRoc) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Score {
+		if err := encodeXMLPrimitive(e, "score", v, elementAt(r.ScoreElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.NumTP {
+		if err := encodeXMLPrimitive(e, "numTP", v, elementAt(r.NumTPElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.NumFP {
+		if err := encodeXMLPrimitive(e, "numFP", v, elementAt(r.NumFPElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.NumFN {
+		if err := encodeXMLPrimitive(e, "numFN", v, elementAt(r.NumFNElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Precision {
+		if err := encodeXMLPrimitive(e, "precision", v, elementAt(r.PrecisionElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Sensitivity {
+		if err := encodeXMLPrimitive(e, "sensitivity", v, elementAt(r.SensitivityElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.FMeasure {
+		if err := encodeXMLPrimitive(e, "fMeasure", v, elementAt(r.FMeasureElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MolecularSequenceQualityRoc from FHIR XML
+func (r *MolecularSequenceQualityRoc) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "score":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ScoreElement = appendElement(r.ScoreElement, len(r.Score), element)
+					r.Score = append(r.Score, v)
+				}
+			case "numTP":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NumTPElement = appendElement(r.NumTPElement, len(r.NumTP), element)
+					r.NumTP = append(r.NumTP, v)
+				}
+			case "numFP":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NumFPElement = appendElement(r.NumFPElement, len(r.NumFP), element)
+					r.NumFP = append(r.NumFP, v)
+				}
+			case "numFN":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.NumFNElement = appendElement(r.NumFNElement, len(r.NumFN), element)
+					r.NumFN = append(r.NumFN, v)
+				}
+			case "precision":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PrecisionElement = appendElement(r.PrecisionElement, len(r.Precision), element)
+					r.Precision = append(r.Precision, v)
+				}
+			case "sensitivity":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SensitivityElement = appendElement(r.SensitivityElement, len(r.Sensitivity), element)
+					r.Sensitivity = append(r.Sensitivity, v)
+				}
+			case "fMeasure":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.FMeasureElement = appendElement(r.FMeasureElement, len(r.FMeasure), element)
+					r.FMeasure = append(r.FMeasure, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MolecularSequenceQualityRoc as properties to the RDF node n
+func (r MolecularSequenceQualityRoc) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Score {
+		n.primitive("MolecularSequence.quality.roc.score", i, v, elementAt(r.ScoreElement, i), "integer")
+	}
+	for i, v := range r.NumTP {
+		n.primitive("MolecularSequence.quality.roc.numTP", i, v, elementAt(r.NumTPElement, i), "integer")
+	}
+	for i, v := range r.NumFP {
+		n.primitive("MolecularSequence.quality.roc.numFP", i, v, elementAt(r.NumFPElement, i), "integer")
+	}
+	for i, v := range r.NumFN {
+		n.primitive("MolecularSequence.quality.roc.numFN", i, v, elementAt(r.NumFNElement, i), "integer")
+	}
+	for i, v := range r.Precision {
+		n.primitive("MolecularSequence.quality.roc.precision", i, v, elementAt(r.PrecisionElement, i), "decimal")
+	}
+	for i, v := range r.Sensitivity {
+		n.primitive("MolecularSequence.quality.roc.sensitivity", i, v, elementAt(r.SensitivityElement, i), "decimal")
+	}
+	for i, v := range r.FMeasure {
+		n.primitive("MolecularSequence.quality.roc.fMeasure", i, v, elementAt(r.FMeasureElement, i), "decimal")
+	}
+}
+
+// MarshalBSON marshals the given MolecularSequenceQualityRoc as BSON document with the structure of its FHIR JSON
+func (r MolecularSequenceQualityRoc) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MolecularSequenceQualityRoc from a BSON document with the structure of its FHIR JSON
+func (r *MolecularSequenceQualityRoc) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MolecularSequenceQualityRoc as protobuf message MolecularSequenceQualityRoc of fhir.proto
+func (r MolecularSequenceQualityRoc) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MolecularSequenceQualityRoc to the encoded message
+func (r MolecularSequenceQualityRoc) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if len(r.Score) > 0 {
+		var p protoEncoder
+		for _, v := range r.Score {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(4, p.buf)
+	}
+	if len(r.NumTP) > 0 {
+		var p protoEncoder
+		for _, v := range r.NumTP {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(5, p.buf)
+	}
+	if len(r.NumFP) > 0 {
+		var p protoEncoder
+		for _, v := range r.NumFP {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(6, p.buf)
+	}
+	if len(r.NumFN) > 0 {
+		var p protoEncoder
+		for _, v := range r.NumFN {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(7, p.buf)
+	}
+	for _, v := range r.Precision {
+		e.string(8, string(v))
+	}
+	for _, v := range r.Sensitivity {
+		e.string(9, string(v))
+	}
+	for _, v := range r.FMeasure {
+		e.string(10, string(v))
+	}
+	for _, v := range r.ScoreElement {
+		e.element(11, v)
+	}
+	for _, v := range r.NumTPElement {
+		e.element(12, v)
+	}
+	for _, v := range r.NumFPElement {
+		e.element(13, v)
+	}
+	for _, v := range r.NumFNElement {
+		e.element(14, v)
+	}
+	for _, v := range r.PrecisionElement {
+		e.element(15, v)
+	}
+	for _, v := range r.SensitivityElement {
+		e.element(16, v)
+	}
+	for _, v := range r.FMeasureElement {
+		e.element(17, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given MolecularSequenceQualityRoc from protobuf message MolecularSequenceQualityRoc of fhir.proto
+func (r *MolecularSequenceQualityRoc) UnmarshalProto(b []byte) error {
+	*r = MolecularSequenceQualityRoc{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			for _, v := range d.varints() {
+				r.Score = append(r.Score, int(int32(v)))
+			}
+		case 5:
+			for _, v := range d.varints() {
+				r.NumTP = append(r.NumTP, int(int32(v)))
+			}
+		case 6:
+			for _, v := range d.varints() {
+				r.NumFP = append(r.NumFP, int(int32(v)))
+			}
+		case 7:
+			for _, v := range d.varints() {
+				r.NumFN = append(r.NumFN, int(int32(v)))
+			}
+		case 8:
+			r.Precision = append(r.Precision, json.Number(d.string()))
+		case 9:
+			r.Sensitivity = append(r.Sensitivity, json.Number(d.string()))
+		case 10:
+			r.FMeasure = append(r.FMeasure, json.Number(d.string()))
+		case 11:
+			r.ScoreElement = append(r.ScoreElement, d.element())
+		case 12:
+			r.NumTPElement = append(r.NumTPElement, d.element())
+		case 13:
+			r.NumFPElement = append(r.NumFPElement, d.element())
+		case 14:
+			r.NumFNElement = append(r.NumFNElement, d.element())
+		case 15:
+			r.PrecisionElement = append(r.PrecisionElement, d.element())
+		case 16:
+			r.SensitivityElement = append(r.SensitivityElement, d.element())
+		case 17:
+			r.FMeasureElement = append(r.FMeasureElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MolecularSequenceRepository as JSON into a byte slice
+func (r MolecularSequenceRepository) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MolecularSequenceRepository as JSON object with the members in the order of the fields
+func (r MolecularSequenceRepository) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type)
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.DatasetId != nil {
+		e.key("datasetId")
+		e.string(*r.DatasetId)
+	}
+	if r.DatasetIdElement != nil {
+		e.key("_datasetId")
+		r.DatasetIdElement.appendJSON(e)
+	}
+	if r.VariantsetId != nil {
+		e.key("variantsetId")
+		e.string(*r.VariantsetId)
+	}
+	if r.VariantsetIdElement != nil {
+		e.key("_variantsetId")
+		r.VariantsetIdElement.appendJSON(e)
+	}
+	if r.ReadsetId != nil {
+		e.key("readsetId")
+		e.string(*r.ReadsetId)
+	}
+	if r.ReadsetIdElement != nil {
+		e.key("_readsetId")
+		r.ReadsetIdElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MolecularSequenceRepository from JSON. Unknown members are ignored.
+func (r *MolecularSequenceRepository) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MolecularSequenceRepository
+func (r *MolecularSequenceRepository) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "datasetId":
+			if v, ok := d.string(); ok {
+				r.DatasetId = &v
+			} else {
+				r.DatasetId = nil
+			}
+		case "_datasetId":
+			r.DatasetIdElement = d.element()
+		case "variantsetId":
+			if v, ok := d.string(); ok {
+				r.VariantsetId = &v
+			} else {
+				r.VariantsetId = nil
+			}
+		case "_variantsetId":
+			r.VariantsetIdElement = d.element()
+		case "readsetId":
+			if v, ok := d.string(); ok {
+				r.ReadsetId = &v
+			} else {
+				r.ReadsetId = nil
+			}
+		case "_readsetId":
+			r.ReadsetIdElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MolecularSequenceRepository which shares no memory with the original
+func (r MolecularSequenceRepository) DeepCopy() MolecularSequenceRepository {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.DatasetId != nil {
+		v := *r.DatasetId
+		out.DatasetId = &v
+	}
+	if r.DatasetIdElement != nil {
+		v := r.DatasetIdElement.DeepCopy()
+		out.DatasetIdElement = &v
+	}
+	if r.VariantsetId != nil {
+		v := *r.VariantsetId
+		out.VariantsetId = &v
+	}
+	if r.VariantsetIdElement != nil {
+		v := r.VariantsetIdElement.DeepCopy()
+		out.VariantsetIdElement = &v
+	}
+	if r.ReadsetId != nil {
+		v := *r.ReadsetId
+		out.ReadsetId = &v
+	}
+	if r.ReadsetIdElement != nil {
+		v := r.ReadsetIdElement.DeepCopy()
+		out.ReadsetIdElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MolecularSequenceRepository) Equal(other MolecularSequenceRepository) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.DatasetId == nil) != (other.DatasetId == nil) || r.DatasetId != nil && *r.DatasetId != *other.DatasetId {
+		return false
+	}
+	if (r.DatasetIdElement == nil) != (other.DatasetIdElement == nil) || r.DatasetIdElement != nil && !r.DatasetIdElement.Equal(*other.DatasetIdElement) {
+		return false
+	}
+	if (r.VariantsetId == nil) != (other.VariantsetId == nil) || r.VariantsetId != nil && *r.VariantsetId != *other.VariantsetId {
+		return false
+	}
+	if (r.VariantsetIdElement == nil) != (other.VariantsetIdElement == nil) || r.VariantsetIdElement != nil && !r.VariantsetIdElement.Equal(*other.VariantsetIdElement) {
+		return false
+	}
+	if (r.ReadsetId == nil) != (other.ReadsetId == nil) || r.ReadsetId != nil && *r.ReadsetId != *other.ReadsetId {
+		return false
+	}
+	if (r.ReadsetIdElement == nil) != (other.ReadsetIdElement == nil) || r.ReadsetIdElement != nil && !r.ReadsetIdElement.Equal(*other.ReadsetIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MolecularSequenceRepository) EqualsDeep(other MolecularSequenceRepository) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.DatasetId == nil) != (other.DatasetId == nil) || r.DatasetId != nil && !equivalentString(*r.DatasetId, *other.DatasetId) {
+		return false
+	}
+	if (r.DatasetIdElement == nil) != (other.DatasetIdElement == nil) || r.DatasetIdElement != nil && !r.DatasetIdElement.EqualsDeep(*other.DatasetIdElement) {
+		return false
+	}
+	if (r.VariantsetId == nil) != (other.VariantsetId == nil) || r.VariantsetId != nil && !equivalentString(*r.VariantsetId, *other.VariantsetId) {
+		return false
+	}
+	if (r.VariantsetIdElement == nil) != (other.VariantsetIdElement == nil) || r.VariantsetIdElement != nil && !r.VariantsetIdElement.EqualsDeep(*other.VariantsetIdElement) {
+		return false
+	}
+	if (r.ReadsetId == nil) != (other.ReadsetId == nil) || r.ReadsetId != nil && !equivalentString(*r.ReadsetId, *other.ReadsetId) {
+		return false
+	}
+	if (r.ReadsetIdElement == nil) != (other.ReadsetIdElement == nil) || r.ReadsetIdElement != nil && !r.ReadsetIdElement.EqualsDeep(*other.ReadsetIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MolecularSequenceRepository) EqualsShallow(other MolecularSequenceRepository) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.DatasetId == nil) != (other.DatasetId == nil) || r.DatasetId != nil && !equivalentString(*r.DatasetId, *other.DatasetId) {
+		return false
+	}
+	if (r.VariantsetId == nil) != (other.VariantsetId == nil) || r.VariantsetId != nil && !equivalentString(*r.VariantsetId, *other.VariantsetId) {
+		return false
+	}
+	if (r.ReadsetId == nil) != (other.ReadsetId == nil) || r.ReadsetId != nil && !equivalentString(*r.ReadsetId, *other.ReadsetId) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MolecularSequenceRepository as FHIR XML
+func (r MolecularSequenceRepository) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "datasetId", r.DatasetId, r.DatasetIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "variantsetId", r.VariantsetId, r.VariantsetIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "readsetId", r.ReadsetId, r.ReadsetIdElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MolecularSequenceRepository from FHIR XML
+func (r *MolecularSequenceRepository) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "datasetId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DatasetId = &v
+				}
+				r.DatasetIdElement = element
+			case "variantsetId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.VariantsetId = &v
+				}
+				r.VariantsetIdElement = element
+			case "readsetId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReadsetId = &v
+				}
+				r.ReadsetIdElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MolecularSequenceRepository as properties to the RDF node n
+func (r MolecularSequenceRepository) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MolecularSequence.repository.type", -1, r.Type, r.TypeElement, "string")
+	n.primitive("MolecularSequence.repository.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("MolecularSequence.repository.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("MolecularSequence.repository.datasetId", -1, r.DatasetId, r.DatasetIdElement, "string")
+	n.primitive("MolecularSequence.repository.variantsetId", -1, r.VariantsetId, r.VariantsetIdElement, "string")
+	n.primitive("MolecularSequence.repository.readsetId", -1, r.ReadsetId, r.ReadsetIdElement, "string")
+}
+
+// MarshalBSON marshals the given MolecularSequenceRepository as BSON document with the structure of its FHIR JSON
+func (r MolecularSequenceRepository) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MolecularSequenceRepository from a BSON document with the structure of its FHIR JSON
+func (r *MolecularSequenceRepository) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MolecularSequenceRepository as protobuf message MolecularSequenceRepository of fhir.proto
+func (r MolecularSequenceRepository) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MolecularSequenceRepository to the encoded message
+func (r MolecularSequenceRepository) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != "" {
+		e.string(4, r.Type)
+	}
+	if r.Url != nil {
+		e.string(5, *r.Url)
+	}
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.DatasetId != nil {
+		e.string(7, *r.DatasetId)
+	}
+	if r.VariantsetId != nil {
+		e.string(8, *r.VariantsetId)
+	}
+	if r.ReadsetId != nil {
+		e.string(9, *r.ReadsetId)
+	}
+	if r.TypeElement != nil {
+		e.message(10, *r.TypeElement)
+	}
+	if r.UrlElement != nil {
+		e.message(11, *r.UrlElement)
+	}
+	if r.NameElement != nil {
+		e.message(12, *r.NameElement)
+	}
+	if r.DatasetIdElement != nil {
+		e.message(13, *r.DatasetIdElement)
+	}
+	if r.VariantsetIdElement != nil {
+		e.message(14, *r.VariantsetIdElement)
+	}
+	if r.ReadsetIdElement != nil {
+		e.message(15, *r.ReadsetIdElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MolecularSequenceRepository from protobuf message MolecularSequenceRepository of fhir.proto
+func (r *MolecularSequenceRepository) UnmarshalProto(b []byte) error {
+	*r = MolecularSequenceRepository{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Type = d.string()
+		case 5:
+			v := d.string()
+			r.Url = &v
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			v := d.string()
+			r.DatasetId = &v
+		case 8:
+			v := d.string()
+			r.VariantsetId = &v
+		case 9:
+			v := d.string()
+			r.ReadsetId = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DatasetIdElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.VariantsetIdElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ReadsetIdElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MolecularSequenceStructureVariant as JSON into a byte slice
+func (r MolecularSequenceStructureVariant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MolecularSequenceStructureVariant as JSON object with the members in the order of the fields
+func (r MolecularSequenceStructureVariant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.VariantType != nil {
+		e.key("variantType")
+		r.VariantType.appendJSON(e)
+	}
+	if r.Exact != nil {
+		e.key("exact")
+		e.bool(*r.Exact)
+	}
+	if r.ExactElement != nil {
+		e.key("_exact")
+		r.ExactElement.appendJSON(e)
+	}
+	if r.Length != nil {
+		e.key("length")
+		e.int(*r.Length)
+	}
+	if r.LengthElement != nil {
+		e.key("_length")
+		r.LengthElement.appendJSON(e)
+	}
+	if r.Outer != nil {
+		e.key("outer")
+		r.Outer.appendJSON(e)
+	}
+	if r.Inner != nil {
+		e.key("inner")
+		r.Inner.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MolecularSequenceStructureVariant from JSON. Unknown members are ignored.
+func (r *MolecularSequenceStructureVariant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MolecularSequenceStructureVariant
+func (r *MolecularSequenceStructureVariant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "variantType":
+			if d.null() {
+				r.VariantType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.VariantType = &v
+			}
+		case "exact":
+			if v, ok := d.bool(); ok {
+				r.Exact = &v
+			} else {
+				r.Exact = nil
+			}
+		case "_exact":
+			r.ExactElement = d.element()
+		case "length":
+			if v, ok := d.int(); ok {
+				r.Length = &v
+			} else {
+				r.Length = nil
+			}
+		case "_length":
+			r.LengthElement = d.element()
+		case "outer":
+			if d.null() {
+				r.Outer = nil
+			} else {
+				var v MolecularSequenceStructureVariantOuter
+				v.decodeJSON(d)
+				r.Outer = &v
+			}
+		case "inner":
+			if d.null() {
+				r.Inner = nil
+			} else {
+				var v MolecularSequenceStructureVariantInner
+				v.decodeJSON(d)
+				r.Inner = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MolecularSequenceStructureVariant which shares no memory with the original
+func (r MolecularSequenceStructureVariant) DeepCopy() MolecularSequenceStructureVariant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.VariantType != nil {
+		v := r.VariantType.DeepCopy()
+		out.VariantType = &v
+	}
+	if r.Exact != nil {
+		v := *r.Exact
+		out.Exact = &v
+	}
+	if r.ExactElement != nil {
+		v := r.ExactElement.DeepCopy()
+		out.ExactElement = &v
+	}
+	if r.Length != nil {
+		v := *r.Length
+		out.Length = &v
+	}
+	if r.LengthElement != nil {
+		v := r.LengthElement.DeepCopy()
+		out.LengthElement = &v
+	}
+	if r.Outer != nil {
+		v := r.Outer.DeepCopy()
+		out.Outer = &v
+	}
+	if r.Inner != nil {
+		v := r.Inner.DeepCopy()
+		out.Inner = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MolecularSequenceStructureVariant) Equal(other MolecularSequenceStructureVariant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.VariantType == nil) != (other.VariantType == nil) || r.VariantType != nil && !r.VariantType.Equal(*other.VariantType) {
+		return false
+	}
+	if (r.Exact == nil) != (other.Exact == nil) || r.Exact != nil && *r.Exact != *other.Exact {
+		return false
+	}
+	if (r.ExactElement == nil) != (other.ExactElement == nil) || r.ExactElement != nil && !r.ExactElement.Equal(*other.ExactElement) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && *r.Length != *other.Length {
+		return false
+	}
+	if (r.LengthElement == nil) != (other.LengthElement == nil) || r.LengthElement != nil && !r.LengthElement.Equal(*other.LengthElement) {
+		return false
+	}
+	if (r.Outer == nil) != (other.Outer == nil) || r.Outer != nil && !r.Outer.Equal(*other.Outer) {
+		return false
+	}
+	if (r.Inner == nil) != (other.Inner == nil) || r.Inner != nil && !r.Inner.Equal(*other.Inner) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MolecularSequenceStructureVariant) EqualsDeep(other MolecularSequenceStructureVariant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.VariantType == nil) != (other.VariantType == nil) || r.VariantType != nil && !r.VariantType.EqualsDeep(*other.VariantType) {
+		return false
+	}
+	if (r.Exact == nil) != (other.Exact == nil) || r.Exact != nil && *r.Exact != *other.Exact {
+		return false
+	}
+	if (r.ExactElement == nil) != (other.ExactElement == nil) || r.ExactElement != nil && !r.ExactElement.EqualsDeep(*other.ExactElement) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && *r.Length != *other.Length {
+		return false
+	}
+	if (r.LengthElement == nil) != (other.LengthElement == nil) || r.LengthElement != nil && !r.LengthElement.EqualsDeep(*other.LengthElement) {
+		return false
+	}
+	if (r.Outer == nil) != (other.Outer == nil) || r.Outer != nil && !r.Outer.EqualsDeep(*other.Outer) {
+		return false
+	}
+	if (r.Inner == nil) != (other.Inner == nil) || r.Inner != nil && !r.Inner.EqualsDeep(*other.Inner) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MolecularSequenceStructureVariant) EqualsShallow(other MolecularSequenceStructureVariant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Exact == nil) != (other.Exact == nil) || r.Exact != nil && *r.Exact != *other.Exact {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && *r.Length != *other.Length {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MolecularSequenceStructureVariant as FHIR XML
+func (r MolecularSequenceStructureVariant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "variantType", r.VariantType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "exact", r.Exact, r.ExactElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "length", r.Length, r.LengthElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "outer", r.Outer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "inner", r.Inner); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MolecularSequenceStructureVariant from FHIR XML
+func (r *MolecularSequenceStructureVariant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "variantType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.VariantType = &v
+			case "exact":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Exact = &v
+				}
+				r.ExactElement = element
+			case "length":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Length = &v
+				}
+				r.LengthElement = element
+			case "outer":
+				var v MolecularSequenceStructureVariantOuter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Outer = &v
+			case "inner":
+				var v MolecularSequenceStructureVariantInner
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Inner = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MolecularSequenceStructureVariant as properties to the RDF node n
+func (r MolecularSequenceStructureVariant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("MolecularSequence.structureVariant.variantType", -1, r.VariantType)
+	n.primitive("MolecularSequence.structureVariant.exact", -1, r.Exact, r.ExactElement, "boolean")
+	n.primitive("MolecularSequence.structureVariant.length", -1, r.Length, r.LengthElement, "integer")
+	n.element("MolecularSequence.structureVariant.outer", -1, r.Outer)
+	n.element("MolecularSequence.structureVariant.inner", -1, r.Inner)
+}
+
+// MarshalBSON marshals the given MolecularSequenceStructureVariant as BSON document with the structure of its FHIR JSON
+func (r MolecularSequenceStructureVariant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MolecularSequenceStructureVariant from a BSON document with the structure of its FHIR JSON
+func (r *MolecularSequenceStructureVariant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MolecularSequenceStructureVariant as protobuf message MolecularSequenceStructureVariant of fhir.proto
+func (r MolecularSequenceStructureVariant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MolecularSequenceStructureVariant to the encoded message
+func (r MolecularSequenceStructureVariant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.VariantType != nil {
+		e.message(4, *r.VariantType)
+	}
+	if r.Exact != nil {
+		e.varint(5, protoBool(*r.Exact))
+	}
+	if r.Length != nil {
+		e.varint(6, uint64(int64(*r.Length)))
+	}
+	if r.Outer != nil {
+		e.message(7, *r.Outer)
+	}
+	if r.Inner != nil {
+		e.message(8, *r.Inner)
+	}
+	if r.ExactElement != nil {
+		e.message(9, *r.ExactElement)
+	}
+	if r.LengthElement != nil {
+		e.message(10, *r.LengthElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MolecularSequenceStructureVariant from protobuf message MolecularSequenceStructureVariant of fhir.proto
+func (r *MolecularSequenceStructureVariant) UnmarshalProto(b []byte) error {
+	*r = MolecularSequenceStructureVariant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.VariantType = &v
+		case 5:
+			v := d.bool()
+			r.Exact = &v
+		case 6:
+			v := d.int()
+			r.Length = &v
+		case 7:
+			var v MolecularSequenceStructureVariantOuter
+			d.message(&v)
+			r.Outer = &v
+		case 8:
+			var v MolecularSequenceStructureVariantInner
+			d.message(&v)
+			r.Inner = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ExactElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.LengthElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MolecularSequenceStructureVariantOuter as JSON into a byte slice
+func (r MolecularSequenceStructureVariantOuter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MolecularSequenceStructureVariantOuter as JSON object with the members in the order of the fields
+func (r MolecularSequenceStructureVariantOuter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Start != nil {
+		e.key("start")
+		e.int(*r.Start)
+	}
+	if r.StartElement != nil {
+		e.key("_start")
+		r.StartElement.appendJSON(e)
+	}
+	if r.End != nil {
+		e.key("end")
+		e.int(*r.End)
+	}
+	if r.EndElement != nil {
+		e.key("_end")
+		r.EndElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MolecularSequenceStructureVariantOuter from JSON. Unknown members are ignored.
+func (r *MolecularSequenceStructureVariantOuter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MolecularSequenceStructureVariantOuter
+func (r *MolecularSequenceStructureVariantOuter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "start":
+			if v, ok := d.int(); ok {
+				r.Start = &v
+			} else {
+				r.Start = nil
+			}
+		case "_start":
+			r.StartElement = d.element()
+		case "end":
+			if v, ok := d.int(); ok {
+				r.End = &v
+			} else {
+				r.End = nil
+			}
+		case "_end":
+			r.EndElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MolecularSequenceStructureVariantOuter which shares no memory with the original
+func (r MolecularSequenceStructureVariantOuter) DeepCopy() MolecularSequenceStructureVariantOuter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Start != nil {
+		v := *r.Start
+		out.Start = &v
+	}
+	if r.StartElement != nil {
+		v := r.StartElement.DeepCopy()
+		out.StartElement = &v
+	}
+	if r.End != nil {
+		v := *r.End
+		out.End = &v
+	}
+	if r.EndElement != nil {
+		v := r.EndElement.DeepCopy()
+		out.EndElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MolecularSequenceStructureVariantOuter) Equal(other MolecularSequenceStructureVariantOuter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.Equal(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.Equal(*other.EndElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MolecularSequenceStructureVariantOuter) EqualsDeep(other MolecularSequenceStructureVariantOuter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.EqualsDeep(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.EqualsDeep(*other.EndElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MolecularSequenceStructureVariantOuter) EqualsShallow(other MolecularSequenceStructureVariantOuter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MolecularSequenceStructureVariantOuter as FHIR XML
+func (r MolecularSequenceStructureVariantOuter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "start", r.Start, r.StartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "end", r.End, r.EndElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MolecularSequenceStructureVariantOuter from FHIR XML
+func (r *MolecularSequenceStructureVariantOuter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "start":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Start = &v
+				}
+				r.StartElement = element
+			case "end":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.End = &v
+				}
+				r.EndElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MolecularSequenceStructureVariantOuter as properties to the RDF node n
+func (r MolecularSequenceStructureVariantOuter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MolecularSequence.structureVariant.outer.start", -1, r.Start, r.StartElement, "integer")
+	n.primitive("MolecularSequence.structureVariant.outer.end", -1, r.End, r.EndElement, "integer")
+}
+
+// MarshalBSON marshals the given MolecularSequenceStructureVariantOuter as BSON document with the structure of its FHIR JSON
+func (r MolecularSequenceStructureVariantOuter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MolecularSequenceStructureVariantOuter from a BSON document with the structure of its FHIR JSON
+func (r *MolecularSequenceStructureVariantOuter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MolecularSequenceStructureVariantOuter as protobuf message MolecularSequenceStructureVariantOuter of fhir.proto
+func (r MolecularSequenceStructureVariantOuter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MolecularSequenceStructureVariantOuter to the encoded message
+func (r MolecularSequenceStructureVariantOuter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Start != nil {
+		e.varint(4, uint64(int64(*r.Start)))
+	}
+	if r.End != nil {
+		e.varint(5, uint64(int64(*r.End)))
+	}
+	if r.StartElement != nil {
+		e.message(6, *r.StartElement)
+	}
+	if r.EndElement != nil {
+		e.message(7, *r.EndElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MolecularSequenceStructureVariantOuter from protobuf message MolecularSequenceStructureVariantOuter of fhir.proto
+func (r *MolecularSequenceStructureVariantOuter) UnmarshalProto(b []byte) error {
+	*r = MolecularSequenceStructureVariantOuter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Start = &v
+		case 5:
+			v := d.int()
+			r.End = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.StartElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.EndElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given MolecularSequenceStructureVariantInner as JSON into a byte slice
+func (r MolecularSequenceStructureVariantInner) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the MolecularSequenceStructureVariantInner as JSON object with the members in the order of the fields
+func (r MolecularSequenceStructureVariantInner) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Start != nil {
+		e.key("start")
+		e.int(*r.Start)
+	}
+	if r.StartElement != nil {
+		e.key("_start")
+		r.StartElement.appendJSON(e)
+	}
+	if r.End != nil {
+		e.key("end")
+		e.int(*r.End)
+	}
+	if r.EndElement != nil {
+		e.key("_end")
+		r.EndElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given MolecularSequenceStructureVariantInner from JSON. Unknown members are ignored.
+func (r *MolecularSequenceStructureVariantInner) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the MolecularSequenceStructureVariantInner
+func (r *MolecularSequenceStructureVariantInner) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "start":
+			if v, ok := d.int(); ok {
+				r.Start = &v
+			} else {
+				r.Start = nil
+			}
+		case "_start":
+			r.StartElement = d.element()
+		case "end":
+			if v, ok := d.int(); ok {
+				r.End = &v
+			} else {
+				r.End = nil
+			}
+		case "_end":
+			r.EndElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the MolecularSequenceStructureVariantInner which shares no memory with the original
+func (r MolecularSequenceStructureVariantInner) DeepCopy() MolecularSequenceStructureVariantInner {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Start != nil {
+		v := *r.Start
+		out.Start = &v
+	}
+	if r.StartElement != nil {
+		v := r.StartElement.DeepCopy()
+		out.StartElement = &v
+	}
+	if r.End != nil {
+		v := *r.End
+		out.End = &v
+	}
+	if r.EndElement != nil {
+		v := r.EndElement.DeepCopy()
+		out.EndElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r MolecularSequenceStructureVariantInner) Equal(other MolecularSequenceStructureVariantInner) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.Equal(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.Equal(*other.EndElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r MolecularSequenceStructureVariantInner) EqualsDeep(other MolecularSequenceStructureVariantInner) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.EqualsDeep(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.EqualsDeep(*other.EndElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r MolecularSequenceStructureVariantInner) EqualsShallow(other MolecularSequenceStructureVariantInner) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given MolecularSequenceStructureVariantInner as FHIR XML
+func (r MolecularSequenceStructureVariantInner) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "start", r.Start, r.StartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "end", r.End, r.EndElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given MolecularSequenceStructureVariantInner from FHIR XML
+func (r *MolecularSequenceStructureVariantInner) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "start":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Start = &v
+				}
+				r.StartElement = element
+			case "end":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.End = &v
+				}
+				r.EndElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the MolecularSequenceStructureVariantInner as properties to the RDF node n
+func (r MolecularSequenceStructureVariantInner) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("MolecularSequence.structureVariant.inner.start", -1, r.Start, r.StartElement, "integer")
+	n.primitive("MolecularSequence.structureVariant.inner.end", -1, r.End, r.EndElement, "integer")
+}
+
+// MarshalBSON marshals the given MolecularSequenceStructureVariantInner as BSON document with the structure of its FHIR JSON
+func (r MolecularSequenceStructureVariantInner) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given MolecularSequenceStructureVariantInner from a BSON document with the structure of its FHIR JSON
+func (r *MolecularSequenceStructureVariantInner) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given MolecularSequenceStructureVariantInner as protobuf message MolecularSequenceStructureVariantInner of fhir.proto
+func (r MolecularSequenceStructureVariantInner) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the MolecularSequenceStructureVariantInner to the encoded message
+func (r MolecularSequenceStructureVariantInner) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Start != nil {
+		e.varint(4, uint64(int64(*r.Start)))
+	}
+	if r.End != nil {
+		e.varint(5, uint64(int64(*r.End)))
+	}
+	if r.StartElement != nil {
+		e.message(6, *r.StartElement)
+	}
+	if r.EndElement != nil {
+		e.message(7, *r.EndElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given MolecularSequenceStructureVariantInner from protobuf message MolecularSequenceStructureVariantInner of fhir.proto
+func (r *MolecularSequenceStructureVariantInner) UnmarshalProto(b []byte) error {
+	*r = MolecularSequenceStructureVariantInner{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Start = &v
+		case 5:
+			v := d.int()
+			r.End = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.StartElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.EndElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalMolecularSequence unmarshals a MolecularSequence.
 func UnmarshalMolecularSequence(b []byte) (MolecularSequence, error) {
 	var molecularSequence MolecularSequence
-	if err := json.Unmarshal(b, &molecularSequence); err != nil {
+	if err := molecularSequence.UnmarshalJSON(b); err != nil {
 		return molecularSequence, err
 	}
 	return molecularSequence, nil
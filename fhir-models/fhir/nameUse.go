@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code NameUse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *NameUse) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *NameUse) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "usual":
 		*code = NameUseUsual
@@ -61,6 +65,12 @@ func (code *NameUse) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code NameUse) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *NameUse) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code NameUse) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code NameUse) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code NameUse) protoNumber() uint64 {
+	switch code {
+	case NameUseUsual:
+		return 1
+	case NameUseOfficial:
+		return 2
+	case NameUseTemp:
+		return 3
+	case NameUseNickname:
+		return 4
+	case NameUseAnonymous:
+		return 5
+	case NameUseOld:
+		return 6
+	case NameUseMaiden:
+		return 7
+	}
+	return 0
+}
+func (code *NameUse) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = NameUseUsual
+	case 2:
+		*code = NameUseOfficial
+	case 3:
+		*code = NameUseTemp
+	case 4:
+		*code = NameUseNickname
+	case 5:
+		*code = NameUseAnonymous
+	case 6:
+		*code = NameUseOld
+	case 7:
+		*code = NameUseMaiden
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code NamingSystemIdentifierType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *NamingSystemIdentifierType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *NamingSystemIdentifierType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "oid":
 		*code = NamingSystemIdentifierTypeOid
@@ -52,6 +56,12 @@ func (code *NamingSystemIdentifierType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code NamingSystemIdentifierType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *NamingSystemIdentifierType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code NamingSystemIdentifierType) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code NamingSystemIdentifierType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code NamingSystemIdentifierType) protoNumber() uint64 {
+	switch code {
+	case NamingSystemIdentifierTypeOid:
+		return 1
+	case NamingSystemIdentifierTypeUuid:
+		return 2
+	case NamingSystemIdentifierTypeUri:
+		return 3
+	case NamingSystemIdentifierTypeOther:
+		return 4
+	}
+	return 0
+}
+func (code *NamingSystemIdentifierType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = NamingSystemIdentifierTypeOid
+	case 2:
+		*code = NamingSystemIdentifierTypeUuid
+	case 3:
+		*code = NamingSystemIdentifierTypeUri
+	case 4:
+		*code = NamingSystemIdentifierTypeOther
+	default:
+		return false
+	}
+	return true
+}
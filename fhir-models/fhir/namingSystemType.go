@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code NamingSystemType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *NamingSystemType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *NamingSystemType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "codesystem":
 		*code = NamingSystemTypeCodesystem
@@ -49,6 +53,12 @@ func (code *NamingSystemType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code NamingSystemType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *NamingSystemType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code NamingSystemType) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code NamingSystemType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code NamingSystemType) protoNumber() uint64 {
+	switch code {
+	case NamingSystemTypeCodesystem:
+		return 1
+	case NamingSystemTypeIdentifier:
+		return 2
+	case NamingSystemTypeRoot:
+		return 3
+	}
+	return 0
+}
+func (code *NamingSystemType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = NamingSystemTypeCodesystem
+	case 2:
+		*code = NamingSystemTypeIdentifier
+	case 3:
+		*code = NamingSystemTypeRoot
+	default:
+		return false
+	}
+	return true
+}
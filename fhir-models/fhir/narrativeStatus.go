@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code NarrativeStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *NarrativeStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *NarrativeStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "generated":
 		*code = NarrativeStatusGenerated
@@ -52,6 +56,12 @@ func (code *NarrativeStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code NarrativeStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *NarrativeStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code NarrativeStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code NarrativeStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code NarrativeStatus) protoNumber() uint64 {
+	switch code {
+	case NarrativeStatusGenerated:
+		return 1
+	case NarrativeStatusExtensions:
+		return 2
+	case NarrativeStatusAdditional:
+		return 3
+	case NarrativeStatusEmpty:
+		return 4
+	}
+	return 0
+}
+func (code *NarrativeStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = NarrativeStatusGenerated
+	case 2:
+		*code = NarrativeStatusExtensions
+	case 3:
+		*code = NarrativeStatusAdditional
+	case 4:
+		*code = NarrativeStatusEmpty
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// NDJSONReader reads resources from newline delimited JSON as produced by the Bulk Data export, one resource per line.
+type NDJSONReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+// NewNDJSONReader returns a reader of the NDJSON read from r.
+func NewNDJSONReader(r io.Reader) *NDJSONReader {
+	return &NDJSONReader{r: bufio.NewReader(r)}
+}
+
+// NextRaw returns the JSON of the next resource. Empty lines are skipped. It returns io.EOF after the last resource has
+// been read. The returned bytes are not reused by the reader.
+func (r *NDJSONReader) NextRaw() (json.RawMessage, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) > 0 {
+			r.line++
+		}
+		if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+			return trimmed, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Next returns the next decoded resource as pointer to its type, for example a *Patient. It returns io.EOF after the
+// last resource has been read.
+func (r *NDJSONReader) Next() (interface{}, error) {
+	b, err := r.NextRaw()
+	if err != nil {
+		return nil, err
+	}
+	resource, err := DecodeResource(b)
+	if err != nil {
+		return nil, &NDJSONError{Line: r.line, Err: err}
+	}
+	return resource, nil
+}
+
+// Line returns the line number of the resource read last.
+func (r *NDJSONReader) Line() int {
+	return r.line
+}
+
+// NDJSONError reports the line of an NDJSON stream that couldn't be decoded.
+type NDJSONError struct {
+	Line int
+	Err  error
+}
+
+func (e *NDJSONError) Error() string {
+	return "line " + strconv.Itoa(e.Line) + ": " + e.Err.Error()
+}
+
+func (e *NDJSONError) Unwrap() error {
+	return e.Err
+}
+
+// NDJSONWriter writes resources as newline delimited JSON.
+type NDJSONWriter struct {
+	w io.Writer
+}
+
+// NewNDJSONWriter returns a writer of NDJSON to w.
+func NewNDJSONWriter(w io.Writer) *NDJSONWriter {
+	return &NDJSONWriter{w: w}
+}
+
+// Write writes the given resource on a line of its own.
+func (w *NDJSONWriter) Write(resource json.Marshaler) error {
+	b, err := resource.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err = w.w.Write(buf.Bytes())
+	return err
+}
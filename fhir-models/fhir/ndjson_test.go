@@ -0,0 +1,117 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNDJSONReader(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		ids   []string
+		lines []int
+	}{
+		{"trailing newline", "{\"resourceType\":\"Patient\",\"id\":\"1\"}\n{\"resourceType\":\"Patient\",\"id\":\"2\"}\n", []string{"1", "2"}, []int{1, 2}},
+		{"no trailing newline", "{\"resourceType\":\"Patient\",\"id\":\"1\"}\n{\"resourceType\":\"Patient\",\"id\":\"2\"}", []string{"1", "2"}, []int{1, 2}},
+		{"empty lines", "\n{\"resourceType\":\"Patient\",\"id\":\"1\"}\n\n  \n{\"resourceType\":\"Patient\",\"id\":\"2\"}\n\n", []string{"1", "2"}, []int{2, 5}},
+		{"CRLF", "{\"resourceType\":\"Patient\",\"id\":\"1\"}\r\n{\"resourceType\":\"Patient\",\"id\":\"2\"}\r\n", []string{"1", "2"}, []int{1, 2}},
+		{"empty", "", nil, nil},
+		{"only newlines", "\n\n\n", nil, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := NewNDJSONReader(strings.NewReader(test.input))
+			var ids []string
+			var lines []int
+			for {
+				resource, err := r.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				ids = append(ids, *resource.(*Patient).Id)
+				lines = append(lines, r.Line())
+			}
+			if !reflect.DeepEqual(ids, test.ids) || !reflect.DeepEqual(lines, test.lines) {
+				t.Errorf("got ids %v in lines %v, want %v in %v", ids, lines, test.ids, test.lines)
+			}
+			if _, err := r.Next(); err != io.EOF {
+				t.Errorf("Next after the end = %v", err)
+			}
+		})
+	}
+}
+
+func TestNDJSONReaderErrors(t *testing.T) {
+	r := NewNDJSONReader(strings.NewReader("{\"resourceType\":\"Patient\"}\n\n{\"resourceType\":\"Foo\"}\n{\"resourceType\":\n{\"resourceType\":\"Patient\",\"id\":\"4\"}\n"))
+	if _, err := r.Next(); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []int{3, 4} {
+		_, err := r.Next()
+		var ndjsonError *NDJSONError
+		if !errors.As(err, &ndjsonError) || ndjsonError.Line != line {
+			t.Errorf("error = %v, want error in line %d", err, line)
+		}
+	}
+	// the reader continues after a malformed line
+	resource, err := r.Next()
+	if err != nil || *resource.(*Patient).Id != "4" {
+		t.Errorf("Next after errors = %v, %v", resource, err)
+	}
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewNDJSONWriter(&buf)
+	id := "1"
+	for _, resource := range []interface {
+		MarshalJSON() ([]byte, error)
+	}{Patient{Id: &id, Name: []HumanName{{Given: []string{"a b"}}}}, Observation{Status: ObservationStatusFinal}} {
+		if err := w.Write(resource); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := strings.Count(buf.String(), "\n"), 2; got != want || !strings.HasSuffix(buf.String(), "\n") {
+		t.Fatalf("got %q, want %d lines", buf.String(), want)
+	}
+
+	// the reader reads what the writer wrote
+	r := NewNDJSONReader(&buf)
+	patient, err := r.Next()
+	if err != nil || *patient.(*Patient).Id != "1" || patient.(*Patient).Name[0].Given[0] != "a b" {
+		t.Errorf("patient = %+v, %v", patient, err)
+	}
+	observation, err := r.Next()
+	if err != nil || observation.(*Observation).Status != ObservationStatusFinal {
+		t.Errorf("observation = %+v, %v", observation, err)
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("Next after the end = %v", err)
+	}
+
+	if err := NewNDJSONWriter(failingWriter{}).Write(Patient{}); err == nil {
+		t.Error("no error from failing writer")
+	}
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code NoteType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *NoteType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *NoteType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "display":
 		*code = NoteTypeDisplay
@@ -49,6 +53,12 @@ func (code *NoteType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code NoteType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *NoteType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code NoteType) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code NoteType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code NoteType) protoNumber() uint64 {
+	switch code {
+	case NoteTypeDisplay:
+		return 1
+	case NoteTypePrint:
+		return 2
+	case NoteTypePrintoper:
+		return 3
+	}
+	return 0
+}
+func (code *NoteType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = NoteTypeDisplay
+	case 2:
+		*code = NoteTypePrint
+	case 3:
+		*code = NoteTypePrintoper
+	default:
+		return false
+	}
+	return true
+}
@@ -14,37 +14,50 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // NutritionOrder is documented here http://hl7.org/fhir/StructureDefinition/NutritionOrder
 type NutritionOrder struct {
-	Id                     *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical  []string                      `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri        []string                      `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	Instantiates           []string                      `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
-	Status                 RequestStatus                 `bson:"status" json:"status"`
-	Intent                 RequestIntent                 `bson:"intent" json:"intent"`
-	Patient                Reference                     `bson:"patient" json:"patient"`
-	Encounter              *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	DateTime               string                        `bson:"dateTime" json:"dateTime"`
-	Orderer                *Reference                    `bson:"orderer,omitempty" json:"orderer,omitempty"`
-	AllergyIntolerance     []Reference                   `bson:"allergyIntolerance,omitempty" json:"allergyIntolerance,omitempty"`
-	FoodPreferenceModifier []CodeableConcept             `bson:"foodPreferenceModifier,omitempty" json:"foodPreferenceModifier,omitempty"`
-	ExcludeFoodModifier    []CodeableConcept             `bson:"excludeFoodModifier,omitempty" json:"excludeFoodModifier,omitempty"`
-	OralDiet               *NutritionOrderOralDiet       `bson:"oralDiet,omitempty" json:"oralDiet,omitempty"`
-	Supplement             []NutritionOrderSupplement    `bson:"supplement,omitempty" json:"supplement,omitempty"`
-	EnteralFormula         *NutritionOrderEnteralFormula `bson:"enteralFormula,omitempty" json:"enteralFormula,omitempty"`
-	Note                   []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
+	Id                           *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element                      `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []string                      `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element                    `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string                      `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element                    `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	Instantiates                 []string                      `bson:"instantiates,omitempty" json:"instantiates,omitempty"`
+	InstantiatesElement          []*Element                    `bson:"_instantiates,omitempty" json:"_instantiates,omitempty"`
+	Status                       RequestStatus                 `bson:"status" json:"status"`
+	StatusElement                *Element                      `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       RequestIntent                 `bson:"intent" json:"intent"`
+	IntentElement                *Element                      `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Patient                      Reference                     `bson:"patient" json:"patient"`
+	Encounter                    *Reference                    `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	DateTime                     string                        `bson:"dateTime" json:"dateTime"`
+	DateTimeElement              *Element                      `bson:"_dateTime,omitempty" json:"_dateTime,omitempty"`
+	Orderer                      *Reference                    `bson:"orderer,omitempty" json:"orderer,omitempty"`
+	AllergyIntolerance           []Reference                   `bson:"allergyIntolerance,omitempty" json:"allergyIntolerance,omitempty"`
+	FoodPreferenceModifier       []CodeableConcept             `bson:"foodPreferenceModifier,omitempty" json:"foodPreferenceModifier,omitempty"`
+	ExcludeFoodModifier          []CodeableConcept             `bson:"excludeFoodModifier,omitempty" json:"excludeFoodModifier,omitempty"`
+	OralDiet                     *NutritionOrderOralDiet       `bson:"oralDiet,omitempty" json:"oralDiet,omitempty"`
+	Supplement                   []NutritionOrderSupplement    `bson:"supplement,omitempty" json:"supplement,omitempty"`
+	EnteralFormula               *NutritionOrderEnteralFormula `bson:"enteralFormula,omitempty" json:"enteralFormula,omitempty"`
+	Note                         []Annotation                  `bson:"note,omitempty" json:"note,omitempty"`
 }
 type NutritionOrderOralDiet struct {
 	Id                   *string                          `bson:"id,omitempty" json:"id,omitempty"`
@@ -56,6 +69,7 @@ type NutritionOrderOralDiet struct {
 	Texture              []NutritionOrderOralDietTexture  `bson:"texture,omitempty" json:"texture,omitempty"`
 	FluidConsistencyType []CodeableConcept                `bson:"fluidConsistencyType,omitempty" json:"fluidConsistencyType,omitempty"`
 	Instruction          *string                          `bson:"instruction,omitempty" json:"instruction,omitempty"`
+	InstructionElement   *Element                         `bson:"_instruction,omitempty" json:"_instruction,omitempty"`
 }
 type NutritionOrderOralDietNutrient struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -72,28 +86,33 @@ type NutritionOrderOralDietTexture struct {
 	FoodType          *CodeableConcept `bson:"foodType,omitempty" json:"foodType,omitempty"`
 }
 type NutritionOrderSupplement struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	ProductName       *string          `bson:"productName,omitempty" json:"productName,omitempty"`
-	Schedule          []Timing         `bson:"schedule,omitempty" json:"schedule,omitempty"`
-	Quantity          *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Instruction       *string          `bson:"instruction,omitempty" json:"instruction,omitempty"`
+	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type               *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	ProductName        *string          `bson:"productName,omitempty" json:"productName,omitempty"`
+	ProductNameElement *Element         `bson:"_productName,omitempty" json:"_productName,omitempty"`
+	Schedule           []Timing         `bson:"schedule,omitempty" json:"schedule,omitempty"`
+	Quantity           *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	Instruction        *string          `bson:"instruction,omitempty" json:"instruction,omitempty"`
+	InstructionElement *Element         `bson:"_instruction,omitempty" json:"_instruction,omitempty"`
 }
 type NutritionOrderEnteralFormula struct {
-	Id                        *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                 []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension         []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	BaseFormulaType           *CodeableConcept                             `bson:"baseFormulaType,omitempty" json:"baseFormulaType,omitempty"`
-	BaseFormulaProductName    *string                                      `bson:"baseFormulaProductName,omitempty" json:"baseFormulaProductName,omitempty"`
-	AdditiveType              *CodeableConcept                             `bson:"additiveType,omitempty" json:"additiveType,omitempty"`
-	AdditiveProductName       *string                                      `bson:"additiveProductName,omitempty" json:"additiveProductName,omitempty"`
-	CaloricDensity            *Quantity                                    `bson:"caloricDensity,omitempty" json:"caloricDensity,omitempty"`
-	RouteofAdministration     *CodeableConcept                             `bson:"routeofAdministration,omitempty" json:"routeofAdministration,omitempty"`
-	Administration            []NutritionOrderEnteralFormulaAdministration `bson:"administration,omitempty" json:"administration,omitempty"`
-	MaxVolumeToDeliver        *Quantity                                    `bson:"maxVolumeToDeliver,omitempty" json:"maxVolumeToDeliver,omitempty"`
-	AdministrationInstruction *string                                      `bson:"administrationInstruction,omitempty" json:"administrationInstruction,omitempty"`
+	Id                               *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                        []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	BaseFormulaType                  *CodeableConcept                             `bson:"baseFormulaType,omitempty" json:"baseFormulaType,omitempty"`
+	BaseFormulaProductName           *string                                      `bson:"baseFormulaProductName,omitempty" json:"baseFormulaProductName,omitempty"`
+	BaseFormulaProductNameElement    *Element                                     `bson:"_baseFormulaProductName,omitempty" json:"_baseFormulaProductName,omitempty"`
+	AdditiveType                     *CodeableConcept                             `bson:"additiveType,omitempty" json:"additiveType,omitempty"`
+	AdditiveProductName              *string                                      `bson:"additiveProductName,omitempty" json:"additiveProductName,omitempty"`
+	AdditiveProductNameElement       *Element                                     `bson:"_additiveProductName,omitempty" json:"_additiveProductName,omitempty"`
+	CaloricDensity                   *Quantity                                    `bson:"caloricDensity,omitempty" json:"caloricDensity,omitempty"`
+	RouteofAdministration            *CodeableConcept                             `bson:"routeofAdministration,omitempty" json:"routeofAdministration,omitempty"`
+	Administration                   []NutritionOrderEnteralFormulaAdministration `bson:"administration,omitempty" json:"administration,omitempty"`
+	MaxVolumeToDeliver               *Quantity                                    `bson:"maxVolumeToDeliver,omitempty" json:"maxVolumeToDeliver,omitempty"`
+	AdministrationInstruction        *string                                      `bson:"administrationInstruction,omitempty" json:"administrationInstruction,omitempty"`
+	AdministrationInstructionElement *Element                                     `bson:"_administrationInstruction,omitempty" json:"_administrationInstruction,omitempty"`
 }
 type NutritionOrderEnteralFormulaAdministration struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
@@ -104,23 +123,4728 @@ type NutritionOrderEnteralFormulaAdministration struct {
 	RateQuantity      *Quantity   `bson:"rateQuantity,omitempty" json:"rateQuantity,omitempty"`
 	RateRatio         *Ratio      `bson:"rateRatio,omitempty" json:"rateRatio,omitempty"`
 }
-type OtherNutritionOrder NutritionOrder
+
+// NutritionOrderEnteralFormulaAdministrationRate is implemented by the types allowed for NutritionOrder.enteralFormula.administration.rate[x]
+type NutritionOrderEnteralFormulaAdministrationRate interface {
+	isNutritionOrderEnteralFormulaAdministrationRate()
+}
+
+func (Quantity) isNutritionOrderEnteralFormulaAdministrationRate() {}
+func (Ratio) isNutritionOrderEnteralFormulaAdministrationRate()    {}
+
+// Rate returns the value of NutritionOrder.enteralFormula.administration.rate[x] together with its FHIR type code or nil if no value is set
+func (r NutritionOrderEnteralFormulaAdministration) Rate() (NutritionOrderEnteralFormulaAdministrationRate, string) {
+	if r.RateQuantity != nil {
+		return *r.RateQuantity, "Quantity"
+	}
+	if r.RateRatio != nil {
+		return *r.RateRatio, "Ratio"
+	}
+	return nil, ""
+}
+
+// SetRate sets the value of NutritionOrder.enteralFormula.administration.rate[x], given as value or pointer, and clears all other types
+func (r *NutritionOrderEnteralFormulaAdministration) SetRate(value NutritionOrderEnteralFormulaAdministrationRate) {
+	r.RateQuantity = nil
+	r.RateRatio = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.RateQuantity = &v
+	case *Quantity:
+		r.RateQuantity = v
+	case Ratio:
+		r.RateRatio = &v
+	case *Ratio:
+		r.RateRatio = v
+	}
+}
 
 // MarshalJSON marshals the given NutritionOrder as JSON into a byte slice
 func (r NutritionOrder) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherNutritionOrder
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherNutritionOrder: OtherNutritionOrder(r),
-		ResourceType:        "NutritionOrder",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the NutritionOrder as JSON object with the members in the order of the fields
+func (r NutritionOrder) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"NutritionOrder\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	if len(r.Instantiates) > 0 {
+		e.key("instantiates")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Instantiates {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesElement) > 0 {
+		e.key("_instantiates")
+		e.elements(r.InstantiatesElement, len(r.Instantiates))
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("intent")
+	e.string(r.Intent.Code())
+	if r.IntentElement != nil {
+		e.key("_intent")
+		r.IntentElement.appendJSON(e)
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	e.key("dateTime")
+	e.string(r.DateTime)
+	if r.DateTimeElement != nil {
+		e.key("_dateTime")
+		r.DateTimeElement.appendJSON(e)
+	}
+	if r.Orderer != nil {
+		e.key("orderer")
+		r.Orderer.appendJSON(e)
+	}
+	if len(r.AllergyIntolerance) > 0 {
+		e.key("allergyIntolerance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AllergyIntolerance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.FoodPreferenceModifier) > 0 {
+		e.key("foodPreferenceModifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.FoodPreferenceModifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ExcludeFoodModifier) > 0 {
+		e.key("excludeFoodModifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ExcludeFoodModifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.OralDiet != nil {
+		e.key("oralDiet")
+		r.OralDiet.appendJSON(e)
+	}
+	if len(r.Supplement) > 0 {
+		e.key("supplement")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Supplement {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.EnteralFormula != nil {
+		e.key("enteralFormula")
+		r.EnteralFormula.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given NutritionOrder from JSON. Unknown members are ignored.
+func (r *NutritionOrder) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the NutritionOrder
+func (r *NutritionOrder) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "instantiates":
+			a := d.array()
+			r.Instantiates = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Instantiates = append(r.Instantiates, v)
+			}
+			if r.Instantiates == nil && !a.isNull {
+				r.Instantiates = []string{}
+			}
+		case "_instantiates":
+			a := d.array()
+			r.InstantiatesElement = nil
+			for a.next() {
+				r.InstantiatesElement = append(r.InstantiatesElement, d.element())
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "intent":
+			d.unmarshal(&r.Intent)
+		case "_intent":
+			r.IntentElement = d.element()
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "dateTime":
+			if v, ok := d.string(); ok {
+				r.DateTime = v
+			}
+		case "_dateTime":
+			r.DateTimeElement = d.element()
+		case "orderer":
+			if d.null() {
+				r.Orderer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Orderer = &v
+			}
+		case "allergyIntolerance":
+			a := d.array()
+			r.AllergyIntolerance = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.AllergyIntolerance = append(r.AllergyIntolerance, v)
+			}
+			if r.AllergyIntolerance == nil && !a.isNull {
+				r.AllergyIntolerance = []Reference{}
+			}
+		case "foodPreferenceModifier":
+			a := d.array()
+			r.FoodPreferenceModifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FoodPreferenceModifier = append(r.FoodPreferenceModifier, v)
+			}
+			if r.FoodPreferenceModifier == nil && !a.isNull {
+				r.FoodPreferenceModifier = []CodeableConcept{}
+			}
+		case "excludeFoodModifier":
+			a := d.array()
+			r.ExcludeFoodModifier = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ExcludeFoodModifier = append(r.ExcludeFoodModifier, v)
+			}
+			if r.ExcludeFoodModifier == nil && !a.isNull {
+				r.ExcludeFoodModifier = []CodeableConcept{}
+			}
+		case "oralDiet":
+			if d.null() {
+				r.OralDiet = nil
+			} else {
+				var v NutritionOrderOralDiet
+				v.decodeJSON(d)
+				r.OralDiet = &v
+			}
+		case "supplement":
+			a := d.array()
+			r.Supplement = nil
+			for a.next() {
+				var v NutritionOrderSupplement
+				v.decodeJSON(d)
+				r.Supplement = append(r.Supplement, v)
+			}
+			if r.Supplement == nil && !a.isNull {
+				r.Supplement = []NutritionOrderSupplement{}
+			}
+		case "enteralFormula":
+			if d.null() {
+				r.EnteralFormula = nil
+			} else {
+				var v NutritionOrderEnteralFormula
+				v.decodeJSON(d)
+				r.EnteralFormula = &v
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the NutritionOrder which shares no memory with the original
+func (r NutritionOrder) DeepCopy() NutritionOrder {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.Instantiates != nil {
+		out.Instantiates = make([]string, len(r.Instantiates))
+		copy(out.Instantiates, r.Instantiates)
+	}
+	if r.InstantiatesElement != nil {
+		out.InstantiatesElement = make([]*Element, len(r.InstantiatesElement))
+		for i, v := range r.InstantiatesElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesElement[i] = &c
+			}
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.IntentElement != nil {
+		v := r.IntentElement.DeepCopy()
+		out.IntentElement = &v
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.DateTimeElement != nil {
+		v := r.DateTimeElement.DeepCopy()
+		out.DateTimeElement = &v
+	}
+	if r.Orderer != nil {
+		v := r.Orderer.DeepCopy()
+		out.Orderer = &v
+	}
+	if r.AllergyIntolerance != nil {
+		out.AllergyIntolerance = make([]Reference, len(r.AllergyIntolerance))
+		for i := range r.AllergyIntolerance {
+			out.AllergyIntolerance[i] = r.AllergyIntolerance[i].DeepCopy()
+		}
+	}
+	if r.FoodPreferenceModifier != nil {
+		out.FoodPreferenceModifier = make([]CodeableConcept, len(r.FoodPreferenceModifier))
+		for i := range r.FoodPreferenceModifier {
+			out.FoodPreferenceModifier[i] = r.FoodPreferenceModifier[i].DeepCopy()
+		}
+	}
+	if r.ExcludeFoodModifier != nil {
+		out.ExcludeFoodModifier = make([]CodeableConcept, len(r.ExcludeFoodModifier))
+		for i := range r.ExcludeFoodModifier {
+			out.ExcludeFoodModifier[i] = r.ExcludeFoodModifier[i].DeepCopy()
+		}
+	}
+	if r.OralDiet != nil {
+		v := r.OralDiet.DeepCopy()
+		out.OralDiet = &v
+	}
+	if r.Supplement != nil {
+		out.Supplement = make([]NutritionOrderSupplement, len(r.Supplement))
+		for i := range r.Supplement {
+			out.Supplement[i] = r.Supplement[i].DeepCopy()
+		}
+	}
+	if r.EnteralFormula != nil {
+		v := r.EnteralFormula.DeepCopy()
+		out.EnteralFormula = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r NutritionOrder) Equal(other NutritionOrder) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if len(r.Instantiates) != len(other.Instantiates) {
+		return false
+	}
+	for i := range r.Instantiates {
+		if r.Instantiates[i] != other.Instantiates[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesElement, other.InstantiatesElement, false) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.Equal(*other.IntentElement) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if r.DateTime != other.DateTime {
+		return false
+	}
+	if (r.DateTimeElement == nil) != (other.DateTimeElement == nil) || r.DateTimeElement != nil && !r.DateTimeElement.Equal(*other.DateTimeElement) {
+		return false
+	}
+	if (r.Orderer == nil) != (other.Orderer == nil) || r.Orderer != nil && !r.Orderer.Equal(*other.Orderer) {
+		return false
+	}
+	if len(r.AllergyIntolerance) != len(other.AllergyIntolerance) {
+		return false
+	}
+	for i := range r.AllergyIntolerance {
+		if !r.AllergyIntolerance[i].Equal(other.AllergyIntolerance[i]) {
+			return false
+		}
+	}
+	if len(r.FoodPreferenceModifier) != len(other.FoodPreferenceModifier) {
+		return false
+	}
+	for i := range r.FoodPreferenceModifier {
+		if !r.FoodPreferenceModifier[i].Equal(other.FoodPreferenceModifier[i]) {
+			return false
+		}
+	}
+	if len(r.ExcludeFoodModifier) != len(other.ExcludeFoodModifier) {
+		return false
+	}
+	for i := range r.ExcludeFoodModifier {
+		if !r.ExcludeFoodModifier[i].Equal(other.ExcludeFoodModifier[i]) {
+			return false
+		}
+	}
+	if (r.OralDiet == nil) != (other.OralDiet == nil) || r.OralDiet != nil && !r.OralDiet.Equal(*other.OralDiet) {
+		return false
+	}
+	if len(r.Supplement) != len(other.Supplement) {
+		return false
+	}
+	for i := range r.Supplement {
+		if !r.Supplement[i].Equal(other.Supplement[i]) {
+			return false
+		}
+	}
+	if (r.EnteralFormula == nil) != (other.EnteralFormula == nil) || r.EnteralFormula != nil && !r.EnteralFormula.Equal(*other.EnteralFormula) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r NutritionOrder) EqualsDeep(other NutritionOrder) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Instantiates), len(other.Instantiates), func(i, j int) bool {
+		return equivalentString(r.Instantiates[i], other.Instantiates[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesElement, other.InstantiatesElement, true) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.EqualsDeep(*other.IntentElement) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if !equivalentString(r.DateTime, other.DateTime) {
+		return false
+	}
+	if (r.DateTimeElement == nil) != (other.DateTimeElement == nil) || r.DateTimeElement != nil && !r.DateTimeElement.EqualsDeep(*other.DateTimeElement) {
+		return false
+	}
+	if (r.Orderer == nil) != (other.Orderer == nil) || r.Orderer != nil && !r.Orderer.EqualsDeep(*other.Orderer) {
+		return false
+	}
+	if !equivalentList(len(r.AllergyIntolerance), len(other.AllergyIntolerance), func(i, j int) bool {
+		return r.AllergyIntolerance[i].EqualsDeep(other.AllergyIntolerance[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.FoodPreferenceModifier), len(other.FoodPreferenceModifier), func(i, j int) bool {
+		return r.FoodPreferenceModifier[i].EqualsDeep(other.FoodPreferenceModifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ExcludeFoodModifier), len(other.ExcludeFoodModifier), func(i, j int) bool {
+		return r.ExcludeFoodModifier[i].EqualsDeep(other.ExcludeFoodModifier[j])
+	}) {
+		return false
+	}
+	if (r.OralDiet == nil) != (other.OralDiet == nil) || r.OralDiet != nil && !r.OralDiet.EqualsDeep(*other.OralDiet) {
+		return false
+	}
+	if !equivalentList(len(r.Supplement), len(other.Supplement), func(i, j int) bool {
+		return r.Supplement[i].EqualsDeep(other.Supplement[j])
+	}) {
+		return false
+	}
+	if (r.EnteralFormula == nil) != (other.EnteralFormula == nil) || r.EnteralFormula != nil && !r.EnteralFormula.EqualsDeep(*other.EnteralFormula) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r NutritionOrder) EqualsShallow(other NutritionOrder) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Instantiates), len(other.Instantiates), func(i, j int) bool {
+		return equivalentString(r.Instantiates[i], other.Instantiates[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if !equivalentString(r.DateTime, other.DateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given NutritionOrder as FHIR XML
+func (r NutritionOrder) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "NutritionOrder",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Instantiates {
+		if err := encodeXMLPrimitive(e, "instantiates", v, elementAt(r.InstantiatesElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "intent", r.Intent, r.IntentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "dateTime", r.DateTime, r.DateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "orderer", r.Orderer); err != nil {
+		return err
+	}
+	for _, v := range r.AllergyIntolerance {
+		if err := encodeXMLElement(e, "allergyIntolerance", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.FoodPreferenceModifier {
+		if err := encodeXMLElement(e, "foodPreferenceModifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ExcludeFoodModifier {
+		if err := encodeXMLElement(e, "excludeFoodModifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "oralDiet", r.OralDiet); err != nil {
+		return err
+	}
+	for _, v := range r.Supplement {
+		if err := encodeXMLElement(e, "supplement", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "enteralFormula", r.EnteralFormula); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given NutritionOrder from FHIR XML
+func (r *NutritionOrder) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "instantiates":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesElement = appendElement(r.InstantiatesElement, len(r.Instantiates), element)
+					r.Instantiates = append(r.Instantiates, v)
+				}
+			case "status":
+				var v RequestStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "intent":
+				var v RequestIntent
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Intent = v
+				}
+				r.IntentElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "dateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DateTime = v
+				}
+				r.DateTimeElement = element
+			case "orderer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Orderer = &v
+			case "allergyIntolerance":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AllergyIntolerance = append(r.AllergyIntolerance, v)
+			case "foodPreferenceModifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FoodPreferenceModifier = append(r.FoodPreferenceModifier, v)
+			case "excludeFoodModifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ExcludeFoodModifier = append(r.ExcludeFoodModifier, v)
+			case "oralDiet":
+				var v NutritionOrderOralDiet
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OralDiet = &v
+			case "supplement":
+				var v NutritionOrderSupplement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Supplement = append(r.Supplement, v)
+			case "enteralFormula":
+				var v NutritionOrderEnteralFormula
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EnteralFormula = &v
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the NutritionOrder as properties to the RDF node n
+func (r NutritionOrder) turtle(n *turtleNode) {
+	n.resource("NutritionOrder", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("NutritionOrder.identifier", i, v)
+	}
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("NutritionOrder.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("NutritionOrder.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	for i, v := range r.Instantiates {
+		n.primitive("NutritionOrder.instantiates", i, v, elementAt(r.InstantiatesElement, i), "string")
+	}
+	n.primitive("NutritionOrder.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("NutritionOrder.intent", -1, r.Intent, r.IntentElement, "code")
+	n.element("NutritionOrder.patient", -1, r.Patient)
+	n.element("NutritionOrder.encounter", -1, r.Encounter)
+	n.primitive("NutritionOrder.dateTime", -1, r.DateTime, r.DateTimeElement, "dateTime")
+	n.element("NutritionOrder.orderer", -1, r.Orderer)
+	for i, v := range r.AllergyIntolerance {
+		n.element("NutritionOrder.allergyIntolerance", i, v)
+	}
+	for i, v := range r.FoodPreferenceModifier {
+		n.element("NutritionOrder.foodPreferenceModifier", i, v)
+	}
+	for i, v := range r.ExcludeFoodModifier {
+		n.element("NutritionOrder.excludeFoodModifier", i, v)
+	}
+	n.element("NutritionOrder.oralDiet", -1, r.OralDiet)
+	for i, v := range r.Supplement {
+		n.element("NutritionOrder.supplement", i, v)
+	}
+	n.element("NutritionOrder.enteralFormula", -1, r.EnteralFormula)
+	for i, v := range r.Note {
+		n.element("NutritionOrder.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given NutritionOrder as BSON document with the structure of its FHIR JSON
+func (r NutritionOrder) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given NutritionOrder from a BSON document with the structure of its FHIR JSON
+func (r *NutritionOrder) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given NutritionOrder as protobuf message NutritionOrder of fhir.proto
+func (r NutritionOrder) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the NutritionOrder to the encoded message
+func (r NutritionOrder) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(10, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(11, v)
+	}
+	for _, v := range r.Instantiates {
+		e.string(12, v)
+	}
+	e.varint(13, r.Status.protoNumber())
+	e.varint(14, r.Intent.protoNumber())
+	e.message(15, r.Patient)
+	if r.Encounter != nil {
+		e.message(16, *r.Encounter)
+	}
+	if r.DateTime != "" {
+		e.string(17, r.DateTime)
+	}
+	if r.Orderer != nil {
+		e.message(18, *r.Orderer)
+	}
+	for _, v := range r.AllergyIntolerance {
+		e.message(19, v)
+	}
+	for _, v := range r.FoodPreferenceModifier {
+		e.message(20, v)
+	}
+	for _, v := range r.ExcludeFoodModifier {
+		e.message(21, v)
+	}
+	if r.OralDiet != nil {
+		e.message(22, *r.OralDiet)
+	}
+	for _, v := range r.Supplement {
+		e.message(23, v)
+	}
+	if r.EnteralFormula != nil {
+		e.message(24, *r.EnteralFormula)
+	}
+	for _, v := range r.Note {
+		e.message(25, v)
+	}
+	if r.IdElement != nil {
+		e.message(26, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(27, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(28, *r.LanguageElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(29, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(30, v)
+	}
+	for _, v := range r.InstantiatesElement {
+		e.element(31, v)
+	}
+	if r.StatusElement != nil {
+		e.message(32, *r.StatusElement)
+	}
+	if r.IntentElement != nil {
+		e.message(33, *r.IntentElement)
+	}
+	if r.DateTimeElement != nil {
+		e.message(34, *r.DateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given NutritionOrder from protobuf message NutritionOrder of fhir.proto
+func (r *NutritionOrder) UnmarshalProto(b []byte) error {
+	*r = NutritionOrder{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 11:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 12:
+			r.Instantiates = append(r.Instantiates, d.string())
+		case 13:
+			var v RequestStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 14:
+			var v RequestIntent
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Intent = v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 17:
+			r.DateTime = d.string()
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Orderer = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.AllergyIntolerance = append(r.AllergyIntolerance, v)
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.FoodPreferenceModifier = append(r.FoodPreferenceModifier, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.ExcludeFoodModifier = append(r.ExcludeFoodModifier, v)
+		case 22:
+			var v NutritionOrderOralDiet
+			d.message(&v)
+			r.OralDiet = &v
+		case 23:
+			var v NutritionOrderSupplement
+			d.message(&v)
+			r.Supplement = append(r.Supplement, v)
+		case 24:
+			var v NutritionOrderEnteralFormula
+			d.message(&v)
+			r.EnteralFormula = &v
+		case 25:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 26:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 29:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 30:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		case 31:
+			r.InstantiatesElement = append(r.InstantiatesElement, d.element())
+		case 32:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.IntentElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.DateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given NutritionOrderOralDiet as JSON into a byte slice
+func (r NutritionOrderOralDiet) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the NutritionOrderOralDiet as JSON object with the members in the order of the fields
+func (r NutritionOrderOralDiet) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Schedule) > 0 {
+		e.key("schedule")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Schedule {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Nutrient) > 0 {
+		e.key("nutrient")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Nutrient {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Texture) > 0 {
+		e.key("texture")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Texture {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.FluidConsistencyType) > 0 {
+		e.key("fluidConsistencyType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.FluidConsistencyType {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Instruction != nil {
+		e.key("instruction")
+		e.string(*r.Instruction)
+	}
+	if r.InstructionElement != nil {
+		e.key("_instruction")
+		r.InstructionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given NutritionOrderOralDiet from JSON. Unknown members are ignored.
+func (r *NutritionOrderOralDiet) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the NutritionOrderOralDiet
+func (r *NutritionOrderOralDiet) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "schedule":
+			a := d.array()
+			r.Schedule = nil
+			for a.next() {
+				var v Timing
+				v.decodeJSON(d)
+				r.Schedule = append(r.Schedule, v)
+			}
+			if r.Schedule == nil && !a.isNull {
+				r.Schedule = []Timing{}
+			}
+		case "nutrient":
+			a := d.array()
+			r.Nutrient = nil
+			for a.next() {
+				var v NutritionOrderOralDietNutrient
+				v.decodeJSON(d)
+				r.Nutrient = append(r.Nutrient, v)
+			}
+			if r.Nutrient == nil && !a.isNull {
+				r.Nutrient = []NutritionOrderOralDietNutrient{}
+			}
+		case "texture":
+			a := d.array()
+			r.Texture = nil
+			for a.next() {
+				var v NutritionOrderOralDietTexture
+				v.decodeJSON(d)
+				r.Texture = append(r.Texture, v)
+			}
+			if r.Texture == nil && !a.isNull {
+				r.Texture = []NutritionOrderOralDietTexture{}
+			}
+		case "fluidConsistencyType":
+			a := d.array()
+			r.FluidConsistencyType = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FluidConsistencyType = append(r.FluidConsistencyType, v)
+			}
+			if r.FluidConsistencyType == nil && !a.isNull {
+				r.FluidConsistencyType = []CodeableConcept{}
+			}
+		case "instruction":
+			if v, ok := d.string(); ok {
+				r.Instruction = &v
+			} else {
+				r.Instruction = nil
+			}
+		case "_instruction":
+			r.InstructionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the NutritionOrderOralDiet which shares no memory with the original
+func (r NutritionOrderOralDiet) DeepCopy() NutritionOrderOralDiet {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.Schedule != nil {
+		out.Schedule = make([]Timing, len(r.Schedule))
+		for i := range r.Schedule {
+			out.Schedule[i] = r.Schedule[i].DeepCopy()
+		}
+	}
+	if r.Nutrient != nil {
+		out.Nutrient = make([]NutritionOrderOralDietNutrient, len(r.Nutrient))
+		for i := range r.Nutrient {
+			out.Nutrient[i] = r.Nutrient[i].DeepCopy()
+		}
+	}
+	if r.Texture != nil {
+		out.Texture = make([]NutritionOrderOralDietTexture, len(r.Texture))
+		for i := range r.Texture {
+			out.Texture[i] = r.Texture[i].DeepCopy()
+		}
+	}
+	if r.FluidConsistencyType != nil {
+		out.FluidConsistencyType = make([]CodeableConcept, len(r.FluidConsistencyType))
+		for i := range r.FluidConsistencyType {
+			out.FluidConsistencyType[i] = r.FluidConsistencyType[i].DeepCopy()
+		}
+	}
+	if r.Instruction != nil {
+		v := *r.Instruction
+		out.Instruction = &v
+	}
+	if r.InstructionElement != nil {
+		v := r.InstructionElement.DeepCopy()
+		out.InstructionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r NutritionOrderOralDiet) Equal(other NutritionOrderOralDiet) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if len(r.Schedule) != len(other.Schedule) {
+		return false
+	}
+	for i := range r.Schedule {
+		if !r.Schedule[i].Equal(other.Schedule[i]) {
+			return false
+		}
+	}
+	if len(r.Nutrient) != len(other.Nutrient) {
+		return false
+	}
+	for i := range r.Nutrient {
+		if !r.Nutrient[i].Equal(other.Nutrient[i]) {
+			return false
+		}
+	}
+	if len(r.Texture) != len(other.Texture) {
+		return false
+	}
+	for i := range r.Texture {
+		if !r.Texture[i].Equal(other.Texture[i]) {
+			return false
+		}
+	}
+	if len(r.FluidConsistencyType) != len(other.FluidConsistencyType) {
+		return false
+	}
+	for i := range r.FluidConsistencyType {
+		if !r.FluidConsistencyType[i].Equal(other.FluidConsistencyType[i]) {
+			return false
+		}
+	}
+	if (r.Instruction == nil) != (other.Instruction == nil) || r.Instruction != nil && *r.Instruction != *other.Instruction {
+		return false
+	}
+	if (r.InstructionElement == nil) != (other.InstructionElement == nil) || r.InstructionElement != nil && !r.InstructionElement.Equal(*other.InstructionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r NutritionOrderOralDiet) EqualsDeep(other NutritionOrderOralDiet) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Schedule), len(other.Schedule), func(i, j int) bool {
+		return r.Schedule[i].EqualsDeep(other.Schedule[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Nutrient), len(other.Nutrient), func(i, j int) bool {
+		return r.Nutrient[i].EqualsDeep(other.Nutrient[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Texture), len(other.Texture), func(i, j int) bool {
+		return r.Texture[i].EqualsDeep(other.Texture[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.FluidConsistencyType), len(other.FluidConsistencyType), func(i, j int) bool {
+		return r.FluidConsistencyType[i].EqualsDeep(other.FluidConsistencyType[j])
+	}) {
+		return false
+	}
+	if (r.Instruction == nil) != (other.Instruction == nil) || r.Instruction != nil && !equivalentString(*r.Instruction, *other.Instruction) {
+		return false
+	}
+	if (r.InstructionElement == nil) != (other.InstructionElement == nil) || r.InstructionElement != nil && !r.InstructionElement.EqualsDeep(*other.InstructionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r NutritionOrderOralDiet) EqualsShallow(other NutritionOrderOralDiet) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Instruction == nil) != (other.Instruction == nil) || r.Instruction != nil && !equivalentString(*r.Instruction, *other.Instruction) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given NutritionOrderOralDiet as FHIR XML
+func (r NutritionOrderOralDiet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Schedule {
+		if err := encodeXMLElement(e, "schedule", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Nutrient {
+		if err := encodeXMLElement(e, "nutrient", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Texture {
+		if err := encodeXMLElement(e, "texture", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.FluidConsistencyType {
+		if err := encodeXMLElement(e, "fluidConsistencyType", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "instruction", r.Instruction, r.InstructionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given NutritionOrderOralDiet from FHIR XML
+func (r *NutritionOrderOralDiet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "schedule":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Schedule = append(r.Schedule, v)
+			case "nutrient":
+				var v NutritionOrderOralDietNutrient
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Nutrient = append(r.Nutrient, v)
+			case "texture":
+				var v NutritionOrderOralDietTexture
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Texture = append(r.Texture, v)
+			case "fluidConsistencyType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FluidConsistencyType = append(r.FluidConsistencyType, v)
+			case "instruction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Instruction = &v
+				}
+				r.InstructionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the NutritionOrderOralDiet as properties to the RDF node n
+func (r NutritionOrderOralDiet) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Type {
+		n.element("NutritionOrder.oralDiet.type", i, v)
+	}
+	for i, v := range r.Schedule {
+		n.element("NutritionOrder.oralDiet.schedule", i, v)
+	}
+	for i, v := range r.Nutrient {
+		n.element("NutritionOrder.oralDiet.nutrient", i, v)
+	}
+	for i, v := range r.Texture {
+		n.element("NutritionOrder.oralDiet.texture", i, v)
+	}
+	for i, v := range r.FluidConsistencyType {
+		n.element("NutritionOrder.oralDiet.fluidConsistencyType", i, v)
+	}
+	n.primitive("NutritionOrder.oralDiet.instruction", -1, r.Instruction, r.InstructionElement, "string")
+}
+
+// MarshalBSON marshals the given NutritionOrderOralDiet as BSON document with the structure of its FHIR JSON
+func (r NutritionOrderOralDiet) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given NutritionOrderOralDiet from a BSON document with the structure of its FHIR JSON
+func (r *NutritionOrderOralDiet) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given NutritionOrderOralDiet as protobuf message NutritionOrderOralDiet of fhir.proto
+func (r NutritionOrderOralDiet) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the NutritionOrderOralDiet to the encoded message
+func (r NutritionOrderOralDiet) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Type {
+		e.message(4, v)
+	}
+	for _, v := range r.Schedule {
+		e.message(5, v)
+	}
+	for _, v := range r.Nutrient {
+		e.message(6, v)
+	}
+	for _, v := range r.Texture {
+		e.message(7, v)
+	}
+	for _, v := range r.FluidConsistencyType {
+		e.message(8, v)
+	}
+	if r.Instruction != nil {
+		e.string(9, *r.Instruction)
+	}
+	if r.InstructionElement != nil {
+		e.message(10, *r.InstructionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given NutritionOrderOralDiet from protobuf message NutritionOrderOralDiet of fhir.proto
+func (r *NutritionOrderOralDiet) UnmarshalProto(b []byte) error {
+	*r = NutritionOrderOralDiet{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 5:
+			var v Timing
+			d.message(&v)
+			r.Schedule = append(r.Schedule, v)
+		case 6:
+			var v NutritionOrderOralDietNutrient
+			d.message(&v)
+			r.Nutrient = append(r.Nutrient, v)
+		case 7:
+			var v NutritionOrderOralDietTexture
+			d.message(&v)
+			r.Texture = append(r.Texture, v)
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.FluidConsistencyType = append(r.FluidConsistencyType, v)
+		case 9:
+			v := d.string()
+			r.Instruction = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.InstructionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given NutritionOrderOralDietNutrient as JSON into a byte slice
+func (r NutritionOrderOralDietNutrient) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the NutritionOrderOralDietNutrient as JSON object with the members in the order of the fields
+func (r NutritionOrderOralDietNutrient) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Modifier != nil {
+		e.key("modifier")
+		r.Modifier.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given NutritionOrderOralDietNutrient from JSON. Unknown members are ignored.
+func (r *NutritionOrderOralDietNutrient) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the NutritionOrderOralDietNutrient
+func (r *NutritionOrderOralDietNutrient) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "modifier":
+			if d.null() {
+				r.Modifier = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = &v
+			}
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the NutritionOrderOralDietNutrient which shares no memory with the original
+func (r NutritionOrderOralDietNutrient) DeepCopy() NutritionOrderOralDietNutrient {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Modifier != nil {
+		v := r.Modifier.DeepCopy()
+		out.Modifier = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r NutritionOrderOralDietNutrient) Equal(other NutritionOrderOralDietNutrient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Modifier == nil) != (other.Modifier == nil) || r.Modifier != nil && !r.Modifier.Equal(*other.Modifier) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r NutritionOrderOralDietNutrient) EqualsDeep(other NutritionOrderOralDietNutrient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Modifier == nil) != (other.Modifier == nil) || r.Modifier != nil && !r.Modifier.EqualsDeep(*other.Modifier) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r NutritionOrderOralDietNutrient) EqualsShallow(other NutritionOrderOralDietNutrient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given NutritionOrderOralDietNutrient as FHIR XML
+func (r NutritionOrderOralDietNutrient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "modifier", r.Modifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given NutritionOrderOralDietNutrient from FHIR XML
+func (r *NutritionOrderOralDietNutrient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = &v
+			case "amount":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the NutritionOrderOralDietNutrient as properties to the RDF node n
+func (r NutritionOrderOralDietNutrient) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("NutritionOrder.oralDiet.nutrient.modifier", -1, r.Modifier)
+	n.element("NutritionOrder.oralDiet.nutrient.amount", -1, r.Amount)
+}
+
+// MarshalBSON marshals the given NutritionOrderOralDietNutrient as BSON document with the structure of its FHIR JSON
+func (r NutritionOrderOralDietNutrient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given NutritionOrderOralDietNutrient from a BSON document with the structure of its FHIR JSON
+func (r *NutritionOrderOralDietNutrient) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given NutritionOrderOralDietNutrient as protobuf message NutritionOrderOralDietNutrient of fhir.proto
+func (r NutritionOrderOralDietNutrient) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the NutritionOrderOralDietNutrient to the encoded message
+func (r NutritionOrderOralDietNutrient) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Modifier != nil {
+		e.message(4, *r.Modifier)
+	}
+	if r.Amount != nil {
+		e.message(5, *r.Amount)
+	}
+}
+
+// UnmarshalProto unmarshals the given NutritionOrderOralDietNutrient from protobuf message NutritionOrderOralDietNutrient of fhir.proto
+func (r *NutritionOrderOralDietNutrient) UnmarshalProto(b []byte) error {
+	*r = NutritionOrderOralDietNutrient{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.Amount = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given NutritionOrderOralDietTexture as JSON into a byte slice
+func (r NutritionOrderOralDietTexture) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the NutritionOrderOralDietTexture as JSON object with the members in the order of the fields
+func (r NutritionOrderOralDietTexture) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Modifier != nil {
+		e.key("modifier")
+		r.Modifier.appendJSON(e)
+	}
+	if r.FoodType != nil {
+		e.key("foodType")
+		r.FoodType.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given NutritionOrderOralDietTexture from JSON. Unknown members are ignored.
+func (r *NutritionOrderOralDietTexture) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the NutritionOrderOralDietTexture
+func (r *NutritionOrderOralDietTexture) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "modifier":
+			if d.null() {
+				r.Modifier = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Modifier = &v
+			}
+		case "foodType":
+			if d.null() {
+				r.FoodType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FoodType = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the NutritionOrderOralDietTexture which shares no memory with the original
+func (r NutritionOrderOralDietTexture) DeepCopy() NutritionOrderOralDietTexture {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Modifier != nil {
+		v := r.Modifier.DeepCopy()
+		out.Modifier = &v
+	}
+	if r.FoodType != nil {
+		v := r.FoodType.DeepCopy()
+		out.FoodType = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r NutritionOrderOralDietTexture) Equal(other NutritionOrderOralDietTexture) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Modifier == nil) != (other.Modifier == nil) || r.Modifier != nil && !r.Modifier.Equal(*other.Modifier) {
+		return false
+	}
+	if (r.FoodType == nil) != (other.FoodType == nil) || r.FoodType != nil && !r.FoodType.Equal(*other.FoodType) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r NutritionOrderOralDietTexture) EqualsDeep(other NutritionOrderOralDietTexture) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Modifier == nil) != (other.Modifier == nil) || r.Modifier != nil && !r.Modifier.EqualsDeep(*other.Modifier) {
+		return false
+	}
+	if (r.FoodType == nil) != (other.FoodType == nil) || r.FoodType != nil && !r.FoodType.EqualsDeep(*other.FoodType) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r NutritionOrderOralDietTexture) EqualsShallow(other NutritionOrderOralDietTexture) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given NutritionOrderOralDietTexture as FHIR XML
+func (r NutritionOrderOralDietTexture) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "modifier", r.Modifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "foodType", r.FoodType); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given NutritionOrderOralDietTexture from FHIR XML
+func (r *NutritionOrderOralDietTexture) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "modifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Modifier = &v
+			case "foodType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FoodType = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the NutritionOrderOralDietTexture as properties to the RDF node n
+func (r NutritionOrderOralDietTexture) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("NutritionOrder.oralDiet.texture.modifier", -1, r.Modifier)
+	n.element("NutritionOrder.oralDiet.texture.foodType", -1, r.FoodType)
+}
+
+// MarshalBSON marshals the given NutritionOrderOralDietTexture as BSON document with the structure of its FHIR JSON
+func (r NutritionOrderOralDietTexture) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given NutritionOrderOralDietTexture from a BSON document with the structure of its FHIR JSON
+func (r *NutritionOrderOralDietTexture) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given NutritionOrderOralDietTexture as protobuf message NutritionOrderOralDietTexture of fhir.proto
+func (r NutritionOrderOralDietTexture) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the NutritionOrderOralDietTexture to the encoded message
+func (r NutritionOrderOralDietTexture) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Modifier != nil {
+		e.message(4, *r.Modifier)
+	}
+	if r.FoodType != nil {
+		e.message(5, *r.FoodType)
+	}
+}
+
+// UnmarshalProto unmarshals the given NutritionOrderOralDietTexture from protobuf message NutritionOrderOralDietTexture of fhir.proto
+func (r *NutritionOrderOralDietTexture) UnmarshalProto(b []byte) error {
+	*r = NutritionOrderOralDietTexture{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Modifier = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.FoodType = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given NutritionOrderSupplement as JSON into a byte slice
+func (r NutritionOrderSupplement) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the NutritionOrderSupplement as JSON object with the members in the order of the fields
+func (r NutritionOrderSupplement) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.ProductName != nil {
+		e.key("productName")
+		e.string(*r.ProductName)
+	}
+	if r.ProductNameElement != nil {
+		e.key("_productName")
+		r.ProductNameElement.appendJSON(e)
+	}
+	if len(r.Schedule) > 0 {
+		e.key("schedule")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Schedule {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.Instruction != nil {
+		e.key("instruction")
+		e.string(*r.Instruction)
+	}
+	if r.InstructionElement != nil {
+		e.key("_instruction")
+		r.InstructionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given NutritionOrderSupplement from JSON. Unknown members are ignored.
+func (r *NutritionOrderSupplement) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the NutritionOrderSupplement
+func (r *NutritionOrderSupplement) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "productName":
+			if v, ok := d.string(); ok {
+				r.ProductName = &v
+			} else {
+				r.ProductName = nil
+			}
+		case "_productName":
+			r.ProductNameElement = d.element()
+		case "schedule":
+			a := d.array()
+			r.Schedule = nil
+			for a.next() {
+				var v Timing
+				v.decodeJSON(d)
+				r.Schedule = append(r.Schedule, v)
+			}
+			if r.Schedule == nil && !a.isNull {
+				r.Schedule = []Timing{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "instruction":
+			if v, ok := d.string(); ok {
+				r.Instruction = &v
+			} else {
+				r.Instruction = nil
+			}
+		case "_instruction":
+			r.InstructionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the NutritionOrderSupplement which shares no memory with the original
+func (r NutritionOrderSupplement) DeepCopy() NutritionOrderSupplement {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.ProductName != nil {
+		v := *r.ProductName
+		out.ProductName = &v
+	}
+	if r.ProductNameElement != nil {
+		v := r.ProductNameElement.DeepCopy()
+		out.ProductNameElement = &v
+	}
+	if r.Schedule != nil {
+		out.Schedule = make([]Timing, len(r.Schedule))
+		for i := range r.Schedule {
+			out.Schedule[i] = r.Schedule[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.Instruction != nil {
+		v := *r.Instruction
+		out.Instruction = &v
+	}
+	if r.InstructionElement != nil {
+		v := r.InstructionElement.DeepCopy()
+		out.InstructionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r NutritionOrderSupplement) Equal(other NutritionOrderSupplement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.ProductName == nil) != (other.ProductName == nil) || r.ProductName != nil && *r.ProductName != *other.ProductName {
+		return false
+	}
+	if (r.ProductNameElement == nil) != (other.ProductNameElement == nil) || r.ProductNameElement != nil && !r.ProductNameElement.Equal(*other.ProductNameElement) {
+		return false
+	}
+	if len(r.Schedule) != len(other.Schedule) {
+		return false
+	}
+	for i := range r.Schedule {
+		if !r.Schedule[i].Equal(other.Schedule[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.Instruction == nil) != (other.Instruction == nil) || r.Instruction != nil && *r.Instruction != *other.Instruction {
+		return false
+	}
+	if (r.InstructionElement == nil) != (other.InstructionElement == nil) || r.InstructionElement != nil && !r.InstructionElement.Equal(*other.InstructionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r NutritionOrderSupplement) EqualsDeep(other NutritionOrderSupplement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.ProductName == nil) != (other.ProductName == nil) || r.ProductName != nil && !equivalentString(*r.ProductName, *other.ProductName) {
+		return false
+	}
+	if (r.ProductNameElement == nil) != (other.ProductNameElement == nil) || r.ProductNameElement != nil && !r.ProductNameElement.EqualsDeep(*other.ProductNameElement) {
+		return false
+	}
+	if !equivalentList(len(r.Schedule), len(other.Schedule), func(i, j int) bool {
+		return r.Schedule[i].EqualsDeep(other.Schedule[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.Instruction == nil) != (other.Instruction == nil) || r.Instruction != nil && !equivalentString(*r.Instruction, *other.Instruction) {
+		return false
+	}
+	if (r.InstructionElement == nil) != (other.InstructionElement == nil) || r.InstructionElement != nil && !r.InstructionElement.EqualsDeep(*other.InstructionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r NutritionOrderSupplement) EqualsShallow(other NutritionOrderSupplement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ProductName == nil) != (other.ProductName == nil) || r.ProductName != nil && !equivalentString(*r.ProductName, *other.ProductName) {
+		return false
+	}
+	if (r.Instruction == nil) != (other.Instruction == nil) || r.Instruction != nil && !equivalentString(*r.Instruction, *other.Instruction) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given NutritionOrderSupplement as FHIR XML
+func (r NutritionOrderSupplement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "productName", r.ProductName, r.ProductNameElement); err != nil {
+		return err
+	}
+	for _, v := range r.Schedule {
+		if err := encodeXMLElement(e, "schedule", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "instruction", r.Instruction, r.InstructionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given NutritionOrderSupplement from FHIR XML
+func (r *NutritionOrderSupplement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "productName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ProductName = &v
+				}
+				r.ProductNameElement = element
+			case "schedule":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Schedule = append(r.Schedule, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "instruction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Instruction = &v
+				}
+				r.InstructionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the NutritionOrderSupplement as properties to the RDF node n
+func (r NutritionOrderSupplement) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("NutritionOrder.supplement.type", -1, r.Type)
+	n.primitive("NutritionOrder.supplement.productName", -1, r.ProductName, r.ProductNameElement, "string")
+	for i, v := range r.Schedule {
+		n.element("NutritionOrder.supplement.schedule", i, v)
+	}
+	n.element("NutritionOrder.supplement.quantity", -1, r.Quantity)
+	n.primitive("NutritionOrder.supplement.instruction", -1, r.Instruction, r.InstructionElement, "string")
+}
+
+// MarshalBSON marshals the given NutritionOrderSupplement as BSON document with the structure of its FHIR JSON
+func (r NutritionOrderSupplement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given NutritionOrderSupplement from a BSON document with the structure of its FHIR JSON
+func (r *NutritionOrderSupplement) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given NutritionOrderSupplement as protobuf message NutritionOrderSupplement of fhir.proto
+func (r NutritionOrderSupplement) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the NutritionOrderSupplement to the encoded message
+func (r NutritionOrderSupplement) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.ProductName != nil {
+		e.string(5, *r.ProductName)
+	}
+	for _, v := range r.Schedule {
+		e.message(6, v)
+	}
+	if r.Quantity != nil {
+		e.message(7, *r.Quantity)
+	}
+	if r.Instruction != nil {
+		e.string(8, *r.Instruction)
+	}
+	if r.ProductNameElement != nil {
+		e.message(9, *r.ProductNameElement)
+	}
+	if r.InstructionElement != nil {
+		e.message(10, *r.InstructionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given NutritionOrderSupplement from protobuf message NutritionOrderSupplement of fhir.proto
+func (r *NutritionOrderSupplement) UnmarshalProto(b []byte) error {
+	*r = NutritionOrderSupplement{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			v := d.string()
+			r.ProductName = &v
+		case 6:
+			var v Timing
+			d.message(&v)
+			r.Schedule = append(r.Schedule, v)
+		case 7:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 8:
+			v := d.string()
+			r.Instruction = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ProductNameElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.InstructionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given NutritionOrderEnteralFormula as JSON into a byte slice
+func (r NutritionOrderEnteralFormula) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the NutritionOrderEnteralFormula as JSON object with the members in the order of the fields
+func (r NutritionOrderEnteralFormula) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BaseFormulaType != nil {
+		e.key("baseFormulaType")
+		r.BaseFormulaType.appendJSON(e)
+	}
+	if r.BaseFormulaProductName != nil {
+		e.key("baseFormulaProductName")
+		e.string(*r.BaseFormulaProductName)
+	}
+	if r.BaseFormulaProductNameElement != nil {
+		e.key("_baseFormulaProductName")
+		r.BaseFormulaProductNameElement.appendJSON(e)
+	}
+	if r.AdditiveType != nil {
+		e.key("additiveType")
+		r.AdditiveType.appendJSON(e)
+	}
+	if r.AdditiveProductName != nil {
+		e.key("additiveProductName")
+		e.string(*r.AdditiveProductName)
+	}
+	if r.AdditiveProductNameElement != nil {
+		e.key("_additiveProductName")
+		r.AdditiveProductNameElement.appendJSON(e)
+	}
+	if r.CaloricDensity != nil {
+		e.key("caloricDensity")
+		r.CaloricDensity.appendJSON(e)
+	}
+	if r.RouteofAdministration != nil {
+		e.key("routeofAdministration")
+		r.RouteofAdministration.appendJSON(e)
+	}
+	if len(r.Administration) > 0 {
+		e.key("administration")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Administration {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MaxVolumeToDeliver != nil {
+		e.key("maxVolumeToDeliver")
+		r.MaxVolumeToDeliver.appendJSON(e)
+	}
+	if r.AdministrationInstruction != nil {
+		e.key("administrationInstruction")
+		e.string(*r.AdministrationInstruction)
+	}
+	if r.AdministrationInstructionElement != nil {
+		e.key("_administrationInstruction")
+		r.AdministrationInstructionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given NutritionOrderEnteralFormula from JSON. Unknown members are ignored.
+func (r *NutritionOrderEnteralFormula) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the NutritionOrderEnteralFormula
+func (r *NutritionOrderEnteralFormula) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "baseFormulaType":
+			if d.null() {
+				r.BaseFormulaType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BaseFormulaType = &v
+			}
+		case "baseFormulaProductName":
+			if v, ok := d.string(); ok {
+				r.BaseFormulaProductName = &v
+			} else {
+				r.BaseFormulaProductName = nil
+			}
+		case "_baseFormulaProductName":
+			r.BaseFormulaProductNameElement = d.element()
+		case "additiveType":
+			if d.null() {
+				r.AdditiveType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdditiveType = &v
+			}
+		case "additiveProductName":
+			if v, ok := d.string(); ok {
+				r.AdditiveProductName = &v
+			} else {
+				r.AdditiveProductName = nil
+			}
+		case "_additiveProductName":
+			r.AdditiveProductNameElement = d.element()
+		case "caloricDensity":
+			if d.null() {
+				r.CaloricDensity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.CaloricDensity = &v
+			}
+		case "routeofAdministration":
+			if d.null() {
+				r.RouteofAdministration = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.RouteofAdministration = &v
+			}
+		case "administration":
+			a := d.array()
+			r.Administration = nil
+			for a.next() {
+				var v NutritionOrderEnteralFormulaAdministration
+				v.decodeJSON(d)
+				r.Administration = append(r.Administration, v)
+			}
+			if r.Administration == nil && !a.isNull {
+				r.Administration = []NutritionOrderEnteralFormulaAdministration{}
+			}
+		case "maxVolumeToDeliver":
+			if d.null() {
+				r.MaxVolumeToDeliver = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MaxVolumeToDeliver = &v
+			}
+		case "administrationInstruction":
+			if v, ok := d.string(); ok {
+				r.AdministrationInstruction = &v
+			} else {
+				r.AdministrationInstruction = nil
+			}
+		case "_administrationInstruction":
+			r.AdministrationInstructionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the NutritionOrderEnteralFormula which shares no memory with the original
+func (r NutritionOrderEnteralFormula) DeepCopy() NutritionOrderEnteralFormula {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.BaseFormulaType != nil {
+		v := r.BaseFormulaType.DeepCopy()
+		out.BaseFormulaType = &v
+	}
+	if r.BaseFormulaProductName != nil {
+		v := *r.BaseFormulaProductName
+		out.BaseFormulaProductName = &v
+	}
+	if r.BaseFormulaProductNameElement != nil {
+		v := r.BaseFormulaProductNameElement.DeepCopy()
+		out.BaseFormulaProductNameElement = &v
+	}
+	if r.AdditiveType != nil {
+		v := r.AdditiveType.DeepCopy()
+		out.AdditiveType = &v
+	}
+	if r.AdditiveProductName != nil {
+		v := *r.AdditiveProductName
+		out.AdditiveProductName = &v
+	}
+	if r.AdditiveProductNameElement != nil {
+		v := r.AdditiveProductNameElement.DeepCopy()
+		out.AdditiveProductNameElement = &v
+	}
+	if r.CaloricDensity != nil {
+		v := r.CaloricDensity.DeepCopy()
+		out.CaloricDensity = &v
+	}
+	if r.RouteofAdministration != nil {
+		v := r.RouteofAdministration.DeepCopy()
+		out.RouteofAdministration = &v
+	}
+	if r.Administration != nil {
+		out.Administration = make([]NutritionOrderEnteralFormulaAdministration, len(r.Administration))
+		for i := range r.Administration {
+			out.Administration[i] = r.Administration[i].DeepCopy()
+		}
+	}
+	if r.MaxVolumeToDeliver != nil {
+		v := r.MaxVolumeToDeliver.DeepCopy()
+		out.MaxVolumeToDeliver = &v
+	}
+	if r.AdministrationInstruction != nil {
+		v := *r.AdministrationInstruction
+		out.AdministrationInstruction = &v
+	}
+	if r.AdministrationInstructionElement != nil {
+		v := r.AdministrationInstructionElement.DeepCopy()
+		out.AdministrationInstructionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r NutritionOrderEnteralFormula) Equal(other NutritionOrderEnteralFormula) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.BaseFormulaType == nil) != (other.BaseFormulaType == nil) || r.BaseFormulaType != nil && !r.BaseFormulaType.Equal(*other.BaseFormulaType) {
+		return false
+	}
+	if (r.BaseFormulaProductName == nil) != (other.BaseFormulaProductName == nil) || r.BaseFormulaProductName != nil && *r.BaseFormulaProductName != *other.BaseFormulaProductName {
+		return false
+	}
+	if (r.BaseFormulaProductNameElement == nil) != (other.BaseFormulaProductNameElement == nil) || r.BaseFormulaProductNameElement != nil && !r.BaseFormulaProductNameElement.Equal(*other.BaseFormulaProductNameElement) {
+		return false
+	}
+	if (r.AdditiveType == nil) != (other.AdditiveType == nil) || r.AdditiveType != nil && !r.AdditiveType.Equal(*other.AdditiveType) {
+		return false
+	}
+	if (r.AdditiveProductName == nil) != (other.AdditiveProductName == nil) || r.AdditiveProductName != nil && *r.AdditiveProductName != *other.AdditiveProductName {
+		return false
+	}
+	if (r.AdditiveProductNameElement == nil) != (other.AdditiveProductNameElement == nil) || r.AdditiveProductNameElement != nil && !r.AdditiveProductNameElement.Equal(*other.AdditiveProductNameElement) {
+		return false
+	}
+	if (r.CaloricDensity == nil) != (other.CaloricDensity == nil) || r.CaloricDensity != nil && !r.CaloricDensity.Equal(*other.CaloricDensity) {
+		return false
+	}
+	if (r.RouteofAdministration == nil) != (other.RouteofAdministration == nil) || r.RouteofAdministration != nil && !r.RouteofAdministration.Equal(*other.RouteofAdministration) {
+		return false
+	}
+	if len(r.Administration) != len(other.Administration) {
+		return false
+	}
+	for i := range r.Administration {
+		if !r.Administration[i].Equal(other.Administration[i]) {
+			return false
+		}
+	}
+	if (r.MaxVolumeToDeliver == nil) != (other.MaxVolumeToDeliver == nil) || r.MaxVolumeToDeliver != nil && !r.MaxVolumeToDeliver.Equal(*other.MaxVolumeToDeliver) {
+		return false
+	}
+	if (r.AdministrationInstruction == nil) != (other.AdministrationInstruction == nil) || r.AdministrationInstruction != nil && *r.AdministrationInstruction != *other.AdministrationInstruction {
+		return false
+	}
+	if (r.AdministrationInstructionElement == nil) != (other.AdministrationInstructionElement == nil) || r.AdministrationInstructionElement != nil && !r.AdministrationInstructionElement.Equal(*other.AdministrationInstructionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r NutritionOrderEnteralFormula) EqualsDeep(other NutritionOrderEnteralFormula) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.BaseFormulaType == nil) != (other.BaseFormulaType == nil) || r.BaseFormulaType != nil && !r.BaseFormulaType.EqualsDeep(*other.BaseFormulaType) {
+		return false
+	}
+	if (r.BaseFormulaProductName == nil) != (other.BaseFormulaProductName == nil) || r.BaseFormulaProductName != nil && !equivalentString(*r.BaseFormulaProductName, *other.BaseFormulaProductName) {
+		return false
+	}
+	if (r.BaseFormulaProductNameElement == nil) != (other.BaseFormulaProductNameElement == nil) || r.BaseFormulaProductNameElement != nil && !r.BaseFormulaProductNameElement.EqualsDeep(*other.BaseFormulaProductNameElement) {
+		return false
+	}
+	if (r.AdditiveType == nil) != (other.AdditiveType == nil) || r.AdditiveType != nil && !r.AdditiveType.EqualsDeep(*other.AdditiveType) {
+		return false
+	}
+	if (r.AdditiveProductName == nil) != (other.AdditiveProductName == nil) || r.AdditiveProductName != nil && !equivalentString(*r.AdditiveProductName, *other.AdditiveProductName) {
+		return false
+	}
+	if (r.AdditiveProductNameElement == nil) != (other.AdditiveProductNameElement == nil) || r.AdditiveProductNameElement != nil && !r.AdditiveProductNameElement.EqualsDeep(*other.AdditiveProductNameElement) {
+		return false
+	}
+	if (r.CaloricDensity == nil) != (other.CaloricDensity == nil) || r.CaloricDensity != nil && !r.CaloricDensity.EqualsDeep(*other.CaloricDensity) {
+		return false
+	}
+	if (r.RouteofAdministration == nil) != (other.RouteofAdministration == nil) || r.RouteofAdministration != nil && !r.RouteofAdministration.EqualsDeep(*other.RouteofAdministration) {
+		return false
+	}
+	if !equivalentList(len(r.Administration), len(other.Administration), func(i, j int) bool {
+		return r.Administration[i].EqualsDeep(other.Administration[j])
+	}) {
+		return false
+	}
+	if (r.MaxVolumeToDeliver == nil) != (other.MaxVolumeToDeliver == nil) || r.MaxVolumeToDeliver != nil && !r.MaxVolumeToDeliver.EqualsDeep(*other.MaxVolumeToDeliver) {
+		return false
+	}
+	if (r.AdministrationInstruction == nil) != (other.AdministrationInstruction == nil) || r.AdministrationInstruction != nil && !equivalentString(*r.AdministrationInstruction, *other.AdministrationInstruction) {
+		return false
+	}
+	if (r.AdministrationInstructionElement == nil) != (other.AdministrationInstructionElement == nil) || r.AdministrationInstructionElement != nil && !r.AdministrationInstructionElement.EqualsDeep(*other.AdministrationInstructionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r NutritionOrderEnteralFormula) EqualsShallow(other NutritionOrderEnteralFormula) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.BaseFormulaProductName == nil) != (other.BaseFormulaProductName == nil) || r.BaseFormulaProductName != nil && !equivalentString(*r.BaseFormulaProductName, *other.BaseFormulaProductName) {
+		return false
+	}
+	if (r.AdditiveProductName == nil) != (other.AdditiveProductName == nil) || r.AdditiveProductName != nil && !equivalentString(*r.AdditiveProductName, *other.AdditiveProductName) {
+		return false
+	}
+	if (r.AdministrationInstruction == nil) != (other.AdministrationInstruction == nil) || r.AdministrationInstruction != nil && !equivalentString(*r.AdministrationInstruction, *other.AdministrationInstruction) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given NutritionOrderEnteralFormula as FHIR XML
+func (r NutritionOrderEnteralFormula) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "baseFormulaType", r.BaseFormulaType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "baseFormulaProductName", r.BaseFormulaProductName, r.BaseFormulaProductNameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "additiveType", r.AdditiveType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "additiveProductName", r.AdditiveProductName, r.AdditiveProductNameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "caloricDensity", r.CaloricDensity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "routeofAdministration", r.RouteofAdministration); err != nil {
+		return err
+	}
+	for _, v := range r.Administration {
+		if err := encodeXMLElement(e, "administration", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "maxVolumeToDeliver", r.MaxVolumeToDeliver); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "administrationInstruction", r.AdministrationInstruction, r.AdministrationInstructionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given NutritionOrderEnteralFormula from FHIR XML
+func (r *NutritionOrderEnteralFormula) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "baseFormulaType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BaseFormulaType = &v
+			case "baseFormulaProductName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BaseFormulaProductName = &v
+				}
+				r.BaseFormulaProductNameElement = element
+			case "additiveType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditiveType = &v
+			case "additiveProductName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AdditiveProductName = &v
+				}
+				r.AdditiveProductNameElement = element
+			case "caloricDensity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CaloricDensity = &v
+			case "routeofAdministration":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RouteofAdministration = &v
+			case "administration":
+				var v NutritionOrderEnteralFormulaAdministration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Administration = append(r.Administration, v)
+			case "maxVolumeToDeliver":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxVolumeToDeliver = &v
+			case "administrationInstruction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AdministrationInstruction = &v
+				}
+				r.AdministrationInstructionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the NutritionOrderEnteralFormula as properties to the RDF node n
+func (r NutritionOrderEnteralFormula) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("NutritionOrder.enteralFormula.baseFormulaType", -1, r.BaseFormulaType)
+	n.primitive("NutritionOrder.enteralFormula.baseFormulaProductName", -1, r.BaseFormulaProductName, r.BaseFormulaProductNameElement, "string")
+	n.element("NutritionOrder.enteralFormula.additiveType", -1, r.AdditiveType)
+	n.primitive("NutritionOrder.enteralFormula.additiveProductName", -1, r.AdditiveProductName, r.AdditiveProductNameElement, "string")
+	n.element("NutritionOrder.enteralFormula.caloricDensity", -1, r.CaloricDensity)
+	n.element("NutritionOrder.enteralFormula.routeofAdministration", -1, r.RouteofAdministration)
+	for i, v := range r.Administration {
+		n.element("NutritionOrder.enteralFormula.administration", i, v)
+	}
+	n.element("NutritionOrder.enteralFormula.maxVolumeToDeliver", -1, r.MaxVolumeToDeliver)
+	n.primitive("NutritionOrder.enteralFormula.administrationInstruction", -1, r.AdministrationInstruction, r.AdministrationInstructionElement, "string")
+}
+
+// MarshalBSON marshals the given NutritionOrderEnteralFormula as BSON document with the structure of its FHIR JSON
+func (r NutritionOrderEnteralFormula) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given NutritionOrderEnteralFormula from a BSON document with the structure of its FHIR JSON
+func (r *NutritionOrderEnteralFormula) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given NutritionOrderEnteralFormula as protobuf message NutritionOrderEnteralFormula of fhir.proto
+func (r NutritionOrderEnteralFormula) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the NutritionOrderEnteralFormula to the encoded message
+func (r NutritionOrderEnteralFormula) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.BaseFormulaType != nil {
+		e.message(4, *r.BaseFormulaType)
+	}
+	if r.BaseFormulaProductName != nil {
+		e.string(5, *r.BaseFormulaProductName)
+	}
+	if r.AdditiveType != nil {
+		e.message(6, *r.AdditiveType)
+	}
+	if r.AdditiveProductName != nil {
+		e.string(7, *r.AdditiveProductName)
+	}
+	if r.CaloricDensity != nil {
+		e.message(8, *r.CaloricDensity)
+	}
+	if r.RouteofAdministration != nil {
+		e.message(9, *r.RouteofAdministration)
+	}
+	for _, v := range r.Administration {
+		e.message(10, v)
+	}
+	if r.MaxVolumeToDeliver != nil {
+		e.message(11, *r.MaxVolumeToDeliver)
+	}
+	if r.AdministrationInstruction != nil {
+		e.string(12, *r.AdministrationInstruction)
+	}
+	if r.BaseFormulaProductNameElement != nil {
+		e.message(13, *r.BaseFormulaProductNameElement)
+	}
+	if r.AdditiveProductNameElement != nil {
+		e.message(14, *r.AdditiveProductNameElement)
+	}
+	if r.AdministrationInstructionElement != nil {
+		e.message(15, *r.AdministrationInstructionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given NutritionOrderEnteralFormula from protobuf message NutritionOrderEnteralFormula of fhir.proto
+func (r *NutritionOrderEnteralFormula) UnmarshalProto(b []byte) error {
+	*r = NutritionOrderEnteralFormula{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.BaseFormulaType = &v
+		case 5:
+			v := d.string()
+			r.BaseFormulaProductName = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdditiveType = &v
+		case 7:
+			v := d.string()
+			r.AdditiveProductName = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.CaloricDensity = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.RouteofAdministration = &v
+		case 10:
+			var v NutritionOrderEnteralFormulaAdministration
+			d.message(&v)
+			r.Administration = append(r.Administration, v)
+		case 11:
+			var v Quantity
+			d.message(&v)
+			r.MaxVolumeToDeliver = &v
+		case 12:
+			v := d.string()
+			r.AdministrationInstruction = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.BaseFormulaProductNameElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.AdditiveProductNameElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.AdministrationInstructionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given NutritionOrderEnteralFormulaAdministration as JSON into a byte slice
+func (r NutritionOrderEnteralFormulaAdministration) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the NutritionOrderEnteralFormulaAdministration as JSON object with the members in the order of the fields
+func (r NutritionOrderEnteralFormulaAdministration) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Schedule != nil {
+		e.key("schedule")
+		r.Schedule.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.RateQuantity != nil {
+		e.key("rateQuantity")
+		r.RateQuantity.appendJSON(e)
+	}
+	if r.RateRatio != nil {
+		e.key("rateRatio")
+		r.RateRatio.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given NutritionOrderEnteralFormulaAdministration from JSON. Unknown members are ignored.
+func (r *NutritionOrderEnteralFormulaAdministration) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the NutritionOrderEnteralFormulaAdministration
+func (r *NutritionOrderEnteralFormulaAdministration) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "schedule":
+			if d.null() {
+				r.Schedule = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.Schedule = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "rateQuantity":
+			if d.null() {
+				r.RateQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.RateQuantity = &v
+			}
+		case "rateRatio":
+			if d.null() {
+				r.RateRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.RateRatio = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the NutritionOrderEnteralFormulaAdministration which shares no memory with the original
+func (r NutritionOrderEnteralFormulaAdministration) DeepCopy() NutritionOrderEnteralFormulaAdministration {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Schedule != nil {
+		v := r.Schedule.DeepCopy()
+		out.Schedule = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.RateQuantity != nil {
+		v := r.RateQuantity.DeepCopy()
+		out.RateQuantity = &v
+	}
+	if r.RateRatio != nil {
+		v := r.RateRatio.DeepCopy()
+		out.RateRatio = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r NutritionOrderEnteralFormulaAdministration) Equal(other NutritionOrderEnteralFormulaAdministration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Schedule == nil) != (other.Schedule == nil) || r.Schedule != nil && !r.Schedule.Equal(*other.Schedule) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.RateQuantity == nil) != (other.RateQuantity == nil) || r.RateQuantity != nil && !r.RateQuantity.Equal(*other.RateQuantity) {
+		return false
+	}
+	if (r.RateRatio == nil) != (other.RateRatio == nil) || r.RateRatio != nil && !r.RateRatio.Equal(*other.RateRatio) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r NutritionOrderEnteralFormulaAdministration) EqualsDeep(other NutritionOrderEnteralFormulaAdministration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Schedule == nil) != (other.Schedule == nil) || r.Schedule != nil && !r.Schedule.EqualsDeep(*other.Schedule) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.RateQuantity == nil) != (other.RateQuantity == nil) || r.RateQuantity != nil && !r.RateQuantity.EqualsDeep(*other.RateQuantity) {
+		return false
+	}
+	if (r.RateRatio == nil) != (other.RateRatio == nil) || r.RateRatio != nil && !r.RateRatio.EqualsDeep(*other.RateRatio) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r NutritionOrderEnteralFormulaAdministration) EqualsShallow(other NutritionOrderEnteralFormulaAdministration) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given NutritionOrderEnteralFormulaAdministration as FHIR XML
+func (r NutritionOrderEnteralFormulaAdministration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "schedule", r.Schedule); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "rateQuantity", r.RateQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "rateRatio", r.RateRatio); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given NutritionOrderEnteralFormulaAdministration from FHIR XML
+func (r *NutritionOrderEnteralFormulaAdministration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "schedule":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Schedule = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "rateQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RateQuantity = &v
+			case "rateRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RateRatio = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the NutritionOrderEnteralFormulaAdministration as properties to the RDF node n
+func (r NutritionOrderEnteralFormulaAdministration) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("NutritionOrder.enteralFormula.administration.schedule", -1, r.Schedule)
+	n.element("NutritionOrder.enteralFormula.administration.quantity", -1, r.Quantity)
+	n.element("NutritionOrder.enteralFormula.administration.rateQuantity", -1, r.RateQuantity)
+	n.element("NutritionOrder.enteralFormula.administration.rateRatio", -1, r.RateRatio)
+}
+
+// MarshalBSON marshals the given NutritionOrderEnteralFormulaAdministration as BSON document with the structure of its FHIR JSON
+func (r NutritionOrderEnteralFormulaAdministration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given NutritionOrderEnteralFormulaAdministration from a BSON document with the structure of its FHIR JSON
+func (r *NutritionOrderEnteralFormulaAdministration) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given NutritionOrderEnteralFormulaAdministration as protobuf message NutritionOrderEnteralFormulaAdministration of fhir.proto
+func (r NutritionOrderEnteralFormulaAdministration) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the NutritionOrderEnteralFormulaAdministration to the encoded message
+func (r NutritionOrderEnteralFormulaAdministration) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Schedule != nil {
+		e.message(4, *r.Schedule)
+	}
+	if r.Quantity != nil {
+		e.message(5, *r.Quantity)
+	}
+	if r.RateQuantity != nil {
+		e.message(6, *r.RateQuantity)
+	}
+	if r.RateRatio != nil {
+		e.message(7, *r.RateRatio)
+	}
+}
+
+// UnmarshalProto unmarshals the given NutritionOrderEnteralFormulaAdministration from protobuf message NutritionOrderEnteralFormulaAdministration of fhir.proto
+func (r *NutritionOrderEnteralFormulaAdministration) UnmarshalProto(b []byte) error {
+	*r = NutritionOrderEnteralFormulaAdministration{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Timing
+			d.message(&v)
+			r.Schedule = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.RateQuantity = &v
+		case 7:
+			var v Ratio
+			d.message(&v)
+			r.RateRatio = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalNutritionOrder unmarshals a NutritionOrder.
 func UnmarshalNutritionOrder(b []byte) (NutritionOrder, error) {
 	var nutritionOrder NutritionOrder
-	if err := json.Unmarshal(b, &nutritionOrder); err != nil {
+	if err := nutritionOrder.UnmarshalJSON(b); err != nil {
 		return nutritionOrder, err
 	}
 	return nutritionOrder, nil
@@ -14,58 +14,251 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Observation is documented here http://hl7.org/fhir/StructureDefinition/Observation
 type Observation struct {
-	Id                   *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string                     `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier           []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn              []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf               []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status               ObservationStatus           `bson:"status" json:"status"`
-	Category             []CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
-	Code                 CodeableConcept             `bson:"code" json:"code"`
-	Subject              *Reference                  `bson:"subject,omitempty" json:"subject,omitempty"`
-	Focus                []Reference                 `bson:"focus,omitempty" json:"focus,omitempty"`
-	Encounter            *Reference                  `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	EffectiveDateTime    *string                     `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
-	EffectivePeriod      *Period                     `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	EffectiveTiming      *Timing                     `bson:"effectiveTiming,omitempty" json:"effectiveTiming,omitempty"`
-	EffectiveInstant     *string                     `bson:"effectiveInstant,omitempty" json:"effectiveInstant,omitempty"`
-	Issued               *string                     `bson:"issued,omitempty" json:"issued,omitempty"`
-	Performer            []Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
-	ValueQuantity        *Quantity                   `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
-	ValueCodeableConcept *CodeableConcept            `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
-	ValueString          *string                     `bson:"valueString,omitempty" json:"valueString,omitempty"`
-	ValueBoolean         *bool                       `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
-	ValueInteger         *int                        `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
-	ValueRange           *Range                      `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
-	ValueRatio           *Ratio                      `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
-	ValueSampledData     *SampledData                `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
-	ValueTime            *string                     `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
-	ValueDateTime        *string                     `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
-	ValuePeriod          *Period                     `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
-	DataAbsentReason     *CodeableConcept            `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
-	Interpretation       []CodeableConcept           `bson:"interpretation,omitempty" json:"interpretation,omitempty"`
-	Note                 []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
-	BodySite             *CodeableConcept            `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Method               *CodeableConcept            `bson:"method,omitempty" json:"method,omitempty"`
-	Specimen             *Reference                  `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	Device               *Reference                  `bson:"device,omitempty" json:"device,omitempty"`
-	ReferenceRange       []ObservationReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
-	HasMember            []Reference                 `bson:"hasMember,omitempty" json:"hasMember,omitempty"`
-	DerivedFrom          []Reference                 `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
-	Component            []ObservationComponent      `bson:"component,omitempty" json:"component,omitempty"`
+	Id                       *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                *Element                    `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                     *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules            *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement     *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                 *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement          *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                     *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                []json.RawMessage           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier               []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                  []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                   []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                   ObservationStatus           `bson:"status" json:"status"`
+	StatusElement            *Element                    `bson:"_status,omitempty" json:"_status,omitempty"`
+	Category                 []CodeableConcept           `bson:"category,omitempty" json:"category,omitempty"`
+	Code                     CodeableConcept             `bson:"code" json:"code"`
+	Subject                  *Reference                  `bson:"subject,omitempty" json:"subject,omitempty"`
+	Focus                    []Reference                 `bson:"focus,omitempty" json:"focus,omitempty"`
+	Encounter                *Reference                  `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	EffectiveDateTime        *string                     `bson:"effectiveDateTime,omitempty" json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *Element                    `bson:"_effectiveDateTime,omitempty" json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *Period                     `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	EffectiveTiming          *Timing                     `bson:"effectiveTiming,omitempty" json:"effectiveTiming,omitempty"`
+	EffectiveInstant         *string                     `bson:"effectiveInstant,omitempty" json:"effectiveInstant,omitempty"`
+	EffectiveInstantElement  *Element                    `bson:"_effectiveInstant,omitempty" json:"_effectiveInstant,omitempty"`
+	Issued                   *string                     `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement            *Element                    `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Performer                []Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
+	ValueQuantity            *Quantity                   `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueCodeableConcept     *CodeableConcept            `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueString              *string                     `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element                    `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueBoolean             *bool                       `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element                    `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueInteger             *int                        `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element                    `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueRange               *Range                      `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                      `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueSampledData         *SampledData                `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueTime                *string                     `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element                    `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueDateTime            *string                     `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element                    `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValuePeriod              *Period                     `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	DataAbsentReason         *CodeableConcept            `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
+	Interpretation           []CodeableConcept           `bson:"interpretation,omitempty" json:"interpretation,omitempty"`
+	Note                     []Annotation                `bson:"note,omitempty" json:"note,omitempty"`
+	BodySite                 *CodeableConcept            `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Method                   *CodeableConcept            `bson:"method,omitempty" json:"method,omitempty"`
+	Specimen                 *Reference                  `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	Device                   *Reference                  `bson:"device,omitempty" json:"device,omitempty"`
+	ReferenceRange           []ObservationReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
+	HasMember                []Reference                 `bson:"hasMember,omitempty" json:"hasMember,omitempty"`
+	DerivedFrom              []Reference                 `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
+	Component                []ObservationComponent      `bson:"component,omitempty" json:"component,omitempty"`
+}
+
+// ObservationEffective is implemented by the types allowed for Observation.effective[x]
+type ObservationEffective interface {
+	isObservationEffective()
+}
+
+func (DateTime) isObservationEffective() {}
+func (Period) isObservationEffective()   {}
+func (Timing) isObservationEffective()   {}
+func (Instant) isObservationEffective()  {}
+
+// Effective returns the value of Observation.effective[x] together with its FHIR type code or nil if no value is set
+func (r Observation) Effective() (ObservationEffective, string) {
+	if r.EffectiveDateTime != nil {
+		return DateTime(*r.EffectiveDateTime), "dateTime"
+	}
+	if r.EffectivePeriod != nil {
+		return *r.EffectivePeriod, "Period"
+	}
+	if r.EffectiveTiming != nil {
+		return *r.EffectiveTiming, "Timing"
+	}
+	if r.EffectiveInstant != nil {
+		return Instant(*r.EffectiveInstant), "instant"
+	}
+	return nil, ""
+}
+
+// SetEffective sets the value of Observation.effective[x], given as value or pointer, and clears all other types
+func (r *Observation) SetEffective(value ObservationEffective) {
+	r.EffectiveDateTime = nil
+	r.EffectivePeriod = nil
+	r.EffectiveTiming = nil
+	r.EffectiveInstant = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.EffectiveDateTime = &primitive
+	case *DateTime:
+		r.EffectiveDateTime = (*string)(v)
+	case Period:
+		r.EffectivePeriod = &v
+	case *Period:
+		r.EffectivePeriod = v
+	case Timing:
+		r.EffectiveTiming = &v
+	case *Timing:
+		r.EffectiveTiming = v
+	case Instant:
+		primitive := string(v)
+		r.EffectiveInstant = &primitive
+	case *Instant:
+		r.EffectiveInstant = (*string)(v)
+	}
+}
+
+// ObservationValue is implemented by the types allowed for Observation.value[x]
+type ObservationValue interface {
+	isObservationValue()
 }
+
+func (Quantity) isObservationValue()        {}
+func (CodeableConcept) isObservationValue() {}
+func (String) isObservationValue()          {}
+func (Boolean) isObservationValue()         {}
+func (Integer) isObservationValue()         {}
+func (Range) isObservationValue()           {}
+func (Ratio) isObservationValue()           {}
+func (SampledData) isObservationValue()     {}
+func (Time) isObservationValue()            {}
+func (DateTime) isObservationValue()        {}
+func (Period) isObservationValue()          {}
+
+// Value returns the value of Observation.value[x] together with its FHIR type code or nil if no value is set
+func (r Observation) Value() (ObservationValue, string) {
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueCodeableConcept != nil {
+		return *r.ValueCodeableConcept, "CodeableConcept"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueRange != nil {
+		return *r.ValueRange, "Range"
+	}
+	if r.ValueRatio != nil {
+		return *r.ValueRatio, "Ratio"
+	}
+	if r.ValueSampledData != nil {
+		return *r.ValueSampledData, "SampledData"
+	}
+	if r.ValueTime != nil {
+		return Time(*r.ValueTime), "time"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValuePeriod != nil {
+		return *r.ValuePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Observation.value[x], given as value or pointer, and clears all other types
+func (r *Observation) SetValue(value ObservationValue) {
+	r.ValueQuantity = nil
+	r.ValueCodeableConcept = nil
+	r.ValueString = nil
+	r.ValueBoolean = nil
+	r.ValueInteger = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueSampledData = nil
+	r.ValueTime = nil
+	r.ValueDateTime = nil
+	r.ValuePeriod = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case CodeableConcept:
+		r.ValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.ValueCodeableConcept = v
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Range:
+		r.ValueRange = &v
+	case *Range:
+		r.ValueRange = v
+	case Ratio:
+		r.ValueRatio = &v
+	case *Ratio:
+		r.ValueRatio = v
+	case SampledData:
+		r.ValueSampledData = &v
+	case *SampledData:
+		r.ValueSampledData = v
+	case Time:
+		primitive := string(v)
+		r.ValueTime = &primitive
+	case *Time:
+		r.ValueTime = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Period:
+		r.ValuePeriod = &v
+	case *Period:
+		r.ValuePeriod = v
+	}
+}
+
 type ObservationReferenceRange struct {
 	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -76,6 +269,7 @@ type ObservationReferenceRange struct {
 	AppliesTo         []CodeableConcept `bson:"appliesTo,omitempty" json:"appliesTo,omitempty"`
 	Age               *Range            `bson:"age,omitempty" json:"age,omitempty"`
 	Text              *string           `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element          `bson:"_text,omitempty" json:"_text,omitempty"`
 }
 type ObservationComponent struct {
 	Id                   *string                     `bson:"id,omitempty" json:"id,omitempty"`
@@ -85,35 +279,4244 @@ type ObservationComponent struct {
 	ValueQuantity        *Quantity                   `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
 	ValueCodeableConcept *CodeableConcept            `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
 	ValueString          *string                     `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element                    `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
 	ValueBoolean         *bool                       `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element                    `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
 	ValueInteger         *int                        `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element                    `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
 	ValueRange           *Range                      `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
 	ValueRatio           *Ratio                      `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
 	ValueSampledData     *SampledData                `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
 	ValueTime            *string                     `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement     *Element                    `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
 	ValueDateTime        *string                     `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element                    `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
 	ValuePeriod          *Period                     `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
 	DataAbsentReason     *CodeableConcept            `bson:"dataAbsentReason,omitempty" json:"dataAbsentReason,omitempty"`
 	Interpretation       []CodeableConcept           `bson:"interpretation,omitempty" json:"interpretation,omitempty"`
 	ReferenceRange       []ObservationReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
 }
-type OtherObservation Observation
+
+// ObservationComponentValue is implemented by the types allowed for Observation.component.value[x]
+type ObservationComponentValue interface {
+	isObservationComponentValue()
+}
+
+func (Quantity) isObservationComponentValue()        {}
+func (CodeableConcept) isObservationComponentValue() {}
+func (String) isObservationComponentValue()          {}
+func (Boolean) isObservationComponentValue()         {}
+func (Integer) isObservationComponentValue()         {}
+func (Range) isObservationComponentValue()           {}
+func (Ratio) isObservationComponentValue()           {}
+func (SampledData) isObservationComponentValue()     {}
+func (Time) isObservationComponentValue()            {}
+func (DateTime) isObservationComponentValue()        {}
+func (Period) isObservationComponentValue()          {}
+
+// Value returns the value of Observation.component.value[x] together with its FHIR type code or nil if no value is set
+func (r ObservationComponent) Value() (ObservationComponentValue, string) {
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueCodeableConcept != nil {
+		return *r.ValueCodeableConcept, "CodeableConcept"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueRange != nil {
+		return *r.ValueRange, "Range"
+	}
+	if r.ValueRatio != nil {
+		return *r.ValueRatio, "Ratio"
+	}
+	if r.ValueSampledData != nil {
+		return *r.ValueSampledData, "SampledData"
+	}
+	if r.ValueTime != nil {
+		return Time(*r.ValueTime), "time"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValuePeriod != nil {
+		return *r.ValuePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Observation.component.value[x], given as value or pointer, and clears all other types
+func (r *ObservationComponent) SetValue(value ObservationComponentValue) {
+	r.ValueQuantity = nil
+	r.ValueCodeableConcept = nil
+	r.ValueString = nil
+	r.ValueBoolean = nil
+	r.ValueInteger = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueSampledData = nil
+	r.ValueTime = nil
+	r.ValueDateTime = nil
+	r.ValuePeriod = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case CodeableConcept:
+		r.ValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.ValueCodeableConcept = v
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Range:
+		r.ValueRange = &v
+	case *Range:
+		r.ValueRange = v
+	case Ratio:
+		r.ValueRatio = &v
+	case *Ratio:
+		r.ValueRatio = v
+	case SampledData:
+		r.ValueSampledData = &v
+	case *SampledData:
+		r.ValueSampledData = v
+	case Time:
+		primitive := string(v)
+		r.ValueTime = &primitive
+	case *Time:
+		r.ValueTime = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Period:
+		r.ValuePeriod = &v
+	case *Period:
+		r.ValuePeriod = v
+	}
+}
 
 // MarshalJSON marshals the given Observation as JSON into a byte slice
 func (r Observation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherObservation
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherObservation: OtherObservation(r),
-		ResourceType:     "Observation",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Observation as JSON object with the members in the order of the fields
+func (r Observation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Observation\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if len(r.Focus) > 0 {
+		e.key("focus")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Focus {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.EffectiveDateTime != nil {
+		e.key("effectiveDateTime")
+		e.string(*r.EffectiveDateTime)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.key("_effectiveDateTime")
+		r.EffectiveDateTimeElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if r.EffectiveTiming != nil {
+		e.key("effectiveTiming")
+		r.EffectiveTiming.appendJSON(e)
+	}
+	if r.EffectiveInstant != nil {
+		e.key("effectiveInstant")
+		e.string(*r.EffectiveInstant)
+	}
+	if r.EffectiveInstantElement != nil {
+		e.key("_effectiveInstant")
+		r.EffectiveInstantElement.appendJSON(e)
+	}
+	if r.Issued != nil {
+		e.key("issued")
+		e.string(*r.Issued)
+	}
+	if r.IssuedElement != nil {
+		e.key("_issued")
+		r.IssuedElement.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.key("valueCodeableConcept")
+		r.ValueCodeableConcept.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueRange != nil {
+		e.key("valueRange")
+		r.ValueRange.appendJSON(e)
+	}
+	if r.ValueRatio != nil {
+		e.key("valueRatio")
+		r.ValueRatio.appendJSON(e)
+	}
+	if r.ValueSampledData != nil {
+		e.key("valueSampledData")
+		r.ValueSampledData.appendJSON(e)
+	}
+	if r.ValueTime != nil {
+		e.key("valueTime")
+		e.string(*r.ValueTime)
+	}
+	if r.ValueTimeElement != nil {
+		e.key("_valueTime")
+		r.ValueTimeElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValuePeriod != nil {
+		e.key("valuePeriod")
+		r.ValuePeriod.appendJSON(e)
+	}
+	if r.DataAbsentReason != nil {
+		e.key("dataAbsentReason")
+		r.DataAbsentReason.appendJSON(e)
+	}
+	if len(r.Interpretation) > 0 {
+		e.key("interpretation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Interpretation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BodySite != nil {
+		e.key("bodySite")
+		r.BodySite.appendJSON(e)
+	}
+	if r.Method != nil {
+		e.key("method")
+		r.Method.appendJSON(e)
+	}
+	if r.Specimen != nil {
+		e.key("specimen")
+		r.Specimen.appendJSON(e)
+	}
+	if r.Device != nil {
+		e.key("device")
+		r.Device.appendJSON(e)
+	}
+	if len(r.ReferenceRange) > 0 {
+		e.key("referenceRange")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReferenceRange {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.HasMember) > 0 {
+		e.key("hasMember")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.HasMember {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DerivedFrom) > 0 {
+		e.key("derivedFrom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DerivedFrom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Component) > 0 {
+		e.key("component")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Component {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Observation from JSON. Unknown members are ignored.
+func (r *Observation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Observation
+func (r *Observation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "focus":
+			a := d.array()
+			r.Focus = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Focus = append(r.Focus, v)
+			}
+			if r.Focus == nil && !a.isNull {
+				r.Focus = []Reference{}
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "effectiveDateTime":
+			if v, ok := d.string(); ok {
+				r.EffectiveDateTime = &v
+			} else {
+				r.EffectiveDateTime = nil
+			}
+		case "_effectiveDateTime":
+			r.EffectiveDateTimeElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "effectiveTiming":
+			if d.null() {
+				r.EffectiveTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.EffectiveTiming = &v
+			}
+		case "effectiveInstant":
+			if v, ok := d.string(); ok {
+				r.EffectiveInstant = &v
+			} else {
+				r.EffectiveInstant = nil
+			}
+		case "_effectiveInstant":
+			r.EffectiveInstantElement = d.element()
+		case "issued":
+			if v, ok := d.string(); ok {
+				r.Issued = &v
+			} else {
+				r.Issued = nil
+			}
+		case "_issued":
+			r.IssuedElement = d.element()
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []Reference{}
+			}
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueCodeableConcept":
+			if d.null() {
+				r.ValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCodeableConcept = &v
+			}
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueRange":
+			if d.null() {
+				r.ValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.ValueRange = &v
+			}
+		case "valueRatio":
+			if d.null() {
+				r.ValueRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.ValueRatio = &v
+			}
+		case "valueSampledData":
+			if d.null() {
+				r.ValueSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.ValueSampledData = &v
+			}
+		case "valueTime":
+			if v, ok := d.string(); ok {
+				r.ValueTime = &v
+			} else {
+				r.ValueTime = nil
+			}
+		case "_valueTime":
+			r.ValueTimeElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valuePeriod":
+			if d.null() {
+				r.ValuePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValuePeriod = &v
+			}
+		case "dataAbsentReason":
+			if d.null() {
+				r.DataAbsentReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DataAbsentReason = &v
+			}
+		case "interpretation":
+			a := d.array()
+			r.Interpretation = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Interpretation = append(r.Interpretation, v)
+			}
+			if r.Interpretation == nil && !a.isNull {
+				r.Interpretation = []CodeableConcept{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "bodySite":
+			if d.null() {
+				r.BodySite = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = &v
+			}
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Method = &v
+			}
+		case "specimen":
+			if d.null() {
+				r.Specimen = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Specimen = &v
+			}
+		case "device":
+			if d.null() {
+				r.Device = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Device = &v
+			}
+		case "referenceRange":
+			a := d.array()
+			r.ReferenceRange = nil
+			for a.next() {
+				var v ObservationReferenceRange
+				v.decodeJSON(d)
+				r.ReferenceRange = append(r.ReferenceRange, v)
+			}
+			if r.ReferenceRange == nil && !a.isNull {
+				r.ReferenceRange = []ObservationReferenceRange{}
+			}
+		case "hasMember":
+			a := d.array()
+			r.HasMember = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.HasMember = append(r.HasMember, v)
+			}
+			if r.HasMember == nil && !a.isNull {
+				r.HasMember = []Reference{}
+			}
+		case "derivedFrom":
+			a := d.array()
+			r.DerivedFrom = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.DerivedFrom = append(r.DerivedFrom, v)
+			}
+			if r.DerivedFrom == nil && !a.isNull {
+				r.DerivedFrom = []Reference{}
+			}
+		case "component":
+			a := d.array()
+			r.Component = nil
+			for a.next() {
+				var v ObservationComponent
+				v.decodeJSON(d)
+				r.Component = append(r.Component, v)
+			}
+			if r.Component == nil && !a.isNull {
+				r.Component = []ObservationComponent{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Observation which shares no memory with the original
+func (r Observation) DeepCopy() Observation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Focus != nil {
+		out.Focus = make([]Reference, len(r.Focus))
+		for i := range r.Focus {
+			out.Focus[i] = r.Focus[i].DeepCopy()
+		}
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.EffectiveDateTime != nil {
+		v := *r.EffectiveDateTime
+		out.EffectiveDateTime = &v
+	}
+	if r.EffectiveDateTimeElement != nil {
+		v := r.EffectiveDateTimeElement.DeepCopy()
+		out.EffectiveDateTimeElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.EffectiveTiming != nil {
+		v := r.EffectiveTiming.DeepCopy()
+		out.EffectiveTiming = &v
+	}
+	if r.EffectiveInstant != nil {
+		v := *r.EffectiveInstant
+		out.EffectiveInstant = &v
+	}
+	if r.EffectiveInstantElement != nil {
+		v := r.EffectiveInstantElement.DeepCopy()
+		out.EffectiveInstantElement = &v
+	}
+	if r.Issued != nil {
+		v := *r.Issued
+		out.Issued = &v
+	}
+	if r.IssuedElement != nil {
+		v := r.IssuedElement.DeepCopy()
+		out.IssuedElement = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]Reference, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueCodeableConcept != nil {
+		v := r.ValueCodeableConcept.DeepCopy()
+		out.ValueCodeableConcept = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueRange != nil {
+		v := r.ValueRange.DeepCopy()
+		out.ValueRange = &v
+	}
+	if r.ValueRatio != nil {
+		v := r.ValueRatio.DeepCopy()
+		out.ValueRatio = &v
+	}
+	if r.ValueSampledData != nil {
+		v := r.ValueSampledData.DeepCopy()
+		out.ValueSampledData = &v
+	}
+	if r.ValueTime != nil {
+		v := *r.ValueTime
+		out.ValueTime = &v
+	}
+	if r.ValueTimeElement != nil {
+		v := r.ValueTimeElement.DeepCopy()
+		out.ValueTimeElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValuePeriod != nil {
+		v := r.ValuePeriod.DeepCopy()
+		out.ValuePeriod = &v
+	}
+	if r.DataAbsentReason != nil {
+		v := r.DataAbsentReason.DeepCopy()
+		out.DataAbsentReason = &v
+	}
+	if r.Interpretation != nil {
+		out.Interpretation = make([]CodeableConcept, len(r.Interpretation))
+		for i := range r.Interpretation {
+			out.Interpretation[i] = r.Interpretation[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.BodySite != nil {
+		v := r.BodySite.DeepCopy()
+		out.BodySite = &v
+	}
+	if r.Method != nil {
+		v := r.Method.DeepCopy()
+		out.Method = &v
+	}
+	if r.Specimen != nil {
+		v := r.Specimen.DeepCopy()
+		out.Specimen = &v
+	}
+	if r.Device != nil {
+		v := r.Device.DeepCopy()
+		out.Device = &v
+	}
+	if r.ReferenceRange != nil {
+		out.ReferenceRange = make([]ObservationReferenceRange, len(r.ReferenceRange))
+		for i := range r.ReferenceRange {
+			out.ReferenceRange[i] = r.ReferenceRange[i].DeepCopy()
+		}
+	}
+	if r.HasMember != nil {
+		out.HasMember = make([]Reference, len(r.HasMember))
+		for i := range r.HasMember {
+			out.HasMember[i] = r.HasMember[i].DeepCopy()
+		}
+	}
+	if r.DerivedFrom != nil {
+		out.DerivedFrom = make([]Reference, len(r.DerivedFrom))
+		for i := range r.DerivedFrom {
+			out.DerivedFrom[i] = r.DerivedFrom[i].DeepCopy()
+		}
+	}
+	if r.Component != nil {
+		out.Component = make([]ObservationComponent, len(r.Component))
+		for i := range r.Component {
+			out.Component[i] = r.Component[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Observation) Equal(other Observation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if len(r.Focus) != len(other.Focus) {
+		return false
+	}
+	for i := range r.Focus {
+		if !r.Focus[i].Equal(other.Focus[i]) {
+			return false
+		}
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && *r.EffectiveDateTime != *other.EffectiveDateTime {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.Equal(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.EffectiveTiming == nil) != (other.EffectiveTiming == nil) || r.EffectiveTiming != nil && !r.EffectiveTiming.Equal(*other.EffectiveTiming) {
+		return false
+	}
+	if (r.EffectiveInstant == nil) != (other.EffectiveInstant == nil) || r.EffectiveInstant != nil && *r.EffectiveInstant != *other.EffectiveInstant {
+		return false
+	}
+	if (r.EffectiveInstantElement == nil) != (other.EffectiveInstantElement == nil) || r.EffectiveInstantElement != nil && !r.EffectiveInstantElement.Equal(*other.EffectiveInstantElement) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && *r.Issued != *other.Issued {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.Equal(*other.IssuedElement) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.Equal(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.Equal(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.Equal(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.Equal(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && *r.ValueTime != *other.ValueTime {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.Equal(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.Equal(*other.ValuePeriod) {
+		return false
+	}
+	if (r.DataAbsentReason == nil) != (other.DataAbsentReason == nil) || r.DataAbsentReason != nil && !r.DataAbsentReason.Equal(*other.DataAbsentReason) {
+		return false
+	}
+	if len(r.Interpretation) != len(other.Interpretation) {
+		return false
+	}
+	for i := range r.Interpretation {
+		if !r.Interpretation[i].Equal(other.Interpretation[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.Equal(*other.BodySite) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.Equal(*other.Method) {
+		return false
+	}
+	if (r.Specimen == nil) != (other.Specimen == nil) || r.Specimen != nil && !r.Specimen.Equal(*other.Specimen) {
+		return false
+	}
+	if (r.Device == nil) != (other.Device == nil) || r.Device != nil && !r.Device.Equal(*other.Device) {
+		return false
+	}
+	if len(r.ReferenceRange) != len(other.ReferenceRange) {
+		return false
+	}
+	for i := range r.ReferenceRange {
+		if !r.ReferenceRange[i].Equal(other.ReferenceRange[i]) {
+			return false
+		}
+	}
+	if len(r.HasMember) != len(other.HasMember) {
+		return false
+	}
+	for i := range r.HasMember {
+		if !r.HasMember[i].Equal(other.HasMember[i]) {
+			return false
+		}
+	}
+	if len(r.DerivedFrom) != len(other.DerivedFrom) {
+		return false
+	}
+	for i := range r.DerivedFrom {
+		if !r.DerivedFrom[i].Equal(other.DerivedFrom[i]) {
+			return false
+		}
+	}
+	if len(r.Component) != len(other.Component) {
+		return false
+	}
+	for i := range r.Component {
+		if !r.Component[i].Equal(other.Component[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Observation) EqualsDeep(other Observation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if !equivalentList(len(r.Focus), len(other.Focus), func(i, j int) bool {
+		return r.Focus[i].EqualsDeep(other.Focus[j])
+	}) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	if (r.EffectiveDateTimeElement == nil) != (other.EffectiveDateTimeElement == nil) || r.EffectiveDateTimeElement != nil && !r.EffectiveDateTimeElement.EqualsDeep(*other.EffectiveDateTimeElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if (r.EffectiveTiming == nil) != (other.EffectiveTiming == nil) || r.EffectiveTiming != nil && !r.EffectiveTiming.EqualsDeep(*other.EffectiveTiming) {
+		return false
+	}
+	if (r.EffectiveInstant == nil) != (other.EffectiveInstant == nil) || r.EffectiveInstant != nil && !equivalentString(*r.EffectiveInstant, *other.EffectiveInstant) {
+		return false
+	}
+	if (r.EffectiveInstantElement == nil) != (other.EffectiveInstantElement == nil) || r.EffectiveInstantElement != nil && !r.EffectiveInstantElement.EqualsDeep(*other.EffectiveInstantElement) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.EqualsDeep(*other.IssuedElement) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.EqualsDeep(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.EqualsDeep(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.EqualsDeep(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.EqualsDeep(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.EqualsDeep(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.EqualsDeep(*other.ValuePeriod) {
+		return false
+	}
+	if (r.DataAbsentReason == nil) != (other.DataAbsentReason == nil) || r.DataAbsentReason != nil && !r.DataAbsentReason.EqualsDeep(*other.DataAbsentReason) {
+		return false
+	}
+	if !equivalentList(len(r.Interpretation), len(other.Interpretation), func(i, j int) bool {
+		return r.Interpretation[i].EqualsDeep(other.Interpretation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.EqualsDeep(*other.BodySite) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.EqualsDeep(*other.Method) {
+		return false
+	}
+	if (r.Specimen == nil) != (other.Specimen == nil) || r.Specimen != nil && !r.Specimen.EqualsDeep(*other.Specimen) {
+		return false
+	}
+	if (r.Device == nil) != (other.Device == nil) || r.Device != nil && !r.Device.EqualsDeep(*other.Device) {
+		return false
+	}
+	if !equivalentList(len(r.ReferenceRange), len(other.ReferenceRange), func(i, j int) bool {
+		return r.ReferenceRange[i].EqualsDeep(other.ReferenceRange[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.HasMember), len(other.HasMember), func(i, j int) bool {
+		return r.HasMember[i].EqualsDeep(other.HasMember[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DerivedFrom), len(other.DerivedFrom), func(i, j int) bool {
+		return r.DerivedFrom[i].EqualsDeep(other.DerivedFrom[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Component), len(other.Component), func(i, j int) bool {
+		return r.Component[i].EqualsDeep(other.Component[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Observation) EqualsShallow(other Observation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.EffectiveDateTime == nil) != (other.EffectiveDateTime == nil) || r.EffectiveDateTime != nil && !equivalentString(*r.EffectiveDateTime, *other.EffectiveDateTime) {
+		return false
+	}
+	if (r.EffectiveInstant == nil) != (other.EffectiveInstant == nil) || r.EffectiveInstant != nil && !equivalentString(*r.EffectiveInstant, *other.EffectiveInstant) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Observation as FHIR XML
+func (r Observation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Observation",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	for _, v := range r.Focus {
+		if err := encodeXMLElement(e, "focus", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "effectiveDateTime", r.EffectiveDateTime, r.EffectiveDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectiveTiming", r.EffectiveTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "effectiveInstant", r.EffectiveInstant, r.EffectiveInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "issued", r.Issued, r.IssuedElement); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCodeableConcept", r.ValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRange", r.ValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRatio", r.ValueRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSampledData", r.ValueSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueTime", r.ValueTime, r.ValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valuePeriod", r.ValuePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dataAbsentReason", r.DataAbsentReason); err != nil {
+		return err
+	}
+	for _, v := range r.Interpretation {
+		if err := encodeXMLElement(e, "interpretation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "bodySite", r.BodySite); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "method", r.Method); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "specimen", r.Specimen); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "device", r.Device); err != nil {
+		return err
+	}
+	for _, v := range r.ReferenceRange {
+		if err := encodeXMLElement(e, "referenceRange", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.HasMember {
+		if err := encodeXMLElement(e, "hasMember", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.DerivedFrom {
+		if err := encodeXMLElement(e, "derivedFrom", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Component {
+		if err := encodeXMLElement(e, "component", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Observation from FHIR XML
+func (r *Observation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v ObservationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "focus":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Focus = append(r.Focus, v)
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "effectiveDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EffectiveDateTime = &v
+				}
+				r.EffectiveDateTimeElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "effectiveTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectiveTiming = &v
+			case "effectiveInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EffectiveInstant = &v
+				}
+				r.EffectiveInstantElement = element
+			case "issued":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Issued = &v
+				}
+				r.IssuedElement = element
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCodeableConcept = &v
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRange = &v
+			case "valueRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRatio = &v
+			case "valueSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSampledData = &v
+			case "valueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueTime = &v
+				}
+				r.ValueTimeElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valuePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValuePeriod = &v
+			case "dataAbsentReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DataAbsentReason = &v
+			case "interpretation":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Interpretation = append(r.Interpretation, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = &v
+			case "method":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Method = &v
+			case "specimen":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specimen = &v
+			case "device":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Device = &v
+			case "referenceRange":
+				var v ObservationReferenceRange
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferenceRange = append(r.ReferenceRange, v)
+			case "hasMember":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.HasMember = append(r.HasMember, v)
+			case "derivedFrom":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DerivedFrom = append(r.DerivedFrom, v)
+			case "component":
+				var v ObservationComponent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Component = append(r.Component, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Observation as properties to the RDF node n
+func (r Observation) turtle(n *turtleNode) {
+	n.resource("Observation", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Observation.identifier", i, v)
+	}
+	for i, v := range r.BasedOn {
+		n.element("Observation.basedOn", i, v)
+	}
+	for i, v := range r.PartOf {
+		n.element("Observation.partOf", i, v)
+	}
+	n.primitive("Observation.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.Category {
+		n.element("Observation.category", i, v)
+	}
+	n.element("Observation.code", -1, r.Code)
+	n.element("Observation.subject", -1, r.Subject)
+	for i, v := range r.Focus {
+		n.element("Observation.focus", i, v)
+	}
+	n.element("Observation.encounter", -1, r.Encounter)
+	n.primitive("Observation.effectiveDateTime", -1, r.EffectiveDateTime, r.EffectiveDateTimeElement, "dateTime")
+	n.element("Observation.effectivePeriod", -1, r.EffectivePeriod)
+	n.element("Observation.effectiveTiming", -1, r.EffectiveTiming)
+	n.primitive("Observation.effectiveInstant", -1, r.EffectiveInstant, r.EffectiveInstantElement, "instant")
+	n.primitive("Observation.issued", -1, r.Issued, r.IssuedElement, "instant")
+	for i, v := range r.Performer {
+		n.element("Observation.performer", i, v)
+	}
+	n.element("Observation.valueQuantity", -1, r.ValueQuantity)
+	n.element("Observation.valueCodeableConcept", -1, r.ValueCodeableConcept)
+	n.primitive("Observation.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("Observation.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("Observation.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.element("Observation.valueRange", -1, r.ValueRange)
+	n.element("Observation.valueRatio", -1, r.ValueRatio)
+	n.element("Observation.valueSampledData", -1, r.ValueSampledData)
+	n.primitive("Observation.valueTime", -1, r.ValueTime, r.ValueTimeElement, "time")
+	n.primitive("Observation.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.element("Observation.valuePeriod", -1, r.ValuePeriod)
+	n.element("Observation.dataAbsentReason", -1, r.DataAbsentReason)
+	for i, v := range r.Interpretation {
+		n.element("Observation.interpretation", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("Observation.note", i, v)
+	}
+	n.element("Observation.bodySite", -1, r.BodySite)
+	n.element("Observation.method", -1, r.Method)
+	n.element("Observation.specimen", -1, r.Specimen)
+	n.element("Observation.device", -1, r.Device)
+	for i, v := range r.ReferenceRange {
+		n.element("Observation.referenceRange", i, v)
+	}
+	for i, v := range r.HasMember {
+		n.element("Observation.hasMember", i, v)
+	}
+	for i, v := range r.DerivedFrom {
+		n.element("Observation.derivedFrom", i, v)
+	}
+	for i, v := range r.Component {
+		n.element("Observation.component", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Observation as BSON document with the structure of its FHIR JSON
+func (r Observation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Observation from a BSON document with the structure of its FHIR JSON
+func (r *Observation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Observation as protobuf message Observation of fhir.proto
+func (r Observation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Observation to the encoded message
+func (r Observation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(10, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(11, v)
+	}
+	e.varint(12, r.Status.protoNumber())
+	for _, v := range r.Category {
+		e.message(13, v)
+	}
+	e.message(14, r.Code)
+	if r.Subject != nil {
+		e.message(15, *r.Subject)
+	}
+	for _, v := range r.Focus {
+		e.message(16, v)
+	}
+	if r.Encounter != nil {
+		e.message(17, *r.Encounter)
+	}
+	if r.EffectiveDateTime != nil {
+		e.string(18, *r.EffectiveDateTime)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(19, *r.EffectivePeriod)
+	}
+	if r.EffectiveTiming != nil {
+		e.message(20, *r.EffectiveTiming)
+	}
+	if r.EffectiveInstant != nil {
+		e.string(21, *r.EffectiveInstant)
+	}
+	if r.Issued != nil {
+		e.string(22, *r.Issued)
+	}
+	for _, v := range r.Performer {
+		e.message(23, v)
+	}
+	if r.ValueQuantity != nil {
+		e.message(24, *r.ValueQuantity)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.message(25, *r.ValueCodeableConcept)
+	}
+	if r.ValueString != nil {
+		e.string(26, *r.ValueString)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(27, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueInteger != nil {
+		e.varint(28, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueRange != nil {
+		e.message(29, *r.ValueRange)
+	}
+	if r.ValueRatio != nil {
+		e.message(30, *r.ValueRatio)
+	}
+	if r.ValueSampledData != nil {
+		e.message(31, *r.ValueSampledData)
+	}
+	if r.ValueTime != nil {
+		e.string(32, *r.ValueTime)
+	}
+	if r.ValueDateTime != nil {
+		e.string(33, *r.ValueDateTime)
+	}
+	if r.ValuePeriod != nil {
+		e.message(34, *r.ValuePeriod)
+	}
+	if r.DataAbsentReason != nil {
+		e.message(35, *r.DataAbsentReason)
+	}
+	for _, v := range r.Interpretation {
+		e.message(36, v)
+	}
+	for _, v := range r.Note {
+		e.message(37, v)
+	}
+	if r.BodySite != nil {
+		e.message(38, *r.BodySite)
+	}
+	if r.Method != nil {
+		e.message(39, *r.Method)
+	}
+	if r.Specimen != nil {
+		e.message(40, *r.Specimen)
+	}
+	if r.Device != nil {
+		e.message(41, *r.Device)
+	}
+	for _, v := range r.ReferenceRange {
+		e.message(42, v)
+	}
+	for _, v := range r.HasMember {
+		e.message(43, v)
+	}
+	for _, v := range r.DerivedFrom {
+		e.message(44, v)
+	}
+	for _, v := range r.Component {
+		e.message(45, v)
+	}
+	if r.IdElement != nil {
+		e.message(46, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(47, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(48, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(49, *r.StatusElement)
+	}
+	if r.EffectiveDateTimeElement != nil {
+		e.message(50, *r.EffectiveDateTimeElement)
+	}
+	if r.EffectiveInstantElement != nil {
+		e.message(51, *r.EffectiveInstantElement)
+	}
+	if r.IssuedElement != nil {
+		e.message(52, *r.IssuedElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(53, *r.ValueStringElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(54, *r.ValueBooleanElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(55, *r.ValueIntegerElement)
+	}
+	if r.ValueTimeElement != nil {
+		e.message(56, *r.ValueTimeElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(57, *r.ValueDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Observation from protobuf message Observation of fhir.proto
+func (r *Observation) UnmarshalProto(b []byte) error {
+	*r = Observation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 12:
+			var v ObservationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Focus = append(r.Focus, v)
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 18:
+			v := d.string()
+			r.EffectiveDateTime = &v
+		case 19:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 20:
+			var v Timing
+			d.message(&v)
+			r.EffectiveTiming = &v
+		case 21:
+			v := d.string()
+			r.EffectiveInstant = &v
+		case 22:
+			v := d.string()
+			r.Issued = &v
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 24:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 25:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCodeableConcept = &v
+		case 26:
+			v := d.string()
+			r.ValueString = &v
+		case 27:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 28:
+			v := d.int()
+			r.ValueInteger = &v
+		case 29:
+			var v Range
+			d.message(&v)
+			r.ValueRange = &v
+		case 30:
+			var v Ratio
+			d.message(&v)
+			r.ValueRatio = &v
+		case 31:
+			var v SampledData
+			d.message(&v)
+			r.ValueSampledData = &v
+		case 32:
+			v := d.string()
+			r.ValueTime = &v
+		case 33:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 34:
+			var v Period
+			d.message(&v)
+			r.ValuePeriod = &v
+		case 35:
+			var v CodeableConcept
+			d.message(&v)
+			r.DataAbsentReason = &v
+		case 36:
+			var v CodeableConcept
+			d.message(&v)
+			r.Interpretation = append(r.Interpretation, v)
+		case 37:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 38:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = &v
+		case 39:
+			var v CodeableConcept
+			d.message(&v)
+			r.Method = &v
+		case 40:
+			var v Reference
+			d.message(&v)
+			r.Specimen = &v
+		case 41:
+			var v Reference
+			d.message(&v)
+			r.Device = &v
+		case 42:
+			var v ObservationReferenceRange
+			d.message(&v)
+			r.ReferenceRange = append(r.ReferenceRange, v)
+		case 43:
+			var v Reference
+			d.message(&v)
+			r.HasMember = append(r.HasMember, v)
+		case 44:
+			var v Reference
+			d.message(&v)
+			r.DerivedFrom = append(r.DerivedFrom, v)
+		case 45:
+			var v ObservationComponent
+			d.message(&v)
+			r.Component = append(r.Component, v)
+		case 46:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.EffectiveDateTimeElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.EffectiveInstantElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.IssuedElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 54:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.ValueTimeElement = &v
+		case 57:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ObservationReferenceRange as JSON into a byte slice
+func (r ObservationReferenceRange) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ObservationReferenceRange as JSON object with the members in the order of the fields
+func (r ObservationReferenceRange) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Low != nil {
+		e.key("low")
+		r.Low.appendJSON(e)
+	}
+	if r.High != nil {
+		e.key("high")
+		r.High.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.AppliesTo) > 0 {
+		e.key("appliesTo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AppliesTo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Age != nil {
+		e.key("age")
+		r.Age.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ObservationReferenceRange from JSON. Unknown members are ignored.
+func (r *ObservationReferenceRange) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ObservationReferenceRange
+func (r *ObservationReferenceRange) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "low":
+			if d.null() {
+				r.Low = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Low = &v
+			}
+		case "high":
+			if d.null() {
+				r.High = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.High = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "appliesTo":
+			a := d.array()
+			r.AppliesTo = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AppliesTo = append(r.AppliesTo, v)
+			}
+			if r.AppliesTo == nil && !a.isNull {
+				r.AppliesTo = []CodeableConcept{}
+			}
+		case "age":
+			if d.null() {
+				r.Age = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.Age = &v
+			}
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ObservationReferenceRange which shares no memory with the original
+func (r ObservationReferenceRange) DeepCopy() ObservationReferenceRange {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Low != nil {
+		v := r.Low.DeepCopy()
+		out.Low = &v
+	}
+	if r.High != nil {
+		v := r.High.DeepCopy()
+		out.High = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.AppliesTo != nil {
+		out.AppliesTo = make([]CodeableConcept, len(r.AppliesTo))
+		for i := range r.AppliesTo {
+			out.AppliesTo[i] = r.AppliesTo[i].DeepCopy()
+		}
+	}
+	if r.Age != nil {
+		v := r.Age.DeepCopy()
+		out.Age = &v
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ObservationReferenceRange) Equal(other ObservationReferenceRange) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Low == nil) != (other.Low == nil) || r.Low != nil && !r.Low.Equal(*other.Low) {
+		return false
+	}
+	if (r.High == nil) != (other.High == nil) || r.High != nil && !r.High.Equal(*other.High) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.AppliesTo) != len(other.AppliesTo) {
+		return false
+	}
+	for i := range r.AppliesTo {
+		if !r.AppliesTo[i].Equal(other.AppliesTo[i]) {
+			return false
+		}
+	}
+	if (r.Age == nil) != (other.Age == nil) || r.Age != nil && !r.Age.Equal(*other.Age) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ObservationReferenceRange) EqualsDeep(other ObservationReferenceRange) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Low == nil) != (other.Low == nil) || r.Low != nil && !r.Low.EqualsDeep(*other.Low) {
+		return false
+	}
+	if (r.High == nil) != (other.High == nil) || r.High != nil && !r.High.EqualsDeep(*other.High) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.AppliesTo), len(other.AppliesTo), func(i, j int) bool {
+		return r.AppliesTo[i].EqualsDeep(other.AppliesTo[j])
+	}) {
+		return false
+	}
+	if (r.Age == nil) != (other.Age == nil) || r.Age != nil && !r.Age.EqualsDeep(*other.Age) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ObservationReferenceRange) EqualsShallow(other ObservationReferenceRange) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ObservationReferenceRange as FHIR XML
+func (r ObservationReferenceRange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "low", r.Low); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "high", r.High); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.AppliesTo {
+		if err := encodeXMLElement(e, "appliesTo", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "age", r.Age); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ObservationReferenceRange from FHIR XML
+func (r *ObservationReferenceRange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "low":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Low = &v
+			case "high":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.High = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "appliesTo":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AppliesTo = append(r.AppliesTo, v)
+			case "age":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Age = &v
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ObservationReferenceRange as properties to the RDF node n
+func (r ObservationReferenceRange) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Observation.referenceRange.low", -1, r.Low)
+	n.element("Observation.referenceRange.high", -1, r.High)
+	n.element("Observation.referenceRange.type", -1, r.Type)
+	for i, v := range r.AppliesTo {
+		n.element("Observation.referenceRange.appliesTo", i, v)
+	}
+	n.element("Observation.referenceRange.age", -1, r.Age)
+	n.primitive("Observation.referenceRange.text", -1, r.Text, r.TextElement, "string")
+}
+
+// MarshalBSON marshals the given ObservationReferenceRange as BSON document with the structure of its FHIR JSON
+func (r ObservationReferenceRange) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ObservationReferenceRange from a BSON document with the structure of its FHIR JSON
+func (r *ObservationReferenceRange) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ObservationReferenceRange as protobuf message ObservationReferenceRange of fhir.proto
+func (r ObservationReferenceRange) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ObservationReferenceRange to the encoded message
+func (r ObservationReferenceRange) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Low != nil {
+		e.message(4, *r.Low)
+	}
+	if r.High != nil {
+		e.message(5, *r.High)
+	}
+	if r.Type != nil {
+		e.message(6, *r.Type)
+	}
+	for _, v := range r.AppliesTo {
+		e.message(7, v)
+	}
+	if r.Age != nil {
+		e.message(8, *r.Age)
+	}
+	if r.Text != nil {
+		e.string(9, *r.Text)
+	}
+	if r.TextElement != nil {
+		e.message(10, *r.TextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ObservationReferenceRange from protobuf message ObservationReferenceRange of fhir.proto
+func (r *ObservationReferenceRange) UnmarshalProto(b []byte) error {
+	*r = ObservationReferenceRange{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.Low = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.High = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.AppliesTo = append(r.AppliesTo, v)
+		case 8:
+			var v Range
+			d.message(&v)
+			r.Age = &v
+		case 9:
+			v := d.string()
+			r.Text = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ObservationComponent as JSON into a byte slice
+func (r ObservationComponent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ObservationComponent as JSON object with the members in the order of the fields
+func (r ObservationComponent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.key("valueCodeableConcept")
+		r.ValueCodeableConcept.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueRange != nil {
+		e.key("valueRange")
+		r.ValueRange.appendJSON(e)
+	}
+	if r.ValueRatio != nil {
+		e.key("valueRatio")
+		r.ValueRatio.appendJSON(e)
+	}
+	if r.ValueSampledData != nil {
+		e.key("valueSampledData")
+		r.ValueSampledData.appendJSON(e)
+	}
+	if r.ValueTime != nil {
+		e.key("valueTime")
+		e.string(*r.ValueTime)
+	}
+	if r.ValueTimeElement != nil {
+		e.key("_valueTime")
+		r.ValueTimeElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValuePeriod != nil {
+		e.key("valuePeriod")
+		r.ValuePeriod.appendJSON(e)
+	}
+	if r.DataAbsentReason != nil {
+		e.key("dataAbsentReason")
+		r.DataAbsentReason.appendJSON(e)
+	}
+	if len(r.Interpretation) > 0 {
+		e.key("interpretation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Interpretation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReferenceRange) > 0 {
+		e.key("referenceRange")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReferenceRange {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ObservationComponent from JSON. Unknown members are ignored.
+func (r *ObservationComponent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ObservationComponent
+func (r *ObservationComponent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueCodeableConcept":
+			if d.null() {
+				r.ValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCodeableConcept = &v
+			}
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueRange":
+			if d.null() {
+				r.ValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.ValueRange = &v
+			}
+		case "valueRatio":
+			if d.null() {
+				r.ValueRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.ValueRatio = &v
+			}
+		case "valueSampledData":
+			if d.null() {
+				r.ValueSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.ValueSampledData = &v
+			}
+		case "valueTime":
+			if v, ok := d.string(); ok {
+				r.ValueTime = &v
+			} else {
+				r.ValueTime = nil
+			}
+		case "_valueTime":
+			r.ValueTimeElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valuePeriod":
+			if d.null() {
+				r.ValuePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValuePeriod = &v
+			}
+		case "dataAbsentReason":
+			if d.null() {
+				r.DataAbsentReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DataAbsentReason = &v
+			}
+		case "interpretation":
+			a := d.array()
+			r.Interpretation = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Interpretation = append(r.Interpretation, v)
+			}
+			if r.Interpretation == nil && !a.isNull {
+				r.Interpretation = []CodeableConcept{}
+			}
+		case "referenceRange":
+			a := d.array()
+			r.ReferenceRange = nil
+			for a.next() {
+				var v ObservationReferenceRange
+				v.decodeJSON(d)
+				r.ReferenceRange = append(r.ReferenceRange, v)
+			}
+			if r.ReferenceRange == nil && !a.isNull {
+				r.ReferenceRange = []ObservationReferenceRange{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ObservationComponent which shares no memory with the original
+func (r ObservationComponent) DeepCopy() ObservationComponent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueCodeableConcept != nil {
+		v := r.ValueCodeableConcept.DeepCopy()
+		out.ValueCodeableConcept = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueRange != nil {
+		v := r.ValueRange.DeepCopy()
+		out.ValueRange = &v
+	}
+	if r.ValueRatio != nil {
+		v := r.ValueRatio.DeepCopy()
+		out.ValueRatio = &v
+	}
+	if r.ValueSampledData != nil {
+		v := r.ValueSampledData.DeepCopy()
+		out.ValueSampledData = &v
+	}
+	if r.ValueTime != nil {
+		v := *r.ValueTime
+		out.ValueTime = &v
+	}
+	if r.ValueTimeElement != nil {
+		v := r.ValueTimeElement.DeepCopy()
+		out.ValueTimeElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValuePeriod != nil {
+		v := r.ValuePeriod.DeepCopy()
+		out.ValuePeriod = &v
+	}
+	if r.DataAbsentReason != nil {
+		v := r.DataAbsentReason.DeepCopy()
+		out.DataAbsentReason = &v
+	}
+	if r.Interpretation != nil {
+		out.Interpretation = make([]CodeableConcept, len(r.Interpretation))
+		for i := range r.Interpretation {
+			out.Interpretation[i] = r.Interpretation[i].DeepCopy()
+		}
+	}
+	if r.ReferenceRange != nil {
+		out.ReferenceRange = make([]ObservationReferenceRange, len(r.ReferenceRange))
+		for i := range r.ReferenceRange {
+			out.ReferenceRange[i] = r.ReferenceRange[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ObservationComponent) Equal(other ObservationComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.Equal(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.Equal(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.Equal(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.Equal(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && *r.ValueTime != *other.ValueTime {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.Equal(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.Equal(*other.ValuePeriod) {
+		return false
+	}
+	if (r.DataAbsentReason == nil) != (other.DataAbsentReason == nil) || r.DataAbsentReason != nil && !r.DataAbsentReason.Equal(*other.DataAbsentReason) {
+		return false
+	}
+	if len(r.Interpretation) != len(other.Interpretation) {
+		return false
+	}
+	for i := range r.Interpretation {
+		if !r.Interpretation[i].Equal(other.Interpretation[i]) {
+			return false
+		}
+	}
+	if len(r.ReferenceRange) != len(other.ReferenceRange) {
+		return false
+	}
+	for i := range r.ReferenceRange {
+		if !r.ReferenceRange[i].Equal(other.ReferenceRange[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ObservationComponent) EqualsDeep(other ObservationComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.EqualsDeep(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.EqualsDeep(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.EqualsDeep(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.EqualsDeep(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.EqualsDeep(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.EqualsDeep(*other.ValuePeriod) {
+		return false
+	}
+	if (r.DataAbsentReason == nil) != (other.DataAbsentReason == nil) || r.DataAbsentReason != nil && !r.DataAbsentReason.EqualsDeep(*other.DataAbsentReason) {
+		return false
+	}
+	if !equivalentList(len(r.Interpretation), len(other.Interpretation), func(i, j int) bool {
+		return r.Interpretation[i].EqualsDeep(other.Interpretation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReferenceRange), len(other.ReferenceRange), func(i, j int) bool {
+		return r.ReferenceRange[i].EqualsDeep(other.ReferenceRange[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ObservationComponent) EqualsShallow(other ObservationComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ObservationComponent as FHIR XML
+func (r ObservationComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCodeableConcept", r.ValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRange", r.ValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRatio", r.ValueRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSampledData", r.ValueSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueTime", r.ValueTime, r.ValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valuePeriod", r.ValuePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "dataAbsentReason", r.DataAbsentReason); err != nil {
+		return err
+	}
+	for _, v := range r.Interpretation {
+		if err := encodeXMLElement(e, "interpretation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReferenceRange {
+		if err := encodeXMLElement(e, "referenceRange", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ObservationComponent from FHIR XML
+func (r *ObservationComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCodeableConcept = &v
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRange = &v
+			case "valueRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRatio = &v
+			case "valueSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSampledData = &v
+			case "valueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueTime = &v
+				}
+				r.ValueTimeElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valuePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValuePeriod = &v
+			case "dataAbsentReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DataAbsentReason = &v
+			case "interpretation":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Interpretation = append(r.Interpretation, v)
+			case "referenceRange":
+				var v ObservationReferenceRange
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferenceRange = append(r.ReferenceRange, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ObservationComponent as properties to the RDF node n
+func (r ObservationComponent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Observation.component.code", -1, r.Code)
+	n.element("Observation.component.valueQuantity", -1, r.ValueQuantity)
+	n.element("Observation.component.valueCodeableConcept", -1, r.ValueCodeableConcept)
+	n.primitive("Observation.component.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("Observation.component.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("Observation.component.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.element("Observation.component.valueRange", -1, r.ValueRange)
+	n.element("Observation.component.valueRatio", -1, r.ValueRatio)
+	n.element("Observation.component.valueSampledData", -1, r.ValueSampledData)
+	n.primitive("Observation.component.valueTime", -1, r.ValueTime, r.ValueTimeElement, "time")
+	n.primitive("Observation.component.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.element("Observation.component.valuePeriod", -1, r.ValuePeriod)
+	n.element("Observation.component.dataAbsentReason", -1, r.DataAbsentReason)
+	for i, v := range r.Interpretation {
+		n.element("Observation.component.interpretation", i, v)
+	}
+	for i, v := range r.ReferenceRange {
+		n.element("Observation.component.referenceRange", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ObservationComponent as BSON document with the structure of its FHIR JSON
+func (r ObservationComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ObservationComponent from a BSON document with the structure of its FHIR JSON
+func (r *ObservationComponent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ObservationComponent as protobuf message ObservationComponent of fhir.proto
+func (r ObservationComponent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ObservationComponent to the encoded message
+func (r ObservationComponent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Code)
+	if r.ValueQuantity != nil {
+		e.message(5, *r.ValueQuantity)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.message(6, *r.ValueCodeableConcept)
+	}
+	if r.ValueString != nil {
+		e.string(7, *r.ValueString)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(8, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueInteger != nil {
+		e.varint(9, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueRange != nil {
+		e.message(10, *r.ValueRange)
+	}
+	if r.ValueRatio != nil {
+		e.message(11, *r.ValueRatio)
+	}
+	if r.ValueSampledData != nil {
+		e.message(12, *r.ValueSampledData)
+	}
+	if r.ValueTime != nil {
+		e.string(13, *r.ValueTime)
+	}
+	if r.ValueDateTime != nil {
+		e.string(14, *r.ValueDateTime)
+	}
+	if r.ValuePeriod != nil {
+		e.message(15, *r.ValuePeriod)
+	}
+	if r.DataAbsentReason != nil {
+		e.message(16, *r.DataAbsentReason)
+	}
+	for _, v := range r.Interpretation {
+		e.message(17, v)
+	}
+	for _, v := range r.ReferenceRange {
+		e.message(18, v)
+	}
+	if r.ValueStringElement != nil {
+		e.message(19, *r.ValueStringElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(20, *r.ValueBooleanElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(21, *r.ValueIntegerElement)
+	}
+	if r.ValueTimeElement != nil {
+		e.message(22, *r.ValueTimeElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(23, *r.ValueDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ObservationComponent from protobuf message ObservationComponent of fhir.proto
+func (r *ObservationComponent) UnmarshalProto(b []byte) error {
+	*r = ObservationComponent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCodeableConcept = &v
+		case 7:
+			v := d.string()
+			r.ValueString = &v
+		case 8:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 9:
+			v := d.int()
+			r.ValueInteger = &v
+		case 10:
+			var v Range
+			d.message(&v)
+			r.ValueRange = &v
+		case 11:
+			var v Ratio
+			d.message(&v)
+			r.ValueRatio = &v
+		case 12:
+			var v SampledData
+			d.message(&v)
+			r.ValueSampledData = &v
+		case 13:
+			v := d.string()
+			r.ValueTime = &v
+		case 14:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.ValuePeriod = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.DataAbsentReason = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.Interpretation = append(r.Interpretation, v)
+		case 18:
+			var v ObservationReferenceRange
+			d.message(&v)
+			r.ReferenceRange = append(r.ReferenceRange, v)
+		case 19:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ValueTimeElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalObservation unmarshals a Observation.
 func UnmarshalObservation(b []byte) (Observation, error) {
 	var observation Observation
-	if err := json.Unmarshal(b, &observation); err != nil {
+	if err := observation.UnmarshalJSON(b); err != nil {
 		return observation, err
 	}
 	return observation, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -43,8 +42,13 @@ const (
 func (code ObservationDataType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ObservationDataType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ObservationDataType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "Quantity":
 		*code = ObservationDataTypeQuantity
@@ -73,6 +77,12 @@ func (code *ObservationDataType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ObservationDataType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ObservationDataType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ObservationDataType) String() string {
 	return code.Code()
 }
@@ -157,3 +167,59 @@ func (code ObservationDataType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ObservationDataType) protoNumber() uint64 {
+	switch code {
+	case ObservationDataTypeQuantity:
+		return 1
+	case ObservationDataTypeCodeableConcept:
+		return 2
+	case ObservationDataTypeString:
+		return 3
+	case ObservationDataTypeBoolean:
+		return 4
+	case ObservationDataTypeInteger:
+		return 5
+	case ObservationDataTypeRange:
+		return 6
+	case ObservationDataTypeRatio:
+		return 7
+	case ObservationDataTypeSampledData:
+		return 8
+	case ObservationDataTypeTime:
+		return 9
+	case ObservationDataTypeDateTime:
+		return 10
+	case ObservationDataTypePeriod:
+		return 11
+	}
+	return 0
+}
+func (code *ObservationDataType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ObservationDataTypeQuantity
+	case 2:
+		*code = ObservationDataTypeCodeableConcept
+	case 3:
+		*code = ObservationDataTypeString
+	case 4:
+		*code = ObservationDataTypeBoolean
+	case 5:
+		*code = ObservationDataTypeInteger
+	case 6:
+		*code = ObservationDataTypeRange
+	case 7:
+		*code = ObservationDataTypeRatio
+	case 8:
+		*code = ObservationDataTypeSampledData
+	case 9:
+		*code = ObservationDataTypeTime
+	case 10:
+		*code = ObservationDataTypeDateTime
+	case 11:
+		*code = ObservationDataTypePeriod
+	default:
+		return false
+	}
+	return true
+}
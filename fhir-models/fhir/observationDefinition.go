@@ -14,73 +14,2531 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ObservationDefinition is documented here http://hl7.org/fhir/StructureDefinition/ObservationDefinition
 type ObservationDefinition struct {
-	Id                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Category               []CodeableConcept                         `bson:"category,omitempty" json:"category,omitempty"`
-	Code                   CodeableConcept                           `bson:"code" json:"code"`
-	Identifier             []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	PermittedDataType      []ObservationDataType                     `bson:"permittedDataType,omitempty" json:"permittedDataType,omitempty"`
-	MultipleResultsAllowed *bool                                     `bson:"multipleResultsAllowed,omitempty" json:"multipleResultsAllowed,omitempty"`
-	Method                 *CodeableConcept                          `bson:"method,omitempty" json:"method,omitempty"`
-	PreferredReportName    *string                                   `bson:"preferredReportName,omitempty" json:"preferredReportName,omitempty"`
-	QuantitativeDetails    *ObservationDefinitionQuantitativeDetails `bson:"quantitativeDetails,omitempty" json:"quantitativeDetails,omitempty"`
-	QualifiedInterval      []ObservationDefinitionQualifiedInterval  `bson:"qualifiedInterval,omitempty" json:"qualifiedInterval,omitempty"`
-	ValidCodedValueSet     *Reference                                `bson:"validCodedValueSet,omitempty" json:"validCodedValueSet,omitempty"`
-	NormalCodedValueSet    *Reference                                `bson:"normalCodedValueSet,omitempty" json:"normalCodedValueSet,omitempty"`
-	AbnormalCodedValueSet  *Reference                                `bson:"abnormalCodedValueSet,omitempty" json:"abnormalCodedValueSet,omitempty"`
-	CriticalCodedValueSet  *Reference                                `bson:"criticalCodedValueSet,omitempty" json:"criticalCodedValueSet,omitempty"`
+	Id                            *string                                   `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                     *Element                                  `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                          *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                                  `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     []json.RawMessage                         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Category                      []CodeableConcept                         `bson:"category,omitempty" json:"category,omitempty"`
+	Code                          CodeableConcept                           `bson:"code" json:"code"`
+	Identifier                    []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	PermittedDataType             []ObservationDataType                     `bson:"permittedDataType,omitempty" json:"permittedDataType,omitempty"`
+	PermittedDataTypeElement      []*Element                                `bson:"_permittedDataType,omitempty" json:"_permittedDataType,omitempty"`
+	MultipleResultsAllowed        *bool                                     `bson:"multipleResultsAllowed,omitempty" json:"multipleResultsAllowed,omitempty"`
+	MultipleResultsAllowedElement *Element                                  `bson:"_multipleResultsAllowed,omitempty" json:"_multipleResultsAllowed,omitempty"`
+	Method                        *CodeableConcept                          `bson:"method,omitempty" json:"method,omitempty"`
+	PreferredReportName           *string                                   `bson:"preferredReportName,omitempty" json:"preferredReportName,omitempty"`
+	PreferredReportNameElement    *Element                                  `bson:"_preferredReportName,omitempty" json:"_preferredReportName,omitempty"`
+	QuantitativeDetails           *ObservationDefinitionQuantitativeDetails `bson:"quantitativeDetails,omitempty" json:"quantitativeDetails,omitempty"`
+	QualifiedInterval             []ObservationDefinitionQualifiedInterval  `bson:"qualifiedInterval,omitempty" json:"qualifiedInterval,omitempty"`
+	ValidCodedValueSet            *Reference                                `bson:"validCodedValueSet,omitempty" json:"validCodedValueSet,omitempty"`
+	NormalCodedValueSet           *Reference                                `bson:"normalCodedValueSet,omitempty" json:"normalCodedValueSet,omitempty"`
+	AbnormalCodedValueSet         *Reference                                `bson:"abnormalCodedValueSet,omitempty" json:"abnormalCodedValueSet,omitempty"`
+	CriticalCodedValueSet         *Reference                                `bson:"criticalCodedValueSet,omitempty" json:"criticalCodedValueSet,omitempty"`
 }
 type ObservationDefinitionQuantitativeDetails struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	CustomaryUnit     *CodeableConcept `bson:"customaryUnit,omitempty" json:"customaryUnit,omitempty"`
-	Unit              *CodeableConcept `bson:"unit,omitempty" json:"unit,omitempty"`
-	ConversionFactor  *json.Number     `bson:"conversionFactor,omitempty" json:"conversionFactor,omitempty"`
-	DecimalPrecision  *int             `bson:"decimalPrecision,omitempty" json:"decimalPrecision,omitempty"`
+	Id                      *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	CustomaryUnit           *CodeableConcept `bson:"customaryUnit,omitempty" json:"customaryUnit,omitempty"`
+	Unit                    *CodeableConcept `bson:"unit,omitempty" json:"unit,omitempty"`
+	ConversionFactor        *json.Number     `bson:"conversionFactor,omitempty" json:"conversionFactor,omitempty"`
+	ConversionFactorElement *Element         `bson:"_conversionFactor,omitempty" json:"_conversionFactor,omitempty"`
+	DecimalPrecision        *int             `bson:"decimalPrecision,omitempty" json:"decimalPrecision,omitempty"`
+	DecimalPrecisionElement *Element         `bson:"_decimalPrecision,omitempty" json:"_decimalPrecision,omitempty"`
 }
 type ObservationDefinitionQualifiedInterval struct {
 	Id                *string                   `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Category          *ObservationRangeCategory `bson:"category,omitempty" json:"category,omitempty"`
+	CategoryElement   *Element                  `bson:"_category,omitempty" json:"_category,omitempty"`
 	Range             *Range                    `bson:"range,omitempty" json:"range,omitempty"`
 	Context           *CodeableConcept          `bson:"context,omitempty" json:"context,omitempty"`
 	AppliesTo         []CodeableConcept         `bson:"appliesTo,omitempty" json:"appliesTo,omitempty"`
 	Gender            *AdministrativeGender     `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement     *Element                  `bson:"_gender,omitempty" json:"_gender,omitempty"`
 	Age               *Range                    `bson:"age,omitempty" json:"age,omitempty"`
 	GestationalAge    *Range                    `bson:"gestationalAge,omitempty" json:"gestationalAge,omitempty"`
 	Condition         *string                   `bson:"condition,omitempty" json:"condition,omitempty"`
+	ConditionElement  *Element                  `bson:"_condition,omitempty" json:"_condition,omitempty"`
 }
-type OtherObservationDefinition ObservationDefinition
 
 // MarshalJSON marshals the given ObservationDefinition as JSON into a byte slice
 func (r ObservationDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherObservationDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherObservationDefinition: OtherObservationDefinition(r),
-		ResourceType:               "ObservationDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ObservationDefinition as JSON object with the members in the order of the fields
+func (r ObservationDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ObservationDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PermittedDataType) > 0 {
+		e.key("permittedDataType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PermittedDataType {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PermittedDataTypeElement) > 0 {
+		e.key("_permittedDataType")
+		e.elements(r.PermittedDataTypeElement, len(r.PermittedDataType))
+	}
+	if r.MultipleResultsAllowed != nil {
+		e.key("multipleResultsAllowed")
+		e.bool(*r.MultipleResultsAllowed)
+	}
+	if r.MultipleResultsAllowedElement != nil {
+		e.key("_multipleResultsAllowed")
+		r.MultipleResultsAllowedElement.appendJSON(e)
+	}
+	if r.Method != nil {
+		e.key("method")
+		r.Method.appendJSON(e)
+	}
+	if r.PreferredReportName != nil {
+		e.key("preferredReportName")
+		e.string(*r.PreferredReportName)
+	}
+	if r.PreferredReportNameElement != nil {
+		e.key("_preferredReportName")
+		r.PreferredReportNameElement.appendJSON(e)
+	}
+	if r.QuantitativeDetails != nil {
+		e.key("quantitativeDetails")
+		r.QuantitativeDetails.appendJSON(e)
+	}
+	if len(r.QualifiedInterval) > 0 {
+		e.key("qualifiedInterval")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.QualifiedInterval {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ValidCodedValueSet != nil {
+		e.key("validCodedValueSet")
+		r.ValidCodedValueSet.appendJSON(e)
+	}
+	if r.NormalCodedValueSet != nil {
+		e.key("normalCodedValueSet")
+		r.NormalCodedValueSet.appendJSON(e)
+	}
+	if r.AbnormalCodedValueSet != nil {
+		e.key("abnormalCodedValueSet")
+		r.AbnormalCodedValueSet.appendJSON(e)
+	}
+	if r.CriticalCodedValueSet != nil {
+		e.key("criticalCodedValueSet")
+		r.CriticalCodedValueSet.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ObservationDefinition from JSON. Unknown members are ignored.
+func (r *ObservationDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ObservationDefinition
+func (r *ObservationDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "permittedDataType":
+			a := d.array()
+			r.PermittedDataType = nil
+			for a.next() {
+				var v ObservationDataType
+				d.unmarshal(&v)
+				r.PermittedDataType = append(r.PermittedDataType, v)
+			}
+			if r.PermittedDataType == nil && !a.isNull {
+				r.PermittedDataType = []ObservationDataType{}
+			}
+		case "_permittedDataType":
+			a := d.array()
+			r.PermittedDataTypeElement = nil
+			for a.next() {
+				r.PermittedDataTypeElement = append(r.PermittedDataTypeElement, d.element())
+			}
+		case "multipleResultsAllowed":
+			if v, ok := d.bool(); ok {
+				r.MultipleResultsAllowed = &v
+			} else {
+				r.MultipleResultsAllowed = nil
+			}
+		case "_multipleResultsAllowed":
+			r.MultipleResultsAllowedElement = d.element()
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Method = &v
+			}
+		case "preferredReportName":
+			if v, ok := d.string(); ok {
+				r.PreferredReportName = &v
+			} else {
+				r.PreferredReportName = nil
+			}
+		case "_preferredReportName":
+			r.PreferredReportNameElement = d.element()
+		case "quantitativeDetails":
+			if d.null() {
+				r.QuantitativeDetails = nil
+			} else {
+				var v ObservationDefinitionQuantitativeDetails
+				v.decodeJSON(d)
+				r.QuantitativeDetails = &v
+			}
+		case "qualifiedInterval":
+			a := d.array()
+			r.QualifiedInterval = nil
+			for a.next() {
+				var v ObservationDefinitionQualifiedInterval
+				v.decodeJSON(d)
+				r.QualifiedInterval = append(r.QualifiedInterval, v)
+			}
+			if r.QualifiedInterval == nil && !a.isNull {
+				r.QualifiedInterval = []ObservationDefinitionQualifiedInterval{}
+			}
+		case "validCodedValueSet":
+			if d.null() {
+				r.ValidCodedValueSet = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValidCodedValueSet = &v
+			}
+		case "normalCodedValueSet":
+			if d.null() {
+				r.NormalCodedValueSet = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.NormalCodedValueSet = &v
+			}
+		case "abnormalCodedValueSet":
+			if d.null() {
+				r.AbnormalCodedValueSet = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.AbnormalCodedValueSet = &v
+			}
+		case "criticalCodedValueSet":
+			if d.null() {
+				r.CriticalCodedValueSet = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.CriticalCodedValueSet = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ObservationDefinition which shares no memory with the original
+func (r ObservationDefinition) DeepCopy() ObservationDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.PermittedDataType != nil {
+		out.PermittedDataType = make([]ObservationDataType, len(r.PermittedDataType))
+		copy(out.PermittedDataType, r.PermittedDataType)
+	}
+	if r.PermittedDataTypeElement != nil {
+		out.PermittedDataTypeElement = make([]*Element, len(r.PermittedDataTypeElement))
+		for i, v := range r.PermittedDataTypeElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PermittedDataTypeElement[i] = &c
+			}
+		}
+	}
+	if r.MultipleResultsAllowed != nil {
+		v := *r.MultipleResultsAllowed
+		out.MultipleResultsAllowed = &v
+	}
+	if r.MultipleResultsAllowedElement != nil {
+		v := r.MultipleResultsAllowedElement.DeepCopy()
+		out.MultipleResultsAllowedElement = &v
+	}
+	if r.Method != nil {
+		v := r.Method.DeepCopy()
+		out.Method = &v
+	}
+	if r.PreferredReportName != nil {
+		v := *r.PreferredReportName
+		out.PreferredReportName = &v
+	}
+	if r.PreferredReportNameElement != nil {
+		v := r.PreferredReportNameElement.DeepCopy()
+		out.PreferredReportNameElement = &v
+	}
+	if r.QuantitativeDetails != nil {
+		v := r.QuantitativeDetails.DeepCopy()
+		out.QuantitativeDetails = &v
+	}
+	if r.QualifiedInterval != nil {
+		out.QualifiedInterval = make([]ObservationDefinitionQualifiedInterval, len(r.QualifiedInterval))
+		for i := range r.QualifiedInterval {
+			out.QualifiedInterval[i] = r.QualifiedInterval[i].DeepCopy()
+		}
+	}
+	if r.ValidCodedValueSet != nil {
+		v := r.ValidCodedValueSet.DeepCopy()
+		out.ValidCodedValueSet = &v
+	}
+	if r.NormalCodedValueSet != nil {
+		v := r.NormalCodedValueSet.DeepCopy()
+		out.NormalCodedValueSet = &v
+	}
+	if r.AbnormalCodedValueSet != nil {
+		v := r.AbnormalCodedValueSet.DeepCopy()
+		out.AbnormalCodedValueSet = &v
+	}
+	if r.CriticalCodedValueSet != nil {
+		v := r.CriticalCodedValueSet.DeepCopy()
+		out.CriticalCodedValueSet = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ObservationDefinition) Equal(other ObservationDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.PermittedDataType) != len(other.PermittedDataType) {
+		return false
+	}
+	for i := range r.PermittedDataType {
+		if r.PermittedDataType[i] != other.PermittedDataType[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PermittedDataTypeElement, other.PermittedDataTypeElement, false) {
+		return false
+	}
+	if (r.MultipleResultsAllowed == nil) != (other.MultipleResultsAllowed == nil) || r.MultipleResultsAllowed != nil && *r.MultipleResultsAllowed != *other.MultipleResultsAllowed {
+		return false
+	}
+	if (r.MultipleResultsAllowedElement == nil) != (other.MultipleResultsAllowedElement == nil) || r.MultipleResultsAllowedElement != nil && !r.MultipleResultsAllowedElement.Equal(*other.MultipleResultsAllowedElement) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.Equal(*other.Method) {
+		return false
+	}
+	if (r.PreferredReportName == nil) != (other.PreferredReportName == nil) || r.PreferredReportName != nil && *r.PreferredReportName != *other.PreferredReportName {
+		return false
+	}
+	if (r.PreferredReportNameElement == nil) != (other.PreferredReportNameElement == nil) || r.PreferredReportNameElement != nil && !r.PreferredReportNameElement.Equal(*other.PreferredReportNameElement) {
+		return false
+	}
+	if (r.QuantitativeDetails == nil) != (other.QuantitativeDetails == nil) || r.QuantitativeDetails != nil && !r.QuantitativeDetails.Equal(*other.QuantitativeDetails) {
+		return false
+	}
+	if len(r.QualifiedInterval) != len(other.QualifiedInterval) {
+		return false
+	}
+	for i := range r.QualifiedInterval {
+		if !r.QualifiedInterval[i].Equal(other.QualifiedInterval[i]) {
+			return false
+		}
+	}
+	if (r.ValidCodedValueSet == nil) != (other.ValidCodedValueSet == nil) || r.ValidCodedValueSet != nil && !r.ValidCodedValueSet.Equal(*other.ValidCodedValueSet) {
+		return false
+	}
+	if (r.NormalCodedValueSet == nil) != (other.NormalCodedValueSet == nil) || r.NormalCodedValueSet != nil && !r.NormalCodedValueSet.Equal(*other.NormalCodedValueSet) {
+		return false
+	}
+	if (r.AbnormalCodedValueSet == nil) != (other.AbnormalCodedValueSet == nil) || r.AbnormalCodedValueSet != nil && !r.AbnormalCodedValueSet.Equal(*other.AbnormalCodedValueSet) {
+		return false
+	}
+	if (r.CriticalCodedValueSet == nil) != (other.CriticalCodedValueSet == nil) || r.CriticalCodedValueSet != nil && !r.CriticalCodedValueSet.Equal(*other.CriticalCodedValueSet) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ObservationDefinition) EqualsDeep(other ObservationDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PermittedDataType), len(other.PermittedDataType), func(i, j int) bool {
+		return r.PermittedDataType[i] == other.PermittedDataType[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.PermittedDataTypeElement, other.PermittedDataTypeElement, true) {
+		return false
+	}
+	if (r.MultipleResultsAllowed == nil) != (other.MultipleResultsAllowed == nil) || r.MultipleResultsAllowed != nil && *r.MultipleResultsAllowed != *other.MultipleResultsAllowed {
+		return false
+	}
+	if (r.MultipleResultsAllowedElement == nil) != (other.MultipleResultsAllowedElement == nil) || r.MultipleResultsAllowedElement != nil && !r.MultipleResultsAllowedElement.EqualsDeep(*other.MultipleResultsAllowedElement) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.EqualsDeep(*other.Method) {
+		return false
+	}
+	if (r.PreferredReportName == nil) != (other.PreferredReportName == nil) || r.PreferredReportName != nil && !equivalentString(*r.PreferredReportName, *other.PreferredReportName) {
+		return false
+	}
+	if (r.PreferredReportNameElement == nil) != (other.PreferredReportNameElement == nil) || r.PreferredReportNameElement != nil && !r.PreferredReportNameElement.EqualsDeep(*other.PreferredReportNameElement) {
+		return false
+	}
+	if (r.QuantitativeDetails == nil) != (other.QuantitativeDetails == nil) || r.QuantitativeDetails != nil && !r.QuantitativeDetails.EqualsDeep(*other.QuantitativeDetails) {
+		return false
+	}
+	if !equivalentList(len(r.QualifiedInterval), len(other.QualifiedInterval), func(i, j int) bool {
+		return r.QualifiedInterval[i].EqualsDeep(other.QualifiedInterval[j])
+	}) {
+		return false
+	}
+	if (r.ValidCodedValueSet == nil) != (other.ValidCodedValueSet == nil) || r.ValidCodedValueSet != nil && !r.ValidCodedValueSet.EqualsDeep(*other.ValidCodedValueSet) {
+		return false
+	}
+	if (r.NormalCodedValueSet == nil) != (other.NormalCodedValueSet == nil) || r.NormalCodedValueSet != nil && !r.NormalCodedValueSet.EqualsDeep(*other.NormalCodedValueSet) {
+		return false
+	}
+	if (r.AbnormalCodedValueSet == nil) != (other.AbnormalCodedValueSet == nil) || r.AbnormalCodedValueSet != nil && !r.AbnormalCodedValueSet.EqualsDeep(*other.AbnormalCodedValueSet) {
+		return false
+	}
+	if (r.CriticalCodedValueSet == nil) != (other.CriticalCodedValueSet == nil) || r.CriticalCodedValueSet != nil && !r.CriticalCodedValueSet.EqualsDeep(*other.CriticalCodedValueSet) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ObservationDefinition) EqualsShallow(other ObservationDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.PermittedDataType), len(other.PermittedDataType), func(i, j int) bool {
+		return r.PermittedDataType[i] == other.PermittedDataType[j]
+	}) {
+		return false
+	}
+	if (r.MultipleResultsAllowed == nil) != (other.MultipleResultsAllowed == nil) || r.MultipleResultsAllowed != nil && *r.MultipleResultsAllowed != *other.MultipleResultsAllowed {
+		return false
+	}
+	if (r.PreferredReportName == nil) != (other.PreferredReportName == nil) || r.PreferredReportName != nil && !equivalentString(*r.PreferredReportName, *other.PreferredReportName) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ObservationDefinition as FHIR XML
+func (r ObservationDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ObservationDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.PermittedDataType {
+		if err := encodeXMLPrimitive(e, "permittedDataType", v, elementAt(r.PermittedDataTypeElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "multipleResultsAllowed", r.MultipleResultsAllowed, r.MultipleResultsAllowedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "method", r.Method); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "preferredReportName", r.PreferredReportName, r.PreferredReportNameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantitativeDetails", r.QuantitativeDetails); err != nil {
+		return err
+	}
+	for _, v := range r.QualifiedInterval {
+		if err := encodeXMLElement(e, "qualifiedInterval", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "validCodedValueSet", r.ValidCodedValueSet); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "normalCodedValueSet", r.NormalCodedValueSet); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "abnormalCodedValueSet", r.AbnormalCodedValueSet); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "criticalCodedValueSet", r.CriticalCodedValueSet); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ObservationDefinition from FHIR XML
+func (r *ObservationDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "permittedDataType":
+				var v ObservationDataType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PermittedDataTypeElement = appendElement(r.PermittedDataTypeElement, len(r.PermittedDataType), element)
+					r.PermittedDataType = append(r.PermittedDataType, v)
+				}
+			case "multipleResultsAllowed":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MultipleResultsAllowed = &v
+				}
+				r.MultipleResultsAllowedElement = element
+			case "method":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Method = &v
+			case "preferredReportName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PreferredReportName = &v
+				}
+				r.PreferredReportNameElement = element
+			case "quantitativeDetails":
+				var v ObservationDefinitionQuantitativeDetails
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.QuantitativeDetails = &v
+			case "qualifiedInterval":
+				var v ObservationDefinitionQualifiedInterval
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.QualifiedInterval = append(r.QualifiedInterval, v)
+			case "validCodedValueSet":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValidCodedValueSet = &v
+			case "normalCodedValueSet":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.NormalCodedValueSet = &v
+			case "abnormalCodedValueSet":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AbnormalCodedValueSet = &v
+			case "criticalCodedValueSet":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CriticalCodedValueSet = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ObservationDefinition as properties to the RDF node n
+func (r ObservationDefinition) turtle(n *turtleNode) {
+	n.resource("ObservationDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Category {
+		n.element("ObservationDefinition.category", i, v)
+	}
+	n.element("ObservationDefinition.code", -1, r.Code)
+	for i, v := range r.Identifier {
+		n.element("ObservationDefinition.identifier", i, v)
+	}
+	for i, v := range r.PermittedDataType {
+		n.primitive("ObservationDefinition.permittedDataType", i, v, elementAt(r.PermittedDataTypeElement, i), "code")
+	}
+	n.primitive("ObservationDefinition.multipleResultsAllowed", -1, r.MultipleResultsAllowed, r.MultipleResultsAllowedElement, "boolean")
+	n.element("ObservationDefinition.method", -1, r.Method)
+	n.primitive("ObservationDefinition.preferredReportName", -1, r.PreferredReportName, r.PreferredReportNameElement, "string")
+	n.element("ObservationDefinition.quantitativeDetails", -1, r.QuantitativeDetails)
+	for i, v := range r.QualifiedInterval {
+		n.element("ObservationDefinition.qualifiedInterval", i, v)
+	}
+	n.element("ObservationDefinition.validCodedValueSet", -1, r.ValidCodedValueSet)
+	n.element("ObservationDefinition.normalCodedValueSet", -1, r.NormalCodedValueSet)
+	n.element("ObservationDefinition.abnormalCodedValueSet", -1, r.AbnormalCodedValueSet)
+	n.element("ObservationDefinition.criticalCodedValueSet", -1, r.CriticalCodedValueSet)
+}
+
+// MarshalBSON marshals the given ObservationDefinition as BSON document with the structure of its FHIR JSON
+func (r ObservationDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ObservationDefinition from a BSON document with the structure of its FHIR JSON
+func (r *ObservationDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ObservationDefinition as protobuf message ObservationDefinition of fhir.proto
+func (r ObservationDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ObservationDefinition to the encoded message
+func (r ObservationDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Category {
+		e.message(9, v)
+	}
+	e.message(10, r.Code)
+	for _, v := range r.Identifier {
+		e.message(11, v)
+	}
+	if len(r.PermittedDataType) > 0 {
+		var p protoEncoder
+		for _, v := range r.PermittedDataType {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(12, p.buf)
+	}
+	if r.MultipleResultsAllowed != nil {
+		e.varint(13, protoBool(*r.MultipleResultsAllowed))
+	}
+	if r.Method != nil {
+		e.message(14, *r.Method)
+	}
+	if r.PreferredReportName != nil {
+		e.string(15, *r.PreferredReportName)
+	}
+	if r.QuantitativeDetails != nil {
+		e.message(16, *r.QuantitativeDetails)
+	}
+	for _, v := range r.QualifiedInterval {
+		e.message(17, v)
+	}
+	if r.ValidCodedValueSet != nil {
+		e.message(18, *r.ValidCodedValueSet)
+	}
+	if r.NormalCodedValueSet != nil {
+		e.message(19, *r.NormalCodedValueSet)
+	}
+	if r.AbnormalCodedValueSet != nil {
+		e.message(20, *r.AbnormalCodedValueSet)
+	}
+	if r.CriticalCodedValueSet != nil {
+		e.message(21, *r.CriticalCodedValueSet)
+	}
+	if r.IdElement != nil {
+		e.message(22, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(23, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(24, *r.LanguageElement)
+	}
+	for _, v := range r.PermittedDataTypeElement {
+		e.element(25, v)
+	}
+	if r.MultipleResultsAllowedElement != nil {
+		e.message(26, *r.MultipleResultsAllowedElement)
+	}
+	if r.PreferredReportNameElement != nil {
+		e.message(27, *r.PreferredReportNameElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ObservationDefinition from protobuf message ObservationDefinition of fhir.proto
+func (r *ObservationDefinition) UnmarshalProto(b []byte) error {
+	*r = ObservationDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 11:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 12:
+			for _, n := range d.varints() {
+				var v ObservationDataType
+				d.enum(n, v.setProtoNumber)
+				r.PermittedDataType = append(r.PermittedDataType, v)
+			}
+		case 13:
+			v := d.bool()
+			r.MultipleResultsAllowed = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Method = &v
+		case 15:
+			v := d.string()
+			r.PreferredReportName = &v
+		case 16:
+			var v ObservationDefinitionQuantitativeDetails
+			d.message(&v)
+			r.QuantitativeDetails = &v
+		case 17:
+			var v ObservationDefinitionQualifiedInterval
+			d.message(&v)
+			r.QualifiedInterval = append(r.QualifiedInterval, v)
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.ValidCodedValueSet = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.NormalCodedValueSet = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.AbnormalCodedValueSet = &v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.CriticalCodedValueSet = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 25:
+			r.PermittedDataTypeElement = append(r.PermittedDataTypeElement, d.element())
+		case 26:
+			var v Element
+			d.message(&v)
+			r.MultipleResultsAllowedElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.PreferredReportNameElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ObservationDefinitionQuantitativeDetails as JSON into a byte slice
+func (r ObservationDefinitionQuantitativeDetails) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ObservationDefinitionQuantitativeDetails as JSON object with the members in the order of the fields
+func (r ObservationDefinitionQuantitativeDetails) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.CustomaryUnit != nil {
+		e.key("customaryUnit")
+		r.CustomaryUnit.appendJSON(e)
+	}
+	if r.Unit != nil {
+		e.key("unit")
+		r.Unit.appendJSON(e)
+	}
+	if r.ConversionFactor != nil {
+		e.key("conversionFactor")
+		e.number(*r.ConversionFactor)
+	}
+	if r.ConversionFactorElement != nil {
+		e.key("_conversionFactor")
+		r.ConversionFactorElement.appendJSON(e)
+	}
+	if r.DecimalPrecision != nil {
+		e.key("decimalPrecision")
+		e.int(*r.DecimalPrecision)
+	}
+	if r.DecimalPrecisionElement != nil {
+		e.key("_decimalPrecision")
+		r.DecimalPrecisionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ObservationDefinitionQuantitativeDetails from JSON. Unknown members are ignored.
+func (r *ObservationDefinitionQuantitativeDetails) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ObservationDefinitionQuantitativeDetails
+func (r *ObservationDefinitionQuantitativeDetails) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "customaryUnit":
+			if d.null() {
+				r.CustomaryUnit = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CustomaryUnit = &v
+			}
+		case "unit":
+			if d.null() {
+				r.Unit = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Unit = &v
+			}
+		case "conversionFactor":
+			if v, ok := d.number(); ok {
+				r.ConversionFactor = &v
+			} else {
+				r.ConversionFactor = nil
+			}
+		case "_conversionFactor":
+			r.ConversionFactorElement = d.element()
+		case "decimalPrecision":
+			if v, ok := d.int(); ok {
+				r.DecimalPrecision = &v
+			} else {
+				r.DecimalPrecision = nil
+			}
+		case "_decimalPrecision":
+			r.DecimalPrecisionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ObservationDefinitionQuantitativeDetails which shares no memory with the original
+func (r ObservationDefinitionQuantitativeDetails) DeepCopy() ObservationDefinitionQuantitativeDetails {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CustomaryUnit != nil {
+		v := r.CustomaryUnit.DeepCopy()
+		out.CustomaryUnit = &v
+	}
+	if r.Unit != nil {
+		v := r.Unit.DeepCopy()
+		out.Unit = &v
+	}
+	if r.ConversionFactor != nil {
+		v := *r.ConversionFactor
+		out.ConversionFactor = &v
+	}
+	if r.ConversionFactorElement != nil {
+		v := r.ConversionFactorElement.DeepCopy()
+		out.ConversionFactorElement = &v
+	}
+	if r.DecimalPrecision != nil {
+		v := *r.DecimalPrecision
+		out.DecimalPrecision = &v
+	}
+	if r.DecimalPrecisionElement != nil {
+		v := r.DecimalPrecisionElement.DeepCopy()
+		out.DecimalPrecisionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ObservationDefinitionQuantitativeDetails) Equal(other ObservationDefinitionQuantitativeDetails) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.CustomaryUnit == nil) != (other.CustomaryUnit == nil) || r.CustomaryUnit != nil && !r.CustomaryUnit.Equal(*other.CustomaryUnit) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !r.Unit.Equal(*other.Unit) {
+		return false
+	}
+	if (r.ConversionFactor == nil) != (other.ConversionFactor == nil) || r.ConversionFactor != nil && !EqualDecimal(*r.ConversionFactor, *other.ConversionFactor) {
+		return false
+	}
+	if (r.ConversionFactorElement == nil) != (other.ConversionFactorElement == nil) || r.ConversionFactorElement != nil && !r.ConversionFactorElement.Equal(*other.ConversionFactorElement) {
+		return false
+	}
+	if (r.DecimalPrecision == nil) != (other.DecimalPrecision == nil) || r.DecimalPrecision != nil && *r.DecimalPrecision != *other.DecimalPrecision {
+		return false
+	}
+	if (r.DecimalPrecisionElement == nil) != (other.DecimalPrecisionElement == nil) || r.DecimalPrecisionElement != nil && !r.DecimalPrecisionElement.Equal(*other.DecimalPrecisionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ObservationDefinitionQuantitativeDetails) EqualsDeep(other ObservationDefinitionQuantitativeDetails) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.CustomaryUnit == nil) != (other.CustomaryUnit == nil) || r.CustomaryUnit != nil && !r.CustomaryUnit.EqualsDeep(*other.CustomaryUnit) {
+		return false
+	}
+	if (r.Unit == nil) != (other.Unit == nil) || r.Unit != nil && !r.Unit.EqualsDeep(*other.Unit) {
+		return false
+	}
+	if (r.ConversionFactor == nil) != (other.ConversionFactor == nil) || r.ConversionFactor != nil && !equivalentDecimal(*r.ConversionFactor, *other.ConversionFactor) {
+		return false
+	}
+	if (r.ConversionFactorElement == nil) != (other.ConversionFactorElement == nil) || r.ConversionFactorElement != nil && !r.ConversionFactorElement.EqualsDeep(*other.ConversionFactorElement) {
+		return false
+	}
+	if (r.DecimalPrecision == nil) != (other.DecimalPrecision == nil) || r.DecimalPrecision != nil && *r.DecimalPrecision != *other.DecimalPrecision {
+		return false
+	}
+	if (r.DecimalPrecisionElement == nil) != (other.DecimalPrecisionElement == nil) || r.DecimalPrecisionElement != nil && !r.DecimalPrecisionElement.EqualsDeep(*other.DecimalPrecisionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ObservationDefinitionQuantitativeDetails) EqualsShallow(other ObservationDefinitionQuantitativeDetails) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ConversionFactor == nil) != (other.ConversionFactor == nil) || r.ConversionFactor != nil && !equivalentDecimal(*r.ConversionFactor, *other.ConversionFactor) {
+		return false
+	}
+	if (r.DecimalPrecision == nil) != (other.DecimalPrecision == nil) || r.DecimalPrecision != nil && *r.DecimalPrecision != *other.DecimalPrecision {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ObservationDefinitionQuantitativeDetails as FHIR XML
+func (r ObservationDefinitionQuantitativeDetails) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "customaryUnit", r.CustomaryUnit); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unit", r.Unit); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "conversionFactor", r.ConversionFactor, r.ConversionFactorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "decimalPrecision", r.DecimalPrecision, r.DecimalPrecisionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ObservationDefinitionQuantitativeDetails from FHIR XML
+func (r *ObservationDefinitionQuantitativeDetails) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "customaryUnit":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CustomaryUnit = &v
+			case "unit":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Unit = &v
+			case "conversionFactor":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ConversionFactor = &v
+				}
+				r.ConversionFactorElement = element
+			case "decimalPrecision":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DecimalPrecision = &v
+				}
+				r.DecimalPrecisionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ObservationDefinitionQuantitativeDetails as properties to the RDF node n
+func (r ObservationDefinitionQuantitativeDetails) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ObservationDefinition.quantitativeDetails.customaryUnit", -1, r.CustomaryUnit)
+	n.element("ObservationDefinition.quantitativeDetails.unit", -1, r.Unit)
+	n.primitive("ObservationDefinition.quantitativeDetails.conversionFactor", -1, r.ConversionFactor, r.ConversionFactorElement, "decimal")
+	n.primitive("ObservationDefinition.quantitativeDetails.decimalPrecision", -1, r.DecimalPrecision, r.DecimalPrecisionElement, "integer")
+}
+
+// MarshalBSON marshals the given ObservationDefinitionQuantitativeDetails as BSON document with the structure of its FHIR JSON
+func (r ObservationDefinitionQuantitativeDetails) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ObservationDefinitionQuantitativeDetails from a BSON document with the structure of its FHIR JSON
+func (r *ObservationDefinitionQuantitativeDetails) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ObservationDefinitionQuantitativeDetails as protobuf message ObservationDefinitionQuantitativeDetails of fhir.proto
+func (r ObservationDefinitionQuantitativeDetails) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ObservationDefinitionQuantitativeDetails to the encoded message
+func (r ObservationDefinitionQuantitativeDetails) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.CustomaryUnit != nil {
+		e.message(4, *r.CustomaryUnit)
+	}
+	if r.Unit != nil {
+		e.message(5, *r.Unit)
+	}
+	if r.ConversionFactor != nil {
+		e.string(6, string(*r.ConversionFactor))
+	}
+	if r.DecimalPrecision != nil {
+		e.varint(7, uint64(int64(*r.DecimalPrecision)))
+	}
+	if r.ConversionFactorElement != nil {
+		e.message(8, *r.ConversionFactorElement)
+	}
+	if r.DecimalPrecisionElement != nil {
+		e.message(9, *r.DecimalPrecisionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ObservationDefinitionQuantitativeDetails from protobuf message ObservationDefinitionQuantitativeDetails of fhir.proto
+func (r *ObservationDefinitionQuantitativeDetails) UnmarshalProto(b []byte) error {
+	*r = ObservationDefinitionQuantitativeDetails{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.CustomaryUnit = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Unit = &v
+		case 6:
+			v := json.Number(d.string())
+			r.ConversionFactor = &v
+		case 7:
+			v := d.int()
+			r.DecimalPrecision = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ConversionFactorElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DecimalPrecisionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ObservationDefinitionQualifiedInterval as JSON into a byte slice
+func (r ObservationDefinitionQualifiedInterval) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ObservationDefinitionQualifiedInterval as JSON object with the members in the order of the fields
+func (r ObservationDefinitionQualifiedInterval) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Category != nil {
+		e.key("category")
+		e.string(r.Category.Code())
+	}
+	if r.CategoryElement != nil {
+		e.key("_category")
+		r.CategoryElement.appendJSON(e)
+	}
+	if r.Range != nil {
+		e.key("range")
+		r.Range.appendJSON(e)
+	}
+	if r.Context != nil {
+		e.key("context")
+		r.Context.appendJSON(e)
+	}
+	if len(r.AppliesTo) > 0 {
+		e.key("appliesTo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AppliesTo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Gender != nil {
+		e.key("gender")
+		e.string(r.Gender.Code())
+	}
+	if r.GenderElement != nil {
+		e.key("_gender")
+		r.GenderElement.appendJSON(e)
+	}
+	if r.Age != nil {
+		e.key("age")
+		r.Age.appendJSON(e)
+	}
+	if r.GestationalAge != nil {
+		e.key("gestationalAge")
+		r.GestationalAge.appendJSON(e)
+	}
+	if r.Condition != nil {
+		e.key("condition")
+		e.string(*r.Condition)
+	}
+	if r.ConditionElement != nil {
+		e.key("_condition")
+		r.ConditionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ObservationDefinitionQualifiedInterval from JSON. Unknown members are ignored.
+func (r *ObservationDefinitionQualifiedInterval) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ObservationDefinitionQualifiedInterval
+func (r *ObservationDefinitionQualifiedInterval) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v ObservationRangeCategory
+				d.unmarshal(&v)
+				r.Category = &v
+			}
+		case "_category":
+			r.CategoryElement = d.element()
+		case "range":
+			if d.null() {
+				r.Range = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.Range = &v
+			}
+		case "context":
+			if d.null() {
+				r.Context = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Context = &v
+			}
+		case "appliesTo":
+			a := d.array()
+			r.AppliesTo = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AppliesTo = append(r.AppliesTo, v)
+			}
+			if r.AppliesTo == nil && !a.isNull {
+				r.AppliesTo = []CodeableConcept{}
+			}
+		case "gender":
+			if d.null() {
+				r.Gender = nil
+			} else {
+				var v AdministrativeGender
+				d.unmarshal(&v)
+				r.Gender = &v
+			}
+		case "_gender":
+			r.GenderElement = d.element()
+		case "age":
+			if d.null() {
+				r.Age = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.Age = &v
+			}
+		case "gestationalAge":
+			if d.null() {
+				r.GestationalAge = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.GestationalAge = &v
+			}
+		case "condition":
+			if v, ok := d.string(); ok {
+				r.Condition = &v
+			} else {
+				r.Condition = nil
+			}
+		case "_condition":
+			r.ConditionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ObservationDefinitionQualifiedInterval which shares no memory with the original
+func (r ObservationDefinitionQualifiedInterval) DeepCopy() ObservationDefinitionQualifiedInterval {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Category != nil {
+		v := *r.Category
+		out.Category = &v
+	}
+	if r.CategoryElement != nil {
+		v := r.CategoryElement.DeepCopy()
+		out.CategoryElement = &v
+	}
+	if r.Range != nil {
+		v := r.Range.DeepCopy()
+		out.Range = &v
+	}
+	if r.Context != nil {
+		v := r.Context.DeepCopy()
+		out.Context = &v
+	}
+	if r.AppliesTo != nil {
+		out.AppliesTo = make([]CodeableConcept, len(r.AppliesTo))
+		for i := range r.AppliesTo {
+			out.AppliesTo[i] = r.AppliesTo[i].DeepCopy()
+		}
+	}
+	if r.Gender != nil {
+		v := *r.Gender
+		out.Gender = &v
+	}
+	if r.GenderElement != nil {
+		v := r.GenderElement.DeepCopy()
+		out.GenderElement = &v
+	}
+	if r.Age != nil {
+		v := r.Age.DeepCopy()
+		out.Age = &v
+	}
+	if r.GestationalAge != nil {
+		v := r.GestationalAge.DeepCopy()
+		out.GestationalAge = &v
+	}
+	if r.Condition != nil {
+		v := *r.Condition
+		out.Condition = &v
+	}
+	if r.ConditionElement != nil {
+		v := r.ConditionElement.DeepCopy()
+		out.ConditionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ObservationDefinitionQualifiedInterval) Equal(other ObservationDefinitionQualifiedInterval) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && *r.Category != *other.Category {
+		return false
+	}
+	if (r.CategoryElement == nil) != (other.CategoryElement == nil) || r.CategoryElement != nil && !r.CategoryElement.Equal(*other.CategoryElement) {
+		return false
+	}
+	if (r.Range == nil) != (other.Range == nil) || r.Range != nil && !r.Range.Equal(*other.Range) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.Equal(*other.Context) {
+		return false
+	}
+	if len(r.AppliesTo) != len(other.AppliesTo) {
+		return false
+	}
+	for i := range r.AppliesTo {
+		if !r.AppliesTo[i].Equal(other.AppliesTo[i]) {
+			return false
+		}
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.Equal(*other.GenderElement) {
+		return false
+	}
+	if (r.Age == nil) != (other.Age == nil) || r.Age != nil && !r.Age.Equal(*other.Age) {
+		return false
+	}
+	if (r.GestationalAge == nil) != (other.GestationalAge == nil) || r.GestationalAge != nil && !r.GestationalAge.Equal(*other.GestationalAge) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && *r.Condition != *other.Condition {
+		return false
+	}
+	if (r.ConditionElement == nil) != (other.ConditionElement == nil) || r.ConditionElement != nil && !r.ConditionElement.Equal(*other.ConditionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ObservationDefinitionQualifiedInterval) EqualsDeep(other ObservationDefinitionQualifiedInterval) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && *r.Category != *other.Category {
+		return false
+	}
+	if (r.CategoryElement == nil) != (other.CategoryElement == nil) || r.CategoryElement != nil && !r.CategoryElement.EqualsDeep(*other.CategoryElement) {
+		return false
+	}
+	if (r.Range == nil) != (other.Range == nil) || r.Range != nil && !r.Range.EqualsDeep(*other.Range) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !r.Context.EqualsDeep(*other.Context) {
+		return false
+	}
+	if !equivalentList(len(r.AppliesTo), len(other.AppliesTo), func(i, j int) bool {
+		return r.AppliesTo[i].EqualsDeep(other.AppliesTo[j])
+	}) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.EqualsDeep(*other.GenderElement) {
+		return false
+	}
+	if (r.Age == nil) != (other.Age == nil) || r.Age != nil && !r.Age.EqualsDeep(*other.Age) {
+		return false
+	}
+	if (r.GestationalAge == nil) != (other.GestationalAge == nil) || r.GestationalAge != nil && !r.GestationalAge.EqualsDeep(*other.GestationalAge) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !equivalentString(*r.Condition, *other.Condition) {
+		return false
+	}
+	if (r.ConditionElement == nil) != (other.ConditionElement == nil) || r.ConditionElement != nil && !r.ConditionElement.EqualsDeep(*other.ConditionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ObservationDefinitionQualifiedInterval) EqualsShallow(other ObservationDefinitionQualifiedInterval) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && *r.Category != *other.Category {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !equivalentString(*r.Condition, *other.Condition) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ObservationDefinitionQualifiedInterval as FHIR XML
+func (r ObservationDefinitionQualifiedInterval) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "category", r.Category, r.CategoryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "range", r.Range); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "context", r.Context); err != nil {
+		return err
+	}
+	for _, v := range r.AppliesTo {
+		if err := encodeXMLElement(e, "appliesTo", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "gender", r.Gender, r.GenderElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "age", r.Age); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "gestationalAge", r.GestationalAge); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "condition", r.Condition, r.ConditionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ObservationDefinitionQualifiedInterval from FHIR XML
+func (r *ObservationDefinitionQualifiedInterval) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v ObservationRangeCategory
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Category = &v
+				}
+				r.CategoryElement = element
+			case "range":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Range = &v
+			case "context":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Context = &v
+			case "appliesTo":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AppliesTo = append(r.AppliesTo, v)
+			case "gender":
+				var v AdministrativeGender
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Gender = &v
+				}
+				r.GenderElement = element
+			case "age":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Age = &v
+			case "gestationalAge":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GestationalAge = &v
+			case "condition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Condition = &v
+				}
+				r.ConditionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ObservationDefinitionQualifiedInterval as properties to the RDF node n
+func (r ObservationDefinitionQualifiedInterval) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ObservationDefinition.qualifiedInterval.category", -1, r.Category, r.CategoryElement, "code")
+	n.element("ObservationDefinition.qualifiedInterval.range", -1, r.Range)
+	n.element("ObservationDefinition.qualifiedInterval.context", -1, r.Context)
+	for i, v := range r.AppliesTo {
+		n.element("ObservationDefinition.qualifiedInterval.appliesTo", i, v)
+	}
+	n.primitive("ObservationDefinition.qualifiedInterval.gender", -1, r.Gender, r.GenderElement, "code")
+	n.element("ObservationDefinition.qualifiedInterval.age", -1, r.Age)
+	n.element("ObservationDefinition.qualifiedInterval.gestationalAge", -1, r.GestationalAge)
+	n.primitive("ObservationDefinition.qualifiedInterval.condition", -1, r.Condition, r.ConditionElement, "string")
+}
+
+// MarshalBSON marshals the given ObservationDefinitionQualifiedInterval as BSON document with the structure of its FHIR JSON
+func (r ObservationDefinitionQualifiedInterval) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ObservationDefinitionQualifiedInterval from a BSON document with the structure of its FHIR JSON
+func (r *ObservationDefinitionQualifiedInterval) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ObservationDefinitionQualifiedInterval as protobuf message ObservationDefinitionQualifiedInterval of fhir.proto
+func (r ObservationDefinitionQualifiedInterval) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ObservationDefinitionQualifiedInterval to the encoded message
+func (r ObservationDefinitionQualifiedInterval) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Category != nil {
+		e.varint(4, r.Category.protoNumber())
+	}
+	if r.Range != nil {
+		e.message(5, *r.Range)
+	}
+	if r.Context != nil {
+		e.message(6, *r.Context)
+	}
+	for _, v := range r.AppliesTo {
+		e.message(7, v)
+	}
+	if r.Gender != nil {
+		e.varint(8, r.Gender.protoNumber())
+	}
+	if r.Age != nil {
+		e.message(9, *r.Age)
+	}
+	if r.GestationalAge != nil {
+		e.message(10, *r.GestationalAge)
+	}
+	if r.Condition != nil {
+		e.string(11, *r.Condition)
+	}
+	if r.CategoryElement != nil {
+		e.message(12, *r.CategoryElement)
+	}
+	if r.GenderElement != nil {
+		e.message(13, *r.GenderElement)
+	}
+	if r.ConditionElement != nil {
+		e.message(14, *r.ConditionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ObservationDefinitionQualifiedInterval from protobuf message ObservationDefinitionQualifiedInterval of fhir.proto
+func (r *ObservationDefinitionQualifiedInterval) UnmarshalProto(b []byte) error {
+	*r = ObservationDefinitionQualifiedInterval{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ObservationRangeCategory
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Category = &v
+		case 5:
+			var v Range
+			d.message(&v)
+			r.Range = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Context = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.AppliesTo = append(r.AppliesTo, v)
+		case 8:
+			var v AdministrativeGender
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Gender = &v
+		case 9:
+			var v Range
+			d.message(&v)
+			r.Age = &v
+		case 10:
+			var v Range
+			d.message(&v)
+			r.GestationalAge = &v
+		case 11:
+			v := d.string()
+			r.Condition = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.CategoryElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.GenderElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.ConditionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalObservationDefinition unmarshals a ObservationDefinition.
 func UnmarshalObservationDefinition(b []byte) (ObservationDefinition, error) {
 	var observationDefinition ObservationDefinition
-	if err := json.Unmarshal(b, &observationDefinition); err != nil {
+	if err := observationDefinition.UnmarshalJSON(b); err != nil {
 		return observationDefinition, err
 	}
 	return observationDefinition, nil
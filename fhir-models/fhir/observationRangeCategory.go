@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ObservationRangeCategory) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ObservationRangeCategory) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ObservationRangeCategory) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "reference":
 		*code = ObservationRangeCategoryReference
@@ -49,6 +53,12 @@ func (code *ObservationRangeCategory) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ObservationRangeCategory) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ObservationRangeCategory) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ObservationRangeCategory) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ObservationRangeCategory) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ObservationRangeCategory) protoNumber() uint64 {
+	switch code {
+	case ObservationRangeCategoryReference:
+		return 1
+	case ObservationRangeCategoryCritical:
+		return 2
+	case ObservationRangeCategoryAbsolute:
+		return 3
+	}
+	return 0
+}
+func (code *ObservationRangeCategory) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ObservationRangeCategoryReference
+	case 2:
+		*code = ObservationRangeCategoryCritical
+	case 3:
+		*code = ObservationRangeCategoryAbsolute
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -40,8 +39,13 @@ const (
 func (code ObservationStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ObservationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ObservationStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "registered":
 		*code = ObservationStatusRegistered
@@ -64,6 +68,12 @@ func (code *ObservationStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ObservationStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ObservationStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ObservationStatus) String() string {
 	return code.Code()
 }
@@ -130,3 +140,47 @@ func (code ObservationStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ObservationStatus) protoNumber() uint64 {
+	switch code {
+	case ObservationStatusRegistered:
+		return 1
+	case ObservationStatusPreliminary:
+		return 2
+	case ObservationStatusFinal:
+		return 3
+	case ObservationStatusAmended:
+		return 4
+	case ObservationStatusCorrected:
+		return 5
+	case ObservationStatusCancelled:
+		return 6
+	case ObservationStatusEnteredInError:
+		return 7
+	case ObservationStatusUnknown:
+		return 8
+	}
+	return 0
+}
+func (code *ObservationStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ObservationStatusRegistered
+	case 2:
+		*code = ObservationStatusPreliminary
+	case 3:
+		*code = ObservationStatusFinal
+	case 4:
+		*code = ObservationStatusAmended
+	case 5:
+		*code = ObservationStatusCorrected
+	case 6:
+		*code = ObservationStatusCancelled
+	case 7:
+		*code = ObservationStatusEnteredInError
+	case 8:
+		*code = ObservationStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestObservationSetValue(t *testing.T) {
+	var o Observation
+	o.SetValue(String("foo"))
+	if v, code := o.Value(); code != "string" || v != String("foo") {
+		t.Fatalf("Value = %v, %q", v, code)
+	}
+
+	unit := "mg"
+	o.SetValue(&Quantity{Value: numberPtr("1.50"), Unit: &unit})
+	if o.ValueString != nil {
+		t.Error("SetValue with pointer kept valueString")
+	}
+	if v, code := o.Value(); code != "Quantity" || *v.(Quantity).Value != "1.50" {
+		t.Errorf("Value = %v, %q", v, code)
+	}
+
+	s := String("bar")
+	o.SetValue(&s)
+	if o.ValueQuantity != nil || o.ValueString == nil || *o.ValueString != "bar" {
+		t.Errorf("SetValue with primitive pointer = %v, %v", o.ValueQuantity, o.ValueString)
+	}
+
+	o.SetValue((*Quantity)(nil))
+	if v, code := o.Value(); v != nil || code != "" {
+		t.Errorf("SetValue with nil pointer left %v, %q", v, code)
+	}
+}
+
+func numberPtr(s string) *json.Number {
+	n := json.Number(s)
+	return &n
+}
@@ -14,101 +14,4582 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // OperationDefinition is documented here http://hl7.org/fhir/StructureDefinition/OperationDefinition
 type OperationDefinition struct {
-	Id                *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string                        `bson:"url,omitempty" json:"url,omitempty"`
-	Version           *string                        `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                         `bson:"name" json:"name"`
-	Title             *string                        `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus              `bson:"status" json:"status"`
-	Kind              OperationKind                  `bson:"kind" json:"kind"`
-	Experimental      *bool                          `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                        `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                        `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                 `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept              `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                        `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	AffectsState      *bool                          `bson:"affectsState,omitempty" json:"affectsState,omitempty"`
-	Code              string                         `bson:"code" json:"code"`
-	Comment           *string                        `bson:"comment,omitempty" json:"comment,omitempty"`
-	Base              *string                        `bson:"base,omitempty" json:"base,omitempty"`
-	Resource          []ResourceType                 `bson:"resource,omitempty" json:"resource,omitempty"`
-	System            bool                           `bson:"system" json:"system"`
-	Type              bool                           `bson:"type" json:"type"`
-	Instance          bool                           `bson:"instance" json:"instance"`
-	InputProfile      *string                        `bson:"inputProfile,omitempty" json:"inputProfile,omitempty"`
-	OutputProfile     *string                        `bson:"outputProfile,omitempty" json:"outputProfile,omitempty"`
-	Parameter         []OperationDefinitionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
-	Overload          []OperationDefinitionOverload  `bson:"overload,omitempty" json:"overload,omitempty"`
+	Id                   *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                       `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  *string                        `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement           *Element                       `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                        `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                       `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                         `bson:"name" json:"name"`
+	NameElement          *Element                       `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                        `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                       `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus              `bson:"status" json:"status"`
+	StatusElement        *Element                       `bson:"_status,omitempty" json:"_status,omitempty"`
+	Kind                 OperationKind                  `bson:"kind" json:"kind"`
+	KindElement          *Element                       `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Experimental         *bool                          `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                       `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *string                        `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                       `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                       `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail                `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                        `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                       `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext                 `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept              `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                        `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                       `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	AffectsState         *bool                          `bson:"affectsState,omitempty" json:"affectsState,omitempty"`
+	AffectsStateElement  *Element                       `bson:"_affectsState,omitempty" json:"_affectsState,omitempty"`
+	Code                 string                         `bson:"code" json:"code"`
+	CodeElement          *Element                       `bson:"_code,omitempty" json:"_code,omitempty"`
+	Comment              *string                        `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element                       `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	Base                 *string                        `bson:"base,omitempty" json:"base,omitempty"`
+	BaseElement          *Element                       `bson:"_base,omitempty" json:"_base,omitempty"`
+	Resource             []ResourceType                 `bson:"resource,omitempty" json:"resource,omitempty"`
+	ResourceElement      []*Element                     `bson:"_resource,omitempty" json:"_resource,omitempty"`
+	System               bool                           `bson:"system" json:"system"`
+	SystemElement        *Element                       `bson:"_system,omitempty" json:"_system,omitempty"`
+	Type                 bool                           `bson:"type" json:"type"`
+	TypeElement          *Element                       `bson:"_type,omitempty" json:"_type,omitempty"`
+	Instance             bool                           `bson:"instance" json:"instance"`
+	InstanceElement      *Element                       `bson:"_instance,omitempty" json:"_instance,omitempty"`
+	InputProfile         *string                        `bson:"inputProfile,omitempty" json:"inputProfile,omitempty"`
+	InputProfileElement  *Element                       `bson:"_inputProfile,omitempty" json:"_inputProfile,omitempty"`
+	OutputProfile        *string                        `bson:"outputProfile,omitempty" json:"outputProfile,omitempty"`
+	OutputProfileElement *Element                       `bson:"_outputProfile,omitempty" json:"_outputProfile,omitempty"`
+	Parameter            []OperationDefinitionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	Overload             []OperationDefinitionOverload  `bson:"overload,omitempty" json:"overload,omitempty"`
 }
 type OperationDefinitionParameter struct {
-	Id                *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                                       `bson:"name" json:"name"`
-	Use               OperationParameterUse                        `bson:"use" json:"use"`
-	Min               int                                          `bson:"min" json:"min"`
-	Max               string                                       `bson:"max" json:"max"`
-	Documentation     *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Type              *string                                      `bson:"type,omitempty" json:"type,omitempty"`
-	TargetProfile     []string                                     `bson:"targetProfile,omitempty" json:"targetProfile,omitempty"`
-	SearchType        *SearchParamType                             `bson:"searchType,omitempty" json:"searchType,omitempty"`
-	Binding           *OperationDefinitionParameterBinding         `bson:"binding,omitempty" json:"binding,omitempty"`
-	ReferencedFrom    []OperationDefinitionParameterReferencedFrom `bson:"referencedFrom,omitempty" json:"referencedFrom,omitempty"`
-	Part              []OperationDefinitionParameter               `bson:"part,omitempty" json:"part,omitempty"`
+	Id                   *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                                       `bson:"name" json:"name"`
+	NameElement          *Element                                     `bson:"_name,omitempty" json:"_name,omitempty"`
+	Use                  OperationParameterUse                        `bson:"use" json:"use"`
+	UseElement           *Element                                     `bson:"_use,omitempty" json:"_use,omitempty"`
+	Min                  int                                          `bson:"min" json:"min"`
+	MinElement           *Element                                     `bson:"_min,omitempty" json:"_min,omitempty"`
+	Max                  string                                       `bson:"max" json:"max"`
+	MaxElement           *Element                                     `bson:"_max,omitempty" json:"_max,omitempty"`
+	Documentation        *string                                      `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                                     `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Type                 *string                                      `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement          *Element                                     `bson:"_type,omitempty" json:"_type,omitempty"`
+	TargetProfile        []string                                     `bson:"targetProfile,omitempty" json:"targetProfile,omitempty"`
+	TargetProfileElement []*Element                                   `bson:"_targetProfile,omitempty" json:"_targetProfile,omitempty"`
+	SearchType           *SearchParamType                             `bson:"searchType,omitempty" json:"searchType,omitempty"`
+	SearchTypeElement    *Element                                     `bson:"_searchType,omitempty" json:"_searchType,omitempty"`
+	Binding              *OperationDefinitionParameterBinding         `bson:"binding,omitempty" json:"binding,omitempty"`
+	ReferencedFrom       []OperationDefinitionParameterReferencedFrom `bson:"referencedFrom,omitempty" json:"referencedFrom,omitempty"`
+	Part                 []OperationDefinitionParameter               `bson:"part,omitempty" json:"part,omitempty"`
 }
 type OperationDefinitionParameterBinding struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Strength          BindingStrength `bson:"strength" json:"strength"`
+	StrengthElement   *Element        `bson:"_strength,omitempty" json:"_strength,omitempty"`
 	ValueSet          string          `bson:"valueSet" json:"valueSet"`
+	ValueSetElement   *Element        `bson:"_valueSet,omitempty" json:"_valueSet,omitempty"`
 }
 type OperationDefinitionParameterReferencedFrom struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Source            string      `bson:"source" json:"source"`
+	SourceElement     *Element    `bson:"_source,omitempty" json:"_source,omitempty"`
 	SourceId          *string     `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	SourceIdElement   *Element    `bson:"_sourceId,omitempty" json:"_sourceId,omitempty"`
 }
 type OperationDefinitionOverload struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ParameterName     []string    `bson:"parameterName,omitempty" json:"parameterName,omitempty"`
-	Comment           *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	Id                   *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ParameterName        []string    `bson:"parameterName,omitempty" json:"parameterName,omitempty"`
+	ParameterNameElement []*Element  `bson:"_parameterName,omitempty" json:"_parameterName,omitempty"`
+	Comment              *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element    `bson:"_comment,omitempty" json:"_comment,omitempty"`
 }
-type OtherOperationDefinition OperationDefinition
 
 // MarshalJSON marshals the given OperationDefinition as JSON into a byte slice
 func (r OperationDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherOperationDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherOperationDefinition: OtherOperationDefinition(r),
-		ResourceType:             "OperationDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the OperationDefinition as JSON object with the members in the order of the fields
+func (r OperationDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"OperationDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("kind")
+	e.string(r.Kind.Code())
+	if r.KindElement != nil {
+		e.key("_kind")
+		r.KindElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.AffectsState != nil {
+		e.key("affectsState")
+		e.bool(*r.AffectsState)
+	}
+	if r.AffectsStateElement != nil {
+		e.key("_affectsState")
+		r.AffectsStateElement.appendJSON(e)
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	if r.Base != nil {
+		e.key("base")
+		e.string(*r.Base)
+	}
+	if r.BaseElement != nil {
+		e.key("_base")
+		r.BaseElement.appendJSON(e)
+	}
+	if len(r.Resource) > 0 {
+		e.key("resource")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Resource {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ResourceElement) > 0 {
+		e.key("_resource")
+		e.elements(r.ResourceElement, len(r.Resource))
+	}
+	e.key("system")
+	e.bool(r.System)
+	if r.SystemElement != nil {
+		e.key("_system")
+		r.SystemElement.appendJSON(e)
+	}
+	e.key("type")
+	e.bool(r.Type)
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("instance")
+	e.bool(r.Instance)
+	if r.InstanceElement != nil {
+		e.key("_instance")
+		r.InstanceElement.appendJSON(e)
+	}
+	if r.InputProfile != nil {
+		e.key("inputProfile")
+		e.string(*r.InputProfile)
+	}
+	if r.InputProfileElement != nil {
+		e.key("_inputProfile")
+		r.InputProfileElement.appendJSON(e)
+	}
+	if r.OutputProfile != nil {
+		e.key("outputProfile")
+		e.string(*r.OutputProfile)
+	}
+	if r.OutputProfileElement != nil {
+		e.key("_outputProfile")
+		r.OutputProfileElement.appendJSON(e)
+	}
+	if len(r.Parameter) > 0 {
+		e.key("parameter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parameter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Overload) > 0 {
+		e.key("overload")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Overload {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given OperationDefinition from JSON. Unknown members are ignored.
+func (r *OperationDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the OperationDefinition
+func (r *OperationDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "kind":
+			d.unmarshal(&r.Kind)
+		case "_kind":
+			r.KindElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "affectsState":
+			if v, ok := d.bool(); ok {
+				r.AffectsState = &v
+			} else {
+				r.AffectsState = nil
+			}
+		case "_affectsState":
+			r.AffectsStateElement = d.element()
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		case "base":
+			if v, ok := d.string(); ok {
+				r.Base = &v
+			} else {
+				r.Base = nil
+			}
+		case "_base":
+			r.BaseElement = d.element()
+		case "resource":
+			a := d.array()
+			r.Resource = nil
+			for a.next() {
+				var v ResourceType
+				d.unmarshal(&v)
+				r.Resource = append(r.Resource, v)
+			}
+			if r.Resource == nil && !a.isNull {
+				r.Resource = []ResourceType{}
+			}
+		case "_resource":
+			a := d.array()
+			r.ResourceElement = nil
+			for a.next() {
+				r.ResourceElement = append(r.ResourceElement, d.element())
+			}
+		case "system":
+			if v, ok := d.bool(); ok {
+				r.System = v
+			}
+		case "_system":
+			r.SystemElement = d.element()
+		case "type":
+			if v, ok := d.bool(); ok {
+				r.Type = v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "instance":
+			if v, ok := d.bool(); ok {
+				r.Instance = v
+			}
+		case "_instance":
+			r.InstanceElement = d.element()
+		case "inputProfile":
+			if v, ok := d.string(); ok {
+				r.InputProfile = &v
+			} else {
+				r.InputProfile = nil
+			}
+		case "_inputProfile":
+			r.InputProfileElement = d.element()
+		case "outputProfile":
+			if v, ok := d.string(); ok {
+				r.OutputProfile = &v
+			} else {
+				r.OutputProfile = nil
+			}
+		case "_outputProfile":
+			r.OutputProfileElement = d.element()
+		case "parameter":
+			a := d.array()
+			r.Parameter = nil
+			for a.next() {
+				var v OperationDefinitionParameter
+				v.decodeJSON(d)
+				r.Parameter = append(r.Parameter, v)
+			}
+			if r.Parameter == nil && !a.isNull {
+				r.Parameter = []OperationDefinitionParameter{}
+			}
+		case "overload":
+			a := d.array()
+			r.Overload = nil
+			for a.next() {
+				var v OperationDefinitionOverload
+				v.decodeJSON(d)
+				r.Overload = append(r.Overload, v)
+			}
+			if r.Overload == nil && !a.isNull {
+				r.Overload = []OperationDefinitionOverload{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the OperationDefinition which shares no memory with the original
+func (r OperationDefinition) DeepCopy() OperationDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.KindElement != nil {
+		v := r.KindElement.DeepCopy()
+		out.KindElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.AffectsState != nil {
+		v := *r.AffectsState
+		out.AffectsState = &v
+	}
+	if r.AffectsStateElement != nil {
+		v := r.AffectsStateElement.DeepCopy()
+		out.AffectsStateElement = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	if r.Base != nil {
+		v := *r.Base
+		out.Base = &v
+	}
+	if r.BaseElement != nil {
+		v := r.BaseElement.DeepCopy()
+		out.BaseElement = &v
+	}
+	if r.Resource != nil {
+		out.Resource = make([]ResourceType, len(r.Resource))
+		copy(out.Resource, r.Resource)
+	}
+	if r.ResourceElement != nil {
+		out.ResourceElement = make([]*Element, len(r.ResourceElement))
+		for i, v := range r.ResourceElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ResourceElement[i] = &c
+			}
+		}
+	}
+	if r.SystemElement != nil {
+		v := r.SystemElement.DeepCopy()
+		out.SystemElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.InstanceElement != nil {
+		v := r.InstanceElement.DeepCopy()
+		out.InstanceElement = &v
+	}
+	if r.InputProfile != nil {
+		v := *r.InputProfile
+		out.InputProfile = &v
+	}
+	if r.InputProfileElement != nil {
+		v := r.InputProfileElement.DeepCopy()
+		out.InputProfileElement = &v
+	}
+	if r.OutputProfile != nil {
+		v := *r.OutputProfile
+		out.OutputProfile = &v
+	}
+	if r.OutputProfileElement != nil {
+		v := r.OutputProfileElement.DeepCopy()
+		out.OutputProfileElement = &v
+	}
+	if r.Parameter != nil {
+		out.Parameter = make([]OperationDefinitionParameter, len(r.Parameter))
+		for i := range r.Parameter {
+			out.Parameter[i] = r.Parameter[i].DeepCopy()
+		}
+	}
+	if r.Overload != nil {
+		out.Overload = make([]OperationDefinitionOverload, len(r.Overload))
+		for i := range r.Overload {
+			out.Overload[i] = r.Overload[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r OperationDefinition) Equal(other OperationDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.Equal(*other.KindElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.AffectsState == nil) != (other.AffectsState == nil) || r.AffectsState != nil && *r.AffectsState != *other.AffectsState {
+		return false
+	}
+	if (r.AffectsStateElement == nil) != (other.AffectsStateElement == nil) || r.AffectsStateElement != nil && !r.AffectsStateElement.Equal(*other.AffectsStateElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	if (r.Base == nil) != (other.Base == nil) || r.Base != nil && *r.Base != *other.Base {
+		return false
+	}
+	if (r.BaseElement == nil) != (other.BaseElement == nil) || r.BaseElement != nil && !r.BaseElement.Equal(*other.BaseElement) {
+		return false
+	}
+	if len(r.Resource) != len(other.Resource) {
+		return false
+	}
+	for i := range r.Resource {
+		if r.Resource[i] != other.Resource[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ResourceElement, other.ResourceElement, false) {
+		return false
+	}
+	if r.System != other.System {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.Equal(*other.SystemElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.Instance != other.Instance {
+		return false
+	}
+	if (r.InstanceElement == nil) != (other.InstanceElement == nil) || r.InstanceElement != nil && !r.InstanceElement.Equal(*other.InstanceElement) {
+		return false
+	}
+	if (r.InputProfile == nil) != (other.InputProfile == nil) || r.InputProfile != nil && *r.InputProfile != *other.InputProfile {
+		return false
+	}
+	if (r.InputProfileElement == nil) != (other.InputProfileElement == nil) || r.InputProfileElement != nil && !r.InputProfileElement.Equal(*other.InputProfileElement) {
+		return false
+	}
+	if (r.OutputProfile == nil) != (other.OutputProfile == nil) || r.OutputProfile != nil && *r.OutputProfile != *other.OutputProfile {
+		return false
+	}
+	if (r.OutputProfileElement == nil) != (other.OutputProfileElement == nil) || r.OutputProfileElement != nil && !r.OutputProfileElement.Equal(*other.OutputProfileElement) {
+		return false
+	}
+	if len(r.Parameter) != len(other.Parameter) {
+		return false
+	}
+	for i := range r.Parameter {
+		if !r.Parameter[i].Equal(other.Parameter[i]) {
+			return false
+		}
+	}
+	if len(r.Overload) != len(other.Overload) {
+		return false
+	}
+	for i := range r.Overload {
+		if !r.Overload[i].Equal(other.Overload[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r OperationDefinition) EqualsDeep(other OperationDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.EqualsDeep(*other.KindElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.AffectsState == nil) != (other.AffectsState == nil) || r.AffectsState != nil && *r.AffectsState != *other.AffectsState {
+		return false
+	}
+	if (r.AffectsStateElement == nil) != (other.AffectsStateElement == nil) || r.AffectsStateElement != nil && !r.AffectsStateElement.EqualsDeep(*other.AffectsStateElement) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	if (r.Base == nil) != (other.Base == nil) || r.Base != nil && !equivalentString(*r.Base, *other.Base) {
+		return false
+	}
+	if (r.BaseElement == nil) != (other.BaseElement == nil) || r.BaseElement != nil && !r.BaseElement.EqualsDeep(*other.BaseElement) {
+		return false
+	}
+	if !equivalentList(len(r.Resource), len(other.Resource), func(i, j int) bool {
+		return r.Resource[i] == other.Resource[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ResourceElement, other.ResourceElement, true) {
+		return false
+	}
+	if r.System != other.System {
+		return false
+	}
+	if (r.SystemElement == nil) != (other.SystemElement == nil) || r.SystemElement != nil && !r.SystemElement.EqualsDeep(*other.SystemElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if r.Instance != other.Instance {
+		return false
+	}
+	if (r.InstanceElement == nil) != (other.InstanceElement == nil) || r.InstanceElement != nil && !r.InstanceElement.EqualsDeep(*other.InstanceElement) {
+		return false
+	}
+	if (r.InputProfile == nil) != (other.InputProfile == nil) || r.InputProfile != nil && !equivalentString(*r.InputProfile, *other.InputProfile) {
+		return false
+	}
+	if (r.InputProfileElement == nil) != (other.InputProfileElement == nil) || r.InputProfileElement != nil && !r.InputProfileElement.EqualsDeep(*other.InputProfileElement) {
+		return false
+	}
+	if (r.OutputProfile == nil) != (other.OutputProfile == nil) || r.OutputProfile != nil && !equivalentString(*r.OutputProfile, *other.OutputProfile) {
+		return false
+	}
+	if (r.OutputProfileElement == nil) != (other.OutputProfileElement == nil) || r.OutputProfileElement != nil && !r.OutputProfileElement.EqualsDeep(*other.OutputProfileElement) {
+		return false
+	}
+	if !equivalentList(len(r.Parameter), len(other.Parameter), func(i, j int) bool {
+		return r.Parameter[i].EqualsDeep(other.Parameter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Overload), len(other.Overload), func(i, j int) bool {
+		return r.Overload[i].EqualsDeep(other.Overload[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r OperationDefinition) EqualsShallow(other OperationDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.AffectsState == nil) != (other.AffectsState == nil) || r.AffectsState != nil && *r.AffectsState != *other.AffectsState {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.Base == nil) != (other.Base == nil) || r.Base != nil && !equivalentString(*r.Base, *other.Base) {
+		return false
+	}
+	if !equivalentList(len(r.Resource), len(other.Resource), func(i, j int) bool {
+		return r.Resource[i] == other.Resource[j]
+	}) {
+		return false
+	}
+	if r.System != other.System {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if r.Instance != other.Instance {
+		return false
+	}
+	if (r.InputProfile == nil) != (other.InputProfile == nil) || r.InputProfile != nil && !equivalentString(*r.InputProfile, *other.InputProfile) {
+		return false
+	}
+	if (r.OutputProfile == nil) != (other.OutputProfile == nil) || r.OutputProfile != nil && !equivalentString(*r.OutputProfile, *other.OutputProfile) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given OperationDefinition as FHIR XML
+func (r OperationDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "OperationDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "kind", r.Kind, r.KindElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "affectsState", r.AffectsState, r.AffectsStateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "base", r.Base, r.BaseElement); err != nil {
+		return err
+	}
+	for i, v := range r.Resource {
+		if err := encodeXMLPrimitive(e, "resource", v, elementAt(r.ResourceElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "system", r.System, r.SystemElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "instance", r.Instance, r.InstanceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "inputProfile", r.InputProfile, r.InputProfileElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "outputProfile", r.OutputProfile, r.OutputProfileElement); err != nil {
+		return err
+	}
+	for _, v := range r.Parameter {
+		if err := encodeXMLElement(e, "parameter", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Overload {
+		if err := encodeXMLElement(e, "overload", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given OperationDefinition from FHIR XML
+func (r *OperationDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "kind":
+				var v OperationKind
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Kind = v
+				}
+				r.KindElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "affectsState":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AffectsState = &v
+				}
+				r.AffectsStateElement = element
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			case "base":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Base = &v
+				}
+				r.BaseElement = element
+			case "resource":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ResourceElement = appendElement(r.ResourceElement, len(r.Resource), element)
+					r.Resource = append(r.Resource, v)
+				}
+			case "system":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.System = v
+				}
+				r.SystemElement = element
+			case "type":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "instance":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Instance = v
+				}
+				r.InstanceElement = element
+			case "inputProfile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.InputProfile = &v
+				}
+				r.InputProfileElement = element
+			case "outputProfile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OutputProfile = &v
+				}
+				r.OutputProfileElement = element
+			case "parameter":
+				var v OperationDefinitionParameter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parameter = append(r.Parameter, v)
+			case "overload":
+				var v OperationDefinitionOverload
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Overload = append(r.Overload, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the OperationDefinition as properties to the RDF node n
+func (r OperationDefinition) turtle(n *turtleNode) {
+	n.resource("OperationDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("OperationDefinition.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("OperationDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("OperationDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("OperationDefinition.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("OperationDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("OperationDefinition.kind", -1, r.Kind, r.KindElement, "code")
+	n.primitive("OperationDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("OperationDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("OperationDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("OperationDefinition.contact", i, v)
+	}
+	n.primitive("OperationDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("OperationDefinition.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("OperationDefinition.jurisdiction", i, v)
+	}
+	n.primitive("OperationDefinition.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("OperationDefinition.affectsState", -1, r.AffectsState, r.AffectsStateElement, "boolean")
+	n.primitive("OperationDefinition.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("OperationDefinition.comment", -1, r.Comment, r.CommentElement, "string")
+	n.primitive("OperationDefinition.base", -1, r.Base, r.BaseElement, "string")
+	for i, v := range r.Resource {
+		n.primitive("OperationDefinition.resource", i, v, elementAt(r.ResourceElement, i), "code")
+	}
+	n.primitive("OperationDefinition.system", -1, r.System, r.SystemElement, "boolean")
+	n.primitive("OperationDefinition.type", -1, r.Type, r.TypeElement, "boolean")
+	n.primitive("OperationDefinition.instance", -1, r.Instance, r.InstanceElement, "boolean")
+	n.primitive("OperationDefinition.inputProfile", -1, r.InputProfile, r.InputProfileElement, "string")
+	n.primitive("OperationDefinition.outputProfile", -1, r.OutputProfile, r.OutputProfileElement, "string")
+	for i, v := range r.Parameter {
+		n.element("OperationDefinition.parameter", i, v)
+	}
+	for i, v := range r.Overload {
+		n.element("OperationDefinition.overload", i, v)
+	}
+}
+
+// MarshalBSON marshals the given OperationDefinition as BSON document with the structure of its FHIR JSON
+func (r OperationDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given OperationDefinition from a BSON document with the structure of its FHIR JSON
+func (r *OperationDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given OperationDefinition as protobuf message OperationDefinition of fhir.proto
+func (r OperationDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the OperationDefinition to the encoded message
+func (r OperationDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	if r.Version != nil {
+		e.string(10, *r.Version)
+	}
+	if r.Name != "" {
+		e.string(11, r.Name)
+	}
+	if r.Title != nil {
+		e.string(12, *r.Title)
+	}
+	e.varint(13, r.Status.protoNumber())
+	e.varint(14, r.Kind.protoNumber())
+	if r.Experimental != nil {
+		e.varint(15, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(16, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(17, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(18, v)
+	}
+	if r.Description != nil {
+		e.string(19, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(20, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(21, v)
+	}
+	if r.Purpose != nil {
+		e.string(22, *r.Purpose)
+	}
+	if r.AffectsState != nil {
+		e.varint(23, protoBool(*r.AffectsState))
+	}
+	if r.Code != "" {
+		e.string(24, r.Code)
+	}
+	if r.Comment != nil {
+		e.string(25, *r.Comment)
+	}
+	if r.Base != nil {
+		e.string(26, *r.Base)
+	}
+	if len(r.Resource) > 0 {
+		var p protoEncoder
+		for _, v := range r.Resource {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(27, p.buf)
+	}
+	if r.System {
+		e.varint(28, protoBool(r.System))
+	}
+	if r.Type {
+		e.varint(29, protoBool(r.Type))
+	}
+	if r.Instance {
+		e.varint(30, protoBool(r.Instance))
+	}
+	if r.InputProfile != nil {
+		e.string(31, *r.InputProfile)
+	}
+	if r.OutputProfile != nil {
+		e.string(32, *r.OutputProfile)
+	}
+	for _, v := range r.Parameter {
+		e.message(33, v)
+	}
+	for _, v := range r.Overload {
+		e.message(34, v)
+	}
+	if r.IdElement != nil {
+		e.message(35, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(36, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(37, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(38, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(39, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(40, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(41, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(42, *r.StatusElement)
+	}
+	if r.KindElement != nil {
+		e.message(43, *r.KindElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(44, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(45, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(46, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(47, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(48, *r.PurposeElement)
+	}
+	if r.AffectsStateElement != nil {
+		e.message(49, *r.AffectsStateElement)
+	}
+	if r.CodeElement != nil {
+		e.message(50, *r.CodeElement)
+	}
+	if r.CommentElement != nil {
+		e.message(51, *r.CommentElement)
+	}
+	if r.BaseElement != nil {
+		e.message(52, *r.BaseElement)
+	}
+	for _, v := range r.ResourceElement {
+		e.element(53, v)
+	}
+	if r.SystemElement != nil {
+		e.message(54, *r.SystemElement)
+	}
+	if r.TypeElement != nil {
+		e.message(55, *r.TypeElement)
+	}
+	if r.InstanceElement != nil {
+		e.message(56, *r.InstanceElement)
+	}
+	if r.InputProfileElement != nil {
+		e.message(57, *r.InputProfileElement)
+	}
+	if r.OutputProfileElement != nil {
+		e.message(58, *r.OutputProfileElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given OperationDefinition from protobuf message OperationDefinition of fhir.proto
+func (r *OperationDefinition) UnmarshalProto(b []byte) error {
+	*r = OperationDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			v := d.string()
+			r.Version = &v
+		case 11:
+			r.Name = d.string()
+		case 12:
+			v := d.string()
+			r.Title = &v
+		case 13:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 14:
+			var v OperationKind
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Kind = v
+		case 15:
+			v := d.bool()
+			r.Experimental = &v
+		case 16:
+			v := d.string()
+			r.Date = &v
+		case 17:
+			v := d.string()
+			r.Publisher = &v
+		case 18:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 19:
+			v := d.string()
+			r.Description = &v
+		case 20:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 22:
+			v := d.string()
+			r.Purpose = &v
+		case 23:
+			v := d.bool()
+			r.AffectsState = &v
+		case 24:
+			r.Code = d.string()
+		case 25:
+			v := d.string()
+			r.Comment = &v
+		case 26:
+			v := d.string()
+			r.Base = &v
+		case 27:
+			for _, n := range d.varints() {
+				var v ResourceType
+				d.enum(n, v.setProtoNumber)
+				r.Resource = append(r.Resource, v)
+			}
+		case 28:
+			r.System = d.bool()
+		case 29:
+			r.Type = d.bool()
+		case 30:
+			r.Instance = d.bool()
+		case 31:
+			v := d.string()
+			r.InputProfile = &v
+		case 32:
+			v := d.string()
+			r.OutputProfile = &v
+		case 33:
+			var v OperationDefinitionParameter
+			d.message(&v)
+			r.Parameter = append(r.Parameter, v)
+		case 34:
+			var v OperationDefinitionOverload
+			d.message(&v)
+			r.Overload = append(r.Overload, v)
+		case 35:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.KindElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.AffectsStateElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.BaseElement = &v
+		case 53:
+			r.ResourceElement = append(r.ResourceElement, d.element())
+		case 54:
+			var v Element
+			d.message(&v)
+			r.SystemElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.InstanceElement = &v
+		case 57:
+			var v Element
+			d.message(&v)
+			r.InputProfileElement = &v
+		case 58:
+			var v Element
+			d.message(&v)
+			r.OutputProfileElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given OperationDefinitionParameter as JSON into a byte slice
+func (r OperationDefinitionParameter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the OperationDefinitionParameter as JSON object with the members in the order of the fields
+func (r OperationDefinitionParameter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("use")
+	e.string(r.Use.Code())
+	if r.UseElement != nil {
+		e.key("_use")
+		r.UseElement.appendJSON(e)
+	}
+	e.key("min")
+	e.int(r.Min)
+	if r.MinElement != nil {
+		e.key("_min")
+		r.MinElement.appendJSON(e)
+	}
+	e.key("max")
+	e.string(r.Max)
+	if r.MaxElement != nil {
+		e.key("_max")
+		r.MaxElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(*r.Type)
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if len(r.TargetProfile) > 0 {
+		e.key("targetProfile")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.TargetProfile {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.TargetProfileElement) > 0 {
+		e.key("_targetProfile")
+		e.elements(r.TargetProfileElement, len(r.TargetProfile))
+	}
+	if r.SearchType != nil {
+		e.key("searchType")
+		e.string(r.SearchType.Code())
+	}
+	if r.SearchTypeElement != nil {
+		e.key("_searchType")
+		r.SearchTypeElement.appendJSON(e)
+	}
+	if r.Binding != nil {
+		e.key("binding")
+		r.Binding.appendJSON(e)
+	}
+	if len(r.ReferencedFrom) > 0 {
+		e.key("referencedFrom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReferencedFrom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Part) > 0 {
+		e.key("part")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Part {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given OperationDefinitionParameter from JSON. Unknown members are ignored.
+func (r *OperationDefinitionParameter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the OperationDefinitionParameter
+func (r *OperationDefinitionParameter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "use":
+			d.unmarshal(&r.Use)
+		case "_use":
+			r.UseElement = d.element()
+		case "min":
+			if v, ok := d.int(); ok {
+				r.Min = v
+			}
+		case "_min":
+			r.MinElement = d.element()
+		case "max":
+			if v, ok := d.string(); ok {
+				r.Max = v
+			}
+		case "_max":
+			r.MaxElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = &v
+			} else {
+				r.Type = nil
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "targetProfile":
+			a := d.array()
+			r.TargetProfile = nil
+			for a.next() {
+				v, _ := d.string()
+				r.TargetProfile = append(r.TargetProfile, v)
+			}
+			if r.TargetProfile == nil && !a.isNull {
+				r.TargetProfile = []string{}
+			}
+		case "_targetProfile":
+			a := d.array()
+			r.TargetProfileElement = nil
+			for a.next() {
+				r.TargetProfileElement = append(r.TargetProfileElement, d.element())
+			}
+		case "searchType":
+			if d.null() {
+				r.SearchType = nil
+			} else {
+				var v SearchParamType
+				d.unmarshal(&v)
+				r.SearchType = &v
+			}
+		case "_searchType":
+			r.SearchTypeElement = d.element()
+		case "binding":
+			if d.null() {
+				r.Binding = nil
+			} else {
+				var v OperationDefinitionParameterBinding
+				v.decodeJSON(d)
+				r.Binding = &v
+			}
+		case "referencedFrom":
+			a := d.array()
+			r.ReferencedFrom = nil
+			for a.next() {
+				var v OperationDefinitionParameterReferencedFrom
+				v.decodeJSON(d)
+				r.ReferencedFrom = append(r.ReferencedFrom, v)
+			}
+			if r.ReferencedFrom == nil && !a.isNull {
+				r.ReferencedFrom = []OperationDefinitionParameterReferencedFrom{}
+			}
+		case "part":
+			a := d.array()
+			r.Part = nil
+			for a.next() {
+				var v OperationDefinitionParameter
+				v.decodeJSON(d)
+				r.Part = append(r.Part, v)
+			}
+			if r.Part == nil && !a.isNull {
+				r.Part = []OperationDefinitionParameter{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the OperationDefinitionParameter which shares no memory with the original
+func (r OperationDefinitionParameter) DeepCopy() OperationDefinitionParameter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.UseElement != nil {
+		v := r.UseElement.DeepCopy()
+		out.UseElement = &v
+	}
+	if r.MinElement != nil {
+		v := r.MinElement.DeepCopy()
+		out.MinElement = &v
+	}
+	if r.MaxElement != nil {
+		v := r.MaxElement.DeepCopy()
+		out.MaxElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.TargetProfile != nil {
+		out.TargetProfile = make([]string, len(r.TargetProfile))
+		copy(out.TargetProfile, r.TargetProfile)
+	}
+	if r.TargetProfileElement != nil {
+		out.TargetProfileElement = make([]*Element, len(r.TargetProfileElement))
+		for i, v := range r.TargetProfileElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.TargetProfileElement[i] = &c
+			}
+		}
+	}
+	if r.SearchType != nil {
+		v := *r.SearchType
+		out.SearchType = &v
+	}
+	if r.SearchTypeElement != nil {
+		v := r.SearchTypeElement.DeepCopy()
+		out.SearchTypeElement = &v
+	}
+	if r.Binding != nil {
+		v := r.Binding.DeepCopy()
+		out.Binding = &v
+	}
+	if r.ReferencedFrom != nil {
+		out.ReferencedFrom = make([]OperationDefinitionParameterReferencedFrom, len(r.ReferencedFrom))
+		for i := range r.ReferencedFrom {
+			out.ReferencedFrom[i] = r.ReferencedFrom[i].DeepCopy()
+		}
+	}
+	if r.Part != nil {
+		out.Part = make([]OperationDefinitionParameter, len(r.Part))
+		for i := range r.Part {
+			out.Part[i] = r.Part[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r OperationDefinitionParameter) Equal(other OperationDefinitionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.Equal(*other.UseElement) {
+		return false
+	}
+	if r.Min != other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.Equal(*other.MinElement) {
+		return false
+	}
+	if r.Max != other.Max {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.Equal(*other.MaxElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if len(r.TargetProfile) != len(other.TargetProfile) {
+		return false
+	}
+	for i := range r.TargetProfile {
+		if r.TargetProfile[i] != other.TargetProfile[i] {
+			return false
+		}
+	}
+	if !equalElements(r.TargetProfileElement, other.TargetProfileElement, false) {
+		return false
+	}
+	if (r.SearchType == nil) != (other.SearchType == nil) || r.SearchType != nil && *r.SearchType != *other.SearchType {
+		return false
+	}
+	if (r.SearchTypeElement == nil) != (other.SearchTypeElement == nil) || r.SearchTypeElement != nil && !r.SearchTypeElement.Equal(*other.SearchTypeElement) {
+		return false
+	}
+	if (r.Binding == nil) != (other.Binding == nil) || r.Binding != nil && !r.Binding.Equal(*other.Binding) {
+		return false
+	}
+	if len(r.ReferencedFrom) != len(other.ReferencedFrom) {
+		return false
+	}
+	for i := range r.ReferencedFrom {
+		if !r.ReferencedFrom[i].Equal(other.ReferencedFrom[i]) {
+			return false
+		}
+	}
+	if len(r.Part) != len(other.Part) {
+		return false
+	}
+	for i := range r.Part {
+		if !r.Part[i].Equal(other.Part[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r OperationDefinitionParameter) EqualsDeep(other OperationDefinitionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if (r.UseElement == nil) != (other.UseElement == nil) || r.UseElement != nil && !r.UseElement.EqualsDeep(*other.UseElement) {
+		return false
+	}
+	if r.Min != other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.EqualsDeep(*other.MinElement) {
+		return false
+	}
+	if !equivalentString(r.Max, other.Max) {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.EqualsDeep(*other.MaxElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentList(len(r.TargetProfile), len(other.TargetProfile), func(i, j int) bool {
+		return equivalentString(r.TargetProfile[i], other.TargetProfile[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.TargetProfileElement, other.TargetProfileElement, true) {
+		return false
+	}
+	if (r.SearchType == nil) != (other.SearchType == nil) || r.SearchType != nil && *r.SearchType != *other.SearchType {
+		return false
+	}
+	if (r.SearchTypeElement == nil) != (other.SearchTypeElement == nil) || r.SearchTypeElement != nil && !r.SearchTypeElement.EqualsDeep(*other.SearchTypeElement) {
+		return false
+	}
+	if (r.Binding == nil) != (other.Binding == nil) || r.Binding != nil && !r.Binding.EqualsDeep(*other.Binding) {
+		return false
+	}
+	if !equivalentList(len(r.ReferencedFrom), len(other.ReferencedFrom), func(i, j int) bool {
+		return r.ReferencedFrom[i].EqualsDeep(other.ReferencedFrom[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Part), len(other.Part), func(i, j int) bool {
+		return r.Part[i].EqualsDeep(other.Part[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r OperationDefinitionParameter) EqualsShallow(other OperationDefinitionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if r.Use != other.Use {
+		return false
+	}
+	if r.Min != other.Min {
+		return false
+	}
+	if !equivalentString(r.Max, other.Max) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.TargetProfile), len(other.TargetProfile), func(i, j int) bool {
+		return equivalentString(r.TargetProfile[i], other.TargetProfile[j])
+	}) {
+		return false
+	}
+	if (r.SearchType == nil) != (other.SearchType == nil) || r.SearchType != nil && *r.SearchType != *other.SearchType {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given OperationDefinitionParameter as FHIR XML
+func (r OperationDefinitionParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "use", r.Use, r.UseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "min", r.Min, r.MinElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "max", r.Max, r.MaxElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	for i, v := range r.TargetProfile {
+		if err := encodeXMLPrimitive(e, "targetProfile", v, elementAt(r.TargetProfileElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "searchType", r.SearchType, r.SearchTypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "binding", r.Binding); err != nil {
+		return err
+	}
+	for _, v := range r.ReferencedFrom {
+		if err := encodeXMLElement(e, "referencedFrom", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Part {
+		if err := encodeXMLElement(e, "part", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given OperationDefinitionParameter from FHIR XML
+func (r *OperationDefinitionParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "use":
+				var v OperationParameterUse
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Use = v
+				}
+				r.UseElement = element
+			case "min":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Min = v
+				}
+				r.MinElement = element
+			case "max":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Max = v
+				}
+				r.MaxElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "targetProfile":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.TargetProfileElement = appendElement(r.TargetProfileElement, len(r.TargetProfile), element)
+					r.TargetProfile = append(r.TargetProfile, v)
+				}
+			case "searchType":
+				var v SearchParamType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SearchType = &v
+				}
+				r.SearchTypeElement = element
+			case "binding":
+				var v OperationDefinitionParameterBinding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Binding = &v
+			case "referencedFrom":
+				var v OperationDefinitionParameterReferencedFrom
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferencedFrom = append(r.ReferencedFrom, v)
+			case "part":
+				var v OperationDefinitionParameter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Part = append(r.Part, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the OperationDefinitionParameter as properties to the RDF node n
+func (r OperationDefinitionParameter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("OperationDefinition.parameter.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("OperationDefinition.parameter.use", -1, r.Use, r.UseElement, "code")
+	n.primitive("OperationDefinition.parameter.min", -1, r.Min, r.MinElement, "integer")
+	n.primitive("OperationDefinition.parameter.max", -1, r.Max, r.MaxElement, "string")
+	n.primitive("OperationDefinition.parameter.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+	n.primitive("OperationDefinition.parameter.type", -1, r.Type, r.TypeElement, "string")
+	for i, v := range r.TargetProfile {
+		n.primitive("OperationDefinition.parameter.targetProfile", i, v, elementAt(r.TargetProfileElement, i), "string")
+	}
+	n.primitive("OperationDefinition.parameter.searchType", -1, r.SearchType, r.SearchTypeElement, "code")
+	n.element("OperationDefinition.parameter.binding", -1, r.Binding)
+	for i, v := range r.ReferencedFrom {
+		n.element("OperationDefinition.parameter.referencedFrom", i, v)
+	}
+	for i, v := range r.Part {
+		n.element("OperationDefinition.parameter.part", i, v)
+	}
+}
+
+// MarshalBSON marshals the given OperationDefinitionParameter as BSON document with the structure of its FHIR JSON
+func (r OperationDefinitionParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given OperationDefinitionParameter from a BSON document with the structure of its FHIR JSON
+func (r *OperationDefinitionParameter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given OperationDefinitionParameter as protobuf message OperationDefinitionParameter of fhir.proto
+func (r OperationDefinitionParameter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the OperationDefinitionParameter to the encoded message
+func (r OperationDefinitionParameter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	e.varint(5, r.Use.protoNumber())
+	if r.Min != 0 {
+		e.varint(6, uint64(int64(r.Min)))
+	}
+	if r.Max != "" {
+		e.string(7, r.Max)
+	}
+	if r.Documentation != nil {
+		e.string(8, *r.Documentation)
+	}
+	if r.Type != nil {
+		e.string(9, *r.Type)
+	}
+	for _, v := range r.TargetProfile {
+		e.string(10, v)
+	}
+	if r.SearchType != nil {
+		e.varint(11, r.SearchType.protoNumber())
+	}
+	if r.Binding != nil {
+		e.message(12, *r.Binding)
+	}
+	for _, v := range r.ReferencedFrom {
+		e.message(13, v)
+	}
+	for _, v := range r.Part {
+		e.message(14, v)
+	}
+	if r.NameElement != nil {
+		e.message(15, *r.NameElement)
+	}
+	if r.UseElement != nil {
+		e.message(16, *r.UseElement)
+	}
+	if r.MinElement != nil {
+		e.message(17, *r.MinElement)
+	}
+	if r.MaxElement != nil {
+		e.message(18, *r.MaxElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(19, *r.DocumentationElement)
+	}
+	if r.TypeElement != nil {
+		e.message(20, *r.TypeElement)
+	}
+	for _, v := range r.TargetProfileElement {
+		e.element(21, v)
+	}
+	if r.SearchTypeElement != nil {
+		e.message(22, *r.SearchTypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given OperationDefinitionParameter from protobuf message OperationDefinitionParameter of fhir.proto
+func (r *OperationDefinitionParameter) UnmarshalProto(b []byte) error {
+	*r = OperationDefinitionParameter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			var v OperationParameterUse
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Use = v
+		case 6:
+			r.Min = d.int()
+		case 7:
+			r.Max = d.string()
+		case 8:
+			v := d.string()
+			r.Documentation = &v
+		case 9:
+			v := d.string()
+			r.Type = &v
+		case 10:
+			r.TargetProfile = append(r.TargetProfile, d.string())
+		case 11:
+			var v SearchParamType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.SearchType = &v
+		case 12:
+			var v OperationDefinitionParameterBinding
+			d.message(&v)
+			r.Binding = &v
+		case 13:
+			var v OperationDefinitionParameterReferencedFrom
+			d.message(&v)
+			r.ReferencedFrom = append(r.ReferencedFrom, v)
+		case 14:
+			var v OperationDefinitionParameter
+			d.message(&v)
+			r.Part = append(r.Part, v)
+		case 15:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.UseElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.MinElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.MaxElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 21:
+			r.TargetProfileElement = append(r.TargetProfileElement, d.element())
+		case 22:
+			var v Element
+			d.message(&v)
+			r.SearchTypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given OperationDefinitionParameterBinding as JSON into a byte slice
+func (r OperationDefinitionParameterBinding) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the OperationDefinitionParameterBinding as JSON object with the members in the order of the fields
+func (r OperationDefinitionParameterBinding) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("strength")
+	e.string(r.Strength.Code())
+	if r.StrengthElement != nil {
+		e.key("_strength")
+		r.StrengthElement.appendJSON(e)
+	}
+	e.key("valueSet")
+	e.string(r.ValueSet)
+	if r.ValueSetElement != nil {
+		e.key("_valueSet")
+		r.ValueSetElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given OperationDefinitionParameterBinding from JSON. Unknown members are ignored.
+func (r *OperationDefinitionParameterBinding) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the OperationDefinitionParameterBinding
+func (r *OperationDefinitionParameterBinding) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "strength":
+			d.unmarshal(&r.Strength)
+		case "_strength":
+			r.StrengthElement = d.element()
+		case "valueSet":
+			if v, ok := d.string(); ok {
+				r.ValueSet = v
+			}
+		case "_valueSet":
+			r.ValueSetElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the OperationDefinitionParameterBinding which shares no memory with the original
+func (r OperationDefinitionParameterBinding) DeepCopy() OperationDefinitionParameterBinding {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.StrengthElement != nil {
+		v := r.StrengthElement.DeepCopy()
+		out.StrengthElement = &v
+	}
+	if r.ValueSetElement != nil {
+		v := r.ValueSetElement.DeepCopy()
+		out.ValueSetElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r OperationDefinitionParameterBinding) Equal(other OperationDefinitionParameterBinding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Strength != other.Strength {
+		return false
+	}
+	if (r.StrengthElement == nil) != (other.StrengthElement == nil) || r.StrengthElement != nil && !r.StrengthElement.Equal(*other.StrengthElement) {
+		return false
+	}
+	if r.ValueSet != other.ValueSet {
+		return false
+	}
+	if (r.ValueSetElement == nil) != (other.ValueSetElement == nil) || r.ValueSetElement != nil && !r.ValueSetElement.Equal(*other.ValueSetElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r OperationDefinitionParameterBinding) EqualsDeep(other OperationDefinitionParameterBinding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Strength != other.Strength {
+		return false
+	}
+	if (r.StrengthElement == nil) != (other.StrengthElement == nil) || r.StrengthElement != nil && !r.StrengthElement.EqualsDeep(*other.StrengthElement) {
+		return false
+	}
+	if !equivalentString(r.ValueSet, other.ValueSet) {
+		return false
+	}
+	if (r.ValueSetElement == nil) != (other.ValueSetElement == nil) || r.ValueSetElement != nil && !r.ValueSetElement.EqualsDeep(*other.ValueSetElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r OperationDefinitionParameterBinding) EqualsShallow(other OperationDefinitionParameterBinding) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Strength != other.Strength {
+		return false
+	}
+	if !equivalentString(r.ValueSet, other.ValueSet) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given OperationDefinitionParameterBinding as FHIR XML
+func (r OperationDefinitionParameterBinding) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "strength", r.Strength, r.StrengthElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueSet", r.ValueSet, r.ValueSetElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given OperationDefinitionParameterBinding from FHIR XML
+func (r *OperationDefinitionParameterBinding) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "strength":
+				var v BindingStrength
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Strength = v
+				}
+				r.StrengthElement = element
+			case "valueSet":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueSet = v
+				}
+				r.ValueSetElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the OperationDefinitionParameterBinding as properties to the RDF node n
+func (r OperationDefinitionParameterBinding) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("OperationDefinition.parameter.binding.strength", -1, r.Strength, r.StrengthElement, "code")
+	n.primitive("OperationDefinition.parameter.binding.valueSet", -1, r.ValueSet, r.ValueSetElement, "string")
+}
+
+// MarshalBSON marshals the given OperationDefinitionParameterBinding as BSON document with the structure of its FHIR JSON
+func (r OperationDefinitionParameterBinding) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given OperationDefinitionParameterBinding from a BSON document with the structure of its FHIR JSON
+func (r *OperationDefinitionParameterBinding) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given OperationDefinitionParameterBinding as protobuf message OperationDefinitionParameterBinding of fhir.proto
+func (r OperationDefinitionParameterBinding) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the OperationDefinitionParameterBinding to the encoded message
+func (r OperationDefinitionParameterBinding) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Strength.protoNumber())
+	if r.ValueSet != "" {
+		e.string(5, r.ValueSet)
+	}
+	if r.StrengthElement != nil {
+		e.message(6, *r.StrengthElement)
+	}
+	if r.ValueSetElement != nil {
+		e.message(7, *r.ValueSetElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given OperationDefinitionParameterBinding from protobuf message OperationDefinitionParameterBinding of fhir.proto
+func (r *OperationDefinitionParameterBinding) UnmarshalProto(b []byte) error {
+	*r = OperationDefinitionParameterBinding{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v BindingStrength
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Strength = v
+		case 5:
+			r.ValueSet = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.StrengthElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ValueSetElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given OperationDefinitionParameterReferencedFrom as JSON into a byte slice
+func (r OperationDefinitionParameterReferencedFrom) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the OperationDefinitionParameterReferencedFrom as JSON object with the members in the order of the fields
+func (r OperationDefinitionParameterReferencedFrom) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("source")
+	e.string(r.Source)
+	if r.SourceElement != nil {
+		e.key("_source")
+		r.SourceElement.appendJSON(e)
+	}
+	if r.SourceId != nil {
+		e.key("sourceId")
+		e.string(*r.SourceId)
+	}
+	if r.SourceIdElement != nil {
+		e.key("_sourceId")
+		r.SourceIdElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given OperationDefinitionParameterReferencedFrom from JSON. Unknown members are ignored.
+func (r *OperationDefinitionParameterReferencedFrom) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the OperationDefinitionParameterReferencedFrom
+func (r *OperationDefinitionParameterReferencedFrom) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "source":
+			if v, ok := d.string(); ok {
+				r.Source = v
+			}
+		case "_source":
+			r.SourceElement = d.element()
+		case "sourceId":
+			if v, ok := d.string(); ok {
+				r.SourceId = &v
+			} else {
+				r.SourceId = nil
+			}
+		case "_sourceId":
+			r.SourceIdElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the OperationDefinitionParameterReferencedFrom which shares no memory with the original
+func (r OperationDefinitionParameterReferencedFrom) DeepCopy() OperationDefinitionParameterReferencedFrom {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SourceElement != nil {
+		v := r.SourceElement.DeepCopy()
+		out.SourceElement = &v
+	}
+	if r.SourceId != nil {
+		v := *r.SourceId
+		out.SourceId = &v
+	}
+	if r.SourceIdElement != nil {
+		v := r.SourceIdElement.DeepCopy()
+		out.SourceIdElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r OperationDefinitionParameterReferencedFrom) Equal(other OperationDefinitionParameterReferencedFrom) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Source != other.Source {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.Equal(*other.SourceElement) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && *r.SourceId != *other.SourceId {
+		return false
+	}
+	if (r.SourceIdElement == nil) != (other.SourceIdElement == nil) || r.SourceIdElement != nil && !r.SourceIdElement.Equal(*other.SourceIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r OperationDefinitionParameterReferencedFrom) EqualsDeep(other OperationDefinitionParameterReferencedFrom) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Source, other.Source) {
+		return false
+	}
+	if (r.SourceElement == nil) != (other.SourceElement == nil) || r.SourceElement != nil && !r.SourceElement.EqualsDeep(*other.SourceElement) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && !equivalentString(*r.SourceId, *other.SourceId) {
+		return false
+	}
+	if (r.SourceIdElement == nil) != (other.SourceIdElement == nil) || r.SourceIdElement != nil && !r.SourceIdElement.EqualsDeep(*other.SourceIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r OperationDefinitionParameterReferencedFrom) EqualsShallow(other OperationDefinitionParameterReferencedFrom) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Source, other.Source) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && !equivalentString(*r.SourceId, *other.SourceId) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given OperationDefinitionParameterReferencedFrom as FHIR XML
+func (r OperationDefinitionParameterReferencedFrom) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "source", r.Source, r.SourceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sourceId", r.SourceId, r.SourceIdElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given OperationDefinitionParameterReferencedFrom from FHIR XML
+func (r *OperationDefinitionParameterReferencedFrom) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "source":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Source = v
+				}
+				r.SourceElement = element
+			case "sourceId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SourceId = &v
+				}
+				r.SourceIdElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the OperationDefinitionParameterReferencedFrom as properties to the RDF node n
+func (r OperationDefinitionParameterReferencedFrom) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("OperationDefinition.parameter.referencedFrom.source", -1, r.Source, r.SourceElement, "string")
+	n.primitive("OperationDefinition.parameter.referencedFrom.sourceId", -1, r.SourceId, r.SourceIdElement, "string")
+}
+
+// MarshalBSON marshals the given OperationDefinitionParameterReferencedFrom as BSON document with the structure of its FHIR JSON
+func (r OperationDefinitionParameterReferencedFrom) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given OperationDefinitionParameterReferencedFrom from a BSON document with the structure of its FHIR JSON
+func (r *OperationDefinitionParameterReferencedFrom) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given OperationDefinitionParameterReferencedFrom as protobuf message OperationDefinitionParameterReferencedFrom of fhir.proto
+func (r OperationDefinitionParameterReferencedFrom) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the OperationDefinitionParameterReferencedFrom to the encoded message
+func (r OperationDefinitionParameterReferencedFrom) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Source != "" {
+		e.string(4, r.Source)
+	}
+	if r.SourceId != nil {
+		e.string(5, *r.SourceId)
+	}
+	if r.SourceElement != nil {
+		e.message(6, *r.SourceElement)
+	}
+	if r.SourceIdElement != nil {
+		e.message(7, *r.SourceIdElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given OperationDefinitionParameterReferencedFrom from protobuf message OperationDefinitionParameterReferencedFrom of fhir.proto
+func (r *OperationDefinitionParameterReferencedFrom) UnmarshalProto(b []byte) error {
+	*r = OperationDefinitionParameterReferencedFrom{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Source = d.string()
+		case 5:
+			v := d.string()
+			r.SourceId = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.SourceElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.SourceIdElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given OperationDefinitionOverload as JSON into a byte slice
+func (r OperationDefinitionOverload) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the OperationDefinitionOverload as JSON object with the members in the order of the fields
+func (r OperationDefinitionOverload) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ParameterName) > 0 {
+		e.key("parameterName")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ParameterName {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ParameterNameElement) > 0 {
+		e.key("_parameterName")
+		e.elements(r.ParameterNameElement, len(r.ParameterName))
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given OperationDefinitionOverload from JSON. Unknown members are ignored.
+func (r *OperationDefinitionOverload) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the OperationDefinitionOverload
+func (r *OperationDefinitionOverload) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "parameterName":
+			a := d.array()
+			r.ParameterName = nil
+			for a.next() {
+				v, _ := d.string()
+				r.ParameterName = append(r.ParameterName, v)
+			}
+			if r.ParameterName == nil && !a.isNull {
+				r.ParameterName = []string{}
+			}
+		case "_parameterName":
+			a := d.array()
+			r.ParameterNameElement = nil
+			for a.next() {
+				r.ParameterNameElement = append(r.ParameterNameElement, d.element())
+			}
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the OperationDefinitionOverload which shares no memory with the original
+func (r OperationDefinitionOverload) DeepCopy() OperationDefinitionOverload {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ParameterName != nil {
+		out.ParameterName = make([]string, len(r.ParameterName))
+		copy(out.ParameterName, r.ParameterName)
+	}
+	if r.ParameterNameElement != nil {
+		out.ParameterNameElement = make([]*Element, len(r.ParameterNameElement))
+		for i, v := range r.ParameterNameElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ParameterNameElement[i] = &c
+			}
+		}
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r OperationDefinitionOverload) Equal(other OperationDefinitionOverload) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.ParameterName) != len(other.ParameterName) {
+		return false
+	}
+	for i := range r.ParameterName {
+		if r.ParameterName[i] != other.ParameterName[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ParameterNameElement, other.ParameterNameElement, false) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r OperationDefinitionOverload) EqualsDeep(other OperationDefinitionOverload) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ParameterName), len(other.ParameterName), func(i, j int) bool {
+		return equivalentString(r.ParameterName[i], other.ParameterName[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ParameterNameElement, other.ParameterNameElement, true) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r OperationDefinitionOverload) EqualsShallow(other OperationDefinitionOverload) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.ParameterName), len(other.ParameterName), func(i, j int) bool {
+		return equivalentString(r.ParameterName[i], other.ParameterName[j])
+	}) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given OperationDefinitionOverload as FHIR XML
+func (r OperationDefinitionOverload) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ParameterName {
+		if err := encodeXMLPrimitive(e, "parameterName", v, elementAt(r.ParameterNameElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given OperationDefinitionOverload from FHIR XML
+func (r *OperationDefinitionOverload) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "parameterName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ParameterNameElement = appendElement(r.ParameterNameElement, len(r.ParameterName), element)
+					r.ParameterName = append(r.ParameterName, v)
+				}
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the OperationDefinitionOverload as properties to the RDF node n
+func (r OperationDefinitionOverload) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.ParameterName {
+		n.primitive("OperationDefinition.overload.parameterName", i, v, elementAt(r.ParameterNameElement, i), "string")
+	}
+	n.primitive("OperationDefinition.overload.comment", -1, r.Comment, r.CommentElement, "string")
+}
+
+// MarshalBSON marshals the given OperationDefinitionOverload as BSON document with the structure of its FHIR JSON
+func (r OperationDefinitionOverload) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given OperationDefinitionOverload from a BSON document with the structure of its FHIR JSON
+func (r *OperationDefinitionOverload) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given OperationDefinitionOverload as protobuf message OperationDefinitionOverload of fhir.proto
+func (r OperationDefinitionOverload) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the OperationDefinitionOverload to the encoded message
+func (r OperationDefinitionOverload) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.ParameterName {
+		e.string(4, v)
+	}
+	if r.Comment != nil {
+		e.string(5, *r.Comment)
+	}
+	for _, v := range r.ParameterNameElement {
+		e.element(6, v)
+	}
+	if r.CommentElement != nil {
+		e.message(7, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given OperationDefinitionOverload from protobuf message OperationDefinitionOverload of fhir.proto
+func (r *OperationDefinitionOverload) UnmarshalProto(b []byte) error {
+	*r = OperationDefinitionOverload{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.ParameterName = append(r.ParameterName, d.string())
+		case 5:
+			v := d.string()
+			r.Comment = &v
+		case 6:
+			r.ParameterNameElement = append(r.ParameterNameElement, d.element())
+		case 7:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalOperationDefinition unmarshals a OperationDefinition.
 func UnmarshalOperationDefinition(b []byte) (OperationDefinition, error) {
 	var operationDefinition OperationDefinition
-	if err := json.Unmarshal(b, &operationDefinition); err != nil {
+	if err := operationDefinition.UnmarshalJSON(b); err != nil {
 		return operationDefinition, err
 	}
 	return operationDefinition, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code OperationKind) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *OperationKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *OperationKind) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "operation":
 		*code = OperationKindOperation
@@ -46,6 +50,12 @@ func (code *OperationKind) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code OperationKind) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *OperationKind) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code OperationKind) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code OperationKind) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code OperationKind) protoNumber() uint64 {
+	switch code {
+	case OperationKindOperation:
+		return 1
+	case OperationKindQuery:
+		return 2
+	}
+	return 0
+}
+func (code *OperationKind) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = OperationKindOperation
+	case 2:
+		*code = OperationKindQuery
+	default:
+		return false
+	}
+	return true
+}
@@ -14,50 +14,1380 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // OperationOutcome is documented here http://hl7.org/fhir/StructureDefinition/OperationOutcome
 type OperationOutcome struct {
-	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Issue             []OperationOutcomeIssue `bson:"issue" json:"issue"`
+	Id                   *string                 `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage       `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Issue                []OperationOutcomeIssue `bson:"issue" json:"issue"`
 }
 type OperationOutcomeIssue struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Severity          IssueSeverity    `bson:"severity" json:"severity"`
-	Code              IssueType        `bson:"code" json:"code"`
-	Details           *CodeableConcept `bson:"details,omitempty" json:"details,omitempty"`
-	Diagnostics       *string          `bson:"diagnostics,omitempty" json:"diagnostics,omitempty"`
-	Location          []string         `bson:"location,omitempty" json:"location,omitempty"`
-	Expression        []string         `bson:"expression,omitempty" json:"expression,omitempty"`
-}
-type OtherOperationOutcome OperationOutcome
+	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Severity           IssueSeverity    `bson:"severity" json:"severity"`
+	SeverityElement    *Element         `bson:"_severity,omitempty" json:"_severity,omitempty"`
+	Code               IssueType        `bson:"code" json:"code"`
+	CodeElement        *Element         `bson:"_code,omitempty" json:"_code,omitempty"`
+	Details            *CodeableConcept `bson:"details,omitempty" json:"details,omitempty"`
+	Diagnostics        *string          `bson:"diagnostics,omitempty" json:"diagnostics,omitempty"`
+	DiagnosticsElement *Element         `bson:"_diagnostics,omitempty" json:"_diagnostics,omitempty"`
+	Location           []string         `bson:"location,omitempty" json:"location,omitempty"`
+	LocationElement    []*Element       `bson:"_location,omitempty" json:"_location,omitempty"`
+	Expression         []string         `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement  []*Element       `bson:"_expression,omitempty" json:"_expression,omitempty"`
+}
 
 // MarshalJSON marshals the given OperationOutcome as JSON into a byte slice
 func (r OperationOutcome) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherOperationOutcome
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherOperationOutcome: OtherOperationOutcome(r),
-		ResourceType:          "OperationOutcome",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the OperationOutcome as JSON object with the members in the order of the fields
+func (r OperationOutcome) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"OperationOutcome\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("issue")
+	if r.Issue == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Issue {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given OperationOutcome from JSON. Unknown members are ignored.
+func (r *OperationOutcome) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the OperationOutcome
+func (r *OperationOutcome) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "issue":
+			a := d.array()
+			r.Issue = nil
+			for a.next() {
+				var v OperationOutcomeIssue
+				v.decodeJSON(d)
+				r.Issue = append(r.Issue, v)
+			}
+			if r.Issue == nil && !a.isNull {
+				r.Issue = []OperationOutcomeIssue{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the OperationOutcome which shares no memory with the original
+func (r OperationOutcome) DeepCopy() OperationOutcome {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Issue != nil {
+		out.Issue = make([]OperationOutcomeIssue, len(r.Issue))
+		for i := range r.Issue {
+			out.Issue[i] = r.Issue[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r OperationOutcome) Equal(other OperationOutcome) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Issue) != len(other.Issue) {
+		return false
+	}
+	for i := range r.Issue {
+		if !r.Issue[i].Equal(other.Issue[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r OperationOutcome) EqualsDeep(other OperationOutcome) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Issue), len(other.Issue), func(i, j int) bool {
+		return r.Issue[i].EqualsDeep(other.Issue[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r OperationOutcome) EqualsShallow(other OperationOutcome) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given OperationOutcome as FHIR XML
+func (r OperationOutcome) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "OperationOutcome",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Issue {
+		if err := encodeXMLElement(e, "issue", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given OperationOutcome from FHIR XML
+func (r *OperationOutcome) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "issue":
+				var v OperationOutcomeIssue
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Issue = append(r.Issue, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the OperationOutcome as properties to the RDF node n
+func (r OperationOutcome) turtle(n *turtleNode) {
+	n.resource("OperationOutcome", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Issue {
+		n.element("OperationOutcome.issue", i, v)
+	}
+}
+
+// MarshalBSON marshals the given OperationOutcome as BSON document with the structure of its FHIR JSON
+func (r OperationOutcome) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given OperationOutcome from a BSON document with the structure of its FHIR JSON
+func (r *OperationOutcome) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given OperationOutcome as protobuf message OperationOutcome of fhir.proto
+func (r OperationOutcome) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the OperationOutcome to the encoded message
+func (r OperationOutcome) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Issue {
+		e.message(9, v)
+	}
+	if r.IdElement != nil {
+		e.message(10, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(11, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(12, *r.LanguageElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given OperationOutcome from protobuf message OperationOutcome of fhir.proto
+func (r *OperationOutcome) UnmarshalProto(b []byte) error {
+	*r = OperationOutcome{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v OperationOutcomeIssue
+			d.message(&v)
+			r.Issue = append(r.Issue, v)
+		case 10:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given OperationOutcomeIssue as JSON into a byte slice
+func (r OperationOutcomeIssue) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the OperationOutcomeIssue as JSON object with the members in the order of the fields
+func (r OperationOutcomeIssue) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("severity")
+	e.string(r.Severity.Code())
+	if r.SeverityElement != nil {
+		e.key("_severity")
+		r.SeverityElement.appendJSON(e)
+	}
+	e.key("code")
+	e.string(r.Code.Code())
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.Details != nil {
+		e.key("details")
+		r.Details.appendJSON(e)
+	}
+	if r.Diagnostics != nil {
+		e.key("diagnostics")
+		e.string(*r.Diagnostics)
+	}
+	if r.DiagnosticsElement != nil {
+		e.key("_diagnostics")
+		r.DiagnosticsElement.appendJSON(e)
+	}
+	if len(r.Location) > 0 {
+		e.key("location")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Location {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LocationElement) > 0 {
+		e.key("_location")
+		e.elements(r.LocationElement, len(r.Location))
+	}
+	if len(r.Expression) > 0 {
+		e.key("expression")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Expression {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ExpressionElement) > 0 {
+		e.key("_expression")
+		e.elements(r.ExpressionElement, len(r.Expression))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given OperationOutcomeIssue from JSON. Unknown members are ignored.
+func (r *OperationOutcomeIssue) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the OperationOutcomeIssue
+func (r *OperationOutcomeIssue) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "severity":
+			d.unmarshal(&r.Severity)
+		case "_severity":
+			r.SeverityElement = d.element()
+		case "code":
+			d.unmarshal(&r.Code)
+		case "_code":
+			r.CodeElement = d.element()
+		case "details":
+			if d.null() {
+				r.Details = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Details = &v
+			}
+		case "diagnostics":
+			if v, ok := d.string(); ok {
+				r.Diagnostics = &v
+			} else {
+				r.Diagnostics = nil
+			}
+		case "_diagnostics":
+			r.DiagnosticsElement = d.element()
+		case "location":
+			a := d.array()
+			r.Location = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Location = append(r.Location, v)
+			}
+			if r.Location == nil && !a.isNull {
+				r.Location = []string{}
+			}
+		case "_location":
+			a := d.array()
+			r.LocationElement = nil
+			for a.next() {
+				r.LocationElement = append(r.LocationElement, d.element())
+			}
+		case "expression":
+			a := d.array()
+			r.Expression = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Expression = append(r.Expression, v)
+			}
+			if r.Expression == nil && !a.isNull {
+				r.Expression = []string{}
+			}
+		case "_expression":
+			a := d.array()
+			r.ExpressionElement = nil
+			for a.next() {
+				r.ExpressionElement = append(r.ExpressionElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the OperationOutcomeIssue which shares no memory with the original
+func (r OperationOutcomeIssue) DeepCopy() OperationOutcomeIssue {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SeverityElement != nil {
+		v := r.SeverityElement.DeepCopy()
+		out.SeverityElement = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Details != nil {
+		v := r.Details.DeepCopy()
+		out.Details = &v
+	}
+	if r.Diagnostics != nil {
+		v := *r.Diagnostics
+		out.Diagnostics = &v
+	}
+	if r.DiagnosticsElement != nil {
+		v := r.DiagnosticsElement.DeepCopy()
+		out.DiagnosticsElement = &v
+	}
+	if r.Location != nil {
+		out.Location = make([]string, len(r.Location))
+		copy(out.Location, r.Location)
+	}
+	if r.LocationElement != nil {
+		out.LocationElement = make([]*Element, len(r.LocationElement))
+		for i, v := range r.LocationElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LocationElement[i] = &c
+			}
+		}
+	}
+	if r.Expression != nil {
+		out.Expression = make([]string, len(r.Expression))
+		copy(out.Expression, r.Expression)
+	}
+	if r.ExpressionElement != nil {
+		out.ExpressionElement = make([]*Element, len(r.ExpressionElement))
+		for i, v := range r.ExpressionElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ExpressionElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r OperationOutcomeIssue) Equal(other OperationOutcomeIssue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Severity != other.Severity {
+		return false
+	}
+	if (r.SeverityElement == nil) != (other.SeverityElement == nil) || r.SeverityElement != nil && !r.SeverityElement.Equal(*other.SeverityElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.Details == nil) != (other.Details == nil) || r.Details != nil && !r.Details.Equal(*other.Details) {
+		return false
+	}
+	if (r.Diagnostics == nil) != (other.Diagnostics == nil) || r.Diagnostics != nil && *r.Diagnostics != *other.Diagnostics {
+		return false
+	}
+	if (r.DiagnosticsElement == nil) != (other.DiagnosticsElement == nil) || r.DiagnosticsElement != nil && !r.DiagnosticsElement.Equal(*other.DiagnosticsElement) {
+		return false
+	}
+	if len(r.Location) != len(other.Location) {
+		return false
+	}
+	for i := range r.Location {
+		if r.Location[i] != other.Location[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LocationElement, other.LocationElement, false) {
+		return false
+	}
+	if len(r.Expression) != len(other.Expression) {
+		return false
+	}
+	for i := range r.Expression {
+		if r.Expression[i] != other.Expression[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ExpressionElement, other.ExpressionElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r OperationOutcomeIssue) EqualsDeep(other OperationOutcomeIssue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Severity != other.Severity {
+		return false
+	}
+	if (r.SeverityElement == nil) != (other.SeverityElement == nil) || r.SeverityElement != nil && !r.SeverityElement.EqualsDeep(*other.SeverityElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.Details == nil) != (other.Details == nil) || r.Details != nil && !r.Details.EqualsDeep(*other.Details) {
+		return false
+	}
+	if (r.Diagnostics == nil) != (other.Diagnostics == nil) || r.Diagnostics != nil && !equivalentString(*r.Diagnostics, *other.Diagnostics) {
+		return false
+	}
+	if (r.DiagnosticsElement == nil) != (other.DiagnosticsElement == nil) || r.DiagnosticsElement != nil && !r.DiagnosticsElement.EqualsDeep(*other.DiagnosticsElement) {
+		return false
+	}
+	if !equivalentList(len(r.Location), len(other.Location), func(i, j int) bool {
+		return equivalentString(r.Location[i], other.Location[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LocationElement, other.LocationElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Expression), len(other.Expression), func(i, j int) bool {
+		return equivalentString(r.Expression[i], other.Expression[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ExpressionElement, other.ExpressionElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r OperationOutcomeIssue) EqualsShallow(other OperationOutcomeIssue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Severity != other.Severity {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.Diagnostics == nil) != (other.Diagnostics == nil) || r.Diagnostics != nil && !equivalentString(*r.Diagnostics, *other.Diagnostics) {
+		return false
+	}
+	if !equivalentList(len(r.Location), len(other.Location), func(i, j int) bool {
+		return equivalentString(r.Location[i], other.Location[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Expression), len(other.Expression), func(i, j int) bool {
+		return equivalentString(r.Expression[i], other.Expression[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given OperationOutcomeIssue as FHIR XML
+func (r OperationOutcomeIssue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "severity", r.Severity, r.SeverityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "details", r.Details); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "diagnostics", r.Diagnostics, r.DiagnosticsElement); err != nil {
+		return err
+	}
+	for i, v := range r.Location {
+		if err := encodeXMLPrimitive(e, "location", v, elementAt(r.LocationElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Expression {
+		if err := encodeXMLPrimitive(e, "expression", v, elementAt(r.ExpressionElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given OperationOutcomeIssue from FHIR XML
+func (r *OperationOutcomeIssue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "severity":
+				var v IssueSeverity
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Severity = v
+				}
+				r.SeverityElement = element
+			case "code":
+				var v IssueType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "details":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Details = &v
+			case "diagnostics":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Diagnostics = &v
+				}
+				r.DiagnosticsElement = element
+			case "location":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LocationElement = appendElement(r.LocationElement, len(r.Location), element)
+					r.Location = append(r.Location, v)
+				}
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ExpressionElement = appendElement(r.ExpressionElement, len(r.Expression), element)
+					r.Expression = append(r.Expression, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the OperationOutcomeIssue as properties to the RDF node n
+func (r OperationOutcomeIssue) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("OperationOutcome.issue.severity", -1, r.Severity, r.SeverityElement, "code")
+	n.primitive("OperationOutcome.issue.code", -1, r.Code, r.CodeElement, "code")
+	n.element("OperationOutcome.issue.details", -1, r.Details)
+	n.primitive("OperationOutcome.issue.diagnostics", -1, r.Diagnostics, r.DiagnosticsElement, "string")
+	for i, v := range r.Location {
+		n.primitive("OperationOutcome.issue.location", i, v, elementAt(r.LocationElement, i), "string")
+	}
+	for i, v := range r.Expression {
+		n.primitive("OperationOutcome.issue.expression", i, v, elementAt(r.ExpressionElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given OperationOutcomeIssue as BSON document with the structure of its FHIR JSON
+func (r OperationOutcomeIssue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given OperationOutcomeIssue from a BSON document with the structure of its FHIR JSON
+func (r *OperationOutcomeIssue) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given OperationOutcomeIssue as protobuf message OperationOutcomeIssue of fhir.proto
+func (r OperationOutcomeIssue) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the OperationOutcomeIssue to the encoded message
+func (r OperationOutcomeIssue) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Severity.protoNumber())
+	e.varint(5, r.Code.protoNumber())
+	if r.Details != nil {
+		e.message(6, *r.Details)
+	}
+	if r.Diagnostics != nil {
+		e.string(7, *r.Diagnostics)
+	}
+	for _, v := range r.Location {
+		e.string(8, v)
+	}
+	for _, v := range r.Expression {
+		e.string(9, v)
+	}
+	if r.SeverityElement != nil {
+		e.message(10, *r.SeverityElement)
+	}
+	if r.CodeElement != nil {
+		e.message(11, *r.CodeElement)
+	}
+	if r.DiagnosticsElement != nil {
+		e.message(12, *r.DiagnosticsElement)
+	}
+	for _, v := range r.LocationElement {
+		e.element(13, v)
+	}
+	for _, v := range r.ExpressionElement {
+		e.element(14, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given OperationOutcomeIssue from protobuf message OperationOutcomeIssue of fhir.proto
+func (r *OperationOutcomeIssue) UnmarshalProto(b []byte) error {
+	*r = OperationOutcomeIssue{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v IssueSeverity
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Severity = v
+		case 5:
+			var v IssueType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Code = v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Details = &v
+		case 7:
+			v := d.string()
+			r.Diagnostics = &v
+		case 8:
+			r.Location = append(r.Location, d.string())
+		case 9:
+			r.Expression = append(r.Expression, d.string())
+		case 10:
+			var v Element
+			d.message(&v)
+			r.SeverityElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.DiagnosticsElement = &v
+		case 13:
+			r.LocationElement = append(r.LocationElement, d.element())
+		case 14:
+			r.ExpressionElement = append(r.ExpressionElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalOperationOutcome unmarshals a OperationOutcome.
 func UnmarshalOperationOutcome(b []byte) (OperationOutcome, error) {
 	var operationOutcome OperationOutcome
-	if err := json.Unmarshal(b, &operationOutcome); err != nil {
+	if err := operationOutcome.UnmarshalJSON(b); err != nil {
 		return operationOutcome, err
 	}
 	return operationOutcome, nil
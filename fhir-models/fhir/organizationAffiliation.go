@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,14 +25,19 @@ import "encoding/json"
 // OrganizationAffiliation is documented here http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation
 type OrganizationAffiliation struct {
 	Id                        *string           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element          `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                      *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules             *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language                  *string           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element          `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                      *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension                 []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension         []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier                []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Active                    *bool             `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement             *Element          `bson:"_active,omitempty" json:"_active,omitempty"`
 	Period                    *Period           `bson:"period,omitempty" json:"period,omitempty"`
 	Organization              *Reference        `bson:"organization,omitempty" json:"organization,omitempty"`
 	ParticipatingOrganization *Reference        `bson:"participatingOrganization,omitempty" json:"participatingOrganization,omitempty"`
@@ -41,23 +49,1254 @@ type OrganizationAffiliation struct {
 	Telecom                   []ContactPoint    `bson:"telecom,omitempty" json:"telecom,omitempty"`
 	Endpoint                  []Reference       `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
 }
-type OtherOrganizationAffiliation OrganizationAffiliation
 
 // MarshalJSON marshals the given OrganizationAffiliation as JSON into a byte slice
 func (r OrganizationAffiliation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherOrganizationAffiliation
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherOrganizationAffiliation: OtherOrganizationAffiliation(r),
-		ResourceType:                 "OrganizationAffiliation",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the OrganizationAffiliation as JSON object with the members in the order of the fields
+func (r OrganizationAffiliation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"OrganizationAffiliation\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Active != nil {
+		e.key("active")
+		e.bool(*r.Active)
+	}
+	if r.ActiveElement != nil {
+		e.key("_active")
+		r.ActiveElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.Organization != nil {
+		e.key("organization")
+		r.Organization.appendJSON(e)
+	}
+	if r.ParticipatingOrganization != nil {
+		e.key("participatingOrganization")
+		r.ParticipatingOrganization.appendJSON(e)
+	}
+	if len(r.Network) > 0 {
+		e.key("network")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Network {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Specialty) > 0 {
+		e.key("specialty")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Specialty {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Location) > 0 {
+		e.key("location")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Location {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.HealthcareService) > 0 {
+		e.key("healthcareService")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.HealthcareService {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endpoint) > 0 {
+		e.key("endpoint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endpoint {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given OrganizationAffiliation from JSON. Unknown members are ignored.
+func (r *OrganizationAffiliation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the OrganizationAffiliation
+func (r *OrganizationAffiliation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "active":
+			if v, ok := d.bool(); ok {
+				r.Active = &v
+			} else {
+				r.Active = nil
+			}
+		case "_active":
+			r.ActiveElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "organization":
+			if d.null() {
+				r.Organization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Organization = &v
+			}
+		case "participatingOrganization":
+			if d.null() {
+				r.ParticipatingOrganization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ParticipatingOrganization = &v
+			}
+		case "network":
+			a := d.array()
+			r.Network = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Network = append(r.Network, v)
+			}
+			if r.Network == nil && !a.isNull {
+				r.Network = []Reference{}
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "specialty":
+			a := d.array()
+			r.Specialty = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Specialty = append(r.Specialty, v)
+			}
+			if r.Specialty == nil && !a.isNull {
+				r.Specialty = []CodeableConcept{}
+			}
+		case "location":
+			a := d.array()
+			r.Location = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = append(r.Location, v)
+			}
+			if r.Location == nil && !a.isNull {
+				r.Location = []Reference{}
+			}
+		case "healthcareService":
+			a := d.array()
+			r.HealthcareService = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.HealthcareService = append(r.HealthcareService, v)
+			}
+			if r.HealthcareService == nil && !a.isNull {
+				r.HealthcareService = []Reference{}
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "endpoint":
+			a := d.array()
+			r.Endpoint = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Endpoint = append(r.Endpoint, v)
+			}
+			if r.Endpoint == nil && !a.isNull {
+				r.Endpoint = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the OrganizationAffiliation which shares no memory with the original
+func (r OrganizationAffiliation) DeepCopy() OrganizationAffiliation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Active != nil {
+		v := *r.Active
+		out.Active = &v
+	}
+	if r.ActiveElement != nil {
+		v := r.ActiveElement.DeepCopy()
+		out.ActiveElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Organization != nil {
+		v := r.Organization.DeepCopy()
+		out.Organization = &v
+	}
+	if r.ParticipatingOrganization != nil {
+		v := r.ParticipatingOrganization.DeepCopy()
+		out.ParticipatingOrganization = &v
+	}
+	if r.Network != nil {
+		out.Network = make([]Reference, len(r.Network))
+		for i := range r.Network {
+			out.Network[i] = r.Network[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Specialty != nil {
+		out.Specialty = make([]CodeableConcept, len(r.Specialty))
+		for i := range r.Specialty {
+			out.Specialty[i] = r.Specialty[i].DeepCopy()
+		}
+	}
+	if r.Location != nil {
+		out.Location = make([]Reference, len(r.Location))
+		for i := range r.Location {
+			out.Location[i] = r.Location[i].DeepCopy()
+		}
+	}
+	if r.HealthcareService != nil {
+		out.HealthcareService = make([]Reference, len(r.HealthcareService))
+		for i := range r.HealthcareService {
+			out.HealthcareService[i] = r.HealthcareService[i].DeepCopy()
+		}
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.Endpoint != nil {
+		out.Endpoint = make([]Reference, len(r.Endpoint))
+		for i := range r.Endpoint {
+			out.Endpoint[i] = r.Endpoint[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r OrganizationAffiliation) Equal(other OrganizationAffiliation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.Equal(*other.ActiveElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.Organization == nil) != (other.Organization == nil) || r.Organization != nil && !r.Organization.Equal(*other.Organization) {
+		return false
+	}
+	if (r.ParticipatingOrganization == nil) != (other.ParticipatingOrganization == nil) || r.ParticipatingOrganization != nil && !r.ParticipatingOrganization.Equal(*other.ParticipatingOrganization) {
+		return false
+	}
+	if len(r.Network) != len(other.Network) {
+		return false
+	}
+	for i := range r.Network {
+		if !r.Network[i].Equal(other.Network[i]) {
+			return false
+		}
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if len(r.Specialty) != len(other.Specialty) {
+		return false
+	}
+	for i := range r.Specialty {
+		if !r.Specialty[i].Equal(other.Specialty[i]) {
+			return false
+		}
+	}
+	if len(r.Location) != len(other.Location) {
+		return false
+	}
+	for i := range r.Location {
+		if !r.Location[i].Equal(other.Location[i]) {
+			return false
+		}
+	}
+	if len(r.HealthcareService) != len(other.HealthcareService) {
+		return false
+	}
+	for i := range r.HealthcareService {
+		if !r.HealthcareService[i].Equal(other.HealthcareService[i]) {
+			return false
+		}
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if len(r.Endpoint) != len(other.Endpoint) {
+		return false
+	}
+	for i := range r.Endpoint {
+		if !r.Endpoint[i].Equal(other.Endpoint[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r OrganizationAffiliation) EqualsDeep(other OrganizationAffiliation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.EqualsDeep(*other.ActiveElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.Organization == nil) != (other.Organization == nil) || r.Organization != nil && !r.Organization.EqualsDeep(*other.Organization) {
+		return false
+	}
+	if (r.ParticipatingOrganization == nil) != (other.ParticipatingOrganization == nil) || r.ParticipatingOrganization != nil && !r.ParticipatingOrganization.EqualsDeep(*other.ParticipatingOrganization) {
+		return false
+	}
+	if !equivalentList(len(r.Network), len(other.Network), func(i, j int) bool {
+		return r.Network[i].EqualsDeep(other.Network[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Specialty), len(other.Specialty), func(i, j int) bool {
+		return r.Specialty[i].EqualsDeep(other.Specialty[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Location), len(other.Location), func(i, j int) bool {
+		return r.Location[i].EqualsDeep(other.Location[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.HealthcareService), len(other.HealthcareService), func(i, j int) bool {
+		return r.HealthcareService[i].EqualsDeep(other.HealthcareService[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endpoint), len(other.Endpoint), func(i, j int) bool {
+		return r.Endpoint[i].EqualsDeep(other.Endpoint[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r OrganizationAffiliation) EqualsShallow(other OrganizationAffiliation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given OrganizationAffiliation as FHIR XML
+func (r OrganizationAffiliation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "OrganizationAffiliation",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "active", r.Active, r.ActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "organization", r.Organization); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "participatingOrganization", r.ParticipatingOrganization); err != nil {
+		return err
+	}
+	for _, v := range r.Network {
+		if err := encodeXMLElement(e, "network", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Specialty {
+		if err := encodeXMLElement(e, "specialty", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Location {
+		if err := encodeXMLElement(e, "location", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.HealthcareService {
+		if err := encodeXMLElement(e, "healthcareService", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endpoint {
+		if err := encodeXMLElement(e, "endpoint", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given OrganizationAffiliation from FHIR XML
+func (r *OrganizationAffiliation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "active":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Active = &v
+				}
+				r.ActiveElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "organization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Organization = &v
+			case "participatingOrganization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParticipatingOrganization = &v
+			case "network":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Network = append(r.Network, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "specialty":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specialty = append(r.Specialty, v)
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = append(r.Location, v)
+			case "healthcareService":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.HealthcareService = append(r.HealthcareService, v)
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "endpoint":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endpoint = append(r.Endpoint, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the OrganizationAffiliation as properties to the RDF node n
+func (r OrganizationAffiliation) turtle(n *turtleNode) {
+	n.resource("OrganizationAffiliation", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("OrganizationAffiliation.identifier", i, v)
+	}
+	n.primitive("OrganizationAffiliation.active", -1, r.Active, r.ActiveElement, "boolean")
+	n.element("OrganizationAffiliation.period", -1, r.Period)
+	n.element("OrganizationAffiliation.organization", -1, r.Organization)
+	n.element("OrganizationAffiliation.participatingOrganization", -1, r.ParticipatingOrganization)
+	for i, v := range r.Network {
+		n.element("OrganizationAffiliation.network", i, v)
+	}
+	for i, v := range r.Code {
+		n.element("OrganizationAffiliation.code", i, v)
+	}
+	for i, v := range r.Specialty {
+		n.element("OrganizationAffiliation.specialty", i, v)
+	}
+	for i, v := range r.Location {
+		n.element("OrganizationAffiliation.location", i, v)
+	}
+	for i, v := range r.HealthcareService {
+		n.element("OrganizationAffiliation.healthcareService", i, v)
+	}
+	for i, v := range r.Telecom {
+		n.element("OrganizationAffiliation.telecom", i, v)
+	}
+	for i, v := range r.Endpoint {
+		n.element("OrganizationAffiliation.endpoint", i, v)
+	}
+}
+
+// MarshalBSON marshals the given OrganizationAffiliation as BSON document with the structure of its FHIR JSON
+func (r OrganizationAffiliation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given OrganizationAffiliation from a BSON document with the structure of its FHIR JSON
+func (r *OrganizationAffiliation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given OrganizationAffiliation as protobuf message OrganizationAffiliation of fhir.proto
+func (r OrganizationAffiliation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the OrganizationAffiliation to the encoded message
+func (r OrganizationAffiliation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Active != nil {
+		e.varint(10, protoBool(*r.Active))
+	}
+	if r.Period != nil {
+		e.message(11, *r.Period)
+	}
+	if r.Organization != nil {
+		e.message(12, *r.Organization)
+	}
+	if r.ParticipatingOrganization != nil {
+		e.message(13, *r.ParticipatingOrganization)
+	}
+	for _, v := range r.Network {
+		e.message(14, v)
+	}
+	for _, v := range r.Code {
+		e.message(15, v)
+	}
+	for _, v := range r.Specialty {
+		e.message(16, v)
+	}
+	for _, v := range r.Location {
+		e.message(17, v)
+	}
+	for _, v := range r.HealthcareService {
+		e.message(18, v)
+	}
+	for _, v := range r.Telecom {
+		e.message(19, v)
+	}
+	for _, v := range r.Endpoint {
+		e.message(20, v)
+	}
+	if r.IdElement != nil {
+		e.message(21, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(22, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(23, *r.LanguageElement)
+	}
+	if r.ActiveElement != nil {
+		e.message(24, *r.ActiveElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given OrganizationAffiliation from protobuf message OrganizationAffiliation of fhir.proto
+func (r *OrganizationAffiliation) UnmarshalProto(b []byte) error {
+	*r = OrganizationAffiliation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.bool()
+			r.Active = &v
+		case 11:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Organization = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.ParticipatingOrganization = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Network = append(r.Network, v)
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.Specialty = append(r.Specialty, v)
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Location = append(r.Location, v)
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.HealthcareService = append(r.HealthcareService, v)
+		case 19:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Endpoint = append(r.Endpoint, v)
+		case 21:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.ActiveElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalOrganizationAffiliation unmarshals a OrganizationAffiliation.
 func UnmarshalOrganizationAffiliation(b []byte) (OrganizationAffiliation, error) {
 	var organizationAffiliation OrganizationAffiliation
-	if err := json.Unmarshal(b, &organizationAffiliation); err != nil {
+	if err := organizationAffiliation.UnmarshalJSON(b); err != nil {
 		return organizationAffiliation, err
 	}
 	return organizationAffiliation, nil
@@ -14,43 +14,69 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Parameters is documented here http://hl7.org/fhir/StructureDefinition/Parameters
 type Parameters struct {
-	Id            *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta          *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language      *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Parameter     []ParametersParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element              `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Parameter            []ParametersParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
 }
 type ParametersParameter struct {
 	Id                       *string               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension                []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension        []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name                     string                `bson:"name" json:"name"`
+	NameElement              *Element              `bson:"_name,omitempty" json:"_name,omitempty"`
 	ValueBase64Binary        *string               `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element              `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
 	ValueBoolean             *bool                 `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element              `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
 	ValueCanonical           *string               `bson:"valueCanonical,omitempty" json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element              `bson:"_valueCanonical,omitempty" json:"_valueCanonical,omitempty"`
 	ValueCode                *string               `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement         *Element              `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
 	ValueDate                *string               `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement         *Element              `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
 	ValueDateTime            *string               `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element              `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
 	ValueDecimal             *json.Number          `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element              `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
 	ValueId                  *string               `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement           *Element              `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
 	ValueInstant             *string               `bson:"valueInstant,omitempty" json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element              `bson:"_valueInstant,omitempty" json:"_valueInstant,omitempty"`
 	ValueInteger             *int                  `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element              `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
 	ValueMarkdown            *string               `bson:"valueMarkdown,omitempty" json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element              `bson:"_valueMarkdown,omitempty" json:"_valueMarkdown,omitempty"`
 	ValueOid                 *string               `bson:"valueOid,omitempty" json:"valueOid,omitempty"`
+	ValueOidElement          *Element              `bson:"_valueOid,omitempty" json:"_valueOid,omitempty"`
 	ValuePositiveInt         *int                  `bson:"valuePositiveInt,omitempty" json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element              `bson:"_valuePositiveInt,omitempty" json:"_valuePositiveInt,omitempty"`
 	ValueString              *string               `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element              `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
 	ValueTime                *string               `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element              `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
 	ValueUnsignedInt         *int                  `bson:"valueUnsignedInt,omitempty" json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element              `bson:"_valueUnsignedInt,omitempty" json:"_valueUnsignedInt,omitempty"`
 	ValueUri                 *string               `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement          *Element              `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
 	ValueUrl                 *string               `bson:"valueUrl,omitempty" json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element              `bson:"_valueUrl,omitempty" json:"_valueUrl,omitempty"`
 	ValueUuid                *string               `bson:"valueUuid,omitempty" json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element              `bson:"_valueUuid,omitempty" json:"_valueUuid,omitempty"`
 	ValueAddress             *Address              `bson:"valueAddress,omitempty" json:"valueAddress,omitempty"`
 	ValueAge                 *Age                  `bson:"valueAge,omitempty" json:"valueAge,omitempty"`
 	ValueAnnotation          *Annotation           `bson:"valueAnnotation,omitempty" json:"valueAnnotation,omitempty"`
@@ -85,23 +111,3817 @@ type ParametersParameter struct {
 	Resource                 json.RawMessage       `bson:"resource,omitempty" json:"resource,omitempty"`
 	Part                     []ParametersParameter `bson:"part,omitempty" json:"part,omitempty"`
 }
-type OtherParameters Parameters
+
+// ParametersParameterValue is implemented by the types allowed for Parameters.parameter.value[x]
+type ParametersParameterValue interface {
+	isParametersParameterValue()
+}
+
+func (Base64Binary) isParametersParameterValue()        {}
+func (Boolean) isParametersParameterValue()             {}
+func (Canonical) isParametersParameterValue()           {}
+func (Code) isParametersParameterValue()                {}
+func (Date) isParametersParameterValue()                {}
+func (DateTime) isParametersParameterValue()            {}
+func (Decimal) isParametersParameterValue()             {}
+func (Id) isParametersParameterValue()                  {}
+func (Instant) isParametersParameterValue()             {}
+func (Integer) isParametersParameterValue()             {}
+func (Markdown) isParametersParameterValue()            {}
+func (Oid) isParametersParameterValue()                 {}
+func (PositiveInt) isParametersParameterValue()         {}
+func (String) isParametersParameterValue()              {}
+func (Time) isParametersParameterValue()                {}
+func (UnsignedInt) isParametersParameterValue()         {}
+func (Uri) isParametersParameterValue()                 {}
+func (Url) isParametersParameterValue()                 {}
+func (Uuid) isParametersParameterValue()                {}
+func (Address) isParametersParameterValue()             {}
+func (Age) isParametersParameterValue()                 {}
+func (Annotation) isParametersParameterValue()          {}
+func (Attachment) isParametersParameterValue()          {}
+func (CodeableConcept) isParametersParameterValue()     {}
+func (Coding) isParametersParameterValue()              {}
+func (ContactPoint) isParametersParameterValue()        {}
+func (Count) isParametersParameterValue()               {}
+func (Distance) isParametersParameterValue()            {}
+func (Duration) isParametersParameterValue()            {}
+func (HumanName) isParametersParameterValue()           {}
+func (Identifier) isParametersParameterValue()          {}
+func (Money) isParametersParameterValue()               {}
+func (Period) isParametersParameterValue()              {}
+func (Quantity) isParametersParameterValue()            {}
+func (Range) isParametersParameterValue()               {}
+func (Ratio) isParametersParameterValue()               {}
+func (Reference) isParametersParameterValue()           {}
+func (SampledData) isParametersParameterValue()         {}
+func (Signature) isParametersParameterValue()           {}
+func (Timing) isParametersParameterValue()              {}
+func (ContactDetail) isParametersParameterValue()       {}
+func (Contributor) isParametersParameterValue()         {}
+func (DataRequirement) isParametersParameterValue()     {}
+func (Expression) isParametersParameterValue()          {}
+func (ParameterDefinition) isParametersParameterValue() {}
+func (RelatedArtifact) isParametersParameterValue()     {}
+func (TriggerDefinition) isParametersParameterValue()   {}
+func (UsageContext) isParametersParameterValue()        {}
+func (Dosage) isParametersParameterValue()              {}
+func (Meta) isParametersParameterValue()                {}
+
+// Value returns the value of Parameters.parameter.value[x] together with its FHIR type code or nil if no value is set
+func (r ParametersParameter) Value() (ParametersParameterValue, string) {
+	if r.ValueBase64Binary != nil {
+		return Base64Binary(*r.ValueBase64Binary), "base64Binary"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueCanonical != nil {
+		return Canonical(*r.ValueCanonical), "canonical"
+	}
+	if r.ValueCode != nil {
+		return Code(*r.ValueCode), "code"
+	}
+	if r.ValueDate != nil {
+		return Date(*r.ValueDate), "date"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValueDecimal != nil {
+		return Decimal(*r.ValueDecimal), "decimal"
+	}
+	if r.ValueId != nil {
+		return Id(*r.ValueId), "id"
+	}
+	if r.ValueInstant != nil {
+		return Instant(*r.ValueInstant), "instant"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueMarkdown != nil {
+		return Markdown(*r.ValueMarkdown), "markdown"
+	}
+	if r.ValueOid != nil {
+		return Oid(*r.ValueOid), "oid"
+	}
+	if r.ValuePositiveInt != nil {
+		return PositiveInt(*r.ValuePositiveInt), "positiveInt"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueTime != nil {
+		return Time(*r.ValueTime), "time"
+	}
+	if r.ValueUnsignedInt != nil {
+		return UnsignedInt(*r.ValueUnsignedInt), "unsignedInt"
+	}
+	if r.ValueUri != nil {
+		return Uri(*r.ValueUri), "uri"
+	}
+	if r.ValueUrl != nil {
+		return Url(*r.ValueUrl), "url"
+	}
+	if r.ValueUuid != nil {
+		return Uuid(*r.ValueUuid), "uuid"
+	}
+	if r.ValueAddress != nil {
+		return *r.ValueAddress, "Address"
+	}
+	if r.ValueAge != nil {
+		return *r.ValueAge, "Age"
+	}
+	if r.ValueAnnotation != nil {
+		return *r.ValueAnnotation, "Annotation"
+	}
+	if r.ValueAttachment != nil {
+		return *r.ValueAttachment, "Attachment"
+	}
+	if r.ValueCodeableConcept != nil {
+		return *r.ValueCodeableConcept, "CodeableConcept"
+	}
+	if r.ValueCoding != nil {
+		return *r.ValueCoding, "Coding"
+	}
+	if r.ValueContactPoint != nil {
+		return *r.ValueContactPoint, "ContactPoint"
+	}
+	if r.ValueCount != nil {
+		return *r.ValueCount, "Count"
+	}
+	if r.ValueDistance != nil {
+		return *r.ValueDistance, "Distance"
+	}
+	if r.ValueDuration != nil {
+		return *r.ValueDuration, "Duration"
+	}
+	if r.ValueHumanName != nil {
+		return *r.ValueHumanName, "HumanName"
+	}
+	if r.ValueIdentifier != nil {
+		return *r.ValueIdentifier, "Identifier"
+	}
+	if r.ValueMoney != nil {
+		return *r.ValueMoney, "Money"
+	}
+	if r.ValuePeriod != nil {
+		return *r.ValuePeriod, "Period"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueRange != nil {
+		return *r.ValueRange, "Range"
+	}
+	if r.ValueRatio != nil {
+		return *r.ValueRatio, "Ratio"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	if r.ValueSampledData != nil {
+		return *r.ValueSampledData, "SampledData"
+	}
+	if r.ValueSignature != nil {
+		return *r.ValueSignature, "Signature"
+	}
+	if r.ValueTiming != nil {
+		return *r.ValueTiming, "Timing"
+	}
+	if r.ValueContactDetail != nil {
+		return *r.ValueContactDetail, "ContactDetail"
+	}
+	if r.ValueContributor != nil {
+		return *r.ValueContributor, "Contributor"
+	}
+	if r.ValueDataRequirement != nil {
+		return *r.ValueDataRequirement, "DataRequirement"
+	}
+	if r.ValueExpression != nil {
+		return *r.ValueExpression, "Expression"
+	}
+	if r.ValueParameterDefinition != nil {
+		return *r.ValueParameterDefinition, "ParameterDefinition"
+	}
+	if r.ValueRelatedArtifact != nil {
+		return *r.ValueRelatedArtifact, "RelatedArtifact"
+	}
+	if r.ValueTriggerDefinition != nil {
+		return *r.ValueTriggerDefinition, "TriggerDefinition"
+	}
+	if r.ValueUsageContext != nil {
+		return *r.ValueUsageContext, "UsageContext"
+	}
+	if r.ValueDosage != nil {
+		return *r.ValueDosage, "Dosage"
+	}
+	if r.ValueMeta != nil {
+		return *r.ValueMeta, "Meta"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Parameters.parameter.value[x], given as value or pointer, and clears all other types
+func (r *ParametersParameter) SetValue(value ParametersParameterValue) {
+	r.ValueBase64Binary = nil
+	r.ValueBoolean = nil
+	r.ValueCanonical = nil
+	r.ValueCode = nil
+	r.ValueDate = nil
+	r.ValueDateTime = nil
+	r.ValueDecimal = nil
+	r.ValueId = nil
+	r.ValueInstant = nil
+	r.ValueInteger = nil
+	r.ValueMarkdown = nil
+	r.ValueOid = nil
+	r.ValuePositiveInt = nil
+	r.ValueString = nil
+	r.ValueTime = nil
+	r.ValueUnsignedInt = nil
+	r.ValueUri = nil
+	r.ValueUrl = nil
+	r.ValueUuid = nil
+	r.ValueAddress = nil
+	r.ValueAge = nil
+	r.ValueAnnotation = nil
+	r.ValueAttachment = nil
+	r.ValueCodeableConcept = nil
+	r.ValueCoding = nil
+	r.ValueContactPoint = nil
+	r.ValueCount = nil
+	r.ValueDistance = nil
+	r.ValueDuration = nil
+	r.ValueHumanName = nil
+	r.ValueIdentifier = nil
+	r.ValueMoney = nil
+	r.ValuePeriod = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueReference = nil
+	r.ValueSampledData = nil
+	r.ValueSignature = nil
+	r.ValueTiming = nil
+	r.ValueContactDetail = nil
+	r.ValueContributor = nil
+	r.ValueDataRequirement = nil
+	r.ValueExpression = nil
+	r.ValueParameterDefinition = nil
+	r.ValueRelatedArtifact = nil
+	r.ValueTriggerDefinition = nil
+	r.ValueUsageContext = nil
+	r.ValueDosage = nil
+	r.ValueMeta = nil
+	switch v := value.(type) {
+	case Base64Binary:
+		primitive := string(v)
+		r.ValueBase64Binary = &primitive
+	case *Base64Binary:
+		r.ValueBase64Binary = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.ValueCanonical = &primitive
+	case *Canonical:
+		r.ValueCanonical = (*string)(v)
+	case Code:
+		primitive := string(v)
+		r.ValueCode = &primitive
+	case *Code:
+		r.ValueCode = (*string)(v)
+	case Date:
+		primitive := string(v)
+		r.ValueDate = &primitive
+	case *Date:
+		r.ValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.ValueDecimal = &primitive
+	case *Decimal:
+		r.ValueDecimal = (*json.Number)(v)
+	case Id:
+		primitive := string(v)
+		r.ValueId = &primitive
+	case *Id:
+		r.ValueId = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.ValueInstant = &primitive
+	case *Instant:
+		r.ValueInstant = (*string)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Markdown:
+		primitive := string(v)
+		r.ValueMarkdown = &primitive
+	case *Markdown:
+		r.ValueMarkdown = (*string)(v)
+	case Oid:
+		primitive := string(v)
+		r.ValueOid = &primitive
+	case *Oid:
+		r.ValueOid = (*string)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.ValuePositiveInt = &primitive
+	case *PositiveInt:
+		r.ValuePositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.ValueTime = &primitive
+	case *Time:
+		r.ValueTime = (*string)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.ValueUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.ValueUnsignedInt = (*int)(v)
+	case Uri:
+		primitive := string(v)
+		r.ValueUri = &primitive
+	case *Uri:
+		r.ValueUri = (*string)(v)
+	case Url:
+		primitive := string(v)
+		r.ValueUrl = &primitive
+	case *Url:
+		r.ValueUrl = (*string)(v)
+	case Uuid:
+		primitive := string(v)
+		r.ValueUuid = &primitive
+	case *Uuid:
+		r.ValueUuid = (*string)(v)
+	case Address:
+		r.ValueAddress = &v
+	case *Address:
+		r.ValueAddress = v
+	case Age:
+		r.ValueAge = &v
+	case *Age:
+		r.ValueAge = v
+	case Annotation:
+		r.ValueAnnotation = &v
+	case *Annotation:
+		r.ValueAnnotation = v
+	case Attachment:
+		r.ValueAttachment = &v
+	case *Attachment:
+		r.ValueAttachment = v
+	case CodeableConcept:
+		r.ValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.ValueCodeableConcept = v
+	case Coding:
+		r.ValueCoding = &v
+	case *Coding:
+		r.ValueCoding = v
+	case ContactPoint:
+		r.ValueContactPoint = &v
+	case *ContactPoint:
+		r.ValueContactPoint = v
+	case Count:
+		r.ValueCount = &v
+	case *Count:
+		r.ValueCount = v
+	case Distance:
+		r.ValueDistance = &v
+	case *Distance:
+		r.ValueDistance = v
+	case Duration:
+		r.ValueDuration = &v
+	case *Duration:
+		r.ValueDuration = v
+	case HumanName:
+		r.ValueHumanName = &v
+	case *HumanName:
+		r.ValueHumanName = v
+	case Identifier:
+		r.ValueIdentifier = &v
+	case *Identifier:
+		r.ValueIdentifier = v
+	case Money:
+		r.ValueMoney = &v
+	case *Money:
+		r.ValueMoney = v
+	case Period:
+		r.ValuePeriod = &v
+	case *Period:
+		r.ValuePeriod = v
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Range:
+		r.ValueRange = &v
+	case *Range:
+		r.ValueRange = v
+	case Ratio:
+		r.ValueRatio = &v
+	case *Ratio:
+		r.ValueRatio = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	case SampledData:
+		r.ValueSampledData = &v
+	case *SampledData:
+		r.ValueSampledData = v
+	case Signature:
+		r.ValueSignature = &v
+	case *Signature:
+		r.ValueSignature = v
+	case Timing:
+		r.ValueTiming = &v
+	case *Timing:
+		r.ValueTiming = v
+	case ContactDetail:
+		r.ValueContactDetail = &v
+	case *ContactDetail:
+		r.ValueContactDetail = v
+	case Contributor:
+		r.ValueContributor = &v
+	case *Contributor:
+		r.ValueContributor = v
+	case DataRequirement:
+		r.ValueDataRequirement = &v
+	case *DataRequirement:
+		r.ValueDataRequirement = v
+	case Expression:
+		r.ValueExpression = &v
+	case *Expression:
+		r.ValueExpression = v
+	case ParameterDefinition:
+		r.ValueParameterDefinition = &v
+	case *ParameterDefinition:
+		r.ValueParameterDefinition = v
+	case RelatedArtifact:
+		r.ValueRelatedArtifact = &v
+	case *RelatedArtifact:
+		r.ValueRelatedArtifact = v
+	case TriggerDefinition:
+		r.ValueTriggerDefinition = &v
+	case *TriggerDefinition:
+		r.ValueTriggerDefinition = v
+	case UsageContext:
+		r.ValueUsageContext = &v
+	case *UsageContext:
+		r.ValueUsageContext = v
+	case Dosage:
+		r.ValueDosage = &v
+	case *Dosage:
+		r.ValueDosage = v
+	case Meta:
+		r.ValueMeta = &v
+	case *Meta:
+		r.ValueMeta = v
+	}
+}
 
 // MarshalJSON marshals the given Parameters as JSON into a byte slice
 func (r Parameters) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherParameters
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherParameters: OtherParameters(r),
-		ResourceType:    "Parameters",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Parameters as JSON object with the members in the order of the fields
+func (r Parameters) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Parameters\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if len(r.Parameter) > 0 {
+		e.key("parameter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parameter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Parameters from JSON. Unknown members are ignored.
+func (r *Parameters) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Parameters
+func (r *Parameters) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "parameter":
+			a := d.array()
+			r.Parameter = nil
+			for a.next() {
+				var v ParametersParameter
+				v.decodeJSON(d)
+				r.Parameter = append(r.Parameter, v)
+			}
+			if r.Parameter == nil && !a.isNull {
+				r.Parameter = []ParametersParameter{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Parameters which shares no memory with the original
+func (r Parameters) DeepCopy() Parameters {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Parameter != nil {
+		out.Parameter = make([]ParametersParameter, len(r.Parameter))
+		for i := range r.Parameter {
+			out.Parameter[i] = r.Parameter[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Parameters) Equal(other Parameters) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if len(r.Parameter) != len(other.Parameter) {
+		return false
+	}
+	for i := range r.Parameter {
+		if !r.Parameter[i].Equal(other.Parameter[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Parameters) EqualsDeep(other Parameters) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if !equivalentList(len(r.Parameter), len(other.Parameter), func(i, j int) bool {
+		return r.Parameter[i].EqualsDeep(other.Parameter[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Parameters) EqualsShallow(other Parameters) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Parameters as FHIR XML
+func (r Parameters) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Parameters",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	for _, v := range r.Parameter {
+		if err := encodeXMLElement(e, "parameter", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Parameters from FHIR XML
+func (r *Parameters) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "parameter":
+				var v ParametersParameter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parameter = append(r.Parameter, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Parameters as properties to the RDF node n
+func (r Parameters) turtle(n *turtleNode) {
+	n.resource("Parameters", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	for i, v := range r.Parameter {
+		n.element("Parameters.parameter", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Parameters as BSON document with the structure of its FHIR JSON
+func (r Parameters) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Parameters from a BSON document with the structure of its FHIR JSON
+func (r *Parameters) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Parameters as protobuf message Parameters of fhir.proto
+func (r Parameters) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Parameters to the encoded message
+func (r Parameters) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	for _, v := range r.Parameter {
+		e.message(5, v)
+	}
+	if r.IdElement != nil {
+		e.message(6, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(7, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(8, *r.LanguageElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Parameters from protobuf message Parameters of fhir.proto
+func (r *Parameters) UnmarshalProto(b []byte) error {
+	*r = Parameters{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v ParametersParameter
+			d.message(&v)
+			r.Parameter = append(r.Parameter, v)
+		case 6:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ParametersParameter as JSON into a byte slice
+func (r ParametersParameter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ParametersParameter as JSON object with the members in the order of the fields
+func (r ParametersParameter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.ValueBase64Binary != nil {
+		e.key("valueBase64Binary")
+		e.string(*r.ValueBase64Binary)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.key("_valueBase64Binary")
+		r.ValueBase64BinaryElement.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueCanonical != nil {
+		e.key("valueCanonical")
+		e.string(*r.ValueCanonical)
+	}
+	if r.ValueCanonicalElement != nil {
+		e.key("_valueCanonical")
+		r.ValueCanonicalElement.appendJSON(e)
+	}
+	if r.ValueCode != nil {
+		e.key("valueCode")
+		e.string(*r.ValueCode)
+	}
+	if r.ValueCodeElement != nil {
+		e.key("_valueCode")
+		r.ValueCodeElement.appendJSON(e)
+	}
+	if r.ValueDate != nil {
+		e.key("valueDate")
+		e.string(*r.ValueDate)
+	}
+	if r.ValueDateElement != nil {
+		e.key("_valueDate")
+		r.ValueDateElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValueDecimal != nil {
+		e.key("valueDecimal")
+		e.number(*r.ValueDecimal)
+	}
+	if r.ValueDecimalElement != nil {
+		e.key("_valueDecimal")
+		r.ValueDecimalElement.appendJSON(e)
+	}
+	if r.ValueId != nil {
+		e.key("valueId")
+		e.string(*r.ValueId)
+	}
+	if r.ValueIdElement != nil {
+		e.key("_valueId")
+		r.ValueIdElement.appendJSON(e)
+	}
+	if r.ValueInstant != nil {
+		e.key("valueInstant")
+		e.string(*r.ValueInstant)
+	}
+	if r.ValueInstantElement != nil {
+		e.key("_valueInstant")
+		r.ValueInstantElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueMarkdown != nil {
+		e.key("valueMarkdown")
+		e.string(*r.ValueMarkdown)
+	}
+	if r.ValueMarkdownElement != nil {
+		e.key("_valueMarkdown")
+		r.ValueMarkdownElement.appendJSON(e)
+	}
+	if r.ValueOid != nil {
+		e.key("valueOid")
+		e.string(*r.ValueOid)
+	}
+	if r.ValueOidElement != nil {
+		e.key("_valueOid")
+		r.ValueOidElement.appendJSON(e)
+	}
+	if r.ValuePositiveInt != nil {
+		e.key("valuePositiveInt")
+		e.int(*r.ValuePositiveInt)
+	}
+	if r.ValuePositiveIntElement != nil {
+		e.key("_valuePositiveInt")
+		r.ValuePositiveIntElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueTime != nil {
+		e.key("valueTime")
+		e.string(*r.ValueTime)
+	}
+	if r.ValueTimeElement != nil {
+		e.key("_valueTime")
+		r.ValueTimeElement.appendJSON(e)
+	}
+	if r.ValueUnsignedInt != nil {
+		e.key("valueUnsignedInt")
+		e.int(*r.ValueUnsignedInt)
+	}
+	if r.ValueUnsignedIntElement != nil {
+		e.key("_valueUnsignedInt")
+		r.ValueUnsignedIntElement.appendJSON(e)
+	}
+	if r.ValueUri != nil {
+		e.key("valueUri")
+		e.string(*r.ValueUri)
+	}
+	if r.ValueUriElement != nil {
+		e.key("_valueUri")
+		r.ValueUriElement.appendJSON(e)
+	}
+	if r.ValueUrl != nil {
+		e.key("valueUrl")
+		e.string(*r.ValueUrl)
+	}
+	if r.ValueUrlElement != nil {
+		e.key("_valueUrl")
+		r.ValueUrlElement.appendJSON(e)
+	}
+	if r.ValueUuid != nil {
+		e.key("valueUuid")
+		e.string(*r.ValueUuid)
+	}
+	if r.ValueUuidElement != nil {
+		e.key("_valueUuid")
+		r.ValueUuidElement.appendJSON(e)
+	}
+	if r.ValueAddress != nil {
+		e.key("valueAddress")
+		r.ValueAddress.appendJSON(e)
+	}
+	if r.ValueAge != nil {
+		e.key("valueAge")
+		r.ValueAge.appendJSON(e)
+	}
+	if r.ValueAnnotation != nil {
+		e.key("valueAnnotation")
+		r.ValueAnnotation.appendJSON(e)
+	}
+	if r.ValueAttachment != nil {
+		e.key("valueAttachment")
+		r.ValueAttachment.appendJSON(e)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.key("valueCodeableConcept")
+		r.ValueCodeableConcept.appendJSON(e)
+	}
+	if r.ValueCoding != nil {
+		e.key("valueCoding")
+		r.ValueCoding.appendJSON(e)
+	}
+	if r.ValueContactPoint != nil {
+		e.key("valueContactPoint")
+		r.ValueContactPoint.appendJSON(e)
+	}
+	if r.ValueCount != nil {
+		e.key("valueCount")
+		r.ValueCount.appendJSON(e)
+	}
+	if r.ValueDistance != nil {
+		e.key("valueDistance")
+		r.ValueDistance.appendJSON(e)
+	}
+	if r.ValueDuration != nil {
+		e.key("valueDuration")
+		r.ValueDuration.appendJSON(e)
+	}
+	if r.ValueHumanName != nil {
+		e.key("valueHumanName")
+		r.ValueHumanName.appendJSON(e)
+	}
+	if r.ValueIdentifier != nil {
+		e.key("valueIdentifier")
+		r.ValueIdentifier.appendJSON(e)
+	}
+	if r.ValueMoney != nil {
+		e.key("valueMoney")
+		r.ValueMoney.appendJSON(e)
+	}
+	if r.ValuePeriod != nil {
+		e.key("valuePeriod")
+		r.ValuePeriod.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueRange != nil {
+		e.key("valueRange")
+		r.ValueRange.appendJSON(e)
+	}
+	if r.ValueRatio != nil {
+		e.key("valueRatio")
+		r.ValueRatio.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	if r.ValueSampledData != nil {
+		e.key("valueSampledData")
+		r.ValueSampledData.appendJSON(e)
+	}
+	if r.ValueSignature != nil {
+		e.key("valueSignature")
+		r.ValueSignature.appendJSON(e)
+	}
+	if r.ValueTiming != nil {
+		e.key("valueTiming")
+		r.ValueTiming.appendJSON(e)
+	}
+	if r.ValueContactDetail != nil {
+		e.key("valueContactDetail")
+		r.ValueContactDetail.appendJSON(e)
+	}
+	if r.ValueContributor != nil {
+		e.key("valueContributor")
+		r.ValueContributor.appendJSON(e)
+	}
+	if r.ValueDataRequirement != nil {
+		e.key("valueDataRequirement")
+		r.ValueDataRequirement.appendJSON(e)
+	}
+	if r.ValueExpression != nil {
+		e.key("valueExpression")
+		r.ValueExpression.appendJSON(e)
+	}
+	if r.ValueParameterDefinition != nil {
+		e.key("valueParameterDefinition")
+		r.ValueParameterDefinition.appendJSON(e)
+	}
+	if r.ValueRelatedArtifact != nil {
+		e.key("valueRelatedArtifact")
+		r.ValueRelatedArtifact.appendJSON(e)
+	}
+	if r.ValueTriggerDefinition != nil {
+		e.key("valueTriggerDefinition")
+		r.ValueTriggerDefinition.appendJSON(e)
+	}
+	if r.ValueUsageContext != nil {
+		e.key("valueUsageContext")
+		r.ValueUsageContext.appendJSON(e)
+	}
+	if r.ValueDosage != nil {
+		e.key("valueDosage")
+		r.ValueDosage.appendJSON(e)
+	}
+	if r.ValueMeta != nil {
+		e.key("valueMeta")
+		r.ValueMeta.appendJSON(e)
+	}
+	if len(r.Resource) > 0 {
+		e.key("resource")
+		e.raw(r.Resource)
+	}
+	if len(r.Part) > 0 {
+		e.key("part")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Part {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ParametersParameter from JSON. Unknown members are ignored.
+func (r *ParametersParameter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ParametersParameter
+func (r *ParametersParameter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "valueBase64Binary":
+			if v, ok := d.string(); ok {
+				r.ValueBase64Binary = &v
+			} else {
+				r.ValueBase64Binary = nil
+			}
+		case "_valueBase64Binary":
+			r.ValueBase64BinaryElement = d.element()
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueCanonical":
+			if v, ok := d.string(); ok {
+				r.ValueCanonical = &v
+			} else {
+				r.ValueCanonical = nil
+			}
+		case "_valueCanonical":
+			r.ValueCanonicalElement = d.element()
+		case "valueCode":
+			if v, ok := d.string(); ok {
+				r.ValueCode = &v
+			} else {
+				r.ValueCode = nil
+			}
+		case "_valueCode":
+			r.ValueCodeElement = d.element()
+		case "valueDate":
+			if v, ok := d.string(); ok {
+				r.ValueDate = &v
+			} else {
+				r.ValueDate = nil
+			}
+		case "_valueDate":
+			r.ValueDateElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valueDecimal":
+			if v, ok := d.number(); ok {
+				r.ValueDecimal = &v
+			} else {
+				r.ValueDecimal = nil
+			}
+		case "_valueDecimal":
+			r.ValueDecimalElement = d.element()
+		case "valueId":
+			if v, ok := d.string(); ok {
+				r.ValueId = &v
+			} else {
+				r.ValueId = nil
+			}
+		case "_valueId":
+			r.ValueIdElement = d.element()
+		case "valueInstant":
+			if v, ok := d.string(); ok {
+				r.ValueInstant = &v
+			} else {
+				r.ValueInstant = nil
+			}
+		case "_valueInstant":
+			r.ValueInstantElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueMarkdown":
+			if v, ok := d.string(); ok {
+				r.ValueMarkdown = &v
+			} else {
+				r.ValueMarkdown = nil
+			}
+		case "_valueMarkdown":
+			r.ValueMarkdownElement = d.element()
+		case "valueOid":
+			if v, ok := d.string(); ok {
+				r.ValueOid = &v
+			} else {
+				r.ValueOid = nil
+			}
+		case "_valueOid":
+			r.ValueOidElement = d.element()
+		case "valuePositiveInt":
+			if v, ok := d.int(); ok {
+				r.ValuePositiveInt = &v
+			} else {
+				r.ValuePositiveInt = nil
+			}
+		case "_valuePositiveInt":
+			r.ValuePositiveIntElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueTime":
+			if v, ok := d.string(); ok {
+				r.ValueTime = &v
+			} else {
+				r.ValueTime = nil
+			}
+		case "_valueTime":
+			r.ValueTimeElement = d.element()
+		case "valueUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.ValueUnsignedInt = &v
+			} else {
+				r.ValueUnsignedInt = nil
+			}
+		case "_valueUnsignedInt":
+			r.ValueUnsignedIntElement = d.element()
+		case "valueUri":
+			if v, ok := d.string(); ok {
+				r.ValueUri = &v
+			} else {
+				r.ValueUri = nil
+			}
+		case "_valueUri":
+			r.ValueUriElement = d.element()
+		case "valueUrl":
+			if v, ok := d.string(); ok {
+				r.ValueUrl = &v
+			} else {
+				r.ValueUrl = nil
+			}
+		case "_valueUrl":
+			r.ValueUrlElement = d.element()
+		case "valueUuid":
+			if v, ok := d.string(); ok {
+				r.ValueUuid = &v
+			} else {
+				r.ValueUuid = nil
+			}
+		case "_valueUuid":
+			r.ValueUuidElement = d.element()
+		case "valueAddress":
+			if d.null() {
+				r.ValueAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.ValueAddress = &v
+			}
+		case "valueAge":
+			if d.null() {
+				r.ValueAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.ValueAge = &v
+			}
+		case "valueAnnotation":
+			if d.null() {
+				r.ValueAnnotation = nil
+			} else {
+				var v Annotation
+				v.decodeJSON(d)
+				r.ValueAnnotation = &v
+			}
+		case "valueAttachment":
+			if d.null() {
+				r.ValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ValueAttachment = &v
+			}
+		case "valueCodeableConcept":
+			if d.null() {
+				r.ValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCodeableConcept = &v
+			}
+		case "valueCoding":
+			if d.null() {
+				r.ValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.ValueCoding = &v
+			}
+		case "valueContactPoint":
+			if d.null() {
+				r.ValueContactPoint = nil
+			} else {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.ValueContactPoint = &v
+			}
+		case "valueCount":
+			if d.null() {
+				r.ValueCount = nil
+			} else {
+				var v Count
+				v.decodeJSON(d)
+				r.ValueCount = &v
+			}
+		case "valueDistance":
+			if d.null() {
+				r.ValueDistance = nil
+			} else {
+				var v Distance
+				v.decodeJSON(d)
+				r.ValueDistance = &v
+			}
+		case "valueDuration":
+			if d.null() {
+				r.ValueDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.ValueDuration = &v
+			}
+		case "valueHumanName":
+			if d.null() {
+				r.ValueHumanName = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.ValueHumanName = &v
+			}
+		case "valueIdentifier":
+			if d.null() {
+				r.ValueIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.ValueIdentifier = &v
+			}
+		case "valueMoney":
+			if d.null() {
+				r.ValueMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.ValueMoney = &v
+			}
+		case "valuePeriod":
+			if d.null() {
+				r.ValuePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValuePeriod = &v
+			}
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueRange":
+			if d.null() {
+				r.ValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.ValueRange = &v
+			}
+		case "valueRatio":
+			if d.null() {
+				r.ValueRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.ValueRatio = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		case "valueSampledData":
+			if d.null() {
+				r.ValueSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.ValueSampledData = &v
+			}
+		case "valueSignature":
+			if d.null() {
+				r.ValueSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.ValueSignature = &v
+			}
+		case "valueTiming":
+			if d.null() {
+				r.ValueTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.ValueTiming = &v
+			}
+		case "valueContactDetail":
+			if d.null() {
+				r.ValueContactDetail = nil
+			} else {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.ValueContactDetail = &v
+			}
+		case "valueContributor":
+			if d.null() {
+				r.ValueContributor = nil
+			} else {
+				var v Contributor
+				v.decodeJSON(d)
+				r.ValueContributor = &v
+			}
+		case "valueDataRequirement":
+			if d.null() {
+				r.ValueDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.ValueDataRequirement = &v
+			}
+		case "valueExpression":
+			if d.null() {
+				r.ValueExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.ValueExpression = &v
+			}
+		case "valueParameterDefinition":
+			if d.null() {
+				r.ValueParameterDefinition = nil
+			} else {
+				var v ParameterDefinition
+				v.decodeJSON(d)
+				r.ValueParameterDefinition = &v
+			}
+		case "valueRelatedArtifact":
+			if d.null() {
+				r.ValueRelatedArtifact = nil
+			} else {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.ValueRelatedArtifact = &v
+			}
+		case "valueTriggerDefinition":
+			if d.null() {
+				r.ValueTriggerDefinition = nil
+			} else {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.ValueTriggerDefinition = &v
+			}
+		case "valueUsageContext":
+			if d.null() {
+				r.ValueUsageContext = nil
+			} else {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.ValueUsageContext = &v
+			}
+		case "valueDosage":
+			if d.null() {
+				r.ValueDosage = nil
+			} else {
+				var v Dosage
+				v.decodeJSON(d)
+				r.ValueDosage = &v
+			}
+		case "valueMeta":
+			if d.null() {
+				r.ValueMeta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.ValueMeta = &v
+			}
+		case "resource":
+			r.Resource = d.raw()
+		case "part":
+			a := d.array()
+			r.Part = nil
+			for a.next() {
+				var v ParametersParameter
+				v.decodeJSON(d)
+				r.Part = append(r.Part, v)
+			}
+			if r.Part == nil && !a.isNull {
+				r.Part = []ParametersParameter{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ParametersParameter which shares no memory with the original
+func (r ParametersParameter) DeepCopy() ParametersParameter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.ValueBase64Binary != nil {
+		v := *r.ValueBase64Binary
+		out.ValueBase64Binary = &v
+	}
+	if r.ValueBase64BinaryElement != nil {
+		v := r.ValueBase64BinaryElement.DeepCopy()
+		out.ValueBase64BinaryElement = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueCanonical != nil {
+		v := *r.ValueCanonical
+		out.ValueCanonical = &v
+	}
+	if r.ValueCanonicalElement != nil {
+		v := r.ValueCanonicalElement.DeepCopy()
+		out.ValueCanonicalElement = &v
+	}
+	if r.ValueCode != nil {
+		v := *r.ValueCode
+		out.ValueCode = &v
+	}
+	if r.ValueCodeElement != nil {
+		v := r.ValueCodeElement.DeepCopy()
+		out.ValueCodeElement = &v
+	}
+	if r.ValueDate != nil {
+		v := *r.ValueDate
+		out.ValueDate = &v
+	}
+	if r.ValueDateElement != nil {
+		v := r.ValueDateElement.DeepCopy()
+		out.ValueDateElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValueDecimal != nil {
+		v := *r.ValueDecimal
+		out.ValueDecimal = &v
+	}
+	if r.ValueDecimalElement != nil {
+		v := r.ValueDecimalElement.DeepCopy()
+		out.ValueDecimalElement = &v
+	}
+	if r.ValueId != nil {
+		v := *r.ValueId
+		out.ValueId = &v
+	}
+	if r.ValueIdElement != nil {
+		v := r.ValueIdElement.DeepCopy()
+		out.ValueIdElement = &v
+	}
+	if r.ValueInstant != nil {
+		v := *r.ValueInstant
+		out.ValueInstant = &v
+	}
+	if r.ValueInstantElement != nil {
+		v := r.ValueInstantElement.DeepCopy()
+		out.ValueInstantElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueMarkdown != nil {
+		v := *r.ValueMarkdown
+		out.ValueMarkdown = &v
+	}
+	if r.ValueMarkdownElement != nil {
+		v := r.ValueMarkdownElement.DeepCopy()
+		out.ValueMarkdownElement = &v
+	}
+	if r.ValueOid != nil {
+		v := *r.ValueOid
+		out.ValueOid = &v
+	}
+	if r.ValueOidElement != nil {
+		v := r.ValueOidElement.DeepCopy()
+		out.ValueOidElement = &v
+	}
+	if r.ValuePositiveInt != nil {
+		v := *r.ValuePositiveInt
+		out.ValuePositiveInt = &v
+	}
+	if r.ValuePositiveIntElement != nil {
+		v := r.ValuePositiveIntElement.DeepCopy()
+		out.ValuePositiveIntElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueTime != nil {
+		v := *r.ValueTime
+		out.ValueTime = &v
+	}
+	if r.ValueTimeElement != nil {
+		v := r.ValueTimeElement.DeepCopy()
+		out.ValueTimeElement = &v
+	}
+	if r.ValueUnsignedInt != nil {
+		v := *r.ValueUnsignedInt
+		out.ValueUnsignedInt = &v
+	}
+	if r.ValueUnsignedIntElement != nil {
+		v := r.ValueUnsignedIntElement.DeepCopy()
+		out.ValueUnsignedIntElement = &v
+	}
+	if r.ValueUri != nil {
+		v := *r.ValueUri
+		out.ValueUri = &v
+	}
+	if r.ValueUriElement != nil {
+		v := r.ValueUriElement.DeepCopy()
+		out.ValueUriElement = &v
+	}
+	if r.ValueUrl != nil {
+		v := *r.ValueUrl
+		out.ValueUrl = &v
+	}
+	if r.ValueUrlElement != nil {
+		v := r.ValueUrlElement.DeepCopy()
+		out.ValueUrlElement = &v
+	}
+	if r.ValueUuid != nil {
+		v := *r.ValueUuid
+		out.ValueUuid = &v
+	}
+	if r.ValueUuidElement != nil {
+		v := r.ValueUuidElement.DeepCopy()
+		out.ValueUuidElement = &v
+	}
+	if r.ValueAddress != nil {
+		v := r.ValueAddress.DeepCopy()
+		out.ValueAddress = &v
+	}
+	if r.ValueAge != nil {
+		v := r.ValueAge.DeepCopy()
+		out.ValueAge = &v
+	}
+	if r.ValueAnnotation != nil {
+		v := r.ValueAnnotation.DeepCopy()
+		out.ValueAnnotation = &v
+	}
+	if r.ValueAttachment != nil {
+		v := r.ValueAttachment.DeepCopy()
+		out.ValueAttachment = &v
+	}
+	if r.ValueCodeableConcept != nil {
+		v := r.ValueCodeableConcept.DeepCopy()
+		out.ValueCodeableConcept = &v
+	}
+	if r.ValueCoding != nil {
+		v := r.ValueCoding.DeepCopy()
+		out.ValueCoding = &v
+	}
+	if r.ValueContactPoint != nil {
+		v := r.ValueContactPoint.DeepCopy()
+		out.ValueContactPoint = &v
+	}
+	if r.ValueCount != nil {
+		v := r.ValueCount.DeepCopy()
+		out.ValueCount = &v
+	}
+	if r.ValueDistance != nil {
+		v := r.ValueDistance.DeepCopy()
+		out.ValueDistance = &v
+	}
+	if r.ValueDuration != nil {
+		v := r.ValueDuration.DeepCopy()
+		out.ValueDuration = &v
+	}
+	if r.ValueHumanName != nil {
+		v := r.ValueHumanName.DeepCopy()
+		out.ValueHumanName = &v
+	}
+	if r.ValueIdentifier != nil {
+		v := r.ValueIdentifier.DeepCopy()
+		out.ValueIdentifier = &v
+	}
+	if r.ValueMoney != nil {
+		v := r.ValueMoney.DeepCopy()
+		out.ValueMoney = &v
+	}
+	if r.ValuePeriod != nil {
+		v := r.ValuePeriod.DeepCopy()
+		out.ValuePeriod = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueRange != nil {
+		v := r.ValueRange.DeepCopy()
+		out.ValueRange = &v
+	}
+	if r.ValueRatio != nil {
+		v := r.ValueRatio.DeepCopy()
+		out.ValueRatio = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	if r.ValueSampledData != nil {
+		v := r.ValueSampledData.DeepCopy()
+		out.ValueSampledData = &v
+	}
+	if r.ValueSignature != nil {
+		v := r.ValueSignature.DeepCopy()
+		out.ValueSignature = &v
+	}
+	if r.ValueTiming != nil {
+		v := r.ValueTiming.DeepCopy()
+		out.ValueTiming = &v
+	}
+	if r.ValueContactDetail != nil {
+		v := r.ValueContactDetail.DeepCopy()
+		out.ValueContactDetail = &v
+	}
+	if r.ValueContributor != nil {
+		v := r.ValueContributor.DeepCopy()
+		out.ValueContributor = &v
+	}
+	if r.ValueDataRequirement != nil {
+		v := r.ValueDataRequirement.DeepCopy()
+		out.ValueDataRequirement = &v
+	}
+	if r.ValueExpression != nil {
+		v := r.ValueExpression.DeepCopy()
+		out.ValueExpression = &v
+	}
+	if r.ValueParameterDefinition != nil {
+		v := r.ValueParameterDefinition.DeepCopy()
+		out.ValueParameterDefinition = &v
+	}
+	if r.ValueRelatedArtifact != nil {
+		v := r.ValueRelatedArtifact.DeepCopy()
+		out.ValueRelatedArtifact = &v
+	}
+	if r.ValueTriggerDefinition != nil {
+		v := r.ValueTriggerDefinition.DeepCopy()
+		out.ValueTriggerDefinition = &v
+	}
+	if r.ValueUsageContext != nil {
+		v := r.ValueUsageContext.DeepCopy()
+		out.ValueUsageContext = &v
+	}
+	if r.ValueDosage != nil {
+		v := r.ValueDosage.DeepCopy()
+		out.ValueDosage = &v
+	}
+	if r.ValueMeta != nil {
+		v := r.ValueMeta.DeepCopy()
+		out.ValueMeta = &v
+	}
+	if r.Resource != nil {
+		out.Resource = make(json.RawMessage, len(r.Resource))
+		copy(out.Resource, r.Resource)
+	}
+	if r.Part != nil {
+		out.Part = make([]ParametersParameter, len(r.Part))
+		for i := range r.Part {
+			out.Part[i] = r.Part[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ParametersParameter) Equal(other ParametersParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && *r.ValueBase64Binary != *other.ValueBase64Binary {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.Equal(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && *r.ValueCanonical != *other.ValueCanonical {
+		return false
+	}
+	if (r.ValueCanonicalElement == nil) != (other.ValueCanonicalElement == nil) || r.ValueCanonicalElement != nil && !r.ValueCanonicalElement.Equal(*other.ValueCanonicalElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && *r.ValueCode != *other.ValueCode {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.Equal(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && *r.ValueDate != *other.ValueDate {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.Equal(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !EqualDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.Equal(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && *r.ValueId != *other.ValueId {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.Equal(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && *r.ValueInstant != *other.ValueInstant {
+		return false
+	}
+	if (r.ValueInstantElement == nil) != (other.ValueInstantElement == nil) || r.ValueInstantElement != nil && !r.ValueInstantElement.Equal(*other.ValueInstantElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && *r.ValueMarkdown != *other.ValueMarkdown {
+		return false
+	}
+	if (r.ValueMarkdownElement == nil) != (other.ValueMarkdownElement == nil) || r.ValueMarkdownElement != nil && !r.ValueMarkdownElement.Equal(*other.ValueMarkdownElement) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && *r.ValueOid != *other.ValueOid {
+		return false
+	}
+	if (r.ValueOidElement == nil) != (other.ValueOidElement == nil) || r.ValueOidElement != nil && !r.ValueOidElement.Equal(*other.ValueOidElement) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValuePositiveIntElement == nil) != (other.ValuePositiveIntElement == nil) || r.ValuePositiveIntElement != nil && !r.ValuePositiveIntElement.Equal(*other.ValuePositiveIntElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && *r.ValueTime != *other.ValueTime {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.Equal(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUnsignedIntElement == nil) != (other.ValueUnsignedIntElement == nil) || r.ValueUnsignedIntElement != nil && !r.ValueUnsignedIntElement.Equal(*other.ValueUnsignedIntElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && *r.ValueUri != *other.ValueUri {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.Equal(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && *r.ValueUrl != *other.ValueUrl {
+		return false
+	}
+	if (r.ValueUrlElement == nil) != (other.ValueUrlElement == nil) || r.ValueUrlElement != nil && !r.ValueUrlElement.Equal(*other.ValueUrlElement) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && *r.ValueUuid != *other.ValueUuid {
+		return false
+	}
+	if (r.ValueUuidElement == nil) != (other.ValueUuidElement == nil) || r.ValueUuidElement != nil && !r.ValueUuidElement.Equal(*other.ValueUuidElement) {
+		return false
+	}
+	if (r.ValueAddress == nil) != (other.ValueAddress == nil) || r.ValueAddress != nil && !r.ValueAddress.Equal(*other.ValueAddress) {
+		return false
+	}
+	if (r.ValueAge == nil) != (other.ValueAge == nil) || r.ValueAge != nil && !r.ValueAge.Equal(*other.ValueAge) {
+		return false
+	}
+	if (r.ValueAnnotation == nil) != (other.ValueAnnotation == nil) || r.ValueAnnotation != nil && !r.ValueAnnotation.Equal(*other.ValueAnnotation) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.Equal(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.Equal(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.Equal(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueContactPoint == nil) != (other.ValueContactPoint == nil) || r.ValueContactPoint != nil && !r.ValueContactPoint.Equal(*other.ValueContactPoint) {
+		return false
+	}
+	if (r.ValueCount == nil) != (other.ValueCount == nil) || r.ValueCount != nil && !r.ValueCount.Equal(*other.ValueCount) {
+		return false
+	}
+	if (r.ValueDistance == nil) != (other.ValueDistance == nil) || r.ValueDistance != nil && !r.ValueDistance.Equal(*other.ValueDistance) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.Equal(*other.ValueDuration) {
+		return false
+	}
+	if (r.ValueHumanName == nil) != (other.ValueHumanName == nil) || r.ValueHumanName != nil && !r.ValueHumanName.Equal(*other.ValueHumanName) {
+		return false
+	}
+	if (r.ValueIdentifier == nil) != (other.ValueIdentifier == nil) || r.ValueIdentifier != nil && !r.ValueIdentifier.Equal(*other.ValueIdentifier) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.Equal(*other.ValueMoney) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.Equal(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.Equal(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.Equal(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.Equal(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueSignature == nil) != (other.ValueSignature == nil) || r.ValueSignature != nil && !r.ValueSignature.Equal(*other.ValueSignature) {
+		return false
+	}
+	if (r.ValueTiming == nil) != (other.ValueTiming == nil) || r.ValueTiming != nil && !r.ValueTiming.Equal(*other.ValueTiming) {
+		return false
+	}
+	if (r.ValueContactDetail == nil) != (other.ValueContactDetail == nil) || r.ValueContactDetail != nil && !r.ValueContactDetail.Equal(*other.ValueContactDetail) {
+		return false
+	}
+	if (r.ValueContributor == nil) != (other.ValueContributor == nil) || r.ValueContributor != nil && !r.ValueContributor.Equal(*other.ValueContributor) {
+		return false
+	}
+	if (r.ValueDataRequirement == nil) != (other.ValueDataRequirement == nil) || r.ValueDataRequirement != nil && !r.ValueDataRequirement.Equal(*other.ValueDataRequirement) {
+		return false
+	}
+	if (r.ValueExpression == nil) != (other.ValueExpression == nil) || r.ValueExpression != nil && !r.ValueExpression.Equal(*other.ValueExpression) {
+		return false
+	}
+	if (r.ValueParameterDefinition == nil) != (other.ValueParameterDefinition == nil) || r.ValueParameterDefinition != nil && !r.ValueParameterDefinition.Equal(*other.ValueParameterDefinition) {
+		return false
+	}
+	if (r.ValueRelatedArtifact == nil) != (other.ValueRelatedArtifact == nil) || r.ValueRelatedArtifact != nil && !r.ValueRelatedArtifact.Equal(*other.ValueRelatedArtifact) {
+		return false
+	}
+	if (r.ValueTriggerDefinition == nil) != (other.ValueTriggerDefinition == nil) || r.ValueTriggerDefinition != nil && !r.ValueTriggerDefinition.Equal(*other.ValueTriggerDefinition) {
+		return false
+	}
+	if (r.ValueUsageContext == nil) != (other.ValueUsageContext == nil) || r.ValueUsageContext != nil && !r.ValueUsageContext.Equal(*other.ValueUsageContext) {
+		return false
+	}
+	if (r.ValueDosage == nil) != (other.ValueDosage == nil) || r.ValueDosage != nil && !r.ValueDosage.Equal(*other.ValueDosage) {
+		return false
+	}
+	if (r.ValueMeta == nil) != (other.ValueMeta == nil) || r.ValueMeta != nil && !r.ValueMeta.Equal(*other.ValueMeta) {
+		return false
+	}
+	if !equalResource(r.Resource, other.Resource) {
+		return false
+	}
+	if len(r.Part) != len(other.Part) {
+		return false
+	}
+	for i := range r.Part {
+		if !r.Part[i].Equal(other.Part[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ParametersParameter) EqualsDeep(other ParametersParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.EqualsDeep(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && !equivalentString(*r.ValueCanonical, *other.ValueCanonical) {
+		return false
+	}
+	if (r.ValueCanonicalElement == nil) != (other.ValueCanonicalElement == nil) || r.ValueCanonicalElement != nil && !r.ValueCanonicalElement.EqualsDeep(*other.ValueCanonicalElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.EqualsDeep(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.EqualsDeep(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.EqualsDeep(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.EqualsDeep(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && !equivalentString(*r.ValueInstant, *other.ValueInstant) {
+		return false
+	}
+	if (r.ValueInstantElement == nil) != (other.ValueInstantElement == nil) || r.ValueInstantElement != nil && !r.ValueInstantElement.EqualsDeep(*other.ValueInstantElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && !equivalentString(*r.ValueMarkdown, *other.ValueMarkdown) {
+		return false
+	}
+	if (r.ValueMarkdownElement == nil) != (other.ValueMarkdownElement == nil) || r.ValueMarkdownElement != nil && !r.ValueMarkdownElement.EqualsDeep(*other.ValueMarkdownElement) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && !equivalentString(*r.ValueOid, *other.ValueOid) {
+		return false
+	}
+	if (r.ValueOidElement == nil) != (other.ValueOidElement == nil) || r.ValueOidElement != nil && !r.ValueOidElement.EqualsDeep(*other.ValueOidElement) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValuePositiveIntElement == nil) != (other.ValuePositiveIntElement == nil) || r.ValuePositiveIntElement != nil && !r.ValuePositiveIntElement.EqualsDeep(*other.ValuePositiveIntElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.EqualsDeep(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUnsignedIntElement == nil) != (other.ValueUnsignedIntElement == nil) || r.ValueUnsignedIntElement != nil && !r.ValueUnsignedIntElement.EqualsDeep(*other.ValueUnsignedIntElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.EqualsDeep(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && !equivalentString(*r.ValueUrl, *other.ValueUrl) {
+		return false
+	}
+	if (r.ValueUrlElement == nil) != (other.ValueUrlElement == nil) || r.ValueUrlElement != nil && !r.ValueUrlElement.EqualsDeep(*other.ValueUrlElement) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && !equivalentString(*r.ValueUuid, *other.ValueUuid) {
+		return false
+	}
+	if (r.ValueUuidElement == nil) != (other.ValueUuidElement == nil) || r.ValueUuidElement != nil && !r.ValueUuidElement.EqualsDeep(*other.ValueUuidElement) {
+		return false
+	}
+	if (r.ValueAddress == nil) != (other.ValueAddress == nil) || r.ValueAddress != nil && !r.ValueAddress.EqualsDeep(*other.ValueAddress) {
+		return false
+	}
+	if (r.ValueAge == nil) != (other.ValueAge == nil) || r.ValueAge != nil && !r.ValueAge.EqualsDeep(*other.ValueAge) {
+		return false
+	}
+	if (r.ValueAnnotation == nil) != (other.ValueAnnotation == nil) || r.ValueAnnotation != nil && !r.ValueAnnotation.EqualsDeep(*other.ValueAnnotation) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.EqualsDeep(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.EqualsDeep(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.EqualsDeep(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueContactPoint == nil) != (other.ValueContactPoint == nil) || r.ValueContactPoint != nil && !r.ValueContactPoint.EqualsDeep(*other.ValueContactPoint) {
+		return false
+	}
+	if (r.ValueCount == nil) != (other.ValueCount == nil) || r.ValueCount != nil && !r.ValueCount.EqualsDeep(*other.ValueCount) {
+		return false
+	}
+	if (r.ValueDistance == nil) != (other.ValueDistance == nil) || r.ValueDistance != nil && !r.ValueDistance.EqualsDeep(*other.ValueDistance) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.EqualsDeep(*other.ValueDuration) {
+		return false
+	}
+	if (r.ValueHumanName == nil) != (other.ValueHumanName == nil) || r.ValueHumanName != nil && !r.ValueHumanName.EqualsDeep(*other.ValueHumanName) {
+		return false
+	}
+	if (r.ValueIdentifier == nil) != (other.ValueIdentifier == nil) || r.ValueIdentifier != nil && !r.ValueIdentifier.EqualsDeep(*other.ValueIdentifier) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.EqualsDeep(*other.ValueMoney) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.EqualsDeep(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.EqualsDeep(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.EqualsDeep(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.EqualsDeep(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueSignature == nil) != (other.ValueSignature == nil) || r.ValueSignature != nil && !r.ValueSignature.EqualsDeep(*other.ValueSignature) {
+		return false
+	}
+	if (r.ValueTiming == nil) != (other.ValueTiming == nil) || r.ValueTiming != nil && !r.ValueTiming.EqualsDeep(*other.ValueTiming) {
+		return false
+	}
+	if (r.ValueContactDetail == nil) != (other.ValueContactDetail == nil) || r.ValueContactDetail != nil && !r.ValueContactDetail.EqualsDeep(*other.ValueContactDetail) {
+		return false
+	}
+	if (r.ValueContributor == nil) != (other.ValueContributor == nil) || r.ValueContributor != nil && !r.ValueContributor.EqualsDeep(*other.ValueContributor) {
+		return false
+	}
+	if (r.ValueDataRequirement == nil) != (other.ValueDataRequirement == nil) || r.ValueDataRequirement != nil && !r.ValueDataRequirement.EqualsDeep(*other.ValueDataRequirement) {
+		return false
+	}
+	if (r.ValueExpression == nil) != (other.ValueExpression == nil) || r.ValueExpression != nil && !r.ValueExpression.EqualsDeep(*other.ValueExpression) {
+		return false
+	}
+	if (r.ValueParameterDefinition == nil) != (other.ValueParameterDefinition == nil) || r.ValueParameterDefinition != nil && !r.ValueParameterDefinition.EqualsDeep(*other.ValueParameterDefinition) {
+		return false
+	}
+	if (r.ValueRelatedArtifact == nil) != (other.ValueRelatedArtifact == nil) || r.ValueRelatedArtifact != nil && !r.ValueRelatedArtifact.EqualsDeep(*other.ValueRelatedArtifact) {
+		return false
+	}
+	if (r.ValueTriggerDefinition == nil) != (other.ValueTriggerDefinition == nil) || r.ValueTriggerDefinition != nil && !r.ValueTriggerDefinition.EqualsDeep(*other.ValueTriggerDefinition) {
+		return false
+	}
+	if (r.ValueUsageContext == nil) != (other.ValueUsageContext == nil) || r.ValueUsageContext != nil && !r.ValueUsageContext.EqualsDeep(*other.ValueUsageContext) {
+		return false
+	}
+	if (r.ValueDosage == nil) != (other.ValueDosage == nil) || r.ValueDosage != nil && !r.ValueDosage.EqualsDeep(*other.ValueDosage) {
+		return false
+	}
+	if (r.ValueMeta == nil) != (other.ValueMeta == nil) || r.ValueMeta != nil && !r.ValueMeta.EqualsDeep(*other.ValueMeta) {
+		return false
+	}
+	if !equalResource(r.Resource, other.Resource) {
+		return false
+	}
+	if !equivalentList(len(r.Part), len(other.Part), func(i, j int) bool {
+		return r.Part[i].EqualsDeep(other.Part[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ParametersParameter) EqualsShallow(other ParametersParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && !equivalentString(*r.ValueCanonical, *other.ValueCanonical) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && !equivalentString(*r.ValueInstant, *other.ValueInstant) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && !equivalentString(*r.ValueMarkdown, *other.ValueMarkdown) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && !equivalentString(*r.ValueOid, *other.ValueOid) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && !equivalentString(*r.ValueUrl, *other.ValueUrl) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && !equivalentString(*r.ValueUuid, *other.ValueUuid) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ParametersParameter as FHIR XML
+func (r ParametersParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBase64Binary", r.ValueBase64Binary, r.ValueBase64BinaryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCanonical", r.ValueCanonical, r.ValueCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCode", r.ValueCode, r.ValueCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDate", r.ValueDate, r.ValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDecimal", r.ValueDecimal, r.ValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueId", r.ValueId, r.ValueIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInstant", r.ValueInstant, r.ValueInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueMarkdown", r.ValueMarkdown, r.ValueMarkdownElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueOid", r.ValueOid, r.ValueOidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valuePositiveInt", r.ValuePositiveInt, r.ValuePositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueTime", r.ValueTime, r.ValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUnsignedInt", r.ValueUnsignedInt, r.ValueUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUri", r.ValueUri, r.ValueUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUrl", r.ValueUrl, r.ValueUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUuid", r.ValueUuid, r.ValueUuidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAddress", r.ValueAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAge", r.ValueAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAnnotation", r.ValueAnnotation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAttachment", r.ValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCodeableConcept", r.ValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCoding", r.ValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContactPoint", r.ValueContactPoint); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCount", r.ValueCount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDistance", r.ValueDistance); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDuration", r.ValueDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueHumanName", r.ValueHumanName); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueIdentifier", r.ValueIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueMoney", r.ValueMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valuePeriod", r.ValuePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRange", r.ValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRatio", r.ValueRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSampledData", r.ValueSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSignature", r.ValueSignature); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueTiming", r.ValueTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContactDetail", r.ValueContactDetail); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContributor", r.ValueContributor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDataRequirement", r.ValueDataRequirement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueExpression", r.ValueExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueParameterDefinition", r.ValueParameterDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRelatedArtifact", r.ValueRelatedArtifact); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueTriggerDefinition", r.ValueTriggerDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueUsageContext", r.ValueUsageContext); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDosage", r.ValueDosage); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueMeta", r.ValueMeta); err != nil {
+		return err
+	}
+	if err := encodeXMLResource(e, "resource", r.Resource); err != nil {
+		return err
+	}
+	for _, v := range r.Part {
+		if err := encodeXMLElement(e, "part", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ParametersParameter from FHIR XML
+func (r *ParametersParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "valueBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBase64Binary = &v
+				}
+				r.ValueBase64BinaryElement = element
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCanonical = &v
+				}
+				r.ValueCanonicalElement = element
+			case "valueCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCode = &v
+				}
+				r.ValueCodeElement = element
+			case "valueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDate = &v
+				}
+				r.ValueDateElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDecimal = &v
+				}
+				r.ValueDecimalElement = element
+			case "valueId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueId = &v
+				}
+				r.ValueIdElement = element
+			case "valueInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInstant = &v
+				}
+				r.ValueInstantElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueMarkdown":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueMarkdown = &v
+				}
+				r.ValueMarkdownElement = element
+			case "valueOid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueOid = &v
+				}
+				r.ValueOidElement = element
+			case "valuePositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValuePositiveInt = &v
+				}
+				r.ValuePositiveIntElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueTime = &v
+				}
+				r.ValueTimeElement = element
+			case "valueUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUnsignedInt = &v
+				}
+				r.ValueUnsignedIntElement = element
+			case "valueUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUri = &v
+				}
+				r.ValueUriElement = element
+			case "valueUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUrl = &v
+				}
+				r.ValueUrlElement = element
+			case "valueUuid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUuid = &v
+				}
+				r.ValueUuidElement = element
+			case "valueAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAddress = &v
+			case "valueAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAge = &v
+			case "valueAnnotation":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAnnotation = &v
+			case "valueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAttachment = &v
+			case "valueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCodeableConcept = &v
+			case "valueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCoding = &v
+			case "valueContactPoint":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContactPoint = &v
+			case "valueCount":
+				var v Count
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCount = &v
+			case "valueDistance":
+				var v Distance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDistance = &v
+			case "valueDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDuration = &v
+			case "valueHumanName":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueHumanName = &v
+			case "valueIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueIdentifier = &v
+			case "valueMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueMoney = &v
+			case "valuePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValuePeriod = &v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRange = &v
+			case "valueRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRatio = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			case "valueSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSampledData = &v
+			case "valueSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSignature = &v
+			case "valueTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueTiming = &v
+			case "valueContactDetail":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContactDetail = &v
+			case "valueContributor":
+				var v Contributor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContributor = &v
+			case "valueDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDataRequirement = &v
+			case "valueExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueExpression = &v
+			case "valueParameterDefinition":
+				var v ParameterDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueParameterDefinition = &v
+			case "valueRelatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRelatedArtifact = &v
+			case "valueTriggerDefinition":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueTriggerDefinition = &v
+			case "valueUsageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueUsageContext = &v
+			case "valueDosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDosage = &v
+			case "valueMeta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueMeta = &v
+			case "resource":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Resource = v
+			case "part":
+				var v ParametersParameter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Part = append(r.Part, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ParametersParameter as properties to the RDF node n
+func (r ParametersParameter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Parameters.parameter.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("Parameters.parameter.valueBase64Binary", -1, r.ValueBase64Binary, r.ValueBase64BinaryElement, "base64Binary")
+	n.primitive("Parameters.parameter.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("Parameters.parameter.valueCanonical", -1, r.ValueCanonical, r.ValueCanonicalElement, "canonical")
+	n.primitive("Parameters.parameter.valueCode", -1, r.ValueCode, r.ValueCodeElement, "code")
+	n.primitive("Parameters.parameter.valueDate", -1, r.ValueDate, r.ValueDateElement, "date")
+	n.primitive("Parameters.parameter.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.primitive("Parameters.parameter.valueDecimal", -1, r.ValueDecimal, r.ValueDecimalElement, "decimal")
+	n.primitive("Parameters.parameter.valueId", -1, r.ValueId, r.ValueIdElement, "id")
+	n.primitive("Parameters.parameter.valueInstant", -1, r.ValueInstant, r.ValueInstantElement, "instant")
+	n.primitive("Parameters.parameter.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("Parameters.parameter.valueMarkdown", -1, r.ValueMarkdown, r.ValueMarkdownElement, "markdown")
+	n.primitive("Parameters.parameter.valueOid", -1, r.ValueOid, r.ValueOidElement, "oid")
+	n.primitive("Parameters.parameter.valuePositiveInt", -1, r.ValuePositiveInt, r.ValuePositiveIntElement, "positiveInt")
+	n.primitive("Parameters.parameter.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("Parameters.parameter.valueTime", -1, r.ValueTime, r.ValueTimeElement, "time")
+	n.primitive("Parameters.parameter.valueUnsignedInt", -1, r.ValueUnsignedInt, r.ValueUnsignedIntElement, "unsignedInt")
+	n.primitive("Parameters.parameter.valueUri", -1, r.ValueUri, r.ValueUriElement, "uri")
+	n.primitive("Parameters.parameter.valueUrl", -1, r.ValueUrl, r.ValueUrlElement, "url")
+	n.primitive("Parameters.parameter.valueUuid", -1, r.ValueUuid, r.ValueUuidElement, "uuid")
+	n.element("Parameters.parameter.valueAddress", -1, r.ValueAddress)
+	n.element("Parameters.parameter.valueAge", -1, r.ValueAge)
+	n.element("Parameters.parameter.valueAnnotation", -1, r.ValueAnnotation)
+	n.element("Parameters.parameter.valueAttachment", -1, r.ValueAttachment)
+	n.element("Parameters.parameter.valueCodeableConcept", -1, r.ValueCodeableConcept)
+	n.element("Parameters.parameter.valueCoding", -1, r.ValueCoding)
+	n.element("Parameters.parameter.valueContactPoint", -1, r.ValueContactPoint)
+	n.element("Parameters.parameter.valueCount", -1, r.ValueCount)
+	n.element("Parameters.parameter.valueDistance", -1, r.ValueDistance)
+	n.element("Parameters.parameter.valueDuration", -1, r.ValueDuration)
+	n.element("Parameters.parameter.valueHumanName", -1, r.ValueHumanName)
+	n.element("Parameters.parameter.valueIdentifier", -1, r.ValueIdentifier)
+	n.element("Parameters.parameter.valueMoney", -1, r.ValueMoney)
+	n.element("Parameters.parameter.valuePeriod", -1, r.ValuePeriod)
+	n.element("Parameters.parameter.valueQuantity", -1, r.ValueQuantity)
+	n.element("Parameters.parameter.valueRange", -1, r.ValueRange)
+	n.element("Parameters.parameter.valueRatio", -1, r.ValueRatio)
+	n.element("Parameters.parameter.valueReference", -1, r.ValueReference)
+	n.element("Parameters.parameter.valueSampledData", -1, r.ValueSampledData)
+	n.element("Parameters.parameter.valueSignature", -1, r.ValueSignature)
+	n.element("Parameters.parameter.valueTiming", -1, r.ValueTiming)
+	n.element("Parameters.parameter.valueContactDetail", -1, r.ValueContactDetail)
+	n.element("Parameters.parameter.valueContributor", -1, r.ValueContributor)
+	n.element("Parameters.parameter.valueDataRequirement", -1, r.ValueDataRequirement)
+	n.element("Parameters.parameter.valueExpression", -1, r.ValueExpression)
+	n.element("Parameters.parameter.valueParameterDefinition", -1, r.ValueParameterDefinition)
+	n.element("Parameters.parameter.valueRelatedArtifact", -1, r.ValueRelatedArtifact)
+	n.element("Parameters.parameter.valueTriggerDefinition", -1, r.ValueTriggerDefinition)
+	n.element("Parameters.parameter.valueUsageContext", -1, r.ValueUsageContext)
+	n.element("Parameters.parameter.valueDosage", -1, r.ValueDosage)
+	n.element("Parameters.parameter.valueMeta", -1, r.ValueMeta)
+	n.inline("Parameters.parameter.resource", -1, r.Resource)
+	for i, v := range r.Part {
+		n.element("Parameters.parameter.part", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ParametersParameter as BSON document with the structure of its FHIR JSON
+func (r ParametersParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ParametersParameter from a BSON document with the structure of its FHIR JSON
+func (r *ParametersParameter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ParametersParameter as protobuf message ParametersParameter of fhir.proto
+func (r ParametersParameter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ParametersParameter to the encoded message
+func (r ParametersParameter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.ValueBase64Binary != nil {
+		e.string(5, *r.ValueBase64Binary)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(6, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueCanonical != nil {
+		e.string(7, *r.ValueCanonical)
+	}
+	if r.ValueCode != nil {
+		e.string(8, *r.ValueCode)
+	}
+	if r.ValueDate != nil {
+		e.string(9, *r.ValueDate)
+	}
+	if r.ValueDateTime != nil {
+		e.string(10, *r.ValueDateTime)
+	}
+	if r.ValueDecimal != nil {
+		e.string(11, string(*r.ValueDecimal))
+	}
+	if r.ValueId != nil {
+		e.string(12, *r.ValueId)
+	}
+	if r.ValueInstant != nil {
+		e.string(13, *r.ValueInstant)
+	}
+	if r.ValueInteger != nil {
+		e.varint(14, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueMarkdown != nil {
+		e.string(15, *r.ValueMarkdown)
+	}
+	if r.ValueOid != nil {
+		e.string(16, *r.ValueOid)
+	}
+	if r.ValuePositiveInt != nil {
+		e.varint(17, uint64(int64(*r.ValuePositiveInt)))
+	}
+	if r.ValueString != nil {
+		e.string(18, *r.ValueString)
+	}
+	if r.ValueTime != nil {
+		e.string(19, *r.ValueTime)
+	}
+	if r.ValueUnsignedInt != nil {
+		e.varint(20, uint64(int64(*r.ValueUnsignedInt)))
+	}
+	if r.ValueUri != nil {
+		e.string(21, *r.ValueUri)
+	}
+	if r.ValueUrl != nil {
+		e.string(22, *r.ValueUrl)
+	}
+	if r.ValueUuid != nil {
+		e.string(23, *r.ValueUuid)
+	}
+	if r.ValueAddress != nil {
+		e.message(24, *r.ValueAddress)
+	}
+	if r.ValueAge != nil {
+		e.message(25, *r.ValueAge)
+	}
+	if r.ValueAnnotation != nil {
+		e.message(26, *r.ValueAnnotation)
+	}
+	if r.ValueAttachment != nil {
+		e.message(27, *r.ValueAttachment)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.message(28, *r.ValueCodeableConcept)
+	}
+	if r.ValueCoding != nil {
+		e.message(29, *r.ValueCoding)
+	}
+	if r.ValueContactPoint != nil {
+		e.message(30, *r.ValueContactPoint)
+	}
+	if r.ValueCount != nil {
+		e.message(31, *r.ValueCount)
+	}
+	if r.ValueDistance != nil {
+		e.message(32, *r.ValueDistance)
+	}
+	if r.ValueDuration != nil {
+		e.message(33, *r.ValueDuration)
+	}
+	if r.ValueHumanName != nil {
+		e.message(34, *r.ValueHumanName)
+	}
+	if r.ValueIdentifier != nil {
+		e.message(35, *r.ValueIdentifier)
+	}
+	if r.ValueMoney != nil {
+		e.message(36, *r.ValueMoney)
+	}
+	if r.ValuePeriod != nil {
+		e.message(37, *r.ValuePeriod)
+	}
+	if r.ValueQuantity != nil {
+		e.message(38, *r.ValueQuantity)
+	}
+	if r.ValueRange != nil {
+		e.message(39, *r.ValueRange)
+	}
+	if r.ValueRatio != nil {
+		e.message(40, *r.ValueRatio)
+	}
+	if r.ValueReference != nil {
+		e.message(41, *r.ValueReference)
+	}
+	if r.ValueSampledData != nil {
+		e.message(42, *r.ValueSampledData)
+	}
+	if r.ValueSignature != nil {
+		e.message(43, *r.ValueSignature)
+	}
+	if r.ValueTiming != nil {
+		e.message(44, *r.ValueTiming)
+	}
+	if r.ValueContactDetail != nil {
+		e.message(45, *r.ValueContactDetail)
+	}
+	if r.ValueContributor != nil {
+		e.message(46, *r.ValueContributor)
+	}
+	if r.ValueDataRequirement != nil {
+		e.message(47, *r.ValueDataRequirement)
+	}
+	if r.ValueExpression != nil {
+		e.message(48, *r.ValueExpression)
+	}
+	if r.ValueParameterDefinition != nil {
+		e.message(49, *r.ValueParameterDefinition)
+	}
+	if r.ValueRelatedArtifact != nil {
+		e.message(50, *r.ValueRelatedArtifact)
+	}
+	if r.ValueTriggerDefinition != nil {
+		e.message(51, *r.ValueTriggerDefinition)
+	}
+	if r.ValueUsageContext != nil {
+		e.message(52, *r.ValueUsageContext)
+	}
+	if r.ValueDosage != nil {
+		e.message(53, *r.ValueDosage)
+	}
+	if r.ValueMeta != nil {
+		e.message(54, *r.ValueMeta)
+	}
+	if len(r.Resource) > 0 {
+		e.resource(55, r.Resource)
+	}
+	for _, v := range r.Part {
+		e.message(56, v)
+	}
+	if r.NameElement != nil {
+		e.message(57, *r.NameElement)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.message(58, *r.ValueBase64BinaryElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(59, *r.ValueBooleanElement)
+	}
+	if r.ValueCanonicalElement != nil {
+		e.message(60, *r.ValueCanonicalElement)
+	}
+	if r.ValueCodeElement != nil {
+		e.message(61, *r.ValueCodeElement)
+	}
+	if r.ValueDateElement != nil {
+		e.message(62, *r.ValueDateElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(63, *r.ValueDateTimeElement)
+	}
+	if r.ValueDecimalElement != nil {
+		e.message(64, *r.ValueDecimalElement)
+	}
+	if r.ValueIdElement != nil {
+		e.message(65, *r.ValueIdElement)
+	}
+	if r.ValueInstantElement != nil {
+		e.message(66, *r.ValueInstantElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(67, *r.ValueIntegerElement)
+	}
+	if r.ValueMarkdownElement != nil {
+		e.message(68, *r.ValueMarkdownElement)
+	}
+	if r.ValueOidElement != nil {
+		e.message(69, *r.ValueOidElement)
+	}
+	if r.ValuePositiveIntElement != nil {
+		e.message(70, *r.ValuePositiveIntElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(71, *r.ValueStringElement)
+	}
+	if r.ValueTimeElement != nil {
+		e.message(72, *r.ValueTimeElement)
+	}
+	if r.ValueUnsignedIntElement != nil {
+		e.message(73, *r.ValueUnsignedIntElement)
+	}
+	if r.ValueUriElement != nil {
+		e.message(74, *r.ValueUriElement)
+	}
+	if r.ValueUrlElement != nil {
+		e.message(75, *r.ValueUrlElement)
+	}
+	if r.ValueUuidElement != nil {
+		e.message(76, *r.ValueUuidElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ParametersParameter from protobuf message ParametersParameter of fhir.proto
+func (r *ParametersParameter) UnmarshalProto(b []byte) error {
+	*r = ParametersParameter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.ValueBase64Binary = &v
+		case 6:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 7:
+			v := d.string()
+			r.ValueCanonical = &v
+		case 8:
+			v := d.string()
+			r.ValueCode = &v
+		case 9:
+			v := d.string()
+			r.ValueDate = &v
+		case 10:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 11:
+			v := json.Number(d.string())
+			r.ValueDecimal = &v
+		case 12:
+			v := d.string()
+			r.ValueId = &v
+		case 13:
+			v := d.string()
+			r.ValueInstant = &v
+		case 14:
+			v := d.int()
+			r.ValueInteger = &v
+		case 15:
+			v := d.string()
+			r.ValueMarkdown = &v
+		case 16:
+			v := d.string()
+			r.ValueOid = &v
+		case 17:
+			v := d.int()
+			r.ValuePositiveInt = &v
+		case 18:
+			v := d.string()
+			r.ValueString = &v
+		case 19:
+			v := d.string()
+			r.ValueTime = &v
+		case 20:
+			v := d.int()
+			r.ValueUnsignedInt = &v
+		case 21:
+			v := d.string()
+			r.ValueUri = &v
+		case 22:
+			v := d.string()
+			r.ValueUrl = &v
+		case 23:
+			v := d.string()
+			r.ValueUuid = &v
+		case 24:
+			var v Address
+			d.message(&v)
+			r.ValueAddress = &v
+		case 25:
+			var v Age
+			d.message(&v)
+			r.ValueAge = &v
+		case 26:
+			var v Annotation
+			d.message(&v)
+			r.ValueAnnotation = &v
+		case 27:
+			var v Attachment
+			d.message(&v)
+			r.ValueAttachment = &v
+		case 28:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCodeableConcept = &v
+		case 29:
+			var v Coding
+			d.message(&v)
+			r.ValueCoding = &v
+		case 30:
+			var v ContactPoint
+			d.message(&v)
+			r.ValueContactPoint = &v
+		case 31:
+			var v Count
+			d.message(&v)
+			r.ValueCount = &v
+		case 32:
+			var v Distance
+			d.message(&v)
+			r.ValueDistance = &v
+		case 33:
+			var v Duration
+			d.message(&v)
+			r.ValueDuration = &v
+		case 34:
+			var v HumanName
+			d.message(&v)
+			r.ValueHumanName = &v
+		case 35:
+			var v Identifier
+			d.message(&v)
+			r.ValueIdentifier = &v
+		case 36:
+			var v Money
+			d.message(&v)
+			r.ValueMoney = &v
+		case 37:
+			var v Period
+			d.message(&v)
+			r.ValuePeriod = &v
+		case 38:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 39:
+			var v Range
+			d.message(&v)
+			r.ValueRange = &v
+		case 40:
+			var v Ratio
+			d.message(&v)
+			r.ValueRatio = &v
+		case 41:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 42:
+			var v SampledData
+			d.message(&v)
+			r.ValueSampledData = &v
+		case 43:
+			var v Signature
+			d.message(&v)
+			r.ValueSignature = &v
+		case 44:
+			var v Timing
+			d.message(&v)
+			r.ValueTiming = &v
+		case 45:
+			var v ContactDetail
+			d.message(&v)
+			r.ValueContactDetail = &v
+		case 46:
+			var v Contributor
+			d.message(&v)
+			r.ValueContributor = &v
+		case 47:
+			var v DataRequirement
+			d.message(&v)
+			r.ValueDataRequirement = &v
+		case 48:
+			var v Expression
+			d.message(&v)
+			r.ValueExpression = &v
+		case 49:
+			var v ParameterDefinition
+			d.message(&v)
+			r.ValueParameterDefinition = &v
+		case 50:
+			var v RelatedArtifact
+			d.message(&v)
+			r.ValueRelatedArtifact = &v
+		case 51:
+			var v TriggerDefinition
+			d.message(&v)
+			r.ValueTriggerDefinition = &v
+		case 52:
+			var v UsageContext
+			d.message(&v)
+			r.ValueUsageContext = &v
+		case 53:
+			var v Dosage
+			d.message(&v)
+			r.ValueDosage = &v
+		case 54:
+			var v Meta
+			d.message(&v)
+			r.ValueMeta = &v
+		case 55:
+			r.Resource = d.resource()
+		case 56:
+			var v ParametersParameter
+			d.message(&v)
+			r.Part = append(r.Part, v)
+		case 57:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 58:
+			var v Element
+			d.message(&v)
+			r.ValueBase64BinaryElement = &v
+		case 59:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 60:
+			var v Element
+			d.message(&v)
+			r.ValueCanonicalElement = &v
+		case 61:
+			var v Element
+			d.message(&v)
+			r.ValueCodeElement = &v
+		case 62:
+			var v Element
+			d.message(&v)
+			r.ValueDateElement = &v
+		case 63:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		case 64:
+			var v Element
+			d.message(&v)
+			r.ValueDecimalElement = &v
+		case 65:
+			var v Element
+			d.message(&v)
+			r.ValueIdElement = &v
+		case 66:
+			var v Element
+			d.message(&v)
+			r.ValueInstantElement = &v
+		case 67:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 68:
+			var v Element
+			d.message(&v)
+			r.ValueMarkdownElement = &v
+		case 69:
+			var v Element
+			d.message(&v)
+			r.ValueOidElement = &v
+		case 70:
+			var v Element
+			d.message(&v)
+			r.ValuePositiveIntElement = &v
+		case 71:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 72:
+			var v Element
+			d.message(&v)
+			r.ValueTimeElement = &v
+		case 73:
+			var v Element
+			d.message(&v)
+			r.ValueUnsignedIntElement = &v
+		case 74:
+			var v Element
+			d.message(&v)
+			r.ValueUriElement = &v
+		case 75:
+			var v Element
+			d.message(&v)
+			r.ValueUrlElement = &v
+		case 76:
+			var v Element
+			d.message(&v)
+			r.ValueUuidElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalParameters unmarshals a Parameters.
 func UnmarshalParameters(b []byte) (Parameters, error) {
 	var parameters Parameters
-	if err := json.Unmarshal(b, &parameters); err != nil {
+	if err := parameters.UnmarshalJSON(b); err != nil {
 		return parameters, err
 	}
 	return parameters, nil
@@ -0,0 +1,751 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+)
+
+// AddParameter appends the given parameter.
+func (p *Parameters) AddParameter(parameter ParametersParameter) *Parameters {
+	addParameter(&p.Parameter, parameter)
+	return p
+}
+
+// AddString appends a parameter with a valueString.
+func (p *Parameters) AddString(name string, value string) *Parameters {
+	addString(&p.Parameter, name, value)
+	return p
+}
+
+// AddBoolean appends a parameter with a valueBoolean.
+func (p *Parameters) AddBoolean(name string, value bool) *Parameters {
+	addBoolean(&p.Parameter, name, value)
+	return p
+}
+
+// AddInteger appends a parameter with a valueInteger.
+func (p *Parameters) AddInteger(name string, value int) *Parameters {
+	addInteger(&p.Parameter, name, value)
+	return p
+}
+
+// AddDecimal appends a parameter with a valueDecimal.
+func (p *Parameters) AddDecimal(name string, value json.Number) *Parameters {
+	addDecimal(&p.Parameter, name, value)
+	return p
+}
+
+// AddCode appends a parameter with a valueCode.
+func (p *Parameters) AddCode(name string, value string) *Parameters {
+	addCode(&p.Parameter, name, value)
+	return p
+}
+
+// AddUri appends a parameter with a valueUri.
+func (p *Parameters) AddUri(name string, value string) *Parameters {
+	addUri(&p.Parameter, name, value)
+	return p
+}
+
+// AddCanonical appends a parameter with a valueCanonical.
+func (p *Parameters) AddCanonical(name string, value string) *Parameters {
+	addCanonical(&p.Parameter, name, value)
+	return p
+}
+
+// AddDate appends a parameter with a valueDate.
+func (p *Parameters) AddDate(name string, value string) *Parameters {
+	addDate(&p.Parameter, name, value)
+	return p
+}
+
+// AddDateTime appends a parameter with a valueDateTime.
+func (p *Parameters) AddDateTime(name string, value string) *Parameters {
+	addDateTime(&p.Parameter, name, value)
+	return p
+}
+
+// AddCoding appends a parameter with a valueCoding.
+func (p *Parameters) AddCoding(name string, value Coding) *Parameters {
+	addCoding(&p.Parameter, name, value)
+	return p
+}
+
+// AddCodeableConcept appends a parameter with a valueCodeableConcept.
+func (p *Parameters) AddCodeableConcept(name string, value CodeableConcept) *Parameters {
+	addCodeableConcept(&p.Parameter, name, value)
+	return p
+}
+
+// AddIdentifier appends a parameter with a valueIdentifier.
+func (p *Parameters) AddIdentifier(name string, value Identifier) *Parameters {
+	addIdentifier(&p.Parameter, name, value)
+	return p
+}
+
+// AddReference appends a parameter with a valueReference.
+func (p *Parameters) AddReference(name string, value Reference) *Parameters {
+	addReference(&p.Parameter, name, value)
+	return p
+}
+
+// AddQuantity appends a parameter with a valueQuantity.
+func (p *Parameters) AddQuantity(name string, value Quantity) *Parameters {
+	addQuantity(&p.Parameter, name, value)
+	return p
+}
+
+// AddPeriod appends a parameter with a valuePeriod.
+func (p *Parameters) AddPeriod(name string, value Period) *Parameters {
+	addPeriod(&p.Parameter, name, value)
+	return p
+}
+
+// AddResource appends a parameter holding the given resource. Generated resources are turned into one with their
+// MarshalJSON method.
+func (p *Parameters) AddResource(name string, resource json.RawMessage) *Parameters {
+	addResource(&p.Parameter, name, resource)
+	return p
+}
+
+// AddPart appends a parameter with the given name whose parts are added by the build function.
+func (p *Parameters) AddPart(name string, build func(part *ParametersParameter)) *Parameters {
+	addPart(&p.Parameter, name, build)
+	return p
+}
+
+// Get returns the first parameter with the given name.
+func (p Parameters) Get(name string) (ParametersParameter, bool) {
+	return firstParameter(p.Parameter, name)
+}
+
+// GetAll returns all parameters with the given name.
+func (p Parameters) GetAll(name string) []ParametersParameter {
+	return allParameters(p.Parameter, name)
+}
+
+// GetString returns the valueString of the first parameter with the given name.
+func (p Parameters) GetString(name string) (string, bool) {
+	return getString(p.Parameter, name)
+}
+
+// GetStrings returns the valueString of all parameters with the given name.
+func (p Parameters) GetStrings(name string) []string {
+	return getStrings(p.Parameter, name)
+}
+
+// GetBoolean returns the valueBoolean of the first parameter with the given name.
+func (p Parameters) GetBoolean(name string) (bool, bool) {
+	return getBoolean(p.Parameter, name)
+}
+
+// GetInteger returns the valueInteger of the first parameter with the given name.
+func (p Parameters) GetInteger(name string) (int, bool) {
+	return getInteger(p.Parameter, name)
+}
+
+// GetDecimal returns the valueDecimal of the first parameter with the given name.
+func (p Parameters) GetDecimal(name string) (json.Number, bool) {
+	return getDecimal(p.Parameter, name)
+}
+
+// GetCode returns the valueCode of the first parameter with the given name.
+func (p Parameters) GetCode(name string) (string, bool) {
+	return getCode(p.Parameter, name)
+}
+
+// GetCodes returns the valueCode of all parameters with the given name.
+func (p Parameters) GetCodes(name string) []string {
+	return getCodes(p.Parameter, name)
+}
+
+// GetUri returns the valueUri of the first parameter with the given name.
+func (p Parameters) GetUri(name string) (string, bool) {
+	return getUri(p.Parameter, name)
+}
+
+// GetCanonical returns the valueCanonical of the first parameter with the given name.
+func (p Parameters) GetCanonical(name string) (string, bool) {
+	return getCanonical(p.Parameter, name)
+}
+
+// GetDate returns the valueDate of the first parameter with the given name.
+func (p Parameters) GetDate(name string) (string, bool) {
+	return getDate(p.Parameter, name)
+}
+
+// GetDateTime returns the valueDateTime of the first parameter with the given name.
+func (p Parameters) GetDateTime(name string) (string, bool) {
+	return getDateTime(p.Parameter, name)
+}
+
+// GetCoding returns the valueCoding of the first parameter with the given name.
+func (p Parameters) GetCoding(name string) (Coding, bool) {
+	return getCoding(p.Parameter, name)
+}
+
+// GetCodings returns the valueCoding of all parameters with the given name.
+func (p Parameters) GetCodings(name string) []Coding {
+	return getCodings(p.Parameter, name)
+}
+
+// GetCodeableConcept returns the valueCodeableConcept of the first parameter with the given name.
+func (p Parameters) GetCodeableConcept(name string) (CodeableConcept, bool) {
+	return getCodeableConcept(p.Parameter, name)
+}
+
+// GetIdentifier returns the valueIdentifier of the first parameter with the given name.
+func (p Parameters) GetIdentifier(name string) (Identifier, bool) {
+	return getIdentifier(p.Parameter, name)
+}
+
+// GetReference returns the valueReference of the first parameter with the given name.
+func (p Parameters) GetReference(name string) (Reference, bool) {
+	return getReference(p.Parameter, name)
+}
+
+// GetQuantity returns the valueQuantity of the first parameter with the given name.
+func (p Parameters) GetQuantity(name string) (Quantity, bool) {
+	return getQuantity(p.Parameter, name)
+}
+
+// GetPeriod returns the valuePeriod of the first parameter with the given name.
+func (p Parameters) GetPeriod(name string) (Period, bool) {
+	return getPeriod(p.Parameter, name)
+}
+
+// GetResource unmarshals the resource of the first parameter with the given name into v. It returns false if no such
+// parameter holds a resource.
+func (p Parameters) GetResource(name string, v interface{}) (bool, error) {
+	return getResource(p.Parameter, name, v)
+}
+
+// GetResources returns the raw resources of all parameters with the given name.
+func (p Parameters) GetResources(name string) []json.RawMessage {
+	return getResources(p.Parameter, name)
+}
+
+// GetPart returns the parts of the first parameter with the given name.
+func (p Parameters) GetPart(name string) ([]ParametersParameter, bool) {
+	parameter, ok := firstParameter(p.Parameter, name)
+	return parameter.Part, ok
+}
+
+// AddParameter appends the given parameter as part.
+func (p *ParametersParameter) AddParameter(parameter ParametersParameter) *ParametersParameter {
+	addParameter(&p.Part, parameter)
+	return p
+}
+
+// AddString appends a part with a valueString.
+func (p *ParametersParameter) AddString(name string, value string) *ParametersParameter {
+	addString(&p.Part, name, value)
+	return p
+}
+
+// AddBoolean appends a part with a valueBoolean.
+func (p *ParametersParameter) AddBoolean(name string, value bool) *ParametersParameter {
+	addBoolean(&p.Part, name, value)
+	return p
+}
+
+// AddInteger appends a part with a valueInteger.
+func (p *ParametersParameter) AddInteger(name string, value int) *ParametersParameter {
+	addInteger(&p.Part, name, value)
+	return p
+}
+
+// AddDecimal appends a part with a valueDecimal.
+func (p *ParametersParameter) AddDecimal(name string, value json.Number) *ParametersParameter {
+	addDecimal(&p.Part, name, value)
+	return p
+}
+
+// AddCode appends a part with a valueCode.
+func (p *ParametersParameter) AddCode(name string, value string) *ParametersParameter {
+	addCode(&p.Part, name, value)
+	return p
+}
+
+// AddUri appends a part with a valueUri.
+func (p *ParametersParameter) AddUri(name string, value string) *ParametersParameter {
+	addUri(&p.Part, name, value)
+	return p
+}
+
+// AddCanonical appends a part with a valueCanonical.
+func (p *ParametersParameter) AddCanonical(name string, value string) *ParametersParameter {
+	addCanonical(&p.Part, name, value)
+	return p
+}
+
+// AddDate appends a part with a valueDate.
+func (p *ParametersParameter) AddDate(name string, value string) *ParametersParameter {
+	addDate(&p.Part, name, value)
+	return p
+}
+
+// AddDateTime appends a part with a valueDateTime.
+func (p *ParametersParameter) AddDateTime(name string, value string) *ParametersParameter {
+	addDateTime(&p.Part, name, value)
+	return p
+}
+
+// AddCoding appends a part with a valueCoding.
+func (p *ParametersParameter) AddCoding(name string, value Coding) *ParametersParameter {
+	addCoding(&p.Part, name, value)
+	return p
+}
+
+// AddCodeableConcept appends a part with a valueCodeableConcept.
+func (p *ParametersParameter) AddCodeableConcept(name string, value CodeableConcept) *ParametersParameter {
+	addCodeableConcept(&p.Part, name, value)
+	return p
+}
+
+// AddIdentifier appends a part with a valueIdentifier.
+func (p *ParametersParameter) AddIdentifier(name string, value Identifier) *ParametersParameter {
+	addIdentifier(&p.Part, name, value)
+	return p
+}
+
+// AddReference appends a part with a valueReference.
+func (p *ParametersParameter) AddReference(name string, value Reference) *ParametersParameter {
+	addReference(&p.Part, name, value)
+	return p
+}
+
+// AddQuantity appends a part with a valueQuantity.
+func (p *ParametersParameter) AddQuantity(name string, value Quantity) *ParametersParameter {
+	addQuantity(&p.Part, name, value)
+	return p
+}
+
+// AddPeriod appends a part with a valuePeriod.
+func (p *ParametersParameter) AddPeriod(name string, value Period) *ParametersParameter {
+	addPeriod(&p.Part, name, value)
+	return p
+}
+
+// AddResource appends a part holding the given resource. Generated resources are turned into one with their MarshalJSON
+// method.
+func (p *ParametersParameter) AddResource(name string, resource json.RawMessage) *ParametersParameter {
+	addResource(&p.Part, name, resource)
+	return p
+}
+
+// AddPart appends a part with the given name whose parts are added by the build function.
+func (p *ParametersParameter) AddPart(name string, build func(part *ParametersParameter)) *ParametersParameter {
+	addPart(&p.Part, name, build)
+	return p
+}
+
+// Get returns the first part with the given name.
+func (p ParametersParameter) Get(name string) (ParametersParameter, bool) {
+	return firstParameter(p.Part, name)
+}
+
+// GetAll returns all parts with the given name.
+func (p ParametersParameter) GetAll(name string) []ParametersParameter {
+	return allParameters(p.Part, name)
+}
+
+// GetString returns the valueString of the first part with the given name.
+func (p ParametersParameter) GetString(name string) (string, bool) {
+	return getString(p.Part, name)
+}
+
+// GetStrings returns the valueString of all parts with the given name.
+func (p ParametersParameter) GetStrings(name string) []string {
+	return getStrings(p.Part, name)
+}
+
+// GetBoolean returns the valueBoolean of the first part with the given name.
+func (p ParametersParameter) GetBoolean(name string) (bool, bool) {
+	return getBoolean(p.Part, name)
+}
+
+// GetInteger returns the valueInteger of the first part with the given name.
+func (p ParametersParameter) GetInteger(name string) (int, bool) {
+	return getInteger(p.Part, name)
+}
+
+// GetDecimal returns the valueDecimal of the first part with the given name.
+func (p ParametersParameter) GetDecimal(name string) (json.Number, bool) {
+	return getDecimal(p.Part, name)
+}
+
+// GetCode returns the valueCode of the first part with the given name.
+func (p ParametersParameter) GetCode(name string) (string, bool) {
+	return getCode(p.Part, name)
+}
+
+// GetCodes returns the valueCode of all parts with the given name.
+func (p ParametersParameter) GetCodes(name string) []string {
+	return getCodes(p.Part, name)
+}
+
+// GetUri returns the valueUri of the first part with the given name.
+func (p ParametersParameter) GetUri(name string) (string, bool) {
+	return getUri(p.Part, name)
+}
+
+// GetCanonical returns the valueCanonical of the first part with the given name.
+func (p ParametersParameter) GetCanonical(name string) (string, bool) {
+	return getCanonical(p.Part, name)
+}
+
+// GetDate returns the valueDate of the first part with the given name.
+func (p ParametersParameter) GetDate(name string) (string, bool) {
+	return getDate(p.Part, name)
+}
+
+// GetDateTime returns the valueDateTime of the first part with the given name.
+func (p ParametersParameter) GetDateTime(name string) (string, bool) {
+	return getDateTime(p.Part, name)
+}
+
+// GetCoding returns the valueCoding of the first part with the given name.
+func (p ParametersParameter) GetCoding(name string) (Coding, bool) {
+	return getCoding(p.Part, name)
+}
+
+// GetCodings returns the valueCoding of all parts with the given name.
+func (p ParametersParameter) GetCodings(name string) []Coding {
+	return getCodings(p.Part, name)
+}
+
+// GetCodeableConcept returns the valueCodeableConcept of the first part with the given name.
+func (p ParametersParameter) GetCodeableConcept(name string) (CodeableConcept, bool) {
+	return getCodeableConcept(p.Part, name)
+}
+
+// GetIdentifier returns the valueIdentifier of the first part with the given name.
+func (p ParametersParameter) GetIdentifier(name string) (Identifier, bool) {
+	return getIdentifier(p.Part, name)
+}
+
+// GetReference returns the valueReference of the first part with the given name.
+func (p ParametersParameter) GetReference(name string) (Reference, bool) {
+	return getReference(p.Part, name)
+}
+
+// GetQuantity returns the valueQuantity of the first part with the given name.
+func (p ParametersParameter) GetQuantity(name string) (Quantity, bool) {
+	return getQuantity(p.Part, name)
+}
+
+// GetPeriod returns the valuePeriod of the first part with the given name.
+func (p ParametersParameter) GetPeriod(name string) (Period, bool) {
+	return getPeriod(p.Part, name)
+}
+
+// GetResource unmarshals the resource of the first part with the given name into v. It returns false if no such part
+// holds a resource.
+func (p ParametersParameter) GetResource(name string, v interface{}) (bool, error) {
+	return getResource(p.Part, name, v)
+}
+
+// GetResources returns the raw resources of all parts with the given name.
+func (p ParametersParameter) GetResources(name string) []json.RawMessage {
+	return getResources(p.Part, name)
+}
+
+// GetPart returns the parts of the first part with the given name.
+func (p ParametersParameter) GetPart(name string) ([]ParametersParameter, bool) {
+	parameter, ok := firstParameter(p.Part, name)
+	return parameter.Part, ok
+}
+
+// The helpers below implement the methods of both Parameters and ParametersParameter over their list of parameters or
+// parts.
+
+func addParameter(parameters *[]ParametersParameter, parameter ParametersParameter) {
+	*parameters = append(*parameters, parameter)
+}
+
+func addString(parameters *[]ParametersParameter, name string, value string) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueString: &value})
+}
+
+func addBoolean(parameters *[]ParametersParameter, name string, value bool) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueBoolean: &value})
+}
+
+func addInteger(parameters *[]ParametersParameter, name string, value int) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueInteger: &value})
+}
+
+func addDecimal(parameters *[]ParametersParameter, name string, value json.Number) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueDecimal: &value})
+}
+
+func addCode(parameters *[]ParametersParameter, name string, value string) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueCode: &value})
+}
+
+func addUri(parameters *[]ParametersParameter, name string, value string) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueUri: &value})
+}
+
+func addCanonical(parameters *[]ParametersParameter, name string, value string) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueCanonical: &value})
+}
+
+func addDate(parameters *[]ParametersParameter, name string, value string) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueDate: &value})
+}
+
+func addDateTime(parameters *[]ParametersParameter, name string, value string) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueDateTime: &value})
+}
+
+func addCoding(parameters *[]ParametersParameter, name string, value Coding) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueCoding: &value})
+}
+
+func addCodeableConcept(parameters *[]ParametersParameter, name string, value CodeableConcept) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueCodeableConcept: &value})
+}
+
+func addIdentifier(parameters *[]ParametersParameter, name string, value Identifier) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueIdentifier: &value})
+}
+
+func addReference(parameters *[]ParametersParameter, name string, value Reference) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueReference: &value})
+}
+
+func addQuantity(parameters *[]ParametersParameter, name string, value Quantity) {
+	addParameter(parameters, ParametersParameter{Name: name, ValueQuantity: &value})
+}
+
+func addPeriod(parameters *[]ParametersParameter, name string, value Period) {
+	addParameter(parameters, ParametersParameter{Name: name, ValuePeriod: &value})
+}
+
+func addResource(parameters *[]ParametersParameter, name string, resource json.RawMessage) {
+	addParameter(parameters, ParametersParameter{Name: name, Resource: resource})
+}
+
+func addPart(parameters *[]ParametersParameter, name string, build func(part *ParametersParameter)) {
+	parameter := ParametersParameter{Name: name}
+	build(&parameter)
+	addParameter(parameters, parameter)
+}
+
+func getString(parameters []ParametersParameter, name string) (string, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueString != nil {
+			return *parameter.ValueString, true
+		}
+	}
+	return "", false
+}
+
+func getStrings(parameters []ParametersParameter, name string) []string {
+	var result []string
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueString != nil {
+			result = append(result, *parameter.ValueString)
+		}
+	}
+	return result
+}
+
+func getBoolean(parameters []ParametersParameter, name string) (bool, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueBoolean != nil {
+			return *parameter.ValueBoolean, true
+		}
+	}
+	return false, false
+}
+
+func getInteger(parameters []ParametersParameter, name string) (int, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueInteger != nil {
+			return *parameter.ValueInteger, true
+		}
+	}
+	return 0, false
+}
+
+func getDecimal(parameters []ParametersParameter, name string) (json.Number, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueDecimal != nil {
+			return *parameter.ValueDecimal, true
+		}
+	}
+	return "", false
+}
+
+func getCode(parameters []ParametersParameter, name string) (string, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueCode != nil {
+			return *parameter.ValueCode, true
+		}
+	}
+	return "", false
+}
+
+func getCodes(parameters []ParametersParameter, name string) []string {
+	var result []string
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueCode != nil {
+			result = append(result, *parameter.ValueCode)
+		}
+	}
+	return result
+}
+
+func getUri(parameters []ParametersParameter, name string) (string, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueUri != nil {
+			return *parameter.ValueUri, true
+		}
+	}
+	return "", false
+}
+
+func getCanonical(parameters []ParametersParameter, name string) (string, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueCanonical != nil {
+			return *parameter.ValueCanonical, true
+		}
+	}
+	return "", false
+}
+
+func getDate(parameters []ParametersParameter, name string) (string, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueDate != nil {
+			return *parameter.ValueDate, true
+		}
+	}
+	return "", false
+}
+
+func getDateTime(parameters []ParametersParameter, name string) (string, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueDateTime != nil {
+			return *parameter.ValueDateTime, true
+		}
+	}
+	return "", false
+}
+
+func getCoding(parameters []ParametersParameter, name string) (Coding, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueCoding != nil {
+			return *parameter.ValueCoding, true
+		}
+	}
+	return Coding{}, false
+}
+
+func getCodings(parameters []ParametersParameter, name string) []Coding {
+	var result []Coding
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueCoding != nil {
+			result = append(result, *parameter.ValueCoding)
+		}
+	}
+	return result
+}
+
+func getCodeableConcept(parameters []ParametersParameter, name string) (CodeableConcept, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueCodeableConcept != nil {
+			return *parameter.ValueCodeableConcept, true
+		}
+	}
+	return CodeableConcept{}, false
+}
+
+func getIdentifier(parameters []ParametersParameter, name string) (Identifier, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueIdentifier != nil {
+			return *parameter.ValueIdentifier, true
+		}
+	}
+	return Identifier{}, false
+}
+
+func getReference(parameters []ParametersParameter, name string) (Reference, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueReference != nil {
+			return *parameter.ValueReference, true
+		}
+	}
+	return Reference{}, false
+}
+
+func getQuantity(parameters []ParametersParameter, name string) (Quantity, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValueQuantity != nil {
+			return *parameter.ValueQuantity, true
+		}
+	}
+	return Quantity{}, false
+}
+
+func getPeriod(parameters []ParametersParameter, name string) (Period, bool) {
+	for _, parameter := range allParameters(parameters, name) {
+		if parameter.ValuePeriod != nil {
+			return *parameter.ValuePeriod, true
+		}
+	}
+	return Period{}, false
+}
+
+func getResource(parameters []ParametersParameter, name string, v interface{}) (bool, error) {
+	for _, parameter := range allParameters(parameters, name) {
+		if len(parameter.Resource) > 0 {
+			return true, json.Unmarshal(parameter.Resource, v)
+		}
+	}
+	return false, nil
+}
+
+func getResources(parameters []ParametersParameter, name string) []json.RawMessage {
+	var result []json.RawMessage
+	for _, parameter := range allParameters(parameters, name) {
+		if len(parameter.Resource) > 0 {
+			result = append(result, parameter.Resource)
+		}
+	}
+	return result
+}
+
+func firstParameter(parameters []ParametersParameter, name string) (ParametersParameter, bool) {
+	for _, parameter := range parameters {
+		if parameter.Name == name {
+			return parameter, true
+		}
+	}
+	return ParametersParameter{}, false
+}
+
+func allParameters(parameters []ParametersParameter, name string) []ParametersParameter {
+	var result []ParametersParameter
+	for _, parameter := range parameters {
+		if parameter.Name == name {
+			result = append(result, parameter)
+		}
+	}
+	return result
+}
@@ -0,0 +1,152 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParametersBuilder(t *testing.T) {
+	patientId := "0"
+	patient, err := Patient{Id: &patientId}.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reference := "Patient/0"
+	coding := Coding{Code: stringPtr("a")}
+
+	var p Parameters
+	p.AddString("s", "foo").
+		AddString("s", "bar").
+		AddBoolean("b", true).
+		AddInteger("i", 1).
+		AddDecimal("d", "1.50").
+		AddCode("c", "x").
+		AddUri("u", "urn:x").
+		AddCoding("coding", coding).
+		AddCodeableConcept("cc", CodeableConcept{Text: stringPtr("t")}).
+		AddReference("r", Reference{Reference: &reference}).
+		AddPart("part", func(part *ParametersParameter) {
+			part.AddString("s", "baz").AddResource("patient", patient)
+		}).
+		AddResource("patient", patient)
+
+	if v, ok := p.GetString("s"); !ok || v != "foo" {
+		t.Errorf("GetString = %q, %v", v, ok)
+	}
+	if v := p.GetStrings("s"); !reflect.DeepEqual(v, []string{"foo", "bar"}) {
+		t.Errorf("GetStrings = %v", v)
+	}
+	if v, ok := p.GetBoolean("b"); !ok || !v {
+		t.Errorf("GetBoolean = %v, %v", v, ok)
+	}
+	if v, ok := p.GetInteger("i"); !ok || v != 1 {
+		t.Errorf("GetInteger = %v, %v", v, ok)
+	}
+	if v, ok := p.GetDecimal("d"); !ok || v != "1.50" {
+		t.Errorf("GetDecimal = %v, %v", v, ok)
+	}
+	if v, ok := p.GetCode("c"); !ok || v != "x" {
+		t.Errorf("GetCode = %v, %v", v, ok)
+	}
+	if v, ok := p.GetUri("u"); !ok || v != "urn:x" {
+		t.Errorf("GetUri = %v, %v", v, ok)
+	}
+	if v := p.GetCodings("coding"); len(v) != 1 || !v[0].Equal(coding) {
+		t.Errorf("GetCodings = %v", v)
+	}
+	if v, ok := p.GetCodeableConcept("cc"); !ok || *v.Text != "t" {
+		t.Errorf("GetCodeableConcept = %v, %v", v, ok)
+	}
+	if v, ok := p.GetReference("r"); !ok || *v.Reference != reference {
+		t.Errorf("GetReference = %v, %v", v, ok)
+	}
+	if _, ok := p.GetString("missing"); ok {
+		t.Error("GetString of missing parameter found a value")
+	}
+	if _, ok := p.GetString("b"); ok {
+		t.Error("GetString of boolean parameter found a value")
+	}
+
+	var got Patient
+	if ok, err := p.GetResource("patient", &got); !ok || err != nil || *got.Id != "0" {
+		t.Errorf("GetResource = %v, %v, %v", got, ok, err)
+	}
+	if v := p.GetResources("patient"); len(v) != 1 {
+		t.Errorf("GetResources = %v", v)
+	}
+
+	part, ok := p.Get("part")
+	if !ok {
+		t.Fatal("part not found")
+	}
+	if v, ok := part.GetString("s"); !ok || v != "baz" {
+		t.Errorf("part GetString = %q, %v", v, ok)
+	}
+	if v := part.GetResources("patient"); len(v) != 1 {
+		t.Errorf("part GetResources = %v", v)
+	}
+}
+
+// TestParametersParameterGetters checks that parts offer the same lookups as
+// parameters.
+func TestParametersParameterGetters(t *testing.T) {
+	reference := "Patient/0"
+	coding := Coding{Code: stringPtr("a")}
+	var part ParametersParameter
+	part.AddBoolean("b", true).
+		AddDecimal("d", "0.1").
+		AddUri("u", "urn:x").
+		AddCoding("coding", coding).
+		AddCoding("coding", coding).
+		AddCodeableConcept("cc", CodeableConcept{Text: stringPtr("t")}).
+		AddReference("r", Reference{Reference: &reference}).
+		AddPart("nested", func(nested *ParametersParameter) {
+			nested.AddCode("c", "x")
+		}).
+		AddResource("resource", json.RawMessage(`{"resourceType":"Patient"}`))
+
+	if v, ok := part.GetBoolean("b"); !ok || !v {
+		t.Errorf("GetBoolean = %v, %v", v, ok)
+	}
+	if v, ok := part.GetDecimal("d"); !ok || v != "0.1" {
+		t.Errorf("GetDecimal = %v, %v", v, ok)
+	}
+	if v, ok := part.GetUri("u"); !ok || v != "urn:x" {
+		t.Errorf("GetUri = %v, %v", v, ok)
+	}
+	if v := part.GetCodings("coding"); len(v) != 2 {
+		t.Errorf("GetCodings = %v", v)
+	}
+	if v, ok := part.GetCodeableConcept("cc"); !ok || *v.Text != "t" {
+		t.Errorf("GetCodeableConcept = %v, %v", v, ok)
+	}
+	if v, ok := part.GetReference("r"); !ok || *v.Reference != reference {
+		t.Errorf("GetReference = %v, %v", v, ok)
+	}
+	if v := part.GetResources("resource"); len(v) != 1 {
+		t.Errorf("GetResources = %v", v)
+	}
+	nested, ok := part.GetPart("nested")
+	if !ok || len(nested) != 1 || *nested[0].ValueCode != "x" {
+		t.Errorf("GetPart = %v, %v", nested, ok)
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
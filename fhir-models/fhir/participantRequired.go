@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ParticipantRequired) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ParticipantRequired) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ParticipantRequired) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "required":
 		*code = ParticipantRequiredRequired
@@ -49,6 +53,12 @@ func (code *ParticipantRequired) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ParticipantRequired) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ParticipantRequired) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ParticipantRequired) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ParticipantRequired) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ParticipantRequired) protoNumber() uint64 {
+	switch code {
+	case ParticipantRequiredRequired:
+		return 1
+	case ParticipantRequiredOptional:
+		return 2
+	case ParticipantRequiredInformationOnly:
+		return 3
+	}
+	return 0
+}
+func (code *ParticipantRequired) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ParticipantRequiredRequired
+	case 2:
+		*code = ParticipantRequiredOptional
+	case 3:
+		*code = ParticipantRequiredInformationOnly
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code ParticipationStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ParticipationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ParticipationStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "accepted":
 		*code = ParticipationStatusAccepted
@@ -52,6 +56,12 @@ func (code *ParticipationStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ParticipationStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ParticipationStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ParticipationStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code ParticipationStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ParticipationStatus) protoNumber() uint64 {
+	switch code {
+	case ParticipationStatusAccepted:
+		return 1
+	case ParticipationStatusDeclined:
+		return 2
+	case ParticipationStatusTentative:
+		return 3
+	case ParticipationStatusNeedsAction:
+		return 4
+	}
+	return 0
+}
+func (code *ParticipationStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ParticipationStatusAccepted
+	case 2:
+		*code = ParticipationStatusDeclined
+	case 3:
+		*code = ParticipationStatusTentative
+	case 4:
+		*code = ParticipationStatusNeedsAction
+	default:
+		return false
+	}
+	return true
+}
@@ -14,39 +14,128 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Patient is documented here http://hl7.org/fhir/StructureDefinition/Patient
 type Patient struct {
-	Id                   *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier           []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active               *bool                  `bson:"active,omitempty" json:"active,omitempty"`
-	Name                 []HumanName            `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom              []ContactPoint         `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Gender               *AdministrativeGender  `bson:"gender,omitempty" json:"gender,omitempty"`
-	BirthDate            *string                `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
-	DeceasedBoolean      *bool                  `bson:"deceasedBoolean,omitempty" json:"deceasedBoolean,omitempty"`
-	DeceasedDateTime     *string                `bson:"deceasedDateTime,omitempty" json:"deceasedDateTime,omitempty"`
-	Address              []Address              `bson:"address,omitempty" json:"address,omitempty"`
-	MaritalStatus        *CodeableConcept       `bson:"maritalStatus,omitempty" json:"maritalStatus,omitempty"`
-	MultipleBirthBoolean *bool                  `bson:"multipleBirthBoolean,omitempty" json:"multipleBirthBoolean,omitempty"`
-	MultipleBirthInteger *int                   `bson:"multipleBirthInteger,omitempty" json:"multipleBirthInteger,omitempty"`
-	Photo                []Attachment           `bson:"photo,omitempty" json:"photo,omitempty"`
-	Contact              []PatientContact       `bson:"contact,omitempty" json:"contact,omitempty"`
-	Communication        []PatientCommunication `bson:"communication,omitempty" json:"communication,omitempty"`
-	GeneralPractitioner  []Reference            `bson:"generalPractitioner,omitempty" json:"generalPractitioner,omitempty"`
-	ManagingOrganization *Reference             `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
-	Link                 []PatientLink          `bson:"link,omitempty" json:"link,omitempty"`
+	Id                          *string                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                   *Element               `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                        *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules               *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement        *Element               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                    *string                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement             *Element               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                        *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                   []json.RawMessage      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                   []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                  []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active                      *bool                  `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement               *Element               `bson:"_active,omitempty" json:"_active,omitempty"`
+	Name                        []HumanName            `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom                     []ContactPoint         `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Gender                      *AdministrativeGender  `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement               *Element               `bson:"_gender,omitempty" json:"_gender,omitempty"`
+	BirthDate                   *string                `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	BirthDateElement            *Element               `bson:"_birthDate,omitempty" json:"_birthDate,omitempty"`
+	DeceasedBoolean             *bool                  `bson:"deceasedBoolean,omitempty" json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement      *Element               `bson:"_deceasedBoolean,omitempty" json:"_deceasedBoolean,omitempty"`
+	DeceasedDateTime            *string                `bson:"deceasedDateTime,omitempty" json:"deceasedDateTime,omitempty"`
+	DeceasedDateTimeElement     *Element               `bson:"_deceasedDateTime,omitempty" json:"_deceasedDateTime,omitempty"`
+	Address                     []Address              `bson:"address,omitempty" json:"address,omitempty"`
+	MaritalStatus               *CodeableConcept       `bson:"maritalStatus,omitempty" json:"maritalStatus,omitempty"`
+	MultipleBirthBoolean        *bool                  `bson:"multipleBirthBoolean,omitempty" json:"multipleBirthBoolean,omitempty"`
+	MultipleBirthBooleanElement *Element               `bson:"_multipleBirthBoolean,omitempty" json:"_multipleBirthBoolean,omitempty"`
+	MultipleBirthInteger        *int                   `bson:"multipleBirthInteger,omitempty" json:"multipleBirthInteger,omitempty"`
+	MultipleBirthIntegerElement *Element               `bson:"_multipleBirthInteger,omitempty" json:"_multipleBirthInteger,omitempty"`
+	Photo                       []Attachment           `bson:"photo,omitempty" json:"photo,omitempty"`
+	Contact                     []PatientContact       `bson:"contact,omitempty" json:"contact,omitempty"`
+	Communication               []PatientCommunication `bson:"communication,omitempty" json:"communication,omitempty"`
+	GeneralPractitioner         []Reference            `bson:"generalPractitioner,omitempty" json:"generalPractitioner,omitempty"`
+	ManagingOrganization        *Reference             `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
+	Link                        []PatientLink          `bson:"link,omitempty" json:"link,omitempty"`
 }
+
+// PatientDeceased is implemented by the types allowed for Patient.deceased[x]
+type PatientDeceased interface {
+	isPatientDeceased()
+}
+
+func (Boolean) isPatientDeceased()  {}
+func (DateTime) isPatientDeceased() {}
+
+// Deceased returns the value of Patient.deceased[x] together with its FHIR type code or nil if no value is set
+func (r Patient) Deceased() (PatientDeceased, string) {
+	if r.DeceasedBoolean != nil {
+		return Boolean(*r.DeceasedBoolean), "boolean"
+	}
+	if r.DeceasedDateTime != nil {
+		return DateTime(*r.DeceasedDateTime), "dateTime"
+	}
+	return nil, ""
+}
+
+// SetDeceased sets the value of Patient.deceased[x], given as value or pointer, and clears all other types
+func (r *Patient) SetDeceased(value PatientDeceased) {
+	r.DeceasedBoolean = nil
+	r.DeceasedDateTime = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.DeceasedBoolean = &primitive
+	case *Boolean:
+		r.DeceasedBoolean = (*bool)(v)
+	case DateTime:
+		primitive := string(v)
+		r.DeceasedDateTime = &primitive
+	case *DateTime:
+		r.DeceasedDateTime = (*string)(v)
+	}
+}
+
+// PatientMultipleBirth is implemented by the types allowed for Patient.multipleBirth[x]
+type PatientMultipleBirth interface {
+	isPatientMultipleBirth()
+}
+
+func (Boolean) isPatientMultipleBirth() {}
+func (Integer) isPatientMultipleBirth() {}
+
+// MultipleBirth returns the value of Patient.multipleBirth[x] together with its FHIR type code or nil if no value is set
+func (r Patient) MultipleBirth() (PatientMultipleBirth, string) {
+	if r.MultipleBirthBoolean != nil {
+		return Boolean(*r.MultipleBirthBoolean), "boolean"
+	}
+	if r.MultipleBirthInteger != nil {
+		return Integer(*r.MultipleBirthInteger), "integer"
+	}
+	return nil, ""
+}
+
+// SetMultipleBirth sets the value of Patient.multipleBirth[x], given as value or pointer, and clears all other types
+func (r *Patient) SetMultipleBirth(value PatientMultipleBirth) {
+	r.MultipleBirthBoolean = nil
+	r.MultipleBirthInteger = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.MultipleBirthBoolean = &primitive
+	case *Boolean:
+		r.MultipleBirthBoolean = (*bool)(v)
+	case Integer:
+		primitive := int(v)
+		r.MultipleBirthInteger = &primitive
+	case *Integer:
+		r.MultipleBirthInteger = (*int)(v)
+	}
+}
+
 type PatientContact struct {
 	Id                *string               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -56,6 +145,7 @@ type PatientContact struct {
 	Telecom           []ContactPoint        `bson:"telecom,omitempty" json:"telecom,omitempty"`
 	Address           *Address              `bson:"address,omitempty" json:"address,omitempty"`
 	Gender            *AdministrativeGender `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement     *Element              `bson:"_gender,omitempty" json:"_gender,omitempty"`
 	Organization      *Reference            `bson:"organization,omitempty" json:"organization,omitempty"`
 	Period            *Period               `bson:"period,omitempty" json:"period,omitempty"`
 }
@@ -65,6 +155,7 @@ type PatientCommunication struct {
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Language          CodeableConcept `bson:"language" json:"language"`
 	Preferred         *bool           `bson:"preferred,omitempty" json:"preferred,omitempty"`
+	PreferredElement  *Element        `bson:"_preferred,omitempty" json:"_preferred,omitempty"`
 }
 type PatientLink struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
@@ -72,24 +163,3049 @@ type PatientLink struct {
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Other             Reference   `bson:"other" json:"other"`
 	Type              LinkType    `bson:"type" json:"type"`
+	TypeElement       *Element    `bson:"_type,omitempty" json:"_type,omitempty"`
 }
-type OtherPatient Patient
 
 // MarshalJSON marshals the given Patient as JSON into a byte slice
 func (r Patient) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherPatient
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherPatient: OtherPatient(r),
-		ResourceType: "Patient",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Patient as JSON object with the members in the order of the fields
+func (r Patient) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Patient\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Active != nil {
+		e.key("active")
+		e.bool(*r.Active)
+	}
+	if r.ActiveElement != nil {
+		e.key("_active")
+		r.ActiveElement.appendJSON(e)
+	}
+	if len(r.Name) > 0 {
+		e.key("name")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Name {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Gender != nil {
+		e.key("gender")
+		e.string(r.Gender.Code())
+	}
+	if r.GenderElement != nil {
+		e.key("_gender")
+		r.GenderElement.appendJSON(e)
+	}
+	if r.BirthDate != nil {
+		e.key("birthDate")
+		e.string(*r.BirthDate)
+	}
+	if r.BirthDateElement != nil {
+		e.key("_birthDate")
+		r.BirthDateElement.appendJSON(e)
+	}
+	if r.DeceasedBoolean != nil {
+		e.key("deceasedBoolean")
+		e.bool(*r.DeceasedBoolean)
+	}
+	if r.DeceasedBooleanElement != nil {
+		e.key("_deceasedBoolean")
+		r.DeceasedBooleanElement.appendJSON(e)
+	}
+	if r.DeceasedDateTime != nil {
+		e.key("deceasedDateTime")
+		e.string(*r.DeceasedDateTime)
+	}
+	if r.DeceasedDateTimeElement != nil {
+		e.key("_deceasedDateTime")
+		r.DeceasedDateTimeElement.appendJSON(e)
+	}
+	if len(r.Address) > 0 {
+		e.key("address")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Address {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MaritalStatus != nil {
+		e.key("maritalStatus")
+		r.MaritalStatus.appendJSON(e)
+	}
+	if r.MultipleBirthBoolean != nil {
+		e.key("multipleBirthBoolean")
+		e.bool(*r.MultipleBirthBoolean)
+	}
+	if r.MultipleBirthBooleanElement != nil {
+		e.key("_multipleBirthBoolean")
+		r.MultipleBirthBooleanElement.appendJSON(e)
+	}
+	if r.MultipleBirthInteger != nil {
+		e.key("multipleBirthInteger")
+		e.int(*r.MultipleBirthInteger)
+	}
+	if r.MultipleBirthIntegerElement != nil {
+		e.key("_multipleBirthInteger")
+		r.MultipleBirthIntegerElement.appendJSON(e)
+	}
+	if len(r.Photo) > 0 {
+		e.key("photo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Photo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Communication) > 0 {
+		e.key("communication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Communication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.GeneralPractitioner) > 0 {
+		e.key("generalPractitioner")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.GeneralPractitioner {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ManagingOrganization != nil {
+		e.key("managingOrganization")
+		r.ManagingOrganization.appendJSON(e)
+	}
+	if len(r.Link) > 0 {
+		e.key("link")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Link {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Patient from JSON. Unknown members are ignored.
+func (r *Patient) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Patient
+func (r *Patient) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "active":
+			if v, ok := d.bool(); ok {
+				r.Active = &v
+			} else {
+				r.Active = nil
+			}
+		case "_active":
+			r.ActiveElement = d.element()
+		case "name":
+			a := d.array()
+			r.Name = nil
+			for a.next() {
+				var v HumanName
+				v.decodeJSON(d)
+				r.Name = append(r.Name, v)
+			}
+			if r.Name == nil && !a.isNull {
+				r.Name = []HumanName{}
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "gender":
+			if d.null() {
+				r.Gender = nil
+			} else {
+				var v AdministrativeGender
+				d.unmarshal(&v)
+				r.Gender = &v
+			}
+		case "_gender":
+			r.GenderElement = d.element()
+		case "birthDate":
+			if v, ok := d.string(); ok {
+				r.BirthDate = &v
+			} else {
+				r.BirthDate = nil
+			}
+		case "_birthDate":
+			r.BirthDateElement = d.element()
+		case "deceasedBoolean":
+			if v, ok := d.bool(); ok {
+				r.DeceasedBoolean = &v
+			} else {
+				r.DeceasedBoolean = nil
+			}
+		case "_deceasedBoolean":
+			r.DeceasedBooleanElement = d.element()
+		case "deceasedDateTime":
+			if v, ok := d.string(); ok {
+				r.DeceasedDateTime = &v
+			} else {
+				r.DeceasedDateTime = nil
+			}
+		case "_deceasedDateTime":
+			r.DeceasedDateTimeElement = d.element()
+		case "address":
+			a := d.array()
+			r.Address = nil
+			for a.next() {
+				var v Address
+				v.decodeJSON(d)
+				r.Address = append(r.Address, v)
+			}
+			if r.Address == nil && !a.isNull {
+				r.Address = []Address{}
+			}
+		case "maritalStatus":
+			if d.null() {
+				r.MaritalStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.MaritalStatus = &v
+			}
+		case "multipleBirthBoolean":
+			if v, ok := d.bool(); ok {
+				r.MultipleBirthBoolean = &v
+			} else {
+				r.MultipleBirthBoolean = nil
+			}
+		case "_multipleBirthBoolean":
+			r.MultipleBirthBooleanElement = d.element()
+		case "multipleBirthInteger":
+			if v, ok := d.int(); ok {
+				r.MultipleBirthInteger = &v
+			} else {
+				r.MultipleBirthInteger = nil
+			}
+		case "_multipleBirthInteger":
+			r.MultipleBirthIntegerElement = d.element()
+		case "photo":
+			a := d.array()
+			r.Photo = nil
+			for a.next() {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Photo = append(r.Photo, v)
+			}
+			if r.Photo == nil && !a.isNull {
+				r.Photo = []Attachment{}
+			}
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v PatientContact
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []PatientContact{}
+			}
+		case "communication":
+			a := d.array()
+			r.Communication = nil
+			for a.next() {
+				var v PatientCommunication
+				v.decodeJSON(d)
+				r.Communication = append(r.Communication, v)
+			}
+			if r.Communication == nil && !a.isNull {
+				r.Communication = []PatientCommunication{}
+			}
+		case "generalPractitioner":
+			a := d.array()
+			r.GeneralPractitioner = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.GeneralPractitioner = append(r.GeneralPractitioner, v)
+			}
+			if r.GeneralPractitioner == nil && !a.isNull {
+				r.GeneralPractitioner = []Reference{}
+			}
+		case "managingOrganization":
+			if d.null() {
+				r.ManagingOrganization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ManagingOrganization = &v
+			}
+		case "link":
+			a := d.array()
+			r.Link = nil
+			for a.next() {
+				var v PatientLink
+				v.decodeJSON(d)
+				r.Link = append(r.Link, v)
+			}
+			if r.Link == nil && !a.isNull {
+				r.Link = []PatientLink{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Patient which shares no memory with the original
+func (r Patient) DeepCopy() Patient {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Active != nil {
+		v := *r.Active
+		out.Active = &v
+	}
+	if r.ActiveElement != nil {
+		v := r.ActiveElement.DeepCopy()
+		out.ActiveElement = &v
+	}
+	if r.Name != nil {
+		out.Name = make([]HumanName, len(r.Name))
+		for i := range r.Name {
+			out.Name[i] = r.Name[i].DeepCopy()
+		}
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.Gender != nil {
+		v := *r.Gender
+		out.Gender = &v
+	}
+	if r.GenderElement != nil {
+		v := r.GenderElement.DeepCopy()
+		out.GenderElement = &v
+	}
+	if r.BirthDate != nil {
+		v := *r.BirthDate
+		out.BirthDate = &v
+	}
+	if r.BirthDateElement != nil {
+		v := r.BirthDateElement.DeepCopy()
+		out.BirthDateElement = &v
+	}
+	if r.DeceasedBoolean != nil {
+		v := *r.DeceasedBoolean
+		out.DeceasedBoolean = &v
+	}
+	if r.DeceasedBooleanElement != nil {
+		v := r.DeceasedBooleanElement.DeepCopy()
+		out.DeceasedBooleanElement = &v
+	}
+	if r.DeceasedDateTime != nil {
+		v := *r.DeceasedDateTime
+		out.DeceasedDateTime = &v
+	}
+	if r.DeceasedDateTimeElement != nil {
+		v := r.DeceasedDateTimeElement.DeepCopy()
+		out.DeceasedDateTimeElement = &v
+	}
+	if r.Address != nil {
+		out.Address = make([]Address, len(r.Address))
+		for i := range r.Address {
+			out.Address[i] = r.Address[i].DeepCopy()
+		}
+	}
+	if r.MaritalStatus != nil {
+		v := r.MaritalStatus.DeepCopy()
+		out.MaritalStatus = &v
+	}
+	if r.MultipleBirthBoolean != nil {
+		v := *r.MultipleBirthBoolean
+		out.MultipleBirthBoolean = &v
+	}
+	if r.MultipleBirthBooleanElement != nil {
+		v := r.MultipleBirthBooleanElement.DeepCopy()
+		out.MultipleBirthBooleanElement = &v
+	}
+	if r.MultipleBirthInteger != nil {
+		v := *r.MultipleBirthInteger
+		out.MultipleBirthInteger = &v
+	}
+	if r.MultipleBirthIntegerElement != nil {
+		v := r.MultipleBirthIntegerElement.DeepCopy()
+		out.MultipleBirthIntegerElement = &v
+	}
+	if r.Photo != nil {
+		out.Photo = make([]Attachment, len(r.Photo))
+		for i := range r.Photo {
+			out.Photo[i] = r.Photo[i].DeepCopy()
+		}
+	}
+	if r.Contact != nil {
+		out.Contact = make([]PatientContact, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Communication != nil {
+		out.Communication = make([]PatientCommunication, len(r.Communication))
+		for i := range r.Communication {
+			out.Communication[i] = r.Communication[i].DeepCopy()
+		}
+	}
+	if r.GeneralPractitioner != nil {
+		out.GeneralPractitioner = make([]Reference, len(r.GeneralPractitioner))
+		for i := range r.GeneralPractitioner {
+			out.GeneralPractitioner[i] = r.GeneralPractitioner[i].DeepCopy()
+		}
+	}
+	if r.ManagingOrganization != nil {
+		v := r.ManagingOrganization.DeepCopy()
+		out.ManagingOrganization = &v
+	}
+	if r.Link != nil {
+		out.Link = make([]PatientLink, len(r.Link))
+		for i := range r.Link {
+			out.Link[i] = r.Link[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Patient) Equal(other Patient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.Equal(*other.ActiveElement) {
+		return false
+	}
+	if len(r.Name) != len(other.Name) {
+		return false
+	}
+	for i := range r.Name {
+		if !r.Name[i].Equal(other.Name[i]) {
+			return false
+		}
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.Equal(*other.GenderElement) {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && *r.BirthDate != *other.BirthDate {
+		return false
+	}
+	if (r.BirthDateElement == nil) != (other.BirthDateElement == nil) || r.BirthDateElement != nil && !r.BirthDateElement.Equal(*other.BirthDateElement) {
+		return false
+	}
+	if (r.DeceasedBoolean == nil) != (other.DeceasedBoolean == nil) || r.DeceasedBoolean != nil && *r.DeceasedBoolean != *other.DeceasedBoolean {
+		return false
+	}
+	if (r.DeceasedBooleanElement == nil) != (other.DeceasedBooleanElement == nil) || r.DeceasedBooleanElement != nil && !r.DeceasedBooleanElement.Equal(*other.DeceasedBooleanElement) {
+		return false
+	}
+	if (r.DeceasedDateTime == nil) != (other.DeceasedDateTime == nil) || r.DeceasedDateTime != nil && *r.DeceasedDateTime != *other.DeceasedDateTime {
+		return false
+	}
+	if (r.DeceasedDateTimeElement == nil) != (other.DeceasedDateTimeElement == nil) || r.DeceasedDateTimeElement != nil && !r.DeceasedDateTimeElement.Equal(*other.DeceasedDateTimeElement) {
+		return false
+	}
+	if len(r.Address) != len(other.Address) {
+		return false
+	}
+	for i := range r.Address {
+		if !r.Address[i].Equal(other.Address[i]) {
+			return false
+		}
+	}
+	if (r.MaritalStatus == nil) != (other.MaritalStatus == nil) || r.MaritalStatus != nil && !r.MaritalStatus.Equal(*other.MaritalStatus) {
+		return false
+	}
+	if (r.MultipleBirthBoolean == nil) != (other.MultipleBirthBoolean == nil) || r.MultipleBirthBoolean != nil && *r.MultipleBirthBoolean != *other.MultipleBirthBoolean {
+		return false
+	}
+	if (r.MultipleBirthBooleanElement == nil) != (other.MultipleBirthBooleanElement == nil) || r.MultipleBirthBooleanElement != nil && !r.MultipleBirthBooleanElement.Equal(*other.MultipleBirthBooleanElement) {
+		return false
+	}
+	if (r.MultipleBirthInteger == nil) != (other.MultipleBirthInteger == nil) || r.MultipleBirthInteger != nil && *r.MultipleBirthInteger != *other.MultipleBirthInteger {
+		return false
+	}
+	if (r.MultipleBirthIntegerElement == nil) != (other.MultipleBirthIntegerElement == nil) || r.MultipleBirthIntegerElement != nil && !r.MultipleBirthIntegerElement.Equal(*other.MultipleBirthIntegerElement) {
+		return false
+	}
+	if len(r.Photo) != len(other.Photo) {
+		return false
+	}
+	for i := range r.Photo {
+		if !r.Photo[i].Equal(other.Photo[i]) {
+			return false
+		}
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if len(r.Communication) != len(other.Communication) {
+		return false
+	}
+	for i := range r.Communication {
+		if !r.Communication[i].Equal(other.Communication[i]) {
+			return false
+		}
+	}
+	if len(r.GeneralPractitioner) != len(other.GeneralPractitioner) {
+		return false
+	}
+	for i := range r.GeneralPractitioner {
+		if !r.GeneralPractitioner[i].Equal(other.GeneralPractitioner[i]) {
+			return false
+		}
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.Equal(*other.ManagingOrganization) {
+		return false
+	}
+	if len(r.Link) != len(other.Link) {
+		return false
+	}
+	for i := range r.Link {
+		if !r.Link[i].Equal(other.Link[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Patient) EqualsDeep(other Patient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.EqualsDeep(*other.ActiveElement) {
+		return false
+	}
+	if !equivalentList(len(r.Name), len(other.Name), func(i, j int) bool {
+		return r.Name[i].EqualsDeep(other.Name[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.EqualsDeep(*other.GenderElement) {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && !equivalentString(*r.BirthDate, *other.BirthDate) {
+		return false
+	}
+	if (r.BirthDateElement == nil) != (other.BirthDateElement == nil) || r.BirthDateElement != nil && !r.BirthDateElement.EqualsDeep(*other.BirthDateElement) {
+		return false
+	}
+	if (r.DeceasedBoolean == nil) != (other.DeceasedBoolean == nil) || r.DeceasedBoolean != nil && *r.DeceasedBoolean != *other.DeceasedBoolean {
+		return false
+	}
+	if (r.DeceasedBooleanElement == nil) != (other.DeceasedBooleanElement == nil) || r.DeceasedBooleanElement != nil && !r.DeceasedBooleanElement.EqualsDeep(*other.DeceasedBooleanElement) {
+		return false
+	}
+	if (r.DeceasedDateTime == nil) != (other.DeceasedDateTime == nil) || r.DeceasedDateTime != nil && !equivalentString(*r.DeceasedDateTime, *other.DeceasedDateTime) {
+		return false
+	}
+	if (r.DeceasedDateTimeElement == nil) != (other.DeceasedDateTimeElement == nil) || r.DeceasedDateTimeElement != nil && !r.DeceasedDateTimeElement.EqualsDeep(*other.DeceasedDateTimeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Address), len(other.Address), func(i, j int) bool {
+		return r.Address[i].EqualsDeep(other.Address[j])
+	}) {
+		return false
+	}
+	if (r.MaritalStatus == nil) != (other.MaritalStatus == nil) || r.MaritalStatus != nil && !r.MaritalStatus.EqualsDeep(*other.MaritalStatus) {
+		return false
+	}
+	if (r.MultipleBirthBoolean == nil) != (other.MultipleBirthBoolean == nil) || r.MultipleBirthBoolean != nil && *r.MultipleBirthBoolean != *other.MultipleBirthBoolean {
+		return false
+	}
+	if (r.MultipleBirthBooleanElement == nil) != (other.MultipleBirthBooleanElement == nil) || r.MultipleBirthBooleanElement != nil && !r.MultipleBirthBooleanElement.EqualsDeep(*other.MultipleBirthBooleanElement) {
+		return false
+	}
+	if (r.MultipleBirthInteger == nil) != (other.MultipleBirthInteger == nil) || r.MultipleBirthInteger != nil && *r.MultipleBirthInteger != *other.MultipleBirthInteger {
+		return false
+	}
+	if (r.MultipleBirthIntegerElement == nil) != (other.MultipleBirthIntegerElement == nil) || r.MultipleBirthIntegerElement != nil && !r.MultipleBirthIntegerElement.EqualsDeep(*other.MultipleBirthIntegerElement) {
+		return false
+	}
+	if !equivalentList(len(r.Photo), len(other.Photo), func(i, j int) bool {
+		return r.Photo[i].EqualsDeep(other.Photo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Communication), len(other.Communication), func(i, j int) bool {
+		return r.Communication[i].EqualsDeep(other.Communication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.GeneralPractitioner), len(other.GeneralPractitioner), func(i, j int) bool {
+		return r.GeneralPractitioner[i].EqualsDeep(other.GeneralPractitioner[j])
+	}) {
+		return false
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.EqualsDeep(*other.ManagingOrganization) {
+		return false
+	}
+	if !equivalentList(len(r.Link), len(other.Link), func(i, j int) bool {
+		return r.Link[i].EqualsDeep(other.Link[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Patient) EqualsShallow(other Patient) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && !equivalentString(*r.BirthDate, *other.BirthDate) {
+		return false
+	}
+	if (r.DeceasedBoolean == nil) != (other.DeceasedBoolean == nil) || r.DeceasedBoolean != nil && *r.DeceasedBoolean != *other.DeceasedBoolean {
+		return false
+	}
+	if (r.DeceasedDateTime == nil) != (other.DeceasedDateTime == nil) || r.DeceasedDateTime != nil && !equivalentString(*r.DeceasedDateTime, *other.DeceasedDateTime) {
+		return false
+	}
+	if (r.MultipleBirthBoolean == nil) != (other.MultipleBirthBoolean == nil) || r.MultipleBirthBoolean != nil && *r.MultipleBirthBoolean != *other.MultipleBirthBoolean {
+		return false
+	}
+	if (r.MultipleBirthInteger == nil) != (other.MultipleBirthInteger == nil) || r.MultipleBirthInteger != nil && *r.MultipleBirthInteger != *other.MultipleBirthInteger {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Patient as FHIR XML
+func (r Patient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Patient",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "active", r.Active, r.ActiveElement); err != nil {
+		return err
+	}
+	for _, v := range r.Name {
+		if err := encodeXMLElement(e, "name", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "gender", r.Gender, r.GenderElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "birthDate", r.BirthDate, r.BirthDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "deceasedBoolean", r.DeceasedBoolean, r.DeceasedBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "deceasedDateTime", r.DeceasedDateTime, r.DeceasedDateTimeElement); err != nil {
+		return err
+	}
+	for _, v := range r.Address {
+		if err := encodeXMLElement(e, "address", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "maritalStatus", r.MaritalStatus); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "multipleBirthBoolean", r.MultipleBirthBoolean, r.MultipleBirthBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "multipleBirthInteger", r.MultipleBirthInteger, r.MultipleBirthIntegerElement); err != nil {
+		return err
+	}
+	for _, v := range r.Photo {
+		if err := encodeXMLElement(e, "photo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Communication {
+		if err := encodeXMLElement(e, "communication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.GeneralPractitioner {
+		if err := encodeXMLElement(e, "generalPractitioner", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "managingOrganization", r.ManagingOrganization); err != nil {
+		return err
+	}
+	for _, v := range r.Link {
+		if err := encodeXMLElement(e, "link", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Patient from FHIR XML
+func (r *Patient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "active":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Active = &v
+				}
+				r.ActiveElement = element
+			case "name":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Name = append(r.Name, v)
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "gender":
+				var v AdministrativeGender
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Gender = &v
+				}
+				r.GenderElement = element
+			case "birthDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BirthDate = &v
+				}
+				r.BirthDateElement = element
+			case "deceasedBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DeceasedBoolean = &v
+				}
+				r.DeceasedBooleanElement = element
+			case "deceasedDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DeceasedDateTime = &v
+				}
+				r.DeceasedDateTimeElement = element
+			case "address":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Address = append(r.Address, v)
+			case "maritalStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaritalStatus = &v
+			case "multipleBirthBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MultipleBirthBoolean = &v
+				}
+				r.MultipleBirthBooleanElement = element
+			case "multipleBirthInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MultipleBirthInteger = &v
+				}
+				r.MultipleBirthIntegerElement = element
+			case "photo":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Photo = append(r.Photo, v)
+			case "contact":
+				var v PatientContact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "communication":
+				var v PatientCommunication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Communication = append(r.Communication, v)
+			case "generalPractitioner":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GeneralPractitioner = append(r.GeneralPractitioner, v)
+			case "managingOrganization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManagingOrganization = &v
+			case "link":
+				var v PatientLink
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Link = append(r.Link, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Patient as properties to the RDF node n
+func (r Patient) turtle(n *turtleNode) {
+	n.resource("Patient", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Patient.identifier", i, v)
+	}
+	n.primitive("Patient.active", -1, r.Active, r.ActiveElement, "boolean")
+	for i, v := range r.Name {
+		n.element("Patient.name", i, v)
+	}
+	for i, v := range r.Telecom {
+		n.element("Patient.telecom", i, v)
+	}
+	n.primitive("Patient.gender", -1, r.Gender, r.GenderElement, "code")
+	n.primitive("Patient.birthDate", -1, r.BirthDate, r.BirthDateElement, "date")
+	n.primitive("Patient.deceasedBoolean", -1, r.DeceasedBoolean, r.DeceasedBooleanElement, "boolean")
+	n.primitive("Patient.deceasedDateTime", -1, r.DeceasedDateTime, r.DeceasedDateTimeElement, "dateTime")
+	for i, v := range r.Address {
+		n.element("Patient.address", i, v)
+	}
+	n.element("Patient.maritalStatus", -1, r.MaritalStatus)
+	n.primitive("Patient.multipleBirthBoolean", -1, r.MultipleBirthBoolean, r.MultipleBirthBooleanElement, "boolean")
+	n.primitive("Patient.multipleBirthInteger", -1, r.MultipleBirthInteger, r.MultipleBirthIntegerElement, "integer")
+	for i, v := range r.Photo {
+		n.element("Patient.photo", i, v)
+	}
+	for i, v := range r.Contact {
+		n.element("Patient.contact", i, v)
+	}
+	for i, v := range r.Communication {
+		n.element("Patient.communication", i, v)
+	}
+	for i, v := range r.GeneralPractitioner {
+		n.element("Patient.generalPractitioner", i, v)
+	}
+	n.element("Patient.managingOrganization", -1, r.ManagingOrganization)
+	for i, v := range r.Link {
+		n.element("Patient.link", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Patient as BSON document with the structure of its FHIR JSON
+func (r Patient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Patient from a BSON document with the structure of its FHIR JSON
+func (r *Patient) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Patient as protobuf message Patient of fhir.proto
+func (r Patient) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Patient to the encoded message
+func (r Patient) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Active != nil {
+		e.varint(10, protoBool(*r.Active))
+	}
+	for _, v := range r.Name {
+		e.message(11, v)
+	}
+	for _, v := range r.Telecom {
+		e.message(12, v)
+	}
+	if r.Gender != nil {
+		e.varint(13, r.Gender.protoNumber())
+	}
+	if r.BirthDate != nil {
+		e.string(14, *r.BirthDate)
+	}
+	if r.DeceasedBoolean != nil {
+		e.varint(15, protoBool(*r.DeceasedBoolean))
+	}
+	if r.DeceasedDateTime != nil {
+		e.string(16, *r.DeceasedDateTime)
+	}
+	for _, v := range r.Address {
+		e.message(17, v)
+	}
+	if r.MaritalStatus != nil {
+		e.message(18, *r.MaritalStatus)
+	}
+	if r.MultipleBirthBoolean != nil {
+		e.varint(19, protoBool(*r.MultipleBirthBoolean))
+	}
+	if r.MultipleBirthInteger != nil {
+		e.varint(20, uint64(int64(*r.MultipleBirthInteger)))
+	}
+	for _, v := range r.Photo {
+		e.message(21, v)
+	}
+	for _, v := range r.Contact {
+		e.message(22, v)
+	}
+	for _, v := range r.Communication {
+		e.message(23, v)
+	}
+	for _, v := range r.GeneralPractitioner {
+		e.message(24, v)
+	}
+	if r.ManagingOrganization != nil {
+		e.message(25, *r.ManagingOrganization)
+	}
+	for _, v := range r.Link {
+		e.message(26, v)
+	}
+	if r.IdElement != nil {
+		e.message(27, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(28, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(29, *r.LanguageElement)
+	}
+	if r.ActiveElement != nil {
+		e.message(30, *r.ActiveElement)
+	}
+	if r.GenderElement != nil {
+		e.message(31, *r.GenderElement)
+	}
+	if r.BirthDateElement != nil {
+		e.message(32, *r.BirthDateElement)
+	}
+	if r.DeceasedBooleanElement != nil {
+		e.message(33, *r.DeceasedBooleanElement)
+	}
+	if r.DeceasedDateTimeElement != nil {
+		e.message(34, *r.DeceasedDateTimeElement)
+	}
+	if r.MultipleBirthBooleanElement != nil {
+		e.message(35, *r.MultipleBirthBooleanElement)
+	}
+	if r.MultipleBirthIntegerElement != nil {
+		e.message(36, *r.MultipleBirthIntegerElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Patient from protobuf message Patient of fhir.proto
+func (r *Patient) UnmarshalProto(b []byte) error {
+	*r = Patient{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.bool()
+			r.Active = &v
+		case 11:
+			var v HumanName
+			d.message(&v)
+			r.Name = append(r.Name, v)
+		case 12:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 13:
+			var v AdministrativeGender
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Gender = &v
+		case 14:
+			v := d.string()
+			r.BirthDate = &v
+		case 15:
+			v := d.bool()
+			r.DeceasedBoolean = &v
+		case 16:
+			v := d.string()
+			r.DeceasedDateTime = &v
+		case 17:
+			var v Address
+			d.message(&v)
+			r.Address = append(r.Address, v)
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.MaritalStatus = &v
+		case 19:
+			v := d.bool()
+			r.MultipleBirthBoolean = &v
+		case 20:
+			v := d.int()
+			r.MultipleBirthInteger = &v
+		case 21:
+			var v Attachment
+			d.message(&v)
+			r.Photo = append(r.Photo, v)
+		case 22:
+			var v PatientContact
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 23:
+			var v PatientCommunication
+			d.message(&v)
+			r.Communication = append(r.Communication, v)
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.GeneralPractitioner = append(r.GeneralPractitioner, v)
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.ManagingOrganization = &v
+		case 26:
+			var v PatientLink
+			d.message(&v)
+			r.Link = append(r.Link, v)
+		case 27:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ActiveElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.GenderElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.BirthDateElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.DeceasedBooleanElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.DeceasedDateTimeElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.MultipleBirthBooleanElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.MultipleBirthIntegerElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PatientContact as JSON into a byte slice
+func (r PatientContact) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PatientContact as JSON object with the members in the order of the fields
+func (r PatientContact) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Relationship) > 0 {
+		e.key("relationship")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Relationship {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		r.Name.appendJSON(e)
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Address != nil {
+		e.key("address")
+		r.Address.appendJSON(e)
+	}
+	if r.Gender != nil {
+		e.key("gender")
+		e.string(r.Gender.Code())
+	}
+	if r.GenderElement != nil {
+		e.key("_gender")
+		r.GenderElement.appendJSON(e)
+	}
+	if r.Organization != nil {
+		e.key("organization")
+		r.Organization.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PatientContact from JSON. Unknown members are ignored.
+func (r *PatientContact) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PatientContact
+func (r *PatientContact) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "relationship":
+			a := d.array()
+			r.Relationship = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Relationship = append(r.Relationship, v)
+			}
+			if r.Relationship == nil && !a.isNull {
+				r.Relationship = []CodeableConcept{}
+			}
+		case "name":
+			if d.null() {
+				r.Name = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.Name = &v
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "address":
+			if d.null() {
+				r.Address = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.Address = &v
+			}
+		case "gender":
+			if d.null() {
+				r.Gender = nil
+			} else {
+				var v AdministrativeGender
+				d.unmarshal(&v)
+				r.Gender = &v
+			}
+		case "_gender":
+			r.GenderElement = d.element()
+		case "organization":
+			if d.null() {
+				r.Organization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Organization = &v
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PatientContact which shares no memory with the original
+func (r PatientContact) DeepCopy() PatientContact {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Relationship != nil {
+		out.Relationship = make([]CodeableConcept, len(r.Relationship))
+		for i := range r.Relationship {
+			out.Relationship[i] = r.Relationship[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := r.Name.DeepCopy()
+		out.Name = &v
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.Address != nil {
+		v := r.Address.DeepCopy()
+		out.Address = &v
+	}
+	if r.Gender != nil {
+		v := *r.Gender
+		out.Gender = &v
+	}
+	if r.GenderElement != nil {
+		v := r.GenderElement.DeepCopy()
+		out.GenderElement = &v
+	}
+	if r.Organization != nil {
+		v := r.Organization.DeepCopy()
+		out.Organization = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PatientContact) Equal(other PatientContact) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Relationship) != len(other.Relationship) {
+		return false
+	}
+	for i := range r.Relationship {
+		if !r.Relationship[i].Equal(other.Relationship[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !r.Name.Equal(*other.Name) {
+		return false
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if (r.Address == nil) != (other.Address == nil) || r.Address != nil && !r.Address.Equal(*other.Address) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.Equal(*other.GenderElement) {
+		return false
+	}
+	if (r.Organization == nil) != (other.Organization == nil) || r.Organization != nil && !r.Organization.Equal(*other.Organization) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PatientContact) EqualsDeep(other PatientContact) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Relationship), len(other.Relationship), func(i, j int) bool {
+		return r.Relationship[i].EqualsDeep(other.Relationship[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !r.Name.EqualsDeep(*other.Name) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if (r.Address == nil) != (other.Address == nil) || r.Address != nil && !r.Address.EqualsDeep(*other.Address) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.EqualsDeep(*other.GenderElement) {
+		return false
+	}
+	if (r.Organization == nil) != (other.Organization == nil) || r.Organization != nil && !r.Organization.EqualsDeep(*other.Organization) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PatientContact) EqualsShallow(other PatientContact) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PatientContact as FHIR XML
+func (r PatientContact) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Relationship {
+		if err := encodeXMLElement(e, "relationship", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "name", r.Name); err != nil {
+		return err
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "address", r.Address); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "gender", r.Gender, r.GenderElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "organization", r.Organization); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PatientContact from FHIR XML
+func (r *PatientContact) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "relationship":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Relationship = append(r.Relationship, v)
+			case "name":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Name = &v
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "address":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Address = &v
+			case "gender":
+				var v AdministrativeGender
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Gender = &v
+				}
+				r.GenderElement = element
+			case "organization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Organization = &v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PatientContact as properties to the RDF node n
+func (r PatientContact) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Relationship {
+		n.element("Patient.contact.relationship", i, v)
+	}
+	n.element("Patient.contact.name", -1, r.Name)
+	for i, v := range r.Telecom {
+		n.element("Patient.contact.telecom", i, v)
+	}
+	n.element("Patient.contact.address", -1, r.Address)
+	n.primitive("Patient.contact.gender", -1, r.Gender, r.GenderElement, "code")
+	n.element("Patient.contact.organization", -1, r.Organization)
+	n.element("Patient.contact.period", -1, r.Period)
+}
+
+// MarshalBSON marshals the given PatientContact as BSON document with the structure of its FHIR JSON
+func (r PatientContact) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PatientContact from a BSON document with the structure of its FHIR JSON
+func (r *PatientContact) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PatientContact as protobuf message PatientContact of fhir.proto
+func (r PatientContact) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PatientContact to the encoded message
+func (r PatientContact) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Relationship {
+		e.message(4, v)
+	}
+	if r.Name != nil {
+		e.message(5, *r.Name)
+	}
+	for _, v := range r.Telecom {
+		e.message(6, v)
+	}
+	if r.Address != nil {
+		e.message(7, *r.Address)
+	}
+	if r.Gender != nil {
+		e.varint(8, r.Gender.protoNumber())
+	}
+	if r.Organization != nil {
+		e.message(9, *r.Organization)
+	}
+	if r.Period != nil {
+		e.message(10, *r.Period)
+	}
+	if r.GenderElement != nil {
+		e.message(11, *r.GenderElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PatientContact from protobuf message PatientContact of fhir.proto
+func (r *PatientContact) UnmarshalProto(b []byte) error {
+	*r = PatientContact{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Relationship = append(r.Relationship, v)
+		case 5:
+			var v HumanName
+			d.message(&v)
+			r.Name = &v
+		case 6:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 7:
+			var v Address
+			d.message(&v)
+			r.Address = &v
+		case 8:
+			var v AdministrativeGender
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Gender = &v
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Organization = &v
+		case 10:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.GenderElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PatientCommunication as JSON into a byte slice
+func (r PatientCommunication) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PatientCommunication as JSON object with the members in the order of the fields
+func (r PatientCommunication) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("language")
+	r.Language.appendJSON(e)
+	if r.Preferred != nil {
+		e.key("preferred")
+		e.bool(*r.Preferred)
+	}
+	if r.PreferredElement != nil {
+		e.key("_preferred")
+		r.PreferredElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PatientCommunication from JSON. Unknown members are ignored.
+func (r *PatientCommunication) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PatientCommunication
+func (r *PatientCommunication) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "language":
+			r.Language.decodeJSON(d)
+		case "preferred":
+			if v, ok := d.bool(); ok {
+				r.Preferred = &v
+			} else {
+				r.Preferred = nil
+			}
+		case "_preferred":
+			r.PreferredElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PatientCommunication which shares no memory with the original
+func (r PatientCommunication) DeepCopy() PatientCommunication {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Language = r.Language.DeepCopy()
+	if r.Preferred != nil {
+		v := *r.Preferred
+		out.Preferred = &v
+	}
+	if r.PreferredElement != nil {
+		v := r.PreferredElement.DeepCopy()
+		out.PreferredElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PatientCommunication) Equal(other PatientCommunication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Language.Equal(other.Language) {
+		return false
+	}
+	if (r.Preferred == nil) != (other.Preferred == nil) || r.Preferred != nil && *r.Preferred != *other.Preferred {
+		return false
+	}
+	if (r.PreferredElement == nil) != (other.PreferredElement == nil) || r.PreferredElement != nil && !r.PreferredElement.Equal(*other.PreferredElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PatientCommunication) EqualsDeep(other PatientCommunication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Language.EqualsDeep(other.Language) {
+		return false
+	}
+	if (r.Preferred == nil) != (other.Preferred == nil) || r.Preferred != nil && *r.Preferred != *other.Preferred {
+		return false
+	}
+	if (r.PreferredElement == nil) != (other.PreferredElement == nil) || r.PreferredElement != nil && !r.PreferredElement.EqualsDeep(*other.PreferredElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PatientCommunication) EqualsShallow(other PatientCommunication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Preferred == nil) != (other.Preferred == nil) || r.Preferred != nil && *r.Preferred != *other.Preferred {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PatientCommunication as FHIR XML
+func (r PatientCommunication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "language", r.Language); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "preferred", r.Preferred, r.PreferredElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PatientCommunication from FHIR XML
+func (r *PatientCommunication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "language":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Language = v
+			case "preferred":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Preferred = &v
+				}
+				r.PreferredElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PatientCommunication as properties to the RDF node n
+func (r PatientCommunication) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Patient.communication.language", -1, r.Language)
+	n.primitive("Patient.communication.preferred", -1, r.Preferred, r.PreferredElement, "boolean")
+}
+
+// MarshalBSON marshals the given PatientCommunication as BSON document with the structure of its FHIR JSON
+func (r PatientCommunication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PatientCommunication from a BSON document with the structure of its FHIR JSON
+func (r *PatientCommunication) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PatientCommunication as protobuf message PatientCommunication of fhir.proto
+func (r PatientCommunication) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PatientCommunication to the encoded message
+func (r PatientCommunication) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Language)
+	if r.Preferred != nil {
+		e.varint(5, protoBool(*r.Preferred))
+	}
+	if r.PreferredElement != nil {
+		e.message(6, *r.PreferredElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PatientCommunication from protobuf message PatientCommunication of fhir.proto
+func (r *PatientCommunication) UnmarshalProto(b []byte) error {
+	*r = PatientCommunication{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Language = v
+		case 5:
+			v := d.bool()
+			r.Preferred = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.PreferredElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PatientLink as JSON into a byte slice
+func (r PatientLink) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PatientLink as JSON object with the members in the order of the fields
+func (r PatientLink) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("other")
+	r.Other.appendJSON(e)
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PatientLink from JSON. Unknown members are ignored.
+func (r *PatientLink) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PatientLink
+func (r *PatientLink) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "other":
+			r.Other.decodeJSON(d)
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PatientLink which shares no memory with the original
+func (r PatientLink) DeepCopy() PatientLink {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Other = r.Other.DeepCopy()
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PatientLink) Equal(other PatientLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Other.Equal(other.Other) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PatientLink) EqualsDeep(other PatientLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Other.EqualsDeep(other.Other) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PatientLink) EqualsShallow(other PatientLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PatientLink as FHIR XML
+func (r PatientLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "other", r.Other); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PatientLink from FHIR XML
+func (r *PatientLink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "other":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Other = v
+			case "type":
+				var v LinkType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PatientLink as properties to the RDF node n
+func (r PatientLink) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Patient.link.other", -1, r.Other)
+	n.primitive("Patient.link.type", -1, r.Type, r.TypeElement, "code")
+}
+
+// MarshalBSON marshals the given PatientLink as BSON document with the structure of its FHIR JSON
+func (r PatientLink) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PatientLink from a BSON document with the structure of its FHIR JSON
+func (r *PatientLink) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PatientLink as protobuf message PatientLink of fhir.proto
+func (r PatientLink) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PatientLink to the encoded message
+func (r PatientLink) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Other)
+	e.varint(5, r.Type.protoNumber())
+	if r.TypeElement != nil {
+		e.message(6, *r.TypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PatientLink from protobuf message PatientLink of fhir.proto
+func (r *PatientLink) UnmarshalProto(b []byte) error {
+	*r = PatientLink{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Other = v
+		case 5:
+			var v LinkType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalPatient unmarshals a Patient.
 func UnmarshalPatient(b []byte) (Patient, error) {
 	var patient Patient
-	if err := json.Unmarshal(b, &patient); err != nil {
+	if err := patient.UnmarshalJSON(b); err != nil {
 		return patient, err
 	}
 	return patient, nil
@@ -14,50 +14,1139 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // PaymentNotice is documented here http://hl7.org/fhir/StructureDefinition/PaymentNotice
 type PaymentNotice struct {
-	Id                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes `bson:"status" json:"status"`
-	Request           *Reference                   `bson:"request,omitempty" json:"request,omitempty"`
-	Response          *Reference                   `bson:"response,omitempty" json:"response,omitempty"`
-	Created           string                       `bson:"created" json:"created"`
-	Provider          *Reference                   `bson:"provider,omitempty" json:"provider,omitempty"`
-	Payment           Reference                    `bson:"payment" json:"payment"`
-	PaymentDate       *string                      `bson:"paymentDate,omitempty" json:"paymentDate,omitempty"`
-	Payee             *Reference                   `bson:"payee,omitempty" json:"payee,omitempty"`
-	Recipient         Reference                    `bson:"recipient" json:"recipient"`
-	Amount            Money                        `bson:"amount" json:"amount"`
-	PaymentStatus     *CodeableConcept             `bson:"paymentStatus,omitempty" json:"paymentStatus,omitempty"`
-}
-type OtherPaymentNotice PaymentNotice
+	Id                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                     `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes `bson:"status" json:"status"`
+	StatusElement        *Element                     `bson:"_status,omitempty" json:"_status,omitempty"`
+	Request              *Reference                   `bson:"request,omitempty" json:"request,omitempty"`
+	Response             *Reference                   `bson:"response,omitempty" json:"response,omitempty"`
+	Created              string                       `bson:"created" json:"created"`
+	CreatedElement       *Element                     `bson:"_created,omitempty" json:"_created,omitempty"`
+	Provider             *Reference                   `bson:"provider,omitempty" json:"provider,omitempty"`
+	Payment              Reference                    `bson:"payment" json:"payment"`
+	PaymentDate          *string                      `bson:"paymentDate,omitempty" json:"paymentDate,omitempty"`
+	PaymentDateElement   *Element                     `bson:"_paymentDate,omitempty" json:"_paymentDate,omitempty"`
+	Payee                *Reference                   `bson:"payee,omitempty" json:"payee,omitempty"`
+	Recipient            Reference                    `bson:"recipient" json:"recipient"`
+	Amount               Money                        `bson:"amount" json:"amount"`
+	PaymentStatus        *CodeableConcept             `bson:"paymentStatus,omitempty" json:"paymentStatus,omitempty"`
+}
 
 // MarshalJSON marshals the given PaymentNotice as JSON into a byte slice
 func (r PaymentNotice) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherPaymentNotice
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherPaymentNotice: OtherPaymentNotice(r),
-		ResourceType:       "PaymentNotice",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PaymentNotice as JSON object with the members in the order of the fields
+func (r PaymentNotice) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"PaymentNotice\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Request != nil {
+		e.key("request")
+		r.Request.appendJSON(e)
+	}
+	if r.Response != nil {
+		e.key("response")
+		r.Response.appendJSON(e)
+	}
+	e.key("created")
+	e.string(r.Created)
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.Provider != nil {
+		e.key("provider")
+		r.Provider.appendJSON(e)
+	}
+	e.key("payment")
+	r.Payment.appendJSON(e)
+	if r.PaymentDate != nil {
+		e.key("paymentDate")
+		e.string(*r.PaymentDate)
+	}
+	if r.PaymentDateElement != nil {
+		e.key("_paymentDate")
+		r.PaymentDateElement.appendJSON(e)
+	}
+	if r.Payee != nil {
+		e.key("payee")
+		r.Payee.appendJSON(e)
+	}
+	e.key("recipient")
+	r.Recipient.appendJSON(e)
+	e.key("amount")
+	r.Amount.appendJSON(e)
+	if r.PaymentStatus != nil {
+		e.key("paymentStatus")
+		r.PaymentStatus.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PaymentNotice from JSON. Unknown members are ignored.
+func (r *PaymentNotice) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PaymentNotice
+func (r *PaymentNotice) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "request":
+			if d.null() {
+				r.Request = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Request = &v
+			}
+		case "response":
+			if d.null() {
+				r.Response = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Response = &v
+			}
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = v
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "provider":
+			if d.null() {
+				r.Provider = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Provider = &v
+			}
+		case "payment":
+			r.Payment.decodeJSON(d)
+		case "paymentDate":
+			if v, ok := d.string(); ok {
+				r.PaymentDate = &v
+			} else {
+				r.PaymentDate = nil
+			}
+		case "_paymentDate":
+			r.PaymentDateElement = d.element()
+		case "payee":
+			if d.null() {
+				r.Payee = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Payee = &v
+			}
+		case "recipient":
+			r.Recipient.decodeJSON(d)
+		case "amount":
+			r.Amount.decodeJSON(d)
+		case "paymentStatus":
+			if d.null() {
+				r.PaymentStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PaymentStatus = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PaymentNotice which shares no memory with the original
+func (r PaymentNotice) DeepCopy() PaymentNotice {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Request != nil {
+		v := r.Request.DeepCopy()
+		out.Request = &v
+	}
+	if r.Response != nil {
+		v := r.Response.DeepCopy()
+		out.Response = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.Provider != nil {
+		v := r.Provider.DeepCopy()
+		out.Provider = &v
+	}
+	out.Payment = r.Payment.DeepCopy()
+	if r.PaymentDate != nil {
+		v := *r.PaymentDate
+		out.PaymentDate = &v
+	}
+	if r.PaymentDateElement != nil {
+		v := r.PaymentDateElement.DeepCopy()
+		out.PaymentDateElement = &v
+	}
+	if r.Payee != nil {
+		v := r.Payee.DeepCopy()
+		out.Payee = &v
+	}
+	out.Recipient = r.Recipient.DeepCopy()
+	out.Amount = r.Amount.DeepCopy()
+	if r.PaymentStatus != nil {
+		v := r.PaymentStatus.DeepCopy()
+		out.PaymentStatus = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PaymentNotice) Equal(other PaymentNotice) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.Equal(*other.Request) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.Equal(*other.Response) {
+		return false
+	}
+	if r.Created != other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.Equal(*other.Provider) {
+		return false
+	}
+	if !r.Payment.Equal(other.Payment) {
+		return false
+	}
+	if (r.PaymentDate == nil) != (other.PaymentDate == nil) || r.PaymentDate != nil && *r.PaymentDate != *other.PaymentDate {
+		return false
+	}
+	if (r.PaymentDateElement == nil) != (other.PaymentDateElement == nil) || r.PaymentDateElement != nil && !r.PaymentDateElement.Equal(*other.PaymentDateElement) {
+		return false
+	}
+	if (r.Payee == nil) != (other.Payee == nil) || r.Payee != nil && !r.Payee.Equal(*other.Payee) {
+		return false
+	}
+	if !r.Recipient.Equal(other.Recipient) {
+		return false
+	}
+	if !r.Amount.Equal(other.Amount) {
+		return false
+	}
+	if (r.PaymentStatus == nil) != (other.PaymentStatus == nil) || r.PaymentStatus != nil && !r.PaymentStatus.Equal(*other.PaymentStatus) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PaymentNotice) EqualsDeep(other PaymentNotice) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.EqualsDeep(*other.Request) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.EqualsDeep(*other.Response) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.Provider == nil) != (other.Provider == nil) || r.Provider != nil && !r.Provider.EqualsDeep(*other.Provider) {
+		return false
+	}
+	if !r.Payment.EqualsDeep(other.Payment) {
+		return false
+	}
+	if (r.PaymentDate == nil) != (other.PaymentDate == nil) || r.PaymentDate != nil && !equivalentString(*r.PaymentDate, *other.PaymentDate) {
+		return false
+	}
+	if (r.PaymentDateElement == nil) != (other.PaymentDateElement == nil) || r.PaymentDateElement != nil && !r.PaymentDateElement.EqualsDeep(*other.PaymentDateElement) {
+		return false
+	}
+	if (r.Payee == nil) != (other.Payee == nil) || r.Payee != nil && !r.Payee.EqualsDeep(*other.Payee) {
+		return false
+	}
+	if !r.Recipient.EqualsDeep(other.Recipient) {
+		return false
+	}
+	if !r.Amount.EqualsDeep(other.Amount) {
+		return false
+	}
+	if (r.PaymentStatus == nil) != (other.PaymentStatus == nil) || r.PaymentStatus != nil && !r.PaymentStatus.EqualsDeep(*other.PaymentStatus) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PaymentNotice) EqualsShallow(other PaymentNotice) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.PaymentDate == nil) != (other.PaymentDate == nil) || r.PaymentDate != nil && !equivalentString(*r.PaymentDate, *other.PaymentDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PaymentNotice as FHIR XML
+func (r PaymentNotice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "PaymentNotice",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "request", r.Request); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "response", r.Response); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "provider", r.Provider); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "payment", r.Payment); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "paymentDate", r.PaymentDate, r.PaymentDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "payee", r.Payee); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "recipient", r.Recipient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "paymentStatus", r.PaymentStatus); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PaymentNotice from FHIR XML
+func (r *PaymentNotice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "request":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = &v
+			case "response":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Response = &v
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = v
+				}
+				r.CreatedElement = element
+			case "provider":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Provider = &v
+			case "payment":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payment = v
+			case "paymentDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PaymentDate = &v
+				}
+				r.PaymentDateElement = element
+			case "payee":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payee = &v
+			case "recipient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recipient = v
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = v
+			case "paymentStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PaymentStatus = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PaymentNotice as properties to the RDF node n
+func (r PaymentNotice) turtle(n *turtleNode) {
+	n.resource("PaymentNotice", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("PaymentNotice.identifier", i, v)
+	}
+	n.primitive("PaymentNotice.status", -1, r.Status, r.StatusElement, "code")
+	n.element("PaymentNotice.request", -1, r.Request)
+	n.element("PaymentNotice.response", -1, r.Response)
+	n.primitive("PaymentNotice.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("PaymentNotice.provider", -1, r.Provider)
+	n.element("PaymentNotice.payment", -1, r.Payment)
+	n.primitive("PaymentNotice.paymentDate", -1, r.PaymentDate, r.PaymentDateElement, "date")
+	n.element("PaymentNotice.payee", -1, r.Payee)
+	n.element("PaymentNotice.recipient", -1, r.Recipient)
+	n.element("PaymentNotice.amount", -1, r.Amount)
+	n.element("PaymentNotice.paymentStatus", -1, r.PaymentStatus)
+}
+
+// MarshalBSON marshals the given PaymentNotice as BSON document with the structure of its FHIR JSON
+func (r PaymentNotice) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PaymentNotice from a BSON document with the structure of its FHIR JSON
+func (r *PaymentNotice) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PaymentNotice as protobuf message PaymentNotice of fhir.proto
+func (r PaymentNotice) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PaymentNotice to the encoded message
+func (r PaymentNotice) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.Request != nil {
+		e.message(11, *r.Request)
+	}
+	if r.Response != nil {
+		e.message(12, *r.Response)
+	}
+	if r.Created != "" {
+		e.string(13, r.Created)
+	}
+	if r.Provider != nil {
+		e.message(14, *r.Provider)
+	}
+	e.message(15, r.Payment)
+	if r.PaymentDate != nil {
+		e.string(16, *r.PaymentDate)
+	}
+	if r.Payee != nil {
+		e.message(17, *r.Payee)
+	}
+	e.message(18, r.Recipient)
+	e.message(19, r.Amount)
+	if r.PaymentStatus != nil {
+		e.message(20, *r.PaymentStatus)
+	}
+	if r.IdElement != nil {
+		e.message(21, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(22, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(23, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(24, *r.StatusElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(25, *r.CreatedElement)
+	}
+	if r.PaymentDateElement != nil {
+		e.message(26, *r.PaymentDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PaymentNotice from protobuf message PaymentNotice of fhir.proto
+func (r *PaymentNotice) UnmarshalProto(b []byte) error {
+	*r = PaymentNotice{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Request = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Response = &v
+		case 13:
+			r.Created = d.string()
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Provider = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Payment = v
+		case 16:
+			v := d.string()
+			r.PaymentDate = &v
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.Payee = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Recipient = v
+		case 19:
+			var v Money
+			d.message(&v)
+			r.Amount = v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.PaymentStatus = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.PaymentDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalPaymentNotice unmarshals a PaymentNotice.
 func UnmarshalPaymentNotice(b []byte) (PaymentNotice, error) {
 	var paymentNotice PaymentNotice
-	if err := json.Unmarshal(b, &paymentNotice); err != nil {
+	if err := paymentNotice.UnmarshalJSON(b); err != nil {
 		return paymentNotice, err
 	}
 	return paymentNotice, nil
@@ -14,35 +14,47 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // PaymentReconciliation is documented here http://hl7.org/fhir/StructureDefinition/PaymentReconciliation
 type PaymentReconciliation struct {
-	Id                *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes       `bson:"status" json:"status"`
-	Period            *Period                            `bson:"period,omitempty" json:"period,omitempty"`
-	Created           string                             `bson:"created" json:"created"`
-	PaymentIssuer     *Reference                         `bson:"paymentIssuer,omitempty" json:"paymentIssuer,omitempty"`
-	Request           *Reference                         `bson:"request,omitempty" json:"request,omitempty"`
-	Requestor         *Reference                         `bson:"requestor,omitempty" json:"requestor,omitempty"`
-	Outcome           *ClaimProcessingCodes              `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	Disposition       *string                            `bson:"disposition,omitempty" json:"disposition,omitempty"`
-	PaymentDate       string                             `bson:"paymentDate" json:"paymentDate"`
-	PaymentAmount     Money                              `bson:"paymentAmount" json:"paymentAmount"`
-	PaymentIdentifier *Identifier                        `bson:"paymentIdentifier,omitempty" json:"paymentIdentifier,omitempty"`
-	Detail            []PaymentReconciliationDetail      `bson:"detail,omitempty" json:"detail,omitempty"`
-	FormCode          *CodeableConcept                   `bson:"formCode,omitempty" json:"formCode,omitempty"`
-	ProcessNote       []PaymentReconciliationProcessNote `bson:"processNote,omitempty" json:"processNote,omitempty"`
+	Id                   *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                           `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage                  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes       `bson:"status" json:"status"`
+	StatusElement        *Element                           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Period               *Period                            `bson:"period,omitempty" json:"period,omitempty"`
+	Created              string                             `bson:"created" json:"created"`
+	CreatedElement       *Element                           `bson:"_created,omitempty" json:"_created,omitempty"`
+	PaymentIssuer        *Reference                         `bson:"paymentIssuer,omitempty" json:"paymentIssuer,omitempty"`
+	Request              *Reference                         `bson:"request,omitempty" json:"request,omitempty"`
+	Requestor            *Reference                         `bson:"requestor,omitempty" json:"requestor,omitempty"`
+	Outcome              *ClaimProcessingCodes              `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	OutcomeElement       *Element                           `bson:"_outcome,omitempty" json:"_outcome,omitempty"`
+	Disposition          *string                            `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	DispositionElement   *Element                           `bson:"_disposition,omitempty" json:"_disposition,omitempty"`
+	PaymentDate          string                             `bson:"paymentDate" json:"paymentDate"`
+	PaymentDateElement   *Element                           `bson:"_paymentDate,omitempty" json:"_paymentDate,omitempty"`
+	PaymentAmount        Money                              `bson:"paymentAmount" json:"paymentAmount"`
+	PaymentIdentifier    *Identifier                        `bson:"paymentIdentifier,omitempty" json:"paymentIdentifier,omitempty"`
+	Detail               []PaymentReconciliationDetail      `bson:"detail,omitempty" json:"detail,omitempty"`
+	FormCode             *CodeableConcept                   `bson:"formCode,omitempty" json:"formCode,omitempty"`
+	ProcessNote          []PaymentReconciliationProcessNote `bson:"processNote,omitempty" json:"processNote,omitempty"`
 }
 type PaymentReconciliationDetail struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
@@ -55,6 +67,7 @@ type PaymentReconciliationDetail struct {
 	Submitter         *Reference      `bson:"submitter,omitempty" json:"submitter,omitempty"`
 	Response          *Reference      `bson:"response,omitempty" json:"response,omitempty"`
 	Date              *string         `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element        `bson:"_date,omitempty" json:"_date,omitempty"`
 	Responsible       *Reference      `bson:"responsible,omitempty" json:"responsible,omitempty"`
 	Payee             *Reference      `bson:"payee,omitempty" json:"payee,omitempty"`
 	Amount            *Money          `bson:"amount,omitempty" json:"amount,omitempty"`
@@ -64,25 +77,2448 @@ type PaymentReconciliationProcessNote struct {
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              *NoteType   `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement       *Element    `bson:"_type,omitempty" json:"_type,omitempty"`
 	Text              *string     `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement       *Element    `bson:"_text,omitempty" json:"_text,omitempty"`
 }
-type OtherPaymentReconciliation PaymentReconciliation
 
 // MarshalJSON marshals the given PaymentReconciliation as JSON into a byte slice
 func (r PaymentReconciliation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherPaymentReconciliation
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherPaymentReconciliation: OtherPaymentReconciliation(r),
-		ResourceType:               "PaymentReconciliation",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PaymentReconciliation as JSON object with the members in the order of the fields
+func (r PaymentReconciliation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"PaymentReconciliation\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.key("created")
+	e.string(r.Created)
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	if r.PaymentIssuer != nil {
+		e.key("paymentIssuer")
+		r.PaymentIssuer.appendJSON(e)
+	}
+	if r.Request != nil {
+		e.key("request")
+		r.Request.appendJSON(e)
+	}
+	if r.Requestor != nil {
+		e.key("requestor")
+		r.Requestor.appendJSON(e)
+	}
+	if r.Outcome != nil {
+		e.key("outcome")
+		e.string(r.Outcome.Code())
+	}
+	if r.OutcomeElement != nil {
+		e.key("_outcome")
+		r.OutcomeElement.appendJSON(e)
+	}
+	if r.Disposition != nil {
+		e.key("disposition")
+		e.string(*r.Disposition)
+	}
+	if r.DispositionElement != nil {
+		e.key("_disposition")
+		r.DispositionElement.appendJSON(e)
+	}
+	e.key("paymentDate")
+	e.string(r.PaymentDate)
+	if r.PaymentDateElement != nil {
+		e.key("_paymentDate")
+		r.PaymentDateElement.appendJSON(e)
+	}
+	e.key("paymentAmount")
+	r.PaymentAmount.appendJSON(e)
+	if r.PaymentIdentifier != nil {
+		e.key("paymentIdentifier")
+		r.PaymentIdentifier.appendJSON(e)
+	}
+	if len(r.Detail) > 0 {
+		e.key("detail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Detail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.FormCode != nil {
+		e.key("formCode")
+		r.FormCode.appendJSON(e)
+	}
+	if len(r.ProcessNote) > 0 {
+		e.key("processNote")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ProcessNote {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PaymentReconciliation from JSON. Unknown members are ignored.
+func (r *PaymentReconciliation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PaymentReconciliation
+func (r *PaymentReconciliation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = v
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "paymentIssuer":
+			if d.null() {
+				r.PaymentIssuer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.PaymentIssuer = &v
+			}
+		case "request":
+			if d.null() {
+				r.Request = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Request = &v
+			}
+		case "requestor":
+			if d.null() {
+				r.Requestor = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Requestor = &v
+			}
+		case "outcome":
+			if d.null() {
+				r.Outcome = nil
+			} else {
+				var v ClaimProcessingCodes
+				d.unmarshal(&v)
+				r.Outcome = &v
+			}
+		case "_outcome":
+			r.OutcomeElement = d.element()
+		case "disposition":
+			if v, ok := d.string(); ok {
+				r.Disposition = &v
+			} else {
+				r.Disposition = nil
+			}
+		case "_disposition":
+			r.DispositionElement = d.element()
+		case "paymentDate":
+			if v, ok := d.string(); ok {
+				r.PaymentDate = v
+			}
+		case "_paymentDate":
+			r.PaymentDateElement = d.element()
+		case "paymentAmount":
+			r.PaymentAmount.decodeJSON(d)
+		case "paymentIdentifier":
+			if d.null() {
+				r.PaymentIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.PaymentIdentifier = &v
+			}
+		case "detail":
+			a := d.array()
+			r.Detail = nil
+			for a.next() {
+				var v PaymentReconciliationDetail
+				v.decodeJSON(d)
+				r.Detail = append(r.Detail, v)
+			}
+			if r.Detail == nil && !a.isNull {
+				r.Detail = []PaymentReconciliationDetail{}
+			}
+		case "formCode":
+			if d.null() {
+				r.FormCode = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FormCode = &v
+			}
+		case "processNote":
+			a := d.array()
+			r.ProcessNote = nil
+			for a.next() {
+				var v PaymentReconciliationProcessNote
+				v.decodeJSON(d)
+				r.ProcessNote = append(r.ProcessNote, v)
+			}
+			if r.ProcessNote == nil && !a.isNull {
+				r.ProcessNote = []PaymentReconciliationProcessNote{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PaymentReconciliation which shares no memory with the original
+func (r PaymentReconciliation) DeepCopy() PaymentReconciliation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	if r.PaymentIssuer != nil {
+		v := r.PaymentIssuer.DeepCopy()
+		out.PaymentIssuer = &v
+	}
+	if r.Request != nil {
+		v := r.Request.DeepCopy()
+		out.Request = &v
+	}
+	if r.Requestor != nil {
+		v := r.Requestor.DeepCopy()
+		out.Requestor = &v
+	}
+	if r.Outcome != nil {
+		v := *r.Outcome
+		out.Outcome = &v
+	}
+	if r.OutcomeElement != nil {
+		v := r.OutcomeElement.DeepCopy()
+		out.OutcomeElement = &v
+	}
+	if r.Disposition != nil {
+		v := *r.Disposition
+		out.Disposition = &v
+	}
+	if r.DispositionElement != nil {
+		v := r.DispositionElement.DeepCopy()
+		out.DispositionElement = &v
+	}
+	if r.PaymentDateElement != nil {
+		v := r.PaymentDateElement.DeepCopy()
+		out.PaymentDateElement = &v
+	}
+	out.PaymentAmount = r.PaymentAmount.DeepCopy()
+	if r.PaymentIdentifier != nil {
+		v := r.PaymentIdentifier.DeepCopy()
+		out.PaymentIdentifier = &v
+	}
+	if r.Detail != nil {
+		out.Detail = make([]PaymentReconciliationDetail, len(r.Detail))
+		for i := range r.Detail {
+			out.Detail[i] = r.Detail[i].DeepCopy()
+		}
+	}
+	if r.FormCode != nil {
+		v := r.FormCode.DeepCopy()
+		out.FormCode = &v
+	}
+	if r.ProcessNote != nil {
+		out.ProcessNote = make([]PaymentReconciliationProcessNote, len(r.ProcessNote))
+		for i := range r.ProcessNote {
+			out.ProcessNote[i] = r.ProcessNote[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PaymentReconciliation) Equal(other PaymentReconciliation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if r.Created != other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if (r.PaymentIssuer == nil) != (other.PaymentIssuer == nil) || r.PaymentIssuer != nil && !r.PaymentIssuer.Equal(*other.PaymentIssuer) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.Equal(*other.Request) {
+		return false
+	}
+	if (r.Requestor == nil) != (other.Requestor == nil) || r.Requestor != nil && !r.Requestor.Equal(*other.Requestor) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && *r.Outcome != *other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.Equal(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && *r.Disposition != *other.Disposition {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.Equal(*other.DispositionElement) {
+		return false
+	}
+	if r.PaymentDate != other.PaymentDate {
+		return false
+	}
+	if (r.PaymentDateElement == nil) != (other.PaymentDateElement == nil) || r.PaymentDateElement != nil && !r.PaymentDateElement.Equal(*other.PaymentDateElement) {
+		return false
+	}
+	if !r.PaymentAmount.Equal(other.PaymentAmount) {
+		return false
+	}
+	if (r.PaymentIdentifier == nil) != (other.PaymentIdentifier == nil) || r.PaymentIdentifier != nil && !r.PaymentIdentifier.Equal(*other.PaymentIdentifier) {
+		return false
+	}
+	if len(r.Detail) != len(other.Detail) {
+		return false
+	}
+	for i := range r.Detail {
+		if !r.Detail[i].Equal(other.Detail[i]) {
+			return false
+		}
+	}
+	if (r.FormCode == nil) != (other.FormCode == nil) || r.FormCode != nil && !r.FormCode.Equal(*other.FormCode) {
+		return false
+	}
+	if len(r.ProcessNote) != len(other.ProcessNote) {
+		return false
+	}
+	for i := range r.ProcessNote {
+		if !r.ProcessNote[i].Equal(other.ProcessNote[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PaymentReconciliation) EqualsDeep(other PaymentReconciliation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if (r.PaymentIssuer == nil) != (other.PaymentIssuer == nil) || r.PaymentIssuer != nil && !r.PaymentIssuer.EqualsDeep(*other.PaymentIssuer) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.EqualsDeep(*other.Request) {
+		return false
+	}
+	if (r.Requestor == nil) != (other.Requestor == nil) || r.Requestor != nil && !r.Requestor.EqualsDeep(*other.Requestor) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && *r.Outcome != *other.Outcome {
+		return false
+	}
+	if (r.OutcomeElement == nil) != (other.OutcomeElement == nil) || r.OutcomeElement != nil && !r.OutcomeElement.EqualsDeep(*other.OutcomeElement) {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if (r.DispositionElement == nil) != (other.DispositionElement == nil) || r.DispositionElement != nil && !r.DispositionElement.EqualsDeep(*other.DispositionElement) {
+		return false
+	}
+	if !equivalentString(r.PaymentDate, other.PaymentDate) {
+		return false
+	}
+	if (r.PaymentDateElement == nil) != (other.PaymentDateElement == nil) || r.PaymentDateElement != nil && !r.PaymentDateElement.EqualsDeep(*other.PaymentDateElement) {
+		return false
+	}
+	if !r.PaymentAmount.EqualsDeep(other.PaymentAmount) {
+		return false
+	}
+	if (r.PaymentIdentifier == nil) != (other.PaymentIdentifier == nil) || r.PaymentIdentifier != nil && !r.PaymentIdentifier.EqualsDeep(*other.PaymentIdentifier) {
+		return false
+	}
+	if !equivalentList(len(r.Detail), len(other.Detail), func(i, j int) bool {
+		return r.Detail[i].EqualsDeep(other.Detail[j])
+	}) {
+		return false
+	}
+	if (r.FormCode == nil) != (other.FormCode == nil) || r.FormCode != nil && !r.FormCode.EqualsDeep(*other.FormCode) {
+		return false
+	}
+	if !equivalentList(len(r.ProcessNote), len(other.ProcessNote), func(i, j int) bool {
+		return r.ProcessNote[i].EqualsDeep(other.ProcessNote[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PaymentReconciliation) EqualsShallow(other PaymentReconciliation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && *r.Outcome != *other.Outcome {
+		return false
+	}
+	if (r.Disposition == nil) != (other.Disposition == nil) || r.Disposition != nil && !equivalentString(*r.Disposition, *other.Disposition) {
+		return false
+	}
+	if !equivalentString(r.PaymentDate, other.PaymentDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PaymentReconciliation as FHIR XML
+func (r PaymentReconciliation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "PaymentReconciliation",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "paymentIssuer", r.PaymentIssuer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "request", r.Request); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requestor", r.Requestor); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "outcome", r.Outcome, r.OutcomeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "disposition", r.Disposition, r.DispositionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "paymentDate", r.PaymentDate, r.PaymentDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "paymentAmount", r.PaymentAmount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "paymentIdentifier", r.PaymentIdentifier); err != nil {
+		return err
+	}
+	for _, v := range r.Detail {
+		if err := encodeXMLElement(e, "detail", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "formCode", r.FormCode); err != nil {
+		return err
+	}
+	for _, v := range r.ProcessNote {
+		if err := encodeXMLElement(e, "processNote", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PaymentReconciliation from FHIR XML
+func (r *PaymentReconciliation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = v
+				}
+				r.CreatedElement = element
+			case "paymentIssuer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PaymentIssuer = &v
+			case "request":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = &v
+			case "requestor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requestor = &v
+			case "outcome":
+				var v ClaimProcessingCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Outcome = &v
+				}
+				r.OutcomeElement = element
+			case "disposition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Disposition = &v
+				}
+				r.DispositionElement = element
+			case "paymentDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PaymentDate = v
+				}
+				r.PaymentDateElement = element
+			case "paymentAmount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PaymentAmount = v
+			case "paymentIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PaymentIdentifier = &v
+			case "detail":
+				var v PaymentReconciliationDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Detail = append(r.Detail, v)
+			case "formCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FormCode = &v
+			case "processNote":
+				var v PaymentReconciliationProcessNote
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProcessNote = append(r.ProcessNote, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PaymentReconciliation as properties to the RDF node n
+func (r PaymentReconciliation) turtle(n *turtleNode) {
+	n.resource("PaymentReconciliation", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("PaymentReconciliation.identifier", i, v)
+	}
+	n.primitive("PaymentReconciliation.status", -1, r.Status, r.StatusElement, "code")
+	n.element("PaymentReconciliation.period", -1, r.Period)
+	n.primitive("PaymentReconciliation.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("PaymentReconciliation.paymentIssuer", -1, r.PaymentIssuer)
+	n.element("PaymentReconciliation.request", -1, r.Request)
+	n.element("PaymentReconciliation.requestor", -1, r.Requestor)
+	n.primitive("PaymentReconciliation.outcome", -1, r.Outcome, r.OutcomeElement, "code")
+	n.primitive("PaymentReconciliation.disposition", -1, r.Disposition, r.DispositionElement, "string")
+	n.primitive("PaymentReconciliation.paymentDate", -1, r.PaymentDate, r.PaymentDateElement, "date")
+	n.element("PaymentReconciliation.paymentAmount", -1, r.PaymentAmount)
+	n.element("PaymentReconciliation.paymentIdentifier", -1, r.PaymentIdentifier)
+	for i, v := range r.Detail {
+		n.element("PaymentReconciliation.detail", i, v)
+	}
+	n.element("PaymentReconciliation.formCode", -1, r.FormCode)
+	for i, v := range r.ProcessNote {
+		n.element("PaymentReconciliation.processNote", i, v)
+	}
+}
+
+// MarshalBSON marshals the given PaymentReconciliation as BSON document with the structure of its FHIR JSON
+func (r PaymentReconciliation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PaymentReconciliation from a BSON document with the structure of its FHIR JSON
+func (r *PaymentReconciliation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PaymentReconciliation as protobuf message PaymentReconciliation of fhir.proto
+func (r PaymentReconciliation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PaymentReconciliation to the encoded message
+func (r PaymentReconciliation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.Period != nil {
+		e.message(11, *r.Period)
+	}
+	if r.Created != "" {
+		e.string(12, r.Created)
+	}
+	if r.PaymentIssuer != nil {
+		e.message(13, *r.PaymentIssuer)
+	}
+	if r.Request != nil {
+		e.message(14, *r.Request)
+	}
+	if r.Requestor != nil {
+		e.message(15, *r.Requestor)
+	}
+	if r.Outcome != nil {
+		e.varint(16, r.Outcome.protoNumber())
+	}
+	if r.Disposition != nil {
+		e.string(17, *r.Disposition)
+	}
+	if r.PaymentDate != "" {
+		e.string(18, r.PaymentDate)
+	}
+	e.message(19, r.PaymentAmount)
+	if r.PaymentIdentifier != nil {
+		e.message(20, *r.PaymentIdentifier)
+	}
+	for _, v := range r.Detail {
+		e.message(21, v)
+	}
+	if r.FormCode != nil {
+		e.message(22, *r.FormCode)
+	}
+	for _, v := range r.ProcessNote {
+		e.message(23, v)
+	}
+	if r.IdElement != nil {
+		e.message(24, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(25, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(26, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(27, *r.StatusElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(28, *r.CreatedElement)
+	}
+	if r.OutcomeElement != nil {
+		e.message(29, *r.OutcomeElement)
+	}
+	if r.DispositionElement != nil {
+		e.message(30, *r.DispositionElement)
+	}
+	if r.PaymentDateElement != nil {
+		e.message(31, *r.PaymentDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PaymentReconciliation from protobuf message PaymentReconciliation of fhir.proto
+func (r *PaymentReconciliation) UnmarshalProto(b []byte) error {
+	*r = PaymentReconciliation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 12:
+			r.Created = d.string()
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.PaymentIssuer = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Request = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Requestor = &v
+		case 16:
+			var v ClaimProcessingCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Outcome = &v
+		case 17:
+			v := d.string()
+			r.Disposition = &v
+		case 18:
+			r.PaymentDate = d.string()
+		case 19:
+			var v Money
+			d.message(&v)
+			r.PaymentAmount = v
+		case 20:
+			var v Identifier
+			d.message(&v)
+			r.PaymentIdentifier = &v
+		case 21:
+			var v PaymentReconciliationDetail
+			d.message(&v)
+			r.Detail = append(r.Detail, v)
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.FormCode = &v
+		case 23:
+			var v PaymentReconciliationProcessNote
+			d.message(&v)
+			r.ProcessNote = append(r.ProcessNote, v)
+		case 24:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.OutcomeElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.DispositionElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.PaymentDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PaymentReconciliationDetail as JSON into a byte slice
+func (r PaymentReconciliationDetail) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PaymentReconciliationDetail as JSON object with the members in the order of the fields
+func (r PaymentReconciliationDetail) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Predecessor != nil {
+		e.key("predecessor")
+		r.Predecessor.appendJSON(e)
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.Request != nil {
+		e.key("request")
+		r.Request.appendJSON(e)
+	}
+	if r.Submitter != nil {
+		e.key("submitter")
+		r.Submitter.appendJSON(e)
+	}
+	if r.Response != nil {
+		e.key("response")
+		r.Response.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Responsible != nil {
+		e.key("responsible")
+		r.Responsible.appendJSON(e)
+	}
+	if r.Payee != nil {
+		e.key("payee")
+		r.Payee.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PaymentReconciliationDetail from JSON. Unknown members are ignored.
+func (r *PaymentReconciliationDetail) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PaymentReconciliationDetail
+func (r *PaymentReconciliationDetail) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "predecessor":
+			if d.null() {
+				r.Predecessor = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Predecessor = &v
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "request":
+			if d.null() {
+				r.Request = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Request = &v
+			}
+		case "submitter":
+			if d.null() {
+				r.Submitter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Submitter = &v
+			}
+		case "response":
+			if d.null() {
+				r.Response = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Response = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "responsible":
+			if d.null() {
+				r.Responsible = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Responsible = &v
+			}
+		case "payee":
+			if d.null() {
+				r.Payee = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Payee = &v
+			}
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PaymentReconciliationDetail which shares no memory with the original
+func (r PaymentReconciliationDetail) DeepCopy() PaymentReconciliationDetail {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Predecessor != nil {
+		v := r.Predecessor.DeepCopy()
+		out.Predecessor = &v
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.Request != nil {
+		v := r.Request.DeepCopy()
+		out.Request = &v
+	}
+	if r.Submitter != nil {
+		v := r.Submitter.DeepCopy()
+		out.Submitter = &v
+	}
+	if r.Response != nil {
+		v := r.Response.DeepCopy()
+		out.Response = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Responsible != nil {
+		v := r.Responsible.DeepCopy()
+		out.Responsible = &v
+	}
+	if r.Payee != nil {
+		v := r.Payee.DeepCopy()
+		out.Payee = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PaymentReconciliationDetail) Equal(other PaymentReconciliationDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Predecessor == nil) != (other.Predecessor == nil) || r.Predecessor != nil && !r.Predecessor.Equal(*other.Predecessor) {
+		return false
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.Equal(*other.Request) {
+		return false
+	}
+	if (r.Submitter == nil) != (other.Submitter == nil) || r.Submitter != nil && !r.Submitter.Equal(*other.Submitter) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.Equal(*other.Response) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && !r.Responsible.Equal(*other.Responsible) {
+		return false
+	}
+	if (r.Payee == nil) != (other.Payee == nil) || r.Payee != nil && !r.Payee.Equal(*other.Payee) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PaymentReconciliationDetail) EqualsDeep(other PaymentReconciliationDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Predecessor == nil) != (other.Predecessor == nil) || r.Predecessor != nil && !r.Predecessor.EqualsDeep(*other.Predecessor) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.Request == nil) != (other.Request == nil) || r.Request != nil && !r.Request.EqualsDeep(*other.Request) {
+		return false
+	}
+	if (r.Submitter == nil) != (other.Submitter == nil) || r.Submitter != nil && !r.Submitter.EqualsDeep(*other.Submitter) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && !r.Response.EqualsDeep(*other.Response) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Responsible == nil) != (other.Responsible == nil) || r.Responsible != nil && !r.Responsible.EqualsDeep(*other.Responsible) {
+		return false
+	}
+	if (r.Payee == nil) != (other.Payee == nil) || r.Payee != nil && !r.Payee.EqualsDeep(*other.Payee) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PaymentReconciliationDetail) EqualsShallow(other PaymentReconciliationDetail) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PaymentReconciliationDetail as FHIR XML
+func (r PaymentReconciliationDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "predecessor", r.Predecessor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "request", r.Request); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "submitter", r.Submitter); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "response", r.Response); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "responsible", r.Responsible); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "payee", r.Payee); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PaymentReconciliationDetail from FHIR XML
+func (r *PaymentReconciliationDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "predecessor":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Predecessor = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "request":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = &v
+			case "submitter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Submitter = &v
+			case "response":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Response = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "responsible":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Responsible = &v
+			case "payee":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Payee = &v
+			case "amount":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PaymentReconciliationDetail as properties to the RDF node n
+func (r PaymentReconciliationDetail) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("PaymentReconciliation.detail.identifier", -1, r.Identifier)
+	n.element("PaymentReconciliation.detail.predecessor", -1, r.Predecessor)
+	n.element("PaymentReconciliation.detail.type", -1, r.Type)
+	n.element("PaymentReconciliation.detail.request", -1, r.Request)
+	n.element("PaymentReconciliation.detail.submitter", -1, r.Submitter)
+	n.element("PaymentReconciliation.detail.response", -1, r.Response)
+	n.primitive("PaymentReconciliation.detail.date", -1, r.Date, r.DateElement, "date")
+	n.element("PaymentReconciliation.detail.responsible", -1, r.Responsible)
+	n.element("PaymentReconciliation.detail.payee", -1, r.Payee)
+	n.element("PaymentReconciliation.detail.amount", -1, r.Amount)
+}
+
+// MarshalBSON marshals the given PaymentReconciliationDetail as BSON document with the structure of its FHIR JSON
+func (r PaymentReconciliationDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PaymentReconciliationDetail from a BSON document with the structure of its FHIR JSON
+func (r *PaymentReconciliationDetail) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PaymentReconciliationDetail as protobuf message PaymentReconciliationDetail of fhir.proto
+func (r PaymentReconciliationDetail) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PaymentReconciliationDetail to the encoded message
+func (r PaymentReconciliationDetail) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identifier != nil {
+		e.message(4, *r.Identifier)
+	}
+	if r.Predecessor != nil {
+		e.message(5, *r.Predecessor)
+	}
+	e.message(6, r.Type)
+	if r.Request != nil {
+		e.message(7, *r.Request)
+	}
+	if r.Submitter != nil {
+		e.message(8, *r.Submitter)
+	}
+	if r.Response != nil {
+		e.message(9, *r.Response)
+	}
+	if r.Date != nil {
+		e.string(10, *r.Date)
+	}
+	if r.Responsible != nil {
+		e.message(11, *r.Responsible)
+	}
+	if r.Payee != nil {
+		e.message(12, *r.Payee)
+	}
+	if r.Amount != nil {
+		e.message(13, *r.Amount)
+	}
+	if r.DateElement != nil {
+		e.message(14, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PaymentReconciliationDetail from protobuf message PaymentReconciliationDetail of fhir.proto
+func (r *PaymentReconciliationDetail) UnmarshalProto(b []byte) error {
+	*r = PaymentReconciliationDetail{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.Predecessor = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Request = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.Submitter = &v
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Response = &v
+		case 10:
+			v := d.string()
+			r.Date = &v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Responsible = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Payee = &v
+		case 13:
+			var v Money
+			d.message(&v)
+			r.Amount = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PaymentReconciliationProcessNote as JSON into a byte slice
+func (r PaymentReconciliationProcessNote) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PaymentReconciliationProcessNote as JSON object with the members in the order of the fields
+func (r PaymentReconciliationProcessNote) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(r.Type.Code())
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PaymentReconciliationProcessNote from JSON. Unknown members are ignored.
+func (r *PaymentReconciliationProcessNote) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PaymentReconciliationProcessNote
+func (r *PaymentReconciliationProcessNote) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v NoteType
+				d.unmarshal(&v)
+				r.Type = &v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PaymentReconciliationProcessNote which shares no memory with the original
+func (r PaymentReconciliationProcessNote) DeepCopy() PaymentReconciliationProcessNote {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PaymentReconciliationProcessNote) Equal(other PaymentReconciliationProcessNote) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PaymentReconciliationProcessNote) EqualsDeep(other PaymentReconciliationProcessNote) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PaymentReconciliationProcessNote) EqualsShallow(other PaymentReconciliationProcessNote) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PaymentReconciliationProcessNote as FHIR XML
+func (r PaymentReconciliationProcessNote) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PaymentReconciliationProcessNote from FHIR XML
+func (r *PaymentReconciliationProcessNote) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v NoteType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PaymentReconciliationProcessNote as properties to the RDF node n
+func (r PaymentReconciliationProcessNote) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("PaymentReconciliation.processNote.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("PaymentReconciliation.processNote.text", -1, r.Text, r.TextElement, "string")
+}
+
+// MarshalBSON marshals the given PaymentReconciliationProcessNote as BSON document with the structure of its FHIR JSON
+func (r PaymentReconciliationProcessNote) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PaymentReconciliationProcessNote from a BSON document with the structure of its FHIR JSON
+func (r *PaymentReconciliationProcessNote) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PaymentReconciliationProcessNote as protobuf message PaymentReconciliationProcessNote of fhir.proto
+func (r PaymentReconciliationProcessNote) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PaymentReconciliationProcessNote to the encoded message
+func (r PaymentReconciliationProcessNote) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.varint(4, r.Type.protoNumber())
+	}
+	if r.Text != nil {
+		e.string(5, *r.Text)
+	}
+	if r.TypeElement != nil {
+		e.message(6, *r.TypeElement)
+	}
+	if r.TextElement != nil {
+		e.message(7, *r.TextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PaymentReconciliationProcessNote from protobuf message PaymentReconciliationProcessNote of fhir.proto
+func (r *PaymentReconciliationProcessNote) UnmarshalProto(b []byte) error {
+	*r = PaymentReconciliationProcessNote{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v NoteType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = &v
+		case 5:
+			v := d.string()
+			r.Text = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalPaymentReconciliation unmarshals a PaymentReconciliation.
 func UnmarshalPaymentReconciliation(b []byte) (PaymentReconciliation, error) {
 	var paymentReconciliation PaymentReconciliation
-	if err := json.Unmarshal(b, &paymentReconciliation); err != nil {
+	if err := paymentReconciliation.UnmarshalJSON(b); err != nil {
 		return paymentReconciliation, err
 	}
 	return paymentReconciliation, nil
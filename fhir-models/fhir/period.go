@@ -14,13 +14,374 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Period is documented here http://hl7.org/fhir/StructureDefinition/Period
 type Period struct {
-	Id        *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Start     *string     `bson:"start,omitempty" json:"start,omitempty"`
-	End       *string     `bson:"end,omitempty" json:"end,omitempty"`
+	Id           *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension    []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Start        *string     `bson:"start,omitempty" json:"start,omitempty"`
+	StartElement *Element    `bson:"_start,omitempty" json:"_start,omitempty"`
+	End          *string     `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement   *Element    `bson:"_end,omitempty" json:"_end,omitempty"`
+}
+
+// MarshalJSON marshals the given Period as JSON into a byte slice
+func (r Period) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Period as JSON object with the members in the order of the fields
+func (r Period) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Start != nil {
+		e.key("start")
+		e.string(*r.Start)
+	}
+	if r.StartElement != nil {
+		e.key("_start")
+		r.StartElement.appendJSON(e)
+	}
+	if r.End != nil {
+		e.key("end")
+		e.string(*r.End)
+	}
+	if r.EndElement != nil {
+		e.key("_end")
+		r.EndElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Period from JSON. Unknown members are ignored.
+func (r *Period) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Period
+func (r *Period) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "start":
+			if v, ok := d.string(); ok {
+				r.Start = &v
+			} else {
+				r.Start = nil
+			}
+		case "_start":
+			r.StartElement = d.element()
+		case "end":
+			if v, ok := d.string(); ok {
+				r.End = &v
+			} else {
+				r.End = nil
+			}
+		case "_end":
+			r.EndElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Period which shares no memory with the original
+func (r Period) DeepCopy() Period {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Start != nil {
+		v := *r.Start
+		out.Start = &v
+	}
+	if r.StartElement != nil {
+		v := r.StartElement.DeepCopy()
+		out.StartElement = &v
+	}
+	if r.End != nil {
+		v := *r.End
+		out.End = &v
+	}
+	if r.EndElement != nil {
+		v := r.EndElement.DeepCopy()
+		out.EndElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Period) Equal(other Period) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && *r.Start != *other.Start {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.Equal(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.Equal(*other.EndElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Period) EqualsDeep(other Period) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && !equivalentString(*r.Start, *other.Start) {
+		return false
+	}
+	if (r.StartElement == nil) != (other.StartElement == nil) || r.StartElement != nil && !r.StartElement.EqualsDeep(*other.StartElement) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && !equivalentString(*r.End, *other.End) {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.EqualsDeep(*other.EndElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Period) EqualsShallow(other Period) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && !equivalentString(*r.Start, *other.Start) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && !equivalentString(*r.End, *other.End) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Period as FHIR XML
+func (r Period) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "start", r.Start, r.StartElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "end", r.End, r.EndElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Period from FHIR XML
+func (r *Period) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "start":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Start = &v
+				}
+				r.StartElement = element
+			case "end":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.End = &v
+				}
+				r.EndElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Period as properties to the RDF node n
+func (r Period) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.primitive("Period.start", -1, r.Start, r.StartElement, "dateTime")
+	n.primitive("Period.end", -1, r.End, r.EndElement, "dateTime")
+}
+
+// MarshalBSON marshals the given Period as BSON document with the structure of its FHIR JSON
+func (r Period) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Period from a BSON document with the structure of its FHIR JSON
+func (r *Period) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Period as protobuf message Period of fhir.proto
+func (r Period) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Period to the encoded message
+func (r Period) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Start != nil {
+		e.string(3, *r.Start)
+	}
+	if r.End != nil {
+		e.string(4, *r.End)
+	}
+	if r.StartElement != nil {
+		e.message(5, *r.StartElement)
+	}
+	if r.EndElement != nil {
+		e.message(6, *r.EndElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Period from protobuf message Period of fhir.proto
+func (r *Period) UnmarshalProto(b []byte) error {
+	*r = Period{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			v := d.string()
+			r.Start = &v
+		case 4:
+			v := d.string()
+			r.End = &v
+		case 5:
+			var v Element
+			d.message(&v)
+			r.StartElement = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.EndElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,21 +25,28 @@ import "encoding/json"
 // Person is documented here http://hl7.org/fhir/StructureDefinition/Person
 type Person struct {
 	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element              `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element              `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage     `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Name                 []HumanName           `bson:"name,omitempty" json:"name,omitempty"`
 	Telecom              []ContactPoint        `bson:"telecom,omitempty" json:"telecom,omitempty"`
 	Gender               *AdministrativeGender `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement        *Element              `bson:"_gender,omitempty" json:"_gender,omitempty"`
 	BirthDate            *string               `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	BirthDateElement     *Element              `bson:"_birthDate,omitempty" json:"_birthDate,omitempty"`
 	Address              []Address             `bson:"address,omitempty" json:"address,omitempty"`
 	Photo                *Attachment           `bson:"photo,omitempty" json:"photo,omitempty"`
 	ManagingOrganization *Reference            `bson:"managingOrganization,omitempty" json:"managingOrganization,omitempty"`
 	Active               *bool                 `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element              `bson:"_active,omitempty" json:"_active,omitempty"`
 	Link                 []PersonLink          `bson:"link,omitempty" json:"link,omitempty"`
 }
 type PersonLink struct {
@@ -45,24 +55,1552 @@ type PersonLink struct {
 	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Target            Reference               `bson:"target" json:"target"`
 	Assurance         *IdentityAssuranceLevel `bson:"assurance,omitempty" json:"assurance,omitempty"`
+	AssuranceElement  *Element                `bson:"_assurance,omitempty" json:"_assurance,omitempty"`
 }
-type OtherPerson Person
 
 // MarshalJSON marshals the given Person as JSON into a byte slice
 func (r Person) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherPerson
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherPerson:  OtherPerson(r),
-		ResourceType: "Person",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Person as JSON object with the members in the order of the fields
+func (r Person) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Person\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Name) > 0 {
+		e.key("name")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Name {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Gender != nil {
+		e.key("gender")
+		e.string(r.Gender.Code())
+	}
+	if r.GenderElement != nil {
+		e.key("_gender")
+		r.GenderElement.appendJSON(e)
+	}
+	if r.BirthDate != nil {
+		e.key("birthDate")
+		e.string(*r.BirthDate)
+	}
+	if r.BirthDateElement != nil {
+		e.key("_birthDate")
+		r.BirthDateElement.appendJSON(e)
+	}
+	if len(r.Address) > 0 {
+		e.key("address")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Address {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Photo != nil {
+		e.key("photo")
+		r.Photo.appendJSON(e)
+	}
+	if r.ManagingOrganization != nil {
+		e.key("managingOrganization")
+		r.ManagingOrganization.appendJSON(e)
+	}
+	if r.Active != nil {
+		e.key("active")
+		e.bool(*r.Active)
+	}
+	if r.ActiveElement != nil {
+		e.key("_active")
+		r.ActiveElement.appendJSON(e)
+	}
+	if len(r.Link) > 0 {
+		e.key("link")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Link {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Person from JSON. Unknown members are ignored.
+func (r *Person) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Person
+func (r *Person) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "name":
+			a := d.array()
+			r.Name = nil
+			for a.next() {
+				var v HumanName
+				v.decodeJSON(d)
+				r.Name = append(r.Name, v)
+			}
+			if r.Name == nil && !a.isNull {
+				r.Name = []HumanName{}
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "gender":
+			if d.null() {
+				r.Gender = nil
+			} else {
+				var v AdministrativeGender
+				d.unmarshal(&v)
+				r.Gender = &v
+			}
+		case "_gender":
+			r.GenderElement = d.element()
+		case "birthDate":
+			if v, ok := d.string(); ok {
+				r.BirthDate = &v
+			} else {
+				r.BirthDate = nil
+			}
+		case "_birthDate":
+			r.BirthDateElement = d.element()
+		case "address":
+			a := d.array()
+			r.Address = nil
+			for a.next() {
+				var v Address
+				v.decodeJSON(d)
+				r.Address = append(r.Address, v)
+			}
+			if r.Address == nil && !a.isNull {
+				r.Address = []Address{}
+			}
+		case "photo":
+			if d.null() {
+				r.Photo = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Photo = &v
+			}
+		case "managingOrganization":
+			if d.null() {
+				r.ManagingOrganization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ManagingOrganization = &v
+			}
+		case "active":
+			if v, ok := d.bool(); ok {
+				r.Active = &v
+			} else {
+				r.Active = nil
+			}
+		case "_active":
+			r.ActiveElement = d.element()
+		case "link":
+			a := d.array()
+			r.Link = nil
+			for a.next() {
+				var v PersonLink
+				v.decodeJSON(d)
+				r.Link = append(r.Link, v)
+			}
+			if r.Link == nil && !a.isNull {
+				r.Link = []PersonLink{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Person which shares no memory with the original
+func (r Person) DeepCopy() Person {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		out.Name = make([]HumanName, len(r.Name))
+		for i := range r.Name {
+			out.Name[i] = r.Name[i].DeepCopy()
+		}
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.Gender != nil {
+		v := *r.Gender
+		out.Gender = &v
+	}
+	if r.GenderElement != nil {
+		v := r.GenderElement.DeepCopy()
+		out.GenderElement = &v
+	}
+	if r.BirthDate != nil {
+		v := *r.BirthDate
+		out.BirthDate = &v
+	}
+	if r.BirthDateElement != nil {
+		v := r.BirthDateElement.DeepCopy()
+		out.BirthDateElement = &v
+	}
+	if r.Address != nil {
+		out.Address = make([]Address, len(r.Address))
+		for i := range r.Address {
+			out.Address[i] = r.Address[i].DeepCopy()
+		}
+	}
+	if r.Photo != nil {
+		v := r.Photo.DeepCopy()
+		out.Photo = &v
+	}
+	if r.ManagingOrganization != nil {
+		v := r.ManagingOrganization.DeepCopy()
+		out.ManagingOrganization = &v
+	}
+	if r.Active != nil {
+		v := *r.Active
+		out.Active = &v
+	}
+	if r.ActiveElement != nil {
+		v := r.ActiveElement.DeepCopy()
+		out.ActiveElement = &v
+	}
+	if r.Link != nil {
+		out.Link = make([]PersonLink, len(r.Link))
+		for i := range r.Link {
+			out.Link[i] = r.Link[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Person) Equal(other Person) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.Name) != len(other.Name) {
+		return false
+	}
+	for i := range r.Name {
+		if !r.Name[i].Equal(other.Name[i]) {
+			return false
+		}
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.Equal(*other.GenderElement) {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && *r.BirthDate != *other.BirthDate {
+		return false
+	}
+	if (r.BirthDateElement == nil) != (other.BirthDateElement == nil) || r.BirthDateElement != nil && !r.BirthDateElement.Equal(*other.BirthDateElement) {
+		return false
+	}
+	if len(r.Address) != len(other.Address) {
+		return false
+	}
+	for i := range r.Address {
+		if !r.Address[i].Equal(other.Address[i]) {
+			return false
+		}
+	}
+	if (r.Photo == nil) != (other.Photo == nil) || r.Photo != nil && !r.Photo.Equal(*other.Photo) {
+		return false
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.Equal(*other.ManagingOrganization) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.Equal(*other.ActiveElement) {
+		return false
+	}
+	if len(r.Link) != len(other.Link) {
+		return false
+	}
+	for i := range r.Link {
+		if !r.Link[i].Equal(other.Link[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Person) EqualsDeep(other Person) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Name), len(other.Name), func(i, j int) bool {
+		return r.Name[i].EqualsDeep(other.Name[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.EqualsDeep(*other.GenderElement) {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && !equivalentString(*r.BirthDate, *other.BirthDate) {
+		return false
+	}
+	if (r.BirthDateElement == nil) != (other.BirthDateElement == nil) || r.BirthDateElement != nil && !r.BirthDateElement.EqualsDeep(*other.BirthDateElement) {
+		return false
+	}
+	if !equivalentList(len(r.Address), len(other.Address), func(i, j int) bool {
+		return r.Address[i].EqualsDeep(other.Address[j])
+	}) {
+		return false
+	}
+	if (r.Photo == nil) != (other.Photo == nil) || r.Photo != nil && !r.Photo.EqualsDeep(*other.Photo) {
+		return false
+	}
+	if (r.ManagingOrganization == nil) != (other.ManagingOrganization == nil) || r.ManagingOrganization != nil && !r.ManagingOrganization.EqualsDeep(*other.ManagingOrganization) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.EqualsDeep(*other.ActiveElement) {
+		return false
+	}
+	if !equivalentList(len(r.Link), len(other.Link), func(i, j int) bool {
+		return r.Link[i].EqualsDeep(other.Link[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Person) EqualsShallow(other Person) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && !equivalentString(*r.BirthDate, *other.BirthDate) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Person as FHIR XML
+func (r Person) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Person",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Name {
+		if err := encodeXMLElement(e, "name", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "gender", r.Gender, r.GenderElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "birthDate", r.BirthDate, r.BirthDateElement); err != nil {
+		return err
+	}
+	for _, v := range r.Address {
+		if err := encodeXMLElement(e, "address", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "photo", r.Photo); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "managingOrganization", r.ManagingOrganization); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "active", r.Active, r.ActiveElement); err != nil {
+		return err
+	}
+	for _, v := range r.Link {
+		if err := encodeXMLElement(e, "link", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Person from FHIR XML
+func (r *Person) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "name":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Name = append(r.Name, v)
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "gender":
+				var v AdministrativeGender
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Gender = &v
+				}
+				r.GenderElement = element
+			case "birthDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BirthDate = &v
+				}
+				r.BirthDateElement = element
+			case "address":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Address = append(r.Address, v)
+			case "photo":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Photo = &v
+			case "managingOrganization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ManagingOrganization = &v
+			case "active":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Active = &v
+				}
+				r.ActiveElement = element
+			case "link":
+				var v PersonLink
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Link = append(r.Link, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Person as properties to the RDF node n
+func (r Person) turtle(n *turtleNode) {
+	n.resource("Person", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Person.identifier", i, v)
+	}
+	for i, v := range r.Name {
+		n.element("Person.name", i, v)
+	}
+	for i, v := range r.Telecom {
+		n.element("Person.telecom", i, v)
+	}
+	n.primitive("Person.gender", -1, r.Gender, r.GenderElement, "code")
+	n.primitive("Person.birthDate", -1, r.BirthDate, r.BirthDateElement, "date")
+	for i, v := range r.Address {
+		n.element("Person.address", i, v)
+	}
+	n.element("Person.photo", -1, r.Photo)
+	n.element("Person.managingOrganization", -1, r.ManagingOrganization)
+	n.primitive("Person.active", -1, r.Active, r.ActiveElement, "boolean")
+	for i, v := range r.Link {
+		n.element("Person.link", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Person as BSON document with the structure of its FHIR JSON
+func (r Person) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Person from a BSON document with the structure of its FHIR JSON
+func (r *Person) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Person as protobuf message Person of fhir.proto
+func (r Person) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Person to the encoded message
+func (r Person) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.Name {
+		e.message(10, v)
+	}
+	for _, v := range r.Telecom {
+		e.message(11, v)
+	}
+	if r.Gender != nil {
+		e.varint(12, r.Gender.protoNumber())
+	}
+	if r.BirthDate != nil {
+		e.string(13, *r.BirthDate)
+	}
+	for _, v := range r.Address {
+		e.message(14, v)
+	}
+	if r.Photo != nil {
+		e.message(15, *r.Photo)
+	}
+	if r.ManagingOrganization != nil {
+		e.message(16, *r.ManagingOrganization)
+	}
+	if r.Active != nil {
+		e.varint(17, protoBool(*r.Active))
+	}
+	for _, v := range r.Link {
+		e.message(18, v)
+	}
+	if r.IdElement != nil {
+		e.message(19, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(20, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(21, *r.LanguageElement)
+	}
+	if r.GenderElement != nil {
+		e.message(22, *r.GenderElement)
+	}
+	if r.BirthDateElement != nil {
+		e.message(23, *r.BirthDateElement)
+	}
+	if r.ActiveElement != nil {
+		e.message(24, *r.ActiveElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Person from protobuf message Person of fhir.proto
+func (r *Person) UnmarshalProto(b []byte) error {
+	*r = Person{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v HumanName
+			d.message(&v)
+			r.Name = append(r.Name, v)
+		case 11:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 12:
+			var v AdministrativeGender
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Gender = &v
+		case 13:
+			v := d.string()
+			r.BirthDate = &v
+		case 14:
+			var v Address
+			d.message(&v)
+			r.Address = append(r.Address, v)
+		case 15:
+			var v Attachment
+			d.message(&v)
+			r.Photo = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.ManagingOrganization = &v
+		case 17:
+			v := d.bool()
+			r.Active = &v
+		case 18:
+			var v PersonLink
+			d.message(&v)
+			r.Link = append(r.Link, v)
+		case 19:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.GenderElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.BirthDateElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.ActiveElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PersonLink as JSON into a byte slice
+func (r PersonLink) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PersonLink as JSON object with the members in the order of the fields
+func (r PersonLink) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("target")
+	r.Target.appendJSON(e)
+	if r.Assurance != nil {
+		e.key("assurance")
+		e.string(r.Assurance.Code())
+	}
+	if r.AssuranceElement != nil {
+		e.key("_assurance")
+		r.AssuranceElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PersonLink from JSON. Unknown members are ignored.
+func (r *PersonLink) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PersonLink
+func (r *PersonLink) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "target":
+			r.Target.decodeJSON(d)
+		case "assurance":
+			if d.null() {
+				r.Assurance = nil
+			} else {
+				var v IdentityAssuranceLevel
+				d.unmarshal(&v)
+				r.Assurance = &v
+			}
+		case "_assurance":
+			r.AssuranceElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PersonLink which shares no memory with the original
+func (r PersonLink) DeepCopy() PersonLink {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Target = r.Target.DeepCopy()
+	if r.Assurance != nil {
+		v := *r.Assurance
+		out.Assurance = &v
+	}
+	if r.AssuranceElement != nil {
+		v := r.AssuranceElement.DeepCopy()
+		out.AssuranceElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PersonLink) Equal(other PersonLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Target.Equal(other.Target) {
+		return false
+	}
+	if (r.Assurance == nil) != (other.Assurance == nil) || r.Assurance != nil && *r.Assurance != *other.Assurance {
+		return false
+	}
+	if (r.AssuranceElement == nil) != (other.AssuranceElement == nil) || r.AssuranceElement != nil && !r.AssuranceElement.Equal(*other.AssuranceElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PersonLink) EqualsDeep(other PersonLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Target.EqualsDeep(other.Target) {
+		return false
+	}
+	if (r.Assurance == nil) != (other.Assurance == nil) || r.Assurance != nil && *r.Assurance != *other.Assurance {
+		return false
+	}
+	if (r.AssuranceElement == nil) != (other.AssuranceElement == nil) || r.AssuranceElement != nil && !r.AssuranceElement.EqualsDeep(*other.AssuranceElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PersonLink) EqualsShallow(other PersonLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Assurance == nil) != (other.Assurance == nil) || r.Assurance != nil && *r.Assurance != *other.Assurance {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PersonLink as FHIR XML
+func (r PersonLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "target", r.Target); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "assurance", r.Assurance, r.AssuranceElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PersonLink from FHIR XML
+func (r *PersonLink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "target":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = v
+			case "assurance":
+				var v IdentityAssuranceLevel
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Assurance = &v
+				}
+				r.AssuranceElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PersonLink as properties to the RDF node n
+func (r PersonLink) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Person.link.target", -1, r.Target)
+	n.primitive("Person.link.assurance", -1, r.Assurance, r.AssuranceElement, "code")
+}
+
+// MarshalBSON marshals the given PersonLink as BSON document with the structure of its FHIR JSON
+func (r PersonLink) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PersonLink from a BSON document with the structure of its FHIR JSON
+func (r *PersonLink) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PersonLink as protobuf message PersonLink of fhir.proto
+func (r PersonLink) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PersonLink to the encoded message
+func (r PersonLink) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Target)
+	if r.Assurance != nil {
+		e.varint(5, r.Assurance.protoNumber())
+	}
+	if r.AssuranceElement != nil {
+		e.message(6, *r.AssuranceElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PersonLink from protobuf message PersonLink of fhir.proto
+func (r *PersonLink) UnmarshalProto(b []byte) error {
+	*r = PersonLink{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Target = v
+		case 5:
+			var v IdentityAssuranceLevel
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Assurance = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.AssuranceElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalPerson unmarshals a Person.
 func UnmarshalPerson(b []byte) (Person, error) {
 	var person Person
-	if err := json.Unmarshal(b, &person); err != nil {
+	if err := person.UnmarshalJSON(b); err != nil {
 		return person, err
 	}
 	return person, nil
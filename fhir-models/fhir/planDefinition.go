@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,34 +25,53 @@ import "encoding/json"
 // PlanDefinition is documented here http://hl7.org/fhir/StructureDefinition/PlanDefinition
 type PlanDefinition struct {
 	Id                     *string                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element               `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                   *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules          *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language               *string                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element               `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                   *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage      `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension              []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension      []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Url                    *string                `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement             *Element               `bson:"_url,omitempty" json:"_url,omitempty"`
 	Identifier             []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Version                *string                `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement         *Element               `bson:"_version,omitempty" json:"_version,omitempty"`
 	Name                   *string                `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element               `bson:"_name,omitempty" json:"_name,omitempty"`
 	Title                  *string                `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement           *Element               `bson:"_title,omitempty" json:"_title,omitempty"`
 	Subtitle               *string                `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement        *Element               `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
 	Type                   *CodeableConcept       `bson:"type,omitempty" json:"type,omitempty"`
 	Status                 PublicationStatus      `bson:"status" json:"status"`
+	StatusElement          *Element               `bson:"_status,omitempty" json:"_status,omitempty"`
 	Experimental           *bool                  `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement    *Element               `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
 	SubjectCodeableConcept *CodeableConcept       `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
 	SubjectReference       *Reference             `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
 	Date                   *string                `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element               `bson:"_date,omitempty" json:"_date,omitempty"`
 	Publisher              *string                `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement       *Element               `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
 	Contact                []ContactDetail        `bson:"contact,omitempty" json:"contact,omitempty"`
 	Description            *string                `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element               `bson:"_description,omitempty" json:"_description,omitempty"`
 	UseContext             []UsageContext         `bson:"useContext,omitempty" json:"useContext,omitempty"`
 	Jurisdiction           []CodeableConcept      `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
 	Purpose                *string                `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement         *Element               `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
 	Usage                  *string                `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement           *Element               `bson:"_usage,omitempty" json:"_usage,omitempty"`
 	Copyright              *string                `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement       *Element               `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
 	ApprovalDate           *string                `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement    *Element               `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
 	LastReviewDate         *string                `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement  *Element               `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
 	EffectivePeriod        *Period                `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
 	Topic                  []CodeableConcept      `bson:"topic,omitempty" json:"topic,omitempty"`
 	Author                 []ContactDetail        `bson:"author,omitempty" json:"author,omitempty"`
@@ -58,9 +80,46 @@ type PlanDefinition struct {
 	Endorser               []ContactDetail        `bson:"endorser,omitempty" json:"endorser,omitempty"`
 	RelatedArtifact        []RelatedArtifact      `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
 	Library                []string               `bson:"library,omitempty" json:"library,omitempty"`
+	LibraryElement         []*Element             `bson:"_library,omitempty" json:"_library,omitempty"`
 	Goal                   []PlanDefinitionGoal   `bson:"goal,omitempty" json:"goal,omitempty"`
 	Action                 []PlanDefinitionAction `bson:"action,omitempty" json:"action,omitempty"`
 }
+
+// PlanDefinitionSubject is implemented by the types allowed for PlanDefinition.subject[x]
+type PlanDefinitionSubject interface {
+	isPlanDefinitionSubject()
+}
+
+func (CodeableConcept) isPlanDefinitionSubject() {}
+func (Reference) isPlanDefinitionSubject()       {}
+
+// Subject returns the value of PlanDefinition.subject[x] together with its FHIR type code or nil if no value is set
+func (r PlanDefinition) Subject() (PlanDefinitionSubject, string) {
+	if r.SubjectCodeableConcept != nil {
+		return *r.SubjectCodeableConcept, "CodeableConcept"
+	}
+	if r.SubjectReference != nil {
+		return *r.SubjectReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetSubject sets the value of PlanDefinition.subject[x], given as value or pointer, and clears all other types
+func (r *PlanDefinition) SetSubject(value PlanDefinitionSubject) {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.SubjectCodeableConcept = &v
+	case *CodeableConcept:
+		r.SubjectCodeableConcept = v
+	case Reference:
+		r.SubjectReference = &v
+	case *Reference:
+		r.SubjectReference = v
+	}
+}
+
 type PlanDefinitionGoal struct {
 	Id                *string                    `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -83,92 +142,7860 @@ type PlanDefinitionGoalTarget struct {
 	DetailCodeableConcept *CodeableConcept `bson:"detailCodeableConcept,omitempty" json:"detailCodeableConcept,omitempty"`
 	Due                   *Duration        `bson:"due,omitempty" json:"due,omitempty"`
 }
+
+// PlanDefinitionGoalTargetDetail is implemented by the types allowed for PlanDefinition.goal.target.detail[x]
+type PlanDefinitionGoalTargetDetail interface {
+	isPlanDefinitionGoalTargetDetail()
+}
+
+func (Quantity) isPlanDefinitionGoalTargetDetail()        {}
+func (Range) isPlanDefinitionGoalTargetDetail()           {}
+func (CodeableConcept) isPlanDefinitionGoalTargetDetail() {}
+
+// Detail returns the value of PlanDefinition.goal.target.detail[x] together with its FHIR type code or nil if no value is set
+func (r PlanDefinitionGoalTarget) Detail() (PlanDefinitionGoalTargetDetail, string) {
+	if r.DetailQuantity != nil {
+		return *r.DetailQuantity, "Quantity"
+	}
+	if r.DetailRange != nil {
+		return *r.DetailRange, "Range"
+	}
+	if r.DetailCodeableConcept != nil {
+		return *r.DetailCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetDetail sets the value of PlanDefinition.goal.target.detail[x], given as value or pointer, and clears all other types
+func (r *PlanDefinitionGoalTarget) SetDetail(value PlanDefinitionGoalTargetDetail) {
+	r.DetailQuantity = nil
+	r.DetailRange = nil
+	r.DetailCodeableConcept = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.DetailQuantity = &v
+	case *Quantity:
+		r.DetailQuantity = v
+	case Range:
+		r.DetailRange = &v
+	case *Range:
+		r.DetailRange = v
+	case CodeableConcept:
+		r.DetailCodeableConcept = &v
+	case *CodeableConcept:
+		r.DetailCodeableConcept = v
+	}
+}
+
 type PlanDefinitionAction struct {
-	Id                     *string                             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension              []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Prefix                 *string                             `bson:"prefix,omitempty" json:"prefix,omitempty"`
-	Title                  *string                             `bson:"title,omitempty" json:"title,omitempty"`
-	Description            *string                             `bson:"description,omitempty" json:"description,omitempty"`
-	TextEquivalent         *string                             `bson:"textEquivalent,omitempty" json:"textEquivalent,omitempty"`
-	Priority               *RequestPriority                    `bson:"priority,omitempty" json:"priority,omitempty"`
-	Code                   []CodeableConcept                   `bson:"code,omitempty" json:"code,omitempty"`
-	Reason                 []CodeableConcept                   `bson:"reason,omitempty" json:"reason,omitempty"`
-	Documentation          []RelatedArtifact                   `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	GoalId                 []string                            `bson:"goalId,omitempty" json:"goalId,omitempty"`
-	SubjectCodeableConcept *CodeableConcept                    `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
-	SubjectReference       *Reference                          `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
-	Trigger                []TriggerDefinition                 `bson:"trigger,omitempty" json:"trigger,omitempty"`
-	Condition              []PlanDefinitionActionCondition     `bson:"condition,omitempty" json:"condition,omitempty"`
-	Input                  []DataRequirement                   `bson:"input,omitempty" json:"input,omitempty"`
-	Output                 []DataRequirement                   `bson:"output,omitempty" json:"output,omitempty"`
-	RelatedAction          []PlanDefinitionActionRelatedAction `bson:"relatedAction,omitempty" json:"relatedAction,omitempty"`
-	TimingDateTime         *string                             `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
-	TimingAge              *Age                                `bson:"timingAge,omitempty" json:"timingAge,omitempty"`
-	TimingPeriod           *Period                             `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
-	TimingDuration         *Duration                           `bson:"timingDuration,omitempty" json:"timingDuration,omitempty"`
-	TimingRange            *Range                              `bson:"timingRange,omitempty" json:"timingRange,omitempty"`
-	TimingTiming           *Timing                             `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
-	Participant            []PlanDefinitionActionParticipant   `bson:"participant,omitempty" json:"participant,omitempty"`
-	Type                   *CodeableConcept                    `bson:"type,omitempty" json:"type,omitempty"`
-	GroupingBehavior       *ActionGroupingBehavior             `bson:"groupingBehavior,omitempty" json:"groupingBehavior,omitempty"`
-	SelectionBehavior      *ActionSelectionBehavior            `bson:"selectionBehavior,omitempty" json:"selectionBehavior,omitempty"`
-	RequiredBehavior       *ActionRequiredBehavior             `bson:"requiredBehavior,omitempty" json:"requiredBehavior,omitempty"`
-	PrecheckBehavior       *ActionPrecheckBehavior             `bson:"precheckBehavior,omitempty" json:"precheckBehavior,omitempty"`
-	CardinalityBehavior    *ActionCardinalityBehavior          `bson:"cardinalityBehavior,omitempty" json:"cardinalityBehavior,omitempty"`
-	DefinitionCanonical    *string                             `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
-	DefinitionUri          *string                             `bson:"definitionUri,omitempty" json:"definitionUri,omitempty"`
-	Transform              *string                             `bson:"transform,omitempty" json:"transform,omitempty"`
-	DynamicValue           []PlanDefinitionActionDynamicValue  `bson:"dynamicValue,omitempty" json:"dynamicValue,omitempty"`
-	Action                 []PlanDefinitionAction              `bson:"action,omitempty" json:"action,omitempty"`
+	Id                         *string                             `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Prefix                     *string                             `bson:"prefix,omitempty" json:"prefix,omitempty"`
+	PrefixElement              *Element                            `bson:"_prefix,omitempty" json:"_prefix,omitempty"`
+	Title                      *string                             `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement               *Element                            `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description                *string                             `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement         *Element                            `bson:"_description,omitempty" json:"_description,omitempty"`
+	TextEquivalent             *string                             `bson:"textEquivalent,omitempty" json:"textEquivalent,omitempty"`
+	TextEquivalentElement      *Element                            `bson:"_textEquivalent,omitempty" json:"_textEquivalent,omitempty"`
+	Priority                   *RequestPriority                    `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement            *Element                            `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Code                       []CodeableConcept                   `bson:"code,omitempty" json:"code,omitempty"`
+	Reason                     []CodeableConcept                   `bson:"reason,omitempty" json:"reason,omitempty"`
+	Documentation              []RelatedArtifact                   `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	GoalId                     []string                            `bson:"goalId,omitempty" json:"goalId,omitempty"`
+	GoalIdElement              []*Element                          `bson:"_goalId,omitempty" json:"_goalId,omitempty"`
+	SubjectCodeableConcept     *CodeableConcept                    `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
+	SubjectReference           *Reference                          `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
+	Trigger                    []TriggerDefinition                 `bson:"trigger,omitempty" json:"trigger,omitempty"`
+	Condition                  []PlanDefinitionActionCondition     `bson:"condition,omitempty" json:"condition,omitempty"`
+	Input                      []DataRequirement                   `bson:"input,omitempty" json:"input,omitempty"`
+	Output                     []DataRequirement                   `bson:"output,omitempty" json:"output,omitempty"`
+	RelatedAction              []PlanDefinitionActionRelatedAction `bson:"relatedAction,omitempty" json:"relatedAction,omitempty"`
+	TimingDateTime             *string                             `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement      *Element                            `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
+	TimingAge                  *Age                                `bson:"timingAge,omitempty" json:"timingAge,omitempty"`
+	TimingPeriod               *Period                             `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	TimingDuration             *Duration                           `bson:"timingDuration,omitempty" json:"timingDuration,omitempty"`
+	TimingRange                *Range                              `bson:"timingRange,omitempty" json:"timingRange,omitempty"`
+	TimingTiming               *Timing                             `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	Participant                []PlanDefinitionActionParticipant   `bson:"participant,omitempty" json:"participant,omitempty"`
+	Type                       *CodeableConcept                    `bson:"type,omitempty" json:"type,omitempty"`
+	GroupingBehavior           *ActionGroupingBehavior             `bson:"groupingBehavior,omitempty" json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement    *Element                            `bson:"_groupingBehavior,omitempty" json:"_groupingBehavior,omitempty"`
+	SelectionBehavior          *ActionSelectionBehavior            `bson:"selectionBehavior,omitempty" json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement   *Element                            `bson:"_selectionBehavior,omitempty" json:"_selectionBehavior,omitempty"`
+	RequiredBehavior           *ActionRequiredBehavior             `bson:"requiredBehavior,omitempty" json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement    *Element                            `bson:"_requiredBehavior,omitempty" json:"_requiredBehavior,omitempty"`
+	PrecheckBehavior           *ActionPrecheckBehavior             `bson:"precheckBehavior,omitempty" json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement    *Element                            `bson:"_precheckBehavior,omitempty" json:"_precheckBehavior,omitempty"`
+	CardinalityBehavior        *ActionCardinalityBehavior          `bson:"cardinalityBehavior,omitempty" json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *Element                            `bson:"_cardinalityBehavior,omitempty" json:"_cardinalityBehavior,omitempty"`
+	DefinitionCanonical        *string                             `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *Element                            `bson:"_definitionCanonical,omitempty" json:"_definitionCanonical,omitempty"`
+	DefinitionUri              *string                             `bson:"definitionUri,omitempty" json:"definitionUri,omitempty"`
+	DefinitionUriElement       *Element                            `bson:"_definitionUri,omitempty" json:"_definitionUri,omitempty"`
+	Transform                  *string                             `bson:"transform,omitempty" json:"transform,omitempty"`
+	TransformElement           *Element                            `bson:"_transform,omitempty" json:"_transform,omitempty"`
+	DynamicValue               []PlanDefinitionActionDynamicValue  `bson:"dynamicValue,omitempty" json:"dynamicValue,omitempty"`
+	Action                     []PlanDefinitionAction              `bson:"action,omitempty" json:"action,omitempty"`
+}
+
+// PlanDefinitionActionSubject is implemented by the types allowed for PlanDefinition.action.subject[x]
+type PlanDefinitionActionSubject interface {
+	isPlanDefinitionActionSubject()
+}
+
+func (CodeableConcept) isPlanDefinitionActionSubject() {}
+func (Reference) isPlanDefinitionActionSubject()       {}
+
+// Subject returns the value of PlanDefinition.action.subject[x] together with its FHIR type code or nil if no value is set
+func (r PlanDefinitionAction) Subject() (PlanDefinitionActionSubject, string) {
+	if r.SubjectCodeableConcept != nil {
+		return *r.SubjectCodeableConcept, "CodeableConcept"
+	}
+	if r.SubjectReference != nil {
+		return *r.SubjectReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetSubject sets the value of PlanDefinition.action.subject[x], given as value or pointer, and clears all other types
+func (r *PlanDefinitionAction) SetSubject(value PlanDefinitionActionSubject) {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.SubjectCodeableConcept = &v
+	case *CodeableConcept:
+		r.SubjectCodeableConcept = v
+	case Reference:
+		r.SubjectReference = &v
+	case *Reference:
+		r.SubjectReference = v
+	}
 }
+
 type PlanDefinitionActionCondition struct {
 	Id                *string             `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Kind              ActionConditionKind `bson:"kind" json:"kind"`
+	KindElement       *Element            `bson:"_kind,omitempty" json:"_kind,omitempty"`
 	Expression        *Expression         `bson:"expression,omitempty" json:"expression,omitempty"`
 }
 type PlanDefinitionActionRelatedAction struct {
-	Id                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ActionId          string                 `bson:"actionId" json:"actionId"`
-	Relationship      ActionRelationshipType `bson:"relationship" json:"relationship"`
-	OffsetDuration    *Duration              `bson:"offsetDuration,omitempty" json:"offsetDuration,omitempty"`
-	OffsetRange       *Range                 `bson:"offsetRange,omitempty" json:"offsetRange,omitempty"`
+	Id                  *string                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ActionId            string                 `bson:"actionId" json:"actionId"`
+	ActionIdElement     *Element               `bson:"_actionId,omitempty" json:"_actionId,omitempty"`
+	Relationship        ActionRelationshipType `bson:"relationship" json:"relationship"`
+	RelationshipElement *Element               `bson:"_relationship,omitempty" json:"_relationship,omitempty"`
+	OffsetDuration      *Duration              `bson:"offsetDuration,omitempty" json:"offsetDuration,omitempty"`
+	OffsetRange         *Range                 `bson:"offsetRange,omitempty" json:"offsetRange,omitempty"`
+}
+
+// PlanDefinitionActionRelatedActionOffset is implemented by the types allowed for PlanDefinition.action.relatedAction.offset[x]
+type PlanDefinitionActionRelatedActionOffset interface {
+	isPlanDefinitionActionRelatedActionOffset()
+}
+
+func (Duration) isPlanDefinitionActionRelatedActionOffset() {}
+func (Range) isPlanDefinitionActionRelatedActionOffset()    {}
+
+// Offset returns the value of PlanDefinition.action.relatedAction.offset[x] together with its FHIR type code or nil if no value is set
+func (r PlanDefinitionActionRelatedAction) Offset() (PlanDefinitionActionRelatedActionOffset, string) {
+	if r.OffsetDuration != nil {
+		return *r.OffsetDuration, "Duration"
+	}
+	if r.OffsetRange != nil {
+		return *r.OffsetRange, "Range"
+	}
+	return nil, ""
+}
+
+// SetOffset sets the value of PlanDefinition.action.relatedAction.offset[x], given as value or pointer, and clears all other types
+func (r *PlanDefinitionActionRelatedAction) SetOffset(value PlanDefinitionActionRelatedActionOffset) {
+	r.OffsetDuration = nil
+	r.OffsetRange = nil
+	switch v := value.(type) {
+	case Duration:
+		r.OffsetDuration = &v
+	case *Duration:
+		r.OffsetDuration = v
+	case Range:
+		r.OffsetRange = &v
+	case *Range:
+		r.OffsetRange = v
+	}
+}
+
+// PlanDefinitionActionTiming is implemented by the types allowed for PlanDefinition.action.timing[x]
+type PlanDefinitionActionTiming interface {
+	isPlanDefinitionActionTiming()
+}
+
+func (DateTime) isPlanDefinitionActionTiming() {}
+func (Age) isPlanDefinitionActionTiming()      {}
+func (Period) isPlanDefinitionActionTiming()   {}
+func (Duration) isPlanDefinitionActionTiming() {}
+func (Range) isPlanDefinitionActionTiming()    {}
+func (Timing) isPlanDefinitionActionTiming()   {}
+
+// Timing returns the value of PlanDefinition.action.timing[x] together with its FHIR type code or nil if no value is set
+func (r PlanDefinitionAction) Timing() (PlanDefinitionActionTiming, string) {
+	if r.TimingDateTime != nil {
+		return DateTime(*r.TimingDateTime), "dateTime"
+	}
+	if r.TimingAge != nil {
+		return *r.TimingAge, "Age"
+	}
+	if r.TimingPeriod != nil {
+		return *r.TimingPeriod, "Period"
+	}
+	if r.TimingDuration != nil {
+		return *r.TimingDuration, "Duration"
+	}
+	if r.TimingRange != nil {
+		return *r.TimingRange, "Range"
+	}
+	if r.TimingTiming != nil {
+		return *r.TimingTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetTiming sets the value of PlanDefinition.action.timing[x], given as value or pointer, and clears all other types
+func (r *PlanDefinitionAction) SetTiming(value PlanDefinitionActionTiming) {
+	r.TimingDateTime = nil
+	r.TimingAge = nil
+	r.TimingPeriod = nil
+	r.TimingDuration = nil
+	r.TimingRange = nil
+	r.TimingTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.TimingDateTime = &primitive
+	case *DateTime:
+		r.TimingDateTime = (*string)(v)
+	case Age:
+		r.TimingAge = &v
+	case *Age:
+		r.TimingAge = v
+	case Period:
+		r.TimingPeriod = &v
+	case *Period:
+		r.TimingPeriod = v
+	case Duration:
+		r.TimingDuration = &v
+	case *Duration:
+		r.TimingDuration = v
+	case Range:
+		r.TimingRange = &v
+	case *Range:
+		r.TimingRange = v
+	case Timing:
+		r.TimingTiming = &v
+	case *Timing:
+		r.TimingTiming = v
+	}
 }
+
 type PlanDefinitionActionParticipant struct {
 	Id                *string               `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              ActionParticipantType `bson:"type" json:"type"`
+	TypeElement       *Element              `bson:"_type,omitempty" json:"_type,omitempty"`
 	Role              *CodeableConcept      `bson:"role,omitempty" json:"role,omitempty"`
 }
+
+// PlanDefinitionActionDefinition is implemented by the types allowed for PlanDefinition.action.definition[x]
+type PlanDefinitionActionDefinition interface {
+	isPlanDefinitionActionDefinition()
+}
+
+func (Canonical) isPlanDefinitionActionDefinition() {}
+func (Uri) isPlanDefinitionActionDefinition()       {}
+
+// Definition returns the value of PlanDefinition.action.definition[x] together with its FHIR type code or nil if no value is set
+func (r PlanDefinitionAction) Definition() (PlanDefinitionActionDefinition, string) {
+	if r.DefinitionCanonical != nil {
+		return Canonical(*r.DefinitionCanonical), "canonical"
+	}
+	if r.DefinitionUri != nil {
+		return Uri(*r.DefinitionUri), "uri"
+	}
+	return nil, ""
+}
+
+// SetDefinition sets the value of PlanDefinition.action.definition[x], given as value or pointer, and clears all other types
+func (r *PlanDefinitionAction) SetDefinition(value PlanDefinitionActionDefinition) {
+	r.DefinitionCanonical = nil
+	r.DefinitionUri = nil
+	switch v := value.(type) {
+	case Canonical:
+		primitive := string(v)
+		r.DefinitionCanonical = &primitive
+	case *Canonical:
+		r.DefinitionCanonical = (*string)(v)
+	case Uri:
+		primitive := string(v)
+		r.DefinitionUri = &primitive
+	case *Uri:
+		r.DefinitionUri = (*string)(v)
+	}
+}
+
 type PlanDefinitionActionDynamicValue struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Path              *string     `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement       *Element    `bson:"_path,omitempty" json:"_path,omitempty"`
 	Expression        *Expression `bson:"expression,omitempty" json:"expression,omitempty"`
 }
-type OtherPlanDefinition PlanDefinition
 
 // MarshalJSON marshals the given PlanDefinition as JSON into a byte slice
 func (r PlanDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherPlanDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherPlanDefinition: OtherPlanDefinition(r),
-		ResourceType:        "PlanDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PlanDefinition as JSON object with the members in the order of the fields
+func (r PlanDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"PlanDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Subtitle != nil {
+		e.key("subtitle")
+		e.string(*r.Subtitle)
+	}
+	if r.SubtitleElement != nil {
+		e.key("_subtitle")
+		r.SubtitleElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.key("subjectCodeableConcept")
+		r.SubjectCodeableConcept.appendJSON(e)
+	}
+	if r.SubjectReference != nil {
+		e.key("subjectReference")
+		r.SubjectReference.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Usage != nil {
+		e.key("usage")
+		e.string(*r.Usage)
+	}
+	if r.UsageElement != nil {
+		e.key("_usage")
+		r.UsageElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.ApprovalDate != nil {
+		e.key("approvalDate")
+		e.string(*r.ApprovalDate)
+	}
+	if r.ApprovalDateElement != nil {
+		e.key("_approvalDate")
+		r.ApprovalDateElement.appendJSON(e)
+	}
+	if r.LastReviewDate != nil {
+		e.key("lastReviewDate")
+		e.string(*r.LastReviewDate)
+	}
+	if r.LastReviewDateElement != nil {
+		e.key("_lastReviewDate")
+		r.LastReviewDateElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if len(r.Topic) > 0 {
+		e.key("topic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Topic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Editor) > 0 {
+		e.key("editor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Editor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Reviewer) > 0 {
+		e.key("reviewer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reviewer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endorser) > 0 {
+		e.key("endorser")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endorser {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedArtifact) > 0 {
+		e.key("relatedArtifact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedArtifact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Library) > 0 {
+		e.key("library")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Library {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LibraryElement) > 0 {
+		e.key("_library")
+		e.elements(r.LibraryElement, len(r.Library))
+	}
+	if len(r.Goal) > 0 {
+		e.key("goal")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Goal {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Action) > 0 {
+		e.key("action")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PlanDefinition from JSON. Unknown members are ignored.
+func (r *PlanDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PlanDefinition
+func (r *PlanDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "subtitle":
+			if v, ok := d.string(); ok {
+				r.Subtitle = &v
+			} else {
+				r.Subtitle = nil
+			}
+		case "_subtitle":
+			r.SubtitleElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "subjectCodeableConcept":
+			if d.null() {
+				r.SubjectCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubjectCodeableConcept = &v
+			}
+		case "subjectReference":
+			if d.null() {
+				r.SubjectReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubjectReference = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "usage":
+			if v, ok := d.string(); ok {
+				r.Usage = &v
+			} else {
+				r.Usage = nil
+			}
+		case "_usage":
+			r.UsageElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "approvalDate":
+			if v, ok := d.string(); ok {
+				r.ApprovalDate = &v
+			} else {
+				r.ApprovalDate = nil
+			}
+		case "_approvalDate":
+			r.ApprovalDateElement = d.element()
+		case "lastReviewDate":
+			if v, ok := d.string(); ok {
+				r.LastReviewDate = &v
+			} else {
+				r.LastReviewDate = nil
+			}
+		case "_lastReviewDate":
+			r.LastReviewDateElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "topic":
+			a := d.array()
+			r.Topic = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Topic = append(r.Topic, v)
+			}
+			if r.Topic == nil && !a.isNull {
+				r.Topic = []CodeableConcept{}
+			}
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []ContactDetail{}
+			}
+		case "editor":
+			a := d.array()
+			r.Editor = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Editor = append(r.Editor, v)
+			}
+			if r.Editor == nil && !a.isNull {
+				r.Editor = []ContactDetail{}
+			}
+		case "reviewer":
+			a := d.array()
+			r.Reviewer = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Reviewer = append(r.Reviewer, v)
+			}
+			if r.Reviewer == nil && !a.isNull {
+				r.Reviewer = []ContactDetail{}
+			}
+		case "endorser":
+			a := d.array()
+			r.Endorser = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Endorser = append(r.Endorser, v)
+			}
+			if r.Endorser == nil && !a.isNull {
+				r.Endorser = []ContactDetail{}
+			}
+		case "relatedArtifact":
+			a := d.array()
+			r.RelatedArtifact = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			}
+			if r.RelatedArtifact == nil && !a.isNull {
+				r.RelatedArtifact = []RelatedArtifact{}
+			}
+		case "library":
+			a := d.array()
+			r.Library = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Library = append(r.Library, v)
+			}
+			if r.Library == nil && !a.isNull {
+				r.Library = []string{}
+			}
+		case "_library":
+			a := d.array()
+			r.LibraryElement = nil
+			for a.next() {
+				r.LibraryElement = append(r.LibraryElement, d.element())
+			}
+		case "goal":
+			a := d.array()
+			r.Goal = nil
+			for a.next() {
+				var v PlanDefinitionGoal
+				v.decodeJSON(d)
+				r.Goal = append(r.Goal, v)
+			}
+			if r.Goal == nil && !a.isNull {
+				r.Goal = []PlanDefinitionGoal{}
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v PlanDefinitionAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []PlanDefinitionAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PlanDefinition which shares no memory with the original
+func (r PlanDefinition) DeepCopy() PlanDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Subtitle != nil {
+		v := *r.Subtitle
+		out.Subtitle = &v
+	}
+	if r.SubtitleElement != nil {
+		v := r.SubtitleElement.DeepCopy()
+		out.SubtitleElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.SubjectCodeableConcept != nil {
+		v := r.SubjectCodeableConcept.DeepCopy()
+		out.SubjectCodeableConcept = &v
+	}
+	if r.SubjectReference != nil {
+		v := r.SubjectReference.DeepCopy()
+		out.SubjectReference = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Usage != nil {
+		v := *r.Usage
+		out.Usage = &v
+	}
+	if r.UsageElement != nil {
+		v := r.UsageElement.DeepCopy()
+		out.UsageElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.ApprovalDate != nil {
+		v := *r.ApprovalDate
+		out.ApprovalDate = &v
+	}
+	if r.ApprovalDateElement != nil {
+		v := r.ApprovalDateElement.DeepCopy()
+		out.ApprovalDateElement = &v
+	}
+	if r.LastReviewDate != nil {
+		v := *r.LastReviewDate
+		out.LastReviewDate = &v
+	}
+	if r.LastReviewDateElement != nil {
+		v := r.LastReviewDateElement.DeepCopy()
+		out.LastReviewDateElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Topic != nil {
+		out.Topic = make([]CodeableConcept, len(r.Topic))
+		for i := range r.Topic {
+			out.Topic[i] = r.Topic[i].DeepCopy()
+		}
+	}
+	if r.Author != nil {
+		out.Author = make([]ContactDetail, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Editor != nil {
+		out.Editor = make([]ContactDetail, len(r.Editor))
+		for i := range r.Editor {
+			out.Editor[i] = r.Editor[i].DeepCopy()
+		}
+	}
+	if r.Reviewer != nil {
+		out.Reviewer = make([]ContactDetail, len(r.Reviewer))
+		for i := range r.Reviewer {
+			out.Reviewer[i] = r.Reviewer[i].DeepCopy()
+		}
+	}
+	if r.Endorser != nil {
+		out.Endorser = make([]ContactDetail, len(r.Endorser))
+		for i := range r.Endorser {
+			out.Endorser[i] = r.Endorser[i].DeepCopy()
+		}
+	}
+	if r.RelatedArtifact != nil {
+		out.RelatedArtifact = make([]RelatedArtifact, len(r.RelatedArtifact))
+		for i := range r.RelatedArtifact {
+			out.RelatedArtifact[i] = r.RelatedArtifact[i].DeepCopy()
+		}
+	}
+	if r.Library != nil {
+		out.Library = make([]string, len(r.Library))
+		copy(out.Library, r.Library)
+	}
+	if r.LibraryElement != nil {
+		out.LibraryElement = make([]*Element, len(r.LibraryElement))
+		for i, v := range r.LibraryElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LibraryElement[i] = &c
+			}
+		}
+	}
+	if r.Goal != nil {
+		out.Goal = make([]PlanDefinitionGoal, len(r.Goal))
+		for i := range r.Goal {
+			out.Goal[i] = r.Goal[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		out.Action = make([]PlanDefinitionAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PlanDefinition) Equal(other PlanDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && *r.Subtitle != *other.Subtitle {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.Equal(*other.SubtitleElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.Equal(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.Equal(*other.SubjectReference) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && *r.Usage != *other.Usage {
+		return false
+	}
+	if (r.UsageElement == nil) != (other.UsageElement == nil) || r.UsageElement != nil && !r.UsageElement.Equal(*other.UsageElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && *r.ApprovalDate != *other.ApprovalDate {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.Equal(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && *r.LastReviewDate != *other.LastReviewDate {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.Equal(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if len(r.Topic) != len(other.Topic) {
+		return false
+	}
+	for i := range r.Topic {
+		if !r.Topic[i].Equal(other.Topic[i]) {
+			return false
+		}
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if len(r.Editor) != len(other.Editor) {
+		return false
+	}
+	for i := range r.Editor {
+		if !r.Editor[i].Equal(other.Editor[i]) {
+			return false
+		}
+	}
+	if len(r.Reviewer) != len(other.Reviewer) {
+		return false
+	}
+	for i := range r.Reviewer {
+		if !r.Reviewer[i].Equal(other.Reviewer[i]) {
+			return false
+		}
+	}
+	if len(r.Endorser) != len(other.Endorser) {
+		return false
+	}
+	for i := range r.Endorser {
+		if !r.Endorser[i].Equal(other.Endorser[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedArtifact) != len(other.RelatedArtifact) {
+		return false
+	}
+	for i := range r.RelatedArtifact {
+		if !r.RelatedArtifact[i].Equal(other.RelatedArtifact[i]) {
+			return false
+		}
+	}
+	if len(r.Library) != len(other.Library) {
+		return false
+	}
+	for i := range r.Library {
+		if r.Library[i] != other.Library[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LibraryElement, other.LibraryElement, false) {
+		return false
+	}
+	if len(r.Goal) != len(other.Goal) {
+		return false
+	}
+	for i := range r.Goal {
+		if !r.Goal[i].Equal(other.Goal[i]) {
+			return false
+		}
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PlanDefinition) EqualsDeep(other PlanDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.EqualsDeep(*other.SubtitleElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.EqualsDeep(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.EqualsDeep(*other.SubjectReference) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && !equivalentString(*r.Usage, *other.Usage) {
+		return false
+	}
+	if (r.UsageElement == nil) != (other.UsageElement == nil) || r.UsageElement != nil && !r.UsageElement.EqualsDeep(*other.UsageElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.EqualsDeep(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.EqualsDeep(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Topic), len(other.Topic), func(i, j int) bool {
+		return r.Topic[i].EqualsDeep(other.Topic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Editor), len(other.Editor), func(i, j int) bool {
+		return r.Editor[i].EqualsDeep(other.Editor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reviewer), len(other.Reviewer), func(i, j int) bool {
+		return r.Reviewer[i].EqualsDeep(other.Reviewer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endorser), len(other.Endorser), func(i, j int) bool {
+		return r.Endorser[i].EqualsDeep(other.Endorser[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedArtifact), len(other.RelatedArtifact), func(i, j int) bool {
+		return r.RelatedArtifact[i].EqualsDeep(other.RelatedArtifact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Library), len(other.Library), func(i, j int) bool {
+		return equivalentString(r.Library[i], other.Library[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LibraryElement, other.LibraryElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Goal), len(other.Goal), func(i, j int) bool {
+		return r.Goal[i].EqualsDeep(other.Goal[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PlanDefinition) EqualsShallow(other PlanDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && !equivalentString(*r.Usage, *other.Usage) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if !equivalentList(len(r.Library), len(other.Library), func(i, j int) bool {
+		return equivalentString(r.Library[i], other.Library[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PlanDefinition as FHIR XML
+func (r PlanDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "PlanDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "subtitle", r.Subtitle, r.SubtitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectCodeableConcept", r.SubjectCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectReference", r.SubjectReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "usage", r.Usage, r.UsageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "approvalDate", r.ApprovalDate, r.ApprovalDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastReviewDate", r.LastReviewDate, r.LastReviewDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Topic {
+		if err := encodeXMLElement(e, "topic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Editor {
+		if err := encodeXMLElement(e, "editor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reviewer {
+		if err := encodeXMLElement(e, "reviewer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endorser {
+		if err := encodeXMLElement(e, "endorser", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedArtifact {
+		if err := encodeXMLElement(e, "relatedArtifact", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Library {
+		if err := encodeXMLPrimitive(e, "library", v, elementAt(r.LibraryElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Goal {
+		if err := encodeXMLElement(e, "goal", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PlanDefinition from FHIR XML
+func (r *PlanDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "subtitle":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subtitle = &v
+				}
+				r.SubtitleElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "subjectCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectCodeableConcept = &v
+			case "subjectReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectReference = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "usage":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Usage = &v
+				}
+				r.UsageElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "approvalDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ApprovalDate = &v
+				}
+				r.ApprovalDateElement = element
+			case "lastReviewDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastReviewDate = &v
+				}
+				r.LastReviewDateElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "topic":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Topic = append(r.Topic, v)
+			case "author":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "editor":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Editor = append(r.Editor, v)
+			case "reviewer":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reviewer = append(r.Reviewer, v)
+			case "endorser":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endorser = append(r.Endorser, v)
+			case "relatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			case "library":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LibraryElement = appendElement(r.LibraryElement, len(r.Library), element)
+					r.Library = append(r.Library, v)
+				}
+			case "goal":
+				var v PlanDefinitionGoal
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Goal = append(r.Goal, v)
+			case "action":
+				var v PlanDefinitionAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PlanDefinition as properties to the RDF node n
+func (r PlanDefinition) turtle(n *turtleNode) {
+	n.resource("PlanDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("PlanDefinition.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("PlanDefinition.identifier", i, v)
+	}
+	n.primitive("PlanDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("PlanDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("PlanDefinition.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("PlanDefinition.subtitle", -1, r.Subtitle, r.SubtitleElement, "string")
+	n.element("PlanDefinition.type", -1, r.Type)
+	n.primitive("PlanDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("PlanDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.element("PlanDefinition.subjectCodeableConcept", -1, r.SubjectCodeableConcept)
+	n.element("PlanDefinition.subjectReference", -1, r.SubjectReference)
+	n.primitive("PlanDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("PlanDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("PlanDefinition.contact", i, v)
+	}
+	n.primitive("PlanDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("PlanDefinition.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("PlanDefinition.jurisdiction", i, v)
+	}
+	n.primitive("PlanDefinition.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("PlanDefinition.usage", -1, r.Usage, r.UsageElement, "string")
+	n.primitive("PlanDefinition.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("PlanDefinition.approvalDate", -1, r.ApprovalDate, r.ApprovalDateElement, "date")
+	n.primitive("PlanDefinition.lastReviewDate", -1, r.LastReviewDate, r.LastReviewDateElement, "date")
+	n.element("PlanDefinition.effectivePeriod", -1, r.EffectivePeriod)
+	for i, v := range r.Topic {
+		n.element("PlanDefinition.topic", i, v)
+	}
+	for i, v := range r.Author {
+		n.element("PlanDefinition.author", i, v)
+	}
+	for i, v := range r.Editor {
+		n.element("PlanDefinition.editor", i, v)
+	}
+	for i, v := range r.Reviewer {
+		n.element("PlanDefinition.reviewer", i, v)
+	}
+	for i, v := range r.Endorser {
+		n.element("PlanDefinition.endorser", i, v)
+	}
+	for i, v := range r.RelatedArtifact {
+		n.element("PlanDefinition.relatedArtifact", i, v)
+	}
+	for i, v := range r.Library {
+		n.primitive("PlanDefinition.library", i, v, elementAt(r.LibraryElement, i), "string")
+	}
+	for i, v := range r.Goal {
+		n.element("PlanDefinition.goal", i, v)
+	}
+	for i, v := range r.Action {
+		n.element("PlanDefinition.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given PlanDefinition as BSON document with the structure of its FHIR JSON
+func (r PlanDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PlanDefinition from a BSON document with the structure of its FHIR JSON
+func (r *PlanDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PlanDefinition as protobuf message PlanDefinition of fhir.proto
+func (r PlanDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PlanDefinition to the encoded message
+func (r PlanDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	if r.Subtitle != nil {
+		e.string(14, *r.Subtitle)
+	}
+	if r.Type != nil {
+		e.message(15, *r.Type)
+	}
+	e.varint(16, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(17, protoBool(*r.Experimental))
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.message(18, *r.SubjectCodeableConcept)
+	}
+	if r.SubjectReference != nil {
+		e.message(19, *r.SubjectReference)
+	}
+	if r.Date != nil {
+		e.string(20, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(21, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(22, v)
+	}
+	if r.Description != nil {
+		e.string(23, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(24, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(25, v)
+	}
+	if r.Purpose != nil {
+		e.string(26, *r.Purpose)
+	}
+	if r.Usage != nil {
+		e.string(27, *r.Usage)
+	}
+	if r.Copyright != nil {
+		e.string(28, *r.Copyright)
+	}
+	if r.ApprovalDate != nil {
+		e.string(29, *r.ApprovalDate)
+	}
+	if r.LastReviewDate != nil {
+		e.string(30, *r.LastReviewDate)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(31, *r.EffectivePeriod)
+	}
+	for _, v := range r.Topic {
+		e.message(32, v)
+	}
+	for _, v := range r.Author {
+		e.message(33, v)
+	}
+	for _, v := range r.Editor {
+		e.message(34, v)
+	}
+	for _, v := range r.Reviewer {
+		e.message(35, v)
+	}
+	for _, v := range r.Endorser {
+		e.message(36, v)
+	}
+	for _, v := range r.RelatedArtifact {
+		e.message(37, v)
+	}
+	for _, v := range r.Library {
+		e.string(38, v)
+	}
+	for _, v := range r.Goal {
+		e.message(39, v)
+	}
+	for _, v := range r.Action {
+		e.message(40, v)
+	}
+	if r.IdElement != nil {
+		e.message(41, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(42, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(43, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(44, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(45, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(46, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(47, *r.TitleElement)
+	}
+	if r.SubtitleElement != nil {
+		e.message(48, *r.SubtitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(49, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(50, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(51, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(52, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(53, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(54, *r.PurposeElement)
+	}
+	if r.UsageElement != nil {
+		e.message(55, *r.UsageElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(56, *r.CopyrightElement)
+	}
+	if r.ApprovalDateElement != nil {
+		e.message(57, *r.ApprovalDateElement)
+	}
+	if r.LastReviewDateElement != nil {
+		e.message(58, *r.LastReviewDateElement)
+	}
+	for _, v := range r.LibraryElement {
+		e.element(59, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given PlanDefinition from protobuf message PlanDefinition of fhir.proto
+func (r *PlanDefinition) UnmarshalProto(b []byte) error {
+	*r = PlanDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			v := d.string()
+			r.Subtitle = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 16:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 17:
+			v := d.bool()
+			r.Experimental = &v
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubjectCodeableConcept = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.SubjectReference = &v
+		case 20:
+			v := d.string()
+			r.Date = &v
+		case 21:
+			v := d.string()
+			r.Publisher = &v
+		case 22:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 23:
+			v := d.string()
+			r.Description = &v
+		case 24:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 25:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 26:
+			v := d.string()
+			r.Purpose = &v
+		case 27:
+			v := d.string()
+			r.Usage = &v
+		case 28:
+			v := d.string()
+			r.Copyright = &v
+		case 29:
+			v := d.string()
+			r.ApprovalDate = &v
+		case 30:
+			v := d.string()
+			r.LastReviewDate = &v
+		case 31:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 32:
+			var v CodeableConcept
+			d.message(&v)
+			r.Topic = append(r.Topic, v)
+		case 33:
+			var v ContactDetail
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 34:
+			var v ContactDetail
+			d.message(&v)
+			r.Editor = append(r.Editor, v)
+		case 35:
+			var v ContactDetail
+			d.message(&v)
+			r.Reviewer = append(r.Reviewer, v)
+		case 36:
+			var v ContactDetail
+			d.message(&v)
+			r.Endorser = append(r.Endorser, v)
+		case 37:
+			var v RelatedArtifact
+			d.message(&v)
+			r.RelatedArtifact = append(r.RelatedArtifact, v)
+		case 38:
+			r.Library = append(r.Library, d.string())
+		case 39:
+			var v PlanDefinitionGoal
+			d.message(&v)
+			r.Goal = append(r.Goal, v)
+		case 40:
+			var v PlanDefinitionAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		case 41:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.SubtitleElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 54:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.UsageElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 57:
+			var v Element
+			d.message(&v)
+			r.ApprovalDateElement = &v
+		case 58:
+			var v Element
+			d.message(&v)
+			r.LastReviewDateElement = &v
+		case 59:
+			r.LibraryElement = append(r.LibraryElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PlanDefinitionGoal as JSON into a byte slice
+func (r PlanDefinitionGoal) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PlanDefinitionGoal as JSON object with the members in the order of the fields
+func (r PlanDefinitionGoal) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	e.key("description")
+	r.Description.appendJSON(e)
+	if r.Priority != nil {
+		e.key("priority")
+		r.Priority.appendJSON(e)
+	}
+	if r.Start != nil {
+		e.key("start")
+		r.Start.appendJSON(e)
+	}
+	if len(r.Addresses) > 0 {
+		e.key("addresses")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Addresses {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Documentation) > 0 {
+		e.key("documentation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Documentation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Target) > 0 {
+		e.key("target")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Target {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PlanDefinitionGoal from JSON. Unknown members are ignored.
+func (r *PlanDefinitionGoal) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PlanDefinitionGoal
+func (r *PlanDefinitionGoal) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "description":
+			r.Description.decodeJSON(d)
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Priority = &v
+			}
+		case "start":
+			if d.null() {
+				r.Start = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Start = &v
+			}
+		case "addresses":
+			a := d.array()
+			r.Addresses = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Addresses = append(r.Addresses, v)
+			}
+			if r.Addresses == nil && !a.isNull {
+				r.Addresses = []CodeableConcept{}
+			}
+		case "documentation":
+			a := d.array()
+			r.Documentation = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.Documentation = append(r.Documentation, v)
+			}
+			if r.Documentation == nil && !a.isNull {
+				r.Documentation = []RelatedArtifact{}
+			}
+		case "target":
+			a := d.array()
+			r.Target = nil
+			for a.next() {
+				var v PlanDefinitionGoalTarget
+				v.decodeJSON(d)
+				r.Target = append(r.Target, v)
+			}
+			if r.Target == nil && !a.isNull {
+				r.Target = []PlanDefinitionGoalTarget{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PlanDefinitionGoal which shares no memory with the original
+func (r PlanDefinitionGoal) DeepCopy() PlanDefinitionGoal {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	out.Description = r.Description.DeepCopy()
+	if r.Priority != nil {
+		v := r.Priority.DeepCopy()
+		out.Priority = &v
+	}
+	if r.Start != nil {
+		v := r.Start.DeepCopy()
+		out.Start = &v
+	}
+	if r.Addresses != nil {
+		out.Addresses = make([]CodeableConcept, len(r.Addresses))
+		for i := range r.Addresses {
+			out.Addresses[i] = r.Addresses[i].DeepCopy()
+		}
+	}
+	if r.Documentation != nil {
+		out.Documentation = make([]RelatedArtifact, len(r.Documentation))
+		for i := range r.Documentation {
+			out.Documentation[i] = r.Documentation[i].DeepCopy()
+		}
+	}
+	if r.Target != nil {
+		out.Target = make([]PlanDefinitionGoalTarget, len(r.Target))
+		for i := range r.Target {
+			out.Target[i] = r.Target[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PlanDefinitionGoal) Equal(other PlanDefinitionGoal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if !r.Description.Equal(other.Description) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.Equal(*other.Priority) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && !r.Start.Equal(*other.Start) {
+		return false
+	}
+	if len(r.Addresses) != len(other.Addresses) {
+		return false
+	}
+	for i := range r.Addresses {
+		if !r.Addresses[i].Equal(other.Addresses[i]) {
+			return false
+		}
+	}
+	if len(r.Documentation) != len(other.Documentation) {
+		return false
+	}
+	for i := range r.Documentation {
+		if !r.Documentation[i].Equal(other.Documentation[i]) {
+			return false
+		}
+	}
+	if len(r.Target) != len(other.Target) {
+		return false
+	}
+	for i := range r.Target {
+		if !r.Target[i].Equal(other.Target[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PlanDefinitionGoal) EqualsDeep(other PlanDefinitionGoal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if !r.Description.EqualsDeep(other.Description) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && !r.Priority.EqualsDeep(*other.Priority) {
+		return false
+	}
+	if (r.Start == nil) != (other.Start == nil) || r.Start != nil && !r.Start.EqualsDeep(*other.Start) {
+		return false
+	}
+	if !equivalentList(len(r.Addresses), len(other.Addresses), func(i, j int) bool {
+		return r.Addresses[i].EqualsDeep(other.Addresses[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Documentation), len(other.Documentation), func(i, j int) bool {
+		return r.Documentation[i].EqualsDeep(other.Documentation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i].EqualsDeep(other.Target[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PlanDefinitionGoal) EqualsShallow(other PlanDefinitionGoal) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PlanDefinitionGoal as FHIR XML
+func (r PlanDefinitionGoal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "description", r.Description); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "priority", r.Priority); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "start", r.Start); err != nil {
+		return err
+	}
+	for _, v := range r.Addresses {
+		if err := encodeXMLElement(e, "addresses", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Documentation {
+		if err := encodeXMLElement(e, "documentation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Target {
+		if err := encodeXMLElement(e, "target", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PlanDefinitionGoal from FHIR XML
+func (r *PlanDefinitionGoal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "description":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Description = v
+			case "priority":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Priority = &v
+			case "start":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Start = &v
+			case "addresses":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Addresses = append(r.Addresses, v)
+			case "documentation":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Documentation = append(r.Documentation, v)
+			case "target":
+				var v PlanDefinitionGoalTarget
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = append(r.Target, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PlanDefinitionGoal as properties to the RDF node n
+func (r PlanDefinitionGoal) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("PlanDefinition.goal.category", -1, r.Category)
+	n.element("PlanDefinition.goal.description", -1, r.Description)
+	n.element("PlanDefinition.goal.priority", -1, r.Priority)
+	n.element("PlanDefinition.goal.start", -1, r.Start)
+	for i, v := range r.Addresses {
+		n.element("PlanDefinition.goal.addresses", i, v)
+	}
+	for i, v := range r.Documentation {
+		n.element("PlanDefinition.goal.documentation", i, v)
+	}
+	for i, v := range r.Target {
+		n.element("PlanDefinition.goal.target", i, v)
+	}
+}
+
+// MarshalBSON marshals the given PlanDefinitionGoal as BSON document with the structure of its FHIR JSON
+func (r PlanDefinitionGoal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PlanDefinitionGoal from a BSON document with the structure of its FHIR JSON
+func (r *PlanDefinitionGoal) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PlanDefinitionGoal as protobuf message PlanDefinitionGoal of fhir.proto
+func (r PlanDefinitionGoal) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PlanDefinitionGoal to the encoded message
+func (r PlanDefinitionGoal) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Category != nil {
+		e.message(4, *r.Category)
+	}
+	e.message(5, r.Description)
+	if r.Priority != nil {
+		e.message(6, *r.Priority)
+	}
+	if r.Start != nil {
+		e.message(7, *r.Start)
+	}
+	for _, v := range r.Addresses {
+		e.message(8, v)
+	}
+	for _, v := range r.Documentation {
+		e.message(9, v)
+	}
+	for _, v := range r.Target {
+		e.message(10, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given PlanDefinitionGoal from protobuf message PlanDefinitionGoal of fhir.proto
+func (r *PlanDefinitionGoal) UnmarshalProto(b []byte) error {
+	*r = PlanDefinitionGoal{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Description = v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Priority = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Start = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Addresses = append(r.Addresses, v)
+		case 9:
+			var v RelatedArtifact
+			d.message(&v)
+			r.Documentation = append(r.Documentation, v)
+		case 10:
+			var v PlanDefinitionGoalTarget
+			d.message(&v)
+			r.Target = append(r.Target, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PlanDefinitionGoalTarget as JSON into a byte slice
+func (r PlanDefinitionGoalTarget) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PlanDefinitionGoalTarget as JSON object with the members in the order of the fields
+func (r PlanDefinitionGoalTarget) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Measure != nil {
+		e.key("measure")
+		r.Measure.appendJSON(e)
+	}
+	if r.DetailQuantity != nil {
+		e.key("detailQuantity")
+		r.DetailQuantity.appendJSON(e)
+	}
+	if r.DetailRange != nil {
+		e.key("detailRange")
+		r.DetailRange.appendJSON(e)
+	}
+	if r.DetailCodeableConcept != nil {
+		e.key("detailCodeableConcept")
+		r.DetailCodeableConcept.appendJSON(e)
+	}
+	if r.Due != nil {
+		e.key("due")
+		r.Due.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PlanDefinitionGoalTarget from JSON. Unknown members are ignored.
+func (r *PlanDefinitionGoalTarget) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PlanDefinitionGoalTarget
+func (r *PlanDefinitionGoalTarget) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "measure":
+			if d.null() {
+				r.Measure = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Measure = &v
+			}
+		case "detailQuantity":
+			if d.null() {
+				r.DetailQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.DetailQuantity = &v
+			}
+		case "detailRange":
+			if d.null() {
+				r.DetailRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.DetailRange = &v
+			}
+		case "detailCodeableConcept":
+			if d.null() {
+				r.DetailCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DetailCodeableConcept = &v
+			}
+		case "due":
+			if d.null() {
+				r.Due = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.Due = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PlanDefinitionGoalTarget which shares no memory with the original
+func (r PlanDefinitionGoalTarget) DeepCopy() PlanDefinitionGoalTarget {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Measure != nil {
+		v := r.Measure.DeepCopy()
+		out.Measure = &v
+	}
+	if r.DetailQuantity != nil {
+		v := r.DetailQuantity.DeepCopy()
+		out.DetailQuantity = &v
+	}
+	if r.DetailRange != nil {
+		v := r.DetailRange.DeepCopy()
+		out.DetailRange = &v
+	}
+	if r.DetailCodeableConcept != nil {
+		v := r.DetailCodeableConcept.DeepCopy()
+		out.DetailCodeableConcept = &v
+	}
+	if r.Due != nil {
+		v := r.Due.DeepCopy()
+		out.Due = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PlanDefinitionGoalTarget) Equal(other PlanDefinitionGoalTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Measure == nil) != (other.Measure == nil) || r.Measure != nil && !r.Measure.Equal(*other.Measure) {
+		return false
+	}
+	if (r.DetailQuantity == nil) != (other.DetailQuantity == nil) || r.DetailQuantity != nil && !r.DetailQuantity.Equal(*other.DetailQuantity) {
+		return false
+	}
+	if (r.DetailRange == nil) != (other.DetailRange == nil) || r.DetailRange != nil && !r.DetailRange.Equal(*other.DetailRange) {
+		return false
+	}
+	if (r.DetailCodeableConcept == nil) != (other.DetailCodeableConcept == nil) || r.DetailCodeableConcept != nil && !r.DetailCodeableConcept.Equal(*other.DetailCodeableConcept) {
+		return false
+	}
+	if (r.Due == nil) != (other.Due == nil) || r.Due != nil && !r.Due.Equal(*other.Due) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PlanDefinitionGoalTarget) EqualsDeep(other PlanDefinitionGoalTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Measure == nil) != (other.Measure == nil) || r.Measure != nil && !r.Measure.EqualsDeep(*other.Measure) {
+		return false
+	}
+	if (r.DetailQuantity == nil) != (other.DetailQuantity == nil) || r.DetailQuantity != nil && !r.DetailQuantity.EqualsDeep(*other.DetailQuantity) {
+		return false
+	}
+	if (r.DetailRange == nil) != (other.DetailRange == nil) || r.DetailRange != nil && !r.DetailRange.EqualsDeep(*other.DetailRange) {
+		return false
+	}
+	if (r.DetailCodeableConcept == nil) != (other.DetailCodeableConcept == nil) || r.DetailCodeableConcept != nil && !r.DetailCodeableConcept.EqualsDeep(*other.DetailCodeableConcept) {
+		return false
+	}
+	if (r.Due == nil) != (other.Due == nil) || r.Due != nil && !r.Due.EqualsDeep(*other.Due) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PlanDefinitionGoalTarget) EqualsShallow(other PlanDefinitionGoalTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PlanDefinitionGoalTarget as FHIR XML
+func (r PlanDefinitionGoalTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "measure", r.Measure); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "detailQuantity", r.DetailQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "detailRange", r.DetailRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "detailCodeableConcept", r.DetailCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "due", r.Due); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PlanDefinitionGoalTarget from FHIR XML
+func (r *PlanDefinitionGoalTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "measure":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Measure = &v
+			case "detailQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DetailQuantity = &v
+			case "detailRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DetailRange = &v
+			case "detailCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DetailCodeableConcept = &v
+			case "due":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Due = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PlanDefinitionGoalTarget as properties to the RDF node n
+func (r PlanDefinitionGoalTarget) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("PlanDefinition.goal.target.measure", -1, r.Measure)
+	n.element("PlanDefinition.goal.target.detailQuantity", -1, r.DetailQuantity)
+	n.element("PlanDefinition.goal.target.detailRange", -1, r.DetailRange)
+	n.element("PlanDefinition.goal.target.detailCodeableConcept", -1, r.DetailCodeableConcept)
+	n.element("PlanDefinition.goal.target.due", -1, r.Due)
+}
+
+// MarshalBSON marshals the given PlanDefinitionGoalTarget as BSON document with the structure of its FHIR JSON
+func (r PlanDefinitionGoalTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PlanDefinitionGoalTarget from a BSON document with the structure of its FHIR JSON
+func (r *PlanDefinitionGoalTarget) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PlanDefinitionGoalTarget as protobuf message PlanDefinitionGoalTarget of fhir.proto
+func (r PlanDefinitionGoalTarget) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PlanDefinitionGoalTarget to the encoded message
+func (r PlanDefinitionGoalTarget) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Measure != nil {
+		e.message(4, *r.Measure)
+	}
+	if r.DetailQuantity != nil {
+		e.message(5, *r.DetailQuantity)
+	}
+	if r.DetailRange != nil {
+		e.message(6, *r.DetailRange)
+	}
+	if r.DetailCodeableConcept != nil {
+		e.message(7, *r.DetailCodeableConcept)
+	}
+	if r.Due != nil {
+		e.message(8, *r.Due)
+	}
+}
+
+// UnmarshalProto unmarshals the given PlanDefinitionGoalTarget from protobuf message PlanDefinitionGoalTarget of fhir.proto
+func (r *PlanDefinitionGoalTarget) UnmarshalProto(b []byte) error {
+	*r = PlanDefinitionGoalTarget{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Measure = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.DetailQuantity = &v
+		case 6:
+			var v Range
+			d.message(&v)
+			r.DetailRange = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.DetailCodeableConcept = &v
+		case 8:
+			var v Duration
+			d.message(&v)
+			r.Due = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PlanDefinitionAction as JSON into a byte slice
+func (r PlanDefinitionAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PlanDefinitionAction as JSON object with the members in the order of the fields
+func (r PlanDefinitionAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Prefix != nil {
+		e.key("prefix")
+		e.string(*r.Prefix)
+	}
+	if r.PrefixElement != nil {
+		e.key("_prefix")
+		r.PrefixElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.TextEquivalent != nil {
+		e.key("textEquivalent")
+		e.string(*r.TextEquivalent)
+	}
+	if r.TextEquivalentElement != nil {
+		e.key("_textEquivalent")
+		r.TextEquivalentElement.appendJSON(e)
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Reason) > 0 {
+		e.key("reason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Documentation) > 0 {
+		e.key("documentation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Documentation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.GoalId) > 0 {
+		e.key("goalId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.GoalId {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.GoalIdElement) > 0 {
+		e.key("_goalId")
+		e.elements(r.GoalIdElement, len(r.GoalId))
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.key("subjectCodeableConcept")
+		r.SubjectCodeableConcept.appendJSON(e)
+	}
+	if r.SubjectReference != nil {
+		e.key("subjectReference")
+		r.SubjectReference.appendJSON(e)
+	}
+	if len(r.Trigger) > 0 {
+		e.key("trigger")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Trigger {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Condition) > 0 {
+		e.key("condition")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Condition {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Input) > 0 {
+		e.key("input")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Input {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Output) > 0 {
+		e.key("output")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Output {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedAction) > 0 {
+		e.key("relatedAction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedAction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.TimingDateTime != nil {
+		e.key("timingDateTime")
+		e.string(*r.TimingDateTime)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.key("_timingDateTime")
+		r.TimingDateTimeElement.appendJSON(e)
+	}
+	if r.TimingAge != nil {
+		e.key("timingAge")
+		r.TimingAge.appendJSON(e)
+	}
+	if r.TimingPeriod != nil {
+		e.key("timingPeriod")
+		r.TimingPeriod.appendJSON(e)
+	}
+	if r.TimingDuration != nil {
+		e.key("timingDuration")
+		r.TimingDuration.appendJSON(e)
+	}
+	if r.TimingRange != nil {
+		e.key("timingRange")
+		r.TimingRange.appendJSON(e)
+	}
+	if r.TimingTiming != nil {
+		e.key("timingTiming")
+		r.TimingTiming.appendJSON(e)
+	}
+	if len(r.Participant) > 0 {
+		e.key("participant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Participant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.GroupingBehavior != nil {
+		e.key("groupingBehavior")
+		e.string(r.GroupingBehavior.Code())
+	}
+	if r.GroupingBehaviorElement != nil {
+		e.key("_groupingBehavior")
+		r.GroupingBehaviorElement.appendJSON(e)
+	}
+	if r.SelectionBehavior != nil {
+		e.key("selectionBehavior")
+		e.string(r.SelectionBehavior.Code())
+	}
+	if r.SelectionBehaviorElement != nil {
+		e.key("_selectionBehavior")
+		r.SelectionBehaviorElement.appendJSON(e)
+	}
+	if r.RequiredBehavior != nil {
+		e.key("requiredBehavior")
+		e.string(r.RequiredBehavior.Code())
+	}
+	if r.RequiredBehaviorElement != nil {
+		e.key("_requiredBehavior")
+		r.RequiredBehaviorElement.appendJSON(e)
+	}
+	if r.PrecheckBehavior != nil {
+		e.key("precheckBehavior")
+		e.string(r.PrecheckBehavior.Code())
+	}
+	if r.PrecheckBehaviorElement != nil {
+		e.key("_precheckBehavior")
+		r.PrecheckBehaviorElement.appendJSON(e)
+	}
+	if r.CardinalityBehavior != nil {
+		e.key("cardinalityBehavior")
+		e.string(r.CardinalityBehavior.Code())
+	}
+	if r.CardinalityBehaviorElement != nil {
+		e.key("_cardinalityBehavior")
+		r.CardinalityBehaviorElement.appendJSON(e)
+	}
+	if r.DefinitionCanonical != nil {
+		e.key("definitionCanonical")
+		e.string(*r.DefinitionCanonical)
+	}
+	if r.DefinitionCanonicalElement != nil {
+		e.key("_definitionCanonical")
+		r.DefinitionCanonicalElement.appendJSON(e)
+	}
+	if r.DefinitionUri != nil {
+		e.key("definitionUri")
+		e.string(*r.DefinitionUri)
+	}
+	if r.DefinitionUriElement != nil {
+		e.key("_definitionUri")
+		r.DefinitionUriElement.appendJSON(e)
+	}
+	if r.Transform != nil {
+		e.key("transform")
+		e.string(*r.Transform)
+	}
+	if r.TransformElement != nil {
+		e.key("_transform")
+		r.TransformElement.appendJSON(e)
+	}
+	if len(r.DynamicValue) > 0 {
+		e.key("dynamicValue")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DynamicValue {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Action) > 0 {
+		e.key("action")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PlanDefinitionAction from JSON. Unknown members are ignored.
+func (r *PlanDefinitionAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PlanDefinitionAction
+func (r *PlanDefinitionAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "prefix":
+			if v, ok := d.string(); ok {
+				r.Prefix = &v
+			} else {
+				r.Prefix = nil
+			}
+		case "_prefix":
+			r.PrefixElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "textEquivalent":
+			if v, ok := d.string(); ok {
+				r.TextEquivalent = &v
+			} else {
+				r.TextEquivalent = nil
+			}
+		case "_textEquivalent":
+			r.TextEquivalentElement = d.element()
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "reason":
+			a := d.array()
+			r.Reason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Reason = append(r.Reason, v)
+			}
+			if r.Reason == nil && !a.isNull {
+				r.Reason = []CodeableConcept{}
+			}
+		case "documentation":
+			a := d.array()
+			r.Documentation = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.Documentation = append(r.Documentation, v)
+			}
+			if r.Documentation == nil && !a.isNull {
+				r.Documentation = []RelatedArtifact{}
+			}
+		case "goalId":
+			a := d.array()
+			r.GoalId = nil
+			for a.next() {
+				v, _ := d.string()
+				r.GoalId = append(r.GoalId, v)
+			}
+			if r.GoalId == nil && !a.isNull {
+				r.GoalId = []string{}
+			}
+		case "_goalId":
+			a := d.array()
+			r.GoalIdElement = nil
+			for a.next() {
+				r.GoalIdElement = append(r.GoalIdElement, d.element())
+			}
+		case "subjectCodeableConcept":
+			if d.null() {
+				r.SubjectCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubjectCodeableConcept = &v
+			}
+		case "subjectReference":
+			if d.null() {
+				r.SubjectReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubjectReference = &v
+			}
+		case "trigger":
+			a := d.array()
+			r.Trigger = nil
+			for a.next() {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.Trigger = append(r.Trigger, v)
+			}
+			if r.Trigger == nil && !a.isNull {
+				r.Trigger = []TriggerDefinition{}
+			}
+		case "condition":
+			a := d.array()
+			r.Condition = nil
+			for a.next() {
+				var v PlanDefinitionActionCondition
+				v.decodeJSON(d)
+				r.Condition = append(r.Condition, v)
+			}
+			if r.Condition == nil && !a.isNull {
+				r.Condition = []PlanDefinitionActionCondition{}
+			}
+		case "input":
+			a := d.array()
+			r.Input = nil
+			for a.next() {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.Input = append(r.Input, v)
+			}
+			if r.Input == nil && !a.isNull {
+				r.Input = []DataRequirement{}
+			}
+		case "output":
+			a := d.array()
+			r.Output = nil
+			for a.next() {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.Output = append(r.Output, v)
+			}
+			if r.Output == nil && !a.isNull {
+				r.Output = []DataRequirement{}
+			}
+		case "relatedAction":
+			a := d.array()
+			r.RelatedAction = nil
+			for a.next() {
+				var v PlanDefinitionActionRelatedAction
+				v.decodeJSON(d)
+				r.RelatedAction = append(r.RelatedAction, v)
+			}
+			if r.RelatedAction == nil && !a.isNull {
+				r.RelatedAction = []PlanDefinitionActionRelatedAction{}
+			}
+		case "timingDateTime":
+			if v, ok := d.string(); ok {
+				r.TimingDateTime = &v
+			} else {
+				r.TimingDateTime = nil
+			}
+		case "_timingDateTime":
+			r.TimingDateTimeElement = d.element()
+		case "timingAge":
+			if d.null() {
+				r.TimingAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.TimingAge = &v
+			}
+		case "timingPeriod":
+			if d.null() {
+				r.TimingPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.TimingPeriod = &v
+			}
+		case "timingDuration":
+			if d.null() {
+				r.TimingDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.TimingDuration = &v
+			}
+		case "timingRange":
+			if d.null() {
+				r.TimingRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.TimingRange = &v
+			}
+		case "timingTiming":
+			if d.null() {
+				r.TimingTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.TimingTiming = &v
+			}
+		case "participant":
+			a := d.array()
+			r.Participant = nil
+			for a.next() {
+				var v PlanDefinitionActionParticipant
+				v.decodeJSON(d)
+				r.Participant = append(r.Participant, v)
+			}
+			if r.Participant == nil && !a.isNull {
+				r.Participant = []PlanDefinitionActionParticipant{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "groupingBehavior":
+			if d.null() {
+				r.GroupingBehavior = nil
+			} else {
+				var v ActionGroupingBehavior
+				d.unmarshal(&v)
+				r.GroupingBehavior = &v
+			}
+		case "_groupingBehavior":
+			r.GroupingBehaviorElement = d.element()
+		case "selectionBehavior":
+			if d.null() {
+				r.SelectionBehavior = nil
+			} else {
+				var v ActionSelectionBehavior
+				d.unmarshal(&v)
+				r.SelectionBehavior = &v
+			}
+		case "_selectionBehavior":
+			r.SelectionBehaviorElement = d.element()
+		case "requiredBehavior":
+			if d.null() {
+				r.RequiredBehavior = nil
+			} else {
+				var v ActionRequiredBehavior
+				d.unmarshal(&v)
+				r.RequiredBehavior = &v
+			}
+		case "_requiredBehavior":
+			r.RequiredBehaviorElement = d.element()
+		case "precheckBehavior":
+			if d.null() {
+				r.PrecheckBehavior = nil
+			} else {
+				var v ActionPrecheckBehavior
+				d.unmarshal(&v)
+				r.PrecheckBehavior = &v
+			}
+		case "_precheckBehavior":
+			r.PrecheckBehaviorElement = d.element()
+		case "cardinalityBehavior":
+			if d.null() {
+				r.CardinalityBehavior = nil
+			} else {
+				var v ActionCardinalityBehavior
+				d.unmarshal(&v)
+				r.CardinalityBehavior = &v
+			}
+		case "_cardinalityBehavior":
+			r.CardinalityBehaviorElement = d.element()
+		case "definitionCanonical":
+			if v, ok := d.string(); ok {
+				r.DefinitionCanonical = &v
+			} else {
+				r.DefinitionCanonical = nil
+			}
+		case "_definitionCanonical":
+			r.DefinitionCanonicalElement = d.element()
+		case "definitionUri":
+			if v, ok := d.string(); ok {
+				r.DefinitionUri = &v
+			} else {
+				r.DefinitionUri = nil
+			}
+		case "_definitionUri":
+			r.DefinitionUriElement = d.element()
+		case "transform":
+			if v, ok := d.string(); ok {
+				r.Transform = &v
+			} else {
+				r.Transform = nil
+			}
+		case "_transform":
+			r.TransformElement = d.element()
+		case "dynamicValue":
+			a := d.array()
+			r.DynamicValue = nil
+			for a.next() {
+				var v PlanDefinitionActionDynamicValue
+				v.decodeJSON(d)
+				r.DynamicValue = append(r.DynamicValue, v)
+			}
+			if r.DynamicValue == nil && !a.isNull {
+				r.DynamicValue = []PlanDefinitionActionDynamicValue{}
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v PlanDefinitionAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []PlanDefinitionAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PlanDefinitionAction which shares no memory with the original
+func (r PlanDefinitionAction) DeepCopy() PlanDefinitionAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Prefix != nil {
+		v := *r.Prefix
+		out.Prefix = &v
+	}
+	if r.PrefixElement != nil {
+		v := r.PrefixElement.DeepCopy()
+		out.PrefixElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.TextEquivalent != nil {
+		v := *r.TextEquivalent
+		out.TextEquivalent = &v
+	}
+	if r.TextEquivalentElement != nil {
+		v := r.TextEquivalentElement.DeepCopy()
+		out.TextEquivalentElement = &v
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Reason != nil {
+		out.Reason = make([]CodeableConcept, len(r.Reason))
+		for i := range r.Reason {
+			out.Reason[i] = r.Reason[i].DeepCopy()
+		}
+	}
+	if r.Documentation != nil {
+		out.Documentation = make([]RelatedArtifact, len(r.Documentation))
+		for i := range r.Documentation {
+			out.Documentation[i] = r.Documentation[i].DeepCopy()
+		}
+	}
+	if r.GoalId != nil {
+		out.GoalId = make([]string, len(r.GoalId))
+		copy(out.GoalId, r.GoalId)
+	}
+	if r.GoalIdElement != nil {
+		out.GoalIdElement = make([]*Element, len(r.GoalIdElement))
+		for i, v := range r.GoalIdElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.GoalIdElement[i] = &c
+			}
+		}
+	}
+	if r.SubjectCodeableConcept != nil {
+		v := r.SubjectCodeableConcept.DeepCopy()
+		out.SubjectCodeableConcept = &v
+	}
+	if r.SubjectReference != nil {
+		v := r.SubjectReference.DeepCopy()
+		out.SubjectReference = &v
+	}
+	if r.Trigger != nil {
+		out.Trigger = make([]TriggerDefinition, len(r.Trigger))
+		for i := range r.Trigger {
+			out.Trigger[i] = r.Trigger[i].DeepCopy()
+		}
+	}
+	if r.Condition != nil {
+		out.Condition = make([]PlanDefinitionActionCondition, len(r.Condition))
+		for i := range r.Condition {
+			out.Condition[i] = r.Condition[i].DeepCopy()
+		}
+	}
+	if r.Input != nil {
+		out.Input = make([]DataRequirement, len(r.Input))
+		for i := range r.Input {
+			out.Input[i] = r.Input[i].DeepCopy()
+		}
+	}
+	if r.Output != nil {
+		out.Output = make([]DataRequirement, len(r.Output))
+		for i := range r.Output {
+			out.Output[i] = r.Output[i].DeepCopy()
+		}
+	}
+	if r.RelatedAction != nil {
+		out.RelatedAction = make([]PlanDefinitionActionRelatedAction, len(r.RelatedAction))
+		for i := range r.RelatedAction {
+			out.RelatedAction[i] = r.RelatedAction[i].DeepCopy()
+		}
+	}
+	if r.TimingDateTime != nil {
+		v := *r.TimingDateTime
+		out.TimingDateTime = &v
+	}
+	if r.TimingDateTimeElement != nil {
+		v := r.TimingDateTimeElement.DeepCopy()
+		out.TimingDateTimeElement = &v
+	}
+	if r.TimingAge != nil {
+		v := r.TimingAge.DeepCopy()
+		out.TimingAge = &v
+	}
+	if r.TimingPeriod != nil {
+		v := r.TimingPeriod.DeepCopy()
+		out.TimingPeriod = &v
+	}
+	if r.TimingDuration != nil {
+		v := r.TimingDuration.DeepCopy()
+		out.TimingDuration = &v
+	}
+	if r.TimingRange != nil {
+		v := r.TimingRange.DeepCopy()
+		out.TimingRange = &v
+	}
+	if r.TimingTiming != nil {
+		v := r.TimingTiming.DeepCopy()
+		out.TimingTiming = &v
+	}
+	if r.Participant != nil {
+		out.Participant = make([]PlanDefinitionActionParticipant, len(r.Participant))
+		for i := range r.Participant {
+			out.Participant[i] = r.Participant[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.GroupingBehavior != nil {
+		v := *r.GroupingBehavior
+		out.GroupingBehavior = &v
+	}
+	if r.GroupingBehaviorElement != nil {
+		v := r.GroupingBehaviorElement.DeepCopy()
+		out.GroupingBehaviorElement = &v
+	}
+	if r.SelectionBehavior != nil {
+		v := *r.SelectionBehavior
+		out.SelectionBehavior = &v
+	}
+	if r.SelectionBehaviorElement != nil {
+		v := r.SelectionBehaviorElement.DeepCopy()
+		out.SelectionBehaviorElement = &v
+	}
+	if r.RequiredBehavior != nil {
+		v := *r.RequiredBehavior
+		out.RequiredBehavior = &v
+	}
+	if r.RequiredBehaviorElement != nil {
+		v := r.RequiredBehaviorElement.DeepCopy()
+		out.RequiredBehaviorElement = &v
+	}
+	if r.PrecheckBehavior != nil {
+		v := *r.PrecheckBehavior
+		out.PrecheckBehavior = &v
+	}
+	if r.PrecheckBehaviorElement != nil {
+		v := r.PrecheckBehaviorElement.DeepCopy()
+		out.PrecheckBehaviorElement = &v
+	}
+	if r.CardinalityBehavior != nil {
+		v := *r.CardinalityBehavior
+		out.CardinalityBehavior = &v
+	}
+	if r.CardinalityBehaviorElement != nil {
+		v := r.CardinalityBehaviorElement.DeepCopy()
+		out.CardinalityBehaviorElement = &v
+	}
+	if r.DefinitionCanonical != nil {
+		v := *r.DefinitionCanonical
+		out.DefinitionCanonical = &v
+	}
+	if r.DefinitionCanonicalElement != nil {
+		v := r.DefinitionCanonicalElement.DeepCopy()
+		out.DefinitionCanonicalElement = &v
+	}
+	if r.DefinitionUri != nil {
+		v := *r.DefinitionUri
+		out.DefinitionUri = &v
+	}
+	if r.DefinitionUriElement != nil {
+		v := r.DefinitionUriElement.DeepCopy()
+		out.DefinitionUriElement = &v
+	}
+	if r.Transform != nil {
+		v := *r.Transform
+		out.Transform = &v
+	}
+	if r.TransformElement != nil {
+		v := r.TransformElement.DeepCopy()
+		out.TransformElement = &v
+	}
+	if r.DynamicValue != nil {
+		out.DynamicValue = make([]PlanDefinitionActionDynamicValue, len(r.DynamicValue))
+		for i := range r.DynamicValue {
+			out.DynamicValue[i] = r.DynamicValue[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		out.Action = make([]PlanDefinitionAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PlanDefinitionAction) Equal(other PlanDefinitionAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Prefix == nil) != (other.Prefix == nil) || r.Prefix != nil && *r.Prefix != *other.Prefix {
+		return false
+	}
+	if (r.PrefixElement == nil) != (other.PrefixElement == nil) || r.PrefixElement != nil && !r.PrefixElement.Equal(*other.PrefixElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.TextEquivalent == nil) != (other.TextEquivalent == nil) || r.TextEquivalent != nil && *r.TextEquivalent != *other.TextEquivalent {
+		return false
+	}
+	if (r.TextEquivalentElement == nil) != (other.TextEquivalentElement == nil) || r.TextEquivalentElement != nil && !r.TextEquivalentElement.Equal(*other.TextEquivalentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if len(r.Reason) != len(other.Reason) {
+		return false
+	}
+	for i := range r.Reason {
+		if !r.Reason[i].Equal(other.Reason[i]) {
+			return false
+		}
+	}
+	if len(r.Documentation) != len(other.Documentation) {
+		return false
+	}
+	for i := range r.Documentation {
+		if !r.Documentation[i].Equal(other.Documentation[i]) {
+			return false
+		}
+	}
+	if len(r.GoalId) != len(other.GoalId) {
+		return false
+	}
+	for i := range r.GoalId {
+		if r.GoalId[i] != other.GoalId[i] {
+			return false
+		}
+	}
+	if !equalElements(r.GoalIdElement, other.GoalIdElement, false) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.Equal(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.Equal(*other.SubjectReference) {
+		return false
+	}
+	if len(r.Trigger) != len(other.Trigger) {
+		return false
+	}
+	for i := range r.Trigger {
+		if !r.Trigger[i].Equal(other.Trigger[i]) {
+			return false
+		}
+	}
+	if len(r.Condition) != len(other.Condition) {
+		return false
+	}
+	for i := range r.Condition {
+		if !r.Condition[i].Equal(other.Condition[i]) {
+			return false
+		}
+	}
+	if len(r.Input) != len(other.Input) {
+		return false
+	}
+	for i := range r.Input {
+		if !r.Input[i].Equal(other.Input[i]) {
+			return false
+		}
+	}
+	if len(r.Output) != len(other.Output) {
+		return false
+	}
+	for i := range r.Output {
+		if !r.Output[i].Equal(other.Output[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedAction) != len(other.RelatedAction) {
+		return false
+	}
+	for i := range r.RelatedAction {
+		if !r.RelatedAction[i].Equal(other.RelatedAction[i]) {
+			return false
+		}
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && *r.TimingDateTime != *other.TimingDateTime {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.Equal(*other.TimingDateTimeElement) {
+		return false
+	}
+	if (r.TimingAge == nil) != (other.TimingAge == nil) || r.TimingAge != nil && !r.TimingAge.Equal(*other.TimingAge) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.Equal(*other.TimingPeriod) {
+		return false
+	}
+	if (r.TimingDuration == nil) != (other.TimingDuration == nil) || r.TimingDuration != nil && !r.TimingDuration.Equal(*other.TimingDuration) {
+		return false
+	}
+	if (r.TimingRange == nil) != (other.TimingRange == nil) || r.TimingRange != nil && !r.TimingRange.Equal(*other.TimingRange) {
+		return false
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.Equal(*other.TimingTiming) {
+		return false
+	}
+	if len(r.Participant) != len(other.Participant) {
+		return false
+	}
+	for i := range r.Participant {
+		if !r.Participant[i].Equal(other.Participant[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.GroupingBehavior == nil) != (other.GroupingBehavior == nil) || r.GroupingBehavior != nil && *r.GroupingBehavior != *other.GroupingBehavior {
+		return false
+	}
+	if (r.GroupingBehaviorElement == nil) != (other.GroupingBehaviorElement == nil) || r.GroupingBehaviorElement != nil && !r.GroupingBehaviorElement.Equal(*other.GroupingBehaviorElement) {
+		return false
+	}
+	if (r.SelectionBehavior == nil) != (other.SelectionBehavior == nil) || r.SelectionBehavior != nil && *r.SelectionBehavior != *other.SelectionBehavior {
+		return false
+	}
+	if (r.SelectionBehaviorElement == nil) != (other.SelectionBehaviorElement == nil) || r.SelectionBehaviorElement != nil && !r.SelectionBehaviorElement.Equal(*other.SelectionBehaviorElement) {
+		return false
+	}
+	if (r.RequiredBehavior == nil) != (other.RequiredBehavior == nil) || r.RequiredBehavior != nil && *r.RequiredBehavior != *other.RequiredBehavior {
+		return false
+	}
+	if (r.RequiredBehaviorElement == nil) != (other.RequiredBehaviorElement == nil) || r.RequiredBehaviorElement != nil && !r.RequiredBehaviorElement.Equal(*other.RequiredBehaviorElement) {
+		return false
+	}
+	if (r.PrecheckBehavior == nil) != (other.PrecheckBehavior == nil) || r.PrecheckBehavior != nil && *r.PrecheckBehavior != *other.PrecheckBehavior {
+		return false
+	}
+	if (r.PrecheckBehaviorElement == nil) != (other.PrecheckBehaviorElement == nil) || r.PrecheckBehaviorElement != nil && !r.PrecheckBehaviorElement.Equal(*other.PrecheckBehaviorElement) {
+		return false
+	}
+	if (r.CardinalityBehavior == nil) != (other.CardinalityBehavior == nil) || r.CardinalityBehavior != nil && *r.CardinalityBehavior != *other.CardinalityBehavior {
+		return false
+	}
+	if (r.CardinalityBehaviorElement == nil) != (other.CardinalityBehaviorElement == nil) || r.CardinalityBehaviorElement != nil && !r.CardinalityBehaviorElement.Equal(*other.CardinalityBehaviorElement) {
+		return false
+	}
+	if (r.DefinitionCanonical == nil) != (other.DefinitionCanonical == nil) || r.DefinitionCanonical != nil && *r.DefinitionCanonical != *other.DefinitionCanonical {
+		return false
+	}
+	if (r.DefinitionCanonicalElement == nil) != (other.DefinitionCanonicalElement == nil) || r.DefinitionCanonicalElement != nil && !r.DefinitionCanonicalElement.Equal(*other.DefinitionCanonicalElement) {
+		return false
+	}
+	if (r.DefinitionUri == nil) != (other.DefinitionUri == nil) || r.DefinitionUri != nil && *r.DefinitionUri != *other.DefinitionUri {
+		return false
+	}
+	if (r.DefinitionUriElement == nil) != (other.DefinitionUriElement == nil) || r.DefinitionUriElement != nil && !r.DefinitionUriElement.Equal(*other.DefinitionUriElement) {
+		return false
+	}
+	if (r.Transform == nil) != (other.Transform == nil) || r.Transform != nil && *r.Transform != *other.Transform {
+		return false
+	}
+	if (r.TransformElement == nil) != (other.TransformElement == nil) || r.TransformElement != nil && !r.TransformElement.Equal(*other.TransformElement) {
+		return false
+	}
+	if len(r.DynamicValue) != len(other.DynamicValue) {
+		return false
+	}
+	for i := range r.DynamicValue {
+		if !r.DynamicValue[i].Equal(other.DynamicValue[i]) {
+			return false
+		}
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PlanDefinitionAction) EqualsDeep(other PlanDefinitionAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Prefix == nil) != (other.Prefix == nil) || r.Prefix != nil && !equivalentString(*r.Prefix, *other.Prefix) {
+		return false
+	}
+	if (r.PrefixElement == nil) != (other.PrefixElement == nil) || r.PrefixElement != nil && !r.PrefixElement.EqualsDeep(*other.PrefixElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.TextEquivalent == nil) != (other.TextEquivalent == nil) || r.TextEquivalent != nil && !equivalentString(*r.TextEquivalent, *other.TextEquivalent) {
+		return false
+	}
+	if (r.TextEquivalentElement == nil) != (other.TextEquivalentElement == nil) || r.TextEquivalentElement != nil && !r.TextEquivalentElement.EqualsDeep(*other.TextEquivalentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reason), len(other.Reason), func(i, j int) bool {
+		return r.Reason[i].EqualsDeep(other.Reason[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Documentation), len(other.Documentation), func(i, j int) bool {
+		return r.Documentation[i].EqualsDeep(other.Documentation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.GoalId), len(other.GoalId), func(i, j int) bool {
+		return equivalentString(r.GoalId[i], other.GoalId[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.GoalIdElement, other.GoalIdElement, true) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.EqualsDeep(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.EqualsDeep(*other.SubjectReference) {
+		return false
+	}
+	if !equivalentList(len(r.Trigger), len(other.Trigger), func(i, j int) bool {
+		return r.Trigger[i].EqualsDeep(other.Trigger[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Condition), len(other.Condition), func(i, j int) bool {
+		return r.Condition[i].EqualsDeep(other.Condition[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Input), len(other.Input), func(i, j int) bool {
+		return r.Input[i].EqualsDeep(other.Input[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Output), len(other.Output), func(i, j int) bool {
+		return r.Output[i].EqualsDeep(other.Output[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedAction), len(other.RelatedAction), func(i, j int) bool {
+		return r.RelatedAction[i].EqualsDeep(other.RelatedAction[j])
+	}) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.EqualsDeep(*other.TimingDateTimeElement) {
+		return false
+	}
+	if (r.TimingAge == nil) != (other.TimingAge == nil) || r.TimingAge != nil && !r.TimingAge.EqualsDeep(*other.TimingAge) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.EqualsDeep(*other.TimingPeriod) {
+		return false
+	}
+	if (r.TimingDuration == nil) != (other.TimingDuration == nil) || r.TimingDuration != nil && !r.TimingDuration.EqualsDeep(*other.TimingDuration) {
+		return false
+	}
+	if (r.TimingRange == nil) != (other.TimingRange == nil) || r.TimingRange != nil && !r.TimingRange.EqualsDeep(*other.TimingRange) {
+		return false
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.EqualsDeep(*other.TimingTiming) {
+		return false
+	}
+	if !equivalentList(len(r.Participant), len(other.Participant), func(i, j int) bool {
+		return r.Participant[i].EqualsDeep(other.Participant[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.GroupingBehavior == nil) != (other.GroupingBehavior == nil) || r.GroupingBehavior != nil && *r.GroupingBehavior != *other.GroupingBehavior {
+		return false
+	}
+	if (r.GroupingBehaviorElement == nil) != (other.GroupingBehaviorElement == nil) || r.GroupingBehaviorElement != nil && !r.GroupingBehaviorElement.EqualsDeep(*other.GroupingBehaviorElement) {
+		return false
+	}
+	if (r.SelectionBehavior == nil) != (other.SelectionBehavior == nil) || r.SelectionBehavior != nil && *r.SelectionBehavior != *other.SelectionBehavior {
+		return false
+	}
+	if (r.SelectionBehaviorElement == nil) != (other.SelectionBehaviorElement == nil) || r.SelectionBehaviorElement != nil && !r.SelectionBehaviorElement.EqualsDeep(*other.SelectionBehaviorElement) {
+		return false
+	}
+	if (r.RequiredBehavior == nil) != (other.RequiredBehavior == nil) || r.RequiredBehavior != nil && *r.RequiredBehavior != *other.RequiredBehavior {
+		return false
+	}
+	if (r.RequiredBehaviorElement == nil) != (other.RequiredBehaviorElement == nil) || r.RequiredBehaviorElement != nil && !r.RequiredBehaviorElement.EqualsDeep(*other.RequiredBehaviorElement) {
+		return false
+	}
+	if (r.PrecheckBehavior == nil) != (other.PrecheckBehavior == nil) || r.PrecheckBehavior != nil && *r.PrecheckBehavior != *other.PrecheckBehavior {
+		return false
+	}
+	if (r.PrecheckBehaviorElement == nil) != (other.PrecheckBehaviorElement == nil) || r.PrecheckBehaviorElement != nil && !r.PrecheckBehaviorElement.EqualsDeep(*other.PrecheckBehaviorElement) {
+		return false
+	}
+	if (r.CardinalityBehavior == nil) != (other.CardinalityBehavior == nil) || r.CardinalityBehavior != nil && *r.CardinalityBehavior != *other.CardinalityBehavior {
+		return false
+	}
+	if (r.CardinalityBehaviorElement == nil) != (other.CardinalityBehaviorElement == nil) || r.CardinalityBehaviorElement != nil && !r.CardinalityBehaviorElement.EqualsDeep(*other.CardinalityBehaviorElement) {
+		return false
+	}
+	if (r.DefinitionCanonical == nil) != (other.DefinitionCanonical == nil) || r.DefinitionCanonical != nil && !equivalentString(*r.DefinitionCanonical, *other.DefinitionCanonical) {
+		return false
+	}
+	if (r.DefinitionCanonicalElement == nil) != (other.DefinitionCanonicalElement == nil) || r.DefinitionCanonicalElement != nil && !r.DefinitionCanonicalElement.EqualsDeep(*other.DefinitionCanonicalElement) {
+		return false
+	}
+	if (r.DefinitionUri == nil) != (other.DefinitionUri == nil) || r.DefinitionUri != nil && !equivalentString(*r.DefinitionUri, *other.DefinitionUri) {
+		return false
+	}
+	if (r.DefinitionUriElement == nil) != (other.DefinitionUriElement == nil) || r.DefinitionUriElement != nil && !r.DefinitionUriElement.EqualsDeep(*other.DefinitionUriElement) {
+		return false
+	}
+	if (r.Transform == nil) != (other.Transform == nil) || r.Transform != nil && !equivalentString(*r.Transform, *other.Transform) {
+		return false
+	}
+	if (r.TransformElement == nil) != (other.TransformElement == nil) || r.TransformElement != nil && !r.TransformElement.EqualsDeep(*other.TransformElement) {
+		return false
+	}
+	if !equivalentList(len(r.DynamicValue), len(other.DynamicValue), func(i, j int) bool {
+		return r.DynamicValue[i].EqualsDeep(other.DynamicValue[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PlanDefinitionAction) EqualsShallow(other PlanDefinitionAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Prefix == nil) != (other.Prefix == nil) || r.Prefix != nil && !equivalentString(*r.Prefix, *other.Prefix) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.TextEquivalent == nil) != (other.TextEquivalent == nil) || r.TextEquivalent != nil && !equivalentString(*r.TextEquivalent, *other.TextEquivalent) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if !equivalentList(len(r.GoalId), len(other.GoalId), func(i, j int) bool {
+		return equivalentString(r.GoalId[i], other.GoalId[j])
+	}) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	if (r.GroupingBehavior == nil) != (other.GroupingBehavior == nil) || r.GroupingBehavior != nil && *r.GroupingBehavior != *other.GroupingBehavior {
+		return false
+	}
+	if (r.SelectionBehavior == nil) != (other.SelectionBehavior == nil) || r.SelectionBehavior != nil && *r.SelectionBehavior != *other.SelectionBehavior {
+		return false
+	}
+	if (r.RequiredBehavior == nil) != (other.RequiredBehavior == nil) || r.RequiredBehavior != nil && *r.RequiredBehavior != *other.RequiredBehavior {
+		return false
+	}
+	if (r.PrecheckBehavior == nil) != (other.PrecheckBehavior == nil) || r.PrecheckBehavior != nil && *r.PrecheckBehavior != *other.PrecheckBehavior {
+		return false
+	}
+	if (r.CardinalityBehavior == nil) != (other.CardinalityBehavior == nil) || r.CardinalityBehavior != nil && *r.CardinalityBehavior != *other.CardinalityBehavior {
+		return false
+	}
+	if (r.DefinitionCanonical == nil) != (other.DefinitionCanonical == nil) || r.DefinitionCanonical != nil && !equivalentString(*r.DefinitionCanonical, *other.DefinitionCanonical) {
+		return false
+	}
+	if (r.DefinitionUri == nil) != (other.DefinitionUri == nil) || r.DefinitionUri != nil && !equivalentString(*r.DefinitionUri, *other.DefinitionUri) {
+		return false
+	}
+	if (r.Transform == nil) != (other.Transform == nil) || r.Transform != nil && !equivalentString(*r.Transform, *other.Transform) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PlanDefinitionAction as FHIR XML
+func (r PlanDefinitionAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "prefix", r.Prefix, r.PrefixElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "textEquivalent", r.TextEquivalent, r.TextEquivalentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reason {
+		if err := encodeXMLElement(e, "reason", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Documentation {
+		if err := encodeXMLElement(e, "documentation", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.GoalId {
+		if err := encodeXMLPrimitive(e, "goalId", v, elementAt(r.GoalIdElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "subjectCodeableConcept", r.SubjectCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectReference", r.SubjectReference); err != nil {
+		return err
+	}
+	for _, v := range r.Trigger {
+		if err := encodeXMLElement(e, "trigger", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Condition {
+		if err := encodeXMLElement(e, "condition", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Input {
+		if err := encodeXMLElement(e, "input", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Output {
+		if err := encodeXMLElement(e, "output", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedAction {
+		if err := encodeXMLElement(e, "relatedAction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "timingDateTime", r.TimingDateTime, r.TimingDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingAge", r.TimingAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingPeriod", r.TimingPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingDuration", r.TimingDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingRange", r.TimingRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingTiming", r.TimingTiming); err != nil {
+		return err
+	}
+	for _, v := range r.Participant {
+		if err := encodeXMLElement(e, "participant", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "groupingBehavior", r.GroupingBehavior, r.GroupingBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "selectionBehavior", r.SelectionBehavior, r.SelectionBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "requiredBehavior", r.RequiredBehavior, r.RequiredBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "precheckBehavior", r.PrecheckBehavior, r.PrecheckBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "cardinalityBehavior", r.CardinalityBehavior, r.CardinalityBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definitionCanonical", r.DefinitionCanonical, r.DefinitionCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definitionUri", r.DefinitionUri, r.DefinitionUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "transform", r.Transform, r.TransformElement); err != nil {
+		return err
+	}
+	for _, v := range r.DynamicValue {
+		if err := encodeXMLElement(e, "dynamicValue", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PlanDefinitionAction from FHIR XML
+func (r *PlanDefinitionAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "prefix":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Prefix = &v
+				}
+				r.PrefixElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "textEquivalent":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TextEquivalent = &v
+				}
+				r.TextEquivalentElement = element
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "reason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = append(r.Reason, v)
+			case "documentation":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Documentation = append(r.Documentation, v)
+			case "goalId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.GoalIdElement = appendElement(r.GoalIdElement, len(r.GoalId), element)
+					r.GoalId = append(r.GoalId, v)
+				}
+			case "subjectCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectCodeableConcept = &v
+			case "subjectReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectReference = &v
+			case "trigger":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Trigger = append(r.Trigger, v)
+			case "condition":
+				var v PlanDefinitionActionCondition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Condition = append(r.Condition, v)
+			case "input":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Input = append(r.Input, v)
+			case "output":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Output = append(r.Output, v)
+			case "relatedAction":
+				var v PlanDefinitionActionRelatedAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedAction = append(r.RelatedAction, v)
+			case "timingDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimingDateTime = &v
+				}
+				r.TimingDateTimeElement = element
+			case "timingAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingAge = &v
+			case "timingPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingPeriod = &v
+			case "timingDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingDuration = &v
+			case "timingRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingRange = &v
+			case "timingTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingTiming = &v
+			case "participant":
+				var v PlanDefinitionActionParticipant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Participant = append(r.Participant, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "groupingBehavior":
+				var v ActionGroupingBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.GroupingBehavior = &v
+				}
+				r.GroupingBehaviorElement = element
+			case "selectionBehavior":
+				var v ActionSelectionBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SelectionBehavior = &v
+				}
+				r.SelectionBehaviorElement = element
+			case "requiredBehavior":
+				var v ActionRequiredBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RequiredBehavior = &v
+				}
+				r.RequiredBehaviorElement = element
+			case "precheckBehavior":
+				var v ActionPrecheckBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PrecheckBehavior = &v
+				}
+				r.PrecheckBehaviorElement = element
+			case "cardinalityBehavior":
+				var v ActionCardinalityBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CardinalityBehavior = &v
+				}
+				r.CardinalityBehaviorElement = element
+			case "definitionCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefinitionCanonical = &v
+				}
+				r.DefinitionCanonicalElement = element
+			case "definitionUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefinitionUri = &v
+				}
+				r.DefinitionUriElement = element
+			case "transform":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Transform = &v
+				}
+				r.TransformElement = element
+			case "dynamicValue":
+				var v PlanDefinitionActionDynamicValue
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DynamicValue = append(r.DynamicValue, v)
+			case "action":
+				var v PlanDefinitionAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PlanDefinitionAction as properties to the RDF node n
+func (r PlanDefinitionAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("PlanDefinition.action.prefix", -1, r.Prefix, r.PrefixElement, "string")
+	n.primitive("PlanDefinition.action.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("PlanDefinition.action.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("PlanDefinition.action.textEquivalent", -1, r.TextEquivalent, r.TextEquivalentElement, "string")
+	n.primitive("PlanDefinition.action.priority", -1, r.Priority, r.PriorityElement, "code")
+	for i, v := range r.Code {
+		n.element("PlanDefinition.action.code", i, v)
+	}
+	for i, v := range r.Reason {
+		n.element("PlanDefinition.action.reason", i, v)
+	}
+	for i, v := range r.Documentation {
+		n.element("PlanDefinition.action.documentation", i, v)
+	}
+	for i, v := range r.GoalId {
+		n.primitive("PlanDefinition.action.goalId", i, v, elementAt(r.GoalIdElement, i), "string")
+	}
+	n.element("PlanDefinition.action.subjectCodeableConcept", -1, r.SubjectCodeableConcept)
+	n.element("PlanDefinition.action.subjectReference", -1, r.SubjectReference)
+	for i, v := range r.Trigger {
+		n.element("PlanDefinition.action.trigger", i, v)
+	}
+	for i, v := range r.Condition {
+		n.element("PlanDefinition.action.condition", i, v)
+	}
+	for i, v := range r.Input {
+		n.element("PlanDefinition.action.input", i, v)
+	}
+	for i, v := range r.Output {
+		n.element("PlanDefinition.action.output", i, v)
+	}
+	for i, v := range r.RelatedAction {
+		n.element("PlanDefinition.action.relatedAction", i, v)
+	}
+	n.primitive("PlanDefinition.action.timingDateTime", -1, r.TimingDateTime, r.TimingDateTimeElement, "dateTime")
+	n.element("PlanDefinition.action.timingAge", -1, r.TimingAge)
+	n.element("PlanDefinition.action.timingPeriod", -1, r.TimingPeriod)
+	n.element("PlanDefinition.action.timingDuration", -1, r.TimingDuration)
+	n.element("PlanDefinition.action.timingRange", -1, r.TimingRange)
+	n.element("PlanDefinition.action.timingTiming", -1, r.TimingTiming)
+	for i, v := range r.Participant {
+		n.element("PlanDefinition.action.participant", i, v)
+	}
+	n.element("PlanDefinition.action.type", -1, r.Type)
+	n.primitive("PlanDefinition.action.groupingBehavior", -1, r.GroupingBehavior, r.GroupingBehaviorElement, "code")
+	n.primitive("PlanDefinition.action.selectionBehavior", -1, r.SelectionBehavior, r.SelectionBehaviorElement, "code")
+	n.primitive("PlanDefinition.action.requiredBehavior", -1, r.RequiredBehavior, r.RequiredBehaviorElement, "code")
+	n.primitive("PlanDefinition.action.precheckBehavior", -1, r.PrecheckBehavior, r.PrecheckBehaviorElement, "code")
+	n.primitive("PlanDefinition.action.cardinalityBehavior", -1, r.CardinalityBehavior, r.CardinalityBehaviorElement, "code")
+	n.primitive("PlanDefinition.action.definitionCanonical", -1, r.DefinitionCanonical, r.DefinitionCanonicalElement, "canonical")
+	n.primitive("PlanDefinition.action.definitionUri", -1, r.DefinitionUri, r.DefinitionUriElement, "uri")
+	n.primitive("PlanDefinition.action.transform", -1, r.Transform, r.TransformElement, "string")
+	for i, v := range r.DynamicValue {
+		n.element("PlanDefinition.action.dynamicValue", i, v)
+	}
+	for i, v := range r.Action {
+		n.element("PlanDefinition.action.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given PlanDefinitionAction as BSON document with the structure of its FHIR JSON
+func (r PlanDefinitionAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PlanDefinitionAction from a BSON document with the structure of its FHIR JSON
+func (r *PlanDefinitionAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PlanDefinitionAction as protobuf message PlanDefinitionAction of fhir.proto
+func (r PlanDefinitionAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PlanDefinitionAction to the encoded message
+func (r PlanDefinitionAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Prefix != nil {
+		e.string(4, *r.Prefix)
+	}
+	if r.Title != nil {
+		e.string(5, *r.Title)
+	}
+	if r.Description != nil {
+		e.string(6, *r.Description)
+	}
+	if r.TextEquivalent != nil {
+		e.string(7, *r.TextEquivalent)
+	}
+	if r.Priority != nil {
+		e.varint(8, r.Priority.protoNumber())
+	}
+	for _, v := range r.Code {
+		e.message(9, v)
+	}
+	for _, v := range r.Reason {
+		e.message(10, v)
+	}
+	for _, v := range r.Documentation {
+		e.message(11, v)
+	}
+	for _, v := range r.GoalId {
+		e.string(12, v)
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.message(13, *r.SubjectCodeableConcept)
+	}
+	if r.SubjectReference != nil {
+		e.message(14, *r.SubjectReference)
+	}
+	for _, v := range r.Trigger {
+		e.message(15, v)
+	}
+	for _, v := range r.Condition {
+		e.message(16, v)
+	}
+	for _, v := range r.Input {
+		e.message(17, v)
+	}
+	for _, v := range r.Output {
+		e.message(18, v)
+	}
+	for _, v := range r.RelatedAction {
+		e.message(19, v)
+	}
+	if r.TimingDateTime != nil {
+		e.string(20, *r.TimingDateTime)
+	}
+	if r.TimingAge != nil {
+		e.message(21, *r.TimingAge)
+	}
+	if r.TimingPeriod != nil {
+		e.message(22, *r.TimingPeriod)
+	}
+	if r.TimingDuration != nil {
+		e.message(23, *r.TimingDuration)
+	}
+	if r.TimingRange != nil {
+		e.message(24, *r.TimingRange)
+	}
+	if r.TimingTiming != nil {
+		e.message(25, *r.TimingTiming)
+	}
+	for _, v := range r.Participant {
+		e.message(26, v)
+	}
+	if r.Type != nil {
+		e.message(27, *r.Type)
+	}
+	if r.GroupingBehavior != nil {
+		e.varint(28, r.GroupingBehavior.protoNumber())
+	}
+	if r.SelectionBehavior != nil {
+		e.varint(29, r.SelectionBehavior.protoNumber())
+	}
+	if r.RequiredBehavior != nil {
+		e.varint(30, r.RequiredBehavior.protoNumber())
+	}
+	if r.PrecheckBehavior != nil {
+		e.varint(31, r.PrecheckBehavior.protoNumber())
+	}
+	if r.CardinalityBehavior != nil {
+		e.varint(32, r.CardinalityBehavior.protoNumber())
+	}
+	if r.DefinitionCanonical != nil {
+		e.string(33, *r.DefinitionCanonical)
+	}
+	if r.DefinitionUri != nil {
+		e.string(34, *r.DefinitionUri)
+	}
+	if r.Transform != nil {
+		e.string(35, *r.Transform)
+	}
+	for _, v := range r.DynamicValue {
+		e.message(36, v)
+	}
+	for _, v := range r.Action {
+		e.message(37, v)
+	}
+	if r.PrefixElement != nil {
+		e.message(38, *r.PrefixElement)
+	}
+	if r.TitleElement != nil {
+		e.message(39, *r.TitleElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(40, *r.DescriptionElement)
+	}
+	if r.TextEquivalentElement != nil {
+		e.message(41, *r.TextEquivalentElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(42, *r.PriorityElement)
+	}
+	for _, v := range r.GoalIdElement {
+		e.element(43, v)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.message(44, *r.TimingDateTimeElement)
+	}
+	if r.GroupingBehaviorElement != nil {
+		e.message(45, *r.GroupingBehaviorElement)
+	}
+	if r.SelectionBehaviorElement != nil {
+		e.message(46, *r.SelectionBehaviorElement)
+	}
+	if r.RequiredBehaviorElement != nil {
+		e.message(47, *r.RequiredBehaviorElement)
+	}
+	if r.PrecheckBehaviorElement != nil {
+		e.message(48, *r.PrecheckBehaviorElement)
+	}
+	if r.CardinalityBehaviorElement != nil {
+		e.message(49, *r.CardinalityBehaviorElement)
+	}
+	if r.DefinitionCanonicalElement != nil {
+		e.message(50, *r.DefinitionCanonicalElement)
+	}
+	if r.DefinitionUriElement != nil {
+		e.message(51, *r.DefinitionUriElement)
+	}
+	if r.TransformElement != nil {
+		e.message(52, *r.TransformElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PlanDefinitionAction from protobuf message PlanDefinitionAction of fhir.proto
+func (r *PlanDefinitionAction) UnmarshalProto(b []byte) error {
+	*r = PlanDefinitionAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Prefix = &v
+		case 5:
+			v := d.string()
+			r.Title = &v
+		case 6:
+			v := d.string()
+			r.Description = &v
+		case 7:
+			v := d.string()
+			r.TextEquivalent = &v
+		case 8:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Reason = append(r.Reason, v)
+		case 11:
+			var v RelatedArtifact
+			d.message(&v)
+			r.Documentation = append(r.Documentation, v)
+		case 12:
+			r.GoalId = append(r.GoalId, d.string())
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubjectCodeableConcept = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.SubjectReference = &v
+		case 15:
+			var v TriggerDefinition
+			d.message(&v)
+			r.Trigger = append(r.Trigger, v)
+		case 16:
+			var v PlanDefinitionActionCondition
+			d.message(&v)
+			r.Condition = append(r.Condition, v)
+		case 17:
+			var v DataRequirement
+			d.message(&v)
+			r.Input = append(r.Input, v)
+		case 18:
+			var v DataRequirement
+			d.message(&v)
+			r.Output = append(r.Output, v)
+		case 19:
+			var v PlanDefinitionActionRelatedAction
+			d.message(&v)
+			r.RelatedAction = append(r.RelatedAction, v)
+		case 20:
+			v := d.string()
+			r.TimingDateTime = &v
+		case 21:
+			var v Age
+			d.message(&v)
+			r.TimingAge = &v
+		case 22:
+			var v Period
+			d.message(&v)
+			r.TimingPeriod = &v
+		case 23:
+			var v Duration
+			d.message(&v)
+			r.TimingDuration = &v
+		case 24:
+			var v Range
+			d.message(&v)
+			r.TimingRange = &v
+		case 25:
+			var v Timing
+			d.message(&v)
+			r.TimingTiming = &v
+		case 26:
+			var v PlanDefinitionActionParticipant
+			d.message(&v)
+			r.Participant = append(r.Participant, v)
+		case 27:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 28:
+			var v ActionGroupingBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.GroupingBehavior = &v
+		case 29:
+			var v ActionSelectionBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.SelectionBehavior = &v
+		case 30:
+			var v ActionRequiredBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.RequiredBehavior = &v
+		case 31:
+			var v ActionPrecheckBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.PrecheckBehavior = &v
+		case 32:
+			var v ActionCardinalityBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.CardinalityBehavior = &v
+		case 33:
+			v := d.string()
+			r.DefinitionCanonical = &v
+		case 34:
+			v := d.string()
+			r.DefinitionUri = &v
+		case 35:
+			v := d.string()
+			r.Transform = &v
+		case 36:
+			var v PlanDefinitionActionDynamicValue
+			d.message(&v)
+			r.DynamicValue = append(r.DynamicValue, v)
+		case 37:
+			var v PlanDefinitionAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		case 38:
+			var v Element
+			d.message(&v)
+			r.PrefixElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.TextEquivalentElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 43:
+			r.GoalIdElement = append(r.GoalIdElement, d.element())
+		case 44:
+			var v Element
+			d.message(&v)
+			r.TimingDateTimeElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.GroupingBehaviorElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.SelectionBehaviorElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.RequiredBehaviorElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.PrecheckBehaviorElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.CardinalityBehaviorElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.DefinitionCanonicalElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.DefinitionUriElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.TransformElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PlanDefinitionActionCondition as JSON into a byte slice
+func (r PlanDefinitionActionCondition) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PlanDefinitionActionCondition as JSON object with the members in the order of the fields
+func (r PlanDefinitionActionCondition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("kind")
+	e.string(r.Kind.Code())
+	if r.KindElement != nil {
+		e.key("_kind")
+		r.KindElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		r.Expression.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PlanDefinitionActionCondition from JSON. Unknown members are ignored.
+func (r *PlanDefinitionActionCondition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PlanDefinitionActionCondition
+func (r *PlanDefinitionActionCondition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "kind":
+			d.unmarshal(&r.Kind)
+		case "_kind":
+			r.KindElement = d.element()
+		case "expression":
+			if d.null() {
+				r.Expression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.Expression = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PlanDefinitionActionCondition which shares no memory with the original
+func (r PlanDefinitionActionCondition) DeepCopy() PlanDefinitionActionCondition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.KindElement != nil {
+		v := r.KindElement.DeepCopy()
+		out.KindElement = &v
+	}
+	if r.Expression != nil {
+		v := r.Expression.DeepCopy()
+		out.Expression = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PlanDefinitionActionCondition) Equal(other PlanDefinitionActionCondition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.Equal(*other.KindElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !r.Expression.Equal(*other.Expression) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PlanDefinitionActionCondition) EqualsDeep(other PlanDefinitionActionCondition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.EqualsDeep(*other.KindElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !r.Expression.EqualsDeep(*other.Expression) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PlanDefinitionActionCondition) EqualsShallow(other PlanDefinitionActionCondition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PlanDefinitionActionCondition as FHIR XML
+func (r PlanDefinitionActionCondition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "kind", r.Kind, r.KindElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "expression", r.Expression); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PlanDefinitionActionCondition from FHIR XML
+func (r *PlanDefinitionActionCondition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "kind":
+				var v ActionConditionKind
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Kind = v
+				}
+				r.KindElement = element
+			case "expression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Expression = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PlanDefinitionActionCondition as properties to the RDF node n
+func (r PlanDefinitionActionCondition) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("PlanDefinition.action.condition.kind", -1, r.Kind, r.KindElement, "code")
+	n.element("PlanDefinition.action.condition.expression", -1, r.Expression)
+}
+
+// MarshalBSON marshals the given PlanDefinitionActionCondition as BSON document with the structure of its FHIR JSON
+func (r PlanDefinitionActionCondition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PlanDefinitionActionCondition from a BSON document with the structure of its FHIR JSON
+func (r *PlanDefinitionActionCondition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PlanDefinitionActionCondition as protobuf message PlanDefinitionActionCondition of fhir.proto
+func (r PlanDefinitionActionCondition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PlanDefinitionActionCondition to the encoded message
+func (r PlanDefinitionActionCondition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Kind.protoNumber())
+	if r.Expression != nil {
+		e.message(5, *r.Expression)
+	}
+	if r.KindElement != nil {
+		e.message(6, *r.KindElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PlanDefinitionActionCondition from protobuf message PlanDefinitionActionCondition of fhir.proto
+func (r *PlanDefinitionActionCondition) UnmarshalProto(b []byte) error {
+	*r = PlanDefinitionActionCondition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ActionConditionKind
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Kind = v
+		case 5:
+			var v Expression
+			d.message(&v)
+			r.Expression = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.KindElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PlanDefinitionActionRelatedAction as JSON into a byte slice
+func (r PlanDefinitionActionRelatedAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PlanDefinitionActionRelatedAction as JSON object with the members in the order of the fields
+func (r PlanDefinitionActionRelatedAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("actionId")
+	e.string(r.ActionId)
+	if r.ActionIdElement != nil {
+		e.key("_actionId")
+		r.ActionIdElement.appendJSON(e)
+	}
+	e.key("relationship")
+	e.string(r.Relationship.Code())
+	if r.RelationshipElement != nil {
+		e.key("_relationship")
+		r.RelationshipElement.appendJSON(e)
+	}
+	if r.OffsetDuration != nil {
+		e.key("offsetDuration")
+		r.OffsetDuration.appendJSON(e)
+	}
+	if r.OffsetRange != nil {
+		e.key("offsetRange")
+		r.OffsetRange.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PlanDefinitionActionRelatedAction from JSON. Unknown members are ignored.
+func (r *PlanDefinitionActionRelatedAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PlanDefinitionActionRelatedAction
+func (r *PlanDefinitionActionRelatedAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "actionId":
+			if v, ok := d.string(); ok {
+				r.ActionId = v
+			}
+		case "_actionId":
+			r.ActionIdElement = d.element()
+		case "relationship":
+			d.unmarshal(&r.Relationship)
+		case "_relationship":
+			r.RelationshipElement = d.element()
+		case "offsetDuration":
+			if d.null() {
+				r.OffsetDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.OffsetDuration = &v
+			}
+		case "offsetRange":
+			if d.null() {
+				r.OffsetRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.OffsetRange = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PlanDefinitionActionRelatedAction which shares no memory with the original
+func (r PlanDefinitionActionRelatedAction) DeepCopy() PlanDefinitionActionRelatedAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ActionIdElement != nil {
+		v := r.ActionIdElement.DeepCopy()
+		out.ActionIdElement = &v
+	}
+	if r.RelationshipElement != nil {
+		v := r.RelationshipElement.DeepCopy()
+		out.RelationshipElement = &v
+	}
+	if r.OffsetDuration != nil {
+		v := r.OffsetDuration.DeepCopy()
+		out.OffsetDuration = &v
+	}
+	if r.OffsetRange != nil {
+		v := r.OffsetRange.DeepCopy()
+		out.OffsetRange = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PlanDefinitionActionRelatedAction) Equal(other PlanDefinitionActionRelatedAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.ActionId != other.ActionId {
+		return false
+	}
+	if (r.ActionIdElement == nil) != (other.ActionIdElement == nil) || r.ActionIdElement != nil && !r.ActionIdElement.Equal(*other.ActionIdElement) {
+		return false
+	}
+	if r.Relationship != other.Relationship {
+		return false
+	}
+	if (r.RelationshipElement == nil) != (other.RelationshipElement == nil) || r.RelationshipElement != nil && !r.RelationshipElement.Equal(*other.RelationshipElement) {
+		return false
+	}
+	if (r.OffsetDuration == nil) != (other.OffsetDuration == nil) || r.OffsetDuration != nil && !r.OffsetDuration.Equal(*other.OffsetDuration) {
+		return false
+	}
+	if (r.OffsetRange == nil) != (other.OffsetRange == nil) || r.OffsetRange != nil && !r.OffsetRange.Equal(*other.OffsetRange) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PlanDefinitionActionRelatedAction) EqualsDeep(other PlanDefinitionActionRelatedAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.ActionId, other.ActionId) {
+		return false
+	}
+	if (r.ActionIdElement == nil) != (other.ActionIdElement == nil) || r.ActionIdElement != nil && !r.ActionIdElement.EqualsDeep(*other.ActionIdElement) {
+		return false
+	}
+	if r.Relationship != other.Relationship {
+		return false
+	}
+	if (r.RelationshipElement == nil) != (other.RelationshipElement == nil) || r.RelationshipElement != nil && !r.RelationshipElement.EqualsDeep(*other.RelationshipElement) {
+		return false
+	}
+	if (r.OffsetDuration == nil) != (other.OffsetDuration == nil) || r.OffsetDuration != nil && !r.OffsetDuration.EqualsDeep(*other.OffsetDuration) {
+		return false
+	}
+	if (r.OffsetRange == nil) != (other.OffsetRange == nil) || r.OffsetRange != nil && !r.OffsetRange.EqualsDeep(*other.OffsetRange) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PlanDefinitionActionRelatedAction) EqualsShallow(other PlanDefinitionActionRelatedAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.ActionId, other.ActionId) {
+		return false
+	}
+	if r.Relationship != other.Relationship {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PlanDefinitionActionRelatedAction as FHIR XML
+func (r PlanDefinitionActionRelatedAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "actionId", r.ActionId, r.ActionIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "relationship", r.Relationship, r.RelationshipElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "offsetDuration", r.OffsetDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "offsetRange", r.OffsetRange); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PlanDefinitionActionRelatedAction from FHIR XML
+func (r *PlanDefinitionActionRelatedAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "actionId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ActionId = v
+				}
+				r.ActionIdElement = element
+			case "relationship":
+				var v ActionRelationshipType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Relationship = v
+				}
+				r.RelationshipElement = element
+			case "offsetDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OffsetDuration = &v
+			case "offsetRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OffsetRange = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PlanDefinitionActionRelatedAction as properties to the RDF node n
+func (r PlanDefinitionActionRelatedAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("PlanDefinition.action.relatedAction.actionId", -1, r.ActionId, r.ActionIdElement, "string")
+	n.primitive("PlanDefinition.action.relatedAction.relationship", -1, r.Relationship, r.RelationshipElement, "code")
+	n.element("PlanDefinition.action.relatedAction.offsetDuration", -1, r.OffsetDuration)
+	n.element("PlanDefinition.action.relatedAction.offsetRange", -1, r.OffsetRange)
+}
+
+// MarshalBSON marshals the given PlanDefinitionActionRelatedAction as BSON document with the structure of its FHIR JSON
+func (r PlanDefinitionActionRelatedAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PlanDefinitionActionRelatedAction from a BSON document with the structure of its FHIR JSON
+func (r *PlanDefinitionActionRelatedAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PlanDefinitionActionRelatedAction as protobuf message PlanDefinitionActionRelatedAction of fhir.proto
+func (r PlanDefinitionActionRelatedAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PlanDefinitionActionRelatedAction to the encoded message
+func (r PlanDefinitionActionRelatedAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ActionId != "" {
+		e.string(4, r.ActionId)
+	}
+	e.varint(5, r.Relationship.protoNumber())
+	if r.OffsetDuration != nil {
+		e.message(6, *r.OffsetDuration)
+	}
+	if r.OffsetRange != nil {
+		e.message(7, *r.OffsetRange)
+	}
+	if r.ActionIdElement != nil {
+		e.message(8, *r.ActionIdElement)
+	}
+	if r.RelationshipElement != nil {
+		e.message(9, *r.RelationshipElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PlanDefinitionActionRelatedAction from protobuf message PlanDefinitionActionRelatedAction of fhir.proto
+func (r *PlanDefinitionActionRelatedAction) UnmarshalProto(b []byte) error {
+	*r = PlanDefinitionActionRelatedAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.ActionId = d.string()
+		case 5:
+			var v ActionRelationshipType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Relationship = v
+		case 6:
+			var v Duration
+			d.message(&v)
+			r.OffsetDuration = &v
+		case 7:
+			var v Range
+			d.message(&v)
+			r.OffsetRange = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ActionIdElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.RelationshipElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PlanDefinitionActionParticipant as JSON into a byte slice
+func (r PlanDefinitionActionParticipant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PlanDefinitionActionParticipant as JSON object with the members in the order of the fields
+func (r PlanDefinitionActionParticipant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Role != nil {
+		e.key("role")
+		r.Role.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PlanDefinitionActionParticipant from JSON. Unknown members are ignored.
+func (r *PlanDefinitionActionParticipant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PlanDefinitionActionParticipant
+func (r *PlanDefinitionActionParticipant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "role":
+			if d.null() {
+				r.Role = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PlanDefinitionActionParticipant which shares no memory with the original
+func (r PlanDefinitionActionParticipant) DeepCopy() PlanDefinitionActionParticipant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Role != nil {
+		v := r.Role.DeepCopy()
+		out.Role = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PlanDefinitionActionParticipant) Equal(other PlanDefinitionActionParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.Equal(*other.Role) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PlanDefinitionActionParticipant) EqualsDeep(other PlanDefinitionActionParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.EqualsDeep(*other.Role) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PlanDefinitionActionParticipant) EqualsShallow(other PlanDefinitionActionParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PlanDefinitionActionParticipant as FHIR XML
+func (r PlanDefinitionActionParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PlanDefinitionActionParticipant from FHIR XML
+func (r *PlanDefinitionActionParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v ActionParticipantType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PlanDefinitionActionParticipant as properties to the RDF node n
+func (r PlanDefinitionActionParticipant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("PlanDefinition.action.participant.type", -1, r.Type, r.TypeElement, "code")
+	n.element("PlanDefinition.action.participant.role", -1, r.Role)
+}
+
+// MarshalBSON marshals the given PlanDefinitionActionParticipant as BSON document with the structure of its FHIR JSON
+func (r PlanDefinitionActionParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PlanDefinitionActionParticipant from a BSON document with the structure of its FHIR JSON
+func (r *PlanDefinitionActionParticipant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PlanDefinitionActionParticipant as protobuf message PlanDefinitionActionParticipant of fhir.proto
+func (r PlanDefinitionActionParticipant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PlanDefinitionActionParticipant to the encoded message
+func (r PlanDefinitionActionParticipant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Role != nil {
+		e.message(5, *r.Role)
+	}
+	if r.TypeElement != nil {
+		e.message(6, *r.TypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PlanDefinitionActionParticipant from protobuf message PlanDefinitionActionParticipant of fhir.proto
+func (r *PlanDefinitionActionParticipant) UnmarshalProto(b []byte) error {
+	*r = PlanDefinitionActionParticipant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ActionParticipantType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PlanDefinitionActionDynamicValue as JSON into a byte slice
+func (r PlanDefinitionActionDynamicValue) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PlanDefinitionActionDynamicValue as JSON object with the members in the order of the fields
+func (r PlanDefinitionActionDynamicValue) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Path != nil {
+		e.key("path")
+		e.string(*r.Path)
+	}
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		r.Expression.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PlanDefinitionActionDynamicValue from JSON. Unknown members are ignored.
+func (r *PlanDefinitionActionDynamicValue) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PlanDefinitionActionDynamicValue
+func (r *PlanDefinitionActionDynamicValue) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = &v
+			} else {
+				r.Path = nil
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "expression":
+			if d.null() {
+				r.Expression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.Expression = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PlanDefinitionActionDynamicValue which shares no memory with the original
+func (r PlanDefinitionActionDynamicValue) DeepCopy() PlanDefinitionActionDynamicValue {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Path != nil {
+		v := *r.Path
+		out.Path = &v
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	if r.Expression != nil {
+		v := r.Expression.DeepCopy()
+		out.Expression = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PlanDefinitionActionDynamicValue) Equal(other PlanDefinitionActionDynamicValue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && *r.Path != *other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !r.Expression.Equal(*other.Expression) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PlanDefinitionActionDynamicValue) EqualsDeep(other PlanDefinitionActionDynamicValue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !r.Expression.EqualsDeep(*other.Expression) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PlanDefinitionActionDynamicValue) EqualsShallow(other PlanDefinitionActionDynamicValue) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PlanDefinitionActionDynamicValue as FHIR XML
+func (r PlanDefinitionActionDynamicValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "expression", r.Expression); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PlanDefinitionActionDynamicValue from FHIR XML
+func (r *PlanDefinitionActionDynamicValue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = &v
+				}
+				r.PathElement = element
+			case "expression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Expression = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PlanDefinitionActionDynamicValue as properties to the RDF node n
+func (r PlanDefinitionActionDynamicValue) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("PlanDefinition.action.dynamicValue.path", -1, r.Path, r.PathElement, "string")
+	n.element("PlanDefinition.action.dynamicValue.expression", -1, r.Expression)
+}
+
+// MarshalBSON marshals the given PlanDefinitionActionDynamicValue as BSON document with the structure of its FHIR JSON
+func (r PlanDefinitionActionDynamicValue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PlanDefinitionActionDynamicValue from a BSON document with the structure of its FHIR JSON
+func (r *PlanDefinitionActionDynamicValue) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PlanDefinitionActionDynamicValue as protobuf message PlanDefinitionActionDynamicValue of fhir.proto
+func (r PlanDefinitionActionDynamicValue) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PlanDefinitionActionDynamicValue to the encoded message
+func (r PlanDefinitionActionDynamicValue) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Path != nil {
+		e.string(4, *r.Path)
+	}
+	if r.Expression != nil {
+		e.message(5, *r.Expression)
+	}
+	if r.PathElement != nil {
+		e.message(6, *r.PathElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PlanDefinitionActionDynamicValue from protobuf message PlanDefinitionActionDynamicValue of fhir.proto
+func (r *PlanDefinitionActionDynamicValue) UnmarshalProto(b []byte) error {
+	*r = PlanDefinitionActionDynamicValue{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Path = &v
+		case 5:
+			var v Expression
+			d.message(&v)
+			r.Expression = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalPlanDefinition unmarshals a PlanDefinition.
 func UnmarshalPlanDefinition(b []byte) (PlanDefinition, error) {
 	var planDefinition PlanDefinition
-	if err := json.Unmarshal(b, &planDefinition); err != nil {
+	if err := planDefinition.UnmarshalJSON(b); err != nil {
 		return planDefinition, err
 	}
 	return planDefinition, nil
@@ -14,6 +14,8 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
@@ -28,3 +30,518 @@ type Population struct {
 	Race                   *CodeableConcept `bson:"race,omitempty" json:"race,omitempty"`
 	PhysiologicalCondition *CodeableConcept `bson:"physiologicalCondition,omitempty" json:"physiologicalCondition,omitempty"`
 }
+
+// PopulationAge is implemented by the types allowed for Population.age[x]
+type PopulationAge interface {
+	isPopulationAge()
+}
+
+func (Range) isPopulationAge()           {}
+func (CodeableConcept) isPopulationAge() {}
+
+// Age returns the value of Population.age[x] together with its FHIR type code or nil if no value is set
+func (r Population) Age() (PopulationAge, string) {
+	if r.AgeRange != nil {
+		return *r.AgeRange, "Range"
+	}
+	if r.AgeCodeableConcept != nil {
+		return *r.AgeCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetAge sets the value of Population.age[x], given as value or pointer, and clears all other types
+func (r *Population) SetAge(value PopulationAge) {
+	r.AgeRange = nil
+	r.AgeCodeableConcept = nil
+	switch v := value.(type) {
+	case Range:
+		r.AgeRange = &v
+	case *Range:
+		r.AgeRange = v
+	case CodeableConcept:
+		r.AgeCodeableConcept = &v
+	case *CodeableConcept:
+		r.AgeCodeableConcept = v
+	}
+}
+
+// MarshalJSON marshals the given Population as JSON into a byte slice
+func (r Population) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Population as JSON object with the members in the order of the fields
+func (r Population) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AgeRange != nil {
+		e.key("ageRange")
+		r.AgeRange.appendJSON(e)
+	}
+	if r.AgeCodeableConcept != nil {
+		e.key("ageCodeableConcept")
+		r.AgeCodeableConcept.appendJSON(e)
+	}
+	if r.Gender != nil {
+		e.key("gender")
+		r.Gender.appendJSON(e)
+	}
+	if r.Race != nil {
+		e.key("race")
+		r.Race.appendJSON(e)
+	}
+	if r.PhysiologicalCondition != nil {
+		e.key("physiologicalCondition")
+		r.PhysiologicalCondition.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Population from JSON. Unknown members are ignored.
+func (r *Population) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Population
+func (r *Population) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "ageRange":
+			if d.null() {
+				r.AgeRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.AgeRange = &v
+			}
+		case "ageCodeableConcept":
+			if d.null() {
+				r.AgeCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AgeCodeableConcept = &v
+			}
+		case "gender":
+			if d.null() {
+				r.Gender = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Gender = &v
+			}
+		case "race":
+			if d.null() {
+				r.Race = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Race = &v
+			}
+		case "physiologicalCondition":
+			if d.null() {
+				r.PhysiologicalCondition = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PhysiologicalCondition = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Population which shares no memory with the original
+func (r Population) DeepCopy() Population {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.AgeRange != nil {
+		v := r.AgeRange.DeepCopy()
+		out.AgeRange = &v
+	}
+	if r.AgeCodeableConcept != nil {
+		v := r.AgeCodeableConcept.DeepCopy()
+		out.AgeCodeableConcept = &v
+	}
+	if r.Gender != nil {
+		v := r.Gender.DeepCopy()
+		out.Gender = &v
+	}
+	if r.Race != nil {
+		v := r.Race.DeepCopy()
+		out.Race = &v
+	}
+	if r.PhysiologicalCondition != nil {
+		v := r.PhysiologicalCondition.DeepCopy()
+		out.PhysiologicalCondition = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Population) Equal(other Population) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.AgeRange == nil) != (other.AgeRange == nil) || r.AgeRange != nil && !r.AgeRange.Equal(*other.AgeRange) {
+		return false
+	}
+	if (r.AgeCodeableConcept == nil) != (other.AgeCodeableConcept == nil) || r.AgeCodeableConcept != nil && !r.AgeCodeableConcept.Equal(*other.AgeCodeableConcept) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && !r.Gender.Equal(*other.Gender) {
+		return false
+	}
+	if (r.Race == nil) != (other.Race == nil) || r.Race != nil && !r.Race.Equal(*other.Race) {
+		return false
+	}
+	if (r.PhysiologicalCondition == nil) != (other.PhysiologicalCondition == nil) || r.PhysiologicalCondition != nil && !r.PhysiologicalCondition.Equal(*other.PhysiologicalCondition) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Population) EqualsDeep(other Population) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.AgeRange == nil) != (other.AgeRange == nil) || r.AgeRange != nil && !r.AgeRange.EqualsDeep(*other.AgeRange) {
+		return false
+	}
+	if (r.AgeCodeableConcept == nil) != (other.AgeCodeableConcept == nil) || r.AgeCodeableConcept != nil && !r.AgeCodeableConcept.EqualsDeep(*other.AgeCodeableConcept) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && !r.Gender.EqualsDeep(*other.Gender) {
+		return false
+	}
+	if (r.Race == nil) != (other.Race == nil) || r.Race != nil && !r.Race.EqualsDeep(*other.Race) {
+		return false
+	}
+	if (r.PhysiologicalCondition == nil) != (other.PhysiologicalCondition == nil) || r.PhysiologicalCondition != nil && !r.PhysiologicalCondition.EqualsDeep(*other.PhysiologicalCondition) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Population) EqualsShallow(other Population) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Population as FHIR XML
+func (r Population) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "ageRange", r.AgeRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "ageCodeableConcept", r.AgeCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "gender", r.Gender); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "race", r.Race); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "physiologicalCondition", r.PhysiologicalCondition); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Population from FHIR XML
+func (r *Population) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "ageRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AgeRange = &v
+			case "ageCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AgeCodeableConcept = &v
+			case "gender":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Gender = &v
+			case "race":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Race = &v
+			case "physiologicalCondition":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PhysiologicalCondition = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Population as properties to the RDF node n
+func (r Population) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Population.ageRange", -1, r.AgeRange)
+	n.element("Population.ageCodeableConcept", -1, r.AgeCodeableConcept)
+	n.element("Population.gender", -1, r.Gender)
+	n.element("Population.race", -1, r.Race)
+	n.element("Population.physiologicalCondition", -1, r.PhysiologicalCondition)
+}
+
+// MarshalBSON marshals the given Population as BSON document with the structure of its FHIR JSON
+func (r Population) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Population from a BSON document with the structure of its FHIR JSON
+func (r *Population) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Population as protobuf message Population of fhir.proto
+func (r Population) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Population to the encoded message
+func (r Population) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.AgeRange != nil {
+		e.message(4, *r.AgeRange)
+	}
+	if r.AgeCodeableConcept != nil {
+		e.message(5, *r.AgeCodeableConcept)
+	}
+	if r.Gender != nil {
+		e.message(6, *r.Gender)
+	}
+	if r.Race != nil {
+		e.message(7, *r.Race)
+	}
+	if r.PhysiologicalCondition != nil {
+		e.message(8, *r.PhysiologicalCondition)
+	}
+}
+
+// UnmarshalProto unmarshals the given Population from protobuf message Population of fhir.proto
+func (r *Population) UnmarshalProto(b []byte) error {
+	*r = Population{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Range
+			d.message(&v)
+			r.AgeRange = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.AgeCodeableConcept = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Gender = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Race = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.PhysiologicalCondition = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
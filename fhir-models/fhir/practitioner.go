@@ -14,30 +14,40 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Practitioner is documented here http://hl7.org/fhir/StructureDefinition/Practitioner
 type Practitioner struct {
-	Id                *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                     `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool                       `bson:"active,omitempty" json:"active,omitempty"`
-	Name              []HumanName                 `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom           []ContactPoint              `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address           []Address                   `bson:"address,omitempty" json:"address,omitempty"`
-	Gender            *AdministrativeGender       `bson:"gender,omitempty" json:"gender,omitempty"`
-	BirthDate         *string                     `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
-	Photo             []Attachment                `bson:"photo,omitempty" json:"photo,omitempty"`
-	Qualification     []PractitionerQualification `bson:"qualification,omitempty" json:"qualification,omitempty"`
-	Communication     []CodeableConcept           `bson:"communication,omitempty" json:"communication,omitempty"`
+	Id                   *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                    `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool                       `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                    `bson:"_active,omitempty" json:"_active,omitempty"`
+	Name                 []HumanName                 `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom              []ContactPoint              `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address              []Address                   `bson:"address,omitempty" json:"address,omitempty"`
+	Gender               *AdministrativeGender       `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement        *Element                    `bson:"_gender,omitempty" json:"_gender,omitempty"`
+	BirthDate            *string                     `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	BirthDateElement     *Element                    `bson:"_birthDate,omitempty" json:"_birthDate,omitempty"`
+	Photo                []Attachment                `bson:"photo,omitempty" json:"photo,omitempty"`
+	Qualification        []PractitionerQualification `bson:"qualification,omitempty" json:"qualification,omitempty"`
+	Communication        []CodeableConcept           `bson:"communication,omitempty" json:"communication,omitempty"`
 }
 type PractitionerQualification struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
@@ -48,23 +58,1661 @@ type PractitionerQualification struct {
 	Period            *Period         `bson:"period,omitempty" json:"period,omitempty"`
 	Issuer            *Reference      `bson:"issuer,omitempty" json:"issuer,omitempty"`
 }
-type OtherPractitioner Practitioner
 
 // MarshalJSON marshals the given Practitioner as JSON into a byte slice
 func (r Practitioner) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherPractitioner
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherPractitioner: OtherPractitioner(r),
-		ResourceType:      "Practitioner",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Practitioner as JSON object with the members in the order of the fields
+func (r Practitioner) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Practitioner\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Active != nil {
+		e.key("active")
+		e.bool(*r.Active)
+	}
+	if r.ActiveElement != nil {
+		e.key("_active")
+		r.ActiveElement.appendJSON(e)
+	}
+	if len(r.Name) > 0 {
+		e.key("name")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Name {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Address) > 0 {
+		e.key("address")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Address {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Gender != nil {
+		e.key("gender")
+		e.string(r.Gender.Code())
+	}
+	if r.GenderElement != nil {
+		e.key("_gender")
+		r.GenderElement.appendJSON(e)
+	}
+	if r.BirthDate != nil {
+		e.key("birthDate")
+		e.string(*r.BirthDate)
+	}
+	if r.BirthDateElement != nil {
+		e.key("_birthDate")
+		r.BirthDateElement.appendJSON(e)
+	}
+	if len(r.Photo) > 0 {
+		e.key("photo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Photo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Qualification) > 0 {
+		e.key("qualification")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Qualification {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Communication) > 0 {
+		e.key("communication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Communication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Practitioner from JSON. Unknown members are ignored.
+func (r *Practitioner) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Practitioner
+func (r *Practitioner) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "active":
+			if v, ok := d.bool(); ok {
+				r.Active = &v
+			} else {
+				r.Active = nil
+			}
+		case "_active":
+			r.ActiveElement = d.element()
+		case "name":
+			a := d.array()
+			r.Name = nil
+			for a.next() {
+				var v HumanName
+				v.decodeJSON(d)
+				r.Name = append(r.Name, v)
+			}
+			if r.Name == nil && !a.isNull {
+				r.Name = []HumanName{}
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "address":
+			a := d.array()
+			r.Address = nil
+			for a.next() {
+				var v Address
+				v.decodeJSON(d)
+				r.Address = append(r.Address, v)
+			}
+			if r.Address == nil && !a.isNull {
+				r.Address = []Address{}
+			}
+		case "gender":
+			if d.null() {
+				r.Gender = nil
+			} else {
+				var v AdministrativeGender
+				d.unmarshal(&v)
+				r.Gender = &v
+			}
+		case "_gender":
+			r.GenderElement = d.element()
+		case "birthDate":
+			if v, ok := d.string(); ok {
+				r.BirthDate = &v
+			} else {
+				r.BirthDate = nil
+			}
+		case "_birthDate":
+			r.BirthDateElement = d.element()
+		case "photo":
+			a := d.array()
+			r.Photo = nil
+			for a.next() {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Photo = append(r.Photo, v)
+			}
+			if r.Photo == nil && !a.isNull {
+				r.Photo = []Attachment{}
+			}
+		case "qualification":
+			a := d.array()
+			r.Qualification = nil
+			for a.next() {
+				var v PractitionerQualification
+				v.decodeJSON(d)
+				r.Qualification = append(r.Qualification, v)
+			}
+			if r.Qualification == nil && !a.isNull {
+				r.Qualification = []PractitionerQualification{}
+			}
+		case "communication":
+			a := d.array()
+			r.Communication = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Communication = append(r.Communication, v)
+			}
+			if r.Communication == nil && !a.isNull {
+				r.Communication = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Practitioner which shares no memory with the original
+func (r Practitioner) DeepCopy() Practitioner {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Active != nil {
+		v := *r.Active
+		out.Active = &v
+	}
+	if r.ActiveElement != nil {
+		v := r.ActiveElement.DeepCopy()
+		out.ActiveElement = &v
+	}
+	if r.Name != nil {
+		out.Name = make([]HumanName, len(r.Name))
+		for i := range r.Name {
+			out.Name[i] = r.Name[i].DeepCopy()
+		}
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.Address != nil {
+		out.Address = make([]Address, len(r.Address))
+		for i := range r.Address {
+			out.Address[i] = r.Address[i].DeepCopy()
+		}
+	}
+	if r.Gender != nil {
+		v := *r.Gender
+		out.Gender = &v
+	}
+	if r.GenderElement != nil {
+		v := r.GenderElement.DeepCopy()
+		out.GenderElement = &v
+	}
+	if r.BirthDate != nil {
+		v := *r.BirthDate
+		out.BirthDate = &v
+	}
+	if r.BirthDateElement != nil {
+		v := r.BirthDateElement.DeepCopy()
+		out.BirthDateElement = &v
+	}
+	if r.Photo != nil {
+		out.Photo = make([]Attachment, len(r.Photo))
+		for i := range r.Photo {
+			out.Photo[i] = r.Photo[i].DeepCopy()
+		}
+	}
+	if r.Qualification != nil {
+		out.Qualification = make([]PractitionerQualification, len(r.Qualification))
+		for i := range r.Qualification {
+			out.Qualification[i] = r.Qualification[i].DeepCopy()
+		}
+	}
+	if r.Communication != nil {
+		out.Communication = make([]CodeableConcept, len(r.Communication))
+		for i := range r.Communication {
+			out.Communication[i] = r.Communication[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Practitioner) Equal(other Practitioner) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.Equal(*other.ActiveElement) {
+		return false
+	}
+	if len(r.Name) != len(other.Name) {
+		return false
+	}
+	for i := range r.Name {
+		if !r.Name[i].Equal(other.Name[i]) {
+			return false
+		}
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if len(r.Address) != len(other.Address) {
+		return false
+	}
+	for i := range r.Address {
+		if !r.Address[i].Equal(other.Address[i]) {
+			return false
+		}
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.Equal(*other.GenderElement) {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && *r.BirthDate != *other.BirthDate {
+		return false
+	}
+	if (r.BirthDateElement == nil) != (other.BirthDateElement == nil) || r.BirthDateElement != nil && !r.BirthDateElement.Equal(*other.BirthDateElement) {
+		return false
+	}
+	if len(r.Photo) != len(other.Photo) {
+		return false
+	}
+	for i := range r.Photo {
+		if !r.Photo[i].Equal(other.Photo[i]) {
+			return false
+		}
+	}
+	if len(r.Qualification) != len(other.Qualification) {
+		return false
+	}
+	for i := range r.Qualification {
+		if !r.Qualification[i].Equal(other.Qualification[i]) {
+			return false
+		}
+	}
+	if len(r.Communication) != len(other.Communication) {
+		return false
+	}
+	for i := range r.Communication {
+		if !r.Communication[i].Equal(other.Communication[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Practitioner) EqualsDeep(other Practitioner) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.EqualsDeep(*other.ActiveElement) {
+		return false
+	}
+	if !equivalentList(len(r.Name), len(other.Name), func(i, j int) bool {
+		return r.Name[i].EqualsDeep(other.Name[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Address), len(other.Address), func(i, j int) bool {
+		return r.Address[i].EqualsDeep(other.Address[j])
+	}) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.EqualsDeep(*other.GenderElement) {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && !equivalentString(*r.BirthDate, *other.BirthDate) {
+		return false
+	}
+	if (r.BirthDateElement == nil) != (other.BirthDateElement == nil) || r.BirthDateElement != nil && !r.BirthDateElement.EqualsDeep(*other.BirthDateElement) {
+		return false
+	}
+	if !equivalentList(len(r.Photo), len(other.Photo), func(i, j int) bool {
+		return r.Photo[i].EqualsDeep(other.Photo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Qualification), len(other.Qualification), func(i, j int) bool {
+		return r.Qualification[i].EqualsDeep(other.Qualification[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Communication), len(other.Communication), func(i, j int) bool {
+		return r.Communication[i].EqualsDeep(other.Communication[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Practitioner) EqualsShallow(other Practitioner) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && !equivalentString(*r.BirthDate, *other.BirthDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Practitioner as FHIR XML
+func (r Practitioner) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Practitioner",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "active", r.Active, r.ActiveElement); err != nil {
+		return err
+	}
+	for _, v := range r.Name {
+		if err := encodeXMLElement(e, "name", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Address {
+		if err := encodeXMLElement(e, "address", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "gender", r.Gender, r.GenderElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "birthDate", r.BirthDate, r.BirthDateElement); err != nil {
+		return err
+	}
+	for _, v := range r.Photo {
+		if err := encodeXMLElement(e, "photo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Qualification {
+		if err := encodeXMLElement(e, "qualification", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Communication {
+		if err := encodeXMLElement(e, "communication", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Practitioner from FHIR XML
+func (r *Practitioner) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "active":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Active = &v
+				}
+				r.ActiveElement = element
+			case "name":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Name = append(r.Name, v)
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "address":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Address = append(r.Address, v)
+			case "gender":
+				var v AdministrativeGender
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Gender = &v
+				}
+				r.GenderElement = element
+			case "birthDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BirthDate = &v
+				}
+				r.BirthDateElement = element
+			case "photo":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Photo = append(r.Photo, v)
+			case "qualification":
+				var v PractitionerQualification
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Qualification = append(r.Qualification, v)
+			case "communication":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Communication = append(r.Communication, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Practitioner as properties to the RDF node n
+func (r Practitioner) turtle(n *turtleNode) {
+	n.resource("Practitioner", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Practitioner.identifier", i, v)
+	}
+	n.primitive("Practitioner.active", -1, r.Active, r.ActiveElement, "boolean")
+	for i, v := range r.Name {
+		n.element("Practitioner.name", i, v)
+	}
+	for i, v := range r.Telecom {
+		n.element("Practitioner.telecom", i, v)
+	}
+	for i, v := range r.Address {
+		n.element("Practitioner.address", i, v)
+	}
+	n.primitive("Practitioner.gender", -1, r.Gender, r.GenderElement, "code")
+	n.primitive("Practitioner.birthDate", -1, r.BirthDate, r.BirthDateElement, "date")
+	for i, v := range r.Photo {
+		n.element("Practitioner.photo", i, v)
+	}
+	for i, v := range r.Qualification {
+		n.element("Practitioner.qualification", i, v)
+	}
+	for i, v := range r.Communication {
+		n.element("Practitioner.communication", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Practitioner as BSON document with the structure of its FHIR JSON
+func (r Practitioner) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Practitioner from a BSON document with the structure of its FHIR JSON
+func (r *Practitioner) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Practitioner as protobuf message Practitioner of fhir.proto
+func (r Practitioner) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Practitioner to the encoded message
+func (r Practitioner) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Active != nil {
+		e.varint(10, protoBool(*r.Active))
+	}
+	for _, v := range r.Name {
+		e.message(11, v)
+	}
+	for _, v := range r.Telecom {
+		e.message(12, v)
+	}
+	for _, v := range r.Address {
+		e.message(13, v)
+	}
+	if r.Gender != nil {
+		e.varint(14, r.Gender.protoNumber())
+	}
+	if r.BirthDate != nil {
+		e.string(15, *r.BirthDate)
+	}
+	for _, v := range r.Photo {
+		e.message(16, v)
+	}
+	for _, v := range r.Qualification {
+		e.message(17, v)
+	}
+	for _, v := range r.Communication {
+		e.message(18, v)
+	}
+	if r.IdElement != nil {
+		e.message(19, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(20, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(21, *r.LanguageElement)
+	}
+	if r.ActiveElement != nil {
+		e.message(22, *r.ActiveElement)
+	}
+	if r.GenderElement != nil {
+		e.message(23, *r.GenderElement)
+	}
+	if r.BirthDateElement != nil {
+		e.message(24, *r.BirthDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Practitioner from protobuf message Practitioner of fhir.proto
+func (r *Practitioner) UnmarshalProto(b []byte) error {
+	*r = Practitioner{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.bool()
+			r.Active = &v
+		case 11:
+			var v HumanName
+			d.message(&v)
+			r.Name = append(r.Name, v)
+		case 12:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 13:
+			var v Address
+			d.message(&v)
+			r.Address = append(r.Address, v)
+		case 14:
+			var v AdministrativeGender
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Gender = &v
+		case 15:
+			v := d.string()
+			r.BirthDate = &v
+		case 16:
+			var v Attachment
+			d.message(&v)
+			r.Photo = append(r.Photo, v)
+		case 17:
+			var v PractitionerQualification
+			d.message(&v)
+			r.Qualification = append(r.Qualification, v)
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.Communication = append(r.Communication, v)
+		case 19:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ActiveElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.GenderElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.BirthDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PractitionerQualification as JSON into a byte slice
+func (r PractitionerQualification) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PractitionerQualification as JSON object with the members in the order of the fields
+func (r PractitionerQualification) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	r.Code.appendJSON(e)
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.Issuer != nil {
+		e.key("issuer")
+		r.Issuer.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PractitionerQualification from JSON. Unknown members are ignored.
+func (r *PractitionerQualification) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PractitionerQualification
+func (r *PractitionerQualification) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "code":
+			r.Code.decodeJSON(d)
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "issuer":
+			if d.null() {
+				r.Issuer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Issuer = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PractitionerQualification which shares no memory with the original
+func (r PractitionerQualification) DeepCopy() PractitionerQualification {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	out.Code = r.Code.DeepCopy()
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Issuer != nil {
+		v := r.Issuer.DeepCopy()
+		out.Issuer = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PractitionerQualification) Equal(other PractitionerQualification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if !r.Code.Equal(other.Code) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.Issuer == nil) != (other.Issuer == nil) || r.Issuer != nil && !r.Issuer.Equal(*other.Issuer) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PractitionerQualification) EqualsDeep(other PractitionerQualification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !r.Code.EqualsDeep(other.Code) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.Issuer == nil) != (other.Issuer == nil) || r.Issuer != nil && !r.Issuer.EqualsDeep(*other.Issuer) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PractitionerQualification) EqualsShallow(other PractitionerQualification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PractitionerQualification as FHIR XML
+func (r PractitionerQualification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "issuer", r.Issuer); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PractitionerQualification from FHIR XML
+func (r *PractitionerQualification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = v
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "issuer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Issuer = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PractitionerQualification as properties to the RDF node n
+func (r PractitionerQualification) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Practitioner.qualification.identifier", i, v)
+	}
+	n.element("Practitioner.qualification.code", -1, r.Code)
+	n.element("Practitioner.qualification.period", -1, r.Period)
+	n.element("Practitioner.qualification.issuer", -1, r.Issuer)
+}
+
+// MarshalBSON marshals the given PractitionerQualification as BSON document with the structure of its FHIR JSON
+func (r PractitionerQualification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PractitionerQualification from a BSON document with the structure of its FHIR JSON
+func (r *PractitionerQualification) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PractitionerQualification as protobuf message PractitionerQualification of fhir.proto
+func (r PractitionerQualification) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PractitionerQualification to the encoded message
+func (r PractitionerQualification) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(4, v)
+	}
+	e.message(5, r.Code)
+	if r.Period != nil {
+		e.message(6, *r.Period)
+	}
+	if r.Issuer != nil {
+		e.message(7, *r.Issuer)
+	}
+}
+
+// UnmarshalProto unmarshals the given PractitionerQualification from protobuf message PractitionerQualification of fhir.proto
+func (r *PractitionerQualification) UnmarshalProto(b []byte) error {
+	*r = PractitionerQualification{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = v
+		case 6:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Issuer = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalPractitioner unmarshals a Practitioner.
 func UnmarshalPractitioner(b []byte) (Practitioner, error) {
 	var practitioner Practitioner
-	if err := json.Unmarshal(b, &practitioner); err != nil {
+	if err := practitioner.UnmarshalJSON(b); err != nil {
 		return practitioner, err
 	}
 	return practitioner, nil
@@ -14,68 +14,2406 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // PractitionerRole is documented here http://hl7.org/fhir/StructureDefinition/PractitionerRole
 type PractitionerRole struct {
-	Id                     *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                   *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules          *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language               *string                         `bson:"language,omitempty" json:"language,omitempty"`
-	Text                   *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
-	Extension              []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension      []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier             []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active                 *bool                           `bson:"active,omitempty" json:"active,omitempty"`
-	Period                 *Period                         `bson:"period,omitempty" json:"period,omitempty"`
-	Practitioner           *Reference                      `bson:"practitioner,omitempty" json:"practitioner,omitempty"`
-	Organization           *Reference                      `bson:"organization,omitempty" json:"organization,omitempty"`
-	Code                   []CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
-	Specialty              []CodeableConcept               `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	Location               []Reference                     `bson:"location,omitempty" json:"location,omitempty"`
-	HealthcareService      []Reference                     `bson:"healthcareService,omitempty" json:"healthcareService,omitempty"`
-	Telecom                []ContactPoint                  `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	AvailableTime          []PractitionerRoleAvailableTime `bson:"availableTime,omitempty" json:"availableTime,omitempty"`
-	NotAvailable           []PractitionerRoleNotAvailable  `bson:"notAvailable,omitempty" json:"notAvailable,omitempty"`
-	AvailabilityExceptions *string                         `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
-	Endpoint               []Reference                     `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	Id                            *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                     *Element                        `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                          *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                 *string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement          *Element                        `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                      *string                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement               *Element                        `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                          *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                     []json.RawMessage               `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                     []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension             []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                    []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active                        *bool                           `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement                 *Element                        `bson:"_active,omitempty" json:"_active,omitempty"`
+	Period                        *Period                         `bson:"period,omitempty" json:"period,omitempty"`
+	Practitioner                  *Reference                      `bson:"practitioner,omitempty" json:"practitioner,omitempty"`
+	Organization                  *Reference                      `bson:"organization,omitempty" json:"organization,omitempty"`
+	Code                          []CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
+	Specialty                     []CodeableConcept               `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	Location                      []Reference                     `bson:"location,omitempty" json:"location,omitempty"`
+	HealthcareService             []Reference                     `bson:"healthcareService,omitempty" json:"healthcareService,omitempty"`
+	Telecom                       []ContactPoint                  `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	AvailableTime                 []PractitionerRoleAvailableTime `bson:"availableTime,omitempty" json:"availableTime,omitempty"`
+	NotAvailable                  []PractitionerRoleNotAvailable  `bson:"notAvailable,omitempty" json:"notAvailable,omitempty"`
+	AvailabilityExceptions        *string                         `bson:"availabilityExceptions,omitempty" json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *Element                        `bson:"_availabilityExceptions,omitempty" json:"_availabilityExceptions,omitempty"`
+	Endpoint                      []Reference                     `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
 }
 type PractitionerRoleAvailableTime struct {
-	Id                 *string      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DaysOfWeek         []DaysOfWeek `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
-	AllDay             *bool        `bson:"allDay,omitempty" json:"allDay,omitempty"`
-	AvailableStartTime *string      `bson:"availableStartTime,omitempty" json:"availableStartTime,omitempty"`
-	AvailableEndTime   *string      `bson:"availableEndTime,omitempty" json:"availableEndTime,omitempty"`
+	Id                        *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DaysOfWeek                []DaysOfWeek `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement         []*Element   `bson:"_daysOfWeek,omitempty" json:"_daysOfWeek,omitempty"`
+	AllDay                    *bool        `bson:"allDay,omitempty" json:"allDay,omitempty"`
+	AllDayElement             *Element     `bson:"_allDay,omitempty" json:"_allDay,omitempty"`
+	AvailableStartTime        *string      `bson:"availableStartTime,omitempty" json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *Element     `bson:"_availableStartTime,omitempty" json:"_availableStartTime,omitempty"`
+	AvailableEndTime          *string      `bson:"availableEndTime,omitempty" json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement   *Element     `bson:"_availableEndTime,omitempty" json:"_availableEndTime,omitempty"`
 }
 type PractitionerRoleNotAvailable struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       string      `bson:"description" json:"description"`
-	During            *Period     `bson:"during,omitempty" json:"during,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        string      `bson:"description" json:"description"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	During             *Period     `bson:"during,omitempty" json:"during,omitempty"`
 }
-type OtherPractitionerRole PractitionerRole
 
 // MarshalJSON marshals the given PractitionerRole as JSON into a byte slice
 func (r PractitionerRole) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherPractitionerRole
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherPractitionerRole: OtherPractitionerRole(r),
-		ResourceType:          "PractitionerRole",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PractitionerRole as JSON object with the members in the order of the fields
+func (r PractitionerRole) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"PractitionerRole\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Active != nil {
+		e.key("active")
+		e.bool(*r.Active)
+	}
+	if r.ActiveElement != nil {
+		e.key("_active")
+		r.ActiveElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.Practitioner != nil {
+		e.key("practitioner")
+		r.Practitioner.appendJSON(e)
+	}
+	if r.Organization != nil {
+		e.key("organization")
+		r.Organization.appendJSON(e)
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Specialty) > 0 {
+		e.key("specialty")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Specialty {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Location) > 0 {
+		e.key("location")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Location {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.HealthcareService) > 0 {
+		e.key("healthcareService")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.HealthcareService {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.AvailableTime) > 0 {
+		e.key("availableTime")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AvailableTime {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.NotAvailable) > 0 {
+		e.key("notAvailable")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.NotAvailable {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AvailabilityExceptions != nil {
+		e.key("availabilityExceptions")
+		e.string(*r.AvailabilityExceptions)
+	}
+	if r.AvailabilityExceptionsElement != nil {
+		e.key("_availabilityExceptions")
+		r.AvailabilityExceptionsElement.appendJSON(e)
+	}
+	if len(r.Endpoint) > 0 {
+		e.key("endpoint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endpoint {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PractitionerRole from JSON. Unknown members are ignored.
+func (r *PractitionerRole) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PractitionerRole
+func (r *PractitionerRole) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "active":
+			if v, ok := d.bool(); ok {
+				r.Active = &v
+			} else {
+				r.Active = nil
+			}
+		case "_active":
+			r.ActiveElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "practitioner":
+			if d.null() {
+				r.Practitioner = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Practitioner = &v
+			}
+		case "organization":
+			if d.null() {
+				r.Organization = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Organization = &v
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "specialty":
+			a := d.array()
+			r.Specialty = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Specialty = append(r.Specialty, v)
+			}
+			if r.Specialty == nil && !a.isNull {
+				r.Specialty = []CodeableConcept{}
+			}
+		case "location":
+			a := d.array()
+			r.Location = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = append(r.Location, v)
+			}
+			if r.Location == nil && !a.isNull {
+				r.Location = []Reference{}
+			}
+		case "healthcareService":
+			a := d.array()
+			r.HealthcareService = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.HealthcareService = append(r.HealthcareService, v)
+			}
+			if r.HealthcareService == nil && !a.isNull {
+				r.HealthcareService = []Reference{}
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "availableTime":
+			a := d.array()
+			r.AvailableTime = nil
+			for a.next() {
+				var v PractitionerRoleAvailableTime
+				v.decodeJSON(d)
+				r.AvailableTime = append(r.AvailableTime, v)
+			}
+			if r.AvailableTime == nil && !a.isNull {
+				r.AvailableTime = []PractitionerRoleAvailableTime{}
+			}
+		case "notAvailable":
+			a := d.array()
+			r.NotAvailable = nil
+			for a.next() {
+				var v PractitionerRoleNotAvailable
+				v.decodeJSON(d)
+				r.NotAvailable = append(r.NotAvailable, v)
+			}
+			if r.NotAvailable == nil && !a.isNull {
+				r.NotAvailable = []PractitionerRoleNotAvailable{}
+			}
+		case "availabilityExceptions":
+			if v, ok := d.string(); ok {
+				r.AvailabilityExceptions = &v
+			} else {
+				r.AvailabilityExceptions = nil
+			}
+		case "_availabilityExceptions":
+			r.AvailabilityExceptionsElement = d.element()
+		case "endpoint":
+			a := d.array()
+			r.Endpoint = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Endpoint = append(r.Endpoint, v)
+			}
+			if r.Endpoint == nil && !a.isNull {
+				r.Endpoint = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PractitionerRole which shares no memory with the original
+func (r PractitionerRole) DeepCopy() PractitionerRole {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Active != nil {
+		v := *r.Active
+		out.Active = &v
+	}
+	if r.ActiveElement != nil {
+		v := r.ActiveElement.DeepCopy()
+		out.ActiveElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Practitioner != nil {
+		v := r.Practitioner.DeepCopy()
+		out.Practitioner = &v
+	}
+	if r.Organization != nil {
+		v := r.Organization.DeepCopy()
+		out.Organization = &v
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Specialty != nil {
+		out.Specialty = make([]CodeableConcept, len(r.Specialty))
+		for i := range r.Specialty {
+			out.Specialty[i] = r.Specialty[i].DeepCopy()
+		}
+	}
+	if r.Location != nil {
+		out.Location = make([]Reference, len(r.Location))
+		for i := range r.Location {
+			out.Location[i] = r.Location[i].DeepCopy()
+		}
+	}
+	if r.HealthcareService != nil {
+		out.HealthcareService = make([]Reference, len(r.HealthcareService))
+		for i := range r.HealthcareService {
+			out.HealthcareService[i] = r.HealthcareService[i].DeepCopy()
+		}
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.AvailableTime != nil {
+		out.AvailableTime = make([]PractitionerRoleAvailableTime, len(r.AvailableTime))
+		for i := range r.AvailableTime {
+			out.AvailableTime[i] = r.AvailableTime[i].DeepCopy()
+		}
+	}
+	if r.NotAvailable != nil {
+		out.NotAvailable = make([]PractitionerRoleNotAvailable, len(r.NotAvailable))
+		for i := range r.NotAvailable {
+			out.NotAvailable[i] = r.NotAvailable[i].DeepCopy()
+		}
+	}
+	if r.AvailabilityExceptions != nil {
+		v := *r.AvailabilityExceptions
+		out.AvailabilityExceptions = &v
+	}
+	if r.AvailabilityExceptionsElement != nil {
+		v := r.AvailabilityExceptionsElement.DeepCopy()
+		out.AvailabilityExceptionsElement = &v
+	}
+	if r.Endpoint != nil {
+		out.Endpoint = make([]Reference, len(r.Endpoint))
+		for i := range r.Endpoint {
+			out.Endpoint[i] = r.Endpoint[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PractitionerRole) Equal(other PractitionerRole) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.Equal(*other.ActiveElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.Practitioner == nil) != (other.Practitioner == nil) || r.Practitioner != nil && !r.Practitioner.Equal(*other.Practitioner) {
+		return false
+	}
+	if (r.Organization == nil) != (other.Organization == nil) || r.Organization != nil && !r.Organization.Equal(*other.Organization) {
+		return false
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if len(r.Specialty) != len(other.Specialty) {
+		return false
+	}
+	for i := range r.Specialty {
+		if !r.Specialty[i].Equal(other.Specialty[i]) {
+			return false
+		}
+	}
+	if len(r.Location) != len(other.Location) {
+		return false
+	}
+	for i := range r.Location {
+		if !r.Location[i].Equal(other.Location[i]) {
+			return false
+		}
+	}
+	if len(r.HealthcareService) != len(other.HealthcareService) {
+		return false
+	}
+	for i := range r.HealthcareService {
+		if !r.HealthcareService[i].Equal(other.HealthcareService[i]) {
+			return false
+		}
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if len(r.AvailableTime) != len(other.AvailableTime) {
+		return false
+	}
+	for i := range r.AvailableTime {
+		if !r.AvailableTime[i].Equal(other.AvailableTime[i]) {
+			return false
+		}
+	}
+	if len(r.NotAvailable) != len(other.NotAvailable) {
+		return false
+	}
+	for i := range r.NotAvailable {
+		if !r.NotAvailable[i].Equal(other.NotAvailable[i]) {
+			return false
+		}
+	}
+	if (r.AvailabilityExceptions == nil) != (other.AvailabilityExceptions == nil) || r.AvailabilityExceptions != nil && *r.AvailabilityExceptions != *other.AvailabilityExceptions {
+		return false
+	}
+	if (r.AvailabilityExceptionsElement == nil) != (other.AvailabilityExceptionsElement == nil) || r.AvailabilityExceptionsElement != nil && !r.AvailabilityExceptionsElement.Equal(*other.AvailabilityExceptionsElement) {
+		return false
+	}
+	if len(r.Endpoint) != len(other.Endpoint) {
+		return false
+	}
+	for i := range r.Endpoint {
+		if !r.Endpoint[i].Equal(other.Endpoint[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PractitionerRole) EqualsDeep(other PractitionerRole) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.EqualsDeep(*other.ActiveElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.Practitioner == nil) != (other.Practitioner == nil) || r.Practitioner != nil && !r.Practitioner.EqualsDeep(*other.Practitioner) {
+		return false
+	}
+	if (r.Organization == nil) != (other.Organization == nil) || r.Organization != nil && !r.Organization.EqualsDeep(*other.Organization) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Specialty), len(other.Specialty), func(i, j int) bool {
+		return r.Specialty[i].EqualsDeep(other.Specialty[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Location), len(other.Location), func(i, j int) bool {
+		return r.Location[i].EqualsDeep(other.Location[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.HealthcareService), len(other.HealthcareService), func(i, j int) bool {
+		return r.HealthcareService[i].EqualsDeep(other.HealthcareService[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.AvailableTime), len(other.AvailableTime), func(i, j int) bool {
+		return r.AvailableTime[i].EqualsDeep(other.AvailableTime[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.NotAvailable), len(other.NotAvailable), func(i, j int) bool {
+		return r.NotAvailable[i].EqualsDeep(other.NotAvailable[j])
+	}) {
+		return false
+	}
+	if (r.AvailabilityExceptions == nil) != (other.AvailabilityExceptions == nil) || r.AvailabilityExceptions != nil && !equivalentString(*r.AvailabilityExceptions, *other.AvailabilityExceptions) {
+		return false
+	}
+	if (r.AvailabilityExceptionsElement == nil) != (other.AvailabilityExceptionsElement == nil) || r.AvailabilityExceptionsElement != nil && !r.AvailabilityExceptionsElement.EqualsDeep(*other.AvailabilityExceptionsElement) {
+		return false
+	}
+	if !equivalentList(len(r.Endpoint), len(other.Endpoint), func(i, j int) bool {
+		return r.Endpoint[i].EqualsDeep(other.Endpoint[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PractitionerRole) EqualsShallow(other PractitionerRole) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.AvailabilityExceptions == nil) != (other.AvailabilityExceptions == nil) || r.AvailabilityExceptions != nil && !equivalentString(*r.AvailabilityExceptions, *other.AvailabilityExceptions) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PractitionerRole as FHIR XML
+func (r PractitionerRole) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "PractitionerRole",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "active", r.Active, r.ActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "practitioner", r.Practitioner); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "organization", r.Organization); err != nil {
+		return err
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Specialty {
+		if err := encodeXMLElement(e, "specialty", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Location {
+		if err := encodeXMLElement(e, "location", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.HealthcareService {
+		if err := encodeXMLElement(e, "healthcareService", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.AvailableTime {
+		if err := encodeXMLElement(e, "availableTime", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.NotAvailable {
+		if err := encodeXMLElement(e, "notAvailable", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "availabilityExceptions", r.AvailabilityExceptions, r.AvailabilityExceptionsElement); err != nil {
+		return err
+	}
+	for _, v := range r.Endpoint {
+		if err := encodeXMLElement(e, "endpoint", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PractitionerRole from FHIR XML
+func (r *PractitionerRole) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "active":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Active = &v
+				}
+				r.ActiveElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "practitioner":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Practitioner = &v
+			case "organization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Organization = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "specialty":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specialty = append(r.Specialty, v)
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = append(r.Location, v)
+			case "healthcareService":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.HealthcareService = append(r.HealthcareService, v)
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "availableTime":
+				var v PractitionerRoleAvailableTime
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AvailableTime = append(r.AvailableTime, v)
+			case "notAvailable":
+				var v PractitionerRoleNotAvailable
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.NotAvailable = append(r.NotAvailable, v)
+			case "availabilityExceptions":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AvailabilityExceptions = &v
+				}
+				r.AvailabilityExceptionsElement = element
+			case "endpoint":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endpoint = append(r.Endpoint, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PractitionerRole as properties to the RDF node n
+func (r PractitionerRole) turtle(n *turtleNode) {
+	n.resource("PractitionerRole", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("PractitionerRole.identifier", i, v)
+	}
+	n.primitive("PractitionerRole.active", -1, r.Active, r.ActiveElement, "boolean")
+	n.element("PractitionerRole.period", -1, r.Period)
+	n.element("PractitionerRole.practitioner", -1, r.Practitioner)
+	n.element("PractitionerRole.organization", -1, r.Organization)
+	for i, v := range r.Code {
+		n.element("PractitionerRole.code", i, v)
+	}
+	for i, v := range r.Specialty {
+		n.element("PractitionerRole.specialty", i, v)
+	}
+	for i, v := range r.Location {
+		n.element("PractitionerRole.location", i, v)
+	}
+	for i, v := range r.HealthcareService {
+		n.element("PractitionerRole.healthcareService", i, v)
+	}
+	for i, v := range r.Telecom {
+		n.element("PractitionerRole.telecom", i, v)
+	}
+	for i, v := range r.AvailableTime {
+		n.element("PractitionerRole.availableTime", i, v)
+	}
+	for i, v := range r.NotAvailable {
+		n.element("PractitionerRole.notAvailable", i, v)
+	}
+	n.primitive("PractitionerRole.availabilityExceptions", -1, r.AvailabilityExceptions, r.AvailabilityExceptionsElement, "string")
+	for i, v := range r.Endpoint {
+		n.element("PractitionerRole.endpoint", i, v)
+	}
+}
+
+// MarshalBSON marshals the given PractitionerRole as BSON document with the structure of its FHIR JSON
+func (r PractitionerRole) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PractitionerRole from a BSON document with the structure of its FHIR JSON
+func (r *PractitionerRole) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PractitionerRole as protobuf message PractitionerRole of fhir.proto
+func (r PractitionerRole) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PractitionerRole to the encoded message
+func (r PractitionerRole) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Active != nil {
+		e.varint(10, protoBool(*r.Active))
+	}
+	if r.Period != nil {
+		e.message(11, *r.Period)
+	}
+	if r.Practitioner != nil {
+		e.message(12, *r.Practitioner)
+	}
+	if r.Organization != nil {
+		e.message(13, *r.Organization)
+	}
+	for _, v := range r.Code {
+		e.message(14, v)
+	}
+	for _, v := range r.Specialty {
+		e.message(15, v)
+	}
+	for _, v := range r.Location {
+		e.message(16, v)
+	}
+	for _, v := range r.HealthcareService {
+		e.message(17, v)
+	}
+	for _, v := range r.Telecom {
+		e.message(18, v)
+	}
+	for _, v := range r.AvailableTime {
+		e.message(19, v)
+	}
+	for _, v := range r.NotAvailable {
+		e.message(20, v)
+	}
+	if r.AvailabilityExceptions != nil {
+		e.string(21, *r.AvailabilityExceptions)
+	}
+	for _, v := range r.Endpoint {
+		e.message(22, v)
+	}
+	if r.IdElement != nil {
+		e.message(23, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(24, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(25, *r.LanguageElement)
+	}
+	if r.ActiveElement != nil {
+		e.message(26, *r.ActiveElement)
+	}
+	if r.AvailabilityExceptionsElement != nil {
+		e.message(27, *r.AvailabilityExceptionsElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PractitionerRole from protobuf message PractitionerRole of fhir.proto
+func (r *PractitionerRole) UnmarshalProto(b []byte) error {
+	*r = PractitionerRole{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.bool()
+			r.Active = &v
+		case 11:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Practitioner = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Organization = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.Specialty = append(r.Specialty, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Location = append(r.Location, v)
+		case 17:
+			var v Reference
+			d.message(&v)
+			r.HealthcareService = append(r.HealthcareService, v)
+		case 18:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 19:
+			var v PractitionerRoleAvailableTime
+			d.message(&v)
+			r.AvailableTime = append(r.AvailableTime, v)
+		case 20:
+			var v PractitionerRoleNotAvailable
+			d.message(&v)
+			r.NotAvailable = append(r.NotAvailable, v)
+		case 21:
+			v := d.string()
+			r.AvailabilityExceptions = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.Endpoint = append(r.Endpoint, v)
+		case 23:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ActiveElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.AvailabilityExceptionsElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PractitionerRoleAvailableTime as JSON into a byte slice
+func (r PractitionerRoleAvailableTime) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PractitionerRoleAvailableTime as JSON object with the members in the order of the fields
+func (r PractitionerRoleAvailableTime) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DaysOfWeek) > 0 {
+		e.key("daysOfWeek")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DaysOfWeek {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DaysOfWeekElement) > 0 {
+		e.key("_daysOfWeek")
+		e.elements(r.DaysOfWeekElement, len(r.DaysOfWeek))
+	}
+	if r.AllDay != nil {
+		e.key("allDay")
+		e.bool(*r.AllDay)
+	}
+	if r.AllDayElement != nil {
+		e.key("_allDay")
+		r.AllDayElement.appendJSON(e)
+	}
+	if r.AvailableStartTime != nil {
+		e.key("availableStartTime")
+		e.string(*r.AvailableStartTime)
+	}
+	if r.AvailableStartTimeElement != nil {
+		e.key("_availableStartTime")
+		r.AvailableStartTimeElement.appendJSON(e)
+	}
+	if r.AvailableEndTime != nil {
+		e.key("availableEndTime")
+		e.string(*r.AvailableEndTime)
+	}
+	if r.AvailableEndTimeElement != nil {
+		e.key("_availableEndTime")
+		r.AvailableEndTimeElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PractitionerRoleAvailableTime from JSON. Unknown members are ignored.
+func (r *PractitionerRoleAvailableTime) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PractitionerRoleAvailableTime
+func (r *PractitionerRoleAvailableTime) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "daysOfWeek":
+			a := d.array()
+			r.DaysOfWeek = nil
+			for a.next() {
+				var v DaysOfWeek
+				d.unmarshal(&v)
+				r.DaysOfWeek = append(r.DaysOfWeek, v)
+			}
+			if r.DaysOfWeek == nil && !a.isNull {
+				r.DaysOfWeek = []DaysOfWeek{}
+			}
+		case "_daysOfWeek":
+			a := d.array()
+			r.DaysOfWeekElement = nil
+			for a.next() {
+				r.DaysOfWeekElement = append(r.DaysOfWeekElement, d.element())
+			}
+		case "allDay":
+			if v, ok := d.bool(); ok {
+				r.AllDay = &v
+			} else {
+				r.AllDay = nil
+			}
+		case "_allDay":
+			r.AllDayElement = d.element()
+		case "availableStartTime":
+			if v, ok := d.string(); ok {
+				r.AvailableStartTime = &v
+			} else {
+				r.AvailableStartTime = nil
+			}
+		case "_availableStartTime":
+			r.AvailableStartTimeElement = d.element()
+		case "availableEndTime":
+			if v, ok := d.string(); ok {
+				r.AvailableEndTime = &v
+			} else {
+				r.AvailableEndTime = nil
+			}
+		case "_availableEndTime":
+			r.AvailableEndTimeElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PractitionerRoleAvailableTime which shares no memory with the original
+func (r PractitionerRoleAvailableTime) DeepCopy() PractitionerRoleAvailableTime {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DaysOfWeek != nil {
+		out.DaysOfWeek = make([]DaysOfWeek, len(r.DaysOfWeek))
+		copy(out.DaysOfWeek, r.DaysOfWeek)
+	}
+	if r.DaysOfWeekElement != nil {
+		out.DaysOfWeekElement = make([]*Element, len(r.DaysOfWeekElement))
+		for i, v := range r.DaysOfWeekElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DaysOfWeekElement[i] = &c
+			}
+		}
+	}
+	if r.AllDay != nil {
+		v := *r.AllDay
+		out.AllDay = &v
+	}
+	if r.AllDayElement != nil {
+		v := r.AllDayElement.DeepCopy()
+		out.AllDayElement = &v
+	}
+	if r.AvailableStartTime != nil {
+		v := *r.AvailableStartTime
+		out.AvailableStartTime = &v
+	}
+	if r.AvailableStartTimeElement != nil {
+		v := r.AvailableStartTimeElement.DeepCopy()
+		out.AvailableStartTimeElement = &v
+	}
+	if r.AvailableEndTime != nil {
+		v := *r.AvailableEndTime
+		out.AvailableEndTime = &v
+	}
+	if r.AvailableEndTimeElement != nil {
+		v := r.AvailableEndTimeElement.DeepCopy()
+		out.AvailableEndTimeElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PractitionerRoleAvailableTime) Equal(other PractitionerRoleAvailableTime) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.DaysOfWeek) != len(other.DaysOfWeek) {
+		return false
+	}
+	for i := range r.DaysOfWeek {
+		if r.DaysOfWeek[i] != other.DaysOfWeek[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DaysOfWeekElement, other.DaysOfWeekElement, false) {
+		return false
+	}
+	if (r.AllDay == nil) != (other.AllDay == nil) || r.AllDay != nil && *r.AllDay != *other.AllDay {
+		return false
+	}
+	if (r.AllDayElement == nil) != (other.AllDayElement == nil) || r.AllDayElement != nil && !r.AllDayElement.Equal(*other.AllDayElement) {
+		return false
+	}
+	if (r.AvailableStartTime == nil) != (other.AvailableStartTime == nil) || r.AvailableStartTime != nil && *r.AvailableStartTime != *other.AvailableStartTime {
+		return false
+	}
+	if (r.AvailableStartTimeElement == nil) != (other.AvailableStartTimeElement == nil) || r.AvailableStartTimeElement != nil && !r.AvailableStartTimeElement.Equal(*other.AvailableStartTimeElement) {
+		return false
+	}
+	if (r.AvailableEndTime == nil) != (other.AvailableEndTime == nil) || r.AvailableEndTime != nil && *r.AvailableEndTime != *other.AvailableEndTime {
+		return false
+	}
+	if (r.AvailableEndTimeElement == nil) != (other.AvailableEndTimeElement == nil) || r.AvailableEndTimeElement != nil && !r.AvailableEndTimeElement.Equal(*other.AvailableEndTimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PractitionerRoleAvailableTime) EqualsDeep(other PractitionerRoleAvailableTime) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.DaysOfWeek), len(other.DaysOfWeek), func(i, j int) bool {
+		return r.DaysOfWeek[i] == other.DaysOfWeek[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.DaysOfWeekElement, other.DaysOfWeekElement, true) {
+		return false
+	}
+	if (r.AllDay == nil) != (other.AllDay == nil) || r.AllDay != nil && *r.AllDay != *other.AllDay {
+		return false
+	}
+	if (r.AllDayElement == nil) != (other.AllDayElement == nil) || r.AllDayElement != nil && !r.AllDayElement.EqualsDeep(*other.AllDayElement) {
+		return false
+	}
+	if (r.AvailableStartTime == nil) != (other.AvailableStartTime == nil) || r.AvailableStartTime != nil && !equivalentString(*r.AvailableStartTime, *other.AvailableStartTime) {
+		return false
+	}
+	if (r.AvailableStartTimeElement == nil) != (other.AvailableStartTimeElement == nil) || r.AvailableStartTimeElement != nil && !r.AvailableStartTimeElement.EqualsDeep(*other.AvailableStartTimeElement) {
+		return false
+	}
+	if (r.AvailableEndTime == nil) != (other.AvailableEndTime == nil) || r.AvailableEndTime != nil && !equivalentString(*r.AvailableEndTime, *other.AvailableEndTime) {
+		return false
+	}
+	if (r.AvailableEndTimeElement == nil) != (other.AvailableEndTimeElement == nil) || r.AvailableEndTimeElement != nil && !r.AvailableEndTimeElement.EqualsDeep(*other.AvailableEndTimeElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PractitionerRoleAvailableTime) EqualsShallow(other PractitionerRoleAvailableTime) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.DaysOfWeek), len(other.DaysOfWeek), func(i, j int) bool {
+		return r.DaysOfWeek[i] == other.DaysOfWeek[j]
+	}) {
+		return false
+	}
+	if (r.AllDay == nil) != (other.AllDay == nil) || r.AllDay != nil && *r.AllDay != *other.AllDay {
+		return false
+	}
+	if (r.AvailableStartTime == nil) != (other.AvailableStartTime == nil) || r.AvailableStartTime != nil && !equivalentString(*r.AvailableStartTime, *other.AvailableStartTime) {
+		return false
+	}
+	if (r.AvailableEndTime == nil) != (other.AvailableEndTime == nil) || r.AvailableEndTime != nil && !equivalentString(*r.AvailableEndTime, *other.AvailableEndTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PractitionerRoleAvailableTime as FHIR XML
+func (r PractitionerRoleAvailableTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.DaysOfWeek {
+		if err := encodeXMLPrimitive(e, "daysOfWeek", v, elementAt(r.DaysOfWeekElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "allDay", r.AllDay, r.AllDayElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "availableStartTime", r.AvailableStartTime, r.AvailableStartTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "availableEndTime", r.AvailableEndTime, r.AvailableEndTimeElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PractitionerRoleAvailableTime from FHIR XML
+func (r *PractitionerRoleAvailableTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "daysOfWeek":
+				var v DaysOfWeek
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DaysOfWeekElement = appendElement(r.DaysOfWeekElement, len(r.DaysOfWeek), element)
+					r.DaysOfWeek = append(r.DaysOfWeek, v)
+				}
+			case "allDay":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AllDay = &v
+				}
+				r.AllDayElement = element
+			case "availableStartTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AvailableStartTime = &v
+				}
+				r.AvailableStartTimeElement = element
+			case "availableEndTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AvailableEndTime = &v
+				}
+				r.AvailableEndTimeElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PractitionerRoleAvailableTime as properties to the RDF node n
+func (r PractitionerRoleAvailableTime) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.DaysOfWeek {
+		n.primitive("PractitionerRole.availableTime.daysOfWeek", i, v, elementAt(r.DaysOfWeekElement, i), "code")
+	}
+	n.primitive("PractitionerRole.availableTime.allDay", -1, r.AllDay, r.AllDayElement, "boolean")
+	n.primitive("PractitionerRole.availableTime.availableStartTime", -1, r.AvailableStartTime, r.AvailableStartTimeElement, "time")
+	n.primitive("PractitionerRole.availableTime.availableEndTime", -1, r.AvailableEndTime, r.AvailableEndTimeElement, "time")
+}
+
+// MarshalBSON marshals the given PractitionerRoleAvailableTime as BSON document with the structure of its FHIR JSON
+func (r PractitionerRoleAvailableTime) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PractitionerRoleAvailableTime from a BSON document with the structure of its FHIR JSON
+func (r *PractitionerRoleAvailableTime) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PractitionerRoleAvailableTime as protobuf message PractitionerRoleAvailableTime of fhir.proto
+func (r PractitionerRoleAvailableTime) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PractitionerRoleAvailableTime to the encoded message
+func (r PractitionerRoleAvailableTime) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if len(r.DaysOfWeek) > 0 {
+		var p protoEncoder
+		for _, v := range r.DaysOfWeek {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(4, p.buf)
+	}
+	if r.AllDay != nil {
+		e.varint(5, protoBool(*r.AllDay))
+	}
+	if r.AvailableStartTime != nil {
+		e.string(6, *r.AvailableStartTime)
+	}
+	if r.AvailableEndTime != nil {
+		e.string(7, *r.AvailableEndTime)
+	}
+	for _, v := range r.DaysOfWeekElement {
+		e.element(8, v)
+	}
+	if r.AllDayElement != nil {
+		e.message(9, *r.AllDayElement)
+	}
+	if r.AvailableStartTimeElement != nil {
+		e.message(10, *r.AvailableStartTimeElement)
+	}
+	if r.AvailableEndTimeElement != nil {
+		e.message(11, *r.AvailableEndTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PractitionerRoleAvailableTime from protobuf message PractitionerRoleAvailableTime of fhir.proto
+func (r *PractitionerRoleAvailableTime) UnmarshalProto(b []byte) error {
+	*r = PractitionerRoleAvailableTime{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			for _, n := range d.varints() {
+				var v DaysOfWeek
+				d.enum(n, v.setProtoNumber)
+				r.DaysOfWeek = append(r.DaysOfWeek, v)
+			}
+		case 5:
+			v := d.bool()
+			r.AllDay = &v
+		case 6:
+			v := d.string()
+			r.AvailableStartTime = &v
+		case 7:
+			v := d.string()
+			r.AvailableEndTime = &v
+		case 8:
+			r.DaysOfWeekElement = append(r.DaysOfWeekElement, d.element())
+		case 9:
+			var v Element
+			d.message(&v)
+			r.AllDayElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.AvailableStartTimeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.AvailableEndTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given PractitionerRoleNotAvailable as JSON into a byte slice
+func (r PractitionerRoleNotAvailable) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the PractitionerRoleNotAvailable as JSON object with the members in the order of the fields
+func (r PractitionerRoleNotAvailable) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("description")
+	e.string(r.Description)
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.During != nil {
+		e.key("during")
+		r.During.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given PractitionerRoleNotAvailable from JSON. Unknown members are ignored.
+func (r *PractitionerRoleNotAvailable) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the PractitionerRoleNotAvailable
+func (r *PractitionerRoleNotAvailable) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = v
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "during":
+			if d.null() {
+				r.During = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.During = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the PractitionerRoleNotAvailable which shares no memory with the original
+func (r PractitionerRoleNotAvailable) DeepCopy() PractitionerRoleNotAvailable {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.During != nil {
+		v := r.During.DeepCopy()
+		out.During = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r PractitionerRoleNotAvailable) Equal(other PractitionerRoleNotAvailable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Description != other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.During == nil) != (other.During == nil) || r.During != nil && !r.During.Equal(*other.During) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r PractitionerRoleNotAvailable) EqualsDeep(other PractitionerRoleNotAvailable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.During == nil) != (other.During == nil) || r.During != nil && !r.During.EqualsDeep(*other.During) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r PractitionerRoleNotAvailable) EqualsShallow(other PractitionerRoleNotAvailable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given PractitionerRoleNotAvailable as FHIR XML
+func (r PractitionerRoleNotAvailable) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "during", r.During); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given PractitionerRoleNotAvailable from FHIR XML
+func (r *PractitionerRoleNotAvailable) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = v
+				}
+				r.DescriptionElement = element
+			case "during":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.During = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the PractitionerRoleNotAvailable as properties to the RDF node n
+func (r PractitionerRoleNotAvailable) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("PractitionerRole.notAvailable.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("PractitionerRole.notAvailable.during", -1, r.During)
+}
+
+// MarshalBSON marshals the given PractitionerRoleNotAvailable as BSON document with the structure of its FHIR JSON
+func (r PractitionerRoleNotAvailable) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given PractitionerRoleNotAvailable from a BSON document with the structure of its FHIR JSON
+func (r *PractitionerRoleNotAvailable) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given PractitionerRoleNotAvailable as protobuf message PractitionerRoleNotAvailable of fhir.proto
+func (r PractitionerRoleNotAvailable) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the PractitionerRoleNotAvailable to the encoded message
+func (r PractitionerRoleNotAvailable) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != "" {
+		e.string(4, r.Description)
+	}
+	if r.During != nil {
+		e.message(5, *r.During)
+	}
+	if r.DescriptionElement != nil {
+		e.message(6, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given PractitionerRoleNotAvailable from protobuf message PractitionerRoleNotAvailable of fhir.proto
+func (r *PractitionerRoleNotAvailable) UnmarshalProto(b []byte) error {
+	*r = PractitionerRoleNotAvailable{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Description = d.string()
+		case 5:
+			var v Period
+			d.message(&v)
+			r.During = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalPractitionerRole unmarshals a PractitionerRole.
 func UnmarshalPractitionerRole(b []byte) (PractitionerRole, error) {
 	var practitionerRole PractitionerRole
-	if err := json.Unmarshal(b, &practitionerRole); err != nil {
+	if err := practitionerRole.UnmarshalJSON(b); err != nil {
 		return practitionerRole, err
 	}
 	return practitionerRole, nil
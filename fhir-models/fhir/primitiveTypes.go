@@ -0,0 +1,77 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import "encoding/json"
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// Base64Binary is the FHIR primitive type base64Binary used as value of polymorphic elements
+type Base64Binary string
+
+// Boolean is the FHIR primitive type boolean used as value of polymorphic elements
+type Boolean bool
+
+// Canonical is the FHIR primitive type canonical used as value of polymorphic elements
+type Canonical string
+
+// Code is the FHIR primitive type code used as value of polymorphic elements
+type Code string
+
+// Date is the FHIR primitive type date used as value of polymorphic elements
+type Date string
+
+// DateTime is the FHIR primitive type dateTime used as value of polymorphic elements
+type DateTime string
+
+// Decimal is the FHIR primitive type decimal used as value of polymorphic elements
+type Decimal json.Number
+
+// Id is the FHIR primitive type id used as value of polymorphic elements
+type Id string
+
+// Instant is the FHIR primitive type instant used as value of polymorphic elements
+type Instant string
+
+// Integer is the FHIR primitive type integer used as value of polymorphic elements
+type Integer int
+
+// Markdown is the FHIR primitive type markdown used as value of polymorphic elements
+type Markdown string
+
+// Oid is the FHIR primitive type oid used as value of polymorphic elements
+type Oid string
+
+// PositiveInt is the FHIR primitive type positiveInt used as value of polymorphic elements
+type PositiveInt int
+
+// String is the FHIR primitive type string used as value of polymorphic elements
+type String string
+
+// Time is the FHIR primitive type time used as value of polymorphic elements
+type Time string
+
+// UnsignedInt is the FHIR primitive type unsignedInt used as value of polymorphic elements
+type UnsignedInt int
+
+// Uri is the FHIR primitive type uri used as value of polymorphic elements
+type Uri string
+
+// Url is the FHIR primitive type url used as value of polymorphic elements
+type Url string
+
+// Uuid is the FHIR primitive type uuid used as value of polymorphic elements
+type Uuid string
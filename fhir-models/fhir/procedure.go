@@ -14,53 +14,130 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Procedure is documented here http://hl7.org/fhir/StructureDefinition/Procedure
 type Procedure struct {
-	Id                    *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string                `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string               `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string               `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference            `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf                []Reference            `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status                EventStatus            `bson:"status" json:"status"`
-	StatusReason          *CodeableConcept       `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	Category              *CodeableConcept       `bson:"category,omitempty" json:"category,omitempty"`
-	Code                  *CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
-	Subject               Reference              `bson:"subject" json:"subject"`
-	Encounter             *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	PerformedDateTime     *string                `bson:"performedDateTime,omitempty" json:"performedDateTime,omitempty"`
-	PerformedPeriod       *Period                `bson:"performedPeriod,omitempty" json:"performedPeriod,omitempty"`
-	PerformedString       *string                `bson:"performedString,omitempty" json:"performedString,omitempty"`
-	PerformedAge          *Age                   `bson:"performedAge,omitempty" json:"performedAge,omitempty"`
-	PerformedRange        *Range                 `bson:"performedRange,omitempty" json:"performedRange,omitempty"`
-	Recorder              *Reference             `bson:"recorder,omitempty" json:"recorder,omitempty"`
-	Asserter              *Reference             `bson:"asserter,omitempty" json:"asserter,omitempty"`
-	Performer             []ProcedurePerformer   `bson:"performer,omitempty" json:"performer,omitempty"`
-	Location              *Reference             `bson:"location,omitempty" json:"location,omitempty"`
-	ReasonCode            []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	BodySite              []CodeableConcept      `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Outcome               *CodeableConcept       `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	Report                []Reference            `bson:"report,omitempty" json:"report,omitempty"`
-	Complication          []CodeableConcept      `bson:"complication,omitempty" json:"complication,omitempty"`
-	ComplicationDetail    []Reference            `bson:"complicationDetail,omitempty" json:"complicationDetail,omitempty"`
-	FollowUp              []CodeableConcept      `bson:"followUp,omitempty" json:"followUp,omitempty"`
-	Note                  []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
-	FocalDevice           []ProcedureFocalDevice `bson:"focalDevice,omitempty" json:"focalDevice,omitempty"`
-	UsedReference         []Reference            `bson:"usedReference,omitempty" json:"usedReference,omitempty"`
-	UsedCode              []CodeableConcept      `bson:"usedCode,omitempty" json:"usedCode,omitempty"`
+	Id                           *string                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element               `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier           `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []string               `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element             `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string               `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element             `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference            `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                       []Reference            `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                       EventStatus            `bson:"status" json:"status"`
+	StatusElement                *Element               `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept       `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	Category                     *CodeableConcept       `bson:"category,omitempty" json:"category,omitempty"`
+	Code                         *CodeableConcept       `bson:"code,omitempty" json:"code,omitempty"`
+	Subject                      Reference              `bson:"subject" json:"subject"`
+	Encounter                    *Reference             `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	PerformedDateTime            *string                `bson:"performedDateTime,omitempty" json:"performedDateTime,omitempty"`
+	PerformedDateTimeElement     *Element               `bson:"_performedDateTime,omitempty" json:"_performedDateTime,omitempty"`
+	PerformedPeriod              *Period                `bson:"performedPeriod,omitempty" json:"performedPeriod,omitempty"`
+	PerformedString              *string                `bson:"performedString,omitempty" json:"performedString,omitempty"`
+	PerformedStringElement       *Element               `bson:"_performedString,omitempty" json:"_performedString,omitempty"`
+	PerformedAge                 *Age                   `bson:"performedAge,omitempty" json:"performedAge,omitempty"`
+	PerformedRange               *Range                 `bson:"performedRange,omitempty" json:"performedRange,omitempty"`
+	Recorder                     *Reference             `bson:"recorder,omitempty" json:"recorder,omitempty"`
+	Asserter                     *Reference             `bson:"asserter,omitempty" json:"asserter,omitempty"`
+	Performer                    []ProcedurePerformer   `bson:"performer,omitempty" json:"performer,omitempty"`
+	Location                     *Reference             `bson:"location,omitempty" json:"location,omitempty"`
+	ReasonCode                   []CodeableConcept      `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference            `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	BodySite                     []CodeableConcept      `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Outcome                      *CodeableConcept       `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	Report                       []Reference            `bson:"report,omitempty" json:"report,omitempty"`
+	Complication                 []CodeableConcept      `bson:"complication,omitempty" json:"complication,omitempty"`
+	ComplicationDetail           []Reference            `bson:"complicationDetail,omitempty" json:"complicationDetail,omitempty"`
+	FollowUp                     []CodeableConcept      `bson:"followUp,omitempty" json:"followUp,omitempty"`
+	Note                         []Annotation           `bson:"note,omitempty" json:"note,omitempty"`
+	FocalDevice                  []ProcedureFocalDevice `bson:"focalDevice,omitempty" json:"focalDevice,omitempty"`
+	UsedReference                []Reference            `bson:"usedReference,omitempty" json:"usedReference,omitempty"`
+	UsedCode                     []CodeableConcept      `bson:"usedCode,omitempty" json:"usedCode,omitempty"`
 }
+
+// ProcedurePerformed is implemented by the types allowed for Procedure.performed[x]
+type ProcedurePerformed interface {
+	isProcedurePerformed()
+}
+
+func (DateTime) isProcedurePerformed() {}
+func (Period) isProcedurePerformed()   {}
+func (String) isProcedurePerformed()   {}
+func (Age) isProcedurePerformed()      {}
+func (Range) isProcedurePerformed()    {}
+
+// Performed returns the value of Procedure.performed[x] together with its FHIR type code or nil if no value is set
+func (r Procedure) Performed() (ProcedurePerformed, string) {
+	if r.PerformedDateTime != nil {
+		return DateTime(*r.PerformedDateTime), "dateTime"
+	}
+	if r.PerformedPeriod != nil {
+		return *r.PerformedPeriod, "Period"
+	}
+	if r.PerformedString != nil {
+		return String(*r.PerformedString), "string"
+	}
+	if r.PerformedAge != nil {
+		return *r.PerformedAge, "Age"
+	}
+	if r.PerformedRange != nil {
+		return *r.PerformedRange, "Range"
+	}
+	return nil, ""
+}
+
+// SetPerformed sets the value of Procedure.performed[x], given as value or pointer, and clears all other types
+func (r *Procedure) SetPerformed(value ProcedurePerformed) {
+	r.PerformedDateTime = nil
+	r.PerformedPeriod = nil
+	r.PerformedString = nil
+	r.PerformedAge = nil
+	r.PerformedRange = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.PerformedDateTime = &primitive
+	case *DateTime:
+		r.PerformedDateTime = (*string)(v)
+	case Period:
+		r.PerformedPeriod = &v
+	case *Period:
+		r.PerformedPeriod = v
+	case String:
+		primitive := string(v)
+		r.PerformedString = &primitive
+	case *String:
+		r.PerformedString = (*string)(v)
+	case Age:
+		r.PerformedAge = &v
+	case *Age:
+		r.PerformedAge = v
+	case Range:
+		r.PerformedRange = &v
+	case *Range:
+		r.PerformedRange = v
+	}
+}
+
 type ProcedurePerformer struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -76,23 +153,3060 @@ type ProcedureFocalDevice struct {
 	Action            *CodeableConcept `bson:"action,omitempty" json:"action,omitempty"`
 	Manipulated       Reference        `bson:"manipulated" json:"manipulated"`
 }
-type OtherProcedure Procedure
 
 // MarshalJSON marshals the given Procedure as JSON into a byte slice
 func (r Procedure) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherProcedure
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherProcedure: OtherProcedure(r),
-		ResourceType:   "Procedure",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Procedure as JSON object with the members in the order of the fields
+func (r Procedure) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Procedure\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusReason != nil {
+		e.key("statusReason")
+		r.StatusReason.appendJSON(e)
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.PerformedDateTime != nil {
+		e.key("performedDateTime")
+		e.string(*r.PerformedDateTime)
+	}
+	if r.PerformedDateTimeElement != nil {
+		e.key("_performedDateTime")
+		r.PerformedDateTimeElement.appendJSON(e)
+	}
+	if r.PerformedPeriod != nil {
+		e.key("performedPeriod")
+		r.PerformedPeriod.appendJSON(e)
+	}
+	if r.PerformedString != nil {
+		e.key("performedString")
+		e.string(*r.PerformedString)
+	}
+	if r.PerformedStringElement != nil {
+		e.key("_performedString")
+		r.PerformedStringElement.appendJSON(e)
+	}
+	if r.PerformedAge != nil {
+		e.key("performedAge")
+		r.PerformedAge.appendJSON(e)
+	}
+	if r.PerformedRange != nil {
+		e.key("performedRange")
+		r.PerformedRange.appendJSON(e)
+	}
+	if r.Recorder != nil {
+		e.key("recorder")
+		r.Recorder.appendJSON(e)
+	}
+	if r.Asserter != nil {
+		e.key("asserter")
+		r.Asserter.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BodySite) > 0 {
+		e.key("bodySite")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BodySite {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Outcome != nil {
+		e.key("outcome")
+		r.Outcome.appendJSON(e)
+	}
+	if len(r.Report) > 0 {
+		e.key("report")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Report {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Complication) > 0 {
+		e.key("complication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Complication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ComplicationDetail) > 0 {
+		e.key("complicationDetail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ComplicationDetail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.FollowUp) > 0 {
+		e.key("followUp")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.FollowUp {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.FocalDevice) > 0 {
+		e.key("focalDevice")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.FocalDevice {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.UsedReference) > 0 {
+		e.key("usedReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UsedReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.UsedCode) > 0 {
+		e.key("usedCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UsedCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Procedure from JSON. Unknown members are ignored.
+func (r *Procedure) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Procedure
+func (r *Procedure) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			if d.null() {
+				r.StatusReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = &v
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "performedDateTime":
+			if v, ok := d.string(); ok {
+				r.PerformedDateTime = &v
+			} else {
+				r.PerformedDateTime = nil
+			}
+		case "_performedDateTime":
+			r.PerformedDateTimeElement = d.element()
+		case "performedPeriod":
+			if d.null() {
+				r.PerformedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.PerformedPeriod = &v
+			}
+		case "performedString":
+			if v, ok := d.string(); ok {
+				r.PerformedString = &v
+			} else {
+				r.PerformedString = nil
+			}
+		case "_performedString":
+			r.PerformedStringElement = d.element()
+		case "performedAge":
+			if d.null() {
+				r.PerformedAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.PerformedAge = &v
+			}
+		case "performedRange":
+			if d.null() {
+				r.PerformedRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.PerformedRange = &v
+			}
+		case "recorder":
+			if d.null() {
+				r.Recorder = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recorder = &v
+			}
+		case "asserter":
+			if d.null() {
+				r.Asserter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Asserter = &v
+			}
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v ProcedurePerformer
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []ProcedurePerformer{}
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "bodySite":
+			a := d.array()
+			r.BodySite = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = append(r.BodySite, v)
+			}
+			if r.BodySite == nil && !a.isNull {
+				r.BodySite = []CodeableConcept{}
+			}
+		case "outcome":
+			if d.null() {
+				r.Outcome = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Outcome = &v
+			}
+		case "report":
+			a := d.array()
+			r.Report = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Report = append(r.Report, v)
+			}
+			if r.Report == nil && !a.isNull {
+				r.Report = []Reference{}
+			}
+		case "complication":
+			a := d.array()
+			r.Complication = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Complication = append(r.Complication, v)
+			}
+			if r.Complication == nil && !a.isNull {
+				r.Complication = []CodeableConcept{}
+			}
+		case "complicationDetail":
+			a := d.array()
+			r.ComplicationDetail = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ComplicationDetail = append(r.ComplicationDetail, v)
+			}
+			if r.ComplicationDetail == nil && !a.isNull {
+				r.ComplicationDetail = []Reference{}
+			}
+		case "followUp":
+			a := d.array()
+			r.FollowUp = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FollowUp = append(r.FollowUp, v)
+			}
+			if r.FollowUp == nil && !a.isNull {
+				r.FollowUp = []CodeableConcept{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "focalDevice":
+			a := d.array()
+			r.FocalDevice = nil
+			for a.next() {
+				var v ProcedureFocalDevice
+				v.decodeJSON(d)
+				r.FocalDevice = append(r.FocalDevice, v)
+			}
+			if r.FocalDevice == nil && !a.isNull {
+				r.FocalDevice = []ProcedureFocalDevice{}
+			}
+		case "usedReference":
+			a := d.array()
+			r.UsedReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.UsedReference = append(r.UsedReference, v)
+			}
+			if r.UsedReference == nil && !a.isNull {
+				r.UsedReference = []Reference{}
+			}
+		case "usedCode":
+			a := d.array()
+			r.UsedCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.UsedCode = append(r.UsedCode, v)
+			}
+			if r.UsedCode == nil && !a.isNull {
+				r.UsedCode = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Procedure which shares no memory with the original
+func (r Procedure) DeepCopy() Procedure {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		v := r.StatusReason.DeepCopy()
+		out.StatusReason = &v
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.PerformedDateTime != nil {
+		v := *r.PerformedDateTime
+		out.PerformedDateTime = &v
+	}
+	if r.PerformedDateTimeElement != nil {
+		v := r.PerformedDateTimeElement.DeepCopy()
+		out.PerformedDateTimeElement = &v
+	}
+	if r.PerformedPeriod != nil {
+		v := r.PerformedPeriod.DeepCopy()
+		out.PerformedPeriod = &v
+	}
+	if r.PerformedString != nil {
+		v := *r.PerformedString
+		out.PerformedString = &v
+	}
+	if r.PerformedStringElement != nil {
+		v := r.PerformedStringElement.DeepCopy()
+		out.PerformedStringElement = &v
+	}
+	if r.PerformedAge != nil {
+		v := r.PerformedAge.DeepCopy()
+		out.PerformedAge = &v
+	}
+	if r.PerformedRange != nil {
+		v := r.PerformedRange.DeepCopy()
+		out.PerformedRange = &v
+	}
+	if r.Recorder != nil {
+		v := r.Recorder.DeepCopy()
+		out.Recorder = &v
+	}
+	if r.Asserter != nil {
+		v := r.Asserter.DeepCopy()
+		out.Asserter = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]ProcedurePerformer, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.BodySite != nil {
+		out.BodySite = make([]CodeableConcept, len(r.BodySite))
+		for i := range r.BodySite {
+			out.BodySite[i] = r.BodySite[i].DeepCopy()
+		}
+	}
+	if r.Outcome != nil {
+		v := r.Outcome.DeepCopy()
+		out.Outcome = &v
+	}
+	if r.Report != nil {
+		out.Report = make([]Reference, len(r.Report))
+		for i := range r.Report {
+			out.Report[i] = r.Report[i].DeepCopy()
+		}
+	}
+	if r.Complication != nil {
+		out.Complication = make([]CodeableConcept, len(r.Complication))
+		for i := range r.Complication {
+			out.Complication[i] = r.Complication[i].DeepCopy()
+		}
+	}
+	if r.ComplicationDetail != nil {
+		out.ComplicationDetail = make([]Reference, len(r.ComplicationDetail))
+		for i := range r.ComplicationDetail {
+			out.ComplicationDetail[i] = r.ComplicationDetail[i].DeepCopy()
+		}
+	}
+	if r.FollowUp != nil {
+		out.FollowUp = make([]CodeableConcept, len(r.FollowUp))
+		for i := range r.FollowUp {
+			out.FollowUp[i] = r.FollowUp[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.FocalDevice != nil {
+		out.FocalDevice = make([]ProcedureFocalDevice, len(r.FocalDevice))
+		for i := range r.FocalDevice {
+			out.FocalDevice[i] = r.FocalDevice[i].DeepCopy()
+		}
+	}
+	if r.UsedReference != nil {
+		out.UsedReference = make([]Reference, len(r.UsedReference))
+		for i := range r.UsedReference {
+			out.UsedReference[i] = r.UsedReference[i].DeepCopy()
+		}
+	}
+	if r.UsedCode != nil {
+		out.UsedCode = make([]CodeableConcept, len(r.UsedCode))
+		for i := range r.UsedCode {
+			out.UsedCode[i] = r.UsedCode[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Procedure) Equal(other Procedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.Equal(*other.StatusReason) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.PerformedDateTime == nil) != (other.PerformedDateTime == nil) || r.PerformedDateTime != nil && *r.PerformedDateTime != *other.PerformedDateTime {
+		return false
+	}
+	if (r.PerformedDateTimeElement == nil) != (other.PerformedDateTimeElement == nil) || r.PerformedDateTimeElement != nil && !r.PerformedDateTimeElement.Equal(*other.PerformedDateTimeElement) {
+		return false
+	}
+	if (r.PerformedPeriod == nil) != (other.PerformedPeriod == nil) || r.PerformedPeriod != nil && !r.PerformedPeriod.Equal(*other.PerformedPeriod) {
+		return false
+	}
+	if (r.PerformedString == nil) != (other.PerformedString == nil) || r.PerformedString != nil && *r.PerformedString != *other.PerformedString {
+		return false
+	}
+	if (r.PerformedStringElement == nil) != (other.PerformedStringElement == nil) || r.PerformedStringElement != nil && !r.PerformedStringElement.Equal(*other.PerformedStringElement) {
+		return false
+	}
+	if (r.PerformedAge == nil) != (other.PerformedAge == nil) || r.PerformedAge != nil && !r.PerformedAge.Equal(*other.PerformedAge) {
+		return false
+	}
+	if (r.PerformedRange == nil) != (other.PerformedRange == nil) || r.PerformedRange != nil && !r.PerformedRange.Equal(*other.PerformedRange) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.Equal(*other.Recorder) {
+		return false
+	}
+	if (r.Asserter == nil) != (other.Asserter == nil) || r.Asserter != nil && !r.Asserter.Equal(*other.Asserter) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.BodySite) != len(other.BodySite) {
+		return false
+	}
+	for i := range r.BodySite {
+		if !r.BodySite[i].Equal(other.BodySite[i]) {
+			return false
+		}
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && !r.Outcome.Equal(*other.Outcome) {
+		return false
+	}
+	if len(r.Report) != len(other.Report) {
+		return false
+	}
+	for i := range r.Report {
+		if !r.Report[i].Equal(other.Report[i]) {
+			return false
+		}
+	}
+	if len(r.Complication) != len(other.Complication) {
+		return false
+	}
+	for i := range r.Complication {
+		if !r.Complication[i].Equal(other.Complication[i]) {
+			return false
+		}
+	}
+	if len(r.ComplicationDetail) != len(other.ComplicationDetail) {
+		return false
+	}
+	for i := range r.ComplicationDetail {
+		if !r.ComplicationDetail[i].Equal(other.ComplicationDetail[i]) {
+			return false
+		}
+	}
+	if len(r.FollowUp) != len(other.FollowUp) {
+		return false
+	}
+	for i := range r.FollowUp {
+		if !r.FollowUp[i].Equal(other.FollowUp[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.FocalDevice) != len(other.FocalDevice) {
+		return false
+	}
+	for i := range r.FocalDevice {
+		if !r.FocalDevice[i].Equal(other.FocalDevice[i]) {
+			return false
+		}
+	}
+	if len(r.UsedReference) != len(other.UsedReference) {
+		return false
+	}
+	for i := range r.UsedReference {
+		if !r.UsedReference[i].Equal(other.UsedReference[i]) {
+			return false
+		}
+	}
+	if len(r.UsedCode) != len(other.UsedCode) {
+		return false
+	}
+	for i := range r.UsedCode {
+		if !r.UsedCode[i].Equal(other.UsedCode[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Procedure) EqualsDeep(other Procedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.EqualsDeep(*other.StatusReason) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.PerformedDateTime == nil) != (other.PerformedDateTime == nil) || r.PerformedDateTime != nil && !equivalentString(*r.PerformedDateTime, *other.PerformedDateTime) {
+		return false
+	}
+	if (r.PerformedDateTimeElement == nil) != (other.PerformedDateTimeElement == nil) || r.PerformedDateTimeElement != nil && !r.PerformedDateTimeElement.EqualsDeep(*other.PerformedDateTimeElement) {
+		return false
+	}
+	if (r.PerformedPeriod == nil) != (other.PerformedPeriod == nil) || r.PerformedPeriod != nil && !r.PerformedPeriod.EqualsDeep(*other.PerformedPeriod) {
+		return false
+	}
+	if (r.PerformedString == nil) != (other.PerformedString == nil) || r.PerformedString != nil && !equivalentString(*r.PerformedString, *other.PerformedString) {
+		return false
+	}
+	if (r.PerformedStringElement == nil) != (other.PerformedStringElement == nil) || r.PerformedStringElement != nil && !r.PerformedStringElement.EqualsDeep(*other.PerformedStringElement) {
+		return false
+	}
+	if (r.PerformedAge == nil) != (other.PerformedAge == nil) || r.PerformedAge != nil && !r.PerformedAge.EqualsDeep(*other.PerformedAge) {
+		return false
+	}
+	if (r.PerformedRange == nil) != (other.PerformedRange == nil) || r.PerformedRange != nil && !r.PerformedRange.EqualsDeep(*other.PerformedRange) {
+		return false
+	}
+	if (r.Recorder == nil) != (other.Recorder == nil) || r.Recorder != nil && !r.Recorder.EqualsDeep(*other.Recorder) {
+		return false
+	}
+	if (r.Asserter == nil) != (other.Asserter == nil) || r.Asserter != nil && !r.Asserter.EqualsDeep(*other.Asserter) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BodySite), len(other.BodySite), func(i, j int) bool {
+		return r.BodySite[i].EqualsDeep(other.BodySite[j])
+	}) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && !r.Outcome.EqualsDeep(*other.Outcome) {
+		return false
+	}
+	if !equivalentList(len(r.Report), len(other.Report), func(i, j int) bool {
+		return r.Report[i].EqualsDeep(other.Report[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Complication), len(other.Complication), func(i, j int) bool {
+		return r.Complication[i].EqualsDeep(other.Complication[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ComplicationDetail), len(other.ComplicationDetail), func(i, j int) bool {
+		return r.ComplicationDetail[i].EqualsDeep(other.ComplicationDetail[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.FollowUp), len(other.FollowUp), func(i, j int) bool {
+		return r.FollowUp[i].EqualsDeep(other.FollowUp[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.FocalDevice), len(other.FocalDevice), func(i, j int) bool {
+		return r.FocalDevice[i].EqualsDeep(other.FocalDevice[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.UsedReference), len(other.UsedReference), func(i, j int) bool {
+		return r.UsedReference[i].EqualsDeep(other.UsedReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.UsedCode), len(other.UsedCode), func(i, j int) bool {
+		return r.UsedCode[i].EqualsDeep(other.UsedCode[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Procedure) EqualsShallow(other Procedure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.PerformedDateTime == nil) != (other.PerformedDateTime == nil) || r.PerformedDateTime != nil && !equivalentString(*r.PerformedDateTime, *other.PerformedDateTime) {
+		return false
+	}
+	if (r.PerformedString == nil) != (other.PerformedString == nil) || r.PerformedString != nil && !equivalentString(*r.PerformedString, *other.PerformedString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Procedure as FHIR XML
+func (r Procedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Procedure",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReason", r.StatusReason); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "performedDateTime", r.PerformedDateTime, r.PerformedDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performedPeriod", r.PerformedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "performedString", r.PerformedString, r.PerformedStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performedAge", r.PerformedAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performedRange", r.PerformedRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "recorder", r.Recorder); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "asserter", r.Asserter); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BodySite {
+		if err := encodeXMLElement(e, "bodySite", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "outcome", r.Outcome); err != nil {
+		return err
+	}
+	for _, v := range r.Report {
+		if err := encodeXMLElement(e, "report", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Complication {
+		if err := encodeXMLElement(e, "complication", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ComplicationDetail {
+		if err := encodeXMLElement(e, "complicationDetail", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.FollowUp {
+		if err := encodeXMLElement(e, "followUp", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.FocalDevice {
+		if err := encodeXMLElement(e, "focalDevice", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.UsedReference {
+		if err := encodeXMLElement(e, "usedReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.UsedCode {
+		if err := encodeXMLElement(e, "usedCode", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Procedure from FHIR XML
+func (r *Procedure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v EventStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "performedDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PerformedDateTime = &v
+				}
+				r.PerformedDateTimeElement = element
+			case "performedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformedPeriod = &v
+			case "performedString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PerformedString = &v
+				}
+				r.PerformedStringElement = element
+			case "performedAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformedAge = &v
+			case "performedRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformedRange = &v
+			case "recorder":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recorder = &v
+			case "asserter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Asserter = &v
+			case "performer":
+				var v ProcedurePerformer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = append(r.BodySite, v)
+			case "outcome":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Outcome = &v
+			case "report":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Report = append(r.Report, v)
+			case "complication":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Complication = append(r.Complication, v)
+			case "complicationDetail":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ComplicationDetail = append(r.ComplicationDetail, v)
+			case "followUp":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FollowUp = append(r.FollowUp, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "focalDevice":
+				var v ProcedureFocalDevice
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FocalDevice = append(r.FocalDevice, v)
+			case "usedReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UsedReference = append(r.UsedReference, v)
+			case "usedCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UsedCode = append(r.UsedCode, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Procedure as properties to the RDF node n
+func (r Procedure) turtle(n *turtleNode) {
+	n.resource("Procedure", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Procedure.identifier", i, v)
+	}
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("Procedure.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("Procedure.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	for i, v := range r.BasedOn {
+		n.element("Procedure.basedOn", i, v)
+	}
+	for i, v := range r.PartOf {
+		n.element("Procedure.partOf", i, v)
+	}
+	n.primitive("Procedure.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Procedure.statusReason", -1, r.StatusReason)
+	n.element("Procedure.category", -1, r.Category)
+	n.element("Procedure.code", -1, r.Code)
+	n.element("Procedure.subject", -1, r.Subject)
+	n.element("Procedure.encounter", -1, r.Encounter)
+	n.primitive("Procedure.performedDateTime", -1, r.PerformedDateTime, r.PerformedDateTimeElement, "dateTime")
+	n.element("Procedure.performedPeriod", -1, r.PerformedPeriod)
+	n.primitive("Procedure.performedString", -1, r.PerformedString, r.PerformedStringElement, "string")
+	n.element("Procedure.performedAge", -1, r.PerformedAge)
+	n.element("Procedure.performedRange", -1, r.PerformedRange)
+	n.element("Procedure.recorder", -1, r.Recorder)
+	n.element("Procedure.asserter", -1, r.Asserter)
+	for i, v := range r.Performer {
+		n.element("Procedure.performer", i, v)
+	}
+	n.element("Procedure.location", -1, r.Location)
+	for i, v := range r.ReasonCode {
+		n.element("Procedure.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("Procedure.reasonReference", i, v)
+	}
+	for i, v := range r.BodySite {
+		n.element("Procedure.bodySite", i, v)
+	}
+	n.element("Procedure.outcome", -1, r.Outcome)
+	for i, v := range r.Report {
+		n.element("Procedure.report", i, v)
+	}
+	for i, v := range r.Complication {
+		n.element("Procedure.complication", i, v)
+	}
+	for i, v := range r.ComplicationDetail {
+		n.element("Procedure.complicationDetail", i, v)
+	}
+	for i, v := range r.FollowUp {
+		n.element("Procedure.followUp", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("Procedure.note", i, v)
+	}
+	for i, v := range r.FocalDevice {
+		n.element("Procedure.focalDevice", i, v)
+	}
+	for i, v := range r.UsedReference {
+		n.element("Procedure.usedReference", i, v)
+	}
+	for i, v := range r.UsedCode {
+		n.element("Procedure.usedCode", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Procedure as BSON document with the structure of its FHIR JSON
+func (r Procedure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Procedure from a BSON document with the structure of its FHIR JSON
+func (r *Procedure) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Procedure as protobuf message Procedure of fhir.proto
+func (r Procedure) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Procedure to the encoded message
+func (r Procedure) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(10, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(11, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(12, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(13, v)
+	}
+	e.varint(14, r.Status.protoNumber())
+	if r.StatusReason != nil {
+		e.message(15, *r.StatusReason)
+	}
+	if r.Category != nil {
+		e.message(16, *r.Category)
+	}
+	if r.Code != nil {
+		e.message(17, *r.Code)
+	}
+	e.message(18, r.Subject)
+	if r.Encounter != nil {
+		e.message(19, *r.Encounter)
+	}
+	if r.PerformedDateTime != nil {
+		e.string(20, *r.PerformedDateTime)
+	}
+	if r.PerformedPeriod != nil {
+		e.message(21, *r.PerformedPeriod)
+	}
+	if r.PerformedString != nil {
+		e.string(22, *r.PerformedString)
+	}
+	if r.PerformedAge != nil {
+		e.message(23, *r.PerformedAge)
+	}
+	if r.PerformedRange != nil {
+		e.message(24, *r.PerformedRange)
+	}
+	if r.Recorder != nil {
+		e.message(25, *r.Recorder)
+	}
+	if r.Asserter != nil {
+		e.message(26, *r.Asserter)
+	}
+	for _, v := range r.Performer {
+		e.message(27, v)
+	}
+	if r.Location != nil {
+		e.message(28, *r.Location)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(29, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(30, v)
+	}
+	for _, v := range r.BodySite {
+		e.message(31, v)
+	}
+	if r.Outcome != nil {
+		e.message(32, *r.Outcome)
+	}
+	for _, v := range r.Report {
+		e.message(33, v)
+	}
+	for _, v := range r.Complication {
+		e.message(34, v)
+	}
+	for _, v := range r.ComplicationDetail {
+		e.message(35, v)
+	}
+	for _, v := range r.FollowUp {
+		e.message(36, v)
+	}
+	for _, v := range r.Note {
+		e.message(37, v)
+	}
+	for _, v := range r.FocalDevice {
+		e.message(38, v)
+	}
+	for _, v := range r.UsedReference {
+		e.message(39, v)
+	}
+	for _, v := range r.UsedCode {
+		e.message(40, v)
+	}
+	if r.IdElement != nil {
+		e.message(41, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(42, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(43, *r.LanguageElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(44, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(45, v)
+	}
+	if r.StatusElement != nil {
+		e.message(46, *r.StatusElement)
+	}
+	if r.PerformedDateTimeElement != nil {
+		e.message(47, *r.PerformedDateTimeElement)
+	}
+	if r.PerformedStringElement != nil {
+		e.message(48, *r.PerformedStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Procedure from protobuf message Procedure of fhir.proto
+func (r *Procedure) UnmarshalProto(b []byte) error {
+	*r = Procedure{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 11:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 14:
+			var v EventStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 20:
+			v := d.string()
+			r.PerformedDateTime = &v
+		case 21:
+			var v Period
+			d.message(&v)
+			r.PerformedPeriod = &v
+		case 22:
+			v := d.string()
+			r.PerformedString = &v
+		case 23:
+			var v Age
+			d.message(&v)
+			r.PerformedAge = &v
+		case 24:
+			var v Range
+			d.message(&v)
+			r.PerformedRange = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Recorder = &v
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Asserter = &v
+		case 27:
+			var v ProcedurePerformer
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 29:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 30:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 31:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = append(r.BodySite, v)
+		case 32:
+			var v CodeableConcept
+			d.message(&v)
+			r.Outcome = &v
+		case 33:
+			var v Reference
+			d.message(&v)
+			r.Report = append(r.Report, v)
+		case 34:
+			var v CodeableConcept
+			d.message(&v)
+			r.Complication = append(r.Complication, v)
+		case 35:
+			var v Reference
+			d.message(&v)
+			r.ComplicationDetail = append(r.ComplicationDetail, v)
+		case 36:
+			var v CodeableConcept
+			d.message(&v)
+			r.FollowUp = append(r.FollowUp, v)
+		case 37:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 38:
+			var v ProcedureFocalDevice
+			d.message(&v)
+			r.FocalDevice = append(r.FocalDevice, v)
+		case 39:
+			var v Reference
+			d.message(&v)
+			r.UsedReference = append(r.UsedReference, v)
+		case 40:
+			var v CodeableConcept
+			d.message(&v)
+			r.UsedCode = append(r.UsedCode, v)
+		case 41:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 44:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 45:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		case 46:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.PerformedDateTimeElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.PerformedStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ProcedurePerformer as JSON into a byte slice
+func (r ProcedurePerformer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ProcedurePerformer as JSON object with the members in the order of the fields
+func (r ProcedurePerformer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Function != nil {
+		e.key("function")
+		r.Function.appendJSON(e)
+	}
+	e.key("actor")
+	r.Actor.appendJSON(e)
+	if r.OnBehalfOf != nil {
+		e.key("onBehalfOf")
+		r.OnBehalfOf.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ProcedurePerformer from JSON. Unknown members are ignored.
+func (r *ProcedurePerformer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ProcedurePerformer
+func (r *ProcedurePerformer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "function":
+			if d.null() {
+				r.Function = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Function = &v
+			}
+		case "actor":
+			r.Actor.decodeJSON(d)
+		case "onBehalfOf":
+			if d.null() {
+				r.OnBehalfOf = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.OnBehalfOf = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ProcedurePerformer which shares no memory with the original
+func (r ProcedurePerformer) DeepCopy() ProcedurePerformer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Function != nil {
+		v := r.Function.DeepCopy()
+		out.Function = &v
+	}
+	out.Actor = r.Actor.DeepCopy()
+	if r.OnBehalfOf != nil {
+		v := r.OnBehalfOf.DeepCopy()
+		out.OnBehalfOf = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ProcedurePerformer) Equal(other ProcedurePerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.Equal(*other.Function) {
+		return false
+	}
+	if !r.Actor.Equal(other.Actor) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.Equal(*other.OnBehalfOf) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ProcedurePerformer) EqualsDeep(other ProcedurePerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Function == nil) != (other.Function == nil) || r.Function != nil && !r.Function.EqualsDeep(*other.Function) {
+		return false
+	}
+	if !r.Actor.EqualsDeep(other.Actor) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.EqualsDeep(*other.OnBehalfOf) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ProcedurePerformer) EqualsShallow(other ProcedurePerformer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ProcedurePerformer as FHIR XML
+func (r ProcedurePerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "function", r.Function); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "actor", r.Actor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onBehalfOf", r.OnBehalfOf); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ProcedurePerformer from FHIR XML
+func (r *ProcedurePerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "function":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Function = &v
+			case "actor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Actor = v
+			case "onBehalfOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnBehalfOf = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ProcedurePerformer as properties to the RDF node n
+func (r ProcedurePerformer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Procedure.performer.function", -1, r.Function)
+	n.element("Procedure.performer.actor", -1, r.Actor)
+	n.element("Procedure.performer.onBehalfOf", -1, r.OnBehalfOf)
+}
+
+// MarshalBSON marshals the given ProcedurePerformer as BSON document with the structure of its FHIR JSON
+func (r ProcedurePerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ProcedurePerformer from a BSON document with the structure of its FHIR JSON
+func (r *ProcedurePerformer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ProcedurePerformer as protobuf message ProcedurePerformer of fhir.proto
+func (r ProcedurePerformer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ProcedurePerformer to the encoded message
+func (r ProcedurePerformer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Function != nil {
+		e.message(4, *r.Function)
+	}
+	e.message(5, r.Actor)
+	if r.OnBehalfOf != nil {
+		e.message(6, *r.OnBehalfOf)
+	}
+}
+
+// UnmarshalProto unmarshals the given ProcedurePerformer from protobuf message ProcedurePerformer of fhir.proto
+func (r *ProcedurePerformer) UnmarshalProto(b []byte) error {
+	*r = ProcedurePerformer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Function = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Actor = v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.OnBehalfOf = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ProcedureFocalDevice as JSON into a byte slice
+func (r ProcedureFocalDevice) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ProcedureFocalDevice as JSON object with the members in the order of the fields
+func (r ProcedureFocalDevice) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Action != nil {
+		e.key("action")
+		r.Action.appendJSON(e)
+	}
+	e.key("manipulated")
+	r.Manipulated.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ProcedureFocalDevice from JSON. Unknown members are ignored.
+func (r *ProcedureFocalDevice) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ProcedureFocalDevice
+func (r *ProcedureFocalDevice) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "action":
+			if d.null() {
+				r.Action = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Action = &v
+			}
+		case "manipulated":
+			r.Manipulated.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ProcedureFocalDevice which shares no memory with the original
+func (r ProcedureFocalDevice) DeepCopy() ProcedureFocalDevice {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		v := r.Action.DeepCopy()
+		out.Action = &v
+	}
+	out.Manipulated = r.Manipulated.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ProcedureFocalDevice) Equal(other ProcedureFocalDevice) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Action == nil) != (other.Action == nil) || r.Action != nil && !r.Action.Equal(*other.Action) {
+		return false
+	}
+	if !r.Manipulated.Equal(other.Manipulated) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ProcedureFocalDevice) EqualsDeep(other ProcedureFocalDevice) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Action == nil) != (other.Action == nil) || r.Action != nil && !r.Action.EqualsDeep(*other.Action) {
+		return false
+	}
+	if !r.Manipulated.EqualsDeep(other.Manipulated) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ProcedureFocalDevice) EqualsShallow(other ProcedureFocalDevice) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ProcedureFocalDevice as FHIR XML
+func (r ProcedureFocalDevice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "action", r.Action); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "manipulated", r.Manipulated); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ProcedureFocalDevice from FHIR XML
+func (r *ProcedureFocalDevice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "action":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = &v
+			case "manipulated":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Manipulated = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ProcedureFocalDevice as properties to the RDF node n
+func (r ProcedureFocalDevice) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Procedure.focalDevice.action", -1, r.Action)
+	n.element("Procedure.focalDevice.manipulated", -1, r.Manipulated)
+}
+
+// MarshalBSON marshals the given ProcedureFocalDevice as BSON document with the structure of its FHIR JSON
+func (r ProcedureFocalDevice) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ProcedureFocalDevice from a BSON document with the structure of its FHIR JSON
+func (r *ProcedureFocalDevice) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ProcedureFocalDevice as protobuf message ProcedureFocalDevice of fhir.proto
+func (r ProcedureFocalDevice) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ProcedureFocalDevice to the encoded message
+func (r ProcedureFocalDevice) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Action != nil {
+		e.message(4, *r.Action)
+	}
+	e.message(5, r.Manipulated)
+}
+
+// UnmarshalProto unmarshals the given ProcedureFocalDevice from protobuf message ProcedureFocalDevice of fhir.proto
+func (r *ProcedureFocalDevice) UnmarshalProto(b []byte) error {
+	*r = ProcedureFocalDevice{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Action = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Manipulated = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalProcedure unmarshals a Procedure.
 func UnmarshalProcedure(b []byte) (Procedure, error) {
 	var procedure Procedure
-	if err := json.Unmarshal(b, &procedure); err != nil {
+	if err := procedure.UnmarshalJSON(b); err != nil {
 		return procedure, err
 	}
 	return procedure, nil
@@ -14,6 +14,8 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
@@ -29,8 +31,883 @@ type ProdCharacteristic struct {
 	NominalVolume     *Quantity        `bson:"nominalVolume,omitempty" json:"nominalVolume,omitempty"`
 	ExternalDiameter  *Quantity        `bson:"externalDiameter,omitempty" json:"externalDiameter,omitempty"`
 	Shape             *string          `bson:"shape,omitempty" json:"shape,omitempty"`
+	ShapeElement      *Element         `bson:"_shape,omitempty" json:"_shape,omitempty"`
 	Color             []string         `bson:"color,omitempty" json:"color,omitempty"`
+	ColorElement      []*Element       `bson:"_color,omitempty" json:"_color,omitempty"`
 	Imprint           []string         `bson:"imprint,omitempty" json:"imprint,omitempty"`
+	ImprintElement    []*Element       `bson:"_imprint,omitempty" json:"_imprint,omitempty"`
 	Image             []Attachment     `bson:"image,omitempty" json:"image,omitempty"`
 	Scoring           *CodeableConcept `bson:"scoring,omitempty" json:"scoring,omitempty"`
 }
+
+// MarshalJSON marshals the given ProdCharacteristic as JSON into a byte slice
+func (r ProdCharacteristic) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ProdCharacteristic as JSON object with the members in the order of the fields
+func (r ProdCharacteristic) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Height != nil {
+		e.key("height")
+		r.Height.appendJSON(e)
+	}
+	if r.Width != nil {
+		e.key("width")
+		r.Width.appendJSON(e)
+	}
+	if r.Depth != nil {
+		e.key("depth")
+		r.Depth.appendJSON(e)
+	}
+	if r.Weight != nil {
+		e.key("weight")
+		r.Weight.appendJSON(e)
+	}
+	if r.NominalVolume != nil {
+		e.key("nominalVolume")
+		r.NominalVolume.appendJSON(e)
+	}
+	if r.ExternalDiameter != nil {
+		e.key("externalDiameter")
+		r.ExternalDiameter.appendJSON(e)
+	}
+	if r.Shape != nil {
+		e.key("shape")
+		e.string(*r.Shape)
+	}
+	if r.ShapeElement != nil {
+		e.key("_shape")
+		r.ShapeElement.appendJSON(e)
+	}
+	if len(r.Color) > 0 {
+		e.key("color")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Color {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ColorElement) > 0 {
+		e.key("_color")
+		e.elements(r.ColorElement, len(r.Color))
+	}
+	if len(r.Imprint) > 0 {
+		e.key("imprint")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Imprint {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ImprintElement) > 0 {
+		e.key("_imprint")
+		e.elements(r.ImprintElement, len(r.Imprint))
+	}
+	if len(r.Image) > 0 {
+		e.key("image")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Image {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Scoring != nil {
+		e.key("scoring")
+		r.Scoring.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ProdCharacteristic from JSON. Unknown members are ignored.
+func (r *ProdCharacteristic) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ProdCharacteristic
+func (r *ProdCharacteristic) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "height":
+			if d.null() {
+				r.Height = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Height = &v
+			}
+		case "width":
+			if d.null() {
+				r.Width = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Width = &v
+			}
+		case "depth":
+			if d.null() {
+				r.Depth = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Depth = &v
+			}
+		case "weight":
+			if d.null() {
+				r.Weight = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Weight = &v
+			}
+		case "nominalVolume":
+			if d.null() {
+				r.NominalVolume = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.NominalVolume = &v
+			}
+		case "externalDiameter":
+			if d.null() {
+				r.ExternalDiameter = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ExternalDiameter = &v
+			}
+		case "shape":
+			if v, ok := d.string(); ok {
+				r.Shape = &v
+			} else {
+				r.Shape = nil
+			}
+		case "_shape":
+			r.ShapeElement = d.element()
+		case "color":
+			a := d.array()
+			r.Color = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Color = append(r.Color, v)
+			}
+			if r.Color == nil && !a.isNull {
+				r.Color = []string{}
+			}
+		case "_color":
+			a := d.array()
+			r.ColorElement = nil
+			for a.next() {
+				r.ColorElement = append(r.ColorElement, d.element())
+			}
+		case "imprint":
+			a := d.array()
+			r.Imprint = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Imprint = append(r.Imprint, v)
+			}
+			if r.Imprint == nil && !a.isNull {
+				r.Imprint = []string{}
+			}
+		case "_imprint":
+			a := d.array()
+			r.ImprintElement = nil
+			for a.next() {
+				r.ImprintElement = append(r.ImprintElement, d.element())
+			}
+		case "image":
+			a := d.array()
+			r.Image = nil
+			for a.next() {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Image = append(r.Image, v)
+			}
+			if r.Image == nil && !a.isNull {
+				r.Image = []Attachment{}
+			}
+		case "scoring":
+			if d.null() {
+				r.Scoring = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Scoring = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ProdCharacteristic which shares no memory with the original
+func (r ProdCharacteristic) DeepCopy() ProdCharacteristic {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Height != nil {
+		v := r.Height.DeepCopy()
+		out.Height = &v
+	}
+	if r.Width != nil {
+		v := r.Width.DeepCopy()
+		out.Width = &v
+	}
+	if r.Depth != nil {
+		v := r.Depth.DeepCopy()
+		out.Depth = &v
+	}
+	if r.Weight != nil {
+		v := r.Weight.DeepCopy()
+		out.Weight = &v
+	}
+	if r.NominalVolume != nil {
+		v := r.NominalVolume.DeepCopy()
+		out.NominalVolume = &v
+	}
+	if r.ExternalDiameter != nil {
+		v := r.ExternalDiameter.DeepCopy()
+		out.ExternalDiameter = &v
+	}
+	if r.Shape != nil {
+		v := *r.Shape
+		out.Shape = &v
+	}
+	if r.ShapeElement != nil {
+		v := r.ShapeElement.DeepCopy()
+		out.ShapeElement = &v
+	}
+	if r.Color != nil {
+		out.Color = make([]string, len(r.Color))
+		copy(out.Color, r.Color)
+	}
+	if r.ColorElement != nil {
+		out.ColorElement = make([]*Element, len(r.ColorElement))
+		for i, v := range r.ColorElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ColorElement[i] = &c
+			}
+		}
+	}
+	if r.Imprint != nil {
+		out.Imprint = make([]string, len(r.Imprint))
+		copy(out.Imprint, r.Imprint)
+	}
+	if r.ImprintElement != nil {
+		out.ImprintElement = make([]*Element, len(r.ImprintElement))
+		for i, v := range r.ImprintElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ImprintElement[i] = &c
+			}
+		}
+	}
+	if r.Image != nil {
+		out.Image = make([]Attachment, len(r.Image))
+		for i := range r.Image {
+			out.Image[i] = r.Image[i].DeepCopy()
+		}
+	}
+	if r.Scoring != nil {
+		v := r.Scoring.DeepCopy()
+		out.Scoring = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ProdCharacteristic) Equal(other ProdCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Height == nil) != (other.Height == nil) || r.Height != nil && !r.Height.Equal(*other.Height) {
+		return false
+	}
+	if (r.Width == nil) != (other.Width == nil) || r.Width != nil && !r.Width.Equal(*other.Width) {
+		return false
+	}
+	if (r.Depth == nil) != (other.Depth == nil) || r.Depth != nil && !r.Depth.Equal(*other.Depth) {
+		return false
+	}
+	if (r.Weight == nil) != (other.Weight == nil) || r.Weight != nil && !r.Weight.Equal(*other.Weight) {
+		return false
+	}
+	if (r.NominalVolume == nil) != (other.NominalVolume == nil) || r.NominalVolume != nil && !r.NominalVolume.Equal(*other.NominalVolume) {
+		return false
+	}
+	if (r.ExternalDiameter == nil) != (other.ExternalDiameter == nil) || r.ExternalDiameter != nil && !r.ExternalDiameter.Equal(*other.ExternalDiameter) {
+		return false
+	}
+	if (r.Shape == nil) != (other.Shape == nil) || r.Shape != nil && *r.Shape != *other.Shape {
+		return false
+	}
+	if (r.ShapeElement == nil) != (other.ShapeElement == nil) || r.ShapeElement != nil && !r.ShapeElement.Equal(*other.ShapeElement) {
+		return false
+	}
+	if len(r.Color) != len(other.Color) {
+		return false
+	}
+	for i := range r.Color {
+		if r.Color[i] != other.Color[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ColorElement, other.ColorElement, false) {
+		return false
+	}
+	if len(r.Imprint) != len(other.Imprint) {
+		return false
+	}
+	for i := range r.Imprint {
+		if r.Imprint[i] != other.Imprint[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ImprintElement, other.ImprintElement, false) {
+		return false
+	}
+	if len(r.Image) != len(other.Image) {
+		return false
+	}
+	for i := range r.Image {
+		if !r.Image[i].Equal(other.Image[i]) {
+			return false
+		}
+	}
+	if (r.Scoring == nil) != (other.Scoring == nil) || r.Scoring != nil && !r.Scoring.Equal(*other.Scoring) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ProdCharacteristic) EqualsDeep(other ProdCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Height == nil) != (other.Height == nil) || r.Height != nil && !r.Height.EqualsDeep(*other.Height) {
+		return false
+	}
+	if (r.Width == nil) != (other.Width == nil) || r.Width != nil && !r.Width.EqualsDeep(*other.Width) {
+		return false
+	}
+	if (r.Depth == nil) != (other.Depth == nil) || r.Depth != nil && !r.Depth.EqualsDeep(*other.Depth) {
+		return false
+	}
+	if (r.Weight == nil) != (other.Weight == nil) || r.Weight != nil && !r.Weight.EqualsDeep(*other.Weight) {
+		return false
+	}
+	if (r.NominalVolume == nil) != (other.NominalVolume == nil) || r.NominalVolume != nil && !r.NominalVolume.EqualsDeep(*other.NominalVolume) {
+		return false
+	}
+	if (r.ExternalDiameter == nil) != (other.ExternalDiameter == nil) || r.ExternalDiameter != nil && !r.ExternalDiameter.EqualsDeep(*other.ExternalDiameter) {
+		return false
+	}
+	if (r.Shape == nil) != (other.Shape == nil) || r.Shape != nil && !equivalentString(*r.Shape, *other.Shape) {
+		return false
+	}
+	if (r.ShapeElement == nil) != (other.ShapeElement == nil) || r.ShapeElement != nil && !r.ShapeElement.EqualsDeep(*other.ShapeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Color), len(other.Color), func(i, j int) bool {
+		return equivalentString(r.Color[i], other.Color[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ColorElement, other.ColorElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Imprint), len(other.Imprint), func(i, j int) bool {
+		return equivalentString(r.Imprint[i], other.Imprint[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ImprintElement, other.ImprintElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Image), len(other.Image), func(i, j int) bool {
+		return r.Image[i].EqualsDeep(other.Image[j])
+	}) {
+		return false
+	}
+	if (r.Scoring == nil) != (other.Scoring == nil) || r.Scoring != nil && !r.Scoring.EqualsDeep(*other.Scoring) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ProdCharacteristic) EqualsShallow(other ProdCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Shape == nil) != (other.Shape == nil) || r.Shape != nil && !equivalentString(*r.Shape, *other.Shape) {
+		return false
+	}
+	if !equivalentList(len(r.Color), len(other.Color), func(i, j int) bool {
+		return equivalentString(r.Color[i], other.Color[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Imprint), len(other.Imprint), func(i, j int) bool {
+		return equivalentString(r.Imprint[i], other.Imprint[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ProdCharacteristic as FHIR XML
+func (r ProdCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "height", r.Height); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "width", r.Width); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "depth", r.Depth); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "weight", r.Weight); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "nominalVolume", r.NominalVolume); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "externalDiameter", r.ExternalDiameter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "shape", r.Shape, r.ShapeElement); err != nil {
+		return err
+	}
+	for i, v := range r.Color {
+		if err := encodeXMLPrimitive(e, "color", v, elementAt(r.ColorElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Imprint {
+		if err := encodeXMLPrimitive(e, "imprint", v, elementAt(r.ImprintElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Image {
+		if err := encodeXMLElement(e, "image", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "scoring", r.Scoring); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ProdCharacteristic from FHIR XML
+func (r *ProdCharacteristic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "height":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Height = &v
+			case "width":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Width = &v
+			case "depth":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Depth = &v
+			case "weight":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Weight = &v
+			case "nominalVolume":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.NominalVolume = &v
+			case "externalDiameter":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ExternalDiameter = &v
+			case "shape":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Shape = &v
+				}
+				r.ShapeElement = element
+			case "color":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ColorElement = appendElement(r.ColorElement, len(r.Color), element)
+					r.Color = append(r.Color, v)
+				}
+			case "imprint":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ImprintElement = appendElement(r.ImprintElement, len(r.Imprint), element)
+					r.Imprint = append(r.Imprint, v)
+				}
+			case "image":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Image = append(r.Image, v)
+			case "scoring":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Scoring = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ProdCharacteristic as properties to the RDF node n
+func (r ProdCharacteristic) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ProdCharacteristic.height", -1, r.Height)
+	n.element("ProdCharacteristic.width", -1, r.Width)
+	n.element("ProdCharacteristic.depth", -1, r.Depth)
+	n.element("ProdCharacteristic.weight", -1, r.Weight)
+	n.element("ProdCharacteristic.nominalVolume", -1, r.NominalVolume)
+	n.element("ProdCharacteristic.externalDiameter", -1, r.ExternalDiameter)
+	n.primitive("ProdCharacteristic.shape", -1, r.Shape, r.ShapeElement, "string")
+	for i, v := range r.Color {
+		n.primitive("ProdCharacteristic.color", i, v, elementAt(r.ColorElement, i), "string")
+	}
+	for i, v := range r.Imprint {
+		n.primitive("ProdCharacteristic.imprint", i, v, elementAt(r.ImprintElement, i), "string")
+	}
+	for i, v := range r.Image {
+		n.element("ProdCharacteristic.image", i, v)
+	}
+	n.element("ProdCharacteristic.scoring", -1, r.Scoring)
+}
+
+// MarshalBSON marshals the given ProdCharacteristic as BSON document with the structure of its FHIR JSON
+func (r ProdCharacteristic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ProdCharacteristic from a BSON document with the structure of its FHIR JSON
+func (r *ProdCharacteristic) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ProdCharacteristic as protobuf message ProdCharacteristic of fhir.proto
+func (r ProdCharacteristic) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ProdCharacteristic to the encoded message
+func (r ProdCharacteristic) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Height != nil {
+		e.message(4, *r.Height)
+	}
+	if r.Width != nil {
+		e.message(5, *r.Width)
+	}
+	if r.Depth != nil {
+		e.message(6, *r.Depth)
+	}
+	if r.Weight != nil {
+		e.message(7, *r.Weight)
+	}
+	if r.NominalVolume != nil {
+		e.message(8, *r.NominalVolume)
+	}
+	if r.ExternalDiameter != nil {
+		e.message(9, *r.ExternalDiameter)
+	}
+	if r.Shape != nil {
+		e.string(10, *r.Shape)
+	}
+	for _, v := range r.Color {
+		e.string(11, v)
+	}
+	for _, v := range r.Imprint {
+		e.string(12, v)
+	}
+	for _, v := range r.Image {
+		e.message(13, v)
+	}
+	if r.Scoring != nil {
+		e.message(14, *r.Scoring)
+	}
+	if r.ShapeElement != nil {
+		e.message(15, *r.ShapeElement)
+	}
+	for _, v := range r.ColorElement {
+		e.element(16, v)
+	}
+	for _, v := range r.ImprintElement {
+		e.element(17, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ProdCharacteristic from protobuf message ProdCharacteristic of fhir.proto
+func (r *ProdCharacteristic) UnmarshalProto(b []byte) error {
+	*r = ProdCharacteristic{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.Height = &v
+		case 5:
+			var v Quantity
+			d.message(&v)
+			r.Width = &v
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.Depth = &v
+		case 7:
+			var v Quantity
+			d.message(&v)
+			r.Weight = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.NominalVolume = &v
+		case 9:
+			var v Quantity
+			d.message(&v)
+			r.ExternalDiameter = &v
+		case 10:
+			v := d.string()
+			r.Shape = &v
+		case 11:
+			r.Color = append(r.Color, d.string())
+		case 12:
+			r.Imprint = append(r.Imprint, d.string())
+		case 13:
+			var v Attachment
+			d.message(&v)
+			r.Image = append(r.Image, v)
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Scoring = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ShapeElement = &v
+		case 16:
+			r.ColorElement = append(r.ColorElement, d.element())
+		case 17:
+			r.ImprintElement = append(r.ImprintElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
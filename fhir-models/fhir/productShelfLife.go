@@ -14,6 +14,8 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
@@ -27,3 +29,439 @@ type ProductShelfLife struct {
 	Period                       Quantity          `bson:"period" json:"period"`
 	SpecialPrecautionsForStorage []CodeableConcept `bson:"specialPrecautionsForStorage,omitempty" json:"specialPrecautionsForStorage,omitempty"`
 }
+
+// MarshalJSON marshals the given ProductShelfLife as JSON into a byte slice
+func (r ProductShelfLife) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ProductShelfLife as JSON object with the members in the order of the fields
+func (r ProductShelfLife) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	e.key("period")
+	r.Period.appendJSON(e)
+	if len(r.SpecialPrecautionsForStorage) > 0 {
+		e.key("specialPrecautionsForStorage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SpecialPrecautionsForStorage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ProductShelfLife from JSON. Unknown members are ignored.
+func (r *ProductShelfLife) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ProductShelfLife
+func (r *ProductShelfLife) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "period":
+			r.Period.decodeJSON(d)
+		case "specialPrecautionsForStorage":
+			a := d.array()
+			r.SpecialPrecautionsForStorage = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SpecialPrecautionsForStorage = append(r.SpecialPrecautionsForStorage, v)
+			}
+			if r.SpecialPrecautionsForStorage == nil && !a.isNull {
+				r.SpecialPrecautionsForStorage = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ProductShelfLife which shares no memory with the original
+func (r ProductShelfLife) DeepCopy() ProductShelfLife {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	out.Type = r.Type.DeepCopy()
+	out.Period = r.Period.DeepCopy()
+	if r.SpecialPrecautionsForStorage != nil {
+		out.SpecialPrecautionsForStorage = make([]CodeableConcept, len(r.SpecialPrecautionsForStorage))
+		for i := range r.SpecialPrecautionsForStorage {
+			out.SpecialPrecautionsForStorage[i] = r.SpecialPrecautionsForStorage[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ProductShelfLife) Equal(other ProductShelfLife) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if !r.Period.Equal(other.Period) {
+		return false
+	}
+	if len(r.SpecialPrecautionsForStorage) != len(other.SpecialPrecautionsForStorage) {
+		return false
+	}
+	for i := range r.SpecialPrecautionsForStorage {
+		if !r.SpecialPrecautionsForStorage[i].Equal(other.SpecialPrecautionsForStorage[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ProductShelfLife) EqualsDeep(other ProductShelfLife) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if !r.Period.EqualsDeep(other.Period) {
+		return false
+	}
+	if !equivalentList(len(r.SpecialPrecautionsForStorage), len(other.SpecialPrecautionsForStorage), func(i, j int) bool {
+		return r.SpecialPrecautionsForStorage[i].EqualsDeep(other.SpecialPrecautionsForStorage[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ProductShelfLife) EqualsShallow(other ProductShelfLife) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ProductShelfLife as FHIR XML
+func (r ProductShelfLife) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	for _, v := range r.SpecialPrecautionsForStorage {
+		if err := encodeXMLElement(e, "specialPrecautionsForStorage", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ProductShelfLife from FHIR XML
+func (r *ProductShelfLife) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "period":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = v
+			case "specialPrecautionsForStorage":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SpecialPrecautionsForStorage = append(r.SpecialPrecautionsForStorage, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ProductShelfLife as properties to the RDF node n
+func (r ProductShelfLife) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ProductShelfLife.identifier", -1, r.Identifier)
+	n.element("ProductShelfLife.type", -1, r.Type)
+	n.element("ProductShelfLife.period", -1, r.Period)
+	for i, v := range r.SpecialPrecautionsForStorage {
+		n.element("ProductShelfLife.specialPrecautionsForStorage", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ProductShelfLife as BSON document with the structure of its FHIR JSON
+func (r ProductShelfLife) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ProductShelfLife from a BSON document with the structure of its FHIR JSON
+func (r *ProductShelfLife) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ProductShelfLife as protobuf message ProductShelfLife of fhir.proto
+func (r ProductShelfLife) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ProductShelfLife to the encoded message
+func (r ProductShelfLife) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identifier != nil {
+		e.message(4, *r.Identifier)
+	}
+	e.message(5, r.Type)
+	e.message(6, r.Period)
+	for _, v := range r.SpecialPrecautionsForStorage {
+		e.message(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given ProductShelfLife from protobuf message ProductShelfLife of fhir.proto
+func (r *ProductShelfLife) UnmarshalProto(b []byte) error {
+	*r = ProductShelfLife{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.Period = v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.SpecialPrecautionsForStorage = append(r.SpecialPrecautionsForStorage, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
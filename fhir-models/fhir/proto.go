@@ -0,0 +1,294 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+)
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// The wire types of the protobuf encoding, see https://protobuf.dev/programming-guides/encoding/
+const (
+	protoWireVarint  = 0
+	protoWireFixed64 = 1
+	protoWireBytes   = 2
+	protoWireFixed32 = 5
+)
+
+// protoResourceTypes maps the field numbers of the ContainedResource message to resource types.
+var protoResourceTypes = func() map[int]string {
+	resourceTypes := make(map[int]string, len(protoResourceNumbers))
+	for resourceType, number := range protoResourceNumbers {
+		resourceTypes[number] = resourceType
+	}
+	return resourceTypes
+}()
+
+type protoAppender interface {
+	appendProto(e *protoEncoder)
+}
+
+type protoUnmarshaler interface {
+	UnmarshalProto(b []byte) error
+}
+
+// protoEncoder appends the fields of a message to buf and keeps the first error.
+type protoEncoder struct {
+	buf []byte
+	err error
+}
+
+func protoBool(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (e *protoEncoder) appendVarint(v uint64) {
+	e.buf = binary.AppendUvarint(e.buf, v)
+}
+
+func (e *protoEncoder) tag(field int, wireType int) {
+	e.appendVarint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (e *protoEncoder) varint(field int, v uint64) {
+	e.tag(field, protoWireVarint)
+	e.appendVarint(v)
+}
+
+func (e *protoEncoder) bytes(field int, b []byte) {
+	e.tag(field, protoWireBytes)
+	e.appendVarint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *protoEncoder) string(field int, s string) {
+	e.tag(field, protoWireBytes)
+	e.appendVarint(uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *protoEncoder) message(field int, m protoAppender) {
+	var sub protoEncoder
+	m.appendProto(&sub)
+	if sub.err != nil && e.err == nil {
+		e.err = sub.err
+	}
+	e.bytes(field, sub.buf)
+}
+
+// element encodes the id and extensions of an item of a primitive list, which are nil for items without them, as
+// Element message, which is empty for nil.
+func (e *protoEncoder) element(field int, element *Element) {
+	if element == nil {
+		e.bytes(field, nil)
+		return
+	}
+	e.message(field, element)
+}
+
+// resource encodes a contained or inline resource held as JSON as ContainedResource message.
+func (e *protoEncoder) resource(field int, raw json.RawMessage) {
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		e.err = err
+		return
+	}
+	resource, ok := newResource(header.ResourceType)
+	if !ok {
+		e.err = fmt.Errorf("unknown resource type `%s`", header.ResourceType)
+		return
+	}
+	if err := json.Unmarshal(raw, resource); err != nil {
+		e.err = err
+		return
+	}
+	var sub protoEncoder
+	sub.message(protoResourceNumbers[header.ResourceType], resource.(protoAppender))
+	if sub.err != nil && e.err == nil {
+		e.err = sub.err
+	}
+	e.bytes(field, sub.buf)
+}
+
+// protoDecoder reads the fields of a message. After next returned true, the value of the field has to be read or
+// skipped.
+type protoDecoder struct {
+	buf      []byte
+	field    int
+	wireType int
+	err      error
+}
+
+func (d *protoDecoder) readVarint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.fail("invalid varint")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *protoDecoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf("protobuf field %d: "+format, append([]interface{}{d.field}, args...)...)
+	}
+	d.buf = nil
+}
+
+func (d *protoDecoder) next() bool {
+	if d.err != nil || len(d.buf) == 0 {
+		return false
+	}
+	tag := d.readVarint()
+	d.field, d.wireType = int(tag>>3), int(tag&7)
+	return d.err == nil
+}
+
+func (d *protoDecoder) expect(wireType int) bool {
+	if d.wireType != wireType {
+		d.fail("unexpected wire type %d", d.wireType)
+		return false
+	}
+	return true
+}
+
+func (d *protoDecoder) varint() uint64 {
+	if !d.expect(protoWireVarint) {
+		return 0
+	}
+	return d.readVarint()
+}
+
+func (d *protoDecoder) bool() bool {
+	return d.varint() != 0
+}
+
+func (d *protoDecoder) int() int {
+	return int(int32(d.varint()))
+}
+
+// enum sets an enum to the value with the given number using its setProtoNumber method.
+func (d *protoDecoder) enum(number uint64, set func(number uint64) bool) {
+	if !set(number) {
+		d.fail("unknown enum value %d", number)
+	}
+}
+
+// varints reads the values of a repeated scalar, which may be packed or not.
+func (d *protoDecoder) varints() []uint64 {
+	if d.wireType == protoWireVarint {
+		return []uint64{d.readVarint()}
+	}
+	packed := protoDecoder{buf: d.bytes(), field: d.field}
+	var values []uint64
+	for len(packed.buf) > 0 && packed.err == nil {
+		values = append(values, packed.readVarint())
+	}
+	if packed.err != nil && d.err == nil {
+		d.err = packed.err
+	}
+	return values
+}
+
+func (d *protoDecoder) bytes() []byte {
+	if !d.expect(protoWireBytes) {
+		return nil
+	}
+	n := d.readVarint()
+	if n > uint64(len(d.buf)) {
+		d.fail("truncated value")
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *protoDecoder) string() string {
+	return string(d.bytes())
+}
+
+func (d *protoDecoder) message(m protoUnmarshaler) {
+	b := d.bytes()
+	if d.err != nil {
+		return
+	}
+	if err := m.UnmarshalProto(b); err != nil {
+		d.err = err
+	}
+}
+
+// element decodes the id and extensions of an item of a primitive list, returning nil for an empty Element message.
+func (d *protoDecoder) element() *Element {
+	var element Element
+	d.message(&element)
+	if element.Id == nil && len(element.Extension) == 0 {
+		return nil
+	}
+	return &element
+}
+
+// resource decodes a ContainedResource message and returns the resource as JSON.
+func (d *protoDecoder) resource() json.RawMessage {
+	contained := protoDecoder{buf: d.bytes()}
+	var raw json.RawMessage
+	for contained.next() {
+		resourceType, ok := protoResourceTypes[contained.field]
+		if !ok {
+			contained.skip()
+			continue
+		}
+		resource, _ := newResource(resourceType)
+		contained.message(resource.(protoUnmarshaler))
+		if contained.err == nil {
+			raw, contained.err = json.Marshal(resource)
+		}
+	}
+	if contained.err != nil && d.err == nil {
+		d.err = contained.err
+	}
+	return raw
+}
+
+func (d *protoDecoder) skip() {
+	switch d.wireType {
+	case protoWireVarint:
+		d.readVarint()
+	case protoWireBytes:
+		d.bytes()
+	case protoWireFixed64, protoWireFixed32:
+		n := 8
+		if d.wireType == protoWireFixed32 {
+			n = 4
+		}
+		if len(d.buf) < n {
+			d.fail("truncated value")
+			return
+		}
+		d.buf = d.buf[n:]
+	default:
+		d.fail("unsupported wire type %d", d.wireType)
+	}
+}
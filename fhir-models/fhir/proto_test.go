@@ -0,0 +1,141 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestProtoRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+	}{
+		{"enums", `{"resourceType":"Patient","gender":"female","contact":[{"gender":"other"}],"link":[{"other":{"reference":"Patient/1"},"type":"seealso"}]}`},
+		{"choice types", `{"resourceType":"Patient","deceasedDateTime":"2020-01-01","multipleBirthInteger":2}`},
+		{"required enum and decimal", `{"resourceType":"Observation","status":"final","code":{"text":"t"},"valueQuantity":{"value":1.50,"comparator":"<"}}`},
+		{"repeated enums", `{"resourceType":"CapabilityStatement","status":"active","date":"2022","kind":"instance","fhirVersion":"4.0.1","format":["json"],"rest":[{"mode":"server","resource":[{"type":"Patient","referencePolicy":["literal","local"]}]}]}`},
+		{"contained resources", `{"resourceType":"Patient","contained":[{"resourceType":"Organization","id":"o","name":"x"},{"resourceType":"Practitioner","id":"p","active":true}],"managingOrganization":{"reference":"#o"}}`},
+		{"bundle entries", `{"resourceType":"Bundle","type":"collection","total":2,"entry":[{"fullUrl":"urn:uuid:1","resource":{"resourceType":"Patient","id":"1","birthDate":"2000"}},{"resource":{"resourceType":"Observation","status":"final","code":{},"valueInteger":-3}}]}`},
+		{"primitive extensions", `{"resourceType":"Patient","name":[{"given":["a","b","c"],"_given":[null,{"id":"x"},null]}],"_birthDate":{"extension":[{"url":"u","valueDecimal":2.0}]},"_gender":{"id":"g"}}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resource, err := DecodeResource([]byte(test.resource))
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := resource.(interface{ MarshalProto() ([]byte, error) }).MarshalProto()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var header struct {
+				ResourceType string `json:"resourceType"`
+			}
+			if err := json.Unmarshal([]byte(test.resource), &header); err != nil {
+				t.Fatal(err)
+			}
+			decoded, _ := newResource(header.ResourceType)
+			if err := decoded.(protoUnmarshaler).UnmarshalProto(b); err != nil {
+				t.Fatal(err)
+			}
+			js, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := canonicalJSON(t, js), canonicalJSON(t, []byte(test.resource)); got != want {
+				t.Errorf("got\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+// TestProtoNumbers checks that the encoded messages use the field and value numbers of fhir.proto.
+func TestProtoNumbers(t *testing.T) {
+	src, err := os.ReadFile("fhir.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	number := func(scope, name string) uint64 {
+		t.Helper()
+		m := regexp.MustCompile(`(?s)\n(?:message|enum) ` + scope + ` \{\n.*?\b` + name + ` = (\d+);`).FindSubmatch(src)
+		if m == nil {
+			t.Fatalf("no %s in %s", name, scope)
+		}
+		n, _ := strconv.ParseUint(string(m[1]), 10, 64)
+		return n
+	}
+	active, other := true, AdministrativeGenderOther
+	b, err := Patient{Active: &active, Gender: &other}.MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []byte
+	want = binary.AppendUvarint(want, number("Patient", "active")<<3)
+	want = binary.AppendUvarint(want, 1)
+	want = binary.AppendUvarint(want, number("Patient", "gender")<<3)
+	want = binary.AppendUvarint(want, number("AdministrativeGender", "ADMINISTRATIVE_GENDER_OTHER"))
+	if string(b) != string(want) {
+		t.Errorf("got %v, want %v", b, want)
+	}
+
+	contained, err := Bundle{Entry: []BundleEntry{{Resource: json.RawMessage(`{"resourceType":"Patient"}`)}}}.MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := protoDecoder{buf: contained}
+	// the required type comes first
+	for d.next() && uint64(d.field) != number("Bundle", "entry") {
+		d.skip()
+	}
+	if uint64(d.field) != number("Bundle", "entry") {
+		t.Fatalf("no entry in %v", contained)
+	}
+	entry := protoDecoder{buf: d.bytes()}
+	if !entry.next() || uint64(entry.field) != number("BundleEntry", "resource") {
+		t.Fatalf("resource encoded as field %d", entry.field)
+	}
+	resource := protoDecoder{buf: entry.bytes()}
+	if !resource.next() || uint64(resource.field) != number("ContainedResource", "patient") {
+		t.Errorf("Patient encoded as ContainedResource field %d", resource.field)
+	}
+}
+
+func TestUnmarshalProtoErrors(t *testing.T) {
+	var gender []byte
+	gender = binary.AppendUvarint(gender, 13<<3)
+	gender = binary.AppendUvarint(gender, 99)
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{"unknown enum value", gender},
+		{"truncated", []byte{1<<3 | 2, 5, 'a'}},
+		{"wrong wire type", []byte{1<<3 | 0, 1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var patient Patient
+			if err := patient.UnmarshalProto(test.b); err == nil {
+				t.Errorf("no error, decoded %+v", patient)
+			}
+		})
+	}
+}
@@ -14,32 +14,79 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Provenance is documented here http://hl7.org/fhir/StructureDefinition/Provenance
 type Provenance struct {
-	Id                *string            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Target            []Reference        `bson:"target" json:"target"`
-	OccurredPeriod    *Period            `bson:"occurredPeriod,omitempty" json:"occurredPeriod,omitempty"`
-	OccurredDateTime  *string            `bson:"occurredDateTime,omitempty" json:"occurredDateTime,omitempty"`
-	Recorded          string             `bson:"recorded" json:"recorded"`
-	Policy            []string           `bson:"policy,omitempty" json:"policy,omitempty"`
-	Location          *Reference         `bson:"location,omitempty" json:"location,omitempty"`
-	Reason            []CodeableConcept  `bson:"reason,omitempty" json:"reason,omitempty"`
-	Activity          *CodeableConcept   `bson:"activity,omitempty" json:"activity,omitempty"`
-	Agent             []ProvenanceAgent  `bson:"agent" json:"agent"`
-	Entity            []ProvenanceEntity `bson:"entity,omitempty" json:"entity,omitempty"`
-	Signature         []Signature        `bson:"signature,omitempty" json:"signature,omitempty"`
+	Id                      *string            `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement               *Element           `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                    *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               []json.RawMessage  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Target                  []Reference        `bson:"target" json:"target"`
+	OccurredPeriod          *Period            `bson:"occurredPeriod,omitempty" json:"occurredPeriod,omitempty"`
+	OccurredDateTime        *string            `bson:"occurredDateTime,omitempty" json:"occurredDateTime,omitempty"`
+	OccurredDateTimeElement *Element           `bson:"_occurredDateTime,omitempty" json:"_occurredDateTime,omitempty"`
+	Recorded                string             `bson:"recorded" json:"recorded"`
+	RecordedElement         *Element           `bson:"_recorded,omitempty" json:"_recorded,omitempty"`
+	Policy                  []string           `bson:"policy,omitempty" json:"policy,omitempty"`
+	PolicyElement           []*Element         `bson:"_policy,omitempty" json:"_policy,omitempty"`
+	Location                *Reference         `bson:"location,omitempty" json:"location,omitempty"`
+	Reason                  []CodeableConcept  `bson:"reason,omitempty" json:"reason,omitempty"`
+	Activity                *CodeableConcept   `bson:"activity,omitempty" json:"activity,omitempty"`
+	Agent                   []ProvenanceAgent  `bson:"agent" json:"agent"`
+	Entity                  []ProvenanceEntity `bson:"entity,omitempty" json:"entity,omitempty"`
+	Signature               []Signature        `bson:"signature,omitempty" json:"signature,omitempty"`
 }
+
+// ProvenanceOccurred is implemented by the types allowed for Provenance.occurred[x]
+type ProvenanceOccurred interface {
+	isProvenanceOccurred()
+}
+
+func (Period) isProvenanceOccurred()   {}
+func (DateTime) isProvenanceOccurred() {}
+
+// Occurred returns the value of Provenance.occurred[x] together with its FHIR type code or nil if no value is set
+func (r Provenance) Occurred() (ProvenanceOccurred, string) {
+	if r.OccurredPeriod != nil {
+		return *r.OccurredPeriod, "Period"
+	}
+	if r.OccurredDateTime != nil {
+		return DateTime(*r.OccurredDateTime), "dateTime"
+	}
+	return nil, ""
+}
+
+// SetOccurred sets the value of Provenance.occurred[x], given as value or pointer, and clears all other types
+func (r *Provenance) SetOccurred(value ProvenanceOccurred) {
+	r.OccurredPeriod = nil
+	r.OccurredDateTime = nil
+	switch v := value.(type) {
+	case Period:
+		r.OccurredPeriod = &v
+	case *Period:
+		r.OccurredPeriod = v
+	case DateTime:
+		primitive := string(v)
+		r.OccurredDateTime = &primitive
+	case *DateTime:
+		r.OccurredDateTime = (*string)(v)
+	}
+}
+
 type ProvenanceAgent struct {
 	Id                *string           `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -54,26 +101,2108 @@ type ProvenanceEntity struct {
 	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Role              ProvenanceEntityRole `bson:"role" json:"role"`
+	RoleElement       *Element             `bson:"_role,omitempty" json:"_role,omitempty"`
 	What              Reference            `bson:"what" json:"what"`
 	Agent             []ProvenanceAgent    `bson:"agent,omitempty" json:"agent,omitempty"`
 }
-type OtherProvenance Provenance
 
 // MarshalJSON marshals the given Provenance as JSON into a byte slice
 func (r Provenance) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherProvenance
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherProvenance: OtherProvenance(r),
-		ResourceType:    "Provenance",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Provenance as JSON object with the members in the order of the fields
+func (r Provenance) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Provenance\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("target")
+	if r.Target == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Target {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.OccurredPeriod != nil {
+		e.key("occurredPeriod")
+		r.OccurredPeriod.appendJSON(e)
+	}
+	if r.OccurredDateTime != nil {
+		e.key("occurredDateTime")
+		e.string(*r.OccurredDateTime)
+	}
+	if r.OccurredDateTimeElement != nil {
+		e.key("_occurredDateTime")
+		r.OccurredDateTimeElement.appendJSON(e)
+	}
+	e.key("recorded")
+	e.string(r.Recorded)
+	if r.RecordedElement != nil {
+		e.key("_recorded")
+		r.RecordedElement.appendJSON(e)
+	}
+	if len(r.Policy) > 0 {
+		e.key("policy")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Policy {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PolicyElement) > 0 {
+		e.key("_policy")
+		e.elements(r.PolicyElement, len(r.Policy))
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if len(r.Reason) > 0 {
+		e.key("reason")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reason {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Activity != nil {
+		e.key("activity")
+		r.Activity.appendJSON(e)
+	}
+	e.key("agent")
+	if r.Agent == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Agent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Entity) > 0 {
+		e.key("entity")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Entity {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Signature) > 0 {
+		e.key("signature")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Signature {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Provenance from JSON. Unknown members are ignored.
+func (r *Provenance) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Provenance
+func (r *Provenance) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "target":
+			a := d.array()
+			r.Target = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Target = append(r.Target, v)
+			}
+			if r.Target == nil && !a.isNull {
+				r.Target = []Reference{}
+			}
+		case "occurredPeriod":
+			if d.null() {
+				r.OccurredPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OccurredPeriod = &v
+			}
+		case "occurredDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurredDateTime = &v
+			} else {
+				r.OccurredDateTime = nil
+			}
+		case "_occurredDateTime":
+			r.OccurredDateTimeElement = d.element()
+		case "recorded":
+			if v, ok := d.string(); ok {
+				r.Recorded = v
+			}
+		case "_recorded":
+			r.RecordedElement = d.element()
+		case "policy":
+			a := d.array()
+			r.Policy = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Policy = append(r.Policy, v)
+			}
+			if r.Policy == nil && !a.isNull {
+				r.Policy = []string{}
+			}
+		case "_policy":
+			a := d.array()
+			r.PolicyElement = nil
+			for a.next() {
+				r.PolicyElement = append(r.PolicyElement, d.element())
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "reason":
+			a := d.array()
+			r.Reason = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Reason = append(r.Reason, v)
+			}
+			if r.Reason == nil && !a.isNull {
+				r.Reason = []CodeableConcept{}
+			}
+		case "activity":
+			if d.null() {
+				r.Activity = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Activity = &v
+			}
+		case "agent":
+			a := d.array()
+			r.Agent = nil
+			for a.next() {
+				var v ProvenanceAgent
+				v.decodeJSON(d)
+				r.Agent = append(r.Agent, v)
+			}
+			if r.Agent == nil && !a.isNull {
+				r.Agent = []ProvenanceAgent{}
+			}
+		case "entity":
+			a := d.array()
+			r.Entity = nil
+			for a.next() {
+				var v ProvenanceEntity
+				v.decodeJSON(d)
+				r.Entity = append(r.Entity, v)
+			}
+			if r.Entity == nil && !a.isNull {
+				r.Entity = []ProvenanceEntity{}
+			}
+		case "signature":
+			a := d.array()
+			r.Signature = nil
+			for a.next() {
+				var v Signature
+				v.decodeJSON(d)
+				r.Signature = append(r.Signature, v)
+			}
+			if r.Signature == nil && !a.isNull {
+				r.Signature = []Signature{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Provenance which shares no memory with the original
+func (r Provenance) DeepCopy() Provenance {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Target != nil {
+		out.Target = make([]Reference, len(r.Target))
+		for i := range r.Target {
+			out.Target[i] = r.Target[i].DeepCopy()
+		}
+	}
+	if r.OccurredPeriod != nil {
+		v := r.OccurredPeriod.DeepCopy()
+		out.OccurredPeriod = &v
+	}
+	if r.OccurredDateTime != nil {
+		v := *r.OccurredDateTime
+		out.OccurredDateTime = &v
+	}
+	if r.OccurredDateTimeElement != nil {
+		v := r.OccurredDateTimeElement.DeepCopy()
+		out.OccurredDateTimeElement = &v
+	}
+	if r.RecordedElement != nil {
+		v := r.RecordedElement.DeepCopy()
+		out.RecordedElement = &v
+	}
+	if r.Policy != nil {
+		out.Policy = make([]string, len(r.Policy))
+		copy(out.Policy, r.Policy)
+	}
+	if r.PolicyElement != nil {
+		out.PolicyElement = make([]*Element, len(r.PolicyElement))
+		for i, v := range r.PolicyElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PolicyElement[i] = &c
+			}
+		}
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.Reason != nil {
+		out.Reason = make([]CodeableConcept, len(r.Reason))
+		for i := range r.Reason {
+			out.Reason[i] = r.Reason[i].DeepCopy()
+		}
+	}
+	if r.Activity != nil {
+		v := r.Activity.DeepCopy()
+		out.Activity = &v
+	}
+	if r.Agent != nil {
+		out.Agent = make([]ProvenanceAgent, len(r.Agent))
+		for i := range r.Agent {
+			out.Agent[i] = r.Agent[i].DeepCopy()
+		}
+	}
+	if r.Entity != nil {
+		out.Entity = make([]ProvenanceEntity, len(r.Entity))
+		for i := range r.Entity {
+			out.Entity[i] = r.Entity[i].DeepCopy()
+		}
+	}
+	if r.Signature != nil {
+		out.Signature = make([]Signature, len(r.Signature))
+		for i := range r.Signature {
+			out.Signature[i] = r.Signature[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Provenance) Equal(other Provenance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Target) != len(other.Target) {
+		return false
+	}
+	for i := range r.Target {
+		if !r.Target[i].Equal(other.Target[i]) {
+			return false
+		}
+	}
+	if (r.OccurredPeriod == nil) != (other.OccurredPeriod == nil) || r.OccurredPeriod != nil && !r.OccurredPeriod.Equal(*other.OccurredPeriod) {
+		return false
+	}
+	if (r.OccurredDateTime == nil) != (other.OccurredDateTime == nil) || r.OccurredDateTime != nil && *r.OccurredDateTime != *other.OccurredDateTime {
+		return false
+	}
+	if (r.OccurredDateTimeElement == nil) != (other.OccurredDateTimeElement == nil) || r.OccurredDateTimeElement != nil && !r.OccurredDateTimeElement.Equal(*other.OccurredDateTimeElement) {
+		return false
+	}
+	if r.Recorded != other.Recorded {
+		return false
+	}
+	if (r.RecordedElement == nil) != (other.RecordedElement == nil) || r.RecordedElement != nil && !r.RecordedElement.Equal(*other.RecordedElement) {
+		return false
+	}
+	if len(r.Policy) != len(other.Policy) {
+		return false
+	}
+	for i := range r.Policy {
+		if r.Policy[i] != other.Policy[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PolicyElement, other.PolicyElement, false) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if len(r.Reason) != len(other.Reason) {
+		return false
+	}
+	for i := range r.Reason {
+		if !r.Reason[i].Equal(other.Reason[i]) {
+			return false
+		}
+	}
+	if (r.Activity == nil) != (other.Activity == nil) || r.Activity != nil && !r.Activity.Equal(*other.Activity) {
+		return false
+	}
+	if len(r.Agent) != len(other.Agent) {
+		return false
+	}
+	for i := range r.Agent {
+		if !r.Agent[i].Equal(other.Agent[i]) {
+			return false
+		}
+	}
+	if len(r.Entity) != len(other.Entity) {
+		return false
+	}
+	for i := range r.Entity {
+		if !r.Entity[i].Equal(other.Entity[i]) {
+			return false
+		}
+	}
+	if len(r.Signature) != len(other.Signature) {
+		return false
+	}
+	for i := range r.Signature {
+		if !r.Signature[i].Equal(other.Signature[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Provenance) EqualsDeep(other Provenance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i].EqualsDeep(other.Target[j])
+	}) {
+		return false
+	}
+	if (r.OccurredPeriod == nil) != (other.OccurredPeriod == nil) || r.OccurredPeriod != nil && !r.OccurredPeriod.EqualsDeep(*other.OccurredPeriod) {
+		return false
+	}
+	if (r.OccurredDateTime == nil) != (other.OccurredDateTime == nil) || r.OccurredDateTime != nil && !equivalentString(*r.OccurredDateTime, *other.OccurredDateTime) {
+		return false
+	}
+	if (r.OccurredDateTimeElement == nil) != (other.OccurredDateTimeElement == nil) || r.OccurredDateTimeElement != nil && !r.OccurredDateTimeElement.EqualsDeep(*other.OccurredDateTimeElement) {
+		return false
+	}
+	if !equivalentString(r.Recorded, other.Recorded) {
+		return false
+	}
+	if (r.RecordedElement == nil) != (other.RecordedElement == nil) || r.RecordedElement != nil && !r.RecordedElement.EqualsDeep(*other.RecordedElement) {
+		return false
+	}
+	if !equivalentList(len(r.Policy), len(other.Policy), func(i, j int) bool {
+		return equivalentString(r.Policy[i], other.Policy[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PolicyElement, other.PolicyElement, true) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if !equivalentList(len(r.Reason), len(other.Reason), func(i, j int) bool {
+		return r.Reason[i].EqualsDeep(other.Reason[j])
+	}) {
+		return false
+	}
+	if (r.Activity == nil) != (other.Activity == nil) || r.Activity != nil && !r.Activity.EqualsDeep(*other.Activity) {
+		return false
+	}
+	if !equivalentList(len(r.Agent), len(other.Agent), func(i, j int) bool {
+		return r.Agent[i].EqualsDeep(other.Agent[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Entity), len(other.Entity), func(i, j int) bool {
+		return r.Entity[i].EqualsDeep(other.Entity[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Signature), len(other.Signature), func(i, j int) bool {
+		return r.Signature[i].EqualsDeep(other.Signature[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Provenance) EqualsShallow(other Provenance) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.OccurredDateTime == nil) != (other.OccurredDateTime == nil) || r.OccurredDateTime != nil && !equivalentString(*r.OccurredDateTime, *other.OccurredDateTime) {
+		return false
+	}
+	if !equivalentString(r.Recorded, other.Recorded) {
+		return false
+	}
+	if !equivalentList(len(r.Policy), len(other.Policy), func(i, j int) bool {
+		return equivalentString(r.Policy[i], other.Policy[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Provenance as FHIR XML
+func (r Provenance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Provenance",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Target {
+		if err := encodeXMLElement(e, "target", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "occurredPeriod", r.OccurredPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "occurredDateTime", r.OccurredDateTime, r.OccurredDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "recorded", r.Recorded, r.RecordedElement); err != nil {
+		return err
+	}
+	for i, v := range r.Policy {
+		if err := encodeXMLPrimitive(e, "policy", v, elementAt(r.PolicyElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	for _, v := range r.Reason {
+		if err := encodeXMLElement(e, "reason", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "activity", r.Activity); err != nil {
+		return err
+	}
+	for _, v := range r.Agent {
+		if err := encodeXMLElement(e, "agent", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Entity {
+		if err := encodeXMLElement(e, "entity", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Signature {
+		if err := encodeXMLElement(e, "signature", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Provenance from FHIR XML
+func (r *Provenance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "target":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = append(r.Target, v)
+			case "occurredPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurredPeriod = &v
+			case "occurredDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurredDateTime = &v
+				}
+				r.OccurredDateTimeElement = element
+			case "recorded":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Recorded = v
+				}
+				r.RecordedElement = element
+			case "policy":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PolicyElement = appendElement(r.PolicyElement, len(r.Policy), element)
+					r.Policy = append(r.Policy, v)
+				}
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "reason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reason = append(r.Reason, v)
+			case "activity":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Activity = &v
+			case "agent":
+				var v ProvenanceAgent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Agent = append(r.Agent, v)
+			case "entity":
+				var v ProvenanceEntity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Entity = append(r.Entity, v)
+			case "signature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Signature = append(r.Signature, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Provenance as properties to the RDF node n
+func (r Provenance) turtle(n *turtleNode) {
+	n.resource("Provenance", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Target {
+		n.element("Provenance.target", i, v)
+	}
+	n.element("Provenance.occurredPeriod", -1, r.OccurredPeriod)
+	n.primitive("Provenance.occurredDateTime", -1, r.OccurredDateTime, r.OccurredDateTimeElement, "dateTime")
+	n.primitive("Provenance.recorded", -1, r.Recorded, r.RecordedElement, "instant")
+	for i, v := range r.Policy {
+		n.primitive("Provenance.policy", i, v, elementAt(r.PolicyElement, i), "string")
+	}
+	n.element("Provenance.location", -1, r.Location)
+	for i, v := range r.Reason {
+		n.element("Provenance.reason", i, v)
+	}
+	n.element("Provenance.activity", -1, r.Activity)
+	for i, v := range r.Agent {
+		n.element("Provenance.agent", i, v)
+	}
+	for i, v := range r.Entity {
+		n.element("Provenance.entity", i, v)
+	}
+	for i, v := range r.Signature {
+		n.element("Provenance.signature", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Provenance as BSON document with the structure of its FHIR JSON
+func (r Provenance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Provenance from a BSON document with the structure of its FHIR JSON
+func (r *Provenance) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Provenance as protobuf message Provenance of fhir.proto
+func (r Provenance) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Provenance to the encoded message
+func (r Provenance) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Target {
+		e.message(9, v)
+	}
+	if r.OccurredPeriod != nil {
+		e.message(10, *r.OccurredPeriod)
+	}
+	if r.OccurredDateTime != nil {
+		e.string(11, *r.OccurredDateTime)
+	}
+	if r.Recorded != "" {
+		e.string(12, r.Recorded)
+	}
+	for _, v := range r.Policy {
+		e.string(13, v)
+	}
+	if r.Location != nil {
+		e.message(14, *r.Location)
+	}
+	for _, v := range r.Reason {
+		e.message(15, v)
+	}
+	if r.Activity != nil {
+		e.message(16, *r.Activity)
+	}
+	for _, v := range r.Agent {
+		e.message(17, v)
+	}
+	for _, v := range r.Entity {
+		e.message(18, v)
+	}
+	for _, v := range r.Signature {
+		e.message(19, v)
+	}
+	if r.IdElement != nil {
+		e.message(20, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(21, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(22, *r.LanguageElement)
+	}
+	if r.OccurredDateTimeElement != nil {
+		e.message(23, *r.OccurredDateTimeElement)
+	}
+	if r.RecordedElement != nil {
+		e.message(24, *r.RecordedElement)
+	}
+	for _, v := range r.PolicyElement {
+		e.element(25, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given Provenance from protobuf message Provenance of fhir.proto
+func (r *Provenance) UnmarshalProto(b []byte) error {
+	*r = Provenance{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Target = append(r.Target, v)
+		case 10:
+			var v Period
+			d.message(&v)
+			r.OccurredPeriod = &v
+		case 11:
+			v := d.string()
+			r.OccurredDateTime = &v
+		case 12:
+			r.Recorded = d.string()
+		case 13:
+			r.Policy = append(r.Policy, d.string())
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.Reason = append(r.Reason, v)
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.Activity = &v
+		case 17:
+			var v ProvenanceAgent
+			d.message(&v)
+			r.Agent = append(r.Agent, v)
+		case 18:
+			var v ProvenanceEntity
+			d.message(&v)
+			r.Entity = append(r.Entity, v)
+		case 19:
+			var v Signature
+			d.message(&v)
+			r.Signature = append(r.Signature, v)
+		case 20:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.OccurredDateTimeElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.RecordedElement = &v
+		case 25:
+			r.PolicyElement = append(r.PolicyElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ProvenanceAgent as JSON into a byte slice
+func (r ProvenanceAgent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ProvenanceAgent as JSON object with the members in the order of the fields
+func (r ProvenanceAgent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.Role) > 0 {
+		e.key("role")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Role {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("who")
+	r.Who.appendJSON(e)
+	if r.OnBehalfOf != nil {
+		e.key("onBehalfOf")
+		r.OnBehalfOf.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ProvenanceAgent from JSON. Unknown members are ignored.
+func (r *ProvenanceAgent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ProvenanceAgent
+func (r *ProvenanceAgent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "role":
+			a := d.array()
+			r.Role = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = append(r.Role, v)
+			}
+			if r.Role == nil && !a.isNull {
+				r.Role = []CodeableConcept{}
+			}
+		case "who":
+			r.Who.decodeJSON(d)
+		case "onBehalfOf":
+			if d.null() {
+				r.OnBehalfOf = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.OnBehalfOf = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ProvenanceAgent which shares no memory with the original
+func (r ProvenanceAgent) DeepCopy() ProvenanceAgent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Role != nil {
+		out.Role = make([]CodeableConcept, len(r.Role))
+		for i := range r.Role {
+			out.Role[i] = r.Role[i].DeepCopy()
+		}
+	}
+	out.Who = r.Who.DeepCopy()
+	if r.OnBehalfOf != nil {
+		v := r.OnBehalfOf.DeepCopy()
+		out.OnBehalfOf = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ProvenanceAgent) Equal(other ProvenanceAgent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.Role) != len(other.Role) {
+		return false
+	}
+	for i := range r.Role {
+		if !r.Role[i].Equal(other.Role[i]) {
+			return false
+		}
+	}
+	if !r.Who.Equal(other.Who) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.Equal(*other.OnBehalfOf) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ProvenanceAgent) EqualsDeep(other ProvenanceAgent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Role), len(other.Role), func(i, j int) bool {
+		return r.Role[i].EqualsDeep(other.Role[j])
+	}) {
+		return false
+	}
+	if !r.Who.EqualsDeep(other.Who) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.EqualsDeep(*other.OnBehalfOf) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ProvenanceAgent) EqualsShallow(other ProvenanceAgent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ProvenanceAgent as FHIR XML
+func (r ProvenanceAgent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Role {
+		if err := encodeXMLElement(e, "role", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "who", r.Who); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onBehalfOf", r.OnBehalfOf); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ProvenanceAgent from FHIR XML
+func (r *ProvenanceAgent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = append(r.Role, v)
+			case "who":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Who = v
+			case "onBehalfOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnBehalfOf = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ProvenanceAgent as properties to the RDF node n
+func (r ProvenanceAgent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Provenance.agent.type", -1, r.Type)
+	for i, v := range r.Role {
+		n.element("Provenance.agent.role", i, v)
+	}
+	n.element("Provenance.agent.who", -1, r.Who)
+	n.element("Provenance.agent.onBehalfOf", -1, r.OnBehalfOf)
+}
+
+// MarshalBSON marshals the given ProvenanceAgent as BSON document with the structure of its FHIR JSON
+func (r ProvenanceAgent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ProvenanceAgent from a BSON document with the structure of its FHIR JSON
+func (r *ProvenanceAgent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ProvenanceAgent as protobuf message ProvenanceAgent of fhir.proto
+func (r ProvenanceAgent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ProvenanceAgent to the encoded message
+func (r ProvenanceAgent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	for _, v := range r.Role {
+		e.message(5, v)
+	}
+	e.message(6, r.Who)
+	if r.OnBehalfOf != nil {
+		e.message(7, *r.OnBehalfOf)
+	}
+}
+
+// UnmarshalProto unmarshals the given ProvenanceAgent from protobuf message ProvenanceAgent of fhir.proto
+func (r *ProvenanceAgent) UnmarshalProto(b []byte) error {
+	*r = ProvenanceAgent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = append(r.Role, v)
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Who = v
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.OnBehalfOf = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ProvenanceEntity as JSON into a byte slice
+func (r ProvenanceEntity) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ProvenanceEntity as JSON object with the members in the order of the fields
+func (r ProvenanceEntity) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("role")
+	e.string(r.Role.Code())
+	if r.RoleElement != nil {
+		e.key("_role")
+		r.RoleElement.appendJSON(e)
+	}
+	e.key("what")
+	r.What.appendJSON(e)
+	if len(r.Agent) > 0 {
+		e.key("agent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Agent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ProvenanceEntity from JSON. Unknown members are ignored.
+func (r *ProvenanceEntity) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ProvenanceEntity
+func (r *ProvenanceEntity) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "role":
+			d.unmarshal(&r.Role)
+		case "_role":
+			r.RoleElement = d.element()
+		case "what":
+			r.What.decodeJSON(d)
+		case "agent":
+			a := d.array()
+			r.Agent = nil
+			for a.next() {
+				var v ProvenanceAgent
+				v.decodeJSON(d)
+				r.Agent = append(r.Agent, v)
+			}
+			if r.Agent == nil && !a.isNull {
+				r.Agent = []ProvenanceAgent{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ProvenanceEntity which shares no memory with the original
+func (r ProvenanceEntity) DeepCopy() ProvenanceEntity {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.RoleElement != nil {
+		v := r.RoleElement.DeepCopy()
+		out.RoleElement = &v
+	}
+	out.What = r.What.DeepCopy()
+	if r.Agent != nil {
+		out.Agent = make([]ProvenanceAgent, len(r.Agent))
+		for i := range r.Agent {
+			out.Agent[i] = r.Agent[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ProvenanceEntity) Equal(other ProvenanceEntity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Role != other.Role {
+		return false
+	}
+	if (r.RoleElement == nil) != (other.RoleElement == nil) || r.RoleElement != nil && !r.RoleElement.Equal(*other.RoleElement) {
+		return false
+	}
+	if !r.What.Equal(other.What) {
+		return false
+	}
+	if len(r.Agent) != len(other.Agent) {
+		return false
+	}
+	for i := range r.Agent {
+		if !r.Agent[i].Equal(other.Agent[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ProvenanceEntity) EqualsDeep(other ProvenanceEntity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Role != other.Role {
+		return false
+	}
+	if (r.RoleElement == nil) != (other.RoleElement == nil) || r.RoleElement != nil && !r.RoleElement.EqualsDeep(*other.RoleElement) {
+		return false
+	}
+	if !r.What.EqualsDeep(other.What) {
+		return false
+	}
+	if !equivalentList(len(r.Agent), len(other.Agent), func(i, j int) bool {
+		return r.Agent[i].EqualsDeep(other.Agent[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ProvenanceEntity) EqualsShallow(other ProvenanceEntity) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Role != other.Role {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ProvenanceEntity as FHIR XML
+func (r ProvenanceEntity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "role", r.Role, r.RoleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "what", r.What); err != nil {
+		return err
+	}
+	for _, v := range r.Agent {
+		if err := encodeXMLElement(e, "agent", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ProvenanceEntity from FHIR XML
+func (r *ProvenanceEntity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "role":
+				var v ProvenanceEntityRole
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Role = v
+				}
+				r.RoleElement = element
+			case "what":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.What = v
+			case "agent":
+				var v ProvenanceAgent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Agent = append(r.Agent, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ProvenanceEntity as properties to the RDF node n
+func (r ProvenanceEntity) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Provenance.entity.role", -1, r.Role, r.RoleElement, "code")
+	n.element("Provenance.entity.what", -1, r.What)
+	for i, v := range r.Agent {
+		n.element("Provenance.entity.agent", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ProvenanceEntity as BSON document with the structure of its FHIR JSON
+func (r ProvenanceEntity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ProvenanceEntity from a BSON document with the structure of its FHIR JSON
+func (r *ProvenanceEntity) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ProvenanceEntity as protobuf message ProvenanceEntity of fhir.proto
+func (r ProvenanceEntity) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ProvenanceEntity to the encoded message
+func (r ProvenanceEntity) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Role.protoNumber())
+	e.message(5, r.What)
+	for _, v := range r.Agent {
+		e.message(6, v)
+	}
+	if r.RoleElement != nil {
+		e.message(7, *r.RoleElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ProvenanceEntity from protobuf message ProvenanceEntity of fhir.proto
+func (r *ProvenanceEntity) UnmarshalProto(b []byte) error {
+	*r = ProvenanceEntity{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ProvenanceEntityRole
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Role = v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.What = v
+		case 6:
+			var v ProvenanceAgent
+			d.message(&v)
+			r.Agent = append(r.Agent, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.RoleElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalProvenance unmarshals a Provenance.
 func UnmarshalProvenance(b []byte) (Provenance, error) {
 	var provenance Provenance
-	if err := json.Unmarshal(b, &provenance); err != nil {
+	if err := provenance.UnmarshalJSON(b); err != nil {
 		return provenance, err
 	}
 	return provenance, nil
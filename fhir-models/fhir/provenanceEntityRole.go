@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code ProvenanceEntityRole) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ProvenanceEntityRole) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ProvenanceEntityRole) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "derivation":
 		*code = ProvenanceEntityRoleDerivation
@@ -55,6 +59,12 @@ func (code *ProvenanceEntityRole) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ProvenanceEntityRole) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ProvenanceEntityRole) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ProvenanceEntityRole) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code ProvenanceEntityRole) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ProvenanceEntityRole) protoNumber() uint64 {
+	switch code {
+	case ProvenanceEntityRoleDerivation:
+		return 1
+	case ProvenanceEntityRoleRevision:
+		return 2
+	case ProvenanceEntityRoleQuotation:
+		return 3
+	case ProvenanceEntityRoleSource:
+		return 4
+	case ProvenanceEntityRoleRemoval:
+		return 5
+	}
+	return 0
+}
+func (code *ProvenanceEntityRole) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ProvenanceEntityRoleDerivation
+	case 2:
+		*code = ProvenanceEntityRoleRevision
+	case 3:
+		*code = ProvenanceEntityRoleQuotation
+	case 4:
+		*code = ProvenanceEntityRoleSource
+	case 5:
+		*code = ProvenanceEntityRoleRemoval
+	default:
+		return false
+	}
+	return true
+}
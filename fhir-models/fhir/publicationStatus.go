@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code PublicationStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *PublicationStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *PublicationStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "draft":
 		*code = PublicationStatusDraft
@@ -52,6 +56,12 @@ func (code *PublicationStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code PublicationStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *PublicationStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code PublicationStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code PublicationStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code PublicationStatus) protoNumber() uint64 {
+	switch code {
+	case PublicationStatusDraft:
+		return 1
+	case PublicationStatusActive:
+		return 2
+	case PublicationStatusRetired:
+		return 3
+	case PublicationStatusUnknown:
+		return 4
+	}
+	return 0
+}
+func (code *PublicationStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = PublicationStatusDraft
+	case 2:
+		*code = PublicationStatusActive
+	case 3:
+		*code = PublicationStatusRetired
+	case 4:
+		*code = PublicationStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -14,127 +14,6449 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Questionnaire is documented here http://hl7.org/fhir/StructureDefinition/Questionnaire
 type Questionnaire struct {
-	Id                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string             `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string             `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string             `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string             `bson:"title,omitempty" json:"title,omitempty"`
-	DerivedFrom       []string            `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
-	Status            PublicationStatus   `bson:"status" json:"status"`
-	Experimental      *bool               `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	SubjectType       []ResourceType      `bson:"subjectType,omitempty" json:"subjectType,omitempty"`
-	Date              *string             `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string             `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail     `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string             `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext      `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept   `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string             `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string             `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *string             `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *string             `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Code              []Coding            `bson:"code,omitempty" json:"code,omitempty"`
-	Item              []QuestionnaireItem `bson:"item,omitempty" json:"item,omitempty"`
+	Id                    *string             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element            `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                  *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage   `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                   *string             `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement            *Element            `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string             `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element            `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                  *string             `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element            `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                 *string             `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element            `bson:"_title,omitempty" json:"_title,omitempty"`
+	DerivedFrom           []string            `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
+	DerivedFromElement    []*Element          `bson:"_derivedFrom,omitempty" json:"_derivedFrom,omitempty"`
+	Status                PublicationStatus   `bson:"status" json:"status"`
+	StatusElement         *Element            `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental          *bool               `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement   *Element            `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	SubjectType           []ResourceType      `bson:"subjectType,omitempty" json:"subjectType,omitempty"`
+	SubjectTypeElement    []*Element          `bson:"_subjectType,omitempty" json:"_subjectType,omitempty"`
+	Date                  *string             `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element            `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string             `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element            `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail     `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string             `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element            `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext            []UsageContext      `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept   `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose               *string             `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement        *Element            `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright             *string             `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element            `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *string             `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element            `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *string             `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element            `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period             `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Code                  []Coding            `bson:"code,omitempty" json:"code,omitempty"`
+	Item                  []QuestionnaireItem `bson:"item,omitempty" json:"item,omitempty"`
 }
 type QuestionnaireItem struct {
-	Id                *string                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	LinkId            string                          `bson:"linkId" json:"linkId"`
-	Definition        *string                         `bson:"definition,omitempty" json:"definition,omitempty"`
-	Code              []Coding                        `bson:"code,omitempty" json:"code,omitempty"`
-	Prefix            *string                         `bson:"prefix,omitempty" json:"prefix,omitempty"`
-	Text              *string                         `bson:"text,omitempty" json:"text,omitempty"`
-	Type              QuestionnaireItemType           `bson:"type" json:"type"`
-	EnableWhen        []QuestionnaireItemEnableWhen   `bson:"enableWhen,omitempty" json:"enableWhen,omitempty"`
-	EnableBehavior    *EnableWhenBehavior             `bson:"enableBehavior,omitempty" json:"enableBehavior,omitempty"`
-	Required          *bool                           `bson:"required,omitempty" json:"required,omitempty"`
-	Repeats           *bool                           `bson:"repeats,omitempty" json:"repeats,omitempty"`
-	ReadOnly          *bool                           `bson:"readOnly,omitempty" json:"readOnly,omitempty"`
-	MaxLength         *int                            `bson:"maxLength,omitempty" json:"maxLength,omitempty"`
-	AnswerValueSet    *string                         `bson:"answerValueSet,omitempty" json:"answerValueSet,omitempty"`
-	AnswerOption      []QuestionnaireItemAnswerOption `bson:"answerOption,omitempty" json:"answerOption,omitempty"`
-	Initial           []QuestionnaireItemInitial      `bson:"initial,omitempty" json:"initial,omitempty"`
-	Item              []QuestionnaireItem             `bson:"item,omitempty" json:"item,omitempty"`
+	Id                    *string                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	LinkId                string                          `bson:"linkId" json:"linkId"`
+	LinkIdElement         *Element                        `bson:"_linkId,omitempty" json:"_linkId,omitempty"`
+	Definition            *string                         `bson:"definition,omitempty" json:"definition,omitempty"`
+	DefinitionElement     *Element                        `bson:"_definition,omitempty" json:"_definition,omitempty"`
+	Code                  []Coding                        `bson:"code,omitempty" json:"code,omitempty"`
+	Prefix                *string                         `bson:"prefix,omitempty" json:"prefix,omitempty"`
+	PrefixElement         *Element                        `bson:"_prefix,omitempty" json:"_prefix,omitempty"`
+	Text                  *string                         `bson:"text,omitempty" json:"text,omitempty"`
+	TextElement           *Element                        `bson:"_text,omitempty" json:"_text,omitempty"`
+	Type                  QuestionnaireItemType           `bson:"type" json:"type"`
+	TypeElement           *Element                        `bson:"_type,omitempty" json:"_type,omitempty"`
+	EnableWhen            []QuestionnaireItemEnableWhen   `bson:"enableWhen,omitempty" json:"enableWhen,omitempty"`
+	EnableBehavior        *EnableWhenBehavior             `bson:"enableBehavior,omitempty" json:"enableBehavior,omitempty"`
+	EnableBehaviorElement *Element                        `bson:"_enableBehavior,omitempty" json:"_enableBehavior,omitempty"`
+	Required              *bool                           `bson:"required,omitempty" json:"required,omitempty"`
+	RequiredElement       *Element                        `bson:"_required,omitempty" json:"_required,omitempty"`
+	Repeats               *bool                           `bson:"repeats,omitempty" json:"repeats,omitempty"`
+	RepeatsElement        *Element                        `bson:"_repeats,omitempty" json:"_repeats,omitempty"`
+	ReadOnly              *bool                           `bson:"readOnly,omitempty" json:"readOnly,omitempty"`
+	ReadOnlyElement       *Element                        `bson:"_readOnly,omitempty" json:"_readOnly,omitempty"`
+	MaxLength             *int                            `bson:"maxLength,omitempty" json:"maxLength,omitempty"`
+	MaxLengthElement      *Element                        `bson:"_maxLength,omitempty" json:"_maxLength,omitempty"`
+	AnswerValueSet        *string                         `bson:"answerValueSet,omitempty" json:"answerValueSet,omitempty"`
+	AnswerValueSetElement *Element                        `bson:"_answerValueSet,omitempty" json:"_answerValueSet,omitempty"`
+	AnswerOption          []QuestionnaireItemAnswerOption `bson:"answerOption,omitempty" json:"answerOption,omitempty"`
+	Initial               []QuestionnaireItemInitial      `bson:"initial,omitempty" json:"initial,omitempty"`
+	Item                  []QuestionnaireItem             `bson:"item,omitempty" json:"item,omitempty"`
 }
 type QuestionnaireItemEnableWhen struct {
-	Id                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Question          string                    `bson:"question" json:"question"`
-	Operator          QuestionnaireItemOperator `bson:"operator" json:"operator"`
-	AnswerBoolean     bool                      `bson:"answerBoolean" json:"answerBoolean"`
-	AnswerDecimal     json.Number               `bson:"answerDecimal" json:"answerDecimal"`
-	AnswerInteger     int                       `bson:"answerInteger" json:"answerInteger"`
-	AnswerDate        string                    `bson:"answerDate" json:"answerDate"`
-	AnswerDateTime    string                    `bson:"answerDateTime" json:"answerDateTime"`
-	AnswerTime        string                    `bson:"answerTime" json:"answerTime"`
-	AnswerString      string                    `bson:"answerString" json:"answerString"`
-	AnswerCoding      Coding                    `bson:"answerCoding" json:"answerCoding"`
-	AnswerQuantity    Quantity                  `bson:"answerQuantity" json:"answerQuantity"`
-	AnswerReference   Reference                 `bson:"answerReference" json:"answerReference"`
+	Id                    *string                   `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Question              string                    `bson:"question" json:"question"`
+	QuestionElement       *Element                  `bson:"_question,omitempty" json:"_question,omitempty"`
+	Operator              QuestionnaireItemOperator `bson:"operator" json:"operator"`
+	OperatorElement       *Element                  `bson:"_operator,omitempty" json:"_operator,omitempty"`
+	AnswerBoolean         *bool                     `bson:"answerBoolean,omitempty" json:"answerBoolean,omitempty"`
+	AnswerBooleanElement  *Element                  `bson:"_answerBoolean,omitempty" json:"_answerBoolean,omitempty"`
+	AnswerDecimal         *json.Number              `bson:"answerDecimal,omitempty" json:"answerDecimal,omitempty"`
+	AnswerDecimalElement  *Element                  `bson:"_answerDecimal,omitempty" json:"_answerDecimal,omitempty"`
+	AnswerInteger         *int                      `bson:"answerInteger,omitempty" json:"answerInteger,omitempty"`
+	AnswerIntegerElement  *Element                  `bson:"_answerInteger,omitempty" json:"_answerInteger,omitempty"`
+	AnswerDate            *string                   `bson:"answerDate,omitempty" json:"answerDate,omitempty"`
+	AnswerDateElement     *Element                  `bson:"_answerDate,omitempty" json:"_answerDate,omitempty"`
+	AnswerDateTime        *string                   `bson:"answerDateTime,omitempty" json:"answerDateTime,omitempty"`
+	AnswerDateTimeElement *Element                  `bson:"_answerDateTime,omitempty" json:"_answerDateTime,omitempty"`
+	AnswerTime            *string                   `bson:"answerTime,omitempty" json:"answerTime,omitempty"`
+	AnswerTimeElement     *Element                  `bson:"_answerTime,omitempty" json:"_answerTime,omitempty"`
+	AnswerString          *string                   `bson:"answerString,omitempty" json:"answerString,omitempty"`
+	AnswerStringElement   *Element                  `bson:"_answerString,omitempty" json:"_answerString,omitempty"`
+	AnswerCoding          *Coding                   `bson:"answerCoding,omitempty" json:"answerCoding,omitempty"`
+	AnswerQuantity        *Quantity                 `bson:"answerQuantity,omitempty" json:"answerQuantity,omitempty"`
+	AnswerReference       *Reference                `bson:"answerReference,omitempty" json:"answerReference,omitempty"`
 }
+
+// QuestionnaireItemEnableWhenAnswer is implemented by the types allowed for Questionnaire.item.enableWhen.answer[x]
+type QuestionnaireItemEnableWhenAnswer interface {
+	isQuestionnaireItemEnableWhenAnswer()
+}
+
+func (Boolean) isQuestionnaireItemEnableWhenAnswer()   {}
+func (Decimal) isQuestionnaireItemEnableWhenAnswer()   {}
+func (Integer) isQuestionnaireItemEnableWhenAnswer()   {}
+func (Date) isQuestionnaireItemEnableWhenAnswer()      {}
+func (DateTime) isQuestionnaireItemEnableWhenAnswer()  {}
+func (Time) isQuestionnaireItemEnableWhenAnswer()      {}
+func (String) isQuestionnaireItemEnableWhenAnswer()    {}
+func (Coding) isQuestionnaireItemEnableWhenAnswer()    {}
+func (Quantity) isQuestionnaireItemEnableWhenAnswer()  {}
+func (Reference) isQuestionnaireItemEnableWhenAnswer() {}
+
+// Answer returns the value of Questionnaire.item.enableWhen.answer[x] together with its FHIR type code or nil if no value is set
+func (r QuestionnaireItemEnableWhen) Answer() (QuestionnaireItemEnableWhenAnswer, string) {
+	if r.AnswerBoolean != nil {
+		return Boolean(*r.AnswerBoolean), "boolean"
+	}
+	if r.AnswerDecimal != nil {
+		return Decimal(*r.AnswerDecimal), "decimal"
+	}
+	if r.AnswerInteger != nil {
+		return Integer(*r.AnswerInteger), "integer"
+	}
+	if r.AnswerDate != nil {
+		return Date(*r.AnswerDate), "date"
+	}
+	if r.AnswerDateTime != nil {
+		return DateTime(*r.AnswerDateTime), "dateTime"
+	}
+	if r.AnswerTime != nil {
+		return Time(*r.AnswerTime), "time"
+	}
+	if r.AnswerString != nil {
+		return String(*r.AnswerString), "string"
+	}
+	if r.AnswerCoding != nil {
+		return *r.AnswerCoding, "Coding"
+	}
+	if r.AnswerQuantity != nil {
+		return *r.AnswerQuantity, "Quantity"
+	}
+	if r.AnswerReference != nil {
+		return *r.AnswerReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetAnswer sets the value of Questionnaire.item.enableWhen.answer[x], given as value or pointer, and clears all other types
+func (r *QuestionnaireItemEnableWhen) SetAnswer(value QuestionnaireItemEnableWhenAnswer) {
+	r.AnswerBoolean = nil
+	r.AnswerDecimal = nil
+	r.AnswerInteger = nil
+	r.AnswerDate = nil
+	r.AnswerDateTime = nil
+	r.AnswerTime = nil
+	r.AnswerString = nil
+	r.AnswerCoding = nil
+	r.AnswerQuantity = nil
+	r.AnswerReference = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.AnswerBoolean = &primitive
+	case *Boolean:
+		r.AnswerBoolean = (*bool)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.AnswerDecimal = &primitive
+	case *Decimal:
+		r.AnswerDecimal = (*json.Number)(v)
+	case Integer:
+		primitive := int(v)
+		r.AnswerInteger = &primitive
+	case *Integer:
+		r.AnswerInteger = (*int)(v)
+	case Date:
+		primitive := string(v)
+		r.AnswerDate = &primitive
+	case *Date:
+		r.AnswerDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.AnswerDateTime = &primitive
+	case *DateTime:
+		r.AnswerDateTime = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.AnswerTime = &primitive
+	case *Time:
+		r.AnswerTime = (*string)(v)
+	case String:
+		primitive := string(v)
+		r.AnswerString = &primitive
+	case *String:
+		r.AnswerString = (*string)(v)
+	case Coding:
+		r.AnswerCoding = &v
+	case *Coding:
+		r.AnswerCoding = v
+	case Quantity:
+		r.AnswerQuantity = &v
+	case *Quantity:
+		r.AnswerQuantity = v
+	case Reference:
+		r.AnswerReference = &v
+	case *Reference:
+		r.AnswerReference = v
+	}
+}
+
 type QuestionnaireItemAnswerOption struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ValueInteger      int         `bson:"valueInteger" json:"valueInteger"`
-	ValueDate         string      `bson:"valueDate" json:"valueDate"`
-	ValueTime         string      `bson:"valueTime" json:"valueTime"`
-	ValueString       string      `bson:"valueString" json:"valueString"`
-	ValueCoding       Coding      `bson:"valueCoding" json:"valueCoding"`
-	ValueReference    Reference   `bson:"valueReference" json:"valueReference"`
-	InitialSelected   *bool       `bson:"initialSelected,omitempty" json:"initialSelected,omitempty"`
+	Id                     *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension              []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension      []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ValueInteger           *int        `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement    *Element    `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDate              *string     `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement       *Element    `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueTime              *string     `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement       *Element    `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueString            *string     `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement     *Element    `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueCoding            *Coding     `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueReference         *Reference  `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	InitialSelected        *bool       `bson:"initialSelected,omitempty" json:"initialSelected,omitempty"`
+	InitialSelectedElement *Element    `bson:"_initialSelected,omitempty" json:"_initialSelected,omitempty"`
+}
+
+// QuestionnaireItemAnswerOptionValue is implemented by the types allowed for Questionnaire.item.answerOption.value[x]
+type QuestionnaireItemAnswerOptionValue interface {
+	isQuestionnaireItemAnswerOptionValue()
+}
+
+func (Integer) isQuestionnaireItemAnswerOptionValue()   {}
+func (Date) isQuestionnaireItemAnswerOptionValue()      {}
+func (Time) isQuestionnaireItemAnswerOptionValue()      {}
+func (String) isQuestionnaireItemAnswerOptionValue()    {}
+func (Coding) isQuestionnaireItemAnswerOptionValue()    {}
+func (Reference) isQuestionnaireItemAnswerOptionValue() {}
+
+// Value returns the value of Questionnaire.item.answerOption.value[x] together with its FHIR type code or nil if no value is set
+func (r QuestionnaireItemAnswerOption) Value() (QuestionnaireItemAnswerOptionValue, string) {
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueDate != nil {
+		return Date(*r.ValueDate), "date"
+	}
+	if r.ValueTime != nil {
+		return Time(*r.ValueTime), "time"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueCoding != nil {
+		return *r.ValueCoding, "Coding"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Questionnaire.item.answerOption.value[x], given as value or pointer, and clears all other types
+func (r *QuestionnaireItemAnswerOption) SetValue(value QuestionnaireItemAnswerOptionValue) {
+	r.ValueInteger = nil
+	r.ValueDate = nil
+	r.ValueTime = nil
+	r.ValueString = nil
+	r.ValueCoding = nil
+	r.ValueReference = nil
+	switch v := value.(type) {
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Date:
+		primitive := string(v)
+		r.ValueDate = &primitive
+	case *Date:
+		r.ValueDate = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.ValueTime = &primitive
+	case *Time:
+		r.ValueTime = (*string)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Coding:
+		r.ValueCoding = &v
+	case *Coding:
+		r.ValueCoding = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	}
 }
+
 type QuestionnaireItemInitial struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ValueBoolean      bool        `bson:"valueBoolean" json:"valueBoolean"`
-	ValueDecimal      json.Number `bson:"valueDecimal" json:"valueDecimal"`
-	ValueInteger      int         `bson:"valueInteger" json:"valueInteger"`
-	ValueDate         string      `bson:"valueDate" json:"valueDate"`
-	ValueDateTime     string      `bson:"valueDateTime" json:"valueDateTime"`
-	ValueTime         string      `bson:"valueTime" json:"valueTime"`
-	ValueString       string      `bson:"valueString" json:"valueString"`
-	ValueUri          string      `bson:"valueUri" json:"valueUri"`
-	ValueAttachment   Attachment  `bson:"valueAttachment" json:"valueAttachment"`
-	ValueCoding       Coding      `bson:"valueCoding" json:"valueCoding"`
-	ValueQuantity     Quantity    `bson:"valueQuantity" json:"valueQuantity"`
-	ValueReference    Reference   `bson:"valueReference" json:"valueReference"`
-}
-type OtherQuestionnaire Questionnaire
+	Id                   *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ValueBoolean         *bool        `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *Element     `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueDecimal         *json.Number `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *Element     `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueInteger         *int         `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement  *Element     `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDate            *string      `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement     *Element     `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime        *string      `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element     `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueTime            *string      `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement     *Element     `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueString          *string      `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement   *Element     `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueUri             *string      `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement      *Element     `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment  `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCoding          *Coding      `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueQuantity        *Quantity    `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueReference       *Reference   `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+}
+
+// QuestionnaireItemInitialValue is implemented by the types allowed for Questionnaire.item.initial.value[x]
+type QuestionnaireItemInitialValue interface {
+	isQuestionnaireItemInitialValue()
+}
+
+func (Boolean) isQuestionnaireItemInitialValue()    {}
+func (Decimal) isQuestionnaireItemInitialValue()    {}
+func (Integer) isQuestionnaireItemInitialValue()    {}
+func (Date) isQuestionnaireItemInitialValue()       {}
+func (DateTime) isQuestionnaireItemInitialValue()   {}
+func (Time) isQuestionnaireItemInitialValue()       {}
+func (String) isQuestionnaireItemInitialValue()     {}
+func (Uri) isQuestionnaireItemInitialValue()        {}
+func (Attachment) isQuestionnaireItemInitialValue() {}
+func (Coding) isQuestionnaireItemInitialValue()     {}
+func (Quantity) isQuestionnaireItemInitialValue()   {}
+func (Reference) isQuestionnaireItemInitialValue()  {}
+
+// Value returns the value of Questionnaire.item.initial.value[x] together with its FHIR type code or nil if no value is set
+func (r QuestionnaireItemInitial) Value() (QuestionnaireItemInitialValue, string) {
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueDecimal != nil {
+		return Decimal(*r.ValueDecimal), "decimal"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueDate != nil {
+		return Date(*r.ValueDate), "date"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValueTime != nil {
+		return Time(*r.ValueTime), "time"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueUri != nil {
+		return Uri(*r.ValueUri), "uri"
+	}
+	if r.ValueAttachment != nil {
+		return *r.ValueAttachment, "Attachment"
+	}
+	if r.ValueCoding != nil {
+		return *r.ValueCoding, "Coding"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Questionnaire.item.initial.value[x], given as value or pointer, and clears all other types
+func (r *QuestionnaireItemInitial) SetValue(value QuestionnaireItemInitialValue) {
+	r.ValueBoolean = nil
+	r.ValueDecimal = nil
+	r.ValueInteger = nil
+	r.ValueDate = nil
+	r.ValueDateTime = nil
+	r.ValueTime = nil
+	r.ValueString = nil
+	r.ValueUri = nil
+	r.ValueAttachment = nil
+	r.ValueCoding = nil
+	r.ValueQuantity = nil
+	r.ValueReference = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.ValueDecimal = &primitive
+	case *Decimal:
+		r.ValueDecimal = (*json.Number)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Date:
+		primitive := string(v)
+		r.ValueDate = &primitive
+	case *Date:
+		r.ValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.ValueTime = &primitive
+	case *Time:
+		r.ValueTime = (*string)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Uri:
+		primitive := string(v)
+		r.ValueUri = &primitive
+	case *Uri:
+		r.ValueUri = (*string)(v)
+	case Attachment:
+		r.ValueAttachment = &v
+	case *Attachment:
+		r.ValueAttachment = v
+	case Coding:
+		r.ValueCoding = &v
+	case *Coding:
+		r.ValueCoding = v
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	}
+}
 
 // MarshalJSON marshals the given Questionnaire as JSON into a byte slice
 func (r Questionnaire) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherQuestionnaire
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherQuestionnaire: OtherQuestionnaire(r),
-		ResourceType:       "Questionnaire",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Questionnaire as JSON object with the members in the order of the fields
+func (r Questionnaire) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Questionnaire\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if len(r.DerivedFrom) > 0 {
+		e.key("derivedFrom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DerivedFrom {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DerivedFromElement) > 0 {
+		e.key("_derivedFrom")
+		e.elements(r.DerivedFromElement, len(r.DerivedFrom))
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if len(r.SubjectType) > 0 {
+		e.key("subjectType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SubjectType {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SubjectTypeElement) > 0 {
+		e.key("_subjectType")
+		e.elements(r.SubjectTypeElement, len(r.SubjectType))
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.ApprovalDate != nil {
+		e.key("approvalDate")
+		e.string(*r.ApprovalDate)
+	}
+	if r.ApprovalDateElement != nil {
+		e.key("_approvalDate")
+		r.ApprovalDateElement.appendJSON(e)
+	}
+	if r.LastReviewDate != nil {
+		e.key("lastReviewDate")
+		e.string(*r.LastReviewDate)
+	}
+	if r.LastReviewDateElement != nil {
+		e.key("_lastReviewDate")
+		r.LastReviewDateElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Item) > 0 {
+		e.key("item")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Item {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Questionnaire from JSON. Unknown members are ignored.
+func (r *Questionnaire) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Questionnaire
+func (r *Questionnaire) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "derivedFrom":
+			a := d.array()
+			r.DerivedFrom = nil
+			for a.next() {
+				v, _ := d.string()
+				r.DerivedFrom = append(r.DerivedFrom, v)
+			}
+			if r.DerivedFrom == nil && !a.isNull {
+				r.DerivedFrom = []string{}
+			}
+		case "_derivedFrom":
+			a := d.array()
+			r.DerivedFromElement = nil
+			for a.next() {
+				r.DerivedFromElement = append(r.DerivedFromElement, d.element())
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "subjectType":
+			a := d.array()
+			r.SubjectType = nil
+			for a.next() {
+				var v ResourceType
+				d.unmarshal(&v)
+				r.SubjectType = append(r.SubjectType, v)
+			}
+			if r.SubjectType == nil && !a.isNull {
+				r.SubjectType = []ResourceType{}
+			}
+		case "_subjectType":
+			a := d.array()
+			r.SubjectTypeElement = nil
+			for a.next() {
+				r.SubjectTypeElement = append(r.SubjectTypeElement, d.element())
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "approvalDate":
+			if v, ok := d.string(); ok {
+				r.ApprovalDate = &v
+			} else {
+				r.ApprovalDate = nil
+			}
+		case "_approvalDate":
+			r.ApprovalDateElement = d.element()
+		case "lastReviewDate":
+			if v, ok := d.string(); ok {
+				r.LastReviewDate = &v
+			} else {
+				r.LastReviewDate = nil
+			}
+		case "_lastReviewDate":
+			r.LastReviewDateElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []Coding{}
+			}
+		case "item":
+			a := d.array()
+			r.Item = nil
+			for a.next() {
+				var v QuestionnaireItem
+				v.decodeJSON(d)
+				r.Item = append(r.Item, v)
+			}
+			if r.Item == nil && !a.isNull {
+				r.Item = []QuestionnaireItem{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Questionnaire which shares no memory with the original
+func (r Questionnaire) DeepCopy() Questionnaire {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.DerivedFrom != nil {
+		out.DerivedFrom = make([]string, len(r.DerivedFrom))
+		copy(out.DerivedFrom, r.DerivedFrom)
+	}
+	if r.DerivedFromElement != nil {
+		out.DerivedFromElement = make([]*Element, len(r.DerivedFromElement))
+		for i, v := range r.DerivedFromElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DerivedFromElement[i] = &c
+			}
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.SubjectType != nil {
+		out.SubjectType = make([]ResourceType, len(r.SubjectType))
+		copy(out.SubjectType, r.SubjectType)
+	}
+	if r.SubjectTypeElement != nil {
+		out.SubjectTypeElement = make([]*Element, len(r.SubjectTypeElement))
+		for i, v := range r.SubjectTypeElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.SubjectTypeElement[i] = &c
+			}
+		}
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.ApprovalDate != nil {
+		v := *r.ApprovalDate
+		out.ApprovalDate = &v
+	}
+	if r.ApprovalDateElement != nil {
+		v := r.ApprovalDateElement.DeepCopy()
+		out.ApprovalDateElement = &v
+	}
+	if r.LastReviewDate != nil {
+		v := *r.LastReviewDate
+		out.LastReviewDate = &v
+	}
+	if r.LastReviewDateElement != nil {
+		v := r.LastReviewDateElement.DeepCopy()
+		out.LastReviewDateElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Code != nil {
+		out.Code = make([]Coding, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Item != nil {
+		out.Item = make([]QuestionnaireItem, len(r.Item))
+		for i := range r.Item {
+			out.Item[i] = r.Item[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Questionnaire) Equal(other Questionnaire) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if len(r.DerivedFrom) != len(other.DerivedFrom) {
+		return false
+	}
+	for i := range r.DerivedFrom {
+		if r.DerivedFrom[i] != other.DerivedFrom[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DerivedFromElement, other.DerivedFromElement, false) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if len(r.SubjectType) != len(other.SubjectType) {
+		return false
+	}
+	for i := range r.SubjectType {
+		if r.SubjectType[i] != other.SubjectType[i] {
+			return false
+		}
+	}
+	if !equalElements(r.SubjectTypeElement, other.SubjectTypeElement, false) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && *r.ApprovalDate != *other.ApprovalDate {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.Equal(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && *r.LastReviewDate != *other.LastReviewDate {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.Equal(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if len(r.Item) != len(other.Item) {
+		return false
+	}
+	for i := range r.Item {
+		if !r.Item[i].Equal(other.Item[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Questionnaire) EqualsDeep(other Questionnaire) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if !equivalentList(len(r.DerivedFrom), len(other.DerivedFrom), func(i, j int) bool {
+		return equivalentString(r.DerivedFrom[i], other.DerivedFrom[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.DerivedFromElement, other.DerivedFromElement, true) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if !equivalentList(len(r.SubjectType), len(other.SubjectType), func(i, j int) bool {
+		return r.SubjectType[i] == other.SubjectType[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.SubjectTypeElement, other.SubjectTypeElement, true) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.EqualsDeep(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.EqualsDeep(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Item), len(other.Item), func(i, j int) bool {
+		return r.Item[i].EqualsDeep(other.Item[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Questionnaire) EqualsShallow(other Questionnaire) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if !equivalentList(len(r.DerivedFrom), len(other.DerivedFrom), func(i, j int) bool {
+		return equivalentString(r.DerivedFrom[i], other.DerivedFrom[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if !equivalentList(len(r.SubjectType), len(other.SubjectType), func(i, j int) bool {
+		return r.SubjectType[i] == other.SubjectType[j]
+	}) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Questionnaire as FHIR XML
+func (r Questionnaire) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Questionnaire",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	for i, v := range r.DerivedFrom {
+		if err := encodeXMLPrimitive(e, "derivedFrom", v, elementAt(r.DerivedFromElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	for i, v := range r.SubjectType {
+		if err := encodeXMLPrimitive(e, "subjectType", v, elementAt(r.SubjectTypeElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "approvalDate", r.ApprovalDate, r.ApprovalDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastReviewDate", r.LastReviewDate, r.LastReviewDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Item {
+		if err := encodeXMLElement(e, "item", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Questionnaire from FHIR XML
+func (r *Questionnaire) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "derivedFrom":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DerivedFromElement = appendElement(r.DerivedFromElement, len(r.DerivedFrom), element)
+					r.DerivedFrom = append(r.DerivedFrom, v)
+				}
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "subjectType":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.SubjectTypeElement = appendElement(r.SubjectTypeElement, len(r.SubjectType), element)
+					r.SubjectType = append(r.SubjectType, v)
+				}
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "approvalDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ApprovalDate = &v
+				}
+				r.ApprovalDateElement = element
+			case "lastReviewDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastReviewDate = &v
+				}
+				r.LastReviewDateElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "code":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "item":
+				var v QuestionnaireItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Item = append(r.Item, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Questionnaire as properties to the RDF node n
+func (r Questionnaire) turtle(n *turtleNode) {
+	n.resource("Questionnaire", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("Questionnaire.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("Questionnaire.identifier", i, v)
+	}
+	n.primitive("Questionnaire.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("Questionnaire.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("Questionnaire.title", -1, r.Title, r.TitleElement, "string")
+	for i, v := range r.DerivedFrom {
+		n.primitive("Questionnaire.derivedFrom", i, v, elementAt(r.DerivedFromElement, i), "string")
+	}
+	n.primitive("Questionnaire.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("Questionnaire.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	for i, v := range r.SubjectType {
+		n.primitive("Questionnaire.subjectType", i, v, elementAt(r.SubjectTypeElement, i), "code")
+	}
+	n.primitive("Questionnaire.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("Questionnaire.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("Questionnaire.contact", i, v)
+	}
+	n.primitive("Questionnaire.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("Questionnaire.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("Questionnaire.jurisdiction", i, v)
+	}
+	n.primitive("Questionnaire.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("Questionnaire.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("Questionnaire.approvalDate", -1, r.ApprovalDate, r.ApprovalDateElement, "date")
+	n.primitive("Questionnaire.lastReviewDate", -1, r.LastReviewDate, r.LastReviewDateElement, "date")
+	n.element("Questionnaire.effectivePeriod", -1, r.EffectivePeriod)
+	for i, v := range r.Code {
+		n.element("Questionnaire.code", i, v)
+	}
+	for i, v := range r.Item {
+		n.element("Questionnaire.item", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Questionnaire as BSON document with the structure of its FHIR JSON
+func (r Questionnaire) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Questionnaire from a BSON document with the structure of its FHIR JSON
+func (r *Questionnaire) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Questionnaire as protobuf message Questionnaire of fhir.proto
+func (r Questionnaire) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Questionnaire to the encoded message
+func (r Questionnaire) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	for _, v := range r.DerivedFrom {
+		e.string(14, v)
+	}
+	e.varint(15, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(16, protoBool(*r.Experimental))
+	}
+	if len(r.SubjectType) > 0 {
+		var p protoEncoder
+		for _, v := range r.SubjectType {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(17, p.buf)
+	}
+	if r.Date != nil {
+		e.string(18, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(19, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(20, v)
+	}
+	if r.Description != nil {
+		e.string(21, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(22, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(23, v)
+	}
+	if r.Purpose != nil {
+		e.string(24, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(25, *r.Copyright)
+	}
+	if r.ApprovalDate != nil {
+		e.string(26, *r.ApprovalDate)
+	}
+	if r.LastReviewDate != nil {
+		e.string(27, *r.LastReviewDate)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(28, *r.EffectivePeriod)
+	}
+	for _, v := range r.Code {
+		e.message(29, v)
+	}
+	for _, v := range r.Item {
+		e.message(30, v)
+	}
+	if r.IdElement != nil {
+		e.message(31, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(32, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(33, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(34, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(35, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(36, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(37, *r.TitleElement)
+	}
+	for _, v := range r.DerivedFromElement {
+		e.element(38, v)
+	}
+	if r.StatusElement != nil {
+		e.message(39, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(40, *r.ExperimentalElement)
+	}
+	for _, v := range r.SubjectTypeElement {
+		e.element(41, v)
+	}
+	if r.DateElement != nil {
+		e.message(42, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(43, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(44, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(45, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(46, *r.CopyrightElement)
+	}
+	if r.ApprovalDateElement != nil {
+		e.message(47, *r.ApprovalDateElement)
+	}
+	if r.LastReviewDateElement != nil {
+		e.message(48, *r.LastReviewDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Questionnaire from protobuf message Questionnaire of fhir.proto
+func (r *Questionnaire) UnmarshalProto(b []byte) error {
+	*r = Questionnaire{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			r.DerivedFrom = append(r.DerivedFrom, d.string())
+		case 15:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 16:
+			v := d.bool()
+			r.Experimental = &v
+		case 17:
+			for _, n := range d.varints() {
+				var v ResourceType
+				d.enum(n, v.setProtoNumber)
+				r.SubjectType = append(r.SubjectType, v)
+			}
+		case 18:
+			v := d.string()
+			r.Date = &v
+		case 19:
+			v := d.string()
+			r.Publisher = &v
+		case 20:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 21:
+			v := d.string()
+			r.Description = &v
+		case 22:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 24:
+			v := d.string()
+			r.Purpose = &v
+		case 25:
+			v := d.string()
+			r.Copyright = &v
+		case 26:
+			v := d.string()
+			r.ApprovalDate = &v
+		case 27:
+			v := d.string()
+			r.LastReviewDate = &v
+		case 28:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 29:
+			var v Coding
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 30:
+			var v QuestionnaireItem
+			d.message(&v)
+			r.Item = append(r.Item, v)
+		case 31:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 38:
+			r.DerivedFromElement = append(r.DerivedFromElement, d.element())
+		case 39:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 41:
+			r.SubjectTypeElement = append(r.SubjectTypeElement, d.element())
+		case 42:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.ApprovalDateElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.LastReviewDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given QuestionnaireItem as JSON into a byte slice
+func (r QuestionnaireItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the QuestionnaireItem as JSON object with the members in the order of the fields
+func (r QuestionnaireItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("linkId")
+	e.string(r.LinkId)
+	if r.LinkIdElement != nil {
+		e.key("_linkId")
+		r.LinkIdElement.appendJSON(e)
+	}
+	if r.Definition != nil {
+		e.key("definition")
+		e.string(*r.Definition)
+	}
+	if r.DefinitionElement != nil {
+		e.key("_definition")
+		r.DefinitionElement.appendJSON(e)
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Prefix != nil {
+		e.key("prefix")
+		e.string(*r.Prefix)
+	}
+	if r.PrefixElement != nil {
+		e.key("_prefix")
+		r.PrefixElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		e.string(*r.Text)
+	}
+	if r.TextElement != nil {
+		e.key("_text")
+		r.TextElement.appendJSON(e)
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if len(r.EnableWhen) > 0 {
+		e.key("enableWhen")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.EnableWhen {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.EnableBehavior != nil {
+		e.key("enableBehavior")
+		e.string(r.EnableBehavior.Code())
+	}
+	if r.EnableBehaviorElement != nil {
+		e.key("_enableBehavior")
+		r.EnableBehaviorElement.appendJSON(e)
+	}
+	if r.Required != nil {
+		e.key("required")
+		e.bool(*r.Required)
+	}
+	if r.RequiredElement != nil {
+		e.key("_required")
+		r.RequiredElement.appendJSON(e)
+	}
+	if r.Repeats != nil {
+		e.key("repeats")
+		e.bool(*r.Repeats)
+	}
+	if r.RepeatsElement != nil {
+		e.key("_repeats")
+		r.RepeatsElement.appendJSON(e)
+	}
+	if r.ReadOnly != nil {
+		e.key("readOnly")
+		e.bool(*r.ReadOnly)
+	}
+	if r.ReadOnlyElement != nil {
+		e.key("_readOnly")
+		r.ReadOnlyElement.appendJSON(e)
+	}
+	if r.MaxLength != nil {
+		e.key("maxLength")
+		e.int(*r.MaxLength)
+	}
+	if r.MaxLengthElement != nil {
+		e.key("_maxLength")
+		r.MaxLengthElement.appendJSON(e)
+	}
+	if r.AnswerValueSet != nil {
+		e.key("answerValueSet")
+		e.string(*r.AnswerValueSet)
+	}
+	if r.AnswerValueSetElement != nil {
+		e.key("_answerValueSet")
+		r.AnswerValueSetElement.appendJSON(e)
+	}
+	if len(r.AnswerOption) > 0 {
+		e.key("answerOption")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.AnswerOption {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Initial) > 0 {
+		e.key("initial")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Initial {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Item) > 0 {
+		e.key("item")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Item {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given QuestionnaireItem from JSON. Unknown members are ignored.
+func (r *QuestionnaireItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the QuestionnaireItem
+func (r *QuestionnaireItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "linkId":
+			if v, ok := d.string(); ok {
+				r.LinkId = v
+			}
+		case "_linkId":
+			r.LinkIdElement = d.element()
+		case "definition":
+			if v, ok := d.string(); ok {
+				r.Definition = &v
+			} else {
+				r.Definition = nil
+			}
+		case "_definition":
+			r.DefinitionElement = d.element()
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []Coding{}
+			}
+		case "prefix":
+			if v, ok := d.string(); ok {
+				r.Prefix = &v
+			} else {
+				r.Prefix = nil
+			}
+		case "_prefix":
+			r.PrefixElement = d.element()
+		case "text":
+			if v, ok := d.string(); ok {
+				r.Text = &v
+			} else {
+				r.Text = nil
+			}
+		case "_text":
+			r.TextElement = d.element()
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "enableWhen":
+			a := d.array()
+			r.EnableWhen = nil
+			for a.next() {
+				var v QuestionnaireItemEnableWhen
+				v.decodeJSON(d)
+				r.EnableWhen = append(r.EnableWhen, v)
+			}
+			if r.EnableWhen == nil && !a.isNull {
+				r.EnableWhen = []QuestionnaireItemEnableWhen{}
+			}
+		case "enableBehavior":
+			if d.null() {
+				r.EnableBehavior = nil
+			} else {
+				var v EnableWhenBehavior
+				d.unmarshal(&v)
+				r.EnableBehavior = &v
+			}
+		case "_enableBehavior":
+			r.EnableBehaviorElement = d.element()
+		case "required":
+			if v, ok := d.bool(); ok {
+				r.Required = &v
+			} else {
+				r.Required = nil
+			}
+		case "_required":
+			r.RequiredElement = d.element()
+		case "repeats":
+			if v, ok := d.bool(); ok {
+				r.Repeats = &v
+			} else {
+				r.Repeats = nil
+			}
+		case "_repeats":
+			r.RepeatsElement = d.element()
+		case "readOnly":
+			if v, ok := d.bool(); ok {
+				r.ReadOnly = &v
+			} else {
+				r.ReadOnly = nil
+			}
+		case "_readOnly":
+			r.ReadOnlyElement = d.element()
+		case "maxLength":
+			if v, ok := d.int(); ok {
+				r.MaxLength = &v
+			} else {
+				r.MaxLength = nil
+			}
+		case "_maxLength":
+			r.MaxLengthElement = d.element()
+		case "answerValueSet":
+			if v, ok := d.string(); ok {
+				r.AnswerValueSet = &v
+			} else {
+				r.AnswerValueSet = nil
+			}
+		case "_answerValueSet":
+			r.AnswerValueSetElement = d.element()
+		case "answerOption":
+			a := d.array()
+			r.AnswerOption = nil
+			for a.next() {
+				var v QuestionnaireItemAnswerOption
+				v.decodeJSON(d)
+				r.AnswerOption = append(r.AnswerOption, v)
+			}
+			if r.AnswerOption == nil && !a.isNull {
+				r.AnswerOption = []QuestionnaireItemAnswerOption{}
+			}
+		case "initial":
+			a := d.array()
+			r.Initial = nil
+			for a.next() {
+				var v QuestionnaireItemInitial
+				v.decodeJSON(d)
+				r.Initial = append(r.Initial, v)
+			}
+			if r.Initial == nil && !a.isNull {
+				r.Initial = []QuestionnaireItemInitial{}
+			}
+		case "item":
+			a := d.array()
+			r.Item = nil
+			for a.next() {
+				var v QuestionnaireItem
+				v.decodeJSON(d)
+				r.Item = append(r.Item, v)
+			}
+			if r.Item == nil && !a.isNull {
+				r.Item = []QuestionnaireItem{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the QuestionnaireItem which shares no memory with the original
+func (r QuestionnaireItem) DeepCopy() QuestionnaireItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.LinkIdElement != nil {
+		v := r.LinkIdElement.DeepCopy()
+		out.LinkIdElement = &v
+	}
+	if r.Definition != nil {
+		v := *r.Definition
+		out.Definition = &v
+	}
+	if r.DefinitionElement != nil {
+		v := r.DefinitionElement.DeepCopy()
+		out.DefinitionElement = &v
+	}
+	if r.Code != nil {
+		out.Code = make([]Coding, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Prefix != nil {
+		v := *r.Prefix
+		out.Prefix = &v
+	}
+	if r.PrefixElement != nil {
+		v := r.PrefixElement.DeepCopy()
+		out.PrefixElement = &v
+	}
+	if r.Text != nil {
+		v := *r.Text
+		out.Text = &v
+	}
+	if r.TextElement != nil {
+		v := r.TextElement.DeepCopy()
+		out.TextElement = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.EnableWhen != nil {
+		out.EnableWhen = make([]QuestionnaireItemEnableWhen, len(r.EnableWhen))
+		for i := range r.EnableWhen {
+			out.EnableWhen[i] = r.EnableWhen[i].DeepCopy()
+		}
+	}
+	if r.EnableBehavior != nil {
+		v := *r.EnableBehavior
+		out.EnableBehavior = &v
+	}
+	if r.EnableBehaviorElement != nil {
+		v := r.EnableBehaviorElement.DeepCopy()
+		out.EnableBehaviorElement = &v
+	}
+	if r.Required != nil {
+		v := *r.Required
+		out.Required = &v
+	}
+	if r.RequiredElement != nil {
+		v := r.RequiredElement.DeepCopy()
+		out.RequiredElement = &v
+	}
+	if r.Repeats != nil {
+		v := *r.Repeats
+		out.Repeats = &v
+	}
+	if r.RepeatsElement != nil {
+		v := r.RepeatsElement.DeepCopy()
+		out.RepeatsElement = &v
+	}
+	if r.ReadOnly != nil {
+		v := *r.ReadOnly
+		out.ReadOnly = &v
+	}
+	if r.ReadOnlyElement != nil {
+		v := r.ReadOnlyElement.DeepCopy()
+		out.ReadOnlyElement = &v
+	}
+	if r.MaxLength != nil {
+		v := *r.MaxLength
+		out.MaxLength = &v
+	}
+	if r.MaxLengthElement != nil {
+		v := r.MaxLengthElement.DeepCopy()
+		out.MaxLengthElement = &v
+	}
+	if r.AnswerValueSet != nil {
+		v := *r.AnswerValueSet
+		out.AnswerValueSet = &v
+	}
+	if r.AnswerValueSetElement != nil {
+		v := r.AnswerValueSetElement.DeepCopy()
+		out.AnswerValueSetElement = &v
+	}
+	if r.AnswerOption != nil {
+		out.AnswerOption = make([]QuestionnaireItemAnswerOption, len(r.AnswerOption))
+		for i := range r.AnswerOption {
+			out.AnswerOption[i] = r.AnswerOption[i].DeepCopy()
+		}
+	}
+	if r.Initial != nil {
+		out.Initial = make([]QuestionnaireItemInitial, len(r.Initial))
+		for i := range r.Initial {
+			out.Initial[i] = r.Initial[i].DeepCopy()
+		}
+	}
+	if r.Item != nil {
+		out.Item = make([]QuestionnaireItem, len(r.Item))
+		for i := range r.Item {
+			out.Item[i] = r.Item[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r QuestionnaireItem) Equal(other QuestionnaireItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.LinkId != other.LinkId {
+		return false
+	}
+	if (r.LinkIdElement == nil) != (other.LinkIdElement == nil) || r.LinkIdElement != nil && !r.LinkIdElement.Equal(*other.LinkIdElement) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && *r.Definition != *other.Definition {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.Equal(*other.DefinitionElement) {
+		return false
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if (r.Prefix == nil) != (other.Prefix == nil) || r.Prefix != nil && *r.Prefix != *other.Prefix {
+		return false
+	}
+	if (r.PrefixElement == nil) != (other.PrefixElement == nil) || r.PrefixElement != nil && !r.PrefixElement.Equal(*other.PrefixElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && *r.Text != *other.Text {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.Equal(*other.TextElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if len(r.EnableWhen) != len(other.EnableWhen) {
+		return false
+	}
+	for i := range r.EnableWhen {
+		if !r.EnableWhen[i].Equal(other.EnableWhen[i]) {
+			return false
+		}
+	}
+	if (r.EnableBehavior == nil) != (other.EnableBehavior == nil) || r.EnableBehavior != nil && *r.EnableBehavior != *other.EnableBehavior {
+		return false
+	}
+	if (r.EnableBehaviorElement == nil) != (other.EnableBehaviorElement == nil) || r.EnableBehaviorElement != nil && !r.EnableBehaviorElement.Equal(*other.EnableBehaviorElement) {
+		return false
+	}
+	if (r.Required == nil) != (other.Required == nil) || r.Required != nil && *r.Required != *other.Required {
+		return false
+	}
+	if (r.RequiredElement == nil) != (other.RequiredElement == nil) || r.RequiredElement != nil && !r.RequiredElement.Equal(*other.RequiredElement) {
+		return false
+	}
+	if (r.Repeats == nil) != (other.Repeats == nil) || r.Repeats != nil && *r.Repeats != *other.Repeats {
+		return false
+	}
+	if (r.RepeatsElement == nil) != (other.RepeatsElement == nil) || r.RepeatsElement != nil && !r.RepeatsElement.Equal(*other.RepeatsElement) {
+		return false
+	}
+	if (r.ReadOnly == nil) != (other.ReadOnly == nil) || r.ReadOnly != nil && *r.ReadOnly != *other.ReadOnly {
+		return false
+	}
+	if (r.ReadOnlyElement == nil) != (other.ReadOnlyElement == nil) || r.ReadOnlyElement != nil && !r.ReadOnlyElement.Equal(*other.ReadOnlyElement) {
+		return false
+	}
+	if (r.MaxLength == nil) != (other.MaxLength == nil) || r.MaxLength != nil && *r.MaxLength != *other.MaxLength {
+		return false
+	}
+	if (r.MaxLengthElement == nil) != (other.MaxLengthElement == nil) || r.MaxLengthElement != nil && !r.MaxLengthElement.Equal(*other.MaxLengthElement) {
+		return false
+	}
+	if (r.AnswerValueSet == nil) != (other.AnswerValueSet == nil) || r.AnswerValueSet != nil && *r.AnswerValueSet != *other.AnswerValueSet {
+		return false
+	}
+	if (r.AnswerValueSetElement == nil) != (other.AnswerValueSetElement == nil) || r.AnswerValueSetElement != nil && !r.AnswerValueSetElement.Equal(*other.AnswerValueSetElement) {
+		return false
+	}
+	if len(r.AnswerOption) != len(other.AnswerOption) {
+		return false
+	}
+	for i := range r.AnswerOption {
+		if !r.AnswerOption[i].Equal(other.AnswerOption[i]) {
+			return false
+		}
+	}
+	if len(r.Initial) != len(other.Initial) {
+		return false
+	}
+	for i := range r.Initial {
+		if !r.Initial[i].Equal(other.Initial[i]) {
+			return false
+		}
+	}
+	if len(r.Item) != len(other.Item) {
+		return false
+	}
+	for i := range r.Item {
+		if !r.Item[i].Equal(other.Item[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r QuestionnaireItem) EqualsDeep(other QuestionnaireItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.LinkId, other.LinkId) {
+		return false
+	}
+	if (r.LinkIdElement == nil) != (other.LinkIdElement == nil) || r.LinkIdElement != nil && !r.LinkIdElement.EqualsDeep(*other.LinkIdElement) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.EqualsDeep(*other.DefinitionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if (r.Prefix == nil) != (other.Prefix == nil) || r.Prefix != nil && !equivalentString(*r.Prefix, *other.Prefix) {
+		return false
+	}
+	if (r.PrefixElement == nil) != (other.PrefixElement == nil) || r.PrefixElement != nil && !r.PrefixElement.EqualsDeep(*other.PrefixElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if (r.TextElement == nil) != (other.TextElement == nil) || r.TextElement != nil && !r.TextElement.EqualsDeep(*other.TextElement) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentList(len(r.EnableWhen), len(other.EnableWhen), func(i, j int) bool {
+		return r.EnableWhen[i].EqualsDeep(other.EnableWhen[j])
+	}) {
+		return false
+	}
+	if (r.EnableBehavior == nil) != (other.EnableBehavior == nil) || r.EnableBehavior != nil && *r.EnableBehavior != *other.EnableBehavior {
+		return false
+	}
+	if (r.EnableBehaviorElement == nil) != (other.EnableBehaviorElement == nil) || r.EnableBehaviorElement != nil && !r.EnableBehaviorElement.EqualsDeep(*other.EnableBehaviorElement) {
+		return false
+	}
+	if (r.Required == nil) != (other.Required == nil) || r.Required != nil && *r.Required != *other.Required {
+		return false
+	}
+	if (r.RequiredElement == nil) != (other.RequiredElement == nil) || r.RequiredElement != nil && !r.RequiredElement.EqualsDeep(*other.RequiredElement) {
+		return false
+	}
+	if (r.Repeats == nil) != (other.Repeats == nil) || r.Repeats != nil && *r.Repeats != *other.Repeats {
+		return false
+	}
+	if (r.RepeatsElement == nil) != (other.RepeatsElement == nil) || r.RepeatsElement != nil && !r.RepeatsElement.EqualsDeep(*other.RepeatsElement) {
+		return false
+	}
+	if (r.ReadOnly == nil) != (other.ReadOnly == nil) || r.ReadOnly != nil && *r.ReadOnly != *other.ReadOnly {
+		return false
+	}
+	if (r.ReadOnlyElement == nil) != (other.ReadOnlyElement == nil) || r.ReadOnlyElement != nil && !r.ReadOnlyElement.EqualsDeep(*other.ReadOnlyElement) {
+		return false
+	}
+	if (r.MaxLength == nil) != (other.MaxLength == nil) || r.MaxLength != nil && *r.MaxLength != *other.MaxLength {
+		return false
+	}
+	if (r.MaxLengthElement == nil) != (other.MaxLengthElement == nil) || r.MaxLengthElement != nil && !r.MaxLengthElement.EqualsDeep(*other.MaxLengthElement) {
+		return false
+	}
+	if (r.AnswerValueSet == nil) != (other.AnswerValueSet == nil) || r.AnswerValueSet != nil && !equivalentString(*r.AnswerValueSet, *other.AnswerValueSet) {
+		return false
+	}
+	if (r.AnswerValueSetElement == nil) != (other.AnswerValueSetElement == nil) || r.AnswerValueSetElement != nil && !r.AnswerValueSetElement.EqualsDeep(*other.AnswerValueSetElement) {
+		return false
+	}
+	if !equivalentList(len(r.AnswerOption), len(other.AnswerOption), func(i, j int) bool {
+		return r.AnswerOption[i].EqualsDeep(other.AnswerOption[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Initial), len(other.Initial), func(i, j int) bool {
+		return r.Initial[i].EqualsDeep(other.Initial[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Item), len(other.Item), func(i, j int) bool {
+		return r.Item[i].EqualsDeep(other.Item[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r QuestionnaireItem) EqualsShallow(other QuestionnaireItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.LinkId, other.LinkId) {
+		return false
+	}
+	if (r.Definition == nil) != (other.Definition == nil) || r.Definition != nil && !equivalentString(*r.Definition, *other.Definition) {
+		return false
+	}
+	if (r.Prefix == nil) != (other.Prefix == nil) || r.Prefix != nil && !equivalentString(*r.Prefix, *other.Prefix) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !equivalentString(*r.Text, *other.Text) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.EnableBehavior == nil) != (other.EnableBehavior == nil) || r.EnableBehavior != nil && *r.EnableBehavior != *other.EnableBehavior {
+		return false
+	}
+	if (r.Required == nil) != (other.Required == nil) || r.Required != nil && *r.Required != *other.Required {
+		return false
+	}
+	if (r.Repeats == nil) != (other.Repeats == nil) || r.Repeats != nil && *r.Repeats != *other.Repeats {
+		return false
+	}
+	if (r.ReadOnly == nil) != (other.ReadOnly == nil) || r.ReadOnly != nil && *r.ReadOnly != *other.ReadOnly {
+		return false
+	}
+	if (r.MaxLength == nil) != (other.MaxLength == nil) || r.MaxLength != nil && *r.MaxLength != *other.MaxLength {
+		return false
+	}
+	if (r.AnswerValueSet == nil) != (other.AnswerValueSet == nil) || r.AnswerValueSet != nil && !equivalentString(*r.AnswerValueSet, *other.AnswerValueSet) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given QuestionnaireItem as FHIR XML
+func (r QuestionnaireItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "linkId", r.LinkId, r.LinkIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "prefix", r.Prefix, r.PrefixElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "text", r.Text, r.TextElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	for _, v := range r.EnableWhen {
+		if err := encodeXMLElement(e, "enableWhen", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "enableBehavior", r.EnableBehavior, r.EnableBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "required", r.Required, r.RequiredElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "repeats", r.Repeats, r.RepeatsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "readOnly", r.ReadOnly, r.ReadOnlyElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maxLength", r.MaxLength, r.MaxLengthElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "answerValueSet", r.AnswerValueSet, r.AnswerValueSetElement); err != nil {
+		return err
+	}
+	for _, v := range r.AnswerOption {
+		if err := encodeXMLElement(e, "answerOption", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Initial {
+		if err := encodeXMLElement(e, "initial", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Item {
+		if err := encodeXMLElement(e, "item", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given QuestionnaireItem from FHIR XML
+func (r *QuestionnaireItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "linkId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LinkId = v
+				}
+				r.LinkIdElement = element
+			case "definition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Definition = &v
+				}
+				r.DefinitionElement = element
+			case "code":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "prefix":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Prefix = &v
+				}
+				r.PrefixElement = element
+			case "text":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Text = &v
+				}
+				r.TextElement = element
+			case "type":
+				var v QuestionnaireItemType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "enableWhen":
+				var v QuestionnaireItemEnableWhen
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EnableWhen = append(r.EnableWhen, v)
+			case "enableBehavior":
+				var v EnableWhenBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EnableBehavior = &v
+				}
+				r.EnableBehaviorElement = element
+			case "required":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Required = &v
+				}
+				r.RequiredElement = element
+			case "repeats":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Repeats = &v
+				}
+				r.RepeatsElement = element
+			case "readOnly":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReadOnly = &v
+				}
+				r.ReadOnlyElement = element
+			case "maxLength":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaxLength = &v
+				}
+				r.MaxLengthElement = element
+			case "answerValueSet":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AnswerValueSet = &v
+				}
+				r.AnswerValueSetElement = element
+			case "answerOption":
+				var v QuestionnaireItemAnswerOption
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AnswerOption = append(r.AnswerOption, v)
+			case "initial":
+				var v QuestionnaireItemInitial
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Initial = append(r.Initial, v)
+			case "item":
+				var v QuestionnaireItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Item = append(r.Item, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the QuestionnaireItem as properties to the RDF node n
+func (r QuestionnaireItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Questionnaire.item.linkId", -1, r.LinkId, r.LinkIdElement, "string")
+	n.primitive("Questionnaire.item.definition", -1, r.Definition, r.DefinitionElement, "string")
+	for i, v := range r.Code {
+		n.element("Questionnaire.item.code", i, v)
+	}
+	n.primitive("Questionnaire.item.prefix", -1, r.Prefix, r.PrefixElement, "string")
+	n.primitive("Questionnaire.item.text", -1, r.Text, r.TextElement, "string")
+	n.primitive("Questionnaire.item.type", -1, r.Type, r.TypeElement, "code")
+	for i, v := range r.EnableWhen {
+		n.element("Questionnaire.item.enableWhen", i, v)
+	}
+	n.primitive("Questionnaire.item.enableBehavior", -1, r.EnableBehavior, r.EnableBehaviorElement, "code")
+	n.primitive("Questionnaire.item.required", -1, r.Required, r.RequiredElement, "boolean")
+	n.primitive("Questionnaire.item.repeats", -1, r.Repeats, r.RepeatsElement, "boolean")
+	n.primitive("Questionnaire.item.readOnly", -1, r.ReadOnly, r.ReadOnlyElement, "boolean")
+	n.primitive("Questionnaire.item.maxLength", -1, r.MaxLength, r.MaxLengthElement, "integer")
+	n.primitive("Questionnaire.item.answerValueSet", -1, r.AnswerValueSet, r.AnswerValueSetElement, "string")
+	for i, v := range r.AnswerOption {
+		n.element("Questionnaire.item.answerOption", i, v)
+	}
+	for i, v := range r.Initial {
+		n.element("Questionnaire.item.initial", i, v)
+	}
+	for i, v := range r.Item {
+		n.element("Questionnaire.item.item", i, v)
+	}
+}
+
+// MarshalBSON marshals the given QuestionnaireItem as BSON document with the structure of its FHIR JSON
+func (r QuestionnaireItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given QuestionnaireItem from a BSON document with the structure of its FHIR JSON
+func (r *QuestionnaireItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given QuestionnaireItem as protobuf message QuestionnaireItem of fhir.proto
+func (r QuestionnaireItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the QuestionnaireItem to the encoded message
+func (r QuestionnaireItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.LinkId != "" {
+		e.string(4, r.LinkId)
+	}
+	if r.Definition != nil {
+		e.string(5, *r.Definition)
+	}
+	for _, v := range r.Code {
+		e.message(6, v)
+	}
+	if r.Prefix != nil {
+		e.string(7, *r.Prefix)
+	}
+	if r.Text != nil {
+		e.string(8, *r.Text)
+	}
+	e.varint(9, r.Type.protoNumber())
+	for _, v := range r.EnableWhen {
+		e.message(10, v)
+	}
+	if r.EnableBehavior != nil {
+		e.varint(11, r.EnableBehavior.protoNumber())
+	}
+	if r.Required != nil {
+		e.varint(12, protoBool(*r.Required))
+	}
+	if r.Repeats != nil {
+		e.varint(13, protoBool(*r.Repeats))
+	}
+	if r.ReadOnly != nil {
+		e.varint(14, protoBool(*r.ReadOnly))
+	}
+	if r.MaxLength != nil {
+		e.varint(15, uint64(int64(*r.MaxLength)))
+	}
+	if r.AnswerValueSet != nil {
+		e.string(16, *r.AnswerValueSet)
+	}
+	for _, v := range r.AnswerOption {
+		e.message(17, v)
+	}
+	for _, v := range r.Initial {
+		e.message(18, v)
+	}
+	for _, v := range r.Item {
+		e.message(19, v)
+	}
+	if r.LinkIdElement != nil {
+		e.message(20, *r.LinkIdElement)
+	}
+	if r.DefinitionElement != nil {
+		e.message(21, *r.DefinitionElement)
+	}
+	if r.PrefixElement != nil {
+		e.message(22, *r.PrefixElement)
+	}
+	if r.TextElement != nil {
+		e.message(23, *r.TextElement)
+	}
+	if r.TypeElement != nil {
+		e.message(24, *r.TypeElement)
+	}
+	if r.EnableBehaviorElement != nil {
+		e.message(25, *r.EnableBehaviorElement)
+	}
+	if r.RequiredElement != nil {
+		e.message(26, *r.RequiredElement)
+	}
+	if r.RepeatsElement != nil {
+		e.message(27, *r.RepeatsElement)
+	}
+	if r.ReadOnlyElement != nil {
+		e.message(28, *r.ReadOnlyElement)
+	}
+	if r.MaxLengthElement != nil {
+		e.message(29, *r.MaxLengthElement)
+	}
+	if r.AnswerValueSetElement != nil {
+		e.message(30, *r.AnswerValueSetElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given QuestionnaireItem from protobuf message QuestionnaireItem of fhir.proto
+func (r *QuestionnaireItem) UnmarshalProto(b []byte) error {
+	*r = QuestionnaireItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.LinkId = d.string()
+		case 5:
+			v := d.string()
+			r.Definition = &v
+		case 6:
+			var v Coding
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 7:
+			v := d.string()
+			r.Prefix = &v
+		case 8:
+			v := d.string()
+			r.Text = &v
+		case 9:
+			var v QuestionnaireItemType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 10:
+			var v QuestionnaireItemEnableWhen
+			d.message(&v)
+			r.EnableWhen = append(r.EnableWhen, v)
+		case 11:
+			var v EnableWhenBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.EnableBehavior = &v
+		case 12:
+			v := d.bool()
+			r.Required = &v
+		case 13:
+			v := d.bool()
+			r.Repeats = &v
+		case 14:
+			v := d.bool()
+			r.ReadOnly = &v
+		case 15:
+			v := d.int()
+			r.MaxLength = &v
+		case 16:
+			v := d.string()
+			r.AnswerValueSet = &v
+		case 17:
+			var v QuestionnaireItemAnswerOption
+			d.message(&v)
+			r.AnswerOption = append(r.AnswerOption, v)
+		case 18:
+			var v QuestionnaireItemInitial
+			d.message(&v)
+			r.Initial = append(r.Initial, v)
+		case 19:
+			var v QuestionnaireItem
+			d.message(&v)
+			r.Item = append(r.Item, v)
+		case 20:
+			var v Element
+			d.message(&v)
+			r.LinkIdElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.DefinitionElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.PrefixElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.TextElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.EnableBehaviorElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.RequiredElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.RepeatsElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.ReadOnlyElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.MaxLengthElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.AnswerValueSetElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given QuestionnaireItemEnableWhen as JSON into a byte slice
+func (r QuestionnaireItemEnableWhen) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the QuestionnaireItemEnableWhen as JSON object with the members in the order of the fields
+func (r QuestionnaireItemEnableWhen) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("question")
+	e.string(r.Question)
+	if r.QuestionElement != nil {
+		e.key("_question")
+		r.QuestionElement.appendJSON(e)
+	}
+	e.key("operator")
+	e.string(r.Operator.Code())
+	if r.OperatorElement != nil {
+		e.key("_operator")
+		r.OperatorElement.appendJSON(e)
+	}
+	if r.AnswerBoolean != nil {
+		e.key("answerBoolean")
+		e.bool(*r.AnswerBoolean)
+	}
+	if r.AnswerBooleanElement != nil {
+		e.key("_answerBoolean")
+		r.AnswerBooleanElement.appendJSON(e)
+	}
+	if r.AnswerDecimal != nil {
+		e.key("answerDecimal")
+		e.number(*r.AnswerDecimal)
+	}
+	if r.AnswerDecimalElement != nil {
+		e.key("_answerDecimal")
+		r.AnswerDecimalElement.appendJSON(e)
+	}
+	if r.AnswerInteger != nil {
+		e.key("answerInteger")
+		e.int(*r.AnswerInteger)
+	}
+	if r.AnswerIntegerElement != nil {
+		e.key("_answerInteger")
+		r.AnswerIntegerElement.appendJSON(e)
+	}
+	if r.AnswerDate != nil {
+		e.key("answerDate")
+		e.string(*r.AnswerDate)
+	}
+	if r.AnswerDateElement != nil {
+		e.key("_answerDate")
+		r.AnswerDateElement.appendJSON(e)
+	}
+	if r.AnswerDateTime != nil {
+		e.key("answerDateTime")
+		e.string(*r.AnswerDateTime)
+	}
+	if r.AnswerDateTimeElement != nil {
+		e.key("_answerDateTime")
+		r.AnswerDateTimeElement.appendJSON(e)
+	}
+	if r.AnswerTime != nil {
+		e.key("answerTime")
+		e.string(*r.AnswerTime)
+	}
+	if r.AnswerTimeElement != nil {
+		e.key("_answerTime")
+		r.AnswerTimeElement.appendJSON(e)
+	}
+	if r.AnswerString != nil {
+		e.key("answerString")
+		e.string(*r.AnswerString)
+	}
+	if r.AnswerStringElement != nil {
+		e.key("_answerString")
+		r.AnswerStringElement.appendJSON(e)
+	}
+	if r.AnswerCoding != nil {
+		e.key("answerCoding")
+		r.AnswerCoding.appendJSON(e)
+	}
+	if r.AnswerQuantity != nil {
+		e.key("answerQuantity")
+		r.AnswerQuantity.appendJSON(e)
+	}
+	if r.AnswerReference != nil {
+		e.key("answerReference")
+		r.AnswerReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given QuestionnaireItemEnableWhen from JSON. Unknown members are ignored.
+func (r *QuestionnaireItemEnableWhen) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the QuestionnaireItemEnableWhen
+func (r *QuestionnaireItemEnableWhen) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "question":
+			if v, ok := d.string(); ok {
+				r.Question = v
+			}
+		case "_question":
+			r.QuestionElement = d.element()
+		case "operator":
+			d.unmarshal(&r.Operator)
+		case "_operator":
+			r.OperatorElement = d.element()
+		case "answerBoolean":
+			if v, ok := d.bool(); ok {
+				r.AnswerBoolean = &v
+			} else {
+				r.AnswerBoolean = nil
+			}
+		case "_answerBoolean":
+			r.AnswerBooleanElement = d.element()
+		case "answerDecimal":
+			if v, ok := d.number(); ok {
+				r.AnswerDecimal = &v
+			} else {
+				r.AnswerDecimal = nil
+			}
+		case "_answerDecimal":
+			r.AnswerDecimalElement = d.element()
+		case "answerInteger":
+			if v, ok := d.int(); ok {
+				r.AnswerInteger = &v
+			} else {
+				r.AnswerInteger = nil
+			}
+		case "_answerInteger":
+			r.AnswerIntegerElement = d.element()
+		case "answerDate":
+			if v, ok := d.string(); ok {
+				r.AnswerDate = &v
+			} else {
+				r.AnswerDate = nil
+			}
+		case "_answerDate":
+			r.AnswerDateElement = d.element()
+		case "answerDateTime":
+			if v, ok := d.string(); ok {
+				r.AnswerDateTime = &v
+			} else {
+				r.AnswerDateTime = nil
+			}
+		case "_answerDateTime":
+			r.AnswerDateTimeElement = d.element()
+		case "answerTime":
+			if v, ok := d.string(); ok {
+				r.AnswerTime = &v
+			} else {
+				r.AnswerTime = nil
+			}
+		case "_answerTime":
+			r.AnswerTimeElement = d.element()
+		case "answerString":
+			if v, ok := d.string(); ok {
+				r.AnswerString = &v
+			} else {
+				r.AnswerString = nil
+			}
+		case "_answerString":
+			r.AnswerStringElement = d.element()
+		case "answerCoding":
+			if d.null() {
+				r.AnswerCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.AnswerCoding = &v
+			}
+		case "answerQuantity":
+			if d.null() {
+				r.AnswerQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.AnswerQuantity = &v
+			}
+		case "answerReference":
+			if d.null() {
+				r.AnswerReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.AnswerReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the QuestionnaireItemEnableWhen which shares no memory with the original
+func (r QuestionnaireItemEnableWhen) DeepCopy() QuestionnaireItemEnableWhen {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.QuestionElement != nil {
+		v := r.QuestionElement.DeepCopy()
+		out.QuestionElement = &v
+	}
+	if r.OperatorElement != nil {
+		v := r.OperatorElement.DeepCopy()
+		out.OperatorElement = &v
+	}
+	if r.AnswerBoolean != nil {
+		v := *r.AnswerBoolean
+		out.AnswerBoolean = &v
+	}
+	if r.AnswerBooleanElement != nil {
+		v := r.AnswerBooleanElement.DeepCopy()
+		out.AnswerBooleanElement = &v
+	}
+	if r.AnswerDecimal != nil {
+		v := *r.AnswerDecimal
+		out.AnswerDecimal = &v
+	}
+	if r.AnswerDecimalElement != nil {
+		v := r.AnswerDecimalElement.DeepCopy()
+		out.AnswerDecimalElement = &v
+	}
+	if r.AnswerInteger != nil {
+		v := *r.AnswerInteger
+		out.AnswerInteger = &v
+	}
+	if r.AnswerIntegerElement != nil {
+		v := r.AnswerIntegerElement.DeepCopy()
+		out.AnswerIntegerElement = &v
+	}
+	if r.AnswerDate != nil {
+		v := *r.AnswerDate
+		out.AnswerDate = &v
+	}
+	if r.AnswerDateElement != nil {
+		v := r.AnswerDateElement.DeepCopy()
+		out.AnswerDateElement = &v
+	}
+	if r.AnswerDateTime != nil {
+		v := *r.AnswerDateTime
+		out.AnswerDateTime = &v
+	}
+	if r.AnswerDateTimeElement != nil {
+		v := r.AnswerDateTimeElement.DeepCopy()
+		out.AnswerDateTimeElement = &v
+	}
+	if r.AnswerTime != nil {
+		v := *r.AnswerTime
+		out.AnswerTime = &v
+	}
+	if r.AnswerTimeElement != nil {
+		v := r.AnswerTimeElement.DeepCopy()
+		out.AnswerTimeElement = &v
+	}
+	if r.AnswerString != nil {
+		v := *r.AnswerString
+		out.AnswerString = &v
+	}
+	if r.AnswerStringElement != nil {
+		v := r.AnswerStringElement.DeepCopy()
+		out.AnswerStringElement = &v
+	}
+	if r.AnswerCoding != nil {
+		v := r.AnswerCoding.DeepCopy()
+		out.AnswerCoding = &v
+	}
+	if r.AnswerQuantity != nil {
+		v := r.AnswerQuantity.DeepCopy()
+		out.AnswerQuantity = &v
+	}
+	if r.AnswerReference != nil {
+		v := r.AnswerReference.DeepCopy()
+		out.AnswerReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r QuestionnaireItemEnableWhen) Equal(other QuestionnaireItemEnableWhen) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Question != other.Question {
+		return false
+	}
+	if (r.QuestionElement == nil) != (other.QuestionElement == nil) || r.QuestionElement != nil && !r.QuestionElement.Equal(*other.QuestionElement) {
+		return false
+	}
+	if r.Operator != other.Operator {
+		return false
+	}
+	if (r.OperatorElement == nil) != (other.OperatorElement == nil) || r.OperatorElement != nil && !r.OperatorElement.Equal(*other.OperatorElement) {
+		return false
+	}
+	if (r.AnswerBoolean == nil) != (other.AnswerBoolean == nil) || r.AnswerBoolean != nil && *r.AnswerBoolean != *other.AnswerBoolean {
+		return false
+	}
+	if (r.AnswerBooleanElement == nil) != (other.AnswerBooleanElement == nil) || r.AnswerBooleanElement != nil && !r.AnswerBooleanElement.Equal(*other.AnswerBooleanElement) {
+		return false
+	}
+	if (r.AnswerDecimal == nil) != (other.AnswerDecimal == nil) || r.AnswerDecimal != nil && !EqualDecimal(*r.AnswerDecimal, *other.AnswerDecimal) {
+		return false
+	}
+	if (r.AnswerDecimalElement == nil) != (other.AnswerDecimalElement == nil) || r.AnswerDecimalElement != nil && !r.AnswerDecimalElement.Equal(*other.AnswerDecimalElement) {
+		return false
+	}
+	if (r.AnswerInteger == nil) != (other.AnswerInteger == nil) || r.AnswerInteger != nil && *r.AnswerInteger != *other.AnswerInteger {
+		return false
+	}
+	if (r.AnswerIntegerElement == nil) != (other.AnswerIntegerElement == nil) || r.AnswerIntegerElement != nil && !r.AnswerIntegerElement.Equal(*other.AnswerIntegerElement) {
+		return false
+	}
+	if (r.AnswerDate == nil) != (other.AnswerDate == nil) || r.AnswerDate != nil && *r.AnswerDate != *other.AnswerDate {
+		return false
+	}
+	if (r.AnswerDateElement == nil) != (other.AnswerDateElement == nil) || r.AnswerDateElement != nil && !r.AnswerDateElement.Equal(*other.AnswerDateElement) {
+		return false
+	}
+	if (r.AnswerDateTime == nil) != (other.AnswerDateTime == nil) || r.AnswerDateTime != nil && *r.AnswerDateTime != *other.AnswerDateTime {
+		return false
+	}
+	if (r.AnswerDateTimeElement == nil) != (other.AnswerDateTimeElement == nil) || r.AnswerDateTimeElement != nil && !r.AnswerDateTimeElement.Equal(*other.AnswerDateTimeElement) {
+		return false
+	}
+	if (r.AnswerTime == nil) != (other.AnswerTime == nil) || r.AnswerTime != nil && *r.AnswerTime != *other.AnswerTime {
+		return false
+	}
+	if (r.AnswerTimeElement == nil) != (other.AnswerTimeElement == nil) || r.AnswerTimeElement != nil && !r.AnswerTimeElement.Equal(*other.AnswerTimeElement) {
+		return false
+	}
+	if (r.AnswerString == nil) != (other.AnswerString == nil) || r.AnswerString != nil && *r.AnswerString != *other.AnswerString {
+		return false
+	}
+	if (r.AnswerStringElement == nil) != (other.AnswerStringElement == nil) || r.AnswerStringElement != nil && !r.AnswerStringElement.Equal(*other.AnswerStringElement) {
+		return false
+	}
+	if (r.AnswerCoding == nil) != (other.AnswerCoding == nil) || r.AnswerCoding != nil && !r.AnswerCoding.Equal(*other.AnswerCoding) {
+		return false
+	}
+	if (r.AnswerQuantity == nil) != (other.AnswerQuantity == nil) || r.AnswerQuantity != nil && !r.AnswerQuantity.Equal(*other.AnswerQuantity) {
+		return false
+	}
+	if (r.AnswerReference == nil) != (other.AnswerReference == nil) || r.AnswerReference != nil && !r.AnswerReference.Equal(*other.AnswerReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r QuestionnaireItemEnableWhen) EqualsDeep(other QuestionnaireItemEnableWhen) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Question, other.Question) {
+		return false
+	}
+	if (r.QuestionElement == nil) != (other.QuestionElement == nil) || r.QuestionElement != nil && !r.QuestionElement.EqualsDeep(*other.QuestionElement) {
+		return false
+	}
+	if r.Operator != other.Operator {
+		return false
+	}
+	if (r.OperatorElement == nil) != (other.OperatorElement == nil) || r.OperatorElement != nil && !r.OperatorElement.EqualsDeep(*other.OperatorElement) {
+		return false
+	}
+	if (r.AnswerBoolean == nil) != (other.AnswerBoolean == nil) || r.AnswerBoolean != nil && *r.AnswerBoolean != *other.AnswerBoolean {
+		return false
+	}
+	if (r.AnswerBooleanElement == nil) != (other.AnswerBooleanElement == nil) || r.AnswerBooleanElement != nil && !r.AnswerBooleanElement.EqualsDeep(*other.AnswerBooleanElement) {
+		return false
+	}
+	if (r.AnswerDecimal == nil) != (other.AnswerDecimal == nil) || r.AnswerDecimal != nil && !equivalentDecimal(*r.AnswerDecimal, *other.AnswerDecimal) {
+		return false
+	}
+	if (r.AnswerDecimalElement == nil) != (other.AnswerDecimalElement == nil) || r.AnswerDecimalElement != nil && !r.AnswerDecimalElement.EqualsDeep(*other.AnswerDecimalElement) {
+		return false
+	}
+	if (r.AnswerInteger == nil) != (other.AnswerInteger == nil) || r.AnswerInteger != nil && *r.AnswerInteger != *other.AnswerInteger {
+		return false
+	}
+	if (r.AnswerIntegerElement == nil) != (other.AnswerIntegerElement == nil) || r.AnswerIntegerElement != nil && !r.AnswerIntegerElement.EqualsDeep(*other.AnswerIntegerElement) {
+		return false
+	}
+	if (r.AnswerDate == nil) != (other.AnswerDate == nil) || r.AnswerDate != nil && !equivalentString(*r.AnswerDate, *other.AnswerDate) {
+		return false
+	}
+	if (r.AnswerDateElement == nil) != (other.AnswerDateElement == nil) || r.AnswerDateElement != nil && !r.AnswerDateElement.EqualsDeep(*other.AnswerDateElement) {
+		return false
+	}
+	if (r.AnswerDateTime == nil) != (other.AnswerDateTime == nil) || r.AnswerDateTime != nil && !equivalentString(*r.AnswerDateTime, *other.AnswerDateTime) {
+		return false
+	}
+	if (r.AnswerDateTimeElement == nil) != (other.AnswerDateTimeElement == nil) || r.AnswerDateTimeElement != nil && !r.AnswerDateTimeElement.EqualsDeep(*other.AnswerDateTimeElement) {
+		return false
+	}
+	if (r.AnswerTime == nil) != (other.AnswerTime == nil) || r.AnswerTime != nil && !equivalentString(*r.AnswerTime, *other.AnswerTime) {
+		return false
+	}
+	if (r.AnswerTimeElement == nil) != (other.AnswerTimeElement == nil) || r.AnswerTimeElement != nil && !r.AnswerTimeElement.EqualsDeep(*other.AnswerTimeElement) {
+		return false
+	}
+	if (r.AnswerString == nil) != (other.AnswerString == nil) || r.AnswerString != nil && !equivalentString(*r.AnswerString, *other.AnswerString) {
+		return false
+	}
+	if (r.AnswerStringElement == nil) != (other.AnswerStringElement == nil) || r.AnswerStringElement != nil && !r.AnswerStringElement.EqualsDeep(*other.AnswerStringElement) {
+		return false
+	}
+	if (r.AnswerCoding == nil) != (other.AnswerCoding == nil) || r.AnswerCoding != nil && !r.AnswerCoding.EqualsDeep(*other.AnswerCoding) {
+		return false
+	}
+	if (r.AnswerQuantity == nil) != (other.AnswerQuantity == nil) || r.AnswerQuantity != nil && !r.AnswerQuantity.EqualsDeep(*other.AnswerQuantity) {
+		return false
+	}
+	if (r.AnswerReference == nil) != (other.AnswerReference == nil) || r.AnswerReference != nil && !r.AnswerReference.EqualsDeep(*other.AnswerReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r QuestionnaireItemEnableWhen) EqualsShallow(other QuestionnaireItemEnableWhen) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Question, other.Question) {
+		return false
+	}
+	if r.Operator != other.Operator {
+		return false
+	}
+	if (r.AnswerBoolean == nil) != (other.AnswerBoolean == nil) || r.AnswerBoolean != nil && *r.AnswerBoolean != *other.AnswerBoolean {
+		return false
+	}
+	if (r.AnswerDecimal == nil) != (other.AnswerDecimal == nil) || r.AnswerDecimal != nil && !equivalentDecimal(*r.AnswerDecimal, *other.AnswerDecimal) {
+		return false
+	}
+	if (r.AnswerInteger == nil) != (other.AnswerInteger == nil) || r.AnswerInteger != nil && *r.AnswerInteger != *other.AnswerInteger {
+		return false
+	}
+	if (r.AnswerDate == nil) != (other.AnswerDate == nil) || r.AnswerDate != nil && !equivalentString(*r.AnswerDate, *other.AnswerDate) {
+		return false
+	}
+	if (r.AnswerDateTime == nil) != (other.AnswerDateTime == nil) || r.AnswerDateTime != nil && !equivalentString(*r.AnswerDateTime, *other.AnswerDateTime) {
+		return false
+	}
+	if (r.AnswerTime == nil) != (other.AnswerTime == nil) || r.AnswerTime != nil && !equivalentString(*r.AnswerTime, *other.AnswerTime) {
+		return false
+	}
+	if (r.AnswerString == nil) != (other.AnswerString == nil) || r.AnswerString != nil && !equivalentString(*r.AnswerString, *other.AnswerString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given QuestionnaireItemEnableWhen as FHIR XML
+func (r QuestionnaireItemEnableWhen) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "question", r.Question, r.QuestionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "operator", r.Operator, r.OperatorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "answerBoolean", r.AnswerBoolean, r.AnswerBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "answerDecimal", r.AnswerDecimal, r.AnswerDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "answerInteger", r.AnswerInteger, r.AnswerIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "answerDate", r.AnswerDate, r.AnswerDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "answerDateTime", r.AnswerDateTime, r.AnswerDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "answerTime", r.AnswerTime, r.AnswerTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "answerString", r.AnswerString, r.AnswerStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "answerCoding", r.AnswerCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "answerQuantity", r.AnswerQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "answerReference", r.AnswerReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given QuestionnaireItemEnableWhen from FHIR XML
+func (r *QuestionnaireItemEnableWhen) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "question":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Question = v
+				}
+				r.QuestionElement = element
+			case "operator":
+				var v QuestionnaireItemOperator
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Operator = v
+				}
+				r.OperatorElement = element
+			case "answerBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AnswerBoolean = &v
+				}
+				r.AnswerBooleanElement = element
+			case "answerDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AnswerDecimal = &v
+				}
+				r.AnswerDecimalElement = element
+			case "answerInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AnswerInteger = &v
+				}
+				r.AnswerIntegerElement = element
+			case "answerDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AnswerDate = &v
+				}
+				r.AnswerDateElement = element
+			case "answerDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AnswerDateTime = &v
+				}
+				r.AnswerDateTimeElement = element
+			case "answerTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AnswerTime = &v
+				}
+				r.AnswerTimeElement = element
+			case "answerString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AnswerString = &v
+				}
+				r.AnswerStringElement = element
+			case "answerCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AnswerCoding = &v
+			case "answerQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AnswerQuantity = &v
+			case "answerReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AnswerReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the QuestionnaireItemEnableWhen as properties to the RDF node n
+func (r QuestionnaireItemEnableWhen) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Questionnaire.item.enableWhen.question", -1, r.Question, r.QuestionElement, "string")
+	n.primitive("Questionnaire.item.enableWhen.operator", -1, r.Operator, r.OperatorElement, "code")
+	n.primitive("Questionnaire.item.enableWhen.answerBoolean", -1, r.AnswerBoolean, r.AnswerBooleanElement, "boolean")
+	n.primitive("Questionnaire.item.enableWhen.answerDecimal", -1, r.AnswerDecimal, r.AnswerDecimalElement, "decimal")
+	n.primitive("Questionnaire.item.enableWhen.answerInteger", -1, r.AnswerInteger, r.AnswerIntegerElement, "integer")
+	n.primitive("Questionnaire.item.enableWhen.answerDate", -1, r.AnswerDate, r.AnswerDateElement, "date")
+	n.primitive("Questionnaire.item.enableWhen.answerDateTime", -1, r.AnswerDateTime, r.AnswerDateTimeElement, "dateTime")
+	n.primitive("Questionnaire.item.enableWhen.answerTime", -1, r.AnswerTime, r.AnswerTimeElement, "time")
+	n.primitive("Questionnaire.item.enableWhen.answerString", -1, r.AnswerString, r.AnswerStringElement, "string")
+	n.element("Questionnaire.item.enableWhen.answerCoding", -1, r.AnswerCoding)
+	n.element("Questionnaire.item.enableWhen.answerQuantity", -1, r.AnswerQuantity)
+	n.element("Questionnaire.item.enableWhen.answerReference", -1, r.AnswerReference)
+}
+
+// MarshalBSON marshals the given QuestionnaireItemEnableWhen as BSON document with the structure of its FHIR JSON
+func (r QuestionnaireItemEnableWhen) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given QuestionnaireItemEnableWhen from a BSON document with the structure of its FHIR JSON
+func (r *QuestionnaireItemEnableWhen) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given QuestionnaireItemEnableWhen as protobuf message QuestionnaireItemEnableWhen of fhir.proto
+func (r QuestionnaireItemEnableWhen) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the QuestionnaireItemEnableWhen to the encoded message
+func (r QuestionnaireItemEnableWhen) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Question != "" {
+		e.string(4, r.Question)
+	}
+	e.varint(5, r.Operator.protoNumber())
+	if r.AnswerBoolean != nil {
+		e.varint(6, protoBool(*r.AnswerBoolean))
+	}
+	if r.AnswerDecimal != nil {
+		e.string(7, string(*r.AnswerDecimal))
+	}
+	if r.AnswerInteger != nil {
+		e.varint(8, uint64(int64(*r.AnswerInteger)))
+	}
+	if r.AnswerDate != nil {
+		e.string(9, *r.AnswerDate)
+	}
+	if r.AnswerDateTime != nil {
+		e.string(10, *r.AnswerDateTime)
+	}
+	if r.AnswerTime != nil {
+		e.string(11, *r.AnswerTime)
+	}
+	if r.AnswerString != nil {
+		e.string(12, *r.AnswerString)
+	}
+	if r.AnswerCoding != nil {
+		e.message(13, *r.AnswerCoding)
+	}
+	if r.AnswerQuantity != nil {
+		e.message(14, *r.AnswerQuantity)
+	}
+	if r.AnswerReference != nil {
+		e.message(15, *r.AnswerReference)
+	}
+	if r.QuestionElement != nil {
+		e.message(16, *r.QuestionElement)
+	}
+	if r.OperatorElement != nil {
+		e.message(17, *r.OperatorElement)
+	}
+	if r.AnswerBooleanElement != nil {
+		e.message(18, *r.AnswerBooleanElement)
+	}
+	if r.AnswerDecimalElement != nil {
+		e.message(19, *r.AnswerDecimalElement)
+	}
+	if r.AnswerIntegerElement != nil {
+		e.message(20, *r.AnswerIntegerElement)
+	}
+	if r.AnswerDateElement != nil {
+		e.message(21, *r.AnswerDateElement)
+	}
+	if r.AnswerDateTimeElement != nil {
+		e.message(22, *r.AnswerDateTimeElement)
+	}
+	if r.AnswerTimeElement != nil {
+		e.message(23, *r.AnswerTimeElement)
+	}
+	if r.AnswerStringElement != nil {
+		e.message(24, *r.AnswerStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given QuestionnaireItemEnableWhen from protobuf message QuestionnaireItemEnableWhen of fhir.proto
+func (r *QuestionnaireItemEnableWhen) UnmarshalProto(b []byte) error {
+	*r = QuestionnaireItemEnableWhen{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Question = d.string()
+		case 5:
+			var v QuestionnaireItemOperator
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Operator = v
+		case 6:
+			v := d.bool()
+			r.AnswerBoolean = &v
+		case 7:
+			v := json.Number(d.string())
+			r.AnswerDecimal = &v
+		case 8:
+			v := d.int()
+			r.AnswerInteger = &v
+		case 9:
+			v := d.string()
+			r.AnswerDate = &v
+		case 10:
+			v := d.string()
+			r.AnswerDateTime = &v
+		case 11:
+			v := d.string()
+			r.AnswerTime = &v
+		case 12:
+			v := d.string()
+			r.AnswerString = &v
+		case 13:
+			var v Coding
+			d.message(&v)
+			r.AnswerCoding = &v
+		case 14:
+			var v Quantity
+			d.message(&v)
+			r.AnswerQuantity = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.AnswerReference = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.QuestionElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.OperatorElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.AnswerBooleanElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.AnswerDecimalElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.AnswerIntegerElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.AnswerDateElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.AnswerDateTimeElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.AnswerTimeElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.AnswerStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given QuestionnaireItemAnswerOption as JSON into a byte slice
+func (r QuestionnaireItemAnswerOption) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the QuestionnaireItemAnswerOption as JSON object with the members in the order of the fields
+func (r QuestionnaireItemAnswerOption) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueDate != nil {
+		e.key("valueDate")
+		e.string(*r.ValueDate)
+	}
+	if r.ValueDateElement != nil {
+		e.key("_valueDate")
+		r.ValueDateElement.appendJSON(e)
+	}
+	if r.ValueTime != nil {
+		e.key("valueTime")
+		e.string(*r.ValueTime)
+	}
+	if r.ValueTimeElement != nil {
+		e.key("_valueTime")
+		r.ValueTimeElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueCoding != nil {
+		e.key("valueCoding")
+		r.ValueCoding.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	if r.InitialSelected != nil {
+		e.key("initialSelected")
+		e.bool(*r.InitialSelected)
+	}
+	if r.InitialSelectedElement != nil {
+		e.key("_initialSelected")
+		r.InitialSelectedElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given QuestionnaireItemAnswerOption from JSON. Unknown members are ignored.
+func (r *QuestionnaireItemAnswerOption) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the QuestionnaireItemAnswerOption
+func (r *QuestionnaireItemAnswerOption) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueDate":
+			if v, ok := d.string(); ok {
+				r.ValueDate = &v
+			} else {
+				r.ValueDate = nil
+			}
+		case "_valueDate":
+			r.ValueDateElement = d.element()
+		case "valueTime":
+			if v, ok := d.string(); ok {
+				r.ValueTime = &v
+			} else {
+				r.ValueTime = nil
+			}
+		case "_valueTime":
+			r.ValueTimeElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueCoding":
+			if d.null() {
+				r.ValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.ValueCoding = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		case "initialSelected":
+			if v, ok := d.bool(); ok {
+				r.InitialSelected = &v
+			} else {
+				r.InitialSelected = nil
+			}
+		case "_initialSelected":
+			r.InitialSelectedElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the QuestionnaireItemAnswerOption which shares no memory with the original
+func (r QuestionnaireItemAnswerOption) DeepCopy() QuestionnaireItemAnswerOption {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueDate != nil {
+		v := *r.ValueDate
+		out.ValueDate = &v
+	}
+	if r.ValueDateElement != nil {
+		v := r.ValueDateElement.DeepCopy()
+		out.ValueDateElement = &v
+	}
+	if r.ValueTime != nil {
+		v := *r.ValueTime
+		out.ValueTime = &v
+	}
+	if r.ValueTimeElement != nil {
+		v := r.ValueTimeElement.DeepCopy()
+		out.ValueTimeElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueCoding != nil {
+		v := r.ValueCoding.DeepCopy()
+		out.ValueCoding = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	if r.InitialSelected != nil {
+		v := *r.InitialSelected
+		out.InitialSelected = &v
+	}
+	if r.InitialSelectedElement != nil {
+		v := r.InitialSelectedElement.DeepCopy()
+		out.InitialSelectedElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r QuestionnaireItemAnswerOption) Equal(other QuestionnaireItemAnswerOption) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && *r.ValueDate != *other.ValueDate {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.Equal(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && *r.ValueTime != *other.ValueTime {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.Equal(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.Equal(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	if (r.InitialSelected == nil) != (other.InitialSelected == nil) || r.InitialSelected != nil && *r.InitialSelected != *other.InitialSelected {
+		return false
+	}
+	if (r.InitialSelectedElement == nil) != (other.InitialSelectedElement == nil) || r.InitialSelectedElement != nil && !r.InitialSelectedElement.Equal(*other.InitialSelectedElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r QuestionnaireItemAnswerOption) EqualsDeep(other QuestionnaireItemAnswerOption) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.EqualsDeep(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.EqualsDeep(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.EqualsDeep(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	if (r.InitialSelected == nil) != (other.InitialSelected == nil) || r.InitialSelected != nil && *r.InitialSelected != *other.InitialSelected {
+		return false
+	}
+	if (r.InitialSelectedElement == nil) != (other.InitialSelectedElement == nil) || r.InitialSelectedElement != nil && !r.InitialSelectedElement.EqualsDeep(*other.InitialSelectedElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r QuestionnaireItemAnswerOption) EqualsShallow(other QuestionnaireItemAnswerOption) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.InitialSelected == nil) != (other.InitialSelected == nil) || r.InitialSelected != nil && *r.InitialSelected != *other.InitialSelected {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given QuestionnaireItemAnswerOption as FHIR XML
+func (r QuestionnaireItemAnswerOption) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDate", r.ValueDate, r.ValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueTime", r.ValueTime, r.ValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCoding", r.ValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "initialSelected", r.InitialSelected, r.InitialSelectedElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given QuestionnaireItemAnswerOption from FHIR XML
+func (r *QuestionnaireItemAnswerOption) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDate = &v
+				}
+				r.ValueDateElement = element
+			case "valueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueTime = &v
+				}
+				r.ValueTimeElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCoding = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			case "initialSelected":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.InitialSelected = &v
+				}
+				r.InitialSelectedElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the QuestionnaireItemAnswerOption as properties to the RDF node n
+func (r QuestionnaireItemAnswerOption) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Questionnaire.item.answerOption.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("Questionnaire.item.answerOption.valueDate", -1, r.ValueDate, r.ValueDateElement, "date")
+	n.primitive("Questionnaire.item.answerOption.valueTime", -1, r.ValueTime, r.ValueTimeElement, "time")
+	n.primitive("Questionnaire.item.answerOption.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.element("Questionnaire.item.answerOption.valueCoding", -1, r.ValueCoding)
+	n.element("Questionnaire.item.answerOption.valueReference", -1, r.ValueReference)
+	n.primitive("Questionnaire.item.answerOption.initialSelected", -1, r.InitialSelected, r.InitialSelectedElement, "boolean")
+}
+
+// MarshalBSON marshals the given QuestionnaireItemAnswerOption as BSON document with the structure of its FHIR JSON
+func (r QuestionnaireItemAnswerOption) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given QuestionnaireItemAnswerOption from a BSON document with the structure of its FHIR JSON
+func (r *QuestionnaireItemAnswerOption) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given QuestionnaireItemAnswerOption as protobuf message QuestionnaireItemAnswerOption of fhir.proto
+func (r QuestionnaireItemAnswerOption) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the QuestionnaireItemAnswerOption to the encoded message
+func (r QuestionnaireItemAnswerOption) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ValueInteger != nil {
+		e.varint(4, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueDate != nil {
+		e.string(5, *r.ValueDate)
+	}
+	if r.ValueTime != nil {
+		e.string(6, *r.ValueTime)
+	}
+	if r.ValueString != nil {
+		e.string(7, *r.ValueString)
+	}
+	if r.ValueCoding != nil {
+		e.message(8, *r.ValueCoding)
+	}
+	if r.ValueReference != nil {
+		e.message(9, *r.ValueReference)
+	}
+	if r.InitialSelected != nil {
+		e.varint(10, protoBool(*r.InitialSelected))
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(11, *r.ValueIntegerElement)
+	}
+	if r.ValueDateElement != nil {
+		e.message(12, *r.ValueDateElement)
+	}
+	if r.ValueTimeElement != nil {
+		e.message(13, *r.ValueTimeElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(14, *r.ValueStringElement)
+	}
+	if r.InitialSelectedElement != nil {
+		e.message(15, *r.InitialSelectedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given QuestionnaireItemAnswerOption from protobuf message QuestionnaireItemAnswerOption of fhir.proto
+func (r *QuestionnaireItemAnswerOption) UnmarshalProto(b []byte) error {
+	*r = QuestionnaireItemAnswerOption{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.ValueInteger = &v
+		case 5:
+			v := d.string()
+			r.ValueDate = &v
+		case 6:
+			v := d.string()
+			r.ValueTime = &v
+		case 7:
+			v := d.string()
+			r.ValueString = &v
+		case 8:
+			var v Coding
+			d.message(&v)
+			r.ValueCoding = &v
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 10:
+			v := d.bool()
+			r.InitialSelected = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ValueDateElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.ValueTimeElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.InitialSelectedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given QuestionnaireItemInitial as JSON into a byte slice
+func (r QuestionnaireItemInitial) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the QuestionnaireItemInitial as JSON object with the members in the order of the fields
+func (r QuestionnaireItemInitial) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueDecimal != nil {
+		e.key("valueDecimal")
+		e.number(*r.ValueDecimal)
+	}
+	if r.ValueDecimalElement != nil {
+		e.key("_valueDecimal")
+		r.ValueDecimalElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueDate != nil {
+		e.key("valueDate")
+		e.string(*r.ValueDate)
+	}
+	if r.ValueDateElement != nil {
+		e.key("_valueDate")
+		r.ValueDateElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValueTime != nil {
+		e.key("valueTime")
+		e.string(*r.ValueTime)
+	}
+	if r.ValueTimeElement != nil {
+		e.key("_valueTime")
+		r.ValueTimeElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueUri != nil {
+		e.key("valueUri")
+		e.string(*r.ValueUri)
+	}
+	if r.ValueUriElement != nil {
+		e.key("_valueUri")
+		r.ValueUriElement.appendJSON(e)
+	}
+	if r.ValueAttachment != nil {
+		e.key("valueAttachment")
+		r.ValueAttachment.appendJSON(e)
+	}
+	if r.ValueCoding != nil {
+		e.key("valueCoding")
+		r.ValueCoding.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given QuestionnaireItemInitial from JSON. Unknown members are ignored.
+func (r *QuestionnaireItemInitial) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the QuestionnaireItemInitial
+func (r *QuestionnaireItemInitial) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueDecimal":
+			if v, ok := d.number(); ok {
+				r.ValueDecimal = &v
+			} else {
+				r.ValueDecimal = nil
+			}
+		case "_valueDecimal":
+			r.ValueDecimalElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueDate":
+			if v, ok := d.string(); ok {
+				r.ValueDate = &v
+			} else {
+				r.ValueDate = nil
+			}
+		case "_valueDate":
+			r.ValueDateElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valueTime":
+			if v, ok := d.string(); ok {
+				r.ValueTime = &v
+			} else {
+				r.ValueTime = nil
+			}
+		case "_valueTime":
+			r.ValueTimeElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueUri":
+			if v, ok := d.string(); ok {
+				r.ValueUri = &v
+			} else {
+				r.ValueUri = nil
+			}
+		case "_valueUri":
+			r.ValueUriElement = d.element()
+		case "valueAttachment":
+			if d.null() {
+				r.ValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ValueAttachment = &v
+			}
+		case "valueCoding":
+			if d.null() {
+				r.ValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.ValueCoding = &v
+			}
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the QuestionnaireItemInitial which shares no memory with the original
+func (r QuestionnaireItemInitial) DeepCopy() QuestionnaireItemInitial {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueDecimal != nil {
+		v := *r.ValueDecimal
+		out.ValueDecimal = &v
+	}
+	if r.ValueDecimalElement != nil {
+		v := r.ValueDecimalElement.DeepCopy()
+		out.ValueDecimalElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueDate != nil {
+		v := *r.ValueDate
+		out.ValueDate = &v
+	}
+	if r.ValueDateElement != nil {
+		v := r.ValueDateElement.DeepCopy()
+		out.ValueDateElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValueTime != nil {
+		v := *r.ValueTime
+		out.ValueTime = &v
+	}
+	if r.ValueTimeElement != nil {
+		v := r.ValueTimeElement.DeepCopy()
+		out.ValueTimeElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueUri != nil {
+		v := *r.ValueUri
+		out.ValueUri = &v
+	}
+	if r.ValueUriElement != nil {
+		v := r.ValueUriElement.DeepCopy()
+		out.ValueUriElement = &v
+	}
+	if r.ValueAttachment != nil {
+		v := r.ValueAttachment.DeepCopy()
+		out.ValueAttachment = &v
+	}
+	if r.ValueCoding != nil {
+		v := r.ValueCoding.DeepCopy()
+		out.ValueCoding = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r QuestionnaireItemInitial) Equal(other QuestionnaireItemInitial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !EqualDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.Equal(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && *r.ValueDate != *other.ValueDate {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.Equal(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && *r.ValueTime != *other.ValueTime {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.Equal(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && *r.ValueUri != *other.ValueUri {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.Equal(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.Equal(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.Equal(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r QuestionnaireItemInitial) EqualsDeep(other QuestionnaireItemInitial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.EqualsDeep(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.EqualsDeep(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.EqualsDeep(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.EqualsDeep(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.EqualsDeep(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.EqualsDeep(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r QuestionnaireItemInitial) EqualsShallow(other QuestionnaireItemInitial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given QuestionnaireItemInitial as FHIR XML
+func (r QuestionnaireItemInitial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDecimal", r.ValueDecimal, r.ValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDate", r.ValueDate, r.ValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueTime", r.ValueTime, r.ValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUri", r.ValueUri, r.ValueUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAttachment", r.ValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCoding", r.ValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given QuestionnaireItemInitial from FHIR XML
+func (r *QuestionnaireItemInitial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDecimal = &v
+				}
+				r.ValueDecimalElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDate = &v
+				}
+				r.ValueDateElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueTime = &v
+				}
+				r.ValueTimeElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUri = &v
+				}
+				r.ValueUriElement = element
+			case "valueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAttachment = &v
+			case "valueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCoding = &v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the QuestionnaireItemInitial as properties to the RDF node n
+func (r QuestionnaireItemInitial) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Questionnaire.item.initial.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("Questionnaire.item.initial.valueDecimal", -1, r.ValueDecimal, r.ValueDecimalElement, "decimal")
+	n.primitive("Questionnaire.item.initial.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("Questionnaire.item.initial.valueDate", -1, r.ValueDate, r.ValueDateElement, "date")
+	n.primitive("Questionnaire.item.initial.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.primitive("Questionnaire.item.initial.valueTime", -1, r.ValueTime, r.ValueTimeElement, "time")
+	n.primitive("Questionnaire.item.initial.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("Questionnaire.item.initial.valueUri", -1, r.ValueUri, r.ValueUriElement, "uri")
+	n.element("Questionnaire.item.initial.valueAttachment", -1, r.ValueAttachment)
+	n.element("Questionnaire.item.initial.valueCoding", -1, r.ValueCoding)
+	n.element("Questionnaire.item.initial.valueQuantity", -1, r.ValueQuantity)
+	n.element("Questionnaire.item.initial.valueReference", -1, r.ValueReference)
+}
+
+// MarshalBSON marshals the given QuestionnaireItemInitial as BSON document with the structure of its FHIR JSON
+func (r QuestionnaireItemInitial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given QuestionnaireItemInitial from a BSON document with the structure of its FHIR JSON
+func (r *QuestionnaireItemInitial) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given QuestionnaireItemInitial as protobuf message QuestionnaireItemInitial of fhir.proto
+func (r QuestionnaireItemInitial) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the QuestionnaireItemInitial to the encoded message
+func (r QuestionnaireItemInitial) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(4, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueDecimal != nil {
+		e.string(5, string(*r.ValueDecimal))
+	}
+	if r.ValueInteger != nil {
+		e.varint(6, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueDate != nil {
+		e.string(7, *r.ValueDate)
+	}
+	if r.ValueDateTime != nil {
+		e.string(8, *r.ValueDateTime)
+	}
+	if r.ValueTime != nil {
+		e.string(9, *r.ValueTime)
+	}
+	if r.ValueString != nil {
+		e.string(10, *r.ValueString)
+	}
+	if r.ValueUri != nil {
+		e.string(11, *r.ValueUri)
+	}
+	if r.ValueAttachment != nil {
+		e.message(12, *r.ValueAttachment)
+	}
+	if r.ValueCoding != nil {
+		e.message(13, *r.ValueCoding)
+	}
+	if r.ValueQuantity != nil {
+		e.message(14, *r.ValueQuantity)
+	}
+	if r.ValueReference != nil {
+		e.message(15, *r.ValueReference)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(16, *r.ValueBooleanElement)
+	}
+	if r.ValueDecimalElement != nil {
+		e.message(17, *r.ValueDecimalElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(18, *r.ValueIntegerElement)
+	}
+	if r.ValueDateElement != nil {
+		e.message(19, *r.ValueDateElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(20, *r.ValueDateTimeElement)
+	}
+	if r.ValueTimeElement != nil {
+		e.message(21, *r.ValueTimeElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(22, *r.ValueStringElement)
+	}
+	if r.ValueUriElement != nil {
+		e.message(23, *r.ValueUriElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given QuestionnaireItemInitial from protobuf message QuestionnaireItemInitial of fhir.proto
+func (r *QuestionnaireItemInitial) UnmarshalProto(b []byte) error {
+	*r = QuestionnaireItemInitial{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 5:
+			v := json.Number(d.string())
+			r.ValueDecimal = &v
+		case 6:
+			v := d.int()
+			r.ValueInteger = &v
+		case 7:
+			v := d.string()
+			r.ValueDate = &v
+		case 8:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 9:
+			v := d.string()
+			r.ValueTime = &v
+		case 10:
+			v := d.string()
+			r.ValueString = &v
+		case 11:
+			v := d.string()
+			r.ValueUri = &v
+		case 12:
+			var v Attachment
+			d.message(&v)
+			r.ValueAttachment = &v
+		case 13:
+			var v Coding
+			d.message(&v)
+			r.ValueCoding = &v
+		case 14:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ValueDecimalElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.ValueDateElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ValueTimeElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ValueUriElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalQuestionnaire unmarshals a Questionnaire.
 func UnmarshalQuestionnaire(b []byte) (Questionnaire, error) {
 	var questionnaire Questionnaire
-	if err := json.Unmarshal(b, &questionnaire); err != nil {
+	if err := questionnaire.UnmarshalJSON(b); err != nil {
 		return questionnaire, err
 	}
 	return questionnaire, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code QuestionnaireItemOperator) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *QuestionnaireItemOperator) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *QuestionnaireItemOperator) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "exists":
 		*code = QuestionnaireItemOperatorExists
@@ -61,6 +65,12 @@ func (code *QuestionnaireItemOperator) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code QuestionnaireItemOperator) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *QuestionnaireItemOperator) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code QuestionnaireItemOperator) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code QuestionnaireItemOperator) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code QuestionnaireItemOperator) protoNumber() uint64 {
+	switch code {
+	case QuestionnaireItemOperatorExists:
+		return 1
+	case QuestionnaireItemOperatorEquals:
+		return 2
+	case QuestionnaireItemOperatorNotEquals:
+		return 3
+	case QuestionnaireItemOperatorGreaterThan:
+		return 4
+	case QuestionnaireItemOperatorLessThan:
+		return 5
+	case QuestionnaireItemOperatorGreaterOrEquals:
+		return 6
+	case QuestionnaireItemOperatorLessOrEquals:
+		return 7
+	}
+	return 0
+}
+func (code *QuestionnaireItemOperator) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = QuestionnaireItemOperatorExists
+	case 2:
+		*code = QuestionnaireItemOperatorEquals
+	case 3:
+		*code = QuestionnaireItemOperatorNotEquals
+	case 4:
+		*code = QuestionnaireItemOperatorGreaterThan
+	case 5:
+		*code = QuestionnaireItemOperatorLessThan
+	case 6:
+		*code = QuestionnaireItemOperatorGreaterOrEquals
+	case 7:
+		*code = QuestionnaireItemOperatorLessOrEquals
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -49,8 +48,13 @@ const (
 func (code QuestionnaireItemType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *QuestionnaireItemType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *QuestionnaireItemType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "group":
 		*code = QuestionnaireItemTypeGroup
@@ -91,6 +95,12 @@ func (code *QuestionnaireItemType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code QuestionnaireItemType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *QuestionnaireItemType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code QuestionnaireItemType) String() string {
 	return code.Code()
 }
@@ -211,3 +221,83 @@ func (code QuestionnaireItemType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code QuestionnaireItemType) protoNumber() uint64 {
+	switch code {
+	case QuestionnaireItemTypeGroup:
+		return 1
+	case QuestionnaireItemTypeDisplay:
+		return 2
+	case QuestionnaireItemTypeQuestion:
+		return 3
+	case QuestionnaireItemTypeBoolean:
+		return 4
+	case QuestionnaireItemTypeDecimal:
+		return 5
+	case QuestionnaireItemTypeInteger:
+		return 6
+	case QuestionnaireItemTypeDate:
+		return 7
+	case QuestionnaireItemTypeDateTime:
+		return 8
+	case QuestionnaireItemTypeTime:
+		return 9
+	case QuestionnaireItemTypeString:
+		return 10
+	case QuestionnaireItemTypeText:
+		return 11
+	case QuestionnaireItemTypeUrl:
+		return 12
+	case QuestionnaireItemTypeChoice:
+		return 13
+	case QuestionnaireItemTypeOpenChoice:
+		return 14
+	case QuestionnaireItemTypeAttachment:
+		return 15
+	case QuestionnaireItemTypeReference:
+		return 16
+	case QuestionnaireItemTypeQuantity:
+		return 17
+	}
+	return 0
+}
+func (code *QuestionnaireItemType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = QuestionnaireItemTypeGroup
+	case 2:
+		*code = QuestionnaireItemTypeDisplay
+	case 3:
+		*code = QuestionnaireItemTypeQuestion
+	case 4:
+		*code = QuestionnaireItemTypeBoolean
+	case 5:
+		*code = QuestionnaireItemTypeDecimal
+	case 6:
+		*code = QuestionnaireItemTypeInteger
+	case 7:
+		*code = QuestionnaireItemTypeDate
+	case 8:
+		*code = QuestionnaireItemTypeDateTime
+	case 9:
+		*code = QuestionnaireItemTypeTime
+	case 10:
+		*code = QuestionnaireItemTypeString
+	case 11:
+		*code = QuestionnaireItemTypeText
+	case 12:
+		*code = QuestionnaireItemTypeUrl
+	case 13:
+		*code = QuestionnaireItemTypeChoice
+	case 14:
+		*code = QuestionnaireItemTypeOpenChoice
+	case 15:
+		*code = QuestionnaireItemTypeAttachment
+	case 16:
+		*code = QuestionnaireItemTypeReference
+	case 17:
+		*code = QuestionnaireItemTypeQuantity
+	default:
+		return false
+	}
+	return true
+}
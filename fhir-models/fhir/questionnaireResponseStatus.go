@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code QuestionnaireResponseStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *QuestionnaireResponseStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *QuestionnaireResponseStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "in-progress":
 		*code = QuestionnaireResponseStatusInProgress
@@ -55,6 +59,12 @@ func (code *QuestionnaireResponseStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code QuestionnaireResponseStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *QuestionnaireResponseStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code QuestionnaireResponseStatus) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code QuestionnaireResponseStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code QuestionnaireResponseStatus) protoNumber() uint64 {
+	switch code {
+	case QuestionnaireResponseStatusInProgress:
+		return 1
+	case QuestionnaireResponseStatusCompleted:
+		return 2
+	case QuestionnaireResponseStatusAmended:
+		return 3
+	case QuestionnaireResponseStatusEnteredInError:
+		return 4
+	case QuestionnaireResponseStatusStopped:
+		return 5
+	}
+	return 0
+}
+func (code *QuestionnaireResponseStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = QuestionnaireResponseStatusInProgress
+	case 2:
+		*code = QuestionnaireResponseStatusCompleted
+	case 3:
+		*code = QuestionnaireResponseStatusAmended
+	case 4:
+		*code = QuestionnaireResponseStatusEnteredInError
+	case 5:
+		*code = QuestionnaireResponseStatusStopped
+	default:
+		return false
+	}
+	return true
+}
@@ -14,6 +14,8 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
@@ -24,3 +26,306 @@ type Range struct {
 	Low       *Quantity   `bson:"low,omitempty" json:"low,omitempty"`
 	High      *Quantity   `bson:"high,omitempty" json:"high,omitempty"`
 }
+
+// MarshalJSON marshals the given Range as JSON into a byte slice
+func (r Range) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Range as JSON object with the members in the order of the fields
+func (r Range) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Low != nil {
+		e.key("low")
+		r.Low.appendJSON(e)
+	}
+	if r.High != nil {
+		e.key("high")
+		r.High.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Range from JSON. Unknown members are ignored.
+func (r *Range) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Range
+func (r *Range) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "low":
+			if d.null() {
+				r.Low = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Low = &v
+			}
+		case "high":
+			if d.null() {
+				r.High = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.High = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Range which shares no memory with the original
+func (r Range) DeepCopy() Range {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Low != nil {
+		v := r.Low.DeepCopy()
+		out.Low = &v
+	}
+	if r.High != nil {
+		v := r.High.DeepCopy()
+		out.High = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Range) Equal(other Range) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Low == nil) != (other.Low == nil) || r.Low != nil && !r.Low.Equal(*other.Low) {
+		return false
+	}
+	if (r.High == nil) != (other.High == nil) || r.High != nil && !r.High.Equal(*other.High) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Range) EqualsDeep(other Range) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Low == nil) != (other.Low == nil) || r.Low != nil && !r.Low.EqualsDeep(*other.Low) {
+		return false
+	}
+	if (r.High == nil) != (other.High == nil) || r.High != nil && !r.High.EqualsDeep(*other.High) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Range) EqualsShallow(other Range) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Range as FHIR XML
+func (r Range) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "low", r.Low); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "high", r.High); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Range from FHIR XML
+func (r *Range) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "low":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Low = &v
+			case "high":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.High = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Range as properties to the RDF node n
+func (r Range) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.element("Range.low", -1, r.Low)
+	n.element("Range.high", -1, r.High)
+}
+
+// MarshalBSON marshals the given Range as BSON document with the structure of its FHIR JSON
+func (r Range) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Range from a BSON document with the structure of its FHIR JSON
+func (r *Range) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Range as protobuf message Range of fhir.proto
+func (r Range) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Range to the encoded message
+func (r Range) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Low != nil {
+		e.message(3, *r.Low)
+	}
+	if r.High != nil {
+		e.message(4, *r.High)
+	}
+}
+
+// UnmarshalProto unmarshals the given Range from protobuf message Range of fhir.proto
+func (r *Range) UnmarshalProto(b []byte) error {
+	*r = Range{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Quantity
+			d.message(&v)
+			r.Low = &v
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.High = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
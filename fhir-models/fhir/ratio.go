@@ -14,6 +14,8 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
@@ -24,3 +26,306 @@ type Ratio struct {
 	Numerator   *Quantity   `bson:"numerator,omitempty" json:"numerator,omitempty"`
 	Denominator *Quantity   `bson:"denominator,omitempty" json:"denominator,omitempty"`
 }
+
+// MarshalJSON marshals the given Ratio as JSON into a byte slice
+func (r Ratio) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Ratio as JSON object with the members in the order of the fields
+func (r Ratio) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Numerator != nil {
+		e.key("numerator")
+		r.Numerator.appendJSON(e)
+	}
+	if r.Denominator != nil {
+		e.key("denominator")
+		r.Denominator.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Ratio from JSON. Unknown members are ignored.
+func (r *Ratio) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Ratio
+func (r *Ratio) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "numerator":
+			if d.null() {
+				r.Numerator = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Numerator = &v
+			}
+		case "denominator":
+			if d.null() {
+				r.Denominator = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Denominator = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Ratio which shares no memory with the original
+func (r Ratio) DeepCopy() Ratio {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Numerator != nil {
+		v := r.Numerator.DeepCopy()
+		out.Numerator = &v
+	}
+	if r.Denominator != nil {
+		v := r.Denominator.DeepCopy()
+		out.Denominator = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Ratio) Equal(other Ratio) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.Numerator == nil) != (other.Numerator == nil) || r.Numerator != nil && !r.Numerator.Equal(*other.Numerator) {
+		return false
+	}
+	if (r.Denominator == nil) != (other.Denominator == nil) || r.Denominator != nil && !r.Denominator.Equal(*other.Denominator) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Ratio) EqualsDeep(other Ratio) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.Numerator == nil) != (other.Numerator == nil) || r.Numerator != nil && !r.Numerator.EqualsDeep(*other.Numerator) {
+		return false
+	}
+	if (r.Denominator == nil) != (other.Denominator == nil) || r.Denominator != nil && !r.Denominator.EqualsDeep(*other.Denominator) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Ratio) EqualsShallow(other Ratio) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Ratio as FHIR XML
+func (r Ratio) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "numerator", r.Numerator); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "denominator", r.Denominator); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Ratio from FHIR XML
+func (r *Ratio) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "numerator":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Numerator = &v
+			case "denominator":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Denominator = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Ratio as properties to the RDF node n
+func (r Ratio) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.element("Ratio.numerator", -1, r.Numerator)
+	n.element("Ratio.denominator", -1, r.Denominator)
+}
+
+// MarshalBSON marshals the given Ratio as BSON document with the structure of its FHIR JSON
+func (r Ratio) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Ratio from a BSON document with the structure of its FHIR JSON
+func (r *Ratio) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Ratio as protobuf message Ratio of fhir.proto
+func (r Ratio) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Ratio to the encoded message
+func (r Ratio) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.Numerator != nil {
+		e.message(3, *r.Numerator)
+	}
+	if r.Denominator != nil {
+		e.message(4, *r.Denominator)
+	}
+}
+
+// UnmarshalProto unmarshals the given Ratio from protobuf message Ratio of fhir.proto
+func (r *Ratio) UnmarshalProto(b []byte) error {
+	*r = Ratio{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Quantity
+			d.message(&v)
+			r.Numerator = &v
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.Denominator = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
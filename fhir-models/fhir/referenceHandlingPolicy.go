@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code ReferenceHandlingPolicy) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ReferenceHandlingPolicy) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ReferenceHandlingPolicy) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "literal":
 		*code = ReferenceHandlingPolicyLiteral
@@ -55,6 +59,12 @@ func (code *ReferenceHandlingPolicy) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ReferenceHandlingPolicy) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ReferenceHandlingPolicy) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ReferenceHandlingPolicy) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code ReferenceHandlingPolicy) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ReferenceHandlingPolicy) protoNumber() uint64 {
+	switch code {
+	case ReferenceHandlingPolicyLiteral:
+		return 1
+	case ReferenceHandlingPolicyLogical:
+		return 2
+	case ReferenceHandlingPolicyResolves:
+		return 3
+	case ReferenceHandlingPolicyEnforced:
+		return 4
+	case ReferenceHandlingPolicyLocal:
+		return 5
+	}
+	return 0
+}
+func (code *ReferenceHandlingPolicy) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ReferenceHandlingPolicyLiteral
+	case 2:
+		*code = ReferenceHandlingPolicyLogical
+	case 3:
+		*code = ReferenceHandlingPolicyResolves
+	case 4:
+		*code = ReferenceHandlingPolicyEnforced
+	case 5:
+		*code = ReferenceHandlingPolicyLocal
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ReferenceVersionRules) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ReferenceVersionRules) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ReferenceVersionRules) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "either":
 		*code = ReferenceVersionRulesEither
@@ -49,6 +53,12 @@ func (code *ReferenceVersionRules) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ReferenceVersionRules) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ReferenceVersionRules) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ReferenceVersionRules) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ReferenceVersionRules) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ReferenceVersionRules) protoNumber() uint64 {
+	switch code {
+	case ReferenceVersionRulesEither:
+		return 1
+	case ReferenceVersionRulesIndependent:
+		return 2
+	case ReferenceVersionRulesSpecific:
+		return 3
+	}
+	return 0
+}
+func (code *ReferenceVersionRules) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ReferenceVersionRulesEither
+	case 2:
+		*code = ReferenceVersionRulesIndependent
+	case 3:
+		*code = ReferenceVersionRulesSpecific
+	default:
+		return false
+	}
+	return true
+}
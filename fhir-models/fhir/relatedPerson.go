@@ -14,32 +14,42 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // RelatedPerson is documented here http://hl7.org/fhir/StructureDefinition/RelatedPerson
 type RelatedPerson struct {
-	Id                *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active            *bool                        `bson:"active,omitempty" json:"active,omitempty"`
-	Patient           Reference                    `bson:"patient" json:"patient"`
-	Relationship      []CodeableConcept            `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Name              []HumanName                  `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom           []ContactPoint               `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Gender            *AdministrativeGender        `bson:"gender,omitempty" json:"gender,omitempty"`
-	BirthDate         *string                      `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
-	Address           []Address                    `bson:"address,omitempty" json:"address,omitempty"`
-	Photo             []Attachment                 `bson:"photo,omitempty" json:"photo,omitempty"`
-	Period            *Period                      `bson:"period,omitempty" json:"period,omitempty"`
-	Communication     []RelatedPersonCommunication `bson:"communication,omitempty" json:"communication,omitempty"`
+	Id                   *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                     `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active               *bool                        `bson:"active,omitempty" json:"active,omitempty"`
+	ActiveElement        *Element                     `bson:"_active,omitempty" json:"_active,omitempty"`
+	Patient              Reference                    `bson:"patient" json:"patient"`
+	Relationship         []CodeableConcept            `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Name                 []HumanName                  `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom              []ContactPoint               `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Gender               *AdministrativeGender        `bson:"gender,omitempty" json:"gender,omitempty"`
+	GenderElement        *Element                     `bson:"_gender,omitempty" json:"_gender,omitempty"`
+	BirthDate            *string                      `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	BirthDateElement     *Element                     `bson:"_birthDate,omitempty" json:"_birthDate,omitempty"`
+	Address              []Address                    `bson:"address,omitempty" json:"address,omitempty"`
+	Photo                []Attachment                 `bson:"photo,omitempty" json:"photo,omitempty"`
+	Period               *Period                      `bson:"period,omitempty" json:"period,omitempty"`
+	Communication        []RelatedPersonCommunication `bson:"communication,omitempty" json:"communication,omitempty"`
 }
 type RelatedPersonCommunication struct {
 	Id                *string         `bson:"id,omitempty" json:"id,omitempty"`
@@ -47,24 +57,1656 @@ type RelatedPersonCommunication struct {
 	ModifierExtension []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Language          CodeableConcept `bson:"language" json:"language"`
 	Preferred         *bool           `bson:"preferred,omitempty" json:"preferred,omitempty"`
+	PreferredElement  *Element        `bson:"_preferred,omitempty" json:"_preferred,omitempty"`
 }
-type OtherRelatedPerson RelatedPerson
 
 // MarshalJSON marshals the given RelatedPerson as JSON into a byte slice
 func (r RelatedPerson) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherRelatedPerson
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherRelatedPerson: OtherRelatedPerson(r),
-		ResourceType:       "RelatedPerson",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RelatedPerson as JSON object with the members in the order of the fields
+func (r RelatedPerson) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"RelatedPerson\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Active != nil {
+		e.key("active")
+		e.bool(*r.Active)
+	}
+	if r.ActiveElement != nil {
+		e.key("_active")
+		r.ActiveElement.appendJSON(e)
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if len(r.Relationship) > 0 {
+		e.key("relationship")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Relationship {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Name) > 0 {
+		e.key("name")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Name {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Telecom) > 0 {
+		e.key("telecom")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Telecom {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Gender != nil {
+		e.key("gender")
+		e.string(r.Gender.Code())
+	}
+	if r.GenderElement != nil {
+		e.key("_gender")
+		r.GenderElement.appendJSON(e)
+	}
+	if r.BirthDate != nil {
+		e.key("birthDate")
+		e.string(*r.BirthDate)
+	}
+	if r.BirthDateElement != nil {
+		e.key("_birthDate")
+		r.BirthDateElement.appendJSON(e)
+	}
+	if len(r.Address) > 0 {
+		e.key("address")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Address {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Photo) > 0 {
+		e.key("photo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Photo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if len(r.Communication) > 0 {
+		e.key("communication")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Communication {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RelatedPerson from JSON. Unknown members are ignored.
+func (r *RelatedPerson) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RelatedPerson
+func (r *RelatedPerson) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "active":
+			if v, ok := d.bool(); ok {
+				r.Active = &v
+			} else {
+				r.Active = nil
+			}
+		case "_active":
+			r.ActiveElement = d.element()
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "relationship":
+			a := d.array()
+			r.Relationship = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Relationship = append(r.Relationship, v)
+			}
+			if r.Relationship == nil && !a.isNull {
+				r.Relationship = []CodeableConcept{}
+			}
+		case "name":
+			a := d.array()
+			r.Name = nil
+			for a.next() {
+				var v HumanName
+				v.decodeJSON(d)
+				r.Name = append(r.Name, v)
+			}
+			if r.Name == nil && !a.isNull {
+				r.Name = []HumanName{}
+			}
+		case "telecom":
+			a := d.array()
+			r.Telecom = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Telecom = append(r.Telecom, v)
+			}
+			if r.Telecom == nil && !a.isNull {
+				r.Telecom = []ContactPoint{}
+			}
+		case "gender":
+			if d.null() {
+				r.Gender = nil
+			} else {
+				var v AdministrativeGender
+				d.unmarshal(&v)
+				r.Gender = &v
+			}
+		case "_gender":
+			r.GenderElement = d.element()
+		case "birthDate":
+			if v, ok := d.string(); ok {
+				r.BirthDate = &v
+			} else {
+				r.BirthDate = nil
+			}
+		case "_birthDate":
+			r.BirthDateElement = d.element()
+		case "address":
+			a := d.array()
+			r.Address = nil
+			for a.next() {
+				var v Address
+				v.decodeJSON(d)
+				r.Address = append(r.Address, v)
+			}
+			if r.Address == nil && !a.isNull {
+				r.Address = []Address{}
+			}
+		case "photo":
+			a := d.array()
+			r.Photo = nil
+			for a.next() {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Photo = append(r.Photo, v)
+			}
+			if r.Photo == nil && !a.isNull {
+				r.Photo = []Attachment{}
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "communication":
+			a := d.array()
+			r.Communication = nil
+			for a.next() {
+				var v RelatedPersonCommunication
+				v.decodeJSON(d)
+				r.Communication = append(r.Communication, v)
+			}
+			if r.Communication == nil && !a.isNull {
+				r.Communication = []RelatedPersonCommunication{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RelatedPerson which shares no memory with the original
+func (r RelatedPerson) DeepCopy() RelatedPerson {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Active != nil {
+		v := *r.Active
+		out.Active = &v
+	}
+	if r.ActiveElement != nil {
+		v := r.ActiveElement.DeepCopy()
+		out.ActiveElement = &v
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.Relationship != nil {
+		out.Relationship = make([]CodeableConcept, len(r.Relationship))
+		for i := range r.Relationship {
+			out.Relationship[i] = r.Relationship[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		out.Name = make([]HumanName, len(r.Name))
+		for i := range r.Name {
+			out.Name[i] = r.Name[i].DeepCopy()
+		}
+	}
+	if r.Telecom != nil {
+		out.Telecom = make([]ContactPoint, len(r.Telecom))
+		for i := range r.Telecom {
+			out.Telecom[i] = r.Telecom[i].DeepCopy()
+		}
+	}
+	if r.Gender != nil {
+		v := *r.Gender
+		out.Gender = &v
+	}
+	if r.GenderElement != nil {
+		v := r.GenderElement.DeepCopy()
+		out.GenderElement = &v
+	}
+	if r.BirthDate != nil {
+		v := *r.BirthDate
+		out.BirthDate = &v
+	}
+	if r.BirthDateElement != nil {
+		v := r.BirthDateElement.DeepCopy()
+		out.BirthDateElement = &v
+	}
+	if r.Address != nil {
+		out.Address = make([]Address, len(r.Address))
+		for i := range r.Address {
+			out.Address[i] = r.Address[i].DeepCopy()
+		}
+	}
+	if r.Photo != nil {
+		out.Photo = make([]Attachment, len(r.Photo))
+		for i := range r.Photo {
+			out.Photo[i] = r.Photo[i].DeepCopy()
+		}
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Communication != nil {
+		out.Communication = make([]RelatedPersonCommunication, len(r.Communication))
+		for i := range r.Communication {
+			out.Communication[i] = r.Communication[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RelatedPerson) Equal(other RelatedPerson) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.Equal(*other.ActiveElement) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if len(r.Relationship) != len(other.Relationship) {
+		return false
+	}
+	for i := range r.Relationship {
+		if !r.Relationship[i].Equal(other.Relationship[i]) {
+			return false
+		}
+	}
+	if len(r.Name) != len(other.Name) {
+		return false
+	}
+	for i := range r.Name {
+		if !r.Name[i].Equal(other.Name[i]) {
+			return false
+		}
+	}
+	if len(r.Telecom) != len(other.Telecom) {
+		return false
+	}
+	for i := range r.Telecom {
+		if !r.Telecom[i].Equal(other.Telecom[i]) {
+			return false
+		}
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.Equal(*other.GenderElement) {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && *r.BirthDate != *other.BirthDate {
+		return false
+	}
+	if (r.BirthDateElement == nil) != (other.BirthDateElement == nil) || r.BirthDateElement != nil && !r.BirthDateElement.Equal(*other.BirthDateElement) {
+		return false
+	}
+	if len(r.Address) != len(other.Address) {
+		return false
+	}
+	for i := range r.Address {
+		if !r.Address[i].Equal(other.Address[i]) {
+			return false
+		}
+	}
+	if len(r.Photo) != len(other.Photo) {
+		return false
+	}
+	for i := range r.Photo {
+		if !r.Photo[i].Equal(other.Photo[i]) {
+			return false
+		}
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if len(r.Communication) != len(other.Communication) {
+		return false
+	}
+	for i := range r.Communication {
+		if !r.Communication[i].Equal(other.Communication[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RelatedPerson) EqualsDeep(other RelatedPerson) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.ActiveElement == nil) != (other.ActiveElement == nil) || r.ActiveElement != nil && !r.ActiveElement.EqualsDeep(*other.ActiveElement) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if !equivalentList(len(r.Relationship), len(other.Relationship), func(i, j int) bool {
+		return r.Relationship[i].EqualsDeep(other.Relationship[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Name), len(other.Name), func(i, j int) bool {
+		return r.Name[i].EqualsDeep(other.Name[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Telecom), len(other.Telecom), func(i, j int) bool {
+		return r.Telecom[i].EqualsDeep(other.Telecom[j])
+	}) {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.GenderElement == nil) != (other.GenderElement == nil) || r.GenderElement != nil && !r.GenderElement.EqualsDeep(*other.GenderElement) {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && !equivalentString(*r.BirthDate, *other.BirthDate) {
+		return false
+	}
+	if (r.BirthDateElement == nil) != (other.BirthDateElement == nil) || r.BirthDateElement != nil && !r.BirthDateElement.EqualsDeep(*other.BirthDateElement) {
+		return false
+	}
+	if !equivalentList(len(r.Address), len(other.Address), func(i, j int) bool {
+		return r.Address[i].EqualsDeep(other.Address[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Photo), len(other.Photo), func(i, j int) bool {
+		return r.Photo[i].EqualsDeep(other.Photo[j])
+	}) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentList(len(r.Communication), len(other.Communication), func(i, j int) bool {
+		return r.Communication[i].EqualsDeep(other.Communication[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RelatedPerson) EqualsShallow(other RelatedPerson) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Active == nil) != (other.Active == nil) || r.Active != nil && *r.Active != *other.Active {
+		return false
+	}
+	if (r.Gender == nil) != (other.Gender == nil) || r.Gender != nil && *r.Gender != *other.Gender {
+		return false
+	}
+	if (r.BirthDate == nil) != (other.BirthDate == nil) || r.BirthDate != nil && !equivalentString(*r.BirthDate, *other.BirthDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RelatedPerson as FHIR XML
+func (r RelatedPerson) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "RelatedPerson",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "active", r.Active, r.ActiveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	for _, v := range r.Relationship {
+		if err := encodeXMLElement(e, "relationship", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Name {
+		if err := encodeXMLElement(e, "name", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Telecom {
+		if err := encodeXMLElement(e, "telecom", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "gender", r.Gender, r.GenderElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "birthDate", r.BirthDate, r.BirthDateElement); err != nil {
+		return err
+	}
+	for _, v := range r.Address {
+		if err := encodeXMLElement(e, "address", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Photo {
+		if err := encodeXMLElement(e, "photo", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	for _, v := range r.Communication {
+		if err := encodeXMLElement(e, "communication", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RelatedPerson from FHIR XML
+func (r *RelatedPerson) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "active":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Active = &v
+				}
+				r.ActiveElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "relationship":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Relationship = append(r.Relationship, v)
+			case "name":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Name = append(r.Name, v)
+			case "telecom":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Telecom = append(r.Telecom, v)
+			case "gender":
+				var v AdministrativeGender
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Gender = &v
+				}
+				r.GenderElement = element
+			case "birthDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BirthDate = &v
+				}
+				r.BirthDateElement = element
+			case "address":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Address = append(r.Address, v)
+			case "photo":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Photo = append(r.Photo, v)
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "communication":
+				var v RelatedPersonCommunication
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Communication = append(r.Communication, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RelatedPerson as properties to the RDF node n
+func (r RelatedPerson) turtle(n *turtleNode) {
+	n.resource("RelatedPerson", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("RelatedPerson.identifier", i, v)
+	}
+	n.primitive("RelatedPerson.active", -1, r.Active, r.ActiveElement, "boolean")
+	n.element("RelatedPerson.patient", -1, r.Patient)
+	for i, v := range r.Relationship {
+		n.element("RelatedPerson.relationship", i, v)
+	}
+	for i, v := range r.Name {
+		n.element("RelatedPerson.name", i, v)
+	}
+	for i, v := range r.Telecom {
+		n.element("RelatedPerson.telecom", i, v)
+	}
+	n.primitive("RelatedPerson.gender", -1, r.Gender, r.GenderElement, "code")
+	n.primitive("RelatedPerson.birthDate", -1, r.BirthDate, r.BirthDateElement, "date")
+	for i, v := range r.Address {
+		n.element("RelatedPerson.address", i, v)
+	}
+	for i, v := range r.Photo {
+		n.element("RelatedPerson.photo", i, v)
+	}
+	n.element("RelatedPerson.period", -1, r.Period)
+	for i, v := range r.Communication {
+		n.element("RelatedPerson.communication", i, v)
+	}
+}
+
+// MarshalBSON marshals the given RelatedPerson as BSON document with the structure of its FHIR JSON
+func (r RelatedPerson) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RelatedPerson from a BSON document with the structure of its FHIR JSON
+func (r *RelatedPerson) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RelatedPerson as protobuf message RelatedPerson of fhir.proto
+func (r RelatedPerson) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RelatedPerson to the encoded message
+func (r RelatedPerson) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Active != nil {
+		e.varint(10, protoBool(*r.Active))
+	}
+	e.message(11, r.Patient)
+	for _, v := range r.Relationship {
+		e.message(12, v)
+	}
+	for _, v := range r.Name {
+		e.message(13, v)
+	}
+	for _, v := range r.Telecom {
+		e.message(14, v)
+	}
+	if r.Gender != nil {
+		e.varint(15, r.Gender.protoNumber())
+	}
+	if r.BirthDate != nil {
+		e.string(16, *r.BirthDate)
+	}
+	for _, v := range r.Address {
+		e.message(17, v)
+	}
+	for _, v := range r.Photo {
+		e.message(18, v)
+	}
+	if r.Period != nil {
+		e.message(19, *r.Period)
+	}
+	for _, v := range r.Communication {
+		e.message(20, v)
+	}
+	if r.IdElement != nil {
+		e.message(21, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(22, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(23, *r.LanguageElement)
+	}
+	if r.ActiveElement != nil {
+		e.message(24, *r.ActiveElement)
+	}
+	if r.GenderElement != nil {
+		e.message(25, *r.GenderElement)
+	}
+	if r.BirthDateElement != nil {
+		e.message(26, *r.BirthDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RelatedPerson from protobuf message RelatedPerson of fhir.proto
+func (r *RelatedPerson) UnmarshalProto(b []byte) error {
+	*r = RelatedPerson{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.bool()
+			r.Active = &v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Relationship = append(r.Relationship, v)
+		case 13:
+			var v HumanName
+			d.message(&v)
+			r.Name = append(r.Name, v)
+		case 14:
+			var v ContactPoint
+			d.message(&v)
+			r.Telecom = append(r.Telecom, v)
+		case 15:
+			var v AdministrativeGender
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Gender = &v
+		case 16:
+			v := d.string()
+			r.BirthDate = &v
+		case 17:
+			var v Address
+			d.message(&v)
+			r.Address = append(r.Address, v)
+		case 18:
+			var v Attachment
+			d.message(&v)
+			r.Photo = append(r.Photo, v)
+		case 19:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 20:
+			var v RelatedPersonCommunication
+			d.message(&v)
+			r.Communication = append(r.Communication, v)
+		case 21:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.ActiveElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.GenderElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.BirthDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RelatedPersonCommunication as JSON into a byte slice
+func (r RelatedPersonCommunication) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RelatedPersonCommunication as JSON object with the members in the order of the fields
+func (r RelatedPersonCommunication) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("language")
+	r.Language.appendJSON(e)
+	if r.Preferred != nil {
+		e.key("preferred")
+		e.bool(*r.Preferred)
+	}
+	if r.PreferredElement != nil {
+		e.key("_preferred")
+		r.PreferredElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RelatedPersonCommunication from JSON. Unknown members are ignored.
+func (r *RelatedPersonCommunication) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RelatedPersonCommunication
+func (r *RelatedPersonCommunication) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "language":
+			r.Language.decodeJSON(d)
+		case "preferred":
+			if v, ok := d.bool(); ok {
+				r.Preferred = &v
+			} else {
+				r.Preferred = nil
+			}
+		case "_preferred":
+			r.PreferredElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RelatedPersonCommunication which shares no memory with the original
+func (r RelatedPersonCommunication) DeepCopy() RelatedPersonCommunication {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Language = r.Language.DeepCopy()
+	if r.Preferred != nil {
+		v := *r.Preferred
+		out.Preferred = &v
+	}
+	if r.PreferredElement != nil {
+		v := r.PreferredElement.DeepCopy()
+		out.PreferredElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RelatedPersonCommunication) Equal(other RelatedPersonCommunication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Language.Equal(other.Language) {
+		return false
+	}
+	if (r.Preferred == nil) != (other.Preferred == nil) || r.Preferred != nil && *r.Preferred != *other.Preferred {
+		return false
+	}
+	if (r.PreferredElement == nil) != (other.PreferredElement == nil) || r.PreferredElement != nil && !r.PreferredElement.Equal(*other.PreferredElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RelatedPersonCommunication) EqualsDeep(other RelatedPersonCommunication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Language.EqualsDeep(other.Language) {
+		return false
+	}
+	if (r.Preferred == nil) != (other.Preferred == nil) || r.Preferred != nil && *r.Preferred != *other.Preferred {
+		return false
+	}
+	if (r.PreferredElement == nil) != (other.PreferredElement == nil) || r.PreferredElement != nil && !r.PreferredElement.EqualsDeep(*other.PreferredElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RelatedPersonCommunication) EqualsShallow(other RelatedPersonCommunication) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Preferred == nil) != (other.Preferred == nil) || r.Preferred != nil && *r.Preferred != *other.Preferred {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RelatedPersonCommunication as FHIR XML
+func (r RelatedPersonCommunication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "language", r.Language); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "preferred", r.Preferred, r.PreferredElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RelatedPersonCommunication from FHIR XML
+func (r *RelatedPersonCommunication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "language":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Language = v
+			case "preferred":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Preferred = &v
+				}
+				r.PreferredElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RelatedPersonCommunication as properties to the RDF node n
+func (r RelatedPersonCommunication) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("RelatedPerson.communication.language", -1, r.Language)
+	n.primitive("RelatedPerson.communication.preferred", -1, r.Preferred, r.PreferredElement, "boolean")
+}
+
+// MarshalBSON marshals the given RelatedPersonCommunication as BSON document with the structure of its FHIR JSON
+func (r RelatedPersonCommunication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RelatedPersonCommunication from a BSON document with the structure of its FHIR JSON
+func (r *RelatedPersonCommunication) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RelatedPersonCommunication as protobuf message RelatedPersonCommunication of fhir.proto
+func (r RelatedPersonCommunication) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RelatedPersonCommunication to the encoded message
+func (r RelatedPersonCommunication) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Language)
+	if r.Preferred != nil {
+		e.varint(5, protoBool(*r.Preferred))
+	}
+	if r.PreferredElement != nil {
+		e.message(6, *r.PreferredElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RelatedPersonCommunication from protobuf message RelatedPersonCommunication of fhir.proto
+func (r *RelatedPersonCommunication) UnmarshalProto(b []byte) error {
+	*r = RelatedPersonCommunication{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Language = v
+		case 5:
+			v := d.bool()
+			r.Preferred = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.PreferredElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalRelatedPerson unmarshals a RelatedPerson.
 func UnmarshalRelatedPerson(b []byte) (RelatedPerson, error) {
 	var relatedPerson RelatedPerson
-	if err := json.Unmarshal(b, &relatedPerson); err != nil {
+	if err := relatedPerson.UnmarshalJSON(b); err != nil {
 		return relatedPerson, err
 	}
 	return relatedPerson, nil
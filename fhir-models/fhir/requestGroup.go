@@ -14,101 +14,4367 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // RequestGroup is documented here http://hl7.org/fhir/StructureDefinition/RequestGroup
 type RequestGroup struct {
-	Id                    *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string              `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical []string             `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string             `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference          `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Replaces              []Reference          `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	GroupIdentifier       *Identifier          `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	Status                RequestStatus        `bson:"status" json:"status"`
-	Intent                RequestIntent        `bson:"intent" json:"intent"`
-	Priority              *RequestPriority     `bson:"priority,omitempty" json:"priority,omitempty"`
-	Code                  *CodeableConcept     `bson:"code,omitempty" json:"code,omitempty"`
-	Subject               *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter             *Reference           `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	AuthoredOn            *string              `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Author                *Reference           `bson:"author,omitempty" json:"author,omitempty"`
-	ReasonCode            []CodeableConcept    `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       []Reference          `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Note                  []Annotation         `bson:"note,omitempty" json:"note,omitempty"`
-	Action                []RequestGroupAction `bson:"action,omitempty" json:"action,omitempty"`
+	Id                           *string              `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element             `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element             `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string              `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element             `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []string             `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element           `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string             `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element           `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference          `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Replaces                     []Reference          `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	GroupIdentifier              *Identifier          `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	Status                       RequestStatus        `bson:"status" json:"status"`
+	StatusElement                *Element             `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       RequestIntent        `bson:"intent" json:"intent"`
+	IntentElement                *Element             `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Priority                     *RequestPriority     `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element             `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Code                         *CodeableConcept     `bson:"code,omitempty" json:"code,omitempty"`
+	Subject                      *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter                    *Reference           `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	AuthoredOn                   *string              `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element             `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Author                       *Reference           `bson:"author,omitempty" json:"author,omitempty"`
+	ReasonCode                   []CodeableConcept    `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference          `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Note                         []Annotation         `bson:"note,omitempty" json:"note,omitempty"`
+	Action                       []RequestGroupAction `bson:"action,omitempty" json:"action,omitempty"`
 }
 type RequestGroupAction struct {
-	Id                  *string                           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Prefix              *string                           `bson:"prefix,omitempty" json:"prefix,omitempty"`
-	Title               *string                           `bson:"title,omitempty" json:"title,omitempty"`
-	Description         *string                           `bson:"description,omitempty" json:"description,omitempty"`
-	TextEquivalent      *string                           `bson:"textEquivalent,omitempty" json:"textEquivalent,omitempty"`
-	Priority            *RequestPriority                  `bson:"priority,omitempty" json:"priority,omitempty"`
-	Code                []CodeableConcept                 `bson:"code,omitempty" json:"code,omitempty"`
-	Documentation       []RelatedArtifact                 `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Condition           []RequestGroupActionCondition     `bson:"condition,omitempty" json:"condition,omitempty"`
-	RelatedAction       []RequestGroupActionRelatedAction `bson:"relatedAction,omitempty" json:"relatedAction,omitempty"`
-	TimingDateTime      *string                           `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
-	TimingAge           *Age                              `bson:"timingAge,omitempty" json:"timingAge,omitempty"`
-	TimingPeriod        *Period                           `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
-	TimingDuration      *Duration                         `bson:"timingDuration,omitempty" json:"timingDuration,omitempty"`
-	TimingRange         *Range                            `bson:"timingRange,omitempty" json:"timingRange,omitempty"`
-	TimingTiming        *Timing                           `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
-	Participant         []Reference                       `bson:"participant,omitempty" json:"participant,omitempty"`
-	Type                *CodeableConcept                  `bson:"type,omitempty" json:"type,omitempty"`
-	GroupingBehavior    *ActionGroupingBehavior           `bson:"groupingBehavior,omitempty" json:"groupingBehavior,omitempty"`
-	SelectionBehavior   *ActionSelectionBehavior          `bson:"selectionBehavior,omitempty" json:"selectionBehavior,omitempty"`
-	RequiredBehavior    *ActionRequiredBehavior           `bson:"requiredBehavior,omitempty" json:"requiredBehavior,omitempty"`
-	PrecheckBehavior    *ActionPrecheckBehavior           `bson:"precheckBehavior,omitempty" json:"precheckBehavior,omitempty"`
-	CardinalityBehavior *ActionCardinalityBehavior        `bson:"cardinalityBehavior,omitempty" json:"cardinalityBehavior,omitempty"`
-	Resource            *Reference                        `bson:"resource,omitempty" json:"resource,omitempty"`
-	Action              []RequestGroupAction              `bson:"action,omitempty" json:"action,omitempty"`
+	Id                         *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Prefix                     *string                           `bson:"prefix,omitempty" json:"prefix,omitempty"`
+	PrefixElement              *Element                          `bson:"_prefix,omitempty" json:"_prefix,omitempty"`
+	Title                      *string                           `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement               *Element                          `bson:"_title,omitempty" json:"_title,omitempty"`
+	Description                *string                           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement         *Element                          `bson:"_description,omitempty" json:"_description,omitempty"`
+	TextEquivalent             *string                           `bson:"textEquivalent,omitempty" json:"textEquivalent,omitempty"`
+	TextEquivalentElement      *Element                          `bson:"_textEquivalent,omitempty" json:"_textEquivalent,omitempty"`
+	Priority                   *RequestPriority                  `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement            *Element                          `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Code                       []CodeableConcept                 `bson:"code,omitempty" json:"code,omitempty"`
+	Documentation              []RelatedArtifact                 `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	Condition                  []RequestGroupActionCondition     `bson:"condition,omitempty" json:"condition,omitempty"`
+	RelatedAction              []RequestGroupActionRelatedAction `bson:"relatedAction,omitempty" json:"relatedAction,omitempty"`
+	TimingDateTime             *string                           `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	TimingDateTimeElement      *Element                          `bson:"_timingDateTime,omitempty" json:"_timingDateTime,omitempty"`
+	TimingAge                  *Age                              `bson:"timingAge,omitempty" json:"timingAge,omitempty"`
+	TimingPeriod               *Period                           `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	TimingDuration             *Duration                         `bson:"timingDuration,omitempty" json:"timingDuration,omitempty"`
+	TimingRange                *Range                            `bson:"timingRange,omitempty" json:"timingRange,omitempty"`
+	TimingTiming               *Timing                           `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	Participant                []Reference                       `bson:"participant,omitempty" json:"participant,omitempty"`
+	Type                       *CodeableConcept                  `bson:"type,omitempty" json:"type,omitempty"`
+	GroupingBehavior           *ActionGroupingBehavior           `bson:"groupingBehavior,omitempty" json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement    *Element                          `bson:"_groupingBehavior,omitempty" json:"_groupingBehavior,omitempty"`
+	SelectionBehavior          *ActionSelectionBehavior          `bson:"selectionBehavior,omitempty" json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement   *Element                          `bson:"_selectionBehavior,omitempty" json:"_selectionBehavior,omitempty"`
+	RequiredBehavior           *ActionRequiredBehavior           `bson:"requiredBehavior,omitempty" json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement    *Element                          `bson:"_requiredBehavior,omitempty" json:"_requiredBehavior,omitempty"`
+	PrecheckBehavior           *ActionPrecheckBehavior           `bson:"precheckBehavior,omitempty" json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement    *Element                          `bson:"_precheckBehavior,omitempty" json:"_precheckBehavior,omitempty"`
+	CardinalityBehavior        *ActionCardinalityBehavior        `bson:"cardinalityBehavior,omitempty" json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *Element                          `bson:"_cardinalityBehavior,omitempty" json:"_cardinalityBehavior,omitempty"`
+	Resource                   *Reference                        `bson:"resource,omitempty" json:"resource,omitempty"`
+	Action                     []RequestGroupAction              `bson:"action,omitempty" json:"action,omitempty"`
 }
 type RequestGroupActionCondition struct {
 	Id                *string             `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Kind              ActionConditionKind `bson:"kind" json:"kind"`
+	KindElement       *Element            `bson:"_kind,omitempty" json:"_kind,omitempty"`
 	Expression        *Expression         `bson:"expression,omitempty" json:"expression,omitempty"`
 }
 type RequestGroupActionRelatedAction struct {
-	Id                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ActionId          string                 `bson:"actionId" json:"actionId"`
-	Relationship      ActionRelationshipType `bson:"relationship" json:"relationship"`
-	OffsetDuration    *Duration              `bson:"offsetDuration,omitempty" json:"offsetDuration,omitempty"`
-	OffsetRange       *Range                 `bson:"offsetRange,omitempty" json:"offsetRange,omitempty"`
+	Id                  *string                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ActionId            string                 `bson:"actionId" json:"actionId"`
+	ActionIdElement     *Element               `bson:"_actionId,omitempty" json:"_actionId,omitempty"`
+	Relationship        ActionRelationshipType `bson:"relationship" json:"relationship"`
+	RelationshipElement *Element               `bson:"_relationship,omitempty" json:"_relationship,omitempty"`
+	OffsetDuration      *Duration              `bson:"offsetDuration,omitempty" json:"offsetDuration,omitempty"`
+	OffsetRange         *Range                 `bson:"offsetRange,omitempty" json:"offsetRange,omitempty"`
+}
+
+// RequestGroupActionRelatedActionOffset is implemented by the types allowed for RequestGroup.action.relatedAction.offset[x]
+type RequestGroupActionRelatedActionOffset interface {
+	isRequestGroupActionRelatedActionOffset()
+}
+
+func (Duration) isRequestGroupActionRelatedActionOffset() {}
+func (Range) isRequestGroupActionRelatedActionOffset()    {}
+
+// Offset returns the value of RequestGroup.action.relatedAction.offset[x] together with its FHIR type code or nil if no value is set
+func (r RequestGroupActionRelatedAction) Offset() (RequestGroupActionRelatedActionOffset, string) {
+	if r.OffsetDuration != nil {
+		return *r.OffsetDuration, "Duration"
+	}
+	if r.OffsetRange != nil {
+		return *r.OffsetRange, "Range"
+	}
+	return nil, ""
+}
+
+// SetOffset sets the value of RequestGroup.action.relatedAction.offset[x], given as value or pointer, and clears all other types
+func (r *RequestGroupActionRelatedAction) SetOffset(value RequestGroupActionRelatedActionOffset) {
+	r.OffsetDuration = nil
+	r.OffsetRange = nil
+	switch v := value.(type) {
+	case Duration:
+		r.OffsetDuration = &v
+	case *Duration:
+		r.OffsetDuration = v
+	case Range:
+		r.OffsetRange = &v
+	case *Range:
+		r.OffsetRange = v
+	}
+}
+
+// RequestGroupActionTiming is implemented by the types allowed for RequestGroup.action.timing[x]
+type RequestGroupActionTiming interface {
+	isRequestGroupActionTiming()
+}
+
+func (DateTime) isRequestGroupActionTiming() {}
+func (Age) isRequestGroupActionTiming()      {}
+func (Period) isRequestGroupActionTiming()   {}
+func (Duration) isRequestGroupActionTiming() {}
+func (Range) isRequestGroupActionTiming()    {}
+func (Timing) isRequestGroupActionTiming()   {}
+
+// Timing returns the value of RequestGroup.action.timing[x] together with its FHIR type code or nil if no value is set
+func (r RequestGroupAction) Timing() (RequestGroupActionTiming, string) {
+	if r.TimingDateTime != nil {
+		return DateTime(*r.TimingDateTime), "dateTime"
+	}
+	if r.TimingAge != nil {
+		return *r.TimingAge, "Age"
+	}
+	if r.TimingPeriod != nil {
+		return *r.TimingPeriod, "Period"
+	}
+	if r.TimingDuration != nil {
+		return *r.TimingDuration, "Duration"
+	}
+	if r.TimingRange != nil {
+		return *r.TimingRange, "Range"
+	}
+	if r.TimingTiming != nil {
+		return *r.TimingTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetTiming sets the value of RequestGroup.action.timing[x], given as value or pointer, and clears all other types
+func (r *RequestGroupAction) SetTiming(value RequestGroupActionTiming) {
+	r.TimingDateTime = nil
+	r.TimingAge = nil
+	r.TimingPeriod = nil
+	r.TimingDuration = nil
+	r.TimingRange = nil
+	r.TimingTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.TimingDateTime = &primitive
+	case *DateTime:
+		r.TimingDateTime = (*string)(v)
+	case Age:
+		r.TimingAge = &v
+	case *Age:
+		r.TimingAge = v
+	case Period:
+		r.TimingPeriod = &v
+	case *Period:
+		r.TimingPeriod = v
+	case Duration:
+		r.TimingDuration = &v
+	case *Duration:
+		r.TimingDuration = v
+	case Range:
+		r.TimingRange = &v
+	case *Range:
+		r.TimingRange = v
+	case Timing:
+		r.TimingTiming = &v
+	case *Timing:
+		r.TimingTiming = v
+	}
 }
-type OtherRequestGroup RequestGroup
 
 // MarshalJSON marshals the given RequestGroup as JSON into a byte slice
 func (r RequestGroup) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherRequestGroup
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherRequestGroup: OtherRequestGroup(r),
-		ResourceType:      "RequestGroup",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RequestGroup as JSON object with the members in the order of the fields
+func (r RequestGroup) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"RequestGroup\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Replaces) > 0 {
+		e.key("replaces")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Replaces {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.GroupIdentifier != nil {
+		e.key("groupIdentifier")
+		r.GroupIdentifier.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("intent")
+	e.string(r.Intent.Code())
+	if r.IntentElement != nil {
+		e.key("_intent")
+		r.IntentElement.appendJSON(e)
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.AuthoredOn != nil {
+		e.key("authoredOn")
+		e.string(*r.AuthoredOn)
+	}
+	if r.AuthoredOnElement != nil {
+		e.key("_authoredOn")
+		r.AuthoredOnElement.appendJSON(e)
+	}
+	if r.Author != nil {
+		e.key("author")
+		r.Author.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Action) > 0 {
+		e.key("action")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RequestGroup from JSON. Unknown members are ignored.
+func (r *RequestGroup) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RequestGroup
+func (r *RequestGroup) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "replaces":
+			a := d.array()
+			r.Replaces = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Replaces = append(r.Replaces, v)
+			}
+			if r.Replaces == nil && !a.isNull {
+				r.Replaces = []Reference{}
+			}
+		case "groupIdentifier":
+			if d.null() {
+				r.GroupIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.GroupIdentifier = &v
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "intent":
+			d.unmarshal(&r.Intent)
+		case "_intent":
+			r.IntentElement = d.element()
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "authoredOn":
+			if v, ok := d.string(); ok {
+				r.AuthoredOn = &v
+			} else {
+				r.AuthoredOn = nil
+			}
+		case "_authoredOn":
+			r.AuthoredOnElement = d.element()
+		case "author":
+			if d.null() {
+				r.Author = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Author = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v RequestGroupAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []RequestGroupAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RequestGroup which shares no memory with the original
+func (r RequestGroup) DeepCopy() RequestGroup {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.Replaces != nil {
+		out.Replaces = make([]Reference, len(r.Replaces))
+		for i := range r.Replaces {
+			out.Replaces[i] = r.Replaces[i].DeepCopy()
+		}
+	}
+	if r.GroupIdentifier != nil {
+		v := r.GroupIdentifier.DeepCopy()
+		out.GroupIdentifier = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.IntentElement != nil {
+		v := r.IntentElement.DeepCopy()
+		out.IntentElement = &v
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.AuthoredOn != nil {
+		v := *r.AuthoredOn
+		out.AuthoredOn = &v
+	}
+	if r.AuthoredOnElement != nil {
+		v := r.AuthoredOnElement.DeepCopy()
+		out.AuthoredOnElement = &v
+	}
+	if r.Author != nil {
+		v := r.Author.DeepCopy()
+		out.Author = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		out.Action = make([]RequestGroupAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RequestGroup) Equal(other RequestGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.Replaces) != len(other.Replaces) {
+		return false
+	}
+	for i := range r.Replaces {
+		if !r.Replaces[i].Equal(other.Replaces[i]) {
+			return false
+		}
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.Equal(*other.GroupIdentifier) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.Equal(*other.IntentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && *r.AuthoredOn != *other.AuthoredOn {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.Equal(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.Equal(*other.Author) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RequestGroup) EqualsDeep(other RequestGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Replaces), len(other.Replaces), func(i, j int) bool {
+		return r.Replaces[i].EqualsDeep(other.Replaces[j])
+	}) {
+		return false
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.EqualsDeep(*other.GroupIdentifier) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.EqualsDeep(*other.IntentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.EqualsDeep(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Author == nil) != (other.Author == nil) || r.Author != nil && !r.Author.EqualsDeep(*other.Author) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RequestGroup) EqualsShallow(other RequestGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RequestGroup as FHIR XML
+func (r RequestGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "RequestGroup",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Replaces {
+		if err := encodeXMLElement(e, "replaces", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "groupIdentifier", r.GroupIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "intent", r.Intent, r.IntentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "authoredOn", r.AuthoredOn, r.AuthoredOnElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "author", r.Author); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RequestGroup from FHIR XML
+func (r *RequestGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "replaces":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Replaces = append(r.Replaces, v)
+			case "groupIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GroupIdentifier = &v
+			case "status":
+				var v RequestStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "intent":
+				var v RequestIntent
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Intent = v
+				}
+				r.IntentElement = element
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "authoredOn":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthoredOn = &v
+				}
+				r.AuthoredOnElement = element
+			case "author":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "action":
+				var v RequestGroupAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RequestGroup as properties to the RDF node n
+func (r RequestGroup) turtle(n *turtleNode) {
+	n.resource("RequestGroup", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("RequestGroup.identifier", i, v)
+	}
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("RequestGroup.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("RequestGroup.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	for i, v := range r.BasedOn {
+		n.element("RequestGroup.basedOn", i, v)
+	}
+	for i, v := range r.Replaces {
+		n.element("RequestGroup.replaces", i, v)
+	}
+	n.element("RequestGroup.groupIdentifier", -1, r.GroupIdentifier)
+	n.primitive("RequestGroup.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("RequestGroup.intent", -1, r.Intent, r.IntentElement, "code")
+	n.primitive("RequestGroup.priority", -1, r.Priority, r.PriorityElement, "code")
+	n.element("RequestGroup.code", -1, r.Code)
+	n.element("RequestGroup.subject", -1, r.Subject)
+	n.element("RequestGroup.encounter", -1, r.Encounter)
+	n.primitive("RequestGroup.authoredOn", -1, r.AuthoredOn, r.AuthoredOnElement, "dateTime")
+	n.element("RequestGroup.author", -1, r.Author)
+	for i, v := range r.ReasonCode {
+		n.element("RequestGroup.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("RequestGroup.reasonReference", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("RequestGroup.note", i, v)
+	}
+	for i, v := range r.Action {
+		n.element("RequestGroup.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given RequestGroup as BSON document with the structure of its FHIR JSON
+func (r RequestGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RequestGroup from a BSON document with the structure of its FHIR JSON
+func (r *RequestGroup) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RequestGroup as protobuf message RequestGroup of fhir.proto
+func (r RequestGroup) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RequestGroup to the encoded message
+func (r RequestGroup) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(10, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(11, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(12, v)
+	}
+	for _, v := range r.Replaces {
+		e.message(13, v)
+	}
+	if r.GroupIdentifier != nil {
+		e.message(14, *r.GroupIdentifier)
+	}
+	e.varint(15, r.Status.protoNumber())
+	e.varint(16, r.Intent.protoNumber())
+	if r.Priority != nil {
+		e.varint(17, r.Priority.protoNumber())
+	}
+	if r.Code != nil {
+		e.message(18, *r.Code)
+	}
+	if r.Subject != nil {
+		e.message(19, *r.Subject)
+	}
+	if r.Encounter != nil {
+		e.message(20, *r.Encounter)
+	}
+	if r.AuthoredOn != nil {
+		e.string(21, *r.AuthoredOn)
+	}
+	if r.Author != nil {
+		e.message(22, *r.Author)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(23, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(24, v)
+	}
+	for _, v := range r.Note {
+		e.message(25, v)
+	}
+	for _, v := range r.Action {
+		e.message(26, v)
+	}
+	if r.IdElement != nil {
+		e.message(27, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(28, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(29, *r.LanguageElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(30, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(31, v)
+	}
+	if r.StatusElement != nil {
+		e.message(32, *r.StatusElement)
+	}
+	if r.IntentElement != nil {
+		e.message(33, *r.IntentElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(34, *r.PriorityElement)
+	}
+	if r.AuthoredOnElement != nil {
+		e.message(35, *r.AuthoredOnElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RequestGroup from protobuf message RequestGroup of fhir.proto
+func (r *RequestGroup) UnmarshalProto(b []byte) error {
+	*r = RequestGroup{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 11:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Replaces = append(r.Replaces, v)
+		case 14:
+			var v Identifier
+			d.message(&v)
+			r.GroupIdentifier = &v
+		case 15:
+			var v RequestStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 16:
+			var v RequestIntent
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Intent = v
+		case 17:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 21:
+			v := d.string()
+			r.AuthoredOn = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.Author = &v
+		case 23:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 25:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 26:
+			var v RequestGroupAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		case 27:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 30:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 31:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		case 32:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.IntentElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.AuthoredOnElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RequestGroupAction as JSON into a byte slice
+func (r RequestGroupAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RequestGroupAction as JSON object with the members in the order of the fields
+func (r RequestGroupAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Prefix != nil {
+		e.key("prefix")
+		e.string(*r.Prefix)
+	}
+	if r.PrefixElement != nil {
+		e.key("_prefix")
+		r.PrefixElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.TextEquivalent != nil {
+		e.key("textEquivalent")
+		e.string(*r.TextEquivalent)
+	}
+	if r.TextEquivalentElement != nil {
+		e.key("_textEquivalent")
+		r.TextEquivalentElement.appendJSON(e)
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Documentation) > 0 {
+		e.key("documentation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Documentation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Condition) > 0 {
+		e.key("condition")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Condition {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedAction) > 0 {
+		e.key("relatedAction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedAction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.TimingDateTime != nil {
+		e.key("timingDateTime")
+		e.string(*r.TimingDateTime)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.key("_timingDateTime")
+		r.TimingDateTimeElement.appendJSON(e)
+	}
+	if r.TimingAge != nil {
+		e.key("timingAge")
+		r.TimingAge.appendJSON(e)
+	}
+	if r.TimingPeriod != nil {
+		e.key("timingPeriod")
+		r.TimingPeriod.appendJSON(e)
+	}
+	if r.TimingDuration != nil {
+		e.key("timingDuration")
+		r.TimingDuration.appendJSON(e)
+	}
+	if r.TimingRange != nil {
+		e.key("timingRange")
+		r.TimingRange.appendJSON(e)
+	}
+	if r.TimingTiming != nil {
+		e.key("timingTiming")
+		r.TimingTiming.appendJSON(e)
+	}
+	if len(r.Participant) > 0 {
+		e.key("participant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Participant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.GroupingBehavior != nil {
+		e.key("groupingBehavior")
+		e.string(r.GroupingBehavior.Code())
+	}
+	if r.GroupingBehaviorElement != nil {
+		e.key("_groupingBehavior")
+		r.GroupingBehaviorElement.appendJSON(e)
+	}
+	if r.SelectionBehavior != nil {
+		e.key("selectionBehavior")
+		e.string(r.SelectionBehavior.Code())
+	}
+	if r.SelectionBehaviorElement != nil {
+		e.key("_selectionBehavior")
+		r.SelectionBehaviorElement.appendJSON(e)
+	}
+	if r.RequiredBehavior != nil {
+		e.key("requiredBehavior")
+		e.string(r.RequiredBehavior.Code())
+	}
+	if r.RequiredBehaviorElement != nil {
+		e.key("_requiredBehavior")
+		r.RequiredBehaviorElement.appendJSON(e)
+	}
+	if r.PrecheckBehavior != nil {
+		e.key("precheckBehavior")
+		e.string(r.PrecheckBehavior.Code())
+	}
+	if r.PrecheckBehaviorElement != nil {
+		e.key("_precheckBehavior")
+		r.PrecheckBehaviorElement.appendJSON(e)
+	}
+	if r.CardinalityBehavior != nil {
+		e.key("cardinalityBehavior")
+		e.string(r.CardinalityBehavior.Code())
+	}
+	if r.CardinalityBehaviorElement != nil {
+		e.key("_cardinalityBehavior")
+		r.CardinalityBehaviorElement.appendJSON(e)
+	}
+	if r.Resource != nil {
+		e.key("resource")
+		r.Resource.appendJSON(e)
+	}
+	if len(r.Action) > 0 {
+		e.key("action")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RequestGroupAction from JSON. Unknown members are ignored.
+func (r *RequestGroupAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RequestGroupAction
+func (r *RequestGroupAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "prefix":
+			if v, ok := d.string(); ok {
+				r.Prefix = &v
+			} else {
+				r.Prefix = nil
+			}
+		case "_prefix":
+			r.PrefixElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "textEquivalent":
+			if v, ok := d.string(); ok {
+				r.TextEquivalent = &v
+			} else {
+				r.TextEquivalent = nil
+			}
+		case "_textEquivalent":
+			r.TextEquivalentElement = d.element()
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []CodeableConcept{}
+			}
+		case "documentation":
+			a := d.array()
+			r.Documentation = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.Documentation = append(r.Documentation, v)
+			}
+			if r.Documentation == nil && !a.isNull {
+				r.Documentation = []RelatedArtifact{}
+			}
+		case "condition":
+			a := d.array()
+			r.Condition = nil
+			for a.next() {
+				var v RequestGroupActionCondition
+				v.decodeJSON(d)
+				r.Condition = append(r.Condition, v)
+			}
+			if r.Condition == nil && !a.isNull {
+				r.Condition = []RequestGroupActionCondition{}
+			}
+		case "relatedAction":
+			a := d.array()
+			r.RelatedAction = nil
+			for a.next() {
+				var v RequestGroupActionRelatedAction
+				v.decodeJSON(d)
+				r.RelatedAction = append(r.RelatedAction, v)
+			}
+			if r.RelatedAction == nil && !a.isNull {
+				r.RelatedAction = []RequestGroupActionRelatedAction{}
+			}
+		case "timingDateTime":
+			if v, ok := d.string(); ok {
+				r.TimingDateTime = &v
+			} else {
+				r.TimingDateTime = nil
+			}
+		case "_timingDateTime":
+			r.TimingDateTimeElement = d.element()
+		case "timingAge":
+			if d.null() {
+				r.TimingAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.TimingAge = &v
+			}
+		case "timingPeriod":
+			if d.null() {
+				r.TimingPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.TimingPeriod = &v
+			}
+		case "timingDuration":
+			if d.null() {
+				r.TimingDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.TimingDuration = &v
+			}
+		case "timingRange":
+			if d.null() {
+				r.TimingRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.TimingRange = &v
+			}
+		case "timingTiming":
+			if d.null() {
+				r.TimingTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.TimingTiming = &v
+			}
+		case "participant":
+			a := d.array()
+			r.Participant = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Participant = append(r.Participant, v)
+			}
+			if r.Participant == nil && !a.isNull {
+				r.Participant = []Reference{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "groupingBehavior":
+			if d.null() {
+				r.GroupingBehavior = nil
+			} else {
+				var v ActionGroupingBehavior
+				d.unmarshal(&v)
+				r.GroupingBehavior = &v
+			}
+		case "_groupingBehavior":
+			r.GroupingBehaviorElement = d.element()
+		case "selectionBehavior":
+			if d.null() {
+				r.SelectionBehavior = nil
+			} else {
+				var v ActionSelectionBehavior
+				d.unmarshal(&v)
+				r.SelectionBehavior = &v
+			}
+		case "_selectionBehavior":
+			r.SelectionBehaviorElement = d.element()
+		case "requiredBehavior":
+			if d.null() {
+				r.RequiredBehavior = nil
+			} else {
+				var v ActionRequiredBehavior
+				d.unmarshal(&v)
+				r.RequiredBehavior = &v
+			}
+		case "_requiredBehavior":
+			r.RequiredBehaviorElement = d.element()
+		case "precheckBehavior":
+			if d.null() {
+				r.PrecheckBehavior = nil
+			} else {
+				var v ActionPrecheckBehavior
+				d.unmarshal(&v)
+				r.PrecheckBehavior = &v
+			}
+		case "_precheckBehavior":
+			r.PrecheckBehaviorElement = d.element()
+		case "cardinalityBehavior":
+			if d.null() {
+				r.CardinalityBehavior = nil
+			} else {
+				var v ActionCardinalityBehavior
+				d.unmarshal(&v)
+				r.CardinalityBehavior = &v
+			}
+		case "_cardinalityBehavior":
+			r.CardinalityBehaviorElement = d.element()
+		case "resource":
+			if d.null() {
+				r.Resource = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Resource = &v
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v RequestGroupAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []RequestGroupAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RequestGroupAction which shares no memory with the original
+func (r RequestGroupAction) DeepCopy() RequestGroupAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Prefix != nil {
+		v := *r.Prefix
+		out.Prefix = &v
+	}
+	if r.PrefixElement != nil {
+		v := r.PrefixElement.DeepCopy()
+		out.PrefixElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.TextEquivalent != nil {
+		v := *r.TextEquivalent
+		out.TextEquivalent = &v
+	}
+	if r.TextEquivalentElement != nil {
+		v := r.TextEquivalentElement.DeepCopy()
+		out.TextEquivalentElement = &v
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.Code != nil {
+		out.Code = make([]CodeableConcept, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Documentation != nil {
+		out.Documentation = make([]RelatedArtifact, len(r.Documentation))
+		for i := range r.Documentation {
+			out.Documentation[i] = r.Documentation[i].DeepCopy()
+		}
+	}
+	if r.Condition != nil {
+		out.Condition = make([]RequestGroupActionCondition, len(r.Condition))
+		for i := range r.Condition {
+			out.Condition[i] = r.Condition[i].DeepCopy()
+		}
+	}
+	if r.RelatedAction != nil {
+		out.RelatedAction = make([]RequestGroupActionRelatedAction, len(r.RelatedAction))
+		for i := range r.RelatedAction {
+			out.RelatedAction[i] = r.RelatedAction[i].DeepCopy()
+		}
+	}
+	if r.TimingDateTime != nil {
+		v := *r.TimingDateTime
+		out.TimingDateTime = &v
+	}
+	if r.TimingDateTimeElement != nil {
+		v := r.TimingDateTimeElement.DeepCopy()
+		out.TimingDateTimeElement = &v
+	}
+	if r.TimingAge != nil {
+		v := r.TimingAge.DeepCopy()
+		out.TimingAge = &v
+	}
+	if r.TimingPeriod != nil {
+		v := r.TimingPeriod.DeepCopy()
+		out.TimingPeriod = &v
+	}
+	if r.TimingDuration != nil {
+		v := r.TimingDuration.DeepCopy()
+		out.TimingDuration = &v
+	}
+	if r.TimingRange != nil {
+		v := r.TimingRange.DeepCopy()
+		out.TimingRange = &v
+	}
+	if r.TimingTiming != nil {
+		v := r.TimingTiming.DeepCopy()
+		out.TimingTiming = &v
+	}
+	if r.Participant != nil {
+		out.Participant = make([]Reference, len(r.Participant))
+		for i := range r.Participant {
+			out.Participant[i] = r.Participant[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.GroupingBehavior != nil {
+		v := *r.GroupingBehavior
+		out.GroupingBehavior = &v
+	}
+	if r.GroupingBehaviorElement != nil {
+		v := r.GroupingBehaviorElement.DeepCopy()
+		out.GroupingBehaviorElement = &v
+	}
+	if r.SelectionBehavior != nil {
+		v := *r.SelectionBehavior
+		out.SelectionBehavior = &v
+	}
+	if r.SelectionBehaviorElement != nil {
+		v := r.SelectionBehaviorElement.DeepCopy()
+		out.SelectionBehaviorElement = &v
+	}
+	if r.RequiredBehavior != nil {
+		v := *r.RequiredBehavior
+		out.RequiredBehavior = &v
+	}
+	if r.RequiredBehaviorElement != nil {
+		v := r.RequiredBehaviorElement.DeepCopy()
+		out.RequiredBehaviorElement = &v
+	}
+	if r.PrecheckBehavior != nil {
+		v := *r.PrecheckBehavior
+		out.PrecheckBehavior = &v
+	}
+	if r.PrecheckBehaviorElement != nil {
+		v := r.PrecheckBehaviorElement.DeepCopy()
+		out.PrecheckBehaviorElement = &v
+	}
+	if r.CardinalityBehavior != nil {
+		v := *r.CardinalityBehavior
+		out.CardinalityBehavior = &v
+	}
+	if r.CardinalityBehaviorElement != nil {
+		v := r.CardinalityBehaviorElement.DeepCopy()
+		out.CardinalityBehaviorElement = &v
+	}
+	if r.Resource != nil {
+		v := r.Resource.DeepCopy()
+		out.Resource = &v
+	}
+	if r.Action != nil {
+		out.Action = make([]RequestGroupAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RequestGroupAction) Equal(other RequestGroupAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Prefix == nil) != (other.Prefix == nil) || r.Prefix != nil && *r.Prefix != *other.Prefix {
+		return false
+	}
+	if (r.PrefixElement == nil) != (other.PrefixElement == nil) || r.PrefixElement != nil && !r.PrefixElement.Equal(*other.PrefixElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.TextEquivalent == nil) != (other.TextEquivalent == nil) || r.TextEquivalent != nil && *r.TextEquivalent != *other.TextEquivalent {
+		return false
+	}
+	if (r.TextEquivalentElement == nil) != (other.TextEquivalentElement == nil) || r.TextEquivalentElement != nil && !r.TextEquivalentElement.Equal(*other.TextEquivalentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if len(r.Documentation) != len(other.Documentation) {
+		return false
+	}
+	for i := range r.Documentation {
+		if !r.Documentation[i].Equal(other.Documentation[i]) {
+			return false
+		}
+	}
+	if len(r.Condition) != len(other.Condition) {
+		return false
+	}
+	for i := range r.Condition {
+		if !r.Condition[i].Equal(other.Condition[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedAction) != len(other.RelatedAction) {
+		return false
+	}
+	for i := range r.RelatedAction {
+		if !r.RelatedAction[i].Equal(other.RelatedAction[i]) {
+			return false
+		}
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && *r.TimingDateTime != *other.TimingDateTime {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.Equal(*other.TimingDateTimeElement) {
+		return false
+	}
+	if (r.TimingAge == nil) != (other.TimingAge == nil) || r.TimingAge != nil && !r.TimingAge.Equal(*other.TimingAge) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.Equal(*other.TimingPeriod) {
+		return false
+	}
+	if (r.TimingDuration == nil) != (other.TimingDuration == nil) || r.TimingDuration != nil && !r.TimingDuration.Equal(*other.TimingDuration) {
+		return false
+	}
+	if (r.TimingRange == nil) != (other.TimingRange == nil) || r.TimingRange != nil && !r.TimingRange.Equal(*other.TimingRange) {
+		return false
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.Equal(*other.TimingTiming) {
+		return false
+	}
+	if len(r.Participant) != len(other.Participant) {
+		return false
+	}
+	for i := range r.Participant {
+		if !r.Participant[i].Equal(other.Participant[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.GroupingBehavior == nil) != (other.GroupingBehavior == nil) || r.GroupingBehavior != nil && *r.GroupingBehavior != *other.GroupingBehavior {
+		return false
+	}
+	if (r.GroupingBehaviorElement == nil) != (other.GroupingBehaviorElement == nil) || r.GroupingBehaviorElement != nil && !r.GroupingBehaviorElement.Equal(*other.GroupingBehaviorElement) {
+		return false
+	}
+	if (r.SelectionBehavior == nil) != (other.SelectionBehavior == nil) || r.SelectionBehavior != nil && *r.SelectionBehavior != *other.SelectionBehavior {
+		return false
+	}
+	if (r.SelectionBehaviorElement == nil) != (other.SelectionBehaviorElement == nil) || r.SelectionBehaviorElement != nil && !r.SelectionBehaviorElement.Equal(*other.SelectionBehaviorElement) {
+		return false
+	}
+	if (r.RequiredBehavior == nil) != (other.RequiredBehavior == nil) || r.RequiredBehavior != nil && *r.RequiredBehavior != *other.RequiredBehavior {
+		return false
+	}
+	if (r.RequiredBehaviorElement == nil) != (other.RequiredBehaviorElement == nil) || r.RequiredBehaviorElement != nil && !r.RequiredBehaviorElement.Equal(*other.RequiredBehaviorElement) {
+		return false
+	}
+	if (r.PrecheckBehavior == nil) != (other.PrecheckBehavior == nil) || r.PrecheckBehavior != nil && *r.PrecheckBehavior != *other.PrecheckBehavior {
+		return false
+	}
+	if (r.PrecheckBehaviorElement == nil) != (other.PrecheckBehaviorElement == nil) || r.PrecheckBehaviorElement != nil && !r.PrecheckBehaviorElement.Equal(*other.PrecheckBehaviorElement) {
+		return false
+	}
+	if (r.CardinalityBehavior == nil) != (other.CardinalityBehavior == nil) || r.CardinalityBehavior != nil && *r.CardinalityBehavior != *other.CardinalityBehavior {
+		return false
+	}
+	if (r.CardinalityBehaviorElement == nil) != (other.CardinalityBehaviorElement == nil) || r.CardinalityBehaviorElement != nil && !r.CardinalityBehaviorElement.Equal(*other.CardinalityBehaviorElement) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !r.Resource.Equal(*other.Resource) {
+		return false
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RequestGroupAction) EqualsDeep(other RequestGroupAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Prefix == nil) != (other.Prefix == nil) || r.Prefix != nil && !equivalentString(*r.Prefix, *other.Prefix) {
+		return false
+	}
+	if (r.PrefixElement == nil) != (other.PrefixElement == nil) || r.PrefixElement != nil && !r.PrefixElement.EqualsDeep(*other.PrefixElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.TextEquivalent == nil) != (other.TextEquivalent == nil) || r.TextEquivalent != nil && !equivalentString(*r.TextEquivalent, *other.TextEquivalent) {
+		return false
+	}
+	if (r.TextEquivalentElement == nil) != (other.TextEquivalentElement == nil) || r.TextEquivalentElement != nil && !r.TextEquivalentElement.EqualsDeep(*other.TextEquivalentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Documentation), len(other.Documentation), func(i, j int) bool {
+		return r.Documentation[i].EqualsDeep(other.Documentation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Condition), len(other.Condition), func(i, j int) bool {
+		return r.Condition[i].EqualsDeep(other.Condition[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedAction), len(other.RelatedAction), func(i, j int) bool {
+		return r.RelatedAction[i].EqualsDeep(other.RelatedAction[j])
+	}) {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	if (r.TimingDateTimeElement == nil) != (other.TimingDateTimeElement == nil) || r.TimingDateTimeElement != nil && !r.TimingDateTimeElement.EqualsDeep(*other.TimingDateTimeElement) {
+		return false
+	}
+	if (r.TimingAge == nil) != (other.TimingAge == nil) || r.TimingAge != nil && !r.TimingAge.EqualsDeep(*other.TimingAge) {
+		return false
+	}
+	if (r.TimingPeriod == nil) != (other.TimingPeriod == nil) || r.TimingPeriod != nil && !r.TimingPeriod.EqualsDeep(*other.TimingPeriod) {
+		return false
+	}
+	if (r.TimingDuration == nil) != (other.TimingDuration == nil) || r.TimingDuration != nil && !r.TimingDuration.EqualsDeep(*other.TimingDuration) {
+		return false
+	}
+	if (r.TimingRange == nil) != (other.TimingRange == nil) || r.TimingRange != nil && !r.TimingRange.EqualsDeep(*other.TimingRange) {
+		return false
+	}
+	if (r.TimingTiming == nil) != (other.TimingTiming == nil) || r.TimingTiming != nil && !r.TimingTiming.EqualsDeep(*other.TimingTiming) {
+		return false
+	}
+	if !equivalentList(len(r.Participant), len(other.Participant), func(i, j int) bool {
+		return r.Participant[i].EqualsDeep(other.Participant[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.GroupingBehavior == nil) != (other.GroupingBehavior == nil) || r.GroupingBehavior != nil && *r.GroupingBehavior != *other.GroupingBehavior {
+		return false
+	}
+	if (r.GroupingBehaviorElement == nil) != (other.GroupingBehaviorElement == nil) || r.GroupingBehaviorElement != nil && !r.GroupingBehaviorElement.EqualsDeep(*other.GroupingBehaviorElement) {
+		return false
+	}
+	if (r.SelectionBehavior == nil) != (other.SelectionBehavior == nil) || r.SelectionBehavior != nil && *r.SelectionBehavior != *other.SelectionBehavior {
+		return false
+	}
+	if (r.SelectionBehaviorElement == nil) != (other.SelectionBehaviorElement == nil) || r.SelectionBehaviorElement != nil && !r.SelectionBehaviorElement.EqualsDeep(*other.SelectionBehaviorElement) {
+		return false
+	}
+	if (r.RequiredBehavior == nil) != (other.RequiredBehavior == nil) || r.RequiredBehavior != nil && *r.RequiredBehavior != *other.RequiredBehavior {
+		return false
+	}
+	if (r.RequiredBehaviorElement == nil) != (other.RequiredBehaviorElement == nil) || r.RequiredBehaviorElement != nil && !r.RequiredBehaviorElement.EqualsDeep(*other.RequiredBehaviorElement) {
+		return false
+	}
+	if (r.PrecheckBehavior == nil) != (other.PrecheckBehavior == nil) || r.PrecheckBehavior != nil && *r.PrecheckBehavior != *other.PrecheckBehavior {
+		return false
+	}
+	if (r.PrecheckBehaviorElement == nil) != (other.PrecheckBehaviorElement == nil) || r.PrecheckBehaviorElement != nil && !r.PrecheckBehaviorElement.EqualsDeep(*other.PrecheckBehaviorElement) {
+		return false
+	}
+	if (r.CardinalityBehavior == nil) != (other.CardinalityBehavior == nil) || r.CardinalityBehavior != nil && *r.CardinalityBehavior != *other.CardinalityBehavior {
+		return false
+	}
+	if (r.CardinalityBehaviorElement == nil) != (other.CardinalityBehaviorElement == nil) || r.CardinalityBehaviorElement != nil && !r.CardinalityBehaviorElement.EqualsDeep(*other.CardinalityBehaviorElement) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !r.Resource.EqualsDeep(*other.Resource) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RequestGroupAction) EqualsShallow(other RequestGroupAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Prefix == nil) != (other.Prefix == nil) || r.Prefix != nil && !equivalentString(*r.Prefix, *other.Prefix) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.TextEquivalent == nil) != (other.TextEquivalent == nil) || r.TextEquivalent != nil && !equivalentString(*r.TextEquivalent, *other.TextEquivalent) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.TimingDateTime == nil) != (other.TimingDateTime == nil) || r.TimingDateTime != nil && !equivalentString(*r.TimingDateTime, *other.TimingDateTime) {
+		return false
+	}
+	if (r.GroupingBehavior == nil) != (other.GroupingBehavior == nil) || r.GroupingBehavior != nil && *r.GroupingBehavior != *other.GroupingBehavior {
+		return false
+	}
+	if (r.SelectionBehavior == nil) != (other.SelectionBehavior == nil) || r.SelectionBehavior != nil && *r.SelectionBehavior != *other.SelectionBehavior {
+		return false
+	}
+	if (r.RequiredBehavior == nil) != (other.RequiredBehavior == nil) || r.RequiredBehavior != nil && *r.RequiredBehavior != *other.RequiredBehavior {
+		return false
+	}
+	if (r.PrecheckBehavior == nil) != (other.PrecheckBehavior == nil) || r.PrecheckBehavior != nil && *r.PrecheckBehavior != *other.PrecheckBehavior {
+		return false
+	}
+	if (r.CardinalityBehavior == nil) != (other.CardinalityBehavior == nil) || r.CardinalityBehavior != nil && *r.CardinalityBehavior != *other.CardinalityBehavior {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RequestGroupAction as FHIR XML
+func (r RequestGroupAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "prefix", r.Prefix, r.PrefixElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "textEquivalent", r.TextEquivalent, r.TextEquivalentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Documentation {
+		if err := encodeXMLElement(e, "documentation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Condition {
+		if err := encodeXMLElement(e, "condition", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedAction {
+		if err := encodeXMLElement(e, "relatedAction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "timingDateTime", r.TimingDateTime, r.TimingDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingAge", r.TimingAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingPeriod", r.TimingPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingDuration", r.TimingDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingRange", r.TimingRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timingTiming", r.TimingTiming); err != nil {
+		return err
+	}
+	for _, v := range r.Participant {
+		if err := encodeXMLElement(e, "participant", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "groupingBehavior", r.GroupingBehavior, r.GroupingBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "selectionBehavior", r.SelectionBehavior, r.SelectionBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "requiredBehavior", r.RequiredBehavior, r.RequiredBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "precheckBehavior", r.PrecheckBehavior, r.PrecheckBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "cardinalityBehavior", r.CardinalityBehavior, r.CardinalityBehaviorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "resource", r.Resource); err != nil {
+		return err
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RequestGroupAction from FHIR XML
+func (r *RequestGroupAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "prefix":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Prefix = &v
+				}
+				r.PrefixElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "textEquivalent":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TextEquivalent = &v
+				}
+				r.TextEquivalentElement = element
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "documentation":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Documentation = append(r.Documentation, v)
+			case "condition":
+				var v RequestGroupActionCondition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Condition = append(r.Condition, v)
+			case "relatedAction":
+				var v RequestGroupActionRelatedAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedAction = append(r.RelatedAction, v)
+			case "timingDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimingDateTime = &v
+				}
+				r.TimingDateTimeElement = element
+			case "timingAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingAge = &v
+			case "timingPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingPeriod = &v
+			case "timingDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingDuration = &v
+			case "timingRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingRange = &v
+			case "timingTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimingTiming = &v
+			case "participant":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Participant = append(r.Participant, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "groupingBehavior":
+				var v ActionGroupingBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.GroupingBehavior = &v
+				}
+				r.GroupingBehaviorElement = element
+			case "selectionBehavior":
+				var v ActionSelectionBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SelectionBehavior = &v
+				}
+				r.SelectionBehaviorElement = element
+			case "requiredBehavior":
+				var v ActionRequiredBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RequiredBehavior = &v
+				}
+				r.RequiredBehaviorElement = element
+			case "precheckBehavior":
+				var v ActionPrecheckBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PrecheckBehavior = &v
+				}
+				r.PrecheckBehaviorElement = element
+			case "cardinalityBehavior":
+				var v ActionCardinalityBehavior
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CardinalityBehavior = &v
+				}
+				r.CardinalityBehaviorElement = element
+			case "resource":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Resource = &v
+			case "action":
+				var v RequestGroupAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RequestGroupAction as properties to the RDF node n
+func (r RequestGroupAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("RequestGroup.action.prefix", -1, r.Prefix, r.PrefixElement, "string")
+	n.primitive("RequestGroup.action.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("RequestGroup.action.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("RequestGroup.action.textEquivalent", -1, r.TextEquivalent, r.TextEquivalentElement, "string")
+	n.primitive("RequestGroup.action.priority", -1, r.Priority, r.PriorityElement, "code")
+	for i, v := range r.Code {
+		n.element("RequestGroup.action.code", i, v)
+	}
+	for i, v := range r.Documentation {
+		n.element("RequestGroup.action.documentation", i, v)
+	}
+	for i, v := range r.Condition {
+		n.element("RequestGroup.action.condition", i, v)
+	}
+	for i, v := range r.RelatedAction {
+		n.element("RequestGroup.action.relatedAction", i, v)
+	}
+	n.primitive("RequestGroup.action.timingDateTime", -1, r.TimingDateTime, r.TimingDateTimeElement, "dateTime")
+	n.element("RequestGroup.action.timingAge", -1, r.TimingAge)
+	n.element("RequestGroup.action.timingPeriod", -1, r.TimingPeriod)
+	n.element("RequestGroup.action.timingDuration", -1, r.TimingDuration)
+	n.element("RequestGroup.action.timingRange", -1, r.TimingRange)
+	n.element("RequestGroup.action.timingTiming", -1, r.TimingTiming)
+	for i, v := range r.Participant {
+		n.element("RequestGroup.action.participant", i, v)
+	}
+	n.element("RequestGroup.action.type", -1, r.Type)
+	n.primitive("RequestGroup.action.groupingBehavior", -1, r.GroupingBehavior, r.GroupingBehaviorElement, "code")
+	n.primitive("RequestGroup.action.selectionBehavior", -1, r.SelectionBehavior, r.SelectionBehaviorElement, "code")
+	n.primitive("RequestGroup.action.requiredBehavior", -1, r.RequiredBehavior, r.RequiredBehaviorElement, "code")
+	n.primitive("RequestGroup.action.precheckBehavior", -1, r.PrecheckBehavior, r.PrecheckBehaviorElement, "code")
+	n.primitive("RequestGroup.action.cardinalityBehavior", -1, r.CardinalityBehavior, r.CardinalityBehaviorElement, "code")
+	n.element("RequestGroup.action.resource", -1, r.Resource)
+	for i, v := range r.Action {
+		n.element("RequestGroup.action.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given RequestGroupAction as BSON document with the structure of its FHIR JSON
+func (r RequestGroupAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RequestGroupAction from a BSON document with the structure of its FHIR JSON
+func (r *RequestGroupAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RequestGroupAction as protobuf message RequestGroupAction of fhir.proto
+func (r RequestGroupAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RequestGroupAction to the encoded message
+func (r RequestGroupAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Prefix != nil {
+		e.string(4, *r.Prefix)
+	}
+	if r.Title != nil {
+		e.string(5, *r.Title)
+	}
+	if r.Description != nil {
+		e.string(6, *r.Description)
+	}
+	if r.TextEquivalent != nil {
+		e.string(7, *r.TextEquivalent)
+	}
+	if r.Priority != nil {
+		e.varint(8, r.Priority.protoNumber())
+	}
+	for _, v := range r.Code {
+		e.message(9, v)
+	}
+	for _, v := range r.Documentation {
+		e.message(10, v)
+	}
+	for _, v := range r.Condition {
+		e.message(11, v)
+	}
+	for _, v := range r.RelatedAction {
+		e.message(12, v)
+	}
+	if r.TimingDateTime != nil {
+		e.string(13, *r.TimingDateTime)
+	}
+	if r.TimingAge != nil {
+		e.message(14, *r.TimingAge)
+	}
+	if r.TimingPeriod != nil {
+		e.message(15, *r.TimingPeriod)
+	}
+	if r.TimingDuration != nil {
+		e.message(16, *r.TimingDuration)
+	}
+	if r.TimingRange != nil {
+		e.message(17, *r.TimingRange)
+	}
+	if r.TimingTiming != nil {
+		e.message(18, *r.TimingTiming)
+	}
+	for _, v := range r.Participant {
+		e.message(19, v)
+	}
+	if r.Type != nil {
+		e.message(20, *r.Type)
+	}
+	if r.GroupingBehavior != nil {
+		e.varint(21, r.GroupingBehavior.protoNumber())
+	}
+	if r.SelectionBehavior != nil {
+		e.varint(22, r.SelectionBehavior.protoNumber())
+	}
+	if r.RequiredBehavior != nil {
+		e.varint(23, r.RequiredBehavior.protoNumber())
+	}
+	if r.PrecheckBehavior != nil {
+		e.varint(24, r.PrecheckBehavior.protoNumber())
+	}
+	if r.CardinalityBehavior != nil {
+		e.varint(25, r.CardinalityBehavior.protoNumber())
+	}
+	if r.Resource != nil {
+		e.message(26, *r.Resource)
+	}
+	for _, v := range r.Action {
+		e.message(27, v)
+	}
+	if r.PrefixElement != nil {
+		e.message(28, *r.PrefixElement)
+	}
+	if r.TitleElement != nil {
+		e.message(29, *r.TitleElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(30, *r.DescriptionElement)
+	}
+	if r.TextEquivalentElement != nil {
+		e.message(31, *r.TextEquivalentElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(32, *r.PriorityElement)
+	}
+	if r.TimingDateTimeElement != nil {
+		e.message(33, *r.TimingDateTimeElement)
+	}
+	if r.GroupingBehaviorElement != nil {
+		e.message(34, *r.GroupingBehaviorElement)
+	}
+	if r.SelectionBehaviorElement != nil {
+		e.message(35, *r.SelectionBehaviorElement)
+	}
+	if r.RequiredBehaviorElement != nil {
+		e.message(36, *r.RequiredBehaviorElement)
+	}
+	if r.PrecheckBehaviorElement != nil {
+		e.message(37, *r.PrecheckBehaviorElement)
+	}
+	if r.CardinalityBehaviorElement != nil {
+		e.message(38, *r.CardinalityBehaviorElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RequestGroupAction from protobuf message RequestGroupAction of fhir.proto
+func (r *RequestGroupAction) UnmarshalProto(b []byte) error {
+	*r = RequestGroupAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Prefix = &v
+		case 5:
+			v := d.string()
+			r.Title = &v
+		case 6:
+			v := d.string()
+			r.Description = &v
+		case 7:
+			v := d.string()
+			r.TextEquivalent = &v
+		case 8:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 10:
+			var v RelatedArtifact
+			d.message(&v)
+			r.Documentation = append(r.Documentation, v)
+		case 11:
+			var v RequestGroupActionCondition
+			d.message(&v)
+			r.Condition = append(r.Condition, v)
+		case 12:
+			var v RequestGroupActionRelatedAction
+			d.message(&v)
+			r.RelatedAction = append(r.RelatedAction, v)
+		case 13:
+			v := d.string()
+			r.TimingDateTime = &v
+		case 14:
+			var v Age
+			d.message(&v)
+			r.TimingAge = &v
+		case 15:
+			var v Period
+			d.message(&v)
+			r.TimingPeriod = &v
+		case 16:
+			var v Duration
+			d.message(&v)
+			r.TimingDuration = &v
+		case 17:
+			var v Range
+			d.message(&v)
+			r.TimingRange = &v
+		case 18:
+			var v Timing
+			d.message(&v)
+			r.TimingTiming = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Participant = append(r.Participant, v)
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 21:
+			var v ActionGroupingBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.GroupingBehavior = &v
+		case 22:
+			var v ActionSelectionBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.SelectionBehavior = &v
+		case 23:
+			var v ActionRequiredBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.RequiredBehavior = &v
+		case 24:
+			var v ActionPrecheckBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.PrecheckBehavior = &v
+		case 25:
+			var v ActionCardinalityBehavior
+			d.enum(d.varint(), v.setProtoNumber)
+			r.CardinalityBehavior = &v
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Resource = &v
+		case 27:
+			var v RequestGroupAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		case 28:
+			var v Element
+			d.message(&v)
+			r.PrefixElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.TextEquivalentElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.TimingDateTimeElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.GroupingBehaviorElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.SelectionBehaviorElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.RequiredBehaviorElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.PrecheckBehaviorElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.CardinalityBehaviorElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RequestGroupActionCondition as JSON into a byte slice
+func (r RequestGroupActionCondition) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RequestGroupActionCondition as JSON object with the members in the order of the fields
+func (r RequestGroupActionCondition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("kind")
+	e.string(r.Kind.Code())
+	if r.KindElement != nil {
+		e.key("_kind")
+		r.KindElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		r.Expression.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RequestGroupActionCondition from JSON. Unknown members are ignored.
+func (r *RequestGroupActionCondition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RequestGroupActionCondition
+func (r *RequestGroupActionCondition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "kind":
+			d.unmarshal(&r.Kind)
+		case "_kind":
+			r.KindElement = d.element()
+		case "expression":
+			if d.null() {
+				r.Expression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.Expression = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RequestGroupActionCondition which shares no memory with the original
+func (r RequestGroupActionCondition) DeepCopy() RequestGroupActionCondition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.KindElement != nil {
+		v := r.KindElement.DeepCopy()
+		out.KindElement = &v
+	}
+	if r.Expression != nil {
+		v := r.Expression.DeepCopy()
+		out.Expression = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RequestGroupActionCondition) Equal(other RequestGroupActionCondition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.Equal(*other.KindElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !r.Expression.Equal(*other.Expression) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RequestGroupActionCondition) EqualsDeep(other RequestGroupActionCondition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.EqualsDeep(*other.KindElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !r.Expression.EqualsDeep(*other.Expression) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RequestGroupActionCondition) EqualsShallow(other RequestGroupActionCondition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RequestGroupActionCondition as FHIR XML
+func (r RequestGroupActionCondition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "kind", r.Kind, r.KindElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "expression", r.Expression); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RequestGroupActionCondition from FHIR XML
+func (r *RequestGroupActionCondition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "kind":
+				var v ActionConditionKind
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Kind = v
+				}
+				r.KindElement = element
+			case "expression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Expression = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RequestGroupActionCondition as properties to the RDF node n
+func (r RequestGroupActionCondition) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("RequestGroup.action.condition.kind", -1, r.Kind, r.KindElement, "code")
+	n.element("RequestGroup.action.condition.expression", -1, r.Expression)
+}
+
+// MarshalBSON marshals the given RequestGroupActionCondition as BSON document with the structure of its FHIR JSON
+func (r RequestGroupActionCondition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RequestGroupActionCondition from a BSON document with the structure of its FHIR JSON
+func (r *RequestGroupActionCondition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RequestGroupActionCondition as protobuf message RequestGroupActionCondition of fhir.proto
+func (r RequestGroupActionCondition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RequestGroupActionCondition to the encoded message
+func (r RequestGroupActionCondition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Kind.protoNumber())
+	if r.Expression != nil {
+		e.message(5, *r.Expression)
+	}
+	if r.KindElement != nil {
+		e.message(6, *r.KindElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RequestGroupActionCondition from protobuf message RequestGroupActionCondition of fhir.proto
+func (r *RequestGroupActionCondition) UnmarshalProto(b []byte) error {
+	*r = RequestGroupActionCondition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ActionConditionKind
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Kind = v
+		case 5:
+			var v Expression
+			d.message(&v)
+			r.Expression = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.KindElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RequestGroupActionRelatedAction as JSON into a byte slice
+func (r RequestGroupActionRelatedAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RequestGroupActionRelatedAction as JSON object with the members in the order of the fields
+func (r RequestGroupActionRelatedAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("actionId")
+	e.string(r.ActionId)
+	if r.ActionIdElement != nil {
+		e.key("_actionId")
+		r.ActionIdElement.appendJSON(e)
+	}
+	e.key("relationship")
+	e.string(r.Relationship.Code())
+	if r.RelationshipElement != nil {
+		e.key("_relationship")
+		r.RelationshipElement.appendJSON(e)
+	}
+	if r.OffsetDuration != nil {
+		e.key("offsetDuration")
+		r.OffsetDuration.appendJSON(e)
+	}
+	if r.OffsetRange != nil {
+		e.key("offsetRange")
+		r.OffsetRange.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RequestGroupActionRelatedAction from JSON. Unknown members are ignored.
+func (r *RequestGroupActionRelatedAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RequestGroupActionRelatedAction
+func (r *RequestGroupActionRelatedAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "actionId":
+			if v, ok := d.string(); ok {
+				r.ActionId = v
+			}
+		case "_actionId":
+			r.ActionIdElement = d.element()
+		case "relationship":
+			d.unmarshal(&r.Relationship)
+		case "_relationship":
+			r.RelationshipElement = d.element()
+		case "offsetDuration":
+			if d.null() {
+				r.OffsetDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.OffsetDuration = &v
+			}
+		case "offsetRange":
+			if d.null() {
+				r.OffsetRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.OffsetRange = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RequestGroupActionRelatedAction which shares no memory with the original
+func (r RequestGroupActionRelatedAction) DeepCopy() RequestGroupActionRelatedAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ActionIdElement != nil {
+		v := r.ActionIdElement.DeepCopy()
+		out.ActionIdElement = &v
+	}
+	if r.RelationshipElement != nil {
+		v := r.RelationshipElement.DeepCopy()
+		out.RelationshipElement = &v
+	}
+	if r.OffsetDuration != nil {
+		v := r.OffsetDuration.DeepCopy()
+		out.OffsetDuration = &v
+	}
+	if r.OffsetRange != nil {
+		v := r.OffsetRange.DeepCopy()
+		out.OffsetRange = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RequestGroupActionRelatedAction) Equal(other RequestGroupActionRelatedAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.ActionId != other.ActionId {
+		return false
+	}
+	if (r.ActionIdElement == nil) != (other.ActionIdElement == nil) || r.ActionIdElement != nil && !r.ActionIdElement.Equal(*other.ActionIdElement) {
+		return false
+	}
+	if r.Relationship != other.Relationship {
+		return false
+	}
+	if (r.RelationshipElement == nil) != (other.RelationshipElement == nil) || r.RelationshipElement != nil && !r.RelationshipElement.Equal(*other.RelationshipElement) {
+		return false
+	}
+	if (r.OffsetDuration == nil) != (other.OffsetDuration == nil) || r.OffsetDuration != nil && !r.OffsetDuration.Equal(*other.OffsetDuration) {
+		return false
+	}
+	if (r.OffsetRange == nil) != (other.OffsetRange == nil) || r.OffsetRange != nil && !r.OffsetRange.Equal(*other.OffsetRange) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RequestGroupActionRelatedAction) EqualsDeep(other RequestGroupActionRelatedAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.ActionId, other.ActionId) {
+		return false
+	}
+	if (r.ActionIdElement == nil) != (other.ActionIdElement == nil) || r.ActionIdElement != nil && !r.ActionIdElement.EqualsDeep(*other.ActionIdElement) {
+		return false
+	}
+	if r.Relationship != other.Relationship {
+		return false
+	}
+	if (r.RelationshipElement == nil) != (other.RelationshipElement == nil) || r.RelationshipElement != nil && !r.RelationshipElement.EqualsDeep(*other.RelationshipElement) {
+		return false
+	}
+	if (r.OffsetDuration == nil) != (other.OffsetDuration == nil) || r.OffsetDuration != nil && !r.OffsetDuration.EqualsDeep(*other.OffsetDuration) {
+		return false
+	}
+	if (r.OffsetRange == nil) != (other.OffsetRange == nil) || r.OffsetRange != nil && !r.OffsetRange.EqualsDeep(*other.OffsetRange) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RequestGroupActionRelatedAction) EqualsShallow(other RequestGroupActionRelatedAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.ActionId, other.ActionId) {
+		return false
+	}
+	if r.Relationship != other.Relationship {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RequestGroupActionRelatedAction as FHIR XML
+func (r RequestGroupActionRelatedAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "actionId", r.ActionId, r.ActionIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "relationship", r.Relationship, r.RelationshipElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "offsetDuration", r.OffsetDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "offsetRange", r.OffsetRange); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RequestGroupActionRelatedAction from FHIR XML
+func (r *RequestGroupActionRelatedAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "actionId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ActionId = v
+				}
+				r.ActionIdElement = element
+			case "relationship":
+				var v ActionRelationshipType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Relationship = v
+				}
+				r.RelationshipElement = element
+			case "offsetDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OffsetDuration = &v
+			case "offsetRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OffsetRange = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RequestGroupActionRelatedAction as properties to the RDF node n
+func (r RequestGroupActionRelatedAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("RequestGroup.action.relatedAction.actionId", -1, r.ActionId, r.ActionIdElement, "string")
+	n.primitive("RequestGroup.action.relatedAction.relationship", -1, r.Relationship, r.RelationshipElement, "code")
+	n.element("RequestGroup.action.relatedAction.offsetDuration", -1, r.OffsetDuration)
+	n.element("RequestGroup.action.relatedAction.offsetRange", -1, r.OffsetRange)
+}
+
+// MarshalBSON marshals the given RequestGroupActionRelatedAction as BSON document with the structure of its FHIR JSON
+func (r RequestGroupActionRelatedAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RequestGroupActionRelatedAction from a BSON document with the structure of its FHIR JSON
+func (r *RequestGroupActionRelatedAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RequestGroupActionRelatedAction as protobuf message RequestGroupActionRelatedAction of fhir.proto
+func (r RequestGroupActionRelatedAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RequestGroupActionRelatedAction to the encoded message
+func (r RequestGroupActionRelatedAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ActionId != "" {
+		e.string(4, r.ActionId)
+	}
+	e.varint(5, r.Relationship.protoNumber())
+	if r.OffsetDuration != nil {
+		e.message(6, *r.OffsetDuration)
+	}
+	if r.OffsetRange != nil {
+		e.message(7, *r.OffsetRange)
+	}
+	if r.ActionIdElement != nil {
+		e.message(8, *r.ActionIdElement)
+	}
+	if r.RelationshipElement != nil {
+		e.message(9, *r.RelationshipElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RequestGroupActionRelatedAction from protobuf message RequestGroupActionRelatedAction of fhir.proto
+func (r *RequestGroupActionRelatedAction) UnmarshalProto(b []byte) error {
+	*r = RequestGroupActionRelatedAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.ActionId = d.string()
+		case 5:
+			var v ActionRelationshipType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Relationship = v
+		case 6:
+			var v Duration
+			d.message(&v)
+			r.OffsetDuration = &v
+		case 7:
+			var v Range
+			d.message(&v)
+			r.OffsetRange = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ActionIdElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.RelationshipElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalRequestGroup unmarshals a RequestGroup.
 func UnmarshalRequestGroup(b []byte) (RequestGroup, error) {
 	var requestGroup RequestGroup
-	if err := json.Unmarshal(b, &requestGroup); err != nil {
+	if err := requestGroup.UnmarshalJSON(b); err != nil {
 		return requestGroup, err
 	}
 	return requestGroup, nil
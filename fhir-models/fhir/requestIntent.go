@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code RequestIntent) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *RequestIntent) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *RequestIntent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "proposal":
 		*code = RequestIntentProposal
@@ -67,6 +71,12 @@ func (code *RequestIntent) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code RequestIntent) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *RequestIntent) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code RequestIntent) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code RequestIntent) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code RequestIntent) protoNumber() uint64 {
+	switch code {
+	case RequestIntentProposal:
+		return 1
+	case RequestIntentPlan:
+		return 2
+	case RequestIntentDirective:
+		return 3
+	case RequestIntentOrder:
+		return 4
+	case RequestIntentOriginalOrder:
+		return 5
+	case RequestIntentReflexOrder:
+		return 6
+	case RequestIntentFillerOrder:
+		return 7
+	case RequestIntentInstanceOrder:
+		return 8
+	case RequestIntentOption:
+		return 9
+	}
+	return 0
+}
+func (code *RequestIntent) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = RequestIntentProposal
+	case 2:
+		*code = RequestIntentPlan
+	case 3:
+		*code = RequestIntentDirective
+	case 4:
+		*code = RequestIntentOrder
+	case 5:
+		*code = RequestIntentOriginalOrder
+	case 6:
+		*code = RequestIntentReflexOrder
+	case 7:
+		*code = RequestIntentFillerOrder
+	case 8:
+		*code = RequestIntentInstanceOrder
+	case 9:
+		*code = RequestIntentOption
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code RequestPriority) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *RequestPriority) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *RequestPriority) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "routine":
 		*code = RequestPriorityRoutine
@@ -52,6 +56,12 @@ func (code *RequestPriority) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code RequestPriority) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *RequestPriority) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code RequestPriority) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code RequestPriority) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code RequestPriority) protoNumber() uint64 {
+	switch code {
+	case RequestPriorityRoutine:
+		return 1
+	case RequestPriorityUrgent:
+		return 2
+	case RequestPriorityAsap:
+		return 3
+	case RequestPriorityStat:
+		return 4
+	}
+	return 0
+}
+func (code *RequestPriority) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = RequestPriorityRoutine
+	case 2:
+		*code = RequestPriorityUrgent
+	case 3:
+		*code = RequestPriorityAsap
+	case 4:
+		*code = RequestPriorityStat
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -47,8 +46,13 @@ const (
 func (code RequestResourceType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *RequestResourceType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *RequestResourceType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "Appointment":
 		*code = RequestResourceTypeAppointment
@@ -85,6 +89,12 @@ func (code *RequestResourceType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code RequestResourceType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *RequestResourceType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code RequestResourceType) String() string {
 	return code.Code()
 }
@@ -193,3 +203,75 @@ func (code RequestResourceType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code RequestResourceType) protoNumber() uint64 {
+	switch code {
+	case RequestResourceTypeAppointment:
+		return 1
+	case RequestResourceTypeAppointmentResponse:
+		return 2
+	case RequestResourceTypeCarePlan:
+		return 3
+	case RequestResourceTypeClaim:
+		return 4
+	case RequestResourceTypeCommunicationRequest:
+		return 5
+	case RequestResourceTypeContract:
+		return 6
+	case RequestResourceTypeDeviceRequest:
+		return 7
+	case RequestResourceTypeEnrollmentRequest:
+		return 8
+	case RequestResourceTypeImmunizationRecommendation:
+		return 9
+	case RequestResourceTypeMedicationRequest:
+		return 10
+	case RequestResourceTypeNutritionOrder:
+		return 11
+	case RequestResourceTypeServiceRequest:
+		return 12
+	case RequestResourceTypeSupplyRequest:
+		return 13
+	case RequestResourceTypeTask:
+		return 14
+	case RequestResourceTypeVisionPrescription:
+		return 15
+	}
+	return 0
+}
+func (code *RequestResourceType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = RequestResourceTypeAppointment
+	case 2:
+		*code = RequestResourceTypeAppointmentResponse
+	case 3:
+		*code = RequestResourceTypeCarePlan
+	case 4:
+		*code = RequestResourceTypeClaim
+	case 5:
+		*code = RequestResourceTypeCommunicationRequest
+	case 6:
+		*code = RequestResourceTypeContract
+	case 7:
+		*code = RequestResourceTypeDeviceRequest
+	case 8:
+		*code = RequestResourceTypeEnrollmentRequest
+	case 9:
+		*code = RequestResourceTypeImmunizationRecommendation
+	case 10:
+		*code = RequestResourceTypeMedicationRequest
+	case 11:
+		*code = RequestResourceTypeNutritionOrder
+	case 12:
+		*code = RequestResourceTypeServiceRequest
+	case 13:
+		*code = RequestResourceTypeSupplyRequest
+	case 14:
+		*code = RequestResourceTypeTask
+	case 15:
+		*code = RequestResourceTypeVisionPrescription
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code RequestStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *RequestStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *RequestStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "draft":
 		*code = RequestStatusDraft
@@ -61,6 +65,12 @@ func (code *RequestStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code RequestStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *RequestStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code RequestStatus) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code RequestStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code RequestStatus) protoNumber() uint64 {
+	switch code {
+	case RequestStatusDraft:
+		return 1
+	case RequestStatusActive:
+		return 2
+	case RequestStatusOnHold:
+		return 3
+	case RequestStatusRevoked:
+		return 4
+	case RequestStatusCompleted:
+		return 5
+	case RequestStatusEnteredInError:
+		return 6
+	case RequestStatusUnknown:
+		return 7
+	}
+	return 0
+}
+func (code *RequestStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = RequestStatusDraft
+	case 2:
+		*code = RequestStatusActive
+	case 3:
+		*code = RequestStatusOnHold
+	case 4:
+		*code = RequestStatusRevoked
+	case 5:
+		*code = RequestStatusCompleted
+	case 6:
+		*code = RequestStatusEnteredInError
+	case 7:
+		*code = RequestStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
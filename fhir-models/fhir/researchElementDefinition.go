@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,35 +25,56 @@ import "encoding/json"
 // ResearchElementDefinition is documented here http://hl7.org/fhir/StructureDefinition/ResearchElementDefinition
 type ResearchElementDefinition struct {
 	Id                     *string                                   `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement              *Element                                  `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                   *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules          *string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement   *Element                                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language               *string                                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement        *Element                                  `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                   *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained              []json.RawMessage                         `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension              []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension      []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Url                    *string                                   `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement             *Element                                  `bson:"_url,omitempty" json:"_url,omitempty"`
 	Identifier             []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Version                *string                                   `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement         *Element                                  `bson:"_version,omitempty" json:"_version,omitempty"`
 	Name                   *string                                   `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement            *Element                                  `bson:"_name,omitempty" json:"_name,omitempty"`
 	Title                  *string                                   `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement           *Element                                  `bson:"_title,omitempty" json:"_title,omitempty"`
 	ShortTitle             *string                                   `bson:"shortTitle,omitempty" json:"shortTitle,omitempty"`
+	ShortTitleElement      *Element                                  `bson:"_shortTitle,omitempty" json:"_shortTitle,omitempty"`
 	Subtitle               *string                                   `bson:"subtitle,omitempty" json:"subtitle,omitempty"`
+	SubtitleElement        *Element                                  `bson:"_subtitle,omitempty" json:"_subtitle,omitempty"`
 	Status                 PublicationStatus                         `bson:"status" json:"status"`
+	StatusElement          *Element                                  `bson:"_status,omitempty" json:"_status,omitempty"`
 	Experimental           *bool                                     `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement    *Element                                  `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
 	SubjectCodeableConcept *CodeableConcept                          `bson:"subjectCodeableConcept,omitempty" json:"subjectCodeableConcept,omitempty"`
 	SubjectReference       *Reference                                `bson:"subjectReference,omitempty" json:"subjectReference,omitempty"`
 	Date                   *string                                   `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement            *Element                                  `bson:"_date,omitempty" json:"_date,omitempty"`
 	Publisher              *string                                   `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement       *Element                                  `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
 	Contact                []ContactDetail                           `bson:"contact,omitempty" json:"contact,omitempty"`
 	Description            *string                                   `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement     *Element                                  `bson:"_description,omitempty" json:"_description,omitempty"`
 	Comment                []string                                  `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement         []*Element                                `bson:"_comment,omitempty" json:"_comment,omitempty"`
 	UseContext             []UsageContext                            `bson:"useContext,omitempty" json:"useContext,omitempty"`
 	Jurisdiction           []CodeableConcept                         `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
 	Purpose                *string                                   `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement         *Element                                  `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
 	Usage                  *string                                   `bson:"usage,omitempty" json:"usage,omitempty"`
+	UsageElement           *Element                                  `bson:"_usage,omitempty" json:"_usage,omitempty"`
 	Copyright              *string                                   `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement       *Element                                  `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
 	ApprovalDate           *string                                   `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement    *Element                                  `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
 	LastReviewDate         *string                                   `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement  *Element                                  `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
 	EffectivePeriod        *Period                                   `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
 	Topic                  []CodeableConcept                         `bson:"topic,omitempty" json:"topic,omitempty"`
 	Author                 []ContactDetail                           `bson:"author,omitempty" json:"author,omitempty"`
@@ -59,53 +83,4313 @@ type ResearchElementDefinition struct {
 	Endorser               []ContactDetail                           `bson:"endorser,omitempty" json:"endorser,omitempty"`
 	RelatedArtifact        []RelatedArtifact                         `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
 	Library                []string                                  `bson:"library,omitempty" json:"library,omitempty"`
+	LibraryElement         []*Element                                `bson:"_library,omitempty" json:"_library,omitempty"`
 	Type                   ResearchElementType                       `bson:"type" json:"type"`
+	TypeElement            *Element                                  `bson:"_type,omitempty" json:"_type,omitempty"`
 	VariableType           *EvidenceVariableType                     `bson:"variableType,omitempty" json:"variableType,omitempty"`
+	VariableTypeElement    *Element                                  `bson:"_variableType,omitempty" json:"_variableType,omitempty"`
 	Characteristic         []ResearchElementDefinitionCharacteristic `bson:"characteristic" json:"characteristic"`
 }
+
+// ResearchElementDefinitionSubject is implemented by the types allowed for ResearchElementDefinition.subject[x]
+type ResearchElementDefinitionSubject interface {
+	isResearchElementDefinitionSubject()
+}
+
+func (CodeableConcept) isResearchElementDefinitionSubject() {}
+func (Reference) isResearchElementDefinitionSubject()       {}
+
+// Subject returns the value of ResearchElementDefinition.subject[x] together with its FHIR type code or nil if no value is set
+func (r ResearchElementDefinition) Subject() (ResearchElementDefinitionSubject, string) {
+	if r.SubjectCodeableConcept != nil {
+		return *r.SubjectCodeableConcept, "CodeableConcept"
+	}
+	if r.SubjectReference != nil {
+		return *r.SubjectReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetSubject sets the value of ResearchElementDefinition.subject[x], given as value or pointer, and clears all other types
+func (r *ResearchElementDefinition) SetSubject(value ResearchElementDefinitionSubject) {
+	r.SubjectCodeableConcept = nil
+	r.SubjectReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.SubjectCodeableConcept = &v
+	case *CodeableConcept:
+		r.SubjectCodeableConcept = v
+	case Reference:
+		r.SubjectReference = &v
+	case *Reference:
+		r.SubjectReference = v
+	}
+}
+
 type ResearchElementDefinitionCharacteristic struct {
-	Id                                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension                 []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	DefinitionCodeableConcept         CodeableConcept  `bson:"definitionCodeableConcept" json:"definitionCodeableConcept"`
-	DefinitionCanonical               string           `bson:"definitionCanonical" json:"definitionCanonical"`
-	DefinitionExpression              Expression       `bson:"definitionExpression" json:"definitionExpression"`
-	DefinitionDataRequirement         DataRequirement  `bson:"definitionDataRequirement" json:"definitionDataRequirement"`
-	UsageContext                      []UsageContext   `bson:"usageContext,omitempty" json:"usageContext,omitempty"`
-	Exclude                           *bool            `bson:"exclude,omitempty" json:"exclude,omitempty"`
-	UnitOfMeasure                     *CodeableConcept `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
-	StudyEffectiveDescription         *string          `bson:"studyEffectiveDescription,omitempty" json:"studyEffectiveDescription,omitempty"`
-	StudyEffectiveDateTime            *string          `bson:"studyEffectiveDateTime,omitempty" json:"studyEffectiveDateTime,omitempty"`
-	StudyEffectivePeriod              *Period          `bson:"studyEffectivePeriod,omitempty" json:"studyEffectivePeriod,omitempty"`
-	StudyEffectiveDuration            *Duration        `bson:"studyEffectiveDuration,omitempty" json:"studyEffectiveDuration,omitempty"`
-	StudyEffectiveTiming              *Timing          `bson:"studyEffectiveTiming,omitempty" json:"studyEffectiveTiming,omitempty"`
-	StudyEffectiveTimeFromStart       *Duration        `bson:"studyEffectiveTimeFromStart,omitempty" json:"studyEffectiveTimeFromStart,omitempty"`
-	StudyEffectiveGroupMeasure        *GroupMeasure    `bson:"studyEffectiveGroupMeasure,omitempty" json:"studyEffectiveGroupMeasure,omitempty"`
-	ParticipantEffectiveDescription   *string          `bson:"participantEffectiveDescription,omitempty" json:"participantEffectiveDescription,omitempty"`
-	ParticipantEffectiveDateTime      *string          `bson:"participantEffectiveDateTime,omitempty" json:"participantEffectiveDateTime,omitempty"`
-	ParticipantEffectivePeriod        *Period          `bson:"participantEffectivePeriod,omitempty" json:"participantEffectivePeriod,omitempty"`
-	ParticipantEffectiveDuration      *Duration        `bson:"participantEffectiveDuration,omitempty" json:"participantEffectiveDuration,omitempty"`
-	ParticipantEffectiveTiming        *Timing          `bson:"participantEffectiveTiming,omitempty" json:"participantEffectiveTiming,omitempty"`
-	ParticipantEffectiveTimeFromStart *Duration        `bson:"participantEffectiveTimeFromStart,omitempty" json:"participantEffectiveTimeFromStart,omitempty"`
-	ParticipantEffectiveGroupMeasure  *GroupMeasure    `bson:"participantEffectiveGroupMeasure,omitempty" json:"participantEffectiveGroupMeasure,omitempty"`
-}
-type OtherResearchElementDefinition ResearchElementDefinition
+	Id                                      *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                               []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                       []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	DefinitionCodeableConcept               *CodeableConcept `bson:"definitionCodeableConcept,omitempty" json:"definitionCodeableConcept,omitempty"`
+	DefinitionCanonical                     *string          `bson:"definitionCanonical,omitempty" json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement              *Element         `bson:"_definitionCanonical,omitempty" json:"_definitionCanonical,omitempty"`
+	DefinitionExpression                    *Expression      `bson:"definitionExpression,omitempty" json:"definitionExpression,omitempty"`
+	DefinitionDataRequirement               *DataRequirement `bson:"definitionDataRequirement,omitempty" json:"definitionDataRequirement,omitempty"`
+	UsageContext                            []UsageContext   `bson:"usageContext,omitempty" json:"usageContext,omitempty"`
+	Exclude                                 *bool            `bson:"exclude,omitempty" json:"exclude,omitempty"`
+	ExcludeElement                          *Element         `bson:"_exclude,omitempty" json:"_exclude,omitempty"`
+	UnitOfMeasure                           *CodeableConcept `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
+	StudyEffectiveDescription               *string          `bson:"studyEffectiveDescription,omitempty" json:"studyEffectiveDescription,omitempty"`
+	StudyEffectiveDescriptionElement        *Element         `bson:"_studyEffectiveDescription,omitempty" json:"_studyEffectiveDescription,omitempty"`
+	StudyEffectiveDateTime                  *string          `bson:"studyEffectiveDateTime,omitempty" json:"studyEffectiveDateTime,omitempty"`
+	StudyEffectiveDateTimeElement           *Element         `bson:"_studyEffectiveDateTime,omitempty" json:"_studyEffectiveDateTime,omitempty"`
+	StudyEffectivePeriod                    *Period          `bson:"studyEffectivePeriod,omitempty" json:"studyEffectivePeriod,omitempty"`
+	StudyEffectiveDuration                  *Duration        `bson:"studyEffectiveDuration,omitempty" json:"studyEffectiveDuration,omitempty"`
+	StudyEffectiveTiming                    *Timing          `bson:"studyEffectiveTiming,omitempty" json:"studyEffectiveTiming,omitempty"`
+	StudyEffectiveTimeFromStart             *Duration        `bson:"studyEffectiveTimeFromStart,omitempty" json:"studyEffectiveTimeFromStart,omitempty"`
+	StudyEffectiveGroupMeasure              *GroupMeasure    `bson:"studyEffectiveGroupMeasure,omitempty" json:"studyEffectiveGroupMeasure,omitempty"`
+	StudyEffectiveGroupMeasureElement       *Element         `bson:"_studyEffectiveGroupMeasure,omitempty" json:"_studyEffectiveGroupMeasure,omitempty"`
+	ParticipantEffectiveDescription         *string          `bson:"participantEffectiveDescription,omitempty" json:"participantEffectiveDescription,omitempty"`
+	ParticipantEffectiveDescriptionElement  *Element         `bson:"_participantEffectiveDescription,omitempty" json:"_participantEffectiveDescription,omitempty"`
+	ParticipantEffectiveDateTime            *string          `bson:"participantEffectiveDateTime,omitempty" json:"participantEffectiveDateTime,omitempty"`
+	ParticipantEffectiveDateTimeElement     *Element         `bson:"_participantEffectiveDateTime,omitempty" json:"_participantEffectiveDateTime,omitempty"`
+	ParticipantEffectivePeriod              *Period          `bson:"participantEffectivePeriod,omitempty" json:"participantEffectivePeriod,omitempty"`
+	ParticipantEffectiveDuration            *Duration        `bson:"participantEffectiveDuration,omitempty" json:"participantEffectiveDuration,omitempty"`
+	ParticipantEffectiveTiming              *Timing          `bson:"participantEffectiveTiming,omitempty" json:"participantEffectiveTiming,omitempty"`
+	ParticipantEffectiveTimeFromStart       *Duration        `bson:"participantEffectiveTimeFromStart,omitempty" json:"participantEffectiveTimeFromStart,omitempty"`
+	ParticipantEffectiveGroupMeasure        *GroupMeasure    `bson:"participantEffectiveGroupMeasure,omitempty" json:"participantEffectiveGroupMeasure,omitempty"`
+	ParticipantEffectiveGroupMeasureElement *Element         `bson:"_participantEffectiveGroupMeasure,omitempty" json:"_participantEffectiveGroupMeasure,omitempty"`
+}
+
+// ResearchElementDefinitionCharacteristicDefinition is implemented by the types allowed for ResearchElementDefinition.characteristic.definition[x]
+type ResearchElementDefinitionCharacteristicDefinition interface {
+	isResearchElementDefinitionCharacteristicDefinition()
+}
+
+func (CodeableConcept) isResearchElementDefinitionCharacteristicDefinition() {}
+func (Canonical) isResearchElementDefinitionCharacteristicDefinition()       {}
+func (Expression) isResearchElementDefinitionCharacteristicDefinition()      {}
+func (DataRequirement) isResearchElementDefinitionCharacteristicDefinition() {}
+
+// Definition returns the value of ResearchElementDefinition.characteristic.definition[x] together with its FHIR type code or nil if no value is set
+func (r ResearchElementDefinitionCharacteristic) Definition() (ResearchElementDefinitionCharacteristicDefinition, string) {
+	if r.DefinitionCodeableConcept != nil {
+		return *r.DefinitionCodeableConcept, "CodeableConcept"
+	}
+	if r.DefinitionCanonical != nil {
+		return Canonical(*r.DefinitionCanonical), "canonical"
+	}
+	if r.DefinitionExpression != nil {
+		return *r.DefinitionExpression, "Expression"
+	}
+	if r.DefinitionDataRequirement != nil {
+		return *r.DefinitionDataRequirement, "DataRequirement"
+	}
+	return nil, ""
+}
+
+// SetDefinition sets the value of ResearchElementDefinition.characteristic.definition[x], given as value or pointer, and clears all other types
+func (r *ResearchElementDefinitionCharacteristic) SetDefinition(value ResearchElementDefinitionCharacteristicDefinition) {
+	r.DefinitionCodeableConcept = nil
+	r.DefinitionCanonical = nil
+	r.DefinitionExpression = nil
+	r.DefinitionDataRequirement = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.DefinitionCodeableConcept = &v
+	case *CodeableConcept:
+		r.DefinitionCodeableConcept = v
+	case Canonical:
+		primitive := string(v)
+		r.DefinitionCanonical = &primitive
+	case *Canonical:
+		r.DefinitionCanonical = (*string)(v)
+	case Expression:
+		r.DefinitionExpression = &v
+	case *Expression:
+		r.DefinitionExpression = v
+	case DataRequirement:
+		r.DefinitionDataRequirement = &v
+	case *DataRequirement:
+		r.DefinitionDataRequirement = v
+	}
+}
+
+// ResearchElementDefinitionCharacteristicStudyEffective is implemented by the types allowed for ResearchElementDefinition.characteristic.studyEffective[x]
+type ResearchElementDefinitionCharacteristicStudyEffective interface {
+	isResearchElementDefinitionCharacteristicStudyEffective()
+}
+
+func (DateTime) isResearchElementDefinitionCharacteristicStudyEffective() {}
+func (Period) isResearchElementDefinitionCharacteristicStudyEffective()   {}
+func (Duration) isResearchElementDefinitionCharacteristicStudyEffective() {}
+func (Timing) isResearchElementDefinitionCharacteristicStudyEffective()   {}
+
+// StudyEffective returns the value of ResearchElementDefinition.characteristic.studyEffective[x] together with its FHIR type code or nil if no value is set
+func (r ResearchElementDefinitionCharacteristic) StudyEffective() (ResearchElementDefinitionCharacteristicStudyEffective, string) {
+	if r.StudyEffectiveDateTime != nil {
+		return DateTime(*r.StudyEffectiveDateTime), "dateTime"
+	}
+	if r.StudyEffectivePeriod != nil {
+		return *r.StudyEffectivePeriod, "Period"
+	}
+	if r.StudyEffectiveDuration != nil {
+		return *r.StudyEffectiveDuration, "Duration"
+	}
+	if r.StudyEffectiveTiming != nil {
+		return *r.StudyEffectiveTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetStudyEffective sets the value of ResearchElementDefinition.characteristic.studyEffective[x], given as value or pointer, and clears all other types
+func (r *ResearchElementDefinitionCharacteristic) SetStudyEffective(value ResearchElementDefinitionCharacteristicStudyEffective) {
+	r.StudyEffectiveDateTime = nil
+	r.StudyEffectivePeriod = nil
+	r.StudyEffectiveDuration = nil
+	r.StudyEffectiveTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.StudyEffectiveDateTime = &primitive
+	case *DateTime:
+		r.StudyEffectiveDateTime = (*string)(v)
+	case Period:
+		r.StudyEffectivePeriod = &v
+	case *Period:
+		r.StudyEffectivePeriod = v
+	case Duration:
+		r.StudyEffectiveDuration = &v
+	case *Duration:
+		r.StudyEffectiveDuration = v
+	case Timing:
+		r.StudyEffectiveTiming = &v
+	case *Timing:
+		r.StudyEffectiveTiming = v
+	}
+}
+
+// ResearchElementDefinitionCharacteristicParticipantEffective is implemented by the types allowed for ResearchElementDefinition.characteristic.participantEffective[x]
+type ResearchElementDefinitionCharacteristicParticipantEffective interface {
+	isResearchElementDefinitionCharacteristicParticipantEffective()
+}
+
+func (DateTime) isResearchElementDefinitionCharacteristicParticipantEffective() {}
+func (Period) isResearchElementDefinitionCharacteristicParticipantEffective()   {}
+func (Duration) isResearchElementDefinitionCharacteristicParticipantEffective() {}
+func (Timing) isResearchElementDefinitionCharacteristicParticipantEffective()   {}
+
+// ParticipantEffective returns the value of ResearchElementDefinition.characteristic.participantEffective[x] together with its FHIR type code or nil if no value is set
+func (r ResearchElementDefinitionCharacteristic) ParticipantEffective() (ResearchElementDefinitionCharacteristicParticipantEffective, string) {
+	if r.ParticipantEffectiveDateTime != nil {
+		return DateTime(*r.ParticipantEffectiveDateTime), "dateTime"
+	}
+	if r.ParticipantEffectivePeriod != nil {
+		return *r.ParticipantEffectivePeriod, "Period"
+	}
+	if r.ParticipantEffectiveDuration != nil {
+		return *r.ParticipantEffectiveDuration, "Duration"
+	}
+	if r.ParticipantEffectiveTiming != nil {
+		return *r.ParticipantEffectiveTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetParticipantEffective sets the value of ResearchElementDefinition.characteristic.participantEffective[x], given as value or pointer, and clears all other types
+func (r *ResearchElementDefinitionCharacteristic) SetParticipantEffective(value ResearchElementDefinitionCharacteristicParticipantEffective) {
+	r.ParticipantEffectiveDateTime = nil
+	r.ParticipantEffectivePeriod = nil
+	r.ParticipantEffectiveDuration = nil
+	r.ParticipantEffectiveTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.ParticipantEffectiveDateTime = &primitive
+	case *DateTime:
+		r.ParticipantEffectiveDateTime = (*string)(v)
+	case Period:
+		r.ParticipantEffectivePeriod = &v
+	case *Period:
+		r.ParticipantEffectivePeriod = v
+	case Duration:
+		r.ParticipantEffectiveDuration = &v
+	case *Duration:
+		r.ParticipantEffectiveDuration = v
+	case Timing:
+		r.ParticipantEffectiveTiming = &v
+	case *Timing:
+		r.ParticipantEffectiveTiming = v
+	}
+}
 
 // MarshalJSON marshals the given ResearchElementDefinition as JSON into a byte slice
 func (r ResearchElementDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherResearchElementDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherResearchElementDefinition: OtherResearchElementDefinition(r),
-		ResourceType:                   "ResearchElementDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ResearchElementDefinition as JSON object with the members in the order of the fields
+func (r ResearchElementDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ResearchElementDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if r.ShortTitle != nil {
+		e.key("shortTitle")
+		e.string(*r.ShortTitle)
+	}
+	if r.ShortTitleElement != nil {
+		e.key("_shortTitle")
+		r.ShortTitleElement.appendJSON(e)
+	}
+	if r.Subtitle != nil {
+		e.key("subtitle")
+		e.string(*r.Subtitle)
+	}
+	if r.SubtitleElement != nil {
+		e.key("_subtitle")
+		r.SubtitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.key("subjectCodeableConcept")
+		r.SubjectCodeableConcept.appendJSON(e)
+	}
+	if r.SubjectReference != nil {
+		e.key("subjectReference")
+		r.SubjectReference.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Comment) > 0 {
+		e.key("comment")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Comment {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CommentElement) > 0 {
+		e.key("_comment")
+		e.elements(r.CommentElement, len(r.Comment))
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Usage != nil {
+		e.key("usage")
+		e.string(*r.Usage)
+	}
+	if r.UsageElement != nil {
+		e.key("_usage")
+		r.UsageElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.ApprovalDate != nil {
+		e.key("approvalDate")
+		e.string(*r.ApprovalDate)
+	}
+	if r.ApprovalDateElement != nil {
+		e.key("_approvalDate")
+		r.ApprovalDateElement.appendJSON(e)
+	}
+	if r.LastReviewDate != nil {
+		e.key("lastReviewDate")
+		e.string(*r.LastReviewDate)
+	}
+	if r.LastReviewDateElement != nil {
+		e.key("_lastReviewDate")
+		r.LastReviewDateElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if len(r.Topic) > 0 {
+		e.key("topic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Topic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Editor) > 0 {
+		e.key("editor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Editor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Reviewer) > 0 {
+		e.key("reviewer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reviewer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endorser) > 0 {
+		e.key("endorser")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endorser {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedArtifact) > 0 {
+		e.key("relatedArtifact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedArtifact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Library) > 0 {
+		e.key("library")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Library {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LibraryElement) > 0 {
+		e.key("_library")
+		e.elements(r.LibraryElement, len(r.Library))
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.VariableType != nil {
+		e.key("variableType")
+		e.string(r.VariableType.Code())
+	}
+	if r.VariableTypeElement != nil {
+		e.key("_variableType")
+		r.VariableTypeElement.appendJSON(e)
+	}
+	e.key("characteristic")
+	if r.Characteristic == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Characteristic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ResearchElementDefinition from JSON. Unknown members are ignored.
+func (r *ResearchElementDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ResearchElementDefinition
+func (r *ResearchElementDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "shortTitle":
+			if v, ok := d.string(); ok {
+				r.ShortTitle = &v
+			} else {
+				r.ShortTitle = nil
+			}
+		case "_shortTitle":
+			r.ShortTitleElement = d.element()
+		case "subtitle":
+			if v, ok := d.string(); ok {
+				r.Subtitle = &v
+			} else {
+				r.Subtitle = nil
+			}
+		case "_subtitle":
+			r.SubtitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "subjectCodeableConcept":
+			if d.null() {
+				r.SubjectCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubjectCodeableConcept = &v
+			}
+		case "subjectReference":
+			if d.null() {
+				r.SubjectReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubjectReference = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "comment":
+			a := d.array()
+			r.Comment = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Comment = append(r.Comment, v)
+			}
+			if r.Comment == nil && !a.isNull {
+				r.Comment = []string{}
+			}
+		case "_comment":
+			a := d.array()
+			r.CommentElement = nil
+			for a.next() {
+				r.CommentElement = append(r.CommentElement, d.element())
+			}
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "usage":
+			if v, ok := d.string(); ok {
+				r.Usage = &v
+			} else {
+				r.Usage = nil
+			}
+		case "_usage":
+			r.UsageElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "approvalDate":
+			if v, ok := d.string(); ok {
+				r.ApprovalDate = &v
+			} else {
+				r.ApprovalDate = nil
+			}
+		case "_approvalDate":
+			r.ApprovalDateElement = d.element()
+		case "lastReviewDate":
+			if v, ok := d.string(); ok {
+				r.LastReviewDate = &v
+			} else {
+				r.LastReviewDate = nil
+			}
+		case "_lastReviewDate":
+			r.LastReviewDateElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "topic":
+			a := d.array()
+			r.Topic = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Topic = append(r.Topic, v)
+			}
+			if r.Topic == nil && !a.isNull {
+				r.Topic = []CodeableConcept{}
+			}
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []ContactDetail{}
+			}
+		case "editor":
+			a := d.array()
+			r.Editor = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Editor = append(r.Editor, v)
+			}
+			if r.Editor == nil && !a.isNull {
+				r.Editor = []ContactDetail{}
+			}
+		case "reviewer":
+			a := d.array()
+			r.Reviewer = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Reviewer = append(r.Reviewer, v)
+			}
+			if r.Reviewer == nil && !a.isNull {
+				r.Reviewer = []ContactDetail{}
+			}
+		case "endorser":
+			a := d.array()
+			r.Endorser = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Endorser = append(r.Endorser, v)
+			}
+			if r.Endorser == nil && !a.isNull {
+				r.Endorser = []ContactDetail{}
+			}
+		case "relatedArtifact":
+			a := d.array()
+			r.RelatedArtifact = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			}
+			if r.RelatedArtifact == nil && !a.isNull {
+				r.RelatedArtifact = []RelatedArtifact{}
+			}
+		case "library":
+			a := d.array()
+			r.Library = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Library = append(r.Library, v)
+			}
+			if r.Library == nil && !a.isNull {
+				r.Library = []string{}
+			}
+		case "_library":
+			a := d.array()
+			r.LibraryElement = nil
+			for a.next() {
+				r.LibraryElement = append(r.LibraryElement, d.element())
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "variableType":
+			if d.null() {
+				r.VariableType = nil
+			} else {
+				var v EvidenceVariableType
+				d.unmarshal(&v)
+				r.VariableType = &v
+			}
+		case "_variableType":
+			r.VariableTypeElement = d.element()
+		case "characteristic":
+			a := d.array()
+			r.Characteristic = nil
+			for a.next() {
+				var v ResearchElementDefinitionCharacteristic
+				v.decodeJSON(d)
+				r.Characteristic = append(r.Characteristic, v)
+			}
+			if r.Characteristic == nil && !a.isNull {
+				r.Characteristic = []ResearchElementDefinitionCharacteristic{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ResearchElementDefinition which shares no memory with the original
+func (r ResearchElementDefinition) DeepCopy() ResearchElementDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.ShortTitle != nil {
+		v := *r.ShortTitle
+		out.ShortTitle = &v
+	}
+	if r.ShortTitleElement != nil {
+		v := r.ShortTitleElement.DeepCopy()
+		out.ShortTitleElement = &v
+	}
+	if r.Subtitle != nil {
+		v := *r.Subtitle
+		out.Subtitle = &v
+	}
+	if r.SubtitleElement != nil {
+		v := r.SubtitleElement.DeepCopy()
+		out.SubtitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.SubjectCodeableConcept != nil {
+		v := r.SubjectCodeableConcept.DeepCopy()
+		out.SubjectCodeableConcept = &v
+	}
+	if r.SubjectReference != nil {
+		v := r.SubjectReference.DeepCopy()
+		out.SubjectReference = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Comment != nil {
+		out.Comment = make([]string, len(r.Comment))
+		copy(out.Comment, r.Comment)
+	}
+	if r.CommentElement != nil {
+		out.CommentElement = make([]*Element, len(r.CommentElement))
+		for i, v := range r.CommentElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.CommentElement[i] = &c
+			}
+		}
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Usage != nil {
+		v := *r.Usage
+		out.Usage = &v
+	}
+	if r.UsageElement != nil {
+		v := r.UsageElement.DeepCopy()
+		out.UsageElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.ApprovalDate != nil {
+		v := *r.ApprovalDate
+		out.ApprovalDate = &v
+	}
+	if r.ApprovalDateElement != nil {
+		v := r.ApprovalDateElement.DeepCopy()
+		out.ApprovalDateElement = &v
+	}
+	if r.LastReviewDate != nil {
+		v := *r.LastReviewDate
+		out.LastReviewDate = &v
+	}
+	if r.LastReviewDateElement != nil {
+		v := r.LastReviewDateElement.DeepCopy()
+		out.LastReviewDateElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Topic != nil {
+		out.Topic = make([]CodeableConcept, len(r.Topic))
+		for i := range r.Topic {
+			out.Topic[i] = r.Topic[i].DeepCopy()
+		}
+	}
+	if r.Author != nil {
+		out.Author = make([]ContactDetail, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Editor != nil {
+		out.Editor = make([]ContactDetail, len(r.Editor))
+		for i := range r.Editor {
+			out.Editor[i] = r.Editor[i].DeepCopy()
+		}
+	}
+	if r.Reviewer != nil {
+		out.Reviewer = make([]ContactDetail, len(r.Reviewer))
+		for i := range r.Reviewer {
+			out.Reviewer[i] = r.Reviewer[i].DeepCopy()
+		}
+	}
+	if r.Endorser != nil {
+		out.Endorser = make([]ContactDetail, len(r.Endorser))
+		for i := range r.Endorser {
+			out.Endorser[i] = r.Endorser[i].DeepCopy()
+		}
+	}
+	if r.RelatedArtifact != nil {
+		out.RelatedArtifact = make([]RelatedArtifact, len(r.RelatedArtifact))
+		for i := range r.RelatedArtifact {
+			out.RelatedArtifact[i] = r.RelatedArtifact[i].DeepCopy()
+		}
+	}
+	if r.Library != nil {
+		out.Library = make([]string, len(r.Library))
+		copy(out.Library, r.Library)
+	}
+	if r.LibraryElement != nil {
+		out.LibraryElement = make([]*Element, len(r.LibraryElement))
+		for i, v := range r.LibraryElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LibraryElement[i] = &c
+			}
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.VariableType != nil {
+		v := *r.VariableType
+		out.VariableType = &v
+	}
+	if r.VariableTypeElement != nil {
+		v := r.VariableTypeElement.DeepCopy()
+		out.VariableTypeElement = &v
+	}
+	if r.Characteristic != nil {
+		out.Characteristic = make([]ResearchElementDefinitionCharacteristic, len(r.Characteristic))
+		for i := range r.Characteristic {
+			out.Characteristic[i] = r.Characteristic[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ResearchElementDefinition) Equal(other ResearchElementDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if (r.ShortTitle == nil) != (other.ShortTitle == nil) || r.ShortTitle != nil && *r.ShortTitle != *other.ShortTitle {
+		return false
+	}
+	if (r.ShortTitleElement == nil) != (other.ShortTitleElement == nil) || r.ShortTitleElement != nil && !r.ShortTitleElement.Equal(*other.ShortTitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && *r.Subtitle != *other.Subtitle {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.Equal(*other.SubtitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.Equal(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.Equal(*other.SubjectReference) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Comment) != len(other.Comment) {
+		return false
+	}
+	for i := range r.Comment {
+		if r.Comment[i] != other.Comment[i] {
+			return false
+		}
+	}
+	if !equalElements(r.CommentElement, other.CommentElement, false) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && *r.Usage != *other.Usage {
+		return false
+	}
+	if (r.UsageElement == nil) != (other.UsageElement == nil) || r.UsageElement != nil && !r.UsageElement.Equal(*other.UsageElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && *r.ApprovalDate != *other.ApprovalDate {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.Equal(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && *r.LastReviewDate != *other.LastReviewDate {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.Equal(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if len(r.Topic) != len(other.Topic) {
+		return false
+	}
+	for i := range r.Topic {
+		if !r.Topic[i].Equal(other.Topic[i]) {
+			return false
+		}
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if len(r.Editor) != len(other.Editor) {
+		return false
+	}
+	for i := range r.Editor {
+		if !r.Editor[i].Equal(other.Editor[i]) {
+			return false
+		}
+	}
+	if len(r.Reviewer) != len(other.Reviewer) {
+		return false
+	}
+	for i := range r.Reviewer {
+		if !r.Reviewer[i].Equal(other.Reviewer[i]) {
+			return false
+		}
+	}
+	if len(r.Endorser) != len(other.Endorser) {
+		return false
+	}
+	for i := range r.Endorser {
+		if !r.Endorser[i].Equal(other.Endorser[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedArtifact) != len(other.RelatedArtifact) {
+		return false
+	}
+	for i := range r.RelatedArtifact {
+		if !r.RelatedArtifact[i].Equal(other.RelatedArtifact[i]) {
+			return false
+		}
+	}
+	if len(r.Library) != len(other.Library) {
+		return false
+	}
+	for i := range r.Library {
+		if r.Library[i] != other.Library[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LibraryElement, other.LibraryElement, false) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.VariableType == nil) != (other.VariableType == nil) || r.VariableType != nil && *r.VariableType != *other.VariableType {
+		return false
+	}
+	if (r.VariableTypeElement == nil) != (other.VariableTypeElement == nil) || r.VariableTypeElement != nil && !r.VariableTypeElement.Equal(*other.VariableTypeElement) {
+		return false
+	}
+	if len(r.Characteristic) != len(other.Characteristic) {
+		return false
+	}
+	for i := range r.Characteristic {
+		if !r.Characteristic[i].Equal(other.Characteristic[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ResearchElementDefinition) EqualsDeep(other ResearchElementDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if (r.ShortTitle == nil) != (other.ShortTitle == nil) || r.ShortTitle != nil && !equivalentString(*r.ShortTitle, *other.ShortTitle) {
+		return false
+	}
+	if (r.ShortTitleElement == nil) != (other.ShortTitleElement == nil) || r.ShortTitleElement != nil && !r.ShortTitleElement.EqualsDeep(*other.ShortTitleElement) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if (r.SubtitleElement == nil) != (other.SubtitleElement == nil) || r.SubtitleElement != nil && !r.SubtitleElement.EqualsDeep(*other.SubtitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.SubjectCodeableConcept == nil) != (other.SubjectCodeableConcept == nil) || r.SubjectCodeableConcept != nil && !r.SubjectCodeableConcept.EqualsDeep(*other.SubjectCodeableConcept) {
+		return false
+	}
+	if (r.SubjectReference == nil) != (other.SubjectReference == nil) || r.SubjectReference != nil && !r.SubjectReference.EqualsDeep(*other.SubjectReference) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Comment), len(other.Comment), func(i, j int) bool {
+		return equivalentString(r.Comment[i], other.Comment[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.CommentElement, other.CommentElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && !equivalentString(*r.Usage, *other.Usage) {
+		return false
+	}
+	if (r.UsageElement == nil) != (other.UsageElement == nil) || r.UsageElement != nil && !r.UsageElement.EqualsDeep(*other.UsageElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.EqualsDeep(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.EqualsDeep(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Topic), len(other.Topic), func(i, j int) bool {
+		return r.Topic[i].EqualsDeep(other.Topic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Editor), len(other.Editor), func(i, j int) bool {
+		return r.Editor[i].EqualsDeep(other.Editor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reviewer), len(other.Reviewer), func(i, j int) bool {
+		return r.Reviewer[i].EqualsDeep(other.Reviewer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endorser), len(other.Endorser), func(i, j int) bool {
+		return r.Endorser[i].EqualsDeep(other.Endorser[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedArtifact), len(other.RelatedArtifact), func(i, j int) bool {
+		return r.RelatedArtifact[i].EqualsDeep(other.RelatedArtifact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Library), len(other.Library), func(i, j int) bool {
+		return equivalentString(r.Library[i], other.Library[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LibraryElement, other.LibraryElement, true) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.VariableType == nil) != (other.VariableType == nil) || r.VariableType != nil && *r.VariableType != *other.VariableType {
+		return false
+	}
+	if (r.VariableTypeElement == nil) != (other.VariableTypeElement == nil) || r.VariableTypeElement != nil && !r.VariableTypeElement.EqualsDeep(*other.VariableTypeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Characteristic), len(other.Characteristic), func(i, j int) bool {
+		return r.Characteristic[i].EqualsDeep(other.Characteristic[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ResearchElementDefinition) EqualsShallow(other ResearchElementDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.ShortTitle == nil) != (other.ShortTitle == nil) || r.ShortTitle != nil && !equivalentString(*r.ShortTitle, *other.ShortTitle) {
+		return false
+	}
+	if (r.Subtitle == nil) != (other.Subtitle == nil) || r.Subtitle != nil && !equivalentString(*r.Subtitle, *other.Subtitle) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if !equivalentList(len(r.Comment), len(other.Comment), func(i, j int) bool {
+		return equivalentString(r.Comment[i], other.Comment[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Usage == nil) != (other.Usage == nil) || r.Usage != nil && !equivalentString(*r.Usage, *other.Usage) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if !equivalentList(len(r.Library), len(other.Library), func(i, j int) bool {
+		return equivalentString(r.Library[i], other.Library[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.VariableType == nil) != (other.VariableType == nil) || r.VariableType != nil && *r.VariableType != *other.VariableType {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ResearchElementDefinition as FHIR XML
+func (r ResearchElementDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ResearchElementDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "shortTitle", r.ShortTitle, r.ShortTitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "subtitle", r.Subtitle, r.SubtitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectCodeableConcept", r.SubjectCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subjectReference", r.SubjectReference); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for i, v := range r.Comment {
+		if err := encodeXMLPrimitive(e, "comment", v, elementAt(r.CommentElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "usage", r.Usage, r.UsageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "approvalDate", r.ApprovalDate, r.ApprovalDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastReviewDate", r.LastReviewDate, r.LastReviewDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Topic {
+		if err := encodeXMLElement(e, "topic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Editor {
+		if err := encodeXMLElement(e, "editor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reviewer {
+		if err := encodeXMLElement(e, "reviewer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endorser {
+		if err := encodeXMLElement(e, "endorser", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedArtifact {
+		if err := encodeXMLElement(e, "relatedArtifact", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Library {
+		if err := encodeXMLPrimitive(e, "library", v, elementAt(r.LibraryElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "variableType", r.VariableType, r.VariableTypeElement); err != nil {
+		return err
+	}
+	for _, v := range r.Characteristic {
+		if err := encodeXMLElement(e, "characteristic", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ResearchElementDefinition from FHIR XML
+func (r *ResearchElementDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "shortTitle":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ShortTitle = &v
+				}
+				r.ShortTitleElement = element
+			case "subtitle":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subtitle = &v
+				}
+				r.SubtitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "subjectCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectCodeableConcept = &v
+			case "subjectReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubjectReference = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.CommentElement = appendElement(r.CommentElement, len(r.Comment), element)
+					r.Comment = append(r.Comment, v)
+				}
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "usage":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Usage = &v
+				}
+				r.UsageElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "approvalDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ApprovalDate = &v
+				}
+				r.ApprovalDateElement = element
+			case "lastReviewDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastReviewDate = &v
+				}
+				r.LastReviewDateElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "topic":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Topic = append(r.Topic, v)
+			case "author":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "editor":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Editor = append(r.Editor, v)
+			case "reviewer":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reviewer = append(r.Reviewer, v)
+			case "endorser":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endorser = append(r.Endorser, v)
+			case "relatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			case "library":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LibraryElement = appendElement(r.LibraryElement, len(r.Library), element)
+					r.Library = append(r.Library, v)
+				}
+			case "type":
+				var v ResearchElementType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "variableType":
+				var v EvidenceVariableType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.VariableType = &v
+				}
+				r.VariableTypeElement = element
+			case "characteristic":
+				var v ResearchElementDefinitionCharacteristic
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Characteristic = append(r.Characteristic, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ResearchElementDefinition as properties to the RDF node n
+func (r ResearchElementDefinition) turtle(n *turtleNode) {
+	n.resource("ResearchElementDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("ResearchElementDefinition.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("ResearchElementDefinition.identifier", i, v)
+	}
+	n.primitive("ResearchElementDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("ResearchElementDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("ResearchElementDefinition.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("ResearchElementDefinition.shortTitle", -1, r.ShortTitle, r.ShortTitleElement, "string")
+	n.primitive("ResearchElementDefinition.subtitle", -1, r.Subtitle, r.SubtitleElement, "string")
+	n.primitive("ResearchElementDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("ResearchElementDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.element("ResearchElementDefinition.subjectCodeableConcept", -1, r.SubjectCodeableConcept)
+	n.element("ResearchElementDefinition.subjectReference", -1, r.SubjectReference)
+	n.primitive("ResearchElementDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("ResearchElementDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("ResearchElementDefinition.contact", i, v)
+	}
+	n.primitive("ResearchElementDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Comment {
+		n.primitive("ResearchElementDefinition.comment", i, v, elementAt(r.CommentElement, i), "string")
+	}
+	for i, v := range r.UseContext {
+		n.element("ResearchElementDefinition.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("ResearchElementDefinition.jurisdiction", i, v)
+	}
+	n.primitive("ResearchElementDefinition.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("ResearchElementDefinition.usage", -1, r.Usage, r.UsageElement, "string")
+	n.primitive("ResearchElementDefinition.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("ResearchElementDefinition.approvalDate", -1, r.ApprovalDate, r.ApprovalDateElement, "date")
+	n.primitive("ResearchElementDefinition.lastReviewDate", -1, r.LastReviewDate, r.LastReviewDateElement, "date")
+	n.element("ResearchElementDefinition.effectivePeriod", -1, r.EffectivePeriod)
+	for i, v := range r.Topic {
+		n.element("ResearchElementDefinition.topic", i, v)
+	}
+	for i, v := range r.Author {
+		n.element("ResearchElementDefinition.author", i, v)
+	}
+	for i, v := range r.Editor {
+		n.element("ResearchElementDefinition.editor", i, v)
+	}
+	for i, v := range r.Reviewer {
+		n.element("ResearchElementDefinition.reviewer", i, v)
+	}
+	for i, v := range r.Endorser {
+		n.element("ResearchElementDefinition.endorser", i, v)
+	}
+	for i, v := range r.RelatedArtifact {
+		n.element("ResearchElementDefinition.relatedArtifact", i, v)
+	}
+	for i, v := range r.Library {
+		n.primitive("ResearchElementDefinition.library", i, v, elementAt(r.LibraryElement, i), "string")
+	}
+	n.primitive("ResearchElementDefinition.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("ResearchElementDefinition.variableType", -1, r.VariableType, r.VariableTypeElement, "code")
+	for i, v := range r.Characteristic {
+		n.element("ResearchElementDefinition.characteristic", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ResearchElementDefinition as BSON document with the structure of its FHIR JSON
+func (r ResearchElementDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ResearchElementDefinition from a BSON document with the structure of its FHIR JSON
+func (r *ResearchElementDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ResearchElementDefinition as protobuf message ResearchElementDefinition of fhir.proto
+func (r ResearchElementDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ResearchElementDefinition to the encoded message
+func (r ResearchElementDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	if r.ShortTitle != nil {
+		e.string(14, *r.ShortTitle)
+	}
+	if r.Subtitle != nil {
+		e.string(15, *r.Subtitle)
+	}
+	e.varint(16, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(17, protoBool(*r.Experimental))
+	}
+	if r.SubjectCodeableConcept != nil {
+		e.message(18, *r.SubjectCodeableConcept)
+	}
+	if r.SubjectReference != nil {
+		e.message(19, *r.SubjectReference)
+	}
+	if r.Date != nil {
+		e.string(20, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(21, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(22, v)
+	}
+	if r.Description != nil {
+		e.string(23, *r.Description)
+	}
+	for _, v := range r.Comment {
+		e.string(24, v)
+	}
+	for _, v := range r.UseContext {
+		e.message(25, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(26, v)
+	}
+	if r.Purpose != nil {
+		e.string(27, *r.Purpose)
+	}
+	if r.Usage != nil {
+		e.string(28, *r.Usage)
+	}
+	if r.Copyright != nil {
+		e.string(29, *r.Copyright)
+	}
+	if r.ApprovalDate != nil {
+		e.string(30, *r.ApprovalDate)
+	}
+	if r.LastReviewDate != nil {
+		e.string(31, *r.LastReviewDate)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(32, *r.EffectivePeriod)
+	}
+	for _, v := range r.Topic {
+		e.message(33, v)
+	}
+	for _, v := range r.Author {
+		e.message(34, v)
+	}
+	for _, v := range r.Editor {
+		e.message(35, v)
+	}
+	for _, v := range r.Reviewer {
+		e.message(36, v)
+	}
+	for _, v := range r.Endorser {
+		e.message(37, v)
+	}
+	for _, v := range r.RelatedArtifact {
+		e.message(38, v)
+	}
+	for _, v := range r.Library {
+		e.string(39, v)
+	}
+	e.varint(40, r.Type.protoNumber())
+	if r.VariableType != nil {
+		e.varint(41, r.VariableType.protoNumber())
+	}
+	for _, v := range r.Characteristic {
+		e.message(42, v)
+	}
+	if r.IdElement != nil {
+		e.message(43, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(44, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(45, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(46, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(47, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(48, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(49, *r.TitleElement)
+	}
+	if r.ShortTitleElement != nil {
+		e.message(50, *r.ShortTitleElement)
+	}
+	if r.SubtitleElement != nil {
+		e.message(51, *r.SubtitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(52, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(53, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(54, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(55, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(56, *r.DescriptionElement)
+	}
+	for _, v := range r.CommentElement {
+		e.element(57, v)
+	}
+	if r.PurposeElement != nil {
+		e.message(58, *r.PurposeElement)
+	}
+	if r.UsageElement != nil {
+		e.message(59, *r.UsageElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(60, *r.CopyrightElement)
+	}
+	if r.ApprovalDateElement != nil {
+		e.message(61, *r.ApprovalDateElement)
+	}
+	if r.LastReviewDateElement != nil {
+		e.message(62, *r.LastReviewDateElement)
+	}
+	for _, v := range r.LibraryElement {
+		e.element(63, v)
+	}
+	if r.TypeElement != nil {
+		e.message(64, *r.TypeElement)
+	}
+	if r.VariableTypeElement != nil {
+		e.message(65, *r.VariableTypeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ResearchElementDefinition from protobuf message ResearchElementDefinition of fhir.proto
+func (r *ResearchElementDefinition) UnmarshalProto(b []byte) error {
+	*r = ResearchElementDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			v := d.string()
+			r.ShortTitle = &v
+		case 15:
+			v := d.string()
+			r.Subtitle = &v
+		case 16:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 17:
+			v := d.bool()
+			r.Experimental = &v
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubjectCodeableConcept = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.SubjectReference = &v
+		case 20:
+			v := d.string()
+			r.Date = &v
+		case 21:
+			v := d.string()
+			r.Publisher = &v
+		case 22:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 23:
+			v := d.string()
+			r.Description = &v
+		case 24:
+			r.Comment = append(r.Comment, d.string())
+		case 25:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 26:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 27:
+			v := d.string()
+			r.Purpose = &v
+		case 28:
+			v := d.string()
+			r.Usage = &v
+		case 29:
+			v := d.string()
+			r.Copyright = &v
+		case 30:
+			v := d.string()
+			r.ApprovalDate = &v
+		case 31:
+			v := d.string()
+			r.LastReviewDate = &v
+		case 32:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 33:
+			var v CodeableConcept
+			d.message(&v)
+			r.Topic = append(r.Topic, v)
+		case 34:
+			var v ContactDetail
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 35:
+			var v ContactDetail
+			d.message(&v)
+			r.Editor = append(r.Editor, v)
+		case 36:
+			var v ContactDetail
+			d.message(&v)
+			r.Reviewer = append(r.Reviewer, v)
+		case 37:
+			var v ContactDetail
+			d.message(&v)
+			r.Endorser = append(r.Endorser, v)
+		case 38:
+			var v RelatedArtifact
+			d.message(&v)
+			r.RelatedArtifact = append(r.RelatedArtifact, v)
+		case 39:
+			r.Library = append(r.Library, d.string())
+		case 40:
+			var v ResearchElementType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 41:
+			var v EvidenceVariableType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.VariableType = &v
+		case 42:
+			var v ResearchElementDefinitionCharacteristic
+			d.message(&v)
+			r.Characteristic = append(r.Characteristic, v)
+		case 43:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.ShortTitleElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.SubtitleElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 54:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 57:
+			r.CommentElement = append(r.CommentElement, d.element())
+		case 58:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 59:
+			var v Element
+			d.message(&v)
+			r.UsageElement = &v
+		case 60:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 61:
+			var v Element
+			d.message(&v)
+			r.ApprovalDateElement = &v
+		case 62:
+			var v Element
+			d.message(&v)
+			r.LastReviewDateElement = &v
+		case 63:
+			r.LibraryElement = append(r.LibraryElement, d.element())
+		case 64:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 65:
+			var v Element
+			d.message(&v)
+			r.VariableTypeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ResearchElementDefinitionCharacteristic as JSON into a byte slice
+func (r ResearchElementDefinitionCharacteristic) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ResearchElementDefinitionCharacteristic as JSON object with the members in the order of the fields
+func (r ResearchElementDefinitionCharacteristic) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.DefinitionCodeableConcept != nil {
+		e.key("definitionCodeableConcept")
+		r.DefinitionCodeableConcept.appendJSON(e)
+	}
+	if r.DefinitionCanonical != nil {
+		e.key("definitionCanonical")
+		e.string(*r.DefinitionCanonical)
+	}
+	if r.DefinitionCanonicalElement != nil {
+		e.key("_definitionCanonical")
+		r.DefinitionCanonicalElement.appendJSON(e)
+	}
+	if r.DefinitionExpression != nil {
+		e.key("definitionExpression")
+		r.DefinitionExpression.appendJSON(e)
+	}
+	if r.DefinitionDataRequirement != nil {
+		e.key("definitionDataRequirement")
+		r.DefinitionDataRequirement.appendJSON(e)
+	}
+	if len(r.UsageContext) > 0 {
+		e.key("usageContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UsageContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Exclude != nil {
+		e.key("exclude")
+		e.bool(*r.Exclude)
+	}
+	if r.ExcludeElement != nil {
+		e.key("_exclude")
+		r.ExcludeElement.appendJSON(e)
+	}
+	if r.UnitOfMeasure != nil {
+		e.key("unitOfMeasure")
+		r.UnitOfMeasure.appendJSON(e)
+	}
+	if r.StudyEffectiveDescription != nil {
+		e.key("studyEffectiveDescription")
+		e.string(*r.StudyEffectiveDescription)
+	}
+	if r.StudyEffectiveDescriptionElement != nil {
+		e.key("_studyEffectiveDescription")
+		r.StudyEffectiveDescriptionElement.appendJSON(e)
+	}
+	if r.StudyEffectiveDateTime != nil {
+		e.key("studyEffectiveDateTime")
+		e.string(*r.StudyEffectiveDateTime)
+	}
+	if r.StudyEffectiveDateTimeElement != nil {
+		e.key("_studyEffectiveDateTime")
+		r.StudyEffectiveDateTimeElement.appendJSON(e)
+	}
+	if r.StudyEffectivePeriod != nil {
+		e.key("studyEffectivePeriod")
+		r.StudyEffectivePeriod.appendJSON(e)
+	}
+	if r.StudyEffectiveDuration != nil {
+		e.key("studyEffectiveDuration")
+		r.StudyEffectiveDuration.appendJSON(e)
+	}
+	if r.StudyEffectiveTiming != nil {
+		e.key("studyEffectiveTiming")
+		r.StudyEffectiveTiming.appendJSON(e)
+	}
+	if r.StudyEffectiveTimeFromStart != nil {
+		e.key("studyEffectiveTimeFromStart")
+		r.StudyEffectiveTimeFromStart.appendJSON(e)
+	}
+	if r.StudyEffectiveGroupMeasure != nil {
+		e.key("studyEffectiveGroupMeasure")
+		e.string(r.StudyEffectiveGroupMeasure.Code())
+	}
+	if r.StudyEffectiveGroupMeasureElement != nil {
+		e.key("_studyEffectiveGroupMeasure")
+		r.StudyEffectiveGroupMeasureElement.appendJSON(e)
+	}
+	if r.ParticipantEffectiveDescription != nil {
+		e.key("participantEffectiveDescription")
+		e.string(*r.ParticipantEffectiveDescription)
+	}
+	if r.ParticipantEffectiveDescriptionElement != nil {
+		e.key("_participantEffectiveDescription")
+		r.ParticipantEffectiveDescriptionElement.appendJSON(e)
+	}
+	if r.ParticipantEffectiveDateTime != nil {
+		e.key("participantEffectiveDateTime")
+		e.string(*r.ParticipantEffectiveDateTime)
+	}
+	if r.ParticipantEffectiveDateTimeElement != nil {
+		e.key("_participantEffectiveDateTime")
+		r.ParticipantEffectiveDateTimeElement.appendJSON(e)
+	}
+	if r.ParticipantEffectivePeriod != nil {
+		e.key("participantEffectivePeriod")
+		r.ParticipantEffectivePeriod.appendJSON(e)
+	}
+	if r.ParticipantEffectiveDuration != nil {
+		e.key("participantEffectiveDuration")
+		r.ParticipantEffectiveDuration.appendJSON(e)
+	}
+	if r.ParticipantEffectiveTiming != nil {
+		e.key("participantEffectiveTiming")
+		r.ParticipantEffectiveTiming.appendJSON(e)
+	}
+	if r.ParticipantEffectiveTimeFromStart != nil {
+		e.key("participantEffectiveTimeFromStart")
+		r.ParticipantEffectiveTimeFromStart.appendJSON(e)
+	}
+	if r.ParticipantEffectiveGroupMeasure != nil {
+		e.key("participantEffectiveGroupMeasure")
+		e.string(r.ParticipantEffectiveGroupMeasure.Code())
+	}
+	if r.ParticipantEffectiveGroupMeasureElement != nil {
+		e.key("_participantEffectiveGroupMeasure")
+		r.ParticipantEffectiveGroupMeasureElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ResearchElementDefinitionCharacteristic from JSON. Unknown members are ignored.
+func (r *ResearchElementDefinitionCharacteristic) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ResearchElementDefinitionCharacteristic
+func (r *ResearchElementDefinitionCharacteristic) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "definitionCodeableConcept":
+			if d.null() {
+				r.DefinitionCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DefinitionCodeableConcept = &v
+			}
+		case "definitionCanonical":
+			if v, ok := d.string(); ok {
+				r.DefinitionCanonical = &v
+			} else {
+				r.DefinitionCanonical = nil
+			}
+		case "_definitionCanonical":
+			r.DefinitionCanonicalElement = d.element()
+		case "definitionExpression":
+			if d.null() {
+				r.DefinitionExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.DefinitionExpression = &v
+			}
+		case "definitionDataRequirement":
+			if d.null() {
+				r.DefinitionDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.DefinitionDataRequirement = &v
+			}
+		case "usageContext":
+			a := d.array()
+			r.UsageContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UsageContext = append(r.UsageContext, v)
+			}
+			if r.UsageContext == nil && !a.isNull {
+				r.UsageContext = []UsageContext{}
+			}
+		case "exclude":
+			if v, ok := d.bool(); ok {
+				r.Exclude = &v
+			} else {
+				r.Exclude = nil
+			}
+		case "_exclude":
+			r.ExcludeElement = d.element()
+		case "unitOfMeasure":
+			if d.null() {
+				r.UnitOfMeasure = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.UnitOfMeasure = &v
+			}
+		case "studyEffectiveDescription":
+			if v, ok := d.string(); ok {
+				r.StudyEffectiveDescription = &v
+			} else {
+				r.StudyEffectiveDescription = nil
+			}
+		case "_studyEffectiveDescription":
+			r.StudyEffectiveDescriptionElement = d.element()
+		case "studyEffectiveDateTime":
+			if v, ok := d.string(); ok {
+				r.StudyEffectiveDateTime = &v
+			} else {
+				r.StudyEffectiveDateTime = nil
+			}
+		case "_studyEffectiveDateTime":
+			r.StudyEffectiveDateTimeElement = d.element()
+		case "studyEffectivePeriod":
+			if d.null() {
+				r.StudyEffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.StudyEffectivePeriod = &v
+			}
+		case "studyEffectiveDuration":
+			if d.null() {
+				r.StudyEffectiveDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.StudyEffectiveDuration = &v
+			}
+		case "studyEffectiveTiming":
+			if d.null() {
+				r.StudyEffectiveTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.StudyEffectiveTiming = &v
+			}
+		case "studyEffectiveTimeFromStart":
+			if d.null() {
+				r.StudyEffectiveTimeFromStart = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.StudyEffectiveTimeFromStart = &v
+			}
+		case "studyEffectiveGroupMeasure":
+			if d.null() {
+				r.StudyEffectiveGroupMeasure = nil
+			} else {
+				var v GroupMeasure
+				d.unmarshal(&v)
+				r.StudyEffectiveGroupMeasure = &v
+			}
+		case "_studyEffectiveGroupMeasure":
+			r.StudyEffectiveGroupMeasureElement = d.element()
+		case "participantEffectiveDescription":
+			if v, ok := d.string(); ok {
+				r.ParticipantEffectiveDescription = &v
+			} else {
+				r.ParticipantEffectiveDescription = nil
+			}
+		case "_participantEffectiveDescription":
+			r.ParticipantEffectiveDescriptionElement = d.element()
+		case "participantEffectiveDateTime":
+			if v, ok := d.string(); ok {
+				r.ParticipantEffectiveDateTime = &v
+			} else {
+				r.ParticipantEffectiveDateTime = nil
+			}
+		case "_participantEffectiveDateTime":
+			r.ParticipantEffectiveDateTimeElement = d.element()
+		case "participantEffectivePeriod":
+			if d.null() {
+				r.ParticipantEffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ParticipantEffectivePeriod = &v
+			}
+		case "participantEffectiveDuration":
+			if d.null() {
+				r.ParticipantEffectiveDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.ParticipantEffectiveDuration = &v
+			}
+		case "participantEffectiveTiming":
+			if d.null() {
+				r.ParticipantEffectiveTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.ParticipantEffectiveTiming = &v
+			}
+		case "participantEffectiveTimeFromStart":
+			if d.null() {
+				r.ParticipantEffectiveTimeFromStart = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.ParticipantEffectiveTimeFromStart = &v
+			}
+		case "participantEffectiveGroupMeasure":
+			if d.null() {
+				r.ParticipantEffectiveGroupMeasure = nil
+			} else {
+				var v GroupMeasure
+				d.unmarshal(&v)
+				r.ParticipantEffectiveGroupMeasure = &v
+			}
+		case "_participantEffectiveGroupMeasure":
+			r.ParticipantEffectiveGroupMeasureElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ResearchElementDefinitionCharacteristic which shares no memory with the original
+func (r ResearchElementDefinitionCharacteristic) DeepCopy() ResearchElementDefinitionCharacteristic {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DefinitionCodeableConcept != nil {
+		v := r.DefinitionCodeableConcept.DeepCopy()
+		out.DefinitionCodeableConcept = &v
+	}
+	if r.DefinitionCanonical != nil {
+		v := *r.DefinitionCanonical
+		out.DefinitionCanonical = &v
+	}
+	if r.DefinitionCanonicalElement != nil {
+		v := r.DefinitionCanonicalElement.DeepCopy()
+		out.DefinitionCanonicalElement = &v
+	}
+	if r.DefinitionExpression != nil {
+		v := r.DefinitionExpression.DeepCopy()
+		out.DefinitionExpression = &v
+	}
+	if r.DefinitionDataRequirement != nil {
+		v := r.DefinitionDataRequirement.DeepCopy()
+		out.DefinitionDataRequirement = &v
+	}
+	if r.UsageContext != nil {
+		out.UsageContext = make([]UsageContext, len(r.UsageContext))
+		for i := range r.UsageContext {
+			out.UsageContext[i] = r.UsageContext[i].DeepCopy()
+		}
+	}
+	if r.Exclude != nil {
+		v := *r.Exclude
+		out.Exclude = &v
+	}
+	if r.ExcludeElement != nil {
+		v := r.ExcludeElement.DeepCopy()
+		out.ExcludeElement = &v
+	}
+	if r.UnitOfMeasure != nil {
+		v := r.UnitOfMeasure.DeepCopy()
+		out.UnitOfMeasure = &v
+	}
+	if r.StudyEffectiveDescription != nil {
+		v := *r.StudyEffectiveDescription
+		out.StudyEffectiveDescription = &v
+	}
+	if r.StudyEffectiveDescriptionElement != nil {
+		v := r.StudyEffectiveDescriptionElement.DeepCopy()
+		out.StudyEffectiveDescriptionElement = &v
+	}
+	if r.StudyEffectiveDateTime != nil {
+		v := *r.StudyEffectiveDateTime
+		out.StudyEffectiveDateTime = &v
+	}
+	if r.StudyEffectiveDateTimeElement != nil {
+		v := r.StudyEffectiveDateTimeElement.DeepCopy()
+		out.StudyEffectiveDateTimeElement = &v
+	}
+	if r.StudyEffectivePeriod != nil {
+		v := r.StudyEffectivePeriod.DeepCopy()
+		out.StudyEffectivePeriod = &v
+	}
+	if r.StudyEffectiveDuration != nil {
+		v := r.StudyEffectiveDuration.DeepCopy()
+		out.StudyEffectiveDuration = &v
+	}
+	if r.StudyEffectiveTiming != nil {
+		v := r.StudyEffectiveTiming.DeepCopy()
+		out.StudyEffectiveTiming = &v
+	}
+	if r.StudyEffectiveTimeFromStart != nil {
+		v := r.StudyEffectiveTimeFromStart.DeepCopy()
+		out.StudyEffectiveTimeFromStart = &v
+	}
+	if r.StudyEffectiveGroupMeasure != nil {
+		v := *r.StudyEffectiveGroupMeasure
+		out.StudyEffectiveGroupMeasure = &v
+	}
+	if r.StudyEffectiveGroupMeasureElement != nil {
+		v := r.StudyEffectiveGroupMeasureElement.DeepCopy()
+		out.StudyEffectiveGroupMeasureElement = &v
+	}
+	if r.ParticipantEffectiveDescription != nil {
+		v := *r.ParticipantEffectiveDescription
+		out.ParticipantEffectiveDescription = &v
+	}
+	if r.ParticipantEffectiveDescriptionElement != nil {
+		v := r.ParticipantEffectiveDescriptionElement.DeepCopy()
+		out.ParticipantEffectiveDescriptionElement = &v
+	}
+	if r.ParticipantEffectiveDateTime != nil {
+		v := *r.ParticipantEffectiveDateTime
+		out.ParticipantEffectiveDateTime = &v
+	}
+	if r.ParticipantEffectiveDateTimeElement != nil {
+		v := r.ParticipantEffectiveDateTimeElement.DeepCopy()
+		out.ParticipantEffectiveDateTimeElement = &v
+	}
+	if r.ParticipantEffectivePeriod != nil {
+		v := r.ParticipantEffectivePeriod.DeepCopy()
+		out.ParticipantEffectivePeriod = &v
+	}
+	if r.ParticipantEffectiveDuration != nil {
+		v := r.ParticipantEffectiveDuration.DeepCopy()
+		out.ParticipantEffectiveDuration = &v
+	}
+	if r.ParticipantEffectiveTiming != nil {
+		v := r.ParticipantEffectiveTiming.DeepCopy()
+		out.ParticipantEffectiveTiming = &v
+	}
+	if r.ParticipantEffectiveTimeFromStart != nil {
+		v := r.ParticipantEffectiveTimeFromStart.DeepCopy()
+		out.ParticipantEffectiveTimeFromStart = &v
+	}
+	if r.ParticipantEffectiveGroupMeasure != nil {
+		v := *r.ParticipantEffectiveGroupMeasure
+		out.ParticipantEffectiveGroupMeasure = &v
+	}
+	if r.ParticipantEffectiveGroupMeasureElement != nil {
+		v := r.ParticipantEffectiveGroupMeasureElement.DeepCopy()
+		out.ParticipantEffectiveGroupMeasureElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ResearchElementDefinitionCharacteristic) Equal(other ResearchElementDefinitionCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.DefinitionCodeableConcept == nil) != (other.DefinitionCodeableConcept == nil) || r.DefinitionCodeableConcept != nil && !r.DefinitionCodeableConcept.Equal(*other.DefinitionCodeableConcept) {
+		return false
+	}
+	if (r.DefinitionCanonical == nil) != (other.DefinitionCanonical == nil) || r.DefinitionCanonical != nil && *r.DefinitionCanonical != *other.DefinitionCanonical {
+		return false
+	}
+	if (r.DefinitionCanonicalElement == nil) != (other.DefinitionCanonicalElement == nil) || r.DefinitionCanonicalElement != nil && !r.DefinitionCanonicalElement.Equal(*other.DefinitionCanonicalElement) {
+		return false
+	}
+	if (r.DefinitionExpression == nil) != (other.DefinitionExpression == nil) || r.DefinitionExpression != nil && !r.DefinitionExpression.Equal(*other.DefinitionExpression) {
+		return false
+	}
+	if (r.DefinitionDataRequirement == nil) != (other.DefinitionDataRequirement == nil) || r.DefinitionDataRequirement != nil && !r.DefinitionDataRequirement.Equal(*other.DefinitionDataRequirement) {
+		return false
+	}
+	if len(r.UsageContext) != len(other.UsageContext) {
+		return false
+	}
+	for i := range r.UsageContext {
+		if !r.UsageContext[i].Equal(other.UsageContext[i]) {
+			return false
+		}
+	}
+	if (r.Exclude == nil) != (other.Exclude == nil) || r.Exclude != nil && *r.Exclude != *other.Exclude {
+		return false
+	}
+	if (r.ExcludeElement == nil) != (other.ExcludeElement == nil) || r.ExcludeElement != nil && !r.ExcludeElement.Equal(*other.ExcludeElement) {
+		return false
+	}
+	if (r.UnitOfMeasure == nil) != (other.UnitOfMeasure == nil) || r.UnitOfMeasure != nil && !r.UnitOfMeasure.Equal(*other.UnitOfMeasure) {
+		return false
+	}
+	if (r.StudyEffectiveDescription == nil) != (other.StudyEffectiveDescription == nil) || r.StudyEffectiveDescription != nil && *r.StudyEffectiveDescription != *other.StudyEffectiveDescription {
+		return false
+	}
+	if (r.StudyEffectiveDescriptionElement == nil) != (other.StudyEffectiveDescriptionElement == nil) || r.StudyEffectiveDescriptionElement != nil && !r.StudyEffectiveDescriptionElement.Equal(*other.StudyEffectiveDescriptionElement) {
+		return false
+	}
+	if (r.StudyEffectiveDateTime == nil) != (other.StudyEffectiveDateTime == nil) || r.StudyEffectiveDateTime != nil && *r.StudyEffectiveDateTime != *other.StudyEffectiveDateTime {
+		return false
+	}
+	if (r.StudyEffectiveDateTimeElement == nil) != (other.StudyEffectiveDateTimeElement == nil) || r.StudyEffectiveDateTimeElement != nil && !r.StudyEffectiveDateTimeElement.Equal(*other.StudyEffectiveDateTimeElement) {
+		return false
+	}
+	if (r.StudyEffectivePeriod == nil) != (other.StudyEffectivePeriod == nil) || r.StudyEffectivePeriod != nil && !r.StudyEffectivePeriod.Equal(*other.StudyEffectivePeriod) {
+		return false
+	}
+	if (r.StudyEffectiveDuration == nil) != (other.StudyEffectiveDuration == nil) || r.StudyEffectiveDuration != nil && !r.StudyEffectiveDuration.Equal(*other.StudyEffectiveDuration) {
+		return false
+	}
+	if (r.StudyEffectiveTiming == nil) != (other.StudyEffectiveTiming == nil) || r.StudyEffectiveTiming != nil && !r.StudyEffectiveTiming.Equal(*other.StudyEffectiveTiming) {
+		return false
+	}
+	if (r.StudyEffectiveTimeFromStart == nil) != (other.StudyEffectiveTimeFromStart == nil) || r.StudyEffectiveTimeFromStart != nil && !r.StudyEffectiveTimeFromStart.Equal(*other.StudyEffectiveTimeFromStart) {
+		return false
+	}
+	if (r.StudyEffectiveGroupMeasure == nil) != (other.StudyEffectiveGroupMeasure == nil) || r.StudyEffectiveGroupMeasure != nil && *r.StudyEffectiveGroupMeasure != *other.StudyEffectiveGroupMeasure {
+		return false
+	}
+	if (r.StudyEffectiveGroupMeasureElement == nil) != (other.StudyEffectiveGroupMeasureElement == nil) || r.StudyEffectiveGroupMeasureElement != nil && !r.StudyEffectiveGroupMeasureElement.Equal(*other.StudyEffectiveGroupMeasureElement) {
+		return false
+	}
+	if (r.ParticipantEffectiveDescription == nil) != (other.ParticipantEffectiveDescription == nil) || r.ParticipantEffectiveDescription != nil && *r.ParticipantEffectiveDescription != *other.ParticipantEffectiveDescription {
+		return false
+	}
+	if (r.ParticipantEffectiveDescriptionElement == nil) != (other.ParticipantEffectiveDescriptionElement == nil) || r.ParticipantEffectiveDescriptionElement != nil && !r.ParticipantEffectiveDescriptionElement.Equal(*other.ParticipantEffectiveDescriptionElement) {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTime == nil) != (other.ParticipantEffectiveDateTime == nil) || r.ParticipantEffectiveDateTime != nil && *r.ParticipantEffectiveDateTime != *other.ParticipantEffectiveDateTime {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTimeElement == nil) != (other.ParticipantEffectiveDateTimeElement == nil) || r.ParticipantEffectiveDateTimeElement != nil && !r.ParticipantEffectiveDateTimeElement.Equal(*other.ParticipantEffectiveDateTimeElement) {
+		return false
+	}
+	if (r.ParticipantEffectivePeriod == nil) != (other.ParticipantEffectivePeriod == nil) || r.ParticipantEffectivePeriod != nil && !r.ParticipantEffectivePeriod.Equal(*other.ParticipantEffectivePeriod) {
+		return false
+	}
+	if (r.ParticipantEffectiveDuration == nil) != (other.ParticipantEffectiveDuration == nil) || r.ParticipantEffectiveDuration != nil && !r.ParticipantEffectiveDuration.Equal(*other.ParticipantEffectiveDuration) {
+		return false
+	}
+	if (r.ParticipantEffectiveTiming == nil) != (other.ParticipantEffectiveTiming == nil) || r.ParticipantEffectiveTiming != nil && !r.ParticipantEffectiveTiming.Equal(*other.ParticipantEffectiveTiming) {
+		return false
+	}
+	if (r.ParticipantEffectiveTimeFromStart == nil) != (other.ParticipantEffectiveTimeFromStart == nil) || r.ParticipantEffectiveTimeFromStart != nil && !r.ParticipantEffectiveTimeFromStart.Equal(*other.ParticipantEffectiveTimeFromStart) {
+		return false
+	}
+	if (r.ParticipantEffectiveGroupMeasure == nil) != (other.ParticipantEffectiveGroupMeasure == nil) || r.ParticipantEffectiveGroupMeasure != nil && *r.ParticipantEffectiveGroupMeasure != *other.ParticipantEffectiveGroupMeasure {
+		return false
+	}
+	if (r.ParticipantEffectiveGroupMeasureElement == nil) != (other.ParticipantEffectiveGroupMeasureElement == nil) || r.ParticipantEffectiveGroupMeasureElement != nil && !r.ParticipantEffectiveGroupMeasureElement.Equal(*other.ParticipantEffectiveGroupMeasureElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ResearchElementDefinitionCharacteristic) EqualsDeep(other ResearchElementDefinitionCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.DefinitionCodeableConcept == nil) != (other.DefinitionCodeableConcept == nil) || r.DefinitionCodeableConcept != nil && !r.DefinitionCodeableConcept.EqualsDeep(*other.DefinitionCodeableConcept) {
+		return false
+	}
+	if (r.DefinitionCanonical == nil) != (other.DefinitionCanonical == nil) || r.DefinitionCanonical != nil && !equivalentString(*r.DefinitionCanonical, *other.DefinitionCanonical) {
+		return false
+	}
+	if (r.DefinitionCanonicalElement == nil) != (other.DefinitionCanonicalElement == nil) || r.DefinitionCanonicalElement != nil && !r.DefinitionCanonicalElement.EqualsDeep(*other.DefinitionCanonicalElement) {
+		return false
+	}
+	if (r.DefinitionExpression == nil) != (other.DefinitionExpression == nil) || r.DefinitionExpression != nil && !r.DefinitionExpression.EqualsDeep(*other.DefinitionExpression) {
+		return false
+	}
+	if (r.DefinitionDataRequirement == nil) != (other.DefinitionDataRequirement == nil) || r.DefinitionDataRequirement != nil && !r.DefinitionDataRequirement.EqualsDeep(*other.DefinitionDataRequirement) {
+		return false
+	}
+	if !equivalentList(len(r.UsageContext), len(other.UsageContext), func(i, j int) bool {
+		return r.UsageContext[i].EqualsDeep(other.UsageContext[j])
+	}) {
+		return false
+	}
+	if (r.Exclude == nil) != (other.Exclude == nil) || r.Exclude != nil && *r.Exclude != *other.Exclude {
+		return false
+	}
+	if (r.ExcludeElement == nil) != (other.ExcludeElement == nil) || r.ExcludeElement != nil && !r.ExcludeElement.EqualsDeep(*other.ExcludeElement) {
+		return false
+	}
+	if (r.UnitOfMeasure == nil) != (other.UnitOfMeasure == nil) || r.UnitOfMeasure != nil && !r.UnitOfMeasure.EqualsDeep(*other.UnitOfMeasure) {
+		return false
+	}
+	if (r.StudyEffectiveDescription == nil) != (other.StudyEffectiveDescription == nil) || r.StudyEffectiveDescription != nil && !equivalentString(*r.StudyEffectiveDescription, *other.StudyEffectiveDescription) {
+		return false
+	}
+	if (r.StudyEffectiveDescriptionElement == nil) != (other.StudyEffectiveDescriptionElement == nil) || r.StudyEffectiveDescriptionElement != nil && !r.StudyEffectiveDescriptionElement.EqualsDeep(*other.StudyEffectiveDescriptionElement) {
+		return false
+	}
+	if (r.StudyEffectiveDateTime == nil) != (other.StudyEffectiveDateTime == nil) || r.StudyEffectiveDateTime != nil && !equivalentString(*r.StudyEffectiveDateTime, *other.StudyEffectiveDateTime) {
+		return false
+	}
+	if (r.StudyEffectiveDateTimeElement == nil) != (other.StudyEffectiveDateTimeElement == nil) || r.StudyEffectiveDateTimeElement != nil && !r.StudyEffectiveDateTimeElement.EqualsDeep(*other.StudyEffectiveDateTimeElement) {
+		return false
+	}
+	if (r.StudyEffectivePeriod == nil) != (other.StudyEffectivePeriod == nil) || r.StudyEffectivePeriod != nil && !r.StudyEffectivePeriod.EqualsDeep(*other.StudyEffectivePeriod) {
+		return false
+	}
+	if (r.StudyEffectiveDuration == nil) != (other.StudyEffectiveDuration == nil) || r.StudyEffectiveDuration != nil && !r.StudyEffectiveDuration.EqualsDeep(*other.StudyEffectiveDuration) {
+		return false
+	}
+	if (r.StudyEffectiveTiming == nil) != (other.StudyEffectiveTiming == nil) || r.StudyEffectiveTiming != nil && !r.StudyEffectiveTiming.EqualsDeep(*other.StudyEffectiveTiming) {
+		return false
+	}
+	if (r.StudyEffectiveTimeFromStart == nil) != (other.StudyEffectiveTimeFromStart == nil) || r.StudyEffectiveTimeFromStart != nil && !r.StudyEffectiveTimeFromStart.EqualsDeep(*other.StudyEffectiveTimeFromStart) {
+		return false
+	}
+	if (r.StudyEffectiveGroupMeasure == nil) != (other.StudyEffectiveGroupMeasure == nil) || r.StudyEffectiveGroupMeasure != nil && *r.StudyEffectiveGroupMeasure != *other.StudyEffectiveGroupMeasure {
+		return false
+	}
+	if (r.StudyEffectiveGroupMeasureElement == nil) != (other.StudyEffectiveGroupMeasureElement == nil) || r.StudyEffectiveGroupMeasureElement != nil && !r.StudyEffectiveGroupMeasureElement.EqualsDeep(*other.StudyEffectiveGroupMeasureElement) {
+		return false
+	}
+	if (r.ParticipantEffectiveDescription == nil) != (other.ParticipantEffectiveDescription == nil) || r.ParticipantEffectiveDescription != nil && !equivalentString(*r.ParticipantEffectiveDescription, *other.ParticipantEffectiveDescription) {
+		return false
+	}
+	if (r.ParticipantEffectiveDescriptionElement == nil) != (other.ParticipantEffectiveDescriptionElement == nil) || r.ParticipantEffectiveDescriptionElement != nil && !r.ParticipantEffectiveDescriptionElement.EqualsDeep(*other.ParticipantEffectiveDescriptionElement) {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTime == nil) != (other.ParticipantEffectiveDateTime == nil) || r.ParticipantEffectiveDateTime != nil && !equivalentString(*r.ParticipantEffectiveDateTime, *other.ParticipantEffectiveDateTime) {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTimeElement == nil) != (other.ParticipantEffectiveDateTimeElement == nil) || r.ParticipantEffectiveDateTimeElement != nil && !r.ParticipantEffectiveDateTimeElement.EqualsDeep(*other.ParticipantEffectiveDateTimeElement) {
+		return false
+	}
+	if (r.ParticipantEffectivePeriod == nil) != (other.ParticipantEffectivePeriod == nil) || r.ParticipantEffectivePeriod != nil && !r.ParticipantEffectivePeriod.EqualsDeep(*other.ParticipantEffectivePeriod) {
+		return false
+	}
+	if (r.ParticipantEffectiveDuration == nil) != (other.ParticipantEffectiveDuration == nil) || r.ParticipantEffectiveDuration != nil && !r.ParticipantEffectiveDuration.EqualsDeep(*other.ParticipantEffectiveDuration) {
+		return false
+	}
+	if (r.ParticipantEffectiveTiming == nil) != (other.ParticipantEffectiveTiming == nil) || r.ParticipantEffectiveTiming != nil && !r.ParticipantEffectiveTiming.EqualsDeep(*other.ParticipantEffectiveTiming) {
+		return false
+	}
+	if (r.ParticipantEffectiveTimeFromStart == nil) != (other.ParticipantEffectiveTimeFromStart == nil) || r.ParticipantEffectiveTimeFromStart != nil && !r.ParticipantEffectiveTimeFromStart.EqualsDeep(*other.ParticipantEffectiveTimeFromStart) {
+		return false
+	}
+	if (r.ParticipantEffectiveGroupMeasure == nil) != (other.ParticipantEffectiveGroupMeasure == nil) || r.ParticipantEffectiveGroupMeasure != nil && *r.ParticipantEffectiveGroupMeasure != *other.ParticipantEffectiveGroupMeasure {
+		return false
+	}
+	if (r.ParticipantEffectiveGroupMeasureElement == nil) != (other.ParticipantEffectiveGroupMeasureElement == nil) || r.ParticipantEffectiveGroupMeasureElement != nil && !r.ParticipantEffectiveGroupMeasureElement.EqualsDeep(*other.ParticipantEffectiveGroupMeasureElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ResearchElementDefinitionCharacteristic) EqualsShallow(other ResearchElementDefinitionCharacteristic) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.DefinitionCanonical == nil) != (other.DefinitionCanonical == nil) || r.DefinitionCanonical != nil && !equivalentString(*r.DefinitionCanonical, *other.DefinitionCanonical) {
+		return false
+	}
+	if (r.Exclude == nil) != (other.Exclude == nil) || r.Exclude != nil && *r.Exclude != *other.Exclude {
+		return false
+	}
+	if (r.StudyEffectiveDescription == nil) != (other.StudyEffectiveDescription == nil) || r.StudyEffectiveDescription != nil && !equivalentString(*r.StudyEffectiveDescription, *other.StudyEffectiveDescription) {
+		return false
+	}
+	if (r.StudyEffectiveDateTime == nil) != (other.StudyEffectiveDateTime == nil) || r.StudyEffectiveDateTime != nil && !equivalentString(*r.StudyEffectiveDateTime, *other.StudyEffectiveDateTime) {
+		return false
+	}
+	if (r.StudyEffectiveGroupMeasure == nil) != (other.StudyEffectiveGroupMeasure == nil) || r.StudyEffectiveGroupMeasure != nil && *r.StudyEffectiveGroupMeasure != *other.StudyEffectiveGroupMeasure {
+		return false
+	}
+	if (r.ParticipantEffectiveDescription == nil) != (other.ParticipantEffectiveDescription == nil) || r.ParticipantEffectiveDescription != nil && !equivalentString(*r.ParticipantEffectiveDescription, *other.ParticipantEffectiveDescription) {
+		return false
+	}
+	if (r.ParticipantEffectiveDateTime == nil) != (other.ParticipantEffectiveDateTime == nil) || r.ParticipantEffectiveDateTime != nil && !equivalentString(*r.ParticipantEffectiveDateTime, *other.ParticipantEffectiveDateTime) {
+		return false
+	}
+	if (r.ParticipantEffectiveGroupMeasure == nil) != (other.ParticipantEffectiveGroupMeasure == nil) || r.ParticipantEffectiveGroupMeasure != nil && *r.ParticipantEffectiveGroupMeasure != *other.ParticipantEffectiveGroupMeasure {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ResearchElementDefinitionCharacteristic as FHIR XML
+func (r ResearchElementDefinitionCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "definitionCodeableConcept", r.DefinitionCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "definitionCanonical", r.DefinitionCanonical, r.DefinitionCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "definitionExpression", r.DefinitionExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "definitionDataRequirement", r.DefinitionDataRequirement); err != nil {
+		return err
+	}
+	for _, v := range r.UsageContext {
+		if err := encodeXMLElement(e, "usageContext", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "exclude", r.Exclude, r.ExcludeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitOfMeasure", r.UnitOfMeasure); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "studyEffectiveDescription", r.StudyEffectiveDescription, r.StudyEffectiveDescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "studyEffectiveDateTime", r.StudyEffectiveDateTime, r.StudyEffectiveDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "studyEffectivePeriod", r.StudyEffectivePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "studyEffectiveDuration", r.StudyEffectiveDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "studyEffectiveTiming", r.StudyEffectiveTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "studyEffectiveTimeFromStart", r.StudyEffectiveTimeFromStart); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "studyEffectiveGroupMeasure", r.StudyEffectiveGroupMeasure, r.StudyEffectiveGroupMeasureElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "participantEffectiveDescription", r.ParticipantEffectiveDescription, r.ParticipantEffectiveDescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "participantEffectiveDateTime", r.ParticipantEffectiveDateTime, r.ParticipantEffectiveDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "participantEffectivePeriod", r.ParticipantEffectivePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "participantEffectiveDuration", r.ParticipantEffectiveDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "participantEffectiveTiming", r.ParticipantEffectiveTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "participantEffectiveTimeFromStart", r.ParticipantEffectiveTimeFromStart); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "participantEffectiveGroupMeasure", r.ParticipantEffectiveGroupMeasure, r.ParticipantEffectiveGroupMeasureElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ResearchElementDefinitionCharacteristic from FHIR XML
+func (r *ResearchElementDefinitionCharacteristic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "definitionCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefinitionCodeableConcept = &v
+			case "definitionCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefinitionCanonical = &v
+				}
+				r.DefinitionCanonicalElement = element
+			case "definitionExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefinitionExpression = &v
+			case "definitionDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefinitionDataRequirement = &v
+			case "usageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UsageContext = append(r.UsageContext, v)
+			case "exclude":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Exclude = &v
+				}
+				r.ExcludeElement = element
+			case "unitOfMeasure":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitOfMeasure = &v
+			case "studyEffectiveDescription":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.StudyEffectiveDescription = &v
+				}
+				r.StudyEffectiveDescriptionElement = element
+			case "studyEffectiveDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.StudyEffectiveDateTime = &v
+				}
+				r.StudyEffectiveDateTimeElement = element
+			case "studyEffectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StudyEffectivePeriod = &v
+			case "studyEffectiveDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StudyEffectiveDuration = &v
+			case "studyEffectiveTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StudyEffectiveTiming = &v
+			case "studyEffectiveTimeFromStart":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StudyEffectiveTimeFromStart = &v
+			case "studyEffectiveGroupMeasure":
+				var v GroupMeasure
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.StudyEffectiveGroupMeasure = &v
+				}
+				r.StudyEffectiveGroupMeasureElement = element
+			case "participantEffectiveDescription":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ParticipantEffectiveDescription = &v
+				}
+				r.ParticipantEffectiveDescriptionElement = element
+			case "participantEffectiveDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ParticipantEffectiveDateTime = &v
+				}
+				r.ParticipantEffectiveDateTimeElement = element
+			case "participantEffectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParticipantEffectivePeriod = &v
+			case "participantEffectiveDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParticipantEffectiveDuration = &v
+			case "participantEffectiveTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParticipantEffectiveTiming = &v
+			case "participantEffectiveTimeFromStart":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParticipantEffectiveTimeFromStart = &v
+			case "participantEffectiveGroupMeasure":
+				var v GroupMeasure
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ParticipantEffectiveGroupMeasure = &v
+				}
+				r.ParticipantEffectiveGroupMeasureElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ResearchElementDefinitionCharacteristic as properties to the RDF node n
+func (r ResearchElementDefinitionCharacteristic) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("ResearchElementDefinition.characteristic.definitionCodeableConcept", -1, r.DefinitionCodeableConcept)
+	n.primitive("ResearchElementDefinition.characteristic.definitionCanonical", -1, r.DefinitionCanonical, r.DefinitionCanonicalElement, "canonical")
+	n.element("ResearchElementDefinition.characteristic.definitionExpression", -1, r.DefinitionExpression)
+	n.element("ResearchElementDefinition.characteristic.definitionDataRequirement", -1, r.DefinitionDataRequirement)
+	for i, v := range r.UsageContext {
+		n.element("ResearchElementDefinition.characteristic.usageContext", i, v)
+	}
+	n.primitive("ResearchElementDefinition.characteristic.exclude", -1, r.Exclude, r.ExcludeElement, "boolean")
+	n.element("ResearchElementDefinition.characteristic.unitOfMeasure", -1, r.UnitOfMeasure)
+	n.primitive("ResearchElementDefinition.characteristic.studyEffectiveDescription", -1, r.StudyEffectiveDescription, r.StudyEffectiveDescriptionElement, "string")
+	n.primitive("ResearchElementDefinition.characteristic.studyEffectiveDateTime", -1, r.StudyEffectiveDateTime, r.StudyEffectiveDateTimeElement, "dateTime")
+	n.element("ResearchElementDefinition.characteristic.studyEffectivePeriod", -1, r.StudyEffectivePeriod)
+	n.element("ResearchElementDefinition.characteristic.studyEffectiveDuration", -1, r.StudyEffectiveDuration)
+	n.element("ResearchElementDefinition.characteristic.studyEffectiveTiming", -1, r.StudyEffectiveTiming)
+	n.element("ResearchElementDefinition.characteristic.studyEffectiveTimeFromStart", -1, r.StudyEffectiveTimeFromStart)
+	n.primitive("ResearchElementDefinition.characteristic.studyEffectiveGroupMeasure", -1, r.StudyEffectiveGroupMeasure, r.StudyEffectiveGroupMeasureElement, "code")
+	n.primitive("ResearchElementDefinition.characteristic.participantEffectiveDescription", -1, r.ParticipantEffectiveDescription, r.ParticipantEffectiveDescriptionElement, "string")
+	n.primitive("ResearchElementDefinition.characteristic.participantEffectiveDateTime", -1, r.ParticipantEffectiveDateTime, r.ParticipantEffectiveDateTimeElement, "dateTime")
+	n.element("ResearchElementDefinition.characteristic.participantEffectivePeriod", -1, r.ParticipantEffectivePeriod)
+	n.element("ResearchElementDefinition.characteristic.participantEffectiveDuration", -1, r.ParticipantEffectiveDuration)
+	n.element("ResearchElementDefinition.characteristic.participantEffectiveTiming", -1, r.ParticipantEffectiveTiming)
+	n.element("ResearchElementDefinition.characteristic.participantEffectiveTimeFromStart", -1, r.ParticipantEffectiveTimeFromStart)
+	n.primitive("ResearchElementDefinition.characteristic.participantEffectiveGroupMeasure", -1, r.ParticipantEffectiveGroupMeasure, r.ParticipantEffectiveGroupMeasureElement, "code")
+}
+
+// MarshalBSON marshals the given ResearchElementDefinitionCharacteristic as BSON document with the structure of its FHIR JSON
+func (r ResearchElementDefinitionCharacteristic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ResearchElementDefinitionCharacteristic from a BSON document with the structure of its FHIR JSON
+func (r *ResearchElementDefinitionCharacteristic) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ResearchElementDefinitionCharacteristic as protobuf message ResearchElementDefinitionCharacteristic of fhir.proto
+func (r ResearchElementDefinitionCharacteristic) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ResearchElementDefinitionCharacteristic to the encoded message
+func (r ResearchElementDefinitionCharacteristic) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.DefinitionCodeableConcept != nil {
+		e.message(4, *r.DefinitionCodeableConcept)
+	}
+	if r.DefinitionCanonical != nil {
+		e.string(5, *r.DefinitionCanonical)
+	}
+	if r.DefinitionExpression != nil {
+		e.message(6, *r.DefinitionExpression)
+	}
+	if r.DefinitionDataRequirement != nil {
+		e.message(7, *r.DefinitionDataRequirement)
+	}
+	for _, v := range r.UsageContext {
+		e.message(8, v)
+	}
+	if r.Exclude != nil {
+		e.varint(9, protoBool(*r.Exclude))
+	}
+	if r.UnitOfMeasure != nil {
+		e.message(10, *r.UnitOfMeasure)
+	}
+	if r.StudyEffectiveDescription != nil {
+		e.string(11, *r.StudyEffectiveDescription)
+	}
+	if r.StudyEffectiveDateTime != nil {
+		e.string(12, *r.StudyEffectiveDateTime)
+	}
+	if r.StudyEffectivePeriod != nil {
+		e.message(13, *r.StudyEffectivePeriod)
+	}
+	if r.StudyEffectiveDuration != nil {
+		e.message(14, *r.StudyEffectiveDuration)
+	}
+	if r.StudyEffectiveTiming != nil {
+		e.message(15, *r.StudyEffectiveTiming)
+	}
+	if r.StudyEffectiveTimeFromStart != nil {
+		e.message(16, *r.StudyEffectiveTimeFromStart)
+	}
+	if r.StudyEffectiveGroupMeasure != nil {
+		e.varint(17, r.StudyEffectiveGroupMeasure.protoNumber())
+	}
+	if r.ParticipantEffectiveDescription != nil {
+		e.string(18, *r.ParticipantEffectiveDescription)
+	}
+	if r.ParticipantEffectiveDateTime != nil {
+		e.string(19, *r.ParticipantEffectiveDateTime)
+	}
+	if r.ParticipantEffectivePeriod != nil {
+		e.message(20, *r.ParticipantEffectivePeriod)
+	}
+	if r.ParticipantEffectiveDuration != nil {
+		e.message(21, *r.ParticipantEffectiveDuration)
+	}
+	if r.ParticipantEffectiveTiming != nil {
+		e.message(22, *r.ParticipantEffectiveTiming)
+	}
+	if r.ParticipantEffectiveTimeFromStart != nil {
+		e.message(23, *r.ParticipantEffectiveTimeFromStart)
+	}
+	if r.ParticipantEffectiveGroupMeasure != nil {
+		e.varint(24, r.ParticipantEffectiveGroupMeasure.protoNumber())
+	}
+	if r.DefinitionCanonicalElement != nil {
+		e.message(25, *r.DefinitionCanonicalElement)
+	}
+	if r.ExcludeElement != nil {
+		e.message(26, *r.ExcludeElement)
+	}
+	if r.StudyEffectiveDescriptionElement != nil {
+		e.message(27, *r.StudyEffectiveDescriptionElement)
+	}
+	if r.StudyEffectiveDateTimeElement != nil {
+		e.message(28, *r.StudyEffectiveDateTimeElement)
+	}
+	if r.StudyEffectiveGroupMeasureElement != nil {
+		e.message(29, *r.StudyEffectiveGroupMeasureElement)
+	}
+	if r.ParticipantEffectiveDescriptionElement != nil {
+		e.message(30, *r.ParticipantEffectiveDescriptionElement)
+	}
+	if r.ParticipantEffectiveDateTimeElement != nil {
+		e.message(31, *r.ParticipantEffectiveDateTimeElement)
+	}
+	if r.ParticipantEffectiveGroupMeasureElement != nil {
+		e.message(32, *r.ParticipantEffectiveGroupMeasureElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ResearchElementDefinitionCharacteristic from protobuf message ResearchElementDefinitionCharacteristic of fhir.proto
+func (r *ResearchElementDefinitionCharacteristic) UnmarshalProto(b []byte) error {
+	*r = ResearchElementDefinitionCharacteristic{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.DefinitionCodeableConcept = &v
+		case 5:
+			v := d.string()
+			r.DefinitionCanonical = &v
+		case 6:
+			var v Expression
+			d.message(&v)
+			r.DefinitionExpression = &v
+		case 7:
+			var v DataRequirement
+			d.message(&v)
+			r.DefinitionDataRequirement = &v
+		case 8:
+			var v UsageContext
+			d.message(&v)
+			r.UsageContext = append(r.UsageContext, v)
+		case 9:
+			v := d.bool()
+			r.Exclude = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.UnitOfMeasure = &v
+		case 11:
+			v := d.string()
+			r.StudyEffectiveDescription = &v
+		case 12:
+			v := d.string()
+			r.StudyEffectiveDateTime = &v
+		case 13:
+			var v Period
+			d.message(&v)
+			r.StudyEffectivePeriod = &v
+		case 14:
+			var v Duration
+			d.message(&v)
+			r.StudyEffectiveDuration = &v
+		case 15:
+			var v Timing
+			d.message(&v)
+			r.StudyEffectiveTiming = &v
+		case 16:
+			var v Duration
+			d.message(&v)
+			r.StudyEffectiveTimeFromStart = &v
+		case 17:
+			var v GroupMeasure
+			d.enum(d.varint(), v.setProtoNumber)
+			r.StudyEffectiveGroupMeasure = &v
+		case 18:
+			v := d.string()
+			r.ParticipantEffectiveDescription = &v
+		case 19:
+			v := d.string()
+			r.ParticipantEffectiveDateTime = &v
+		case 20:
+			var v Period
+			d.message(&v)
+			r.ParticipantEffectivePeriod = &v
+		case 21:
+			var v Duration
+			d.message(&v)
+			r.ParticipantEffectiveDuration = &v
+		case 22:
+			var v Timing
+			d.message(&v)
+			r.ParticipantEffectiveTiming = &v
+		case 23:
+			var v Duration
+			d.message(&v)
+			r.ParticipantEffectiveTimeFromStart = &v
+		case 24:
+			var v GroupMeasure
+			d.enum(d.varint(), v.setProtoNumber)
+			r.ParticipantEffectiveGroupMeasure = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.DefinitionCanonicalElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ExcludeElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.StudyEffectiveDescriptionElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.StudyEffectiveDateTimeElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.StudyEffectiveGroupMeasureElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ParticipantEffectiveDescriptionElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.ParticipantEffectiveDateTimeElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.ParticipantEffectiveGroupMeasureElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalResearchElementDefinition unmarshals a ResearchElementDefinition.
 func UnmarshalResearchElementDefinition(b []byte) (ResearchElementDefinition, error) {
 	var researchElementDefinition ResearchElementDefinition
-	if err := json.Unmarshal(b, &researchElementDefinition); err != nil {
+	if err := researchElementDefinition.UnmarshalJSON(b); err != nil {
 		return researchElementDefinition, err
 	}
 	return researchElementDefinition, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ResearchElementType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ResearchElementType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ResearchElementType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "population":
 		*code = ResearchElementTypePopulation
@@ -49,6 +53,12 @@ func (code *ResearchElementType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ResearchElementType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ResearchElementType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ResearchElementType) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ResearchElementType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ResearchElementType) protoNumber() uint64 {
+	switch code {
+	case ResearchElementTypePopulation:
+		return 1
+	case ResearchElementTypeExposure:
+		return 2
+	case ResearchElementTypeOutcome:
+		return 3
+	}
+	return 0
+}
+func (code *ResearchElementType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ResearchElementTypePopulation
+	case 2:
+		*code = ResearchElementTypeExposure
+	case 3:
+		*code = ResearchElementTypeOutcome
+	default:
+		return false
+	}
+	return true
+}
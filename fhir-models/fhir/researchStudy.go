@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,17 +25,23 @@ import "encoding/json"
 // ResearchStudy is documented here http://hl7.org/fhir/StructureDefinition/ResearchStudy
 type ResearchStudy struct {
 	Id                    *string                  `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                 `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                  *Meta                    `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                 `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                  `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                 `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative               `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage        `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension              `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier            []Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Title                 *string                  `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                 `bson:"_title,omitempty" json:"_title,omitempty"`
 	Protocol              []Reference              `bson:"protocol,omitempty" json:"protocol,omitempty"`
 	PartOf                []Reference              `bson:"partOf,omitempty" json:"partOf,omitempty"`
 	Status                ResearchStudyStatus      `bson:"status" json:"status"`
+	StatusElement         *Element                 `bson:"_status,omitempty" json:"_status,omitempty"`
 	PrimaryPurposeType    *CodeableConcept         `bson:"primaryPurposeType,omitempty" json:"primaryPurposeType,omitempty"`
 	Phase                 *CodeableConcept         `bson:"phase,omitempty" json:"phase,omitempty"`
 	Category              []CodeableConcept        `bson:"category,omitempty" json:"category,omitempty"`
@@ -43,6 +52,7 @@ type ResearchStudy struct {
 	Keyword               []CodeableConcept        `bson:"keyword,omitempty" json:"keyword,omitempty"`
 	Location              []CodeableConcept        `bson:"location,omitempty" json:"location,omitempty"`
 	Description           *string                  `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                 `bson:"_description,omitempty" json:"_description,omitempty"`
 	Enrollment            []Reference              `bson:"enrollment,omitempty" json:"enrollment,omitempty"`
 	Period                *Period                  `bson:"period,omitempty" json:"period,omitempty"`
 	Sponsor               *Reference               `bson:"sponsor,omitempty" json:"sponsor,omitempty"`
@@ -54,37 +64,2766 @@ type ResearchStudy struct {
 	Objective             []ResearchStudyObjective `bson:"objective,omitempty" json:"objective,omitempty"`
 }
 type ResearchStudyArm struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string           `bson:"name" json:"name"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
+	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               string           `bson:"name" json:"name"`
+	NameElement        *Element         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Type               *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	Description        *string          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element         `bson:"_description,omitempty" json:"_description,omitempty"`
 }
 type ResearchStudyObjective struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              *string          `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element         `bson:"_name,omitempty" json:"_name,omitempty"`
 	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 }
-type OtherResearchStudy ResearchStudy
 
 // MarshalJSON marshals the given ResearchStudy as JSON into a byte slice
 func (r ResearchStudy) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherResearchStudy
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherResearchStudy: OtherResearchStudy(r),
-		ResourceType:       "ResearchStudy",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ResearchStudy as JSON object with the members in the order of the fields
+func (r ResearchStudy) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ResearchStudy\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	if len(r.Protocol) > 0 {
+		e.key("protocol")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Protocol {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.PrimaryPurposeType != nil {
+		e.key("primaryPurposeType")
+		r.PrimaryPurposeType.appendJSON(e)
+	}
+	if r.Phase != nil {
+		e.key("phase")
+		r.Phase.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Focus) > 0 {
+		e.key("focus")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Focus {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Condition) > 0 {
+		e.key("condition")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Condition {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedArtifact) > 0 {
+		e.key("relatedArtifact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedArtifact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Keyword) > 0 {
+		e.key("keyword")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Keyword {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Location) > 0 {
+		e.key("location")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Location {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Enrollment) > 0 {
+		e.key("enrollment")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Enrollment {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if r.Sponsor != nil {
+		e.key("sponsor")
+		r.Sponsor.appendJSON(e)
+	}
+	if r.PrincipalInvestigator != nil {
+		e.key("principalInvestigator")
+		r.PrincipalInvestigator.appendJSON(e)
+	}
+	if len(r.Site) > 0 {
+		e.key("site")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Site {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ReasonStopped != nil {
+		e.key("reasonStopped")
+		r.ReasonStopped.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Arm) > 0 {
+		e.key("arm")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Arm {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Objective) > 0 {
+		e.key("objective")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Objective {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ResearchStudy from JSON. Unknown members are ignored.
+func (r *ResearchStudy) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ResearchStudy
+func (r *ResearchStudy) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "protocol":
+			a := d.array()
+			r.Protocol = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Protocol = append(r.Protocol, v)
+			}
+			if r.Protocol == nil && !a.isNull {
+				r.Protocol = []Reference{}
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "primaryPurposeType":
+			if d.null() {
+				r.PrimaryPurposeType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PrimaryPurposeType = &v
+			}
+		case "phase":
+			if d.null() {
+				r.Phase = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Phase = &v
+			}
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "focus":
+			a := d.array()
+			r.Focus = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Focus = append(r.Focus, v)
+			}
+			if r.Focus == nil && !a.isNull {
+				r.Focus = []CodeableConcept{}
+			}
+		case "condition":
+			a := d.array()
+			r.Condition = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Condition = append(r.Condition, v)
+			}
+			if r.Condition == nil && !a.isNull {
+				r.Condition = []CodeableConcept{}
+			}
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "relatedArtifact":
+			a := d.array()
+			r.RelatedArtifact = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			}
+			if r.RelatedArtifact == nil && !a.isNull {
+				r.RelatedArtifact = []RelatedArtifact{}
+			}
+		case "keyword":
+			a := d.array()
+			r.Keyword = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Keyword = append(r.Keyword, v)
+			}
+			if r.Keyword == nil && !a.isNull {
+				r.Keyword = []CodeableConcept{}
+			}
+		case "location":
+			a := d.array()
+			r.Location = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Location = append(r.Location, v)
+			}
+			if r.Location == nil && !a.isNull {
+				r.Location = []CodeableConcept{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "enrollment":
+			a := d.array()
+			r.Enrollment = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Enrollment = append(r.Enrollment, v)
+			}
+			if r.Enrollment == nil && !a.isNull {
+				r.Enrollment = []Reference{}
+			}
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "sponsor":
+			if d.null() {
+				r.Sponsor = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Sponsor = &v
+			}
+		case "principalInvestigator":
+			if d.null() {
+				r.PrincipalInvestigator = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.PrincipalInvestigator = &v
+			}
+		case "site":
+			a := d.array()
+			r.Site = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Site = append(r.Site, v)
+			}
+			if r.Site == nil && !a.isNull {
+				r.Site = []Reference{}
+			}
+		case "reasonStopped":
+			if d.null() {
+				r.ReasonStopped = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonStopped = &v
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "arm":
+			a := d.array()
+			r.Arm = nil
+			for a.next() {
+				var v ResearchStudyArm
+				v.decodeJSON(d)
+				r.Arm = append(r.Arm, v)
+			}
+			if r.Arm == nil && !a.isNull {
+				r.Arm = []ResearchStudyArm{}
+			}
+		case "objective":
+			a := d.array()
+			r.Objective = nil
+			for a.next() {
+				var v ResearchStudyObjective
+				v.decodeJSON(d)
+				r.Objective = append(r.Objective, v)
+			}
+			if r.Objective == nil && !a.isNull {
+				r.Objective = []ResearchStudyObjective{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ResearchStudy which shares no memory with the original
+func (r ResearchStudy) DeepCopy() ResearchStudy {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.Protocol != nil {
+		out.Protocol = make([]Reference, len(r.Protocol))
+		for i := range r.Protocol {
+			out.Protocol[i] = r.Protocol[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.PrimaryPurposeType != nil {
+		v := r.PrimaryPurposeType.DeepCopy()
+		out.PrimaryPurposeType = &v
+	}
+	if r.Phase != nil {
+		v := r.Phase.DeepCopy()
+		out.Phase = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Focus != nil {
+		out.Focus = make([]CodeableConcept, len(r.Focus))
+		for i := range r.Focus {
+			out.Focus[i] = r.Focus[i].DeepCopy()
+		}
+	}
+	if r.Condition != nil {
+		out.Condition = make([]CodeableConcept, len(r.Condition))
+		for i := range r.Condition {
+			out.Condition[i] = r.Condition[i].DeepCopy()
+		}
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.RelatedArtifact != nil {
+		out.RelatedArtifact = make([]RelatedArtifact, len(r.RelatedArtifact))
+		for i := range r.RelatedArtifact {
+			out.RelatedArtifact[i] = r.RelatedArtifact[i].DeepCopy()
+		}
+	}
+	if r.Keyword != nil {
+		out.Keyword = make([]CodeableConcept, len(r.Keyword))
+		for i := range r.Keyword {
+			out.Keyword[i] = r.Keyword[i].DeepCopy()
+		}
+	}
+	if r.Location != nil {
+		out.Location = make([]CodeableConcept, len(r.Location))
+		for i := range r.Location {
+			out.Location[i] = r.Location[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Enrollment != nil {
+		out.Enrollment = make([]Reference, len(r.Enrollment))
+		for i := range r.Enrollment {
+			out.Enrollment[i] = r.Enrollment[i].DeepCopy()
+		}
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Sponsor != nil {
+		v := r.Sponsor.DeepCopy()
+		out.Sponsor = &v
+	}
+	if r.PrincipalInvestigator != nil {
+		v := r.PrincipalInvestigator.DeepCopy()
+		out.PrincipalInvestigator = &v
+	}
+	if r.Site != nil {
+		out.Site = make([]Reference, len(r.Site))
+		for i := range r.Site {
+			out.Site[i] = r.Site[i].DeepCopy()
+		}
+	}
+	if r.ReasonStopped != nil {
+		v := r.ReasonStopped.DeepCopy()
+		out.ReasonStopped = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.Arm != nil {
+		out.Arm = make([]ResearchStudyArm, len(r.Arm))
+		for i := range r.Arm {
+			out.Arm[i] = r.Arm[i].DeepCopy()
+		}
+	}
+	if r.Objective != nil {
+		out.Objective = make([]ResearchStudyObjective, len(r.Objective))
+		for i := range r.Objective {
+			out.Objective[i] = r.Objective[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ResearchStudy) Equal(other ResearchStudy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if len(r.Protocol) != len(other.Protocol) {
+		return false
+	}
+	for i := range r.Protocol {
+		if !r.Protocol[i].Equal(other.Protocol[i]) {
+			return false
+		}
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.PrimaryPurposeType == nil) != (other.PrimaryPurposeType == nil) || r.PrimaryPurposeType != nil && !r.PrimaryPurposeType.Equal(*other.PrimaryPurposeType) {
+		return false
+	}
+	if (r.Phase == nil) != (other.Phase == nil) || r.Phase != nil && !r.Phase.Equal(*other.Phase) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if len(r.Focus) != len(other.Focus) {
+		return false
+	}
+	for i := range r.Focus {
+		if !r.Focus[i].Equal(other.Focus[i]) {
+			return false
+		}
+	}
+	if len(r.Condition) != len(other.Condition) {
+		return false
+	}
+	for i := range r.Condition {
+		if !r.Condition[i].Equal(other.Condition[i]) {
+			return false
+		}
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedArtifact) != len(other.RelatedArtifact) {
+		return false
+	}
+	for i := range r.RelatedArtifact {
+		if !r.RelatedArtifact[i].Equal(other.RelatedArtifact[i]) {
+			return false
+		}
+	}
+	if len(r.Keyword) != len(other.Keyword) {
+		return false
+	}
+	for i := range r.Keyword {
+		if !r.Keyword[i].Equal(other.Keyword[i]) {
+			return false
+		}
+	}
+	if len(r.Location) != len(other.Location) {
+		return false
+	}
+	for i := range r.Location {
+		if !r.Location[i].Equal(other.Location[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Enrollment) != len(other.Enrollment) {
+		return false
+	}
+	for i := range r.Enrollment {
+		if !r.Enrollment[i].Equal(other.Enrollment[i]) {
+			return false
+		}
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if (r.Sponsor == nil) != (other.Sponsor == nil) || r.Sponsor != nil && !r.Sponsor.Equal(*other.Sponsor) {
+		return false
+	}
+	if (r.PrincipalInvestigator == nil) != (other.PrincipalInvestigator == nil) || r.PrincipalInvestigator != nil && !r.PrincipalInvestigator.Equal(*other.PrincipalInvestigator) {
+		return false
+	}
+	if len(r.Site) != len(other.Site) {
+		return false
+	}
+	for i := range r.Site {
+		if !r.Site[i].Equal(other.Site[i]) {
+			return false
+		}
+	}
+	if (r.ReasonStopped == nil) != (other.ReasonStopped == nil) || r.ReasonStopped != nil && !r.ReasonStopped.Equal(*other.ReasonStopped) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.Arm) != len(other.Arm) {
+		return false
+	}
+	for i := range r.Arm {
+		if !r.Arm[i].Equal(other.Arm[i]) {
+			return false
+		}
+	}
+	if len(r.Objective) != len(other.Objective) {
+		return false
+	}
+	for i := range r.Objective {
+		if !r.Objective[i].Equal(other.Objective[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ResearchStudy) EqualsDeep(other ResearchStudy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if !equivalentList(len(r.Protocol), len(other.Protocol), func(i, j int) bool {
+		return r.Protocol[i].EqualsDeep(other.Protocol[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.PrimaryPurposeType == nil) != (other.PrimaryPurposeType == nil) || r.PrimaryPurposeType != nil && !r.PrimaryPurposeType.EqualsDeep(*other.PrimaryPurposeType) {
+		return false
+	}
+	if (r.Phase == nil) != (other.Phase == nil) || r.Phase != nil && !r.Phase.EqualsDeep(*other.Phase) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Focus), len(other.Focus), func(i, j int) bool {
+		return r.Focus[i].EqualsDeep(other.Focus[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Condition), len(other.Condition), func(i, j int) bool {
+		return r.Condition[i].EqualsDeep(other.Condition[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedArtifact), len(other.RelatedArtifact), func(i, j int) bool {
+		return r.RelatedArtifact[i].EqualsDeep(other.RelatedArtifact[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Keyword), len(other.Keyword), func(i, j int) bool {
+		return r.Keyword[i].EqualsDeep(other.Keyword[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Location), len(other.Location), func(i, j int) bool {
+		return r.Location[i].EqualsDeep(other.Location[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Enrollment), len(other.Enrollment), func(i, j int) bool {
+		return r.Enrollment[i].EqualsDeep(other.Enrollment[j])
+	}) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if (r.Sponsor == nil) != (other.Sponsor == nil) || r.Sponsor != nil && !r.Sponsor.EqualsDeep(*other.Sponsor) {
+		return false
+	}
+	if (r.PrincipalInvestigator == nil) != (other.PrincipalInvestigator == nil) || r.PrincipalInvestigator != nil && !r.PrincipalInvestigator.EqualsDeep(*other.PrincipalInvestigator) {
+		return false
+	}
+	if !equivalentList(len(r.Site), len(other.Site), func(i, j int) bool {
+		return r.Site[i].EqualsDeep(other.Site[j])
+	}) {
+		return false
+	}
+	if (r.ReasonStopped == nil) != (other.ReasonStopped == nil) || r.ReasonStopped != nil && !r.ReasonStopped.EqualsDeep(*other.ReasonStopped) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Arm), len(other.Arm), func(i, j int) bool {
+		return r.Arm[i].EqualsDeep(other.Arm[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Objective), len(other.Objective), func(i, j int) bool {
+		return r.Objective[i].EqualsDeep(other.Objective[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ResearchStudy) EqualsShallow(other ResearchStudy) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ResearchStudy as FHIR XML
+func (r ResearchStudy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ResearchStudy",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	for _, v := range r.Protocol {
+		if err := encodeXMLElement(e, "protocol", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "primaryPurposeType", r.PrimaryPurposeType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "phase", r.Phase); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Focus {
+		if err := encodeXMLElement(e, "focus", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Condition {
+		if err := encodeXMLElement(e, "condition", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedArtifact {
+		if err := encodeXMLElement(e, "relatedArtifact", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Keyword {
+		if err := encodeXMLElement(e, "keyword", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Location {
+		if err := encodeXMLElement(e, "location", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Enrollment {
+		if err := encodeXMLElement(e, "enrollment", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sponsor", r.Sponsor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "principalInvestigator", r.PrincipalInvestigator); err != nil {
+		return err
+	}
+	for _, v := range r.Site {
+		if err := encodeXMLElement(e, "site", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "reasonStopped", r.ReasonStopped); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Arm {
+		if err := encodeXMLElement(e, "arm", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Objective {
+		if err := encodeXMLElement(e, "objective", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ResearchStudy from FHIR XML
+func (r *ResearchStudy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "protocol":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Protocol = append(r.Protocol, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v ResearchStudyStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "primaryPurposeType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PrimaryPurposeType = &v
+			case "phase":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Phase = &v
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "focus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Focus = append(r.Focus, v)
+			case "condition":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Condition = append(r.Condition, v)
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "relatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			case "keyword":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Keyword = append(r.Keyword, v)
+			case "location":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = append(r.Location, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "enrollment":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Enrollment = append(r.Enrollment, v)
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "sponsor":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Sponsor = &v
+			case "principalInvestigator":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PrincipalInvestigator = &v
+			case "site":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Site = append(r.Site, v)
+			case "reasonStopped":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonStopped = &v
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "arm":
+				var v ResearchStudyArm
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Arm = append(r.Arm, v)
+			case "objective":
+				var v ResearchStudyObjective
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Objective = append(r.Objective, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ResearchStudy as properties to the RDF node n
+func (r ResearchStudy) turtle(n *turtleNode) {
+	n.resource("ResearchStudy", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ResearchStudy.identifier", i, v)
+	}
+	n.primitive("ResearchStudy.title", -1, r.Title, r.TitleElement, "string")
+	for i, v := range r.Protocol {
+		n.element("ResearchStudy.protocol", i, v)
+	}
+	for i, v := range r.PartOf {
+		n.element("ResearchStudy.partOf", i, v)
+	}
+	n.primitive("ResearchStudy.status", -1, r.Status, r.StatusElement, "code")
+	n.element("ResearchStudy.primaryPurposeType", -1, r.PrimaryPurposeType)
+	n.element("ResearchStudy.phase", -1, r.Phase)
+	for i, v := range r.Category {
+		n.element("ResearchStudy.category", i, v)
+	}
+	for i, v := range r.Focus {
+		n.element("ResearchStudy.focus", i, v)
+	}
+	for i, v := range r.Condition {
+		n.element("ResearchStudy.condition", i, v)
+	}
+	for i, v := range r.Contact {
+		n.element("ResearchStudy.contact", i, v)
+	}
+	for i, v := range r.RelatedArtifact {
+		n.element("ResearchStudy.relatedArtifact", i, v)
+	}
+	for i, v := range r.Keyword {
+		n.element("ResearchStudy.keyword", i, v)
+	}
+	for i, v := range r.Location {
+		n.element("ResearchStudy.location", i, v)
+	}
+	n.primitive("ResearchStudy.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Enrollment {
+		n.element("ResearchStudy.enrollment", i, v)
+	}
+	n.element("ResearchStudy.period", -1, r.Period)
+	n.element("ResearchStudy.sponsor", -1, r.Sponsor)
+	n.element("ResearchStudy.principalInvestigator", -1, r.PrincipalInvestigator)
+	for i, v := range r.Site {
+		n.element("ResearchStudy.site", i, v)
+	}
+	n.element("ResearchStudy.reasonStopped", -1, r.ReasonStopped)
+	for i, v := range r.Note {
+		n.element("ResearchStudy.note", i, v)
+	}
+	for i, v := range r.Arm {
+		n.element("ResearchStudy.arm", i, v)
+	}
+	for i, v := range r.Objective {
+		n.element("ResearchStudy.objective", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ResearchStudy as BSON document with the structure of its FHIR JSON
+func (r ResearchStudy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ResearchStudy from a BSON document with the structure of its FHIR JSON
+func (r *ResearchStudy) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ResearchStudy as protobuf message ResearchStudy of fhir.proto
+func (r ResearchStudy) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ResearchStudy to the encoded message
+func (r ResearchStudy) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.Title != nil {
+		e.string(10, *r.Title)
+	}
+	for _, v := range r.Protocol {
+		e.message(11, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(12, v)
+	}
+	e.varint(13, r.Status.protoNumber())
+	if r.PrimaryPurposeType != nil {
+		e.message(14, *r.PrimaryPurposeType)
+	}
+	if r.Phase != nil {
+		e.message(15, *r.Phase)
+	}
+	for _, v := range r.Category {
+		e.message(16, v)
+	}
+	for _, v := range r.Focus {
+		e.message(17, v)
+	}
+	for _, v := range r.Condition {
+		e.message(18, v)
+	}
+	for _, v := range r.Contact {
+		e.message(19, v)
+	}
+	for _, v := range r.RelatedArtifact {
+		e.message(20, v)
+	}
+	for _, v := range r.Keyword {
+		e.message(21, v)
+	}
+	for _, v := range r.Location {
+		e.message(22, v)
+	}
+	if r.Description != nil {
+		e.string(23, *r.Description)
+	}
+	for _, v := range r.Enrollment {
+		e.message(24, v)
+	}
+	if r.Period != nil {
+		e.message(25, *r.Period)
+	}
+	if r.Sponsor != nil {
+		e.message(26, *r.Sponsor)
+	}
+	if r.PrincipalInvestigator != nil {
+		e.message(27, *r.PrincipalInvestigator)
+	}
+	for _, v := range r.Site {
+		e.message(28, v)
+	}
+	if r.ReasonStopped != nil {
+		e.message(29, *r.ReasonStopped)
+	}
+	for _, v := range r.Note {
+		e.message(30, v)
+	}
+	for _, v := range r.Arm {
+		e.message(31, v)
+	}
+	for _, v := range r.Objective {
+		e.message(32, v)
+	}
+	if r.IdElement != nil {
+		e.message(33, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(34, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(35, *r.LanguageElement)
+	}
+	if r.TitleElement != nil {
+		e.message(36, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(37, *r.StatusElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(38, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ResearchStudy from protobuf message ResearchStudy of fhir.proto
+func (r *ResearchStudy) UnmarshalProto(b []byte) error {
+	*r = ResearchStudy{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.string()
+			r.Title = &v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Protocol = append(r.Protocol, v)
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 13:
+			var v ResearchStudyStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.PrimaryPurposeType = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.Phase = &v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.Focus = append(r.Focus, v)
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.Condition = append(r.Condition, v)
+		case 19:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 20:
+			var v RelatedArtifact
+			d.message(&v)
+			r.RelatedArtifact = append(r.RelatedArtifact, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Keyword = append(r.Keyword, v)
+		case 22:
+			var v CodeableConcept
+			d.message(&v)
+			r.Location = append(r.Location, v)
+		case 23:
+			v := d.string()
+			r.Description = &v
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Enrollment = append(r.Enrollment, v)
+		case 25:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Sponsor = &v
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.PrincipalInvestigator = &v
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Site = append(r.Site, v)
+		case 29:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonStopped = &v
+		case 30:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 31:
+			var v ResearchStudyArm
+			d.message(&v)
+			r.Arm = append(r.Arm, v)
+		case 32:
+			var v ResearchStudyObjective
+			d.message(&v)
+			r.Objective = append(r.Objective, v)
+		case 33:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ResearchStudyArm as JSON into a byte slice
+func (r ResearchStudyArm) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ResearchStudyArm as JSON object with the members in the order of the fields
+func (r ResearchStudyArm) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ResearchStudyArm from JSON. Unknown members are ignored.
+func (r *ResearchStudyArm) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ResearchStudyArm
+func (r *ResearchStudyArm) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ResearchStudyArm which shares no memory with the original
+func (r ResearchStudyArm) DeepCopy() ResearchStudyArm {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ResearchStudyArm) Equal(other ResearchStudyArm) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ResearchStudyArm) EqualsDeep(other ResearchStudyArm) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ResearchStudyArm) EqualsShallow(other ResearchStudyArm) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ResearchStudyArm as FHIR XML
+func (r ResearchStudyArm) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ResearchStudyArm from FHIR XML
+func (r *ResearchStudyArm) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ResearchStudyArm as properties to the RDF node n
+func (r ResearchStudyArm) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ResearchStudy.arm.name", -1, r.Name, r.NameElement, "string")
+	n.element("ResearchStudy.arm.type", -1, r.Type)
+	n.primitive("ResearchStudy.arm.description", -1, r.Description, r.DescriptionElement, "string")
+}
+
+// MarshalBSON marshals the given ResearchStudyArm as BSON document with the structure of its FHIR JSON
+func (r ResearchStudyArm) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ResearchStudyArm from a BSON document with the structure of its FHIR JSON
+func (r *ResearchStudyArm) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ResearchStudyArm as protobuf message ResearchStudyArm of fhir.proto
+func (r ResearchStudyArm) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ResearchStudyArm to the encoded message
+func (r ResearchStudyArm) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.Description != nil {
+		e.string(6, *r.Description)
+	}
+	if r.NameElement != nil {
+		e.message(7, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(8, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ResearchStudyArm from protobuf message ResearchStudyArm of fhir.proto
+func (r *ResearchStudyArm) UnmarshalProto(b []byte) error {
+	*r = ResearchStudyArm{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			v := d.string()
+			r.Description = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given ResearchStudyObjective as JSON into a byte slice
+func (r ResearchStudyObjective) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ResearchStudyObjective as JSON object with the members in the order of the fields
+func (r ResearchStudyObjective) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ResearchStudyObjective from JSON. Unknown members are ignored.
+func (r *ResearchStudyObjective) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ResearchStudyObjective
+func (r *ResearchStudyObjective) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ResearchStudyObjective which shares no memory with the original
+func (r ResearchStudyObjective) DeepCopy() ResearchStudyObjective {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ResearchStudyObjective) Equal(other ResearchStudyObjective) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ResearchStudyObjective) EqualsDeep(other ResearchStudyObjective) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ResearchStudyObjective) EqualsShallow(other ResearchStudyObjective) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ResearchStudyObjective as FHIR XML
+func (r ResearchStudyObjective) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ResearchStudyObjective from FHIR XML
+func (r *ResearchStudyObjective) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ResearchStudyObjective as properties to the RDF node n
+func (r ResearchStudyObjective) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("ResearchStudy.objective.name", -1, r.Name, r.NameElement, "string")
+	n.element("ResearchStudy.objective.type", -1, r.Type)
+}
+
+// MarshalBSON marshals the given ResearchStudyObjective as BSON document with the structure of its FHIR JSON
+func (r ResearchStudyObjective) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ResearchStudyObjective from a BSON document with the structure of its FHIR JSON
+func (r *ResearchStudyObjective) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ResearchStudyObjective as protobuf message ResearchStudyObjective of fhir.proto
+func (r ResearchStudyObjective) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ResearchStudyObjective to the encoded message
+func (r ResearchStudyObjective) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != nil {
+		e.string(4, *r.Name)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.NameElement != nil {
+		e.message(6, *r.NameElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ResearchStudyObjective from protobuf message ResearchStudyObjective of fhir.proto
+func (r *ResearchStudyObjective) UnmarshalProto(b []byte) error {
+	*r = ResearchStudyObjective{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Name = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalResearchStudy unmarshals a ResearchStudy.
 func UnmarshalResearchStudy(b []byte) (ResearchStudy, error) {
 	var researchStudy ResearchStudy
-	if err := json.Unmarshal(b, &researchStudy); err != nil {
+	if err := researchStudy.UnmarshalJSON(b); err != nil {
 		return researchStudy, err
 	}
 	return researchStudy, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -43,8 +42,13 @@ const (
 func (code ResearchStudyStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ResearchStudyStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ResearchStudyStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "active":
 		*code = ResearchStudyStatusActive
@@ -73,6 +77,12 @@ func (code *ResearchStudyStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ResearchStudyStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ResearchStudyStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ResearchStudyStatus) String() string {
 	return code.Code()
 }
@@ -157,3 +167,59 @@ func (code ResearchStudyStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ResearchStudyStatus) protoNumber() uint64 {
+	switch code {
+	case ResearchStudyStatusActive:
+		return 1
+	case ResearchStudyStatusAdministrativelyCompleted:
+		return 2
+	case ResearchStudyStatusApproved:
+		return 3
+	case ResearchStudyStatusClosedToAccrual:
+		return 4
+	case ResearchStudyStatusClosedToAccrualAndIntervention:
+		return 5
+	case ResearchStudyStatusCompleted:
+		return 6
+	case ResearchStudyStatusDisapproved:
+		return 7
+	case ResearchStudyStatusInReview:
+		return 8
+	case ResearchStudyStatusTemporarilyClosedToAccrual:
+		return 9
+	case ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention:
+		return 10
+	case ResearchStudyStatusWithdrawn:
+		return 11
+	}
+	return 0
+}
+func (code *ResearchStudyStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ResearchStudyStatusActive
+	case 2:
+		*code = ResearchStudyStatusAdministrativelyCompleted
+	case 3:
+		*code = ResearchStudyStatusApproved
+	case 4:
+		*code = ResearchStudyStatusClosedToAccrual
+	case 5:
+		*code = ResearchStudyStatusClosedToAccrualAndIntervention
+	case 6:
+		*code = ResearchStudyStatusCompleted
+	case 7:
+		*code = ResearchStudyStatusDisapproved
+	case 8:
+		*code = ResearchStudyStatusInReview
+	case 9:
+		*code = ResearchStudyStatusTemporarilyClosedToAccrual
+	case 10:
+		*code = ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention
+	case 11:
+		*code = ResearchStudyStatusWithdrawn
+	default:
+		return false
+	}
+	return true
+}
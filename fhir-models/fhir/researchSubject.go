@@ -14,46 +14,1001 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ResearchSubject is documented here http://hl7.org/fhir/StructureDefinition/ResearchSubject
 type ResearchSubject struct {
-	Id                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            ResearchSubjectStatus `bson:"status" json:"status"`
-	Period            *Period               `bson:"period,omitempty" json:"period,omitempty"`
-	Study             Reference             `bson:"study" json:"study"`
-	Individual        Reference             `bson:"individual" json:"individual"`
-	AssignedArm       *string               `bson:"assignedArm,omitempty" json:"assignedArm,omitempty"`
-	ActualArm         *string               `bson:"actualArm,omitempty" json:"actualArm,omitempty"`
-	Consent           *Reference            `bson:"consent,omitempty" json:"consent,omitempty"`
-}
-type OtherResearchSubject ResearchSubject
+	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element              `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               ResearchSubjectStatus `bson:"status" json:"status"`
+	StatusElement        *Element              `bson:"_status,omitempty" json:"_status,omitempty"`
+	Period               *Period               `bson:"period,omitempty" json:"period,omitempty"`
+	Study                Reference             `bson:"study" json:"study"`
+	Individual           Reference             `bson:"individual" json:"individual"`
+	AssignedArm          *string               `bson:"assignedArm,omitempty" json:"assignedArm,omitempty"`
+	AssignedArmElement   *Element              `bson:"_assignedArm,omitempty" json:"_assignedArm,omitempty"`
+	ActualArm            *string               `bson:"actualArm,omitempty" json:"actualArm,omitempty"`
+	ActualArmElement     *Element              `bson:"_actualArm,omitempty" json:"_actualArm,omitempty"`
+	Consent              *Reference            `bson:"consent,omitempty" json:"consent,omitempty"`
+}
 
 // MarshalJSON marshals the given ResearchSubject as JSON into a byte slice
 func (r ResearchSubject) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherResearchSubject
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherResearchSubject: OtherResearchSubject(r),
-		ResourceType:         "ResearchSubject",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ResearchSubject as JSON object with the members in the order of the fields
+func (r ResearchSubject) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ResearchSubject\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	e.key("study")
+	r.Study.appendJSON(e)
+	e.key("individual")
+	r.Individual.appendJSON(e)
+	if r.AssignedArm != nil {
+		e.key("assignedArm")
+		e.string(*r.AssignedArm)
+	}
+	if r.AssignedArmElement != nil {
+		e.key("_assignedArm")
+		r.AssignedArmElement.appendJSON(e)
+	}
+	if r.ActualArm != nil {
+		e.key("actualArm")
+		e.string(*r.ActualArm)
+	}
+	if r.ActualArmElement != nil {
+		e.key("_actualArm")
+		r.ActualArmElement.appendJSON(e)
+	}
+	if r.Consent != nil {
+		e.key("consent")
+		r.Consent.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ResearchSubject from JSON. Unknown members are ignored.
+func (r *ResearchSubject) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ResearchSubject
+func (r *ResearchSubject) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "study":
+			r.Study.decodeJSON(d)
+		case "individual":
+			r.Individual.decodeJSON(d)
+		case "assignedArm":
+			if v, ok := d.string(); ok {
+				r.AssignedArm = &v
+			} else {
+				r.AssignedArm = nil
+			}
+		case "_assignedArm":
+			r.AssignedArmElement = d.element()
+		case "actualArm":
+			if v, ok := d.string(); ok {
+				r.ActualArm = &v
+			} else {
+				r.ActualArm = nil
+			}
+		case "_actualArm":
+			r.ActualArmElement = d.element()
+		case "consent":
+			if d.null() {
+				r.Consent = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Consent = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ResearchSubject which shares no memory with the original
+func (r ResearchSubject) DeepCopy() ResearchSubject {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	out.Study = r.Study.DeepCopy()
+	out.Individual = r.Individual.DeepCopy()
+	if r.AssignedArm != nil {
+		v := *r.AssignedArm
+		out.AssignedArm = &v
+	}
+	if r.AssignedArmElement != nil {
+		v := r.AssignedArmElement.DeepCopy()
+		out.AssignedArmElement = &v
+	}
+	if r.ActualArm != nil {
+		v := *r.ActualArm
+		out.ActualArm = &v
+	}
+	if r.ActualArmElement != nil {
+		v := r.ActualArmElement.DeepCopy()
+		out.ActualArmElement = &v
+	}
+	if r.Consent != nil {
+		v := r.Consent.DeepCopy()
+		out.Consent = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ResearchSubject) Equal(other ResearchSubject) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if !r.Study.Equal(other.Study) {
+		return false
+	}
+	if !r.Individual.Equal(other.Individual) {
+		return false
+	}
+	if (r.AssignedArm == nil) != (other.AssignedArm == nil) || r.AssignedArm != nil && *r.AssignedArm != *other.AssignedArm {
+		return false
+	}
+	if (r.AssignedArmElement == nil) != (other.AssignedArmElement == nil) || r.AssignedArmElement != nil && !r.AssignedArmElement.Equal(*other.AssignedArmElement) {
+		return false
+	}
+	if (r.ActualArm == nil) != (other.ActualArm == nil) || r.ActualArm != nil && *r.ActualArm != *other.ActualArm {
+		return false
+	}
+	if (r.ActualArmElement == nil) != (other.ActualArmElement == nil) || r.ActualArmElement != nil && !r.ActualArmElement.Equal(*other.ActualArmElement) {
+		return false
+	}
+	if (r.Consent == nil) != (other.Consent == nil) || r.Consent != nil && !r.Consent.Equal(*other.Consent) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ResearchSubject) EqualsDeep(other ResearchSubject) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !r.Study.EqualsDeep(other.Study) {
+		return false
+	}
+	if !r.Individual.EqualsDeep(other.Individual) {
+		return false
+	}
+	if (r.AssignedArm == nil) != (other.AssignedArm == nil) || r.AssignedArm != nil && !equivalentString(*r.AssignedArm, *other.AssignedArm) {
+		return false
+	}
+	if (r.AssignedArmElement == nil) != (other.AssignedArmElement == nil) || r.AssignedArmElement != nil && !r.AssignedArmElement.EqualsDeep(*other.AssignedArmElement) {
+		return false
+	}
+	if (r.ActualArm == nil) != (other.ActualArm == nil) || r.ActualArm != nil && !equivalentString(*r.ActualArm, *other.ActualArm) {
+		return false
+	}
+	if (r.ActualArmElement == nil) != (other.ActualArmElement == nil) || r.ActualArmElement != nil && !r.ActualArmElement.EqualsDeep(*other.ActualArmElement) {
+		return false
+	}
+	if (r.Consent == nil) != (other.Consent == nil) || r.Consent != nil && !r.Consent.EqualsDeep(*other.Consent) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ResearchSubject) EqualsShallow(other ResearchSubject) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.AssignedArm == nil) != (other.AssignedArm == nil) || r.AssignedArm != nil && !equivalentString(*r.AssignedArm, *other.AssignedArm) {
+		return false
+	}
+	if (r.ActualArm == nil) != (other.ActualArm == nil) || r.ActualArm != nil && !equivalentString(*r.ActualArm, *other.ActualArm) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ResearchSubject as FHIR XML
+func (r ResearchSubject) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ResearchSubject",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "study", r.Study); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "individual", r.Individual); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "assignedArm", r.AssignedArm, r.AssignedArmElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "actualArm", r.ActualArm, r.ActualArmElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "consent", r.Consent); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ResearchSubject from FHIR XML
+func (r *ResearchSubject) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v ResearchSubjectStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "study":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Study = v
+			case "individual":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Individual = v
+			case "assignedArm":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AssignedArm = &v
+				}
+				r.AssignedArmElement = element
+			case "actualArm":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ActualArm = &v
+				}
+				r.ActualArmElement = element
+			case "consent":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Consent = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ResearchSubject as properties to the RDF node n
+func (r ResearchSubject) turtle(n *turtleNode) {
+	n.resource("ResearchSubject", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ResearchSubject.identifier", i, v)
+	}
+	n.primitive("ResearchSubject.status", -1, r.Status, r.StatusElement, "code")
+	n.element("ResearchSubject.period", -1, r.Period)
+	n.element("ResearchSubject.study", -1, r.Study)
+	n.element("ResearchSubject.individual", -1, r.Individual)
+	n.primitive("ResearchSubject.assignedArm", -1, r.AssignedArm, r.AssignedArmElement, "string")
+	n.primitive("ResearchSubject.actualArm", -1, r.ActualArm, r.ActualArmElement, "string")
+	n.element("ResearchSubject.consent", -1, r.Consent)
+}
+
+// MarshalBSON marshals the given ResearchSubject as BSON document with the structure of its FHIR JSON
+func (r ResearchSubject) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ResearchSubject from a BSON document with the structure of its FHIR JSON
+func (r *ResearchSubject) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ResearchSubject as protobuf message ResearchSubject of fhir.proto
+func (r ResearchSubject) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ResearchSubject to the encoded message
+func (r ResearchSubject) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.Period != nil {
+		e.message(11, *r.Period)
+	}
+	e.message(12, r.Study)
+	e.message(13, r.Individual)
+	if r.AssignedArm != nil {
+		e.string(14, *r.AssignedArm)
+	}
+	if r.ActualArm != nil {
+		e.string(15, *r.ActualArm)
+	}
+	if r.Consent != nil {
+		e.message(16, *r.Consent)
+	}
+	if r.IdElement != nil {
+		e.message(17, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(18, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(19, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(20, *r.StatusElement)
+	}
+	if r.AssignedArmElement != nil {
+		e.message(21, *r.AssignedArmElement)
+	}
+	if r.ActualArmElement != nil {
+		e.message(22, *r.ActualArmElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ResearchSubject from protobuf message ResearchSubject of fhir.proto
+func (r *ResearchSubject) UnmarshalProto(b []byte) error {
+	*r = ResearchSubject{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v ResearchSubjectStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Study = v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Individual = v
+		case 14:
+			v := d.string()
+			r.AssignedArm = &v
+		case 15:
+			v := d.string()
+			r.ActualArm = &v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Consent = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.AssignedArmElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ActualArmElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalResearchSubject unmarshals a ResearchSubject.
 func UnmarshalResearchSubject(b []byte) (ResearchSubject, error) {
 	var researchSubject ResearchSubject
-	if err := json.Unmarshal(b, &researchSubject); err != nil {
+	if err := researchSubject.UnmarshalJSON(b); err != nil {
 		return researchSubject, err
 	}
 	return researchSubject, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -45,8 +44,13 @@ const (
 func (code ResearchSubjectStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ResearchSubjectStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ResearchSubjectStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "candidate":
 		*code = ResearchSubjectStatusCandidate
@@ -79,6 +83,12 @@ func (code *ResearchSubjectStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ResearchSubjectStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ResearchSubjectStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ResearchSubjectStatus) String() string {
 	return code.Code()
 }
@@ -175,3 +185,67 @@ func (code ResearchSubjectStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ResearchSubjectStatus) protoNumber() uint64 {
+	switch code {
+	case ResearchSubjectStatusCandidate:
+		return 1
+	case ResearchSubjectStatusEligible:
+		return 2
+	case ResearchSubjectStatusFollowUp:
+		return 3
+	case ResearchSubjectStatusIneligible:
+		return 4
+	case ResearchSubjectStatusNotRegistered:
+		return 5
+	case ResearchSubjectStatusOffStudy:
+		return 6
+	case ResearchSubjectStatusOnStudy:
+		return 7
+	case ResearchSubjectStatusOnStudyIntervention:
+		return 8
+	case ResearchSubjectStatusOnStudyObservation:
+		return 9
+	case ResearchSubjectStatusPendingOnStudy:
+		return 10
+	case ResearchSubjectStatusPotentialCandidate:
+		return 11
+	case ResearchSubjectStatusScreening:
+		return 12
+	case ResearchSubjectStatusWithdrawn:
+		return 13
+	}
+	return 0
+}
+func (code *ResearchSubjectStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ResearchSubjectStatusCandidate
+	case 2:
+		*code = ResearchSubjectStatusEligible
+	case 3:
+		*code = ResearchSubjectStatusFollowUp
+	case 4:
+		*code = ResearchSubjectStatusIneligible
+	case 5:
+		*code = ResearchSubjectStatusNotRegistered
+	case 6:
+		*code = ResearchSubjectStatusOffStudy
+	case 7:
+		*code = ResearchSubjectStatusOnStudy
+	case 8:
+		*code = ResearchSubjectStatusOnStudyIntervention
+	case 9:
+		*code = ResearchSubjectStatusOnStudyObservation
+	case 10:
+		*code = ResearchSubjectStatusPendingOnStudy
+	case 11:
+		*code = ResearchSubjectStatusPotentialCandidate
+	case 12:
+		*code = ResearchSubjectStatusScreening
+	case 13:
+		*code = ResearchSubjectStatusWithdrawn
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,3685 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+// THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
+// PLEASE DO NOT EDIT BY HAND
+
+// resourceTypes lists all resources in alphabetical order
+var resourceTypes = []string{"Account", "ActivityDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "CatalogEntry", "ChargeItem", "ChargeItemDefinition", "Claim", "ClaimResponse", "ClinicalImpression", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DeviceUseStatement", "DiagnosticReport", "DocumentManifest", "DocumentReference", "EffectEvidenceSynthesis", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "GraphDefinition", "Group", "GuidanceResponse", "HealthcareService", "ImagingStudy", "Immunization", "ImmunizationEvaluation", "ImmunizationRecommendation", "ImplementationGuide", "InsurancePlan", "Invoice", "Library", "Linkage", "List", "Location", "Measure", "MeasureReport", "Media", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationKnowledge", "MedicationRequest", "MedicationStatement", "MedicinalProduct", "MedicinalProductAuthorization", "MedicinalProductContraindication", "MedicinalProductIndication", "MedicinalProductIngredient", "MedicinalProductInteraction", "MedicinalProductManufactured", "MedicinalProductPackaged", "MedicinalProductPharmaceutical", "MedicinalProductUndesirableEffect", "MessageDefinition", "MessageHeader", "MolecularSequence", "NamingSystem", "NutritionOrder", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RelatedPerson", "RequestGroup", "ResearchDefinition", "ResearchElementDefinition", "ResearchStudy", "ResearchSubject", "RiskAssessment", "RiskEvidenceSynthesis", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "Substance", "SubstanceNucleicAcid", "SubstancePolymer", "SubstanceProtein", "SubstanceReferenceInformation", "SubstanceSourceMaterial", "SubstanceSpecification", "SupplyDelivery", "SupplyRequest", "Task", "TerminologyCapabilities", "TestReport", "TestScript", "ValueSet", "VerificationResult", "VisionPrescription"}
+
+// newResource returns a pointer to a new instance of the resource with the given type
+func newResource(resourceType string) (interface{}, bool) {
+	switch resourceType {
+	case "Account":
+		return &Account{}, true
+	case "ActivityDefinition":
+		return &ActivityDefinition{}, true
+	case "AdverseEvent":
+		return &AdverseEvent{}, true
+	case "AllergyIntolerance":
+		return &AllergyIntolerance{}, true
+	case "Appointment":
+		return &Appointment{}, true
+	case "AppointmentResponse":
+		return &AppointmentResponse{}, true
+	case "AuditEvent":
+		return &AuditEvent{}, true
+	case "Basic":
+		return &Basic{}, true
+	case "Binary":
+		return &Binary{}, true
+	case "BiologicallyDerivedProduct":
+		return &BiologicallyDerivedProduct{}, true
+	case "BodyStructure":
+		return &BodyStructure{}, true
+	case "Bundle":
+		return &Bundle{}, true
+	case "CapabilityStatement":
+		return &CapabilityStatement{}, true
+	case "CarePlan":
+		return &CarePlan{}, true
+	case "CareTeam":
+		return &CareTeam{}, true
+	case "CatalogEntry":
+		return &CatalogEntry{}, true
+	case "ChargeItem":
+		return &ChargeItem{}, true
+	case "ChargeItemDefinition":
+		return &ChargeItemDefinition{}, true
+	case "Claim":
+		return &Claim{}, true
+	case "ClaimResponse":
+		return &ClaimResponse{}, true
+	case "ClinicalImpression":
+		return &ClinicalImpression{}, true
+	case "CodeSystem":
+		return &CodeSystem{}, true
+	case "Communication":
+		return &Communication{}, true
+	case "CommunicationRequest":
+		return &CommunicationRequest{}, true
+	case "CompartmentDefinition":
+		return &CompartmentDefinition{}, true
+	case "Composition":
+		return &Composition{}, true
+	case "ConceptMap":
+		return &ConceptMap{}, true
+	case "Condition":
+		return &Condition{}, true
+	case "Consent":
+		return &Consent{}, true
+	case "Contract":
+		return &Contract{}, true
+	case "Coverage":
+		return &Coverage{}, true
+	case "CoverageEligibilityRequest":
+		return &CoverageEligibilityRequest{}, true
+	case "CoverageEligibilityResponse":
+		return &CoverageEligibilityResponse{}, true
+	case "DetectedIssue":
+		return &DetectedIssue{}, true
+	case "Device":
+		return &Device{}, true
+	case "DeviceDefinition":
+		return &DeviceDefinition{}, true
+	case "DeviceMetric":
+		return &DeviceMetric{}, true
+	case "DeviceRequest":
+		return &DeviceRequest{}, true
+	case "DeviceUseStatement":
+		return &DeviceUseStatement{}, true
+	case "DiagnosticReport":
+		return &DiagnosticReport{}, true
+	case "DocumentManifest":
+		return &DocumentManifest{}, true
+	case "DocumentReference":
+		return &DocumentReference{}, true
+	case "EffectEvidenceSynthesis":
+		return &EffectEvidenceSynthesis{}, true
+	case "Encounter":
+		return &Encounter{}, true
+	case "Endpoint":
+		return &Endpoint{}, true
+	case "EnrollmentRequest":
+		return &EnrollmentRequest{}, true
+	case "EnrollmentResponse":
+		return &EnrollmentResponse{}, true
+	case "EpisodeOfCare":
+		return &EpisodeOfCare{}, true
+	case "EventDefinition":
+		return &EventDefinition{}, true
+	case "Evidence":
+		return &Evidence{}, true
+	case "EvidenceVariable":
+		return &EvidenceVariable{}, true
+	case "ExampleScenario":
+		return &ExampleScenario{}, true
+	case "ExplanationOfBenefit":
+		return &ExplanationOfBenefit{}, true
+	case "FamilyMemberHistory":
+		return &FamilyMemberHistory{}, true
+	case "Flag":
+		return &Flag{}, true
+	case "Goal":
+		return &Goal{}, true
+	case "GraphDefinition":
+		return &GraphDefinition{}, true
+	case "Group":
+		return &Group{}, true
+	case "GuidanceResponse":
+		return &GuidanceResponse{}, true
+	case "HealthcareService":
+		return &HealthcareService{}, true
+	case "ImagingStudy":
+		return &ImagingStudy{}, true
+	case "Immunization":
+		return &Immunization{}, true
+	case "ImmunizationEvaluation":
+		return &ImmunizationEvaluation{}, true
+	case "ImmunizationRecommendation":
+		return &ImmunizationRecommendation{}, true
+	case "ImplementationGuide":
+		return &ImplementationGuide{}, true
+	case "InsurancePlan":
+		return &InsurancePlan{}, true
+	case "Invoice":
+		return &Invoice{}, true
+	case "Library":
+		return &Library{}, true
+	case "Linkage":
+		return &Linkage{}, true
+	case "List":
+		return &List{}, true
+	case "Location":
+		return &Location{}, true
+	case "Measure":
+		return &Measure{}, true
+	case "MeasureReport":
+		return &MeasureReport{}, true
+	case "Media":
+		return &Media{}, true
+	case "Medication":
+		return &Medication{}, true
+	case "MedicationAdministration":
+		return &MedicationAdministration{}, true
+	case "MedicationDispense":
+		return &MedicationDispense{}, true
+	case "MedicationKnowledge":
+		return &MedicationKnowledge{}, true
+	case "MedicationRequest":
+		return &MedicationRequest{}, true
+	case "MedicationStatement":
+		return &MedicationStatement{}, true
+	case "MedicinalProduct":
+		return &MedicinalProduct{}, true
+	case "MedicinalProductAuthorization":
+		return &MedicinalProductAuthorization{}, true
+	case "MedicinalProductContraindication":
+		return &MedicinalProductContraindication{}, true
+	case "MedicinalProductIndication":
+		return &MedicinalProductIndication{}, true
+	case "MedicinalProductIngredient":
+		return &MedicinalProductIngredient{}, true
+	case "MedicinalProductInteraction":
+		return &MedicinalProductInteraction{}, true
+	case "MedicinalProductManufactured":
+		return &MedicinalProductManufactured{}, true
+	case "MedicinalProductPackaged":
+		return &MedicinalProductPackaged{}, true
+	case "MedicinalProductPharmaceutical":
+		return &MedicinalProductPharmaceutical{}, true
+	case "MedicinalProductUndesirableEffect":
+		return &MedicinalProductUndesirableEffect{}, true
+	case "MessageDefinition":
+		return &MessageDefinition{}, true
+	case "MessageHeader":
+		return &MessageHeader{}, true
+	case "MolecularSequence":
+		return &MolecularSequence{}, true
+	case "NamingSystem":
+		return &NamingSystem{}, true
+	case "NutritionOrder":
+		return &NutritionOrder{}, true
+	case "Observation":
+		return &Observation{}, true
+	case "ObservationDefinition":
+		return &ObservationDefinition{}, true
+	case "OperationDefinition":
+		return &OperationDefinition{}, true
+	case "OperationOutcome":
+		return &OperationOutcome{}, true
+	case "Organization":
+		return &Organization{}, true
+	case "OrganizationAffiliation":
+		return &OrganizationAffiliation{}, true
+	case "Parameters":
+		return &Parameters{}, true
+	case "Patient":
+		return &Patient{}, true
+	case "PaymentNotice":
+		return &PaymentNotice{}, true
+	case "PaymentReconciliation":
+		return &PaymentReconciliation{}, true
+	case "Person":
+		return &Person{}, true
+	case "PlanDefinition":
+		return &PlanDefinition{}, true
+	case "Practitioner":
+		return &Practitioner{}, true
+	case "PractitionerRole":
+		return &PractitionerRole{}, true
+	case "Procedure":
+		return &Procedure{}, true
+	case "Provenance":
+		return &Provenance{}, true
+	case "Questionnaire":
+		return &Questionnaire{}, true
+	case "QuestionnaireResponse":
+		return &QuestionnaireResponse{}, true
+	case "RelatedPerson":
+		return &RelatedPerson{}, true
+	case "RequestGroup":
+		return &RequestGroup{}, true
+	case "ResearchDefinition":
+		return &ResearchDefinition{}, true
+	case "ResearchElementDefinition":
+		return &ResearchElementDefinition{}, true
+	case "ResearchStudy":
+		return &ResearchStudy{}, true
+	case "ResearchSubject":
+		return &ResearchSubject{}, true
+	case "RiskAssessment":
+		return &RiskAssessment{}, true
+	case "RiskEvidenceSynthesis":
+		return &RiskEvidenceSynthesis{}, true
+	case "Schedule":
+		return &Schedule{}, true
+	case "SearchParameter":
+		return &SearchParameter{}, true
+	case "ServiceRequest":
+		return &ServiceRequest{}, true
+	case "Slot":
+		return &Slot{}, true
+	case "Specimen":
+		return &Specimen{}, true
+	case "SpecimenDefinition":
+		return &SpecimenDefinition{}, true
+	case "StructureDefinition":
+		return &StructureDefinition{}, true
+	case "StructureMap":
+		return &StructureMap{}, true
+	case "Subscription":
+		return &Subscription{}, true
+	case "Substance":
+		return &Substance{}, true
+	case "SubstanceNucleicAcid":
+		return &SubstanceNucleicAcid{}, true
+	case "SubstancePolymer":
+		return &SubstancePolymer{}, true
+	case "SubstanceProtein":
+		return &SubstanceProtein{}, true
+	case "SubstanceReferenceInformation":
+		return &SubstanceReferenceInformation{}, true
+	case "SubstanceSourceMaterial":
+		return &SubstanceSourceMaterial{}, true
+	case "SubstanceSpecification":
+		return &SubstanceSpecification{}, true
+	case "SupplyDelivery":
+		return &SupplyDelivery{}, true
+	case "SupplyRequest":
+		return &SupplyRequest{}, true
+	case "Task":
+		return &Task{}, true
+	case "TerminologyCapabilities":
+		return &TerminologyCapabilities{}, true
+	case "TestReport":
+		return &TestReport{}, true
+	case "TestScript":
+		return &TestScript{}, true
+	case "ValueSet":
+		return &ValueSet{}, true
+	case "VerificationResult":
+		return &VerificationResult{}, true
+	case "VisionPrescription":
+		return &VisionPrescription{}, true
+	}
+	return nil, false
+}
+
+// primitiveTypeCodes maps the fields of primitive elements, named by struct and field, to their FHIR type code
+var primitiveTypeCodes = map[string]string{
+	"Account.Description":                                                "string",
+	"Account.Id":                                                         "string",
+	"Account.ImplicitRules":                                              "string",
+	"Account.Language":                                                   "string",
+	"Account.Name":                                                       "string",
+	"Account.Status":                                                     "code",
+	"AccountCoverage.Id":                                                 "string",
+	"AccountCoverage.Priority":                                           "positiveInt",
+	"AccountGuarantor.Id":                                                "string",
+	"AccountGuarantor.OnHold":                                            "boolean",
+	"ActivityDefinition.ApprovalDate":                                    "date",
+	"ActivityDefinition.Copyright":                                       "string",
+	"ActivityDefinition.Date":                                            "dateTime",
+	"ActivityDefinition.Description":                                     "string",
+	"ActivityDefinition.DoNotPerform":                                    "boolean",
+	"ActivityDefinition.Experimental":                                    "boolean",
+	"ActivityDefinition.Id":                                              "string",
+	"ActivityDefinition.ImplicitRules":                                   "string",
+	"ActivityDefinition.Intent":                                          "code",
+	"ActivityDefinition.Kind":                                            "code",
+	"ActivityDefinition.Language":                                        "string",
+	"ActivityDefinition.LastReviewDate":                                  "date",
+	"ActivityDefinition.Library":                                         "string",
+	"ActivityDefinition.Name":                                            "string",
+	"ActivityDefinition.Priority":                                        "code",
+	"ActivityDefinition.Profile":                                         "string",
+	"ActivityDefinition.Publisher":                                       "string",
+	"ActivityDefinition.Purpose":                                         "string",
+	"ActivityDefinition.Status":                                          "code",
+	"ActivityDefinition.Subtitle":                                        "string",
+	"ActivityDefinition.TimingDateTime":                                  "dateTime",
+	"ActivityDefinition.Title":                                           "string",
+	"ActivityDefinition.Transform":                                       "string",
+	"ActivityDefinition.Url":                                             "string",
+	"ActivityDefinition.Usage":                                           "string",
+	"ActivityDefinition.Version":                                         "string",
+	"ActivityDefinitionDynamicValue.Id":                                  "string",
+	"ActivityDefinitionDynamicValue.Path":                                "string",
+	"ActivityDefinitionParticipant.Id":                                   "string",
+	"ActivityDefinitionParticipant.Type":                                 "code",
+	"Address.City":                                                       "string",
+	"Address.Country":                                                    "string",
+	"Address.District":                                                   "string",
+	"Address.Id":                                                         "string",
+	"Address.Line":                                                       "string",
+	"Address.PostalCode":                                                 "string",
+	"Address.State":                                                      "string",
+	"Address.Text":                                                       "string",
+	"Address.Type":                                                       "code",
+	"Address.Use":                                                        "code",
+	"AdverseEvent.Actuality":                                             "code",
+	"AdverseEvent.Date":                                                  "dateTime",
+	"AdverseEvent.Detected":                                              "dateTime",
+	"AdverseEvent.Id":                                                    "string",
+	"AdverseEvent.ImplicitRules":                                         "string",
+	"AdverseEvent.Language":                                              "string",
+	"AdverseEvent.RecordedDate":                                          "dateTime",
+	"AdverseEventSuspectEntity.Id":                                       "string",
+	"AdverseEventSuspectEntityCausality.Id":                              "string",
+	"AdverseEventSuspectEntityCausality.ProductRelatedness":              "string",
+	"Age.Code":                                                           "string",
+	"Age.Comparator":                                                     "code",
+	"Age.Id":                                                             "string",
+	"Age.System":                                                         "string",
+	"Age.Unit":                                                           "string",
+	"Age.Value":                                                          "decimal",
+	"AllergyIntolerance.Category":                                        "code",
+	"AllergyIntolerance.Criticality":                                     "code",
+	"AllergyIntolerance.Id":                                              "string",
+	"AllergyIntolerance.ImplicitRules":                                   "string",
+	"AllergyIntolerance.Language":                                        "string",
+	"AllergyIntolerance.LastOccurrence":                                  "dateTime",
+	"AllergyIntolerance.OnsetDateTime":                                   "dateTime",
+	"AllergyIntolerance.OnsetString":                                     "string",
+	"AllergyIntolerance.RecordedDate":                                    "dateTime",
+	"AllergyIntolerance.Type":                                            "code",
+	"AllergyIntoleranceReaction.Description":                             "string",
+	"AllergyIntoleranceReaction.Id":                                      "string",
+	"AllergyIntoleranceReaction.Onset":                                   "dateTime",
+	"AllergyIntoleranceReaction.Severity":                                "code",
+	"Annotation.AuthorString":                                            "string",
+	"Annotation.Id":                                                      "string",
+	"Annotation.Text":                                                    "string",
+	"Annotation.Time":                                                    "dateTime",
+	"Appointment.Comment":                                                "string",
+	"Appointment.Created":                                                "dateTime",
+	"Appointment.Description":                                            "string",
+	"Appointment.End":                                                    "instant",
+	"Appointment.Id":                                                     "string",
+	"Appointment.ImplicitRules":                                          "string",
+	"Appointment.Language":                                               "string",
+	"Appointment.MinutesDuration":                                        "positiveInt",
+	"Appointment.PatientInstruction":                                     "string",
+	"Appointment.Priority":                                               "unsignedInt",
+	"Appointment.Start":                                                  "instant",
+	"Appointment.Status":                                                 "code",
+	"AppointmentParticipant.Id":                                          "string",
+	"AppointmentParticipant.Required":                                    "code",
+	"AppointmentParticipant.Status":                                      "code",
+	"AppointmentResponse.Comment":                                        "string",
+	"AppointmentResponse.End":                                            "instant",
+	"AppointmentResponse.Id":                                             "string",
+	"AppointmentResponse.ImplicitRules":                                  "string",
+	"AppointmentResponse.Language":                                       "string",
+	"AppointmentResponse.ParticipantStatus":                              "code",
+	"AppointmentResponse.Start":                                          "instant",
+	"Attachment.ContentType":                                             "string",
+	"Attachment.Creation":                                                "dateTime",
+	"Attachment.Data":                                                    "base64Binary",
+	"Attachment.Hash":                                                    "base64Binary",
+	"Attachment.Id":                                                      "string",
+	"Attachment.Language":                                                "string",
+	"Attachment.Size":                                                    "unsignedInt",
+	"Attachment.Title":                                                   "string",
+	"Attachment.Url":                                                     "string",
+	"AuditEvent.Action":                                                  "code",
+	"AuditEvent.Id":                                                      "string",
+	"AuditEvent.ImplicitRules":                                           "string",
+	"AuditEvent.Language":                                                "string",
+	"AuditEvent.Outcome":                                                 "code",
+	"AuditEvent.OutcomeDesc":                                             "string",
+	"AuditEvent.Recorded":                                                "instant",
+	"AuditEventAgent.AltId":                                              "string",
+	"AuditEventAgent.Id":                                                 "string",
+	"AuditEventAgent.Name":                                               "string",
+	"AuditEventAgent.Policy":                                             "string",
+	"AuditEventAgent.Requestor":                                          "boolean",
+	"AuditEventAgentNetwork.Address":                                     "string",
+	"AuditEventAgentNetwork.Id":                                          "string",
+	"AuditEventAgentNetwork.Type":                                        "code",
+	"AuditEventEntity.Description":                                       "string",
+	"AuditEventEntity.Id":                                                "string",
+	"AuditEventEntity.Name":                                              "string",
+	"AuditEventEntity.Query":                                             "base64Binary",
+	"AuditEventEntityDetail.Id":                                          "string",
+	"AuditEventEntityDetail.Type":                                        "string",
+	"AuditEventEntityDetail.ValueBase64Binary":                           "base64Binary",
+	"AuditEventEntityDetail.ValueString":                                 "string",
+	"AuditEventSource.Id":                                                "string",
+	"AuditEventSource.Site":                                              "string",
+	"Basic.Created":                                                      "date",
+	"Basic.Id":                                                           "string",
+	"Basic.ImplicitRules":                                                "string",
+	"Basic.Language":                                                     "string",
+	"Binary.ContentType":                                                 "string",
+	"Binary.Data":                                                        "base64Binary",
+	"Binary.Id":                                                          "string",
+	"Binary.ImplicitRules":                                               "string",
+	"Binary.Language":                                                    "string",
+	"BiologicallyDerivedProduct.Id":                                      "string",
+	"BiologicallyDerivedProduct.ImplicitRules":                           "string",
+	"BiologicallyDerivedProduct.Language":                                "string",
+	"BiologicallyDerivedProduct.ProductCategory":                         "code",
+	"BiologicallyDerivedProduct.Quantity":                                "integer",
+	"BiologicallyDerivedProduct.Status":                                  "code",
+	"BiologicallyDerivedProductCollection.CollectedDateTime":             "dateTime",
+	"BiologicallyDerivedProductCollection.Id":                            "string",
+	"BiologicallyDerivedProductManipulation.Description":                 "string",
+	"BiologicallyDerivedProductManipulation.Id":                          "string",
+	"BiologicallyDerivedProductManipulation.TimeDateTime":                "dateTime",
+	"BiologicallyDerivedProductProcessing.Description":                   "string",
+	"BiologicallyDerivedProductProcessing.Id":                            "string",
+	"BiologicallyDerivedProductProcessing.TimeDateTime":                  "dateTime",
+	"BiologicallyDerivedProductStorage.Description":                      "string",
+	"BiologicallyDerivedProductStorage.Id":                               "string",
+	"BiologicallyDerivedProductStorage.Scale":                            "code",
+	"BiologicallyDerivedProductStorage.Temperature":                      "decimal",
+	"BodyStructure.Active":                                               "boolean",
+	"BodyStructure.Description":                                          "string",
+	"BodyStructure.Id":                                                   "string",
+	"BodyStructure.ImplicitRules":                                        "string",
+	"BodyStructure.Language":                                             "string",
+	"Bundle.Id":                                                          "string",
+	"Bundle.ImplicitRules":                                               "string",
+	"Bundle.Language":                                                    "string",
+	"Bundle.Timestamp":                                                   "instant",
+	"Bundle.Total":                                                       "unsignedInt",
+	"Bundle.Type":                                                        "code",
+	"BundleEntry.FullUrl":                                                "string",
+	"BundleEntry.Id":                                                     "string",
+	"BundleEntryRequest.Id":                                              "string",
+	"BundleEntryRequest.IfMatch":                                         "string",
+	"BundleEntryRequest.IfModifiedSince":                                 "instant",
+	"BundleEntryRequest.IfNoneExist":                                     "string",
+	"BundleEntryRequest.IfNoneMatch":                                     "string",
+	"BundleEntryRequest.Method":                                          "code",
+	"BundleEntryRequest.Url":                                             "string",
+	"BundleEntryResponse.Etag":                                           "string",
+	"BundleEntryResponse.Id":                                             "string",
+	"BundleEntryResponse.LastModified":                                   "instant",
+	"BundleEntryResponse.Location":                                       "string",
+	"BundleEntryResponse.Status":                                         "string",
+	"BundleEntrySearch.Id":                                               "string",
+	"BundleEntrySearch.Mode":                                             "code",
+	"BundleEntrySearch.Score":                                            "decimal",
+	"BundleLink.Id":                                                      "string",
+	"BundleLink.Relation":                                                "string",
+	"BundleLink.Url":                                                     "string",
+	"CapabilityStatement.Copyright":                                      "string",
+	"CapabilityStatement.Date":                                           "dateTime",
+	"CapabilityStatement.Description":                                    "string",
+	"CapabilityStatement.Experimental":                                   "boolean",
+	"CapabilityStatement.FhirVersion":                                    "code",
+	"CapabilityStatement.Format":                                         "string",
+	"CapabilityStatement.Id":                                             "string",
+	"CapabilityStatement.ImplementationGuide":                            "string",
+	"CapabilityStatement.ImplicitRules":                                  "string",
+	"CapabilityStatement.Imports":                                        "string",
+	"CapabilityStatement.Instantiates":                                   "string",
+	"CapabilityStatement.Kind":                                           "code",
+	"CapabilityStatement.Language":                                       "string",
+	"CapabilityStatement.Name":                                           "string",
+	"CapabilityStatement.PatchFormat":                                    "string",
+	"CapabilityStatement.Publisher":                                      "string",
+	"CapabilityStatement.Purpose":                                        "string",
+	"CapabilityStatement.Status":                                         "code",
+	"CapabilityStatement.Title":                                          "string",
+	"CapabilityStatement.Url":                                            "string",
+	"CapabilityStatement.Version":                                        "string",
+	"CapabilityStatementDocument.Documentation":                          "string",
+	"CapabilityStatementDocument.Id":                                     "string",
+	"CapabilityStatementDocument.Mode":                                   "code",
+	"CapabilityStatementDocument.Profile":                                "string",
+	"CapabilityStatementImplementation.Description":                      "string",
+	"CapabilityStatementImplementation.Id":                               "string",
+	"CapabilityStatementImplementation.Url":                              "string",
+	"CapabilityStatementMessaging.Documentation":                         "string",
+	"CapabilityStatementMessaging.Id":                                    "string",
+	"CapabilityStatementMessaging.ReliableCache":                         "unsignedInt",
+	"CapabilityStatementMessagingEndpoint.Address":                       "string",
+	"CapabilityStatementMessagingEndpoint.Id":                            "string",
+	"CapabilityStatementMessagingSupportedMessage.Definition":            "string",
+	"CapabilityStatementMessagingSupportedMessage.Id":                    "string",
+	"CapabilityStatementMessagingSupportedMessage.Mode":                  "code",
+	"CapabilityStatementRest.Compartment":                                "string",
+	"CapabilityStatementRest.Documentation":                              "string",
+	"CapabilityStatementRest.Id":                                         "string",
+	"CapabilityStatementRest.Mode":                                       "code",
+	"CapabilityStatementRestInteraction.Code":                            "code",
+	"CapabilityStatementRestInteraction.Documentation":                   "string",
+	"CapabilityStatementRestInteraction.Id":                              "string",
+	"CapabilityStatementRestResource.ConditionalCreate":                  "boolean",
+	"CapabilityStatementRestResource.ConditionalDelete":                  "code",
+	"CapabilityStatementRestResource.ConditionalRead":                    "code",
+	"CapabilityStatementRestResource.ConditionalUpdate":                  "boolean",
+	"CapabilityStatementRestResource.Documentation":                      "string",
+	"CapabilityStatementRestResource.Id":                                 "string",
+	"CapabilityStatementRestResource.Profile":                            "string",
+	"CapabilityStatementRestResource.ReadHistory":                        "boolean",
+	"CapabilityStatementRestResource.ReferencePolicy":                    "code",
+	"CapabilityStatementRestResource.SearchInclude":                      "string",
+	"CapabilityStatementRestResource.SearchRevInclude":                   "string",
+	"CapabilityStatementRestResource.SupportedProfile":                   "string",
+	"CapabilityStatementRestResource.Type":                               "code",
+	"CapabilityStatementRestResource.UpdateCreate":                       "boolean",
+	"CapabilityStatementRestResource.Versioning":                         "code",
+	"CapabilityStatementRestResourceInteraction.Code":                    "code",
+	"CapabilityStatementRestResourceInteraction.Documentation":           "string",
+	"CapabilityStatementRestResourceInteraction.Id":                      "string",
+	"CapabilityStatementRestResourceOperation.Definition":                "string",
+	"CapabilityStatementRestResourceOperation.Documentation":             "string",
+	"CapabilityStatementRestResourceOperation.Id":                        "string",
+	"CapabilityStatementRestResourceOperation.Name":                      "string",
+	"CapabilityStatementRestResourceSearchParam.Definition":              "string",
+	"CapabilityStatementRestResourceSearchParam.Documentation":           "string",
+	"CapabilityStatementRestResourceSearchParam.Id":                      "string",
+	"CapabilityStatementRestResourceSearchParam.Name":                    "string",
+	"CapabilityStatementRestResourceSearchParam.Type":                    "code",
+	"CapabilityStatementRestSecurity.Cors":                               "boolean",
+	"CapabilityStatementRestSecurity.Description":                        "string",
+	"CapabilityStatementRestSecurity.Id":                                 "string",
+	"CapabilityStatementSoftware.Id":                                     "string",
+	"CapabilityStatementSoftware.Name":                                   "string",
+	"CapabilityStatementSoftware.ReleaseDate":                            "dateTime",
+	"CapabilityStatementSoftware.Version":                                "string",
+	"CarePlan.Created":                                                   "dateTime",
+	"CarePlan.Description":                                               "string",
+	"CarePlan.Id":                                                        "string",
+	"CarePlan.ImplicitRules":                                             "string",
+	"CarePlan.InstantiatesCanonical":                                     "string",
+	"CarePlan.InstantiatesUri":                                           "string",
+	"CarePlan.Intent":                                                    "code",
+	"CarePlan.Language":                                                  "string",
+	"CarePlan.Status":                                                    "code",
+	"CarePlan.Title":                                                     "string",
+	"CarePlanActivity.Id":                                                "string",
+	"CarePlanActivityDetail.Description":                                 "string",
+	"CarePlanActivityDetail.DoNotPerform":                                "boolean",
+	"CarePlanActivityDetail.Id":                                          "string",
+	"CarePlanActivityDetail.InstantiatesCanonical":                       "string",
+	"CarePlanActivityDetail.InstantiatesUri":                             "string",
+	"CarePlanActivityDetail.Kind":                                        "code",
+	"CarePlanActivityDetail.ScheduledString":                             "string",
+	"CarePlanActivityDetail.Status":                                      "code",
+	"CareTeam.Id":                                                        "string",
+	"CareTeam.ImplicitRules":                                             "string",
+	"CareTeam.Language":                                                  "string",
+	"CareTeam.Name":                                                      "string",
+	"CareTeam.Status":                                                    "code",
+	"CareTeamParticipant.Id":                                             "string",
+	"CatalogEntry.Id":                                                    "string",
+	"CatalogEntry.ImplicitRules":                                         "string",
+	"CatalogEntry.Language":                                              "string",
+	"CatalogEntry.LastUpdated":                                           "dateTime",
+	"CatalogEntry.Orderable":                                             "boolean",
+	"CatalogEntry.Status":                                                "code",
+	"CatalogEntry.ValidTo":                                               "string",
+	"CatalogEntryRelatedEntry.Id":                                        "string",
+	"CatalogEntryRelatedEntry.Relationtype":                              "code",
+	"ChargeItem.DefinitionCanonical":                                     "string",
+	"ChargeItem.DefinitionUri":                                           "string",
+	"ChargeItem.EnteredDate":                                             "dateTime",
+	"ChargeItem.FactorOverride":                                          "decimal",
+	"ChargeItem.Id":                                                      "string",
+	"ChargeItem.ImplicitRules":                                           "string",
+	"ChargeItem.Language":                                                "string",
+	"ChargeItem.OccurrenceDateTime":                                      "dateTime",
+	"ChargeItem.OverrideReason":                                          "string",
+	"ChargeItem.Status":                                                  "code",
+	"ChargeItemDefinition.ApprovalDate":                                  "date",
+	"ChargeItemDefinition.Copyright":                                     "string",
+	"ChargeItemDefinition.Date":                                          "dateTime",
+	"ChargeItemDefinition.DerivedFromUri":                                "string",
+	"ChargeItemDefinition.Description":                                   "string",
+	"ChargeItemDefinition.Experimental":                                  "boolean",
+	"ChargeItemDefinition.Id":                                            "string",
+	"ChargeItemDefinition.ImplicitRules":                                 "string",
+	"ChargeItemDefinition.Language":                                      "string",
+	"ChargeItemDefinition.LastReviewDate":                                "date",
+	"ChargeItemDefinition.PartOf":                                        "string",
+	"ChargeItemDefinition.Publisher":                                     "string",
+	"ChargeItemDefinition.Replaces":                                      "string",
+	"ChargeItemDefinition.Status":                                        "code",
+	"ChargeItemDefinition.Title":                                         "string",
+	"ChargeItemDefinition.Url":                                           "string",
+	"ChargeItemDefinition.Version":                                       "string",
+	"ChargeItemDefinitionApplicability.Description":                      "string",
+	"ChargeItemDefinitionApplicability.Expression":                       "string",
+	"ChargeItemDefinitionApplicability.Id":                               "string",
+	"ChargeItemDefinitionApplicability.Language":                         "string",
+	"ChargeItemDefinitionPropertyGroup.Id":                               "string",
+	"ChargeItemDefinitionPropertyGroupPriceComponent.Factor":             "decimal",
+	"ChargeItemDefinitionPropertyGroupPriceComponent.Id":                 "string",
+	"ChargeItemDefinitionPropertyGroupPriceComponent.Type":               "code",
+	"ChargeItemPerformer.Id":                                             "string",
+	"Claim.Created":                                                      "dateTime",
+	"Claim.Id":                                                           "string",
+	"Claim.ImplicitRules":                                                "string",
+	"Claim.Language":                                                     "string",
+	"Claim.Status":                                                       "code",
+	"Claim.Use":                                                          "code",
+	"ClaimAccident.Date":                                                 "date",
+	"ClaimAccident.Id":                                                   "string",
+	"ClaimCareTeam.Id":                                                   "string",
+	"ClaimCareTeam.Responsible":                                          "boolean",
+	"ClaimCareTeam.Sequence":                                             "positiveInt",
+	"ClaimDiagnosis.Id":                                                  "string",
+	"ClaimDiagnosis.Sequence":                                            "positiveInt",
+	"ClaimInsurance.BusinessArrangement":                                 "string",
+	"ClaimInsurance.Focal":                                               "boolean",
+	"ClaimInsurance.Id":                                                  "string",
+	"ClaimInsurance.PreAuthRef":                                          "string",
+	"ClaimInsurance.Sequence":                                            "positiveInt",
+	"ClaimItem.CareTeamSequence":                                         "positiveInt",
+	"ClaimItem.DiagnosisSequence":                                        "positiveInt",
+	"ClaimItem.Factor":                                                   "decimal",
+	"ClaimItem.Id":                                                       "string",
+	"ClaimItem.InformationSequence":                                      "positiveInt",
+	"ClaimItem.ProcedureSequence":                                        "positiveInt",
+	"ClaimItem.Sequence":                                                 "positiveInt",
+	"ClaimItem.ServicedDate":                                             "date",
+	"ClaimItemDetail.Factor":                                             "decimal",
+	"ClaimItemDetail.Id":                                                 "string",
+	"ClaimItemDetail.Sequence":                                           "positiveInt",
+	"ClaimItemDetailSubDetail.Factor":                                    "decimal",
+	"ClaimItemDetailSubDetail.Id":                                        "string",
+	"ClaimItemDetailSubDetail.Sequence":                                  "positiveInt",
+	"ClaimPayee.Id":                                                      "string",
+	"ClaimProcedure.Date":                                                "dateTime",
+	"ClaimProcedure.Id":                                                  "string",
+	"ClaimProcedure.Sequence":                                            "positiveInt",
+	"ClaimRelated.Id":                                                    "string",
+	"ClaimResponse.Created":                                              "dateTime",
+	"ClaimResponse.Disposition":                                          "string",
+	"ClaimResponse.Id":                                                   "string",
+	"ClaimResponse.ImplicitRules":                                        "string",
+	"ClaimResponse.Language":                                             "string",
+	"ClaimResponse.Outcome":                                              "code",
+	"ClaimResponse.PreAuthRef":                                           "string",
+	"ClaimResponse.Status":                                               "code",
+	"ClaimResponse.Use":                                                  "code",
+	"ClaimResponseAddItem.DetailSequence":                                "positiveInt",
+	"ClaimResponseAddItem.Factor":                                        "decimal",
+	"ClaimResponseAddItem.Id":                                            "string",
+	"ClaimResponseAddItem.ItemSequence":                                  "positiveInt",
+	"ClaimResponseAddItem.NoteNumber":                                    "positiveInt",
+	"ClaimResponseAddItem.ServicedDate":                                  "date",
+	"ClaimResponseAddItem.SubdetailSequence":                             "positiveInt",
+	"ClaimResponseAddItemDetail.Factor":                                  "decimal",
+	"ClaimResponseAddItemDetail.Id":                                      "string",
+	"ClaimResponseAddItemDetail.NoteNumber":                              "positiveInt",
+	"ClaimResponseAddItemDetailSubDetail.Factor":                         "decimal",
+	"ClaimResponseAddItemDetailSubDetail.Id":                             "string",
+	"ClaimResponseAddItemDetailSubDetail.NoteNumber":                     "positiveInt",
+	"ClaimResponseError.DetailSequence":                                  "positiveInt",
+	"ClaimResponseError.Id":                                              "string",
+	"ClaimResponseError.ItemSequence":                                    "positiveInt",
+	"ClaimResponseError.SubDetailSequence":                               "positiveInt",
+	"ClaimResponseInsurance.BusinessArrangement":                         "string",
+	"ClaimResponseInsurance.Focal":                                       "boolean",
+	"ClaimResponseInsurance.Id":                                          "string",
+	"ClaimResponseInsurance.Sequence":                                    "positiveInt",
+	"ClaimResponseItem.Id":                                               "string",
+	"ClaimResponseItem.ItemSequence":                                     "positiveInt",
+	"ClaimResponseItem.NoteNumber":                                       "positiveInt",
+	"ClaimResponseItemAdjudication.Id":                                   "string",
+	"ClaimResponseItemAdjudication.Value":                                "decimal",
+	"ClaimResponseItemDetail.DetailSequence":                             "positiveInt",
+	"ClaimResponseItemDetail.Id":                                         "string",
+	"ClaimResponseItemDetail.NoteNumber":                                 "positiveInt",
+	"ClaimResponseItemDetailSubDetail.Id":                                "string",
+	"ClaimResponseItemDetailSubDetail.NoteNumber":                        "positiveInt",
+	"ClaimResponseItemDetailSubDetail.SubDetailSequence":                 "positiveInt",
+	"ClaimResponsePayment.Date":                                          "date",
+	"ClaimResponsePayment.Id":                                            "string",
+	"ClaimResponseProcessNote.Id":                                        "string",
+	"ClaimResponseProcessNote.Number":                                    "positiveInt",
+	"ClaimResponseProcessNote.Text":                                      "string",
+	"ClaimResponseProcessNote.Type":                                      "code",
+	"ClaimResponseTotal.Id":                                              "string",
+	"ClaimSupportingInfo.Id":                                             "string",
+	"ClaimSupportingInfo.Sequence":                                       "positiveInt",
+	"ClaimSupportingInfo.TimingDate":                                     "date",
+	"ClaimSupportingInfo.ValueBoolean":                                   "boolean",
+	"ClaimSupportingInfo.ValueString":                                    "string",
+	"ClinicalImpression.Date":                                            "dateTime",
+	"ClinicalImpression.Description":                                     "string",
+	"ClinicalImpression.EffectiveDateTime":                               "dateTime",
+	"ClinicalImpression.Id":                                              "string",
+	"ClinicalImpression.ImplicitRules":                                   "string",
+	"ClinicalImpression.Language":                                        "string",
+	"ClinicalImpression.Protocol":                                        "string",
+	"ClinicalImpression.Status":                                          "code",
+	"ClinicalImpression.Summary":                                         "string",
+	"ClinicalImpressionFinding.Basis":                                    "string",
+	"ClinicalImpressionFinding.Id":                                       "string",
+	"ClinicalImpressionInvestigation.Id":                                 "string",
+	"CodeSystem.CaseSensitive":                                           "boolean",
+	"CodeSystem.Compositional":                                           "boolean",
+	"CodeSystem.Content":                                                 "code",
+	"CodeSystem.Copyright":                                               "string",
+	"CodeSystem.Count":                                                   "unsignedInt",
+	"CodeSystem.Date":                                                    "dateTime",
+	"CodeSystem.Description":                                             "string",
+	"CodeSystem.Experimental":                                            "boolean",
+	"CodeSystem.HierarchyMeaning":                                        "code",
+	"CodeSystem.Id":                                                      "string",
+	"CodeSystem.ImplicitRules":                                           "string",
+	"CodeSystem.Language":                                                "string",
+	"CodeSystem.Name":                                                    "string",
+	"CodeSystem.Publisher":                                               "string",
+	"CodeSystem.Purpose":                                                 "string",
+	"CodeSystem.Status":                                                  "code",
+	"CodeSystem.Supplements":                                             "string",
+	"CodeSystem.Title":                                                   "string",
+	"CodeSystem.Url":                                                     "string",
+	"CodeSystem.ValueSet":                                                "string",
+	"CodeSystem.Version":                                                 "string",
+	"CodeSystem.VersionNeeded":                                           "boolean",
+	"CodeSystemConcept.Code":                                             "string",
+	"CodeSystemConcept.Definition":                                       "string",
+	"CodeSystemConcept.Display":                                          "string",
+	"CodeSystemConcept.Id":                                               "string",
+	"CodeSystemConceptDesignation.Id":                                    "string",
+	"CodeSystemConceptDesignation.Language":                              "string",
+	"CodeSystemConceptDesignation.Value":                                 "string",
+	"CodeSystemConceptProperty.Code":                                     "string",
+	"CodeSystemConceptProperty.Id":                                       "string",
+	"CodeSystemConceptProperty.ValueBoolean":                             "boolean",
+	"CodeSystemConceptProperty.ValueCode":                                "code",
+	"CodeSystemConceptProperty.ValueDateTime":                            "dateTime",
+	"CodeSystemConceptProperty.ValueDecimal":                             "decimal",
+	"CodeSystemConceptProperty.ValueInteger":                             "integer",
+	"CodeSystemConceptProperty.ValueString":                              "string",
+	"CodeSystemFilter.Code":                                              "string",
+	"CodeSystemFilter.Description":                                       "string",
+	"CodeSystemFilter.Id":                                                "string",
+	"CodeSystemFilter.Operator":                                          "code",
+	"CodeSystemFilter.Value":                                             "string",
+	"CodeSystemProperty.Code":                                            "string",
+	"CodeSystemProperty.Description":                                     "string",
+	"CodeSystemProperty.Id":                                              "string",
+	"CodeSystemProperty.Type":                                            "code",
+	"CodeSystemProperty.Uri":                                             "string",
+	"CodeableConcept.Id":                                                 "string",
+	"CodeableConcept.Text":                                               "string",
+	"Coding.Code":                                                        "string",
+	"Coding.Display":                                                     "string",
+	"Coding.Id":                                                          "string",
+	"Coding.System":                                                      "string",
+	"Coding.UserSelected":                                                "boolean",
+	"Coding.Version":                                                     "string",
+	"Communication.Id":                                                   "string",
+	"Communication.ImplicitRules":                                        "string",
+	"Communication.InstantiatesCanonical":                                "string",
+	"Communication.InstantiatesUri":                                      "string",
+	"Communication.Language":                                             "string",
+	"Communication.Priority":                                             "code",
+	"Communication.Received":                                             "dateTime",
+	"Communication.Sent":                                                 "dateTime",
+	"Communication.Status":                                               "code",
+	"CommunicationPayload.ContentString":                                 "string",
+	"CommunicationPayload.Id":                                            "string",
+	"CommunicationRequest.AuthoredOn":                                    "dateTime",
+	"CommunicationRequest.DoNotPerform":                                  "boolean",
+	"CommunicationRequest.Id":                                            "string",
+	"CommunicationRequest.ImplicitRules":                                 "string",
+	"CommunicationRequest.Language":                                      "string",
+	"CommunicationRequest.OccurrenceDateTime":                            "dateTime",
+	"CommunicationRequest.Priority":                                      "code",
+	"CommunicationRequest.Status":                                        "code",
+	"CommunicationRequestPayload.ContentString":                          "string",
+	"CommunicationRequestPayload.Id":                                     "string",
+	"CompartmentDefinition.Code":                                         "code",
+	"CompartmentDefinition.Date":                                         "dateTime",
+	"CompartmentDefinition.Description":                                  "string",
+	"CompartmentDefinition.Experimental":                                 "boolean",
+	"CompartmentDefinition.Id":                                           "string",
+	"CompartmentDefinition.ImplicitRules":                                "string",
+	"CompartmentDefinition.Language":                                     "string",
+	"CompartmentDefinition.Name":                                         "string",
+	"CompartmentDefinition.Publisher":                                    "string",
+	"CompartmentDefinition.Purpose":                                      "string",
+	"CompartmentDefinition.Search":                                       "boolean",
+	"CompartmentDefinition.Status":                                       "code",
+	"CompartmentDefinition.Url":                                          "string",
+	"CompartmentDefinition.Version":                                      "string",
+	"CompartmentDefinitionResource.Code":                                 "code",
+	"CompartmentDefinitionResource.Documentation":                        "string",
+	"CompartmentDefinitionResource.Id":                                   "string",
+	"CompartmentDefinitionResource.Param":                                "string",
+	"Composition.Confidentiality":                                        "string",
+	"Composition.Date":                                                   "dateTime",
+	"Composition.Id":                                                     "string",
+	"Composition.ImplicitRules":                                          "string",
+	"Composition.Language":                                               "string",
+	"Composition.Status":                                                 "code",
+	"Composition.Title":                                                  "string",
+	"CompositionAttester.Id":                                             "string",
+	"CompositionAttester.Mode":                                           "code",
+	"CompositionAttester.Time":                                           "dateTime",
+	"CompositionEvent.Id":                                                "string",
+	"CompositionRelatesTo.Code":                                          "code",
+	"CompositionRelatesTo.Id":                                            "string",
+	"CompositionSection.Id":                                              "string",
+	"CompositionSection.Mode":                                            "code",
+	"CompositionSection.Title":                                           "string",
+	"ConceptMap.Copyright":                                               "string",
+	"ConceptMap.Date":                                                    "dateTime",
+	"ConceptMap.Description":                                             "string",
+	"ConceptMap.Experimental":                                            "boolean",
+	"ConceptMap.Id":                                                      "string",
+	"ConceptMap.ImplicitRules":                                           "string",
+	"ConceptMap.Language":                                                "string",
+	"ConceptMap.Name":                                                    "string",
+	"ConceptMap.Publisher":                                               "string",
+	"ConceptMap.Purpose":                                                 "string",
+	"ConceptMap.SourceCanonical":                                         "canonical",
+	"ConceptMap.SourceUri":                                               "uri",
+	"ConceptMap.Status":                                                  "code",
+	"ConceptMap.TargetCanonical":                                         "canonical",
+	"ConceptMap.TargetUri":                                               "uri",
+	"ConceptMap.Title":                                                   "string",
+	"ConceptMap.Url":                                                     "string",
+	"ConceptMap.Version":                                                 "string",
+	"ConceptMapGroup.Id":                                                 "string",
+	"ConceptMapGroup.Source":                                             "string",
+	"ConceptMapGroup.SourceVersion":                                      "string",
+	"ConceptMapGroup.Target":                                             "string",
+	"ConceptMapGroup.TargetVersion":                                      "string",
+	"ConceptMapGroupElement.Code":                                        "string",
+	"ConceptMapGroupElement.Display":                                     "string",
+	"ConceptMapGroupElement.Id":                                          "string",
+	"ConceptMapGroupElementTarget.Code":                                  "string",
+	"ConceptMapGroupElementTarget.Comment":                               "string",
+	"ConceptMapGroupElementTarget.Display":                               "string",
+	"ConceptMapGroupElementTarget.Equivalence":                           "code",
+	"ConceptMapGroupElementTarget.Id":                                    "string",
+	"ConceptMapGroupElementTargetDependsOn.Display":                      "string",
+	"ConceptMapGroupElementTargetDependsOn.Id":                           "string",
+	"ConceptMapGroupElementTargetDependsOn.Property":                     "string",
+	"ConceptMapGroupElementTargetDependsOn.System":                       "string",
+	"ConceptMapGroupElementTargetDependsOn.Value":                        "string",
+	"ConceptMapGroupUnmapped.Code":                                       "string",
+	"ConceptMapGroupUnmapped.Display":                                    "string",
+	"ConceptMapGroupUnmapped.Id":                                         "string",
+	"ConceptMapGroupUnmapped.Mode":                                       "code",
+	"ConceptMapGroupUnmapped.Url":                                        "string",
+	"Condition.AbatementDateTime":                                        "dateTime",
+	"Condition.AbatementString":                                          "string",
+	"Condition.Id":                                                       "string",
+	"Condition.ImplicitRules":                                            "string",
+	"Condition.Language":                                                 "string",
+	"Condition.OnsetDateTime":                                            "dateTime",
+	"Condition.OnsetString":                                              "string",
+	"Condition.RecordedDate":                                             "dateTime",
+	"ConditionEvidence.Id":                                               "string",
+	"ConditionStage.Id":                                                  "string",
+	"Consent.DateTime":                                                   "dateTime",
+	"Consent.Id":                                                         "string",
+	"Consent.ImplicitRules":                                              "string",
+	"Consent.Language":                                                   "string",
+	"Consent.Status":                                                     "code",
+	"ConsentPolicy.Authority":                                            "string",
+	"ConsentPolicy.Id":                                                   "string",
+	"ConsentPolicy.Uri":                                                  "string",
+	"ConsentProvision.Id":                                                "string",
+	"ConsentProvision.Type":                                              "code",
+	"ConsentProvisionActor.Id":                                           "string",
+	"ConsentProvisionData.Id":                                            "string",
+	"ConsentProvisionData.Meaning":                                       "code",
+	"ConsentVerification.Id":                                             "string",
+	"ConsentVerification.VerificationDate":                               "dateTime",
+	"ConsentVerification.Verified":                                       "boolean",
+	"ContactDetail.Id":                                                   "string",
+	"ContactDetail.Name":                                                 "string",
+	"ContactPoint.Id":                                                    "string",
+	"ContactPoint.Rank":                                                  "positiveInt",
+	"ContactPoint.System":                                                "code",
+	"ContactPoint.Use":                                                   "code",
+	"ContactPoint.Value":                                                 "string",
+	"Contract.Alias":                                                     "string",
+	"Contract.Id":                                                        "string",
+	"Contract.ImplicitRules":                                             "string",
+	"Contract.InstantiatesUri":                                           "string",
+	"Contract.Issued":                                                    "dateTime",
+	"Contract.Language":                                                  "string",
+	"Contract.Name":                                                      "string",
+	"Contract.Status":                                                    "code",
+	"Contract.Subtitle":                                                  "string",
+	"Contract.Title":                                                     "string",
+	"Contract.Url":                                                       "string",
+	"Contract.Version":                                                   "string",
+	"ContractContentDefinition.Copyright":                                "string",
+	"ContractContentDefinition.Id":                                       "string",
+	"ContractContentDefinition.PublicationDate":                          "dateTime",
+	"ContractContentDefinition.PublicationStatus":                        "code",
+	"ContractFriendly.Id":                                                "string",
+	"ContractLegal.Id":                                                   "string",
+	"ContractRule.Id":                                                    "string",
+	"ContractSigner.Id":                                                  "string",
+	"ContractTerm.Id":                                                    "string",
+	"ContractTerm.Issued":                                                "dateTime",
+	"ContractTerm.Text":                                                  "string",
+	"ContractTermAction.ContextLinkId":                                   "string",
+	"ContractTermAction.DoNotPerform":                                    "boolean",
+	"ContractTermAction.Id":                                              "string",
+	"ContractTermAction.LinkId":                                          "string",
+	"ContractTermAction.OccurrenceDateTime":                              "dateTime",
+	"ContractTermAction.PerformerLinkId":                                 "string",
+	"ContractTermAction.Reason":                                          "string",
+	"ContractTermAction.ReasonLinkId":                                    "string",
+	"ContractTermAction.RequesterLinkId":                                 "string",
+	"ContractTermAction.SecurityLabelNumber":                             "unsignedInt",
+	"ContractTermActionSubject.Id":                                       "string",
+	"ContractTermAsset.Condition":                                        "string",
+	"ContractTermAsset.Id":                                               "string",
+	"ContractTermAsset.LinkId":                                           "string",
+	"ContractTermAsset.SecurityLabelNumber":                              "unsignedInt",
+	"ContractTermAsset.Text":                                             "string",
+	"ContractTermAssetContext.Id":                                        "string",
+	"ContractTermAssetContext.Text":                                      "string",
+	"ContractTermAssetValuedItem.EffectiveTime":                          "dateTime",
+	"ContractTermAssetValuedItem.Factor":                                 "decimal",
+	"ContractTermAssetValuedItem.Id":                                     "string",
+	"ContractTermAssetValuedItem.LinkId":                                 "string",
+	"ContractTermAssetValuedItem.Payment":                                "string",
+	"ContractTermAssetValuedItem.PaymentDate":                            "dateTime",
+	"ContractTermAssetValuedItem.Points":                                 "decimal",
+	"ContractTermAssetValuedItem.SecurityLabelNumber":                    "unsignedInt",
+	"ContractTermOffer.Id":                                               "string",
+	"ContractTermOffer.LinkId":                                           "string",
+	"ContractTermOffer.SecurityLabelNumber":                              "unsignedInt",
+	"ContractTermOffer.Text":                                             "string",
+	"ContractTermOfferAnswer.Id":                                         "string",
+	"ContractTermOfferAnswer.ValueBoolean":                               "boolean",
+	"ContractTermOfferAnswer.ValueDate":                                  "date",
+	"ContractTermOfferAnswer.ValueDateTime":                              "dateTime",
+	"ContractTermOfferAnswer.ValueDecimal":                               "decimal",
+	"ContractTermOfferAnswer.ValueInteger":                               "integer",
+	"ContractTermOfferAnswer.ValueString":                                "string",
+	"ContractTermOfferAnswer.ValueTime":                                  "time",
+	"ContractTermOfferAnswer.ValueUri":                                   "uri",
+	"ContractTermOfferParty.Id":                                          "string",
+	"ContractTermSecurityLabel.Id":                                       "string",
+	"ContractTermSecurityLabel.Number":                                   "unsignedInt",
+	"Contributor.Id":                                                     "string",
+	"Contributor.Name":                                                   "string",
+	"Contributor.Type":                                                   "code",
+	"Count.Code":                                                         "string",
+	"Count.Comparator":                                                   "code",
+	"Count.Id":                                                           "string",
+	"Count.System":                                                       "string",
+	"Count.Unit":                                                         "string",
+	"Count.Value":                                                        "decimal",
+	"Coverage.Dependent":                                                 "string",
+	"Coverage.Id":                                                        "string",
+	"Coverage.ImplicitRules":                                             "string",
+	"Coverage.Language":                                                  "string",
+	"Coverage.Network":                                                   "string",
+	"Coverage.Order":                                                     "positiveInt",
+	"Coverage.Status":                                                    "code",
+	"Coverage.Subrogation":                                               "boolean",
+	"Coverage.SubscriberId":                                              "string",
+	"CoverageClass.Id":                                                   "string",
+	"CoverageClass.Name":                                                 "string",
+	"CoverageClass.Value":                                                "string",
+	"CoverageCostToBeneficiary.Id":                                       "string",
+	"CoverageCostToBeneficiaryException.Id":                              "string",
+	"CoverageEligibilityRequest.Created":                                 "dateTime",
+	"CoverageEligibilityRequest.Id":                                      "string",
+	"CoverageEligibilityRequest.ImplicitRules":                           "string",
+	"CoverageEligibilityRequest.Language":                                "string",
+	"CoverageEligibilityRequest.Purpose":                                 "code",
+	"CoverageEligibilityRequest.ServicedDate":                            "date",
+	"CoverageEligibilityRequest.Status":                                  "code",
+	"CoverageEligibilityRequestInsurance.BusinessArrangement":            "string",
+	"CoverageEligibilityRequestInsurance.Focal":                          "boolean",
+	"CoverageEligibilityRequestInsurance.Id":                             "string",
+	"CoverageEligibilityRequestItem.Id":                                  "string",
+	"CoverageEligibilityRequestItem.SupportingInfoSequence":              "positiveInt",
+	"CoverageEligibilityRequestItemDiagnosis.Id":                         "string",
+	"CoverageEligibilityRequestSupportingInfo.AppliesToAll":              "boolean",
+	"CoverageEligibilityRequestSupportingInfo.Id":                        "string",
+	"CoverageEligibilityRequestSupportingInfo.Sequence":                  "positiveInt",
+	"CoverageEligibilityResponse.Created":                                "dateTime",
+	"CoverageEligibilityResponse.Disposition":                            "string",
+	"CoverageEligibilityResponse.Id":                                     "string",
+	"CoverageEligibilityResponse.ImplicitRules":                          "string",
+	"CoverageEligibilityResponse.Language":                               "string",
+	"CoverageEligibilityResponse.Outcome":                                "code",
+	"CoverageEligibilityResponse.PreAuthRef":                             "string",
+	"CoverageEligibilityResponse.Purpose":                                "code",
+	"CoverageEligibilityResponse.ServicedDate":                           "date",
+	"CoverageEligibilityResponse.Status":                                 "code",
+	"CoverageEligibilityResponseError.Id":                                "string",
+	"CoverageEligibilityResponseInsurance.Id":                            "string",
+	"CoverageEligibilityResponseInsurance.Inforce":                       "boolean",
+	"CoverageEligibilityResponseInsuranceItem.AuthorizationRequired":     "boolean",
+	"CoverageEligibilityResponseInsuranceItem.AuthorizationUrl":          "string",
+	"CoverageEligibilityResponseInsuranceItem.Description":               "string",
+	"CoverageEligibilityResponseInsuranceItem.Excluded":                  "boolean",
+	"CoverageEligibilityResponseInsuranceItem.Id":                        "string",
+	"CoverageEligibilityResponseInsuranceItem.Name":                      "string",
+	"CoverageEligibilityResponseInsuranceItemBenefit.AllowedString":      "string",
+	"CoverageEligibilityResponseInsuranceItemBenefit.AllowedUnsignedInt": "unsignedInt",
+	"CoverageEligibilityResponseInsuranceItemBenefit.Id":                 "string",
+	"CoverageEligibilityResponseInsuranceItemBenefit.UsedString":         "string",
+	"CoverageEligibilityResponseInsuranceItemBenefit.UsedUnsignedInt":    "unsignedInt",
+	"DataRequirement.Id":                                                 "string",
+	"DataRequirement.Limit":                                              "positiveInt",
+	"DataRequirement.MustSupport":                                        "string",
+	"DataRequirement.Profile":                                            "string",
+	"DataRequirement.Type":                                               "string",
+	"DataRequirementCodeFilter.Id":                                       "string",
+	"DataRequirementCodeFilter.Path":                                     "string",
+	"DataRequirementCodeFilter.SearchParam":                              "string",
+	"DataRequirementCodeFilter.ValueSet":                                 "string",
+	"DataRequirementDateFilter.Id":                                       "string",
+	"DataRequirementDateFilter.Path":                                     "string",
+	"DataRequirementDateFilter.SearchParam":                              "string",
+	"DataRequirementDateFilter.ValueDateTime":                            "dateTime",
+	"DataRequirementSort.Direction":                                      "code",
+	"DataRequirementSort.Id":                                             "string",
+	"DataRequirementSort.Path":                                           "string",
+	"DetectedIssue.Detail":                                               "string",
+	"DetectedIssue.Id":                                                   "string",
+	"DetectedIssue.IdentifiedDateTime":                                   "dateTime",
+	"DetectedIssue.ImplicitRules":                                        "string",
+	"DetectedIssue.Language":                                             "string",
+	"DetectedIssue.Reference":                                            "string",
+	"DetectedIssue.Severity":                                             "code",
+	"DetectedIssue.Status":                                               "code",
+	"DetectedIssueEvidence.Id":                                           "string",
+	"DetectedIssueMitigation.Date":                                       "dateTime",
+	"DetectedIssueMitigation.Id":                                         "string",
+	"Device.DistinctIdentifier":                                          "string",
+	"Device.ExpirationDate":                                              "dateTime",
+	"Device.Id":                                                          "string",
+	"Device.ImplicitRules":                                               "string",
+	"Device.Language":                                                    "string",
+	"Device.LotNumber":                                                   "string",
+	"Device.ManufactureDate":                                             "dateTime",
+	"Device.Manufacturer":                                                "string",
+	"Device.ModelNumber":                                                 "string",
+	"Device.PartNumber":                                                  "string",
+	"Device.SerialNumber":                                                "string",
+	"Device.Status":                                                      "code",
+	"Device.Url":                                                         "string",
+	"DeviceDefinition.Id":                                                "string",
+	"DeviceDefinition.ImplicitRules":                                     "string",
+	"DeviceDefinition.Language":                                          "string",
+	"DeviceDefinition.ManufacturerString":                                "string",
+	"DeviceDefinition.ModelNumber":                                       "string",
+	"DeviceDefinition.OnlineInformation":                                 "string",
+	"DeviceDefinition.Url":                                               "string",
+	"DeviceDefinition.Version":                                           "string",
+	"DeviceDefinitionCapability.Id":                                      "string",
+	"DeviceDefinitionDeviceName.Id":                                      "string",
+	"DeviceDefinitionDeviceName.Name":                                    "string",
+	"DeviceDefinitionDeviceName.Type":                                    "code",
+	"DeviceDefinitionMaterial.AllergenicIndicator":                       "boolean",
+	"DeviceDefinitionMaterial.Alternate":                                 "boolean",
+	"DeviceDefinitionMaterial.Id":                                        "string",
+	"DeviceDefinitionProperty.Id":                                        "string",
+	"DeviceDefinitionSpecialization.Id":                                  "string",
+	"DeviceDefinitionSpecialization.SystemType":                          "string",
+	"DeviceDefinitionSpecialization.Version":                             "string",
+	"DeviceDefinitionUdiDeviceIdentifier.DeviceIdentifier":               "string",
+	"DeviceDefinitionUdiDeviceIdentifier.Id":                             "string",
+	"DeviceDefinitionUdiDeviceIdentifier.Issuer":                         "string",
+	"DeviceDefinitionUdiDeviceIdentifier.Jurisdiction":                   "string",
+	"DeviceDeviceName.Id":                                                "string",
+	"DeviceDeviceName.Name":                                              "string",
+	"DeviceDeviceName.Type":                                              "code",
+	"DeviceMetric.Category":                                              "code",
+	"DeviceMetric.Color":                                                 "code",
+	"DeviceMetric.Id":                                                    "string",
+	"DeviceMetric.ImplicitRules":                                         "string",
+	"DeviceMetric.Language":                                              "string",
+	"DeviceMetric.OperationalStatus":                                     "code",
+	"DeviceMetricCalibration.Id":                                         "string",
+	"DeviceMetricCalibration.State":                                      "code",
+	"DeviceMetricCalibration.Time":                                       "instant",
+	"DeviceMetricCalibration.Type":                                       "code",
+	"DeviceProperty.Id":                                                  "string",
+	"DeviceRequest.AuthoredOn":                                           "dateTime",
+	"DeviceRequest.Id":                                                   "string",
+	"DeviceRequest.ImplicitRules":                                        "string",
+	"DeviceRequest.InstantiatesCanonical":                                "string",
+	"DeviceRequest.InstantiatesUri":                                      "string",
+	"DeviceRequest.Intent":                                               "code",
+	"DeviceRequest.Language":                                             "string",
+	"DeviceRequest.OccurrenceDateTime":                                   "dateTime",
+	"DeviceRequest.Priority":                                             "code",
+	"DeviceRequest.Status":                                               "code",
+	"DeviceRequestParameter.Id":                                          "string",
+	"DeviceRequestParameter.ValueBoolean":                                "boolean",
+	"DeviceSpecialization.Id":                                            "string",
+	"DeviceSpecialization.Version":                                       "string",
+	"DeviceUdiCarrier.CarrierAIDC":                                       "base64Binary",
+	"DeviceUdiCarrier.CarrierHRF":                                        "string",
+	"DeviceUdiCarrier.DeviceIdentifier":                                  "string",
+	"DeviceUdiCarrier.EntryType":                                         "code",
+	"DeviceUdiCarrier.Id":                                                "string",
+	"DeviceUdiCarrier.Issuer":                                            "string",
+	"DeviceUdiCarrier.Jurisdiction":                                      "string",
+	"DeviceUseStatement.Id":                                              "string",
+	"DeviceUseStatement.ImplicitRules":                                   "string",
+	"DeviceUseStatement.Language":                                        "string",
+	"DeviceUseStatement.RecordedOn":                                      "dateTime",
+	"DeviceUseStatement.Status":                                          "code",
+	"DeviceUseStatement.TimingDateTime":                                  "dateTime",
+	"DeviceVersion.Id":                                                   "string",
+	"DeviceVersion.Value":                                                "string",
+	"DiagnosticReport.Conclusion":                                        "string",
+	"DiagnosticReport.EffectiveDateTime":                                 "dateTime",
+	"DiagnosticReport.Id":                                                "string",
+	"DiagnosticReport.ImplicitRules":                                     "string",
+	"DiagnosticReport.Issued":                                            "instant",
+	"DiagnosticReport.Language":                                          "string",
+	"DiagnosticReport.Status":                                            "code",
+	"DiagnosticReportMedia.Comment":                                      "string",
+	"DiagnosticReportMedia.Id":                                           "string",
+	"Distance.Code":                                                      "string",
+	"Distance.Comparator":                                                "code",
+	"Distance.Id":                                                        "string",
+	"Distance.System":                                                    "string",
+	"Distance.Unit":                                                      "string",
+	"Distance.Value":                                                     "decimal",
+	"DocumentManifest.Created":                                           "dateTime",
+	"DocumentManifest.Description":                                       "string",
+	"DocumentManifest.Id":                                                "string",
+	"DocumentManifest.ImplicitRules":                                     "string",
+	"DocumentManifest.Language":                                          "string",
+	"DocumentManifest.Source":                                            "string",
+	"DocumentManifest.Status":                                            "code",
+	"DocumentManifestRelated.Id":                                         "string",
+	"DocumentReference.Date":                                             "instant",
+	"DocumentReference.Description":                                      "string",
+	"DocumentReference.DocStatus":                                        "code",
+	"DocumentReference.Id":                                               "string",
+	"DocumentReference.ImplicitRules":                                    "string",
+	"DocumentReference.Language":                                         "string",
+	"DocumentReference.Status":                                           "code",
+	"DocumentReferenceContent.Id":                                        "string",
+	"DocumentReferenceContext.Id":                                        "string",
+	"DocumentReferenceRelatesTo.Code":                                    "code",
+	"DocumentReferenceRelatesTo.Id":                                      "string",
+	"DomainResource.Id":                                                  "string",
+	"DomainResource.ImplicitRules":                                       "string",
+	"DomainResource.Language":                                            "string",
+	"Dosage.AsNeededBoolean":                                             "boolean",
+	"Dosage.Id":                                                          "string",
+	"Dosage.PatientInstruction":                                          "string",
+	"Dosage.Sequence":                                                    "integer",
+	"Dosage.Text":                                                        "string",
+	"DosageDoseAndRate.Id":                                               "string",
+	"Duration.Code":                                                      "string",
+	"Duration.Comparator":                                                "code",
+	"Duration.Id":                                                        "string",
+	"Duration.System":                                                    "string",
+	"Duration.Unit":                                                      "string",
+	"Duration.Value":                                                     "decimal",
+	"EffectEvidenceSynthesis.ApprovalDate":                               "date",
+	"EffectEvidenceSynthesis.Copyright":                                  "string",
+	"EffectEvidenceSynthesis.Date":                                       "dateTime",
+	"EffectEvidenceSynthesis.Description":                                "string",
+	"EffectEvidenceSynthesis.Id":                                         "string",
+	"EffectEvidenceSynthesis.ImplicitRules":                              "string",
+	"EffectEvidenceSynthesis.Language":                                   "string",
+	"EffectEvidenceSynthesis.LastReviewDate":                             "date",
+	"EffectEvidenceSynthesis.Name":                                       "string",
+	"EffectEvidenceSynthesis.Publisher":                                  "string",
+	"EffectEvidenceSynthesis.Status":                                     "code",
+	"EffectEvidenceSynthesis.Title":                                      "string",
+	"EffectEvidenceSynthesis.Url":                                        "string",
+	"EffectEvidenceSynthesis.Version":                                    "string",
+	"EffectEvidenceSynthesisCertainty.Id":                                "string",
+	"EffectEvidenceSynthesisCertaintyCertaintySubcomponent.Id":           "string",
+	"EffectEvidenceSynthesisEffectEstimate.Description":                  "string",
+	"EffectEvidenceSynthesisEffectEstimate.Id":                           "string",
+	"EffectEvidenceSynthesisEffectEstimate.Value":                        "decimal",
+	"EffectEvidenceSynthesisEffectEstimatePrecisionEstimate.From":        "decimal",
+	"EffectEvidenceSynthesisEffectEstimatePrecisionEstimate.Id":          "string",
+	"EffectEvidenceSynthesisEffectEstimatePrecisionEstimate.Level":       "decimal",
+	"EffectEvidenceSynthesisEffectEstimatePrecisionEstimate.To":          "decimal",
+	"EffectEvidenceSynthesisResultsByExposure.Description":               "string",
+	"EffectEvidenceSynthesisResultsByExposure.ExposureState":             "code",
+	"EffectEvidenceSynthesisResultsByExposure.Id":                        "string",
+	"EffectEvidenceSynthesisSampleSize.Description":                      "string",
+	"EffectEvidenceSynthesisSampleSize.Id":                               "string",
+	"EffectEvidenceSynthesisSampleSize.NumberOfParticipants":             "integer",
+	"EffectEvidenceSynthesisSampleSize.NumberOfStudies":                  "integer",
+	"ElementDefinition.Alias":                                            "string",
+	"ElementDefinition.Comment":                                          "string",
+	"ElementDefinition.Condition":                                        "string",
+	"ElementDefinition.ContentReference":                                 "string",
+	"ElementDefinition.DefaultValueBase64Binary":                         "base64Binary",
+	"ElementDefinition.DefaultValueBoolean":                              "boolean",
+	"ElementDefinition.DefaultValueCanonical":                            "canonical",
+	"ElementDefinition.DefaultValueCode":                                 "code",
+	"ElementDefinition.DefaultValueDate":                                 "date",
+	"ElementDefinition.DefaultValueDateTime":                             "dateTime",
+	"ElementDefinition.DefaultValueDecimal":                              "decimal",
+	"ElementDefinition.DefaultValueId":                                   "id",
+	"ElementDefinition.DefaultValueInstant":                              "instant",
+	"ElementDefinition.DefaultValueInteger":                              "integer",
+	"ElementDefinition.DefaultValueMarkdown":                             "markdown",
+	"ElementDefinition.DefaultValueOid":                                  "oid",
+	"ElementDefinition.DefaultValuePositiveInt":                          "positiveInt",
+	"ElementDefinition.DefaultValueString":                               "string",
+	"ElementDefinition.DefaultValueTime":                                 "time",
+	"ElementDefinition.DefaultValueUnsignedInt":                          "unsignedInt",
+	"ElementDefinition.DefaultValueUri":                                  "uri",
+	"ElementDefinition.DefaultValueUrl":                                  "url",
+	"ElementDefinition.DefaultValueUuid":                                 "uuid",
+	"ElementDefinition.Definition":                                       "string",
+	"ElementDefinition.FixedBase64Binary":                                "base64Binary",
+	"ElementDefinition.FixedBoolean":                                     "boolean",
+	"ElementDefinition.FixedCanonical":                                   "canonical",
+	"ElementDefinition.FixedCode":                                        "code",
+	"ElementDefinition.FixedDate":                                        "date",
+	"ElementDefinition.FixedDateTime":                                    "dateTime",
+	"ElementDefinition.FixedDecimal":                                     "decimal",
+	"ElementDefinition.FixedId":                                          "id",
+	"ElementDefinition.FixedInstant":                                     "instant",
+	"ElementDefinition.FixedInteger":                                     "integer",
+	"ElementDefinition.FixedMarkdown":                                    "markdown",
+	"ElementDefinition.FixedOid":                                         "oid",
+	"ElementDefinition.FixedPositiveInt":                                 "positiveInt",
+	"ElementDefinition.FixedString":                                      "string",
+	"ElementDefinition.FixedTime":                                        "time",
+	"ElementDefinition.FixedUnsignedInt":                                 "unsignedInt",
+	"ElementDefinition.FixedUri":                                         "uri",
+	"ElementDefinition.FixedUrl":                                         "url",
+	"ElementDefinition.FixedUuid":                                        "uuid",
+	"ElementDefinition.Id":                                               "string",
+	"ElementDefinition.IsModifier":                                       "boolean",
+	"ElementDefinition.IsModifierReason":                                 "string",
+	"ElementDefinition.IsSummary":                                        "boolean",
+	"ElementDefinition.Label":                                            "string",
+	"ElementDefinition.Max":                                              "string",
+	"ElementDefinition.MaxLength":                                        "integer",
+	"ElementDefinition.MaxValueDate":                                     "date",
+	"ElementDefinition.MaxValueDateTime":                                 "dateTime",
+	"ElementDefinition.MaxValueDecimal":                                  "decimal",
+	"ElementDefinition.MaxValueInstant":                                  "instant",
+	"ElementDefinition.MaxValueInteger":                                  "integer",
+	"ElementDefinition.MaxValuePositiveInt":                              "positiveInt",
+	"ElementDefinition.MaxValueTime":                                     "time",
+	"ElementDefinition.MaxValueUnsignedInt":                              "unsignedInt",
+	"ElementDefinition.MeaningWhenMissing":                               "string",
+	"ElementDefinition.Min":                                              "unsignedInt",
+	"ElementDefinition.MinValueDate":                                     "date",
+	"ElementDefinition.MinValueDateTime":                                 "dateTime",
+	"ElementDefinition.MinValueDecimal":                                  "decimal",
+	"ElementDefinition.MinValueInstant":                                  "instant",
+	"ElementDefinition.MinValueInteger":                                  "integer",
+	"ElementDefinition.MinValuePositiveInt":                              "positiveInt",
+	"ElementDefinition.MinValueTime":                                     "time",
+	"ElementDefinition.MinValueUnsignedInt":                              "unsignedInt",
+	"ElementDefinition.MustSupport":                                      "boolean",
+	"ElementDefinition.OrderMeaning":                                     "string",
+	"ElementDefinition.Path":                                             "string",
+	"ElementDefinition.PatternBase64Binary":                              "base64Binary",
+	"ElementDefinition.PatternBoolean":                                   "boolean",
+	"ElementDefinition.PatternCanonical":                                 "canonical",
+	"ElementDefinition.PatternCode":                                      "code",
+	"ElementDefinition.PatternDate":                                      "date",
+	"ElementDefinition.PatternDateTime":                                  "dateTime",
+	"ElementDefinition.PatternDecimal":                                   "decimal",
+	"ElementDefinition.PatternId":                                        "id",
+	"ElementDefinition.PatternInstant":                                   "instant",
+	"ElementDefinition.PatternInteger":                                   "integer",
+	"ElementDefinition.PatternMarkdown":                                  "markdown",
+	"ElementDefinition.PatternOid":                                       "oid",
+	"ElementDefinition.PatternPositiveInt":                               "positiveInt",
+	"ElementDefinition.PatternString":                                    "string",
+	"ElementDefinition.PatternTime":                                      "time",
+	"ElementDefinition.PatternUnsignedInt":                               "unsignedInt",
+	"ElementDefinition.PatternUri":                                       "uri",
+	"ElementDefinition.PatternUrl":                                       "url",
+	"ElementDefinition.PatternUuid":                                      "uuid",
+	"ElementDefinition.Representation":                                   "code",
+	"ElementDefinition.Requirements":                                     "string",
+	"ElementDefinition.Short":                                            "string",
+	"ElementDefinition.SliceIsConstraining":                              "boolean",
+	"ElementDefinition.SliceName":                                        "string",
+	"ElementDefinitionBase.Id":                                           "string",
+	"ElementDefinitionBase.Max":                                          "string",
+	"ElementDefinitionBase.Min":                                          "unsignedInt",
+	"ElementDefinitionBase.Path":                                         "string",
+	"ElementDefinitionBinding.Description":                               "string",
+	"ElementDefinitionBinding.Id":                                        "string",
+	"ElementDefinitionBinding.Strength":                                  "code",
+	"ElementDefinitionBinding.ValueSet":                                  "string",
+	"ElementDefinitionConstraint.Expression":                             "string",
+	"ElementDefinitionConstraint.Human":                                  "string",
+	"ElementDefinitionConstraint.Id":                                     "string",
+	"ElementDefinitionConstraint.Key":                                    "string",
+	"ElementDefinitionConstraint.Requirements":                           "string",
+	"ElementDefinitionConstraint.Severity":                               "code",
+	"ElementDefinitionConstraint.Source":                                 "string",
+	"ElementDefinitionConstraint.Xpath":                                  "string",
+	"ElementDefinitionExample.Id":                                        "string",
+	"ElementDefinitionExample.Label":                                     "string",
+	"ElementDefinitionExample.ValueBase64Binary":                         "base64Binary",
+	"ElementDefinitionExample.ValueBoolean":                              "boolean",
+	"ElementDefinitionExample.ValueCanonical":                            "canonical",
+	"ElementDefinitionExample.ValueCode":                                 "code",
+	"ElementDefinitionExample.ValueDate":                                 "date",
+	"ElementDefinitionExample.ValueDateTime":                             "dateTime",
+	"ElementDefinitionExample.ValueDecimal":                              "decimal",
+	"ElementDefinitionExample.ValueId":                                   "id",
+	"ElementDefinitionExample.ValueInstant":                              "instant",
+	"ElementDefinitionExample.ValueInteger":                              "integer",
+	"ElementDefinitionExample.ValueMarkdown":                             "markdown",
+	"ElementDefinitionExample.ValueOid":                                  "oid",
+	"ElementDefinitionExample.ValuePositiveInt":                          "positiveInt",
+	"ElementDefinitionExample.ValueString":                               "string",
+	"ElementDefinitionExample.ValueTime":                                 "time",
+	"ElementDefinitionExample.ValueUnsignedInt":                          "unsignedInt",
+	"ElementDefinitionExample.ValueUri":                                  "uri",
+	"ElementDefinitionExample.ValueUrl":                                  "url",
+	"ElementDefinitionExample.ValueUuid":                                 "uuid",
+	"ElementDefinitionMapping.Comment":                                   "string",
+	"ElementDefinitionMapping.Id":                                        "string",
+	"ElementDefinitionMapping.Identity":                                  "string",
+	"ElementDefinitionMapping.Language":                                  "string",
+	"ElementDefinitionMapping.Map":                                       "string",
+	"ElementDefinitionSlicing.Description":                               "string",
+	"ElementDefinitionSlicing.Id":                                        "string",
+	"ElementDefinitionSlicing.Ordered":                                   "boolean",
+	"ElementDefinitionSlicing.Rules":                                     "code",
+	"ElementDefinitionSlicingDiscriminator.Id":                           "string",
+	"ElementDefinitionSlicingDiscriminator.Path":                         "string",
+	"ElementDefinitionSlicingDiscriminator.Type":                         "code",
+	"ElementDefinitionType.Aggregation":                                  "code",
+	"ElementDefinitionType.Code":                                         "string",
+	"ElementDefinitionType.Id":                                           "string",
+	"ElementDefinitionType.Profile":                                      "string",
+	"ElementDefinitionType.TargetProfile":                                "string",
+	"ElementDefinitionType.Versioning":                                   "code",
+	"Encounter.Id":                                                       "string",
+	"Encounter.ImplicitRules":                                            "string",
+	"Encounter.Language":                                                 "string",
+	"Encounter.Status":                                                   "code",
+	"EncounterClassHistory.Id":                                           "string",
+	"EncounterDiagnosis.Id":                                              "string",
+	"EncounterDiagnosis.Rank":                                            "positiveInt",
+	"EncounterHospitalization.Id":                                        "string",
+	"EncounterLocation.Id":                                               "string",
+	"EncounterLocation.Status":                                           "code",
+	"EncounterParticipant.Id":                                            "string",
+	"EncounterStatusHistory.Id":                                          "string",
+	"EncounterStatusHistory.Status":                                      "code",
+	"Endpoint.Address":                                                   "string",
+	"Endpoint.Header":                                                    "string",
+	"Endpoint.Id":                                                        "string",
+	"Endpoint.ImplicitRules":                                             "string",
+	"Endpoint.Language":                                                  "string",
+	"Endpoint.Name":                                                      "string",
+	"Endpoint.PayloadMimeType":                                           "string",
+	"Endpoint.Status":                                                    "code",
+	"EnrollmentRequest.Created":                                          "dateTime",
+	"EnrollmentRequest.Id":                                               "string",
+	"EnrollmentRequest.ImplicitRules":                                    "string",
+	"EnrollmentRequest.Language":                                         "string",
+	"EnrollmentRequest.Status":                                           "code",
+	"EnrollmentResponse.Created":                                         "dateTime",
+	"EnrollmentResponse.Disposition":                                     "string",
+	"EnrollmentResponse.Id":                                              "string",
+	"EnrollmentResponse.ImplicitRules":                                   "string",
+	"EnrollmentResponse.Language":                                        "string",
+	"EnrollmentResponse.Outcome":                                         "code",
+	"EnrollmentResponse.Status":                                          "code",
+	"EpisodeOfCare.Id":                                                   "string",
+	"EpisodeOfCare.ImplicitRules":                                        "string",
+	"EpisodeOfCare.Language":                                             "string",
+	"EpisodeOfCare.Status":                                               "code",
+	"EpisodeOfCareDiagnosis.Id":                                          "string",
+	"EpisodeOfCareDiagnosis.Rank":                                        "positiveInt",
+	"EpisodeOfCareStatusHistory.Id":                                      "string",
+	"EpisodeOfCareStatusHistory.Status":                                  "code",
+	"EventDefinition.ApprovalDate":                                       "date",
+	"EventDefinition.Copyright":                                          "string",
+	"EventDefinition.Date":                                               "dateTime",
+	"EventDefinition.Description":                                        "string",
+	"EventDefinition.Experimental":                                       "boolean",
+	"EventDefinition.Id":                                                 "string",
+	"EventDefinition.ImplicitRules":                                      "string",
+	"EventDefinition.Language":                                           "string",
+	"EventDefinition.LastReviewDate":                                     "date",
+	"EventDefinition.Name":                                               "string",
+	"EventDefinition.Publisher":                                          "string",
+	"EventDefinition.Purpose":                                            "string",
+	"EventDefinition.Status":                                             "code",
+	"EventDefinition.Subtitle":                                           "string",
+	"EventDefinition.Title":                                              "string",
+	"EventDefinition.Url":                                                "string",
+	"EventDefinition.Usage":                                              "string",
+	"EventDefinition.Version":                                            "string",
+	"Evidence.ApprovalDate":                                              "date",
+	"Evidence.Copyright":                                                 "string",
+	"Evidence.Date":                                                      "dateTime",
+	"Evidence.Description":                                               "string",
+	"Evidence.Id":                                                        "string",
+	"Evidence.ImplicitRules":                                             "string",
+	"Evidence.Language":                                                  "string",
+	"Evidence.LastReviewDate":                                            "date",
+	"Evidence.Name":                                                      "string",
+	"Evidence.Publisher":                                                 "string",
+	"Evidence.ShortTitle":                                                "string",
+	"Evidence.Status":                                                    "code",
+	"Evidence.Subtitle":                                                  "string",
+	"Evidence.Title":                                                     "string",
+	"Evidence.Url":                                                       "string",
+	"Evidence.Version":                                                   "string",
+	"EvidenceVariable.ApprovalDate":                                      "date",
+	"EvidenceVariable.Copyright":                                         "string",
+	"EvidenceVariable.Date":                                              "dateTime",
+	"EvidenceVariable.Description":                                       "string",
+	"EvidenceVariable.Id":                                                "string",
+	"EvidenceVariable.ImplicitRules":                                     "string",
+	"EvidenceVariable.Language":                                          "string",
+	"EvidenceVariable.LastReviewDate":                                    "date",
+	"EvidenceVariable.Name":                                              "string",
+	"EvidenceVariable.Publisher":                                         "string",
+	"EvidenceVariable.ShortTitle":                                        "string",
+	"EvidenceVariable.Status":                                            "code",
+	"EvidenceVariable.Subtitle":                                          "string",
+	"EvidenceVariable.Title":                                             "string",
+	"EvidenceVariable.Type":                                              "code",
+	"EvidenceVariable.Url":                                               "string",
+	"EvidenceVariable.Version":                                           "string",
+	"EvidenceVariableCharacteristic.DefinitionCanonical":                 "canonical",
+	"EvidenceVariableCharacteristic.Description":                         "string",
+	"EvidenceVariableCharacteristic.Exclude":                             "boolean",
+	"EvidenceVariableCharacteristic.GroupMeasure":                        "code",
+	"EvidenceVariableCharacteristic.Id":                                  "string",
+	"EvidenceVariableCharacteristic.ParticipantEffectiveDateTime":        "dateTime",
+	"ExampleScenario.Copyright":                                          "string",
+	"ExampleScenario.Date":                                               "dateTime",
+	"ExampleScenario.Experimental":                                       "boolean",
+	"ExampleScenario.Id":                                                 "string",
+	"ExampleScenario.ImplicitRules":                                      "string",
+	"ExampleScenario.Language":                                           "string",
+	"ExampleScenario.Name":                                               "string",
+	"ExampleScenario.Publisher":                                          "string",
+	"ExampleScenario.Purpose":                                            "string",
+	"ExampleScenario.Status":                                             "code",
+	"ExampleScenario.Url":                                                "string",
+	"ExampleScenario.Version":                                            "string",
+	"ExampleScenario.Workflow":                                           "string",
+	"ExampleScenarioActor.ActorId":                                       "string",
+	"ExampleScenarioActor.Description":                                   "string",
+	"ExampleScenarioActor.Id":                                            "string",
+	"ExampleScenarioActor.Name":                                          "string",
+	"ExampleScenarioActor.Type":                                          "code",
+	"ExampleScenarioInstance.Description":                                "string",
+	"ExampleScenarioInstance.Id":                                         "string",
+	"ExampleScenarioInstance.Name":                                       "string",
+	"ExampleScenarioInstance.ResourceId":                                 "string",
+	"ExampleScenarioInstance.ResourceType":                               "code",
+	"ExampleScenarioInstanceContainedInstance.Id":                        "string",
+	"ExampleScenarioInstanceContainedInstance.ResourceId":                "string",
+	"ExampleScenarioInstanceContainedInstance.VersionId":                 "string",
+	"ExampleScenarioInstanceVersion.Description":                         "string",
+	"ExampleScenarioInstanceVersion.Id":                                  "string",
+	"ExampleScenarioInstanceVersion.VersionId":                           "string",
+	"ExampleScenarioProcess.Description":                                 "string",
+	"ExampleScenarioProcess.Id":                                          "string",
+	"ExampleScenarioProcess.PostConditions":                              "string",
+	"ExampleScenarioProcess.PreConditions":                               "string",
+	"ExampleScenarioProcess.Title":                                       "string",
+	"ExampleScenarioProcessStep.Id":                                      "string",
+	"ExampleScenarioProcessStep.Pause":                                   "boolean",
+	"ExampleScenarioProcessStepAlternative.Description":                  "string",
+	"ExampleScenarioProcessStepAlternative.Id":                           "string",
+	"ExampleScenarioProcessStepAlternative.Title":                        "string",
+	"ExampleScenarioProcessStepOperation.Description":                    "string",
+	"ExampleScenarioProcessStepOperation.Id":                             "string",
+	"ExampleScenarioProcessStepOperation.Initiator":                      "string",
+	"ExampleScenarioProcessStepOperation.InitiatorActive":                "boolean",
+	"ExampleScenarioProcessStepOperation.Name":                           "string",
+	"ExampleScenarioProcessStepOperation.Number":                         "string",
+	"ExampleScenarioProcessStepOperation.Receiver":                       "string",
+	"ExampleScenarioProcessStepOperation.ReceiverActive":                 "boolean",
+	"ExampleScenarioProcessStepOperation.Type":                           "string",
+	"ExplanationOfBenefit.Created":                                       "dateTime",
+	"ExplanationOfBenefit.Disposition":                                   "string",
+	"ExplanationOfBenefit.Id":                                            "string",
+	"ExplanationOfBenefit.ImplicitRules":                                 "string",
+	"ExplanationOfBenefit.Language":                                      "string",
+	"ExplanationOfBenefit.Outcome":                                       "code",
+	"ExplanationOfBenefit.PreAuthRef":                                    "string",
+	"ExplanationOfBenefit.Precedence":                                    "positiveInt",
+	"ExplanationOfBenefit.Status":                                        "code",
+	"ExplanationOfBenefit.Use":                                           "code",
+	"ExplanationOfBenefitAccident.Date":                                  "date",
+	"ExplanationOfBenefitAccident.Id":                                    "string",
+	"ExplanationOfBenefitAddItem.DetailSequence":                         "positiveInt",
+	"ExplanationOfBenefitAddItem.Factor":                                 "decimal",
+	"ExplanationOfBenefitAddItem.Id":                                     "string",
+	"ExplanationOfBenefitAddItem.ItemSequence":                           "positiveInt",
+	"ExplanationOfBenefitAddItem.NoteNumber":                             "positiveInt",
+	"ExplanationOfBenefitAddItem.ServicedDate":                           "date",
+	"ExplanationOfBenefitAddItem.SubDetailSequence":                      "positiveInt",
+	"ExplanationOfBenefitAddItemDetail.Factor":                           "decimal",
+	"ExplanationOfBenefitAddItemDetail.Id":                               "string",
+	"ExplanationOfBenefitAddItemDetail.NoteNumber":                       "positiveInt",
+	"ExplanationOfBenefitAddItemDetailSubDetail.Factor":                  "decimal",
+	"ExplanationOfBenefitAddItemDetailSubDetail.Id":                      "string",
+	"ExplanationOfBenefitAddItemDetailSubDetail.NoteNumber":              "positiveInt",
+	"ExplanationOfBenefitBenefitBalance.Description":                     "string",
+	"ExplanationOfBenefitBenefitBalance.Excluded":                        "boolean",
+	"ExplanationOfBenefitBenefitBalance.Id":                              "string",
+	"ExplanationOfBenefitBenefitBalance.Name":                            "string",
+	"ExplanationOfBenefitBenefitBalanceFinancial.AllowedString":          "string",
+	"ExplanationOfBenefitBenefitBalanceFinancial.AllowedUnsignedInt":     "unsignedInt",
+	"ExplanationOfBenefitBenefitBalanceFinancial.Id":                     "string",
+	"ExplanationOfBenefitBenefitBalanceFinancial.UsedUnsignedInt":        "unsignedInt",
+	"ExplanationOfBenefitCareTeam.Id":                                    "string",
+	"ExplanationOfBenefitCareTeam.Responsible":                           "boolean",
+	"ExplanationOfBenefitCareTeam.Sequence":                              "positiveInt",
+	"ExplanationOfBenefitDiagnosis.Id":                                   "string",
+	"ExplanationOfBenefitDiagnosis.Sequence":                             "positiveInt",
+	"ExplanationOfBenefitInsurance.Focal":                                "boolean",
+	"ExplanationOfBenefitInsurance.Id":                                   "string",
+	"ExplanationOfBenefitInsurance.PreAuthRef":                           "string",
+	"ExplanationOfBenefitItem.CareTeamSequence":                          "positiveInt",
+	"ExplanationOfBenefitItem.DiagnosisSequence":                         "positiveInt",
+	"ExplanationOfBenefitItem.Factor":                                    "decimal",
+	"ExplanationOfBenefitItem.Id":                                        "string",
+	"ExplanationOfBenefitItem.InformationSequence":                       "positiveInt",
+	"ExplanationOfBenefitItem.NoteNumber":                                "positiveInt",
+	"ExplanationOfBenefitItem.ProcedureSequence":                         "positiveInt",
+	"ExplanationOfBenefitItem.Sequence":                                  "positiveInt",
+	"ExplanationOfBenefitItem.ServicedDate":                              "date",
+	"ExplanationOfBenefitItemAdjudication.Id":                            "string",
+	"ExplanationOfBenefitItemAdjudication.Value":                         "decimal",
+	"ExplanationOfBenefitItemDetail.Factor":                              "decimal",
+	"ExplanationOfBenefitItemDetail.Id":                                  "string",
+	"ExplanationOfBenefitItemDetail.NoteNumber":                          "positiveInt",
+	"ExplanationOfBenefitItemDetail.Sequence":                            "positiveInt",
+	"ExplanationOfBenefitItemDetailSubDetail.Factor":                     "decimal",
+	"ExplanationOfBenefitItemDetailSubDetail.Id":                         "string",
+	"ExplanationOfBenefitItemDetailSubDetail.NoteNumber":                 "positiveInt",
+	"ExplanationOfBenefitItemDetailSubDetail.Sequence":                   "positiveInt",
+	"ExplanationOfBenefitPayee.Id":                                       "string",
+	"ExplanationOfBenefitPayment.Date":                                   "date",
+	"ExplanationOfBenefitPayment.Id":                                     "string",
+	"ExplanationOfBenefitProcedure.Date":                                 "dateTime",
+	"ExplanationOfBenefitProcedure.Id":                                   "string",
+	"ExplanationOfBenefitProcedure.Sequence":                             "positiveInt",
+	"ExplanationOfBenefitProcessNote.Id":                                 "string",
+	"ExplanationOfBenefitProcessNote.Number":                             "positiveInt",
+	"ExplanationOfBenefitProcessNote.Text":                               "string",
+	"ExplanationOfBenefitProcessNote.Type":                               "code",
+	"ExplanationOfBenefitRelated.Id":                                     "string",
+	"ExplanationOfBenefitSupportingInfo.Id":                              "string",
+	"ExplanationOfBenefitSupportingInfo.Sequence":                        "positiveInt",
+	"ExplanationOfBenefitSupportingInfo.TimingDate":                      "date",
+	"ExplanationOfBenefitSupportingInfo.ValueBoolean":                    "boolean",
+	"ExplanationOfBenefitSupportingInfo.ValueString":                     "string",
+	"ExplanationOfBenefitTotal.Id":                                       "string",
+	"Expression.Description":                                             "string",
+	"Expression.Expression":                                              "string",
+	"Expression.Id":                                                      "string",
+	"Expression.Language":                                                "string",
+	"Expression.Name":                                                    "string",
+	"Expression.Reference":                                               "string",
+	"Extension.Id":                                                       "string",
+	"Extension.Url":                                                      "uri",
+	"Extension.ValueBase64Binary":                                        "base64Binary",
+	"Extension.ValueBoolean":                                             "boolean",
+	"Extension.ValueCanonical":                                           "canonical",
+	"Extension.ValueCode":                                                "code",
+	"Extension.ValueDate":                                                "date",
+	"Extension.ValueDateTime":                                            "dateTime",
+	"Extension.ValueDecimal":                                             "decimal",
+	"Extension.ValueId":                                                  "id",
+	"Extension.ValueInstant":                                             "instant",
+	"Extension.ValueInteger":                                             "integer",
+	"Extension.ValueMarkdown":                                            "markdown",
+	"Extension.ValueOid":                                                 "oid",
+	"Extension.ValuePositiveInt":                                         "positiveInt",
+	"Extension.ValueString":                                              "string",
+	"Extension.ValueTime":                                                "time",
+	"Extension.ValueUnsignedInt":                                         "unsignedInt",
+	"Extension.ValueUri":                                                 "uri",
+	"Extension.ValueUrl":                                                 "url",
+	"Extension.ValueUuid":                                                "uuid",
+	"FamilyMemberHistory.AgeString":                                      "string",
+	"FamilyMemberHistory.BornDate":                                       "date",
+	"FamilyMemberHistory.BornString":                                     "string",
+	"FamilyMemberHistory.Date":                                           "dateTime",
+	"FamilyMemberHistory.DeceasedBoolean":                                "boolean",
+	"FamilyMemberHistory.DeceasedDate":                                   "date",
+	"FamilyMemberHistory.DeceasedString":                                 "string",
+	"FamilyMemberHistory.EstimatedAge":                                   "boolean",
+	"FamilyMemberHistory.Id":                                             "string",
+	"FamilyMemberHistory.ImplicitRules":                                  "string",
+	"FamilyMemberHistory.InstantiatesCanonical":                          "string",
+	"FamilyMemberHistory.InstantiatesUri":                                "string",
+	"FamilyMemberHistory.Language":                                       "string",
+	"FamilyMemberHistory.Name":                                           "string",
+	"FamilyMemberHistory.Status":                                         "code",
+	"FamilyMemberHistoryCondition.ContributedToDeath":                    "boolean",
+	"FamilyMemberHistoryCondition.Id":                                    "string",
+	"FamilyMemberHistoryCondition.OnsetString":                           "string",
+	"Flag.Id":                                                         "string",
+	"Flag.ImplicitRules":                                              "string",
+	"Flag.Language":                                                   "string",
+	"Flag.Status":                                                     "code",
+	"Goal.Id":                                                         "string",
+	"Goal.ImplicitRules":                                              "string",
+	"Goal.Language":                                                   "string",
+	"Goal.LifecycleStatus":                                            "code",
+	"Goal.StartDate":                                                  "date",
+	"Goal.StatusDate":                                                 "date",
+	"Goal.StatusReason":                                               "string",
+	"GoalTarget.DetailBoolean":                                        "boolean",
+	"GoalTarget.DetailInteger":                                        "integer",
+	"GoalTarget.DetailString":                                         "string",
+	"GoalTarget.DueDate":                                              "date",
+	"GoalTarget.Id":                                                   "string",
+	"GraphDefinition.Date":                                            "dateTime",
+	"GraphDefinition.Description":                                     "string",
+	"GraphDefinition.Experimental":                                    "boolean",
+	"GraphDefinition.Id":                                              "string",
+	"GraphDefinition.ImplicitRules":                                   "string",
+	"GraphDefinition.Language":                                        "string",
+	"GraphDefinition.Name":                                            "string",
+	"GraphDefinition.Profile":                                         "string",
+	"GraphDefinition.Publisher":                                       "string",
+	"GraphDefinition.Purpose":                                         "string",
+	"GraphDefinition.Start":                                           "code",
+	"GraphDefinition.Status":                                          "code",
+	"GraphDefinition.Url":                                             "string",
+	"GraphDefinition.Version":                                         "string",
+	"GraphDefinitionLink.Description":                                 "string",
+	"GraphDefinitionLink.Id":                                          "string",
+	"GraphDefinitionLink.Max":                                         "string",
+	"GraphDefinitionLink.Min":                                         "integer",
+	"GraphDefinitionLink.Path":                                        "string",
+	"GraphDefinitionLink.SliceName":                                   "string",
+	"GraphDefinitionLinkTarget.Id":                                    "string",
+	"GraphDefinitionLinkTarget.Params":                                "string",
+	"GraphDefinitionLinkTarget.Profile":                               "string",
+	"GraphDefinitionLinkTarget.Type":                                  "code",
+	"GraphDefinitionLinkTargetCompartment.Code":                       "code",
+	"GraphDefinitionLinkTargetCompartment.Description":                "string",
+	"GraphDefinitionLinkTargetCompartment.Expression":                 "string",
+	"GraphDefinitionLinkTargetCompartment.Id":                         "string",
+	"GraphDefinitionLinkTargetCompartment.Rule":                       "code",
+	"GraphDefinitionLinkTargetCompartment.Use":                        "code",
+	"Group.Active":                                                    "boolean",
+	"Group.Actual":                                                    "boolean",
+	"Group.Id":                                                        "string",
+	"Group.ImplicitRules":                                             "string",
+	"Group.Language":                                                  "string",
+	"Group.Name":                                                      "string",
+	"Group.Quantity":                                                  "unsignedInt",
+	"Group.Type":                                                      "code",
+	"GroupCharacteristic.Exclude":                                     "boolean",
+	"GroupCharacteristic.Id":                                          "string",
+	"GroupCharacteristic.ValueBoolean":                                "boolean",
+	"GroupMember.Id":                                                  "string",
+	"GroupMember.Inactive":                                            "boolean",
+	"GuidanceResponse.Id":                                             "string",
+	"GuidanceResponse.ImplicitRules":                                  "string",
+	"GuidanceResponse.Language":                                       "string",
+	"GuidanceResponse.ModuleCanonical":                                "canonical",
+	"GuidanceResponse.ModuleUri":                                      "uri",
+	"GuidanceResponse.OccurrenceDateTime":                             "dateTime",
+	"GuidanceResponse.Status":                                         "code",
+	"HealthcareService.Active":                                        "boolean",
+	"HealthcareService.AppointmentRequired":                           "boolean",
+	"HealthcareService.AvailabilityExceptions":                        "string",
+	"HealthcareService.Comment":                                       "string",
+	"HealthcareService.ExtraDetails":                                  "string",
+	"HealthcareService.Id":                                            "string",
+	"HealthcareService.ImplicitRules":                                 "string",
+	"HealthcareService.Language":                                      "string",
+	"HealthcareService.Name":                                          "string",
+	"HealthcareServiceAvailableTime.AllDay":                           "boolean",
+	"HealthcareServiceAvailableTime.AvailableEndTime":                 "time",
+	"HealthcareServiceAvailableTime.AvailableStartTime":               "time",
+	"HealthcareServiceAvailableTime.DaysOfWeek":                       "code",
+	"HealthcareServiceAvailableTime.Id":                               "string",
+	"HealthcareServiceEligibility.Comment":                            "string",
+	"HealthcareServiceEligibility.Id":                                 "string",
+	"HealthcareServiceNotAvailable.Description":                       "string",
+	"HealthcareServiceNotAvailable.Id":                                "string",
+	"HumanName.Family":                                                "string",
+	"HumanName.Given":                                                 "string",
+	"HumanName.Id":                                                    "string",
+	"HumanName.Prefix":                                                "string",
+	"HumanName.Suffix":                                                "string",
+	"HumanName.Text":                                                  "string",
+	"HumanName.Use":                                                   "code",
+	"Identifier.Id":                                                   "string",
+	"Identifier.System":                                               "string",
+	"Identifier.Use":                                                  "code",
+	"Identifier.Value":                                                "string",
+	"ImagingStudy.Description":                                        "string",
+	"ImagingStudy.Id":                                                 "string",
+	"ImagingStudy.ImplicitRules":                                      "string",
+	"ImagingStudy.Language":                                           "string",
+	"ImagingStudy.NumberOfInstances":                                  "unsignedInt",
+	"ImagingStudy.NumberOfSeries":                                     "unsignedInt",
+	"ImagingStudy.Started":                                            "dateTime",
+	"ImagingStudy.Status":                                             "code",
+	"ImagingStudySeries.Description":                                  "string",
+	"ImagingStudySeries.Id":                                           "string",
+	"ImagingStudySeries.Number":                                       "unsignedInt",
+	"ImagingStudySeries.NumberOfInstances":                            "unsignedInt",
+	"ImagingStudySeries.Started":                                      "dateTime",
+	"ImagingStudySeries.Uid":                                          "string",
+	"ImagingStudySeriesInstance.Id":                                   "string",
+	"ImagingStudySeriesInstance.Number":                               "unsignedInt",
+	"ImagingStudySeriesInstance.Title":                                "string",
+	"ImagingStudySeriesInstance.Uid":                                  "string",
+	"ImagingStudySeriesPerformer.Id":                                  "string",
+	"Immunization.ExpirationDate":                                     "date",
+	"Immunization.Id":                                                 "string",
+	"Immunization.ImplicitRules":                                      "string",
+	"Immunization.IsSubpotent":                                        "boolean",
+	"Immunization.Language":                                           "string",
+	"Immunization.LotNumber":                                          "string",
+	"Immunization.OccurrenceDateTime":                                 "dateTime",
+	"Immunization.OccurrenceString":                                   "string",
+	"Immunization.PrimarySource":                                      "boolean",
+	"Immunization.Recorded":                                           "dateTime",
+	"Immunization.Status":                                             "code",
+	"ImmunizationEducation.DocumentType":                              "string",
+	"ImmunizationEducation.Id":                                        "string",
+	"ImmunizationEducation.PresentationDate":                          "dateTime",
+	"ImmunizationEducation.PublicationDate":                           "dateTime",
+	"ImmunizationEducation.Reference":                                 "string",
+	"ImmunizationEvaluation.Date":                                     "dateTime",
+	"ImmunizationEvaluation.Description":                              "string",
+	"ImmunizationEvaluation.DoseNumberPositiveInt":                    "positiveInt",
+	"ImmunizationEvaluation.DoseNumberString":                         "string",
+	"ImmunizationEvaluation.Id":                                       "string",
+	"ImmunizationEvaluation.ImplicitRules":                            "string",
+	"ImmunizationEvaluation.Language":                                 "string",
+	"ImmunizationEvaluation.Series":                                   "string",
+	"ImmunizationEvaluation.SeriesDosesPositiveInt":                   "positiveInt",
+	"ImmunizationEvaluation.SeriesDosesString":                        "string",
+	"ImmunizationEvaluation.Status":                                   "code",
+	"ImmunizationPerformer.Id":                                        "string",
+	"ImmunizationProtocolApplied.DoseNumberPositiveInt":               "positiveInt",
+	"ImmunizationProtocolApplied.DoseNumberString":                    "string",
+	"ImmunizationProtocolApplied.Id":                                  "string",
+	"ImmunizationProtocolApplied.Series":                              "string",
+	"ImmunizationProtocolApplied.SeriesDosesPositiveInt":              "positiveInt",
+	"ImmunizationProtocolApplied.SeriesDosesString":                   "string",
+	"ImmunizationReaction.Date":                                       "dateTime",
+	"ImmunizationReaction.Id":                                         "string",
+	"ImmunizationReaction.Reported":                                   "boolean",
+	"ImmunizationRecommendation.Date":                                 "dateTime",
+	"ImmunizationRecommendation.Id":                                   "string",
+	"ImmunizationRecommendation.ImplicitRules":                        "string",
+	"ImmunizationRecommendation.Language":                             "string",
+	"ImmunizationRecommendationRecommendation.Description":            "string",
+	"ImmunizationRecommendationRecommendation.DoseNumberPositiveInt":  "positiveInt",
+	"ImmunizationRecommendationRecommendation.DoseNumberString":       "string",
+	"ImmunizationRecommendationRecommendation.Id":                     "string",
+	"ImmunizationRecommendationRecommendation.Series":                 "string",
+	"ImmunizationRecommendationRecommendation.SeriesDosesPositiveInt": "positiveInt",
+	"ImmunizationRecommendationRecommendation.SeriesDosesString":      "string",
+	"ImmunizationRecommendationRecommendationDateCriterion.Id":        "string",
+	"ImmunizationRecommendationRecommendationDateCriterion.Value":     "dateTime",
+	"ImplementationGuide.Copyright":                                   "string",
+	"ImplementationGuide.Date":                                        "dateTime",
+	"ImplementationGuide.Description":                                 "string",
+	"ImplementationGuide.Experimental":                                "boolean",
+	"ImplementationGuide.FhirVersion":                                 "code",
+	"ImplementationGuide.Id":                                          "string",
+	"ImplementationGuide.ImplicitRules":                               "string",
+	"ImplementationGuide.Language":                                    "string",
+	"ImplementationGuide.License":                                     "code",
+	"ImplementationGuide.Name":                                        "string",
+	"ImplementationGuide.PackageId":                                   "string",
+	"ImplementationGuide.Publisher":                                   "string",
+	"ImplementationGuide.Status":                                      "code",
+	"ImplementationGuide.Title":                                       "string",
+	"ImplementationGuide.Url":                                         "string",
+	"ImplementationGuide.Version":                                     "string",
+	"ImplementationGuideDefinition.Id":                                "string",
+	"ImplementationGuideDefinitionGrouping.Description":               "string",
+	"ImplementationGuideDefinitionGrouping.Id":                        "string",
+	"ImplementationGuideDefinitionGrouping.Name":                      "string",
+	"ImplementationGuideDefinitionPage.Generation":                    "code",
+	"ImplementationGuideDefinitionPage.Id":                            "string",
+	"ImplementationGuideDefinitionPage.NameUrl":                       "url",
+	"ImplementationGuideDefinitionPage.Title":                         "string",
+	"ImplementationGuideDefinitionParameter.Code":                     "code",
+	"ImplementationGuideDefinitionParameter.Id":                       "string",
+	"ImplementationGuideDefinitionParameter.Value":                    "string",
+	"ImplementationGuideDefinitionResource.Description":               "string",
+	"ImplementationGuideDefinitionResource.ExampleBoolean":            "boolean",
+	"ImplementationGuideDefinitionResource.ExampleCanonical":          "canonical",
+	"ImplementationGuideDefinitionResource.FhirVersion":               "code",
+	"ImplementationGuideDefinitionResource.GroupingId":                "string",
+	"ImplementationGuideDefinitionResource.Id":                        "string",
+	"ImplementationGuideDefinitionResource.Name":                      "string",
+	"ImplementationGuideDefinitionTemplate.Code":                      "string",
+	"ImplementationGuideDefinitionTemplate.Id":                        "string",
+	"ImplementationGuideDefinitionTemplate.Scope":                     "string",
+	"ImplementationGuideDefinitionTemplate.Source":                    "string",
+	"ImplementationGuideDependsOn.Id":                                 "string",
+	"ImplementationGuideDependsOn.PackageId":                          "string",
+	"ImplementationGuideDependsOn.Uri":                                "string",
+	"ImplementationGuideDependsOn.Version":                            "string",
+	"ImplementationGuideGlobal.Id":                                    "string",
+	"ImplementationGuideGlobal.Profile":                               "string",
+	"ImplementationGuideGlobal.Type":                                  "code",
+	"ImplementationGuideManifest.Id":                                  "string",
+	"ImplementationGuideManifest.Image":                               "string",
+	"ImplementationGuideManifest.Other":                               "string",
+	"ImplementationGuideManifest.Rendering":                           "string",
+	"ImplementationGuideManifestPage.Anchor":                          "string",
+	"ImplementationGuideManifestPage.Id":                              "string",
+	"ImplementationGuideManifestPage.Name":                            "string",
+	"ImplementationGuideManifestPage.Title":                           "string",
+	"ImplementationGuideManifestResource.ExampleBoolean":              "boolean",
+	"ImplementationGuideManifestResource.ExampleCanonical":            "canonical",
+	"ImplementationGuideManifestResource.Id":                          "string",
+	"ImplementationGuideManifestResource.RelativePath":                "string",
+	"InsurancePlan.Alias":                                             "string",
+	"InsurancePlan.Id":                                                "string",
+	"InsurancePlan.ImplicitRules":                                     "string",
+	"InsurancePlan.Language":                                          "string",
+	"InsurancePlan.Name":                                              "string",
+	"InsurancePlan.Status":                                            "code",
+	"InsurancePlanContact.Id":                                         "string",
+	"InsurancePlanCoverage.Id":                                        "string",
+	"InsurancePlanCoverageBenefit.Id":                                 "string",
+	"InsurancePlanCoverageBenefit.Requirement":                        "string",
+	"InsurancePlanCoverageBenefitLimit.Id":                            "string",
+	"InsurancePlanPlan.Id":                                            "string",
+	"InsurancePlanPlanGeneralCost.Comment":                            "string",
+	"InsurancePlanPlanGeneralCost.GroupSize":                          "positiveInt",
+	"InsurancePlanPlanGeneralCost.Id":                                 "string",
+	"InsurancePlanPlanSpecificCost.Id":                                "string",
+	"InsurancePlanPlanSpecificCostBenefit.Id":                         "string",
+	"InsurancePlanPlanSpecificCostBenefitCost.Id":                     "string",
+	"Invoice.CancelledReason":                                         "string",
+	"Invoice.Date":                                                    "dateTime",
+	"Invoice.Id":                                                      "string",
+	"Invoice.ImplicitRules":                                           "string",
+	"Invoice.Language":                                                "string",
+	"Invoice.PaymentTerms":                                            "string",
+	"Invoice.Status":                                                  "code",
+	"InvoiceLineItem.Id":                                              "string",
+	"InvoiceLineItem.Sequence":                                        "positiveInt",
+	"InvoiceLineItemPriceComponent.Factor":                            "decimal",
+	"InvoiceLineItemPriceComponent.Id":                                "string",
+	"InvoiceLineItemPriceComponent.Type":                              "code",
+	"InvoiceParticipant.Id":                                           "string",
+	"Library.ApprovalDate":                                            "date",
+	"Library.Copyright":                                               "string",
+	"Library.Date":                                                    "dateTime",
+	"Library.Description":                                             "string",
+	"Library.Experimental":                                            "boolean",
+	"Library.Id":                                                      "string",
+	"Library.ImplicitRules":                                           "string",
+	"Library.Language":                                                "string",
+	"Library.LastReviewDate":                                          "date",
+	"Library.Name":                                                    "string",
+	"Library.Publisher":                                               "string",
+	"Library.Purpose":                                                 "string",
+	"Library.Status":                                                  "code",
+	"Library.Subtitle":                                                "string",
+	"Library.Title":                                                   "string",
+	"Library.Url":                                                     "string",
+	"Library.Usage":                                                   "string",
+	"Library.Version":                                                 "string",
+	"Linkage.Active":                                                  "boolean",
+	"Linkage.Id":                                                      "string",
+	"Linkage.ImplicitRules":                                           "string",
+	"Linkage.Language":                                                "string",
+	"LinkageItem.Id":                                                  "string",
+	"LinkageItem.Type":                                                "code",
+	"List.Date":                                                       "dateTime",
+	"List.Id":                                                         "string",
+	"List.ImplicitRules":                                              "string",
+	"List.Language":                                                   "string",
+	"List.Mode":                                                       "code",
+	"List.Status":                                                     "code",
+	"List.Title":                                                      "string",
+	"ListEntry.Date":                                                  "dateTime",
+	"ListEntry.Deleted":                                               "boolean",
+	"ListEntry.Id":                                                    "string",
+	"Location.Alias":                                                  "string",
+	"Location.AvailabilityExceptions":                                 "string",
+	"Location.Description":                                            "string",
+	"Location.Id":                                                     "string",
+	"Location.ImplicitRules":                                          "string",
+	"Location.Language":                                               "string",
+	"Location.Mode":                                                   "code",
+	"Location.Name":                                                   "string",
+	"Location.Status":                                                 "code",
+	"LocationHoursOfOperation.AllDay":                                 "boolean",
+	"LocationHoursOfOperation.ClosingTime":                            "time",
+	"LocationHoursOfOperation.DaysOfWeek":                             "code",
+	"LocationHoursOfOperation.Id":                                     "string",
+	"LocationHoursOfOperation.OpeningTime":                            "time",
+	"LocationPosition.Altitude":                                       "decimal",
+	"LocationPosition.Id":                                             "string",
+	"LocationPosition.Latitude":                                       "decimal",
+	"LocationPosition.Longitude":                                      "decimal",
+	"MarketingStatus.Id":                                              "string",
+	"MarketingStatus.RestoreDate":                                     "dateTime",
+	"Measure.ApprovalDate":                                            "date",
+	"Measure.ClinicalRecommendationStatement":                         "string",
+	"Measure.Copyright":                                               "string",
+	"Measure.Date":                                                    "dateTime",
+	"Measure.Definition":                                              "string",
+	"Measure.Description":                                             "string",
+	"Measure.Disclaimer":                                              "string",
+	"Measure.Experimental":                                            "boolean",
+	"Measure.Guidance":                                                "string",
+	"Measure.Id":                                                      "string",
+	"Measure.ImplicitRules":                                           "string",
+	"Measure.Language":                                                "string",
+	"Measure.LastReviewDate":                                          "date",
+	"Measure.Library":                                                 "string",
+	"Measure.Name":                                                    "string",
+	"Measure.Publisher":                                               "string",
+	"Measure.Purpose":                                                 "string",
+	"Measure.RateAggregation":                                         "string",
+	"Measure.Rationale":                                               "string",
+	"Measure.RiskAdjustment":                                          "string",
+	"Measure.Status":                                                  "code",
+	"Measure.Subtitle":                                                "string",
+	"Measure.Title":                                                   "string",
+	"Measure.Url":                                                     "string",
+	"Measure.Usage":                                                   "string",
+	"Measure.Version":                                                 "string",
+	"MeasureGroup.Description":                                        "string",
+	"MeasureGroup.Id":                                                 "string",
+	"MeasureGroupPopulation.Description":                              "string",
+	"MeasureGroupPopulation.Id":                                       "string",
+	"MeasureGroupStratifier.Description":                              "string",
+	"MeasureGroupStratifier.Id":                                       "string",
+	"MeasureGroupStratifierComponent.Description":                     "string",
+	"MeasureGroupStratifierComponent.Id":                              "string",
+	"MeasureReport.Date":                                              "dateTime",
+	"MeasureReport.Id":                                                "string",
+	"MeasureReport.ImplicitRules":                                     "string",
+	"MeasureReport.Language":                                          "string",
+	"MeasureReport.Measure":                                           "string",
+	"MeasureReport.Status":                                            "code",
+	"MeasureReport.Type":                                              "code",
+	"MeasureReportGroup.Id":                                           "string",
+	"MeasureReportGroupPopulation.Count":                              "integer",
+	"MeasureReportGroupPopulation.Id":                                 "string",
+	"MeasureReportGroupStratifier.Id":                                 "string",
+	"MeasureReportGroupStratifierStratum.Id":                          "string",
+	"MeasureReportGroupStratifierStratumComponent.Id":                 "string",
+	"MeasureReportGroupStratifierStratumPopulation.Count":             "integer",
+	"MeasureReportGroupStratifierStratumPopulation.Id":                "string",
+	"MeasureSupplementalData.Description":                             "string",
+	"MeasureSupplementalData.Id":                                      "string",
+	"Media.CreatedDateTime":                                           "dateTime",
+	"Media.DeviceName":                                                "string",
+	"Media.Duration":                                                  "decimal",
+	"Media.Frames":                                                    "positiveInt",
+	"Media.Height":                                                    "positiveInt",
+	"Media.Id":                                                        "string",
+	"Media.ImplicitRules":                                             "string",
+	"Media.Issued":                                                    "instant",
+	"Media.Language":                                                  "string",
+	"Media.Status":                                                    "code",
+	"Media.Width":                                                     "positiveInt",
+	"Medication.Id":                                                   "string",
+	"Medication.ImplicitRules":                                        "string",
+	"Medication.Language":                                             "string",
+	"Medication.Status":                                               "string",
+	"MedicationAdministration.EffectiveDateTime":                      "dateTime",
+	"MedicationAdministration.Id":                                     "string",
+	"MedicationAdministration.ImplicitRules":                          "string",
+	"MedicationAdministration.Instantiates":                           "string",
+	"MedicationAdministration.Language":                               "string",
+	"MedicationAdministration.Status":                                 "string",
+	"MedicationAdministrationDosage.Id":                               "string",
+	"MedicationAdministrationDosage.Text":                             "string",
+	"MedicationAdministrationPerformer.Id":                            "string",
+	"MedicationBatch.ExpirationDate":                                  "dateTime",
+	"MedicationBatch.Id":                                              "string",
+	"MedicationBatch.LotNumber":                                       "string",
+	"MedicationDispense.Id":                                           "string",
+	"MedicationDispense.ImplicitRules":                                "string",
+	"MedicationDispense.Language":                                     "string",
+	"MedicationDispense.Status":                                       "string",
+	"MedicationDispense.WhenHandedOver":                               "dateTime",
+	"MedicationDispense.WhenPrepared":                                 "dateTime",
+	"MedicationDispensePerformer.Id":                                  "string",
+	"MedicationDispenseSubstitution.Id":                               "string",
+	"MedicationDispenseSubstitution.WasSubstituted":                   "boolean",
+	"MedicationIngredient.Id":                                         "string",
+	"MedicationIngredient.IsActive":                                   "boolean",
+	"MedicationKnowledge.Id":                                          "string",
+	"MedicationKnowledge.ImplicitRules":                               "string",
+	"MedicationKnowledge.Language":                                    "string",
+	"MedicationKnowledge.PreparationInstruction":                      "string",
+	"MedicationKnowledge.Status":                                      "string",
+	"MedicationKnowledge.Synonym":                                     "string",
+	"MedicationKnowledgeAdministrationGuidelines.Id":                  "string",
+	"MedicationKnowledgeAdministrationGuidelinesDosage.Id":            "string",
+	"MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics.Id":    "string",
+	"MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics.Value": "string",
+	"MedicationKnowledgeCost.Id":                                                             "string",
+	"MedicationKnowledgeCost.Source":                                                         "string",
+	"MedicationKnowledgeDrugCharacteristic.Id":                                               "string",
+	"MedicationKnowledgeDrugCharacteristic.ValueBase64Binary":                                "base64Binary",
+	"MedicationKnowledgeDrugCharacteristic.ValueString":                                      "string",
+	"MedicationKnowledgeIngredient.Id":                                                       "string",
+	"MedicationKnowledgeIngredient.IsActive":                                                 "boolean",
+	"MedicationKnowledgeKinetics.Id":                                                         "string",
+	"MedicationKnowledgeMedicineClassification.Id":                                           "string",
+	"MedicationKnowledgeMonitoringProgram.Id":                                                "string",
+	"MedicationKnowledgeMonitoringProgram.Name":                                              "string",
+	"MedicationKnowledgeMonograph.Id":                                                        "string",
+	"MedicationKnowledgePackaging.Id":                                                        "string",
+	"MedicationKnowledgeRegulatory.Id":                                                       "string",
+	"MedicationKnowledgeRegulatoryMaxDispense.Id":                                            "string",
+	"MedicationKnowledgeRegulatorySchedule.Id":                                               "string",
+	"MedicationKnowledgeRegulatorySubstitution.Allowed":                                      "boolean",
+	"MedicationKnowledgeRegulatorySubstitution.Id":                                           "string",
+	"MedicationKnowledgeRelatedMedicationKnowledge.Id":                                       "string",
+	"MedicationRequest.AuthoredOn":                                                           "dateTime",
+	"MedicationRequest.DoNotPerform":                                                         "boolean",
+	"MedicationRequest.Id":                                                                   "string",
+	"MedicationRequest.ImplicitRules":                                                        "string",
+	"MedicationRequest.InstantiatesCanonical":                                                "string",
+	"MedicationRequest.InstantiatesUri":                                                      "string",
+	"MedicationRequest.Intent":                                                               "string",
+	"MedicationRequest.Language":                                                             "string",
+	"MedicationRequest.Priority":                                                             "code",
+	"MedicationRequest.ReportedBoolean":                                                      "boolean",
+	"MedicationRequest.Status":                                                               "string",
+	"MedicationRequestDispenseRequest.Id":                                                    "string",
+	"MedicationRequestDispenseRequest.NumberOfRepeatsAllowed":                                "unsignedInt",
+	"MedicationRequestDispenseRequestInitialFill.Id":                                         "string",
+	"MedicationRequestSubstitution.AllowedBoolean":                                           "boolean",
+	"MedicationRequestSubstitution.Id":                                                       "string",
+	"MedicationStatement.DateAsserted":                                                       "dateTime",
+	"MedicationStatement.EffectiveDateTime":                                                  "dateTime",
+	"MedicationStatement.Id":                                                                 "string",
+	"MedicationStatement.ImplicitRules":                                                      "string",
+	"MedicationStatement.Language":                                                           "string",
+	"MedicationStatement.Status":                                                             "string",
+	"MedicinalProduct.Id":                                                                    "string",
+	"MedicinalProduct.ImplicitRules":                                                         "string",
+	"MedicinalProduct.Language":                                                              "string",
+	"MedicinalProduct.SpecialMeasures":                                                       "string",
+	"MedicinalProductAuthorization.DateOfFirstAuthorization":                                 "dateTime",
+	"MedicinalProductAuthorization.Id":                                                       "string",
+	"MedicinalProductAuthorization.ImplicitRules":                                            "string",
+	"MedicinalProductAuthorization.InternationalBirthDate":                                   "dateTime",
+	"MedicinalProductAuthorization.Language":                                                 "string",
+	"MedicinalProductAuthorization.RestoreDate":                                              "dateTime",
+	"MedicinalProductAuthorization.StatusDate":                                               "dateTime",
+	"MedicinalProductAuthorizationJurisdictionalAuthorization.Id":                            "string",
+	"MedicinalProductAuthorizationProcedure.DateDateTime":                                    "dateTime",
+	"MedicinalProductAuthorizationProcedure.Id":                                              "string",
+	"MedicinalProductContraindication.Id":                                                    "string",
+	"MedicinalProductContraindication.ImplicitRules":                                         "string",
+	"MedicinalProductContraindication.Language":                                              "string",
+	"MedicinalProductContraindicationOtherTherapy.Id":                                        "string",
+	"MedicinalProductIndication.Id":                                                          "string",
+	"MedicinalProductIndication.ImplicitRules":                                               "string",
+	"MedicinalProductIndication.Language":                                                    "string",
+	"MedicinalProductIndicationOtherTherapy.Id":                                              "string",
+	"MedicinalProductIngredient.AllergenicIndicator":                                         "boolean",
+	"MedicinalProductIngredient.Id":                                                          "string",
+	"MedicinalProductIngredient.ImplicitRules":                                               "string",
+	"MedicinalProductIngredient.Language":                                                    "string",
+	"MedicinalProductIngredientSpecifiedSubstance.Id":                                        "string",
+	"MedicinalProductIngredientSpecifiedSubstanceStrength.Id":                                "string",
+	"MedicinalProductIngredientSpecifiedSubstanceStrength.MeasurementPoint":                  "string",
+	"MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength.Id":               "string",
+	"MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength.MeasurementPoint": "string",
+	"MedicinalProductIngredientSubstance.Id":                                                 "string",
+	"MedicinalProductInteraction.Description":                                                "string",
+	"MedicinalProductInteraction.Id":                                                         "string",
+	"MedicinalProductInteraction.ImplicitRules":                                              "string",
+	"MedicinalProductInteraction.Language":                                                   "string",
+	"MedicinalProductInteractionInteractant.Id":                                              "string",
+	"MedicinalProductManufactured.Id":                                                        "string",
+	"MedicinalProductManufactured.ImplicitRules":                                             "string",
+	"MedicinalProductManufactured.Language":                                                  "string",
+	"MedicinalProductManufacturingBusinessOperation.EffectiveDate":                           "dateTime",
+	"MedicinalProductManufacturingBusinessOperation.Id":                                      "string",
+	"MedicinalProductName.Id":                                                                "string",
+	"MedicinalProductName.ProductName":                                                       "string",
+	"MedicinalProductNameCountryLanguage.Id":                                                 "string",
+	"MedicinalProductNameNamePart.Id":                                                        "string",
+	"MedicinalProductNameNamePart.Part":                                                      "string",
+	"MedicinalProductPackaged.Description":                                                   "string",
+	"MedicinalProductPackaged.Id":                                                            "string",
+	"MedicinalProductPackaged.ImplicitRules":                                                 "string",
+	"MedicinalProductPackaged.Language":                                                      "string",
+	"MedicinalProductPackagedBatchIdentifier.Id":                                             "string",
+	"MedicinalProductPackagedPackageItem.Id":                                                 "string",
+	"MedicinalProductPharmaceutical.Id":                                                      "string",
+	"MedicinalProductPharmaceutical.ImplicitRules":                                           "string",
+	"MedicinalProductPharmaceutical.Language":                                                "string",
+	"MedicinalProductPharmaceuticalCharacteristics.Id":                                       "string",
+	"MedicinalProductPharmaceuticalRouteOfAdministration.Id":                                 "string",
+	"MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies.Id":                    "string",
+	"MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod.Id":    "string",
+	"MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod.SupportingInformation": "string",
+	"MedicinalProductSpecialDesignation.Date":                   "dateTime",
+	"MedicinalProductSpecialDesignation.Id":                     "string",
+	"MedicinalProductUndesirableEffect.Id":                      "string",
+	"MedicinalProductUndesirableEffect.ImplicitRules":           "string",
+	"MedicinalProductUndesirableEffect.Language":                "string",
+	"MessageDefinition.Base":                                    "string",
+	"MessageDefinition.Category":                                "code",
+	"MessageDefinition.Copyright":                               "string",
+	"MessageDefinition.Date":                                    "dateTime",
+	"MessageDefinition.Description":                             "string",
+	"MessageDefinition.EventUri":                                "uri",
+	"MessageDefinition.Experimental":                            "boolean",
+	"MessageDefinition.Graph":                                   "string",
+	"MessageDefinition.Id":                                      "string",
+	"MessageDefinition.ImplicitRules":                           "string",
+	"MessageDefinition.Language":                                "string",
+	"MessageDefinition.Name":                                    "string",
+	"MessageDefinition.Parent":                                  "string",
+	"MessageDefinition.Publisher":                               "string",
+	"MessageDefinition.Purpose":                                 "string",
+	"MessageDefinition.Replaces":                                "string",
+	"MessageDefinition.ResponseRequired":                        "string",
+	"MessageDefinition.Status":                                  "code",
+	"MessageDefinition.Title":                                   "string",
+	"MessageDefinition.Url":                                     "string",
+	"MessageDefinition.Version":                                 "string",
+	"MessageDefinitionAllowedResponse.Id":                       "string",
+	"MessageDefinitionAllowedResponse.Message":                  "string",
+	"MessageDefinitionAllowedResponse.Situation":                "string",
+	"MessageDefinitionFocus.Code":                               "code",
+	"MessageDefinitionFocus.Id":                                 "string",
+	"MessageDefinitionFocus.Max":                                "string",
+	"MessageDefinitionFocus.Min":                                "unsignedInt",
+	"MessageDefinitionFocus.Profile":                            "string",
+	"MessageHeader.Definition":                                  "string",
+	"MessageHeader.EventUri":                                    "uri",
+	"MessageHeader.Id":                                          "string",
+	"MessageHeader.ImplicitRules":                               "string",
+	"MessageHeader.Language":                                    "string",
+	"MessageHeaderDestination.Endpoint":                         "string",
+	"MessageHeaderDestination.Id":                               "string",
+	"MessageHeaderDestination.Name":                             "string",
+	"MessageHeaderResponse.Code":                                "code",
+	"MessageHeaderResponse.Id":                                  "string",
+	"MessageHeaderResponse.Identifier":                          "string",
+	"MessageHeaderSource.Endpoint":                              "string",
+	"MessageHeaderSource.Id":                                    "string",
+	"MessageHeaderSource.Name":                                  "string",
+	"MessageHeaderSource.Software":                              "string",
+	"MessageHeaderSource.Version":                               "string",
+	"Meta.Id":                                                   "string",
+	"Meta.LastUpdated":                                          "instant",
+	"Meta.Profile":                                              "string",
+	"Meta.Source":                                               "string",
+	"Meta.VersionId":                                            "string",
+	"MolecularSequence.CoordinateSystem":                        "integer",
+	"MolecularSequence.Id":                                      "string",
+	"MolecularSequence.ImplicitRules":                           "string",
+	"MolecularSequence.Language":                                "string",
+	"MolecularSequence.ObservedSeq":                             "string",
+	"MolecularSequence.ReadCoverage":                            "integer",
+	"MolecularSequence.Type":                                    "string",
+	"MolecularSequenceQuality.End":                              "integer",
+	"MolecularSequenceQuality.FScore":                           "decimal",
+	"MolecularSequenceQuality.GtFP":                             "decimal",
+	"MolecularSequenceQuality.Id":                               "string",
+	"MolecularSequenceQuality.Precision":                        "decimal",
+	"MolecularSequenceQuality.QueryFP":                          "decimal",
+	"MolecularSequenceQuality.QueryTP":                          "decimal",
+	"MolecularSequenceQuality.Recall":                           "decimal",
+	"MolecularSequenceQuality.Start":                            "integer",
+	"MolecularSequenceQuality.TruthFN":                          "decimal",
+	"MolecularSequenceQuality.TruthTP":                          "decimal",
+	"MolecularSequenceQuality.Type":                             "string",
+	"MolecularSequenceQualityRoc.FMeasure":                      "decimal",
+	"MolecularSequenceQualityRoc.Id":                            "string",
+	"MolecularSequenceQualityRoc.NumFN":                         "integer",
+	"MolecularSequenceQualityRoc.NumFP":                         "integer",
+	"MolecularSequenceQualityRoc.NumTP":                         "integer",
+	"MolecularSequenceQualityRoc.Precision":                     "decimal",
+	"MolecularSequenceQualityRoc.Score":                         "integer",
+	"MolecularSequenceQualityRoc.Sensitivity":                   "decimal",
+	"MolecularSequenceReferenceSeq.GenomeBuild":                 "string",
+	"MolecularSequenceReferenceSeq.Id":                          "string",
+	"MolecularSequenceReferenceSeq.Orientation":                 "string",
+	"MolecularSequenceReferenceSeq.ReferenceSeqString":          "string",
+	"MolecularSequenceReferenceSeq.Strand":                      "string",
+	"MolecularSequenceReferenceSeq.WindowEnd":                   "integer",
+	"MolecularSequenceReferenceSeq.WindowStart":                 "integer",
+	"MolecularSequenceRepository.DatasetId":                     "string",
+	"MolecularSequenceRepository.Id":                            "string",
+	"MolecularSequenceRepository.Name":                          "string",
+	"MolecularSequenceRepository.ReadsetId":                     "string",
+	"MolecularSequenceRepository.Type":                          "string",
+	"MolecularSequenceRepository.Url":                           "string",
+	"MolecularSequenceRepository.VariantsetId":                  "string",
+	"MolecularSequenceStructureVariant.Exact":                   "boolean",
+	"MolecularSequenceStructureVariant.Id":                      "string",
+	"MolecularSequenceStructureVariant.Length":                  "integer",
+	"MolecularSequenceStructureVariantInner.End":                "integer",
+	"MolecularSequenceStructureVariantInner.Id":                 "string",
+	"MolecularSequenceStructureVariantInner.Start":              "integer",
+	"MolecularSequenceStructureVariantOuter.End":                "integer",
+	"MolecularSequenceStructureVariantOuter.Id":                 "string",
+	"MolecularSequenceStructureVariantOuter.Start":              "integer",
+	"MolecularSequenceVariant.Cigar":                            "string",
+	"MolecularSequenceVariant.End":                              "integer",
+	"MolecularSequenceVariant.Id":                               "string",
+	"MolecularSequenceVariant.ObservedAllele":                   "string",
+	"MolecularSequenceVariant.ReferenceAllele":                  "string",
+	"MolecularSequenceVariant.Start":                            "integer",
+	"Money.Currency":                                            "string",
+	"Money.Id":                                                  "string",
+	"Money.Value":                                               "decimal",
+	"NamingSystem.Date":                                         "dateTime",
+	"NamingSystem.Description":                                  "string",
+	"NamingSystem.Id":                                           "string",
+	"NamingSystem.ImplicitRules":                                "string",
+	"NamingSystem.Kind":                                         "code",
+	"NamingSystem.Language":                                     "string",
+	"NamingSystem.Name":                                         "string",
+	"NamingSystem.Publisher":                                    "string",
+	"NamingSystem.Responsible":                                  "string",
+	"NamingSystem.Status":                                       "code",
+	"NamingSystem.Usage":                                        "string",
+	"NamingSystemUniqueId.Comment":                              "string",
+	"NamingSystemUniqueId.Id":                                   "string",
+	"NamingSystemUniqueId.Preferred":                            "boolean",
+	"NamingSystemUniqueId.Type":                                 "code",
+	"NamingSystemUniqueId.Value":                                "string",
+	"Narrative.Div":                                             "xhtml",
+	"Narrative.Id":                                              "string",
+	"Narrative.Status":                                          "code",
+	"NutritionOrder.DateTime":                                   "dateTime",
+	"NutritionOrder.Id":                                         "string",
+	"NutritionOrder.ImplicitRules":                              "string",
+	"NutritionOrder.Instantiates":                               "string",
+	"NutritionOrder.InstantiatesCanonical":                      "string",
+	"NutritionOrder.InstantiatesUri":                            "string",
+	"NutritionOrder.Intent":                                     "code",
+	"NutritionOrder.Language":                                   "string",
+	"NutritionOrder.Status":                                     "code",
+	"NutritionOrderEnteralFormula.AdditiveProductName":          "string",
+	"NutritionOrderEnteralFormula.AdministrationInstruction":    "string",
+	"NutritionOrderEnteralFormula.BaseFormulaProductName":       "string",
+	"NutritionOrderEnteralFormula.Id":                           "string",
+	"NutritionOrderEnteralFormulaAdministration.Id":             "string",
+	"NutritionOrderOralDiet.Id":                                 "string",
+	"NutritionOrderOralDiet.Instruction":                        "string",
+	"NutritionOrderOralDietNutrient.Id":                         "string",
+	"NutritionOrderOralDietTexture.Id":                          "string",
+	"NutritionOrderSupplement.Id":                               "string",
+	"NutritionOrderSupplement.Instruction":                      "string",
+	"NutritionOrderSupplement.ProductName":                      "string",
+	"Observation.EffectiveDateTime":                             "dateTime",
+	"Observation.EffectiveInstant":                              "instant",
+	"Observation.Id":                                            "string",
+	"Observation.ImplicitRules":                                 "string",
+	"Observation.Issued":                                        "instant",
+	"Observation.Language":                                      "string",
+	"Observation.Status":                                        "code",
+	"Observation.ValueBoolean":                                  "boolean",
+	"Observation.ValueDateTime":                                 "dateTime",
+	"Observation.ValueInteger":                                  "integer",
+	"Observation.ValueString":                                   "string",
+	"Observation.ValueTime":                                     "time",
+	"ObservationComponent.Id":                                   "string",
+	"ObservationComponent.ValueBoolean":                         "boolean",
+	"ObservationComponent.ValueDateTime":                        "dateTime",
+	"ObservationComponent.ValueInteger":                         "integer",
+	"ObservationComponent.ValueString":                          "string",
+	"ObservationComponent.ValueTime":                            "time",
+	"ObservationDefinition.Id":                                  "string",
+	"ObservationDefinition.ImplicitRules":                       "string",
+	"ObservationDefinition.Language":                            "string",
+	"ObservationDefinition.MultipleResultsAllowed":              "boolean",
+	"ObservationDefinition.PermittedDataType":                   "code",
+	"ObservationDefinition.PreferredReportName":                 "string",
+	"ObservationDefinitionQualifiedInterval.Category":           "code",
+	"ObservationDefinitionQualifiedInterval.Condition":          "string",
+	"ObservationDefinitionQualifiedInterval.Gender":             "code",
+	"ObservationDefinitionQualifiedInterval.Id":                 "string",
+	"ObservationDefinitionQuantitativeDetails.ConversionFactor": "decimal",
+	"ObservationDefinitionQuantitativeDetails.DecimalPrecision": "integer",
+	"ObservationDefinitionQuantitativeDetails.Id":               "string",
+	"ObservationReferenceRange.Id":                              "string",
+	"ObservationReferenceRange.Text":                            "string",
+	"OperationDefinition.AffectsState":                          "boolean",
+	"OperationDefinition.Base":                                  "string",
+	"OperationDefinition.Code":                                  "string",
+	"OperationDefinition.Comment":                               "string",
+	"OperationDefinition.Date":                                  "dateTime",
+	"OperationDefinition.Description":                           "string",
+	"OperationDefinition.Experimental":                          "boolean",
+	"OperationDefinition.Id":                                    "string",
+	"OperationDefinition.ImplicitRules":                         "string",
+	"OperationDefinition.InputProfile":                          "string",
+	"OperationDefinition.Instance":                              "boolean",
+	"OperationDefinition.Kind":                                  "code",
+	"OperationDefinition.Language":                              "string",
+	"OperationDefinition.Name":                                  "string",
+	"OperationDefinition.OutputProfile":                         "string",
+	"OperationDefinition.Publisher":                             "string",
+	"OperationDefinition.Purpose":                               "string",
+	"OperationDefinition.Resource":                              "code",
+	"OperationDefinition.Status":                                "code",
+	"OperationDefinition.System":                                "boolean",
+	"OperationDefinition.Title":                                 "string",
+	"OperationDefinition.Type":                                  "boolean",
+	"OperationDefinition.Url":                                   "string",
+	"OperationDefinition.Version":                               "string",
+	"OperationDefinitionOverload.Comment":                       "string",
+	"OperationDefinitionOverload.Id":                            "string",
+	"OperationDefinitionOverload.ParameterName":                 "string",
+	"OperationDefinitionParameter.Documentation":                "string",
+	"OperationDefinitionParameter.Id":                           "string",
+	"OperationDefinitionParameter.Max":                          "string",
+	"OperationDefinitionParameter.Min":                          "integer",
+	"OperationDefinitionParameter.Name":                         "string",
+	"OperationDefinitionParameter.SearchType":                   "code",
+	"OperationDefinitionParameter.TargetProfile":                "string",
+	"OperationDefinitionParameter.Type":                         "string",
+	"OperationDefinitionParameter.Use":                          "code",
+	"OperationDefinitionParameterBinding.Id":                    "string",
+	"OperationDefinitionParameterBinding.Strength":              "code",
+	"OperationDefinitionParameterBinding.ValueSet":              "string",
+	"OperationDefinitionParameterReferencedFrom.Id":             "string",
+	"OperationDefinitionParameterReferencedFrom.Source":         "string",
+	"OperationDefinitionParameterReferencedFrom.SourceId":       "string",
+	"OperationOutcome.Id":                                       "string",
+	"OperationOutcome.ImplicitRules":                            "string",
+	"OperationOutcome.Language":                                 "string",
+	"OperationOutcomeIssue.Code":                                "code",
+	"OperationOutcomeIssue.Diagnostics":                         "string",
+	"OperationOutcomeIssue.Expression":                          "string",
+	"OperationOutcomeIssue.Id":                                  "string",
+	"OperationOutcomeIssue.Location":                            "string",
+	"OperationOutcomeIssue.Severity":                            "code",
+	"Organization.Active":                                       "boolean",
+	"Organization.Alias":                                        "string",
+	"Organization.Id":                                           "string",
+	"Organization.ImplicitRules":                                "string",
+	"Organization.Language":                                     "string",
+	"Organization.Name":                                         "string",
+	"OrganizationAffiliation.Active":                            "boolean",
+	"OrganizationAffiliation.Id":                                "string",
+	"OrganizationAffiliation.ImplicitRules":                     "string",
+	"OrganizationAffiliation.Language":                          "string",
+	"OrganizationContact.Id":                                    "string",
+	"ParameterDefinition.Documentation":                         "string",
+	"ParameterDefinition.Id":                                    "string",
+	"ParameterDefinition.Max":                                   "string",
+	"ParameterDefinition.Min":                                   "integer",
+	"ParameterDefinition.Name":                                  "string",
+	"ParameterDefinition.Profile":                               "string",
+	"ParameterDefinition.Type":                                  "string",
+	"ParameterDefinition.Use":                                   "code",
+	"Parameters.Id":                                             "string",
+	"Parameters.ImplicitRules":                                  "string",
+	"Parameters.Language":                                       "string",
+	"ParametersParameter.Id":                                    "string",
+	"ParametersParameter.Name":                                  "string",
+	"ParametersParameter.ValueBase64Binary":                     "base64Binary",
+	"ParametersParameter.ValueBoolean":                          "boolean",
+	"ParametersParameter.ValueCanonical":                        "canonical",
+	"ParametersParameter.ValueCode":                             "code",
+	"ParametersParameter.ValueDate":                             "date",
+	"ParametersParameter.ValueDateTime":                         "dateTime",
+	"ParametersParameter.ValueDecimal":                          "decimal",
+	"ParametersParameter.ValueId":                               "id",
+	"ParametersParameter.ValueInstant":                          "instant",
+	"ParametersParameter.ValueInteger":                          "integer",
+	"ParametersParameter.ValueMarkdown":                         "markdown",
+	"ParametersParameter.ValueOid":                              "oid",
+	"ParametersParameter.ValuePositiveInt":                      "positiveInt",
+	"ParametersParameter.ValueString":                           "string",
+	"ParametersParameter.ValueTime":                             "time",
+	"ParametersParameter.ValueUnsignedInt":                      "unsignedInt",
+	"ParametersParameter.ValueUri":                              "uri",
+	"ParametersParameter.ValueUrl":                              "url",
+	"ParametersParameter.ValueUuid":                             "uuid",
+	"Patient.Active":                                            "boolean",
+	"Patient.BirthDate":                                         "date",
+	"Patient.DeceasedBoolean":                                   "boolean",
+	"Patient.DeceasedDateTime":                                  "dateTime",
+	"Patient.Gender":                                            "code",
+	"Patient.Id":                                                "string",
+	"Patient.ImplicitRules":                                     "string",
+	"Patient.Language":                                          "string",
+	"Patient.MultipleBirthBoolean":                              "boolean",
+	"Patient.MultipleBirthInteger":                              "integer",
+	"PatientCommunication.Id":                                   "string",
+	"PatientCommunication.Preferred":                            "boolean",
+	"PatientContact.Gender":                                     "code",
+	"PatientContact.Id":                                         "string",
+	"PatientLink.Id":                                            "string",
+	"PatientLink.Type":                                          "code",
+	"PaymentNotice.Created":                                     "dateTime",
+	"PaymentNotice.Id":                                          "string",
+	"PaymentNotice.ImplicitRules":                               "string",
+	"PaymentNotice.Language":                                    "string",
+	"PaymentNotice.PaymentDate":                                 "date",
+	"PaymentNotice.Status":                                      "code",
+	"PaymentReconciliation.Created":                             "dateTime",
+	"PaymentReconciliation.Disposition":                         "string",
+	"PaymentReconciliation.Id":                                  "string",
+	"PaymentReconciliation.ImplicitRules":                       "string",
+	"PaymentReconciliation.Language":                            "string",
+	"PaymentReconciliation.Outcome":                             "code",
+	"PaymentReconciliation.PaymentDate":                         "date",
+	"PaymentReconciliation.Status":                              "code",
+	"PaymentReconciliationDetail.Date":                          "date",
+	"PaymentReconciliationDetail.Id":                            "string",
+	"PaymentReconciliationProcessNote.Id":                       "string",
+	"PaymentReconciliationProcessNote.Text":                     "string",
+	"PaymentReconciliationProcessNote.Type":                     "code",
+	"Period.End":                                                "dateTime",
+	"Period.Id":                                                 "string",
+	"Period.Start":                                              "dateTime",
+	"Person.Active":                                             "boolean",
+	"Person.BirthDate":                                          "date",
+	"Person.Gender":                                             "code",
+	"Person.Id":                                                 "string",
+	"Person.ImplicitRules":                                      "string",
+	"Person.Language":                                           "string",
+	"PersonLink.Assurance":                                      "code",
+	"PersonLink.Id":                                             "string",
+	"PlanDefinition.ApprovalDate":                               "date",
+	"PlanDefinition.Copyright":                                  "string",
+	"PlanDefinition.Date":                                       "dateTime",
+	"PlanDefinition.Description":                                "string",
+	"PlanDefinition.Experimental":                               "boolean",
+	"PlanDefinition.Id":                                         "string",
+	"PlanDefinition.ImplicitRules":                              "string",
+	"PlanDefinition.Language":                                   "string",
+	"PlanDefinition.LastReviewDate":                             "date",
+	"PlanDefinition.Library":                                    "string",
+	"PlanDefinition.Name":                                       "string",
+	"PlanDefinition.Publisher":                                  "string",
+	"PlanDefinition.Purpose":                                    "string",
+	"PlanDefinition.Status":                                     "code",
+	"PlanDefinition.Subtitle":                                   "string",
+	"PlanDefinition.Title":                                      "string",
+	"PlanDefinition.Url":                                        "string",
+	"PlanDefinition.Usage":                                      "string",
+	"PlanDefinition.Version":                                    "string",
+	"PlanDefinitionAction.CardinalityBehavior":                  "code",
+	"PlanDefinitionAction.DefinitionCanonical":                  "canonical",
+	"PlanDefinitionAction.DefinitionUri":                        "uri",
+	"PlanDefinitionAction.Description":                          "string",
+	"PlanDefinitionAction.GoalId":                               "string",
+	"PlanDefinitionAction.GroupingBehavior":                     "code",
+	"PlanDefinitionAction.Id":                                   "string",
+	"PlanDefinitionAction.PrecheckBehavior":                     "code",
+	"PlanDefinitionAction.Prefix":                               "string",
+	"PlanDefinitionAction.Priority":                             "code",
+	"PlanDefinitionAction.RequiredBehavior":                     "code",
+	"PlanDefinitionAction.SelectionBehavior":                    "code",
+	"PlanDefinitionAction.TextEquivalent":                       "string",
+	"PlanDefinitionAction.TimingDateTime":                       "dateTime",
+	"PlanDefinitionAction.Title":                                "string",
+	"PlanDefinitionAction.Transform":                            "string",
+	"PlanDefinitionActionCondition.Id":                          "string",
+	"PlanDefinitionActionCondition.Kind":                        "code",
+	"PlanDefinitionActionDynamicValue.Id":                       "string",
+	"PlanDefinitionActionDynamicValue.Path":                     "string",
+	"PlanDefinitionActionParticipant.Id":                        "string",
+	"PlanDefinitionActionParticipant.Type":                      "code",
+	"PlanDefinitionActionRelatedAction.ActionId":                "string",
+	"PlanDefinitionActionRelatedAction.Id":                      "string",
+	"PlanDefinitionActionRelatedAction.Relationship":            "code",
+	"PlanDefinitionGoal.Id":                                     "string",
+	"PlanDefinitionGoalTarget.Id":                               "string",
+	"Population.Id":                                             "string",
+	"Practitioner.Active":                                       "boolean",
+	"Practitioner.BirthDate":                                    "date",
+	"Practitioner.Gender":                                       "code",
+	"Practitioner.Id":                                           "string",
+	"Practitioner.ImplicitRules":                                "string",
+	"Practitioner.Language":                                     "string",
+	"PractitionerQualification.Id":                              "string",
+	"PractitionerRole.Active":                                   "boolean",
+	"PractitionerRole.AvailabilityExceptions":                   "string",
+	"PractitionerRole.Id":                                       "string",
+	"PractitionerRole.ImplicitRules":                            "string",
+	"PractitionerRole.Language":                                 "string",
+	"PractitionerRoleAvailableTime.AllDay":                      "boolean",
+	"PractitionerRoleAvailableTime.AvailableEndTime":            "time",
+	"PractitionerRoleAvailableTime.AvailableStartTime":          "time",
+	"PractitionerRoleAvailableTime.DaysOfWeek":                  "code",
+	"PractitionerRoleAvailableTime.Id":                          "string",
+	"PractitionerRoleNotAvailable.Description":                  "string",
+	"PractitionerRoleNotAvailable.Id":                           "string",
+	"Procedure.Id":                                              "string",
+	"Procedure.ImplicitRules":                                   "string",
+	"Procedure.InstantiatesCanonical":                           "string",
+	"Procedure.InstantiatesUri":                                 "string",
+	"Procedure.Language":                                        "string",
+	"Procedure.PerformedDateTime":                               "dateTime",
+	"Procedure.PerformedString":                                 "string",
+	"Procedure.Status":                                          "code",
+	"ProcedureFocalDevice.Id":                                   "string",
+	"ProcedurePerformer.Id":                                     "string",
+	"ProdCharacteristic.Color":                                  "string",
+	"ProdCharacteristic.Id":                                     "string",
+	"ProdCharacteristic.Imprint":                                "string",
+	"ProdCharacteristic.Shape":                                  "string",
+	"ProductShelfLife.Id":                                       "string",
+	"Provenance.Id":                                             "string",
+	"Provenance.ImplicitRules":                                  "string",
+	"Provenance.Language":                                       "string",
+	"Provenance.OccurredDateTime":                               "dateTime",
+	"Provenance.Policy":                                         "string",
+	"Provenance.Recorded":                                       "instant",
+	"ProvenanceAgent.Id":                                        "string",
+	"ProvenanceEntity.Id":                                       "string",
+	"ProvenanceEntity.Role":                                     "code",
+	"Quantity.Code":                                             "string",
+	"Quantity.Comparator":                                       "code",
+	"Quantity.Id":                                               "string",
+	"Quantity.System":                                           "string",
+	"Quantity.Unit":                                             "string",
+	"Quantity.Value":                                            "decimal",
+	"Questionnaire.ApprovalDate":                                "date",
+	"Questionnaire.Copyright":                                   "string",
+	"Questionnaire.Date":                                        "dateTime",
+	"Questionnaire.DerivedFrom":                                 "string",
+	"Questionnaire.Description":                                 "string",
+	"Questionnaire.Experimental":                                "boolean",
+	"Questionnaire.Id":                                          "string",
+	"Questionnaire.ImplicitRules":                               "string",
+	"Questionnaire.Language":                                    "string",
+	"Questionnaire.LastReviewDate":                              "date",
+	"Questionnaire.Name":                                        "string",
+	"Questionnaire.Publisher":                                   "string",
+	"Questionnaire.Purpose":                                     "string",
+	"Questionnaire.Status":                                      "code",
+	"Questionnaire.SubjectType":                                 "code",
+	"Questionnaire.Title":                                       "string",
+	"Questionnaire.Url":                                         "string",
+	"Questionnaire.Version":                                     "string",
+	"QuestionnaireItem.AnswerValueSet":                          "string",
+	"QuestionnaireItem.Definition":                              "string",
+	"QuestionnaireItem.EnableBehavior":                          "code",
+	"QuestionnaireItem.Id":                                      "string",
+	"QuestionnaireItem.LinkId":                                  "string",
+	"QuestionnaireItem.MaxLength":                               "integer",
+	"QuestionnaireItem.Prefix":                                  "string",
+	"QuestionnaireItem.ReadOnly":                                "boolean",
+	"QuestionnaireItem.Repeats":                                 "boolean",
+	"QuestionnaireItem.Required":                                "boolean",
+	"QuestionnaireItem.Text":                                    "string",
+	"QuestionnaireItem.Type":                                    "code",
+	"QuestionnaireItemAnswerOption.Id":                          "string",
+	"QuestionnaireItemAnswerOption.InitialSelected":             "boolean",
+	"QuestionnaireItemAnswerOption.ValueDate":                   "date",
+	"QuestionnaireItemAnswerOption.ValueInteger":                "integer",
+	"QuestionnaireItemAnswerOption.ValueString":                 "string",
+	"QuestionnaireItemAnswerOption.ValueTime":                   "time",
+	"QuestionnaireItemEnableWhen.AnswerBoolean":                 "boolean",
+	"QuestionnaireItemEnableWhen.AnswerDate":                    "date",
+	"QuestionnaireItemEnableWhen.AnswerDateTime":                "dateTime",
+	"QuestionnaireItemEnableWhen.AnswerDecimal":                 "decimal",
+	"QuestionnaireItemEnableWhen.AnswerInteger":                 "integer",
+	"QuestionnaireItemEnableWhen.AnswerString":                  "string",
+	"QuestionnaireItemEnableWhen.AnswerTime":                    "time",
+	"QuestionnaireItemEnableWhen.Id":                            "string",
+	"QuestionnaireItemEnableWhen.Operator":                      "code",
+	"QuestionnaireItemEnableWhen.Question":                      "string",
+	"QuestionnaireItemInitial.Id":                               "string",
+	"QuestionnaireItemInitial.ValueBoolean":                     "boolean",
+	"QuestionnaireItemInitial.ValueDate":                        "date",
+	"QuestionnaireItemInitial.ValueDateTime":                    "dateTime",
+	"QuestionnaireItemInitial.ValueDecimal":                     "decimal",
+	"QuestionnaireItemInitial.ValueInteger":                     "integer",
+	"QuestionnaireItemInitial.ValueString":                      "string",
+	"QuestionnaireItemInitial.ValueTime":                        "time",
+	"QuestionnaireItemInitial.ValueUri":                         "uri",
+	"QuestionnaireResponse.Authored":                            "dateTime",
+	"QuestionnaireResponse.Id":                                  "string",
+	"QuestionnaireResponse.ImplicitRules":                       "string",
+	"QuestionnaireResponse.Language":                            "string",
+	"QuestionnaireResponse.Questionnaire":                       "string",
+	"QuestionnaireResponse.Status":                              "code",
+	"QuestionnaireResponseItem.Definition":                      "string",
+	"QuestionnaireResponseItem.Id":                              "string",
+	"QuestionnaireResponseItem.LinkId":                          "string",
+	"QuestionnaireResponseItem.Text":                            "string",
+	"QuestionnaireResponseItemAnswer.Id":                        "string",
+	"QuestionnaireResponseItemAnswer.ValueBoolean":              "boolean",
+	"QuestionnaireResponseItemAnswer.ValueDate":                 "date",
+	"QuestionnaireResponseItemAnswer.ValueDateTime":             "dateTime",
+	"QuestionnaireResponseItemAnswer.ValueDecimal":              "decimal",
+	"QuestionnaireResponseItemAnswer.ValueInteger":              "integer",
+	"QuestionnaireResponseItemAnswer.ValueString":               "string",
+	"QuestionnaireResponseItemAnswer.ValueTime":                 "time",
+	"QuestionnaireResponseItemAnswer.ValueUri":                  "uri",
+	"Range.Id":                                                             "string",
+	"Ratio.Id":                                                             "string",
+	"Reference.Display":                                                    "string",
+	"Reference.Id":                                                         "string",
+	"Reference.Reference":                                                  "string",
+	"Reference.Type":                                                       "string",
+	"RelatedArtifact.Citation":                                             "string",
+	"RelatedArtifact.Display":                                              "string",
+	"RelatedArtifact.Id":                                                   "string",
+	"RelatedArtifact.Label":                                                "string",
+	"RelatedArtifact.Resource":                                             "string",
+	"RelatedArtifact.Type":                                                 "code",
+	"RelatedArtifact.Url":                                                  "string",
+	"RelatedPerson.Active":                                                 "boolean",
+	"RelatedPerson.BirthDate":                                              "date",
+	"RelatedPerson.Gender":                                                 "code",
+	"RelatedPerson.Id":                                                     "string",
+	"RelatedPerson.ImplicitRules":                                          "string",
+	"RelatedPerson.Language":                                               "string",
+	"RelatedPersonCommunication.Id":                                        "string",
+	"RelatedPersonCommunication.Preferred":                                 "boolean",
+	"RequestGroup.AuthoredOn":                                              "dateTime",
+	"RequestGroup.Id":                                                      "string",
+	"RequestGroup.ImplicitRules":                                           "string",
+	"RequestGroup.InstantiatesCanonical":                                   "string",
+	"RequestGroup.InstantiatesUri":                                         "string",
+	"RequestGroup.Intent":                                                  "code",
+	"RequestGroup.Language":                                                "string",
+	"RequestGroup.Priority":                                                "code",
+	"RequestGroup.Status":                                                  "code",
+	"RequestGroupAction.CardinalityBehavior":                               "code",
+	"RequestGroupAction.Description":                                       "string",
+	"RequestGroupAction.GroupingBehavior":                                  "code",
+	"RequestGroupAction.Id":                                                "string",
+	"RequestGroupAction.PrecheckBehavior":                                  "code",
+	"RequestGroupAction.Prefix":                                            "string",
+	"RequestGroupAction.Priority":                                          "code",
+	"RequestGroupAction.RequiredBehavior":                                  "code",
+	"RequestGroupAction.SelectionBehavior":                                 "code",
+	"RequestGroupAction.TextEquivalent":                                    "string",
+	"RequestGroupAction.TimingDateTime":                                    "dateTime",
+	"RequestGroupAction.Title":                                             "string",
+	"RequestGroupActionCondition.Id":                                       "string",
+	"RequestGroupActionCondition.Kind":                                     "code",
+	"RequestGroupActionRelatedAction.ActionId":                             "string",
+	"RequestGroupActionRelatedAction.Id":                                   "string",
+	"RequestGroupActionRelatedAction.Relationship":                         "code",
+	"ResearchDefinition.ApprovalDate":                                      "date",
+	"ResearchDefinition.Comment":                                           "string",
+	"ResearchDefinition.Copyright":                                         "string",
+	"ResearchDefinition.Date":                                              "dateTime",
+	"ResearchDefinition.Description":                                       "string",
+	"ResearchDefinition.Experimental":                                      "boolean",
+	"ResearchDefinition.Id":                                                "string",
+	"ResearchDefinition.ImplicitRules":                                     "string",
+	"ResearchDefinition.Language":                                          "string",
+	"ResearchDefinition.LastReviewDate":                                    "date",
+	"ResearchDefinition.Library":                                           "string",
+	"ResearchDefinition.Name":                                              "string",
+	"ResearchDefinition.Publisher":                                         "string",
+	"ResearchDefinition.Purpose":                                           "string",
+	"ResearchDefinition.ShortTitle":                                        "string",
+	"ResearchDefinition.Status":                                            "code",
+	"ResearchDefinition.Subtitle":                                          "string",
+	"ResearchDefinition.Title":                                             "string",
+	"ResearchDefinition.Url":                                               "string",
+	"ResearchDefinition.Usage":                                             "string",
+	"ResearchDefinition.Version":                                           "string",
+	"ResearchElementDefinition.ApprovalDate":                               "date",
+	"ResearchElementDefinition.Comment":                                    "string",
+	"ResearchElementDefinition.Copyright":                                  "string",
+	"ResearchElementDefinition.Date":                                       "dateTime",
+	"ResearchElementDefinition.Description":                                "string",
+	"ResearchElementDefinition.Experimental":                               "boolean",
+	"ResearchElementDefinition.Id":                                         "string",
+	"ResearchElementDefinition.ImplicitRules":                              "string",
+	"ResearchElementDefinition.Language":                                   "string",
+	"ResearchElementDefinition.LastReviewDate":                             "date",
+	"ResearchElementDefinition.Library":                                    "string",
+	"ResearchElementDefinition.Name":                                       "string",
+	"ResearchElementDefinition.Publisher":                                  "string",
+	"ResearchElementDefinition.Purpose":                                    "string",
+	"ResearchElementDefinition.ShortTitle":                                 "string",
+	"ResearchElementDefinition.Status":                                     "code",
+	"ResearchElementDefinition.Subtitle":                                   "string",
+	"ResearchElementDefinition.Title":                                      "string",
+	"ResearchElementDefinition.Type":                                       "code",
+	"ResearchElementDefinition.Url":                                        "string",
+	"ResearchElementDefinition.Usage":                                      "string",
+	"ResearchElementDefinition.VariableType":                               "code",
+	"ResearchElementDefinition.Version":                                    "string",
+	"ResearchElementDefinitionCharacteristic.DefinitionCanonical":          "canonical",
+	"ResearchElementDefinitionCharacteristic.Exclude":                      "boolean",
+	"ResearchElementDefinitionCharacteristic.Id":                           "string",
+	"ResearchElementDefinitionCharacteristic.ParticipantEffectiveDateTime": "dateTime",
+	"ResearchElementDefinitionCharacteristic.ParticipantEffectiveDescription":  "string",
+	"ResearchElementDefinitionCharacteristic.ParticipantEffectiveGroupMeasure": "code",
+	"ResearchElementDefinitionCharacteristic.StudyEffectiveDateTime":           "dateTime",
+	"ResearchElementDefinitionCharacteristic.StudyEffectiveDescription":        "string",
+	"ResearchElementDefinitionCharacteristic.StudyEffectiveGroupMeasure":       "code",
+	"ResearchStudy.Description":                                               "string",
+	"ResearchStudy.Id":                                                        "string",
+	"ResearchStudy.ImplicitRules":                                             "string",
+	"ResearchStudy.Language":                                                  "string",
+	"ResearchStudy.Status":                                                    "code",
+	"ResearchStudy.Title":                                                     "string",
+	"ResearchStudyArm.Description":                                            "string",
+	"ResearchStudyArm.Id":                                                     "string",
+	"ResearchStudyArm.Name":                                                   "string",
+	"ResearchStudyObjective.Id":                                               "string",
+	"ResearchStudyObjective.Name":                                             "string",
+	"ResearchSubject.ActualArm":                                               "string",
+	"ResearchSubject.AssignedArm":                                             "string",
+	"ResearchSubject.Id":                                                      "string",
+	"ResearchSubject.ImplicitRules":                                           "string",
+	"ResearchSubject.Language":                                                "string",
+	"ResearchSubject.Status":                                                  "code",
+	"Resource.Id":                                                             "string",
+	"Resource.ImplicitRules":                                                  "string",
+	"Resource.Language":                                                       "string",
+	"RiskAssessment.Id":                                                       "string",
+	"RiskAssessment.ImplicitRules":                                            "string",
+	"RiskAssessment.Language":                                                 "string",
+	"RiskAssessment.Mitigation":                                               "string",
+	"RiskAssessment.OccurrenceDateTime":                                       "dateTime",
+	"RiskAssessment.Status":                                                   "code",
+	"RiskAssessmentPrediction.Id":                                             "string",
+	"RiskAssessmentPrediction.ProbabilityDecimal":                             "decimal",
+	"RiskAssessmentPrediction.Rationale":                                      "string",
+	"RiskAssessmentPrediction.RelativeRisk":                                   "decimal",
+	"RiskEvidenceSynthesis.ApprovalDate":                                      "date",
+	"RiskEvidenceSynthesis.Copyright":                                         "string",
+	"RiskEvidenceSynthesis.Date":                                              "dateTime",
+	"RiskEvidenceSynthesis.Description":                                       "string",
+	"RiskEvidenceSynthesis.Id":                                                "string",
+	"RiskEvidenceSynthesis.ImplicitRules":                                     "string",
+	"RiskEvidenceSynthesis.Language":                                          "string",
+	"RiskEvidenceSynthesis.LastReviewDate":                                    "date",
+	"RiskEvidenceSynthesis.Name":                                              "string",
+	"RiskEvidenceSynthesis.Publisher":                                         "string",
+	"RiskEvidenceSynthesis.Status":                                            "code",
+	"RiskEvidenceSynthesis.Title":                                             "string",
+	"RiskEvidenceSynthesis.Url":                                               "string",
+	"RiskEvidenceSynthesis.Version":                                           "string",
+	"RiskEvidenceSynthesisCertainty.Id":                                       "string",
+	"RiskEvidenceSynthesisCertaintyCertaintySubcomponent.Id":                  "string",
+	"RiskEvidenceSynthesisRiskEstimate.DenominatorCount":                      "integer",
+	"RiskEvidenceSynthesisRiskEstimate.Description":                           "string",
+	"RiskEvidenceSynthesisRiskEstimate.Id":                                    "string",
+	"RiskEvidenceSynthesisRiskEstimate.NumeratorCount":                        "integer",
+	"RiskEvidenceSynthesisRiskEstimate.Value":                                 "decimal",
+	"RiskEvidenceSynthesisRiskEstimatePrecisionEstimate.From":                 "decimal",
+	"RiskEvidenceSynthesisRiskEstimatePrecisionEstimate.Id":                   "string",
+	"RiskEvidenceSynthesisRiskEstimatePrecisionEstimate.Level":                "decimal",
+	"RiskEvidenceSynthesisRiskEstimatePrecisionEstimate.To":                   "decimal",
+	"RiskEvidenceSynthesisSampleSize.Description":                             "string",
+	"RiskEvidenceSynthesisSampleSize.Id":                                      "string",
+	"RiskEvidenceSynthesisSampleSize.NumberOfParticipants":                    "integer",
+	"RiskEvidenceSynthesisSampleSize.NumberOfStudies":                         "integer",
+	"SampledData.Data":                                                        "string",
+	"SampledData.Dimensions":                                                  "positiveInt",
+	"SampledData.Factor":                                                      "decimal",
+	"SampledData.Id":                                                          "string",
+	"SampledData.LowerLimit":                                                  "decimal",
+	"SampledData.Period":                                                      "decimal",
+	"SampledData.UpperLimit":                                                  "decimal",
+	"Schedule.Active":                                                         "boolean",
+	"Schedule.Comment":                                                        "string",
+	"Schedule.Id":                                                             "string",
+	"Schedule.ImplicitRules":                                                  "string",
+	"Schedule.Language":                                                       "string",
+	"SearchParameter.Base":                                                    "code",
+	"SearchParameter.Chain":                                                   "string",
+	"SearchParameter.Code":                                                    "string",
+	"SearchParameter.Comparator":                                              "code",
+	"SearchParameter.Date":                                                    "dateTime",
+	"SearchParameter.DerivedFrom":                                             "string",
+	"SearchParameter.Description":                                             "string",
+	"SearchParameter.Experimental":                                            "boolean",
+	"SearchParameter.Expression":                                              "string",
+	"SearchParameter.Id":                                                      "string",
+	"SearchParameter.ImplicitRules":                                           "string",
+	"SearchParameter.Language":                                                "string",
+	"SearchParameter.Modifier":                                                "code",
+	"SearchParameter.MultipleAnd":                                             "boolean",
+	"SearchParameter.MultipleOr":                                              "boolean",
+	"SearchParameter.Name":                                                    "string",
+	"SearchParameter.Publisher":                                               "string",
+	"SearchParameter.Purpose":                                                 "string",
+	"SearchParameter.Status":                                                  "code",
+	"SearchParameter.Target":                                                  "code",
+	"SearchParameter.Type":                                                    "code",
+	"SearchParameter.Url":                                                     "string",
+	"SearchParameter.Version":                                                 "string",
+	"SearchParameter.Xpath":                                                   "string",
+	"SearchParameter.XpathUsage":                                              "code",
+	"SearchParameterComponent.Definition":                                     "string",
+	"SearchParameterComponent.Expression":                                     "string",
+	"SearchParameterComponent.Id":                                             "string",
+	"ServiceRequest.AsNeededBoolean":                                          "boolean",
+	"ServiceRequest.AuthoredOn":                                               "dateTime",
+	"ServiceRequest.DoNotPerform":                                             "boolean",
+	"ServiceRequest.Id":                                                       "string",
+	"ServiceRequest.ImplicitRules":                                            "string",
+	"ServiceRequest.InstantiatesCanonical":                                    "string",
+	"ServiceRequest.InstantiatesUri":                                          "string",
+	"ServiceRequest.Intent":                                                   "code",
+	"ServiceRequest.Language":                                                 "string",
+	"ServiceRequest.OccurrenceDateTime":                                       "dateTime",
+	"ServiceRequest.PatientInstruction":                                       "string",
+	"ServiceRequest.Priority":                                                 "code",
+	"ServiceRequest.Status":                                                   "code",
+	"Signature.Data":                                                          "base64Binary",
+	"Signature.Id":                                                            "string",
+	"Signature.SigFormat":                                                     "string",
+	"Signature.TargetFormat":                                                  "string",
+	"Signature.When":                                                          "instant",
+	"Slot.Comment":                                                            "string",
+	"Slot.End":                                                                "instant",
+	"Slot.Id":                                                                 "string",
+	"Slot.ImplicitRules":                                                      "string",
+	"Slot.Language":                                                           "string",
+	"Slot.Overbooked":                                                         "boolean",
+	"Slot.Start":                                                              "instant",
+	"Slot.Status":                                                             "code",
+	"Specimen.Id":                                                             "string",
+	"Specimen.ImplicitRules":                                                  "string",
+	"Specimen.Language":                                                       "string",
+	"Specimen.ReceivedTime":                                                   "dateTime",
+	"Specimen.Status":                                                         "code",
+	"SpecimenCollection.CollectedDateTime":                                    "dateTime",
+	"SpecimenCollection.Id":                                                   "string",
+	"SpecimenContainer.Description":                                           "string",
+	"SpecimenContainer.Id":                                                    "string",
+	"SpecimenDefinition.Id":                                                   "string",
+	"SpecimenDefinition.ImplicitRules":                                        "string",
+	"SpecimenDefinition.Language":                                             "string",
+	"SpecimenDefinition.TimeAspect":                                           "string",
+	"SpecimenDefinitionTypeTested.Id":                                         "string",
+	"SpecimenDefinitionTypeTested.IsDerived":                                  "boolean",
+	"SpecimenDefinitionTypeTested.Preference":                                 "code",
+	"SpecimenDefinitionTypeTested.Requirement":                                "string",
+	"SpecimenDefinitionTypeTestedContainer.Description":                       "string",
+	"SpecimenDefinitionTypeTestedContainer.Id":                                "string",
+	"SpecimenDefinitionTypeTestedContainer.MinimumVolumeString":               "string",
+	"SpecimenDefinitionTypeTestedContainer.Preparation":                       "string",
+	"SpecimenDefinitionTypeTestedContainerAdditive.Id":                        "string",
+	"SpecimenDefinitionTypeTestedHandling.Id":                                 "string",
+	"SpecimenDefinitionTypeTestedHandling.Instruction":                        "string",
+	"SpecimenProcessing.Description":                                          "string",
+	"SpecimenProcessing.Id":                                                   "string",
+	"SpecimenProcessing.TimeDateTime":                                         "dateTime",
+	"StructureDefinition.Abstract":                                            "boolean",
+	"StructureDefinition.BaseDefinition":                                      "string",
+	"StructureDefinition.ContextInvariant":                                    "string",
+	"StructureDefinition.Copyright":                                           "string",
+	"StructureDefinition.Date":                                                "dateTime",
+	"StructureDefinition.Derivation":                                          "code",
+	"StructureDefinition.Description":                                         "string",
+	"StructureDefinition.Experimental":                                        "boolean",
+	"StructureDefinition.FhirVersion":                                         "code",
+	"StructureDefinition.Id":                                                  "string",
+	"StructureDefinition.ImplicitRules":                                       "string",
+	"StructureDefinition.Kind":                                                "code",
+	"StructureDefinition.Language":                                            "string",
+	"StructureDefinition.Name":                                                "string",
+	"StructureDefinition.Publisher":                                           "string",
+	"StructureDefinition.Purpose":                                             "string",
+	"StructureDefinition.Status":                                              "code",
+	"StructureDefinition.Title":                                               "string",
+	"StructureDefinition.Type":                                                "string",
+	"StructureDefinition.Url":                                                 "string",
+	"StructureDefinition.Version":                                             "string",
+	"StructureDefinitionContext.Expression":                                   "string",
+	"StructureDefinitionContext.Id":                                           "string",
+	"StructureDefinitionContext.Type":                                         "code",
+	"StructureDefinitionDifferential.Id":                                      "string",
+	"StructureDefinitionMapping.Comment":                                      "string",
+	"StructureDefinitionMapping.Id":                                           "string",
+	"StructureDefinitionMapping.Identity":                                     "string",
+	"StructureDefinitionMapping.Name":                                         "string",
+	"StructureDefinitionMapping.Uri":                                          "string",
+	"StructureDefinitionSnapshot.Id":                                          "string",
+	"StructureMap.Copyright":                                                  "string",
+	"StructureMap.Date":                                                       "dateTime",
+	"StructureMap.Description":                                                "string",
+	"StructureMap.Experimental":                                               "boolean",
+	"StructureMap.Id":                                                         "string",
+	"StructureMap.ImplicitRules":                                              "string",
+	"StructureMap.Import":                                                     "string",
+	"StructureMap.Language":                                                   "string",
+	"StructureMap.Name":                                                       "string",
+	"StructureMap.Publisher":                                                  "string",
+	"StructureMap.Purpose":                                                    "string",
+	"StructureMap.Status":                                                     "code",
+	"StructureMap.Title":                                                      "string",
+	"StructureMap.Url":                                                        "string",
+	"StructureMap.Version":                                                    "string",
+	"StructureMapGroup.Documentation":                                         "string",
+	"StructureMapGroup.Extends":                                               "string",
+	"StructureMapGroup.Id":                                                    "string",
+	"StructureMapGroup.Name":                                                  "string",
+	"StructureMapGroup.TypeMode":                                              "code",
+	"StructureMapGroupInput.Documentation":                                    "string",
+	"StructureMapGroupInput.Id":                                               "string",
+	"StructureMapGroupInput.Mode":                                             "code",
+	"StructureMapGroupInput.Name":                                             "string",
+	"StructureMapGroupInput.Type":                                             "string",
+	"StructureMapGroupRule.Documentation":                                     "string",
+	"StructureMapGroupRule.Id":                                                "string",
+	"StructureMapGroupRule.Name":                                              "string",
+	"StructureMapGroupRuleDependent.Id":                                       "string",
+	"StructureMapGroupRuleDependent.Name":                                     "string",
+	"StructureMapGroupRuleDependent.Variable":                                 "string",
+	"StructureMapGroupRuleSource.Check":                                       "string",
+	"StructureMapGroupRuleSource.Condition":                                   "string",
+	"StructureMapGroupRuleSource.Context":                                     "string",
+	"StructureMapGroupRuleSource.DefaultValueBase64Binary":                    "base64Binary",
+	"StructureMapGroupRuleSource.DefaultValueBoolean":                         "boolean",
+	"StructureMapGroupRuleSource.DefaultValueCanonical":                       "canonical",
+	"StructureMapGroupRuleSource.DefaultValueCode":                            "code",
+	"StructureMapGroupRuleSource.DefaultValueDate":                            "date",
+	"StructureMapGroupRuleSource.DefaultValueDateTime":                        "dateTime",
+	"StructureMapGroupRuleSource.DefaultValueDecimal":                         "decimal",
+	"StructureMapGroupRuleSource.DefaultValueId":                              "id",
+	"StructureMapGroupRuleSource.DefaultValueInstant":                         "instant",
+	"StructureMapGroupRuleSource.DefaultValueInteger":                         "integer",
+	"StructureMapGroupRuleSource.DefaultValueMarkdown":                        "markdown",
+	"StructureMapGroupRuleSource.DefaultValueOid":                             "oid",
+	"StructureMapGroupRuleSource.DefaultValuePositiveInt":                     "positiveInt",
+	"StructureMapGroupRuleSource.DefaultValueString":                          "string",
+	"StructureMapGroupRuleSource.DefaultValueTime":                            "time",
+	"StructureMapGroupRuleSource.DefaultValueUnsignedInt":                     "unsignedInt",
+	"StructureMapGroupRuleSource.DefaultValueUri":                             "uri",
+	"StructureMapGroupRuleSource.DefaultValueUrl":                             "url",
+	"StructureMapGroupRuleSource.DefaultValueUuid":                            "uuid",
+	"StructureMapGroupRuleSource.Element":                                     "string",
+	"StructureMapGroupRuleSource.Id":                                          "string",
+	"StructureMapGroupRuleSource.ListMode":                                    "code",
+	"StructureMapGroupRuleSource.LogMessage":                                  "string",
+	"StructureMapGroupRuleSource.Max":                                         "string",
+	"StructureMapGroupRuleSource.Min":                                         "integer",
+	"StructureMapGroupRuleSource.Type":                                        "string",
+	"StructureMapGroupRuleSource.Variable":                                    "string",
+	"StructureMapGroupRuleTarget.Context":                                     "string",
+	"StructureMapGroupRuleTarget.ContextType":                                 "code",
+	"StructureMapGroupRuleTarget.Element":                                     "string",
+	"StructureMapGroupRuleTarget.Id":                                          "string",
+	"StructureMapGroupRuleTarget.ListMode":                                    "code",
+	"StructureMapGroupRuleTarget.ListRuleId":                                  "string",
+	"StructureMapGroupRuleTarget.Transform":                                   "code",
+	"StructureMapGroupRuleTarget.Variable":                                    "string",
+	"StructureMapGroupRuleTargetParameter.Id":                                 "string",
+	"StructureMapGroupRuleTargetParameter.ValueBoolean":                       "boolean",
+	"StructureMapGroupRuleTargetParameter.ValueDecimal":                       "decimal",
+	"StructureMapGroupRuleTargetParameter.ValueId":                            "id",
+	"StructureMapGroupRuleTargetParameter.ValueInteger":                       "integer",
+	"StructureMapGroupRuleTargetParameter.ValueString":                        "string",
+	"StructureMapStructure.Alias":                                             "string",
+	"StructureMapStructure.Documentation":                                     "string",
+	"StructureMapStructure.Id":                                                "string",
+	"StructureMapStructure.Mode":                                              "code",
+	"StructureMapStructure.Url":                                               "string",
+	"Subscription.Criteria":                                                   "string",
+	"Subscription.End":                                                        "instant",
+	"Subscription.Error":                                                      "string",
+	"Subscription.Id":                                                         "string",
+	"Subscription.ImplicitRules":                                              "string",
+	"Subscription.Language":                                                   "string",
+	"Subscription.Reason":                                                     "string",
+	"Subscription.Status":                                                     "code",
+	"SubscriptionChannel.Endpoint":                                            "string",
+	"SubscriptionChannel.Header":                                              "string",
+	"SubscriptionChannel.Id":                                                  "string",
+	"SubscriptionChannel.Payload":                                             "string",
+	"SubscriptionChannel.Type":                                                "code",
+	"Substance.Description":                                                   "string",
+	"Substance.Id":                                                            "string",
+	"Substance.ImplicitRules":                                                 "string",
+	"Substance.Language":                                                      "string",
+	"Substance.Status":                                                        "code",
+	"SubstanceAmount.AmountString":                                            "string",
+	"SubstanceAmount.AmountText":                                              "string",
+	"SubstanceAmount.Id":                                                      "string",
+	"SubstanceAmountReferenceRange.Id":                                        "string",
+	"SubstanceIngredient.Id":                                                  "string",
+	"SubstanceInstance.Expiry":                                                "dateTime",
+	"SubstanceInstance.Id":                                                    "string",
+	"SubstanceNucleicAcid.AreaOfHybridisation":                                "string",
+	"SubstanceNucleicAcid.Id":                                                 "string",
+	"SubstanceNucleicAcid.ImplicitRules":                                      "string",
+	"SubstanceNucleicAcid.Language":                                           "string",
+	"SubstanceNucleicAcid.NumberOfSubunits":                                   "integer",
+	"SubstanceNucleicAcidSubunit.Id":                                          "string",
+	"SubstanceNucleicAcidSubunit.Length":                                      "integer",
+	"SubstanceNucleicAcidSubunit.Sequence":                                    "string",
+	"SubstanceNucleicAcidSubunit.Subunit":                                     "integer",
+	"SubstanceNucleicAcidSubunitLinkage.Connectivity":                         "string",
+	"SubstanceNucleicAcidSubunitLinkage.Id":                                   "string",
+	"SubstanceNucleicAcidSubunitLinkage.Name":                                 "string",
+	"SubstanceNucleicAcidSubunitLinkage.ResidueSite":                          "string",
+	"SubstanceNucleicAcidSubunitSugar.Id":                                     "string",
+	"SubstanceNucleicAcidSubunitSugar.Name":                                   "string",
+	"SubstanceNucleicAcidSubunitSugar.ResidueSite":                            "string",
+	"SubstancePolymer.Id":                                                     "string",
+	"SubstancePolymer.ImplicitRules":                                          "string",
+	"SubstancePolymer.Language":                                               "string",
+	"SubstancePolymer.Modification":                                           "string",
+	"SubstancePolymerMonomerSet.Id":                                           "string",
+	"SubstancePolymerMonomerSetStartingMaterial.Id":                           "string",
+	"SubstancePolymerMonomerSetStartingMaterial.IsDefining":                   "boolean",
+	"SubstancePolymerRepeat.AverageMolecularFormula":                          "string",
+	"SubstancePolymerRepeat.Id":                                               "string",
+	"SubstancePolymerRepeat.NumberOfUnits":                                    "integer",
+	"SubstancePolymerRepeatRepeatUnit.Id":                                     "string",
+	"SubstancePolymerRepeatRepeatUnit.RepeatUnit":                             "string",
+	"SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation.Id":               "string",
+	"SubstancePolymerRepeatRepeatUnitStructuralRepresentation.Id":             "string",
+	"SubstancePolymerRepeatRepeatUnitStructuralRepresentation.Representation": "string",
+	"SubstanceProtein.DisulfideLinkage":                                       "string",
+	"SubstanceProtein.Id":                                                     "string",
+	"SubstanceProtein.ImplicitRules":                                          "string",
+	"SubstanceProtein.Language":                                               "string",
+	"SubstanceProtein.NumberOfSubunits":                                       "integer",
+	"SubstanceProteinSubunit.CTerminalModification":                           "string",
+	"SubstanceProteinSubunit.Id":                                              "string",
+	"SubstanceProteinSubunit.Length":                                          "integer",
+	"SubstanceProteinSubunit.NTerminalModification":                           "string",
+	"SubstanceProteinSubunit.Sequence":                                        "string",
+	"SubstanceProteinSubunit.Subunit":                                         "integer",
+	"SubstanceReferenceInformation.Comment":                                   "string",
+	"SubstanceReferenceInformation.Id":                                        "string",
+	"SubstanceReferenceInformation.ImplicitRules":                             "string",
+	"SubstanceReferenceInformation.Language":                                  "string",
+	"SubstanceReferenceInformationClassification.Id":                          "string",
+	"SubstanceReferenceInformationGene.Id":                                    "string",
+	"SubstanceReferenceInformationGeneElement.Id":                             "string",
+	"SubstanceReferenceInformationTarget.AmountString":                        "string",
+	"SubstanceReferenceInformationTarget.Id":                                  "string",
+	"SubstanceSourceMaterial.GeographicalLocation":                            "string",
+	"SubstanceSourceMaterial.Id":                                              "string",
+	"SubstanceSourceMaterial.ImplicitRules":                                   "string",
+	"SubstanceSourceMaterial.Language":                                        "string",
+	"SubstanceSourceMaterial.OrganismName":                                    "string",
+	"SubstanceSourceMaterial.ParentSubstanceName":                             "string",
+	"SubstanceSourceMaterialFractionDescription.Fraction":                     "string",
+	"SubstanceSourceMaterialFractionDescription.Id":                           "string",
+	"SubstanceSourceMaterialOrganism.Id":                                      "string",
+	"SubstanceSourceMaterialOrganism.IntraspecificDescription":                "string",
+	"SubstanceSourceMaterialOrganismAuthor.AuthorDescription":                 "string",
+	"SubstanceSourceMaterialOrganismAuthor.Id":                                "string",
+	"SubstanceSourceMaterialOrganismHybrid.Id":                                "string",
+	"SubstanceSourceMaterialOrganismHybrid.MaternalOrganismId":                "string",
+	"SubstanceSourceMaterialOrganismHybrid.MaternalOrganismName":              "string",
+	"SubstanceSourceMaterialOrganismHybrid.PaternalOrganismId":                "string",
+	"SubstanceSourceMaterialOrganismHybrid.PaternalOrganismName":              "string",
+	"SubstanceSourceMaterialOrganismOrganismGeneral.Id":                       "string",
+	"SubstanceSourceMaterialPartDescription.Id":                               "string",
+	"SubstanceSpecification.Comment":                                          "string",
+	"SubstanceSpecification.Description":                                      "string",
+	"SubstanceSpecification.Id":                                               "string",
+	"SubstanceSpecification.ImplicitRules":                                    "string",
+	"SubstanceSpecification.Language":                                         "string",
+	"SubstanceSpecificationCode.Comment":                                      "string",
+	"SubstanceSpecificationCode.Id":                                           "string",
+	"SubstanceSpecificationCode.StatusDate":                                   "dateTime",
+	"SubstanceSpecificationMoiety.AmountString":                               "string",
+	"SubstanceSpecificationMoiety.Id":                                         "string",
+	"SubstanceSpecificationMoiety.MolecularFormula":                           "string",
+	"SubstanceSpecificationMoiety.Name":                                       "string",
+	"SubstanceSpecificationName.Id":                                           "string",
+	"SubstanceSpecificationName.Name":                                         "string",
+	"SubstanceSpecificationName.Preferred":                                    "boolean",
+	"SubstanceSpecificationNameOfficial.Date":                                 "dateTime",
+	"SubstanceSpecificationNameOfficial.Id":                                   "string",
+	"SubstanceSpecificationProperty.AmountString":                             "string",
+	"SubstanceSpecificationProperty.Id":                                       "string",
+	"SubstanceSpecificationProperty.Parameters":                               "string",
+	"SubstanceSpecificationRelationship.AmountString":                         "string",
+	"SubstanceSpecificationRelationship.Id":                                   "string",
+	"SubstanceSpecificationRelationship.IsDefining":                           "boolean",
+	"SubstanceSpecificationStructure.Id":                                      "string",
+	"SubstanceSpecificationStructure.MolecularFormula":                        "string",
+	"SubstanceSpecificationStructure.MolecularFormulaByMoiety":                "string",
+	"SubstanceSpecificationStructureIsotope.Id":                               "string",
+	"SubstanceSpecificationStructureIsotopeMolecularWeight.Id":                "string",
+	"SubstanceSpecificationStructureRepresentation.Id":                        "string",
+	"SubstanceSpecificationStructureRepresentation.Representation":            "string",
+	"SupplyDelivery.Id":                                                       "string",
+	"SupplyDelivery.ImplicitRules":                                            "string",
+	"SupplyDelivery.Language":                                                 "string",
+	"SupplyDelivery.OccurrenceDateTime":                                       "dateTime",
+	"SupplyDelivery.Status":                                                   "code",
+	"SupplyDeliverySuppliedItem.Id":                                           "string",
+	"SupplyRequest.AuthoredOn":                                                "dateTime",
+	"SupplyRequest.Id":                                                        "string",
+	"SupplyRequest.ImplicitRules":                                             "string",
+	"SupplyRequest.Language":                                                  "string",
+	"SupplyRequest.OccurrenceDateTime":                                        "dateTime",
+	"SupplyRequest.Priority":                                                  "code",
+	"SupplyRequest.Status":                                                    "code",
+	"SupplyRequestParameter.Id":                                               "string",
+	"SupplyRequestParameter.ValueBoolean":                                     "boolean",
+	"Task.AuthoredOn":                                                         "dateTime",
+	"Task.Description":                                                        "string",
+	"Task.Id":                                                                 "string",
+	"Task.ImplicitRules":                                                      "string",
+	"Task.InstantiatesCanonical":                                              "string",
+	"Task.InstantiatesUri":                                                    "string",
+	"Task.Intent":                                                             "string",
+	"Task.Language":                                                           "string",
+	"Task.LastModified":                                                       "dateTime",
+	"Task.Priority":                                                           "code",
+	"Task.Status":                                                             "code",
+	"TaskInput.Id":                                                            "string",
+	"TaskInput.ValueBase64Binary":                                             "base64Binary",
+	"TaskInput.ValueBoolean":                                                  "boolean",
+	"TaskInput.ValueCanonical":                                                "canonical",
+	"TaskInput.ValueCode":                                                     "code",
+	"TaskInput.ValueDate":                                                     "date",
+	"TaskInput.ValueDateTime":                                                 "dateTime",
+	"TaskInput.ValueDecimal":                                                  "decimal",
+	"TaskInput.ValueId":                                                       "id",
+	"TaskInput.ValueInstant":                                                  "instant",
+	"TaskInput.ValueInteger":                                                  "integer",
+	"TaskInput.ValueMarkdown":                                                 "markdown",
+	"TaskInput.ValueOid":                                                      "oid",
+	"TaskInput.ValuePositiveInt":                                              "positiveInt",
+	"TaskInput.ValueString":                                                   "string",
+	"TaskInput.ValueTime":                                                     "time",
+	"TaskInput.ValueUnsignedInt":                                              "unsignedInt",
+	"TaskInput.ValueUri":                                                      "uri",
+	"TaskInput.ValueUrl":                                                      "url",
+	"TaskInput.ValueUuid":                                                     "uuid",
+	"TaskOutput.Id":                                                           "string",
+	"TaskOutput.ValueBase64Binary":                                            "base64Binary",
+	"TaskOutput.ValueBoolean":                                                 "boolean",
+	"TaskOutput.ValueCanonical":                                               "canonical",
+	"TaskOutput.ValueCode":                                                    "code",
+	"TaskOutput.ValueDate":                                                    "date",
+	"TaskOutput.ValueDateTime":                                                "dateTime",
+	"TaskOutput.ValueDecimal":                                                 "decimal",
+	"TaskOutput.ValueId":                                                      "id",
+	"TaskOutput.ValueInstant":                                                 "instant",
+	"TaskOutput.ValueInteger":                                                 "integer",
+	"TaskOutput.ValueMarkdown":                                                "markdown",
+	"TaskOutput.ValueOid":                                                     "oid",
+	"TaskOutput.ValuePositiveInt":                                             "positiveInt",
+	"TaskOutput.ValueString":                                                  "string",
+	"TaskOutput.ValueTime":                                                    "time",
+	"TaskOutput.ValueUnsignedInt":                                             "unsignedInt",
+	"TaskOutput.ValueUri":                                                     "uri",
+	"TaskOutput.ValueUrl":                                                     "url",
+	"TaskOutput.ValueUuid":                                                    "uuid",
+	"TaskRestriction.Id":                                                      "string",
+	"TaskRestriction.Repetitions":                                             "positiveInt",
+	"TerminologyCapabilities.CodeSearch":                                      "code",
+	"TerminologyCapabilities.Copyright":                                       "string",
+	"TerminologyCapabilities.Date":                                            "dateTime",
+	"TerminologyCapabilities.Description":                                     "string",
+	"TerminologyCapabilities.Experimental":                                    "boolean",
+	"TerminologyCapabilities.Id":                                              "string",
+	"TerminologyCapabilities.ImplicitRules":                                   "string",
+	"TerminologyCapabilities.Kind":                                            "code",
+	"TerminologyCapabilities.Language":                                        "string",
+	"TerminologyCapabilities.LockedDate":                                      "boolean",
+	"TerminologyCapabilities.Name":                                            "string",
+	"TerminologyCapabilities.Publisher":                                       "string",
+	"TerminologyCapabilities.Purpose":                                         "string",
+	"TerminologyCapabilities.Status":                                          "code",
+	"TerminologyCapabilities.Title":                                           "string",
+	"TerminologyCapabilities.Url":                                             "string",
+	"TerminologyCapabilities.Version":                                         "string",
+	"TerminologyCapabilitiesClosure.Id":                                       "string",
+	"TerminologyCapabilitiesClosure.Translation":                              "boolean",
+	"TerminologyCapabilitiesCodeSystem.Id":                                    "string",
+	"TerminologyCapabilitiesCodeSystem.Subsumption":                           "boolean",
+	"TerminologyCapabilitiesCodeSystem.Uri":                                   "string",
+	"TerminologyCapabilitiesCodeSystemVersion.Code":                           "string",
+	"TerminologyCapabilitiesCodeSystemVersion.Compositional":                  "boolean",
+	"TerminologyCapabilitiesCodeSystemVersion.Id":                             "string",
+	"TerminologyCapabilitiesCodeSystemVersion.IsDefault":                      "boolean",
+	"TerminologyCapabilitiesCodeSystemVersion.Language":                       "string",
+	"TerminologyCapabilitiesCodeSystemVersion.Property":                       "string",
+	"TerminologyCapabilitiesCodeSystemVersionFilter.Code":                     "string",
+	"TerminologyCapabilitiesCodeSystemVersionFilter.Id":                       "string",
+	"TerminologyCapabilitiesCodeSystemVersionFilter.Op":                       "string",
+	"TerminologyCapabilitiesExpansion.Hierarchical":                           "boolean",
+	"TerminologyCapabilitiesExpansion.Id":                                     "string",
+	"TerminologyCapabilitiesExpansion.Incomplete":                             "boolean",
+	"TerminologyCapabilitiesExpansion.Paging":                                 "boolean",
+	"TerminologyCapabilitiesExpansion.TextFilter":                             "string",
+	"TerminologyCapabilitiesExpansionParameter.Documentation":                 "string",
+	"TerminologyCapabilitiesExpansionParameter.Id":                            "string",
+	"TerminologyCapabilitiesExpansionParameter.Name":                          "string",
+	"TerminologyCapabilitiesImplementation.Description":                       "string",
+	"TerminologyCapabilitiesImplementation.Id":                                "string",
+	"TerminologyCapabilitiesImplementation.Url":                               "string",
+	"TerminologyCapabilitiesSoftware.Id":                                      "string",
+	"TerminologyCapabilitiesSoftware.Name":                                    "string",
+	"TerminologyCapabilitiesSoftware.Version":                                 "string",
+	"TerminologyCapabilitiesTranslation.Id":                                   "string",
+	"TerminologyCapabilitiesTranslation.NeedsMap":                             "boolean",
+	"TerminologyCapabilitiesValidateCode.Id":                                  "string",
+	"TerminologyCapabilitiesValidateCode.Translations":                        "boolean",
+	"TestReport.Id":                                                           "string",
+	"TestReport.ImplicitRules":                                                "string",
+	"TestReport.Issued":                                                       "dateTime",
+	"TestReport.Language":                                                     "string",
+	"TestReport.Name":                                                         "string",
+	"TestReport.Result":                                                       "code",
+	"TestReport.Score":                                                        "decimal",
+	"TestReport.Status":                                                       "code",
+	"TestReport.Tester":                                                       "string",
+	"TestReportParticipant.Display":                                           "string",
+	"TestReportParticipant.Id":                                                "string",
+	"TestReportParticipant.Type":                                              "code",
+	"TestReportParticipant.Uri":                                               "string",
+	"TestReportSetup.Id":                                                      "string",
+	"TestReportSetupAction.Id":                                                "string",
+	"TestReportSetupActionAssert.Detail":                                      "string",
+	"TestReportSetupActionAssert.Id":                                          "string",
+	"TestReportSetupActionAssert.Message":                                     "string",
+	"TestReportSetupActionAssert.Result":                                      "code",
+	"TestReportSetupActionOperation.Detail":                                   "string",
+	"TestReportSetupActionOperation.Id":                                       "string",
+	"TestReportSetupActionOperation.Message":                                  "string",
+	"TestReportSetupActionOperation.Result":                                   "code",
+	"TestReportTeardown.Id":                                                   "string",
+	"TestReportTeardownAction.Id":                                             "string",
+	"TestReportTest.Description":                                              "string",
+	"TestReportTest.Id":                                                       "string",
+	"TestReportTest.Name":                                                     "string",
+	"TestReportTestAction.Id":                                                 "string",
+	"TestScript.Copyright":                                                    "string",
+	"TestScript.Date":                                                         "dateTime",
+	"TestScript.Description":                                                  "string",
+	"TestScript.Experimental":                                                 "boolean",
+	"TestScript.Id":                                                           "string",
+	"TestScript.ImplicitRules":                                                "string",
+	"TestScript.Language":                                                     "string",
+	"TestScript.Name":                                                         "string",
+	"TestScript.Publisher":                                                    "string",
+	"TestScript.Purpose":                                                      "string",
+	"TestScript.Status":                                                       "code",
+	"TestScript.Title":                                                        "string",
+	"TestScript.Url":                                                          "string",
+	"TestScript.Version":                                                      "string",
+	"TestScriptDestination.Id":                                                "string",
+	"TestScriptDestination.Index":                                             "integer",
+	"TestScriptFixture.Autocreate":                                            "boolean",
+	"TestScriptFixture.Autodelete":                                            "boolean",
+	"TestScriptFixture.Id":                                                    "string",
+	"TestScriptMetadata.Id":                                                   "string",
+	"TestScriptMetadataCapability.Capabilities":                               "string",
+	"TestScriptMetadataCapability.Description":                                "string",
+	"TestScriptMetadataCapability.Destination":                                "integer",
+	"TestScriptMetadataCapability.Id":                                         "string",
+	"TestScriptMetadataCapability.Link":                                       "string",
+	"TestScriptMetadataCapability.Origin":                                     "integer",
+	"TestScriptMetadataCapability.Required":                                   "boolean",
+	"TestScriptMetadataCapability.Validated":                                  "boolean",
+	"TestScriptMetadataLink.Description":                                      "string",
+	"TestScriptMetadataLink.Id":                                               "string",
+	"TestScriptMetadataLink.Url":                                              "string",
+	"TestScriptOrigin.Id":                                                     "string",
+	"TestScriptOrigin.Index":                                                  "integer",
+	"TestScriptSetup.Id":                                                      "string",
+	"TestScriptSetupAction.Id":                                                "string",
+	"TestScriptSetupActionAssert.CompareToSourceExpression":                   "string",
+	"TestScriptSetupActionAssert.CompareToSourceId":                           "string",
+	"TestScriptSetupActionAssert.CompareToSourcePath":                         "string",
+	"TestScriptSetupActionAssert.ContentType":                                 "string",
+	"TestScriptSetupActionAssert.Description":                                 "string",
+	"TestScriptSetupActionAssert.Direction":                                   "code",
+	"TestScriptSetupActionAssert.Expression":                                  "string",
+	"TestScriptSetupActionAssert.HeaderField":                                 "string",
+	"TestScriptSetupActionAssert.Id":                                          "string",
+	"TestScriptSetupActionAssert.Label":                                       "string",
+	"TestScriptSetupActionAssert.MinimumId":                                   "string",
+	"TestScriptSetupActionAssert.NavigationLinks":                             "boolean",
+	"TestScriptSetupActionAssert.Operator":                                    "code",
+	"TestScriptSetupActionAssert.Path":                                        "string",
+	"TestScriptSetupActionAssert.RequestMethod":                               "code",
+	"TestScriptSetupActionAssert.RequestURL":                                  "string",
+	"TestScriptSetupActionAssert.Resource":                                    "string",
+	"TestScriptSetupActionAssert.Response":                                    "code",
+	"TestScriptSetupActionAssert.ResponseCode":                                "string",
+	"TestScriptSetupActionAssert.SourceId":                                    "string",
+	"TestScriptSetupActionAssert.ValidateProfileId":                           "string",
+	"TestScriptSetupActionAssert.Value":                                       "string",
+	"TestScriptSetupActionAssert.WarningOnly":                                 "boolean",
+	"TestScriptSetupActionOperation.Accept":                                   "string",
+	"TestScriptSetupActionOperation.ContentType":                              "string",
+	"TestScriptSetupActionOperation.Description":                              "string",
+	"TestScriptSetupActionOperation.Destination":                              "integer",
+	"TestScriptSetupActionOperation.EncodeRequestUrl":                         "boolean",
+	"TestScriptSetupActionOperation.Id":                                       "string",
+	"TestScriptSetupActionOperation.Label":                                    "string",
+	"TestScriptSetupActionOperation.Method":                                   "code",
+	"TestScriptSetupActionOperation.Origin":                                   "integer",
+	"TestScriptSetupActionOperation.Params":                                   "string",
+	"TestScriptSetupActionOperation.RequestId":                                "string",
+	"TestScriptSetupActionOperation.Resource":                                 "string",
+	"TestScriptSetupActionOperation.ResponseId":                               "string",
+	"TestScriptSetupActionOperation.SourceId":                                 "string",
+	"TestScriptSetupActionOperation.TargetId":                                 "string",
+	"TestScriptSetupActionOperation.Url":                                      "string",
+	"TestScriptSetupActionOperationRequestHeader.Field":                       "string",
+	"TestScriptSetupActionOperationRequestHeader.Id":                          "string",
+	"TestScriptSetupActionOperationRequestHeader.Value":                       "string",
+	"TestScriptTeardown.Id":                                                   "string",
+	"TestScriptTeardownAction.Id":                                             "string",
+	"TestScriptTest.Description":                                              "string",
+	"TestScriptTest.Id":                                                       "string",
+	"TestScriptTest.Name":                                                     "string",
+	"TestScriptTestAction.Id":                                                 "string",
+	"TestScriptVariable.DefaultValue":                                         "string",
+	"TestScriptVariable.Description":                                          "string",
+	"TestScriptVariable.Expression":                                           "string",
+	"TestScriptVariable.HeaderField":                                          "string",
+	"TestScriptVariable.Hint":                                                 "string",
+	"TestScriptVariable.Id":                                                   "string",
+	"TestScriptVariable.Name":                                                 "string",
+	"TestScriptVariable.Path":                                                 "string",
+	"TestScriptVariable.SourceId":                                             "string",
+	"Timing.Event":                                                            "dateTime",
+	"Timing.Id":                                                               "string",
+	"TimingRepeat.Count":                                                      "positiveInt",
+	"TimingRepeat.CountMax":                                                   "positiveInt",
+	"TimingRepeat.DayOfWeek":                                                  "code",
+	"TimingRepeat.Duration":                                                   "decimal",
+	"TimingRepeat.DurationMax":                                                "decimal",
+	"TimingRepeat.DurationUnit":                                               "string",
+	"TimingRepeat.Frequency":                                                  "positiveInt",
+	"TimingRepeat.FrequencyMax":                                               "positiveInt",
+	"TimingRepeat.Id":                                                         "string",
+	"TimingRepeat.Offset":                                                     "unsignedInt",
+	"TimingRepeat.Period":                                                     "decimal",
+	"TimingRepeat.PeriodMax":                                                  "decimal",
+	"TimingRepeat.PeriodUnit":                                                 "string",
+	"TimingRepeat.TimeOfDay":                                                  "time",
+	"TimingRepeat.When":                                                       "string",
+	"TriggerDefinition.Id":                                                    "string",
+	"TriggerDefinition.Name":                                                  "string",
+	"TriggerDefinition.TimingDate":                                            "date",
+	"TriggerDefinition.TimingDateTime":                                        "dateTime",
+	"TriggerDefinition.Type":                                                  "code",
+	"UsageContext.Id":                                                         "string",
+	"ValueSet.Copyright":                                                      "string",
+	"ValueSet.Date":                                                           "dateTime",
+	"ValueSet.Description":                                                    "string",
+	"ValueSet.Experimental":                                                   "boolean",
+	"ValueSet.Id":                                                             "string",
+	"ValueSet.Immutable":                                                      "boolean",
+	"ValueSet.ImplicitRules":                                                  "string",
+	"ValueSet.Language":                                                       "string",
+	"ValueSet.Name":                                                           "string",
+	"ValueSet.Publisher":                                                      "string",
+	"ValueSet.Purpose":                                                        "string",
+	"ValueSet.Status":                                                         "code",
+	"ValueSet.Title":                                                          "string",
+	"ValueSet.Url":                                                            "string",
+	"ValueSet.Version":                                                        "string",
+	"ValueSetCompose.Id":                                                      "string",
+	"ValueSetCompose.Inactive":                                                "boolean",
+	"ValueSetCompose.LockedDate":                                              "date",
+	"ValueSetComposeInclude.Id":                                               "string",
+	"ValueSetComposeInclude.System":                                           "string",
+	"ValueSetComposeInclude.ValueSet":                                         "string",
+	"ValueSetComposeInclude.Version":                                          "string",
+	"ValueSetComposeIncludeConcept.Code":                                      "string",
+	"ValueSetComposeIncludeConcept.Display":                                   "string",
+	"ValueSetComposeIncludeConcept.Id":                                        "string",
+	"ValueSetComposeIncludeConceptDesignation.Id":                             "string",
+	"ValueSetComposeIncludeConceptDesignation.Language":                       "string",
+	"ValueSetComposeIncludeConceptDesignation.Value":                          "string",
+	"ValueSetComposeIncludeFilter.Id":                                         "string",
+	"ValueSetComposeIncludeFilter.Op":                                         "code",
+	"ValueSetComposeIncludeFilter.Property":                                   "string",
+	"ValueSetComposeIncludeFilter.Value":                                      "string",
+	"ValueSetExpansion.Id":                                                    "string",
+	"ValueSetExpansion.Identifier":                                            "string",
+	"ValueSetExpansion.Offset":                                                "integer",
+	"ValueSetExpansion.Timestamp":                                             "dateTime",
+	"ValueSetExpansion.Total":                                                 "integer",
+	"ValueSetExpansionContains.Abstract":                                      "boolean",
+	"ValueSetExpansionContains.Code":                                          "string",
+	"ValueSetExpansionContains.Display":                                       "string",
+	"ValueSetExpansionContains.Id":                                            "string",
+	"ValueSetExpansionContains.Inactive":                                      "boolean",
+	"ValueSetExpansionContains.System":                                        "string",
+	"ValueSetExpansionContains.Version":                                       "string",
+	"ValueSetExpansionParameter.Id":                                           "string",
+	"ValueSetExpansionParameter.Name":                                         "string",
+	"ValueSetExpansionParameter.ValueBoolean":                                 "boolean",
+	"ValueSetExpansionParameter.ValueCode":                                    "code",
+	"ValueSetExpansionParameter.ValueDateTime":                                "dateTime",
+	"ValueSetExpansionParameter.ValueDecimal":                                 "decimal",
+	"ValueSetExpansionParameter.ValueInteger":                                 "integer",
+	"ValueSetExpansionParameter.ValueString":                                  "string",
+	"ValueSetExpansionParameter.ValueUri":                                     "uri",
+	"VerificationResult.Id":                                                   "string",
+	"VerificationResult.ImplicitRules":                                        "string",
+	"VerificationResult.Language":                                             "string",
+	"VerificationResult.LastPerformed":                                        "dateTime",
+	"VerificationResult.NextScheduled":                                        "date",
+	"VerificationResult.Status":                                               "string",
+	"VerificationResult.StatusDate":                                           "dateTime",
+	"VerificationResult.TargetLocation":                                       "string",
+	"VerificationResultAttestation.Date":                                      "date",
+	"VerificationResultAttestation.Id":                                        "string",
+	"VerificationResultAttestation.ProxyIdentityCertificate":                  "string",
+	"VerificationResultAttestation.SourceIdentityCertificate":                 "string",
+	"VerificationResultPrimarySource.Id":                                      "string",
+	"VerificationResultPrimarySource.ValidationDate":                          "dateTime",
+	"VerificationResultValidator.Id":                                          "string",
+	"VerificationResultValidator.IdentityCertificate":                         "string",
+	"VisionPrescription.Created":                                              "dateTime",
+	"VisionPrescription.DateWritten":                                          "dateTime",
+	"VisionPrescription.Id":                                                   "string",
+	"VisionPrescription.ImplicitRules":                                        "string",
+	"VisionPrescription.Language":                                             "string",
+	"VisionPrescription.Status":                                               "code",
+	"VisionPrescriptionLensSpecification.Add":                                 "decimal",
+	"VisionPrescriptionLensSpecification.Axis":                                "integer",
+	"VisionPrescriptionLensSpecification.BackCurve":                           "decimal",
+	"VisionPrescriptionLensSpecification.Brand":                               "string",
+	"VisionPrescriptionLensSpecification.Color":                               "string",
+	"VisionPrescriptionLensSpecification.Cylinder":                            "decimal",
+	"VisionPrescriptionLensSpecification.Diameter":                            "decimal",
+	"VisionPrescriptionLensSpecification.Eye":                                 "code",
+	"VisionPrescriptionLensSpecification.Id":                                  "string",
+	"VisionPrescriptionLensSpecification.Power":                               "decimal",
+	"VisionPrescriptionLensSpecification.Sphere":                              "decimal",
+	"VisionPrescriptionLensSpecificationPrism.Amount":                         "decimal",
+	"VisionPrescriptionLensSpecificationPrism.Base":                           "code",
+	"VisionPrescriptionLensSpecificationPrism.Id":                             "string",
+}
+
+// PrimitiveTypeCode returns the FHIR type code of the primitive field of the given struct, like date or positiveInt,
+// as the Go types string and int don't distinguish them. It returns false if the field is no primitive field.
+func PrimitiveTypeCode(structName, fieldName string) (string, bool) {
+	code, ok := primitiveTypeCodes[structName+"."+fieldName]
+	return code, ok
+}
+
+// protoResourceNumbers maps resource types to their field numbers in the ContainedResource message
+var protoResourceNumbers = map[string]int{
+	"Account":                           1,
+	"ActivityDefinition":                2,
+	"AdverseEvent":                      3,
+	"AllergyIntolerance":                4,
+	"Appointment":                       5,
+	"AppointmentResponse":               6,
+	"AuditEvent":                        7,
+	"Basic":                             8,
+	"Binary":                            9,
+	"BiologicallyDerivedProduct":        10,
+	"BodyStructure":                     11,
+	"Bundle":                            12,
+	"CapabilityStatement":               13,
+	"CarePlan":                          14,
+	"CareTeam":                          15,
+	"CatalogEntry":                      16,
+	"ChargeItem":                        17,
+	"ChargeItemDefinition":              18,
+	"Claim":                             19,
+	"ClaimResponse":                     20,
+	"ClinicalImpression":                21,
+	"CodeSystem":                        22,
+	"Communication":                     23,
+	"CommunicationRequest":              24,
+	"CompartmentDefinition":             25,
+	"Composition":                       26,
+	"ConceptMap":                        27,
+	"Condition":                         28,
+	"Consent":                           29,
+	"Contract":                          30,
+	"Coverage":                          31,
+	"CoverageEligibilityRequest":        32,
+	"CoverageEligibilityResponse":       33,
+	"DetectedIssue":                     34,
+	"Device":                            35,
+	"DeviceDefinition":                  36,
+	"DeviceMetric":                      37,
+	"DeviceRequest":                     38,
+	"DeviceUseStatement":                39,
+	"DiagnosticReport":                  40,
+	"DocumentManifest":                  41,
+	"DocumentReference":                 42,
+	"EffectEvidenceSynthesis":           43,
+	"Encounter":                         44,
+	"Endpoint":                          45,
+	"EnrollmentRequest":                 46,
+	"EnrollmentResponse":                47,
+	"EpisodeOfCare":                     48,
+	"EventDefinition":                   49,
+	"Evidence":                          50,
+	"EvidenceVariable":                  51,
+	"ExampleScenario":                   52,
+	"ExplanationOfBenefit":              53,
+	"FamilyMemberHistory":               54,
+	"Flag":                              55,
+	"Goal":                              56,
+	"GraphDefinition":                   57,
+	"Group":                             58,
+	"GuidanceResponse":                  59,
+	"HealthcareService":                 60,
+	"ImagingStudy":                      61,
+	"Immunization":                      62,
+	"ImmunizationEvaluation":            63,
+	"ImmunizationRecommendation":        64,
+	"ImplementationGuide":               65,
+	"InsurancePlan":                     66,
+	"Invoice":                           67,
+	"Library":                           68,
+	"Linkage":                           69,
+	"List":                              70,
+	"Location":                          71,
+	"Measure":                           72,
+	"MeasureReport":                     73,
+	"Media":                             74,
+	"Medication":                        75,
+	"MedicationAdministration":          76,
+	"MedicationDispense":                77,
+	"MedicationKnowledge":               78,
+	"MedicationRequest":                 79,
+	"MedicationStatement":               80,
+	"MedicinalProduct":                  81,
+	"MedicinalProductAuthorization":     82,
+	"MedicinalProductContraindication":  83,
+	"MedicinalProductIndication":        84,
+	"MedicinalProductIngredient":        85,
+	"MedicinalProductInteraction":       86,
+	"MedicinalProductManufactured":      87,
+	"MedicinalProductPackaged":          88,
+	"MedicinalProductPharmaceutical":    89,
+	"MedicinalProductUndesirableEffect": 90,
+	"MessageDefinition":                 91,
+	"MessageHeader":                     92,
+	"MolecularSequence":                 93,
+	"NamingSystem":                      94,
+	"NutritionOrder":                    95,
+	"Observation":                       96,
+	"ObservationDefinition":             97,
+	"OperationDefinition":               98,
+	"OperationOutcome":                  99,
+	"Organization":                      100,
+	"OrganizationAffiliation":           101,
+	"Parameters":                        102,
+	"Patient":                           103,
+	"PaymentNotice":                     104,
+	"PaymentReconciliation":             105,
+	"Person":                            106,
+	"PlanDefinition":                    107,
+	"Practitioner":                      108,
+	"PractitionerRole":                  109,
+	"Procedure":                         110,
+	"Provenance":                        111,
+	"Questionnaire":                     112,
+	"QuestionnaireResponse":             113,
+	"RelatedPerson":                     114,
+	"RequestGroup":                      115,
+	"ResearchDefinition":                116,
+	"ResearchElementDefinition":         117,
+	"ResearchStudy":                     118,
+	"ResearchSubject":                   119,
+	"RiskAssessment":                    120,
+	"RiskEvidenceSynthesis":             121,
+	"Schedule":                          122,
+	"SearchParameter":                   123,
+	"ServiceRequest":                    124,
+	"Slot":                              125,
+	"Specimen":                          126,
+	"SpecimenDefinition":                127,
+	"StructureDefinition":               128,
+	"StructureMap":                      129,
+	"Subscription":                      130,
+	"Substance":                         131,
+	"SubstanceNucleicAcid":              132,
+	"SubstancePolymer":                  133,
+	"SubstanceProtein":                  134,
+	"SubstanceReferenceInformation":     135,
+	"SubstanceSourceMaterial":           136,
+	"SubstanceSpecification":            137,
+	"SupplyDelivery":                    138,
+	"SupplyRequest":                     139,
+	"Task":                              140,
+	"TerminologyCapabilities":           141,
+	"TestReport":                        142,
+	"TestScript":                        143,
+	"ValueSet":                          144,
+	"VerificationResult":                145,
+	"VisionPrescription":                146,
+}
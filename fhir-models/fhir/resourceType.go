@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -180,8 +179,13 @@ const (
 func (code ResourceType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ResourceType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ResourceType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "Account":
 		*code = ResourceTypeAccount
@@ -484,6 +488,12 @@ func (code *ResourceType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ResourceType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ResourceType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ResourceType) String() string {
 	return code.Code()
 }
@@ -1390,3 +1400,607 @@ func (code ResourceType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ResourceType) protoNumber() uint64 {
+	switch code {
+	case ResourceTypeAccount:
+		return 1
+	case ResourceTypeActivityDefinition:
+		return 2
+	case ResourceTypeAdverseEvent:
+		return 3
+	case ResourceTypeAllergyIntolerance:
+		return 4
+	case ResourceTypeAppointment:
+		return 5
+	case ResourceTypeAppointmentResponse:
+		return 6
+	case ResourceTypeAuditEvent:
+		return 7
+	case ResourceTypeBasic:
+		return 8
+	case ResourceTypeBinary:
+		return 9
+	case ResourceTypeBiologicallyDerivedProduct:
+		return 10
+	case ResourceTypeBodyStructure:
+		return 11
+	case ResourceTypeBundle:
+		return 12
+	case ResourceTypeCapabilityStatement:
+		return 13
+	case ResourceTypeCarePlan:
+		return 14
+	case ResourceTypeCareTeam:
+		return 15
+	case ResourceTypeCatalogEntry:
+		return 16
+	case ResourceTypeChargeItem:
+		return 17
+	case ResourceTypeChargeItemDefinition:
+		return 18
+	case ResourceTypeClaim:
+		return 19
+	case ResourceTypeClaimResponse:
+		return 20
+	case ResourceTypeClinicalImpression:
+		return 21
+	case ResourceTypeCodeSystem:
+		return 22
+	case ResourceTypeCommunication:
+		return 23
+	case ResourceTypeCommunicationRequest:
+		return 24
+	case ResourceTypeCompartmentDefinition:
+		return 25
+	case ResourceTypeComposition:
+		return 26
+	case ResourceTypeConceptMap:
+		return 27
+	case ResourceTypeCondition:
+		return 28
+	case ResourceTypeConsent:
+		return 29
+	case ResourceTypeContract:
+		return 30
+	case ResourceTypeCoverage:
+		return 31
+	case ResourceTypeCoverageEligibilityRequest:
+		return 32
+	case ResourceTypeCoverageEligibilityResponse:
+		return 33
+	case ResourceTypeDetectedIssue:
+		return 34
+	case ResourceTypeDevice:
+		return 35
+	case ResourceTypeDeviceDefinition:
+		return 36
+	case ResourceTypeDeviceMetric:
+		return 37
+	case ResourceTypeDeviceRequest:
+		return 38
+	case ResourceTypeDeviceUseStatement:
+		return 39
+	case ResourceTypeDiagnosticReport:
+		return 40
+	case ResourceTypeDocumentManifest:
+		return 41
+	case ResourceTypeDocumentReference:
+		return 42
+	case ResourceTypeDomainResource:
+		return 43
+	case ResourceTypeEffectEvidenceSynthesis:
+		return 44
+	case ResourceTypeEncounter:
+		return 45
+	case ResourceTypeEndpoint:
+		return 46
+	case ResourceTypeEnrollmentRequest:
+		return 47
+	case ResourceTypeEnrollmentResponse:
+		return 48
+	case ResourceTypeEpisodeOfCare:
+		return 49
+	case ResourceTypeEventDefinition:
+		return 50
+	case ResourceTypeEvidence:
+		return 51
+	case ResourceTypeEvidenceVariable:
+		return 52
+	case ResourceTypeExampleScenario:
+		return 53
+	case ResourceTypeExplanationOfBenefit:
+		return 54
+	case ResourceTypeFamilyMemberHistory:
+		return 55
+	case ResourceTypeFlag:
+		return 56
+	case ResourceTypeGoal:
+		return 57
+	case ResourceTypeGraphDefinition:
+		return 58
+	case ResourceTypeGroup:
+		return 59
+	case ResourceTypeGuidanceResponse:
+		return 60
+	case ResourceTypeHealthcareService:
+		return 61
+	case ResourceTypeImagingStudy:
+		return 62
+	case ResourceTypeImmunization:
+		return 63
+	case ResourceTypeImmunizationEvaluation:
+		return 64
+	case ResourceTypeImmunizationRecommendation:
+		return 65
+	case ResourceTypeImplementationGuide:
+		return 66
+	case ResourceTypeInsurancePlan:
+		return 67
+	case ResourceTypeInvoice:
+		return 68
+	case ResourceTypeLibrary:
+		return 69
+	case ResourceTypeLinkage:
+		return 70
+	case ResourceTypeList:
+		return 71
+	case ResourceTypeLocation:
+		return 72
+	case ResourceTypeMeasure:
+		return 73
+	case ResourceTypeMeasureReport:
+		return 74
+	case ResourceTypeMedia:
+		return 75
+	case ResourceTypeMedication:
+		return 76
+	case ResourceTypeMedicationAdministration:
+		return 77
+	case ResourceTypeMedicationDispense:
+		return 78
+	case ResourceTypeMedicationKnowledge:
+		return 79
+	case ResourceTypeMedicationRequest:
+		return 80
+	case ResourceTypeMedicationStatement:
+		return 81
+	case ResourceTypeMedicinalProduct:
+		return 82
+	case ResourceTypeMedicinalProductAuthorization:
+		return 83
+	case ResourceTypeMedicinalProductContraindication:
+		return 84
+	case ResourceTypeMedicinalProductIndication:
+		return 85
+	case ResourceTypeMedicinalProductIngredient:
+		return 86
+	case ResourceTypeMedicinalProductInteraction:
+		return 87
+	case ResourceTypeMedicinalProductManufactured:
+		return 88
+	case ResourceTypeMedicinalProductPackaged:
+		return 89
+	case ResourceTypeMedicinalProductPharmaceutical:
+		return 90
+	case ResourceTypeMedicinalProductUndesirableEffect:
+		return 91
+	case ResourceTypeMessageDefinition:
+		return 92
+	case ResourceTypeMessageHeader:
+		return 93
+	case ResourceTypeMolecularSequence:
+		return 94
+	case ResourceTypeNamingSystem:
+		return 95
+	case ResourceTypeNutritionOrder:
+		return 96
+	case ResourceTypeObservation:
+		return 97
+	case ResourceTypeObservationDefinition:
+		return 98
+	case ResourceTypeOperationDefinition:
+		return 99
+	case ResourceTypeOperationOutcome:
+		return 100
+	case ResourceTypeOrganization:
+		return 101
+	case ResourceTypeOrganizationAffiliation:
+		return 102
+	case ResourceTypeParameters:
+		return 103
+	case ResourceTypePatient:
+		return 104
+	case ResourceTypePaymentNotice:
+		return 105
+	case ResourceTypePaymentReconciliation:
+		return 106
+	case ResourceTypePerson:
+		return 107
+	case ResourceTypePlanDefinition:
+		return 108
+	case ResourceTypePractitioner:
+		return 109
+	case ResourceTypePractitionerRole:
+		return 110
+	case ResourceTypeProcedure:
+		return 111
+	case ResourceTypeProvenance:
+		return 112
+	case ResourceTypeQuestionnaire:
+		return 113
+	case ResourceTypeQuestionnaireResponse:
+		return 114
+	case ResourceTypeRelatedPerson:
+		return 115
+	case ResourceTypeRequestGroup:
+		return 116
+	case ResourceTypeResearchDefinition:
+		return 117
+	case ResourceTypeResearchElementDefinition:
+		return 118
+	case ResourceTypeResearchStudy:
+		return 119
+	case ResourceTypeResearchSubject:
+		return 120
+	case ResourceTypeResource:
+		return 121
+	case ResourceTypeRiskAssessment:
+		return 122
+	case ResourceTypeRiskEvidenceSynthesis:
+		return 123
+	case ResourceTypeSchedule:
+		return 124
+	case ResourceTypeSearchParameter:
+		return 125
+	case ResourceTypeServiceRequest:
+		return 126
+	case ResourceTypeSlot:
+		return 127
+	case ResourceTypeSpecimen:
+		return 128
+	case ResourceTypeSpecimenDefinition:
+		return 129
+	case ResourceTypeStructureDefinition:
+		return 130
+	case ResourceTypeStructureMap:
+		return 131
+	case ResourceTypeSubscription:
+		return 132
+	case ResourceTypeSubstance:
+		return 133
+	case ResourceTypeSubstanceNucleicAcid:
+		return 134
+	case ResourceTypeSubstancePolymer:
+		return 135
+	case ResourceTypeSubstanceProtein:
+		return 136
+	case ResourceTypeSubstanceReferenceInformation:
+		return 137
+	case ResourceTypeSubstanceSourceMaterial:
+		return 138
+	case ResourceTypeSubstanceSpecification:
+		return 139
+	case ResourceTypeSupplyDelivery:
+		return 140
+	case ResourceTypeSupplyRequest:
+		return 141
+	case ResourceTypeTask:
+		return 142
+	case ResourceTypeTerminologyCapabilities:
+		return 143
+	case ResourceTypeTestReport:
+		return 144
+	case ResourceTypeTestScript:
+		return 145
+	case ResourceTypeValueSet:
+		return 146
+	case ResourceTypeVerificationResult:
+		return 147
+	case ResourceTypeVisionPrescription:
+		return 148
+	}
+	return 0
+}
+func (code *ResourceType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ResourceTypeAccount
+	case 2:
+		*code = ResourceTypeActivityDefinition
+	case 3:
+		*code = ResourceTypeAdverseEvent
+	case 4:
+		*code = ResourceTypeAllergyIntolerance
+	case 5:
+		*code = ResourceTypeAppointment
+	case 6:
+		*code = ResourceTypeAppointmentResponse
+	case 7:
+		*code = ResourceTypeAuditEvent
+	case 8:
+		*code = ResourceTypeBasic
+	case 9:
+		*code = ResourceTypeBinary
+	case 10:
+		*code = ResourceTypeBiologicallyDerivedProduct
+	case 11:
+		*code = ResourceTypeBodyStructure
+	case 12:
+		*code = ResourceTypeBundle
+	case 13:
+		*code = ResourceTypeCapabilityStatement
+	case 14:
+		*code = ResourceTypeCarePlan
+	case 15:
+		*code = ResourceTypeCareTeam
+	case 16:
+		*code = ResourceTypeCatalogEntry
+	case 17:
+		*code = ResourceTypeChargeItem
+	case 18:
+		*code = ResourceTypeChargeItemDefinition
+	case 19:
+		*code = ResourceTypeClaim
+	case 20:
+		*code = ResourceTypeClaimResponse
+	case 21:
+		*code = ResourceTypeClinicalImpression
+	case 22:
+		*code = ResourceTypeCodeSystem
+	case 23:
+		*code = ResourceTypeCommunication
+	case 24:
+		*code = ResourceTypeCommunicationRequest
+	case 25:
+		*code = ResourceTypeCompartmentDefinition
+	case 26:
+		*code = ResourceTypeComposition
+	case 27:
+		*code = ResourceTypeConceptMap
+	case 28:
+		*code = ResourceTypeCondition
+	case 29:
+		*code = ResourceTypeConsent
+	case 30:
+		*code = ResourceTypeContract
+	case 31:
+		*code = ResourceTypeCoverage
+	case 32:
+		*code = ResourceTypeCoverageEligibilityRequest
+	case 33:
+		*code = ResourceTypeCoverageEligibilityResponse
+	case 34:
+		*code = ResourceTypeDetectedIssue
+	case 35:
+		*code = ResourceTypeDevice
+	case 36:
+		*code = ResourceTypeDeviceDefinition
+	case 37:
+		*code = ResourceTypeDeviceMetric
+	case 38:
+		*code = ResourceTypeDeviceRequest
+	case 39:
+		*code = ResourceTypeDeviceUseStatement
+	case 40:
+		*code = ResourceTypeDiagnosticReport
+	case 41:
+		*code = ResourceTypeDocumentManifest
+	case 42:
+		*code = ResourceTypeDocumentReference
+	case 43:
+		*code = ResourceTypeDomainResource
+	case 44:
+		*code = ResourceTypeEffectEvidenceSynthesis
+	case 45:
+		*code = ResourceTypeEncounter
+	case 46:
+		*code = ResourceTypeEndpoint
+	case 47:
+		*code = ResourceTypeEnrollmentRequest
+	case 48:
+		*code = ResourceTypeEnrollmentResponse
+	case 49:
+		*code = ResourceTypeEpisodeOfCare
+	case 50:
+		*code = ResourceTypeEventDefinition
+	case 51:
+		*code = ResourceTypeEvidence
+	case 52:
+		*code = ResourceTypeEvidenceVariable
+	case 53:
+		*code = ResourceTypeExampleScenario
+	case 54:
+		*code = ResourceTypeExplanationOfBenefit
+	case 55:
+		*code = ResourceTypeFamilyMemberHistory
+	case 56:
+		*code = ResourceTypeFlag
+	case 57:
+		*code = ResourceTypeGoal
+	case 58:
+		*code = ResourceTypeGraphDefinition
+	case 59:
+		*code = ResourceTypeGroup
+	case 60:
+		*code = ResourceTypeGuidanceResponse
+	case 61:
+		*code = ResourceTypeHealthcareService
+	case 62:
+		*code = ResourceTypeImagingStudy
+	case 63:
+		*code = ResourceTypeImmunization
+	case 64:
+		*code = ResourceTypeImmunizationEvaluation
+	case 65:
+		*code = ResourceTypeImmunizationRecommendation
+	case 66:
+		*code = ResourceTypeImplementationGuide
+	case 67:
+		*code = ResourceTypeInsurancePlan
+	case 68:
+		*code = ResourceTypeInvoice
+	case 69:
+		*code = ResourceTypeLibrary
+	case 70:
+		*code = ResourceTypeLinkage
+	case 71:
+		*code = ResourceTypeList
+	case 72:
+		*code = ResourceTypeLocation
+	case 73:
+		*code = ResourceTypeMeasure
+	case 74:
+		*code = ResourceTypeMeasureReport
+	case 75:
+		*code = ResourceTypeMedia
+	case 76:
+		*code = ResourceTypeMedication
+	case 77:
+		*code = ResourceTypeMedicationAdministration
+	case 78:
+		*code = ResourceTypeMedicationDispense
+	case 79:
+		*code = ResourceTypeMedicationKnowledge
+	case 80:
+		*code = ResourceTypeMedicationRequest
+	case 81:
+		*code = ResourceTypeMedicationStatement
+	case 82:
+		*code = ResourceTypeMedicinalProduct
+	case 83:
+		*code = ResourceTypeMedicinalProductAuthorization
+	case 84:
+		*code = ResourceTypeMedicinalProductContraindication
+	case 85:
+		*code = ResourceTypeMedicinalProductIndication
+	case 86:
+		*code = ResourceTypeMedicinalProductIngredient
+	case 87:
+		*code = ResourceTypeMedicinalProductInteraction
+	case 88:
+		*code = ResourceTypeMedicinalProductManufactured
+	case 89:
+		*code = ResourceTypeMedicinalProductPackaged
+	case 90:
+		*code = ResourceTypeMedicinalProductPharmaceutical
+	case 91:
+		*code = ResourceTypeMedicinalProductUndesirableEffect
+	case 92:
+		*code = ResourceTypeMessageDefinition
+	case 93:
+		*code = ResourceTypeMessageHeader
+	case 94:
+		*code = ResourceTypeMolecularSequence
+	case 95:
+		*code = ResourceTypeNamingSystem
+	case 96:
+		*code = ResourceTypeNutritionOrder
+	case 97:
+		*code = ResourceTypeObservation
+	case 98:
+		*code = ResourceTypeObservationDefinition
+	case 99:
+		*code = ResourceTypeOperationDefinition
+	case 100:
+		*code = ResourceTypeOperationOutcome
+	case 101:
+		*code = ResourceTypeOrganization
+	case 102:
+		*code = ResourceTypeOrganizationAffiliation
+	case 103:
+		*code = ResourceTypeParameters
+	case 104:
+		*code = ResourceTypePatient
+	case 105:
+		*code = ResourceTypePaymentNotice
+	case 106:
+		*code = ResourceTypePaymentReconciliation
+	case 107:
+		*code = ResourceTypePerson
+	case 108:
+		*code = ResourceTypePlanDefinition
+	case 109:
+		*code = ResourceTypePractitioner
+	case 110:
+		*code = ResourceTypePractitionerRole
+	case 111:
+		*code = ResourceTypeProcedure
+	case 112:
+		*code = ResourceTypeProvenance
+	case 113:
+		*code = ResourceTypeQuestionnaire
+	case 114:
+		*code = ResourceTypeQuestionnaireResponse
+	case 115:
+		*code = ResourceTypeRelatedPerson
+	case 116:
+		*code = ResourceTypeRequestGroup
+	case 117:
+		*code = ResourceTypeResearchDefinition
+	case 118:
+		*code = ResourceTypeResearchElementDefinition
+	case 119:
+		*code = ResourceTypeResearchStudy
+	case 120:
+		*code = ResourceTypeResearchSubject
+	case 121:
+		*code = ResourceTypeResource
+	case 122:
+		*code = ResourceTypeRiskAssessment
+	case 123:
+		*code = ResourceTypeRiskEvidenceSynthesis
+	case 124:
+		*code = ResourceTypeSchedule
+	case 125:
+		*code = ResourceTypeSearchParameter
+	case 126:
+		*code = ResourceTypeServiceRequest
+	case 127:
+		*code = ResourceTypeSlot
+	case 128:
+		*code = ResourceTypeSpecimen
+	case 129:
+		*code = ResourceTypeSpecimenDefinition
+	case 130:
+		*code = ResourceTypeStructureDefinition
+	case 131:
+		*code = ResourceTypeStructureMap
+	case 132:
+		*code = ResourceTypeSubscription
+	case 133:
+		*code = ResourceTypeSubstance
+	case 134:
+		*code = ResourceTypeSubstanceNucleicAcid
+	case 135:
+		*code = ResourceTypeSubstancePolymer
+	case 136:
+		*code = ResourceTypeSubstanceProtein
+	case 137:
+		*code = ResourceTypeSubstanceReferenceInformation
+	case 138:
+		*code = ResourceTypeSubstanceSourceMaterial
+	case 139:
+		*code = ResourceTypeSubstanceSpecification
+	case 140:
+		*code = ResourceTypeSupplyDelivery
+	case 141:
+		*code = ResourceTypeSupplyRequest
+	case 142:
+		*code = ResourceTypeTask
+	case 143:
+		*code = ResourceTypeTerminologyCapabilities
+	case 144:
+		*code = ResourceTypeTestReport
+	case 145:
+		*code = ResourceTypeTestScript
+	case 146:
+		*code = ResourceTypeValueSet
+	case 147:
+		*code = ResourceTypeVerificationResult
+	case 148:
+		*code = ResourceTypeVisionPrescription
+	default:
+		return false
+	}
+	return true
+}
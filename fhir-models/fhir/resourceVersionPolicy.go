@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ResourceVersionPolicy) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ResourceVersionPolicy) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ResourceVersionPolicy) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "no-version":
 		*code = ResourceVersionPolicyNoVersion
@@ -49,6 +53,12 @@ func (code *ResourceVersionPolicy) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ResourceVersionPolicy) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ResourceVersionPolicy) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ResourceVersionPolicy) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ResourceVersionPolicy) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ResourceVersionPolicy) protoNumber() uint64 {
+	switch code {
+	case ResourceVersionPolicyNoVersion:
+		return 1
+	case ResourceVersionPolicyVersioned:
+		return 2
+	case ResourceVersionPolicyVersionedUpdate:
+		return 3
+	}
+	return 0
+}
+func (code *ResourceVersionPolicy) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ResourceVersionPolicyNoVersion
+	case 2:
+		*code = ResourceVersionPolicyVersioned
+	case 3:
+		*code = ResourceVersionPolicyVersionedUpdate
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code ResponseType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *ResponseType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *ResponseType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "ok":
 		*code = ResponseTypeOk
@@ -49,6 +53,12 @@ func (code *ResponseType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code ResponseType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *ResponseType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code ResponseType) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code ResponseType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code ResponseType) protoNumber() uint64 {
+	switch code {
+	case ResponseTypeOk:
+		return 1
+	case ResponseTypeTransientError:
+		return 2
+	case ResponseTypeFatalError:
+		return 3
+	}
+	return 0
+}
+func (code *ResponseType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = ResponseTypeOk
+	case 2:
+		*code = ResponseTypeTransientError
+	case 3:
+		*code = ResponseTypeFatalError
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code RestfulCapabilityMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *RestfulCapabilityMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *RestfulCapabilityMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "client":
 		*code = RestfulCapabilityModeClient
@@ -46,6 +50,12 @@ func (code *RestfulCapabilityMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code RestfulCapabilityMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *RestfulCapabilityMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code RestfulCapabilityMode) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code RestfulCapabilityMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code RestfulCapabilityMode) protoNumber() uint64 {
+	switch code {
+	case RestfulCapabilityModeClient:
+		return 1
+	case RestfulCapabilityModeServer:
+		return 2
+	}
+	return 0
+}
+func (code *RestfulCapabilityMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = RestfulCapabilityModeClient
+	case 2:
+		*code = RestfulCapabilityModeServer
+	default:
+		return false
+	}
+	return true
+}
@@ -14,69 +14,2321 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // RiskAssessment is documented here http://hl7.org/fhir/StructureDefinition/RiskAssessment
 type RiskAssessment struct {
-	Id                 *string                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn            *Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Parent             *Reference                 `bson:"parent,omitempty" json:"parent,omitempty"`
-	Status             ObservationStatus          `bson:"status" json:"status"`
-	Method             *CodeableConcept           `bson:"method,omitempty" json:"method,omitempty"`
-	Code               *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
-	Subject            Reference                  `bson:"subject" json:"subject"`
-	Encounter          *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	OccurrenceDateTime *string                    `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *Period                    `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
-	Condition          *Reference                 `bson:"condition,omitempty" json:"condition,omitempty"`
-	Performer          *Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
-	ReasonCode         []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference    []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Basis              []Reference                `bson:"basis,omitempty" json:"basis,omitempty"`
-	Prediction         []RiskAssessmentPrediction `bson:"prediction,omitempty" json:"prediction,omitempty"`
-	Mitigation         *string                    `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
-	Note               []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
+	Id                        *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element                   `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                      *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier               `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                   *Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Parent                    *Reference                 `bson:"parent,omitempty" json:"parent,omitempty"`
+	Status                    ObservationStatus          `bson:"status" json:"status"`
+	StatusElement             *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Method                    *CodeableConcept           `bson:"method,omitempty" json:"method,omitempty"`
+	Code                      *CodeableConcept           `bson:"code,omitempty" json:"code,omitempty"`
+	Subject                   Reference                  `bson:"subject" json:"subject"`
+	Encounter                 *Reference                 `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime        *string                    `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                   `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *Period                    `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	Condition                 *Reference                 `bson:"condition,omitempty" json:"condition,omitempty"`
+	Performer                 *Reference                 `bson:"performer,omitempty" json:"performer,omitempty"`
+	ReasonCode                []CodeableConcept          `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference           []Reference                `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Basis                     []Reference                `bson:"basis,omitempty" json:"basis,omitempty"`
+	Prediction                []RiskAssessmentPrediction `bson:"prediction,omitempty" json:"prediction,omitempty"`
+	Mitigation                *string                    `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
+	MitigationElement         *Element                   `bson:"_mitigation,omitempty" json:"_mitigation,omitempty"`
+	Note                      []Annotation               `bson:"note,omitempty" json:"note,omitempty"`
 }
+
+// RiskAssessmentOccurrence is implemented by the types allowed for RiskAssessment.occurrence[x]
+type RiskAssessmentOccurrence interface {
+	isRiskAssessmentOccurrence()
+}
+
+func (DateTime) isRiskAssessmentOccurrence() {}
+func (Period) isRiskAssessmentOccurrence()   {}
+
+// Occurrence returns the value of RiskAssessment.occurrence[x] together with its FHIR type code or nil if no value is set
+func (r RiskAssessment) Occurrence() (RiskAssessmentOccurrence, string) {
+	if r.OccurrenceDateTime != nil {
+		return DateTime(*r.OccurrenceDateTime), "dateTime"
+	}
+	if r.OccurrencePeriod != nil {
+		return *r.OccurrencePeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetOccurrence sets the value of RiskAssessment.occurrence[x], given as value or pointer, and clears all other types
+func (r *RiskAssessment) SetOccurrence(value RiskAssessmentOccurrence) {
+	r.OccurrenceDateTime = nil
+	r.OccurrencePeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OccurrenceDateTime = &primitive
+	case *DateTime:
+		r.OccurrenceDateTime = (*string)(v)
+	case Period:
+		r.OccurrencePeriod = &v
+	case *Period:
+		r.OccurrencePeriod = v
+	}
+}
+
 type RiskAssessmentPrediction struct {
-	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension          []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Outcome            *CodeableConcept `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	ProbabilityDecimal *json.Number     `bson:"probabilityDecimal,omitempty" json:"probabilityDecimal,omitempty"`
-	ProbabilityRange   *Range           `bson:"probabilityRange,omitempty" json:"probabilityRange,omitempty"`
-	QualitativeRisk    *CodeableConcept `bson:"qualitativeRisk,omitempty" json:"qualitativeRisk,omitempty"`
-	RelativeRisk       *json.Number     `bson:"relativeRisk,omitempty" json:"relativeRisk,omitempty"`
-	WhenPeriod         *Period          `bson:"whenPeriod,omitempty" json:"whenPeriod,omitempty"`
-	WhenRange          *Range           `bson:"whenRange,omitempty" json:"whenRange,omitempty"`
-	Rationale          *string          `bson:"rationale,omitempty" json:"rationale,omitempty"`
-}
-type OtherRiskAssessment RiskAssessment
+	Id                        *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                 []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Outcome                   *CodeableConcept `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	ProbabilityDecimal        *json.Number     `bson:"probabilityDecimal,omitempty" json:"probabilityDecimal,omitempty"`
+	ProbabilityDecimalElement *Element         `bson:"_probabilityDecimal,omitempty" json:"_probabilityDecimal,omitempty"`
+	ProbabilityRange          *Range           `bson:"probabilityRange,omitempty" json:"probabilityRange,omitempty"`
+	QualitativeRisk           *CodeableConcept `bson:"qualitativeRisk,omitempty" json:"qualitativeRisk,omitempty"`
+	RelativeRisk              *json.Number     `bson:"relativeRisk,omitempty" json:"relativeRisk,omitempty"`
+	RelativeRiskElement       *Element         `bson:"_relativeRisk,omitempty" json:"_relativeRisk,omitempty"`
+	WhenPeriod                *Period          `bson:"whenPeriod,omitempty" json:"whenPeriod,omitempty"`
+	WhenRange                 *Range           `bson:"whenRange,omitempty" json:"whenRange,omitempty"`
+	Rationale                 *string          `bson:"rationale,omitempty" json:"rationale,omitempty"`
+	RationaleElement          *Element         `bson:"_rationale,omitempty" json:"_rationale,omitempty"`
+}
+
+// RiskAssessmentPredictionProbability is implemented by the types allowed for RiskAssessment.prediction.probability[x]
+type RiskAssessmentPredictionProbability interface {
+	isRiskAssessmentPredictionProbability()
+}
+
+func (Decimal) isRiskAssessmentPredictionProbability() {}
+func (Range) isRiskAssessmentPredictionProbability()   {}
+
+// Probability returns the value of RiskAssessment.prediction.probability[x] together with its FHIR type code or nil if no value is set
+func (r RiskAssessmentPrediction) Probability() (RiskAssessmentPredictionProbability, string) {
+	if r.ProbabilityDecimal != nil {
+		return Decimal(*r.ProbabilityDecimal), "decimal"
+	}
+	if r.ProbabilityRange != nil {
+		return *r.ProbabilityRange, "Range"
+	}
+	return nil, ""
+}
+
+// SetProbability sets the value of RiskAssessment.prediction.probability[x], given as value or pointer, and clears all other types
+func (r *RiskAssessmentPrediction) SetProbability(value RiskAssessmentPredictionProbability) {
+	r.ProbabilityDecimal = nil
+	r.ProbabilityRange = nil
+	switch v := value.(type) {
+	case Decimal:
+		primitive := json.Number(v)
+		r.ProbabilityDecimal = &primitive
+	case *Decimal:
+		r.ProbabilityDecimal = (*json.Number)(v)
+	case Range:
+		r.ProbabilityRange = &v
+	case *Range:
+		r.ProbabilityRange = v
+	}
+}
+
+// RiskAssessmentPredictionWhen is implemented by the types allowed for RiskAssessment.prediction.when[x]
+type RiskAssessmentPredictionWhen interface {
+	isRiskAssessmentPredictionWhen()
+}
+
+func (Period) isRiskAssessmentPredictionWhen() {}
+func (Range) isRiskAssessmentPredictionWhen()  {}
+
+// When returns the value of RiskAssessment.prediction.when[x] together with its FHIR type code or nil if no value is set
+func (r RiskAssessmentPrediction) When() (RiskAssessmentPredictionWhen, string) {
+	if r.WhenPeriod != nil {
+		return *r.WhenPeriod, "Period"
+	}
+	if r.WhenRange != nil {
+		return *r.WhenRange, "Range"
+	}
+	return nil, ""
+}
+
+// SetWhen sets the value of RiskAssessment.prediction.when[x], given as value or pointer, and clears all other types
+func (r *RiskAssessmentPrediction) SetWhen(value RiskAssessmentPredictionWhen) {
+	r.WhenPeriod = nil
+	r.WhenRange = nil
+	switch v := value.(type) {
+	case Period:
+		r.WhenPeriod = &v
+	case *Period:
+		r.WhenPeriod = v
+	case Range:
+		r.WhenRange = &v
+	case *Range:
+		r.WhenRange = v
+	}
+}
 
 // MarshalJSON marshals the given RiskAssessment as JSON into a byte slice
 func (r RiskAssessment) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherRiskAssessment
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherRiskAssessment: OtherRiskAssessment(r),
-		ResourceType:        "RiskAssessment",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RiskAssessment as JSON object with the members in the order of the fields
+func (r RiskAssessment) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"RiskAssessment\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.BasedOn != nil {
+		e.key("basedOn")
+		r.BasedOn.appendJSON(e)
+	}
+	if r.Parent != nil {
+		e.key("parent")
+		r.Parent.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Method != nil {
+		e.key("method")
+		r.Method.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.key("occurrenceDateTime")
+		e.string(*r.OccurrenceDateTime)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.key("_occurrenceDateTime")
+		r.OccurrenceDateTimeElement.appendJSON(e)
+	}
+	if r.OccurrencePeriod != nil {
+		e.key("occurrencePeriod")
+		r.OccurrencePeriod.appendJSON(e)
+	}
+	if r.Condition != nil {
+		e.key("condition")
+		r.Condition.appendJSON(e)
+	}
+	if r.Performer != nil {
+		e.key("performer")
+		r.Performer.appendJSON(e)
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Basis) > 0 {
+		e.key("basis")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Basis {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Prediction) > 0 {
+		e.key("prediction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Prediction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Mitigation != nil {
+		e.key("mitigation")
+		e.string(*r.Mitigation)
+	}
+	if r.MitigationElement != nil {
+		e.key("_mitigation")
+		r.MitigationElement.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RiskAssessment from JSON. Unknown members are ignored.
+func (r *RiskAssessment) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RiskAssessment
+func (r *RiskAssessment) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "basedOn":
+			if d.null() {
+				r.BasedOn = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = &v
+			}
+		case "parent":
+			if d.null() {
+				r.Parent = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Parent = &v
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Method = &v
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "occurrenceDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurrenceDateTime = &v
+			} else {
+				r.OccurrenceDateTime = nil
+			}
+		case "_occurrenceDateTime":
+			r.OccurrenceDateTimeElement = d.element()
+		case "occurrencePeriod":
+			if d.null() {
+				r.OccurrencePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OccurrencePeriod = &v
+			}
+		case "condition":
+			if d.null() {
+				r.Condition = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Condition = &v
+			}
+		case "performer":
+			if d.null() {
+				r.Performer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = &v
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "basis":
+			a := d.array()
+			r.Basis = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Basis = append(r.Basis, v)
+			}
+			if r.Basis == nil && !a.isNull {
+				r.Basis = []Reference{}
+			}
+		case "prediction":
+			a := d.array()
+			r.Prediction = nil
+			for a.next() {
+				var v RiskAssessmentPrediction
+				v.decodeJSON(d)
+				r.Prediction = append(r.Prediction, v)
+			}
+			if r.Prediction == nil && !a.isNull {
+				r.Prediction = []RiskAssessmentPrediction{}
+			}
+		case "mitigation":
+			if v, ok := d.string(); ok {
+				r.Mitigation = &v
+			} else {
+				r.Mitigation = nil
+			}
+		case "_mitigation":
+			r.MitigationElement = d.element()
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RiskAssessment which shares no memory with the original
+func (r RiskAssessment) DeepCopy() RiskAssessment {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.BasedOn != nil {
+		v := r.BasedOn.DeepCopy()
+		out.BasedOn = &v
+	}
+	if r.Parent != nil {
+		v := r.Parent.DeepCopy()
+		out.Parent = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Method != nil {
+		v := r.Method.DeepCopy()
+		out.Method = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.OccurrenceDateTime != nil {
+		v := *r.OccurrenceDateTime
+		out.OccurrenceDateTime = &v
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		v := r.OccurrenceDateTimeElement.DeepCopy()
+		out.OccurrenceDateTimeElement = &v
+	}
+	if r.OccurrencePeriod != nil {
+		v := r.OccurrencePeriod.DeepCopy()
+		out.OccurrencePeriod = &v
+	}
+	if r.Condition != nil {
+		v := r.Condition.DeepCopy()
+		out.Condition = &v
+	}
+	if r.Performer != nil {
+		v := r.Performer.DeepCopy()
+		out.Performer = &v
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Basis != nil {
+		out.Basis = make([]Reference, len(r.Basis))
+		for i := range r.Basis {
+			out.Basis[i] = r.Basis[i].DeepCopy()
+		}
+	}
+	if r.Prediction != nil {
+		out.Prediction = make([]RiskAssessmentPrediction, len(r.Prediction))
+		for i := range r.Prediction {
+			out.Prediction[i] = r.Prediction[i].DeepCopy()
+		}
+	}
+	if r.Mitigation != nil {
+		v := *r.Mitigation
+		out.Mitigation = &v
+	}
+	if r.MitigationElement != nil {
+		v := r.MitigationElement.DeepCopy()
+		out.MitigationElement = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RiskAssessment) Equal(other RiskAssessment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.BasedOn == nil) != (other.BasedOn == nil) || r.BasedOn != nil && !r.BasedOn.Equal(*other.BasedOn) {
+		return false
+	}
+	if (r.Parent == nil) != (other.Parent == nil) || r.Parent != nil && !r.Parent.Equal(*other.Parent) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.Equal(*other.Method) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && *r.OccurrenceDateTime != *other.OccurrenceDateTime {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.Equal(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.Equal(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !r.Condition.Equal(*other.Condition) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.Equal(*other.Performer) {
+		return false
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Basis) != len(other.Basis) {
+		return false
+	}
+	for i := range r.Basis {
+		if !r.Basis[i].Equal(other.Basis[i]) {
+			return false
+		}
+	}
+	if len(r.Prediction) != len(other.Prediction) {
+		return false
+	}
+	for i := range r.Prediction {
+		if !r.Prediction[i].Equal(other.Prediction[i]) {
+			return false
+		}
+	}
+	if (r.Mitigation == nil) != (other.Mitigation == nil) || r.Mitigation != nil && *r.Mitigation != *other.Mitigation {
+		return false
+	}
+	if (r.MitigationElement == nil) != (other.MitigationElement == nil) || r.MitigationElement != nil && !r.MitigationElement.Equal(*other.MitigationElement) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RiskAssessment) EqualsDeep(other RiskAssessment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.BasedOn == nil) != (other.BasedOn == nil) || r.BasedOn != nil && !r.BasedOn.EqualsDeep(*other.BasedOn) {
+		return false
+	}
+	if (r.Parent == nil) != (other.Parent == nil) || r.Parent != nil && !r.Parent.EqualsDeep(*other.Parent) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.EqualsDeep(*other.Method) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.EqualsDeep(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.EqualsDeep(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !r.Condition.EqualsDeep(*other.Condition) {
+		return false
+	}
+	if (r.Performer == nil) != (other.Performer == nil) || r.Performer != nil && !r.Performer.EqualsDeep(*other.Performer) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Basis), len(other.Basis), func(i, j int) bool {
+		return r.Basis[i].EqualsDeep(other.Basis[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Prediction), len(other.Prediction), func(i, j int) bool {
+		return r.Prediction[i].EqualsDeep(other.Prediction[j])
+	}) {
+		return false
+	}
+	if (r.Mitigation == nil) != (other.Mitigation == nil) || r.Mitigation != nil && !equivalentString(*r.Mitigation, *other.Mitigation) {
+		return false
+	}
+	if (r.MitigationElement == nil) != (other.MitigationElement == nil) || r.MitigationElement != nil && !r.MitigationElement.EqualsDeep(*other.MitigationElement) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RiskAssessment) EqualsShallow(other RiskAssessment) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.Mitigation == nil) != (other.Mitigation == nil) || r.Mitigation != nil && !equivalentString(*r.Mitigation, *other.Mitigation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RiskAssessment as FHIR XML
+func (r RiskAssessment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "RiskAssessment",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "basedOn", r.BasedOn); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "parent", r.Parent); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "method", r.Method); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceDateTime", r.OccurrenceDateTime, r.OccurrenceDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrencePeriod", r.OccurrencePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "condition", r.Condition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performer", r.Performer); err != nil {
+		return err
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Basis {
+		if err := encodeXMLElement(e, "basis", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Prediction {
+		if err := encodeXMLElement(e, "prediction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "mitigation", r.Mitigation, r.MitigationElement); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RiskAssessment from FHIR XML
+func (r *RiskAssessment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = &v
+			case "parent":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parent = &v
+			case "status":
+				var v ObservationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "method":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Method = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "occurrenceDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceDateTime = &v
+				}
+				r.OccurrenceDateTimeElement = element
+			case "occurrencePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrencePeriod = &v
+			case "condition":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Condition = &v
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "basis":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Basis = append(r.Basis, v)
+			case "prediction":
+				var v RiskAssessmentPrediction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Prediction = append(r.Prediction, v)
+			case "mitigation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mitigation = &v
+				}
+				r.MitigationElement = element
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RiskAssessment as properties to the RDF node n
+func (r RiskAssessment) turtle(n *turtleNode) {
+	n.resource("RiskAssessment", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("RiskAssessment.identifier", i, v)
+	}
+	n.element("RiskAssessment.basedOn", -1, r.BasedOn)
+	n.element("RiskAssessment.parent", -1, r.Parent)
+	n.primitive("RiskAssessment.status", -1, r.Status, r.StatusElement, "code")
+	n.element("RiskAssessment.method", -1, r.Method)
+	n.element("RiskAssessment.code", -1, r.Code)
+	n.element("RiskAssessment.subject", -1, r.Subject)
+	n.element("RiskAssessment.encounter", -1, r.Encounter)
+	n.primitive("RiskAssessment.occurrenceDateTime", -1, r.OccurrenceDateTime, r.OccurrenceDateTimeElement, "dateTime")
+	n.element("RiskAssessment.occurrencePeriod", -1, r.OccurrencePeriod)
+	n.element("RiskAssessment.condition", -1, r.Condition)
+	n.element("RiskAssessment.performer", -1, r.Performer)
+	for i, v := range r.ReasonCode {
+		n.element("RiskAssessment.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("RiskAssessment.reasonReference", i, v)
+	}
+	for i, v := range r.Basis {
+		n.element("RiskAssessment.basis", i, v)
+	}
+	for i, v := range r.Prediction {
+		n.element("RiskAssessment.prediction", i, v)
+	}
+	n.primitive("RiskAssessment.mitigation", -1, r.Mitigation, r.MitigationElement, "string")
+	for i, v := range r.Note {
+		n.element("RiskAssessment.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given RiskAssessment as BSON document with the structure of its FHIR JSON
+func (r RiskAssessment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RiskAssessment from a BSON document with the structure of its FHIR JSON
+func (r *RiskAssessment) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RiskAssessment as protobuf message RiskAssessment of fhir.proto
+func (r RiskAssessment) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RiskAssessment to the encoded message
+func (r RiskAssessment) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.BasedOn != nil {
+		e.message(10, *r.BasedOn)
+	}
+	if r.Parent != nil {
+		e.message(11, *r.Parent)
+	}
+	e.varint(12, r.Status.protoNumber())
+	if r.Method != nil {
+		e.message(13, *r.Method)
+	}
+	if r.Code != nil {
+		e.message(14, *r.Code)
+	}
+	e.message(15, r.Subject)
+	if r.Encounter != nil {
+		e.message(16, *r.Encounter)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.string(17, *r.OccurrenceDateTime)
+	}
+	if r.OccurrencePeriod != nil {
+		e.message(18, *r.OccurrencePeriod)
+	}
+	if r.Condition != nil {
+		e.message(19, *r.Condition)
+	}
+	if r.Performer != nil {
+		e.message(20, *r.Performer)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(21, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(22, v)
+	}
+	for _, v := range r.Basis {
+		e.message(23, v)
+	}
+	for _, v := range r.Prediction {
+		e.message(24, v)
+	}
+	if r.Mitigation != nil {
+		e.string(25, *r.Mitigation)
+	}
+	for _, v := range r.Note {
+		e.message(26, v)
+	}
+	if r.IdElement != nil {
+		e.message(27, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(28, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(29, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(30, *r.StatusElement)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.message(31, *r.OccurrenceDateTimeElement)
+	}
+	if r.MitigationElement != nil {
+		e.message(32, *r.MitigationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RiskAssessment from protobuf message RiskAssessment of fhir.proto
+func (r *RiskAssessment) UnmarshalProto(b []byte) error {
+	*r = RiskAssessment{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = &v
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.Parent = &v
+		case 12:
+			var v ObservationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Method = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 17:
+			v := d.string()
+			r.OccurrenceDateTime = &v
+		case 18:
+			var v Period
+			d.message(&v)
+			r.OccurrencePeriod = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Condition = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Performer = &v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.Basis = append(r.Basis, v)
+		case 24:
+			var v RiskAssessmentPrediction
+			d.message(&v)
+			r.Prediction = append(r.Prediction, v)
+		case 25:
+			v := d.string()
+			r.Mitigation = &v
+		case 26:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 27:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.OccurrenceDateTimeElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.MitigationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RiskAssessmentPrediction as JSON into a byte slice
+func (r RiskAssessmentPrediction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RiskAssessmentPrediction as JSON object with the members in the order of the fields
+func (r RiskAssessmentPrediction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Outcome != nil {
+		e.key("outcome")
+		r.Outcome.appendJSON(e)
+	}
+	if r.ProbabilityDecimal != nil {
+		e.key("probabilityDecimal")
+		e.number(*r.ProbabilityDecimal)
+	}
+	if r.ProbabilityDecimalElement != nil {
+		e.key("_probabilityDecimal")
+		r.ProbabilityDecimalElement.appendJSON(e)
+	}
+	if r.ProbabilityRange != nil {
+		e.key("probabilityRange")
+		r.ProbabilityRange.appendJSON(e)
+	}
+	if r.QualitativeRisk != nil {
+		e.key("qualitativeRisk")
+		r.QualitativeRisk.appendJSON(e)
+	}
+	if r.RelativeRisk != nil {
+		e.key("relativeRisk")
+		e.number(*r.RelativeRisk)
+	}
+	if r.RelativeRiskElement != nil {
+		e.key("_relativeRisk")
+		r.RelativeRiskElement.appendJSON(e)
+	}
+	if r.WhenPeriod != nil {
+		e.key("whenPeriod")
+		r.WhenPeriod.appendJSON(e)
+	}
+	if r.WhenRange != nil {
+		e.key("whenRange")
+		r.WhenRange.appendJSON(e)
+	}
+	if r.Rationale != nil {
+		e.key("rationale")
+		e.string(*r.Rationale)
+	}
+	if r.RationaleElement != nil {
+		e.key("_rationale")
+		r.RationaleElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RiskAssessmentPrediction from JSON. Unknown members are ignored.
+func (r *RiskAssessmentPrediction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RiskAssessmentPrediction
+func (r *RiskAssessmentPrediction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "outcome":
+			if d.null() {
+				r.Outcome = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Outcome = &v
+			}
+		case "probabilityDecimal":
+			if v, ok := d.number(); ok {
+				r.ProbabilityDecimal = &v
+			} else {
+				r.ProbabilityDecimal = nil
+			}
+		case "_probabilityDecimal":
+			r.ProbabilityDecimalElement = d.element()
+		case "probabilityRange":
+			if d.null() {
+				r.ProbabilityRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.ProbabilityRange = &v
+			}
+		case "qualitativeRisk":
+			if d.null() {
+				r.QualitativeRisk = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.QualitativeRisk = &v
+			}
+		case "relativeRisk":
+			if v, ok := d.number(); ok {
+				r.RelativeRisk = &v
+			} else {
+				r.RelativeRisk = nil
+			}
+		case "_relativeRisk":
+			r.RelativeRiskElement = d.element()
+		case "whenPeriod":
+			if d.null() {
+				r.WhenPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.WhenPeriod = &v
+			}
+		case "whenRange":
+			if d.null() {
+				r.WhenRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.WhenRange = &v
+			}
+		case "rationale":
+			if v, ok := d.string(); ok {
+				r.Rationale = &v
+			} else {
+				r.Rationale = nil
+			}
+		case "_rationale":
+			r.RationaleElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RiskAssessmentPrediction which shares no memory with the original
+func (r RiskAssessmentPrediction) DeepCopy() RiskAssessmentPrediction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Outcome != nil {
+		v := r.Outcome.DeepCopy()
+		out.Outcome = &v
+	}
+	if r.ProbabilityDecimal != nil {
+		v := *r.ProbabilityDecimal
+		out.ProbabilityDecimal = &v
+	}
+	if r.ProbabilityDecimalElement != nil {
+		v := r.ProbabilityDecimalElement.DeepCopy()
+		out.ProbabilityDecimalElement = &v
+	}
+	if r.ProbabilityRange != nil {
+		v := r.ProbabilityRange.DeepCopy()
+		out.ProbabilityRange = &v
+	}
+	if r.QualitativeRisk != nil {
+		v := r.QualitativeRisk.DeepCopy()
+		out.QualitativeRisk = &v
+	}
+	if r.RelativeRisk != nil {
+		v := *r.RelativeRisk
+		out.RelativeRisk = &v
+	}
+	if r.RelativeRiskElement != nil {
+		v := r.RelativeRiskElement.DeepCopy()
+		out.RelativeRiskElement = &v
+	}
+	if r.WhenPeriod != nil {
+		v := r.WhenPeriod.DeepCopy()
+		out.WhenPeriod = &v
+	}
+	if r.WhenRange != nil {
+		v := r.WhenRange.DeepCopy()
+		out.WhenRange = &v
+	}
+	if r.Rationale != nil {
+		v := *r.Rationale
+		out.Rationale = &v
+	}
+	if r.RationaleElement != nil {
+		v := r.RationaleElement.DeepCopy()
+		out.RationaleElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RiskAssessmentPrediction) Equal(other RiskAssessmentPrediction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && !r.Outcome.Equal(*other.Outcome) {
+		return false
+	}
+	if (r.ProbabilityDecimal == nil) != (other.ProbabilityDecimal == nil) || r.ProbabilityDecimal != nil && !EqualDecimal(*r.ProbabilityDecimal, *other.ProbabilityDecimal) {
+		return false
+	}
+	if (r.ProbabilityDecimalElement == nil) != (other.ProbabilityDecimalElement == nil) || r.ProbabilityDecimalElement != nil && !r.ProbabilityDecimalElement.Equal(*other.ProbabilityDecimalElement) {
+		return false
+	}
+	if (r.ProbabilityRange == nil) != (other.ProbabilityRange == nil) || r.ProbabilityRange != nil && !r.ProbabilityRange.Equal(*other.ProbabilityRange) {
+		return false
+	}
+	if (r.QualitativeRisk == nil) != (other.QualitativeRisk == nil) || r.QualitativeRisk != nil && !r.QualitativeRisk.Equal(*other.QualitativeRisk) {
+		return false
+	}
+	if (r.RelativeRisk == nil) != (other.RelativeRisk == nil) || r.RelativeRisk != nil && !EqualDecimal(*r.RelativeRisk, *other.RelativeRisk) {
+		return false
+	}
+	if (r.RelativeRiskElement == nil) != (other.RelativeRiskElement == nil) || r.RelativeRiskElement != nil && !r.RelativeRiskElement.Equal(*other.RelativeRiskElement) {
+		return false
+	}
+	if (r.WhenPeriod == nil) != (other.WhenPeriod == nil) || r.WhenPeriod != nil && !r.WhenPeriod.Equal(*other.WhenPeriod) {
+		return false
+	}
+	if (r.WhenRange == nil) != (other.WhenRange == nil) || r.WhenRange != nil && !r.WhenRange.Equal(*other.WhenRange) {
+		return false
+	}
+	if (r.Rationale == nil) != (other.Rationale == nil) || r.Rationale != nil && *r.Rationale != *other.Rationale {
+		return false
+	}
+	if (r.RationaleElement == nil) != (other.RationaleElement == nil) || r.RationaleElement != nil && !r.RationaleElement.Equal(*other.RationaleElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RiskAssessmentPrediction) EqualsDeep(other RiskAssessmentPrediction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Outcome == nil) != (other.Outcome == nil) || r.Outcome != nil && !r.Outcome.EqualsDeep(*other.Outcome) {
+		return false
+	}
+	if (r.ProbabilityDecimal == nil) != (other.ProbabilityDecimal == nil) || r.ProbabilityDecimal != nil && !equivalentDecimal(*r.ProbabilityDecimal, *other.ProbabilityDecimal) {
+		return false
+	}
+	if (r.ProbabilityDecimalElement == nil) != (other.ProbabilityDecimalElement == nil) || r.ProbabilityDecimalElement != nil && !r.ProbabilityDecimalElement.EqualsDeep(*other.ProbabilityDecimalElement) {
+		return false
+	}
+	if (r.ProbabilityRange == nil) != (other.ProbabilityRange == nil) || r.ProbabilityRange != nil && !r.ProbabilityRange.EqualsDeep(*other.ProbabilityRange) {
+		return false
+	}
+	if (r.QualitativeRisk == nil) != (other.QualitativeRisk == nil) || r.QualitativeRisk != nil && !r.QualitativeRisk.EqualsDeep(*other.QualitativeRisk) {
+		return false
+	}
+	if (r.RelativeRisk == nil) != (other.RelativeRisk == nil) || r.RelativeRisk != nil && !equivalentDecimal(*r.RelativeRisk, *other.RelativeRisk) {
+		return false
+	}
+	if (r.RelativeRiskElement == nil) != (other.RelativeRiskElement == nil) || r.RelativeRiskElement != nil && !r.RelativeRiskElement.EqualsDeep(*other.RelativeRiskElement) {
+		return false
+	}
+	if (r.WhenPeriod == nil) != (other.WhenPeriod == nil) || r.WhenPeriod != nil && !r.WhenPeriod.EqualsDeep(*other.WhenPeriod) {
+		return false
+	}
+	if (r.WhenRange == nil) != (other.WhenRange == nil) || r.WhenRange != nil && !r.WhenRange.EqualsDeep(*other.WhenRange) {
+		return false
+	}
+	if (r.Rationale == nil) != (other.Rationale == nil) || r.Rationale != nil && !equivalentString(*r.Rationale, *other.Rationale) {
+		return false
+	}
+	if (r.RationaleElement == nil) != (other.RationaleElement == nil) || r.RationaleElement != nil && !r.RationaleElement.EqualsDeep(*other.RationaleElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RiskAssessmentPrediction) EqualsShallow(other RiskAssessmentPrediction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ProbabilityDecimal == nil) != (other.ProbabilityDecimal == nil) || r.ProbabilityDecimal != nil && !equivalentDecimal(*r.ProbabilityDecimal, *other.ProbabilityDecimal) {
+		return false
+	}
+	if (r.RelativeRisk == nil) != (other.RelativeRisk == nil) || r.RelativeRisk != nil && !equivalentDecimal(*r.RelativeRisk, *other.RelativeRisk) {
+		return false
+	}
+	if (r.Rationale == nil) != (other.Rationale == nil) || r.Rationale != nil && !equivalentString(*r.Rationale, *other.Rationale) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RiskAssessmentPrediction as FHIR XML
+func (r RiskAssessmentPrediction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "outcome", r.Outcome); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "probabilityDecimal", r.ProbabilityDecimal, r.ProbabilityDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "probabilityRange", r.ProbabilityRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "qualitativeRisk", r.QualitativeRisk); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "relativeRisk", r.RelativeRisk, r.RelativeRiskElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "whenPeriod", r.WhenPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "whenRange", r.WhenRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "rationale", r.Rationale, r.RationaleElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RiskAssessmentPrediction from FHIR XML
+func (r *RiskAssessmentPrediction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "outcome":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Outcome = &v
+			case "probabilityDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ProbabilityDecimal = &v
+				}
+				r.ProbabilityDecimalElement = element
+			case "probabilityRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProbabilityRange = &v
+			case "qualitativeRisk":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.QualitativeRisk = &v
+			case "relativeRisk":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RelativeRisk = &v
+				}
+				r.RelativeRiskElement = element
+			case "whenPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.WhenPeriod = &v
+			case "whenRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.WhenRange = &v
+			case "rationale":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Rationale = &v
+				}
+				r.RationaleElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RiskAssessmentPrediction as properties to the RDF node n
+func (r RiskAssessmentPrediction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("RiskAssessment.prediction.outcome", -1, r.Outcome)
+	n.primitive("RiskAssessment.prediction.probabilityDecimal", -1, r.ProbabilityDecimal, r.ProbabilityDecimalElement, "decimal")
+	n.element("RiskAssessment.prediction.probabilityRange", -1, r.ProbabilityRange)
+	n.element("RiskAssessment.prediction.qualitativeRisk", -1, r.QualitativeRisk)
+	n.primitive("RiskAssessment.prediction.relativeRisk", -1, r.RelativeRisk, r.RelativeRiskElement, "decimal")
+	n.element("RiskAssessment.prediction.whenPeriod", -1, r.WhenPeriod)
+	n.element("RiskAssessment.prediction.whenRange", -1, r.WhenRange)
+	n.primitive("RiskAssessment.prediction.rationale", -1, r.Rationale, r.RationaleElement, "string")
+}
+
+// MarshalBSON marshals the given RiskAssessmentPrediction as BSON document with the structure of its FHIR JSON
+func (r RiskAssessmentPrediction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RiskAssessmentPrediction from a BSON document with the structure of its FHIR JSON
+func (r *RiskAssessmentPrediction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RiskAssessmentPrediction as protobuf message RiskAssessmentPrediction of fhir.proto
+func (r RiskAssessmentPrediction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RiskAssessmentPrediction to the encoded message
+func (r RiskAssessmentPrediction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Outcome != nil {
+		e.message(4, *r.Outcome)
+	}
+	if r.ProbabilityDecimal != nil {
+		e.string(5, string(*r.ProbabilityDecimal))
+	}
+	if r.ProbabilityRange != nil {
+		e.message(6, *r.ProbabilityRange)
+	}
+	if r.QualitativeRisk != nil {
+		e.message(7, *r.QualitativeRisk)
+	}
+	if r.RelativeRisk != nil {
+		e.string(8, string(*r.RelativeRisk))
+	}
+	if r.WhenPeriod != nil {
+		e.message(9, *r.WhenPeriod)
+	}
+	if r.WhenRange != nil {
+		e.message(10, *r.WhenRange)
+	}
+	if r.Rationale != nil {
+		e.string(11, *r.Rationale)
+	}
+	if r.ProbabilityDecimalElement != nil {
+		e.message(12, *r.ProbabilityDecimalElement)
+	}
+	if r.RelativeRiskElement != nil {
+		e.message(13, *r.RelativeRiskElement)
+	}
+	if r.RationaleElement != nil {
+		e.message(14, *r.RationaleElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RiskAssessmentPrediction from protobuf message RiskAssessmentPrediction of fhir.proto
+func (r *RiskAssessmentPrediction) UnmarshalProto(b []byte) error {
+	*r = RiskAssessmentPrediction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Outcome = &v
+		case 5:
+			v := json.Number(d.string())
+			r.ProbabilityDecimal = &v
+		case 6:
+			var v Range
+			d.message(&v)
+			r.ProbabilityRange = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.QualitativeRisk = &v
+		case 8:
+			v := json.Number(d.string())
+			r.RelativeRisk = &v
+		case 9:
+			var v Period
+			d.message(&v)
+			r.WhenPeriod = &v
+		case 10:
+			var v Range
+			d.message(&v)
+			r.WhenRange = &v
+		case 11:
+			v := d.string()
+			r.Rationale = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ProbabilityDecimalElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.RelativeRiskElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.RationaleElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalRiskAssessment unmarshals a RiskAssessment.
 func UnmarshalRiskAssessment(b []byte) (RiskAssessment, error) {
 	var riskAssessment RiskAssessment
-	if err := json.Unmarshal(b, &riskAssessment); err != nil {
+	if err := riskAssessment.UnmarshalJSON(b); err != nil {
 		return riskAssessment, err
 	}
 	return riskAssessment, nil
@@ -14,71 +14,96 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // RiskEvidenceSynthesis is documented here http://hl7.org/fhir/StructureDefinition/RiskEvidenceSynthesis
 type RiskEvidenceSynthesis struct {
-	Id                *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                            `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string                            `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier        []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                            `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                            `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                            `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus                  `bson:"status" json:"status"`
-	Date              *string                            `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                            `bson:"description,omitempty" json:"description,omitempty"`
-	Note              []Annotation                       `bson:"note,omitempty" json:"note,omitempty"`
-	UseContext        []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Copyright         *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	ApprovalDate      *string                            `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
-	LastReviewDate    *string                            `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
-	EffectivePeriod   *Period                            `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
-	Topic             []CodeableConcept                  `bson:"topic,omitempty" json:"topic,omitempty"`
-	Author            []ContactDetail                    `bson:"author,omitempty" json:"author,omitempty"`
-	Editor            []ContactDetail                    `bson:"editor,omitempty" json:"editor,omitempty"`
-	Reviewer          []ContactDetail                    `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
-	Endorser          []ContactDetail                    `bson:"endorser,omitempty" json:"endorser,omitempty"`
-	RelatedArtifact   []RelatedArtifact                  `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
-	SynthesisType     *CodeableConcept                   `bson:"synthesisType,omitempty" json:"synthesisType,omitempty"`
-	StudyType         *CodeableConcept                   `bson:"studyType,omitempty" json:"studyType,omitempty"`
-	Population        Reference                          `bson:"population" json:"population"`
-	Exposure          *Reference                         `bson:"exposure,omitempty" json:"exposure,omitempty"`
-	Outcome           Reference                          `bson:"outcome" json:"outcome"`
-	SampleSize        *RiskEvidenceSynthesisSampleSize   `bson:"sampleSize,omitempty" json:"sampleSize,omitempty"`
-	RiskEstimate      *RiskEvidenceSynthesisRiskEstimate `bson:"riskEstimate,omitempty" json:"riskEstimate,omitempty"`
-	Certainty         []RiskEvidenceSynthesisCertainty   `bson:"certainty,omitempty" json:"certainty,omitempty"`
+	Id                    *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                           `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                  *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                           `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                            `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                           `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage                  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                   *string                            `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement            *Element                           `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier            []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version               *string                            `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement        *Element                           `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                  *string                            `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement           *Element                           `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                 *string                            `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement          *Element                           `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                PublicationStatus                  `bson:"status" json:"status"`
+	StatusElement         *Element                           `bson:"_status,omitempty" json:"_status,omitempty"`
+	Date                  *string                            `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement           *Element                           `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher             *string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement      *Element                           `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact               []ContactDetail                    `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description           *string                            `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement    *Element                           `bson:"_description,omitempty" json:"_description,omitempty"`
+	Note                  []Annotation                       `bson:"note,omitempty" json:"note,omitempty"`
+	UseContext            []UsageContext                     `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction          []CodeableConcept                  `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Copyright             *string                            `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement      *Element                           `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	ApprovalDate          *string                            `bson:"approvalDate,omitempty" json:"approvalDate,omitempty"`
+	ApprovalDateElement   *Element                           `bson:"_approvalDate,omitempty" json:"_approvalDate,omitempty"`
+	LastReviewDate        *string                            `bson:"lastReviewDate,omitempty" json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *Element                           `bson:"_lastReviewDate,omitempty" json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *Period                            `bson:"effectivePeriod,omitempty" json:"effectivePeriod,omitempty"`
+	Topic                 []CodeableConcept                  `bson:"topic,omitempty" json:"topic,omitempty"`
+	Author                []ContactDetail                    `bson:"author,omitempty" json:"author,omitempty"`
+	Editor                []ContactDetail                    `bson:"editor,omitempty" json:"editor,omitempty"`
+	Reviewer              []ContactDetail                    `bson:"reviewer,omitempty" json:"reviewer,omitempty"`
+	Endorser              []ContactDetail                    `bson:"endorser,omitempty" json:"endorser,omitempty"`
+	RelatedArtifact       []RelatedArtifact                  `bson:"relatedArtifact,omitempty" json:"relatedArtifact,omitempty"`
+	SynthesisType         *CodeableConcept                   `bson:"synthesisType,omitempty" json:"synthesisType,omitempty"`
+	StudyType             *CodeableConcept                   `bson:"studyType,omitempty" json:"studyType,omitempty"`
+	Population            Reference                          `bson:"population" json:"population"`
+	Exposure              *Reference                         `bson:"exposure,omitempty" json:"exposure,omitempty"`
+	Outcome               Reference                          `bson:"outcome" json:"outcome"`
+	SampleSize            *RiskEvidenceSynthesisSampleSize   `bson:"sampleSize,omitempty" json:"sampleSize,omitempty"`
+	RiskEstimate          *RiskEvidenceSynthesisRiskEstimate `bson:"riskEstimate,omitempty" json:"riskEstimate,omitempty"`
+	Certainty             []RiskEvidenceSynthesisCertainty   `bson:"certainty,omitempty" json:"certainty,omitempty"`
 }
 type RiskEvidenceSynthesisSampleSize struct {
-	Id                   *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description          *string     `bson:"description,omitempty" json:"description,omitempty"`
-	NumberOfStudies      *int        `bson:"numberOfStudies,omitempty" json:"numberOfStudies,omitempty"`
-	NumberOfParticipants *int        `bson:"numberOfParticipants,omitempty" json:"numberOfParticipants,omitempty"`
+	Id                          *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                   []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description                 *string     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement          *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	NumberOfStudies             *int        `bson:"numberOfStudies,omitempty" json:"numberOfStudies,omitempty"`
+	NumberOfStudiesElement      *Element    `bson:"_numberOfStudies,omitempty" json:"_numberOfStudies,omitempty"`
+	NumberOfParticipants        *int        `bson:"numberOfParticipants,omitempty" json:"numberOfParticipants,omitempty"`
+	NumberOfParticipantsElement *Element    `bson:"_numberOfParticipants,omitempty" json:"_numberOfParticipants,omitempty"`
 }
 type RiskEvidenceSynthesisRiskEstimate struct {
-	Id                *string                                              `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string                                              `bson:"description,omitempty" json:"description,omitempty"`
-	Type              *CodeableConcept                                     `bson:"type,omitempty" json:"type,omitempty"`
-	Value             *json.Number                                         `bson:"value,omitempty" json:"value,omitempty"`
-	UnitOfMeasure     *CodeableConcept                                     `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
-	DenominatorCount  *int                                                 `bson:"denominatorCount,omitempty" json:"denominatorCount,omitempty"`
-	NumeratorCount    *int                                                 `bson:"numeratorCount,omitempty" json:"numeratorCount,omitempty"`
-	PrecisionEstimate []RiskEvidenceSynthesisRiskEstimatePrecisionEstimate `bson:"precisionEstimate,omitempty" json:"precisionEstimate,omitempty"`
+	Id                      *string                                              `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                                          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description             *string                                              `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                                             `bson:"_description,omitempty" json:"_description,omitempty"`
+	Type                    *CodeableConcept                                     `bson:"type,omitempty" json:"type,omitempty"`
+	Value                   *json.Number                                         `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement            *Element                                             `bson:"_value,omitempty" json:"_value,omitempty"`
+	UnitOfMeasure           *CodeableConcept                                     `bson:"unitOfMeasure,omitempty" json:"unitOfMeasure,omitempty"`
+	DenominatorCount        *int                                                 `bson:"denominatorCount,omitempty" json:"denominatorCount,omitempty"`
+	DenominatorCountElement *Element                                             `bson:"_denominatorCount,omitempty" json:"_denominatorCount,omitempty"`
+	NumeratorCount          *int                                                 `bson:"numeratorCount,omitempty" json:"numeratorCount,omitempty"`
+	NumeratorCountElement   *Element                                             `bson:"_numeratorCount,omitempty" json:"_numeratorCount,omitempty"`
+	PrecisionEstimate       []RiskEvidenceSynthesisRiskEstimatePrecisionEstimate `bson:"precisionEstimate,omitempty" json:"precisionEstimate,omitempty"`
 }
 type RiskEvidenceSynthesisRiskEstimatePrecisionEstimate struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -86,8 +111,11 @@ type RiskEvidenceSynthesisRiskEstimatePrecisionEstimate struct {
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	Level             *json.Number     `bson:"level,omitempty" json:"level,omitempty"`
+	LevelElement      *Element         `bson:"_level,omitempty" json:"_level,omitempty"`
 	From              *json.Number     `bson:"from,omitempty" json:"from,omitempty"`
+	FromElement       *Element         `bson:"_from,omitempty" json:"_from,omitempty"`
 	To                *json.Number     `bson:"to,omitempty" json:"to,omitempty"`
+	ToElement         *Element         `bson:"_to,omitempty" json:"_to,omitempty"`
 }
 type RiskEvidenceSynthesisCertainty struct {
 	Id                    *string                                               `bson:"id,omitempty" json:"id,omitempty"`
@@ -105,23 +133,4913 @@ type RiskEvidenceSynthesisCertaintyCertaintySubcomponent struct {
 	Rating            []CodeableConcept `bson:"rating,omitempty" json:"rating,omitempty"`
 	Note              []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
 }
-type OtherRiskEvidenceSynthesis RiskEvidenceSynthesis
 
 // MarshalJSON marshals the given RiskEvidenceSynthesis as JSON into a byte slice
 func (r RiskEvidenceSynthesis) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherRiskEvidenceSynthesis
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherRiskEvidenceSynthesis: OtherRiskEvidenceSynthesis(r),
-		ResourceType:               "RiskEvidenceSynthesis",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RiskEvidenceSynthesis as JSON object with the members in the order of the fields
+func (r RiskEvidenceSynthesis) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"RiskEvidenceSynthesis\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if r.ApprovalDate != nil {
+		e.key("approvalDate")
+		e.string(*r.ApprovalDate)
+	}
+	if r.ApprovalDateElement != nil {
+		e.key("_approvalDate")
+		r.ApprovalDateElement.appendJSON(e)
+	}
+	if r.LastReviewDate != nil {
+		e.key("lastReviewDate")
+		e.string(*r.LastReviewDate)
+	}
+	if r.LastReviewDateElement != nil {
+		e.key("_lastReviewDate")
+		r.LastReviewDateElement.appendJSON(e)
+	}
+	if r.EffectivePeriod != nil {
+		e.key("effectivePeriod")
+		r.EffectivePeriod.appendJSON(e)
+	}
+	if len(r.Topic) > 0 {
+		e.key("topic")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Topic {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Editor) > 0 {
+		e.key("editor")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Editor {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Reviewer) > 0 {
+		e.key("reviewer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Reviewer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Endorser) > 0 {
+		e.key("endorser")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Endorser {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelatedArtifact) > 0 {
+		e.key("relatedArtifact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelatedArtifact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.SynthesisType != nil {
+		e.key("synthesisType")
+		r.SynthesisType.appendJSON(e)
+	}
+	if r.StudyType != nil {
+		e.key("studyType")
+		r.StudyType.appendJSON(e)
+	}
+	e.key("population")
+	r.Population.appendJSON(e)
+	if r.Exposure != nil {
+		e.key("exposure")
+		r.Exposure.appendJSON(e)
+	}
+	e.key("outcome")
+	r.Outcome.appendJSON(e)
+	if r.SampleSize != nil {
+		e.key("sampleSize")
+		r.SampleSize.appendJSON(e)
+	}
+	if r.RiskEstimate != nil {
+		e.key("riskEstimate")
+		r.RiskEstimate.appendJSON(e)
+	}
+	if len(r.Certainty) > 0 {
+		e.key("certainty")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Certainty {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RiskEvidenceSynthesis from JSON. Unknown members are ignored.
+func (r *RiskEvidenceSynthesis) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RiskEvidenceSynthesis
+func (r *RiskEvidenceSynthesis) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "approvalDate":
+			if v, ok := d.string(); ok {
+				r.ApprovalDate = &v
+			} else {
+				r.ApprovalDate = nil
+			}
+		case "_approvalDate":
+			r.ApprovalDateElement = d.element()
+		case "lastReviewDate":
+			if v, ok := d.string(); ok {
+				r.LastReviewDate = &v
+			} else {
+				r.LastReviewDate = nil
+			}
+		case "_lastReviewDate":
+			r.LastReviewDateElement = d.element()
+		case "effectivePeriod":
+			if d.null() {
+				r.EffectivePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.EffectivePeriod = &v
+			}
+		case "topic":
+			a := d.array()
+			r.Topic = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Topic = append(r.Topic, v)
+			}
+			if r.Topic == nil && !a.isNull {
+				r.Topic = []CodeableConcept{}
+			}
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []ContactDetail{}
+			}
+		case "editor":
+			a := d.array()
+			r.Editor = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Editor = append(r.Editor, v)
+			}
+			if r.Editor == nil && !a.isNull {
+				r.Editor = []ContactDetail{}
+			}
+		case "reviewer":
+			a := d.array()
+			r.Reviewer = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Reviewer = append(r.Reviewer, v)
+			}
+			if r.Reviewer == nil && !a.isNull {
+				r.Reviewer = []ContactDetail{}
+			}
+		case "endorser":
+			a := d.array()
+			r.Endorser = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Endorser = append(r.Endorser, v)
+			}
+			if r.Endorser == nil && !a.isNull {
+				r.Endorser = []ContactDetail{}
+			}
+		case "relatedArtifact":
+			a := d.array()
+			r.RelatedArtifact = nil
+			for a.next() {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			}
+			if r.RelatedArtifact == nil && !a.isNull {
+				r.RelatedArtifact = []RelatedArtifact{}
+			}
+		case "synthesisType":
+			if d.null() {
+				r.SynthesisType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SynthesisType = &v
+			}
+		case "studyType":
+			if d.null() {
+				r.StudyType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StudyType = &v
+			}
+		case "population":
+			r.Population.decodeJSON(d)
+		case "exposure":
+			if d.null() {
+				r.Exposure = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Exposure = &v
+			}
+		case "outcome":
+			r.Outcome.decodeJSON(d)
+		case "sampleSize":
+			if d.null() {
+				r.SampleSize = nil
+			} else {
+				var v RiskEvidenceSynthesisSampleSize
+				v.decodeJSON(d)
+				r.SampleSize = &v
+			}
+		case "riskEstimate":
+			if d.null() {
+				r.RiskEstimate = nil
+			} else {
+				var v RiskEvidenceSynthesisRiskEstimate
+				v.decodeJSON(d)
+				r.RiskEstimate = &v
+			}
+		case "certainty":
+			a := d.array()
+			r.Certainty = nil
+			for a.next() {
+				var v RiskEvidenceSynthesisCertainty
+				v.decodeJSON(d)
+				r.Certainty = append(r.Certainty, v)
+			}
+			if r.Certainty == nil && !a.isNull {
+				r.Certainty = []RiskEvidenceSynthesisCertainty{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RiskEvidenceSynthesis which shares no memory with the original
+func (r RiskEvidenceSynthesis) DeepCopy() RiskEvidenceSynthesis {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.ApprovalDate != nil {
+		v := *r.ApprovalDate
+		out.ApprovalDate = &v
+	}
+	if r.ApprovalDateElement != nil {
+		v := r.ApprovalDateElement.DeepCopy()
+		out.ApprovalDateElement = &v
+	}
+	if r.LastReviewDate != nil {
+		v := *r.LastReviewDate
+		out.LastReviewDate = &v
+	}
+	if r.LastReviewDateElement != nil {
+		v := r.LastReviewDateElement.DeepCopy()
+		out.LastReviewDateElement = &v
+	}
+	if r.EffectivePeriod != nil {
+		v := r.EffectivePeriod.DeepCopy()
+		out.EffectivePeriod = &v
+	}
+	if r.Topic != nil {
+		out.Topic = make([]CodeableConcept, len(r.Topic))
+		for i := range r.Topic {
+			out.Topic[i] = r.Topic[i].DeepCopy()
+		}
+	}
+	if r.Author != nil {
+		out.Author = make([]ContactDetail, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Editor != nil {
+		out.Editor = make([]ContactDetail, len(r.Editor))
+		for i := range r.Editor {
+			out.Editor[i] = r.Editor[i].DeepCopy()
+		}
+	}
+	if r.Reviewer != nil {
+		out.Reviewer = make([]ContactDetail, len(r.Reviewer))
+		for i := range r.Reviewer {
+			out.Reviewer[i] = r.Reviewer[i].DeepCopy()
+		}
+	}
+	if r.Endorser != nil {
+		out.Endorser = make([]ContactDetail, len(r.Endorser))
+		for i := range r.Endorser {
+			out.Endorser[i] = r.Endorser[i].DeepCopy()
+		}
+	}
+	if r.RelatedArtifact != nil {
+		out.RelatedArtifact = make([]RelatedArtifact, len(r.RelatedArtifact))
+		for i := range r.RelatedArtifact {
+			out.RelatedArtifact[i] = r.RelatedArtifact[i].DeepCopy()
+		}
+	}
+	if r.SynthesisType != nil {
+		v := r.SynthesisType.DeepCopy()
+		out.SynthesisType = &v
+	}
+	if r.StudyType != nil {
+		v := r.StudyType.DeepCopy()
+		out.StudyType = &v
+	}
+	out.Population = r.Population.DeepCopy()
+	if r.Exposure != nil {
+		v := r.Exposure.DeepCopy()
+		out.Exposure = &v
+	}
+	out.Outcome = r.Outcome.DeepCopy()
+	if r.SampleSize != nil {
+		v := r.SampleSize.DeepCopy()
+		out.SampleSize = &v
+	}
+	if r.RiskEstimate != nil {
+		v := r.RiskEstimate.DeepCopy()
+		out.RiskEstimate = &v
+	}
+	if r.Certainty != nil {
+		out.Certainty = make([]RiskEvidenceSynthesisCertainty, len(r.Certainty))
+		for i := range r.Certainty {
+			out.Certainty[i] = r.Certainty[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RiskEvidenceSynthesis) Equal(other RiskEvidenceSynthesis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && *r.ApprovalDate != *other.ApprovalDate {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.Equal(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && *r.LastReviewDate != *other.LastReviewDate {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.Equal(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.Equal(*other.EffectivePeriod) {
+		return false
+	}
+	if len(r.Topic) != len(other.Topic) {
+		return false
+	}
+	for i := range r.Topic {
+		if !r.Topic[i].Equal(other.Topic[i]) {
+			return false
+		}
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if len(r.Editor) != len(other.Editor) {
+		return false
+	}
+	for i := range r.Editor {
+		if !r.Editor[i].Equal(other.Editor[i]) {
+			return false
+		}
+	}
+	if len(r.Reviewer) != len(other.Reviewer) {
+		return false
+	}
+	for i := range r.Reviewer {
+		if !r.Reviewer[i].Equal(other.Reviewer[i]) {
+			return false
+		}
+	}
+	if len(r.Endorser) != len(other.Endorser) {
+		return false
+	}
+	for i := range r.Endorser {
+		if !r.Endorser[i].Equal(other.Endorser[i]) {
+			return false
+		}
+	}
+	if len(r.RelatedArtifact) != len(other.RelatedArtifact) {
+		return false
+	}
+	for i := range r.RelatedArtifact {
+		if !r.RelatedArtifact[i].Equal(other.RelatedArtifact[i]) {
+			return false
+		}
+	}
+	if (r.SynthesisType == nil) != (other.SynthesisType == nil) || r.SynthesisType != nil && !r.SynthesisType.Equal(*other.SynthesisType) {
+		return false
+	}
+	if (r.StudyType == nil) != (other.StudyType == nil) || r.StudyType != nil && !r.StudyType.Equal(*other.StudyType) {
+		return false
+	}
+	if !r.Population.Equal(other.Population) {
+		return false
+	}
+	if (r.Exposure == nil) != (other.Exposure == nil) || r.Exposure != nil && !r.Exposure.Equal(*other.Exposure) {
+		return false
+	}
+	if !r.Outcome.Equal(other.Outcome) {
+		return false
+	}
+	if (r.SampleSize == nil) != (other.SampleSize == nil) || r.SampleSize != nil && !r.SampleSize.Equal(*other.SampleSize) {
+		return false
+	}
+	if (r.RiskEstimate == nil) != (other.RiskEstimate == nil) || r.RiskEstimate != nil && !r.RiskEstimate.Equal(*other.RiskEstimate) {
+		return false
+	}
+	if len(r.Certainty) != len(other.Certainty) {
+		return false
+	}
+	for i := range r.Certainty {
+		if !r.Certainty[i].Equal(other.Certainty[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RiskEvidenceSynthesis) EqualsDeep(other RiskEvidenceSynthesis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.ApprovalDateElement == nil) != (other.ApprovalDateElement == nil) || r.ApprovalDateElement != nil && !r.ApprovalDateElement.EqualsDeep(*other.ApprovalDateElement) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	if (r.LastReviewDateElement == nil) != (other.LastReviewDateElement == nil) || r.LastReviewDateElement != nil && !r.LastReviewDateElement.EqualsDeep(*other.LastReviewDateElement) {
+		return false
+	}
+	if (r.EffectivePeriod == nil) != (other.EffectivePeriod == nil) || r.EffectivePeriod != nil && !r.EffectivePeriod.EqualsDeep(*other.EffectivePeriod) {
+		return false
+	}
+	if !equivalentList(len(r.Topic), len(other.Topic), func(i, j int) bool {
+		return r.Topic[i].EqualsDeep(other.Topic[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Editor), len(other.Editor), func(i, j int) bool {
+		return r.Editor[i].EqualsDeep(other.Editor[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Reviewer), len(other.Reviewer), func(i, j int) bool {
+		return r.Reviewer[i].EqualsDeep(other.Reviewer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Endorser), len(other.Endorser), func(i, j int) bool {
+		return r.Endorser[i].EqualsDeep(other.Endorser[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelatedArtifact), len(other.RelatedArtifact), func(i, j int) bool {
+		return r.RelatedArtifact[i].EqualsDeep(other.RelatedArtifact[j])
+	}) {
+		return false
+	}
+	if (r.SynthesisType == nil) != (other.SynthesisType == nil) || r.SynthesisType != nil && !r.SynthesisType.EqualsDeep(*other.SynthesisType) {
+		return false
+	}
+	if (r.StudyType == nil) != (other.StudyType == nil) || r.StudyType != nil && !r.StudyType.EqualsDeep(*other.StudyType) {
+		return false
+	}
+	if !r.Population.EqualsDeep(other.Population) {
+		return false
+	}
+	if (r.Exposure == nil) != (other.Exposure == nil) || r.Exposure != nil && !r.Exposure.EqualsDeep(*other.Exposure) {
+		return false
+	}
+	if !r.Outcome.EqualsDeep(other.Outcome) {
+		return false
+	}
+	if (r.SampleSize == nil) != (other.SampleSize == nil) || r.SampleSize != nil && !r.SampleSize.EqualsDeep(*other.SampleSize) {
+		return false
+	}
+	if (r.RiskEstimate == nil) != (other.RiskEstimate == nil) || r.RiskEstimate != nil && !r.RiskEstimate.EqualsDeep(*other.RiskEstimate) {
+		return false
+	}
+	if !equivalentList(len(r.Certainty), len(other.Certainty), func(i, j int) bool {
+		return r.Certainty[i].EqualsDeep(other.Certainty[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RiskEvidenceSynthesis) EqualsShallow(other RiskEvidenceSynthesis) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.ApprovalDate == nil) != (other.ApprovalDate == nil) || r.ApprovalDate != nil && !equivalentString(*r.ApprovalDate, *other.ApprovalDate) {
+		return false
+	}
+	if (r.LastReviewDate == nil) != (other.LastReviewDate == nil) || r.LastReviewDate != nil && !equivalentString(*r.LastReviewDate, *other.LastReviewDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RiskEvidenceSynthesis as FHIR XML
+func (r RiskEvidenceSynthesis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "RiskEvidenceSynthesis",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "approvalDate", r.ApprovalDate, r.ApprovalDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastReviewDate", r.LastReviewDate, r.LastReviewDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "effectivePeriod", r.EffectivePeriod); err != nil {
+		return err
+	}
+	for _, v := range r.Topic {
+		if err := encodeXMLElement(e, "topic", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Editor {
+		if err := encodeXMLElement(e, "editor", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Reviewer {
+		if err := encodeXMLElement(e, "reviewer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Endorser {
+		if err := encodeXMLElement(e, "endorser", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelatedArtifact {
+		if err := encodeXMLElement(e, "relatedArtifact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "synthesisType", r.SynthesisType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "studyType", r.StudyType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "population", r.Population); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "exposure", r.Exposure); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "outcome", r.Outcome); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sampleSize", r.SampleSize); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "riskEstimate", r.RiskEstimate); err != nil {
+		return err
+	}
+	for _, v := range r.Certainty {
+		if err := encodeXMLElement(e, "certainty", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RiskEvidenceSynthesis from FHIR XML
+func (r *RiskEvidenceSynthesis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "approvalDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ApprovalDate = &v
+				}
+				r.ApprovalDateElement = element
+			case "lastReviewDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastReviewDate = &v
+				}
+				r.LastReviewDateElement = element
+			case "effectivePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.EffectivePeriod = &v
+			case "topic":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Topic = append(r.Topic, v)
+			case "author":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "editor":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Editor = append(r.Editor, v)
+			case "reviewer":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Reviewer = append(r.Reviewer, v)
+			case "endorser":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Endorser = append(r.Endorser, v)
+			case "relatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelatedArtifact = append(r.RelatedArtifact, v)
+			case "synthesisType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SynthesisType = &v
+			case "studyType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StudyType = &v
+			case "population":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Population = v
+			case "exposure":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Exposure = &v
+			case "outcome":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Outcome = v
+			case "sampleSize":
+				var v RiskEvidenceSynthesisSampleSize
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SampleSize = &v
+			case "riskEstimate":
+				var v RiskEvidenceSynthesisRiskEstimate
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RiskEstimate = &v
+			case "certainty":
+				var v RiskEvidenceSynthesisCertainty
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Certainty = append(r.Certainty, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RiskEvidenceSynthesis as properties to the RDF node n
+func (r RiskEvidenceSynthesis) turtle(n *turtleNode) {
+	n.resource("RiskEvidenceSynthesis", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("RiskEvidenceSynthesis.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("RiskEvidenceSynthesis.identifier", i, v)
+	}
+	n.primitive("RiskEvidenceSynthesis.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("RiskEvidenceSynthesis.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("RiskEvidenceSynthesis.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("RiskEvidenceSynthesis.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("RiskEvidenceSynthesis.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("RiskEvidenceSynthesis.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("RiskEvidenceSynthesis.contact", i, v)
+	}
+	n.primitive("RiskEvidenceSynthesis.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Note {
+		n.element("RiskEvidenceSynthesis.note", i, v)
+	}
+	for i, v := range r.UseContext {
+		n.element("RiskEvidenceSynthesis.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("RiskEvidenceSynthesis.jurisdiction", i, v)
+	}
+	n.primitive("RiskEvidenceSynthesis.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("RiskEvidenceSynthesis.approvalDate", -1, r.ApprovalDate, r.ApprovalDateElement, "date")
+	n.primitive("RiskEvidenceSynthesis.lastReviewDate", -1, r.LastReviewDate, r.LastReviewDateElement, "date")
+	n.element("RiskEvidenceSynthesis.effectivePeriod", -1, r.EffectivePeriod)
+	for i, v := range r.Topic {
+		n.element("RiskEvidenceSynthesis.topic", i, v)
+	}
+	for i, v := range r.Author {
+		n.element("RiskEvidenceSynthesis.author", i, v)
+	}
+	for i, v := range r.Editor {
+		n.element("RiskEvidenceSynthesis.editor", i, v)
+	}
+	for i, v := range r.Reviewer {
+		n.element("RiskEvidenceSynthesis.reviewer", i, v)
+	}
+	for i, v := range r.Endorser {
+		n.element("RiskEvidenceSynthesis.endorser", i, v)
+	}
+	for i, v := range r.RelatedArtifact {
+		n.element("RiskEvidenceSynthesis.relatedArtifact", i, v)
+	}
+	n.element("RiskEvidenceSynthesis.synthesisType", -1, r.SynthesisType)
+	n.element("RiskEvidenceSynthesis.studyType", -1, r.StudyType)
+	n.element("RiskEvidenceSynthesis.population", -1, r.Population)
+	n.element("RiskEvidenceSynthesis.exposure", -1, r.Exposure)
+	n.element("RiskEvidenceSynthesis.outcome", -1, r.Outcome)
+	n.element("RiskEvidenceSynthesis.sampleSize", -1, r.SampleSize)
+	n.element("RiskEvidenceSynthesis.riskEstimate", -1, r.RiskEstimate)
+	for i, v := range r.Certainty {
+		n.element("RiskEvidenceSynthesis.certainty", i, v)
+	}
+}
+
+// MarshalBSON marshals the given RiskEvidenceSynthesis as BSON document with the structure of its FHIR JSON
+func (r RiskEvidenceSynthesis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RiskEvidenceSynthesis from a BSON document with the structure of its FHIR JSON
+func (r *RiskEvidenceSynthesis) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RiskEvidenceSynthesis as protobuf message RiskEvidenceSynthesis of fhir.proto
+func (r RiskEvidenceSynthesis) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RiskEvidenceSynthesis to the encoded message
+func (r RiskEvidenceSynthesis) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(12, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	e.varint(14, r.Status.protoNumber())
+	if r.Date != nil {
+		e.string(15, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(16, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(17, v)
+	}
+	if r.Description != nil {
+		e.string(18, *r.Description)
+	}
+	for _, v := range r.Note {
+		e.message(19, v)
+	}
+	for _, v := range r.UseContext {
+		e.message(20, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(21, v)
+	}
+	if r.Copyright != nil {
+		e.string(22, *r.Copyright)
+	}
+	if r.ApprovalDate != nil {
+		e.string(23, *r.ApprovalDate)
+	}
+	if r.LastReviewDate != nil {
+		e.string(24, *r.LastReviewDate)
+	}
+	if r.EffectivePeriod != nil {
+		e.message(25, *r.EffectivePeriod)
+	}
+	for _, v := range r.Topic {
+		e.message(26, v)
+	}
+	for _, v := range r.Author {
+		e.message(27, v)
+	}
+	for _, v := range r.Editor {
+		e.message(28, v)
+	}
+	for _, v := range r.Reviewer {
+		e.message(29, v)
+	}
+	for _, v := range r.Endorser {
+		e.message(30, v)
+	}
+	for _, v := range r.RelatedArtifact {
+		e.message(31, v)
+	}
+	if r.SynthesisType != nil {
+		e.message(32, *r.SynthesisType)
+	}
+	if r.StudyType != nil {
+		e.message(33, *r.StudyType)
+	}
+	e.message(34, r.Population)
+	if r.Exposure != nil {
+		e.message(35, *r.Exposure)
+	}
+	e.message(36, r.Outcome)
+	if r.SampleSize != nil {
+		e.message(37, *r.SampleSize)
+	}
+	if r.RiskEstimate != nil {
+		e.message(38, *r.RiskEstimate)
+	}
+	for _, v := range r.Certainty {
+		e.message(39, v)
+	}
+	if r.IdElement != nil {
+		e.message(40, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(41, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(42, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(43, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(44, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(45, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(46, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(47, *r.StatusElement)
+	}
+	if r.DateElement != nil {
+		e.message(48, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(49, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(50, *r.DescriptionElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(51, *r.CopyrightElement)
+	}
+	if r.ApprovalDateElement != nil {
+		e.message(52, *r.ApprovalDateElement)
+	}
+	if r.LastReviewDateElement != nil {
+		e.message(53, *r.LastReviewDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RiskEvidenceSynthesis from protobuf message RiskEvidenceSynthesis of fhir.proto
+func (r *RiskEvidenceSynthesis) UnmarshalProto(b []byte) error {
+	*r = RiskEvidenceSynthesis{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			v := d.string()
+			r.Name = &v
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 15:
+			v := d.string()
+			r.Date = &v
+		case 16:
+			v := d.string()
+			r.Publisher = &v
+		case 17:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 18:
+			v := d.string()
+			r.Description = &v
+		case 19:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 20:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 22:
+			v := d.string()
+			r.Copyright = &v
+		case 23:
+			v := d.string()
+			r.ApprovalDate = &v
+		case 24:
+			v := d.string()
+			r.LastReviewDate = &v
+		case 25:
+			var v Period
+			d.message(&v)
+			r.EffectivePeriod = &v
+		case 26:
+			var v CodeableConcept
+			d.message(&v)
+			r.Topic = append(r.Topic, v)
+		case 27:
+			var v ContactDetail
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 28:
+			var v ContactDetail
+			d.message(&v)
+			r.Editor = append(r.Editor, v)
+		case 29:
+			var v ContactDetail
+			d.message(&v)
+			r.Reviewer = append(r.Reviewer, v)
+		case 30:
+			var v ContactDetail
+			d.message(&v)
+			r.Endorser = append(r.Endorser, v)
+		case 31:
+			var v RelatedArtifact
+			d.message(&v)
+			r.RelatedArtifact = append(r.RelatedArtifact, v)
+		case 32:
+			var v CodeableConcept
+			d.message(&v)
+			r.SynthesisType = &v
+		case 33:
+			var v CodeableConcept
+			d.message(&v)
+			r.StudyType = &v
+		case 34:
+			var v Reference
+			d.message(&v)
+			r.Population = v
+		case 35:
+			var v Reference
+			d.message(&v)
+			r.Exposure = &v
+		case 36:
+			var v Reference
+			d.message(&v)
+			r.Outcome = v
+		case 37:
+			var v RiskEvidenceSynthesisSampleSize
+			d.message(&v)
+			r.SampleSize = &v
+		case 38:
+			var v RiskEvidenceSynthesisRiskEstimate
+			d.message(&v)
+			r.RiskEstimate = &v
+		case 39:
+			var v RiskEvidenceSynthesisCertainty
+			d.message(&v)
+			r.Certainty = append(r.Certainty, v)
+		case 40:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.ApprovalDateElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.LastReviewDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RiskEvidenceSynthesisSampleSize as JSON into a byte slice
+func (r RiskEvidenceSynthesisSampleSize) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RiskEvidenceSynthesisSampleSize as JSON object with the members in the order of the fields
+func (r RiskEvidenceSynthesisSampleSize) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.NumberOfStudies != nil {
+		e.key("numberOfStudies")
+		e.int(*r.NumberOfStudies)
+	}
+	if r.NumberOfStudiesElement != nil {
+		e.key("_numberOfStudies")
+		r.NumberOfStudiesElement.appendJSON(e)
+	}
+	if r.NumberOfParticipants != nil {
+		e.key("numberOfParticipants")
+		e.int(*r.NumberOfParticipants)
+	}
+	if r.NumberOfParticipantsElement != nil {
+		e.key("_numberOfParticipants")
+		r.NumberOfParticipantsElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RiskEvidenceSynthesisSampleSize from JSON. Unknown members are ignored.
+func (r *RiskEvidenceSynthesisSampleSize) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RiskEvidenceSynthesisSampleSize
+func (r *RiskEvidenceSynthesisSampleSize) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "numberOfStudies":
+			if v, ok := d.int(); ok {
+				r.NumberOfStudies = &v
+			} else {
+				r.NumberOfStudies = nil
+			}
+		case "_numberOfStudies":
+			r.NumberOfStudiesElement = d.element()
+		case "numberOfParticipants":
+			if v, ok := d.int(); ok {
+				r.NumberOfParticipants = &v
+			} else {
+				r.NumberOfParticipants = nil
+			}
+		case "_numberOfParticipants":
+			r.NumberOfParticipantsElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RiskEvidenceSynthesisSampleSize which shares no memory with the original
+func (r RiskEvidenceSynthesisSampleSize) DeepCopy() RiskEvidenceSynthesisSampleSize {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.NumberOfStudies != nil {
+		v := *r.NumberOfStudies
+		out.NumberOfStudies = &v
+	}
+	if r.NumberOfStudiesElement != nil {
+		v := r.NumberOfStudiesElement.DeepCopy()
+		out.NumberOfStudiesElement = &v
+	}
+	if r.NumberOfParticipants != nil {
+		v := *r.NumberOfParticipants
+		out.NumberOfParticipants = &v
+	}
+	if r.NumberOfParticipantsElement != nil {
+		v := r.NumberOfParticipantsElement.DeepCopy()
+		out.NumberOfParticipantsElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RiskEvidenceSynthesisSampleSize) Equal(other RiskEvidenceSynthesisSampleSize) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.NumberOfStudies == nil) != (other.NumberOfStudies == nil) || r.NumberOfStudies != nil && *r.NumberOfStudies != *other.NumberOfStudies {
+		return false
+	}
+	if (r.NumberOfStudiesElement == nil) != (other.NumberOfStudiesElement == nil) || r.NumberOfStudiesElement != nil && !r.NumberOfStudiesElement.Equal(*other.NumberOfStudiesElement) {
+		return false
+	}
+	if (r.NumberOfParticipants == nil) != (other.NumberOfParticipants == nil) || r.NumberOfParticipants != nil && *r.NumberOfParticipants != *other.NumberOfParticipants {
+		return false
+	}
+	if (r.NumberOfParticipantsElement == nil) != (other.NumberOfParticipantsElement == nil) || r.NumberOfParticipantsElement != nil && !r.NumberOfParticipantsElement.Equal(*other.NumberOfParticipantsElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RiskEvidenceSynthesisSampleSize) EqualsDeep(other RiskEvidenceSynthesisSampleSize) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.NumberOfStudies == nil) != (other.NumberOfStudies == nil) || r.NumberOfStudies != nil && *r.NumberOfStudies != *other.NumberOfStudies {
+		return false
+	}
+	if (r.NumberOfStudiesElement == nil) != (other.NumberOfStudiesElement == nil) || r.NumberOfStudiesElement != nil && !r.NumberOfStudiesElement.EqualsDeep(*other.NumberOfStudiesElement) {
+		return false
+	}
+	if (r.NumberOfParticipants == nil) != (other.NumberOfParticipants == nil) || r.NumberOfParticipants != nil && *r.NumberOfParticipants != *other.NumberOfParticipants {
+		return false
+	}
+	if (r.NumberOfParticipantsElement == nil) != (other.NumberOfParticipantsElement == nil) || r.NumberOfParticipantsElement != nil && !r.NumberOfParticipantsElement.EqualsDeep(*other.NumberOfParticipantsElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RiskEvidenceSynthesisSampleSize) EqualsShallow(other RiskEvidenceSynthesisSampleSize) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.NumberOfStudies == nil) != (other.NumberOfStudies == nil) || r.NumberOfStudies != nil && *r.NumberOfStudies != *other.NumberOfStudies {
+		return false
+	}
+	if (r.NumberOfParticipants == nil) != (other.NumberOfParticipants == nil) || r.NumberOfParticipants != nil && *r.NumberOfParticipants != *other.NumberOfParticipants {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RiskEvidenceSynthesisSampleSize as FHIR XML
+func (r RiskEvidenceSynthesisSampleSize) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "numberOfStudies", r.NumberOfStudies, r.NumberOfStudiesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "numberOfParticipants", r.NumberOfParticipants, r.NumberOfParticipantsElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RiskEvidenceSynthesisSampleSize from FHIR XML
+func (r *RiskEvidenceSynthesisSampleSize) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "numberOfStudies":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumberOfStudies = &v
+				}
+				r.NumberOfStudiesElement = element
+			case "numberOfParticipants":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumberOfParticipants = &v
+				}
+				r.NumberOfParticipantsElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RiskEvidenceSynthesisSampleSize as properties to the RDF node n
+func (r RiskEvidenceSynthesisSampleSize) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("RiskEvidenceSynthesis.sampleSize.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("RiskEvidenceSynthesis.sampleSize.numberOfStudies", -1, r.NumberOfStudies, r.NumberOfStudiesElement, "integer")
+	n.primitive("RiskEvidenceSynthesis.sampleSize.numberOfParticipants", -1, r.NumberOfParticipants, r.NumberOfParticipantsElement, "integer")
+}
+
+// MarshalBSON marshals the given RiskEvidenceSynthesisSampleSize as BSON document with the structure of its FHIR JSON
+func (r RiskEvidenceSynthesisSampleSize) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RiskEvidenceSynthesisSampleSize from a BSON document with the structure of its FHIR JSON
+func (r *RiskEvidenceSynthesisSampleSize) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RiskEvidenceSynthesisSampleSize as protobuf message RiskEvidenceSynthesisSampleSize of fhir.proto
+func (r RiskEvidenceSynthesisSampleSize) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RiskEvidenceSynthesisSampleSize to the encoded message
+func (r RiskEvidenceSynthesisSampleSize) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.NumberOfStudies != nil {
+		e.varint(5, uint64(int64(*r.NumberOfStudies)))
+	}
+	if r.NumberOfParticipants != nil {
+		e.varint(6, uint64(int64(*r.NumberOfParticipants)))
+	}
+	if r.DescriptionElement != nil {
+		e.message(7, *r.DescriptionElement)
+	}
+	if r.NumberOfStudiesElement != nil {
+		e.message(8, *r.NumberOfStudiesElement)
+	}
+	if r.NumberOfParticipantsElement != nil {
+		e.message(9, *r.NumberOfParticipantsElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RiskEvidenceSynthesisSampleSize from protobuf message RiskEvidenceSynthesisSampleSize of fhir.proto
+func (r *RiskEvidenceSynthesisSampleSize) UnmarshalProto(b []byte) error {
+	*r = RiskEvidenceSynthesisSampleSize{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			v := d.int()
+			r.NumberOfStudies = &v
+		case 6:
+			v := d.int()
+			r.NumberOfParticipants = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.NumberOfStudiesElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.NumberOfParticipantsElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RiskEvidenceSynthesisRiskEstimate as JSON into a byte slice
+func (r RiskEvidenceSynthesisRiskEstimate) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RiskEvidenceSynthesisRiskEstimate as JSON object with the members in the order of the fields
+func (r RiskEvidenceSynthesisRiskEstimate) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Value != nil {
+		e.key("value")
+		e.number(*r.Value)
+	}
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	if r.UnitOfMeasure != nil {
+		e.key("unitOfMeasure")
+		r.UnitOfMeasure.appendJSON(e)
+	}
+	if r.DenominatorCount != nil {
+		e.key("denominatorCount")
+		e.int(*r.DenominatorCount)
+	}
+	if r.DenominatorCountElement != nil {
+		e.key("_denominatorCount")
+		r.DenominatorCountElement.appendJSON(e)
+	}
+	if r.NumeratorCount != nil {
+		e.key("numeratorCount")
+		e.int(*r.NumeratorCount)
+	}
+	if r.NumeratorCountElement != nil {
+		e.key("_numeratorCount")
+		r.NumeratorCountElement.appendJSON(e)
+	}
+	if len(r.PrecisionEstimate) > 0 {
+		e.key("precisionEstimate")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PrecisionEstimate {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RiskEvidenceSynthesisRiskEstimate from JSON. Unknown members are ignored.
+func (r *RiskEvidenceSynthesisRiskEstimate) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RiskEvidenceSynthesisRiskEstimate
+func (r *RiskEvidenceSynthesisRiskEstimate) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "value":
+			if v, ok := d.number(); ok {
+				r.Value = &v
+			} else {
+				r.Value = nil
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		case "unitOfMeasure":
+			if d.null() {
+				r.UnitOfMeasure = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.UnitOfMeasure = &v
+			}
+		case "denominatorCount":
+			if v, ok := d.int(); ok {
+				r.DenominatorCount = &v
+			} else {
+				r.DenominatorCount = nil
+			}
+		case "_denominatorCount":
+			r.DenominatorCountElement = d.element()
+		case "numeratorCount":
+			if v, ok := d.int(); ok {
+				r.NumeratorCount = &v
+			} else {
+				r.NumeratorCount = nil
+			}
+		case "_numeratorCount":
+			r.NumeratorCountElement = d.element()
+		case "precisionEstimate":
+			a := d.array()
+			r.PrecisionEstimate = nil
+			for a.next() {
+				var v RiskEvidenceSynthesisRiskEstimatePrecisionEstimate
+				v.decodeJSON(d)
+				r.PrecisionEstimate = append(r.PrecisionEstimate, v)
+			}
+			if r.PrecisionEstimate == nil && !a.isNull {
+				r.PrecisionEstimate = []RiskEvidenceSynthesisRiskEstimatePrecisionEstimate{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RiskEvidenceSynthesisRiskEstimate which shares no memory with the original
+func (r RiskEvidenceSynthesisRiskEstimate) DeepCopy() RiskEvidenceSynthesisRiskEstimate {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Value != nil {
+		v := *r.Value
+		out.Value = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	if r.UnitOfMeasure != nil {
+		v := r.UnitOfMeasure.DeepCopy()
+		out.UnitOfMeasure = &v
+	}
+	if r.DenominatorCount != nil {
+		v := *r.DenominatorCount
+		out.DenominatorCount = &v
+	}
+	if r.DenominatorCountElement != nil {
+		v := r.DenominatorCountElement.DeepCopy()
+		out.DenominatorCountElement = &v
+	}
+	if r.NumeratorCount != nil {
+		v := *r.NumeratorCount
+		out.NumeratorCount = &v
+	}
+	if r.NumeratorCountElement != nil {
+		v := r.NumeratorCountElement.DeepCopy()
+		out.NumeratorCountElement = &v
+	}
+	if r.PrecisionEstimate != nil {
+		out.PrecisionEstimate = make([]RiskEvidenceSynthesisRiskEstimatePrecisionEstimate, len(r.PrecisionEstimate))
+		for i := range r.PrecisionEstimate {
+			out.PrecisionEstimate[i] = r.PrecisionEstimate[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RiskEvidenceSynthesisRiskEstimate) Equal(other RiskEvidenceSynthesisRiskEstimate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !EqualDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	if (r.UnitOfMeasure == nil) != (other.UnitOfMeasure == nil) || r.UnitOfMeasure != nil && !r.UnitOfMeasure.Equal(*other.UnitOfMeasure) {
+		return false
+	}
+	if (r.DenominatorCount == nil) != (other.DenominatorCount == nil) || r.DenominatorCount != nil && *r.DenominatorCount != *other.DenominatorCount {
+		return false
+	}
+	if (r.DenominatorCountElement == nil) != (other.DenominatorCountElement == nil) || r.DenominatorCountElement != nil && !r.DenominatorCountElement.Equal(*other.DenominatorCountElement) {
+		return false
+	}
+	if (r.NumeratorCount == nil) != (other.NumeratorCount == nil) || r.NumeratorCount != nil && *r.NumeratorCount != *other.NumeratorCount {
+		return false
+	}
+	if (r.NumeratorCountElement == nil) != (other.NumeratorCountElement == nil) || r.NumeratorCountElement != nil && !r.NumeratorCountElement.Equal(*other.NumeratorCountElement) {
+		return false
+	}
+	if len(r.PrecisionEstimate) != len(other.PrecisionEstimate) {
+		return false
+	}
+	for i := range r.PrecisionEstimate {
+		if !r.PrecisionEstimate[i].Equal(other.PrecisionEstimate[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RiskEvidenceSynthesisRiskEstimate) EqualsDeep(other RiskEvidenceSynthesisRiskEstimate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	if (r.UnitOfMeasure == nil) != (other.UnitOfMeasure == nil) || r.UnitOfMeasure != nil && !r.UnitOfMeasure.EqualsDeep(*other.UnitOfMeasure) {
+		return false
+	}
+	if (r.DenominatorCount == nil) != (other.DenominatorCount == nil) || r.DenominatorCount != nil && *r.DenominatorCount != *other.DenominatorCount {
+		return false
+	}
+	if (r.DenominatorCountElement == nil) != (other.DenominatorCountElement == nil) || r.DenominatorCountElement != nil && !r.DenominatorCountElement.EqualsDeep(*other.DenominatorCountElement) {
+		return false
+	}
+	if (r.NumeratorCount == nil) != (other.NumeratorCount == nil) || r.NumeratorCount != nil && *r.NumeratorCount != *other.NumeratorCount {
+		return false
+	}
+	if (r.NumeratorCountElement == nil) != (other.NumeratorCountElement == nil) || r.NumeratorCountElement != nil && !r.NumeratorCountElement.EqualsDeep(*other.NumeratorCountElement) {
+		return false
+	}
+	if !equivalentList(len(r.PrecisionEstimate), len(other.PrecisionEstimate), func(i, j int) bool {
+		return r.PrecisionEstimate[i].EqualsDeep(other.PrecisionEstimate[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RiskEvidenceSynthesisRiskEstimate) EqualsShallow(other RiskEvidenceSynthesisRiskEstimate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentDecimal(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.DenominatorCount == nil) != (other.DenominatorCount == nil) || r.DenominatorCount != nil && *r.DenominatorCount != *other.DenominatorCount {
+		return false
+	}
+	if (r.NumeratorCount == nil) != (other.NumeratorCount == nil) || r.NumeratorCount != nil && *r.NumeratorCount != *other.NumeratorCount {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RiskEvidenceSynthesisRiskEstimate as FHIR XML
+func (r RiskEvidenceSynthesisRiskEstimate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "unitOfMeasure", r.UnitOfMeasure); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "denominatorCount", r.DenominatorCount, r.DenominatorCountElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "numeratorCount", r.NumeratorCount, r.NumeratorCountElement); err != nil {
+		return err
+	}
+	for _, v := range r.PrecisionEstimate {
+		if err := encodeXMLElement(e, "precisionEstimate", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RiskEvidenceSynthesisRiskEstimate from FHIR XML
+func (r *RiskEvidenceSynthesisRiskEstimate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "value":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = &v
+				}
+				r.ValueElement = element
+			case "unitOfMeasure":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UnitOfMeasure = &v
+			case "denominatorCount":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DenominatorCount = &v
+				}
+				r.DenominatorCountElement = element
+			case "numeratorCount":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumeratorCount = &v
+				}
+				r.NumeratorCountElement = element
+			case "precisionEstimate":
+				var v RiskEvidenceSynthesisRiskEstimatePrecisionEstimate
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PrecisionEstimate = append(r.PrecisionEstimate, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RiskEvidenceSynthesisRiskEstimate as properties to the RDF node n
+func (r RiskEvidenceSynthesisRiskEstimate) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("RiskEvidenceSynthesis.riskEstimate.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("RiskEvidenceSynthesis.riskEstimate.type", -1, r.Type)
+	n.primitive("RiskEvidenceSynthesis.riskEstimate.value", -1, r.Value, r.ValueElement, "decimal")
+	n.element("RiskEvidenceSynthesis.riskEstimate.unitOfMeasure", -1, r.UnitOfMeasure)
+	n.primitive("RiskEvidenceSynthesis.riskEstimate.denominatorCount", -1, r.DenominatorCount, r.DenominatorCountElement, "integer")
+	n.primitive("RiskEvidenceSynthesis.riskEstimate.numeratorCount", -1, r.NumeratorCount, r.NumeratorCountElement, "integer")
+	for i, v := range r.PrecisionEstimate {
+		n.element("RiskEvidenceSynthesis.riskEstimate.precisionEstimate", i, v)
+	}
+}
+
+// MarshalBSON marshals the given RiskEvidenceSynthesisRiskEstimate as BSON document with the structure of its FHIR JSON
+func (r RiskEvidenceSynthesisRiskEstimate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RiskEvidenceSynthesisRiskEstimate from a BSON document with the structure of its FHIR JSON
+func (r *RiskEvidenceSynthesisRiskEstimate) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RiskEvidenceSynthesisRiskEstimate as protobuf message RiskEvidenceSynthesisRiskEstimate of fhir.proto
+func (r RiskEvidenceSynthesisRiskEstimate) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RiskEvidenceSynthesisRiskEstimate to the encoded message
+func (r RiskEvidenceSynthesisRiskEstimate) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.Value != nil {
+		e.string(6, string(*r.Value))
+	}
+	if r.UnitOfMeasure != nil {
+		e.message(7, *r.UnitOfMeasure)
+	}
+	if r.DenominatorCount != nil {
+		e.varint(8, uint64(int64(*r.DenominatorCount)))
+	}
+	if r.NumeratorCount != nil {
+		e.varint(9, uint64(int64(*r.NumeratorCount)))
+	}
+	for _, v := range r.PrecisionEstimate {
+		e.message(10, v)
+	}
+	if r.DescriptionElement != nil {
+		e.message(11, *r.DescriptionElement)
+	}
+	if r.ValueElement != nil {
+		e.message(12, *r.ValueElement)
+	}
+	if r.DenominatorCountElement != nil {
+		e.message(13, *r.DenominatorCountElement)
+	}
+	if r.NumeratorCountElement != nil {
+		e.message(14, *r.NumeratorCountElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RiskEvidenceSynthesisRiskEstimate from protobuf message RiskEvidenceSynthesisRiskEstimate of fhir.proto
+func (r *RiskEvidenceSynthesisRiskEstimate) UnmarshalProto(b []byte) error {
+	*r = RiskEvidenceSynthesisRiskEstimate{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			v := json.Number(d.string())
+			r.Value = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.UnitOfMeasure = &v
+		case 8:
+			v := d.int()
+			r.DenominatorCount = &v
+		case 9:
+			v := d.int()
+			r.NumeratorCount = &v
+		case 10:
+			var v RiskEvidenceSynthesisRiskEstimatePrecisionEstimate
+			d.message(&v)
+			r.PrecisionEstimate = append(r.PrecisionEstimate, v)
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DenominatorCountElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.NumeratorCountElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RiskEvidenceSynthesisRiskEstimatePrecisionEstimate as JSON into a byte slice
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RiskEvidenceSynthesisRiskEstimatePrecisionEstimate as JSON object with the members in the order of the fields
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Level != nil {
+		e.key("level")
+		e.number(*r.Level)
+	}
+	if r.LevelElement != nil {
+		e.key("_level")
+		r.LevelElement.appendJSON(e)
+	}
+	if r.From != nil {
+		e.key("from")
+		e.number(*r.From)
+	}
+	if r.FromElement != nil {
+		e.key("_from")
+		r.FromElement.appendJSON(e)
+	}
+	if r.To != nil {
+		e.key("to")
+		e.number(*r.To)
+	}
+	if r.ToElement != nil {
+		e.key("_to")
+		r.ToElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RiskEvidenceSynthesisRiskEstimatePrecisionEstimate from JSON. Unknown members are ignored.
+func (r *RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RiskEvidenceSynthesisRiskEstimatePrecisionEstimate
+func (r *RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "level":
+			if v, ok := d.number(); ok {
+				r.Level = &v
+			} else {
+				r.Level = nil
+			}
+		case "_level":
+			r.LevelElement = d.element()
+		case "from":
+			if v, ok := d.number(); ok {
+				r.From = &v
+			} else {
+				r.From = nil
+			}
+		case "_from":
+			r.FromElement = d.element()
+		case "to":
+			if v, ok := d.number(); ok {
+				r.To = &v
+			} else {
+				r.To = nil
+			}
+		case "_to":
+			r.ToElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RiskEvidenceSynthesisRiskEstimatePrecisionEstimate which shares no memory with the original
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) DeepCopy() RiskEvidenceSynthesisRiskEstimatePrecisionEstimate {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Level != nil {
+		v := *r.Level
+		out.Level = &v
+	}
+	if r.LevelElement != nil {
+		v := r.LevelElement.DeepCopy()
+		out.LevelElement = &v
+	}
+	if r.From != nil {
+		v := *r.From
+		out.From = &v
+	}
+	if r.FromElement != nil {
+		v := r.FromElement.DeepCopy()
+		out.FromElement = &v
+	}
+	if r.To != nil {
+		v := *r.To
+		out.To = &v
+	}
+	if r.ToElement != nil {
+		v := r.ToElement.DeepCopy()
+		out.ToElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) Equal(other RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Level == nil) != (other.Level == nil) || r.Level != nil && !EqualDecimal(*r.Level, *other.Level) {
+		return false
+	}
+	if (r.LevelElement == nil) != (other.LevelElement == nil) || r.LevelElement != nil && !r.LevelElement.Equal(*other.LevelElement) {
+		return false
+	}
+	if (r.From == nil) != (other.From == nil) || r.From != nil && !EqualDecimal(*r.From, *other.From) {
+		return false
+	}
+	if (r.FromElement == nil) != (other.FromElement == nil) || r.FromElement != nil && !r.FromElement.Equal(*other.FromElement) {
+		return false
+	}
+	if (r.To == nil) != (other.To == nil) || r.To != nil && !EqualDecimal(*r.To, *other.To) {
+		return false
+	}
+	if (r.ToElement == nil) != (other.ToElement == nil) || r.ToElement != nil && !r.ToElement.Equal(*other.ToElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) EqualsDeep(other RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Level == nil) != (other.Level == nil) || r.Level != nil && !equivalentDecimal(*r.Level, *other.Level) {
+		return false
+	}
+	if (r.LevelElement == nil) != (other.LevelElement == nil) || r.LevelElement != nil && !r.LevelElement.EqualsDeep(*other.LevelElement) {
+		return false
+	}
+	if (r.From == nil) != (other.From == nil) || r.From != nil && !equivalentDecimal(*r.From, *other.From) {
+		return false
+	}
+	if (r.FromElement == nil) != (other.FromElement == nil) || r.FromElement != nil && !r.FromElement.EqualsDeep(*other.FromElement) {
+		return false
+	}
+	if (r.To == nil) != (other.To == nil) || r.To != nil && !equivalentDecimal(*r.To, *other.To) {
+		return false
+	}
+	if (r.ToElement == nil) != (other.ToElement == nil) || r.ToElement != nil && !r.ToElement.EqualsDeep(*other.ToElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) EqualsShallow(other RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Level == nil) != (other.Level == nil) || r.Level != nil && !equivalentDecimal(*r.Level, *other.Level) {
+		return false
+	}
+	if (r.From == nil) != (other.From == nil) || r.From != nil && !equivalentDecimal(*r.From, *other.From) {
+		return false
+	}
+	if (r.To == nil) != (other.To == nil) || r.To != nil && !equivalentDecimal(*r.To, *other.To) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RiskEvidenceSynthesisRiskEstimatePrecisionEstimate as FHIR XML
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "level", r.Level, r.LevelElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "from", r.From, r.FromElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "to", r.To, r.ToElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RiskEvidenceSynthesisRiskEstimatePrecisionEstimate from FHIR XML
+func (r *RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "level":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Level = &v
+				}
+				r.LevelElement = element
+			case "from":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.From = &v
+				}
+				r.FromElement = element
+			case "to":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.To = &v
+				}
+				r.ToElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RiskEvidenceSynthesisRiskEstimatePrecisionEstimate as properties to the RDF node n
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("RiskEvidenceSynthesis.riskEstimate.precisionEstimate.type", -1, r.Type)
+	n.primitive("RiskEvidenceSynthesis.riskEstimate.precisionEstimate.level", -1, r.Level, r.LevelElement, "decimal")
+	n.primitive("RiskEvidenceSynthesis.riskEstimate.precisionEstimate.from", -1, r.From, r.FromElement, "decimal")
+	n.primitive("RiskEvidenceSynthesis.riskEstimate.precisionEstimate.to", -1, r.To, r.ToElement, "decimal")
+}
+
+// MarshalBSON marshals the given RiskEvidenceSynthesisRiskEstimatePrecisionEstimate as BSON document with the structure of its FHIR JSON
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RiskEvidenceSynthesisRiskEstimatePrecisionEstimate from a BSON document with the structure of its FHIR JSON
+func (r *RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RiskEvidenceSynthesisRiskEstimatePrecisionEstimate as protobuf message RiskEvidenceSynthesisRiskEstimatePrecisionEstimate of fhir.proto
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RiskEvidenceSynthesisRiskEstimatePrecisionEstimate to the encoded message
+func (r RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Level != nil {
+		e.string(5, string(*r.Level))
+	}
+	if r.From != nil {
+		e.string(6, string(*r.From))
+	}
+	if r.To != nil {
+		e.string(7, string(*r.To))
+	}
+	if r.LevelElement != nil {
+		e.message(8, *r.LevelElement)
+	}
+	if r.FromElement != nil {
+		e.message(9, *r.FromElement)
+	}
+	if r.ToElement != nil {
+		e.message(10, *r.ToElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given RiskEvidenceSynthesisRiskEstimatePrecisionEstimate from protobuf message RiskEvidenceSynthesisRiskEstimatePrecisionEstimate of fhir.proto
+func (r *RiskEvidenceSynthesisRiskEstimatePrecisionEstimate) UnmarshalProto(b []byte) error {
+	*r = RiskEvidenceSynthesisRiskEstimatePrecisionEstimate{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			v := json.Number(d.string())
+			r.Level = &v
+		case 6:
+			v := json.Number(d.string())
+			r.From = &v
+		case 7:
+			v := json.Number(d.string())
+			r.To = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.LevelElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.FromElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ToElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RiskEvidenceSynthesisCertainty as JSON into a byte slice
+func (r RiskEvidenceSynthesisCertainty) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RiskEvidenceSynthesisCertainty as JSON object with the members in the order of the fields
+func (r RiskEvidenceSynthesisCertainty) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Rating) > 0 {
+		e.key("rating")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Rating {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CertaintySubcomponent) > 0 {
+		e.key("certaintySubcomponent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CertaintySubcomponent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RiskEvidenceSynthesisCertainty from JSON. Unknown members are ignored.
+func (r *RiskEvidenceSynthesisCertainty) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RiskEvidenceSynthesisCertainty
+func (r *RiskEvidenceSynthesisCertainty) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "rating":
+			a := d.array()
+			r.Rating = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Rating = append(r.Rating, v)
+			}
+			if r.Rating == nil && !a.isNull {
+				r.Rating = []CodeableConcept{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "certaintySubcomponent":
+			a := d.array()
+			r.CertaintySubcomponent = nil
+			for a.next() {
+				var v RiskEvidenceSynthesisCertaintyCertaintySubcomponent
+				v.decodeJSON(d)
+				r.CertaintySubcomponent = append(r.CertaintySubcomponent, v)
+			}
+			if r.CertaintySubcomponent == nil && !a.isNull {
+				r.CertaintySubcomponent = []RiskEvidenceSynthesisCertaintyCertaintySubcomponent{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RiskEvidenceSynthesisCertainty which shares no memory with the original
+func (r RiskEvidenceSynthesisCertainty) DeepCopy() RiskEvidenceSynthesisCertainty {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Rating != nil {
+		out.Rating = make([]CodeableConcept, len(r.Rating))
+		for i := range r.Rating {
+			out.Rating[i] = r.Rating[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.CertaintySubcomponent != nil {
+		out.CertaintySubcomponent = make([]RiskEvidenceSynthesisCertaintyCertaintySubcomponent, len(r.CertaintySubcomponent))
+		for i := range r.CertaintySubcomponent {
+			out.CertaintySubcomponent[i] = r.CertaintySubcomponent[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RiskEvidenceSynthesisCertainty) Equal(other RiskEvidenceSynthesisCertainty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Rating) != len(other.Rating) {
+		return false
+	}
+	for i := range r.Rating {
+		if !r.Rating[i].Equal(other.Rating[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.CertaintySubcomponent) != len(other.CertaintySubcomponent) {
+		return false
+	}
+	for i := range r.CertaintySubcomponent {
+		if !r.CertaintySubcomponent[i].Equal(other.CertaintySubcomponent[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RiskEvidenceSynthesisCertainty) EqualsDeep(other RiskEvidenceSynthesisCertainty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Rating), len(other.Rating), func(i, j int) bool {
+		return r.Rating[i].EqualsDeep(other.Rating[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.CertaintySubcomponent), len(other.CertaintySubcomponent), func(i, j int) bool {
+		return r.CertaintySubcomponent[i].EqualsDeep(other.CertaintySubcomponent[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RiskEvidenceSynthesisCertainty) EqualsShallow(other RiskEvidenceSynthesisCertainty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RiskEvidenceSynthesisCertainty as FHIR XML
+func (r RiskEvidenceSynthesisCertainty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Rating {
+		if err := encodeXMLElement(e, "rating", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CertaintySubcomponent {
+		if err := encodeXMLElement(e, "certaintySubcomponent", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RiskEvidenceSynthesisCertainty from FHIR XML
+func (r *RiskEvidenceSynthesisCertainty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "rating":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Rating = append(r.Rating, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "certaintySubcomponent":
+				var v RiskEvidenceSynthesisCertaintyCertaintySubcomponent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CertaintySubcomponent = append(r.CertaintySubcomponent, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RiskEvidenceSynthesisCertainty as properties to the RDF node n
+func (r RiskEvidenceSynthesisCertainty) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Rating {
+		n.element("RiskEvidenceSynthesis.certainty.rating", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("RiskEvidenceSynthesis.certainty.note", i, v)
+	}
+	for i, v := range r.CertaintySubcomponent {
+		n.element("RiskEvidenceSynthesis.certainty.certaintySubcomponent", i, v)
+	}
+}
+
+// MarshalBSON marshals the given RiskEvidenceSynthesisCertainty as BSON document with the structure of its FHIR JSON
+func (r RiskEvidenceSynthesisCertainty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RiskEvidenceSynthesisCertainty from a BSON document with the structure of its FHIR JSON
+func (r *RiskEvidenceSynthesisCertainty) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RiskEvidenceSynthesisCertainty as protobuf message RiskEvidenceSynthesisCertainty of fhir.proto
+func (r RiskEvidenceSynthesisCertainty) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RiskEvidenceSynthesisCertainty to the encoded message
+func (r RiskEvidenceSynthesisCertainty) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Rating {
+		e.message(4, v)
+	}
+	for _, v := range r.Note {
+		e.message(5, v)
+	}
+	for _, v := range r.CertaintySubcomponent {
+		e.message(6, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given RiskEvidenceSynthesisCertainty from protobuf message RiskEvidenceSynthesisCertainty of fhir.proto
+func (r *RiskEvidenceSynthesisCertainty) UnmarshalProto(b []byte) error {
+	*r = RiskEvidenceSynthesisCertainty{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Rating = append(r.Rating, v)
+		case 5:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 6:
+			var v RiskEvidenceSynthesisCertaintyCertaintySubcomponent
+			d.message(&v)
+			r.CertaintySubcomponent = append(r.CertaintySubcomponent, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given RiskEvidenceSynthesisCertaintyCertaintySubcomponent as JSON into a byte slice
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the RiskEvidenceSynthesisCertaintyCertaintySubcomponent as JSON object with the members in the order of the fields
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if len(r.Rating) > 0 {
+		e.key("rating")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Rating {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given RiskEvidenceSynthesisCertaintyCertaintySubcomponent from JSON. Unknown members are ignored.
+func (r *RiskEvidenceSynthesisCertaintyCertaintySubcomponent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the RiskEvidenceSynthesisCertaintyCertaintySubcomponent
+func (r *RiskEvidenceSynthesisCertaintyCertaintySubcomponent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "rating":
+			a := d.array()
+			r.Rating = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Rating = append(r.Rating, v)
+			}
+			if r.Rating == nil && !a.isNull {
+				r.Rating = []CodeableConcept{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the RiskEvidenceSynthesisCertaintyCertaintySubcomponent which shares no memory with the original
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) DeepCopy() RiskEvidenceSynthesisCertaintyCertaintySubcomponent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Rating != nil {
+		out.Rating = make([]CodeableConcept, len(r.Rating))
+		for i := range r.Rating {
+			out.Rating[i] = r.Rating[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) Equal(other RiskEvidenceSynthesisCertaintyCertaintySubcomponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if len(r.Rating) != len(other.Rating) {
+		return false
+	}
+	for i := range r.Rating {
+		if !r.Rating[i].Equal(other.Rating[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) EqualsDeep(other RiskEvidenceSynthesisCertaintyCertaintySubcomponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if !equivalentList(len(r.Rating), len(other.Rating), func(i, j int) bool {
+		return r.Rating[i].EqualsDeep(other.Rating[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) EqualsShallow(other RiskEvidenceSynthesisCertaintyCertaintySubcomponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given RiskEvidenceSynthesisCertaintyCertaintySubcomponent as FHIR XML
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	for _, v := range r.Rating {
+		if err := encodeXMLElement(e, "rating", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given RiskEvidenceSynthesisCertaintyCertaintySubcomponent from FHIR XML
+func (r *RiskEvidenceSynthesisCertaintyCertaintySubcomponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "rating":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Rating = append(r.Rating, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the RiskEvidenceSynthesisCertaintyCertaintySubcomponent as properties to the RDF node n
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("RiskEvidenceSynthesis.certainty.certaintySubcomponent.type", -1, r.Type)
+	for i, v := range r.Rating {
+		n.element("RiskEvidenceSynthesis.certainty.certaintySubcomponent.rating", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("RiskEvidenceSynthesis.certainty.certaintySubcomponent.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given RiskEvidenceSynthesisCertaintyCertaintySubcomponent as BSON document with the structure of its FHIR JSON
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given RiskEvidenceSynthesisCertaintyCertaintySubcomponent from a BSON document with the structure of its FHIR JSON
+func (r *RiskEvidenceSynthesisCertaintyCertaintySubcomponent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given RiskEvidenceSynthesisCertaintyCertaintySubcomponent as protobuf message RiskEvidenceSynthesisCertaintyCertaintySubcomponent of fhir.proto
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the RiskEvidenceSynthesisCertaintyCertaintySubcomponent to the encoded message
+func (r RiskEvidenceSynthesisCertaintyCertaintySubcomponent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	for _, v := range r.Rating {
+		e.message(5, v)
+	}
+	for _, v := range r.Note {
+		e.message(6, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given RiskEvidenceSynthesisCertaintyCertaintySubcomponent from protobuf message RiskEvidenceSynthesisCertaintyCertaintySubcomponent of fhir.proto
+func (r *RiskEvidenceSynthesisCertaintyCertaintySubcomponent) UnmarshalProto(b []byte) error {
+	*r = RiskEvidenceSynthesisCertaintyCertaintySubcomponent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Rating = append(r.Rating, v)
+		case 6:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalRiskEvidenceSynthesis unmarshals a RiskEvidenceSynthesis.
 func UnmarshalRiskEvidenceSynthesis(b []byte) (RiskEvidenceSynthesis, error) {
 	var riskEvidenceSynthesis RiskEvidenceSynthesis
-	if err := json.Unmarshal(b, &riskEvidenceSynthesis); err != nil {
+	if err := riskEvidenceSynthesis.UnmarshalJSON(b); err != nil {
 		return riskEvidenceSynthesis, err
 	}
 	return riskEvidenceSynthesis, nil
@@ -13,5 +13,6 @@
 // limitations under the License.
 
 //go:generate fhir-models-gen gen-resources .
+//go:generate fhir-models-gen gen-jsonschema .
 
 package fhir
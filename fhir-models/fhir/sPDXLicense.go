@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -378,8 +377,13 @@ const (
 func (code SPDXLicense) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SPDXLicense) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SPDXLicense) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "not-open-source":
 		*code = SPDXLicenseNotOpenSource
@@ -1078,6 +1082,12 @@ func (code *SPDXLicense) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SPDXLicense) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SPDXLicense) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SPDXLicense) String() string {
 	return code.Code()
 }
@@ -3172,3 +3182,1399 @@ func (code SPDXLicense) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SPDXLicense) protoNumber() uint64 {
+	switch code {
+	case SPDXLicenseNotOpenSource:
+		return 1
+	case SPDXLicense0BSD:
+		return 2
+	case SPDXLicenseAAL:
+		return 3
+	case SPDXLicenseAbstyles:
+		return 4
+	case SPDXLicenseAdobe2006:
+		return 5
+	case SPDXLicenseAdobeGlyph:
+		return 6
+	case SPDXLicenseADSL:
+		return 7
+	case SPDXLicenseAFL1_1:
+		return 8
+	case SPDXLicenseAFL1_2:
+		return 9
+	case SPDXLicenseAFL2_0:
+		return 10
+	case SPDXLicenseAFL2_1:
+		return 11
+	case SPDXLicenseAFL3_0:
+		return 12
+	case SPDXLicenseAfmparse:
+		return 13
+	case SPDXLicenseAGPL1_0Only:
+		return 14
+	case SPDXLicenseAGPL1_0OrLater:
+		return 15
+	case SPDXLicenseAGPL3_0Only:
+		return 16
+	case SPDXLicenseAGPL3_0OrLater:
+		return 17
+	case SPDXLicenseAladdin:
+		return 18
+	case SPDXLicenseAMDPLPA:
+		return 19
+	case SPDXLicenseAML:
+		return 20
+	case SPDXLicenseAMPAS:
+		return 21
+	case SPDXLicenseANTLRPD:
+		return 22
+	case SPDXLicenseApache1_0:
+		return 23
+	case SPDXLicenseApache1_1:
+		return 24
+	case SPDXLicenseApache2_0:
+		return 25
+	case SPDXLicenseAPAFML:
+		return 26
+	case SPDXLicenseAPL1_0:
+		return 27
+	case SPDXLicenseAPSL1_0:
+		return 28
+	case SPDXLicenseAPSL1_1:
+		return 29
+	case SPDXLicenseAPSL1_2:
+		return 30
+	case SPDXLicenseAPSL2_0:
+		return 31
+	case SPDXLicenseArtistic1_0Cl8:
+		return 32
+	case SPDXLicenseArtistic1_0Perl:
+		return 33
+	case SPDXLicenseArtistic1_0:
+		return 34
+	case SPDXLicenseArtistic2_0:
+		return 35
+	case SPDXLicenseBahyph:
+		return 36
+	case SPDXLicenseBarr:
+		return 37
+	case SPDXLicenseBeerware:
+		return 38
+	case SPDXLicenseBitTorrent1_0:
+		return 39
+	case SPDXLicenseBitTorrent1_1:
+		return 40
+	case SPDXLicenseBorceux:
+		return 41
+	case SPDXLicenseBSD1Clause:
+		return 42
+	case SPDXLicenseBSD2ClauseFreeBSD:
+		return 43
+	case SPDXLicenseBSD2ClauseNetBSD:
+		return 44
+	case SPDXLicenseBSD2ClausePatent:
+		return 45
+	case SPDXLicenseBSD2Clause:
+		return 46
+	case SPDXLicenseBSD3ClauseAttribution:
+		return 47
+	case SPDXLicenseBSD3ClauseClear:
+		return 48
+	case SPDXLicenseBSD3ClauseLBNL:
+		return 49
+	case SPDXLicenseBSD3ClauseNoNuclearLicense2014:
+		return 50
+	case SPDXLicenseBSD3ClauseNoNuclearLicense:
+		return 51
+	case SPDXLicenseBSD3ClauseNoNuclearWarranty:
+		return 52
+	case SPDXLicenseBSD3Clause:
+		return 53
+	case SPDXLicenseBSD4ClauseUC:
+		return 54
+	case SPDXLicenseBSD4Clause:
+		return 55
+	case SPDXLicenseBSDProtection:
+		return 56
+	case SPDXLicenseBSDSourceCode:
+		return 57
+	case SPDXLicenseBSL1_0:
+		return 58
+	case SPDXLicenseBzip21_0_5:
+		return 59
+	case SPDXLicenseBzip21_0_6:
+		return 60
+	case SPDXLicenseCaldera:
+		return 61
+	case SPDXLicenseCATOSL1_1:
+		return 62
+	case SPDXLicenseCCBY1_0:
+		return 63
+	case SPDXLicenseCCBY2_0:
+		return 64
+	case SPDXLicenseCCBY2_5:
+		return 65
+	case SPDXLicenseCCBY3_0:
+		return 66
+	case SPDXLicenseCCBY4_0:
+		return 67
+	case SPDXLicenseCCBYNC1_0:
+		return 68
+	case SPDXLicenseCCBYNC2_0:
+		return 69
+	case SPDXLicenseCCBYNC2_5:
+		return 70
+	case SPDXLicenseCCBYNC3_0:
+		return 71
+	case SPDXLicenseCCBYNC4_0:
+		return 72
+	case SPDXLicenseCCBYNCND1_0:
+		return 73
+	case SPDXLicenseCCBYNCND2_0:
+		return 74
+	case SPDXLicenseCCBYNCND2_5:
+		return 75
+	case SPDXLicenseCCBYNCND3_0:
+		return 76
+	case SPDXLicenseCCBYNCND4_0:
+		return 77
+	case SPDXLicenseCCBYNCSA1_0:
+		return 78
+	case SPDXLicenseCCBYNCSA2_0:
+		return 79
+	case SPDXLicenseCCBYNCSA2_5:
+		return 80
+	case SPDXLicenseCCBYNCSA3_0:
+		return 81
+	case SPDXLicenseCCBYNCSA4_0:
+		return 82
+	case SPDXLicenseCCBYND1_0:
+		return 83
+	case SPDXLicenseCCBYND2_0:
+		return 84
+	case SPDXLicenseCCBYND2_5:
+		return 85
+	case SPDXLicenseCCBYND3_0:
+		return 86
+	case SPDXLicenseCCBYND4_0:
+		return 87
+	case SPDXLicenseCCBYSA1_0:
+		return 88
+	case SPDXLicenseCCBYSA2_0:
+		return 89
+	case SPDXLicenseCCBYSA2_5:
+		return 90
+	case SPDXLicenseCCBYSA3_0:
+		return 91
+	case SPDXLicenseCCBYSA4_0:
+		return 92
+	case SPDXLicenseCC01_0:
+		return 93
+	case SPDXLicenseCDDL1_0:
+		return 94
+	case SPDXLicenseCDDL1_1:
+		return 95
+	case SPDXLicenseCDLAPermissive1_0:
+		return 96
+	case SPDXLicenseCDLASharing1_0:
+		return 97
+	case SPDXLicenseCECILL1_0:
+		return 98
+	case SPDXLicenseCECILL1_1:
+		return 99
+	case SPDXLicenseCECILL2_0:
+		return 100
+	case SPDXLicenseCECILL2_1:
+		return 101
+	case SPDXLicenseCECILLB:
+		return 102
+	case SPDXLicenseCECILLC:
+		return 103
+	case SPDXLicenseClArtistic:
+		return 104
+	case SPDXLicenseCNRIJython:
+		return 105
+	case SPDXLicenseCNRIPythonGPLCompatible:
+		return 106
+	case SPDXLicenseCNRIPython:
+		return 107
+	case SPDXLicenseCondor1_1:
+		return 108
+	case SPDXLicenseCPAL1_0:
+		return 109
+	case SPDXLicenseCPL1_0:
+		return 110
+	case SPDXLicenseCPOL1_02:
+		return 111
+	case SPDXLicenseCrossword:
+		return 112
+	case SPDXLicenseCrystalStacker:
+		return 113
+	case SPDXLicenseCUAOPL1_0:
+		return 114
+	case SPDXLicenseCube:
+		return 115
+	case SPDXLicenseCurl:
+		return 116
+	case SPDXLicenseDFSL1_0:
+		return 117
+	case SPDXLicenseDiffmark:
+		return 118
+	case SPDXLicenseDOC:
+		return 119
+	case SPDXLicenseDotseqn:
+		return 120
+	case SPDXLicenseDSDP:
+		return 121
+	case SPDXLicenseDvipdfm:
+		return 122
+	case SPDXLicenseECL1_0:
+		return 123
+	case SPDXLicenseECL2_0:
+		return 124
+	case SPDXLicenseEFL1_0:
+		return 125
+	case SPDXLicenseEFL2_0:
+		return 126
+	case SPDXLicenseEGenix:
+		return 127
+	case SPDXLicenseEntessa:
+		return 128
+	case SPDXLicenseEPL1_0:
+		return 129
+	case SPDXLicenseEPL2_0:
+		return 130
+	case SPDXLicenseErlPL1_1:
+		return 131
+	case SPDXLicenseEUDatagrid:
+		return 132
+	case SPDXLicenseEUPL1_0:
+		return 133
+	case SPDXLicenseEUPL1_1:
+		return 134
+	case SPDXLicenseEUPL1_2:
+		return 135
+	case SPDXLicenseEurosym:
+		return 136
+	case SPDXLicenseFair:
+		return 137
+	case SPDXLicenseFrameworx1_0:
+		return 138
+	case SPDXLicenseFreeImage:
+		return 139
+	case SPDXLicenseFSFAP:
+		return 140
+	case SPDXLicenseFSFUL:
+		return 141
+	case SPDXLicenseFSFULLR:
+		return 142
+	case SPDXLicenseFTL:
+		return 143
+	case SPDXLicenseGFDL1_1Only:
+		return 144
+	case SPDXLicenseGFDL1_1OrLater:
+		return 145
+	case SPDXLicenseGFDL1_2Only:
+		return 146
+	case SPDXLicenseGFDL1_2OrLater:
+		return 147
+	case SPDXLicenseGFDL1_3Only:
+		return 148
+	case SPDXLicenseGFDL1_3OrLater:
+		return 149
+	case SPDXLicenseGiftware:
+		return 150
+	case SPDXLicenseGL2PS:
+		return 151
+	case SPDXLicenseGlide:
+		return 152
+	case SPDXLicenseGlulxe:
+		return 153
+	case SPDXLicenseGnuplot:
+		return 154
+	case SPDXLicenseGPL1_0Only:
+		return 155
+	case SPDXLicenseGPL1_0OrLater:
+		return 156
+	case SPDXLicenseGPL2_0Only:
+		return 157
+	case SPDXLicenseGPL2_0OrLater:
+		return 158
+	case SPDXLicenseGPL3_0Only:
+		return 159
+	case SPDXLicenseGPL3_0OrLater:
+		return 160
+	case SPDXLicenseGSOAP1_3b:
+		return 161
+	case SPDXLicenseHaskellReport:
+		return 162
+	case SPDXLicenseHPND:
+		return 163
+	case SPDXLicenseIBMPibs:
+		return 164
+	case SPDXLicenseICU:
+		return 165
+	case SPDXLicenseIJG:
+		return 166
+	case SPDXLicenseImageMagick:
+		return 167
+	case SPDXLicenseIMatix:
+		return 168
+	case SPDXLicenseImlib2:
+		return 169
+	case SPDXLicenseInfoZIP:
+		return 170
+	case SPDXLicenseIntelACPI:
+		return 171
+	case SPDXLicenseIntel:
+		return 172
+	case SPDXLicenseInterbase1_0:
+		return 173
+	case SPDXLicenseIPA:
+		return 174
+	case SPDXLicenseIPL1_0:
+		return 175
+	case SPDXLicenseISC:
+		return 176
+	case SPDXLicenseJasPer2_0:
+		return 177
+	case SPDXLicenseJSON:
+		return 178
+	case SPDXLicenseLAL1_2:
+		return 179
+	case SPDXLicenseLAL1_3:
+		return 180
+	case SPDXLicenseLatex2e:
+		return 181
+	case SPDXLicenseLeptonica:
+		return 182
+	case SPDXLicenseLGPL2_0Only:
+		return 183
+	case SPDXLicenseLGPL2_0OrLater:
+		return 184
+	case SPDXLicenseLGPL2_1Only:
+		return 185
+	case SPDXLicenseLGPL2_1OrLater:
+		return 186
+	case SPDXLicenseLGPL3_0Only:
+		return 187
+	case SPDXLicenseLGPL3_0OrLater:
+		return 188
+	case SPDXLicenseLGPLLR:
+		return 189
+	case SPDXLicenseLibpng:
+		return 190
+	case SPDXLicenseLibtiff:
+		return 191
+	case SPDXLicenseLiLiQP1_1:
+		return 192
+	case SPDXLicenseLiLiQR1_1:
+		return 193
+	case SPDXLicenseLiLiQRplus1_1:
+		return 194
+	case SPDXLicenseLinuxOpenIB:
+		return 195
+	case SPDXLicenseLPL1_0:
+		return 196
+	case SPDXLicenseLPL1_02:
+		return 197
+	case SPDXLicenseLPPL1_0:
+		return 198
+	case SPDXLicenseLPPL1_1:
+		return 199
+	case SPDXLicenseLPPL1_2:
+		return 200
+	case SPDXLicenseLPPL1_3a:
+		return 201
+	case SPDXLicenseLPPL1_3c:
+		return 202
+	case SPDXLicenseMakeIndex:
+		return 203
+	case SPDXLicenseMirOS:
+		return 204
+	case SPDXLicenseMIT0:
+		return 205
+	case SPDXLicenseMITAdvertising:
+		return 206
+	case SPDXLicenseMITCMU:
+		return 207
+	case SPDXLicenseMITEnna:
+		return 208
+	case SPDXLicenseMITFeh:
+		return 209
+	case SPDXLicenseMIT:
+		return 210
+	case SPDXLicenseMITNFA:
+		return 211
+	case SPDXLicenseMotosoto:
+		return 212
+	case SPDXLicenseMpich2:
+		return 213
+	case SPDXLicenseMPL1_0:
+		return 214
+	case SPDXLicenseMPL1_1:
+		return 215
+	case SPDXLicenseMPL2_0NoCopyleftException:
+		return 216
+	case SPDXLicenseMPL2_0:
+		return 217
+	case SPDXLicenseMSPL:
+		return 218
+	case SPDXLicenseMSRL:
+		return 219
+	case SPDXLicenseMTLL:
+		return 220
+	case SPDXLicenseMultics:
+		return 221
+	case SPDXLicenseMup:
+		return 222
+	case SPDXLicenseNASA1_3:
+		return 223
+	case SPDXLicenseNaumen:
+		return 224
+	case SPDXLicenseNBPL1_0:
+		return 225
+	case SPDXLicenseNCSA:
+		return 226
+	case SPDXLicenseNetSNMP:
+		return 227
+	case SPDXLicenseNetCDF:
+		return 228
+	case SPDXLicenseNewsletr:
+		return 229
+	case SPDXLicenseNGPL:
+		return 230
+	case SPDXLicenseNLOD1_0:
+		return 231
+	case SPDXLicenseNLPL:
+		return 232
+	case SPDXLicenseNokia:
+		return 233
+	case SPDXLicenseNOSL:
+		return 234
+	case SPDXLicenseNoweb:
+		return 235
+	case SPDXLicenseNPL1_0:
+		return 236
+	case SPDXLicenseNPL1_1:
+		return 237
+	case SPDXLicenseNPOSL3_0:
+		return 238
+	case SPDXLicenseNRL:
+		return 239
+	case SPDXLicenseNTP:
+		return 240
+	case SPDXLicenseOCCTPL:
+		return 241
+	case SPDXLicenseOCLC2_0:
+		return 242
+	case SPDXLicenseODbL1_0:
+		return 243
+	case SPDXLicenseOFL1_0:
+		return 244
+	case SPDXLicenseOFL1_1:
+		return 245
+	case SPDXLicenseOGTSL:
+		return 246
+	case SPDXLicenseOLDAP1_1:
+		return 247
+	case SPDXLicenseOLDAP1_2:
+		return 248
+	case SPDXLicenseOLDAP1_3:
+		return 249
+	case SPDXLicenseOLDAP1_4:
+		return 250
+	case SPDXLicenseOLDAP2_0_1:
+		return 251
+	case SPDXLicenseOLDAP2_0:
+		return 252
+	case SPDXLicenseOLDAP2_1:
+		return 253
+	case SPDXLicenseOLDAP2_2_1:
+		return 254
+	case SPDXLicenseOLDAP2_2_2:
+		return 255
+	case SPDXLicenseOLDAP2_2:
+		return 256
+	case SPDXLicenseOLDAP2_3:
+		return 257
+	case SPDXLicenseOLDAP2_4:
+		return 258
+	case SPDXLicenseOLDAP2_5:
+		return 259
+	case SPDXLicenseOLDAP2_6:
+		return 260
+	case SPDXLicenseOLDAP2_7:
+		return 261
+	case SPDXLicenseOLDAP2_8:
+		return 262
+	case SPDXLicenseOML:
+		return 263
+	case SPDXLicenseOpenSSL:
+		return 264
+	case SPDXLicenseOPL1_0:
+		return 265
+	case SPDXLicenseOSETPL2_1:
+		return 266
+	case SPDXLicenseOSL1_0:
+		return 267
+	case SPDXLicenseOSL1_1:
+		return 268
+	case SPDXLicenseOSL2_0:
+		return 269
+	case SPDXLicenseOSL2_1:
+		return 270
+	case SPDXLicenseOSL3_0:
+		return 271
+	case SPDXLicensePDDL1_0:
+		return 272
+	case SPDXLicensePHP3_0:
+		return 273
+	case SPDXLicensePHP3_01:
+		return 274
+	case SPDXLicensePlexus:
+		return 275
+	case SPDXLicensePostgreSQL:
+		return 276
+	case SPDXLicensePsfrag:
+		return 277
+	case SPDXLicensePsutils:
+		return 278
+	case SPDXLicensePython2_0:
+		return 279
+	case SPDXLicenseQhull:
+		return 280
+	case SPDXLicenseQPL1_0:
+		return 281
+	case SPDXLicenseRdisc:
+		return 282
+	case SPDXLicenseRHeCos1_1:
+		return 283
+	case SPDXLicenseRPL1_1:
+		return 284
+	case SPDXLicenseRPL1_5:
+		return 285
+	case SPDXLicenseRPSL1_0:
+		return 286
+	case SPDXLicenseRSAMD:
+		return 287
+	case SPDXLicenseRSCPL:
+		return 288
+	case SPDXLicenseRuby:
+		return 289
+	case SPDXLicenseSAXPD:
+		return 290
+	case SPDXLicenseSaxpath:
+		return 291
+	case SPDXLicenseSCEA:
+		return 292
+	case SPDXLicenseSendmail:
+		return 293
+	case SPDXLicenseSGIB1_0:
+		return 294
+	case SPDXLicenseSGIB1_1:
+		return 295
+	case SPDXLicenseSGIB2_0:
+		return 296
+	case SPDXLicenseSimPL2_0:
+		return 297
+	case SPDXLicenseSISSL1_2:
+		return 298
+	case SPDXLicenseSISSL:
+		return 299
+	case SPDXLicenseSleepycat:
+		return 300
+	case SPDXLicenseSMLNJ:
+		return 301
+	case SPDXLicenseSMPPL:
+		return 302
+	case SPDXLicenseSNIA:
+		return 303
+	case SPDXLicenseSpencer86:
+		return 304
+	case SPDXLicenseSpencer94:
+		return 305
+	case SPDXLicenseSpencer99:
+		return 306
+	case SPDXLicenseSPL1_0:
+		return 307
+	case SPDXLicenseSugarCRM1_1_3:
+		return 308
+	case SPDXLicenseSWL:
+		return 309
+	case SPDXLicenseTCL:
+		return 310
+	case SPDXLicenseTCPWrappers:
+		return 311
+	case SPDXLicenseTMate:
+		return 312
+	case SPDXLicenseTORQUE1_1:
+		return 313
+	case SPDXLicenseTOSL:
+		return 314
+	case SPDXLicenseUnicodeDFS2015:
+		return 315
+	case SPDXLicenseUnicodeDFS2016:
+		return 316
+	case SPDXLicenseUnicodeTOU:
+		return 317
+	case SPDXLicenseUnlicense:
+		return 318
+	case SPDXLicenseUPL1_0:
+		return 319
+	case SPDXLicenseVim:
+		return 320
+	case SPDXLicenseVOSTROM:
+		return 321
+	case SPDXLicenseVSL1_0:
+		return 322
+	case SPDXLicenseW3C19980720:
+		return 323
+	case SPDXLicenseW3C20150513:
+		return 324
+	case SPDXLicenseW3C:
+		return 325
+	case SPDXLicenseWatcom1_0:
+		return 326
+	case SPDXLicenseWsuipa:
+		return 327
+	case SPDXLicenseWTFPL:
+		return 328
+	case SPDXLicenseX11:
+		return 329
+	case SPDXLicenseXerox:
+		return 330
+	case SPDXLicenseXFree861_1:
+		return 331
+	case SPDXLicenseXinetd:
+		return 332
+	case SPDXLicenseXnet:
+		return 333
+	case SPDXLicenseXpp:
+		return 334
+	case SPDXLicenseXSkat:
+		return 335
+	case SPDXLicenseYPL1_0:
+		return 336
+	case SPDXLicenseYPL1_1:
+		return 337
+	case SPDXLicenseZed:
+		return 338
+	case SPDXLicenseZend2_0:
+		return 339
+	case SPDXLicenseZimbra1_3:
+		return 340
+	case SPDXLicenseZimbra1_4:
+		return 341
+	case SPDXLicenseZlibAcknowledgement:
+		return 342
+	case SPDXLicenseZlib:
+		return 343
+	case SPDXLicenseZPL1_1:
+		return 344
+	case SPDXLicenseZPL2_0:
+		return 345
+	case SPDXLicenseZPL2_1:
+		return 346
+	}
+	return 0
+}
+func (code *SPDXLicense) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SPDXLicenseNotOpenSource
+	case 2:
+		*code = SPDXLicense0BSD
+	case 3:
+		*code = SPDXLicenseAAL
+	case 4:
+		*code = SPDXLicenseAbstyles
+	case 5:
+		*code = SPDXLicenseAdobe2006
+	case 6:
+		*code = SPDXLicenseAdobeGlyph
+	case 7:
+		*code = SPDXLicenseADSL
+	case 8:
+		*code = SPDXLicenseAFL1_1
+	case 9:
+		*code = SPDXLicenseAFL1_2
+	case 10:
+		*code = SPDXLicenseAFL2_0
+	case 11:
+		*code = SPDXLicenseAFL2_1
+	case 12:
+		*code = SPDXLicenseAFL3_0
+	case 13:
+		*code = SPDXLicenseAfmparse
+	case 14:
+		*code = SPDXLicenseAGPL1_0Only
+	case 15:
+		*code = SPDXLicenseAGPL1_0OrLater
+	case 16:
+		*code = SPDXLicenseAGPL3_0Only
+	case 17:
+		*code = SPDXLicenseAGPL3_0OrLater
+	case 18:
+		*code = SPDXLicenseAladdin
+	case 19:
+		*code = SPDXLicenseAMDPLPA
+	case 20:
+		*code = SPDXLicenseAML
+	case 21:
+		*code = SPDXLicenseAMPAS
+	case 22:
+		*code = SPDXLicenseANTLRPD
+	case 23:
+		*code = SPDXLicenseApache1_0
+	case 24:
+		*code = SPDXLicenseApache1_1
+	case 25:
+		*code = SPDXLicenseApache2_0
+	case 26:
+		*code = SPDXLicenseAPAFML
+	case 27:
+		*code = SPDXLicenseAPL1_0
+	case 28:
+		*code = SPDXLicenseAPSL1_0
+	case 29:
+		*code = SPDXLicenseAPSL1_1
+	case 30:
+		*code = SPDXLicenseAPSL1_2
+	case 31:
+		*code = SPDXLicenseAPSL2_0
+	case 32:
+		*code = SPDXLicenseArtistic1_0Cl8
+	case 33:
+		*code = SPDXLicenseArtistic1_0Perl
+	case 34:
+		*code = SPDXLicenseArtistic1_0
+	case 35:
+		*code = SPDXLicenseArtistic2_0
+	case 36:
+		*code = SPDXLicenseBahyph
+	case 37:
+		*code = SPDXLicenseBarr
+	case 38:
+		*code = SPDXLicenseBeerware
+	case 39:
+		*code = SPDXLicenseBitTorrent1_0
+	case 40:
+		*code = SPDXLicenseBitTorrent1_1
+	case 41:
+		*code = SPDXLicenseBorceux
+	case 42:
+		*code = SPDXLicenseBSD1Clause
+	case 43:
+		*code = SPDXLicenseBSD2ClauseFreeBSD
+	case 44:
+		*code = SPDXLicenseBSD2ClauseNetBSD
+	case 45:
+		*code = SPDXLicenseBSD2ClausePatent
+	case 46:
+		*code = SPDXLicenseBSD2Clause
+	case 47:
+		*code = SPDXLicenseBSD3ClauseAttribution
+	case 48:
+		*code = SPDXLicenseBSD3ClauseClear
+	case 49:
+		*code = SPDXLicenseBSD3ClauseLBNL
+	case 50:
+		*code = SPDXLicenseBSD3ClauseNoNuclearLicense2014
+	case 51:
+		*code = SPDXLicenseBSD3ClauseNoNuclearLicense
+	case 52:
+		*code = SPDXLicenseBSD3ClauseNoNuclearWarranty
+	case 53:
+		*code = SPDXLicenseBSD3Clause
+	case 54:
+		*code = SPDXLicenseBSD4ClauseUC
+	case 55:
+		*code = SPDXLicenseBSD4Clause
+	case 56:
+		*code = SPDXLicenseBSDProtection
+	case 57:
+		*code = SPDXLicenseBSDSourceCode
+	case 58:
+		*code = SPDXLicenseBSL1_0
+	case 59:
+		*code = SPDXLicenseBzip21_0_5
+	case 60:
+		*code = SPDXLicenseBzip21_0_6
+	case 61:
+		*code = SPDXLicenseCaldera
+	case 62:
+		*code = SPDXLicenseCATOSL1_1
+	case 63:
+		*code = SPDXLicenseCCBY1_0
+	case 64:
+		*code = SPDXLicenseCCBY2_0
+	case 65:
+		*code = SPDXLicenseCCBY2_5
+	case 66:
+		*code = SPDXLicenseCCBY3_0
+	case 67:
+		*code = SPDXLicenseCCBY4_0
+	case 68:
+		*code = SPDXLicenseCCBYNC1_0
+	case 69:
+		*code = SPDXLicenseCCBYNC2_0
+	case 70:
+		*code = SPDXLicenseCCBYNC2_5
+	case 71:
+		*code = SPDXLicenseCCBYNC3_0
+	case 72:
+		*code = SPDXLicenseCCBYNC4_0
+	case 73:
+		*code = SPDXLicenseCCBYNCND1_0
+	case 74:
+		*code = SPDXLicenseCCBYNCND2_0
+	case 75:
+		*code = SPDXLicenseCCBYNCND2_5
+	case 76:
+		*code = SPDXLicenseCCBYNCND3_0
+	case 77:
+		*code = SPDXLicenseCCBYNCND4_0
+	case 78:
+		*code = SPDXLicenseCCBYNCSA1_0
+	case 79:
+		*code = SPDXLicenseCCBYNCSA2_0
+	case 80:
+		*code = SPDXLicenseCCBYNCSA2_5
+	case 81:
+		*code = SPDXLicenseCCBYNCSA3_0
+	case 82:
+		*code = SPDXLicenseCCBYNCSA4_0
+	case 83:
+		*code = SPDXLicenseCCBYND1_0
+	case 84:
+		*code = SPDXLicenseCCBYND2_0
+	case 85:
+		*code = SPDXLicenseCCBYND2_5
+	case 86:
+		*code = SPDXLicenseCCBYND3_0
+	case 87:
+		*code = SPDXLicenseCCBYND4_0
+	case 88:
+		*code = SPDXLicenseCCBYSA1_0
+	case 89:
+		*code = SPDXLicenseCCBYSA2_0
+	case 90:
+		*code = SPDXLicenseCCBYSA2_5
+	case 91:
+		*code = SPDXLicenseCCBYSA3_0
+	case 92:
+		*code = SPDXLicenseCCBYSA4_0
+	case 93:
+		*code = SPDXLicenseCC01_0
+	case 94:
+		*code = SPDXLicenseCDDL1_0
+	case 95:
+		*code = SPDXLicenseCDDL1_1
+	case 96:
+		*code = SPDXLicenseCDLAPermissive1_0
+	case 97:
+		*code = SPDXLicenseCDLASharing1_0
+	case 98:
+		*code = SPDXLicenseCECILL1_0
+	case 99:
+		*code = SPDXLicenseCECILL1_1
+	case 100:
+		*code = SPDXLicenseCECILL2_0
+	case 101:
+		*code = SPDXLicenseCECILL2_1
+	case 102:
+		*code = SPDXLicenseCECILLB
+	case 103:
+		*code = SPDXLicenseCECILLC
+	case 104:
+		*code = SPDXLicenseClArtistic
+	case 105:
+		*code = SPDXLicenseCNRIJython
+	case 106:
+		*code = SPDXLicenseCNRIPythonGPLCompatible
+	case 107:
+		*code = SPDXLicenseCNRIPython
+	case 108:
+		*code = SPDXLicenseCondor1_1
+	case 109:
+		*code = SPDXLicenseCPAL1_0
+	case 110:
+		*code = SPDXLicenseCPL1_0
+	case 111:
+		*code = SPDXLicenseCPOL1_02
+	case 112:
+		*code = SPDXLicenseCrossword
+	case 113:
+		*code = SPDXLicenseCrystalStacker
+	case 114:
+		*code = SPDXLicenseCUAOPL1_0
+	case 115:
+		*code = SPDXLicenseCube
+	case 116:
+		*code = SPDXLicenseCurl
+	case 117:
+		*code = SPDXLicenseDFSL1_0
+	case 118:
+		*code = SPDXLicenseDiffmark
+	case 119:
+		*code = SPDXLicenseDOC
+	case 120:
+		*code = SPDXLicenseDotseqn
+	case 121:
+		*code = SPDXLicenseDSDP
+	case 122:
+		*code = SPDXLicenseDvipdfm
+	case 123:
+		*code = SPDXLicenseECL1_0
+	case 124:
+		*code = SPDXLicenseECL2_0
+	case 125:
+		*code = SPDXLicenseEFL1_0
+	case 126:
+		*code = SPDXLicenseEFL2_0
+	case 127:
+		*code = SPDXLicenseEGenix
+	case 128:
+		*code = SPDXLicenseEntessa
+	case 129:
+		*code = SPDXLicenseEPL1_0
+	case 130:
+		*code = SPDXLicenseEPL2_0
+	case 131:
+		*code = SPDXLicenseErlPL1_1
+	case 132:
+		*code = SPDXLicenseEUDatagrid
+	case 133:
+		*code = SPDXLicenseEUPL1_0
+	case 134:
+		*code = SPDXLicenseEUPL1_1
+	case 135:
+		*code = SPDXLicenseEUPL1_2
+	case 136:
+		*code = SPDXLicenseEurosym
+	case 137:
+		*code = SPDXLicenseFair
+	case 138:
+		*code = SPDXLicenseFrameworx1_0
+	case 139:
+		*code = SPDXLicenseFreeImage
+	case 140:
+		*code = SPDXLicenseFSFAP
+	case 141:
+		*code = SPDXLicenseFSFUL
+	case 142:
+		*code = SPDXLicenseFSFULLR
+	case 143:
+		*code = SPDXLicenseFTL
+	case 144:
+		*code = SPDXLicenseGFDL1_1Only
+	case 145:
+		*code = SPDXLicenseGFDL1_1OrLater
+	case 146:
+		*code = SPDXLicenseGFDL1_2Only
+	case 147:
+		*code = SPDXLicenseGFDL1_2OrLater
+	case 148:
+		*code = SPDXLicenseGFDL1_3Only
+	case 149:
+		*code = SPDXLicenseGFDL1_3OrLater
+	case 150:
+		*code = SPDXLicenseGiftware
+	case 151:
+		*code = SPDXLicenseGL2PS
+	case 152:
+		*code = SPDXLicenseGlide
+	case 153:
+		*code = SPDXLicenseGlulxe
+	case 154:
+		*code = SPDXLicenseGnuplot
+	case 155:
+		*code = SPDXLicenseGPL1_0Only
+	case 156:
+		*code = SPDXLicenseGPL1_0OrLater
+	case 157:
+		*code = SPDXLicenseGPL2_0Only
+	case 158:
+		*code = SPDXLicenseGPL2_0OrLater
+	case 159:
+		*code = SPDXLicenseGPL3_0Only
+	case 160:
+		*code = SPDXLicenseGPL3_0OrLater
+	case 161:
+		*code = SPDXLicenseGSOAP1_3b
+	case 162:
+		*code = SPDXLicenseHaskellReport
+	case 163:
+		*code = SPDXLicenseHPND
+	case 164:
+		*code = SPDXLicenseIBMPibs
+	case 165:
+		*code = SPDXLicenseICU
+	case 166:
+		*code = SPDXLicenseIJG
+	case 167:
+		*code = SPDXLicenseImageMagick
+	case 168:
+		*code = SPDXLicenseIMatix
+	case 169:
+		*code = SPDXLicenseImlib2
+	case 170:
+		*code = SPDXLicenseInfoZIP
+	case 171:
+		*code = SPDXLicenseIntelACPI
+	case 172:
+		*code = SPDXLicenseIntel
+	case 173:
+		*code = SPDXLicenseInterbase1_0
+	case 174:
+		*code = SPDXLicenseIPA
+	case 175:
+		*code = SPDXLicenseIPL1_0
+	case 176:
+		*code = SPDXLicenseISC
+	case 177:
+		*code = SPDXLicenseJasPer2_0
+	case 178:
+		*code = SPDXLicenseJSON
+	case 179:
+		*code = SPDXLicenseLAL1_2
+	case 180:
+		*code = SPDXLicenseLAL1_3
+	case 181:
+		*code = SPDXLicenseLatex2e
+	case 182:
+		*code = SPDXLicenseLeptonica
+	case 183:
+		*code = SPDXLicenseLGPL2_0Only
+	case 184:
+		*code = SPDXLicenseLGPL2_0OrLater
+	case 185:
+		*code = SPDXLicenseLGPL2_1Only
+	case 186:
+		*code = SPDXLicenseLGPL2_1OrLater
+	case 187:
+		*code = SPDXLicenseLGPL3_0Only
+	case 188:
+		*code = SPDXLicenseLGPL3_0OrLater
+	case 189:
+		*code = SPDXLicenseLGPLLR
+	case 190:
+		*code = SPDXLicenseLibpng
+	case 191:
+		*code = SPDXLicenseLibtiff
+	case 192:
+		*code = SPDXLicenseLiLiQP1_1
+	case 193:
+		*code = SPDXLicenseLiLiQR1_1
+	case 194:
+		*code = SPDXLicenseLiLiQRplus1_1
+	case 195:
+		*code = SPDXLicenseLinuxOpenIB
+	case 196:
+		*code = SPDXLicenseLPL1_0
+	case 197:
+		*code = SPDXLicenseLPL1_02
+	case 198:
+		*code = SPDXLicenseLPPL1_0
+	case 199:
+		*code = SPDXLicenseLPPL1_1
+	case 200:
+		*code = SPDXLicenseLPPL1_2
+	case 201:
+		*code = SPDXLicenseLPPL1_3a
+	case 202:
+		*code = SPDXLicenseLPPL1_3c
+	case 203:
+		*code = SPDXLicenseMakeIndex
+	case 204:
+		*code = SPDXLicenseMirOS
+	case 205:
+		*code = SPDXLicenseMIT0
+	case 206:
+		*code = SPDXLicenseMITAdvertising
+	case 207:
+		*code = SPDXLicenseMITCMU
+	case 208:
+		*code = SPDXLicenseMITEnna
+	case 209:
+		*code = SPDXLicenseMITFeh
+	case 210:
+		*code = SPDXLicenseMIT
+	case 211:
+		*code = SPDXLicenseMITNFA
+	case 212:
+		*code = SPDXLicenseMotosoto
+	case 213:
+		*code = SPDXLicenseMpich2
+	case 214:
+		*code = SPDXLicenseMPL1_0
+	case 215:
+		*code = SPDXLicenseMPL1_1
+	case 216:
+		*code = SPDXLicenseMPL2_0NoCopyleftException
+	case 217:
+		*code = SPDXLicenseMPL2_0
+	case 218:
+		*code = SPDXLicenseMSPL
+	case 219:
+		*code = SPDXLicenseMSRL
+	case 220:
+		*code = SPDXLicenseMTLL
+	case 221:
+		*code = SPDXLicenseMultics
+	case 222:
+		*code = SPDXLicenseMup
+	case 223:
+		*code = SPDXLicenseNASA1_3
+	case 224:
+		*code = SPDXLicenseNaumen
+	case 225:
+		*code = SPDXLicenseNBPL1_0
+	case 226:
+		*code = SPDXLicenseNCSA
+	case 227:
+		*code = SPDXLicenseNetSNMP
+	case 228:
+		*code = SPDXLicenseNetCDF
+	case 229:
+		*code = SPDXLicenseNewsletr
+	case 230:
+		*code = SPDXLicenseNGPL
+	case 231:
+		*code = SPDXLicenseNLOD1_0
+	case 232:
+		*code = SPDXLicenseNLPL
+	case 233:
+		*code = SPDXLicenseNokia
+	case 234:
+		*code = SPDXLicenseNOSL
+	case 235:
+		*code = SPDXLicenseNoweb
+	case 236:
+		*code = SPDXLicenseNPL1_0
+	case 237:
+		*code = SPDXLicenseNPL1_1
+	case 238:
+		*code = SPDXLicenseNPOSL3_0
+	case 239:
+		*code = SPDXLicenseNRL
+	case 240:
+		*code = SPDXLicenseNTP
+	case 241:
+		*code = SPDXLicenseOCCTPL
+	case 242:
+		*code = SPDXLicenseOCLC2_0
+	case 243:
+		*code = SPDXLicenseODbL1_0
+	case 244:
+		*code = SPDXLicenseOFL1_0
+	case 245:
+		*code = SPDXLicenseOFL1_1
+	case 246:
+		*code = SPDXLicenseOGTSL
+	case 247:
+		*code = SPDXLicenseOLDAP1_1
+	case 248:
+		*code = SPDXLicenseOLDAP1_2
+	case 249:
+		*code = SPDXLicenseOLDAP1_3
+	case 250:
+		*code = SPDXLicenseOLDAP1_4
+	case 251:
+		*code = SPDXLicenseOLDAP2_0_1
+	case 252:
+		*code = SPDXLicenseOLDAP2_0
+	case 253:
+		*code = SPDXLicenseOLDAP2_1
+	case 254:
+		*code = SPDXLicenseOLDAP2_2_1
+	case 255:
+		*code = SPDXLicenseOLDAP2_2_2
+	case 256:
+		*code = SPDXLicenseOLDAP2_2
+	case 257:
+		*code = SPDXLicenseOLDAP2_3
+	case 258:
+		*code = SPDXLicenseOLDAP2_4
+	case 259:
+		*code = SPDXLicenseOLDAP2_5
+	case 260:
+		*code = SPDXLicenseOLDAP2_6
+	case 261:
+		*code = SPDXLicenseOLDAP2_7
+	case 262:
+		*code = SPDXLicenseOLDAP2_8
+	case 263:
+		*code = SPDXLicenseOML
+	case 264:
+		*code = SPDXLicenseOpenSSL
+	case 265:
+		*code = SPDXLicenseOPL1_0
+	case 266:
+		*code = SPDXLicenseOSETPL2_1
+	case 267:
+		*code = SPDXLicenseOSL1_0
+	case 268:
+		*code = SPDXLicenseOSL1_1
+	case 269:
+		*code = SPDXLicenseOSL2_0
+	case 270:
+		*code = SPDXLicenseOSL2_1
+	case 271:
+		*code = SPDXLicenseOSL3_0
+	case 272:
+		*code = SPDXLicensePDDL1_0
+	case 273:
+		*code = SPDXLicensePHP3_0
+	case 274:
+		*code = SPDXLicensePHP3_01
+	case 275:
+		*code = SPDXLicensePlexus
+	case 276:
+		*code = SPDXLicensePostgreSQL
+	case 277:
+		*code = SPDXLicensePsfrag
+	case 278:
+		*code = SPDXLicensePsutils
+	case 279:
+		*code = SPDXLicensePython2_0
+	case 280:
+		*code = SPDXLicenseQhull
+	case 281:
+		*code = SPDXLicenseQPL1_0
+	case 282:
+		*code = SPDXLicenseRdisc
+	case 283:
+		*code = SPDXLicenseRHeCos1_1
+	case 284:
+		*code = SPDXLicenseRPL1_1
+	case 285:
+		*code = SPDXLicenseRPL1_5
+	case 286:
+		*code = SPDXLicenseRPSL1_0
+	case 287:
+		*code = SPDXLicenseRSAMD
+	case 288:
+		*code = SPDXLicenseRSCPL
+	case 289:
+		*code = SPDXLicenseRuby
+	case 290:
+		*code = SPDXLicenseSAXPD
+	case 291:
+		*code = SPDXLicenseSaxpath
+	case 292:
+		*code = SPDXLicenseSCEA
+	case 293:
+		*code = SPDXLicenseSendmail
+	case 294:
+		*code = SPDXLicenseSGIB1_0
+	case 295:
+		*code = SPDXLicenseSGIB1_1
+	case 296:
+		*code = SPDXLicenseSGIB2_0
+	case 297:
+		*code = SPDXLicenseSimPL2_0
+	case 298:
+		*code = SPDXLicenseSISSL1_2
+	case 299:
+		*code = SPDXLicenseSISSL
+	case 300:
+		*code = SPDXLicenseSleepycat
+	case 301:
+		*code = SPDXLicenseSMLNJ
+	case 302:
+		*code = SPDXLicenseSMPPL
+	case 303:
+		*code = SPDXLicenseSNIA
+	case 304:
+		*code = SPDXLicenseSpencer86
+	case 305:
+		*code = SPDXLicenseSpencer94
+	case 306:
+		*code = SPDXLicenseSpencer99
+	case 307:
+		*code = SPDXLicenseSPL1_0
+	case 308:
+		*code = SPDXLicenseSugarCRM1_1_3
+	case 309:
+		*code = SPDXLicenseSWL
+	case 310:
+		*code = SPDXLicenseTCL
+	case 311:
+		*code = SPDXLicenseTCPWrappers
+	case 312:
+		*code = SPDXLicenseTMate
+	case 313:
+		*code = SPDXLicenseTORQUE1_1
+	case 314:
+		*code = SPDXLicenseTOSL
+	case 315:
+		*code = SPDXLicenseUnicodeDFS2015
+	case 316:
+		*code = SPDXLicenseUnicodeDFS2016
+	case 317:
+		*code = SPDXLicenseUnicodeTOU
+	case 318:
+		*code = SPDXLicenseUnlicense
+	case 319:
+		*code = SPDXLicenseUPL1_0
+	case 320:
+		*code = SPDXLicenseVim
+	case 321:
+		*code = SPDXLicenseVOSTROM
+	case 322:
+		*code = SPDXLicenseVSL1_0
+	case 323:
+		*code = SPDXLicenseW3C19980720
+	case 324:
+		*code = SPDXLicenseW3C20150513
+	case 325:
+		*code = SPDXLicenseW3C
+	case 326:
+		*code = SPDXLicenseWatcom1_0
+	case 327:
+		*code = SPDXLicenseWsuipa
+	case 328:
+		*code = SPDXLicenseWTFPL
+	case 329:
+		*code = SPDXLicenseX11
+	case 330:
+		*code = SPDXLicenseXerox
+	case 331:
+		*code = SPDXLicenseXFree861_1
+	case 332:
+		*code = SPDXLicenseXinetd
+	case 333:
+		*code = SPDXLicenseXnet
+	case 334:
+		*code = SPDXLicenseXpp
+	case 335:
+		*code = SPDXLicenseXSkat
+	case 336:
+		*code = SPDXLicenseYPL1_0
+	case 337:
+		*code = SPDXLicenseYPL1_1
+	case 338:
+		*code = SPDXLicenseZed
+	case 339:
+		*code = SPDXLicenseZend2_0
+	case 340:
+		*code = SPDXLicenseZimbra1_3
+	case 341:
+		*code = SPDXLicenseZimbra1_4
+	case 342:
+		*code = SPDXLicenseZlibAcknowledgement
+	case 343:
+		*code = SPDXLicenseZlib
+	case 344:
+		*code = SPDXLicenseZPL1_1
+	case 345:
+		*code = SPDXLicenseZPL2_0
+	case 346:
+		*code = SPDXLicenseZPL2_1
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code SearchComparator) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SearchComparator) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SearchComparator) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "eq":
 		*code = SearchComparatorEq
@@ -67,6 +71,12 @@ func (code *SearchComparator) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SearchComparator) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SearchComparator) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SearchComparator) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code SearchComparator) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SearchComparator) protoNumber() uint64 {
+	switch code {
+	case SearchComparatorEq:
+		return 1
+	case SearchComparatorNe:
+		return 2
+	case SearchComparatorGt:
+		return 3
+	case SearchComparatorLt:
+		return 4
+	case SearchComparatorGe:
+		return 5
+	case SearchComparatorLe:
+		return 6
+	case SearchComparatorSa:
+		return 7
+	case SearchComparatorEb:
+		return 8
+	case SearchComparatorAp:
+		return 9
+	}
+	return 0
+}
+func (code *SearchComparator) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SearchComparatorEq
+	case 2:
+		*code = SearchComparatorNe
+	case 3:
+		*code = SearchComparatorGt
+	case 4:
+		*code = SearchComparatorLt
+	case 5:
+		*code = SearchComparatorGe
+	case 6:
+		*code = SearchComparatorLe
+	case 7:
+		*code = SearchComparatorSa
+	case 8:
+		*code = SearchComparatorEb
+	case 9:
+		*code = SearchComparatorAp
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -44,8 +43,13 @@ const (
 func (code SearchModifierCode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SearchModifierCode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SearchModifierCode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "missing":
 		*code = SearchModifierCodeMissing
@@ -76,6 +80,12 @@ func (code *SearchModifierCode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SearchModifierCode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SearchModifierCode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SearchModifierCode) String() string {
 	return code.Code()
 }
@@ -166,3 +176,63 @@ func (code SearchModifierCode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SearchModifierCode) protoNumber() uint64 {
+	switch code {
+	case SearchModifierCodeMissing:
+		return 1
+	case SearchModifierCodeExact:
+		return 2
+	case SearchModifierCodeContains:
+		return 3
+	case SearchModifierCodeNot:
+		return 4
+	case SearchModifierCodeText:
+		return 5
+	case SearchModifierCodeIn:
+		return 6
+	case SearchModifierCodeNotIn:
+		return 7
+	case SearchModifierCodeBelow:
+		return 8
+	case SearchModifierCodeAbove:
+		return 9
+	case SearchModifierCodeType:
+		return 10
+	case SearchModifierCodeIdentifier:
+		return 11
+	case SearchModifierCodeOfType:
+		return 12
+	}
+	return 0
+}
+func (code *SearchModifierCode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SearchModifierCodeMissing
+	case 2:
+		*code = SearchModifierCodeExact
+	case 3:
+		*code = SearchModifierCodeContains
+	case 4:
+		*code = SearchModifierCodeNot
+	case 5:
+		*code = SearchModifierCodeText
+	case 6:
+		*code = SearchModifierCodeIn
+	case 7:
+		*code = SearchModifierCodeNotIn
+	case 8:
+		*code = SearchModifierCodeBelow
+	case 9:
+		*code = SearchModifierCodeAbove
+	case 10:
+		*code = SearchModifierCodeType
+	case 11:
+		*code = SearchModifierCodeIdentifier
+	case 12:
+		*code = SearchModifierCodeOfType
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -41,8 +40,13 @@ const (
 func (code SearchParamType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SearchParamType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SearchParamType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "number":
 		*code = SearchParamTypeNumber
@@ -67,6 +71,12 @@ func (code *SearchParamType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SearchParamType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SearchParamType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SearchParamType) String() string {
 	return code.Code()
 }
@@ -139,3 +149,51 @@ func (code SearchParamType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SearchParamType) protoNumber() uint64 {
+	switch code {
+	case SearchParamTypeNumber:
+		return 1
+	case SearchParamTypeDate:
+		return 2
+	case SearchParamTypeString:
+		return 3
+	case SearchParamTypeToken:
+		return 4
+	case SearchParamTypeReference:
+		return 5
+	case SearchParamTypeComposite:
+		return 6
+	case SearchParamTypeQuantity:
+		return 7
+	case SearchParamTypeUri:
+		return 8
+	case SearchParamTypeSpecial:
+		return 9
+	}
+	return 0
+}
+func (code *SearchParamType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SearchParamTypeNumber
+	case 2:
+		*code = SearchParamTypeDate
+	case 3:
+		*code = SearchParamTypeString
+	case 4:
+		*code = SearchParamTypeToken
+	case 5:
+		*code = SearchParamTypeReference
+	case 6:
+		*code = SearchParamTypeComposite
+	case 7:
+		*code = SearchParamTypeQuantity
+	case 8:
+		*code = SearchParamTypeUri
+	case 9:
+		*code = SearchParamTypeSpecial
+	default:
+		return false
+	}
+	return true
+}
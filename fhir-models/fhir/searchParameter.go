@@ -14,71 +14,2884 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // SearchParameter is documented here http://hl7.org/fhir/StructureDefinition/SearchParameter
 type SearchParameter struct {
-	Id                *string                    `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                    `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               string                     `bson:"url" json:"url"`
-	Version           *string                    `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                     `bson:"name" json:"name"`
-	DerivedFrom       *string                    `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
-	Status            PublicationStatus          `bson:"status" json:"status"`
-	Experimental      *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                    `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       string                     `bson:"description" json:"description"`
-	UseContext        []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Code              string                     `bson:"code" json:"code"`
-	Base              []ResourceType             `bson:"base" json:"base"`
-	Type              SearchParamType            `bson:"type" json:"type"`
-	Expression        *string                    `bson:"expression,omitempty" json:"expression,omitempty"`
-	Xpath             *string                    `bson:"xpath,omitempty" json:"xpath,omitempty"`
-	XpathUsage        *XPathUsageType            `bson:"xpathUsage,omitempty" json:"xpathUsage,omitempty"`
-	Target            []ResourceType             `bson:"target,omitempty" json:"target,omitempty"`
-	MultipleOr        *bool                      `bson:"multipleOr,omitempty" json:"multipleOr,omitempty"`
-	MultipleAnd       *bool                      `bson:"multipleAnd,omitempty" json:"multipleAnd,omitempty"`
-	Comparator        []SearchComparator         `bson:"comparator,omitempty" json:"comparator,omitempty"`
-	Modifier          []SearchModifierCode       `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	Chain             []string                   `bson:"chain,omitempty" json:"chain,omitempty"`
-	Component         []SearchParameterComponent `bson:"component,omitempty" json:"component,omitempty"`
+	Id                   *string                    `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                   `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                   `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                    `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                   `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  string                     `bson:"url" json:"url"`
+	UrlElement           *Element                   `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                    `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                   `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                     `bson:"name" json:"name"`
+	NameElement          *Element                   `bson:"_name,omitempty" json:"_name,omitempty"`
+	DerivedFrom          *string                    `bson:"derivedFrom,omitempty" json:"derivedFrom,omitempty"`
+	DerivedFromElement   *Element                   `bson:"_derivedFrom,omitempty" json:"_derivedFrom,omitempty"`
+	Status               PublicationStatus          `bson:"status" json:"status"`
+	StatusElement        *Element                   `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                      `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                   `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *string                    `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                   `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                   `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail            `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          string                     `bson:"description" json:"description"`
+	DescriptionElement   *Element                   `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept          `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                    `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                   `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Code                 string                     `bson:"code" json:"code"`
+	CodeElement          *Element                   `bson:"_code,omitempty" json:"_code,omitempty"`
+	Base                 []ResourceType             `bson:"base" json:"base"`
+	BaseElement          []*Element                 `bson:"_base,omitempty" json:"_base,omitempty"`
+	Type                 SearchParamType            `bson:"type" json:"type"`
+	TypeElement          *Element                   `bson:"_type,omitempty" json:"_type,omitempty"`
+	Expression           *string                    `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement    *Element                   `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	Xpath                *string                    `bson:"xpath,omitempty" json:"xpath,omitempty"`
+	XpathElement         *Element                   `bson:"_xpath,omitempty" json:"_xpath,omitempty"`
+	XpathUsage           *XPathUsageType            `bson:"xpathUsage,omitempty" json:"xpathUsage,omitempty"`
+	XpathUsageElement    *Element                   `bson:"_xpathUsage,omitempty" json:"_xpathUsage,omitempty"`
+	Target               []ResourceType             `bson:"target,omitempty" json:"target,omitempty"`
+	TargetElement        []*Element                 `bson:"_target,omitempty" json:"_target,omitempty"`
+	MultipleOr           *bool                      `bson:"multipleOr,omitempty" json:"multipleOr,omitempty"`
+	MultipleOrElement    *Element                   `bson:"_multipleOr,omitempty" json:"_multipleOr,omitempty"`
+	MultipleAnd          *bool                      `bson:"multipleAnd,omitempty" json:"multipleAnd,omitempty"`
+	MultipleAndElement   *Element                   `bson:"_multipleAnd,omitempty" json:"_multipleAnd,omitempty"`
+	Comparator           []SearchComparator         `bson:"comparator,omitempty" json:"comparator,omitempty"`
+	ComparatorElement    []*Element                 `bson:"_comparator,omitempty" json:"_comparator,omitempty"`
+	Modifier             []SearchModifierCode       `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	ModifierElement      []*Element                 `bson:"_modifier,omitempty" json:"_modifier,omitempty"`
+	Chain                []string                   `bson:"chain,omitempty" json:"chain,omitempty"`
+	ChainElement         []*Element                 `bson:"_chain,omitempty" json:"_chain,omitempty"`
+	Component            []SearchParameterComponent `bson:"component,omitempty" json:"component,omitempty"`
 }
 type SearchParameterComponent struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Definition        string      `bson:"definition" json:"definition"`
+	DefinitionElement *Element    `bson:"_definition,omitempty" json:"_definition,omitempty"`
 	Expression        string      `bson:"expression" json:"expression"`
+	ExpressionElement *Element    `bson:"_expression,omitempty" json:"_expression,omitempty"`
 }
-type OtherSearchParameter SearchParameter
 
 // MarshalJSON marshals the given SearchParameter as JSON into a byte slice
 func (r SearchParameter) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSearchParameter
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSearchParameter: OtherSearchParameter(r),
-		ResourceType:         "SearchParameter",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SearchParameter as JSON object with the members in the order of the fields
+func (r SearchParameter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"SearchParameter\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.DerivedFrom != nil {
+		e.key("derivedFrom")
+		e.string(*r.DerivedFrom)
+	}
+	if r.DerivedFromElement != nil {
+		e.key("_derivedFrom")
+		r.DerivedFromElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("description")
+	e.string(r.Description)
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	e.key("base")
+	if r.Base == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Base {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BaseElement) > 0 {
+		e.key("_base")
+		e.elements(r.BaseElement, len(r.Base))
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		e.string(*r.Expression)
+	}
+	if r.ExpressionElement != nil {
+		e.key("_expression")
+		r.ExpressionElement.appendJSON(e)
+	}
+	if r.Xpath != nil {
+		e.key("xpath")
+		e.string(*r.Xpath)
+	}
+	if r.XpathElement != nil {
+		e.key("_xpath")
+		r.XpathElement.appendJSON(e)
+	}
+	if r.XpathUsage != nil {
+		e.key("xpathUsage")
+		e.string(r.XpathUsage.Code())
+	}
+	if r.XpathUsageElement != nil {
+		e.key("_xpathUsage")
+		r.XpathUsageElement.appendJSON(e)
+	}
+	if len(r.Target) > 0 {
+		e.key("target")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Target {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.TargetElement) > 0 {
+		e.key("_target")
+		e.elements(r.TargetElement, len(r.Target))
+	}
+	if r.MultipleOr != nil {
+		e.key("multipleOr")
+		e.bool(*r.MultipleOr)
+	}
+	if r.MultipleOrElement != nil {
+		e.key("_multipleOr")
+		r.MultipleOrElement.appendJSON(e)
+	}
+	if r.MultipleAnd != nil {
+		e.key("multipleAnd")
+		e.bool(*r.MultipleAnd)
+	}
+	if r.MultipleAndElement != nil {
+		e.key("_multipleAnd")
+		r.MultipleAndElement.appendJSON(e)
+	}
+	if len(r.Comparator) > 0 {
+		e.key("comparator")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Comparator {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ComparatorElement) > 0 {
+		e.key("_comparator")
+		e.elements(r.ComparatorElement, len(r.Comparator))
+	}
+	if len(r.Modifier) > 0 {
+		e.key("modifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modifier {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierElement) > 0 {
+		e.key("_modifier")
+		e.elements(r.ModifierElement, len(r.Modifier))
+	}
+	if len(r.Chain) > 0 {
+		e.key("chain")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Chain {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ChainElement) > 0 {
+		e.key("_chain")
+		e.elements(r.ChainElement, len(r.Chain))
+	}
+	if len(r.Component) > 0 {
+		e.key("component")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Component {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SearchParameter from JSON. Unknown members are ignored.
+func (r *SearchParameter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SearchParameter
+func (r *SearchParameter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "derivedFrom":
+			if v, ok := d.string(); ok {
+				r.DerivedFrom = &v
+			} else {
+				r.DerivedFrom = nil
+			}
+		case "_derivedFrom":
+			r.DerivedFromElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = v
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "base":
+			a := d.array()
+			r.Base = nil
+			for a.next() {
+				var v ResourceType
+				d.unmarshal(&v)
+				r.Base = append(r.Base, v)
+			}
+			if r.Base == nil && !a.isNull {
+				r.Base = []ResourceType{}
+			}
+		case "_base":
+			a := d.array()
+			r.BaseElement = nil
+			for a.next() {
+				r.BaseElement = append(r.BaseElement, d.element())
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "expression":
+			if v, ok := d.string(); ok {
+				r.Expression = &v
+			} else {
+				r.Expression = nil
+			}
+		case "_expression":
+			r.ExpressionElement = d.element()
+		case "xpath":
+			if v, ok := d.string(); ok {
+				r.Xpath = &v
+			} else {
+				r.Xpath = nil
+			}
+		case "_xpath":
+			r.XpathElement = d.element()
+		case "xpathUsage":
+			if d.null() {
+				r.XpathUsage = nil
+			} else {
+				var v XPathUsageType
+				d.unmarshal(&v)
+				r.XpathUsage = &v
+			}
+		case "_xpathUsage":
+			r.XpathUsageElement = d.element()
+		case "target":
+			a := d.array()
+			r.Target = nil
+			for a.next() {
+				var v ResourceType
+				d.unmarshal(&v)
+				r.Target = append(r.Target, v)
+			}
+			if r.Target == nil && !a.isNull {
+				r.Target = []ResourceType{}
+			}
+		case "_target":
+			a := d.array()
+			r.TargetElement = nil
+			for a.next() {
+				r.TargetElement = append(r.TargetElement, d.element())
+			}
+		case "multipleOr":
+			if v, ok := d.bool(); ok {
+				r.MultipleOr = &v
+			} else {
+				r.MultipleOr = nil
+			}
+		case "_multipleOr":
+			r.MultipleOrElement = d.element()
+		case "multipleAnd":
+			if v, ok := d.bool(); ok {
+				r.MultipleAnd = &v
+			} else {
+				r.MultipleAnd = nil
+			}
+		case "_multipleAnd":
+			r.MultipleAndElement = d.element()
+		case "comparator":
+			a := d.array()
+			r.Comparator = nil
+			for a.next() {
+				var v SearchComparator
+				d.unmarshal(&v)
+				r.Comparator = append(r.Comparator, v)
+			}
+			if r.Comparator == nil && !a.isNull {
+				r.Comparator = []SearchComparator{}
+			}
+		case "_comparator":
+			a := d.array()
+			r.ComparatorElement = nil
+			for a.next() {
+				r.ComparatorElement = append(r.ComparatorElement, d.element())
+			}
+		case "modifier":
+			a := d.array()
+			r.Modifier = nil
+			for a.next() {
+				var v SearchModifierCode
+				d.unmarshal(&v)
+				r.Modifier = append(r.Modifier, v)
+			}
+			if r.Modifier == nil && !a.isNull {
+				r.Modifier = []SearchModifierCode{}
+			}
+		case "_modifier":
+			a := d.array()
+			r.ModifierElement = nil
+			for a.next() {
+				r.ModifierElement = append(r.ModifierElement, d.element())
+			}
+		case "chain":
+			a := d.array()
+			r.Chain = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Chain = append(r.Chain, v)
+			}
+			if r.Chain == nil && !a.isNull {
+				r.Chain = []string{}
+			}
+		case "_chain":
+			a := d.array()
+			r.ChainElement = nil
+			for a.next() {
+				r.ChainElement = append(r.ChainElement, d.element())
+			}
+		case "component":
+			a := d.array()
+			r.Component = nil
+			for a.next() {
+				var v SearchParameterComponent
+				v.decodeJSON(d)
+				r.Component = append(r.Component, v)
+			}
+			if r.Component == nil && !a.isNull {
+				r.Component = []SearchParameterComponent{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SearchParameter which shares no memory with the original
+func (r SearchParameter) DeepCopy() SearchParameter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.DerivedFrom != nil {
+		v := *r.DerivedFrom
+		out.DerivedFrom = &v
+	}
+	if r.DerivedFromElement != nil {
+		v := r.DerivedFromElement.DeepCopy()
+		out.DerivedFromElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Base != nil {
+		out.Base = make([]ResourceType, len(r.Base))
+		copy(out.Base, r.Base)
+	}
+	if r.BaseElement != nil {
+		out.BaseElement = make([]*Element, len(r.BaseElement))
+		for i, v := range r.BaseElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.BaseElement[i] = &c
+			}
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Expression != nil {
+		v := *r.Expression
+		out.Expression = &v
+	}
+	if r.ExpressionElement != nil {
+		v := r.ExpressionElement.DeepCopy()
+		out.ExpressionElement = &v
+	}
+	if r.Xpath != nil {
+		v := *r.Xpath
+		out.Xpath = &v
+	}
+	if r.XpathElement != nil {
+		v := r.XpathElement.DeepCopy()
+		out.XpathElement = &v
+	}
+	if r.XpathUsage != nil {
+		v := *r.XpathUsage
+		out.XpathUsage = &v
+	}
+	if r.XpathUsageElement != nil {
+		v := r.XpathUsageElement.DeepCopy()
+		out.XpathUsageElement = &v
+	}
+	if r.Target != nil {
+		out.Target = make([]ResourceType, len(r.Target))
+		copy(out.Target, r.Target)
+	}
+	if r.TargetElement != nil {
+		out.TargetElement = make([]*Element, len(r.TargetElement))
+		for i, v := range r.TargetElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.TargetElement[i] = &c
+			}
+		}
+	}
+	if r.MultipleOr != nil {
+		v := *r.MultipleOr
+		out.MultipleOr = &v
+	}
+	if r.MultipleOrElement != nil {
+		v := r.MultipleOrElement.DeepCopy()
+		out.MultipleOrElement = &v
+	}
+	if r.MultipleAnd != nil {
+		v := *r.MultipleAnd
+		out.MultipleAnd = &v
+	}
+	if r.MultipleAndElement != nil {
+		v := r.MultipleAndElement.DeepCopy()
+		out.MultipleAndElement = &v
+	}
+	if r.Comparator != nil {
+		out.Comparator = make([]SearchComparator, len(r.Comparator))
+		copy(out.Comparator, r.Comparator)
+	}
+	if r.ComparatorElement != nil {
+		out.ComparatorElement = make([]*Element, len(r.ComparatorElement))
+		for i, v := range r.ComparatorElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ComparatorElement[i] = &c
+			}
+		}
+	}
+	if r.Modifier != nil {
+		out.Modifier = make([]SearchModifierCode, len(r.Modifier))
+		copy(out.Modifier, r.Modifier)
+	}
+	if r.ModifierElement != nil {
+		out.ModifierElement = make([]*Element, len(r.ModifierElement))
+		for i, v := range r.ModifierElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ModifierElement[i] = &c
+			}
+		}
+	}
+	if r.Chain != nil {
+		out.Chain = make([]string, len(r.Chain))
+		copy(out.Chain, r.Chain)
+	}
+	if r.ChainElement != nil {
+		out.ChainElement = make([]*Element, len(r.ChainElement))
+		for i, v := range r.ChainElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ChainElement[i] = &c
+			}
+		}
+	}
+	if r.Component != nil {
+		out.Component = make([]SearchParameterComponent, len(r.Component))
+		for i := range r.Component {
+			out.Component[i] = r.Component[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SearchParameter) Equal(other SearchParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.DerivedFrom == nil) != (other.DerivedFrom == nil) || r.DerivedFrom != nil && *r.DerivedFrom != *other.DerivedFrom {
+		return false
+	}
+	if (r.DerivedFromElement == nil) != (other.DerivedFromElement == nil) || r.DerivedFromElement != nil && !r.DerivedFromElement.Equal(*other.DerivedFromElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if r.Description != other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if len(r.Base) != len(other.Base) {
+		return false
+	}
+	for i := range r.Base {
+		if r.Base[i] != other.Base[i] {
+			return false
+		}
+	}
+	if !equalElements(r.BaseElement, other.BaseElement, false) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && *r.Expression != *other.Expression {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.Equal(*other.ExpressionElement) {
+		return false
+	}
+	if (r.Xpath == nil) != (other.Xpath == nil) || r.Xpath != nil && *r.Xpath != *other.Xpath {
+		return false
+	}
+	if (r.XpathElement == nil) != (other.XpathElement == nil) || r.XpathElement != nil && !r.XpathElement.Equal(*other.XpathElement) {
+		return false
+	}
+	if (r.XpathUsage == nil) != (other.XpathUsage == nil) || r.XpathUsage != nil && *r.XpathUsage != *other.XpathUsage {
+		return false
+	}
+	if (r.XpathUsageElement == nil) != (other.XpathUsageElement == nil) || r.XpathUsageElement != nil && !r.XpathUsageElement.Equal(*other.XpathUsageElement) {
+		return false
+	}
+	if len(r.Target) != len(other.Target) {
+		return false
+	}
+	for i := range r.Target {
+		if r.Target[i] != other.Target[i] {
+			return false
+		}
+	}
+	if !equalElements(r.TargetElement, other.TargetElement, false) {
+		return false
+	}
+	if (r.MultipleOr == nil) != (other.MultipleOr == nil) || r.MultipleOr != nil && *r.MultipleOr != *other.MultipleOr {
+		return false
+	}
+	if (r.MultipleOrElement == nil) != (other.MultipleOrElement == nil) || r.MultipleOrElement != nil && !r.MultipleOrElement.Equal(*other.MultipleOrElement) {
+		return false
+	}
+	if (r.MultipleAnd == nil) != (other.MultipleAnd == nil) || r.MultipleAnd != nil && *r.MultipleAnd != *other.MultipleAnd {
+		return false
+	}
+	if (r.MultipleAndElement == nil) != (other.MultipleAndElement == nil) || r.MultipleAndElement != nil && !r.MultipleAndElement.Equal(*other.MultipleAndElement) {
+		return false
+	}
+	if len(r.Comparator) != len(other.Comparator) {
+		return false
+	}
+	for i := range r.Comparator {
+		if r.Comparator[i] != other.Comparator[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ComparatorElement, other.ComparatorElement, false) {
+		return false
+	}
+	if len(r.Modifier) != len(other.Modifier) {
+		return false
+	}
+	for i := range r.Modifier {
+		if r.Modifier[i] != other.Modifier[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ModifierElement, other.ModifierElement, false) {
+		return false
+	}
+	if len(r.Chain) != len(other.Chain) {
+		return false
+	}
+	for i := range r.Chain {
+		if r.Chain[i] != other.Chain[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ChainElement, other.ChainElement, false) {
+		return false
+	}
+	if len(r.Component) != len(other.Component) {
+		return false
+	}
+	for i := range r.Component {
+		if !r.Component[i].Equal(other.Component[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SearchParameter) EqualsDeep(other SearchParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.DerivedFrom == nil) != (other.DerivedFrom == nil) || r.DerivedFrom != nil && !equivalentString(*r.DerivedFrom, *other.DerivedFrom) {
+		return false
+	}
+	if (r.DerivedFromElement == nil) != (other.DerivedFromElement == nil) || r.DerivedFromElement != nil && !r.DerivedFromElement.EqualsDeep(*other.DerivedFromElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Base), len(other.Base), func(i, j int) bool {
+		return r.Base[i] == other.Base[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.BaseElement, other.BaseElement, true) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.EqualsDeep(*other.ExpressionElement) {
+		return false
+	}
+	if (r.Xpath == nil) != (other.Xpath == nil) || r.Xpath != nil && !equivalentString(*r.Xpath, *other.Xpath) {
+		return false
+	}
+	if (r.XpathElement == nil) != (other.XpathElement == nil) || r.XpathElement != nil && !r.XpathElement.EqualsDeep(*other.XpathElement) {
+		return false
+	}
+	if (r.XpathUsage == nil) != (other.XpathUsage == nil) || r.XpathUsage != nil && *r.XpathUsage != *other.XpathUsage {
+		return false
+	}
+	if (r.XpathUsageElement == nil) != (other.XpathUsageElement == nil) || r.XpathUsageElement != nil && !r.XpathUsageElement.EqualsDeep(*other.XpathUsageElement) {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i] == other.Target[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.TargetElement, other.TargetElement, true) {
+		return false
+	}
+	if (r.MultipleOr == nil) != (other.MultipleOr == nil) || r.MultipleOr != nil && *r.MultipleOr != *other.MultipleOr {
+		return false
+	}
+	if (r.MultipleOrElement == nil) != (other.MultipleOrElement == nil) || r.MultipleOrElement != nil && !r.MultipleOrElement.EqualsDeep(*other.MultipleOrElement) {
+		return false
+	}
+	if (r.MultipleAnd == nil) != (other.MultipleAnd == nil) || r.MultipleAnd != nil && *r.MultipleAnd != *other.MultipleAnd {
+		return false
+	}
+	if (r.MultipleAndElement == nil) != (other.MultipleAndElement == nil) || r.MultipleAndElement != nil && !r.MultipleAndElement.EqualsDeep(*other.MultipleAndElement) {
+		return false
+	}
+	if !equivalentList(len(r.Comparator), len(other.Comparator), func(i, j int) bool {
+		return r.Comparator[i] == other.Comparator[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ComparatorElement, other.ComparatorElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i] == other.Modifier[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ModifierElement, other.ModifierElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Chain), len(other.Chain), func(i, j int) bool {
+		return equivalentString(r.Chain[i], other.Chain[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ChainElement, other.ChainElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Component), len(other.Component), func(i, j int) bool {
+		return r.Component[i].EqualsDeep(other.Component[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SearchParameter) EqualsShallow(other SearchParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.DerivedFrom == nil) != (other.DerivedFrom == nil) || r.DerivedFrom != nil && !equivalentString(*r.DerivedFrom, *other.DerivedFrom) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.Base), len(other.Base), func(i, j int) bool {
+		return r.Base[i] == other.Base[j]
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.Xpath == nil) != (other.Xpath == nil) || r.Xpath != nil && !equivalentString(*r.Xpath, *other.Xpath) {
+		return false
+	}
+	if (r.XpathUsage == nil) != (other.XpathUsage == nil) || r.XpathUsage != nil && *r.XpathUsage != *other.XpathUsage {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i] == other.Target[j]
+	}) {
+		return false
+	}
+	if (r.MultipleOr == nil) != (other.MultipleOr == nil) || r.MultipleOr != nil && *r.MultipleOr != *other.MultipleOr {
+		return false
+	}
+	if (r.MultipleAnd == nil) != (other.MultipleAnd == nil) || r.MultipleAnd != nil && *r.MultipleAnd != *other.MultipleAnd {
+		return false
+	}
+	if !equivalentList(len(r.Comparator), len(other.Comparator), func(i, j int) bool {
+		return r.Comparator[i] == other.Comparator[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Modifier), len(other.Modifier), func(i, j int) bool {
+		return r.Modifier[i] == other.Modifier[j]
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Chain), len(other.Chain), func(i, j int) bool {
+		return equivalentString(r.Chain[i], other.Chain[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SearchParameter as FHIR XML
+func (r SearchParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "SearchParameter",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "derivedFrom", r.DerivedFrom, r.DerivedFromElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	for i, v := range r.Base {
+		if err := encodeXMLPrimitive(e, "base", v, elementAt(r.BaseElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expression", r.Expression, r.ExpressionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "xpath", r.Xpath, r.XpathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "xpathUsage", r.XpathUsage, r.XpathUsageElement); err != nil {
+		return err
+	}
+	for i, v := range r.Target {
+		if err := encodeXMLPrimitive(e, "target", v, elementAt(r.TargetElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "multipleOr", r.MultipleOr, r.MultipleOrElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "multipleAnd", r.MultipleAnd, r.MultipleAndElement); err != nil {
+		return err
+	}
+	for i, v := range r.Comparator {
+		if err := encodeXMLPrimitive(e, "comparator", v, elementAt(r.ComparatorElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Modifier {
+		if err := encodeXMLPrimitive(e, "modifier", v, elementAt(r.ModifierElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Chain {
+		if err := encodeXMLPrimitive(e, "chain", v, elementAt(r.ChainElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Component {
+		if err := encodeXMLElement(e, "component", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SearchParameter from FHIR XML
+func (r *SearchParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "derivedFrom":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DerivedFrom = &v
+				}
+				r.DerivedFromElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "base":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.BaseElement = appendElement(r.BaseElement, len(r.Base), element)
+					r.Base = append(r.Base, v)
+				}
+			case "type":
+				var v SearchParamType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Expression = &v
+				}
+				r.ExpressionElement = element
+			case "xpath":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Xpath = &v
+				}
+				r.XpathElement = element
+			case "xpathUsage":
+				var v XPathUsageType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.XpathUsage = &v
+				}
+				r.XpathUsageElement = element
+			case "target":
+				var v ResourceType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.TargetElement = appendElement(r.TargetElement, len(r.Target), element)
+					r.Target = append(r.Target, v)
+				}
+			case "multipleOr":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MultipleOr = &v
+				}
+				r.MultipleOrElement = element
+			case "multipleAnd":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MultipleAnd = &v
+				}
+				r.MultipleAndElement = element
+			case "comparator":
+				var v SearchComparator
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ComparatorElement = appendElement(r.ComparatorElement, len(r.Comparator), element)
+					r.Comparator = append(r.Comparator, v)
+				}
+			case "modifier":
+				var v SearchModifierCode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ModifierElement = appendElement(r.ModifierElement, len(r.Modifier), element)
+					r.Modifier = append(r.Modifier, v)
+				}
+			case "chain":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ChainElement = appendElement(r.ChainElement, len(r.Chain), element)
+					r.Chain = append(r.Chain, v)
+				}
+			case "component":
+				var v SearchParameterComponent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Component = append(r.Component, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SearchParameter as properties to the RDF node n
+func (r SearchParameter) turtle(n *turtleNode) {
+	n.resource("SearchParameter", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("SearchParameter.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("SearchParameter.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("SearchParameter.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("SearchParameter.derivedFrom", -1, r.DerivedFrom, r.DerivedFromElement, "string")
+	n.primitive("SearchParameter.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("SearchParameter.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("SearchParameter.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("SearchParameter.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("SearchParameter.contact", i, v)
+	}
+	n.primitive("SearchParameter.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("SearchParameter.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("SearchParameter.jurisdiction", i, v)
+	}
+	n.primitive("SearchParameter.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("SearchParameter.code", -1, r.Code, r.CodeElement, "string")
+	for i, v := range r.Base {
+		n.primitive("SearchParameter.base", i, v, elementAt(r.BaseElement, i), "code")
+	}
+	n.primitive("SearchParameter.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("SearchParameter.expression", -1, r.Expression, r.ExpressionElement, "string")
+	n.primitive("SearchParameter.xpath", -1, r.Xpath, r.XpathElement, "string")
+	n.primitive("SearchParameter.xpathUsage", -1, r.XpathUsage, r.XpathUsageElement, "code")
+	for i, v := range r.Target {
+		n.primitive("SearchParameter.target", i, v, elementAt(r.TargetElement, i), "code")
+	}
+	n.primitive("SearchParameter.multipleOr", -1, r.MultipleOr, r.MultipleOrElement, "boolean")
+	n.primitive("SearchParameter.multipleAnd", -1, r.MultipleAnd, r.MultipleAndElement, "boolean")
+	for i, v := range r.Comparator {
+		n.primitive("SearchParameter.comparator", i, v, elementAt(r.ComparatorElement, i), "code")
+	}
+	for i, v := range r.Modifier {
+		n.primitive("SearchParameter.modifier", i, v, elementAt(r.ModifierElement, i), "code")
+	}
+	for i, v := range r.Chain {
+		n.primitive("SearchParameter.chain", i, v, elementAt(r.ChainElement, i), "string")
+	}
+	for i, v := range r.Component {
+		n.element("SearchParameter.component", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SearchParameter as BSON document with the structure of its FHIR JSON
+func (r SearchParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SearchParameter from a BSON document with the structure of its FHIR JSON
+func (r *SearchParameter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SearchParameter as protobuf message SearchParameter of fhir.proto
+func (r SearchParameter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SearchParameter to the encoded message
+func (r SearchParameter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != "" {
+		e.string(9, r.Url)
+	}
+	if r.Version != nil {
+		e.string(10, *r.Version)
+	}
+	if r.Name != "" {
+		e.string(11, r.Name)
+	}
+	if r.DerivedFrom != nil {
+		e.string(12, *r.DerivedFrom)
+	}
+	e.varint(13, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(14, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(15, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(16, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(17, v)
+	}
+	if r.Description != "" {
+		e.string(18, r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(19, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(20, v)
+	}
+	if r.Purpose != nil {
+		e.string(21, *r.Purpose)
+	}
+	if r.Code != "" {
+		e.string(22, r.Code)
+	}
+	if len(r.Base) > 0 {
+		var p protoEncoder
+		for _, v := range r.Base {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(23, p.buf)
+	}
+	e.varint(24, r.Type.protoNumber())
+	if r.Expression != nil {
+		e.string(25, *r.Expression)
+	}
+	if r.Xpath != nil {
+		e.string(26, *r.Xpath)
+	}
+	if r.XpathUsage != nil {
+		e.varint(27, r.XpathUsage.protoNumber())
+	}
+	if len(r.Target) > 0 {
+		var p protoEncoder
+		for _, v := range r.Target {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(28, p.buf)
+	}
+	if r.MultipleOr != nil {
+		e.varint(29, protoBool(*r.MultipleOr))
+	}
+	if r.MultipleAnd != nil {
+		e.varint(30, protoBool(*r.MultipleAnd))
+	}
+	if len(r.Comparator) > 0 {
+		var p protoEncoder
+		for _, v := range r.Comparator {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(31, p.buf)
+	}
+	if len(r.Modifier) > 0 {
+		var p protoEncoder
+		for _, v := range r.Modifier {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(32, p.buf)
+	}
+	for _, v := range r.Chain {
+		e.string(33, v)
+	}
+	for _, v := range r.Component {
+		e.message(34, v)
+	}
+	if r.IdElement != nil {
+		e.message(35, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(36, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(37, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(38, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(39, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(40, *r.NameElement)
+	}
+	if r.DerivedFromElement != nil {
+		e.message(41, *r.DerivedFromElement)
+	}
+	if r.StatusElement != nil {
+		e.message(42, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(43, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(44, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(45, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(46, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(47, *r.PurposeElement)
+	}
+	if r.CodeElement != nil {
+		e.message(48, *r.CodeElement)
+	}
+	for _, v := range r.BaseElement {
+		e.element(49, v)
+	}
+	if r.TypeElement != nil {
+		e.message(50, *r.TypeElement)
+	}
+	if r.ExpressionElement != nil {
+		e.message(51, *r.ExpressionElement)
+	}
+	if r.XpathElement != nil {
+		e.message(52, *r.XpathElement)
+	}
+	if r.XpathUsageElement != nil {
+		e.message(53, *r.XpathUsageElement)
+	}
+	for _, v := range r.TargetElement {
+		e.element(54, v)
+	}
+	if r.MultipleOrElement != nil {
+		e.message(55, *r.MultipleOrElement)
+	}
+	if r.MultipleAndElement != nil {
+		e.message(56, *r.MultipleAndElement)
+	}
+	for _, v := range r.ComparatorElement {
+		e.element(57, v)
+	}
+	for _, v := range r.ModifierElement {
+		e.element(58, v)
+	}
+	for _, v := range r.ChainElement {
+		e.element(59, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given SearchParameter from protobuf message SearchParameter of fhir.proto
+func (r *SearchParameter) UnmarshalProto(b []byte) error {
+	*r = SearchParameter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			r.Url = d.string()
+		case 10:
+			v := d.string()
+			r.Version = &v
+		case 11:
+			r.Name = d.string()
+		case 12:
+			v := d.string()
+			r.DerivedFrom = &v
+		case 13:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 14:
+			v := d.bool()
+			r.Experimental = &v
+		case 15:
+			v := d.string()
+			r.Date = &v
+		case 16:
+			v := d.string()
+			r.Publisher = &v
+		case 17:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 18:
+			r.Description = d.string()
+		case 19:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 21:
+			v := d.string()
+			r.Purpose = &v
+		case 22:
+			r.Code = d.string()
+		case 23:
+			for _, n := range d.varints() {
+				var v ResourceType
+				d.enum(n, v.setProtoNumber)
+				r.Base = append(r.Base, v)
+			}
+		case 24:
+			var v SearchParamType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 25:
+			v := d.string()
+			r.Expression = &v
+		case 26:
+			v := d.string()
+			r.Xpath = &v
+		case 27:
+			var v XPathUsageType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.XpathUsage = &v
+		case 28:
+			for _, n := range d.varints() {
+				var v ResourceType
+				d.enum(n, v.setProtoNumber)
+				r.Target = append(r.Target, v)
+			}
+		case 29:
+			v := d.bool()
+			r.MultipleOr = &v
+		case 30:
+			v := d.bool()
+			r.MultipleAnd = &v
+		case 31:
+			for _, n := range d.varints() {
+				var v SearchComparator
+				d.enum(n, v.setProtoNumber)
+				r.Comparator = append(r.Comparator, v)
+			}
+		case 32:
+			for _, n := range d.varints() {
+				var v SearchModifierCode
+				d.enum(n, v.setProtoNumber)
+				r.Modifier = append(r.Modifier, v)
+			}
+		case 33:
+			r.Chain = append(r.Chain, d.string())
+		case 34:
+			var v SearchParameterComponent
+			d.message(&v)
+			r.Component = append(r.Component, v)
+		case 35:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.DerivedFromElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 49:
+			r.BaseElement = append(r.BaseElement, d.element())
+		case 50:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.ExpressionElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.XpathElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.XpathUsageElement = &v
+		case 54:
+			r.TargetElement = append(r.TargetElement, d.element())
+		case 55:
+			var v Element
+			d.message(&v)
+			r.MultipleOrElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.MultipleAndElement = &v
+		case 57:
+			r.ComparatorElement = append(r.ComparatorElement, d.element())
+		case 58:
+			r.ModifierElement = append(r.ModifierElement, d.element())
+		case 59:
+			r.ChainElement = append(r.ChainElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SearchParameterComponent as JSON into a byte slice
+func (r SearchParameterComponent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SearchParameterComponent as JSON object with the members in the order of the fields
+func (r SearchParameterComponent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("definition")
+	e.string(r.Definition)
+	if r.DefinitionElement != nil {
+		e.key("_definition")
+		r.DefinitionElement.appendJSON(e)
+	}
+	e.key("expression")
+	e.string(r.Expression)
+	if r.ExpressionElement != nil {
+		e.key("_expression")
+		r.ExpressionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SearchParameterComponent from JSON. Unknown members are ignored.
+func (r *SearchParameterComponent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SearchParameterComponent
+func (r *SearchParameterComponent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "definition":
+			if v, ok := d.string(); ok {
+				r.Definition = v
+			}
+		case "_definition":
+			r.DefinitionElement = d.element()
+		case "expression":
+			if v, ok := d.string(); ok {
+				r.Expression = v
+			}
+		case "_expression":
+			r.ExpressionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SearchParameterComponent which shares no memory with the original
+func (r SearchParameterComponent) DeepCopy() SearchParameterComponent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DefinitionElement != nil {
+		v := r.DefinitionElement.DeepCopy()
+		out.DefinitionElement = &v
+	}
+	if r.ExpressionElement != nil {
+		v := r.ExpressionElement.DeepCopy()
+		out.ExpressionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SearchParameterComponent) Equal(other SearchParameterComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Definition != other.Definition {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.Equal(*other.DefinitionElement) {
+		return false
+	}
+	if r.Expression != other.Expression {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.Equal(*other.ExpressionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SearchParameterComponent) EqualsDeep(other SearchParameterComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Definition, other.Definition) {
+		return false
+	}
+	if (r.DefinitionElement == nil) != (other.DefinitionElement == nil) || r.DefinitionElement != nil && !r.DefinitionElement.EqualsDeep(*other.DefinitionElement) {
+		return false
+	}
+	if !equivalentString(r.Expression, other.Expression) {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.EqualsDeep(*other.ExpressionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SearchParameterComponent) EqualsShallow(other SearchParameterComponent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Definition, other.Definition) {
+		return false
+	}
+	if !equivalentString(r.Expression, other.Expression) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SearchParameterComponent as FHIR XML
+func (r SearchParameterComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expression", r.Expression, r.ExpressionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SearchParameterComponent from FHIR XML
+func (r *SearchParameterComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "definition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Definition = v
+				}
+				r.DefinitionElement = element
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Expression = v
+				}
+				r.ExpressionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SearchParameterComponent as properties to the RDF node n
+func (r SearchParameterComponent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("SearchParameter.component.definition", -1, r.Definition, r.DefinitionElement, "string")
+	n.primitive("SearchParameter.component.expression", -1, r.Expression, r.ExpressionElement, "string")
+}
+
+// MarshalBSON marshals the given SearchParameterComponent as BSON document with the structure of its FHIR JSON
+func (r SearchParameterComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SearchParameterComponent from a BSON document with the structure of its FHIR JSON
+func (r *SearchParameterComponent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SearchParameterComponent as protobuf message SearchParameterComponent of fhir.proto
+func (r SearchParameterComponent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SearchParameterComponent to the encoded message
+func (r SearchParameterComponent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Definition != "" {
+		e.string(4, r.Definition)
+	}
+	if r.Expression != "" {
+		e.string(5, r.Expression)
+	}
+	if r.DefinitionElement != nil {
+		e.message(6, *r.DefinitionElement)
+	}
+	if r.ExpressionElement != nil {
+		e.message(7, *r.ExpressionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SearchParameterComponent from protobuf message SearchParameterComponent of fhir.proto
+func (r *SearchParameterComponent) UnmarshalProto(b []byte) error {
+	*r = SearchParameterComponent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Definition = d.string()
+		case 5:
+			r.Expression = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.DefinitionElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ExpressionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSearchParameter unmarshals a SearchParameter.
 func UnmarshalSearchParameter(b []byte) (SearchParameter, error) {
 	var searchParameter SearchParameter
-	if err := json.Unmarshal(b, &searchParameter); err != nil {
+	if err := searchParameter.UnmarshalJSON(b); err != nil {
 		return searchParameter, err
 	}
 	return searchParameter, nil
@@ -14,76 +14,2898 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // ServiceRequest is documented here http://hl7.org/fhir/StructureDefinition/ServiceRequest
 type ServiceRequest struct {
-	Id                      *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                    *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules           *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language                *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                    *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension               []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier              []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical   []string          `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri         []string          `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn                 []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	Replaces                []Reference       `bson:"replaces,omitempty" json:"replaces,omitempty"`
-	Requisition             *Identifier       `bson:"requisition,omitempty" json:"requisition,omitempty"`
-	Status                  RequestStatus     `bson:"status" json:"status"`
-	Intent                  RequestIntent     `bson:"intent" json:"intent"`
-	Category                []CodeableConcept `bson:"category,omitempty" json:"category,omitempty"`
-	Priority                *RequestPriority  `bson:"priority,omitempty" json:"priority,omitempty"`
-	DoNotPerform            *bool             `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
-	Code                    *CodeableConcept  `bson:"code,omitempty" json:"code,omitempty"`
-	OrderDetail             []CodeableConcept `bson:"orderDetail,omitempty" json:"orderDetail,omitempty"`
-	QuantityQuantity        *Quantity         `bson:"quantityQuantity,omitempty" json:"quantityQuantity,omitempty"`
-	QuantityRatio           *Ratio            `bson:"quantityRatio,omitempty" json:"quantityRatio,omitempty"`
-	QuantityRange           *Range            `bson:"quantityRange,omitempty" json:"quantityRange,omitempty"`
-	Subject                 Reference         `bson:"subject" json:"subject"`
-	Encounter               *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	OccurrenceDateTime      *string           `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod        *Period           `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming        *Timing           `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
-	AsNeededBoolean         *bool             `bson:"asNeededBoolean,omitempty" json:"asNeededBoolean,omitempty"`
-	AsNeededCodeableConcept *CodeableConcept  `bson:"asNeededCodeableConcept,omitempty" json:"asNeededCodeableConcept,omitempty"`
-	AuthoredOn              *string           `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	Requester               *Reference        `bson:"requester,omitempty" json:"requester,omitempty"`
-	PerformerType           *CodeableConcept  `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	Performer               []Reference       `bson:"performer,omitempty" json:"performer,omitempty"`
-	LocationCode            []CodeableConcept `bson:"locationCode,omitempty" json:"locationCode,omitempty"`
-	LocationReference       []Reference       `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
-	ReasonCode              []CodeableConcept `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference         []Reference       `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Insurance               []Reference       `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	SupportingInfo          []Reference       `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
-	Specimen                []Reference       `bson:"specimen,omitempty" json:"specimen,omitempty"`
-	BodySite                []CodeableConcept `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
-	Note                    []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
-	PatientInstruction      *string           `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
-	RelevantHistory         []Reference       `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
-}
-type OtherServiceRequest ServiceRequest
+	Id                           *string           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        []string          `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*Element        `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string          `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*Element        `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	Replaces                     []Reference       `bson:"replaces,omitempty" json:"replaces,omitempty"`
+	Requisition                  *Identifier       `bson:"requisition,omitempty" json:"requisition,omitempty"`
+	Status                       RequestStatus     `bson:"status" json:"status"`
+	StatusElement                *Element          `bson:"_status,omitempty" json:"_status,omitempty"`
+	Intent                       RequestIntent     `bson:"intent" json:"intent"`
+	IntentElement                *Element          `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Category                     []CodeableConcept `bson:"category,omitempty" json:"category,omitempty"`
+	Priority                     *RequestPriority  `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element          `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	DoNotPerform                 *bool             `bson:"doNotPerform,omitempty" json:"doNotPerform,omitempty"`
+	DoNotPerformElement          *Element          `bson:"_doNotPerform,omitempty" json:"_doNotPerform,omitempty"`
+	Code                         *CodeableConcept  `bson:"code,omitempty" json:"code,omitempty"`
+	OrderDetail                  []CodeableConcept `bson:"orderDetail,omitempty" json:"orderDetail,omitempty"`
+	QuantityQuantity             *Quantity         `bson:"quantityQuantity,omitempty" json:"quantityQuantity,omitempty"`
+	QuantityRatio                *Ratio            `bson:"quantityRatio,omitempty" json:"quantityRatio,omitempty"`
+	QuantityRange                *Range            `bson:"quantityRange,omitempty" json:"quantityRange,omitempty"`
+	Subject                      Reference         `bson:"subject" json:"subject"`
+	Encounter                    *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	OccurrenceDateTime           *string           `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement    *Element          `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod             *Period           `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming             *Timing           `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	AsNeededBoolean              *bool             `bson:"asNeededBoolean,omitempty" json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement       *Element          `bson:"_asNeededBoolean,omitempty" json:"_asNeededBoolean,omitempty"`
+	AsNeededCodeableConcept      *CodeableConcept  `bson:"asNeededCodeableConcept,omitempty" json:"asNeededCodeableConcept,omitempty"`
+	AuthoredOn                   *string           `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element          `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	Requester                    *Reference        `bson:"requester,omitempty" json:"requester,omitempty"`
+	PerformerType                *CodeableConcept  `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	Performer                    []Reference       `bson:"performer,omitempty" json:"performer,omitempty"`
+	LocationCode                 []CodeableConcept `bson:"locationCode,omitempty" json:"locationCode,omitempty"`
+	LocationReference            []Reference       `bson:"locationReference,omitempty" json:"locationReference,omitempty"`
+	ReasonCode                   []CodeableConcept `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              []Reference       `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Insurance                    []Reference       `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	SupportingInfo               []Reference       `bson:"supportingInfo,omitempty" json:"supportingInfo,omitempty"`
+	Specimen                     []Reference       `bson:"specimen,omitempty" json:"specimen,omitempty"`
+	BodySite                     []CodeableConcept `bson:"bodySite,omitempty" json:"bodySite,omitempty"`
+	Note                         []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
+	PatientInstruction           *string           `bson:"patientInstruction,omitempty" json:"patientInstruction,omitempty"`
+	PatientInstructionElement    *Element          `bson:"_patientInstruction,omitempty" json:"_patientInstruction,omitempty"`
+	RelevantHistory              []Reference       `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
+}
+
+// ServiceRequestQuantity is implemented by the types allowed for ServiceRequest.quantity[x]
+type ServiceRequestQuantity interface {
+	isServiceRequestQuantity()
+}
+
+func (Quantity) isServiceRequestQuantity() {}
+func (Ratio) isServiceRequestQuantity()    {}
+func (Range) isServiceRequestQuantity()    {}
+
+// Quantity returns the value of ServiceRequest.quantity[x] together with its FHIR type code or nil if no value is set
+func (r ServiceRequest) Quantity() (ServiceRequestQuantity, string) {
+	if r.QuantityQuantity != nil {
+		return *r.QuantityQuantity, "Quantity"
+	}
+	if r.QuantityRatio != nil {
+		return *r.QuantityRatio, "Ratio"
+	}
+	if r.QuantityRange != nil {
+		return *r.QuantityRange, "Range"
+	}
+	return nil, ""
+}
+
+// SetQuantity sets the value of ServiceRequest.quantity[x], given as value or pointer, and clears all other types
+func (r *ServiceRequest) SetQuantity(value ServiceRequestQuantity) {
+	r.QuantityQuantity = nil
+	r.QuantityRatio = nil
+	r.QuantityRange = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.QuantityQuantity = &v
+	case *Quantity:
+		r.QuantityQuantity = v
+	case Ratio:
+		r.QuantityRatio = &v
+	case *Ratio:
+		r.QuantityRatio = v
+	case Range:
+		r.QuantityRange = &v
+	case *Range:
+		r.QuantityRange = v
+	}
+}
+
+// ServiceRequestOccurrence is implemented by the types allowed for ServiceRequest.occurrence[x]
+type ServiceRequestOccurrence interface {
+	isServiceRequestOccurrence()
+}
+
+func (DateTime) isServiceRequestOccurrence() {}
+func (Period) isServiceRequestOccurrence()   {}
+func (Timing) isServiceRequestOccurrence()   {}
+
+// Occurrence returns the value of ServiceRequest.occurrence[x] together with its FHIR type code or nil if no value is set
+func (r ServiceRequest) Occurrence() (ServiceRequestOccurrence, string) {
+	if r.OccurrenceDateTime != nil {
+		return DateTime(*r.OccurrenceDateTime), "dateTime"
+	}
+	if r.OccurrencePeriod != nil {
+		return *r.OccurrencePeriod, "Period"
+	}
+	if r.OccurrenceTiming != nil {
+		return *r.OccurrenceTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetOccurrence sets the value of ServiceRequest.occurrence[x], given as value or pointer, and clears all other types
+func (r *ServiceRequest) SetOccurrence(value ServiceRequestOccurrence) {
+	r.OccurrenceDateTime = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OccurrenceDateTime = &primitive
+	case *DateTime:
+		r.OccurrenceDateTime = (*string)(v)
+	case Period:
+		r.OccurrencePeriod = &v
+	case *Period:
+		r.OccurrencePeriod = v
+	case Timing:
+		r.OccurrenceTiming = &v
+	case *Timing:
+		r.OccurrenceTiming = v
+	}
+}
+
+// ServiceRequestAsNeeded is implemented by the types allowed for ServiceRequest.asNeeded[x]
+type ServiceRequestAsNeeded interface {
+	isServiceRequestAsNeeded()
+}
+
+func (Boolean) isServiceRequestAsNeeded()         {}
+func (CodeableConcept) isServiceRequestAsNeeded() {}
+
+// AsNeeded returns the value of ServiceRequest.asNeeded[x] together with its FHIR type code or nil if no value is set
+func (r ServiceRequest) AsNeeded() (ServiceRequestAsNeeded, string) {
+	if r.AsNeededBoolean != nil {
+		return Boolean(*r.AsNeededBoolean), "boolean"
+	}
+	if r.AsNeededCodeableConcept != nil {
+		return *r.AsNeededCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetAsNeeded sets the value of ServiceRequest.asNeeded[x], given as value or pointer, and clears all other types
+func (r *ServiceRequest) SetAsNeeded(value ServiceRequestAsNeeded) {
+	r.AsNeededBoolean = nil
+	r.AsNeededCodeableConcept = nil
+	switch v := value.(type) {
+	case Boolean:
+		primitive := bool(v)
+		r.AsNeededBoolean = &primitive
+	case *Boolean:
+		r.AsNeededBoolean = (*bool)(v)
+	case CodeableConcept:
+		r.AsNeededCodeableConcept = &v
+	case *CodeableConcept:
+		r.AsNeededCodeableConcept = v
+	}
+}
 
 // MarshalJSON marshals the given ServiceRequest as JSON into a byte slice
 func (r ServiceRequest) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherServiceRequest
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherServiceRequest: OtherServiceRequest(r),
-		ResourceType:        "ServiceRequest",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the ServiceRequest as JSON object with the members in the order of the fields
+func (r ServiceRequest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"ServiceRequest\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonical) > 0 {
+		e.key("instantiatesCanonical")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesCanonical {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesCanonicalElement) > 0 {
+		e.key("_instantiatesCanonical")
+		e.elements(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical))
+	}
+	if len(r.InstantiatesUri) > 0 {
+		e.key("instantiatesUri")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.InstantiatesUri {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.InstantiatesUriElement) > 0 {
+		e.key("_instantiatesUri")
+		e.elements(r.InstantiatesUriElement, len(r.InstantiatesUri))
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Replaces) > 0 {
+		e.key("replaces")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Replaces {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Requisition != nil {
+		e.key("requisition")
+		r.Requisition.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("intent")
+	e.string(r.Intent.Code())
+	if r.IntentElement != nil {
+		e.key("_intent")
+		r.IntentElement.appendJSON(e)
+	}
+	if len(r.Category) > 0 {
+		e.key("category")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Category {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if r.DoNotPerform != nil {
+		e.key("doNotPerform")
+		e.bool(*r.DoNotPerform)
+	}
+	if r.DoNotPerformElement != nil {
+		e.key("_doNotPerform")
+		r.DoNotPerformElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if len(r.OrderDetail) > 0 {
+		e.key("orderDetail")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.OrderDetail {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.QuantityQuantity != nil {
+		e.key("quantityQuantity")
+		r.QuantityQuantity.appendJSON(e)
+	}
+	if r.QuantityRatio != nil {
+		e.key("quantityRatio")
+		r.QuantityRatio.appendJSON(e)
+	}
+	if r.QuantityRange != nil {
+		e.key("quantityRange")
+		r.QuantityRange.appendJSON(e)
+	}
+	e.key("subject")
+	r.Subject.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.key("occurrenceDateTime")
+		e.string(*r.OccurrenceDateTime)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.key("_occurrenceDateTime")
+		r.OccurrenceDateTimeElement.appendJSON(e)
+	}
+	if r.OccurrencePeriod != nil {
+		e.key("occurrencePeriod")
+		r.OccurrencePeriod.appendJSON(e)
+	}
+	if r.OccurrenceTiming != nil {
+		e.key("occurrenceTiming")
+		r.OccurrenceTiming.appendJSON(e)
+	}
+	if r.AsNeededBoolean != nil {
+		e.key("asNeededBoolean")
+		e.bool(*r.AsNeededBoolean)
+	}
+	if r.AsNeededBooleanElement != nil {
+		e.key("_asNeededBoolean")
+		r.AsNeededBooleanElement.appendJSON(e)
+	}
+	if r.AsNeededCodeableConcept != nil {
+		e.key("asNeededCodeableConcept")
+		r.AsNeededCodeableConcept.appendJSON(e)
+	}
+	if r.AuthoredOn != nil {
+		e.key("authoredOn")
+		e.string(*r.AuthoredOn)
+	}
+	if r.AuthoredOnElement != nil {
+		e.key("_authoredOn")
+		r.AuthoredOnElement.appendJSON(e)
+	}
+	if r.Requester != nil {
+		e.key("requester")
+		r.Requester.appendJSON(e)
+	}
+	if r.PerformerType != nil {
+		e.key("performerType")
+		r.PerformerType.appendJSON(e)
+	}
+	if len(r.Performer) > 0 {
+		e.key("performer")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Performer {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LocationCode) > 0 {
+		e.key("locationCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LocationCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LocationReference) > 0 {
+		e.key("locationReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LocationReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonCode) > 0 {
+		e.key("reasonCode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonCode {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ReasonReference) > 0 {
+		e.key("reasonReference")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ReasonReference {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Insurance) > 0 {
+		e.key("insurance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Insurance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.SupportingInfo) > 0 {
+		e.key("supportingInfo")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.SupportingInfo {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Specimen) > 0 {
+		e.key("specimen")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Specimen {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BodySite) > 0 {
+		e.key("bodySite")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BodySite {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.PatientInstruction != nil {
+		e.key("patientInstruction")
+		e.string(*r.PatientInstruction)
+	}
+	if r.PatientInstructionElement != nil {
+		e.key("_patientInstruction")
+		r.PatientInstructionElement.appendJSON(e)
+	}
+	if len(r.RelevantHistory) > 0 {
+		e.key("relevantHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelevantHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given ServiceRequest from JSON. Unknown members are ignored.
+func (r *ServiceRequest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the ServiceRequest
+func (r *ServiceRequest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonical = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+			}
+			if r.InstantiatesCanonical == nil && !a.isNull {
+				r.InstantiatesCanonical = []string{}
+			}
+		case "_instantiatesCanonical":
+			a := d.array()
+			r.InstantiatesCanonicalElement = nil
+			for a.next() {
+				r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+			}
+		case "instantiatesUri":
+			a := d.array()
+			r.InstantiatesUri = nil
+			for a.next() {
+				v, _ := d.string()
+				r.InstantiatesUri = append(r.InstantiatesUri, v)
+			}
+			if r.InstantiatesUri == nil && !a.isNull {
+				r.InstantiatesUri = []string{}
+			}
+		case "_instantiatesUri":
+			a := d.array()
+			r.InstantiatesUriElement = nil
+			for a.next() {
+				r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "replaces":
+			a := d.array()
+			r.Replaces = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Replaces = append(r.Replaces, v)
+			}
+			if r.Replaces == nil && !a.isNull {
+				r.Replaces = []Reference{}
+			}
+		case "requisition":
+			if d.null() {
+				r.Requisition = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Requisition = &v
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "intent":
+			d.unmarshal(&r.Intent)
+		case "_intent":
+			r.IntentElement = d.element()
+		case "category":
+			a := d.array()
+			r.Category = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = append(r.Category, v)
+			}
+			if r.Category == nil && !a.isNull {
+				r.Category = []CodeableConcept{}
+			}
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "doNotPerform":
+			if v, ok := d.bool(); ok {
+				r.DoNotPerform = &v
+			} else {
+				r.DoNotPerform = nil
+			}
+		case "_doNotPerform":
+			r.DoNotPerformElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "orderDetail":
+			a := d.array()
+			r.OrderDetail = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OrderDetail = append(r.OrderDetail, v)
+			}
+			if r.OrderDetail == nil && !a.isNull {
+				r.OrderDetail = []CodeableConcept{}
+			}
+		case "quantityQuantity":
+			if d.null() {
+				r.QuantityQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.QuantityQuantity = &v
+			}
+		case "quantityRatio":
+			if d.null() {
+				r.QuantityRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.QuantityRatio = &v
+			}
+		case "quantityRange":
+			if d.null() {
+				r.QuantityRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.QuantityRange = &v
+			}
+		case "subject":
+			r.Subject.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "occurrenceDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurrenceDateTime = &v
+			} else {
+				r.OccurrenceDateTime = nil
+			}
+		case "_occurrenceDateTime":
+			r.OccurrenceDateTimeElement = d.element()
+		case "occurrencePeriod":
+			if d.null() {
+				r.OccurrencePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OccurrencePeriod = &v
+			}
+		case "occurrenceTiming":
+			if d.null() {
+				r.OccurrenceTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.OccurrenceTiming = &v
+			}
+		case "asNeededBoolean":
+			if v, ok := d.bool(); ok {
+				r.AsNeededBoolean = &v
+			} else {
+				r.AsNeededBoolean = nil
+			}
+		case "_asNeededBoolean":
+			r.AsNeededBooleanElement = d.element()
+		case "asNeededCodeableConcept":
+			if d.null() {
+				r.AsNeededCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AsNeededCodeableConcept = &v
+			}
+		case "authoredOn":
+			if v, ok := d.string(); ok {
+				r.AuthoredOn = &v
+			} else {
+				r.AuthoredOn = nil
+			}
+		case "_authoredOn":
+			r.AuthoredOnElement = d.element()
+		case "requester":
+			if d.null() {
+				r.Requester = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Requester = &v
+			}
+		case "performerType":
+			if d.null() {
+				r.PerformerType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PerformerType = &v
+			}
+		case "performer":
+			a := d.array()
+			r.Performer = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Performer = append(r.Performer, v)
+			}
+			if r.Performer == nil && !a.isNull {
+				r.Performer = []Reference{}
+			}
+		case "locationCode":
+			a := d.array()
+			r.LocationCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.LocationCode = append(r.LocationCode, v)
+			}
+			if r.LocationCode == nil && !a.isNull {
+				r.LocationCode = []CodeableConcept{}
+			}
+		case "locationReference":
+			a := d.array()
+			r.LocationReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.LocationReference = append(r.LocationReference, v)
+			}
+			if r.LocationReference == nil && !a.isNull {
+				r.LocationReference = []Reference{}
+			}
+		case "reasonCode":
+			a := d.array()
+			r.ReasonCode = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = append(r.ReasonCode, v)
+			}
+			if r.ReasonCode == nil && !a.isNull {
+				r.ReasonCode = []CodeableConcept{}
+			}
+		case "reasonReference":
+			a := d.array()
+			r.ReasonReference = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = append(r.ReasonReference, v)
+			}
+			if r.ReasonReference == nil && !a.isNull {
+				r.ReasonReference = []Reference{}
+			}
+		case "insurance":
+			a := d.array()
+			r.Insurance = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Insurance = append(r.Insurance, v)
+			}
+			if r.Insurance == nil && !a.isNull {
+				r.Insurance = []Reference{}
+			}
+		case "supportingInfo":
+			a := d.array()
+			r.SupportingInfo = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			}
+			if r.SupportingInfo == nil && !a.isNull {
+				r.SupportingInfo = []Reference{}
+			}
+		case "specimen":
+			a := d.array()
+			r.Specimen = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Specimen = append(r.Specimen, v)
+			}
+			if r.Specimen == nil && !a.isNull {
+				r.Specimen = []Reference{}
+			}
+		case "bodySite":
+			a := d.array()
+			r.BodySite = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = append(r.BodySite, v)
+			}
+			if r.BodySite == nil && !a.isNull {
+				r.BodySite = []CodeableConcept{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "patientInstruction":
+			if v, ok := d.string(); ok {
+				r.PatientInstruction = &v
+			} else {
+				r.PatientInstruction = nil
+			}
+		case "_patientInstruction":
+			r.PatientInstructionElement = d.element()
+		case "relevantHistory":
+			a := d.array()
+			r.RelevantHistory = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.RelevantHistory = append(r.RelevantHistory, v)
+			}
+			if r.RelevantHistory == nil && !a.isNull {
+				r.RelevantHistory = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the ServiceRequest which shares no memory with the original
+func (r ServiceRequest) DeepCopy() ServiceRequest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		out.InstantiatesCanonical = make([]string, len(r.InstantiatesCanonical))
+		copy(out.InstantiatesCanonical, r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		out.InstantiatesCanonicalElement = make([]*Element, len(r.InstantiatesCanonicalElement))
+		for i, v := range r.InstantiatesCanonicalElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesCanonicalElement[i] = &c
+			}
+		}
+	}
+	if r.InstantiatesUri != nil {
+		out.InstantiatesUri = make([]string, len(r.InstantiatesUri))
+		copy(out.InstantiatesUri, r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		out.InstantiatesUriElement = make([]*Element, len(r.InstantiatesUriElement))
+		for i, v := range r.InstantiatesUriElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.InstantiatesUriElement[i] = &c
+			}
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.Replaces != nil {
+		out.Replaces = make([]Reference, len(r.Replaces))
+		for i := range r.Replaces {
+			out.Replaces[i] = r.Replaces[i].DeepCopy()
+		}
+	}
+	if r.Requisition != nil {
+		v := r.Requisition.DeepCopy()
+		out.Requisition = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.IntentElement != nil {
+		v := r.IntentElement.DeepCopy()
+		out.IntentElement = &v
+	}
+	if r.Category != nil {
+		out.Category = make([]CodeableConcept, len(r.Category))
+		for i := range r.Category {
+			out.Category[i] = r.Category[i].DeepCopy()
+		}
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.DoNotPerform != nil {
+		v := *r.DoNotPerform
+		out.DoNotPerform = &v
+	}
+	if r.DoNotPerformElement != nil {
+		v := r.DoNotPerformElement.DeepCopy()
+		out.DoNotPerformElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.OrderDetail != nil {
+		out.OrderDetail = make([]CodeableConcept, len(r.OrderDetail))
+		for i := range r.OrderDetail {
+			out.OrderDetail[i] = r.OrderDetail[i].DeepCopy()
+		}
+	}
+	if r.QuantityQuantity != nil {
+		v := r.QuantityQuantity.DeepCopy()
+		out.QuantityQuantity = &v
+	}
+	if r.QuantityRatio != nil {
+		v := r.QuantityRatio.DeepCopy()
+		out.QuantityRatio = &v
+	}
+	if r.QuantityRange != nil {
+		v := r.QuantityRange.DeepCopy()
+		out.QuantityRange = &v
+	}
+	out.Subject = r.Subject.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.OccurrenceDateTime != nil {
+		v := *r.OccurrenceDateTime
+		out.OccurrenceDateTime = &v
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		v := r.OccurrenceDateTimeElement.DeepCopy()
+		out.OccurrenceDateTimeElement = &v
+	}
+	if r.OccurrencePeriod != nil {
+		v := r.OccurrencePeriod.DeepCopy()
+		out.OccurrencePeriod = &v
+	}
+	if r.OccurrenceTiming != nil {
+		v := r.OccurrenceTiming.DeepCopy()
+		out.OccurrenceTiming = &v
+	}
+	if r.AsNeededBoolean != nil {
+		v := *r.AsNeededBoolean
+		out.AsNeededBoolean = &v
+	}
+	if r.AsNeededBooleanElement != nil {
+		v := r.AsNeededBooleanElement.DeepCopy()
+		out.AsNeededBooleanElement = &v
+	}
+	if r.AsNeededCodeableConcept != nil {
+		v := r.AsNeededCodeableConcept.DeepCopy()
+		out.AsNeededCodeableConcept = &v
+	}
+	if r.AuthoredOn != nil {
+		v := *r.AuthoredOn
+		out.AuthoredOn = &v
+	}
+	if r.AuthoredOnElement != nil {
+		v := r.AuthoredOnElement.DeepCopy()
+		out.AuthoredOnElement = &v
+	}
+	if r.Requester != nil {
+		v := r.Requester.DeepCopy()
+		out.Requester = &v
+	}
+	if r.PerformerType != nil {
+		v := r.PerformerType.DeepCopy()
+		out.PerformerType = &v
+	}
+	if r.Performer != nil {
+		out.Performer = make([]Reference, len(r.Performer))
+		for i := range r.Performer {
+			out.Performer[i] = r.Performer[i].DeepCopy()
+		}
+	}
+	if r.LocationCode != nil {
+		out.LocationCode = make([]CodeableConcept, len(r.LocationCode))
+		for i := range r.LocationCode {
+			out.LocationCode[i] = r.LocationCode[i].DeepCopy()
+		}
+	}
+	if r.LocationReference != nil {
+		out.LocationReference = make([]Reference, len(r.LocationReference))
+		for i := range r.LocationReference {
+			out.LocationReference[i] = r.LocationReference[i].DeepCopy()
+		}
+	}
+	if r.ReasonCode != nil {
+		out.ReasonCode = make([]CodeableConcept, len(r.ReasonCode))
+		for i := range r.ReasonCode {
+			out.ReasonCode[i] = r.ReasonCode[i].DeepCopy()
+		}
+	}
+	if r.ReasonReference != nil {
+		out.ReasonReference = make([]Reference, len(r.ReasonReference))
+		for i := range r.ReasonReference {
+			out.ReasonReference[i] = r.ReasonReference[i].DeepCopy()
+		}
+	}
+	if r.Insurance != nil {
+		out.Insurance = make([]Reference, len(r.Insurance))
+		for i := range r.Insurance {
+			out.Insurance[i] = r.Insurance[i].DeepCopy()
+		}
+	}
+	if r.SupportingInfo != nil {
+		out.SupportingInfo = make([]Reference, len(r.SupportingInfo))
+		for i := range r.SupportingInfo {
+			out.SupportingInfo[i] = r.SupportingInfo[i].DeepCopy()
+		}
+	}
+	if r.Specimen != nil {
+		out.Specimen = make([]Reference, len(r.Specimen))
+		for i := range r.Specimen {
+			out.Specimen[i] = r.Specimen[i].DeepCopy()
+		}
+	}
+	if r.BodySite != nil {
+		out.BodySite = make([]CodeableConcept, len(r.BodySite))
+		for i := range r.BodySite {
+			out.BodySite[i] = r.BodySite[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.PatientInstruction != nil {
+		v := *r.PatientInstruction
+		out.PatientInstruction = &v
+	}
+	if r.PatientInstructionElement != nil {
+		v := r.PatientInstructionElement.DeepCopy()
+		out.PatientInstructionElement = &v
+	}
+	if r.RelevantHistory != nil {
+		out.RelevantHistory = make([]Reference, len(r.RelevantHistory))
+		for i := range r.RelevantHistory {
+			out.RelevantHistory[i] = r.RelevantHistory[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r ServiceRequest) Equal(other ServiceRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.InstantiatesCanonical) != len(other.InstantiatesCanonical) {
+		return false
+	}
+	for i := range r.InstantiatesCanonical {
+		if r.InstantiatesCanonical[i] != other.InstantiatesCanonical[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, false) {
+		return false
+	}
+	if len(r.InstantiatesUri) != len(other.InstantiatesUri) {
+		return false
+	}
+	for i := range r.InstantiatesUri {
+		if r.InstantiatesUri[i] != other.InstantiatesUri[i] {
+			return false
+		}
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, false) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.Replaces) != len(other.Replaces) {
+		return false
+	}
+	for i := range r.Replaces {
+		if !r.Replaces[i].Equal(other.Replaces[i]) {
+			return false
+		}
+	}
+	if (r.Requisition == nil) != (other.Requisition == nil) || r.Requisition != nil && !r.Requisition.Equal(*other.Requisition) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.Equal(*other.IntentElement) {
+		return false
+	}
+	if len(r.Category) != len(other.Category) {
+		return false
+	}
+	for i := range r.Category {
+		if !r.Category[i].Equal(other.Category[i]) {
+			return false
+		}
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.Equal(*other.DoNotPerformElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if len(r.OrderDetail) != len(other.OrderDetail) {
+		return false
+	}
+	for i := range r.OrderDetail {
+		if !r.OrderDetail[i].Equal(other.OrderDetail[i]) {
+			return false
+		}
+	}
+	if (r.QuantityQuantity == nil) != (other.QuantityQuantity == nil) || r.QuantityQuantity != nil && !r.QuantityQuantity.Equal(*other.QuantityQuantity) {
+		return false
+	}
+	if (r.QuantityRatio == nil) != (other.QuantityRatio == nil) || r.QuantityRatio != nil && !r.QuantityRatio.Equal(*other.QuantityRatio) {
+		return false
+	}
+	if (r.QuantityRange == nil) != (other.QuantityRange == nil) || r.QuantityRange != nil && !r.QuantityRange.Equal(*other.QuantityRange) {
+		return false
+	}
+	if !r.Subject.Equal(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && *r.OccurrenceDateTime != *other.OccurrenceDateTime {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.Equal(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.Equal(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.Equal(*other.OccurrenceTiming) {
+		return false
+	}
+	if (r.AsNeededBoolean == nil) != (other.AsNeededBoolean == nil) || r.AsNeededBoolean != nil && *r.AsNeededBoolean != *other.AsNeededBoolean {
+		return false
+	}
+	if (r.AsNeededBooleanElement == nil) != (other.AsNeededBooleanElement == nil) || r.AsNeededBooleanElement != nil && !r.AsNeededBooleanElement.Equal(*other.AsNeededBooleanElement) {
+		return false
+	}
+	if (r.AsNeededCodeableConcept == nil) != (other.AsNeededCodeableConcept == nil) || r.AsNeededCodeableConcept != nil && !r.AsNeededCodeableConcept.Equal(*other.AsNeededCodeableConcept) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && *r.AuthoredOn != *other.AuthoredOn {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.Equal(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.Equal(*other.Requester) {
+		return false
+	}
+	if (r.PerformerType == nil) != (other.PerformerType == nil) || r.PerformerType != nil && !r.PerformerType.Equal(*other.PerformerType) {
+		return false
+	}
+	if len(r.Performer) != len(other.Performer) {
+		return false
+	}
+	for i := range r.Performer {
+		if !r.Performer[i].Equal(other.Performer[i]) {
+			return false
+		}
+	}
+	if len(r.LocationCode) != len(other.LocationCode) {
+		return false
+	}
+	for i := range r.LocationCode {
+		if !r.LocationCode[i].Equal(other.LocationCode[i]) {
+			return false
+		}
+	}
+	if len(r.LocationReference) != len(other.LocationReference) {
+		return false
+	}
+	for i := range r.LocationReference {
+		if !r.LocationReference[i].Equal(other.LocationReference[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonCode) != len(other.ReasonCode) {
+		return false
+	}
+	for i := range r.ReasonCode {
+		if !r.ReasonCode[i].Equal(other.ReasonCode[i]) {
+			return false
+		}
+	}
+	if len(r.ReasonReference) != len(other.ReasonReference) {
+		return false
+	}
+	for i := range r.ReasonReference {
+		if !r.ReasonReference[i].Equal(other.ReasonReference[i]) {
+			return false
+		}
+	}
+	if len(r.Insurance) != len(other.Insurance) {
+		return false
+	}
+	for i := range r.Insurance {
+		if !r.Insurance[i].Equal(other.Insurance[i]) {
+			return false
+		}
+	}
+	if len(r.SupportingInfo) != len(other.SupportingInfo) {
+		return false
+	}
+	for i := range r.SupportingInfo {
+		if !r.SupportingInfo[i].Equal(other.SupportingInfo[i]) {
+			return false
+		}
+	}
+	if len(r.Specimen) != len(other.Specimen) {
+		return false
+	}
+	for i := range r.Specimen {
+		if !r.Specimen[i].Equal(other.Specimen[i]) {
+			return false
+		}
+	}
+	if len(r.BodySite) != len(other.BodySite) {
+		return false
+	}
+	for i := range r.BodySite {
+		if !r.BodySite[i].Equal(other.BodySite[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if (r.PatientInstruction == nil) != (other.PatientInstruction == nil) || r.PatientInstruction != nil && *r.PatientInstruction != *other.PatientInstruction {
+		return false
+	}
+	if (r.PatientInstructionElement == nil) != (other.PatientInstructionElement == nil) || r.PatientInstructionElement != nil && !r.PatientInstructionElement.Equal(*other.PatientInstructionElement) {
+		return false
+	}
+	if len(r.RelevantHistory) != len(other.RelevantHistory) {
+		return false
+	}
+	for i := range r.RelevantHistory {
+		if !r.RelevantHistory[i].Equal(other.RelevantHistory[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r ServiceRequest) EqualsDeep(other ServiceRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesCanonicalElement, other.InstantiatesCanonicalElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.InstantiatesUriElement, other.InstantiatesUriElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Replaces), len(other.Replaces), func(i, j int) bool {
+		return r.Replaces[i].EqualsDeep(other.Replaces[j])
+	}) {
+		return false
+	}
+	if (r.Requisition == nil) != (other.Requisition == nil) || r.Requisition != nil && !r.Requisition.EqualsDeep(*other.Requisition) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.EqualsDeep(*other.IntentElement) {
+		return false
+	}
+	if !equivalentList(len(r.Category), len(other.Category), func(i, j int) bool {
+		return r.Category[i].EqualsDeep(other.Category[j])
+	}) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.DoNotPerformElement == nil) != (other.DoNotPerformElement == nil) || r.DoNotPerformElement != nil && !r.DoNotPerformElement.EqualsDeep(*other.DoNotPerformElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.OrderDetail), len(other.OrderDetail), func(i, j int) bool {
+		return r.OrderDetail[i].EqualsDeep(other.OrderDetail[j])
+	}) {
+		return false
+	}
+	if (r.QuantityQuantity == nil) != (other.QuantityQuantity == nil) || r.QuantityQuantity != nil && !r.QuantityQuantity.EqualsDeep(*other.QuantityQuantity) {
+		return false
+	}
+	if (r.QuantityRatio == nil) != (other.QuantityRatio == nil) || r.QuantityRatio != nil && !r.QuantityRatio.EqualsDeep(*other.QuantityRatio) {
+		return false
+	}
+	if (r.QuantityRange == nil) != (other.QuantityRange == nil) || r.QuantityRange != nil && !r.QuantityRange.EqualsDeep(*other.QuantityRange) {
+		return false
+	}
+	if !r.Subject.EqualsDeep(other.Subject) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.EqualsDeep(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.EqualsDeep(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.EqualsDeep(*other.OccurrenceTiming) {
+		return false
+	}
+	if (r.AsNeededBoolean == nil) != (other.AsNeededBoolean == nil) || r.AsNeededBoolean != nil && *r.AsNeededBoolean != *other.AsNeededBoolean {
+		return false
+	}
+	if (r.AsNeededBooleanElement == nil) != (other.AsNeededBooleanElement == nil) || r.AsNeededBooleanElement != nil && !r.AsNeededBooleanElement.EqualsDeep(*other.AsNeededBooleanElement) {
+		return false
+	}
+	if (r.AsNeededCodeableConcept == nil) != (other.AsNeededCodeableConcept == nil) || r.AsNeededCodeableConcept != nil && !r.AsNeededCodeableConcept.EqualsDeep(*other.AsNeededCodeableConcept) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.EqualsDeep(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.EqualsDeep(*other.Requester) {
+		return false
+	}
+	if (r.PerformerType == nil) != (other.PerformerType == nil) || r.PerformerType != nil && !r.PerformerType.EqualsDeep(*other.PerformerType) {
+		return false
+	}
+	if !equivalentList(len(r.Performer), len(other.Performer), func(i, j int) bool {
+		return r.Performer[i].EqualsDeep(other.Performer[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.LocationCode), len(other.LocationCode), func(i, j int) bool {
+		return r.LocationCode[i].EqualsDeep(other.LocationCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.LocationReference), len(other.LocationReference), func(i, j int) bool {
+		return r.LocationReference[i].EqualsDeep(other.LocationReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonCode), len(other.ReasonCode), func(i, j int) bool {
+		return r.ReasonCode[i].EqualsDeep(other.ReasonCode[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ReasonReference), len(other.ReasonReference), func(i, j int) bool {
+		return r.ReasonReference[i].EqualsDeep(other.ReasonReference[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Insurance), len(other.Insurance), func(i, j int) bool {
+		return r.Insurance[i].EqualsDeep(other.Insurance[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.SupportingInfo), len(other.SupportingInfo), func(i, j int) bool {
+		return r.SupportingInfo[i].EqualsDeep(other.SupportingInfo[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Specimen), len(other.Specimen), func(i, j int) bool {
+		return r.Specimen[i].EqualsDeep(other.Specimen[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BodySite), len(other.BodySite), func(i, j int) bool {
+		return r.BodySite[i].EqualsDeep(other.BodySite[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if (r.PatientInstruction == nil) != (other.PatientInstruction == nil) || r.PatientInstruction != nil && !equivalentString(*r.PatientInstruction, *other.PatientInstruction) {
+		return false
+	}
+	if (r.PatientInstructionElement == nil) != (other.PatientInstructionElement == nil) || r.PatientInstructionElement != nil && !r.PatientInstructionElement.EqualsDeep(*other.PatientInstructionElement) {
+		return false
+	}
+	if !equivalentList(len(r.RelevantHistory), len(other.RelevantHistory), func(i, j int) bool {
+		return r.RelevantHistory[i].EqualsDeep(other.RelevantHistory[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r ServiceRequest) EqualsShallow(other ServiceRequest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesCanonical), len(other.InstantiatesCanonical), func(i, j int) bool {
+		return equivalentString(r.InstantiatesCanonical[i], other.InstantiatesCanonical[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.InstantiatesUri), len(other.InstantiatesUri), func(i, j int) bool {
+		return equivalentString(r.InstantiatesUri[i], other.InstantiatesUri[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.DoNotPerform == nil) != (other.DoNotPerform == nil) || r.DoNotPerform != nil && *r.DoNotPerform != *other.DoNotPerform {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.AsNeededBoolean == nil) != (other.AsNeededBoolean == nil) || r.AsNeededBoolean != nil && *r.AsNeededBoolean != *other.AsNeededBoolean {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	if (r.PatientInstruction == nil) != (other.PatientInstruction == nil) || r.PatientInstruction != nil && !equivalentString(*r.PatientInstruction, *other.PatientInstruction) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given ServiceRequest as FHIR XML
+func (r ServiceRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "ServiceRequest",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesCanonical {
+		if err := encodeXMLPrimitive(e, "instantiatesCanonical", v, elementAt(r.InstantiatesCanonicalElement, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.InstantiatesUri {
+		if err := encodeXMLPrimitive(e, "instantiatesUri", v, elementAt(r.InstantiatesUriElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Replaces {
+		if err := encodeXMLElement(e, "replaces", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "requisition", r.Requisition); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "intent", r.Intent, r.IntentElement); err != nil {
+		return err
+	}
+	for _, v := range r.Category {
+		if err := encodeXMLElement(e, "category", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "doNotPerform", r.DoNotPerform, r.DoNotPerformElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	for _, v := range r.OrderDetail {
+		if err := encodeXMLElement(e, "orderDetail", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantityQuantity", r.QuantityQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantityRatio", r.QuantityRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantityRange", r.QuantityRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceDateTime", r.OccurrenceDateTime, r.OccurrenceDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrencePeriod", r.OccurrencePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrenceTiming", r.OccurrenceTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "asNeededBoolean", r.AsNeededBoolean, r.AsNeededBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "asNeededCodeableConcept", r.AsNeededCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "authoredOn", r.AuthoredOn, r.AuthoredOnElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requester", r.Requester); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "performerType", r.PerformerType); err != nil {
+		return err
+	}
+	for _, v := range r.Performer {
+		if err := encodeXMLElement(e, "performer", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.LocationCode {
+		if err := encodeXMLElement(e, "locationCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.LocationReference {
+		if err := encodeXMLElement(e, "locationReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonCode {
+		if err := encodeXMLElement(e, "reasonCode", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ReasonReference {
+		if err := encodeXMLElement(e, "reasonReference", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Insurance {
+		if err := encodeXMLElement(e, "insurance", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.SupportingInfo {
+		if err := encodeXMLElement(e, "supportingInfo", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Specimen {
+		if err := encodeXMLElement(e, "specimen", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BodySite {
+		if err := encodeXMLElement(e, "bodySite", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "patientInstruction", r.PatientInstruction, r.PatientInstructionElement); err != nil {
+		return err
+	}
+	for _, v := range r.RelevantHistory {
+		if err := encodeXMLElement(e, "relevantHistory", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given ServiceRequest from FHIR XML
+func (r *ServiceRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesCanonicalElement = appendElement(r.InstantiatesCanonicalElement, len(r.InstantiatesCanonical), element)
+					r.InstantiatesCanonical = append(r.InstantiatesCanonical, v)
+				}
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.InstantiatesUriElement = appendElement(r.InstantiatesUriElement, len(r.InstantiatesUri), element)
+					r.InstantiatesUri = append(r.InstantiatesUri, v)
+				}
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "replaces":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Replaces = append(r.Replaces, v)
+			case "requisition":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requisition = &v
+			case "status":
+				var v RequestStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "intent":
+				var v RequestIntent
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Intent = v
+				}
+				r.IntentElement = element
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = append(r.Category, v)
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "doNotPerform":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DoNotPerform = &v
+				}
+				r.DoNotPerformElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "orderDetail":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OrderDetail = append(r.OrderDetail, v)
+			case "quantityQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.QuantityQuantity = &v
+			case "quantityRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.QuantityRatio = &v
+			case "quantityRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.QuantityRange = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "occurrenceDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceDateTime = &v
+				}
+				r.OccurrenceDateTimeElement = element
+			case "occurrencePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrencePeriod = &v
+			case "occurrenceTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrenceTiming = &v
+			case "asNeededBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AsNeededBoolean = &v
+				}
+				r.AsNeededBooleanElement = element
+			case "asNeededCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AsNeededCodeableConcept = &v
+			case "authoredOn":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthoredOn = &v
+				}
+				r.AuthoredOnElement = element
+			case "requester":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requester = &v
+			case "performerType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformerType = &v
+			case "performer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Performer = append(r.Performer, v)
+			case "locationCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationCode = append(r.LocationCode, v)
+			case "locationReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LocationReference = append(r.LocationReference, v)
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = append(r.ReasonCode, v)
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = append(r.ReasonReference, v)
+			case "insurance":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurance = append(r.Insurance, v)
+			case "supportingInfo":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SupportingInfo = append(r.SupportingInfo, v)
+			case "specimen":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Specimen = append(r.Specimen, v)
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = append(r.BodySite, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "patientInstruction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PatientInstruction = &v
+				}
+				r.PatientInstructionElement = element
+			case "relevantHistory":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelevantHistory = append(r.RelevantHistory, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the ServiceRequest as properties to the RDF node n
+func (r ServiceRequest) turtle(n *turtleNode) {
+	n.resource("ServiceRequest", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("ServiceRequest.identifier", i, v)
+	}
+	for i, v := range r.InstantiatesCanonical {
+		n.primitive("ServiceRequest.instantiatesCanonical", i, v, elementAt(r.InstantiatesCanonicalElement, i), "string")
+	}
+	for i, v := range r.InstantiatesUri {
+		n.primitive("ServiceRequest.instantiatesUri", i, v, elementAt(r.InstantiatesUriElement, i), "string")
+	}
+	for i, v := range r.BasedOn {
+		n.element("ServiceRequest.basedOn", i, v)
+	}
+	for i, v := range r.Replaces {
+		n.element("ServiceRequest.replaces", i, v)
+	}
+	n.element("ServiceRequest.requisition", -1, r.Requisition)
+	n.primitive("ServiceRequest.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("ServiceRequest.intent", -1, r.Intent, r.IntentElement, "code")
+	for i, v := range r.Category {
+		n.element("ServiceRequest.category", i, v)
+	}
+	n.primitive("ServiceRequest.priority", -1, r.Priority, r.PriorityElement, "code")
+	n.primitive("ServiceRequest.doNotPerform", -1, r.DoNotPerform, r.DoNotPerformElement, "boolean")
+	n.element("ServiceRequest.code", -1, r.Code)
+	for i, v := range r.OrderDetail {
+		n.element("ServiceRequest.orderDetail", i, v)
+	}
+	n.element("ServiceRequest.quantityQuantity", -1, r.QuantityQuantity)
+	n.element("ServiceRequest.quantityRatio", -1, r.QuantityRatio)
+	n.element("ServiceRequest.quantityRange", -1, r.QuantityRange)
+	n.element("ServiceRequest.subject", -1, r.Subject)
+	n.element("ServiceRequest.encounter", -1, r.Encounter)
+	n.primitive("ServiceRequest.occurrenceDateTime", -1, r.OccurrenceDateTime, r.OccurrenceDateTimeElement, "dateTime")
+	n.element("ServiceRequest.occurrencePeriod", -1, r.OccurrencePeriod)
+	n.element("ServiceRequest.occurrenceTiming", -1, r.OccurrenceTiming)
+	n.primitive("ServiceRequest.asNeededBoolean", -1, r.AsNeededBoolean, r.AsNeededBooleanElement, "boolean")
+	n.element("ServiceRequest.asNeededCodeableConcept", -1, r.AsNeededCodeableConcept)
+	n.primitive("ServiceRequest.authoredOn", -1, r.AuthoredOn, r.AuthoredOnElement, "dateTime")
+	n.element("ServiceRequest.requester", -1, r.Requester)
+	n.element("ServiceRequest.performerType", -1, r.PerformerType)
+	for i, v := range r.Performer {
+		n.element("ServiceRequest.performer", i, v)
+	}
+	for i, v := range r.LocationCode {
+		n.element("ServiceRequest.locationCode", i, v)
+	}
+	for i, v := range r.LocationReference {
+		n.element("ServiceRequest.locationReference", i, v)
+	}
+	for i, v := range r.ReasonCode {
+		n.element("ServiceRequest.reasonCode", i, v)
+	}
+	for i, v := range r.ReasonReference {
+		n.element("ServiceRequest.reasonReference", i, v)
+	}
+	for i, v := range r.Insurance {
+		n.element("ServiceRequest.insurance", i, v)
+	}
+	for i, v := range r.SupportingInfo {
+		n.element("ServiceRequest.supportingInfo", i, v)
+	}
+	for i, v := range r.Specimen {
+		n.element("ServiceRequest.specimen", i, v)
+	}
+	for i, v := range r.BodySite {
+		n.element("ServiceRequest.bodySite", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("ServiceRequest.note", i, v)
+	}
+	n.primitive("ServiceRequest.patientInstruction", -1, r.PatientInstruction, r.PatientInstructionElement, "string")
+	for i, v := range r.RelevantHistory {
+		n.element("ServiceRequest.relevantHistory", i, v)
+	}
+}
+
+// MarshalBSON marshals the given ServiceRequest as BSON document with the structure of its FHIR JSON
+func (r ServiceRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given ServiceRequest from a BSON document with the structure of its FHIR JSON
+func (r *ServiceRequest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given ServiceRequest as protobuf message ServiceRequest of fhir.proto
+func (r ServiceRequest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the ServiceRequest to the encoded message
+func (r ServiceRequest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.InstantiatesCanonical {
+		e.string(10, v)
+	}
+	for _, v := range r.InstantiatesUri {
+		e.string(11, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(12, v)
+	}
+	for _, v := range r.Replaces {
+		e.message(13, v)
+	}
+	if r.Requisition != nil {
+		e.message(14, *r.Requisition)
+	}
+	e.varint(15, r.Status.protoNumber())
+	e.varint(16, r.Intent.protoNumber())
+	for _, v := range r.Category {
+		e.message(17, v)
+	}
+	if r.Priority != nil {
+		e.varint(18, r.Priority.protoNumber())
+	}
+	if r.DoNotPerform != nil {
+		e.varint(19, protoBool(*r.DoNotPerform))
+	}
+	if r.Code != nil {
+		e.message(20, *r.Code)
+	}
+	for _, v := range r.OrderDetail {
+		e.message(21, v)
+	}
+	if r.QuantityQuantity != nil {
+		e.message(22, *r.QuantityQuantity)
+	}
+	if r.QuantityRatio != nil {
+		e.message(23, *r.QuantityRatio)
+	}
+	if r.QuantityRange != nil {
+		e.message(24, *r.QuantityRange)
+	}
+	e.message(25, r.Subject)
+	if r.Encounter != nil {
+		e.message(26, *r.Encounter)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.string(27, *r.OccurrenceDateTime)
+	}
+	if r.OccurrencePeriod != nil {
+		e.message(28, *r.OccurrencePeriod)
+	}
+	if r.OccurrenceTiming != nil {
+		e.message(29, *r.OccurrenceTiming)
+	}
+	if r.AsNeededBoolean != nil {
+		e.varint(30, protoBool(*r.AsNeededBoolean))
+	}
+	if r.AsNeededCodeableConcept != nil {
+		e.message(31, *r.AsNeededCodeableConcept)
+	}
+	if r.AuthoredOn != nil {
+		e.string(32, *r.AuthoredOn)
+	}
+	if r.Requester != nil {
+		e.message(33, *r.Requester)
+	}
+	if r.PerformerType != nil {
+		e.message(34, *r.PerformerType)
+	}
+	for _, v := range r.Performer {
+		e.message(35, v)
+	}
+	for _, v := range r.LocationCode {
+		e.message(36, v)
+	}
+	for _, v := range r.LocationReference {
+		e.message(37, v)
+	}
+	for _, v := range r.ReasonCode {
+		e.message(38, v)
+	}
+	for _, v := range r.ReasonReference {
+		e.message(39, v)
+	}
+	for _, v := range r.Insurance {
+		e.message(40, v)
+	}
+	for _, v := range r.SupportingInfo {
+		e.message(41, v)
+	}
+	for _, v := range r.Specimen {
+		e.message(42, v)
+	}
+	for _, v := range r.BodySite {
+		e.message(43, v)
+	}
+	for _, v := range r.Note {
+		e.message(44, v)
+	}
+	if r.PatientInstruction != nil {
+		e.string(45, *r.PatientInstruction)
+	}
+	for _, v := range r.RelevantHistory {
+		e.message(46, v)
+	}
+	if r.IdElement != nil {
+		e.message(47, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(48, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(49, *r.LanguageElement)
+	}
+	for _, v := range r.InstantiatesCanonicalElement {
+		e.element(50, v)
+	}
+	for _, v := range r.InstantiatesUriElement {
+		e.element(51, v)
+	}
+	if r.StatusElement != nil {
+		e.message(52, *r.StatusElement)
+	}
+	if r.IntentElement != nil {
+		e.message(53, *r.IntentElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(54, *r.PriorityElement)
+	}
+	if r.DoNotPerformElement != nil {
+		e.message(55, *r.DoNotPerformElement)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.message(56, *r.OccurrenceDateTimeElement)
+	}
+	if r.AsNeededBooleanElement != nil {
+		e.message(57, *r.AsNeededBooleanElement)
+	}
+	if r.AuthoredOnElement != nil {
+		e.message(58, *r.AuthoredOnElement)
+	}
+	if r.PatientInstructionElement != nil {
+		e.message(59, *r.PatientInstructionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given ServiceRequest from protobuf message ServiceRequest of fhir.proto
+func (r *ServiceRequest) UnmarshalProto(b []byte) error {
+	*r = ServiceRequest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			r.InstantiatesCanonical = append(r.InstantiatesCanonical, d.string())
+		case 11:
+			r.InstantiatesUri = append(r.InstantiatesUri, d.string())
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Replaces = append(r.Replaces, v)
+		case 14:
+			var v Identifier
+			d.message(&v)
+			r.Requisition = &v
+		case 15:
+			var v RequestStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 16:
+			var v RequestIntent
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Intent = v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = append(r.Category, v)
+		case 18:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 19:
+			v := d.bool()
+			r.DoNotPerform = &v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.OrderDetail = append(r.OrderDetail, v)
+		case 22:
+			var v Quantity
+			d.message(&v)
+			r.QuantityQuantity = &v
+		case 23:
+			var v Ratio
+			d.message(&v)
+			r.QuantityRatio = &v
+		case 24:
+			var v Range
+			d.message(&v)
+			r.QuantityRange = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Subject = v
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 27:
+			v := d.string()
+			r.OccurrenceDateTime = &v
+		case 28:
+			var v Period
+			d.message(&v)
+			r.OccurrencePeriod = &v
+		case 29:
+			var v Timing
+			d.message(&v)
+			r.OccurrenceTiming = &v
+		case 30:
+			v := d.bool()
+			r.AsNeededBoolean = &v
+		case 31:
+			var v CodeableConcept
+			d.message(&v)
+			r.AsNeededCodeableConcept = &v
+		case 32:
+			v := d.string()
+			r.AuthoredOn = &v
+		case 33:
+			var v Reference
+			d.message(&v)
+			r.Requester = &v
+		case 34:
+			var v CodeableConcept
+			d.message(&v)
+			r.PerformerType = &v
+		case 35:
+			var v Reference
+			d.message(&v)
+			r.Performer = append(r.Performer, v)
+		case 36:
+			var v CodeableConcept
+			d.message(&v)
+			r.LocationCode = append(r.LocationCode, v)
+		case 37:
+			var v Reference
+			d.message(&v)
+			r.LocationReference = append(r.LocationReference, v)
+		case 38:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = append(r.ReasonCode, v)
+		case 39:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = append(r.ReasonReference, v)
+		case 40:
+			var v Reference
+			d.message(&v)
+			r.Insurance = append(r.Insurance, v)
+		case 41:
+			var v Reference
+			d.message(&v)
+			r.SupportingInfo = append(r.SupportingInfo, v)
+		case 42:
+			var v Reference
+			d.message(&v)
+			r.Specimen = append(r.Specimen, v)
+		case 43:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = append(r.BodySite, v)
+		case 44:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 45:
+			v := d.string()
+			r.PatientInstruction = &v
+		case 46:
+			var v Reference
+			d.message(&v)
+			r.RelevantHistory = append(r.RelevantHistory, v)
+		case 47:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 50:
+			r.InstantiatesCanonicalElement = append(r.InstantiatesCanonicalElement, d.element())
+		case 51:
+			r.InstantiatesUriElement = append(r.InstantiatesUriElement, d.element())
+		case 52:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 53:
+			var v Element
+			d.message(&v)
+			r.IntentElement = &v
+		case 54:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.DoNotPerformElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.OccurrenceDateTimeElement = &v
+		case 57:
+			var v Element
+			d.message(&v)
+			r.AsNeededBooleanElement = &v
+		case 58:
+			var v Element
+			d.message(&v)
+			r.AuthoredOnElement = &v
+		case 59:
+			var v Element
+			d.message(&v)
+			r.PatientInstructionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalServiceRequest unmarshals a ServiceRequest.
 func UnmarshalServiceRequest(b []byte) (ServiceRequest, error) {
 	var serviceRequest ServiceRequest
-	if err := json.Unmarshal(b, &serviceRequest); err != nil {
+	if err := serviceRequest.UnmarshalJSON(b); err != nil {
 		return serviceRequest, err
 	}
 	return serviceRequest, nil
@@ -14,18 +14,631 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Signature is documented here http://hl7.org/fhir/StructureDefinition/Signature
 type Signature struct {
-	Id           *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension    []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	Type         []Coding    `bson:"type" json:"type"`
-	When         string      `bson:"when" json:"when"`
-	Who          Reference   `bson:"who" json:"who"`
-	OnBehalfOf   *Reference  `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
-	TargetFormat *string     `bson:"targetFormat,omitempty" json:"targetFormat,omitempty"`
-	SigFormat    *string     `bson:"sigFormat,omitempty" json:"sigFormat,omitempty"`
-	Data         *string     `bson:"data,omitempty" json:"data,omitempty"`
+	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	Type                []Coding    `bson:"type" json:"type"`
+	When                string      `bson:"when" json:"when"`
+	WhenElement         *Element    `bson:"_when,omitempty" json:"_when,omitempty"`
+	Who                 Reference   `bson:"who" json:"who"`
+	OnBehalfOf          *Reference  `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
+	TargetFormat        *string     `bson:"targetFormat,omitempty" json:"targetFormat,omitempty"`
+	TargetFormatElement *Element    `bson:"_targetFormat,omitempty" json:"_targetFormat,omitempty"`
+	SigFormat           *string     `bson:"sigFormat,omitempty" json:"sigFormat,omitempty"`
+	SigFormatElement    *Element    `bson:"_sigFormat,omitempty" json:"_sigFormat,omitempty"`
+	Data                *string     `bson:"data,omitempty" json:"data,omitempty"`
+	DataElement         *Element    `bson:"_data,omitempty" json:"_data,omitempty"`
+}
+
+// MarshalJSON marshals the given Signature as JSON into a byte slice
+func (r Signature) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Signature as JSON object with the members in the order of the fields
+func (r Signature) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	if r.Type == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("when")
+	e.string(r.When)
+	if r.WhenElement != nil {
+		e.key("_when")
+		r.WhenElement.appendJSON(e)
+	}
+	e.key("who")
+	r.Who.appendJSON(e)
+	if r.OnBehalfOf != nil {
+		e.key("onBehalfOf")
+		r.OnBehalfOf.appendJSON(e)
+	}
+	if r.TargetFormat != nil {
+		e.key("targetFormat")
+		e.string(*r.TargetFormat)
+	}
+	if r.TargetFormatElement != nil {
+		e.key("_targetFormat")
+		r.TargetFormatElement.appendJSON(e)
+	}
+	if r.SigFormat != nil {
+		e.key("sigFormat")
+		e.string(*r.SigFormat)
+	}
+	if r.SigFormatElement != nil {
+		e.key("_sigFormat")
+		r.SigFormatElement.appendJSON(e)
+	}
+	if r.Data != nil {
+		e.key("data")
+		e.string(*r.Data)
+	}
+	if r.DataElement != nil {
+		e.key("_data")
+		r.DataElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Signature from JSON. Unknown members are ignored.
+func (r *Signature) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Signature
+func (r *Signature) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []Coding{}
+			}
+		case "when":
+			if v, ok := d.string(); ok {
+				r.When = v
+			}
+		case "_when":
+			r.WhenElement = d.element()
+		case "who":
+			r.Who.decodeJSON(d)
+		case "onBehalfOf":
+			if d.null() {
+				r.OnBehalfOf = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.OnBehalfOf = &v
+			}
+		case "targetFormat":
+			if v, ok := d.string(); ok {
+				r.TargetFormat = &v
+			} else {
+				r.TargetFormat = nil
+			}
+		case "_targetFormat":
+			r.TargetFormatElement = d.element()
+		case "sigFormat":
+			if v, ok := d.string(); ok {
+				r.SigFormat = &v
+			} else {
+				r.SigFormat = nil
+			}
+		case "_sigFormat":
+			r.SigFormatElement = d.element()
+		case "data":
+			if v, ok := d.string(); ok {
+				r.Data = &v
+			} else {
+				r.Data = nil
+			}
+		case "_data":
+			r.DataElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Signature which shares no memory with the original
+func (r Signature) DeepCopy() Signature {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		out.Type = make([]Coding, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.WhenElement != nil {
+		v := r.WhenElement.DeepCopy()
+		out.WhenElement = &v
+	}
+	out.Who = r.Who.DeepCopy()
+	if r.OnBehalfOf != nil {
+		v := r.OnBehalfOf.DeepCopy()
+		out.OnBehalfOf = &v
+	}
+	if r.TargetFormat != nil {
+		v := *r.TargetFormat
+		out.TargetFormat = &v
+	}
+	if r.TargetFormatElement != nil {
+		v := r.TargetFormatElement.DeepCopy()
+		out.TargetFormatElement = &v
+	}
+	if r.SigFormat != nil {
+		v := *r.SigFormat
+		out.SigFormat = &v
+	}
+	if r.SigFormatElement != nil {
+		v := r.SigFormatElement.DeepCopy()
+		out.SigFormatElement = &v
+	}
+	if r.Data != nil {
+		v := *r.Data
+		out.Data = &v
+	}
+	if r.DataElement != nil {
+		v := r.DataElement.DeepCopy()
+		out.DataElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Signature) Equal(other Signature) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if r.When != other.When {
+		return false
+	}
+	if (r.WhenElement == nil) != (other.WhenElement == nil) || r.WhenElement != nil && !r.WhenElement.Equal(*other.WhenElement) {
+		return false
+	}
+	if !r.Who.Equal(other.Who) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.Equal(*other.OnBehalfOf) {
+		return false
+	}
+	if (r.TargetFormat == nil) != (other.TargetFormat == nil) || r.TargetFormat != nil && *r.TargetFormat != *other.TargetFormat {
+		return false
+	}
+	if (r.TargetFormatElement == nil) != (other.TargetFormatElement == nil) || r.TargetFormatElement != nil && !r.TargetFormatElement.Equal(*other.TargetFormatElement) {
+		return false
+	}
+	if (r.SigFormat == nil) != (other.SigFormat == nil) || r.SigFormat != nil && *r.SigFormat != *other.SigFormat {
+		return false
+	}
+	if (r.SigFormatElement == nil) != (other.SigFormatElement == nil) || r.SigFormatElement != nil && !r.SigFormatElement.Equal(*other.SigFormatElement) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && *r.Data != *other.Data {
+		return false
+	}
+	if (r.DataElement == nil) != (other.DataElement == nil) || r.DataElement != nil && !r.DataElement.Equal(*other.DataElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Signature) EqualsDeep(other Signature) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.When, other.When) {
+		return false
+	}
+	if (r.WhenElement == nil) != (other.WhenElement == nil) || r.WhenElement != nil && !r.WhenElement.EqualsDeep(*other.WhenElement) {
+		return false
+	}
+	if !r.Who.EqualsDeep(other.Who) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.EqualsDeep(*other.OnBehalfOf) {
+		return false
+	}
+	if (r.TargetFormat == nil) != (other.TargetFormat == nil) || r.TargetFormat != nil && !equivalentString(*r.TargetFormat, *other.TargetFormat) {
+		return false
+	}
+	if (r.TargetFormatElement == nil) != (other.TargetFormatElement == nil) || r.TargetFormatElement != nil && !r.TargetFormatElement.EqualsDeep(*other.TargetFormatElement) {
+		return false
+	}
+	if (r.SigFormat == nil) != (other.SigFormat == nil) || r.SigFormat != nil && !equivalentString(*r.SigFormat, *other.SigFormat) {
+		return false
+	}
+	if (r.SigFormatElement == nil) != (other.SigFormatElement == nil) || r.SigFormatElement != nil && !r.SigFormatElement.EqualsDeep(*other.SigFormatElement) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && !equivalentString(*r.Data, *other.Data) {
+		return false
+	}
+	if (r.DataElement == nil) != (other.DataElement == nil) || r.DataElement != nil && !r.DataElement.EqualsDeep(*other.DataElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Signature) EqualsShallow(other Signature) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.When, other.When) {
+		return false
+	}
+	if (r.TargetFormat == nil) != (other.TargetFormat == nil) || r.TargetFormat != nil && !equivalentString(*r.TargetFormat, *other.TargetFormat) {
+		return false
+	}
+	if (r.SigFormat == nil) != (other.SigFormat == nil) || r.SigFormat != nil && !equivalentString(*r.SigFormat, *other.SigFormat) {
+		return false
+	}
+	if (r.Data == nil) != (other.Data == nil) || r.Data != nil && !equivalentString(*r.Data, *other.Data) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Signature as FHIR XML
+func (r Signature) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "when", r.When, r.WhenElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "who", r.Who); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onBehalfOf", r.OnBehalfOf); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "targetFormat", r.TargetFormat, r.TargetFormatElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sigFormat", r.SigFormat, r.SigFormatElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "data", r.Data, r.DataElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Signature from FHIR XML
+func (r *Signature) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "type":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "when":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.When = v
+				}
+				r.WhenElement = element
+			case "who":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Who = v
+			case "onBehalfOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnBehalfOf = &v
+			case "targetFormat":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TargetFormat = &v
+				}
+				r.TargetFormatElement = element
+			case "sigFormat":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SigFormat = &v
+				}
+				r.SigFormatElement = element
+			case "data":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Data = &v
+				}
+				r.DataElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Signature as properties to the RDF node n
+func (r Signature) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.Type {
+		n.element("Signature.type", i, v)
+	}
+	n.primitive("Signature.when", -1, r.When, r.WhenElement, "instant")
+	n.element("Signature.who", -1, r.Who)
+	n.element("Signature.onBehalfOf", -1, r.OnBehalfOf)
+	n.primitive("Signature.targetFormat", -1, r.TargetFormat, r.TargetFormatElement, "string")
+	n.primitive("Signature.sigFormat", -1, r.SigFormat, r.SigFormatElement, "string")
+	n.primitive("Signature.data", -1, r.Data, r.DataElement, "base64Binary")
+}
+
+// MarshalBSON marshals the given Signature as BSON document with the structure of its FHIR JSON
+func (r Signature) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Signature from a BSON document with the structure of its FHIR JSON
+func (r *Signature) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Signature as protobuf message Signature of fhir.proto
+func (r Signature) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Signature to the encoded message
+func (r Signature) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.Type {
+		e.message(3, v)
+	}
+	if r.When != "" {
+		e.string(4, r.When)
+	}
+	e.message(5, r.Who)
+	if r.OnBehalfOf != nil {
+		e.message(6, *r.OnBehalfOf)
+	}
+	if r.TargetFormat != nil {
+		e.string(7, *r.TargetFormat)
+	}
+	if r.SigFormat != nil {
+		e.string(8, *r.SigFormat)
+	}
+	if r.Data != nil {
+		e.string(9, *r.Data)
+	}
+	if r.WhenElement != nil {
+		e.message(10, *r.WhenElement)
+	}
+	if r.TargetFormatElement != nil {
+		e.message(11, *r.TargetFormatElement)
+	}
+	if r.SigFormatElement != nil {
+		e.message(12, *r.SigFormatElement)
+	}
+	if r.DataElement != nil {
+		e.message(13, *r.DataElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Signature from protobuf message Signature of fhir.proto
+func (r *Signature) UnmarshalProto(b []byte) error {
+	*r = Signature{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Coding
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 4:
+			r.When = d.string()
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.Who = v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.OnBehalfOf = &v
+		case 7:
+			v := d.string()
+			r.TargetFormat = &v
+		case 8:
+			v := d.string()
+			r.SigFormat = &v
+		case 9:
+			v := d.string()
+			r.Data = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.WhenElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.TargetFormatElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.SigFormatElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DataElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code SlicingRules) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SlicingRules) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SlicingRules) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "closed":
 		*code = SlicingRulesClosed
@@ -49,6 +53,12 @@ func (code *SlicingRules) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SlicingRules) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SlicingRules) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SlicingRules) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code SlicingRules) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SlicingRules) protoNumber() uint64 {
+	switch code {
+	case SlicingRulesClosed:
+		return 1
+	case SlicingRulesOpen:
+		return 2
+	case SlicingRulesOpenAtEnd:
+		return 3
+	}
+	return 0
+}
+func (code *SlicingRules) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SlicingRulesClosed
+	case 2:
+		*code = SlicingRulesOpen
+	case 3:
+		*code = SlicingRulesOpenAtEnd
+	default:
+		return false
+	}
+	return true
+}
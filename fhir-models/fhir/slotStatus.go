@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code SlotStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SlotStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SlotStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "busy":
 		*code = SlotStatusBusy
@@ -55,6 +59,12 @@ func (code *SlotStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SlotStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SlotStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SlotStatus) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code SlotStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SlotStatus) protoNumber() uint64 {
+	switch code {
+	case SlotStatusBusy:
+		return 1
+	case SlotStatusFree:
+		return 2
+	case SlotStatusBusyUnavailable:
+		return 3
+	case SlotStatusBusyTentative:
+		return 4
+	case SlotStatusEnteredInError:
+		return 5
+	}
+	return 0
+}
+func (code *SlotStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SlotStatusBusy
+	case 2:
+		*code = SlotStatusFree
+	case 3:
+		*code = SlotStatusBusyUnavailable
+	case 4:
+		*code = SlotStatusBusyTentative
+	case 5:
+		*code = SlotStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
@@ -14,33 +14,42 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Specimen is documented here http://hl7.org/fhir/StructureDefinition/Specimen
 type Specimen struct {
-	Id                  *string              `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string              `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier          []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	AccessionIdentifier *Identifier          `bson:"accessionIdentifier,omitempty" json:"accessionIdentifier,omitempty"`
-	Status              *SpecimenStatus      `bson:"status,omitempty" json:"status,omitempty"`
-	Type                *CodeableConcept     `bson:"type,omitempty" json:"type,omitempty"`
-	Subject             *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
-	ReceivedTime        *string              `bson:"receivedTime,omitempty" json:"receivedTime,omitempty"`
-	Parent              []Reference          `bson:"parent,omitempty" json:"parent,omitempty"`
-	Request             []Reference          `bson:"request,omitempty" json:"request,omitempty"`
-	Collection          *SpecimenCollection  `bson:"collection,omitempty" json:"collection,omitempty"`
-	Processing          []SpecimenProcessing `bson:"processing,omitempty" json:"processing,omitempty"`
-	Container           []SpecimenContainer  `bson:"container,omitempty" json:"container,omitempty"`
-	Condition           []CodeableConcept    `bson:"condition,omitempty" json:"condition,omitempty"`
-	Note                []Annotation         `bson:"note,omitempty" json:"note,omitempty"`
+	Id                   *string              `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element             `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element             `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string              `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element             `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	AccessionIdentifier  *Identifier          `bson:"accessionIdentifier,omitempty" json:"accessionIdentifier,omitempty"`
+	Status               *SpecimenStatus      `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement        *Element             `bson:"_status,omitempty" json:"_status,omitempty"`
+	Type                 *CodeableConcept     `bson:"type,omitempty" json:"type,omitempty"`
+	Subject              *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
+	ReceivedTime         *string              `bson:"receivedTime,omitempty" json:"receivedTime,omitempty"`
+	ReceivedTimeElement  *Element             `bson:"_receivedTime,omitempty" json:"_receivedTime,omitempty"`
+	Parent               []Reference          `bson:"parent,omitempty" json:"parent,omitempty"`
+	Request              []Reference          `bson:"request,omitempty" json:"request,omitempty"`
+	Collection           *SpecimenCollection  `bson:"collection,omitempty" json:"collection,omitempty"`
+	Processing           []SpecimenProcessing `bson:"processing,omitempty" json:"processing,omitempty"`
+	Container            []SpecimenContainer  `bson:"container,omitempty" json:"container,omitempty"`
+	Condition            []CodeableConcept    `bson:"condition,omitempty" json:"condition,omitempty"`
+	Note                 []Annotation         `bson:"note,omitempty" json:"note,omitempty"`
 }
 type SpecimenCollection struct {
 	Id                           *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -48,6 +57,7 @@ type SpecimenCollection struct {
 	ModifierExtension            []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Collector                    *Reference       `bson:"collector,omitempty" json:"collector,omitempty"`
 	CollectedDateTime            *string          `bson:"collectedDateTime,omitempty" json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement     *Element         `bson:"_collectedDateTime,omitempty" json:"_collectedDateTime,omitempty"`
 	CollectedPeriod              *Period          `bson:"collectedPeriod,omitempty" json:"collectedPeriod,omitempty"`
 	Duration                     *Duration        `bson:"duration,omitempty" json:"duration,omitempty"`
 	Quantity                     *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
@@ -56,45 +66,3295 @@ type SpecimenCollection struct {
 	FastingStatusCodeableConcept *CodeableConcept `bson:"fastingStatusCodeableConcept,omitempty" json:"fastingStatusCodeableConcept,omitempty"`
 	FastingStatusDuration        *Duration        `bson:"fastingStatusDuration,omitempty" json:"fastingStatusDuration,omitempty"`
 }
+
+// SpecimenCollectionCollected is implemented by the types allowed for Specimen.collection.collected[x]
+type SpecimenCollectionCollected interface {
+	isSpecimenCollectionCollected()
+}
+
+func (DateTime) isSpecimenCollectionCollected() {}
+func (Period) isSpecimenCollectionCollected()   {}
+
+// Collected returns the value of Specimen.collection.collected[x] together with its FHIR type code or nil if no value is set
+func (r SpecimenCollection) Collected() (SpecimenCollectionCollected, string) {
+	if r.CollectedDateTime != nil {
+		return DateTime(*r.CollectedDateTime), "dateTime"
+	}
+	if r.CollectedPeriod != nil {
+		return *r.CollectedPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetCollected sets the value of Specimen.collection.collected[x], given as value or pointer, and clears all other types
+func (r *SpecimenCollection) SetCollected(value SpecimenCollectionCollected) {
+	r.CollectedDateTime = nil
+	r.CollectedPeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.CollectedDateTime = &primitive
+	case *DateTime:
+		r.CollectedDateTime = (*string)(v)
+	case Period:
+		r.CollectedPeriod = &v
+	case *Period:
+		r.CollectedPeriod = v
+	}
+}
+
+// SpecimenCollectionFastingStatus is implemented by the types allowed for Specimen.collection.fastingStatus[x]
+type SpecimenCollectionFastingStatus interface {
+	isSpecimenCollectionFastingStatus()
+}
+
+func (CodeableConcept) isSpecimenCollectionFastingStatus() {}
+func (Duration) isSpecimenCollectionFastingStatus()        {}
+
+// FastingStatus returns the value of Specimen.collection.fastingStatus[x] together with its FHIR type code or nil if no value is set
+func (r SpecimenCollection) FastingStatus() (SpecimenCollectionFastingStatus, string) {
+	if r.FastingStatusCodeableConcept != nil {
+		return *r.FastingStatusCodeableConcept, "CodeableConcept"
+	}
+	if r.FastingStatusDuration != nil {
+		return *r.FastingStatusDuration, "Duration"
+	}
+	return nil, ""
+}
+
+// SetFastingStatus sets the value of Specimen.collection.fastingStatus[x], given as value or pointer, and clears all other types
+func (r *SpecimenCollection) SetFastingStatus(value SpecimenCollectionFastingStatus) {
+	r.FastingStatusCodeableConcept = nil
+	r.FastingStatusDuration = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.FastingStatusCodeableConcept = &v
+	case *CodeableConcept:
+		r.FastingStatusCodeableConcept = v
+	case Duration:
+		r.FastingStatusDuration = &v
+	case *Duration:
+		r.FastingStatusDuration = v
+	}
+}
+
 type SpecimenProcessing struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       *string          `bson:"description,omitempty" json:"description,omitempty"`
-	Procedure         *CodeableConcept `bson:"procedure,omitempty" json:"procedure,omitempty"`
-	Additive          []Reference      `bson:"additive,omitempty" json:"additive,omitempty"`
-	TimeDateTime      *string          `bson:"timeDateTime,omitempty" json:"timeDateTime,omitempty"`
-	TimePeriod        *Period          `bson:"timePeriod,omitempty" json:"timePeriod,omitempty"`
+	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description         *string          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element         `bson:"_description,omitempty" json:"_description,omitempty"`
+	Procedure           *CodeableConcept `bson:"procedure,omitempty" json:"procedure,omitempty"`
+	Additive            []Reference      `bson:"additive,omitempty" json:"additive,omitempty"`
+	TimeDateTime        *string          `bson:"timeDateTime,omitempty" json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *Element         `bson:"_timeDateTime,omitempty" json:"_timeDateTime,omitempty"`
+	TimePeriod          *Period          `bson:"timePeriod,omitempty" json:"timePeriod,omitempty"`
+}
+
+// SpecimenProcessingTime is implemented by the types allowed for Specimen.processing.time[x]
+type SpecimenProcessingTime interface {
+	isSpecimenProcessingTime()
+}
+
+func (DateTime) isSpecimenProcessingTime() {}
+func (Period) isSpecimenProcessingTime()   {}
+
+// Time returns the value of Specimen.processing.time[x] together with its FHIR type code or nil if no value is set
+func (r SpecimenProcessing) Time() (SpecimenProcessingTime, string) {
+	if r.TimeDateTime != nil {
+		return DateTime(*r.TimeDateTime), "dateTime"
+	}
+	if r.TimePeriod != nil {
+		return *r.TimePeriod, "Period"
+	}
+	return nil, ""
 }
+
+// SetTime sets the value of Specimen.processing.time[x], given as value or pointer, and clears all other types
+func (r *SpecimenProcessing) SetTime(value SpecimenProcessingTime) {
+	r.TimeDateTime = nil
+	r.TimePeriod = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.TimeDateTime = &primitive
+	case *DateTime:
+		r.TimeDateTime = (*string)(v)
+	case Period:
+		r.TimePeriod = &v
+	case *Period:
+		r.TimePeriod = v
+	}
+}
+
 type SpecimenContainer struct {
 	Id                      *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension               []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension       []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier              []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Description             *string          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element         `bson:"_description,omitempty" json:"_description,omitempty"`
 	Type                    *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	Capacity                *Quantity        `bson:"capacity,omitempty" json:"capacity,omitempty"`
 	SpecimenQuantity        *Quantity        `bson:"specimenQuantity,omitempty" json:"specimenQuantity,omitempty"`
 	AdditiveCodeableConcept *CodeableConcept `bson:"additiveCodeableConcept,omitempty" json:"additiveCodeableConcept,omitempty"`
 	AdditiveReference       *Reference       `bson:"additiveReference,omitempty" json:"additiveReference,omitempty"`
 }
-type OtherSpecimen Specimen
+
+// SpecimenContainerAdditive is implemented by the types allowed for Specimen.container.additive[x]
+type SpecimenContainerAdditive interface {
+	isSpecimenContainerAdditive()
+}
+
+func (CodeableConcept) isSpecimenContainerAdditive() {}
+func (Reference) isSpecimenContainerAdditive()       {}
+
+// Additive returns the value of Specimen.container.additive[x] together with its FHIR type code or nil if no value is set
+func (r SpecimenContainer) Additive() (SpecimenContainerAdditive, string) {
+	if r.AdditiveCodeableConcept != nil {
+		return *r.AdditiveCodeableConcept, "CodeableConcept"
+	}
+	if r.AdditiveReference != nil {
+		return *r.AdditiveReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetAdditive sets the value of Specimen.container.additive[x], given as value or pointer, and clears all other types
+func (r *SpecimenContainer) SetAdditive(value SpecimenContainerAdditive) {
+	r.AdditiveCodeableConcept = nil
+	r.AdditiveReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.AdditiveCodeableConcept = &v
+	case *CodeableConcept:
+		r.AdditiveCodeableConcept = v
+	case Reference:
+		r.AdditiveReference = &v
+	case *Reference:
+		r.AdditiveReference = v
+	}
+}
 
 // MarshalJSON marshals the given Specimen as JSON into a byte slice
 func (r Specimen) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSpecimen
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSpecimen: OtherSpecimen(r),
-		ResourceType:  "Specimen",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Specimen as JSON object with the members in the order of the fields
+func (r Specimen) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Specimen\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AccessionIdentifier != nil {
+		e.key("accessionIdentifier")
+		r.AccessionIdentifier.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Subject != nil {
+		e.key("subject")
+		r.Subject.appendJSON(e)
+	}
+	if r.ReceivedTime != nil {
+		e.key("receivedTime")
+		e.string(*r.ReceivedTime)
+	}
+	if r.ReceivedTimeElement != nil {
+		e.key("_receivedTime")
+		r.ReceivedTimeElement.appendJSON(e)
+	}
+	if len(r.Parent) > 0 {
+		e.key("parent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Request) > 0 {
+		e.key("request")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Request {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Collection != nil {
+		e.key("collection")
+		r.Collection.appendJSON(e)
+	}
+	if len(r.Processing) > 0 {
+		e.key("processing")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Processing {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Container) > 0 {
+		e.key("container")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Container {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Condition) > 0 {
+		e.key("condition")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Condition {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Specimen from JSON. Unknown members are ignored.
+func (r *Specimen) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Specimen
+func (r *Specimen) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "accessionIdentifier":
+			if d.null() {
+				r.AccessionIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.AccessionIdentifier = &v
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v SpecimenStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "subject":
+			if d.null() {
+				r.Subject = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Subject = &v
+			}
+		case "receivedTime":
+			if v, ok := d.string(); ok {
+				r.ReceivedTime = &v
+			} else {
+				r.ReceivedTime = nil
+			}
+		case "_receivedTime":
+			r.ReceivedTimeElement = d.element()
+		case "parent":
+			a := d.array()
+			r.Parent = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Parent = append(r.Parent, v)
+			}
+			if r.Parent == nil && !a.isNull {
+				r.Parent = []Reference{}
+			}
+		case "request":
+			a := d.array()
+			r.Request = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Request = append(r.Request, v)
+			}
+			if r.Request == nil && !a.isNull {
+				r.Request = []Reference{}
+			}
+		case "collection":
+			if d.null() {
+				r.Collection = nil
+			} else {
+				var v SpecimenCollection
+				v.decodeJSON(d)
+				r.Collection = &v
+			}
+		case "processing":
+			a := d.array()
+			r.Processing = nil
+			for a.next() {
+				var v SpecimenProcessing
+				v.decodeJSON(d)
+				r.Processing = append(r.Processing, v)
+			}
+			if r.Processing == nil && !a.isNull {
+				r.Processing = []SpecimenProcessing{}
+			}
+		case "container":
+			a := d.array()
+			r.Container = nil
+			for a.next() {
+				var v SpecimenContainer
+				v.decodeJSON(d)
+				r.Container = append(r.Container, v)
+			}
+			if r.Container == nil && !a.isNull {
+				r.Container = []SpecimenContainer{}
+			}
+		case "condition":
+			a := d.array()
+			r.Condition = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Condition = append(r.Condition, v)
+			}
+			if r.Condition == nil && !a.isNull {
+				r.Condition = []CodeableConcept{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Specimen which shares no memory with the original
+func (r Specimen) DeepCopy() Specimen {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.AccessionIdentifier != nil {
+		v := r.AccessionIdentifier.DeepCopy()
+		out.AccessionIdentifier = &v
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Subject != nil {
+		v := r.Subject.DeepCopy()
+		out.Subject = &v
+	}
+	if r.ReceivedTime != nil {
+		v := *r.ReceivedTime
+		out.ReceivedTime = &v
+	}
+	if r.ReceivedTimeElement != nil {
+		v := r.ReceivedTimeElement.DeepCopy()
+		out.ReceivedTimeElement = &v
+	}
+	if r.Parent != nil {
+		out.Parent = make([]Reference, len(r.Parent))
+		for i := range r.Parent {
+			out.Parent[i] = r.Parent[i].DeepCopy()
+		}
+	}
+	if r.Request != nil {
+		out.Request = make([]Reference, len(r.Request))
+		for i := range r.Request {
+			out.Request[i] = r.Request[i].DeepCopy()
+		}
+	}
+	if r.Collection != nil {
+		v := r.Collection.DeepCopy()
+		out.Collection = &v
+	}
+	if r.Processing != nil {
+		out.Processing = make([]SpecimenProcessing, len(r.Processing))
+		for i := range r.Processing {
+			out.Processing[i] = r.Processing[i].DeepCopy()
+		}
+	}
+	if r.Container != nil {
+		out.Container = make([]SpecimenContainer, len(r.Container))
+		for i := range r.Container {
+			out.Container[i] = r.Container[i].DeepCopy()
+		}
+	}
+	if r.Condition != nil {
+		out.Condition = make([]CodeableConcept, len(r.Condition))
+		for i := range r.Condition {
+			out.Condition[i] = r.Condition[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Specimen) Equal(other Specimen) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.AccessionIdentifier == nil) != (other.AccessionIdentifier == nil) || r.AccessionIdentifier != nil && !r.AccessionIdentifier.Equal(*other.AccessionIdentifier) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.Equal(*other.Subject) {
+		return false
+	}
+	if (r.ReceivedTime == nil) != (other.ReceivedTime == nil) || r.ReceivedTime != nil && *r.ReceivedTime != *other.ReceivedTime {
+		return false
+	}
+	if (r.ReceivedTimeElement == nil) != (other.ReceivedTimeElement == nil) || r.ReceivedTimeElement != nil && !r.ReceivedTimeElement.Equal(*other.ReceivedTimeElement) {
+		return false
+	}
+	if len(r.Parent) != len(other.Parent) {
+		return false
+	}
+	for i := range r.Parent {
+		if !r.Parent[i].Equal(other.Parent[i]) {
+			return false
+		}
+	}
+	if len(r.Request) != len(other.Request) {
+		return false
+	}
+	for i := range r.Request {
+		if !r.Request[i].Equal(other.Request[i]) {
+			return false
+		}
+	}
+	if (r.Collection == nil) != (other.Collection == nil) || r.Collection != nil && !r.Collection.Equal(*other.Collection) {
+		return false
+	}
+	if len(r.Processing) != len(other.Processing) {
+		return false
+	}
+	for i := range r.Processing {
+		if !r.Processing[i].Equal(other.Processing[i]) {
+			return false
+		}
+	}
+	if len(r.Container) != len(other.Container) {
+		return false
+	}
+	for i := range r.Container {
+		if !r.Container[i].Equal(other.Container[i]) {
+			return false
+		}
+	}
+	if len(r.Condition) != len(other.Condition) {
+		return false
+	}
+	for i := range r.Condition {
+		if !r.Condition[i].Equal(other.Condition[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Specimen) EqualsDeep(other Specimen) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.AccessionIdentifier == nil) != (other.AccessionIdentifier == nil) || r.AccessionIdentifier != nil && !r.AccessionIdentifier.EqualsDeep(*other.AccessionIdentifier) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Subject == nil) != (other.Subject == nil) || r.Subject != nil && !r.Subject.EqualsDeep(*other.Subject) {
+		return false
+	}
+	if (r.ReceivedTime == nil) != (other.ReceivedTime == nil) || r.ReceivedTime != nil && !equivalentString(*r.ReceivedTime, *other.ReceivedTime) {
+		return false
+	}
+	if (r.ReceivedTimeElement == nil) != (other.ReceivedTimeElement == nil) || r.ReceivedTimeElement != nil && !r.ReceivedTimeElement.EqualsDeep(*other.ReceivedTimeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Parent), len(other.Parent), func(i, j int) bool {
+		return r.Parent[i].EqualsDeep(other.Parent[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Request), len(other.Request), func(i, j int) bool {
+		return r.Request[i].EqualsDeep(other.Request[j])
+	}) {
+		return false
+	}
+	if (r.Collection == nil) != (other.Collection == nil) || r.Collection != nil && !r.Collection.EqualsDeep(*other.Collection) {
+		return false
+	}
+	if !equivalentList(len(r.Processing), len(other.Processing), func(i, j int) bool {
+		return r.Processing[i].EqualsDeep(other.Processing[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Container), len(other.Container), func(i, j int) bool {
+		return r.Container[i].EqualsDeep(other.Container[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Condition), len(other.Condition), func(i, j int) bool {
+		return r.Condition[i].EqualsDeep(other.Condition[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Specimen) EqualsShallow(other Specimen) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.ReceivedTime == nil) != (other.ReceivedTime == nil) || r.ReceivedTime != nil && !equivalentString(*r.ReceivedTime, *other.ReceivedTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Specimen as FHIR XML
+func (r Specimen) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Specimen",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "accessionIdentifier", r.AccessionIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "subject", r.Subject); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "receivedTime", r.ReceivedTime, r.ReceivedTimeElement); err != nil {
+		return err
+	}
+	for _, v := range r.Parent {
+		if err := encodeXMLElement(e, "parent", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Request {
+		if err := encodeXMLElement(e, "request", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "collection", r.Collection); err != nil {
+		return err
+	}
+	for _, v := range r.Processing {
+		if err := encodeXMLElement(e, "processing", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Container {
+		if err := encodeXMLElement(e, "container", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Condition {
+		if err := encodeXMLElement(e, "condition", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Specimen from FHIR XML
+func (r *Specimen) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "accessionIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AccessionIdentifier = &v
+			case "status":
+				var v SpecimenStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "subject":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subject = &v
+			case "receivedTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ReceivedTime = &v
+				}
+				r.ReceivedTimeElement = element
+			case "parent":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parent = append(r.Parent, v)
+			case "request":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Request = append(r.Request, v)
+			case "collection":
+				var v SpecimenCollection
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Collection = &v
+			case "processing":
+				var v SpecimenProcessing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Processing = append(r.Processing, v)
+			case "container":
+				var v SpecimenContainer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Container = append(r.Container, v)
+			case "condition":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Condition = append(r.Condition, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Specimen as properties to the RDF node n
+func (r Specimen) turtle(n *turtleNode) {
+	n.resource("Specimen", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Specimen.identifier", i, v)
+	}
+	n.element("Specimen.accessionIdentifier", -1, r.AccessionIdentifier)
+	n.primitive("Specimen.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Specimen.type", -1, r.Type)
+	n.element("Specimen.subject", -1, r.Subject)
+	n.primitive("Specimen.receivedTime", -1, r.ReceivedTime, r.ReceivedTimeElement, "dateTime")
+	for i, v := range r.Parent {
+		n.element("Specimen.parent", i, v)
+	}
+	for i, v := range r.Request {
+		n.element("Specimen.request", i, v)
+	}
+	n.element("Specimen.collection", -1, r.Collection)
+	for i, v := range r.Processing {
+		n.element("Specimen.processing", i, v)
+	}
+	for i, v := range r.Container {
+		n.element("Specimen.container", i, v)
+	}
+	for i, v := range r.Condition {
+		n.element("Specimen.condition", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("Specimen.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Specimen as BSON document with the structure of its FHIR JSON
+func (r Specimen) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Specimen from a BSON document with the structure of its FHIR JSON
+func (r *Specimen) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Specimen as protobuf message Specimen of fhir.proto
+func (r Specimen) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Specimen to the encoded message
+func (r Specimen) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.AccessionIdentifier != nil {
+		e.message(10, *r.AccessionIdentifier)
+	}
+	if r.Status != nil {
+		e.varint(11, r.Status.protoNumber())
+	}
+	if r.Type != nil {
+		e.message(12, *r.Type)
+	}
+	if r.Subject != nil {
+		e.message(13, *r.Subject)
+	}
+	if r.ReceivedTime != nil {
+		e.string(14, *r.ReceivedTime)
+	}
+	for _, v := range r.Parent {
+		e.message(15, v)
+	}
+	for _, v := range r.Request {
+		e.message(16, v)
+	}
+	if r.Collection != nil {
+		e.message(17, *r.Collection)
+	}
+	for _, v := range r.Processing {
+		e.message(18, v)
+	}
+	for _, v := range r.Container {
+		e.message(19, v)
+	}
+	for _, v := range r.Condition {
+		e.message(20, v)
+	}
+	for _, v := range r.Note {
+		e.message(21, v)
+	}
+	if r.IdElement != nil {
+		e.message(22, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(23, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(24, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(25, *r.StatusElement)
+	}
+	if r.ReceivedTimeElement != nil {
+		e.message(26, *r.ReceivedTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Specimen from protobuf message Specimen of fhir.proto
+func (r *Specimen) UnmarshalProto(b []byte) error {
+	*r = Specimen{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.AccessionIdentifier = &v
+		case 11:
+			var v SpecimenStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Subject = &v
+		case 14:
+			v := d.string()
+			r.ReceivedTime = &v
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Parent = append(r.Parent, v)
+		case 16:
+			var v Reference
+			d.message(&v)
+			r.Request = append(r.Request, v)
+		case 17:
+			var v SpecimenCollection
+			d.message(&v)
+			r.Collection = &v
+		case 18:
+			var v SpecimenProcessing
+			d.message(&v)
+			r.Processing = append(r.Processing, v)
+		case 19:
+			var v SpecimenContainer
+			d.message(&v)
+			r.Container = append(r.Container, v)
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Condition = append(r.Condition, v)
+		case 21:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 22:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ReceivedTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SpecimenCollection as JSON into a byte slice
+func (r SpecimenCollection) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SpecimenCollection as JSON object with the members in the order of the fields
+func (r SpecimenCollection) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Collector != nil {
+		e.key("collector")
+		r.Collector.appendJSON(e)
+	}
+	if r.CollectedDateTime != nil {
+		e.key("collectedDateTime")
+		e.string(*r.CollectedDateTime)
+	}
+	if r.CollectedDateTimeElement != nil {
+		e.key("_collectedDateTime")
+		r.CollectedDateTimeElement.appendJSON(e)
+	}
+	if r.CollectedPeriod != nil {
+		e.key("collectedPeriod")
+		r.CollectedPeriod.appendJSON(e)
+	}
+	if r.Duration != nil {
+		e.key("duration")
+		r.Duration.appendJSON(e)
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.Method != nil {
+		e.key("method")
+		r.Method.appendJSON(e)
+	}
+	if r.BodySite != nil {
+		e.key("bodySite")
+		r.BodySite.appendJSON(e)
+	}
+	if r.FastingStatusCodeableConcept != nil {
+		e.key("fastingStatusCodeableConcept")
+		r.FastingStatusCodeableConcept.appendJSON(e)
+	}
+	if r.FastingStatusDuration != nil {
+		e.key("fastingStatusDuration")
+		r.FastingStatusDuration.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SpecimenCollection from JSON. Unknown members are ignored.
+func (r *SpecimenCollection) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SpecimenCollection
+func (r *SpecimenCollection) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "collector":
+			if d.null() {
+				r.Collector = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Collector = &v
+			}
+		case "collectedDateTime":
+			if v, ok := d.string(); ok {
+				r.CollectedDateTime = &v
+			} else {
+				r.CollectedDateTime = nil
+			}
+		case "_collectedDateTime":
+			r.CollectedDateTimeElement = d.element()
+		case "collectedPeriod":
+			if d.null() {
+				r.CollectedPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.CollectedPeriod = &v
+			}
+		case "duration":
+			if d.null() {
+				r.Duration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.Duration = &v
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Method = &v
+			}
+		case "bodySite":
+			if d.null() {
+				r.BodySite = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BodySite = &v
+			}
+		case "fastingStatusCodeableConcept":
+			if d.null() {
+				r.FastingStatusCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FastingStatusCodeableConcept = &v
+			}
+		case "fastingStatusDuration":
+			if d.null() {
+				r.FastingStatusDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.FastingStatusDuration = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SpecimenCollection which shares no memory with the original
+func (r SpecimenCollection) DeepCopy() SpecimenCollection {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Collector != nil {
+		v := r.Collector.DeepCopy()
+		out.Collector = &v
+	}
+	if r.CollectedDateTime != nil {
+		v := *r.CollectedDateTime
+		out.CollectedDateTime = &v
+	}
+	if r.CollectedDateTimeElement != nil {
+		v := r.CollectedDateTimeElement.DeepCopy()
+		out.CollectedDateTimeElement = &v
+	}
+	if r.CollectedPeriod != nil {
+		v := r.CollectedPeriod.DeepCopy()
+		out.CollectedPeriod = &v
+	}
+	if r.Duration != nil {
+		v := r.Duration.DeepCopy()
+		out.Duration = &v
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.Method != nil {
+		v := r.Method.DeepCopy()
+		out.Method = &v
+	}
+	if r.BodySite != nil {
+		v := r.BodySite.DeepCopy()
+		out.BodySite = &v
+	}
+	if r.FastingStatusCodeableConcept != nil {
+		v := r.FastingStatusCodeableConcept.DeepCopy()
+		out.FastingStatusCodeableConcept = &v
+	}
+	if r.FastingStatusDuration != nil {
+		v := r.FastingStatusDuration.DeepCopy()
+		out.FastingStatusDuration = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SpecimenCollection) Equal(other SpecimenCollection) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Collector == nil) != (other.Collector == nil) || r.Collector != nil && !r.Collector.Equal(*other.Collector) {
+		return false
+	}
+	if (r.CollectedDateTime == nil) != (other.CollectedDateTime == nil) || r.CollectedDateTime != nil && *r.CollectedDateTime != *other.CollectedDateTime {
+		return false
+	}
+	if (r.CollectedDateTimeElement == nil) != (other.CollectedDateTimeElement == nil) || r.CollectedDateTimeElement != nil && !r.CollectedDateTimeElement.Equal(*other.CollectedDateTimeElement) {
+		return false
+	}
+	if (r.CollectedPeriod == nil) != (other.CollectedPeriod == nil) || r.CollectedPeriod != nil && !r.CollectedPeriod.Equal(*other.CollectedPeriod) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.Equal(*other.Duration) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.Equal(*other.Method) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.Equal(*other.BodySite) {
+		return false
+	}
+	if (r.FastingStatusCodeableConcept == nil) != (other.FastingStatusCodeableConcept == nil) || r.FastingStatusCodeableConcept != nil && !r.FastingStatusCodeableConcept.Equal(*other.FastingStatusCodeableConcept) {
+		return false
+	}
+	if (r.FastingStatusDuration == nil) != (other.FastingStatusDuration == nil) || r.FastingStatusDuration != nil && !r.FastingStatusDuration.Equal(*other.FastingStatusDuration) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SpecimenCollection) EqualsDeep(other SpecimenCollection) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Collector == nil) != (other.Collector == nil) || r.Collector != nil && !r.Collector.EqualsDeep(*other.Collector) {
+		return false
+	}
+	if (r.CollectedDateTime == nil) != (other.CollectedDateTime == nil) || r.CollectedDateTime != nil && !equivalentString(*r.CollectedDateTime, *other.CollectedDateTime) {
+		return false
+	}
+	if (r.CollectedDateTimeElement == nil) != (other.CollectedDateTimeElement == nil) || r.CollectedDateTimeElement != nil && !r.CollectedDateTimeElement.EqualsDeep(*other.CollectedDateTimeElement) {
+		return false
+	}
+	if (r.CollectedPeriod == nil) != (other.CollectedPeriod == nil) || r.CollectedPeriod != nil && !r.CollectedPeriod.EqualsDeep(*other.CollectedPeriod) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.EqualsDeep(*other.Duration) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.EqualsDeep(*other.Method) {
+		return false
+	}
+	if (r.BodySite == nil) != (other.BodySite == nil) || r.BodySite != nil && !r.BodySite.EqualsDeep(*other.BodySite) {
+		return false
+	}
+	if (r.FastingStatusCodeableConcept == nil) != (other.FastingStatusCodeableConcept == nil) || r.FastingStatusCodeableConcept != nil && !r.FastingStatusCodeableConcept.EqualsDeep(*other.FastingStatusCodeableConcept) {
+		return false
+	}
+	if (r.FastingStatusDuration == nil) != (other.FastingStatusDuration == nil) || r.FastingStatusDuration != nil && !r.FastingStatusDuration.EqualsDeep(*other.FastingStatusDuration) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SpecimenCollection) EqualsShallow(other SpecimenCollection) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.CollectedDateTime == nil) != (other.CollectedDateTime == nil) || r.CollectedDateTime != nil && !equivalentString(*r.CollectedDateTime, *other.CollectedDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SpecimenCollection as FHIR XML
+func (r SpecimenCollection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "collector", r.Collector); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "collectedDateTime", r.CollectedDateTime, r.CollectedDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "collectedPeriod", r.CollectedPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "duration", r.Duration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "method", r.Method); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "bodySite", r.BodySite); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fastingStatusCodeableConcept", r.FastingStatusCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fastingStatusDuration", r.FastingStatusDuration); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SpecimenCollection from FHIR XML
+func (r *SpecimenCollection) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "collector":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Collector = &v
+			case "collectedDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CollectedDateTime = &v
+				}
+				r.CollectedDateTimeElement = element
+			case "collectedPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CollectedPeriod = &v
+			case "duration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Duration = &v
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "method":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Method = &v
+			case "bodySite":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BodySite = &v
+			case "fastingStatusCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FastingStatusCodeableConcept = &v
+			case "fastingStatusDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FastingStatusDuration = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SpecimenCollection as properties to the RDF node n
+func (r SpecimenCollection) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Specimen.collection.collector", -1, r.Collector)
+	n.primitive("Specimen.collection.collectedDateTime", -1, r.CollectedDateTime, r.CollectedDateTimeElement, "dateTime")
+	n.element("Specimen.collection.collectedPeriod", -1, r.CollectedPeriod)
+	n.element("Specimen.collection.duration", -1, r.Duration)
+	n.element("Specimen.collection.quantity", -1, r.Quantity)
+	n.element("Specimen.collection.method", -1, r.Method)
+	n.element("Specimen.collection.bodySite", -1, r.BodySite)
+	n.element("Specimen.collection.fastingStatusCodeableConcept", -1, r.FastingStatusCodeableConcept)
+	n.element("Specimen.collection.fastingStatusDuration", -1, r.FastingStatusDuration)
+}
+
+// MarshalBSON marshals the given SpecimenCollection as BSON document with the structure of its FHIR JSON
+func (r SpecimenCollection) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SpecimenCollection from a BSON document with the structure of its FHIR JSON
+func (r *SpecimenCollection) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SpecimenCollection as protobuf message SpecimenCollection of fhir.proto
+func (r SpecimenCollection) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SpecimenCollection to the encoded message
+func (r SpecimenCollection) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Collector != nil {
+		e.message(4, *r.Collector)
+	}
+	if r.CollectedDateTime != nil {
+		e.string(5, *r.CollectedDateTime)
+	}
+	if r.CollectedPeriod != nil {
+		e.message(6, *r.CollectedPeriod)
+	}
+	if r.Duration != nil {
+		e.message(7, *r.Duration)
+	}
+	if r.Quantity != nil {
+		e.message(8, *r.Quantity)
+	}
+	if r.Method != nil {
+		e.message(9, *r.Method)
+	}
+	if r.BodySite != nil {
+		e.message(10, *r.BodySite)
+	}
+	if r.FastingStatusCodeableConcept != nil {
+		e.message(11, *r.FastingStatusCodeableConcept)
+	}
+	if r.FastingStatusDuration != nil {
+		e.message(12, *r.FastingStatusDuration)
+	}
+	if r.CollectedDateTimeElement != nil {
+		e.message(13, *r.CollectedDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SpecimenCollection from protobuf message SpecimenCollection of fhir.proto
+func (r *SpecimenCollection) UnmarshalProto(b []byte) error {
+	*r = SpecimenCollection{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Collector = &v
+		case 5:
+			v := d.string()
+			r.CollectedDateTime = &v
+		case 6:
+			var v Period
+			d.message(&v)
+			r.CollectedPeriod = &v
+		case 7:
+			var v Duration
+			d.message(&v)
+			r.Duration = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Method = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.BodySite = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.FastingStatusCodeableConcept = &v
+		case 12:
+			var v Duration
+			d.message(&v)
+			r.FastingStatusDuration = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.CollectedDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SpecimenProcessing as JSON into a byte slice
+func (r SpecimenProcessing) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SpecimenProcessing as JSON object with the members in the order of the fields
+func (r SpecimenProcessing) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Procedure != nil {
+		e.key("procedure")
+		r.Procedure.appendJSON(e)
+	}
+	if len(r.Additive) > 0 {
+		e.key("additive")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Additive {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.TimeDateTime != nil {
+		e.key("timeDateTime")
+		e.string(*r.TimeDateTime)
+	}
+	if r.TimeDateTimeElement != nil {
+		e.key("_timeDateTime")
+		r.TimeDateTimeElement.appendJSON(e)
+	}
+	if r.TimePeriod != nil {
+		e.key("timePeriod")
+		r.TimePeriod.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SpecimenProcessing from JSON. Unknown members are ignored.
+func (r *SpecimenProcessing) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SpecimenProcessing
+func (r *SpecimenProcessing) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "procedure":
+			if d.null() {
+				r.Procedure = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Procedure = &v
+			}
+		case "additive":
+			a := d.array()
+			r.Additive = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Additive = append(r.Additive, v)
+			}
+			if r.Additive == nil && !a.isNull {
+				r.Additive = []Reference{}
+			}
+		case "timeDateTime":
+			if v, ok := d.string(); ok {
+				r.TimeDateTime = &v
+			} else {
+				r.TimeDateTime = nil
+			}
+		case "_timeDateTime":
+			r.TimeDateTimeElement = d.element()
+		case "timePeriod":
+			if d.null() {
+				r.TimePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.TimePeriod = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SpecimenProcessing which shares no memory with the original
+func (r SpecimenProcessing) DeepCopy() SpecimenProcessing {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Procedure != nil {
+		v := r.Procedure.DeepCopy()
+		out.Procedure = &v
+	}
+	if r.Additive != nil {
+		out.Additive = make([]Reference, len(r.Additive))
+		for i := range r.Additive {
+			out.Additive[i] = r.Additive[i].DeepCopy()
+		}
+	}
+	if r.TimeDateTime != nil {
+		v := *r.TimeDateTime
+		out.TimeDateTime = &v
+	}
+	if r.TimeDateTimeElement != nil {
+		v := r.TimeDateTimeElement.DeepCopy()
+		out.TimeDateTimeElement = &v
+	}
+	if r.TimePeriod != nil {
+		v := r.TimePeriod.DeepCopy()
+		out.TimePeriod = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SpecimenProcessing) Equal(other SpecimenProcessing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Procedure == nil) != (other.Procedure == nil) || r.Procedure != nil && !r.Procedure.Equal(*other.Procedure) {
+		return false
+	}
+	if len(r.Additive) != len(other.Additive) {
+		return false
+	}
+	for i := range r.Additive {
+		if !r.Additive[i].Equal(other.Additive[i]) {
+			return false
+		}
+	}
+	if (r.TimeDateTime == nil) != (other.TimeDateTime == nil) || r.TimeDateTime != nil && *r.TimeDateTime != *other.TimeDateTime {
+		return false
+	}
+	if (r.TimeDateTimeElement == nil) != (other.TimeDateTimeElement == nil) || r.TimeDateTimeElement != nil && !r.TimeDateTimeElement.Equal(*other.TimeDateTimeElement) {
+		return false
+	}
+	if (r.TimePeriod == nil) != (other.TimePeriod == nil) || r.TimePeriod != nil && !r.TimePeriod.Equal(*other.TimePeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SpecimenProcessing) EqualsDeep(other SpecimenProcessing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Procedure == nil) != (other.Procedure == nil) || r.Procedure != nil && !r.Procedure.EqualsDeep(*other.Procedure) {
+		return false
+	}
+	if !equivalentList(len(r.Additive), len(other.Additive), func(i, j int) bool {
+		return r.Additive[i].EqualsDeep(other.Additive[j])
+	}) {
+		return false
+	}
+	if (r.TimeDateTime == nil) != (other.TimeDateTime == nil) || r.TimeDateTime != nil && !equivalentString(*r.TimeDateTime, *other.TimeDateTime) {
+		return false
+	}
+	if (r.TimeDateTimeElement == nil) != (other.TimeDateTimeElement == nil) || r.TimeDateTimeElement != nil && !r.TimeDateTimeElement.EqualsDeep(*other.TimeDateTimeElement) {
+		return false
+	}
+	if (r.TimePeriod == nil) != (other.TimePeriod == nil) || r.TimePeriod != nil && !r.TimePeriod.EqualsDeep(*other.TimePeriod) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SpecimenProcessing) EqualsShallow(other SpecimenProcessing) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.TimeDateTime == nil) != (other.TimeDateTime == nil) || r.TimeDateTime != nil && !equivalentString(*r.TimeDateTime, *other.TimeDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SpecimenProcessing as FHIR XML
+func (r SpecimenProcessing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "procedure", r.Procedure); err != nil {
+		return err
+	}
+	for _, v := range r.Additive {
+		if err := encodeXMLElement(e, "additive", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "timeDateTime", r.TimeDateTime, r.TimeDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "timePeriod", r.TimePeriod); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SpecimenProcessing from FHIR XML
+func (r *SpecimenProcessing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "procedure":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Procedure = &v
+			case "additive":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Additive = append(r.Additive, v)
+			case "timeDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimeDateTime = &v
+				}
+				r.TimeDateTimeElement = element
+			case "timePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TimePeriod = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SpecimenProcessing as properties to the RDF node n
+func (r SpecimenProcessing) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Specimen.processing.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("Specimen.processing.procedure", -1, r.Procedure)
+	for i, v := range r.Additive {
+		n.element("Specimen.processing.additive", i, v)
+	}
+	n.primitive("Specimen.processing.timeDateTime", -1, r.TimeDateTime, r.TimeDateTimeElement, "dateTime")
+	n.element("Specimen.processing.timePeriod", -1, r.TimePeriod)
+}
+
+// MarshalBSON marshals the given SpecimenProcessing as BSON document with the structure of its FHIR JSON
+func (r SpecimenProcessing) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SpecimenProcessing from a BSON document with the structure of its FHIR JSON
+func (r *SpecimenProcessing) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SpecimenProcessing as protobuf message SpecimenProcessing of fhir.proto
+func (r SpecimenProcessing) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SpecimenProcessing to the encoded message
+func (r SpecimenProcessing) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != nil {
+		e.string(4, *r.Description)
+	}
+	if r.Procedure != nil {
+		e.message(5, *r.Procedure)
+	}
+	for _, v := range r.Additive {
+		e.message(6, v)
+	}
+	if r.TimeDateTime != nil {
+		e.string(7, *r.TimeDateTime)
+	}
+	if r.TimePeriod != nil {
+		e.message(8, *r.TimePeriod)
+	}
+	if r.DescriptionElement != nil {
+		e.message(9, *r.DescriptionElement)
+	}
+	if r.TimeDateTimeElement != nil {
+		e.message(10, *r.TimeDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SpecimenProcessing from protobuf message SpecimenProcessing of fhir.proto
+func (r *SpecimenProcessing) UnmarshalProto(b []byte) error {
+	*r = SpecimenProcessing{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Description = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Procedure = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Additive = append(r.Additive, v)
+		case 7:
+			v := d.string()
+			r.TimeDateTime = &v
+		case 8:
+			var v Period
+			d.message(&v)
+			r.TimePeriod = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.TimeDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SpecimenContainer as JSON into a byte slice
+func (r SpecimenContainer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SpecimenContainer as JSON object with the members in the order of the fields
+func (r SpecimenContainer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Capacity != nil {
+		e.key("capacity")
+		r.Capacity.appendJSON(e)
+	}
+	if r.SpecimenQuantity != nil {
+		e.key("specimenQuantity")
+		r.SpecimenQuantity.appendJSON(e)
+	}
+	if r.AdditiveCodeableConcept != nil {
+		e.key("additiveCodeableConcept")
+		r.AdditiveCodeableConcept.appendJSON(e)
+	}
+	if r.AdditiveReference != nil {
+		e.key("additiveReference")
+		r.AdditiveReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SpecimenContainer from JSON. Unknown members are ignored.
+func (r *SpecimenContainer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SpecimenContainer
+func (r *SpecimenContainer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "capacity":
+			if d.null() {
+				r.Capacity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Capacity = &v
+			}
+		case "specimenQuantity":
+			if d.null() {
+				r.SpecimenQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.SpecimenQuantity = &v
+			}
+		case "additiveCodeableConcept":
+			if d.null() {
+				r.AdditiveCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdditiveCodeableConcept = &v
+			}
+		case "additiveReference":
+			if d.null() {
+				r.AdditiveReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.AdditiveReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SpecimenContainer which shares no memory with the original
+func (r SpecimenContainer) DeepCopy() SpecimenContainer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Capacity != nil {
+		v := r.Capacity.DeepCopy()
+		out.Capacity = &v
+	}
+	if r.SpecimenQuantity != nil {
+		v := r.SpecimenQuantity.DeepCopy()
+		out.SpecimenQuantity = &v
+	}
+	if r.AdditiveCodeableConcept != nil {
+		v := r.AdditiveCodeableConcept.DeepCopy()
+		out.AdditiveCodeableConcept = &v
+	}
+	if r.AdditiveReference != nil {
+		v := r.AdditiveReference.DeepCopy()
+		out.AdditiveReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SpecimenContainer) Equal(other SpecimenContainer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Capacity == nil) != (other.Capacity == nil) || r.Capacity != nil && !r.Capacity.Equal(*other.Capacity) {
+		return false
+	}
+	if (r.SpecimenQuantity == nil) != (other.SpecimenQuantity == nil) || r.SpecimenQuantity != nil && !r.SpecimenQuantity.Equal(*other.SpecimenQuantity) {
+		return false
+	}
+	if (r.AdditiveCodeableConcept == nil) != (other.AdditiveCodeableConcept == nil) || r.AdditiveCodeableConcept != nil && !r.AdditiveCodeableConcept.Equal(*other.AdditiveCodeableConcept) {
+		return false
+	}
+	if (r.AdditiveReference == nil) != (other.AdditiveReference == nil) || r.AdditiveReference != nil && !r.AdditiveReference.Equal(*other.AdditiveReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SpecimenContainer) EqualsDeep(other SpecimenContainer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Capacity == nil) != (other.Capacity == nil) || r.Capacity != nil && !r.Capacity.EqualsDeep(*other.Capacity) {
+		return false
+	}
+	if (r.SpecimenQuantity == nil) != (other.SpecimenQuantity == nil) || r.SpecimenQuantity != nil && !r.SpecimenQuantity.EqualsDeep(*other.SpecimenQuantity) {
+		return false
+	}
+	if (r.AdditiveCodeableConcept == nil) != (other.AdditiveCodeableConcept == nil) || r.AdditiveCodeableConcept != nil && !r.AdditiveCodeableConcept.EqualsDeep(*other.AdditiveCodeableConcept) {
+		return false
+	}
+	if (r.AdditiveReference == nil) != (other.AdditiveReference == nil) || r.AdditiveReference != nil && !r.AdditiveReference.EqualsDeep(*other.AdditiveReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SpecimenContainer) EqualsShallow(other SpecimenContainer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SpecimenContainer as FHIR XML
+func (r SpecimenContainer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "capacity", r.Capacity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "specimenQuantity", r.SpecimenQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "additiveCodeableConcept", r.AdditiveCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "additiveReference", r.AdditiveReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SpecimenContainer from FHIR XML
+func (r *SpecimenContainer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "capacity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Capacity = &v
+			case "specimenQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SpecimenQuantity = &v
+			case "additiveCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditiveCodeableConcept = &v
+			case "additiveReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditiveReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SpecimenContainer as properties to the RDF node n
+func (r SpecimenContainer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Specimen.container.identifier", i, v)
+	}
+	n.primitive("Specimen.container.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("Specimen.container.type", -1, r.Type)
+	n.element("Specimen.container.capacity", -1, r.Capacity)
+	n.element("Specimen.container.specimenQuantity", -1, r.SpecimenQuantity)
+	n.element("Specimen.container.additiveCodeableConcept", -1, r.AdditiveCodeableConcept)
+	n.element("Specimen.container.additiveReference", -1, r.AdditiveReference)
+}
+
+// MarshalBSON marshals the given SpecimenContainer as BSON document with the structure of its FHIR JSON
+func (r SpecimenContainer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SpecimenContainer from a BSON document with the structure of its FHIR JSON
+func (r *SpecimenContainer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SpecimenContainer as protobuf message SpecimenContainer of fhir.proto
+func (r SpecimenContainer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SpecimenContainer to the encoded message
+func (r SpecimenContainer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(4, v)
+	}
+	if r.Description != nil {
+		e.string(5, *r.Description)
+	}
+	if r.Type != nil {
+		e.message(6, *r.Type)
+	}
+	if r.Capacity != nil {
+		e.message(7, *r.Capacity)
+	}
+	if r.SpecimenQuantity != nil {
+		e.message(8, *r.SpecimenQuantity)
+	}
+	if r.AdditiveCodeableConcept != nil {
+		e.message(9, *r.AdditiveCodeableConcept)
+	}
+	if r.AdditiveReference != nil {
+		e.message(10, *r.AdditiveReference)
+	}
+	if r.DescriptionElement != nil {
+		e.message(11, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SpecimenContainer from protobuf message SpecimenContainer of fhir.proto
+func (r *SpecimenContainer) UnmarshalProto(b []byte) error {
+	*r = SpecimenContainer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 5:
+			v := d.string()
+			r.Description = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 7:
+			var v Quantity
+			d.message(&v)
+			r.Capacity = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.SpecimenQuantity = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdditiveCodeableConcept = &v
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.AdditiveReference = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSpecimen unmarshals a Specimen.
 func UnmarshalSpecimen(b []byte) (Specimen, error) {
 	var specimen Specimen
-	if err := json.Unmarshal(b, &specimen); err != nil {
+	if err := specimen.UnmarshalJSON(b); err != nil {
 		return specimen, err
 	}
 	return specimen, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code SpecimenContainedPreference) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SpecimenContainedPreference) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SpecimenContainedPreference) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "preferred":
 		*code = SpecimenContainedPreferencePreferred
@@ -46,6 +50,12 @@ func (code *SpecimenContainedPreference) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SpecimenContainedPreference) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SpecimenContainedPreference) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SpecimenContainedPreference) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code SpecimenContainedPreference) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SpecimenContainedPreference) protoNumber() uint64 {
+	switch code {
+	case SpecimenContainedPreferencePreferred:
+		return 1
+	case SpecimenContainedPreferenceAlternate:
+		return 2
+	}
+	return 0
+}
+func (code *SpecimenContainedPreference) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SpecimenContainedPreferencePreferred
+	case 2:
+		*code = SpecimenContainedPreferenceAlternate
+	default:
+		return false
+	}
+	return true
+}
@@ -14,61 +14,148 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // SpecimenDefinition is documented here http://hl7.org/fhir/StructureDefinition/SpecimenDefinition
 type SpecimenDefinition struct {
-	Id                 *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                        `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         *Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	TypeCollected      *CodeableConcept               `bson:"typeCollected,omitempty" json:"typeCollected,omitempty"`
-	PatientPreparation []CodeableConcept              `bson:"patientPreparation,omitempty" json:"patientPreparation,omitempty"`
-	TimeAspect         *string                        `bson:"timeAspect,omitempty" json:"timeAspect,omitempty"`
-	Collection         []CodeableConcept              `bson:"collection,omitempty" json:"collection,omitempty"`
-	TypeTested         []SpecimenDefinitionTypeTested `bson:"typeTested,omitempty" json:"typeTested,omitempty"`
+	Id                   *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                       `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                       `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                        `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           *Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	TypeCollected        *CodeableConcept               `bson:"typeCollected,omitempty" json:"typeCollected,omitempty"`
+	PatientPreparation   []CodeableConcept              `bson:"patientPreparation,omitempty" json:"patientPreparation,omitempty"`
+	TimeAspect           *string                        `bson:"timeAspect,omitempty" json:"timeAspect,omitempty"`
+	TimeAspectElement    *Element                       `bson:"_timeAspect,omitempty" json:"_timeAspect,omitempty"`
+	Collection           []CodeableConcept              `bson:"collection,omitempty" json:"collection,omitempty"`
+	TypeTested           []SpecimenDefinitionTypeTested `bson:"typeTested,omitempty" json:"typeTested,omitempty"`
 }
 type SpecimenDefinitionTypeTested struct {
 	Id                 *string                                `bson:"id,omitempty" json:"id,omitempty"`
 	Extension          []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension  []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	IsDerived          *bool                                  `bson:"isDerived,omitempty" json:"isDerived,omitempty"`
+	IsDerivedElement   *Element                               `bson:"_isDerived,omitempty" json:"_isDerived,omitempty"`
 	Type               *CodeableConcept                       `bson:"type,omitempty" json:"type,omitempty"`
 	Preference         SpecimenContainedPreference            `bson:"preference" json:"preference"`
+	PreferenceElement  *Element                               `bson:"_preference,omitempty" json:"_preference,omitempty"`
 	Container          *SpecimenDefinitionTypeTestedContainer `bson:"container,omitempty" json:"container,omitempty"`
 	Requirement        *string                                `bson:"requirement,omitempty" json:"requirement,omitempty"`
+	RequirementElement *Element                               `bson:"_requirement,omitempty" json:"_requirement,omitempty"`
 	RetentionTime      *Duration                              `bson:"retentionTime,omitempty" json:"retentionTime,omitempty"`
 	RejectionCriterion []CodeableConcept                      `bson:"rejectionCriterion,omitempty" json:"rejectionCriterion,omitempty"`
 	Handling           []SpecimenDefinitionTypeTestedHandling `bson:"handling,omitempty" json:"handling,omitempty"`
 }
 type SpecimenDefinitionTypeTestedContainer struct {
-	Id                    *string                                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension             []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Material              *CodeableConcept                                `bson:"material,omitempty" json:"material,omitempty"`
-	Type                  *CodeableConcept                                `bson:"type,omitempty" json:"type,omitempty"`
-	Cap                   *CodeableConcept                                `bson:"cap,omitempty" json:"cap,omitempty"`
-	Description           *string                                         `bson:"description,omitempty" json:"description,omitempty"`
-	Capacity              *Quantity                                       `bson:"capacity,omitempty" json:"capacity,omitempty"`
-	MinimumVolumeQuantity *Quantity                                       `bson:"minimumVolumeQuantity,omitempty" json:"minimumVolumeQuantity,omitempty"`
-	MinimumVolumeString   *string                                         `bson:"minimumVolumeString,omitempty" json:"minimumVolumeString,omitempty"`
-	Additive              []SpecimenDefinitionTypeTestedContainerAdditive `bson:"additive,omitempty" json:"additive,omitempty"`
-	Preparation           *string                                         `bson:"preparation,omitempty" json:"preparation,omitempty"`
+	Id                         *string                                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Material                   *CodeableConcept                                `bson:"material,omitempty" json:"material,omitempty"`
+	Type                       *CodeableConcept                                `bson:"type,omitempty" json:"type,omitempty"`
+	Cap                        *CodeableConcept                                `bson:"cap,omitempty" json:"cap,omitempty"`
+	Description                *string                                         `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement         *Element                                        `bson:"_description,omitempty" json:"_description,omitempty"`
+	Capacity                   *Quantity                                       `bson:"capacity,omitempty" json:"capacity,omitempty"`
+	MinimumVolumeQuantity      *Quantity                                       `bson:"minimumVolumeQuantity,omitempty" json:"minimumVolumeQuantity,omitempty"`
+	MinimumVolumeString        *string                                         `bson:"minimumVolumeString,omitempty" json:"minimumVolumeString,omitempty"`
+	MinimumVolumeStringElement *Element                                        `bson:"_minimumVolumeString,omitempty" json:"_minimumVolumeString,omitempty"`
+	Additive                   []SpecimenDefinitionTypeTestedContainerAdditive `bson:"additive,omitempty" json:"additive,omitempty"`
+	Preparation                *string                                         `bson:"preparation,omitempty" json:"preparation,omitempty"`
+	PreparationElement         *Element                                        `bson:"_preparation,omitempty" json:"_preparation,omitempty"`
 }
+
+// SpecimenDefinitionTypeTestedContainerMinimumVolume is implemented by the types allowed for SpecimenDefinition.typeTested.container.minimumVolume[x]
+type SpecimenDefinitionTypeTestedContainerMinimumVolume interface {
+	isSpecimenDefinitionTypeTestedContainerMinimumVolume()
+}
+
+func (Quantity) isSpecimenDefinitionTypeTestedContainerMinimumVolume() {}
+func (String) isSpecimenDefinitionTypeTestedContainerMinimumVolume()   {}
+
+// MinimumVolume returns the value of SpecimenDefinition.typeTested.container.minimumVolume[x] together with its FHIR type code or nil if no value is set
+func (r SpecimenDefinitionTypeTestedContainer) MinimumVolume() (SpecimenDefinitionTypeTestedContainerMinimumVolume, string) {
+	if r.MinimumVolumeQuantity != nil {
+		return *r.MinimumVolumeQuantity, "Quantity"
+	}
+	if r.MinimumVolumeString != nil {
+		return String(*r.MinimumVolumeString), "string"
+	}
+	return nil, ""
+}
+
+// SetMinimumVolume sets the value of SpecimenDefinition.typeTested.container.minimumVolume[x], given as value or pointer, and clears all other types
+func (r *SpecimenDefinitionTypeTestedContainer) SetMinimumVolume(value SpecimenDefinitionTypeTestedContainerMinimumVolume) {
+	r.MinimumVolumeQuantity = nil
+	r.MinimumVolumeString = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.MinimumVolumeQuantity = &v
+	case *Quantity:
+		r.MinimumVolumeQuantity = v
+	case String:
+		primitive := string(v)
+		r.MinimumVolumeString = &primitive
+	case *String:
+		r.MinimumVolumeString = (*string)(v)
+	}
+}
+
 type SpecimenDefinitionTypeTestedContainerAdditive struct {
-	Id                      *string         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	AdditiveCodeableConcept CodeableConcept `bson:"additiveCodeableConcept" json:"additiveCodeableConcept"`
-	AdditiveReference       Reference       `bson:"additiveReference" json:"additiveReference"`
+	Id                      *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	AdditiveCodeableConcept *CodeableConcept `bson:"additiveCodeableConcept,omitempty" json:"additiveCodeableConcept,omitempty"`
+	AdditiveReference       *Reference       `bson:"additiveReference,omitempty" json:"additiveReference,omitempty"`
+}
+
+// SpecimenDefinitionTypeTestedContainerAdditiveAdditive is implemented by the types allowed for SpecimenDefinition.typeTested.container.additive.additive[x]
+type SpecimenDefinitionTypeTestedContainerAdditiveAdditive interface {
+	isSpecimenDefinitionTypeTestedContainerAdditiveAdditive()
+}
+
+func (CodeableConcept) isSpecimenDefinitionTypeTestedContainerAdditiveAdditive() {}
+func (Reference) isSpecimenDefinitionTypeTestedContainerAdditiveAdditive()       {}
+
+// Additive returns the value of SpecimenDefinition.typeTested.container.additive.additive[x] together with its FHIR type code or nil if no value is set
+func (r SpecimenDefinitionTypeTestedContainerAdditive) Additive() (SpecimenDefinitionTypeTestedContainerAdditiveAdditive, string) {
+	if r.AdditiveCodeableConcept != nil {
+		return *r.AdditiveCodeableConcept, "CodeableConcept"
+	}
+	if r.AdditiveReference != nil {
+		return *r.AdditiveReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetAdditive sets the value of SpecimenDefinition.typeTested.container.additive.additive[x], given as value or pointer, and clears all other types
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) SetAdditive(value SpecimenDefinitionTypeTestedContainerAdditiveAdditive) {
+	r.AdditiveCodeableConcept = nil
+	r.AdditiveReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.AdditiveCodeableConcept = &v
+	case *CodeableConcept:
+		r.AdditiveCodeableConcept = v
+	case Reference:
+		r.AdditiveReference = &v
+	case *Reference:
+		r.AdditiveReference = v
+	}
 }
+
 type SpecimenDefinitionTypeTestedHandling struct {
 	Id                   *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension            []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -77,24 +164,3195 @@ type SpecimenDefinitionTypeTestedHandling struct {
 	TemperatureRange     *Range           `bson:"temperatureRange,omitempty" json:"temperatureRange,omitempty"`
 	MaxDuration          *Duration        `bson:"maxDuration,omitempty" json:"maxDuration,omitempty"`
 	Instruction          *string          `bson:"instruction,omitempty" json:"instruction,omitempty"`
+	InstructionElement   *Element         `bson:"_instruction,omitempty" json:"_instruction,omitempty"`
 }
-type OtherSpecimenDefinition SpecimenDefinition
 
 // MarshalJSON marshals the given SpecimenDefinition as JSON into a byte slice
 func (r SpecimenDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSpecimenDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSpecimenDefinition: OtherSpecimenDefinition(r),
-		ResourceType:            "SpecimenDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SpecimenDefinition as JSON object with the members in the order of the fields
+func (r SpecimenDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"SpecimenDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.TypeCollected != nil {
+		e.key("typeCollected")
+		r.TypeCollected.appendJSON(e)
+	}
+	if len(r.PatientPreparation) > 0 {
+		e.key("patientPreparation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PatientPreparation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.TimeAspect != nil {
+		e.key("timeAspect")
+		e.string(*r.TimeAspect)
+	}
+	if r.TimeAspectElement != nil {
+		e.key("_timeAspect")
+		r.TimeAspectElement.appendJSON(e)
+	}
+	if len(r.Collection) > 0 {
+		e.key("collection")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Collection {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.TypeTested) > 0 {
+		e.key("typeTested")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.TypeTested {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SpecimenDefinition from JSON. Unknown members are ignored.
+func (r *SpecimenDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SpecimenDefinition
+func (r *SpecimenDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "typeCollected":
+			if d.null() {
+				r.TypeCollected = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.TypeCollected = &v
+			}
+		case "patientPreparation":
+			a := d.array()
+			r.PatientPreparation = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PatientPreparation = append(r.PatientPreparation, v)
+			}
+			if r.PatientPreparation == nil && !a.isNull {
+				r.PatientPreparation = []CodeableConcept{}
+			}
+		case "timeAspect":
+			if v, ok := d.string(); ok {
+				r.TimeAspect = &v
+			} else {
+				r.TimeAspect = nil
+			}
+		case "_timeAspect":
+			r.TimeAspectElement = d.element()
+		case "collection":
+			a := d.array()
+			r.Collection = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Collection = append(r.Collection, v)
+			}
+			if r.Collection == nil && !a.isNull {
+				r.Collection = []CodeableConcept{}
+			}
+		case "typeTested":
+			a := d.array()
+			r.TypeTested = nil
+			for a.next() {
+				var v SpecimenDefinitionTypeTested
+				v.decodeJSON(d)
+				r.TypeTested = append(r.TypeTested, v)
+			}
+			if r.TypeTested == nil && !a.isNull {
+				r.TypeTested = []SpecimenDefinitionTypeTested{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SpecimenDefinition which shares no memory with the original
+func (r SpecimenDefinition) DeepCopy() SpecimenDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.TypeCollected != nil {
+		v := r.TypeCollected.DeepCopy()
+		out.TypeCollected = &v
+	}
+	if r.PatientPreparation != nil {
+		out.PatientPreparation = make([]CodeableConcept, len(r.PatientPreparation))
+		for i := range r.PatientPreparation {
+			out.PatientPreparation[i] = r.PatientPreparation[i].DeepCopy()
+		}
+	}
+	if r.TimeAspect != nil {
+		v := *r.TimeAspect
+		out.TimeAspect = &v
+	}
+	if r.TimeAspectElement != nil {
+		v := r.TimeAspectElement.DeepCopy()
+		out.TimeAspectElement = &v
+	}
+	if r.Collection != nil {
+		out.Collection = make([]CodeableConcept, len(r.Collection))
+		for i := range r.Collection {
+			out.Collection[i] = r.Collection[i].DeepCopy()
+		}
+	}
+	if r.TypeTested != nil {
+		out.TypeTested = make([]SpecimenDefinitionTypeTested, len(r.TypeTested))
+		for i := range r.TypeTested {
+			out.TypeTested[i] = r.TypeTested[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SpecimenDefinition) Equal(other SpecimenDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.TypeCollected == nil) != (other.TypeCollected == nil) || r.TypeCollected != nil && !r.TypeCollected.Equal(*other.TypeCollected) {
+		return false
+	}
+	if len(r.PatientPreparation) != len(other.PatientPreparation) {
+		return false
+	}
+	for i := range r.PatientPreparation {
+		if !r.PatientPreparation[i].Equal(other.PatientPreparation[i]) {
+			return false
+		}
+	}
+	if (r.TimeAspect == nil) != (other.TimeAspect == nil) || r.TimeAspect != nil && *r.TimeAspect != *other.TimeAspect {
+		return false
+	}
+	if (r.TimeAspectElement == nil) != (other.TimeAspectElement == nil) || r.TimeAspectElement != nil && !r.TimeAspectElement.Equal(*other.TimeAspectElement) {
+		return false
+	}
+	if len(r.Collection) != len(other.Collection) {
+		return false
+	}
+	for i := range r.Collection {
+		if !r.Collection[i].Equal(other.Collection[i]) {
+			return false
+		}
+	}
+	if len(r.TypeTested) != len(other.TypeTested) {
+		return false
+	}
+	for i := range r.TypeTested {
+		if !r.TypeTested[i].Equal(other.TypeTested[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SpecimenDefinition) EqualsDeep(other SpecimenDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.TypeCollected == nil) != (other.TypeCollected == nil) || r.TypeCollected != nil && !r.TypeCollected.EqualsDeep(*other.TypeCollected) {
+		return false
+	}
+	if !equivalentList(len(r.PatientPreparation), len(other.PatientPreparation), func(i, j int) bool {
+		return r.PatientPreparation[i].EqualsDeep(other.PatientPreparation[j])
+	}) {
+		return false
+	}
+	if (r.TimeAspect == nil) != (other.TimeAspect == nil) || r.TimeAspect != nil && !equivalentString(*r.TimeAspect, *other.TimeAspect) {
+		return false
+	}
+	if (r.TimeAspectElement == nil) != (other.TimeAspectElement == nil) || r.TimeAspectElement != nil && !r.TimeAspectElement.EqualsDeep(*other.TimeAspectElement) {
+		return false
+	}
+	if !equivalentList(len(r.Collection), len(other.Collection), func(i, j int) bool {
+		return r.Collection[i].EqualsDeep(other.Collection[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.TypeTested), len(other.TypeTested), func(i, j int) bool {
+		return r.TypeTested[i].EqualsDeep(other.TypeTested[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SpecimenDefinition) EqualsShallow(other SpecimenDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.TimeAspect == nil) != (other.TimeAspect == nil) || r.TimeAspect != nil && !equivalentString(*r.TimeAspect, *other.TimeAspect) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SpecimenDefinition as FHIR XML
+func (r SpecimenDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "SpecimenDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "typeCollected", r.TypeCollected); err != nil {
+		return err
+	}
+	for _, v := range r.PatientPreparation {
+		if err := encodeXMLElement(e, "patientPreparation", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "timeAspect", r.TimeAspect, r.TimeAspectElement); err != nil {
+		return err
+	}
+	for _, v := range r.Collection {
+		if err := encodeXMLElement(e, "collection", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.TypeTested {
+		if err := encodeXMLElement(e, "typeTested", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SpecimenDefinition from FHIR XML
+func (r *SpecimenDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "typeCollected":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TypeCollected = &v
+			case "patientPreparation":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PatientPreparation = append(r.PatientPreparation, v)
+			case "timeAspect":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TimeAspect = &v
+				}
+				r.TimeAspectElement = element
+			case "collection":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Collection = append(r.Collection, v)
+			case "typeTested":
+				var v SpecimenDefinitionTypeTested
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TypeTested = append(r.TypeTested, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SpecimenDefinition as properties to the RDF node n
+func (r SpecimenDefinition) turtle(n *turtleNode) {
+	n.resource("SpecimenDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("SpecimenDefinition.identifier", -1, r.Identifier)
+	n.element("SpecimenDefinition.typeCollected", -1, r.TypeCollected)
+	for i, v := range r.PatientPreparation {
+		n.element("SpecimenDefinition.patientPreparation", i, v)
+	}
+	n.primitive("SpecimenDefinition.timeAspect", -1, r.TimeAspect, r.TimeAspectElement, "string")
+	for i, v := range r.Collection {
+		n.element("SpecimenDefinition.collection", i, v)
+	}
+	for i, v := range r.TypeTested {
+		n.element("SpecimenDefinition.typeTested", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SpecimenDefinition as BSON document with the structure of its FHIR JSON
+func (r SpecimenDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SpecimenDefinition from a BSON document with the structure of its FHIR JSON
+func (r *SpecimenDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SpecimenDefinition as protobuf message SpecimenDefinition of fhir.proto
+func (r SpecimenDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SpecimenDefinition to the encoded message
+func (r SpecimenDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Identifier != nil {
+		e.message(9, *r.Identifier)
+	}
+	if r.TypeCollected != nil {
+		e.message(10, *r.TypeCollected)
+	}
+	for _, v := range r.PatientPreparation {
+		e.message(11, v)
+	}
+	if r.TimeAspect != nil {
+		e.string(12, *r.TimeAspect)
+	}
+	for _, v := range r.Collection {
+		e.message(13, v)
+	}
+	for _, v := range r.TypeTested {
+		e.message(14, v)
+	}
+	if r.IdElement != nil {
+		e.message(15, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(16, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(17, *r.LanguageElement)
+	}
+	if r.TimeAspectElement != nil {
+		e.message(18, *r.TimeAspectElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SpecimenDefinition from protobuf message SpecimenDefinition of fhir.proto
+func (r *SpecimenDefinition) UnmarshalProto(b []byte) error {
+	*r = SpecimenDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.TypeCollected = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.PatientPreparation = append(r.PatientPreparation, v)
+		case 12:
+			v := d.string()
+			r.TimeAspect = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.Collection = append(r.Collection, v)
+		case 14:
+			var v SpecimenDefinitionTypeTested
+			d.message(&v)
+			r.TypeTested = append(r.TypeTested, v)
+		case 15:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.TimeAspectElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SpecimenDefinitionTypeTested as JSON into a byte slice
+func (r SpecimenDefinitionTypeTested) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SpecimenDefinitionTypeTested as JSON object with the members in the order of the fields
+func (r SpecimenDefinitionTypeTested) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.IsDerived != nil {
+		e.key("isDerived")
+		e.bool(*r.IsDerived)
+	}
+	if r.IsDerivedElement != nil {
+		e.key("_isDerived")
+		r.IsDerivedElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	e.key("preference")
+	e.string(r.Preference.Code())
+	if r.PreferenceElement != nil {
+		e.key("_preference")
+		r.PreferenceElement.appendJSON(e)
+	}
+	if r.Container != nil {
+		e.key("container")
+		r.Container.appendJSON(e)
+	}
+	if r.Requirement != nil {
+		e.key("requirement")
+		e.string(*r.Requirement)
+	}
+	if r.RequirementElement != nil {
+		e.key("_requirement")
+		r.RequirementElement.appendJSON(e)
+	}
+	if r.RetentionTime != nil {
+		e.key("retentionTime")
+		r.RetentionTime.appendJSON(e)
+	}
+	if len(r.RejectionCriterion) > 0 {
+		e.key("rejectionCriterion")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RejectionCriterion {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Handling) > 0 {
+		e.key("handling")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Handling {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SpecimenDefinitionTypeTested from JSON. Unknown members are ignored.
+func (r *SpecimenDefinitionTypeTested) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SpecimenDefinitionTypeTested
+func (r *SpecimenDefinitionTypeTested) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "isDerived":
+			if v, ok := d.bool(); ok {
+				r.IsDerived = &v
+			} else {
+				r.IsDerived = nil
+			}
+		case "_isDerived":
+			r.IsDerivedElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "preference":
+			d.unmarshal(&r.Preference)
+		case "_preference":
+			r.PreferenceElement = d.element()
+		case "container":
+			if d.null() {
+				r.Container = nil
+			} else {
+				var v SpecimenDefinitionTypeTestedContainer
+				v.decodeJSON(d)
+				r.Container = &v
+			}
+		case "requirement":
+			if v, ok := d.string(); ok {
+				r.Requirement = &v
+			} else {
+				r.Requirement = nil
+			}
+		case "_requirement":
+			r.RequirementElement = d.element()
+		case "retentionTime":
+			if d.null() {
+				r.RetentionTime = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.RetentionTime = &v
+			}
+		case "rejectionCriterion":
+			a := d.array()
+			r.RejectionCriterion = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.RejectionCriterion = append(r.RejectionCriterion, v)
+			}
+			if r.RejectionCriterion == nil && !a.isNull {
+				r.RejectionCriterion = []CodeableConcept{}
+			}
+		case "handling":
+			a := d.array()
+			r.Handling = nil
+			for a.next() {
+				var v SpecimenDefinitionTypeTestedHandling
+				v.decodeJSON(d)
+				r.Handling = append(r.Handling, v)
+			}
+			if r.Handling == nil && !a.isNull {
+				r.Handling = []SpecimenDefinitionTypeTestedHandling{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SpecimenDefinitionTypeTested which shares no memory with the original
+func (r SpecimenDefinitionTypeTested) DeepCopy() SpecimenDefinitionTypeTested {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.IsDerived != nil {
+		v := *r.IsDerived
+		out.IsDerived = &v
+	}
+	if r.IsDerivedElement != nil {
+		v := r.IsDerivedElement.DeepCopy()
+		out.IsDerivedElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.PreferenceElement != nil {
+		v := r.PreferenceElement.DeepCopy()
+		out.PreferenceElement = &v
+	}
+	if r.Container != nil {
+		v := r.Container.DeepCopy()
+		out.Container = &v
+	}
+	if r.Requirement != nil {
+		v := *r.Requirement
+		out.Requirement = &v
+	}
+	if r.RequirementElement != nil {
+		v := r.RequirementElement.DeepCopy()
+		out.RequirementElement = &v
+	}
+	if r.RetentionTime != nil {
+		v := r.RetentionTime.DeepCopy()
+		out.RetentionTime = &v
+	}
+	if r.RejectionCriterion != nil {
+		out.RejectionCriterion = make([]CodeableConcept, len(r.RejectionCriterion))
+		for i := range r.RejectionCriterion {
+			out.RejectionCriterion[i] = r.RejectionCriterion[i].DeepCopy()
+		}
+	}
+	if r.Handling != nil {
+		out.Handling = make([]SpecimenDefinitionTypeTestedHandling, len(r.Handling))
+		for i := range r.Handling {
+			out.Handling[i] = r.Handling[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SpecimenDefinitionTypeTested) Equal(other SpecimenDefinitionTypeTested) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.IsDerived == nil) != (other.IsDerived == nil) || r.IsDerived != nil && *r.IsDerived != *other.IsDerived {
+		return false
+	}
+	if (r.IsDerivedElement == nil) != (other.IsDerivedElement == nil) || r.IsDerivedElement != nil && !r.IsDerivedElement.Equal(*other.IsDerivedElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if r.Preference != other.Preference {
+		return false
+	}
+	if (r.PreferenceElement == nil) != (other.PreferenceElement == nil) || r.PreferenceElement != nil && !r.PreferenceElement.Equal(*other.PreferenceElement) {
+		return false
+	}
+	if (r.Container == nil) != (other.Container == nil) || r.Container != nil && !r.Container.Equal(*other.Container) {
+		return false
+	}
+	if (r.Requirement == nil) != (other.Requirement == nil) || r.Requirement != nil && *r.Requirement != *other.Requirement {
+		return false
+	}
+	if (r.RequirementElement == nil) != (other.RequirementElement == nil) || r.RequirementElement != nil && !r.RequirementElement.Equal(*other.RequirementElement) {
+		return false
+	}
+	if (r.RetentionTime == nil) != (other.RetentionTime == nil) || r.RetentionTime != nil && !r.RetentionTime.Equal(*other.RetentionTime) {
+		return false
+	}
+	if len(r.RejectionCriterion) != len(other.RejectionCriterion) {
+		return false
+	}
+	for i := range r.RejectionCriterion {
+		if !r.RejectionCriterion[i].Equal(other.RejectionCriterion[i]) {
+			return false
+		}
+	}
+	if len(r.Handling) != len(other.Handling) {
+		return false
+	}
+	for i := range r.Handling {
+		if !r.Handling[i].Equal(other.Handling[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SpecimenDefinitionTypeTested) EqualsDeep(other SpecimenDefinitionTypeTested) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.IsDerived == nil) != (other.IsDerived == nil) || r.IsDerived != nil && *r.IsDerived != *other.IsDerived {
+		return false
+	}
+	if (r.IsDerivedElement == nil) != (other.IsDerivedElement == nil) || r.IsDerivedElement != nil && !r.IsDerivedElement.EqualsDeep(*other.IsDerivedElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if r.Preference != other.Preference {
+		return false
+	}
+	if (r.PreferenceElement == nil) != (other.PreferenceElement == nil) || r.PreferenceElement != nil && !r.PreferenceElement.EqualsDeep(*other.PreferenceElement) {
+		return false
+	}
+	if (r.Container == nil) != (other.Container == nil) || r.Container != nil && !r.Container.EqualsDeep(*other.Container) {
+		return false
+	}
+	if (r.Requirement == nil) != (other.Requirement == nil) || r.Requirement != nil && !equivalentString(*r.Requirement, *other.Requirement) {
+		return false
+	}
+	if (r.RequirementElement == nil) != (other.RequirementElement == nil) || r.RequirementElement != nil && !r.RequirementElement.EqualsDeep(*other.RequirementElement) {
+		return false
+	}
+	if (r.RetentionTime == nil) != (other.RetentionTime == nil) || r.RetentionTime != nil && !r.RetentionTime.EqualsDeep(*other.RetentionTime) {
+		return false
+	}
+	if !equivalentList(len(r.RejectionCriterion), len(other.RejectionCriterion), func(i, j int) bool {
+		return r.RejectionCriterion[i].EqualsDeep(other.RejectionCriterion[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Handling), len(other.Handling), func(i, j int) bool {
+		return r.Handling[i].EqualsDeep(other.Handling[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SpecimenDefinitionTypeTested) EqualsShallow(other SpecimenDefinitionTypeTested) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IsDerived == nil) != (other.IsDerived == nil) || r.IsDerived != nil && *r.IsDerived != *other.IsDerived {
+		return false
+	}
+	if r.Preference != other.Preference {
+		return false
+	}
+	if (r.Requirement == nil) != (other.Requirement == nil) || r.Requirement != nil && !equivalentString(*r.Requirement, *other.Requirement) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SpecimenDefinitionTypeTested as FHIR XML
+func (r SpecimenDefinitionTypeTested) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "isDerived", r.IsDerived, r.IsDerivedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "preference", r.Preference, r.PreferenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "container", r.Container); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "requirement", r.Requirement, r.RequirementElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "retentionTime", r.RetentionTime); err != nil {
+		return err
+	}
+	for _, v := range r.RejectionCriterion {
+		if err := encodeXMLElement(e, "rejectionCriterion", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Handling {
+		if err := encodeXMLElement(e, "handling", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SpecimenDefinitionTypeTested from FHIR XML
+func (r *SpecimenDefinitionTypeTested) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "isDerived":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsDerived = &v
+				}
+				r.IsDerivedElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "preference":
+				var v SpecimenContainedPreference
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Preference = v
+				}
+				r.PreferenceElement = element
+			case "container":
+				var v SpecimenDefinitionTypeTestedContainer
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Container = &v
+			case "requirement":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Requirement = &v
+				}
+				r.RequirementElement = element
+			case "retentionTime":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RetentionTime = &v
+			case "rejectionCriterion":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RejectionCriterion = append(r.RejectionCriterion, v)
+			case "handling":
+				var v SpecimenDefinitionTypeTestedHandling
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Handling = append(r.Handling, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SpecimenDefinitionTypeTested as properties to the RDF node n
+func (r SpecimenDefinitionTypeTested) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("SpecimenDefinition.typeTested.isDerived", -1, r.IsDerived, r.IsDerivedElement, "boolean")
+	n.element("SpecimenDefinition.typeTested.type", -1, r.Type)
+	n.primitive("SpecimenDefinition.typeTested.preference", -1, r.Preference, r.PreferenceElement, "code")
+	n.element("SpecimenDefinition.typeTested.container", -1, r.Container)
+	n.primitive("SpecimenDefinition.typeTested.requirement", -1, r.Requirement, r.RequirementElement, "string")
+	n.element("SpecimenDefinition.typeTested.retentionTime", -1, r.RetentionTime)
+	for i, v := range r.RejectionCriterion {
+		n.element("SpecimenDefinition.typeTested.rejectionCriterion", i, v)
+	}
+	for i, v := range r.Handling {
+		n.element("SpecimenDefinition.typeTested.handling", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SpecimenDefinitionTypeTested as BSON document with the structure of its FHIR JSON
+func (r SpecimenDefinitionTypeTested) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SpecimenDefinitionTypeTested from a BSON document with the structure of its FHIR JSON
+func (r *SpecimenDefinitionTypeTested) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SpecimenDefinitionTypeTested as protobuf message SpecimenDefinitionTypeTested of fhir.proto
+func (r SpecimenDefinitionTypeTested) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SpecimenDefinitionTypeTested to the encoded message
+func (r SpecimenDefinitionTypeTested) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.IsDerived != nil {
+		e.varint(4, protoBool(*r.IsDerived))
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	e.varint(6, r.Preference.protoNumber())
+	if r.Container != nil {
+		e.message(7, *r.Container)
+	}
+	if r.Requirement != nil {
+		e.string(8, *r.Requirement)
+	}
+	if r.RetentionTime != nil {
+		e.message(9, *r.RetentionTime)
+	}
+	for _, v := range r.RejectionCriterion {
+		e.message(10, v)
+	}
+	for _, v := range r.Handling {
+		e.message(11, v)
+	}
+	if r.IsDerivedElement != nil {
+		e.message(12, *r.IsDerivedElement)
+	}
+	if r.PreferenceElement != nil {
+		e.message(13, *r.PreferenceElement)
+	}
+	if r.RequirementElement != nil {
+		e.message(14, *r.RequirementElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SpecimenDefinitionTypeTested from protobuf message SpecimenDefinitionTypeTested of fhir.proto
+func (r *SpecimenDefinitionTypeTested) UnmarshalProto(b []byte) error {
+	*r = SpecimenDefinitionTypeTested{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.bool()
+			r.IsDerived = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v SpecimenContainedPreference
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Preference = v
+		case 7:
+			var v SpecimenDefinitionTypeTestedContainer
+			d.message(&v)
+			r.Container = &v
+		case 8:
+			v := d.string()
+			r.Requirement = &v
+		case 9:
+			var v Duration
+			d.message(&v)
+			r.RetentionTime = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.RejectionCriterion = append(r.RejectionCriterion, v)
+		case 11:
+			var v SpecimenDefinitionTypeTestedHandling
+			d.message(&v)
+			r.Handling = append(r.Handling, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.IsDerivedElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.PreferenceElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.RequirementElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SpecimenDefinitionTypeTestedContainer as JSON into a byte slice
+func (r SpecimenDefinitionTypeTestedContainer) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SpecimenDefinitionTypeTestedContainer as JSON object with the members in the order of the fields
+func (r SpecimenDefinitionTypeTestedContainer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Material != nil {
+		e.key("material")
+		r.Material.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Cap != nil {
+		e.key("cap")
+		r.Cap.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Capacity != nil {
+		e.key("capacity")
+		r.Capacity.appendJSON(e)
+	}
+	if r.MinimumVolumeQuantity != nil {
+		e.key("minimumVolumeQuantity")
+		r.MinimumVolumeQuantity.appendJSON(e)
+	}
+	if r.MinimumVolumeString != nil {
+		e.key("minimumVolumeString")
+		e.string(*r.MinimumVolumeString)
+	}
+	if r.MinimumVolumeStringElement != nil {
+		e.key("_minimumVolumeString")
+		r.MinimumVolumeStringElement.appendJSON(e)
+	}
+	if len(r.Additive) > 0 {
+		e.key("additive")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Additive {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Preparation != nil {
+		e.key("preparation")
+		e.string(*r.Preparation)
+	}
+	if r.PreparationElement != nil {
+		e.key("_preparation")
+		r.PreparationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SpecimenDefinitionTypeTestedContainer from JSON. Unknown members are ignored.
+func (r *SpecimenDefinitionTypeTestedContainer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SpecimenDefinitionTypeTestedContainer
+func (r *SpecimenDefinitionTypeTestedContainer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "material":
+			if d.null() {
+				r.Material = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Material = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "cap":
+			if d.null() {
+				r.Cap = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Cap = &v
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "capacity":
+			if d.null() {
+				r.Capacity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Capacity = &v
+			}
+		case "minimumVolumeQuantity":
+			if d.null() {
+				r.MinimumVolumeQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.MinimumVolumeQuantity = &v
+			}
+		case "minimumVolumeString":
+			if v, ok := d.string(); ok {
+				r.MinimumVolumeString = &v
+			} else {
+				r.MinimumVolumeString = nil
+			}
+		case "_minimumVolumeString":
+			r.MinimumVolumeStringElement = d.element()
+		case "additive":
+			a := d.array()
+			r.Additive = nil
+			for a.next() {
+				var v SpecimenDefinitionTypeTestedContainerAdditive
+				v.decodeJSON(d)
+				r.Additive = append(r.Additive, v)
+			}
+			if r.Additive == nil && !a.isNull {
+				r.Additive = []SpecimenDefinitionTypeTestedContainerAdditive{}
+			}
+		case "preparation":
+			if v, ok := d.string(); ok {
+				r.Preparation = &v
+			} else {
+				r.Preparation = nil
+			}
+		case "_preparation":
+			r.PreparationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SpecimenDefinitionTypeTestedContainer which shares no memory with the original
+func (r SpecimenDefinitionTypeTestedContainer) DeepCopy() SpecimenDefinitionTypeTestedContainer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Material != nil {
+		v := r.Material.DeepCopy()
+		out.Material = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Cap != nil {
+		v := r.Cap.DeepCopy()
+		out.Cap = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Capacity != nil {
+		v := r.Capacity.DeepCopy()
+		out.Capacity = &v
+	}
+	if r.MinimumVolumeQuantity != nil {
+		v := r.MinimumVolumeQuantity.DeepCopy()
+		out.MinimumVolumeQuantity = &v
+	}
+	if r.MinimumVolumeString != nil {
+		v := *r.MinimumVolumeString
+		out.MinimumVolumeString = &v
+	}
+	if r.MinimumVolumeStringElement != nil {
+		v := r.MinimumVolumeStringElement.DeepCopy()
+		out.MinimumVolumeStringElement = &v
+	}
+	if r.Additive != nil {
+		out.Additive = make([]SpecimenDefinitionTypeTestedContainerAdditive, len(r.Additive))
+		for i := range r.Additive {
+			out.Additive[i] = r.Additive[i].DeepCopy()
+		}
+	}
+	if r.Preparation != nil {
+		v := *r.Preparation
+		out.Preparation = &v
+	}
+	if r.PreparationElement != nil {
+		v := r.PreparationElement.DeepCopy()
+		out.PreparationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SpecimenDefinitionTypeTestedContainer) Equal(other SpecimenDefinitionTypeTestedContainer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Material == nil) != (other.Material == nil) || r.Material != nil && !r.Material.Equal(*other.Material) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Cap == nil) != (other.Cap == nil) || r.Cap != nil && !r.Cap.Equal(*other.Cap) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Capacity == nil) != (other.Capacity == nil) || r.Capacity != nil && !r.Capacity.Equal(*other.Capacity) {
+		return false
+	}
+	if (r.MinimumVolumeQuantity == nil) != (other.MinimumVolumeQuantity == nil) || r.MinimumVolumeQuantity != nil && !r.MinimumVolumeQuantity.Equal(*other.MinimumVolumeQuantity) {
+		return false
+	}
+	if (r.MinimumVolumeString == nil) != (other.MinimumVolumeString == nil) || r.MinimumVolumeString != nil && *r.MinimumVolumeString != *other.MinimumVolumeString {
+		return false
+	}
+	if (r.MinimumVolumeStringElement == nil) != (other.MinimumVolumeStringElement == nil) || r.MinimumVolumeStringElement != nil && !r.MinimumVolumeStringElement.Equal(*other.MinimumVolumeStringElement) {
+		return false
+	}
+	if len(r.Additive) != len(other.Additive) {
+		return false
+	}
+	for i := range r.Additive {
+		if !r.Additive[i].Equal(other.Additive[i]) {
+			return false
+		}
+	}
+	if (r.Preparation == nil) != (other.Preparation == nil) || r.Preparation != nil && *r.Preparation != *other.Preparation {
+		return false
+	}
+	if (r.PreparationElement == nil) != (other.PreparationElement == nil) || r.PreparationElement != nil && !r.PreparationElement.Equal(*other.PreparationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SpecimenDefinitionTypeTestedContainer) EqualsDeep(other SpecimenDefinitionTypeTestedContainer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Material == nil) != (other.Material == nil) || r.Material != nil && !r.Material.EqualsDeep(*other.Material) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Cap == nil) != (other.Cap == nil) || r.Cap != nil && !r.Cap.EqualsDeep(*other.Cap) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Capacity == nil) != (other.Capacity == nil) || r.Capacity != nil && !r.Capacity.EqualsDeep(*other.Capacity) {
+		return false
+	}
+	if (r.MinimumVolumeQuantity == nil) != (other.MinimumVolumeQuantity == nil) || r.MinimumVolumeQuantity != nil && !r.MinimumVolumeQuantity.EqualsDeep(*other.MinimumVolumeQuantity) {
+		return false
+	}
+	if (r.MinimumVolumeString == nil) != (other.MinimumVolumeString == nil) || r.MinimumVolumeString != nil && !equivalentString(*r.MinimumVolumeString, *other.MinimumVolumeString) {
+		return false
+	}
+	if (r.MinimumVolumeStringElement == nil) != (other.MinimumVolumeStringElement == nil) || r.MinimumVolumeStringElement != nil && !r.MinimumVolumeStringElement.EqualsDeep(*other.MinimumVolumeStringElement) {
+		return false
+	}
+	if !equivalentList(len(r.Additive), len(other.Additive), func(i, j int) bool {
+		return r.Additive[i].EqualsDeep(other.Additive[j])
+	}) {
+		return false
+	}
+	if (r.Preparation == nil) != (other.Preparation == nil) || r.Preparation != nil && !equivalentString(*r.Preparation, *other.Preparation) {
+		return false
+	}
+	if (r.PreparationElement == nil) != (other.PreparationElement == nil) || r.PreparationElement != nil && !r.PreparationElement.EqualsDeep(*other.PreparationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SpecimenDefinitionTypeTestedContainer) EqualsShallow(other SpecimenDefinitionTypeTestedContainer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.MinimumVolumeString == nil) != (other.MinimumVolumeString == nil) || r.MinimumVolumeString != nil && !equivalentString(*r.MinimumVolumeString, *other.MinimumVolumeString) {
+		return false
+	}
+	if (r.Preparation == nil) != (other.Preparation == nil) || r.Preparation != nil && !equivalentString(*r.Preparation, *other.Preparation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SpecimenDefinitionTypeTestedContainer as FHIR XML
+func (r SpecimenDefinitionTypeTestedContainer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "material", r.Material); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "cap", r.Cap); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "capacity", r.Capacity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "minimumVolumeQuantity", r.MinimumVolumeQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minimumVolumeString", r.MinimumVolumeString, r.MinimumVolumeStringElement); err != nil {
+		return err
+	}
+	for _, v := range r.Additive {
+		if err := encodeXMLElement(e, "additive", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "preparation", r.Preparation, r.PreparationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SpecimenDefinitionTypeTestedContainer from FHIR XML
+func (r *SpecimenDefinitionTypeTestedContainer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "material":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Material = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "cap":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Cap = &v
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "capacity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Capacity = &v
+			case "minimumVolumeQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MinimumVolumeQuantity = &v
+			case "minimumVolumeString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinimumVolumeString = &v
+				}
+				r.MinimumVolumeStringElement = element
+			case "additive":
+				var v SpecimenDefinitionTypeTestedContainerAdditive
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Additive = append(r.Additive, v)
+			case "preparation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Preparation = &v
+				}
+				r.PreparationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SpecimenDefinitionTypeTestedContainer as properties to the RDF node n
+func (r SpecimenDefinitionTypeTestedContainer) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SpecimenDefinition.typeTested.container.material", -1, r.Material)
+	n.element("SpecimenDefinition.typeTested.container.type", -1, r.Type)
+	n.element("SpecimenDefinition.typeTested.container.cap", -1, r.Cap)
+	n.primitive("SpecimenDefinition.typeTested.container.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("SpecimenDefinition.typeTested.container.capacity", -1, r.Capacity)
+	n.element("SpecimenDefinition.typeTested.container.minimumVolumeQuantity", -1, r.MinimumVolumeQuantity)
+	n.primitive("SpecimenDefinition.typeTested.container.minimumVolumeString", -1, r.MinimumVolumeString, r.MinimumVolumeStringElement, "string")
+	for i, v := range r.Additive {
+		n.element("SpecimenDefinition.typeTested.container.additive", i, v)
+	}
+	n.primitive("SpecimenDefinition.typeTested.container.preparation", -1, r.Preparation, r.PreparationElement, "string")
+}
+
+// MarshalBSON marshals the given SpecimenDefinitionTypeTestedContainer as BSON document with the structure of its FHIR JSON
+func (r SpecimenDefinitionTypeTestedContainer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SpecimenDefinitionTypeTestedContainer from a BSON document with the structure of its FHIR JSON
+func (r *SpecimenDefinitionTypeTestedContainer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SpecimenDefinitionTypeTestedContainer as protobuf message SpecimenDefinitionTypeTestedContainer of fhir.proto
+func (r SpecimenDefinitionTypeTestedContainer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SpecimenDefinitionTypeTestedContainer to the encoded message
+func (r SpecimenDefinitionTypeTestedContainer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Material != nil {
+		e.message(4, *r.Material)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.Cap != nil {
+		e.message(6, *r.Cap)
+	}
+	if r.Description != nil {
+		e.string(7, *r.Description)
+	}
+	if r.Capacity != nil {
+		e.message(8, *r.Capacity)
+	}
+	if r.MinimumVolumeQuantity != nil {
+		e.message(9, *r.MinimumVolumeQuantity)
+	}
+	if r.MinimumVolumeString != nil {
+		e.string(10, *r.MinimumVolumeString)
+	}
+	for _, v := range r.Additive {
+		e.message(11, v)
+	}
+	if r.Preparation != nil {
+		e.string(12, *r.Preparation)
+	}
+	if r.DescriptionElement != nil {
+		e.message(13, *r.DescriptionElement)
+	}
+	if r.MinimumVolumeStringElement != nil {
+		e.message(14, *r.MinimumVolumeStringElement)
+	}
+	if r.PreparationElement != nil {
+		e.message(15, *r.PreparationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SpecimenDefinitionTypeTestedContainer from protobuf message SpecimenDefinitionTypeTestedContainer of fhir.proto
+func (r *SpecimenDefinitionTypeTestedContainer) UnmarshalProto(b []byte) error {
+	*r = SpecimenDefinitionTypeTestedContainer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Material = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Cap = &v
+		case 7:
+			v := d.string()
+			r.Description = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.Capacity = &v
+		case 9:
+			var v Quantity
+			d.message(&v)
+			r.MinimumVolumeQuantity = &v
+		case 10:
+			v := d.string()
+			r.MinimumVolumeString = &v
+		case 11:
+			var v SpecimenDefinitionTypeTestedContainerAdditive
+			d.message(&v)
+			r.Additive = append(r.Additive, v)
+		case 12:
+			v := d.string()
+			r.Preparation = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.MinimumVolumeStringElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.PreparationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SpecimenDefinitionTypeTestedContainerAdditive as JSON into a byte slice
+func (r SpecimenDefinitionTypeTestedContainerAdditive) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SpecimenDefinitionTypeTestedContainerAdditive as JSON object with the members in the order of the fields
+func (r SpecimenDefinitionTypeTestedContainerAdditive) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AdditiveCodeableConcept != nil {
+		e.key("additiveCodeableConcept")
+		r.AdditiveCodeableConcept.appendJSON(e)
+	}
+	if r.AdditiveReference != nil {
+		e.key("additiveReference")
+		r.AdditiveReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SpecimenDefinitionTypeTestedContainerAdditive from JSON. Unknown members are ignored.
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SpecimenDefinitionTypeTestedContainerAdditive
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "additiveCodeableConcept":
+			if d.null() {
+				r.AdditiveCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AdditiveCodeableConcept = &v
+			}
+		case "additiveReference":
+			if d.null() {
+				r.AdditiveReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.AdditiveReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SpecimenDefinitionTypeTestedContainerAdditive which shares no memory with the original
+func (r SpecimenDefinitionTypeTestedContainerAdditive) DeepCopy() SpecimenDefinitionTypeTestedContainerAdditive {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.AdditiveCodeableConcept != nil {
+		v := r.AdditiveCodeableConcept.DeepCopy()
+		out.AdditiveCodeableConcept = &v
+	}
+	if r.AdditiveReference != nil {
+		v := r.AdditiveReference.DeepCopy()
+		out.AdditiveReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SpecimenDefinitionTypeTestedContainerAdditive) Equal(other SpecimenDefinitionTypeTestedContainerAdditive) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.AdditiveCodeableConcept == nil) != (other.AdditiveCodeableConcept == nil) || r.AdditiveCodeableConcept != nil && !r.AdditiveCodeableConcept.Equal(*other.AdditiveCodeableConcept) {
+		return false
+	}
+	if (r.AdditiveReference == nil) != (other.AdditiveReference == nil) || r.AdditiveReference != nil && !r.AdditiveReference.Equal(*other.AdditiveReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SpecimenDefinitionTypeTestedContainerAdditive) EqualsDeep(other SpecimenDefinitionTypeTestedContainerAdditive) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.AdditiveCodeableConcept == nil) != (other.AdditiveCodeableConcept == nil) || r.AdditiveCodeableConcept != nil && !r.AdditiveCodeableConcept.EqualsDeep(*other.AdditiveCodeableConcept) {
+		return false
+	}
+	if (r.AdditiveReference == nil) != (other.AdditiveReference == nil) || r.AdditiveReference != nil && !r.AdditiveReference.EqualsDeep(*other.AdditiveReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SpecimenDefinitionTypeTestedContainerAdditive) EqualsShallow(other SpecimenDefinitionTypeTestedContainerAdditive) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SpecimenDefinitionTypeTestedContainerAdditive as FHIR XML
+func (r SpecimenDefinitionTypeTestedContainerAdditive) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "additiveCodeableConcept", r.AdditiveCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "additiveReference", r.AdditiveReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SpecimenDefinitionTypeTestedContainerAdditive from FHIR XML
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "additiveCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditiveCodeableConcept = &v
+			case "additiveReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AdditiveReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SpecimenDefinitionTypeTestedContainerAdditive as properties to the RDF node n
+func (r SpecimenDefinitionTypeTestedContainerAdditive) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SpecimenDefinition.typeTested.container.additive.additiveCodeableConcept", -1, r.AdditiveCodeableConcept)
+	n.element("SpecimenDefinition.typeTested.container.additive.additiveReference", -1, r.AdditiveReference)
+}
+
+// MarshalBSON marshals the given SpecimenDefinitionTypeTestedContainerAdditive as BSON document with the structure of its FHIR JSON
+func (r SpecimenDefinitionTypeTestedContainerAdditive) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SpecimenDefinitionTypeTestedContainerAdditive from a BSON document with the structure of its FHIR JSON
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SpecimenDefinitionTypeTestedContainerAdditive as protobuf message SpecimenDefinitionTypeTestedContainerAdditive of fhir.proto
+func (r SpecimenDefinitionTypeTestedContainerAdditive) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SpecimenDefinitionTypeTestedContainerAdditive to the encoded message
+func (r SpecimenDefinitionTypeTestedContainerAdditive) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.AdditiveCodeableConcept != nil {
+		e.message(4, *r.AdditiveCodeableConcept)
+	}
+	if r.AdditiveReference != nil {
+		e.message(5, *r.AdditiveReference)
+	}
+}
+
+// UnmarshalProto unmarshals the given SpecimenDefinitionTypeTestedContainerAdditive from protobuf message SpecimenDefinitionTypeTestedContainerAdditive of fhir.proto
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) UnmarshalProto(b []byte) error {
+	*r = SpecimenDefinitionTypeTestedContainerAdditive{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.AdditiveCodeableConcept = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.AdditiveReference = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SpecimenDefinitionTypeTestedHandling as JSON into a byte slice
+func (r SpecimenDefinitionTypeTestedHandling) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SpecimenDefinitionTypeTestedHandling as JSON object with the members in the order of the fields
+func (r SpecimenDefinitionTypeTestedHandling) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.TemperatureQualifier != nil {
+		e.key("temperatureQualifier")
+		r.TemperatureQualifier.appendJSON(e)
+	}
+	if r.TemperatureRange != nil {
+		e.key("temperatureRange")
+		r.TemperatureRange.appendJSON(e)
+	}
+	if r.MaxDuration != nil {
+		e.key("maxDuration")
+		r.MaxDuration.appendJSON(e)
+	}
+	if r.Instruction != nil {
+		e.key("instruction")
+		e.string(*r.Instruction)
+	}
+	if r.InstructionElement != nil {
+		e.key("_instruction")
+		r.InstructionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SpecimenDefinitionTypeTestedHandling from JSON. Unknown members are ignored.
+func (r *SpecimenDefinitionTypeTestedHandling) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SpecimenDefinitionTypeTestedHandling
+func (r *SpecimenDefinitionTypeTestedHandling) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "temperatureQualifier":
+			if d.null() {
+				r.TemperatureQualifier = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.TemperatureQualifier = &v
+			}
+		case "temperatureRange":
+			if d.null() {
+				r.TemperatureRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.TemperatureRange = &v
+			}
+		case "maxDuration":
+			if d.null() {
+				r.MaxDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.MaxDuration = &v
+			}
+		case "instruction":
+			if v, ok := d.string(); ok {
+				r.Instruction = &v
+			} else {
+				r.Instruction = nil
+			}
+		case "_instruction":
+			r.InstructionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SpecimenDefinitionTypeTestedHandling which shares no memory with the original
+func (r SpecimenDefinitionTypeTestedHandling) DeepCopy() SpecimenDefinitionTypeTestedHandling {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TemperatureQualifier != nil {
+		v := r.TemperatureQualifier.DeepCopy()
+		out.TemperatureQualifier = &v
+	}
+	if r.TemperatureRange != nil {
+		v := r.TemperatureRange.DeepCopy()
+		out.TemperatureRange = &v
+	}
+	if r.MaxDuration != nil {
+		v := r.MaxDuration.DeepCopy()
+		out.MaxDuration = &v
+	}
+	if r.Instruction != nil {
+		v := *r.Instruction
+		out.Instruction = &v
+	}
+	if r.InstructionElement != nil {
+		v := r.InstructionElement.DeepCopy()
+		out.InstructionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SpecimenDefinitionTypeTestedHandling) Equal(other SpecimenDefinitionTypeTestedHandling) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.TemperatureQualifier == nil) != (other.TemperatureQualifier == nil) || r.TemperatureQualifier != nil && !r.TemperatureQualifier.Equal(*other.TemperatureQualifier) {
+		return false
+	}
+	if (r.TemperatureRange == nil) != (other.TemperatureRange == nil) || r.TemperatureRange != nil && !r.TemperatureRange.Equal(*other.TemperatureRange) {
+		return false
+	}
+	if (r.MaxDuration == nil) != (other.MaxDuration == nil) || r.MaxDuration != nil && !r.MaxDuration.Equal(*other.MaxDuration) {
+		return false
+	}
+	if (r.Instruction == nil) != (other.Instruction == nil) || r.Instruction != nil && *r.Instruction != *other.Instruction {
+		return false
+	}
+	if (r.InstructionElement == nil) != (other.InstructionElement == nil) || r.InstructionElement != nil && !r.InstructionElement.Equal(*other.InstructionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SpecimenDefinitionTypeTestedHandling) EqualsDeep(other SpecimenDefinitionTypeTestedHandling) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.TemperatureQualifier == nil) != (other.TemperatureQualifier == nil) || r.TemperatureQualifier != nil && !r.TemperatureQualifier.EqualsDeep(*other.TemperatureQualifier) {
+		return false
+	}
+	if (r.TemperatureRange == nil) != (other.TemperatureRange == nil) || r.TemperatureRange != nil && !r.TemperatureRange.EqualsDeep(*other.TemperatureRange) {
+		return false
+	}
+	if (r.MaxDuration == nil) != (other.MaxDuration == nil) || r.MaxDuration != nil && !r.MaxDuration.EqualsDeep(*other.MaxDuration) {
+		return false
+	}
+	if (r.Instruction == nil) != (other.Instruction == nil) || r.Instruction != nil && !equivalentString(*r.Instruction, *other.Instruction) {
+		return false
+	}
+	if (r.InstructionElement == nil) != (other.InstructionElement == nil) || r.InstructionElement != nil && !r.InstructionElement.EqualsDeep(*other.InstructionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SpecimenDefinitionTypeTestedHandling) EqualsShallow(other SpecimenDefinitionTypeTestedHandling) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Instruction == nil) != (other.Instruction == nil) || r.Instruction != nil && !equivalentString(*r.Instruction, *other.Instruction) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SpecimenDefinitionTypeTestedHandling as FHIR XML
+func (r SpecimenDefinitionTypeTestedHandling) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "temperatureQualifier", r.TemperatureQualifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "temperatureRange", r.TemperatureRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "maxDuration", r.MaxDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "instruction", r.Instruction, r.InstructionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SpecimenDefinitionTypeTestedHandling from FHIR XML
+func (r *SpecimenDefinitionTypeTestedHandling) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "temperatureQualifier":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TemperatureQualifier = &v
+			case "temperatureRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TemperatureRange = &v
+			case "maxDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaxDuration = &v
+			case "instruction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Instruction = &v
+				}
+				r.InstructionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SpecimenDefinitionTypeTestedHandling as properties to the RDF node n
+func (r SpecimenDefinitionTypeTestedHandling) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SpecimenDefinition.typeTested.handling.temperatureQualifier", -1, r.TemperatureQualifier)
+	n.element("SpecimenDefinition.typeTested.handling.temperatureRange", -1, r.TemperatureRange)
+	n.element("SpecimenDefinition.typeTested.handling.maxDuration", -1, r.MaxDuration)
+	n.primitive("SpecimenDefinition.typeTested.handling.instruction", -1, r.Instruction, r.InstructionElement, "string")
+}
+
+// MarshalBSON marshals the given SpecimenDefinitionTypeTestedHandling as BSON document with the structure of its FHIR JSON
+func (r SpecimenDefinitionTypeTestedHandling) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SpecimenDefinitionTypeTestedHandling from a BSON document with the structure of its FHIR JSON
+func (r *SpecimenDefinitionTypeTestedHandling) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SpecimenDefinitionTypeTestedHandling as protobuf message SpecimenDefinitionTypeTestedHandling of fhir.proto
+func (r SpecimenDefinitionTypeTestedHandling) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SpecimenDefinitionTypeTestedHandling to the encoded message
+func (r SpecimenDefinitionTypeTestedHandling) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.TemperatureQualifier != nil {
+		e.message(4, *r.TemperatureQualifier)
+	}
+	if r.TemperatureRange != nil {
+		e.message(5, *r.TemperatureRange)
+	}
+	if r.MaxDuration != nil {
+		e.message(6, *r.MaxDuration)
+	}
+	if r.Instruction != nil {
+		e.string(7, *r.Instruction)
+	}
+	if r.InstructionElement != nil {
+		e.message(8, *r.InstructionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SpecimenDefinitionTypeTestedHandling from protobuf message SpecimenDefinitionTypeTestedHandling of fhir.proto
+func (r *SpecimenDefinitionTypeTestedHandling) UnmarshalProto(b []byte) error {
+	*r = SpecimenDefinitionTypeTestedHandling{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.TemperatureQualifier = &v
+		case 5:
+			var v Range
+			d.message(&v)
+			r.TemperatureRange = &v
+		case 6:
+			var v Duration
+			d.message(&v)
+			r.MaxDuration = &v
+		case 7:
+			v := d.string()
+			r.Instruction = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.InstructionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSpecimenDefinition unmarshals a SpecimenDefinition.
 func UnmarshalSpecimenDefinition(b []byte) (SpecimenDefinition, error) {
 	var specimenDefinition SpecimenDefinition
-	if err := json.Unmarshal(b, &specimenDefinition); err != nil {
+	if err := specimenDefinition.UnmarshalJSON(b); err != nil {
 		return specimenDefinition, err
 	}
 	return specimenDefinition, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code SpecimenStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SpecimenStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SpecimenStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "available":
 		*code = SpecimenStatusAvailable
@@ -52,6 +56,12 @@ func (code *SpecimenStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SpecimenStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SpecimenStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SpecimenStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code SpecimenStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SpecimenStatus) protoNumber() uint64 {
+	switch code {
+	case SpecimenStatusAvailable:
+		return 1
+	case SpecimenStatusUnavailable:
+		return 2
+	case SpecimenStatusUnsatisfactory:
+		return 3
+	case SpecimenStatusEnteredInError:
+		return 4
+	}
+	return 0
+}
+func (code *SpecimenStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SpecimenStatusAvailable
+	case 2:
+		*code = SpecimenStatusUnavailable
+	case 3:
+		*code = SpecimenStatusUnsatisfactory
+	case 4:
+		*code = SpecimenStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
@@ -14,63 +14,94 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // StructureDefinition is documented here http://hl7.org/fhir/StructureDefinition/StructureDefinition
 type StructureDefinition struct {
-	Id                *string                          `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                          `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               string                           `bson:"url" json:"url"`
-	Identifier        []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                          `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                           `bson:"name" json:"name"`
-	Title             *string                          `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus                `bson:"status" json:"status"`
-	Experimental      *bool                            `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                          `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                          `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                          `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Keyword           []Coding                         `bson:"keyword,omitempty" json:"keyword,omitempty"`
-	FhirVersion       *FHIRVersion                     `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
-	Mapping           []StructureDefinitionMapping     `bson:"mapping,omitempty" json:"mapping,omitempty"`
-	Kind              StructureDefinitionKind          `bson:"kind" json:"kind"`
-	Abstract          bool                             `bson:"abstract" json:"abstract"`
-	Context           []StructureDefinitionContext     `bson:"context,omitempty" json:"context,omitempty"`
-	ContextInvariant  []string                         `bson:"contextInvariant,omitempty" json:"contextInvariant,omitempty"`
-	Type              string                           `bson:"type" json:"type"`
-	BaseDefinition    *string                          `bson:"baseDefinition,omitempty" json:"baseDefinition,omitempty"`
-	Derivation        *TypeDerivationRule              `bson:"derivation,omitempty" json:"derivation,omitempty"`
-	Snapshot          *StructureDefinitionSnapshot     `bson:"snapshot,omitempty" json:"snapshot,omitempty"`
-	Differential      *StructureDefinitionDifferential `bson:"differential,omitempty" json:"differential,omitempty"`
+	Id                      *string                          `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement               *Element                         `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                    *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                         `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                          `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                         `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               []json.RawMessage                `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                     string                           `bson:"url" json:"url"`
+	UrlElement              *Element                         `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier              []Identifier                     `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version                 *string                          `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement          *Element                         `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                    string                           `bson:"name" json:"name"`
+	NameElement             *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                   *string                          `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement            *Element                         `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status                  PublicationStatus                `bson:"status" json:"status"`
+	StatusElement           *Element                         `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental            *bool                            `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement     *Element                         `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                    *string                          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement             *Element                         `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher               *string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement        *Element                         `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact                 []ContactDetail                  `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description             *string                          `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                         `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext              []UsageContext                   `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction            []CodeableConcept                `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose                 *string                          `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement          *Element                         `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright               *string                          `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement        *Element                         `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Keyword                 []Coding                         `bson:"keyword,omitempty" json:"keyword,omitempty"`
+	FhirVersion             *FHIRVersion                     `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
+	FhirVersionElement      *Element                         `bson:"_fhirVersion,omitempty" json:"_fhirVersion,omitempty"`
+	Mapping                 []StructureDefinitionMapping     `bson:"mapping,omitempty" json:"mapping,omitempty"`
+	Kind                    StructureDefinitionKind          `bson:"kind" json:"kind"`
+	KindElement             *Element                         `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Abstract                bool                             `bson:"abstract" json:"abstract"`
+	AbstractElement         *Element                         `bson:"_abstract,omitempty" json:"_abstract,omitempty"`
+	Context                 []StructureDefinitionContext     `bson:"context,omitempty" json:"context,omitempty"`
+	ContextInvariant        []string                         `bson:"contextInvariant,omitempty" json:"contextInvariant,omitempty"`
+	ContextInvariantElement []*Element                       `bson:"_contextInvariant,omitempty" json:"_contextInvariant,omitempty"`
+	Type                    string                           `bson:"type" json:"type"`
+	TypeElement             *Element                         `bson:"_type,omitempty" json:"_type,omitempty"`
+	BaseDefinition          *string                          `bson:"baseDefinition,omitempty" json:"baseDefinition,omitempty"`
+	BaseDefinitionElement   *Element                         `bson:"_baseDefinition,omitempty" json:"_baseDefinition,omitempty"`
+	Derivation              *TypeDerivationRule              `bson:"derivation,omitempty" json:"derivation,omitempty"`
+	DerivationElement       *Element                         `bson:"_derivation,omitempty" json:"_derivation,omitempty"`
+	Snapshot                *StructureDefinitionSnapshot     `bson:"snapshot,omitempty" json:"snapshot,omitempty"`
+	Differential            *StructureDefinitionDifferential `bson:"differential,omitempty" json:"differential,omitempty"`
 }
 type StructureDefinitionMapping struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identity          string      `bson:"identity" json:"identity"`
+	IdentityElement   *Element    `bson:"_identity,omitempty" json:"_identity,omitempty"`
 	Uri               *string     `bson:"uri,omitempty" json:"uri,omitempty"`
+	UriElement        *Element    `bson:"_uri,omitempty" json:"_uri,omitempty"`
 	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement       *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
 	Comment           *string     `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element    `bson:"_comment,omitempty" json:"_comment,omitempty"`
 }
 type StructureDefinitionContext struct {
 	Id                *string              `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              ExtensionContextType `bson:"type" json:"type"`
+	TypeElement       *Element             `bson:"_type,omitempty" json:"_type,omitempty"`
 	Expression        string               `bson:"expression" json:"expression"`
+	ExpressionElement *Element             `bson:"_expression,omitempty" json:"_expression,omitempty"`
 }
 type StructureDefinitionSnapshot struct {
 	Id                *string             `bson:"id,omitempty" json:"id,omitempty"`
@@ -84,23 +115,3884 @@ type StructureDefinitionDifferential struct {
 	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Element           []ElementDefinition `bson:"element" json:"element"`
 }
-type OtherStructureDefinition StructureDefinition
 
 // MarshalJSON marshals the given StructureDefinition as JSON into a byte slice
 func (r StructureDefinition) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherStructureDefinition
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherStructureDefinition: OtherStructureDefinition(r),
-		ResourceType:             "StructureDefinition",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureDefinition as JSON object with the members in the order of the fields
+func (r StructureDefinition) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"StructureDefinition\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if len(r.Keyword) > 0 {
+		e.key("keyword")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Keyword {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.FhirVersion != nil {
+		e.key("fhirVersion")
+		e.string(r.FhirVersion.Code())
+	}
+	if r.FhirVersionElement != nil {
+		e.key("_fhirVersion")
+		r.FhirVersionElement.appendJSON(e)
+	}
+	if len(r.Mapping) > 0 {
+		e.key("mapping")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Mapping {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("kind")
+	e.string(r.Kind.Code())
+	if r.KindElement != nil {
+		e.key("_kind")
+		r.KindElement.appendJSON(e)
+	}
+	e.key("abstract")
+	e.bool(r.Abstract)
+	if r.AbstractElement != nil {
+		e.key("_abstract")
+		r.AbstractElement.appendJSON(e)
+	}
+	if len(r.Context) > 0 {
+		e.key("context")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Context {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ContextInvariant) > 0 {
+		e.key("contextInvariant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ContextInvariant {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ContextInvariantElement) > 0 {
+		e.key("_contextInvariant")
+		e.elements(r.ContextInvariantElement, len(r.ContextInvariant))
+	}
+	e.key("type")
+	e.string(r.Type)
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.BaseDefinition != nil {
+		e.key("baseDefinition")
+		e.string(*r.BaseDefinition)
+	}
+	if r.BaseDefinitionElement != nil {
+		e.key("_baseDefinition")
+		r.BaseDefinitionElement.appendJSON(e)
+	}
+	if r.Derivation != nil {
+		e.key("derivation")
+		e.string(r.Derivation.Code())
+	}
+	if r.DerivationElement != nil {
+		e.key("_derivation")
+		r.DerivationElement.appendJSON(e)
+	}
+	if r.Snapshot != nil {
+		e.key("snapshot")
+		r.Snapshot.appendJSON(e)
+	}
+	if r.Differential != nil {
+		e.key("differential")
+		r.Differential.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureDefinition from JSON. Unknown members are ignored.
+func (r *StructureDefinition) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureDefinition
+func (r *StructureDefinition) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "keyword":
+			a := d.array()
+			r.Keyword = nil
+			for a.next() {
+				var v Coding
+				v.decodeJSON(d)
+				r.Keyword = append(r.Keyword, v)
+			}
+			if r.Keyword == nil && !a.isNull {
+				r.Keyword = []Coding{}
+			}
+		case "fhirVersion":
+			if d.null() {
+				r.FhirVersion = nil
+			} else {
+				var v FHIRVersion
+				d.unmarshal(&v)
+				r.FhirVersion = &v
+			}
+		case "_fhirVersion":
+			r.FhirVersionElement = d.element()
+		case "mapping":
+			a := d.array()
+			r.Mapping = nil
+			for a.next() {
+				var v StructureDefinitionMapping
+				v.decodeJSON(d)
+				r.Mapping = append(r.Mapping, v)
+			}
+			if r.Mapping == nil && !a.isNull {
+				r.Mapping = []StructureDefinitionMapping{}
+			}
+		case "kind":
+			d.unmarshal(&r.Kind)
+		case "_kind":
+			r.KindElement = d.element()
+		case "abstract":
+			if v, ok := d.bool(); ok {
+				r.Abstract = v
+			}
+		case "_abstract":
+			r.AbstractElement = d.element()
+		case "context":
+			a := d.array()
+			r.Context = nil
+			for a.next() {
+				var v StructureDefinitionContext
+				v.decodeJSON(d)
+				r.Context = append(r.Context, v)
+			}
+			if r.Context == nil && !a.isNull {
+				r.Context = []StructureDefinitionContext{}
+			}
+		case "contextInvariant":
+			a := d.array()
+			r.ContextInvariant = nil
+			for a.next() {
+				v, _ := d.string()
+				r.ContextInvariant = append(r.ContextInvariant, v)
+			}
+			if r.ContextInvariant == nil && !a.isNull {
+				r.ContextInvariant = []string{}
+			}
+		case "_contextInvariant":
+			a := d.array()
+			r.ContextInvariantElement = nil
+			for a.next() {
+				r.ContextInvariantElement = append(r.ContextInvariantElement, d.element())
+			}
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = v
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "baseDefinition":
+			if v, ok := d.string(); ok {
+				r.BaseDefinition = &v
+			} else {
+				r.BaseDefinition = nil
+			}
+		case "_baseDefinition":
+			r.BaseDefinitionElement = d.element()
+		case "derivation":
+			if d.null() {
+				r.Derivation = nil
+			} else {
+				var v TypeDerivationRule
+				d.unmarshal(&v)
+				r.Derivation = &v
+			}
+		case "_derivation":
+			r.DerivationElement = d.element()
+		case "snapshot":
+			if d.null() {
+				r.Snapshot = nil
+			} else {
+				var v StructureDefinitionSnapshot
+				v.decodeJSON(d)
+				r.Snapshot = &v
+			}
+		case "differential":
+			if d.null() {
+				r.Differential = nil
+			} else {
+				var v StructureDefinitionDifferential
+				v.decodeJSON(d)
+				r.Differential = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureDefinition which shares no memory with the original
+func (r StructureDefinition) DeepCopy() StructureDefinition {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.Keyword != nil {
+		out.Keyword = make([]Coding, len(r.Keyword))
+		for i := range r.Keyword {
+			out.Keyword[i] = r.Keyword[i].DeepCopy()
+		}
+	}
+	if r.FhirVersion != nil {
+		v := *r.FhirVersion
+		out.FhirVersion = &v
+	}
+	if r.FhirVersionElement != nil {
+		v := r.FhirVersionElement.DeepCopy()
+		out.FhirVersionElement = &v
+	}
+	if r.Mapping != nil {
+		out.Mapping = make([]StructureDefinitionMapping, len(r.Mapping))
+		for i := range r.Mapping {
+			out.Mapping[i] = r.Mapping[i].DeepCopy()
+		}
+	}
+	if r.KindElement != nil {
+		v := r.KindElement.DeepCopy()
+		out.KindElement = &v
+	}
+	if r.AbstractElement != nil {
+		v := r.AbstractElement.DeepCopy()
+		out.AbstractElement = &v
+	}
+	if r.Context != nil {
+		out.Context = make([]StructureDefinitionContext, len(r.Context))
+		for i := range r.Context {
+			out.Context[i] = r.Context[i].DeepCopy()
+		}
+	}
+	if r.ContextInvariant != nil {
+		out.ContextInvariant = make([]string, len(r.ContextInvariant))
+		copy(out.ContextInvariant, r.ContextInvariant)
+	}
+	if r.ContextInvariantElement != nil {
+		out.ContextInvariantElement = make([]*Element, len(r.ContextInvariantElement))
+		for i, v := range r.ContextInvariantElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ContextInvariantElement[i] = &c
+			}
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.BaseDefinition != nil {
+		v := *r.BaseDefinition
+		out.BaseDefinition = &v
+	}
+	if r.BaseDefinitionElement != nil {
+		v := r.BaseDefinitionElement.DeepCopy()
+		out.BaseDefinitionElement = &v
+	}
+	if r.Derivation != nil {
+		v := *r.Derivation
+		out.Derivation = &v
+	}
+	if r.DerivationElement != nil {
+		v := r.DerivationElement.DeepCopy()
+		out.DerivationElement = &v
+	}
+	if r.Snapshot != nil {
+		v := r.Snapshot.DeepCopy()
+		out.Snapshot = &v
+	}
+	if r.Differential != nil {
+		v := r.Differential.DeepCopy()
+		out.Differential = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureDefinition) Equal(other StructureDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if len(r.Keyword) != len(other.Keyword) {
+		return false
+	}
+	for i := range r.Keyword {
+		if !r.Keyword[i].Equal(other.Keyword[i]) {
+			return false
+		}
+	}
+	if (r.FhirVersion == nil) != (other.FhirVersion == nil) || r.FhirVersion != nil && *r.FhirVersion != *other.FhirVersion {
+		return false
+	}
+	if (r.FhirVersionElement == nil) != (other.FhirVersionElement == nil) || r.FhirVersionElement != nil && !r.FhirVersionElement.Equal(*other.FhirVersionElement) {
+		return false
+	}
+	if len(r.Mapping) != len(other.Mapping) {
+		return false
+	}
+	for i := range r.Mapping {
+		if !r.Mapping[i].Equal(other.Mapping[i]) {
+			return false
+		}
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.Equal(*other.KindElement) {
+		return false
+	}
+	if r.Abstract != other.Abstract {
+		return false
+	}
+	if (r.AbstractElement == nil) != (other.AbstractElement == nil) || r.AbstractElement != nil && !r.AbstractElement.Equal(*other.AbstractElement) {
+		return false
+	}
+	if len(r.Context) != len(other.Context) {
+		return false
+	}
+	for i := range r.Context {
+		if !r.Context[i].Equal(other.Context[i]) {
+			return false
+		}
+	}
+	if len(r.ContextInvariant) != len(other.ContextInvariant) {
+		return false
+	}
+	for i := range r.ContextInvariant {
+		if r.ContextInvariant[i] != other.ContextInvariant[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ContextInvariantElement, other.ContextInvariantElement, false) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.BaseDefinition == nil) != (other.BaseDefinition == nil) || r.BaseDefinition != nil && *r.BaseDefinition != *other.BaseDefinition {
+		return false
+	}
+	if (r.BaseDefinitionElement == nil) != (other.BaseDefinitionElement == nil) || r.BaseDefinitionElement != nil && !r.BaseDefinitionElement.Equal(*other.BaseDefinitionElement) {
+		return false
+	}
+	if (r.Derivation == nil) != (other.Derivation == nil) || r.Derivation != nil && *r.Derivation != *other.Derivation {
+		return false
+	}
+	if (r.DerivationElement == nil) != (other.DerivationElement == nil) || r.DerivationElement != nil && !r.DerivationElement.Equal(*other.DerivationElement) {
+		return false
+	}
+	if (r.Snapshot == nil) != (other.Snapshot == nil) || r.Snapshot != nil && !r.Snapshot.Equal(*other.Snapshot) {
+		return false
+	}
+	if (r.Differential == nil) != (other.Differential == nil) || r.Differential != nil && !r.Differential.Equal(*other.Differential) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureDefinition) EqualsDeep(other StructureDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if !equivalentList(len(r.Keyword), len(other.Keyword), func(i, j int) bool {
+		return r.Keyword[i].EqualsDeep(other.Keyword[j])
+	}) {
+		return false
+	}
+	if (r.FhirVersion == nil) != (other.FhirVersion == nil) || r.FhirVersion != nil && *r.FhirVersion != *other.FhirVersion {
+		return false
+	}
+	if (r.FhirVersionElement == nil) != (other.FhirVersionElement == nil) || r.FhirVersionElement != nil && !r.FhirVersionElement.EqualsDeep(*other.FhirVersionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Mapping), len(other.Mapping), func(i, j int) bool {
+		return r.Mapping[i].EqualsDeep(other.Mapping[j])
+	}) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.EqualsDeep(*other.KindElement) {
+		return false
+	}
+	if r.Abstract != other.Abstract {
+		return false
+	}
+	if (r.AbstractElement == nil) != (other.AbstractElement == nil) || r.AbstractElement != nil && !r.AbstractElement.EqualsDeep(*other.AbstractElement) {
+		return false
+	}
+	if !equivalentList(len(r.Context), len(other.Context), func(i, j int) bool {
+		return r.Context[i].EqualsDeep(other.Context[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ContextInvariant), len(other.ContextInvariant), func(i, j int) bool {
+		return equivalentString(r.ContextInvariant[i], other.ContextInvariant[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ContextInvariantElement, other.ContextInvariantElement, true) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.BaseDefinition == nil) != (other.BaseDefinition == nil) || r.BaseDefinition != nil && !equivalentString(*r.BaseDefinition, *other.BaseDefinition) {
+		return false
+	}
+	if (r.BaseDefinitionElement == nil) != (other.BaseDefinitionElement == nil) || r.BaseDefinitionElement != nil && !r.BaseDefinitionElement.EqualsDeep(*other.BaseDefinitionElement) {
+		return false
+	}
+	if (r.Derivation == nil) != (other.Derivation == nil) || r.Derivation != nil && *r.Derivation != *other.Derivation {
+		return false
+	}
+	if (r.DerivationElement == nil) != (other.DerivationElement == nil) || r.DerivationElement != nil && !r.DerivationElement.EqualsDeep(*other.DerivationElement) {
+		return false
+	}
+	if (r.Snapshot == nil) != (other.Snapshot == nil) || r.Snapshot != nil && !r.Snapshot.EqualsDeep(*other.Snapshot) {
+		return false
+	}
+	if (r.Differential == nil) != (other.Differential == nil) || r.Differential != nil && !r.Differential.EqualsDeep(*other.Differential) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureDefinition) EqualsShallow(other StructureDefinition) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.FhirVersion == nil) != (other.FhirVersion == nil) || r.FhirVersion != nil && *r.FhirVersion != *other.FhirVersion {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if r.Abstract != other.Abstract {
+		return false
+	}
+	if !equivalentList(len(r.ContextInvariant), len(other.ContextInvariant), func(i, j int) bool {
+		return equivalentString(r.ContextInvariant[i], other.ContextInvariant[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Type, other.Type) {
+		return false
+	}
+	if (r.BaseDefinition == nil) != (other.BaseDefinition == nil) || r.BaseDefinition != nil && !equivalentString(*r.BaseDefinition, *other.BaseDefinition) {
+		return false
+	}
+	if (r.Derivation == nil) != (other.Derivation == nil) || r.Derivation != nil && *r.Derivation != *other.Derivation {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureDefinition as FHIR XML
+func (r StructureDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "StructureDefinition",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	for _, v := range r.Keyword {
+		if err := encodeXMLElement(e, "keyword", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "fhirVersion", r.FhirVersion, r.FhirVersionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Mapping {
+		if err := encodeXMLElement(e, "mapping", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "kind", r.Kind, r.KindElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "abstract", r.Abstract, r.AbstractElement); err != nil {
+		return err
+	}
+	for _, v := range r.Context {
+		if err := encodeXMLElement(e, "context", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ContextInvariant {
+		if err := encodeXMLPrimitive(e, "contextInvariant", v, elementAt(r.ContextInvariantElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "baseDefinition", r.BaseDefinition, r.BaseDefinitionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "derivation", r.Derivation, r.DerivationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "snapshot", r.Snapshot); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "differential", r.Differential); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureDefinition from FHIR XML
+func (r *StructureDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "keyword":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Keyword = append(r.Keyword, v)
+			case "fhirVersion":
+				var v FHIRVersion
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.FhirVersion = &v
+				}
+				r.FhirVersionElement = element
+			case "mapping":
+				var v StructureDefinitionMapping
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Mapping = append(r.Mapping, v)
+			case "kind":
+				var v StructureDefinitionKind
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Kind = v
+				}
+				r.KindElement = element
+			case "abstract":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Abstract = v
+				}
+				r.AbstractElement = element
+			case "context":
+				var v StructureDefinitionContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Context = append(r.Context, v)
+			case "contextInvariant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ContextInvariantElement = appendElement(r.ContextInvariantElement, len(r.ContextInvariant), element)
+					r.ContextInvariant = append(r.ContextInvariant, v)
+				}
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "baseDefinition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BaseDefinition = &v
+				}
+				r.BaseDefinitionElement = element
+			case "derivation":
+				var v TypeDerivationRule
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Derivation = &v
+				}
+				r.DerivationElement = element
+			case "snapshot":
+				var v StructureDefinitionSnapshot
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Snapshot = &v
+			case "differential":
+				var v StructureDefinitionDifferential
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Differential = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureDefinition as properties to the RDF node n
+func (r StructureDefinition) turtle(n *turtleNode) {
+	n.resource("StructureDefinition", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("StructureDefinition.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("StructureDefinition.identifier", i, v)
+	}
+	n.primitive("StructureDefinition.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("StructureDefinition.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("StructureDefinition.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("StructureDefinition.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("StructureDefinition.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("StructureDefinition.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("StructureDefinition.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("StructureDefinition.contact", i, v)
+	}
+	n.primitive("StructureDefinition.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("StructureDefinition.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("StructureDefinition.jurisdiction", i, v)
+	}
+	n.primitive("StructureDefinition.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("StructureDefinition.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	for i, v := range r.Keyword {
+		n.element("StructureDefinition.keyword", i, v)
+	}
+	n.primitive("StructureDefinition.fhirVersion", -1, r.FhirVersion, r.FhirVersionElement, "code")
+	for i, v := range r.Mapping {
+		n.element("StructureDefinition.mapping", i, v)
+	}
+	n.primitive("StructureDefinition.kind", -1, r.Kind, r.KindElement, "code")
+	n.primitive("StructureDefinition.abstract", -1, r.Abstract, r.AbstractElement, "boolean")
+	for i, v := range r.Context {
+		n.element("StructureDefinition.context", i, v)
+	}
+	for i, v := range r.ContextInvariant {
+		n.primitive("StructureDefinition.contextInvariant", i, v, elementAt(r.ContextInvariantElement, i), "string")
+	}
+	n.primitive("StructureDefinition.type", -1, r.Type, r.TypeElement, "string")
+	n.primitive("StructureDefinition.baseDefinition", -1, r.BaseDefinition, r.BaseDefinitionElement, "string")
+	n.primitive("StructureDefinition.derivation", -1, r.Derivation, r.DerivationElement, "code")
+	n.element("StructureDefinition.snapshot", -1, r.Snapshot)
+	n.element("StructureDefinition.differential", -1, r.Differential)
+}
+
+// MarshalBSON marshals the given StructureDefinition as BSON document with the structure of its FHIR JSON
+func (r StructureDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureDefinition from a BSON document with the structure of its FHIR JSON
+func (r *StructureDefinition) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureDefinition as protobuf message StructureDefinition of fhir.proto
+func (r StructureDefinition) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureDefinition to the encoded message
+func (r StructureDefinition) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != "" {
+		e.string(9, r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != "" {
+		e.string(12, r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	e.varint(14, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(15, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(16, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(17, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(18, v)
+	}
+	if r.Description != nil {
+		e.string(19, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(20, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(21, v)
+	}
+	if r.Purpose != nil {
+		e.string(22, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(23, *r.Copyright)
+	}
+	for _, v := range r.Keyword {
+		e.message(24, v)
+	}
+	if r.FhirVersion != nil {
+		e.varint(25, r.FhirVersion.protoNumber())
+	}
+	for _, v := range r.Mapping {
+		e.message(26, v)
+	}
+	e.varint(27, r.Kind.protoNumber())
+	if r.Abstract {
+		e.varint(28, protoBool(r.Abstract))
+	}
+	for _, v := range r.Context {
+		e.message(29, v)
+	}
+	for _, v := range r.ContextInvariant {
+		e.string(30, v)
+	}
+	if r.Type != "" {
+		e.string(31, r.Type)
+	}
+	if r.BaseDefinition != nil {
+		e.string(32, *r.BaseDefinition)
+	}
+	if r.Derivation != nil {
+		e.varint(33, r.Derivation.protoNumber())
+	}
+	if r.Snapshot != nil {
+		e.message(34, *r.Snapshot)
+	}
+	if r.Differential != nil {
+		e.message(35, *r.Differential)
+	}
+	if r.IdElement != nil {
+		e.message(36, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(37, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(38, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(39, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(40, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(41, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(42, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(43, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(44, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(45, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(46, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(47, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(48, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(49, *r.CopyrightElement)
+	}
+	if r.FhirVersionElement != nil {
+		e.message(50, *r.FhirVersionElement)
+	}
+	if r.KindElement != nil {
+		e.message(51, *r.KindElement)
+	}
+	if r.AbstractElement != nil {
+		e.message(52, *r.AbstractElement)
+	}
+	for _, v := range r.ContextInvariantElement {
+		e.element(53, v)
+	}
+	if r.TypeElement != nil {
+		e.message(54, *r.TypeElement)
+	}
+	if r.BaseDefinitionElement != nil {
+		e.message(55, *r.BaseDefinitionElement)
+	}
+	if r.DerivationElement != nil {
+		e.message(56, *r.DerivationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureDefinition from protobuf message StructureDefinition of fhir.proto
+func (r *StructureDefinition) UnmarshalProto(b []byte) error {
+	*r = StructureDefinition{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			r.Url = d.string()
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			r.Name = d.string()
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 15:
+			v := d.bool()
+			r.Experimental = &v
+		case 16:
+			v := d.string()
+			r.Date = &v
+		case 17:
+			v := d.string()
+			r.Publisher = &v
+		case 18:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 19:
+			v := d.string()
+			r.Description = &v
+		case 20:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 22:
+			v := d.string()
+			r.Purpose = &v
+		case 23:
+			v := d.string()
+			r.Copyright = &v
+		case 24:
+			var v Coding
+			d.message(&v)
+			r.Keyword = append(r.Keyword, v)
+		case 25:
+			var v FHIRVersion
+			d.enum(d.varint(), v.setProtoNumber)
+			r.FhirVersion = &v
+		case 26:
+			var v StructureDefinitionMapping
+			d.message(&v)
+			r.Mapping = append(r.Mapping, v)
+		case 27:
+			var v StructureDefinitionKind
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Kind = v
+		case 28:
+			r.Abstract = d.bool()
+		case 29:
+			var v StructureDefinitionContext
+			d.message(&v)
+			r.Context = append(r.Context, v)
+		case 30:
+			r.ContextInvariant = append(r.ContextInvariant, d.string())
+		case 31:
+			r.Type = d.string()
+		case 32:
+			v := d.string()
+			r.BaseDefinition = &v
+		case 33:
+			var v TypeDerivationRule
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Derivation = &v
+		case 34:
+			var v StructureDefinitionSnapshot
+			d.message(&v)
+			r.Snapshot = &v
+		case 35:
+			var v StructureDefinitionDifferential
+			d.message(&v)
+			r.Differential = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.FhirVersionElement = &v
+		case 51:
+			var v Element
+			d.message(&v)
+			r.KindElement = &v
+		case 52:
+			var v Element
+			d.message(&v)
+			r.AbstractElement = &v
+		case 53:
+			r.ContextInvariantElement = append(r.ContextInvariantElement, d.element())
+		case 54:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.BaseDefinitionElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.DerivationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureDefinitionMapping as JSON into a byte slice
+func (r StructureDefinitionMapping) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureDefinitionMapping as JSON object with the members in the order of the fields
+func (r StructureDefinitionMapping) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("identity")
+	e.string(r.Identity)
+	if r.IdentityElement != nil {
+		e.key("_identity")
+		r.IdentityElement.appendJSON(e)
+	}
+	if r.Uri != nil {
+		e.key("uri")
+		e.string(*r.Uri)
+	}
+	if r.UriElement != nil {
+		e.key("_uri")
+		r.UriElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureDefinitionMapping from JSON. Unknown members are ignored.
+func (r *StructureDefinitionMapping) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureDefinitionMapping
+func (r *StructureDefinitionMapping) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identity":
+			if v, ok := d.string(); ok {
+				r.Identity = v
+			}
+		case "_identity":
+			r.IdentityElement = d.element()
+		case "uri":
+			if v, ok := d.string(); ok {
+				r.Uri = &v
+			} else {
+				r.Uri = nil
+			}
+		case "_uri":
+			r.UriElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureDefinitionMapping which shares no memory with the original
+func (r StructureDefinitionMapping) DeepCopy() StructureDefinitionMapping {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.IdentityElement != nil {
+		v := r.IdentityElement.DeepCopy()
+		out.IdentityElement = &v
+	}
+	if r.Uri != nil {
+		v := *r.Uri
+		out.Uri = &v
+	}
+	if r.UriElement != nil {
+		v := r.UriElement.DeepCopy()
+		out.UriElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureDefinitionMapping) Equal(other StructureDefinitionMapping) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Identity != other.Identity {
+		return false
+	}
+	if (r.IdentityElement == nil) != (other.IdentityElement == nil) || r.IdentityElement != nil && !r.IdentityElement.Equal(*other.IdentityElement) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && *r.Uri != *other.Uri {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.Equal(*other.UriElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureDefinitionMapping) EqualsDeep(other StructureDefinitionMapping) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Identity, other.Identity) {
+		return false
+	}
+	if (r.IdentityElement == nil) != (other.IdentityElement == nil) || r.IdentityElement != nil && !r.IdentityElement.EqualsDeep(*other.IdentityElement) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && !equivalentString(*r.Uri, *other.Uri) {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.EqualsDeep(*other.UriElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureDefinitionMapping) EqualsShallow(other StructureDefinitionMapping) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Identity, other.Identity) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && !equivalentString(*r.Uri, *other.Uri) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureDefinitionMapping as FHIR XML
+func (r StructureDefinitionMapping) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "identity", r.Identity, r.IdentityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "uri", r.Uri, r.UriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureDefinitionMapping from FHIR XML
+func (r *StructureDefinitionMapping) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identity":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Identity = v
+				}
+				r.IdentityElement = element
+			case "uri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Uri = &v
+				}
+				r.UriElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureDefinitionMapping as properties to the RDF node n
+func (r StructureDefinitionMapping) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureDefinition.mapping.identity", -1, r.Identity, r.IdentityElement, "string")
+	n.primitive("StructureDefinition.mapping.uri", -1, r.Uri, r.UriElement, "string")
+	n.primitive("StructureDefinition.mapping.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("StructureDefinition.mapping.comment", -1, r.Comment, r.CommentElement, "string")
+}
+
+// MarshalBSON marshals the given StructureDefinitionMapping as BSON document with the structure of its FHIR JSON
+func (r StructureDefinitionMapping) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureDefinitionMapping from a BSON document with the structure of its FHIR JSON
+func (r *StructureDefinitionMapping) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureDefinitionMapping as protobuf message StructureDefinitionMapping of fhir.proto
+func (r StructureDefinitionMapping) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureDefinitionMapping to the encoded message
+func (r StructureDefinitionMapping) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identity != "" {
+		e.string(4, r.Identity)
+	}
+	if r.Uri != nil {
+		e.string(5, *r.Uri)
+	}
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.Comment != nil {
+		e.string(7, *r.Comment)
+	}
+	if r.IdentityElement != nil {
+		e.message(8, *r.IdentityElement)
+	}
+	if r.UriElement != nil {
+		e.message(9, *r.UriElement)
+	}
+	if r.NameElement != nil {
+		e.message(10, *r.NameElement)
+	}
+	if r.CommentElement != nil {
+		e.message(11, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureDefinitionMapping from protobuf message StructureDefinitionMapping of fhir.proto
+func (r *StructureDefinitionMapping) UnmarshalProto(b []byte) error {
+	*r = StructureDefinitionMapping{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Identity = d.string()
+		case 5:
+			v := d.string()
+			r.Uri = &v
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			v := d.string()
+			r.Comment = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.IdentityElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.UriElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureDefinitionContext as JSON into a byte slice
+func (r StructureDefinitionContext) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureDefinitionContext as JSON object with the members in the order of the fields
+func (r StructureDefinitionContext) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("expression")
+	e.string(r.Expression)
+	if r.ExpressionElement != nil {
+		e.key("_expression")
+		r.ExpressionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureDefinitionContext from JSON. Unknown members are ignored.
+func (r *StructureDefinitionContext) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureDefinitionContext
+func (r *StructureDefinitionContext) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "expression":
+			if v, ok := d.string(); ok {
+				r.Expression = v
+			}
+		case "_expression":
+			r.ExpressionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureDefinitionContext which shares no memory with the original
+func (r StructureDefinitionContext) DeepCopy() StructureDefinitionContext {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.ExpressionElement != nil {
+		v := r.ExpressionElement.DeepCopy()
+		out.ExpressionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureDefinitionContext) Equal(other StructureDefinitionContext) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.Expression != other.Expression {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.Equal(*other.ExpressionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureDefinitionContext) EqualsDeep(other StructureDefinitionContext) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentString(r.Expression, other.Expression) {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.EqualsDeep(*other.ExpressionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureDefinitionContext) EqualsShallow(other StructureDefinitionContext) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if !equivalentString(r.Expression, other.Expression) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureDefinitionContext as FHIR XML
+func (r StructureDefinitionContext) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expression", r.Expression, r.ExpressionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureDefinitionContext from FHIR XML
+func (r *StructureDefinitionContext) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v ExtensionContextType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Expression = v
+				}
+				r.ExpressionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureDefinitionContext as properties to the RDF node n
+func (r StructureDefinitionContext) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureDefinition.context.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("StructureDefinition.context.expression", -1, r.Expression, r.ExpressionElement, "string")
+}
+
+// MarshalBSON marshals the given StructureDefinitionContext as BSON document with the structure of its FHIR JSON
+func (r StructureDefinitionContext) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureDefinitionContext from a BSON document with the structure of its FHIR JSON
+func (r *StructureDefinitionContext) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureDefinitionContext as protobuf message StructureDefinitionContext of fhir.proto
+func (r StructureDefinitionContext) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureDefinitionContext to the encoded message
+func (r StructureDefinitionContext) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Expression != "" {
+		e.string(5, r.Expression)
+	}
+	if r.TypeElement != nil {
+		e.message(6, *r.TypeElement)
+	}
+	if r.ExpressionElement != nil {
+		e.message(7, *r.ExpressionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureDefinitionContext from protobuf message StructureDefinitionContext of fhir.proto
+func (r *StructureDefinitionContext) UnmarshalProto(b []byte) error {
+	*r = StructureDefinitionContext{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ExtensionContextType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			r.Expression = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ExpressionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureDefinitionSnapshot as JSON into a byte slice
+func (r StructureDefinitionSnapshot) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureDefinitionSnapshot as JSON object with the members in the order of the fields
+func (r StructureDefinitionSnapshot) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("element")
+	if r.Element == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Element {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureDefinitionSnapshot from JSON. Unknown members are ignored.
+func (r *StructureDefinitionSnapshot) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureDefinitionSnapshot
+func (r *StructureDefinitionSnapshot) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "element":
+			a := d.array()
+			r.Element = nil
+			for a.next() {
+				var v ElementDefinition
+				v.decodeJSON(d)
+				r.Element = append(r.Element, v)
+			}
+			if r.Element == nil && !a.isNull {
+				r.Element = []ElementDefinition{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureDefinitionSnapshot which shares no memory with the original
+func (r StructureDefinitionSnapshot) DeepCopy() StructureDefinitionSnapshot {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Element != nil {
+		out.Element = make([]ElementDefinition, len(r.Element))
+		for i := range r.Element {
+			out.Element[i] = r.Element[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureDefinitionSnapshot) Equal(other StructureDefinitionSnapshot) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Element) != len(other.Element) {
+		return false
+	}
+	for i := range r.Element {
+		if !r.Element[i].Equal(other.Element[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureDefinitionSnapshot) EqualsDeep(other StructureDefinitionSnapshot) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Element), len(other.Element), func(i, j int) bool {
+		return r.Element[i].EqualsDeep(other.Element[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureDefinitionSnapshot) EqualsShallow(other StructureDefinitionSnapshot) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureDefinitionSnapshot as FHIR XML
+func (r StructureDefinitionSnapshot) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Element {
+		if err := encodeXMLElement(e, "element", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureDefinitionSnapshot from FHIR XML
+func (r *StructureDefinitionSnapshot) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "element":
+				var v ElementDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Element = append(r.Element, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureDefinitionSnapshot as properties to the RDF node n
+func (r StructureDefinitionSnapshot) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Element {
+		n.element("StructureDefinition.snapshot.element", i, v)
+	}
+}
+
+// MarshalBSON marshals the given StructureDefinitionSnapshot as BSON document with the structure of its FHIR JSON
+func (r StructureDefinitionSnapshot) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureDefinitionSnapshot from a BSON document with the structure of its FHIR JSON
+func (r *StructureDefinitionSnapshot) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureDefinitionSnapshot as protobuf message StructureDefinitionSnapshot of fhir.proto
+func (r StructureDefinitionSnapshot) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureDefinitionSnapshot to the encoded message
+func (r StructureDefinitionSnapshot) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Element {
+		e.message(4, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureDefinitionSnapshot from protobuf message StructureDefinitionSnapshot of fhir.proto
+func (r *StructureDefinitionSnapshot) UnmarshalProto(b []byte) error {
+	*r = StructureDefinitionSnapshot{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ElementDefinition
+			d.message(&v)
+			r.Element = append(r.Element, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureDefinitionDifferential as JSON into a byte slice
+func (r StructureDefinitionDifferential) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureDefinitionDifferential as JSON object with the members in the order of the fields
+func (r StructureDefinitionDifferential) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("element")
+	if r.Element == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Element {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureDefinitionDifferential from JSON. Unknown members are ignored.
+func (r *StructureDefinitionDifferential) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureDefinitionDifferential
+func (r *StructureDefinitionDifferential) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "element":
+			a := d.array()
+			r.Element = nil
+			for a.next() {
+				var v ElementDefinition
+				v.decodeJSON(d)
+				r.Element = append(r.Element, v)
+			}
+			if r.Element == nil && !a.isNull {
+				r.Element = []ElementDefinition{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureDefinitionDifferential which shares no memory with the original
+func (r StructureDefinitionDifferential) DeepCopy() StructureDefinitionDifferential {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Element != nil {
+		out.Element = make([]ElementDefinition, len(r.Element))
+		for i := range r.Element {
+			out.Element[i] = r.Element[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureDefinitionDifferential) Equal(other StructureDefinitionDifferential) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Element) != len(other.Element) {
+		return false
+	}
+	for i := range r.Element {
+		if !r.Element[i].Equal(other.Element[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureDefinitionDifferential) EqualsDeep(other StructureDefinitionDifferential) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Element), len(other.Element), func(i, j int) bool {
+		return r.Element[i].EqualsDeep(other.Element[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureDefinitionDifferential) EqualsShallow(other StructureDefinitionDifferential) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureDefinitionDifferential as FHIR XML
+func (r StructureDefinitionDifferential) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Element {
+		if err := encodeXMLElement(e, "element", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureDefinitionDifferential from FHIR XML
+func (r *StructureDefinitionDifferential) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "element":
+				var v ElementDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Element = append(r.Element, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureDefinitionDifferential as properties to the RDF node n
+func (r StructureDefinitionDifferential) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Element {
+		n.element("StructureDefinition.differential.element", i, v)
+	}
+}
+
+// MarshalBSON marshals the given StructureDefinitionDifferential as BSON document with the structure of its FHIR JSON
+func (r StructureDefinitionDifferential) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureDefinitionDifferential from a BSON document with the structure of its FHIR JSON
+func (r *StructureDefinitionDifferential) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureDefinitionDifferential as protobuf message StructureDefinitionDifferential of fhir.proto
+func (r StructureDefinitionDifferential) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureDefinitionDifferential to the encoded message
+func (r StructureDefinitionDifferential) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Element {
+		e.message(4, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureDefinitionDifferential from protobuf message StructureDefinitionDifferential of fhir.proto
+func (r *StructureDefinitionDifferential) UnmarshalProto(b []byte) error {
+	*r = StructureDefinitionDifferential{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v ElementDefinition
+			d.message(&v)
+			r.Element = append(r.Element, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalStructureDefinition unmarshals a StructureDefinition.
 func UnmarshalStructureDefinition(b []byte) (StructureDefinition, error) {
 	var structureDefinition StructureDefinition
-	if err := json.Unmarshal(b, &structureDefinition); err != nil {
+	if err := structureDefinition.UnmarshalJSON(b); err != nil {
 		return structureDefinition, err
 	}
 	return structureDefinition, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code StructureDefinitionKind) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *StructureDefinitionKind) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *StructureDefinitionKind) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "primitive-type":
 		*code = StructureDefinitionKindPrimitiveType
@@ -52,6 +56,12 @@ func (code *StructureDefinitionKind) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code StructureDefinitionKind) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *StructureDefinitionKind) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code StructureDefinitionKind) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code StructureDefinitionKind) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code StructureDefinitionKind) protoNumber() uint64 {
+	switch code {
+	case StructureDefinitionKindPrimitiveType:
+		return 1
+	case StructureDefinitionKindComplexType:
+		return 2
+	case StructureDefinitionKindResource:
+		return 3
+	case StructureDefinitionKindLogical:
+		return 4
+	}
+	return 0
+}
+func (code *StructureDefinitionKind) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = StructureDefinitionKindPrimitiveType
+	case 2:
+		*code = StructureDefinitionKindComplexType
+	case 3:
+		*code = StructureDefinitionKindResource
+	case 4:
+		*code = StructureDefinitionKindLogical
+	default:
+		return false
+	}
+	return true
+}
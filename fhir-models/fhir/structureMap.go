@@ -14,106 +14,162 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // StructureMap is documented here http://hl7.org/fhir/StructureDefinition/StructureMap
 type StructureMap struct {
-	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               string                  `bson:"url" json:"url"`
-	Identifier        []Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                 `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                  `bson:"name" json:"name"`
-	Title             *string                 `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus       `bson:"status" json:"status"`
-	Experimental      *bool                   `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                 `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                 `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail         `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                 `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext          `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept       `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                 `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                 `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Structure         []StructureMapStructure `bson:"structure,omitempty" json:"structure,omitempty"`
-	Import            []string                `bson:"import,omitempty" json:"import,omitempty"`
-	Group             []StructureMapGroup     `bson:"group" json:"group"`
+	Id                   *string                 `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage       `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  string                  `bson:"url" json:"url"`
+	UrlElement           *Element                `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier           []Identifier            `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version              *string                 `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                  `bson:"name" json:"name"`
+	NameElement          *Element                `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                 `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus       `bson:"status" json:"status"`
+	StatusElement        *Element                `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                   `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *string                 `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                 `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail         `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext          `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept       `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                 `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright            *string                 `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Structure            []StructureMapStructure `bson:"structure,omitempty" json:"structure,omitempty"`
+	Import               []string                `bson:"import,omitempty" json:"import,omitempty"`
+	ImportElement        []*Element              `bson:"_import,omitempty" json:"_import,omitempty"`
+	Group                []StructureMapGroup     `bson:"group" json:"group"`
 }
 type StructureMapStructure struct {
-	Id                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               string                `bson:"url" json:"url"`
-	Mode              StructureMapModelMode `bson:"mode" json:"mode"`
-	Alias             *string               `bson:"alias,omitempty" json:"alias,omitempty"`
-	Documentation     *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  string                `bson:"url" json:"url"`
+	UrlElement           *Element              `bson:"_url,omitempty" json:"_url,omitempty"`
+	Mode                 StructureMapModelMode `bson:"mode" json:"mode"`
+	ModeElement          *Element              `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Alias                *string               `bson:"alias,omitempty" json:"alias,omitempty"`
+	AliasElement         *Element              `bson:"_alias,omitempty" json:"_alias,omitempty"`
+	Documentation        *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element              `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
 }
 type StructureMapGroup struct {
-	Id                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                    `bson:"name" json:"name"`
-	Extends           *string                   `bson:"extends,omitempty" json:"extends,omitempty"`
-	TypeMode          StructureMapGroupTypeMode `bson:"typeMode" json:"typeMode"`
-	Documentation     *string                   `bson:"documentation,omitempty" json:"documentation,omitempty"`
-	Input             []StructureMapGroupInput  `bson:"input" json:"input"`
-	Rule              []StructureMapGroupRule   `bson:"rule" json:"rule"`
+	Id                   *string                   `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                    `bson:"name" json:"name"`
+	NameElement          *Element                  `bson:"_name,omitempty" json:"_name,omitempty"`
+	Extends              *string                   `bson:"extends,omitempty" json:"extends,omitempty"`
+	ExtendsElement       *Element                  `bson:"_extends,omitempty" json:"_extends,omitempty"`
+	TypeMode             StructureMapGroupTypeMode `bson:"typeMode" json:"typeMode"`
+	TypeModeElement      *Element                  `bson:"_typeMode,omitempty" json:"_typeMode,omitempty"`
+	Documentation        *string                   `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                  `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
+	Input                []StructureMapGroupInput  `bson:"input" json:"input"`
+	Rule                 []StructureMapGroupRule   `bson:"rule" json:"rule"`
 }
 type StructureMapGroupInput struct {
-	Id                *string               `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                `bson:"name" json:"name"`
-	Type              *string               `bson:"type,omitempty" json:"type,omitempty"`
-	Mode              StructureMapInputMode `bson:"mode" json:"mode"`
-	Documentation     *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	Id                   *string               `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                `bson:"name" json:"name"`
+	NameElement          *Element              `bson:"_name,omitempty" json:"_name,omitempty"`
+	Type                 *string               `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement          *Element              `bson:"_type,omitempty" json:"_type,omitempty"`
+	Mode                 StructureMapInputMode `bson:"mode" json:"mode"`
+	ModeElement          *Element              `bson:"_mode,omitempty" json:"_mode,omitempty"`
+	Documentation        *string               `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element              `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
 }
 type StructureMapGroupRule struct {
-	Id                *string                          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string                           `bson:"name" json:"name"`
-	Source            []StructureMapGroupRuleSource    `bson:"source" json:"source"`
-	Target            []StructureMapGroupRuleTarget    `bson:"target,omitempty" json:"target,omitempty"`
-	Rule              []StructureMapGroupRule          `bson:"rule,omitempty" json:"rule,omitempty"`
-	Dependent         []StructureMapGroupRuleDependent `bson:"dependent,omitempty" json:"dependent,omitempty"`
-	Documentation     *string                          `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	Id                   *string                          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string                           `bson:"name" json:"name"`
+	NameElement          *Element                         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Source               []StructureMapGroupRuleSource    `bson:"source" json:"source"`
+	Target               []StructureMapGroupRuleTarget    `bson:"target,omitempty" json:"target,omitempty"`
+	Rule                 []StructureMapGroupRule          `bson:"rule,omitempty" json:"rule,omitempty"`
+	Dependent            []StructureMapGroupRuleDependent `bson:"dependent,omitempty" json:"dependent,omitempty"`
+	Documentation        *string                          `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element                         `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
 }
 type StructureMapGroupRuleSource struct {
 	Id                              *string                     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension                       []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension               []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Context                         string                      `bson:"context" json:"context"`
+	ContextElement                  *Element                    `bson:"_context,omitempty" json:"_context,omitempty"`
 	Min                             *int                        `bson:"min,omitempty" json:"min,omitempty"`
+	MinElement                      *Element                    `bson:"_min,omitempty" json:"_min,omitempty"`
 	Max                             *string                     `bson:"max,omitempty" json:"max,omitempty"`
+	MaxElement                      *Element                    `bson:"_max,omitempty" json:"_max,omitempty"`
 	Type                            *string                     `bson:"type,omitempty" json:"type,omitempty"`
+	TypeElement                     *Element                    `bson:"_type,omitempty" json:"_type,omitempty"`
 	DefaultValueBase64Binary        *string                     `bson:"defaultValueBase64Binary,omitempty" json:"defaultValueBase64Binary,omitempty"`
+	DefaultValueBase64BinaryElement *Element                    `bson:"_defaultValueBase64Binary,omitempty" json:"_defaultValueBase64Binary,omitempty"`
 	DefaultValueBoolean             *bool                       `bson:"defaultValueBoolean,omitempty" json:"defaultValueBoolean,omitempty"`
+	DefaultValueBooleanElement      *Element                    `bson:"_defaultValueBoolean,omitempty" json:"_defaultValueBoolean,omitempty"`
 	DefaultValueCanonical           *string                     `bson:"defaultValueCanonical,omitempty" json:"defaultValueCanonical,omitempty"`
+	DefaultValueCanonicalElement    *Element                    `bson:"_defaultValueCanonical,omitempty" json:"_defaultValueCanonical,omitempty"`
 	DefaultValueCode                *string                     `bson:"defaultValueCode,omitempty" json:"defaultValueCode,omitempty"`
+	DefaultValueCodeElement         *Element                    `bson:"_defaultValueCode,omitempty" json:"_defaultValueCode,omitempty"`
 	DefaultValueDate                *string                     `bson:"defaultValueDate,omitempty" json:"defaultValueDate,omitempty"`
+	DefaultValueDateElement         *Element                    `bson:"_defaultValueDate,omitempty" json:"_defaultValueDate,omitempty"`
 	DefaultValueDateTime            *string                     `bson:"defaultValueDateTime,omitempty" json:"defaultValueDateTime,omitempty"`
+	DefaultValueDateTimeElement     *Element                    `bson:"_defaultValueDateTime,omitempty" json:"_defaultValueDateTime,omitempty"`
 	DefaultValueDecimal             *json.Number                `bson:"defaultValueDecimal,omitempty" json:"defaultValueDecimal,omitempty"`
+	DefaultValueDecimalElement      *Element                    `bson:"_defaultValueDecimal,omitempty" json:"_defaultValueDecimal,omitempty"`
 	DefaultValueId                  *string                     `bson:"defaultValueId,omitempty" json:"defaultValueId,omitempty"`
+	DefaultValueIdElement           *Element                    `bson:"_defaultValueId,omitempty" json:"_defaultValueId,omitempty"`
 	DefaultValueInstant             *string                     `bson:"defaultValueInstant,omitempty" json:"defaultValueInstant,omitempty"`
+	DefaultValueInstantElement      *Element                    `bson:"_defaultValueInstant,omitempty" json:"_defaultValueInstant,omitempty"`
 	DefaultValueInteger             *int                        `bson:"defaultValueInteger,omitempty" json:"defaultValueInteger,omitempty"`
+	DefaultValueIntegerElement      *Element                    `bson:"_defaultValueInteger,omitempty" json:"_defaultValueInteger,omitempty"`
 	DefaultValueMarkdown            *string                     `bson:"defaultValueMarkdown,omitempty" json:"defaultValueMarkdown,omitempty"`
+	DefaultValueMarkdownElement     *Element                    `bson:"_defaultValueMarkdown,omitempty" json:"_defaultValueMarkdown,omitempty"`
 	DefaultValueOid                 *string                     `bson:"defaultValueOid,omitempty" json:"defaultValueOid,omitempty"`
+	DefaultValueOidElement          *Element                    `bson:"_defaultValueOid,omitempty" json:"_defaultValueOid,omitempty"`
 	DefaultValuePositiveInt         *int                        `bson:"defaultValuePositiveInt,omitempty" json:"defaultValuePositiveInt,omitempty"`
+	DefaultValuePositiveIntElement  *Element                    `bson:"_defaultValuePositiveInt,omitempty" json:"_defaultValuePositiveInt,omitempty"`
 	DefaultValueString              *string                     `bson:"defaultValueString,omitempty" json:"defaultValueString,omitempty"`
+	DefaultValueStringElement       *Element                    `bson:"_defaultValueString,omitempty" json:"_defaultValueString,omitempty"`
 	DefaultValueTime                *string                     `bson:"defaultValueTime,omitempty" json:"defaultValueTime,omitempty"`
+	DefaultValueTimeElement         *Element                    `bson:"_defaultValueTime,omitempty" json:"_defaultValueTime,omitempty"`
 	DefaultValueUnsignedInt         *int                        `bson:"defaultValueUnsignedInt,omitempty" json:"defaultValueUnsignedInt,omitempty"`
+	DefaultValueUnsignedIntElement  *Element                    `bson:"_defaultValueUnsignedInt,omitempty" json:"_defaultValueUnsignedInt,omitempty"`
 	DefaultValueUri                 *string                     `bson:"defaultValueUri,omitempty" json:"defaultValueUri,omitempty"`
+	DefaultValueUriElement          *Element                    `bson:"_defaultValueUri,omitempty" json:"_defaultValueUri,omitempty"`
 	DefaultValueUrl                 *string                     `bson:"defaultValueUrl,omitempty" json:"defaultValueUrl,omitempty"`
+	DefaultValueUrlElement          *Element                    `bson:"_defaultValueUrl,omitempty" json:"_defaultValueUrl,omitempty"`
 	DefaultValueUuid                *string                     `bson:"defaultValueUuid,omitempty" json:"defaultValueUuid,omitempty"`
+	DefaultValueUuidElement         *Element                    `bson:"_defaultValueUuid,omitempty" json:"_defaultValueUuid,omitempty"`
 	DefaultValueAddress             *Address                    `bson:"defaultValueAddress,omitempty" json:"defaultValueAddress,omitempty"`
 	DefaultValueAge                 *Age                        `bson:"defaultValueAge,omitempty" json:"defaultValueAge,omitempty"`
 	DefaultValueAnnotation          *Annotation                 `bson:"defaultValueAnnotation,omitempty" json:"defaultValueAnnotation,omitempty"`
@@ -146,59 +202,10021 @@ type StructureMapGroupRuleSource struct {
 	DefaultValueDosage              *Dosage                     `bson:"defaultValueDosage,omitempty" json:"defaultValueDosage,omitempty"`
 	DefaultValueMeta                *Meta                       `bson:"defaultValueMeta,omitempty" json:"defaultValueMeta,omitempty"`
 	Element                         *string                     `bson:"element,omitempty" json:"element,omitempty"`
+	ElementElement                  *Element                    `bson:"_element,omitempty" json:"_element,omitempty"`
 	ListMode                        *StructureMapSourceListMode `bson:"listMode,omitempty" json:"listMode,omitempty"`
+	ListModeElement                 *Element                    `bson:"_listMode,omitempty" json:"_listMode,omitempty"`
 	Variable                        *string                     `bson:"variable,omitempty" json:"variable,omitempty"`
+	VariableElement                 *Element                    `bson:"_variable,omitempty" json:"_variable,omitempty"`
 	Condition                       *string                     `bson:"condition,omitempty" json:"condition,omitempty"`
+	ConditionElement                *Element                    `bson:"_condition,omitempty" json:"_condition,omitempty"`
 	Check                           *string                     `bson:"check,omitempty" json:"check,omitempty"`
+	CheckElement                    *Element                    `bson:"_check,omitempty" json:"_check,omitempty"`
 	LogMessage                      *string                     `bson:"logMessage,omitempty" json:"logMessage,omitempty"`
+	LogMessageElement               *Element                    `bson:"_logMessage,omitempty" json:"_logMessage,omitempty"`
 }
+
+// StructureMapGroupRuleSourceDefaultValue is implemented by the types allowed for StructureMap.group.rule.source.defaultValue[x]
+type StructureMapGroupRuleSourceDefaultValue interface {
+	isStructureMapGroupRuleSourceDefaultValue()
+}
+
+func (Base64Binary) isStructureMapGroupRuleSourceDefaultValue()        {}
+func (Boolean) isStructureMapGroupRuleSourceDefaultValue()             {}
+func (Canonical) isStructureMapGroupRuleSourceDefaultValue()           {}
+func (Code) isStructureMapGroupRuleSourceDefaultValue()                {}
+func (Date) isStructureMapGroupRuleSourceDefaultValue()                {}
+func (DateTime) isStructureMapGroupRuleSourceDefaultValue()            {}
+func (Decimal) isStructureMapGroupRuleSourceDefaultValue()             {}
+func (Id) isStructureMapGroupRuleSourceDefaultValue()                  {}
+func (Instant) isStructureMapGroupRuleSourceDefaultValue()             {}
+func (Integer) isStructureMapGroupRuleSourceDefaultValue()             {}
+func (Markdown) isStructureMapGroupRuleSourceDefaultValue()            {}
+func (Oid) isStructureMapGroupRuleSourceDefaultValue()                 {}
+func (PositiveInt) isStructureMapGroupRuleSourceDefaultValue()         {}
+func (String) isStructureMapGroupRuleSourceDefaultValue()              {}
+func (Time) isStructureMapGroupRuleSourceDefaultValue()                {}
+func (UnsignedInt) isStructureMapGroupRuleSourceDefaultValue()         {}
+func (Uri) isStructureMapGroupRuleSourceDefaultValue()                 {}
+func (Url) isStructureMapGroupRuleSourceDefaultValue()                 {}
+func (Uuid) isStructureMapGroupRuleSourceDefaultValue()                {}
+func (Address) isStructureMapGroupRuleSourceDefaultValue()             {}
+func (Age) isStructureMapGroupRuleSourceDefaultValue()                 {}
+func (Annotation) isStructureMapGroupRuleSourceDefaultValue()          {}
+func (Attachment) isStructureMapGroupRuleSourceDefaultValue()          {}
+func (CodeableConcept) isStructureMapGroupRuleSourceDefaultValue()     {}
+func (Coding) isStructureMapGroupRuleSourceDefaultValue()              {}
+func (ContactPoint) isStructureMapGroupRuleSourceDefaultValue()        {}
+func (Count) isStructureMapGroupRuleSourceDefaultValue()               {}
+func (Distance) isStructureMapGroupRuleSourceDefaultValue()            {}
+func (Duration) isStructureMapGroupRuleSourceDefaultValue()            {}
+func (HumanName) isStructureMapGroupRuleSourceDefaultValue()           {}
+func (Identifier) isStructureMapGroupRuleSourceDefaultValue()          {}
+func (Money) isStructureMapGroupRuleSourceDefaultValue()               {}
+func (Period) isStructureMapGroupRuleSourceDefaultValue()              {}
+func (Quantity) isStructureMapGroupRuleSourceDefaultValue()            {}
+func (Range) isStructureMapGroupRuleSourceDefaultValue()               {}
+func (Ratio) isStructureMapGroupRuleSourceDefaultValue()               {}
+func (Reference) isStructureMapGroupRuleSourceDefaultValue()           {}
+func (SampledData) isStructureMapGroupRuleSourceDefaultValue()         {}
+func (Signature) isStructureMapGroupRuleSourceDefaultValue()           {}
+func (Timing) isStructureMapGroupRuleSourceDefaultValue()              {}
+func (ContactDetail) isStructureMapGroupRuleSourceDefaultValue()       {}
+func (Contributor) isStructureMapGroupRuleSourceDefaultValue()         {}
+func (DataRequirement) isStructureMapGroupRuleSourceDefaultValue()     {}
+func (Expression) isStructureMapGroupRuleSourceDefaultValue()          {}
+func (ParameterDefinition) isStructureMapGroupRuleSourceDefaultValue() {}
+func (RelatedArtifact) isStructureMapGroupRuleSourceDefaultValue()     {}
+func (TriggerDefinition) isStructureMapGroupRuleSourceDefaultValue()   {}
+func (UsageContext) isStructureMapGroupRuleSourceDefaultValue()        {}
+func (Dosage) isStructureMapGroupRuleSourceDefaultValue()              {}
+func (Meta) isStructureMapGroupRuleSourceDefaultValue()                {}
+
+// DefaultValue returns the value of StructureMap.group.rule.source.defaultValue[x] together with its FHIR type code or nil if no value is set
+func (r StructureMapGroupRuleSource) DefaultValue() (StructureMapGroupRuleSourceDefaultValue, string) {
+	if r.DefaultValueBase64Binary != nil {
+		return Base64Binary(*r.DefaultValueBase64Binary), "base64Binary"
+	}
+	if r.DefaultValueBoolean != nil {
+		return Boolean(*r.DefaultValueBoolean), "boolean"
+	}
+	if r.DefaultValueCanonical != nil {
+		return Canonical(*r.DefaultValueCanonical), "canonical"
+	}
+	if r.DefaultValueCode != nil {
+		return Code(*r.DefaultValueCode), "code"
+	}
+	if r.DefaultValueDate != nil {
+		return Date(*r.DefaultValueDate), "date"
+	}
+	if r.DefaultValueDateTime != nil {
+		return DateTime(*r.DefaultValueDateTime), "dateTime"
+	}
+	if r.DefaultValueDecimal != nil {
+		return Decimal(*r.DefaultValueDecimal), "decimal"
+	}
+	if r.DefaultValueId != nil {
+		return Id(*r.DefaultValueId), "id"
+	}
+	if r.DefaultValueInstant != nil {
+		return Instant(*r.DefaultValueInstant), "instant"
+	}
+	if r.DefaultValueInteger != nil {
+		return Integer(*r.DefaultValueInteger), "integer"
+	}
+	if r.DefaultValueMarkdown != nil {
+		return Markdown(*r.DefaultValueMarkdown), "markdown"
+	}
+	if r.DefaultValueOid != nil {
+		return Oid(*r.DefaultValueOid), "oid"
+	}
+	if r.DefaultValuePositiveInt != nil {
+		return PositiveInt(*r.DefaultValuePositiveInt), "positiveInt"
+	}
+	if r.DefaultValueString != nil {
+		return String(*r.DefaultValueString), "string"
+	}
+	if r.DefaultValueTime != nil {
+		return Time(*r.DefaultValueTime), "time"
+	}
+	if r.DefaultValueUnsignedInt != nil {
+		return UnsignedInt(*r.DefaultValueUnsignedInt), "unsignedInt"
+	}
+	if r.DefaultValueUri != nil {
+		return Uri(*r.DefaultValueUri), "uri"
+	}
+	if r.DefaultValueUrl != nil {
+		return Url(*r.DefaultValueUrl), "url"
+	}
+	if r.DefaultValueUuid != nil {
+		return Uuid(*r.DefaultValueUuid), "uuid"
+	}
+	if r.DefaultValueAddress != nil {
+		return *r.DefaultValueAddress, "Address"
+	}
+	if r.DefaultValueAge != nil {
+		return *r.DefaultValueAge, "Age"
+	}
+	if r.DefaultValueAnnotation != nil {
+		return *r.DefaultValueAnnotation, "Annotation"
+	}
+	if r.DefaultValueAttachment != nil {
+		return *r.DefaultValueAttachment, "Attachment"
+	}
+	if r.DefaultValueCodeableConcept != nil {
+		return *r.DefaultValueCodeableConcept, "CodeableConcept"
+	}
+	if r.DefaultValueCoding != nil {
+		return *r.DefaultValueCoding, "Coding"
+	}
+	if r.DefaultValueContactPoint != nil {
+		return *r.DefaultValueContactPoint, "ContactPoint"
+	}
+	if r.DefaultValueCount != nil {
+		return *r.DefaultValueCount, "Count"
+	}
+	if r.DefaultValueDistance != nil {
+		return *r.DefaultValueDistance, "Distance"
+	}
+	if r.DefaultValueDuration != nil {
+		return *r.DefaultValueDuration, "Duration"
+	}
+	if r.DefaultValueHumanName != nil {
+		return *r.DefaultValueHumanName, "HumanName"
+	}
+	if r.DefaultValueIdentifier != nil {
+		return *r.DefaultValueIdentifier, "Identifier"
+	}
+	if r.DefaultValueMoney != nil {
+		return *r.DefaultValueMoney, "Money"
+	}
+	if r.DefaultValuePeriod != nil {
+		return *r.DefaultValuePeriod, "Period"
+	}
+	if r.DefaultValueQuantity != nil {
+		return *r.DefaultValueQuantity, "Quantity"
+	}
+	if r.DefaultValueRange != nil {
+		return *r.DefaultValueRange, "Range"
+	}
+	if r.DefaultValueRatio != nil {
+		return *r.DefaultValueRatio, "Ratio"
+	}
+	if r.DefaultValueReference != nil {
+		return *r.DefaultValueReference, "Reference"
+	}
+	if r.DefaultValueSampledData != nil {
+		return *r.DefaultValueSampledData, "SampledData"
+	}
+	if r.DefaultValueSignature != nil {
+		return *r.DefaultValueSignature, "Signature"
+	}
+	if r.DefaultValueTiming != nil {
+		return *r.DefaultValueTiming, "Timing"
+	}
+	if r.DefaultValueContactDetail != nil {
+		return *r.DefaultValueContactDetail, "ContactDetail"
+	}
+	if r.DefaultValueContributor != nil {
+		return *r.DefaultValueContributor, "Contributor"
+	}
+	if r.DefaultValueDataRequirement != nil {
+		return *r.DefaultValueDataRequirement, "DataRequirement"
+	}
+	if r.DefaultValueExpression != nil {
+		return *r.DefaultValueExpression, "Expression"
+	}
+	if r.DefaultValueParameterDefinition != nil {
+		return *r.DefaultValueParameterDefinition, "ParameterDefinition"
+	}
+	if r.DefaultValueRelatedArtifact != nil {
+		return *r.DefaultValueRelatedArtifact, "RelatedArtifact"
+	}
+	if r.DefaultValueTriggerDefinition != nil {
+		return *r.DefaultValueTriggerDefinition, "TriggerDefinition"
+	}
+	if r.DefaultValueUsageContext != nil {
+		return *r.DefaultValueUsageContext, "UsageContext"
+	}
+	if r.DefaultValueDosage != nil {
+		return *r.DefaultValueDosage, "Dosage"
+	}
+	if r.DefaultValueMeta != nil {
+		return *r.DefaultValueMeta, "Meta"
+	}
+	return nil, ""
+}
+
+// SetDefaultValue sets the value of StructureMap.group.rule.source.defaultValue[x], given as value or pointer, and clears all other types
+func (r *StructureMapGroupRuleSource) SetDefaultValue(value StructureMapGroupRuleSourceDefaultValue) {
+	r.DefaultValueBase64Binary = nil
+	r.DefaultValueBoolean = nil
+	r.DefaultValueCanonical = nil
+	r.DefaultValueCode = nil
+	r.DefaultValueDate = nil
+	r.DefaultValueDateTime = nil
+	r.DefaultValueDecimal = nil
+	r.DefaultValueId = nil
+	r.DefaultValueInstant = nil
+	r.DefaultValueInteger = nil
+	r.DefaultValueMarkdown = nil
+	r.DefaultValueOid = nil
+	r.DefaultValuePositiveInt = nil
+	r.DefaultValueString = nil
+	r.DefaultValueTime = nil
+	r.DefaultValueUnsignedInt = nil
+	r.DefaultValueUri = nil
+	r.DefaultValueUrl = nil
+	r.DefaultValueUuid = nil
+	r.DefaultValueAddress = nil
+	r.DefaultValueAge = nil
+	r.DefaultValueAnnotation = nil
+	r.DefaultValueAttachment = nil
+	r.DefaultValueCodeableConcept = nil
+	r.DefaultValueCoding = nil
+	r.DefaultValueContactPoint = nil
+	r.DefaultValueCount = nil
+	r.DefaultValueDistance = nil
+	r.DefaultValueDuration = nil
+	r.DefaultValueHumanName = nil
+	r.DefaultValueIdentifier = nil
+	r.DefaultValueMoney = nil
+	r.DefaultValuePeriod = nil
+	r.DefaultValueQuantity = nil
+	r.DefaultValueRange = nil
+	r.DefaultValueRatio = nil
+	r.DefaultValueReference = nil
+	r.DefaultValueSampledData = nil
+	r.DefaultValueSignature = nil
+	r.DefaultValueTiming = nil
+	r.DefaultValueContactDetail = nil
+	r.DefaultValueContributor = nil
+	r.DefaultValueDataRequirement = nil
+	r.DefaultValueExpression = nil
+	r.DefaultValueParameterDefinition = nil
+	r.DefaultValueRelatedArtifact = nil
+	r.DefaultValueTriggerDefinition = nil
+	r.DefaultValueUsageContext = nil
+	r.DefaultValueDosage = nil
+	r.DefaultValueMeta = nil
+	switch v := value.(type) {
+	case Base64Binary:
+		primitive := string(v)
+		r.DefaultValueBase64Binary = &primitive
+	case *Base64Binary:
+		r.DefaultValueBase64Binary = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.DefaultValueBoolean = &primitive
+	case *Boolean:
+		r.DefaultValueBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.DefaultValueCanonical = &primitive
+	case *Canonical:
+		r.DefaultValueCanonical = (*string)(v)
+	case Code:
+		primitive := string(v)
+		r.DefaultValueCode = &primitive
+	case *Code:
+		r.DefaultValueCode = (*string)(v)
+	case Date:
+		primitive := string(v)
+		r.DefaultValueDate = &primitive
+	case *Date:
+		r.DefaultValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.DefaultValueDateTime = &primitive
+	case *DateTime:
+		r.DefaultValueDateTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.DefaultValueDecimal = &primitive
+	case *Decimal:
+		r.DefaultValueDecimal = (*json.Number)(v)
+	case Id:
+		primitive := string(v)
+		r.DefaultValueId = &primitive
+	case *Id:
+		r.DefaultValueId = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.DefaultValueInstant = &primitive
+	case *Instant:
+		r.DefaultValueInstant = (*string)(v)
+	case Integer:
+		primitive := int(v)
+		r.DefaultValueInteger = &primitive
+	case *Integer:
+		r.DefaultValueInteger = (*int)(v)
+	case Markdown:
+		primitive := string(v)
+		r.DefaultValueMarkdown = &primitive
+	case *Markdown:
+		r.DefaultValueMarkdown = (*string)(v)
+	case Oid:
+		primitive := string(v)
+		r.DefaultValueOid = &primitive
+	case *Oid:
+		r.DefaultValueOid = (*string)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.DefaultValuePositiveInt = &primitive
+	case *PositiveInt:
+		r.DefaultValuePositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.DefaultValueString = &primitive
+	case *String:
+		r.DefaultValueString = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.DefaultValueTime = &primitive
+	case *Time:
+		r.DefaultValueTime = (*string)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.DefaultValueUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.DefaultValueUnsignedInt = (*int)(v)
+	case Uri:
+		primitive := string(v)
+		r.DefaultValueUri = &primitive
+	case *Uri:
+		r.DefaultValueUri = (*string)(v)
+	case Url:
+		primitive := string(v)
+		r.DefaultValueUrl = &primitive
+	case *Url:
+		r.DefaultValueUrl = (*string)(v)
+	case Uuid:
+		primitive := string(v)
+		r.DefaultValueUuid = &primitive
+	case *Uuid:
+		r.DefaultValueUuid = (*string)(v)
+	case Address:
+		r.DefaultValueAddress = &v
+	case *Address:
+		r.DefaultValueAddress = v
+	case Age:
+		r.DefaultValueAge = &v
+	case *Age:
+		r.DefaultValueAge = v
+	case Annotation:
+		r.DefaultValueAnnotation = &v
+	case *Annotation:
+		r.DefaultValueAnnotation = v
+	case Attachment:
+		r.DefaultValueAttachment = &v
+	case *Attachment:
+		r.DefaultValueAttachment = v
+	case CodeableConcept:
+		r.DefaultValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.DefaultValueCodeableConcept = v
+	case Coding:
+		r.DefaultValueCoding = &v
+	case *Coding:
+		r.DefaultValueCoding = v
+	case ContactPoint:
+		r.DefaultValueContactPoint = &v
+	case *ContactPoint:
+		r.DefaultValueContactPoint = v
+	case Count:
+		r.DefaultValueCount = &v
+	case *Count:
+		r.DefaultValueCount = v
+	case Distance:
+		r.DefaultValueDistance = &v
+	case *Distance:
+		r.DefaultValueDistance = v
+	case Duration:
+		r.DefaultValueDuration = &v
+	case *Duration:
+		r.DefaultValueDuration = v
+	case HumanName:
+		r.DefaultValueHumanName = &v
+	case *HumanName:
+		r.DefaultValueHumanName = v
+	case Identifier:
+		r.DefaultValueIdentifier = &v
+	case *Identifier:
+		r.DefaultValueIdentifier = v
+	case Money:
+		r.DefaultValueMoney = &v
+	case *Money:
+		r.DefaultValueMoney = v
+	case Period:
+		r.DefaultValuePeriod = &v
+	case *Period:
+		r.DefaultValuePeriod = v
+	case Quantity:
+		r.DefaultValueQuantity = &v
+	case *Quantity:
+		r.DefaultValueQuantity = v
+	case Range:
+		r.DefaultValueRange = &v
+	case *Range:
+		r.DefaultValueRange = v
+	case Ratio:
+		r.DefaultValueRatio = &v
+	case *Ratio:
+		r.DefaultValueRatio = v
+	case Reference:
+		r.DefaultValueReference = &v
+	case *Reference:
+		r.DefaultValueReference = v
+	case SampledData:
+		r.DefaultValueSampledData = &v
+	case *SampledData:
+		r.DefaultValueSampledData = v
+	case Signature:
+		r.DefaultValueSignature = &v
+	case *Signature:
+		r.DefaultValueSignature = v
+	case Timing:
+		r.DefaultValueTiming = &v
+	case *Timing:
+		r.DefaultValueTiming = v
+	case ContactDetail:
+		r.DefaultValueContactDetail = &v
+	case *ContactDetail:
+		r.DefaultValueContactDetail = v
+	case Contributor:
+		r.DefaultValueContributor = &v
+	case *Contributor:
+		r.DefaultValueContributor = v
+	case DataRequirement:
+		r.DefaultValueDataRequirement = &v
+	case *DataRequirement:
+		r.DefaultValueDataRequirement = v
+	case Expression:
+		r.DefaultValueExpression = &v
+	case *Expression:
+		r.DefaultValueExpression = v
+	case ParameterDefinition:
+		r.DefaultValueParameterDefinition = &v
+	case *ParameterDefinition:
+		r.DefaultValueParameterDefinition = v
+	case RelatedArtifact:
+		r.DefaultValueRelatedArtifact = &v
+	case *RelatedArtifact:
+		r.DefaultValueRelatedArtifact = v
+	case TriggerDefinition:
+		r.DefaultValueTriggerDefinition = &v
+	case *TriggerDefinition:
+		r.DefaultValueTriggerDefinition = v
+	case UsageContext:
+		r.DefaultValueUsageContext = &v
+	case *UsageContext:
+		r.DefaultValueUsageContext = v
+	case Dosage:
+		r.DefaultValueDosage = &v
+	case *Dosage:
+		r.DefaultValueDosage = v
+	case Meta:
+		r.DefaultValueMeta = &v
+	case *Meta:
+		r.DefaultValueMeta = v
+	}
+}
+
 type StructureMapGroupRuleTarget struct {
-	Id                *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Context           *string                                `bson:"context,omitempty" json:"context,omitempty"`
-	ContextType       *StructureMapContextType               `bson:"contextType,omitempty" json:"contextType,omitempty"`
-	Element           *string                                `bson:"element,omitempty" json:"element,omitempty"`
-	Variable          *string                                `bson:"variable,omitempty" json:"variable,omitempty"`
-	ListMode          []StructureMapTargetListMode           `bson:"listMode,omitempty" json:"listMode,omitempty"`
-	ListRuleId        *string                                `bson:"listRuleId,omitempty" json:"listRuleId,omitempty"`
-	Transform         *StructureMapTransform                 `bson:"transform,omitempty" json:"transform,omitempty"`
-	Parameter         []StructureMapGroupRuleTargetParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	Id                 *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Context            *string                                `bson:"context,omitempty" json:"context,omitempty"`
+	ContextElement     *Element                               `bson:"_context,omitempty" json:"_context,omitempty"`
+	ContextType        *StructureMapContextType               `bson:"contextType,omitempty" json:"contextType,omitempty"`
+	ContextTypeElement *Element                               `bson:"_contextType,omitempty" json:"_contextType,omitempty"`
+	Element            *string                                `bson:"element,omitempty" json:"element,omitempty"`
+	ElementElement     *Element                               `bson:"_element,omitempty" json:"_element,omitempty"`
+	Variable           *string                                `bson:"variable,omitempty" json:"variable,omitempty"`
+	VariableElement    *Element                               `bson:"_variable,omitempty" json:"_variable,omitempty"`
+	ListMode           []StructureMapTargetListMode           `bson:"listMode,omitempty" json:"listMode,omitempty"`
+	ListModeElement    []*Element                             `bson:"_listMode,omitempty" json:"_listMode,omitempty"`
+	ListRuleId         *string                                `bson:"listRuleId,omitempty" json:"listRuleId,omitempty"`
+	ListRuleIdElement  *Element                               `bson:"_listRuleId,omitempty" json:"_listRuleId,omitempty"`
+	Transform          *StructureMapTransform                 `bson:"transform,omitempty" json:"transform,omitempty"`
+	TransformElement   *Element                               `bson:"_transform,omitempty" json:"_transform,omitempty"`
+	Parameter          []StructureMapGroupRuleTargetParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
 }
 type StructureMapGroupRuleTargetParameter struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	ValueId           string      `bson:"valueId" json:"valueId"`
-	ValueString       string      `bson:"valueString" json:"valueString"`
-	ValueBoolean      bool        `bson:"valueBoolean" json:"valueBoolean"`
-	ValueInteger      int         `bson:"valueInteger" json:"valueInteger"`
-	ValueDecimal      json.Number `bson:"valueDecimal" json:"valueDecimal"`
+	Id                  *string      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	ValueId             *string      `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement      *Element     `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueString         *string      `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement  *Element     `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueBoolean        *bool        `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement *Element     `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueInteger        *int         `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement *Element     `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueDecimal        *json.Number `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement *Element     `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+}
+
+// StructureMapGroupRuleTargetParameterValue is implemented by the types allowed for StructureMap.group.rule.target.parameter.value[x]
+type StructureMapGroupRuleTargetParameterValue interface {
+	isStructureMapGroupRuleTargetParameterValue()
 }
+
+func (Id) isStructureMapGroupRuleTargetParameterValue()      {}
+func (String) isStructureMapGroupRuleTargetParameterValue()  {}
+func (Boolean) isStructureMapGroupRuleTargetParameterValue() {}
+func (Integer) isStructureMapGroupRuleTargetParameterValue() {}
+func (Decimal) isStructureMapGroupRuleTargetParameterValue() {}
+
+// Value returns the value of StructureMap.group.rule.target.parameter.value[x] together with its FHIR type code or nil if no value is set
+func (r StructureMapGroupRuleTargetParameter) Value() (StructureMapGroupRuleTargetParameterValue, string) {
+	if r.ValueId != nil {
+		return Id(*r.ValueId), "id"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueDecimal != nil {
+		return Decimal(*r.ValueDecimal), "decimal"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of StructureMap.group.rule.target.parameter.value[x], given as value or pointer, and clears all other types
+func (r *StructureMapGroupRuleTargetParameter) SetValue(value StructureMapGroupRuleTargetParameterValue) {
+	r.ValueId = nil
+	r.ValueString = nil
+	r.ValueBoolean = nil
+	r.ValueInteger = nil
+	r.ValueDecimal = nil
+	switch v := value.(type) {
+	case Id:
+		primitive := string(v)
+		r.ValueId = &primitive
+	case *Id:
+		r.ValueId = (*string)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.ValueDecimal = &primitive
+	case *Decimal:
+		r.ValueDecimal = (*json.Number)(v)
+	}
+}
+
 type StructureMapGroupRuleDependent struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              string      `bson:"name" json:"name"`
+	NameElement       *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
 	Variable          []string    `bson:"variable" json:"variable"`
+	VariableElement   []*Element  `bson:"_variable,omitempty" json:"_variable,omitempty"`
 }
-type OtherStructureMap StructureMap
 
 // MarshalJSON marshals the given StructureMap as JSON into a byte slice
 func (r StructureMap) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherStructureMap
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherStructureMap: OtherStructureMap(r),
-		ResourceType:      "StructureMap",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureMap as JSON object with the members in the order of the fields
+func (r StructureMap) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"StructureMap\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if len(r.Structure) > 0 {
+		e.key("structure")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Structure {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Import) > 0 {
+		e.key("import")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Import {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ImportElement) > 0 {
+		e.key("_import")
+		e.elements(r.ImportElement, len(r.Import))
+	}
+	e.key("group")
+	if r.Group == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Group {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureMap from JSON. Unknown members are ignored.
+func (r *StructureMap) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureMap
+func (r *StructureMap) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "structure":
+			a := d.array()
+			r.Structure = nil
+			for a.next() {
+				var v StructureMapStructure
+				v.decodeJSON(d)
+				r.Structure = append(r.Structure, v)
+			}
+			if r.Structure == nil && !a.isNull {
+				r.Structure = []StructureMapStructure{}
+			}
+		case "import":
+			a := d.array()
+			r.Import = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Import = append(r.Import, v)
+			}
+			if r.Import == nil && !a.isNull {
+				r.Import = []string{}
+			}
+		case "_import":
+			a := d.array()
+			r.ImportElement = nil
+			for a.next() {
+				r.ImportElement = append(r.ImportElement, d.element())
+			}
+		case "group":
+			a := d.array()
+			r.Group = nil
+			for a.next() {
+				var v StructureMapGroup
+				v.decodeJSON(d)
+				r.Group = append(r.Group, v)
+			}
+			if r.Group == nil && !a.isNull {
+				r.Group = []StructureMapGroup{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureMap which shares no memory with the original
+func (r StructureMap) DeepCopy() StructureMap {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.Structure != nil {
+		out.Structure = make([]StructureMapStructure, len(r.Structure))
+		for i := range r.Structure {
+			out.Structure[i] = r.Structure[i].DeepCopy()
+		}
+	}
+	if r.Import != nil {
+		out.Import = make([]string, len(r.Import))
+		copy(out.Import, r.Import)
+	}
+	if r.ImportElement != nil {
+		out.ImportElement = make([]*Element, len(r.ImportElement))
+		for i, v := range r.ImportElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ImportElement[i] = &c
+			}
+		}
+	}
+	if r.Group != nil {
+		out.Group = make([]StructureMapGroup, len(r.Group))
+		for i := range r.Group {
+			out.Group[i] = r.Group[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureMap) Equal(other StructureMap) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if len(r.Structure) != len(other.Structure) {
+		return false
+	}
+	for i := range r.Structure {
+		if !r.Structure[i].Equal(other.Structure[i]) {
+			return false
+		}
+	}
+	if len(r.Import) != len(other.Import) {
+		return false
+	}
+	for i := range r.Import {
+		if r.Import[i] != other.Import[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ImportElement, other.ImportElement, false) {
+		return false
+	}
+	if len(r.Group) != len(other.Group) {
+		return false
+	}
+	for i := range r.Group {
+		if !r.Group[i].Equal(other.Group[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureMap) EqualsDeep(other StructureMap) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if !equivalentList(len(r.Structure), len(other.Structure), func(i, j int) bool {
+		return r.Structure[i].EqualsDeep(other.Structure[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Import), len(other.Import), func(i, j int) bool {
+		return equivalentString(r.Import[i], other.Import[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ImportElement, other.ImportElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Group), len(other.Group), func(i, j int) bool {
+		return r.Group[i].EqualsDeep(other.Group[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureMap) EqualsShallow(other StructureMap) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if !equivalentList(len(r.Import), len(other.Import), func(i, j int) bool {
+		return equivalentString(r.Import[i], other.Import[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureMap as FHIR XML
+func (r StructureMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "StructureMap",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	for _, v := range r.Structure {
+		if err := encodeXMLElement(e, "structure", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Import {
+		if err := encodeXMLPrimitive(e, "import", v, elementAt(r.ImportElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Group {
+		if err := encodeXMLElement(e, "group", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureMap from FHIR XML
+func (r *StructureMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "structure":
+				var v StructureMapStructure
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Structure = append(r.Structure, v)
+			case "import":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ImportElement = appendElement(r.ImportElement, len(r.Import), element)
+					r.Import = append(r.Import, v)
+				}
+			case "group":
+				var v StructureMapGroup
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Group = append(r.Group, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureMap as properties to the RDF node n
+func (r StructureMap) turtle(n *turtleNode) {
+	n.resource("StructureMap", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("StructureMap.url", -1, r.Url, r.UrlElement, "string")
+	for i, v := range r.Identifier {
+		n.element("StructureMap.identifier", i, v)
+	}
+	n.primitive("StructureMap.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("StructureMap.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("StructureMap.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("StructureMap.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("StructureMap.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("StructureMap.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("StructureMap.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("StructureMap.contact", i, v)
+	}
+	n.primitive("StructureMap.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("StructureMap.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("StructureMap.jurisdiction", i, v)
+	}
+	n.primitive("StructureMap.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("StructureMap.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	for i, v := range r.Structure {
+		n.element("StructureMap.structure", i, v)
+	}
+	for i, v := range r.Import {
+		n.primitive("StructureMap.import", i, v, elementAt(r.ImportElement, i), "string")
+	}
+	for i, v := range r.Group {
+		n.element("StructureMap.group", i, v)
+	}
+}
+
+// MarshalBSON marshals the given StructureMap as BSON document with the structure of its FHIR JSON
+func (r StructureMap) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureMap from a BSON document with the structure of its FHIR JSON
+func (r *StructureMap) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureMap as protobuf message StructureMap of fhir.proto
+func (r StructureMap) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureMap to the encoded message
+func (r StructureMap) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != "" {
+		e.string(9, r.Url)
+	}
+	for _, v := range r.Identifier {
+		e.message(10, v)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != "" {
+		e.string(12, r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	e.varint(14, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(15, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(16, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(17, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(18, v)
+	}
+	if r.Description != nil {
+		e.string(19, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(20, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(21, v)
+	}
+	if r.Purpose != nil {
+		e.string(22, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(23, *r.Copyright)
+	}
+	for _, v := range r.Structure {
+		e.message(24, v)
+	}
+	for _, v := range r.Import {
+		e.string(25, v)
+	}
+	for _, v := range r.Group {
+		e.message(26, v)
+	}
+	if r.IdElement != nil {
+		e.message(27, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(28, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(29, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(30, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(31, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(32, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(33, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(34, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(35, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(36, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(37, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(38, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(39, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(40, *r.CopyrightElement)
+	}
+	for _, v := range r.ImportElement {
+		e.element(41, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureMap from protobuf message StructureMap of fhir.proto
+func (r *StructureMap) UnmarshalProto(b []byte) error {
+	*r = StructureMap{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			r.Url = d.string()
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			r.Name = d.string()
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 15:
+			v := d.bool()
+			r.Experimental = &v
+		case 16:
+			v := d.string()
+			r.Date = &v
+		case 17:
+			v := d.string()
+			r.Publisher = &v
+		case 18:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 19:
+			v := d.string()
+			r.Description = &v
+		case 20:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 22:
+			v := d.string()
+			r.Purpose = &v
+		case 23:
+			v := d.string()
+			r.Copyright = &v
+		case 24:
+			var v StructureMapStructure
+			d.message(&v)
+			r.Structure = append(r.Structure, v)
+		case 25:
+			r.Import = append(r.Import, d.string())
+		case 26:
+			var v StructureMapGroup
+			d.message(&v)
+			r.Group = append(r.Group, v)
+		case 27:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 41:
+			r.ImportElement = append(r.ImportElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureMapStructure as JSON into a byte slice
+func (r StructureMapStructure) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureMapStructure as JSON object with the members in the order of the fields
+func (r StructureMapStructure) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	e.key("mode")
+	e.string(r.Mode.Code())
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	if r.Alias != nil {
+		e.key("alias")
+		e.string(*r.Alias)
+	}
+	if r.AliasElement != nil {
+		e.key("_alias")
+		r.AliasElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureMapStructure from JSON. Unknown members are ignored.
+func (r *StructureMapStructure) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureMapStructure
+func (r *StructureMapStructure) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "mode":
+			d.unmarshal(&r.Mode)
+		case "_mode":
+			r.ModeElement = d.element()
+		case "alias":
+			if v, ok := d.string(); ok {
+				r.Alias = &v
+			} else {
+				r.Alias = nil
+			}
+		case "_alias":
+			r.AliasElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureMapStructure which shares no memory with the original
+func (r StructureMapStructure) DeepCopy() StructureMapStructure {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.Alias != nil {
+		v := *r.Alias
+		out.Alias = &v
+	}
+	if r.AliasElement != nil {
+		v := r.AliasElement.DeepCopy()
+		out.AliasElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureMapStructure) Equal(other StructureMapStructure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if (r.Alias == nil) != (other.Alias == nil) || r.Alias != nil && *r.Alias != *other.Alias {
+		return false
+	}
+	if (r.AliasElement == nil) != (other.AliasElement == nil) || r.AliasElement != nil && !r.AliasElement.Equal(*other.AliasElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureMapStructure) EqualsDeep(other StructureMapStructure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if (r.Alias == nil) != (other.Alias == nil) || r.Alias != nil && !equivalentString(*r.Alias, *other.Alias) {
+		return false
+	}
+	if (r.AliasElement == nil) != (other.AliasElement == nil) || r.AliasElement != nil && !r.AliasElement.EqualsDeep(*other.AliasElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureMapStructure) EqualsShallow(other StructureMapStructure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.Alias == nil) != (other.Alias == nil) || r.Alias != nil && !equivalentString(*r.Alias, *other.Alias) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureMapStructure as FHIR XML
+func (r StructureMapStructure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "alias", r.Alias, r.AliasElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureMapStructure from FHIR XML
+func (r *StructureMapStructure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "mode":
+				var v StructureMapModelMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = v
+				}
+				r.ModeElement = element
+			case "alias":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Alias = &v
+				}
+				r.AliasElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureMapStructure as properties to the RDF node n
+func (r StructureMapStructure) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureMap.structure.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("StructureMap.structure.mode", -1, r.Mode, r.ModeElement, "code")
+	n.primitive("StructureMap.structure.alias", -1, r.Alias, r.AliasElement, "string")
+	n.primitive("StructureMap.structure.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+}
+
+// MarshalBSON marshals the given StructureMapStructure as BSON document with the structure of its FHIR JSON
+func (r StructureMapStructure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureMapStructure from a BSON document with the structure of its FHIR JSON
+func (r *StructureMapStructure) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureMapStructure as protobuf message StructureMapStructure of fhir.proto
+func (r StructureMapStructure) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureMapStructure to the encoded message
+func (r StructureMapStructure) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Url != "" {
+		e.string(4, r.Url)
+	}
+	e.varint(5, r.Mode.protoNumber())
+	if r.Alias != nil {
+		e.string(6, *r.Alias)
+	}
+	if r.Documentation != nil {
+		e.string(7, *r.Documentation)
+	}
+	if r.UrlElement != nil {
+		e.message(8, *r.UrlElement)
+	}
+	if r.ModeElement != nil {
+		e.message(9, *r.ModeElement)
+	}
+	if r.AliasElement != nil {
+		e.message(10, *r.AliasElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(11, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureMapStructure from protobuf message StructureMapStructure of fhir.proto
+func (r *StructureMapStructure) UnmarshalProto(b []byte) error {
+	*r = StructureMapStructure{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Url = d.string()
+		case 5:
+			var v StructureMapModelMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = v
+		case 6:
+			v := d.string()
+			r.Alias = &v
+		case 7:
+			v := d.string()
+			r.Documentation = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.AliasElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureMapGroup as JSON into a byte slice
+func (r StructureMapGroup) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureMapGroup as JSON object with the members in the order of the fields
+func (r StructureMapGroup) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Extends != nil {
+		e.key("extends")
+		e.string(*r.Extends)
+	}
+	if r.ExtendsElement != nil {
+		e.key("_extends")
+		r.ExtendsElement.appendJSON(e)
+	}
+	e.key("typeMode")
+	e.string(r.TypeMode.Code())
+	if r.TypeModeElement != nil {
+		e.key("_typeMode")
+		r.TypeModeElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.key("input")
+	if r.Input == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Input {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("rule")
+	if r.Rule == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Rule {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureMapGroup from JSON. Unknown members are ignored.
+func (r *StructureMapGroup) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureMapGroup
+func (r *StructureMapGroup) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "extends":
+			if v, ok := d.string(); ok {
+				r.Extends = &v
+			} else {
+				r.Extends = nil
+			}
+		case "_extends":
+			r.ExtendsElement = d.element()
+		case "typeMode":
+			d.unmarshal(&r.TypeMode)
+		case "_typeMode":
+			r.TypeModeElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		case "input":
+			a := d.array()
+			r.Input = nil
+			for a.next() {
+				var v StructureMapGroupInput
+				v.decodeJSON(d)
+				r.Input = append(r.Input, v)
+			}
+			if r.Input == nil && !a.isNull {
+				r.Input = []StructureMapGroupInput{}
+			}
+		case "rule":
+			a := d.array()
+			r.Rule = nil
+			for a.next() {
+				var v StructureMapGroupRule
+				v.decodeJSON(d)
+				r.Rule = append(r.Rule, v)
+			}
+			if r.Rule == nil && !a.isNull {
+				r.Rule = []StructureMapGroupRule{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureMapGroup which shares no memory with the original
+func (r StructureMapGroup) DeepCopy() StructureMapGroup {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Extends != nil {
+		v := *r.Extends
+		out.Extends = &v
+	}
+	if r.ExtendsElement != nil {
+		v := r.ExtendsElement.DeepCopy()
+		out.ExtendsElement = &v
+	}
+	if r.TypeModeElement != nil {
+		v := r.TypeModeElement.DeepCopy()
+		out.TypeModeElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	if r.Input != nil {
+		out.Input = make([]StructureMapGroupInput, len(r.Input))
+		for i := range r.Input {
+			out.Input[i] = r.Input[i].DeepCopy()
+		}
+	}
+	if r.Rule != nil {
+		out.Rule = make([]StructureMapGroupRule, len(r.Rule))
+		for i := range r.Rule {
+			out.Rule[i] = r.Rule[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureMapGroup) Equal(other StructureMapGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Extends == nil) != (other.Extends == nil) || r.Extends != nil && *r.Extends != *other.Extends {
+		return false
+	}
+	if (r.ExtendsElement == nil) != (other.ExtendsElement == nil) || r.ExtendsElement != nil && !r.ExtendsElement.Equal(*other.ExtendsElement) {
+		return false
+	}
+	if r.TypeMode != other.TypeMode {
+		return false
+	}
+	if (r.TypeModeElement == nil) != (other.TypeModeElement == nil) || r.TypeModeElement != nil && !r.TypeModeElement.Equal(*other.TypeModeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	if len(r.Input) != len(other.Input) {
+		return false
+	}
+	for i := range r.Input {
+		if !r.Input[i].Equal(other.Input[i]) {
+			return false
+		}
+	}
+	if len(r.Rule) != len(other.Rule) {
+		return false
+	}
+	for i := range r.Rule {
+		if !r.Rule[i].Equal(other.Rule[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureMapGroup) EqualsDeep(other StructureMapGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Extends == nil) != (other.Extends == nil) || r.Extends != nil && !equivalentString(*r.Extends, *other.Extends) {
+		return false
+	}
+	if (r.ExtendsElement == nil) != (other.ExtendsElement == nil) || r.ExtendsElement != nil && !r.ExtendsElement.EqualsDeep(*other.ExtendsElement) {
+		return false
+	}
+	if r.TypeMode != other.TypeMode {
+		return false
+	}
+	if (r.TypeModeElement == nil) != (other.TypeModeElement == nil) || r.TypeModeElement != nil && !r.TypeModeElement.EqualsDeep(*other.TypeModeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	if !equivalentList(len(r.Input), len(other.Input), func(i, j int) bool {
+		return r.Input[i].EqualsDeep(other.Input[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Rule), len(other.Rule), func(i, j int) bool {
+		return r.Rule[i].EqualsDeep(other.Rule[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureMapGroup) EqualsShallow(other StructureMapGroup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Extends == nil) != (other.Extends == nil) || r.Extends != nil && !equivalentString(*r.Extends, *other.Extends) {
+		return false
+	}
+	if r.TypeMode != other.TypeMode {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureMapGroup as FHIR XML
+func (r StructureMapGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "extends", r.Extends, r.ExtendsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "typeMode", r.TypeMode, r.TypeModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	for _, v := range r.Input {
+		if err := encodeXMLElement(e, "input", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Rule {
+		if err := encodeXMLElement(e, "rule", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureMapGroup from FHIR XML
+func (r *StructureMapGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "extends":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Extends = &v
+				}
+				r.ExtendsElement = element
+			case "typeMode":
+				var v StructureMapGroupTypeMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TypeMode = v
+				}
+				r.TypeModeElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			case "input":
+				var v StructureMapGroupInput
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Input = append(r.Input, v)
+			case "rule":
+				var v StructureMapGroupRule
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Rule = append(r.Rule, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureMapGroup as properties to the RDF node n
+func (r StructureMapGroup) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureMap.group.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("StructureMap.group.extends", -1, r.Extends, r.ExtendsElement, "string")
+	n.primitive("StructureMap.group.typeMode", -1, r.TypeMode, r.TypeModeElement, "code")
+	n.primitive("StructureMap.group.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+	for i, v := range r.Input {
+		n.element("StructureMap.group.input", i, v)
+	}
+	for i, v := range r.Rule {
+		n.element("StructureMap.group.rule", i, v)
+	}
+}
+
+// MarshalBSON marshals the given StructureMapGroup as BSON document with the structure of its FHIR JSON
+func (r StructureMapGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureMapGroup from a BSON document with the structure of its FHIR JSON
+func (r *StructureMapGroup) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureMapGroup as protobuf message StructureMapGroup of fhir.proto
+func (r StructureMapGroup) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureMapGroup to the encoded message
+func (r StructureMapGroup) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Extends != nil {
+		e.string(5, *r.Extends)
+	}
+	e.varint(6, r.TypeMode.protoNumber())
+	if r.Documentation != nil {
+		e.string(7, *r.Documentation)
+	}
+	for _, v := range r.Input {
+		e.message(8, v)
+	}
+	for _, v := range r.Rule {
+		e.message(9, v)
+	}
+	if r.NameElement != nil {
+		e.message(10, *r.NameElement)
+	}
+	if r.ExtendsElement != nil {
+		e.message(11, *r.ExtendsElement)
+	}
+	if r.TypeModeElement != nil {
+		e.message(12, *r.TypeModeElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(13, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureMapGroup from protobuf message StructureMapGroup of fhir.proto
+func (r *StructureMapGroup) UnmarshalProto(b []byte) error {
+	*r = StructureMapGroup{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.Extends = &v
+		case 6:
+			var v StructureMapGroupTypeMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.TypeMode = v
+		case 7:
+			v := d.string()
+			r.Documentation = &v
+		case 8:
+			var v StructureMapGroupInput
+			d.message(&v)
+			r.Input = append(r.Input, v)
+		case 9:
+			var v StructureMapGroupRule
+			d.message(&v)
+			r.Rule = append(r.Rule, v)
+		case 10:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ExtendsElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.TypeModeElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureMapGroupInput as JSON into a byte slice
+func (r StructureMapGroupInput) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureMapGroupInput as JSON object with the members in the order of the fields
+func (r StructureMapGroupInput) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(*r.Type)
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("mode")
+	e.string(r.Mode.Code())
+	if r.ModeElement != nil {
+		e.key("_mode")
+		r.ModeElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureMapGroupInput from JSON. Unknown members are ignored.
+func (r *StructureMapGroupInput) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureMapGroupInput
+func (r *StructureMapGroupInput) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = &v
+			} else {
+				r.Type = nil
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "mode":
+			d.unmarshal(&r.Mode)
+		case "_mode":
+			r.ModeElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureMapGroupInput which shares no memory with the original
+func (r StructureMapGroupInput) DeepCopy() StructureMapGroupInput {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.ModeElement != nil {
+		v := r.ModeElement.DeepCopy()
+		out.ModeElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureMapGroupInput) Equal(other StructureMapGroupInput) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.Equal(*other.ModeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureMapGroupInput) EqualsDeep(other StructureMapGroupInput) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.ModeElement == nil) != (other.ModeElement == nil) || r.ModeElement != nil && !r.ModeElement.EqualsDeep(*other.ModeElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureMapGroupInput) EqualsShallow(other StructureMapGroupInput) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if r.Mode != other.Mode {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureMapGroupInput as FHIR XML
+func (r StructureMapGroupInput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "mode", r.Mode, r.ModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureMapGroupInput from FHIR XML
+func (r *StructureMapGroupInput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "mode":
+				var v StructureMapInputMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Mode = v
+				}
+				r.ModeElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureMapGroupInput as properties to the RDF node n
+func (r StructureMapGroupInput) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureMap.group.input.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("StructureMap.group.input.type", -1, r.Type, r.TypeElement, "string")
+	n.primitive("StructureMap.group.input.mode", -1, r.Mode, r.ModeElement, "code")
+	n.primitive("StructureMap.group.input.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+}
+
+// MarshalBSON marshals the given StructureMapGroupInput as BSON document with the structure of its FHIR JSON
+func (r StructureMapGroupInput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureMapGroupInput from a BSON document with the structure of its FHIR JSON
+func (r *StructureMapGroupInput) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureMapGroupInput as protobuf message StructureMapGroupInput of fhir.proto
+func (r StructureMapGroupInput) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureMapGroupInput to the encoded message
+func (r StructureMapGroupInput) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Type != nil {
+		e.string(5, *r.Type)
+	}
+	e.varint(6, r.Mode.protoNumber())
+	if r.Documentation != nil {
+		e.string(7, *r.Documentation)
+	}
+	if r.NameElement != nil {
+		e.message(8, *r.NameElement)
+	}
+	if r.TypeElement != nil {
+		e.message(9, *r.TypeElement)
+	}
+	if r.ModeElement != nil {
+		e.message(10, *r.ModeElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(11, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureMapGroupInput from protobuf message StructureMapGroupInput of fhir.proto
+func (r *StructureMapGroupInput) UnmarshalProto(b []byte) error {
+	*r = StructureMapGroupInput{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.Type = &v
+		case 6:
+			var v StructureMapInputMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Mode = v
+		case 7:
+			v := d.string()
+			r.Documentation = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ModeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureMapGroupRule as JSON into a byte slice
+func (r StructureMapGroupRule) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureMapGroupRule as JSON object with the members in the order of the fields
+func (r StructureMapGroupRule) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("source")
+	if r.Source == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Target) > 0 {
+		e.key("target")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Target {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Rule) > 0 {
+		e.key("rule")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Rule {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Dependent) > 0 {
+		e.key("dependent")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Dependent {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureMapGroupRule from JSON. Unknown members are ignored.
+func (r *StructureMapGroupRule) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureMapGroupRule
+func (r *StructureMapGroupRule) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v StructureMapGroupRuleSource
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []StructureMapGroupRuleSource{}
+			}
+		case "target":
+			a := d.array()
+			r.Target = nil
+			for a.next() {
+				var v StructureMapGroupRuleTarget
+				v.decodeJSON(d)
+				r.Target = append(r.Target, v)
+			}
+			if r.Target == nil && !a.isNull {
+				r.Target = []StructureMapGroupRuleTarget{}
+			}
+		case "rule":
+			a := d.array()
+			r.Rule = nil
+			for a.next() {
+				var v StructureMapGroupRule
+				v.decodeJSON(d)
+				r.Rule = append(r.Rule, v)
+			}
+			if r.Rule == nil && !a.isNull {
+				r.Rule = []StructureMapGroupRule{}
+			}
+		case "dependent":
+			a := d.array()
+			r.Dependent = nil
+			for a.next() {
+				var v StructureMapGroupRuleDependent
+				v.decodeJSON(d)
+				r.Dependent = append(r.Dependent, v)
+			}
+			if r.Dependent == nil && !a.isNull {
+				r.Dependent = []StructureMapGroupRuleDependent{}
+			}
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureMapGroupRule which shares no memory with the original
+func (r StructureMapGroupRule) DeepCopy() StructureMapGroupRule {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Source != nil {
+		out.Source = make([]StructureMapGroupRuleSource, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	if r.Target != nil {
+		out.Target = make([]StructureMapGroupRuleTarget, len(r.Target))
+		for i := range r.Target {
+			out.Target[i] = r.Target[i].DeepCopy()
+		}
+	}
+	if r.Rule != nil {
+		out.Rule = make([]StructureMapGroupRule, len(r.Rule))
+		for i := range r.Rule {
+			out.Rule[i] = r.Rule[i].DeepCopy()
+		}
+	}
+	if r.Dependent != nil {
+		out.Dependent = make([]StructureMapGroupRuleDependent, len(r.Dependent))
+		for i := range r.Dependent {
+			out.Dependent[i] = r.Dependent[i].DeepCopy()
+		}
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureMapGroupRule) Equal(other StructureMapGroupRule) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	if len(r.Target) != len(other.Target) {
+		return false
+	}
+	for i := range r.Target {
+		if !r.Target[i].Equal(other.Target[i]) {
+			return false
+		}
+	}
+	if len(r.Rule) != len(other.Rule) {
+		return false
+	}
+	for i := range r.Rule {
+		if !r.Rule[i].Equal(other.Rule[i]) {
+			return false
+		}
+	}
+	if len(r.Dependent) != len(other.Dependent) {
+		return false
+	}
+	for i := range r.Dependent {
+		if !r.Dependent[i].Equal(other.Dependent[i]) {
+			return false
+		}
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureMapGroupRule) EqualsDeep(other StructureMapGroupRule) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i].EqualsDeep(other.Target[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Rule), len(other.Rule), func(i, j int) bool {
+		return r.Rule[i].EqualsDeep(other.Rule[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Dependent), len(other.Dependent), func(i, j int) bool {
+		return r.Dependent[i].EqualsDeep(other.Dependent[j])
+	}) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureMapGroupRule) EqualsShallow(other StructureMapGroupRule) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureMapGroupRule as FHIR XML
+func (r StructureMapGroupRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Target {
+		if err := encodeXMLElement(e, "target", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Rule {
+		if err := encodeXMLElement(e, "rule", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Dependent {
+		if err := encodeXMLElement(e, "dependent", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureMapGroupRule from FHIR XML
+func (r *StructureMapGroupRule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "source":
+				var v StructureMapGroupRuleSource
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			case "target":
+				var v StructureMapGroupRuleTarget
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = append(r.Target, v)
+			case "rule":
+				var v StructureMapGroupRule
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Rule = append(r.Rule, v)
+			case "dependent":
+				var v StructureMapGroupRuleDependent
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Dependent = append(r.Dependent, v)
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureMapGroupRule as properties to the RDF node n
+func (r StructureMapGroupRule) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureMap.group.rule.name", -1, r.Name, r.NameElement, "string")
+	for i, v := range r.Source {
+		n.element("StructureMap.group.rule.source", i, v)
+	}
+	for i, v := range r.Target {
+		n.element("StructureMap.group.rule.target", i, v)
+	}
+	for i, v := range r.Rule {
+		n.element("StructureMap.group.rule.rule", i, v)
+	}
+	for i, v := range r.Dependent {
+		n.element("StructureMap.group.rule.dependent", i, v)
+	}
+	n.primitive("StructureMap.group.rule.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+}
+
+// MarshalBSON marshals the given StructureMapGroupRule as BSON document with the structure of its FHIR JSON
+func (r StructureMapGroupRule) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureMapGroupRule from a BSON document with the structure of its FHIR JSON
+func (r *StructureMapGroupRule) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureMapGroupRule as protobuf message StructureMapGroupRule of fhir.proto
+func (r StructureMapGroupRule) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureMapGroupRule to the encoded message
+func (r StructureMapGroupRule) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	for _, v := range r.Source {
+		e.message(5, v)
+	}
+	for _, v := range r.Target {
+		e.message(6, v)
+	}
+	for _, v := range r.Rule {
+		e.message(7, v)
+	}
+	for _, v := range r.Dependent {
+		e.message(8, v)
+	}
+	if r.Documentation != nil {
+		e.string(9, *r.Documentation)
+	}
+	if r.NameElement != nil {
+		e.message(10, *r.NameElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(11, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureMapGroupRule from protobuf message StructureMapGroupRule of fhir.proto
+func (r *StructureMapGroupRule) UnmarshalProto(b []byte) error {
+	*r = StructureMapGroupRule{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			var v StructureMapGroupRuleSource
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		case 6:
+			var v StructureMapGroupRuleTarget
+			d.message(&v)
+			r.Target = append(r.Target, v)
+		case 7:
+			var v StructureMapGroupRule
+			d.message(&v)
+			r.Rule = append(r.Rule, v)
+		case 8:
+			var v StructureMapGroupRuleDependent
+			d.message(&v)
+			r.Dependent = append(r.Dependent, v)
+		case 9:
+			v := d.string()
+			r.Documentation = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureMapGroupRuleSource as JSON into a byte slice
+func (r StructureMapGroupRuleSource) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureMapGroupRuleSource as JSON object with the members in the order of the fields
+func (r StructureMapGroupRuleSource) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("context")
+	e.string(r.Context)
+	if r.ContextElement != nil {
+		e.key("_context")
+		r.ContextElement.appendJSON(e)
+	}
+	if r.Min != nil {
+		e.key("min")
+		e.int(*r.Min)
+	}
+	if r.MinElement != nil {
+		e.key("_min")
+		r.MinElement.appendJSON(e)
+	}
+	if r.Max != nil {
+		e.key("max")
+		e.string(*r.Max)
+	}
+	if r.MaxElement != nil {
+		e.key("_max")
+		r.MaxElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		e.string(*r.Type)
+	}
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.DefaultValueBase64Binary != nil {
+		e.key("defaultValueBase64Binary")
+		e.string(*r.DefaultValueBase64Binary)
+	}
+	if r.DefaultValueBase64BinaryElement != nil {
+		e.key("_defaultValueBase64Binary")
+		r.DefaultValueBase64BinaryElement.appendJSON(e)
+	}
+	if r.DefaultValueBoolean != nil {
+		e.key("defaultValueBoolean")
+		e.bool(*r.DefaultValueBoolean)
+	}
+	if r.DefaultValueBooleanElement != nil {
+		e.key("_defaultValueBoolean")
+		r.DefaultValueBooleanElement.appendJSON(e)
+	}
+	if r.DefaultValueCanonical != nil {
+		e.key("defaultValueCanonical")
+		e.string(*r.DefaultValueCanonical)
+	}
+	if r.DefaultValueCanonicalElement != nil {
+		e.key("_defaultValueCanonical")
+		r.DefaultValueCanonicalElement.appendJSON(e)
+	}
+	if r.DefaultValueCode != nil {
+		e.key("defaultValueCode")
+		e.string(*r.DefaultValueCode)
+	}
+	if r.DefaultValueCodeElement != nil {
+		e.key("_defaultValueCode")
+		r.DefaultValueCodeElement.appendJSON(e)
+	}
+	if r.DefaultValueDate != nil {
+		e.key("defaultValueDate")
+		e.string(*r.DefaultValueDate)
+	}
+	if r.DefaultValueDateElement != nil {
+		e.key("_defaultValueDate")
+		r.DefaultValueDateElement.appendJSON(e)
+	}
+	if r.DefaultValueDateTime != nil {
+		e.key("defaultValueDateTime")
+		e.string(*r.DefaultValueDateTime)
+	}
+	if r.DefaultValueDateTimeElement != nil {
+		e.key("_defaultValueDateTime")
+		r.DefaultValueDateTimeElement.appendJSON(e)
+	}
+	if r.DefaultValueDecimal != nil {
+		e.key("defaultValueDecimal")
+		e.number(*r.DefaultValueDecimal)
+	}
+	if r.DefaultValueDecimalElement != nil {
+		e.key("_defaultValueDecimal")
+		r.DefaultValueDecimalElement.appendJSON(e)
+	}
+	if r.DefaultValueId != nil {
+		e.key("defaultValueId")
+		e.string(*r.DefaultValueId)
+	}
+	if r.DefaultValueIdElement != nil {
+		e.key("_defaultValueId")
+		r.DefaultValueIdElement.appendJSON(e)
+	}
+	if r.DefaultValueInstant != nil {
+		e.key("defaultValueInstant")
+		e.string(*r.DefaultValueInstant)
+	}
+	if r.DefaultValueInstantElement != nil {
+		e.key("_defaultValueInstant")
+		r.DefaultValueInstantElement.appendJSON(e)
+	}
+	if r.DefaultValueInteger != nil {
+		e.key("defaultValueInteger")
+		e.int(*r.DefaultValueInteger)
+	}
+	if r.DefaultValueIntegerElement != nil {
+		e.key("_defaultValueInteger")
+		r.DefaultValueIntegerElement.appendJSON(e)
+	}
+	if r.DefaultValueMarkdown != nil {
+		e.key("defaultValueMarkdown")
+		e.string(*r.DefaultValueMarkdown)
+	}
+	if r.DefaultValueMarkdownElement != nil {
+		e.key("_defaultValueMarkdown")
+		r.DefaultValueMarkdownElement.appendJSON(e)
+	}
+	if r.DefaultValueOid != nil {
+		e.key("defaultValueOid")
+		e.string(*r.DefaultValueOid)
+	}
+	if r.DefaultValueOidElement != nil {
+		e.key("_defaultValueOid")
+		r.DefaultValueOidElement.appendJSON(e)
+	}
+	if r.DefaultValuePositiveInt != nil {
+		e.key("defaultValuePositiveInt")
+		e.int(*r.DefaultValuePositiveInt)
+	}
+	if r.DefaultValuePositiveIntElement != nil {
+		e.key("_defaultValuePositiveInt")
+		r.DefaultValuePositiveIntElement.appendJSON(e)
+	}
+	if r.DefaultValueString != nil {
+		e.key("defaultValueString")
+		e.string(*r.DefaultValueString)
+	}
+	if r.DefaultValueStringElement != nil {
+		e.key("_defaultValueString")
+		r.DefaultValueStringElement.appendJSON(e)
+	}
+	if r.DefaultValueTime != nil {
+		e.key("defaultValueTime")
+		e.string(*r.DefaultValueTime)
+	}
+	if r.DefaultValueTimeElement != nil {
+		e.key("_defaultValueTime")
+		r.DefaultValueTimeElement.appendJSON(e)
+	}
+	if r.DefaultValueUnsignedInt != nil {
+		e.key("defaultValueUnsignedInt")
+		e.int(*r.DefaultValueUnsignedInt)
+	}
+	if r.DefaultValueUnsignedIntElement != nil {
+		e.key("_defaultValueUnsignedInt")
+		r.DefaultValueUnsignedIntElement.appendJSON(e)
+	}
+	if r.DefaultValueUri != nil {
+		e.key("defaultValueUri")
+		e.string(*r.DefaultValueUri)
+	}
+	if r.DefaultValueUriElement != nil {
+		e.key("_defaultValueUri")
+		r.DefaultValueUriElement.appendJSON(e)
+	}
+	if r.DefaultValueUrl != nil {
+		e.key("defaultValueUrl")
+		e.string(*r.DefaultValueUrl)
+	}
+	if r.DefaultValueUrlElement != nil {
+		e.key("_defaultValueUrl")
+		r.DefaultValueUrlElement.appendJSON(e)
+	}
+	if r.DefaultValueUuid != nil {
+		e.key("defaultValueUuid")
+		e.string(*r.DefaultValueUuid)
+	}
+	if r.DefaultValueUuidElement != nil {
+		e.key("_defaultValueUuid")
+		r.DefaultValueUuidElement.appendJSON(e)
+	}
+	if r.DefaultValueAddress != nil {
+		e.key("defaultValueAddress")
+		r.DefaultValueAddress.appendJSON(e)
+	}
+	if r.DefaultValueAge != nil {
+		e.key("defaultValueAge")
+		r.DefaultValueAge.appendJSON(e)
+	}
+	if r.DefaultValueAnnotation != nil {
+		e.key("defaultValueAnnotation")
+		r.DefaultValueAnnotation.appendJSON(e)
+	}
+	if r.DefaultValueAttachment != nil {
+		e.key("defaultValueAttachment")
+		r.DefaultValueAttachment.appendJSON(e)
+	}
+	if r.DefaultValueCodeableConcept != nil {
+		e.key("defaultValueCodeableConcept")
+		r.DefaultValueCodeableConcept.appendJSON(e)
+	}
+	if r.DefaultValueCoding != nil {
+		e.key("defaultValueCoding")
+		r.DefaultValueCoding.appendJSON(e)
+	}
+	if r.DefaultValueContactPoint != nil {
+		e.key("defaultValueContactPoint")
+		r.DefaultValueContactPoint.appendJSON(e)
+	}
+	if r.DefaultValueCount != nil {
+		e.key("defaultValueCount")
+		r.DefaultValueCount.appendJSON(e)
+	}
+	if r.DefaultValueDistance != nil {
+		e.key("defaultValueDistance")
+		r.DefaultValueDistance.appendJSON(e)
+	}
+	if r.DefaultValueDuration != nil {
+		e.key("defaultValueDuration")
+		r.DefaultValueDuration.appendJSON(e)
+	}
+	if r.DefaultValueHumanName != nil {
+		e.key("defaultValueHumanName")
+		r.DefaultValueHumanName.appendJSON(e)
+	}
+	if r.DefaultValueIdentifier != nil {
+		e.key("defaultValueIdentifier")
+		r.DefaultValueIdentifier.appendJSON(e)
+	}
+	if r.DefaultValueMoney != nil {
+		e.key("defaultValueMoney")
+		r.DefaultValueMoney.appendJSON(e)
+	}
+	if r.DefaultValuePeriod != nil {
+		e.key("defaultValuePeriod")
+		r.DefaultValuePeriod.appendJSON(e)
+	}
+	if r.DefaultValueQuantity != nil {
+		e.key("defaultValueQuantity")
+		r.DefaultValueQuantity.appendJSON(e)
+	}
+	if r.DefaultValueRange != nil {
+		e.key("defaultValueRange")
+		r.DefaultValueRange.appendJSON(e)
+	}
+	if r.DefaultValueRatio != nil {
+		e.key("defaultValueRatio")
+		r.DefaultValueRatio.appendJSON(e)
+	}
+	if r.DefaultValueReference != nil {
+		e.key("defaultValueReference")
+		r.DefaultValueReference.appendJSON(e)
+	}
+	if r.DefaultValueSampledData != nil {
+		e.key("defaultValueSampledData")
+		r.DefaultValueSampledData.appendJSON(e)
+	}
+	if r.DefaultValueSignature != nil {
+		e.key("defaultValueSignature")
+		r.DefaultValueSignature.appendJSON(e)
+	}
+	if r.DefaultValueTiming != nil {
+		e.key("defaultValueTiming")
+		r.DefaultValueTiming.appendJSON(e)
+	}
+	if r.DefaultValueContactDetail != nil {
+		e.key("defaultValueContactDetail")
+		r.DefaultValueContactDetail.appendJSON(e)
+	}
+	if r.DefaultValueContributor != nil {
+		e.key("defaultValueContributor")
+		r.DefaultValueContributor.appendJSON(e)
+	}
+	if r.DefaultValueDataRequirement != nil {
+		e.key("defaultValueDataRequirement")
+		r.DefaultValueDataRequirement.appendJSON(e)
+	}
+	if r.DefaultValueExpression != nil {
+		e.key("defaultValueExpression")
+		r.DefaultValueExpression.appendJSON(e)
+	}
+	if r.DefaultValueParameterDefinition != nil {
+		e.key("defaultValueParameterDefinition")
+		r.DefaultValueParameterDefinition.appendJSON(e)
+	}
+	if r.DefaultValueRelatedArtifact != nil {
+		e.key("defaultValueRelatedArtifact")
+		r.DefaultValueRelatedArtifact.appendJSON(e)
+	}
+	if r.DefaultValueTriggerDefinition != nil {
+		e.key("defaultValueTriggerDefinition")
+		r.DefaultValueTriggerDefinition.appendJSON(e)
+	}
+	if r.DefaultValueUsageContext != nil {
+		e.key("defaultValueUsageContext")
+		r.DefaultValueUsageContext.appendJSON(e)
+	}
+	if r.DefaultValueDosage != nil {
+		e.key("defaultValueDosage")
+		r.DefaultValueDosage.appendJSON(e)
+	}
+	if r.DefaultValueMeta != nil {
+		e.key("defaultValueMeta")
+		r.DefaultValueMeta.appendJSON(e)
+	}
+	if r.Element != nil {
+		e.key("element")
+		e.string(*r.Element)
+	}
+	if r.ElementElement != nil {
+		e.key("_element")
+		r.ElementElement.appendJSON(e)
+	}
+	if r.ListMode != nil {
+		e.key("listMode")
+		e.string(r.ListMode.Code())
+	}
+	if r.ListModeElement != nil {
+		e.key("_listMode")
+		r.ListModeElement.appendJSON(e)
+	}
+	if r.Variable != nil {
+		e.key("variable")
+		e.string(*r.Variable)
+	}
+	if r.VariableElement != nil {
+		e.key("_variable")
+		r.VariableElement.appendJSON(e)
+	}
+	if r.Condition != nil {
+		e.key("condition")
+		e.string(*r.Condition)
+	}
+	if r.ConditionElement != nil {
+		e.key("_condition")
+		r.ConditionElement.appendJSON(e)
+	}
+	if r.Check != nil {
+		e.key("check")
+		e.string(*r.Check)
+	}
+	if r.CheckElement != nil {
+		e.key("_check")
+		r.CheckElement.appendJSON(e)
+	}
+	if r.LogMessage != nil {
+		e.key("logMessage")
+		e.string(*r.LogMessage)
+	}
+	if r.LogMessageElement != nil {
+		e.key("_logMessage")
+		r.LogMessageElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureMapGroupRuleSource from JSON. Unknown members are ignored.
+func (r *StructureMapGroupRuleSource) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureMapGroupRuleSource
+func (r *StructureMapGroupRuleSource) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "context":
+			if v, ok := d.string(); ok {
+				r.Context = v
+			}
+		case "_context":
+			r.ContextElement = d.element()
+		case "min":
+			if v, ok := d.int(); ok {
+				r.Min = &v
+			} else {
+				r.Min = nil
+			}
+		case "_min":
+			r.MinElement = d.element()
+		case "max":
+			if v, ok := d.string(); ok {
+				r.Max = &v
+			} else {
+				r.Max = nil
+			}
+		case "_max":
+			r.MaxElement = d.element()
+		case "type":
+			if v, ok := d.string(); ok {
+				r.Type = &v
+			} else {
+				r.Type = nil
+			}
+		case "_type":
+			r.TypeElement = d.element()
+		case "defaultValueBase64Binary":
+			if v, ok := d.string(); ok {
+				r.DefaultValueBase64Binary = &v
+			} else {
+				r.DefaultValueBase64Binary = nil
+			}
+		case "_defaultValueBase64Binary":
+			r.DefaultValueBase64BinaryElement = d.element()
+		case "defaultValueBoolean":
+			if v, ok := d.bool(); ok {
+				r.DefaultValueBoolean = &v
+			} else {
+				r.DefaultValueBoolean = nil
+			}
+		case "_defaultValueBoolean":
+			r.DefaultValueBooleanElement = d.element()
+		case "defaultValueCanonical":
+			if v, ok := d.string(); ok {
+				r.DefaultValueCanonical = &v
+			} else {
+				r.DefaultValueCanonical = nil
+			}
+		case "_defaultValueCanonical":
+			r.DefaultValueCanonicalElement = d.element()
+		case "defaultValueCode":
+			if v, ok := d.string(); ok {
+				r.DefaultValueCode = &v
+			} else {
+				r.DefaultValueCode = nil
+			}
+		case "_defaultValueCode":
+			r.DefaultValueCodeElement = d.element()
+		case "defaultValueDate":
+			if v, ok := d.string(); ok {
+				r.DefaultValueDate = &v
+			} else {
+				r.DefaultValueDate = nil
+			}
+		case "_defaultValueDate":
+			r.DefaultValueDateElement = d.element()
+		case "defaultValueDateTime":
+			if v, ok := d.string(); ok {
+				r.DefaultValueDateTime = &v
+			} else {
+				r.DefaultValueDateTime = nil
+			}
+		case "_defaultValueDateTime":
+			r.DefaultValueDateTimeElement = d.element()
+		case "defaultValueDecimal":
+			if v, ok := d.number(); ok {
+				r.DefaultValueDecimal = &v
+			} else {
+				r.DefaultValueDecimal = nil
+			}
+		case "_defaultValueDecimal":
+			r.DefaultValueDecimalElement = d.element()
+		case "defaultValueId":
+			if v, ok := d.string(); ok {
+				r.DefaultValueId = &v
+			} else {
+				r.DefaultValueId = nil
+			}
+		case "_defaultValueId":
+			r.DefaultValueIdElement = d.element()
+		case "defaultValueInstant":
+			if v, ok := d.string(); ok {
+				r.DefaultValueInstant = &v
+			} else {
+				r.DefaultValueInstant = nil
+			}
+		case "_defaultValueInstant":
+			r.DefaultValueInstantElement = d.element()
+		case "defaultValueInteger":
+			if v, ok := d.int(); ok {
+				r.DefaultValueInteger = &v
+			} else {
+				r.DefaultValueInteger = nil
+			}
+		case "_defaultValueInteger":
+			r.DefaultValueIntegerElement = d.element()
+		case "defaultValueMarkdown":
+			if v, ok := d.string(); ok {
+				r.DefaultValueMarkdown = &v
+			} else {
+				r.DefaultValueMarkdown = nil
+			}
+		case "_defaultValueMarkdown":
+			r.DefaultValueMarkdownElement = d.element()
+		case "defaultValueOid":
+			if v, ok := d.string(); ok {
+				r.DefaultValueOid = &v
+			} else {
+				r.DefaultValueOid = nil
+			}
+		case "_defaultValueOid":
+			r.DefaultValueOidElement = d.element()
+		case "defaultValuePositiveInt":
+			if v, ok := d.int(); ok {
+				r.DefaultValuePositiveInt = &v
+			} else {
+				r.DefaultValuePositiveInt = nil
+			}
+		case "_defaultValuePositiveInt":
+			r.DefaultValuePositiveIntElement = d.element()
+		case "defaultValueString":
+			if v, ok := d.string(); ok {
+				r.DefaultValueString = &v
+			} else {
+				r.DefaultValueString = nil
+			}
+		case "_defaultValueString":
+			r.DefaultValueStringElement = d.element()
+		case "defaultValueTime":
+			if v, ok := d.string(); ok {
+				r.DefaultValueTime = &v
+			} else {
+				r.DefaultValueTime = nil
+			}
+		case "_defaultValueTime":
+			r.DefaultValueTimeElement = d.element()
+		case "defaultValueUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.DefaultValueUnsignedInt = &v
+			} else {
+				r.DefaultValueUnsignedInt = nil
+			}
+		case "_defaultValueUnsignedInt":
+			r.DefaultValueUnsignedIntElement = d.element()
+		case "defaultValueUri":
+			if v, ok := d.string(); ok {
+				r.DefaultValueUri = &v
+			} else {
+				r.DefaultValueUri = nil
+			}
+		case "_defaultValueUri":
+			r.DefaultValueUriElement = d.element()
+		case "defaultValueUrl":
+			if v, ok := d.string(); ok {
+				r.DefaultValueUrl = &v
+			} else {
+				r.DefaultValueUrl = nil
+			}
+		case "_defaultValueUrl":
+			r.DefaultValueUrlElement = d.element()
+		case "defaultValueUuid":
+			if v, ok := d.string(); ok {
+				r.DefaultValueUuid = &v
+			} else {
+				r.DefaultValueUuid = nil
+			}
+		case "_defaultValueUuid":
+			r.DefaultValueUuidElement = d.element()
+		case "defaultValueAddress":
+			if d.null() {
+				r.DefaultValueAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.DefaultValueAddress = &v
+			}
+		case "defaultValueAge":
+			if d.null() {
+				r.DefaultValueAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.DefaultValueAge = &v
+			}
+		case "defaultValueAnnotation":
+			if d.null() {
+				r.DefaultValueAnnotation = nil
+			} else {
+				var v Annotation
+				v.decodeJSON(d)
+				r.DefaultValueAnnotation = &v
+			}
+		case "defaultValueAttachment":
+			if d.null() {
+				r.DefaultValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.DefaultValueAttachment = &v
+			}
+		case "defaultValueCodeableConcept":
+			if d.null() {
+				r.DefaultValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DefaultValueCodeableConcept = &v
+			}
+		case "defaultValueCoding":
+			if d.null() {
+				r.DefaultValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.DefaultValueCoding = &v
+			}
+		case "defaultValueContactPoint":
+			if d.null() {
+				r.DefaultValueContactPoint = nil
+			} else {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.DefaultValueContactPoint = &v
+			}
+		case "defaultValueCount":
+			if d.null() {
+				r.DefaultValueCount = nil
+			} else {
+				var v Count
+				v.decodeJSON(d)
+				r.DefaultValueCount = &v
+			}
+		case "defaultValueDistance":
+			if d.null() {
+				r.DefaultValueDistance = nil
+			} else {
+				var v Distance
+				v.decodeJSON(d)
+				r.DefaultValueDistance = &v
+			}
+		case "defaultValueDuration":
+			if d.null() {
+				r.DefaultValueDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.DefaultValueDuration = &v
+			}
+		case "defaultValueHumanName":
+			if d.null() {
+				r.DefaultValueHumanName = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.DefaultValueHumanName = &v
+			}
+		case "defaultValueIdentifier":
+			if d.null() {
+				r.DefaultValueIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.DefaultValueIdentifier = &v
+			}
+		case "defaultValueMoney":
+			if d.null() {
+				r.DefaultValueMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.DefaultValueMoney = &v
+			}
+		case "defaultValuePeriod":
+			if d.null() {
+				r.DefaultValuePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.DefaultValuePeriod = &v
+			}
+		case "defaultValueQuantity":
+			if d.null() {
+				r.DefaultValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.DefaultValueQuantity = &v
+			}
+		case "defaultValueRange":
+			if d.null() {
+				r.DefaultValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.DefaultValueRange = &v
+			}
+		case "defaultValueRatio":
+			if d.null() {
+				r.DefaultValueRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.DefaultValueRatio = &v
+			}
+		case "defaultValueReference":
+			if d.null() {
+				r.DefaultValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.DefaultValueReference = &v
+			}
+		case "defaultValueSampledData":
+			if d.null() {
+				r.DefaultValueSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.DefaultValueSampledData = &v
+			}
+		case "defaultValueSignature":
+			if d.null() {
+				r.DefaultValueSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.DefaultValueSignature = &v
+			}
+		case "defaultValueTiming":
+			if d.null() {
+				r.DefaultValueTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.DefaultValueTiming = &v
+			}
+		case "defaultValueContactDetail":
+			if d.null() {
+				r.DefaultValueContactDetail = nil
+			} else {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.DefaultValueContactDetail = &v
+			}
+		case "defaultValueContributor":
+			if d.null() {
+				r.DefaultValueContributor = nil
+			} else {
+				var v Contributor
+				v.decodeJSON(d)
+				r.DefaultValueContributor = &v
+			}
+		case "defaultValueDataRequirement":
+			if d.null() {
+				r.DefaultValueDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.DefaultValueDataRequirement = &v
+			}
+		case "defaultValueExpression":
+			if d.null() {
+				r.DefaultValueExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.DefaultValueExpression = &v
+			}
+		case "defaultValueParameterDefinition":
+			if d.null() {
+				r.DefaultValueParameterDefinition = nil
+			} else {
+				var v ParameterDefinition
+				v.decodeJSON(d)
+				r.DefaultValueParameterDefinition = &v
+			}
+		case "defaultValueRelatedArtifact":
+			if d.null() {
+				r.DefaultValueRelatedArtifact = nil
+			} else {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.DefaultValueRelatedArtifact = &v
+			}
+		case "defaultValueTriggerDefinition":
+			if d.null() {
+				r.DefaultValueTriggerDefinition = nil
+			} else {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.DefaultValueTriggerDefinition = &v
+			}
+		case "defaultValueUsageContext":
+			if d.null() {
+				r.DefaultValueUsageContext = nil
+			} else {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.DefaultValueUsageContext = &v
+			}
+		case "defaultValueDosage":
+			if d.null() {
+				r.DefaultValueDosage = nil
+			} else {
+				var v Dosage
+				v.decodeJSON(d)
+				r.DefaultValueDosage = &v
+			}
+		case "defaultValueMeta":
+			if d.null() {
+				r.DefaultValueMeta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.DefaultValueMeta = &v
+			}
+		case "element":
+			if v, ok := d.string(); ok {
+				r.Element = &v
+			} else {
+				r.Element = nil
+			}
+		case "_element":
+			r.ElementElement = d.element()
+		case "listMode":
+			if d.null() {
+				r.ListMode = nil
+			} else {
+				var v StructureMapSourceListMode
+				d.unmarshal(&v)
+				r.ListMode = &v
+			}
+		case "_listMode":
+			r.ListModeElement = d.element()
+		case "variable":
+			if v, ok := d.string(); ok {
+				r.Variable = &v
+			} else {
+				r.Variable = nil
+			}
+		case "_variable":
+			r.VariableElement = d.element()
+		case "condition":
+			if v, ok := d.string(); ok {
+				r.Condition = &v
+			} else {
+				r.Condition = nil
+			}
+		case "_condition":
+			r.ConditionElement = d.element()
+		case "check":
+			if v, ok := d.string(); ok {
+				r.Check = &v
+			} else {
+				r.Check = nil
+			}
+		case "_check":
+			r.CheckElement = d.element()
+		case "logMessage":
+			if v, ok := d.string(); ok {
+				r.LogMessage = &v
+			} else {
+				r.LogMessage = nil
+			}
+		case "_logMessage":
+			r.LogMessageElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureMapGroupRuleSource which shares no memory with the original
+func (r StructureMapGroupRuleSource) DeepCopy() StructureMapGroupRuleSource {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ContextElement != nil {
+		v := r.ContextElement.DeepCopy()
+		out.ContextElement = &v
+	}
+	if r.Min != nil {
+		v := *r.Min
+		out.Min = &v
+	}
+	if r.MinElement != nil {
+		v := r.MinElement.DeepCopy()
+		out.MinElement = &v
+	}
+	if r.Max != nil {
+		v := *r.Max
+		out.Max = &v
+	}
+	if r.MaxElement != nil {
+		v := r.MaxElement.DeepCopy()
+		out.MaxElement = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		out.Type = &v
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.DefaultValueBase64Binary != nil {
+		v := *r.DefaultValueBase64Binary
+		out.DefaultValueBase64Binary = &v
+	}
+	if r.DefaultValueBase64BinaryElement != nil {
+		v := r.DefaultValueBase64BinaryElement.DeepCopy()
+		out.DefaultValueBase64BinaryElement = &v
+	}
+	if r.DefaultValueBoolean != nil {
+		v := *r.DefaultValueBoolean
+		out.DefaultValueBoolean = &v
+	}
+	if r.DefaultValueBooleanElement != nil {
+		v := r.DefaultValueBooleanElement.DeepCopy()
+		out.DefaultValueBooleanElement = &v
+	}
+	if r.DefaultValueCanonical != nil {
+		v := *r.DefaultValueCanonical
+		out.DefaultValueCanonical = &v
+	}
+	if r.DefaultValueCanonicalElement != nil {
+		v := r.DefaultValueCanonicalElement.DeepCopy()
+		out.DefaultValueCanonicalElement = &v
+	}
+	if r.DefaultValueCode != nil {
+		v := *r.DefaultValueCode
+		out.DefaultValueCode = &v
+	}
+	if r.DefaultValueCodeElement != nil {
+		v := r.DefaultValueCodeElement.DeepCopy()
+		out.DefaultValueCodeElement = &v
+	}
+	if r.DefaultValueDate != nil {
+		v := *r.DefaultValueDate
+		out.DefaultValueDate = &v
+	}
+	if r.DefaultValueDateElement != nil {
+		v := r.DefaultValueDateElement.DeepCopy()
+		out.DefaultValueDateElement = &v
+	}
+	if r.DefaultValueDateTime != nil {
+		v := *r.DefaultValueDateTime
+		out.DefaultValueDateTime = &v
+	}
+	if r.DefaultValueDateTimeElement != nil {
+		v := r.DefaultValueDateTimeElement.DeepCopy()
+		out.DefaultValueDateTimeElement = &v
+	}
+	if r.DefaultValueDecimal != nil {
+		v := *r.DefaultValueDecimal
+		out.DefaultValueDecimal = &v
+	}
+	if r.DefaultValueDecimalElement != nil {
+		v := r.DefaultValueDecimalElement.DeepCopy()
+		out.DefaultValueDecimalElement = &v
+	}
+	if r.DefaultValueId != nil {
+		v := *r.DefaultValueId
+		out.DefaultValueId = &v
+	}
+	if r.DefaultValueIdElement != nil {
+		v := r.DefaultValueIdElement.DeepCopy()
+		out.DefaultValueIdElement = &v
+	}
+	if r.DefaultValueInstant != nil {
+		v := *r.DefaultValueInstant
+		out.DefaultValueInstant = &v
+	}
+	if r.DefaultValueInstantElement != nil {
+		v := r.DefaultValueInstantElement.DeepCopy()
+		out.DefaultValueInstantElement = &v
+	}
+	if r.DefaultValueInteger != nil {
+		v := *r.DefaultValueInteger
+		out.DefaultValueInteger = &v
+	}
+	if r.DefaultValueIntegerElement != nil {
+		v := r.DefaultValueIntegerElement.DeepCopy()
+		out.DefaultValueIntegerElement = &v
+	}
+	if r.DefaultValueMarkdown != nil {
+		v := *r.DefaultValueMarkdown
+		out.DefaultValueMarkdown = &v
+	}
+	if r.DefaultValueMarkdownElement != nil {
+		v := r.DefaultValueMarkdownElement.DeepCopy()
+		out.DefaultValueMarkdownElement = &v
+	}
+	if r.DefaultValueOid != nil {
+		v := *r.DefaultValueOid
+		out.DefaultValueOid = &v
+	}
+	if r.DefaultValueOidElement != nil {
+		v := r.DefaultValueOidElement.DeepCopy()
+		out.DefaultValueOidElement = &v
+	}
+	if r.DefaultValuePositiveInt != nil {
+		v := *r.DefaultValuePositiveInt
+		out.DefaultValuePositiveInt = &v
+	}
+	if r.DefaultValuePositiveIntElement != nil {
+		v := r.DefaultValuePositiveIntElement.DeepCopy()
+		out.DefaultValuePositiveIntElement = &v
+	}
+	if r.DefaultValueString != nil {
+		v := *r.DefaultValueString
+		out.DefaultValueString = &v
+	}
+	if r.DefaultValueStringElement != nil {
+		v := r.DefaultValueStringElement.DeepCopy()
+		out.DefaultValueStringElement = &v
+	}
+	if r.DefaultValueTime != nil {
+		v := *r.DefaultValueTime
+		out.DefaultValueTime = &v
+	}
+	if r.DefaultValueTimeElement != nil {
+		v := r.DefaultValueTimeElement.DeepCopy()
+		out.DefaultValueTimeElement = &v
+	}
+	if r.DefaultValueUnsignedInt != nil {
+		v := *r.DefaultValueUnsignedInt
+		out.DefaultValueUnsignedInt = &v
+	}
+	if r.DefaultValueUnsignedIntElement != nil {
+		v := r.DefaultValueUnsignedIntElement.DeepCopy()
+		out.DefaultValueUnsignedIntElement = &v
+	}
+	if r.DefaultValueUri != nil {
+		v := *r.DefaultValueUri
+		out.DefaultValueUri = &v
+	}
+	if r.DefaultValueUriElement != nil {
+		v := r.DefaultValueUriElement.DeepCopy()
+		out.DefaultValueUriElement = &v
+	}
+	if r.DefaultValueUrl != nil {
+		v := *r.DefaultValueUrl
+		out.DefaultValueUrl = &v
+	}
+	if r.DefaultValueUrlElement != nil {
+		v := r.DefaultValueUrlElement.DeepCopy()
+		out.DefaultValueUrlElement = &v
+	}
+	if r.DefaultValueUuid != nil {
+		v := *r.DefaultValueUuid
+		out.DefaultValueUuid = &v
+	}
+	if r.DefaultValueUuidElement != nil {
+		v := r.DefaultValueUuidElement.DeepCopy()
+		out.DefaultValueUuidElement = &v
+	}
+	if r.DefaultValueAddress != nil {
+		v := r.DefaultValueAddress.DeepCopy()
+		out.DefaultValueAddress = &v
+	}
+	if r.DefaultValueAge != nil {
+		v := r.DefaultValueAge.DeepCopy()
+		out.DefaultValueAge = &v
+	}
+	if r.DefaultValueAnnotation != nil {
+		v := r.DefaultValueAnnotation.DeepCopy()
+		out.DefaultValueAnnotation = &v
+	}
+	if r.DefaultValueAttachment != nil {
+		v := r.DefaultValueAttachment.DeepCopy()
+		out.DefaultValueAttachment = &v
+	}
+	if r.DefaultValueCodeableConcept != nil {
+		v := r.DefaultValueCodeableConcept.DeepCopy()
+		out.DefaultValueCodeableConcept = &v
+	}
+	if r.DefaultValueCoding != nil {
+		v := r.DefaultValueCoding.DeepCopy()
+		out.DefaultValueCoding = &v
+	}
+	if r.DefaultValueContactPoint != nil {
+		v := r.DefaultValueContactPoint.DeepCopy()
+		out.DefaultValueContactPoint = &v
+	}
+	if r.DefaultValueCount != nil {
+		v := r.DefaultValueCount.DeepCopy()
+		out.DefaultValueCount = &v
+	}
+	if r.DefaultValueDistance != nil {
+		v := r.DefaultValueDistance.DeepCopy()
+		out.DefaultValueDistance = &v
+	}
+	if r.DefaultValueDuration != nil {
+		v := r.DefaultValueDuration.DeepCopy()
+		out.DefaultValueDuration = &v
+	}
+	if r.DefaultValueHumanName != nil {
+		v := r.DefaultValueHumanName.DeepCopy()
+		out.DefaultValueHumanName = &v
+	}
+	if r.DefaultValueIdentifier != nil {
+		v := r.DefaultValueIdentifier.DeepCopy()
+		out.DefaultValueIdentifier = &v
+	}
+	if r.DefaultValueMoney != nil {
+		v := r.DefaultValueMoney.DeepCopy()
+		out.DefaultValueMoney = &v
+	}
+	if r.DefaultValuePeriod != nil {
+		v := r.DefaultValuePeriod.DeepCopy()
+		out.DefaultValuePeriod = &v
+	}
+	if r.DefaultValueQuantity != nil {
+		v := r.DefaultValueQuantity.DeepCopy()
+		out.DefaultValueQuantity = &v
+	}
+	if r.DefaultValueRange != nil {
+		v := r.DefaultValueRange.DeepCopy()
+		out.DefaultValueRange = &v
+	}
+	if r.DefaultValueRatio != nil {
+		v := r.DefaultValueRatio.DeepCopy()
+		out.DefaultValueRatio = &v
+	}
+	if r.DefaultValueReference != nil {
+		v := r.DefaultValueReference.DeepCopy()
+		out.DefaultValueReference = &v
+	}
+	if r.DefaultValueSampledData != nil {
+		v := r.DefaultValueSampledData.DeepCopy()
+		out.DefaultValueSampledData = &v
+	}
+	if r.DefaultValueSignature != nil {
+		v := r.DefaultValueSignature.DeepCopy()
+		out.DefaultValueSignature = &v
+	}
+	if r.DefaultValueTiming != nil {
+		v := r.DefaultValueTiming.DeepCopy()
+		out.DefaultValueTiming = &v
+	}
+	if r.DefaultValueContactDetail != nil {
+		v := r.DefaultValueContactDetail.DeepCopy()
+		out.DefaultValueContactDetail = &v
+	}
+	if r.DefaultValueContributor != nil {
+		v := r.DefaultValueContributor.DeepCopy()
+		out.DefaultValueContributor = &v
+	}
+	if r.DefaultValueDataRequirement != nil {
+		v := r.DefaultValueDataRequirement.DeepCopy()
+		out.DefaultValueDataRequirement = &v
+	}
+	if r.DefaultValueExpression != nil {
+		v := r.DefaultValueExpression.DeepCopy()
+		out.DefaultValueExpression = &v
+	}
+	if r.DefaultValueParameterDefinition != nil {
+		v := r.DefaultValueParameterDefinition.DeepCopy()
+		out.DefaultValueParameterDefinition = &v
+	}
+	if r.DefaultValueRelatedArtifact != nil {
+		v := r.DefaultValueRelatedArtifact.DeepCopy()
+		out.DefaultValueRelatedArtifact = &v
+	}
+	if r.DefaultValueTriggerDefinition != nil {
+		v := r.DefaultValueTriggerDefinition.DeepCopy()
+		out.DefaultValueTriggerDefinition = &v
+	}
+	if r.DefaultValueUsageContext != nil {
+		v := r.DefaultValueUsageContext.DeepCopy()
+		out.DefaultValueUsageContext = &v
+	}
+	if r.DefaultValueDosage != nil {
+		v := r.DefaultValueDosage.DeepCopy()
+		out.DefaultValueDosage = &v
+	}
+	if r.DefaultValueMeta != nil {
+		v := r.DefaultValueMeta.DeepCopy()
+		out.DefaultValueMeta = &v
+	}
+	if r.Element != nil {
+		v := *r.Element
+		out.Element = &v
+	}
+	if r.ElementElement != nil {
+		v := r.ElementElement.DeepCopy()
+		out.ElementElement = &v
+	}
+	if r.ListMode != nil {
+		v := *r.ListMode
+		out.ListMode = &v
+	}
+	if r.ListModeElement != nil {
+		v := r.ListModeElement.DeepCopy()
+		out.ListModeElement = &v
+	}
+	if r.Variable != nil {
+		v := *r.Variable
+		out.Variable = &v
+	}
+	if r.VariableElement != nil {
+		v := r.VariableElement.DeepCopy()
+		out.VariableElement = &v
+	}
+	if r.Condition != nil {
+		v := *r.Condition
+		out.Condition = &v
+	}
+	if r.ConditionElement != nil {
+		v := r.ConditionElement.DeepCopy()
+		out.ConditionElement = &v
+	}
+	if r.Check != nil {
+		v := *r.Check
+		out.Check = &v
+	}
+	if r.CheckElement != nil {
+		v := r.CheckElement.DeepCopy()
+		out.CheckElement = &v
+	}
+	if r.LogMessage != nil {
+		v := *r.LogMessage
+		out.LogMessage = &v
+	}
+	if r.LogMessageElement != nil {
+		v := r.LogMessageElement.DeepCopy()
+		out.LogMessageElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureMapGroupRuleSource) Equal(other StructureMapGroupRuleSource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Context != other.Context {
+		return false
+	}
+	if (r.ContextElement == nil) != (other.ContextElement == nil) || r.ContextElement != nil && !r.ContextElement.Equal(*other.ContextElement) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.Equal(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && *r.Max != *other.Max {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.Equal(*other.MaxElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.DefaultValueBase64Binary == nil) != (other.DefaultValueBase64Binary == nil) || r.DefaultValueBase64Binary != nil && *r.DefaultValueBase64Binary != *other.DefaultValueBase64Binary {
+		return false
+	}
+	if (r.DefaultValueBase64BinaryElement == nil) != (other.DefaultValueBase64BinaryElement == nil) || r.DefaultValueBase64BinaryElement != nil && !r.DefaultValueBase64BinaryElement.Equal(*other.DefaultValueBase64BinaryElement) {
+		return false
+	}
+	if (r.DefaultValueBoolean == nil) != (other.DefaultValueBoolean == nil) || r.DefaultValueBoolean != nil && *r.DefaultValueBoolean != *other.DefaultValueBoolean {
+		return false
+	}
+	if (r.DefaultValueBooleanElement == nil) != (other.DefaultValueBooleanElement == nil) || r.DefaultValueBooleanElement != nil && !r.DefaultValueBooleanElement.Equal(*other.DefaultValueBooleanElement) {
+		return false
+	}
+	if (r.DefaultValueCanonical == nil) != (other.DefaultValueCanonical == nil) || r.DefaultValueCanonical != nil && *r.DefaultValueCanonical != *other.DefaultValueCanonical {
+		return false
+	}
+	if (r.DefaultValueCanonicalElement == nil) != (other.DefaultValueCanonicalElement == nil) || r.DefaultValueCanonicalElement != nil && !r.DefaultValueCanonicalElement.Equal(*other.DefaultValueCanonicalElement) {
+		return false
+	}
+	if (r.DefaultValueCode == nil) != (other.DefaultValueCode == nil) || r.DefaultValueCode != nil && *r.DefaultValueCode != *other.DefaultValueCode {
+		return false
+	}
+	if (r.DefaultValueCodeElement == nil) != (other.DefaultValueCodeElement == nil) || r.DefaultValueCodeElement != nil && !r.DefaultValueCodeElement.Equal(*other.DefaultValueCodeElement) {
+		return false
+	}
+	if (r.DefaultValueDate == nil) != (other.DefaultValueDate == nil) || r.DefaultValueDate != nil && *r.DefaultValueDate != *other.DefaultValueDate {
+		return false
+	}
+	if (r.DefaultValueDateElement == nil) != (other.DefaultValueDateElement == nil) || r.DefaultValueDateElement != nil && !r.DefaultValueDateElement.Equal(*other.DefaultValueDateElement) {
+		return false
+	}
+	if (r.DefaultValueDateTime == nil) != (other.DefaultValueDateTime == nil) || r.DefaultValueDateTime != nil && *r.DefaultValueDateTime != *other.DefaultValueDateTime {
+		return false
+	}
+	if (r.DefaultValueDateTimeElement == nil) != (other.DefaultValueDateTimeElement == nil) || r.DefaultValueDateTimeElement != nil && !r.DefaultValueDateTimeElement.Equal(*other.DefaultValueDateTimeElement) {
+		return false
+	}
+	if (r.DefaultValueDecimal == nil) != (other.DefaultValueDecimal == nil) || r.DefaultValueDecimal != nil && !EqualDecimal(*r.DefaultValueDecimal, *other.DefaultValueDecimal) {
+		return false
+	}
+	if (r.DefaultValueDecimalElement == nil) != (other.DefaultValueDecimalElement == nil) || r.DefaultValueDecimalElement != nil && !r.DefaultValueDecimalElement.Equal(*other.DefaultValueDecimalElement) {
+		return false
+	}
+	if (r.DefaultValueId == nil) != (other.DefaultValueId == nil) || r.DefaultValueId != nil && *r.DefaultValueId != *other.DefaultValueId {
+		return false
+	}
+	if (r.DefaultValueIdElement == nil) != (other.DefaultValueIdElement == nil) || r.DefaultValueIdElement != nil && !r.DefaultValueIdElement.Equal(*other.DefaultValueIdElement) {
+		return false
+	}
+	if (r.DefaultValueInstant == nil) != (other.DefaultValueInstant == nil) || r.DefaultValueInstant != nil && *r.DefaultValueInstant != *other.DefaultValueInstant {
+		return false
+	}
+	if (r.DefaultValueInstantElement == nil) != (other.DefaultValueInstantElement == nil) || r.DefaultValueInstantElement != nil && !r.DefaultValueInstantElement.Equal(*other.DefaultValueInstantElement) {
+		return false
+	}
+	if (r.DefaultValueInteger == nil) != (other.DefaultValueInteger == nil) || r.DefaultValueInteger != nil && *r.DefaultValueInteger != *other.DefaultValueInteger {
+		return false
+	}
+	if (r.DefaultValueIntegerElement == nil) != (other.DefaultValueIntegerElement == nil) || r.DefaultValueIntegerElement != nil && !r.DefaultValueIntegerElement.Equal(*other.DefaultValueIntegerElement) {
+		return false
+	}
+	if (r.DefaultValueMarkdown == nil) != (other.DefaultValueMarkdown == nil) || r.DefaultValueMarkdown != nil && *r.DefaultValueMarkdown != *other.DefaultValueMarkdown {
+		return false
+	}
+	if (r.DefaultValueMarkdownElement == nil) != (other.DefaultValueMarkdownElement == nil) || r.DefaultValueMarkdownElement != nil && !r.DefaultValueMarkdownElement.Equal(*other.DefaultValueMarkdownElement) {
+		return false
+	}
+	if (r.DefaultValueOid == nil) != (other.DefaultValueOid == nil) || r.DefaultValueOid != nil && *r.DefaultValueOid != *other.DefaultValueOid {
+		return false
+	}
+	if (r.DefaultValueOidElement == nil) != (other.DefaultValueOidElement == nil) || r.DefaultValueOidElement != nil && !r.DefaultValueOidElement.Equal(*other.DefaultValueOidElement) {
+		return false
+	}
+	if (r.DefaultValuePositiveInt == nil) != (other.DefaultValuePositiveInt == nil) || r.DefaultValuePositiveInt != nil && *r.DefaultValuePositiveInt != *other.DefaultValuePositiveInt {
+		return false
+	}
+	if (r.DefaultValuePositiveIntElement == nil) != (other.DefaultValuePositiveIntElement == nil) || r.DefaultValuePositiveIntElement != nil && !r.DefaultValuePositiveIntElement.Equal(*other.DefaultValuePositiveIntElement) {
+		return false
+	}
+	if (r.DefaultValueString == nil) != (other.DefaultValueString == nil) || r.DefaultValueString != nil && *r.DefaultValueString != *other.DefaultValueString {
+		return false
+	}
+	if (r.DefaultValueStringElement == nil) != (other.DefaultValueStringElement == nil) || r.DefaultValueStringElement != nil && !r.DefaultValueStringElement.Equal(*other.DefaultValueStringElement) {
+		return false
+	}
+	if (r.DefaultValueTime == nil) != (other.DefaultValueTime == nil) || r.DefaultValueTime != nil && *r.DefaultValueTime != *other.DefaultValueTime {
+		return false
+	}
+	if (r.DefaultValueTimeElement == nil) != (other.DefaultValueTimeElement == nil) || r.DefaultValueTimeElement != nil && !r.DefaultValueTimeElement.Equal(*other.DefaultValueTimeElement) {
+		return false
+	}
+	if (r.DefaultValueUnsignedInt == nil) != (other.DefaultValueUnsignedInt == nil) || r.DefaultValueUnsignedInt != nil && *r.DefaultValueUnsignedInt != *other.DefaultValueUnsignedInt {
+		return false
+	}
+	if (r.DefaultValueUnsignedIntElement == nil) != (other.DefaultValueUnsignedIntElement == nil) || r.DefaultValueUnsignedIntElement != nil && !r.DefaultValueUnsignedIntElement.Equal(*other.DefaultValueUnsignedIntElement) {
+		return false
+	}
+	if (r.DefaultValueUri == nil) != (other.DefaultValueUri == nil) || r.DefaultValueUri != nil && *r.DefaultValueUri != *other.DefaultValueUri {
+		return false
+	}
+	if (r.DefaultValueUriElement == nil) != (other.DefaultValueUriElement == nil) || r.DefaultValueUriElement != nil && !r.DefaultValueUriElement.Equal(*other.DefaultValueUriElement) {
+		return false
+	}
+	if (r.DefaultValueUrl == nil) != (other.DefaultValueUrl == nil) || r.DefaultValueUrl != nil && *r.DefaultValueUrl != *other.DefaultValueUrl {
+		return false
+	}
+	if (r.DefaultValueUrlElement == nil) != (other.DefaultValueUrlElement == nil) || r.DefaultValueUrlElement != nil && !r.DefaultValueUrlElement.Equal(*other.DefaultValueUrlElement) {
+		return false
+	}
+	if (r.DefaultValueUuid == nil) != (other.DefaultValueUuid == nil) || r.DefaultValueUuid != nil && *r.DefaultValueUuid != *other.DefaultValueUuid {
+		return false
+	}
+	if (r.DefaultValueUuidElement == nil) != (other.DefaultValueUuidElement == nil) || r.DefaultValueUuidElement != nil && !r.DefaultValueUuidElement.Equal(*other.DefaultValueUuidElement) {
+		return false
+	}
+	if (r.DefaultValueAddress == nil) != (other.DefaultValueAddress == nil) || r.DefaultValueAddress != nil && !r.DefaultValueAddress.Equal(*other.DefaultValueAddress) {
+		return false
+	}
+	if (r.DefaultValueAge == nil) != (other.DefaultValueAge == nil) || r.DefaultValueAge != nil && !r.DefaultValueAge.Equal(*other.DefaultValueAge) {
+		return false
+	}
+	if (r.DefaultValueAnnotation == nil) != (other.DefaultValueAnnotation == nil) || r.DefaultValueAnnotation != nil && !r.DefaultValueAnnotation.Equal(*other.DefaultValueAnnotation) {
+		return false
+	}
+	if (r.DefaultValueAttachment == nil) != (other.DefaultValueAttachment == nil) || r.DefaultValueAttachment != nil && !r.DefaultValueAttachment.Equal(*other.DefaultValueAttachment) {
+		return false
+	}
+	if (r.DefaultValueCodeableConcept == nil) != (other.DefaultValueCodeableConcept == nil) || r.DefaultValueCodeableConcept != nil && !r.DefaultValueCodeableConcept.Equal(*other.DefaultValueCodeableConcept) {
+		return false
+	}
+	if (r.DefaultValueCoding == nil) != (other.DefaultValueCoding == nil) || r.DefaultValueCoding != nil && !r.DefaultValueCoding.Equal(*other.DefaultValueCoding) {
+		return false
+	}
+	if (r.DefaultValueContactPoint == nil) != (other.DefaultValueContactPoint == nil) || r.DefaultValueContactPoint != nil && !r.DefaultValueContactPoint.Equal(*other.DefaultValueContactPoint) {
+		return false
+	}
+	if (r.DefaultValueCount == nil) != (other.DefaultValueCount == nil) || r.DefaultValueCount != nil && !r.DefaultValueCount.Equal(*other.DefaultValueCount) {
+		return false
+	}
+	if (r.DefaultValueDistance == nil) != (other.DefaultValueDistance == nil) || r.DefaultValueDistance != nil && !r.DefaultValueDistance.Equal(*other.DefaultValueDistance) {
+		return false
+	}
+	if (r.DefaultValueDuration == nil) != (other.DefaultValueDuration == nil) || r.DefaultValueDuration != nil && !r.DefaultValueDuration.Equal(*other.DefaultValueDuration) {
+		return false
+	}
+	if (r.DefaultValueHumanName == nil) != (other.DefaultValueHumanName == nil) || r.DefaultValueHumanName != nil && !r.DefaultValueHumanName.Equal(*other.DefaultValueHumanName) {
+		return false
+	}
+	if (r.DefaultValueIdentifier == nil) != (other.DefaultValueIdentifier == nil) || r.DefaultValueIdentifier != nil && !r.DefaultValueIdentifier.Equal(*other.DefaultValueIdentifier) {
+		return false
+	}
+	if (r.DefaultValueMoney == nil) != (other.DefaultValueMoney == nil) || r.DefaultValueMoney != nil && !r.DefaultValueMoney.Equal(*other.DefaultValueMoney) {
+		return false
+	}
+	if (r.DefaultValuePeriod == nil) != (other.DefaultValuePeriod == nil) || r.DefaultValuePeriod != nil && !r.DefaultValuePeriod.Equal(*other.DefaultValuePeriod) {
+		return false
+	}
+	if (r.DefaultValueQuantity == nil) != (other.DefaultValueQuantity == nil) || r.DefaultValueQuantity != nil && !r.DefaultValueQuantity.Equal(*other.DefaultValueQuantity) {
+		return false
+	}
+	if (r.DefaultValueRange == nil) != (other.DefaultValueRange == nil) || r.DefaultValueRange != nil && !r.DefaultValueRange.Equal(*other.DefaultValueRange) {
+		return false
+	}
+	if (r.DefaultValueRatio == nil) != (other.DefaultValueRatio == nil) || r.DefaultValueRatio != nil && !r.DefaultValueRatio.Equal(*other.DefaultValueRatio) {
+		return false
+	}
+	if (r.DefaultValueReference == nil) != (other.DefaultValueReference == nil) || r.DefaultValueReference != nil && !r.DefaultValueReference.Equal(*other.DefaultValueReference) {
+		return false
+	}
+	if (r.DefaultValueSampledData == nil) != (other.DefaultValueSampledData == nil) || r.DefaultValueSampledData != nil && !r.DefaultValueSampledData.Equal(*other.DefaultValueSampledData) {
+		return false
+	}
+	if (r.DefaultValueSignature == nil) != (other.DefaultValueSignature == nil) || r.DefaultValueSignature != nil && !r.DefaultValueSignature.Equal(*other.DefaultValueSignature) {
+		return false
+	}
+	if (r.DefaultValueTiming == nil) != (other.DefaultValueTiming == nil) || r.DefaultValueTiming != nil && !r.DefaultValueTiming.Equal(*other.DefaultValueTiming) {
+		return false
+	}
+	if (r.DefaultValueContactDetail == nil) != (other.DefaultValueContactDetail == nil) || r.DefaultValueContactDetail != nil && !r.DefaultValueContactDetail.Equal(*other.DefaultValueContactDetail) {
+		return false
+	}
+	if (r.DefaultValueContributor == nil) != (other.DefaultValueContributor == nil) || r.DefaultValueContributor != nil && !r.DefaultValueContributor.Equal(*other.DefaultValueContributor) {
+		return false
+	}
+	if (r.DefaultValueDataRequirement == nil) != (other.DefaultValueDataRequirement == nil) || r.DefaultValueDataRequirement != nil && !r.DefaultValueDataRequirement.Equal(*other.DefaultValueDataRequirement) {
+		return false
+	}
+	if (r.DefaultValueExpression == nil) != (other.DefaultValueExpression == nil) || r.DefaultValueExpression != nil && !r.DefaultValueExpression.Equal(*other.DefaultValueExpression) {
+		return false
+	}
+	if (r.DefaultValueParameterDefinition == nil) != (other.DefaultValueParameterDefinition == nil) || r.DefaultValueParameterDefinition != nil && !r.DefaultValueParameterDefinition.Equal(*other.DefaultValueParameterDefinition) {
+		return false
+	}
+	if (r.DefaultValueRelatedArtifact == nil) != (other.DefaultValueRelatedArtifact == nil) || r.DefaultValueRelatedArtifact != nil && !r.DefaultValueRelatedArtifact.Equal(*other.DefaultValueRelatedArtifact) {
+		return false
+	}
+	if (r.DefaultValueTriggerDefinition == nil) != (other.DefaultValueTriggerDefinition == nil) || r.DefaultValueTriggerDefinition != nil && !r.DefaultValueTriggerDefinition.Equal(*other.DefaultValueTriggerDefinition) {
+		return false
+	}
+	if (r.DefaultValueUsageContext == nil) != (other.DefaultValueUsageContext == nil) || r.DefaultValueUsageContext != nil && !r.DefaultValueUsageContext.Equal(*other.DefaultValueUsageContext) {
+		return false
+	}
+	if (r.DefaultValueDosage == nil) != (other.DefaultValueDosage == nil) || r.DefaultValueDosage != nil && !r.DefaultValueDosage.Equal(*other.DefaultValueDosage) {
+		return false
+	}
+	if (r.DefaultValueMeta == nil) != (other.DefaultValueMeta == nil) || r.DefaultValueMeta != nil && !r.DefaultValueMeta.Equal(*other.DefaultValueMeta) {
+		return false
+	}
+	if (r.Element == nil) != (other.Element == nil) || r.Element != nil && *r.Element != *other.Element {
+		return false
+	}
+	if (r.ElementElement == nil) != (other.ElementElement == nil) || r.ElementElement != nil && !r.ElementElement.Equal(*other.ElementElement) {
+		return false
+	}
+	if (r.ListMode == nil) != (other.ListMode == nil) || r.ListMode != nil && *r.ListMode != *other.ListMode {
+		return false
+	}
+	if (r.ListModeElement == nil) != (other.ListModeElement == nil) || r.ListModeElement != nil && !r.ListModeElement.Equal(*other.ListModeElement) {
+		return false
+	}
+	if (r.Variable == nil) != (other.Variable == nil) || r.Variable != nil && *r.Variable != *other.Variable {
+		return false
+	}
+	if (r.VariableElement == nil) != (other.VariableElement == nil) || r.VariableElement != nil && !r.VariableElement.Equal(*other.VariableElement) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && *r.Condition != *other.Condition {
+		return false
+	}
+	if (r.ConditionElement == nil) != (other.ConditionElement == nil) || r.ConditionElement != nil && !r.ConditionElement.Equal(*other.ConditionElement) {
+		return false
+	}
+	if (r.Check == nil) != (other.Check == nil) || r.Check != nil && *r.Check != *other.Check {
+		return false
+	}
+	if (r.CheckElement == nil) != (other.CheckElement == nil) || r.CheckElement != nil && !r.CheckElement.Equal(*other.CheckElement) {
+		return false
+	}
+	if (r.LogMessage == nil) != (other.LogMessage == nil) || r.LogMessage != nil && *r.LogMessage != *other.LogMessage {
+		return false
+	}
+	if (r.LogMessageElement == nil) != (other.LogMessageElement == nil) || r.LogMessageElement != nil && !r.LogMessageElement.Equal(*other.LogMessageElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureMapGroupRuleSource) EqualsDeep(other StructureMapGroupRuleSource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Context, other.Context) {
+		return false
+	}
+	if (r.ContextElement == nil) != (other.ContextElement == nil) || r.ContextElement != nil && !r.ContextElement.EqualsDeep(*other.ContextElement) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.MinElement == nil) != (other.MinElement == nil) || r.MinElement != nil && !r.MinElement.EqualsDeep(*other.MinElement) {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	if (r.MaxElement == nil) != (other.MaxElement == nil) || r.MaxElement != nil && !r.MaxElement.EqualsDeep(*other.MaxElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.DefaultValueBase64Binary == nil) != (other.DefaultValueBase64Binary == nil) || r.DefaultValueBase64Binary != nil && !equivalentString(*r.DefaultValueBase64Binary, *other.DefaultValueBase64Binary) {
+		return false
+	}
+	if (r.DefaultValueBase64BinaryElement == nil) != (other.DefaultValueBase64BinaryElement == nil) || r.DefaultValueBase64BinaryElement != nil && !r.DefaultValueBase64BinaryElement.EqualsDeep(*other.DefaultValueBase64BinaryElement) {
+		return false
+	}
+	if (r.DefaultValueBoolean == nil) != (other.DefaultValueBoolean == nil) || r.DefaultValueBoolean != nil && *r.DefaultValueBoolean != *other.DefaultValueBoolean {
+		return false
+	}
+	if (r.DefaultValueBooleanElement == nil) != (other.DefaultValueBooleanElement == nil) || r.DefaultValueBooleanElement != nil && !r.DefaultValueBooleanElement.EqualsDeep(*other.DefaultValueBooleanElement) {
+		return false
+	}
+	if (r.DefaultValueCanonical == nil) != (other.DefaultValueCanonical == nil) || r.DefaultValueCanonical != nil && !equivalentString(*r.DefaultValueCanonical, *other.DefaultValueCanonical) {
+		return false
+	}
+	if (r.DefaultValueCanonicalElement == nil) != (other.DefaultValueCanonicalElement == nil) || r.DefaultValueCanonicalElement != nil && !r.DefaultValueCanonicalElement.EqualsDeep(*other.DefaultValueCanonicalElement) {
+		return false
+	}
+	if (r.DefaultValueCode == nil) != (other.DefaultValueCode == nil) || r.DefaultValueCode != nil && !equivalentString(*r.DefaultValueCode, *other.DefaultValueCode) {
+		return false
+	}
+	if (r.DefaultValueCodeElement == nil) != (other.DefaultValueCodeElement == nil) || r.DefaultValueCodeElement != nil && !r.DefaultValueCodeElement.EqualsDeep(*other.DefaultValueCodeElement) {
+		return false
+	}
+	if (r.DefaultValueDate == nil) != (other.DefaultValueDate == nil) || r.DefaultValueDate != nil && !equivalentString(*r.DefaultValueDate, *other.DefaultValueDate) {
+		return false
+	}
+	if (r.DefaultValueDateElement == nil) != (other.DefaultValueDateElement == nil) || r.DefaultValueDateElement != nil && !r.DefaultValueDateElement.EqualsDeep(*other.DefaultValueDateElement) {
+		return false
+	}
+	if (r.DefaultValueDateTime == nil) != (other.DefaultValueDateTime == nil) || r.DefaultValueDateTime != nil && !equivalentString(*r.DefaultValueDateTime, *other.DefaultValueDateTime) {
+		return false
+	}
+	if (r.DefaultValueDateTimeElement == nil) != (other.DefaultValueDateTimeElement == nil) || r.DefaultValueDateTimeElement != nil && !r.DefaultValueDateTimeElement.EqualsDeep(*other.DefaultValueDateTimeElement) {
+		return false
+	}
+	if (r.DefaultValueDecimal == nil) != (other.DefaultValueDecimal == nil) || r.DefaultValueDecimal != nil && !equivalentDecimal(*r.DefaultValueDecimal, *other.DefaultValueDecimal) {
+		return false
+	}
+	if (r.DefaultValueDecimalElement == nil) != (other.DefaultValueDecimalElement == nil) || r.DefaultValueDecimalElement != nil && !r.DefaultValueDecimalElement.EqualsDeep(*other.DefaultValueDecimalElement) {
+		return false
+	}
+	if (r.DefaultValueId == nil) != (other.DefaultValueId == nil) || r.DefaultValueId != nil && !equivalentString(*r.DefaultValueId, *other.DefaultValueId) {
+		return false
+	}
+	if (r.DefaultValueIdElement == nil) != (other.DefaultValueIdElement == nil) || r.DefaultValueIdElement != nil && !r.DefaultValueIdElement.EqualsDeep(*other.DefaultValueIdElement) {
+		return false
+	}
+	if (r.DefaultValueInstant == nil) != (other.DefaultValueInstant == nil) || r.DefaultValueInstant != nil && !equivalentString(*r.DefaultValueInstant, *other.DefaultValueInstant) {
+		return false
+	}
+	if (r.DefaultValueInstantElement == nil) != (other.DefaultValueInstantElement == nil) || r.DefaultValueInstantElement != nil && !r.DefaultValueInstantElement.EqualsDeep(*other.DefaultValueInstantElement) {
+		return false
+	}
+	if (r.DefaultValueInteger == nil) != (other.DefaultValueInteger == nil) || r.DefaultValueInteger != nil && *r.DefaultValueInteger != *other.DefaultValueInteger {
+		return false
+	}
+	if (r.DefaultValueIntegerElement == nil) != (other.DefaultValueIntegerElement == nil) || r.DefaultValueIntegerElement != nil && !r.DefaultValueIntegerElement.EqualsDeep(*other.DefaultValueIntegerElement) {
+		return false
+	}
+	if (r.DefaultValueMarkdown == nil) != (other.DefaultValueMarkdown == nil) || r.DefaultValueMarkdown != nil && !equivalentString(*r.DefaultValueMarkdown, *other.DefaultValueMarkdown) {
+		return false
+	}
+	if (r.DefaultValueMarkdownElement == nil) != (other.DefaultValueMarkdownElement == nil) || r.DefaultValueMarkdownElement != nil && !r.DefaultValueMarkdownElement.EqualsDeep(*other.DefaultValueMarkdownElement) {
+		return false
+	}
+	if (r.DefaultValueOid == nil) != (other.DefaultValueOid == nil) || r.DefaultValueOid != nil && !equivalentString(*r.DefaultValueOid, *other.DefaultValueOid) {
+		return false
+	}
+	if (r.DefaultValueOidElement == nil) != (other.DefaultValueOidElement == nil) || r.DefaultValueOidElement != nil && !r.DefaultValueOidElement.EqualsDeep(*other.DefaultValueOidElement) {
+		return false
+	}
+	if (r.DefaultValuePositiveInt == nil) != (other.DefaultValuePositiveInt == nil) || r.DefaultValuePositiveInt != nil && *r.DefaultValuePositiveInt != *other.DefaultValuePositiveInt {
+		return false
+	}
+	if (r.DefaultValuePositiveIntElement == nil) != (other.DefaultValuePositiveIntElement == nil) || r.DefaultValuePositiveIntElement != nil && !r.DefaultValuePositiveIntElement.EqualsDeep(*other.DefaultValuePositiveIntElement) {
+		return false
+	}
+	if (r.DefaultValueString == nil) != (other.DefaultValueString == nil) || r.DefaultValueString != nil && !equivalentString(*r.DefaultValueString, *other.DefaultValueString) {
+		return false
+	}
+	if (r.DefaultValueStringElement == nil) != (other.DefaultValueStringElement == nil) || r.DefaultValueStringElement != nil && !r.DefaultValueStringElement.EqualsDeep(*other.DefaultValueStringElement) {
+		return false
+	}
+	if (r.DefaultValueTime == nil) != (other.DefaultValueTime == nil) || r.DefaultValueTime != nil && !equivalentString(*r.DefaultValueTime, *other.DefaultValueTime) {
+		return false
+	}
+	if (r.DefaultValueTimeElement == nil) != (other.DefaultValueTimeElement == nil) || r.DefaultValueTimeElement != nil && !r.DefaultValueTimeElement.EqualsDeep(*other.DefaultValueTimeElement) {
+		return false
+	}
+	if (r.DefaultValueUnsignedInt == nil) != (other.DefaultValueUnsignedInt == nil) || r.DefaultValueUnsignedInt != nil && *r.DefaultValueUnsignedInt != *other.DefaultValueUnsignedInt {
+		return false
+	}
+	if (r.DefaultValueUnsignedIntElement == nil) != (other.DefaultValueUnsignedIntElement == nil) || r.DefaultValueUnsignedIntElement != nil && !r.DefaultValueUnsignedIntElement.EqualsDeep(*other.DefaultValueUnsignedIntElement) {
+		return false
+	}
+	if (r.DefaultValueUri == nil) != (other.DefaultValueUri == nil) || r.DefaultValueUri != nil && !equivalentString(*r.DefaultValueUri, *other.DefaultValueUri) {
+		return false
+	}
+	if (r.DefaultValueUriElement == nil) != (other.DefaultValueUriElement == nil) || r.DefaultValueUriElement != nil && !r.DefaultValueUriElement.EqualsDeep(*other.DefaultValueUriElement) {
+		return false
+	}
+	if (r.DefaultValueUrl == nil) != (other.DefaultValueUrl == nil) || r.DefaultValueUrl != nil && !equivalentString(*r.DefaultValueUrl, *other.DefaultValueUrl) {
+		return false
+	}
+	if (r.DefaultValueUrlElement == nil) != (other.DefaultValueUrlElement == nil) || r.DefaultValueUrlElement != nil && !r.DefaultValueUrlElement.EqualsDeep(*other.DefaultValueUrlElement) {
+		return false
+	}
+	if (r.DefaultValueUuid == nil) != (other.DefaultValueUuid == nil) || r.DefaultValueUuid != nil && !equivalentString(*r.DefaultValueUuid, *other.DefaultValueUuid) {
+		return false
+	}
+	if (r.DefaultValueUuidElement == nil) != (other.DefaultValueUuidElement == nil) || r.DefaultValueUuidElement != nil && !r.DefaultValueUuidElement.EqualsDeep(*other.DefaultValueUuidElement) {
+		return false
+	}
+	if (r.DefaultValueAddress == nil) != (other.DefaultValueAddress == nil) || r.DefaultValueAddress != nil && !r.DefaultValueAddress.EqualsDeep(*other.DefaultValueAddress) {
+		return false
+	}
+	if (r.DefaultValueAge == nil) != (other.DefaultValueAge == nil) || r.DefaultValueAge != nil && !r.DefaultValueAge.EqualsDeep(*other.DefaultValueAge) {
+		return false
+	}
+	if (r.DefaultValueAnnotation == nil) != (other.DefaultValueAnnotation == nil) || r.DefaultValueAnnotation != nil && !r.DefaultValueAnnotation.EqualsDeep(*other.DefaultValueAnnotation) {
+		return false
+	}
+	if (r.DefaultValueAttachment == nil) != (other.DefaultValueAttachment == nil) || r.DefaultValueAttachment != nil && !r.DefaultValueAttachment.EqualsDeep(*other.DefaultValueAttachment) {
+		return false
+	}
+	if (r.DefaultValueCodeableConcept == nil) != (other.DefaultValueCodeableConcept == nil) || r.DefaultValueCodeableConcept != nil && !r.DefaultValueCodeableConcept.EqualsDeep(*other.DefaultValueCodeableConcept) {
+		return false
+	}
+	if (r.DefaultValueCoding == nil) != (other.DefaultValueCoding == nil) || r.DefaultValueCoding != nil && !r.DefaultValueCoding.EqualsDeep(*other.DefaultValueCoding) {
+		return false
+	}
+	if (r.DefaultValueContactPoint == nil) != (other.DefaultValueContactPoint == nil) || r.DefaultValueContactPoint != nil && !r.DefaultValueContactPoint.EqualsDeep(*other.DefaultValueContactPoint) {
+		return false
+	}
+	if (r.DefaultValueCount == nil) != (other.DefaultValueCount == nil) || r.DefaultValueCount != nil && !r.DefaultValueCount.EqualsDeep(*other.DefaultValueCount) {
+		return false
+	}
+	if (r.DefaultValueDistance == nil) != (other.DefaultValueDistance == nil) || r.DefaultValueDistance != nil && !r.DefaultValueDistance.EqualsDeep(*other.DefaultValueDistance) {
+		return false
+	}
+	if (r.DefaultValueDuration == nil) != (other.DefaultValueDuration == nil) || r.DefaultValueDuration != nil && !r.DefaultValueDuration.EqualsDeep(*other.DefaultValueDuration) {
+		return false
+	}
+	if (r.DefaultValueHumanName == nil) != (other.DefaultValueHumanName == nil) || r.DefaultValueHumanName != nil && !r.DefaultValueHumanName.EqualsDeep(*other.DefaultValueHumanName) {
+		return false
+	}
+	if (r.DefaultValueIdentifier == nil) != (other.DefaultValueIdentifier == nil) || r.DefaultValueIdentifier != nil && !r.DefaultValueIdentifier.EqualsDeep(*other.DefaultValueIdentifier) {
+		return false
+	}
+	if (r.DefaultValueMoney == nil) != (other.DefaultValueMoney == nil) || r.DefaultValueMoney != nil && !r.DefaultValueMoney.EqualsDeep(*other.DefaultValueMoney) {
+		return false
+	}
+	if (r.DefaultValuePeriod == nil) != (other.DefaultValuePeriod == nil) || r.DefaultValuePeriod != nil && !r.DefaultValuePeriod.EqualsDeep(*other.DefaultValuePeriod) {
+		return false
+	}
+	if (r.DefaultValueQuantity == nil) != (other.DefaultValueQuantity == nil) || r.DefaultValueQuantity != nil && !r.DefaultValueQuantity.EqualsDeep(*other.DefaultValueQuantity) {
+		return false
+	}
+	if (r.DefaultValueRange == nil) != (other.DefaultValueRange == nil) || r.DefaultValueRange != nil && !r.DefaultValueRange.EqualsDeep(*other.DefaultValueRange) {
+		return false
+	}
+	if (r.DefaultValueRatio == nil) != (other.DefaultValueRatio == nil) || r.DefaultValueRatio != nil && !r.DefaultValueRatio.EqualsDeep(*other.DefaultValueRatio) {
+		return false
+	}
+	if (r.DefaultValueReference == nil) != (other.DefaultValueReference == nil) || r.DefaultValueReference != nil && !r.DefaultValueReference.EqualsDeep(*other.DefaultValueReference) {
+		return false
+	}
+	if (r.DefaultValueSampledData == nil) != (other.DefaultValueSampledData == nil) || r.DefaultValueSampledData != nil && !r.DefaultValueSampledData.EqualsDeep(*other.DefaultValueSampledData) {
+		return false
+	}
+	if (r.DefaultValueSignature == nil) != (other.DefaultValueSignature == nil) || r.DefaultValueSignature != nil && !r.DefaultValueSignature.EqualsDeep(*other.DefaultValueSignature) {
+		return false
+	}
+	if (r.DefaultValueTiming == nil) != (other.DefaultValueTiming == nil) || r.DefaultValueTiming != nil && !r.DefaultValueTiming.EqualsDeep(*other.DefaultValueTiming) {
+		return false
+	}
+	if (r.DefaultValueContactDetail == nil) != (other.DefaultValueContactDetail == nil) || r.DefaultValueContactDetail != nil && !r.DefaultValueContactDetail.EqualsDeep(*other.DefaultValueContactDetail) {
+		return false
+	}
+	if (r.DefaultValueContributor == nil) != (other.DefaultValueContributor == nil) || r.DefaultValueContributor != nil && !r.DefaultValueContributor.EqualsDeep(*other.DefaultValueContributor) {
+		return false
+	}
+	if (r.DefaultValueDataRequirement == nil) != (other.DefaultValueDataRequirement == nil) || r.DefaultValueDataRequirement != nil && !r.DefaultValueDataRequirement.EqualsDeep(*other.DefaultValueDataRequirement) {
+		return false
+	}
+	if (r.DefaultValueExpression == nil) != (other.DefaultValueExpression == nil) || r.DefaultValueExpression != nil && !r.DefaultValueExpression.EqualsDeep(*other.DefaultValueExpression) {
+		return false
+	}
+	if (r.DefaultValueParameterDefinition == nil) != (other.DefaultValueParameterDefinition == nil) || r.DefaultValueParameterDefinition != nil && !r.DefaultValueParameterDefinition.EqualsDeep(*other.DefaultValueParameterDefinition) {
+		return false
+	}
+	if (r.DefaultValueRelatedArtifact == nil) != (other.DefaultValueRelatedArtifact == nil) || r.DefaultValueRelatedArtifact != nil && !r.DefaultValueRelatedArtifact.EqualsDeep(*other.DefaultValueRelatedArtifact) {
+		return false
+	}
+	if (r.DefaultValueTriggerDefinition == nil) != (other.DefaultValueTriggerDefinition == nil) || r.DefaultValueTriggerDefinition != nil && !r.DefaultValueTriggerDefinition.EqualsDeep(*other.DefaultValueTriggerDefinition) {
+		return false
+	}
+	if (r.DefaultValueUsageContext == nil) != (other.DefaultValueUsageContext == nil) || r.DefaultValueUsageContext != nil && !r.DefaultValueUsageContext.EqualsDeep(*other.DefaultValueUsageContext) {
+		return false
+	}
+	if (r.DefaultValueDosage == nil) != (other.DefaultValueDosage == nil) || r.DefaultValueDosage != nil && !r.DefaultValueDosage.EqualsDeep(*other.DefaultValueDosage) {
+		return false
+	}
+	if (r.DefaultValueMeta == nil) != (other.DefaultValueMeta == nil) || r.DefaultValueMeta != nil && !r.DefaultValueMeta.EqualsDeep(*other.DefaultValueMeta) {
+		return false
+	}
+	if (r.Element == nil) != (other.Element == nil) || r.Element != nil && !equivalentString(*r.Element, *other.Element) {
+		return false
+	}
+	if (r.ElementElement == nil) != (other.ElementElement == nil) || r.ElementElement != nil && !r.ElementElement.EqualsDeep(*other.ElementElement) {
+		return false
+	}
+	if (r.ListMode == nil) != (other.ListMode == nil) || r.ListMode != nil && *r.ListMode != *other.ListMode {
+		return false
+	}
+	if (r.ListModeElement == nil) != (other.ListModeElement == nil) || r.ListModeElement != nil && !r.ListModeElement.EqualsDeep(*other.ListModeElement) {
+		return false
+	}
+	if (r.Variable == nil) != (other.Variable == nil) || r.Variable != nil && !equivalentString(*r.Variable, *other.Variable) {
+		return false
+	}
+	if (r.VariableElement == nil) != (other.VariableElement == nil) || r.VariableElement != nil && !r.VariableElement.EqualsDeep(*other.VariableElement) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !equivalentString(*r.Condition, *other.Condition) {
+		return false
+	}
+	if (r.ConditionElement == nil) != (other.ConditionElement == nil) || r.ConditionElement != nil && !r.ConditionElement.EqualsDeep(*other.ConditionElement) {
+		return false
+	}
+	if (r.Check == nil) != (other.Check == nil) || r.Check != nil && !equivalentString(*r.Check, *other.Check) {
+		return false
+	}
+	if (r.CheckElement == nil) != (other.CheckElement == nil) || r.CheckElement != nil && !r.CheckElement.EqualsDeep(*other.CheckElement) {
+		return false
+	}
+	if (r.LogMessage == nil) != (other.LogMessage == nil) || r.LogMessage != nil && !equivalentString(*r.LogMessage, *other.LogMessage) {
+		return false
+	}
+	if (r.LogMessageElement == nil) != (other.LogMessageElement == nil) || r.LogMessageElement != nil && !r.LogMessageElement.EqualsDeep(*other.LogMessageElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureMapGroupRuleSource) EqualsShallow(other StructureMapGroupRuleSource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Context, other.Context) {
+		return false
+	}
+	if (r.Min == nil) != (other.Min == nil) || r.Min != nil && *r.Min != *other.Min {
+		return false
+	}
+	if (r.Max == nil) != (other.Max == nil) || r.Max != nil && !equivalentString(*r.Max, *other.Max) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !equivalentString(*r.Type, *other.Type) {
+		return false
+	}
+	if (r.DefaultValueBase64Binary == nil) != (other.DefaultValueBase64Binary == nil) || r.DefaultValueBase64Binary != nil && !equivalentString(*r.DefaultValueBase64Binary, *other.DefaultValueBase64Binary) {
+		return false
+	}
+	if (r.DefaultValueBoolean == nil) != (other.DefaultValueBoolean == nil) || r.DefaultValueBoolean != nil && *r.DefaultValueBoolean != *other.DefaultValueBoolean {
+		return false
+	}
+	if (r.DefaultValueCanonical == nil) != (other.DefaultValueCanonical == nil) || r.DefaultValueCanonical != nil && !equivalentString(*r.DefaultValueCanonical, *other.DefaultValueCanonical) {
+		return false
+	}
+	if (r.DefaultValueCode == nil) != (other.DefaultValueCode == nil) || r.DefaultValueCode != nil && !equivalentString(*r.DefaultValueCode, *other.DefaultValueCode) {
+		return false
+	}
+	if (r.DefaultValueDate == nil) != (other.DefaultValueDate == nil) || r.DefaultValueDate != nil && !equivalentString(*r.DefaultValueDate, *other.DefaultValueDate) {
+		return false
+	}
+	if (r.DefaultValueDateTime == nil) != (other.DefaultValueDateTime == nil) || r.DefaultValueDateTime != nil && !equivalentString(*r.DefaultValueDateTime, *other.DefaultValueDateTime) {
+		return false
+	}
+	if (r.DefaultValueDecimal == nil) != (other.DefaultValueDecimal == nil) || r.DefaultValueDecimal != nil && !equivalentDecimal(*r.DefaultValueDecimal, *other.DefaultValueDecimal) {
+		return false
+	}
+	if (r.DefaultValueId == nil) != (other.DefaultValueId == nil) || r.DefaultValueId != nil && !equivalentString(*r.DefaultValueId, *other.DefaultValueId) {
+		return false
+	}
+	if (r.DefaultValueInstant == nil) != (other.DefaultValueInstant == nil) || r.DefaultValueInstant != nil && !equivalentString(*r.DefaultValueInstant, *other.DefaultValueInstant) {
+		return false
+	}
+	if (r.DefaultValueInteger == nil) != (other.DefaultValueInteger == nil) || r.DefaultValueInteger != nil && *r.DefaultValueInteger != *other.DefaultValueInteger {
+		return false
+	}
+	if (r.DefaultValueMarkdown == nil) != (other.DefaultValueMarkdown == nil) || r.DefaultValueMarkdown != nil && !equivalentString(*r.DefaultValueMarkdown, *other.DefaultValueMarkdown) {
+		return false
+	}
+	if (r.DefaultValueOid == nil) != (other.DefaultValueOid == nil) || r.DefaultValueOid != nil && !equivalentString(*r.DefaultValueOid, *other.DefaultValueOid) {
+		return false
+	}
+	if (r.DefaultValuePositiveInt == nil) != (other.DefaultValuePositiveInt == nil) || r.DefaultValuePositiveInt != nil && *r.DefaultValuePositiveInt != *other.DefaultValuePositiveInt {
+		return false
+	}
+	if (r.DefaultValueString == nil) != (other.DefaultValueString == nil) || r.DefaultValueString != nil && !equivalentString(*r.DefaultValueString, *other.DefaultValueString) {
+		return false
+	}
+	if (r.DefaultValueTime == nil) != (other.DefaultValueTime == nil) || r.DefaultValueTime != nil && !equivalentString(*r.DefaultValueTime, *other.DefaultValueTime) {
+		return false
+	}
+	if (r.DefaultValueUnsignedInt == nil) != (other.DefaultValueUnsignedInt == nil) || r.DefaultValueUnsignedInt != nil && *r.DefaultValueUnsignedInt != *other.DefaultValueUnsignedInt {
+		return false
+	}
+	if (r.DefaultValueUri == nil) != (other.DefaultValueUri == nil) || r.DefaultValueUri != nil && !equivalentString(*r.DefaultValueUri, *other.DefaultValueUri) {
+		return false
+	}
+	if (r.DefaultValueUrl == nil) != (other.DefaultValueUrl == nil) || r.DefaultValueUrl != nil && !equivalentString(*r.DefaultValueUrl, *other.DefaultValueUrl) {
+		return false
+	}
+	if (r.DefaultValueUuid == nil) != (other.DefaultValueUuid == nil) || r.DefaultValueUuid != nil && !equivalentString(*r.DefaultValueUuid, *other.DefaultValueUuid) {
+		return false
+	}
+	if (r.Element == nil) != (other.Element == nil) || r.Element != nil && !equivalentString(*r.Element, *other.Element) {
+		return false
+	}
+	if (r.ListMode == nil) != (other.ListMode == nil) || r.ListMode != nil && *r.ListMode != *other.ListMode {
+		return false
+	}
+	if (r.Variable == nil) != (other.Variable == nil) || r.Variable != nil && !equivalentString(*r.Variable, *other.Variable) {
+		return false
+	}
+	if (r.Condition == nil) != (other.Condition == nil) || r.Condition != nil && !equivalentString(*r.Condition, *other.Condition) {
+		return false
+	}
+	if (r.Check == nil) != (other.Check == nil) || r.Check != nil && !equivalentString(*r.Check, *other.Check) {
+		return false
+	}
+	if (r.LogMessage == nil) != (other.LogMessage == nil) || r.LogMessage != nil && !equivalentString(*r.LogMessage, *other.LogMessage) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureMapGroupRuleSource as FHIR XML
+func (r StructureMapGroupRuleSource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "context", r.Context, r.ContextElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "min", r.Min, r.MinElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "max", r.Max, r.MaxElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueBase64Binary", r.DefaultValueBase64Binary, r.DefaultValueBase64BinaryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueBoolean", r.DefaultValueBoolean, r.DefaultValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueCanonical", r.DefaultValueCanonical, r.DefaultValueCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueCode", r.DefaultValueCode, r.DefaultValueCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueDate", r.DefaultValueDate, r.DefaultValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueDateTime", r.DefaultValueDateTime, r.DefaultValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueDecimal", r.DefaultValueDecimal, r.DefaultValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueId", r.DefaultValueId, r.DefaultValueIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueInstant", r.DefaultValueInstant, r.DefaultValueInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueInteger", r.DefaultValueInteger, r.DefaultValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueMarkdown", r.DefaultValueMarkdown, r.DefaultValueMarkdownElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueOid", r.DefaultValueOid, r.DefaultValueOidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValuePositiveInt", r.DefaultValuePositiveInt, r.DefaultValuePositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueString", r.DefaultValueString, r.DefaultValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueTime", r.DefaultValueTime, r.DefaultValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueUnsignedInt", r.DefaultValueUnsignedInt, r.DefaultValueUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueUri", r.DefaultValueUri, r.DefaultValueUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueUrl", r.DefaultValueUrl, r.DefaultValueUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValueUuid", r.DefaultValueUuid, r.DefaultValueUuidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueAddress", r.DefaultValueAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueAge", r.DefaultValueAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueAnnotation", r.DefaultValueAnnotation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueAttachment", r.DefaultValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueCodeableConcept", r.DefaultValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueCoding", r.DefaultValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueContactPoint", r.DefaultValueContactPoint); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueCount", r.DefaultValueCount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueDistance", r.DefaultValueDistance); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueDuration", r.DefaultValueDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueHumanName", r.DefaultValueHumanName); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueIdentifier", r.DefaultValueIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueMoney", r.DefaultValueMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValuePeriod", r.DefaultValuePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueQuantity", r.DefaultValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueRange", r.DefaultValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueRatio", r.DefaultValueRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueReference", r.DefaultValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueSampledData", r.DefaultValueSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueSignature", r.DefaultValueSignature); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueTiming", r.DefaultValueTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueContactDetail", r.DefaultValueContactDetail); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueContributor", r.DefaultValueContributor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueDataRequirement", r.DefaultValueDataRequirement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueExpression", r.DefaultValueExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueParameterDefinition", r.DefaultValueParameterDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueRelatedArtifact", r.DefaultValueRelatedArtifact); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueTriggerDefinition", r.DefaultValueTriggerDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueUsageContext", r.DefaultValueUsageContext); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueDosage", r.DefaultValueDosage); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "defaultValueMeta", r.DefaultValueMeta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "element", r.Element, r.ElementElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "listMode", r.ListMode, r.ListModeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "variable", r.Variable, r.VariableElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "condition", r.Condition, r.ConditionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "check", r.Check, r.CheckElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "logMessage", r.LogMessage, r.LogMessageElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureMapGroupRuleSource from FHIR XML
+func (r *StructureMapGroupRuleSource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "context":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Context = v
+				}
+				r.ContextElement = element
+			case "min":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Min = &v
+				}
+				r.MinElement = element
+			case "max":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Max = &v
+				}
+				r.MaxElement = element
+			case "type":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = &v
+				}
+				r.TypeElement = element
+			case "defaultValueBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueBase64Binary = &v
+				}
+				r.DefaultValueBase64BinaryElement = element
+			case "defaultValueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueBoolean = &v
+				}
+				r.DefaultValueBooleanElement = element
+			case "defaultValueCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueCanonical = &v
+				}
+				r.DefaultValueCanonicalElement = element
+			case "defaultValueCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueCode = &v
+				}
+				r.DefaultValueCodeElement = element
+			case "defaultValueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueDate = &v
+				}
+				r.DefaultValueDateElement = element
+			case "defaultValueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueDateTime = &v
+				}
+				r.DefaultValueDateTimeElement = element
+			case "defaultValueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueDecimal = &v
+				}
+				r.DefaultValueDecimalElement = element
+			case "defaultValueId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueId = &v
+				}
+				r.DefaultValueIdElement = element
+			case "defaultValueInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueInstant = &v
+				}
+				r.DefaultValueInstantElement = element
+			case "defaultValueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueInteger = &v
+				}
+				r.DefaultValueIntegerElement = element
+			case "defaultValueMarkdown":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueMarkdown = &v
+				}
+				r.DefaultValueMarkdownElement = element
+			case "defaultValueOid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueOid = &v
+				}
+				r.DefaultValueOidElement = element
+			case "defaultValuePositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValuePositiveInt = &v
+				}
+				r.DefaultValuePositiveIntElement = element
+			case "defaultValueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueString = &v
+				}
+				r.DefaultValueStringElement = element
+			case "defaultValueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueTime = &v
+				}
+				r.DefaultValueTimeElement = element
+			case "defaultValueUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueUnsignedInt = &v
+				}
+				r.DefaultValueUnsignedIntElement = element
+			case "defaultValueUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueUri = &v
+				}
+				r.DefaultValueUriElement = element
+			case "defaultValueUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueUrl = &v
+				}
+				r.DefaultValueUrlElement = element
+			case "defaultValueUuid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValueUuid = &v
+				}
+				r.DefaultValueUuidElement = element
+			case "defaultValueAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueAddress = &v
+			case "defaultValueAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueAge = &v
+			case "defaultValueAnnotation":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueAnnotation = &v
+			case "defaultValueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueAttachment = &v
+			case "defaultValueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueCodeableConcept = &v
+			case "defaultValueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueCoding = &v
+			case "defaultValueContactPoint":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueContactPoint = &v
+			case "defaultValueCount":
+				var v Count
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueCount = &v
+			case "defaultValueDistance":
+				var v Distance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueDistance = &v
+			case "defaultValueDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueDuration = &v
+			case "defaultValueHumanName":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueHumanName = &v
+			case "defaultValueIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueIdentifier = &v
+			case "defaultValueMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueMoney = &v
+			case "defaultValuePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValuePeriod = &v
+			case "defaultValueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueQuantity = &v
+			case "defaultValueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueRange = &v
+			case "defaultValueRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueRatio = &v
+			case "defaultValueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueReference = &v
+			case "defaultValueSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueSampledData = &v
+			case "defaultValueSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueSignature = &v
+			case "defaultValueTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueTiming = &v
+			case "defaultValueContactDetail":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueContactDetail = &v
+			case "defaultValueContributor":
+				var v Contributor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueContributor = &v
+			case "defaultValueDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueDataRequirement = &v
+			case "defaultValueExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueExpression = &v
+			case "defaultValueParameterDefinition":
+				var v ParameterDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueParameterDefinition = &v
+			case "defaultValueRelatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueRelatedArtifact = &v
+			case "defaultValueTriggerDefinition":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueTriggerDefinition = &v
+			case "defaultValueUsageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueUsageContext = &v
+			case "defaultValueDosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueDosage = &v
+			case "defaultValueMeta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefaultValueMeta = &v
+			case "element":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Element = &v
+				}
+				r.ElementElement = element
+			case "listMode":
+				var v StructureMapSourceListMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ListMode = &v
+				}
+				r.ListModeElement = element
+			case "variable":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Variable = &v
+				}
+				r.VariableElement = element
+			case "condition":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Condition = &v
+				}
+				r.ConditionElement = element
+			case "check":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Check = &v
+				}
+				r.CheckElement = element
+			case "logMessage":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LogMessage = &v
+				}
+				r.LogMessageElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureMapGroupRuleSource as properties to the RDF node n
+func (r StructureMapGroupRuleSource) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureMap.group.rule.source.context", -1, r.Context, r.ContextElement, "string")
+	n.primitive("StructureMap.group.rule.source.min", -1, r.Min, r.MinElement, "integer")
+	n.primitive("StructureMap.group.rule.source.max", -1, r.Max, r.MaxElement, "string")
+	n.primitive("StructureMap.group.rule.source.type", -1, r.Type, r.TypeElement, "string")
+	n.primitive("StructureMap.group.rule.source.defaultValueBase64Binary", -1, r.DefaultValueBase64Binary, r.DefaultValueBase64BinaryElement, "base64Binary")
+	n.primitive("StructureMap.group.rule.source.defaultValueBoolean", -1, r.DefaultValueBoolean, r.DefaultValueBooleanElement, "boolean")
+	n.primitive("StructureMap.group.rule.source.defaultValueCanonical", -1, r.DefaultValueCanonical, r.DefaultValueCanonicalElement, "canonical")
+	n.primitive("StructureMap.group.rule.source.defaultValueCode", -1, r.DefaultValueCode, r.DefaultValueCodeElement, "code")
+	n.primitive("StructureMap.group.rule.source.defaultValueDate", -1, r.DefaultValueDate, r.DefaultValueDateElement, "date")
+	n.primitive("StructureMap.group.rule.source.defaultValueDateTime", -1, r.DefaultValueDateTime, r.DefaultValueDateTimeElement, "dateTime")
+	n.primitive("StructureMap.group.rule.source.defaultValueDecimal", -1, r.DefaultValueDecimal, r.DefaultValueDecimalElement, "decimal")
+	n.primitive("StructureMap.group.rule.source.defaultValueId", -1, r.DefaultValueId, r.DefaultValueIdElement, "id")
+	n.primitive("StructureMap.group.rule.source.defaultValueInstant", -1, r.DefaultValueInstant, r.DefaultValueInstantElement, "instant")
+	n.primitive("StructureMap.group.rule.source.defaultValueInteger", -1, r.DefaultValueInteger, r.DefaultValueIntegerElement, "integer")
+	n.primitive("StructureMap.group.rule.source.defaultValueMarkdown", -1, r.DefaultValueMarkdown, r.DefaultValueMarkdownElement, "markdown")
+	n.primitive("StructureMap.group.rule.source.defaultValueOid", -1, r.DefaultValueOid, r.DefaultValueOidElement, "oid")
+	n.primitive("StructureMap.group.rule.source.defaultValuePositiveInt", -1, r.DefaultValuePositiveInt, r.DefaultValuePositiveIntElement, "positiveInt")
+	n.primitive("StructureMap.group.rule.source.defaultValueString", -1, r.DefaultValueString, r.DefaultValueStringElement, "string")
+	n.primitive("StructureMap.group.rule.source.defaultValueTime", -1, r.DefaultValueTime, r.DefaultValueTimeElement, "time")
+	n.primitive("StructureMap.group.rule.source.defaultValueUnsignedInt", -1, r.DefaultValueUnsignedInt, r.DefaultValueUnsignedIntElement, "unsignedInt")
+	n.primitive("StructureMap.group.rule.source.defaultValueUri", -1, r.DefaultValueUri, r.DefaultValueUriElement, "uri")
+	n.primitive("StructureMap.group.rule.source.defaultValueUrl", -1, r.DefaultValueUrl, r.DefaultValueUrlElement, "url")
+	n.primitive("StructureMap.group.rule.source.defaultValueUuid", -1, r.DefaultValueUuid, r.DefaultValueUuidElement, "uuid")
+	n.element("StructureMap.group.rule.source.defaultValueAddress", -1, r.DefaultValueAddress)
+	n.element("StructureMap.group.rule.source.defaultValueAge", -1, r.DefaultValueAge)
+	n.element("StructureMap.group.rule.source.defaultValueAnnotation", -1, r.DefaultValueAnnotation)
+	n.element("StructureMap.group.rule.source.defaultValueAttachment", -1, r.DefaultValueAttachment)
+	n.element("StructureMap.group.rule.source.defaultValueCodeableConcept", -1, r.DefaultValueCodeableConcept)
+	n.element("StructureMap.group.rule.source.defaultValueCoding", -1, r.DefaultValueCoding)
+	n.element("StructureMap.group.rule.source.defaultValueContactPoint", -1, r.DefaultValueContactPoint)
+	n.element("StructureMap.group.rule.source.defaultValueCount", -1, r.DefaultValueCount)
+	n.element("StructureMap.group.rule.source.defaultValueDistance", -1, r.DefaultValueDistance)
+	n.element("StructureMap.group.rule.source.defaultValueDuration", -1, r.DefaultValueDuration)
+	n.element("StructureMap.group.rule.source.defaultValueHumanName", -1, r.DefaultValueHumanName)
+	n.element("StructureMap.group.rule.source.defaultValueIdentifier", -1, r.DefaultValueIdentifier)
+	n.element("StructureMap.group.rule.source.defaultValueMoney", -1, r.DefaultValueMoney)
+	n.element("StructureMap.group.rule.source.defaultValuePeriod", -1, r.DefaultValuePeriod)
+	n.element("StructureMap.group.rule.source.defaultValueQuantity", -1, r.DefaultValueQuantity)
+	n.element("StructureMap.group.rule.source.defaultValueRange", -1, r.DefaultValueRange)
+	n.element("StructureMap.group.rule.source.defaultValueRatio", -1, r.DefaultValueRatio)
+	n.element("StructureMap.group.rule.source.defaultValueReference", -1, r.DefaultValueReference)
+	n.element("StructureMap.group.rule.source.defaultValueSampledData", -1, r.DefaultValueSampledData)
+	n.element("StructureMap.group.rule.source.defaultValueSignature", -1, r.DefaultValueSignature)
+	n.element("StructureMap.group.rule.source.defaultValueTiming", -1, r.DefaultValueTiming)
+	n.element("StructureMap.group.rule.source.defaultValueContactDetail", -1, r.DefaultValueContactDetail)
+	n.element("StructureMap.group.rule.source.defaultValueContributor", -1, r.DefaultValueContributor)
+	n.element("StructureMap.group.rule.source.defaultValueDataRequirement", -1, r.DefaultValueDataRequirement)
+	n.element("StructureMap.group.rule.source.defaultValueExpression", -1, r.DefaultValueExpression)
+	n.element("StructureMap.group.rule.source.defaultValueParameterDefinition", -1, r.DefaultValueParameterDefinition)
+	n.element("StructureMap.group.rule.source.defaultValueRelatedArtifact", -1, r.DefaultValueRelatedArtifact)
+	n.element("StructureMap.group.rule.source.defaultValueTriggerDefinition", -1, r.DefaultValueTriggerDefinition)
+	n.element("StructureMap.group.rule.source.defaultValueUsageContext", -1, r.DefaultValueUsageContext)
+	n.element("StructureMap.group.rule.source.defaultValueDosage", -1, r.DefaultValueDosage)
+	n.element("StructureMap.group.rule.source.defaultValueMeta", -1, r.DefaultValueMeta)
+	n.primitive("StructureMap.group.rule.source.element", -1, r.Element, r.ElementElement, "string")
+	n.primitive("StructureMap.group.rule.source.listMode", -1, r.ListMode, r.ListModeElement, "code")
+	n.primitive("StructureMap.group.rule.source.variable", -1, r.Variable, r.VariableElement, "string")
+	n.primitive("StructureMap.group.rule.source.condition", -1, r.Condition, r.ConditionElement, "string")
+	n.primitive("StructureMap.group.rule.source.check", -1, r.Check, r.CheckElement, "string")
+	n.primitive("StructureMap.group.rule.source.logMessage", -1, r.LogMessage, r.LogMessageElement, "string")
+}
+
+// MarshalBSON marshals the given StructureMapGroupRuleSource as BSON document with the structure of its FHIR JSON
+func (r StructureMapGroupRuleSource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureMapGroupRuleSource from a BSON document with the structure of its FHIR JSON
+func (r *StructureMapGroupRuleSource) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureMapGroupRuleSource as protobuf message StructureMapGroupRuleSource of fhir.proto
+func (r StructureMapGroupRuleSource) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureMapGroupRuleSource to the encoded message
+func (r StructureMapGroupRuleSource) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Context != "" {
+		e.string(4, r.Context)
+	}
+	if r.Min != nil {
+		e.varint(5, uint64(int64(*r.Min)))
+	}
+	if r.Max != nil {
+		e.string(6, *r.Max)
+	}
+	if r.Type != nil {
+		e.string(7, *r.Type)
+	}
+	if r.DefaultValueBase64Binary != nil {
+		e.string(8, *r.DefaultValueBase64Binary)
+	}
+	if r.DefaultValueBoolean != nil {
+		e.varint(9, protoBool(*r.DefaultValueBoolean))
+	}
+	if r.DefaultValueCanonical != nil {
+		e.string(10, *r.DefaultValueCanonical)
+	}
+	if r.DefaultValueCode != nil {
+		e.string(11, *r.DefaultValueCode)
+	}
+	if r.DefaultValueDate != nil {
+		e.string(12, *r.DefaultValueDate)
+	}
+	if r.DefaultValueDateTime != nil {
+		e.string(13, *r.DefaultValueDateTime)
+	}
+	if r.DefaultValueDecimal != nil {
+		e.string(14, string(*r.DefaultValueDecimal))
+	}
+	if r.DefaultValueId != nil {
+		e.string(15, *r.DefaultValueId)
+	}
+	if r.DefaultValueInstant != nil {
+		e.string(16, *r.DefaultValueInstant)
+	}
+	if r.DefaultValueInteger != nil {
+		e.varint(17, uint64(int64(*r.DefaultValueInteger)))
+	}
+	if r.DefaultValueMarkdown != nil {
+		e.string(18, *r.DefaultValueMarkdown)
+	}
+	if r.DefaultValueOid != nil {
+		e.string(19, *r.DefaultValueOid)
+	}
+	if r.DefaultValuePositiveInt != nil {
+		e.varint(20, uint64(int64(*r.DefaultValuePositiveInt)))
+	}
+	if r.DefaultValueString != nil {
+		e.string(21, *r.DefaultValueString)
+	}
+	if r.DefaultValueTime != nil {
+		e.string(22, *r.DefaultValueTime)
+	}
+	if r.DefaultValueUnsignedInt != nil {
+		e.varint(23, uint64(int64(*r.DefaultValueUnsignedInt)))
+	}
+	if r.DefaultValueUri != nil {
+		e.string(24, *r.DefaultValueUri)
+	}
+	if r.DefaultValueUrl != nil {
+		e.string(25, *r.DefaultValueUrl)
+	}
+	if r.DefaultValueUuid != nil {
+		e.string(26, *r.DefaultValueUuid)
+	}
+	if r.DefaultValueAddress != nil {
+		e.message(27, *r.DefaultValueAddress)
+	}
+	if r.DefaultValueAge != nil {
+		e.message(28, *r.DefaultValueAge)
+	}
+	if r.DefaultValueAnnotation != nil {
+		e.message(29, *r.DefaultValueAnnotation)
+	}
+	if r.DefaultValueAttachment != nil {
+		e.message(30, *r.DefaultValueAttachment)
+	}
+	if r.DefaultValueCodeableConcept != nil {
+		e.message(31, *r.DefaultValueCodeableConcept)
+	}
+	if r.DefaultValueCoding != nil {
+		e.message(32, *r.DefaultValueCoding)
+	}
+	if r.DefaultValueContactPoint != nil {
+		e.message(33, *r.DefaultValueContactPoint)
+	}
+	if r.DefaultValueCount != nil {
+		e.message(34, *r.DefaultValueCount)
+	}
+	if r.DefaultValueDistance != nil {
+		e.message(35, *r.DefaultValueDistance)
+	}
+	if r.DefaultValueDuration != nil {
+		e.message(36, *r.DefaultValueDuration)
+	}
+	if r.DefaultValueHumanName != nil {
+		e.message(37, *r.DefaultValueHumanName)
+	}
+	if r.DefaultValueIdentifier != nil {
+		e.message(38, *r.DefaultValueIdentifier)
+	}
+	if r.DefaultValueMoney != nil {
+		e.message(39, *r.DefaultValueMoney)
+	}
+	if r.DefaultValuePeriod != nil {
+		e.message(40, *r.DefaultValuePeriod)
+	}
+	if r.DefaultValueQuantity != nil {
+		e.message(41, *r.DefaultValueQuantity)
+	}
+	if r.DefaultValueRange != nil {
+		e.message(42, *r.DefaultValueRange)
+	}
+	if r.DefaultValueRatio != nil {
+		e.message(43, *r.DefaultValueRatio)
+	}
+	if r.DefaultValueReference != nil {
+		e.message(44, *r.DefaultValueReference)
+	}
+	if r.DefaultValueSampledData != nil {
+		e.message(45, *r.DefaultValueSampledData)
+	}
+	if r.DefaultValueSignature != nil {
+		e.message(46, *r.DefaultValueSignature)
+	}
+	if r.DefaultValueTiming != nil {
+		e.message(47, *r.DefaultValueTiming)
+	}
+	if r.DefaultValueContactDetail != nil {
+		e.message(48, *r.DefaultValueContactDetail)
+	}
+	if r.DefaultValueContributor != nil {
+		e.message(49, *r.DefaultValueContributor)
+	}
+	if r.DefaultValueDataRequirement != nil {
+		e.message(50, *r.DefaultValueDataRequirement)
+	}
+	if r.DefaultValueExpression != nil {
+		e.message(51, *r.DefaultValueExpression)
+	}
+	if r.DefaultValueParameterDefinition != nil {
+		e.message(52, *r.DefaultValueParameterDefinition)
+	}
+	if r.DefaultValueRelatedArtifact != nil {
+		e.message(53, *r.DefaultValueRelatedArtifact)
+	}
+	if r.DefaultValueTriggerDefinition != nil {
+		e.message(54, *r.DefaultValueTriggerDefinition)
+	}
+	if r.DefaultValueUsageContext != nil {
+		e.message(55, *r.DefaultValueUsageContext)
+	}
+	if r.DefaultValueDosage != nil {
+		e.message(56, *r.DefaultValueDosage)
+	}
+	if r.DefaultValueMeta != nil {
+		e.message(57, *r.DefaultValueMeta)
+	}
+	if r.Element != nil {
+		e.string(58, *r.Element)
+	}
+	if r.ListMode != nil {
+		e.varint(59, r.ListMode.protoNumber())
+	}
+	if r.Variable != nil {
+		e.string(60, *r.Variable)
+	}
+	if r.Condition != nil {
+		e.string(61, *r.Condition)
+	}
+	if r.Check != nil {
+		e.string(62, *r.Check)
+	}
+	if r.LogMessage != nil {
+		e.string(63, *r.LogMessage)
+	}
+	if r.ContextElement != nil {
+		e.message(64, *r.ContextElement)
+	}
+	if r.MinElement != nil {
+		e.message(65, *r.MinElement)
+	}
+	if r.MaxElement != nil {
+		e.message(66, *r.MaxElement)
+	}
+	if r.TypeElement != nil {
+		e.message(67, *r.TypeElement)
+	}
+	if r.DefaultValueBase64BinaryElement != nil {
+		e.message(68, *r.DefaultValueBase64BinaryElement)
+	}
+	if r.DefaultValueBooleanElement != nil {
+		e.message(69, *r.DefaultValueBooleanElement)
+	}
+	if r.DefaultValueCanonicalElement != nil {
+		e.message(70, *r.DefaultValueCanonicalElement)
+	}
+	if r.DefaultValueCodeElement != nil {
+		e.message(71, *r.DefaultValueCodeElement)
+	}
+	if r.DefaultValueDateElement != nil {
+		e.message(72, *r.DefaultValueDateElement)
+	}
+	if r.DefaultValueDateTimeElement != nil {
+		e.message(73, *r.DefaultValueDateTimeElement)
+	}
+	if r.DefaultValueDecimalElement != nil {
+		e.message(74, *r.DefaultValueDecimalElement)
+	}
+	if r.DefaultValueIdElement != nil {
+		e.message(75, *r.DefaultValueIdElement)
+	}
+	if r.DefaultValueInstantElement != nil {
+		e.message(76, *r.DefaultValueInstantElement)
+	}
+	if r.DefaultValueIntegerElement != nil {
+		e.message(77, *r.DefaultValueIntegerElement)
+	}
+	if r.DefaultValueMarkdownElement != nil {
+		e.message(78, *r.DefaultValueMarkdownElement)
+	}
+	if r.DefaultValueOidElement != nil {
+		e.message(79, *r.DefaultValueOidElement)
+	}
+	if r.DefaultValuePositiveIntElement != nil {
+		e.message(80, *r.DefaultValuePositiveIntElement)
+	}
+	if r.DefaultValueStringElement != nil {
+		e.message(81, *r.DefaultValueStringElement)
+	}
+	if r.DefaultValueTimeElement != nil {
+		e.message(82, *r.DefaultValueTimeElement)
+	}
+	if r.DefaultValueUnsignedIntElement != nil {
+		e.message(83, *r.DefaultValueUnsignedIntElement)
+	}
+	if r.DefaultValueUriElement != nil {
+		e.message(84, *r.DefaultValueUriElement)
+	}
+	if r.DefaultValueUrlElement != nil {
+		e.message(85, *r.DefaultValueUrlElement)
+	}
+	if r.DefaultValueUuidElement != nil {
+		e.message(86, *r.DefaultValueUuidElement)
+	}
+	if r.ElementElement != nil {
+		e.message(87, *r.ElementElement)
+	}
+	if r.ListModeElement != nil {
+		e.message(88, *r.ListModeElement)
+	}
+	if r.VariableElement != nil {
+		e.message(89, *r.VariableElement)
+	}
+	if r.ConditionElement != nil {
+		e.message(90, *r.ConditionElement)
+	}
+	if r.CheckElement != nil {
+		e.message(91, *r.CheckElement)
+	}
+	if r.LogMessageElement != nil {
+		e.message(92, *r.LogMessageElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureMapGroupRuleSource from protobuf message StructureMapGroupRuleSource of fhir.proto
+func (r *StructureMapGroupRuleSource) UnmarshalProto(b []byte) error {
+	*r = StructureMapGroupRuleSource{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Context = d.string()
+		case 5:
+			v := d.int()
+			r.Min = &v
+		case 6:
+			v := d.string()
+			r.Max = &v
+		case 7:
+			v := d.string()
+			r.Type = &v
+		case 8:
+			v := d.string()
+			r.DefaultValueBase64Binary = &v
+		case 9:
+			v := d.bool()
+			r.DefaultValueBoolean = &v
+		case 10:
+			v := d.string()
+			r.DefaultValueCanonical = &v
+		case 11:
+			v := d.string()
+			r.DefaultValueCode = &v
+		case 12:
+			v := d.string()
+			r.DefaultValueDate = &v
+		case 13:
+			v := d.string()
+			r.DefaultValueDateTime = &v
+		case 14:
+			v := json.Number(d.string())
+			r.DefaultValueDecimal = &v
+		case 15:
+			v := d.string()
+			r.DefaultValueId = &v
+		case 16:
+			v := d.string()
+			r.DefaultValueInstant = &v
+		case 17:
+			v := d.int()
+			r.DefaultValueInteger = &v
+		case 18:
+			v := d.string()
+			r.DefaultValueMarkdown = &v
+		case 19:
+			v := d.string()
+			r.DefaultValueOid = &v
+		case 20:
+			v := d.int()
+			r.DefaultValuePositiveInt = &v
+		case 21:
+			v := d.string()
+			r.DefaultValueString = &v
+		case 22:
+			v := d.string()
+			r.DefaultValueTime = &v
+		case 23:
+			v := d.int()
+			r.DefaultValueUnsignedInt = &v
+		case 24:
+			v := d.string()
+			r.DefaultValueUri = &v
+		case 25:
+			v := d.string()
+			r.DefaultValueUrl = &v
+		case 26:
+			v := d.string()
+			r.DefaultValueUuid = &v
+		case 27:
+			var v Address
+			d.message(&v)
+			r.DefaultValueAddress = &v
+		case 28:
+			var v Age
+			d.message(&v)
+			r.DefaultValueAge = &v
+		case 29:
+			var v Annotation
+			d.message(&v)
+			r.DefaultValueAnnotation = &v
+		case 30:
+			var v Attachment
+			d.message(&v)
+			r.DefaultValueAttachment = &v
+		case 31:
+			var v CodeableConcept
+			d.message(&v)
+			r.DefaultValueCodeableConcept = &v
+		case 32:
+			var v Coding
+			d.message(&v)
+			r.DefaultValueCoding = &v
+		case 33:
+			var v ContactPoint
+			d.message(&v)
+			r.DefaultValueContactPoint = &v
+		case 34:
+			var v Count
+			d.message(&v)
+			r.DefaultValueCount = &v
+		case 35:
+			var v Distance
+			d.message(&v)
+			r.DefaultValueDistance = &v
+		case 36:
+			var v Duration
+			d.message(&v)
+			r.DefaultValueDuration = &v
+		case 37:
+			var v HumanName
+			d.message(&v)
+			r.DefaultValueHumanName = &v
+		case 38:
+			var v Identifier
+			d.message(&v)
+			r.DefaultValueIdentifier = &v
+		case 39:
+			var v Money
+			d.message(&v)
+			r.DefaultValueMoney = &v
+		case 40:
+			var v Period
+			d.message(&v)
+			r.DefaultValuePeriod = &v
+		case 41:
+			var v Quantity
+			d.message(&v)
+			r.DefaultValueQuantity = &v
+		case 42:
+			var v Range
+			d.message(&v)
+			r.DefaultValueRange = &v
+		case 43:
+			var v Ratio
+			d.message(&v)
+			r.DefaultValueRatio = &v
+		case 44:
+			var v Reference
+			d.message(&v)
+			r.DefaultValueReference = &v
+		case 45:
+			var v SampledData
+			d.message(&v)
+			r.DefaultValueSampledData = &v
+		case 46:
+			var v Signature
+			d.message(&v)
+			r.DefaultValueSignature = &v
+		case 47:
+			var v Timing
+			d.message(&v)
+			r.DefaultValueTiming = &v
+		case 48:
+			var v ContactDetail
+			d.message(&v)
+			r.DefaultValueContactDetail = &v
+		case 49:
+			var v Contributor
+			d.message(&v)
+			r.DefaultValueContributor = &v
+		case 50:
+			var v DataRequirement
+			d.message(&v)
+			r.DefaultValueDataRequirement = &v
+		case 51:
+			var v Expression
+			d.message(&v)
+			r.DefaultValueExpression = &v
+		case 52:
+			var v ParameterDefinition
+			d.message(&v)
+			r.DefaultValueParameterDefinition = &v
+		case 53:
+			var v RelatedArtifact
+			d.message(&v)
+			r.DefaultValueRelatedArtifact = &v
+		case 54:
+			var v TriggerDefinition
+			d.message(&v)
+			r.DefaultValueTriggerDefinition = &v
+		case 55:
+			var v UsageContext
+			d.message(&v)
+			r.DefaultValueUsageContext = &v
+		case 56:
+			var v Dosage
+			d.message(&v)
+			r.DefaultValueDosage = &v
+		case 57:
+			var v Meta
+			d.message(&v)
+			r.DefaultValueMeta = &v
+		case 58:
+			v := d.string()
+			r.Element = &v
+		case 59:
+			var v StructureMapSourceListMode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.ListMode = &v
+		case 60:
+			v := d.string()
+			r.Variable = &v
+		case 61:
+			v := d.string()
+			r.Condition = &v
+		case 62:
+			v := d.string()
+			r.Check = &v
+		case 63:
+			v := d.string()
+			r.LogMessage = &v
+		case 64:
+			var v Element
+			d.message(&v)
+			r.ContextElement = &v
+		case 65:
+			var v Element
+			d.message(&v)
+			r.MinElement = &v
+		case 66:
+			var v Element
+			d.message(&v)
+			r.MaxElement = &v
+		case 67:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 68:
+			var v Element
+			d.message(&v)
+			r.DefaultValueBase64BinaryElement = &v
+		case 69:
+			var v Element
+			d.message(&v)
+			r.DefaultValueBooleanElement = &v
+		case 70:
+			var v Element
+			d.message(&v)
+			r.DefaultValueCanonicalElement = &v
+		case 71:
+			var v Element
+			d.message(&v)
+			r.DefaultValueCodeElement = &v
+		case 72:
+			var v Element
+			d.message(&v)
+			r.DefaultValueDateElement = &v
+		case 73:
+			var v Element
+			d.message(&v)
+			r.DefaultValueDateTimeElement = &v
+		case 74:
+			var v Element
+			d.message(&v)
+			r.DefaultValueDecimalElement = &v
+		case 75:
+			var v Element
+			d.message(&v)
+			r.DefaultValueIdElement = &v
+		case 76:
+			var v Element
+			d.message(&v)
+			r.DefaultValueInstantElement = &v
+		case 77:
+			var v Element
+			d.message(&v)
+			r.DefaultValueIntegerElement = &v
+		case 78:
+			var v Element
+			d.message(&v)
+			r.DefaultValueMarkdownElement = &v
+		case 79:
+			var v Element
+			d.message(&v)
+			r.DefaultValueOidElement = &v
+		case 80:
+			var v Element
+			d.message(&v)
+			r.DefaultValuePositiveIntElement = &v
+		case 81:
+			var v Element
+			d.message(&v)
+			r.DefaultValueStringElement = &v
+		case 82:
+			var v Element
+			d.message(&v)
+			r.DefaultValueTimeElement = &v
+		case 83:
+			var v Element
+			d.message(&v)
+			r.DefaultValueUnsignedIntElement = &v
+		case 84:
+			var v Element
+			d.message(&v)
+			r.DefaultValueUriElement = &v
+		case 85:
+			var v Element
+			d.message(&v)
+			r.DefaultValueUrlElement = &v
+		case 86:
+			var v Element
+			d.message(&v)
+			r.DefaultValueUuidElement = &v
+		case 87:
+			var v Element
+			d.message(&v)
+			r.ElementElement = &v
+		case 88:
+			var v Element
+			d.message(&v)
+			r.ListModeElement = &v
+		case 89:
+			var v Element
+			d.message(&v)
+			r.VariableElement = &v
+		case 90:
+			var v Element
+			d.message(&v)
+			r.ConditionElement = &v
+		case 91:
+			var v Element
+			d.message(&v)
+			r.CheckElement = &v
+		case 92:
+			var v Element
+			d.message(&v)
+			r.LogMessageElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureMapGroupRuleTarget as JSON into a byte slice
+func (r StructureMapGroupRuleTarget) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureMapGroupRuleTarget as JSON object with the members in the order of the fields
+func (r StructureMapGroupRuleTarget) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Context != nil {
+		e.key("context")
+		e.string(*r.Context)
+	}
+	if r.ContextElement != nil {
+		e.key("_context")
+		r.ContextElement.appendJSON(e)
+	}
+	if r.ContextType != nil {
+		e.key("contextType")
+		e.string(r.ContextType.Code())
+	}
+	if r.ContextTypeElement != nil {
+		e.key("_contextType")
+		r.ContextTypeElement.appendJSON(e)
+	}
+	if r.Element != nil {
+		e.key("element")
+		e.string(*r.Element)
+	}
+	if r.ElementElement != nil {
+		e.key("_element")
+		r.ElementElement.appendJSON(e)
+	}
+	if r.Variable != nil {
+		e.key("variable")
+		e.string(*r.Variable)
+	}
+	if r.VariableElement != nil {
+		e.key("_variable")
+		r.VariableElement.appendJSON(e)
+	}
+	if len(r.ListMode) > 0 {
+		e.key("listMode")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ListMode {
+			e.item()
+			e.string(v.Code())
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ListModeElement) > 0 {
+		e.key("_listMode")
+		e.elements(r.ListModeElement, len(r.ListMode))
+	}
+	if r.ListRuleId != nil {
+		e.key("listRuleId")
+		e.string(*r.ListRuleId)
+	}
+	if r.ListRuleIdElement != nil {
+		e.key("_listRuleId")
+		r.ListRuleIdElement.appendJSON(e)
+	}
+	if r.Transform != nil {
+		e.key("transform")
+		e.string(r.Transform.Code())
+	}
+	if r.TransformElement != nil {
+		e.key("_transform")
+		r.TransformElement.appendJSON(e)
+	}
+	if len(r.Parameter) > 0 {
+		e.key("parameter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parameter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureMapGroupRuleTarget from JSON. Unknown members are ignored.
+func (r *StructureMapGroupRuleTarget) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureMapGroupRuleTarget
+func (r *StructureMapGroupRuleTarget) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "context":
+			if v, ok := d.string(); ok {
+				r.Context = &v
+			} else {
+				r.Context = nil
+			}
+		case "_context":
+			r.ContextElement = d.element()
+		case "contextType":
+			if d.null() {
+				r.ContextType = nil
+			} else {
+				var v StructureMapContextType
+				d.unmarshal(&v)
+				r.ContextType = &v
+			}
+		case "_contextType":
+			r.ContextTypeElement = d.element()
+		case "element":
+			if v, ok := d.string(); ok {
+				r.Element = &v
+			} else {
+				r.Element = nil
+			}
+		case "_element":
+			r.ElementElement = d.element()
+		case "variable":
+			if v, ok := d.string(); ok {
+				r.Variable = &v
+			} else {
+				r.Variable = nil
+			}
+		case "_variable":
+			r.VariableElement = d.element()
+		case "listMode":
+			a := d.array()
+			r.ListMode = nil
+			for a.next() {
+				var v StructureMapTargetListMode
+				d.unmarshal(&v)
+				r.ListMode = append(r.ListMode, v)
+			}
+			if r.ListMode == nil && !a.isNull {
+				r.ListMode = []StructureMapTargetListMode{}
+			}
+		case "_listMode":
+			a := d.array()
+			r.ListModeElement = nil
+			for a.next() {
+				r.ListModeElement = append(r.ListModeElement, d.element())
+			}
+		case "listRuleId":
+			if v, ok := d.string(); ok {
+				r.ListRuleId = &v
+			} else {
+				r.ListRuleId = nil
+			}
+		case "_listRuleId":
+			r.ListRuleIdElement = d.element()
+		case "transform":
+			if d.null() {
+				r.Transform = nil
+			} else {
+				var v StructureMapTransform
+				d.unmarshal(&v)
+				r.Transform = &v
+			}
+		case "_transform":
+			r.TransformElement = d.element()
+		case "parameter":
+			a := d.array()
+			r.Parameter = nil
+			for a.next() {
+				var v StructureMapGroupRuleTargetParameter
+				v.decodeJSON(d)
+				r.Parameter = append(r.Parameter, v)
+			}
+			if r.Parameter == nil && !a.isNull {
+				r.Parameter = []StructureMapGroupRuleTargetParameter{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureMapGroupRuleTarget which shares no memory with the original
+func (r StructureMapGroupRuleTarget) DeepCopy() StructureMapGroupRuleTarget {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Context != nil {
+		v := *r.Context
+		out.Context = &v
+	}
+	if r.ContextElement != nil {
+		v := r.ContextElement.DeepCopy()
+		out.ContextElement = &v
+	}
+	if r.ContextType != nil {
+		v := *r.ContextType
+		out.ContextType = &v
+	}
+	if r.ContextTypeElement != nil {
+		v := r.ContextTypeElement.DeepCopy()
+		out.ContextTypeElement = &v
+	}
+	if r.Element != nil {
+		v := *r.Element
+		out.Element = &v
+	}
+	if r.ElementElement != nil {
+		v := r.ElementElement.DeepCopy()
+		out.ElementElement = &v
+	}
+	if r.Variable != nil {
+		v := *r.Variable
+		out.Variable = &v
+	}
+	if r.VariableElement != nil {
+		v := r.VariableElement.DeepCopy()
+		out.VariableElement = &v
+	}
+	if r.ListMode != nil {
+		out.ListMode = make([]StructureMapTargetListMode, len(r.ListMode))
+		copy(out.ListMode, r.ListMode)
+	}
+	if r.ListModeElement != nil {
+		out.ListModeElement = make([]*Element, len(r.ListModeElement))
+		for i, v := range r.ListModeElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ListModeElement[i] = &c
+			}
+		}
+	}
+	if r.ListRuleId != nil {
+		v := *r.ListRuleId
+		out.ListRuleId = &v
+	}
+	if r.ListRuleIdElement != nil {
+		v := r.ListRuleIdElement.DeepCopy()
+		out.ListRuleIdElement = &v
+	}
+	if r.Transform != nil {
+		v := *r.Transform
+		out.Transform = &v
+	}
+	if r.TransformElement != nil {
+		v := r.TransformElement.DeepCopy()
+		out.TransformElement = &v
+	}
+	if r.Parameter != nil {
+		out.Parameter = make([]StructureMapGroupRuleTargetParameter, len(r.Parameter))
+		for i := range r.Parameter {
+			out.Parameter[i] = r.Parameter[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureMapGroupRuleTarget) Equal(other StructureMapGroupRuleTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && *r.Context != *other.Context {
+		return false
+	}
+	if (r.ContextElement == nil) != (other.ContextElement == nil) || r.ContextElement != nil && !r.ContextElement.Equal(*other.ContextElement) {
+		return false
+	}
+	if (r.ContextType == nil) != (other.ContextType == nil) || r.ContextType != nil && *r.ContextType != *other.ContextType {
+		return false
+	}
+	if (r.ContextTypeElement == nil) != (other.ContextTypeElement == nil) || r.ContextTypeElement != nil && !r.ContextTypeElement.Equal(*other.ContextTypeElement) {
+		return false
+	}
+	if (r.Element == nil) != (other.Element == nil) || r.Element != nil && *r.Element != *other.Element {
+		return false
+	}
+	if (r.ElementElement == nil) != (other.ElementElement == nil) || r.ElementElement != nil && !r.ElementElement.Equal(*other.ElementElement) {
+		return false
+	}
+	if (r.Variable == nil) != (other.Variable == nil) || r.Variable != nil && *r.Variable != *other.Variable {
+		return false
+	}
+	if (r.VariableElement == nil) != (other.VariableElement == nil) || r.VariableElement != nil && !r.VariableElement.Equal(*other.VariableElement) {
+		return false
+	}
+	if len(r.ListMode) != len(other.ListMode) {
+		return false
+	}
+	for i := range r.ListMode {
+		if r.ListMode[i] != other.ListMode[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ListModeElement, other.ListModeElement, false) {
+		return false
+	}
+	if (r.ListRuleId == nil) != (other.ListRuleId == nil) || r.ListRuleId != nil && *r.ListRuleId != *other.ListRuleId {
+		return false
+	}
+	if (r.ListRuleIdElement == nil) != (other.ListRuleIdElement == nil) || r.ListRuleIdElement != nil && !r.ListRuleIdElement.Equal(*other.ListRuleIdElement) {
+		return false
+	}
+	if (r.Transform == nil) != (other.Transform == nil) || r.Transform != nil && *r.Transform != *other.Transform {
+		return false
+	}
+	if (r.TransformElement == nil) != (other.TransformElement == nil) || r.TransformElement != nil && !r.TransformElement.Equal(*other.TransformElement) {
+		return false
+	}
+	if len(r.Parameter) != len(other.Parameter) {
+		return false
+	}
+	for i := range r.Parameter {
+		if !r.Parameter[i].Equal(other.Parameter[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureMapGroupRuleTarget) EqualsDeep(other StructureMapGroupRuleTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !equivalentString(*r.Context, *other.Context) {
+		return false
+	}
+	if (r.ContextElement == nil) != (other.ContextElement == nil) || r.ContextElement != nil && !r.ContextElement.EqualsDeep(*other.ContextElement) {
+		return false
+	}
+	if (r.ContextType == nil) != (other.ContextType == nil) || r.ContextType != nil && *r.ContextType != *other.ContextType {
+		return false
+	}
+	if (r.ContextTypeElement == nil) != (other.ContextTypeElement == nil) || r.ContextTypeElement != nil && !r.ContextTypeElement.EqualsDeep(*other.ContextTypeElement) {
+		return false
+	}
+	if (r.Element == nil) != (other.Element == nil) || r.Element != nil && !equivalentString(*r.Element, *other.Element) {
+		return false
+	}
+	if (r.ElementElement == nil) != (other.ElementElement == nil) || r.ElementElement != nil && !r.ElementElement.EqualsDeep(*other.ElementElement) {
+		return false
+	}
+	if (r.Variable == nil) != (other.Variable == nil) || r.Variable != nil && !equivalentString(*r.Variable, *other.Variable) {
+		return false
+	}
+	if (r.VariableElement == nil) != (other.VariableElement == nil) || r.VariableElement != nil && !r.VariableElement.EqualsDeep(*other.VariableElement) {
+		return false
+	}
+	if !equivalentList(len(r.ListMode), len(other.ListMode), func(i, j int) bool {
+		return r.ListMode[i] == other.ListMode[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.ListModeElement, other.ListModeElement, true) {
+		return false
+	}
+	if (r.ListRuleId == nil) != (other.ListRuleId == nil) || r.ListRuleId != nil && !equivalentString(*r.ListRuleId, *other.ListRuleId) {
+		return false
+	}
+	if (r.ListRuleIdElement == nil) != (other.ListRuleIdElement == nil) || r.ListRuleIdElement != nil && !r.ListRuleIdElement.EqualsDeep(*other.ListRuleIdElement) {
+		return false
+	}
+	if (r.Transform == nil) != (other.Transform == nil) || r.Transform != nil && *r.Transform != *other.Transform {
+		return false
+	}
+	if (r.TransformElement == nil) != (other.TransformElement == nil) || r.TransformElement != nil && !r.TransformElement.EqualsDeep(*other.TransformElement) {
+		return false
+	}
+	if !equivalentList(len(r.Parameter), len(other.Parameter), func(i, j int) bool {
+		return r.Parameter[i].EqualsDeep(other.Parameter[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureMapGroupRuleTarget) EqualsShallow(other StructureMapGroupRuleTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Context == nil) != (other.Context == nil) || r.Context != nil && !equivalentString(*r.Context, *other.Context) {
+		return false
+	}
+	if (r.ContextType == nil) != (other.ContextType == nil) || r.ContextType != nil && *r.ContextType != *other.ContextType {
+		return false
+	}
+	if (r.Element == nil) != (other.Element == nil) || r.Element != nil && !equivalentString(*r.Element, *other.Element) {
+		return false
+	}
+	if (r.Variable == nil) != (other.Variable == nil) || r.Variable != nil && !equivalentString(*r.Variable, *other.Variable) {
+		return false
+	}
+	if !equivalentList(len(r.ListMode), len(other.ListMode), func(i, j int) bool {
+		return r.ListMode[i] == other.ListMode[j]
+	}) {
+		return false
+	}
+	if (r.ListRuleId == nil) != (other.ListRuleId == nil) || r.ListRuleId != nil && !equivalentString(*r.ListRuleId, *other.ListRuleId) {
+		return false
+	}
+	if (r.Transform == nil) != (other.Transform == nil) || r.Transform != nil && *r.Transform != *other.Transform {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureMapGroupRuleTarget as FHIR XML
+func (r StructureMapGroupRuleTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "context", r.Context, r.ContextElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "contextType", r.ContextType, r.ContextTypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "element", r.Element, r.ElementElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "variable", r.Variable, r.VariableElement); err != nil {
+		return err
+	}
+	for i, v := range r.ListMode {
+		if err := encodeXMLPrimitive(e, "listMode", v, elementAt(r.ListModeElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "listRuleId", r.ListRuleId, r.ListRuleIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "transform", r.Transform, r.TransformElement); err != nil {
+		return err
+	}
+	for _, v := range r.Parameter {
+		if err := encodeXMLElement(e, "parameter", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureMapGroupRuleTarget from FHIR XML
+func (r *StructureMapGroupRuleTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "context":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Context = &v
+				}
+				r.ContextElement = element
+			case "contextType":
+				var v StructureMapContextType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ContextType = &v
+				}
+				r.ContextTypeElement = element
+			case "element":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Element = &v
+				}
+				r.ElementElement = element
+			case "variable":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Variable = &v
+				}
+				r.VariableElement = element
+			case "listMode":
+				var v StructureMapTargetListMode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ListModeElement = appendElement(r.ListModeElement, len(r.ListMode), element)
+					r.ListMode = append(r.ListMode, v)
+				}
+			case "listRuleId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ListRuleId = &v
+				}
+				r.ListRuleIdElement = element
+			case "transform":
+				var v StructureMapTransform
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Transform = &v
+				}
+				r.TransformElement = element
+			case "parameter":
+				var v StructureMapGroupRuleTargetParameter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parameter = append(r.Parameter, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureMapGroupRuleTarget as properties to the RDF node n
+func (r StructureMapGroupRuleTarget) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureMap.group.rule.target.context", -1, r.Context, r.ContextElement, "string")
+	n.primitive("StructureMap.group.rule.target.contextType", -1, r.ContextType, r.ContextTypeElement, "code")
+	n.primitive("StructureMap.group.rule.target.element", -1, r.Element, r.ElementElement, "string")
+	n.primitive("StructureMap.group.rule.target.variable", -1, r.Variable, r.VariableElement, "string")
+	for i, v := range r.ListMode {
+		n.primitive("StructureMap.group.rule.target.listMode", i, v, elementAt(r.ListModeElement, i), "code")
+	}
+	n.primitive("StructureMap.group.rule.target.listRuleId", -1, r.ListRuleId, r.ListRuleIdElement, "string")
+	n.primitive("StructureMap.group.rule.target.transform", -1, r.Transform, r.TransformElement, "code")
+	for i, v := range r.Parameter {
+		n.element("StructureMap.group.rule.target.parameter", i, v)
+	}
+}
+
+// MarshalBSON marshals the given StructureMapGroupRuleTarget as BSON document with the structure of its FHIR JSON
+func (r StructureMapGroupRuleTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureMapGroupRuleTarget from a BSON document with the structure of its FHIR JSON
+func (r *StructureMapGroupRuleTarget) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureMapGroupRuleTarget as protobuf message StructureMapGroupRuleTarget of fhir.proto
+func (r StructureMapGroupRuleTarget) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureMapGroupRuleTarget to the encoded message
+func (r StructureMapGroupRuleTarget) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Context != nil {
+		e.string(4, *r.Context)
+	}
+	if r.ContextType != nil {
+		e.varint(5, r.ContextType.protoNumber())
+	}
+	if r.Element != nil {
+		e.string(6, *r.Element)
+	}
+	if r.Variable != nil {
+		e.string(7, *r.Variable)
+	}
+	if len(r.ListMode) > 0 {
+		var p protoEncoder
+		for _, v := range r.ListMode {
+			p.appendVarint(v.protoNumber())
+		}
+		e.bytes(8, p.buf)
+	}
+	if r.ListRuleId != nil {
+		e.string(9, *r.ListRuleId)
+	}
+	if r.Transform != nil {
+		e.varint(10, r.Transform.protoNumber())
+	}
+	for _, v := range r.Parameter {
+		e.message(11, v)
+	}
+	if r.ContextElement != nil {
+		e.message(12, *r.ContextElement)
+	}
+	if r.ContextTypeElement != nil {
+		e.message(13, *r.ContextTypeElement)
+	}
+	if r.ElementElement != nil {
+		e.message(14, *r.ElementElement)
+	}
+	if r.VariableElement != nil {
+		e.message(15, *r.VariableElement)
+	}
+	for _, v := range r.ListModeElement {
+		e.element(16, v)
+	}
+	if r.ListRuleIdElement != nil {
+		e.message(17, *r.ListRuleIdElement)
+	}
+	if r.TransformElement != nil {
+		e.message(18, *r.TransformElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureMapGroupRuleTarget from protobuf message StructureMapGroupRuleTarget of fhir.proto
+func (r *StructureMapGroupRuleTarget) UnmarshalProto(b []byte) error {
+	*r = StructureMapGroupRuleTarget{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Context = &v
+		case 5:
+			var v StructureMapContextType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.ContextType = &v
+		case 6:
+			v := d.string()
+			r.Element = &v
+		case 7:
+			v := d.string()
+			r.Variable = &v
+		case 8:
+			for _, n := range d.varints() {
+				var v StructureMapTargetListMode
+				d.enum(n, v.setProtoNumber)
+				r.ListMode = append(r.ListMode, v)
+			}
+		case 9:
+			v := d.string()
+			r.ListRuleId = &v
+		case 10:
+			var v StructureMapTransform
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Transform = &v
+		case 11:
+			var v StructureMapGroupRuleTargetParameter
+			d.message(&v)
+			r.Parameter = append(r.Parameter, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ContextElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.ContextTypeElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.ElementElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.VariableElement = &v
+		case 16:
+			r.ListModeElement = append(r.ListModeElement, d.element())
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ListRuleIdElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.TransformElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureMapGroupRuleTargetParameter as JSON into a byte slice
+func (r StructureMapGroupRuleTargetParameter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureMapGroupRuleTargetParameter as JSON object with the members in the order of the fields
+func (r StructureMapGroupRuleTargetParameter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ValueId != nil {
+		e.key("valueId")
+		e.string(*r.ValueId)
+	}
+	if r.ValueIdElement != nil {
+		e.key("_valueId")
+		r.ValueIdElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueDecimal != nil {
+		e.key("valueDecimal")
+		e.number(*r.ValueDecimal)
+	}
+	if r.ValueDecimalElement != nil {
+		e.key("_valueDecimal")
+		r.ValueDecimalElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureMapGroupRuleTargetParameter from JSON. Unknown members are ignored.
+func (r *StructureMapGroupRuleTargetParameter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureMapGroupRuleTargetParameter
+func (r *StructureMapGroupRuleTargetParameter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "valueId":
+			if v, ok := d.string(); ok {
+				r.ValueId = &v
+			} else {
+				r.ValueId = nil
+			}
+		case "_valueId":
+			r.ValueIdElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueDecimal":
+			if v, ok := d.number(); ok {
+				r.ValueDecimal = &v
+			} else {
+				r.ValueDecimal = nil
+			}
+		case "_valueDecimal":
+			r.ValueDecimalElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureMapGroupRuleTargetParameter which shares no memory with the original
+func (r StructureMapGroupRuleTargetParameter) DeepCopy() StructureMapGroupRuleTargetParameter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ValueId != nil {
+		v := *r.ValueId
+		out.ValueId = &v
+	}
+	if r.ValueIdElement != nil {
+		v := r.ValueIdElement.DeepCopy()
+		out.ValueIdElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueDecimal != nil {
+		v := *r.ValueDecimal
+		out.ValueDecimal = &v
+	}
+	if r.ValueDecimalElement != nil {
+		v := r.ValueDecimalElement.DeepCopy()
+		out.ValueDecimalElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureMapGroupRuleTargetParameter) Equal(other StructureMapGroupRuleTargetParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && *r.ValueId != *other.ValueId {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.Equal(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !EqualDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.Equal(*other.ValueDecimalElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureMapGroupRuleTargetParameter) EqualsDeep(other StructureMapGroupRuleTargetParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.EqualsDeep(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.EqualsDeep(*other.ValueDecimalElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureMapGroupRuleTargetParameter) EqualsShallow(other StructureMapGroupRuleTargetParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureMapGroupRuleTargetParameter as FHIR XML
+func (r StructureMapGroupRuleTargetParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "valueId", r.ValueId, r.ValueIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDecimal", r.ValueDecimal, r.ValueDecimalElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureMapGroupRuleTargetParameter from FHIR XML
+func (r *StructureMapGroupRuleTargetParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "valueId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueId = &v
+				}
+				r.ValueIdElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDecimal = &v
+				}
+				r.ValueDecimalElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureMapGroupRuleTargetParameter as properties to the RDF node n
+func (r StructureMapGroupRuleTargetParameter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureMap.group.rule.target.parameter.valueId", -1, r.ValueId, r.ValueIdElement, "id")
+	n.primitive("StructureMap.group.rule.target.parameter.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("StructureMap.group.rule.target.parameter.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("StructureMap.group.rule.target.parameter.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("StructureMap.group.rule.target.parameter.valueDecimal", -1, r.ValueDecimal, r.ValueDecimalElement, "decimal")
+}
+
+// MarshalBSON marshals the given StructureMapGroupRuleTargetParameter as BSON document with the structure of its FHIR JSON
+func (r StructureMapGroupRuleTargetParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureMapGroupRuleTargetParameter from a BSON document with the structure of its FHIR JSON
+func (r *StructureMapGroupRuleTargetParameter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureMapGroupRuleTargetParameter as protobuf message StructureMapGroupRuleTargetParameter of fhir.proto
+func (r StructureMapGroupRuleTargetParameter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureMapGroupRuleTargetParameter to the encoded message
+func (r StructureMapGroupRuleTargetParameter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.ValueId != nil {
+		e.string(4, *r.ValueId)
+	}
+	if r.ValueString != nil {
+		e.string(5, *r.ValueString)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(6, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueInteger != nil {
+		e.varint(7, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueDecimal != nil {
+		e.string(8, string(*r.ValueDecimal))
+	}
+	if r.ValueIdElement != nil {
+		e.message(9, *r.ValueIdElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(10, *r.ValueStringElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(11, *r.ValueBooleanElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(12, *r.ValueIntegerElement)
+	}
+	if r.ValueDecimalElement != nil {
+		e.message(13, *r.ValueDecimalElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureMapGroupRuleTargetParameter from protobuf message StructureMapGroupRuleTargetParameter of fhir.proto
+func (r *StructureMapGroupRuleTargetParameter) UnmarshalProto(b []byte) error {
+	*r = StructureMapGroupRuleTargetParameter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.ValueId = &v
+		case 5:
+			v := d.string()
+			r.ValueString = &v
+		case 6:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 7:
+			v := d.int()
+			r.ValueInteger = &v
+		case 8:
+			v := json.Number(d.string())
+			r.ValueDecimal = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.ValueIdElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.ValueDecimalElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given StructureMapGroupRuleDependent as JSON into a byte slice
+func (r StructureMapGroupRuleDependent) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the StructureMapGroupRuleDependent as JSON object with the members in the order of the fields
+func (r StructureMapGroupRuleDependent) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("variable")
+	if r.Variable == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Variable {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.VariableElement) > 0 {
+		e.key("_variable")
+		e.elements(r.VariableElement, len(r.Variable))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given StructureMapGroupRuleDependent from JSON. Unknown members are ignored.
+func (r *StructureMapGroupRuleDependent) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the StructureMapGroupRuleDependent
+func (r *StructureMapGroupRuleDependent) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "variable":
+			a := d.array()
+			r.Variable = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Variable = append(r.Variable, v)
+			}
+			if r.Variable == nil && !a.isNull {
+				r.Variable = []string{}
+			}
+		case "_variable":
+			a := d.array()
+			r.VariableElement = nil
+			for a.next() {
+				r.VariableElement = append(r.VariableElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the StructureMapGroupRuleDependent which shares no memory with the original
+func (r StructureMapGroupRuleDependent) DeepCopy() StructureMapGroupRuleDependent {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Variable != nil {
+		out.Variable = make([]string, len(r.Variable))
+		copy(out.Variable, r.Variable)
+	}
+	if r.VariableElement != nil {
+		out.VariableElement = make([]*Element, len(r.VariableElement))
+		for i, v := range r.VariableElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.VariableElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r StructureMapGroupRuleDependent) Equal(other StructureMapGroupRuleDependent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if len(r.Variable) != len(other.Variable) {
+		return false
+	}
+	for i := range r.Variable {
+		if r.Variable[i] != other.Variable[i] {
+			return false
+		}
+	}
+	if !equalElements(r.VariableElement, other.VariableElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r StructureMapGroupRuleDependent) EqualsDeep(other StructureMapGroupRuleDependent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if !equivalentList(len(r.Variable), len(other.Variable), func(i, j int) bool {
+		return equivalentString(r.Variable[i], other.Variable[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.VariableElement, other.VariableElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r StructureMapGroupRuleDependent) EqualsShallow(other StructureMapGroupRuleDependent) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if !equivalentList(len(r.Variable), len(other.Variable), func(i, j int) bool {
+		return equivalentString(r.Variable[i], other.Variable[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given StructureMapGroupRuleDependent as FHIR XML
+func (r StructureMapGroupRuleDependent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	for i, v := range r.Variable {
+		if err := encodeXMLPrimitive(e, "variable", v, elementAt(r.VariableElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given StructureMapGroupRuleDependent from FHIR XML
+func (r *StructureMapGroupRuleDependent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "variable":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.VariableElement = appendElement(r.VariableElement, len(r.Variable), element)
+					r.Variable = append(r.Variable, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the StructureMapGroupRuleDependent as properties to the RDF node n
+func (r StructureMapGroupRuleDependent) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("StructureMap.group.rule.dependent.name", -1, r.Name, r.NameElement, "string")
+	for i, v := range r.Variable {
+		n.primitive("StructureMap.group.rule.dependent.variable", i, v, elementAt(r.VariableElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given StructureMapGroupRuleDependent as BSON document with the structure of its FHIR JSON
+func (r StructureMapGroupRuleDependent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given StructureMapGroupRuleDependent from a BSON document with the structure of its FHIR JSON
+func (r *StructureMapGroupRuleDependent) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given StructureMapGroupRuleDependent as protobuf message StructureMapGroupRuleDependent of fhir.proto
+func (r StructureMapGroupRuleDependent) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the StructureMapGroupRuleDependent to the encoded message
+func (r StructureMapGroupRuleDependent) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	for _, v := range r.Variable {
+		e.string(5, v)
+	}
+	if r.NameElement != nil {
+		e.message(6, *r.NameElement)
+	}
+	for _, v := range r.VariableElement {
+		e.element(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given StructureMapGroupRuleDependent from protobuf message StructureMapGroupRuleDependent of fhir.proto
+func (r *StructureMapGroupRuleDependent) UnmarshalProto(b []byte) error {
+	*r = StructureMapGroupRuleDependent{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			r.Variable = append(r.Variable, d.string())
+		case 6:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 7:
+			r.VariableElement = append(r.VariableElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalStructureMap unmarshals a StructureMap.
 func UnmarshalStructureMap(b []byte) (StructureMap, error) {
 	var structureMap StructureMap
-	if err := json.Unmarshal(b, &structureMap); err != nil {
+	if err := structureMap.UnmarshalJSON(b); err != nil {
 		return structureMap, err
 	}
 	return structureMap, nil
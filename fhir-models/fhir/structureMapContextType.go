@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code StructureMapContextType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapContextType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *StructureMapContextType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "type":
 		*code = StructureMapContextTypeType
@@ -46,6 +50,12 @@ func (code *StructureMapContextType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code StructureMapContextType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *StructureMapContextType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code StructureMapContextType) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code StructureMapContextType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code StructureMapContextType) protoNumber() uint64 {
+	switch code {
+	case StructureMapContextTypeType:
+		return 1
+	case StructureMapContextTypeVariable:
+		return 2
+	}
+	return 0
+}
+func (code *StructureMapContextType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = StructureMapContextTypeType
+	case 2:
+		*code = StructureMapContextTypeVariable
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code StructureMapGroupTypeMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapGroupTypeMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *StructureMapGroupTypeMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "none":
 		*code = StructureMapGroupTypeModeNone
@@ -49,6 +53,12 @@ func (code *StructureMapGroupTypeMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code StructureMapGroupTypeMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *StructureMapGroupTypeMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code StructureMapGroupTypeMode) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code StructureMapGroupTypeMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code StructureMapGroupTypeMode) protoNumber() uint64 {
+	switch code {
+	case StructureMapGroupTypeModeNone:
+		return 1
+	case StructureMapGroupTypeModeTypes:
+		return 2
+	case StructureMapGroupTypeModeTypeAndTypes:
+		return 3
+	}
+	return 0
+}
+func (code *StructureMapGroupTypeMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = StructureMapGroupTypeModeNone
+	case 2:
+		*code = StructureMapGroupTypeModeTypes
+	case 3:
+		*code = StructureMapGroupTypeModeTypeAndTypes
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code StructureMapInputMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapInputMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *StructureMapInputMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "source":
 		*code = StructureMapInputModeSource
@@ -46,6 +50,12 @@ func (code *StructureMapInputMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code StructureMapInputMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *StructureMapInputMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code StructureMapInputMode) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code StructureMapInputMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code StructureMapInputMode) protoNumber() uint64 {
+	switch code {
+	case StructureMapInputModeSource:
+		return 1
+	case StructureMapInputModeTarget:
+		return 2
+	}
+	return 0
+}
+func (code *StructureMapInputMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = StructureMapInputModeSource
+	case 2:
+		*code = StructureMapInputModeTarget
+	default:
+		return false
+	}
+	return true
+}
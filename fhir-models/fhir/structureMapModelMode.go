@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code StructureMapModelMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapModelMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *StructureMapModelMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "source":
 		*code = StructureMapModelModeSource
@@ -52,6 +56,12 @@ func (code *StructureMapModelMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code StructureMapModelMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *StructureMapModelMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code StructureMapModelMode) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code StructureMapModelMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code StructureMapModelMode) protoNumber() uint64 {
+	switch code {
+	case StructureMapModelModeSource:
+		return 1
+	case StructureMapModelModeQueried:
+		return 2
+	case StructureMapModelModeTarget:
+		return 3
+	case StructureMapModelModeProduced:
+		return 4
+	}
+	return 0
+}
+func (code *StructureMapModelMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = StructureMapModelModeSource
+	case 2:
+		*code = StructureMapModelModeQueried
+	case 3:
+		*code = StructureMapModelModeTarget
+	case 4:
+		*code = StructureMapModelModeProduced
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code StructureMapSourceListMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapSourceListMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *StructureMapSourceListMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "first":
 		*code = StructureMapSourceListModeFirst
@@ -55,6 +59,12 @@ func (code *StructureMapSourceListMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code StructureMapSourceListMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *StructureMapSourceListMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code StructureMapSourceListMode) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code StructureMapSourceListMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code StructureMapSourceListMode) protoNumber() uint64 {
+	switch code {
+	case StructureMapSourceListModeFirst:
+		return 1
+	case StructureMapSourceListModeNot_first:
+		return 2
+	case StructureMapSourceListModeLast:
+		return 3
+	case StructureMapSourceListModeNot_last:
+		return 4
+	case StructureMapSourceListModeOnly_one:
+		return 5
+	}
+	return 0
+}
+func (code *StructureMapSourceListMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = StructureMapSourceListModeFirst
+	case 2:
+		*code = StructureMapSourceListModeNot_first
+	case 3:
+		*code = StructureMapSourceListModeLast
+	case 4:
+		*code = StructureMapSourceListModeNot_last
+	case 5:
+		*code = StructureMapSourceListModeOnly_one
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code StructureMapTargetListMode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapTargetListMode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *StructureMapTargetListMode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "first":
 		*code = StructureMapTargetListModeFirst
@@ -52,6 +56,12 @@ func (code *StructureMapTargetListMode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code StructureMapTargetListMode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *StructureMapTargetListMode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code StructureMapTargetListMode) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code StructureMapTargetListMode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code StructureMapTargetListMode) protoNumber() uint64 {
+	switch code {
+	case StructureMapTargetListModeFirst:
+		return 1
+	case StructureMapTargetListModeShare:
+		return 2
+	case StructureMapTargetListModeLast:
+		return 3
+	case StructureMapTargetListModeCollate:
+		return 4
+	}
+	return 0
+}
+func (code *StructureMapTargetListMode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = StructureMapTargetListModeFirst
+	case 2:
+		*code = StructureMapTargetListModeShare
+	case 3:
+		*code = StructureMapTargetListModeLast
+	case 4:
+		*code = StructureMapTargetListModeCollate
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -49,8 +48,13 @@ const (
 func (code StructureMapTransform) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *StructureMapTransform) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *StructureMapTransform) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "create":
 		*code = StructureMapTransformCreate
@@ -91,6 +95,12 @@ func (code *StructureMapTransform) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code StructureMapTransform) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *StructureMapTransform) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code StructureMapTransform) String() string {
 	return code.Code()
 }
@@ -211,3 +221,83 @@ func (code StructureMapTransform) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code StructureMapTransform) protoNumber() uint64 {
+	switch code {
+	case StructureMapTransformCreate:
+		return 1
+	case StructureMapTransformCopy:
+		return 2
+	case StructureMapTransformTruncate:
+		return 3
+	case StructureMapTransformEscape:
+		return 4
+	case StructureMapTransformCast:
+		return 5
+	case StructureMapTransformAppend:
+		return 6
+	case StructureMapTransformTranslate:
+		return 7
+	case StructureMapTransformReference:
+		return 8
+	case StructureMapTransformDateOp:
+		return 9
+	case StructureMapTransformUuid:
+		return 10
+	case StructureMapTransformPointer:
+		return 11
+	case StructureMapTransformEvaluate:
+		return 12
+	case StructureMapTransformCc:
+		return 13
+	case StructureMapTransformC:
+		return 14
+	case StructureMapTransformQty:
+		return 15
+	case StructureMapTransformId:
+		return 16
+	case StructureMapTransformCp:
+		return 17
+	}
+	return 0
+}
+func (code *StructureMapTransform) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = StructureMapTransformCreate
+	case 2:
+		*code = StructureMapTransformCopy
+	case 3:
+		*code = StructureMapTransformTruncate
+	case 4:
+		*code = StructureMapTransformEscape
+	case 5:
+		*code = StructureMapTransformCast
+	case 6:
+		*code = StructureMapTransformAppend
+	case 7:
+		*code = StructureMapTransformTranslate
+	case 8:
+		*code = StructureMapTransformReference
+	case 9:
+		*code = StructureMapTransformDateOp
+	case 10:
+		*code = StructureMapTransformUuid
+	case 11:
+		*code = StructureMapTransformPointer
+	case 12:
+		*code = StructureMapTransformEvaluate
+	case 13:
+		*code = StructureMapTransformCc
+	case 14:
+		*code = StructureMapTransformC
+	case 15:
+		*code = StructureMapTransformQty
+	case 16:
+		*code = StructureMapTransformId
+	case 17:
+		*code = StructureMapTransformCp
+	default:
+		return false
+	}
+	return true
+}
@@ -14,54 +14,1591 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Subscription is documented here http://hl7.org/fhir/StructureDefinition/Subscription
 type Subscription struct {
-	Id                *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string             `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Status            SubscriptionStatus  `bson:"status" json:"status"`
-	Contact           []ContactPoint      `bson:"contact,omitempty" json:"contact,omitempty"`
-	End               *string             `bson:"end,omitempty" json:"end,omitempty"`
-	Reason            string              `bson:"reason" json:"reason"`
-	Criteria          string              `bson:"criteria" json:"criteria"`
-	Error             *string             `bson:"error,omitempty" json:"error,omitempty"`
-	Channel           SubscriptionChannel `bson:"channel" json:"channel"`
+	Id                   *string             `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element            `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element            `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string             `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element            `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage   `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Status               SubscriptionStatus  `bson:"status" json:"status"`
+	StatusElement        *Element            `bson:"_status,omitempty" json:"_status,omitempty"`
+	Contact              []ContactPoint      `bson:"contact,omitempty" json:"contact,omitempty"`
+	End                  *string             `bson:"end,omitempty" json:"end,omitempty"`
+	EndElement           *Element            `bson:"_end,omitempty" json:"_end,omitempty"`
+	Reason               string              `bson:"reason" json:"reason"`
+	ReasonElement        *Element            `bson:"_reason,omitempty" json:"_reason,omitempty"`
+	Criteria             string              `bson:"criteria" json:"criteria"`
+	CriteriaElement      *Element            `bson:"_criteria,omitempty" json:"_criteria,omitempty"`
+	Error                *string             `bson:"error,omitempty" json:"error,omitempty"`
+	ErrorElement         *Element            `bson:"_error,omitempty" json:"_error,omitempty"`
+	Channel              SubscriptionChannel `bson:"channel" json:"channel"`
 }
 type SubscriptionChannel struct {
 	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              SubscriptionChannelType `bson:"type" json:"type"`
+	TypeElement       *Element                `bson:"_type,omitempty" json:"_type,omitempty"`
 	Endpoint          *string                 `bson:"endpoint,omitempty" json:"endpoint,omitempty"`
+	EndpointElement   *Element                `bson:"_endpoint,omitempty" json:"_endpoint,omitempty"`
 	Payload           *string                 `bson:"payload,omitempty" json:"payload,omitempty"`
+	PayloadElement    *Element                `bson:"_payload,omitempty" json:"_payload,omitempty"`
 	Header            []string                `bson:"header,omitempty" json:"header,omitempty"`
+	HeaderElement     []*Element              `bson:"_header,omitempty" json:"_header,omitempty"`
 }
-type OtherSubscription Subscription
 
 // MarshalJSON marshals the given Subscription as JSON into a byte slice
 func (r Subscription) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSubscription
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSubscription: OtherSubscription(r),
-		ResourceType:      "Subscription",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Subscription as JSON object with the members in the order of the fields
+func (r Subscription) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Subscription\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.End != nil {
+		e.key("end")
+		e.string(*r.End)
+	}
+	if r.EndElement != nil {
+		e.key("_end")
+		r.EndElement.appendJSON(e)
+	}
+	e.key("reason")
+	e.string(r.Reason)
+	if r.ReasonElement != nil {
+		e.key("_reason")
+		r.ReasonElement.appendJSON(e)
+	}
+	e.key("criteria")
+	e.string(r.Criteria)
+	if r.CriteriaElement != nil {
+		e.key("_criteria")
+		r.CriteriaElement.appendJSON(e)
+	}
+	if r.Error != nil {
+		e.key("error")
+		e.string(*r.Error)
+	}
+	if r.ErrorElement != nil {
+		e.key("_error")
+		r.ErrorElement.appendJSON(e)
+	}
+	e.key("channel")
+	r.Channel.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Subscription from JSON. Unknown members are ignored.
+func (r *Subscription) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Subscription
+func (r *Subscription) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactPoint{}
+			}
+		case "end":
+			if v, ok := d.string(); ok {
+				r.End = &v
+			} else {
+				r.End = nil
+			}
+		case "_end":
+			r.EndElement = d.element()
+		case "reason":
+			if v, ok := d.string(); ok {
+				r.Reason = v
+			}
+		case "_reason":
+			r.ReasonElement = d.element()
+		case "criteria":
+			if v, ok := d.string(); ok {
+				r.Criteria = v
+			}
+		case "_criteria":
+			r.CriteriaElement = d.element()
+		case "error":
+			if v, ok := d.string(); ok {
+				r.Error = &v
+			} else {
+				r.Error = nil
+			}
+		case "_error":
+			r.ErrorElement = d.element()
+		case "channel":
+			r.Channel.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Subscription which shares no memory with the original
+func (r Subscription) DeepCopy() Subscription {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactPoint, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.End != nil {
+		v := *r.End
+		out.End = &v
+	}
+	if r.EndElement != nil {
+		v := r.EndElement.DeepCopy()
+		out.EndElement = &v
+	}
+	if r.ReasonElement != nil {
+		v := r.ReasonElement.DeepCopy()
+		out.ReasonElement = &v
+	}
+	if r.CriteriaElement != nil {
+		v := r.CriteriaElement.DeepCopy()
+		out.CriteriaElement = &v
+	}
+	if r.Error != nil {
+		v := *r.Error
+		out.Error = &v
+	}
+	if r.ErrorElement != nil {
+		v := r.ErrorElement.DeepCopy()
+		out.ErrorElement = &v
+	}
+	out.Channel = r.Channel.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Subscription) Equal(other Subscription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && *r.End != *other.End {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.Equal(*other.EndElement) {
+		return false
+	}
+	if r.Reason != other.Reason {
+		return false
+	}
+	if (r.ReasonElement == nil) != (other.ReasonElement == nil) || r.ReasonElement != nil && !r.ReasonElement.Equal(*other.ReasonElement) {
+		return false
+	}
+	if r.Criteria != other.Criteria {
+		return false
+	}
+	if (r.CriteriaElement == nil) != (other.CriteriaElement == nil) || r.CriteriaElement != nil && !r.CriteriaElement.Equal(*other.CriteriaElement) {
+		return false
+	}
+	if (r.Error == nil) != (other.Error == nil) || r.Error != nil && *r.Error != *other.Error {
+		return false
+	}
+	if (r.ErrorElement == nil) != (other.ErrorElement == nil) || r.ErrorElement != nil && !r.ErrorElement.Equal(*other.ErrorElement) {
+		return false
+	}
+	if !r.Channel.Equal(other.Channel) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Subscription) EqualsDeep(other Subscription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && !equivalentString(*r.End, *other.End) {
+		return false
+	}
+	if (r.EndElement == nil) != (other.EndElement == nil) || r.EndElement != nil && !r.EndElement.EqualsDeep(*other.EndElement) {
+		return false
+	}
+	if !equivalentString(r.Reason, other.Reason) {
+		return false
+	}
+	if (r.ReasonElement == nil) != (other.ReasonElement == nil) || r.ReasonElement != nil && !r.ReasonElement.EqualsDeep(*other.ReasonElement) {
+		return false
+	}
+	if !equivalentString(r.Criteria, other.Criteria) {
+		return false
+	}
+	if (r.CriteriaElement == nil) != (other.CriteriaElement == nil) || r.CriteriaElement != nil && !r.CriteriaElement.EqualsDeep(*other.CriteriaElement) {
+		return false
+	}
+	if (r.Error == nil) != (other.Error == nil) || r.Error != nil && !equivalentString(*r.Error, *other.Error) {
+		return false
+	}
+	if (r.ErrorElement == nil) != (other.ErrorElement == nil) || r.ErrorElement != nil && !r.ErrorElement.EqualsDeep(*other.ErrorElement) {
+		return false
+	}
+	if !r.Channel.EqualsDeep(other.Channel) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Subscription) EqualsShallow(other Subscription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.End == nil) != (other.End == nil) || r.End != nil && !equivalentString(*r.End, *other.End) {
+		return false
+	}
+	if !equivalentString(r.Reason, other.Reason) {
+		return false
+	}
+	if !equivalentString(r.Criteria, other.Criteria) {
+		return false
+	}
+	if (r.Error == nil) != (other.Error == nil) || r.Error != nil && !equivalentString(*r.Error, *other.Error) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Subscription as FHIR XML
+func (r Subscription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Subscription",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "end", r.End, r.EndElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "reason", r.Reason, r.ReasonElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "criteria", r.Criteria, r.CriteriaElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "error", r.Error, r.ErrorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "channel", r.Channel); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Subscription from FHIR XML
+func (r *Subscription) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "status":
+				var v SubscriptionStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "contact":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "end":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.End = &v
+				}
+				r.EndElement = element
+			case "reason":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Reason = v
+				}
+				r.ReasonElement = element
+			case "criteria":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Criteria = v
+				}
+				r.CriteriaElement = element
+			case "error":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Error = &v
+				}
+				r.ErrorElement = element
+			case "channel":
+				var v SubscriptionChannel
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Channel = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Subscription as properties to the RDF node n
+func (r Subscription) turtle(n *turtleNode) {
+	n.resource("Subscription", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("Subscription.status", -1, r.Status, r.StatusElement, "code")
+	for i, v := range r.Contact {
+		n.element("Subscription.contact", i, v)
+	}
+	n.primitive("Subscription.end", -1, r.End, r.EndElement, "instant")
+	n.primitive("Subscription.reason", -1, r.Reason, r.ReasonElement, "string")
+	n.primitive("Subscription.criteria", -1, r.Criteria, r.CriteriaElement, "string")
+	n.primitive("Subscription.error", -1, r.Error, r.ErrorElement, "string")
+	n.element("Subscription.channel", -1, r.Channel)
+}
+
+// MarshalBSON marshals the given Subscription as BSON document with the structure of its FHIR JSON
+func (r Subscription) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Subscription from a BSON document with the structure of its FHIR JSON
+func (r *Subscription) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Subscription as protobuf message Subscription of fhir.proto
+func (r Subscription) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Subscription to the encoded message
+func (r Subscription) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	e.varint(9, r.Status.protoNumber())
+	for _, v := range r.Contact {
+		e.message(10, v)
+	}
+	if r.End != nil {
+		e.string(11, *r.End)
+	}
+	if r.Reason != "" {
+		e.string(12, r.Reason)
+	}
+	if r.Criteria != "" {
+		e.string(13, r.Criteria)
+	}
+	if r.Error != nil {
+		e.string(14, *r.Error)
+	}
+	e.message(15, r.Channel)
+	if r.IdElement != nil {
+		e.message(16, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(17, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(18, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(19, *r.StatusElement)
+	}
+	if r.EndElement != nil {
+		e.message(20, *r.EndElement)
+	}
+	if r.ReasonElement != nil {
+		e.message(21, *r.ReasonElement)
+	}
+	if r.CriteriaElement != nil {
+		e.message(22, *r.CriteriaElement)
+	}
+	if r.ErrorElement != nil {
+		e.message(23, *r.ErrorElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Subscription from protobuf message Subscription of fhir.proto
+func (r *Subscription) UnmarshalProto(b []byte) error {
+	*r = Subscription{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v SubscriptionStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 10:
+			var v ContactPoint
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 11:
+			v := d.string()
+			r.End = &v
+		case 12:
+			r.Reason = d.string()
+		case 13:
+			r.Criteria = d.string()
+		case 14:
+			v := d.string()
+			r.Error = &v
+		case 15:
+			var v SubscriptionChannel
+			d.message(&v)
+			r.Channel = v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.EndElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ReasonElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.CriteriaElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ErrorElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubscriptionChannel as JSON into a byte slice
+func (r SubscriptionChannel) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubscriptionChannel as JSON object with the members in the order of the fields
+func (r SubscriptionChannel) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	if r.Endpoint != nil {
+		e.key("endpoint")
+		e.string(*r.Endpoint)
+	}
+	if r.EndpointElement != nil {
+		e.key("_endpoint")
+		r.EndpointElement.appendJSON(e)
+	}
+	if r.Payload != nil {
+		e.key("payload")
+		e.string(*r.Payload)
+	}
+	if r.PayloadElement != nil {
+		e.key("_payload")
+		r.PayloadElement.appendJSON(e)
+	}
+	if len(r.Header) > 0 {
+		e.key("header")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Header {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.HeaderElement) > 0 {
+		e.key("_header")
+		e.elements(r.HeaderElement, len(r.Header))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubscriptionChannel from JSON. Unknown members are ignored.
+func (r *SubscriptionChannel) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubscriptionChannel
+func (r *SubscriptionChannel) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "endpoint":
+			if v, ok := d.string(); ok {
+				r.Endpoint = &v
+			} else {
+				r.Endpoint = nil
+			}
+		case "_endpoint":
+			r.EndpointElement = d.element()
+		case "payload":
+			if v, ok := d.string(); ok {
+				r.Payload = &v
+			} else {
+				r.Payload = nil
+			}
+		case "_payload":
+			r.PayloadElement = d.element()
+		case "header":
+			a := d.array()
+			r.Header = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Header = append(r.Header, v)
+			}
+			if r.Header == nil && !a.isNull {
+				r.Header = []string{}
+			}
+		case "_header":
+			a := d.array()
+			r.HeaderElement = nil
+			for a.next() {
+				r.HeaderElement = append(r.HeaderElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubscriptionChannel which shares no memory with the original
+func (r SubscriptionChannel) DeepCopy() SubscriptionChannel {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.Endpoint != nil {
+		v := *r.Endpoint
+		out.Endpoint = &v
+	}
+	if r.EndpointElement != nil {
+		v := r.EndpointElement.DeepCopy()
+		out.EndpointElement = &v
+	}
+	if r.Payload != nil {
+		v := *r.Payload
+		out.Payload = &v
+	}
+	if r.PayloadElement != nil {
+		v := r.PayloadElement.DeepCopy()
+		out.PayloadElement = &v
+	}
+	if r.Header != nil {
+		out.Header = make([]string, len(r.Header))
+		copy(out.Header, r.Header)
+	}
+	if r.HeaderElement != nil {
+		out.HeaderElement = make([]*Element, len(r.HeaderElement))
+		for i, v := range r.HeaderElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.HeaderElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubscriptionChannel) Equal(other SubscriptionChannel) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if (r.Endpoint == nil) != (other.Endpoint == nil) || r.Endpoint != nil && *r.Endpoint != *other.Endpoint {
+		return false
+	}
+	if (r.EndpointElement == nil) != (other.EndpointElement == nil) || r.EndpointElement != nil && !r.EndpointElement.Equal(*other.EndpointElement) {
+		return false
+	}
+	if (r.Payload == nil) != (other.Payload == nil) || r.Payload != nil && *r.Payload != *other.Payload {
+		return false
+	}
+	if (r.PayloadElement == nil) != (other.PayloadElement == nil) || r.PayloadElement != nil && !r.PayloadElement.Equal(*other.PayloadElement) {
+		return false
+	}
+	if len(r.Header) != len(other.Header) {
+		return false
+	}
+	for i := range r.Header {
+		if r.Header[i] != other.Header[i] {
+			return false
+		}
+	}
+	if !equalElements(r.HeaderElement, other.HeaderElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubscriptionChannel) EqualsDeep(other SubscriptionChannel) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if (r.Endpoint == nil) != (other.Endpoint == nil) || r.Endpoint != nil && !equivalentString(*r.Endpoint, *other.Endpoint) {
+		return false
+	}
+	if (r.EndpointElement == nil) != (other.EndpointElement == nil) || r.EndpointElement != nil && !r.EndpointElement.EqualsDeep(*other.EndpointElement) {
+		return false
+	}
+	if (r.Payload == nil) != (other.Payload == nil) || r.Payload != nil && !equivalentString(*r.Payload, *other.Payload) {
+		return false
+	}
+	if (r.PayloadElement == nil) != (other.PayloadElement == nil) || r.PayloadElement != nil && !r.PayloadElement.EqualsDeep(*other.PayloadElement) {
+		return false
+	}
+	if !equivalentList(len(r.Header), len(other.Header), func(i, j int) bool {
+		return equivalentString(r.Header[i], other.Header[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.HeaderElement, other.HeaderElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubscriptionChannel) EqualsShallow(other SubscriptionChannel) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.Endpoint == nil) != (other.Endpoint == nil) || r.Endpoint != nil && !equivalentString(*r.Endpoint, *other.Endpoint) {
+		return false
+	}
+	if (r.Payload == nil) != (other.Payload == nil) || r.Payload != nil && !equivalentString(*r.Payload, *other.Payload) {
+		return false
+	}
+	if !equivalentList(len(r.Header), len(other.Header), func(i, j int) bool {
+		return equivalentString(r.Header[i], other.Header[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubscriptionChannel as FHIR XML
+func (r SubscriptionChannel) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "endpoint", r.Endpoint, r.EndpointElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "payload", r.Payload, r.PayloadElement); err != nil {
+		return err
+	}
+	for i, v := range r.Header {
+		if err := encodeXMLPrimitive(e, "header", v, elementAt(r.HeaderElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubscriptionChannel from FHIR XML
+func (r *SubscriptionChannel) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v SubscriptionChannelType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "endpoint":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Endpoint = &v
+				}
+				r.EndpointElement = element
+			case "payload":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Payload = &v
+				}
+				r.PayloadElement = element
+			case "header":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.HeaderElement = appendElement(r.HeaderElement, len(r.Header), element)
+					r.Header = append(r.Header, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubscriptionChannel as properties to the RDF node n
+func (r SubscriptionChannel) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Subscription.channel.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("Subscription.channel.endpoint", -1, r.Endpoint, r.EndpointElement, "string")
+	n.primitive("Subscription.channel.payload", -1, r.Payload, r.PayloadElement, "string")
+	for i, v := range r.Header {
+		n.primitive("Subscription.channel.header", i, v, elementAt(r.HeaderElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given SubscriptionChannel as BSON document with the structure of its FHIR JSON
+func (r SubscriptionChannel) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubscriptionChannel from a BSON document with the structure of its FHIR JSON
+func (r *SubscriptionChannel) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubscriptionChannel as protobuf message SubscriptionChannel of fhir.proto
+func (r SubscriptionChannel) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubscriptionChannel to the encoded message
+func (r SubscriptionChannel) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Endpoint != nil {
+		e.string(5, *r.Endpoint)
+	}
+	if r.Payload != nil {
+		e.string(6, *r.Payload)
+	}
+	for _, v := range r.Header {
+		e.string(7, v)
+	}
+	if r.TypeElement != nil {
+		e.message(8, *r.TypeElement)
+	}
+	if r.EndpointElement != nil {
+		e.message(9, *r.EndpointElement)
+	}
+	if r.PayloadElement != nil {
+		e.message(10, *r.PayloadElement)
+	}
+	for _, v := range r.HeaderElement {
+		e.element(11, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubscriptionChannel from protobuf message SubscriptionChannel of fhir.proto
+func (r *SubscriptionChannel) UnmarshalProto(b []byte) error {
+	*r = SubscriptionChannel{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v SubscriptionChannelType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			v := d.string()
+			r.Endpoint = &v
+		case 6:
+			v := d.string()
+			r.Payload = &v
+		case 7:
+			r.Header = append(r.Header, d.string())
+		case 8:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.EndpointElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.PayloadElement = &v
+		case 11:
+			r.HeaderElement = append(r.HeaderElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSubscription unmarshals a Subscription.
 func UnmarshalSubscription(b []byte) (Subscription, error) {
 	var subscription Subscription
-	if err := json.Unmarshal(b, &subscription); err != nil {
+	if err := subscription.UnmarshalJSON(b); err != nil {
 		return subscription, err
 	}
 	return subscription, nil
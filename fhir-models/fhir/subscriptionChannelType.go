@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code SubscriptionChannelType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SubscriptionChannelType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SubscriptionChannelType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "rest-hook":
 		*code = SubscriptionChannelTypeRestHook
@@ -55,6 +59,12 @@ func (code *SubscriptionChannelType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SubscriptionChannelType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SubscriptionChannelType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SubscriptionChannelType) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code SubscriptionChannelType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SubscriptionChannelType) protoNumber() uint64 {
+	switch code {
+	case SubscriptionChannelTypeRestHook:
+		return 1
+	case SubscriptionChannelTypeWebsocket:
+		return 2
+	case SubscriptionChannelTypeEmail:
+		return 3
+	case SubscriptionChannelTypeSms:
+		return 4
+	case SubscriptionChannelTypeMessage:
+		return 5
+	}
+	return 0
+}
+func (code *SubscriptionChannelType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SubscriptionChannelTypeRestHook
+	case 2:
+		*code = SubscriptionChannelTypeWebsocket
+	case 3:
+		*code = SubscriptionChannelTypeEmail
+	case 4:
+		*code = SubscriptionChannelTypeSms
+	case 5:
+		*code = SubscriptionChannelTypeMessage
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code SubscriptionStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SubscriptionStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SubscriptionStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "requested":
 		*code = SubscriptionStatusRequested
@@ -52,6 +56,12 @@ func (code *SubscriptionStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SubscriptionStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SubscriptionStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SubscriptionStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code SubscriptionStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SubscriptionStatus) protoNumber() uint64 {
+	switch code {
+	case SubscriptionStatusRequested:
+		return 1
+	case SubscriptionStatusActive:
+		return 2
+	case SubscriptionStatusError:
+		return 3
+	case SubscriptionStatusOff:
+		return 4
+	}
+	return 0
+}
+func (code *SubscriptionStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SubscriptionStatusRequested
+	case 2:
+		*code = SubscriptionStatusActive
+	case 3:
+		*code = SubscriptionStatusError
+	case 4:
+		*code = SubscriptionStatusOff
+	default:
+		return false
+	}
+	return true
+}
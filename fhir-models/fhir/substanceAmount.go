@@ -14,24 +14,950 @@
 
 package fhir
 
+import "encoding/xml"
+
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // SubstanceAmount is documented here http://hl7.org/fhir/StructureDefinition/SubstanceAmount
 type SubstanceAmount struct {
-	Id                *string                        `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	AmountQuantity    *Quantity                      `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
-	AmountRange       *Range                         `bson:"amountRange,omitempty" json:"amountRange,omitempty"`
-	AmountString      *string                        `bson:"amountString,omitempty" json:"amountString,omitempty"`
-	AmountType        *CodeableConcept               `bson:"amountType,omitempty" json:"amountType,omitempty"`
-	AmountText        *string                        `bson:"amountText,omitempty" json:"amountText,omitempty"`
-	ReferenceRange    *SubstanceAmountReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
+	Id                  *string                        `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	AmountQuantity      *Quantity                      `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
+	AmountRange         *Range                         `bson:"amountRange,omitempty" json:"amountRange,omitempty"`
+	AmountString        *string                        `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement *Element                       `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
+	AmountType          *CodeableConcept               `bson:"amountType,omitempty" json:"amountType,omitempty"`
+	AmountText          *string                        `bson:"amountText,omitempty" json:"amountText,omitempty"`
+	AmountTextElement   *Element                       `bson:"_amountText,omitempty" json:"_amountText,omitempty"`
+	ReferenceRange      *SubstanceAmountReferenceRange `bson:"referenceRange,omitempty" json:"referenceRange,omitempty"`
+}
+
+// SubstanceAmountAmount is implemented by the types allowed for SubstanceAmount.amount[x]
+type SubstanceAmountAmount interface {
+	isSubstanceAmountAmount()
+}
+
+func (Quantity) isSubstanceAmountAmount() {}
+func (Range) isSubstanceAmountAmount()    {}
+func (String) isSubstanceAmountAmount()   {}
+
+// Amount returns the value of SubstanceAmount.amount[x] together with its FHIR type code or nil if no value is set
+func (r SubstanceAmount) Amount() (SubstanceAmountAmount, string) {
+	if r.AmountQuantity != nil {
+		return *r.AmountQuantity, "Quantity"
+	}
+	if r.AmountRange != nil {
+		return *r.AmountRange, "Range"
+	}
+	if r.AmountString != nil {
+		return String(*r.AmountString), "string"
+	}
+	return nil, ""
+}
+
+// SetAmount sets the value of SubstanceAmount.amount[x], given as value or pointer, and clears all other types
+func (r *SubstanceAmount) SetAmount(value SubstanceAmountAmount) {
+	r.AmountQuantity = nil
+	r.AmountRange = nil
+	r.AmountString = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.AmountQuantity = &v
+	case *Quantity:
+		r.AmountQuantity = v
+	case Range:
+		r.AmountRange = &v
+	case *Range:
+		r.AmountRange = v
+	case String:
+		primitive := string(v)
+		r.AmountString = &primitive
+	case *String:
+		r.AmountString = (*string)(v)
+	}
 }
+
 type SubstanceAmountReferenceRange struct {
 	Id        *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	LowLimit  *Quantity   `bson:"lowLimit,omitempty" json:"lowLimit,omitempty"`
 	HighLimit *Quantity   `bson:"highLimit,omitempty" json:"highLimit,omitempty"`
 }
+
+// MarshalJSON marshals the given SubstanceAmount as JSON into a byte slice
+func (r SubstanceAmount) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceAmount as JSON object with the members in the order of the fields
+func (r SubstanceAmount) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AmountQuantity != nil {
+		e.key("amountQuantity")
+		r.AmountQuantity.appendJSON(e)
+	}
+	if r.AmountRange != nil {
+		e.key("amountRange")
+		r.AmountRange.appendJSON(e)
+	}
+	if r.AmountString != nil {
+		e.key("amountString")
+		e.string(*r.AmountString)
+	}
+	if r.AmountStringElement != nil {
+		e.key("_amountString")
+		r.AmountStringElement.appendJSON(e)
+	}
+	if r.AmountType != nil {
+		e.key("amountType")
+		r.AmountType.appendJSON(e)
+	}
+	if r.AmountText != nil {
+		e.key("amountText")
+		e.string(*r.AmountText)
+	}
+	if r.AmountTextElement != nil {
+		e.key("_amountText")
+		r.AmountTextElement.appendJSON(e)
+	}
+	if r.ReferenceRange != nil {
+		e.key("referenceRange")
+		r.ReferenceRange.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceAmount from JSON. Unknown members are ignored.
+func (r *SubstanceAmount) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceAmount
+func (r *SubstanceAmount) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "amountQuantity":
+			if d.null() {
+				r.AmountQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.AmountQuantity = &v
+			}
+		case "amountRange":
+			if d.null() {
+				r.AmountRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.AmountRange = &v
+			}
+		case "amountString":
+			if v, ok := d.string(); ok {
+				r.AmountString = &v
+			} else {
+				r.AmountString = nil
+			}
+		case "_amountString":
+			r.AmountStringElement = d.element()
+		case "amountType":
+			if d.null() {
+				r.AmountType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AmountType = &v
+			}
+		case "amountText":
+			if v, ok := d.string(); ok {
+				r.AmountText = &v
+			} else {
+				r.AmountText = nil
+			}
+		case "_amountText":
+			r.AmountTextElement = d.element()
+		case "referenceRange":
+			if d.null() {
+				r.ReferenceRange = nil
+			} else {
+				var v SubstanceAmountReferenceRange
+				v.decodeJSON(d)
+				r.ReferenceRange = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceAmount which shares no memory with the original
+func (r SubstanceAmount) DeepCopy() SubstanceAmount {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.AmountQuantity != nil {
+		v := r.AmountQuantity.DeepCopy()
+		out.AmountQuantity = &v
+	}
+	if r.AmountRange != nil {
+		v := r.AmountRange.DeepCopy()
+		out.AmountRange = &v
+	}
+	if r.AmountString != nil {
+		v := *r.AmountString
+		out.AmountString = &v
+	}
+	if r.AmountStringElement != nil {
+		v := r.AmountStringElement.DeepCopy()
+		out.AmountStringElement = &v
+	}
+	if r.AmountType != nil {
+		v := r.AmountType.DeepCopy()
+		out.AmountType = &v
+	}
+	if r.AmountText != nil {
+		v := *r.AmountText
+		out.AmountText = &v
+	}
+	if r.AmountTextElement != nil {
+		v := r.AmountTextElement.DeepCopy()
+		out.AmountTextElement = &v
+	}
+	if r.ReferenceRange != nil {
+		v := r.ReferenceRange.DeepCopy()
+		out.ReferenceRange = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceAmount) Equal(other SubstanceAmount) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.Equal(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountRange == nil) != (other.AmountRange == nil) || r.AmountRange != nil && !r.AmountRange.Equal(*other.AmountRange) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && *r.AmountString != *other.AmountString {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.Equal(*other.AmountStringElement) {
+		return false
+	}
+	if (r.AmountType == nil) != (other.AmountType == nil) || r.AmountType != nil && !r.AmountType.Equal(*other.AmountType) {
+		return false
+	}
+	if (r.AmountText == nil) != (other.AmountText == nil) || r.AmountText != nil && *r.AmountText != *other.AmountText {
+		return false
+	}
+	if (r.AmountTextElement == nil) != (other.AmountTextElement == nil) || r.AmountTextElement != nil && !r.AmountTextElement.Equal(*other.AmountTextElement) {
+		return false
+	}
+	if (r.ReferenceRange == nil) != (other.ReferenceRange == nil) || r.ReferenceRange != nil && !r.ReferenceRange.Equal(*other.ReferenceRange) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceAmount) EqualsDeep(other SubstanceAmount) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.EqualsDeep(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountRange == nil) != (other.AmountRange == nil) || r.AmountRange != nil && !r.AmountRange.EqualsDeep(*other.AmountRange) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.EqualsDeep(*other.AmountStringElement) {
+		return false
+	}
+	if (r.AmountType == nil) != (other.AmountType == nil) || r.AmountType != nil && !r.AmountType.EqualsDeep(*other.AmountType) {
+		return false
+	}
+	if (r.AmountText == nil) != (other.AmountText == nil) || r.AmountText != nil && !equivalentString(*r.AmountText, *other.AmountText) {
+		return false
+	}
+	if (r.AmountTextElement == nil) != (other.AmountTextElement == nil) || r.AmountTextElement != nil && !r.AmountTextElement.EqualsDeep(*other.AmountTextElement) {
+		return false
+	}
+	if (r.ReferenceRange == nil) != (other.ReferenceRange == nil) || r.ReferenceRange != nil && !r.ReferenceRange.EqualsDeep(*other.ReferenceRange) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceAmount) EqualsShallow(other SubstanceAmount) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	if (r.AmountText == nil) != (other.AmountText == nil) || r.AmountText != nil && !equivalentString(*r.AmountText, *other.AmountText) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceAmount as FHIR XML
+func (r SubstanceAmount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "amountQuantity", r.AmountQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountRange", r.AmountRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "amountString", r.AmountString, r.AmountStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountType", r.AmountType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "amountText", r.AmountText, r.AmountTextElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "referenceRange", r.ReferenceRange); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceAmount from FHIR XML
+func (r *SubstanceAmount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "amountQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountQuantity = &v
+			case "amountRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountRange = &v
+			case "amountString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AmountString = &v
+				}
+				r.AmountStringElement = element
+			case "amountType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountType = &v
+			case "amountText":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AmountText = &v
+				}
+				r.AmountTextElement = element
+			case "referenceRange":
+				var v SubstanceAmountReferenceRange
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferenceRange = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceAmount as properties to the RDF node n
+func (r SubstanceAmount) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceAmount.amountQuantity", -1, r.AmountQuantity)
+	n.element("SubstanceAmount.amountRange", -1, r.AmountRange)
+	n.primitive("SubstanceAmount.amountString", -1, r.AmountString, r.AmountStringElement, "string")
+	n.element("SubstanceAmount.amountType", -1, r.AmountType)
+	n.primitive("SubstanceAmount.amountText", -1, r.AmountText, r.AmountTextElement, "string")
+	n.element("SubstanceAmount.referenceRange", -1, r.ReferenceRange)
+}
+
+// MarshalBSON marshals the given SubstanceAmount as BSON document with the structure of its FHIR JSON
+func (r SubstanceAmount) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceAmount from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceAmount) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceAmount as protobuf message SubstanceAmount of fhir.proto
+func (r SubstanceAmount) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceAmount to the encoded message
+func (r SubstanceAmount) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.AmountQuantity != nil {
+		e.message(4, *r.AmountQuantity)
+	}
+	if r.AmountRange != nil {
+		e.message(5, *r.AmountRange)
+	}
+	if r.AmountString != nil {
+		e.string(6, *r.AmountString)
+	}
+	if r.AmountType != nil {
+		e.message(7, *r.AmountType)
+	}
+	if r.AmountText != nil {
+		e.string(8, *r.AmountText)
+	}
+	if r.ReferenceRange != nil {
+		e.message(9, *r.ReferenceRange)
+	}
+	if r.AmountStringElement != nil {
+		e.message(10, *r.AmountStringElement)
+	}
+	if r.AmountTextElement != nil {
+		e.message(11, *r.AmountTextElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceAmount from protobuf message SubstanceAmount of fhir.proto
+func (r *SubstanceAmount) UnmarshalProto(b []byte) error {
+	*r = SubstanceAmount{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.AmountQuantity = &v
+		case 5:
+			var v Range
+			d.message(&v)
+			r.AmountRange = &v
+		case 6:
+			v := d.string()
+			r.AmountString = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.AmountType = &v
+		case 8:
+			v := d.string()
+			r.AmountText = &v
+		case 9:
+			var v SubstanceAmountReferenceRange
+			d.message(&v)
+			r.ReferenceRange = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.AmountStringElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.AmountTextElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceAmountReferenceRange as JSON into a byte slice
+func (r SubstanceAmountReferenceRange) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceAmountReferenceRange as JSON object with the members in the order of the fields
+func (r SubstanceAmountReferenceRange) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.LowLimit != nil {
+		e.key("lowLimit")
+		r.LowLimit.appendJSON(e)
+	}
+	if r.HighLimit != nil {
+		e.key("highLimit")
+		r.HighLimit.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceAmountReferenceRange from JSON. Unknown members are ignored.
+func (r *SubstanceAmountReferenceRange) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceAmountReferenceRange
+func (r *SubstanceAmountReferenceRange) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "lowLimit":
+			if d.null() {
+				r.LowLimit = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.LowLimit = &v
+			}
+		case "highLimit":
+			if d.null() {
+				r.HighLimit = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.HighLimit = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceAmountReferenceRange which shares no memory with the original
+func (r SubstanceAmountReferenceRange) DeepCopy() SubstanceAmountReferenceRange {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.LowLimit != nil {
+		v := r.LowLimit.DeepCopy()
+		out.LowLimit = &v
+	}
+	if r.HighLimit != nil {
+		v := r.HighLimit.DeepCopy()
+		out.HighLimit = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceAmountReferenceRange) Equal(other SubstanceAmountReferenceRange) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if (r.LowLimit == nil) != (other.LowLimit == nil) || r.LowLimit != nil && !r.LowLimit.Equal(*other.LowLimit) {
+		return false
+	}
+	if (r.HighLimit == nil) != (other.HighLimit == nil) || r.HighLimit != nil && !r.HighLimit.Equal(*other.HighLimit) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceAmountReferenceRange) EqualsDeep(other SubstanceAmountReferenceRange) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if (r.LowLimit == nil) != (other.LowLimit == nil) || r.LowLimit != nil && !r.LowLimit.EqualsDeep(*other.LowLimit) {
+		return false
+	}
+	if (r.HighLimit == nil) != (other.HighLimit == nil) || r.HighLimit != nil && !r.HighLimit.EqualsDeep(*other.HighLimit) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceAmountReferenceRange) EqualsShallow(other SubstanceAmountReferenceRange) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceAmountReferenceRange as FHIR XML
+func (r SubstanceAmountReferenceRange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "lowLimit", r.LowLimit); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "highLimit", r.HighLimit); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceAmountReferenceRange from FHIR XML
+func (r *SubstanceAmountReferenceRange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "lowLimit":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LowLimit = &v
+			case "highLimit":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.HighLimit = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceAmountReferenceRange as properties to the RDF node n
+func (r SubstanceAmountReferenceRange) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	n.element("SubstanceAmount.referenceRange.lowLimit", -1, r.LowLimit)
+	n.element("SubstanceAmount.referenceRange.highLimit", -1, r.HighLimit)
+}
+
+// MarshalBSON marshals the given SubstanceAmountReferenceRange as BSON document with the structure of its FHIR JSON
+func (r SubstanceAmountReferenceRange) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceAmountReferenceRange from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceAmountReferenceRange) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceAmountReferenceRange as protobuf message SubstanceAmountReferenceRange of fhir.proto
+func (r SubstanceAmountReferenceRange) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceAmountReferenceRange to the encoded message
+func (r SubstanceAmountReferenceRange) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	if r.LowLimit != nil {
+		e.message(3, *r.LowLimit)
+	}
+	if r.HighLimit != nil {
+		e.message(4, *r.HighLimit)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceAmountReferenceRange from protobuf message SubstanceAmountReferenceRange of fhir.proto
+func (r *SubstanceAmountReferenceRange) UnmarshalProto(b []byte) error {
+	*r = SubstanceAmountReferenceRange{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Quantity
+			d.message(&v)
+			r.LowLimit = &v
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.HighLimit = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
@@ -14,33 +14,45 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // SubstanceNucleicAcid is documented here http://hl7.org/fhir/StructureDefinition/SubstanceNucleicAcid
 type SubstanceNucleicAcid struct {
-	Id                  *string                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules       *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language            *string                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text                *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension           []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SequenceType        *CodeableConcept              `bson:"sequenceType,omitempty" json:"sequenceType,omitempty"`
-	NumberOfSubunits    *int                          `bson:"numberOfSubunits,omitempty" json:"numberOfSubunits,omitempty"`
-	AreaOfHybridisation *string                       `bson:"areaOfHybridisation,omitempty" json:"areaOfHybridisation,omitempty"`
-	OligoNucleotideType *CodeableConcept              `bson:"oligoNucleotideType,omitempty" json:"oligoNucleotideType,omitempty"`
-	Subunit             []SubstanceNucleicAcidSubunit `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	Id                         *string                       `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                  *Element                      `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                       *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules              *string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement       *Element                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                   *string                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement            *Element                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                       *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                  []json.RawMessage             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                  []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SequenceType               *CodeableConcept              `bson:"sequenceType,omitempty" json:"sequenceType,omitempty"`
+	NumberOfSubunits           *int                          `bson:"numberOfSubunits,omitempty" json:"numberOfSubunits,omitempty"`
+	NumberOfSubunitsElement    *Element                      `bson:"_numberOfSubunits,omitempty" json:"_numberOfSubunits,omitempty"`
+	AreaOfHybridisation        *string                       `bson:"areaOfHybridisation,omitempty" json:"areaOfHybridisation,omitempty"`
+	AreaOfHybridisationElement *Element                      `bson:"_areaOfHybridisation,omitempty" json:"_areaOfHybridisation,omitempty"`
+	OligoNucleotideType        *CodeableConcept              `bson:"oligoNucleotideType,omitempty" json:"oligoNucleotideType,omitempty"`
+	Subunit                    []SubstanceNucleicAcidSubunit `bson:"subunit,omitempty" json:"subunit,omitempty"`
 }
 type SubstanceNucleicAcidSubunit struct {
 	Id                 *string                              `bson:"id,omitempty" json:"id,omitempty"`
 	Extension          []Extension                          `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension  []Extension                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Subunit            *int                                 `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	SubunitElement     *Element                             `bson:"_subunit,omitempty" json:"_subunit,omitempty"`
 	Sequence           *string                              `bson:"sequence,omitempty" json:"sequence,omitempty"`
+	SequenceElement    *Element                             `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
 	Length             *int                                 `bson:"length,omitempty" json:"length,omitempty"`
+	LengthElement      *Element                             `bson:"_length,omitempty" json:"_length,omitempty"`
 	SequenceAttachment *Attachment                          `bson:"sequenceAttachment,omitempty" json:"sequenceAttachment,omitempty"`
 	FivePrime          *CodeableConcept                     `bson:"fivePrime,omitempty" json:"fivePrime,omitempty"`
 	ThreePrime         *CodeableConcept                     `bson:"threePrime,omitempty" json:"threePrime,omitempty"`
@@ -48,39 +60,2580 @@ type SubstanceNucleicAcidSubunit struct {
 	Sugar              []SubstanceNucleicAcidSubunitSugar   `bson:"sugar,omitempty" json:"sugar,omitempty"`
 }
 type SubstanceNucleicAcidSubunitLinkage struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Connectivity      *string     `bson:"connectivity,omitempty" json:"connectivity,omitempty"`
-	Identifier        *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
-	ResidueSite       *string     `bson:"residueSite,omitempty" json:"residueSite,omitempty"`
+	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Connectivity        *string     `bson:"connectivity,omitempty" json:"connectivity,omitempty"`
+	ConnectivityElement *Element    `bson:"_connectivity,omitempty" json:"_connectivity,omitempty"`
+	Identifier          *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name                *string     `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement         *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	ResidueSite         *string     `bson:"residueSite,omitempty" json:"residueSite,omitempty"`
+	ResidueSiteElement  *Element    `bson:"_residueSite,omitempty" json:"_residueSite,omitempty"`
 }
 type SubstanceNucleicAcidSubunitSugar struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name              *string     `bson:"name,omitempty" json:"name,omitempty"`
-	ResidueSite       *string     `bson:"residueSite,omitempty" json:"residueSite,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier         *Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name               *string     `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	ResidueSite        *string     `bson:"residueSite,omitempty" json:"residueSite,omitempty"`
+	ResidueSiteElement *Element    `bson:"_residueSite,omitempty" json:"_residueSite,omitempty"`
 }
-type OtherSubstanceNucleicAcid SubstanceNucleicAcid
 
 // MarshalJSON marshals the given SubstanceNucleicAcid as JSON into a byte slice
 func (r SubstanceNucleicAcid) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSubstanceNucleicAcid
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSubstanceNucleicAcid: OtherSubstanceNucleicAcid(r),
-		ResourceType:              "SubstanceNucleicAcid",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceNucleicAcid as JSON object with the members in the order of the fields
+func (r SubstanceNucleicAcid) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"SubstanceNucleicAcid\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.SequenceType != nil {
+		e.key("sequenceType")
+		r.SequenceType.appendJSON(e)
+	}
+	if r.NumberOfSubunits != nil {
+		e.key("numberOfSubunits")
+		e.int(*r.NumberOfSubunits)
+	}
+	if r.NumberOfSubunitsElement != nil {
+		e.key("_numberOfSubunits")
+		r.NumberOfSubunitsElement.appendJSON(e)
+	}
+	if r.AreaOfHybridisation != nil {
+		e.key("areaOfHybridisation")
+		e.string(*r.AreaOfHybridisation)
+	}
+	if r.AreaOfHybridisationElement != nil {
+		e.key("_areaOfHybridisation")
+		r.AreaOfHybridisationElement.appendJSON(e)
+	}
+	if r.OligoNucleotideType != nil {
+		e.key("oligoNucleotideType")
+		r.OligoNucleotideType.appendJSON(e)
+	}
+	if len(r.Subunit) > 0 {
+		e.key("subunit")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subunit {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceNucleicAcid from JSON. Unknown members are ignored.
+func (r *SubstanceNucleicAcid) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceNucleicAcid
+func (r *SubstanceNucleicAcid) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequenceType":
+			if d.null() {
+				r.SequenceType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SequenceType = &v
+			}
+		case "numberOfSubunits":
+			if v, ok := d.int(); ok {
+				r.NumberOfSubunits = &v
+			} else {
+				r.NumberOfSubunits = nil
+			}
+		case "_numberOfSubunits":
+			r.NumberOfSubunitsElement = d.element()
+		case "areaOfHybridisation":
+			if v, ok := d.string(); ok {
+				r.AreaOfHybridisation = &v
+			} else {
+				r.AreaOfHybridisation = nil
+			}
+		case "_areaOfHybridisation":
+			r.AreaOfHybridisationElement = d.element()
+		case "oligoNucleotideType":
+			if d.null() {
+				r.OligoNucleotideType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OligoNucleotideType = &v
+			}
+		case "subunit":
+			a := d.array()
+			r.Subunit = nil
+			for a.next() {
+				var v SubstanceNucleicAcidSubunit
+				v.decodeJSON(d)
+				r.Subunit = append(r.Subunit, v)
+			}
+			if r.Subunit == nil && !a.isNull {
+				r.Subunit = []SubstanceNucleicAcidSubunit{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceNucleicAcid which shares no memory with the original
+func (r SubstanceNucleicAcid) DeepCopy() SubstanceNucleicAcid {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceType != nil {
+		v := r.SequenceType.DeepCopy()
+		out.SequenceType = &v
+	}
+	if r.NumberOfSubunits != nil {
+		v := *r.NumberOfSubunits
+		out.NumberOfSubunits = &v
+	}
+	if r.NumberOfSubunitsElement != nil {
+		v := r.NumberOfSubunitsElement.DeepCopy()
+		out.NumberOfSubunitsElement = &v
+	}
+	if r.AreaOfHybridisation != nil {
+		v := *r.AreaOfHybridisation
+		out.AreaOfHybridisation = &v
+	}
+	if r.AreaOfHybridisationElement != nil {
+		v := r.AreaOfHybridisationElement.DeepCopy()
+		out.AreaOfHybridisationElement = &v
+	}
+	if r.OligoNucleotideType != nil {
+		v := r.OligoNucleotideType.DeepCopy()
+		out.OligoNucleotideType = &v
+	}
+	if r.Subunit != nil {
+		out.Subunit = make([]SubstanceNucleicAcidSubunit, len(r.Subunit))
+		for i := range r.Subunit {
+			out.Subunit[i] = r.Subunit[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceNucleicAcid) Equal(other SubstanceNucleicAcid) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.SequenceType == nil) != (other.SequenceType == nil) || r.SequenceType != nil && !r.SequenceType.Equal(*other.SequenceType) {
+		return false
+	}
+	if (r.NumberOfSubunits == nil) != (other.NumberOfSubunits == nil) || r.NumberOfSubunits != nil && *r.NumberOfSubunits != *other.NumberOfSubunits {
+		return false
+	}
+	if (r.NumberOfSubunitsElement == nil) != (other.NumberOfSubunitsElement == nil) || r.NumberOfSubunitsElement != nil && !r.NumberOfSubunitsElement.Equal(*other.NumberOfSubunitsElement) {
+		return false
+	}
+	if (r.AreaOfHybridisation == nil) != (other.AreaOfHybridisation == nil) || r.AreaOfHybridisation != nil && *r.AreaOfHybridisation != *other.AreaOfHybridisation {
+		return false
+	}
+	if (r.AreaOfHybridisationElement == nil) != (other.AreaOfHybridisationElement == nil) || r.AreaOfHybridisationElement != nil && !r.AreaOfHybridisationElement.Equal(*other.AreaOfHybridisationElement) {
+		return false
+	}
+	if (r.OligoNucleotideType == nil) != (other.OligoNucleotideType == nil) || r.OligoNucleotideType != nil && !r.OligoNucleotideType.Equal(*other.OligoNucleotideType) {
+		return false
+	}
+	if len(r.Subunit) != len(other.Subunit) {
+		return false
+	}
+	for i := range r.Subunit {
+		if !r.Subunit[i].Equal(other.Subunit[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceNucleicAcid) EqualsDeep(other SubstanceNucleicAcid) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.SequenceType == nil) != (other.SequenceType == nil) || r.SequenceType != nil && !r.SequenceType.EqualsDeep(*other.SequenceType) {
+		return false
+	}
+	if (r.NumberOfSubunits == nil) != (other.NumberOfSubunits == nil) || r.NumberOfSubunits != nil && *r.NumberOfSubunits != *other.NumberOfSubunits {
+		return false
+	}
+	if (r.NumberOfSubunitsElement == nil) != (other.NumberOfSubunitsElement == nil) || r.NumberOfSubunitsElement != nil && !r.NumberOfSubunitsElement.EqualsDeep(*other.NumberOfSubunitsElement) {
+		return false
+	}
+	if (r.AreaOfHybridisation == nil) != (other.AreaOfHybridisation == nil) || r.AreaOfHybridisation != nil && !equivalentString(*r.AreaOfHybridisation, *other.AreaOfHybridisation) {
+		return false
+	}
+	if (r.AreaOfHybridisationElement == nil) != (other.AreaOfHybridisationElement == nil) || r.AreaOfHybridisationElement != nil && !r.AreaOfHybridisationElement.EqualsDeep(*other.AreaOfHybridisationElement) {
+		return false
+	}
+	if (r.OligoNucleotideType == nil) != (other.OligoNucleotideType == nil) || r.OligoNucleotideType != nil && !r.OligoNucleotideType.EqualsDeep(*other.OligoNucleotideType) {
+		return false
+	}
+	if !equivalentList(len(r.Subunit), len(other.Subunit), func(i, j int) bool {
+		return r.Subunit[i].EqualsDeep(other.Subunit[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceNucleicAcid) EqualsShallow(other SubstanceNucleicAcid) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.NumberOfSubunits == nil) != (other.NumberOfSubunits == nil) || r.NumberOfSubunits != nil && *r.NumberOfSubunits != *other.NumberOfSubunits {
+		return false
+	}
+	if (r.AreaOfHybridisation == nil) != (other.AreaOfHybridisation == nil) || r.AreaOfHybridisation != nil && !equivalentString(*r.AreaOfHybridisation, *other.AreaOfHybridisation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceNucleicAcid as FHIR XML
+func (r SubstanceNucleicAcid) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "SubstanceNucleicAcid",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "sequenceType", r.SequenceType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "numberOfSubunits", r.NumberOfSubunits, r.NumberOfSubunitsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "areaOfHybridisation", r.AreaOfHybridisation, r.AreaOfHybridisationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "oligoNucleotideType", r.OligoNucleotideType); err != nil {
+		return err
+	}
+	for _, v := range r.Subunit {
+		if err := encodeXMLElement(e, "subunit", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceNucleicAcid from FHIR XML
+func (r *SubstanceNucleicAcid) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequenceType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SequenceType = &v
+			case "numberOfSubunits":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumberOfSubunits = &v
+				}
+				r.NumberOfSubunitsElement = element
+			case "areaOfHybridisation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AreaOfHybridisation = &v
+				}
+				r.AreaOfHybridisationElement = element
+			case "oligoNucleotideType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OligoNucleotideType = &v
+			case "subunit":
+				var v SubstanceNucleicAcidSubunit
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subunit = append(r.Subunit, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceNucleicAcid as properties to the RDF node n
+func (r SubstanceNucleicAcid) turtle(n *turtleNode) {
+	n.resource("SubstanceNucleicAcid", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("SubstanceNucleicAcid.sequenceType", -1, r.SequenceType)
+	n.primitive("SubstanceNucleicAcid.numberOfSubunits", -1, r.NumberOfSubunits, r.NumberOfSubunitsElement, "integer")
+	n.primitive("SubstanceNucleicAcid.areaOfHybridisation", -1, r.AreaOfHybridisation, r.AreaOfHybridisationElement, "string")
+	n.element("SubstanceNucleicAcid.oligoNucleotideType", -1, r.OligoNucleotideType)
+	for i, v := range r.Subunit {
+		n.element("SubstanceNucleicAcid.subunit", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceNucleicAcid as BSON document with the structure of its FHIR JSON
+func (r SubstanceNucleicAcid) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceNucleicAcid from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceNucleicAcid) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceNucleicAcid as protobuf message SubstanceNucleicAcid of fhir.proto
+func (r SubstanceNucleicAcid) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceNucleicAcid to the encoded message
+func (r SubstanceNucleicAcid) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.SequenceType != nil {
+		e.message(9, *r.SequenceType)
+	}
+	if r.NumberOfSubunits != nil {
+		e.varint(10, uint64(int64(*r.NumberOfSubunits)))
+	}
+	if r.AreaOfHybridisation != nil {
+		e.string(11, *r.AreaOfHybridisation)
+	}
+	if r.OligoNucleotideType != nil {
+		e.message(12, *r.OligoNucleotideType)
+	}
+	for _, v := range r.Subunit {
+		e.message(13, v)
+	}
+	if r.IdElement != nil {
+		e.message(14, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(15, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(16, *r.LanguageElement)
+	}
+	if r.NumberOfSubunitsElement != nil {
+		e.message(17, *r.NumberOfSubunitsElement)
+	}
+	if r.AreaOfHybridisationElement != nil {
+		e.message(18, *r.AreaOfHybridisationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceNucleicAcid from protobuf message SubstanceNucleicAcid of fhir.proto
+func (r *SubstanceNucleicAcid) UnmarshalProto(b []byte) error {
+	*r = SubstanceNucleicAcid{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.SequenceType = &v
+		case 10:
+			v := d.int()
+			r.NumberOfSubunits = &v
+		case 11:
+			v := d.string()
+			r.AreaOfHybridisation = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.OligoNucleotideType = &v
+		case 13:
+			var v SubstanceNucleicAcidSubunit
+			d.message(&v)
+			r.Subunit = append(r.Subunit, v)
+		case 14:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.NumberOfSubunitsElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.AreaOfHybridisationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceNucleicAcidSubunit as JSON into a byte slice
+func (r SubstanceNucleicAcidSubunit) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceNucleicAcidSubunit as JSON object with the members in the order of the fields
+func (r SubstanceNucleicAcidSubunit) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Subunit != nil {
+		e.key("subunit")
+		e.int(*r.Subunit)
+	}
+	if r.SubunitElement != nil {
+		e.key("_subunit")
+		r.SubunitElement.appendJSON(e)
+	}
+	if r.Sequence != nil {
+		e.key("sequence")
+		e.string(*r.Sequence)
+	}
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.Length != nil {
+		e.key("length")
+		e.int(*r.Length)
+	}
+	if r.LengthElement != nil {
+		e.key("_length")
+		r.LengthElement.appendJSON(e)
+	}
+	if r.SequenceAttachment != nil {
+		e.key("sequenceAttachment")
+		r.SequenceAttachment.appendJSON(e)
+	}
+	if r.FivePrime != nil {
+		e.key("fivePrime")
+		r.FivePrime.appendJSON(e)
+	}
+	if r.ThreePrime != nil {
+		e.key("threePrime")
+		r.ThreePrime.appendJSON(e)
+	}
+	if len(r.Linkage) > 0 {
+		e.key("linkage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Linkage {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Sugar) > 0 {
+		e.key("sugar")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Sugar {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceNucleicAcidSubunit from JSON. Unknown members are ignored.
+func (r *SubstanceNucleicAcidSubunit) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceNucleicAcidSubunit
+func (r *SubstanceNucleicAcidSubunit) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "subunit":
+			if v, ok := d.int(); ok {
+				r.Subunit = &v
+			} else {
+				r.Subunit = nil
+			}
+		case "_subunit":
+			r.SubunitElement = d.element()
+		case "sequence":
+			if v, ok := d.string(); ok {
+				r.Sequence = &v
+			} else {
+				r.Sequence = nil
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "length":
+			if v, ok := d.int(); ok {
+				r.Length = &v
+			} else {
+				r.Length = nil
+			}
+		case "_length":
+			r.LengthElement = d.element()
+		case "sequenceAttachment":
+			if d.null() {
+				r.SequenceAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.SequenceAttachment = &v
+			}
+		case "fivePrime":
+			if d.null() {
+				r.FivePrime = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FivePrime = &v
+			}
+		case "threePrime":
+			if d.null() {
+				r.ThreePrime = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ThreePrime = &v
+			}
+		case "linkage":
+			a := d.array()
+			r.Linkage = nil
+			for a.next() {
+				var v SubstanceNucleicAcidSubunitLinkage
+				v.decodeJSON(d)
+				r.Linkage = append(r.Linkage, v)
+			}
+			if r.Linkage == nil && !a.isNull {
+				r.Linkage = []SubstanceNucleicAcidSubunitLinkage{}
+			}
+		case "sugar":
+			a := d.array()
+			r.Sugar = nil
+			for a.next() {
+				var v SubstanceNucleicAcidSubunitSugar
+				v.decodeJSON(d)
+				r.Sugar = append(r.Sugar, v)
+			}
+			if r.Sugar == nil && !a.isNull {
+				r.Sugar = []SubstanceNucleicAcidSubunitSugar{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceNucleicAcidSubunit which shares no memory with the original
+func (r SubstanceNucleicAcidSubunit) DeepCopy() SubstanceNucleicAcidSubunit {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Subunit != nil {
+		v := *r.Subunit
+		out.Subunit = &v
+	}
+	if r.SubunitElement != nil {
+		v := r.SubunitElement.DeepCopy()
+		out.SubunitElement = &v
+	}
+	if r.Sequence != nil {
+		v := *r.Sequence
+		out.Sequence = &v
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.Length != nil {
+		v := *r.Length
+		out.Length = &v
+	}
+	if r.LengthElement != nil {
+		v := r.LengthElement.DeepCopy()
+		out.LengthElement = &v
+	}
+	if r.SequenceAttachment != nil {
+		v := r.SequenceAttachment.DeepCopy()
+		out.SequenceAttachment = &v
+	}
+	if r.FivePrime != nil {
+		v := r.FivePrime.DeepCopy()
+		out.FivePrime = &v
+	}
+	if r.ThreePrime != nil {
+		v := r.ThreePrime.DeepCopy()
+		out.ThreePrime = &v
+	}
+	if r.Linkage != nil {
+		out.Linkage = make([]SubstanceNucleicAcidSubunitLinkage, len(r.Linkage))
+		for i := range r.Linkage {
+			out.Linkage[i] = r.Linkage[i].DeepCopy()
+		}
+	}
+	if r.Sugar != nil {
+		out.Sugar = make([]SubstanceNucleicAcidSubunitSugar, len(r.Sugar))
+		for i := range r.Sugar {
+			out.Sugar[i] = r.Sugar[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceNucleicAcidSubunit) Equal(other SubstanceNucleicAcidSubunit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Subunit == nil) != (other.Subunit == nil) || r.Subunit != nil && *r.Subunit != *other.Subunit {
+		return false
+	}
+	if (r.SubunitElement == nil) != (other.SubunitElement == nil) || r.SubunitElement != nil && !r.SubunitElement.Equal(*other.SubunitElement) {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && *r.Sequence != *other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && *r.Length != *other.Length {
+		return false
+	}
+	if (r.LengthElement == nil) != (other.LengthElement == nil) || r.LengthElement != nil && !r.LengthElement.Equal(*other.LengthElement) {
+		return false
+	}
+	if (r.SequenceAttachment == nil) != (other.SequenceAttachment == nil) || r.SequenceAttachment != nil && !r.SequenceAttachment.Equal(*other.SequenceAttachment) {
+		return false
+	}
+	if (r.FivePrime == nil) != (other.FivePrime == nil) || r.FivePrime != nil && !r.FivePrime.Equal(*other.FivePrime) {
+		return false
+	}
+	if (r.ThreePrime == nil) != (other.ThreePrime == nil) || r.ThreePrime != nil && !r.ThreePrime.Equal(*other.ThreePrime) {
+		return false
+	}
+	if len(r.Linkage) != len(other.Linkage) {
+		return false
+	}
+	for i := range r.Linkage {
+		if !r.Linkage[i].Equal(other.Linkage[i]) {
+			return false
+		}
+	}
+	if len(r.Sugar) != len(other.Sugar) {
+		return false
+	}
+	for i := range r.Sugar {
+		if !r.Sugar[i].Equal(other.Sugar[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceNucleicAcidSubunit) EqualsDeep(other SubstanceNucleicAcidSubunit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Subunit == nil) != (other.Subunit == nil) || r.Subunit != nil && *r.Subunit != *other.Subunit {
+		return false
+	}
+	if (r.SubunitElement == nil) != (other.SubunitElement == nil) || r.SubunitElement != nil && !r.SubunitElement.EqualsDeep(*other.SubunitElement) {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && !equivalentString(*r.Sequence, *other.Sequence) {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && *r.Length != *other.Length {
+		return false
+	}
+	if (r.LengthElement == nil) != (other.LengthElement == nil) || r.LengthElement != nil && !r.LengthElement.EqualsDeep(*other.LengthElement) {
+		return false
+	}
+	if (r.SequenceAttachment == nil) != (other.SequenceAttachment == nil) || r.SequenceAttachment != nil && !r.SequenceAttachment.EqualsDeep(*other.SequenceAttachment) {
+		return false
+	}
+	if (r.FivePrime == nil) != (other.FivePrime == nil) || r.FivePrime != nil && !r.FivePrime.EqualsDeep(*other.FivePrime) {
+		return false
+	}
+	if (r.ThreePrime == nil) != (other.ThreePrime == nil) || r.ThreePrime != nil && !r.ThreePrime.EqualsDeep(*other.ThreePrime) {
+		return false
+	}
+	if !equivalentList(len(r.Linkage), len(other.Linkage), func(i, j int) bool {
+		return r.Linkage[i].EqualsDeep(other.Linkage[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Sugar), len(other.Sugar), func(i, j int) bool {
+		return r.Sugar[i].EqualsDeep(other.Sugar[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceNucleicAcidSubunit) EqualsShallow(other SubstanceNucleicAcidSubunit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Subunit == nil) != (other.Subunit == nil) || r.Subunit != nil && *r.Subunit != *other.Subunit {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && !equivalentString(*r.Sequence, *other.Sequence) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && *r.Length != *other.Length {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceNucleicAcidSubunit as FHIR XML
+func (r SubstanceNucleicAcidSubunit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "subunit", r.Subunit, r.SubunitElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "length", r.Length, r.LengthElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sequenceAttachment", r.SequenceAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "fivePrime", r.FivePrime); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "threePrime", r.ThreePrime); err != nil {
+		return err
+	}
+	for _, v := range r.Linkage {
+		if err := encodeXMLElement(e, "linkage", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Sugar {
+		if err := encodeXMLElement(e, "sugar", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceNucleicAcidSubunit from FHIR XML
+func (r *SubstanceNucleicAcidSubunit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "subunit":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subunit = &v
+				}
+				r.SubunitElement = element
+			case "sequence":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = &v
+				}
+				r.SequenceElement = element
+			case "length":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Length = &v
+				}
+				r.LengthElement = element
+			case "sequenceAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SequenceAttachment = &v
+			case "fivePrime":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FivePrime = &v
+			case "threePrime":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ThreePrime = &v
+			case "linkage":
+				var v SubstanceNucleicAcidSubunitLinkage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Linkage = append(r.Linkage, v)
+			case "sugar":
+				var v SubstanceNucleicAcidSubunitSugar
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Sugar = append(r.Sugar, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceNucleicAcidSubunit as properties to the RDF node n
+func (r SubstanceNucleicAcidSubunit) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("SubstanceNucleicAcid.subunit.subunit", -1, r.Subunit, r.SubunitElement, "integer")
+	n.primitive("SubstanceNucleicAcid.subunit.sequence", -1, r.Sequence, r.SequenceElement, "string")
+	n.primitive("SubstanceNucleicAcid.subunit.length", -1, r.Length, r.LengthElement, "integer")
+	n.element("SubstanceNucleicAcid.subunit.sequenceAttachment", -1, r.SequenceAttachment)
+	n.element("SubstanceNucleicAcid.subunit.fivePrime", -1, r.FivePrime)
+	n.element("SubstanceNucleicAcid.subunit.threePrime", -1, r.ThreePrime)
+	for i, v := range r.Linkage {
+		n.element("SubstanceNucleicAcid.subunit.linkage", i, v)
+	}
+	for i, v := range r.Sugar {
+		n.element("SubstanceNucleicAcid.subunit.sugar", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceNucleicAcidSubunit as BSON document with the structure of its FHIR JSON
+func (r SubstanceNucleicAcidSubunit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceNucleicAcidSubunit from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceNucleicAcidSubunit) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceNucleicAcidSubunit as protobuf message SubstanceNucleicAcidSubunit of fhir.proto
+func (r SubstanceNucleicAcidSubunit) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceNucleicAcidSubunit to the encoded message
+func (r SubstanceNucleicAcidSubunit) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Subunit != nil {
+		e.varint(4, uint64(int64(*r.Subunit)))
+	}
+	if r.Sequence != nil {
+		e.string(5, *r.Sequence)
+	}
+	if r.Length != nil {
+		e.varint(6, uint64(int64(*r.Length)))
+	}
+	if r.SequenceAttachment != nil {
+		e.message(7, *r.SequenceAttachment)
+	}
+	if r.FivePrime != nil {
+		e.message(8, *r.FivePrime)
+	}
+	if r.ThreePrime != nil {
+		e.message(9, *r.ThreePrime)
+	}
+	for _, v := range r.Linkage {
+		e.message(10, v)
+	}
+	for _, v := range r.Sugar {
+		e.message(11, v)
+	}
+	if r.SubunitElement != nil {
+		e.message(12, *r.SubunitElement)
+	}
+	if r.SequenceElement != nil {
+		e.message(13, *r.SequenceElement)
+	}
+	if r.LengthElement != nil {
+		e.message(14, *r.LengthElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceNucleicAcidSubunit from protobuf message SubstanceNucleicAcidSubunit of fhir.proto
+func (r *SubstanceNucleicAcidSubunit) UnmarshalProto(b []byte) error {
+	*r = SubstanceNucleicAcidSubunit{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Subunit = &v
+		case 5:
+			v := d.string()
+			r.Sequence = &v
+		case 6:
+			v := d.int()
+			r.Length = &v
+		case 7:
+			var v Attachment
+			d.message(&v)
+			r.SequenceAttachment = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.FivePrime = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.ThreePrime = &v
+		case 10:
+			var v SubstanceNucleicAcidSubunitLinkage
+			d.message(&v)
+			r.Linkage = append(r.Linkage, v)
+		case 11:
+			var v SubstanceNucleicAcidSubunitSugar
+			d.message(&v)
+			r.Sugar = append(r.Sugar, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.SubunitElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.LengthElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceNucleicAcidSubunitLinkage as JSON into a byte slice
+func (r SubstanceNucleicAcidSubunitLinkage) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceNucleicAcidSubunitLinkage as JSON object with the members in the order of the fields
+func (r SubstanceNucleicAcidSubunitLinkage) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Connectivity != nil {
+		e.key("connectivity")
+		e.string(*r.Connectivity)
+	}
+	if r.ConnectivityElement != nil {
+		e.key("_connectivity")
+		r.ConnectivityElement.appendJSON(e)
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.ResidueSite != nil {
+		e.key("residueSite")
+		e.string(*r.ResidueSite)
+	}
+	if r.ResidueSiteElement != nil {
+		e.key("_residueSite")
+		r.ResidueSiteElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceNucleicAcidSubunitLinkage from JSON. Unknown members are ignored.
+func (r *SubstanceNucleicAcidSubunitLinkage) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceNucleicAcidSubunitLinkage
+func (r *SubstanceNucleicAcidSubunitLinkage) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "connectivity":
+			if v, ok := d.string(); ok {
+				r.Connectivity = &v
+			} else {
+				r.Connectivity = nil
+			}
+		case "_connectivity":
+			r.ConnectivityElement = d.element()
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "residueSite":
+			if v, ok := d.string(); ok {
+				r.ResidueSite = &v
+			} else {
+				r.ResidueSite = nil
+			}
+		case "_residueSite":
+			r.ResidueSiteElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceNucleicAcidSubunitLinkage which shares no memory with the original
+func (r SubstanceNucleicAcidSubunitLinkage) DeepCopy() SubstanceNucleicAcidSubunitLinkage {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Connectivity != nil {
+		v := *r.Connectivity
+		out.Connectivity = &v
+	}
+	if r.ConnectivityElement != nil {
+		v := r.ConnectivityElement.DeepCopy()
+		out.ConnectivityElement = &v
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.ResidueSite != nil {
+		v := *r.ResidueSite
+		out.ResidueSite = &v
+	}
+	if r.ResidueSiteElement != nil {
+		v := r.ResidueSiteElement.DeepCopy()
+		out.ResidueSiteElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceNucleicAcidSubunitLinkage) Equal(other SubstanceNucleicAcidSubunitLinkage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Connectivity == nil) != (other.Connectivity == nil) || r.Connectivity != nil && *r.Connectivity != *other.Connectivity {
+		return false
+	}
+	if (r.ConnectivityElement == nil) != (other.ConnectivityElement == nil) || r.ConnectivityElement != nil && !r.ConnectivityElement.Equal(*other.ConnectivityElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.ResidueSite == nil) != (other.ResidueSite == nil) || r.ResidueSite != nil && *r.ResidueSite != *other.ResidueSite {
+		return false
+	}
+	if (r.ResidueSiteElement == nil) != (other.ResidueSiteElement == nil) || r.ResidueSiteElement != nil && !r.ResidueSiteElement.Equal(*other.ResidueSiteElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceNucleicAcidSubunitLinkage) EqualsDeep(other SubstanceNucleicAcidSubunitLinkage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Connectivity == nil) != (other.Connectivity == nil) || r.Connectivity != nil && !equivalentString(*r.Connectivity, *other.Connectivity) {
+		return false
+	}
+	if (r.ConnectivityElement == nil) != (other.ConnectivityElement == nil) || r.ConnectivityElement != nil && !r.ConnectivityElement.EqualsDeep(*other.ConnectivityElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.ResidueSite == nil) != (other.ResidueSite == nil) || r.ResidueSite != nil && !equivalentString(*r.ResidueSite, *other.ResidueSite) {
+		return false
+	}
+	if (r.ResidueSiteElement == nil) != (other.ResidueSiteElement == nil) || r.ResidueSiteElement != nil && !r.ResidueSiteElement.EqualsDeep(*other.ResidueSiteElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceNucleicAcidSubunitLinkage) EqualsShallow(other SubstanceNucleicAcidSubunitLinkage) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Connectivity == nil) != (other.Connectivity == nil) || r.Connectivity != nil && !equivalentString(*r.Connectivity, *other.Connectivity) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.ResidueSite == nil) != (other.ResidueSite == nil) || r.ResidueSite != nil && !equivalentString(*r.ResidueSite, *other.ResidueSite) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceNucleicAcidSubunitLinkage as FHIR XML
+func (r SubstanceNucleicAcidSubunitLinkage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "connectivity", r.Connectivity, r.ConnectivityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "residueSite", r.ResidueSite, r.ResidueSiteElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceNucleicAcidSubunitLinkage from FHIR XML
+func (r *SubstanceNucleicAcidSubunitLinkage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "connectivity":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Connectivity = &v
+				}
+				r.ConnectivityElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "residueSite":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ResidueSite = &v
+				}
+				r.ResidueSiteElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceNucleicAcidSubunitLinkage as properties to the RDF node n
+func (r SubstanceNucleicAcidSubunitLinkage) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("SubstanceNucleicAcid.subunit.linkage.connectivity", -1, r.Connectivity, r.ConnectivityElement, "string")
+	n.element("SubstanceNucleicAcid.subunit.linkage.identifier", -1, r.Identifier)
+	n.primitive("SubstanceNucleicAcid.subunit.linkage.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("SubstanceNucleicAcid.subunit.linkage.residueSite", -1, r.ResidueSite, r.ResidueSiteElement, "string")
+}
+
+// MarshalBSON marshals the given SubstanceNucleicAcidSubunitLinkage as BSON document with the structure of its FHIR JSON
+func (r SubstanceNucleicAcidSubunitLinkage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceNucleicAcidSubunitLinkage from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceNucleicAcidSubunitLinkage) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceNucleicAcidSubunitLinkage as protobuf message SubstanceNucleicAcidSubunitLinkage of fhir.proto
+func (r SubstanceNucleicAcidSubunitLinkage) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceNucleicAcidSubunitLinkage to the encoded message
+func (r SubstanceNucleicAcidSubunitLinkage) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Connectivity != nil {
+		e.string(4, *r.Connectivity)
+	}
+	if r.Identifier != nil {
+		e.message(5, *r.Identifier)
+	}
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.ResidueSite != nil {
+		e.string(7, *r.ResidueSite)
+	}
+	if r.ConnectivityElement != nil {
+		e.message(8, *r.ConnectivityElement)
+	}
+	if r.NameElement != nil {
+		e.message(9, *r.NameElement)
+	}
+	if r.ResidueSiteElement != nil {
+		e.message(10, *r.ResidueSiteElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceNucleicAcidSubunitLinkage from protobuf message SubstanceNucleicAcidSubunitLinkage of fhir.proto
+func (r *SubstanceNucleicAcidSubunitLinkage) UnmarshalProto(b []byte) error {
+	*r = SubstanceNucleicAcidSubunitLinkage{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Connectivity = &v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			v := d.string()
+			r.ResidueSite = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ConnectivityElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.ResidueSiteElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceNucleicAcidSubunitSugar as JSON into a byte slice
+func (r SubstanceNucleicAcidSubunitSugar) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceNucleicAcidSubunitSugar as JSON object with the members in the order of the fields
+func (r SubstanceNucleicAcidSubunitSugar) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.ResidueSite != nil {
+		e.key("residueSite")
+		e.string(*r.ResidueSite)
+	}
+	if r.ResidueSiteElement != nil {
+		e.key("_residueSite")
+		r.ResidueSiteElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceNucleicAcidSubunitSugar from JSON. Unknown members are ignored.
+func (r *SubstanceNucleicAcidSubunitSugar) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceNucleicAcidSubunitSugar
+func (r *SubstanceNucleicAcidSubunitSugar) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "residueSite":
+			if v, ok := d.string(); ok {
+				r.ResidueSite = &v
+			} else {
+				r.ResidueSite = nil
+			}
+		case "_residueSite":
+			r.ResidueSiteElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceNucleicAcidSubunitSugar which shares no memory with the original
+func (r SubstanceNucleicAcidSubunitSugar) DeepCopy() SubstanceNucleicAcidSubunitSugar {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.ResidueSite != nil {
+		v := *r.ResidueSite
+		out.ResidueSite = &v
+	}
+	if r.ResidueSiteElement != nil {
+		v := r.ResidueSiteElement.DeepCopy()
+		out.ResidueSiteElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceNucleicAcidSubunitSugar) Equal(other SubstanceNucleicAcidSubunitSugar) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.ResidueSite == nil) != (other.ResidueSite == nil) || r.ResidueSite != nil && *r.ResidueSite != *other.ResidueSite {
+		return false
+	}
+	if (r.ResidueSiteElement == nil) != (other.ResidueSiteElement == nil) || r.ResidueSiteElement != nil && !r.ResidueSiteElement.Equal(*other.ResidueSiteElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceNucleicAcidSubunitSugar) EqualsDeep(other SubstanceNucleicAcidSubunitSugar) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.ResidueSite == nil) != (other.ResidueSite == nil) || r.ResidueSite != nil && !equivalentString(*r.ResidueSite, *other.ResidueSite) {
+		return false
+	}
+	if (r.ResidueSiteElement == nil) != (other.ResidueSiteElement == nil) || r.ResidueSiteElement != nil && !r.ResidueSiteElement.EqualsDeep(*other.ResidueSiteElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceNucleicAcidSubunitSugar) EqualsShallow(other SubstanceNucleicAcidSubunitSugar) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.ResidueSite == nil) != (other.ResidueSite == nil) || r.ResidueSite != nil && !equivalentString(*r.ResidueSite, *other.ResidueSite) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceNucleicAcidSubunitSugar as FHIR XML
+func (r SubstanceNucleicAcidSubunitSugar) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "residueSite", r.ResidueSite, r.ResidueSiteElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceNucleicAcidSubunitSugar from FHIR XML
+func (r *SubstanceNucleicAcidSubunitSugar) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "residueSite":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ResidueSite = &v
+				}
+				r.ResidueSiteElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceNucleicAcidSubunitSugar as properties to the RDF node n
+func (r SubstanceNucleicAcidSubunitSugar) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceNucleicAcid.subunit.sugar.identifier", -1, r.Identifier)
+	n.primitive("SubstanceNucleicAcid.subunit.sugar.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("SubstanceNucleicAcid.subunit.sugar.residueSite", -1, r.ResidueSite, r.ResidueSiteElement, "string")
+}
+
+// MarshalBSON marshals the given SubstanceNucleicAcidSubunitSugar as BSON document with the structure of its FHIR JSON
+func (r SubstanceNucleicAcidSubunitSugar) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceNucleicAcidSubunitSugar from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceNucleicAcidSubunitSugar) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceNucleicAcidSubunitSugar as protobuf message SubstanceNucleicAcidSubunitSugar of fhir.proto
+func (r SubstanceNucleicAcidSubunitSugar) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceNucleicAcidSubunitSugar to the encoded message
+func (r SubstanceNucleicAcidSubunitSugar) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identifier != nil {
+		e.message(4, *r.Identifier)
+	}
+	if r.Name != nil {
+		e.string(5, *r.Name)
+	}
+	if r.ResidueSite != nil {
+		e.string(6, *r.ResidueSite)
+	}
+	if r.NameElement != nil {
+		e.message(7, *r.NameElement)
+	}
+	if r.ResidueSiteElement != nil {
+		e.message(8, *r.ResidueSiteElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceNucleicAcidSubunitSugar from protobuf message SubstanceNucleicAcidSubunitSugar of fhir.proto
+func (r *SubstanceNucleicAcidSubunitSugar) UnmarshalProto(b []byte) error {
+	*r = SubstanceNucleicAcidSubunitSugar{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 5:
+			v := d.string()
+			r.Name = &v
+		case 6:
+			v := d.string()
+			r.ResidueSite = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.ResidueSiteElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSubstanceNucleicAcid unmarshals a SubstanceNucleicAcid.
 func UnmarshalSubstanceNucleicAcid(b []byte) (SubstanceNucleicAcid, error) {
 	var substanceNucleicAcid SubstanceNucleicAcid
-	if err := json.Unmarshal(b, &substanceNucleicAcid); err != nil {
+	if err := substanceNucleicAcid.UnmarshalJSON(b); err != nil {
 		return substanceNucleicAcid, err
 	}
 	return substanceNucleicAcid, nil
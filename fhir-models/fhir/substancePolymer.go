@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,16 +25,21 @@ import "encoding/json"
 // SubstancePolymer is documented here http://hl7.org/fhir/StructureDefinition/SubstancePolymer
 type SubstancePolymer struct {
 	Id                    *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                     `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                  *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules         *string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language              *string                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                     `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                  *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage            `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension             []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension     []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Class                 *CodeableConcept             `bson:"class,omitempty" json:"class,omitempty"`
 	Geometry              *CodeableConcept             `bson:"geometry,omitempty" json:"geometry,omitempty"`
 	CopolymerConnectivity []CodeableConcept            `bson:"copolymerConnectivity,omitempty" json:"copolymerConnectivity,omitempty"`
 	Modification          []string                     `bson:"modification,omitempty" json:"modification,omitempty"`
+	ModificationElement   []*Element                   `bson:"_modification,omitempty" json:"_modification,omitempty"`
 	MonomerSet            []SubstancePolymerMonomerSet `bson:"monomerSet,omitempty" json:"monomerSet,omitempty"`
 	Repeat                []SubstancePolymerRepeat     `bson:"repeat,omitempty" json:"repeat,omitempty"`
 }
@@ -49,16 +57,19 @@ type SubstancePolymerMonomerSetStartingMaterial struct {
 	Material          *CodeableConcept `bson:"material,omitempty" json:"material,omitempty"`
 	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
 	IsDefining        *bool            `bson:"isDefining,omitempty" json:"isDefining,omitempty"`
+	IsDefiningElement *Element         `bson:"_isDefining,omitempty" json:"_isDefining,omitempty"`
 	Amount            *SubstanceAmount `bson:"amount,omitempty" json:"amount,omitempty"`
 }
 type SubstancePolymerRepeat struct {
-	Id                      *string                            `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	NumberOfUnits           *int                               `bson:"numberOfUnits,omitempty" json:"numberOfUnits,omitempty"`
-	AverageMolecularFormula *string                            `bson:"averageMolecularFormula,omitempty" json:"averageMolecularFormula,omitempty"`
-	RepeatUnitAmountType    *CodeableConcept                   `bson:"repeatUnitAmountType,omitempty" json:"repeatUnitAmountType,omitempty"`
-	RepeatUnit              []SubstancePolymerRepeatRepeatUnit `bson:"repeatUnit,omitempty" json:"repeatUnit,omitempty"`
+	Id                             *string                            `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                      []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension              []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	NumberOfUnits                  *int                               `bson:"numberOfUnits,omitempty" json:"numberOfUnits,omitempty"`
+	NumberOfUnitsElement           *Element                           `bson:"_numberOfUnits,omitempty" json:"_numberOfUnits,omitempty"`
+	AverageMolecularFormula        *string                            `bson:"averageMolecularFormula,omitempty" json:"averageMolecularFormula,omitempty"`
+	AverageMolecularFormulaElement *Element                           `bson:"_averageMolecularFormula,omitempty" json:"_averageMolecularFormula,omitempty"`
+	RepeatUnitAmountType           *CodeableConcept                   `bson:"repeatUnitAmountType,omitempty" json:"repeatUnitAmountType,omitempty"`
+	RepeatUnit                     []SubstancePolymerRepeatRepeatUnit `bson:"repeatUnit,omitempty" json:"repeatUnit,omitempty"`
 }
 type SubstancePolymerRepeatRepeatUnit struct {
 	Id                          *string                                                    `bson:"id,omitempty" json:"id,omitempty"`
@@ -66,6 +77,7 @@ type SubstancePolymerRepeatRepeatUnit struct {
 	ModifierExtension           []Extension                                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	OrientationOfPolymerisation *CodeableConcept                                           `bson:"orientationOfPolymerisation,omitempty" json:"orientationOfPolymerisation,omitempty"`
 	RepeatUnit                  *string                                                    `bson:"repeatUnit,omitempty" json:"repeatUnit,omitempty"`
+	RepeatUnitElement           *Element                                                   `bson:"_repeatUnit,omitempty" json:"_repeatUnit,omitempty"`
 	Amount                      *SubstanceAmount                                           `bson:"amount,omitempty" json:"amount,omitempty"`
 	DegreeOfPolymerisation      []SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation   `bson:"degreeOfPolymerisation,omitempty" json:"degreeOfPolymerisation,omitempty"`
 	StructuralRepresentation    []SubstancePolymerRepeatRepeatUnitStructuralRepresentation `bson:"structuralRepresentation,omitempty" json:"structuralRepresentation,omitempty"`
@@ -78,30 +90,3660 @@ type SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation struct {
 	Amount            *SubstanceAmount `bson:"amount,omitempty" json:"amount,omitempty"`
 }
 type SubstancePolymerRepeatRepeatUnitStructuralRepresentation struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Representation    *string          `bson:"representation,omitempty" json:"representation,omitempty"`
-	Attachment        *Attachment      `bson:"attachment,omitempty" json:"attachment,omitempty"`
+	Id                    *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                  *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	Representation        *string          `bson:"representation,omitempty" json:"representation,omitempty"`
+	RepresentationElement *Element         `bson:"_representation,omitempty" json:"_representation,omitempty"`
+	Attachment            *Attachment      `bson:"attachment,omitempty" json:"attachment,omitempty"`
 }
-type OtherSubstancePolymer SubstancePolymer
 
 // MarshalJSON marshals the given SubstancePolymer as JSON into a byte slice
 func (r SubstancePolymer) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSubstancePolymer
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSubstancePolymer: OtherSubstancePolymer(r),
-		ResourceType:          "SubstancePolymer",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstancePolymer as JSON object with the members in the order of the fields
+func (r SubstancePolymer) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"SubstancePolymer\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Class != nil {
+		e.key("class")
+		r.Class.appendJSON(e)
+	}
+	if r.Geometry != nil {
+		e.key("geometry")
+		r.Geometry.appendJSON(e)
+	}
+	if len(r.CopolymerConnectivity) > 0 {
+		e.key("copolymerConnectivity")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CopolymerConnectivity {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Modification) > 0 {
+		e.key("modification")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Modification {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModificationElement) > 0 {
+		e.key("_modification")
+		e.elements(r.ModificationElement, len(r.Modification))
+	}
+	if len(r.MonomerSet) > 0 {
+		e.key("monomerSet")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.MonomerSet {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Repeat) > 0 {
+		e.key("repeat")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Repeat {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstancePolymer from JSON. Unknown members are ignored.
+func (r *SubstancePolymer) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstancePolymer
+func (r *SubstancePolymer) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "class":
+			if d.null() {
+				r.Class = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Class = &v
+			}
+		case "geometry":
+			if d.null() {
+				r.Geometry = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Geometry = &v
+			}
+		case "copolymerConnectivity":
+			a := d.array()
+			r.CopolymerConnectivity = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CopolymerConnectivity = append(r.CopolymerConnectivity, v)
+			}
+			if r.CopolymerConnectivity == nil && !a.isNull {
+				r.CopolymerConnectivity = []CodeableConcept{}
+			}
+		case "modification":
+			a := d.array()
+			r.Modification = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Modification = append(r.Modification, v)
+			}
+			if r.Modification == nil && !a.isNull {
+				r.Modification = []string{}
+			}
+		case "_modification":
+			a := d.array()
+			r.ModificationElement = nil
+			for a.next() {
+				r.ModificationElement = append(r.ModificationElement, d.element())
+			}
+		case "monomerSet":
+			a := d.array()
+			r.MonomerSet = nil
+			for a.next() {
+				var v SubstancePolymerMonomerSet
+				v.decodeJSON(d)
+				r.MonomerSet = append(r.MonomerSet, v)
+			}
+			if r.MonomerSet == nil && !a.isNull {
+				r.MonomerSet = []SubstancePolymerMonomerSet{}
+			}
+		case "repeat":
+			a := d.array()
+			r.Repeat = nil
+			for a.next() {
+				var v SubstancePolymerRepeat
+				v.decodeJSON(d)
+				r.Repeat = append(r.Repeat, v)
+			}
+			if r.Repeat == nil && !a.isNull {
+				r.Repeat = []SubstancePolymerRepeat{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstancePolymer which shares no memory with the original
+func (r SubstancePolymer) DeepCopy() SubstancePolymer {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Class != nil {
+		v := r.Class.DeepCopy()
+		out.Class = &v
+	}
+	if r.Geometry != nil {
+		v := r.Geometry.DeepCopy()
+		out.Geometry = &v
+	}
+	if r.CopolymerConnectivity != nil {
+		out.CopolymerConnectivity = make([]CodeableConcept, len(r.CopolymerConnectivity))
+		for i := range r.CopolymerConnectivity {
+			out.CopolymerConnectivity[i] = r.CopolymerConnectivity[i].DeepCopy()
+		}
+	}
+	if r.Modification != nil {
+		out.Modification = make([]string, len(r.Modification))
+		copy(out.Modification, r.Modification)
+	}
+	if r.ModificationElement != nil {
+		out.ModificationElement = make([]*Element, len(r.ModificationElement))
+		for i, v := range r.ModificationElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ModificationElement[i] = &c
+			}
+		}
+	}
+	if r.MonomerSet != nil {
+		out.MonomerSet = make([]SubstancePolymerMonomerSet, len(r.MonomerSet))
+		for i := range r.MonomerSet {
+			out.MonomerSet[i] = r.MonomerSet[i].DeepCopy()
+		}
+	}
+	if r.Repeat != nil {
+		out.Repeat = make([]SubstancePolymerRepeat, len(r.Repeat))
+		for i := range r.Repeat {
+			out.Repeat[i] = r.Repeat[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstancePolymer) Equal(other SubstancePolymer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Class == nil) != (other.Class == nil) || r.Class != nil && !r.Class.Equal(*other.Class) {
+		return false
+	}
+	if (r.Geometry == nil) != (other.Geometry == nil) || r.Geometry != nil && !r.Geometry.Equal(*other.Geometry) {
+		return false
+	}
+	if len(r.CopolymerConnectivity) != len(other.CopolymerConnectivity) {
+		return false
+	}
+	for i := range r.CopolymerConnectivity {
+		if !r.CopolymerConnectivity[i].Equal(other.CopolymerConnectivity[i]) {
+			return false
+		}
+	}
+	if len(r.Modification) != len(other.Modification) {
+		return false
+	}
+	for i := range r.Modification {
+		if r.Modification[i] != other.Modification[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ModificationElement, other.ModificationElement, false) {
+		return false
+	}
+	if len(r.MonomerSet) != len(other.MonomerSet) {
+		return false
+	}
+	for i := range r.MonomerSet {
+		if !r.MonomerSet[i].Equal(other.MonomerSet[i]) {
+			return false
+		}
+	}
+	if len(r.Repeat) != len(other.Repeat) {
+		return false
+	}
+	for i := range r.Repeat {
+		if !r.Repeat[i].Equal(other.Repeat[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstancePolymer) EqualsDeep(other SubstancePolymer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Class == nil) != (other.Class == nil) || r.Class != nil && !r.Class.EqualsDeep(*other.Class) {
+		return false
+	}
+	if (r.Geometry == nil) != (other.Geometry == nil) || r.Geometry != nil && !r.Geometry.EqualsDeep(*other.Geometry) {
+		return false
+	}
+	if !equivalentList(len(r.CopolymerConnectivity), len(other.CopolymerConnectivity), func(i, j int) bool {
+		return r.CopolymerConnectivity[i].EqualsDeep(other.CopolymerConnectivity[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Modification), len(other.Modification), func(i, j int) bool {
+		return equivalentString(r.Modification[i], other.Modification[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ModificationElement, other.ModificationElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.MonomerSet), len(other.MonomerSet), func(i, j int) bool {
+		return r.MonomerSet[i].EqualsDeep(other.MonomerSet[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Repeat), len(other.Repeat), func(i, j int) bool {
+		return r.Repeat[i].EqualsDeep(other.Repeat[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstancePolymer) EqualsShallow(other SubstancePolymer) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.Modification), len(other.Modification), func(i, j int) bool {
+		return equivalentString(r.Modification[i], other.Modification[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstancePolymer as FHIR XML
+func (r SubstancePolymer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "SubstancePolymer",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "class", r.Class); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "geometry", r.Geometry); err != nil {
+		return err
+	}
+	for _, v := range r.CopolymerConnectivity {
+		if err := encodeXMLElement(e, "copolymerConnectivity", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Modification {
+		if err := encodeXMLPrimitive(e, "modification", v, elementAt(r.ModificationElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.MonomerSet {
+		if err := encodeXMLElement(e, "monomerSet", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Repeat {
+		if err := encodeXMLElement(e, "repeat", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstancePolymer from FHIR XML
+func (r *SubstancePolymer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "class":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Class = &v
+			case "geometry":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Geometry = &v
+			case "copolymerConnectivity":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CopolymerConnectivity = append(r.CopolymerConnectivity, v)
+			case "modification":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ModificationElement = appendElement(r.ModificationElement, len(r.Modification), element)
+					r.Modification = append(r.Modification, v)
+				}
+			case "monomerSet":
+				var v SubstancePolymerMonomerSet
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MonomerSet = append(r.MonomerSet, v)
+			case "repeat":
+				var v SubstancePolymerRepeat
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Repeat = append(r.Repeat, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstancePolymer as properties to the RDF node n
+func (r SubstancePolymer) turtle(n *turtleNode) {
+	n.resource("SubstancePolymer", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("SubstancePolymer.class", -1, r.Class)
+	n.element("SubstancePolymer.geometry", -1, r.Geometry)
+	for i, v := range r.CopolymerConnectivity {
+		n.element("SubstancePolymer.copolymerConnectivity", i, v)
+	}
+	for i, v := range r.Modification {
+		n.primitive("SubstancePolymer.modification", i, v, elementAt(r.ModificationElement, i), "string")
+	}
+	for i, v := range r.MonomerSet {
+		n.element("SubstancePolymer.monomerSet", i, v)
+	}
+	for i, v := range r.Repeat {
+		n.element("SubstancePolymer.repeat", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstancePolymer as BSON document with the structure of its FHIR JSON
+func (r SubstancePolymer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstancePolymer from a BSON document with the structure of its FHIR JSON
+func (r *SubstancePolymer) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstancePolymer as protobuf message SubstancePolymer of fhir.proto
+func (r SubstancePolymer) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstancePolymer to the encoded message
+func (r SubstancePolymer) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Class != nil {
+		e.message(9, *r.Class)
+	}
+	if r.Geometry != nil {
+		e.message(10, *r.Geometry)
+	}
+	for _, v := range r.CopolymerConnectivity {
+		e.message(11, v)
+	}
+	for _, v := range r.Modification {
+		e.string(12, v)
+	}
+	for _, v := range r.MonomerSet {
+		e.message(13, v)
+	}
+	for _, v := range r.Repeat {
+		e.message(14, v)
+	}
+	if r.IdElement != nil {
+		e.message(15, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(16, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(17, *r.LanguageElement)
+	}
+	for _, v := range r.ModificationElement {
+		e.element(18, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstancePolymer from protobuf message SubstancePolymer of fhir.proto
+func (r *SubstancePolymer) UnmarshalProto(b []byte) error {
+	*r = SubstancePolymer{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Class = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Geometry = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.CopolymerConnectivity = append(r.CopolymerConnectivity, v)
+		case 12:
+			r.Modification = append(r.Modification, d.string())
+		case 13:
+			var v SubstancePolymerMonomerSet
+			d.message(&v)
+			r.MonomerSet = append(r.MonomerSet, v)
+		case 14:
+			var v SubstancePolymerRepeat
+			d.message(&v)
+			r.Repeat = append(r.Repeat, v)
+		case 15:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 18:
+			r.ModificationElement = append(r.ModificationElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstancePolymerMonomerSet as JSON into a byte slice
+func (r SubstancePolymerMonomerSet) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstancePolymerMonomerSet as JSON object with the members in the order of the fields
+func (r SubstancePolymerMonomerSet) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.RatioType != nil {
+		e.key("ratioType")
+		r.RatioType.appendJSON(e)
+	}
+	if len(r.StartingMaterial) > 0 {
+		e.key("startingMaterial")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.StartingMaterial {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstancePolymerMonomerSet from JSON. Unknown members are ignored.
+func (r *SubstancePolymerMonomerSet) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstancePolymerMonomerSet
+func (r *SubstancePolymerMonomerSet) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "ratioType":
+			if d.null() {
+				r.RatioType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.RatioType = &v
+			}
+		case "startingMaterial":
+			a := d.array()
+			r.StartingMaterial = nil
+			for a.next() {
+				var v SubstancePolymerMonomerSetStartingMaterial
+				v.decodeJSON(d)
+				r.StartingMaterial = append(r.StartingMaterial, v)
+			}
+			if r.StartingMaterial == nil && !a.isNull {
+				r.StartingMaterial = []SubstancePolymerMonomerSetStartingMaterial{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstancePolymerMonomerSet which shares no memory with the original
+func (r SubstancePolymerMonomerSet) DeepCopy() SubstancePolymerMonomerSet {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.RatioType != nil {
+		v := r.RatioType.DeepCopy()
+		out.RatioType = &v
+	}
+	if r.StartingMaterial != nil {
+		out.StartingMaterial = make([]SubstancePolymerMonomerSetStartingMaterial, len(r.StartingMaterial))
+		for i := range r.StartingMaterial {
+			out.StartingMaterial[i] = r.StartingMaterial[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstancePolymerMonomerSet) Equal(other SubstancePolymerMonomerSet) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.RatioType == nil) != (other.RatioType == nil) || r.RatioType != nil && !r.RatioType.Equal(*other.RatioType) {
+		return false
+	}
+	if len(r.StartingMaterial) != len(other.StartingMaterial) {
+		return false
+	}
+	for i := range r.StartingMaterial {
+		if !r.StartingMaterial[i].Equal(other.StartingMaterial[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstancePolymerMonomerSet) EqualsDeep(other SubstancePolymerMonomerSet) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.RatioType == nil) != (other.RatioType == nil) || r.RatioType != nil && !r.RatioType.EqualsDeep(*other.RatioType) {
+		return false
+	}
+	if !equivalentList(len(r.StartingMaterial), len(other.StartingMaterial), func(i, j int) bool {
+		return r.StartingMaterial[i].EqualsDeep(other.StartingMaterial[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstancePolymerMonomerSet) EqualsShallow(other SubstancePolymerMonomerSet) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstancePolymerMonomerSet as FHIR XML
+func (r SubstancePolymerMonomerSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "ratioType", r.RatioType); err != nil {
+		return err
+	}
+	for _, v := range r.StartingMaterial {
+		if err := encodeXMLElement(e, "startingMaterial", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstancePolymerMonomerSet from FHIR XML
+func (r *SubstancePolymerMonomerSet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "ratioType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RatioType = &v
+			case "startingMaterial":
+				var v SubstancePolymerMonomerSetStartingMaterial
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StartingMaterial = append(r.StartingMaterial, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstancePolymerMonomerSet as properties to the RDF node n
+func (r SubstancePolymerMonomerSet) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstancePolymer.monomerSet.ratioType", -1, r.RatioType)
+	for i, v := range r.StartingMaterial {
+		n.element("SubstancePolymer.monomerSet.startingMaterial", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstancePolymerMonomerSet as BSON document with the structure of its FHIR JSON
+func (r SubstancePolymerMonomerSet) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstancePolymerMonomerSet from a BSON document with the structure of its FHIR JSON
+func (r *SubstancePolymerMonomerSet) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstancePolymerMonomerSet as protobuf message SubstancePolymerMonomerSet of fhir.proto
+func (r SubstancePolymerMonomerSet) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstancePolymerMonomerSet to the encoded message
+func (r SubstancePolymerMonomerSet) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.RatioType != nil {
+		e.message(4, *r.RatioType)
+	}
+	for _, v := range r.StartingMaterial {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstancePolymerMonomerSet from protobuf message SubstancePolymerMonomerSet of fhir.proto
+func (r *SubstancePolymerMonomerSet) UnmarshalProto(b []byte) error {
+	*r = SubstancePolymerMonomerSet{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.RatioType = &v
+		case 5:
+			var v SubstancePolymerMonomerSetStartingMaterial
+			d.message(&v)
+			r.StartingMaterial = append(r.StartingMaterial, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstancePolymerMonomerSetStartingMaterial as JSON into a byte slice
+func (r SubstancePolymerMonomerSetStartingMaterial) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstancePolymerMonomerSetStartingMaterial as JSON object with the members in the order of the fields
+func (r SubstancePolymerMonomerSetStartingMaterial) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Material != nil {
+		e.key("material")
+		r.Material.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.IsDefining != nil {
+		e.key("isDefining")
+		e.bool(*r.IsDefining)
+	}
+	if r.IsDefiningElement != nil {
+		e.key("_isDefining")
+		r.IsDefiningElement.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstancePolymerMonomerSetStartingMaterial from JSON. Unknown members are ignored.
+func (r *SubstancePolymerMonomerSetStartingMaterial) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstancePolymerMonomerSetStartingMaterial
+func (r *SubstancePolymerMonomerSetStartingMaterial) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "material":
+			if d.null() {
+				r.Material = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Material = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "isDefining":
+			if v, ok := d.bool(); ok {
+				r.IsDefining = &v
+			} else {
+				r.IsDefining = nil
+			}
+		case "_isDefining":
+			r.IsDefiningElement = d.element()
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v SubstanceAmount
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstancePolymerMonomerSetStartingMaterial which shares no memory with the original
+func (r SubstancePolymerMonomerSetStartingMaterial) DeepCopy() SubstancePolymerMonomerSetStartingMaterial {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Material != nil {
+		v := r.Material.DeepCopy()
+		out.Material = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.IsDefining != nil {
+		v := *r.IsDefining
+		out.IsDefining = &v
+	}
+	if r.IsDefiningElement != nil {
+		v := r.IsDefiningElement.DeepCopy()
+		out.IsDefiningElement = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstancePolymerMonomerSetStartingMaterial) Equal(other SubstancePolymerMonomerSetStartingMaterial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Material == nil) != (other.Material == nil) || r.Material != nil && !r.Material.Equal(*other.Material) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.IsDefining == nil) != (other.IsDefining == nil) || r.IsDefining != nil && *r.IsDefining != *other.IsDefining {
+		return false
+	}
+	if (r.IsDefiningElement == nil) != (other.IsDefiningElement == nil) || r.IsDefiningElement != nil && !r.IsDefiningElement.Equal(*other.IsDefiningElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstancePolymerMonomerSetStartingMaterial) EqualsDeep(other SubstancePolymerMonomerSetStartingMaterial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Material == nil) != (other.Material == nil) || r.Material != nil && !r.Material.EqualsDeep(*other.Material) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.IsDefining == nil) != (other.IsDefining == nil) || r.IsDefining != nil && *r.IsDefining != *other.IsDefining {
+		return false
+	}
+	if (r.IsDefiningElement == nil) != (other.IsDefiningElement == nil) || r.IsDefiningElement != nil && !r.IsDefiningElement.EqualsDeep(*other.IsDefiningElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstancePolymerMonomerSetStartingMaterial) EqualsShallow(other SubstancePolymerMonomerSetStartingMaterial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IsDefining == nil) != (other.IsDefining == nil) || r.IsDefining != nil && *r.IsDefining != *other.IsDefining {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstancePolymerMonomerSetStartingMaterial as FHIR XML
+func (r SubstancePolymerMonomerSetStartingMaterial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "material", r.Material); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "isDefining", r.IsDefining, r.IsDefiningElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstancePolymerMonomerSetStartingMaterial from FHIR XML
+func (r *SubstancePolymerMonomerSetStartingMaterial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "material":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Material = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "isDefining":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsDefining = &v
+				}
+				r.IsDefiningElement = element
+			case "amount":
+				var v SubstanceAmount
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstancePolymerMonomerSetStartingMaterial as properties to the RDF node n
+func (r SubstancePolymerMonomerSetStartingMaterial) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstancePolymer.monomerSet.startingMaterial.material", -1, r.Material)
+	n.element("SubstancePolymer.monomerSet.startingMaterial.type", -1, r.Type)
+	n.primitive("SubstancePolymer.monomerSet.startingMaterial.isDefining", -1, r.IsDefining, r.IsDefiningElement, "boolean")
+	n.element("SubstancePolymer.monomerSet.startingMaterial.amount", -1, r.Amount)
+}
+
+// MarshalBSON marshals the given SubstancePolymerMonomerSetStartingMaterial as BSON document with the structure of its FHIR JSON
+func (r SubstancePolymerMonomerSetStartingMaterial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstancePolymerMonomerSetStartingMaterial from a BSON document with the structure of its FHIR JSON
+func (r *SubstancePolymerMonomerSetStartingMaterial) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstancePolymerMonomerSetStartingMaterial as protobuf message SubstancePolymerMonomerSetStartingMaterial of fhir.proto
+func (r SubstancePolymerMonomerSetStartingMaterial) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstancePolymerMonomerSetStartingMaterial to the encoded message
+func (r SubstancePolymerMonomerSetStartingMaterial) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Material != nil {
+		e.message(4, *r.Material)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.IsDefining != nil {
+		e.varint(6, protoBool(*r.IsDefining))
+	}
+	if r.Amount != nil {
+		e.message(7, *r.Amount)
+	}
+	if r.IsDefiningElement != nil {
+		e.message(8, *r.IsDefiningElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstancePolymerMonomerSetStartingMaterial from protobuf message SubstancePolymerMonomerSetStartingMaterial of fhir.proto
+func (r *SubstancePolymerMonomerSetStartingMaterial) UnmarshalProto(b []byte) error {
+	*r = SubstancePolymerMonomerSetStartingMaterial{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Material = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			v := d.bool()
+			r.IsDefining = &v
+		case 7:
+			var v SubstanceAmount
+			d.message(&v)
+			r.Amount = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.IsDefiningElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstancePolymerRepeat as JSON into a byte slice
+func (r SubstancePolymerRepeat) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstancePolymerRepeat as JSON object with the members in the order of the fields
+func (r SubstancePolymerRepeat) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.NumberOfUnits != nil {
+		e.key("numberOfUnits")
+		e.int(*r.NumberOfUnits)
+	}
+	if r.NumberOfUnitsElement != nil {
+		e.key("_numberOfUnits")
+		r.NumberOfUnitsElement.appendJSON(e)
+	}
+	if r.AverageMolecularFormula != nil {
+		e.key("averageMolecularFormula")
+		e.string(*r.AverageMolecularFormula)
+	}
+	if r.AverageMolecularFormulaElement != nil {
+		e.key("_averageMolecularFormula")
+		r.AverageMolecularFormulaElement.appendJSON(e)
+	}
+	if r.RepeatUnitAmountType != nil {
+		e.key("repeatUnitAmountType")
+		r.RepeatUnitAmountType.appendJSON(e)
+	}
+	if len(r.RepeatUnit) > 0 {
+		e.key("repeatUnit")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RepeatUnit {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstancePolymerRepeat from JSON. Unknown members are ignored.
+func (r *SubstancePolymerRepeat) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstancePolymerRepeat
+func (r *SubstancePolymerRepeat) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "numberOfUnits":
+			if v, ok := d.int(); ok {
+				r.NumberOfUnits = &v
+			} else {
+				r.NumberOfUnits = nil
+			}
+		case "_numberOfUnits":
+			r.NumberOfUnitsElement = d.element()
+		case "averageMolecularFormula":
+			if v, ok := d.string(); ok {
+				r.AverageMolecularFormula = &v
+			} else {
+				r.AverageMolecularFormula = nil
+			}
+		case "_averageMolecularFormula":
+			r.AverageMolecularFormulaElement = d.element()
+		case "repeatUnitAmountType":
+			if d.null() {
+				r.RepeatUnitAmountType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.RepeatUnitAmountType = &v
+			}
+		case "repeatUnit":
+			a := d.array()
+			r.RepeatUnit = nil
+			for a.next() {
+				var v SubstancePolymerRepeatRepeatUnit
+				v.decodeJSON(d)
+				r.RepeatUnit = append(r.RepeatUnit, v)
+			}
+			if r.RepeatUnit == nil && !a.isNull {
+				r.RepeatUnit = []SubstancePolymerRepeatRepeatUnit{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstancePolymerRepeat which shares no memory with the original
+func (r SubstancePolymerRepeat) DeepCopy() SubstancePolymerRepeat {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NumberOfUnits != nil {
+		v := *r.NumberOfUnits
+		out.NumberOfUnits = &v
+	}
+	if r.NumberOfUnitsElement != nil {
+		v := r.NumberOfUnitsElement.DeepCopy()
+		out.NumberOfUnitsElement = &v
+	}
+	if r.AverageMolecularFormula != nil {
+		v := *r.AverageMolecularFormula
+		out.AverageMolecularFormula = &v
+	}
+	if r.AverageMolecularFormulaElement != nil {
+		v := r.AverageMolecularFormulaElement.DeepCopy()
+		out.AverageMolecularFormulaElement = &v
+	}
+	if r.RepeatUnitAmountType != nil {
+		v := r.RepeatUnitAmountType.DeepCopy()
+		out.RepeatUnitAmountType = &v
+	}
+	if r.RepeatUnit != nil {
+		out.RepeatUnit = make([]SubstancePolymerRepeatRepeatUnit, len(r.RepeatUnit))
+		for i := range r.RepeatUnit {
+			out.RepeatUnit[i] = r.RepeatUnit[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstancePolymerRepeat) Equal(other SubstancePolymerRepeat) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.NumberOfUnits == nil) != (other.NumberOfUnits == nil) || r.NumberOfUnits != nil && *r.NumberOfUnits != *other.NumberOfUnits {
+		return false
+	}
+	if (r.NumberOfUnitsElement == nil) != (other.NumberOfUnitsElement == nil) || r.NumberOfUnitsElement != nil && !r.NumberOfUnitsElement.Equal(*other.NumberOfUnitsElement) {
+		return false
+	}
+	if (r.AverageMolecularFormula == nil) != (other.AverageMolecularFormula == nil) || r.AverageMolecularFormula != nil && *r.AverageMolecularFormula != *other.AverageMolecularFormula {
+		return false
+	}
+	if (r.AverageMolecularFormulaElement == nil) != (other.AverageMolecularFormulaElement == nil) || r.AverageMolecularFormulaElement != nil && !r.AverageMolecularFormulaElement.Equal(*other.AverageMolecularFormulaElement) {
+		return false
+	}
+	if (r.RepeatUnitAmountType == nil) != (other.RepeatUnitAmountType == nil) || r.RepeatUnitAmountType != nil && !r.RepeatUnitAmountType.Equal(*other.RepeatUnitAmountType) {
+		return false
+	}
+	if len(r.RepeatUnit) != len(other.RepeatUnit) {
+		return false
+	}
+	for i := range r.RepeatUnit {
+		if !r.RepeatUnit[i].Equal(other.RepeatUnit[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstancePolymerRepeat) EqualsDeep(other SubstancePolymerRepeat) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.NumberOfUnits == nil) != (other.NumberOfUnits == nil) || r.NumberOfUnits != nil && *r.NumberOfUnits != *other.NumberOfUnits {
+		return false
+	}
+	if (r.NumberOfUnitsElement == nil) != (other.NumberOfUnitsElement == nil) || r.NumberOfUnitsElement != nil && !r.NumberOfUnitsElement.EqualsDeep(*other.NumberOfUnitsElement) {
+		return false
+	}
+	if (r.AverageMolecularFormula == nil) != (other.AverageMolecularFormula == nil) || r.AverageMolecularFormula != nil && !equivalentString(*r.AverageMolecularFormula, *other.AverageMolecularFormula) {
+		return false
+	}
+	if (r.AverageMolecularFormulaElement == nil) != (other.AverageMolecularFormulaElement == nil) || r.AverageMolecularFormulaElement != nil && !r.AverageMolecularFormulaElement.EqualsDeep(*other.AverageMolecularFormulaElement) {
+		return false
+	}
+	if (r.RepeatUnitAmountType == nil) != (other.RepeatUnitAmountType == nil) || r.RepeatUnitAmountType != nil && !r.RepeatUnitAmountType.EqualsDeep(*other.RepeatUnitAmountType) {
+		return false
+	}
+	if !equivalentList(len(r.RepeatUnit), len(other.RepeatUnit), func(i, j int) bool {
+		return r.RepeatUnit[i].EqualsDeep(other.RepeatUnit[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstancePolymerRepeat) EqualsShallow(other SubstancePolymerRepeat) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.NumberOfUnits == nil) != (other.NumberOfUnits == nil) || r.NumberOfUnits != nil && *r.NumberOfUnits != *other.NumberOfUnits {
+		return false
+	}
+	if (r.AverageMolecularFormula == nil) != (other.AverageMolecularFormula == nil) || r.AverageMolecularFormula != nil && !equivalentString(*r.AverageMolecularFormula, *other.AverageMolecularFormula) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstancePolymerRepeat as FHIR XML
+func (r SubstancePolymerRepeat) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "numberOfUnits", r.NumberOfUnits, r.NumberOfUnitsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "averageMolecularFormula", r.AverageMolecularFormula, r.AverageMolecularFormulaElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "repeatUnitAmountType", r.RepeatUnitAmountType); err != nil {
+		return err
+	}
+	for _, v := range r.RepeatUnit {
+		if err := encodeXMLElement(e, "repeatUnit", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstancePolymerRepeat from FHIR XML
+func (r *SubstancePolymerRepeat) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "numberOfUnits":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumberOfUnits = &v
+				}
+				r.NumberOfUnitsElement = element
+			case "averageMolecularFormula":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AverageMolecularFormula = &v
+				}
+				r.AverageMolecularFormulaElement = element
+			case "repeatUnitAmountType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RepeatUnitAmountType = &v
+			case "repeatUnit":
+				var v SubstancePolymerRepeatRepeatUnit
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RepeatUnit = append(r.RepeatUnit, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstancePolymerRepeat as properties to the RDF node n
+func (r SubstancePolymerRepeat) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("SubstancePolymer.repeat.numberOfUnits", -1, r.NumberOfUnits, r.NumberOfUnitsElement, "integer")
+	n.primitive("SubstancePolymer.repeat.averageMolecularFormula", -1, r.AverageMolecularFormula, r.AverageMolecularFormulaElement, "string")
+	n.element("SubstancePolymer.repeat.repeatUnitAmountType", -1, r.RepeatUnitAmountType)
+	for i, v := range r.RepeatUnit {
+		n.element("SubstancePolymer.repeat.repeatUnit", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstancePolymerRepeat as BSON document with the structure of its FHIR JSON
+func (r SubstancePolymerRepeat) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstancePolymerRepeat from a BSON document with the structure of its FHIR JSON
+func (r *SubstancePolymerRepeat) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstancePolymerRepeat as protobuf message SubstancePolymerRepeat of fhir.proto
+func (r SubstancePolymerRepeat) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstancePolymerRepeat to the encoded message
+func (r SubstancePolymerRepeat) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.NumberOfUnits != nil {
+		e.varint(4, uint64(int64(*r.NumberOfUnits)))
+	}
+	if r.AverageMolecularFormula != nil {
+		e.string(5, *r.AverageMolecularFormula)
+	}
+	if r.RepeatUnitAmountType != nil {
+		e.message(6, *r.RepeatUnitAmountType)
+	}
+	for _, v := range r.RepeatUnit {
+		e.message(7, v)
+	}
+	if r.NumberOfUnitsElement != nil {
+		e.message(8, *r.NumberOfUnitsElement)
+	}
+	if r.AverageMolecularFormulaElement != nil {
+		e.message(9, *r.AverageMolecularFormulaElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstancePolymerRepeat from protobuf message SubstancePolymerRepeat of fhir.proto
+func (r *SubstancePolymerRepeat) UnmarshalProto(b []byte) error {
+	*r = SubstancePolymerRepeat{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.NumberOfUnits = &v
+		case 5:
+			v := d.string()
+			r.AverageMolecularFormula = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.RepeatUnitAmountType = &v
+		case 7:
+			var v SubstancePolymerRepeatRepeatUnit
+			d.message(&v)
+			r.RepeatUnit = append(r.RepeatUnit, v)
+		case 8:
+			var v Element
+			d.message(&v)
+			r.NumberOfUnitsElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.AverageMolecularFormulaElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstancePolymerRepeatRepeatUnit as JSON into a byte slice
+func (r SubstancePolymerRepeatRepeatUnit) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstancePolymerRepeatRepeatUnit as JSON object with the members in the order of the fields
+func (r SubstancePolymerRepeatRepeatUnit) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.OrientationOfPolymerisation != nil {
+		e.key("orientationOfPolymerisation")
+		r.OrientationOfPolymerisation.appendJSON(e)
+	}
+	if r.RepeatUnit != nil {
+		e.key("repeatUnit")
+		e.string(*r.RepeatUnit)
+	}
+	if r.RepeatUnitElement != nil {
+		e.key("_repeatUnit")
+		r.RepeatUnitElement.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	if len(r.DegreeOfPolymerisation) > 0 {
+		e.key("degreeOfPolymerisation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DegreeOfPolymerisation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.StructuralRepresentation) > 0 {
+		e.key("structuralRepresentation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.StructuralRepresentation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstancePolymerRepeatRepeatUnit from JSON. Unknown members are ignored.
+func (r *SubstancePolymerRepeatRepeatUnit) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstancePolymerRepeatRepeatUnit
+func (r *SubstancePolymerRepeatRepeatUnit) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "orientationOfPolymerisation":
+			if d.null() {
+				r.OrientationOfPolymerisation = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OrientationOfPolymerisation = &v
+			}
+		case "repeatUnit":
+			if v, ok := d.string(); ok {
+				r.RepeatUnit = &v
+			} else {
+				r.RepeatUnit = nil
+			}
+		case "_repeatUnit":
+			r.RepeatUnitElement = d.element()
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v SubstanceAmount
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		case "degreeOfPolymerisation":
+			a := d.array()
+			r.DegreeOfPolymerisation = nil
+			for a.next() {
+				var v SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation
+				v.decodeJSON(d)
+				r.DegreeOfPolymerisation = append(r.DegreeOfPolymerisation, v)
+			}
+			if r.DegreeOfPolymerisation == nil && !a.isNull {
+				r.DegreeOfPolymerisation = []SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation{}
+			}
+		case "structuralRepresentation":
+			a := d.array()
+			r.StructuralRepresentation = nil
+			for a.next() {
+				var v SubstancePolymerRepeatRepeatUnitStructuralRepresentation
+				v.decodeJSON(d)
+				r.StructuralRepresentation = append(r.StructuralRepresentation, v)
+			}
+			if r.StructuralRepresentation == nil && !a.isNull {
+				r.StructuralRepresentation = []SubstancePolymerRepeatRepeatUnitStructuralRepresentation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstancePolymerRepeatRepeatUnit which shares no memory with the original
+func (r SubstancePolymerRepeatRepeatUnit) DeepCopy() SubstancePolymerRepeatRepeatUnit {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.OrientationOfPolymerisation != nil {
+		v := r.OrientationOfPolymerisation.DeepCopy()
+		out.OrientationOfPolymerisation = &v
+	}
+	if r.RepeatUnit != nil {
+		v := *r.RepeatUnit
+		out.RepeatUnit = &v
+	}
+	if r.RepeatUnitElement != nil {
+		v := r.RepeatUnitElement.DeepCopy()
+		out.RepeatUnitElement = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	if r.DegreeOfPolymerisation != nil {
+		out.DegreeOfPolymerisation = make([]SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation, len(r.DegreeOfPolymerisation))
+		for i := range r.DegreeOfPolymerisation {
+			out.DegreeOfPolymerisation[i] = r.DegreeOfPolymerisation[i].DeepCopy()
+		}
+	}
+	if r.StructuralRepresentation != nil {
+		out.StructuralRepresentation = make([]SubstancePolymerRepeatRepeatUnitStructuralRepresentation, len(r.StructuralRepresentation))
+		for i := range r.StructuralRepresentation {
+			out.StructuralRepresentation[i] = r.StructuralRepresentation[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstancePolymerRepeatRepeatUnit) Equal(other SubstancePolymerRepeatRepeatUnit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.OrientationOfPolymerisation == nil) != (other.OrientationOfPolymerisation == nil) || r.OrientationOfPolymerisation != nil && !r.OrientationOfPolymerisation.Equal(*other.OrientationOfPolymerisation) {
+		return false
+	}
+	if (r.RepeatUnit == nil) != (other.RepeatUnit == nil) || r.RepeatUnit != nil && *r.RepeatUnit != *other.RepeatUnit {
+		return false
+	}
+	if (r.RepeatUnitElement == nil) != (other.RepeatUnitElement == nil) || r.RepeatUnitElement != nil && !r.RepeatUnitElement.Equal(*other.RepeatUnitElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	if len(r.DegreeOfPolymerisation) != len(other.DegreeOfPolymerisation) {
+		return false
+	}
+	for i := range r.DegreeOfPolymerisation {
+		if !r.DegreeOfPolymerisation[i].Equal(other.DegreeOfPolymerisation[i]) {
+			return false
+		}
+	}
+	if len(r.StructuralRepresentation) != len(other.StructuralRepresentation) {
+		return false
+	}
+	for i := range r.StructuralRepresentation {
+		if !r.StructuralRepresentation[i].Equal(other.StructuralRepresentation[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstancePolymerRepeatRepeatUnit) EqualsDeep(other SubstancePolymerRepeatRepeatUnit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.OrientationOfPolymerisation == nil) != (other.OrientationOfPolymerisation == nil) || r.OrientationOfPolymerisation != nil && !r.OrientationOfPolymerisation.EqualsDeep(*other.OrientationOfPolymerisation) {
+		return false
+	}
+	if (r.RepeatUnit == nil) != (other.RepeatUnit == nil) || r.RepeatUnit != nil && !equivalentString(*r.RepeatUnit, *other.RepeatUnit) {
+		return false
+	}
+	if (r.RepeatUnitElement == nil) != (other.RepeatUnitElement == nil) || r.RepeatUnitElement != nil && !r.RepeatUnitElement.EqualsDeep(*other.RepeatUnitElement) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	if !equivalentList(len(r.DegreeOfPolymerisation), len(other.DegreeOfPolymerisation), func(i, j int) bool {
+		return r.DegreeOfPolymerisation[i].EqualsDeep(other.DegreeOfPolymerisation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.StructuralRepresentation), len(other.StructuralRepresentation), func(i, j int) bool {
+		return r.StructuralRepresentation[i].EqualsDeep(other.StructuralRepresentation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstancePolymerRepeatRepeatUnit) EqualsShallow(other SubstancePolymerRepeatRepeatUnit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.RepeatUnit == nil) != (other.RepeatUnit == nil) || r.RepeatUnit != nil && !equivalentString(*r.RepeatUnit, *other.RepeatUnit) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstancePolymerRepeatRepeatUnit as FHIR XML
+func (r SubstancePolymerRepeatRepeatUnit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "orientationOfPolymerisation", r.OrientationOfPolymerisation); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "repeatUnit", r.RepeatUnit, r.RepeatUnitElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	for _, v := range r.DegreeOfPolymerisation {
+		if err := encodeXMLElement(e, "degreeOfPolymerisation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.StructuralRepresentation {
+		if err := encodeXMLElement(e, "structuralRepresentation", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstancePolymerRepeatRepeatUnit from FHIR XML
+func (r *SubstancePolymerRepeatRepeatUnit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "orientationOfPolymerisation":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OrientationOfPolymerisation = &v
+			case "repeatUnit":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RepeatUnit = &v
+				}
+				r.RepeatUnitElement = element
+			case "amount":
+				var v SubstanceAmount
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			case "degreeOfPolymerisation":
+				var v SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DegreeOfPolymerisation = append(r.DegreeOfPolymerisation, v)
+			case "structuralRepresentation":
+				var v SubstancePolymerRepeatRepeatUnitStructuralRepresentation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StructuralRepresentation = append(r.StructuralRepresentation, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstancePolymerRepeatRepeatUnit as properties to the RDF node n
+func (r SubstancePolymerRepeatRepeatUnit) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstancePolymer.repeat.repeatUnit.orientationOfPolymerisation", -1, r.OrientationOfPolymerisation)
+	n.primitive("SubstancePolymer.repeat.repeatUnit.repeatUnit", -1, r.RepeatUnit, r.RepeatUnitElement, "string")
+	n.element("SubstancePolymer.repeat.repeatUnit.amount", -1, r.Amount)
+	for i, v := range r.DegreeOfPolymerisation {
+		n.element("SubstancePolymer.repeat.repeatUnit.degreeOfPolymerisation", i, v)
+	}
+	for i, v := range r.StructuralRepresentation {
+		n.element("SubstancePolymer.repeat.repeatUnit.structuralRepresentation", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstancePolymerRepeatRepeatUnit as BSON document with the structure of its FHIR JSON
+func (r SubstancePolymerRepeatRepeatUnit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstancePolymerRepeatRepeatUnit from a BSON document with the structure of its FHIR JSON
+func (r *SubstancePolymerRepeatRepeatUnit) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstancePolymerRepeatRepeatUnit as protobuf message SubstancePolymerRepeatRepeatUnit of fhir.proto
+func (r SubstancePolymerRepeatRepeatUnit) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstancePolymerRepeatRepeatUnit to the encoded message
+func (r SubstancePolymerRepeatRepeatUnit) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.OrientationOfPolymerisation != nil {
+		e.message(4, *r.OrientationOfPolymerisation)
+	}
+	if r.RepeatUnit != nil {
+		e.string(5, *r.RepeatUnit)
+	}
+	if r.Amount != nil {
+		e.message(6, *r.Amount)
+	}
+	for _, v := range r.DegreeOfPolymerisation {
+		e.message(7, v)
+	}
+	for _, v := range r.StructuralRepresentation {
+		e.message(8, v)
+	}
+	if r.RepeatUnitElement != nil {
+		e.message(9, *r.RepeatUnitElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstancePolymerRepeatRepeatUnit from protobuf message SubstancePolymerRepeatRepeatUnit of fhir.proto
+func (r *SubstancePolymerRepeatRepeatUnit) UnmarshalProto(b []byte) error {
+	*r = SubstancePolymerRepeatRepeatUnit{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.OrientationOfPolymerisation = &v
+		case 5:
+			v := d.string()
+			r.RepeatUnit = &v
+		case 6:
+			var v SubstanceAmount
+			d.message(&v)
+			r.Amount = &v
+		case 7:
+			var v SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation
+			d.message(&v)
+			r.DegreeOfPolymerisation = append(r.DegreeOfPolymerisation, v)
+		case 8:
+			var v SubstancePolymerRepeatRepeatUnitStructuralRepresentation
+			d.message(&v)
+			r.StructuralRepresentation = append(r.StructuralRepresentation, v)
+		case 9:
+			var v Element
+			d.message(&v)
+			r.RepeatUnitElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation as JSON into a byte slice
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation as JSON object with the members in the order of the fields
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Degree != nil {
+		e.key("degree")
+		r.Degree.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation from JSON. Unknown members are ignored.
+func (r *SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation
+func (r *SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "degree":
+			if d.null() {
+				r.Degree = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Degree = &v
+			}
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v SubstanceAmount
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation which shares no memory with the original
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) DeepCopy() SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Degree != nil {
+		v := r.Degree.DeepCopy()
+		out.Degree = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) Equal(other SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Degree == nil) != (other.Degree == nil) || r.Degree != nil && !r.Degree.Equal(*other.Degree) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) EqualsDeep(other SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Degree == nil) != (other.Degree == nil) || r.Degree != nil && !r.Degree.EqualsDeep(*other.Degree) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) EqualsShallow(other SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation as FHIR XML
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "degree", r.Degree); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation from FHIR XML
+func (r *SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "degree":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Degree = &v
+			case "amount":
+				var v SubstanceAmount
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation as properties to the RDF node n
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstancePolymer.repeat.repeatUnit.degreeOfPolymerisation.degree", -1, r.Degree)
+	n.element("SubstancePolymer.repeat.repeatUnit.degreeOfPolymerisation.amount", -1, r.Amount)
+}
+
+// MarshalBSON marshals the given SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation as BSON document with the structure of its FHIR JSON
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation from a BSON document with the structure of its FHIR JSON
+func (r *SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation as protobuf message SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation of fhir.proto
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation to the encoded message
+func (r SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Degree != nil {
+		e.message(4, *r.Degree)
+	}
+	if r.Amount != nil {
+		e.message(5, *r.Amount)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation from protobuf message SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation of fhir.proto
+func (r *SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation) UnmarshalProto(b []byte) error {
+	*r = SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Degree = &v
+		case 5:
+			var v SubstanceAmount
+			d.message(&v)
+			r.Amount = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstancePolymerRepeatRepeatUnitStructuralRepresentation as JSON into a byte slice
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstancePolymerRepeatRepeatUnitStructuralRepresentation as JSON object with the members in the order of the fields
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Representation != nil {
+		e.key("representation")
+		e.string(*r.Representation)
+	}
+	if r.RepresentationElement != nil {
+		e.key("_representation")
+		r.RepresentationElement.appendJSON(e)
+	}
+	if r.Attachment != nil {
+		e.key("attachment")
+		r.Attachment.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstancePolymerRepeatRepeatUnitStructuralRepresentation from JSON. Unknown members are ignored.
+func (r *SubstancePolymerRepeatRepeatUnitStructuralRepresentation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstancePolymerRepeatRepeatUnitStructuralRepresentation
+func (r *SubstancePolymerRepeatRepeatUnitStructuralRepresentation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "representation":
+			if v, ok := d.string(); ok {
+				r.Representation = &v
+			} else {
+				r.Representation = nil
+			}
+		case "_representation":
+			r.RepresentationElement = d.element()
+		case "attachment":
+			if d.null() {
+				r.Attachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Attachment = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstancePolymerRepeatRepeatUnitStructuralRepresentation which shares no memory with the original
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) DeepCopy() SubstancePolymerRepeatRepeatUnitStructuralRepresentation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Representation != nil {
+		v := *r.Representation
+		out.Representation = &v
+	}
+	if r.RepresentationElement != nil {
+		v := r.RepresentationElement.DeepCopy()
+		out.RepresentationElement = &v
+	}
+	if r.Attachment != nil {
+		v := r.Attachment.DeepCopy()
+		out.Attachment = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) Equal(other SubstancePolymerRepeatRepeatUnitStructuralRepresentation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Representation == nil) != (other.Representation == nil) || r.Representation != nil && *r.Representation != *other.Representation {
+		return false
+	}
+	if (r.RepresentationElement == nil) != (other.RepresentationElement == nil) || r.RepresentationElement != nil && !r.RepresentationElement.Equal(*other.RepresentationElement) {
+		return false
+	}
+	if (r.Attachment == nil) != (other.Attachment == nil) || r.Attachment != nil && !r.Attachment.Equal(*other.Attachment) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) EqualsDeep(other SubstancePolymerRepeatRepeatUnitStructuralRepresentation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Representation == nil) != (other.Representation == nil) || r.Representation != nil && !equivalentString(*r.Representation, *other.Representation) {
+		return false
+	}
+	if (r.RepresentationElement == nil) != (other.RepresentationElement == nil) || r.RepresentationElement != nil && !r.RepresentationElement.EqualsDeep(*other.RepresentationElement) {
+		return false
+	}
+	if (r.Attachment == nil) != (other.Attachment == nil) || r.Attachment != nil && !r.Attachment.EqualsDeep(*other.Attachment) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) EqualsShallow(other SubstancePolymerRepeatRepeatUnitStructuralRepresentation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Representation == nil) != (other.Representation == nil) || r.Representation != nil && !equivalentString(*r.Representation, *other.Representation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstancePolymerRepeatRepeatUnitStructuralRepresentation as FHIR XML
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "representation", r.Representation, r.RepresentationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "attachment", r.Attachment); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstancePolymerRepeatRepeatUnitStructuralRepresentation from FHIR XML
+func (r *SubstancePolymerRepeatRepeatUnitStructuralRepresentation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "representation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Representation = &v
+				}
+				r.RepresentationElement = element
+			case "attachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Attachment = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstancePolymerRepeatRepeatUnitStructuralRepresentation as properties to the RDF node n
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstancePolymer.repeat.repeatUnit.structuralRepresentation.type", -1, r.Type)
+	n.primitive("SubstancePolymer.repeat.repeatUnit.structuralRepresentation.representation", -1, r.Representation, r.RepresentationElement, "string")
+	n.element("SubstancePolymer.repeat.repeatUnit.structuralRepresentation.attachment", -1, r.Attachment)
+}
+
+// MarshalBSON marshals the given SubstancePolymerRepeatRepeatUnitStructuralRepresentation as BSON document with the structure of its FHIR JSON
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstancePolymerRepeatRepeatUnitStructuralRepresentation from a BSON document with the structure of its FHIR JSON
+func (r *SubstancePolymerRepeatRepeatUnitStructuralRepresentation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstancePolymerRepeatRepeatUnitStructuralRepresentation as protobuf message SubstancePolymerRepeatRepeatUnitStructuralRepresentation of fhir.proto
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstancePolymerRepeatRepeatUnitStructuralRepresentation to the encoded message
+func (r SubstancePolymerRepeatRepeatUnitStructuralRepresentation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Representation != nil {
+		e.string(5, *r.Representation)
+	}
+	if r.Attachment != nil {
+		e.message(6, *r.Attachment)
+	}
+	if r.RepresentationElement != nil {
+		e.message(7, *r.RepresentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstancePolymerRepeatRepeatUnitStructuralRepresentation from protobuf message SubstancePolymerRepeatRepeatUnitStructuralRepresentation of fhir.proto
+func (r *SubstancePolymerRepeatRepeatUnitStructuralRepresentation) UnmarshalProto(b []byte) error {
+	*r = SubstancePolymerRepeatRepeatUnitStructuralRepresentation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			v := d.string()
+			r.Representation = &v
+		case 6:
+			var v Attachment
+			d.message(&v)
+			r.Attachment = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.RepresentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSubstancePolymer unmarshals a SubstancePolymer.
 func UnmarshalSubstancePolymer(b []byte) (SubstancePolymer, error) {
 	var substancePolymer SubstancePolymer
-	if err := json.Unmarshal(b, &substancePolymer); err != nil {
+	if err := substancePolymer.UnmarshalJSON(b); err != nil {
 		return substancePolymer, err
 	}
 	return substancePolymer, nil
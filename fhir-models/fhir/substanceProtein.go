@@ -14,55 +14,1628 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // SubstanceProtein is documented here http://hl7.org/fhir/StructureDefinition/SubstanceProtein
 type SubstanceProtein struct {
-	Id                *string                   `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                   `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SequenceType      *CodeableConcept          `bson:"sequenceType,omitempty" json:"sequenceType,omitempty"`
-	NumberOfSubunits  *int                      `bson:"numberOfSubunits,omitempty" json:"numberOfSubunits,omitempty"`
-	DisulfideLinkage  []string                  `bson:"disulfideLinkage,omitempty" json:"disulfideLinkage,omitempty"`
-	Subunit           []SubstanceProteinSubunit `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	Id                      *string                   `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement               *Element                  `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                    *Meta                     `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           *string                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement    *Element                  `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                *string                   `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement         *Element                  `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                    *Narrative                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               []json.RawMessage         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SequenceType            *CodeableConcept          `bson:"sequenceType,omitempty" json:"sequenceType,omitempty"`
+	NumberOfSubunits        *int                      `bson:"numberOfSubunits,omitempty" json:"numberOfSubunits,omitempty"`
+	NumberOfSubunitsElement *Element                  `bson:"_numberOfSubunits,omitempty" json:"_numberOfSubunits,omitempty"`
+	DisulfideLinkage        []string                  `bson:"disulfideLinkage,omitempty" json:"disulfideLinkage,omitempty"`
+	DisulfideLinkageElement []*Element                `bson:"_disulfideLinkage,omitempty" json:"_disulfideLinkage,omitempty"`
+	Subunit                 []SubstanceProteinSubunit `bson:"subunit,omitempty" json:"subunit,omitempty"`
 }
 type SubstanceProteinSubunit struct {
-	Id                      *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension               []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension       []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Subunit                 *int        `bson:"subunit,omitempty" json:"subunit,omitempty"`
-	Sequence                *string     `bson:"sequence,omitempty" json:"sequence,omitempty"`
-	Length                  *int        `bson:"length,omitempty" json:"length,omitempty"`
-	SequenceAttachment      *Attachment `bson:"sequenceAttachment,omitempty" json:"sequenceAttachment,omitempty"`
-	NTerminalModificationId *Identifier `bson:"nTerminalModificationId,omitempty" json:"nTerminalModificationId,omitempty"`
-	NTerminalModification   *string     `bson:"nTerminalModification,omitempty" json:"nTerminalModification,omitempty"`
-	CTerminalModificationId *Identifier `bson:"cTerminalModificationId,omitempty" json:"cTerminalModificationId,omitempty"`
-	CTerminalModification   *string     `bson:"cTerminalModification,omitempty" json:"cTerminalModification,omitempty"`
-}
-type OtherSubstanceProtein SubstanceProtein
+	Id                           *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                    []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Subunit                      *int        `bson:"subunit,omitempty" json:"subunit,omitempty"`
+	SubunitElement               *Element    `bson:"_subunit,omitempty" json:"_subunit,omitempty"`
+	Sequence                     *string     `bson:"sequence,omitempty" json:"sequence,omitempty"`
+	SequenceElement              *Element    `bson:"_sequence,omitempty" json:"_sequence,omitempty"`
+	Length                       *int        `bson:"length,omitempty" json:"length,omitempty"`
+	LengthElement                *Element    `bson:"_length,omitempty" json:"_length,omitempty"`
+	SequenceAttachment           *Attachment `bson:"sequenceAttachment,omitempty" json:"sequenceAttachment,omitempty"`
+	NTerminalModificationId      *Identifier `bson:"nTerminalModificationId,omitempty" json:"nTerminalModificationId,omitempty"`
+	NTerminalModification        *string     `bson:"nTerminalModification,omitempty" json:"nTerminalModification,omitempty"`
+	NTerminalModificationElement *Element    `bson:"_nTerminalModification,omitempty" json:"_nTerminalModification,omitempty"`
+	CTerminalModificationId      *Identifier `bson:"cTerminalModificationId,omitempty" json:"cTerminalModificationId,omitempty"`
+	CTerminalModification        *string     `bson:"cTerminalModification,omitempty" json:"cTerminalModification,omitempty"`
+	CTerminalModificationElement *Element    `bson:"_cTerminalModification,omitempty" json:"_cTerminalModification,omitempty"`
+}
 
 // MarshalJSON marshals the given SubstanceProtein as JSON into a byte slice
 func (r SubstanceProtein) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSubstanceProtein
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSubstanceProtein: OtherSubstanceProtein(r),
-		ResourceType:          "SubstanceProtein",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceProtein as JSON object with the members in the order of the fields
+func (r SubstanceProtein) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"SubstanceProtein\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.SequenceType != nil {
+		e.key("sequenceType")
+		r.SequenceType.appendJSON(e)
+	}
+	if r.NumberOfSubunits != nil {
+		e.key("numberOfSubunits")
+		e.int(*r.NumberOfSubunits)
+	}
+	if r.NumberOfSubunitsElement != nil {
+		e.key("_numberOfSubunits")
+		r.NumberOfSubunitsElement.appendJSON(e)
+	}
+	if len(r.DisulfideLinkage) > 0 {
+		e.key("disulfideLinkage")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.DisulfideLinkage {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.DisulfideLinkageElement) > 0 {
+		e.key("_disulfideLinkage")
+		e.elements(r.DisulfideLinkageElement, len(r.DisulfideLinkage))
+	}
+	if len(r.Subunit) > 0 {
+		e.key("subunit")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subunit {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceProtein from JSON. Unknown members are ignored.
+func (r *SubstanceProtein) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceProtein
+func (r *SubstanceProtein) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sequenceType":
+			if d.null() {
+				r.SequenceType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SequenceType = &v
+			}
+		case "numberOfSubunits":
+			if v, ok := d.int(); ok {
+				r.NumberOfSubunits = &v
+			} else {
+				r.NumberOfSubunits = nil
+			}
+		case "_numberOfSubunits":
+			r.NumberOfSubunitsElement = d.element()
+		case "disulfideLinkage":
+			a := d.array()
+			r.DisulfideLinkage = nil
+			for a.next() {
+				v, _ := d.string()
+				r.DisulfideLinkage = append(r.DisulfideLinkage, v)
+			}
+			if r.DisulfideLinkage == nil && !a.isNull {
+				r.DisulfideLinkage = []string{}
+			}
+		case "_disulfideLinkage":
+			a := d.array()
+			r.DisulfideLinkageElement = nil
+			for a.next() {
+				r.DisulfideLinkageElement = append(r.DisulfideLinkageElement, d.element())
+			}
+		case "subunit":
+			a := d.array()
+			r.Subunit = nil
+			for a.next() {
+				var v SubstanceProteinSubunit
+				v.decodeJSON(d)
+				r.Subunit = append(r.Subunit, v)
+			}
+			if r.Subunit == nil && !a.isNull {
+				r.Subunit = []SubstanceProteinSubunit{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceProtein which shares no memory with the original
+func (r SubstanceProtein) DeepCopy() SubstanceProtein {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SequenceType != nil {
+		v := r.SequenceType.DeepCopy()
+		out.SequenceType = &v
+	}
+	if r.NumberOfSubunits != nil {
+		v := *r.NumberOfSubunits
+		out.NumberOfSubunits = &v
+	}
+	if r.NumberOfSubunitsElement != nil {
+		v := r.NumberOfSubunitsElement.DeepCopy()
+		out.NumberOfSubunitsElement = &v
+	}
+	if r.DisulfideLinkage != nil {
+		out.DisulfideLinkage = make([]string, len(r.DisulfideLinkage))
+		copy(out.DisulfideLinkage, r.DisulfideLinkage)
+	}
+	if r.DisulfideLinkageElement != nil {
+		out.DisulfideLinkageElement = make([]*Element, len(r.DisulfideLinkageElement))
+		for i, v := range r.DisulfideLinkageElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.DisulfideLinkageElement[i] = &c
+			}
+		}
+	}
+	if r.Subunit != nil {
+		out.Subunit = make([]SubstanceProteinSubunit, len(r.Subunit))
+		for i := range r.Subunit {
+			out.Subunit[i] = r.Subunit[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceProtein) Equal(other SubstanceProtein) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.SequenceType == nil) != (other.SequenceType == nil) || r.SequenceType != nil && !r.SequenceType.Equal(*other.SequenceType) {
+		return false
+	}
+	if (r.NumberOfSubunits == nil) != (other.NumberOfSubunits == nil) || r.NumberOfSubunits != nil && *r.NumberOfSubunits != *other.NumberOfSubunits {
+		return false
+	}
+	if (r.NumberOfSubunitsElement == nil) != (other.NumberOfSubunitsElement == nil) || r.NumberOfSubunitsElement != nil && !r.NumberOfSubunitsElement.Equal(*other.NumberOfSubunitsElement) {
+		return false
+	}
+	if len(r.DisulfideLinkage) != len(other.DisulfideLinkage) {
+		return false
+	}
+	for i := range r.DisulfideLinkage {
+		if r.DisulfideLinkage[i] != other.DisulfideLinkage[i] {
+			return false
+		}
+	}
+	if !equalElements(r.DisulfideLinkageElement, other.DisulfideLinkageElement, false) {
+		return false
+	}
+	if len(r.Subunit) != len(other.Subunit) {
+		return false
+	}
+	for i := range r.Subunit {
+		if !r.Subunit[i].Equal(other.Subunit[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceProtein) EqualsDeep(other SubstanceProtein) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.SequenceType == nil) != (other.SequenceType == nil) || r.SequenceType != nil && !r.SequenceType.EqualsDeep(*other.SequenceType) {
+		return false
+	}
+	if (r.NumberOfSubunits == nil) != (other.NumberOfSubunits == nil) || r.NumberOfSubunits != nil && *r.NumberOfSubunits != *other.NumberOfSubunits {
+		return false
+	}
+	if (r.NumberOfSubunitsElement == nil) != (other.NumberOfSubunitsElement == nil) || r.NumberOfSubunitsElement != nil && !r.NumberOfSubunitsElement.EqualsDeep(*other.NumberOfSubunitsElement) {
+		return false
+	}
+	if !equivalentList(len(r.DisulfideLinkage), len(other.DisulfideLinkage), func(i, j int) bool {
+		return equivalentString(r.DisulfideLinkage[i], other.DisulfideLinkage[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.DisulfideLinkageElement, other.DisulfideLinkageElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Subunit), len(other.Subunit), func(i, j int) bool {
+		return r.Subunit[i].EqualsDeep(other.Subunit[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceProtein) EqualsShallow(other SubstanceProtein) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.NumberOfSubunits == nil) != (other.NumberOfSubunits == nil) || r.NumberOfSubunits != nil && *r.NumberOfSubunits != *other.NumberOfSubunits {
+		return false
+	}
+	if !equivalentList(len(r.DisulfideLinkage), len(other.DisulfideLinkage), func(i, j int) bool {
+		return equivalentString(r.DisulfideLinkage[i], other.DisulfideLinkage[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceProtein as FHIR XML
+func (r SubstanceProtein) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "SubstanceProtein",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "sequenceType", r.SequenceType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "numberOfSubunits", r.NumberOfSubunits, r.NumberOfSubunitsElement); err != nil {
+		return err
+	}
+	for i, v := range r.DisulfideLinkage {
+		if err := encodeXMLPrimitive(e, "disulfideLinkage", v, elementAt(r.DisulfideLinkageElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Subunit {
+		if err := encodeXMLElement(e, "subunit", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceProtein from FHIR XML
+func (r *SubstanceProtein) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sequenceType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SequenceType = &v
+			case "numberOfSubunits":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NumberOfSubunits = &v
+				}
+				r.NumberOfSubunitsElement = element
+			case "disulfideLinkage":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.DisulfideLinkageElement = appendElement(r.DisulfideLinkageElement, len(r.DisulfideLinkage), element)
+					r.DisulfideLinkage = append(r.DisulfideLinkage, v)
+				}
+			case "subunit":
+				var v SubstanceProteinSubunit
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subunit = append(r.Subunit, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceProtein as properties to the RDF node n
+func (r SubstanceProtein) turtle(n *turtleNode) {
+	n.resource("SubstanceProtein", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("SubstanceProtein.sequenceType", -1, r.SequenceType)
+	n.primitive("SubstanceProtein.numberOfSubunits", -1, r.NumberOfSubunits, r.NumberOfSubunitsElement, "integer")
+	for i, v := range r.DisulfideLinkage {
+		n.primitive("SubstanceProtein.disulfideLinkage", i, v, elementAt(r.DisulfideLinkageElement, i), "string")
+	}
+	for i, v := range r.Subunit {
+		n.element("SubstanceProtein.subunit", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceProtein as BSON document with the structure of its FHIR JSON
+func (r SubstanceProtein) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceProtein from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceProtein) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceProtein as protobuf message SubstanceProtein of fhir.proto
+func (r SubstanceProtein) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceProtein to the encoded message
+func (r SubstanceProtein) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.SequenceType != nil {
+		e.message(9, *r.SequenceType)
+	}
+	if r.NumberOfSubunits != nil {
+		e.varint(10, uint64(int64(*r.NumberOfSubunits)))
+	}
+	for _, v := range r.DisulfideLinkage {
+		e.string(11, v)
+	}
+	for _, v := range r.Subunit {
+		e.message(12, v)
+	}
+	if r.IdElement != nil {
+		e.message(13, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(14, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(15, *r.LanguageElement)
+	}
+	if r.NumberOfSubunitsElement != nil {
+		e.message(16, *r.NumberOfSubunitsElement)
+	}
+	for _, v := range r.DisulfideLinkageElement {
+		e.element(17, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceProtein from protobuf message SubstanceProtein of fhir.proto
+func (r *SubstanceProtein) UnmarshalProto(b []byte) error {
+	*r = SubstanceProtein{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.SequenceType = &v
+		case 10:
+			v := d.int()
+			r.NumberOfSubunits = &v
+		case 11:
+			r.DisulfideLinkage = append(r.DisulfideLinkage, d.string())
+		case 12:
+			var v SubstanceProteinSubunit
+			d.message(&v)
+			r.Subunit = append(r.Subunit, v)
+		case 13:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.NumberOfSubunitsElement = &v
+		case 17:
+			r.DisulfideLinkageElement = append(r.DisulfideLinkageElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceProteinSubunit as JSON into a byte slice
+func (r SubstanceProteinSubunit) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceProteinSubunit as JSON object with the members in the order of the fields
+func (r SubstanceProteinSubunit) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Subunit != nil {
+		e.key("subunit")
+		e.int(*r.Subunit)
+	}
+	if r.SubunitElement != nil {
+		e.key("_subunit")
+		r.SubunitElement.appendJSON(e)
+	}
+	if r.Sequence != nil {
+		e.key("sequence")
+		e.string(*r.Sequence)
+	}
+	if r.SequenceElement != nil {
+		e.key("_sequence")
+		r.SequenceElement.appendJSON(e)
+	}
+	if r.Length != nil {
+		e.key("length")
+		e.int(*r.Length)
+	}
+	if r.LengthElement != nil {
+		e.key("_length")
+		r.LengthElement.appendJSON(e)
+	}
+	if r.SequenceAttachment != nil {
+		e.key("sequenceAttachment")
+		r.SequenceAttachment.appendJSON(e)
+	}
+	if r.NTerminalModificationId != nil {
+		e.key("nTerminalModificationId")
+		r.NTerminalModificationId.appendJSON(e)
+	}
+	if r.NTerminalModification != nil {
+		e.key("nTerminalModification")
+		e.string(*r.NTerminalModification)
+	}
+	if r.NTerminalModificationElement != nil {
+		e.key("_nTerminalModification")
+		r.NTerminalModificationElement.appendJSON(e)
+	}
+	if r.CTerminalModificationId != nil {
+		e.key("cTerminalModificationId")
+		r.CTerminalModificationId.appendJSON(e)
+	}
+	if r.CTerminalModification != nil {
+		e.key("cTerminalModification")
+		e.string(*r.CTerminalModification)
+	}
+	if r.CTerminalModificationElement != nil {
+		e.key("_cTerminalModification")
+		r.CTerminalModificationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceProteinSubunit from JSON. Unknown members are ignored.
+func (r *SubstanceProteinSubunit) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceProteinSubunit
+func (r *SubstanceProteinSubunit) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "subunit":
+			if v, ok := d.int(); ok {
+				r.Subunit = &v
+			} else {
+				r.Subunit = nil
+			}
+		case "_subunit":
+			r.SubunitElement = d.element()
+		case "sequence":
+			if v, ok := d.string(); ok {
+				r.Sequence = &v
+			} else {
+				r.Sequence = nil
+			}
+		case "_sequence":
+			r.SequenceElement = d.element()
+		case "length":
+			if v, ok := d.int(); ok {
+				r.Length = &v
+			} else {
+				r.Length = nil
+			}
+		case "_length":
+			r.LengthElement = d.element()
+		case "sequenceAttachment":
+			if d.null() {
+				r.SequenceAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.SequenceAttachment = &v
+			}
+		case "nTerminalModificationId":
+			if d.null() {
+				r.NTerminalModificationId = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.NTerminalModificationId = &v
+			}
+		case "nTerminalModification":
+			if v, ok := d.string(); ok {
+				r.NTerminalModification = &v
+			} else {
+				r.NTerminalModification = nil
+			}
+		case "_nTerminalModification":
+			r.NTerminalModificationElement = d.element()
+		case "cTerminalModificationId":
+			if d.null() {
+				r.CTerminalModificationId = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.CTerminalModificationId = &v
+			}
+		case "cTerminalModification":
+			if v, ok := d.string(); ok {
+				r.CTerminalModification = &v
+			} else {
+				r.CTerminalModification = nil
+			}
+		case "_cTerminalModification":
+			r.CTerminalModificationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceProteinSubunit which shares no memory with the original
+func (r SubstanceProteinSubunit) DeepCopy() SubstanceProteinSubunit {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Subunit != nil {
+		v := *r.Subunit
+		out.Subunit = &v
+	}
+	if r.SubunitElement != nil {
+		v := r.SubunitElement.DeepCopy()
+		out.SubunitElement = &v
+	}
+	if r.Sequence != nil {
+		v := *r.Sequence
+		out.Sequence = &v
+	}
+	if r.SequenceElement != nil {
+		v := r.SequenceElement.DeepCopy()
+		out.SequenceElement = &v
+	}
+	if r.Length != nil {
+		v := *r.Length
+		out.Length = &v
+	}
+	if r.LengthElement != nil {
+		v := r.LengthElement.DeepCopy()
+		out.LengthElement = &v
+	}
+	if r.SequenceAttachment != nil {
+		v := r.SequenceAttachment.DeepCopy()
+		out.SequenceAttachment = &v
+	}
+	if r.NTerminalModificationId != nil {
+		v := r.NTerminalModificationId.DeepCopy()
+		out.NTerminalModificationId = &v
+	}
+	if r.NTerminalModification != nil {
+		v := *r.NTerminalModification
+		out.NTerminalModification = &v
+	}
+	if r.NTerminalModificationElement != nil {
+		v := r.NTerminalModificationElement.DeepCopy()
+		out.NTerminalModificationElement = &v
+	}
+	if r.CTerminalModificationId != nil {
+		v := r.CTerminalModificationId.DeepCopy()
+		out.CTerminalModificationId = &v
+	}
+	if r.CTerminalModification != nil {
+		v := *r.CTerminalModification
+		out.CTerminalModification = &v
+	}
+	if r.CTerminalModificationElement != nil {
+		v := r.CTerminalModificationElement.DeepCopy()
+		out.CTerminalModificationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceProteinSubunit) Equal(other SubstanceProteinSubunit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Subunit == nil) != (other.Subunit == nil) || r.Subunit != nil && *r.Subunit != *other.Subunit {
+		return false
+	}
+	if (r.SubunitElement == nil) != (other.SubunitElement == nil) || r.SubunitElement != nil && !r.SubunitElement.Equal(*other.SubunitElement) {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && *r.Sequence != *other.Sequence {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.Equal(*other.SequenceElement) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && *r.Length != *other.Length {
+		return false
+	}
+	if (r.LengthElement == nil) != (other.LengthElement == nil) || r.LengthElement != nil && !r.LengthElement.Equal(*other.LengthElement) {
+		return false
+	}
+	if (r.SequenceAttachment == nil) != (other.SequenceAttachment == nil) || r.SequenceAttachment != nil && !r.SequenceAttachment.Equal(*other.SequenceAttachment) {
+		return false
+	}
+	if (r.NTerminalModificationId == nil) != (other.NTerminalModificationId == nil) || r.NTerminalModificationId != nil && !r.NTerminalModificationId.Equal(*other.NTerminalModificationId) {
+		return false
+	}
+	if (r.NTerminalModification == nil) != (other.NTerminalModification == nil) || r.NTerminalModification != nil && *r.NTerminalModification != *other.NTerminalModification {
+		return false
+	}
+	if (r.NTerminalModificationElement == nil) != (other.NTerminalModificationElement == nil) || r.NTerminalModificationElement != nil && !r.NTerminalModificationElement.Equal(*other.NTerminalModificationElement) {
+		return false
+	}
+	if (r.CTerminalModificationId == nil) != (other.CTerminalModificationId == nil) || r.CTerminalModificationId != nil && !r.CTerminalModificationId.Equal(*other.CTerminalModificationId) {
+		return false
+	}
+	if (r.CTerminalModification == nil) != (other.CTerminalModification == nil) || r.CTerminalModification != nil && *r.CTerminalModification != *other.CTerminalModification {
+		return false
+	}
+	if (r.CTerminalModificationElement == nil) != (other.CTerminalModificationElement == nil) || r.CTerminalModificationElement != nil && !r.CTerminalModificationElement.Equal(*other.CTerminalModificationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceProteinSubunit) EqualsDeep(other SubstanceProteinSubunit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Subunit == nil) != (other.Subunit == nil) || r.Subunit != nil && *r.Subunit != *other.Subunit {
+		return false
+	}
+	if (r.SubunitElement == nil) != (other.SubunitElement == nil) || r.SubunitElement != nil && !r.SubunitElement.EqualsDeep(*other.SubunitElement) {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && !equivalentString(*r.Sequence, *other.Sequence) {
+		return false
+	}
+	if (r.SequenceElement == nil) != (other.SequenceElement == nil) || r.SequenceElement != nil && !r.SequenceElement.EqualsDeep(*other.SequenceElement) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && *r.Length != *other.Length {
+		return false
+	}
+	if (r.LengthElement == nil) != (other.LengthElement == nil) || r.LengthElement != nil && !r.LengthElement.EqualsDeep(*other.LengthElement) {
+		return false
+	}
+	if (r.SequenceAttachment == nil) != (other.SequenceAttachment == nil) || r.SequenceAttachment != nil && !r.SequenceAttachment.EqualsDeep(*other.SequenceAttachment) {
+		return false
+	}
+	if (r.NTerminalModificationId == nil) != (other.NTerminalModificationId == nil) || r.NTerminalModificationId != nil && !r.NTerminalModificationId.EqualsDeep(*other.NTerminalModificationId) {
+		return false
+	}
+	if (r.NTerminalModification == nil) != (other.NTerminalModification == nil) || r.NTerminalModification != nil && !equivalentString(*r.NTerminalModification, *other.NTerminalModification) {
+		return false
+	}
+	if (r.NTerminalModificationElement == nil) != (other.NTerminalModificationElement == nil) || r.NTerminalModificationElement != nil && !r.NTerminalModificationElement.EqualsDeep(*other.NTerminalModificationElement) {
+		return false
+	}
+	if (r.CTerminalModificationId == nil) != (other.CTerminalModificationId == nil) || r.CTerminalModificationId != nil && !r.CTerminalModificationId.EqualsDeep(*other.CTerminalModificationId) {
+		return false
+	}
+	if (r.CTerminalModification == nil) != (other.CTerminalModification == nil) || r.CTerminalModification != nil && !equivalentString(*r.CTerminalModification, *other.CTerminalModification) {
+		return false
+	}
+	if (r.CTerminalModificationElement == nil) != (other.CTerminalModificationElement == nil) || r.CTerminalModificationElement != nil && !r.CTerminalModificationElement.EqualsDeep(*other.CTerminalModificationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceProteinSubunit) EqualsShallow(other SubstanceProteinSubunit) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Subunit == nil) != (other.Subunit == nil) || r.Subunit != nil && *r.Subunit != *other.Subunit {
+		return false
+	}
+	if (r.Sequence == nil) != (other.Sequence == nil) || r.Sequence != nil && !equivalentString(*r.Sequence, *other.Sequence) {
+		return false
+	}
+	if (r.Length == nil) != (other.Length == nil) || r.Length != nil && *r.Length != *other.Length {
+		return false
+	}
+	if (r.NTerminalModification == nil) != (other.NTerminalModification == nil) || r.NTerminalModification != nil && !equivalentString(*r.NTerminalModification, *other.NTerminalModification) {
+		return false
+	}
+	if (r.CTerminalModification == nil) != (other.CTerminalModification == nil) || r.CTerminalModification != nil && !equivalentString(*r.CTerminalModification, *other.CTerminalModification) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceProteinSubunit as FHIR XML
+func (r SubstanceProteinSubunit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "subunit", r.Subunit, r.SubunitElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sequence", r.Sequence, r.SequenceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "length", r.Length, r.LengthElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sequenceAttachment", r.SequenceAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "nTerminalModificationId", r.NTerminalModificationId); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "nTerminalModification", r.NTerminalModification, r.NTerminalModificationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "cTerminalModificationId", r.CTerminalModificationId); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "cTerminalModification", r.CTerminalModification, r.CTerminalModificationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceProteinSubunit from FHIR XML
+func (r *SubstanceProteinSubunit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "subunit":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subunit = &v
+				}
+				r.SubunitElement = element
+			case "sequence":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sequence = &v
+				}
+				r.SequenceElement = element
+			case "length":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Length = &v
+				}
+				r.LengthElement = element
+			case "sequenceAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SequenceAttachment = &v
+			case "nTerminalModificationId":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.NTerminalModificationId = &v
+			case "nTerminalModification":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NTerminalModification = &v
+				}
+				r.NTerminalModificationElement = element
+			case "cTerminalModificationId":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CTerminalModificationId = &v
+			case "cTerminalModification":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CTerminalModification = &v
+				}
+				r.CTerminalModificationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceProteinSubunit as properties to the RDF node n
+func (r SubstanceProteinSubunit) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("SubstanceProtein.subunit.subunit", -1, r.Subunit, r.SubunitElement, "integer")
+	n.primitive("SubstanceProtein.subunit.sequence", -1, r.Sequence, r.SequenceElement, "string")
+	n.primitive("SubstanceProtein.subunit.length", -1, r.Length, r.LengthElement, "integer")
+	n.element("SubstanceProtein.subunit.sequenceAttachment", -1, r.SequenceAttachment)
+	n.element("SubstanceProtein.subunit.nTerminalModificationId", -1, r.NTerminalModificationId)
+	n.primitive("SubstanceProtein.subunit.nTerminalModification", -1, r.NTerminalModification, r.NTerminalModificationElement, "string")
+	n.element("SubstanceProtein.subunit.cTerminalModificationId", -1, r.CTerminalModificationId)
+	n.primitive("SubstanceProtein.subunit.cTerminalModification", -1, r.CTerminalModification, r.CTerminalModificationElement, "string")
+}
+
+// MarshalBSON marshals the given SubstanceProteinSubunit as BSON document with the structure of its FHIR JSON
+func (r SubstanceProteinSubunit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceProteinSubunit from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceProteinSubunit) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceProteinSubunit as protobuf message SubstanceProteinSubunit of fhir.proto
+func (r SubstanceProteinSubunit) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceProteinSubunit to the encoded message
+func (r SubstanceProteinSubunit) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Subunit != nil {
+		e.varint(4, uint64(int64(*r.Subunit)))
+	}
+	if r.Sequence != nil {
+		e.string(5, *r.Sequence)
+	}
+	if r.Length != nil {
+		e.varint(6, uint64(int64(*r.Length)))
+	}
+	if r.SequenceAttachment != nil {
+		e.message(7, *r.SequenceAttachment)
+	}
+	if r.NTerminalModificationId != nil {
+		e.message(8, *r.NTerminalModificationId)
+	}
+	if r.NTerminalModification != nil {
+		e.string(9, *r.NTerminalModification)
+	}
+	if r.CTerminalModificationId != nil {
+		e.message(10, *r.CTerminalModificationId)
+	}
+	if r.CTerminalModification != nil {
+		e.string(11, *r.CTerminalModification)
+	}
+	if r.SubunitElement != nil {
+		e.message(12, *r.SubunitElement)
+	}
+	if r.SequenceElement != nil {
+		e.message(13, *r.SequenceElement)
+	}
+	if r.LengthElement != nil {
+		e.message(14, *r.LengthElement)
+	}
+	if r.NTerminalModificationElement != nil {
+		e.message(15, *r.NTerminalModificationElement)
+	}
+	if r.CTerminalModificationElement != nil {
+		e.message(16, *r.CTerminalModificationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceProteinSubunit from protobuf message SubstanceProteinSubunit of fhir.proto
+func (r *SubstanceProteinSubunit) UnmarshalProto(b []byte) error {
+	*r = SubstanceProteinSubunit{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Subunit = &v
+		case 5:
+			v := d.string()
+			r.Sequence = &v
+		case 6:
+			v := d.int()
+			r.Length = &v
+		case 7:
+			var v Attachment
+			d.message(&v)
+			r.SequenceAttachment = &v
+		case 8:
+			var v Identifier
+			d.message(&v)
+			r.NTerminalModificationId = &v
+		case 9:
+			v := d.string()
+			r.NTerminalModification = &v
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.CTerminalModificationId = &v
+		case 11:
+			v := d.string()
+			r.CTerminalModification = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.SubunitElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.SequenceElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.LengthElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.NTerminalModificationElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.CTerminalModificationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSubstanceProtein unmarshals a SubstanceProtein.
 func UnmarshalSubstanceProtein(b []byte) (SubstanceProtein, error) {
 	var substanceProtein SubstanceProtein
-	if err := json.Unmarshal(b, &substanceProtein); err != nil {
+	if err := substanceProtein.UnmarshalJSON(b); err != nil {
 		return substanceProtein, err
 	}
 	return substanceProtein, nil
@@ -14,25 +14,33 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // SubstanceReferenceInformation is documented here http://hl7.org/fhir/StructureDefinition/SubstanceReferenceInformation
 type SubstanceReferenceInformation struct {
-	Id                *string                                       `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                         `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                       `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                                    `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Comment           *string                                       `bson:"comment,omitempty" json:"comment,omitempty"`
-	Gene              []SubstanceReferenceInformationGene           `bson:"gene,omitempty" json:"gene,omitempty"`
-	GeneElement       []SubstanceReferenceInformationGeneElement    `bson:"geneElement,omitempty" json:"geneElement,omitempty"`
-	Classification    []SubstanceReferenceInformationClassification `bson:"classification,omitempty" json:"classification,omitempty"`
-	Target            []SubstanceReferenceInformationTarget         `bson:"target,omitempty" json:"target,omitempty"`
+	Id                   *string                                       `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                                      `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                      `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                       `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                      `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage                             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Comment              *string                                       `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element                                      `bson:"_comment,omitempty" json:"_comment,omitempty"`
+	Gene                 []SubstanceReferenceInformationGene           `bson:"gene,omitempty" json:"gene,omitempty"`
+	GeneElement          []SubstanceReferenceInformationGeneElement    `bson:"geneElement,omitempty" json:"geneElement,omitempty"`
+	Classification       []SubstanceReferenceInformationClassification `bson:"classification,omitempty" json:"classification,omitempty"`
+	Target               []SubstanceReferenceInformationTarget         `bson:"target,omitempty" json:"target,omitempty"`
 }
 type SubstanceReferenceInformationGene struct {
 	Id                 *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -60,37 +68,3009 @@ type SubstanceReferenceInformationClassification struct {
 	Source            []Reference       `bson:"source,omitempty" json:"source,omitempty"`
 }
 type SubstanceReferenceInformationTarget struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Target            *Identifier      `bson:"target,omitempty" json:"target,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Interaction       *CodeableConcept `bson:"interaction,omitempty" json:"interaction,omitempty"`
-	Organism          *CodeableConcept `bson:"organism,omitempty" json:"organism,omitempty"`
-	OrganismType      *CodeableConcept `bson:"organismType,omitempty" json:"organismType,omitempty"`
-	AmountQuantity    *Quantity        `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
-	AmountRange       *Range           `bson:"amountRange,omitempty" json:"amountRange,omitempty"`
-	AmountString      *string          `bson:"amountString,omitempty" json:"amountString,omitempty"`
-	AmountType        *CodeableConcept `bson:"amountType,omitempty" json:"amountType,omitempty"`
-	Source            []Reference      `bson:"source,omitempty" json:"source,omitempty"`
+	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Target              *Identifier      `bson:"target,omitempty" json:"target,omitempty"`
+	Type                *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	Interaction         *CodeableConcept `bson:"interaction,omitempty" json:"interaction,omitempty"`
+	Organism            *CodeableConcept `bson:"organism,omitempty" json:"organism,omitempty"`
+	OrganismType        *CodeableConcept `bson:"organismType,omitempty" json:"organismType,omitempty"`
+	AmountQuantity      *Quantity        `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
+	AmountRange         *Range           `bson:"amountRange,omitempty" json:"amountRange,omitempty"`
+	AmountString        *string          `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement *Element         `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
+	AmountType          *CodeableConcept `bson:"amountType,omitempty" json:"amountType,omitempty"`
+	Source              []Reference      `bson:"source,omitempty" json:"source,omitempty"`
+}
+
+// SubstanceReferenceInformationTargetAmount is implemented by the types allowed for SubstanceReferenceInformation.target.amount[x]
+type SubstanceReferenceInformationTargetAmount interface {
+	isSubstanceReferenceInformationTargetAmount()
+}
+
+func (Quantity) isSubstanceReferenceInformationTargetAmount() {}
+func (Range) isSubstanceReferenceInformationTargetAmount()    {}
+func (String) isSubstanceReferenceInformationTargetAmount()   {}
+
+// Amount returns the value of SubstanceReferenceInformation.target.amount[x] together with its FHIR type code or nil if no value is set
+func (r SubstanceReferenceInformationTarget) Amount() (SubstanceReferenceInformationTargetAmount, string) {
+	if r.AmountQuantity != nil {
+		return *r.AmountQuantity, "Quantity"
+	}
+	if r.AmountRange != nil {
+		return *r.AmountRange, "Range"
+	}
+	if r.AmountString != nil {
+		return String(*r.AmountString), "string"
+	}
+	return nil, ""
+}
+
+// SetAmount sets the value of SubstanceReferenceInformation.target.amount[x], given as value or pointer, and clears all other types
+func (r *SubstanceReferenceInformationTarget) SetAmount(value SubstanceReferenceInformationTargetAmount) {
+	r.AmountQuantity = nil
+	r.AmountRange = nil
+	r.AmountString = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.AmountQuantity = &v
+	case *Quantity:
+		r.AmountQuantity = v
+	case Range:
+		r.AmountRange = &v
+	case *Range:
+		r.AmountRange = v
+	case String:
+		primitive := string(v)
+		r.AmountString = &primitive
+	case *String:
+		r.AmountString = (*string)(v)
+	}
 }
-type OtherSubstanceReferenceInformation SubstanceReferenceInformation
 
 // MarshalJSON marshals the given SubstanceReferenceInformation as JSON into a byte slice
 func (r SubstanceReferenceInformation) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSubstanceReferenceInformation
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSubstanceReferenceInformation: OtherSubstanceReferenceInformation(r),
-		ResourceType:                       "SubstanceReferenceInformation",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceReferenceInformation as JSON object with the members in the order of the fields
+func (r SubstanceReferenceInformation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"SubstanceReferenceInformation\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	if len(r.Gene) > 0 {
+		e.key("gene")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Gene {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.GeneElement) > 0 {
+		e.key("geneElement")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.GeneElement {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Classification) > 0 {
+		e.key("classification")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Classification {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Target) > 0 {
+		e.key("target")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Target {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceReferenceInformation from JSON. Unknown members are ignored.
+func (r *SubstanceReferenceInformation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceReferenceInformation
+func (r *SubstanceReferenceInformation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		case "gene":
+			a := d.array()
+			r.Gene = nil
+			for a.next() {
+				var v SubstanceReferenceInformationGene
+				v.decodeJSON(d)
+				r.Gene = append(r.Gene, v)
+			}
+			if r.Gene == nil && !a.isNull {
+				r.Gene = []SubstanceReferenceInformationGene{}
+			}
+		case "geneElement":
+			a := d.array()
+			r.GeneElement = nil
+			for a.next() {
+				var v SubstanceReferenceInformationGeneElement
+				v.decodeJSON(d)
+				r.GeneElement = append(r.GeneElement, v)
+			}
+			if r.GeneElement == nil && !a.isNull {
+				r.GeneElement = []SubstanceReferenceInformationGeneElement{}
+			}
+		case "classification":
+			a := d.array()
+			r.Classification = nil
+			for a.next() {
+				var v SubstanceReferenceInformationClassification
+				v.decodeJSON(d)
+				r.Classification = append(r.Classification, v)
+			}
+			if r.Classification == nil && !a.isNull {
+				r.Classification = []SubstanceReferenceInformationClassification{}
+			}
+		case "target":
+			a := d.array()
+			r.Target = nil
+			for a.next() {
+				var v SubstanceReferenceInformationTarget
+				v.decodeJSON(d)
+				r.Target = append(r.Target, v)
+			}
+			if r.Target == nil && !a.isNull {
+				r.Target = []SubstanceReferenceInformationTarget{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceReferenceInformation which shares no memory with the original
+func (r SubstanceReferenceInformation) DeepCopy() SubstanceReferenceInformation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	if r.Gene != nil {
+		out.Gene = make([]SubstanceReferenceInformationGene, len(r.Gene))
+		for i := range r.Gene {
+			out.Gene[i] = r.Gene[i].DeepCopy()
+		}
+	}
+	if r.GeneElement != nil {
+		out.GeneElement = make([]SubstanceReferenceInformationGeneElement, len(r.GeneElement))
+		for i := range r.GeneElement {
+			out.GeneElement[i] = r.GeneElement[i].DeepCopy()
+		}
+	}
+	if r.Classification != nil {
+		out.Classification = make([]SubstanceReferenceInformationClassification, len(r.Classification))
+		for i := range r.Classification {
+			out.Classification[i] = r.Classification[i].DeepCopy()
+		}
+	}
+	if r.Target != nil {
+		out.Target = make([]SubstanceReferenceInformationTarget, len(r.Target))
+		for i := range r.Target {
+			out.Target[i] = r.Target[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceReferenceInformation) Equal(other SubstanceReferenceInformation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	if len(r.Gene) != len(other.Gene) {
+		return false
+	}
+	for i := range r.Gene {
+		if !r.Gene[i].Equal(other.Gene[i]) {
+			return false
+		}
+	}
+	if len(r.GeneElement) != len(other.GeneElement) {
+		return false
+	}
+	for i := range r.GeneElement {
+		if !r.GeneElement[i].Equal(other.GeneElement[i]) {
+			return false
+		}
+	}
+	if len(r.Classification) != len(other.Classification) {
+		return false
+	}
+	for i := range r.Classification {
+		if !r.Classification[i].Equal(other.Classification[i]) {
+			return false
+		}
+	}
+	if len(r.Target) != len(other.Target) {
+		return false
+	}
+	for i := range r.Target {
+		if !r.Target[i].Equal(other.Target[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceReferenceInformation) EqualsDeep(other SubstanceReferenceInformation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	if !equivalentList(len(r.Gene), len(other.Gene), func(i, j int) bool {
+		return r.Gene[i].EqualsDeep(other.Gene[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.GeneElement), len(other.GeneElement), func(i, j int) bool {
+		return r.GeneElement[i].EqualsDeep(other.GeneElement[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Classification), len(other.Classification), func(i, j int) bool {
+		return r.Classification[i].EqualsDeep(other.Classification[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i].EqualsDeep(other.Target[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceReferenceInformation) EqualsShallow(other SubstanceReferenceInformation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceReferenceInformation as FHIR XML
+func (r SubstanceReferenceInformation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "SubstanceReferenceInformation",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	for _, v := range r.Gene {
+		if err := encodeXMLElement(e, "gene", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.GeneElement {
+		if err := encodeXMLElement(e, "geneElement", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Classification {
+		if err := encodeXMLElement(e, "classification", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Target {
+		if err := encodeXMLElement(e, "target", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceReferenceInformation from FHIR XML
+func (r *SubstanceReferenceInformation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			case "gene":
+				var v SubstanceReferenceInformationGene
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Gene = append(r.Gene, v)
+			case "geneElement":
+				var v SubstanceReferenceInformationGeneElement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GeneElement = append(r.GeneElement, v)
+			case "classification":
+				var v SubstanceReferenceInformationClassification
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Classification = append(r.Classification, v)
+			case "target":
+				var v SubstanceReferenceInformationTarget
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = append(r.Target, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceReferenceInformation as properties to the RDF node n
+func (r SubstanceReferenceInformation) turtle(n *turtleNode) {
+	n.resource("SubstanceReferenceInformation", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("SubstanceReferenceInformation.comment", -1, r.Comment, r.CommentElement, "string")
+	for i, v := range r.Gene {
+		n.element("SubstanceReferenceInformation.gene", i, v)
+	}
+	for i, v := range r.GeneElement {
+		n.element("SubstanceReferenceInformation.geneElement", i, v)
+	}
+	for i, v := range r.Classification {
+		n.element("SubstanceReferenceInformation.classification", i, v)
+	}
+	for i, v := range r.Target {
+		n.element("SubstanceReferenceInformation.target", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceReferenceInformation as BSON document with the structure of its FHIR JSON
+func (r SubstanceReferenceInformation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceReferenceInformation from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceReferenceInformation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceReferenceInformation as protobuf message SubstanceReferenceInformation of fhir.proto
+func (r SubstanceReferenceInformation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceReferenceInformation to the encoded message
+func (r SubstanceReferenceInformation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Comment != nil {
+		e.string(9, *r.Comment)
+	}
+	for _, v := range r.Gene {
+		e.message(10, v)
+	}
+	for _, v := range r.GeneElement {
+		e.message(11, v)
+	}
+	for _, v := range r.Classification {
+		e.message(12, v)
+	}
+	for _, v := range r.Target {
+		e.message(13, v)
+	}
+	if r.IdElement != nil {
+		e.message(14, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(15, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(16, *r.LanguageElement)
+	}
+	if r.CommentElement != nil {
+		e.message(17, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceReferenceInformation from protobuf message SubstanceReferenceInformation of fhir.proto
+func (r *SubstanceReferenceInformation) UnmarshalProto(b []byte) error {
+	*r = SubstanceReferenceInformation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Comment = &v
+		case 10:
+			var v SubstanceReferenceInformationGene
+			d.message(&v)
+			r.Gene = append(r.Gene, v)
+		case 11:
+			var v SubstanceReferenceInformationGeneElement
+			d.message(&v)
+			r.GeneElement = append(r.GeneElement, v)
+		case 12:
+			var v SubstanceReferenceInformationClassification
+			d.message(&v)
+			r.Classification = append(r.Classification, v)
+		case 13:
+			var v SubstanceReferenceInformationTarget
+			d.message(&v)
+			r.Target = append(r.Target, v)
+		case 14:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceReferenceInformationGene as JSON into a byte slice
+func (r SubstanceReferenceInformationGene) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceReferenceInformationGene as JSON object with the members in the order of the fields
+func (r SubstanceReferenceInformationGene) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.GeneSequenceOrigin != nil {
+		e.key("geneSequenceOrigin")
+		r.GeneSequenceOrigin.appendJSON(e)
+	}
+	if r.Gene != nil {
+		e.key("gene")
+		r.Gene.appendJSON(e)
+	}
+	if len(r.Source) > 0 {
+		e.key("source")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceReferenceInformationGene from JSON. Unknown members are ignored.
+func (r *SubstanceReferenceInformationGene) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceReferenceInformationGene
+func (r *SubstanceReferenceInformationGene) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "geneSequenceOrigin":
+			if d.null() {
+				r.GeneSequenceOrigin = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.GeneSequenceOrigin = &v
+			}
+		case "gene":
+			if d.null() {
+				r.Gene = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Gene = &v
+			}
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceReferenceInformationGene which shares no memory with the original
+func (r SubstanceReferenceInformationGene) DeepCopy() SubstanceReferenceInformationGene {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.GeneSequenceOrigin != nil {
+		v := r.GeneSequenceOrigin.DeepCopy()
+		out.GeneSequenceOrigin = &v
+	}
+	if r.Gene != nil {
+		v := r.Gene.DeepCopy()
+		out.Gene = &v
+	}
+	if r.Source != nil {
+		out.Source = make([]Reference, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceReferenceInformationGene) Equal(other SubstanceReferenceInformationGene) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.GeneSequenceOrigin == nil) != (other.GeneSequenceOrigin == nil) || r.GeneSequenceOrigin != nil && !r.GeneSequenceOrigin.Equal(*other.GeneSequenceOrigin) {
+		return false
+	}
+	if (r.Gene == nil) != (other.Gene == nil) || r.Gene != nil && !r.Gene.Equal(*other.Gene) {
+		return false
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceReferenceInformationGene) EqualsDeep(other SubstanceReferenceInformationGene) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.GeneSequenceOrigin == nil) != (other.GeneSequenceOrigin == nil) || r.GeneSequenceOrigin != nil && !r.GeneSequenceOrigin.EqualsDeep(*other.GeneSequenceOrigin) {
+		return false
+	}
+	if (r.Gene == nil) != (other.Gene == nil) || r.Gene != nil && !r.Gene.EqualsDeep(*other.Gene) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceReferenceInformationGene) EqualsShallow(other SubstanceReferenceInformationGene) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceReferenceInformationGene as FHIR XML
+func (r SubstanceReferenceInformationGene) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "geneSequenceOrigin", r.GeneSequenceOrigin); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "gene", r.Gene); err != nil {
+		return err
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceReferenceInformationGene from FHIR XML
+func (r *SubstanceReferenceInformationGene) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "geneSequenceOrigin":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GeneSequenceOrigin = &v
+			case "gene":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Gene = &v
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceReferenceInformationGene as properties to the RDF node n
+func (r SubstanceReferenceInformationGene) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceReferenceInformation.gene.geneSequenceOrigin", -1, r.GeneSequenceOrigin)
+	n.element("SubstanceReferenceInformation.gene.gene", -1, r.Gene)
+	for i, v := range r.Source {
+		n.element("SubstanceReferenceInformation.gene.source", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceReferenceInformationGene as BSON document with the structure of its FHIR JSON
+func (r SubstanceReferenceInformationGene) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceReferenceInformationGene from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceReferenceInformationGene) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceReferenceInformationGene as protobuf message SubstanceReferenceInformationGene of fhir.proto
+func (r SubstanceReferenceInformationGene) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceReferenceInformationGene to the encoded message
+func (r SubstanceReferenceInformationGene) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.GeneSequenceOrigin != nil {
+		e.message(4, *r.GeneSequenceOrigin)
+	}
+	if r.Gene != nil {
+		e.message(5, *r.Gene)
+	}
+	for _, v := range r.Source {
+		e.message(6, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceReferenceInformationGene from protobuf message SubstanceReferenceInformationGene of fhir.proto
+func (r *SubstanceReferenceInformationGene) UnmarshalProto(b []byte) error {
+	*r = SubstanceReferenceInformationGene{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.GeneSequenceOrigin = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Gene = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceReferenceInformationGeneElement as JSON into a byte slice
+func (r SubstanceReferenceInformationGeneElement) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceReferenceInformationGeneElement as JSON object with the members in the order of the fields
+func (r SubstanceReferenceInformationGeneElement) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Element != nil {
+		e.key("element")
+		r.Element.appendJSON(e)
+	}
+	if len(r.Source) > 0 {
+		e.key("source")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceReferenceInformationGeneElement from JSON. Unknown members are ignored.
+func (r *SubstanceReferenceInformationGeneElement) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceReferenceInformationGeneElement
+func (r *SubstanceReferenceInformationGeneElement) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "element":
+			if d.null() {
+				r.Element = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Element = &v
+			}
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceReferenceInformationGeneElement which shares no memory with the original
+func (r SubstanceReferenceInformationGeneElement) DeepCopy() SubstanceReferenceInformationGeneElement {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Element != nil {
+		v := r.Element.DeepCopy()
+		out.Element = &v
+	}
+	if r.Source != nil {
+		out.Source = make([]Reference, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceReferenceInformationGeneElement) Equal(other SubstanceReferenceInformationGeneElement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Element == nil) != (other.Element == nil) || r.Element != nil && !r.Element.Equal(*other.Element) {
+		return false
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceReferenceInformationGeneElement) EqualsDeep(other SubstanceReferenceInformationGeneElement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Element == nil) != (other.Element == nil) || r.Element != nil && !r.Element.EqualsDeep(*other.Element) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceReferenceInformationGeneElement) EqualsShallow(other SubstanceReferenceInformationGeneElement) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceReferenceInformationGeneElement as FHIR XML
+func (r SubstanceReferenceInformationGeneElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "element", r.Element); err != nil {
+		return err
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceReferenceInformationGeneElement from FHIR XML
+func (r *SubstanceReferenceInformationGeneElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "element":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Element = &v
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceReferenceInformationGeneElement as properties to the RDF node n
+func (r SubstanceReferenceInformationGeneElement) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceReferenceInformation.geneElement.type", -1, r.Type)
+	n.element("SubstanceReferenceInformation.geneElement.element", -1, r.Element)
+	for i, v := range r.Source {
+		n.element("SubstanceReferenceInformation.geneElement.source", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceReferenceInformationGeneElement as BSON document with the structure of its FHIR JSON
+func (r SubstanceReferenceInformationGeneElement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceReferenceInformationGeneElement from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceReferenceInformationGeneElement) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceReferenceInformationGeneElement as protobuf message SubstanceReferenceInformationGeneElement of fhir.proto
+func (r SubstanceReferenceInformationGeneElement) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceReferenceInformationGeneElement to the encoded message
+func (r SubstanceReferenceInformationGeneElement) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Element != nil {
+		e.message(5, *r.Element)
+	}
+	for _, v := range r.Source {
+		e.message(6, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceReferenceInformationGeneElement from protobuf message SubstanceReferenceInformationGeneElement of fhir.proto
+func (r *SubstanceReferenceInformationGeneElement) UnmarshalProto(b []byte) error {
+	*r = SubstanceReferenceInformationGeneElement{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.Element = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceReferenceInformationClassification as JSON into a byte slice
+func (r SubstanceReferenceInformationClassification) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceReferenceInformationClassification as JSON object with the members in the order of the fields
+func (r SubstanceReferenceInformationClassification) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Domain != nil {
+		e.key("domain")
+		r.Domain.appendJSON(e)
+	}
+	if r.Classification != nil {
+		e.key("classification")
+		r.Classification.appendJSON(e)
+	}
+	if len(r.Subtype) > 0 {
+		e.key("subtype")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Subtype {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Source) > 0 {
+		e.key("source")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceReferenceInformationClassification from JSON. Unknown members are ignored.
+func (r *SubstanceReferenceInformationClassification) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceReferenceInformationClassification
+func (r *SubstanceReferenceInformationClassification) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "domain":
+			if d.null() {
+				r.Domain = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Domain = &v
+			}
+		case "classification":
+			if d.null() {
+				r.Classification = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Classification = &v
+			}
+		case "subtype":
+			a := d.array()
+			r.Subtype = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Subtype = append(r.Subtype, v)
+			}
+			if r.Subtype == nil && !a.isNull {
+				r.Subtype = []CodeableConcept{}
+			}
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceReferenceInformationClassification which shares no memory with the original
+func (r SubstanceReferenceInformationClassification) DeepCopy() SubstanceReferenceInformationClassification {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Domain != nil {
+		v := r.Domain.DeepCopy()
+		out.Domain = &v
+	}
+	if r.Classification != nil {
+		v := r.Classification.DeepCopy()
+		out.Classification = &v
+	}
+	if r.Subtype != nil {
+		out.Subtype = make([]CodeableConcept, len(r.Subtype))
+		for i := range r.Subtype {
+			out.Subtype[i] = r.Subtype[i].DeepCopy()
+		}
+	}
+	if r.Source != nil {
+		out.Source = make([]Reference, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceReferenceInformationClassification) Equal(other SubstanceReferenceInformationClassification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Domain == nil) != (other.Domain == nil) || r.Domain != nil && !r.Domain.Equal(*other.Domain) {
+		return false
+	}
+	if (r.Classification == nil) != (other.Classification == nil) || r.Classification != nil && !r.Classification.Equal(*other.Classification) {
+		return false
+	}
+	if len(r.Subtype) != len(other.Subtype) {
+		return false
+	}
+	for i := range r.Subtype {
+		if !r.Subtype[i].Equal(other.Subtype[i]) {
+			return false
+		}
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceReferenceInformationClassification) EqualsDeep(other SubstanceReferenceInformationClassification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Domain == nil) != (other.Domain == nil) || r.Domain != nil && !r.Domain.EqualsDeep(*other.Domain) {
+		return false
+	}
+	if (r.Classification == nil) != (other.Classification == nil) || r.Classification != nil && !r.Classification.EqualsDeep(*other.Classification) {
+		return false
+	}
+	if !equivalentList(len(r.Subtype), len(other.Subtype), func(i, j int) bool {
+		return r.Subtype[i].EqualsDeep(other.Subtype[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceReferenceInformationClassification) EqualsShallow(other SubstanceReferenceInformationClassification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceReferenceInformationClassification as FHIR XML
+func (r SubstanceReferenceInformationClassification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "domain", r.Domain); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "classification", r.Classification); err != nil {
+		return err
+	}
+	for _, v := range r.Subtype {
+		if err := encodeXMLElement(e, "subtype", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceReferenceInformationClassification from FHIR XML
+func (r *SubstanceReferenceInformationClassification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "domain":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Domain = &v
+			case "classification":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Classification = &v
+			case "subtype":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Subtype = append(r.Subtype, v)
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceReferenceInformationClassification as properties to the RDF node n
+func (r SubstanceReferenceInformationClassification) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceReferenceInformation.classification.domain", -1, r.Domain)
+	n.element("SubstanceReferenceInformation.classification.classification", -1, r.Classification)
+	for i, v := range r.Subtype {
+		n.element("SubstanceReferenceInformation.classification.subtype", i, v)
+	}
+	for i, v := range r.Source {
+		n.element("SubstanceReferenceInformation.classification.source", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceReferenceInformationClassification as BSON document with the structure of its FHIR JSON
+func (r SubstanceReferenceInformationClassification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceReferenceInformationClassification from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceReferenceInformationClassification) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceReferenceInformationClassification as protobuf message SubstanceReferenceInformationClassification of fhir.proto
+func (r SubstanceReferenceInformationClassification) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceReferenceInformationClassification to the encoded message
+func (r SubstanceReferenceInformationClassification) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Domain != nil {
+		e.message(4, *r.Domain)
+	}
+	if r.Classification != nil {
+		e.message(5, *r.Classification)
+	}
+	for _, v := range r.Subtype {
+		e.message(6, v)
+	}
+	for _, v := range r.Source {
+		e.message(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceReferenceInformationClassification from protobuf message SubstanceReferenceInformationClassification of fhir.proto
+func (r *SubstanceReferenceInformationClassification) UnmarshalProto(b []byte) error {
+	*r = SubstanceReferenceInformationClassification{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Domain = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Classification = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Subtype = append(r.Subtype, v)
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceReferenceInformationTarget as JSON into a byte slice
+func (r SubstanceReferenceInformationTarget) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceReferenceInformationTarget as JSON object with the members in the order of the fields
+func (r SubstanceReferenceInformationTarget) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Target != nil {
+		e.key("target")
+		r.Target.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Interaction != nil {
+		e.key("interaction")
+		r.Interaction.appendJSON(e)
+	}
+	if r.Organism != nil {
+		e.key("organism")
+		r.Organism.appendJSON(e)
+	}
+	if r.OrganismType != nil {
+		e.key("organismType")
+		r.OrganismType.appendJSON(e)
+	}
+	if r.AmountQuantity != nil {
+		e.key("amountQuantity")
+		r.AmountQuantity.appendJSON(e)
+	}
+	if r.AmountRange != nil {
+		e.key("amountRange")
+		r.AmountRange.appendJSON(e)
+	}
+	if r.AmountString != nil {
+		e.key("amountString")
+		e.string(*r.AmountString)
+	}
+	if r.AmountStringElement != nil {
+		e.key("_amountString")
+		r.AmountStringElement.appendJSON(e)
+	}
+	if r.AmountType != nil {
+		e.key("amountType")
+		r.AmountType.appendJSON(e)
+	}
+	if len(r.Source) > 0 {
+		e.key("source")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceReferenceInformationTarget from JSON. Unknown members are ignored.
+func (r *SubstanceReferenceInformationTarget) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceReferenceInformationTarget
+func (r *SubstanceReferenceInformationTarget) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "target":
+			if d.null() {
+				r.Target = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Target = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "interaction":
+			if d.null() {
+				r.Interaction = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Interaction = &v
+			}
+		case "organism":
+			if d.null() {
+				r.Organism = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Organism = &v
+			}
+		case "organismType":
+			if d.null() {
+				r.OrganismType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OrganismType = &v
+			}
+		case "amountQuantity":
+			if d.null() {
+				r.AmountQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.AmountQuantity = &v
+			}
+		case "amountRange":
+			if d.null() {
+				r.AmountRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.AmountRange = &v
+			}
+		case "amountString":
+			if v, ok := d.string(); ok {
+				r.AmountString = &v
+			} else {
+				r.AmountString = nil
+			}
+		case "_amountString":
+			r.AmountStringElement = d.element()
+		case "amountType":
+			if d.null() {
+				r.AmountType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AmountType = &v
+			}
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceReferenceInformationTarget which shares no memory with the original
+func (r SubstanceReferenceInformationTarget) DeepCopy() SubstanceReferenceInformationTarget {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Target != nil {
+		v := r.Target.DeepCopy()
+		out.Target = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Interaction != nil {
+		v := r.Interaction.DeepCopy()
+		out.Interaction = &v
+	}
+	if r.Organism != nil {
+		v := r.Organism.DeepCopy()
+		out.Organism = &v
+	}
+	if r.OrganismType != nil {
+		v := r.OrganismType.DeepCopy()
+		out.OrganismType = &v
+	}
+	if r.AmountQuantity != nil {
+		v := r.AmountQuantity.DeepCopy()
+		out.AmountQuantity = &v
+	}
+	if r.AmountRange != nil {
+		v := r.AmountRange.DeepCopy()
+		out.AmountRange = &v
+	}
+	if r.AmountString != nil {
+		v := *r.AmountString
+		out.AmountString = &v
+	}
+	if r.AmountStringElement != nil {
+		v := r.AmountStringElement.DeepCopy()
+		out.AmountStringElement = &v
+	}
+	if r.AmountType != nil {
+		v := r.AmountType.DeepCopy()
+		out.AmountType = &v
+	}
+	if r.Source != nil {
+		out.Source = make([]Reference, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceReferenceInformationTarget) Equal(other SubstanceReferenceInformationTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Target == nil) != (other.Target == nil) || r.Target != nil && !r.Target.Equal(*other.Target) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Interaction == nil) != (other.Interaction == nil) || r.Interaction != nil && !r.Interaction.Equal(*other.Interaction) {
+		return false
+	}
+	if (r.Organism == nil) != (other.Organism == nil) || r.Organism != nil && !r.Organism.Equal(*other.Organism) {
+		return false
+	}
+	if (r.OrganismType == nil) != (other.OrganismType == nil) || r.OrganismType != nil && !r.OrganismType.Equal(*other.OrganismType) {
+		return false
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.Equal(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountRange == nil) != (other.AmountRange == nil) || r.AmountRange != nil && !r.AmountRange.Equal(*other.AmountRange) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && *r.AmountString != *other.AmountString {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.Equal(*other.AmountStringElement) {
+		return false
+	}
+	if (r.AmountType == nil) != (other.AmountType == nil) || r.AmountType != nil && !r.AmountType.Equal(*other.AmountType) {
+		return false
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceReferenceInformationTarget) EqualsDeep(other SubstanceReferenceInformationTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Target == nil) != (other.Target == nil) || r.Target != nil && !r.Target.EqualsDeep(*other.Target) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Interaction == nil) != (other.Interaction == nil) || r.Interaction != nil && !r.Interaction.EqualsDeep(*other.Interaction) {
+		return false
+	}
+	if (r.Organism == nil) != (other.Organism == nil) || r.Organism != nil && !r.Organism.EqualsDeep(*other.Organism) {
+		return false
+	}
+	if (r.OrganismType == nil) != (other.OrganismType == nil) || r.OrganismType != nil && !r.OrganismType.EqualsDeep(*other.OrganismType) {
+		return false
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.EqualsDeep(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountRange == nil) != (other.AmountRange == nil) || r.AmountRange != nil && !r.AmountRange.EqualsDeep(*other.AmountRange) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.EqualsDeep(*other.AmountStringElement) {
+		return false
+	}
+	if (r.AmountType == nil) != (other.AmountType == nil) || r.AmountType != nil && !r.AmountType.EqualsDeep(*other.AmountType) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceReferenceInformationTarget) EqualsShallow(other SubstanceReferenceInformationTarget) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceReferenceInformationTarget as FHIR XML
+func (r SubstanceReferenceInformationTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "target", r.Target); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "interaction", r.Interaction); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "organism", r.Organism); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "organismType", r.OrganismType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountQuantity", r.AmountQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountRange", r.AmountRange); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "amountString", r.AmountString, r.AmountStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountType", r.AmountType); err != nil {
+		return err
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceReferenceInformationTarget from FHIR XML
+func (r *SubstanceReferenceInformationTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "target":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "interaction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Interaction = &v
+			case "organism":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Organism = &v
+			case "organismType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OrganismType = &v
+			case "amountQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountQuantity = &v
+			case "amountRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountRange = &v
+			case "amountString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AmountString = &v
+				}
+				r.AmountStringElement = element
+			case "amountType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountType = &v
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceReferenceInformationTarget as properties to the RDF node n
+func (r SubstanceReferenceInformationTarget) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceReferenceInformation.target.target", -1, r.Target)
+	n.element("SubstanceReferenceInformation.target.type", -1, r.Type)
+	n.element("SubstanceReferenceInformation.target.interaction", -1, r.Interaction)
+	n.element("SubstanceReferenceInformation.target.organism", -1, r.Organism)
+	n.element("SubstanceReferenceInformation.target.organismType", -1, r.OrganismType)
+	n.element("SubstanceReferenceInformation.target.amountQuantity", -1, r.AmountQuantity)
+	n.element("SubstanceReferenceInformation.target.amountRange", -1, r.AmountRange)
+	n.primitive("SubstanceReferenceInformation.target.amountString", -1, r.AmountString, r.AmountStringElement, "string")
+	n.element("SubstanceReferenceInformation.target.amountType", -1, r.AmountType)
+	for i, v := range r.Source {
+		n.element("SubstanceReferenceInformation.target.source", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceReferenceInformationTarget as BSON document with the structure of its FHIR JSON
+func (r SubstanceReferenceInformationTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceReferenceInformationTarget from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceReferenceInformationTarget) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceReferenceInformationTarget as protobuf message SubstanceReferenceInformationTarget of fhir.proto
+func (r SubstanceReferenceInformationTarget) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceReferenceInformationTarget to the encoded message
+func (r SubstanceReferenceInformationTarget) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Target != nil {
+		e.message(4, *r.Target)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.Interaction != nil {
+		e.message(6, *r.Interaction)
+	}
+	if r.Organism != nil {
+		e.message(7, *r.Organism)
+	}
+	if r.OrganismType != nil {
+		e.message(8, *r.OrganismType)
+	}
+	if r.AmountQuantity != nil {
+		e.message(9, *r.AmountQuantity)
+	}
+	if r.AmountRange != nil {
+		e.message(10, *r.AmountRange)
+	}
+	if r.AmountString != nil {
+		e.string(11, *r.AmountString)
+	}
+	if r.AmountType != nil {
+		e.message(12, *r.AmountType)
+	}
+	for _, v := range r.Source {
+		e.message(13, v)
+	}
+	if r.AmountStringElement != nil {
+		e.message(14, *r.AmountStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceReferenceInformationTarget from protobuf message SubstanceReferenceInformationTarget of fhir.proto
+func (r *SubstanceReferenceInformationTarget) UnmarshalProto(b []byte) error {
+	*r = SubstanceReferenceInformationTarget{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Target = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Interaction = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Organism = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.OrganismType = &v
+		case 9:
+			var v Quantity
+			d.message(&v)
+			r.AmountQuantity = &v
+		case 10:
+			var v Range
+			d.message(&v)
+			r.AmountRange = &v
+		case 11:
+			v := d.string()
+			r.AmountString = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.AmountType = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		case 14:
+			var v Element
+			d.message(&v)
+			r.AmountStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSubstanceReferenceInformation unmarshals a SubstanceReferenceInformation.
 func UnmarshalSubstanceReferenceInformation(b []byte) (SubstanceReferenceInformation, error) {
 	var substanceReferenceInformation SubstanceReferenceInformation
-	if err := json.Unmarshal(b, &substanceReferenceInformation); err != nil {
+	if err := substanceReferenceInformation.UnmarshalJSON(b); err != nil {
 		return substanceReferenceInformation, err
 	}
 	return substanceReferenceInformation, nil
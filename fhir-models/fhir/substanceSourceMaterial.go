@@ -14,70 +14,87 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // SubstanceSourceMaterial is documented here http://hl7.org/fhir/StructureDefinition/SubstanceSourceMaterial
 type SubstanceSourceMaterial struct {
-	Id                   *string                                      `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                 *Meta                                        `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules        *string                                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language             *string                                      `bson:"language,omitempty" json:"language,omitempty"`
-	Text                 *Narrative                                   `bson:"text,omitempty" json:"text,omitempty"`
-	Extension            []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	SourceMaterialClass  *CodeableConcept                             `bson:"sourceMaterialClass,omitempty" json:"sourceMaterialClass,omitempty"`
-	SourceMaterialType   *CodeableConcept                             `bson:"sourceMaterialType,omitempty" json:"sourceMaterialType,omitempty"`
-	SourceMaterialState  *CodeableConcept                             `bson:"sourceMaterialState,omitempty" json:"sourceMaterialState,omitempty"`
-	OrganismId           *Identifier                                  `bson:"organismId,omitempty" json:"organismId,omitempty"`
-	OrganismName         *string                                      `bson:"organismName,omitempty" json:"organismName,omitempty"`
-	ParentSubstanceId    []Identifier                                 `bson:"parentSubstanceId,omitempty" json:"parentSubstanceId,omitempty"`
-	ParentSubstanceName  []string                                     `bson:"parentSubstanceName,omitempty" json:"parentSubstanceName,omitempty"`
-	CountryOfOrigin      []CodeableConcept                            `bson:"countryOfOrigin,omitempty" json:"countryOfOrigin,omitempty"`
-	GeographicalLocation []string                                     `bson:"geographicalLocation,omitempty" json:"geographicalLocation,omitempty"`
-	DevelopmentStage     *CodeableConcept                             `bson:"developmentStage,omitempty" json:"developmentStage,omitempty"`
-	FractionDescription  []SubstanceSourceMaterialFractionDescription `bson:"fractionDescription,omitempty" json:"fractionDescription,omitempty"`
-	Organism             *SubstanceSourceMaterialOrganism             `bson:"organism,omitempty" json:"organism,omitempty"`
-	PartDescription      []SubstanceSourceMaterialPartDescription     `bson:"partDescription,omitempty" json:"partDescription,omitempty"`
+	Id                          *string                                      `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                   *Element                                     `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                        *Meta                                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules               *string                                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement        *Element                                     `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                    *string                                      `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement             *Element                                     `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                        *Narrative                                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                   []json.RawMessage                            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                   []Extension                                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension                                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	SourceMaterialClass         *CodeableConcept                             `bson:"sourceMaterialClass,omitempty" json:"sourceMaterialClass,omitempty"`
+	SourceMaterialType          *CodeableConcept                             `bson:"sourceMaterialType,omitempty" json:"sourceMaterialType,omitempty"`
+	SourceMaterialState         *CodeableConcept                             `bson:"sourceMaterialState,omitempty" json:"sourceMaterialState,omitempty"`
+	OrganismId                  *Identifier                                  `bson:"organismId,omitempty" json:"organismId,omitempty"`
+	OrganismName                *string                                      `bson:"organismName,omitempty" json:"organismName,omitempty"`
+	OrganismNameElement         *Element                                     `bson:"_organismName,omitempty" json:"_organismName,omitempty"`
+	ParentSubstanceId           []Identifier                                 `bson:"parentSubstanceId,omitempty" json:"parentSubstanceId,omitempty"`
+	ParentSubstanceName         []string                                     `bson:"parentSubstanceName,omitempty" json:"parentSubstanceName,omitempty"`
+	ParentSubstanceNameElement  []*Element                                   `bson:"_parentSubstanceName,omitempty" json:"_parentSubstanceName,omitempty"`
+	CountryOfOrigin             []CodeableConcept                            `bson:"countryOfOrigin,omitempty" json:"countryOfOrigin,omitempty"`
+	GeographicalLocation        []string                                     `bson:"geographicalLocation,omitempty" json:"geographicalLocation,omitempty"`
+	GeographicalLocationElement []*Element                                   `bson:"_geographicalLocation,omitempty" json:"_geographicalLocation,omitempty"`
+	DevelopmentStage            *CodeableConcept                             `bson:"developmentStage,omitempty" json:"developmentStage,omitempty"`
+	FractionDescription         []SubstanceSourceMaterialFractionDescription `bson:"fractionDescription,omitempty" json:"fractionDescription,omitempty"`
+	Organism                    *SubstanceSourceMaterialOrganism             `bson:"organism,omitempty" json:"organism,omitempty"`
+	PartDescription             []SubstanceSourceMaterialPartDescription     `bson:"partDescription,omitempty" json:"partDescription,omitempty"`
 }
 type SubstanceSourceMaterialFractionDescription struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Fraction          *string          `bson:"fraction,omitempty" json:"fraction,omitempty"`
+	FractionElement   *Element         `bson:"_fraction,omitempty" json:"_fraction,omitempty"`
 	MaterialType      *CodeableConcept `bson:"materialType,omitempty" json:"materialType,omitempty"`
 }
 type SubstanceSourceMaterialOrganism struct {
-	Id                       *string                                         `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension        []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Family                   *CodeableConcept                                `bson:"family,omitempty" json:"family,omitempty"`
-	Genus                    *CodeableConcept                                `bson:"genus,omitempty" json:"genus,omitempty"`
-	Species                  *CodeableConcept                                `bson:"species,omitempty" json:"species,omitempty"`
-	IntraspecificType        *CodeableConcept                                `bson:"intraspecificType,omitempty" json:"intraspecificType,omitempty"`
-	IntraspecificDescription *string                                         `bson:"intraspecificDescription,omitempty" json:"intraspecificDescription,omitempty"`
-	Author                   []SubstanceSourceMaterialOrganismAuthor         `bson:"author,omitempty" json:"author,omitempty"`
-	Hybrid                   *SubstanceSourceMaterialOrganismHybrid          `bson:"hybrid,omitempty" json:"hybrid,omitempty"`
-	OrganismGeneral          *SubstanceSourceMaterialOrganismOrganismGeneral `bson:"organismGeneral,omitempty" json:"organismGeneral,omitempty"`
+	Id                              *string                                         `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                       []Extension                                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension               []Extension                                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Family                          *CodeableConcept                                `bson:"family,omitempty" json:"family,omitempty"`
+	Genus                           *CodeableConcept                                `bson:"genus,omitempty" json:"genus,omitempty"`
+	Species                         *CodeableConcept                                `bson:"species,omitempty" json:"species,omitempty"`
+	IntraspecificType               *CodeableConcept                                `bson:"intraspecificType,omitempty" json:"intraspecificType,omitempty"`
+	IntraspecificDescription        *string                                         `bson:"intraspecificDescription,omitempty" json:"intraspecificDescription,omitempty"`
+	IntraspecificDescriptionElement *Element                                        `bson:"_intraspecificDescription,omitempty" json:"_intraspecificDescription,omitempty"`
+	Author                          []SubstanceSourceMaterialOrganismAuthor         `bson:"author,omitempty" json:"author,omitempty"`
+	Hybrid                          *SubstanceSourceMaterialOrganismHybrid          `bson:"hybrid,omitempty" json:"hybrid,omitempty"`
+	OrganismGeneral                 *SubstanceSourceMaterialOrganismOrganismGeneral `bson:"organismGeneral,omitempty" json:"organismGeneral,omitempty"`
 }
 type SubstanceSourceMaterialOrganismAuthor struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	AuthorType        *CodeableConcept `bson:"authorType,omitempty" json:"authorType,omitempty"`
-	AuthorDescription *string          `bson:"authorDescription,omitempty" json:"authorDescription,omitempty"`
+	Id                       *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	AuthorType               *CodeableConcept `bson:"authorType,omitempty" json:"authorType,omitempty"`
+	AuthorDescription        *string          `bson:"authorDescription,omitempty" json:"authorDescription,omitempty"`
+	AuthorDescriptionElement *Element         `bson:"_authorDescription,omitempty" json:"_authorDescription,omitempty"`
 }
 type SubstanceSourceMaterialOrganismHybrid struct {
-	Id                   *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	MaternalOrganismId   *string          `bson:"maternalOrganismId,omitempty" json:"maternalOrganismId,omitempty"`
-	MaternalOrganismName *string          `bson:"maternalOrganismName,omitempty" json:"maternalOrganismName,omitempty"`
-	PaternalOrganismId   *string          `bson:"paternalOrganismId,omitempty" json:"paternalOrganismId,omitempty"`
-	PaternalOrganismName *string          `bson:"paternalOrganismName,omitempty" json:"paternalOrganismName,omitempty"`
-	HybridType           *CodeableConcept `bson:"hybridType,omitempty" json:"hybridType,omitempty"`
+	Id                          *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                   []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension           []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	MaternalOrganismId          *string          `bson:"maternalOrganismId,omitempty" json:"maternalOrganismId,omitempty"`
+	MaternalOrganismIdElement   *Element         `bson:"_maternalOrganismId,omitempty" json:"_maternalOrganismId,omitempty"`
+	MaternalOrganismName        *string          `bson:"maternalOrganismName,omitempty" json:"maternalOrganismName,omitempty"`
+	MaternalOrganismNameElement *Element         `bson:"_maternalOrganismName,omitempty" json:"_maternalOrganismName,omitempty"`
+	PaternalOrganismId          *string          `bson:"paternalOrganismId,omitempty" json:"paternalOrganismId,omitempty"`
+	PaternalOrganismIdElement   *Element         `bson:"_paternalOrganismId,omitempty" json:"_paternalOrganismId,omitempty"`
+	PaternalOrganismName        *string          `bson:"paternalOrganismName,omitempty" json:"paternalOrganismName,omitempty"`
+	PaternalOrganismNameElement *Element         `bson:"_paternalOrganismName,omitempty" json:"_paternalOrganismName,omitempty"`
+	HybridType                  *CodeableConcept `bson:"hybridType,omitempty" json:"hybridType,omitempty"`
 }
 type SubstanceSourceMaterialOrganismOrganismGeneral struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -95,23 +112,4136 @@ type SubstanceSourceMaterialPartDescription struct {
 	Part              *CodeableConcept `bson:"part,omitempty" json:"part,omitempty"`
 	PartLocation      *CodeableConcept `bson:"partLocation,omitempty" json:"partLocation,omitempty"`
 }
-type OtherSubstanceSourceMaterial SubstanceSourceMaterial
 
 // MarshalJSON marshals the given SubstanceSourceMaterial as JSON into a byte slice
 func (r SubstanceSourceMaterial) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSubstanceSourceMaterial
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSubstanceSourceMaterial: OtherSubstanceSourceMaterial(r),
-		ResourceType:                 "SubstanceSourceMaterial",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSourceMaterial as JSON object with the members in the order of the fields
+func (r SubstanceSourceMaterial) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"SubstanceSourceMaterial\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.SourceMaterialClass != nil {
+		e.key("sourceMaterialClass")
+		r.SourceMaterialClass.appendJSON(e)
+	}
+	if r.SourceMaterialType != nil {
+		e.key("sourceMaterialType")
+		r.SourceMaterialType.appendJSON(e)
+	}
+	if r.SourceMaterialState != nil {
+		e.key("sourceMaterialState")
+		r.SourceMaterialState.appendJSON(e)
+	}
+	if r.OrganismId != nil {
+		e.key("organismId")
+		r.OrganismId.appendJSON(e)
+	}
+	if r.OrganismName != nil {
+		e.key("organismName")
+		e.string(*r.OrganismName)
+	}
+	if r.OrganismNameElement != nil {
+		e.key("_organismName")
+		r.OrganismNameElement.appendJSON(e)
+	}
+	if len(r.ParentSubstanceId) > 0 {
+		e.key("parentSubstanceId")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ParentSubstanceId {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ParentSubstanceName) > 0 {
+		e.key("parentSubstanceName")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ParentSubstanceName {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ParentSubstanceNameElement) > 0 {
+		e.key("_parentSubstanceName")
+		e.elements(r.ParentSubstanceNameElement, len(r.ParentSubstanceName))
+	}
+	if len(r.CountryOfOrigin) > 0 {
+		e.key("countryOfOrigin")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CountryOfOrigin {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.GeographicalLocation) > 0 {
+		e.key("geographicalLocation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.GeographicalLocation {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.GeographicalLocationElement) > 0 {
+		e.key("_geographicalLocation")
+		e.elements(r.GeographicalLocationElement, len(r.GeographicalLocation))
+	}
+	if r.DevelopmentStage != nil {
+		e.key("developmentStage")
+		r.DevelopmentStage.appendJSON(e)
+	}
+	if len(r.FractionDescription) > 0 {
+		e.key("fractionDescription")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.FractionDescription {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Organism != nil {
+		e.key("organism")
+		r.Organism.appendJSON(e)
+	}
+	if len(r.PartDescription) > 0 {
+		e.key("partDescription")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartDescription {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSourceMaterial from JSON. Unknown members are ignored.
+func (r *SubstanceSourceMaterial) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSourceMaterial
+func (r *SubstanceSourceMaterial) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "sourceMaterialClass":
+			if d.null() {
+				r.SourceMaterialClass = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SourceMaterialClass = &v
+			}
+		case "sourceMaterialType":
+			if d.null() {
+				r.SourceMaterialType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SourceMaterialType = &v
+			}
+		case "sourceMaterialState":
+			if d.null() {
+				r.SourceMaterialState = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SourceMaterialState = &v
+			}
+		case "organismId":
+			if d.null() {
+				r.OrganismId = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.OrganismId = &v
+			}
+		case "organismName":
+			if v, ok := d.string(); ok {
+				r.OrganismName = &v
+			} else {
+				r.OrganismName = nil
+			}
+		case "_organismName":
+			r.OrganismNameElement = d.element()
+		case "parentSubstanceId":
+			a := d.array()
+			r.ParentSubstanceId = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.ParentSubstanceId = append(r.ParentSubstanceId, v)
+			}
+			if r.ParentSubstanceId == nil && !a.isNull {
+				r.ParentSubstanceId = []Identifier{}
+			}
+		case "parentSubstanceName":
+			a := d.array()
+			r.ParentSubstanceName = nil
+			for a.next() {
+				v, _ := d.string()
+				r.ParentSubstanceName = append(r.ParentSubstanceName, v)
+			}
+			if r.ParentSubstanceName == nil && !a.isNull {
+				r.ParentSubstanceName = []string{}
+			}
+		case "_parentSubstanceName":
+			a := d.array()
+			r.ParentSubstanceNameElement = nil
+			for a.next() {
+				r.ParentSubstanceNameElement = append(r.ParentSubstanceNameElement, d.element())
+			}
+		case "countryOfOrigin":
+			a := d.array()
+			r.CountryOfOrigin = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CountryOfOrigin = append(r.CountryOfOrigin, v)
+			}
+			if r.CountryOfOrigin == nil && !a.isNull {
+				r.CountryOfOrigin = []CodeableConcept{}
+			}
+		case "geographicalLocation":
+			a := d.array()
+			r.GeographicalLocation = nil
+			for a.next() {
+				v, _ := d.string()
+				r.GeographicalLocation = append(r.GeographicalLocation, v)
+			}
+			if r.GeographicalLocation == nil && !a.isNull {
+				r.GeographicalLocation = []string{}
+			}
+		case "_geographicalLocation":
+			a := d.array()
+			r.GeographicalLocationElement = nil
+			for a.next() {
+				r.GeographicalLocationElement = append(r.GeographicalLocationElement, d.element())
+			}
+		case "developmentStage":
+			if d.null() {
+				r.DevelopmentStage = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DevelopmentStage = &v
+			}
+		case "fractionDescription":
+			a := d.array()
+			r.FractionDescription = nil
+			for a.next() {
+				var v SubstanceSourceMaterialFractionDescription
+				v.decodeJSON(d)
+				r.FractionDescription = append(r.FractionDescription, v)
+			}
+			if r.FractionDescription == nil && !a.isNull {
+				r.FractionDescription = []SubstanceSourceMaterialFractionDescription{}
+			}
+		case "organism":
+			if d.null() {
+				r.Organism = nil
+			} else {
+				var v SubstanceSourceMaterialOrganism
+				v.decodeJSON(d)
+				r.Organism = &v
+			}
+		case "partDescription":
+			a := d.array()
+			r.PartDescription = nil
+			for a.next() {
+				var v SubstanceSourceMaterialPartDescription
+				v.decodeJSON(d)
+				r.PartDescription = append(r.PartDescription, v)
+			}
+			if r.PartDescription == nil && !a.isNull {
+				r.PartDescription = []SubstanceSourceMaterialPartDescription{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSourceMaterial which shares no memory with the original
+func (r SubstanceSourceMaterial) DeepCopy() SubstanceSourceMaterial {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SourceMaterialClass != nil {
+		v := r.SourceMaterialClass.DeepCopy()
+		out.SourceMaterialClass = &v
+	}
+	if r.SourceMaterialType != nil {
+		v := r.SourceMaterialType.DeepCopy()
+		out.SourceMaterialType = &v
+	}
+	if r.SourceMaterialState != nil {
+		v := r.SourceMaterialState.DeepCopy()
+		out.SourceMaterialState = &v
+	}
+	if r.OrganismId != nil {
+		v := r.OrganismId.DeepCopy()
+		out.OrganismId = &v
+	}
+	if r.OrganismName != nil {
+		v := *r.OrganismName
+		out.OrganismName = &v
+	}
+	if r.OrganismNameElement != nil {
+		v := r.OrganismNameElement.DeepCopy()
+		out.OrganismNameElement = &v
+	}
+	if r.ParentSubstanceId != nil {
+		out.ParentSubstanceId = make([]Identifier, len(r.ParentSubstanceId))
+		for i := range r.ParentSubstanceId {
+			out.ParentSubstanceId[i] = r.ParentSubstanceId[i].DeepCopy()
+		}
+	}
+	if r.ParentSubstanceName != nil {
+		out.ParentSubstanceName = make([]string, len(r.ParentSubstanceName))
+		copy(out.ParentSubstanceName, r.ParentSubstanceName)
+	}
+	if r.ParentSubstanceNameElement != nil {
+		out.ParentSubstanceNameElement = make([]*Element, len(r.ParentSubstanceNameElement))
+		for i, v := range r.ParentSubstanceNameElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.ParentSubstanceNameElement[i] = &c
+			}
+		}
+	}
+	if r.CountryOfOrigin != nil {
+		out.CountryOfOrigin = make([]CodeableConcept, len(r.CountryOfOrigin))
+		for i := range r.CountryOfOrigin {
+			out.CountryOfOrigin[i] = r.CountryOfOrigin[i].DeepCopy()
+		}
+	}
+	if r.GeographicalLocation != nil {
+		out.GeographicalLocation = make([]string, len(r.GeographicalLocation))
+		copy(out.GeographicalLocation, r.GeographicalLocation)
+	}
+	if r.GeographicalLocationElement != nil {
+		out.GeographicalLocationElement = make([]*Element, len(r.GeographicalLocationElement))
+		for i, v := range r.GeographicalLocationElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.GeographicalLocationElement[i] = &c
+			}
+		}
+	}
+	if r.DevelopmentStage != nil {
+		v := r.DevelopmentStage.DeepCopy()
+		out.DevelopmentStage = &v
+	}
+	if r.FractionDescription != nil {
+		out.FractionDescription = make([]SubstanceSourceMaterialFractionDescription, len(r.FractionDescription))
+		for i := range r.FractionDescription {
+			out.FractionDescription[i] = r.FractionDescription[i].DeepCopy()
+		}
+	}
+	if r.Organism != nil {
+		v := r.Organism.DeepCopy()
+		out.Organism = &v
+	}
+	if r.PartDescription != nil {
+		out.PartDescription = make([]SubstanceSourceMaterialPartDescription, len(r.PartDescription))
+		for i := range r.PartDescription {
+			out.PartDescription[i] = r.PartDescription[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSourceMaterial) Equal(other SubstanceSourceMaterial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.SourceMaterialClass == nil) != (other.SourceMaterialClass == nil) || r.SourceMaterialClass != nil && !r.SourceMaterialClass.Equal(*other.SourceMaterialClass) {
+		return false
+	}
+	if (r.SourceMaterialType == nil) != (other.SourceMaterialType == nil) || r.SourceMaterialType != nil && !r.SourceMaterialType.Equal(*other.SourceMaterialType) {
+		return false
+	}
+	if (r.SourceMaterialState == nil) != (other.SourceMaterialState == nil) || r.SourceMaterialState != nil && !r.SourceMaterialState.Equal(*other.SourceMaterialState) {
+		return false
+	}
+	if (r.OrganismId == nil) != (other.OrganismId == nil) || r.OrganismId != nil && !r.OrganismId.Equal(*other.OrganismId) {
+		return false
+	}
+	if (r.OrganismName == nil) != (other.OrganismName == nil) || r.OrganismName != nil && *r.OrganismName != *other.OrganismName {
+		return false
+	}
+	if (r.OrganismNameElement == nil) != (other.OrganismNameElement == nil) || r.OrganismNameElement != nil && !r.OrganismNameElement.Equal(*other.OrganismNameElement) {
+		return false
+	}
+	if len(r.ParentSubstanceId) != len(other.ParentSubstanceId) {
+		return false
+	}
+	for i := range r.ParentSubstanceId {
+		if !r.ParentSubstanceId[i].Equal(other.ParentSubstanceId[i]) {
+			return false
+		}
+	}
+	if len(r.ParentSubstanceName) != len(other.ParentSubstanceName) {
+		return false
+	}
+	for i := range r.ParentSubstanceName {
+		if r.ParentSubstanceName[i] != other.ParentSubstanceName[i] {
+			return false
+		}
+	}
+	if !equalElements(r.ParentSubstanceNameElement, other.ParentSubstanceNameElement, false) {
+		return false
+	}
+	if len(r.CountryOfOrigin) != len(other.CountryOfOrigin) {
+		return false
+	}
+	for i := range r.CountryOfOrigin {
+		if !r.CountryOfOrigin[i].Equal(other.CountryOfOrigin[i]) {
+			return false
+		}
+	}
+	if len(r.GeographicalLocation) != len(other.GeographicalLocation) {
+		return false
+	}
+	for i := range r.GeographicalLocation {
+		if r.GeographicalLocation[i] != other.GeographicalLocation[i] {
+			return false
+		}
+	}
+	if !equalElements(r.GeographicalLocationElement, other.GeographicalLocationElement, false) {
+		return false
+	}
+	if (r.DevelopmentStage == nil) != (other.DevelopmentStage == nil) || r.DevelopmentStage != nil && !r.DevelopmentStage.Equal(*other.DevelopmentStage) {
+		return false
+	}
+	if len(r.FractionDescription) != len(other.FractionDescription) {
+		return false
+	}
+	for i := range r.FractionDescription {
+		if !r.FractionDescription[i].Equal(other.FractionDescription[i]) {
+			return false
+		}
+	}
+	if (r.Organism == nil) != (other.Organism == nil) || r.Organism != nil && !r.Organism.Equal(*other.Organism) {
+		return false
+	}
+	if len(r.PartDescription) != len(other.PartDescription) {
+		return false
+	}
+	for i := range r.PartDescription {
+		if !r.PartDescription[i].Equal(other.PartDescription[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSourceMaterial) EqualsDeep(other SubstanceSourceMaterial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.SourceMaterialClass == nil) != (other.SourceMaterialClass == nil) || r.SourceMaterialClass != nil && !r.SourceMaterialClass.EqualsDeep(*other.SourceMaterialClass) {
+		return false
+	}
+	if (r.SourceMaterialType == nil) != (other.SourceMaterialType == nil) || r.SourceMaterialType != nil && !r.SourceMaterialType.EqualsDeep(*other.SourceMaterialType) {
+		return false
+	}
+	if (r.SourceMaterialState == nil) != (other.SourceMaterialState == nil) || r.SourceMaterialState != nil && !r.SourceMaterialState.EqualsDeep(*other.SourceMaterialState) {
+		return false
+	}
+	if (r.OrganismId == nil) != (other.OrganismId == nil) || r.OrganismId != nil && !r.OrganismId.EqualsDeep(*other.OrganismId) {
+		return false
+	}
+	if (r.OrganismName == nil) != (other.OrganismName == nil) || r.OrganismName != nil && !equivalentString(*r.OrganismName, *other.OrganismName) {
+		return false
+	}
+	if (r.OrganismNameElement == nil) != (other.OrganismNameElement == nil) || r.OrganismNameElement != nil && !r.OrganismNameElement.EqualsDeep(*other.OrganismNameElement) {
+		return false
+	}
+	if !equivalentList(len(r.ParentSubstanceId), len(other.ParentSubstanceId), func(i, j int) bool {
+		return r.ParentSubstanceId[i].EqualsDeep(other.ParentSubstanceId[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ParentSubstanceName), len(other.ParentSubstanceName), func(i, j int) bool {
+		return equivalentString(r.ParentSubstanceName[i], other.ParentSubstanceName[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.ParentSubstanceNameElement, other.ParentSubstanceNameElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.CountryOfOrigin), len(other.CountryOfOrigin), func(i, j int) bool {
+		return r.CountryOfOrigin[i].EqualsDeep(other.CountryOfOrigin[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.GeographicalLocation), len(other.GeographicalLocation), func(i, j int) bool {
+		return equivalentString(r.GeographicalLocation[i], other.GeographicalLocation[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.GeographicalLocationElement, other.GeographicalLocationElement, true) {
+		return false
+	}
+	if (r.DevelopmentStage == nil) != (other.DevelopmentStage == nil) || r.DevelopmentStage != nil && !r.DevelopmentStage.EqualsDeep(*other.DevelopmentStage) {
+		return false
+	}
+	if !equivalentList(len(r.FractionDescription), len(other.FractionDescription), func(i, j int) bool {
+		return r.FractionDescription[i].EqualsDeep(other.FractionDescription[j])
+	}) {
+		return false
+	}
+	if (r.Organism == nil) != (other.Organism == nil) || r.Organism != nil && !r.Organism.EqualsDeep(*other.Organism) {
+		return false
+	}
+	if !equivalentList(len(r.PartDescription), len(other.PartDescription), func(i, j int) bool {
+		return r.PartDescription[i].EqualsDeep(other.PartDescription[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSourceMaterial) EqualsShallow(other SubstanceSourceMaterial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.OrganismName == nil) != (other.OrganismName == nil) || r.OrganismName != nil && !equivalentString(*r.OrganismName, *other.OrganismName) {
+		return false
+	}
+	if !equivalentList(len(r.ParentSubstanceName), len(other.ParentSubstanceName), func(i, j int) bool {
+		return equivalentString(r.ParentSubstanceName[i], other.ParentSubstanceName[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.GeographicalLocation), len(other.GeographicalLocation), func(i, j int) bool {
+		return equivalentString(r.GeographicalLocation[i], other.GeographicalLocation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSourceMaterial as FHIR XML
+func (r SubstanceSourceMaterial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "SubstanceSourceMaterial",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "sourceMaterialClass", r.SourceMaterialClass); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sourceMaterialType", r.SourceMaterialType); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sourceMaterialState", r.SourceMaterialState); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "organismId", r.OrganismId); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "organismName", r.OrganismName, r.OrganismNameElement); err != nil {
+		return err
+	}
+	for _, v := range r.ParentSubstanceId {
+		if err := encodeXMLElement(e, "parentSubstanceId", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.ParentSubstanceName {
+		if err := encodeXMLPrimitive(e, "parentSubstanceName", v, elementAt(r.ParentSubstanceNameElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CountryOfOrigin {
+		if err := encodeXMLElement(e, "countryOfOrigin", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.GeographicalLocation {
+		if err := encodeXMLPrimitive(e, "geographicalLocation", v, elementAt(r.GeographicalLocationElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "developmentStage", r.DevelopmentStage); err != nil {
+		return err
+	}
+	for _, v := range r.FractionDescription {
+		if err := encodeXMLElement(e, "fractionDescription", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "organism", r.Organism); err != nil {
+		return err
+	}
+	for _, v := range r.PartDescription {
+		if err := encodeXMLElement(e, "partDescription", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSourceMaterial from FHIR XML
+func (r *SubstanceSourceMaterial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "sourceMaterialClass":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SourceMaterialClass = &v
+			case "sourceMaterialType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SourceMaterialType = &v
+			case "sourceMaterialState":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SourceMaterialState = &v
+			case "organismId":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OrganismId = &v
+			case "organismName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OrganismName = &v
+				}
+				r.OrganismNameElement = element
+			case "parentSubstanceId":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ParentSubstanceId = append(r.ParentSubstanceId, v)
+			case "parentSubstanceName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.ParentSubstanceNameElement = appendElement(r.ParentSubstanceNameElement, len(r.ParentSubstanceName), element)
+					r.ParentSubstanceName = append(r.ParentSubstanceName, v)
+				}
+			case "countryOfOrigin":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CountryOfOrigin = append(r.CountryOfOrigin, v)
+			case "geographicalLocation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.GeographicalLocationElement = appendElement(r.GeographicalLocationElement, len(r.GeographicalLocation), element)
+					r.GeographicalLocation = append(r.GeographicalLocation, v)
+				}
+			case "developmentStage":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DevelopmentStage = &v
+			case "fractionDescription":
+				var v SubstanceSourceMaterialFractionDescription
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FractionDescription = append(r.FractionDescription, v)
+			case "organism":
+				var v SubstanceSourceMaterialOrganism
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Organism = &v
+			case "partDescription":
+				var v SubstanceSourceMaterialPartDescription
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartDescription = append(r.PartDescription, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSourceMaterial as properties to the RDF node n
+func (r SubstanceSourceMaterial) turtle(n *turtleNode) {
+	n.resource("SubstanceSourceMaterial", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("SubstanceSourceMaterial.sourceMaterialClass", -1, r.SourceMaterialClass)
+	n.element("SubstanceSourceMaterial.sourceMaterialType", -1, r.SourceMaterialType)
+	n.element("SubstanceSourceMaterial.sourceMaterialState", -1, r.SourceMaterialState)
+	n.element("SubstanceSourceMaterial.organismId", -1, r.OrganismId)
+	n.primitive("SubstanceSourceMaterial.organismName", -1, r.OrganismName, r.OrganismNameElement, "string")
+	for i, v := range r.ParentSubstanceId {
+		n.element("SubstanceSourceMaterial.parentSubstanceId", i, v)
+	}
+	for i, v := range r.ParentSubstanceName {
+		n.primitive("SubstanceSourceMaterial.parentSubstanceName", i, v, elementAt(r.ParentSubstanceNameElement, i), "string")
+	}
+	for i, v := range r.CountryOfOrigin {
+		n.element("SubstanceSourceMaterial.countryOfOrigin", i, v)
+	}
+	for i, v := range r.GeographicalLocation {
+		n.primitive("SubstanceSourceMaterial.geographicalLocation", i, v, elementAt(r.GeographicalLocationElement, i), "string")
+	}
+	n.element("SubstanceSourceMaterial.developmentStage", -1, r.DevelopmentStage)
+	for i, v := range r.FractionDescription {
+		n.element("SubstanceSourceMaterial.fractionDescription", i, v)
+	}
+	n.element("SubstanceSourceMaterial.organism", -1, r.Organism)
+	for i, v := range r.PartDescription {
+		n.element("SubstanceSourceMaterial.partDescription", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceSourceMaterial as BSON document with the structure of its FHIR JSON
+func (r SubstanceSourceMaterial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSourceMaterial from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSourceMaterial) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSourceMaterial as protobuf message SubstanceSourceMaterial of fhir.proto
+func (r SubstanceSourceMaterial) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSourceMaterial to the encoded message
+func (r SubstanceSourceMaterial) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.SourceMaterialClass != nil {
+		e.message(9, *r.SourceMaterialClass)
+	}
+	if r.SourceMaterialType != nil {
+		e.message(10, *r.SourceMaterialType)
+	}
+	if r.SourceMaterialState != nil {
+		e.message(11, *r.SourceMaterialState)
+	}
+	if r.OrganismId != nil {
+		e.message(12, *r.OrganismId)
+	}
+	if r.OrganismName != nil {
+		e.string(13, *r.OrganismName)
+	}
+	for _, v := range r.ParentSubstanceId {
+		e.message(14, v)
+	}
+	for _, v := range r.ParentSubstanceName {
+		e.string(15, v)
+	}
+	for _, v := range r.CountryOfOrigin {
+		e.message(16, v)
+	}
+	for _, v := range r.GeographicalLocation {
+		e.string(17, v)
+	}
+	if r.DevelopmentStage != nil {
+		e.message(18, *r.DevelopmentStage)
+	}
+	for _, v := range r.FractionDescription {
+		e.message(19, v)
+	}
+	if r.Organism != nil {
+		e.message(20, *r.Organism)
+	}
+	for _, v := range r.PartDescription {
+		e.message(21, v)
+	}
+	if r.IdElement != nil {
+		e.message(22, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(23, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(24, *r.LanguageElement)
+	}
+	if r.OrganismNameElement != nil {
+		e.message(25, *r.OrganismNameElement)
+	}
+	for _, v := range r.ParentSubstanceNameElement {
+		e.element(26, v)
+	}
+	for _, v := range r.GeographicalLocationElement {
+		e.element(27, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSourceMaterial from protobuf message SubstanceSourceMaterial of fhir.proto
+func (r *SubstanceSourceMaterial) UnmarshalProto(b []byte) error {
+	*r = SubstanceSourceMaterial{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.SourceMaterialClass = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.SourceMaterialType = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.SourceMaterialState = &v
+		case 12:
+			var v Identifier
+			d.message(&v)
+			r.OrganismId = &v
+		case 13:
+			v := d.string()
+			r.OrganismName = &v
+		case 14:
+			var v Identifier
+			d.message(&v)
+			r.ParentSubstanceId = append(r.ParentSubstanceId, v)
+		case 15:
+			r.ParentSubstanceName = append(r.ParentSubstanceName, d.string())
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.CountryOfOrigin = append(r.CountryOfOrigin, v)
+		case 17:
+			r.GeographicalLocation = append(r.GeographicalLocation, d.string())
+		case 18:
+			var v CodeableConcept
+			d.message(&v)
+			r.DevelopmentStage = &v
+		case 19:
+			var v SubstanceSourceMaterialFractionDescription
+			d.message(&v)
+			r.FractionDescription = append(r.FractionDescription, v)
+		case 20:
+			var v SubstanceSourceMaterialOrganism
+			d.message(&v)
+			r.Organism = &v
+		case 21:
+			var v SubstanceSourceMaterialPartDescription
+			d.message(&v)
+			r.PartDescription = append(r.PartDescription, v)
+		case 22:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.OrganismNameElement = &v
+		case 26:
+			r.ParentSubstanceNameElement = append(r.ParentSubstanceNameElement, d.element())
+		case 27:
+			r.GeographicalLocationElement = append(r.GeographicalLocationElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSourceMaterialFractionDescription as JSON into a byte slice
+func (r SubstanceSourceMaterialFractionDescription) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSourceMaterialFractionDescription as JSON object with the members in the order of the fields
+func (r SubstanceSourceMaterialFractionDescription) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Fraction != nil {
+		e.key("fraction")
+		e.string(*r.Fraction)
+	}
+	if r.FractionElement != nil {
+		e.key("_fraction")
+		r.FractionElement.appendJSON(e)
+	}
+	if r.MaterialType != nil {
+		e.key("materialType")
+		r.MaterialType.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSourceMaterialFractionDescription from JSON. Unknown members are ignored.
+func (r *SubstanceSourceMaterialFractionDescription) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSourceMaterialFractionDescription
+func (r *SubstanceSourceMaterialFractionDescription) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "fraction":
+			if v, ok := d.string(); ok {
+				r.Fraction = &v
+			} else {
+				r.Fraction = nil
+			}
+		case "_fraction":
+			r.FractionElement = d.element()
+		case "materialType":
+			if d.null() {
+				r.MaterialType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.MaterialType = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSourceMaterialFractionDescription which shares no memory with the original
+func (r SubstanceSourceMaterialFractionDescription) DeepCopy() SubstanceSourceMaterialFractionDescription {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Fraction != nil {
+		v := *r.Fraction
+		out.Fraction = &v
+	}
+	if r.FractionElement != nil {
+		v := r.FractionElement.DeepCopy()
+		out.FractionElement = &v
+	}
+	if r.MaterialType != nil {
+		v := r.MaterialType.DeepCopy()
+		out.MaterialType = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSourceMaterialFractionDescription) Equal(other SubstanceSourceMaterialFractionDescription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Fraction == nil) != (other.Fraction == nil) || r.Fraction != nil && *r.Fraction != *other.Fraction {
+		return false
+	}
+	if (r.FractionElement == nil) != (other.FractionElement == nil) || r.FractionElement != nil && !r.FractionElement.Equal(*other.FractionElement) {
+		return false
+	}
+	if (r.MaterialType == nil) != (other.MaterialType == nil) || r.MaterialType != nil && !r.MaterialType.Equal(*other.MaterialType) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSourceMaterialFractionDescription) EqualsDeep(other SubstanceSourceMaterialFractionDescription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Fraction == nil) != (other.Fraction == nil) || r.Fraction != nil && !equivalentString(*r.Fraction, *other.Fraction) {
+		return false
+	}
+	if (r.FractionElement == nil) != (other.FractionElement == nil) || r.FractionElement != nil && !r.FractionElement.EqualsDeep(*other.FractionElement) {
+		return false
+	}
+	if (r.MaterialType == nil) != (other.MaterialType == nil) || r.MaterialType != nil && !r.MaterialType.EqualsDeep(*other.MaterialType) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSourceMaterialFractionDescription) EqualsShallow(other SubstanceSourceMaterialFractionDescription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Fraction == nil) != (other.Fraction == nil) || r.Fraction != nil && !equivalentString(*r.Fraction, *other.Fraction) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSourceMaterialFractionDescription as FHIR XML
+func (r SubstanceSourceMaterialFractionDescription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "fraction", r.Fraction, r.FractionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "materialType", r.MaterialType); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSourceMaterialFractionDescription from FHIR XML
+func (r *SubstanceSourceMaterialFractionDescription) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "fraction":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Fraction = &v
+				}
+				r.FractionElement = element
+			case "materialType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MaterialType = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSourceMaterialFractionDescription as properties to the RDF node n
+func (r SubstanceSourceMaterialFractionDescription) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("SubstanceSourceMaterial.fractionDescription.fraction", -1, r.Fraction, r.FractionElement, "string")
+	n.element("SubstanceSourceMaterial.fractionDescription.materialType", -1, r.MaterialType)
+}
+
+// MarshalBSON marshals the given SubstanceSourceMaterialFractionDescription as BSON document with the structure of its FHIR JSON
+func (r SubstanceSourceMaterialFractionDescription) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSourceMaterialFractionDescription from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSourceMaterialFractionDescription) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSourceMaterialFractionDescription as protobuf message SubstanceSourceMaterialFractionDescription of fhir.proto
+func (r SubstanceSourceMaterialFractionDescription) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSourceMaterialFractionDescription to the encoded message
+func (r SubstanceSourceMaterialFractionDescription) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Fraction != nil {
+		e.string(4, *r.Fraction)
+	}
+	if r.MaterialType != nil {
+		e.message(5, *r.MaterialType)
+	}
+	if r.FractionElement != nil {
+		e.message(6, *r.FractionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSourceMaterialFractionDescription from protobuf message SubstanceSourceMaterialFractionDescription of fhir.proto
+func (r *SubstanceSourceMaterialFractionDescription) UnmarshalProto(b []byte) error {
+	*r = SubstanceSourceMaterialFractionDescription{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Fraction = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.MaterialType = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.FractionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSourceMaterialOrganism as JSON into a byte slice
+func (r SubstanceSourceMaterialOrganism) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSourceMaterialOrganism as JSON object with the members in the order of the fields
+func (r SubstanceSourceMaterialOrganism) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Family != nil {
+		e.key("family")
+		r.Family.appendJSON(e)
+	}
+	if r.Genus != nil {
+		e.key("genus")
+		r.Genus.appendJSON(e)
+	}
+	if r.Species != nil {
+		e.key("species")
+		r.Species.appendJSON(e)
+	}
+	if r.IntraspecificType != nil {
+		e.key("intraspecificType")
+		r.IntraspecificType.appendJSON(e)
+	}
+	if r.IntraspecificDescription != nil {
+		e.key("intraspecificDescription")
+		e.string(*r.IntraspecificDescription)
+	}
+	if r.IntraspecificDescriptionElement != nil {
+		e.key("_intraspecificDescription")
+		r.IntraspecificDescriptionElement.appendJSON(e)
+	}
+	if len(r.Author) > 0 {
+		e.key("author")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Author {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Hybrid != nil {
+		e.key("hybrid")
+		r.Hybrid.appendJSON(e)
+	}
+	if r.OrganismGeneral != nil {
+		e.key("organismGeneral")
+		r.OrganismGeneral.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSourceMaterialOrganism from JSON. Unknown members are ignored.
+func (r *SubstanceSourceMaterialOrganism) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSourceMaterialOrganism
+func (r *SubstanceSourceMaterialOrganism) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "family":
+			if d.null() {
+				r.Family = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Family = &v
+			}
+		case "genus":
+			if d.null() {
+				r.Genus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Genus = &v
+			}
+		case "species":
+			if d.null() {
+				r.Species = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Species = &v
+			}
+		case "intraspecificType":
+			if d.null() {
+				r.IntraspecificType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.IntraspecificType = &v
+			}
+		case "intraspecificDescription":
+			if v, ok := d.string(); ok {
+				r.IntraspecificDescription = &v
+			} else {
+				r.IntraspecificDescription = nil
+			}
+		case "_intraspecificDescription":
+			r.IntraspecificDescriptionElement = d.element()
+		case "author":
+			a := d.array()
+			r.Author = nil
+			for a.next() {
+				var v SubstanceSourceMaterialOrganismAuthor
+				v.decodeJSON(d)
+				r.Author = append(r.Author, v)
+			}
+			if r.Author == nil && !a.isNull {
+				r.Author = []SubstanceSourceMaterialOrganismAuthor{}
+			}
+		case "hybrid":
+			if d.null() {
+				r.Hybrid = nil
+			} else {
+				var v SubstanceSourceMaterialOrganismHybrid
+				v.decodeJSON(d)
+				r.Hybrid = &v
+			}
+		case "organismGeneral":
+			if d.null() {
+				r.OrganismGeneral = nil
+			} else {
+				var v SubstanceSourceMaterialOrganismOrganismGeneral
+				v.decodeJSON(d)
+				r.OrganismGeneral = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSourceMaterialOrganism which shares no memory with the original
+func (r SubstanceSourceMaterialOrganism) DeepCopy() SubstanceSourceMaterialOrganism {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Family != nil {
+		v := r.Family.DeepCopy()
+		out.Family = &v
+	}
+	if r.Genus != nil {
+		v := r.Genus.DeepCopy()
+		out.Genus = &v
+	}
+	if r.Species != nil {
+		v := r.Species.DeepCopy()
+		out.Species = &v
+	}
+	if r.IntraspecificType != nil {
+		v := r.IntraspecificType.DeepCopy()
+		out.IntraspecificType = &v
+	}
+	if r.IntraspecificDescription != nil {
+		v := *r.IntraspecificDescription
+		out.IntraspecificDescription = &v
+	}
+	if r.IntraspecificDescriptionElement != nil {
+		v := r.IntraspecificDescriptionElement.DeepCopy()
+		out.IntraspecificDescriptionElement = &v
+	}
+	if r.Author != nil {
+		out.Author = make([]SubstanceSourceMaterialOrganismAuthor, len(r.Author))
+		for i := range r.Author {
+			out.Author[i] = r.Author[i].DeepCopy()
+		}
+	}
+	if r.Hybrid != nil {
+		v := r.Hybrid.DeepCopy()
+		out.Hybrid = &v
+	}
+	if r.OrganismGeneral != nil {
+		v := r.OrganismGeneral.DeepCopy()
+		out.OrganismGeneral = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSourceMaterialOrganism) Equal(other SubstanceSourceMaterialOrganism) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Family == nil) != (other.Family == nil) || r.Family != nil && !r.Family.Equal(*other.Family) {
+		return false
+	}
+	if (r.Genus == nil) != (other.Genus == nil) || r.Genus != nil && !r.Genus.Equal(*other.Genus) {
+		return false
+	}
+	if (r.Species == nil) != (other.Species == nil) || r.Species != nil && !r.Species.Equal(*other.Species) {
+		return false
+	}
+	if (r.IntraspecificType == nil) != (other.IntraspecificType == nil) || r.IntraspecificType != nil && !r.IntraspecificType.Equal(*other.IntraspecificType) {
+		return false
+	}
+	if (r.IntraspecificDescription == nil) != (other.IntraspecificDescription == nil) || r.IntraspecificDescription != nil && *r.IntraspecificDescription != *other.IntraspecificDescription {
+		return false
+	}
+	if (r.IntraspecificDescriptionElement == nil) != (other.IntraspecificDescriptionElement == nil) || r.IntraspecificDescriptionElement != nil && !r.IntraspecificDescriptionElement.Equal(*other.IntraspecificDescriptionElement) {
+		return false
+	}
+	if len(r.Author) != len(other.Author) {
+		return false
+	}
+	for i := range r.Author {
+		if !r.Author[i].Equal(other.Author[i]) {
+			return false
+		}
+	}
+	if (r.Hybrid == nil) != (other.Hybrid == nil) || r.Hybrid != nil && !r.Hybrid.Equal(*other.Hybrid) {
+		return false
+	}
+	if (r.OrganismGeneral == nil) != (other.OrganismGeneral == nil) || r.OrganismGeneral != nil && !r.OrganismGeneral.Equal(*other.OrganismGeneral) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSourceMaterialOrganism) EqualsDeep(other SubstanceSourceMaterialOrganism) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Family == nil) != (other.Family == nil) || r.Family != nil && !r.Family.EqualsDeep(*other.Family) {
+		return false
+	}
+	if (r.Genus == nil) != (other.Genus == nil) || r.Genus != nil && !r.Genus.EqualsDeep(*other.Genus) {
+		return false
+	}
+	if (r.Species == nil) != (other.Species == nil) || r.Species != nil && !r.Species.EqualsDeep(*other.Species) {
+		return false
+	}
+	if (r.IntraspecificType == nil) != (other.IntraspecificType == nil) || r.IntraspecificType != nil && !r.IntraspecificType.EqualsDeep(*other.IntraspecificType) {
+		return false
+	}
+	if (r.IntraspecificDescription == nil) != (other.IntraspecificDescription == nil) || r.IntraspecificDescription != nil && !equivalentString(*r.IntraspecificDescription, *other.IntraspecificDescription) {
+		return false
+	}
+	if (r.IntraspecificDescriptionElement == nil) != (other.IntraspecificDescriptionElement == nil) || r.IntraspecificDescriptionElement != nil && !r.IntraspecificDescriptionElement.EqualsDeep(*other.IntraspecificDescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Author), len(other.Author), func(i, j int) bool {
+		return r.Author[i].EqualsDeep(other.Author[j])
+	}) {
+		return false
+	}
+	if (r.Hybrid == nil) != (other.Hybrid == nil) || r.Hybrid != nil && !r.Hybrid.EqualsDeep(*other.Hybrid) {
+		return false
+	}
+	if (r.OrganismGeneral == nil) != (other.OrganismGeneral == nil) || r.OrganismGeneral != nil && !r.OrganismGeneral.EqualsDeep(*other.OrganismGeneral) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSourceMaterialOrganism) EqualsShallow(other SubstanceSourceMaterialOrganism) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IntraspecificDescription == nil) != (other.IntraspecificDescription == nil) || r.IntraspecificDescription != nil && !equivalentString(*r.IntraspecificDescription, *other.IntraspecificDescription) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSourceMaterialOrganism as FHIR XML
+func (r SubstanceSourceMaterialOrganism) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "family", r.Family); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "genus", r.Genus); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "species", r.Species); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "intraspecificType", r.IntraspecificType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "intraspecificDescription", r.IntraspecificDescription, r.IntraspecificDescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Author {
+		if err := encodeXMLElement(e, "author", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "hybrid", r.Hybrid); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "organismGeneral", r.OrganismGeneral); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSourceMaterialOrganism from FHIR XML
+func (r *SubstanceSourceMaterialOrganism) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "family":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Family = &v
+			case "genus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Genus = &v
+			case "species":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Species = &v
+			case "intraspecificType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.IntraspecificType = &v
+			case "intraspecificDescription":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IntraspecificDescription = &v
+				}
+				r.IntraspecificDescriptionElement = element
+			case "author":
+				var v SubstanceSourceMaterialOrganismAuthor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Author = append(r.Author, v)
+			case "hybrid":
+				var v SubstanceSourceMaterialOrganismHybrid
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Hybrid = &v
+			case "organismGeneral":
+				var v SubstanceSourceMaterialOrganismOrganismGeneral
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OrganismGeneral = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSourceMaterialOrganism as properties to the RDF node n
+func (r SubstanceSourceMaterialOrganism) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSourceMaterial.organism.family", -1, r.Family)
+	n.element("SubstanceSourceMaterial.organism.genus", -1, r.Genus)
+	n.element("SubstanceSourceMaterial.organism.species", -1, r.Species)
+	n.element("SubstanceSourceMaterial.organism.intraspecificType", -1, r.IntraspecificType)
+	n.primitive("SubstanceSourceMaterial.organism.intraspecificDescription", -1, r.IntraspecificDescription, r.IntraspecificDescriptionElement, "string")
+	for i, v := range r.Author {
+		n.element("SubstanceSourceMaterial.organism.author", i, v)
+	}
+	n.element("SubstanceSourceMaterial.organism.hybrid", -1, r.Hybrid)
+	n.element("SubstanceSourceMaterial.organism.organismGeneral", -1, r.OrganismGeneral)
+}
+
+// MarshalBSON marshals the given SubstanceSourceMaterialOrganism as BSON document with the structure of its FHIR JSON
+func (r SubstanceSourceMaterialOrganism) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSourceMaterialOrganism from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSourceMaterialOrganism) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSourceMaterialOrganism as protobuf message SubstanceSourceMaterialOrganism of fhir.proto
+func (r SubstanceSourceMaterialOrganism) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSourceMaterialOrganism to the encoded message
+func (r SubstanceSourceMaterialOrganism) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Family != nil {
+		e.message(4, *r.Family)
+	}
+	if r.Genus != nil {
+		e.message(5, *r.Genus)
+	}
+	if r.Species != nil {
+		e.message(6, *r.Species)
+	}
+	if r.IntraspecificType != nil {
+		e.message(7, *r.IntraspecificType)
+	}
+	if r.IntraspecificDescription != nil {
+		e.string(8, *r.IntraspecificDescription)
+	}
+	for _, v := range r.Author {
+		e.message(9, v)
+	}
+	if r.Hybrid != nil {
+		e.message(10, *r.Hybrid)
+	}
+	if r.OrganismGeneral != nil {
+		e.message(11, *r.OrganismGeneral)
+	}
+	if r.IntraspecificDescriptionElement != nil {
+		e.message(12, *r.IntraspecificDescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSourceMaterialOrganism from protobuf message SubstanceSourceMaterialOrganism of fhir.proto
+func (r *SubstanceSourceMaterialOrganism) UnmarshalProto(b []byte) error {
+	*r = SubstanceSourceMaterialOrganism{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Family = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Genus = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Species = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.IntraspecificType = &v
+		case 8:
+			v := d.string()
+			r.IntraspecificDescription = &v
+		case 9:
+			var v SubstanceSourceMaterialOrganismAuthor
+			d.message(&v)
+			r.Author = append(r.Author, v)
+		case 10:
+			var v SubstanceSourceMaterialOrganismHybrid
+			d.message(&v)
+			r.Hybrid = &v
+		case 11:
+			var v SubstanceSourceMaterialOrganismOrganismGeneral
+			d.message(&v)
+			r.OrganismGeneral = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.IntraspecificDescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSourceMaterialOrganismAuthor as JSON into a byte slice
+func (r SubstanceSourceMaterialOrganismAuthor) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSourceMaterialOrganismAuthor as JSON object with the members in the order of the fields
+func (r SubstanceSourceMaterialOrganismAuthor) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.AuthorType != nil {
+		e.key("authorType")
+		r.AuthorType.appendJSON(e)
+	}
+	if r.AuthorDescription != nil {
+		e.key("authorDescription")
+		e.string(*r.AuthorDescription)
+	}
+	if r.AuthorDescriptionElement != nil {
+		e.key("_authorDescription")
+		r.AuthorDescriptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSourceMaterialOrganismAuthor from JSON. Unknown members are ignored.
+func (r *SubstanceSourceMaterialOrganismAuthor) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSourceMaterialOrganismAuthor
+func (r *SubstanceSourceMaterialOrganismAuthor) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "authorType":
+			if d.null() {
+				r.AuthorType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AuthorType = &v
+			}
+		case "authorDescription":
+			if v, ok := d.string(); ok {
+				r.AuthorDescription = &v
+			} else {
+				r.AuthorDescription = nil
+			}
+		case "_authorDescription":
+			r.AuthorDescriptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSourceMaterialOrganismAuthor which shares no memory with the original
+func (r SubstanceSourceMaterialOrganismAuthor) DeepCopy() SubstanceSourceMaterialOrganismAuthor {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.AuthorType != nil {
+		v := r.AuthorType.DeepCopy()
+		out.AuthorType = &v
+	}
+	if r.AuthorDescription != nil {
+		v := *r.AuthorDescription
+		out.AuthorDescription = &v
+	}
+	if r.AuthorDescriptionElement != nil {
+		v := r.AuthorDescriptionElement.DeepCopy()
+		out.AuthorDescriptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSourceMaterialOrganismAuthor) Equal(other SubstanceSourceMaterialOrganismAuthor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.AuthorType == nil) != (other.AuthorType == nil) || r.AuthorType != nil && !r.AuthorType.Equal(*other.AuthorType) {
+		return false
+	}
+	if (r.AuthorDescription == nil) != (other.AuthorDescription == nil) || r.AuthorDescription != nil && *r.AuthorDescription != *other.AuthorDescription {
+		return false
+	}
+	if (r.AuthorDescriptionElement == nil) != (other.AuthorDescriptionElement == nil) || r.AuthorDescriptionElement != nil && !r.AuthorDescriptionElement.Equal(*other.AuthorDescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSourceMaterialOrganismAuthor) EqualsDeep(other SubstanceSourceMaterialOrganismAuthor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.AuthorType == nil) != (other.AuthorType == nil) || r.AuthorType != nil && !r.AuthorType.EqualsDeep(*other.AuthorType) {
+		return false
+	}
+	if (r.AuthorDescription == nil) != (other.AuthorDescription == nil) || r.AuthorDescription != nil && !equivalentString(*r.AuthorDescription, *other.AuthorDescription) {
+		return false
+	}
+	if (r.AuthorDescriptionElement == nil) != (other.AuthorDescriptionElement == nil) || r.AuthorDescriptionElement != nil && !r.AuthorDescriptionElement.EqualsDeep(*other.AuthorDescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSourceMaterialOrganismAuthor) EqualsShallow(other SubstanceSourceMaterialOrganismAuthor) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.AuthorDescription == nil) != (other.AuthorDescription == nil) || r.AuthorDescription != nil && !equivalentString(*r.AuthorDescription, *other.AuthorDescription) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSourceMaterialOrganismAuthor as FHIR XML
+func (r SubstanceSourceMaterialOrganismAuthor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "authorType", r.AuthorType); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "authorDescription", r.AuthorDescription, r.AuthorDescriptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSourceMaterialOrganismAuthor from FHIR XML
+func (r *SubstanceSourceMaterialOrganismAuthor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "authorType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AuthorType = &v
+			case "authorDescription":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthorDescription = &v
+				}
+				r.AuthorDescriptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSourceMaterialOrganismAuthor as properties to the RDF node n
+func (r SubstanceSourceMaterialOrganismAuthor) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSourceMaterial.organism.author.authorType", -1, r.AuthorType)
+	n.primitive("SubstanceSourceMaterial.organism.author.authorDescription", -1, r.AuthorDescription, r.AuthorDescriptionElement, "string")
+}
+
+// MarshalBSON marshals the given SubstanceSourceMaterialOrganismAuthor as BSON document with the structure of its FHIR JSON
+func (r SubstanceSourceMaterialOrganismAuthor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSourceMaterialOrganismAuthor from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSourceMaterialOrganismAuthor) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSourceMaterialOrganismAuthor as protobuf message SubstanceSourceMaterialOrganismAuthor of fhir.proto
+func (r SubstanceSourceMaterialOrganismAuthor) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSourceMaterialOrganismAuthor to the encoded message
+func (r SubstanceSourceMaterialOrganismAuthor) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.AuthorType != nil {
+		e.message(4, *r.AuthorType)
+	}
+	if r.AuthorDescription != nil {
+		e.string(5, *r.AuthorDescription)
+	}
+	if r.AuthorDescriptionElement != nil {
+		e.message(6, *r.AuthorDescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSourceMaterialOrganismAuthor from protobuf message SubstanceSourceMaterialOrganismAuthor of fhir.proto
+func (r *SubstanceSourceMaterialOrganismAuthor) UnmarshalProto(b []byte) error {
+	*r = SubstanceSourceMaterialOrganismAuthor{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.AuthorType = &v
+		case 5:
+			v := d.string()
+			r.AuthorDescription = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.AuthorDescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSourceMaterialOrganismHybrid as JSON into a byte slice
+func (r SubstanceSourceMaterialOrganismHybrid) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSourceMaterialOrganismHybrid as JSON object with the members in the order of the fields
+func (r SubstanceSourceMaterialOrganismHybrid) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MaternalOrganismId != nil {
+		e.key("maternalOrganismId")
+		e.string(*r.MaternalOrganismId)
+	}
+	if r.MaternalOrganismIdElement != nil {
+		e.key("_maternalOrganismId")
+		r.MaternalOrganismIdElement.appendJSON(e)
+	}
+	if r.MaternalOrganismName != nil {
+		e.key("maternalOrganismName")
+		e.string(*r.MaternalOrganismName)
+	}
+	if r.MaternalOrganismNameElement != nil {
+		e.key("_maternalOrganismName")
+		r.MaternalOrganismNameElement.appendJSON(e)
+	}
+	if r.PaternalOrganismId != nil {
+		e.key("paternalOrganismId")
+		e.string(*r.PaternalOrganismId)
+	}
+	if r.PaternalOrganismIdElement != nil {
+		e.key("_paternalOrganismId")
+		r.PaternalOrganismIdElement.appendJSON(e)
+	}
+	if r.PaternalOrganismName != nil {
+		e.key("paternalOrganismName")
+		e.string(*r.PaternalOrganismName)
+	}
+	if r.PaternalOrganismNameElement != nil {
+		e.key("_paternalOrganismName")
+		r.PaternalOrganismNameElement.appendJSON(e)
+	}
+	if r.HybridType != nil {
+		e.key("hybridType")
+		r.HybridType.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSourceMaterialOrganismHybrid from JSON. Unknown members are ignored.
+func (r *SubstanceSourceMaterialOrganismHybrid) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSourceMaterialOrganismHybrid
+func (r *SubstanceSourceMaterialOrganismHybrid) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "maternalOrganismId":
+			if v, ok := d.string(); ok {
+				r.MaternalOrganismId = &v
+			} else {
+				r.MaternalOrganismId = nil
+			}
+		case "_maternalOrganismId":
+			r.MaternalOrganismIdElement = d.element()
+		case "maternalOrganismName":
+			if v, ok := d.string(); ok {
+				r.MaternalOrganismName = &v
+			} else {
+				r.MaternalOrganismName = nil
+			}
+		case "_maternalOrganismName":
+			r.MaternalOrganismNameElement = d.element()
+		case "paternalOrganismId":
+			if v, ok := d.string(); ok {
+				r.PaternalOrganismId = &v
+			} else {
+				r.PaternalOrganismId = nil
+			}
+		case "_paternalOrganismId":
+			r.PaternalOrganismIdElement = d.element()
+		case "paternalOrganismName":
+			if v, ok := d.string(); ok {
+				r.PaternalOrganismName = &v
+			} else {
+				r.PaternalOrganismName = nil
+			}
+		case "_paternalOrganismName":
+			r.PaternalOrganismNameElement = d.element()
+		case "hybridType":
+			if d.null() {
+				r.HybridType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.HybridType = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSourceMaterialOrganismHybrid which shares no memory with the original
+func (r SubstanceSourceMaterialOrganismHybrid) DeepCopy() SubstanceSourceMaterialOrganismHybrid {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.MaternalOrganismId != nil {
+		v := *r.MaternalOrganismId
+		out.MaternalOrganismId = &v
+	}
+	if r.MaternalOrganismIdElement != nil {
+		v := r.MaternalOrganismIdElement.DeepCopy()
+		out.MaternalOrganismIdElement = &v
+	}
+	if r.MaternalOrganismName != nil {
+		v := *r.MaternalOrganismName
+		out.MaternalOrganismName = &v
+	}
+	if r.MaternalOrganismNameElement != nil {
+		v := r.MaternalOrganismNameElement.DeepCopy()
+		out.MaternalOrganismNameElement = &v
+	}
+	if r.PaternalOrganismId != nil {
+		v := *r.PaternalOrganismId
+		out.PaternalOrganismId = &v
+	}
+	if r.PaternalOrganismIdElement != nil {
+		v := r.PaternalOrganismIdElement.DeepCopy()
+		out.PaternalOrganismIdElement = &v
+	}
+	if r.PaternalOrganismName != nil {
+		v := *r.PaternalOrganismName
+		out.PaternalOrganismName = &v
+	}
+	if r.PaternalOrganismNameElement != nil {
+		v := r.PaternalOrganismNameElement.DeepCopy()
+		out.PaternalOrganismNameElement = &v
+	}
+	if r.HybridType != nil {
+		v := r.HybridType.DeepCopy()
+		out.HybridType = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSourceMaterialOrganismHybrid) Equal(other SubstanceSourceMaterialOrganismHybrid) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.MaternalOrganismId == nil) != (other.MaternalOrganismId == nil) || r.MaternalOrganismId != nil && *r.MaternalOrganismId != *other.MaternalOrganismId {
+		return false
+	}
+	if (r.MaternalOrganismIdElement == nil) != (other.MaternalOrganismIdElement == nil) || r.MaternalOrganismIdElement != nil && !r.MaternalOrganismIdElement.Equal(*other.MaternalOrganismIdElement) {
+		return false
+	}
+	if (r.MaternalOrganismName == nil) != (other.MaternalOrganismName == nil) || r.MaternalOrganismName != nil && *r.MaternalOrganismName != *other.MaternalOrganismName {
+		return false
+	}
+	if (r.MaternalOrganismNameElement == nil) != (other.MaternalOrganismNameElement == nil) || r.MaternalOrganismNameElement != nil && !r.MaternalOrganismNameElement.Equal(*other.MaternalOrganismNameElement) {
+		return false
+	}
+	if (r.PaternalOrganismId == nil) != (other.PaternalOrganismId == nil) || r.PaternalOrganismId != nil && *r.PaternalOrganismId != *other.PaternalOrganismId {
+		return false
+	}
+	if (r.PaternalOrganismIdElement == nil) != (other.PaternalOrganismIdElement == nil) || r.PaternalOrganismIdElement != nil && !r.PaternalOrganismIdElement.Equal(*other.PaternalOrganismIdElement) {
+		return false
+	}
+	if (r.PaternalOrganismName == nil) != (other.PaternalOrganismName == nil) || r.PaternalOrganismName != nil && *r.PaternalOrganismName != *other.PaternalOrganismName {
+		return false
+	}
+	if (r.PaternalOrganismNameElement == nil) != (other.PaternalOrganismNameElement == nil) || r.PaternalOrganismNameElement != nil && !r.PaternalOrganismNameElement.Equal(*other.PaternalOrganismNameElement) {
+		return false
+	}
+	if (r.HybridType == nil) != (other.HybridType == nil) || r.HybridType != nil && !r.HybridType.Equal(*other.HybridType) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSourceMaterialOrganismHybrid) EqualsDeep(other SubstanceSourceMaterialOrganismHybrid) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.MaternalOrganismId == nil) != (other.MaternalOrganismId == nil) || r.MaternalOrganismId != nil && !equivalentString(*r.MaternalOrganismId, *other.MaternalOrganismId) {
+		return false
+	}
+	if (r.MaternalOrganismIdElement == nil) != (other.MaternalOrganismIdElement == nil) || r.MaternalOrganismIdElement != nil && !r.MaternalOrganismIdElement.EqualsDeep(*other.MaternalOrganismIdElement) {
+		return false
+	}
+	if (r.MaternalOrganismName == nil) != (other.MaternalOrganismName == nil) || r.MaternalOrganismName != nil && !equivalentString(*r.MaternalOrganismName, *other.MaternalOrganismName) {
+		return false
+	}
+	if (r.MaternalOrganismNameElement == nil) != (other.MaternalOrganismNameElement == nil) || r.MaternalOrganismNameElement != nil && !r.MaternalOrganismNameElement.EqualsDeep(*other.MaternalOrganismNameElement) {
+		return false
+	}
+	if (r.PaternalOrganismId == nil) != (other.PaternalOrganismId == nil) || r.PaternalOrganismId != nil && !equivalentString(*r.PaternalOrganismId, *other.PaternalOrganismId) {
+		return false
+	}
+	if (r.PaternalOrganismIdElement == nil) != (other.PaternalOrganismIdElement == nil) || r.PaternalOrganismIdElement != nil && !r.PaternalOrganismIdElement.EqualsDeep(*other.PaternalOrganismIdElement) {
+		return false
+	}
+	if (r.PaternalOrganismName == nil) != (other.PaternalOrganismName == nil) || r.PaternalOrganismName != nil && !equivalentString(*r.PaternalOrganismName, *other.PaternalOrganismName) {
+		return false
+	}
+	if (r.PaternalOrganismNameElement == nil) != (other.PaternalOrganismNameElement == nil) || r.PaternalOrganismNameElement != nil && !r.PaternalOrganismNameElement.EqualsDeep(*other.PaternalOrganismNameElement) {
+		return false
+	}
+	if (r.HybridType == nil) != (other.HybridType == nil) || r.HybridType != nil && !r.HybridType.EqualsDeep(*other.HybridType) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSourceMaterialOrganismHybrid) EqualsShallow(other SubstanceSourceMaterialOrganismHybrid) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.MaternalOrganismId == nil) != (other.MaternalOrganismId == nil) || r.MaternalOrganismId != nil && !equivalentString(*r.MaternalOrganismId, *other.MaternalOrganismId) {
+		return false
+	}
+	if (r.MaternalOrganismName == nil) != (other.MaternalOrganismName == nil) || r.MaternalOrganismName != nil && !equivalentString(*r.MaternalOrganismName, *other.MaternalOrganismName) {
+		return false
+	}
+	if (r.PaternalOrganismId == nil) != (other.PaternalOrganismId == nil) || r.PaternalOrganismId != nil && !equivalentString(*r.PaternalOrganismId, *other.PaternalOrganismId) {
+		return false
+	}
+	if (r.PaternalOrganismName == nil) != (other.PaternalOrganismName == nil) || r.PaternalOrganismName != nil && !equivalentString(*r.PaternalOrganismName, *other.PaternalOrganismName) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSourceMaterialOrganismHybrid as FHIR XML
+func (r SubstanceSourceMaterialOrganismHybrid) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "maternalOrganismId", r.MaternalOrganismId, r.MaternalOrganismIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "maternalOrganismName", r.MaternalOrganismName, r.MaternalOrganismNameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "paternalOrganismId", r.PaternalOrganismId, r.PaternalOrganismIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "paternalOrganismName", r.PaternalOrganismName, r.PaternalOrganismNameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "hybridType", r.HybridType); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSourceMaterialOrganismHybrid from FHIR XML
+func (r *SubstanceSourceMaterialOrganismHybrid) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "maternalOrganismId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaternalOrganismId = &v
+				}
+				r.MaternalOrganismIdElement = element
+			case "maternalOrganismName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MaternalOrganismName = &v
+				}
+				r.MaternalOrganismNameElement = element
+			case "paternalOrganismId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PaternalOrganismId = &v
+				}
+				r.PaternalOrganismIdElement = element
+			case "paternalOrganismName":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.PaternalOrganismName = &v
+				}
+				r.PaternalOrganismNameElement = element
+			case "hybridType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.HybridType = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSourceMaterialOrganismHybrid as properties to the RDF node n
+func (r SubstanceSourceMaterialOrganismHybrid) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("SubstanceSourceMaterial.organism.hybrid.maternalOrganismId", -1, r.MaternalOrganismId, r.MaternalOrganismIdElement, "string")
+	n.primitive("SubstanceSourceMaterial.organism.hybrid.maternalOrganismName", -1, r.MaternalOrganismName, r.MaternalOrganismNameElement, "string")
+	n.primitive("SubstanceSourceMaterial.organism.hybrid.paternalOrganismId", -1, r.PaternalOrganismId, r.PaternalOrganismIdElement, "string")
+	n.primitive("SubstanceSourceMaterial.organism.hybrid.paternalOrganismName", -1, r.PaternalOrganismName, r.PaternalOrganismNameElement, "string")
+	n.element("SubstanceSourceMaterial.organism.hybrid.hybridType", -1, r.HybridType)
+}
+
+// MarshalBSON marshals the given SubstanceSourceMaterialOrganismHybrid as BSON document with the structure of its FHIR JSON
+func (r SubstanceSourceMaterialOrganismHybrid) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSourceMaterialOrganismHybrid from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSourceMaterialOrganismHybrid) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSourceMaterialOrganismHybrid as protobuf message SubstanceSourceMaterialOrganismHybrid of fhir.proto
+func (r SubstanceSourceMaterialOrganismHybrid) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSourceMaterialOrganismHybrid to the encoded message
+func (r SubstanceSourceMaterialOrganismHybrid) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.MaternalOrganismId != nil {
+		e.string(4, *r.MaternalOrganismId)
+	}
+	if r.MaternalOrganismName != nil {
+		e.string(5, *r.MaternalOrganismName)
+	}
+	if r.PaternalOrganismId != nil {
+		e.string(6, *r.PaternalOrganismId)
+	}
+	if r.PaternalOrganismName != nil {
+		e.string(7, *r.PaternalOrganismName)
+	}
+	if r.HybridType != nil {
+		e.message(8, *r.HybridType)
+	}
+	if r.MaternalOrganismIdElement != nil {
+		e.message(9, *r.MaternalOrganismIdElement)
+	}
+	if r.MaternalOrganismNameElement != nil {
+		e.message(10, *r.MaternalOrganismNameElement)
+	}
+	if r.PaternalOrganismIdElement != nil {
+		e.message(11, *r.PaternalOrganismIdElement)
+	}
+	if r.PaternalOrganismNameElement != nil {
+		e.message(12, *r.PaternalOrganismNameElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSourceMaterialOrganismHybrid from protobuf message SubstanceSourceMaterialOrganismHybrid of fhir.proto
+func (r *SubstanceSourceMaterialOrganismHybrid) UnmarshalProto(b []byte) error {
+	*r = SubstanceSourceMaterialOrganismHybrid{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.MaternalOrganismId = &v
+		case 5:
+			v := d.string()
+			r.MaternalOrganismName = &v
+		case 6:
+			v := d.string()
+			r.PaternalOrganismId = &v
+		case 7:
+			v := d.string()
+			r.PaternalOrganismName = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.HybridType = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.MaternalOrganismIdElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.MaternalOrganismNameElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.PaternalOrganismIdElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.PaternalOrganismNameElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSourceMaterialOrganismOrganismGeneral as JSON into a byte slice
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSourceMaterialOrganismOrganismGeneral as JSON object with the members in the order of the fields
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Kingdom != nil {
+		e.key("kingdom")
+		r.Kingdom.appendJSON(e)
+	}
+	if r.Phylum != nil {
+		e.key("phylum")
+		r.Phylum.appendJSON(e)
+	}
+	if r.Class != nil {
+		e.key("class")
+		r.Class.appendJSON(e)
+	}
+	if r.Order != nil {
+		e.key("order")
+		r.Order.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSourceMaterialOrganismOrganismGeneral from JSON. Unknown members are ignored.
+func (r *SubstanceSourceMaterialOrganismOrganismGeneral) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSourceMaterialOrganismOrganismGeneral
+func (r *SubstanceSourceMaterialOrganismOrganismGeneral) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "kingdom":
+			if d.null() {
+				r.Kingdom = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Kingdom = &v
+			}
+		case "phylum":
+			if d.null() {
+				r.Phylum = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Phylum = &v
+			}
+		case "class":
+			if d.null() {
+				r.Class = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Class = &v
+			}
+		case "order":
+			if d.null() {
+				r.Order = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Order = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSourceMaterialOrganismOrganismGeneral which shares no memory with the original
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) DeepCopy() SubstanceSourceMaterialOrganismOrganismGeneral {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Kingdom != nil {
+		v := r.Kingdom.DeepCopy()
+		out.Kingdom = &v
+	}
+	if r.Phylum != nil {
+		v := r.Phylum.DeepCopy()
+		out.Phylum = &v
+	}
+	if r.Class != nil {
+		v := r.Class.DeepCopy()
+		out.Class = &v
+	}
+	if r.Order != nil {
+		v := r.Order.DeepCopy()
+		out.Order = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) Equal(other SubstanceSourceMaterialOrganismOrganismGeneral) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Kingdom == nil) != (other.Kingdom == nil) || r.Kingdom != nil && !r.Kingdom.Equal(*other.Kingdom) {
+		return false
+	}
+	if (r.Phylum == nil) != (other.Phylum == nil) || r.Phylum != nil && !r.Phylum.Equal(*other.Phylum) {
+		return false
+	}
+	if (r.Class == nil) != (other.Class == nil) || r.Class != nil && !r.Class.Equal(*other.Class) {
+		return false
+	}
+	if (r.Order == nil) != (other.Order == nil) || r.Order != nil && !r.Order.Equal(*other.Order) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) EqualsDeep(other SubstanceSourceMaterialOrganismOrganismGeneral) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Kingdom == nil) != (other.Kingdom == nil) || r.Kingdom != nil && !r.Kingdom.EqualsDeep(*other.Kingdom) {
+		return false
+	}
+	if (r.Phylum == nil) != (other.Phylum == nil) || r.Phylum != nil && !r.Phylum.EqualsDeep(*other.Phylum) {
+		return false
+	}
+	if (r.Class == nil) != (other.Class == nil) || r.Class != nil && !r.Class.EqualsDeep(*other.Class) {
+		return false
+	}
+	if (r.Order == nil) != (other.Order == nil) || r.Order != nil && !r.Order.EqualsDeep(*other.Order) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) EqualsShallow(other SubstanceSourceMaterialOrganismOrganismGeneral) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSourceMaterialOrganismOrganismGeneral as FHIR XML
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "kingdom", r.Kingdom); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "phylum", r.Phylum); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "class", r.Class); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "order", r.Order); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSourceMaterialOrganismOrganismGeneral from FHIR XML
+func (r *SubstanceSourceMaterialOrganismOrganismGeneral) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "kingdom":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Kingdom = &v
+			case "phylum":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Phylum = &v
+			case "class":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Class = &v
+			case "order":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Order = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSourceMaterialOrganismOrganismGeneral as properties to the RDF node n
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSourceMaterial.organism.organismGeneral.kingdom", -1, r.Kingdom)
+	n.element("SubstanceSourceMaterial.organism.organismGeneral.phylum", -1, r.Phylum)
+	n.element("SubstanceSourceMaterial.organism.organismGeneral.class", -1, r.Class)
+	n.element("SubstanceSourceMaterial.organism.organismGeneral.order", -1, r.Order)
+}
+
+// MarshalBSON marshals the given SubstanceSourceMaterialOrganismOrganismGeneral as BSON document with the structure of its FHIR JSON
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSourceMaterialOrganismOrganismGeneral from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSourceMaterialOrganismOrganismGeneral) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSourceMaterialOrganismOrganismGeneral as protobuf message SubstanceSourceMaterialOrganismOrganismGeneral of fhir.proto
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSourceMaterialOrganismOrganismGeneral to the encoded message
+func (r SubstanceSourceMaterialOrganismOrganismGeneral) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Kingdom != nil {
+		e.message(4, *r.Kingdom)
+	}
+	if r.Phylum != nil {
+		e.message(5, *r.Phylum)
+	}
+	if r.Class != nil {
+		e.message(6, *r.Class)
+	}
+	if r.Order != nil {
+		e.message(7, *r.Order)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSourceMaterialOrganismOrganismGeneral from protobuf message SubstanceSourceMaterialOrganismOrganismGeneral of fhir.proto
+func (r *SubstanceSourceMaterialOrganismOrganismGeneral) UnmarshalProto(b []byte) error {
+	*r = SubstanceSourceMaterialOrganismOrganismGeneral{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Kingdom = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Phylum = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Class = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Order = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSourceMaterialPartDescription as JSON into a byte slice
+func (r SubstanceSourceMaterialPartDescription) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSourceMaterialPartDescription as JSON object with the members in the order of the fields
+func (r SubstanceSourceMaterialPartDescription) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Part != nil {
+		e.key("part")
+		r.Part.appendJSON(e)
+	}
+	if r.PartLocation != nil {
+		e.key("partLocation")
+		r.PartLocation.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSourceMaterialPartDescription from JSON. Unknown members are ignored.
+func (r *SubstanceSourceMaterialPartDescription) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSourceMaterialPartDescription
+func (r *SubstanceSourceMaterialPartDescription) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "part":
+			if d.null() {
+				r.Part = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Part = &v
+			}
+		case "partLocation":
+			if d.null() {
+				r.PartLocation = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PartLocation = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSourceMaterialPartDescription which shares no memory with the original
+func (r SubstanceSourceMaterialPartDescription) DeepCopy() SubstanceSourceMaterialPartDescription {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Part != nil {
+		v := r.Part.DeepCopy()
+		out.Part = &v
+	}
+	if r.PartLocation != nil {
+		v := r.PartLocation.DeepCopy()
+		out.PartLocation = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSourceMaterialPartDescription) Equal(other SubstanceSourceMaterialPartDescription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Part == nil) != (other.Part == nil) || r.Part != nil && !r.Part.Equal(*other.Part) {
+		return false
+	}
+	if (r.PartLocation == nil) != (other.PartLocation == nil) || r.PartLocation != nil && !r.PartLocation.Equal(*other.PartLocation) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSourceMaterialPartDescription) EqualsDeep(other SubstanceSourceMaterialPartDescription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Part == nil) != (other.Part == nil) || r.Part != nil && !r.Part.EqualsDeep(*other.Part) {
+		return false
+	}
+	if (r.PartLocation == nil) != (other.PartLocation == nil) || r.PartLocation != nil && !r.PartLocation.EqualsDeep(*other.PartLocation) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSourceMaterialPartDescription) EqualsShallow(other SubstanceSourceMaterialPartDescription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSourceMaterialPartDescription as FHIR XML
+func (r SubstanceSourceMaterialPartDescription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "part", r.Part); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "partLocation", r.PartLocation); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSourceMaterialPartDescription from FHIR XML
+func (r *SubstanceSourceMaterialPartDescription) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "part":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Part = &v
+			case "partLocation":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartLocation = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSourceMaterialPartDescription as properties to the RDF node n
+func (r SubstanceSourceMaterialPartDescription) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSourceMaterial.partDescription.part", -1, r.Part)
+	n.element("SubstanceSourceMaterial.partDescription.partLocation", -1, r.PartLocation)
+}
+
+// MarshalBSON marshals the given SubstanceSourceMaterialPartDescription as BSON document with the structure of its FHIR JSON
+func (r SubstanceSourceMaterialPartDescription) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSourceMaterialPartDescription from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSourceMaterialPartDescription) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSourceMaterialPartDescription as protobuf message SubstanceSourceMaterialPartDescription of fhir.proto
+func (r SubstanceSourceMaterialPartDescription) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSourceMaterialPartDescription to the encoded message
+func (r SubstanceSourceMaterialPartDescription) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Part != nil {
+		e.message(4, *r.Part)
+	}
+	if r.PartLocation != nil {
+		e.message(5, *r.PartLocation)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSourceMaterialPartDescription from protobuf message SubstanceSourceMaterialPartDescription of fhir.proto
+func (r *SubstanceSourceMaterialPartDescription) UnmarshalProto(b []byte) error {
+	*r = SubstanceSourceMaterialPartDescription{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Part = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.PartLocation = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSubstanceSourceMaterial unmarshals a SubstanceSourceMaterial.
 func UnmarshalSubstanceSourceMaterial(b []byte) (SubstanceSourceMaterial, error) {
 	var substanceSourceMaterial SubstanceSourceMaterial
-	if err := json.Unmarshal(b, &substanceSourceMaterial); err != nil {
+	if err := substanceSourceMaterial.UnmarshalJSON(b); err != nil {
 		return substanceSourceMaterial, err
 	}
 	return substanceSourceMaterial, nil
@@ -14,7 +14,10 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
@@ -22,10 +25,14 @@ import "encoding/json"
 // SubstanceSpecification is documented here http://hl7.org/fhir/StructureDefinition/SubstanceSpecification
 type SubstanceSpecification struct {
 	Id                   *string                                                 `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                                                `bson:"_id,omitempty" json:"_id,omitempty"`
 	Meta                 *Meta                                                   `bson:"meta,omitempty" json:"meta,omitempty"`
 	ImplicitRules        *string                                                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                                                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
 	Language             *string                                                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                                                `bson:"_language,omitempty" json:"_language,omitempty"`
 	Text                 *Narrative                                              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage                                       `bson:"contained,omitempty" json:"contained,omitempty"`
 	Extension            []Extension                                             `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension    []Extension                                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           *Identifier                                             `bson:"identifier,omitempty" json:"identifier,omitempty"`
@@ -33,8 +40,10 @@ type SubstanceSpecification struct {
 	Status               *CodeableConcept                                        `bson:"status,omitempty" json:"status,omitempty"`
 	Domain               *CodeableConcept                                        `bson:"domain,omitempty" json:"domain,omitempty"`
 	Description          *string                                                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                                                `bson:"_description,omitempty" json:"_description,omitempty"`
 	Source               []Reference                                             `bson:"source,omitempty" json:"source,omitempty"`
 	Comment              *string                                                 `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement       *Element                                                `bson:"_comment,omitempty" json:"_comment,omitempty"`
 	Moiety               []SubstanceSpecificationMoiety                          `bson:"moiety,omitempty" json:"moiety,omitempty"`
 	Property             []SubstanceSpecificationProperty                        `bson:"property,omitempty" json:"property,omitempty"`
 	ReferenceInformation *Reference                                              `bson:"referenceInformation,omitempty" json:"referenceInformation,omitempty"`
@@ -49,18 +58,58 @@ type SubstanceSpecification struct {
 	SourceMaterial       *Reference                                              `bson:"sourceMaterial,omitempty" json:"sourceMaterial,omitempty"`
 }
 type SubstanceSpecificationMoiety struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Role              *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
-	Identifier        *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name              *string          `bson:"name,omitempty" json:"name,omitempty"`
-	Stereochemistry   *CodeableConcept `bson:"stereochemistry,omitempty" json:"stereochemistry,omitempty"`
-	OpticalActivity   *CodeableConcept `bson:"opticalActivity,omitempty" json:"opticalActivity,omitempty"`
-	MolecularFormula  *string          `bson:"molecularFormula,omitempty" json:"molecularFormula,omitempty"`
-	AmountQuantity    *Quantity        `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
-	AmountString      *string          `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	Id                      *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Role                    *CodeableConcept `bson:"role,omitempty" json:"role,omitempty"`
+	Identifier              *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name                    *string          `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement             *Element         `bson:"_name,omitempty" json:"_name,omitempty"`
+	Stereochemistry         *CodeableConcept `bson:"stereochemistry,omitempty" json:"stereochemistry,omitempty"`
+	OpticalActivity         *CodeableConcept `bson:"opticalActivity,omitempty" json:"opticalActivity,omitempty"`
+	MolecularFormula        *string          `bson:"molecularFormula,omitempty" json:"molecularFormula,omitempty"`
+	MolecularFormulaElement *Element         `bson:"_molecularFormula,omitempty" json:"_molecularFormula,omitempty"`
+	AmountQuantity          *Quantity        `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
+	AmountString            *string          `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement     *Element         `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
+}
+
+// SubstanceSpecificationMoietyAmount is implemented by the types allowed for SubstanceSpecification.moiety.amount[x]
+type SubstanceSpecificationMoietyAmount interface {
+	isSubstanceSpecificationMoietyAmount()
+}
+
+func (Quantity) isSubstanceSpecificationMoietyAmount() {}
+func (String) isSubstanceSpecificationMoietyAmount()   {}
+
+// Amount returns the value of SubstanceSpecification.moiety.amount[x] together with its FHIR type code or nil if no value is set
+func (r SubstanceSpecificationMoiety) Amount() (SubstanceSpecificationMoietyAmount, string) {
+	if r.AmountQuantity != nil {
+		return *r.AmountQuantity, "Quantity"
+	}
+	if r.AmountString != nil {
+		return String(*r.AmountString), "string"
+	}
+	return nil, ""
+}
+
+// SetAmount sets the value of SubstanceSpecification.moiety.amount[x], given as value or pointer, and clears all other types
+func (r *SubstanceSpecificationMoiety) SetAmount(value SubstanceSpecificationMoietyAmount) {
+	r.AmountQuantity = nil
+	r.AmountString = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.AmountQuantity = &v
+	case *Quantity:
+		r.AmountQuantity = v
+	case String:
+		primitive := string(v)
+		r.AmountString = &primitive
+	case *String:
+		r.AmountString = (*string)(v)
+	}
 }
+
 type SubstanceSpecificationProperty struct {
 	Id                               *string          `bson:"id,omitempty" json:"id,omitempty"`
 	Extension                        []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
@@ -68,23 +117,99 @@ type SubstanceSpecificationProperty struct {
 	Category                         *CodeableConcept `bson:"category,omitempty" json:"category,omitempty"`
 	Code                             *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
 	Parameters                       *string          `bson:"parameters,omitempty" json:"parameters,omitempty"`
+	ParametersElement                *Element         `bson:"_parameters,omitempty" json:"_parameters,omitempty"`
 	DefiningSubstanceReference       *Reference       `bson:"definingSubstanceReference,omitempty" json:"definingSubstanceReference,omitempty"`
 	DefiningSubstanceCodeableConcept *CodeableConcept `bson:"definingSubstanceCodeableConcept,omitempty" json:"definingSubstanceCodeableConcept,omitempty"`
 	AmountQuantity                   *Quantity        `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
 	AmountString                     *string          `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement              *Element         `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
+}
+
+// SubstanceSpecificationPropertyDefiningSubstance is implemented by the types allowed for SubstanceSpecification.property.definingSubstance[x]
+type SubstanceSpecificationPropertyDefiningSubstance interface {
+	isSubstanceSpecificationPropertyDefiningSubstance()
+}
+
+func (Reference) isSubstanceSpecificationPropertyDefiningSubstance()       {}
+func (CodeableConcept) isSubstanceSpecificationPropertyDefiningSubstance() {}
+
+// DefiningSubstance returns the value of SubstanceSpecification.property.definingSubstance[x] together with its FHIR type code or nil if no value is set
+func (r SubstanceSpecificationProperty) DefiningSubstance() (SubstanceSpecificationPropertyDefiningSubstance, string) {
+	if r.DefiningSubstanceReference != nil {
+		return *r.DefiningSubstanceReference, "Reference"
+	}
+	if r.DefiningSubstanceCodeableConcept != nil {
+		return *r.DefiningSubstanceCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetDefiningSubstance sets the value of SubstanceSpecification.property.definingSubstance[x], given as value or pointer, and clears all other types
+func (r *SubstanceSpecificationProperty) SetDefiningSubstance(value SubstanceSpecificationPropertyDefiningSubstance) {
+	r.DefiningSubstanceReference = nil
+	r.DefiningSubstanceCodeableConcept = nil
+	switch v := value.(type) {
+	case Reference:
+		r.DefiningSubstanceReference = &v
+	case *Reference:
+		r.DefiningSubstanceReference = v
+	case CodeableConcept:
+		r.DefiningSubstanceCodeableConcept = &v
+	case *CodeableConcept:
+		r.DefiningSubstanceCodeableConcept = v
+	}
+}
+
+// SubstanceSpecificationPropertyAmount is implemented by the types allowed for SubstanceSpecification.property.amount[x]
+type SubstanceSpecificationPropertyAmount interface {
+	isSubstanceSpecificationPropertyAmount()
+}
+
+func (Quantity) isSubstanceSpecificationPropertyAmount() {}
+func (String) isSubstanceSpecificationPropertyAmount()   {}
+
+// Amount returns the value of SubstanceSpecification.property.amount[x] together with its FHIR type code or nil if no value is set
+func (r SubstanceSpecificationProperty) Amount() (SubstanceSpecificationPropertyAmount, string) {
+	if r.AmountQuantity != nil {
+		return *r.AmountQuantity, "Quantity"
+	}
+	if r.AmountString != nil {
+		return String(*r.AmountString), "string"
+	}
+	return nil, ""
+}
+
+// SetAmount sets the value of SubstanceSpecification.property.amount[x], given as value or pointer, and clears all other types
+func (r *SubstanceSpecificationProperty) SetAmount(value SubstanceSpecificationPropertyAmount) {
+	r.AmountQuantity = nil
+	r.AmountString = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.AmountQuantity = &v
+	case *Quantity:
+		r.AmountQuantity = v
+	case String:
+		primitive := string(v)
+		r.AmountString = &primitive
+	case *String:
+		r.AmountString = (*string)(v)
+	}
 }
+
 type SubstanceSpecificationStructure struct {
-	Id                       *string                                                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                []Extension                                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension        []Extension                                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Stereochemistry          *CodeableConcept                                       `bson:"stereochemistry,omitempty" json:"stereochemistry,omitempty"`
-	OpticalActivity          *CodeableConcept                                       `bson:"opticalActivity,omitempty" json:"opticalActivity,omitempty"`
-	MolecularFormula         *string                                                `bson:"molecularFormula,omitempty" json:"molecularFormula,omitempty"`
-	MolecularFormulaByMoiety *string                                                `bson:"molecularFormulaByMoiety,omitempty" json:"molecularFormulaByMoiety,omitempty"`
-	Isotope                  []SubstanceSpecificationStructureIsotope               `bson:"isotope,omitempty" json:"isotope,omitempty"`
-	MolecularWeight          *SubstanceSpecificationStructureIsotopeMolecularWeight `bson:"molecularWeight,omitempty" json:"molecularWeight,omitempty"`
-	Source                   []Reference                                            `bson:"source,omitempty" json:"source,omitempty"`
-	Representation           []SubstanceSpecificationStructureRepresentation        `bson:"representation,omitempty" json:"representation,omitempty"`
+	Id                              *string                                                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                       []Extension                                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension               []Extension                                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Stereochemistry                 *CodeableConcept                                       `bson:"stereochemistry,omitempty" json:"stereochemistry,omitempty"`
+	OpticalActivity                 *CodeableConcept                                       `bson:"opticalActivity,omitempty" json:"opticalActivity,omitempty"`
+	MolecularFormula                *string                                                `bson:"molecularFormula,omitempty" json:"molecularFormula,omitempty"`
+	MolecularFormulaElement         *Element                                               `bson:"_molecularFormula,omitempty" json:"_molecularFormula,omitempty"`
+	MolecularFormulaByMoiety        *string                                                `bson:"molecularFormulaByMoiety,omitempty" json:"molecularFormulaByMoiety,omitempty"`
+	MolecularFormulaByMoietyElement *Element                                               `bson:"_molecularFormulaByMoiety,omitempty" json:"_molecularFormulaByMoiety,omitempty"`
+	Isotope                         []SubstanceSpecificationStructureIsotope               `bson:"isotope,omitempty" json:"isotope,omitempty"`
+	MolecularWeight                 *SubstanceSpecificationStructureIsotopeMolecularWeight `bson:"molecularWeight,omitempty" json:"molecularWeight,omitempty"`
+	Source                          []Reference                                            `bson:"source,omitempty" json:"source,omitempty"`
+	Representation                  []SubstanceSpecificationStructureRepresentation        `bson:"representation,omitempty" json:"representation,omitempty"`
 }
 type SubstanceSpecificationStructureIsotope struct {
 	Id                *string                                                `bson:"id,omitempty" json:"id,omitempty"`
@@ -105,12 +230,13 @@ type SubstanceSpecificationStructureIsotopeMolecularWeight struct {
 	Amount            *Quantity        `bson:"amount,omitempty" json:"amount,omitempty"`
 }
 type SubstanceSpecificationStructureRepresentation struct {
-	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Representation    *string          `bson:"representation,omitempty" json:"representation,omitempty"`
-	Attachment        *Attachment      `bson:"attachment,omitempty" json:"attachment,omitempty"`
+	Id                    *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                  *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	Representation        *string          `bson:"representation,omitempty" json:"representation,omitempty"`
+	RepresentationElement *Element         `bson:"_representation,omitempty" json:"_representation,omitempty"`
+	Attachment            *Attachment      `bson:"attachment,omitempty" json:"attachment,omitempty"`
 }
 type SubstanceSpecificationCode struct {
 	Id                *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -119,7 +245,9 @@ type SubstanceSpecificationCode struct {
 	Code              *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
 	Status            *CodeableConcept `bson:"status,omitempty" json:"status,omitempty"`
 	StatusDate        *string          `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
+	StatusDateElement *Element         `bson:"_statusDate,omitempty" json:"_statusDate,omitempty"`
 	Comment           *string          `bson:"comment,omitempty" json:"comment,omitempty"`
+	CommentElement    *Element         `bson:"_comment,omitempty" json:"_comment,omitempty"`
 	Source            []Reference      `bson:"source,omitempty" json:"source,omitempty"`
 }
 type SubstanceSpecificationName struct {
@@ -127,9 +255,11 @@ type SubstanceSpecificationName struct {
 	Extension         []Extension                          `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              string                               `bson:"name" json:"name"`
+	NameElement       *Element                             `bson:"_name,omitempty" json:"_name,omitempty"`
 	Type              *CodeableConcept                     `bson:"type,omitempty" json:"type,omitempty"`
 	Status            *CodeableConcept                     `bson:"status,omitempty" json:"status,omitempty"`
 	Preferred         *bool                                `bson:"preferred,omitempty" json:"preferred,omitempty"`
+	PreferredElement  *Element                             `bson:"_preferred,omitempty" json:"_preferred,omitempty"`
 	Language          []CodeableConcept                    `bson:"language,omitempty" json:"language,omitempty"`
 	Domain            []CodeableConcept                    `bson:"domain,omitempty" json:"domain,omitempty"`
 	Jurisdiction      []CodeableConcept                    `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
@@ -145,6 +275,7 @@ type SubstanceSpecificationNameOfficial struct {
 	Authority         *CodeableConcept `bson:"authority,omitempty" json:"authority,omitempty"`
 	Status            *CodeableConcept `bson:"status,omitempty" json:"status,omitempty"`
 	Date              *string          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement       *Element         `bson:"_date,omitempty" json:"_date,omitempty"`
 }
 type SubstanceSpecificationRelationship struct {
 	Id                       *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -154,31 +285,7626 @@ type SubstanceSpecificationRelationship struct {
 	SubstanceCodeableConcept *CodeableConcept `bson:"substanceCodeableConcept,omitempty" json:"substanceCodeableConcept,omitempty"`
 	Relationship             *CodeableConcept `bson:"relationship,omitempty" json:"relationship,omitempty"`
 	IsDefining               *bool            `bson:"isDefining,omitempty" json:"isDefining,omitempty"`
+	IsDefiningElement        *Element         `bson:"_isDefining,omitempty" json:"_isDefining,omitempty"`
 	AmountQuantity           *Quantity        `bson:"amountQuantity,omitempty" json:"amountQuantity,omitempty"`
 	AmountRange              *Range           `bson:"amountRange,omitempty" json:"amountRange,omitempty"`
 	AmountRatio              *Ratio           `bson:"amountRatio,omitempty" json:"amountRatio,omitempty"`
 	AmountString             *string          `bson:"amountString,omitempty" json:"amountString,omitempty"`
+	AmountStringElement      *Element         `bson:"_amountString,omitempty" json:"_amountString,omitempty"`
 	AmountRatioLowLimit      *Ratio           `bson:"amountRatioLowLimit,omitempty" json:"amountRatioLowLimit,omitempty"`
 	AmountType               *CodeableConcept `bson:"amountType,omitempty" json:"amountType,omitempty"`
 	Source                   []Reference      `bson:"source,omitempty" json:"source,omitempty"`
 }
-type OtherSubstanceSpecification SubstanceSpecification
+
+// SubstanceSpecificationRelationshipSubstance is implemented by the types allowed for SubstanceSpecification.relationship.substance[x]
+type SubstanceSpecificationRelationshipSubstance interface {
+	isSubstanceSpecificationRelationshipSubstance()
+}
+
+func (Reference) isSubstanceSpecificationRelationshipSubstance()       {}
+func (CodeableConcept) isSubstanceSpecificationRelationshipSubstance() {}
+
+// Substance returns the value of SubstanceSpecification.relationship.substance[x] together with its FHIR type code or nil if no value is set
+func (r SubstanceSpecificationRelationship) Substance() (SubstanceSpecificationRelationshipSubstance, string) {
+	if r.SubstanceReference != nil {
+		return *r.SubstanceReference, "Reference"
+	}
+	if r.SubstanceCodeableConcept != nil {
+		return *r.SubstanceCodeableConcept, "CodeableConcept"
+	}
+	return nil, ""
+}
+
+// SetSubstance sets the value of SubstanceSpecification.relationship.substance[x], given as value or pointer, and clears all other types
+func (r *SubstanceSpecificationRelationship) SetSubstance(value SubstanceSpecificationRelationshipSubstance) {
+	r.SubstanceReference = nil
+	r.SubstanceCodeableConcept = nil
+	switch v := value.(type) {
+	case Reference:
+		r.SubstanceReference = &v
+	case *Reference:
+		r.SubstanceReference = v
+	case CodeableConcept:
+		r.SubstanceCodeableConcept = &v
+	case *CodeableConcept:
+		r.SubstanceCodeableConcept = v
+	}
+}
+
+// SubstanceSpecificationRelationshipAmount is implemented by the types allowed for SubstanceSpecification.relationship.amount[x]
+type SubstanceSpecificationRelationshipAmount interface {
+	isSubstanceSpecificationRelationshipAmount()
+}
+
+func (Quantity) isSubstanceSpecificationRelationshipAmount() {}
+func (Range) isSubstanceSpecificationRelationshipAmount()    {}
+func (Ratio) isSubstanceSpecificationRelationshipAmount()    {}
+func (String) isSubstanceSpecificationRelationshipAmount()   {}
+
+// Amount returns the value of SubstanceSpecification.relationship.amount[x] together with its FHIR type code or nil if no value is set
+func (r SubstanceSpecificationRelationship) Amount() (SubstanceSpecificationRelationshipAmount, string) {
+	if r.AmountQuantity != nil {
+		return *r.AmountQuantity, "Quantity"
+	}
+	if r.AmountRange != nil {
+		return *r.AmountRange, "Range"
+	}
+	if r.AmountRatio != nil {
+		return *r.AmountRatio, "Ratio"
+	}
+	if r.AmountString != nil {
+		return String(*r.AmountString), "string"
+	}
+	return nil, ""
+}
+
+// SetAmount sets the value of SubstanceSpecification.relationship.amount[x], given as value or pointer, and clears all other types
+func (r *SubstanceSpecificationRelationship) SetAmount(value SubstanceSpecificationRelationshipAmount) {
+	r.AmountQuantity = nil
+	r.AmountRange = nil
+	r.AmountRatio = nil
+	r.AmountString = nil
+	switch v := value.(type) {
+	case Quantity:
+		r.AmountQuantity = &v
+	case *Quantity:
+		r.AmountQuantity = v
+	case Range:
+		r.AmountRange = &v
+	case *Range:
+		r.AmountRange = v
+	case Ratio:
+		r.AmountRatio = &v
+	case *Ratio:
+		r.AmountRatio = v
+	case String:
+		primitive := string(v)
+		r.AmountString = &primitive
+	case *String:
+		r.AmountString = (*string)(v)
+	}
+}
 
 // MarshalJSON marshals the given SubstanceSpecification as JSON into a byte slice
 func (r SubstanceSpecification) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSubstanceSpecification
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSubstanceSpecification: OtherSubstanceSpecification(r),
-		ResourceType:                "SubstanceSpecification",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecification as JSON object with the members in the order of the fields
+func (r SubstanceSpecification) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"SubstanceSpecification\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		r.Status.appendJSON(e)
+	}
+	if r.Domain != nil {
+		e.key("domain")
+		r.Domain.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Source) > 0 {
+		e.key("source")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	if len(r.Moiety) > 0 {
+		e.key("moiety")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Moiety {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Property) > 0 {
+		e.key("property")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Property {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ReferenceInformation != nil {
+		e.key("referenceInformation")
+		r.ReferenceInformation.appendJSON(e)
+	}
+	if r.Structure != nil {
+		e.key("structure")
+		r.Structure.appendJSON(e)
+	}
+	if len(r.Code) > 0 {
+		e.key("code")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Code {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Name) > 0 {
+		e.key("name")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Name {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.MolecularWeight) > 0 {
+		e.key("molecularWeight")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.MolecularWeight {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Relationship) > 0 {
+		e.key("relationship")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Relationship {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.NucleicAcid != nil {
+		e.key("nucleicAcid")
+		r.NucleicAcid.appendJSON(e)
+	}
+	if r.Polymer != nil {
+		e.key("polymer")
+		r.Polymer.appendJSON(e)
+	}
+	if r.Protein != nil {
+		e.key("protein")
+		r.Protein.appendJSON(e)
+	}
+	if r.SourceMaterial != nil {
+		e.key("sourceMaterial")
+		r.SourceMaterial.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecification from JSON. Unknown members are ignored.
+func (r *SubstanceSpecification) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecification
+func (r *SubstanceSpecification) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Status = &v
+			}
+		case "domain":
+			if d.null() {
+				r.Domain = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Domain = &v
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []Reference{}
+			}
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		case "moiety":
+			a := d.array()
+			r.Moiety = nil
+			for a.next() {
+				var v SubstanceSpecificationMoiety
+				v.decodeJSON(d)
+				r.Moiety = append(r.Moiety, v)
+			}
+			if r.Moiety == nil && !a.isNull {
+				r.Moiety = []SubstanceSpecificationMoiety{}
+			}
+		case "property":
+			a := d.array()
+			r.Property = nil
+			for a.next() {
+				var v SubstanceSpecificationProperty
+				v.decodeJSON(d)
+				r.Property = append(r.Property, v)
+			}
+			if r.Property == nil && !a.isNull {
+				r.Property = []SubstanceSpecificationProperty{}
+			}
+		case "referenceInformation":
+			if d.null() {
+				r.ReferenceInformation = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReferenceInformation = &v
+			}
+		case "structure":
+			if d.null() {
+				r.Structure = nil
+			} else {
+				var v SubstanceSpecificationStructure
+				v.decodeJSON(d)
+				r.Structure = &v
+			}
+		case "code":
+			a := d.array()
+			r.Code = nil
+			for a.next() {
+				var v SubstanceSpecificationCode
+				v.decodeJSON(d)
+				r.Code = append(r.Code, v)
+			}
+			if r.Code == nil && !a.isNull {
+				r.Code = []SubstanceSpecificationCode{}
+			}
+		case "name":
+			a := d.array()
+			r.Name = nil
+			for a.next() {
+				var v SubstanceSpecificationName
+				v.decodeJSON(d)
+				r.Name = append(r.Name, v)
+			}
+			if r.Name == nil && !a.isNull {
+				r.Name = []SubstanceSpecificationName{}
+			}
+		case "molecularWeight":
+			a := d.array()
+			r.MolecularWeight = nil
+			for a.next() {
+				var v SubstanceSpecificationStructureIsotopeMolecularWeight
+				v.decodeJSON(d)
+				r.MolecularWeight = append(r.MolecularWeight, v)
+			}
+			if r.MolecularWeight == nil && !a.isNull {
+				r.MolecularWeight = []SubstanceSpecificationStructureIsotopeMolecularWeight{}
+			}
+		case "relationship":
+			a := d.array()
+			r.Relationship = nil
+			for a.next() {
+				var v SubstanceSpecificationRelationship
+				v.decodeJSON(d)
+				r.Relationship = append(r.Relationship, v)
+			}
+			if r.Relationship == nil && !a.isNull {
+				r.Relationship = []SubstanceSpecificationRelationship{}
+			}
+		case "nucleicAcid":
+			if d.null() {
+				r.NucleicAcid = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.NucleicAcid = &v
+			}
+		case "polymer":
+			if d.null() {
+				r.Polymer = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Polymer = &v
+			}
+		case "protein":
+			if d.null() {
+				r.Protein = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Protein = &v
+			}
+		case "sourceMaterial":
+			if d.null() {
+				r.SourceMaterial = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SourceMaterial = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecification which shares no memory with the original
+func (r SubstanceSpecification) DeepCopy() SubstanceSpecification {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Status != nil {
+		v := r.Status.DeepCopy()
+		out.Status = &v
+	}
+	if r.Domain != nil {
+		v := r.Domain.DeepCopy()
+		out.Domain = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Source != nil {
+		out.Source = make([]Reference, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	if r.Moiety != nil {
+		out.Moiety = make([]SubstanceSpecificationMoiety, len(r.Moiety))
+		for i := range r.Moiety {
+			out.Moiety[i] = r.Moiety[i].DeepCopy()
+		}
+	}
+	if r.Property != nil {
+		out.Property = make([]SubstanceSpecificationProperty, len(r.Property))
+		for i := range r.Property {
+			out.Property[i] = r.Property[i].DeepCopy()
+		}
+	}
+	if r.ReferenceInformation != nil {
+		v := r.ReferenceInformation.DeepCopy()
+		out.ReferenceInformation = &v
+	}
+	if r.Structure != nil {
+		v := r.Structure.DeepCopy()
+		out.Structure = &v
+	}
+	if r.Code != nil {
+		out.Code = make([]SubstanceSpecificationCode, len(r.Code))
+		for i := range r.Code {
+			out.Code[i] = r.Code[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		out.Name = make([]SubstanceSpecificationName, len(r.Name))
+		for i := range r.Name {
+			out.Name[i] = r.Name[i].DeepCopy()
+		}
+	}
+	if r.MolecularWeight != nil {
+		out.MolecularWeight = make([]SubstanceSpecificationStructureIsotopeMolecularWeight, len(r.MolecularWeight))
+		for i := range r.MolecularWeight {
+			out.MolecularWeight[i] = r.MolecularWeight[i].DeepCopy()
+		}
+	}
+	if r.Relationship != nil {
+		out.Relationship = make([]SubstanceSpecificationRelationship, len(r.Relationship))
+		for i := range r.Relationship {
+			out.Relationship[i] = r.Relationship[i].DeepCopy()
+		}
+	}
+	if r.NucleicAcid != nil {
+		v := r.NucleicAcid.DeepCopy()
+		out.NucleicAcid = &v
+	}
+	if r.Polymer != nil {
+		v := r.Polymer.DeepCopy()
+		out.Polymer = &v
+	}
+	if r.Protein != nil {
+		v := r.Protein.DeepCopy()
+		out.Protein = &v
+	}
+	if r.SourceMaterial != nil {
+		v := r.SourceMaterial.DeepCopy()
+		out.SourceMaterial = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecification) Equal(other SubstanceSpecification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.Equal(*other.Status) {
+		return false
+	}
+	if (r.Domain == nil) != (other.Domain == nil) || r.Domain != nil && !r.Domain.Equal(*other.Domain) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	if len(r.Moiety) != len(other.Moiety) {
+		return false
+	}
+	for i := range r.Moiety {
+		if !r.Moiety[i].Equal(other.Moiety[i]) {
+			return false
+		}
+	}
+	if len(r.Property) != len(other.Property) {
+		return false
+	}
+	for i := range r.Property {
+		if !r.Property[i].Equal(other.Property[i]) {
+			return false
+		}
+	}
+	if (r.ReferenceInformation == nil) != (other.ReferenceInformation == nil) || r.ReferenceInformation != nil && !r.ReferenceInformation.Equal(*other.ReferenceInformation) {
+		return false
+	}
+	if (r.Structure == nil) != (other.Structure == nil) || r.Structure != nil && !r.Structure.Equal(*other.Structure) {
+		return false
+	}
+	if len(r.Code) != len(other.Code) {
+		return false
+	}
+	for i := range r.Code {
+		if !r.Code[i].Equal(other.Code[i]) {
+			return false
+		}
+	}
+	if len(r.Name) != len(other.Name) {
+		return false
+	}
+	for i := range r.Name {
+		if !r.Name[i].Equal(other.Name[i]) {
+			return false
+		}
+	}
+	if len(r.MolecularWeight) != len(other.MolecularWeight) {
+		return false
+	}
+	for i := range r.MolecularWeight {
+		if !r.MolecularWeight[i].Equal(other.MolecularWeight[i]) {
+			return false
+		}
+	}
+	if len(r.Relationship) != len(other.Relationship) {
+		return false
+	}
+	for i := range r.Relationship {
+		if !r.Relationship[i].Equal(other.Relationship[i]) {
+			return false
+		}
+	}
+	if (r.NucleicAcid == nil) != (other.NucleicAcid == nil) || r.NucleicAcid != nil && !r.NucleicAcid.Equal(*other.NucleicAcid) {
+		return false
+	}
+	if (r.Polymer == nil) != (other.Polymer == nil) || r.Polymer != nil && !r.Polymer.Equal(*other.Polymer) {
+		return false
+	}
+	if (r.Protein == nil) != (other.Protein == nil) || r.Protein != nil && !r.Protein.Equal(*other.Protein) {
+		return false
+	}
+	if (r.SourceMaterial == nil) != (other.SourceMaterial == nil) || r.SourceMaterial != nil && !r.SourceMaterial.Equal(*other.SourceMaterial) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecification) EqualsDeep(other SubstanceSpecification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.EqualsDeep(*other.Status) {
+		return false
+	}
+	if (r.Domain == nil) != (other.Domain == nil) || r.Domain != nil && !r.Domain.EqualsDeep(*other.Domain) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	if !equivalentList(len(r.Moiety), len(other.Moiety), func(i, j int) bool {
+		return r.Moiety[i].EqualsDeep(other.Moiety[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Property), len(other.Property), func(i, j int) bool {
+		return r.Property[i].EqualsDeep(other.Property[j])
+	}) {
+		return false
+	}
+	if (r.ReferenceInformation == nil) != (other.ReferenceInformation == nil) || r.ReferenceInformation != nil && !r.ReferenceInformation.EqualsDeep(*other.ReferenceInformation) {
+		return false
+	}
+	if (r.Structure == nil) != (other.Structure == nil) || r.Structure != nil && !r.Structure.EqualsDeep(*other.Structure) {
+		return false
+	}
+	if !equivalentList(len(r.Code), len(other.Code), func(i, j int) bool {
+		return r.Code[i].EqualsDeep(other.Code[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Name), len(other.Name), func(i, j int) bool {
+		return r.Name[i].EqualsDeep(other.Name[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.MolecularWeight), len(other.MolecularWeight), func(i, j int) bool {
+		return r.MolecularWeight[i].EqualsDeep(other.MolecularWeight[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Relationship), len(other.Relationship), func(i, j int) bool {
+		return r.Relationship[i].EqualsDeep(other.Relationship[j])
+	}) {
+		return false
+	}
+	if (r.NucleicAcid == nil) != (other.NucleicAcid == nil) || r.NucleicAcid != nil && !r.NucleicAcid.EqualsDeep(*other.NucleicAcid) {
+		return false
+	}
+	if (r.Polymer == nil) != (other.Polymer == nil) || r.Polymer != nil && !r.Polymer.EqualsDeep(*other.Polymer) {
+		return false
+	}
+	if (r.Protein == nil) != (other.Protein == nil) || r.Protein != nil && !r.Protein.EqualsDeep(*other.Protein) {
+		return false
+	}
+	if (r.SourceMaterial == nil) != (other.SourceMaterial == nil) || r.SourceMaterial != nil && !r.SourceMaterial.EqualsDeep(*other.SourceMaterial) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecification) EqualsShallow(other SubstanceSpecification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecification as FHIR XML
+func (r SubstanceSpecification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "SubstanceSpecification",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "status", r.Status); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "domain", r.Domain); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	for _, v := range r.Moiety {
+		if err := encodeXMLElement(e, "moiety", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Property {
+		if err := encodeXMLElement(e, "property", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "referenceInformation", r.ReferenceInformation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "structure", r.Structure); err != nil {
+		return err
+	}
+	for _, v := range r.Code {
+		if err := encodeXMLElement(e, "code", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Name {
+		if err := encodeXMLElement(e, "name", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.MolecularWeight {
+		if err := encodeXMLElement(e, "molecularWeight", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Relationship {
+		if err := encodeXMLElement(e, "relationship", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "nucleicAcid", r.NucleicAcid); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "polymer", r.Polymer); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "protein", r.Protein); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sourceMaterial", r.SourceMaterial); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecification from FHIR XML
+func (r *SubstanceSpecification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "status":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Status = &v
+			case "domain":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Domain = &v
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			case "moiety":
+				var v SubstanceSpecificationMoiety
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Moiety = append(r.Moiety, v)
+			case "property":
+				var v SubstanceSpecificationProperty
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Property = append(r.Property, v)
+			case "referenceInformation":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReferenceInformation = &v
+			case "structure":
+				var v SubstanceSpecificationStructure
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Structure = &v
+			case "code":
+				var v SubstanceSpecificationCode
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = append(r.Code, v)
+			case "name":
+				var v SubstanceSpecificationName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Name = append(r.Name, v)
+			case "molecularWeight":
+				var v SubstanceSpecificationStructureIsotopeMolecularWeight
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MolecularWeight = append(r.MolecularWeight, v)
+			case "relationship":
+				var v SubstanceSpecificationRelationship
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Relationship = append(r.Relationship, v)
+			case "nucleicAcid":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.NucleicAcid = &v
+			case "polymer":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Polymer = &v
+			case "protein":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Protein = &v
+			case "sourceMaterial":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SourceMaterial = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecification as properties to the RDF node n
+func (r SubstanceSpecification) turtle(n *turtleNode) {
+	n.resource("SubstanceSpecification", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.identifier", -1, r.Identifier)
+	n.element("SubstanceSpecification.type", -1, r.Type)
+	n.element("SubstanceSpecification.status", -1, r.Status)
+	n.element("SubstanceSpecification.domain", -1, r.Domain)
+	n.primitive("SubstanceSpecification.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Source {
+		n.element("SubstanceSpecification.source", i, v)
+	}
+	n.primitive("SubstanceSpecification.comment", -1, r.Comment, r.CommentElement, "string")
+	for i, v := range r.Moiety {
+		n.element("SubstanceSpecification.moiety", i, v)
+	}
+	for i, v := range r.Property {
+		n.element("SubstanceSpecification.property", i, v)
+	}
+	n.element("SubstanceSpecification.referenceInformation", -1, r.ReferenceInformation)
+	n.element("SubstanceSpecification.structure", -1, r.Structure)
+	for i, v := range r.Code {
+		n.element("SubstanceSpecification.code", i, v)
+	}
+	for i, v := range r.Name {
+		n.element("SubstanceSpecification.name", i, v)
+	}
+	for i, v := range r.MolecularWeight {
+		n.element("SubstanceSpecification.molecularWeight", i, v)
+	}
+	for i, v := range r.Relationship {
+		n.element("SubstanceSpecification.relationship", i, v)
+	}
+	n.element("SubstanceSpecification.nucleicAcid", -1, r.NucleicAcid)
+	n.element("SubstanceSpecification.polymer", -1, r.Polymer)
+	n.element("SubstanceSpecification.protein", -1, r.Protein)
+	n.element("SubstanceSpecification.sourceMaterial", -1, r.SourceMaterial)
+}
+
+// MarshalBSON marshals the given SubstanceSpecification as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecification from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecification) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecification as protobuf message SubstanceSpecification of fhir.proto
+func (r SubstanceSpecification) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecification to the encoded message
+func (r SubstanceSpecification) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Identifier != nil {
+		e.message(9, *r.Identifier)
+	}
+	if r.Type != nil {
+		e.message(10, *r.Type)
+	}
+	if r.Status != nil {
+		e.message(11, *r.Status)
+	}
+	if r.Domain != nil {
+		e.message(12, *r.Domain)
+	}
+	if r.Description != nil {
+		e.string(13, *r.Description)
+	}
+	for _, v := range r.Source {
+		e.message(14, v)
+	}
+	if r.Comment != nil {
+		e.string(15, *r.Comment)
+	}
+	for _, v := range r.Moiety {
+		e.message(16, v)
+	}
+	for _, v := range r.Property {
+		e.message(17, v)
+	}
+	if r.ReferenceInformation != nil {
+		e.message(18, *r.ReferenceInformation)
+	}
+	if r.Structure != nil {
+		e.message(19, *r.Structure)
+	}
+	for _, v := range r.Code {
+		e.message(20, v)
+	}
+	for _, v := range r.Name {
+		e.message(21, v)
+	}
+	for _, v := range r.MolecularWeight {
+		e.message(22, v)
+	}
+	for _, v := range r.Relationship {
+		e.message(23, v)
+	}
+	if r.NucleicAcid != nil {
+		e.message(24, *r.NucleicAcid)
+	}
+	if r.Polymer != nil {
+		e.message(25, *r.Polymer)
+	}
+	if r.Protein != nil {
+		e.message(26, *r.Protein)
+	}
+	if r.SourceMaterial != nil {
+		e.message(27, *r.SourceMaterial)
+	}
+	if r.IdElement != nil {
+		e.message(28, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(29, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(30, *r.LanguageElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(31, *r.DescriptionElement)
+	}
+	if r.CommentElement != nil {
+		e.message(32, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecification from protobuf message SubstanceSpecification of fhir.proto
+func (r *SubstanceSpecification) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecification{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Status = &v
+		case 12:
+			var v CodeableConcept
+			d.message(&v)
+			r.Domain = &v
+		case 13:
+			v := d.string()
+			r.Description = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		case 15:
+			v := d.string()
+			r.Comment = &v
+		case 16:
+			var v SubstanceSpecificationMoiety
+			d.message(&v)
+			r.Moiety = append(r.Moiety, v)
+		case 17:
+			var v SubstanceSpecificationProperty
+			d.message(&v)
+			r.Property = append(r.Property, v)
+		case 18:
+			var v Reference
+			d.message(&v)
+			r.ReferenceInformation = &v
+		case 19:
+			var v SubstanceSpecificationStructure
+			d.message(&v)
+			r.Structure = &v
+		case 20:
+			var v SubstanceSpecificationCode
+			d.message(&v)
+			r.Code = append(r.Code, v)
+		case 21:
+			var v SubstanceSpecificationName
+			d.message(&v)
+			r.Name = append(r.Name, v)
+		case 22:
+			var v SubstanceSpecificationStructureIsotopeMolecularWeight
+			d.message(&v)
+			r.MolecularWeight = append(r.MolecularWeight, v)
+		case 23:
+			var v SubstanceSpecificationRelationship
+			d.message(&v)
+			r.Relationship = append(r.Relationship, v)
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.NucleicAcid = &v
+		case 25:
+			var v Reference
+			d.message(&v)
+			r.Polymer = &v
+		case 26:
+			var v Reference
+			d.message(&v)
+			r.Protein = &v
+		case 27:
+			var v Reference
+			d.message(&v)
+			r.SourceMaterial = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationMoiety as JSON into a byte slice
+func (r SubstanceSpecificationMoiety) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationMoiety as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationMoiety) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Role != nil {
+		e.key("role")
+		r.Role.appendJSON(e)
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Stereochemistry != nil {
+		e.key("stereochemistry")
+		r.Stereochemistry.appendJSON(e)
+	}
+	if r.OpticalActivity != nil {
+		e.key("opticalActivity")
+		r.OpticalActivity.appendJSON(e)
+	}
+	if r.MolecularFormula != nil {
+		e.key("molecularFormula")
+		e.string(*r.MolecularFormula)
+	}
+	if r.MolecularFormulaElement != nil {
+		e.key("_molecularFormula")
+		r.MolecularFormulaElement.appendJSON(e)
+	}
+	if r.AmountQuantity != nil {
+		e.key("amountQuantity")
+		r.AmountQuantity.appendJSON(e)
+	}
+	if r.AmountString != nil {
+		e.key("amountString")
+		e.string(*r.AmountString)
+	}
+	if r.AmountStringElement != nil {
+		e.key("_amountString")
+		r.AmountStringElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationMoiety from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationMoiety) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationMoiety
+func (r *SubstanceSpecificationMoiety) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "role":
+			if d.null() {
+				r.Role = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Role = &v
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "stereochemistry":
+			if d.null() {
+				r.Stereochemistry = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Stereochemistry = &v
+			}
+		case "opticalActivity":
+			if d.null() {
+				r.OpticalActivity = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OpticalActivity = &v
+			}
+		case "molecularFormula":
+			if v, ok := d.string(); ok {
+				r.MolecularFormula = &v
+			} else {
+				r.MolecularFormula = nil
+			}
+		case "_molecularFormula":
+			r.MolecularFormulaElement = d.element()
+		case "amountQuantity":
+			if d.null() {
+				r.AmountQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.AmountQuantity = &v
+			}
+		case "amountString":
+			if v, ok := d.string(); ok {
+				r.AmountString = &v
+			} else {
+				r.AmountString = nil
+			}
+		case "_amountString":
+			r.AmountStringElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationMoiety which shares no memory with the original
+func (r SubstanceSpecificationMoiety) DeepCopy() SubstanceSpecificationMoiety {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Role != nil {
+		v := r.Role.DeepCopy()
+		out.Role = &v
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Stereochemistry != nil {
+		v := r.Stereochemistry.DeepCopy()
+		out.Stereochemistry = &v
+	}
+	if r.OpticalActivity != nil {
+		v := r.OpticalActivity.DeepCopy()
+		out.OpticalActivity = &v
+	}
+	if r.MolecularFormula != nil {
+		v := *r.MolecularFormula
+		out.MolecularFormula = &v
+	}
+	if r.MolecularFormulaElement != nil {
+		v := r.MolecularFormulaElement.DeepCopy()
+		out.MolecularFormulaElement = &v
+	}
+	if r.AmountQuantity != nil {
+		v := r.AmountQuantity.DeepCopy()
+		out.AmountQuantity = &v
+	}
+	if r.AmountString != nil {
+		v := *r.AmountString
+		out.AmountString = &v
+	}
+	if r.AmountStringElement != nil {
+		v := r.AmountStringElement.DeepCopy()
+		out.AmountStringElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationMoiety) Equal(other SubstanceSpecificationMoiety) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.Equal(*other.Role) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Stereochemistry == nil) != (other.Stereochemistry == nil) || r.Stereochemistry != nil && !r.Stereochemistry.Equal(*other.Stereochemistry) {
+		return false
+	}
+	if (r.OpticalActivity == nil) != (other.OpticalActivity == nil) || r.OpticalActivity != nil && !r.OpticalActivity.Equal(*other.OpticalActivity) {
+		return false
+	}
+	if (r.MolecularFormula == nil) != (other.MolecularFormula == nil) || r.MolecularFormula != nil && *r.MolecularFormula != *other.MolecularFormula {
+		return false
+	}
+	if (r.MolecularFormulaElement == nil) != (other.MolecularFormulaElement == nil) || r.MolecularFormulaElement != nil && !r.MolecularFormulaElement.Equal(*other.MolecularFormulaElement) {
+		return false
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.Equal(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && *r.AmountString != *other.AmountString {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.Equal(*other.AmountStringElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationMoiety) EqualsDeep(other SubstanceSpecificationMoiety) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Role == nil) != (other.Role == nil) || r.Role != nil && !r.Role.EqualsDeep(*other.Role) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Stereochemistry == nil) != (other.Stereochemistry == nil) || r.Stereochemistry != nil && !r.Stereochemistry.EqualsDeep(*other.Stereochemistry) {
+		return false
+	}
+	if (r.OpticalActivity == nil) != (other.OpticalActivity == nil) || r.OpticalActivity != nil && !r.OpticalActivity.EqualsDeep(*other.OpticalActivity) {
+		return false
+	}
+	if (r.MolecularFormula == nil) != (other.MolecularFormula == nil) || r.MolecularFormula != nil && !equivalentString(*r.MolecularFormula, *other.MolecularFormula) {
+		return false
+	}
+	if (r.MolecularFormulaElement == nil) != (other.MolecularFormulaElement == nil) || r.MolecularFormulaElement != nil && !r.MolecularFormulaElement.EqualsDeep(*other.MolecularFormulaElement) {
+		return false
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.EqualsDeep(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.EqualsDeep(*other.AmountStringElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationMoiety) EqualsShallow(other SubstanceSpecificationMoiety) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.MolecularFormula == nil) != (other.MolecularFormula == nil) || r.MolecularFormula != nil && !equivalentString(*r.MolecularFormula, *other.MolecularFormula) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationMoiety as FHIR XML
+func (r SubstanceSpecificationMoiety) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "role", r.Role); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "stereochemistry", r.Stereochemistry); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "opticalActivity", r.OpticalActivity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "molecularFormula", r.MolecularFormula, r.MolecularFormulaElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountQuantity", r.AmountQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "amountString", r.AmountString, r.AmountStringElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationMoiety from FHIR XML
+func (r *SubstanceSpecificationMoiety) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "role":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Role = &v
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "stereochemistry":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Stereochemistry = &v
+			case "opticalActivity":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OpticalActivity = &v
+			case "molecularFormula":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MolecularFormula = &v
+				}
+				r.MolecularFormulaElement = element
+			case "amountQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountQuantity = &v
+			case "amountString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AmountString = &v
+				}
+				r.AmountStringElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationMoiety as properties to the RDF node n
+func (r SubstanceSpecificationMoiety) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.moiety.role", -1, r.Role)
+	n.element("SubstanceSpecification.moiety.identifier", -1, r.Identifier)
+	n.primitive("SubstanceSpecification.moiety.name", -1, r.Name, r.NameElement, "string")
+	n.element("SubstanceSpecification.moiety.stereochemistry", -1, r.Stereochemistry)
+	n.element("SubstanceSpecification.moiety.opticalActivity", -1, r.OpticalActivity)
+	n.primitive("SubstanceSpecification.moiety.molecularFormula", -1, r.MolecularFormula, r.MolecularFormulaElement, "string")
+	n.element("SubstanceSpecification.moiety.amountQuantity", -1, r.AmountQuantity)
+	n.primitive("SubstanceSpecification.moiety.amountString", -1, r.AmountString, r.AmountStringElement, "string")
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationMoiety as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationMoiety) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationMoiety from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationMoiety) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationMoiety as protobuf message SubstanceSpecificationMoiety of fhir.proto
+func (r SubstanceSpecificationMoiety) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationMoiety to the encoded message
+func (r SubstanceSpecificationMoiety) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Role != nil {
+		e.message(4, *r.Role)
+	}
+	if r.Identifier != nil {
+		e.message(5, *r.Identifier)
+	}
+	if r.Name != nil {
+		e.string(6, *r.Name)
+	}
+	if r.Stereochemistry != nil {
+		e.message(7, *r.Stereochemistry)
+	}
+	if r.OpticalActivity != nil {
+		e.message(8, *r.OpticalActivity)
+	}
+	if r.MolecularFormula != nil {
+		e.string(9, *r.MolecularFormula)
+	}
+	if r.AmountQuantity != nil {
+		e.message(10, *r.AmountQuantity)
+	}
+	if r.AmountString != nil {
+		e.string(11, *r.AmountString)
+	}
+	if r.NameElement != nil {
+		e.message(12, *r.NameElement)
+	}
+	if r.MolecularFormulaElement != nil {
+		e.message(13, *r.MolecularFormulaElement)
+	}
+	if r.AmountStringElement != nil {
+		e.message(14, *r.AmountStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationMoiety from protobuf message SubstanceSpecificationMoiety of fhir.proto
+func (r *SubstanceSpecificationMoiety) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationMoiety{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Role = &v
+		case 5:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 6:
+			v := d.string()
+			r.Name = &v
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.Stereochemistry = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.OpticalActivity = &v
+		case 9:
+			v := d.string()
+			r.MolecularFormula = &v
+		case 10:
+			var v Quantity
+			d.message(&v)
+			r.AmountQuantity = &v
+		case 11:
+			v := d.string()
+			r.AmountString = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.MolecularFormulaElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.AmountStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationProperty as JSON into a byte slice
+func (r SubstanceSpecificationProperty) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationProperty as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationProperty) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Category != nil {
+		e.key("category")
+		r.Category.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Parameters != nil {
+		e.key("parameters")
+		e.string(*r.Parameters)
+	}
+	if r.ParametersElement != nil {
+		e.key("_parameters")
+		r.ParametersElement.appendJSON(e)
+	}
+	if r.DefiningSubstanceReference != nil {
+		e.key("definingSubstanceReference")
+		r.DefiningSubstanceReference.appendJSON(e)
+	}
+	if r.DefiningSubstanceCodeableConcept != nil {
+		e.key("definingSubstanceCodeableConcept")
+		r.DefiningSubstanceCodeableConcept.appendJSON(e)
+	}
+	if r.AmountQuantity != nil {
+		e.key("amountQuantity")
+		r.AmountQuantity.appendJSON(e)
+	}
+	if r.AmountString != nil {
+		e.key("amountString")
+		e.string(*r.AmountString)
+	}
+	if r.AmountStringElement != nil {
+		e.key("_amountString")
+		r.AmountStringElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationProperty from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationProperty) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationProperty
+func (r *SubstanceSpecificationProperty) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "category":
+			if d.null() {
+				r.Category = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Category = &v
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "parameters":
+			if v, ok := d.string(); ok {
+				r.Parameters = &v
+			} else {
+				r.Parameters = nil
+			}
+		case "_parameters":
+			r.ParametersElement = d.element()
+		case "definingSubstanceReference":
+			if d.null() {
+				r.DefiningSubstanceReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.DefiningSubstanceReference = &v
+			}
+		case "definingSubstanceCodeableConcept":
+			if d.null() {
+				r.DefiningSubstanceCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.DefiningSubstanceCodeableConcept = &v
+			}
+		case "amountQuantity":
+			if d.null() {
+				r.AmountQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.AmountQuantity = &v
+			}
+		case "amountString":
+			if v, ok := d.string(); ok {
+				r.AmountString = &v
+			} else {
+				r.AmountString = nil
+			}
+		case "_amountString":
+			r.AmountStringElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationProperty which shares no memory with the original
+func (r SubstanceSpecificationProperty) DeepCopy() SubstanceSpecificationProperty {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Category != nil {
+		v := r.Category.DeepCopy()
+		out.Category = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Parameters != nil {
+		v := *r.Parameters
+		out.Parameters = &v
+	}
+	if r.ParametersElement != nil {
+		v := r.ParametersElement.DeepCopy()
+		out.ParametersElement = &v
+	}
+	if r.DefiningSubstanceReference != nil {
+		v := r.DefiningSubstanceReference.DeepCopy()
+		out.DefiningSubstanceReference = &v
+	}
+	if r.DefiningSubstanceCodeableConcept != nil {
+		v := r.DefiningSubstanceCodeableConcept.DeepCopy()
+		out.DefiningSubstanceCodeableConcept = &v
+	}
+	if r.AmountQuantity != nil {
+		v := r.AmountQuantity.DeepCopy()
+		out.AmountQuantity = &v
+	}
+	if r.AmountString != nil {
+		v := *r.AmountString
+		out.AmountString = &v
+	}
+	if r.AmountStringElement != nil {
+		v := r.AmountStringElement.DeepCopy()
+		out.AmountStringElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationProperty) Equal(other SubstanceSpecificationProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.Equal(*other.Category) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Parameters == nil) != (other.Parameters == nil) || r.Parameters != nil && *r.Parameters != *other.Parameters {
+		return false
+	}
+	if (r.ParametersElement == nil) != (other.ParametersElement == nil) || r.ParametersElement != nil && !r.ParametersElement.Equal(*other.ParametersElement) {
+		return false
+	}
+	if (r.DefiningSubstanceReference == nil) != (other.DefiningSubstanceReference == nil) || r.DefiningSubstanceReference != nil && !r.DefiningSubstanceReference.Equal(*other.DefiningSubstanceReference) {
+		return false
+	}
+	if (r.DefiningSubstanceCodeableConcept == nil) != (other.DefiningSubstanceCodeableConcept == nil) || r.DefiningSubstanceCodeableConcept != nil && !r.DefiningSubstanceCodeableConcept.Equal(*other.DefiningSubstanceCodeableConcept) {
+		return false
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.Equal(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && *r.AmountString != *other.AmountString {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.Equal(*other.AmountStringElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationProperty) EqualsDeep(other SubstanceSpecificationProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Category == nil) != (other.Category == nil) || r.Category != nil && !r.Category.EqualsDeep(*other.Category) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Parameters == nil) != (other.Parameters == nil) || r.Parameters != nil && !equivalentString(*r.Parameters, *other.Parameters) {
+		return false
+	}
+	if (r.ParametersElement == nil) != (other.ParametersElement == nil) || r.ParametersElement != nil && !r.ParametersElement.EqualsDeep(*other.ParametersElement) {
+		return false
+	}
+	if (r.DefiningSubstanceReference == nil) != (other.DefiningSubstanceReference == nil) || r.DefiningSubstanceReference != nil && !r.DefiningSubstanceReference.EqualsDeep(*other.DefiningSubstanceReference) {
+		return false
+	}
+	if (r.DefiningSubstanceCodeableConcept == nil) != (other.DefiningSubstanceCodeableConcept == nil) || r.DefiningSubstanceCodeableConcept != nil && !r.DefiningSubstanceCodeableConcept.EqualsDeep(*other.DefiningSubstanceCodeableConcept) {
+		return false
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.EqualsDeep(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.EqualsDeep(*other.AmountStringElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationProperty) EqualsShallow(other SubstanceSpecificationProperty) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Parameters == nil) != (other.Parameters == nil) || r.Parameters != nil && !equivalentString(*r.Parameters, *other.Parameters) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationProperty as FHIR XML
+func (r SubstanceSpecificationProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "category", r.Category); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "parameters", r.Parameters, r.ParametersElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "definingSubstanceReference", r.DefiningSubstanceReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "definingSubstanceCodeableConcept", r.DefiningSubstanceCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountQuantity", r.AmountQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "amountString", r.AmountString, r.AmountStringElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationProperty from FHIR XML
+func (r *SubstanceSpecificationProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "category":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Category = &v
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "parameters":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Parameters = &v
+				}
+				r.ParametersElement = element
+			case "definingSubstanceReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefiningSubstanceReference = &v
+			case "definingSubstanceCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.DefiningSubstanceCodeableConcept = &v
+			case "amountQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountQuantity = &v
+			case "amountString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AmountString = &v
+				}
+				r.AmountStringElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationProperty as properties to the RDF node n
+func (r SubstanceSpecificationProperty) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.property.category", -1, r.Category)
+	n.element("SubstanceSpecification.property.code", -1, r.Code)
+	n.primitive("SubstanceSpecification.property.parameters", -1, r.Parameters, r.ParametersElement, "string")
+	n.element("SubstanceSpecification.property.definingSubstanceReference", -1, r.DefiningSubstanceReference)
+	n.element("SubstanceSpecification.property.definingSubstanceCodeableConcept", -1, r.DefiningSubstanceCodeableConcept)
+	n.element("SubstanceSpecification.property.amountQuantity", -1, r.AmountQuantity)
+	n.primitive("SubstanceSpecification.property.amountString", -1, r.AmountString, r.AmountStringElement, "string")
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationProperty as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationProperty from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationProperty) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationProperty as protobuf message SubstanceSpecificationProperty of fhir.proto
+func (r SubstanceSpecificationProperty) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationProperty to the encoded message
+func (r SubstanceSpecificationProperty) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Category != nil {
+		e.message(4, *r.Category)
+	}
+	if r.Code != nil {
+		e.message(5, *r.Code)
+	}
+	if r.Parameters != nil {
+		e.string(6, *r.Parameters)
+	}
+	if r.DefiningSubstanceReference != nil {
+		e.message(7, *r.DefiningSubstanceReference)
+	}
+	if r.DefiningSubstanceCodeableConcept != nil {
+		e.message(8, *r.DefiningSubstanceCodeableConcept)
+	}
+	if r.AmountQuantity != nil {
+		e.message(9, *r.AmountQuantity)
+	}
+	if r.AmountString != nil {
+		e.string(10, *r.AmountString)
+	}
+	if r.ParametersElement != nil {
+		e.message(11, *r.ParametersElement)
+	}
+	if r.AmountStringElement != nil {
+		e.message(12, *r.AmountStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationProperty from protobuf message SubstanceSpecificationProperty of fhir.proto
+func (r *SubstanceSpecificationProperty) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationProperty{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Category = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 6:
+			v := d.string()
+			r.Parameters = &v
+		case 7:
+			var v Reference
+			d.message(&v)
+			r.DefiningSubstanceReference = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.DefiningSubstanceCodeableConcept = &v
+		case 9:
+			var v Quantity
+			d.message(&v)
+			r.AmountQuantity = &v
+		case 10:
+			v := d.string()
+			r.AmountString = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ParametersElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.AmountStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationStructure as JSON into a byte slice
+func (r SubstanceSpecificationStructure) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationStructure as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationStructure) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Stereochemistry != nil {
+		e.key("stereochemistry")
+		r.Stereochemistry.appendJSON(e)
+	}
+	if r.OpticalActivity != nil {
+		e.key("opticalActivity")
+		r.OpticalActivity.appendJSON(e)
+	}
+	if r.MolecularFormula != nil {
+		e.key("molecularFormula")
+		e.string(*r.MolecularFormula)
+	}
+	if r.MolecularFormulaElement != nil {
+		e.key("_molecularFormula")
+		r.MolecularFormulaElement.appendJSON(e)
+	}
+	if r.MolecularFormulaByMoiety != nil {
+		e.key("molecularFormulaByMoiety")
+		e.string(*r.MolecularFormulaByMoiety)
+	}
+	if r.MolecularFormulaByMoietyElement != nil {
+		e.key("_molecularFormulaByMoiety")
+		r.MolecularFormulaByMoietyElement.appendJSON(e)
+	}
+	if len(r.Isotope) > 0 {
+		e.key("isotope")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Isotope {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.MolecularWeight != nil {
+		e.key("molecularWeight")
+		r.MolecularWeight.appendJSON(e)
+	}
+	if len(r.Source) > 0 {
+		e.key("source")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Representation) > 0 {
+		e.key("representation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Representation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationStructure from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationStructure) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationStructure
+func (r *SubstanceSpecificationStructure) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "stereochemistry":
+			if d.null() {
+				r.Stereochemistry = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Stereochemistry = &v
+			}
+		case "opticalActivity":
+			if d.null() {
+				r.OpticalActivity = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.OpticalActivity = &v
+			}
+		case "molecularFormula":
+			if v, ok := d.string(); ok {
+				r.MolecularFormula = &v
+			} else {
+				r.MolecularFormula = nil
+			}
+		case "_molecularFormula":
+			r.MolecularFormulaElement = d.element()
+		case "molecularFormulaByMoiety":
+			if v, ok := d.string(); ok {
+				r.MolecularFormulaByMoiety = &v
+			} else {
+				r.MolecularFormulaByMoiety = nil
+			}
+		case "_molecularFormulaByMoiety":
+			r.MolecularFormulaByMoietyElement = d.element()
+		case "isotope":
+			a := d.array()
+			r.Isotope = nil
+			for a.next() {
+				var v SubstanceSpecificationStructureIsotope
+				v.decodeJSON(d)
+				r.Isotope = append(r.Isotope, v)
+			}
+			if r.Isotope == nil && !a.isNull {
+				r.Isotope = []SubstanceSpecificationStructureIsotope{}
+			}
+		case "molecularWeight":
+			if d.null() {
+				r.MolecularWeight = nil
+			} else {
+				var v SubstanceSpecificationStructureIsotopeMolecularWeight
+				v.decodeJSON(d)
+				r.MolecularWeight = &v
+			}
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []Reference{}
+			}
+		case "representation":
+			a := d.array()
+			r.Representation = nil
+			for a.next() {
+				var v SubstanceSpecificationStructureRepresentation
+				v.decodeJSON(d)
+				r.Representation = append(r.Representation, v)
+			}
+			if r.Representation == nil && !a.isNull {
+				r.Representation = []SubstanceSpecificationStructureRepresentation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationStructure which shares no memory with the original
+func (r SubstanceSpecificationStructure) DeepCopy() SubstanceSpecificationStructure {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Stereochemistry != nil {
+		v := r.Stereochemistry.DeepCopy()
+		out.Stereochemistry = &v
+	}
+	if r.OpticalActivity != nil {
+		v := r.OpticalActivity.DeepCopy()
+		out.OpticalActivity = &v
+	}
+	if r.MolecularFormula != nil {
+		v := *r.MolecularFormula
+		out.MolecularFormula = &v
+	}
+	if r.MolecularFormulaElement != nil {
+		v := r.MolecularFormulaElement.DeepCopy()
+		out.MolecularFormulaElement = &v
+	}
+	if r.MolecularFormulaByMoiety != nil {
+		v := *r.MolecularFormulaByMoiety
+		out.MolecularFormulaByMoiety = &v
+	}
+	if r.MolecularFormulaByMoietyElement != nil {
+		v := r.MolecularFormulaByMoietyElement.DeepCopy()
+		out.MolecularFormulaByMoietyElement = &v
+	}
+	if r.Isotope != nil {
+		out.Isotope = make([]SubstanceSpecificationStructureIsotope, len(r.Isotope))
+		for i := range r.Isotope {
+			out.Isotope[i] = r.Isotope[i].DeepCopy()
+		}
+	}
+	if r.MolecularWeight != nil {
+		v := r.MolecularWeight.DeepCopy()
+		out.MolecularWeight = &v
+	}
+	if r.Source != nil {
+		out.Source = make([]Reference, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	if r.Representation != nil {
+		out.Representation = make([]SubstanceSpecificationStructureRepresentation, len(r.Representation))
+		for i := range r.Representation {
+			out.Representation[i] = r.Representation[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationStructure) Equal(other SubstanceSpecificationStructure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Stereochemistry == nil) != (other.Stereochemistry == nil) || r.Stereochemistry != nil && !r.Stereochemistry.Equal(*other.Stereochemistry) {
+		return false
+	}
+	if (r.OpticalActivity == nil) != (other.OpticalActivity == nil) || r.OpticalActivity != nil && !r.OpticalActivity.Equal(*other.OpticalActivity) {
+		return false
+	}
+	if (r.MolecularFormula == nil) != (other.MolecularFormula == nil) || r.MolecularFormula != nil && *r.MolecularFormula != *other.MolecularFormula {
+		return false
+	}
+	if (r.MolecularFormulaElement == nil) != (other.MolecularFormulaElement == nil) || r.MolecularFormulaElement != nil && !r.MolecularFormulaElement.Equal(*other.MolecularFormulaElement) {
+		return false
+	}
+	if (r.MolecularFormulaByMoiety == nil) != (other.MolecularFormulaByMoiety == nil) || r.MolecularFormulaByMoiety != nil && *r.MolecularFormulaByMoiety != *other.MolecularFormulaByMoiety {
+		return false
+	}
+	if (r.MolecularFormulaByMoietyElement == nil) != (other.MolecularFormulaByMoietyElement == nil) || r.MolecularFormulaByMoietyElement != nil && !r.MolecularFormulaByMoietyElement.Equal(*other.MolecularFormulaByMoietyElement) {
+		return false
+	}
+	if len(r.Isotope) != len(other.Isotope) {
+		return false
+	}
+	for i := range r.Isotope {
+		if !r.Isotope[i].Equal(other.Isotope[i]) {
+			return false
+		}
+	}
+	if (r.MolecularWeight == nil) != (other.MolecularWeight == nil) || r.MolecularWeight != nil && !r.MolecularWeight.Equal(*other.MolecularWeight) {
+		return false
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	if len(r.Representation) != len(other.Representation) {
+		return false
+	}
+	for i := range r.Representation {
+		if !r.Representation[i].Equal(other.Representation[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationStructure) EqualsDeep(other SubstanceSpecificationStructure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Stereochemistry == nil) != (other.Stereochemistry == nil) || r.Stereochemistry != nil && !r.Stereochemistry.EqualsDeep(*other.Stereochemistry) {
+		return false
+	}
+	if (r.OpticalActivity == nil) != (other.OpticalActivity == nil) || r.OpticalActivity != nil && !r.OpticalActivity.EqualsDeep(*other.OpticalActivity) {
+		return false
+	}
+	if (r.MolecularFormula == nil) != (other.MolecularFormula == nil) || r.MolecularFormula != nil && !equivalentString(*r.MolecularFormula, *other.MolecularFormula) {
+		return false
+	}
+	if (r.MolecularFormulaElement == nil) != (other.MolecularFormulaElement == nil) || r.MolecularFormulaElement != nil && !r.MolecularFormulaElement.EqualsDeep(*other.MolecularFormulaElement) {
+		return false
+	}
+	if (r.MolecularFormulaByMoiety == nil) != (other.MolecularFormulaByMoiety == nil) || r.MolecularFormulaByMoiety != nil && !equivalentString(*r.MolecularFormulaByMoiety, *other.MolecularFormulaByMoiety) {
+		return false
+	}
+	if (r.MolecularFormulaByMoietyElement == nil) != (other.MolecularFormulaByMoietyElement == nil) || r.MolecularFormulaByMoietyElement != nil && !r.MolecularFormulaByMoietyElement.EqualsDeep(*other.MolecularFormulaByMoietyElement) {
+		return false
+	}
+	if !equivalentList(len(r.Isotope), len(other.Isotope), func(i, j int) bool {
+		return r.Isotope[i].EqualsDeep(other.Isotope[j])
+	}) {
+		return false
+	}
+	if (r.MolecularWeight == nil) != (other.MolecularWeight == nil) || r.MolecularWeight != nil && !r.MolecularWeight.EqualsDeep(*other.MolecularWeight) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Representation), len(other.Representation), func(i, j int) bool {
+		return r.Representation[i].EqualsDeep(other.Representation[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationStructure) EqualsShallow(other SubstanceSpecificationStructure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.MolecularFormula == nil) != (other.MolecularFormula == nil) || r.MolecularFormula != nil && !equivalentString(*r.MolecularFormula, *other.MolecularFormula) {
+		return false
+	}
+	if (r.MolecularFormulaByMoiety == nil) != (other.MolecularFormulaByMoiety == nil) || r.MolecularFormulaByMoiety != nil && !equivalentString(*r.MolecularFormulaByMoiety, *other.MolecularFormulaByMoiety) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationStructure as FHIR XML
+func (r SubstanceSpecificationStructure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "stereochemistry", r.Stereochemistry); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "opticalActivity", r.OpticalActivity); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "molecularFormula", r.MolecularFormula, r.MolecularFormulaElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "molecularFormulaByMoiety", r.MolecularFormulaByMoiety, r.MolecularFormulaByMoietyElement); err != nil {
+		return err
+	}
+	for _, v := range r.Isotope {
+		if err := encodeXMLElement(e, "isotope", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "molecularWeight", r.MolecularWeight); err != nil {
+		return err
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Representation {
+		if err := encodeXMLElement(e, "representation", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationStructure from FHIR XML
+func (r *SubstanceSpecificationStructure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "stereochemistry":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Stereochemistry = &v
+			case "opticalActivity":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OpticalActivity = &v
+			case "molecularFormula":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MolecularFormula = &v
+				}
+				r.MolecularFormulaElement = element
+			case "molecularFormulaByMoiety":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MolecularFormulaByMoiety = &v
+				}
+				r.MolecularFormulaByMoietyElement = element
+			case "isotope":
+				var v SubstanceSpecificationStructureIsotope
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Isotope = append(r.Isotope, v)
+			case "molecularWeight":
+				var v SubstanceSpecificationStructureIsotopeMolecularWeight
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MolecularWeight = &v
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			case "representation":
+				var v SubstanceSpecificationStructureRepresentation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Representation = append(r.Representation, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationStructure as properties to the RDF node n
+func (r SubstanceSpecificationStructure) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.structure.stereochemistry", -1, r.Stereochemistry)
+	n.element("SubstanceSpecification.structure.opticalActivity", -1, r.OpticalActivity)
+	n.primitive("SubstanceSpecification.structure.molecularFormula", -1, r.MolecularFormula, r.MolecularFormulaElement, "string")
+	n.primitive("SubstanceSpecification.structure.molecularFormulaByMoiety", -1, r.MolecularFormulaByMoiety, r.MolecularFormulaByMoietyElement, "string")
+	for i, v := range r.Isotope {
+		n.element("SubstanceSpecification.structure.isotope", i, v)
+	}
+	n.element("SubstanceSpecification.structure.molecularWeight", -1, r.MolecularWeight)
+	for i, v := range r.Source {
+		n.element("SubstanceSpecification.structure.source", i, v)
+	}
+	for i, v := range r.Representation {
+		n.element("SubstanceSpecification.structure.representation", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationStructure as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationStructure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationStructure from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationStructure) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationStructure as protobuf message SubstanceSpecificationStructure of fhir.proto
+func (r SubstanceSpecificationStructure) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationStructure to the encoded message
+func (r SubstanceSpecificationStructure) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Stereochemistry != nil {
+		e.message(4, *r.Stereochemistry)
+	}
+	if r.OpticalActivity != nil {
+		e.message(5, *r.OpticalActivity)
+	}
+	if r.MolecularFormula != nil {
+		e.string(6, *r.MolecularFormula)
+	}
+	if r.MolecularFormulaByMoiety != nil {
+		e.string(7, *r.MolecularFormulaByMoiety)
+	}
+	for _, v := range r.Isotope {
+		e.message(8, v)
+	}
+	if r.MolecularWeight != nil {
+		e.message(9, *r.MolecularWeight)
+	}
+	for _, v := range r.Source {
+		e.message(10, v)
+	}
+	for _, v := range r.Representation {
+		e.message(11, v)
+	}
+	if r.MolecularFormulaElement != nil {
+		e.message(12, *r.MolecularFormulaElement)
+	}
+	if r.MolecularFormulaByMoietyElement != nil {
+		e.message(13, *r.MolecularFormulaByMoietyElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationStructure from protobuf message SubstanceSpecificationStructure of fhir.proto
+func (r *SubstanceSpecificationStructure) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationStructure{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Stereochemistry = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.OpticalActivity = &v
+		case 6:
+			v := d.string()
+			r.MolecularFormula = &v
+		case 7:
+			v := d.string()
+			r.MolecularFormulaByMoiety = &v
+		case 8:
+			var v SubstanceSpecificationStructureIsotope
+			d.message(&v)
+			r.Isotope = append(r.Isotope, v)
+		case 9:
+			var v SubstanceSpecificationStructureIsotopeMolecularWeight
+			d.message(&v)
+			r.MolecularWeight = &v
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		case 11:
+			var v SubstanceSpecificationStructureRepresentation
+			d.message(&v)
+			r.Representation = append(r.Representation, v)
+		case 12:
+			var v Element
+			d.message(&v)
+			r.MolecularFormulaElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.MolecularFormulaByMoietyElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationStructureIsotope as JSON into a byte slice
+func (r SubstanceSpecificationStructureIsotope) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationStructureIsotope as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationStructureIsotope) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		r.Name.appendJSON(e)
+	}
+	if r.Substitution != nil {
+		e.key("substitution")
+		r.Substitution.appendJSON(e)
+	}
+	if r.HalfLife != nil {
+		e.key("halfLife")
+		r.HalfLife.appendJSON(e)
+	}
+	if r.MolecularWeight != nil {
+		e.key("molecularWeight")
+		r.MolecularWeight.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationStructureIsotope from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationStructureIsotope) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationStructureIsotope
+func (r *SubstanceSpecificationStructureIsotope) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "name":
+			if d.null() {
+				r.Name = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Name = &v
+			}
+		case "substitution":
+			if d.null() {
+				r.Substitution = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Substitution = &v
+			}
+		case "halfLife":
+			if d.null() {
+				r.HalfLife = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.HalfLife = &v
+			}
+		case "molecularWeight":
+			if d.null() {
+				r.MolecularWeight = nil
+			} else {
+				var v SubstanceSpecificationStructureIsotopeMolecularWeight
+				v.decodeJSON(d)
+				r.MolecularWeight = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationStructureIsotope which shares no memory with the original
+func (r SubstanceSpecificationStructureIsotope) DeepCopy() SubstanceSpecificationStructureIsotope {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Name != nil {
+		v := r.Name.DeepCopy()
+		out.Name = &v
+	}
+	if r.Substitution != nil {
+		v := r.Substitution.DeepCopy()
+		out.Substitution = &v
+	}
+	if r.HalfLife != nil {
+		v := r.HalfLife.DeepCopy()
+		out.HalfLife = &v
+	}
+	if r.MolecularWeight != nil {
+		v := r.MolecularWeight.DeepCopy()
+		out.MolecularWeight = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationStructureIsotope) Equal(other SubstanceSpecificationStructureIsotope) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !r.Name.Equal(*other.Name) {
+		return false
+	}
+	if (r.Substitution == nil) != (other.Substitution == nil) || r.Substitution != nil && !r.Substitution.Equal(*other.Substitution) {
+		return false
+	}
+	if (r.HalfLife == nil) != (other.HalfLife == nil) || r.HalfLife != nil && !r.HalfLife.Equal(*other.HalfLife) {
+		return false
+	}
+	if (r.MolecularWeight == nil) != (other.MolecularWeight == nil) || r.MolecularWeight != nil && !r.MolecularWeight.Equal(*other.MolecularWeight) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationStructureIsotope) EqualsDeep(other SubstanceSpecificationStructureIsotope) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !r.Name.EqualsDeep(*other.Name) {
+		return false
+	}
+	if (r.Substitution == nil) != (other.Substitution == nil) || r.Substitution != nil && !r.Substitution.EqualsDeep(*other.Substitution) {
+		return false
+	}
+	if (r.HalfLife == nil) != (other.HalfLife == nil) || r.HalfLife != nil && !r.HalfLife.EqualsDeep(*other.HalfLife) {
+		return false
+	}
+	if (r.MolecularWeight == nil) != (other.MolecularWeight == nil) || r.MolecularWeight != nil && !r.MolecularWeight.EqualsDeep(*other.MolecularWeight) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationStructureIsotope) EqualsShallow(other SubstanceSpecificationStructureIsotope) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationStructureIsotope as FHIR XML
+func (r SubstanceSpecificationStructureIsotope) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "name", r.Name); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "substitution", r.Substitution); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "halfLife", r.HalfLife); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "molecularWeight", r.MolecularWeight); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationStructureIsotope from FHIR XML
+func (r *SubstanceSpecificationStructureIsotope) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "name":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Name = &v
+			case "substitution":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Substitution = &v
+			case "halfLife":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.HalfLife = &v
+			case "molecularWeight":
+				var v SubstanceSpecificationStructureIsotopeMolecularWeight
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.MolecularWeight = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationStructureIsotope as properties to the RDF node n
+func (r SubstanceSpecificationStructureIsotope) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.structure.isotope.identifier", -1, r.Identifier)
+	n.element("SubstanceSpecification.structure.isotope.name", -1, r.Name)
+	n.element("SubstanceSpecification.structure.isotope.substitution", -1, r.Substitution)
+	n.element("SubstanceSpecification.structure.isotope.halfLife", -1, r.HalfLife)
+	n.element("SubstanceSpecification.structure.isotope.molecularWeight", -1, r.MolecularWeight)
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationStructureIsotope as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationStructureIsotope) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationStructureIsotope from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationStructureIsotope) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationStructureIsotope as protobuf message SubstanceSpecificationStructureIsotope of fhir.proto
+func (r SubstanceSpecificationStructureIsotope) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationStructureIsotope to the encoded message
+func (r SubstanceSpecificationStructureIsotope) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Identifier != nil {
+		e.message(4, *r.Identifier)
+	}
+	if r.Name != nil {
+		e.message(5, *r.Name)
+	}
+	if r.Substitution != nil {
+		e.message(6, *r.Substitution)
+	}
+	if r.HalfLife != nil {
+		e.message(7, *r.HalfLife)
+	}
+	if r.MolecularWeight != nil {
+		e.message(8, *r.MolecularWeight)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationStructureIsotope from protobuf message SubstanceSpecificationStructureIsotope of fhir.proto
+func (r *SubstanceSpecificationStructureIsotope) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationStructureIsotope{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Name = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Substitution = &v
+		case 7:
+			var v Quantity
+			d.message(&v)
+			r.HalfLife = &v
+		case 8:
+			var v SubstanceSpecificationStructureIsotopeMolecularWeight
+			d.message(&v)
+			r.MolecularWeight = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationStructureIsotopeMolecularWeight as JSON into a byte slice
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationStructureIsotopeMolecularWeight as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Method != nil {
+		e.key("method")
+		r.Method.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Amount != nil {
+		e.key("amount")
+		r.Amount.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationStructureIsotopeMolecularWeight from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationStructureIsotopeMolecularWeight) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationStructureIsotopeMolecularWeight
+func (r *SubstanceSpecificationStructureIsotopeMolecularWeight) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Method = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "amount":
+			if d.null() {
+				r.Amount = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Amount = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationStructureIsotopeMolecularWeight which shares no memory with the original
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) DeepCopy() SubstanceSpecificationStructureIsotopeMolecularWeight {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Method != nil {
+		v := r.Method.DeepCopy()
+		out.Method = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Amount != nil {
+		v := r.Amount.DeepCopy()
+		out.Amount = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) Equal(other SubstanceSpecificationStructureIsotopeMolecularWeight) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.Equal(*other.Method) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.Equal(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) EqualsDeep(other SubstanceSpecificationStructureIsotopeMolecularWeight) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && !r.Method.EqualsDeep(*other.Method) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Amount == nil) != (other.Amount == nil) || r.Amount != nil && !r.Amount.EqualsDeep(*other.Amount) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) EqualsShallow(other SubstanceSpecificationStructureIsotopeMolecularWeight) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationStructureIsotopeMolecularWeight as FHIR XML
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "method", r.Method); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amount", r.Amount); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationStructureIsotopeMolecularWeight from FHIR XML
+func (r *SubstanceSpecificationStructureIsotopeMolecularWeight) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "method":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Method = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "amount":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Amount = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationStructureIsotopeMolecularWeight as properties to the RDF node n
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.structure.isotope.molecularWeight.method", -1, r.Method)
+	n.element("SubstanceSpecification.structure.isotope.molecularWeight.type", -1, r.Type)
+	n.element("SubstanceSpecification.structure.isotope.molecularWeight.amount", -1, r.Amount)
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationStructureIsotopeMolecularWeight as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationStructureIsotopeMolecularWeight from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationStructureIsotopeMolecularWeight) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationStructureIsotopeMolecularWeight as protobuf message SubstanceSpecificationStructureIsotopeMolecularWeight of fhir.proto
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationStructureIsotopeMolecularWeight to the encoded message
+func (r SubstanceSpecificationStructureIsotopeMolecularWeight) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Method != nil {
+		e.message(4, *r.Method)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.Amount != nil {
+		e.message(6, *r.Amount)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationStructureIsotopeMolecularWeight from protobuf message SubstanceSpecificationStructureIsotopeMolecularWeight of fhir.proto
+func (r *SubstanceSpecificationStructureIsotopeMolecularWeight) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationStructureIsotopeMolecularWeight{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Method = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v Quantity
+			d.message(&v)
+			r.Amount = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationStructureRepresentation as JSON into a byte slice
+func (r SubstanceSpecificationStructureRepresentation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationStructureRepresentation as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationStructureRepresentation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Representation != nil {
+		e.key("representation")
+		e.string(*r.Representation)
+	}
+	if r.RepresentationElement != nil {
+		e.key("_representation")
+		r.RepresentationElement.appendJSON(e)
+	}
+	if r.Attachment != nil {
+		e.key("attachment")
+		r.Attachment.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationStructureRepresentation from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationStructureRepresentation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationStructureRepresentation
+func (r *SubstanceSpecificationStructureRepresentation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "representation":
+			if v, ok := d.string(); ok {
+				r.Representation = &v
+			} else {
+				r.Representation = nil
+			}
+		case "_representation":
+			r.RepresentationElement = d.element()
+		case "attachment":
+			if d.null() {
+				r.Attachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.Attachment = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationStructureRepresentation which shares no memory with the original
+func (r SubstanceSpecificationStructureRepresentation) DeepCopy() SubstanceSpecificationStructureRepresentation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Representation != nil {
+		v := *r.Representation
+		out.Representation = &v
+	}
+	if r.RepresentationElement != nil {
+		v := r.RepresentationElement.DeepCopy()
+		out.RepresentationElement = &v
+	}
+	if r.Attachment != nil {
+		v := r.Attachment.DeepCopy()
+		out.Attachment = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationStructureRepresentation) Equal(other SubstanceSpecificationStructureRepresentation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Representation == nil) != (other.Representation == nil) || r.Representation != nil && *r.Representation != *other.Representation {
+		return false
+	}
+	if (r.RepresentationElement == nil) != (other.RepresentationElement == nil) || r.RepresentationElement != nil && !r.RepresentationElement.Equal(*other.RepresentationElement) {
+		return false
+	}
+	if (r.Attachment == nil) != (other.Attachment == nil) || r.Attachment != nil && !r.Attachment.Equal(*other.Attachment) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationStructureRepresentation) EqualsDeep(other SubstanceSpecificationStructureRepresentation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Representation == nil) != (other.Representation == nil) || r.Representation != nil && !equivalentString(*r.Representation, *other.Representation) {
+		return false
+	}
+	if (r.RepresentationElement == nil) != (other.RepresentationElement == nil) || r.RepresentationElement != nil && !r.RepresentationElement.EqualsDeep(*other.RepresentationElement) {
+		return false
+	}
+	if (r.Attachment == nil) != (other.Attachment == nil) || r.Attachment != nil && !r.Attachment.EqualsDeep(*other.Attachment) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationStructureRepresentation) EqualsShallow(other SubstanceSpecificationStructureRepresentation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Representation == nil) != (other.Representation == nil) || r.Representation != nil && !equivalentString(*r.Representation, *other.Representation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationStructureRepresentation as FHIR XML
+func (r SubstanceSpecificationStructureRepresentation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "representation", r.Representation, r.RepresentationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "attachment", r.Attachment); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationStructureRepresentation from FHIR XML
+func (r *SubstanceSpecificationStructureRepresentation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "representation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Representation = &v
+				}
+				r.RepresentationElement = element
+			case "attachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Attachment = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationStructureRepresentation as properties to the RDF node n
+func (r SubstanceSpecificationStructureRepresentation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.structure.representation.type", -1, r.Type)
+	n.primitive("SubstanceSpecification.structure.representation.representation", -1, r.Representation, r.RepresentationElement, "string")
+	n.element("SubstanceSpecification.structure.representation.attachment", -1, r.Attachment)
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationStructureRepresentation as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationStructureRepresentation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationStructureRepresentation from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationStructureRepresentation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationStructureRepresentation as protobuf message SubstanceSpecificationStructureRepresentation of fhir.proto
+func (r SubstanceSpecificationStructureRepresentation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationStructureRepresentation to the encoded message
+func (r SubstanceSpecificationStructureRepresentation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Representation != nil {
+		e.string(5, *r.Representation)
+	}
+	if r.Attachment != nil {
+		e.message(6, *r.Attachment)
+	}
+	if r.RepresentationElement != nil {
+		e.message(7, *r.RepresentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationStructureRepresentation from protobuf message SubstanceSpecificationStructureRepresentation of fhir.proto
+func (r *SubstanceSpecificationStructureRepresentation) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationStructureRepresentation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			v := d.string()
+			r.Representation = &v
+		case 6:
+			var v Attachment
+			d.message(&v)
+			r.Attachment = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.RepresentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationCode as JSON into a byte slice
+func (r SubstanceSpecificationCode) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationCode as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationCode) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		r.Status.appendJSON(e)
+	}
+	if r.StatusDate != nil {
+		e.key("statusDate")
+		e.string(*r.StatusDate)
+	}
+	if r.StatusDateElement != nil {
+		e.key("_statusDate")
+		r.StatusDateElement.appendJSON(e)
+	}
+	if r.Comment != nil {
+		e.key("comment")
+		e.string(*r.Comment)
+	}
+	if r.CommentElement != nil {
+		e.key("_comment")
+		r.CommentElement.appendJSON(e)
+	}
+	if len(r.Source) > 0 {
+		e.key("source")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationCode from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationCode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationCode
+func (r *SubstanceSpecificationCode) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Status = &v
+			}
+		case "statusDate":
+			if v, ok := d.string(); ok {
+				r.StatusDate = &v
+			} else {
+				r.StatusDate = nil
+			}
+		case "_statusDate":
+			r.StatusDateElement = d.element()
+		case "comment":
+			if v, ok := d.string(); ok {
+				r.Comment = &v
+			} else {
+				r.Comment = nil
+			}
+		case "_comment":
+			r.CommentElement = d.element()
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationCode which shares no memory with the original
+func (r SubstanceSpecificationCode) DeepCopy() SubstanceSpecificationCode {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Status != nil {
+		v := r.Status.DeepCopy()
+		out.Status = &v
+	}
+	if r.StatusDate != nil {
+		v := *r.StatusDate
+		out.StatusDate = &v
+	}
+	if r.StatusDateElement != nil {
+		v := r.StatusDateElement.DeepCopy()
+		out.StatusDateElement = &v
+	}
+	if r.Comment != nil {
+		v := *r.Comment
+		out.Comment = &v
+	}
+	if r.CommentElement != nil {
+		v := r.CommentElement.DeepCopy()
+		out.CommentElement = &v
+	}
+	if r.Source != nil {
+		out.Source = make([]Reference, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationCode) Equal(other SubstanceSpecificationCode) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.Equal(*other.Status) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && *r.StatusDate != *other.StatusDate {
+		return false
+	}
+	if (r.StatusDateElement == nil) != (other.StatusDateElement == nil) || r.StatusDateElement != nil && !r.StatusDateElement.Equal(*other.StatusDateElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && *r.Comment != *other.Comment {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.Equal(*other.CommentElement) {
+		return false
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationCode) EqualsDeep(other SubstanceSpecificationCode) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.EqualsDeep(*other.Status) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && !equivalentString(*r.StatusDate, *other.StatusDate) {
+		return false
+	}
+	if (r.StatusDateElement == nil) != (other.StatusDateElement == nil) || r.StatusDateElement != nil && !r.StatusDateElement.EqualsDeep(*other.StatusDateElement) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	if (r.CommentElement == nil) != (other.CommentElement == nil) || r.CommentElement != nil && !r.CommentElement.EqualsDeep(*other.CommentElement) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationCode) EqualsShallow(other SubstanceSpecificationCode) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && !equivalentString(*r.StatusDate, *other.StatusDate) {
+		return false
+	}
+	if (r.Comment == nil) != (other.Comment == nil) || r.Comment != nil && !equivalentString(*r.Comment, *other.Comment) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationCode as FHIR XML
+func (r SubstanceSpecificationCode) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "status", r.Status); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "statusDate", r.StatusDate, r.StatusDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "comment", r.Comment, r.CommentElement); err != nil {
+		return err
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationCode from FHIR XML
+func (r *SubstanceSpecificationCode) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "status":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Status = &v
+			case "statusDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.StatusDate = &v
+				}
+				r.StatusDateElement = element
+			case "comment":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Comment = &v
+				}
+				r.CommentElement = element
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationCode as properties to the RDF node n
+func (r SubstanceSpecificationCode) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.code.code", -1, r.Code)
+	n.element("SubstanceSpecification.code.status", -1, r.Status)
+	n.primitive("SubstanceSpecification.code.statusDate", -1, r.StatusDate, r.StatusDateElement, "dateTime")
+	n.primitive("SubstanceSpecification.code.comment", -1, r.Comment, r.CommentElement, "string")
+	for i, v := range r.Source {
+		n.element("SubstanceSpecification.code.source", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationCode as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationCode) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationCode from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationCode) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationCode as protobuf message SubstanceSpecificationCode of fhir.proto
+func (r SubstanceSpecificationCode) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationCode to the encoded message
+func (r SubstanceSpecificationCode) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != nil {
+		e.message(4, *r.Code)
+	}
+	if r.Status != nil {
+		e.message(5, *r.Status)
+	}
+	if r.StatusDate != nil {
+		e.string(6, *r.StatusDate)
+	}
+	if r.Comment != nil {
+		e.string(7, *r.Comment)
+	}
+	for _, v := range r.Source {
+		e.message(8, v)
+	}
+	if r.StatusDateElement != nil {
+		e.message(9, *r.StatusDateElement)
+	}
+	if r.CommentElement != nil {
+		e.message(10, *r.CommentElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationCode from protobuf message SubstanceSpecificationCode of fhir.proto
+func (r *SubstanceSpecificationCode) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationCode{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Status = &v
+		case 6:
+			v := d.string()
+			r.StatusDate = &v
+		case 7:
+			v := d.string()
+			r.Comment = &v
+		case 8:
+			var v Reference
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		case 9:
+			var v Element
+			d.message(&v)
+			r.StatusDateElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.CommentElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationName as JSON into a byte slice
+func (r SubstanceSpecificationName) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationName as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationName) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		r.Status.appendJSON(e)
+	}
+	if r.Preferred != nil {
+		e.key("preferred")
+		e.bool(*r.Preferred)
+	}
+	if r.PreferredElement != nil {
+		e.key("_preferred")
+		r.PreferredElement.appendJSON(e)
+	}
+	if len(r.Language) > 0 {
+		e.key("language")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Language {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Domain) > 0 {
+		e.key("domain")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Domain {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Synonym) > 0 {
+		e.key("synonym")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Synonym {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Translation) > 0 {
+		e.key("translation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Translation {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Official) > 0 {
+		e.key("official")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Official {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Source) > 0 {
+		e.key("source")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationName from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationName) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationName
+func (r *SubstanceSpecificationName) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Status = &v
+			}
+		case "preferred":
+			if v, ok := d.bool(); ok {
+				r.Preferred = &v
+			} else {
+				r.Preferred = nil
+			}
+		case "_preferred":
+			r.PreferredElement = d.element()
+		case "language":
+			a := d.array()
+			r.Language = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Language = append(r.Language, v)
+			}
+			if r.Language == nil && !a.isNull {
+				r.Language = []CodeableConcept{}
+			}
+		case "domain":
+			a := d.array()
+			r.Domain = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Domain = append(r.Domain, v)
+			}
+			if r.Domain == nil && !a.isNull {
+				r.Domain = []CodeableConcept{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "synonym":
+			a := d.array()
+			r.Synonym = nil
+			for a.next() {
+				var v SubstanceSpecificationName
+				v.decodeJSON(d)
+				r.Synonym = append(r.Synonym, v)
+			}
+			if r.Synonym == nil && !a.isNull {
+				r.Synonym = []SubstanceSpecificationName{}
+			}
+		case "translation":
+			a := d.array()
+			r.Translation = nil
+			for a.next() {
+				var v SubstanceSpecificationName
+				v.decodeJSON(d)
+				r.Translation = append(r.Translation, v)
+			}
+			if r.Translation == nil && !a.isNull {
+				r.Translation = []SubstanceSpecificationName{}
+			}
+		case "official":
+			a := d.array()
+			r.Official = nil
+			for a.next() {
+				var v SubstanceSpecificationNameOfficial
+				v.decodeJSON(d)
+				r.Official = append(r.Official, v)
+			}
+			if r.Official == nil && !a.isNull {
+				r.Official = []SubstanceSpecificationNameOfficial{}
+			}
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationName which shares no memory with the original
+func (r SubstanceSpecificationName) DeepCopy() SubstanceSpecificationName {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Status != nil {
+		v := r.Status.DeepCopy()
+		out.Status = &v
+	}
+	if r.Preferred != nil {
+		v := *r.Preferred
+		out.Preferred = &v
+	}
+	if r.PreferredElement != nil {
+		v := r.PreferredElement.DeepCopy()
+		out.PreferredElement = &v
+	}
+	if r.Language != nil {
+		out.Language = make([]CodeableConcept, len(r.Language))
+		for i := range r.Language {
+			out.Language[i] = r.Language[i].DeepCopy()
+		}
+	}
+	if r.Domain != nil {
+		out.Domain = make([]CodeableConcept, len(r.Domain))
+		for i := range r.Domain {
+			out.Domain[i] = r.Domain[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Synonym != nil {
+		out.Synonym = make([]SubstanceSpecificationName, len(r.Synonym))
+		for i := range r.Synonym {
+			out.Synonym[i] = r.Synonym[i].DeepCopy()
+		}
+	}
+	if r.Translation != nil {
+		out.Translation = make([]SubstanceSpecificationName, len(r.Translation))
+		for i := range r.Translation {
+			out.Translation[i] = r.Translation[i].DeepCopy()
+		}
+	}
+	if r.Official != nil {
+		out.Official = make([]SubstanceSpecificationNameOfficial, len(r.Official))
+		for i := range r.Official {
+			out.Official[i] = r.Official[i].DeepCopy()
+		}
+	}
+	if r.Source != nil {
+		out.Source = make([]Reference, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationName) Equal(other SubstanceSpecificationName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.Equal(*other.Status) {
+		return false
+	}
+	if (r.Preferred == nil) != (other.Preferred == nil) || r.Preferred != nil && *r.Preferred != *other.Preferred {
+		return false
+	}
+	if (r.PreferredElement == nil) != (other.PreferredElement == nil) || r.PreferredElement != nil && !r.PreferredElement.Equal(*other.PreferredElement) {
+		return false
+	}
+	if len(r.Language) != len(other.Language) {
+		return false
+	}
+	for i := range r.Language {
+		if !r.Language[i].Equal(other.Language[i]) {
+			return false
+		}
+	}
+	if len(r.Domain) != len(other.Domain) {
+		return false
+	}
+	for i := range r.Domain {
+		if !r.Domain[i].Equal(other.Domain[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if len(r.Synonym) != len(other.Synonym) {
+		return false
+	}
+	for i := range r.Synonym {
+		if !r.Synonym[i].Equal(other.Synonym[i]) {
+			return false
+		}
+	}
+	if len(r.Translation) != len(other.Translation) {
+		return false
+	}
+	for i := range r.Translation {
+		if !r.Translation[i].Equal(other.Translation[i]) {
+			return false
+		}
+	}
+	if len(r.Official) != len(other.Official) {
+		return false
+	}
+	for i := range r.Official {
+		if !r.Official[i].Equal(other.Official[i]) {
+			return false
+		}
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationName) EqualsDeep(other SubstanceSpecificationName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.EqualsDeep(*other.Status) {
+		return false
+	}
+	if (r.Preferred == nil) != (other.Preferred == nil) || r.Preferred != nil && *r.Preferred != *other.Preferred {
+		return false
+	}
+	if (r.PreferredElement == nil) != (other.PreferredElement == nil) || r.PreferredElement != nil && !r.PreferredElement.EqualsDeep(*other.PreferredElement) {
+		return false
+	}
+	if !equivalentList(len(r.Language), len(other.Language), func(i, j int) bool {
+		return r.Language[i].EqualsDeep(other.Language[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Domain), len(other.Domain), func(i, j int) bool {
+		return r.Domain[i].EqualsDeep(other.Domain[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Synonym), len(other.Synonym), func(i, j int) bool {
+		return r.Synonym[i].EqualsDeep(other.Synonym[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Translation), len(other.Translation), func(i, j int) bool {
+		return r.Translation[i].EqualsDeep(other.Translation[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Official), len(other.Official), func(i, j int) bool {
+		return r.Official[i].EqualsDeep(other.Official[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationName) EqualsShallow(other SubstanceSpecificationName) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Preferred == nil) != (other.Preferred == nil) || r.Preferred != nil && *r.Preferred != *other.Preferred {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationName as FHIR XML
+func (r SubstanceSpecificationName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "status", r.Status); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "preferred", r.Preferred, r.PreferredElement); err != nil {
+		return err
+	}
+	for _, v := range r.Language {
+		if err := encodeXMLElement(e, "language", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Domain {
+		if err := encodeXMLElement(e, "domain", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Synonym {
+		if err := encodeXMLElement(e, "synonym", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Translation {
+		if err := encodeXMLElement(e, "translation", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Official {
+		if err := encodeXMLElement(e, "official", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationName from FHIR XML
+func (r *SubstanceSpecificationName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "status":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Status = &v
+			case "preferred":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Preferred = &v
+				}
+				r.PreferredElement = element
+			case "language":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Language = append(r.Language, v)
+			case "domain":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Domain = append(r.Domain, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "synonym":
+				var v SubstanceSpecificationName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Synonym = append(r.Synonym, v)
+			case "translation":
+				var v SubstanceSpecificationName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Translation = append(r.Translation, v)
+			case "official":
+				var v SubstanceSpecificationNameOfficial
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Official = append(r.Official, v)
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationName as properties to the RDF node n
+func (r SubstanceSpecificationName) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("SubstanceSpecification.name.name", -1, r.Name, r.NameElement, "string")
+	n.element("SubstanceSpecification.name.type", -1, r.Type)
+	n.element("SubstanceSpecification.name.status", -1, r.Status)
+	n.primitive("SubstanceSpecification.name.preferred", -1, r.Preferred, r.PreferredElement, "boolean")
+	for i, v := range r.Language {
+		n.element("SubstanceSpecification.name.language", i, v)
+	}
+	for i, v := range r.Domain {
+		n.element("SubstanceSpecification.name.domain", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("SubstanceSpecification.name.jurisdiction", i, v)
+	}
+	for i, v := range r.Synonym {
+		n.element("SubstanceSpecification.name.synonym", i, v)
+	}
+	for i, v := range r.Translation {
+		n.element("SubstanceSpecification.name.translation", i, v)
+	}
+	for i, v := range r.Official {
+		n.element("SubstanceSpecification.name.official", i, v)
+	}
+	for i, v := range r.Source {
+		n.element("SubstanceSpecification.name.source", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationName as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationName from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationName) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationName as protobuf message SubstanceSpecificationName of fhir.proto
+func (r SubstanceSpecificationName) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationName to the encoded message
+func (r SubstanceSpecificationName) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Type != nil {
+		e.message(5, *r.Type)
+	}
+	if r.Status != nil {
+		e.message(6, *r.Status)
+	}
+	if r.Preferred != nil {
+		e.varint(7, protoBool(*r.Preferred))
+	}
+	for _, v := range r.Language {
+		e.message(8, v)
+	}
+	for _, v := range r.Domain {
+		e.message(9, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(10, v)
+	}
+	for _, v := range r.Synonym {
+		e.message(11, v)
+	}
+	for _, v := range r.Translation {
+		e.message(12, v)
+	}
+	for _, v := range r.Official {
+		e.message(13, v)
+	}
+	for _, v := range r.Source {
+		e.message(14, v)
+	}
+	if r.NameElement != nil {
+		e.message(15, *r.NameElement)
+	}
+	if r.PreferredElement != nil {
+		e.message(16, *r.PreferredElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationName from protobuf message SubstanceSpecificationName of fhir.proto
+func (r *SubstanceSpecificationName) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationName{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Status = &v
+		case 7:
+			v := d.bool()
+			r.Preferred = &v
+		case 8:
+			var v CodeableConcept
+			d.message(&v)
+			r.Language = append(r.Language, v)
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.Domain = append(r.Domain, v)
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 11:
+			var v SubstanceSpecificationName
+			d.message(&v)
+			r.Synonym = append(r.Synonym, v)
+		case 12:
+			var v SubstanceSpecificationName
+			d.message(&v)
+			r.Translation = append(r.Translation, v)
+		case 13:
+			var v SubstanceSpecificationNameOfficial
+			d.message(&v)
+			r.Official = append(r.Official, v)
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		case 15:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.PreferredElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationNameOfficial as JSON into a byte slice
+func (r SubstanceSpecificationNameOfficial) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationNameOfficial as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationNameOfficial) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Authority != nil {
+		e.key("authority")
+		r.Authority.appendJSON(e)
+	}
+	if r.Status != nil {
+		e.key("status")
+		r.Status.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationNameOfficial from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationNameOfficial) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationNameOfficial
+func (r *SubstanceSpecificationNameOfficial) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "authority":
+			if d.null() {
+				r.Authority = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Authority = &v
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Status = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationNameOfficial which shares no memory with the original
+func (r SubstanceSpecificationNameOfficial) DeepCopy() SubstanceSpecificationNameOfficial {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Authority != nil {
+		v := r.Authority.DeepCopy()
+		out.Authority = &v
+	}
+	if r.Status != nil {
+		v := r.Status.DeepCopy()
+		out.Status = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationNameOfficial) Equal(other SubstanceSpecificationNameOfficial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !r.Authority.Equal(*other.Authority) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.Equal(*other.Status) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationNameOfficial) EqualsDeep(other SubstanceSpecificationNameOfficial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Authority == nil) != (other.Authority == nil) || r.Authority != nil && !r.Authority.EqualsDeep(*other.Authority) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && !r.Status.EqualsDeep(*other.Status) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationNameOfficial) EqualsShallow(other SubstanceSpecificationNameOfficial) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationNameOfficial as FHIR XML
+func (r SubstanceSpecificationNameOfficial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "authority", r.Authority); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "status", r.Status); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationNameOfficial from FHIR XML
+func (r *SubstanceSpecificationNameOfficial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "authority":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Authority = &v
+			case "status":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Status = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationNameOfficial as properties to the RDF node n
+func (r SubstanceSpecificationNameOfficial) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.name.official.authority", -1, r.Authority)
+	n.element("SubstanceSpecification.name.official.status", -1, r.Status)
+	n.primitive("SubstanceSpecification.name.official.date", -1, r.Date, r.DateElement, "dateTime")
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationNameOfficial as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationNameOfficial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationNameOfficial from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationNameOfficial) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationNameOfficial as protobuf message SubstanceSpecificationNameOfficial of fhir.proto
+func (r SubstanceSpecificationNameOfficial) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationNameOfficial to the encoded message
+func (r SubstanceSpecificationNameOfficial) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Authority != nil {
+		e.message(4, *r.Authority)
+	}
+	if r.Status != nil {
+		e.message(5, *r.Status)
+	}
+	if r.Date != nil {
+		e.string(6, *r.Date)
+	}
+	if r.DateElement != nil {
+		e.message(7, *r.DateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationNameOfficial from protobuf message SubstanceSpecificationNameOfficial of fhir.proto
+func (r *SubstanceSpecificationNameOfficial) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationNameOfficial{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Authority = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Status = &v
+		case 6:
+			v := d.string()
+			r.Date = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SubstanceSpecificationRelationship as JSON into a byte slice
+func (r SubstanceSpecificationRelationship) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SubstanceSpecificationRelationship as JSON object with the members in the order of the fields
+func (r SubstanceSpecificationRelationship) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.SubstanceReference != nil {
+		e.key("substanceReference")
+		r.SubstanceReference.appendJSON(e)
+	}
+	if r.SubstanceCodeableConcept != nil {
+		e.key("substanceCodeableConcept")
+		r.SubstanceCodeableConcept.appendJSON(e)
+	}
+	if r.Relationship != nil {
+		e.key("relationship")
+		r.Relationship.appendJSON(e)
+	}
+	if r.IsDefining != nil {
+		e.key("isDefining")
+		e.bool(*r.IsDefining)
+	}
+	if r.IsDefiningElement != nil {
+		e.key("_isDefining")
+		r.IsDefiningElement.appendJSON(e)
+	}
+	if r.AmountQuantity != nil {
+		e.key("amountQuantity")
+		r.AmountQuantity.appendJSON(e)
+	}
+	if r.AmountRange != nil {
+		e.key("amountRange")
+		r.AmountRange.appendJSON(e)
+	}
+	if r.AmountRatio != nil {
+		e.key("amountRatio")
+		r.AmountRatio.appendJSON(e)
+	}
+	if r.AmountString != nil {
+		e.key("amountString")
+		e.string(*r.AmountString)
+	}
+	if r.AmountStringElement != nil {
+		e.key("_amountString")
+		r.AmountStringElement.appendJSON(e)
+	}
+	if r.AmountRatioLowLimit != nil {
+		e.key("amountRatioLowLimit")
+		r.AmountRatioLowLimit.appendJSON(e)
+	}
+	if r.AmountType != nil {
+		e.key("amountType")
+		r.AmountType.appendJSON(e)
+	}
+	if len(r.Source) > 0 {
+		e.key("source")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Source {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SubstanceSpecificationRelationship from JSON. Unknown members are ignored.
+func (r *SubstanceSpecificationRelationship) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SubstanceSpecificationRelationship
+func (r *SubstanceSpecificationRelationship) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "substanceReference":
+			if d.null() {
+				r.SubstanceReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.SubstanceReference = &v
+			}
+		case "substanceCodeableConcept":
+			if d.null() {
+				r.SubstanceCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.SubstanceCodeableConcept = &v
+			}
+		case "relationship":
+			if d.null() {
+				r.Relationship = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Relationship = &v
+			}
+		case "isDefining":
+			if v, ok := d.bool(); ok {
+				r.IsDefining = &v
+			} else {
+				r.IsDefining = nil
+			}
+		case "_isDefining":
+			r.IsDefiningElement = d.element()
+		case "amountQuantity":
+			if d.null() {
+				r.AmountQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.AmountQuantity = &v
+			}
+		case "amountRange":
+			if d.null() {
+				r.AmountRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.AmountRange = &v
+			}
+		case "amountRatio":
+			if d.null() {
+				r.AmountRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.AmountRatio = &v
+			}
+		case "amountString":
+			if v, ok := d.string(); ok {
+				r.AmountString = &v
+			} else {
+				r.AmountString = nil
+			}
+		case "_amountString":
+			r.AmountStringElement = d.element()
+		case "amountRatioLowLimit":
+			if d.null() {
+				r.AmountRatioLowLimit = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.AmountRatioLowLimit = &v
+			}
+		case "amountType":
+			if d.null() {
+				r.AmountType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.AmountType = &v
+			}
+		case "source":
+			a := d.array()
+			r.Source = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Source = append(r.Source, v)
+			}
+			if r.Source == nil && !a.isNull {
+				r.Source = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SubstanceSpecificationRelationship which shares no memory with the original
+func (r SubstanceSpecificationRelationship) DeepCopy() SubstanceSpecificationRelationship {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.SubstanceReference != nil {
+		v := r.SubstanceReference.DeepCopy()
+		out.SubstanceReference = &v
+	}
+	if r.SubstanceCodeableConcept != nil {
+		v := r.SubstanceCodeableConcept.DeepCopy()
+		out.SubstanceCodeableConcept = &v
+	}
+	if r.Relationship != nil {
+		v := r.Relationship.DeepCopy()
+		out.Relationship = &v
+	}
+	if r.IsDefining != nil {
+		v := *r.IsDefining
+		out.IsDefining = &v
+	}
+	if r.IsDefiningElement != nil {
+		v := r.IsDefiningElement.DeepCopy()
+		out.IsDefiningElement = &v
+	}
+	if r.AmountQuantity != nil {
+		v := r.AmountQuantity.DeepCopy()
+		out.AmountQuantity = &v
+	}
+	if r.AmountRange != nil {
+		v := r.AmountRange.DeepCopy()
+		out.AmountRange = &v
+	}
+	if r.AmountRatio != nil {
+		v := r.AmountRatio.DeepCopy()
+		out.AmountRatio = &v
+	}
+	if r.AmountString != nil {
+		v := *r.AmountString
+		out.AmountString = &v
+	}
+	if r.AmountStringElement != nil {
+		v := r.AmountStringElement.DeepCopy()
+		out.AmountStringElement = &v
+	}
+	if r.AmountRatioLowLimit != nil {
+		v := r.AmountRatioLowLimit.DeepCopy()
+		out.AmountRatioLowLimit = &v
+	}
+	if r.AmountType != nil {
+		v := r.AmountType.DeepCopy()
+		out.AmountType = &v
+	}
+	if r.Source != nil {
+		out.Source = make([]Reference, len(r.Source))
+		for i := range r.Source {
+			out.Source[i] = r.Source[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SubstanceSpecificationRelationship) Equal(other SubstanceSpecificationRelationship) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.SubstanceReference == nil) != (other.SubstanceReference == nil) || r.SubstanceReference != nil && !r.SubstanceReference.Equal(*other.SubstanceReference) {
+		return false
+	}
+	if (r.SubstanceCodeableConcept == nil) != (other.SubstanceCodeableConcept == nil) || r.SubstanceCodeableConcept != nil && !r.SubstanceCodeableConcept.Equal(*other.SubstanceCodeableConcept) {
+		return false
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.Equal(*other.Relationship) {
+		return false
+	}
+	if (r.IsDefining == nil) != (other.IsDefining == nil) || r.IsDefining != nil && *r.IsDefining != *other.IsDefining {
+		return false
+	}
+	if (r.IsDefiningElement == nil) != (other.IsDefiningElement == nil) || r.IsDefiningElement != nil && !r.IsDefiningElement.Equal(*other.IsDefiningElement) {
+		return false
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.Equal(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountRange == nil) != (other.AmountRange == nil) || r.AmountRange != nil && !r.AmountRange.Equal(*other.AmountRange) {
+		return false
+	}
+	if (r.AmountRatio == nil) != (other.AmountRatio == nil) || r.AmountRatio != nil && !r.AmountRatio.Equal(*other.AmountRatio) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && *r.AmountString != *other.AmountString {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.Equal(*other.AmountStringElement) {
+		return false
+	}
+	if (r.AmountRatioLowLimit == nil) != (other.AmountRatioLowLimit == nil) || r.AmountRatioLowLimit != nil && !r.AmountRatioLowLimit.Equal(*other.AmountRatioLowLimit) {
+		return false
+	}
+	if (r.AmountType == nil) != (other.AmountType == nil) || r.AmountType != nil && !r.AmountType.Equal(*other.AmountType) {
+		return false
+	}
+	if len(r.Source) != len(other.Source) {
+		return false
+	}
+	for i := range r.Source {
+		if !r.Source[i].Equal(other.Source[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SubstanceSpecificationRelationship) EqualsDeep(other SubstanceSpecificationRelationship) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.SubstanceReference == nil) != (other.SubstanceReference == nil) || r.SubstanceReference != nil && !r.SubstanceReference.EqualsDeep(*other.SubstanceReference) {
+		return false
+	}
+	if (r.SubstanceCodeableConcept == nil) != (other.SubstanceCodeableConcept == nil) || r.SubstanceCodeableConcept != nil && !r.SubstanceCodeableConcept.EqualsDeep(*other.SubstanceCodeableConcept) {
+		return false
+	}
+	if (r.Relationship == nil) != (other.Relationship == nil) || r.Relationship != nil && !r.Relationship.EqualsDeep(*other.Relationship) {
+		return false
+	}
+	if (r.IsDefining == nil) != (other.IsDefining == nil) || r.IsDefining != nil && *r.IsDefining != *other.IsDefining {
+		return false
+	}
+	if (r.IsDefiningElement == nil) != (other.IsDefiningElement == nil) || r.IsDefiningElement != nil && !r.IsDefiningElement.EqualsDeep(*other.IsDefiningElement) {
+		return false
+	}
+	if (r.AmountQuantity == nil) != (other.AmountQuantity == nil) || r.AmountQuantity != nil && !r.AmountQuantity.EqualsDeep(*other.AmountQuantity) {
+		return false
+	}
+	if (r.AmountRange == nil) != (other.AmountRange == nil) || r.AmountRange != nil && !r.AmountRange.EqualsDeep(*other.AmountRange) {
+		return false
+	}
+	if (r.AmountRatio == nil) != (other.AmountRatio == nil) || r.AmountRatio != nil && !r.AmountRatio.EqualsDeep(*other.AmountRatio) {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	if (r.AmountStringElement == nil) != (other.AmountStringElement == nil) || r.AmountStringElement != nil && !r.AmountStringElement.EqualsDeep(*other.AmountStringElement) {
+		return false
+	}
+	if (r.AmountRatioLowLimit == nil) != (other.AmountRatioLowLimit == nil) || r.AmountRatioLowLimit != nil && !r.AmountRatioLowLimit.EqualsDeep(*other.AmountRatioLowLimit) {
+		return false
+	}
+	if (r.AmountType == nil) != (other.AmountType == nil) || r.AmountType != nil && !r.AmountType.EqualsDeep(*other.AmountType) {
+		return false
+	}
+	if !equivalentList(len(r.Source), len(other.Source), func(i, j int) bool {
+		return r.Source[i].EqualsDeep(other.Source[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SubstanceSpecificationRelationship) EqualsShallow(other SubstanceSpecificationRelationship) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IsDefining == nil) != (other.IsDefining == nil) || r.IsDefining != nil && *r.IsDefining != *other.IsDefining {
+		return false
+	}
+	if (r.AmountString == nil) != (other.AmountString == nil) || r.AmountString != nil && !equivalentString(*r.AmountString, *other.AmountString) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SubstanceSpecificationRelationship as FHIR XML
+func (r SubstanceSpecificationRelationship) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "substanceReference", r.SubstanceReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "substanceCodeableConcept", r.SubstanceCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "relationship", r.Relationship); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "isDefining", r.IsDefining, r.IsDefiningElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountQuantity", r.AmountQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountRange", r.AmountRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountRatio", r.AmountRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "amountString", r.AmountString, r.AmountStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountRatioLowLimit", r.AmountRatioLowLimit); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "amountType", r.AmountType); err != nil {
+		return err
+	}
+	for _, v := range r.Source {
+		if err := encodeXMLElement(e, "source", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SubstanceSpecificationRelationship from FHIR XML
+func (r *SubstanceSpecificationRelationship) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "substanceReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubstanceReference = &v
+			case "substanceCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SubstanceCodeableConcept = &v
+			case "relationship":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Relationship = &v
+			case "isDefining":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsDefining = &v
+				}
+				r.IsDefiningElement = element
+			case "amountQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountQuantity = &v
+			case "amountRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountRange = &v
+			case "amountRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountRatio = &v
+			case "amountString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AmountString = &v
+				}
+				r.AmountStringElement = element
+			case "amountRatioLowLimit":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountRatioLowLimit = &v
+			case "amountType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AmountType = &v
+			case "source":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Source = append(r.Source, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SubstanceSpecificationRelationship as properties to the RDF node n
+func (r SubstanceSpecificationRelationship) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SubstanceSpecification.relationship.substanceReference", -1, r.SubstanceReference)
+	n.element("SubstanceSpecification.relationship.substanceCodeableConcept", -1, r.SubstanceCodeableConcept)
+	n.element("SubstanceSpecification.relationship.relationship", -1, r.Relationship)
+	n.primitive("SubstanceSpecification.relationship.isDefining", -1, r.IsDefining, r.IsDefiningElement, "boolean")
+	n.element("SubstanceSpecification.relationship.amountQuantity", -1, r.AmountQuantity)
+	n.element("SubstanceSpecification.relationship.amountRange", -1, r.AmountRange)
+	n.element("SubstanceSpecification.relationship.amountRatio", -1, r.AmountRatio)
+	n.primitive("SubstanceSpecification.relationship.amountString", -1, r.AmountString, r.AmountStringElement, "string")
+	n.element("SubstanceSpecification.relationship.amountRatioLowLimit", -1, r.AmountRatioLowLimit)
+	n.element("SubstanceSpecification.relationship.amountType", -1, r.AmountType)
+	for i, v := range r.Source {
+		n.element("SubstanceSpecification.relationship.source", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SubstanceSpecificationRelationship as BSON document with the structure of its FHIR JSON
+func (r SubstanceSpecificationRelationship) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SubstanceSpecificationRelationship from a BSON document with the structure of its FHIR JSON
+func (r *SubstanceSpecificationRelationship) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SubstanceSpecificationRelationship as protobuf message SubstanceSpecificationRelationship of fhir.proto
+func (r SubstanceSpecificationRelationship) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SubstanceSpecificationRelationship to the encoded message
+func (r SubstanceSpecificationRelationship) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.SubstanceReference != nil {
+		e.message(4, *r.SubstanceReference)
+	}
+	if r.SubstanceCodeableConcept != nil {
+		e.message(5, *r.SubstanceCodeableConcept)
+	}
+	if r.Relationship != nil {
+		e.message(6, *r.Relationship)
+	}
+	if r.IsDefining != nil {
+		e.varint(7, protoBool(*r.IsDefining))
+	}
+	if r.AmountQuantity != nil {
+		e.message(8, *r.AmountQuantity)
+	}
+	if r.AmountRange != nil {
+		e.message(9, *r.AmountRange)
+	}
+	if r.AmountRatio != nil {
+		e.message(10, *r.AmountRatio)
+	}
+	if r.AmountString != nil {
+		e.string(11, *r.AmountString)
+	}
+	if r.AmountRatioLowLimit != nil {
+		e.message(12, *r.AmountRatioLowLimit)
+	}
+	if r.AmountType != nil {
+		e.message(13, *r.AmountType)
+	}
+	for _, v := range r.Source {
+		e.message(14, v)
+	}
+	if r.IsDefiningElement != nil {
+		e.message(15, *r.IsDefiningElement)
+	}
+	if r.AmountStringElement != nil {
+		e.message(16, *r.AmountStringElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SubstanceSpecificationRelationship from protobuf message SubstanceSpecificationRelationship of fhir.proto
+func (r *SubstanceSpecificationRelationship) UnmarshalProto(b []byte) error {
+	*r = SubstanceSpecificationRelationship{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.SubstanceReference = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.SubstanceCodeableConcept = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.Relationship = &v
+		case 7:
+			v := d.bool()
+			r.IsDefining = &v
+		case 8:
+			var v Quantity
+			d.message(&v)
+			r.AmountQuantity = &v
+		case 9:
+			var v Range
+			d.message(&v)
+			r.AmountRange = &v
+		case 10:
+			var v Ratio
+			d.message(&v)
+			r.AmountRatio = &v
+		case 11:
+			v := d.string()
+			r.AmountString = &v
+		case 12:
+			var v Ratio
+			d.message(&v)
+			r.AmountRatioLowLimit = &v
+		case 13:
+			var v CodeableConcept
+			d.message(&v)
+			r.AmountType = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.Source = append(r.Source, v)
+		case 15:
+			var v Element
+			d.message(&v)
+			r.IsDefiningElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.AmountStringElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSubstanceSpecification unmarshals a SubstanceSpecification.
 func UnmarshalSubstanceSpecification(b []byte) (SubstanceSpecification, error) {
 	var substanceSpecification SubstanceSpecification
-	if err := json.Unmarshal(b, &substanceSpecification); err != nil {
+	if err := substanceSpecification.UnmarshalJSON(b); err != nil {
 		return substanceSpecification, err
 	}
 	return substanceSpecification, nil
@@ -14,33 +14,42 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // SupplyDelivery is documented here http://hl7.org/fhir/StructureDefinition/SupplyDelivery
 type SupplyDelivery struct {
-	Id                 *string                     `bson:"id,omitempty" json:"id,omitempty"`
-	Meta               *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules      *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language           *string                     `bson:"language,omitempty" json:"language,omitempty"`
-	Text               *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
-	Extension          []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension  []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier         []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	BasedOn            []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	PartOf             []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status             *SupplyDeliveryStatus       `bson:"status,omitempty" json:"status,omitempty"`
-	Patient            *Reference                  `bson:"patient,omitempty" json:"patient,omitempty"`
-	Type               *CodeableConcept            `bson:"type,omitempty" json:"type,omitempty"`
-	SuppliedItem       *SupplyDeliverySuppliedItem `bson:"suppliedItem,omitempty" json:"suppliedItem,omitempty"`
-	OccurrenceDateTime *string                     `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *Period                     `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming   *Timing                     `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
-	Supplier           *Reference                  `bson:"supplier,omitempty" json:"supplier,omitempty"`
-	Destination        *Reference                  `bson:"destination,omitempty" json:"destination,omitempty"`
-	Receiver           []Reference                 `bson:"receiver,omitempty" json:"receiver,omitempty"`
+	Id                        *string                     `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                 *Element                    `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                      *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules             *string                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement      *Element                    `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                  *string                     `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement           *Element                    `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                      *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                 []json.RawMessage           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                 []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension         []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                []Identifier                `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	BasedOn                   []Reference                 `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	PartOf                    []Reference                 `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                    *SupplyDeliveryStatus       `bson:"status,omitempty" json:"status,omitempty"`
+	StatusElement             *Element                    `bson:"_status,omitempty" json:"_status,omitempty"`
+	Patient                   *Reference                  `bson:"patient,omitempty" json:"patient,omitempty"`
+	Type                      *CodeableConcept            `bson:"type,omitempty" json:"type,omitempty"`
+	SuppliedItem              *SupplyDeliverySuppliedItem `bson:"suppliedItem,omitempty" json:"suppliedItem,omitempty"`
+	OccurrenceDateTime        *string                     `bson:"occurrenceDateTime,omitempty" json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *Element                    `bson:"_occurrenceDateTime,omitempty" json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *Period                     `bson:"occurrencePeriod,omitempty" json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing                     `bson:"occurrenceTiming,omitempty" json:"occurrenceTiming,omitempty"`
+	Supplier                  *Reference                  `bson:"supplier,omitempty" json:"supplier,omitempty"`
+	Destination               *Reference                  `bson:"destination,omitempty" json:"destination,omitempty"`
+	Receiver                  []Reference                 `bson:"receiver,omitempty" json:"receiver,omitempty"`
 }
 type SupplyDeliverySuppliedItem struct {
 	Id                  *string          `bson:"id,omitempty" json:"id,omitempty"`
@@ -50,23 +59,1721 @@ type SupplyDeliverySuppliedItem struct {
 	ItemCodeableConcept *CodeableConcept `bson:"itemCodeableConcept,omitempty" json:"itemCodeableConcept,omitempty"`
 	ItemReference       *Reference       `bson:"itemReference,omitempty" json:"itemReference,omitempty"`
 }
-type OtherSupplyDelivery SupplyDelivery
+
+// SupplyDeliverySuppliedItemItem is implemented by the types allowed for SupplyDelivery.suppliedItem.item[x]
+type SupplyDeliverySuppliedItemItem interface {
+	isSupplyDeliverySuppliedItemItem()
+}
+
+func (CodeableConcept) isSupplyDeliverySuppliedItemItem() {}
+func (Reference) isSupplyDeliverySuppliedItemItem()       {}
+
+// Item returns the value of SupplyDelivery.suppliedItem.item[x] together with its FHIR type code or nil if no value is set
+func (r SupplyDeliverySuppliedItem) Item() (SupplyDeliverySuppliedItemItem, string) {
+	if r.ItemCodeableConcept != nil {
+		return *r.ItemCodeableConcept, "CodeableConcept"
+	}
+	if r.ItemReference != nil {
+		return *r.ItemReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetItem sets the value of SupplyDelivery.suppliedItem.item[x], given as value or pointer, and clears all other types
+func (r *SupplyDeliverySuppliedItem) SetItem(value SupplyDeliverySuppliedItemItem) {
+	r.ItemCodeableConcept = nil
+	r.ItemReference = nil
+	switch v := value.(type) {
+	case CodeableConcept:
+		r.ItemCodeableConcept = &v
+	case *CodeableConcept:
+		r.ItemCodeableConcept = v
+	case Reference:
+		r.ItemReference = &v
+	case *Reference:
+		r.ItemReference = v
+	}
+}
+
+// SupplyDeliveryOccurrence is implemented by the types allowed for SupplyDelivery.occurrence[x]
+type SupplyDeliveryOccurrence interface {
+	isSupplyDeliveryOccurrence()
+}
+
+func (DateTime) isSupplyDeliveryOccurrence() {}
+func (Period) isSupplyDeliveryOccurrence()   {}
+func (Timing) isSupplyDeliveryOccurrence()   {}
+
+// Occurrence returns the value of SupplyDelivery.occurrence[x] together with its FHIR type code or nil if no value is set
+func (r SupplyDelivery) Occurrence() (SupplyDeliveryOccurrence, string) {
+	if r.OccurrenceDateTime != nil {
+		return DateTime(*r.OccurrenceDateTime), "dateTime"
+	}
+	if r.OccurrencePeriod != nil {
+		return *r.OccurrencePeriod, "Period"
+	}
+	if r.OccurrenceTiming != nil {
+		return *r.OccurrenceTiming, "Timing"
+	}
+	return nil, ""
+}
+
+// SetOccurrence sets the value of SupplyDelivery.occurrence[x], given as value or pointer, and clears all other types
+func (r *SupplyDelivery) SetOccurrence(value SupplyDeliveryOccurrence) {
+	r.OccurrenceDateTime = nil
+	r.OccurrencePeriod = nil
+	r.OccurrenceTiming = nil
+	switch v := value.(type) {
+	case DateTime:
+		primitive := string(v)
+		r.OccurrenceDateTime = &primitive
+	case *DateTime:
+		r.OccurrenceDateTime = (*string)(v)
+	case Period:
+		r.OccurrencePeriod = &v
+	case *Period:
+		r.OccurrencePeriod = v
+	case Timing:
+		r.OccurrenceTiming = &v
+	case *Timing:
+		r.OccurrenceTiming = v
+	}
+}
 
 // MarshalJSON marshals the given SupplyDelivery as JSON into a byte slice
 func (r SupplyDelivery) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherSupplyDelivery
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherSupplyDelivery: OtherSupplyDelivery(r),
-		ResourceType:        "SupplyDelivery",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SupplyDelivery as JSON object with the members in the order of the fields
+func (r SupplyDelivery) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"SupplyDelivery\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Status != nil {
+		e.key("status")
+		e.string(r.Status.Code())
+	}
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Patient != nil {
+		e.key("patient")
+		r.Patient.appendJSON(e)
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.SuppliedItem != nil {
+		e.key("suppliedItem")
+		r.SuppliedItem.appendJSON(e)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.key("occurrenceDateTime")
+		e.string(*r.OccurrenceDateTime)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.key("_occurrenceDateTime")
+		r.OccurrenceDateTimeElement.appendJSON(e)
+	}
+	if r.OccurrencePeriod != nil {
+		e.key("occurrencePeriod")
+		r.OccurrencePeriod.appendJSON(e)
+	}
+	if r.OccurrenceTiming != nil {
+		e.key("occurrenceTiming")
+		r.OccurrenceTiming.appendJSON(e)
+	}
+	if r.Supplier != nil {
+		e.key("supplier")
+		r.Supplier.appendJSON(e)
+	}
+	if r.Destination != nil {
+		e.key("destination")
+		r.Destination.appendJSON(e)
+	}
+	if len(r.Receiver) > 0 {
+		e.key("receiver")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Receiver {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SupplyDelivery from JSON. Unknown members are ignored.
+func (r *SupplyDelivery) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SupplyDelivery
+func (r *SupplyDelivery) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			if d.null() {
+				r.Status = nil
+			} else {
+				var v SupplyDeliveryStatus
+				d.unmarshal(&v)
+				r.Status = &v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "patient":
+			if d.null() {
+				r.Patient = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Patient = &v
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "suppliedItem":
+			if d.null() {
+				r.SuppliedItem = nil
+			} else {
+				var v SupplyDeliverySuppliedItem
+				v.decodeJSON(d)
+				r.SuppliedItem = &v
+			}
+		case "occurrenceDateTime":
+			if v, ok := d.string(); ok {
+				r.OccurrenceDateTime = &v
+			} else {
+				r.OccurrenceDateTime = nil
+			}
+		case "_occurrenceDateTime":
+			r.OccurrenceDateTimeElement = d.element()
+		case "occurrencePeriod":
+			if d.null() {
+				r.OccurrencePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.OccurrencePeriod = &v
+			}
+		case "occurrenceTiming":
+			if d.null() {
+				r.OccurrenceTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.OccurrenceTiming = &v
+			}
+		case "supplier":
+			if d.null() {
+				r.Supplier = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Supplier = &v
+			}
+		case "destination":
+			if d.null() {
+				r.Destination = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Destination = &v
+			}
+		case "receiver":
+			a := d.array()
+			r.Receiver = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Receiver = append(r.Receiver, v)
+			}
+			if r.Receiver == nil && !a.isNull {
+				r.Receiver = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SupplyDelivery which shares no memory with the original
+func (r SupplyDelivery) DeepCopy() SupplyDelivery {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.Status != nil {
+		v := *r.Status
+		out.Status = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Patient != nil {
+		v := r.Patient.DeepCopy()
+		out.Patient = &v
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.SuppliedItem != nil {
+		v := r.SuppliedItem.DeepCopy()
+		out.SuppliedItem = &v
+	}
+	if r.OccurrenceDateTime != nil {
+		v := *r.OccurrenceDateTime
+		out.OccurrenceDateTime = &v
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		v := r.OccurrenceDateTimeElement.DeepCopy()
+		out.OccurrenceDateTimeElement = &v
+	}
+	if r.OccurrencePeriod != nil {
+		v := r.OccurrencePeriod.DeepCopy()
+		out.OccurrencePeriod = &v
+	}
+	if r.OccurrenceTiming != nil {
+		v := r.OccurrenceTiming.DeepCopy()
+		out.OccurrenceTiming = &v
+	}
+	if r.Supplier != nil {
+		v := r.Supplier.DeepCopy()
+		out.Supplier = &v
+	}
+	if r.Destination != nil {
+		v := r.Destination.DeepCopy()
+		out.Destination = &v
+	}
+	if r.Receiver != nil {
+		out.Receiver = make([]Reference, len(r.Receiver))
+		for i := range r.Receiver {
+			out.Receiver[i] = r.Receiver[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SupplyDelivery) Equal(other SupplyDelivery) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.Equal(*other.Patient) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.SuppliedItem == nil) != (other.SuppliedItem == nil) || r.SuppliedItem != nil && !r.SuppliedItem.Equal(*other.SuppliedItem) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && *r.OccurrenceDateTime != *other.OccurrenceDateTime {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.Equal(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.Equal(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.Equal(*other.OccurrenceTiming) {
+		return false
+	}
+	if (r.Supplier == nil) != (other.Supplier == nil) || r.Supplier != nil && !r.Supplier.Equal(*other.Supplier) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && !r.Destination.Equal(*other.Destination) {
+		return false
+	}
+	if len(r.Receiver) != len(other.Receiver) {
+		return false
+	}
+	for i := range r.Receiver {
+		if !r.Receiver[i].Equal(other.Receiver[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SupplyDelivery) EqualsDeep(other SupplyDelivery) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Patient == nil) != (other.Patient == nil) || r.Patient != nil && !r.Patient.EqualsDeep(*other.Patient) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.SuppliedItem == nil) != (other.SuppliedItem == nil) || r.SuppliedItem != nil && !r.SuppliedItem.EqualsDeep(*other.SuppliedItem) {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	if (r.OccurrenceDateTimeElement == nil) != (other.OccurrenceDateTimeElement == nil) || r.OccurrenceDateTimeElement != nil && !r.OccurrenceDateTimeElement.EqualsDeep(*other.OccurrenceDateTimeElement) {
+		return false
+	}
+	if (r.OccurrencePeriod == nil) != (other.OccurrencePeriod == nil) || r.OccurrencePeriod != nil && !r.OccurrencePeriod.EqualsDeep(*other.OccurrencePeriod) {
+		return false
+	}
+	if (r.OccurrenceTiming == nil) != (other.OccurrenceTiming == nil) || r.OccurrenceTiming != nil && !r.OccurrenceTiming.EqualsDeep(*other.OccurrenceTiming) {
+		return false
+	}
+	if (r.Supplier == nil) != (other.Supplier == nil) || r.Supplier != nil && !r.Supplier.EqualsDeep(*other.Supplier) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && !r.Destination.EqualsDeep(*other.Destination) {
+		return false
+	}
+	if !equivalentList(len(r.Receiver), len(other.Receiver), func(i, j int) bool {
+		return r.Receiver[i].EqualsDeep(other.Receiver[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SupplyDelivery) EqualsShallow(other SupplyDelivery) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Status == nil) != (other.Status == nil) || r.Status != nil && *r.Status != *other.Status {
+		return false
+	}
+	if (r.OccurrenceDateTime == nil) != (other.OccurrenceDateTime == nil) || r.OccurrenceDateTime != nil && !equivalentString(*r.OccurrenceDateTime, *other.OccurrenceDateTime) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SupplyDelivery as FHIR XML
+func (r SupplyDelivery) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "SupplyDelivery",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "suppliedItem", r.SuppliedItem); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "occurrenceDateTime", r.OccurrenceDateTime, r.OccurrenceDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrencePeriod", r.OccurrencePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "occurrenceTiming", r.OccurrenceTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "supplier", r.Supplier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "destination", r.Destination); err != nil {
+		return err
+	}
+	for _, v := range r.Receiver {
+		if err := encodeXMLElement(e, "receiver", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SupplyDelivery from FHIR XML
+func (r *SupplyDelivery) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v SupplyDeliveryStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = &v
+				}
+				r.StatusElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "suppliedItem":
+				var v SupplyDeliverySuppliedItem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SuppliedItem = &v
+			case "occurrenceDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.OccurrenceDateTime = &v
+				}
+				r.OccurrenceDateTimeElement = element
+			case "occurrencePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrencePeriod = &v
+			case "occurrenceTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OccurrenceTiming = &v
+			case "supplier":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Supplier = &v
+			case "destination":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Destination = &v
+			case "receiver":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Receiver = append(r.Receiver, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SupplyDelivery as properties to the RDF node n
+func (r SupplyDelivery) turtle(n *turtleNode) {
+	n.resource("SupplyDelivery", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("SupplyDelivery.identifier", i, v)
+	}
+	for i, v := range r.BasedOn {
+		n.element("SupplyDelivery.basedOn", i, v)
+	}
+	for i, v := range r.PartOf {
+		n.element("SupplyDelivery.partOf", i, v)
+	}
+	n.primitive("SupplyDelivery.status", -1, r.Status, r.StatusElement, "code")
+	n.element("SupplyDelivery.patient", -1, r.Patient)
+	n.element("SupplyDelivery.type", -1, r.Type)
+	n.element("SupplyDelivery.suppliedItem", -1, r.SuppliedItem)
+	n.primitive("SupplyDelivery.occurrenceDateTime", -1, r.OccurrenceDateTime, r.OccurrenceDateTimeElement, "dateTime")
+	n.element("SupplyDelivery.occurrencePeriod", -1, r.OccurrencePeriod)
+	n.element("SupplyDelivery.occurrenceTiming", -1, r.OccurrenceTiming)
+	n.element("SupplyDelivery.supplier", -1, r.Supplier)
+	n.element("SupplyDelivery.destination", -1, r.Destination)
+	for i, v := range r.Receiver {
+		n.element("SupplyDelivery.receiver", i, v)
+	}
+}
+
+// MarshalBSON marshals the given SupplyDelivery as BSON document with the structure of its FHIR JSON
+func (r SupplyDelivery) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SupplyDelivery from a BSON document with the structure of its FHIR JSON
+func (r *SupplyDelivery) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SupplyDelivery as protobuf message SupplyDelivery of fhir.proto
+func (r SupplyDelivery) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SupplyDelivery to the encoded message
+func (r SupplyDelivery) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	for _, v := range r.BasedOn {
+		e.message(10, v)
+	}
+	for _, v := range r.PartOf {
+		e.message(11, v)
+	}
+	if r.Status != nil {
+		e.varint(12, r.Status.protoNumber())
+	}
+	if r.Patient != nil {
+		e.message(13, *r.Patient)
+	}
+	if r.Type != nil {
+		e.message(14, *r.Type)
+	}
+	if r.SuppliedItem != nil {
+		e.message(15, *r.SuppliedItem)
+	}
+	if r.OccurrenceDateTime != nil {
+		e.string(16, *r.OccurrenceDateTime)
+	}
+	if r.OccurrencePeriod != nil {
+		e.message(17, *r.OccurrencePeriod)
+	}
+	if r.OccurrenceTiming != nil {
+		e.message(18, *r.OccurrenceTiming)
+	}
+	if r.Supplier != nil {
+		e.message(19, *r.Supplier)
+	}
+	if r.Destination != nil {
+		e.message(20, *r.Destination)
+	}
+	for _, v := range r.Receiver {
+		e.message(21, v)
+	}
+	if r.IdElement != nil {
+		e.message(22, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(23, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(24, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(25, *r.StatusElement)
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		e.message(26, *r.OccurrenceDateTimeElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given SupplyDelivery from protobuf message SupplyDelivery of fhir.proto
+func (r *SupplyDelivery) UnmarshalProto(b []byte) error {
+	*r = SupplyDelivery{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 11:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 12:
+			var v SupplyDeliveryStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = &v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Patient = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = &v
+		case 15:
+			var v SupplyDeliverySuppliedItem
+			d.message(&v)
+			r.SuppliedItem = &v
+		case 16:
+			v := d.string()
+			r.OccurrenceDateTime = &v
+		case 17:
+			var v Period
+			d.message(&v)
+			r.OccurrencePeriod = &v
+		case 18:
+			var v Timing
+			d.message(&v)
+			r.OccurrenceTiming = &v
+		case 19:
+			var v Reference
+			d.message(&v)
+			r.Supplier = &v
+		case 20:
+			var v Reference
+			d.message(&v)
+			r.Destination = &v
+		case 21:
+			var v Reference
+			d.message(&v)
+			r.Receiver = append(r.Receiver, v)
+		case 22:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.OccurrenceDateTimeElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given SupplyDeliverySuppliedItem as JSON into a byte slice
+func (r SupplyDeliverySuppliedItem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the SupplyDeliverySuppliedItem as JSON object with the members in the order of the fields
+func (r SupplyDeliverySuppliedItem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Quantity != nil {
+		e.key("quantity")
+		r.Quantity.appendJSON(e)
+	}
+	if r.ItemCodeableConcept != nil {
+		e.key("itemCodeableConcept")
+		r.ItemCodeableConcept.appendJSON(e)
+	}
+	if r.ItemReference != nil {
+		e.key("itemReference")
+		r.ItemReference.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given SupplyDeliverySuppliedItem from JSON. Unknown members are ignored.
+func (r *SupplyDeliverySuppliedItem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the SupplyDeliverySuppliedItem
+func (r *SupplyDeliverySuppliedItem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "quantity":
+			if d.null() {
+				r.Quantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Quantity = &v
+			}
+		case "itemCodeableConcept":
+			if d.null() {
+				r.ItemCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ItemCodeableConcept = &v
+			}
+		case "itemReference":
+			if d.null() {
+				r.ItemReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ItemReference = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the SupplyDeliverySuppliedItem which shares no memory with the original
+func (r SupplyDeliverySuppliedItem) DeepCopy() SupplyDeliverySuppliedItem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Quantity != nil {
+		v := r.Quantity.DeepCopy()
+		out.Quantity = &v
+	}
+	if r.ItemCodeableConcept != nil {
+		v := r.ItemCodeableConcept.DeepCopy()
+		out.ItemCodeableConcept = &v
+	}
+	if r.ItemReference != nil {
+		v := r.ItemReference.DeepCopy()
+		out.ItemReference = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r SupplyDeliverySuppliedItem) Equal(other SupplyDeliverySuppliedItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.Equal(*other.Quantity) {
+		return false
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.Equal(*other.ItemCodeableConcept) {
+		return false
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.Equal(*other.ItemReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r SupplyDeliverySuppliedItem) EqualsDeep(other SupplyDeliverySuppliedItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Quantity == nil) != (other.Quantity == nil) || r.Quantity != nil && !r.Quantity.EqualsDeep(*other.Quantity) {
+		return false
+	}
+	if (r.ItemCodeableConcept == nil) != (other.ItemCodeableConcept == nil) || r.ItemCodeableConcept != nil && !r.ItemCodeableConcept.EqualsDeep(*other.ItemCodeableConcept) {
+		return false
+	}
+	if (r.ItemReference == nil) != (other.ItemReference == nil) || r.ItemReference != nil && !r.ItemReference.EqualsDeep(*other.ItemReference) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r SupplyDeliverySuppliedItem) EqualsShallow(other SupplyDeliverySuppliedItem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given SupplyDeliverySuppliedItem as FHIR XML
+func (r SupplyDeliverySuppliedItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "quantity", r.Quantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "itemCodeableConcept", r.ItemCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "itemReference", r.ItemReference); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given SupplyDeliverySuppliedItem from FHIR XML
+func (r *SupplyDeliverySuppliedItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "quantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Quantity = &v
+			case "itemCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemCodeableConcept = &v
+			case "itemReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ItemReference = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the SupplyDeliverySuppliedItem as properties to the RDF node n
+func (r SupplyDeliverySuppliedItem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("SupplyDelivery.suppliedItem.quantity", -1, r.Quantity)
+	n.element("SupplyDelivery.suppliedItem.itemCodeableConcept", -1, r.ItemCodeableConcept)
+	n.element("SupplyDelivery.suppliedItem.itemReference", -1, r.ItemReference)
+}
+
+// MarshalBSON marshals the given SupplyDeliverySuppliedItem as BSON document with the structure of its FHIR JSON
+func (r SupplyDeliverySuppliedItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given SupplyDeliverySuppliedItem from a BSON document with the structure of its FHIR JSON
+func (r *SupplyDeliverySuppliedItem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given SupplyDeliverySuppliedItem as protobuf message SupplyDeliverySuppliedItem of fhir.proto
+func (r SupplyDeliverySuppliedItem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the SupplyDeliverySuppliedItem to the encoded message
+func (r SupplyDeliverySuppliedItem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Quantity != nil {
+		e.message(4, *r.Quantity)
+	}
+	if r.ItemCodeableConcept != nil {
+		e.message(5, *r.ItemCodeableConcept)
+	}
+	if r.ItemReference != nil {
+		e.message(6, *r.ItemReference)
+	}
+}
+
+// UnmarshalProto unmarshals the given SupplyDeliverySuppliedItem from protobuf message SupplyDeliverySuppliedItem of fhir.proto
+func (r *SupplyDeliverySuppliedItem) UnmarshalProto(b []byte) error {
+	*r = SupplyDeliverySuppliedItem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Quantity
+			d.message(&v)
+			r.Quantity = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.ItemCodeableConcept = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.ItemReference = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalSupplyDelivery unmarshals a SupplyDelivery.
 func UnmarshalSupplyDelivery(b []byte) (SupplyDelivery, error) {
 	var supplyDelivery SupplyDelivery
-	if err := json.Unmarshal(b, &supplyDelivery); err != nil {
+	if err := supplyDelivery.UnmarshalJSON(b); err != nil {
 		return supplyDelivery, err
 	}
 	return supplyDelivery, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code SupplyDeliveryStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SupplyDeliveryStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SupplyDeliveryStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "in-progress":
 		*code = SupplyDeliveryStatusInProgress
@@ -52,6 +56,12 @@ func (code *SupplyDeliveryStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SupplyDeliveryStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SupplyDeliveryStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SupplyDeliveryStatus) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code SupplyDeliveryStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SupplyDeliveryStatus) protoNumber() uint64 {
+	switch code {
+	case SupplyDeliveryStatusInProgress:
+		return 1
+	case SupplyDeliveryStatusCompleted:
+		return 2
+	case SupplyDeliveryStatusAbandoned:
+		return 3
+	case SupplyDeliveryStatusEnteredInError:
+		return 4
+	}
+	return 0
+}
+func (code *SupplyDeliveryStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SupplyDeliveryStatusInProgress
+	case 2:
+		*code = SupplyDeliveryStatusCompleted
+	case 3:
+		*code = SupplyDeliveryStatusAbandoned
+	case 4:
+		*code = SupplyDeliveryStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
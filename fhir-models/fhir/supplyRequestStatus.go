@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code SupplyRequestStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SupplyRequestStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SupplyRequestStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "draft":
 		*code = SupplyRequestStatusDraft
@@ -61,6 +65,12 @@ func (code *SupplyRequestStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SupplyRequestStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SupplyRequestStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SupplyRequestStatus) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code SupplyRequestStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SupplyRequestStatus) protoNumber() uint64 {
+	switch code {
+	case SupplyRequestStatusDraft:
+		return 1
+	case SupplyRequestStatusActive:
+		return 2
+	case SupplyRequestStatusSuspended:
+		return 3
+	case SupplyRequestStatusCancelled:
+		return 4
+	case SupplyRequestStatusCompleted:
+		return 5
+	case SupplyRequestStatusEnteredInError:
+		return 6
+	case SupplyRequestStatusUnknown:
+		return 7
+	}
+	return 0
+}
+func (code *SupplyRequestStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SupplyRequestStatusDraft
+	case 2:
+		*code = SupplyRequestStatusActive
+	case 3:
+		*code = SupplyRequestStatusSuspended
+	case 4:
+		*code = SupplyRequestStatusCancelled
+	case 5:
+		*code = SupplyRequestStatusCompleted
+	case 6:
+		*code = SupplyRequestStatusEnteredInError
+	case 7:
+		*code = SupplyRequestStatusUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -49,8 +48,13 @@ const (
 func (code SystemRestfulInteraction) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *SystemRestfulInteraction) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *SystemRestfulInteraction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "read":
 		*code = SystemRestfulInteractionRead
@@ -91,6 +95,12 @@ func (code *SystemRestfulInteraction) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code SystemRestfulInteraction) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *SystemRestfulInteraction) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code SystemRestfulInteraction) String() string {
 	return code.Code()
 }
@@ -211,3 +221,83 @@ func (code SystemRestfulInteraction) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code SystemRestfulInteraction) protoNumber() uint64 {
+	switch code {
+	case SystemRestfulInteractionRead:
+		return 1
+	case SystemRestfulInteractionVread:
+		return 2
+	case SystemRestfulInteractionUpdate:
+		return 3
+	case SystemRestfulInteractionPatch:
+		return 4
+	case SystemRestfulInteractionDelete:
+		return 5
+	case SystemRestfulInteractionHistory:
+		return 6
+	case SystemRestfulInteractionHistoryInstance:
+		return 7
+	case SystemRestfulInteractionHistoryType:
+		return 8
+	case SystemRestfulInteractionHistorySystem:
+		return 9
+	case SystemRestfulInteractionCreate:
+		return 10
+	case SystemRestfulInteractionSearch:
+		return 11
+	case SystemRestfulInteractionSearchType:
+		return 12
+	case SystemRestfulInteractionSearchSystem:
+		return 13
+	case SystemRestfulInteractionCapabilities:
+		return 14
+	case SystemRestfulInteractionTransaction:
+		return 15
+	case SystemRestfulInteractionBatch:
+		return 16
+	case SystemRestfulInteractionOperation:
+		return 17
+	}
+	return 0
+}
+func (code *SystemRestfulInteraction) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = SystemRestfulInteractionRead
+	case 2:
+		*code = SystemRestfulInteractionVread
+	case 3:
+		*code = SystemRestfulInteractionUpdate
+	case 4:
+		*code = SystemRestfulInteractionPatch
+	case 5:
+		*code = SystemRestfulInteractionDelete
+	case 6:
+		*code = SystemRestfulInteractionHistory
+	case 7:
+		*code = SystemRestfulInteractionHistoryInstance
+	case 8:
+		*code = SystemRestfulInteractionHistoryType
+	case 9:
+		*code = SystemRestfulInteractionHistorySystem
+	case 10:
+		*code = SystemRestfulInteractionCreate
+	case 11:
+		*code = SystemRestfulInteractionSearch
+	case 12:
+		*code = SystemRestfulInteractionSearchType
+	case 13:
+		*code = SystemRestfulInteractionSearchSystem
+	case 14:
+		*code = SystemRestfulInteractionCapabilities
+	case 15:
+		*code = SystemRestfulInteractionTransaction
+	case 16:
+		*code = SystemRestfulInteractionBatch
+	case 17:
+		*code = SystemRestfulInteractionOperation
+	default:
+		return false
+	}
+	return true
+}
@@ -14,189 +14,9379 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // Task is documented here http://hl7.org/fhir/StructureDefinition/Task
 type Task struct {
-	Id                    *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta                  *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules         *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language              *string           `bson:"language,omitempty" json:"language,omitempty"`
-	Text                  *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension             []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension     []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier            []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	InstantiatesCanonical *string           `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       *string           `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
-	BasedOn               []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
-	GroupIdentifier       *Identifier       `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
-	PartOf                []Reference       `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Status                TaskStatus        `bson:"status" json:"status"`
-	StatusReason          *CodeableConcept  `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
-	BusinessStatus        *CodeableConcept  `bson:"businessStatus,omitempty" json:"businessStatus,omitempty"`
-	Intent                string            `bson:"intent" json:"intent"`
-	Priority              *RequestPriority  `bson:"priority,omitempty" json:"priority,omitempty"`
-	Code                  *CodeableConcept  `bson:"code,omitempty" json:"code,omitempty"`
-	Description           *string           `bson:"description,omitempty" json:"description,omitempty"`
-	Focus                 *Reference        `bson:"focus,omitempty" json:"focus,omitempty"`
-	For                   *Reference        `bson:"for,omitempty" json:"for,omitempty"`
-	Encounter             *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	ExecutionPeriod       *Period           `bson:"executionPeriod,omitempty" json:"executionPeriod,omitempty"`
-	AuthoredOn            *string           `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
-	LastModified          *string           `bson:"lastModified,omitempty" json:"lastModified,omitempty"`
-	Requester             *Reference        `bson:"requester,omitempty" json:"requester,omitempty"`
-	PerformerType         []CodeableConcept `bson:"performerType,omitempty" json:"performerType,omitempty"`
-	Owner                 *Reference        `bson:"owner,omitempty" json:"owner,omitempty"`
-	Location              *Reference        `bson:"location,omitempty" json:"location,omitempty"`
-	ReasonCode            *CodeableConcept  `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
-	ReasonReference       *Reference        `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
-	Insurance             []Reference       `bson:"insurance,omitempty" json:"insurance,omitempty"`
-	Note                  []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
-	RelevantHistory       []Reference       `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
-	Restriction           *TaskRestriction  `bson:"restriction,omitempty" json:"restriction,omitempty"`
-	Input                 []TaskInput       `bson:"input,omitempty" json:"input,omitempty"`
-	Output                []TaskOutput      `bson:"output,omitempty" json:"output,omitempty"`
+	Id                           *string           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement                    *Element          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                         *Meta             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules                *string           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement         *Element          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language                     *string           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement              *Element          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                         *Narrative        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained                    []json.RawMessage `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension                    []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension            []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier                   []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	InstantiatesCanonical        *string           `bson:"instantiatesCanonical,omitempty" json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement *Element          `bson:"_instantiatesCanonical,omitempty" json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              *string           `bson:"instantiatesUri,omitempty" json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       *Element          `bson:"_instantiatesUri,omitempty" json:"_instantiatesUri,omitempty"`
+	BasedOn                      []Reference       `bson:"basedOn,omitempty" json:"basedOn,omitempty"`
+	GroupIdentifier              *Identifier       `bson:"groupIdentifier,omitempty" json:"groupIdentifier,omitempty"`
+	PartOf                       []Reference       `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Status                       TaskStatus        `bson:"status" json:"status"`
+	StatusElement                *Element          `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusReason                 *CodeableConcept  `bson:"statusReason,omitempty" json:"statusReason,omitempty"`
+	BusinessStatus               *CodeableConcept  `bson:"businessStatus,omitempty" json:"businessStatus,omitempty"`
+	Intent                       string            `bson:"intent" json:"intent"`
+	IntentElement                *Element          `bson:"_intent,omitempty" json:"_intent,omitempty"`
+	Priority                     *RequestPriority  `bson:"priority,omitempty" json:"priority,omitempty"`
+	PriorityElement              *Element          `bson:"_priority,omitempty" json:"_priority,omitempty"`
+	Code                         *CodeableConcept  `bson:"code,omitempty" json:"code,omitempty"`
+	Description                  *string           `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement           *Element          `bson:"_description,omitempty" json:"_description,omitempty"`
+	Focus                        *Reference        `bson:"focus,omitempty" json:"focus,omitempty"`
+	For                          *Reference        `bson:"for,omitempty" json:"for,omitempty"`
+	Encounter                    *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	ExecutionPeriod              *Period           `bson:"executionPeriod,omitempty" json:"executionPeriod,omitempty"`
+	AuthoredOn                   *string           `bson:"authoredOn,omitempty" json:"authoredOn,omitempty"`
+	AuthoredOnElement            *Element          `bson:"_authoredOn,omitempty" json:"_authoredOn,omitempty"`
+	LastModified                 *string           `bson:"lastModified,omitempty" json:"lastModified,omitempty"`
+	LastModifiedElement          *Element          `bson:"_lastModified,omitempty" json:"_lastModified,omitempty"`
+	Requester                    *Reference        `bson:"requester,omitempty" json:"requester,omitempty"`
+	PerformerType                []CodeableConcept `bson:"performerType,omitempty" json:"performerType,omitempty"`
+	Owner                        *Reference        `bson:"owner,omitempty" json:"owner,omitempty"`
+	Location                     *Reference        `bson:"location,omitempty" json:"location,omitempty"`
+	ReasonCode                   *CodeableConcept  `bson:"reasonCode,omitempty" json:"reasonCode,omitempty"`
+	ReasonReference              *Reference        `bson:"reasonReference,omitempty" json:"reasonReference,omitempty"`
+	Insurance                    []Reference       `bson:"insurance,omitempty" json:"insurance,omitempty"`
+	Note                         []Annotation      `bson:"note,omitempty" json:"note,omitempty"`
+	RelevantHistory              []Reference       `bson:"relevantHistory,omitempty" json:"relevantHistory,omitempty"`
+	Restriction                  *TaskRestriction  `bson:"restriction,omitempty" json:"restriction,omitempty"`
+	Input                        []TaskInput       `bson:"input,omitempty" json:"input,omitempty"`
+	Output                       []TaskOutput      `bson:"output,omitempty" json:"output,omitempty"`
 }
 type TaskRestriction struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Repetitions       *int        `bson:"repetitions,omitempty" json:"repetitions,omitempty"`
-	Period            *Period     `bson:"period,omitempty" json:"period,omitempty"`
-	Recipient         []Reference `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Repetitions        *int        `bson:"repetitions,omitempty" json:"repetitions,omitempty"`
+	RepetitionsElement *Element    `bson:"_repetitions,omitempty" json:"_repetitions,omitempty"`
+	Period             *Period     `bson:"period,omitempty" json:"period,omitempty"`
+	Recipient          []Reference `bson:"recipient,omitempty" json:"recipient,omitempty"`
 }
 type TaskInput struct {
-	Id                       *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension        []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type                     CodeableConcept     `bson:"type" json:"type"`
-	ValueBase64Binary        string              `bson:"valueBase64Binary" json:"valueBase64Binary"`
-	ValueBoolean             bool                `bson:"valueBoolean" json:"valueBoolean"`
-	ValueCanonical           string              `bson:"valueCanonical" json:"valueCanonical"`
-	ValueCode                string              `bson:"valueCode" json:"valueCode"`
-	ValueDate                string              `bson:"valueDate" json:"valueDate"`
-	ValueDateTime            string              `bson:"valueDateTime" json:"valueDateTime"`
-	ValueDecimal             json.Number         `bson:"valueDecimal" json:"valueDecimal"`
-	ValueId                  string              `bson:"valueId" json:"valueId"`
-	ValueInstant             string              `bson:"valueInstant" json:"valueInstant"`
-	ValueInteger             int                 `bson:"valueInteger" json:"valueInteger"`
-	ValueMarkdown            string              `bson:"valueMarkdown" json:"valueMarkdown"`
-	ValueOid                 string              `bson:"valueOid" json:"valueOid"`
-	ValuePositiveInt         int                 `bson:"valuePositiveInt" json:"valuePositiveInt"`
-	ValueString              string              `bson:"valueString" json:"valueString"`
-	ValueTime                string              `bson:"valueTime" json:"valueTime"`
-	ValueUnsignedInt         int                 `bson:"valueUnsignedInt" json:"valueUnsignedInt"`
-	ValueUri                 string              `bson:"valueUri" json:"valueUri"`
-	ValueUrl                 string              `bson:"valueUrl" json:"valueUrl"`
-	ValueUuid                string              `bson:"valueUuid" json:"valueUuid"`
-	ValueAddress             Address             `bson:"valueAddress" json:"valueAddress"`
-	ValueAge                 Age                 `bson:"valueAge" json:"valueAge"`
-	ValueAnnotation          Annotation          `bson:"valueAnnotation" json:"valueAnnotation"`
-	ValueAttachment          Attachment          `bson:"valueAttachment" json:"valueAttachment"`
-	ValueCodeableConcept     CodeableConcept     `bson:"valueCodeableConcept" json:"valueCodeableConcept"`
-	ValueCoding              Coding              `bson:"valueCoding" json:"valueCoding"`
-	ValueContactPoint        ContactPoint        `bson:"valueContactPoint" json:"valueContactPoint"`
-	ValueCount               Count               `bson:"valueCount" json:"valueCount"`
-	ValueDistance            Distance            `bson:"valueDistance" json:"valueDistance"`
-	ValueDuration            Duration            `bson:"valueDuration" json:"valueDuration"`
-	ValueHumanName           HumanName           `bson:"valueHumanName" json:"valueHumanName"`
-	ValueIdentifier          Identifier          `bson:"valueIdentifier" json:"valueIdentifier"`
-	ValueMoney               Money               `bson:"valueMoney" json:"valueMoney"`
-	ValuePeriod              Period              `bson:"valuePeriod" json:"valuePeriod"`
-	ValueQuantity            Quantity            `bson:"valueQuantity" json:"valueQuantity"`
-	ValueRange               Range               `bson:"valueRange" json:"valueRange"`
-	ValueRatio               Ratio               `bson:"valueRatio" json:"valueRatio"`
-	ValueReference           Reference           `bson:"valueReference" json:"valueReference"`
-	ValueSampledData         SampledData         `bson:"valueSampledData" json:"valueSampledData"`
-	ValueSignature           Signature           `bson:"valueSignature" json:"valueSignature"`
-	ValueTiming              Timing              `bson:"valueTiming" json:"valueTiming"`
-	ValueContactDetail       ContactDetail       `bson:"valueContactDetail" json:"valueContactDetail"`
-	ValueContributor         Contributor         `bson:"valueContributor" json:"valueContributor"`
-	ValueDataRequirement     DataRequirement     `bson:"valueDataRequirement" json:"valueDataRequirement"`
-	ValueExpression          Expression          `bson:"valueExpression" json:"valueExpression"`
-	ValueParameterDefinition ParameterDefinition `bson:"valueParameterDefinition" json:"valueParameterDefinition"`
-	ValueRelatedArtifact     RelatedArtifact     `bson:"valueRelatedArtifact" json:"valueRelatedArtifact"`
-	ValueTriggerDefinition   TriggerDefinition   `bson:"valueTriggerDefinition" json:"valueTriggerDefinition"`
-	ValueUsageContext        UsageContext        `bson:"valueUsageContext" json:"valueUsageContext"`
-	ValueDosage              Dosage              `bson:"valueDosage" json:"valueDosage"`
-	ValueMeta                Meta                `bson:"valueMeta" json:"valueMeta"`
+	Id                       *string              `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     CodeableConcept      `bson:"type" json:"type"`
+	ValueBase64Binary        *string              `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element             `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element             `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string              `bson:"valueCanonical,omitempty" json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element             `bson:"_valueCanonical,omitempty" json:"_valueCanonical,omitempty"`
+	ValueCode                *string              `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement         *Element             `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDate                *string              `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement         *Element             `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime            *string              `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element             `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal             *json.Number         `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element             `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueId                  *string              `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement           *Element             `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueInstant             *string              `bson:"valueInstant,omitempty" json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element             `bson:"_valueInstant,omitempty" json:"_valueInstant,omitempty"`
+	ValueInteger             *int                 `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element             `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string              `bson:"valueMarkdown,omitempty" json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element             `bson:"_valueMarkdown,omitempty" json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string              `bson:"valueOid,omitempty" json:"valueOid,omitempty"`
+	ValueOidElement          *Element             `bson:"_valueOid,omitempty" json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                 `bson:"valuePositiveInt,omitempty" json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element             `bson:"_valuePositiveInt,omitempty" json:"_valuePositiveInt,omitempty"`
+	ValueString              *string              `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element             `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueTime                *string              `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element             `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                 `bson:"valueUnsignedInt,omitempty" json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element             `bson:"_valueUnsignedInt,omitempty" json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string              `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement          *Element             `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueUrl                 *string              `bson:"valueUrl,omitempty" json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element             `bson:"_valueUrl,omitempty" json:"_valueUrl,omitempty"`
+	ValueUuid                *string              `bson:"valueUuid,omitempty" json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element             `bson:"_valueUuid,omitempty" json:"_valueUuid,omitempty"`
+	ValueAddress             *Address             `bson:"valueAddress,omitempty" json:"valueAddress,omitempty"`
+	ValueAge                 *Age                 `bson:"valueAge,omitempty" json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation          `bson:"valueAnnotation,omitempty" json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment          `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *CodeableConcept     `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *Coding              `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint        `bson:"valueContactPoint,omitempty" json:"valueContactPoint,omitempty"`
+	ValueCount               *Count               `bson:"valueCount,omitempty" json:"valueCount,omitempty"`
+	ValueDistance            *Distance            `bson:"valueDistance,omitempty" json:"valueDistance,omitempty"`
+	ValueDuration            *Duration            `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName           `bson:"valueHumanName,omitempty" json:"valueHumanName,omitempty"`
+	ValueIdentifier          *Identifier          `bson:"valueIdentifier,omitempty" json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money               `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
+	ValuePeriod              *Period              `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueQuantity            *Quantity            `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange               *Range               `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio               `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueReference           *Reference           `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData         `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature           `bson:"valueSignature,omitempty" json:"valueSignature,omitempty"`
+	ValueTiming              *Timing              `bson:"valueTiming,omitempty" json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail       `bson:"valueContactDetail,omitempty" json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor         `bson:"valueContributor,omitempty" json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement     `bson:"valueDataRequirement,omitempty" json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression          `bson:"valueExpression,omitempty" json:"valueExpression,omitempty"`
+	ValueParameterDefinition *ParameterDefinition `bson:"valueParameterDefinition,omitempty" json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact     `bson:"valueRelatedArtifact,omitempty" json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition   `bson:"valueTriggerDefinition,omitempty" json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext        `bson:"valueUsageContext,omitempty" json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage              `bson:"valueDosage,omitempty" json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                `bson:"valueMeta,omitempty" json:"valueMeta,omitempty"`
 }
+
+// TaskInputValue is implemented by the types allowed for Task.input.value[x]
+type TaskInputValue interface {
+	isTaskInputValue()
+}
+
+func (Base64Binary) isTaskInputValue()        {}
+func (Boolean) isTaskInputValue()             {}
+func (Canonical) isTaskInputValue()           {}
+func (Code) isTaskInputValue()                {}
+func (Date) isTaskInputValue()                {}
+func (DateTime) isTaskInputValue()            {}
+func (Decimal) isTaskInputValue()             {}
+func (Id) isTaskInputValue()                  {}
+func (Instant) isTaskInputValue()             {}
+func (Integer) isTaskInputValue()             {}
+func (Markdown) isTaskInputValue()            {}
+func (Oid) isTaskInputValue()                 {}
+func (PositiveInt) isTaskInputValue()         {}
+func (String) isTaskInputValue()              {}
+func (Time) isTaskInputValue()                {}
+func (UnsignedInt) isTaskInputValue()         {}
+func (Uri) isTaskInputValue()                 {}
+func (Url) isTaskInputValue()                 {}
+func (Uuid) isTaskInputValue()                {}
+func (Address) isTaskInputValue()             {}
+func (Age) isTaskInputValue()                 {}
+func (Annotation) isTaskInputValue()          {}
+func (Attachment) isTaskInputValue()          {}
+func (CodeableConcept) isTaskInputValue()     {}
+func (Coding) isTaskInputValue()              {}
+func (ContactPoint) isTaskInputValue()        {}
+func (Count) isTaskInputValue()               {}
+func (Distance) isTaskInputValue()            {}
+func (Duration) isTaskInputValue()            {}
+func (HumanName) isTaskInputValue()           {}
+func (Identifier) isTaskInputValue()          {}
+func (Money) isTaskInputValue()               {}
+func (Period) isTaskInputValue()              {}
+func (Quantity) isTaskInputValue()            {}
+func (Range) isTaskInputValue()               {}
+func (Ratio) isTaskInputValue()               {}
+func (Reference) isTaskInputValue()           {}
+func (SampledData) isTaskInputValue()         {}
+func (Signature) isTaskInputValue()           {}
+func (Timing) isTaskInputValue()              {}
+func (ContactDetail) isTaskInputValue()       {}
+func (Contributor) isTaskInputValue()         {}
+func (DataRequirement) isTaskInputValue()     {}
+func (Expression) isTaskInputValue()          {}
+func (ParameterDefinition) isTaskInputValue() {}
+func (RelatedArtifact) isTaskInputValue()     {}
+func (TriggerDefinition) isTaskInputValue()   {}
+func (UsageContext) isTaskInputValue()        {}
+func (Dosage) isTaskInputValue()              {}
+func (Meta) isTaskInputValue()                {}
+
+// Value returns the value of Task.input.value[x] together with its FHIR type code or nil if no value is set
+func (r TaskInput) Value() (TaskInputValue, string) {
+	if r.ValueBase64Binary != nil {
+		return Base64Binary(*r.ValueBase64Binary), "base64Binary"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueCanonical != nil {
+		return Canonical(*r.ValueCanonical), "canonical"
+	}
+	if r.ValueCode != nil {
+		return Code(*r.ValueCode), "code"
+	}
+	if r.ValueDate != nil {
+		return Date(*r.ValueDate), "date"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValueDecimal != nil {
+		return Decimal(*r.ValueDecimal), "decimal"
+	}
+	if r.ValueId != nil {
+		return Id(*r.ValueId), "id"
+	}
+	if r.ValueInstant != nil {
+		return Instant(*r.ValueInstant), "instant"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueMarkdown != nil {
+		return Markdown(*r.ValueMarkdown), "markdown"
+	}
+	if r.ValueOid != nil {
+		return Oid(*r.ValueOid), "oid"
+	}
+	if r.ValuePositiveInt != nil {
+		return PositiveInt(*r.ValuePositiveInt), "positiveInt"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueTime != nil {
+		return Time(*r.ValueTime), "time"
+	}
+	if r.ValueUnsignedInt != nil {
+		return UnsignedInt(*r.ValueUnsignedInt), "unsignedInt"
+	}
+	if r.ValueUri != nil {
+		return Uri(*r.ValueUri), "uri"
+	}
+	if r.ValueUrl != nil {
+		return Url(*r.ValueUrl), "url"
+	}
+	if r.ValueUuid != nil {
+		return Uuid(*r.ValueUuid), "uuid"
+	}
+	if r.ValueAddress != nil {
+		return *r.ValueAddress, "Address"
+	}
+	if r.ValueAge != nil {
+		return *r.ValueAge, "Age"
+	}
+	if r.ValueAnnotation != nil {
+		return *r.ValueAnnotation, "Annotation"
+	}
+	if r.ValueAttachment != nil {
+		return *r.ValueAttachment, "Attachment"
+	}
+	if r.ValueCodeableConcept != nil {
+		return *r.ValueCodeableConcept, "CodeableConcept"
+	}
+	if r.ValueCoding != nil {
+		return *r.ValueCoding, "Coding"
+	}
+	if r.ValueContactPoint != nil {
+		return *r.ValueContactPoint, "ContactPoint"
+	}
+	if r.ValueCount != nil {
+		return *r.ValueCount, "Count"
+	}
+	if r.ValueDistance != nil {
+		return *r.ValueDistance, "Distance"
+	}
+	if r.ValueDuration != nil {
+		return *r.ValueDuration, "Duration"
+	}
+	if r.ValueHumanName != nil {
+		return *r.ValueHumanName, "HumanName"
+	}
+	if r.ValueIdentifier != nil {
+		return *r.ValueIdentifier, "Identifier"
+	}
+	if r.ValueMoney != nil {
+		return *r.ValueMoney, "Money"
+	}
+	if r.ValuePeriod != nil {
+		return *r.ValuePeriod, "Period"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueRange != nil {
+		return *r.ValueRange, "Range"
+	}
+	if r.ValueRatio != nil {
+		return *r.ValueRatio, "Ratio"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	if r.ValueSampledData != nil {
+		return *r.ValueSampledData, "SampledData"
+	}
+	if r.ValueSignature != nil {
+		return *r.ValueSignature, "Signature"
+	}
+	if r.ValueTiming != nil {
+		return *r.ValueTiming, "Timing"
+	}
+	if r.ValueContactDetail != nil {
+		return *r.ValueContactDetail, "ContactDetail"
+	}
+	if r.ValueContributor != nil {
+		return *r.ValueContributor, "Contributor"
+	}
+	if r.ValueDataRequirement != nil {
+		return *r.ValueDataRequirement, "DataRequirement"
+	}
+	if r.ValueExpression != nil {
+		return *r.ValueExpression, "Expression"
+	}
+	if r.ValueParameterDefinition != nil {
+		return *r.ValueParameterDefinition, "ParameterDefinition"
+	}
+	if r.ValueRelatedArtifact != nil {
+		return *r.ValueRelatedArtifact, "RelatedArtifact"
+	}
+	if r.ValueTriggerDefinition != nil {
+		return *r.ValueTriggerDefinition, "TriggerDefinition"
+	}
+	if r.ValueUsageContext != nil {
+		return *r.ValueUsageContext, "UsageContext"
+	}
+	if r.ValueDosage != nil {
+		return *r.ValueDosage, "Dosage"
+	}
+	if r.ValueMeta != nil {
+		return *r.ValueMeta, "Meta"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Task.input.value[x], given as value or pointer, and clears all other types
+func (r *TaskInput) SetValue(value TaskInputValue) {
+	r.ValueBase64Binary = nil
+	r.ValueBoolean = nil
+	r.ValueCanonical = nil
+	r.ValueCode = nil
+	r.ValueDate = nil
+	r.ValueDateTime = nil
+	r.ValueDecimal = nil
+	r.ValueId = nil
+	r.ValueInstant = nil
+	r.ValueInteger = nil
+	r.ValueMarkdown = nil
+	r.ValueOid = nil
+	r.ValuePositiveInt = nil
+	r.ValueString = nil
+	r.ValueTime = nil
+	r.ValueUnsignedInt = nil
+	r.ValueUri = nil
+	r.ValueUrl = nil
+	r.ValueUuid = nil
+	r.ValueAddress = nil
+	r.ValueAge = nil
+	r.ValueAnnotation = nil
+	r.ValueAttachment = nil
+	r.ValueCodeableConcept = nil
+	r.ValueCoding = nil
+	r.ValueContactPoint = nil
+	r.ValueCount = nil
+	r.ValueDistance = nil
+	r.ValueDuration = nil
+	r.ValueHumanName = nil
+	r.ValueIdentifier = nil
+	r.ValueMoney = nil
+	r.ValuePeriod = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueReference = nil
+	r.ValueSampledData = nil
+	r.ValueSignature = nil
+	r.ValueTiming = nil
+	r.ValueContactDetail = nil
+	r.ValueContributor = nil
+	r.ValueDataRequirement = nil
+	r.ValueExpression = nil
+	r.ValueParameterDefinition = nil
+	r.ValueRelatedArtifact = nil
+	r.ValueTriggerDefinition = nil
+	r.ValueUsageContext = nil
+	r.ValueDosage = nil
+	r.ValueMeta = nil
+	switch v := value.(type) {
+	case Base64Binary:
+		primitive := string(v)
+		r.ValueBase64Binary = &primitive
+	case *Base64Binary:
+		r.ValueBase64Binary = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.ValueCanonical = &primitive
+	case *Canonical:
+		r.ValueCanonical = (*string)(v)
+	case Code:
+		primitive := string(v)
+		r.ValueCode = &primitive
+	case *Code:
+		r.ValueCode = (*string)(v)
+	case Date:
+		primitive := string(v)
+		r.ValueDate = &primitive
+	case *Date:
+		r.ValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.ValueDecimal = &primitive
+	case *Decimal:
+		r.ValueDecimal = (*json.Number)(v)
+	case Id:
+		primitive := string(v)
+		r.ValueId = &primitive
+	case *Id:
+		r.ValueId = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.ValueInstant = &primitive
+	case *Instant:
+		r.ValueInstant = (*string)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Markdown:
+		primitive := string(v)
+		r.ValueMarkdown = &primitive
+	case *Markdown:
+		r.ValueMarkdown = (*string)(v)
+	case Oid:
+		primitive := string(v)
+		r.ValueOid = &primitive
+	case *Oid:
+		r.ValueOid = (*string)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.ValuePositiveInt = &primitive
+	case *PositiveInt:
+		r.ValuePositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.ValueTime = &primitive
+	case *Time:
+		r.ValueTime = (*string)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.ValueUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.ValueUnsignedInt = (*int)(v)
+	case Uri:
+		primitive := string(v)
+		r.ValueUri = &primitive
+	case *Uri:
+		r.ValueUri = (*string)(v)
+	case Url:
+		primitive := string(v)
+		r.ValueUrl = &primitive
+	case *Url:
+		r.ValueUrl = (*string)(v)
+	case Uuid:
+		primitive := string(v)
+		r.ValueUuid = &primitive
+	case *Uuid:
+		r.ValueUuid = (*string)(v)
+	case Address:
+		r.ValueAddress = &v
+	case *Address:
+		r.ValueAddress = v
+	case Age:
+		r.ValueAge = &v
+	case *Age:
+		r.ValueAge = v
+	case Annotation:
+		r.ValueAnnotation = &v
+	case *Annotation:
+		r.ValueAnnotation = v
+	case Attachment:
+		r.ValueAttachment = &v
+	case *Attachment:
+		r.ValueAttachment = v
+	case CodeableConcept:
+		r.ValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.ValueCodeableConcept = v
+	case Coding:
+		r.ValueCoding = &v
+	case *Coding:
+		r.ValueCoding = v
+	case ContactPoint:
+		r.ValueContactPoint = &v
+	case *ContactPoint:
+		r.ValueContactPoint = v
+	case Count:
+		r.ValueCount = &v
+	case *Count:
+		r.ValueCount = v
+	case Distance:
+		r.ValueDistance = &v
+	case *Distance:
+		r.ValueDistance = v
+	case Duration:
+		r.ValueDuration = &v
+	case *Duration:
+		r.ValueDuration = v
+	case HumanName:
+		r.ValueHumanName = &v
+	case *HumanName:
+		r.ValueHumanName = v
+	case Identifier:
+		r.ValueIdentifier = &v
+	case *Identifier:
+		r.ValueIdentifier = v
+	case Money:
+		r.ValueMoney = &v
+	case *Money:
+		r.ValueMoney = v
+	case Period:
+		r.ValuePeriod = &v
+	case *Period:
+		r.ValuePeriod = v
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Range:
+		r.ValueRange = &v
+	case *Range:
+		r.ValueRange = v
+	case Ratio:
+		r.ValueRatio = &v
+	case *Ratio:
+		r.ValueRatio = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	case SampledData:
+		r.ValueSampledData = &v
+	case *SampledData:
+		r.ValueSampledData = v
+	case Signature:
+		r.ValueSignature = &v
+	case *Signature:
+		r.ValueSignature = v
+	case Timing:
+		r.ValueTiming = &v
+	case *Timing:
+		r.ValueTiming = v
+	case ContactDetail:
+		r.ValueContactDetail = &v
+	case *ContactDetail:
+		r.ValueContactDetail = v
+	case Contributor:
+		r.ValueContributor = &v
+	case *Contributor:
+		r.ValueContributor = v
+	case DataRequirement:
+		r.ValueDataRequirement = &v
+	case *DataRequirement:
+		r.ValueDataRequirement = v
+	case Expression:
+		r.ValueExpression = &v
+	case *Expression:
+		r.ValueExpression = v
+	case ParameterDefinition:
+		r.ValueParameterDefinition = &v
+	case *ParameterDefinition:
+		r.ValueParameterDefinition = v
+	case RelatedArtifact:
+		r.ValueRelatedArtifact = &v
+	case *RelatedArtifact:
+		r.ValueRelatedArtifact = v
+	case TriggerDefinition:
+		r.ValueTriggerDefinition = &v
+	case *TriggerDefinition:
+		r.ValueTriggerDefinition = v
+	case UsageContext:
+		r.ValueUsageContext = &v
+	case *UsageContext:
+		r.ValueUsageContext = v
+	case Dosage:
+		r.ValueDosage = &v
+	case *Dosage:
+		r.ValueDosage = v
+	case Meta:
+		r.ValueMeta = &v
+	case *Meta:
+		r.ValueMeta = v
+	}
+}
+
 type TaskOutput struct {
-	Id                       *string             `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                []Extension         `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension        []Extension         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type                     CodeableConcept     `bson:"type" json:"type"`
-	ValueBase64Binary        string              `bson:"valueBase64Binary" json:"valueBase64Binary"`
-	ValueBoolean             bool                `bson:"valueBoolean" json:"valueBoolean"`
-	ValueCanonical           string              `bson:"valueCanonical" json:"valueCanonical"`
-	ValueCode                string              `bson:"valueCode" json:"valueCode"`
-	ValueDate                string              `bson:"valueDate" json:"valueDate"`
-	ValueDateTime            string              `bson:"valueDateTime" json:"valueDateTime"`
-	ValueDecimal             json.Number         `bson:"valueDecimal" json:"valueDecimal"`
-	ValueId                  string              `bson:"valueId" json:"valueId"`
-	ValueInstant             string              `bson:"valueInstant" json:"valueInstant"`
-	ValueInteger             int                 `bson:"valueInteger" json:"valueInteger"`
-	ValueMarkdown            string              `bson:"valueMarkdown" json:"valueMarkdown"`
-	ValueOid                 string              `bson:"valueOid" json:"valueOid"`
-	ValuePositiveInt         int                 `bson:"valuePositiveInt" json:"valuePositiveInt"`
-	ValueString              string              `bson:"valueString" json:"valueString"`
-	ValueTime                string              `bson:"valueTime" json:"valueTime"`
-	ValueUnsignedInt         int                 `bson:"valueUnsignedInt" json:"valueUnsignedInt"`
-	ValueUri                 string              `bson:"valueUri" json:"valueUri"`
-	ValueUrl                 string              `bson:"valueUrl" json:"valueUrl"`
-	ValueUuid                string              `bson:"valueUuid" json:"valueUuid"`
-	ValueAddress             Address             `bson:"valueAddress" json:"valueAddress"`
-	ValueAge                 Age                 `bson:"valueAge" json:"valueAge"`
-	ValueAnnotation          Annotation          `bson:"valueAnnotation" json:"valueAnnotation"`
-	ValueAttachment          Attachment          `bson:"valueAttachment" json:"valueAttachment"`
-	ValueCodeableConcept     CodeableConcept     `bson:"valueCodeableConcept" json:"valueCodeableConcept"`
-	ValueCoding              Coding              `bson:"valueCoding" json:"valueCoding"`
-	ValueContactPoint        ContactPoint        `bson:"valueContactPoint" json:"valueContactPoint"`
-	ValueCount               Count               `bson:"valueCount" json:"valueCount"`
-	ValueDistance            Distance            `bson:"valueDistance" json:"valueDistance"`
-	ValueDuration            Duration            `bson:"valueDuration" json:"valueDuration"`
-	ValueHumanName           HumanName           `bson:"valueHumanName" json:"valueHumanName"`
-	ValueIdentifier          Identifier          `bson:"valueIdentifier" json:"valueIdentifier"`
-	ValueMoney               Money               `bson:"valueMoney" json:"valueMoney"`
-	ValuePeriod              Period              `bson:"valuePeriod" json:"valuePeriod"`
-	ValueQuantity            Quantity            `bson:"valueQuantity" json:"valueQuantity"`
-	ValueRange               Range               `bson:"valueRange" json:"valueRange"`
-	ValueRatio               Ratio               `bson:"valueRatio" json:"valueRatio"`
-	ValueReference           Reference           `bson:"valueReference" json:"valueReference"`
-	ValueSampledData         SampledData         `bson:"valueSampledData" json:"valueSampledData"`
-	ValueSignature           Signature           `bson:"valueSignature" json:"valueSignature"`
-	ValueTiming              Timing              `bson:"valueTiming" json:"valueTiming"`
-	ValueContactDetail       ContactDetail       `bson:"valueContactDetail" json:"valueContactDetail"`
-	ValueContributor         Contributor         `bson:"valueContributor" json:"valueContributor"`
-	ValueDataRequirement     DataRequirement     `bson:"valueDataRequirement" json:"valueDataRequirement"`
-	ValueExpression          Expression          `bson:"valueExpression" json:"valueExpression"`
-	ValueParameterDefinition ParameterDefinition `bson:"valueParameterDefinition" json:"valueParameterDefinition"`
-	ValueRelatedArtifact     RelatedArtifact     `bson:"valueRelatedArtifact" json:"valueRelatedArtifact"`
-	ValueTriggerDefinition   TriggerDefinition   `bson:"valueTriggerDefinition" json:"valueTriggerDefinition"`
-	ValueUsageContext        UsageContext        `bson:"valueUsageContext" json:"valueUsageContext"`
-	ValueDosage              Dosage              `bson:"valueDosage" json:"valueDosage"`
-	ValueMeta                Meta                `bson:"valueMeta" json:"valueMeta"`
-}
-type OtherTask Task
+	Id                       *string              `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension        []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                     CodeableConcept      `bson:"type" json:"type"`
+	ValueBase64Binary        *string              `bson:"valueBase64Binary,omitempty" json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element             `bson:"_valueBase64Binary,omitempty" json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                `bson:"valueBoolean,omitempty" json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element             `bson:"_valueBoolean,omitempty" json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string              `bson:"valueCanonical,omitempty" json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element             `bson:"_valueCanonical,omitempty" json:"_valueCanonical,omitempty"`
+	ValueCode                *string              `bson:"valueCode,omitempty" json:"valueCode,omitempty"`
+	ValueCodeElement         *Element             `bson:"_valueCode,omitempty" json:"_valueCode,omitempty"`
+	ValueDate                *string              `bson:"valueDate,omitempty" json:"valueDate,omitempty"`
+	ValueDateElement         *Element             `bson:"_valueDate,omitempty" json:"_valueDate,omitempty"`
+	ValueDateTime            *string              `bson:"valueDateTime,omitempty" json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element             `bson:"_valueDateTime,omitempty" json:"_valueDateTime,omitempty"`
+	ValueDecimal             *json.Number         `bson:"valueDecimal,omitempty" json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element             `bson:"_valueDecimal,omitempty" json:"_valueDecimal,omitempty"`
+	ValueId                  *string              `bson:"valueId,omitempty" json:"valueId,omitempty"`
+	ValueIdElement           *Element             `bson:"_valueId,omitempty" json:"_valueId,omitempty"`
+	ValueInstant             *string              `bson:"valueInstant,omitempty" json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element             `bson:"_valueInstant,omitempty" json:"_valueInstant,omitempty"`
+	ValueInteger             *int                 `bson:"valueInteger,omitempty" json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element             `bson:"_valueInteger,omitempty" json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string              `bson:"valueMarkdown,omitempty" json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element             `bson:"_valueMarkdown,omitempty" json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string              `bson:"valueOid,omitempty" json:"valueOid,omitempty"`
+	ValueOidElement          *Element             `bson:"_valueOid,omitempty" json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                 `bson:"valuePositiveInt,omitempty" json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element             `bson:"_valuePositiveInt,omitempty" json:"_valuePositiveInt,omitempty"`
+	ValueString              *string              `bson:"valueString,omitempty" json:"valueString,omitempty"`
+	ValueStringElement       *Element             `bson:"_valueString,omitempty" json:"_valueString,omitempty"`
+	ValueTime                *string              `bson:"valueTime,omitempty" json:"valueTime,omitempty"`
+	ValueTimeElement         *Element             `bson:"_valueTime,omitempty" json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                 `bson:"valueUnsignedInt,omitempty" json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element             `bson:"_valueUnsignedInt,omitempty" json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string              `bson:"valueUri,omitempty" json:"valueUri,omitempty"`
+	ValueUriElement          *Element             `bson:"_valueUri,omitempty" json:"_valueUri,omitempty"`
+	ValueUrl                 *string              `bson:"valueUrl,omitempty" json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element             `bson:"_valueUrl,omitempty" json:"_valueUrl,omitempty"`
+	ValueUuid                *string              `bson:"valueUuid,omitempty" json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element             `bson:"_valueUuid,omitempty" json:"_valueUuid,omitempty"`
+	ValueAddress             *Address             `bson:"valueAddress,omitempty" json:"valueAddress,omitempty"`
+	ValueAge                 *Age                 `bson:"valueAge,omitempty" json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation          `bson:"valueAnnotation,omitempty" json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment          `bson:"valueAttachment,omitempty" json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *CodeableConcept     `bson:"valueCodeableConcept,omitempty" json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *Coding              `bson:"valueCoding,omitempty" json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint        `bson:"valueContactPoint,omitempty" json:"valueContactPoint,omitempty"`
+	ValueCount               *Count               `bson:"valueCount,omitempty" json:"valueCount,omitempty"`
+	ValueDistance            *Distance            `bson:"valueDistance,omitempty" json:"valueDistance,omitempty"`
+	ValueDuration            *Duration            `bson:"valueDuration,omitempty" json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName           `bson:"valueHumanName,omitempty" json:"valueHumanName,omitempty"`
+	ValueIdentifier          *Identifier          `bson:"valueIdentifier,omitempty" json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money               `bson:"valueMoney,omitempty" json:"valueMoney,omitempty"`
+	ValuePeriod              *Period              `bson:"valuePeriod,omitempty" json:"valuePeriod,omitempty"`
+	ValueQuantity            *Quantity            `bson:"valueQuantity,omitempty" json:"valueQuantity,omitempty"`
+	ValueRange               *Range               `bson:"valueRange,omitempty" json:"valueRange,omitempty"`
+	ValueRatio               *Ratio               `bson:"valueRatio,omitempty" json:"valueRatio,omitempty"`
+	ValueReference           *Reference           `bson:"valueReference,omitempty" json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData         `bson:"valueSampledData,omitempty" json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature           `bson:"valueSignature,omitempty" json:"valueSignature,omitempty"`
+	ValueTiming              *Timing              `bson:"valueTiming,omitempty" json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail       `bson:"valueContactDetail,omitempty" json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor         `bson:"valueContributor,omitempty" json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement     `bson:"valueDataRequirement,omitempty" json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression          `bson:"valueExpression,omitempty" json:"valueExpression,omitempty"`
+	ValueParameterDefinition *ParameterDefinition `bson:"valueParameterDefinition,omitempty" json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact     `bson:"valueRelatedArtifact,omitempty" json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition   `bson:"valueTriggerDefinition,omitempty" json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext        `bson:"valueUsageContext,omitempty" json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage              `bson:"valueDosage,omitempty" json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                `bson:"valueMeta,omitempty" json:"valueMeta,omitempty"`
+}
+
+// TaskOutputValue is implemented by the types allowed for Task.output.value[x]
+type TaskOutputValue interface {
+	isTaskOutputValue()
+}
+
+func (Base64Binary) isTaskOutputValue()        {}
+func (Boolean) isTaskOutputValue()             {}
+func (Canonical) isTaskOutputValue()           {}
+func (Code) isTaskOutputValue()                {}
+func (Date) isTaskOutputValue()                {}
+func (DateTime) isTaskOutputValue()            {}
+func (Decimal) isTaskOutputValue()             {}
+func (Id) isTaskOutputValue()                  {}
+func (Instant) isTaskOutputValue()             {}
+func (Integer) isTaskOutputValue()             {}
+func (Markdown) isTaskOutputValue()            {}
+func (Oid) isTaskOutputValue()                 {}
+func (PositiveInt) isTaskOutputValue()         {}
+func (String) isTaskOutputValue()              {}
+func (Time) isTaskOutputValue()                {}
+func (UnsignedInt) isTaskOutputValue()         {}
+func (Uri) isTaskOutputValue()                 {}
+func (Url) isTaskOutputValue()                 {}
+func (Uuid) isTaskOutputValue()                {}
+func (Address) isTaskOutputValue()             {}
+func (Age) isTaskOutputValue()                 {}
+func (Annotation) isTaskOutputValue()          {}
+func (Attachment) isTaskOutputValue()          {}
+func (CodeableConcept) isTaskOutputValue()     {}
+func (Coding) isTaskOutputValue()              {}
+func (ContactPoint) isTaskOutputValue()        {}
+func (Count) isTaskOutputValue()               {}
+func (Distance) isTaskOutputValue()            {}
+func (Duration) isTaskOutputValue()            {}
+func (HumanName) isTaskOutputValue()           {}
+func (Identifier) isTaskOutputValue()          {}
+func (Money) isTaskOutputValue()               {}
+func (Period) isTaskOutputValue()              {}
+func (Quantity) isTaskOutputValue()            {}
+func (Range) isTaskOutputValue()               {}
+func (Ratio) isTaskOutputValue()               {}
+func (Reference) isTaskOutputValue()           {}
+func (SampledData) isTaskOutputValue()         {}
+func (Signature) isTaskOutputValue()           {}
+func (Timing) isTaskOutputValue()              {}
+func (ContactDetail) isTaskOutputValue()       {}
+func (Contributor) isTaskOutputValue()         {}
+func (DataRequirement) isTaskOutputValue()     {}
+func (Expression) isTaskOutputValue()          {}
+func (ParameterDefinition) isTaskOutputValue() {}
+func (RelatedArtifact) isTaskOutputValue()     {}
+func (TriggerDefinition) isTaskOutputValue()   {}
+func (UsageContext) isTaskOutputValue()        {}
+func (Dosage) isTaskOutputValue()              {}
+func (Meta) isTaskOutputValue()                {}
+
+// Value returns the value of Task.output.value[x] together with its FHIR type code or nil if no value is set
+func (r TaskOutput) Value() (TaskOutputValue, string) {
+	if r.ValueBase64Binary != nil {
+		return Base64Binary(*r.ValueBase64Binary), "base64Binary"
+	}
+	if r.ValueBoolean != nil {
+		return Boolean(*r.ValueBoolean), "boolean"
+	}
+	if r.ValueCanonical != nil {
+		return Canonical(*r.ValueCanonical), "canonical"
+	}
+	if r.ValueCode != nil {
+		return Code(*r.ValueCode), "code"
+	}
+	if r.ValueDate != nil {
+		return Date(*r.ValueDate), "date"
+	}
+	if r.ValueDateTime != nil {
+		return DateTime(*r.ValueDateTime), "dateTime"
+	}
+	if r.ValueDecimal != nil {
+		return Decimal(*r.ValueDecimal), "decimal"
+	}
+	if r.ValueId != nil {
+		return Id(*r.ValueId), "id"
+	}
+	if r.ValueInstant != nil {
+		return Instant(*r.ValueInstant), "instant"
+	}
+	if r.ValueInteger != nil {
+		return Integer(*r.ValueInteger), "integer"
+	}
+	if r.ValueMarkdown != nil {
+		return Markdown(*r.ValueMarkdown), "markdown"
+	}
+	if r.ValueOid != nil {
+		return Oid(*r.ValueOid), "oid"
+	}
+	if r.ValuePositiveInt != nil {
+		return PositiveInt(*r.ValuePositiveInt), "positiveInt"
+	}
+	if r.ValueString != nil {
+		return String(*r.ValueString), "string"
+	}
+	if r.ValueTime != nil {
+		return Time(*r.ValueTime), "time"
+	}
+	if r.ValueUnsignedInt != nil {
+		return UnsignedInt(*r.ValueUnsignedInt), "unsignedInt"
+	}
+	if r.ValueUri != nil {
+		return Uri(*r.ValueUri), "uri"
+	}
+	if r.ValueUrl != nil {
+		return Url(*r.ValueUrl), "url"
+	}
+	if r.ValueUuid != nil {
+		return Uuid(*r.ValueUuid), "uuid"
+	}
+	if r.ValueAddress != nil {
+		return *r.ValueAddress, "Address"
+	}
+	if r.ValueAge != nil {
+		return *r.ValueAge, "Age"
+	}
+	if r.ValueAnnotation != nil {
+		return *r.ValueAnnotation, "Annotation"
+	}
+	if r.ValueAttachment != nil {
+		return *r.ValueAttachment, "Attachment"
+	}
+	if r.ValueCodeableConcept != nil {
+		return *r.ValueCodeableConcept, "CodeableConcept"
+	}
+	if r.ValueCoding != nil {
+		return *r.ValueCoding, "Coding"
+	}
+	if r.ValueContactPoint != nil {
+		return *r.ValueContactPoint, "ContactPoint"
+	}
+	if r.ValueCount != nil {
+		return *r.ValueCount, "Count"
+	}
+	if r.ValueDistance != nil {
+		return *r.ValueDistance, "Distance"
+	}
+	if r.ValueDuration != nil {
+		return *r.ValueDuration, "Duration"
+	}
+	if r.ValueHumanName != nil {
+		return *r.ValueHumanName, "HumanName"
+	}
+	if r.ValueIdentifier != nil {
+		return *r.ValueIdentifier, "Identifier"
+	}
+	if r.ValueMoney != nil {
+		return *r.ValueMoney, "Money"
+	}
+	if r.ValuePeriod != nil {
+		return *r.ValuePeriod, "Period"
+	}
+	if r.ValueQuantity != nil {
+		return *r.ValueQuantity, "Quantity"
+	}
+	if r.ValueRange != nil {
+		return *r.ValueRange, "Range"
+	}
+	if r.ValueRatio != nil {
+		return *r.ValueRatio, "Ratio"
+	}
+	if r.ValueReference != nil {
+		return *r.ValueReference, "Reference"
+	}
+	if r.ValueSampledData != nil {
+		return *r.ValueSampledData, "SampledData"
+	}
+	if r.ValueSignature != nil {
+		return *r.ValueSignature, "Signature"
+	}
+	if r.ValueTiming != nil {
+		return *r.ValueTiming, "Timing"
+	}
+	if r.ValueContactDetail != nil {
+		return *r.ValueContactDetail, "ContactDetail"
+	}
+	if r.ValueContributor != nil {
+		return *r.ValueContributor, "Contributor"
+	}
+	if r.ValueDataRequirement != nil {
+		return *r.ValueDataRequirement, "DataRequirement"
+	}
+	if r.ValueExpression != nil {
+		return *r.ValueExpression, "Expression"
+	}
+	if r.ValueParameterDefinition != nil {
+		return *r.ValueParameterDefinition, "ParameterDefinition"
+	}
+	if r.ValueRelatedArtifact != nil {
+		return *r.ValueRelatedArtifact, "RelatedArtifact"
+	}
+	if r.ValueTriggerDefinition != nil {
+		return *r.ValueTriggerDefinition, "TriggerDefinition"
+	}
+	if r.ValueUsageContext != nil {
+		return *r.ValueUsageContext, "UsageContext"
+	}
+	if r.ValueDosage != nil {
+		return *r.ValueDosage, "Dosage"
+	}
+	if r.ValueMeta != nil {
+		return *r.ValueMeta, "Meta"
+	}
+	return nil, ""
+}
+
+// SetValue sets the value of Task.output.value[x], given as value or pointer, and clears all other types
+func (r *TaskOutput) SetValue(value TaskOutputValue) {
+	r.ValueBase64Binary = nil
+	r.ValueBoolean = nil
+	r.ValueCanonical = nil
+	r.ValueCode = nil
+	r.ValueDate = nil
+	r.ValueDateTime = nil
+	r.ValueDecimal = nil
+	r.ValueId = nil
+	r.ValueInstant = nil
+	r.ValueInteger = nil
+	r.ValueMarkdown = nil
+	r.ValueOid = nil
+	r.ValuePositiveInt = nil
+	r.ValueString = nil
+	r.ValueTime = nil
+	r.ValueUnsignedInt = nil
+	r.ValueUri = nil
+	r.ValueUrl = nil
+	r.ValueUuid = nil
+	r.ValueAddress = nil
+	r.ValueAge = nil
+	r.ValueAnnotation = nil
+	r.ValueAttachment = nil
+	r.ValueCodeableConcept = nil
+	r.ValueCoding = nil
+	r.ValueContactPoint = nil
+	r.ValueCount = nil
+	r.ValueDistance = nil
+	r.ValueDuration = nil
+	r.ValueHumanName = nil
+	r.ValueIdentifier = nil
+	r.ValueMoney = nil
+	r.ValuePeriod = nil
+	r.ValueQuantity = nil
+	r.ValueRange = nil
+	r.ValueRatio = nil
+	r.ValueReference = nil
+	r.ValueSampledData = nil
+	r.ValueSignature = nil
+	r.ValueTiming = nil
+	r.ValueContactDetail = nil
+	r.ValueContributor = nil
+	r.ValueDataRequirement = nil
+	r.ValueExpression = nil
+	r.ValueParameterDefinition = nil
+	r.ValueRelatedArtifact = nil
+	r.ValueTriggerDefinition = nil
+	r.ValueUsageContext = nil
+	r.ValueDosage = nil
+	r.ValueMeta = nil
+	switch v := value.(type) {
+	case Base64Binary:
+		primitive := string(v)
+		r.ValueBase64Binary = &primitive
+	case *Base64Binary:
+		r.ValueBase64Binary = (*string)(v)
+	case Boolean:
+		primitive := bool(v)
+		r.ValueBoolean = &primitive
+	case *Boolean:
+		r.ValueBoolean = (*bool)(v)
+	case Canonical:
+		primitive := string(v)
+		r.ValueCanonical = &primitive
+	case *Canonical:
+		r.ValueCanonical = (*string)(v)
+	case Code:
+		primitive := string(v)
+		r.ValueCode = &primitive
+	case *Code:
+		r.ValueCode = (*string)(v)
+	case Date:
+		primitive := string(v)
+		r.ValueDate = &primitive
+	case *Date:
+		r.ValueDate = (*string)(v)
+	case DateTime:
+		primitive := string(v)
+		r.ValueDateTime = &primitive
+	case *DateTime:
+		r.ValueDateTime = (*string)(v)
+	case Decimal:
+		primitive := json.Number(v)
+		r.ValueDecimal = &primitive
+	case *Decimal:
+		r.ValueDecimal = (*json.Number)(v)
+	case Id:
+		primitive := string(v)
+		r.ValueId = &primitive
+	case *Id:
+		r.ValueId = (*string)(v)
+	case Instant:
+		primitive := string(v)
+		r.ValueInstant = &primitive
+	case *Instant:
+		r.ValueInstant = (*string)(v)
+	case Integer:
+		primitive := int(v)
+		r.ValueInteger = &primitive
+	case *Integer:
+		r.ValueInteger = (*int)(v)
+	case Markdown:
+		primitive := string(v)
+		r.ValueMarkdown = &primitive
+	case *Markdown:
+		r.ValueMarkdown = (*string)(v)
+	case Oid:
+		primitive := string(v)
+		r.ValueOid = &primitive
+	case *Oid:
+		r.ValueOid = (*string)(v)
+	case PositiveInt:
+		primitive := int(v)
+		r.ValuePositiveInt = &primitive
+	case *PositiveInt:
+		r.ValuePositiveInt = (*int)(v)
+	case String:
+		primitive := string(v)
+		r.ValueString = &primitive
+	case *String:
+		r.ValueString = (*string)(v)
+	case Time:
+		primitive := string(v)
+		r.ValueTime = &primitive
+	case *Time:
+		r.ValueTime = (*string)(v)
+	case UnsignedInt:
+		primitive := int(v)
+		r.ValueUnsignedInt = &primitive
+	case *UnsignedInt:
+		r.ValueUnsignedInt = (*int)(v)
+	case Uri:
+		primitive := string(v)
+		r.ValueUri = &primitive
+	case *Uri:
+		r.ValueUri = (*string)(v)
+	case Url:
+		primitive := string(v)
+		r.ValueUrl = &primitive
+	case *Url:
+		r.ValueUrl = (*string)(v)
+	case Uuid:
+		primitive := string(v)
+		r.ValueUuid = &primitive
+	case *Uuid:
+		r.ValueUuid = (*string)(v)
+	case Address:
+		r.ValueAddress = &v
+	case *Address:
+		r.ValueAddress = v
+	case Age:
+		r.ValueAge = &v
+	case *Age:
+		r.ValueAge = v
+	case Annotation:
+		r.ValueAnnotation = &v
+	case *Annotation:
+		r.ValueAnnotation = v
+	case Attachment:
+		r.ValueAttachment = &v
+	case *Attachment:
+		r.ValueAttachment = v
+	case CodeableConcept:
+		r.ValueCodeableConcept = &v
+	case *CodeableConcept:
+		r.ValueCodeableConcept = v
+	case Coding:
+		r.ValueCoding = &v
+	case *Coding:
+		r.ValueCoding = v
+	case ContactPoint:
+		r.ValueContactPoint = &v
+	case *ContactPoint:
+		r.ValueContactPoint = v
+	case Count:
+		r.ValueCount = &v
+	case *Count:
+		r.ValueCount = v
+	case Distance:
+		r.ValueDistance = &v
+	case *Distance:
+		r.ValueDistance = v
+	case Duration:
+		r.ValueDuration = &v
+	case *Duration:
+		r.ValueDuration = v
+	case HumanName:
+		r.ValueHumanName = &v
+	case *HumanName:
+		r.ValueHumanName = v
+	case Identifier:
+		r.ValueIdentifier = &v
+	case *Identifier:
+		r.ValueIdentifier = v
+	case Money:
+		r.ValueMoney = &v
+	case *Money:
+		r.ValueMoney = v
+	case Period:
+		r.ValuePeriod = &v
+	case *Period:
+		r.ValuePeriod = v
+	case Quantity:
+		r.ValueQuantity = &v
+	case *Quantity:
+		r.ValueQuantity = v
+	case Range:
+		r.ValueRange = &v
+	case *Range:
+		r.ValueRange = v
+	case Ratio:
+		r.ValueRatio = &v
+	case *Ratio:
+		r.ValueRatio = v
+	case Reference:
+		r.ValueReference = &v
+	case *Reference:
+		r.ValueReference = v
+	case SampledData:
+		r.ValueSampledData = &v
+	case *SampledData:
+		r.ValueSampledData = v
+	case Signature:
+		r.ValueSignature = &v
+	case *Signature:
+		r.ValueSignature = v
+	case Timing:
+		r.ValueTiming = &v
+	case *Timing:
+		r.ValueTiming = v
+	case ContactDetail:
+		r.ValueContactDetail = &v
+	case *ContactDetail:
+		r.ValueContactDetail = v
+	case Contributor:
+		r.ValueContributor = &v
+	case *Contributor:
+		r.ValueContributor = v
+	case DataRequirement:
+		r.ValueDataRequirement = &v
+	case *DataRequirement:
+		r.ValueDataRequirement = v
+	case Expression:
+		r.ValueExpression = &v
+	case *Expression:
+		r.ValueExpression = v
+	case ParameterDefinition:
+		r.ValueParameterDefinition = &v
+	case *ParameterDefinition:
+		r.ValueParameterDefinition = v
+	case RelatedArtifact:
+		r.ValueRelatedArtifact = &v
+	case *RelatedArtifact:
+		r.ValueRelatedArtifact = v
+	case TriggerDefinition:
+		r.ValueTriggerDefinition = &v
+	case *TriggerDefinition:
+		r.ValueTriggerDefinition = v
+	case UsageContext:
+		r.ValueUsageContext = &v
+	case *UsageContext:
+		r.ValueUsageContext = v
+	case Dosage:
+		r.ValueDosage = &v
+	case *Dosage:
+		r.ValueDosage = v
+	case Meta:
+		r.ValueMeta = &v
+	case *Meta:
+		r.ValueMeta = v
+	}
+}
 
 // MarshalJSON marshals the given Task as JSON into a byte slice
 func (r Task) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherTask
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherTask:    OtherTask(r),
-		ResourceType: "Task",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the Task as JSON object with the members in the order of the fields
+func (r Task) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"Task\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.InstantiatesCanonical != nil {
+		e.key("instantiatesCanonical")
+		e.string(*r.InstantiatesCanonical)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		e.key("_instantiatesCanonical")
+		r.InstantiatesCanonicalElement.appendJSON(e)
+	}
+	if r.InstantiatesUri != nil {
+		e.key("instantiatesUri")
+		e.string(*r.InstantiatesUri)
+	}
+	if r.InstantiatesUriElement != nil {
+		e.key("_instantiatesUri")
+		r.InstantiatesUriElement.appendJSON(e)
+	}
+	if len(r.BasedOn) > 0 {
+		e.key("basedOn")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.BasedOn {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.GroupIdentifier != nil {
+		e.key("groupIdentifier")
+		r.GroupIdentifier.appendJSON(e)
+	}
+	if len(r.PartOf) > 0 {
+		e.key("partOf")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PartOf {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusReason != nil {
+		e.key("statusReason")
+		r.StatusReason.appendJSON(e)
+	}
+	if r.BusinessStatus != nil {
+		e.key("businessStatus")
+		r.BusinessStatus.appendJSON(e)
+	}
+	e.key("intent")
+	e.string(r.Intent)
+	if r.IntentElement != nil {
+		e.key("_intent")
+		r.IntentElement.appendJSON(e)
+	}
+	if r.Priority != nil {
+		e.key("priority")
+		e.string(r.Priority.Code())
+	}
+	if r.PriorityElement != nil {
+		e.key("_priority")
+		r.PriorityElement.appendJSON(e)
+	}
+	if r.Code != nil {
+		e.key("code")
+		r.Code.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Focus != nil {
+		e.key("focus")
+		r.Focus.appendJSON(e)
+	}
+	if r.For != nil {
+		e.key("for")
+		r.For.appendJSON(e)
+	}
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	if r.ExecutionPeriod != nil {
+		e.key("executionPeriod")
+		r.ExecutionPeriod.appendJSON(e)
+	}
+	if r.AuthoredOn != nil {
+		e.key("authoredOn")
+		e.string(*r.AuthoredOn)
+	}
+	if r.AuthoredOnElement != nil {
+		e.key("_authoredOn")
+		r.AuthoredOnElement.appendJSON(e)
+	}
+	if r.LastModified != nil {
+		e.key("lastModified")
+		e.string(*r.LastModified)
+	}
+	if r.LastModifiedElement != nil {
+		e.key("_lastModified")
+		r.LastModifiedElement.appendJSON(e)
+	}
+	if r.Requester != nil {
+		e.key("requester")
+		r.Requester.appendJSON(e)
+	}
+	if len(r.PerformerType) > 0 {
+		e.key("performerType")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PerformerType {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Owner != nil {
+		e.key("owner")
+		r.Owner.appendJSON(e)
+	}
+	if r.Location != nil {
+		e.key("location")
+		r.Location.appendJSON(e)
+	}
+	if r.ReasonCode != nil {
+		e.key("reasonCode")
+		r.ReasonCode.appendJSON(e)
+	}
+	if r.ReasonReference != nil {
+		e.key("reasonReference")
+		r.ReasonReference.appendJSON(e)
+	}
+	if len(r.Insurance) > 0 {
+		e.key("insurance")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Insurance {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.RelevantHistory) > 0 {
+		e.key("relevantHistory")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RelevantHistory {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Restriction != nil {
+		e.key("restriction")
+		r.Restriction.appendJSON(e)
+	}
+	if len(r.Input) > 0 {
+		e.key("input")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Input {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Output) > 0 {
+		e.key("output")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Output {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given Task from JSON. Unknown members are ignored.
+func (r *Task) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the Task
+func (r *Task) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "instantiatesCanonical":
+			if v, ok := d.string(); ok {
+				r.InstantiatesCanonical = &v
+			} else {
+				r.InstantiatesCanonical = nil
+			}
+		case "_instantiatesCanonical":
+			r.InstantiatesCanonicalElement = d.element()
+		case "instantiatesUri":
+			if v, ok := d.string(); ok {
+				r.InstantiatesUri = &v
+			} else {
+				r.InstantiatesUri = nil
+			}
+		case "_instantiatesUri":
+			r.InstantiatesUriElement = d.element()
+		case "basedOn":
+			a := d.array()
+			r.BasedOn = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.BasedOn = append(r.BasedOn, v)
+			}
+			if r.BasedOn == nil && !a.isNull {
+				r.BasedOn = []Reference{}
+			}
+		case "groupIdentifier":
+			if d.null() {
+				r.GroupIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.GroupIdentifier = &v
+			}
+		case "partOf":
+			a := d.array()
+			r.PartOf = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.PartOf = append(r.PartOf, v)
+			}
+			if r.PartOf == nil && !a.isNull {
+				r.PartOf = []Reference{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusReason":
+			if d.null() {
+				r.StatusReason = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.StatusReason = &v
+			}
+		case "businessStatus":
+			if d.null() {
+				r.BusinessStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.BusinessStatus = &v
+			}
+		case "intent":
+			if v, ok := d.string(); ok {
+				r.Intent = v
+			}
+		case "_intent":
+			r.IntentElement = d.element()
+		case "priority":
+			if d.null() {
+				r.Priority = nil
+			} else {
+				var v RequestPriority
+				d.unmarshal(&v)
+				r.Priority = &v
+			}
+		case "_priority":
+			r.PriorityElement = d.element()
+		case "code":
+			if d.null() {
+				r.Code = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Code = &v
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "focus":
+			if d.null() {
+				r.Focus = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Focus = &v
+			}
+		case "for":
+			if d.null() {
+				r.For = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.For = &v
+			}
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "executionPeriod":
+			if d.null() {
+				r.ExecutionPeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ExecutionPeriod = &v
+			}
+		case "authoredOn":
+			if v, ok := d.string(); ok {
+				r.AuthoredOn = &v
+			} else {
+				r.AuthoredOn = nil
+			}
+		case "_authoredOn":
+			r.AuthoredOnElement = d.element()
+		case "lastModified":
+			if v, ok := d.string(); ok {
+				r.LastModified = &v
+			} else {
+				r.LastModified = nil
+			}
+		case "_lastModified":
+			r.LastModifiedElement = d.element()
+		case "requester":
+			if d.null() {
+				r.Requester = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Requester = &v
+			}
+		case "performerType":
+			a := d.array()
+			r.PerformerType = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PerformerType = append(r.PerformerType, v)
+			}
+			if r.PerformerType == nil && !a.isNull {
+				r.PerformerType = []CodeableConcept{}
+			}
+		case "owner":
+			if d.null() {
+				r.Owner = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Owner = &v
+			}
+		case "location":
+			if d.null() {
+				r.Location = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Location = &v
+			}
+		case "reasonCode":
+			if d.null() {
+				r.ReasonCode = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ReasonCode = &v
+			}
+		case "reasonReference":
+			if d.null() {
+				r.ReasonReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ReasonReference = &v
+			}
+		case "insurance":
+			a := d.array()
+			r.Insurance = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Insurance = append(r.Insurance, v)
+			}
+			if r.Insurance == nil && !a.isNull {
+				r.Insurance = []Reference{}
+			}
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		case "relevantHistory":
+			a := d.array()
+			r.RelevantHistory = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.RelevantHistory = append(r.RelevantHistory, v)
+			}
+			if r.RelevantHistory == nil && !a.isNull {
+				r.RelevantHistory = []Reference{}
+			}
+		case "restriction":
+			if d.null() {
+				r.Restriction = nil
+			} else {
+				var v TaskRestriction
+				v.decodeJSON(d)
+				r.Restriction = &v
+			}
+		case "input":
+			a := d.array()
+			r.Input = nil
+			for a.next() {
+				var v TaskInput
+				v.decodeJSON(d)
+				r.Input = append(r.Input, v)
+			}
+			if r.Input == nil && !a.isNull {
+				r.Input = []TaskInput{}
+			}
+		case "output":
+			a := d.array()
+			r.Output = nil
+			for a.next() {
+				var v TaskOutput
+				v.decodeJSON(d)
+				r.Output = append(r.Output, v)
+			}
+			if r.Output == nil && !a.isNull {
+				r.Output = []TaskOutput{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the Task which shares no memory with the original
+func (r Task) DeepCopy() Task {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.InstantiatesCanonical != nil {
+		v := *r.InstantiatesCanonical
+		out.InstantiatesCanonical = &v
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		v := r.InstantiatesCanonicalElement.DeepCopy()
+		out.InstantiatesCanonicalElement = &v
+	}
+	if r.InstantiatesUri != nil {
+		v := *r.InstantiatesUri
+		out.InstantiatesUri = &v
+	}
+	if r.InstantiatesUriElement != nil {
+		v := r.InstantiatesUriElement.DeepCopy()
+		out.InstantiatesUriElement = &v
+	}
+	if r.BasedOn != nil {
+		out.BasedOn = make([]Reference, len(r.BasedOn))
+		for i := range r.BasedOn {
+			out.BasedOn[i] = r.BasedOn[i].DeepCopy()
+		}
+	}
+	if r.GroupIdentifier != nil {
+		v := r.GroupIdentifier.DeepCopy()
+		out.GroupIdentifier = &v
+	}
+	if r.PartOf != nil {
+		out.PartOf = make([]Reference, len(r.PartOf))
+		for i := range r.PartOf {
+			out.PartOf[i] = r.PartOf[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusReason != nil {
+		v := r.StatusReason.DeepCopy()
+		out.StatusReason = &v
+	}
+	if r.BusinessStatus != nil {
+		v := r.BusinessStatus.DeepCopy()
+		out.BusinessStatus = &v
+	}
+	if r.IntentElement != nil {
+		v := r.IntentElement.DeepCopy()
+		out.IntentElement = &v
+	}
+	if r.Priority != nil {
+		v := *r.Priority
+		out.Priority = &v
+	}
+	if r.PriorityElement != nil {
+		v := r.PriorityElement.DeepCopy()
+		out.PriorityElement = &v
+	}
+	if r.Code != nil {
+		v := r.Code.DeepCopy()
+		out.Code = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Focus != nil {
+		v := r.Focus.DeepCopy()
+		out.Focus = &v
+	}
+	if r.For != nil {
+		v := r.For.DeepCopy()
+		out.For = &v
+	}
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.ExecutionPeriod != nil {
+		v := r.ExecutionPeriod.DeepCopy()
+		out.ExecutionPeriod = &v
+	}
+	if r.AuthoredOn != nil {
+		v := *r.AuthoredOn
+		out.AuthoredOn = &v
+	}
+	if r.AuthoredOnElement != nil {
+		v := r.AuthoredOnElement.DeepCopy()
+		out.AuthoredOnElement = &v
+	}
+	if r.LastModified != nil {
+		v := *r.LastModified
+		out.LastModified = &v
+	}
+	if r.LastModifiedElement != nil {
+		v := r.LastModifiedElement.DeepCopy()
+		out.LastModifiedElement = &v
+	}
+	if r.Requester != nil {
+		v := r.Requester.DeepCopy()
+		out.Requester = &v
+	}
+	if r.PerformerType != nil {
+		out.PerformerType = make([]CodeableConcept, len(r.PerformerType))
+		for i := range r.PerformerType {
+			out.PerformerType[i] = r.PerformerType[i].DeepCopy()
+		}
+	}
+	if r.Owner != nil {
+		v := r.Owner.DeepCopy()
+		out.Owner = &v
+	}
+	if r.Location != nil {
+		v := r.Location.DeepCopy()
+		out.Location = &v
+	}
+	if r.ReasonCode != nil {
+		v := r.ReasonCode.DeepCopy()
+		out.ReasonCode = &v
+	}
+	if r.ReasonReference != nil {
+		v := r.ReasonReference.DeepCopy()
+		out.ReasonReference = &v
+	}
+	if r.Insurance != nil {
+		out.Insurance = make([]Reference, len(r.Insurance))
+		for i := range r.Insurance {
+			out.Insurance[i] = r.Insurance[i].DeepCopy()
+		}
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	if r.RelevantHistory != nil {
+		out.RelevantHistory = make([]Reference, len(r.RelevantHistory))
+		for i := range r.RelevantHistory {
+			out.RelevantHistory[i] = r.RelevantHistory[i].DeepCopy()
+		}
+	}
+	if r.Restriction != nil {
+		v := r.Restriction.DeepCopy()
+		out.Restriction = &v
+	}
+	if r.Input != nil {
+		out.Input = make([]TaskInput, len(r.Input))
+		for i := range r.Input {
+			out.Input[i] = r.Input[i].DeepCopy()
+		}
+	}
+	if r.Output != nil {
+		out.Output = make([]TaskOutput, len(r.Output))
+		for i := range r.Output {
+			out.Output[i] = r.Output[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r Task) Equal(other Task) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if (r.InstantiatesCanonical == nil) != (other.InstantiatesCanonical == nil) || r.InstantiatesCanonical != nil && *r.InstantiatesCanonical != *other.InstantiatesCanonical {
+		return false
+	}
+	if (r.InstantiatesCanonicalElement == nil) != (other.InstantiatesCanonicalElement == nil) || r.InstantiatesCanonicalElement != nil && !r.InstantiatesCanonicalElement.Equal(*other.InstantiatesCanonicalElement) {
+		return false
+	}
+	if (r.InstantiatesUri == nil) != (other.InstantiatesUri == nil) || r.InstantiatesUri != nil && *r.InstantiatesUri != *other.InstantiatesUri {
+		return false
+	}
+	if (r.InstantiatesUriElement == nil) != (other.InstantiatesUriElement == nil) || r.InstantiatesUriElement != nil && !r.InstantiatesUriElement.Equal(*other.InstantiatesUriElement) {
+		return false
+	}
+	if len(r.BasedOn) != len(other.BasedOn) {
+		return false
+	}
+	for i := range r.BasedOn {
+		if !r.BasedOn[i].Equal(other.BasedOn[i]) {
+			return false
+		}
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.Equal(*other.GroupIdentifier) {
+		return false
+	}
+	if len(r.PartOf) != len(other.PartOf) {
+		return false
+	}
+	for i := range r.PartOf {
+		if !r.PartOf[i].Equal(other.PartOf[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.Equal(*other.StatusReason) {
+		return false
+	}
+	if (r.BusinessStatus == nil) != (other.BusinessStatus == nil) || r.BusinessStatus != nil && !r.BusinessStatus.Equal(*other.BusinessStatus) {
+		return false
+	}
+	if r.Intent != other.Intent {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.Equal(*other.IntentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.Equal(*other.PriorityElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.Equal(*other.Code) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Focus == nil) != (other.Focus == nil) || r.Focus != nil && !r.Focus.Equal(*other.Focus) {
+		return false
+	}
+	if (r.For == nil) != (other.For == nil) || r.For != nil && !r.For.Equal(*other.For) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if (r.ExecutionPeriod == nil) != (other.ExecutionPeriod == nil) || r.ExecutionPeriod != nil && !r.ExecutionPeriod.Equal(*other.ExecutionPeriod) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && *r.AuthoredOn != *other.AuthoredOn {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.Equal(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.LastModified == nil) != (other.LastModified == nil) || r.LastModified != nil && *r.LastModified != *other.LastModified {
+		return false
+	}
+	if (r.LastModifiedElement == nil) != (other.LastModifiedElement == nil) || r.LastModifiedElement != nil && !r.LastModifiedElement.Equal(*other.LastModifiedElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.Equal(*other.Requester) {
+		return false
+	}
+	if len(r.PerformerType) != len(other.PerformerType) {
+		return false
+	}
+	for i := range r.PerformerType {
+		if !r.PerformerType[i].Equal(other.PerformerType[i]) {
+			return false
+		}
+	}
+	if (r.Owner == nil) != (other.Owner == nil) || r.Owner != nil && !r.Owner.Equal(*other.Owner) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.Equal(*other.Location) {
+		return false
+	}
+	if (r.ReasonCode == nil) != (other.ReasonCode == nil) || r.ReasonCode != nil && !r.ReasonCode.Equal(*other.ReasonCode) {
+		return false
+	}
+	if (r.ReasonReference == nil) != (other.ReasonReference == nil) || r.ReasonReference != nil && !r.ReasonReference.Equal(*other.ReasonReference) {
+		return false
+	}
+	if len(r.Insurance) != len(other.Insurance) {
+		return false
+	}
+	for i := range r.Insurance {
+		if !r.Insurance[i].Equal(other.Insurance[i]) {
+			return false
+		}
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	if len(r.RelevantHistory) != len(other.RelevantHistory) {
+		return false
+	}
+	for i := range r.RelevantHistory {
+		if !r.RelevantHistory[i].Equal(other.RelevantHistory[i]) {
+			return false
+		}
+	}
+	if (r.Restriction == nil) != (other.Restriction == nil) || r.Restriction != nil && !r.Restriction.Equal(*other.Restriction) {
+		return false
+	}
+	if len(r.Input) != len(other.Input) {
+		return false
+	}
+	for i := range r.Input {
+		if !r.Input[i].Equal(other.Input[i]) {
+			return false
+		}
+	}
+	if len(r.Output) != len(other.Output) {
+		return false
+	}
+	for i := range r.Output {
+		if !r.Output[i].Equal(other.Output[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r Task) EqualsDeep(other Task) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if (r.InstantiatesCanonical == nil) != (other.InstantiatesCanonical == nil) || r.InstantiatesCanonical != nil && !equivalentString(*r.InstantiatesCanonical, *other.InstantiatesCanonical) {
+		return false
+	}
+	if (r.InstantiatesCanonicalElement == nil) != (other.InstantiatesCanonicalElement == nil) || r.InstantiatesCanonicalElement != nil && !r.InstantiatesCanonicalElement.EqualsDeep(*other.InstantiatesCanonicalElement) {
+		return false
+	}
+	if (r.InstantiatesUri == nil) != (other.InstantiatesUri == nil) || r.InstantiatesUri != nil && !equivalentString(*r.InstantiatesUri, *other.InstantiatesUri) {
+		return false
+	}
+	if (r.InstantiatesUriElement == nil) != (other.InstantiatesUriElement == nil) || r.InstantiatesUriElement != nil && !r.InstantiatesUriElement.EqualsDeep(*other.InstantiatesUriElement) {
+		return false
+	}
+	if !equivalentList(len(r.BasedOn), len(other.BasedOn), func(i, j int) bool {
+		return r.BasedOn[i].EqualsDeep(other.BasedOn[j])
+	}) {
+		return false
+	}
+	if (r.GroupIdentifier == nil) != (other.GroupIdentifier == nil) || r.GroupIdentifier != nil && !r.GroupIdentifier.EqualsDeep(*other.GroupIdentifier) {
+		return false
+	}
+	if !equivalentList(len(r.PartOf), len(other.PartOf), func(i, j int) bool {
+		return r.PartOf[i].EqualsDeep(other.PartOf[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusReason == nil) != (other.StatusReason == nil) || r.StatusReason != nil && !r.StatusReason.EqualsDeep(*other.StatusReason) {
+		return false
+	}
+	if (r.BusinessStatus == nil) != (other.BusinessStatus == nil) || r.BusinessStatus != nil && !r.BusinessStatus.EqualsDeep(*other.BusinessStatus) {
+		return false
+	}
+	if !equivalentString(r.Intent, other.Intent) {
+		return false
+	}
+	if (r.IntentElement == nil) != (other.IntentElement == nil) || r.IntentElement != nil && !r.IntentElement.EqualsDeep(*other.IntentElement) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.PriorityElement == nil) != (other.PriorityElement == nil) || r.PriorityElement != nil && !r.PriorityElement.EqualsDeep(*other.PriorityElement) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !r.Code.EqualsDeep(*other.Code) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Focus == nil) != (other.Focus == nil) || r.Focus != nil && !r.Focus.EqualsDeep(*other.Focus) {
+		return false
+	}
+	if (r.For == nil) != (other.For == nil) || r.For != nil && !r.For.EqualsDeep(*other.For) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if (r.ExecutionPeriod == nil) != (other.ExecutionPeriod == nil) || r.ExecutionPeriod != nil && !r.ExecutionPeriod.EqualsDeep(*other.ExecutionPeriod) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	if (r.AuthoredOnElement == nil) != (other.AuthoredOnElement == nil) || r.AuthoredOnElement != nil && !r.AuthoredOnElement.EqualsDeep(*other.AuthoredOnElement) {
+		return false
+	}
+	if (r.LastModified == nil) != (other.LastModified == nil) || r.LastModified != nil && !equivalentString(*r.LastModified, *other.LastModified) {
+		return false
+	}
+	if (r.LastModifiedElement == nil) != (other.LastModifiedElement == nil) || r.LastModifiedElement != nil && !r.LastModifiedElement.EqualsDeep(*other.LastModifiedElement) {
+		return false
+	}
+	if (r.Requester == nil) != (other.Requester == nil) || r.Requester != nil && !r.Requester.EqualsDeep(*other.Requester) {
+		return false
+	}
+	if !equivalentList(len(r.PerformerType), len(other.PerformerType), func(i, j int) bool {
+		return r.PerformerType[i].EqualsDeep(other.PerformerType[j])
+	}) {
+		return false
+	}
+	if (r.Owner == nil) != (other.Owner == nil) || r.Owner != nil && !r.Owner.EqualsDeep(*other.Owner) {
+		return false
+	}
+	if (r.Location == nil) != (other.Location == nil) || r.Location != nil && !r.Location.EqualsDeep(*other.Location) {
+		return false
+	}
+	if (r.ReasonCode == nil) != (other.ReasonCode == nil) || r.ReasonCode != nil && !r.ReasonCode.EqualsDeep(*other.ReasonCode) {
+		return false
+	}
+	if (r.ReasonReference == nil) != (other.ReasonReference == nil) || r.ReasonReference != nil && !r.ReasonReference.EqualsDeep(*other.ReasonReference) {
+		return false
+	}
+	if !equivalentList(len(r.Insurance), len(other.Insurance), func(i, j int) bool {
+		return r.Insurance[i].EqualsDeep(other.Insurance[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.RelevantHistory), len(other.RelevantHistory), func(i, j int) bool {
+		return r.RelevantHistory[i].EqualsDeep(other.RelevantHistory[j])
+	}) {
+		return false
+	}
+	if (r.Restriction == nil) != (other.Restriction == nil) || r.Restriction != nil && !r.Restriction.EqualsDeep(*other.Restriction) {
+		return false
+	}
+	if !equivalentList(len(r.Input), len(other.Input), func(i, j int) bool {
+		return r.Input[i].EqualsDeep(other.Input[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Output), len(other.Output), func(i, j int) bool {
+		return r.Output[i].EqualsDeep(other.Output[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r Task) EqualsShallow(other Task) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.InstantiatesCanonical == nil) != (other.InstantiatesCanonical == nil) || r.InstantiatesCanonical != nil && !equivalentString(*r.InstantiatesCanonical, *other.InstantiatesCanonical) {
+		return false
+	}
+	if (r.InstantiatesUri == nil) != (other.InstantiatesUri == nil) || r.InstantiatesUri != nil && !equivalentString(*r.InstantiatesUri, *other.InstantiatesUri) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if !equivalentString(r.Intent, other.Intent) {
+		return false
+	}
+	if (r.Priority == nil) != (other.Priority == nil) || r.Priority != nil && *r.Priority != *other.Priority {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.AuthoredOn == nil) != (other.AuthoredOn == nil) || r.AuthoredOn != nil && !equivalentString(*r.AuthoredOn, *other.AuthoredOn) {
+		return false
+	}
+	if (r.LastModified == nil) != (other.LastModified == nil) || r.LastModified != nil && !equivalentString(*r.LastModified, *other.LastModified) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given Task as FHIR XML
+func (r Task) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "Task",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "instantiatesCanonical", r.InstantiatesCanonical, r.InstantiatesCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "instantiatesUri", r.InstantiatesUri, r.InstantiatesUriElement); err != nil {
+		return err
+	}
+	for _, v := range r.BasedOn {
+		if err := encodeXMLElement(e, "basedOn", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "groupIdentifier", r.GroupIdentifier); err != nil {
+		return err
+	}
+	for _, v := range r.PartOf {
+		if err := encodeXMLElement(e, "partOf", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "statusReason", r.StatusReason); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "businessStatus", r.BusinessStatus); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "intent", r.Intent, r.IntentElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "priority", r.Priority, r.PriorityElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "code", r.Code); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "focus", r.Focus); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "for", r.For); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "executionPeriod", r.ExecutionPeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "authoredOn", r.AuthoredOn, r.AuthoredOnElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastModified", r.LastModified, r.LastModifiedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "requester", r.Requester); err != nil {
+		return err
+	}
+	for _, v := range r.PerformerType {
+		if err := encodeXMLElement(e, "performerType", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "owner", r.Owner); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "location", r.Location); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reasonCode", r.ReasonCode); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "reasonReference", r.ReasonReference); err != nil {
+		return err
+	}
+	for _, v := range r.Insurance {
+		if err := encodeXMLElement(e, "insurance", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.RelevantHistory {
+		if err := encodeXMLElement(e, "relevantHistory", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "restriction", r.Restriction); err != nil {
+		return err
+	}
+	for _, v := range r.Input {
+		if err := encodeXMLElement(e, "input", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Output {
+		if err := encodeXMLElement(e, "output", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given Task from FHIR XML
+func (r *Task) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "instantiatesCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.InstantiatesCanonical = &v
+				}
+				r.InstantiatesCanonicalElement = element
+			case "instantiatesUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.InstantiatesUri = &v
+				}
+				r.InstantiatesUriElement = element
+			case "basedOn":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BasedOn = append(r.BasedOn, v)
+			case "groupIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.GroupIdentifier = &v
+			case "partOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PartOf = append(r.PartOf, v)
+			case "status":
+				var v TaskStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusReason":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.StatusReason = &v
+			case "businessStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.BusinessStatus = &v
+			case "intent":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Intent = v
+				}
+				r.IntentElement = element
+			case "priority":
+				var v RequestPriority
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Priority = &v
+				}
+				r.PriorityElement = element
+			case "code":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Code = &v
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "focus":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Focus = &v
+			case "for":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.For = &v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "executionPeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ExecutionPeriod = &v
+			case "authoredOn":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.AuthoredOn = &v
+				}
+				r.AuthoredOnElement = element
+			case "lastModified":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastModified = &v
+				}
+				r.LastModifiedElement = element
+			case "requester":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Requester = &v
+			case "performerType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PerformerType = append(r.PerformerType, v)
+			case "owner":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Owner = &v
+			case "location":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Location = &v
+			case "reasonCode":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonCode = &v
+			case "reasonReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ReasonReference = &v
+			case "insurance":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Insurance = append(r.Insurance, v)
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			case "relevantHistory":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RelevantHistory = append(r.RelevantHistory, v)
+			case "restriction":
+				var v TaskRestriction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Restriction = &v
+			case "input":
+				var v TaskInput
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Input = append(r.Input, v)
+			case "output":
+				var v TaskOutput
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Output = append(r.Output, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the Task as properties to the RDF node n
+func (r Task) turtle(n *turtleNode) {
+	n.resource("Task", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("Task.identifier", i, v)
+	}
+	n.primitive("Task.instantiatesCanonical", -1, r.InstantiatesCanonical, r.InstantiatesCanonicalElement, "string")
+	n.primitive("Task.instantiatesUri", -1, r.InstantiatesUri, r.InstantiatesUriElement, "string")
+	for i, v := range r.BasedOn {
+		n.element("Task.basedOn", i, v)
+	}
+	n.element("Task.groupIdentifier", -1, r.GroupIdentifier)
+	for i, v := range r.PartOf {
+		n.element("Task.partOf", i, v)
+	}
+	n.primitive("Task.status", -1, r.Status, r.StatusElement, "code")
+	n.element("Task.statusReason", -1, r.StatusReason)
+	n.element("Task.businessStatus", -1, r.BusinessStatus)
+	n.primitive("Task.intent", -1, r.Intent, r.IntentElement, "string")
+	n.primitive("Task.priority", -1, r.Priority, r.PriorityElement, "code")
+	n.element("Task.code", -1, r.Code)
+	n.primitive("Task.description", -1, r.Description, r.DescriptionElement, "string")
+	n.element("Task.focus", -1, r.Focus)
+	n.element("Task.for", -1, r.For)
+	n.element("Task.encounter", -1, r.Encounter)
+	n.element("Task.executionPeriod", -1, r.ExecutionPeriod)
+	n.primitive("Task.authoredOn", -1, r.AuthoredOn, r.AuthoredOnElement, "dateTime")
+	n.primitive("Task.lastModified", -1, r.LastModified, r.LastModifiedElement, "dateTime")
+	n.element("Task.requester", -1, r.Requester)
+	for i, v := range r.PerformerType {
+		n.element("Task.performerType", i, v)
+	}
+	n.element("Task.owner", -1, r.Owner)
+	n.element("Task.location", -1, r.Location)
+	n.element("Task.reasonCode", -1, r.ReasonCode)
+	n.element("Task.reasonReference", -1, r.ReasonReference)
+	for i, v := range r.Insurance {
+		n.element("Task.insurance", i, v)
+	}
+	for i, v := range r.Note {
+		n.element("Task.note", i, v)
+	}
+	for i, v := range r.RelevantHistory {
+		n.element("Task.relevantHistory", i, v)
+	}
+	n.element("Task.restriction", -1, r.Restriction)
+	for i, v := range r.Input {
+		n.element("Task.input", i, v)
+	}
+	for i, v := range r.Output {
+		n.element("Task.output", i, v)
+	}
+}
+
+// MarshalBSON marshals the given Task as BSON document with the structure of its FHIR JSON
+func (r Task) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given Task from a BSON document with the structure of its FHIR JSON
+func (r *Task) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given Task as protobuf message Task of fhir.proto
+func (r Task) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the Task to the encoded message
+func (r Task) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	if r.InstantiatesCanonical != nil {
+		e.string(10, *r.InstantiatesCanonical)
+	}
+	if r.InstantiatesUri != nil {
+		e.string(11, *r.InstantiatesUri)
+	}
+	for _, v := range r.BasedOn {
+		e.message(12, v)
+	}
+	if r.GroupIdentifier != nil {
+		e.message(13, *r.GroupIdentifier)
+	}
+	for _, v := range r.PartOf {
+		e.message(14, v)
+	}
+	e.varint(15, r.Status.protoNumber())
+	if r.StatusReason != nil {
+		e.message(16, *r.StatusReason)
+	}
+	if r.BusinessStatus != nil {
+		e.message(17, *r.BusinessStatus)
+	}
+	if r.Intent != "" {
+		e.string(18, r.Intent)
+	}
+	if r.Priority != nil {
+		e.varint(19, r.Priority.protoNumber())
+	}
+	if r.Code != nil {
+		e.message(20, *r.Code)
+	}
+	if r.Description != nil {
+		e.string(21, *r.Description)
+	}
+	if r.Focus != nil {
+		e.message(22, *r.Focus)
+	}
+	if r.For != nil {
+		e.message(23, *r.For)
+	}
+	if r.Encounter != nil {
+		e.message(24, *r.Encounter)
+	}
+	if r.ExecutionPeriod != nil {
+		e.message(25, *r.ExecutionPeriod)
+	}
+	if r.AuthoredOn != nil {
+		e.string(26, *r.AuthoredOn)
+	}
+	if r.LastModified != nil {
+		e.string(27, *r.LastModified)
+	}
+	if r.Requester != nil {
+		e.message(28, *r.Requester)
+	}
+	for _, v := range r.PerformerType {
+		e.message(29, v)
+	}
+	if r.Owner != nil {
+		e.message(30, *r.Owner)
+	}
+	if r.Location != nil {
+		e.message(31, *r.Location)
+	}
+	if r.ReasonCode != nil {
+		e.message(32, *r.ReasonCode)
+	}
+	if r.ReasonReference != nil {
+		e.message(33, *r.ReasonReference)
+	}
+	for _, v := range r.Insurance {
+		e.message(34, v)
+	}
+	for _, v := range r.Note {
+		e.message(35, v)
+	}
+	for _, v := range r.RelevantHistory {
+		e.message(36, v)
+	}
+	if r.Restriction != nil {
+		e.message(37, *r.Restriction)
+	}
+	for _, v := range r.Input {
+		e.message(38, v)
+	}
+	for _, v := range r.Output {
+		e.message(39, v)
+	}
+	if r.IdElement != nil {
+		e.message(40, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(41, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(42, *r.LanguageElement)
+	}
+	if r.InstantiatesCanonicalElement != nil {
+		e.message(43, *r.InstantiatesCanonicalElement)
+	}
+	if r.InstantiatesUriElement != nil {
+		e.message(44, *r.InstantiatesUriElement)
+	}
+	if r.StatusElement != nil {
+		e.message(45, *r.StatusElement)
+	}
+	if r.IntentElement != nil {
+		e.message(46, *r.IntentElement)
+	}
+	if r.PriorityElement != nil {
+		e.message(47, *r.PriorityElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(48, *r.DescriptionElement)
+	}
+	if r.AuthoredOnElement != nil {
+		e.message(49, *r.AuthoredOnElement)
+	}
+	if r.LastModifiedElement != nil {
+		e.message(50, *r.LastModifiedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given Task from protobuf message Task of fhir.proto
+func (r *Task) UnmarshalProto(b []byte) error {
+	*r = Task{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			v := d.string()
+			r.InstantiatesCanonical = &v
+		case 11:
+			v := d.string()
+			r.InstantiatesUri = &v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.BasedOn = append(r.BasedOn, v)
+		case 13:
+			var v Identifier
+			d.message(&v)
+			r.GroupIdentifier = &v
+		case 14:
+			var v Reference
+			d.message(&v)
+			r.PartOf = append(r.PartOf, v)
+		case 15:
+			var v TaskStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 16:
+			var v CodeableConcept
+			d.message(&v)
+			r.StatusReason = &v
+		case 17:
+			var v CodeableConcept
+			d.message(&v)
+			r.BusinessStatus = &v
+		case 18:
+			r.Intent = d.string()
+		case 19:
+			var v RequestPriority
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Priority = &v
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Code = &v
+		case 21:
+			v := d.string()
+			r.Description = &v
+		case 22:
+			var v Reference
+			d.message(&v)
+			r.Focus = &v
+		case 23:
+			var v Reference
+			d.message(&v)
+			r.For = &v
+		case 24:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 25:
+			var v Period
+			d.message(&v)
+			r.ExecutionPeriod = &v
+		case 26:
+			v := d.string()
+			r.AuthoredOn = &v
+		case 27:
+			v := d.string()
+			r.LastModified = &v
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Requester = &v
+		case 29:
+			var v CodeableConcept
+			d.message(&v)
+			r.PerformerType = append(r.PerformerType, v)
+		case 30:
+			var v Reference
+			d.message(&v)
+			r.Owner = &v
+		case 31:
+			var v Reference
+			d.message(&v)
+			r.Location = &v
+		case 32:
+			var v CodeableConcept
+			d.message(&v)
+			r.ReasonCode = &v
+		case 33:
+			var v Reference
+			d.message(&v)
+			r.ReasonReference = &v
+		case 34:
+			var v Reference
+			d.message(&v)
+			r.Insurance = append(r.Insurance, v)
+		case 35:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 36:
+			var v Reference
+			d.message(&v)
+			r.RelevantHistory = append(r.RelevantHistory, v)
+		case 37:
+			var v TaskRestriction
+			d.message(&v)
+			r.Restriction = &v
+		case 38:
+			var v TaskInput
+			d.message(&v)
+			r.Input = append(r.Input, v)
+		case 39:
+			var v TaskOutput
+			d.message(&v)
+			r.Output = append(r.Output, v)
+		case 40:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.InstantiatesCanonicalElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.InstantiatesUriElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.IntentElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.PriorityElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.AuthoredOnElement = &v
+		case 50:
+			var v Element
+			d.message(&v)
+			r.LastModifiedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TaskRestriction as JSON into a byte slice
+func (r TaskRestriction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TaskRestriction as JSON object with the members in the order of the fields
+func (r TaskRestriction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Repetitions != nil {
+		e.key("repetitions")
+		e.int(*r.Repetitions)
+	}
+	if r.RepetitionsElement != nil {
+		e.key("_repetitions")
+		r.RepetitionsElement.appendJSON(e)
+	}
+	if r.Period != nil {
+		e.key("period")
+		r.Period.appendJSON(e)
+	}
+	if len(r.Recipient) > 0 {
+		e.key("recipient")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Recipient {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TaskRestriction from JSON. Unknown members are ignored.
+func (r *TaskRestriction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TaskRestriction
+func (r *TaskRestriction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "repetitions":
+			if v, ok := d.int(); ok {
+				r.Repetitions = &v
+			} else {
+				r.Repetitions = nil
+			}
+		case "_repetitions":
+			r.RepetitionsElement = d.element()
+		case "period":
+			if d.null() {
+				r.Period = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.Period = &v
+			}
+		case "recipient":
+			a := d.array()
+			r.Recipient = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Recipient = append(r.Recipient, v)
+			}
+			if r.Recipient == nil && !a.isNull {
+				r.Recipient = []Reference{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TaskRestriction which shares no memory with the original
+func (r TaskRestriction) DeepCopy() TaskRestriction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Repetitions != nil {
+		v := *r.Repetitions
+		out.Repetitions = &v
+	}
+	if r.RepetitionsElement != nil {
+		v := r.RepetitionsElement.DeepCopy()
+		out.RepetitionsElement = &v
+	}
+	if r.Period != nil {
+		v := r.Period.DeepCopy()
+		out.Period = &v
+	}
+	if r.Recipient != nil {
+		out.Recipient = make([]Reference, len(r.Recipient))
+		for i := range r.Recipient {
+			out.Recipient[i] = r.Recipient[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TaskRestriction) Equal(other TaskRestriction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Repetitions == nil) != (other.Repetitions == nil) || r.Repetitions != nil && *r.Repetitions != *other.Repetitions {
+		return false
+	}
+	if (r.RepetitionsElement == nil) != (other.RepetitionsElement == nil) || r.RepetitionsElement != nil && !r.RepetitionsElement.Equal(*other.RepetitionsElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.Equal(*other.Period) {
+		return false
+	}
+	if len(r.Recipient) != len(other.Recipient) {
+		return false
+	}
+	for i := range r.Recipient {
+		if !r.Recipient[i].Equal(other.Recipient[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TaskRestriction) EqualsDeep(other TaskRestriction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Repetitions == nil) != (other.Repetitions == nil) || r.Repetitions != nil && *r.Repetitions != *other.Repetitions {
+		return false
+	}
+	if (r.RepetitionsElement == nil) != (other.RepetitionsElement == nil) || r.RepetitionsElement != nil && !r.RepetitionsElement.EqualsDeep(*other.RepetitionsElement) {
+		return false
+	}
+	if (r.Period == nil) != (other.Period == nil) || r.Period != nil && !r.Period.EqualsDeep(*other.Period) {
+		return false
+	}
+	if !equivalentList(len(r.Recipient), len(other.Recipient), func(i, j int) bool {
+		return r.Recipient[i].EqualsDeep(other.Recipient[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TaskRestriction) EqualsShallow(other TaskRestriction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Repetitions == nil) != (other.Repetitions == nil) || r.Repetitions != nil && *r.Repetitions != *other.Repetitions {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TaskRestriction as FHIR XML
+func (r TaskRestriction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "repetitions", r.Repetitions, r.RepetitionsElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "period", r.Period); err != nil {
+		return err
+	}
+	for _, v := range r.Recipient {
+		if err := encodeXMLElement(e, "recipient", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TaskRestriction from FHIR XML
+func (r *TaskRestriction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "repetitions":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Repetitions = &v
+				}
+				r.RepetitionsElement = element
+			case "period":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Period = &v
+			case "recipient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Recipient = append(r.Recipient, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TaskRestriction as properties to the RDF node n
+func (r TaskRestriction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("Task.restriction.repetitions", -1, r.Repetitions, r.RepetitionsElement, "positiveInt")
+	n.element("Task.restriction.period", -1, r.Period)
+	for i, v := range r.Recipient {
+		n.element("Task.restriction.recipient", i, v)
+	}
+}
+
+// MarshalBSON marshals the given TaskRestriction as BSON document with the structure of its FHIR JSON
+func (r TaskRestriction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TaskRestriction from a BSON document with the structure of its FHIR JSON
+func (r *TaskRestriction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TaskRestriction as protobuf message TaskRestriction of fhir.proto
+func (r TaskRestriction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TaskRestriction to the encoded message
+func (r TaskRestriction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Repetitions != nil {
+		e.varint(4, uint64(int64(*r.Repetitions)))
+	}
+	if r.Period != nil {
+		e.message(5, *r.Period)
+	}
+	for _, v := range r.Recipient {
+		e.message(6, v)
+	}
+	if r.RepetitionsElement != nil {
+		e.message(7, *r.RepetitionsElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TaskRestriction from protobuf message TaskRestriction of fhir.proto
+func (r *TaskRestriction) UnmarshalProto(b []byte) error {
+	*r = TaskRestriction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.int()
+			r.Repetitions = &v
+		case 5:
+			var v Period
+			d.message(&v)
+			r.Period = &v
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Recipient = append(r.Recipient, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.RepetitionsElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TaskInput as JSON into a byte slice
+func (r TaskInput) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TaskInput as JSON object with the members in the order of the fields
+func (r TaskInput) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.ValueBase64Binary != nil {
+		e.key("valueBase64Binary")
+		e.string(*r.ValueBase64Binary)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.key("_valueBase64Binary")
+		r.ValueBase64BinaryElement.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueCanonical != nil {
+		e.key("valueCanonical")
+		e.string(*r.ValueCanonical)
+	}
+	if r.ValueCanonicalElement != nil {
+		e.key("_valueCanonical")
+		r.ValueCanonicalElement.appendJSON(e)
+	}
+	if r.ValueCode != nil {
+		e.key("valueCode")
+		e.string(*r.ValueCode)
+	}
+	if r.ValueCodeElement != nil {
+		e.key("_valueCode")
+		r.ValueCodeElement.appendJSON(e)
+	}
+	if r.ValueDate != nil {
+		e.key("valueDate")
+		e.string(*r.ValueDate)
+	}
+	if r.ValueDateElement != nil {
+		e.key("_valueDate")
+		r.ValueDateElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValueDecimal != nil {
+		e.key("valueDecimal")
+		e.number(*r.ValueDecimal)
+	}
+	if r.ValueDecimalElement != nil {
+		e.key("_valueDecimal")
+		r.ValueDecimalElement.appendJSON(e)
+	}
+	if r.ValueId != nil {
+		e.key("valueId")
+		e.string(*r.ValueId)
+	}
+	if r.ValueIdElement != nil {
+		e.key("_valueId")
+		r.ValueIdElement.appendJSON(e)
+	}
+	if r.ValueInstant != nil {
+		e.key("valueInstant")
+		e.string(*r.ValueInstant)
+	}
+	if r.ValueInstantElement != nil {
+		e.key("_valueInstant")
+		r.ValueInstantElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueMarkdown != nil {
+		e.key("valueMarkdown")
+		e.string(*r.ValueMarkdown)
+	}
+	if r.ValueMarkdownElement != nil {
+		e.key("_valueMarkdown")
+		r.ValueMarkdownElement.appendJSON(e)
+	}
+	if r.ValueOid != nil {
+		e.key("valueOid")
+		e.string(*r.ValueOid)
+	}
+	if r.ValueOidElement != nil {
+		e.key("_valueOid")
+		r.ValueOidElement.appendJSON(e)
+	}
+	if r.ValuePositiveInt != nil {
+		e.key("valuePositiveInt")
+		e.int(*r.ValuePositiveInt)
+	}
+	if r.ValuePositiveIntElement != nil {
+		e.key("_valuePositiveInt")
+		r.ValuePositiveIntElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueTime != nil {
+		e.key("valueTime")
+		e.string(*r.ValueTime)
+	}
+	if r.ValueTimeElement != nil {
+		e.key("_valueTime")
+		r.ValueTimeElement.appendJSON(e)
+	}
+	if r.ValueUnsignedInt != nil {
+		e.key("valueUnsignedInt")
+		e.int(*r.ValueUnsignedInt)
+	}
+	if r.ValueUnsignedIntElement != nil {
+		e.key("_valueUnsignedInt")
+		r.ValueUnsignedIntElement.appendJSON(e)
+	}
+	if r.ValueUri != nil {
+		e.key("valueUri")
+		e.string(*r.ValueUri)
+	}
+	if r.ValueUriElement != nil {
+		e.key("_valueUri")
+		r.ValueUriElement.appendJSON(e)
+	}
+	if r.ValueUrl != nil {
+		e.key("valueUrl")
+		e.string(*r.ValueUrl)
+	}
+	if r.ValueUrlElement != nil {
+		e.key("_valueUrl")
+		r.ValueUrlElement.appendJSON(e)
+	}
+	if r.ValueUuid != nil {
+		e.key("valueUuid")
+		e.string(*r.ValueUuid)
+	}
+	if r.ValueUuidElement != nil {
+		e.key("_valueUuid")
+		r.ValueUuidElement.appendJSON(e)
+	}
+	if r.ValueAddress != nil {
+		e.key("valueAddress")
+		r.ValueAddress.appendJSON(e)
+	}
+	if r.ValueAge != nil {
+		e.key("valueAge")
+		r.ValueAge.appendJSON(e)
+	}
+	if r.ValueAnnotation != nil {
+		e.key("valueAnnotation")
+		r.ValueAnnotation.appendJSON(e)
+	}
+	if r.ValueAttachment != nil {
+		e.key("valueAttachment")
+		r.ValueAttachment.appendJSON(e)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.key("valueCodeableConcept")
+		r.ValueCodeableConcept.appendJSON(e)
+	}
+	if r.ValueCoding != nil {
+		e.key("valueCoding")
+		r.ValueCoding.appendJSON(e)
+	}
+	if r.ValueContactPoint != nil {
+		e.key("valueContactPoint")
+		r.ValueContactPoint.appendJSON(e)
+	}
+	if r.ValueCount != nil {
+		e.key("valueCount")
+		r.ValueCount.appendJSON(e)
+	}
+	if r.ValueDistance != nil {
+		e.key("valueDistance")
+		r.ValueDistance.appendJSON(e)
+	}
+	if r.ValueDuration != nil {
+		e.key("valueDuration")
+		r.ValueDuration.appendJSON(e)
+	}
+	if r.ValueHumanName != nil {
+		e.key("valueHumanName")
+		r.ValueHumanName.appendJSON(e)
+	}
+	if r.ValueIdentifier != nil {
+		e.key("valueIdentifier")
+		r.ValueIdentifier.appendJSON(e)
+	}
+	if r.ValueMoney != nil {
+		e.key("valueMoney")
+		r.ValueMoney.appendJSON(e)
+	}
+	if r.ValuePeriod != nil {
+		e.key("valuePeriod")
+		r.ValuePeriod.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueRange != nil {
+		e.key("valueRange")
+		r.ValueRange.appendJSON(e)
+	}
+	if r.ValueRatio != nil {
+		e.key("valueRatio")
+		r.ValueRatio.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	if r.ValueSampledData != nil {
+		e.key("valueSampledData")
+		r.ValueSampledData.appendJSON(e)
+	}
+	if r.ValueSignature != nil {
+		e.key("valueSignature")
+		r.ValueSignature.appendJSON(e)
+	}
+	if r.ValueTiming != nil {
+		e.key("valueTiming")
+		r.ValueTiming.appendJSON(e)
+	}
+	if r.ValueContactDetail != nil {
+		e.key("valueContactDetail")
+		r.ValueContactDetail.appendJSON(e)
+	}
+	if r.ValueContributor != nil {
+		e.key("valueContributor")
+		r.ValueContributor.appendJSON(e)
+	}
+	if r.ValueDataRequirement != nil {
+		e.key("valueDataRequirement")
+		r.ValueDataRequirement.appendJSON(e)
+	}
+	if r.ValueExpression != nil {
+		e.key("valueExpression")
+		r.ValueExpression.appendJSON(e)
+	}
+	if r.ValueParameterDefinition != nil {
+		e.key("valueParameterDefinition")
+		r.ValueParameterDefinition.appendJSON(e)
+	}
+	if r.ValueRelatedArtifact != nil {
+		e.key("valueRelatedArtifact")
+		r.ValueRelatedArtifact.appendJSON(e)
+	}
+	if r.ValueTriggerDefinition != nil {
+		e.key("valueTriggerDefinition")
+		r.ValueTriggerDefinition.appendJSON(e)
+	}
+	if r.ValueUsageContext != nil {
+		e.key("valueUsageContext")
+		r.ValueUsageContext.appendJSON(e)
+	}
+	if r.ValueDosage != nil {
+		e.key("valueDosage")
+		r.ValueDosage.appendJSON(e)
+	}
+	if r.ValueMeta != nil {
+		e.key("valueMeta")
+		r.ValueMeta.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TaskInput from JSON. Unknown members are ignored.
+func (r *TaskInput) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TaskInput
+func (r *TaskInput) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "valueBase64Binary":
+			if v, ok := d.string(); ok {
+				r.ValueBase64Binary = &v
+			} else {
+				r.ValueBase64Binary = nil
+			}
+		case "_valueBase64Binary":
+			r.ValueBase64BinaryElement = d.element()
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueCanonical":
+			if v, ok := d.string(); ok {
+				r.ValueCanonical = &v
+			} else {
+				r.ValueCanonical = nil
+			}
+		case "_valueCanonical":
+			r.ValueCanonicalElement = d.element()
+		case "valueCode":
+			if v, ok := d.string(); ok {
+				r.ValueCode = &v
+			} else {
+				r.ValueCode = nil
+			}
+		case "_valueCode":
+			r.ValueCodeElement = d.element()
+		case "valueDate":
+			if v, ok := d.string(); ok {
+				r.ValueDate = &v
+			} else {
+				r.ValueDate = nil
+			}
+		case "_valueDate":
+			r.ValueDateElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valueDecimal":
+			if v, ok := d.number(); ok {
+				r.ValueDecimal = &v
+			} else {
+				r.ValueDecimal = nil
+			}
+		case "_valueDecimal":
+			r.ValueDecimalElement = d.element()
+		case "valueId":
+			if v, ok := d.string(); ok {
+				r.ValueId = &v
+			} else {
+				r.ValueId = nil
+			}
+		case "_valueId":
+			r.ValueIdElement = d.element()
+		case "valueInstant":
+			if v, ok := d.string(); ok {
+				r.ValueInstant = &v
+			} else {
+				r.ValueInstant = nil
+			}
+		case "_valueInstant":
+			r.ValueInstantElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueMarkdown":
+			if v, ok := d.string(); ok {
+				r.ValueMarkdown = &v
+			} else {
+				r.ValueMarkdown = nil
+			}
+		case "_valueMarkdown":
+			r.ValueMarkdownElement = d.element()
+		case "valueOid":
+			if v, ok := d.string(); ok {
+				r.ValueOid = &v
+			} else {
+				r.ValueOid = nil
+			}
+		case "_valueOid":
+			r.ValueOidElement = d.element()
+		case "valuePositiveInt":
+			if v, ok := d.int(); ok {
+				r.ValuePositiveInt = &v
+			} else {
+				r.ValuePositiveInt = nil
+			}
+		case "_valuePositiveInt":
+			r.ValuePositiveIntElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueTime":
+			if v, ok := d.string(); ok {
+				r.ValueTime = &v
+			} else {
+				r.ValueTime = nil
+			}
+		case "_valueTime":
+			r.ValueTimeElement = d.element()
+		case "valueUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.ValueUnsignedInt = &v
+			} else {
+				r.ValueUnsignedInt = nil
+			}
+		case "_valueUnsignedInt":
+			r.ValueUnsignedIntElement = d.element()
+		case "valueUri":
+			if v, ok := d.string(); ok {
+				r.ValueUri = &v
+			} else {
+				r.ValueUri = nil
+			}
+		case "_valueUri":
+			r.ValueUriElement = d.element()
+		case "valueUrl":
+			if v, ok := d.string(); ok {
+				r.ValueUrl = &v
+			} else {
+				r.ValueUrl = nil
+			}
+		case "_valueUrl":
+			r.ValueUrlElement = d.element()
+		case "valueUuid":
+			if v, ok := d.string(); ok {
+				r.ValueUuid = &v
+			} else {
+				r.ValueUuid = nil
+			}
+		case "_valueUuid":
+			r.ValueUuidElement = d.element()
+		case "valueAddress":
+			if d.null() {
+				r.ValueAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.ValueAddress = &v
+			}
+		case "valueAge":
+			if d.null() {
+				r.ValueAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.ValueAge = &v
+			}
+		case "valueAnnotation":
+			if d.null() {
+				r.ValueAnnotation = nil
+			} else {
+				var v Annotation
+				v.decodeJSON(d)
+				r.ValueAnnotation = &v
+			}
+		case "valueAttachment":
+			if d.null() {
+				r.ValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ValueAttachment = &v
+			}
+		case "valueCodeableConcept":
+			if d.null() {
+				r.ValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCodeableConcept = &v
+			}
+		case "valueCoding":
+			if d.null() {
+				r.ValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.ValueCoding = &v
+			}
+		case "valueContactPoint":
+			if d.null() {
+				r.ValueContactPoint = nil
+			} else {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.ValueContactPoint = &v
+			}
+		case "valueCount":
+			if d.null() {
+				r.ValueCount = nil
+			} else {
+				var v Count
+				v.decodeJSON(d)
+				r.ValueCount = &v
+			}
+		case "valueDistance":
+			if d.null() {
+				r.ValueDistance = nil
+			} else {
+				var v Distance
+				v.decodeJSON(d)
+				r.ValueDistance = &v
+			}
+		case "valueDuration":
+			if d.null() {
+				r.ValueDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.ValueDuration = &v
+			}
+		case "valueHumanName":
+			if d.null() {
+				r.ValueHumanName = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.ValueHumanName = &v
+			}
+		case "valueIdentifier":
+			if d.null() {
+				r.ValueIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.ValueIdentifier = &v
+			}
+		case "valueMoney":
+			if d.null() {
+				r.ValueMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.ValueMoney = &v
+			}
+		case "valuePeriod":
+			if d.null() {
+				r.ValuePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValuePeriod = &v
+			}
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueRange":
+			if d.null() {
+				r.ValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.ValueRange = &v
+			}
+		case "valueRatio":
+			if d.null() {
+				r.ValueRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.ValueRatio = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		case "valueSampledData":
+			if d.null() {
+				r.ValueSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.ValueSampledData = &v
+			}
+		case "valueSignature":
+			if d.null() {
+				r.ValueSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.ValueSignature = &v
+			}
+		case "valueTiming":
+			if d.null() {
+				r.ValueTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.ValueTiming = &v
+			}
+		case "valueContactDetail":
+			if d.null() {
+				r.ValueContactDetail = nil
+			} else {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.ValueContactDetail = &v
+			}
+		case "valueContributor":
+			if d.null() {
+				r.ValueContributor = nil
+			} else {
+				var v Contributor
+				v.decodeJSON(d)
+				r.ValueContributor = &v
+			}
+		case "valueDataRequirement":
+			if d.null() {
+				r.ValueDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.ValueDataRequirement = &v
+			}
+		case "valueExpression":
+			if d.null() {
+				r.ValueExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.ValueExpression = &v
+			}
+		case "valueParameterDefinition":
+			if d.null() {
+				r.ValueParameterDefinition = nil
+			} else {
+				var v ParameterDefinition
+				v.decodeJSON(d)
+				r.ValueParameterDefinition = &v
+			}
+		case "valueRelatedArtifact":
+			if d.null() {
+				r.ValueRelatedArtifact = nil
+			} else {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.ValueRelatedArtifact = &v
+			}
+		case "valueTriggerDefinition":
+			if d.null() {
+				r.ValueTriggerDefinition = nil
+			} else {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.ValueTriggerDefinition = &v
+			}
+		case "valueUsageContext":
+			if d.null() {
+				r.ValueUsageContext = nil
+			} else {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.ValueUsageContext = &v
+			}
+		case "valueDosage":
+			if d.null() {
+				r.ValueDosage = nil
+			} else {
+				var v Dosage
+				v.decodeJSON(d)
+				r.ValueDosage = &v
+			}
+		case "valueMeta":
+			if d.null() {
+				r.ValueMeta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.ValueMeta = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TaskInput which shares no memory with the original
+func (r TaskInput) DeepCopy() TaskInput {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.ValueBase64Binary != nil {
+		v := *r.ValueBase64Binary
+		out.ValueBase64Binary = &v
+	}
+	if r.ValueBase64BinaryElement != nil {
+		v := r.ValueBase64BinaryElement.DeepCopy()
+		out.ValueBase64BinaryElement = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueCanonical != nil {
+		v := *r.ValueCanonical
+		out.ValueCanonical = &v
+	}
+	if r.ValueCanonicalElement != nil {
+		v := r.ValueCanonicalElement.DeepCopy()
+		out.ValueCanonicalElement = &v
+	}
+	if r.ValueCode != nil {
+		v := *r.ValueCode
+		out.ValueCode = &v
+	}
+	if r.ValueCodeElement != nil {
+		v := r.ValueCodeElement.DeepCopy()
+		out.ValueCodeElement = &v
+	}
+	if r.ValueDate != nil {
+		v := *r.ValueDate
+		out.ValueDate = &v
+	}
+	if r.ValueDateElement != nil {
+		v := r.ValueDateElement.DeepCopy()
+		out.ValueDateElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValueDecimal != nil {
+		v := *r.ValueDecimal
+		out.ValueDecimal = &v
+	}
+	if r.ValueDecimalElement != nil {
+		v := r.ValueDecimalElement.DeepCopy()
+		out.ValueDecimalElement = &v
+	}
+	if r.ValueId != nil {
+		v := *r.ValueId
+		out.ValueId = &v
+	}
+	if r.ValueIdElement != nil {
+		v := r.ValueIdElement.DeepCopy()
+		out.ValueIdElement = &v
+	}
+	if r.ValueInstant != nil {
+		v := *r.ValueInstant
+		out.ValueInstant = &v
+	}
+	if r.ValueInstantElement != nil {
+		v := r.ValueInstantElement.DeepCopy()
+		out.ValueInstantElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueMarkdown != nil {
+		v := *r.ValueMarkdown
+		out.ValueMarkdown = &v
+	}
+	if r.ValueMarkdownElement != nil {
+		v := r.ValueMarkdownElement.DeepCopy()
+		out.ValueMarkdownElement = &v
+	}
+	if r.ValueOid != nil {
+		v := *r.ValueOid
+		out.ValueOid = &v
+	}
+	if r.ValueOidElement != nil {
+		v := r.ValueOidElement.DeepCopy()
+		out.ValueOidElement = &v
+	}
+	if r.ValuePositiveInt != nil {
+		v := *r.ValuePositiveInt
+		out.ValuePositiveInt = &v
+	}
+	if r.ValuePositiveIntElement != nil {
+		v := r.ValuePositiveIntElement.DeepCopy()
+		out.ValuePositiveIntElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueTime != nil {
+		v := *r.ValueTime
+		out.ValueTime = &v
+	}
+	if r.ValueTimeElement != nil {
+		v := r.ValueTimeElement.DeepCopy()
+		out.ValueTimeElement = &v
+	}
+	if r.ValueUnsignedInt != nil {
+		v := *r.ValueUnsignedInt
+		out.ValueUnsignedInt = &v
+	}
+	if r.ValueUnsignedIntElement != nil {
+		v := r.ValueUnsignedIntElement.DeepCopy()
+		out.ValueUnsignedIntElement = &v
+	}
+	if r.ValueUri != nil {
+		v := *r.ValueUri
+		out.ValueUri = &v
+	}
+	if r.ValueUriElement != nil {
+		v := r.ValueUriElement.DeepCopy()
+		out.ValueUriElement = &v
+	}
+	if r.ValueUrl != nil {
+		v := *r.ValueUrl
+		out.ValueUrl = &v
+	}
+	if r.ValueUrlElement != nil {
+		v := r.ValueUrlElement.DeepCopy()
+		out.ValueUrlElement = &v
+	}
+	if r.ValueUuid != nil {
+		v := *r.ValueUuid
+		out.ValueUuid = &v
+	}
+	if r.ValueUuidElement != nil {
+		v := r.ValueUuidElement.DeepCopy()
+		out.ValueUuidElement = &v
+	}
+	if r.ValueAddress != nil {
+		v := r.ValueAddress.DeepCopy()
+		out.ValueAddress = &v
+	}
+	if r.ValueAge != nil {
+		v := r.ValueAge.DeepCopy()
+		out.ValueAge = &v
+	}
+	if r.ValueAnnotation != nil {
+		v := r.ValueAnnotation.DeepCopy()
+		out.ValueAnnotation = &v
+	}
+	if r.ValueAttachment != nil {
+		v := r.ValueAttachment.DeepCopy()
+		out.ValueAttachment = &v
+	}
+	if r.ValueCodeableConcept != nil {
+		v := r.ValueCodeableConcept.DeepCopy()
+		out.ValueCodeableConcept = &v
+	}
+	if r.ValueCoding != nil {
+		v := r.ValueCoding.DeepCopy()
+		out.ValueCoding = &v
+	}
+	if r.ValueContactPoint != nil {
+		v := r.ValueContactPoint.DeepCopy()
+		out.ValueContactPoint = &v
+	}
+	if r.ValueCount != nil {
+		v := r.ValueCount.DeepCopy()
+		out.ValueCount = &v
+	}
+	if r.ValueDistance != nil {
+		v := r.ValueDistance.DeepCopy()
+		out.ValueDistance = &v
+	}
+	if r.ValueDuration != nil {
+		v := r.ValueDuration.DeepCopy()
+		out.ValueDuration = &v
+	}
+	if r.ValueHumanName != nil {
+		v := r.ValueHumanName.DeepCopy()
+		out.ValueHumanName = &v
+	}
+	if r.ValueIdentifier != nil {
+		v := r.ValueIdentifier.DeepCopy()
+		out.ValueIdentifier = &v
+	}
+	if r.ValueMoney != nil {
+		v := r.ValueMoney.DeepCopy()
+		out.ValueMoney = &v
+	}
+	if r.ValuePeriod != nil {
+		v := r.ValuePeriod.DeepCopy()
+		out.ValuePeriod = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueRange != nil {
+		v := r.ValueRange.DeepCopy()
+		out.ValueRange = &v
+	}
+	if r.ValueRatio != nil {
+		v := r.ValueRatio.DeepCopy()
+		out.ValueRatio = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	if r.ValueSampledData != nil {
+		v := r.ValueSampledData.DeepCopy()
+		out.ValueSampledData = &v
+	}
+	if r.ValueSignature != nil {
+		v := r.ValueSignature.DeepCopy()
+		out.ValueSignature = &v
+	}
+	if r.ValueTiming != nil {
+		v := r.ValueTiming.DeepCopy()
+		out.ValueTiming = &v
+	}
+	if r.ValueContactDetail != nil {
+		v := r.ValueContactDetail.DeepCopy()
+		out.ValueContactDetail = &v
+	}
+	if r.ValueContributor != nil {
+		v := r.ValueContributor.DeepCopy()
+		out.ValueContributor = &v
+	}
+	if r.ValueDataRequirement != nil {
+		v := r.ValueDataRequirement.DeepCopy()
+		out.ValueDataRequirement = &v
+	}
+	if r.ValueExpression != nil {
+		v := r.ValueExpression.DeepCopy()
+		out.ValueExpression = &v
+	}
+	if r.ValueParameterDefinition != nil {
+		v := r.ValueParameterDefinition.DeepCopy()
+		out.ValueParameterDefinition = &v
+	}
+	if r.ValueRelatedArtifact != nil {
+		v := r.ValueRelatedArtifact.DeepCopy()
+		out.ValueRelatedArtifact = &v
+	}
+	if r.ValueTriggerDefinition != nil {
+		v := r.ValueTriggerDefinition.DeepCopy()
+		out.ValueTriggerDefinition = &v
+	}
+	if r.ValueUsageContext != nil {
+		v := r.ValueUsageContext.DeepCopy()
+		out.ValueUsageContext = &v
+	}
+	if r.ValueDosage != nil {
+		v := r.ValueDosage.DeepCopy()
+		out.ValueDosage = &v
+	}
+	if r.ValueMeta != nil {
+		v := r.ValueMeta.DeepCopy()
+		out.ValueMeta = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TaskInput) Equal(other TaskInput) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && *r.ValueBase64Binary != *other.ValueBase64Binary {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.Equal(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && *r.ValueCanonical != *other.ValueCanonical {
+		return false
+	}
+	if (r.ValueCanonicalElement == nil) != (other.ValueCanonicalElement == nil) || r.ValueCanonicalElement != nil && !r.ValueCanonicalElement.Equal(*other.ValueCanonicalElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && *r.ValueCode != *other.ValueCode {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.Equal(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && *r.ValueDate != *other.ValueDate {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.Equal(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !EqualDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.Equal(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && *r.ValueId != *other.ValueId {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.Equal(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && *r.ValueInstant != *other.ValueInstant {
+		return false
+	}
+	if (r.ValueInstantElement == nil) != (other.ValueInstantElement == nil) || r.ValueInstantElement != nil && !r.ValueInstantElement.Equal(*other.ValueInstantElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && *r.ValueMarkdown != *other.ValueMarkdown {
+		return false
+	}
+	if (r.ValueMarkdownElement == nil) != (other.ValueMarkdownElement == nil) || r.ValueMarkdownElement != nil && !r.ValueMarkdownElement.Equal(*other.ValueMarkdownElement) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && *r.ValueOid != *other.ValueOid {
+		return false
+	}
+	if (r.ValueOidElement == nil) != (other.ValueOidElement == nil) || r.ValueOidElement != nil && !r.ValueOidElement.Equal(*other.ValueOidElement) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValuePositiveIntElement == nil) != (other.ValuePositiveIntElement == nil) || r.ValuePositiveIntElement != nil && !r.ValuePositiveIntElement.Equal(*other.ValuePositiveIntElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && *r.ValueTime != *other.ValueTime {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.Equal(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUnsignedIntElement == nil) != (other.ValueUnsignedIntElement == nil) || r.ValueUnsignedIntElement != nil && !r.ValueUnsignedIntElement.Equal(*other.ValueUnsignedIntElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && *r.ValueUri != *other.ValueUri {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.Equal(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && *r.ValueUrl != *other.ValueUrl {
+		return false
+	}
+	if (r.ValueUrlElement == nil) != (other.ValueUrlElement == nil) || r.ValueUrlElement != nil && !r.ValueUrlElement.Equal(*other.ValueUrlElement) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && *r.ValueUuid != *other.ValueUuid {
+		return false
+	}
+	if (r.ValueUuidElement == nil) != (other.ValueUuidElement == nil) || r.ValueUuidElement != nil && !r.ValueUuidElement.Equal(*other.ValueUuidElement) {
+		return false
+	}
+	if (r.ValueAddress == nil) != (other.ValueAddress == nil) || r.ValueAddress != nil && !r.ValueAddress.Equal(*other.ValueAddress) {
+		return false
+	}
+	if (r.ValueAge == nil) != (other.ValueAge == nil) || r.ValueAge != nil && !r.ValueAge.Equal(*other.ValueAge) {
+		return false
+	}
+	if (r.ValueAnnotation == nil) != (other.ValueAnnotation == nil) || r.ValueAnnotation != nil && !r.ValueAnnotation.Equal(*other.ValueAnnotation) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.Equal(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.Equal(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.Equal(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueContactPoint == nil) != (other.ValueContactPoint == nil) || r.ValueContactPoint != nil && !r.ValueContactPoint.Equal(*other.ValueContactPoint) {
+		return false
+	}
+	if (r.ValueCount == nil) != (other.ValueCount == nil) || r.ValueCount != nil && !r.ValueCount.Equal(*other.ValueCount) {
+		return false
+	}
+	if (r.ValueDistance == nil) != (other.ValueDistance == nil) || r.ValueDistance != nil && !r.ValueDistance.Equal(*other.ValueDistance) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.Equal(*other.ValueDuration) {
+		return false
+	}
+	if (r.ValueHumanName == nil) != (other.ValueHumanName == nil) || r.ValueHumanName != nil && !r.ValueHumanName.Equal(*other.ValueHumanName) {
+		return false
+	}
+	if (r.ValueIdentifier == nil) != (other.ValueIdentifier == nil) || r.ValueIdentifier != nil && !r.ValueIdentifier.Equal(*other.ValueIdentifier) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.Equal(*other.ValueMoney) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.Equal(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.Equal(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.Equal(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.Equal(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueSignature == nil) != (other.ValueSignature == nil) || r.ValueSignature != nil && !r.ValueSignature.Equal(*other.ValueSignature) {
+		return false
+	}
+	if (r.ValueTiming == nil) != (other.ValueTiming == nil) || r.ValueTiming != nil && !r.ValueTiming.Equal(*other.ValueTiming) {
+		return false
+	}
+	if (r.ValueContactDetail == nil) != (other.ValueContactDetail == nil) || r.ValueContactDetail != nil && !r.ValueContactDetail.Equal(*other.ValueContactDetail) {
+		return false
+	}
+	if (r.ValueContributor == nil) != (other.ValueContributor == nil) || r.ValueContributor != nil && !r.ValueContributor.Equal(*other.ValueContributor) {
+		return false
+	}
+	if (r.ValueDataRequirement == nil) != (other.ValueDataRequirement == nil) || r.ValueDataRequirement != nil && !r.ValueDataRequirement.Equal(*other.ValueDataRequirement) {
+		return false
+	}
+	if (r.ValueExpression == nil) != (other.ValueExpression == nil) || r.ValueExpression != nil && !r.ValueExpression.Equal(*other.ValueExpression) {
+		return false
+	}
+	if (r.ValueParameterDefinition == nil) != (other.ValueParameterDefinition == nil) || r.ValueParameterDefinition != nil && !r.ValueParameterDefinition.Equal(*other.ValueParameterDefinition) {
+		return false
+	}
+	if (r.ValueRelatedArtifact == nil) != (other.ValueRelatedArtifact == nil) || r.ValueRelatedArtifact != nil && !r.ValueRelatedArtifact.Equal(*other.ValueRelatedArtifact) {
+		return false
+	}
+	if (r.ValueTriggerDefinition == nil) != (other.ValueTriggerDefinition == nil) || r.ValueTriggerDefinition != nil && !r.ValueTriggerDefinition.Equal(*other.ValueTriggerDefinition) {
+		return false
+	}
+	if (r.ValueUsageContext == nil) != (other.ValueUsageContext == nil) || r.ValueUsageContext != nil && !r.ValueUsageContext.Equal(*other.ValueUsageContext) {
+		return false
+	}
+	if (r.ValueDosage == nil) != (other.ValueDosage == nil) || r.ValueDosage != nil && !r.ValueDosage.Equal(*other.ValueDosage) {
+		return false
+	}
+	if (r.ValueMeta == nil) != (other.ValueMeta == nil) || r.ValueMeta != nil && !r.ValueMeta.Equal(*other.ValueMeta) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TaskInput) EqualsDeep(other TaskInput) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.EqualsDeep(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && !equivalentString(*r.ValueCanonical, *other.ValueCanonical) {
+		return false
+	}
+	if (r.ValueCanonicalElement == nil) != (other.ValueCanonicalElement == nil) || r.ValueCanonicalElement != nil && !r.ValueCanonicalElement.EqualsDeep(*other.ValueCanonicalElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.EqualsDeep(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.EqualsDeep(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.EqualsDeep(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.EqualsDeep(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && !equivalentString(*r.ValueInstant, *other.ValueInstant) {
+		return false
+	}
+	if (r.ValueInstantElement == nil) != (other.ValueInstantElement == nil) || r.ValueInstantElement != nil && !r.ValueInstantElement.EqualsDeep(*other.ValueInstantElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && !equivalentString(*r.ValueMarkdown, *other.ValueMarkdown) {
+		return false
+	}
+	if (r.ValueMarkdownElement == nil) != (other.ValueMarkdownElement == nil) || r.ValueMarkdownElement != nil && !r.ValueMarkdownElement.EqualsDeep(*other.ValueMarkdownElement) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && !equivalentString(*r.ValueOid, *other.ValueOid) {
+		return false
+	}
+	if (r.ValueOidElement == nil) != (other.ValueOidElement == nil) || r.ValueOidElement != nil && !r.ValueOidElement.EqualsDeep(*other.ValueOidElement) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValuePositiveIntElement == nil) != (other.ValuePositiveIntElement == nil) || r.ValuePositiveIntElement != nil && !r.ValuePositiveIntElement.EqualsDeep(*other.ValuePositiveIntElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.EqualsDeep(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUnsignedIntElement == nil) != (other.ValueUnsignedIntElement == nil) || r.ValueUnsignedIntElement != nil && !r.ValueUnsignedIntElement.EqualsDeep(*other.ValueUnsignedIntElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.EqualsDeep(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && !equivalentString(*r.ValueUrl, *other.ValueUrl) {
+		return false
+	}
+	if (r.ValueUrlElement == nil) != (other.ValueUrlElement == nil) || r.ValueUrlElement != nil && !r.ValueUrlElement.EqualsDeep(*other.ValueUrlElement) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && !equivalentString(*r.ValueUuid, *other.ValueUuid) {
+		return false
+	}
+	if (r.ValueUuidElement == nil) != (other.ValueUuidElement == nil) || r.ValueUuidElement != nil && !r.ValueUuidElement.EqualsDeep(*other.ValueUuidElement) {
+		return false
+	}
+	if (r.ValueAddress == nil) != (other.ValueAddress == nil) || r.ValueAddress != nil && !r.ValueAddress.EqualsDeep(*other.ValueAddress) {
+		return false
+	}
+	if (r.ValueAge == nil) != (other.ValueAge == nil) || r.ValueAge != nil && !r.ValueAge.EqualsDeep(*other.ValueAge) {
+		return false
+	}
+	if (r.ValueAnnotation == nil) != (other.ValueAnnotation == nil) || r.ValueAnnotation != nil && !r.ValueAnnotation.EqualsDeep(*other.ValueAnnotation) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.EqualsDeep(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.EqualsDeep(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.EqualsDeep(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueContactPoint == nil) != (other.ValueContactPoint == nil) || r.ValueContactPoint != nil && !r.ValueContactPoint.EqualsDeep(*other.ValueContactPoint) {
+		return false
+	}
+	if (r.ValueCount == nil) != (other.ValueCount == nil) || r.ValueCount != nil && !r.ValueCount.EqualsDeep(*other.ValueCount) {
+		return false
+	}
+	if (r.ValueDistance == nil) != (other.ValueDistance == nil) || r.ValueDistance != nil && !r.ValueDistance.EqualsDeep(*other.ValueDistance) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.EqualsDeep(*other.ValueDuration) {
+		return false
+	}
+	if (r.ValueHumanName == nil) != (other.ValueHumanName == nil) || r.ValueHumanName != nil && !r.ValueHumanName.EqualsDeep(*other.ValueHumanName) {
+		return false
+	}
+	if (r.ValueIdentifier == nil) != (other.ValueIdentifier == nil) || r.ValueIdentifier != nil && !r.ValueIdentifier.EqualsDeep(*other.ValueIdentifier) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.EqualsDeep(*other.ValueMoney) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.EqualsDeep(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.EqualsDeep(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.EqualsDeep(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.EqualsDeep(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueSignature == nil) != (other.ValueSignature == nil) || r.ValueSignature != nil && !r.ValueSignature.EqualsDeep(*other.ValueSignature) {
+		return false
+	}
+	if (r.ValueTiming == nil) != (other.ValueTiming == nil) || r.ValueTiming != nil && !r.ValueTiming.EqualsDeep(*other.ValueTiming) {
+		return false
+	}
+	if (r.ValueContactDetail == nil) != (other.ValueContactDetail == nil) || r.ValueContactDetail != nil && !r.ValueContactDetail.EqualsDeep(*other.ValueContactDetail) {
+		return false
+	}
+	if (r.ValueContributor == nil) != (other.ValueContributor == nil) || r.ValueContributor != nil && !r.ValueContributor.EqualsDeep(*other.ValueContributor) {
+		return false
+	}
+	if (r.ValueDataRequirement == nil) != (other.ValueDataRequirement == nil) || r.ValueDataRequirement != nil && !r.ValueDataRequirement.EqualsDeep(*other.ValueDataRequirement) {
+		return false
+	}
+	if (r.ValueExpression == nil) != (other.ValueExpression == nil) || r.ValueExpression != nil && !r.ValueExpression.EqualsDeep(*other.ValueExpression) {
+		return false
+	}
+	if (r.ValueParameterDefinition == nil) != (other.ValueParameterDefinition == nil) || r.ValueParameterDefinition != nil && !r.ValueParameterDefinition.EqualsDeep(*other.ValueParameterDefinition) {
+		return false
+	}
+	if (r.ValueRelatedArtifact == nil) != (other.ValueRelatedArtifact == nil) || r.ValueRelatedArtifact != nil && !r.ValueRelatedArtifact.EqualsDeep(*other.ValueRelatedArtifact) {
+		return false
+	}
+	if (r.ValueTriggerDefinition == nil) != (other.ValueTriggerDefinition == nil) || r.ValueTriggerDefinition != nil && !r.ValueTriggerDefinition.EqualsDeep(*other.ValueTriggerDefinition) {
+		return false
+	}
+	if (r.ValueUsageContext == nil) != (other.ValueUsageContext == nil) || r.ValueUsageContext != nil && !r.ValueUsageContext.EqualsDeep(*other.ValueUsageContext) {
+		return false
+	}
+	if (r.ValueDosage == nil) != (other.ValueDosage == nil) || r.ValueDosage != nil && !r.ValueDosage.EqualsDeep(*other.ValueDosage) {
+		return false
+	}
+	if (r.ValueMeta == nil) != (other.ValueMeta == nil) || r.ValueMeta != nil && !r.ValueMeta.EqualsDeep(*other.ValueMeta) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TaskInput) EqualsShallow(other TaskInput) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && !equivalentString(*r.ValueCanonical, *other.ValueCanonical) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && !equivalentString(*r.ValueInstant, *other.ValueInstant) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && !equivalentString(*r.ValueMarkdown, *other.ValueMarkdown) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && !equivalentString(*r.ValueOid, *other.ValueOid) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && !equivalentString(*r.ValueUrl, *other.ValueUrl) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && !equivalentString(*r.ValueUuid, *other.ValueUuid) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TaskInput as FHIR XML
+func (r TaskInput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBase64Binary", r.ValueBase64Binary, r.ValueBase64BinaryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCanonical", r.ValueCanonical, r.ValueCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCode", r.ValueCode, r.ValueCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDate", r.ValueDate, r.ValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDecimal", r.ValueDecimal, r.ValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueId", r.ValueId, r.ValueIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInstant", r.ValueInstant, r.ValueInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueMarkdown", r.ValueMarkdown, r.ValueMarkdownElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueOid", r.ValueOid, r.ValueOidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valuePositiveInt", r.ValuePositiveInt, r.ValuePositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueTime", r.ValueTime, r.ValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUnsignedInt", r.ValueUnsignedInt, r.ValueUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUri", r.ValueUri, r.ValueUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUrl", r.ValueUrl, r.ValueUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUuid", r.ValueUuid, r.ValueUuidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAddress", r.ValueAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAge", r.ValueAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAnnotation", r.ValueAnnotation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAttachment", r.ValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCodeableConcept", r.ValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCoding", r.ValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContactPoint", r.ValueContactPoint); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCount", r.ValueCount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDistance", r.ValueDistance); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDuration", r.ValueDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueHumanName", r.ValueHumanName); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueIdentifier", r.ValueIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueMoney", r.ValueMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valuePeriod", r.ValuePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRange", r.ValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRatio", r.ValueRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSampledData", r.ValueSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSignature", r.ValueSignature); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueTiming", r.ValueTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContactDetail", r.ValueContactDetail); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContributor", r.ValueContributor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDataRequirement", r.ValueDataRequirement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueExpression", r.ValueExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueParameterDefinition", r.ValueParameterDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRelatedArtifact", r.ValueRelatedArtifact); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueTriggerDefinition", r.ValueTriggerDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueUsageContext", r.ValueUsageContext); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDosage", r.ValueDosage); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueMeta", r.ValueMeta); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TaskInput from FHIR XML
+func (r *TaskInput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "valueBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBase64Binary = &v
+				}
+				r.ValueBase64BinaryElement = element
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCanonical = &v
+				}
+				r.ValueCanonicalElement = element
+			case "valueCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCode = &v
+				}
+				r.ValueCodeElement = element
+			case "valueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDate = &v
+				}
+				r.ValueDateElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDecimal = &v
+				}
+				r.ValueDecimalElement = element
+			case "valueId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueId = &v
+				}
+				r.ValueIdElement = element
+			case "valueInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInstant = &v
+				}
+				r.ValueInstantElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueMarkdown":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueMarkdown = &v
+				}
+				r.ValueMarkdownElement = element
+			case "valueOid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueOid = &v
+				}
+				r.ValueOidElement = element
+			case "valuePositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValuePositiveInt = &v
+				}
+				r.ValuePositiveIntElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueTime = &v
+				}
+				r.ValueTimeElement = element
+			case "valueUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUnsignedInt = &v
+				}
+				r.ValueUnsignedIntElement = element
+			case "valueUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUri = &v
+				}
+				r.ValueUriElement = element
+			case "valueUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUrl = &v
+				}
+				r.ValueUrlElement = element
+			case "valueUuid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUuid = &v
+				}
+				r.ValueUuidElement = element
+			case "valueAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAddress = &v
+			case "valueAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAge = &v
+			case "valueAnnotation":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAnnotation = &v
+			case "valueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAttachment = &v
+			case "valueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCodeableConcept = &v
+			case "valueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCoding = &v
+			case "valueContactPoint":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContactPoint = &v
+			case "valueCount":
+				var v Count
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCount = &v
+			case "valueDistance":
+				var v Distance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDistance = &v
+			case "valueDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDuration = &v
+			case "valueHumanName":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueHumanName = &v
+			case "valueIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueIdentifier = &v
+			case "valueMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueMoney = &v
+			case "valuePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValuePeriod = &v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRange = &v
+			case "valueRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRatio = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			case "valueSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSampledData = &v
+			case "valueSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSignature = &v
+			case "valueTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueTiming = &v
+			case "valueContactDetail":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContactDetail = &v
+			case "valueContributor":
+				var v Contributor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContributor = &v
+			case "valueDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDataRequirement = &v
+			case "valueExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueExpression = &v
+			case "valueParameterDefinition":
+				var v ParameterDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueParameterDefinition = &v
+			case "valueRelatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRelatedArtifact = &v
+			case "valueTriggerDefinition":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueTriggerDefinition = &v
+			case "valueUsageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueUsageContext = &v
+			case "valueDosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDosage = &v
+			case "valueMeta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueMeta = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TaskInput as properties to the RDF node n
+func (r TaskInput) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Task.input.type", -1, r.Type)
+	n.primitive("Task.input.valueBase64Binary", -1, r.ValueBase64Binary, r.ValueBase64BinaryElement, "base64Binary")
+	n.primitive("Task.input.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("Task.input.valueCanonical", -1, r.ValueCanonical, r.ValueCanonicalElement, "canonical")
+	n.primitive("Task.input.valueCode", -1, r.ValueCode, r.ValueCodeElement, "code")
+	n.primitive("Task.input.valueDate", -1, r.ValueDate, r.ValueDateElement, "date")
+	n.primitive("Task.input.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.primitive("Task.input.valueDecimal", -1, r.ValueDecimal, r.ValueDecimalElement, "decimal")
+	n.primitive("Task.input.valueId", -1, r.ValueId, r.ValueIdElement, "id")
+	n.primitive("Task.input.valueInstant", -1, r.ValueInstant, r.ValueInstantElement, "instant")
+	n.primitive("Task.input.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("Task.input.valueMarkdown", -1, r.ValueMarkdown, r.ValueMarkdownElement, "markdown")
+	n.primitive("Task.input.valueOid", -1, r.ValueOid, r.ValueOidElement, "oid")
+	n.primitive("Task.input.valuePositiveInt", -1, r.ValuePositiveInt, r.ValuePositiveIntElement, "positiveInt")
+	n.primitive("Task.input.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("Task.input.valueTime", -1, r.ValueTime, r.ValueTimeElement, "time")
+	n.primitive("Task.input.valueUnsignedInt", -1, r.ValueUnsignedInt, r.ValueUnsignedIntElement, "unsignedInt")
+	n.primitive("Task.input.valueUri", -1, r.ValueUri, r.ValueUriElement, "uri")
+	n.primitive("Task.input.valueUrl", -1, r.ValueUrl, r.ValueUrlElement, "url")
+	n.primitive("Task.input.valueUuid", -1, r.ValueUuid, r.ValueUuidElement, "uuid")
+	n.element("Task.input.valueAddress", -1, r.ValueAddress)
+	n.element("Task.input.valueAge", -1, r.ValueAge)
+	n.element("Task.input.valueAnnotation", -1, r.ValueAnnotation)
+	n.element("Task.input.valueAttachment", -1, r.ValueAttachment)
+	n.element("Task.input.valueCodeableConcept", -1, r.ValueCodeableConcept)
+	n.element("Task.input.valueCoding", -1, r.ValueCoding)
+	n.element("Task.input.valueContactPoint", -1, r.ValueContactPoint)
+	n.element("Task.input.valueCount", -1, r.ValueCount)
+	n.element("Task.input.valueDistance", -1, r.ValueDistance)
+	n.element("Task.input.valueDuration", -1, r.ValueDuration)
+	n.element("Task.input.valueHumanName", -1, r.ValueHumanName)
+	n.element("Task.input.valueIdentifier", -1, r.ValueIdentifier)
+	n.element("Task.input.valueMoney", -1, r.ValueMoney)
+	n.element("Task.input.valuePeriod", -1, r.ValuePeriod)
+	n.element("Task.input.valueQuantity", -1, r.ValueQuantity)
+	n.element("Task.input.valueRange", -1, r.ValueRange)
+	n.element("Task.input.valueRatio", -1, r.ValueRatio)
+	n.element("Task.input.valueReference", -1, r.ValueReference)
+	n.element("Task.input.valueSampledData", -1, r.ValueSampledData)
+	n.element("Task.input.valueSignature", -1, r.ValueSignature)
+	n.element("Task.input.valueTiming", -1, r.ValueTiming)
+	n.element("Task.input.valueContactDetail", -1, r.ValueContactDetail)
+	n.element("Task.input.valueContributor", -1, r.ValueContributor)
+	n.element("Task.input.valueDataRequirement", -1, r.ValueDataRequirement)
+	n.element("Task.input.valueExpression", -1, r.ValueExpression)
+	n.element("Task.input.valueParameterDefinition", -1, r.ValueParameterDefinition)
+	n.element("Task.input.valueRelatedArtifact", -1, r.ValueRelatedArtifact)
+	n.element("Task.input.valueTriggerDefinition", -1, r.ValueTriggerDefinition)
+	n.element("Task.input.valueUsageContext", -1, r.ValueUsageContext)
+	n.element("Task.input.valueDosage", -1, r.ValueDosage)
+	n.element("Task.input.valueMeta", -1, r.ValueMeta)
+}
+
+// MarshalBSON marshals the given TaskInput as BSON document with the structure of its FHIR JSON
+func (r TaskInput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TaskInput from a BSON document with the structure of its FHIR JSON
+func (r *TaskInput) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TaskInput as protobuf message TaskInput of fhir.proto
+func (r TaskInput) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TaskInput to the encoded message
+func (r TaskInput) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.ValueBase64Binary != nil {
+		e.string(5, *r.ValueBase64Binary)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(6, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueCanonical != nil {
+		e.string(7, *r.ValueCanonical)
+	}
+	if r.ValueCode != nil {
+		e.string(8, *r.ValueCode)
+	}
+	if r.ValueDate != nil {
+		e.string(9, *r.ValueDate)
+	}
+	if r.ValueDateTime != nil {
+		e.string(10, *r.ValueDateTime)
+	}
+	if r.ValueDecimal != nil {
+		e.string(11, string(*r.ValueDecimal))
+	}
+	if r.ValueId != nil {
+		e.string(12, *r.ValueId)
+	}
+	if r.ValueInstant != nil {
+		e.string(13, *r.ValueInstant)
+	}
+	if r.ValueInteger != nil {
+		e.varint(14, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueMarkdown != nil {
+		e.string(15, *r.ValueMarkdown)
+	}
+	if r.ValueOid != nil {
+		e.string(16, *r.ValueOid)
+	}
+	if r.ValuePositiveInt != nil {
+		e.varint(17, uint64(int64(*r.ValuePositiveInt)))
+	}
+	if r.ValueString != nil {
+		e.string(18, *r.ValueString)
+	}
+	if r.ValueTime != nil {
+		e.string(19, *r.ValueTime)
+	}
+	if r.ValueUnsignedInt != nil {
+		e.varint(20, uint64(int64(*r.ValueUnsignedInt)))
+	}
+	if r.ValueUri != nil {
+		e.string(21, *r.ValueUri)
+	}
+	if r.ValueUrl != nil {
+		e.string(22, *r.ValueUrl)
+	}
+	if r.ValueUuid != nil {
+		e.string(23, *r.ValueUuid)
+	}
+	if r.ValueAddress != nil {
+		e.message(24, *r.ValueAddress)
+	}
+	if r.ValueAge != nil {
+		e.message(25, *r.ValueAge)
+	}
+	if r.ValueAnnotation != nil {
+		e.message(26, *r.ValueAnnotation)
+	}
+	if r.ValueAttachment != nil {
+		e.message(27, *r.ValueAttachment)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.message(28, *r.ValueCodeableConcept)
+	}
+	if r.ValueCoding != nil {
+		e.message(29, *r.ValueCoding)
+	}
+	if r.ValueContactPoint != nil {
+		e.message(30, *r.ValueContactPoint)
+	}
+	if r.ValueCount != nil {
+		e.message(31, *r.ValueCount)
+	}
+	if r.ValueDistance != nil {
+		e.message(32, *r.ValueDistance)
+	}
+	if r.ValueDuration != nil {
+		e.message(33, *r.ValueDuration)
+	}
+	if r.ValueHumanName != nil {
+		e.message(34, *r.ValueHumanName)
+	}
+	if r.ValueIdentifier != nil {
+		e.message(35, *r.ValueIdentifier)
+	}
+	if r.ValueMoney != nil {
+		e.message(36, *r.ValueMoney)
+	}
+	if r.ValuePeriod != nil {
+		e.message(37, *r.ValuePeriod)
+	}
+	if r.ValueQuantity != nil {
+		e.message(38, *r.ValueQuantity)
+	}
+	if r.ValueRange != nil {
+		e.message(39, *r.ValueRange)
+	}
+	if r.ValueRatio != nil {
+		e.message(40, *r.ValueRatio)
+	}
+	if r.ValueReference != nil {
+		e.message(41, *r.ValueReference)
+	}
+	if r.ValueSampledData != nil {
+		e.message(42, *r.ValueSampledData)
+	}
+	if r.ValueSignature != nil {
+		e.message(43, *r.ValueSignature)
+	}
+	if r.ValueTiming != nil {
+		e.message(44, *r.ValueTiming)
+	}
+	if r.ValueContactDetail != nil {
+		e.message(45, *r.ValueContactDetail)
+	}
+	if r.ValueContributor != nil {
+		e.message(46, *r.ValueContributor)
+	}
+	if r.ValueDataRequirement != nil {
+		e.message(47, *r.ValueDataRequirement)
+	}
+	if r.ValueExpression != nil {
+		e.message(48, *r.ValueExpression)
+	}
+	if r.ValueParameterDefinition != nil {
+		e.message(49, *r.ValueParameterDefinition)
+	}
+	if r.ValueRelatedArtifact != nil {
+		e.message(50, *r.ValueRelatedArtifact)
+	}
+	if r.ValueTriggerDefinition != nil {
+		e.message(51, *r.ValueTriggerDefinition)
+	}
+	if r.ValueUsageContext != nil {
+		e.message(52, *r.ValueUsageContext)
+	}
+	if r.ValueDosage != nil {
+		e.message(53, *r.ValueDosage)
+	}
+	if r.ValueMeta != nil {
+		e.message(54, *r.ValueMeta)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.message(55, *r.ValueBase64BinaryElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(56, *r.ValueBooleanElement)
+	}
+	if r.ValueCanonicalElement != nil {
+		e.message(57, *r.ValueCanonicalElement)
+	}
+	if r.ValueCodeElement != nil {
+		e.message(58, *r.ValueCodeElement)
+	}
+	if r.ValueDateElement != nil {
+		e.message(59, *r.ValueDateElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(60, *r.ValueDateTimeElement)
+	}
+	if r.ValueDecimalElement != nil {
+		e.message(61, *r.ValueDecimalElement)
+	}
+	if r.ValueIdElement != nil {
+		e.message(62, *r.ValueIdElement)
+	}
+	if r.ValueInstantElement != nil {
+		e.message(63, *r.ValueInstantElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(64, *r.ValueIntegerElement)
+	}
+	if r.ValueMarkdownElement != nil {
+		e.message(65, *r.ValueMarkdownElement)
+	}
+	if r.ValueOidElement != nil {
+		e.message(66, *r.ValueOidElement)
+	}
+	if r.ValuePositiveIntElement != nil {
+		e.message(67, *r.ValuePositiveIntElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(68, *r.ValueStringElement)
+	}
+	if r.ValueTimeElement != nil {
+		e.message(69, *r.ValueTimeElement)
+	}
+	if r.ValueUnsignedIntElement != nil {
+		e.message(70, *r.ValueUnsignedIntElement)
+	}
+	if r.ValueUriElement != nil {
+		e.message(71, *r.ValueUriElement)
+	}
+	if r.ValueUrlElement != nil {
+		e.message(72, *r.ValueUrlElement)
+	}
+	if r.ValueUuidElement != nil {
+		e.message(73, *r.ValueUuidElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TaskInput from protobuf message TaskInput of fhir.proto
+func (r *TaskInput) UnmarshalProto(b []byte) error {
+	*r = TaskInput{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			v := d.string()
+			r.ValueBase64Binary = &v
+		case 6:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 7:
+			v := d.string()
+			r.ValueCanonical = &v
+		case 8:
+			v := d.string()
+			r.ValueCode = &v
+		case 9:
+			v := d.string()
+			r.ValueDate = &v
+		case 10:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 11:
+			v := json.Number(d.string())
+			r.ValueDecimal = &v
+		case 12:
+			v := d.string()
+			r.ValueId = &v
+		case 13:
+			v := d.string()
+			r.ValueInstant = &v
+		case 14:
+			v := d.int()
+			r.ValueInteger = &v
+		case 15:
+			v := d.string()
+			r.ValueMarkdown = &v
+		case 16:
+			v := d.string()
+			r.ValueOid = &v
+		case 17:
+			v := d.int()
+			r.ValuePositiveInt = &v
+		case 18:
+			v := d.string()
+			r.ValueString = &v
+		case 19:
+			v := d.string()
+			r.ValueTime = &v
+		case 20:
+			v := d.int()
+			r.ValueUnsignedInt = &v
+		case 21:
+			v := d.string()
+			r.ValueUri = &v
+		case 22:
+			v := d.string()
+			r.ValueUrl = &v
+		case 23:
+			v := d.string()
+			r.ValueUuid = &v
+		case 24:
+			var v Address
+			d.message(&v)
+			r.ValueAddress = &v
+		case 25:
+			var v Age
+			d.message(&v)
+			r.ValueAge = &v
+		case 26:
+			var v Annotation
+			d.message(&v)
+			r.ValueAnnotation = &v
+		case 27:
+			var v Attachment
+			d.message(&v)
+			r.ValueAttachment = &v
+		case 28:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCodeableConcept = &v
+		case 29:
+			var v Coding
+			d.message(&v)
+			r.ValueCoding = &v
+		case 30:
+			var v ContactPoint
+			d.message(&v)
+			r.ValueContactPoint = &v
+		case 31:
+			var v Count
+			d.message(&v)
+			r.ValueCount = &v
+		case 32:
+			var v Distance
+			d.message(&v)
+			r.ValueDistance = &v
+		case 33:
+			var v Duration
+			d.message(&v)
+			r.ValueDuration = &v
+		case 34:
+			var v HumanName
+			d.message(&v)
+			r.ValueHumanName = &v
+		case 35:
+			var v Identifier
+			d.message(&v)
+			r.ValueIdentifier = &v
+		case 36:
+			var v Money
+			d.message(&v)
+			r.ValueMoney = &v
+		case 37:
+			var v Period
+			d.message(&v)
+			r.ValuePeriod = &v
+		case 38:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 39:
+			var v Range
+			d.message(&v)
+			r.ValueRange = &v
+		case 40:
+			var v Ratio
+			d.message(&v)
+			r.ValueRatio = &v
+		case 41:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 42:
+			var v SampledData
+			d.message(&v)
+			r.ValueSampledData = &v
+		case 43:
+			var v Signature
+			d.message(&v)
+			r.ValueSignature = &v
+		case 44:
+			var v Timing
+			d.message(&v)
+			r.ValueTiming = &v
+		case 45:
+			var v ContactDetail
+			d.message(&v)
+			r.ValueContactDetail = &v
+		case 46:
+			var v Contributor
+			d.message(&v)
+			r.ValueContributor = &v
+		case 47:
+			var v DataRequirement
+			d.message(&v)
+			r.ValueDataRequirement = &v
+		case 48:
+			var v Expression
+			d.message(&v)
+			r.ValueExpression = &v
+		case 49:
+			var v ParameterDefinition
+			d.message(&v)
+			r.ValueParameterDefinition = &v
+		case 50:
+			var v RelatedArtifact
+			d.message(&v)
+			r.ValueRelatedArtifact = &v
+		case 51:
+			var v TriggerDefinition
+			d.message(&v)
+			r.ValueTriggerDefinition = &v
+		case 52:
+			var v UsageContext
+			d.message(&v)
+			r.ValueUsageContext = &v
+		case 53:
+			var v Dosage
+			d.message(&v)
+			r.ValueDosage = &v
+		case 54:
+			var v Meta
+			d.message(&v)
+			r.ValueMeta = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.ValueBase64BinaryElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 57:
+			var v Element
+			d.message(&v)
+			r.ValueCanonicalElement = &v
+		case 58:
+			var v Element
+			d.message(&v)
+			r.ValueCodeElement = &v
+		case 59:
+			var v Element
+			d.message(&v)
+			r.ValueDateElement = &v
+		case 60:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		case 61:
+			var v Element
+			d.message(&v)
+			r.ValueDecimalElement = &v
+		case 62:
+			var v Element
+			d.message(&v)
+			r.ValueIdElement = &v
+		case 63:
+			var v Element
+			d.message(&v)
+			r.ValueInstantElement = &v
+		case 64:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 65:
+			var v Element
+			d.message(&v)
+			r.ValueMarkdownElement = &v
+		case 66:
+			var v Element
+			d.message(&v)
+			r.ValueOidElement = &v
+		case 67:
+			var v Element
+			d.message(&v)
+			r.ValuePositiveIntElement = &v
+		case 68:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 69:
+			var v Element
+			d.message(&v)
+			r.ValueTimeElement = &v
+		case 70:
+			var v Element
+			d.message(&v)
+			r.ValueUnsignedIntElement = &v
+		case 71:
+			var v Element
+			d.message(&v)
+			r.ValueUriElement = &v
+		case 72:
+			var v Element
+			d.message(&v)
+			r.ValueUrlElement = &v
+		case 73:
+			var v Element
+			d.message(&v)
+			r.ValueUuidElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TaskOutput as JSON into a byte slice
+func (r TaskOutput) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TaskOutput as JSON object with the members in the order of the fields
+func (r TaskOutput) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	r.Type.appendJSON(e)
+	if r.ValueBase64Binary != nil {
+		e.key("valueBase64Binary")
+		e.string(*r.ValueBase64Binary)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.key("_valueBase64Binary")
+		r.ValueBase64BinaryElement.appendJSON(e)
+	}
+	if r.ValueBoolean != nil {
+		e.key("valueBoolean")
+		e.bool(*r.ValueBoolean)
+	}
+	if r.ValueBooleanElement != nil {
+		e.key("_valueBoolean")
+		r.ValueBooleanElement.appendJSON(e)
+	}
+	if r.ValueCanonical != nil {
+		e.key("valueCanonical")
+		e.string(*r.ValueCanonical)
+	}
+	if r.ValueCanonicalElement != nil {
+		e.key("_valueCanonical")
+		r.ValueCanonicalElement.appendJSON(e)
+	}
+	if r.ValueCode != nil {
+		e.key("valueCode")
+		e.string(*r.ValueCode)
+	}
+	if r.ValueCodeElement != nil {
+		e.key("_valueCode")
+		r.ValueCodeElement.appendJSON(e)
+	}
+	if r.ValueDate != nil {
+		e.key("valueDate")
+		e.string(*r.ValueDate)
+	}
+	if r.ValueDateElement != nil {
+		e.key("_valueDate")
+		r.ValueDateElement.appendJSON(e)
+	}
+	if r.ValueDateTime != nil {
+		e.key("valueDateTime")
+		e.string(*r.ValueDateTime)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.key("_valueDateTime")
+		r.ValueDateTimeElement.appendJSON(e)
+	}
+	if r.ValueDecimal != nil {
+		e.key("valueDecimal")
+		e.number(*r.ValueDecimal)
+	}
+	if r.ValueDecimalElement != nil {
+		e.key("_valueDecimal")
+		r.ValueDecimalElement.appendJSON(e)
+	}
+	if r.ValueId != nil {
+		e.key("valueId")
+		e.string(*r.ValueId)
+	}
+	if r.ValueIdElement != nil {
+		e.key("_valueId")
+		r.ValueIdElement.appendJSON(e)
+	}
+	if r.ValueInstant != nil {
+		e.key("valueInstant")
+		e.string(*r.ValueInstant)
+	}
+	if r.ValueInstantElement != nil {
+		e.key("_valueInstant")
+		r.ValueInstantElement.appendJSON(e)
+	}
+	if r.ValueInteger != nil {
+		e.key("valueInteger")
+		e.int(*r.ValueInteger)
+	}
+	if r.ValueIntegerElement != nil {
+		e.key("_valueInteger")
+		r.ValueIntegerElement.appendJSON(e)
+	}
+	if r.ValueMarkdown != nil {
+		e.key("valueMarkdown")
+		e.string(*r.ValueMarkdown)
+	}
+	if r.ValueMarkdownElement != nil {
+		e.key("_valueMarkdown")
+		r.ValueMarkdownElement.appendJSON(e)
+	}
+	if r.ValueOid != nil {
+		e.key("valueOid")
+		e.string(*r.ValueOid)
+	}
+	if r.ValueOidElement != nil {
+		e.key("_valueOid")
+		r.ValueOidElement.appendJSON(e)
+	}
+	if r.ValuePositiveInt != nil {
+		e.key("valuePositiveInt")
+		e.int(*r.ValuePositiveInt)
+	}
+	if r.ValuePositiveIntElement != nil {
+		e.key("_valuePositiveInt")
+		r.ValuePositiveIntElement.appendJSON(e)
+	}
+	if r.ValueString != nil {
+		e.key("valueString")
+		e.string(*r.ValueString)
+	}
+	if r.ValueStringElement != nil {
+		e.key("_valueString")
+		r.ValueStringElement.appendJSON(e)
+	}
+	if r.ValueTime != nil {
+		e.key("valueTime")
+		e.string(*r.ValueTime)
+	}
+	if r.ValueTimeElement != nil {
+		e.key("_valueTime")
+		r.ValueTimeElement.appendJSON(e)
+	}
+	if r.ValueUnsignedInt != nil {
+		e.key("valueUnsignedInt")
+		e.int(*r.ValueUnsignedInt)
+	}
+	if r.ValueUnsignedIntElement != nil {
+		e.key("_valueUnsignedInt")
+		r.ValueUnsignedIntElement.appendJSON(e)
+	}
+	if r.ValueUri != nil {
+		e.key("valueUri")
+		e.string(*r.ValueUri)
+	}
+	if r.ValueUriElement != nil {
+		e.key("_valueUri")
+		r.ValueUriElement.appendJSON(e)
+	}
+	if r.ValueUrl != nil {
+		e.key("valueUrl")
+		e.string(*r.ValueUrl)
+	}
+	if r.ValueUrlElement != nil {
+		e.key("_valueUrl")
+		r.ValueUrlElement.appendJSON(e)
+	}
+	if r.ValueUuid != nil {
+		e.key("valueUuid")
+		e.string(*r.ValueUuid)
+	}
+	if r.ValueUuidElement != nil {
+		e.key("_valueUuid")
+		r.ValueUuidElement.appendJSON(e)
+	}
+	if r.ValueAddress != nil {
+		e.key("valueAddress")
+		r.ValueAddress.appendJSON(e)
+	}
+	if r.ValueAge != nil {
+		e.key("valueAge")
+		r.ValueAge.appendJSON(e)
+	}
+	if r.ValueAnnotation != nil {
+		e.key("valueAnnotation")
+		r.ValueAnnotation.appendJSON(e)
+	}
+	if r.ValueAttachment != nil {
+		e.key("valueAttachment")
+		r.ValueAttachment.appendJSON(e)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.key("valueCodeableConcept")
+		r.ValueCodeableConcept.appendJSON(e)
+	}
+	if r.ValueCoding != nil {
+		e.key("valueCoding")
+		r.ValueCoding.appendJSON(e)
+	}
+	if r.ValueContactPoint != nil {
+		e.key("valueContactPoint")
+		r.ValueContactPoint.appendJSON(e)
+	}
+	if r.ValueCount != nil {
+		e.key("valueCount")
+		r.ValueCount.appendJSON(e)
+	}
+	if r.ValueDistance != nil {
+		e.key("valueDistance")
+		r.ValueDistance.appendJSON(e)
+	}
+	if r.ValueDuration != nil {
+		e.key("valueDuration")
+		r.ValueDuration.appendJSON(e)
+	}
+	if r.ValueHumanName != nil {
+		e.key("valueHumanName")
+		r.ValueHumanName.appendJSON(e)
+	}
+	if r.ValueIdentifier != nil {
+		e.key("valueIdentifier")
+		r.ValueIdentifier.appendJSON(e)
+	}
+	if r.ValueMoney != nil {
+		e.key("valueMoney")
+		r.ValueMoney.appendJSON(e)
+	}
+	if r.ValuePeriod != nil {
+		e.key("valuePeriod")
+		r.ValuePeriod.appendJSON(e)
+	}
+	if r.ValueQuantity != nil {
+		e.key("valueQuantity")
+		r.ValueQuantity.appendJSON(e)
+	}
+	if r.ValueRange != nil {
+		e.key("valueRange")
+		r.ValueRange.appendJSON(e)
+	}
+	if r.ValueRatio != nil {
+		e.key("valueRatio")
+		r.ValueRatio.appendJSON(e)
+	}
+	if r.ValueReference != nil {
+		e.key("valueReference")
+		r.ValueReference.appendJSON(e)
+	}
+	if r.ValueSampledData != nil {
+		e.key("valueSampledData")
+		r.ValueSampledData.appendJSON(e)
+	}
+	if r.ValueSignature != nil {
+		e.key("valueSignature")
+		r.ValueSignature.appendJSON(e)
+	}
+	if r.ValueTiming != nil {
+		e.key("valueTiming")
+		r.ValueTiming.appendJSON(e)
+	}
+	if r.ValueContactDetail != nil {
+		e.key("valueContactDetail")
+		r.ValueContactDetail.appendJSON(e)
+	}
+	if r.ValueContributor != nil {
+		e.key("valueContributor")
+		r.ValueContributor.appendJSON(e)
+	}
+	if r.ValueDataRequirement != nil {
+		e.key("valueDataRequirement")
+		r.ValueDataRequirement.appendJSON(e)
+	}
+	if r.ValueExpression != nil {
+		e.key("valueExpression")
+		r.ValueExpression.appendJSON(e)
+	}
+	if r.ValueParameterDefinition != nil {
+		e.key("valueParameterDefinition")
+		r.ValueParameterDefinition.appendJSON(e)
+	}
+	if r.ValueRelatedArtifact != nil {
+		e.key("valueRelatedArtifact")
+		r.ValueRelatedArtifact.appendJSON(e)
+	}
+	if r.ValueTriggerDefinition != nil {
+		e.key("valueTriggerDefinition")
+		r.ValueTriggerDefinition.appendJSON(e)
+	}
+	if r.ValueUsageContext != nil {
+		e.key("valueUsageContext")
+		r.ValueUsageContext.appendJSON(e)
+	}
+	if r.ValueDosage != nil {
+		e.key("valueDosage")
+		r.ValueDosage.appendJSON(e)
+	}
+	if r.ValueMeta != nil {
+		e.key("valueMeta")
+		r.ValueMeta.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TaskOutput from JSON. Unknown members are ignored.
+func (r *TaskOutput) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TaskOutput
+func (r *TaskOutput) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			r.Type.decodeJSON(d)
+		case "valueBase64Binary":
+			if v, ok := d.string(); ok {
+				r.ValueBase64Binary = &v
+			} else {
+				r.ValueBase64Binary = nil
+			}
+		case "_valueBase64Binary":
+			r.ValueBase64BinaryElement = d.element()
+		case "valueBoolean":
+			if v, ok := d.bool(); ok {
+				r.ValueBoolean = &v
+			} else {
+				r.ValueBoolean = nil
+			}
+		case "_valueBoolean":
+			r.ValueBooleanElement = d.element()
+		case "valueCanonical":
+			if v, ok := d.string(); ok {
+				r.ValueCanonical = &v
+			} else {
+				r.ValueCanonical = nil
+			}
+		case "_valueCanonical":
+			r.ValueCanonicalElement = d.element()
+		case "valueCode":
+			if v, ok := d.string(); ok {
+				r.ValueCode = &v
+			} else {
+				r.ValueCode = nil
+			}
+		case "_valueCode":
+			r.ValueCodeElement = d.element()
+		case "valueDate":
+			if v, ok := d.string(); ok {
+				r.ValueDate = &v
+			} else {
+				r.ValueDate = nil
+			}
+		case "_valueDate":
+			r.ValueDateElement = d.element()
+		case "valueDateTime":
+			if v, ok := d.string(); ok {
+				r.ValueDateTime = &v
+			} else {
+				r.ValueDateTime = nil
+			}
+		case "_valueDateTime":
+			r.ValueDateTimeElement = d.element()
+		case "valueDecimal":
+			if v, ok := d.number(); ok {
+				r.ValueDecimal = &v
+			} else {
+				r.ValueDecimal = nil
+			}
+		case "_valueDecimal":
+			r.ValueDecimalElement = d.element()
+		case "valueId":
+			if v, ok := d.string(); ok {
+				r.ValueId = &v
+			} else {
+				r.ValueId = nil
+			}
+		case "_valueId":
+			r.ValueIdElement = d.element()
+		case "valueInstant":
+			if v, ok := d.string(); ok {
+				r.ValueInstant = &v
+			} else {
+				r.ValueInstant = nil
+			}
+		case "_valueInstant":
+			r.ValueInstantElement = d.element()
+		case "valueInteger":
+			if v, ok := d.int(); ok {
+				r.ValueInteger = &v
+			} else {
+				r.ValueInteger = nil
+			}
+		case "_valueInteger":
+			r.ValueIntegerElement = d.element()
+		case "valueMarkdown":
+			if v, ok := d.string(); ok {
+				r.ValueMarkdown = &v
+			} else {
+				r.ValueMarkdown = nil
+			}
+		case "_valueMarkdown":
+			r.ValueMarkdownElement = d.element()
+		case "valueOid":
+			if v, ok := d.string(); ok {
+				r.ValueOid = &v
+			} else {
+				r.ValueOid = nil
+			}
+		case "_valueOid":
+			r.ValueOidElement = d.element()
+		case "valuePositiveInt":
+			if v, ok := d.int(); ok {
+				r.ValuePositiveInt = &v
+			} else {
+				r.ValuePositiveInt = nil
+			}
+		case "_valuePositiveInt":
+			r.ValuePositiveIntElement = d.element()
+		case "valueString":
+			if v, ok := d.string(); ok {
+				r.ValueString = &v
+			} else {
+				r.ValueString = nil
+			}
+		case "_valueString":
+			r.ValueStringElement = d.element()
+		case "valueTime":
+			if v, ok := d.string(); ok {
+				r.ValueTime = &v
+			} else {
+				r.ValueTime = nil
+			}
+		case "_valueTime":
+			r.ValueTimeElement = d.element()
+		case "valueUnsignedInt":
+			if v, ok := d.int(); ok {
+				r.ValueUnsignedInt = &v
+			} else {
+				r.ValueUnsignedInt = nil
+			}
+		case "_valueUnsignedInt":
+			r.ValueUnsignedIntElement = d.element()
+		case "valueUri":
+			if v, ok := d.string(); ok {
+				r.ValueUri = &v
+			} else {
+				r.ValueUri = nil
+			}
+		case "_valueUri":
+			r.ValueUriElement = d.element()
+		case "valueUrl":
+			if v, ok := d.string(); ok {
+				r.ValueUrl = &v
+			} else {
+				r.ValueUrl = nil
+			}
+		case "_valueUrl":
+			r.ValueUrlElement = d.element()
+		case "valueUuid":
+			if v, ok := d.string(); ok {
+				r.ValueUuid = &v
+			} else {
+				r.ValueUuid = nil
+			}
+		case "_valueUuid":
+			r.ValueUuidElement = d.element()
+		case "valueAddress":
+			if d.null() {
+				r.ValueAddress = nil
+			} else {
+				var v Address
+				v.decodeJSON(d)
+				r.ValueAddress = &v
+			}
+		case "valueAge":
+			if d.null() {
+				r.ValueAge = nil
+			} else {
+				var v Age
+				v.decodeJSON(d)
+				r.ValueAge = &v
+			}
+		case "valueAnnotation":
+			if d.null() {
+				r.ValueAnnotation = nil
+			} else {
+				var v Annotation
+				v.decodeJSON(d)
+				r.ValueAnnotation = &v
+			}
+		case "valueAttachment":
+			if d.null() {
+				r.ValueAttachment = nil
+			} else {
+				var v Attachment
+				v.decodeJSON(d)
+				r.ValueAttachment = &v
+			}
+		case "valueCodeableConcept":
+			if d.null() {
+				r.ValueCodeableConcept = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValueCodeableConcept = &v
+			}
+		case "valueCoding":
+			if d.null() {
+				r.ValueCoding = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.ValueCoding = &v
+			}
+		case "valueContactPoint":
+			if d.null() {
+				r.ValueContactPoint = nil
+			} else {
+				var v ContactPoint
+				v.decodeJSON(d)
+				r.ValueContactPoint = &v
+			}
+		case "valueCount":
+			if d.null() {
+				r.ValueCount = nil
+			} else {
+				var v Count
+				v.decodeJSON(d)
+				r.ValueCount = &v
+			}
+		case "valueDistance":
+			if d.null() {
+				r.ValueDistance = nil
+			} else {
+				var v Distance
+				v.decodeJSON(d)
+				r.ValueDistance = &v
+			}
+		case "valueDuration":
+			if d.null() {
+				r.ValueDuration = nil
+			} else {
+				var v Duration
+				v.decodeJSON(d)
+				r.ValueDuration = &v
+			}
+		case "valueHumanName":
+			if d.null() {
+				r.ValueHumanName = nil
+			} else {
+				var v HumanName
+				v.decodeJSON(d)
+				r.ValueHumanName = &v
+			}
+		case "valueIdentifier":
+			if d.null() {
+				r.ValueIdentifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.ValueIdentifier = &v
+			}
+		case "valueMoney":
+			if d.null() {
+				r.ValueMoney = nil
+			} else {
+				var v Money
+				v.decodeJSON(d)
+				r.ValueMoney = &v
+			}
+		case "valuePeriod":
+			if d.null() {
+				r.ValuePeriod = nil
+			} else {
+				var v Period
+				v.decodeJSON(d)
+				r.ValuePeriod = &v
+			}
+		case "valueQuantity":
+			if d.null() {
+				r.ValueQuantity = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.ValueQuantity = &v
+			}
+		case "valueRange":
+			if d.null() {
+				r.ValueRange = nil
+			} else {
+				var v Range
+				v.decodeJSON(d)
+				r.ValueRange = &v
+			}
+		case "valueRatio":
+			if d.null() {
+				r.ValueRatio = nil
+			} else {
+				var v Ratio
+				v.decodeJSON(d)
+				r.ValueRatio = &v
+			}
+		case "valueReference":
+			if d.null() {
+				r.ValueReference = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.ValueReference = &v
+			}
+		case "valueSampledData":
+			if d.null() {
+				r.ValueSampledData = nil
+			} else {
+				var v SampledData
+				v.decodeJSON(d)
+				r.ValueSampledData = &v
+			}
+		case "valueSignature":
+			if d.null() {
+				r.ValueSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.ValueSignature = &v
+			}
+		case "valueTiming":
+			if d.null() {
+				r.ValueTiming = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.ValueTiming = &v
+			}
+		case "valueContactDetail":
+			if d.null() {
+				r.ValueContactDetail = nil
+			} else {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.ValueContactDetail = &v
+			}
+		case "valueContributor":
+			if d.null() {
+				r.ValueContributor = nil
+			} else {
+				var v Contributor
+				v.decodeJSON(d)
+				r.ValueContributor = &v
+			}
+		case "valueDataRequirement":
+			if d.null() {
+				r.ValueDataRequirement = nil
+			} else {
+				var v DataRequirement
+				v.decodeJSON(d)
+				r.ValueDataRequirement = &v
+			}
+		case "valueExpression":
+			if d.null() {
+				r.ValueExpression = nil
+			} else {
+				var v Expression
+				v.decodeJSON(d)
+				r.ValueExpression = &v
+			}
+		case "valueParameterDefinition":
+			if d.null() {
+				r.ValueParameterDefinition = nil
+			} else {
+				var v ParameterDefinition
+				v.decodeJSON(d)
+				r.ValueParameterDefinition = &v
+			}
+		case "valueRelatedArtifact":
+			if d.null() {
+				r.ValueRelatedArtifact = nil
+			} else {
+				var v RelatedArtifact
+				v.decodeJSON(d)
+				r.ValueRelatedArtifact = &v
+			}
+		case "valueTriggerDefinition":
+			if d.null() {
+				r.ValueTriggerDefinition = nil
+			} else {
+				var v TriggerDefinition
+				v.decodeJSON(d)
+				r.ValueTriggerDefinition = &v
+			}
+		case "valueUsageContext":
+			if d.null() {
+				r.ValueUsageContext = nil
+			} else {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.ValueUsageContext = &v
+			}
+		case "valueDosage":
+			if d.null() {
+				r.ValueDosage = nil
+			} else {
+				var v Dosage
+				v.decodeJSON(d)
+				r.ValueDosage = &v
+			}
+		case "valueMeta":
+			if d.null() {
+				r.ValueMeta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.ValueMeta = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TaskOutput which shares no memory with the original
+func (r TaskOutput) DeepCopy() TaskOutput {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Type = r.Type.DeepCopy()
+	if r.ValueBase64Binary != nil {
+		v := *r.ValueBase64Binary
+		out.ValueBase64Binary = &v
+	}
+	if r.ValueBase64BinaryElement != nil {
+		v := r.ValueBase64BinaryElement.DeepCopy()
+		out.ValueBase64BinaryElement = &v
+	}
+	if r.ValueBoolean != nil {
+		v := *r.ValueBoolean
+		out.ValueBoolean = &v
+	}
+	if r.ValueBooleanElement != nil {
+		v := r.ValueBooleanElement.DeepCopy()
+		out.ValueBooleanElement = &v
+	}
+	if r.ValueCanonical != nil {
+		v := *r.ValueCanonical
+		out.ValueCanonical = &v
+	}
+	if r.ValueCanonicalElement != nil {
+		v := r.ValueCanonicalElement.DeepCopy()
+		out.ValueCanonicalElement = &v
+	}
+	if r.ValueCode != nil {
+		v := *r.ValueCode
+		out.ValueCode = &v
+	}
+	if r.ValueCodeElement != nil {
+		v := r.ValueCodeElement.DeepCopy()
+		out.ValueCodeElement = &v
+	}
+	if r.ValueDate != nil {
+		v := *r.ValueDate
+		out.ValueDate = &v
+	}
+	if r.ValueDateElement != nil {
+		v := r.ValueDateElement.DeepCopy()
+		out.ValueDateElement = &v
+	}
+	if r.ValueDateTime != nil {
+		v := *r.ValueDateTime
+		out.ValueDateTime = &v
+	}
+	if r.ValueDateTimeElement != nil {
+		v := r.ValueDateTimeElement.DeepCopy()
+		out.ValueDateTimeElement = &v
+	}
+	if r.ValueDecimal != nil {
+		v := *r.ValueDecimal
+		out.ValueDecimal = &v
+	}
+	if r.ValueDecimalElement != nil {
+		v := r.ValueDecimalElement.DeepCopy()
+		out.ValueDecimalElement = &v
+	}
+	if r.ValueId != nil {
+		v := *r.ValueId
+		out.ValueId = &v
+	}
+	if r.ValueIdElement != nil {
+		v := r.ValueIdElement.DeepCopy()
+		out.ValueIdElement = &v
+	}
+	if r.ValueInstant != nil {
+		v := *r.ValueInstant
+		out.ValueInstant = &v
+	}
+	if r.ValueInstantElement != nil {
+		v := r.ValueInstantElement.DeepCopy()
+		out.ValueInstantElement = &v
+	}
+	if r.ValueInteger != nil {
+		v := *r.ValueInteger
+		out.ValueInteger = &v
+	}
+	if r.ValueIntegerElement != nil {
+		v := r.ValueIntegerElement.DeepCopy()
+		out.ValueIntegerElement = &v
+	}
+	if r.ValueMarkdown != nil {
+		v := *r.ValueMarkdown
+		out.ValueMarkdown = &v
+	}
+	if r.ValueMarkdownElement != nil {
+		v := r.ValueMarkdownElement.DeepCopy()
+		out.ValueMarkdownElement = &v
+	}
+	if r.ValueOid != nil {
+		v := *r.ValueOid
+		out.ValueOid = &v
+	}
+	if r.ValueOidElement != nil {
+		v := r.ValueOidElement.DeepCopy()
+		out.ValueOidElement = &v
+	}
+	if r.ValuePositiveInt != nil {
+		v := *r.ValuePositiveInt
+		out.ValuePositiveInt = &v
+	}
+	if r.ValuePositiveIntElement != nil {
+		v := r.ValuePositiveIntElement.DeepCopy()
+		out.ValuePositiveIntElement = &v
+	}
+	if r.ValueString != nil {
+		v := *r.ValueString
+		out.ValueString = &v
+	}
+	if r.ValueStringElement != nil {
+		v := r.ValueStringElement.DeepCopy()
+		out.ValueStringElement = &v
+	}
+	if r.ValueTime != nil {
+		v := *r.ValueTime
+		out.ValueTime = &v
+	}
+	if r.ValueTimeElement != nil {
+		v := r.ValueTimeElement.DeepCopy()
+		out.ValueTimeElement = &v
+	}
+	if r.ValueUnsignedInt != nil {
+		v := *r.ValueUnsignedInt
+		out.ValueUnsignedInt = &v
+	}
+	if r.ValueUnsignedIntElement != nil {
+		v := r.ValueUnsignedIntElement.DeepCopy()
+		out.ValueUnsignedIntElement = &v
+	}
+	if r.ValueUri != nil {
+		v := *r.ValueUri
+		out.ValueUri = &v
+	}
+	if r.ValueUriElement != nil {
+		v := r.ValueUriElement.DeepCopy()
+		out.ValueUriElement = &v
+	}
+	if r.ValueUrl != nil {
+		v := *r.ValueUrl
+		out.ValueUrl = &v
+	}
+	if r.ValueUrlElement != nil {
+		v := r.ValueUrlElement.DeepCopy()
+		out.ValueUrlElement = &v
+	}
+	if r.ValueUuid != nil {
+		v := *r.ValueUuid
+		out.ValueUuid = &v
+	}
+	if r.ValueUuidElement != nil {
+		v := r.ValueUuidElement.DeepCopy()
+		out.ValueUuidElement = &v
+	}
+	if r.ValueAddress != nil {
+		v := r.ValueAddress.DeepCopy()
+		out.ValueAddress = &v
+	}
+	if r.ValueAge != nil {
+		v := r.ValueAge.DeepCopy()
+		out.ValueAge = &v
+	}
+	if r.ValueAnnotation != nil {
+		v := r.ValueAnnotation.DeepCopy()
+		out.ValueAnnotation = &v
+	}
+	if r.ValueAttachment != nil {
+		v := r.ValueAttachment.DeepCopy()
+		out.ValueAttachment = &v
+	}
+	if r.ValueCodeableConcept != nil {
+		v := r.ValueCodeableConcept.DeepCopy()
+		out.ValueCodeableConcept = &v
+	}
+	if r.ValueCoding != nil {
+		v := r.ValueCoding.DeepCopy()
+		out.ValueCoding = &v
+	}
+	if r.ValueContactPoint != nil {
+		v := r.ValueContactPoint.DeepCopy()
+		out.ValueContactPoint = &v
+	}
+	if r.ValueCount != nil {
+		v := r.ValueCount.DeepCopy()
+		out.ValueCount = &v
+	}
+	if r.ValueDistance != nil {
+		v := r.ValueDistance.DeepCopy()
+		out.ValueDistance = &v
+	}
+	if r.ValueDuration != nil {
+		v := r.ValueDuration.DeepCopy()
+		out.ValueDuration = &v
+	}
+	if r.ValueHumanName != nil {
+		v := r.ValueHumanName.DeepCopy()
+		out.ValueHumanName = &v
+	}
+	if r.ValueIdentifier != nil {
+		v := r.ValueIdentifier.DeepCopy()
+		out.ValueIdentifier = &v
+	}
+	if r.ValueMoney != nil {
+		v := r.ValueMoney.DeepCopy()
+		out.ValueMoney = &v
+	}
+	if r.ValuePeriod != nil {
+		v := r.ValuePeriod.DeepCopy()
+		out.ValuePeriod = &v
+	}
+	if r.ValueQuantity != nil {
+		v := r.ValueQuantity.DeepCopy()
+		out.ValueQuantity = &v
+	}
+	if r.ValueRange != nil {
+		v := r.ValueRange.DeepCopy()
+		out.ValueRange = &v
+	}
+	if r.ValueRatio != nil {
+		v := r.ValueRatio.DeepCopy()
+		out.ValueRatio = &v
+	}
+	if r.ValueReference != nil {
+		v := r.ValueReference.DeepCopy()
+		out.ValueReference = &v
+	}
+	if r.ValueSampledData != nil {
+		v := r.ValueSampledData.DeepCopy()
+		out.ValueSampledData = &v
+	}
+	if r.ValueSignature != nil {
+		v := r.ValueSignature.DeepCopy()
+		out.ValueSignature = &v
+	}
+	if r.ValueTiming != nil {
+		v := r.ValueTiming.DeepCopy()
+		out.ValueTiming = &v
+	}
+	if r.ValueContactDetail != nil {
+		v := r.ValueContactDetail.DeepCopy()
+		out.ValueContactDetail = &v
+	}
+	if r.ValueContributor != nil {
+		v := r.ValueContributor.DeepCopy()
+		out.ValueContributor = &v
+	}
+	if r.ValueDataRequirement != nil {
+		v := r.ValueDataRequirement.DeepCopy()
+		out.ValueDataRequirement = &v
+	}
+	if r.ValueExpression != nil {
+		v := r.ValueExpression.DeepCopy()
+		out.ValueExpression = &v
+	}
+	if r.ValueParameterDefinition != nil {
+		v := r.ValueParameterDefinition.DeepCopy()
+		out.ValueParameterDefinition = &v
+	}
+	if r.ValueRelatedArtifact != nil {
+		v := r.ValueRelatedArtifact.DeepCopy()
+		out.ValueRelatedArtifact = &v
+	}
+	if r.ValueTriggerDefinition != nil {
+		v := r.ValueTriggerDefinition.DeepCopy()
+		out.ValueTriggerDefinition = &v
+	}
+	if r.ValueUsageContext != nil {
+		v := r.ValueUsageContext.DeepCopy()
+		out.ValueUsageContext = &v
+	}
+	if r.ValueDosage != nil {
+		v := r.ValueDosage.DeepCopy()
+		out.ValueDosage = &v
+	}
+	if r.ValueMeta != nil {
+		v := r.ValueMeta.DeepCopy()
+		out.ValueMeta = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TaskOutput) Equal(other TaskOutput) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Type.Equal(other.Type) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && *r.ValueBase64Binary != *other.ValueBase64Binary {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.Equal(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.Equal(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && *r.ValueCanonical != *other.ValueCanonical {
+		return false
+	}
+	if (r.ValueCanonicalElement == nil) != (other.ValueCanonicalElement == nil) || r.ValueCanonicalElement != nil && !r.ValueCanonicalElement.Equal(*other.ValueCanonicalElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && *r.ValueCode != *other.ValueCode {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.Equal(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && *r.ValueDate != *other.ValueDate {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.Equal(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && *r.ValueDateTime != *other.ValueDateTime {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.Equal(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !EqualDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.Equal(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && *r.ValueId != *other.ValueId {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.Equal(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && *r.ValueInstant != *other.ValueInstant {
+		return false
+	}
+	if (r.ValueInstantElement == nil) != (other.ValueInstantElement == nil) || r.ValueInstantElement != nil && !r.ValueInstantElement.Equal(*other.ValueInstantElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.Equal(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && *r.ValueMarkdown != *other.ValueMarkdown {
+		return false
+	}
+	if (r.ValueMarkdownElement == nil) != (other.ValueMarkdownElement == nil) || r.ValueMarkdownElement != nil && !r.ValueMarkdownElement.Equal(*other.ValueMarkdownElement) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && *r.ValueOid != *other.ValueOid {
+		return false
+	}
+	if (r.ValueOidElement == nil) != (other.ValueOidElement == nil) || r.ValueOidElement != nil && !r.ValueOidElement.Equal(*other.ValueOidElement) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValuePositiveIntElement == nil) != (other.ValuePositiveIntElement == nil) || r.ValuePositiveIntElement != nil && !r.ValuePositiveIntElement.Equal(*other.ValuePositiveIntElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && *r.ValueString != *other.ValueString {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.Equal(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && *r.ValueTime != *other.ValueTime {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.Equal(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUnsignedIntElement == nil) != (other.ValueUnsignedIntElement == nil) || r.ValueUnsignedIntElement != nil && !r.ValueUnsignedIntElement.Equal(*other.ValueUnsignedIntElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && *r.ValueUri != *other.ValueUri {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.Equal(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && *r.ValueUrl != *other.ValueUrl {
+		return false
+	}
+	if (r.ValueUrlElement == nil) != (other.ValueUrlElement == nil) || r.ValueUrlElement != nil && !r.ValueUrlElement.Equal(*other.ValueUrlElement) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && *r.ValueUuid != *other.ValueUuid {
+		return false
+	}
+	if (r.ValueUuidElement == nil) != (other.ValueUuidElement == nil) || r.ValueUuidElement != nil && !r.ValueUuidElement.Equal(*other.ValueUuidElement) {
+		return false
+	}
+	if (r.ValueAddress == nil) != (other.ValueAddress == nil) || r.ValueAddress != nil && !r.ValueAddress.Equal(*other.ValueAddress) {
+		return false
+	}
+	if (r.ValueAge == nil) != (other.ValueAge == nil) || r.ValueAge != nil && !r.ValueAge.Equal(*other.ValueAge) {
+		return false
+	}
+	if (r.ValueAnnotation == nil) != (other.ValueAnnotation == nil) || r.ValueAnnotation != nil && !r.ValueAnnotation.Equal(*other.ValueAnnotation) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.Equal(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.Equal(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.Equal(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueContactPoint == nil) != (other.ValueContactPoint == nil) || r.ValueContactPoint != nil && !r.ValueContactPoint.Equal(*other.ValueContactPoint) {
+		return false
+	}
+	if (r.ValueCount == nil) != (other.ValueCount == nil) || r.ValueCount != nil && !r.ValueCount.Equal(*other.ValueCount) {
+		return false
+	}
+	if (r.ValueDistance == nil) != (other.ValueDistance == nil) || r.ValueDistance != nil && !r.ValueDistance.Equal(*other.ValueDistance) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.Equal(*other.ValueDuration) {
+		return false
+	}
+	if (r.ValueHumanName == nil) != (other.ValueHumanName == nil) || r.ValueHumanName != nil && !r.ValueHumanName.Equal(*other.ValueHumanName) {
+		return false
+	}
+	if (r.ValueIdentifier == nil) != (other.ValueIdentifier == nil) || r.ValueIdentifier != nil && !r.ValueIdentifier.Equal(*other.ValueIdentifier) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.Equal(*other.ValueMoney) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.Equal(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.Equal(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.Equal(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.Equal(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.Equal(*other.ValueReference) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.Equal(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueSignature == nil) != (other.ValueSignature == nil) || r.ValueSignature != nil && !r.ValueSignature.Equal(*other.ValueSignature) {
+		return false
+	}
+	if (r.ValueTiming == nil) != (other.ValueTiming == nil) || r.ValueTiming != nil && !r.ValueTiming.Equal(*other.ValueTiming) {
+		return false
+	}
+	if (r.ValueContactDetail == nil) != (other.ValueContactDetail == nil) || r.ValueContactDetail != nil && !r.ValueContactDetail.Equal(*other.ValueContactDetail) {
+		return false
+	}
+	if (r.ValueContributor == nil) != (other.ValueContributor == nil) || r.ValueContributor != nil && !r.ValueContributor.Equal(*other.ValueContributor) {
+		return false
+	}
+	if (r.ValueDataRequirement == nil) != (other.ValueDataRequirement == nil) || r.ValueDataRequirement != nil && !r.ValueDataRequirement.Equal(*other.ValueDataRequirement) {
+		return false
+	}
+	if (r.ValueExpression == nil) != (other.ValueExpression == nil) || r.ValueExpression != nil && !r.ValueExpression.Equal(*other.ValueExpression) {
+		return false
+	}
+	if (r.ValueParameterDefinition == nil) != (other.ValueParameterDefinition == nil) || r.ValueParameterDefinition != nil && !r.ValueParameterDefinition.Equal(*other.ValueParameterDefinition) {
+		return false
+	}
+	if (r.ValueRelatedArtifact == nil) != (other.ValueRelatedArtifact == nil) || r.ValueRelatedArtifact != nil && !r.ValueRelatedArtifact.Equal(*other.ValueRelatedArtifact) {
+		return false
+	}
+	if (r.ValueTriggerDefinition == nil) != (other.ValueTriggerDefinition == nil) || r.ValueTriggerDefinition != nil && !r.ValueTriggerDefinition.Equal(*other.ValueTriggerDefinition) {
+		return false
+	}
+	if (r.ValueUsageContext == nil) != (other.ValueUsageContext == nil) || r.ValueUsageContext != nil && !r.ValueUsageContext.Equal(*other.ValueUsageContext) {
+		return false
+	}
+	if (r.ValueDosage == nil) != (other.ValueDosage == nil) || r.ValueDosage != nil && !r.ValueDosage.Equal(*other.ValueDosage) {
+		return false
+	}
+	if (r.ValueMeta == nil) != (other.ValueMeta == nil) || r.ValueMeta != nil && !r.ValueMeta.Equal(*other.ValueMeta) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TaskOutput) EqualsDeep(other TaskOutput) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Type.EqualsDeep(other.Type) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBase64BinaryElement == nil) != (other.ValueBase64BinaryElement == nil) || r.ValueBase64BinaryElement != nil && !r.ValueBase64BinaryElement.EqualsDeep(*other.ValueBase64BinaryElement) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueBooleanElement == nil) != (other.ValueBooleanElement == nil) || r.ValueBooleanElement != nil && !r.ValueBooleanElement.EqualsDeep(*other.ValueBooleanElement) {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && !equivalentString(*r.ValueCanonical, *other.ValueCanonical) {
+		return false
+	}
+	if (r.ValueCanonicalElement == nil) != (other.ValueCanonicalElement == nil) || r.ValueCanonicalElement != nil && !r.ValueCanonicalElement.EqualsDeep(*other.ValueCanonicalElement) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueCodeElement == nil) != (other.ValueCodeElement == nil) || r.ValueCodeElement != nil && !r.ValueCodeElement.EqualsDeep(*other.ValueCodeElement) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateElement == nil) != (other.ValueDateElement == nil) || r.ValueDateElement != nil && !r.ValueDateElement.EqualsDeep(*other.ValueDateElement) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDateTimeElement == nil) != (other.ValueDateTimeElement == nil) || r.ValueDateTimeElement != nil && !r.ValueDateTimeElement.EqualsDeep(*other.ValueDateTimeElement) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueDecimalElement == nil) != (other.ValueDecimalElement == nil) || r.ValueDecimalElement != nil && !r.ValueDecimalElement.EqualsDeep(*other.ValueDecimalElement) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueIdElement == nil) != (other.ValueIdElement == nil) || r.ValueIdElement != nil && !r.ValueIdElement.EqualsDeep(*other.ValueIdElement) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && !equivalentString(*r.ValueInstant, *other.ValueInstant) {
+		return false
+	}
+	if (r.ValueInstantElement == nil) != (other.ValueInstantElement == nil) || r.ValueInstantElement != nil && !r.ValueInstantElement.EqualsDeep(*other.ValueInstantElement) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueIntegerElement == nil) != (other.ValueIntegerElement == nil) || r.ValueIntegerElement != nil && !r.ValueIntegerElement.EqualsDeep(*other.ValueIntegerElement) {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && !equivalentString(*r.ValueMarkdown, *other.ValueMarkdown) {
+		return false
+	}
+	if (r.ValueMarkdownElement == nil) != (other.ValueMarkdownElement == nil) || r.ValueMarkdownElement != nil && !r.ValueMarkdownElement.EqualsDeep(*other.ValueMarkdownElement) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && !equivalentString(*r.ValueOid, *other.ValueOid) {
+		return false
+	}
+	if (r.ValueOidElement == nil) != (other.ValueOidElement == nil) || r.ValueOidElement != nil && !r.ValueOidElement.EqualsDeep(*other.ValueOidElement) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValuePositiveIntElement == nil) != (other.ValuePositiveIntElement == nil) || r.ValuePositiveIntElement != nil && !r.ValuePositiveIntElement.EqualsDeep(*other.ValuePositiveIntElement) {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueStringElement == nil) != (other.ValueStringElement == nil) || r.ValueStringElement != nil && !r.ValueStringElement.EqualsDeep(*other.ValueStringElement) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueTimeElement == nil) != (other.ValueTimeElement == nil) || r.ValueTimeElement != nil && !r.ValueTimeElement.EqualsDeep(*other.ValueTimeElement) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUnsignedIntElement == nil) != (other.ValueUnsignedIntElement == nil) || r.ValueUnsignedIntElement != nil && !r.ValueUnsignedIntElement.EqualsDeep(*other.ValueUnsignedIntElement) {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUriElement == nil) != (other.ValueUriElement == nil) || r.ValueUriElement != nil && !r.ValueUriElement.EqualsDeep(*other.ValueUriElement) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && !equivalentString(*r.ValueUrl, *other.ValueUrl) {
+		return false
+	}
+	if (r.ValueUrlElement == nil) != (other.ValueUrlElement == nil) || r.ValueUrlElement != nil && !r.ValueUrlElement.EqualsDeep(*other.ValueUrlElement) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && !equivalentString(*r.ValueUuid, *other.ValueUuid) {
+		return false
+	}
+	if (r.ValueUuidElement == nil) != (other.ValueUuidElement == nil) || r.ValueUuidElement != nil && !r.ValueUuidElement.EqualsDeep(*other.ValueUuidElement) {
+		return false
+	}
+	if (r.ValueAddress == nil) != (other.ValueAddress == nil) || r.ValueAddress != nil && !r.ValueAddress.EqualsDeep(*other.ValueAddress) {
+		return false
+	}
+	if (r.ValueAge == nil) != (other.ValueAge == nil) || r.ValueAge != nil && !r.ValueAge.EqualsDeep(*other.ValueAge) {
+		return false
+	}
+	if (r.ValueAnnotation == nil) != (other.ValueAnnotation == nil) || r.ValueAnnotation != nil && !r.ValueAnnotation.EqualsDeep(*other.ValueAnnotation) {
+		return false
+	}
+	if (r.ValueAttachment == nil) != (other.ValueAttachment == nil) || r.ValueAttachment != nil && !r.ValueAttachment.EqualsDeep(*other.ValueAttachment) {
+		return false
+	}
+	if (r.ValueCodeableConcept == nil) != (other.ValueCodeableConcept == nil) || r.ValueCodeableConcept != nil && !r.ValueCodeableConcept.EqualsDeep(*other.ValueCodeableConcept) {
+		return false
+	}
+	if (r.ValueCoding == nil) != (other.ValueCoding == nil) || r.ValueCoding != nil && !r.ValueCoding.EqualsDeep(*other.ValueCoding) {
+		return false
+	}
+	if (r.ValueContactPoint == nil) != (other.ValueContactPoint == nil) || r.ValueContactPoint != nil && !r.ValueContactPoint.EqualsDeep(*other.ValueContactPoint) {
+		return false
+	}
+	if (r.ValueCount == nil) != (other.ValueCount == nil) || r.ValueCount != nil && !r.ValueCount.EqualsDeep(*other.ValueCount) {
+		return false
+	}
+	if (r.ValueDistance == nil) != (other.ValueDistance == nil) || r.ValueDistance != nil && !r.ValueDistance.EqualsDeep(*other.ValueDistance) {
+		return false
+	}
+	if (r.ValueDuration == nil) != (other.ValueDuration == nil) || r.ValueDuration != nil && !r.ValueDuration.EqualsDeep(*other.ValueDuration) {
+		return false
+	}
+	if (r.ValueHumanName == nil) != (other.ValueHumanName == nil) || r.ValueHumanName != nil && !r.ValueHumanName.EqualsDeep(*other.ValueHumanName) {
+		return false
+	}
+	if (r.ValueIdentifier == nil) != (other.ValueIdentifier == nil) || r.ValueIdentifier != nil && !r.ValueIdentifier.EqualsDeep(*other.ValueIdentifier) {
+		return false
+	}
+	if (r.ValueMoney == nil) != (other.ValueMoney == nil) || r.ValueMoney != nil && !r.ValueMoney.EqualsDeep(*other.ValueMoney) {
+		return false
+	}
+	if (r.ValuePeriod == nil) != (other.ValuePeriod == nil) || r.ValuePeriod != nil && !r.ValuePeriod.EqualsDeep(*other.ValuePeriod) {
+		return false
+	}
+	if (r.ValueQuantity == nil) != (other.ValueQuantity == nil) || r.ValueQuantity != nil && !r.ValueQuantity.EqualsDeep(*other.ValueQuantity) {
+		return false
+	}
+	if (r.ValueRange == nil) != (other.ValueRange == nil) || r.ValueRange != nil && !r.ValueRange.EqualsDeep(*other.ValueRange) {
+		return false
+	}
+	if (r.ValueRatio == nil) != (other.ValueRatio == nil) || r.ValueRatio != nil && !r.ValueRatio.EqualsDeep(*other.ValueRatio) {
+		return false
+	}
+	if (r.ValueReference == nil) != (other.ValueReference == nil) || r.ValueReference != nil && !r.ValueReference.EqualsDeep(*other.ValueReference) {
+		return false
+	}
+	if (r.ValueSampledData == nil) != (other.ValueSampledData == nil) || r.ValueSampledData != nil && !r.ValueSampledData.EqualsDeep(*other.ValueSampledData) {
+		return false
+	}
+	if (r.ValueSignature == nil) != (other.ValueSignature == nil) || r.ValueSignature != nil && !r.ValueSignature.EqualsDeep(*other.ValueSignature) {
+		return false
+	}
+	if (r.ValueTiming == nil) != (other.ValueTiming == nil) || r.ValueTiming != nil && !r.ValueTiming.EqualsDeep(*other.ValueTiming) {
+		return false
+	}
+	if (r.ValueContactDetail == nil) != (other.ValueContactDetail == nil) || r.ValueContactDetail != nil && !r.ValueContactDetail.EqualsDeep(*other.ValueContactDetail) {
+		return false
+	}
+	if (r.ValueContributor == nil) != (other.ValueContributor == nil) || r.ValueContributor != nil && !r.ValueContributor.EqualsDeep(*other.ValueContributor) {
+		return false
+	}
+	if (r.ValueDataRequirement == nil) != (other.ValueDataRequirement == nil) || r.ValueDataRequirement != nil && !r.ValueDataRequirement.EqualsDeep(*other.ValueDataRequirement) {
+		return false
+	}
+	if (r.ValueExpression == nil) != (other.ValueExpression == nil) || r.ValueExpression != nil && !r.ValueExpression.EqualsDeep(*other.ValueExpression) {
+		return false
+	}
+	if (r.ValueParameterDefinition == nil) != (other.ValueParameterDefinition == nil) || r.ValueParameterDefinition != nil && !r.ValueParameterDefinition.EqualsDeep(*other.ValueParameterDefinition) {
+		return false
+	}
+	if (r.ValueRelatedArtifact == nil) != (other.ValueRelatedArtifact == nil) || r.ValueRelatedArtifact != nil && !r.ValueRelatedArtifact.EqualsDeep(*other.ValueRelatedArtifact) {
+		return false
+	}
+	if (r.ValueTriggerDefinition == nil) != (other.ValueTriggerDefinition == nil) || r.ValueTriggerDefinition != nil && !r.ValueTriggerDefinition.EqualsDeep(*other.ValueTriggerDefinition) {
+		return false
+	}
+	if (r.ValueUsageContext == nil) != (other.ValueUsageContext == nil) || r.ValueUsageContext != nil && !r.ValueUsageContext.EqualsDeep(*other.ValueUsageContext) {
+		return false
+	}
+	if (r.ValueDosage == nil) != (other.ValueDosage == nil) || r.ValueDosage != nil && !r.ValueDosage.EqualsDeep(*other.ValueDosage) {
+		return false
+	}
+	if (r.ValueMeta == nil) != (other.ValueMeta == nil) || r.ValueMeta != nil && !r.ValueMeta.EqualsDeep(*other.ValueMeta) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TaskOutput) EqualsShallow(other TaskOutput) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValueBase64Binary == nil) != (other.ValueBase64Binary == nil) || r.ValueBase64Binary != nil && !equivalentString(*r.ValueBase64Binary, *other.ValueBase64Binary) {
+		return false
+	}
+	if (r.ValueBoolean == nil) != (other.ValueBoolean == nil) || r.ValueBoolean != nil && *r.ValueBoolean != *other.ValueBoolean {
+		return false
+	}
+	if (r.ValueCanonical == nil) != (other.ValueCanonical == nil) || r.ValueCanonical != nil && !equivalentString(*r.ValueCanonical, *other.ValueCanonical) {
+		return false
+	}
+	if (r.ValueCode == nil) != (other.ValueCode == nil) || r.ValueCode != nil && !equivalentString(*r.ValueCode, *other.ValueCode) {
+		return false
+	}
+	if (r.ValueDate == nil) != (other.ValueDate == nil) || r.ValueDate != nil && !equivalentString(*r.ValueDate, *other.ValueDate) {
+		return false
+	}
+	if (r.ValueDateTime == nil) != (other.ValueDateTime == nil) || r.ValueDateTime != nil && !equivalentString(*r.ValueDateTime, *other.ValueDateTime) {
+		return false
+	}
+	if (r.ValueDecimal == nil) != (other.ValueDecimal == nil) || r.ValueDecimal != nil && !equivalentDecimal(*r.ValueDecimal, *other.ValueDecimal) {
+		return false
+	}
+	if (r.ValueId == nil) != (other.ValueId == nil) || r.ValueId != nil && !equivalentString(*r.ValueId, *other.ValueId) {
+		return false
+	}
+	if (r.ValueInstant == nil) != (other.ValueInstant == nil) || r.ValueInstant != nil && !equivalentString(*r.ValueInstant, *other.ValueInstant) {
+		return false
+	}
+	if (r.ValueInteger == nil) != (other.ValueInteger == nil) || r.ValueInteger != nil && *r.ValueInteger != *other.ValueInteger {
+		return false
+	}
+	if (r.ValueMarkdown == nil) != (other.ValueMarkdown == nil) || r.ValueMarkdown != nil && !equivalentString(*r.ValueMarkdown, *other.ValueMarkdown) {
+		return false
+	}
+	if (r.ValueOid == nil) != (other.ValueOid == nil) || r.ValueOid != nil && !equivalentString(*r.ValueOid, *other.ValueOid) {
+		return false
+	}
+	if (r.ValuePositiveInt == nil) != (other.ValuePositiveInt == nil) || r.ValuePositiveInt != nil && *r.ValuePositiveInt != *other.ValuePositiveInt {
+		return false
+	}
+	if (r.ValueString == nil) != (other.ValueString == nil) || r.ValueString != nil && !equivalentString(*r.ValueString, *other.ValueString) {
+		return false
+	}
+	if (r.ValueTime == nil) != (other.ValueTime == nil) || r.ValueTime != nil && !equivalentString(*r.ValueTime, *other.ValueTime) {
+		return false
+	}
+	if (r.ValueUnsignedInt == nil) != (other.ValueUnsignedInt == nil) || r.ValueUnsignedInt != nil && *r.ValueUnsignedInt != *other.ValueUnsignedInt {
+		return false
+	}
+	if (r.ValueUri == nil) != (other.ValueUri == nil) || r.ValueUri != nil && !equivalentString(*r.ValueUri, *other.ValueUri) {
+		return false
+	}
+	if (r.ValueUrl == nil) != (other.ValueUrl == nil) || r.ValueUrl != nil && !equivalentString(*r.ValueUrl, *other.ValueUrl) {
+		return false
+	}
+	if (r.ValueUuid == nil) != (other.ValueUuid == nil) || r.ValueUuid != nil && !equivalentString(*r.ValueUuid, *other.ValueUuid) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TaskOutput as FHIR XML
+func (r TaskOutput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBase64Binary", r.ValueBase64Binary, r.ValueBase64BinaryElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueBoolean", r.ValueBoolean, r.ValueBooleanElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCanonical", r.ValueCanonical, r.ValueCanonicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueCode", r.ValueCode, r.ValueCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDate", r.ValueDate, r.ValueDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDateTime", r.ValueDateTime, r.ValueDateTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueDecimal", r.ValueDecimal, r.ValueDecimalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueId", r.ValueId, r.ValueIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInstant", r.ValueInstant, r.ValueInstantElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueInteger", r.ValueInteger, r.ValueIntegerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueMarkdown", r.ValueMarkdown, r.ValueMarkdownElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueOid", r.ValueOid, r.ValueOidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valuePositiveInt", r.ValuePositiveInt, r.ValuePositiveIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueString", r.ValueString, r.ValueStringElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueTime", r.ValueTime, r.ValueTimeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUnsignedInt", r.ValueUnsignedInt, r.ValueUnsignedIntElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUri", r.ValueUri, r.ValueUriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUrl", r.ValueUrl, r.ValueUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "valueUuid", r.ValueUuid, r.ValueUuidElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAddress", r.ValueAddress); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAge", r.ValueAge); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAnnotation", r.ValueAnnotation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueAttachment", r.ValueAttachment); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCodeableConcept", r.ValueCodeableConcept); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCoding", r.ValueCoding); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContactPoint", r.ValueContactPoint); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueCount", r.ValueCount); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDistance", r.ValueDistance); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDuration", r.ValueDuration); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueHumanName", r.ValueHumanName); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueIdentifier", r.ValueIdentifier); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueMoney", r.ValueMoney); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valuePeriod", r.ValuePeriod); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueQuantity", r.ValueQuantity); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRange", r.ValueRange); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRatio", r.ValueRatio); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueReference", r.ValueReference); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSampledData", r.ValueSampledData); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueSignature", r.ValueSignature); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueTiming", r.ValueTiming); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContactDetail", r.ValueContactDetail); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueContributor", r.ValueContributor); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDataRequirement", r.ValueDataRequirement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueExpression", r.ValueExpression); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueParameterDefinition", r.ValueParameterDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueRelatedArtifact", r.ValueRelatedArtifact); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueTriggerDefinition", r.ValueTriggerDefinition); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueUsageContext", r.ValueUsageContext); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueDosage", r.ValueDosage); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "valueMeta", r.ValueMeta); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TaskOutput from FHIR XML
+func (r *TaskOutput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = v
+			case "valueBase64Binary":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBase64Binary = &v
+				}
+				r.ValueBase64BinaryElement = element
+			case "valueBoolean":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueBoolean = &v
+				}
+				r.ValueBooleanElement = element
+			case "valueCanonical":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCanonical = &v
+				}
+				r.ValueCanonicalElement = element
+			case "valueCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueCode = &v
+				}
+				r.ValueCodeElement = element
+			case "valueDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDate = &v
+				}
+				r.ValueDateElement = element
+			case "valueDateTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDateTime = &v
+				}
+				r.ValueDateTimeElement = element
+			case "valueDecimal":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueDecimal = &v
+				}
+				r.ValueDecimalElement = element
+			case "valueId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueId = &v
+				}
+				r.ValueIdElement = element
+			case "valueInstant":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInstant = &v
+				}
+				r.ValueInstantElement = element
+			case "valueInteger":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueInteger = &v
+				}
+				r.ValueIntegerElement = element
+			case "valueMarkdown":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueMarkdown = &v
+				}
+				r.ValueMarkdownElement = element
+			case "valueOid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueOid = &v
+				}
+				r.ValueOidElement = element
+			case "valuePositiveInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValuePositiveInt = &v
+				}
+				r.ValuePositiveIntElement = element
+			case "valueString":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueString = &v
+				}
+				r.ValueStringElement = element
+			case "valueTime":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueTime = &v
+				}
+				r.ValueTimeElement = element
+			case "valueUnsignedInt":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUnsignedInt = &v
+				}
+				r.ValueUnsignedIntElement = element
+			case "valueUri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUri = &v
+				}
+				r.ValueUriElement = element
+			case "valueUrl":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUrl = &v
+				}
+				r.ValueUrlElement = element
+			case "valueUuid":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValueUuid = &v
+				}
+				r.ValueUuidElement = element
+			case "valueAddress":
+				var v Address
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAddress = &v
+			case "valueAge":
+				var v Age
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAge = &v
+			case "valueAnnotation":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAnnotation = &v
+			case "valueAttachment":
+				var v Attachment
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueAttachment = &v
+			case "valueCodeableConcept":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCodeableConcept = &v
+			case "valueCoding":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCoding = &v
+			case "valueContactPoint":
+				var v ContactPoint
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContactPoint = &v
+			case "valueCount":
+				var v Count
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueCount = &v
+			case "valueDistance":
+				var v Distance
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDistance = &v
+			case "valueDuration":
+				var v Duration
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDuration = &v
+			case "valueHumanName":
+				var v HumanName
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueHumanName = &v
+			case "valueIdentifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueIdentifier = &v
+			case "valueMoney":
+				var v Money
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueMoney = &v
+			case "valuePeriod":
+				var v Period
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValuePeriod = &v
+			case "valueQuantity":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueQuantity = &v
+			case "valueRange":
+				var v Range
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRange = &v
+			case "valueRatio":
+				var v Ratio
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRatio = &v
+			case "valueReference":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueReference = &v
+			case "valueSampledData":
+				var v SampledData
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSampledData = &v
+			case "valueSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueSignature = &v
+			case "valueTiming":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueTiming = &v
+			case "valueContactDetail":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContactDetail = &v
+			case "valueContributor":
+				var v Contributor
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueContributor = &v
+			case "valueDataRequirement":
+				var v DataRequirement
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDataRequirement = &v
+			case "valueExpression":
+				var v Expression
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueExpression = &v
+			case "valueParameterDefinition":
+				var v ParameterDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueParameterDefinition = &v
+			case "valueRelatedArtifact":
+				var v RelatedArtifact
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueRelatedArtifact = &v
+			case "valueTriggerDefinition":
+				var v TriggerDefinition
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueTriggerDefinition = &v
+			case "valueUsageContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueUsageContext = &v
+			case "valueDosage":
+				var v Dosage
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueDosage = &v
+			case "valueMeta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValueMeta = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TaskOutput as properties to the RDF node n
+func (r TaskOutput) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("Task.output.type", -1, r.Type)
+	n.primitive("Task.output.valueBase64Binary", -1, r.ValueBase64Binary, r.ValueBase64BinaryElement, "base64Binary")
+	n.primitive("Task.output.valueBoolean", -1, r.ValueBoolean, r.ValueBooleanElement, "boolean")
+	n.primitive("Task.output.valueCanonical", -1, r.ValueCanonical, r.ValueCanonicalElement, "canonical")
+	n.primitive("Task.output.valueCode", -1, r.ValueCode, r.ValueCodeElement, "code")
+	n.primitive("Task.output.valueDate", -1, r.ValueDate, r.ValueDateElement, "date")
+	n.primitive("Task.output.valueDateTime", -1, r.ValueDateTime, r.ValueDateTimeElement, "dateTime")
+	n.primitive("Task.output.valueDecimal", -1, r.ValueDecimal, r.ValueDecimalElement, "decimal")
+	n.primitive("Task.output.valueId", -1, r.ValueId, r.ValueIdElement, "id")
+	n.primitive("Task.output.valueInstant", -1, r.ValueInstant, r.ValueInstantElement, "instant")
+	n.primitive("Task.output.valueInteger", -1, r.ValueInteger, r.ValueIntegerElement, "integer")
+	n.primitive("Task.output.valueMarkdown", -1, r.ValueMarkdown, r.ValueMarkdownElement, "markdown")
+	n.primitive("Task.output.valueOid", -1, r.ValueOid, r.ValueOidElement, "oid")
+	n.primitive("Task.output.valuePositiveInt", -1, r.ValuePositiveInt, r.ValuePositiveIntElement, "positiveInt")
+	n.primitive("Task.output.valueString", -1, r.ValueString, r.ValueStringElement, "string")
+	n.primitive("Task.output.valueTime", -1, r.ValueTime, r.ValueTimeElement, "time")
+	n.primitive("Task.output.valueUnsignedInt", -1, r.ValueUnsignedInt, r.ValueUnsignedIntElement, "unsignedInt")
+	n.primitive("Task.output.valueUri", -1, r.ValueUri, r.ValueUriElement, "uri")
+	n.primitive("Task.output.valueUrl", -1, r.ValueUrl, r.ValueUrlElement, "url")
+	n.primitive("Task.output.valueUuid", -1, r.ValueUuid, r.ValueUuidElement, "uuid")
+	n.element("Task.output.valueAddress", -1, r.ValueAddress)
+	n.element("Task.output.valueAge", -1, r.ValueAge)
+	n.element("Task.output.valueAnnotation", -1, r.ValueAnnotation)
+	n.element("Task.output.valueAttachment", -1, r.ValueAttachment)
+	n.element("Task.output.valueCodeableConcept", -1, r.ValueCodeableConcept)
+	n.element("Task.output.valueCoding", -1, r.ValueCoding)
+	n.element("Task.output.valueContactPoint", -1, r.ValueContactPoint)
+	n.element("Task.output.valueCount", -1, r.ValueCount)
+	n.element("Task.output.valueDistance", -1, r.ValueDistance)
+	n.element("Task.output.valueDuration", -1, r.ValueDuration)
+	n.element("Task.output.valueHumanName", -1, r.ValueHumanName)
+	n.element("Task.output.valueIdentifier", -1, r.ValueIdentifier)
+	n.element("Task.output.valueMoney", -1, r.ValueMoney)
+	n.element("Task.output.valuePeriod", -1, r.ValuePeriod)
+	n.element("Task.output.valueQuantity", -1, r.ValueQuantity)
+	n.element("Task.output.valueRange", -1, r.ValueRange)
+	n.element("Task.output.valueRatio", -1, r.ValueRatio)
+	n.element("Task.output.valueReference", -1, r.ValueReference)
+	n.element("Task.output.valueSampledData", -1, r.ValueSampledData)
+	n.element("Task.output.valueSignature", -1, r.ValueSignature)
+	n.element("Task.output.valueTiming", -1, r.ValueTiming)
+	n.element("Task.output.valueContactDetail", -1, r.ValueContactDetail)
+	n.element("Task.output.valueContributor", -1, r.ValueContributor)
+	n.element("Task.output.valueDataRequirement", -1, r.ValueDataRequirement)
+	n.element("Task.output.valueExpression", -1, r.ValueExpression)
+	n.element("Task.output.valueParameterDefinition", -1, r.ValueParameterDefinition)
+	n.element("Task.output.valueRelatedArtifact", -1, r.ValueRelatedArtifact)
+	n.element("Task.output.valueTriggerDefinition", -1, r.ValueTriggerDefinition)
+	n.element("Task.output.valueUsageContext", -1, r.ValueUsageContext)
+	n.element("Task.output.valueDosage", -1, r.ValueDosage)
+	n.element("Task.output.valueMeta", -1, r.ValueMeta)
+}
+
+// MarshalBSON marshals the given TaskOutput as BSON document with the structure of its FHIR JSON
+func (r TaskOutput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TaskOutput from a BSON document with the structure of its FHIR JSON
+func (r *TaskOutput) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TaskOutput as protobuf message TaskOutput of fhir.proto
+func (r TaskOutput) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TaskOutput to the encoded message
+func (r TaskOutput) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Type)
+	if r.ValueBase64Binary != nil {
+		e.string(5, *r.ValueBase64Binary)
+	}
+	if r.ValueBoolean != nil {
+		e.varint(6, protoBool(*r.ValueBoolean))
+	}
+	if r.ValueCanonical != nil {
+		e.string(7, *r.ValueCanonical)
+	}
+	if r.ValueCode != nil {
+		e.string(8, *r.ValueCode)
+	}
+	if r.ValueDate != nil {
+		e.string(9, *r.ValueDate)
+	}
+	if r.ValueDateTime != nil {
+		e.string(10, *r.ValueDateTime)
+	}
+	if r.ValueDecimal != nil {
+		e.string(11, string(*r.ValueDecimal))
+	}
+	if r.ValueId != nil {
+		e.string(12, *r.ValueId)
+	}
+	if r.ValueInstant != nil {
+		e.string(13, *r.ValueInstant)
+	}
+	if r.ValueInteger != nil {
+		e.varint(14, uint64(int64(*r.ValueInteger)))
+	}
+	if r.ValueMarkdown != nil {
+		e.string(15, *r.ValueMarkdown)
+	}
+	if r.ValueOid != nil {
+		e.string(16, *r.ValueOid)
+	}
+	if r.ValuePositiveInt != nil {
+		e.varint(17, uint64(int64(*r.ValuePositiveInt)))
+	}
+	if r.ValueString != nil {
+		e.string(18, *r.ValueString)
+	}
+	if r.ValueTime != nil {
+		e.string(19, *r.ValueTime)
+	}
+	if r.ValueUnsignedInt != nil {
+		e.varint(20, uint64(int64(*r.ValueUnsignedInt)))
+	}
+	if r.ValueUri != nil {
+		e.string(21, *r.ValueUri)
+	}
+	if r.ValueUrl != nil {
+		e.string(22, *r.ValueUrl)
+	}
+	if r.ValueUuid != nil {
+		e.string(23, *r.ValueUuid)
+	}
+	if r.ValueAddress != nil {
+		e.message(24, *r.ValueAddress)
+	}
+	if r.ValueAge != nil {
+		e.message(25, *r.ValueAge)
+	}
+	if r.ValueAnnotation != nil {
+		e.message(26, *r.ValueAnnotation)
+	}
+	if r.ValueAttachment != nil {
+		e.message(27, *r.ValueAttachment)
+	}
+	if r.ValueCodeableConcept != nil {
+		e.message(28, *r.ValueCodeableConcept)
+	}
+	if r.ValueCoding != nil {
+		e.message(29, *r.ValueCoding)
+	}
+	if r.ValueContactPoint != nil {
+		e.message(30, *r.ValueContactPoint)
+	}
+	if r.ValueCount != nil {
+		e.message(31, *r.ValueCount)
+	}
+	if r.ValueDistance != nil {
+		e.message(32, *r.ValueDistance)
+	}
+	if r.ValueDuration != nil {
+		e.message(33, *r.ValueDuration)
+	}
+	if r.ValueHumanName != nil {
+		e.message(34, *r.ValueHumanName)
+	}
+	if r.ValueIdentifier != nil {
+		e.message(35, *r.ValueIdentifier)
+	}
+	if r.ValueMoney != nil {
+		e.message(36, *r.ValueMoney)
+	}
+	if r.ValuePeriod != nil {
+		e.message(37, *r.ValuePeriod)
+	}
+	if r.ValueQuantity != nil {
+		e.message(38, *r.ValueQuantity)
+	}
+	if r.ValueRange != nil {
+		e.message(39, *r.ValueRange)
+	}
+	if r.ValueRatio != nil {
+		e.message(40, *r.ValueRatio)
+	}
+	if r.ValueReference != nil {
+		e.message(41, *r.ValueReference)
+	}
+	if r.ValueSampledData != nil {
+		e.message(42, *r.ValueSampledData)
+	}
+	if r.ValueSignature != nil {
+		e.message(43, *r.ValueSignature)
+	}
+	if r.ValueTiming != nil {
+		e.message(44, *r.ValueTiming)
+	}
+	if r.ValueContactDetail != nil {
+		e.message(45, *r.ValueContactDetail)
+	}
+	if r.ValueContributor != nil {
+		e.message(46, *r.ValueContributor)
+	}
+	if r.ValueDataRequirement != nil {
+		e.message(47, *r.ValueDataRequirement)
+	}
+	if r.ValueExpression != nil {
+		e.message(48, *r.ValueExpression)
+	}
+	if r.ValueParameterDefinition != nil {
+		e.message(49, *r.ValueParameterDefinition)
+	}
+	if r.ValueRelatedArtifact != nil {
+		e.message(50, *r.ValueRelatedArtifact)
+	}
+	if r.ValueTriggerDefinition != nil {
+		e.message(51, *r.ValueTriggerDefinition)
+	}
+	if r.ValueUsageContext != nil {
+		e.message(52, *r.ValueUsageContext)
+	}
+	if r.ValueDosage != nil {
+		e.message(53, *r.ValueDosage)
+	}
+	if r.ValueMeta != nil {
+		e.message(54, *r.ValueMeta)
+	}
+	if r.ValueBase64BinaryElement != nil {
+		e.message(55, *r.ValueBase64BinaryElement)
+	}
+	if r.ValueBooleanElement != nil {
+		e.message(56, *r.ValueBooleanElement)
+	}
+	if r.ValueCanonicalElement != nil {
+		e.message(57, *r.ValueCanonicalElement)
+	}
+	if r.ValueCodeElement != nil {
+		e.message(58, *r.ValueCodeElement)
+	}
+	if r.ValueDateElement != nil {
+		e.message(59, *r.ValueDateElement)
+	}
+	if r.ValueDateTimeElement != nil {
+		e.message(60, *r.ValueDateTimeElement)
+	}
+	if r.ValueDecimalElement != nil {
+		e.message(61, *r.ValueDecimalElement)
+	}
+	if r.ValueIdElement != nil {
+		e.message(62, *r.ValueIdElement)
+	}
+	if r.ValueInstantElement != nil {
+		e.message(63, *r.ValueInstantElement)
+	}
+	if r.ValueIntegerElement != nil {
+		e.message(64, *r.ValueIntegerElement)
+	}
+	if r.ValueMarkdownElement != nil {
+		e.message(65, *r.ValueMarkdownElement)
+	}
+	if r.ValueOidElement != nil {
+		e.message(66, *r.ValueOidElement)
+	}
+	if r.ValuePositiveIntElement != nil {
+		e.message(67, *r.ValuePositiveIntElement)
+	}
+	if r.ValueStringElement != nil {
+		e.message(68, *r.ValueStringElement)
+	}
+	if r.ValueTimeElement != nil {
+		e.message(69, *r.ValueTimeElement)
+	}
+	if r.ValueUnsignedIntElement != nil {
+		e.message(70, *r.ValueUnsignedIntElement)
+	}
+	if r.ValueUriElement != nil {
+		e.message(71, *r.ValueUriElement)
+	}
+	if r.ValueUrlElement != nil {
+		e.message(72, *r.ValueUrlElement)
+	}
+	if r.ValueUuidElement != nil {
+		e.message(73, *r.ValueUuidElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TaskOutput from protobuf message TaskOutput of fhir.proto
+func (r *TaskOutput) UnmarshalProto(b []byte) error {
+	*r = TaskOutput{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = v
+		case 5:
+			v := d.string()
+			r.ValueBase64Binary = &v
+		case 6:
+			v := d.bool()
+			r.ValueBoolean = &v
+		case 7:
+			v := d.string()
+			r.ValueCanonical = &v
+		case 8:
+			v := d.string()
+			r.ValueCode = &v
+		case 9:
+			v := d.string()
+			r.ValueDate = &v
+		case 10:
+			v := d.string()
+			r.ValueDateTime = &v
+		case 11:
+			v := json.Number(d.string())
+			r.ValueDecimal = &v
+		case 12:
+			v := d.string()
+			r.ValueId = &v
+		case 13:
+			v := d.string()
+			r.ValueInstant = &v
+		case 14:
+			v := d.int()
+			r.ValueInteger = &v
+		case 15:
+			v := d.string()
+			r.ValueMarkdown = &v
+		case 16:
+			v := d.string()
+			r.ValueOid = &v
+		case 17:
+			v := d.int()
+			r.ValuePositiveInt = &v
+		case 18:
+			v := d.string()
+			r.ValueString = &v
+		case 19:
+			v := d.string()
+			r.ValueTime = &v
+		case 20:
+			v := d.int()
+			r.ValueUnsignedInt = &v
+		case 21:
+			v := d.string()
+			r.ValueUri = &v
+		case 22:
+			v := d.string()
+			r.ValueUrl = &v
+		case 23:
+			v := d.string()
+			r.ValueUuid = &v
+		case 24:
+			var v Address
+			d.message(&v)
+			r.ValueAddress = &v
+		case 25:
+			var v Age
+			d.message(&v)
+			r.ValueAge = &v
+		case 26:
+			var v Annotation
+			d.message(&v)
+			r.ValueAnnotation = &v
+		case 27:
+			var v Attachment
+			d.message(&v)
+			r.ValueAttachment = &v
+		case 28:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValueCodeableConcept = &v
+		case 29:
+			var v Coding
+			d.message(&v)
+			r.ValueCoding = &v
+		case 30:
+			var v ContactPoint
+			d.message(&v)
+			r.ValueContactPoint = &v
+		case 31:
+			var v Count
+			d.message(&v)
+			r.ValueCount = &v
+		case 32:
+			var v Distance
+			d.message(&v)
+			r.ValueDistance = &v
+		case 33:
+			var v Duration
+			d.message(&v)
+			r.ValueDuration = &v
+		case 34:
+			var v HumanName
+			d.message(&v)
+			r.ValueHumanName = &v
+		case 35:
+			var v Identifier
+			d.message(&v)
+			r.ValueIdentifier = &v
+		case 36:
+			var v Money
+			d.message(&v)
+			r.ValueMoney = &v
+		case 37:
+			var v Period
+			d.message(&v)
+			r.ValuePeriod = &v
+		case 38:
+			var v Quantity
+			d.message(&v)
+			r.ValueQuantity = &v
+		case 39:
+			var v Range
+			d.message(&v)
+			r.ValueRange = &v
+		case 40:
+			var v Ratio
+			d.message(&v)
+			r.ValueRatio = &v
+		case 41:
+			var v Reference
+			d.message(&v)
+			r.ValueReference = &v
+		case 42:
+			var v SampledData
+			d.message(&v)
+			r.ValueSampledData = &v
+		case 43:
+			var v Signature
+			d.message(&v)
+			r.ValueSignature = &v
+		case 44:
+			var v Timing
+			d.message(&v)
+			r.ValueTiming = &v
+		case 45:
+			var v ContactDetail
+			d.message(&v)
+			r.ValueContactDetail = &v
+		case 46:
+			var v Contributor
+			d.message(&v)
+			r.ValueContributor = &v
+		case 47:
+			var v DataRequirement
+			d.message(&v)
+			r.ValueDataRequirement = &v
+		case 48:
+			var v Expression
+			d.message(&v)
+			r.ValueExpression = &v
+		case 49:
+			var v ParameterDefinition
+			d.message(&v)
+			r.ValueParameterDefinition = &v
+		case 50:
+			var v RelatedArtifact
+			d.message(&v)
+			r.ValueRelatedArtifact = &v
+		case 51:
+			var v TriggerDefinition
+			d.message(&v)
+			r.ValueTriggerDefinition = &v
+		case 52:
+			var v UsageContext
+			d.message(&v)
+			r.ValueUsageContext = &v
+		case 53:
+			var v Dosage
+			d.message(&v)
+			r.ValueDosage = &v
+		case 54:
+			var v Meta
+			d.message(&v)
+			r.ValueMeta = &v
+		case 55:
+			var v Element
+			d.message(&v)
+			r.ValueBase64BinaryElement = &v
+		case 56:
+			var v Element
+			d.message(&v)
+			r.ValueBooleanElement = &v
+		case 57:
+			var v Element
+			d.message(&v)
+			r.ValueCanonicalElement = &v
+		case 58:
+			var v Element
+			d.message(&v)
+			r.ValueCodeElement = &v
+		case 59:
+			var v Element
+			d.message(&v)
+			r.ValueDateElement = &v
+		case 60:
+			var v Element
+			d.message(&v)
+			r.ValueDateTimeElement = &v
+		case 61:
+			var v Element
+			d.message(&v)
+			r.ValueDecimalElement = &v
+		case 62:
+			var v Element
+			d.message(&v)
+			r.ValueIdElement = &v
+		case 63:
+			var v Element
+			d.message(&v)
+			r.ValueInstantElement = &v
+		case 64:
+			var v Element
+			d.message(&v)
+			r.ValueIntegerElement = &v
+		case 65:
+			var v Element
+			d.message(&v)
+			r.ValueMarkdownElement = &v
+		case 66:
+			var v Element
+			d.message(&v)
+			r.ValueOidElement = &v
+		case 67:
+			var v Element
+			d.message(&v)
+			r.ValuePositiveIntElement = &v
+		case 68:
+			var v Element
+			d.message(&v)
+			r.ValueStringElement = &v
+		case 69:
+			var v Element
+			d.message(&v)
+			r.ValueTimeElement = &v
+		case 70:
+			var v Element
+			d.message(&v)
+			r.ValueUnsignedIntElement = &v
+		case 71:
+			var v Element
+			d.message(&v)
+			r.ValueUriElement = &v
+		case 72:
+			var v Element
+			d.message(&v)
+			r.ValueUrlElement = &v
+		case 73:
+			var v Element
+			d.message(&v)
+			r.ValueUuidElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalTask unmarshals a Task.
 func UnmarshalTask(b []byte) (Task, error) {
 	var task Task
-	if err := json.Unmarshal(b, &task); err != nil {
+	if err := task.UnmarshalJSON(b); err != nil {
 		return task, err
 	}
 	return task, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -44,8 +43,13 @@ const (
 func (code TaskStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *TaskStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *TaskStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "draft":
 		*code = TaskStatusDraft
@@ -76,6 +80,12 @@ func (code *TaskStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code TaskStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TaskStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code TaskStatus) String() string {
 	return code.Code()
 }
@@ -166,3 +176,63 @@ func (code TaskStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code TaskStatus) protoNumber() uint64 {
+	switch code {
+	case TaskStatusDraft:
+		return 1
+	case TaskStatusRequested:
+		return 2
+	case TaskStatusReceived:
+		return 3
+	case TaskStatusAccepted:
+		return 4
+	case TaskStatusRejected:
+		return 5
+	case TaskStatusReady:
+		return 6
+	case TaskStatusCancelled:
+		return 7
+	case TaskStatusInProgress:
+		return 8
+	case TaskStatusOnHold:
+		return 9
+	case TaskStatusFailed:
+		return 10
+	case TaskStatusCompleted:
+		return 11
+	case TaskStatusEnteredInError:
+		return 12
+	}
+	return 0
+}
+func (code *TaskStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TaskStatusDraft
+	case 2:
+		*code = TaskStatusRequested
+	case 3:
+		*code = TaskStatusReceived
+	case 4:
+		*code = TaskStatusAccepted
+	case 5:
+		*code = TaskStatusRejected
+	case 6:
+		*code = TaskStatusReady
+	case 7:
+		*code = TaskStatusCancelled
+	case 8:
+		*code = TaskStatusInProgress
+	case 9:
+		*code = TaskStatusOnHold
+	case 10:
+		*code = TaskStatusFailed
+	case 11:
+		*code = TaskStatusCompleted
+	case 12:
+		*code = TaskStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
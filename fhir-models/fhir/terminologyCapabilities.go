@@ -14,137 +14,6632 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // TerminologyCapabilities is documented here http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities
 type TerminologyCapabilities struct {
-	Id                *string                                `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                                `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               *string                                `bson:"url,omitempty" json:"url,omitempty"`
-	Version           *string                                `bson:"version,omitempty" json:"version,omitempty"`
-	Name              *string                                `bson:"name,omitempty" json:"name,omitempty"`
-	Title             *string                                `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus                      `bson:"status" json:"status"`
-	Experimental      *bool                                  `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              string                                 `bson:"date" json:"date"`
-	Publisher         *string                                `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail                        `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                                `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext                         `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept                      `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                                `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                                `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Kind              CapabilityStatementKind                `bson:"kind" json:"kind"`
-	Software          *TerminologyCapabilitiesSoftware       `bson:"software,omitempty" json:"software,omitempty"`
-	Implementation    *TerminologyCapabilitiesImplementation `bson:"implementation,omitempty" json:"implementation,omitempty"`
-	LockedDate        *bool                                  `bson:"lockedDate,omitempty" json:"lockedDate,omitempty"`
-	CodeSystem        []TerminologyCapabilitiesCodeSystem    `bson:"codeSystem,omitempty" json:"codeSystem,omitempty"`
-	Expansion         *TerminologyCapabilitiesExpansion      `bson:"expansion,omitempty" json:"expansion,omitempty"`
-	CodeSearch        *CodeSearchSupport                     `bson:"codeSearch,omitempty" json:"codeSearch,omitempty"`
-	ValidateCode      *TerminologyCapabilitiesValidateCode   `bson:"validateCode,omitempty" json:"validateCode,omitempty"`
-	Translation       *TerminologyCapabilitiesTranslation    `bson:"translation,omitempty" json:"translation,omitempty"`
-	Closure           *TerminologyCapabilitiesClosure        `bson:"closure,omitempty" json:"closure,omitempty"`
+	Id                   *string                                `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                               `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                               `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                                `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                               `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage                      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  *string                                `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement           *Element                               `bson:"_url,omitempty" json:"_url,omitempty"`
+	Version              *string                                `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                               `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 *string                                `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                               `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                                `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                               `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus                      `bson:"status" json:"status"`
+	StatusElement        *Element                               `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                                  `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                               `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 string                                 `bson:"date" json:"date"`
+	DateElement          *Element                               `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                                `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                               `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail                        `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                                `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                               `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext                         `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept                      `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                                `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                               `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright            *string                                `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                               `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Kind                 CapabilityStatementKind                `bson:"kind" json:"kind"`
+	KindElement          *Element                               `bson:"_kind,omitempty" json:"_kind,omitempty"`
+	Software             *TerminologyCapabilitiesSoftware       `bson:"software,omitempty" json:"software,omitempty"`
+	Implementation       *TerminologyCapabilitiesImplementation `bson:"implementation,omitempty" json:"implementation,omitempty"`
+	LockedDate           *bool                                  `bson:"lockedDate,omitempty" json:"lockedDate,omitempty"`
+	LockedDateElement    *Element                               `bson:"_lockedDate,omitempty" json:"_lockedDate,omitempty"`
+	CodeSystem           []TerminologyCapabilitiesCodeSystem    `bson:"codeSystem,omitempty" json:"codeSystem,omitempty"`
+	Expansion            *TerminologyCapabilitiesExpansion      `bson:"expansion,omitempty" json:"expansion,omitempty"`
+	CodeSearch           *CodeSearchSupport                     `bson:"codeSearch,omitempty" json:"codeSearch,omitempty"`
+	CodeSearchElement    *Element                               `bson:"_codeSearch,omitempty" json:"_codeSearch,omitempty"`
+	ValidateCode         *TerminologyCapabilitiesValidateCode   `bson:"validateCode,omitempty" json:"validateCode,omitempty"`
+	Translation          *TerminologyCapabilitiesTranslation    `bson:"translation,omitempty" json:"translation,omitempty"`
+	Closure              *TerminologyCapabilitiesClosure        `bson:"closure,omitempty" json:"closure,omitempty"`
 }
 type TerminologyCapabilitiesSoftware struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Name              string      `bson:"name" json:"name"`
+	NameElement       *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
 	Version           *string     `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement    *Element    `bson:"_version,omitempty" json:"_version,omitempty"`
 }
 type TerminologyCapabilitiesImplementation struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Description       string      `bson:"description" json:"description"`
-	Url               *string     `bson:"url,omitempty" json:"url,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Description        string      `bson:"description" json:"description"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Url                *string     `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement         *Element    `bson:"_url,omitempty" json:"_url,omitempty"`
 }
 type TerminologyCapabilitiesCodeSystem struct {
-	Id                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Uri               *string                                    `bson:"uri,omitempty" json:"uri,omitempty"`
-	Version           []TerminologyCapabilitiesCodeSystemVersion `bson:"version,omitempty" json:"version,omitempty"`
-	Subsumption       *bool                                      `bson:"subsumption,omitempty" json:"subsumption,omitempty"`
+	Id                 *string                                    `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Uri                *string                                    `bson:"uri,omitempty" json:"uri,omitempty"`
+	UriElement         *Element                                   `bson:"_uri,omitempty" json:"_uri,omitempty"`
+	Version            []TerminologyCapabilitiesCodeSystemVersion `bson:"version,omitempty" json:"version,omitempty"`
+	Subsumption        *bool                                      `bson:"subsumption,omitempty" json:"subsumption,omitempty"`
+	SubsumptionElement *Element                                   `bson:"_subsumption,omitempty" json:"_subsumption,omitempty"`
 }
 type TerminologyCapabilitiesCodeSystemVersion struct {
-	Id                *string                                          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Code              *string                                          `bson:"code,omitempty" json:"code,omitempty"`
-	IsDefault         *bool                                            `bson:"isDefault,omitempty" json:"isDefault,omitempty"`
-	Compositional     *bool                                            `bson:"compositional,omitempty" json:"compositional,omitempty"`
-	Language          []string                                         `bson:"language,omitempty" json:"language,omitempty"`
-	Filter            []TerminologyCapabilitiesCodeSystemVersionFilter `bson:"filter,omitempty" json:"filter,omitempty"`
-	Property          []string                                         `bson:"property,omitempty" json:"property,omitempty"`
+	Id                   *string                                          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension                                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code                 *string                                          `bson:"code,omitempty" json:"code,omitempty"`
+	CodeElement          *Element                                         `bson:"_code,omitempty" json:"_code,omitempty"`
+	IsDefault            *bool                                            `bson:"isDefault,omitempty" json:"isDefault,omitempty"`
+	IsDefaultElement     *Element                                         `bson:"_isDefault,omitempty" json:"_isDefault,omitempty"`
+	Compositional        *bool                                            `bson:"compositional,omitempty" json:"compositional,omitempty"`
+	CompositionalElement *Element                                         `bson:"_compositional,omitempty" json:"_compositional,omitempty"`
+	Language             []string                                         `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      []*Element                                       `bson:"_language,omitempty" json:"_language,omitempty"`
+	Filter               []TerminologyCapabilitiesCodeSystemVersionFilter `bson:"filter,omitempty" json:"filter,omitempty"`
+	Property             []string                                         `bson:"property,omitempty" json:"property,omitempty"`
+	PropertyElement      []*Element                                       `bson:"_property,omitempty" json:"_property,omitempty"`
 }
 type TerminologyCapabilitiesCodeSystemVersionFilter struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Code              string      `bson:"code" json:"code"`
+	CodeElement       *Element    `bson:"_code,omitempty" json:"_code,omitempty"`
 	Op                []string    `bson:"op" json:"op"`
+	OpElement         []*Element  `bson:"_op,omitempty" json:"_op,omitempty"`
 }
 type TerminologyCapabilitiesExpansion struct {
-	Id                *string                                     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                 `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Hierarchical      *bool                                       `bson:"hierarchical,omitempty" json:"hierarchical,omitempty"`
-	Paging            *bool                                       `bson:"paging,omitempty" json:"paging,omitempty"`
-	Incomplete        *bool                                       `bson:"incomplete,omitempty" json:"incomplete,omitempty"`
-	Parameter         []TerminologyCapabilitiesExpansionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
-	TextFilter        *string                                     `bson:"textFilter,omitempty" json:"textFilter,omitempty"`
+	Id                  *string                                     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension                                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension                                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Hierarchical        *bool                                       `bson:"hierarchical,omitempty" json:"hierarchical,omitempty"`
+	HierarchicalElement *Element                                    `bson:"_hierarchical,omitempty" json:"_hierarchical,omitempty"`
+	Paging              *bool                                       `bson:"paging,omitempty" json:"paging,omitempty"`
+	PagingElement       *Element                                    `bson:"_paging,omitempty" json:"_paging,omitempty"`
+	Incomplete          *bool                                       `bson:"incomplete,omitempty" json:"incomplete,omitempty"`
+	IncompleteElement   *Element                                    `bson:"_incomplete,omitempty" json:"_incomplete,omitempty"`
+	Parameter           []TerminologyCapabilitiesExpansionParameter `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	TextFilter          *string                                     `bson:"textFilter,omitempty" json:"textFilter,omitempty"`
+	TextFilterElement   *Element                                    `bson:"_textFilter,omitempty" json:"_textFilter,omitempty"`
 }
 type TerminologyCapabilitiesExpansionParameter struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	Documentation     *string     `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	Id                   *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                 string      `bson:"name" json:"name"`
+	NameElement          *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	Documentation        *string     `bson:"documentation,omitempty" json:"documentation,omitempty"`
+	DocumentationElement *Element    `bson:"_documentation,omitempty" json:"_documentation,omitempty"`
 }
 type TerminologyCapabilitiesValidateCode struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Translations      bool        `bson:"translations" json:"translations"`
+	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Translations        bool        `bson:"translations" json:"translations"`
+	TranslationsElement *Element    `bson:"_translations,omitempty" json:"_translations,omitempty"`
 }
 type TerminologyCapabilitiesTranslation struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	NeedsMap          bool        `bson:"needsMap" json:"needsMap"`
+	NeedsMapElement   *Element    `bson:"_needsMap,omitempty" json:"_needsMap,omitempty"`
 }
 type TerminologyCapabilitiesClosure struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Translation       *bool       `bson:"translation,omitempty" json:"translation,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Translation        *bool       `bson:"translation,omitempty" json:"translation,omitempty"`
+	TranslationElement *Element    `bson:"_translation,omitempty" json:"_translation,omitempty"`
 }
-type OtherTerminologyCapabilities TerminologyCapabilities
 
 // MarshalJSON marshals the given TerminologyCapabilities as JSON into a byte slice
 func (r TerminologyCapabilities) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherTerminologyCapabilities
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherTerminologyCapabilities: OtherTerminologyCapabilities(r),
-		ResourceType:                 "TerminologyCapabilities",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilities as JSON object with the members in the order of the fields
+func (r TerminologyCapabilities) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"TerminologyCapabilities\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	e.key("date")
+	e.string(r.Date)
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	e.key("kind")
+	e.string(r.Kind.Code())
+	if r.KindElement != nil {
+		e.key("_kind")
+		r.KindElement.appendJSON(e)
+	}
+	if r.Software != nil {
+		e.key("software")
+		r.Software.appendJSON(e)
+	}
+	if r.Implementation != nil {
+		e.key("implementation")
+		r.Implementation.appendJSON(e)
+	}
+	if r.LockedDate != nil {
+		e.key("lockedDate")
+		e.bool(*r.LockedDate)
+	}
+	if r.LockedDateElement != nil {
+		e.key("_lockedDate")
+		r.LockedDateElement.appendJSON(e)
+	}
+	if len(r.CodeSystem) > 0 {
+		e.key("codeSystem")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CodeSystem {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Expansion != nil {
+		e.key("expansion")
+		r.Expansion.appendJSON(e)
+	}
+	if r.CodeSearch != nil {
+		e.key("codeSearch")
+		e.string(r.CodeSearch.Code())
+	}
+	if r.CodeSearchElement != nil {
+		e.key("_codeSearch")
+		r.CodeSearchElement.appendJSON(e)
+	}
+	if r.ValidateCode != nil {
+		e.key("validateCode")
+		r.ValidateCode.appendJSON(e)
+	}
+	if r.Translation != nil {
+		e.key("translation")
+		r.Translation.appendJSON(e)
+	}
+	if r.Closure != nil {
+		e.key("closure")
+		r.Closure.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilities from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilities) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilities
+func (r *TerminologyCapabilities) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = v
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "kind":
+			d.unmarshal(&r.Kind)
+		case "_kind":
+			r.KindElement = d.element()
+		case "software":
+			if d.null() {
+				r.Software = nil
+			} else {
+				var v TerminologyCapabilitiesSoftware
+				v.decodeJSON(d)
+				r.Software = &v
+			}
+		case "implementation":
+			if d.null() {
+				r.Implementation = nil
+			} else {
+				var v TerminologyCapabilitiesImplementation
+				v.decodeJSON(d)
+				r.Implementation = &v
+			}
+		case "lockedDate":
+			if v, ok := d.bool(); ok {
+				r.LockedDate = &v
+			} else {
+				r.LockedDate = nil
+			}
+		case "_lockedDate":
+			r.LockedDateElement = d.element()
+		case "codeSystem":
+			a := d.array()
+			r.CodeSystem = nil
+			for a.next() {
+				var v TerminologyCapabilitiesCodeSystem
+				v.decodeJSON(d)
+				r.CodeSystem = append(r.CodeSystem, v)
+			}
+			if r.CodeSystem == nil && !a.isNull {
+				r.CodeSystem = []TerminologyCapabilitiesCodeSystem{}
+			}
+		case "expansion":
+			if d.null() {
+				r.Expansion = nil
+			} else {
+				var v TerminologyCapabilitiesExpansion
+				v.decodeJSON(d)
+				r.Expansion = &v
+			}
+		case "codeSearch":
+			if d.null() {
+				r.CodeSearch = nil
+			} else {
+				var v CodeSearchSupport
+				d.unmarshal(&v)
+				r.CodeSearch = &v
+			}
+		case "_codeSearch":
+			r.CodeSearchElement = d.element()
+		case "validateCode":
+			if d.null() {
+				r.ValidateCode = nil
+			} else {
+				var v TerminologyCapabilitiesValidateCode
+				v.decodeJSON(d)
+				r.ValidateCode = &v
+			}
+		case "translation":
+			if d.null() {
+				r.Translation = nil
+			} else {
+				var v TerminologyCapabilitiesTranslation
+				v.decodeJSON(d)
+				r.Translation = &v
+			}
+		case "closure":
+			if d.null() {
+				r.Closure = nil
+			} else {
+				var v TerminologyCapabilitiesClosure
+				v.decodeJSON(d)
+				r.Closure = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilities which shares no memory with the original
+func (r TerminologyCapabilities) DeepCopy() TerminologyCapabilities {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.KindElement != nil {
+		v := r.KindElement.DeepCopy()
+		out.KindElement = &v
+	}
+	if r.Software != nil {
+		v := r.Software.DeepCopy()
+		out.Software = &v
+	}
+	if r.Implementation != nil {
+		v := r.Implementation.DeepCopy()
+		out.Implementation = &v
+	}
+	if r.LockedDate != nil {
+		v := *r.LockedDate
+		out.LockedDate = &v
+	}
+	if r.LockedDateElement != nil {
+		v := r.LockedDateElement.DeepCopy()
+		out.LockedDateElement = &v
+	}
+	if r.CodeSystem != nil {
+		out.CodeSystem = make([]TerminologyCapabilitiesCodeSystem, len(r.CodeSystem))
+		for i := range r.CodeSystem {
+			out.CodeSystem[i] = r.CodeSystem[i].DeepCopy()
+		}
+	}
+	if r.Expansion != nil {
+		v := r.Expansion.DeepCopy()
+		out.Expansion = &v
+	}
+	if r.CodeSearch != nil {
+		v := *r.CodeSearch
+		out.CodeSearch = &v
+	}
+	if r.CodeSearchElement != nil {
+		v := r.CodeSearchElement.DeepCopy()
+		out.CodeSearchElement = &v
+	}
+	if r.ValidateCode != nil {
+		v := r.ValidateCode.DeepCopy()
+		out.ValidateCode = &v
+	}
+	if r.Translation != nil {
+		v := r.Translation.DeepCopy()
+		out.Translation = &v
+	}
+	if r.Closure != nil {
+		v := r.Closure.DeepCopy()
+		out.Closure = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilities) Equal(other TerminologyCapabilities) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if r.Date != other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.Equal(*other.KindElement) {
+		return false
+	}
+	if (r.Software == nil) != (other.Software == nil) || r.Software != nil && !r.Software.Equal(*other.Software) {
+		return false
+	}
+	if (r.Implementation == nil) != (other.Implementation == nil) || r.Implementation != nil && !r.Implementation.Equal(*other.Implementation) {
+		return false
+	}
+	if (r.LockedDate == nil) != (other.LockedDate == nil) || r.LockedDate != nil && *r.LockedDate != *other.LockedDate {
+		return false
+	}
+	if (r.LockedDateElement == nil) != (other.LockedDateElement == nil) || r.LockedDateElement != nil && !r.LockedDateElement.Equal(*other.LockedDateElement) {
+		return false
+	}
+	if len(r.CodeSystem) != len(other.CodeSystem) {
+		return false
+	}
+	for i := range r.CodeSystem {
+		if !r.CodeSystem[i].Equal(other.CodeSystem[i]) {
+			return false
+		}
+	}
+	if (r.Expansion == nil) != (other.Expansion == nil) || r.Expansion != nil && !r.Expansion.Equal(*other.Expansion) {
+		return false
+	}
+	if (r.CodeSearch == nil) != (other.CodeSearch == nil) || r.CodeSearch != nil && *r.CodeSearch != *other.CodeSearch {
+		return false
+	}
+	if (r.CodeSearchElement == nil) != (other.CodeSearchElement == nil) || r.CodeSearchElement != nil && !r.CodeSearchElement.Equal(*other.CodeSearchElement) {
+		return false
+	}
+	if (r.ValidateCode == nil) != (other.ValidateCode == nil) || r.ValidateCode != nil && !r.ValidateCode.Equal(*other.ValidateCode) {
+		return false
+	}
+	if (r.Translation == nil) != (other.Translation == nil) || r.Translation != nil && !r.Translation.Equal(*other.Translation) {
+		return false
+	}
+	if (r.Closure == nil) != (other.Closure == nil) || r.Closure != nil && !r.Closure.Equal(*other.Closure) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilities) EqualsDeep(other TerminologyCapabilities) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.KindElement == nil) != (other.KindElement == nil) || r.KindElement != nil && !r.KindElement.EqualsDeep(*other.KindElement) {
+		return false
+	}
+	if (r.Software == nil) != (other.Software == nil) || r.Software != nil && !r.Software.EqualsDeep(*other.Software) {
+		return false
+	}
+	if (r.Implementation == nil) != (other.Implementation == nil) || r.Implementation != nil && !r.Implementation.EqualsDeep(*other.Implementation) {
+		return false
+	}
+	if (r.LockedDate == nil) != (other.LockedDate == nil) || r.LockedDate != nil && *r.LockedDate != *other.LockedDate {
+		return false
+	}
+	if (r.LockedDateElement == nil) != (other.LockedDateElement == nil) || r.LockedDateElement != nil && !r.LockedDateElement.EqualsDeep(*other.LockedDateElement) {
+		return false
+	}
+	if !equivalentList(len(r.CodeSystem), len(other.CodeSystem), func(i, j int) bool {
+		return r.CodeSystem[i].EqualsDeep(other.CodeSystem[j])
+	}) {
+		return false
+	}
+	if (r.Expansion == nil) != (other.Expansion == nil) || r.Expansion != nil && !r.Expansion.EqualsDeep(*other.Expansion) {
+		return false
+	}
+	if (r.CodeSearch == nil) != (other.CodeSearch == nil) || r.CodeSearch != nil && *r.CodeSearch != *other.CodeSearch {
+		return false
+	}
+	if (r.CodeSearchElement == nil) != (other.CodeSearchElement == nil) || r.CodeSearchElement != nil && !r.CodeSearchElement.EqualsDeep(*other.CodeSearchElement) {
+		return false
+	}
+	if (r.ValidateCode == nil) != (other.ValidateCode == nil) || r.ValidateCode != nil && !r.ValidateCode.EqualsDeep(*other.ValidateCode) {
+		return false
+	}
+	if (r.Translation == nil) != (other.Translation == nil) || r.Translation != nil && !r.Translation.EqualsDeep(*other.Translation) {
+		return false
+	}
+	if (r.Closure == nil) != (other.Closure == nil) || r.Closure != nil && !r.Closure.EqualsDeep(*other.Closure) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilities) EqualsShallow(other TerminologyCapabilities) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if !equivalentString(r.Date, other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if r.Kind != other.Kind {
+		return false
+	}
+	if (r.LockedDate == nil) != (other.LockedDate == nil) || r.LockedDate != nil && *r.LockedDate != *other.LockedDate {
+		return false
+	}
+	if (r.CodeSearch == nil) != (other.CodeSearch == nil) || r.CodeSearch != nil && *r.CodeSearch != *other.CodeSearch {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilities as FHIR XML
+func (r TerminologyCapabilities) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "TerminologyCapabilities",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "kind", r.Kind, r.KindElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "software", r.Software); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "implementation", r.Implementation); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lockedDate", r.LockedDate, r.LockedDateElement); err != nil {
+		return err
+	}
+	for _, v := range r.CodeSystem {
+		if err := encodeXMLElement(e, "codeSystem", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "expansion", r.Expansion); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "codeSearch", r.CodeSearch, r.CodeSearchElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "validateCode", r.ValidateCode); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "translation", r.Translation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "closure", r.Closure); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilities from FHIR XML
+func (r *TerminologyCapabilities) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "kind":
+				var v CapabilityStatementKind
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Kind = v
+				}
+				r.KindElement = element
+			case "software":
+				var v TerminologyCapabilitiesSoftware
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Software = &v
+			case "implementation":
+				var v TerminologyCapabilitiesImplementation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Implementation = &v
+			case "lockedDate":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LockedDate = &v
+				}
+				r.LockedDateElement = element
+			case "codeSystem":
+				var v TerminologyCapabilitiesCodeSystem
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CodeSystem = append(r.CodeSystem, v)
+			case "expansion":
+				var v TerminologyCapabilitiesExpansion
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Expansion = &v
+			case "codeSearch":
+				var v CodeSearchSupport
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CodeSearch = &v
+				}
+				r.CodeSearchElement = element
+			case "validateCode":
+				var v TerminologyCapabilitiesValidateCode
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValidateCode = &v
+			case "translation":
+				var v TerminologyCapabilitiesTranslation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Translation = &v
+			case "closure":
+				var v TerminologyCapabilitiesClosure
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Closure = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilities as properties to the RDF node n
+func (r TerminologyCapabilities) turtle(n *turtleNode) {
+	n.resource("TerminologyCapabilities", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("TerminologyCapabilities.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("TerminologyCapabilities.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("TerminologyCapabilities.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("TerminologyCapabilities.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("TerminologyCapabilities.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("TerminologyCapabilities.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("TerminologyCapabilities.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("TerminologyCapabilities.contact", i, v)
+	}
+	n.primitive("TerminologyCapabilities.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("TerminologyCapabilities.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("TerminologyCapabilities.jurisdiction", i, v)
+	}
+	n.primitive("TerminologyCapabilities.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("TerminologyCapabilities.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	n.primitive("TerminologyCapabilities.kind", -1, r.Kind, r.KindElement, "code")
+	n.element("TerminologyCapabilities.software", -1, r.Software)
+	n.element("TerminologyCapabilities.implementation", -1, r.Implementation)
+	n.primitive("TerminologyCapabilities.lockedDate", -1, r.LockedDate, r.LockedDateElement, "boolean")
+	for i, v := range r.CodeSystem {
+		n.element("TerminologyCapabilities.codeSystem", i, v)
+	}
+	n.element("TerminologyCapabilities.expansion", -1, r.Expansion)
+	n.primitive("TerminologyCapabilities.codeSearch", -1, r.CodeSearch, r.CodeSearchElement, "code")
+	n.element("TerminologyCapabilities.validateCode", -1, r.ValidateCode)
+	n.element("TerminologyCapabilities.translation", -1, r.Translation)
+	n.element("TerminologyCapabilities.closure", -1, r.Closure)
+}
+
+// MarshalBSON marshals the given TerminologyCapabilities as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilities) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilities from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilities) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilities as protobuf message TerminologyCapabilities of fhir.proto
+func (r TerminologyCapabilities) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilities to the encoded message
+func (r TerminologyCapabilities) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != nil {
+		e.string(9, *r.Url)
+	}
+	if r.Version != nil {
+		e.string(10, *r.Version)
+	}
+	if r.Name != nil {
+		e.string(11, *r.Name)
+	}
+	if r.Title != nil {
+		e.string(12, *r.Title)
+	}
+	e.varint(13, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(14, protoBool(*r.Experimental))
+	}
+	if r.Date != "" {
+		e.string(15, r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(16, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(17, v)
+	}
+	if r.Description != nil {
+		e.string(18, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(19, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(20, v)
+	}
+	if r.Purpose != nil {
+		e.string(21, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(22, *r.Copyright)
+	}
+	e.varint(23, r.Kind.protoNumber())
+	if r.Software != nil {
+		e.message(24, *r.Software)
+	}
+	if r.Implementation != nil {
+		e.message(25, *r.Implementation)
+	}
+	if r.LockedDate != nil {
+		e.varint(26, protoBool(*r.LockedDate))
+	}
+	for _, v := range r.CodeSystem {
+		e.message(27, v)
+	}
+	if r.Expansion != nil {
+		e.message(28, *r.Expansion)
+	}
+	if r.CodeSearch != nil {
+		e.varint(29, r.CodeSearch.protoNumber())
+	}
+	if r.ValidateCode != nil {
+		e.message(30, *r.ValidateCode)
+	}
+	if r.Translation != nil {
+		e.message(31, *r.Translation)
+	}
+	if r.Closure != nil {
+		e.message(32, *r.Closure)
+	}
+	if r.IdElement != nil {
+		e.message(33, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(34, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(35, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(36, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(37, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(38, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(39, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(40, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(41, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(42, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(43, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(44, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(45, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(46, *r.CopyrightElement)
+	}
+	if r.KindElement != nil {
+		e.message(47, *r.KindElement)
+	}
+	if r.LockedDateElement != nil {
+		e.message(48, *r.LockedDateElement)
+	}
+	if r.CodeSearchElement != nil {
+		e.message(49, *r.CodeSearchElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilities from protobuf message TerminologyCapabilities of fhir.proto
+func (r *TerminologyCapabilities) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilities{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			v := d.string()
+			r.Url = &v
+		case 10:
+			v := d.string()
+			r.Version = &v
+		case 11:
+			v := d.string()
+			r.Name = &v
+		case 12:
+			v := d.string()
+			r.Title = &v
+		case 13:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 14:
+			v := d.bool()
+			r.Experimental = &v
+		case 15:
+			r.Date = d.string()
+		case 16:
+			v := d.string()
+			r.Publisher = &v
+		case 17:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 18:
+			v := d.string()
+			r.Description = &v
+		case 19:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 20:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 21:
+			v := d.string()
+			r.Purpose = &v
+		case 22:
+			v := d.string()
+			r.Copyright = &v
+		case 23:
+			var v CapabilityStatementKind
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Kind = v
+		case 24:
+			var v TerminologyCapabilitiesSoftware
+			d.message(&v)
+			r.Software = &v
+		case 25:
+			var v TerminologyCapabilitiesImplementation
+			d.message(&v)
+			r.Implementation = &v
+		case 26:
+			v := d.bool()
+			r.LockedDate = &v
+		case 27:
+			var v TerminologyCapabilitiesCodeSystem
+			d.message(&v)
+			r.CodeSystem = append(r.CodeSystem, v)
+		case 28:
+			var v TerminologyCapabilitiesExpansion
+			d.message(&v)
+			r.Expansion = &v
+		case 29:
+			var v CodeSearchSupport
+			d.enum(d.varint(), v.setProtoNumber)
+			r.CodeSearch = &v
+		case 30:
+			var v TerminologyCapabilitiesValidateCode
+			d.message(&v)
+			r.ValidateCode = &v
+		case 31:
+			var v TerminologyCapabilitiesTranslation
+			d.message(&v)
+			r.Translation = &v
+		case 32:
+			var v TerminologyCapabilitiesClosure
+			d.message(&v)
+			r.Closure = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.KindElement = &v
+		case 48:
+			var v Element
+			d.message(&v)
+			r.LockedDateElement = &v
+		case 49:
+			var v Element
+			d.message(&v)
+			r.CodeSearchElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesSoftware as JSON into a byte slice
+func (r TerminologyCapabilitiesSoftware) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesSoftware as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesSoftware) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesSoftware from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesSoftware) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesSoftware
+func (r *TerminologyCapabilitiesSoftware) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesSoftware which shares no memory with the original
+func (r TerminologyCapabilitiesSoftware) DeepCopy() TerminologyCapabilitiesSoftware {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesSoftware) Equal(other TerminologyCapabilitiesSoftware) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesSoftware) EqualsDeep(other TerminologyCapabilitiesSoftware) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesSoftware) EqualsShallow(other TerminologyCapabilitiesSoftware) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesSoftware as FHIR XML
+func (r TerminologyCapabilitiesSoftware) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesSoftware from FHIR XML
+func (r *TerminologyCapabilitiesSoftware) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesSoftware as properties to the RDF node n
+func (r TerminologyCapabilitiesSoftware) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.software.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("TerminologyCapabilities.software.version", -1, r.Version, r.VersionElement, "string")
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesSoftware as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesSoftware) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesSoftware from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesSoftware) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesSoftware as protobuf message TerminologyCapabilitiesSoftware of fhir.proto
+func (r TerminologyCapabilitiesSoftware) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesSoftware to the encoded message
+func (r TerminologyCapabilitiesSoftware) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Version != nil {
+		e.string(5, *r.Version)
+	}
+	if r.NameElement != nil {
+		e.message(6, *r.NameElement)
+	}
+	if r.VersionElement != nil {
+		e.message(7, *r.VersionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesSoftware from protobuf message TerminologyCapabilitiesSoftware of fhir.proto
+func (r *TerminologyCapabilitiesSoftware) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesSoftware{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.Version = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesImplementation as JSON into a byte slice
+func (r TerminologyCapabilitiesImplementation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesImplementation as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesImplementation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("description")
+	e.string(r.Description)
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesImplementation from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesImplementation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesImplementation
+func (r *TerminologyCapabilitiesImplementation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = v
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesImplementation which shares no memory with the original
+func (r TerminologyCapabilitiesImplementation) DeepCopy() TerminologyCapabilitiesImplementation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesImplementation) Equal(other TerminologyCapabilitiesImplementation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Description != other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesImplementation) EqualsDeep(other TerminologyCapabilitiesImplementation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesImplementation) EqualsShallow(other TerminologyCapabilitiesImplementation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Description, other.Description) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesImplementation as FHIR XML
+func (r TerminologyCapabilitiesImplementation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesImplementation from FHIR XML
+func (r *TerminologyCapabilitiesImplementation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = v
+				}
+				r.DescriptionElement = element
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesImplementation as properties to the RDF node n
+func (r TerminologyCapabilitiesImplementation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.implementation.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("TerminologyCapabilities.implementation.url", -1, r.Url, r.UrlElement, "string")
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesImplementation as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesImplementation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesImplementation from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesImplementation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesImplementation as protobuf message TerminologyCapabilitiesImplementation of fhir.proto
+func (r TerminologyCapabilitiesImplementation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesImplementation to the encoded message
+func (r TerminologyCapabilitiesImplementation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Description != "" {
+		e.string(4, r.Description)
+	}
+	if r.Url != nil {
+		e.string(5, *r.Url)
+	}
+	if r.DescriptionElement != nil {
+		e.message(6, *r.DescriptionElement)
+	}
+	if r.UrlElement != nil {
+		e.message(7, *r.UrlElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesImplementation from protobuf message TerminologyCapabilitiesImplementation of fhir.proto
+func (r *TerminologyCapabilitiesImplementation) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesImplementation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Description = d.string()
+		case 5:
+			v := d.string()
+			r.Url = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesCodeSystem as JSON into a byte slice
+func (r TerminologyCapabilitiesCodeSystem) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesCodeSystem as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesCodeSystem) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Uri != nil {
+		e.key("uri")
+		e.string(*r.Uri)
+	}
+	if r.UriElement != nil {
+		e.key("_uri")
+		r.UriElement.appendJSON(e)
+	}
+	if len(r.Version) > 0 {
+		e.key("version")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Version {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Subsumption != nil {
+		e.key("subsumption")
+		e.bool(*r.Subsumption)
+	}
+	if r.SubsumptionElement != nil {
+		e.key("_subsumption")
+		r.SubsumptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesCodeSystem from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesCodeSystem) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesCodeSystem
+func (r *TerminologyCapabilitiesCodeSystem) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "uri":
+			if v, ok := d.string(); ok {
+				r.Uri = &v
+			} else {
+				r.Uri = nil
+			}
+		case "_uri":
+			r.UriElement = d.element()
+		case "version":
+			a := d.array()
+			r.Version = nil
+			for a.next() {
+				var v TerminologyCapabilitiesCodeSystemVersion
+				v.decodeJSON(d)
+				r.Version = append(r.Version, v)
+			}
+			if r.Version == nil && !a.isNull {
+				r.Version = []TerminologyCapabilitiesCodeSystemVersion{}
+			}
+		case "subsumption":
+			if v, ok := d.bool(); ok {
+				r.Subsumption = &v
+			} else {
+				r.Subsumption = nil
+			}
+		case "_subsumption":
+			r.SubsumptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesCodeSystem which shares no memory with the original
+func (r TerminologyCapabilitiesCodeSystem) DeepCopy() TerminologyCapabilitiesCodeSystem {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Uri != nil {
+		v := *r.Uri
+		out.Uri = &v
+	}
+	if r.UriElement != nil {
+		v := r.UriElement.DeepCopy()
+		out.UriElement = &v
+	}
+	if r.Version != nil {
+		out.Version = make([]TerminologyCapabilitiesCodeSystemVersion, len(r.Version))
+		for i := range r.Version {
+			out.Version[i] = r.Version[i].DeepCopy()
+		}
+	}
+	if r.Subsumption != nil {
+		v := *r.Subsumption
+		out.Subsumption = &v
+	}
+	if r.SubsumptionElement != nil {
+		v := r.SubsumptionElement.DeepCopy()
+		out.SubsumptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesCodeSystem) Equal(other TerminologyCapabilitiesCodeSystem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && *r.Uri != *other.Uri {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.Equal(*other.UriElement) {
+		return false
+	}
+	if len(r.Version) != len(other.Version) {
+		return false
+	}
+	for i := range r.Version {
+		if !r.Version[i].Equal(other.Version[i]) {
+			return false
+		}
+	}
+	if (r.Subsumption == nil) != (other.Subsumption == nil) || r.Subsumption != nil && *r.Subsumption != *other.Subsumption {
+		return false
+	}
+	if (r.SubsumptionElement == nil) != (other.SubsumptionElement == nil) || r.SubsumptionElement != nil && !r.SubsumptionElement.Equal(*other.SubsumptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesCodeSystem) EqualsDeep(other TerminologyCapabilitiesCodeSystem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && !equivalentString(*r.Uri, *other.Uri) {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.EqualsDeep(*other.UriElement) {
+		return false
+	}
+	if !equivalentList(len(r.Version), len(other.Version), func(i, j int) bool {
+		return r.Version[i].EqualsDeep(other.Version[j])
+	}) {
+		return false
+	}
+	if (r.Subsumption == nil) != (other.Subsumption == nil) || r.Subsumption != nil && *r.Subsumption != *other.Subsumption {
+		return false
+	}
+	if (r.SubsumptionElement == nil) != (other.SubsumptionElement == nil) || r.SubsumptionElement != nil && !r.SubsumptionElement.EqualsDeep(*other.SubsumptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesCodeSystem) EqualsShallow(other TerminologyCapabilitiesCodeSystem) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Uri == nil) != (other.Uri == nil) || r.Uri != nil && !equivalentString(*r.Uri, *other.Uri) {
+		return false
+	}
+	if (r.Subsumption == nil) != (other.Subsumption == nil) || r.Subsumption != nil && *r.Subsumption != *other.Subsumption {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesCodeSystem as FHIR XML
+func (r TerminologyCapabilitiesCodeSystem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "uri", r.Uri, r.UriElement); err != nil {
+		return err
+	}
+	for _, v := range r.Version {
+		if err := encodeXMLElement(e, "version", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "subsumption", r.Subsumption, r.SubsumptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesCodeSystem from FHIR XML
+func (r *TerminologyCapabilitiesCodeSystem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "uri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Uri = &v
+				}
+				r.UriElement = element
+			case "version":
+				var v TerminologyCapabilitiesCodeSystemVersion
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Version = append(r.Version, v)
+			case "subsumption":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Subsumption = &v
+				}
+				r.SubsumptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesCodeSystem as properties to the RDF node n
+func (r TerminologyCapabilitiesCodeSystem) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.codeSystem.uri", -1, r.Uri, r.UriElement, "string")
+	for i, v := range r.Version {
+		n.element("TerminologyCapabilities.codeSystem.version", i, v)
+	}
+	n.primitive("TerminologyCapabilities.codeSystem.subsumption", -1, r.Subsumption, r.SubsumptionElement, "boolean")
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesCodeSystem as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesCodeSystem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesCodeSystem from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesCodeSystem) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesCodeSystem as protobuf message TerminologyCapabilitiesCodeSystem of fhir.proto
+func (r TerminologyCapabilitiesCodeSystem) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesCodeSystem to the encoded message
+func (r TerminologyCapabilitiesCodeSystem) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Uri != nil {
+		e.string(4, *r.Uri)
+	}
+	for _, v := range r.Version {
+		e.message(5, v)
+	}
+	if r.Subsumption != nil {
+		e.varint(6, protoBool(*r.Subsumption))
+	}
+	if r.UriElement != nil {
+		e.message(7, *r.UriElement)
+	}
+	if r.SubsumptionElement != nil {
+		e.message(8, *r.SubsumptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesCodeSystem from protobuf message TerminologyCapabilitiesCodeSystem of fhir.proto
+func (r *TerminologyCapabilitiesCodeSystem) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesCodeSystem{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Uri = &v
+		case 5:
+			var v TerminologyCapabilitiesCodeSystemVersion
+			d.message(&v)
+			r.Version = append(r.Version, v)
+		case 6:
+			v := d.bool()
+			r.Subsumption = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.UriElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.SubsumptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesCodeSystemVersion as JSON into a byte slice
+func (r TerminologyCapabilitiesCodeSystemVersion) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesCodeSystemVersion as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesCodeSystemVersion) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Code != nil {
+		e.key("code")
+		e.string(*r.Code)
+	}
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	if r.IsDefault != nil {
+		e.key("isDefault")
+		e.bool(*r.IsDefault)
+	}
+	if r.IsDefaultElement != nil {
+		e.key("_isDefault")
+		r.IsDefaultElement.appendJSON(e)
+	}
+	if r.Compositional != nil {
+		e.key("compositional")
+		e.bool(*r.Compositional)
+	}
+	if r.CompositionalElement != nil {
+		e.key("_compositional")
+		r.CompositionalElement.appendJSON(e)
+	}
+	if len(r.Language) > 0 {
+		e.key("language")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Language {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LanguageElement) > 0 {
+		e.key("_language")
+		e.elements(r.LanguageElement, len(r.Language))
+	}
+	if len(r.Filter) > 0 {
+		e.key("filter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Filter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Property) > 0 {
+		e.key("property")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Property {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.PropertyElement) > 0 {
+		e.key("_property")
+		e.elements(r.PropertyElement, len(r.Property))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesCodeSystemVersion from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesCodeSystemVersion) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesCodeSystemVersion
+func (r *TerminologyCapabilitiesCodeSystemVersion) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = &v
+			} else {
+				r.Code = nil
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "isDefault":
+			if v, ok := d.bool(); ok {
+				r.IsDefault = &v
+			} else {
+				r.IsDefault = nil
+			}
+		case "_isDefault":
+			r.IsDefaultElement = d.element()
+		case "compositional":
+			if v, ok := d.bool(); ok {
+				r.Compositional = &v
+			} else {
+				r.Compositional = nil
+			}
+		case "_compositional":
+			r.CompositionalElement = d.element()
+		case "language":
+			a := d.array()
+			r.Language = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Language = append(r.Language, v)
+			}
+			if r.Language == nil && !a.isNull {
+				r.Language = []string{}
+			}
+		case "_language":
+			a := d.array()
+			r.LanguageElement = nil
+			for a.next() {
+				r.LanguageElement = append(r.LanguageElement, d.element())
+			}
+		case "filter":
+			a := d.array()
+			r.Filter = nil
+			for a.next() {
+				var v TerminologyCapabilitiesCodeSystemVersionFilter
+				v.decodeJSON(d)
+				r.Filter = append(r.Filter, v)
+			}
+			if r.Filter == nil && !a.isNull {
+				r.Filter = []TerminologyCapabilitiesCodeSystemVersionFilter{}
+			}
+		case "property":
+			a := d.array()
+			r.Property = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Property = append(r.Property, v)
+			}
+			if r.Property == nil && !a.isNull {
+				r.Property = []string{}
+			}
+		case "_property":
+			a := d.array()
+			r.PropertyElement = nil
+			for a.next() {
+				r.PropertyElement = append(r.PropertyElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesCodeSystemVersion which shares no memory with the original
+func (r TerminologyCapabilitiesCodeSystemVersion) DeepCopy() TerminologyCapabilitiesCodeSystemVersion {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Code != nil {
+		v := *r.Code
+		out.Code = &v
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.IsDefault != nil {
+		v := *r.IsDefault
+		out.IsDefault = &v
+	}
+	if r.IsDefaultElement != nil {
+		v := r.IsDefaultElement.DeepCopy()
+		out.IsDefaultElement = &v
+	}
+	if r.Compositional != nil {
+		v := *r.Compositional
+		out.Compositional = &v
+	}
+	if r.CompositionalElement != nil {
+		v := r.CompositionalElement.DeepCopy()
+		out.CompositionalElement = &v
+	}
+	if r.Language != nil {
+		out.Language = make([]string, len(r.Language))
+		copy(out.Language, r.Language)
+	}
+	if r.LanguageElement != nil {
+		out.LanguageElement = make([]*Element, len(r.LanguageElement))
+		for i, v := range r.LanguageElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LanguageElement[i] = &c
+			}
+		}
+	}
+	if r.Filter != nil {
+		out.Filter = make([]TerminologyCapabilitiesCodeSystemVersionFilter, len(r.Filter))
+		for i := range r.Filter {
+			out.Filter[i] = r.Filter[i].DeepCopy()
+		}
+	}
+	if r.Property != nil {
+		out.Property = make([]string, len(r.Property))
+		copy(out.Property, r.Property)
+	}
+	if r.PropertyElement != nil {
+		out.PropertyElement = make([]*Element, len(r.PropertyElement))
+		for i, v := range r.PropertyElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.PropertyElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesCodeSystemVersion) Equal(other TerminologyCapabilitiesCodeSystemVersion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && *r.Code != *other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if (r.IsDefault == nil) != (other.IsDefault == nil) || r.IsDefault != nil && *r.IsDefault != *other.IsDefault {
+		return false
+	}
+	if (r.IsDefaultElement == nil) != (other.IsDefaultElement == nil) || r.IsDefaultElement != nil && !r.IsDefaultElement.Equal(*other.IsDefaultElement) {
+		return false
+	}
+	if (r.Compositional == nil) != (other.Compositional == nil) || r.Compositional != nil && *r.Compositional != *other.Compositional {
+		return false
+	}
+	if (r.CompositionalElement == nil) != (other.CompositionalElement == nil) || r.CompositionalElement != nil && !r.CompositionalElement.Equal(*other.CompositionalElement) {
+		return false
+	}
+	if len(r.Language) != len(other.Language) {
+		return false
+	}
+	for i := range r.Language {
+		if r.Language[i] != other.Language[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LanguageElement, other.LanguageElement, false) {
+		return false
+	}
+	if len(r.Filter) != len(other.Filter) {
+		return false
+	}
+	for i := range r.Filter {
+		if !r.Filter[i].Equal(other.Filter[i]) {
+			return false
+		}
+	}
+	if len(r.Property) != len(other.Property) {
+		return false
+	}
+	for i := range r.Property {
+		if r.Property[i] != other.Property[i] {
+			return false
+		}
+	}
+	if !equalElements(r.PropertyElement, other.PropertyElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesCodeSystemVersion) EqualsDeep(other TerminologyCapabilitiesCodeSystemVersion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if (r.IsDefault == nil) != (other.IsDefault == nil) || r.IsDefault != nil && *r.IsDefault != *other.IsDefault {
+		return false
+	}
+	if (r.IsDefaultElement == nil) != (other.IsDefaultElement == nil) || r.IsDefaultElement != nil && !r.IsDefaultElement.EqualsDeep(*other.IsDefaultElement) {
+		return false
+	}
+	if (r.Compositional == nil) != (other.Compositional == nil) || r.Compositional != nil && *r.Compositional != *other.Compositional {
+		return false
+	}
+	if (r.CompositionalElement == nil) != (other.CompositionalElement == nil) || r.CompositionalElement != nil && !r.CompositionalElement.EqualsDeep(*other.CompositionalElement) {
+		return false
+	}
+	if !equivalentList(len(r.Language), len(other.Language), func(i, j int) bool {
+		return equivalentString(r.Language[i], other.Language[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LanguageElement, other.LanguageElement, true) {
+		return false
+	}
+	if !equivalentList(len(r.Filter), len(other.Filter), func(i, j int) bool {
+		return r.Filter[i].EqualsDeep(other.Filter[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Property), len(other.Property), func(i, j int) bool {
+		return equivalentString(r.Property[i], other.Property[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.PropertyElement, other.PropertyElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesCodeSystemVersion) EqualsShallow(other TerminologyCapabilitiesCodeSystemVersion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Code == nil) != (other.Code == nil) || r.Code != nil && !equivalentString(*r.Code, *other.Code) {
+		return false
+	}
+	if (r.IsDefault == nil) != (other.IsDefault == nil) || r.IsDefault != nil && *r.IsDefault != *other.IsDefault {
+		return false
+	}
+	if (r.Compositional == nil) != (other.Compositional == nil) || r.Compositional != nil && *r.Compositional != *other.Compositional {
+		return false
+	}
+	if !equivalentList(len(r.Language), len(other.Language), func(i, j int) bool {
+		return equivalentString(r.Language[i], other.Language[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Property), len(other.Property), func(i, j int) bool {
+		return equivalentString(r.Property[i], other.Property[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesCodeSystemVersion as FHIR XML
+func (r TerminologyCapabilitiesCodeSystemVersion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "isDefault", r.IsDefault, r.IsDefaultElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "compositional", r.Compositional, r.CompositionalElement); err != nil {
+		return err
+	}
+	for i, v := range r.Language {
+		if err := encodeXMLPrimitive(e, "language", v, elementAt(r.LanguageElement, i)); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Filter {
+		if err := encodeXMLElement(e, "filter", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.Property {
+		if err := encodeXMLPrimitive(e, "property", v, elementAt(r.PropertyElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesCodeSystemVersion from FHIR XML
+func (r *TerminologyCapabilitiesCodeSystemVersion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = &v
+				}
+				r.CodeElement = element
+			case "isDefault":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IsDefault = &v
+				}
+				r.IsDefaultElement = element
+			case "compositional":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Compositional = &v
+				}
+				r.CompositionalElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LanguageElement = appendElement(r.LanguageElement, len(r.Language), element)
+					r.Language = append(r.Language, v)
+				}
+			case "filter":
+				var v TerminologyCapabilitiesCodeSystemVersionFilter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Filter = append(r.Filter, v)
+			case "property":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.PropertyElement = appendElement(r.PropertyElement, len(r.Property), element)
+					r.Property = append(r.Property, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesCodeSystemVersion as properties to the RDF node n
+func (r TerminologyCapabilitiesCodeSystemVersion) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.codeSystem.version.code", -1, r.Code, r.CodeElement, "string")
+	n.primitive("TerminologyCapabilities.codeSystem.version.isDefault", -1, r.IsDefault, r.IsDefaultElement, "boolean")
+	n.primitive("TerminologyCapabilities.codeSystem.version.compositional", -1, r.Compositional, r.CompositionalElement, "boolean")
+	for i, v := range r.Language {
+		n.primitive("TerminologyCapabilities.codeSystem.version.language", i, v, elementAt(r.LanguageElement, i), "string")
+	}
+	for i, v := range r.Filter {
+		n.element("TerminologyCapabilities.codeSystem.version.filter", i, v)
+	}
+	for i, v := range r.Property {
+		n.primitive("TerminologyCapabilities.codeSystem.version.property", i, v, elementAt(r.PropertyElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesCodeSystemVersion as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesCodeSystemVersion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesCodeSystemVersion from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesCodeSystemVersion) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesCodeSystemVersion as protobuf message TerminologyCapabilitiesCodeSystemVersion of fhir.proto
+func (r TerminologyCapabilitiesCodeSystemVersion) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesCodeSystemVersion to the encoded message
+func (r TerminologyCapabilitiesCodeSystemVersion) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != nil {
+		e.string(4, *r.Code)
+	}
+	if r.IsDefault != nil {
+		e.varint(5, protoBool(*r.IsDefault))
+	}
+	if r.Compositional != nil {
+		e.varint(6, protoBool(*r.Compositional))
+	}
+	for _, v := range r.Language {
+		e.string(7, v)
+	}
+	for _, v := range r.Filter {
+		e.message(8, v)
+	}
+	for _, v := range r.Property {
+		e.string(9, v)
+	}
+	if r.CodeElement != nil {
+		e.message(10, *r.CodeElement)
+	}
+	if r.IsDefaultElement != nil {
+		e.message(11, *r.IsDefaultElement)
+	}
+	if r.CompositionalElement != nil {
+		e.message(12, *r.CompositionalElement)
+	}
+	for _, v := range r.LanguageElement {
+		e.element(13, v)
+	}
+	for _, v := range r.PropertyElement {
+		e.element(14, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesCodeSystemVersion from protobuf message TerminologyCapabilitiesCodeSystemVersion of fhir.proto
+func (r *TerminologyCapabilitiesCodeSystemVersion) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesCodeSystemVersion{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Code = &v
+		case 5:
+			v := d.bool()
+			r.IsDefault = &v
+		case 6:
+			v := d.bool()
+			r.Compositional = &v
+		case 7:
+			r.Language = append(r.Language, d.string())
+		case 8:
+			var v TerminologyCapabilitiesCodeSystemVersionFilter
+			d.message(&v)
+			r.Filter = append(r.Filter, v)
+		case 9:
+			r.Property = append(r.Property, d.string())
+		case 10:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.IsDefaultElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.CompositionalElement = &v
+		case 13:
+			r.LanguageElement = append(r.LanguageElement, d.element())
+		case 14:
+			r.PropertyElement = append(r.PropertyElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesCodeSystemVersionFilter as JSON into a byte slice
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesCodeSystemVersionFilter as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("code")
+	e.string(r.Code)
+	if r.CodeElement != nil {
+		e.key("_code")
+		r.CodeElement.appendJSON(e)
+	}
+	e.key("op")
+	if r.Op == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Op {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.OpElement) > 0 {
+		e.key("_op")
+		e.elements(r.OpElement, len(r.Op))
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesCodeSystemVersionFilter from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesCodeSystemVersionFilter
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "code":
+			if v, ok := d.string(); ok {
+				r.Code = v
+			}
+		case "_code":
+			r.CodeElement = d.element()
+		case "op":
+			a := d.array()
+			r.Op = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Op = append(r.Op, v)
+			}
+			if r.Op == nil && !a.isNull {
+				r.Op = []string{}
+			}
+		case "_op":
+			a := d.array()
+			r.OpElement = nil
+			for a.next() {
+				r.OpElement = append(r.OpElement, d.element())
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesCodeSystemVersionFilter which shares no memory with the original
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) DeepCopy() TerminologyCapabilitiesCodeSystemVersionFilter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.CodeElement != nil {
+		v := r.CodeElement.DeepCopy()
+		out.CodeElement = &v
+	}
+	if r.Op != nil {
+		out.Op = make([]string, len(r.Op))
+		copy(out.Op, r.Op)
+	}
+	if r.OpElement != nil {
+		out.OpElement = make([]*Element, len(r.OpElement))
+		for i, v := range r.OpElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.OpElement[i] = &c
+			}
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) Equal(other TerminologyCapabilitiesCodeSystemVersionFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Code != other.Code {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.Equal(*other.CodeElement) {
+		return false
+	}
+	if len(r.Op) != len(other.Op) {
+		return false
+	}
+	for i := range r.Op {
+		if r.Op[i] != other.Op[i] {
+			return false
+		}
+	}
+	if !equalElements(r.OpElement, other.OpElement, false) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) EqualsDeep(other TerminologyCapabilitiesCodeSystemVersionFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if (r.CodeElement == nil) != (other.CodeElement == nil) || r.CodeElement != nil && !r.CodeElement.EqualsDeep(*other.CodeElement) {
+		return false
+	}
+	if !equivalentList(len(r.Op), len(other.Op), func(i, j int) bool {
+		return equivalentString(r.Op[i], other.Op[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.OpElement, other.OpElement, true) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) EqualsShallow(other TerminologyCapabilitiesCodeSystemVersionFilter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Code, other.Code) {
+		return false
+	}
+	if !equivalentList(len(r.Op), len(other.Op), func(i, j int) bool {
+		return equivalentString(r.Op[i], other.Op[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesCodeSystemVersionFilter as FHIR XML
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "code", r.Code, r.CodeElement); err != nil {
+		return err
+	}
+	for i, v := range r.Op {
+		if err := encodeXMLPrimitive(e, "op", v, elementAt(r.OpElement, i)); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesCodeSystemVersionFilter from FHIR XML
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "code":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Code = v
+				}
+				r.CodeElement = element
+			case "op":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.OpElement = appendElement(r.OpElement, len(r.Op), element)
+					r.Op = append(r.Op, v)
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesCodeSystemVersionFilter as properties to the RDF node n
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.codeSystem.version.filter.code", -1, r.Code, r.CodeElement, "string")
+	for i, v := range r.Op {
+		n.primitive("TerminologyCapabilities.codeSystem.version.filter.op", i, v, elementAt(r.OpElement, i), "string")
+	}
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesCodeSystemVersionFilter as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesCodeSystemVersionFilter from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesCodeSystemVersionFilter as protobuf message TerminologyCapabilitiesCodeSystemVersionFilter of fhir.proto
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesCodeSystemVersionFilter to the encoded message
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Code != "" {
+		e.string(4, r.Code)
+	}
+	for _, v := range r.Op {
+		e.string(5, v)
+	}
+	if r.CodeElement != nil {
+		e.message(6, *r.CodeElement)
+	}
+	for _, v := range r.OpElement {
+		e.element(7, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesCodeSystemVersionFilter from protobuf message TerminologyCapabilitiesCodeSystemVersionFilter of fhir.proto
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesCodeSystemVersionFilter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Code = d.string()
+		case 5:
+			r.Op = append(r.Op, d.string())
+		case 6:
+			var v Element
+			d.message(&v)
+			r.CodeElement = &v
+		case 7:
+			r.OpElement = append(r.OpElement, d.element())
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesExpansion as JSON into a byte slice
+func (r TerminologyCapabilitiesExpansion) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesExpansion as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesExpansion) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Hierarchical != nil {
+		e.key("hierarchical")
+		e.bool(*r.Hierarchical)
+	}
+	if r.HierarchicalElement != nil {
+		e.key("_hierarchical")
+		r.HierarchicalElement.appendJSON(e)
+	}
+	if r.Paging != nil {
+		e.key("paging")
+		e.bool(*r.Paging)
+	}
+	if r.PagingElement != nil {
+		e.key("_paging")
+		r.PagingElement.appendJSON(e)
+	}
+	if r.Incomplete != nil {
+		e.key("incomplete")
+		e.bool(*r.Incomplete)
+	}
+	if r.IncompleteElement != nil {
+		e.key("_incomplete")
+		r.IncompleteElement.appendJSON(e)
+	}
+	if len(r.Parameter) > 0 {
+		e.key("parameter")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Parameter {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.TextFilter != nil {
+		e.key("textFilter")
+		e.string(*r.TextFilter)
+	}
+	if r.TextFilterElement != nil {
+		e.key("_textFilter")
+		r.TextFilterElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesExpansion from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesExpansion) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesExpansion
+func (r *TerminologyCapabilitiesExpansion) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "hierarchical":
+			if v, ok := d.bool(); ok {
+				r.Hierarchical = &v
+			} else {
+				r.Hierarchical = nil
+			}
+		case "_hierarchical":
+			r.HierarchicalElement = d.element()
+		case "paging":
+			if v, ok := d.bool(); ok {
+				r.Paging = &v
+			} else {
+				r.Paging = nil
+			}
+		case "_paging":
+			r.PagingElement = d.element()
+		case "incomplete":
+			if v, ok := d.bool(); ok {
+				r.Incomplete = &v
+			} else {
+				r.Incomplete = nil
+			}
+		case "_incomplete":
+			r.IncompleteElement = d.element()
+		case "parameter":
+			a := d.array()
+			r.Parameter = nil
+			for a.next() {
+				var v TerminologyCapabilitiesExpansionParameter
+				v.decodeJSON(d)
+				r.Parameter = append(r.Parameter, v)
+			}
+			if r.Parameter == nil && !a.isNull {
+				r.Parameter = []TerminologyCapabilitiesExpansionParameter{}
+			}
+		case "textFilter":
+			if v, ok := d.string(); ok {
+				r.TextFilter = &v
+			} else {
+				r.TextFilter = nil
+			}
+		case "_textFilter":
+			r.TextFilterElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesExpansion which shares no memory with the original
+func (r TerminologyCapabilitiesExpansion) DeepCopy() TerminologyCapabilitiesExpansion {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Hierarchical != nil {
+		v := *r.Hierarchical
+		out.Hierarchical = &v
+	}
+	if r.HierarchicalElement != nil {
+		v := r.HierarchicalElement.DeepCopy()
+		out.HierarchicalElement = &v
+	}
+	if r.Paging != nil {
+		v := *r.Paging
+		out.Paging = &v
+	}
+	if r.PagingElement != nil {
+		v := r.PagingElement.DeepCopy()
+		out.PagingElement = &v
+	}
+	if r.Incomplete != nil {
+		v := *r.Incomplete
+		out.Incomplete = &v
+	}
+	if r.IncompleteElement != nil {
+		v := r.IncompleteElement.DeepCopy()
+		out.IncompleteElement = &v
+	}
+	if r.Parameter != nil {
+		out.Parameter = make([]TerminologyCapabilitiesExpansionParameter, len(r.Parameter))
+		for i := range r.Parameter {
+			out.Parameter[i] = r.Parameter[i].DeepCopy()
+		}
+	}
+	if r.TextFilter != nil {
+		v := *r.TextFilter
+		out.TextFilter = &v
+	}
+	if r.TextFilterElement != nil {
+		v := r.TextFilterElement.DeepCopy()
+		out.TextFilterElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesExpansion) Equal(other TerminologyCapabilitiesExpansion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Hierarchical == nil) != (other.Hierarchical == nil) || r.Hierarchical != nil && *r.Hierarchical != *other.Hierarchical {
+		return false
+	}
+	if (r.HierarchicalElement == nil) != (other.HierarchicalElement == nil) || r.HierarchicalElement != nil && !r.HierarchicalElement.Equal(*other.HierarchicalElement) {
+		return false
+	}
+	if (r.Paging == nil) != (other.Paging == nil) || r.Paging != nil && *r.Paging != *other.Paging {
+		return false
+	}
+	if (r.PagingElement == nil) != (other.PagingElement == nil) || r.PagingElement != nil && !r.PagingElement.Equal(*other.PagingElement) {
+		return false
+	}
+	if (r.Incomplete == nil) != (other.Incomplete == nil) || r.Incomplete != nil && *r.Incomplete != *other.Incomplete {
+		return false
+	}
+	if (r.IncompleteElement == nil) != (other.IncompleteElement == nil) || r.IncompleteElement != nil && !r.IncompleteElement.Equal(*other.IncompleteElement) {
+		return false
+	}
+	if len(r.Parameter) != len(other.Parameter) {
+		return false
+	}
+	for i := range r.Parameter {
+		if !r.Parameter[i].Equal(other.Parameter[i]) {
+			return false
+		}
+	}
+	if (r.TextFilter == nil) != (other.TextFilter == nil) || r.TextFilter != nil && *r.TextFilter != *other.TextFilter {
+		return false
+	}
+	if (r.TextFilterElement == nil) != (other.TextFilterElement == nil) || r.TextFilterElement != nil && !r.TextFilterElement.Equal(*other.TextFilterElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesExpansion) EqualsDeep(other TerminologyCapabilitiesExpansion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Hierarchical == nil) != (other.Hierarchical == nil) || r.Hierarchical != nil && *r.Hierarchical != *other.Hierarchical {
+		return false
+	}
+	if (r.HierarchicalElement == nil) != (other.HierarchicalElement == nil) || r.HierarchicalElement != nil && !r.HierarchicalElement.EqualsDeep(*other.HierarchicalElement) {
+		return false
+	}
+	if (r.Paging == nil) != (other.Paging == nil) || r.Paging != nil && *r.Paging != *other.Paging {
+		return false
+	}
+	if (r.PagingElement == nil) != (other.PagingElement == nil) || r.PagingElement != nil && !r.PagingElement.EqualsDeep(*other.PagingElement) {
+		return false
+	}
+	if (r.Incomplete == nil) != (other.Incomplete == nil) || r.Incomplete != nil && *r.Incomplete != *other.Incomplete {
+		return false
+	}
+	if (r.IncompleteElement == nil) != (other.IncompleteElement == nil) || r.IncompleteElement != nil && !r.IncompleteElement.EqualsDeep(*other.IncompleteElement) {
+		return false
+	}
+	if !equivalentList(len(r.Parameter), len(other.Parameter), func(i, j int) bool {
+		return r.Parameter[i].EqualsDeep(other.Parameter[j])
+	}) {
+		return false
+	}
+	if (r.TextFilter == nil) != (other.TextFilter == nil) || r.TextFilter != nil && !equivalentString(*r.TextFilter, *other.TextFilter) {
+		return false
+	}
+	if (r.TextFilterElement == nil) != (other.TextFilterElement == nil) || r.TextFilterElement != nil && !r.TextFilterElement.EqualsDeep(*other.TextFilterElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesExpansion) EqualsShallow(other TerminologyCapabilitiesExpansion) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Hierarchical == nil) != (other.Hierarchical == nil) || r.Hierarchical != nil && *r.Hierarchical != *other.Hierarchical {
+		return false
+	}
+	if (r.Paging == nil) != (other.Paging == nil) || r.Paging != nil && *r.Paging != *other.Paging {
+		return false
+	}
+	if (r.Incomplete == nil) != (other.Incomplete == nil) || r.Incomplete != nil && *r.Incomplete != *other.Incomplete {
+		return false
+	}
+	if (r.TextFilter == nil) != (other.TextFilter == nil) || r.TextFilter != nil && !equivalentString(*r.TextFilter, *other.TextFilter) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesExpansion as FHIR XML
+func (r TerminologyCapabilitiesExpansion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "hierarchical", r.Hierarchical, r.HierarchicalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "paging", r.Paging, r.PagingElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "incomplete", r.Incomplete, r.IncompleteElement); err != nil {
+		return err
+	}
+	for _, v := range r.Parameter {
+		if err := encodeXMLElement(e, "parameter", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "textFilter", r.TextFilter, r.TextFilterElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesExpansion from FHIR XML
+func (r *TerminologyCapabilitiesExpansion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "hierarchical":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Hierarchical = &v
+				}
+				r.HierarchicalElement = element
+			case "paging":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Paging = &v
+				}
+				r.PagingElement = element
+			case "incomplete":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Incomplete = &v
+				}
+				r.IncompleteElement = element
+			case "parameter":
+				var v TerminologyCapabilitiesExpansionParameter
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Parameter = append(r.Parameter, v)
+			case "textFilter":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TextFilter = &v
+				}
+				r.TextFilterElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesExpansion as properties to the RDF node n
+func (r TerminologyCapabilitiesExpansion) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.expansion.hierarchical", -1, r.Hierarchical, r.HierarchicalElement, "boolean")
+	n.primitive("TerminologyCapabilities.expansion.paging", -1, r.Paging, r.PagingElement, "boolean")
+	n.primitive("TerminologyCapabilities.expansion.incomplete", -1, r.Incomplete, r.IncompleteElement, "boolean")
+	for i, v := range r.Parameter {
+		n.element("TerminologyCapabilities.expansion.parameter", i, v)
+	}
+	n.primitive("TerminologyCapabilities.expansion.textFilter", -1, r.TextFilter, r.TextFilterElement, "string")
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesExpansion as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesExpansion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesExpansion from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesExpansion) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesExpansion as protobuf message TerminologyCapabilitiesExpansion of fhir.proto
+func (r TerminologyCapabilitiesExpansion) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesExpansion to the encoded message
+func (r TerminologyCapabilitiesExpansion) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Hierarchical != nil {
+		e.varint(4, protoBool(*r.Hierarchical))
+	}
+	if r.Paging != nil {
+		e.varint(5, protoBool(*r.Paging))
+	}
+	if r.Incomplete != nil {
+		e.varint(6, protoBool(*r.Incomplete))
+	}
+	for _, v := range r.Parameter {
+		e.message(7, v)
+	}
+	if r.TextFilter != nil {
+		e.string(8, *r.TextFilter)
+	}
+	if r.HierarchicalElement != nil {
+		e.message(9, *r.HierarchicalElement)
+	}
+	if r.PagingElement != nil {
+		e.message(10, *r.PagingElement)
+	}
+	if r.IncompleteElement != nil {
+		e.message(11, *r.IncompleteElement)
+	}
+	if r.TextFilterElement != nil {
+		e.message(12, *r.TextFilterElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesExpansion from protobuf message TerminologyCapabilitiesExpansion of fhir.proto
+func (r *TerminologyCapabilitiesExpansion) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesExpansion{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.bool()
+			r.Hierarchical = &v
+		case 5:
+			v := d.bool()
+			r.Paging = &v
+		case 6:
+			v := d.bool()
+			r.Incomplete = &v
+		case 7:
+			var v TerminologyCapabilitiesExpansionParameter
+			d.message(&v)
+			r.Parameter = append(r.Parameter, v)
+		case 8:
+			v := d.string()
+			r.TextFilter = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.HierarchicalElement = &v
+		case 10:
+			var v Element
+			d.message(&v)
+			r.PagingElement = &v
+		case 11:
+			var v Element
+			d.message(&v)
+			r.IncompleteElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.TextFilterElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesExpansionParameter as JSON into a byte slice
+func (r TerminologyCapabilitiesExpansionParameter) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesExpansionParameter as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesExpansionParameter) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Documentation != nil {
+		e.key("documentation")
+		e.string(*r.Documentation)
+	}
+	if r.DocumentationElement != nil {
+		e.key("_documentation")
+		r.DocumentationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesExpansionParameter from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesExpansionParameter) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesExpansionParameter
+func (r *TerminologyCapabilitiesExpansionParameter) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "documentation":
+			if v, ok := d.string(); ok {
+				r.Documentation = &v
+			} else {
+				r.Documentation = nil
+			}
+		case "_documentation":
+			r.DocumentationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesExpansionParameter which shares no memory with the original
+func (r TerminologyCapabilitiesExpansionParameter) DeepCopy() TerminologyCapabilitiesExpansionParameter {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Documentation != nil {
+		v := *r.Documentation
+		out.Documentation = &v
+	}
+	if r.DocumentationElement != nil {
+		v := r.DocumentationElement.DeepCopy()
+		out.DocumentationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesExpansionParameter) Equal(other TerminologyCapabilitiesExpansionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && *r.Documentation != *other.Documentation {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.Equal(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesExpansionParameter) EqualsDeep(other TerminologyCapabilitiesExpansionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	if (r.DocumentationElement == nil) != (other.DocumentationElement == nil) || r.DocumentationElement != nil && !r.DocumentationElement.EqualsDeep(*other.DocumentationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesExpansionParameter) EqualsShallow(other TerminologyCapabilitiesExpansionParameter) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Documentation == nil) != (other.Documentation == nil) || r.Documentation != nil && !equivalentString(*r.Documentation, *other.Documentation) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesExpansionParameter as FHIR XML
+func (r TerminologyCapabilitiesExpansionParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "documentation", r.Documentation, r.DocumentationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesExpansionParameter from FHIR XML
+func (r *TerminologyCapabilitiesExpansionParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "documentation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Documentation = &v
+				}
+				r.DocumentationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesExpansionParameter as properties to the RDF node n
+func (r TerminologyCapabilitiesExpansionParameter) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.expansion.parameter.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("TerminologyCapabilities.expansion.parameter.documentation", -1, r.Documentation, r.DocumentationElement, "string")
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesExpansionParameter as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesExpansionParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesExpansionParameter from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesExpansionParameter) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesExpansionParameter as protobuf message TerminologyCapabilitiesExpansionParameter of fhir.proto
+func (r TerminologyCapabilitiesExpansionParameter) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesExpansionParameter to the encoded message
+func (r TerminologyCapabilitiesExpansionParameter) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.Documentation != nil {
+		e.string(5, *r.Documentation)
+	}
+	if r.NameElement != nil {
+		e.message(6, *r.NameElement)
+	}
+	if r.DocumentationElement != nil {
+		e.message(7, *r.DocumentationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesExpansionParameter from protobuf message TerminologyCapabilitiesExpansionParameter of fhir.proto
+func (r *TerminologyCapabilitiesExpansionParameter) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesExpansionParameter{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.Documentation = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DocumentationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesValidateCode as JSON into a byte slice
+func (r TerminologyCapabilitiesValidateCode) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesValidateCode as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesValidateCode) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("translations")
+	e.bool(r.Translations)
+	if r.TranslationsElement != nil {
+		e.key("_translations")
+		r.TranslationsElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesValidateCode from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesValidateCode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesValidateCode
+func (r *TerminologyCapabilitiesValidateCode) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "translations":
+			if v, ok := d.bool(); ok {
+				r.Translations = v
+			}
+		case "_translations":
+			r.TranslationsElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesValidateCode which shares no memory with the original
+func (r TerminologyCapabilitiesValidateCode) DeepCopy() TerminologyCapabilitiesValidateCode {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TranslationsElement != nil {
+		v := r.TranslationsElement.DeepCopy()
+		out.TranslationsElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesValidateCode) Equal(other TerminologyCapabilitiesValidateCode) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Translations != other.Translations {
+		return false
+	}
+	if (r.TranslationsElement == nil) != (other.TranslationsElement == nil) || r.TranslationsElement != nil && !r.TranslationsElement.Equal(*other.TranslationsElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesValidateCode) EqualsDeep(other TerminologyCapabilitiesValidateCode) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Translations != other.Translations {
+		return false
+	}
+	if (r.TranslationsElement == nil) != (other.TranslationsElement == nil) || r.TranslationsElement != nil && !r.TranslationsElement.EqualsDeep(*other.TranslationsElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesValidateCode) EqualsShallow(other TerminologyCapabilitiesValidateCode) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Translations != other.Translations {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesValidateCode as FHIR XML
+func (r TerminologyCapabilitiesValidateCode) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "translations", r.Translations, r.TranslationsElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesValidateCode from FHIR XML
+func (r *TerminologyCapabilitiesValidateCode) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "translations":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Translations = v
+				}
+				r.TranslationsElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesValidateCode as properties to the RDF node n
+func (r TerminologyCapabilitiesValidateCode) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.validateCode.translations", -1, r.Translations, r.TranslationsElement, "boolean")
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesValidateCode as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesValidateCode) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesValidateCode from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesValidateCode) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesValidateCode as protobuf message TerminologyCapabilitiesValidateCode of fhir.proto
+func (r TerminologyCapabilitiesValidateCode) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesValidateCode to the encoded message
+func (r TerminologyCapabilitiesValidateCode) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Translations {
+		e.varint(4, protoBool(r.Translations))
+	}
+	if r.TranslationsElement != nil {
+		e.message(5, *r.TranslationsElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesValidateCode from protobuf message TerminologyCapabilitiesValidateCode of fhir.proto
+func (r *TerminologyCapabilitiesValidateCode) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesValidateCode{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Translations = d.bool()
+		case 5:
+			var v Element
+			d.message(&v)
+			r.TranslationsElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesTranslation as JSON into a byte slice
+func (r TerminologyCapabilitiesTranslation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesTranslation as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesTranslation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("needsMap")
+	e.bool(r.NeedsMap)
+	if r.NeedsMapElement != nil {
+		e.key("_needsMap")
+		r.NeedsMapElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesTranslation from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesTranslation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesTranslation
+func (r *TerminologyCapabilitiesTranslation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "needsMap":
+			if v, ok := d.bool(); ok {
+				r.NeedsMap = v
+			}
+		case "_needsMap":
+			r.NeedsMapElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesTranslation which shares no memory with the original
+func (r TerminologyCapabilitiesTranslation) DeepCopy() TerminologyCapabilitiesTranslation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NeedsMapElement != nil {
+		v := r.NeedsMapElement.DeepCopy()
+		out.NeedsMapElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesTranslation) Equal(other TerminologyCapabilitiesTranslation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.NeedsMap != other.NeedsMap {
+		return false
+	}
+	if (r.NeedsMapElement == nil) != (other.NeedsMapElement == nil) || r.NeedsMapElement != nil && !r.NeedsMapElement.Equal(*other.NeedsMapElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesTranslation) EqualsDeep(other TerminologyCapabilitiesTranslation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.NeedsMap != other.NeedsMap {
+		return false
+	}
+	if (r.NeedsMapElement == nil) != (other.NeedsMapElement == nil) || r.NeedsMapElement != nil && !r.NeedsMapElement.EqualsDeep(*other.NeedsMapElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesTranslation) EqualsShallow(other TerminologyCapabilitiesTranslation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.NeedsMap != other.NeedsMap {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesTranslation as FHIR XML
+func (r TerminologyCapabilitiesTranslation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "needsMap", r.NeedsMap, r.NeedsMapElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesTranslation from FHIR XML
+func (r *TerminologyCapabilitiesTranslation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "needsMap":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NeedsMap = v
+				}
+				r.NeedsMapElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesTranslation as properties to the RDF node n
+func (r TerminologyCapabilitiesTranslation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.translation.needsMap", -1, r.NeedsMap, r.NeedsMapElement, "boolean")
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesTranslation as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesTranslation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesTranslation from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesTranslation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesTranslation as protobuf message TerminologyCapabilitiesTranslation of fhir.proto
+func (r TerminologyCapabilitiesTranslation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesTranslation to the encoded message
+func (r TerminologyCapabilitiesTranslation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.NeedsMap {
+		e.varint(4, protoBool(r.NeedsMap))
+	}
+	if r.NeedsMapElement != nil {
+		e.message(5, *r.NeedsMapElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesTranslation from protobuf message TerminologyCapabilitiesTranslation of fhir.proto
+func (r *TerminologyCapabilitiesTranslation) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesTranslation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.NeedsMap = d.bool()
+		case 5:
+			var v Element
+			d.message(&v)
+			r.NeedsMapElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TerminologyCapabilitiesClosure as JSON into a byte slice
+func (r TerminologyCapabilitiesClosure) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TerminologyCapabilitiesClosure as JSON object with the members in the order of the fields
+func (r TerminologyCapabilitiesClosure) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Translation != nil {
+		e.key("translation")
+		e.bool(*r.Translation)
+	}
+	if r.TranslationElement != nil {
+		e.key("_translation")
+		r.TranslationElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TerminologyCapabilitiesClosure from JSON. Unknown members are ignored.
+func (r *TerminologyCapabilitiesClosure) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TerminologyCapabilitiesClosure
+func (r *TerminologyCapabilitiesClosure) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "translation":
+			if v, ok := d.bool(); ok {
+				r.Translation = &v
+			} else {
+				r.Translation = nil
+			}
+		case "_translation":
+			r.TranslationElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TerminologyCapabilitiesClosure which shares no memory with the original
+func (r TerminologyCapabilitiesClosure) DeepCopy() TerminologyCapabilitiesClosure {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Translation != nil {
+		v := *r.Translation
+		out.Translation = &v
+	}
+	if r.TranslationElement != nil {
+		v := r.TranslationElement.DeepCopy()
+		out.TranslationElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TerminologyCapabilitiesClosure) Equal(other TerminologyCapabilitiesClosure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Translation == nil) != (other.Translation == nil) || r.Translation != nil && *r.Translation != *other.Translation {
+		return false
+	}
+	if (r.TranslationElement == nil) != (other.TranslationElement == nil) || r.TranslationElement != nil && !r.TranslationElement.Equal(*other.TranslationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TerminologyCapabilitiesClosure) EqualsDeep(other TerminologyCapabilitiesClosure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Translation == nil) != (other.Translation == nil) || r.Translation != nil && *r.Translation != *other.Translation {
+		return false
+	}
+	if (r.TranslationElement == nil) != (other.TranslationElement == nil) || r.TranslationElement != nil && !r.TranslationElement.EqualsDeep(*other.TranslationElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TerminologyCapabilitiesClosure) EqualsShallow(other TerminologyCapabilitiesClosure) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Translation == nil) != (other.Translation == nil) || r.Translation != nil && *r.Translation != *other.Translation {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TerminologyCapabilitiesClosure as FHIR XML
+func (r TerminologyCapabilitiesClosure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "translation", r.Translation, r.TranslationElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TerminologyCapabilitiesClosure from FHIR XML
+func (r *TerminologyCapabilitiesClosure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "translation":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Translation = &v
+				}
+				r.TranslationElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TerminologyCapabilitiesClosure as properties to the RDF node n
+func (r TerminologyCapabilitiesClosure) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TerminologyCapabilities.closure.translation", -1, r.Translation, r.TranslationElement, "boolean")
+}
+
+// MarshalBSON marshals the given TerminologyCapabilitiesClosure as BSON document with the structure of its FHIR JSON
+func (r TerminologyCapabilitiesClosure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TerminologyCapabilitiesClosure from a BSON document with the structure of its FHIR JSON
+func (r *TerminologyCapabilitiesClosure) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TerminologyCapabilitiesClosure as protobuf message TerminologyCapabilitiesClosure of fhir.proto
+func (r TerminologyCapabilitiesClosure) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TerminologyCapabilitiesClosure to the encoded message
+func (r TerminologyCapabilitiesClosure) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Translation != nil {
+		e.varint(4, protoBool(*r.Translation))
+	}
+	if r.TranslationElement != nil {
+		e.message(5, *r.TranslationElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TerminologyCapabilitiesClosure from protobuf message TerminologyCapabilitiesClosure of fhir.proto
+func (r *TerminologyCapabilitiesClosure) UnmarshalProto(b []byte) error {
+	*r = TerminologyCapabilitiesClosure{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.bool()
+			r.Translation = &v
+		case 5:
+			var v Element
+			d.message(&v)
+			r.TranslationElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalTerminologyCapabilities unmarshals a TerminologyCapabilities.
 func UnmarshalTerminologyCapabilities(b []byte) (TerminologyCapabilities, error) {
 	var terminologyCapabilities TerminologyCapabilities
-	if err := json.Unmarshal(b, &terminologyCapabilities); err != nil {
+	if err := terminologyCapabilities.UnmarshalJSON(b); err != nil {
 		return terminologyCapabilities, err
 	}
 	return terminologyCapabilities, nil
@@ -14,40 +14,56 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // TestReport is documented here http://hl7.org/fhir/StructureDefinition/TestReport
 type TestReport struct {
-	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        *Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name              *string                 `bson:"name,omitempty" json:"name,omitempty"`
-	Status            TestReportStatus        `bson:"status" json:"status"`
-	TestScript        Reference               `bson:"testScript" json:"testScript"`
-	Result            TestReportResult        `bson:"result" json:"result"`
-	Score             *json.Number            `bson:"score,omitempty" json:"score,omitempty"`
-	Tester            *string                 `bson:"tester,omitempty" json:"tester,omitempty"`
-	Issued            *string                 `bson:"issued,omitempty" json:"issued,omitempty"`
-	Participant       []TestReportParticipant `bson:"participant,omitempty" json:"participant,omitempty"`
-	Setup             *TestReportSetup        `bson:"setup,omitempty" json:"setup,omitempty"`
-	Test              []TestReportTest        `bson:"test,omitempty" json:"test,omitempty"`
-	Teardown          *TestReportTeardown     `bson:"teardown,omitempty" json:"teardown,omitempty"`
+	Id                   *string                 `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage       `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           *Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name                 *string                 `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement          *Element                `bson:"_name,omitempty" json:"_name,omitempty"`
+	Status               TestReportStatus        `bson:"status" json:"status"`
+	StatusElement        *Element                `bson:"_status,omitempty" json:"_status,omitempty"`
+	TestScript           Reference               `bson:"testScript" json:"testScript"`
+	Result               TestReportResult        `bson:"result" json:"result"`
+	ResultElement        *Element                `bson:"_result,omitempty" json:"_result,omitempty"`
+	Score                *json.Number            `bson:"score,omitempty" json:"score,omitempty"`
+	ScoreElement         *Element                `bson:"_score,omitempty" json:"_score,omitempty"`
+	Tester               *string                 `bson:"tester,omitempty" json:"tester,omitempty"`
+	TesterElement        *Element                `bson:"_tester,omitempty" json:"_tester,omitempty"`
+	Issued               *string                 `bson:"issued,omitempty" json:"issued,omitempty"`
+	IssuedElement        *Element                `bson:"_issued,omitempty" json:"_issued,omitempty"`
+	Participant          []TestReportParticipant `bson:"participant,omitempty" json:"participant,omitempty"`
+	Setup                *TestReportSetup        `bson:"setup,omitempty" json:"setup,omitempty"`
+	Test                 []TestReportTest        `bson:"test,omitempty" json:"test,omitempty"`
+	Teardown             *TestReportTeardown     `bson:"teardown,omitempty" json:"teardown,omitempty"`
 }
 type TestReportParticipant struct {
 	Id                *string                   `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension               `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Type              TestReportParticipantType `bson:"type" json:"type"`
+	TypeElement       *Element                  `bson:"_type,omitempty" json:"_type,omitempty"`
 	Uri               string                    `bson:"uri" json:"uri"`
+	UriElement        *Element                  `bson:"_uri,omitempty" json:"_uri,omitempty"`
 	Display           *string                   `bson:"display,omitempty" json:"display,omitempty"`
+	DisplayElement    *Element                  `bson:"_display,omitempty" json:"_display,omitempty"`
 }
 type TestReportSetup struct {
 	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
@@ -67,24 +83,32 @@ type TestReportSetupActionOperation struct {
 	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Result            TestReportActionResult `bson:"result" json:"result"`
+	ResultElement     *Element               `bson:"_result,omitempty" json:"_result,omitempty"`
 	Message           *string                `bson:"message,omitempty" json:"message,omitempty"`
+	MessageElement    *Element               `bson:"_message,omitempty" json:"_message,omitempty"`
 	Detail            *string                `bson:"detail,omitempty" json:"detail,omitempty"`
+	DetailElement     *Element               `bson:"_detail,omitempty" json:"_detail,omitempty"`
 }
 type TestReportSetupActionAssert struct {
 	Id                *string                `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Result            TestReportActionResult `bson:"result" json:"result"`
+	ResultElement     *Element               `bson:"_result,omitempty" json:"_result,omitempty"`
 	Message           *string                `bson:"message,omitempty" json:"message,omitempty"`
+	MessageElement    *Element               `bson:"_message,omitempty" json:"_message,omitempty"`
 	Detail            *string                `bson:"detail,omitempty" json:"detail,omitempty"`
+	DetailElement     *Element               `bson:"_detail,omitempty" json:"_detail,omitempty"`
 }
 type TestReportTest struct {
-	Id                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              *string                `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                `bson:"description,omitempty" json:"description,omitempty"`
-	Action            []TestReportTestAction `bson:"action" json:"action"`
+	Id                 *string                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               *string                `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element               `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element               `bson:"_description,omitempty" json:"_description,omitempty"`
+	Action             []TestReportTestAction `bson:"action" json:"action"`
 }
 type TestReportTestAction struct {
 	Id                *string                         `bson:"id,omitempty" json:"id,omitempty"`
@@ -105,23 +129,4845 @@ type TestReportTeardownAction struct {
 	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Operation         TestReportSetupActionOperation `bson:"operation,omitempty" json:"operation,omitempty"`
 }
-type OtherTestReport TestReport
 
 // MarshalJSON marshals the given TestReport as JSON into a byte slice
 func (r TestReport) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherTestReport
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherTestReport: OtherTestReport(r),
-		ResourceType:    "TestReport",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReport as JSON object with the members in the order of the fields
+func (r TestReport) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"TestReport\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("testScript")
+	r.TestScript.appendJSON(e)
+	e.key("result")
+	e.string(r.Result.Code())
+	if r.ResultElement != nil {
+		e.key("_result")
+		r.ResultElement.appendJSON(e)
+	}
+	if r.Score != nil {
+		e.key("score")
+		e.number(*r.Score)
+	}
+	if r.ScoreElement != nil {
+		e.key("_score")
+		r.ScoreElement.appendJSON(e)
+	}
+	if r.Tester != nil {
+		e.key("tester")
+		e.string(*r.Tester)
+	}
+	if r.TesterElement != nil {
+		e.key("_tester")
+		r.TesterElement.appendJSON(e)
+	}
+	if r.Issued != nil {
+		e.key("issued")
+		e.string(*r.Issued)
+	}
+	if r.IssuedElement != nil {
+		e.key("_issued")
+		r.IssuedElement.appendJSON(e)
+	}
+	if len(r.Participant) > 0 {
+		e.key("participant")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Participant {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Setup != nil {
+		e.key("setup")
+		r.Setup.appendJSON(e)
+	}
+	if len(r.Test) > 0 {
+		e.key("test")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Test {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Teardown != nil {
+		e.key("teardown")
+		r.Teardown.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReport from JSON. Unknown members are ignored.
+func (r *TestReport) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReport
+func (r *TestReport) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "testScript":
+			r.TestScript.decodeJSON(d)
+		case "result":
+			d.unmarshal(&r.Result)
+		case "_result":
+			r.ResultElement = d.element()
+		case "score":
+			if v, ok := d.number(); ok {
+				r.Score = &v
+			} else {
+				r.Score = nil
+			}
+		case "_score":
+			r.ScoreElement = d.element()
+		case "tester":
+			if v, ok := d.string(); ok {
+				r.Tester = &v
+			} else {
+				r.Tester = nil
+			}
+		case "_tester":
+			r.TesterElement = d.element()
+		case "issued":
+			if v, ok := d.string(); ok {
+				r.Issued = &v
+			} else {
+				r.Issued = nil
+			}
+		case "_issued":
+			r.IssuedElement = d.element()
+		case "participant":
+			a := d.array()
+			r.Participant = nil
+			for a.next() {
+				var v TestReportParticipant
+				v.decodeJSON(d)
+				r.Participant = append(r.Participant, v)
+			}
+			if r.Participant == nil && !a.isNull {
+				r.Participant = []TestReportParticipant{}
+			}
+		case "setup":
+			if d.null() {
+				r.Setup = nil
+			} else {
+				var v TestReportSetup
+				v.decodeJSON(d)
+				r.Setup = &v
+			}
+		case "test":
+			a := d.array()
+			r.Test = nil
+			for a.next() {
+				var v TestReportTest
+				v.decodeJSON(d)
+				r.Test = append(r.Test, v)
+			}
+			if r.Test == nil && !a.isNull {
+				r.Test = []TestReportTest{}
+			}
+		case "teardown":
+			if d.null() {
+				r.Teardown = nil
+			} else {
+				var v TestReportTeardown
+				v.decodeJSON(d)
+				r.Teardown = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReport which shares no memory with the original
+func (r TestReport) DeepCopy() TestReport {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	out.TestScript = r.TestScript.DeepCopy()
+	if r.ResultElement != nil {
+		v := r.ResultElement.DeepCopy()
+		out.ResultElement = &v
+	}
+	if r.Score != nil {
+		v := *r.Score
+		out.Score = &v
+	}
+	if r.ScoreElement != nil {
+		v := r.ScoreElement.DeepCopy()
+		out.ScoreElement = &v
+	}
+	if r.Tester != nil {
+		v := *r.Tester
+		out.Tester = &v
+	}
+	if r.TesterElement != nil {
+		v := r.TesterElement.DeepCopy()
+		out.TesterElement = &v
+	}
+	if r.Issued != nil {
+		v := *r.Issued
+		out.Issued = &v
+	}
+	if r.IssuedElement != nil {
+		v := r.IssuedElement.DeepCopy()
+		out.IssuedElement = &v
+	}
+	if r.Participant != nil {
+		out.Participant = make([]TestReportParticipant, len(r.Participant))
+		for i := range r.Participant {
+			out.Participant[i] = r.Participant[i].DeepCopy()
+		}
+	}
+	if r.Setup != nil {
+		v := r.Setup.DeepCopy()
+		out.Setup = &v
+	}
+	if r.Test != nil {
+		out.Test = make([]TestReportTest, len(r.Test))
+		for i := range r.Test {
+			out.Test[i] = r.Test[i].DeepCopy()
+		}
+	}
+	if r.Teardown != nil {
+		v := r.Teardown.DeepCopy()
+		out.Teardown = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReport) Equal(other TestReport) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if !r.TestScript.Equal(other.TestScript) {
+		return false
+	}
+	if r.Result != other.Result {
+		return false
+	}
+	if (r.ResultElement == nil) != (other.ResultElement == nil) || r.ResultElement != nil && !r.ResultElement.Equal(*other.ResultElement) {
+		return false
+	}
+	if (r.Score == nil) != (other.Score == nil) || r.Score != nil && !EqualDecimal(*r.Score, *other.Score) {
+		return false
+	}
+	if (r.ScoreElement == nil) != (other.ScoreElement == nil) || r.ScoreElement != nil && !r.ScoreElement.Equal(*other.ScoreElement) {
+		return false
+	}
+	if (r.Tester == nil) != (other.Tester == nil) || r.Tester != nil && *r.Tester != *other.Tester {
+		return false
+	}
+	if (r.TesterElement == nil) != (other.TesterElement == nil) || r.TesterElement != nil && !r.TesterElement.Equal(*other.TesterElement) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && *r.Issued != *other.Issued {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.Equal(*other.IssuedElement) {
+		return false
+	}
+	if len(r.Participant) != len(other.Participant) {
+		return false
+	}
+	for i := range r.Participant {
+		if !r.Participant[i].Equal(other.Participant[i]) {
+			return false
+		}
+	}
+	if (r.Setup == nil) != (other.Setup == nil) || r.Setup != nil && !r.Setup.Equal(*other.Setup) {
+		return false
+	}
+	if len(r.Test) != len(other.Test) {
+		return false
+	}
+	for i := range r.Test {
+		if !r.Test[i].Equal(other.Test[i]) {
+			return false
+		}
+	}
+	if (r.Teardown == nil) != (other.Teardown == nil) || r.Teardown != nil && !r.Teardown.Equal(*other.Teardown) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReport) EqualsDeep(other TestReport) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !r.TestScript.EqualsDeep(other.TestScript) {
+		return false
+	}
+	if r.Result != other.Result {
+		return false
+	}
+	if (r.ResultElement == nil) != (other.ResultElement == nil) || r.ResultElement != nil && !r.ResultElement.EqualsDeep(*other.ResultElement) {
+		return false
+	}
+	if (r.Score == nil) != (other.Score == nil) || r.Score != nil && !equivalentDecimal(*r.Score, *other.Score) {
+		return false
+	}
+	if (r.ScoreElement == nil) != (other.ScoreElement == nil) || r.ScoreElement != nil && !r.ScoreElement.EqualsDeep(*other.ScoreElement) {
+		return false
+	}
+	if (r.Tester == nil) != (other.Tester == nil) || r.Tester != nil && !equivalentString(*r.Tester, *other.Tester) {
+		return false
+	}
+	if (r.TesterElement == nil) != (other.TesterElement == nil) || r.TesterElement != nil && !r.TesterElement.EqualsDeep(*other.TesterElement) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	if (r.IssuedElement == nil) != (other.IssuedElement == nil) || r.IssuedElement != nil && !r.IssuedElement.EqualsDeep(*other.IssuedElement) {
+		return false
+	}
+	if !equivalentList(len(r.Participant), len(other.Participant), func(i, j int) bool {
+		return r.Participant[i].EqualsDeep(other.Participant[j])
+	}) {
+		return false
+	}
+	if (r.Setup == nil) != (other.Setup == nil) || r.Setup != nil && !r.Setup.EqualsDeep(*other.Setup) {
+		return false
+	}
+	if !equivalentList(len(r.Test), len(other.Test), func(i, j int) bool {
+		return r.Test[i].EqualsDeep(other.Test[j])
+	}) {
+		return false
+	}
+	if (r.Teardown == nil) != (other.Teardown == nil) || r.Teardown != nil && !r.Teardown.EqualsDeep(*other.Teardown) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReport) EqualsShallow(other TestReport) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if r.Result != other.Result {
+		return false
+	}
+	if (r.Score == nil) != (other.Score == nil) || r.Score != nil && !equivalentDecimal(*r.Score, *other.Score) {
+		return false
+	}
+	if (r.Tester == nil) != (other.Tester == nil) || r.Tester != nil && !equivalentString(*r.Tester, *other.Tester) {
+		return false
+	}
+	if (r.Issued == nil) != (other.Issued == nil) || r.Issued != nil && !equivalentString(*r.Issued, *other.Issued) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReport as FHIR XML
+func (r TestReport) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "TestReport",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "testScript", r.TestScript); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "result", r.Result, r.ResultElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "score", r.Score, r.ScoreElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "tester", r.Tester, r.TesterElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "issued", r.Issued, r.IssuedElement); err != nil {
+		return err
+	}
+	for _, v := range r.Participant {
+		if err := encodeXMLElement(e, "participant", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "setup", r.Setup); err != nil {
+		return err
+	}
+	for _, v := range r.Test {
+		if err := encodeXMLElement(e, "test", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "teardown", r.Teardown); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReport from FHIR XML
+func (r *TestReport) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "status":
+				var v TestReportStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "testScript":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.TestScript = v
+			case "result":
+				var v TestReportResult
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Result = v
+				}
+				r.ResultElement = element
+			case "score":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Score = &v
+				}
+				r.ScoreElement = element
+			case "tester":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Tester = &v
+				}
+				r.TesterElement = element
+			case "issued":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Issued = &v
+				}
+				r.IssuedElement = element
+			case "participant":
+				var v TestReportParticipant
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Participant = append(r.Participant, v)
+			case "setup":
+				var v TestReportSetup
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Setup = &v
+			case "test":
+				var v TestReportTest
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Test = append(r.Test, v)
+			case "teardown":
+				var v TestReportTeardown
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Teardown = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReport as properties to the RDF node n
+func (r TestReport) turtle(n *turtleNode) {
+	n.resource("TestReport", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.element("TestReport.identifier", -1, r.Identifier)
+	n.primitive("TestReport.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("TestReport.status", -1, r.Status, r.StatusElement, "code")
+	n.element("TestReport.testScript", -1, r.TestScript)
+	n.primitive("TestReport.result", -1, r.Result, r.ResultElement, "code")
+	n.primitive("TestReport.score", -1, r.Score, r.ScoreElement, "decimal")
+	n.primitive("TestReport.tester", -1, r.Tester, r.TesterElement, "string")
+	n.primitive("TestReport.issued", -1, r.Issued, r.IssuedElement, "dateTime")
+	for i, v := range r.Participant {
+		n.element("TestReport.participant", i, v)
+	}
+	n.element("TestReport.setup", -1, r.Setup)
+	for i, v := range r.Test {
+		n.element("TestReport.test", i, v)
+	}
+	n.element("TestReport.teardown", -1, r.Teardown)
+}
+
+// MarshalBSON marshals the given TestReport as BSON document with the structure of its FHIR JSON
+func (r TestReport) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReport from a BSON document with the structure of its FHIR JSON
+func (r *TestReport) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReport as protobuf message TestReport of fhir.proto
+func (r TestReport) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReport to the encoded message
+func (r TestReport) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Identifier != nil {
+		e.message(9, *r.Identifier)
+	}
+	if r.Name != nil {
+		e.string(10, *r.Name)
+	}
+	e.varint(11, r.Status.protoNumber())
+	e.message(12, r.TestScript)
+	e.varint(13, r.Result.protoNumber())
+	if r.Score != nil {
+		e.string(14, string(*r.Score))
+	}
+	if r.Tester != nil {
+		e.string(15, *r.Tester)
+	}
+	if r.Issued != nil {
+		e.string(16, *r.Issued)
+	}
+	for _, v := range r.Participant {
+		e.message(17, v)
+	}
+	if r.Setup != nil {
+		e.message(18, *r.Setup)
+	}
+	for _, v := range r.Test {
+		e.message(19, v)
+	}
+	if r.Teardown != nil {
+		e.message(20, *r.Teardown)
+	}
+	if r.IdElement != nil {
+		e.message(21, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(22, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(23, *r.LanguageElement)
+	}
+	if r.NameElement != nil {
+		e.message(24, *r.NameElement)
+	}
+	if r.StatusElement != nil {
+		e.message(25, *r.StatusElement)
+	}
+	if r.ResultElement != nil {
+		e.message(26, *r.ResultElement)
+	}
+	if r.ScoreElement != nil {
+		e.message(27, *r.ScoreElement)
+	}
+	if r.TesterElement != nil {
+		e.message(28, *r.TesterElement)
+	}
+	if r.IssuedElement != nil {
+		e.message(29, *r.IssuedElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestReport from protobuf message TestReport of fhir.proto
+func (r *TestReport) UnmarshalProto(b []byte) error {
+	*r = TestReport{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 10:
+			v := d.string()
+			r.Name = &v
+		case 11:
+			var v TestReportStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.TestScript = v
+		case 13:
+			var v TestReportResult
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Result = v
+		case 14:
+			v := json.Number(d.string())
+			r.Score = &v
+		case 15:
+			v := d.string()
+			r.Tester = &v
+		case 16:
+			v := d.string()
+			r.Issued = &v
+		case 17:
+			var v TestReportParticipant
+			d.message(&v)
+			r.Participant = append(r.Participant, v)
+		case 18:
+			var v TestReportSetup
+			d.message(&v)
+			r.Setup = &v
+		case 19:
+			var v TestReportTest
+			d.message(&v)
+			r.Test = append(r.Test, v)
+		case 20:
+			var v TestReportTeardown
+			d.message(&v)
+			r.Teardown = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ResultElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.ScoreElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.TesterElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.IssuedElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestReportParticipant as JSON into a byte slice
+func (r TestReportParticipant) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReportParticipant as JSON object with the members in the order of the fields
+func (r TestReportParticipant) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("type")
+	e.string(r.Type.Code())
+	if r.TypeElement != nil {
+		e.key("_type")
+		r.TypeElement.appendJSON(e)
+	}
+	e.key("uri")
+	e.string(r.Uri)
+	if r.UriElement != nil {
+		e.key("_uri")
+		r.UriElement.appendJSON(e)
+	}
+	if r.Display != nil {
+		e.key("display")
+		e.string(*r.Display)
+	}
+	if r.DisplayElement != nil {
+		e.key("_display")
+		r.DisplayElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReportParticipant from JSON. Unknown members are ignored.
+func (r *TestReportParticipant) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReportParticipant
+func (r *TestReportParticipant) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			d.unmarshal(&r.Type)
+		case "_type":
+			r.TypeElement = d.element()
+		case "uri":
+			if v, ok := d.string(); ok {
+				r.Uri = v
+			}
+		case "_uri":
+			r.UriElement = d.element()
+		case "display":
+			if v, ok := d.string(); ok {
+				r.Display = &v
+			} else {
+				r.Display = nil
+			}
+		case "_display":
+			r.DisplayElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReportParticipant which shares no memory with the original
+func (r TestReportParticipant) DeepCopy() TestReportParticipant {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.TypeElement != nil {
+		v := r.TypeElement.DeepCopy()
+		out.TypeElement = &v
+	}
+	if r.UriElement != nil {
+		v := r.UriElement.DeepCopy()
+		out.UriElement = &v
+	}
+	if r.Display != nil {
+		v := *r.Display
+		out.Display = &v
+	}
+	if r.DisplayElement != nil {
+		v := r.DisplayElement.DeepCopy()
+		out.DisplayElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReportParticipant) Equal(other TestReportParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.Equal(*other.TypeElement) {
+		return false
+	}
+	if r.Uri != other.Uri {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.Equal(*other.UriElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && *r.Display != *other.Display {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.Equal(*other.DisplayElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReportParticipant) EqualsDeep(other TestReportParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if (r.TypeElement == nil) != (other.TypeElement == nil) || r.TypeElement != nil && !r.TypeElement.EqualsDeep(*other.TypeElement) {
+		return false
+	}
+	if !equivalentString(r.Uri, other.Uri) {
+		return false
+	}
+	if (r.UriElement == nil) != (other.UriElement == nil) || r.UriElement != nil && !r.UriElement.EqualsDeep(*other.UriElement) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	if (r.DisplayElement == nil) != (other.DisplayElement == nil) || r.DisplayElement != nil && !r.DisplayElement.EqualsDeep(*other.DisplayElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReportParticipant) EqualsShallow(other TestReportParticipant) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Type != other.Type {
+		return false
+	}
+	if !equivalentString(r.Uri, other.Uri) {
+		return false
+	}
+	if (r.Display == nil) != (other.Display == nil) || r.Display != nil && !equivalentString(*r.Display, *other.Display) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReportParticipant as FHIR XML
+func (r TestReportParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "type", r.Type, r.TypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "uri", r.Uri, r.UriElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "display", r.Display, r.DisplayElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReportParticipant from FHIR XML
+func (r *TestReportParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v TestReportParticipantType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Type = v
+				}
+				r.TypeElement = element
+			case "uri":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Uri = v
+				}
+				r.UriElement = element
+			case "display":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Display = &v
+				}
+				r.DisplayElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReportParticipant as properties to the RDF node n
+func (r TestReportParticipant) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestReport.participant.type", -1, r.Type, r.TypeElement, "code")
+	n.primitive("TestReport.participant.uri", -1, r.Uri, r.UriElement, "string")
+	n.primitive("TestReport.participant.display", -1, r.Display, r.DisplayElement, "string")
+}
+
+// MarshalBSON marshals the given TestReportParticipant as BSON document with the structure of its FHIR JSON
+func (r TestReportParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReportParticipant from a BSON document with the structure of its FHIR JSON
+func (r *TestReportParticipant) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReportParticipant as protobuf message TestReportParticipant of fhir.proto
+func (r TestReportParticipant) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReportParticipant to the encoded message
+func (r TestReportParticipant) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Type.protoNumber())
+	if r.Uri != "" {
+		e.string(5, r.Uri)
+	}
+	if r.Display != nil {
+		e.string(6, *r.Display)
+	}
+	if r.TypeElement != nil {
+		e.message(7, *r.TypeElement)
+	}
+	if r.UriElement != nil {
+		e.message(8, *r.UriElement)
+	}
+	if r.DisplayElement != nil {
+		e.message(9, *r.DisplayElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestReportParticipant from protobuf message TestReportParticipant of fhir.proto
+func (r *TestReportParticipant) UnmarshalProto(b []byte) error {
+	*r = TestReportParticipant{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestReportParticipantType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Type = v
+		case 5:
+			r.Uri = d.string()
+		case 6:
+			v := d.string()
+			r.Display = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.TypeElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.UriElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DisplayElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestReportSetup as JSON into a byte slice
+func (r TestReportSetup) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReportSetup as JSON object with the members in the order of the fields
+func (r TestReportSetup) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("action")
+	if r.Action == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReportSetup from JSON. Unknown members are ignored.
+func (r *TestReportSetup) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReportSetup
+func (r *TestReportSetup) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v TestReportSetupAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []TestReportSetupAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReportSetup which shares no memory with the original
+func (r TestReportSetup) DeepCopy() TestReportSetup {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		out.Action = make([]TestReportSetupAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReportSetup) Equal(other TestReportSetup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReportSetup) EqualsDeep(other TestReportSetup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReportSetup) EqualsShallow(other TestReportSetup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReportSetup as FHIR XML
+func (r TestReportSetup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReportSetup from FHIR XML
+func (r *TestReportSetup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "action":
+				var v TestReportSetupAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReportSetup as properties to the RDF node n
+func (r TestReportSetup) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Action {
+		n.element("TestReport.setup.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given TestReportSetup as BSON document with the structure of its FHIR JSON
+func (r TestReportSetup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReportSetup from a BSON document with the structure of its FHIR JSON
+func (r *TestReportSetup) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReportSetup as protobuf message TestReportSetup of fhir.proto
+func (r TestReportSetup) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReportSetup to the encoded message
+func (r TestReportSetup) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Action {
+		e.message(4, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestReportSetup from protobuf message TestReportSetup of fhir.proto
+func (r *TestReportSetup) UnmarshalProto(b []byte) error {
+	*r = TestReportSetup{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestReportSetupAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestReportSetupAction as JSON into a byte slice
+func (r TestReportSetupAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReportSetupAction as JSON object with the members in the order of the fields
+func (r TestReportSetupAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Operation != nil {
+		e.key("operation")
+		r.Operation.appendJSON(e)
+	}
+	if r.Assert != nil {
+		e.key("assert")
+		r.Assert.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReportSetupAction from JSON. Unknown members are ignored.
+func (r *TestReportSetupAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReportSetupAction
+func (r *TestReportSetupAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "operation":
+			if d.null() {
+				r.Operation = nil
+			} else {
+				var v TestReportSetupActionOperation
+				v.decodeJSON(d)
+				r.Operation = &v
+			}
+		case "assert":
+			if d.null() {
+				r.Assert = nil
+			} else {
+				var v TestReportSetupActionAssert
+				v.decodeJSON(d)
+				r.Assert = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReportSetupAction which shares no memory with the original
+func (r TestReportSetupAction) DeepCopy() TestReportSetupAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Operation != nil {
+		v := r.Operation.DeepCopy()
+		out.Operation = &v
+	}
+	if r.Assert != nil {
+		v := r.Assert.DeepCopy()
+		out.Assert = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReportSetupAction) Equal(other TestReportSetupAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.Equal(*other.Operation) {
+		return false
+	}
+	if (r.Assert == nil) != (other.Assert == nil) || r.Assert != nil && !r.Assert.Equal(*other.Assert) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReportSetupAction) EqualsDeep(other TestReportSetupAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.EqualsDeep(*other.Operation) {
+		return false
+	}
+	if (r.Assert == nil) != (other.Assert == nil) || r.Assert != nil && !r.Assert.EqualsDeep(*other.Assert) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReportSetupAction) EqualsShallow(other TestReportSetupAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReportSetupAction as FHIR XML
+func (r TestReportSetupAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "operation", r.Operation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "assert", r.Assert); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReportSetupAction from FHIR XML
+func (r *TestReportSetupAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "operation":
+				var v TestReportSetupActionOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operation = &v
+			case "assert":
+				var v TestReportSetupActionAssert
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Assert = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReportSetupAction as properties to the RDF node n
+func (r TestReportSetupAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("TestReport.setup.action.operation", -1, r.Operation)
+	n.element("TestReport.setup.action.assert", -1, r.Assert)
+}
+
+// MarshalBSON marshals the given TestReportSetupAction as BSON document with the structure of its FHIR JSON
+func (r TestReportSetupAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReportSetupAction from a BSON document with the structure of its FHIR JSON
+func (r *TestReportSetupAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReportSetupAction as protobuf message TestReportSetupAction of fhir.proto
+func (r TestReportSetupAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReportSetupAction to the encoded message
+func (r TestReportSetupAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Operation != nil {
+		e.message(4, *r.Operation)
+	}
+	if r.Assert != nil {
+		e.message(5, *r.Assert)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestReportSetupAction from protobuf message TestReportSetupAction of fhir.proto
+func (r *TestReportSetupAction) UnmarshalProto(b []byte) error {
+	*r = TestReportSetupAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestReportSetupActionOperation
+			d.message(&v)
+			r.Operation = &v
+		case 5:
+			var v TestReportSetupActionAssert
+			d.message(&v)
+			r.Assert = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestReportSetupActionOperation as JSON into a byte slice
+func (r TestReportSetupActionOperation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReportSetupActionOperation as JSON object with the members in the order of the fields
+func (r TestReportSetupActionOperation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("result")
+	e.string(r.Result.Code())
+	if r.ResultElement != nil {
+		e.key("_result")
+		r.ResultElement.appendJSON(e)
+	}
+	if r.Message != nil {
+		e.key("message")
+		e.string(*r.Message)
+	}
+	if r.MessageElement != nil {
+		e.key("_message")
+		r.MessageElement.appendJSON(e)
+	}
+	if r.Detail != nil {
+		e.key("detail")
+		e.string(*r.Detail)
+	}
+	if r.DetailElement != nil {
+		e.key("_detail")
+		r.DetailElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReportSetupActionOperation from JSON. Unknown members are ignored.
+func (r *TestReportSetupActionOperation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReportSetupActionOperation
+func (r *TestReportSetupActionOperation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "result":
+			d.unmarshal(&r.Result)
+		case "_result":
+			r.ResultElement = d.element()
+		case "message":
+			if v, ok := d.string(); ok {
+				r.Message = &v
+			} else {
+				r.Message = nil
+			}
+		case "_message":
+			r.MessageElement = d.element()
+		case "detail":
+			if v, ok := d.string(); ok {
+				r.Detail = &v
+			} else {
+				r.Detail = nil
+			}
+		case "_detail":
+			r.DetailElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReportSetupActionOperation which shares no memory with the original
+func (r TestReportSetupActionOperation) DeepCopy() TestReportSetupActionOperation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ResultElement != nil {
+		v := r.ResultElement.DeepCopy()
+		out.ResultElement = &v
+	}
+	if r.Message != nil {
+		v := *r.Message
+		out.Message = &v
+	}
+	if r.MessageElement != nil {
+		v := r.MessageElement.DeepCopy()
+		out.MessageElement = &v
+	}
+	if r.Detail != nil {
+		v := *r.Detail
+		out.Detail = &v
+	}
+	if r.DetailElement != nil {
+		v := r.DetailElement.DeepCopy()
+		out.DetailElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReportSetupActionOperation) Equal(other TestReportSetupActionOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Result != other.Result {
+		return false
+	}
+	if (r.ResultElement == nil) != (other.ResultElement == nil) || r.ResultElement != nil && !r.ResultElement.Equal(*other.ResultElement) {
+		return false
+	}
+	if (r.Message == nil) != (other.Message == nil) || r.Message != nil && *r.Message != *other.Message {
+		return false
+	}
+	if (r.MessageElement == nil) != (other.MessageElement == nil) || r.MessageElement != nil && !r.MessageElement.Equal(*other.MessageElement) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && *r.Detail != *other.Detail {
+		return false
+	}
+	if (r.DetailElement == nil) != (other.DetailElement == nil) || r.DetailElement != nil && !r.DetailElement.Equal(*other.DetailElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReportSetupActionOperation) EqualsDeep(other TestReportSetupActionOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Result != other.Result {
+		return false
+	}
+	if (r.ResultElement == nil) != (other.ResultElement == nil) || r.ResultElement != nil && !r.ResultElement.EqualsDeep(*other.ResultElement) {
+		return false
+	}
+	if (r.Message == nil) != (other.Message == nil) || r.Message != nil && !equivalentString(*r.Message, *other.Message) {
+		return false
+	}
+	if (r.MessageElement == nil) != (other.MessageElement == nil) || r.MessageElement != nil && !r.MessageElement.EqualsDeep(*other.MessageElement) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !equivalentString(*r.Detail, *other.Detail) {
+		return false
+	}
+	if (r.DetailElement == nil) != (other.DetailElement == nil) || r.DetailElement != nil && !r.DetailElement.EqualsDeep(*other.DetailElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReportSetupActionOperation) EqualsShallow(other TestReportSetupActionOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Result != other.Result {
+		return false
+	}
+	if (r.Message == nil) != (other.Message == nil) || r.Message != nil && !equivalentString(*r.Message, *other.Message) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !equivalentString(*r.Detail, *other.Detail) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReportSetupActionOperation as FHIR XML
+func (r TestReportSetupActionOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "result", r.Result, r.ResultElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "message", r.Message, r.MessageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "detail", r.Detail, r.DetailElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReportSetupActionOperation from FHIR XML
+func (r *TestReportSetupActionOperation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "result":
+				var v TestReportActionResult
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Result = v
+				}
+				r.ResultElement = element
+			case "message":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Message = &v
+				}
+				r.MessageElement = element
+			case "detail":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Detail = &v
+				}
+				r.DetailElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReportSetupActionOperation as properties to the RDF node n
+func (r TestReportSetupActionOperation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestReport.setup.action.operation.result", -1, r.Result, r.ResultElement, "code")
+	n.primitive("TestReport.setup.action.operation.message", -1, r.Message, r.MessageElement, "string")
+	n.primitive("TestReport.setup.action.operation.detail", -1, r.Detail, r.DetailElement, "string")
+}
+
+// MarshalBSON marshals the given TestReportSetupActionOperation as BSON document with the structure of its FHIR JSON
+func (r TestReportSetupActionOperation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReportSetupActionOperation from a BSON document with the structure of its FHIR JSON
+func (r *TestReportSetupActionOperation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReportSetupActionOperation as protobuf message TestReportSetupActionOperation of fhir.proto
+func (r TestReportSetupActionOperation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReportSetupActionOperation to the encoded message
+func (r TestReportSetupActionOperation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Result.protoNumber())
+	if r.Message != nil {
+		e.string(5, *r.Message)
+	}
+	if r.Detail != nil {
+		e.string(6, *r.Detail)
+	}
+	if r.ResultElement != nil {
+		e.message(7, *r.ResultElement)
+	}
+	if r.MessageElement != nil {
+		e.message(8, *r.MessageElement)
+	}
+	if r.DetailElement != nil {
+		e.message(9, *r.DetailElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestReportSetupActionOperation from protobuf message TestReportSetupActionOperation of fhir.proto
+func (r *TestReportSetupActionOperation) UnmarshalProto(b []byte) error {
+	*r = TestReportSetupActionOperation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestReportActionResult
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Result = v
+		case 5:
+			v := d.string()
+			r.Message = &v
+		case 6:
+			v := d.string()
+			r.Detail = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ResultElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.MessageElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DetailElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestReportSetupActionAssert as JSON into a byte slice
+func (r TestReportSetupActionAssert) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReportSetupActionAssert as JSON object with the members in the order of the fields
+func (r TestReportSetupActionAssert) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("result")
+	e.string(r.Result.Code())
+	if r.ResultElement != nil {
+		e.key("_result")
+		r.ResultElement.appendJSON(e)
+	}
+	if r.Message != nil {
+		e.key("message")
+		e.string(*r.Message)
+	}
+	if r.MessageElement != nil {
+		e.key("_message")
+		r.MessageElement.appendJSON(e)
+	}
+	if r.Detail != nil {
+		e.key("detail")
+		e.string(*r.Detail)
+	}
+	if r.DetailElement != nil {
+		e.key("_detail")
+		r.DetailElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReportSetupActionAssert from JSON. Unknown members are ignored.
+func (r *TestReportSetupActionAssert) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReportSetupActionAssert
+func (r *TestReportSetupActionAssert) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "result":
+			d.unmarshal(&r.Result)
+		case "_result":
+			r.ResultElement = d.element()
+		case "message":
+			if v, ok := d.string(); ok {
+				r.Message = &v
+			} else {
+				r.Message = nil
+			}
+		case "_message":
+			r.MessageElement = d.element()
+		case "detail":
+			if v, ok := d.string(); ok {
+				r.Detail = &v
+			} else {
+				r.Detail = nil
+			}
+		case "_detail":
+			r.DetailElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReportSetupActionAssert which shares no memory with the original
+func (r TestReportSetupActionAssert) DeepCopy() TestReportSetupActionAssert {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.ResultElement != nil {
+		v := r.ResultElement.DeepCopy()
+		out.ResultElement = &v
+	}
+	if r.Message != nil {
+		v := *r.Message
+		out.Message = &v
+	}
+	if r.MessageElement != nil {
+		v := r.MessageElement.DeepCopy()
+		out.MessageElement = &v
+	}
+	if r.Detail != nil {
+		v := *r.Detail
+		out.Detail = &v
+	}
+	if r.DetailElement != nil {
+		v := r.DetailElement.DeepCopy()
+		out.DetailElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReportSetupActionAssert) Equal(other TestReportSetupActionAssert) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Result != other.Result {
+		return false
+	}
+	if (r.ResultElement == nil) != (other.ResultElement == nil) || r.ResultElement != nil && !r.ResultElement.Equal(*other.ResultElement) {
+		return false
+	}
+	if (r.Message == nil) != (other.Message == nil) || r.Message != nil && *r.Message != *other.Message {
+		return false
+	}
+	if (r.MessageElement == nil) != (other.MessageElement == nil) || r.MessageElement != nil && !r.MessageElement.Equal(*other.MessageElement) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && *r.Detail != *other.Detail {
+		return false
+	}
+	if (r.DetailElement == nil) != (other.DetailElement == nil) || r.DetailElement != nil && !r.DetailElement.Equal(*other.DetailElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReportSetupActionAssert) EqualsDeep(other TestReportSetupActionAssert) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Result != other.Result {
+		return false
+	}
+	if (r.ResultElement == nil) != (other.ResultElement == nil) || r.ResultElement != nil && !r.ResultElement.EqualsDeep(*other.ResultElement) {
+		return false
+	}
+	if (r.Message == nil) != (other.Message == nil) || r.Message != nil && !equivalentString(*r.Message, *other.Message) {
+		return false
+	}
+	if (r.MessageElement == nil) != (other.MessageElement == nil) || r.MessageElement != nil && !r.MessageElement.EqualsDeep(*other.MessageElement) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !equivalentString(*r.Detail, *other.Detail) {
+		return false
+	}
+	if (r.DetailElement == nil) != (other.DetailElement == nil) || r.DetailElement != nil && !r.DetailElement.EqualsDeep(*other.DetailElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReportSetupActionAssert) EqualsShallow(other TestReportSetupActionAssert) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Result != other.Result {
+		return false
+	}
+	if (r.Message == nil) != (other.Message == nil) || r.Message != nil && !equivalentString(*r.Message, *other.Message) {
+		return false
+	}
+	if (r.Detail == nil) != (other.Detail == nil) || r.Detail != nil && !equivalentString(*r.Detail, *other.Detail) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReportSetupActionAssert as FHIR XML
+func (r TestReportSetupActionAssert) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "result", r.Result, r.ResultElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "message", r.Message, r.MessageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "detail", r.Detail, r.DetailElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReportSetupActionAssert from FHIR XML
+func (r *TestReportSetupActionAssert) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "result":
+				var v TestReportActionResult
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Result = v
+				}
+				r.ResultElement = element
+			case "message":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Message = &v
+				}
+				r.MessageElement = element
+			case "detail":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Detail = &v
+				}
+				r.DetailElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReportSetupActionAssert as properties to the RDF node n
+func (r TestReportSetupActionAssert) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestReport.setup.action.assert.result", -1, r.Result, r.ResultElement, "code")
+	n.primitive("TestReport.setup.action.assert.message", -1, r.Message, r.MessageElement, "string")
+	n.primitive("TestReport.setup.action.assert.detail", -1, r.Detail, r.DetailElement, "string")
+}
+
+// MarshalBSON marshals the given TestReportSetupActionAssert as BSON document with the structure of its FHIR JSON
+func (r TestReportSetupActionAssert) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReportSetupActionAssert from a BSON document with the structure of its FHIR JSON
+func (r *TestReportSetupActionAssert) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReportSetupActionAssert as protobuf message TestReportSetupActionAssert of fhir.proto
+func (r TestReportSetupActionAssert) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReportSetupActionAssert to the encoded message
+func (r TestReportSetupActionAssert) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.varint(4, r.Result.protoNumber())
+	if r.Message != nil {
+		e.string(5, *r.Message)
+	}
+	if r.Detail != nil {
+		e.string(6, *r.Detail)
+	}
+	if r.ResultElement != nil {
+		e.message(7, *r.ResultElement)
+	}
+	if r.MessageElement != nil {
+		e.message(8, *r.MessageElement)
+	}
+	if r.DetailElement != nil {
+		e.message(9, *r.DetailElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestReportSetupActionAssert from protobuf message TestReportSetupActionAssert of fhir.proto
+func (r *TestReportSetupActionAssert) UnmarshalProto(b []byte) error {
+	*r = TestReportSetupActionAssert{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestReportActionResult
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Result = v
+		case 5:
+			v := d.string()
+			r.Message = &v
+		case 6:
+			v := d.string()
+			r.Detail = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ResultElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.MessageElement = &v
+		case 9:
+			var v Element
+			d.message(&v)
+			r.DetailElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestReportTest as JSON into a byte slice
+func (r TestReportTest) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReportTest as JSON object with the members in the order of the fields
+func (r TestReportTest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.key("action")
+	if r.Action == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReportTest from JSON. Unknown members are ignored.
+func (r *TestReportTest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReportTest
+func (r *TestReportTest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v TestReportTestAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []TestReportTestAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReportTest which shares no memory with the original
+func (r TestReportTest) DeepCopy() TestReportTest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Action != nil {
+		out.Action = make([]TestReportTestAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReportTest) Equal(other TestReportTest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReportTest) EqualsDeep(other TestReportTest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReportTest) EqualsShallow(other TestReportTest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReportTest as FHIR XML
+func (r TestReportTest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReportTest from FHIR XML
+func (r *TestReportTest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "action":
+				var v TestReportTestAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReportTest as properties to the RDF node n
+func (r TestReportTest) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestReport.test.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("TestReport.test.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Action {
+		n.element("TestReport.test.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given TestReportTest as BSON document with the structure of its FHIR JSON
+func (r TestReportTest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReportTest from a BSON document with the structure of its FHIR JSON
+func (r *TestReportTest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReportTest as protobuf message TestReportTest of fhir.proto
+func (r TestReportTest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReportTest to the encoded message
+func (r TestReportTest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != nil {
+		e.string(4, *r.Name)
+	}
+	if r.Description != nil {
+		e.string(5, *r.Description)
+	}
+	for _, v := range r.Action {
+		e.message(6, v)
+	}
+	if r.NameElement != nil {
+		e.message(7, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(8, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestReportTest from protobuf message TestReportTest of fhir.proto
+func (r *TestReportTest) UnmarshalProto(b []byte) error {
+	*r = TestReportTest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Name = &v
+		case 5:
+			v := d.string()
+			r.Description = &v
+		case 6:
+			var v TestReportTestAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestReportTestAction as JSON into a byte slice
+func (r TestReportTestAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReportTestAction as JSON object with the members in the order of the fields
+func (r TestReportTestAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Operation != nil {
+		e.key("operation")
+		r.Operation.appendJSON(e)
+	}
+	if r.Assert != nil {
+		e.key("assert")
+		r.Assert.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReportTestAction from JSON. Unknown members are ignored.
+func (r *TestReportTestAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReportTestAction
+func (r *TestReportTestAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "operation":
+			if d.null() {
+				r.Operation = nil
+			} else {
+				var v TestReportSetupActionOperation
+				v.decodeJSON(d)
+				r.Operation = &v
+			}
+		case "assert":
+			if d.null() {
+				r.Assert = nil
+			} else {
+				var v TestReportSetupActionAssert
+				v.decodeJSON(d)
+				r.Assert = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReportTestAction which shares no memory with the original
+func (r TestReportTestAction) DeepCopy() TestReportTestAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Operation != nil {
+		v := r.Operation.DeepCopy()
+		out.Operation = &v
+	}
+	if r.Assert != nil {
+		v := r.Assert.DeepCopy()
+		out.Assert = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReportTestAction) Equal(other TestReportTestAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.Equal(*other.Operation) {
+		return false
+	}
+	if (r.Assert == nil) != (other.Assert == nil) || r.Assert != nil && !r.Assert.Equal(*other.Assert) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReportTestAction) EqualsDeep(other TestReportTestAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.EqualsDeep(*other.Operation) {
+		return false
+	}
+	if (r.Assert == nil) != (other.Assert == nil) || r.Assert != nil && !r.Assert.EqualsDeep(*other.Assert) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReportTestAction) EqualsShallow(other TestReportTestAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReportTestAction as FHIR XML
+func (r TestReportTestAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "operation", r.Operation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "assert", r.Assert); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReportTestAction from FHIR XML
+func (r *TestReportTestAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "operation":
+				var v TestReportSetupActionOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operation = &v
+			case "assert":
+				var v TestReportSetupActionAssert
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Assert = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReportTestAction as properties to the RDF node n
+func (r TestReportTestAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("TestReport.test.action.operation", -1, r.Operation)
+	n.element("TestReport.test.action.assert", -1, r.Assert)
+}
+
+// MarshalBSON marshals the given TestReportTestAction as BSON document with the structure of its FHIR JSON
+func (r TestReportTestAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReportTestAction from a BSON document with the structure of its FHIR JSON
+func (r *TestReportTestAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReportTestAction as protobuf message TestReportTestAction of fhir.proto
+func (r TestReportTestAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReportTestAction to the encoded message
+func (r TestReportTestAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Operation != nil {
+		e.message(4, *r.Operation)
+	}
+	if r.Assert != nil {
+		e.message(5, *r.Assert)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestReportTestAction from protobuf message TestReportTestAction of fhir.proto
+func (r *TestReportTestAction) UnmarshalProto(b []byte) error {
+	*r = TestReportTestAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestReportSetupActionOperation
+			d.message(&v)
+			r.Operation = &v
+		case 5:
+			var v TestReportSetupActionAssert
+			d.message(&v)
+			r.Assert = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestReportTeardown as JSON into a byte slice
+func (r TestReportTeardown) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReportTeardown as JSON object with the members in the order of the fields
+func (r TestReportTeardown) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("action")
+	if r.Action == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReportTeardown from JSON. Unknown members are ignored.
+func (r *TestReportTeardown) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReportTeardown
+func (r *TestReportTeardown) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v TestReportTeardownAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []TestReportTeardownAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReportTeardown which shares no memory with the original
+func (r TestReportTeardown) DeepCopy() TestReportTeardown {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		out.Action = make([]TestReportTeardownAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReportTeardown) Equal(other TestReportTeardown) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReportTeardown) EqualsDeep(other TestReportTeardown) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReportTeardown) EqualsShallow(other TestReportTeardown) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReportTeardown as FHIR XML
+func (r TestReportTeardown) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReportTeardown from FHIR XML
+func (r *TestReportTeardown) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "action":
+				var v TestReportTeardownAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReportTeardown as properties to the RDF node n
+func (r TestReportTeardown) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Action {
+		n.element("TestReport.teardown.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given TestReportTeardown as BSON document with the structure of its FHIR JSON
+func (r TestReportTeardown) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReportTeardown from a BSON document with the structure of its FHIR JSON
+func (r *TestReportTeardown) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReportTeardown as protobuf message TestReportTeardown of fhir.proto
+func (r TestReportTeardown) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReportTeardown to the encoded message
+func (r TestReportTeardown) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Action {
+		e.message(4, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestReportTeardown from protobuf message TestReportTeardown of fhir.proto
+func (r *TestReportTeardown) UnmarshalProto(b []byte) error {
+	*r = TestReportTeardown{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestReportTeardownAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestReportTeardownAction as JSON into a byte slice
+func (r TestReportTeardownAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestReportTeardownAction as JSON object with the members in the order of the fields
+func (r TestReportTeardownAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("operation")
+	r.Operation.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestReportTeardownAction from JSON. Unknown members are ignored.
+func (r *TestReportTeardownAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestReportTeardownAction
+func (r *TestReportTeardownAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "operation":
+			r.Operation.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestReportTeardownAction which shares no memory with the original
+func (r TestReportTeardownAction) DeepCopy() TestReportTeardownAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Operation = r.Operation.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestReportTeardownAction) Equal(other TestReportTeardownAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Operation.Equal(other.Operation) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestReportTeardownAction) EqualsDeep(other TestReportTeardownAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Operation.EqualsDeep(other.Operation) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestReportTeardownAction) EqualsShallow(other TestReportTeardownAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestReportTeardownAction as FHIR XML
+func (r TestReportTeardownAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "operation", r.Operation); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestReportTeardownAction from FHIR XML
+func (r *TestReportTeardownAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "operation":
+				var v TestReportSetupActionOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operation = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestReportTeardownAction as properties to the RDF node n
+func (r TestReportTeardownAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("TestReport.teardown.action.operation", -1, r.Operation)
+}
+
+// MarshalBSON marshals the given TestReportTeardownAction as BSON document with the structure of its FHIR JSON
+func (r TestReportTeardownAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestReportTeardownAction from a BSON document with the structure of its FHIR JSON
+func (r *TestReportTeardownAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestReportTeardownAction as protobuf message TestReportTeardownAction of fhir.proto
+func (r TestReportTeardownAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestReportTeardownAction to the encoded message
+func (r TestReportTeardownAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Operation)
+}
+
+// UnmarshalProto unmarshals the given TestReportTeardownAction from protobuf message TestReportTeardownAction of fhir.proto
+func (r *TestReportTeardownAction) UnmarshalProto(b []byte) error {
+	*r = TestReportTeardownAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestReportSetupActionOperation
+			d.message(&v)
+			r.Operation = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalTestReport unmarshals a TestReport.
 func UnmarshalTestReport(b []byte) (TestReport, error) {
 	var testReport TestReport
-	if err := json.Unmarshal(b, &testReport); err != nil {
+	if err := testReport.UnmarshalJSON(b); err != nil {
 		return testReport, err
 	}
 	return testReport, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code TestReportActionResult) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *TestReportActionResult) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *TestReportActionResult) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "pass":
 		*code = TestReportActionResultPass
@@ -55,6 +59,12 @@ func (code *TestReportActionResult) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code TestReportActionResult) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TestReportActionResult) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code TestReportActionResult) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code TestReportActionResult) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code TestReportActionResult) protoNumber() uint64 {
+	switch code {
+	case TestReportActionResultPass:
+		return 1
+	case TestReportActionResultSkip:
+		return 2
+	case TestReportActionResultFail:
+		return 3
+	case TestReportActionResultWarning:
+		return 4
+	case TestReportActionResultError:
+		return 5
+	}
+	return 0
+}
+func (code *TestReportActionResult) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TestReportActionResultPass
+	case 2:
+		*code = TestReportActionResultSkip
+	case 3:
+		*code = TestReportActionResultFail
+	case 4:
+		*code = TestReportActionResultWarning
+	case 5:
+		*code = TestReportActionResultError
+	default:
+		return false
+	}
+	return true
+}
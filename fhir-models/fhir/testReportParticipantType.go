@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code TestReportParticipantType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *TestReportParticipantType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *TestReportParticipantType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "test-engine":
 		*code = TestReportParticipantTypeTestEngine
@@ -49,6 +53,12 @@ func (code *TestReportParticipantType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code TestReportParticipantType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TestReportParticipantType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code TestReportParticipantType) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code TestReportParticipantType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code TestReportParticipantType) protoNumber() uint64 {
+	switch code {
+	case TestReportParticipantTypeTestEngine:
+		return 1
+	case TestReportParticipantTypeClient:
+		return 2
+	case TestReportParticipantTypeServer:
+		return 3
+	}
+	return 0
+}
+func (code *TestReportParticipantType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TestReportParticipantTypeTestEngine
+	case 2:
+		*code = TestReportParticipantTypeClient
+	case 3:
+		*code = TestReportParticipantTypeServer
+	default:
+		return false
+	}
+	return true
+}
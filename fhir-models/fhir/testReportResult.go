@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code TestReportResult) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *TestReportResult) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *TestReportResult) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "pass":
 		*code = TestReportResultPass
@@ -49,6 +53,12 @@ func (code *TestReportResult) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code TestReportResult) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TestReportResult) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code TestReportResult) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code TestReportResult) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code TestReportResult) protoNumber() uint64 {
+	switch code {
+	case TestReportResultPass:
+		return 1
+	case TestReportResultFail:
+		return 2
+	case TestReportResultPending:
+		return 3
+	}
+	return 0
+}
+func (code *TestReportResult) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TestReportResultPass
+	case 2:
+		*code = TestReportResultFail
+	case 3:
+		*code = TestReportResultPending
+	default:
+		return false
+	}
+	return true
+}
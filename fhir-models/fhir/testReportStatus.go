@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code TestReportStatus) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *TestReportStatus) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *TestReportStatus) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "completed":
 		*code = TestReportStatusCompleted
@@ -55,6 +59,12 @@ func (code *TestReportStatus) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code TestReportStatus) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TestReportStatus) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code TestReportStatus) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code TestReportStatus) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code TestReportStatus) protoNumber() uint64 {
+	switch code {
+	case TestReportStatusCompleted:
+		return 1
+	case TestReportStatusInProgress:
+		return 2
+	case TestReportStatusWaiting:
+		return 3
+	case TestReportStatusStopped:
+		return 4
+	case TestReportStatusEnteredInError:
+		return 5
+	}
+	return 0
+}
+func (code *TestReportStatus) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TestReportStatusCompleted
+	case 2:
+		*code = TestReportStatusInProgress
+	case 3:
+		*code = TestReportStatusWaiting
+	case 4:
+		*code = TestReportStatusStopped
+	case 5:
+		*code = TestReportStatusEnteredInError
+	default:
+		return false
+	}
+	return true
+}
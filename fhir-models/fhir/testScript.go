@@ -14,50 +14,69 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // TestScript is documented here http://hl7.org/fhir/StructureDefinition/TestScript
 type TestScript struct {
-	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                 `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               string                  `bson:"url" json:"url"`
-	Identifier        *Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version           *string                 `bson:"version,omitempty" json:"version,omitempty"`
-	Name              string                  `bson:"name" json:"name"`
-	Title             *string                 `bson:"title,omitempty" json:"title,omitempty"`
-	Status            PublicationStatus       `bson:"status" json:"status"`
-	Experimental      *bool                   `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Date              *string                 `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher         *string                 `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact           []ContactDetail         `bson:"contact,omitempty" json:"contact,omitempty"`
-	Description       *string                 `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext        []UsageContext          `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Jurisdiction      []CodeableConcept       `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Purpose           *string                 `bson:"purpose,omitempty" json:"purpose,omitempty"`
-	Copyright         *string                 `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Origin            []TestScriptOrigin      `bson:"origin,omitempty" json:"origin,omitempty"`
-	Destination       []TestScriptDestination `bson:"destination,omitempty" json:"destination,omitempty"`
-	Metadata          *TestScriptMetadata     `bson:"metadata,omitempty" json:"metadata,omitempty"`
-	Fixture           []TestScriptFixture     `bson:"fixture,omitempty" json:"fixture,omitempty"`
-	Profile           []Reference             `bson:"profile,omitempty" json:"profile,omitempty"`
-	Variable          []TestScriptVariable    `bson:"variable,omitempty" json:"variable,omitempty"`
-	Setup             *TestScriptSetup        `bson:"setup,omitempty" json:"setup,omitempty"`
-	Test              []TestScriptTest        `bson:"test,omitempty" json:"test,omitempty"`
-	Teardown          *TestScriptTeardown     `bson:"teardown,omitempty" json:"teardown,omitempty"`
+	Id                   *string                 `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                 `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage       `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                  string                  `bson:"url" json:"url"`
+	UrlElement           *Element                `bson:"_url,omitempty" json:"_url,omitempty"`
+	Identifier           *Identifier             `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version              *string                 `bson:"version,omitempty" json:"version,omitempty"`
+	VersionElement       *Element                `bson:"_version,omitempty" json:"_version,omitempty"`
+	Name                 string                  `bson:"name" json:"name"`
+	NameElement          *Element                `bson:"_name,omitempty" json:"_name,omitempty"`
+	Title                *string                 `bson:"title,omitempty" json:"title,omitempty"`
+	TitleElement         *Element                `bson:"_title,omitempty" json:"_title,omitempty"`
+	Status               PublicationStatus       `bson:"status" json:"status"`
+	StatusElement        *Element                `bson:"_status,omitempty" json:"_status,omitempty"`
+	Experimental         *bool                   `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	ExperimentalElement  *Element                `bson:"_experimental,omitempty" json:"_experimental,omitempty"`
+	Date                 *string                 `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement          *Element                `bson:"_date,omitempty" json:"_date,omitempty"`
+	Publisher            *string                 `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	PublisherElement     *Element                `bson:"_publisher,omitempty" json:"_publisher,omitempty"`
+	Contact              []ContactDetail         `bson:"contact,omitempty" json:"contact,omitempty"`
+	Description          *string                 `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement   *Element                `bson:"_description,omitempty" json:"_description,omitempty"`
+	UseContext           []UsageContext          `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Jurisdiction         []CodeableConcept       `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Purpose              *string                 `bson:"purpose,omitempty" json:"purpose,omitempty"`
+	PurposeElement       *Element                `bson:"_purpose,omitempty" json:"_purpose,omitempty"`
+	Copyright            *string                 `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	CopyrightElement     *Element                `bson:"_copyright,omitempty" json:"_copyright,omitempty"`
+	Origin               []TestScriptOrigin      `bson:"origin,omitempty" json:"origin,omitempty"`
+	Destination          []TestScriptDestination `bson:"destination,omitempty" json:"destination,omitempty"`
+	Metadata             *TestScriptMetadata     `bson:"metadata,omitempty" json:"metadata,omitempty"`
+	Fixture              []TestScriptFixture     `bson:"fixture,omitempty" json:"fixture,omitempty"`
+	Profile              []Reference             `bson:"profile,omitempty" json:"profile,omitempty"`
+	Variable             []TestScriptVariable    `bson:"variable,omitempty" json:"variable,omitempty"`
+	Setup                *TestScriptSetup        `bson:"setup,omitempty" json:"setup,omitempty"`
+	Test                 []TestScriptTest        `bson:"test,omitempty" json:"test,omitempty"`
+	Teardown             *TestScriptTeardown     `bson:"teardown,omitempty" json:"teardown,omitempty"`
 }
 type TestScriptOrigin struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Index             int         `bson:"index" json:"index"`
+	IndexElement      *Element    `bson:"_index,omitempty" json:"_index,omitempty"`
 	Profile           Coding      `bson:"profile" json:"profile"`
 }
 type TestScriptDestination struct {
@@ -65,6 +84,7 @@ type TestScriptDestination struct {
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Index             int         `bson:"index" json:"index"`
+	IndexElement      *Element    `bson:"_index,omitempty" json:"_index,omitempty"`
 	Profile           Coding      `bson:"profile" json:"profile"`
 }
 type TestScriptMetadata struct {
@@ -75,44 +95,63 @@ type TestScriptMetadata struct {
 	Capability        []TestScriptMetadataCapability `bson:"capability" json:"capability"`
 }
 type TestScriptMetadataLink struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Url               string      `bson:"url" json:"url"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
+	Id                 *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url                string      `bson:"url" json:"url"`
+	UrlElement         *Element    `bson:"_url,omitempty" json:"_url,omitempty"`
+	Description        *string     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
 }
 type TestScriptMetadataCapability struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Required          bool        `bson:"required" json:"required"`
-	Validated         bool        `bson:"validated" json:"validated"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
-	Origin            []int       `bson:"origin,omitempty" json:"origin,omitempty"`
-	Destination       *int        `bson:"destination,omitempty" json:"destination,omitempty"`
-	Link              []string    `bson:"link,omitempty" json:"link,omitempty"`
-	Capabilities      string      `bson:"capabilities" json:"capabilities"`
+	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Required            bool        `bson:"required" json:"required"`
+	RequiredElement     *Element    `bson:"_required,omitempty" json:"_required,omitempty"`
+	Validated           bool        `bson:"validated" json:"validated"`
+	ValidatedElement    *Element    `bson:"_validated,omitempty" json:"_validated,omitempty"`
+	Description         *string     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Origin              []int       `bson:"origin,omitempty" json:"origin,omitempty"`
+	OriginElement       []*Element  `bson:"_origin,omitempty" json:"_origin,omitempty"`
+	Destination         *int        `bson:"destination,omitempty" json:"destination,omitempty"`
+	DestinationElement  *Element    `bson:"_destination,omitempty" json:"_destination,omitempty"`
+	Link                []string    `bson:"link,omitempty" json:"link,omitempty"`
+	LinkElement         []*Element  `bson:"_link,omitempty" json:"_link,omitempty"`
+	Capabilities        string      `bson:"capabilities" json:"capabilities"`
+	CapabilitiesElement *Element    `bson:"_capabilities,omitempty" json:"_capabilities,omitempty"`
 }
 type TestScriptFixture struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Autocreate        bool        `bson:"autocreate" json:"autocreate"`
+	AutocreateElement *Element    `bson:"_autocreate,omitempty" json:"_autocreate,omitempty"`
 	Autodelete        bool        `bson:"autodelete" json:"autodelete"`
+	AutodeleteElement *Element    `bson:"_autodelete,omitempty" json:"_autodelete,omitempty"`
 	Resource          *Reference  `bson:"resource,omitempty" json:"resource,omitempty"`
 }
 type TestScriptVariable struct {
-	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              string      `bson:"name" json:"name"`
-	DefaultValue      *string     `bson:"defaultValue,omitempty" json:"defaultValue,omitempty"`
-	Description       *string     `bson:"description,omitempty" json:"description,omitempty"`
-	Expression        *string     `bson:"expression,omitempty" json:"expression,omitempty"`
-	HeaderField       *string     `bson:"headerField,omitempty" json:"headerField,omitempty"`
-	Hint              *string     `bson:"hint,omitempty" json:"hint,omitempty"`
-	Path              *string     `bson:"path,omitempty" json:"path,omitempty"`
-	SourceId          *string     `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	Id                  *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension           []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name                string      `bson:"name" json:"name"`
+	NameElement         *Element    `bson:"_name,omitempty" json:"_name,omitempty"`
+	DefaultValue        *string     `bson:"defaultValue,omitempty" json:"defaultValue,omitempty"`
+	DefaultValueElement *Element    `bson:"_defaultValue,omitempty" json:"_defaultValue,omitempty"`
+	Description         *string     `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement  *Element    `bson:"_description,omitempty" json:"_description,omitempty"`
+	Expression          *string     `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement   *Element    `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	HeaderField         *string     `bson:"headerField,omitempty" json:"headerField,omitempty"`
+	HeaderFieldElement  *Element    `bson:"_headerField,omitempty" json:"_headerField,omitempty"`
+	Hint                *string     `bson:"hint,omitempty" json:"hint,omitempty"`
+	HintElement         *Element    `bson:"_hint,omitempty" json:"_hint,omitempty"`
+	Path                *string     `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement         *Element    `bson:"_path,omitempty" json:"_path,omitempty"`
+	SourceId            *string     `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	SourceIdElement     *Element    `bson:"_sourceId,omitempty" json:"_sourceId,omitempty"`
 }
 type TestScriptSetup struct {
 	Id                *string                 `bson:"id,omitempty" json:"id,omitempty"`
@@ -128,68 +167,109 @@ type TestScriptSetupAction struct {
 	Assert            *TestScriptSetupActionAssert    `bson:"assert,omitempty" json:"assert,omitempty"`
 }
 type TestScriptSetupActionOperation struct {
-	Id                *string                                       `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Type              *Coding                                       `bson:"type,omitempty" json:"type,omitempty"`
-	Resource          *string                                       `bson:"resource,omitempty" json:"resource,omitempty"`
-	Label             *string                                       `bson:"label,omitempty" json:"label,omitempty"`
-	Description       *string                                       `bson:"description,omitempty" json:"description,omitempty"`
-	Accept            *string                                       `bson:"accept,omitempty" json:"accept,omitempty"`
-	ContentType       *string                                       `bson:"contentType,omitempty" json:"contentType,omitempty"`
-	Destination       *int                                          `bson:"destination,omitempty" json:"destination,omitempty"`
-	EncodeRequestUrl  bool                                          `bson:"encodeRequestUrl" json:"encodeRequestUrl"`
-	Method            *TestScriptRequestMethodCode                  `bson:"method,omitempty" json:"method,omitempty"`
-	Origin            *int                                          `bson:"origin,omitempty" json:"origin,omitempty"`
-	Params            *string                                       `bson:"params,omitempty" json:"params,omitempty"`
-	RequestHeader     []TestScriptSetupActionOperationRequestHeader `bson:"requestHeader,omitempty" json:"requestHeader,omitempty"`
-	RequestId         *string                                       `bson:"requestId,omitempty" json:"requestId,omitempty"`
-	ResponseId        *string                                       `bson:"responseId,omitempty" json:"responseId,omitempty"`
-	SourceId          *string                                       `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
-	TargetId          *string                                       `bson:"targetId,omitempty" json:"targetId,omitempty"`
-	Url               *string                                       `bson:"url,omitempty" json:"url,omitempty"`
+	Id                      *string                                       `bson:"id,omitempty" json:"id,omitempty"`
+	Extension               []Extension                                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension                                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type                    *Coding                                       `bson:"type,omitempty" json:"type,omitempty"`
+	Resource                *string                                       `bson:"resource,omitempty" json:"resource,omitempty"`
+	ResourceElement         *Element                                      `bson:"_resource,omitempty" json:"_resource,omitempty"`
+	Label                   *string                                       `bson:"label,omitempty" json:"label,omitempty"`
+	LabelElement            *Element                                      `bson:"_label,omitempty" json:"_label,omitempty"`
+	Description             *string                                       `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement      *Element                                      `bson:"_description,omitempty" json:"_description,omitempty"`
+	Accept                  *string                                       `bson:"accept,omitempty" json:"accept,omitempty"`
+	AcceptElement           *Element                                      `bson:"_accept,omitempty" json:"_accept,omitempty"`
+	ContentType             *string                                       `bson:"contentType,omitempty" json:"contentType,omitempty"`
+	ContentTypeElement      *Element                                      `bson:"_contentType,omitempty" json:"_contentType,omitempty"`
+	Destination             *int                                          `bson:"destination,omitempty" json:"destination,omitempty"`
+	DestinationElement      *Element                                      `bson:"_destination,omitempty" json:"_destination,omitempty"`
+	EncodeRequestUrl        bool                                          `bson:"encodeRequestUrl" json:"encodeRequestUrl"`
+	EncodeRequestUrlElement *Element                                      `bson:"_encodeRequestUrl,omitempty" json:"_encodeRequestUrl,omitempty"`
+	Method                  *TestScriptRequestMethodCode                  `bson:"method,omitempty" json:"method,omitempty"`
+	MethodElement           *Element                                      `bson:"_method,omitempty" json:"_method,omitempty"`
+	Origin                  *int                                          `bson:"origin,omitempty" json:"origin,omitempty"`
+	OriginElement           *Element                                      `bson:"_origin,omitempty" json:"_origin,omitempty"`
+	Params                  *string                                       `bson:"params,omitempty" json:"params,omitempty"`
+	ParamsElement           *Element                                      `bson:"_params,omitempty" json:"_params,omitempty"`
+	RequestHeader           []TestScriptSetupActionOperationRequestHeader `bson:"requestHeader,omitempty" json:"requestHeader,omitempty"`
+	RequestId               *string                                       `bson:"requestId,omitempty" json:"requestId,omitempty"`
+	RequestIdElement        *Element                                      `bson:"_requestId,omitempty" json:"_requestId,omitempty"`
+	ResponseId              *string                                       `bson:"responseId,omitempty" json:"responseId,omitempty"`
+	ResponseIdElement       *Element                                      `bson:"_responseId,omitempty" json:"_responseId,omitempty"`
+	SourceId                *string                                       `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	SourceIdElement         *Element                                      `bson:"_sourceId,omitempty" json:"_sourceId,omitempty"`
+	TargetId                *string                                       `bson:"targetId,omitempty" json:"targetId,omitempty"`
+	TargetIdElement         *Element                                      `bson:"_targetId,omitempty" json:"_targetId,omitempty"`
+	Url                     *string                                       `bson:"url,omitempty" json:"url,omitempty"`
+	UrlElement              *Element                                      `bson:"_url,omitempty" json:"_url,omitempty"`
 }
 type TestScriptSetupActionOperationRequestHeader struct {
 	Id                *string     `bson:"id,omitempty" json:"id,omitempty"`
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Field             string      `bson:"field" json:"field"`
+	FieldElement      *Element    `bson:"_field,omitempty" json:"_field,omitempty"`
 	Value             string      `bson:"value" json:"value"`
+	ValueElement      *Element    `bson:"_value,omitempty" json:"_value,omitempty"`
 }
 type TestScriptSetupActionAssert struct {
-	Id                        *string                      `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                 []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension         []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Label                     *string                      `bson:"label,omitempty" json:"label,omitempty"`
-	Description               *string                      `bson:"description,omitempty" json:"description,omitempty"`
-	Direction                 *AssertionDirectionType      `bson:"direction,omitempty" json:"direction,omitempty"`
-	CompareToSourceId         *string                      `bson:"compareToSourceId,omitempty" json:"compareToSourceId,omitempty"`
-	CompareToSourceExpression *string                      `bson:"compareToSourceExpression,omitempty" json:"compareToSourceExpression,omitempty"`
-	CompareToSourcePath       *string                      `bson:"compareToSourcePath,omitempty" json:"compareToSourcePath,omitempty"`
-	ContentType               *string                      `bson:"contentType,omitempty" json:"contentType,omitempty"`
-	Expression                *string                      `bson:"expression,omitempty" json:"expression,omitempty"`
-	HeaderField               *string                      `bson:"headerField,omitempty" json:"headerField,omitempty"`
-	MinimumId                 *string                      `bson:"minimumId,omitempty" json:"minimumId,omitempty"`
-	NavigationLinks           *bool                        `bson:"navigationLinks,omitempty" json:"navigationLinks,omitempty"`
-	Operator                  *AssertionOperatorType       `bson:"operator,omitempty" json:"operator,omitempty"`
-	Path                      *string                      `bson:"path,omitempty" json:"path,omitempty"`
-	RequestMethod             *TestScriptRequestMethodCode `bson:"requestMethod,omitempty" json:"requestMethod,omitempty"`
-	RequestURL                *string                      `bson:"requestURL,omitempty" json:"requestURL,omitempty"`
-	Resource                  *string                      `bson:"resource,omitempty" json:"resource,omitempty"`
-	Response                  *AssertionResponseTypes      `bson:"response,omitempty" json:"response,omitempty"`
-	ResponseCode              *string                      `bson:"responseCode,omitempty" json:"responseCode,omitempty"`
-	SourceId                  *string                      `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
-	ValidateProfileId         *string                      `bson:"validateProfileId,omitempty" json:"validateProfileId,omitempty"`
-	Value                     *string                      `bson:"value,omitempty" json:"value,omitempty"`
-	WarningOnly               bool                         `bson:"warningOnly" json:"warningOnly"`
+	Id                               *string                      `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                        []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Label                            *string                      `bson:"label,omitempty" json:"label,omitempty"`
+	LabelElement                     *Element                     `bson:"_label,omitempty" json:"_label,omitempty"`
+	Description                      *string                      `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement               *Element                     `bson:"_description,omitempty" json:"_description,omitempty"`
+	Direction                        *AssertionDirectionType      `bson:"direction,omitempty" json:"direction,omitempty"`
+	DirectionElement                 *Element                     `bson:"_direction,omitempty" json:"_direction,omitempty"`
+	CompareToSourceId                *string                      `bson:"compareToSourceId,omitempty" json:"compareToSourceId,omitempty"`
+	CompareToSourceIdElement         *Element                     `bson:"_compareToSourceId,omitempty" json:"_compareToSourceId,omitempty"`
+	CompareToSourceExpression        *string                      `bson:"compareToSourceExpression,omitempty" json:"compareToSourceExpression,omitempty"`
+	CompareToSourceExpressionElement *Element                     `bson:"_compareToSourceExpression,omitempty" json:"_compareToSourceExpression,omitempty"`
+	CompareToSourcePath              *string                      `bson:"compareToSourcePath,omitempty" json:"compareToSourcePath,omitempty"`
+	CompareToSourcePathElement       *Element                     `bson:"_compareToSourcePath,omitempty" json:"_compareToSourcePath,omitempty"`
+	ContentType                      *string                      `bson:"contentType,omitempty" json:"contentType,omitempty"`
+	ContentTypeElement               *Element                     `bson:"_contentType,omitempty" json:"_contentType,omitempty"`
+	Expression                       *string                      `bson:"expression,omitempty" json:"expression,omitempty"`
+	ExpressionElement                *Element                     `bson:"_expression,omitempty" json:"_expression,omitempty"`
+	HeaderField                      *string                      `bson:"headerField,omitempty" json:"headerField,omitempty"`
+	HeaderFieldElement               *Element                     `bson:"_headerField,omitempty" json:"_headerField,omitempty"`
+	MinimumId                        *string                      `bson:"minimumId,omitempty" json:"minimumId,omitempty"`
+	MinimumIdElement                 *Element                     `bson:"_minimumId,omitempty" json:"_minimumId,omitempty"`
+	NavigationLinks                  *bool                        `bson:"navigationLinks,omitempty" json:"navigationLinks,omitempty"`
+	NavigationLinksElement           *Element                     `bson:"_navigationLinks,omitempty" json:"_navigationLinks,omitempty"`
+	Operator                         *AssertionOperatorType       `bson:"operator,omitempty" json:"operator,omitempty"`
+	OperatorElement                  *Element                     `bson:"_operator,omitempty" json:"_operator,omitempty"`
+	Path                             *string                      `bson:"path,omitempty" json:"path,omitempty"`
+	PathElement                      *Element                     `bson:"_path,omitempty" json:"_path,omitempty"`
+	RequestMethod                    *TestScriptRequestMethodCode `bson:"requestMethod,omitempty" json:"requestMethod,omitempty"`
+	RequestMethodElement             *Element                     `bson:"_requestMethod,omitempty" json:"_requestMethod,omitempty"`
+	RequestURL                       *string                      `bson:"requestURL,omitempty" json:"requestURL,omitempty"`
+	RequestURLElement                *Element                     `bson:"_requestURL,omitempty" json:"_requestURL,omitempty"`
+	Resource                         *string                      `bson:"resource,omitempty" json:"resource,omitempty"`
+	ResourceElement                  *Element                     `bson:"_resource,omitempty" json:"_resource,omitempty"`
+	Response                         *AssertionResponseTypes      `bson:"response,omitempty" json:"response,omitempty"`
+	ResponseElement                  *Element                     `bson:"_response,omitempty" json:"_response,omitempty"`
+	ResponseCode                     *string                      `bson:"responseCode,omitempty" json:"responseCode,omitempty"`
+	ResponseCodeElement              *Element                     `bson:"_responseCode,omitempty" json:"_responseCode,omitempty"`
+	SourceId                         *string                      `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	SourceIdElement                  *Element                     `bson:"_sourceId,omitempty" json:"_sourceId,omitempty"`
+	ValidateProfileId                *string                      `bson:"validateProfileId,omitempty" json:"validateProfileId,omitempty"`
+	ValidateProfileIdElement         *Element                     `bson:"_validateProfileId,omitempty" json:"_validateProfileId,omitempty"`
+	Value                            *string                      `bson:"value,omitempty" json:"value,omitempty"`
+	ValueElement                     *Element                     `bson:"_value,omitempty" json:"_value,omitempty"`
+	WarningOnly                      bool                         `bson:"warningOnly" json:"warningOnly"`
+	WarningOnlyElement               *Element                     `bson:"_warningOnly,omitempty" json:"_warningOnly,omitempty"`
 }
 type TestScriptTest struct {
-	Id                *string                `bson:"id,omitempty" json:"id,omitempty"`
-	Extension         []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Name              *string                `bson:"name,omitempty" json:"name,omitempty"`
-	Description       *string                `bson:"description,omitempty" json:"description,omitempty"`
-	Action            []TestScriptTestAction `bson:"action" json:"action"`
+	Id                 *string                `bson:"id,omitempty" json:"id,omitempty"`
+	Extension          []Extension            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension  []Extension            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name               *string                `bson:"name,omitempty" json:"name,omitempty"`
+	NameElement        *Element               `bson:"_name,omitempty" json:"_name,omitempty"`
+	Description        *string                `bson:"description,omitempty" json:"description,omitempty"`
+	DescriptionElement *Element               `bson:"_description,omitempty" json:"_description,omitempty"`
+	Action             []TestScriptTestAction `bson:"action" json:"action"`
 }
 type TestScriptTestAction struct {
 	Id                *string                         `bson:"id,omitempty" json:"id,omitempty"`
@@ -210,23 +290,11276 @@ type TestScriptTeardownAction struct {
 	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Operation         TestScriptSetupActionOperation `bson:"operation,omitempty" json:"operation,omitempty"`
 }
-type OtherTestScript TestScript
 
 // MarshalJSON marshals the given TestScript as JSON into a byte slice
 func (r TestScript) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherTestScript
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherTestScript: OtherTestScript(r),
-		ResourceType:    "TestScript",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScript as JSON object with the members in the order of the fields
+func (r TestScript) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"TestScript\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Identifier != nil {
+		e.key("identifier")
+		r.Identifier.appendJSON(e)
+	}
+	if r.Version != nil {
+		e.key("version")
+		e.string(*r.Version)
+	}
+	if r.VersionElement != nil {
+		e.key("_version")
+		r.VersionElement.appendJSON(e)
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Title != nil {
+		e.key("title")
+		e.string(*r.Title)
+	}
+	if r.TitleElement != nil {
+		e.key("_title")
+		r.TitleElement.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.Experimental != nil {
+		e.key("experimental")
+		e.bool(*r.Experimental)
+	}
+	if r.ExperimentalElement != nil {
+		e.key("_experimental")
+		r.ExperimentalElement.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.Publisher != nil {
+		e.key("publisher")
+		e.string(*r.Publisher)
+	}
+	if r.PublisherElement != nil {
+		e.key("_publisher")
+		r.PublisherElement.appendJSON(e)
+	}
+	if len(r.Contact) > 0 {
+		e.key("contact")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contact {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.UseContext) > 0 {
+		e.key("useContext")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.UseContext {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Jurisdiction) > 0 {
+		e.key("jurisdiction")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Jurisdiction {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Purpose != nil {
+		e.key("purpose")
+		e.string(*r.Purpose)
+	}
+	if r.PurposeElement != nil {
+		e.key("_purpose")
+		r.PurposeElement.appendJSON(e)
+	}
+	if r.Copyright != nil {
+		e.key("copyright")
+		e.string(*r.Copyright)
+	}
+	if r.CopyrightElement != nil {
+		e.key("_copyright")
+		r.CopyrightElement.appendJSON(e)
+	}
+	if len(r.Origin) > 0 {
+		e.key("origin")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Origin {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Destination) > 0 {
+		e.key("destination")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Destination {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Metadata != nil {
+		e.key("metadata")
+		r.Metadata.appendJSON(e)
+	}
+	if len(r.Fixture) > 0 {
+		e.key("fixture")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Fixture {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Profile) > 0 {
+		e.key("profile")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Profile {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Variable) > 0 {
+		e.key("variable")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Variable {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Setup != nil {
+		e.key("setup")
+		r.Setup.appendJSON(e)
+	}
+	if len(r.Test) > 0 {
+		e.key("test")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Test {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Teardown != nil {
+		e.key("teardown")
+		r.Teardown.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScript from JSON. Unknown members are ignored.
+func (r *TestScript) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScript
+func (r *TestScript) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "identifier":
+			if d.null() {
+				r.Identifier = nil
+			} else {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = &v
+			}
+		case "version":
+			if v, ok := d.string(); ok {
+				r.Version = &v
+			} else {
+				r.Version = nil
+			}
+		case "_version":
+			r.VersionElement = d.element()
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "title":
+			if v, ok := d.string(); ok {
+				r.Title = &v
+			} else {
+				r.Title = nil
+			}
+		case "_title":
+			r.TitleElement = d.element()
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "experimental":
+			if v, ok := d.bool(); ok {
+				r.Experimental = &v
+			} else {
+				r.Experimental = nil
+			}
+		case "_experimental":
+			r.ExperimentalElement = d.element()
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "publisher":
+			if v, ok := d.string(); ok {
+				r.Publisher = &v
+			} else {
+				r.Publisher = nil
+			}
+		case "_publisher":
+			r.PublisherElement = d.element()
+		case "contact":
+			a := d.array()
+			r.Contact = nil
+			for a.next() {
+				var v ContactDetail
+				v.decodeJSON(d)
+				r.Contact = append(r.Contact, v)
+			}
+			if r.Contact == nil && !a.isNull {
+				r.Contact = []ContactDetail{}
+			}
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "useContext":
+			a := d.array()
+			r.UseContext = nil
+			for a.next() {
+				var v UsageContext
+				v.decodeJSON(d)
+				r.UseContext = append(r.UseContext, v)
+			}
+			if r.UseContext == nil && !a.isNull {
+				r.UseContext = []UsageContext{}
+			}
+		case "jurisdiction":
+			a := d.array()
+			r.Jurisdiction = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			}
+			if r.Jurisdiction == nil && !a.isNull {
+				r.Jurisdiction = []CodeableConcept{}
+			}
+		case "purpose":
+			if v, ok := d.string(); ok {
+				r.Purpose = &v
+			} else {
+				r.Purpose = nil
+			}
+		case "_purpose":
+			r.PurposeElement = d.element()
+		case "copyright":
+			if v, ok := d.string(); ok {
+				r.Copyright = &v
+			} else {
+				r.Copyright = nil
+			}
+		case "_copyright":
+			r.CopyrightElement = d.element()
+		case "origin":
+			a := d.array()
+			r.Origin = nil
+			for a.next() {
+				var v TestScriptOrigin
+				v.decodeJSON(d)
+				r.Origin = append(r.Origin, v)
+			}
+			if r.Origin == nil && !a.isNull {
+				r.Origin = []TestScriptOrigin{}
+			}
+		case "destination":
+			a := d.array()
+			r.Destination = nil
+			for a.next() {
+				var v TestScriptDestination
+				v.decodeJSON(d)
+				r.Destination = append(r.Destination, v)
+			}
+			if r.Destination == nil && !a.isNull {
+				r.Destination = []TestScriptDestination{}
+			}
+		case "metadata":
+			if d.null() {
+				r.Metadata = nil
+			} else {
+				var v TestScriptMetadata
+				v.decodeJSON(d)
+				r.Metadata = &v
+			}
+		case "fixture":
+			a := d.array()
+			r.Fixture = nil
+			for a.next() {
+				var v TestScriptFixture
+				v.decodeJSON(d)
+				r.Fixture = append(r.Fixture, v)
+			}
+			if r.Fixture == nil && !a.isNull {
+				r.Fixture = []TestScriptFixture{}
+			}
+		case "profile":
+			a := d.array()
+			r.Profile = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Profile = append(r.Profile, v)
+			}
+			if r.Profile == nil && !a.isNull {
+				r.Profile = []Reference{}
+			}
+		case "variable":
+			a := d.array()
+			r.Variable = nil
+			for a.next() {
+				var v TestScriptVariable
+				v.decodeJSON(d)
+				r.Variable = append(r.Variable, v)
+			}
+			if r.Variable == nil && !a.isNull {
+				r.Variable = []TestScriptVariable{}
+			}
+		case "setup":
+			if d.null() {
+				r.Setup = nil
+			} else {
+				var v TestScriptSetup
+				v.decodeJSON(d)
+				r.Setup = &v
+			}
+		case "test":
+			a := d.array()
+			r.Test = nil
+			for a.next() {
+				var v TestScriptTest
+				v.decodeJSON(d)
+				r.Test = append(r.Test, v)
+			}
+			if r.Test == nil && !a.isNull {
+				r.Test = []TestScriptTest{}
+			}
+		case "teardown":
+			if d.null() {
+				r.Teardown = nil
+			} else {
+				var v TestScriptTeardown
+				v.decodeJSON(d)
+				r.Teardown = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScript which shares no memory with the original
+func (r TestScript) DeepCopy() TestScript {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Identifier != nil {
+		v := r.Identifier.DeepCopy()
+		out.Identifier = &v
+	}
+	if r.Version != nil {
+		v := *r.Version
+		out.Version = &v
+	}
+	if r.VersionElement != nil {
+		v := r.VersionElement.DeepCopy()
+		out.VersionElement = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Title != nil {
+		v := *r.Title
+		out.Title = &v
+	}
+	if r.TitleElement != nil {
+		v := r.TitleElement.DeepCopy()
+		out.TitleElement = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.Experimental != nil {
+		v := *r.Experimental
+		out.Experimental = &v
+	}
+	if r.ExperimentalElement != nil {
+		v := r.ExperimentalElement.DeepCopy()
+		out.ExperimentalElement = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.Publisher != nil {
+		v := *r.Publisher
+		out.Publisher = &v
+	}
+	if r.PublisherElement != nil {
+		v := r.PublisherElement.DeepCopy()
+		out.PublisherElement = &v
+	}
+	if r.Contact != nil {
+		out.Contact = make([]ContactDetail, len(r.Contact))
+		for i := range r.Contact {
+			out.Contact[i] = r.Contact[i].DeepCopy()
+		}
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.UseContext != nil {
+		out.UseContext = make([]UsageContext, len(r.UseContext))
+		for i := range r.UseContext {
+			out.UseContext[i] = r.UseContext[i].DeepCopy()
+		}
+	}
+	if r.Jurisdiction != nil {
+		out.Jurisdiction = make([]CodeableConcept, len(r.Jurisdiction))
+		for i := range r.Jurisdiction {
+			out.Jurisdiction[i] = r.Jurisdiction[i].DeepCopy()
+		}
+	}
+	if r.Purpose != nil {
+		v := *r.Purpose
+		out.Purpose = &v
+	}
+	if r.PurposeElement != nil {
+		v := r.PurposeElement.DeepCopy()
+		out.PurposeElement = &v
+	}
+	if r.Copyright != nil {
+		v := *r.Copyright
+		out.Copyright = &v
+	}
+	if r.CopyrightElement != nil {
+		v := r.CopyrightElement.DeepCopy()
+		out.CopyrightElement = &v
+	}
+	if r.Origin != nil {
+		out.Origin = make([]TestScriptOrigin, len(r.Origin))
+		for i := range r.Origin {
+			out.Origin[i] = r.Origin[i].DeepCopy()
+		}
+	}
+	if r.Destination != nil {
+		out.Destination = make([]TestScriptDestination, len(r.Destination))
+		for i := range r.Destination {
+			out.Destination[i] = r.Destination[i].DeepCopy()
+		}
+	}
+	if r.Metadata != nil {
+		v := r.Metadata.DeepCopy()
+		out.Metadata = &v
+	}
+	if r.Fixture != nil {
+		out.Fixture = make([]TestScriptFixture, len(r.Fixture))
+		for i := range r.Fixture {
+			out.Fixture[i] = r.Fixture[i].DeepCopy()
+		}
+	}
+	if r.Profile != nil {
+		out.Profile = make([]Reference, len(r.Profile))
+		for i := range r.Profile {
+			out.Profile[i] = r.Profile[i].DeepCopy()
+		}
+	}
+	if r.Variable != nil {
+		out.Variable = make([]TestScriptVariable, len(r.Variable))
+		for i := range r.Variable {
+			out.Variable[i] = r.Variable[i].DeepCopy()
+		}
+	}
+	if r.Setup != nil {
+		v := r.Setup.DeepCopy()
+		out.Setup = &v
+	}
+	if r.Test != nil {
+		out.Test = make([]TestScriptTest, len(r.Test))
+		for i := range r.Test {
+			out.Test[i] = r.Test[i].DeepCopy()
+		}
+	}
+	if r.Teardown != nil {
+		v := r.Teardown.DeepCopy()
+		out.Teardown = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScript) Equal(other TestScript) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.Equal(*other.Identifier) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.Equal(*other.VersionElement) {
+		return false
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && *r.Title != *other.Title {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.Equal(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.Equal(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && *r.Publisher != *other.Publisher {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.Equal(*other.PublisherElement) {
+		return false
+	}
+	if len(r.Contact) != len(other.Contact) {
+		return false
+	}
+	for i := range r.Contact {
+		if !r.Contact[i].Equal(other.Contact[i]) {
+			return false
+		}
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.UseContext) != len(other.UseContext) {
+		return false
+	}
+	for i := range r.UseContext {
+		if !r.UseContext[i].Equal(other.UseContext[i]) {
+			return false
+		}
+	}
+	if len(r.Jurisdiction) != len(other.Jurisdiction) {
+		return false
+	}
+	for i := range r.Jurisdiction {
+		if !r.Jurisdiction[i].Equal(other.Jurisdiction[i]) {
+			return false
+		}
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && *r.Purpose != *other.Purpose {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.Equal(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && *r.Copyright != *other.Copyright {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.Equal(*other.CopyrightElement) {
+		return false
+	}
+	if len(r.Origin) != len(other.Origin) {
+		return false
+	}
+	for i := range r.Origin {
+		if !r.Origin[i].Equal(other.Origin[i]) {
+			return false
+		}
+	}
+	if len(r.Destination) != len(other.Destination) {
+		return false
+	}
+	for i := range r.Destination {
+		if !r.Destination[i].Equal(other.Destination[i]) {
+			return false
+		}
+	}
+	if (r.Metadata == nil) != (other.Metadata == nil) || r.Metadata != nil && !r.Metadata.Equal(*other.Metadata) {
+		return false
+	}
+	if len(r.Fixture) != len(other.Fixture) {
+		return false
+	}
+	for i := range r.Fixture {
+		if !r.Fixture[i].Equal(other.Fixture[i]) {
+			return false
+		}
+	}
+	if len(r.Profile) != len(other.Profile) {
+		return false
+	}
+	for i := range r.Profile {
+		if !r.Profile[i].Equal(other.Profile[i]) {
+			return false
+		}
+	}
+	if len(r.Variable) != len(other.Variable) {
+		return false
+	}
+	for i := range r.Variable {
+		if !r.Variable[i].Equal(other.Variable[i]) {
+			return false
+		}
+	}
+	if (r.Setup == nil) != (other.Setup == nil) || r.Setup != nil && !r.Setup.Equal(*other.Setup) {
+		return false
+	}
+	if len(r.Test) != len(other.Test) {
+		return false
+	}
+	for i := range r.Test {
+		if !r.Test[i].Equal(other.Test[i]) {
+			return false
+		}
+	}
+	if (r.Teardown == nil) != (other.Teardown == nil) || r.Teardown != nil && !r.Teardown.Equal(*other.Teardown) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScript) EqualsDeep(other TestScript) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Identifier == nil) != (other.Identifier == nil) || r.Identifier != nil && !r.Identifier.EqualsDeep(*other.Identifier) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if (r.VersionElement == nil) != (other.VersionElement == nil) || r.VersionElement != nil && !r.VersionElement.EqualsDeep(*other.VersionElement) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if (r.TitleElement == nil) != (other.TitleElement == nil) || r.TitleElement != nil && !r.TitleElement.EqualsDeep(*other.TitleElement) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.ExperimentalElement == nil) != (other.ExperimentalElement == nil) || r.ExperimentalElement != nil && !r.ExperimentalElement.EqualsDeep(*other.ExperimentalElement) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.PublisherElement == nil) != (other.PublisherElement == nil) || r.PublisherElement != nil && !r.PublisherElement.EqualsDeep(*other.PublisherElement) {
+		return false
+	}
+	if !equivalentList(len(r.Contact), len(other.Contact), func(i, j int) bool {
+		return r.Contact[i].EqualsDeep(other.Contact[j])
+	}) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.UseContext), len(other.UseContext), func(i, j int) bool {
+		return r.UseContext[i].EqualsDeep(other.UseContext[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Jurisdiction), len(other.Jurisdiction), func(i, j int) bool {
+		return r.Jurisdiction[i].EqualsDeep(other.Jurisdiction[j])
+	}) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.PurposeElement == nil) != (other.PurposeElement == nil) || r.PurposeElement != nil && !r.PurposeElement.EqualsDeep(*other.PurposeElement) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	if (r.CopyrightElement == nil) != (other.CopyrightElement == nil) || r.CopyrightElement != nil && !r.CopyrightElement.EqualsDeep(*other.CopyrightElement) {
+		return false
+	}
+	if !equivalentList(len(r.Origin), len(other.Origin), func(i, j int) bool {
+		return r.Origin[i].EqualsDeep(other.Origin[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Destination), len(other.Destination), func(i, j int) bool {
+		return r.Destination[i].EqualsDeep(other.Destination[j])
+	}) {
+		return false
+	}
+	if (r.Metadata == nil) != (other.Metadata == nil) || r.Metadata != nil && !r.Metadata.EqualsDeep(*other.Metadata) {
+		return false
+	}
+	if !equivalentList(len(r.Fixture), len(other.Fixture), func(i, j int) bool {
+		return r.Fixture[i].EqualsDeep(other.Fixture[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Profile), len(other.Profile), func(i, j int) bool {
+		return r.Profile[i].EqualsDeep(other.Profile[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Variable), len(other.Variable), func(i, j int) bool {
+		return r.Variable[i].EqualsDeep(other.Variable[j])
+	}) {
+		return false
+	}
+	if (r.Setup == nil) != (other.Setup == nil) || r.Setup != nil && !r.Setup.EqualsDeep(*other.Setup) {
+		return false
+	}
+	if !equivalentList(len(r.Test), len(other.Test), func(i, j int) bool {
+		return r.Test[i].EqualsDeep(other.Test[j])
+	}) {
+		return false
+	}
+	if (r.Teardown == nil) != (other.Teardown == nil) || r.Teardown != nil && !r.Teardown.EqualsDeep(*other.Teardown) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScript) EqualsShallow(other TestScript) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && !equivalentString(*r.Version, *other.Version) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.Title == nil) != (other.Title == nil) || r.Title != nil && !equivalentString(*r.Title, *other.Title) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.Experimental == nil) != (other.Experimental == nil) || r.Experimental != nil && *r.Experimental != *other.Experimental {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.Publisher == nil) != (other.Publisher == nil) || r.Publisher != nil && !equivalentString(*r.Publisher, *other.Publisher) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Purpose == nil) != (other.Purpose == nil) || r.Purpose != nil && !equivalentString(*r.Purpose, *other.Purpose) {
+		return false
+	}
+	if (r.Copyright == nil) != (other.Copyright == nil) || r.Copyright != nil && !equivalentString(*r.Copyright, *other.Copyright) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScript as FHIR XML
+func (r TestScript) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "TestScript",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "identifier", r.Identifier); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "version", r.Version, r.VersionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "title", r.Title, r.TitleElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "experimental", r.Experimental, r.ExperimentalElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "publisher", r.Publisher, r.PublisherElement); err != nil {
+		return err
+	}
+	for _, v := range r.Contact {
+		if err := encodeXMLElement(e, "contact", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.UseContext {
+		if err := encodeXMLElement(e, "useContext", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Jurisdiction {
+		if err := encodeXMLElement(e, "jurisdiction", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "purpose", r.Purpose, r.PurposeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "copyright", r.Copyright, r.CopyrightElement); err != nil {
+		return err
+	}
+	for _, v := range r.Origin {
+		if err := encodeXMLElement(e, "origin", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Destination {
+		if err := encodeXMLElement(e, "destination", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "metadata", r.Metadata); err != nil {
+		return err
+	}
+	for _, v := range r.Fixture {
+		if err := encodeXMLElement(e, "fixture", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Profile {
+		if err := encodeXMLElement(e, "profile", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Variable {
+		if err := encodeXMLElement(e, "variable", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "setup", r.Setup); err != nil {
+		return err
+	}
+	for _, v := range r.Test {
+		if err := encodeXMLElement(e, "test", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "teardown", r.Teardown); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScript from FHIR XML
+func (r *TestScript) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = &v
+			case "version":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Version = &v
+				}
+				r.VersionElement = element
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "title":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Title = &v
+				}
+				r.TitleElement = element
+			case "status":
+				var v PublicationStatus
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "experimental":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Experimental = &v
+				}
+				r.ExperimentalElement = element
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "publisher":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Publisher = &v
+				}
+				r.PublisherElement = element
+			case "contact":
+				var v ContactDetail
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Contact = append(r.Contact, v)
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "useContext":
+				var v UsageContext
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.UseContext = append(r.UseContext, v)
+			case "jurisdiction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Jurisdiction = append(r.Jurisdiction, v)
+			case "purpose":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Purpose = &v
+				}
+				r.PurposeElement = element
+			case "copyright":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Copyright = &v
+				}
+				r.CopyrightElement = element
+			case "origin":
+				var v TestScriptOrigin
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Origin = append(r.Origin, v)
+			case "destination":
+				var v TestScriptDestination
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Destination = append(r.Destination, v)
+			case "metadata":
+				var v TestScriptMetadata
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Metadata = &v
+			case "fixture":
+				var v TestScriptFixture
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Fixture = append(r.Fixture, v)
+			case "profile":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Profile = append(r.Profile, v)
+			case "variable":
+				var v TestScriptVariable
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Variable = append(r.Variable, v)
+			case "setup":
+				var v TestScriptSetup
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Setup = &v
+			case "test":
+				var v TestScriptTest
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Test = append(r.Test, v)
+			case "teardown":
+				var v TestScriptTeardown
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Teardown = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScript as properties to the RDF node n
+func (r TestScript) turtle(n *turtleNode) {
+	n.resource("TestScript", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.url", -1, r.Url, r.UrlElement, "string")
+	n.element("TestScript.identifier", -1, r.Identifier)
+	n.primitive("TestScript.version", -1, r.Version, r.VersionElement, "string")
+	n.primitive("TestScript.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("TestScript.title", -1, r.Title, r.TitleElement, "string")
+	n.primitive("TestScript.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("TestScript.experimental", -1, r.Experimental, r.ExperimentalElement, "boolean")
+	n.primitive("TestScript.date", -1, r.Date, r.DateElement, "dateTime")
+	n.primitive("TestScript.publisher", -1, r.Publisher, r.PublisherElement, "string")
+	for i, v := range r.Contact {
+		n.element("TestScript.contact", i, v)
+	}
+	n.primitive("TestScript.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.UseContext {
+		n.element("TestScript.useContext", i, v)
+	}
+	for i, v := range r.Jurisdiction {
+		n.element("TestScript.jurisdiction", i, v)
+	}
+	n.primitive("TestScript.purpose", -1, r.Purpose, r.PurposeElement, "string")
+	n.primitive("TestScript.copyright", -1, r.Copyright, r.CopyrightElement, "string")
+	for i, v := range r.Origin {
+		n.element("TestScript.origin", i, v)
+	}
+	for i, v := range r.Destination {
+		n.element("TestScript.destination", i, v)
+	}
+	n.element("TestScript.metadata", -1, r.Metadata)
+	for i, v := range r.Fixture {
+		n.element("TestScript.fixture", i, v)
+	}
+	for i, v := range r.Profile {
+		n.element("TestScript.profile", i, v)
+	}
+	for i, v := range r.Variable {
+		n.element("TestScript.variable", i, v)
+	}
+	n.element("TestScript.setup", -1, r.Setup)
+	for i, v := range r.Test {
+		n.element("TestScript.test", i, v)
+	}
+	n.element("TestScript.teardown", -1, r.Teardown)
+}
+
+// MarshalBSON marshals the given TestScript as BSON document with the structure of its FHIR JSON
+func (r TestScript) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScript from a BSON document with the structure of its FHIR JSON
+func (r *TestScript) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScript as protobuf message TestScript of fhir.proto
+func (r TestScript) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScript to the encoded message
+func (r TestScript) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	if r.Url != "" {
+		e.string(9, r.Url)
+	}
+	if r.Identifier != nil {
+		e.message(10, *r.Identifier)
+	}
+	if r.Version != nil {
+		e.string(11, *r.Version)
+	}
+	if r.Name != "" {
+		e.string(12, r.Name)
+	}
+	if r.Title != nil {
+		e.string(13, *r.Title)
+	}
+	e.varint(14, r.Status.protoNumber())
+	if r.Experimental != nil {
+		e.varint(15, protoBool(*r.Experimental))
+	}
+	if r.Date != nil {
+		e.string(16, *r.Date)
+	}
+	if r.Publisher != nil {
+		e.string(17, *r.Publisher)
+	}
+	for _, v := range r.Contact {
+		e.message(18, v)
+	}
+	if r.Description != nil {
+		e.string(19, *r.Description)
+	}
+	for _, v := range r.UseContext {
+		e.message(20, v)
+	}
+	for _, v := range r.Jurisdiction {
+		e.message(21, v)
+	}
+	if r.Purpose != nil {
+		e.string(22, *r.Purpose)
+	}
+	if r.Copyright != nil {
+		e.string(23, *r.Copyright)
+	}
+	for _, v := range r.Origin {
+		e.message(24, v)
+	}
+	for _, v := range r.Destination {
+		e.message(25, v)
+	}
+	if r.Metadata != nil {
+		e.message(26, *r.Metadata)
+	}
+	for _, v := range r.Fixture {
+		e.message(27, v)
+	}
+	for _, v := range r.Profile {
+		e.message(28, v)
+	}
+	for _, v := range r.Variable {
+		e.message(29, v)
+	}
+	if r.Setup != nil {
+		e.message(30, *r.Setup)
+	}
+	for _, v := range r.Test {
+		e.message(31, v)
+	}
+	if r.Teardown != nil {
+		e.message(32, *r.Teardown)
+	}
+	if r.IdElement != nil {
+		e.message(33, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(34, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(35, *r.LanguageElement)
+	}
+	if r.UrlElement != nil {
+		e.message(36, *r.UrlElement)
+	}
+	if r.VersionElement != nil {
+		e.message(37, *r.VersionElement)
+	}
+	if r.NameElement != nil {
+		e.message(38, *r.NameElement)
+	}
+	if r.TitleElement != nil {
+		e.message(39, *r.TitleElement)
+	}
+	if r.StatusElement != nil {
+		e.message(40, *r.StatusElement)
+	}
+	if r.ExperimentalElement != nil {
+		e.message(41, *r.ExperimentalElement)
+	}
+	if r.DateElement != nil {
+		e.message(42, *r.DateElement)
+	}
+	if r.PublisherElement != nil {
+		e.message(43, *r.PublisherElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(44, *r.DescriptionElement)
+	}
+	if r.PurposeElement != nil {
+		e.message(45, *r.PurposeElement)
+	}
+	if r.CopyrightElement != nil {
+		e.message(46, *r.CopyrightElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScript from protobuf message TestScript of fhir.proto
+func (r *TestScript) UnmarshalProto(b []byte) error {
+	*r = TestScript{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			r.Url = d.string()
+		case 10:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = &v
+		case 11:
+			v := d.string()
+			r.Version = &v
+		case 12:
+			r.Name = d.string()
+		case 13:
+			v := d.string()
+			r.Title = &v
+		case 14:
+			var v PublicationStatus
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 15:
+			v := d.bool()
+			r.Experimental = &v
+		case 16:
+			v := d.string()
+			r.Date = &v
+		case 17:
+			v := d.string()
+			r.Publisher = &v
+		case 18:
+			var v ContactDetail
+			d.message(&v)
+			r.Contact = append(r.Contact, v)
+		case 19:
+			v := d.string()
+			r.Description = &v
+		case 20:
+			var v UsageContext
+			d.message(&v)
+			r.UseContext = append(r.UseContext, v)
+		case 21:
+			var v CodeableConcept
+			d.message(&v)
+			r.Jurisdiction = append(r.Jurisdiction, v)
+		case 22:
+			v := d.string()
+			r.Purpose = &v
+		case 23:
+			v := d.string()
+			r.Copyright = &v
+		case 24:
+			var v TestScriptOrigin
+			d.message(&v)
+			r.Origin = append(r.Origin, v)
+		case 25:
+			var v TestScriptDestination
+			d.message(&v)
+			r.Destination = append(r.Destination, v)
+		case 26:
+			var v TestScriptMetadata
+			d.message(&v)
+			r.Metadata = &v
+		case 27:
+			var v TestScriptFixture
+			d.message(&v)
+			r.Fixture = append(r.Fixture, v)
+		case 28:
+			var v Reference
+			d.message(&v)
+			r.Profile = append(r.Profile, v)
+		case 29:
+			var v TestScriptVariable
+			d.message(&v)
+			r.Variable = append(r.Variable, v)
+		case 30:
+			var v TestScriptSetup
+			d.message(&v)
+			r.Setup = &v
+		case 31:
+			var v TestScriptTest
+			d.message(&v)
+			r.Test = append(r.Test, v)
+		case 32:
+			var v TestScriptTeardown
+			d.message(&v)
+			r.Teardown = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.VersionElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.TitleElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.ExperimentalElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.PublisherElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.PurposeElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.CopyrightElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptOrigin as JSON into a byte slice
+func (r TestScriptOrigin) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptOrigin as JSON object with the members in the order of the fields
+func (r TestScriptOrigin) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("index")
+	e.int(r.Index)
+	if r.IndexElement != nil {
+		e.key("_index")
+		r.IndexElement.appendJSON(e)
+	}
+	e.key("profile")
+	r.Profile.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptOrigin from JSON. Unknown members are ignored.
+func (r *TestScriptOrigin) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptOrigin
+func (r *TestScriptOrigin) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "index":
+			if v, ok := d.int(); ok {
+				r.Index = v
+			}
+		case "_index":
+			r.IndexElement = d.element()
+		case "profile":
+			r.Profile.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptOrigin which shares no memory with the original
+func (r TestScriptOrigin) DeepCopy() TestScriptOrigin {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.IndexElement != nil {
+		v := r.IndexElement.DeepCopy()
+		out.IndexElement = &v
+	}
+	out.Profile = r.Profile.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptOrigin) Equal(other TestScriptOrigin) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Index != other.Index {
+		return false
+	}
+	if (r.IndexElement == nil) != (other.IndexElement == nil) || r.IndexElement != nil && !r.IndexElement.Equal(*other.IndexElement) {
+		return false
+	}
+	if !r.Profile.Equal(other.Profile) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptOrigin) EqualsDeep(other TestScriptOrigin) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Index != other.Index {
+		return false
+	}
+	if (r.IndexElement == nil) != (other.IndexElement == nil) || r.IndexElement != nil && !r.IndexElement.EqualsDeep(*other.IndexElement) {
+		return false
+	}
+	if !r.Profile.EqualsDeep(other.Profile) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptOrigin) EqualsShallow(other TestScriptOrigin) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Index != other.Index {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptOrigin as FHIR XML
+func (r TestScriptOrigin) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "index", r.Index, r.IndexElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "profile", r.Profile); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptOrigin from FHIR XML
+func (r *TestScriptOrigin) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "index":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Index = v
+				}
+				r.IndexElement = element
+			case "profile":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Profile = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptOrigin as properties to the RDF node n
+func (r TestScriptOrigin) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.origin.index", -1, r.Index, r.IndexElement, "integer")
+	n.element("TestScript.origin.profile", -1, r.Profile)
+}
+
+// MarshalBSON marshals the given TestScriptOrigin as BSON document with the structure of its FHIR JSON
+func (r TestScriptOrigin) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptOrigin from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptOrigin) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptOrigin as protobuf message TestScriptOrigin of fhir.proto
+func (r TestScriptOrigin) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptOrigin to the encoded message
+func (r TestScriptOrigin) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Index != 0 {
+		e.varint(4, uint64(int64(r.Index)))
+	}
+	e.message(5, r.Profile)
+	if r.IndexElement != nil {
+		e.message(6, *r.IndexElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptOrigin from protobuf message TestScriptOrigin of fhir.proto
+func (r *TestScriptOrigin) UnmarshalProto(b []byte) error {
+	*r = TestScriptOrigin{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Index = d.int()
+		case 5:
+			var v Coding
+			d.message(&v)
+			r.Profile = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.IndexElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptDestination as JSON into a byte slice
+func (r TestScriptDestination) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptDestination as JSON object with the members in the order of the fields
+func (r TestScriptDestination) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("index")
+	e.int(r.Index)
+	if r.IndexElement != nil {
+		e.key("_index")
+		r.IndexElement.appendJSON(e)
+	}
+	e.key("profile")
+	r.Profile.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptDestination from JSON. Unknown members are ignored.
+func (r *TestScriptDestination) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptDestination
+func (r *TestScriptDestination) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "index":
+			if v, ok := d.int(); ok {
+				r.Index = v
+			}
+		case "_index":
+			r.IndexElement = d.element()
+		case "profile":
+			r.Profile.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptDestination which shares no memory with the original
+func (r TestScriptDestination) DeepCopy() TestScriptDestination {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.IndexElement != nil {
+		v := r.IndexElement.DeepCopy()
+		out.IndexElement = &v
+	}
+	out.Profile = r.Profile.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptDestination) Equal(other TestScriptDestination) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Index != other.Index {
+		return false
+	}
+	if (r.IndexElement == nil) != (other.IndexElement == nil) || r.IndexElement != nil && !r.IndexElement.Equal(*other.IndexElement) {
+		return false
+	}
+	if !r.Profile.Equal(other.Profile) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptDestination) EqualsDeep(other TestScriptDestination) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Index != other.Index {
+		return false
+	}
+	if (r.IndexElement == nil) != (other.IndexElement == nil) || r.IndexElement != nil && !r.IndexElement.EqualsDeep(*other.IndexElement) {
+		return false
+	}
+	if !r.Profile.EqualsDeep(other.Profile) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptDestination) EqualsShallow(other TestScriptDestination) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Index != other.Index {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptDestination as FHIR XML
+func (r TestScriptDestination) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "index", r.Index, r.IndexElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "profile", r.Profile); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptDestination from FHIR XML
+func (r *TestScriptDestination) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "index":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Index = v
+				}
+				r.IndexElement = element
+			case "profile":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Profile = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptDestination as properties to the RDF node n
+func (r TestScriptDestination) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.destination.index", -1, r.Index, r.IndexElement, "integer")
+	n.element("TestScript.destination.profile", -1, r.Profile)
+}
+
+// MarshalBSON marshals the given TestScriptDestination as BSON document with the structure of its FHIR JSON
+func (r TestScriptDestination) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptDestination from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptDestination) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptDestination as protobuf message TestScriptDestination of fhir.proto
+func (r TestScriptDestination) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptDestination to the encoded message
+func (r TestScriptDestination) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Index != 0 {
+		e.varint(4, uint64(int64(r.Index)))
+	}
+	e.message(5, r.Profile)
+	if r.IndexElement != nil {
+		e.message(6, *r.IndexElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptDestination from protobuf message TestScriptDestination of fhir.proto
+func (r *TestScriptDestination) UnmarshalProto(b []byte) error {
+	*r = TestScriptDestination{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Index = d.int()
+		case 5:
+			var v Coding
+			d.message(&v)
+			r.Profile = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.IndexElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptMetadata as JSON into a byte slice
+func (r TestScriptMetadata) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptMetadata as JSON object with the members in the order of the fields
+func (r TestScriptMetadata) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Link) > 0 {
+		e.key("link")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Link {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("capability")
+	if r.Capability == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Capability {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptMetadata from JSON. Unknown members are ignored.
+func (r *TestScriptMetadata) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptMetadata
+func (r *TestScriptMetadata) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "link":
+			a := d.array()
+			r.Link = nil
+			for a.next() {
+				var v TestScriptMetadataLink
+				v.decodeJSON(d)
+				r.Link = append(r.Link, v)
+			}
+			if r.Link == nil && !a.isNull {
+				r.Link = []TestScriptMetadataLink{}
+			}
+		case "capability":
+			a := d.array()
+			r.Capability = nil
+			for a.next() {
+				var v TestScriptMetadataCapability
+				v.decodeJSON(d)
+				r.Capability = append(r.Capability, v)
+			}
+			if r.Capability == nil && !a.isNull {
+				r.Capability = []TestScriptMetadataCapability{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptMetadata which shares no memory with the original
+func (r TestScriptMetadata) DeepCopy() TestScriptMetadata {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Link != nil {
+		out.Link = make([]TestScriptMetadataLink, len(r.Link))
+		for i := range r.Link {
+			out.Link[i] = r.Link[i].DeepCopy()
+		}
+	}
+	if r.Capability != nil {
+		out.Capability = make([]TestScriptMetadataCapability, len(r.Capability))
+		for i := range r.Capability {
+			out.Capability[i] = r.Capability[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptMetadata) Equal(other TestScriptMetadata) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Link) != len(other.Link) {
+		return false
+	}
+	for i := range r.Link {
+		if !r.Link[i].Equal(other.Link[i]) {
+			return false
+		}
+	}
+	if len(r.Capability) != len(other.Capability) {
+		return false
+	}
+	for i := range r.Capability {
+		if !r.Capability[i].Equal(other.Capability[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptMetadata) EqualsDeep(other TestScriptMetadata) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Link), len(other.Link), func(i, j int) bool {
+		return r.Link[i].EqualsDeep(other.Link[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Capability), len(other.Capability), func(i, j int) bool {
+		return r.Capability[i].EqualsDeep(other.Capability[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptMetadata) EqualsShallow(other TestScriptMetadata) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptMetadata as FHIR XML
+func (r TestScriptMetadata) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Link {
+		if err := encodeXMLElement(e, "link", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Capability {
+		if err := encodeXMLElement(e, "capability", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptMetadata from FHIR XML
+func (r *TestScriptMetadata) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "link":
+				var v TestScriptMetadataLink
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Link = append(r.Link, v)
+			case "capability":
+				var v TestScriptMetadataCapability
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Capability = append(r.Capability, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptMetadata as properties to the RDF node n
+func (r TestScriptMetadata) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Link {
+		n.element("TestScript.metadata.link", i, v)
+	}
+	for i, v := range r.Capability {
+		n.element("TestScript.metadata.capability", i, v)
+	}
+}
+
+// MarshalBSON marshals the given TestScriptMetadata as BSON document with the structure of its FHIR JSON
+func (r TestScriptMetadata) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptMetadata from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptMetadata) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptMetadata as protobuf message TestScriptMetadata of fhir.proto
+func (r TestScriptMetadata) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptMetadata to the encoded message
+func (r TestScriptMetadata) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Link {
+		e.message(4, v)
+	}
+	for _, v := range r.Capability {
+		e.message(5, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptMetadata from protobuf message TestScriptMetadata of fhir.proto
+func (r *TestScriptMetadata) UnmarshalProto(b []byte) error {
+	*r = TestScriptMetadata{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestScriptMetadataLink
+			d.message(&v)
+			r.Link = append(r.Link, v)
+		case 5:
+			var v TestScriptMetadataCapability
+			d.message(&v)
+			r.Capability = append(r.Capability, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptMetadataLink as JSON into a byte slice
+func (r TestScriptMetadataLink) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptMetadataLink as JSON object with the members in the order of the fields
+func (r TestScriptMetadataLink) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("url")
+	e.string(r.Url)
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptMetadataLink from JSON. Unknown members are ignored.
+func (r *TestScriptMetadataLink) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptMetadataLink
+func (r *TestScriptMetadataLink) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = v
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptMetadataLink which shares no memory with the original
+func (r TestScriptMetadataLink) DeepCopy() TestScriptMetadataLink {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptMetadataLink) Equal(other TestScriptMetadataLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Url != other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptMetadataLink) EqualsDeep(other TestScriptMetadataLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptMetadataLink) EqualsShallow(other TestScriptMetadataLink) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Url, other.Url) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptMetadataLink as FHIR XML
+func (r TestScriptMetadataLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptMetadataLink from FHIR XML
+func (r *TestScriptMetadataLink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = v
+				}
+				r.UrlElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptMetadataLink as properties to the RDF node n
+func (r TestScriptMetadataLink) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.metadata.link.url", -1, r.Url, r.UrlElement, "string")
+	n.primitive("TestScript.metadata.link.description", -1, r.Description, r.DescriptionElement, "string")
+}
+
+// MarshalBSON marshals the given TestScriptMetadataLink as BSON document with the structure of its FHIR JSON
+func (r TestScriptMetadataLink) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptMetadataLink from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptMetadataLink) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptMetadataLink as protobuf message TestScriptMetadataLink of fhir.proto
+func (r TestScriptMetadataLink) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptMetadataLink to the encoded message
+func (r TestScriptMetadataLink) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Url != "" {
+		e.string(4, r.Url)
+	}
+	if r.Description != nil {
+		e.string(5, *r.Description)
+	}
+	if r.UrlElement != nil {
+		e.message(6, *r.UrlElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(7, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptMetadataLink from protobuf message TestScriptMetadataLink of fhir.proto
+func (r *TestScriptMetadataLink) UnmarshalProto(b []byte) error {
+	*r = TestScriptMetadataLink{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Url = d.string()
+		case 5:
+			v := d.string()
+			r.Description = &v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptMetadataCapability as JSON into a byte slice
+func (r TestScriptMetadataCapability) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptMetadataCapability as JSON object with the members in the order of the fields
+func (r TestScriptMetadataCapability) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("required")
+	e.bool(r.Required)
+	if r.RequiredElement != nil {
+		e.key("_required")
+		r.RequiredElement.appendJSON(e)
+	}
+	e.key("validated")
+	e.bool(r.Validated)
+	if r.ValidatedElement != nil {
+		e.key("_validated")
+		r.ValidatedElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if len(r.Origin) > 0 {
+		e.key("origin")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Origin {
+			e.item()
+			e.int(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.OriginElement) > 0 {
+		e.key("_origin")
+		e.elements(r.OriginElement, len(r.Origin))
+	}
+	if r.Destination != nil {
+		e.key("destination")
+		e.int(*r.Destination)
+	}
+	if r.DestinationElement != nil {
+		e.key("_destination")
+		r.DestinationElement.appendJSON(e)
+	}
+	if len(r.Link) > 0 {
+		e.key("link")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Link {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.LinkElement) > 0 {
+		e.key("_link")
+		e.elements(r.LinkElement, len(r.Link))
+	}
+	e.key("capabilities")
+	e.string(r.Capabilities)
+	if r.CapabilitiesElement != nil {
+		e.key("_capabilities")
+		r.CapabilitiesElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptMetadataCapability from JSON. Unknown members are ignored.
+func (r *TestScriptMetadataCapability) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptMetadataCapability
+func (r *TestScriptMetadataCapability) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "required":
+			if v, ok := d.bool(); ok {
+				r.Required = v
+			}
+		case "_required":
+			r.RequiredElement = d.element()
+		case "validated":
+			if v, ok := d.bool(); ok {
+				r.Validated = v
+			}
+		case "_validated":
+			r.ValidatedElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "origin":
+			a := d.array()
+			r.Origin = nil
+			for a.next() {
+				v, _ := d.int()
+				r.Origin = append(r.Origin, v)
+			}
+			if r.Origin == nil && !a.isNull {
+				r.Origin = []int{}
+			}
+		case "_origin":
+			a := d.array()
+			r.OriginElement = nil
+			for a.next() {
+				r.OriginElement = append(r.OriginElement, d.element())
+			}
+		case "destination":
+			if v, ok := d.int(); ok {
+				r.Destination = &v
+			} else {
+				r.Destination = nil
+			}
+		case "_destination":
+			r.DestinationElement = d.element()
+		case "link":
+			a := d.array()
+			r.Link = nil
+			for a.next() {
+				v, _ := d.string()
+				r.Link = append(r.Link, v)
+			}
+			if r.Link == nil && !a.isNull {
+				r.Link = []string{}
+			}
+		case "_link":
+			a := d.array()
+			r.LinkElement = nil
+			for a.next() {
+				r.LinkElement = append(r.LinkElement, d.element())
+			}
+		case "capabilities":
+			if v, ok := d.string(); ok {
+				r.Capabilities = v
+			}
+		case "_capabilities":
+			r.CapabilitiesElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptMetadataCapability which shares no memory with the original
+func (r TestScriptMetadataCapability) DeepCopy() TestScriptMetadataCapability {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.RequiredElement != nil {
+		v := r.RequiredElement.DeepCopy()
+		out.RequiredElement = &v
+	}
+	if r.ValidatedElement != nil {
+		v := r.ValidatedElement.DeepCopy()
+		out.ValidatedElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Origin != nil {
+		out.Origin = make([]int, len(r.Origin))
+		copy(out.Origin, r.Origin)
+	}
+	if r.OriginElement != nil {
+		out.OriginElement = make([]*Element, len(r.OriginElement))
+		for i, v := range r.OriginElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.OriginElement[i] = &c
+			}
+		}
+	}
+	if r.Destination != nil {
+		v := *r.Destination
+		out.Destination = &v
+	}
+	if r.DestinationElement != nil {
+		v := r.DestinationElement.DeepCopy()
+		out.DestinationElement = &v
+	}
+	if r.Link != nil {
+		out.Link = make([]string, len(r.Link))
+		copy(out.Link, r.Link)
+	}
+	if r.LinkElement != nil {
+		out.LinkElement = make([]*Element, len(r.LinkElement))
+		for i, v := range r.LinkElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.LinkElement[i] = &c
+			}
+		}
+	}
+	if r.CapabilitiesElement != nil {
+		v := r.CapabilitiesElement.DeepCopy()
+		out.CapabilitiesElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptMetadataCapability) Equal(other TestScriptMetadataCapability) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Required != other.Required {
+		return false
+	}
+	if (r.RequiredElement == nil) != (other.RequiredElement == nil) || r.RequiredElement != nil && !r.RequiredElement.Equal(*other.RequiredElement) {
+		return false
+	}
+	if r.Validated != other.Validated {
+		return false
+	}
+	if (r.ValidatedElement == nil) != (other.ValidatedElement == nil) || r.ValidatedElement != nil && !r.ValidatedElement.Equal(*other.ValidatedElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Origin) != len(other.Origin) {
+		return false
+	}
+	for i := range r.Origin {
+		if r.Origin[i] != other.Origin[i] {
+			return false
+		}
+	}
+	if !equalElements(r.OriginElement, other.OriginElement, false) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && *r.Destination != *other.Destination {
+		return false
+	}
+	if (r.DestinationElement == nil) != (other.DestinationElement == nil) || r.DestinationElement != nil && !r.DestinationElement.Equal(*other.DestinationElement) {
+		return false
+	}
+	if len(r.Link) != len(other.Link) {
+		return false
+	}
+	for i := range r.Link {
+		if r.Link[i] != other.Link[i] {
+			return false
+		}
+	}
+	if !equalElements(r.LinkElement, other.LinkElement, false) {
+		return false
+	}
+	if r.Capabilities != other.Capabilities {
+		return false
+	}
+	if (r.CapabilitiesElement == nil) != (other.CapabilitiesElement == nil) || r.CapabilitiesElement != nil && !r.CapabilitiesElement.Equal(*other.CapabilitiesElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptMetadataCapability) EqualsDeep(other TestScriptMetadataCapability) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Required != other.Required {
+		return false
+	}
+	if (r.RequiredElement == nil) != (other.RequiredElement == nil) || r.RequiredElement != nil && !r.RequiredElement.EqualsDeep(*other.RequiredElement) {
+		return false
+	}
+	if r.Validated != other.Validated {
+		return false
+	}
+	if (r.ValidatedElement == nil) != (other.ValidatedElement == nil) || r.ValidatedElement != nil && !r.ValidatedElement.EqualsDeep(*other.ValidatedElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Origin), len(other.Origin), func(i, j int) bool {
+		return r.Origin[i] == other.Origin[j]
+	}) {
+		return false
+	}
+	if !equalElements(r.OriginElement, other.OriginElement, true) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && *r.Destination != *other.Destination {
+		return false
+	}
+	if (r.DestinationElement == nil) != (other.DestinationElement == nil) || r.DestinationElement != nil && !r.DestinationElement.EqualsDeep(*other.DestinationElement) {
+		return false
+	}
+	if !equivalentList(len(r.Link), len(other.Link), func(i, j int) bool {
+		return equivalentString(r.Link[i], other.Link[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.LinkElement, other.LinkElement, true) {
+		return false
+	}
+	if !equivalentString(r.Capabilities, other.Capabilities) {
+		return false
+	}
+	if (r.CapabilitiesElement == nil) != (other.CapabilitiesElement == nil) || r.CapabilitiesElement != nil && !r.CapabilitiesElement.EqualsDeep(*other.CapabilitiesElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptMetadataCapability) EqualsShallow(other TestScriptMetadataCapability) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Required != other.Required {
+		return false
+	}
+	if r.Validated != other.Validated {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if !equivalentList(len(r.Origin), len(other.Origin), func(i, j int) bool {
+		return r.Origin[i] == other.Origin[j]
+	}) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && *r.Destination != *other.Destination {
+		return false
+	}
+	if !equivalentList(len(r.Link), len(other.Link), func(i, j int) bool {
+		return equivalentString(r.Link[i], other.Link[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Capabilities, other.Capabilities) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptMetadataCapability as FHIR XML
+func (r TestScriptMetadataCapability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "required", r.Required, r.RequiredElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "validated", r.Validated, r.ValidatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for i, v := range r.Origin {
+		if err := encodeXMLPrimitive(e, "origin", v, elementAt(r.OriginElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "destination", r.Destination, r.DestinationElement); err != nil {
+		return err
+	}
+	for i, v := range r.Link {
+		if err := encodeXMLPrimitive(e, "link", v, elementAt(r.LinkElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "capabilities", r.Capabilities, r.CapabilitiesElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptMetadataCapability from FHIR XML
+func (r *TestScriptMetadataCapability) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "required":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Required = v
+				}
+				r.RequiredElement = element
+			case "validated":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Validated = v
+				}
+				r.ValidatedElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "origin":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.OriginElement = appendElement(r.OriginElement, len(r.Origin), element)
+					r.Origin = append(r.Origin, v)
+				}
+			case "destination":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Destination = &v
+				}
+				r.DestinationElement = element
+			case "link":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.LinkElement = appendElement(r.LinkElement, len(r.Link), element)
+					r.Link = append(r.Link, v)
+				}
+			case "capabilities":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Capabilities = v
+				}
+				r.CapabilitiesElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptMetadataCapability as properties to the RDF node n
+func (r TestScriptMetadataCapability) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.metadata.capability.required", -1, r.Required, r.RequiredElement, "boolean")
+	n.primitive("TestScript.metadata.capability.validated", -1, r.Validated, r.ValidatedElement, "boolean")
+	n.primitive("TestScript.metadata.capability.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Origin {
+		n.primitive("TestScript.metadata.capability.origin", i, v, elementAt(r.OriginElement, i), "integer")
+	}
+	n.primitive("TestScript.metadata.capability.destination", -1, r.Destination, r.DestinationElement, "integer")
+	for i, v := range r.Link {
+		n.primitive("TestScript.metadata.capability.link", i, v, elementAt(r.LinkElement, i), "string")
+	}
+	n.primitive("TestScript.metadata.capability.capabilities", -1, r.Capabilities, r.CapabilitiesElement, "string")
+}
+
+// MarshalBSON marshals the given TestScriptMetadataCapability as BSON document with the structure of its FHIR JSON
+func (r TestScriptMetadataCapability) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptMetadataCapability from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptMetadataCapability) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptMetadataCapability as protobuf message TestScriptMetadataCapability of fhir.proto
+func (r TestScriptMetadataCapability) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptMetadataCapability to the encoded message
+func (r TestScriptMetadataCapability) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Required {
+		e.varint(4, protoBool(r.Required))
+	}
+	if r.Validated {
+		e.varint(5, protoBool(r.Validated))
+	}
+	if r.Description != nil {
+		e.string(6, *r.Description)
+	}
+	if len(r.Origin) > 0 {
+		var p protoEncoder
+		for _, v := range r.Origin {
+			p.appendVarint(uint64(int64(v)))
+		}
+		e.bytes(7, p.buf)
+	}
+	if r.Destination != nil {
+		e.varint(8, uint64(int64(*r.Destination)))
+	}
+	for _, v := range r.Link {
+		e.string(9, v)
+	}
+	if r.Capabilities != "" {
+		e.string(10, r.Capabilities)
+	}
+	if r.RequiredElement != nil {
+		e.message(11, *r.RequiredElement)
+	}
+	if r.ValidatedElement != nil {
+		e.message(12, *r.ValidatedElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(13, *r.DescriptionElement)
+	}
+	for _, v := range r.OriginElement {
+		e.element(14, v)
+	}
+	if r.DestinationElement != nil {
+		e.message(15, *r.DestinationElement)
+	}
+	for _, v := range r.LinkElement {
+		e.element(16, v)
+	}
+	if r.CapabilitiesElement != nil {
+		e.message(17, *r.CapabilitiesElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptMetadataCapability from protobuf message TestScriptMetadataCapability of fhir.proto
+func (r *TestScriptMetadataCapability) UnmarshalProto(b []byte) error {
+	*r = TestScriptMetadataCapability{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Required = d.bool()
+		case 5:
+			r.Validated = d.bool()
+		case 6:
+			v := d.string()
+			r.Description = &v
+		case 7:
+			for _, v := range d.varints() {
+				r.Origin = append(r.Origin, int(int32(v)))
+			}
+		case 8:
+			v := d.int()
+			r.Destination = &v
+		case 9:
+			r.Link = append(r.Link, d.string())
+		case 10:
+			r.Capabilities = d.string()
+		case 11:
+			var v Element
+			d.message(&v)
+			r.RequiredElement = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.ValidatedElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 14:
+			r.OriginElement = append(r.OriginElement, d.element())
+		case 15:
+			var v Element
+			d.message(&v)
+			r.DestinationElement = &v
+		case 16:
+			r.LinkElement = append(r.LinkElement, d.element())
+		case 17:
+			var v Element
+			d.message(&v)
+			r.CapabilitiesElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptFixture as JSON into a byte slice
+func (r TestScriptFixture) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptFixture as JSON object with the members in the order of the fields
+func (r TestScriptFixture) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("autocreate")
+	e.bool(r.Autocreate)
+	if r.AutocreateElement != nil {
+		e.key("_autocreate")
+		r.AutocreateElement.appendJSON(e)
+	}
+	e.key("autodelete")
+	e.bool(r.Autodelete)
+	if r.AutodeleteElement != nil {
+		e.key("_autodelete")
+		r.AutodeleteElement.appendJSON(e)
+	}
+	if r.Resource != nil {
+		e.key("resource")
+		r.Resource.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptFixture from JSON. Unknown members are ignored.
+func (r *TestScriptFixture) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptFixture
+func (r *TestScriptFixture) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "autocreate":
+			if v, ok := d.bool(); ok {
+				r.Autocreate = v
+			}
+		case "_autocreate":
+			r.AutocreateElement = d.element()
+		case "autodelete":
+			if v, ok := d.bool(); ok {
+				r.Autodelete = v
+			}
+		case "_autodelete":
+			r.AutodeleteElement = d.element()
+		case "resource":
+			if d.null() {
+				r.Resource = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Resource = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptFixture which shares no memory with the original
+func (r TestScriptFixture) DeepCopy() TestScriptFixture {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.AutocreateElement != nil {
+		v := r.AutocreateElement.DeepCopy()
+		out.AutocreateElement = &v
+	}
+	if r.AutodeleteElement != nil {
+		v := r.AutodeleteElement.DeepCopy()
+		out.AutodeleteElement = &v
+	}
+	if r.Resource != nil {
+		v := r.Resource.DeepCopy()
+		out.Resource = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptFixture) Equal(other TestScriptFixture) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Autocreate != other.Autocreate {
+		return false
+	}
+	if (r.AutocreateElement == nil) != (other.AutocreateElement == nil) || r.AutocreateElement != nil && !r.AutocreateElement.Equal(*other.AutocreateElement) {
+		return false
+	}
+	if r.Autodelete != other.Autodelete {
+		return false
+	}
+	if (r.AutodeleteElement == nil) != (other.AutodeleteElement == nil) || r.AutodeleteElement != nil && !r.AutodeleteElement.Equal(*other.AutodeleteElement) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !r.Resource.Equal(*other.Resource) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptFixture) EqualsDeep(other TestScriptFixture) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if r.Autocreate != other.Autocreate {
+		return false
+	}
+	if (r.AutocreateElement == nil) != (other.AutocreateElement == nil) || r.AutocreateElement != nil && !r.AutocreateElement.EqualsDeep(*other.AutocreateElement) {
+		return false
+	}
+	if r.Autodelete != other.Autodelete {
+		return false
+	}
+	if (r.AutodeleteElement == nil) != (other.AutodeleteElement == nil) || r.AutodeleteElement != nil && !r.AutodeleteElement.EqualsDeep(*other.AutodeleteElement) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !r.Resource.EqualsDeep(*other.Resource) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptFixture) EqualsShallow(other TestScriptFixture) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Autocreate != other.Autocreate {
+		return false
+	}
+	if r.Autodelete != other.Autodelete {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptFixture as FHIR XML
+func (r TestScriptFixture) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "autocreate", r.Autocreate, r.AutocreateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "autodelete", r.Autodelete, r.AutodeleteElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "resource", r.Resource); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptFixture from FHIR XML
+func (r *TestScriptFixture) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "autocreate":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Autocreate = v
+				}
+				r.AutocreateElement = element
+			case "autodelete":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Autodelete = v
+				}
+				r.AutodeleteElement = element
+			case "resource":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Resource = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptFixture as properties to the RDF node n
+func (r TestScriptFixture) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.fixture.autocreate", -1, r.Autocreate, r.AutocreateElement, "boolean")
+	n.primitive("TestScript.fixture.autodelete", -1, r.Autodelete, r.AutodeleteElement, "boolean")
+	n.element("TestScript.fixture.resource", -1, r.Resource)
+}
+
+// MarshalBSON marshals the given TestScriptFixture as BSON document with the structure of its FHIR JSON
+func (r TestScriptFixture) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptFixture from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptFixture) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptFixture as protobuf message TestScriptFixture of fhir.proto
+func (r TestScriptFixture) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptFixture to the encoded message
+func (r TestScriptFixture) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Autocreate {
+		e.varint(4, protoBool(r.Autocreate))
+	}
+	if r.Autodelete {
+		e.varint(5, protoBool(r.Autodelete))
+	}
+	if r.Resource != nil {
+		e.message(6, *r.Resource)
+	}
+	if r.AutocreateElement != nil {
+		e.message(7, *r.AutocreateElement)
+	}
+	if r.AutodeleteElement != nil {
+		e.message(8, *r.AutodeleteElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptFixture from protobuf message TestScriptFixture of fhir.proto
+func (r *TestScriptFixture) UnmarshalProto(b []byte) error {
+	*r = TestScriptFixture{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Autocreate = d.bool()
+		case 5:
+			r.Autodelete = d.bool()
+		case 6:
+			var v Reference
+			d.message(&v)
+			r.Resource = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.AutocreateElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.AutodeleteElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptVariable as JSON into a byte slice
+func (r TestScriptVariable) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptVariable as JSON object with the members in the order of the fields
+func (r TestScriptVariable) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("name")
+	e.string(r.Name)
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.DefaultValue != nil {
+		e.key("defaultValue")
+		e.string(*r.DefaultValue)
+	}
+	if r.DefaultValueElement != nil {
+		e.key("_defaultValue")
+		r.DefaultValueElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		e.string(*r.Expression)
+	}
+	if r.ExpressionElement != nil {
+		e.key("_expression")
+		r.ExpressionElement.appendJSON(e)
+	}
+	if r.HeaderField != nil {
+		e.key("headerField")
+		e.string(*r.HeaderField)
+	}
+	if r.HeaderFieldElement != nil {
+		e.key("_headerField")
+		r.HeaderFieldElement.appendJSON(e)
+	}
+	if r.Hint != nil {
+		e.key("hint")
+		e.string(*r.Hint)
+	}
+	if r.HintElement != nil {
+		e.key("_hint")
+		r.HintElement.appendJSON(e)
+	}
+	if r.Path != nil {
+		e.key("path")
+		e.string(*r.Path)
+	}
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	if r.SourceId != nil {
+		e.key("sourceId")
+		e.string(*r.SourceId)
+	}
+	if r.SourceIdElement != nil {
+		e.key("_sourceId")
+		r.SourceIdElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptVariable from JSON. Unknown members are ignored.
+func (r *TestScriptVariable) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptVariable
+func (r *TestScriptVariable) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = v
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "defaultValue":
+			if v, ok := d.string(); ok {
+				r.DefaultValue = &v
+			} else {
+				r.DefaultValue = nil
+			}
+		case "_defaultValue":
+			r.DefaultValueElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "expression":
+			if v, ok := d.string(); ok {
+				r.Expression = &v
+			} else {
+				r.Expression = nil
+			}
+		case "_expression":
+			r.ExpressionElement = d.element()
+		case "headerField":
+			if v, ok := d.string(); ok {
+				r.HeaderField = &v
+			} else {
+				r.HeaderField = nil
+			}
+		case "_headerField":
+			r.HeaderFieldElement = d.element()
+		case "hint":
+			if v, ok := d.string(); ok {
+				r.Hint = &v
+			} else {
+				r.Hint = nil
+			}
+		case "_hint":
+			r.HintElement = d.element()
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = &v
+			} else {
+				r.Path = nil
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "sourceId":
+			if v, ok := d.string(); ok {
+				r.SourceId = &v
+			} else {
+				r.SourceId = nil
+			}
+		case "_sourceId":
+			r.SourceIdElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptVariable which shares no memory with the original
+func (r TestScriptVariable) DeepCopy() TestScriptVariable {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.DefaultValue != nil {
+		v := *r.DefaultValue
+		out.DefaultValue = &v
+	}
+	if r.DefaultValueElement != nil {
+		v := r.DefaultValueElement.DeepCopy()
+		out.DefaultValueElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Expression != nil {
+		v := *r.Expression
+		out.Expression = &v
+	}
+	if r.ExpressionElement != nil {
+		v := r.ExpressionElement.DeepCopy()
+		out.ExpressionElement = &v
+	}
+	if r.HeaderField != nil {
+		v := *r.HeaderField
+		out.HeaderField = &v
+	}
+	if r.HeaderFieldElement != nil {
+		v := r.HeaderFieldElement.DeepCopy()
+		out.HeaderFieldElement = &v
+	}
+	if r.Hint != nil {
+		v := *r.Hint
+		out.Hint = &v
+	}
+	if r.HintElement != nil {
+		v := r.HintElement.DeepCopy()
+		out.HintElement = &v
+	}
+	if r.Path != nil {
+		v := *r.Path
+		out.Path = &v
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	if r.SourceId != nil {
+		v := *r.SourceId
+		out.SourceId = &v
+	}
+	if r.SourceIdElement != nil {
+		v := r.SourceIdElement.DeepCopy()
+		out.SourceIdElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptVariable) Equal(other TestScriptVariable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Name != other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.DefaultValue == nil) != (other.DefaultValue == nil) || r.DefaultValue != nil && *r.DefaultValue != *other.DefaultValue {
+		return false
+	}
+	if (r.DefaultValueElement == nil) != (other.DefaultValueElement == nil) || r.DefaultValueElement != nil && !r.DefaultValueElement.Equal(*other.DefaultValueElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && *r.Expression != *other.Expression {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.Equal(*other.ExpressionElement) {
+		return false
+	}
+	if (r.HeaderField == nil) != (other.HeaderField == nil) || r.HeaderField != nil && *r.HeaderField != *other.HeaderField {
+		return false
+	}
+	if (r.HeaderFieldElement == nil) != (other.HeaderFieldElement == nil) || r.HeaderFieldElement != nil && !r.HeaderFieldElement.Equal(*other.HeaderFieldElement) {
+		return false
+	}
+	if (r.Hint == nil) != (other.Hint == nil) || r.Hint != nil && *r.Hint != *other.Hint {
+		return false
+	}
+	if (r.HintElement == nil) != (other.HintElement == nil) || r.HintElement != nil && !r.HintElement.Equal(*other.HintElement) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && *r.Path != *other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && *r.SourceId != *other.SourceId {
+		return false
+	}
+	if (r.SourceIdElement == nil) != (other.SourceIdElement == nil) || r.SourceIdElement != nil && !r.SourceIdElement.Equal(*other.SourceIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptVariable) EqualsDeep(other TestScriptVariable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.DefaultValue == nil) != (other.DefaultValue == nil) || r.DefaultValue != nil && !equivalentString(*r.DefaultValue, *other.DefaultValue) {
+		return false
+	}
+	if (r.DefaultValueElement == nil) != (other.DefaultValueElement == nil) || r.DefaultValueElement != nil && !r.DefaultValueElement.EqualsDeep(*other.DefaultValueElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.EqualsDeep(*other.ExpressionElement) {
+		return false
+	}
+	if (r.HeaderField == nil) != (other.HeaderField == nil) || r.HeaderField != nil && !equivalentString(*r.HeaderField, *other.HeaderField) {
+		return false
+	}
+	if (r.HeaderFieldElement == nil) != (other.HeaderFieldElement == nil) || r.HeaderFieldElement != nil && !r.HeaderFieldElement.EqualsDeep(*other.HeaderFieldElement) {
+		return false
+	}
+	if (r.Hint == nil) != (other.Hint == nil) || r.Hint != nil && !equivalentString(*r.Hint, *other.Hint) {
+		return false
+	}
+	if (r.HintElement == nil) != (other.HintElement == nil) || r.HintElement != nil && !r.HintElement.EqualsDeep(*other.HintElement) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && !equivalentString(*r.SourceId, *other.SourceId) {
+		return false
+	}
+	if (r.SourceIdElement == nil) != (other.SourceIdElement == nil) || r.SourceIdElement != nil && !r.SourceIdElement.EqualsDeep(*other.SourceIdElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptVariable) EqualsShallow(other TestScriptVariable) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Name, other.Name) {
+		return false
+	}
+	if (r.DefaultValue == nil) != (other.DefaultValue == nil) || r.DefaultValue != nil && !equivalentString(*r.DefaultValue, *other.DefaultValue) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.HeaderField == nil) != (other.HeaderField == nil) || r.HeaderField != nil && !equivalentString(*r.HeaderField, *other.HeaderField) {
+		return false
+	}
+	if (r.Hint == nil) != (other.Hint == nil) || r.Hint != nil && !equivalentString(*r.Hint, *other.Hint) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && !equivalentString(*r.SourceId, *other.SourceId) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptVariable as FHIR XML
+func (r TestScriptVariable) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "defaultValue", r.DefaultValue, r.DefaultValueElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expression", r.Expression, r.ExpressionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "headerField", r.HeaderField, r.HeaderFieldElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "hint", r.Hint, r.HintElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sourceId", r.SourceId, r.SourceIdElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptVariable from FHIR XML
+func (r *TestScriptVariable) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = v
+				}
+				r.NameElement = element
+			case "defaultValue":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DefaultValue = &v
+				}
+				r.DefaultValueElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Expression = &v
+				}
+				r.ExpressionElement = element
+			case "headerField":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.HeaderField = &v
+				}
+				r.HeaderFieldElement = element
+			case "hint":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Hint = &v
+				}
+				r.HintElement = element
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = &v
+				}
+				r.PathElement = element
+			case "sourceId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SourceId = &v
+				}
+				r.SourceIdElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptVariable as properties to the RDF node n
+func (r TestScriptVariable) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.variable.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("TestScript.variable.defaultValue", -1, r.DefaultValue, r.DefaultValueElement, "string")
+	n.primitive("TestScript.variable.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("TestScript.variable.expression", -1, r.Expression, r.ExpressionElement, "string")
+	n.primitive("TestScript.variable.headerField", -1, r.HeaderField, r.HeaderFieldElement, "string")
+	n.primitive("TestScript.variable.hint", -1, r.Hint, r.HintElement, "string")
+	n.primitive("TestScript.variable.path", -1, r.Path, r.PathElement, "string")
+	n.primitive("TestScript.variable.sourceId", -1, r.SourceId, r.SourceIdElement, "string")
+}
+
+// MarshalBSON marshals the given TestScriptVariable as BSON document with the structure of its FHIR JSON
+func (r TestScriptVariable) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptVariable from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptVariable) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptVariable as protobuf message TestScriptVariable of fhir.proto
+func (r TestScriptVariable) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptVariable to the encoded message
+func (r TestScriptVariable) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != "" {
+		e.string(4, r.Name)
+	}
+	if r.DefaultValue != nil {
+		e.string(5, *r.DefaultValue)
+	}
+	if r.Description != nil {
+		e.string(6, *r.Description)
+	}
+	if r.Expression != nil {
+		e.string(7, *r.Expression)
+	}
+	if r.HeaderField != nil {
+		e.string(8, *r.HeaderField)
+	}
+	if r.Hint != nil {
+		e.string(9, *r.Hint)
+	}
+	if r.Path != nil {
+		e.string(10, *r.Path)
+	}
+	if r.SourceId != nil {
+		e.string(11, *r.SourceId)
+	}
+	if r.NameElement != nil {
+		e.message(12, *r.NameElement)
+	}
+	if r.DefaultValueElement != nil {
+		e.message(13, *r.DefaultValueElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(14, *r.DescriptionElement)
+	}
+	if r.ExpressionElement != nil {
+		e.message(15, *r.ExpressionElement)
+	}
+	if r.HeaderFieldElement != nil {
+		e.message(16, *r.HeaderFieldElement)
+	}
+	if r.HintElement != nil {
+		e.message(17, *r.HintElement)
+	}
+	if r.PathElement != nil {
+		e.message(18, *r.PathElement)
+	}
+	if r.SourceIdElement != nil {
+		e.message(19, *r.SourceIdElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptVariable from protobuf message TestScriptVariable of fhir.proto
+func (r *TestScriptVariable) UnmarshalProto(b []byte) error {
+	*r = TestScriptVariable{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Name = d.string()
+		case 5:
+			v := d.string()
+			r.DefaultValue = &v
+		case 6:
+			v := d.string()
+			r.Description = &v
+		case 7:
+			v := d.string()
+			r.Expression = &v
+		case 8:
+			v := d.string()
+			r.HeaderField = &v
+		case 9:
+			v := d.string()
+			r.Hint = &v
+		case 10:
+			v := d.string()
+			r.Path = &v
+		case 11:
+			v := d.string()
+			r.SourceId = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.DefaultValueElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 15:
+			var v Element
+			d.message(&v)
+			r.ExpressionElement = &v
+		case 16:
+			var v Element
+			d.message(&v)
+			r.HeaderFieldElement = &v
+		case 17:
+			var v Element
+			d.message(&v)
+			r.HintElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.SourceIdElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptSetup as JSON into a byte slice
+func (r TestScriptSetup) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptSetup as JSON object with the members in the order of the fields
+func (r TestScriptSetup) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("action")
+	if r.Action == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptSetup from JSON. Unknown members are ignored.
+func (r *TestScriptSetup) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptSetup
+func (r *TestScriptSetup) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v TestScriptSetupAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []TestScriptSetupAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptSetup which shares no memory with the original
+func (r TestScriptSetup) DeepCopy() TestScriptSetup {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		out.Action = make([]TestScriptSetupAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptSetup) Equal(other TestScriptSetup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptSetup) EqualsDeep(other TestScriptSetup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptSetup) EqualsShallow(other TestScriptSetup) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptSetup as FHIR XML
+func (r TestScriptSetup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptSetup from FHIR XML
+func (r *TestScriptSetup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "action":
+				var v TestScriptSetupAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptSetup as properties to the RDF node n
+func (r TestScriptSetup) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Action {
+		n.element("TestScript.setup.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given TestScriptSetup as BSON document with the structure of its FHIR JSON
+func (r TestScriptSetup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptSetup from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptSetup) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptSetup as protobuf message TestScriptSetup of fhir.proto
+func (r TestScriptSetup) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptSetup to the encoded message
+func (r TestScriptSetup) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Action {
+		e.message(4, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptSetup from protobuf message TestScriptSetup of fhir.proto
+func (r *TestScriptSetup) UnmarshalProto(b []byte) error {
+	*r = TestScriptSetup{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestScriptSetupAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptSetupAction as JSON into a byte slice
+func (r TestScriptSetupAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptSetupAction as JSON object with the members in the order of the fields
+func (r TestScriptSetupAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Operation != nil {
+		e.key("operation")
+		r.Operation.appendJSON(e)
+	}
+	if r.Assert != nil {
+		e.key("assert")
+		r.Assert.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptSetupAction from JSON. Unknown members are ignored.
+func (r *TestScriptSetupAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptSetupAction
+func (r *TestScriptSetupAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "operation":
+			if d.null() {
+				r.Operation = nil
+			} else {
+				var v TestScriptSetupActionOperation
+				v.decodeJSON(d)
+				r.Operation = &v
+			}
+		case "assert":
+			if d.null() {
+				r.Assert = nil
+			} else {
+				var v TestScriptSetupActionAssert
+				v.decodeJSON(d)
+				r.Assert = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptSetupAction which shares no memory with the original
+func (r TestScriptSetupAction) DeepCopy() TestScriptSetupAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Operation != nil {
+		v := r.Operation.DeepCopy()
+		out.Operation = &v
+	}
+	if r.Assert != nil {
+		v := r.Assert.DeepCopy()
+		out.Assert = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptSetupAction) Equal(other TestScriptSetupAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.Equal(*other.Operation) {
+		return false
+	}
+	if (r.Assert == nil) != (other.Assert == nil) || r.Assert != nil && !r.Assert.Equal(*other.Assert) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptSetupAction) EqualsDeep(other TestScriptSetupAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.EqualsDeep(*other.Operation) {
+		return false
+	}
+	if (r.Assert == nil) != (other.Assert == nil) || r.Assert != nil && !r.Assert.EqualsDeep(*other.Assert) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptSetupAction) EqualsShallow(other TestScriptSetupAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptSetupAction as FHIR XML
+func (r TestScriptSetupAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "operation", r.Operation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "assert", r.Assert); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptSetupAction from FHIR XML
+func (r *TestScriptSetupAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "operation":
+				var v TestScriptSetupActionOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operation = &v
+			case "assert":
+				var v TestScriptSetupActionAssert
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Assert = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptSetupAction as properties to the RDF node n
+func (r TestScriptSetupAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("TestScript.setup.action.operation", -1, r.Operation)
+	n.element("TestScript.setup.action.assert", -1, r.Assert)
+}
+
+// MarshalBSON marshals the given TestScriptSetupAction as BSON document with the structure of its FHIR JSON
+func (r TestScriptSetupAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptSetupAction from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptSetupAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptSetupAction as protobuf message TestScriptSetupAction of fhir.proto
+func (r TestScriptSetupAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptSetupAction to the encoded message
+func (r TestScriptSetupAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Operation != nil {
+		e.message(4, *r.Operation)
+	}
+	if r.Assert != nil {
+		e.message(5, *r.Assert)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptSetupAction from protobuf message TestScriptSetupAction of fhir.proto
+func (r *TestScriptSetupAction) UnmarshalProto(b []byte) error {
+	*r = TestScriptSetupAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestScriptSetupActionOperation
+			d.message(&v)
+			r.Operation = &v
+		case 5:
+			var v TestScriptSetupActionAssert
+			d.message(&v)
+			r.Assert = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptSetupActionOperation as JSON into a byte slice
+func (r TestScriptSetupActionOperation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptSetupActionOperation as JSON object with the members in the order of the fields
+func (r TestScriptSetupActionOperation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Type != nil {
+		e.key("type")
+		r.Type.appendJSON(e)
+	}
+	if r.Resource != nil {
+		e.key("resource")
+		e.string(*r.Resource)
+	}
+	if r.ResourceElement != nil {
+		e.key("_resource")
+		r.ResourceElement.appendJSON(e)
+	}
+	if r.Label != nil {
+		e.key("label")
+		e.string(*r.Label)
+	}
+	if r.LabelElement != nil {
+		e.key("_label")
+		r.LabelElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Accept != nil {
+		e.key("accept")
+		e.string(*r.Accept)
+	}
+	if r.AcceptElement != nil {
+		e.key("_accept")
+		r.AcceptElement.appendJSON(e)
+	}
+	if r.ContentType != nil {
+		e.key("contentType")
+		e.string(*r.ContentType)
+	}
+	if r.ContentTypeElement != nil {
+		e.key("_contentType")
+		r.ContentTypeElement.appendJSON(e)
+	}
+	if r.Destination != nil {
+		e.key("destination")
+		e.int(*r.Destination)
+	}
+	if r.DestinationElement != nil {
+		e.key("_destination")
+		r.DestinationElement.appendJSON(e)
+	}
+	e.key("encodeRequestUrl")
+	e.bool(r.EncodeRequestUrl)
+	if r.EncodeRequestUrlElement != nil {
+		e.key("_encodeRequestUrl")
+		r.EncodeRequestUrlElement.appendJSON(e)
+	}
+	if r.Method != nil {
+		e.key("method")
+		e.string(r.Method.Code())
+	}
+	if r.MethodElement != nil {
+		e.key("_method")
+		r.MethodElement.appendJSON(e)
+	}
+	if r.Origin != nil {
+		e.key("origin")
+		e.int(*r.Origin)
+	}
+	if r.OriginElement != nil {
+		e.key("_origin")
+		r.OriginElement.appendJSON(e)
+	}
+	if r.Params != nil {
+		e.key("params")
+		e.string(*r.Params)
+	}
+	if r.ParamsElement != nil {
+		e.key("_params")
+		r.ParamsElement.appendJSON(e)
+	}
+	if len(r.RequestHeader) > 0 {
+		e.key("requestHeader")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.RequestHeader {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.RequestId != nil {
+		e.key("requestId")
+		e.string(*r.RequestId)
+	}
+	if r.RequestIdElement != nil {
+		e.key("_requestId")
+		r.RequestIdElement.appendJSON(e)
+	}
+	if r.ResponseId != nil {
+		e.key("responseId")
+		e.string(*r.ResponseId)
+	}
+	if r.ResponseIdElement != nil {
+		e.key("_responseId")
+		r.ResponseIdElement.appendJSON(e)
+	}
+	if r.SourceId != nil {
+		e.key("sourceId")
+		e.string(*r.SourceId)
+	}
+	if r.SourceIdElement != nil {
+		e.key("_sourceId")
+		r.SourceIdElement.appendJSON(e)
+	}
+	if r.TargetId != nil {
+		e.key("targetId")
+		e.string(*r.TargetId)
+	}
+	if r.TargetIdElement != nil {
+		e.key("_targetId")
+		r.TargetIdElement.appendJSON(e)
+	}
+	if r.Url != nil {
+		e.key("url")
+		e.string(*r.Url)
+	}
+	if r.UrlElement != nil {
+		e.key("_url")
+		r.UrlElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptSetupActionOperation from JSON. Unknown members are ignored.
+func (r *TestScriptSetupActionOperation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptSetupActionOperation
+func (r *TestScriptSetupActionOperation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "type":
+			if d.null() {
+				r.Type = nil
+			} else {
+				var v Coding
+				v.decodeJSON(d)
+				r.Type = &v
+			}
+		case "resource":
+			if v, ok := d.string(); ok {
+				r.Resource = &v
+			} else {
+				r.Resource = nil
+			}
+		case "_resource":
+			r.ResourceElement = d.element()
+		case "label":
+			if v, ok := d.string(); ok {
+				r.Label = &v
+			} else {
+				r.Label = nil
+			}
+		case "_label":
+			r.LabelElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "accept":
+			if v, ok := d.string(); ok {
+				r.Accept = &v
+			} else {
+				r.Accept = nil
+			}
+		case "_accept":
+			r.AcceptElement = d.element()
+		case "contentType":
+			if v, ok := d.string(); ok {
+				r.ContentType = &v
+			} else {
+				r.ContentType = nil
+			}
+		case "_contentType":
+			r.ContentTypeElement = d.element()
+		case "destination":
+			if v, ok := d.int(); ok {
+				r.Destination = &v
+			} else {
+				r.Destination = nil
+			}
+		case "_destination":
+			r.DestinationElement = d.element()
+		case "encodeRequestUrl":
+			if v, ok := d.bool(); ok {
+				r.EncodeRequestUrl = v
+			}
+		case "_encodeRequestUrl":
+			r.EncodeRequestUrlElement = d.element()
+		case "method":
+			if d.null() {
+				r.Method = nil
+			} else {
+				var v TestScriptRequestMethodCode
+				d.unmarshal(&v)
+				r.Method = &v
+			}
+		case "_method":
+			r.MethodElement = d.element()
+		case "origin":
+			if v, ok := d.int(); ok {
+				r.Origin = &v
+			} else {
+				r.Origin = nil
+			}
+		case "_origin":
+			r.OriginElement = d.element()
+		case "params":
+			if v, ok := d.string(); ok {
+				r.Params = &v
+			} else {
+				r.Params = nil
+			}
+		case "_params":
+			r.ParamsElement = d.element()
+		case "requestHeader":
+			a := d.array()
+			r.RequestHeader = nil
+			for a.next() {
+				var v TestScriptSetupActionOperationRequestHeader
+				v.decodeJSON(d)
+				r.RequestHeader = append(r.RequestHeader, v)
+			}
+			if r.RequestHeader == nil && !a.isNull {
+				r.RequestHeader = []TestScriptSetupActionOperationRequestHeader{}
+			}
+		case "requestId":
+			if v, ok := d.string(); ok {
+				r.RequestId = &v
+			} else {
+				r.RequestId = nil
+			}
+		case "_requestId":
+			r.RequestIdElement = d.element()
+		case "responseId":
+			if v, ok := d.string(); ok {
+				r.ResponseId = &v
+			} else {
+				r.ResponseId = nil
+			}
+		case "_responseId":
+			r.ResponseIdElement = d.element()
+		case "sourceId":
+			if v, ok := d.string(); ok {
+				r.SourceId = &v
+			} else {
+				r.SourceId = nil
+			}
+		case "_sourceId":
+			r.SourceIdElement = d.element()
+		case "targetId":
+			if v, ok := d.string(); ok {
+				r.TargetId = &v
+			} else {
+				r.TargetId = nil
+			}
+		case "_targetId":
+			r.TargetIdElement = d.element()
+		case "url":
+			if v, ok := d.string(); ok {
+				r.Url = &v
+			} else {
+				r.Url = nil
+			}
+		case "_url":
+			r.UrlElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptSetupActionOperation which shares no memory with the original
+func (r TestScriptSetupActionOperation) DeepCopy() TestScriptSetupActionOperation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Type != nil {
+		v := r.Type.DeepCopy()
+		out.Type = &v
+	}
+	if r.Resource != nil {
+		v := *r.Resource
+		out.Resource = &v
+	}
+	if r.ResourceElement != nil {
+		v := r.ResourceElement.DeepCopy()
+		out.ResourceElement = &v
+	}
+	if r.Label != nil {
+		v := *r.Label
+		out.Label = &v
+	}
+	if r.LabelElement != nil {
+		v := r.LabelElement.DeepCopy()
+		out.LabelElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Accept != nil {
+		v := *r.Accept
+		out.Accept = &v
+	}
+	if r.AcceptElement != nil {
+		v := r.AcceptElement.DeepCopy()
+		out.AcceptElement = &v
+	}
+	if r.ContentType != nil {
+		v := *r.ContentType
+		out.ContentType = &v
+	}
+	if r.ContentTypeElement != nil {
+		v := r.ContentTypeElement.DeepCopy()
+		out.ContentTypeElement = &v
+	}
+	if r.Destination != nil {
+		v := *r.Destination
+		out.Destination = &v
+	}
+	if r.DestinationElement != nil {
+		v := r.DestinationElement.DeepCopy()
+		out.DestinationElement = &v
+	}
+	if r.EncodeRequestUrlElement != nil {
+		v := r.EncodeRequestUrlElement.DeepCopy()
+		out.EncodeRequestUrlElement = &v
+	}
+	if r.Method != nil {
+		v := *r.Method
+		out.Method = &v
+	}
+	if r.MethodElement != nil {
+		v := r.MethodElement.DeepCopy()
+		out.MethodElement = &v
+	}
+	if r.Origin != nil {
+		v := *r.Origin
+		out.Origin = &v
+	}
+	if r.OriginElement != nil {
+		v := r.OriginElement.DeepCopy()
+		out.OriginElement = &v
+	}
+	if r.Params != nil {
+		v := *r.Params
+		out.Params = &v
+	}
+	if r.ParamsElement != nil {
+		v := r.ParamsElement.DeepCopy()
+		out.ParamsElement = &v
+	}
+	if r.RequestHeader != nil {
+		out.RequestHeader = make([]TestScriptSetupActionOperationRequestHeader, len(r.RequestHeader))
+		for i := range r.RequestHeader {
+			out.RequestHeader[i] = r.RequestHeader[i].DeepCopy()
+		}
+	}
+	if r.RequestId != nil {
+		v := *r.RequestId
+		out.RequestId = &v
+	}
+	if r.RequestIdElement != nil {
+		v := r.RequestIdElement.DeepCopy()
+		out.RequestIdElement = &v
+	}
+	if r.ResponseId != nil {
+		v := *r.ResponseId
+		out.ResponseId = &v
+	}
+	if r.ResponseIdElement != nil {
+		v := r.ResponseIdElement.DeepCopy()
+		out.ResponseIdElement = &v
+	}
+	if r.SourceId != nil {
+		v := *r.SourceId
+		out.SourceId = &v
+	}
+	if r.SourceIdElement != nil {
+		v := r.SourceIdElement.DeepCopy()
+		out.SourceIdElement = &v
+	}
+	if r.TargetId != nil {
+		v := *r.TargetId
+		out.TargetId = &v
+	}
+	if r.TargetIdElement != nil {
+		v := r.TargetIdElement.DeepCopy()
+		out.TargetIdElement = &v
+	}
+	if r.Url != nil {
+		v := *r.Url
+		out.Url = &v
+	}
+	if r.UrlElement != nil {
+		v := r.UrlElement.DeepCopy()
+		out.UrlElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptSetupActionOperation) Equal(other TestScriptSetupActionOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.Equal(*other.Type) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && *r.Resource != *other.Resource {
+		return false
+	}
+	if (r.ResourceElement == nil) != (other.ResourceElement == nil) || r.ResourceElement != nil && !r.ResourceElement.Equal(*other.ResourceElement) {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && *r.Label != *other.Label {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.Equal(*other.LabelElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Accept == nil) != (other.Accept == nil) || r.Accept != nil && *r.Accept != *other.Accept {
+		return false
+	}
+	if (r.AcceptElement == nil) != (other.AcceptElement == nil) || r.AcceptElement != nil && !r.AcceptElement.Equal(*other.AcceptElement) {
+		return false
+	}
+	if (r.ContentType == nil) != (other.ContentType == nil) || r.ContentType != nil && *r.ContentType != *other.ContentType {
+		return false
+	}
+	if (r.ContentTypeElement == nil) != (other.ContentTypeElement == nil) || r.ContentTypeElement != nil && !r.ContentTypeElement.Equal(*other.ContentTypeElement) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && *r.Destination != *other.Destination {
+		return false
+	}
+	if (r.DestinationElement == nil) != (other.DestinationElement == nil) || r.DestinationElement != nil && !r.DestinationElement.Equal(*other.DestinationElement) {
+		return false
+	}
+	if r.EncodeRequestUrl != other.EncodeRequestUrl {
+		return false
+	}
+	if (r.EncodeRequestUrlElement == nil) != (other.EncodeRequestUrlElement == nil) || r.EncodeRequestUrlElement != nil && !r.EncodeRequestUrlElement.Equal(*other.EncodeRequestUrlElement) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && *r.Method != *other.Method {
+		return false
+	}
+	if (r.MethodElement == nil) != (other.MethodElement == nil) || r.MethodElement != nil && !r.MethodElement.Equal(*other.MethodElement) {
+		return false
+	}
+	if (r.Origin == nil) != (other.Origin == nil) || r.Origin != nil && *r.Origin != *other.Origin {
+		return false
+	}
+	if (r.OriginElement == nil) != (other.OriginElement == nil) || r.OriginElement != nil && !r.OriginElement.Equal(*other.OriginElement) {
+		return false
+	}
+	if (r.Params == nil) != (other.Params == nil) || r.Params != nil && *r.Params != *other.Params {
+		return false
+	}
+	if (r.ParamsElement == nil) != (other.ParamsElement == nil) || r.ParamsElement != nil && !r.ParamsElement.Equal(*other.ParamsElement) {
+		return false
+	}
+	if len(r.RequestHeader) != len(other.RequestHeader) {
+		return false
+	}
+	for i := range r.RequestHeader {
+		if !r.RequestHeader[i].Equal(other.RequestHeader[i]) {
+			return false
+		}
+	}
+	if (r.RequestId == nil) != (other.RequestId == nil) || r.RequestId != nil && *r.RequestId != *other.RequestId {
+		return false
+	}
+	if (r.RequestIdElement == nil) != (other.RequestIdElement == nil) || r.RequestIdElement != nil && !r.RequestIdElement.Equal(*other.RequestIdElement) {
+		return false
+	}
+	if (r.ResponseId == nil) != (other.ResponseId == nil) || r.ResponseId != nil && *r.ResponseId != *other.ResponseId {
+		return false
+	}
+	if (r.ResponseIdElement == nil) != (other.ResponseIdElement == nil) || r.ResponseIdElement != nil && !r.ResponseIdElement.Equal(*other.ResponseIdElement) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && *r.SourceId != *other.SourceId {
+		return false
+	}
+	if (r.SourceIdElement == nil) != (other.SourceIdElement == nil) || r.SourceIdElement != nil && !r.SourceIdElement.Equal(*other.SourceIdElement) {
+		return false
+	}
+	if (r.TargetId == nil) != (other.TargetId == nil) || r.TargetId != nil && *r.TargetId != *other.TargetId {
+		return false
+	}
+	if (r.TargetIdElement == nil) != (other.TargetIdElement == nil) || r.TargetIdElement != nil && !r.TargetIdElement.Equal(*other.TargetIdElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && *r.Url != *other.Url {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.Equal(*other.UrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptSetupActionOperation) EqualsDeep(other TestScriptSetupActionOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && !r.Type.EqualsDeep(*other.Type) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !equivalentString(*r.Resource, *other.Resource) {
+		return false
+	}
+	if (r.ResourceElement == nil) != (other.ResourceElement == nil) || r.ResourceElement != nil && !r.ResourceElement.EqualsDeep(*other.ResourceElement) {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && !equivalentString(*r.Label, *other.Label) {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.EqualsDeep(*other.LabelElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Accept == nil) != (other.Accept == nil) || r.Accept != nil && !equivalentString(*r.Accept, *other.Accept) {
+		return false
+	}
+	if (r.AcceptElement == nil) != (other.AcceptElement == nil) || r.AcceptElement != nil && !r.AcceptElement.EqualsDeep(*other.AcceptElement) {
+		return false
+	}
+	if (r.ContentType == nil) != (other.ContentType == nil) || r.ContentType != nil && !equivalentString(*r.ContentType, *other.ContentType) {
+		return false
+	}
+	if (r.ContentTypeElement == nil) != (other.ContentTypeElement == nil) || r.ContentTypeElement != nil && !r.ContentTypeElement.EqualsDeep(*other.ContentTypeElement) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && *r.Destination != *other.Destination {
+		return false
+	}
+	if (r.DestinationElement == nil) != (other.DestinationElement == nil) || r.DestinationElement != nil && !r.DestinationElement.EqualsDeep(*other.DestinationElement) {
+		return false
+	}
+	if r.EncodeRequestUrl != other.EncodeRequestUrl {
+		return false
+	}
+	if (r.EncodeRequestUrlElement == nil) != (other.EncodeRequestUrlElement == nil) || r.EncodeRequestUrlElement != nil && !r.EncodeRequestUrlElement.EqualsDeep(*other.EncodeRequestUrlElement) {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && *r.Method != *other.Method {
+		return false
+	}
+	if (r.MethodElement == nil) != (other.MethodElement == nil) || r.MethodElement != nil && !r.MethodElement.EqualsDeep(*other.MethodElement) {
+		return false
+	}
+	if (r.Origin == nil) != (other.Origin == nil) || r.Origin != nil && *r.Origin != *other.Origin {
+		return false
+	}
+	if (r.OriginElement == nil) != (other.OriginElement == nil) || r.OriginElement != nil && !r.OriginElement.EqualsDeep(*other.OriginElement) {
+		return false
+	}
+	if (r.Params == nil) != (other.Params == nil) || r.Params != nil && !equivalentString(*r.Params, *other.Params) {
+		return false
+	}
+	if (r.ParamsElement == nil) != (other.ParamsElement == nil) || r.ParamsElement != nil && !r.ParamsElement.EqualsDeep(*other.ParamsElement) {
+		return false
+	}
+	if !equivalentList(len(r.RequestHeader), len(other.RequestHeader), func(i, j int) bool {
+		return r.RequestHeader[i].EqualsDeep(other.RequestHeader[j])
+	}) {
+		return false
+	}
+	if (r.RequestId == nil) != (other.RequestId == nil) || r.RequestId != nil && !equivalentString(*r.RequestId, *other.RequestId) {
+		return false
+	}
+	if (r.RequestIdElement == nil) != (other.RequestIdElement == nil) || r.RequestIdElement != nil && !r.RequestIdElement.EqualsDeep(*other.RequestIdElement) {
+		return false
+	}
+	if (r.ResponseId == nil) != (other.ResponseId == nil) || r.ResponseId != nil && !equivalentString(*r.ResponseId, *other.ResponseId) {
+		return false
+	}
+	if (r.ResponseIdElement == nil) != (other.ResponseIdElement == nil) || r.ResponseIdElement != nil && !r.ResponseIdElement.EqualsDeep(*other.ResponseIdElement) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && !equivalentString(*r.SourceId, *other.SourceId) {
+		return false
+	}
+	if (r.SourceIdElement == nil) != (other.SourceIdElement == nil) || r.SourceIdElement != nil && !r.SourceIdElement.EqualsDeep(*other.SourceIdElement) {
+		return false
+	}
+	if (r.TargetId == nil) != (other.TargetId == nil) || r.TargetId != nil && !equivalentString(*r.TargetId, *other.TargetId) {
+		return false
+	}
+	if (r.TargetIdElement == nil) != (other.TargetIdElement == nil) || r.TargetIdElement != nil && !r.TargetIdElement.EqualsDeep(*other.TargetIdElement) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	if (r.UrlElement == nil) != (other.UrlElement == nil) || r.UrlElement != nil && !r.UrlElement.EqualsDeep(*other.UrlElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptSetupActionOperation) EqualsShallow(other TestScriptSetupActionOperation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !equivalentString(*r.Resource, *other.Resource) {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && !equivalentString(*r.Label, *other.Label) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Accept == nil) != (other.Accept == nil) || r.Accept != nil && !equivalentString(*r.Accept, *other.Accept) {
+		return false
+	}
+	if (r.ContentType == nil) != (other.ContentType == nil) || r.ContentType != nil && !equivalentString(*r.ContentType, *other.ContentType) {
+		return false
+	}
+	if (r.Destination == nil) != (other.Destination == nil) || r.Destination != nil && *r.Destination != *other.Destination {
+		return false
+	}
+	if r.EncodeRequestUrl != other.EncodeRequestUrl {
+		return false
+	}
+	if (r.Method == nil) != (other.Method == nil) || r.Method != nil && *r.Method != *other.Method {
+		return false
+	}
+	if (r.Origin == nil) != (other.Origin == nil) || r.Origin != nil && *r.Origin != *other.Origin {
+		return false
+	}
+	if (r.Params == nil) != (other.Params == nil) || r.Params != nil && !equivalentString(*r.Params, *other.Params) {
+		return false
+	}
+	if (r.RequestId == nil) != (other.RequestId == nil) || r.RequestId != nil && !equivalentString(*r.RequestId, *other.RequestId) {
+		return false
+	}
+	if (r.ResponseId == nil) != (other.ResponseId == nil) || r.ResponseId != nil && !equivalentString(*r.ResponseId, *other.ResponseId) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && !equivalentString(*r.SourceId, *other.SourceId) {
+		return false
+	}
+	if (r.TargetId == nil) != (other.TargetId == nil) || r.TargetId != nil && !equivalentString(*r.TargetId, *other.TargetId) {
+		return false
+	}
+	if (r.Url == nil) != (other.Url == nil) || r.Url != nil && !equivalentString(*r.Url, *other.Url) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptSetupActionOperation as FHIR XML
+func (r TestScriptSetupActionOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "type", r.Type); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "resource", r.Resource, r.ResourceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "label", r.Label, r.LabelElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "accept", r.Accept, r.AcceptElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "contentType", r.ContentType, r.ContentTypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "destination", r.Destination, r.DestinationElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "encodeRequestUrl", r.EncodeRequestUrl, r.EncodeRequestUrlElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "method", r.Method, r.MethodElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "origin", r.Origin, r.OriginElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "params", r.Params, r.ParamsElement); err != nil {
+		return err
+	}
+	for _, v := range r.RequestHeader {
+		if err := encodeXMLElement(e, "requestHeader", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "requestId", r.RequestId, r.RequestIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "responseId", r.ResponseId, r.ResponseIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sourceId", r.SourceId, r.SourceIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "targetId", r.TargetId, r.TargetIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "url", r.Url, r.UrlElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptSetupActionOperation from FHIR XML
+func (r *TestScriptSetupActionOperation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "type":
+				var v Coding
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = &v
+			case "resource":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Resource = &v
+				}
+				r.ResourceElement = element
+			case "label":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Label = &v
+				}
+				r.LabelElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "accept":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Accept = &v
+				}
+				r.AcceptElement = element
+			case "contentType":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ContentType = &v
+				}
+				r.ContentTypeElement = element
+			case "destination":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Destination = &v
+				}
+				r.DestinationElement = element
+			case "encodeRequestUrl":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.EncodeRequestUrl = v
+				}
+				r.EncodeRequestUrlElement = element
+			case "method":
+				var v TestScriptRequestMethodCode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Method = &v
+				}
+				r.MethodElement = element
+			case "origin":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Origin = &v
+				}
+				r.OriginElement = element
+			case "params":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Params = &v
+				}
+				r.ParamsElement = element
+			case "requestHeader":
+				var v TestScriptSetupActionOperationRequestHeader
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.RequestHeader = append(r.RequestHeader, v)
+			case "requestId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RequestId = &v
+				}
+				r.RequestIdElement = element
+			case "responseId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ResponseId = &v
+				}
+				r.ResponseIdElement = element
+			case "sourceId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SourceId = &v
+				}
+				r.SourceIdElement = element
+			case "targetId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.TargetId = &v
+				}
+				r.TargetIdElement = element
+			case "url":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Url = &v
+				}
+				r.UrlElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptSetupActionOperation as properties to the RDF node n
+func (r TestScriptSetupActionOperation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("TestScript.setup.action.operation.type", -1, r.Type)
+	n.primitive("TestScript.setup.action.operation.resource", -1, r.Resource, r.ResourceElement, "string")
+	n.primitive("TestScript.setup.action.operation.label", -1, r.Label, r.LabelElement, "string")
+	n.primitive("TestScript.setup.action.operation.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("TestScript.setup.action.operation.accept", -1, r.Accept, r.AcceptElement, "string")
+	n.primitive("TestScript.setup.action.operation.contentType", -1, r.ContentType, r.ContentTypeElement, "string")
+	n.primitive("TestScript.setup.action.operation.destination", -1, r.Destination, r.DestinationElement, "integer")
+	n.primitive("TestScript.setup.action.operation.encodeRequestUrl", -1, r.EncodeRequestUrl, r.EncodeRequestUrlElement, "boolean")
+	n.primitive("TestScript.setup.action.operation.method", -1, r.Method, r.MethodElement, "code")
+	n.primitive("TestScript.setup.action.operation.origin", -1, r.Origin, r.OriginElement, "integer")
+	n.primitive("TestScript.setup.action.operation.params", -1, r.Params, r.ParamsElement, "string")
+	for i, v := range r.RequestHeader {
+		n.element("TestScript.setup.action.operation.requestHeader", i, v)
+	}
+	n.primitive("TestScript.setup.action.operation.requestId", -1, r.RequestId, r.RequestIdElement, "string")
+	n.primitive("TestScript.setup.action.operation.responseId", -1, r.ResponseId, r.ResponseIdElement, "string")
+	n.primitive("TestScript.setup.action.operation.sourceId", -1, r.SourceId, r.SourceIdElement, "string")
+	n.primitive("TestScript.setup.action.operation.targetId", -1, r.TargetId, r.TargetIdElement, "string")
+	n.primitive("TestScript.setup.action.operation.url", -1, r.Url, r.UrlElement, "string")
+}
+
+// MarshalBSON marshals the given TestScriptSetupActionOperation as BSON document with the structure of its FHIR JSON
+func (r TestScriptSetupActionOperation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptSetupActionOperation from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptSetupActionOperation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptSetupActionOperation as protobuf message TestScriptSetupActionOperation of fhir.proto
+func (r TestScriptSetupActionOperation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptSetupActionOperation to the encoded message
+func (r TestScriptSetupActionOperation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Type != nil {
+		e.message(4, *r.Type)
+	}
+	if r.Resource != nil {
+		e.string(5, *r.Resource)
+	}
+	if r.Label != nil {
+		e.string(6, *r.Label)
+	}
+	if r.Description != nil {
+		e.string(7, *r.Description)
+	}
+	if r.Accept != nil {
+		e.string(8, *r.Accept)
+	}
+	if r.ContentType != nil {
+		e.string(9, *r.ContentType)
+	}
+	if r.Destination != nil {
+		e.varint(10, uint64(int64(*r.Destination)))
+	}
+	if r.EncodeRequestUrl {
+		e.varint(11, protoBool(r.EncodeRequestUrl))
+	}
+	if r.Method != nil {
+		e.varint(12, r.Method.protoNumber())
+	}
+	if r.Origin != nil {
+		e.varint(13, uint64(int64(*r.Origin)))
+	}
+	if r.Params != nil {
+		e.string(14, *r.Params)
+	}
+	for _, v := range r.RequestHeader {
+		e.message(15, v)
+	}
+	if r.RequestId != nil {
+		e.string(16, *r.RequestId)
+	}
+	if r.ResponseId != nil {
+		e.string(17, *r.ResponseId)
+	}
+	if r.SourceId != nil {
+		e.string(18, *r.SourceId)
+	}
+	if r.TargetId != nil {
+		e.string(19, *r.TargetId)
+	}
+	if r.Url != nil {
+		e.string(20, *r.Url)
+	}
+	if r.ResourceElement != nil {
+		e.message(21, *r.ResourceElement)
+	}
+	if r.LabelElement != nil {
+		e.message(22, *r.LabelElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(23, *r.DescriptionElement)
+	}
+	if r.AcceptElement != nil {
+		e.message(24, *r.AcceptElement)
+	}
+	if r.ContentTypeElement != nil {
+		e.message(25, *r.ContentTypeElement)
+	}
+	if r.DestinationElement != nil {
+		e.message(26, *r.DestinationElement)
+	}
+	if r.EncodeRequestUrlElement != nil {
+		e.message(27, *r.EncodeRequestUrlElement)
+	}
+	if r.MethodElement != nil {
+		e.message(28, *r.MethodElement)
+	}
+	if r.OriginElement != nil {
+		e.message(29, *r.OriginElement)
+	}
+	if r.ParamsElement != nil {
+		e.message(30, *r.ParamsElement)
+	}
+	if r.RequestIdElement != nil {
+		e.message(31, *r.RequestIdElement)
+	}
+	if r.ResponseIdElement != nil {
+		e.message(32, *r.ResponseIdElement)
+	}
+	if r.SourceIdElement != nil {
+		e.message(33, *r.SourceIdElement)
+	}
+	if r.TargetIdElement != nil {
+		e.message(34, *r.TargetIdElement)
+	}
+	if r.UrlElement != nil {
+		e.message(35, *r.UrlElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptSetupActionOperation from protobuf message TestScriptSetupActionOperation of fhir.proto
+func (r *TestScriptSetupActionOperation) UnmarshalProto(b []byte) error {
+	*r = TestScriptSetupActionOperation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Coding
+			d.message(&v)
+			r.Type = &v
+		case 5:
+			v := d.string()
+			r.Resource = &v
+		case 6:
+			v := d.string()
+			r.Label = &v
+		case 7:
+			v := d.string()
+			r.Description = &v
+		case 8:
+			v := d.string()
+			r.Accept = &v
+		case 9:
+			v := d.string()
+			r.ContentType = &v
+		case 10:
+			v := d.int()
+			r.Destination = &v
+		case 11:
+			r.EncodeRequestUrl = d.bool()
+		case 12:
+			var v TestScriptRequestMethodCode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Method = &v
+		case 13:
+			v := d.int()
+			r.Origin = &v
+		case 14:
+			v := d.string()
+			r.Params = &v
+		case 15:
+			var v TestScriptSetupActionOperationRequestHeader
+			d.message(&v)
+			r.RequestHeader = append(r.RequestHeader, v)
+		case 16:
+			v := d.string()
+			r.RequestId = &v
+		case 17:
+			v := d.string()
+			r.ResponseId = &v
+		case 18:
+			v := d.string()
+			r.SourceId = &v
+		case 19:
+			v := d.string()
+			r.TargetId = &v
+		case 20:
+			v := d.string()
+			r.Url = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.ResourceElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.LabelElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.AcceptElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.ContentTypeElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.DestinationElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.EncodeRequestUrlElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.MethodElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.OriginElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.ParamsElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.RequestIdElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.ResponseIdElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.SourceIdElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.TargetIdElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.UrlElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptSetupActionOperationRequestHeader as JSON into a byte slice
+func (r TestScriptSetupActionOperationRequestHeader) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptSetupActionOperationRequestHeader as JSON object with the members in the order of the fields
+func (r TestScriptSetupActionOperationRequestHeader) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("field")
+	e.string(r.Field)
+	if r.FieldElement != nil {
+		e.key("_field")
+		r.FieldElement.appendJSON(e)
+	}
+	e.key("value")
+	e.string(r.Value)
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptSetupActionOperationRequestHeader from JSON. Unknown members are ignored.
+func (r *TestScriptSetupActionOperationRequestHeader) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptSetupActionOperationRequestHeader
+func (r *TestScriptSetupActionOperationRequestHeader) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "field":
+			if v, ok := d.string(); ok {
+				r.Field = v
+			}
+		case "_field":
+			r.FieldElement = d.element()
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = v
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptSetupActionOperationRequestHeader which shares no memory with the original
+func (r TestScriptSetupActionOperationRequestHeader) DeepCopy() TestScriptSetupActionOperationRequestHeader {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.FieldElement != nil {
+		v := r.FieldElement.DeepCopy()
+		out.FieldElement = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptSetupActionOperationRequestHeader) Equal(other TestScriptSetupActionOperationRequestHeader) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if r.Field != other.Field {
+		return false
+	}
+	if (r.FieldElement == nil) != (other.FieldElement == nil) || r.FieldElement != nil && !r.FieldElement.Equal(*other.FieldElement) {
+		return false
+	}
+	if r.Value != other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptSetupActionOperationRequestHeader) EqualsDeep(other TestScriptSetupActionOperationRequestHeader) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Field, other.Field) {
+		return false
+	}
+	if (r.FieldElement == nil) != (other.FieldElement == nil) || r.FieldElement != nil && !r.FieldElement.EqualsDeep(*other.FieldElement) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptSetupActionOperationRequestHeader) EqualsShallow(other TestScriptSetupActionOperationRequestHeader) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentString(r.Field, other.Field) {
+		return false
+	}
+	if !equivalentString(r.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptSetupActionOperationRequestHeader as FHIR XML
+func (r TestScriptSetupActionOperationRequestHeader) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "field", r.Field, r.FieldElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptSetupActionOperationRequestHeader from FHIR XML
+func (r *TestScriptSetupActionOperationRequestHeader) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "field":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Field = v
+				}
+				r.FieldElement = element
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = v
+				}
+				r.ValueElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptSetupActionOperationRequestHeader as properties to the RDF node n
+func (r TestScriptSetupActionOperationRequestHeader) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.setup.action.operation.requestHeader.field", -1, r.Field, r.FieldElement, "string")
+	n.primitive("TestScript.setup.action.operation.requestHeader.value", -1, r.Value, r.ValueElement, "string")
+}
+
+// MarshalBSON marshals the given TestScriptSetupActionOperationRequestHeader as BSON document with the structure of its FHIR JSON
+func (r TestScriptSetupActionOperationRequestHeader) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptSetupActionOperationRequestHeader from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptSetupActionOperationRequestHeader) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptSetupActionOperationRequestHeader as protobuf message TestScriptSetupActionOperationRequestHeader of fhir.proto
+func (r TestScriptSetupActionOperationRequestHeader) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptSetupActionOperationRequestHeader to the encoded message
+func (r TestScriptSetupActionOperationRequestHeader) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Field != "" {
+		e.string(4, r.Field)
+	}
+	if r.Value != "" {
+		e.string(5, r.Value)
+	}
+	if r.FieldElement != nil {
+		e.message(6, *r.FieldElement)
+	}
+	if r.ValueElement != nil {
+		e.message(7, *r.ValueElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptSetupActionOperationRequestHeader from protobuf message TestScriptSetupActionOperationRequestHeader of fhir.proto
+func (r *TestScriptSetupActionOperationRequestHeader) UnmarshalProto(b []byte) error {
+	*r = TestScriptSetupActionOperationRequestHeader{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Field = d.string()
+		case 5:
+			r.Value = d.string()
+		case 6:
+			var v Element
+			d.message(&v)
+			r.FieldElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptSetupActionAssert as JSON into a byte slice
+func (r TestScriptSetupActionAssert) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptSetupActionAssert as JSON object with the members in the order of the fields
+func (r TestScriptSetupActionAssert) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Label != nil {
+		e.key("label")
+		e.string(*r.Label)
+	}
+	if r.LabelElement != nil {
+		e.key("_label")
+		r.LabelElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	if r.Direction != nil {
+		e.key("direction")
+		e.string(r.Direction.Code())
+	}
+	if r.DirectionElement != nil {
+		e.key("_direction")
+		r.DirectionElement.appendJSON(e)
+	}
+	if r.CompareToSourceId != nil {
+		e.key("compareToSourceId")
+		e.string(*r.CompareToSourceId)
+	}
+	if r.CompareToSourceIdElement != nil {
+		e.key("_compareToSourceId")
+		r.CompareToSourceIdElement.appendJSON(e)
+	}
+	if r.CompareToSourceExpression != nil {
+		e.key("compareToSourceExpression")
+		e.string(*r.CompareToSourceExpression)
+	}
+	if r.CompareToSourceExpressionElement != nil {
+		e.key("_compareToSourceExpression")
+		r.CompareToSourceExpressionElement.appendJSON(e)
+	}
+	if r.CompareToSourcePath != nil {
+		e.key("compareToSourcePath")
+		e.string(*r.CompareToSourcePath)
+	}
+	if r.CompareToSourcePathElement != nil {
+		e.key("_compareToSourcePath")
+		r.CompareToSourcePathElement.appendJSON(e)
+	}
+	if r.ContentType != nil {
+		e.key("contentType")
+		e.string(*r.ContentType)
+	}
+	if r.ContentTypeElement != nil {
+		e.key("_contentType")
+		r.ContentTypeElement.appendJSON(e)
+	}
+	if r.Expression != nil {
+		e.key("expression")
+		e.string(*r.Expression)
+	}
+	if r.ExpressionElement != nil {
+		e.key("_expression")
+		r.ExpressionElement.appendJSON(e)
+	}
+	if r.HeaderField != nil {
+		e.key("headerField")
+		e.string(*r.HeaderField)
+	}
+	if r.HeaderFieldElement != nil {
+		e.key("_headerField")
+		r.HeaderFieldElement.appendJSON(e)
+	}
+	if r.MinimumId != nil {
+		e.key("minimumId")
+		e.string(*r.MinimumId)
+	}
+	if r.MinimumIdElement != nil {
+		e.key("_minimumId")
+		r.MinimumIdElement.appendJSON(e)
+	}
+	if r.NavigationLinks != nil {
+		e.key("navigationLinks")
+		e.bool(*r.NavigationLinks)
+	}
+	if r.NavigationLinksElement != nil {
+		e.key("_navigationLinks")
+		r.NavigationLinksElement.appendJSON(e)
+	}
+	if r.Operator != nil {
+		e.key("operator")
+		e.string(r.Operator.Code())
+	}
+	if r.OperatorElement != nil {
+		e.key("_operator")
+		r.OperatorElement.appendJSON(e)
+	}
+	if r.Path != nil {
+		e.key("path")
+		e.string(*r.Path)
+	}
+	if r.PathElement != nil {
+		e.key("_path")
+		r.PathElement.appendJSON(e)
+	}
+	if r.RequestMethod != nil {
+		e.key("requestMethod")
+		e.string(r.RequestMethod.Code())
+	}
+	if r.RequestMethodElement != nil {
+		e.key("_requestMethod")
+		r.RequestMethodElement.appendJSON(e)
+	}
+	if r.RequestURL != nil {
+		e.key("requestURL")
+		e.string(*r.RequestURL)
+	}
+	if r.RequestURLElement != nil {
+		e.key("_requestURL")
+		r.RequestURLElement.appendJSON(e)
+	}
+	if r.Resource != nil {
+		e.key("resource")
+		e.string(*r.Resource)
+	}
+	if r.ResourceElement != nil {
+		e.key("_resource")
+		r.ResourceElement.appendJSON(e)
+	}
+	if r.Response != nil {
+		e.key("response")
+		e.string(r.Response.Code())
+	}
+	if r.ResponseElement != nil {
+		e.key("_response")
+		r.ResponseElement.appendJSON(e)
+	}
+	if r.ResponseCode != nil {
+		e.key("responseCode")
+		e.string(*r.ResponseCode)
+	}
+	if r.ResponseCodeElement != nil {
+		e.key("_responseCode")
+		r.ResponseCodeElement.appendJSON(e)
+	}
+	if r.SourceId != nil {
+		e.key("sourceId")
+		e.string(*r.SourceId)
+	}
+	if r.SourceIdElement != nil {
+		e.key("_sourceId")
+		r.SourceIdElement.appendJSON(e)
+	}
+	if r.ValidateProfileId != nil {
+		e.key("validateProfileId")
+		e.string(*r.ValidateProfileId)
+	}
+	if r.ValidateProfileIdElement != nil {
+		e.key("_validateProfileId")
+		r.ValidateProfileIdElement.appendJSON(e)
+	}
+	if r.Value != nil {
+		e.key("value")
+		e.string(*r.Value)
+	}
+	if r.ValueElement != nil {
+		e.key("_value")
+		r.ValueElement.appendJSON(e)
+	}
+	e.key("warningOnly")
+	e.bool(r.WarningOnly)
+	if r.WarningOnlyElement != nil {
+		e.key("_warningOnly")
+		r.WarningOnlyElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptSetupActionAssert from JSON. Unknown members are ignored.
+func (r *TestScriptSetupActionAssert) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptSetupActionAssert
+func (r *TestScriptSetupActionAssert) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "label":
+			if v, ok := d.string(); ok {
+				r.Label = &v
+			} else {
+				r.Label = nil
+			}
+		case "_label":
+			r.LabelElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "direction":
+			if d.null() {
+				r.Direction = nil
+			} else {
+				var v AssertionDirectionType
+				d.unmarshal(&v)
+				r.Direction = &v
+			}
+		case "_direction":
+			r.DirectionElement = d.element()
+		case "compareToSourceId":
+			if v, ok := d.string(); ok {
+				r.CompareToSourceId = &v
+			} else {
+				r.CompareToSourceId = nil
+			}
+		case "_compareToSourceId":
+			r.CompareToSourceIdElement = d.element()
+		case "compareToSourceExpression":
+			if v, ok := d.string(); ok {
+				r.CompareToSourceExpression = &v
+			} else {
+				r.CompareToSourceExpression = nil
+			}
+		case "_compareToSourceExpression":
+			r.CompareToSourceExpressionElement = d.element()
+		case "compareToSourcePath":
+			if v, ok := d.string(); ok {
+				r.CompareToSourcePath = &v
+			} else {
+				r.CompareToSourcePath = nil
+			}
+		case "_compareToSourcePath":
+			r.CompareToSourcePathElement = d.element()
+		case "contentType":
+			if v, ok := d.string(); ok {
+				r.ContentType = &v
+			} else {
+				r.ContentType = nil
+			}
+		case "_contentType":
+			r.ContentTypeElement = d.element()
+		case "expression":
+			if v, ok := d.string(); ok {
+				r.Expression = &v
+			} else {
+				r.Expression = nil
+			}
+		case "_expression":
+			r.ExpressionElement = d.element()
+		case "headerField":
+			if v, ok := d.string(); ok {
+				r.HeaderField = &v
+			} else {
+				r.HeaderField = nil
+			}
+		case "_headerField":
+			r.HeaderFieldElement = d.element()
+		case "minimumId":
+			if v, ok := d.string(); ok {
+				r.MinimumId = &v
+			} else {
+				r.MinimumId = nil
+			}
+		case "_minimumId":
+			r.MinimumIdElement = d.element()
+		case "navigationLinks":
+			if v, ok := d.bool(); ok {
+				r.NavigationLinks = &v
+			} else {
+				r.NavigationLinks = nil
+			}
+		case "_navigationLinks":
+			r.NavigationLinksElement = d.element()
+		case "operator":
+			if d.null() {
+				r.Operator = nil
+			} else {
+				var v AssertionOperatorType
+				d.unmarshal(&v)
+				r.Operator = &v
+			}
+		case "_operator":
+			r.OperatorElement = d.element()
+		case "path":
+			if v, ok := d.string(); ok {
+				r.Path = &v
+			} else {
+				r.Path = nil
+			}
+		case "_path":
+			r.PathElement = d.element()
+		case "requestMethod":
+			if d.null() {
+				r.RequestMethod = nil
+			} else {
+				var v TestScriptRequestMethodCode
+				d.unmarshal(&v)
+				r.RequestMethod = &v
+			}
+		case "_requestMethod":
+			r.RequestMethodElement = d.element()
+		case "requestURL":
+			if v, ok := d.string(); ok {
+				r.RequestURL = &v
+			} else {
+				r.RequestURL = nil
+			}
+		case "_requestURL":
+			r.RequestURLElement = d.element()
+		case "resource":
+			if v, ok := d.string(); ok {
+				r.Resource = &v
+			} else {
+				r.Resource = nil
+			}
+		case "_resource":
+			r.ResourceElement = d.element()
+		case "response":
+			if d.null() {
+				r.Response = nil
+			} else {
+				var v AssertionResponseTypes
+				d.unmarshal(&v)
+				r.Response = &v
+			}
+		case "_response":
+			r.ResponseElement = d.element()
+		case "responseCode":
+			if v, ok := d.string(); ok {
+				r.ResponseCode = &v
+			} else {
+				r.ResponseCode = nil
+			}
+		case "_responseCode":
+			r.ResponseCodeElement = d.element()
+		case "sourceId":
+			if v, ok := d.string(); ok {
+				r.SourceId = &v
+			} else {
+				r.SourceId = nil
+			}
+		case "_sourceId":
+			r.SourceIdElement = d.element()
+		case "validateProfileId":
+			if v, ok := d.string(); ok {
+				r.ValidateProfileId = &v
+			} else {
+				r.ValidateProfileId = nil
+			}
+		case "_validateProfileId":
+			r.ValidateProfileIdElement = d.element()
+		case "value":
+			if v, ok := d.string(); ok {
+				r.Value = &v
+			} else {
+				r.Value = nil
+			}
+		case "_value":
+			r.ValueElement = d.element()
+		case "warningOnly":
+			if v, ok := d.bool(); ok {
+				r.WarningOnly = v
+			}
+		case "_warningOnly":
+			r.WarningOnlyElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptSetupActionAssert which shares no memory with the original
+func (r TestScriptSetupActionAssert) DeepCopy() TestScriptSetupActionAssert {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Label != nil {
+		v := *r.Label
+		out.Label = &v
+	}
+	if r.LabelElement != nil {
+		v := r.LabelElement.DeepCopy()
+		out.LabelElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Direction != nil {
+		v := *r.Direction
+		out.Direction = &v
+	}
+	if r.DirectionElement != nil {
+		v := r.DirectionElement.DeepCopy()
+		out.DirectionElement = &v
+	}
+	if r.CompareToSourceId != nil {
+		v := *r.CompareToSourceId
+		out.CompareToSourceId = &v
+	}
+	if r.CompareToSourceIdElement != nil {
+		v := r.CompareToSourceIdElement.DeepCopy()
+		out.CompareToSourceIdElement = &v
+	}
+	if r.CompareToSourceExpression != nil {
+		v := *r.CompareToSourceExpression
+		out.CompareToSourceExpression = &v
+	}
+	if r.CompareToSourceExpressionElement != nil {
+		v := r.CompareToSourceExpressionElement.DeepCopy()
+		out.CompareToSourceExpressionElement = &v
+	}
+	if r.CompareToSourcePath != nil {
+		v := *r.CompareToSourcePath
+		out.CompareToSourcePath = &v
+	}
+	if r.CompareToSourcePathElement != nil {
+		v := r.CompareToSourcePathElement.DeepCopy()
+		out.CompareToSourcePathElement = &v
+	}
+	if r.ContentType != nil {
+		v := *r.ContentType
+		out.ContentType = &v
+	}
+	if r.ContentTypeElement != nil {
+		v := r.ContentTypeElement.DeepCopy()
+		out.ContentTypeElement = &v
+	}
+	if r.Expression != nil {
+		v := *r.Expression
+		out.Expression = &v
+	}
+	if r.ExpressionElement != nil {
+		v := r.ExpressionElement.DeepCopy()
+		out.ExpressionElement = &v
+	}
+	if r.HeaderField != nil {
+		v := *r.HeaderField
+		out.HeaderField = &v
+	}
+	if r.HeaderFieldElement != nil {
+		v := r.HeaderFieldElement.DeepCopy()
+		out.HeaderFieldElement = &v
+	}
+	if r.MinimumId != nil {
+		v := *r.MinimumId
+		out.MinimumId = &v
+	}
+	if r.MinimumIdElement != nil {
+		v := r.MinimumIdElement.DeepCopy()
+		out.MinimumIdElement = &v
+	}
+	if r.NavigationLinks != nil {
+		v := *r.NavigationLinks
+		out.NavigationLinks = &v
+	}
+	if r.NavigationLinksElement != nil {
+		v := r.NavigationLinksElement.DeepCopy()
+		out.NavigationLinksElement = &v
+	}
+	if r.Operator != nil {
+		v := *r.Operator
+		out.Operator = &v
+	}
+	if r.OperatorElement != nil {
+		v := r.OperatorElement.DeepCopy()
+		out.OperatorElement = &v
+	}
+	if r.Path != nil {
+		v := *r.Path
+		out.Path = &v
+	}
+	if r.PathElement != nil {
+		v := r.PathElement.DeepCopy()
+		out.PathElement = &v
+	}
+	if r.RequestMethod != nil {
+		v := *r.RequestMethod
+		out.RequestMethod = &v
+	}
+	if r.RequestMethodElement != nil {
+		v := r.RequestMethodElement.DeepCopy()
+		out.RequestMethodElement = &v
+	}
+	if r.RequestURL != nil {
+		v := *r.RequestURL
+		out.RequestURL = &v
+	}
+	if r.RequestURLElement != nil {
+		v := r.RequestURLElement.DeepCopy()
+		out.RequestURLElement = &v
+	}
+	if r.Resource != nil {
+		v := *r.Resource
+		out.Resource = &v
+	}
+	if r.ResourceElement != nil {
+		v := r.ResourceElement.DeepCopy()
+		out.ResourceElement = &v
+	}
+	if r.Response != nil {
+		v := *r.Response
+		out.Response = &v
+	}
+	if r.ResponseElement != nil {
+		v := r.ResponseElement.DeepCopy()
+		out.ResponseElement = &v
+	}
+	if r.ResponseCode != nil {
+		v := *r.ResponseCode
+		out.ResponseCode = &v
+	}
+	if r.ResponseCodeElement != nil {
+		v := r.ResponseCodeElement.DeepCopy()
+		out.ResponseCodeElement = &v
+	}
+	if r.SourceId != nil {
+		v := *r.SourceId
+		out.SourceId = &v
+	}
+	if r.SourceIdElement != nil {
+		v := r.SourceIdElement.DeepCopy()
+		out.SourceIdElement = &v
+	}
+	if r.ValidateProfileId != nil {
+		v := *r.ValidateProfileId
+		out.ValidateProfileId = &v
+	}
+	if r.ValidateProfileIdElement != nil {
+		v := r.ValidateProfileIdElement.DeepCopy()
+		out.ValidateProfileIdElement = &v
+	}
+	if r.Value != nil {
+		v := *r.Value
+		out.Value = &v
+	}
+	if r.ValueElement != nil {
+		v := r.ValueElement.DeepCopy()
+		out.ValueElement = &v
+	}
+	if r.WarningOnlyElement != nil {
+		v := r.WarningOnlyElement.DeepCopy()
+		out.WarningOnlyElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptSetupActionAssert) Equal(other TestScriptSetupActionAssert) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && *r.Label != *other.Label {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.Equal(*other.LabelElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Direction == nil) != (other.Direction == nil) || r.Direction != nil && *r.Direction != *other.Direction {
+		return false
+	}
+	if (r.DirectionElement == nil) != (other.DirectionElement == nil) || r.DirectionElement != nil && !r.DirectionElement.Equal(*other.DirectionElement) {
+		return false
+	}
+	if (r.CompareToSourceId == nil) != (other.CompareToSourceId == nil) || r.CompareToSourceId != nil && *r.CompareToSourceId != *other.CompareToSourceId {
+		return false
+	}
+	if (r.CompareToSourceIdElement == nil) != (other.CompareToSourceIdElement == nil) || r.CompareToSourceIdElement != nil && !r.CompareToSourceIdElement.Equal(*other.CompareToSourceIdElement) {
+		return false
+	}
+	if (r.CompareToSourceExpression == nil) != (other.CompareToSourceExpression == nil) || r.CompareToSourceExpression != nil && *r.CompareToSourceExpression != *other.CompareToSourceExpression {
+		return false
+	}
+	if (r.CompareToSourceExpressionElement == nil) != (other.CompareToSourceExpressionElement == nil) || r.CompareToSourceExpressionElement != nil && !r.CompareToSourceExpressionElement.Equal(*other.CompareToSourceExpressionElement) {
+		return false
+	}
+	if (r.CompareToSourcePath == nil) != (other.CompareToSourcePath == nil) || r.CompareToSourcePath != nil && *r.CompareToSourcePath != *other.CompareToSourcePath {
+		return false
+	}
+	if (r.CompareToSourcePathElement == nil) != (other.CompareToSourcePathElement == nil) || r.CompareToSourcePathElement != nil && !r.CompareToSourcePathElement.Equal(*other.CompareToSourcePathElement) {
+		return false
+	}
+	if (r.ContentType == nil) != (other.ContentType == nil) || r.ContentType != nil && *r.ContentType != *other.ContentType {
+		return false
+	}
+	if (r.ContentTypeElement == nil) != (other.ContentTypeElement == nil) || r.ContentTypeElement != nil && !r.ContentTypeElement.Equal(*other.ContentTypeElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && *r.Expression != *other.Expression {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.Equal(*other.ExpressionElement) {
+		return false
+	}
+	if (r.HeaderField == nil) != (other.HeaderField == nil) || r.HeaderField != nil && *r.HeaderField != *other.HeaderField {
+		return false
+	}
+	if (r.HeaderFieldElement == nil) != (other.HeaderFieldElement == nil) || r.HeaderFieldElement != nil && !r.HeaderFieldElement.Equal(*other.HeaderFieldElement) {
+		return false
+	}
+	if (r.MinimumId == nil) != (other.MinimumId == nil) || r.MinimumId != nil && *r.MinimumId != *other.MinimumId {
+		return false
+	}
+	if (r.MinimumIdElement == nil) != (other.MinimumIdElement == nil) || r.MinimumIdElement != nil && !r.MinimumIdElement.Equal(*other.MinimumIdElement) {
+		return false
+	}
+	if (r.NavigationLinks == nil) != (other.NavigationLinks == nil) || r.NavigationLinks != nil && *r.NavigationLinks != *other.NavigationLinks {
+		return false
+	}
+	if (r.NavigationLinksElement == nil) != (other.NavigationLinksElement == nil) || r.NavigationLinksElement != nil && !r.NavigationLinksElement.Equal(*other.NavigationLinksElement) {
+		return false
+	}
+	if (r.Operator == nil) != (other.Operator == nil) || r.Operator != nil && *r.Operator != *other.Operator {
+		return false
+	}
+	if (r.OperatorElement == nil) != (other.OperatorElement == nil) || r.OperatorElement != nil && !r.OperatorElement.Equal(*other.OperatorElement) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && *r.Path != *other.Path {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.Equal(*other.PathElement) {
+		return false
+	}
+	if (r.RequestMethod == nil) != (other.RequestMethod == nil) || r.RequestMethod != nil && *r.RequestMethod != *other.RequestMethod {
+		return false
+	}
+	if (r.RequestMethodElement == nil) != (other.RequestMethodElement == nil) || r.RequestMethodElement != nil && !r.RequestMethodElement.Equal(*other.RequestMethodElement) {
+		return false
+	}
+	if (r.RequestURL == nil) != (other.RequestURL == nil) || r.RequestURL != nil && *r.RequestURL != *other.RequestURL {
+		return false
+	}
+	if (r.RequestURLElement == nil) != (other.RequestURLElement == nil) || r.RequestURLElement != nil && !r.RequestURLElement.Equal(*other.RequestURLElement) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && *r.Resource != *other.Resource {
+		return false
+	}
+	if (r.ResourceElement == nil) != (other.ResourceElement == nil) || r.ResourceElement != nil && !r.ResourceElement.Equal(*other.ResourceElement) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && *r.Response != *other.Response {
+		return false
+	}
+	if (r.ResponseElement == nil) != (other.ResponseElement == nil) || r.ResponseElement != nil && !r.ResponseElement.Equal(*other.ResponseElement) {
+		return false
+	}
+	if (r.ResponseCode == nil) != (other.ResponseCode == nil) || r.ResponseCode != nil && *r.ResponseCode != *other.ResponseCode {
+		return false
+	}
+	if (r.ResponseCodeElement == nil) != (other.ResponseCodeElement == nil) || r.ResponseCodeElement != nil && !r.ResponseCodeElement.Equal(*other.ResponseCodeElement) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && *r.SourceId != *other.SourceId {
+		return false
+	}
+	if (r.SourceIdElement == nil) != (other.SourceIdElement == nil) || r.SourceIdElement != nil && !r.SourceIdElement.Equal(*other.SourceIdElement) {
+		return false
+	}
+	if (r.ValidateProfileId == nil) != (other.ValidateProfileId == nil) || r.ValidateProfileId != nil && *r.ValidateProfileId != *other.ValidateProfileId {
+		return false
+	}
+	if (r.ValidateProfileIdElement == nil) != (other.ValidateProfileIdElement == nil) || r.ValidateProfileIdElement != nil && !r.ValidateProfileIdElement.Equal(*other.ValidateProfileIdElement) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && *r.Value != *other.Value {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.Equal(*other.ValueElement) {
+		return false
+	}
+	if r.WarningOnly != other.WarningOnly {
+		return false
+	}
+	if (r.WarningOnlyElement == nil) != (other.WarningOnlyElement == nil) || r.WarningOnlyElement != nil && !r.WarningOnlyElement.Equal(*other.WarningOnlyElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptSetupActionAssert) EqualsDeep(other TestScriptSetupActionAssert) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && !equivalentString(*r.Label, *other.Label) {
+		return false
+	}
+	if (r.LabelElement == nil) != (other.LabelElement == nil) || r.LabelElement != nil && !r.LabelElement.EqualsDeep(*other.LabelElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if (r.Direction == nil) != (other.Direction == nil) || r.Direction != nil && *r.Direction != *other.Direction {
+		return false
+	}
+	if (r.DirectionElement == nil) != (other.DirectionElement == nil) || r.DirectionElement != nil && !r.DirectionElement.EqualsDeep(*other.DirectionElement) {
+		return false
+	}
+	if (r.CompareToSourceId == nil) != (other.CompareToSourceId == nil) || r.CompareToSourceId != nil && !equivalentString(*r.CompareToSourceId, *other.CompareToSourceId) {
+		return false
+	}
+	if (r.CompareToSourceIdElement == nil) != (other.CompareToSourceIdElement == nil) || r.CompareToSourceIdElement != nil && !r.CompareToSourceIdElement.EqualsDeep(*other.CompareToSourceIdElement) {
+		return false
+	}
+	if (r.CompareToSourceExpression == nil) != (other.CompareToSourceExpression == nil) || r.CompareToSourceExpression != nil && !equivalentString(*r.CompareToSourceExpression, *other.CompareToSourceExpression) {
+		return false
+	}
+	if (r.CompareToSourceExpressionElement == nil) != (other.CompareToSourceExpressionElement == nil) || r.CompareToSourceExpressionElement != nil && !r.CompareToSourceExpressionElement.EqualsDeep(*other.CompareToSourceExpressionElement) {
+		return false
+	}
+	if (r.CompareToSourcePath == nil) != (other.CompareToSourcePath == nil) || r.CompareToSourcePath != nil && !equivalentString(*r.CompareToSourcePath, *other.CompareToSourcePath) {
+		return false
+	}
+	if (r.CompareToSourcePathElement == nil) != (other.CompareToSourcePathElement == nil) || r.CompareToSourcePathElement != nil && !r.CompareToSourcePathElement.EqualsDeep(*other.CompareToSourcePathElement) {
+		return false
+	}
+	if (r.ContentType == nil) != (other.ContentType == nil) || r.ContentType != nil && !equivalentString(*r.ContentType, *other.ContentType) {
+		return false
+	}
+	if (r.ContentTypeElement == nil) != (other.ContentTypeElement == nil) || r.ContentTypeElement != nil && !r.ContentTypeElement.EqualsDeep(*other.ContentTypeElement) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.ExpressionElement == nil) != (other.ExpressionElement == nil) || r.ExpressionElement != nil && !r.ExpressionElement.EqualsDeep(*other.ExpressionElement) {
+		return false
+	}
+	if (r.HeaderField == nil) != (other.HeaderField == nil) || r.HeaderField != nil && !equivalentString(*r.HeaderField, *other.HeaderField) {
+		return false
+	}
+	if (r.HeaderFieldElement == nil) != (other.HeaderFieldElement == nil) || r.HeaderFieldElement != nil && !r.HeaderFieldElement.EqualsDeep(*other.HeaderFieldElement) {
+		return false
+	}
+	if (r.MinimumId == nil) != (other.MinimumId == nil) || r.MinimumId != nil && !equivalentString(*r.MinimumId, *other.MinimumId) {
+		return false
+	}
+	if (r.MinimumIdElement == nil) != (other.MinimumIdElement == nil) || r.MinimumIdElement != nil && !r.MinimumIdElement.EqualsDeep(*other.MinimumIdElement) {
+		return false
+	}
+	if (r.NavigationLinks == nil) != (other.NavigationLinks == nil) || r.NavigationLinks != nil && *r.NavigationLinks != *other.NavigationLinks {
+		return false
+	}
+	if (r.NavigationLinksElement == nil) != (other.NavigationLinksElement == nil) || r.NavigationLinksElement != nil && !r.NavigationLinksElement.EqualsDeep(*other.NavigationLinksElement) {
+		return false
+	}
+	if (r.Operator == nil) != (other.Operator == nil) || r.Operator != nil && *r.Operator != *other.Operator {
+		return false
+	}
+	if (r.OperatorElement == nil) != (other.OperatorElement == nil) || r.OperatorElement != nil && !r.OperatorElement.EqualsDeep(*other.OperatorElement) {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.PathElement == nil) != (other.PathElement == nil) || r.PathElement != nil && !r.PathElement.EqualsDeep(*other.PathElement) {
+		return false
+	}
+	if (r.RequestMethod == nil) != (other.RequestMethod == nil) || r.RequestMethod != nil && *r.RequestMethod != *other.RequestMethod {
+		return false
+	}
+	if (r.RequestMethodElement == nil) != (other.RequestMethodElement == nil) || r.RequestMethodElement != nil && !r.RequestMethodElement.EqualsDeep(*other.RequestMethodElement) {
+		return false
+	}
+	if (r.RequestURL == nil) != (other.RequestURL == nil) || r.RequestURL != nil && !equivalentString(*r.RequestURL, *other.RequestURL) {
+		return false
+	}
+	if (r.RequestURLElement == nil) != (other.RequestURLElement == nil) || r.RequestURLElement != nil && !r.RequestURLElement.EqualsDeep(*other.RequestURLElement) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !equivalentString(*r.Resource, *other.Resource) {
+		return false
+	}
+	if (r.ResourceElement == nil) != (other.ResourceElement == nil) || r.ResourceElement != nil && !r.ResourceElement.EqualsDeep(*other.ResourceElement) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && *r.Response != *other.Response {
+		return false
+	}
+	if (r.ResponseElement == nil) != (other.ResponseElement == nil) || r.ResponseElement != nil && !r.ResponseElement.EqualsDeep(*other.ResponseElement) {
+		return false
+	}
+	if (r.ResponseCode == nil) != (other.ResponseCode == nil) || r.ResponseCode != nil && !equivalentString(*r.ResponseCode, *other.ResponseCode) {
+		return false
+	}
+	if (r.ResponseCodeElement == nil) != (other.ResponseCodeElement == nil) || r.ResponseCodeElement != nil && !r.ResponseCodeElement.EqualsDeep(*other.ResponseCodeElement) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && !equivalentString(*r.SourceId, *other.SourceId) {
+		return false
+	}
+	if (r.SourceIdElement == nil) != (other.SourceIdElement == nil) || r.SourceIdElement != nil && !r.SourceIdElement.EqualsDeep(*other.SourceIdElement) {
+		return false
+	}
+	if (r.ValidateProfileId == nil) != (other.ValidateProfileId == nil) || r.ValidateProfileId != nil && !equivalentString(*r.ValidateProfileId, *other.ValidateProfileId) {
+		return false
+	}
+	if (r.ValidateProfileIdElement == nil) != (other.ValidateProfileIdElement == nil) || r.ValidateProfileIdElement != nil && !r.ValidateProfileIdElement.EqualsDeep(*other.ValidateProfileIdElement) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentString(*r.Value, *other.Value) {
+		return false
+	}
+	if (r.ValueElement == nil) != (other.ValueElement == nil) || r.ValueElement != nil && !r.ValueElement.EqualsDeep(*other.ValueElement) {
+		return false
+	}
+	if r.WarningOnly != other.WarningOnly {
+		return false
+	}
+	if (r.WarningOnlyElement == nil) != (other.WarningOnlyElement == nil) || r.WarningOnlyElement != nil && !r.WarningOnlyElement.EqualsDeep(*other.WarningOnlyElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptSetupActionAssert) EqualsShallow(other TestScriptSetupActionAssert) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Label == nil) != (other.Label == nil) || r.Label != nil && !equivalentString(*r.Label, *other.Label) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.Direction == nil) != (other.Direction == nil) || r.Direction != nil && *r.Direction != *other.Direction {
+		return false
+	}
+	if (r.CompareToSourceId == nil) != (other.CompareToSourceId == nil) || r.CompareToSourceId != nil && !equivalentString(*r.CompareToSourceId, *other.CompareToSourceId) {
+		return false
+	}
+	if (r.CompareToSourceExpression == nil) != (other.CompareToSourceExpression == nil) || r.CompareToSourceExpression != nil && !equivalentString(*r.CompareToSourceExpression, *other.CompareToSourceExpression) {
+		return false
+	}
+	if (r.CompareToSourcePath == nil) != (other.CompareToSourcePath == nil) || r.CompareToSourcePath != nil && !equivalentString(*r.CompareToSourcePath, *other.CompareToSourcePath) {
+		return false
+	}
+	if (r.ContentType == nil) != (other.ContentType == nil) || r.ContentType != nil && !equivalentString(*r.ContentType, *other.ContentType) {
+		return false
+	}
+	if (r.Expression == nil) != (other.Expression == nil) || r.Expression != nil && !equivalentString(*r.Expression, *other.Expression) {
+		return false
+	}
+	if (r.HeaderField == nil) != (other.HeaderField == nil) || r.HeaderField != nil && !equivalentString(*r.HeaderField, *other.HeaderField) {
+		return false
+	}
+	if (r.MinimumId == nil) != (other.MinimumId == nil) || r.MinimumId != nil && !equivalentString(*r.MinimumId, *other.MinimumId) {
+		return false
+	}
+	if (r.NavigationLinks == nil) != (other.NavigationLinks == nil) || r.NavigationLinks != nil && *r.NavigationLinks != *other.NavigationLinks {
+		return false
+	}
+	if (r.Operator == nil) != (other.Operator == nil) || r.Operator != nil && *r.Operator != *other.Operator {
+		return false
+	}
+	if (r.Path == nil) != (other.Path == nil) || r.Path != nil && !equivalentString(*r.Path, *other.Path) {
+		return false
+	}
+	if (r.RequestMethod == nil) != (other.RequestMethod == nil) || r.RequestMethod != nil && *r.RequestMethod != *other.RequestMethod {
+		return false
+	}
+	if (r.RequestURL == nil) != (other.RequestURL == nil) || r.RequestURL != nil && !equivalentString(*r.RequestURL, *other.RequestURL) {
+		return false
+	}
+	if (r.Resource == nil) != (other.Resource == nil) || r.Resource != nil && !equivalentString(*r.Resource, *other.Resource) {
+		return false
+	}
+	if (r.Response == nil) != (other.Response == nil) || r.Response != nil && *r.Response != *other.Response {
+		return false
+	}
+	if (r.ResponseCode == nil) != (other.ResponseCode == nil) || r.ResponseCode != nil && !equivalentString(*r.ResponseCode, *other.ResponseCode) {
+		return false
+	}
+	if (r.SourceId == nil) != (other.SourceId == nil) || r.SourceId != nil && !equivalentString(*r.SourceId, *other.SourceId) {
+		return false
+	}
+	if (r.ValidateProfileId == nil) != (other.ValidateProfileId == nil) || r.ValidateProfileId != nil && !equivalentString(*r.ValidateProfileId, *other.ValidateProfileId) {
+		return false
+	}
+	if (r.Value == nil) != (other.Value == nil) || r.Value != nil && !equivalentString(*r.Value, *other.Value) {
+		return false
+	}
+	if r.WarningOnly != other.WarningOnly {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptSetupActionAssert as FHIR XML
+func (r TestScriptSetupActionAssert) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "label", r.Label, r.LabelElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "direction", r.Direction, r.DirectionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "compareToSourceId", r.CompareToSourceId, r.CompareToSourceIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "compareToSourceExpression", r.CompareToSourceExpression, r.CompareToSourceExpressionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "compareToSourcePath", r.CompareToSourcePath, r.CompareToSourcePathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "contentType", r.ContentType, r.ContentTypeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "expression", r.Expression, r.ExpressionElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "headerField", r.HeaderField, r.HeaderFieldElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "minimumId", r.MinimumId, r.MinimumIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "navigationLinks", r.NavigationLinks, r.NavigationLinksElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "operator", r.Operator, r.OperatorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "path", r.Path, r.PathElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "requestMethod", r.RequestMethod, r.RequestMethodElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "requestURL", r.RequestURL, r.RequestURLElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "resource", r.Resource, r.ResourceElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "response", r.Response, r.ResponseElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "responseCode", r.ResponseCode, r.ResponseCodeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sourceId", r.SourceId, r.SourceIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "validateProfileId", r.ValidateProfileId, r.ValidateProfileIdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "value", r.Value, r.ValueElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "warningOnly", r.WarningOnly, r.WarningOnlyElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptSetupActionAssert from FHIR XML
+func (r *TestScriptSetupActionAssert) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "label":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Label = &v
+				}
+				r.LabelElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "direction":
+				var v AssertionDirectionType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Direction = &v
+				}
+				r.DirectionElement = element
+			case "compareToSourceId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CompareToSourceId = &v
+				}
+				r.CompareToSourceIdElement = element
+			case "compareToSourceExpression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CompareToSourceExpression = &v
+				}
+				r.CompareToSourceExpressionElement = element
+			case "compareToSourcePath":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.CompareToSourcePath = &v
+				}
+				r.CompareToSourcePathElement = element
+			case "contentType":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ContentType = &v
+				}
+				r.ContentTypeElement = element
+			case "expression":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Expression = &v
+				}
+				r.ExpressionElement = element
+			case "headerField":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.HeaderField = &v
+				}
+				r.HeaderFieldElement = element
+			case "minimumId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.MinimumId = &v
+				}
+				r.MinimumIdElement = element
+			case "navigationLinks":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NavigationLinks = &v
+				}
+				r.NavigationLinksElement = element
+			case "operator":
+				var v AssertionOperatorType
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Operator = &v
+				}
+				r.OperatorElement = element
+			case "path":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Path = &v
+				}
+				r.PathElement = element
+			case "requestMethod":
+				var v TestScriptRequestMethodCode
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RequestMethod = &v
+				}
+				r.RequestMethodElement = element
+			case "requestURL":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.RequestURL = &v
+				}
+				r.RequestURLElement = element
+			case "resource":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Resource = &v
+				}
+				r.ResourceElement = element
+			case "response":
+				var v AssertionResponseTypes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Response = &v
+				}
+				r.ResponseElement = element
+			case "responseCode":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ResponseCode = &v
+				}
+				r.ResponseCodeElement = element
+			case "sourceId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SourceId = &v
+				}
+				r.SourceIdElement = element
+			case "validateProfileId":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValidateProfileId = &v
+				}
+				r.ValidateProfileIdElement = element
+			case "value":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Value = &v
+				}
+				r.ValueElement = element
+			case "warningOnly":
+				var v bool
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.WarningOnly = v
+				}
+				r.WarningOnlyElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptSetupActionAssert as properties to the RDF node n
+func (r TestScriptSetupActionAssert) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.setup.action.assert.label", -1, r.Label, r.LabelElement, "string")
+	n.primitive("TestScript.setup.action.assert.description", -1, r.Description, r.DescriptionElement, "string")
+	n.primitive("TestScript.setup.action.assert.direction", -1, r.Direction, r.DirectionElement, "code")
+	n.primitive("TestScript.setup.action.assert.compareToSourceId", -1, r.CompareToSourceId, r.CompareToSourceIdElement, "string")
+	n.primitive("TestScript.setup.action.assert.compareToSourceExpression", -1, r.CompareToSourceExpression, r.CompareToSourceExpressionElement, "string")
+	n.primitive("TestScript.setup.action.assert.compareToSourcePath", -1, r.CompareToSourcePath, r.CompareToSourcePathElement, "string")
+	n.primitive("TestScript.setup.action.assert.contentType", -1, r.ContentType, r.ContentTypeElement, "string")
+	n.primitive("TestScript.setup.action.assert.expression", -1, r.Expression, r.ExpressionElement, "string")
+	n.primitive("TestScript.setup.action.assert.headerField", -1, r.HeaderField, r.HeaderFieldElement, "string")
+	n.primitive("TestScript.setup.action.assert.minimumId", -1, r.MinimumId, r.MinimumIdElement, "string")
+	n.primitive("TestScript.setup.action.assert.navigationLinks", -1, r.NavigationLinks, r.NavigationLinksElement, "boolean")
+	n.primitive("TestScript.setup.action.assert.operator", -1, r.Operator, r.OperatorElement, "code")
+	n.primitive("TestScript.setup.action.assert.path", -1, r.Path, r.PathElement, "string")
+	n.primitive("TestScript.setup.action.assert.requestMethod", -1, r.RequestMethod, r.RequestMethodElement, "code")
+	n.primitive("TestScript.setup.action.assert.requestURL", -1, r.RequestURL, r.RequestURLElement, "string")
+	n.primitive("TestScript.setup.action.assert.resource", -1, r.Resource, r.ResourceElement, "string")
+	n.primitive("TestScript.setup.action.assert.response", -1, r.Response, r.ResponseElement, "code")
+	n.primitive("TestScript.setup.action.assert.responseCode", -1, r.ResponseCode, r.ResponseCodeElement, "string")
+	n.primitive("TestScript.setup.action.assert.sourceId", -1, r.SourceId, r.SourceIdElement, "string")
+	n.primitive("TestScript.setup.action.assert.validateProfileId", -1, r.ValidateProfileId, r.ValidateProfileIdElement, "string")
+	n.primitive("TestScript.setup.action.assert.value", -1, r.Value, r.ValueElement, "string")
+	n.primitive("TestScript.setup.action.assert.warningOnly", -1, r.WarningOnly, r.WarningOnlyElement, "boolean")
+}
+
+// MarshalBSON marshals the given TestScriptSetupActionAssert as BSON document with the structure of its FHIR JSON
+func (r TestScriptSetupActionAssert) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptSetupActionAssert from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptSetupActionAssert) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptSetupActionAssert as protobuf message TestScriptSetupActionAssert of fhir.proto
+func (r TestScriptSetupActionAssert) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptSetupActionAssert to the encoded message
+func (r TestScriptSetupActionAssert) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Label != nil {
+		e.string(4, *r.Label)
+	}
+	if r.Description != nil {
+		e.string(5, *r.Description)
+	}
+	if r.Direction != nil {
+		e.varint(6, r.Direction.protoNumber())
+	}
+	if r.CompareToSourceId != nil {
+		e.string(7, *r.CompareToSourceId)
+	}
+	if r.CompareToSourceExpression != nil {
+		e.string(8, *r.CompareToSourceExpression)
+	}
+	if r.CompareToSourcePath != nil {
+		e.string(9, *r.CompareToSourcePath)
+	}
+	if r.ContentType != nil {
+		e.string(10, *r.ContentType)
+	}
+	if r.Expression != nil {
+		e.string(11, *r.Expression)
+	}
+	if r.HeaderField != nil {
+		e.string(12, *r.HeaderField)
+	}
+	if r.MinimumId != nil {
+		e.string(13, *r.MinimumId)
+	}
+	if r.NavigationLinks != nil {
+		e.varint(14, protoBool(*r.NavigationLinks))
+	}
+	if r.Operator != nil {
+		e.varint(15, r.Operator.protoNumber())
+	}
+	if r.Path != nil {
+		e.string(16, *r.Path)
+	}
+	if r.RequestMethod != nil {
+		e.varint(17, r.RequestMethod.protoNumber())
+	}
+	if r.RequestURL != nil {
+		e.string(18, *r.RequestURL)
+	}
+	if r.Resource != nil {
+		e.string(19, *r.Resource)
+	}
+	if r.Response != nil {
+		e.varint(20, r.Response.protoNumber())
+	}
+	if r.ResponseCode != nil {
+		e.string(21, *r.ResponseCode)
+	}
+	if r.SourceId != nil {
+		e.string(22, *r.SourceId)
+	}
+	if r.ValidateProfileId != nil {
+		e.string(23, *r.ValidateProfileId)
+	}
+	if r.Value != nil {
+		e.string(24, *r.Value)
+	}
+	if r.WarningOnly {
+		e.varint(25, protoBool(r.WarningOnly))
+	}
+	if r.LabelElement != nil {
+		e.message(26, *r.LabelElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(27, *r.DescriptionElement)
+	}
+	if r.DirectionElement != nil {
+		e.message(28, *r.DirectionElement)
+	}
+	if r.CompareToSourceIdElement != nil {
+		e.message(29, *r.CompareToSourceIdElement)
+	}
+	if r.CompareToSourceExpressionElement != nil {
+		e.message(30, *r.CompareToSourceExpressionElement)
+	}
+	if r.CompareToSourcePathElement != nil {
+		e.message(31, *r.CompareToSourcePathElement)
+	}
+	if r.ContentTypeElement != nil {
+		e.message(32, *r.ContentTypeElement)
+	}
+	if r.ExpressionElement != nil {
+		e.message(33, *r.ExpressionElement)
+	}
+	if r.HeaderFieldElement != nil {
+		e.message(34, *r.HeaderFieldElement)
+	}
+	if r.MinimumIdElement != nil {
+		e.message(35, *r.MinimumIdElement)
+	}
+	if r.NavigationLinksElement != nil {
+		e.message(36, *r.NavigationLinksElement)
+	}
+	if r.OperatorElement != nil {
+		e.message(37, *r.OperatorElement)
+	}
+	if r.PathElement != nil {
+		e.message(38, *r.PathElement)
+	}
+	if r.RequestMethodElement != nil {
+		e.message(39, *r.RequestMethodElement)
+	}
+	if r.RequestURLElement != nil {
+		e.message(40, *r.RequestURLElement)
+	}
+	if r.ResourceElement != nil {
+		e.message(41, *r.ResourceElement)
+	}
+	if r.ResponseElement != nil {
+		e.message(42, *r.ResponseElement)
+	}
+	if r.ResponseCodeElement != nil {
+		e.message(43, *r.ResponseCodeElement)
+	}
+	if r.SourceIdElement != nil {
+		e.message(44, *r.SourceIdElement)
+	}
+	if r.ValidateProfileIdElement != nil {
+		e.message(45, *r.ValidateProfileIdElement)
+	}
+	if r.ValueElement != nil {
+		e.message(46, *r.ValueElement)
+	}
+	if r.WarningOnlyElement != nil {
+		e.message(47, *r.WarningOnlyElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptSetupActionAssert from protobuf message TestScriptSetupActionAssert of fhir.proto
+func (r *TestScriptSetupActionAssert) UnmarshalProto(b []byte) error {
+	*r = TestScriptSetupActionAssert{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Label = &v
+		case 5:
+			v := d.string()
+			r.Description = &v
+		case 6:
+			var v AssertionDirectionType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Direction = &v
+		case 7:
+			v := d.string()
+			r.CompareToSourceId = &v
+		case 8:
+			v := d.string()
+			r.CompareToSourceExpression = &v
+		case 9:
+			v := d.string()
+			r.CompareToSourcePath = &v
+		case 10:
+			v := d.string()
+			r.ContentType = &v
+		case 11:
+			v := d.string()
+			r.Expression = &v
+		case 12:
+			v := d.string()
+			r.HeaderField = &v
+		case 13:
+			v := d.string()
+			r.MinimumId = &v
+		case 14:
+			v := d.bool()
+			r.NavigationLinks = &v
+		case 15:
+			var v AssertionOperatorType
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Operator = &v
+		case 16:
+			v := d.string()
+			r.Path = &v
+		case 17:
+			var v TestScriptRequestMethodCode
+			d.enum(d.varint(), v.setProtoNumber)
+			r.RequestMethod = &v
+		case 18:
+			v := d.string()
+			r.RequestURL = &v
+		case 19:
+			v := d.string()
+			r.Resource = &v
+		case 20:
+			var v AssertionResponseTypes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Response = &v
+		case 21:
+			v := d.string()
+			r.ResponseCode = &v
+		case 22:
+			v := d.string()
+			r.SourceId = &v
+		case 23:
+			v := d.string()
+			r.ValidateProfileId = &v
+		case 24:
+			v := d.string()
+			r.Value = &v
+		case 25:
+			r.WarningOnly = d.bool()
+		case 26:
+			var v Element
+			d.message(&v)
+			r.LabelElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.DirectionElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.CompareToSourceIdElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.CompareToSourceExpressionElement = &v
+		case 31:
+			var v Element
+			d.message(&v)
+			r.CompareToSourcePathElement = &v
+		case 32:
+			var v Element
+			d.message(&v)
+			r.ContentTypeElement = &v
+		case 33:
+			var v Element
+			d.message(&v)
+			r.ExpressionElement = &v
+		case 34:
+			var v Element
+			d.message(&v)
+			r.HeaderFieldElement = &v
+		case 35:
+			var v Element
+			d.message(&v)
+			r.MinimumIdElement = &v
+		case 36:
+			var v Element
+			d.message(&v)
+			r.NavigationLinksElement = &v
+		case 37:
+			var v Element
+			d.message(&v)
+			r.OperatorElement = &v
+		case 38:
+			var v Element
+			d.message(&v)
+			r.PathElement = &v
+		case 39:
+			var v Element
+			d.message(&v)
+			r.RequestMethodElement = &v
+		case 40:
+			var v Element
+			d.message(&v)
+			r.RequestURLElement = &v
+		case 41:
+			var v Element
+			d.message(&v)
+			r.ResourceElement = &v
+		case 42:
+			var v Element
+			d.message(&v)
+			r.ResponseElement = &v
+		case 43:
+			var v Element
+			d.message(&v)
+			r.ResponseCodeElement = &v
+		case 44:
+			var v Element
+			d.message(&v)
+			r.SourceIdElement = &v
+		case 45:
+			var v Element
+			d.message(&v)
+			r.ValidateProfileIdElement = &v
+		case 46:
+			var v Element
+			d.message(&v)
+			r.ValueElement = &v
+		case 47:
+			var v Element
+			d.message(&v)
+			r.WarningOnlyElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptTest as JSON into a byte slice
+func (r TestScriptTest) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptTest as JSON object with the members in the order of the fields
+func (r TestScriptTest) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Name != nil {
+		e.key("name")
+		e.string(*r.Name)
+	}
+	if r.NameElement != nil {
+		e.key("_name")
+		r.NameElement.appendJSON(e)
+	}
+	if r.Description != nil {
+		e.key("description")
+		e.string(*r.Description)
+	}
+	if r.DescriptionElement != nil {
+		e.key("_description")
+		r.DescriptionElement.appendJSON(e)
+	}
+	e.key("action")
+	if r.Action == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptTest from JSON. Unknown members are ignored.
+func (r *TestScriptTest) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptTest
+func (r *TestScriptTest) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "name":
+			if v, ok := d.string(); ok {
+				r.Name = &v
+			} else {
+				r.Name = nil
+			}
+		case "_name":
+			r.NameElement = d.element()
+		case "description":
+			if v, ok := d.string(); ok {
+				r.Description = &v
+			} else {
+				r.Description = nil
+			}
+		case "_description":
+			r.DescriptionElement = d.element()
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v TestScriptTestAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []TestScriptTestAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptTest which shares no memory with the original
+func (r TestScriptTest) DeepCopy() TestScriptTest {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Name != nil {
+		v := *r.Name
+		out.Name = &v
+	}
+	if r.NameElement != nil {
+		v := r.NameElement.DeepCopy()
+		out.NameElement = &v
+	}
+	if r.Description != nil {
+		v := *r.Description
+		out.Description = &v
+	}
+	if r.DescriptionElement != nil {
+		v := r.DescriptionElement.DeepCopy()
+		out.DescriptionElement = &v
+	}
+	if r.Action != nil {
+		out.Action = make([]TestScriptTestAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptTest) Equal(other TestScriptTest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.Equal(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && *r.Description != *other.Description {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.Equal(*other.DescriptionElement) {
+		return false
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptTest) EqualsDeep(other TestScriptTest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.NameElement == nil) != (other.NameElement == nil) || r.NameElement != nil && !r.NameElement.EqualsDeep(*other.NameElement) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	if (r.DescriptionElement == nil) != (other.DescriptionElement == nil) || r.DescriptionElement != nil && !r.DescriptionElement.EqualsDeep(*other.DescriptionElement) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptTest) EqualsShallow(other TestScriptTest) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && !equivalentString(*r.Name, *other.Name) {
+		return false
+	}
+	if (r.Description == nil) != (other.Description == nil) || r.Description != nil && !equivalentString(*r.Description, *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptTest as FHIR XML
+func (r TestScriptTest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "name", r.Name, r.NameElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "description", r.Description, r.DescriptionElement); err != nil {
+		return err
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptTest from FHIR XML
+func (r *TestScriptTest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "name":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Name = &v
+				}
+				r.NameElement = element
+			case "description":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Description = &v
+				}
+				r.DescriptionElement = element
+			case "action":
+				var v TestScriptTestAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptTest as properties to the RDF node n
+func (r TestScriptTest) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("TestScript.test.name", -1, r.Name, r.NameElement, "string")
+	n.primitive("TestScript.test.description", -1, r.Description, r.DescriptionElement, "string")
+	for i, v := range r.Action {
+		n.element("TestScript.test.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given TestScriptTest as BSON document with the structure of its FHIR JSON
+func (r TestScriptTest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptTest from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptTest) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptTest as protobuf message TestScriptTest of fhir.proto
+func (r TestScriptTest) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptTest to the encoded message
+func (r TestScriptTest) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Name != nil {
+		e.string(4, *r.Name)
+	}
+	if r.Description != nil {
+		e.string(5, *r.Description)
+	}
+	for _, v := range r.Action {
+		e.message(6, v)
+	}
+	if r.NameElement != nil {
+		e.message(7, *r.NameElement)
+	}
+	if r.DescriptionElement != nil {
+		e.message(8, *r.DescriptionElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptTest from protobuf message TestScriptTest of fhir.proto
+func (r *TestScriptTest) UnmarshalProto(b []byte) error {
+	*r = TestScriptTest{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			v := d.string()
+			r.Name = &v
+		case 5:
+			v := d.string()
+			r.Description = &v
+		case 6:
+			var v TestScriptTestAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		case 7:
+			var v Element
+			d.message(&v)
+			r.NameElement = &v
+		case 8:
+			var v Element
+			d.message(&v)
+			r.DescriptionElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptTestAction as JSON into a byte slice
+func (r TestScriptTestAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptTestAction as JSON object with the members in the order of the fields
+func (r TestScriptTestAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Operation != nil {
+		e.key("operation")
+		r.Operation.appendJSON(e)
+	}
+	if r.Assert != nil {
+		e.key("assert")
+		r.Assert.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptTestAction from JSON. Unknown members are ignored.
+func (r *TestScriptTestAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptTestAction
+func (r *TestScriptTestAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "operation":
+			if d.null() {
+				r.Operation = nil
+			} else {
+				var v TestScriptSetupActionOperation
+				v.decodeJSON(d)
+				r.Operation = &v
+			}
+		case "assert":
+			if d.null() {
+				r.Assert = nil
+			} else {
+				var v TestScriptSetupActionAssert
+				v.decodeJSON(d)
+				r.Assert = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptTestAction which shares no memory with the original
+func (r TestScriptTestAction) DeepCopy() TestScriptTestAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Operation != nil {
+		v := r.Operation.DeepCopy()
+		out.Operation = &v
+	}
+	if r.Assert != nil {
+		v := r.Assert.DeepCopy()
+		out.Assert = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptTestAction) Equal(other TestScriptTestAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.Equal(*other.Operation) {
+		return false
+	}
+	if (r.Assert == nil) != (other.Assert == nil) || r.Assert != nil && !r.Assert.Equal(*other.Assert) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptTestAction) EqualsDeep(other TestScriptTestAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Operation == nil) != (other.Operation == nil) || r.Operation != nil && !r.Operation.EqualsDeep(*other.Operation) {
+		return false
+	}
+	if (r.Assert == nil) != (other.Assert == nil) || r.Assert != nil && !r.Assert.EqualsDeep(*other.Assert) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptTestAction) EqualsShallow(other TestScriptTestAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptTestAction as FHIR XML
+func (r TestScriptTestAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "operation", r.Operation); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "assert", r.Assert); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptTestAction from FHIR XML
+func (r *TestScriptTestAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "operation":
+				var v TestScriptSetupActionOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operation = &v
+			case "assert":
+				var v TestScriptSetupActionAssert
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Assert = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptTestAction as properties to the RDF node n
+func (r TestScriptTestAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("TestScript.test.action.operation", -1, r.Operation)
+	n.element("TestScript.test.action.assert", -1, r.Assert)
+}
+
+// MarshalBSON marshals the given TestScriptTestAction as BSON document with the structure of its FHIR JSON
+func (r TestScriptTestAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptTestAction from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptTestAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptTestAction as protobuf message TestScriptTestAction of fhir.proto
+func (r TestScriptTestAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptTestAction to the encoded message
+func (r TestScriptTestAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Operation != nil {
+		e.message(4, *r.Operation)
+	}
+	if r.Assert != nil {
+		e.message(5, *r.Assert)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptTestAction from protobuf message TestScriptTestAction of fhir.proto
+func (r *TestScriptTestAction) UnmarshalProto(b []byte) error {
+	*r = TestScriptTestAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestScriptSetupActionOperation
+			d.message(&v)
+			r.Operation = &v
+		case 5:
+			var v TestScriptSetupActionAssert
+			d.message(&v)
+			r.Assert = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptTeardown as JSON into a byte slice
+func (r TestScriptTeardown) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptTeardown as JSON object with the members in the order of the fields
+func (r TestScriptTeardown) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("action")
+	if r.Action == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Action {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptTeardown from JSON. Unknown members are ignored.
+func (r *TestScriptTeardown) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptTeardown
+func (r *TestScriptTeardown) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "action":
+			a := d.array()
+			r.Action = nil
+			for a.next() {
+				var v TestScriptTeardownAction
+				v.decodeJSON(d)
+				r.Action = append(r.Action, v)
+			}
+			if r.Action == nil && !a.isNull {
+				r.Action = []TestScriptTeardownAction{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptTeardown which shares no memory with the original
+func (r TestScriptTeardown) DeepCopy() TestScriptTeardown {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Action != nil {
+		out.Action = make([]TestScriptTeardownAction, len(r.Action))
+		for i := range r.Action {
+			out.Action[i] = r.Action[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptTeardown) Equal(other TestScriptTeardown) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Action) != len(other.Action) {
+		return false
+	}
+	for i := range r.Action {
+		if !r.Action[i].Equal(other.Action[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptTeardown) EqualsDeep(other TestScriptTeardown) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Action), len(other.Action), func(i, j int) bool {
+		return r.Action[i].EqualsDeep(other.Action[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptTeardown) EqualsShallow(other TestScriptTeardown) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptTeardown as FHIR XML
+func (r TestScriptTeardown) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Action {
+		if err := encodeXMLElement(e, "action", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptTeardown from FHIR XML
+func (r *TestScriptTeardown) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "action":
+				var v TestScriptTeardownAction
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Action = append(r.Action, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptTeardown as properties to the RDF node n
+func (r TestScriptTeardown) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	for i, v := range r.Action {
+		n.element("TestScript.teardown.action", i, v)
+	}
+}
+
+// MarshalBSON marshals the given TestScriptTeardown as BSON document with the structure of its FHIR JSON
+func (r TestScriptTeardown) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptTeardown from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptTeardown) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptTeardown as protobuf message TestScriptTeardown of fhir.proto
+func (r TestScriptTeardown) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptTeardown to the encoded message
+func (r TestScriptTeardown) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	for _, v := range r.Action {
+		e.message(4, v)
+	}
+}
+
+// UnmarshalProto unmarshals the given TestScriptTeardown from protobuf message TestScriptTeardown of fhir.proto
+func (r *TestScriptTeardown) UnmarshalProto(b []byte) error {
+	*r = TestScriptTeardown{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestScriptTeardownAction
+			d.message(&v)
+			r.Action = append(r.Action, v)
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given TestScriptTeardownAction as JSON into a byte slice
+func (r TestScriptTeardownAction) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the TestScriptTeardownAction as JSON object with the members in the order of the fields
+func (r TestScriptTeardownAction) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("operation")
+	r.Operation.appendJSON(e)
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given TestScriptTeardownAction from JSON. Unknown members are ignored.
+func (r *TestScriptTeardownAction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the TestScriptTeardownAction
+func (r *TestScriptTeardownAction) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "operation":
+			r.Operation.decodeJSON(d)
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the TestScriptTeardownAction which shares no memory with the original
+func (r TestScriptTeardownAction) DeepCopy() TestScriptTeardownAction {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Operation = r.Operation.DeepCopy()
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r TestScriptTeardownAction) Equal(other TestScriptTeardownAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Operation.Equal(other.Operation) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r TestScriptTeardownAction) EqualsDeep(other TestScriptTeardownAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Operation.EqualsDeep(other.Operation) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r TestScriptTeardownAction) EqualsShallow(other TestScriptTeardownAction) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given TestScriptTeardownAction as FHIR XML
+func (r TestScriptTeardownAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "operation", r.Operation); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given TestScriptTeardownAction from FHIR XML
+func (r *TestScriptTeardownAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "operation":
+				var v TestScriptSetupActionOperation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Operation = v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the TestScriptTeardownAction as properties to the RDF node n
+func (r TestScriptTeardownAction) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("TestScript.teardown.action.operation", -1, r.Operation)
+}
+
+// MarshalBSON marshals the given TestScriptTeardownAction as BSON document with the structure of its FHIR JSON
+func (r TestScriptTeardownAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given TestScriptTeardownAction from a BSON document with the structure of its FHIR JSON
+func (r *TestScriptTeardownAction) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given TestScriptTeardownAction as protobuf message TestScriptTeardownAction of fhir.proto
+func (r TestScriptTeardownAction) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the TestScriptTeardownAction to the encoded message
+func (r TestScriptTeardownAction) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Operation)
+}
+
+// UnmarshalProto unmarshals the given TestScriptTeardownAction from protobuf message TestScriptTeardownAction of fhir.proto
+func (r *TestScriptTeardownAction) UnmarshalProto(b []byte) error {
+	*r = TestScriptTeardownAction{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v TestScriptSetupActionOperation
+			d.message(&v)
+			r.Operation = v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalTestScript unmarshals a TestScript.
 func UnmarshalTestScript(b []byte) (TestScript, error) {
 	var testScript TestScript
-	if err := json.Unmarshal(b, &testScript); err != nil {
+	if err := testScript.UnmarshalJSON(b); err != nil {
 		return testScript, err
 	}
 	return testScript, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -39,8 +38,13 @@ const (
 func (code TestScriptRequestMethodCode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *TestScriptRequestMethodCode) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *TestScriptRequestMethodCode) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "delete":
 		*code = TestScriptRequestMethodCodeDelete
@@ -61,6 +65,12 @@ func (code *TestScriptRequestMethodCode) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code TestScriptRequestMethodCode) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TestScriptRequestMethodCode) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code TestScriptRequestMethodCode) String() string {
 	return code.Code()
 }
@@ -121,3 +131,43 @@ func (code TestScriptRequestMethodCode) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code TestScriptRequestMethodCode) protoNumber() uint64 {
+	switch code {
+	case TestScriptRequestMethodCodeDelete:
+		return 1
+	case TestScriptRequestMethodCodeGet:
+		return 2
+	case TestScriptRequestMethodCodeOptions:
+		return 3
+	case TestScriptRequestMethodCodePatch:
+		return 4
+	case TestScriptRequestMethodCodePost:
+		return 5
+	case TestScriptRequestMethodCodePut:
+		return 6
+	case TestScriptRequestMethodCodeHead:
+		return 7
+	}
+	return 0
+}
+func (code *TestScriptRequestMethodCode) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TestScriptRequestMethodCodeDelete
+	case 2:
+		*code = TestScriptRequestMethodCodeGet
+	case 3:
+		*code = TestScriptRequestMethodCodeOptions
+	case 4:
+		*code = TestScriptRequestMethodCodePatch
+	case 5:
+		*code = TestScriptRequestMethodCodePost
+	case 6:
+		*code = TestScriptRequestMethodCodePut
+	case 7:
+		*code = TestScriptRequestMethodCodeHead
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,297 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// TestTurtleR4Examples compares the Turtle of resources with the R4 examples of the FHIR specification, see
+// http://hl7.org/fhir/R4/patient-example.ttl.html, reduced to the elements in the JSON of the test data and with
+// fhir:v instead of fhir:value.
+func TestTurtleR4Examples(t *testing.T) {
+	for _, name := range []string{"patient-example", "observation-example"} {
+		input, err := os.ReadFile("testdata/" + name + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := os.ReadFile("testdata/" + name + ".ttl")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resource interface{}
+		if name == "patient-example" {
+			resource, err = UnmarshalPatient(input)
+		} else {
+			resource, err = UnmarshalObservation(input)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b strings.Builder
+		if err := NewTurtleEncoder(&b).Encode(resource); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := canonicalTurtle(b.String())
+		if err != nil {
+			t.Fatalf("%s: %v in\n%s", name, err, b.String())
+		}
+		expected, err := canonicalTurtle(string(want))
+		if err != nil {
+			t.Fatalf("%s.ttl: %v", name, err)
+		}
+		if got != expected {
+			t.Errorf("%s: got\n%s\nwant\n%s", name, got, expected)
+		}
+	}
+}
+
+func TestTurtleLiteral(t *testing.T) {
+	for _, test := range []struct {
+		value, typeCode, want string
+	}{
+		{"true", "boolean", `"true"^^xsd:boolean`},
+		{"2", "integer", `"2"^^xsd:integer`},
+		{"2", "positiveInt", `"2"^^xsd:positiveInteger`},
+		{"0", "unsignedInt", `"0"^^xsd:nonNegativeInteger`},
+		{"1.50", "decimal", `"1.50"^^xsd:decimal`},
+		{"2020", "date", `"2020"^^xsd:gYear`},
+		{"2020-02", "dateTime", `"2020-02"^^xsd:gYearMonth`},
+		{"2020-02-03", "dateTime", `"2020-02-03"^^xsd:date`},
+		{"2020-02-03T10:00:00+01:00", "dateTime", `"2020-02-03T10:00:00+01:00"^^xsd:dateTime`},
+		{"2020-02-03T10:00:00Z", "instant", `"2020-02-03T10:00:00Z"^^xsd:dateTime`},
+		{"10:00:00", "time", `"10:00:00"^^xsd:time`},
+		{"aGk=", "base64Binary", `"aGk="^^xsd:base64Binary`},
+		{"http://loinc.org", "uri", `"http://loinc.org"`},
+		{"a \"b\"\n", "string", `"a \"b\"\n"`},
+	} {
+		if got := turtleLiteral(test.value, test.typeCode); got != test.want {
+			t.Errorf("turtleLiteral(%q, %s) = %s, want %s", test.value, test.typeCode, got, test.want)
+		}
+	}
+}
+
+// canonicalTurtle parses the subset of Turtle used by the FHIR RDF representation and returns the graph as sorted
+// lines, one per subject, with nested blank nodes written inline with sorted properties. Prefixed names are expanded
+// and the abbreviations of numbers and booleans are written as typed literals.
+func canonicalTurtle(s string) (string, error) {
+	p := &turtleParser{input: s, prefixes: map[string]string{}}
+	subjects := map[string][]string{}
+	for {
+		p.skip()
+		if p.pos >= len(p.input) {
+			break
+		}
+		if strings.HasPrefix(p.input[p.pos:], "@prefix") {
+			p.pos += len("@prefix")
+			p.skip()
+			end := strings.IndexByte(p.input[p.pos:], ':')
+			if end < 0 {
+				return "", fmt.Errorf("invalid prefix at %d", p.pos)
+			}
+			prefix := p.input[p.pos : p.pos+end]
+			p.pos += end + 1
+			p.skip()
+			iri, err := p.term()
+			if err != nil {
+				return "", err
+			}
+			p.prefixes[prefix] = strings.Trim(iri, "<>")
+			if err := p.expect('.'); err != nil {
+				return "", err
+			}
+			continue
+		}
+		subject, err := p.term()
+		if err != nil {
+			return "", err
+		}
+		properties, err := p.properties()
+		if err != nil {
+			return "", err
+		}
+		subjects[subject] = append(subjects[subject], properties...)
+		if err := p.expect('.'); err != nil {
+			return "", err
+		}
+	}
+	var lines []string
+	for subject, properties := range subjects {
+		sort.Strings(properties)
+		lines = append(lines, subject+" "+strings.Join(properties, " ; "))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n"), nil
+}
+
+type turtleParser struct {
+	input    string
+	pos      int
+	prefixes map[string]string
+}
+
+func (p *turtleParser) skip() {
+	for p.pos < len(p.input) {
+		switch c := p.input[p.pos]; {
+		case c == '#':
+			for p.pos < len(p.input) && p.input[p.pos] != '\n' {
+				p.pos++
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *turtleParser) peek() byte {
+	p.skip()
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *turtleParser) expect(c byte) error {
+	if p.peek() != c {
+		return fmt.Errorf("expected %c at %d", c, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+// properties parses a predicate object list, ending before `.` or `]`, into canonical "predicate object" strings.
+func (p *turtleParser) properties() ([]string, error) {
+	var properties []string
+	for {
+		if c := p.peek(); c == '.' || c == ']' {
+			return properties, nil
+		}
+		predicate, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		if predicate == "a" {
+			predicate = "<http://www.w3.org/1999/02/22-rdf-syntax-ns#type>"
+		}
+		for {
+			object, err := p.object()
+			if err != nil {
+				return nil, err
+			}
+			properties = append(properties, predicate+" "+object)
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+		if p.peek() != ';' {
+			return properties, nil
+		}
+		p.pos++
+	}
+}
+
+func (p *turtleParser) object() (string, error) {
+	if p.peek() != '[' {
+		return p.term()
+	}
+	p.pos++
+	properties, err := p.properties()
+	if err != nil {
+		return "", err
+	}
+	if err := p.expect(']'); err != nil {
+		return "", err
+	}
+	sort.Strings(properties)
+	return "[ " + strings.Join(properties, " ; ") + " ]", nil
+}
+
+// term parses an IRI, prefixed name, literal or the keyword a.
+func (p *turtleParser) term() (string, error) {
+	p.skip()
+	start := p.pos
+	if p.pos >= len(p.input) {
+		return "", fmt.Errorf("unexpected end")
+	}
+	switch c := p.input[p.pos]; {
+	case c == '<':
+		end := strings.IndexByte(p.input[p.pos:], '>')
+		if end < 0 {
+			return "", fmt.Errorf("unterminated IRI at %d", start)
+		}
+		p.pos += end + 1
+		return p.input[start:p.pos], nil
+	case c == '"':
+		p.pos++
+		for p.pos < len(p.input) && p.input[p.pos] != '"' {
+			if p.input[p.pos] == '\\' {
+				p.pos++
+			}
+			p.pos++
+		}
+		if p.pos >= len(p.input) {
+			return "", fmt.Errorf("unterminated string at %d", start)
+		}
+		p.pos++
+		literal := p.input[start:p.pos]
+		if strings.HasPrefix(p.input[p.pos:], "^^") {
+			p.pos += 2
+			datatype, err := p.term()
+			if err != nil {
+				return "", err
+			}
+			return literal + "^^" + datatype, nil
+		}
+		return literal, nil
+	}
+	for p.pos < len(p.input) && !strings.ContainsRune(" \t\r\n;,[]", rune(p.input[p.pos])) {
+		// a dot ends the name unless it is followed by a name character
+		if p.input[p.pos] == '.' && (p.pos+1 >= len(p.input) || strings.ContainsRune(" \t\r\n", rune(p.input[p.pos+1]))) {
+			break
+		}
+		if p.input[p.pos] == '\\' {
+			p.pos++
+		}
+		p.pos++
+	}
+	name := p.input[start:p.pos]
+	switch {
+	case name == "":
+		return "", fmt.Errorf("unexpected %c at %d", p.input[start], start)
+	case name == "a":
+		return name, nil
+	case name == "true" || name == "false":
+		return `"` + name + `"^^<http://www.w3.org/2001/XMLSchema#boolean>`, nil
+	case strings.Trim(name, "0123456789") == "":
+		return `"` + name + `"^^<http://www.w3.org/2001/XMLSchema#integer>`, nil
+	}
+	colon := strings.IndexByte(name, ':')
+	if colon < 0 {
+		return "", fmt.Errorf("invalid name %s at %d", name, start)
+	}
+	base, ok := p.prefixes[name[:colon]]
+	if !ok {
+		return "", fmt.Errorf("unknown prefix %s at %d", name[:colon], start)
+	}
+	return "<" + base + strings.ReplaceAll(name[colon+1:], "\\", "") + ">", nil
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -49,8 +48,13 @@ const (
 func (code TypeRestfulInteraction) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *TypeRestfulInteraction) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *TypeRestfulInteraction) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "read":
 		*code = TypeRestfulInteractionRead
@@ -91,6 +95,12 @@ func (code *TypeRestfulInteraction) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code TypeRestfulInteraction) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *TypeRestfulInteraction) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code TypeRestfulInteraction) String() string {
 	return code.Code()
 }
@@ -211,3 +221,83 @@ func (code TypeRestfulInteraction) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code TypeRestfulInteraction) protoNumber() uint64 {
+	switch code {
+	case TypeRestfulInteractionRead:
+		return 1
+	case TypeRestfulInteractionVread:
+		return 2
+	case TypeRestfulInteractionUpdate:
+		return 3
+	case TypeRestfulInteractionPatch:
+		return 4
+	case TypeRestfulInteractionDelete:
+		return 5
+	case TypeRestfulInteractionHistory:
+		return 6
+	case TypeRestfulInteractionHistoryInstance:
+		return 7
+	case TypeRestfulInteractionHistoryType:
+		return 8
+	case TypeRestfulInteractionHistorySystem:
+		return 9
+	case TypeRestfulInteractionCreate:
+		return 10
+	case TypeRestfulInteractionSearch:
+		return 11
+	case TypeRestfulInteractionSearchType:
+		return 12
+	case TypeRestfulInteractionSearchSystem:
+		return 13
+	case TypeRestfulInteractionCapabilities:
+		return 14
+	case TypeRestfulInteractionTransaction:
+		return 15
+	case TypeRestfulInteractionBatch:
+		return 16
+	case TypeRestfulInteractionOperation:
+		return 17
+	}
+	return 0
+}
+func (code *TypeRestfulInteraction) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = TypeRestfulInteractionRead
+	case 2:
+		*code = TypeRestfulInteractionVread
+	case 3:
+		*code = TypeRestfulInteractionUpdate
+	case 4:
+		*code = TypeRestfulInteractionPatch
+	case 5:
+		*code = TypeRestfulInteractionDelete
+	case 6:
+		*code = TypeRestfulInteractionHistory
+	case 7:
+		*code = TypeRestfulInteractionHistoryInstance
+	case 8:
+		*code = TypeRestfulInteractionHistoryType
+	case 9:
+		*code = TypeRestfulInteractionHistorySystem
+	case 10:
+		*code = TypeRestfulInteractionCreate
+	case 11:
+		*code = TypeRestfulInteractionSearch
+	case 12:
+		*code = TypeRestfulInteractionSearchType
+	case 13:
+		*code = TypeRestfulInteractionSearchSystem
+	case 14:
+		*code = TypeRestfulInteractionCapabilities
+	case 15:
+		*code = TypeRestfulInteractionTransaction
+	case 16:
+		*code = TypeRestfulInteractionBatch
+	case 17:
+		*code = TypeRestfulInteractionOperation
+	default:
+		return false
+	}
+	return true
+}
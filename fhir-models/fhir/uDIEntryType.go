@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -38,8 +37,13 @@ const (
 func (code UDIEntryType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *UDIEntryType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *UDIEntryType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "barcode":
 		*code = UDIEntryTypeBarcode
@@ -58,6 +62,12 @@ func (code *UDIEntryType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code UDIEntryType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *UDIEntryType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code UDIEntryType) String() string {
 	return code.Code()
 }
@@ -112,3 +122,39 @@ func (code UDIEntryType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code UDIEntryType) protoNumber() uint64 {
+	switch code {
+	case UDIEntryTypeBarcode:
+		return 1
+	case UDIEntryTypeRfid:
+		return 2
+	case UDIEntryTypeManual:
+		return 3
+	case UDIEntryTypeCard:
+		return 4
+	case UDIEntryTypeSelfReported:
+		return 5
+	case UDIEntryTypeUnknown:
+		return 6
+	}
+	return 0
+}
+func (code *UDIEntryType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = UDIEntryTypeBarcode
+	case 2:
+		*code = UDIEntryTypeRfid
+	case 3:
+		*code = UDIEntryTypeManual
+	case 4:
+		*code = UDIEntryTypeCard
+	case 5:
+		*code = UDIEntryTypeSelfReported
+	case 6:
+		*code = UDIEntryTypeUnknown
+	default:
+		return false
+	}
+	return true
+}
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -35,8 +34,13 @@ const (
 func (code Use) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *Use) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *Use) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "claim":
 		*code = UseClaim
@@ -49,6 +53,12 @@ func (code *Use) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code Use) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *Use) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code Use) String() string {
 	return code.Code()
 }
@@ -85,3 +95,27 @@ func (code Use) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code Use) protoNumber() uint64 {
+	switch code {
+	case UseClaim:
+		return 1
+	case UsePreauthorization:
+		return 2
+	case UsePredetermination:
+		return 3
+	}
+	return 0
+}
+func (code *Use) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = UseClaim
+	case 2:
+		*code = UsePreauthorization
+	case 3:
+		*code = UsePredetermination
+	default:
+		return false
+	}
+	return true
+}
@@ -14,85 +14,3196 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // VerificationResult is documented here http://hl7.org/fhir/StructureDefinition/VerificationResult
 type VerificationResult struct {
-	Id                *string                           `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                           `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Target            []Reference                       `bson:"target,omitempty" json:"target,omitempty"`
-	TargetLocation    []string                          `bson:"targetLocation,omitempty" json:"targetLocation,omitempty"`
-	Need              *CodeableConcept                  `bson:"need,omitempty" json:"need,omitempty"`
-	Status            string                            `bson:"status" json:"status"`
-	StatusDate        *string                           `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
-	ValidationType    *CodeableConcept                  `bson:"validationType,omitempty" json:"validationType,omitempty"`
-	ValidationProcess []CodeableConcept                 `bson:"validationProcess,omitempty" json:"validationProcess,omitempty"`
-	Frequency         *Timing                           `bson:"frequency,omitempty" json:"frequency,omitempty"`
-	LastPerformed     *string                           `bson:"lastPerformed,omitempty" json:"lastPerformed,omitempty"`
-	NextScheduled     *string                           `bson:"nextScheduled,omitempty" json:"nextScheduled,omitempty"`
-	FailureAction     *CodeableConcept                  `bson:"failureAction,omitempty" json:"failureAction,omitempty"`
-	PrimarySource     []VerificationResultPrimarySource `bson:"primarySource,omitempty" json:"primarySource,omitempty"`
-	Attestation       *VerificationResultAttestation    `bson:"attestation,omitempty" json:"attestation,omitempty"`
-	Validator         []VerificationResultValidator     `bson:"validator,omitempty" json:"validator,omitempty"`
+	Id                    *string                           `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement             *Element                          `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                  *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         *string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement  *Element                          `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language              *string                           `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement       *Element                          `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                  *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             []json.RawMessage                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Target                []Reference                       `bson:"target,omitempty" json:"target,omitempty"`
+	TargetLocation        []string                          `bson:"targetLocation,omitempty" json:"targetLocation,omitempty"`
+	TargetLocationElement []*Element                        `bson:"_targetLocation,omitempty" json:"_targetLocation,omitempty"`
+	Need                  *CodeableConcept                  `bson:"need,omitempty" json:"need,omitempty"`
+	Status                string                            `bson:"status" json:"status"`
+	StatusElement         *Element                          `bson:"_status,omitempty" json:"_status,omitempty"`
+	StatusDate            *string                           `bson:"statusDate,omitempty" json:"statusDate,omitempty"`
+	StatusDateElement     *Element                          `bson:"_statusDate,omitempty" json:"_statusDate,omitempty"`
+	ValidationType        *CodeableConcept                  `bson:"validationType,omitempty" json:"validationType,omitempty"`
+	ValidationProcess     []CodeableConcept                 `bson:"validationProcess,omitempty" json:"validationProcess,omitempty"`
+	Frequency             *Timing                           `bson:"frequency,omitempty" json:"frequency,omitempty"`
+	LastPerformed         *string                           `bson:"lastPerformed,omitempty" json:"lastPerformed,omitempty"`
+	LastPerformedElement  *Element                          `bson:"_lastPerformed,omitempty" json:"_lastPerformed,omitempty"`
+	NextScheduled         *string                           `bson:"nextScheduled,omitempty" json:"nextScheduled,omitempty"`
+	NextScheduledElement  *Element                          `bson:"_nextScheduled,omitempty" json:"_nextScheduled,omitempty"`
+	FailureAction         *CodeableConcept                  `bson:"failureAction,omitempty" json:"failureAction,omitempty"`
+	PrimarySource         []VerificationResultPrimarySource `bson:"primarySource,omitempty" json:"primarySource,omitempty"`
+	Attestation           *VerificationResultAttestation    `bson:"attestation,omitempty" json:"attestation,omitempty"`
+	Validator             []VerificationResultValidator     `bson:"validator,omitempty" json:"validator,omitempty"`
 }
 type VerificationResultPrimarySource struct {
-	Id                  *string           `bson:"id,omitempty" json:"id,omitempty"`
-	Extension           []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension   []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Who                 *Reference        `bson:"who,omitempty" json:"who,omitempty"`
-	Type                []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	CommunicationMethod []CodeableConcept `bson:"communicationMethod,omitempty" json:"communicationMethod,omitempty"`
-	ValidationStatus    *CodeableConcept  `bson:"validationStatus,omitempty" json:"validationStatus,omitempty"`
-	ValidationDate      *string           `bson:"validationDate,omitempty" json:"validationDate,omitempty"`
-	CanPushUpdates      *CodeableConcept  `bson:"canPushUpdates,omitempty" json:"canPushUpdates,omitempty"`
-	PushTypeAvailable   []CodeableConcept `bson:"pushTypeAvailable,omitempty" json:"pushTypeAvailable,omitempty"`
+	Id                    *string           `bson:"id,omitempty" json:"id,omitempty"`
+	Extension             []Extension       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Who                   *Reference        `bson:"who,omitempty" json:"who,omitempty"`
+	Type                  []CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
+	CommunicationMethod   []CodeableConcept `bson:"communicationMethod,omitempty" json:"communicationMethod,omitempty"`
+	ValidationStatus      *CodeableConcept  `bson:"validationStatus,omitempty" json:"validationStatus,omitempty"`
+	ValidationDate        *string           `bson:"validationDate,omitempty" json:"validationDate,omitempty"`
+	ValidationDateElement *Element          `bson:"_validationDate,omitempty" json:"_validationDate,omitempty"`
+	CanPushUpdates        *CodeableConcept  `bson:"canPushUpdates,omitempty" json:"canPushUpdates,omitempty"`
+	PushTypeAvailable     []CodeableConcept `bson:"pushTypeAvailable,omitempty" json:"pushTypeAvailable,omitempty"`
 }
 type VerificationResultAttestation struct {
-	Id                        *string          `bson:"id,omitempty" json:"id,omitempty"`
-	Extension                 []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension         []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Who                       *Reference       `bson:"who,omitempty" json:"who,omitempty"`
-	OnBehalfOf                *Reference       `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
-	CommunicationMethod       *CodeableConcept `bson:"communicationMethod,omitempty" json:"communicationMethod,omitempty"`
-	Date                      *string          `bson:"date,omitempty" json:"date,omitempty"`
-	SourceIdentityCertificate *string          `bson:"sourceIdentityCertificate,omitempty" json:"sourceIdentityCertificate,omitempty"`
-	ProxyIdentityCertificate  *string          `bson:"proxyIdentityCertificate,omitempty" json:"proxyIdentityCertificate,omitempty"`
-	ProxySignature            *Signature       `bson:"proxySignature,omitempty" json:"proxySignature,omitempty"`
-	SourceSignature           *Signature       `bson:"sourceSignature,omitempty" json:"sourceSignature,omitempty"`
+	Id                               *string          `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                        []Extension      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension                []Extension      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Who                              *Reference       `bson:"who,omitempty" json:"who,omitempty"`
+	OnBehalfOf                       *Reference       `bson:"onBehalfOf,omitempty" json:"onBehalfOf,omitempty"`
+	CommunicationMethod              *CodeableConcept `bson:"communicationMethod,omitempty" json:"communicationMethod,omitempty"`
+	Date                             *string          `bson:"date,omitempty" json:"date,omitempty"`
+	DateElement                      *Element         `bson:"_date,omitempty" json:"_date,omitempty"`
+	SourceIdentityCertificate        *string          `bson:"sourceIdentityCertificate,omitempty" json:"sourceIdentityCertificate,omitempty"`
+	SourceIdentityCertificateElement *Element         `bson:"_sourceIdentityCertificate,omitempty" json:"_sourceIdentityCertificate,omitempty"`
+	ProxyIdentityCertificate         *string          `bson:"proxyIdentityCertificate,omitempty" json:"proxyIdentityCertificate,omitempty"`
+	ProxyIdentityCertificateElement  *Element         `bson:"_proxyIdentityCertificate,omitempty" json:"_proxyIdentityCertificate,omitempty"`
+	ProxySignature                   *Signature       `bson:"proxySignature,omitempty" json:"proxySignature,omitempty"`
+	SourceSignature                  *Signature       `bson:"sourceSignature,omitempty" json:"sourceSignature,omitempty"`
 }
 type VerificationResultValidator struct {
-	Id                   *string     `bson:"id,omitempty" json:"id,omitempty"`
-	Extension            []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension    []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Organization         Reference   `bson:"organization" json:"organization"`
-	IdentityCertificate  *string     `bson:"identityCertificate,omitempty" json:"identityCertificate,omitempty"`
-	AttestationSignature *Signature  `bson:"attestationSignature,omitempty" json:"attestationSignature,omitempty"`
+	Id                         *string     `bson:"id,omitempty" json:"id,omitempty"`
+	Extension                  []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension          []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Organization               Reference   `bson:"organization" json:"organization"`
+	IdentityCertificate        *string     `bson:"identityCertificate,omitempty" json:"identityCertificate,omitempty"`
+	IdentityCertificateElement *Element    `bson:"_identityCertificate,omitempty" json:"_identityCertificate,omitempty"`
+	AttestationSignature       *Signature  `bson:"attestationSignature,omitempty" json:"attestationSignature,omitempty"`
 }
-type OtherVerificationResult VerificationResult
 
 // MarshalJSON marshals the given VerificationResult as JSON into a byte slice
 func (r VerificationResult) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherVerificationResult
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherVerificationResult: OtherVerificationResult(r),
-		ResourceType:            "VerificationResult",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the VerificationResult as JSON object with the members in the order of the fields
+func (r VerificationResult) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"VerificationResult\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Target) > 0 {
+		e.key("target")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Target {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.TargetLocation) > 0 {
+		e.key("targetLocation")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.TargetLocation {
+			e.item()
+			e.string(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.TargetLocationElement) > 0 {
+		e.key("_targetLocation")
+		e.elements(r.TargetLocationElement, len(r.TargetLocation))
+	}
+	if r.Need != nil {
+		e.key("need")
+		r.Need.appendJSON(e)
+	}
+	e.key("status")
+	e.string(r.Status)
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	if r.StatusDate != nil {
+		e.key("statusDate")
+		e.string(*r.StatusDate)
+	}
+	if r.StatusDateElement != nil {
+		e.key("_statusDate")
+		r.StatusDateElement.appendJSON(e)
+	}
+	if r.ValidationType != nil {
+		e.key("validationType")
+		r.ValidationType.appendJSON(e)
+	}
+	if len(r.ValidationProcess) > 0 {
+		e.key("validationProcess")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ValidationProcess {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Frequency != nil {
+		e.key("frequency")
+		r.Frequency.appendJSON(e)
+	}
+	if r.LastPerformed != nil {
+		e.key("lastPerformed")
+		e.string(*r.LastPerformed)
+	}
+	if r.LastPerformedElement != nil {
+		e.key("_lastPerformed")
+		r.LastPerformedElement.appendJSON(e)
+	}
+	if r.NextScheduled != nil {
+		e.key("nextScheduled")
+		e.string(*r.NextScheduled)
+	}
+	if r.NextScheduledElement != nil {
+		e.key("_nextScheduled")
+		r.NextScheduledElement.appendJSON(e)
+	}
+	if r.FailureAction != nil {
+		e.key("failureAction")
+		r.FailureAction.appendJSON(e)
+	}
+	if len(r.PrimarySource) > 0 {
+		e.key("primarySource")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PrimarySource {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Attestation != nil {
+		e.key("attestation")
+		r.Attestation.appendJSON(e)
+	}
+	if len(r.Validator) > 0 {
+		e.key("validator")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Validator {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given VerificationResult from JSON. Unknown members are ignored.
+func (r *VerificationResult) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the VerificationResult
+func (r *VerificationResult) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "target":
+			a := d.array()
+			r.Target = nil
+			for a.next() {
+				var v Reference
+				v.decodeJSON(d)
+				r.Target = append(r.Target, v)
+			}
+			if r.Target == nil && !a.isNull {
+				r.Target = []Reference{}
+			}
+		case "targetLocation":
+			a := d.array()
+			r.TargetLocation = nil
+			for a.next() {
+				v, _ := d.string()
+				r.TargetLocation = append(r.TargetLocation, v)
+			}
+			if r.TargetLocation == nil && !a.isNull {
+				r.TargetLocation = []string{}
+			}
+		case "_targetLocation":
+			a := d.array()
+			r.TargetLocationElement = nil
+			for a.next() {
+				r.TargetLocationElement = append(r.TargetLocationElement, d.element())
+			}
+		case "need":
+			if d.null() {
+				r.Need = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Need = &v
+			}
+		case "status":
+			if v, ok := d.string(); ok {
+				r.Status = v
+			}
+		case "_status":
+			r.StatusElement = d.element()
+		case "statusDate":
+			if v, ok := d.string(); ok {
+				r.StatusDate = &v
+			} else {
+				r.StatusDate = nil
+			}
+		case "_statusDate":
+			r.StatusDateElement = d.element()
+		case "validationType":
+			if d.null() {
+				r.ValidationType = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValidationType = &v
+			}
+		case "validationProcess":
+			a := d.array()
+			r.ValidationProcess = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValidationProcess = append(r.ValidationProcess, v)
+			}
+			if r.ValidationProcess == nil && !a.isNull {
+				r.ValidationProcess = []CodeableConcept{}
+			}
+		case "frequency":
+			if d.null() {
+				r.Frequency = nil
+			} else {
+				var v Timing
+				v.decodeJSON(d)
+				r.Frequency = &v
+			}
+		case "lastPerformed":
+			if v, ok := d.string(); ok {
+				r.LastPerformed = &v
+			} else {
+				r.LastPerformed = nil
+			}
+		case "_lastPerformed":
+			r.LastPerformedElement = d.element()
+		case "nextScheduled":
+			if v, ok := d.string(); ok {
+				r.NextScheduled = &v
+			} else {
+				r.NextScheduled = nil
+			}
+		case "_nextScheduled":
+			r.NextScheduledElement = d.element()
+		case "failureAction":
+			if d.null() {
+				r.FailureAction = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.FailureAction = &v
+			}
+		case "primarySource":
+			a := d.array()
+			r.PrimarySource = nil
+			for a.next() {
+				var v VerificationResultPrimarySource
+				v.decodeJSON(d)
+				r.PrimarySource = append(r.PrimarySource, v)
+			}
+			if r.PrimarySource == nil && !a.isNull {
+				r.PrimarySource = []VerificationResultPrimarySource{}
+			}
+		case "attestation":
+			if d.null() {
+				r.Attestation = nil
+			} else {
+				var v VerificationResultAttestation
+				v.decodeJSON(d)
+				r.Attestation = &v
+			}
+		case "validator":
+			a := d.array()
+			r.Validator = nil
+			for a.next() {
+				var v VerificationResultValidator
+				v.decodeJSON(d)
+				r.Validator = append(r.Validator, v)
+			}
+			if r.Validator == nil && !a.isNull {
+				r.Validator = []VerificationResultValidator{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the VerificationResult which shares no memory with the original
+func (r VerificationResult) DeepCopy() VerificationResult {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Target != nil {
+		out.Target = make([]Reference, len(r.Target))
+		for i := range r.Target {
+			out.Target[i] = r.Target[i].DeepCopy()
+		}
+	}
+	if r.TargetLocation != nil {
+		out.TargetLocation = make([]string, len(r.TargetLocation))
+		copy(out.TargetLocation, r.TargetLocation)
+	}
+	if r.TargetLocationElement != nil {
+		out.TargetLocationElement = make([]*Element, len(r.TargetLocationElement))
+		for i, v := range r.TargetLocationElement {
+			if v != nil {
+				c := v.DeepCopy()
+				out.TargetLocationElement[i] = &c
+			}
+		}
+	}
+	if r.Need != nil {
+		v := r.Need.DeepCopy()
+		out.Need = &v
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.StatusDate != nil {
+		v := *r.StatusDate
+		out.StatusDate = &v
+	}
+	if r.StatusDateElement != nil {
+		v := r.StatusDateElement.DeepCopy()
+		out.StatusDateElement = &v
+	}
+	if r.ValidationType != nil {
+		v := r.ValidationType.DeepCopy()
+		out.ValidationType = &v
+	}
+	if r.ValidationProcess != nil {
+		out.ValidationProcess = make([]CodeableConcept, len(r.ValidationProcess))
+		for i := range r.ValidationProcess {
+			out.ValidationProcess[i] = r.ValidationProcess[i].DeepCopy()
+		}
+	}
+	if r.Frequency != nil {
+		v := r.Frequency.DeepCopy()
+		out.Frequency = &v
+	}
+	if r.LastPerformed != nil {
+		v := *r.LastPerformed
+		out.LastPerformed = &v
+	}
+	if r.LastPerformedElement != nil {
+		v := r.LastPerformedElement.DeepCopy()
+		out.LastPerformedElement = &v
+	}
+	if r.NextScheduled != nil {
+		v := *r.NextScheduled
+		out.NextScheduled = &v
+	}
+	if r.NextScheduledElement != nil {
+		v := r.NextScheduledElement.DeepCopy()
+		out.NextScheduledElement = &v
+	}
+	if r.FailureAction != nil {
+		v := r.FailureAction.DeepCopy()
+		out.FailureAction = &v
+	}
+	if r.PrimarySource != nil {
+		out.PrimarySource = make([]VerificationResultPrimarySource, len(r.PrimarySource))
+		for i := range r.PrimarySource {
+			out.PrimarySource[i] = r.PrimarySource[i].DeepCopy()
+		}
+	}
+	if r.Attestation != nil {
+		v := r.Attestation.DeepCopy()
+		out.Attestation = &v
+	}
+	if r.Validator != nil {
+		out.Validator = make([]VerificationResultValidator, len(r.Validator))
+		for i := range r.Validator {
+			out.Validator[i] = r.Validator[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r VerificationResult) Equal(other VerificationResult) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Target) != len(other.Target) {
+		return false
+	}
+	for i := range r.Target {
+		if !r.Target[i].Equal(other.Target[i]) {
+			return false
+		}
+	}
+	if len(r.TargetLocation) != len(other.TargetLocation) {
+		return false
+	}
+	for i := range r.TargetLocation {
+		if r.TargetLocation[i] != other.TargetLocation[i] {
+			return false
+		}
+	}
+	if !equalElements(r.TargetLocationElement, other.TargetLocationElement, false) {
+		return false
+	}
+	if (r.Need == nil) != (other.Need == nil) || r.Need != nil && !r.Need.Equal(*other.Need) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && *r.StatusDate != *other.StatusDate {
+		return false
+	}
+	if (r.StatusDateElement == nil) != (other.StatusDateElement == nil) || r.StatusDateElement != nil && !r.StatusDateElement.Equal(*other.StatusDateElement) {
+		return false
+	}
+	if (r.ValidationType == nil) != (other.ValidationType == nil) || r.ValidationType != nil && !r.ValidationType.Equal(*other.ValidationType) {
+		return false
+	}
+	if len(r.ValidationProcess) != len(other.ValidationProcess) {
+		return false
+	}
+	for i := range r.ValidationProcess {
+		if !r.ValidationProcess[i].Equal(other.ValidationProcess[i]) {
+			return false
+		}
+	}
+	if (r.Frequency == nil) != (other.Frequency == nil) || r.Frequency != nil && !r.Frequency.Equal(*other.Frequency) {
+		return false
+	}
+	if (r.LastPerformed == nil) != (other.LastPerformed == nil) || r.LastPerformed != nil && *r.LastPerformed != *other.LastPerformed {
+		return false
+	}
+	if (r.LastPerformedElement == nil) != (other.LastPerformedElement == nil) || r.LastPerformedElement != nil && !r.LastPerformedElement.Equal(*other.LastPerformedElement) {
+		return false
+	}
+	if (r.NextScheduled == nil) != (other.NextScheduled == nil) || r.NextScheduled != nil && *r.NextScheduled != *other.NextScheduled {
+		return false
+	}
+	if (r.NextScheduledElement == nil) != (other.NextScheduledElement == nil) || r.NextScheduledElement != nil && !r.NextScheduledElement.Equal(*other.NextScheduledElement) {
+		return false
+	}
+	if (r.FailureAction == nil) != (other.FailureAction == nil) || r.FailureAction != nil && !r.FailureAction.Equal(*other.FailureAction) {
+		return false
+	}
+	if len(r.PrimarySource) != len(other.PrimarySource) {
+		return false
+	}
+	for i := range r.PrimarySource {
+		if !r.PrimarySource[i].Equal(other.PrimarySource[i]) {
+			return false
+		}
+	}
+	if (r.Attestation == nil) != (other.Attestation == nil) || r.Attestation != nil && !r.Attestation.Equal(*other.Attestation) {
+		return false
+	}
+	if len(r.Validator) != len(other.Validator) {
+		return false
+	}
+	for i := range r.Validator {
+		if !r.Validator[i].Equal(other.Validator[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r VerificationResult) EqualsDeep(other VerificationResult) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Target), len(other.Target), func(i, j int) bool {
+		return r.Target[i].EqualsDeep(other.Target[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.TargetLocation), len(other.TargetLocation), func(i, j int) bool {
+		return equivalentString(r.TargetLocation[i], other.TargetLocation[j])
+	}) {
+		return false
+	}
+	if !equalElements(r.TargetLocationElement, other.TargetLocationElement, true) {
+		return false
+	}
+	if (r.Need == nil) != (other.Need == nil) || r.Need != nil && !r.Need.EqualsDeep(*other.Need) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && !equivalentString(*r.StatusDate, *other.StatusDate) {
+		return false
+	}
+	if (r.StatusDateElement == nil) != (other.StatusDateElement == nil) || r.StatusDateElement != nil && !r.StatusDateElement.EqualsDeep(*other.StatusDateElement) {
+		return false
+	}
+	if (r.ValidationType == nil) != (other.ValidationType == nil) || r.ValidationType != nil && !r.ValidationType.EqualsDeep(*other.ValidationType) {
+		return false
+	}
+	if !equivalentList(len(r.ValidationProcess), len(other.ValidationProcess), func(i, j int) bool {
+		return r.ValidationProcess[i].EqualsDeep(other.ValidationProcess[j])
+	}) {
+		return false
+	}
+	if (r.Frequency == nil) != (other.Frequency == nil) || r.Frequency != nil && !r.Frequency.EqualsDeep(*other.Frequency) {
+		return false
+	}
+	if (r.LastPerformed == nil) != (other.LastPerformed == nil) || r.LastPerformed != nil && !equivalentString(*r.LastPerformed, *other.LastPerformed) {
+		return false
+	}
+	if (r.LastPerformedElement == nil) != (other.LastPerformedElement == nil) || r.LastPerformedElement != nil && !r.LastPerformedElement.EqualsDeep(*other.LastPerformedElement) {
+		return false
+	}
+	if (r.NextScheduled == nil) != (other.NextScheduled == nil) || r.NextScheduled != nil && !equivalentString(*r.NextScheduled, *other.NextScheduled) {
+		return false
+	}
+	if (r.NextScheduledElement == nil) != (other.NextScheduledElement == nil) || r.NextScheduledElement != nil && !r.NextScheduledElement.EqualsDeep(*other.NextScheduledElement) {
+		return false
+	}
+	if (r.FailureAction == nil) != (other.FailureAction == nil) || r.FailureAction != nil && !r.FailureAction.EqualsDeep(*other.FailureAction) {
+		return false
+	}
+	if !equivalentList(len(r.PrimarySource), len(other.PrimarySource), func(i, j int) bool {
+		return r.PrimarySource[i].EqualsDeep(other.PrimarySource[j])
+	}) {
+		return false
+	}
+	if (r.Attestation == nil) != (other.Attestation == nil) || r.Attestation != nil && !r.Attestation.EqualsDeep(*other.Attestation) {
+		return false
+	}
+	if !equivalentList(len(r.Validator), len(other.Validator), func(i, j int) bool {
+		return r.Validator[i].EqualsDeep(other.Validator[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r VerificationResult) EqualsShallow(other VerificationResult) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if !equivalentList(len(r.TargetLocation), len(other.TargetLocation), func(i, j int) bool {
+		return equivalentString(r.TargetLocation[i], other.TargetLocation[j])
+	}) {
+		return false
+	}
+	if !equivalentString(r.Status, other.Status) {
+		return false
+	}
+	if (r.StatusDate == nil) != (other.StatusDate == nil) || r.StatusDate != nil && !equivalentString(*r.StatusDate, *other.StatusDate) {
+		return false
+	}
+	if (r.LastPerformed == nil) != (other.LastPerformed == nil) || r.LastPerformed != nil && !equivalentString(*r.LastPerformed, *other.LastPerformed) {
+		return false
+	}
+	if (r.NextScheduled == nil) != (other.NextScheduled == nil) || r.NextScheduled != nil && !equivalentString(*r.NextScheduled, *other.NextScheduled) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given VerificationResult as FHIR XML
+func (r VerificationResult) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "VerificationResult",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Target {
+		if err := encodeXMLElement(e, "target", v); err != nil {
+			return err
+		}
+	}
+	for i, v := range r.TargetLocation {
+		if err := encodeXMLPrimitive(e, "targetLocation", v, elementAt(r.TargetLocationElement, i)); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "need", r.Need); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "statusDate", r.StatusDate, r.StatusDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "validationType", r.ValidationType); err != nil {
+		return err
+	}
+	for _, v := range r.ValidationProcess {
+		if err := encodeXMLElement(e, "validationProcess", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "frequency", r.Frequency); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "lastPerformed", r.LastPerformed, r.LastPerformedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "nextScheduled", r.NextScheduled, r.NextScheduledElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "failureAction", r.FailureAction); err != nil {
+		return err
+	}
+	for _, v := range r.PrimarySource {
+		if err := encodeXMLElement(e, "primarySource", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "attestation", r.Attestation); err != nil {
+		return err
+	}
+	for _, v := range r.Validator {
+		if err := encodeXMLElement(e, "validator", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given VerificationResult from FHIR XML
+func (r *VerificationResult) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "target":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Target = append(r.Target, v)
+			case "targetLocation":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok || element != nil {
+					r.TargetLocationElement = appendElement(r.TargetLocationElement, len(r.TargetLocation), element)
+					r.TargetLocation = append(r.TargetLocation, v)
+				}
+			case "need":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Need = &v
+			case "status":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "statusDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.StatusDate = &v
+				}
+				r.StatusDateElement = element
+			case "validationType":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValidationType = &v
+			case "validationProcess":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValidationProcess = append(r.ValidationProcess, v)
+			case "frequency":
+				var v Timing
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Frequency = &v
+			case "lastPerformed":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.LastPerformed = &v
+				}
+				r.LastPerformedElement = element
+			case "nextScheduled":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.NextScheduled = &v
+				}
+				r.NextScheduledElement = element
+			case "failureAction":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.FailureAction = &v
+			case "primarySource":
+				var v VerificationResultPrimarySource
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PrimarySource = append(r.PrimarySource, v)
+			case "attestation":
+				var v VerificationResultAttestation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Attestation = &v
+			case "validator":
+				var v VerificationResultValidator
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Validator = append(r.Validator, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the VerificationResult as properties to the RDF node n
+func (r VerificationResult) turtle(n *turtleNode) {
+	n.resource("VerificationResult", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Target {
+		n.element("VerificationResult.target", i, v)
+	}
+	for i, v := range r.TargetLocation {
+		n.primitive("VerificationResult.targetLocation", i, v, elementAt(r.TargetLocationElement, i), "string")
+	}
+	n.element("VerificationResult.need", -1, r.Need)
+	n.primitive("VerificationResult.status", -1, r.Status, r.StatusElement, "string")
+	n.primitive("VerificationResult.statusDate", -1, r.StatusDate, r.StatusDateElement, "dateTime")
+	n.element("VerificationResult.validationType", -1, r.ValidationType)
+	for i, v := range r.ValidationProcess {
+		n.element("VerificationResult.validationProcess", i, v)
+	}
+	n.element("VerificationResult.frequency", -1, r.Frequency)
+	n.primitive("VerificationResult.lastPerformed", -1, r.LastPerformed, r.LastPerformedElement, "dateTime")
+	n.primitive("VerificationResult.nextScheduled", -1, r.NextScheduled, r.NextScheduledElement, "date")
+	n.element("VerificationResult.failureAction", -1, r.FailureAction)
+	for i, v := range r.PrimarySource {
+		n.element("VerificationResult.primarySource", i, v)
+	}
+	n.element("VerificationResult.attestation", -1, r.Attestation)
+	for i, v := range r.Validator {
+		n.element("VerificationResult.validator", i, v)
+	}
+}
+
+// MarshalBSON marshals the given VerificationResult as BSON document with the structure of its FHIR JSON
+func (r VerificationResult) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given VerificationResult from a BSON document with the structure of its FHIR JSON
+func (r *VerificationResult) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given VerificationResult as protobuf message VerificationResult of fhir.proto
+func (r VerificationResult) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the VerificationResult to the encoded message
+func (r VerificationResult) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Target {
+		e.message(9, v)
+	}
+	for _, v := range r.TargetLocation {
+		e.string(10, v)
+	}
+	if r.Need != nil {
+		e.message(11, *r.Need)
+	}
+	if r.Status != "" {
+		e.string(12, r.Status)
+	}
+	if r.StatusDate != nil {
+		e.string(13, *r.StatusDate)
+	}
+	if r.ValidationType != nil {
+		e.message(14, *r.ValidationType)
+	}
+	for _, v := range r.ValidationProcess {
+		e.message(15, v)
+	}
+	if r.Frequency != nil {
+		e.message(16, *r.Frequency)
+	}
+	if r.LastPerformed != nil {
+		e.string(17, *r.LastPerformed)
+	}
+	if r.NextScheduled != nil {
+		e.string(18, *r.NextScheduled)
+	}
+	if r.FailureAction != nil {
+		e.message(19, *r.FailureAction)
+	}
+	for _, v := range r.PrimarySource {
+		e.message(20, v)
+	}
+	if r.Attestation != nil {
+		e.message(21, *r.Attestation)
+	}
+	for _, v := range r.Validator {
+		e.message(22, v)
+	}
+	if r.IdElement != nil {
+		e.message(23, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(24, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(25, *r.LanguageElement)
+	}
+	for _, v := range r.TargetLocationElement {
+		e.element(26, v)
+	}
+	if r.StatusElement != nil {
+		e.message(27, *r.StatusElement)
+	}
+	if r.StatusDateElement != nil {
+		e.message(28, *r.StatusDateElement)
+	}
+	if r.LastPerformedElement != nil {
+		e.message(29, *r.LastPerformedElement)
+	}
+	if r.NextScheduledElement != nil {
+		e.message(30, *r.NextScheduledElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given VerificationResult from protobuf message VerificationResult of fhir.proto
+func (r *VerificationResult) UnmarshalProto(b []byte) error {
+	*r = VerificationResult{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Reference
+			d.message(&v)
+			r.Target = append(r.Target, v)
+		case 10:
+			r.TargetLocation = append(r.TargetLocation, d.string())
+		case 11:
+			var v CodeableConcept
+			d.message(&v)
+			r.Need = &v
+		case 12:
+			r.Status = d.string()
+		case 13:
+			v := d.string()
+			r.StatusDate = &v
+		case 14:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValidationType = &v
+		case 15:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValidationProcess = append(r.ValidationProcess, v)
+		case 16:
+			var v Timing
+			d.message(&v)
+			r.Frequency = &v
+		case 17:
+			v := d.string()
+			r.LastPerformed = &v
+		case 18:
+			v := d.string()
+			r.NextScheduled = &v
+		case 19:
+			var v CodeableConcept
+			d.message(&v)
+			r.FailureAction = &v
+		case 20:
+			var v VerificationResultPrimarySource
+			d.message(&v)
+			r.PrimarySource = append(r.PrimarySource, v)
+		case 21:
+			var v VerificationResultAttestation
+			d.message(&v)
+			r.Attestation = &v
+		case 22:
+			var v VerificationResultValidator
+			d.message(&v)
+			r.Validator = append(r.Validator, v)
+		case 23:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 26:
+			r.TargetLocationElement = append(r.TargetLocationElement, d.element())
+		case 27:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 28:
+			var v Element
+			d.message(&v)
+			r.StatusDateElement = &v
+		case 29:
+			var v Element
+			d.message(&v)
+			r.LastPerformedElement = &v
+		case 30:
+			var v Element
+			d.message(&v)
+			r.NextScheduledElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given VerificationResultPrimarySource as JSON into a byte slice
+func (r VerificationResultPrimarySource) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the VerificationResultPrimarySource as JSON object with the members in the order of the fields
+func (r VerificationResultPrimarySource) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Who != nil {
+		e.key("who")
+		r.Who.appendJSON(e)
+	}
+	if len(r.Type) > 0 {
+		e.key("type")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Type {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.CommunicationMethod) > 0 {
+		e.key("communicationMethod")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.CommunicationMethod {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.ValidationStatus != nil {
+		e.key("validationStatus")
+		r.ValidationStatus.appendJSON(e)
+	}
+	if r.ValidationDate != nil {
+		e.key("validationDate")
+		e.string(*r.ValidationDate)
+	}
+	if r.ValidationDateElement != nil {
+		e.key("_validationDate")
+		r.ValidationDateElement.appendJSON(e)
+	}
+	if r.CanPushUpdates != nil {
+		e.key("canPushUpdates")
+		r.CanPushUpdates.appendJSON(e)
+	}
+	if len(r.PushTypeAvailable) > 0 {
+		e.key("pushTypeAvailable")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.PushTypeAvailable {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given VerificationResultPrimarySource from JSON. Unknown members are ignored.
+func (r *VerificationResultPrimarySource) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the VerificationResultPrimarySource
+func (r *VerificationResultPrimarySource) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "who":
+			if d.null() {
+				r.Who = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Who = &v
+			}
+		case "type":
+			a := d.array()
+			r.Type = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.Type = append(r.Type, v)
+			}
+			if r.Type == nil && !a.isNull {
+				r.Type = []CodeableConcept{}
+			}
+		case "communicationMethod":
+			a := d.array()
+			r.CommunicationMethod = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CommunicationMethod = append(r.CommunicationMethod, v)
+			}
+			if r.CommunicationMethod == nil && !a.isNull {
+				r.CommunicationMethod = []CodeableConcept{}
+			}
+		case "validationStatus":
+			if d.null() {
+				r.ValidationStatus = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.ValidationStatus = &v
+			}
+		case "validationDate":
+			if v, ok := d.string(); ok {
+				r.ValidationDate = &v
+			} else {
+				r.ValidationDate = nil
+			}
+		case "_validationDate":
+			r.ValidationDateElement = d.element()
+		case "canPushUpdates":
+			if d.null() {
+				r.CanPushUpdates = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CanPushUpdates = &v
+			}
+		case "pushTypeAvailable":
+			a := d.array()
+			r.PushTypeAvailable = nil
+			for a.next() {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.PushTypeAvailable = append(r.PushTypeAvailable, v)
+			}
+			if r.PushTypeAvailable == nil && !a.isNull {
+				r.PushTypeAvailable = []CodeableConcept{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the VerificationResultPrimarySource which shares no memory with the original
+func (r VerificationResultPrimarySource) DeepCopy() VerificationResultPrimarySource {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Who != nil {
+		v := r.Who.DeepCopy()
+		out.Who = &v
+	}
+	if r.Type != nil {
+		out.Type = make([]CodeableConcept, len(r.Type))
+		for i := range r.Type {
+			out.Type[i] = r.Type[i].DeepCopy()
+		}
+	}
+	if r.CommunicationMethod != nil {
+		out.CommunicationMethod = make([]CodeableConcept, len(r.CommunicationMethod))
+		for i := range r.CommunicationMethod {
+			out.CommunicationMethod[i] = r.CommunicationMethod[i].DeepCopy()
+		}
+	}
+	if r.ValidationStatus != nil {
+		v := r.ValidationStatus.DeepCopy()
+		out.ValidationStatus = &v
+	}
+	if r.ValidationDate != nil {
+		v := *r.ValidationDate
+		out.ValidationDate = &v
+	}
+	if r.ValidationDateElement != nil {
+		v := r.ValidationDateElement.DeepCopy()
+		out.ValidationDateElement = &v
+	}
+	if r.CanPushUpdates != nil {
+		v := r.CanPushUpdates.DeepCopy()
+		out.CanPushUpdates = &v
+	}
+	if r.PushTypeAvailable != nil {
+		out.PushTypeAvailable = make([]CodeableConcept, len(r.PushTypeAvailable))
+		for i := range r.PushTypeAvailable {
+			out.PushTypeAvailable[i] = r.PushTypeAvailable[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r VerificationResultPrimarySource) Equal(other VerificationResultPrimarySource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Who == nil) != (other.Who == nil) || r.Who != nil && !r.Who.Equal(*other.Who) {
+		return false
+	}
+	if len(r.Type) != len(other.Type) {
+		return false
+	}
+	for i := range r.Type {
+		if !r.Type[i].Equal(other.Type[i]) {
+			return false
+		}
+	}
+	if len(r.CommunicationMethod) != len(other.CommunicationMethod) {
+		return false
+	}
+	for i := range r.CommunicationMethod {
+		if !r.CommunicationMethod[i].Equal(other.CommunicationMethod[i]) {
+			return false
+		}
+	}
+	if (r.ValidationStatus == nil) != (other.ValidationStatus == nil) || r.ValidationStatus != nil && !r.ValidationStatus.Equal(*other.ValidationStatus) {
+		return false
+	}
+	if (r.ValidationDate == nil) != (other.ValidationDate == nil) || r.ValidationDate != nil && *r.ValidationDate != *other.ValidationDate {
+		return false
+	}
+	if (r.ValidationDateElement == nil) != (other.ValidationDateElement == nil) || r.ValidationDateElement != nil && !r.ValidationDateElement.Equal(*other.ValidationDateElement) {
+		return false
+	}
+	if (r.CanPushUpdates == nil) != (other.CanPushUpdates == nil) || r.CanPushUpdates != nil && !r.CanPushUpdates.Equal(*other.CanPushUpdates) {
+		return false
+	}
+	if len(r.PushTypeAvailable) != len(other.PushTypeAvailable) {
+		return false
+	}
+	for i := range r.PushTypeAvailable {
+		if !r.PushTypeAvailable[i].Equal(other.PushTypeAvailable[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r VerificationResultPrimarySource) EqualsDeep(other VerificationResultPrimarySource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Who == nil) != (other.Who == nil) || r.Who != nil && !r.Who.EqualsDeep(*other.Who) {
+		return false
+	}
+	if !equivalentList(len(r.Type), len(other.Type), func(i, j int) bool {
+		return r.Type[i].EqualsDeep(other.Type[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.CommunicationMethod), len(other.CommunicationMethod), func(i, j int) bool {
+		return r.CommunicationMethod[i].EqualsDeep(other.CommunicationMethod[j])
+	}) {
+		return false
+	}
+	if (r.ValidationStatus == nil) != (other.ValidationStatus == nil) || r.ValidationStatus != nil && !r.ValidationStatus.EqualsDeep(*other.ValidationStatus) {
+		return false
+	}
+	if (r.ValidationDate == nil) != (other.ValidationDate == nil) || r.ValidationDate != nil && !equivalentString(*r.ValidationDate, *other.ValidationDate) {
+		return false
+	}
+	if (r.ValidationDateElement == nil) != (other.ValidationDateElement == nil) || r.ValidationDateElement != nil && !r.ValidationDateElement.EqualsDeep(*other.ValidationDateElement) {
+		return false
+	}
+	if (r.CanPushUpdates == nil) != (other.CanPushUpdates == nil) || r.CanPushUpdates != nil && !r.CanPushUpdates.EqualsDeep(*other.CanPushUpdates) {
+		return false
+	}
+	if !equivalentList(len(r.PushTypeAvailable), len(other.PushTypeAvailable), func(i, j int) bool {
+		return r.PushTypeAvailable[i].EqualsDeep(other.PushTypeAvailable[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r VerificationResultPrimarySource) EqualsShallow(other VerificationResultPrimarySource) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ValidationDate == nil) != (other.ValidationDate == nil) || r.ValidationDate != nil && !equivalentString(*r.ValidationDate, *other.ValidationDate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given VerificationResultPrimarySource as FHIR XML
+func (r VerificationResultPrimarySource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "who", r.Who); err != nil {
+		return err
+	}
+	for _, v := range r.Type {
+		if err := encodeXMLElement(e, "type", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.CommunicationMethod {
+		if err := encodeXMLElement(e, "communicationMethod", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "validationStatus", r.ValidationStatus); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "validationDate", r.ValidationDate, r.ValidationDateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "canPushUpdates", r.CanPushUpdates); err != nil {
+		return err
+	}
+	for _, v := range r.PushTypeAvailable {
+		if err := encodeXMLElement(e, "pushTypeAvailable", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given VerificationResultPrimarySource from FHIR XML
+func (r *VerificationResultPrimarySource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "who":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Who = &v
+			case "type":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Type = append(r.Type, v)
+			case "communicationMethod":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CommunicationMethod = append(r.CommunicationMethod, v)
+			case "validationStatus":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ValidationStatus = &v
+			case "validationDate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ValidationDate = &v
+				}
+				r.ValidationDateElement = element
+			case "canPushUpdates":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CanPushUpdates = &v
+			case "pushTypeAvailable":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.PushTypeAvailable = append(r.PushTypeAvailable, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the VerificationResultPrimarySource as properties to the RDF node n
+func (r VerificationResultPrimarySource) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("VerificationResult.primarySource.who", -1, r.Who)
+	for i, v := range r.Type {
+		n.element("VerificationResult.primarySource.type", i, v)
+	}
+	for i, v := range r.CommunicationMethod {
+		n.element("VerificationResult.primarySource.communicationMethod", i, v)
+	}
+	n.element("VerificationResult.primarySource.validationStatus", -1, r.ValidationStatus)
+	n.primitive("VerificationResult.primarySource.validationDate", -1, r.ValidationDate, r.ValidationDateElement, "dateTime")
+	n.element("VerificationResult.primarySource.canPushUpdates", -1, r.CanPushUpdates)
+	for i, v := range r.PushTypeAvailable {
+		n.element("VerificationResult.primarySource.pushTypeAvailable", i, v)
+	}
+}
+
+// MarshalBSON marshals the given VerificationResultPrimarySource as BSON document with the structure of its FHIR JSON
+func (r VerificationResultPrimarySource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given VerificationResultPrimarySource from a BSON document with the structure of its FHIR JSON
+func (r *VerificationResultPrimarySource) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given VerificationResultPrimarySource as protobuf message VerificationResultPrimarySource of fhir.proto
+func (r VerificationResultPrimarySource) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the VerificationResultPrimarySource to the encoded message
+func (r VerificationResultPrimarySource) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Who != nil {
+		e.message(4, *r.Who)
+	}
+	for _, v := range r.Type {
+		e.message(5, v)
+	}
+	for _, v := range r.CommunicationMethod {
+		e.message(6, v)
+	}
+	if r.ValidationStatus != nil {
+		e.message(7, *r.ValidationStatus)
+	}
+	if r.ValidationDate != nil {
+		e.string(8, *r.ValidationDate)
+	}
+	if r.CanPushUpdates != nil {
+		e.message(9, *r.CanPushUpdates)
+	}
+	for _, v := range r.PushTypeAvailable {
+		e.message(10, v)
+	}
+	if r.ValidationDateElement != nil {
+		e.message(11, *r.ValidationDateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given VerificationResultPrimarySource from protobuf message VerificationResultPrimarySource of fhir.proto
+func (r *VerificationResultPrimarySource) UnmarshalProto(b []byte) error {
+	*r = VerificationResultPrimarySource{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Who = &v
+		case 5:
+			var v CodeableConcept
+			d.message(&v)
+			r.Type = append(r.Type, v)
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.CommunicationMethod = append(r.CommunicationMethod, v)
+		case 7:
+			var v CodeableConcept
+			d.message(&v)
+			r.ValidationStatus = &v
+		case 8:
+			v := d.string()
+			r.ValidationDate = &v
+		case 9:
+			var v CodeableConcept
+			d.message(&v)
+			r.CanPushUpdates = &v
+		case 10:
+			var v CodeableConcept
+			d.message(&v)
+			r.PushTypeAvailable = append(r.PushTypeAvailable, v)
+		case 11:
+			var v Element
+			d.message(&v)
+			r.ValidationDateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given VerificationResultAttestation as JSON into a byte slice
+func (r VerificationResultAttestation) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the VerificationResultAttestation as JSON object with the members in the order of the fields
+func (r VerificationResultAttestation) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Who != nil {
+		e.key("who")
+		r.Who.appendJSON(e)
+	}
+	if r.OnBehalfOf != nil {
+		e.key("onBehalfOf")
+		r.OnBehalfOf.appendJSON(e)
+	}
+	if r.CommunicationMethod != nil {
+		e.key("communicationMethod")
+		r.CommunicationMethod.appendJSON(e)
+	}
+	if r.Date != nil {
+		e.key("date")
+		e.string(*r.Date)
+	}
+	if r.DateElement != nil {
+		e.key("_date")
+		r.DateElement.appendJSON(e)
+	}
+	if r.SourceIdentityCertificate != nil {
+		e.key("sourceIdentityCertificate")
+		e.string(*r.SourceIdentityCertificate)
+	}
+	if r.SourceIdentityCertificateElement != nil {
+		e.key("_sourceIdentityCertificate")
+		r.SourceIdentityCertificateElement.appendJSON(e)
+	}
+	if r.ProxyIdentityCertificate != nil {
+		e.key("proxyIdentityCertificate")
+		e.string(*r.ProxyIdentityCertificate)
+	}
+	if r.ProxyIdentityCertificateElement != nil {
+		e.key("_proxyIdentityCertificate")
+		r.ProxyIdentityCertificateElement.appendJSON(e)
+	}
+	if r.ProxySignature != nil {
+		e.key("proxySignature")
+		r.ProxySignature.appendJSON(e)
+	}
+	if r.SourceSignature != nil {
+		e.key("sourceSignature")
+		r.SourceSignature.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given VerificationResultAttestation from JSON. Unknown members are ignored.
+func (r *VerificationResultAttestation) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the VerificationResultAttestation
+func (r *VerificationResultAttestation) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "who":
+			if d.null() {
+				r.Who = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Who = &v
+			}
+		case "onBehalfOf":
+			if d.null() {
+				r.OnBehalfOf = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.OnBehalfOf = &v
+			}
+		case "communicationMethod":
+			if d.null() {
+				r.CommunicationMethod = nil
+			} else {
+				var v CodeableConcept
+				v.decodeJSON(d)
+				r.CommunicationMethod = &v
+			}
+		case "date":
+			if v, ok := d.string(); ok {
+				r.Date = &v
+			} else {
+				r.Date = nil
+			}
+		case "_date":
+			r.DateElement = d.element()
+		case "sourceIdentityCertificate":
+			if v, ok := d.string(); ok {
+				r.SourceIdentityCertificate = &v
+			} else {
+				r.SourceIdentityCertificate = nil
+			}
+		case "_sourceIdentityCertificate":
+			r.SourceIdentityCertificateElement = d.element()
+		case "proxyIdentityCertificate":
+			if v, ok := d.string(); ok {
+				r.ProxyIdentityCertificate = &v
+			} else {
+				r.ProxyIdentityCertificate = nil
+			}
+		case "_proxyIdentityCertificate":
+			r.ProxyIdentityCertificateElement = d.element()
+		case "proxySignature":
+			if d.null() {
+				r.ProxySignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.ProxySignature = &v
+			}
+		case "sourceSignature":
+			if d.null() {
+				r.SourceSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.SourceSignature = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the VerificationResultAttestation which shares no memory with the original
+func (r VerificationResultAttestation) DeepCopy() VerificationResultAttestation {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Who != nil {
+		v := r.Who.DeepCopy()
+		out.Who = &v
+	}
+	if r.OnBehalfOf != nil {
+		v := r.OnBehalfOf.DeepCopy()
+		out.OnBehalfOf = &v
+	}
+	if r.CommunicationMethod != nil {
+		v := r.CommunicationMethod.DeepCopy()
+		out.CommunicationMethod = &v
+	}
+	if r.Date != nil {
+		v := *r.Date
+		out.Date = &v
+	}
+	if r.DateElement != nil {
+		v := r.DateElement.DeepCopy()
+		out.DateElement = &v
+	}
+	if r.SourceIdentityCertificate != nil {
+		v := *r.SourceIdentityCertificate
+		out.SourceIdentityCertificate = &v
+	}
+	if r.SourceIdentityCertificateElement != nil {
+		v := r.SourceIdentityCertificateElement.DeepCopy()
+		out.SourceIdentityCertificateElement = &v
+	}
+	if r.ProxyIdentityCertificate != nil {
+		v := *r.ProxyIdentityCertificate
+		out.ProxyIdentityCertificate = &v
+	}
+	if r.ProxyIdentityCertificateElement != nil {
+		v := r.ProxyIdentityCertificateElement.DeepCopy()
+		out.ProxyIdentityCertificateElement = &v
+	}
+	if r.ProxySignature != nil {
+		v := r.ProxySignature.DeepCopy()
+		out.ProxySignature = &v
+	}
+	if r.SourceSignature != nil {
+		v := r.SourceSignature.DeepCopy()
+		out.SourceSignature = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r VerificationResultAttestation) Equal(other VerificationResultAttestation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if (r.Who == nil) != (other.Who == nil) || r.Who != nil && !r.Who.Equal(*other.Who) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.Equal(*other.OnBehalfOf) {
+		return false
+	}
+	if (r.CommunicationMethod == nil) != (other.CommunicationMethod == nil) || r.CommunicationMethod != nil && !r.CommunicationMethod.Equal(*other.CommunicationMethod) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && *r.Date != *other.Date {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.Equal(*other.DateElement) {
+		return false
+	}
+	if (r.SourceIdentityCertificate == nil) != (other.SourceIdentityCertificate == nil) || r.SourceIdentityCertificate != nil && *r.SourceIdentityCertificate != *other.SourceIdentityCertificate {
+		return false
+	}
+	if (r.SourceIdentityCertificateElement == nil) != (other.SourceIdentityCertificateElement == nil) || r.SourceIdentityCertificateElement != nil && !r.SourceIdentityCertificateElement.Equal(*other.SourceIdentityCertificateElement) {
+		return false
+	}
+	if (r.ProxyIdentityCertificate == nil) != (other.ProxyIdentityCertificate == nil) || r.ProxyIdentityCertificate != nil && *r.ProxyIdentityCertificate != *other.ProxyIdentityCertificate {
+		return false
+	}
+	if (r.ProxyIdentityCertificateElement == nil) != (other.ProxyIdentityCertificateElement == nil) || r.ProxyIdentityCertificateElement != nil && !r.ProxyIdentityCertificateElement.Equal(*other.ProxyIdentityCertificateElement) {
+		return false
+	}
+	if (r.ProxySignature == nil) != (other.ProxySignature == nil) || r.ProxySignature != nil && !r.ProxySignature.Equal(*other.ProxySignature) {
+		return false
+	}
+	if (r.SourceSignature == nil) != (other.SourceSignature == nil) || r.SourceSignature != nil && !r.SourceSignature.Equal(*other.SourceSignature) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r VerificationResultAttestation) EqualsDeep(other VerificationResultAttestation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if (r.Who == nil) != (other.Who == nil) || r.Who != nil && !r.Who.EqualsDeep(*other.Who) {
+		return false
+	}
+	if (r.OnBehalfOf == nil) != (other.OnBehalfOf == nil) || r.OnBehalfOf != nil && !r.OnBehalfOf.EqualsDeep(*other.OnBehalfOf) {
+		return false
+	}
+	if (r.CommunicationMethod == nil) != (other.CommunicationMethod == nil) || r.CommunicationMethod != nil && !r.CommunicationMethod.EqualsDeep(*other.CommunicationMethod) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.DateElement == nil) != (other.DateElement == nil) || r.DateElement != nil && !r.DateElement.EqualsDeep(*other.DateElement) {
+		return false
+	}
+	if (r.SourceIdentityCertificate == nil) != (other.SourceIdentityCertificate == nil) || r.SourceIdentityCertificate != nil && !equivalentString(*r.SourceIdentityCertificate, *other.SourceIdentityCertificate) {
+		return false
+	}
+	if (r.SourceIdentityCertificateElement == nil) != (other.SourceIdentityCertificateElement == nil) || r.SourceIdentityCertificateElement != nil && !r.SourceIdentityCertificateElement.EqualsDeep(*other.SourceIdentityCertificateElement) {
+		return false
+	}
+	if (r.ProxyIdentityCertificate == nil) != (other.ProxyIdentityCertificate == nil) || r.ProxyIdentityCertificate != nil && !equivalentString(*r.ProxyIdentityCertificate, *other.ProxyIdentityCertificate) {
+		return false
+	}
+	if (r.ProxyIdentityCertificateElement == nil) != (other.ProxyIdentityCertificateElement == nil) || r.ProxyIdentityCertificateElement != nil && !r.ProxyIdentityCertificateElement.EqualsDeep(*other.ProxyIdentityCertificateElement) {
+		return false
+	}
+	if (r.ProxySignature == nil) != (other.ProxySignature == nil) || r.ProxySignature != nil && !r.ProxySignature.EqualsDeep(*other.ProxySignature) {
+		return false
+	}
+	if (r.SourceSignature == nil) != (other.SourceSignature == nil) || r.SourceSignature != nil && !r.SourceSignature.EqualsDeep(*other.SourceSignature) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r VerificationResultAttestation) EqualsShallow(other VerificationResultAttestation) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.Date == nil) != (other.Date == nil) || r.Date != nil && !equivalentString(*r.Date, *other.Date) {
+		return false
+	}
+	if (r.SourceIdentityCertificate == nil) != (other.SourceIdentityCertificate == nil) || r.SourceIdentityCertificate != nil && !equivalentString(*r.SourceIdentityCertificate, *other.SourceIdentityCertificate) {
+		return false
+	}
+	if (r.ProxyIdentityCertificate == nil) != (other.ProxyIdentityCertificate == nil) || r.ProxyIdentityCertificate != nil && !equivalentString(*r.ProxyIdentityCertificate, *other.ProxyIdentityCertificate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given VerificationResultAttestation as FHIR XML
+func (r VerificationResultAttestation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "who", r.Who); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "onBehalfOf", r.OnBehalfOf); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "communicationMethod", r.CommunicationMethod); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "date", r.Date, r.DateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sourceIdentityCertificate", r.SourceIdentityCertificate, r.SourceIdentityCertificateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "proxyIdentityCertificate", r.ProxyIdentityCertificate, r.ProxyIdentityCertificateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "proxySignature", r.ProxySignature); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "sourceSignature", r.SourceSignature); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given VerificationResultAttestation from FHIR XML
+func (r *VerificationResultAttestation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "who":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Who = &v
+			case "onBehalfOf":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.OnBehalfOf = &v
+			case "communicationMethod":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.CommunicationMethod = &v
+			case "date":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Date = &v
+				}
+				r.DateElement = element
+			case "sourceIdentityCertificate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.SourceIdentityCertificate = &v
+				}
+				r.SourceIdentityCertificateElement = element
+			case "proxyIdentityCertificate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ProxyIdentityCertificate = &v
+				}
+				r.ProxyIdentityCertificateElement = element
+			case "proxySignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ProxySignature = &v
+			case "sourceSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.SourceSignature = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the VerificationResultAttestation as properties to the RDF node n
+func (r VerificationResultAttestation) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("VerificationResult.attestation.who", -1, r.Who)
+	n.element("VerificationResult.attestation.onBehalfOf", -1, r.OnBehalfOf)
+	n.element("VerificationResult.attestation.communicationMethod", -1, r.CommunicationMethod)
+	n.primitive("VerificationResult.attestation.date", -1, r.Date, r.DateElement, "date")
+	n.primitive("VerificationResult.attestation.sourceIdentityCertificate", -1, r.SourceIdentityCertificate, r.SourceIdentityCertificateElement, "string")
+	n.primitive("VerificationResult.attestation.proxyIdentityCertificate", -1, r.ProxyIdentityCertificate, r.ProxyIdentityCertificateElement, "string")
+	n.element("VerificationResult.attestation.proxySignature", -1, r.ProxySignature)
+	n.element("VerificationResult.attestation.sourceSignature", -1, r.SourceSignature)
+}
+
+// MarshalBSON marshals the given VerificationResultAttestation as BSON document with the structure of its FHIR JSON
+func (r VerificationResultAttestation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given VerificationResultAttestation from a BSON document with the structure of its FHIR JSON
+func (r *VerificationResultAttestation) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given VerificationResultAttestation as protobuf message VerificationResultAttestation of fhir.proto
+func (r VerificationResultAttestation) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the VerificationResultAttestation to the encoded message
+func (r VerificationResultAttestation) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Who != nil {
+		e.message(4, *r.Who)
+	}
+	if r.OnBehalfOf != nil {
+		e.message(5, *r.OnBehalfOf)
+	}
+	if r.CommunicationMethod != nil {
+		e.message(6, *r.CommunicationMethod)
+	}
+	if r.Date != nil {
+		e.string(7, *r.Date)
+	}
+	if r.SourceIdentityCertificate != nil {
+		e.string(8, *r.SourceIdentityCertificate)
+	}
+	if r.ProxyIdentityCertificate != nil {
+		e.string(9, *r.ProxyIdentityCertificate)
+	}
+	if r.ProxySignature != nil {
+		e.message(10, *r.ProxySignature)
+	}
+	if r.SourceSignature != nil {
+		e.message(11, *r.SourceSignature)
+	}
+	if r.DateElement != nil {
+		e.message(12, *r.DateElement)
+	}
+	if r.SourceIdentityCertificateElement != nil {
+		e.message(13, *r.SourceIdentityCertificateElement)
+	}
+	if r.ProxyIdentityCertificateElement != nil {
+		e.message(14, *r.ProxyIdentityCertificateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given VerificationResultAttestation from protobuf message VerificationResultAttestation of fhir.proto
+func (r *VerificationResultAttestation) UnmarshalProto(b []byte) error {
+	*r = VerificationResultAttestation{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Who = &v
+		case 5:
+			var v Reference
+			d.message(&v)
+			r.OnBehalfOf = &v
+		case 6:
+			var v CodeableConcept
+			d.message(&v)
+			r.CommunicationMethod = &v
+		case 7:
+			v := d.string()
+			r.Date = &v
+		case 8:
+			v := d.string()
+			r.SourceIdentityCertificate = &v
+		case 9:
+			v := d.string()
+			r.ProxyIdentityCertificate = &v
+		case 10:
+			var v Signature
+			d.message(&v)
+			r.ProxySignature = &v
+		case 11:
+			var v Signature
+			d.message(&v)
+			r.SourceSignature = &v
+		case 12:
+			var v Element
+			d.message(&v)
+			r.DateElement = &v
+		case 13:
+			var v Element
+			d.message(&v)
+			r.SourceIdentityCertificateElement = &v
+		case 14:
+			var v Element
+			d.message(&v)
+			r.ProxyIdentityCertificateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given VerificationResultValidator as JSON into a byte slice
+func (r VerificationResultValidator) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the VerificationResultValidator as JSON object with the members in the order of the fields
+func (r VerificationResultValidator) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("organization")
+	r.Organization.appendJSON(e)
+	if r.IdentityCertificate != nil {
+		e.key("identityCertificate")
+		e.string(*r.IdentityCertificate)
+	}
+	if r.IdentityCertificateElement != nil {
+		e.key("_identityCertificate")
+		r.IdentityCertificateElement.appendJSON(e)
+	}
+	if r.AttestationSignature != nil {
+		e.key("attestationSignature")
+		r.AttestationSignature.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given VerificationResultValidator from JSON. Unknown members are ignored.
+func (r *VerificationResultValidator) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the VerificationResultValidator
+func (r *VerificationResultValidator) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "organization":
+			r.Organization.decodeJSON(d)
+		case "identityCertificate":
+			if v, ok := d.string(); ok {
+				r.IdentityCertificate = &v
+			} else {
+				r.IdentityCertificate = nil
+			}
+		case "_identityCertificate":
+			r.IdentityCertificateElement = d.element()
+		case "attestationSignature":
+			if d.null() {
+				r.AttestationSignature = nil
+			} else {
+				var v Signature
+				v.decodeJSON(d)
+				r.AttestationSignature = &v
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the VerificationResultValidator which shares no memory with the original
+func (r VerificationResultValidator) DeepCopy() VerificationResultValidator {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Organization = r.Organization.DeepCopy()
+	if r.IdentityCertificate != nil {
+		v := *r.IdentityCertificate
+		out.IdentityCertificate = &v
+	}
+	if r.IdentityCertificateElement != nil {
+		v := r.IdentityCertificateElement.DeepCopy()
+		out.IdentityCertificateElement = &v
+	}
+	if r.AttestationSignature != nil {
+		v := r.AttestationSignature.DeepCopy()
+		out.AttestationSignature = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r VerificationResultValidator) Equal(other VerificationResultValidator) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Organization.Equal(other.Organization) {
+		return false
+	}
+	if (r.IdentityCertificate == nil) != (other.IdentityCertificate == nil) || r.IdentityCertificate != nil && *r.IdentityCertificate != *other.IdentityCertificate {
+		return false
+	}
+	if (r.IdentityCertificateElement == nil) != (other.IdentityCertificateElement == nil) || r.IdentityCertificateElement != nil && !r.IdentityCertificateElement.Equal(*other.IdentityCertificateElement) {
+		return false
+	}
+	if (r.AttestationSignature == nil) != (other.AttestationSignature == nil) || r.AttestationSignature != nil && !r.AttestationSignature.Equal(*other.AttestationSignature) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r VerificationResultValidator) EqualsDeep(other VerificationResultValidator) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Organization.EqualsDeep(other.Organization) {
+		return false
+	}
+	if (r.IdentityCertificate == nil) != (other.IdentityCertificate == nil) || r.IdentityCertificate != nil && !equivalentString(*r.IdentityCertificate, *other.IdentityCertificate) {
+		return false
+	}
+	if (r.IdentityCertificateElement == nil) != (other.IdentityCertificateElement == nil) || r.IdentityCertificateElement != nil && !r.IdentityCertificateElement.EqualsDeep(*other.IdentityCertificateElement) {
+		return false
+	}
+	if (r.AttestationSignature == nil) != (other.AttestationSignature == nil) || r.AttestationSignature != nil && !r.AttestationSignature.EqualsDeep(*other.AttestationSignature) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r VerificationResultValidator) EqualsShallow(other VerificationResultValidator) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdentityCertificate == nil) != (other.IdentityCertificate == nil) || r.IdentityCertificate != nil && !equivalentString(*r.IdentityCertificate, *other.IdentityCertificate) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given VerificationResultValidator as FHIR XML
+func (r VerificationResultValidator) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "organization", r.Organization); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "identityCertificate", r.IdentityCertificate, r.IdentityCertificateElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "attestationSignature", r.AttestationSignature); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given VerificationResultValidator from FHIR XML
+func (r *VerificationResultValidator) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "organization":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Organization = v
+			case "identityCertificate":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.IdentityCertificate = &v
+				}
+				r.IdentityCertificateElement = element
+			case "attestationSignature":
+				var v Signature
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.AttestationSignature = &v
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the VerificationResultValidator as properties to the RDF node n
+func (r VerificationResultValidator) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("VerificationResult.validator.organization", -1, r.Organization)
+	n.primitive("VerificationResult.validator.identityCertificate", -1, r.IdentityCertificate, r.IdentityCertificateElement, "string")
+	n.element("VerificationResult.validator.attestationSignature", -1, r.AttestationSignature)
+}
+
+// MarshalBSON marshals the given VerificationResultValidator as BSON document with the structure of its FHIR JSON
+func (r VerificationResultValidator) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given VerificationResultValidator from a BSON document with the structure of its FHIR JSON
+func (r *VerificationResultValidator) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given VerificationResultValidator as protobuf message VerificationResultValidator of fhir.proto
+func (r VerificationResultValidator) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the VerificationResultValidator to the encoded message
+func (r VerificationResultValidator) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Organization)
+	if r.IdentityCertificate != nil {
+		e.string(5, *r.IdentityCertificate)
+	}
+	if r.AttestationSignature != nil {
+		e.message(6, *r.AttestationSignature)
+	}
+	if r.IdentityCertificateElement != nil {
+		e.message(7, *r.IdentityCertificateElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given VerificationResultValidator from protobuf message VerificationResultValidator of fhir.proto
+func (r *VerificationResultValidator) UnmarshalProto(b []byte) error {
+	*r = VerificationResultValidator{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v Reference
+			d.message(&v)
+			r.Organization = v
+		case 5:
+			v := d.string()
+			r.IdentityCertificate = &v
+		case 6:
+			var v Signature
+			d.message(&v)
+			r.AttestationSignature = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.IdentityCertificateElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalVerificationResult unmarshals a VerificationResult.
 func UnmarshalVerificationResult(b []byte) (VerificationResult, error) {
 	var verificationResult VerificationResult
-	if err := json.Unmarshal(b, &verificationResult); err != nil {
+	if err := verificationResult.UnmarshalJSON(b); err != nil {
 		return verificationResult, err
 	}
 	return verificationResult, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -36,8 +35,13 @@ const (
 func (code VisionBase) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *VisionBase) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *VisionBase) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "up":
 		*code = VisionBaseUp
@@ -52,6 +56,12 @@ func (code *VisionBase) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code VisionBase) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *VisionBase) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code VisionBase) String() string {
 	return code.Code()
 }
@@ -94,3 +104,31 @@ func (code VisionBase) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code VisionBase) protoNumber() uint64 {
+	switch code {
+	case VisionBaseUp:
+		return 1
+	case VisionBaseDown:
+		return 2
+	case VisionBaseIn:
+		return 3
+	case VisionBaseOut:
+		return 4
+	}
+	return 0
+}
+func (code *VisionBase) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = VisionBaseUp
+	case 2:
+		*code = VisionBaseDown
+	case 3:
+		*code = VisionBaseIn
+	case 4:
+		*code = VisionBaseOut
+	default:
+		return false
+	}
+	return true
+}
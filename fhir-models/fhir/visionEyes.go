@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -34,8 +33,13 @@ const (
 func (code VisionEyes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *VisionEyes) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *VisionEyes) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "right":
 		*code = VisionEyesRight
@@ -46,6 +50,12 @@ func (code *VisionEyes) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code VisionEyes) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *VisionEyes) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code VisionEyes) String() string {
 	return code.Code()
 }
@@ -76,3 +86,23 @@ func (code VisionEyes) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code VisionEyes) protoNumber() uint64 {
+	switch code {
+	case VisionEyesRight:
+		return 1
+	case VisionEyesLeft:
+		return 2
+	}
+	return 0
+}
+func (code *VisionEyes) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = VisionEyesRight
+	case 2:
+		*code = VisionEyesLeft
+	default:
+		return false
+	}
+	return true
+}
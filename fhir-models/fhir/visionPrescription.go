@@ -14,28 +14,38 @@
 
 package fhir
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"encoding/xml"
+)
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
 // PLEASE DO NOT EDIT BY HAND
 
 // VisionPrescription is documented here http://hl7.org/fhir/StructureDefinition/VisionPrescription
 type VisionPrescription struct {
-	Id                *string                               `bson:"id,omitempty" json:"id,omitempty"`
-	Meta              *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
-	ImplicitRules     *string                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
-	Language          *string                               `bson:"language,omitempty" json:"language,omitempty"`
-	Text              *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
-	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
-	ModifierExtension []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
-	Identifier        []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status            FinancialResourceStatusCodes          `bson:"status" json:"status"`
-	Created           string                                `bson:"created" json:"created"`
-	Patient           Reference                             `bson:"patient" json:"patient"`
-	Encounter         *Reference                            `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	DateWritten       string                                `bson:"dateWritten" json:"dateWritten"`
-	Prescriber        Reference                             `bson:"prescriber" json:"prescriber"`
-	LensSpecification []VisionPrescriptionLensSpecification `bson:"lensSpecification" json:"lensSpecification"`
+	Id                   *string                               `bson:"id,omitempty" json:"id,omitempty"`
+	IdElement            *Element                              `bson:"_id,omitempty" json:"_id,omitempty"`
+	Meta                 *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        *string                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	ImplicitRulesElement *Element                              `bson:"_implicitRules,omitempty" json:"_implicitRules,omitempty"`
+	Language             *string                               `bson:"language,omitempty" json:"language,omitempty"`
+	LanguageElement      *Element                              `bson:"_language,omitempty" json:"_language,omitempty"`
+	Text                 *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            []json.RawMessage                     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier           []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status               FinancialResourceStatusCodes          `bson:"status" json:"status"`
+	StatusElement        *Element                              `bson:"_status,omitempty" json:"_status,omitempty"`
+	Created              string                                `bson:"created" json:"created"`
+	CreatedElement       *Element                              `bson:"_created,omitempty" json:"_created,omitempty"`
+	Patient              Reference                             `bson:"patient" json:"patient"`
+	Encounter            *Reference                            `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	DateWritten          string                                `bson:"dateWritten" json:"dateWritten"`
+	DateWrittenElement   *Element                              `bson:"_dateWritten,omitempty" json:"_dateWritten,omitempty"`
+	Prescriber           Reference                             `bson:"prescriber" json:"prescriber"`
+	LensSpecification    []VisionPrescriptionLensSpecification `bson:"lensSpecification" json:"lensSpecification"`
 }
 type VisionPrescriptionLensSpecification struct {
 	Id                *string                                    `bson:"id,omitempty" json:"id,omitempty"`
@@ -43,17 +53,27 @@ type VisionPrescriptionLensSpecification struct {
 	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Product           CodeableConcept                            `bson:"product" json:"product"`
 	Eye               VisionEyes                                 `bson:"eye" json:"eye"`
+	EyeElement        *Element                                   `bson:"_eye,omitempty" json:"_eye,omitempty"`
 	Sphere            *json.Number                               `bson:"sphere,omitempty" json:"sphere,omitempty"`
+	SphereElement     *Element                                   `bson:"_sphere,omitempty" json:"_sphere,omitempty"`
 	Cylinder          *json.Number                               `bson:"cylinder,omitempty" json:"cylinder,omitempty"`
+	CylinderElement   *Element                                   `bson:"_cylinder,omitempty" json:"_cylinder,omitempty"`
 	Axis              *int                                       `bson:"axis,omitempty" json:"axis,omitempty"`
+	AxisElement       *Element                                   `bson:"_axis,omitempty" json:"_axis,omitempty"`
 	Prism             []VisionPrescriptionLensSpecificationPrism `bson:"prism,omitempty" json:"prism,omitempty"`
 	Add               *json.Number                               `bson:"add,omitempty" json:"add,omitempty"`
+	AddElement        *Element                                   `bson:"_add,omitempty" json:"_add,omitempty"`
 	Power             *json.Number                               `bson:"power,omitempty" json:"power,omitempty"`
+	PowerElement      *Element                                   `bson:"_power,omitempty" json:"_power,omitempty"`
 	BackCurve         *json.Number                               `bson:"backCurve,omitempty" json:"backCurve,omitempty"`
+	BackCurveElement  *Element                                   `bson:"_backCurve,omitempty" json:"_backCurve,omitempty"`
 	Diameter          *json.Number                               `bson:"diameter,omitempty" json:"diameter,omitempty"`
+	DiameterElement   *Element                                   `bson:"_diameter,omitempty" json:"_diameter,omitempty"`
 	Duration          *Quantity                                  `bson:"duration,omitempty" json:"duration,omitempty"`
 	Color             *string                                    `bson:"color,omitempty" json:"color,omitempty"`
+	ColorElement      *Element                                   `bson:"_color,omitempty" json:"_color,omitempty"`
 	Brand             *string                                    `bson:"brand,omitempty" json:"brand,omitempty"`
+	BrandElement      *Element                                   `bson:"_brand,omitempty" json:"_brand,omitempty"`
 	Note              []Annotation                               `bson:"note,omitempty" json:"note,omitempty"`
 }
 type VisionPrescriptionLensSpecificationPrism struct {
@@ -61,25 +81,2493 @@ type VisionPrescriptionLensSpecificationPrism struct {
 	Extension         []Extension `bson:"extension,omitempty" json:"extension,omitempty"`
 	ModifierExtension []Extension `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Amount            json.Number `bson:"amount" json:"amount"`
+	AmountElement     *Element    `bson:"_amount,omitempty" json:"_amount,omitempty"`
 	Base              VisionBase  `bson:"base" json:"base"`
+	BaseElement       *Element    `bson:"_base,omitempty" json:"_base,omitempty"`
 }
-type OtherVisionPrescription VisionPrescription
 
 // MarshalJSON marshals the given VisionPrescription as JSON into a byte slice
 func (r VisionPrescription) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		OtherVisionPrescription
-		ResourceType string `json:"resourceType"`
-	}{
-		OtherVisionPrescription: OtherVisionPrescription(r),
-		ResourceType:            "VisionPrescription",
-	})
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the VisionPrescription as JSON object with the members in the order of the fields
+func (r VisionPrescription) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, "{\"resourceType\":\"VisionPrescription\""...)
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if r.IdElement != nil {
+		e.key("_id")
+		r.IdElement.appendJSON(e)
+	}
+	if r.Meta != nil {
+		e.key("meta")
+		r.Meta.appendJSON(e)
+	}
+	if r.ImplicitRules != nil {
+		e.key("implicitRules")
+		e.string(*r.ImplicitRules)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.key("_implicitRules")
+		r.ImplicitRulesElement.appendJSON(e)
+	}
+	if r.Language != nil {
+		e.key("language")
+		e.string(*r.Language)
+	}
+	if r.LanguageElement != nil {
+		e.key("_language")
+		r.LanguageElement.appendJSON(e)
+	}
+	if r.Text != nil {
+		e.key("text")
+		r.Text.appendJSON(e)
+	}
+	if len(r.Contained) > 0 {
+		e.key("contained")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Contained {
+			e.item()
+			e.raw(v)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.Identifier) > 0 {
+		e.key("identifier")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Identifier {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("status")
+	e.string(r.Status.Code())
+	if r.StatusElement != nil {
+		e.key("_status")
+		r.StatusElement.appendJSON(e)
+	}
+	e.key("created")
+	e.string(r.Created)
+	if r.CreatedElement != nil {
+		e.key("_created")
+		r.CreatedElement.appendJSON(e)
+	}
+	e.key("patient")
+	r.Patient.appendJSON(e)
+	if r.Encounter != nil {
+		e.key("encounter")
+		r.Encounter.appendJSON(e)
+	}
+	e.key("dateWritten")
+	e.string(r.DateWritten)
+	if r.DateWrittenElement != nil {
+		e.key("_dateWritten")
+		r.DateWrittenElement.appendJSON(e)
+	}
+	e.key("prescriber")
+	r.Prescriber.appendJSON(e)
+	e.key("lensSpecification")
+	if r.LensSpecification == nil {
+		e.null()
+	} else {
+		e.buf = append(e.buf, '[')
+		for _, v := range r.LensSpecification {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given VisionPrescription from JSON. Unknown members are ignored.
+func (r *VisionPrescription) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the VisionPrescription
+func (r *VisionPrescription) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "_id":
+			r.IdElement = d.element()
+		case "meta":
+			if d.null() {
+				r.Meta = nil
+			} else {
+				var v Meta
+				v.decodeJSON(d)
+				r.Meta = &v
+			}
+		case "implicitRules":
+			if v, ok := d.string(); ok {
+				r.ImplicitRules = &v
+			} else {
+				r.ImplicitRules = nil
+			}
+		case "_implicitRules":
+			r.ImplicitRulesElement = d.element()
+		case "language":
+			if v, ok := d.string(); ok {
+				r.Language = &v
+			} else {
+				r.Language = nil
+			}
+		case "_language":
+			r.LanguageElement = d.element()
+		case "text":
+			if d.null() {
+				r.Text = nil
+			} else {
+				var v Narrative
+				v.decodeJSON(d)
+				r.Text = &v
+			}
+		case "contained":
+			a := d.array()
+			r.Contained = nil
+			for a.next() {
+				v := d.raw()
+				r.Contained = append(r.Contained, v)
+			}
+			if r.Contained == nil && !a.isNull {
+				r.Contained = []json.RawMessage{}
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "identifier":
+			a := d.array()
+			r.Identifier = nil
+			for a.next() {
+				var v Identifier
+				v.decodeJSON(d)
+				r.Identifier = append(r.Identifier, v)
+			}
+			if r.Identifier == nil && !a.isNull {
+				r.Identifier = []Identifier{}
+			}
+		case "status":
+			d.unmarshal(&r.Status)
+		case "_status":
+			r.StatusElement = d.element()
+		case "created":
+			if v, ok := d.string(); ok {
+				r.Created = v
+			}
+		case "_created":
+			r.CreatedElement = d.element()
+		case "patient":
+			r.Patient.decodeJSON(d)
+		case "encounter":
+			if d.null() {
+				r.Encounter = nil
+			} else {
+				var v Reference
+				v.decodeJSON(d)
+				r.Encounter = &v
+			}
+		case "dateWritten":
+			if v, ok := d.string(); ok {
+				r.DateWritten = v
+			}
+		case "_dateWritten":
+			r.DateWrittenElement = d.element()
+		case "prescriber":
+			r.Prescriber.decodeJSON(d)
+		case "lensSpecification":
+			a := d.array()
+			r.LensSpecification = nil
+			for a.next() {
+				var v VisionPrescriptionLensSpecification
+				v.decodeJSON(d)
+				r.LensSpecification = append(r.LensSpecification, v)
+			}
+			if r.LensSpecification == nil && !a.isNull {
+				r.LensSpecification = []VisionPrescriptionLensSpecification{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the VisionPrescription which shares no memory with the original
+func (r VisionPrescription) DeepCopy() VisionPrescription {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.IdElement != nil {
+		v := r.IdElement.DeepCopy()
+		out.IdElement = &v
+	}
+	if r.Meta != nil {
+		v := r.Meta.DeepCopy()
+		out.Meta = &v
+	}
+	if r.ImplicitRules != nil {
+		v := *r.ImplicitRules
+		out.ImplicitRules = &v
+	}
+	if r.ImplicitRulesElement != nil {
+		v := r.ImplicitRulesElement.DeepCopy()
+		out.ImplicitRulesElement = &v
+	}
+	if r.Language != nil {
+		v := *r.Language
+		out.Language = &v
+	}
+	if r.LanguageElement != nil {
+		v := r.LanguageElement.DeepCopy()
+		out.LanguageElement = &v
+	}
+	if r.Text != nil {
+		v := r.Text.DeepCopy()
+		out.Text = &v
+	}
+	if r.Contained != nil {
+		out.Contained = make([]json.RawMessage, len(r.Contained))
+		for i := range r.Contained {
+			out.Contained[i] = append(json.RawMessage(nil), r.Contained[i]...)
+		}
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.Identifier != nil {
+		out.Identifier = make([]Identifier, len(r.Identifier))
+		for i := range r.Identifier {
+			out.Identifier[i] = r.Identifier[i].DeepCopy()
+		}
+	}
+	if r.StatusElement != nil {
+		v := r.StatusElement.DeepCopy()
+		out.StatusElement = &v
+	}
+	if r.CreatedElement != nil {
+		v := r.CreatedElement.DeepCopy()
+		out.CreatedElement = &v
+	}
+	out.Patient = r.Patient.DeepCopy()
+	if r.Encounter != nil {
+		v := r.Encounter.DeepCopy()
+		out.Encounter = &v
+	}
+	if r.DateWrittenElement != nil {
+		v := r.DateWrittenElement.DeepCopy()
+		out.DateWrittenElement = &v
+	}
+	out.Prescriber = r.Prescriber.DeepCopy()
+	if r.LensSpecification != nil {
+		out.LensSpecification = make([]VisionPrescriptionLensSpecification, len(r.LensSpecification))
+		for i := range r.LensSpecification {
+			out.LensSpecification[i] = r.LensSpecification[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r VisionPrescription) Equal(other VisionPrescription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.Equal(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.Equal(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && *r.ImplicitRules != *other.ImplicitRules {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.Equal(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && *r.Language != *other.Language {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.Equal(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.Equal(*other.Text) {
+		return false
+	}
+	if len(r.Contained) != len(other.Contained) {
+		return false
+	}
+	for i := range r.Contained {
+		if !equalResource(r.Contained[i], other.Contained[i]) {
+			return false
+		}
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if len(r.Identifier) != len(other.Identifier) {
+		return false
+	}
+	for i := range r.Identifier {
+		if !r.Identifier[i].Equal(other.Identifier[i]) {
+			return false
+		}
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.Equal(*other.StatusElement) {
+		return false
+	}
+	if r.Created != other.Created {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.Equal(*other.CreatedElement) {
+		return false
+	}
+	if !r.Patient.Equal(other.Patient) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.Equal(*other.Encounter) {
+		return false
+	}
+	if r.DateWritten != other.DateWritten {
+		return false
+	}
+	if (r.DateWrittenElement == nil) != (other.DateWrittenElement == nil) || r.DateWrittenElement != nil && !r.DateWrittenElement.Equal(*other.DateWrittenElement) {
+		return false
+	}
+	if !r.Prescriber.Equal(other.Prescriber) {
+		return false
+	}
+	if len(r.LensSpecification) != len(other.LensSpecification) {
+		return false
+	}
+	for i := range r.LensSpecification {
+		if !r.LensSpecification[i].Equal(other.LensSpecification[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r VisionPrescription) EqualsDeep(other VisionPrescription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.IdElement == nil) != (other.IdElement == nil) || r.IdElement != nil && !r.IdElement.EqualsDeep(*other.IdElement) {
+		return false
+	}
+	if (r.Meta == nil) != (other.Meta == nil) || r.Meta != nil && !r.Meta.EqualsDeep(*other.Meta) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.ImplicitRulesElement == nil) != (other.ImplicitRulesElement == nil) || r.ImplicitRulesElement != nil && !r.ImplicitRulesElement.EqualsDeep(*other.ImplicitRulesElement) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if (r.LanguageElement == nil) != (other.LanguageElement == nil) || r.LanguageElement != nil && !r.LanguageElement.EqualsDeep(*other.LanguageElement) {
+		return false
+	}
+	if (r.Text == nil) != (other.Text == nil) || r.Text != nil && !r.Text.EqualsDeep(*other.Text) {
+		return false
+	}
+	if !equivalentList(len(r.Contained), len(other.Contained), func(i, j int) bool {
+		return equalResource(r.Contained[i], other.Contained[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.Identifier), len(other.Identifier), func(i, j int) bool {
+		return r.Identifier[i].EqualsDeep(other.Identifier[j])
+	}) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if (r.StatusElement == nil) != (other.StatusElement == nil) || r.StatusElement != nil && !r.StatusElement.EqualsDeep(*other.StatusElement) {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if (r.CreatedElement == nil) != (other.CreatedElement == nil) || r.CreatedElement != nil && !r.CreatedElement.EqualsDeep(*other.CreatedElement) {
+		return false
+	}
+	if !r.Patient.EqualsDeep(other.Patient) {
+		return false
+	}
+	if (r.Encounter == nil) != (other.Encounter == nil) || r.Encounter != nil && !r.Encounter.EqualsDeep(*other.Encounter) {
+		return false
+	}
+	if !equivalentString(r.DateWritten, other.DateWritten) {
+		return false
+	}
+	if (r.DateWrittenElement == nil) != (other.DateWrittenElement == nil) || r.DateWrittenElement != nil && !r.DateWrittenElement.EqualsDeep(*other.DateWrittenElement) {
+		return false
+	}
+	if !r.Prescriber.EqualsDeep(other.Prescriber) {
+		return false
+	}
+	if !equivalentList(len(r.LensSpecification), len(other.LensSpecification), func(i, j int) bool {
+		return r.LensSpecification[i].EqualsDeep(other.LensSpecification[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r VisionPrescription) EqualsShallow(other VisionPrescription) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if (r.ImplicitRules == nil) != (other.ImplicitRules == nil) || r.ImplicitRules != nil && !equivalentString(*r.ImplicitRules, *other.ImplicitRules) {
+		return false
+	}
+	if (r.Language == nil) != (other.Language == nil) || r.Language != nil && !equivalentString(*r.Language, *other.Language) {
+		return false
+	}
+	if r.Status != other.Status {
+		return false
+	}
+	if !equivalentString(r.Created, other.Created) {
+		return false
+	}
+	if !equivalentString(r.DateWritten, other.DateWritten) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given VisionPrescription as FHIR XML
+func (r VisionPrescription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{
+		Local: "VisionPrescription",
+		Space: fhirNamespace,
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "id", r.Id, r.IdElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "meta", r.Meta); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "implicitRules", r.ImplicitRules, r.ImplicitRulesElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "language", r.Language, r.LanguageElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "text", r.Text); err != nil {
+		return err
+	}
+	for _, v := range r.Contained {
+		if err := encodeXMLResource(e, "contained", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.Identifier {
+		if err := encodeXMLElement(e, "identifier", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "status", r.Status, r.StatusElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "created", r.Created, r.CreatedElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "patient", r.Patient); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "encounter", r.Encounter); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "dateWritten", r.DateWritten, r.DateWrittenElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "prescriber", r.Prescriber); err != nil {
+		return err
+	}
+	for _, v := range r.LensSpecification {
+		if err := encodeXMLElement(e, "lensSpecification", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given VisionPrescription from FHIR XML
+func (r *VisionPrescription) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "id":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Id = &v
+				}
+				r.IdElement = element
+			case "meta":
+				var v Meta
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Meta = &v
+			case "implicitRules":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.ImplicitRules = &v
+				}
+				r.ImplicitRulesElement = element
+			case "language":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Language = &v
+				}
+				r.LanguageElement = element
+			case "text":
+				var v Narrative
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Text = &v
+			case "contained":
+				v, err := decodeXMLResource(d, t)
+				if err != nil {
+					return err
+				}
+				r.Contained = append(r.Contained, v)
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "identifier":
+				var v Identifier
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Identifier = append(r.Identifier, v)
+			case "status":
+				var v FinancialResourceStatusCodes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Status = v
+				}
+				r.StatusElement = element
+			case "created":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Created = v
+				}
+				r.CreatedElement = element
+			case "patient":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Patient = v
+			case "encounter":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Encounter = &v
+			case "dateWritten":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.DateWritten = v
+				}
+				r.DateWrittenElement = element
+			case "prescriber":
+				var v Reference
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Prescriber = v
+			case "lensSpecification":
+				var v VisionPrescriptionLensSpecification
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.LensSpecification = append(r.LensSpecification, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the VisionPrescription as properties to the RDF node n
+func (r VisionPrescription) turtle(n *turtleNode) {
+	n.resource("VisionPrescription", r.Id)
+	n.primitive("Resource.id", -1, r.Id, r.IdElement, "string")
+	n.element("Resource.meta", -1, r.Meta)
+	n.primitive("Resource.implicitRules", -1, r.ImplicitRules, r.ImplicitRulesElement, "string")
+	n.primitive("Resource.language", -1, r.Language, r.LanguageElement, "string")
+	n.element("DomainResource.text", -1, r.Text)
+	for i, v := range r.Contained {
+		n.inline("DomainResource.contained", i, v)
+	}
+	for i, v := range r.Extension {
+		n.element("DomainResource.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("DomainResource.modifierExtension", i, v)
+	}
+	for i, v := range r.Identifier {
+		n.element("VisionPrescription.identifier", i, v)
+	}
+	n.primitive("VisionPrescription.status", -1, r.Status, r.StatusElement, "code")
+	n.primitive("VisionPrescription.created", -1, r.Created, r.CreatedElement, "dateTime")
+	n.element("VisionPrescription.patient", -1, r.Patient)
+	n.element("VisionPrescription.encounter", -1, r.Encounter)
+	n.primitive("VisionPrescription.dateWritten", -1, r.DateWritten, r.DateWrittenElement, "dateTime")
+	n.element("VisionPrescription.prescriber", -1, r.Prescriber)
+	for i, v := range r.LensSpecification {
+		n.element("VisionPrescription.lensSpecification", i, v)
+	}
+}
+
+// MarshalBSON marshals the given VisionPrescription as BSON document with the structure of its FHIR JSON
+func (r VisionPrescription) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given VisionPrescription from a BSON document with the structure of its FHIR JSON
+func (r *VisionPrescription) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given VisionPrescription as protobuf message VisionPrescription of fhir.proto
+func (r VisionPrescription) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the VisionPrescription to the encoded message
+func (r VisionPrescription) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	if r.Meta != nil {
+		e.message(2, *r.Meta)
+	}
+	if r.ImplicitRules != nil {
+		e.string(3, *r.ImplicitRules)
+	}
+	if r.Language != nil {
+		e.string(4, *r.Language)
+	}
+	if r.Text != nil {
+		e.message(5, *r.Text)
+	}
+	for _, v := range r.Contained {
+		e.resource(6, v)
+	}
+	for _, v := range r.Extension {
+		e.message(7, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(8, v)
+	}
+	for _, v := range r.Identifier {
+		e.message(9, v)
+	}
+	e.varint(10, r.Status.protoNumber())
+	if r.Created != "" {
+		e.string(11, r.Created)
+	}
+	e.message(12, r.Patient)
+	if r.Encounter != nil {
+		e.message(13, *r.Encounter)
+	}
+	if r.DateWritten != "" {
+		e.string(14, r.DateWritten)
+	}
+	e.message(15, r.Prescriber)
+	for _, v := range r.LensSpecification {
+		e.message(16, v)
+	}
+	if r.IdElement != nil {
+		e.message(17, *r.IdElement)
+	}
+	if r.ImplicitRulesElement != nil {
+		e.message(18, *r.ImplicitRulesElement)
+	}
+	if r.LanguageElement != nil {
+		e.message(19, *r.LanguageElement)
+	}
+	if r.StatusElement != nil {
+		e.message(20, *r.StatusElement)
+	}
+	if r.CreatedElement != nil {
+		e.message(21, *r.CreatedElement)
+	}
+	if r.DateWrittenElement != nil {
+		e.message(22, *r.DateWrittenElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given VisionPrescription from protobuf message VisionPrescription of fhir.proto
+func (r *VisionPrescription) UnmarshalProto(b []byte) error {
+	*r = VisionPrescription{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Meta
+			d.message(&v)
+			r.Meta = &v
+		case 3:
+			v := d.string()
+			r.ImplicitRules = &v
+		case 4:
+			v := d.string()
+			r.Language = &v
+		case 5:
+			var v Narrative
+			d.message(&v)
+			r.Text = &v
+		case 6:
+			r.Contained = append(r.Contained, d.resource())
+		case 7:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 8:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 9:
+			var v Identifier
+			d.message(&v)
+			r.Identifier = append(r.Identifier, v)
+		case 10:
+			var v FinancialResourceStatusCodes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Status = v
+		case 11:
+			r.Created = d.string()
+		case 12:
+			var v Reference
+			d.message(&v)
+			r.Patient = v
+		case 13:
+			var v Reference
+			d.message(&v)
+			r.Encounter = &v
+		case 14:
+			r.DateWritten = d.string()
+		case 15:
+			var v Reference
+			d.message(&v)
+			r.Prescriber = v
+		case 16:
+			var v VisionPrescriptionLensSpecification
+			d.message(&v)
+			r.LensSpecification = append(r.LensSpecification, v)
+		case 17:
+			var v Element
+			d.message(&v)
+			r.IdElement = &v
+		case 18:
+			var v Element
+			d.message(&v)
+			r.ImplicitRulesElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.LanguageElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.StatusElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.CreatedElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.DateWrittenElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given VisionPrescriptionLensSpecification as JSON into a byte slice
+func (r VisionPrescriptionLensSpecification) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the VisionPrescriptionLensSpecification as JSON object with the members in the order of the fields
+func (r VisionPrescriptionLensSpecification) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("product")
+	r.Product.appendJSON(e)
+	e.key("eye")
+	e.string(r.Eye.Code())
+	if r.EyeElement != nil {
+		e.key("_eye")
+		r.EyeElement.appendJSON(e)
+	}
+	if r.Sphere != nil {
+		e.key("sphere")
+		e.number(*r.Sphere)
+	}
+	if r.SphereElement != nil {
+		e.key("_sphere")
+		r.SphereElement.appendJSON(e)
+	}
+	if r.Cylinder != nil {
+		e.key("cylinder")
+		e.number(*r.Cylinder)
+	}
+	if r.CylinderElement != nil {
+		e.key("_cylinder")
+		r.CylinderElement.appendJSON(e)
+	}
+	if r.Axis != nil {
+		e.key("axis")
+		e.int(*r.Axis)
+	}
+	if r.AxisElement != nil {
+		e.key("_axis")
+		r.AxisElement.appendJSON(e)
+	}
+	if len(r.Prism) > 0 {
+		e.key("prism")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Prism {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if r.Add != nil {
+		e.key("add")
+		e.number(*r.Add)
+	}
+	if r.AddElement != nil {
+		e.key("_add")
+		r.AddElement.appendJSON(e)
+	}
+	if r.Power != nil {
+		e.key("power")
+		e.number(*r.Power)
+	}
+	if r.PowerElement != nil {
+		e.key("_power")
+		r.PowerElement.appendJSON(e)
+	}
+	if r.BackCurve != nil {
+		e.key("backCurve")
+		e.number(*r.BackCurve)
+	}
+	if r.BackCurveElement != nil {
+		e.key("_backCurve")
+		r.BackCurveElement.appendJSON(e)
+	}
+	if r.Diameter != nil {
+		e.key("diameter")
+		e.number(*r.Diameter)
+	}
+	if r.DiameterElement != nil {
+		e.key("_diameter")
+		r.DiameterElement.appendJSON(e)
+	}
+	if r.Duration != nil {
+		e.key("duration")
+		r.Duration.appendJSON(e)
+	}
+	if r.Color != nil {
+		e.key("color")
+		e.string(*r.Color)
+	}
+	if r.ColorElement != nil {
+		e.key("_color")
+		r.ColorElement.appendJSON(e)
+	}
+	if r.Brand != nil {
+		e.key("brand")
+		e.string(*r.Brand)
+	}
+	if r.BrandElement != nil {
+		e.key("_brand")
+		r.BrandElement.appendJSON(e)
+	}
+	if len(r.Note) > 0 {
+		e.key("note")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Note {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given VisionPrescriptionLensSpecification from JSON. Unknown members are ignored.
+func (r *VisionPrescriptionLensSpecification) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the VisionPrescriptionLensSpecification
+func (r *VisionPrescriptionLensSpecification) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "product":
+			r.Product.decodeJSON(d)
+		case "eye":
+			d.unmarshal(&r.Eye)
+		case "_eye":
+			r.EyeElement = d.element()
+		case "sphere":
+			if v, ok := d.number(); ok {
+				r.Sphere = &v
+			} else {
+				r.Sphere = nil
+			}
+		case "_sphere":
+			r.SphereElement = d.element()
+		case "cylinder":
+			if v, ok := d.number(); ok {
+				r.Cylinder = &v
+			} else {
+				r.Cylinder = nil
+			}
+		case "_cylinder":
+			r.CylinderElement = d.element()
+		case "axis":
+			if v, ok := d.int(); ok {
+				r.Axis = &v
+			} else {
+				r.Axis = nil
+			}
+		case "_axis":
+			r.AxisElement = d.element()
+		case "prism":
+			a := d.array()
+			r.Prism = nil
+			for a.next() {
+				var v VisionPrescriptionLensSpecificationPrism
+				v.decodeJSON(d)
+				r.Prism = append(r.Prism, v)
+			}
+			if r.Prism == nil && !a.isNull {
+				r.Prism = []VisionPrescriptionLensSpecificationPrism{}
+			}
+		case "add":
+			if v, ok := d.number(); ok {
+				r.Add = &v
+			} else {
+				r.Add = nil
+			}
+		case "_add":
+			r.AddElement = d.element()
+		case "power":
+			if v, ok := d.number(); ok {
+				r.Power = &v
+			} else {
+				r.Power = nil
+			}
+		case "_power":
+			r.PowerElement = d.element()
+		case "backCurve":
+			if v, ok := d.number(); ok {
+				r.BackCurve = &v
+			} else {
+				r.BackCurve = nil
+			}
+		case "_backCurve":
+			r.BackCurveElement = d.element()
+		case "diameter":
+			if v, ok := d.number(); ok {
+				r.Diameter = &v
+			} else {
+				r.Diameter = nil
+			}
+		case "_diameter":
+			r.DiameterElement = d.element()
+		case "duration":
+			if d.null() {
+				r.Duration = nil
+			} else {
+				var v Quantity
+				v.decodeJSON(d)
+				r.Duration = &v
+			}
+		case "color":
+			if v, ok := d.string(); ok {
+				r.Color = &v
+			} else {
+				r.Color = nil
+			}
+		case "_color":
+			r.ColorElement = d.element()
+		case "brand":
+			if v, ok := d.string(); ok {
+				r.Brand = &v
+			} else {
+				r.Brand = nil
+			}
+		case "_brand":
+			r.BrandElement = d.element()
+		case "note":
+			a := d.array()
+			r.Note = nil
+			for a.next() {
+				var v Annotation
+				v.decodeJSON(d)
+				r.Note = append(r.Note, v)
+			}
+			if r.Note == nil && !a.isNull {
+				r.Note = []Annotation{}
+			}
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the VisionPrescriptionLensSpecification which shares no memory with the original
+func (r VisionPrescriptionLensSpecification) DeepCopy() VisionPrescriptionLensSpecification {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	out.Product = r.Product.DeepCopy()
+	if r.EyeElement != nil {
+		v := r.EyeElement.DeepCopy()
+		out.EyeElement = &v
+	}
+	if r.Sphere != nil {
+		v := *r.Sphere
+		out.Sphere = &v
+	}
+	if r.SphereElement != nil {
+		v := r.SphereElement.DeepCopy()
+		out.SphereElement = &v
+	}
+	if r.Cylinder != nil {
+		v := *r.Cylinder
+		out.Cylinder = &v
+	}
+	if r.CylinderElement != nil {
+		v := r.CylinderElement.DeepCopy()
+		out.CylinderElement = &v
+	}
+	if r.Axis != nil {
+		v := *r.Axis
+		out.Axis = &v
+	}
+	if r.AxisElement != nil {
+		v := r.AxisElement.DeepCopy()
+		out.AxisElement = &v
+	}
+	if r.Prism != nil {
+		out.Prism = make([]VisionPrescriptionLensSpecificationPrism, len(r.Prism))
+		for i := range r.Prism {
+			out.Prism[i] = r.Prism[i].DeepCopy()
+		}
+	}
+	if r.Add != nil {
+		v := *r.Add
+		out.Add = &v
+	}
+	if r.AddElement != nil {
+		v := r.AddElement.DeepCopy()
+		out.AddElement = &v
+	}
+	if r.Power != nil {
+		v := *r.Power
+		out.Power = &v
+	}
+	if r.PowerElement != nil {
+		v := r.PowerElement.DeepCopy()
+		out.PowerElement = &v
+	}
+	if r.BackCurve != nil {
+		v := *r.BackCurve
+		out.BackCurve = &v
+	}
+	if r.BackCurveElement != nil {
+		v := r.BackCurveElement.DeepCopy()
+		out.BackCurveElement = &v
+	}
+	if r.Diameter != nil {
+		v := *r.Diameter
+		out.Diameter = &v
+	}
+	if r.DiameterElement != nil {
+		v := r.DiameterElement.DeepCopy()
+		out.DiameterElement = &v
+	}
+	if r.Duration != nil {
+		v := r.Duration.DeepCopy()
+		out.Duration = &v
+	}
+	if r.Color != nil {
+		v := *r.Color
+		out.Color = &v
+	}
+	if r.ColorElement != nil {
+		v := r.ColorElement.DeepCopy()
+		out.ColorElement = &v
+	}
+	if r.Brand != nil {
+		v := *r.Brand
+		out.Brand = &v
+	}
+	if r.BrandElement != nil {
+		v := r.BrandElement.DeepCopy()
+		out.BrandElement = &v
+	}
+	if r.Note != nil {
+		out.Note = make([]Annotation, len(r.Note))
+		for i := range r.Note {
+			out.Note[i] = r.Note[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r VisionPrescriptionLensSpecification) Equal(other VisionPrescriptionLensSpecification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !r.Product.Equal(other.Product) {
+		return false
+	}
+	if r.Eye != other.Eye {
+		return false
+	}
+	if (r.EyeElement == nil) != (other.EyeElement == nil) || r.EyeElement != nil && !r.EyeElement.Equal(*other.EyeElement) {
+		return false
+	}
+	if (r.Sphere == nil) != (other.Sphere == nil) || r.Sphere != nil && !EqualDecimal(*r.Sphere, *other.Sphere) {
+		return false
+	}
+	if (r.SphereElement == nil) != (other.SphereElement == nil) || r.SphereElement != nil && !r.SphereElement.Equal(*other.SphereElement) {
+		return false
+	}
+	if (r.Cylinder == nil) != (other.Cylinder == nil) || r.Cylinder != nil && !EqualDecimal(*r.Cylinder, *other.Cylinder) {
+		return false
+	}
+	if (r.CylinderElement == nil) != (other.CylinderElement == nil) || r.CylinderElement != nil && !r.CylinderElement.Equal(*other.CylinderElement) {
+		return false
+	}
+	if (r.Axis == nil) != (other.Axis == nil) || r.Axis != nil && *r.Axis != *other.Axis {
+		return false
+	}
+	if (r.AxisElement == nil) != (other.AxisElement == nil) || r.AxisElement != nil && !r.AxisElement.Equal(*other.AxisElement) {
+		return false
+	}
+	if len(r.Prism) != len(other.Prism) {
+		return false
+	}
+	for i := range r.Prism {
+		if !r.Prism[i].Equal(other.Prism[i]) {
+			return false
+		}
+	}
+	if (r.Add == nil) != (other.Add == nil) || r.Add != nil && !EqualDecimal(*r.Add, *other.Add) {
+		return false
+	}
+	if (r.AddElement == nil) != (other.AddElement == nil) || r.AddElement != nil && !r.AddElement.Equal(*other.AddElement) {
+		return false
+	}
+	if (r.Power == nil) != (other.Power == nil) || r.Power != nil && !EqualDecimal(*r.Power, *other.Power) {
+		return false
+	}
+	if (r.PowerElement == nil) != (other.PowerElement == nil) || r.PowerElement != nil && !r.PowerElement.Equal(*other.PowerElement) {
+		return false
+	}
+	if (r.BackCurve == nil) != (other.BackCurve == nil) || r.BackCurve != nil && !EqualDecimal(*r.BackCurve, *other.BackCurve) {
+		return false
+	}
+	if (r.BackCurveElement == nil) != (other.BackCurveElement == nil) || r.BackCurveElement != nil && !r.BackCurveElement.Equal(*other.BackCurveElement) {
+		return false
+	}
+	if (r.Diameter == nil) != (other.Diameter == nil) || r.Diameter != nil && !EqualDecimal(*r.Diameter, *other.Diameter) {
+		return false
+	}
+	if (r.DiameterElement == nil) != (other.DiameterElement == nil) || r.DiameterElement != nil && !r.DiameterElement.Equal(*other.DiameterElement) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.Equal(*other.Duration) {
+		return false
+	}
+	if (r.Color == nil) != (other.Color == nil) || r.Color != nil && *r.Color != *other.Color {
+		return false
+	}
+	if (r.ColorElement == nil) != (other.ColorElement == nil) || r.ColorElement != nil && !r.ColorElement.Equal(*other.ColorElement) {
+		return false
+	}
+	if (r.Brand == nil) != (other.Brand == nil) || r.Brand != nil && *r.Brand != *other.Brand {
+		return false
+	}
+	if (r.BrandElement == nil) != (other.BrandElement == nil) || r.BrandElement != nil && !r.BrandElement.Equal(*other.BrandElement) {
+		return false
+	}
+	if len(r.Note) != len(other.Note) {
+		return false
+	}
+	for i := range r.Note {
+		if !r.Note[i].Equal(other.Note[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r VisionPrescriptionLensSpecification) EqualsDeep(other VisionPrescriptionLensSpecification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !r.Product.EqualsDeep(other.Product) {
+		return false
+	}
+	if r.Eye != other.Eye {
+		return false
+	}
+	if (r.EyeElement == nil) != (other.EyeElement == nil) || r.EyeElement != nil && !r.EyeElement.EqualsDeep(*other.EyeElement) {
+		return false
+	}
+	if (r.Sphere == nil) != (other.Sphere == nil) || r.Sphere != nil && !equivalentDecimal(*r.Sphere, *other.Sphere) {
+		return false
+	}
+	if (r.SphereElement == nil) != (other.SphereElement == nil) || r.SphereElement != nil && !r.SphereElement.EqualsDeep(*other.SphereElement) {
+		return false
+	}
+	if (r.Cylinder == nil) != (other.Cylinder == nil) || r.Cylinder != nil && !equivalentDecimal(*r.Cylinder, *other.Cylinder) {
+		return false
+	}
+	if (r.CylinderElement == nil) != (other.CylinderElement == nil) || r.CylinderElement != nil && !r.CylinderElement.EqualsDeep(*other.CylinderElement) {
+		return false
+	}
+	if (r.Axis == nil) != (other.Axis == nil) || r.Axis != nil && *r.Axis != *other.Axis {
+		return false
+	}
+	if (r.AxisElement == nil) != (other.AxisElement == nil) || r.AxisElement != nil && !r.AxisElement.EqualsDeep(*other.AxisElement) {
+		return false
+	}
+	if !equivalentList(len(r.Prism), len(other.Prism), func(i, j int) bool {
+		return r.Prism[i].EqualsDeep(other.Prism[j])
+	}) {
+		return false
+	}
+	if (r.Add == nil) != (other.Add == nil) || r.Add != nil && !equivalentDecimal(*r.Add, *other.Add) {
+		return false
+	}
+	if (r.AddElement == nil) != (other.AddElement == nil) || r.AddElement != nil && !r.AddElement.EqualsDeep(*other.AddElement) {
+		return false
+	}
+	if (r.Power == nil) != (other.Power == nil) || r.Power != nil && !equivalentDecimal(*r.Power, *other.Power) {
+		return false
+	}
+	if (r.PowerElement == nil) != (other.PowerElement == nil) || r.PowerElement != nil && !r.PowerElement.EqualsDeep(*other.PowerElement) {
+		return false
+	}
+	if (r.BackCurve == nil) != (other.BackCurve == nil) || r.BackCurve != nil && !equivalentDecimal(*r.BackCurve, *other.BackCurve) {
+		return false
+	}
+	if (r.BackCurveElement == nil) != (other.BackCurveElement == nil) || r.BackCurveElement != nil && !r.BackCurveElement.EqualsDeep(*other.BackCurveElement) {
+		return false
+	}
+	if (r.Diameter == nil) != (other.Diameter == nil) || r.Diameter != nil && !equivalentDecimal(*r.Diameter, *other.Diameter) {
+		return false
+	}
+	if (r.DiameterElement == nil) != (other.DiameterElement == nil) || r.DiameterElement != nil && !r.DiameterElement.EqualsDeep(*other.DiameterElement) {
+		return false
+	}
+	if (r.Duration == nil) != (other.Duration == nil) || r.Duration != nil && !r.Duration.EqualsDeep(*other.Duration) {
+		return false
+	}
+	if (r.Color == nil) != (other.Color == nil) || r.Color != nil && !equivalentString(*r.Color, *other.Color) {
+		return false
+	}
+	if (r.ColorElement == nil) != (other.ColorElement == nil) || r.ColorElement != nil && !r.ColorElement.EqualsDeep(*other.ColorElement) {
+		return false
+	}
+	if (r.Brand == nil) != (other.Brand == nil) || r.Brand != nil && !equivalentString(*r.Brand, *other.Brand) {
+		return false
+	}
+	if (r.BrandElement == nil) != (other.BrandElement == nil) || r.BrandElement != nil && !r.BrandElement.EqualsDeep(*other.BrandElement) {
+		return false
+	}
+	if !equivalentList(len(r.Note), len(other.Note), func(i, j int) bool {
+		return r.Note[i].EqualsDeep(other.Note[j])
+	}) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r VisionPrescriptionLensSpecification) EqualsShallow(other VisionPrescriptionLensSpecification) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if r.Eye != other.Eye {
+		return false
+	}
+	if (r.Sphere == nil) != (other.Sphere == nil) || r.Sphere != nil && !equivalentDecimal(*r.Sphere, *other.Sphere) {
+		return false
+	}
+	if (r.Cylinder == nil) != (other.Cylinder == nil) || r.Cylinder != nil && !equivalentDecimal(*r.Cylinder, *other.Cylinder) {
+		return false
+	}
+	if (r.Axis == nil) != (other.Axis == nil) || r.Axis != nil && *r.Axis != *other.Axis {
+		return false
+	}
+	if (r.Add == nil) != (other.Add == nil) || r.Add != nil && !equivalentDecimal(*r.Add, *other.Add) {
+		return false
+	}
+	if (r.Power == nil) != (other.Power == nil) || r.Power != nil && !equivalentDecimal(*r.Power, *other.Power) {
+		return false
+	}
+	if (r.BackCurve == nil) != (other.BackCurve == nil) || r.BackCurve != nil && !equivalentDecimal(*r.BackCurve, *other.BackCurve) {
+		return false
+	}
+	if (r.Diameter == nil) != (other.Diameter == nil) || r.Diameter != nil && !equivalentDecimal(*r.Diameter, *other.Diameter) {
+		return false
+	}
+	if (r.Color == nil) != (other.Color == nil) || r.Color != nil && !equivalentString(*r.Color, *other.Color) {
+		return false
+	}
+	if (r.Brand == nil) != (other.Brand == nil) || r.Brand != nil && !equivalentString(*r.Brand, *other.Brand) {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given VisionPrescriptionLensSpecification as FHIR XML
+func (r VisionPrescriptionLensSpecification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLElement(e, "product", r.Product); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "eye", r.Eye, r.EyeElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "sphere", r.Sphere, r.SphereElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "cylinder", r.Cylinder, r.CylinderElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "axis", r.Axis, r.AxisElement); err != nil {
+		return err
+	}
+	for _, v := range r.Prism {
+		if err := encodeXMLElement(e, "prism", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "add", r.Add, r.AddElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "power", r.Power, r.PowerElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "backCurve", r.BackCurve, r.BackCurveElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "diameter", r.Diameter, r.DiameterElement); err != nil {
+		return err
+	}
+	if err := encodeXMLElement(e, "duration", r.Duration); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "color", r.Color, r.ColorElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "brand", r.Brand, r.BrandElement); err != nil {
+		return err
+	}
+	for _, v := range r.Note {
+		if err := encodeXMLElement(e, "note", v); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given VisionPrescriptionLensSpecification from FHIR XML
+func (r *VisionPrescriptionLensSpecification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "product":
+				var v CodeableConcept
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Product = v
+			case "eye":
+				var v VisionEyes
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Eye = v
+				}
+				r.EyeElement = element
+			case "sphere":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Sphere = &v
+				}
+				r.SphereElement = element
+			case "cylinder":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Cylinder = &v
+				}
+				r.CylinderElement = element
+			case "axis":
+				var v int
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Axis = &v
+				}
+				r.AxisElement = element
+			case "prism":
+				var v VisionPrescriptionLensSpecificationPrism
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Prism = append(r.Prism, v)
+			case "add":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Add = &v
+				}
+				r.AddElement = element
+			case "power":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Power = &v
+				}
+				r.PowerElement = element
+			case "backCurve":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.BackCurve = &v
+				}
+				r.BackCurveElement = element
+			case "diameter":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Diameter = &v
+				}
+				r.DiameterElement = element
+			case "duration":
+				var v Quantity
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Duration = &v
+			case "color":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Color = &v
+				}
+				r.ColorElement = element
+			case "brand":
+				var v string
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Brand = &v
+				}
+				r.BrandElement = element
+			case "note":
+				var v Annotation
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Note = append(r.Note, v)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the VisionPrescriptionLensSpecification as properties to the RDF node n
+func (r VisionPrescriptionLensSpecification) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.element("VisionPrescription.lensSpecification.product", -1, r.Product)
+	n.primitive("VisionPrescription.lensSpecification.eye", -1, r.Eye, r.EyeElement, "code")
+	n.primitive("VisionPrescription.lensSpecification.sphere", -1, r.Sphere, r.SphereElement, "decimal")
+	n.primitive("VisionPrescription.lensSpecification.cylinder", -1, r.Cylinder, r.CylinderElement, "decimal")
+	n.primitive("VisionPrescription.lensSpecification.axis", -1, r.Axis, r.AxisElement, "integer")
+	for i, v := range r.Prism {
+		n.element("VisionPrescription.lensSpecification.prism", i, v)
+	}
+	n.primitive("VisionPrescription.lensSpecification.add", -1, r.Add, r.AddElement, "decimal")
+	n.primitive("VisionPrescription.lensSpecification.power", -1, r.Power, r.PowerElement, "decimal")
+	n.primitive("VisionPrescription.lensSpecification.backCurve", -1, r.BackCurve, r.BackCurveElement, "decimal")
+	n.primitive("VisionPrescription.lensSpecification.diameter", -1, r.Diameter, r.DiameterElement, "decimal")
+	n.element("VisionPrescription.lensSpecification.duration", -1, r.Duration)
+	n.primitive("VisionPrescription.lensSpecification.color", -1, r.Color, r.ColorElement, "string")
+	n.primitive("VisionPrescription.lensSpecification.brand", -1, r.Brand, r.BrandElement, "string")
+	for i, v := range r.Note {
+		n.element("VisionPrescription.lensSpecification.note", i, v)
+	}
+}
+
+// MarshalBSON marshals the given VisionPrescriptionLensSpecification as BSON document with the structure of its FHIR JSON
+func (r VisionPrescriptionLensSpecification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given VisionPrescriptionLensSpecification from a BSON document with the structure of its FHIR JSON
+func (r *VisionPrescriptionLensSpecification) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given VisionPrescriptionLensSpecification as protobuf message VisionPrescriptionLensSpecification of fhir.proto
+func (r VisionPrescriptionLensSpecification) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the VisionPrescriptionLensSpecification to the encoded message
+func (r VisionPrescriptionLensSpecification) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	e.message(4, r.Product)
+	e.varint(5, r.Eye.protoNumber())
+	if r.Sphere != nil {
+		e.string(6, string(*r.Sphere))
+	}
+	if r.Cylinder != nil {
+		e.string(7, string(*r.Cylinder))
+	}
+	if r.Axis != nil {
+		e.varint(8, uint64(int64(*r.Axis)))
+	}
+	for _, v := range r.Prism {
+		e.message(9, v)
+	}
+	if r.Add != nil {
+		e.string(10, string(*r.Add))
+	}
+	if r.Power != nil {
+		e.string(11, string(*r.Power))
+	}
+	if r.BackCurve != nil {
+		e.string(12, string(*r.BackCurve))
+	}
+	if r.Diameter != nil {
+		e.string(13, string(*r.Diameter))
+	}
+	if r.Duration != nil {
+		e.message(14, *r.Duration)
+	}
+	if r.Color != nil {
+		e.string(15, *r.Color)
+	}
+	if r.Brand != nil {
+		e.string(16, *r.Brand)
+	}
+	for _, v := range r.Note {
+		e.message(17, v)
+	}
+	if r.EyeElement != nil {
+		e.message(18, *r.EyeElement)
+	}
+	if r.SphereElement != nil {
+		e.message(19, *r.SphereElement)
+	}
+	if r.CylinderElement != nil {
+		e.message(20, *r.CylinderElement)
+	}
+	if r.AxisElement != nil {
+		e.message(21, *r.AxisElement)
+	}
+	if r.AddElement != nil {
+		e.message(22, *r.AddElement)
+	}
+	if r.PowerElement != nil {
+		e.message(23, *r.PowerElement)
+	}
+	if r.BackCurveElement != nil {
+		e.message(24, *r.BackCurveElement)
+	}
+	if r.DiameterElement != nil {
+		e.message(25, *r.DiameterElement)
+	}
+	if r.ColorElement != nil {
+		e.message(26, *r.ColorElement)
+	}
+	if r.BrandElement != nil {
+		e.message(27, *r.BrandElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given VisionPrescriptionLensSpecification from protobuf message VisionPrescriptionLensSpecification of fhir.proto
+func (r *VisionPrescriptionLensSpecification) UnmarshalProto(b []byte) error {
+	*r = VisionPrescriptionLensSpecification{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			var v CodeableConcept
+			d.message(&v)
+			r.Product = v
+		case 5:
+			var v VisionEyes
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Eye = v
+		case 6:
+			v := json.Number(d.string())
+			r.Sphere = &v
+		case 7:
+			v := json.Number(d.string())
+			r.Cylinder = &v
+		case 8:
+			v := d.int()
+			r.Axis = &v
+		case 9:
+			var v VisionPrescriptionLensSpecificationPrism
+			d.message(&v)
+			r.Prism = append(r.Prism, v)
+		case 10:
+			v := json.Number(d.string())
+			r.Add = &v
+		case 11:
+			v := json.Number(d.string())
+			r.Power = &v
+		case 12:
+			v := json.Number(d.string())
+			r.BackCurve = &v
+		case 13:
+			v := json.Number(d.string())
+			r.Diameter = &v
+		case 14:
+			var v Quantity
+			d.message(&v)
+			r.Duration = &v
+		case 15:
+			v := d.string()
+			r.Color = &v
+		case 16:
+			v := d.string()
+			r.Brand = &v
+		case 17:
+			var v Annotation
+			d.message(&v)
+			r.Note = append(r.Note, v)
+		case 18:
+			var v Element
+			d.message(&v)
+			r.EyeElement = &v
+		case 19:
+			var v Element
+			d.message(&v)
+			r.SphereElement = &v
+		case 20:
+			var v Element
+			d.message(&v)
+			r.CylinderElement = &v
+		case 21:
+			var v Element
+			d.message(&v)
+			r.AxisElement = &v
+		case 22:
+			var v Element
+			d.message(&v)
+			r.AddElement = &v
+		case 23:
+			var v Element
+			d.message(&v)
+			r.PowerElement = &v
+		case 24:
+			var v Element
+			d.message(&v)
+			r.BackCurveElement = &v
+		case 25:
+			var v Element
+			d.message(&v)
+			r.DiameterElement = &v
+		case 26:
+			var v Element
+			d.message(&v)
+			r.ColorElement = &v
+		case 27:
+			var v Element
+			d.message(&v)
+			r.BrandElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
+}
+
+// MarshalJSON marshals the given VisionPrescriptionLensSpecificationPrism as JSON into a byte slice
+func (r VisionPrescriptionLensSpecificationPrism) MarshalJSON() ([]byte, error) {
+	var e jsonEncoder
+	r.appendJSON(&e)
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf, nil
+}
+
+// appendJSON appends the VisionPrescriptionLensSpecificationPrism as JSON object with the members in the order of the fields
+func (r VisionPrescriptionLensSpecificationPrism) appendJSON(e *jsonEncoder) {
+	e.buf = append(e.buf, '{')
+	if r.Id != nil {
+		e.key("id")
+		e.string(*r.Id)
+	}
+	if len(r.Extension) > 0 {
+		e.key("extension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.Extension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if len(r.ModifierExtension) > 0 {
+		e.key("modifierExtension")
+		e.buf = append(e.buf, '[')
+		for _, v := range r.ModifierExtension {
+			e.item()
+			v.appendJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	e.key("amount")
+	e.number(r.Amount)
+	if r.AmountElement != nil {
+		e.key("_amount")
+		r.AmountElement.appendJSON(e)
+	}
+	e.key("base")
+	e.string(r.Base.Code())
+	if r.BaseElement != nil {
+		e.key("_base")
+		r.BaseElement.appendJSON(e)
+	}
+	e.buf = append(e.buf, '}')
+}
+
+// UnmarshalJSON unmarshals the given VisionPrescriptionLensSpecificationPrism from JSON. Unknown members are ignored.
+func (r *VisionPrescriptionLensSpecificationPrism) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	r.decodeJSON(&d)
+	d.end()
+	return d.err
+}
+
+// decodeJSON decodes the JSON object at the position of the decoder into the VisionPrescriptionLensSpecificationPrism
+func (r *VisionPrescriptionLensSpecificationPrism) decodeJSON(d *jsonDecoder) {
+	for o := d.object(); o.next(); {
+		switch string(o.key) {
+		case "id":
+			if v, ok := d.string(); ok {
+				r.Id = &v
+			} else {
+				r.Id = nil
+			}
+		case "extension":
+			a := d.array()
+			r.Extension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.Extension = append(r.Extension, v)
+			}
+			if r.Extension == nil && !a.isNull {
+				r.Extension = []Extension{}
+			}
+		case "modifierExtension":
+			a := d.array()
+			r.ModifierExtension = nil
+			for a.next() {
+				var v Extension
+				v.decodeJSON(d)
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			}
+			if r.ModifierExtension == nil && !a.isNull {
+				r.ModifierExtension = []Extension{}
+			}
+		case "amount":
+			if v, ok := d.number(); ok {
+				r.Amount = v
+			}
+		case "_amount":
+			r.AmountElement = d.element()
+		case "base":
+			d.unmarshal(&r.Base)
+		case "_base":
+			r.BaseElement = d.element()
+		default:
+			d.skip()
+		}
+	}
+}
+
+// DeepCopy returns a copy of the VisionPrescriptionLensSpecificationPrism which shares no memory with the original
+func (r VisionPrescriptionLensSpecificationPrism) DeepCopy() VisionPrescriptionLensSpecificationPrism {
+	out := r
+	if r.Id != nil {
+		v := *r.Id
+		out.Id = &v
+	}
+	if r.Extension != nil {
+		out.Extension = make([]Extension, len(r.Extension))
+		for i := range r.Extension {
+			out.Extension[i] = r.Extension[i].DeepCopy()
+		}
+	}
+	if r.ModifierExtension != nil {
+		out.ModifierExtension = make([]Extension, len(r.ModifierExtension))
+		for i := range r.ModifierExtension {
+			out.ModifierExtension[i] = r.ModifierExtension[i].DeepCopy()
+		}
+	}
+	if r.AmountElement != nil {
+		v := r.AmountElement.DeepCopy()
+		out.AmountElement = &v
+	}
+	if r.BaseElement != nil {
+		v := r.BaseElement.DeepCopy()
+		out.BaseElement = &v
+	}
+	return out
+}
+
+// Equal reports whether r and other are equal. Absent and empty lists are equal and decimals are compared by value.
+func (r VisionPrescriptionLensSpecificationPrism) Equal(other VisionPrescriptionLensSpecificationPrism) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && *r.Id != *other.Id {
+		return false
+	}
+	if len(r.Extension) != len(other.Extension) {
+		return false
+	}
+	for i := range r.Extension {
+		if !r.Extension[i].Equal(other.Extension[i]) {
+			return false
+		}
+	}
+	if len(r.ModifierExtension) != len(other.ModifierExtension) {
+		return false
+	}
+	for i := range r.ModifierExtension {
+		if !r.ModifierExtension[i].Equal(other.ModifierExtension[i]) {
+			return false
+		}
+	}
+	if !EqualDecimal(r.Amount, other.Amount) {
+		return false
+	}
+	if (r.AmountElement == nil) != (other.AmountElement == nil) || r.AmountElement != nil && !r.AmountElement.Equal(*other.AmountElement) {
+		return false
+	}
+	if r.Base != other.Base {
+		return false
+	}
+	if (r.BaseElement == nil) != (other.BaseElement == nil) || r.BaseElement != nil && !r.BaseElement.Equal(*other.BaseElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsDeep reports whether r and other are equivalent in the sense of the FHIRPath ~ operator. Strings are compared ignoring case and whitespace, decimals at the precision of the less precise value and lists regardless of order.
+func (r VisionPrescriptionLensSpecificationPrism) EqualsDeep(other VisionPrescriptionLensSpecificationPrism) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentList(len(r.Extension), len(other.Extension), func(i, j int) bool {
+		return r.Extension[i].EqualsDeep(other.Extension[j])
+	}) {
+		return false
+	}
+	if !equivalentList(len(r.ModifierExtension), len(other.ModifierExtension), func(i, j int) bool {
+		return r.ModifierExtension[i].EqualsDeep(other.ModifierExtension[j])
+	}) {
+		return false
+	}
+	if !equivalentDecimal(r.Amount, other.Amount) {
+		return false
+	}
+	if (r.AmountElement == nil) != (other.AmountElement == nil) || r.AmountElement != nil && !r.AmountElement.EqualsDeep(*other.AmountElement) {
+		return false
+	}
+	if r.Base != other.Base {
+		return false
+	}
+	if (r.BaseElement == nil) != (other.BaseElement == nil) || r.BaseElement != nil && !r.BaseElement.EqualsDeep(*other.BaseElement) {
+		return false
+	}
+	return true
+}
+
+// EqualsShallow reports whether the primitive elements of r and other are equivalent in the sense of the FHIRPath ~ operator. Complex elements are not compared.
+func (r VisionPrescriptionLensSpecificationPrism) EqualsShallow(other VisionPrescriptionLensSpecificationPrism) bool {
+	if (r.Id == nil) != (other.Id == nil) || r.Id != nil && !equivalentString(*r.Id, *other.Id) {
+		return false
+	}
+	if !equivalentDecimal(r.Amount, other.Amount) {
+		return false
+	}
+	if r.Base != other.Base {
+		return false
+	}
+	return true
+}
+
+// MarshalXML marshals the given VisionPrescriptionLensSpecificationPrism as FHIR XML
+func (r VisionPrescriptionLensSpecificationPrism) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.Id != nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Local: "id"},
+			Value: *r.Id,
+		})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range r.Extension {
+		if err := encodeXMLElement(e, "extension", v); err != nil {
+			return err
+		}
+	}
+	for _, v := range r.ModifierExtension {
+		if err := encodeXMLElement(e, "modifierExtension", v); err != nil {
+			return err
+		}
+	}
+	if err := encodeXMLPrimitive(e, "amount", r.Amount, r.AmountElement); err != nil {
+		return err
+	}
+	if err := encodeXMLPrimitive(e, "base", r.Base, r.BaseElement); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML unmarshals the given VisionPrescriptionLensSpecificationPrism from FHIR XML
+func (r *VisionPrescriptionLensSpecificationPrism) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		attr := attr
+		switch attr.Name.Local {
+		case "id":
+			r.Id = &attr.Value
+		}
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "extension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.Extension = append(r.Extension, v)
+			case "modifierExtension":
+				var v Extension
+				if err := d.DecodeElement(&v, &t); err != nil {
+					return err
+				}
+				r.ModifierExtension = append(r.ModifierExtension, v)
+			case "amount":
+				var v json.Number
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Amount = v
+				}
+				r.AmountElement = element
+			case "base":
+				var v VisionBase
+				ok, element, err := decodeXMLPrimitive(d, t, &v)
+				if err != nil {
+					return err
+				}
+				if ok {
+					r.Base = v
+				}
+				r.BaseElement = element
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// turtle adds the elements of the VisionPrescriptionLensSpecificationPrism as properties to the RDF node n
+func (r VisionPrescriptionLensSpecificationPrism) turtle(n *turtleNode) {
+	n.primitive("Element.id", -1, r.Id, nil, "string")
+	for i, v := range r.Extension {
+		n.element("Element.extension", i, v)
+	}
+	for i, v := range r.ModifierExtension {
+		n.element("BackboneElement.modifierExtension", i, v)
+	}
+	n.primitive("VisionPrescription.lensSpecification.prism.amount", -1, r.Amount, r.AmountElement, "decimal")
+	n.primitive("VisionPrescription.lensSpecification.prism.base", -1, r.Base, r.BaseElement, "code")
+}
+
+// MarshalBSON marshals the given VisionPrescriptionLensSpecificationPrism as BSON document with the structure of its FHIR JSON
+func (r VisionPrescriptionLensSpecificationPrism) MarshalBSON() ([]byte, error) {
+	return marshalBSON(r)
+}
+
+// UnmarshalBSON unmarshals the given VisionPrescriptionLensSpecificationPrism from a BSON document with the structure of its FHIR JSON
+func (r *VisionPrescriptionLensSpecificationPrism) UnmarshalBSON(b []byte) error {
+	return unmarshalBSON(b, r)
+}
+
+// MarshalProto marshals the given VisionPrescriptionLensSpecificationPrism as protobuf message VisionPrescriptionLensSpecificationPrism of fhir.proto
+func (r VisionPrescriptionLensSpecificationPrism) MarshalProto() ([]byte, error) {
+	var e protoEncoder
+	r.appendProto(&e)
+	return e.buf, e.err
+}
+
+// appendProto appends the fields of the VisionPrescriptionLensSpecificationPrism to the encoded message
+func (r VisionPrescriptionLensSpecificationPrism) appendProto(e *protoEncoder) {
+	if r.Id != nil {
+		e.string(1, *r.Id)
+	}
+	for _, v := range r.Extension {
+		e.message(2, v)
+	}
+	for _, v := range r.ModifierExtension {
+		e.message(3, v)
+	}
+	if r.Amount != "" {
+		e.string(4, string(r.Amount))
+	}
+	e.varint(5, r.Base.protoNumber())
+	if r.AmountElement != nil {
+		e.message(6, *r.AmountElement)
+	}
+	if r.BaseElement != nil {
+		e.message(7, *r.BaseElement)
+	}
+}
+
+// UnmarshalProto unmarshals the given VisionPrescriptionLensSpecificationPrism from protobuf message VisionPrescriptionLensSpecificationPrism of fhir.proto
+func (r *VisionPrescriptionLensSpecificationPrism) UnmarshalProto(b []byte) error {
+	*r = VisionPrescriptionLensSpecificationPrism{}
+	d := protoDecoder{buf: b}
+	for d.next() {
+		switch d.field {
+		case 1:
+			v := d.string()
+			r.Id = &v
+		case 2:
+			var v Extension
+			d.message(&v)
+			r.Extension = append(r.Extension, v)
+		case 3:
+			var v Extension
+			d.message(&v)
+			r.ModifierExtension = append(r.ModifierExtension, v)
+		case 4:
+			r.Amount = json.Number(d.string())
+		case 5:
+			var v VisionBase
+			d.enum(d.varint(), v.setProtoNumber)
+			r.Base = v
+		case 6:
+			var v Element
+			d.message(&v)
+			r.AmountElement = &v
+		case 7:
+			var v Element
+			d.message(&v)
+			r.BaseElement = &v
+		default:
+			d.skip()
+		}
+	}
+	return d.err
 }
 
 // UnmarshalVisionPrescription unmarshals a VisionPrescription.
 func UnmarshalVisionPrescription(b []byte) (VisionPrescription, error) {
 	var visionPrescription VisionPrescription
-	if err := json.Unmarshal(b, &visionPrescription); err != nil {
+	if err := visionPrescription.UnmarshalJSON(b); err != nil {
 		return visionPrescription, err
 	}
 	return visionPrescription, nil
@@ -17,7 +17,6 @@ package fhir
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 // THIS FILE IS GENERATED BY https://github.com/samply/golang-fhir-models
@@ -37,8 +36,13 @@ const (
 func (code XPathUsageType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(code.Code())
 }
-func (code *XPathUsageType) UnmarshalJSON(json []byte) error {
-	s := strings.Trim(string(json), "\"")
+func (code *XPathUsageType) UnmarshalJSON(b []byte) error {
+	d := jsonDecoder{buf: b}
+	s, _ := d.string()
+	d.end()
+	if d.err != nil {
+		return d.err
+	}
 	switch s {
 	case "normal":
 		*code = XPathUsageTypeNormal
@@ -55,6 +59,12 @@ func (code *XPathUsageType) UnmarshalJSON(json []byte) error {
 	}
 	return nil
 }
+func (code XPathUsageType) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONValue(code)
+}
+func (code *XPathUsageType) UnmarshalBSONValue(t byte, b []byte) error {
+	return unmarshalBSONValue(t, b, code)
+}
 func (code XPathUsageType) String() string {
 	return code.Code()
 }
@@ -103,3 +113,35 @@ func (code XPathUsageType) Definition() string {
 	}
 	return "<unknown>"
 }
+func (code XPathUsageType) protoNumber() uint64 {
+	switch code {
+	case XPathUsageTypeNormal:
+		return 1
+	case XPathUsageTypePhonetic:
+		return 2
+	case XPathUsageTypeNearby:
+		return 3
+	case XPathUsageTypeDistance:
+		return 4
+	case XPathUsageTypeOther:
+		return 5
+	}
+	return 0
+}
+func (code *XPathUsageType) setProtoNumber(number uint64) bool {
+	switch number {
+	case 1:
+		*code = XPathUsageTypeNormal
+	case 2:
+		*code = XPathUsageTypePhonetic
+	case 3:
+		*code = XPathUsageTypeNearby
+	case 4:
+		*code = XPathUsageTypeDistance
+	case 5:
+		*code = XPathUsageTypeOther
+	default:
+		return false
+	}
+	return true
+}
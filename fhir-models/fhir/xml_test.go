@@ -0,0 +1,195 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhir
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestNarrativeXMLRoundTrip checks that the XHTML of narratives survives encoding to and decoding from XML
+// unchanged.
+func TestNarrativeXMLRoundTrip(t *testing.T) {
+	for _, div := range []string{
+		`<div xmlns="http://www.w3.org/1999/xhtml">a<br/>b</div>`,
+		`<div xmlns="http://www.w3.org/1999/xhtml" lang="en"><p class="x">1 &lt; 2 &amp; <b>bold</b></p><!-- comment --><img src="a.png"/></div>`,
+		`<div xmlns="http://www.w3.org/1999/xhtml"/>`,
+	} {
+		patientId := "0"
+		patient := Patient{Id: &patientId, Text: &Narrative{Status: NarrativeStatusGenerated, Div: div}}
+		bs, err := xml.Marshal(patient)
+		if err != nil {
+			t.Errorf("marshal %s: %v", div, err)
+			continue
+		}
+		inner := div[strings.Index(div, ">")+1:]
+		if !strings.HasSuffix(div, "/>") && !strings.Contains(string(bs), inner) {
+			t.Errorf("XML %s doesn't contain %s verbatim", bs, inner)
+		}
+		var decoded Patient
+		if err := xml.Unmarshal(bs, &decoded); err != nil {
+			t.Errorf("unmarshal %s: %v", bs, err)
+			continue
+		}
+		want := div
+		if strings.HasSuffix(div, "/>") {
+			want = strings.TrimSuffix(div, "/>") + "></div>"
+		}
+		if decoded.Text == nil || decoded.Text.Div != want {
+			t.Errorf("round trip of %s gave %v", div, decoded.Text)
+		}
+	}
+}
+
+func TestNarrativeXMLInvalid(t *testing.T) {
+	for _, div := range []string{
+		`<div xmlns="http://www.w3.org/1999/xhtml"><p>`,
+		`<div xmlns="http://www.w3.org/1999/xhtml"></div><div/>`,
+		`text`,
+	} {
+		narrative := Narrative{Status: NarrativeStatusGenerated, Div: div}
+		if bs, err := xml.Marshal(narrative); err == nil {
+			t.Errorf("marshal of %s gave %s", div, bs)
+		}
+	}
+}
+
+// canonicalJSON compacts JSON and sorts the members of objects, keeping the literals of numbers, so that decimals
+// compare with their trailing zeros.
+func canonicalJSON(t *testing.T, b []byte) string {
+	t.Helper()
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		t.Fatalf("invalid JSON %s: %v", b, err)
+	}
+	canonical, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(canonical)
+}
+
+// TestXMLRoundTrip checks that resources survive the way from JSON to XML and back without losing anything.
+func TestXMLRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+	}{
+		{
+			name: "choice types",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"code": {"coding": [{"system": "http://loinc.org", "code": "29463-7"}]},
+				"effectiveDateTime": "2021-03-04T10:00:00+01:00",
+				"valueQuantity": {"value": 80, "unit": "kg"},
+				"component": [
+					{"code": {"text": "a"}, "valueString": "text"},
+					{"code": {"text": "b"}, "valueBoolean": false},
+					{"code": {"text": "c"}, "valueInteger": -3}
+				]}`,
+		},
+		{
+			name: "primitive extensions",
+			resource: `{"resourceType": "Patient", "id": "p1",
+				"active": true,
+				"_active": {"id": "a1"},
+				"gender": "female",
+				"_gender": {"extension": [{"url": "http://example.org/g", "valueCode": "x"}]},
+				"birthDate": "1970-01-01",
+				"_birthDate": {"id": "b1", "extension": [
+					{"url": "http://hl7.org/fhir/StructureDefinition/patient-birthTime", "valueDateTime": "1970-01-01T10:00:00Z",
+						"_valueDateTime": {"id": "t1"}}
+				]},
+				"_deceasedBoolean": {"extension": [{"url": "http://hl7.org/fhir/StructureDefinition/data-absent-reason", "valueCode": "unknown"}]},
+				"name": [{"family": "Chalmers", "given": ["Peter", "James", "Jim"], "_given": [null, {"id": "g2"}, null]}]}`,
+		},
+		{
+			name: "contained resources",
+			resource: `{"resourceType": "Patient", "id": "p1",
+				"contained": [
+					{"resourceType": "Organization", "id": "org", "name": "Clinic", "_name": {"id": "n1"}},
+					{"resourceType": "Practitioner", "id": "pr", "active": false}
+				],
+				"managingOrganization": {"reference": "#org"},
+				"generalPractitioner": [{"reference": "#pr"}]}`,
+		},
+		{
+			name: "decimals with trailing zeros",
+			resource: `{"resourceType": "Observation", "status": "final", "code": {"text": "weight"},
+				"valueQuantity": {"value": 1.50, "unit": "kg"},
+				"referenceRange": [{"low": {"value": 100.000}, "high": {"value": 0.0}}]}`,
+		},
+		{
+			name: "Bundle entry resources",
+			resource: `{"resourceType": "Bundle", "type": "collection", "entry": [
+				{"fullUrl": "urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a",
+					"resource": {"resourceType": "Patient", "active": true, "_active": {"id": "a1"}}},
+				{"fullUrl": "urn:uuid:88f151c0-a954-468a-88bd-5ae15c08e059",
+					"resource": {"resourceType": "Observation", "status": "final", "code": {"text": "x"},
+						"subject": {"reference": "urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a"},
+						"valueQuantity": {"value": 2.50}}}
+			]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resource, err := DecodeResource([]byte(test.resource))
+			if err != nil {
+				t.Fatal(err)
+			}
+			bs, err := xml.Marshal(resource)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded := reflect.New(reflect.TypeOf(resource).Elem()).Interface()
+			if err := xml.Unmarshal(bs, decoded); err != nil {
+				t.Fatalf("unmarshal %s: %v", bs, err)
+			}
+			js, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := canonicalJSON(t, js), canonicalJSON(t, []byte(test.resource)); got != want {
+				t.Errorf("round trip through %s gave\n%s\nwant\n%s", bs, got, want)
+			}
+		})
+	}
+}
+
+func TestPrimitiveExtensionXML(t *testing.T) {
+	birthDate, id, url, code := "1970-01-01", "b1", "http://example.org/e", "x"
+	patient := Patient{
+		BirthDate:        &birthDate,
+		BirthDateElement: &Element{Id: &id, Extension: []Extension{{Url: url, ValueCode: &code}}},
+		Name:             []HumanName{{Given: []string{"Peter", "James"}, GivenElement: []*Element{nil, {Id: &id}}}},
+	}
+	bs, err := xml.Marshal(patient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<birthDate value="1970-01-01" id="b1"><extension url="http://example.org/e"><valueCode value="x"></valueCode></extension></birthDate>`,
+		`<given value="Peter"></given><given value="James" id="b1"></given>`,
+	} {
+		if !strings.Contains(string(bs), want) {
+			t.Errorf("XML %s doesn't contain %s", bs, want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhirpath
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	dateTimePattern = regexp.MustCompile(`^(\d{4})(?:-(\d{2})(?:-(\d{2})(?:T(\d{2})(?::(\d{2})(?::(\d{2})(?:\.(\d+))?)?)?(Z|[+-]\d{2}:\d{2})?)?)?)?$`)
+	timePattern     = regexp.MustCompile(`^(\d{2})(?::(\d{2})(?::(\d{2})(?:\.(\d+))?)?)?$`)
+)
+
+// temporal is a date, dateTime or time split into the components it specifies: year, month, day, hour, minute and
+// millisecond of dates and hour, minute and millisecond of times. Seconds and milliseconds are one precision.
+type temporal struct {
+	time       bool
+	components []int
+	// zone is the offset of the time zone in minutes, if the value has one
+	zone *int
+}
+
+// isTemporalType reports whether the FHIR type is a date, dateTime, instant or time.
+func isTemporalType(typeName string) bool {
+	switch typeName {
+	case "date", "dateTime", "instant", "time":
+		return true
+	}
+	return false
+}
+
+// temporalValues returns the date, dateTime or time values of two items, which have to be typed as such or be strings
+// of elements without type compared with a typed value.
+func temporalValues(a, b Node) (temporal, temporal, bool) {
+	typedA, typedB := isTemporalType(a.Type), isTemporalType(b.Type)
+	if !typedA && !typedB || !typedA && a.Type != "" || !typedB && b.Type != "" {
+		return temporal{}, temporal{}, false
+	}
+	x, okX := parseTemporal(a)
+	y, okY := parseTemporal(b)
+	if !okX || !okY || x.time != y.time {
+		return temporal{}, temporal{}, false
+	}
+	return x, y, true
+}
+
+// parseTemporal parses the value of the item, which is a time if it is typed as time or, like time literals, starts
+// with T.
+func parseTemporal(item Node) (temporal, bool) {
+	s, ok := item.Value.(string)
+	if !ok {
+		return temporal{}, false
+	}
+	if item.Type == "time" || strings.HasPrefix(s, "T") {
+		m := timePattern.FindStringSubmatch(strings.TrimPrefix(s, "T"))
+		if m == nil {
+			return temporal{}, false
+		}
+		return temporal{time: true, components: components(m[1:4], m[4])}, true
+	}
+	m := dateTimePattern.FindStringSubmatch(strings.TrimSuffix(s, "T"))
+	if m == nil {
+		return temporal{}, false
+	}
+	t := temporal{components: components(m[1:7], m[7])}
+	if m[8] != "" {
+		zone := 0
+		if m[8] != "Z" {
+			hours, _ := strconv.Atoi(m[8][1:3])
+			minutes, _ := strconv.Atoi(m[8][4:6])
+			zone = hours*60 + minutes
+			if m[8][0] == '-' {
+				zone = -zone
+			}
+		}
+		t.zone = &zone
+	}
+	return t, true
+}
+
+// components returns the numbers of the matched components up to the first missing one. The last one are seconds,
+// which are turned into milliseconds with the fraction.
+func components(matches []string, fraction string) []int {
+	var result []int
+	for i, s := range matches {
+		if s == "" {
+			break
+		}
+		n, _ := strconv.Atoi(s)
+		if i == len(matches)-1 {
+			fraction = (fraction + "000")[:3]
+			ms, _ := strconv.Atoi(fraction)
+			n = n*1000 + ms
+		}
+		result = append(result, n)
+	}
+	return result
+}
+
+// utc returns the dateTime in UTC if it has a time zone and specifies at least the hour.
+func (t temporal) utc() temporal {
+	if t.time || t.zone == nil || len(t.components) < 4 {
+		return t
+	}
+	c := append(t.components, 0, 0)
+	u := time.Date(c[0], time.Month(c[1]), c[2], c[3], c[4], 0, c[5]*int(time.Millisecond), time.FixedZone("", *t.zone*60)).UTC()
+	all := []int{u.Year(), int(u.Month()), u.Day(), u.Hour(), u.Minute(), u.Second()*1000 + u.Nanosecond()/int(time.Millisecond)}
+	zero := 0
+	return temporal{components: all[:len(t.components)], zone: &zero}
+}
+
+// compareTemporal compares two dates, dateTimes or times precision by precision. It returns false if they are equal in
+// the precisions both specify but one is more precise, in which case the result of the comparison is empty.
+func compareTemporal(a, b temporal) (int, bool) {
+	if a.zone != nil && b.zone != nil {
+		a, b = a.utc(), b.utc()
+	}
+	for i := 0; i < len(a.components) && i < len(b.components); i++ {
+		switch {
+		case a.components[i] < b.components[i]:
+			return -1, true
+		case a.components[i] > b.components[i]:
+			return 1, true
+		}
+	}
+	if len(a.components) != len(b.components) {
+		return 0, false
+	}
+	return 0, true
+}
+
+// toDate returns the date part of a date or dateTime.
+func toDate(item Node) (Node, bool) {
+	if item.Type != "" && item.Type != "string" && item.Type != "date" && item.Type != "dateTime" && item.Type != "instant" {
+		return Node{}, false
+	}
+	s, ok := item.Value.(string)
+	if !ok || !dateTimePattern.MatchString(strings.TrimSuffix(s, "T")) {
+		return Node{}, false
+	}
+	if i := strings.IndexByte(s, 'T'); i >= 0 {
+		s = s[:i]
+	}
+	return Node{Value: s, Type: "date", Index: -1}, true
+}
+
+// toDateTime returns a date or dateTime as dateTime.
+func toDateTime(item Node) (Node, bool) {
+	if item.Type != "" && item.Type != "string" && item.Type != "date" && item.Type != "dateTime" && item.Type != "instant" {
+		return Node{}, false
+	}
+	s, ok := item.Value.(string)
+	if !ok || !dateTimePattern.MatchString(strings.TrimSuffix(s, "T")) {
+		return Node{}, false
+	}
+	return Node{Value: strings.TrimSuffix(s, "T"), Type: "dateTime", Index: -1}, true
+}
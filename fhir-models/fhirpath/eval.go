@@ -0,0 +1,730 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhirpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+type context struct {
+	options Options
+	root    Node
+}
+
+// scope holds the values of $this, $index and $total
+type scope struct {
+	this  []Node
+	index int
+	total []Node
+}
+
+func (c *context) eval(n node, focus []Node, s scope) ([]Node, error) {
+	switch n := n.(type) {
+	case literalNode:
+		return []Node{n.value}, nil
+	case emptyNode:
+		return nil, nil
+	case memberNode:
+		return c.member(n.name, focus), nil
+	case functionNode:
+		return c.function(n, focus, s)
+	case invocationNode:
+		target, err := c.eval(n.target, focus, s)
+		if err != nil {
+			return nil, err
+		}
+		return c.eval(n.member, target, s)
+	case indexNode:
+		target, err := c.eval(n.target, focus, s)
+		if err != nil {
+			return nil, err
+		}
+		index, err := c.eval(n.index, s.this, s)
+		if err != nil {
+			return nil, err
+		}
+		i, ok, err := integer(index)
+		if err != nil || !ok {
+			return nil, err
+		}
+		if i < 0 || i >= len(target) {
+			return nil, nil
+		}
+		return target[i : i+1], nil
+	case variableNode:
+		return c.variable(n.name, s)
+	case unaryNode:
+		operand, err := c.eval(n.operand, focus, s)
+		if err != nil {
+			return nil, err
+		}
+		if n.op == "+" || len(operand) == 0 {
+			return operand, nil
+		}
+		r, typeName, err := number(operand)
+		if err != nil {
+			return nil, err
+		}
+		return []Node{numberNode(new(big.Rat).Neg(r), typeName)}, nil
+	case typeNode:
+		operand, err := c.eval(n.operand, focus, s)
+		if err != nil {
+			return nil, err
+		}
+		if len(operand) > 1 {
+			return nil, fmt.Errorf("operator %s expects a single item but got %d", n.op, len(operand))
+		}
+		if n.op == "is" {
+			if len(operand) == 0 {
+				return nil, nil
+			}
+			return boolean(isType(operand[0], n.typeName)), nil
+		}
+		return ofType(operand, n.typeName), nil
+	case binaryNode:
+		return c.binary(n, focus, s)
+	}
+	return nil, fmt.Errorf("unknown expression %T", n)
+}
+
+// member navigates to the child elements with the given name. An identifier naming the type of a resource in the
+// focus, or one of its base types Resource and DomainResource, selects the resource itself, so that expressions may
+// start with the resource type.
+func (c *context) member(name string, focus []Node) []Node {
+	var result []Node
+	for _, item := range focus {
+		isType := item.Type == name || name == "Resource" || name == "DomainResource"
+		if isType && item.Type != "" && unicode.IsUpper(rune(name[0])) && resourceType(item.Value) != "" {
+			result = append(result, item)
+			continue
+		}
+		result = append(result, children(item, name)...)
+	}
+	return result
+}
+
+// children returns the child elements with the given name. Polymorphic elements are found by their name without
+// type suffix, e.g. value finds valueQuantity.
+func children(item Node, name string) []Node {
+	object, ok := item.Value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	key := name
+	typeName := ""
+	value, ok := object[name]
+	if !ok {
+		for k, v := range object {
+			if len(k) > len(name) && strings.HasPrefix(k, name) && unicode.IsUpper(rune(k[len(name)])) {
+				if t, ok := choiceTypes[k[len(name):]]; ok {
+					key, typeName, value = k, t, v
+					break
+				}
+			}
+		}
+		if key == name {
+			return nil
+		}
+	}
+	if items, ok := value.([]interface{}); ok {
+		result := make([]Node, 0, len(items))
+		for i, v := range items {
+			result = append(result, Node{Value: v, Type: childType(typeName, v), Parent: object, Key: key, Index: i})
+		}
+		return result
+	}
+	if value == nil {
+		return nil
+	}
+	return []Node{{Value: value, Type: childType(typeName, value), Parent: object, Key: key, Index: -1}}
+}
+
+func childType(typeName string, value interface{}) string {
+	if typeName != "" {
+		return typeName
+	}
+	return resourceType(value)
+}
+
+// allChildren returns all child elements of the item in no particular order.
+func allChildren(item Node) []Node {
+	object, ok := item.Value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var result []Node
+	for key := range object {
+		if key == "resourceType" || strings.HasPrefix(key, "_") {
+			continue
+		}
+		result = append(result, children(item, key)...)
+	}
+	return result
+}
+
+func resourceType(value interface{}) string {
+	if object, ok := value.(map[string]interface{}); ok {
+		if t, ok := object["resourceType"].(string); ok {
+			return t
+		}
+	}
+	return ""
+}
+
+// choiceTypes maps the type suffixes of polymorphic elements to their FHIR types.
+var choiceTypes = map[string]string{}
+
+func init() {
+	for _, t := range []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id",
+		"instant", "integer", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid"} {
+		choiceTypes[strings.ToUpper(t[:1])+t[1:]] = t
+	}
+	for _, t := range []string{"Address", "Age", "Annotation", "Attachment", "CodeableConcept", "Coding",
+		"ContactDetail", "ContactPoint", "Contributor", "Count", "DataRequirement", "Distance", "Dosage", "Duration",
+		"Expression", "HumanName", "Identifier", "Meta", "Money", "ParameterDefinition", "Period", "Quantity", "Range",
+		"Ratio", "Reference", "RelatedArtifact", "SampledData", "Signature", "Timing", "TriggerDefinition",
+		"UsageContext"} {
+		choiceTypes[t] = t
+	}
+}
+
+func (c *context) variable(name string, s scope) ([]Node, error) {
+	switch name {
+	case "$this":
+		return s.this, nil
+	case "$index":
+		return []Node{integerNode(s.index)}, nil
+	case "$total":
+		return s.total, nil
+	case "%resource", "%rootResource", "%context":
+		return []Node{c.root}, nil
+	case "%ucum":
+		return []Node{stringNode("http://unitsofmeasure.org")}, nil
+	case "%sct":
+		return []Node{stringNode("http://snomed.info/sct")}, nil
+	case "%loinc":
+		return []Node{stringNode("http://loinc.org")}, nil
+	}
+	value, ok := c.options.Variables[strings.TrimPrefix(name, "%")]
+	if !ok {
+		return nil, fmt.Errorf("unknown variable %s", name)
+	}
+	value, err := generic(value)
+	if err != nil {
+		return nil, err
+	}
+	if items, ok := value.([]interface{}); ok {
+		result := make([]Node, len(items))
+		for i, v := range items {
+			result[i] = valueNode(v)
+		}
+		return result, nil
+	}
+	if value == nil {
+		return nil, nil
+	}
+	return []Node{valueNode(value)}, nil
+}
+
+func (c *context) binary(n binaryNode, focus []Node, s scope) ([]Node, error) {
+	left, err := c.eval(n.left, focus, s)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "and", "or", "xor", "implies":
+		return c.logic(n, left, focus, s)
+	}
+	right, err := c.eval(n.right, focus, s)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "|":
+		return union(left, right), nil
+	case "=", "!=":
+		if len(left) == 0 || len(right) == 0 {
+			return nil, nil
+		}
+		// dates and times of different precision are neither equal nor different
+		if len(left) == 1 && len(right) == 1 {
+			if x, y, ok := temporalValues(left[0], right[0]); ok {
+				cmp, ok := compareTemporal(x, y)
+				if !ok {
+					return nil, nil
+				}
+				return boolean((cmp == 0) == (n.op == "=")), nil
+			}
+		}
+		equal := len(left) == len(right)
+		for i := 0; equal && i < len(left); i++ {
+			equal = equalValues(left[i].Value, right[i].Value)
+		}
+		return boolean(equal == (n.op == "=")), nil
+	case "~", "!~":
+		return boolean(equivalentCollections(left, right) == (n.op == "~")), nil
+	case "in", "contains":
+		if n.op == "contains" {
+			left, right = right, left
+		}
+		if len(left) == 0 {
+			return nil, nil
+		}
+		if len(left) > 1 {
+			return nil, fmt.Errorf("operator %s expects a single item but got %d", n.op, len(left))
+		}
+		return boolean(containsValue(right, left[0].Value)), nil
+	case "&":
+		return []Node{stringNode(concatString(left) + concatString(right))}, nil
+	}
+	if len(left) == 0 || len(right) == 0 {
+		return nil, nil
+	}
+	if len(left) > 1 || len(right) > 1 {
+		return nil, fmt.Errorf("operator %s expects single items", n.op)
+	}
+	switch n.op {
+	case "<", "<=", ">", ">=":
+		cmp, ok := compareNodes(left[0], right[0])
+		if !ok {
+			return nil, nil
+		}
+		switch n.op {
+		case "<":
+			return boolean(cmp < 0), nil
+		case "<=":
+			return boolean(cmp <= 0), nil
+		case ">":
+			return boolean(cmp > 0), nil
+		default:
+			return boolean(cmp >= 0), nil
+		}
+	}
+	return arithmetic(n.op, left[0], right[0])
+}
+
+func (c *context) logic(n binaryNode, left []Node, focus []Node, s scope) ([]Node, error) {
+	a, knownA, err := truth(left)
+	if err != nil {
+		return nil, err
+	}
+	// short-circuit where the result is known
+	switch {
+	case n.op == "and" && knownA && !a:
+		return boolean(false), nil
+	case n.op == "or" && knownA && a:
+		return boolean(true), nil
+	case n.op == "implies" && knownA && !a:
+		return boolean(true), nil
+	}
+	right, err := c.eval(n.right, focus, s)
+	if err != nil {
+		return nil, err
+	}
+	b, knownB, err := truth(right)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "and":
+		if knownB && !b {
+			return boolean(false), nil
+		}
+		if knownA && knownB {
+			return boolean(true), nil
+		}
+	case "or":
+		if knownB && b {
+			return boolean(true), nil
+		}
+		if knownA && knownB {
+			return boolean(false), nil
+		}
+	case "xor":
+		if knownA && knownB {
+			return boolean(a != b), nil
+		}
+	case "implies":
+		if knownB && b {
+			return boolean(true), nil
+		}
+		if knownA && knownB {
+			return boolean(false), nil
+		}
+	}
+	return nil, nil
+}
+
+// truth converts a collection to a boolean. The empty collection is unknown and a single non-boolean item is true.
+func truth(collection []Node) (value bool, known bool, err error) {
+	switch len(collection) {
+	case 0:
+		return false, false, nil
+	case 1:
+		if b, ok := collection[0].Value.(bool); ok {
+			return b, true, nil
+		}
+		return true, true, nil
+	}
+	return false, false, fmt.Errorf("expected a single boolean but got %d items", len(collection))
+}
+
+func boolean(b bool) []Node {
+	return []Node{{Value: b, Type: "boolean", Index: -1}}
+}
+
+func stringNode(s string) Node {
+	return Node{Value: s, Type: "string", Index: -1}
+}
+
+func integerNode(i int) Node {
+	return Node{Value: json.Number(fmt.Sprint(i)), Type: "integer", Index: -1}
+}
+
+func numberNode(r *big.Rat, typeName string) Node {
+	if typeName == "integer" && r.IsInt() {
+		return Node{Value: json.Number(r.Num().String()), Type: "integer", Index: -1}
+	}
+	s := strings.TrimRight(r.FloatString(8), "0")
+	if strings.HasSuffix(s, ".") {
+		s += "0"
+	}
+	return Node{Value: json.Number(s), Type: "decimal", Index: -1}
+}
+
+// valueNode wraps a computed value and infers its type.
+func valueNode(value interface{}) Node {
+	return Node{Value: value, Type: inferType(value), Index: -1}
+}
+
+func inferType(value interface{}) string {
+	switch v := value.(type) {
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if strings.ContainsAny(string(v), ".eE") {
+			return "decimal"
+		}
+		return "integer"
+	}
+	return resourceType(value)
+}
+
+// number returns the single numeric value of the collection.
+func number(collection []Node) (*big.Rat, string, error) {
+	if len(collection) != 1 {
+		return nil, "", fmt.Errorf("expected a single number but got %d items", len(collection))
+	}
+	n, ok := collection[0].Value.(json.Number)
+	if !ok {
+		return nil, "", fmt.Errorf("expected a number but got %v", collection[0].Value)
+	}
+	r, ok := new(big.Rat).SetString(string(n))
+	if !ok {
+		return nil, "", fmt.Errorf("invalid number %s", n)
+	}
+	return r, inferType(n), nil
+}
+
+// integer returns the single integer value of the collection. ok is false for the empty collection.
+func integer(collection []Node) (i int, ok bool, err error) {
+	if len(collection) == 0 {
+		return 0, false, nil
+	}
+	r, _, err := number(collection)
+	if err != nil {
+		return 0, false, err
+	}
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, false, fmt.Errorf("expected an integer but got %s", r.RatString())
+	}
+	return int(r.Num().Int64()), true, nil
+}
+
+func arithmetic(op string, a, b Node) ([]Node, error) {
+	if op == "+" {
+		if x, ok := a.Value.(string); ok {
+			if y, ok := b.Value.(string); ok {
+				return []Node{stringNode(x + y)}, nil
+			}
+		}
+	}
+	x, typeA, err := number([]Node{a})
+	if err != nil {
+		return nil, err
+	}
+	y, typeB, err := number([]Node{b})
+	if err != nil {
+		return nil, err
+	}
+	typeName := "decimal"
+	if typeA == "integer" && typeB == "integer" {
+		typeName = "integer"
+	}
+	r := new(big.Rat)
+	switch op {
+	case "+":
+		r.Add(x, y)
+	case "-":
+		r.Sub(x, y)
+	case "*":
+		r.Mul(x, y)
+	case "/":
+		if y.Sign() == 0 {
+			return nil, nil
+		}
+		r.Quo(x, y)
+		typeName = "decimal"
+	case "div", "mod":
+		if y.Sign() == 0 {
+			return nil, nil
+		}
+		q := new(big.Int).Quo(new(big.Int).Mul(x.Num(), y.Denom()), new(big.Int).Mul(x.Denom(), y.Num()))
+		if op == "div" {
+			return []Node{numberNode(r.SetInt(q), "integer")}, nil
+		}
+		r.Sub(x, new(big.Rat).Mul(y, new(big.Rat).SetInt(q)))
+	default:
+		return nil, fmt.Errorf("unknown operator %s", op)
+	}
+	return []Node{numberNode(r, typeName)}, nil
+}
+
+func concatString(collection []Node) string {
+	if len(collection) == 0 {
+		return ""
+	}
+	s, _ := toString(collection[0].Value)
+	return s
+}
+
+// equalValues implements FHIRPath equality of two items.
+func equalValues(a, b interface{}) bool {
+	switch x := a.(type) {
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		r, okX := new(big.Rat).SetString(string(x))
+		s, okY := new(big.Rat).SetString(string(y))
+		return okX && okY && r.Cmp(s) == 0
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, v := range x {
+			if w, ok := y[k]; !ok || !equalValues(v, w) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equalValues(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+// equivalentValues implements FHIRPath equivalence of two items.
+func equivalentValues(a, b interface{}) bool {
+	switch x := a.(type) {
+	case string:
+		y, ok := b.(string)
+		return ok && strings.EqualFold(strings.Join(strings.Fields(x), " "), strings.Join(strings.Fields(y), " "))
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		precision := decimals(string(x))
+		if p := decimals(string(y)); p < precision {
+			precision = p
+		}
+		r, okX := new(big.Rat).SetString(string(x))
+		s, okY := new(big.Rat).SetString(string(y))
+		return okX && okY && r.FloatString(precision) == s.FloatString(precision)
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range x {
+			if k == "id" {
+				continue
+			}
+			if w, ok := y[k]; !ok || !equivalentValues(v, w) {
+				return false
+			}
+		}
+		for k := range y {
+			if _, ok := x[k]; !ok && k != "id" {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok {
+			return false
+		}
+		left := make([]Node, len(x))
+		for i, v := range x {
+			left[i] = Node{Value: v}
+		}
+		right := make([]Node, len(y))
+		for i, v := range y {
+			right[i] = Node{Value: v}
+		}
+		return equivalentCollections(left, right)
+	}
+	return a == b
+}
+
+func decimals(s string) int {
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+// equivalentCollections compares two collections item by item regardless of order.
+func equivalentCollections(a, b []Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	used := make([]bool, len(b))
+outer:
+	for _, x := range a {
+		for j, y := range b {
+			if !used[j] && equivalentValues(x.Value, y.Value) {
+				used[j] = true
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func containsValue(collection []Node, value interface{}) bool {
+	for _, item := range collection {
+		if equalValues(item.Value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// compare orders numbers by value and strings, dates and times lexically.
+// compareNodes compares two items, dates and times by their precisions and other values by compare.
+func compareNodes(a, b Node) (int, bool) {
+	if x, y, ok := temporalValues(a, b); ok {
+		return compareTemporal(x, y)
+	}
+	return compare(a.Value, b.Value)
+}
+
+func compare(a, b interface{}) (int, bool) {
+	switch x := a.(type) {
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return 0, false
+		}
+		r, okX := new(big.Rat).SetString(string(x))
+		s, okY := new(big.Rat).SetString(string(y))
+		if !okX || !okY {
+			return 0, false
+		}
+		return r.Cmp(s), true
+	case string:
+		y, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(x, y), true
+	}
+	return 0, false
+}
+
+// union merges two collections and removes duplicates.
+func union(a, b []Node) []Node {
+	var result []Node
+	for _, item := range append(append([]Node(nil), a...), b...) {
+		if !containsValue(result, item.Value) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// isType reports whether the item is of the given type. Primitive type names are matched regardless of case, so that
+// both FHIR and System types are accepted.
+func isType(item Node, typeName string) bool {
+	t := item.Type
+	if t == "" {
+		t = inferType(item.Value)
+	}
+	switch {
+	case t == "":
+		return false
+	case t == typeName:
+		return true
+	case typeName == "Resource" || typeName == "DomainResource":
+		return resourceType(item.Value) != ""
+	}
+	return unicode.IsLower(rune(t[0])) && strings.EqualFold(t, typeName)
+}
+
+func ofType(collection []Node, typeName string) []Node {
+	var result []Node
+	for _, item := range collection {
+		if isType(item, typeName) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func toString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return string(v), true
+	case bool:
+		if v {
+			return "true", true
+		}
+		return "false", true
+	}
+	return "", false
+}
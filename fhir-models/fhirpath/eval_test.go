@@ -0,0 +1,288 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhirpath
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testPatient = `{
+	"resourceType": "Patient",
+	"id": "p1",
+	"active": true,
+	"birthDate": "1974-12-25",
+	"multipleBirthInteger": 2,
+	"name": [
+		{"use": "official", "family": "Chalmers", "given": ["Peter", "James"]},
+		{"use": "usual", "given": ["Jim"]}
+	],
+	"telecom": [{"system": "phone", "value": "555", "rank": 1}],
+	"extension": [{"url": "http://example.org/weight", "valueQuantity": {"value": 1.50, "unit": "kg"}}],
+	"contained": [{"resourceType": "Organization", "id": "o1", "name": "Acme"}],
+	"managingOrganization": {"reference": "#o1"},
+	"generalPractitioner": [{"reference": "Practitioner/pr1/_history/2"}]
+}`
+
+func decodeTest(t *testing.T, s string) interface{} {
+	t.Helper()
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+type evalTest struct {
+	expression string
+	want       []interface{}
+}
+
+// values builds the expected result, turning numeric strings prefixed with # into json.Number.
+func values(vs ...interface{}) []interface{} {
+	result := make([]interface{}, len(vs))
+	for i, v := range vs {
+		if s, ok := v.(string); ok && strings.HasPrefix(s, "#") {
+			v = json.Number(s[1:])
+		}
+		result[i] = v
+	}
+	return result
+}
+
+func runEvalTests(t *testing.T, input interface{}, tests []evalTest) {
+	t.Helper()
+	for _, test := range tests {
+		got, err := MustCompile(test.expression).Evaluate(input)
+		if err != nil {
+			t.Errorf("%s failed: %v", test.expression, err)
+			continue
+		}
+		if len(got) == 0 && len(test.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s = %#v, want %#v", test.expression, got, test.want)
+		}
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	runEvalTests(t, decodeTest(t, testPatient), []evalTest{
+		{"Patient.id", values("p1")},
+		{"id", values("p1")},
+		{"Resource.id", values("p1")},
+		{"DomainResource.id", values("p1")},
+		{"Observation.id", nil},
+		{"name.given", values("Peter", "James", "Jim")},
+		{"Patient.name[1].given", values("Jim")},
+		{"name[5].given", nil},
+		{"multipleBirth", values("#2")},
+		{"extension.value.unit", values("kg")},
+		{"contained.name", values("Acme")},
+		{"unknown.element", nil},
+		{"%resource.id", values("p1")},
+		{"%context.active", values(true)},
+		{"%ucum", values("http://unitsofmeasure.org")},
+		{"name.given.where($index = 1)", values("James")},
+	})
+}
+
+func TestOperators(t *testing.T) {
+	runEvalTests(t, decodeTest(t, testPatient), []evalTest{
+		// arithmetic
+		{"1 + 2 * 3", values("#7")},
+		{"(1 + 2) * 3", values("#9")},
+		{"10 - 4 - 3", values("#3")},
+		{"7 div 2", values("#3")},
+		{"7 mod 2", values("#1")},
+		{"7 / 2", values("#3.5")},
+		{"1.50 + 1", values("#2.5")},
+		{"-(2 * 3)", values("#-6")},
+		{"'a' + 'b'", values("ab")},
+		{"'a' & {} & 'b'", values("ab")},
+		// equality and equivalence
+		{"1 = 1.0", values(true)},
+		{"'a' != 'b'", values(true)},
+		{"'ABC' ~ 'abc'", values(true)},
+		{"name.given = 'Peter' | 'James' | 'Jim'", values(true)},
+		{"{} ~ {}", values(true)},
+		{"1 !~ 2", values(true)},
+		// comparison
+		{"2 > 1", values(true)},
+		{"1 >= 1.0", values(true)},
+		{"'a' < 'b'", values(true)},
+		{"birthDate < @2000-01-01", values(true)},
+		{"@2020-01-01T10:00:00Z <= @2020-01-01T11:00:00Z", values(true)},
+		{"@2020-01-01T12:00:00+02:00 < @2020-01-01T11:00:00Z", values(true)},
+		{"@2020-01-01T10:00:00.5Z > @2020-01-01T10:00:00Z", values(true)},
+		{"@T10:30 < @T11", values(true)},
+		{"@2013-01 > @2012", values(true)},
+		{"@2012-01 > @2012", nil},
+		{"@2012-01 <= @2012", nil},
+		{"birthDate > @1974-12", nil},
+		{"birthDate = @1974-12-25", values(true)},
+		{"birthDate != @1974-12-24", values(true)},
+		{"@2012 = @2012-01", nil},
+		{"@2012 != @2012-01", nil},
+		{"@2012-01-01T10:00:00Z = @2012-01-01T10:00:00.000Z", values(true)},
+		{"@2012-01-01T12:00:00+02:00 = @2012-01-01T10:00:00Z", values(true)},
+		// collections
+		{"(1 | 2 | 1).count()", values("#2")},
+		{"'Jim' in name.given", values(true)},
+		{"name.given contains 'Paul'", values(false)},
+		// logic
+		{"true and false", values(false)},
+		{"true or false and false", values(true)},
+		{"false xor true", values(true)},
+		{"false implies {}", values(true)},
+		{"true implies true", values(true)},
+		// types
+		{"1 is Integer", values(true)},
+		{"1.5 is System.Decimal", values(true)},
+		{"active is Boolean", values(true)},
+		{"extension.value is Quantity", values(true)},
+		{"(extension.value as Quantity).unit", values("kg")},
+		{"contained.is(Organization)", values(true)},
+		{"2 + 3 is Integer", values(true)},
+	})
+}
+
+// TestEmptyPropagation checks that operators and functions on the empty collection yield the empty collection,
+// and that the three-valued logic treats the empty collection as unknown.
+func TestEmptyPropagation(t *testing.T) {
+	runEvalTests(t, decodeTest(t, testPatient), []evalTest{
+		{"{} + 1", nil},
+		{"1 - {}", nil},
+		{"-{}", nil},
+		{"{} * 2", nil},
+		{"{} = 1", nil},
+		{"1 != {}", nil},
+		{"{} < 1", nil},
+		{"{} in name.given", nil},
+		{"{} is Integer", nil},
+		{"{} as Integer", nil},
+		{"foo.upper()", nil},
+		{"'abc'.startsWith({})", nil},
+		{"{}.toInteger()", nil},
+		{"{}.abs()", nil},
+		{"{} and true", nil},
+		{"{} and false", values(false)},
+		{"{} or true", values(true)},
+		{"{} or false", nil},
+		{"{} xor true", nil},
+		{"{} implies true", values(true)},
+		{"{} implies false", nil},
+		{"true implies {}", nil},
+		{"{}.not()", nil},
+		{"{}.empty()", values(true)},
+		{"{}.exists()", values(false)},
+		{"{}.count()", values("#0")},
+		{"{}[0]", nil},
+	})
+}
+
+func TestEvaluateGeneratedResource(t *testing.T) {
+	// Evaluate accepts any value that marshals to a resource
+	resource := json.RawMessage(testPatient)
+	got, err := MustCompile("name.family").Evaluate(resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, values("Chalmers")) {
+		t.Errorf("name.family = %v", got)
+	}
+}
+
+func TestEvaluateNodes(t *testing.T) {
+	patient := decodeTest(t, testPatient)
+	nodes, err := MustCompile("name[0].given[1]").EvaluateNodes(patient, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 1 || nodes[0].Key != "given" || nodes[0].Index != 1 || nodes[0].Type != "" {
+		t.Fatalf("nodes = %#v", nodes)
+	}
+	// nodes point into the input
+	nodes[0].Parent[nodes[0].Key].([]interface{})[nodes[0].Index] = "Jimmy"
+	got, _ := MustCompile("name[0].given").Evaluate(patient)
+	if !reflect.DeepEqual(got, values("Peter", "Jimmy")) {
+		t.Errorf("given = %v after modification", got)
+	}
+
+	nodes, err = MustCompile("extension.value").EvaluateNodes(patient, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 1 || nodes[0].Key != "valueQuantity" || nodes[0].Type != "Quantity" {
+		t.Errorf("choice type node = %#v", nodes)
+	}
+}
+
+func TestVariables(t *testing.T) {
+	options := Options{Variables: map[string]interface{}{
+		"limit":  json.Number("2"),
+		"names":  []interface{}{"Peter", "Jim"},
+		"us-zip": "12345",
+	}}
+	patient := decodeTest(t, testPatient)
+	for expression, want := range map[string][]interface{}{
+		"name.given.take(%limit)":                 values("Peter", "James"),
+		"name.given.where($this in %names)":       values("Peter", "Jim"),
+		"%'us-zip'":                               values("12345"),
+		"name.given.select($this & '/' & $index)": values("Peter/0", "James/1", "Jim/2"),
+	} {
+		nodes, err := MustCompile(expression).EvaluateNodes(patient, options)
+		if err != nil {
+			t.Errorf("%s failed: %v", expression, err)
+			continue
+		}
+		got := make([]interface{}, len(nodes))
+		for i, n := range nodes {
+			got[i] = n.Value
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %v, want %v", expression, got, want)
+		}
+	}
+	if _, err := MustCompile("%unknown").EvaluateNodes(patient, options); err == nil {
+		t.Error("unknown variable didn't fail")
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	patient := decodeTest(t, testPatient)
+	for _, expression := range []string{
+		"name.given + 1",
+		"name.given.upper()",
+		"name.given > 'a'",
+		"'a' * 2",
+		"name.given.single()",
+		"unknownFunction()",
+		"first(1)",
+		"name.given and true",
+		"iif(true)",
+		"where()",
+		"name.given in 'Jim'",
+	} {
+		if got, err := MustCompile(expression).Evaluate(patient); err == nil {
+			t.Errorf("%s = %v, want error", expression, got)
+		}
+	}
+}
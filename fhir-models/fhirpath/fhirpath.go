@@ -0,0 +1,173 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fhirpath evaluates FHIRPath expressions on resources decoded into generic JSON values.
+//
+// Resources are represented as decoded by encoding/json with json.Number for numbers, so that any resource,
+// including contained ones, can be navigated without knowing its Go type. Decode turns a generated resource into
+// this representation.
+package fhirpath
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Node is a single item of a collection. Nodes which were reached by navigation remember the object and property
+// they were read from, so that callers can modify the element in place.
+type Node struct {
+	Value interface{}
+	// FHIR type of the value if known, e.g. string, Quantity or Patient
+	Type string
+	// object holding the value or nil for literals and computed values
+	Parent map[string]interface{}
+	// JSON property name of the value in Parent
+	Key string
+	// index of the value if the property holds a list or -1
+	Index int
+}
+
+// Options carry the environment of an evaluation.
+type Options struct {
+	// Variables are accessible as %name in addition to %resource, %rootResource and %context
+	Variables map[string]interface{}
+	// Resolver returns the resource a reference points to and is used by resolve()
+	Resolver func(reference string) (interface{}, error)
+}
+
+// Expression is a compiled FHIRPath expression which can be evaluated repeatedly.
+type Expression struct {
+	source string
+	root   node
+}
+
+// Compile parses a FHIRPath expression.
+func Compile(expression string) (*Expression, error) {
+	root, err := parse(expression)
+	if err != nil {
+		return nil, &SyntaxError{Expression: expression, Err: err}
+	}
+	return &Expression{source: expression, root: root}, nil
+}
+
+// MustCompile is like Compile but panics if the expression can't be parsed.
+func MustCompile(expression string) *Expression {
+	e, err := Compile(expression)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Functions returns the names of the functions the expression invokes in the order of their first occurrence.
+func (e *Expression) Functions() []string {
+	var names []string
+	seen := make(map[string]bool)
+	var walk func(n node)
+	walk = func(n node) {
+		switch n := n.(type) {
+		case functionNode:
+			if !seen[n.name] {
+				seen[n.name] = true
+				names = append(names, n.name)
+			}
+			for _, arg := range n.args {
+				walk(arg)
+			}
+		case invocationNode:
+			walk(n.target)
+			walk(n.member)
+		case indexNode:
+			walk(n.target)
+			walk(n.index)
+		case unaryNode:
+			walk(n.operand)
+		case binaryNode:
+			walk(n.left)
+			walk(n.right)
+		case typeNode:
+			walk(n.operand)
+		}
+	}
+	walk(e.root)
+	return names
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Evaluate returns the values the expression evaluates to on the input, which is either a generic JSON value or a
+// generated resource.
+func (e *Expression) Evaluate(input interface{}) ([]interface{}, error) {
+	nodes, err := e.EvaluateNodes(input, Options{})
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(nodes))
+	for i, n := range nodes {
+		values[i] = n.Value
+	}
+	return values, nil
+}
+
+// EvaluateNodes returns the nodes the expression evaluates to on the input, which is either a generic JSON value or
+// a generated resource. Navigating a generic value yields nodes which point into it.
+func (e *Expression) EvaluateNodes(input interface{}, options Options) ([]Node, error) {
+	value, err := generic(input)
+	if err != nil {
+		return nil, err
+	}
+	root := Node{Value: value, Type: resourceType(value), Index: -1}
+	ctx := &context{options: options, root: root}
+	return ctx.eval(e.root, []Node{root}, scope{this: []Node{root}})
+}
+
+// Decode returns the generic JSON representation of a resource.
+func Decode(resource interface{}) (interface{}, error) {
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// generic returns values which are already generic as they are and decodes all others.
+func generic(input interface{}) (interface{}, error) {
+	switch input.(type) {
+	case nil, map[string]interface{}, []interface{}, string, bool, json.Number:
+		return input, nil
+	}
+	return Decode(input)
+}
+
+// SyntaxError is returned for expressions which can't be parsed.
+type SyntaxError struct {
+	Expression string
+	Err        error
+}
+
+func (e *SyntaxError) Error() string {
+	return "invalid FHIRPath expression `" + e.Expression + "`: " + e.Err.Error()
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
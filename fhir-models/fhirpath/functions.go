@@ -0,0 +1,725 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhirpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+func (c *context) function(n functionNode, focus []Node, s scope) ([]Node, error) {
+	switch n.name {
+	case "where", "select", "all", "exists", "repeat", "iif":
+		return c.iteration(n, focus, s)
+	case "ofType", "is", "as":
+		if len(n.args) != 1 {
+			return nil, fmt.Errorf("%s() expects a type", n.name)
+		}
+		typeName, ok := typeArgument(n.args[0])
+		if !ok {
+			return nil, fmt.Errorf("%s() expects a type", n.name)
+		}
+		if n.name == "is" {
+			if len(focus) != 1 {
+				return nil, nil
+			}
+			return boolean(isType(focus[0], typeName)), nil
+		}
+		return ofType(focus, typeName), nil
+	case "getReferenceKey":
+		if len(n.args) > 1 {
+			return nil, fmt.Errorf("getReferenceKey() expects at most one type")
+		}
+		typeName := ""
+		if len(n.args) == 1 {
+			var ok bool
+			if typeName, ok = typeArgument(n.args[0]); !ok {
+				return nil, fmt.Errorf("getReferenceKey() expects a type")
+			}
+		}
+		return referenceKeys(focus, typeName), nil
+	}
+
+	args := make([][]Node, len(n.args))
+	for i, arg := range n.args {
+		value, err := c.eval(arg, s.this, s)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = value
+	}
+	if f, ok := collectionFunctions[n.name]; ok {
+		if len(args) < f.min || len(args) > f.max {
+			return nil, fmt.Errorf("%s() expects between %d and %d arguments", n.name, f.min, f.max)
+		}
+		return f.fn(c, focus, args)
+	}
+	if f, ok := stringFunctions[n.name]; ok {
+		if len(focus) == 0 {
+			return nil, nil
+		}
+		if len(focus) > 1 {
+			return nil, fmt.Errorf("%s() expects a single item but got %d", n.name, len(focus))
+		}
+		str, ok := focus[0].Value.(string)
+		if !ok {
+			return nil, nil
+		}
+		var strArgs []string
+		for _, arg := range args {
+			if len(arg) == 0 {
+				return nil, nil
+			}
+			a, _ := toString(arg[0].Value)
+			strArgs = append(strArgs, a)
+		}
+		return f(str, strArgs)
+	}
+	return nil, fmt.Errorf("unknown function %s()", n.name)
+}
+
+// referenceKeys implements getReferenceKey() of SQL on FHIR, which returns the id of the resources the references
+// point to, matching the key of getResourceKey(). With a type, only references to resources of that type are kept.
+func referenceKeys(focus []Node, typeName string) []Node {
+	var result []Node
+	for _, item := range focus {
+		object, ok := item.Value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		reference, ok := object["reference"].(string)
+		if !ok {
+			continue
+		}
+		parts := strings.Split(reference, "/")
+		if len(parts) >= 4 && parts[len(parts)-2] == "_history" {
+			parts = parts[:len(parts)-2]
+		}
+		if len(parts) < 2 || parts[len(parts)-1] == "" {
+			continue
+		}
+		if typeName != "" && parts[len(parts)-2] != typeName {
+			continue
+		}
+		result = append(result, stringNode(parts[len(parts)-1]))
+	}
+	return result
+}
+
+// typeArgument returns the type name passed to ofType(), is() or as().
+func typeArgument(n node) (string, bool) {
+	switch n := n.(type) {
+	case memberNode:
+		return n.name, true
+	case invocationNode:
+		if m, ok := n.member.(memberNode); ok {
+			return m.name, true
+		}
+	}
+	return "", false
+}
+
+// iteration evaluates functions whose arguments are evaluated for each item of the focus.
+func (c *context) iteration(n functionNode, focus []Node, s scope) ([]Node, error) {
+	each := func(i int, item Node, arg node) ([]Node, error) {
+		return c.eval(arg, []Node{item}, scope{this: []Node{item}, index: i, total: s.total})
+	}
+	switch n.name {
+	case "iif":
+		if len(n.args) < 2 || len(n.args) > 3 {
+			return nil, fmt.Errorf("iif() expects two or three arguments")
+		}
+		criterion, err := c.eval(n.args[0], focus, s)
+		if err != nil {
+			return nil, err
+		}
+		b, known, err := truth(criterion)
+		if err != nil {
+			return nil, err
+		}
+		if known && b {
+			return c.eval(n.args[1], focus, s)
+		}
+		if len(n.args) == 3 {
+			return c.eval(n.args[2], focus, s)
+		}
+		return nil, nil
+	case "exists":
+		if len(n.args) == 0 {
+			return boolean(len(focus) > 0), nil
+		}
+	}
+	if len(n.args) != 1 {
+		return nil, fmt.Errorf("%s() expects one argument", n.name)
+	}
+	arg := n.args[0]
+	var result []Node
+	switch n.name {
+	case "where", "exists":
+		for i, item := range focus {
+			value, err := each(i, item, arg)
+			if err != nil {
+				return nil, err
+			}
+			if b, known, err := truth(value); err != nil {
+				return nil, err
+			} else if known && b {
+				result = append(result, item)
+			}
+		}
+		if n.name == "exists" {
+			return boolean(len(result) > 0), nil
+		}
+		return result, nil
+	case "all":
+		for i, item := range focus {
+			value, err := each(i, item, arg)
+			if err != nil {
+				return nil, err
+			}
+			if b, known, err := truth(value); err != nil {
+				return nil, err
+			} else if !known || !b {
+				return boolean(false), nil
+			}
+		}
+		return boolean(true), nil
+	case "select":
+		for i, item := range focus {
+			value, err := each(i, item, arg)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value...)
+		}
+		return result, nil
+	default:
+		// repeat
+		queue := focus
+		for len(queue) > 0 {
+			var next []Node
+			for i, item := range queue {
+				value, err := each(i, item, arg)
+				if err != nil {
+					return nil, err
+				}
+				for _, v := range value {
+					if !containsValue(result, v.Value) {
+						result = append(result, v)
+						next = append(next, v)
+					}
+				}
+			}
+			queue = next
+		}
+		return result, nil
+	}
+}
+
+type collectionFunction struct {
+	min, max int
+	fn       func(c *context, focus []Node, args [][]Node) ([]Node, error)
+}
+
+var collectionFunctions map[string]collectionFunction
+
+func init() {
+	collectionFunctions = map[string]collectionFunction{
+		"empty": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return boolean(len(focus) == 0), nil
+		}},
+		"hasValue": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			if len(focus) != 1 {
+				return boolean(false), nil
+			}
+			_, ok := toString(focus[0].Value)
+			return boolean(ok), nil
+		}},
+		"count": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return []Node{integerNode(len(focus))}, nil
+		}},
+		"distinct": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return union(focus, nil), nil
+		}},
+		"isDistinct": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return boolean(len(union(focus, nil)) == len(focus)), nil
+		}},
+		"allTrue":  {0, 0, allOf(true, true)},
+		"anyTrue":  {0, 0, allOf(false, true)},
+		"allFalse": {0, 0, allOf(true, false)},
+		"anyFalse": {0, 0, allOf(false, false)},
+		"subsetOf": {1, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return boolean(subset(focus, args[0])), nil
+		}},
+		"supersetOf": {1, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return boolean(subset(args[0], focus)), nil
+		}},
+		"first": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			if len(focus) == 0 {
+				return nil, nil
+			}
+			return focus[:1], nil
+		}},
+		"last": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			if len(focus) == 0 {
+				return nil, nil
+			}
+			return focus[len(focus)-1:], nil
+		}},
+		"tail": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			if len(focus) == 0 {
+				return nil, nil
+			}
+			return focus[1:], nil
+		}},
+		"skip": {1, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			i, _, err := integer(args[0])
+			if err != nil {
+				return nil, err
+			}
+			if i <= 0 {
+				return focus, nil
+			}
+			if i >= len(focus) {
+				return nil, nil
+			}
+			return focus[i:], nil
+		}},
+		"take": {1, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			i, _, err := integer(args[0])
+			if err != nil {
+				return nil, err
+			}
+			if i <= 0 {
+				return nil, nil
+			}
+			if i >= len(focus) {
+				return focus, nil
+			}
+			return focus[:i], nil
+		}},
+		"single": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			if len(focus) > 1 {
+				return nil, fmt.Errorf("single() expects at most one item but got %d", len(focus))
+			}
+			return focus, nil
+		}},
+		"intersect": {1, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			var result []Node
+			for _, item := range focus {
+				if containsValue(args[0], item.Value) && !containsValue(result, item.Value) {
+					result = append(result, item)
+				}
+			}
+			return result, nil
+		}},
+		"exclude": {1, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			var result []Node
+			for _, item := range focus {
+				if !containsValue(args[0], item.Value) {
+					result = append(result, item)
+				}
+			}
+			return result, nil
+		}},
+		"union": {1, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return union(focus, args[0]), nil
+		}},
+		"combine": {1, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return append(append([]Node(nil), focus...), args[0]...), nil
+		}},
+		"not": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			b, known, err := truth(focus)
+			if err != nil || !known {
+				return nil, err
+			}
+			return boolean(!b), nil
+		}},
+		"children": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			var result []Node
+			for _, item := range focus {
+				result = append(result, allChildren(item)...)
+			}
+			return result, nil
+		}},
+		"descendants": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			var result []Node
+			queue := focus
+			for len(queue) > 0 {
+				var next []Node
+				for _, item := range queue {
+					next = append(next, allChildren(item)...)
+				}
+				result = append(result, next...)
+				queue = next
+			}
+			return result, nil
+		}},
+		"trace": {1, 2, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return focus, nil
+		}},
+		"extension": {1, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			if len(args[0]) == 0 {
+				return nil, nil
+			}
+			url, _ := toString(args[0][0].Value)
+			var result []Node
+			for _, item := range focus {
+				for _, extension := range children(item, "extension") {
+					if object, ok := extension.Value.(map[string]interface{}); ok && object["url"] == url {
+						result = append(result, extension)
+					}
+				}
+			}
+			return result, nil
+		}},
+		// getResourceKey() is defined by SQL on FHIR and returns the id of the resource, which is its key
+		"getResourceKey": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			var result []Node
+			for _, item := range focus {
+				if object, ok := item.Value.(map[string]interface{}); ok && resourceType(object) != "" {
+					if id, ok := object["id"].(string); ok {
+						result = append(result, stringNode(id))
+					}
+				}
+			}
+			return result, nil
+		}},
+		"resolve": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			if c.options.Resolver == nil {
+				return nil, nil
+			}
+			var result []Node
+			for _, item := range focus {
+				reference, ok := item.Value.(string)
+				if object, isObject := item.Value.(map[string]interface{}); isObject {
+					reference, ok = object["reference"].(string)
+				}
+				if !ok {
+					continue
+				}
+				resource, err := c.options.Resolver(reference)
+				if err != nil {
+					return nil, err
+				}
+				if resource == nil {
+					continue
+				}
+				value, err := generic(resource)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, valueNode(value))
+			}
+			return result, nil
+		}},
+		"toString": {0, 0, singleton(func(value interface{}) (interface{}, bool) {
+			return toString(value)
+		})},
+		"toDate":     {0, 0, singleNode(toDate)},
+		"toDateTime": {0, 0, singleNode(toDateTime)},
+		"toInteger": {0, 0, singleton(func(value interface{}) (interface{}, bool) {
+			switch v := value.(type) {
+			case json.Number:
+				if r, ok := new(big.Rat).SetString(string(v)); ok && r.IsInt() {
+					return json.Number(r.Num().String()), true
+				}
+			case string:
+				if r, ok := new(big.Rat).SetString(v); ok && r.IsInt() && !strings.Contains(v, ".") {
+					return json.Number(r.Num().String()), true
+				}
+			case bool:
+				if v {
+					return json.Number("1"), true
+				}
+				return json.Number("0"), true
+			}
+			return nil, false
+		})},
+		"toDecimal": {0, 0, singleton(func(value interface{}) (interface{}, bool) {
+			switch v := value.(type) {
+			case json.Number:
+				return v, true
+			case string:
+				if _, ok := new(big.Rat).SetString(v); ok {
+					return json.Number(v), true
+				}
+			case bool:
+				if v {
+					return json.Number("1.0"), true
+				}
+				return json.Number("0.0"), true
+			}
+			return nil, false
+		})},
+		"toBoolean": {0, 0, singleton(func(value interface{}) (interface{}, bool) {
+			s, _ := toString(value)
+			switch strings.ToLower(s) {
+			case "true", "t", "yes", "y", "1", "1.0":
+				return true, true
+			case "false", "f", "no", "n", "0", "0.0":
+				return false, true
+			}
+			return nil, false
+		})},
+		"abs":      {0, 0, math(func(r *big.Rat) *big.Rat { return r.Abs(r) })},
+		"ceiling":  {0, 0, math(func(r *big.Rat) *big.Rat { return roundRat(r, 1) })},
+		"floor":    {0, 0, math(func(r *big.Rat) *big.Rat { return roundRat(r, -1) })},
+		"truncate": {0, 0, math(func(r *big.Rat) *big.Rat { return roundRat(r, 0) })},
+		"round": {0, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			if len(focus) == 0 {
+				return nil, nil
+			}
+			r, _, err := number(focus)
+			if err != nil {
+				return nil, err
+			}
+			precision := 0
+			if len(args) == 1 {
+				if precision, _, err = integer(args[0]); err != nil {
+					return nil, err
+				}
+			}
+			return []Node{{Value: json.Number(r.FloatString(precision)), Type: "decimal", Index: -1}}, nil
+		}},
+		"join": {0, 1, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			separator := ""
+			if len(args) == 1 && len(args[0]) == 1 {
+				separator, _ = toString(args[0][0].Value)
+			}
+			var parts []string
+			for _, item := range focus {
+				if s, ok := item.Value.(string); ok {
+					parts = append(parts, s)
+				}
+			}
+			return []Node{stringNode(strings.Join(parts, separator))}, nil
+		}},
+		"today": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return []Node{{Value: time.Now().Format("2006-01-02"), Type: "date", Index: -1}}, nil
+		}},
+		"now": {0, 0, func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+			return []Node{{Value: time.Now().Format(time.RFC3339), Type: "dateTime", Index: -1}}, nil
+		}},
+	}
+}
+
+// allOf returns the implementation of allTrue(), anyTrue(), allFalse() and anyFalse().
+func allOf(all, value bool) func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+	return func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+		for _, item := range focus {
+			b, ok := item.Value.(bool)
+			matches := ok && b == value
+			if all && !matches {
+				return boolean(false), nil
+			}
+			if !all && matches {
+				return boolean(true), nil
+			}
+		}
+		return boolean(all), nil
+	}
+}
+
+func subset(a, b []Node) bool {
+	for _, item := range a {
+		if !containsValue(b, item.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// singleton returns the implementation of a conversion function of a single item.
+func singleton(convert func(value interface{}) (interface{}, bool)) func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+	return func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+		if len(focus) == 0 {
+			return nil, nil
+		}
+		if len(focus) > 1 {
+			return nil, fmt.Errorf("expected a single item but got %d", len(focus))
+		}
+		value, ok := convert(focus[0].Value)
+		if !ok {
+			return nil, nil
+		}
+		return []Node{valueNode(value)}, nil
+	}
+}
+
+// singleNode returns the implementation of a conversion function of a single item that keeps the FHIR type of the
+// result.
+func singleNode(convert func(item Node) (Node, bool)) func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+	return func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+		if len(focus) == 0 {
+			return nil, nil
+		}
+		if len(focus) > 1 {
+			return nil, fmt.Errorf("expected a single item but got %d", len(focus))
+		}
+		item, ok := convert(focus[0])
+		if !ok {
+			return nil, nil
+		}
+		return []Node{item}, nil
+	}
+}
+
+func math(fn func(r *big.Rat) *big.Rat) func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+	return func(c *context, focus []Node, args [][]Node) ([]Node, error) {
+		if len(focus) == 0 {
+			return nil, nil
+		}
+		r, typeName, err := number(focus)
+		if err != nil {
+			return nil, err
+		}
+		result := fn(r)
+		if result.IsInt() {
+			typeName = "integer"
+		}
+		return []Node{numberNode(result, typeName)}, nil
+	}
+}
+
+// roundRat rounds towards positive infinity for direction 1, towards negative infinity for -1 and towards zero for 0.
+func roundRat(r *big.Rat, direction int) *big.Rat {
+	q := new(big.Int).Quo(r.Num(), r.Denom())
+	if !r.IsInt() {
+		if direction > 0 && r.Sign() > 0 {
+			q.Add(q, big.NewInt(1))
+		} else if direction < 0 && r.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return new(big.Rat).SetInt(q)
+}
+
+var stringFunctions = map[string]func(s string, args []string) ([]Node, error){
+	"startsWith": func(s string, args []string) ([]Node, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("startsWith() expects one argument")
+		}
+		return boolean(strings.HasPrefix(s, args[0])), nil
+	},
+	"endsWith": func(s string, args []string) ([]Node, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("endsWith() expects one argument")
+		}
+		return boolean(strings.HasSuffix(s, args[0])), nil
+	},
+	"contains": func(s string, args []string) ([]Node, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("contains() expects one argument")
+		}
+		return boolean(strings.Contains(s, args[0])), nil
+	},
+	"indexOf": func(s string, args []string) ([]Node, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("indexOf() expects one argument")
+		}
+		i := strings.Index(s, args[0])
+		if i > 0 {
+			i = utf8.RuneCountInString(s[:i])
+		}
+		return []Node{integerNode(i)}, nil
+	},
+	"substring": func(s string, args []string) ([]Node, error) {
+		if len(args) < 1 || len(args) > 2 {
+			return nil, fmt.Errorf("substring() expects one or two arguments")
+		}
+		runes := []rune(s)
+		var start, length int
+		if _, err := fmt.Sscan(args[0], &start); err != nil {
+			return nil, fmt.Errorf("substring() expects an integer start")
+		}
+		if start < 0 || start >= len(runes) {
+			return nil, nil
+		}
+		end := len(runes)
+		if len(args) == 2 {
+			if _, err := fmt.Sscan(args[1], &length); err != nil {
+				return nil, fmt.Errorf("substring() expects an integer length")
+			}
+			if length < 0 {
+				length = 0
+			}
+			if start+length < end {
+				end = start + length
+			}
+		}
+		return []Node{stringNode(string(runes[start:end]))}, nil
+	},
+	"upper": func(s string, args []string) ([]Node, error) {
+		return []Node{stringNode(strings.ToUpper(s))}, nil
+	},
+	"lower": func(s string, args []string) ([]Node, error) {
+		return []Node{stringNode(strings.ToLower(s))}, nil
+	},
+	"trim": func(s string, args []string) ([]Node, error) {
+		return []Node{stringNode(strings.TrimSpace(s))}, nil
+	},
+	"length": func(s string, args []string) ([]Node, error) {
+		return []Node{integerNode(utf8.RuneCountInString(s))}, nil
+	},
+	"toChars": func(s string, args []string) ([]Node, error) {
+		var result []Node
+		for _, r := range s {
+			result = append(result, stringNode(string(r)))
+		}
+		return result, nil
+	},
+	"split": func(s string, args []string) ([]Node, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("split() expects one argument")
+		}
+		var result []Node
+		for _, part := range strings.Split(s, args[0]) {
+			result = append(result, stringNode(part))
+		}
+		return result, nil
+	},
+	"replace": func(s string, args []string) ([]Node, error) {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("replace() expects two arguments")
+		}
+		return []Node{stringNode(strings.ReplaceAll(s, args[0], args[1]))}, nil
+	},
+	"matches": func(s string, args []string) ([]Node, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("matches() expects one argument")
+		}
+		re, err := regexp.Compile(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return boolean(re.MatchString(s)), nil
+	},
+	"replaceMatches": func(s string, args []string) ([]Node, error) {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("replaceMatches() expects two arguments")
+		}
+		re, err := regexp.Compile(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return []Node{stringNode(re.ReplaceAllString(s, args[1]))}, nil
+	},
+}
@@ -0,0 +1,228 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhirpath
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestIterationFunctions(t *testing.T) {
+	runEvalTests(t, decodeTest(t, testPatient), []evalTest{
+		{"name.where(use = 'usual').given", values("Jim")},
+		{"name.where(family.exists()).use", values("official")},
+		{"name.where(foo)", nil},
+		{"name.select(given.first())", values("Peter", "Jim")},
+		{"name.select(given).count()", values("#3")},
+		{"name.all(given.exists())", values(true)},
+		{"name.all(family.exists())", values(false)},
+		{"{}.all(false)", values(true)},
+		{"name.exists()", values(true)},
+		{"name.exists(use = 'old')", values(false)},
+		{"contained.repeat(name)", values("Acme")},
+		{"(1 | 2).repeat(iif($this < 4, $this + 1, {}))", values("#2", "#3", "#4")},
+		{"iif(active, 'yes', 'no')", values("yes")},
+		{"iif({}, 'yes', 'no')", values("no")},
+		{"iif(false, 'yes')", nil},
+		{"contained.ofType(Organization).id", values("o1")},
+		{"(1 | 'a' | true).ofType(String)", values("a")},
+		{"extension.value.ofType(Quantity).unit", values("kg")},
+		{"active.is(Boolean)", values(true)},
+		{"active.as(String)", nil},
+		{"generalPractitioner.getReferenceKey()", values("pr1")},
+		{"generalPractitioner.getReferenceKey(Practitioner)", values("pr1")},
+		{"generalPractitioner.getReferenceKey(Patient)", nil},
+		{"managingOrganization.getReferenceKey()", nil},
+	})
+}
+
+func TestCollectionFunctions(t *testing.T) {
+	patient := decodeTest(t, testPatient)
+	runEvalTests(t, patient, []evalTest{
+		{"name.empty()", values(false)},
+		{"foo.empty()", values(true)},
+		{"active.hasValue()", values(true)},
+		{"name.hasValue()", values(false)},
+		{"name.given.count()", values("#3")},
+		{"(1 | 2).combine(1 | 3).distinct()", values("#1", "#2", "#3")},
+		{"(1 | 2).isDistinct()", values(true)},
+		{"(1).combine(1).isDistinct()", values(false)},
+		{"(true | false).allTrue()", values(false)},
+		{"(true | false).anyTrue()", values(true)},
+		{"(false).allFalse()", values(true)},
+		{"(true).anyFalse()", values(false)},
+		{"{}.allTrue()", values(true)},
+		{"{}.anyTrue()", values(false)},
+		{"(1 | 2).subsetOf(1 | 2 | 3)", values(true)},
+		{"(1 | 4).subsetOf(1 | 2 | 3)", values(false)},
+		{"(1 | 2 | 3).supersetOf(2)", values(true)},
+		{"name.given.first()", values("Peter")},
+		{"name.given.last()", values("Jim")},
+		{"name.given.tail()", values("James", "Jim")},
+		{"name.given.skip(2)", values("Jim")},
+		{"name.given.skip(-1).count()", values("#3")},
+		{"name.given.skip(5)", nil},
+		{"name.given.take(1)", values("Peter")},
+		{"name.given.take(0)", nil},
+		{"name.given.take(9).count()", values("#3")},
+		{"id.single()", values("p1")},
+		{"(1 | 2 | 3).intersect(2 | 3 | 4)", values("#2", "#3")},
+		{"(1 | 2 | 3).exclude(2)", values("#1", "#3")},
+		{"(1 | 2).union(2 | 3)", values("#1", "#2", "#3")},
+		{"(1 | 2).combine(2 | 3)", values("#1", "#2", "#2", "#3")},
+		{"active.not()", values(false)},
+		{"(false).not()", values(true)},
+		{"telecom.children().count()", values("#3")},
+		{"name.descendants().count()", values("#6")},
+		{"id.trace('id')", values("p1")},
+		{"extension('http://example.org/weight').value.unit", values("kg")},
+		{"extension('http://example.org/height')", nil},
+		{"contained.getResourceKey()", values("o1")},
+		{"getResourceKey()", values("p1")},
+		{"name.getResourceKey()", nil},
+		{"active.toString()", values("true")},
+		{"1.50.toString()", values("1.50")},
+		{"'2020-01-01T10:00:00Z'.toDate()", values("2020-01-01")},
+		{"'2020-01-01T10:00:00Z'.toDate() = @2020-01-01", values(true)},
+		{"birthDate.toDate() > @1974", nil},
+		{"'abc'.toDate()", nil},
+		{"(@2020-01).toDateTime()", values("2020-01")},
+		{"'2020-01-01'.toDateTime() < @2020-01-01T10:00:00Z", nil},
+		{"true.toDateTime()", nil},
+		{"'12'.toInteger()", values("#12")},
+		{"'1.5'.toInteger()", nil},
+		{"true.toInteger()", values("#1")},
+		{"'1.5'.toDecimal()", values("#1.5")},
+		{"'abc'.toDecimal()", nil},
+		{"false.toDecimal()", values("#0.0")},
+		{"'yes'.toBoolean()", values(true)},
+		{"'0'.toBoolean()", values(false)},
+		{"'maybe'.toBoolean()", nil},
+		{"(-1.5).abs()", values("#1.5")},
+		{"(-3).abs()", values("#3")},
+		{"1.1.ceiling()", values("#2")},
+		{"(-1.1).ceiling()", values("#-1")},
+		{"1.9.floor()", values("#1")},
+		{"(-1.1).floor()", values("#-2")},
+		{"(-1.9).truncate()", values("#-1")},
+		{"1.25.round(1)", values("#1.3")},
+		{"2.5.round()", values("#3")},
+		{"name.given.join(', ')", values("Peter, James, Jim")},
+		{"name.given.join()", values("PeterJamesJim")},
+	})
+
+	// the descendants of the contained organization are its id and name
+	got, err := MustCompile("contained.descendants()").Evaluate(patient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Errorf("contained.descendants() = %v", got)
+	}
+
+	for expression, pattern := range map[string]string{
+		"today()": `^\d{4}-\d{2}-\d{2}$`,
+		"now()":   `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}`,
+	} {
+		got, err := MustCompile(expression).Evaluate(patient)
+		if err != nil || len(got) != 1 {
+			t.Errorf("%s = %v, %v", expression, got, err)
+			continue
+		}
+		if s, ok := got[0].(string); !ok || !regexp.MustCompile(pattern).MatchString(s) {
+			t.Errorf("%s = %v", expression, got[0])
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	patient := decodeTest(t, testPatient)
+	organization := decodeTest(t, `{"resourceType": "Organization", "id": "o2", "name": "Other"}`)
+	options := Options{Resolver: func(reference string) (interface{}, error) {
+		switch reference {
+		case "Organization/o2":
+			return organization, nil
+		case "Organization/fail":
+			return nil, errors.New("unavailable")
+		}
+		return nil, nil
+	}}
+	evaluate := func(expression string, options Options) ([]interface{}, error) {
+		nodes, err := MustCompile(expression).EvaluateNodes(patient, options)
+		if err != nil {
+			return nil, err
+		}
+		var result []interface{}
+		for _, n := range nodes {
+			result = append(result, n.Value)
+		}
+		return result, nil
+	}
+
+	got, err := evaluate("'Organization/o2'.resolve().name", options)
+	if err != nil || !reflect.DeepEqual(got, values("Other")) {
+		t.Errorf("resolve() of string = %v, %v", got, err)
+	}
+	got, err = evaluate("managingOrganization.resolve()", options)
+	if err != nil || len(got) != 0 {
+		t.Errorf("resolve() of unknown reference = %v, %v", got, err)
+	}
+	got, err = evaluate("'Organization/o2'.resolve().is(Organization)", options)
+	if err != nil || !reflect.DeepEqual(got, values(true)) {
+		t.Errorf("type of resolved resource = %v, %v", got, err)
+	}
+	if _, err := evaluate("'Organization/fail'.resolve()", options); err == nil {
+		t.Error("resolver error wasn't returned")
+	}
+	got, err = evaluate("'Organization/o2'.resolve()", Options{})
+	if err != nil || len(got) != 0 {
+		t.Errorf("resolve() without resolver = %v, %v", got, err)
+	}
+}
+
+func TestStringFunctions(t *testing.T) {
+	runEvalTests(t, decodeTest(t, testPatient), []evalTest{
+		{"'abc'.startsWith('ab')", values(true)},
+		{"'abc'.startsWith('bc')", values(false)},
+		{"'abc'.endsWith('bc')", values(true)},
+		{"'abc'.contains('b')", values(true)},
+		{"'abc'.contains('x')", values(false)},
+		{"'abcb'.indexOf('b')", values("#1")},
+		{"'abc'.indexOf('x')", values("#-1")},
+		{"'abcdef'.substring(2)", values("cdef")},
+		{"'abcdef'.substring(1, 2)", values("bc")},
+		{"'abc'.substring(5)", nil},
+		{"'abc'.upper()", values("ABC")},
+		{"'ABC'.lower()", values("abc")},
+		{"'  a b  '.trim()", values("a b")},
+		{"'äbc'.length()", values("#3")},
+		{"'ab'.toChars()", values("a", "b")},
+		{"'a,b,,c'.split(',')", values("a", "b", "", "c")},
+		{"'abab'.replace('b', 'x')", values("axax")},
+		{"'abc'.matches('^a.c$')", values(true)},
+		{"'abc'.matches('^b')", values(false)},
+		{"'a1b22'.replaceMatches('[0-9]+', '#')", values("a#b#")},
+		{"name.family.upper()", values("CHALMERS")},
+		// the empty focus and empty arguments yield the empty collection, non-string items are ignored
+		{"name.use.where(false).upper()", nil},
+		{"'abc'.contains({})", nil},
+		{"active.upper()", nil},
+	})
+	if _, err := MustCompile("'abc'.matches('(')").Evaluate(nil); err == nil {
+		t.Error("invalid regular expression didn't fail")
+	}
+}
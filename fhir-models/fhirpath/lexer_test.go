@@ -0,0 +1,78 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhirpath
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLex(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       []token
+	}{
+		{"Patient.name", []token{
+			{tokenIdentifier, "Patient", 0}, {tokenOperator, ".", 7}, {tokenIdentifier, "name", 8},
+		}},
+		{"a <= 1.5", []token{
+			{tokenIdentifier, "a", 0}, {tokenOperator, "<=", 2}, {tokenNumber, "1.5", 5},
+		}},
+		{"1.given", []token{
+			{tokenNumber, "1", 0}, {tokenOperator, ".", 1}, {tokenIdentifier, "given", 2},
+		}},
+		{`'it\'s\nA'`, []token{{tokenString, "it's\nA", 0}}},
+		{"`div`.x", []token{
+			{tokenIdentifier, "div", 0}, {tokenOperator, ".", 5}, {tokenIdentifier, "x", 6},
+		}},
+		{"@2020-01-02T10:00:00Z @T12:30", []token{
+			{tokenDateTime, "2020-01-02T10:00:00Z", 0}, {tokenDateTime, "T12:30", 22},
+		}},
+		{"%resource %'us-zip'", []token{{tokenVariable, "resource", 0}, {tokenVariable, "us-zip", 10}}},
+		{"$this != {}", []token{
+			{tokenIdentifier, "$this", 0}, {tokenOperator, "!=", 6}, {tokenOperator, "{", 9}, {tokenOperator, "}", 10},
+		}},
+		{"a // comment\n/* block\ncomment */ b", []token{
+			{tokenIdentifier, "a", 0}, {tokenIdentifier, "b", 33},
+		}},
+		{"1 div 2", []token{{tokenNumber, "1", 0}, {tokenIdentifier, "div", 2}, {tokenNumber, "2", 6}}},
+	}
+	for _, test := range tests {
+		got, err := lex(test.expression)
+		if err != nil {
+			t.Errorf("lex(%q) failed: %v", test.expression, err)
+			continue
+		}
+		want := append(test.want, token{kind: tokenEOF, pos: len([]rune(test.expression))})
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("lex(%q) = %v, want %v", test.expression, got, want)
+		}
+	}
+}
+
+func TestLexErrors(t *testing.T) {
+	for _, expression := range []string{
+		"'unterminated",
+		"`unterminated",
+		"'escape at end\\",
+		"'\\u00'",
+		"/* unterminated",
+		"a # b",
+	} {
+		if tokens, err := lex(expression); err == nil {
+			t.Errorf("lex(%q) = %v, want error", expression, tokens)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhirpath
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// node is a node of the syntax tree of an expression
+type node interface{}
+
+type literalNode struct {
+	value Node
+}
+
+type emptyNode struct{}
+
+// memberNode navigates to the child elements with the given name
+type memberNode struct {
+	name string
+}
+
+type functionNode struct {
+	name string
+	args []node
+}
+
+// invocationNode evaluates the member or function on the result of the target
+type invocationNode struct {
+	target node
+	member node
+}
+
+type indexNode struct {
+	target node
+	index  node
+}
+
+type variableNode struct {
+	name string
+}
+
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+type typeNode struct {
+	op       string
+	operand  node
+	typeName string
+}
+
+var precedence = map[string]int{
+	"implies":  1,
+	"or":       2,
+	"xor":      2,
+	"and":      3,
+	"in":       4,
+	"contains": 4,
+	"=":        5,
+	"~":        5,
+	"!=":       5,
+	"!~":       5,
+	"<":        6,
+	"<=":       6,
+	">":        6,
+	">=":       6,
+	"|":        7,
+	"is":       8,
+	"as":       8,
+	"+":        9,
+	"-":        9,
+	"&":        9,
+	"*":        10,
+	"/":        10,
+	"div":      10,
+	"mod":      10,
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func parse(expression string) (node, error) {
+	tokens, err := lex(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	n, err := p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at %d", t, t.pos)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(text string) error {
+	if t := p.next(); t.kind != tokenOperator || t.text != text {
+		return fmt.Errorf("expected `%s` but found %s at %d", text, t, t.pos)
+	}
+	return nil
+}
+
+// binaryOperator returns the operator of the next token if it is one.
+func (p *parser) binaryOperator() (string, bool) {
+	t := p.peek()
+	switch t.kind {
+	case tokenOperator, tokenIdentifier:
+		if _, ok := precedence[t.text]; ok {
+			return t.text, true
+		}
+	}
+	return "", false
+}
+
+// expression parses operators with a precedence of at least min by precedence climbing.
+func (p *parser) expression(min int) (node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.binaryOperator()
+		if !ok || precedence[op] < min {
+			return left, nil
+		}
+		p.next()
+		if op == "is" || op == "as" {
+			typeName, err := p.typeSpecifier()
+			if err != nil {
+				return nil, err
+			}
+			left = typeNode{op: op, operand: left, typeName: typeName}
+			continue
+		}
+		right, err := p.expression(precedence[op] + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) typeSpecifier() (string, error) {
+	t := p.next()
+	if t.kind != tokenIdentifier {
+		return "", fmt.Errorf("expected type name but found %s at %d", t, t.pos)
+	}
+	name := t.text
+	// qualified names like FHIR.Patient or System.String
+	if next := p.peek(); next.kind == tokenOperator && next.text == "." {
+		p.next()
+		t = p.next()
+		if t.kind != tokenIdentifier {
+			return "", fmt.Errorf("expected type name but found %s at %d", t, t.pos)
+		}
+		name = t.text
+	}
+	return name, nil
+}
+
+func (p *parser) unary() (node, error) {
+	if t := p.peek(); t.kind == tokenOperator && (t.text == "-" || t.text == "+") {
+		p.next()
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: t.text, operand: operand}, nil
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (node, error) {
+	n, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokenOperator {
+			return n, nil
+		}
+		switch t.text {
+		case ".":
+			p.next()
+			member, err := p.invocation()
+			if err != nil {
+				return nil, err
+			}
+			n = invocationNode{target: n, member: member}
+		case "[":
+			p.next()
+			index, err := p.expression(0)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			n = indexNode{target: n, index: index}
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) term() (node, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenString:
+		p.next()
+		return literalNode{value: Node{Value: t.text, Type: "string", Index: -1}}, nil
+	case tokenNumber:
+		p.next()
+		typeName := "integer"
+		for _, r := range t.text {
+			if r == '.' {
+				typeName = "decimal"
+			}
+		}
+		// quantity literals like 4 'mg' are reduced to their value
+		if next := p.peek(); next.kind == tokenString {
+			p.next()
+		} else if next.kind == tokenIdentifier && calendarUnits[next.text] {
+			p.next()
+		}
+		return literalNode{value: Node{Value: json.Number(t.text), Type: typeName, Index: -1}}, nil
+	case tokenDateTime:
+		p.next()
+		return literalNode{value: Node{Value: t.text, Type: dateTimeLiteralType(t.text), Index: -1}}, nil
+	case tokenVariable:
+		p.next()
+		return variableNode{name: "%" + t.text}, nil
+	case tokenOperator:
+		switch t.text {
+		case "(":
+			p.next()
+			n, err := p.expression(0)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return n, nil
+		case "{":
+			p.next()
+			if err := p.expect("}"); err != nil {
+				return nil, err
+			}
+			return emptyNode{}, nil
+		}
+	case tokenIdentifier:
+		switch t.text {
+		case "true", "false":
+			p.next()
+			return literalNode{value: Node{Value: t.text == "true", Type: "boolean", Index: -1}}, nil
+		}
+		return p.invocation()
+	}
+	return nil, fmt.Errorf("unexpected %s at %d", t, t.pos)
+}
+
+func (p *parser) invocation() (node, error) {
+	t := p.next()
+	if t.kind != tokenIdentifier {
+		return nil, fmt.Errorf("expected identifier but found %s at %d", t, t.pos)
+	}
+	switch t.text {
+	case "$this", "$index", "$total":
+		return variableNode{name: t.text}, nil
+	}
+	if next := p.peek(); next.kind == tokenOperator && next.text == "(" {
+		p.next()
+		var args []node
+		if closing := p.peek(); !(closing.kind == tokenOperator && closing.text == ")") {
+			for {
+				arg, err := p.expression(0)
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+				if sep := p.peek(); sep.kind == tokenOperator && sep.text == "," {
+					p.next()
+					continue
+				}
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return functionNode{name: t.text, args: args}, nil
+	}
+	return memberNode{name: t.text}, nil
+}
+
+var calendarUnits = map[string]bool{
+	"year": true, "years": true, "month": true, "months": true, "week": true, "weeks": true, "day": true,
+	"days": true, "hour": true, "hours": true, "minute": true, "minutes": true, "second": true, "seconds": true,
+	"millisecond": true, "milliseconds": true,
+}
+
+func dateTimeLiteralType(s string) string {
+	switch {
+	case len(s) > 0 && s[0] == 'T':
+		return "time"
+	case len(s) > 10 || len(s) > 0 && s[len(s)-1] == 'T':
+		return "dateTime"
+	default:
+		return "date"
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fhirpath
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// format renders a parse tree with explicit parentheses.
+func format(n node) string {
+	switch n := n.(type) {
+	case literalNode:
+		switch n.value.Type {
+		case "string":
+			return "'" + n.value.Value.(string) + "'"
+		case "date", "dateTime", "time":
+			return "@" + n.value.Value.(string)
+		}
+		return fmt.Sprint(n.value.Value)
+	case emptyNode:
+		return "{}"
+	case memberNode:
+		return n.name
+	case variableNode:
+		return n.name
+	case functionNode:
+		args := make([]string, len(n.args))
+		for i, arg := range n.args {
+			args[i] = format(arg)
+		}
+		return n.name + "(" + strings.Join(args, ", ") + ")"
+	case invocationNode:
+		return format(n.target) + "." + format(n.member)
+	case indexNode:
+		return format(n.target) + "[" + format(n.index) + "]"
+	case unaryNode:
+		return "(" + n.op + format(n.operand) + ")"
+	case binaryNode:
+		return "(" + format(n.left) + " " + n.op + " " + format(n.right) + ")"
+	case typeNode:
+		return "(" + format(n.operand) + " " + n.op + " " + n.typeName + ")"
+	}
+	return fmt.Sprintf("%T", n)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{"Patient.name.given", "Patient.name.given"},
+		{"name[0].given.first()", "name[0].given.first()"},
+		{"name.where(use = 'official')", "name.where((use = 'official'))"},
+		{"iif(a, 'x', 'y')", "iif(a, 'x', 'y')"},
+		{"%resource.id | $this", "(%resource.id | $this)"},
+		{"{}", "{}"},
+		{"-1.5", "(-1.5)"},
+		{"4 'mg' + 2 days", "(4 + 2)"},
+		{"@2020-01-01 < @2020-01-01T10:00", "(@2020-01-01 < @2020-01-01T10:00)"},
+		{"value as FHIR.Quantity", "(value as Quantity)"},
+		{"(a or b).not()", "(a or b).not()"},
+
+		// precedence from lowest to highest: implies, or/xor, and, in/contains, equality, comparison, |, is/as,
+		// additive, multiplicative
+		{"a implies b or c", "(a implies (b or c))"},
+		{"a or b and c", "(a or (b and c))"},
+		{"a xor b or c", "((a xor b) or c)"},
+		{"a and b in c", "(a and (b in c))"},
+		{"a in b = c", "(a in (b = c))"},
+		{"a = b < c", "(a = (b < c))"},
+		{"a < b | c", "(a < (b | c))"},
+		{"a | b is Integer", "(a | (b is Integer))"},
+		{"a + b is Integer", "((a + b) is Integer)"},
+		{"a + b * c", "(a + (b * c))"},
+		{"a - b - c", "((a - b) - c)"},
+		{"a & b + c", "((a & b) + c)"},
+		{"a div b mod c", "((a div b) mod c)"},
+		{"-a.b * c", "((-a.b) * c)"},
+	}
+	for _, test := range tests {
+		n, err := parse(test.expression)
+		if err != nil {
+			t.Errorf("parse(%q) failed: %v", test.expression, err)
+			continue
+		}
+		if got := format(n); got != test.want {
+			t.Errorf("parse(%q) = %s, want %s", test.expression, got, test.want)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, expression := range []string{
+		"",
+		"name.",
+		"name.where(",
+		"name[0",
+		"1 +",
+		"a b",
+		"{1}",
+		"value is 'x'",
+		"'unterminated",
+	} {
+		_, err := Compile(expression)
+		var syntaxError *SyntaxError
+		if !errors.As(err, &syntaxError) {
+			t.Errorf("Compile(%q) returned %v, want a SyntaxError", expression, err)
+		} else if syntaxError.Expression != expression {
+			t.Errorf("Compile(%q) returned error for %q", expression, syntaxError.Expression)
+		}
+	}
+}
+
+func TestFunctions(t *testing.T) {
+	e := MustCompile("name.where(given.exists()).select(family.upper() & given.first()).exists()")
+	want := []string{"where", "exists", "select", "upper", "first"}
+	if got := e.Functions(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Functions() = %v, want %v", got, want)
+	}
+}
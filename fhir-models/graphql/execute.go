@@ -0,0 +1,690 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhirpath"
+)
+
+// execution holds the state of executing a single request
+type execution struct {
+	ctx       context.Context
+	schema    *schema
+	source    Source
+	query     string
+	fragments map[string]*fragment
+	variables map[string]interface{}
+	errors    []*Error
+}
+
+// object is a JSON object keeping the order of its members
+type object struct {
+	keys   []string
+	values []interface{}
+}
+
+func (o *object) set(key string, v interface{}) {
+	o.keys = append(o.keys, key)
+	o.values = append(o.values, v)
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(o.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (e *Executor) execute(ctx context.Context, request Request, rootType string, root map[string]interface{}) *Response {
+	x := &execution{ctx: ctx, schema: e.schema, source: e.source, query: request.Query}
+	response := &Response{Data: json.RawMessage("null")}
+	op, err := x.prepare(request)
+	if err != nil {
+		response.Errors = append(x.errors, x.newError(err, -1, nil))
+		return response
+	}
+	x.validate(rootType, op.selections, make(map[string]bool))
+	if len(x.errors) > 0 {
+		response.Errors = x.errors
+		return response
+	}
+	data := x.object(rootType, root, root, op.selections, nil)
+	if response.Data, err = json.Marshal(data); err != nil {
+		response.Data = json.RawMessage("null")
+		x.errors = append(x.errors, &Error{Message: err.Error()})
+	}
+	response.Errors = x.errors
+	return response
+}
+
+// prepare parses the query, selects the operation and coerces its variables.
+func (x *execution) prepare(request Request) (*operation, error) {
+	doc, err := parseDocument(request.Query)
+	if err != nil {
+		return nil, err
+	}
+	x.fragments = doc.fragments
+	var op *operation
+	for _, o := range doc.operations {
+		if request.OperationName == "" && len(doc.operations) > 1 {
+			return nil, fmt.Errorf("the operation name is required for documents with several operations")
+		}
+		if request.OperationName == "" || o.name == request.OperationName {
+			op = o
+			break
+		}
+	}
+	if op == nil {
+		return nil, fmt.Errorf("unknown operation `%s`", request.OperationName)
+	}
+	if op.kind != "query" {
+		return nil, fmt.Errorf("%s operations aren't supported", op.kind)
+	}
+	x.variables = make(map[string]interface{})
+	for _, definition := range op.variables {
+		v, ok := request.Variables[definition.name]
+		if !ok && definition.defaultValue != nil {
+			v, ok = x.value(definition.defaultValue), true
+		}
+		if definition.typ.nonNull && v == nil {
+			return nil, fmt.Errorf("missing value of variable $%s", definition.name)
+		}
+		if ok {
+			x.variables[definition.name] = v
+		}
+	}
+	return op, nil
+}
+
+// validate checks that the selected fields and their arguments exist and that fields are selected exactly on objects.
+func (x *execution) validate(typeName string, selections []selection, visited map[string]bool) {
+	t := x.schema.types[typeName]
+	for _, s := range selections {
+		switch s := s.(type) {
+		case *field:
+			if s.name == "__typename" {
+				continue
+			}
+			definition := t.fields[s.name]
+			if definition == nil {
+				x.errors = append(x.errors, x.newError(fmt.Errorf("cannot query field `%s` on type `%s`", s.name, typeName), s.pos, nil))
+				continue
+			}
+			for _, a := range s.arguments {
+				if _, ok := definition.arguments[a.name]; !ok && definition.typ.elem == nil {
+					x.errors = append(x.errors, x.newError(fmt.Errorf("unknown argument `%s` of field `%s`", a.name, s.name), s.pos, nil))
+				}
+			}
+			fieldType := x.schema.types[definition.typ.namedType()]
+			switch {
+			case fieldType == nil:
+				x.errors = append(x.errors, x.newError(fmt.Errorf("unknown type `%s`", definition.typ.namedType()), s.pos, nil))
+			case fieldType.kind == "scalar" || fieldType.kind == "enum":
+				if len(s.selections) > 0 {
+					x.errors = append(x.errors, x.newError(fmt.Errorf("fields can't be selected on `%s` of type `%s`", s.name, fieldType.name), s.pos, nil))
+				}
+			case len(s.selections) == 0:
+				x.errors = append(x.errors, x.newError(fmt.Errorf("fields have to be selected on `%s` of type `%s`", s.name, fieldType.name), s.pos, nil))
+			default:
+				x.validate(fieldType.name, s.selections, visited)
+			}
+		case *fragmentSpread:
+			fragment := x.fragments[s.name]
+			if fragment == nil {
+				x.errors = append(x.errors, x.newError(fmt.Errorf("unknown fragment `%s`", s.name), s.pos, nil))
+				continue
+			}
+			if !visited[s.name] {
+				visited[s.name] = true
+				x.validateFragment(fragment.typeCondition, typeName, fragment.selections, s.pos, visited)
+			}
+		case *inlineFragment:
+			condition := s.typeCondition
+			if condition == "" {
+				condition = typeName
+			}
+			x.validateFragment(condition, typeName, s.selections, -1, visited)
+		}
+	}
+}
+
+func (x *execution) validateFragment(condition, typeName string, selections []selection, pos int, visited map[string]bool) {
+	t := x.schema.types[condition]
+	if t == nil || t.fields == nil && t.possibleTypes == nil {
+		x.errors = append(x.errors, x.newError(fmt.Errorf("invalid type condition `%s`", condition), pos, nil))
+		return
+	}
+	if !x.applies(condition, typeName) && !x.applies(typeName, condition) {
+		x.errors = append(x.errors, x.newError(fmt.Errorf("fragment on `%s` can never apply to `%s`", condition, typeName), pos, nil))
+		return
+	}
+	x.validate(condition, selections, visited)
+}
+
+func (x *execution) newError(err error, pos int, path []interface{}) *Error {
+	e := &Error{Message: err.Error(), Path: path}
+	if s, ok := err.(*syntaxError); ok {
+		pos = s.pos
+	}
+	if pos >= 0 && pos <= len(x.query) {
+		line := strings.Count(x.query[:pos], "\n") + 1
+		column := pos - strings.LastIndex(x.query[:pos], "\n")
+		e.Locations = []Location{{Line: line, Column: column}}
+	}
+	return e
+}
+
+// object returns the selected fields of the value of the given object type. The container is the resource which
+// contains the value, in which contained resources are looked up.
+func (x *execution) object(typeName string, v map[string]interface{}, container map[string]interface{}, selections []selection, path []interface{}) *object {
+	if resourceType(v) != "" {
+		container = v
+	}
+	result := &object{}
+	keys, fields := x.collectFields(typeName, selections, make(map[string]bool))
+	for _, key := range keys {
+		f := fields[key][0]
+		fieldPath := append(append([]interface{}(nil), path...), key)
+		if f.name == "__typename" {
+			result.set(key, typeName)
+			continue
+		}
+		definition := x.schema.types[typeName].fields[f.name]
+		if definition == nil {
+			x.errors = append(x.errors, x.newError(fmt.Errorf("cannot query field `%s` on type `%s`", f.name, typeName), f.pos, fieldPath))
+			result.set(key, nil)
+			continue
+		}
+		var selections []selection
+		for _, same := range fields[key] {
+			selections = append(selections, same.selections...)
+		}
+		value, err := x.field(typeName, v, container, definition, f, selections, fieldPath)
+		if err != nil {
+			x.errors = append(x.errors, x.newError(err, f.pos, fieldPath))
+			value = nil
+		}
+		result.set(key, value)
+	}
+	return result
+}
+
+// collectFields returns the fields of the selections which apply to the type, grouped by their response key.
+func (x *execution) collectFields(typeName string, selections []selection, visited map[string]bool) ([]string, map[string][]*field) {
+	var keys []string
+	fields := make(map[string][]*field)
+	add := func(moreKeys []string, more map[string][]*field) {
+		for _, key := range moreKeys {
+			if fields[key] == nil {
+				keys = append(keys, key)
+			}
+			fields[key] = append(fields[key], more[key]...)
+		}
+	}
+	for _, s := range selections {
+		switch s := s.(type) {
+		case *field:
+			if !x.included(s.directives) {
+				continue
+			}
+			add([]string{s.responseKey()}, map[string][]*field{s.responseKey(): {s}})
+		case *fragmentSpread:
+			fragment := x.fragments[s.name]
+			if !x.included(s.directives) || visited[s.name] || fragment == nil || !x.applies(fragment.typeCondition, typeName) {
+				if fragment == nil {
+					x.errors = append(x.errors, x.newError(fmt.Errorf("unknown fragment `%s`", s.name), s.pos, nil))
+				}
+				continue
+			}
+			visited[s.name] = true
+			add(x.collectFields(typeName, fragment.selections, visited))
+		case *inlineFragment:
+			if !x.included(s.directives) || s.typeCondition != "" && !x.applies(s.typeCondition, typeName) {
+				continue
+			}
+			add(x.collectFields(typeName, s.selections, visited))
+		}
+	}
+	return keys, fields
+}
+
+// included evaluates the directives @skip and @include.
+func (x *execution) included(directives []directive) bool {
+	for _, d := range directives {
+		for _, a := range d.arguments {
+			if a.name != "if" {
+				continue
+			}
+			condition, _ := x.value(a.value).(bool)
+			if d.name == "skip" && condition || d.name == "include" && !condition {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// applies returns true if a fragment with the type condition applies to objects of the given type.
+func (x *execution) applies(condition, typeName string) bool {
+	if condition == typeName {
+		return true
+	}
+	t := x.schema.types[condition]
+	return t != nil && t.possibleTypes[typeName]
+}
+
+// value returns the value of a literal with variables replaced by their values.
+func (x *execution) value(v value) interface{} {
+	switch v := v.(type) {
+	case variable:
+		return x.variables[string(v)]
+	case enumValue:
+		return string(v)
+	case []value:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			list[i] = x.value(item)
+		}
+		return list
+	case map[string]value:
+		object := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			object[key] = x.value(item)
+		}
+		return object
+	}
+	return v
+}
+
+// field resolves and completes the value of a field.
+func (x *execution) field(typeName string, v, container map[string]interface{}, definition *fieldDefinition, f *field, selections []selection, path []interface{}) (interface{}, error) {
+	arguments := make(map[string]interface{}, len(f.arguments))
+	for _, a := range f.arguments {
+		if _, ok := definition.arguments[a.name]; !ok && definition.typ.elem == nil {
+			return nil, fmt.Errorf("unknown argument `%s` of field `%s`", a.name, f.name)
+		}
+		arguments[a.name] = x.value(a.value)
+	}
+	for name, t := range definition.arguments {
+		if _, ok := arguments[name]; !ok && t.nonNull {
+			return nil, fmt.Errorf("missing argument `%s` of field `%s`", name, f.name)
+		}
+	}
+
+	var resolved interface{}
+	var err error
+	filter := true
+	switch {
+	case typeName == x.schema.query:
+		resolved, err = x.root(definition, arguments)
+		filter = false
+	case typeName == "Reference" && f.name == "resource":
+		resolved, err = x.reference(v, container, arguments)
+	default:
+		resolved = v[f.name]
+	}
+	if err != nil {
+		return nil, err
+	}
+	return x.complete(definition.typ, resolved, container, arguments, filter, selections, path)
+}
+
+// root reads or searches the resources of a field of the query type.
+func (x *execution) root(definition *fieldDefinition, arguments map[string]interface{}) (interface{}, error) {
+	if x.source == nil {
+		return nil, fmt.Errorf("no source of resources")
+	}
+	resourceType := definition.typ.namedType()
+	if definition.typ.elem == nil {
+		id, _ := arguments["id"].(string)
+		resource, err := x.source.Read(x.ctx, resourceType, id)
+		if err != nil || resource == nil {
+			return nil, err
+		}
+		return decode(resource)
+	}
+	params := make(url.Values)
+	for name, v := range arguments {
+		// arguments of search parameters like general_practitioner stand for general-practitioner
+		if !strings.HasPrefix(name, "_") {
+			name = strings.ReplaceAll(name, "_", "-")
+		}
+		if list, ok := v.([]interface{}); ok {
+			for _, item := range list {
+				params.Add(name, argumentString(item))
+			}
+		} else {
+			params.Add(name, argumentString(v))
+		}
+	}
+	resources, err := x.source.Search(x.ctx, resourceType, params)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		value, err := decode(resource)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+// reference resolves the resource a Reference points to. Unresolvable references are an error unless the argument
+// optional is true. References to other types than the one given by the argument type resolve to null.
+func (x *execution) reference(v, container map[string]interface{}, arguments map[string]interface{}) (interface{}, error) {
+	reference, _ := v["reference"].(string)
+	optional, _ := arguments["optional"].(bool)
+	wanted, _ := arguments["type"].(string)
+	if reference == "" {
+		return nil, nil
+	}
+	resource, err := x.resolve(reference, container)
+	if err == nil && resource == nil {
+		err = fmt.Errorf("can't resolve reference `%s`", reference)
+	}
+	if err != nil {
+		if optional {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if wanted != "" && resourceType(resource) != wanted {
+		return nil, nil
+	}
+	return resource, nil
+}
+
+func (x *execution) resolve(reference string, container map[string]interface{}) (map[string]interface{}, error) {
+	if strings.HasPrefix(reference, "#") {
+		contained, _ := container["contained"].([]interface{})
+		for _, c := range contained {
+			if resource, ok := c.(map[string]interface{}); ok && resource["id"] == reference[1:] {
+				return resource, nil
+			}
+		}
+		return nil, nil
+	}
+	parts := strings.Split(reference, "/")
+	if len(parts) >= 4 && parts[len(parts)-2] == "_history" {
+		parts = parts[:len(parts)-2]
+	}
+	if len(parts) < 2 || x.schema.types["Resource"] == nil || !x.schema.types["Resource"].possibleTypes[parts[len(parts)-2]] {
+		return nil, fmt.Errorf("unsupported reference `%s`", reference)
+	}
+	if x.source == nil {
+		return nil, fmt.Errorf("no source of resources")
+	}
+	resource, err := x.source.Read(x.ctx, parts[len(parts)-2], parts[len(parts)-1])
+	if err != nil || resource == nil {
+		return nil, err
+	}
+	return decode(resource)
+}
+
+// complete converts the resolved value into the result of the given type.
+func (x *execution) complete(t typeRef, v interface{}, container map[string]interface{}, arguments map[string]interface{}, filter bool, selections []selection, path []interface{}) (interface{}, error) {
+	if v == nil {
+		if t.nonNull {
+			return nil, fmt.Errorf("missing value of non-null type %s", t)
+		}
+		return nil, nil
+	}
+	if t.elem != nil {
+		items, ok := v.([]interface{})
+		if !ok {
+			items = []interface{}{v}
+		}
+		if filter {
+			var err error
+			if items, err = x.filter(items, arguments); err != nil {
+				return nil, err
+			}
+		}
+		result := make([]interface{}, len(items))
+		for i, item := range items {
+			itemPath := append(append([]interface{}(nil), path...), i)
+			value, err := x.complete(*t.elem, item, container, nil, false, selections, itemPath)
+			if err != nil {
+				x.errors = append(x.errors, x.newError(err, -1, itemPath))
+			}
+			result[i] = value
+		}
+		return result, nil
+	}
+
+	definition := x.schema.types[t.name]
+	if definition == nil {
+		return nil, fmt.Errorf("unknown type `%s`", t.name)
+	}
+	switch definition.kind {
+	case "scalar":
+		if len(selections) > 0 {
+			return nil, fmt.Errorf("fields can't be selected on scalar type `%s`", t.name)
+		}
+		return v, nil
+	case "enum":
+		code, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid value of enum `%s`", t.name)
+		}
+		return codeToEnumValue(code), nil
+	}
+	objectValue, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid value of type `%s`", t.name)
+	}
+	if len(selections) == 0 {
+		return nil, fmt.Errorf("fields have to be selected on type `%s`", t.name)
+	}
+	typeName := t.name
+	if definition.possibleTypes != nil {
+		typeName = resourceType(objectValue)
+		if !definition.possibleTypes[typeName] {
+			return nil, fmt.Errorf("`%s` isn't a member of `%s`", typeName, t.name)
+		}
+	}
+	return x.object(typeName, objectValue, container, selections, path), nil
+}
+
+// filter applies the list arguments _offset, _count, fhirpath, _filter and those filtering by a property value.
+func (x *execution) filter(items []interface{}, arguments map[string]interface{}) ([]interface{}, error) {
+	names := make([]string, 0, len(arguments))
+	for name := range arguments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		argument := arguments[name]
+		var keep func(item interface{}) (bool, error)
+		switch name {
+		case "_offset", "_count":
+			continue
+		case "fhirpath":
+			expression, err := fhirpath.Compile(argumentString(argument))
+			if err != nil {
+				return nil, err
+			}
+			keep = func(item interface{}) (bool, error) {
+				result, err := expression.Evaluate(item)
+				return len(result) == 1 && result[0] == true, err
+			}
+		case "_filter":
+			f, err := parseFilter(argumentString(argument))
+			if err != nil {
+				return nil, err
+			}
+			keep = f.matches
+		default:
+			keep = func(item interface{}) (bool, error) {
+				return hasPropertyValue(item, name, argument), nil
+			}
+		}
+		var kept []interface{}
+		for _, item := range items {
+			ok, err := keep(item)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				kept = append(kept, item)
+			}
+		}
+		items = kept
+	}
+	if offset, ok := intArgument(arguments["_offset"]); ok {
+		if offset > len(items) {
+			offset = len(items)
+		}
+		if offset > 0 {
+			items = items[offset:]
+		}
+	}
+	if count, ok := intArgument(arguments["_count"]); ok && count >= 0 && count < len(items) {
+		items = items[:count]
+	}
+	return items, nil
+}
+
+// hasPropertyValue returns true if the property of the item or one of its values equals the argument.
+func hasPropertyValue(item interface{}, name string, argument interface{}) bool {
+	object, ok := item.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	values, ok := object[name].([]interface{})
+	if !ok {
+		values = []interface{}{object[name]}
+	}
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		s := argumentString(v)
+		if s == argumentString(argument) || codeToEnumValue(s) == argumentString(argument) {
+			return true
+		}
+	}
+	return false
+}
+
+func argumentString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+func intArgument(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		i, err := strconv.Atoi(v.String())
+		return i, err == nil
+	case float64:
+		return int(v), v == float64(int(v))
+	case int:
+		return v, true
+	}
+	return 0, false
+}
+
+// codeToEnumValue returns the GraphQL enum value of a code, which the generator derives the same way.
+func codeToEnumValue(code string) string {
+	switch code {
+	case "=":
+		return "Equals"
+	case "!=":
+		return "NotEquals"
+	case ">":
+		return "GreaterThan"
+	case "<":
+		return "LessThan"
+	case ">=":
+		return "GreaterOrEquals"
+	case "<=":
+		return "LessOrEquals"
+	case "true", "false", "null":
+		return code + "_"
+	}
+	var b strings.Builder
+	for i := 0; i < len(code); i++ {
+		switch c := code[i]; {
+		case c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z':
+			b.WriteByte(c)
+		case c >= '0' && c <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteByte(c)
+		default:
+			b.WriteByte('_')
+			// runes beyond ASCII are replaced as a whole
+			for i+1 < len(code) && code[i+1]&0xC0 == 0x80 {
+				i++
+			}
+		}
+	}
+	return b.String()
+}
+
+// decode returns the generic JSON representation of a resource.
+func decode(resource interface{}) (map[string]interface{}, error) {
+	if object, ok := resource.(map[string]interface{}); ok {
+		return object, nil
+	}
+	v, err := fhirpath.Decode(resource)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%T isn't a resource", resource)
+	}
+	return object, nil
+}
+
+func resourceType(v map[string]interface{}) string {
+	t, _ := v["resourceType"].(string)
+	return t
+}
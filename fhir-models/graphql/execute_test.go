@@ -0,0 +1,265 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+const testPatient = `{
+	"resourceType": "Patient",
+	"id": "p1",
+	"active": true,
+	"gender": "male",
+	"birthDate": "1974-12-25",
+	"name": [
+		{"use": "official", "family": "Chalmers", "given": ["Peter", "James"]},
+		{"use": "usual", "given": ["Jim"]},
+		{"use": "maiden", "family": "Windsor"}
+	],
+	"contained": [{"resourceType": "Organization", "id": "o1", "name": "Acme"}],
+	"managingOrganization": {"reference": "#o1"},
+	"generalPractitioner": [
+		{"reference": "Practitioner/pr1/_history/2"},
+		{"reference": "Organization/missing"},
+		{"reference": "http://example.org/fhir/Practitioner/pr1"}
+	]
+}`
+
+// testSource holds resources by type and id and records the parameters of searches.
+type testSource struct {
+	resources map[string]interface{}
+	searches  []url.Values
+}
+
+func newTestSource(t *testing.T) *testSource {
+	patient := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(testPatient), &patient); err != nil {
+		t.Fatal(err)
+	}
+	id := "pr1"
+	family := "Welby"
+	return &testSource{resources: map[string]interface{}{
+		"Patient/p1":       patient,
+		"Practitioner/pr1": fhir.Practitioner{Id: &id, Name: []fhir.HumanName{{Family: &family}}},
+	}}
+}
+
+func (s *testSource) Read(_ context.Context, resourceType, id string) (interface{}, error) {
+	if resourceType == "Patient" && id == "fail" {
+		return nil, errors.New("read failed")
+	}
+	return s.resources[resourceType+"/"+id], nil
+}
+
+func (s *testSource) Search(_ context.Context, resourceType string, params url.Values) ([]interface{}, error) {
+	s.searches = append(s.searches, params)
+	var result []interface{}
+	for key, resource := range s.resources {
+		if strings.HasPrefix(key, resourceType+"/") {
+			result = append(result, resource)
+		}
+	}
+	return result, nil
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		want      string
+	}{
+		{"read", `{ Patient(id: "p1") { id active birthDate } }`, nil,
+			`{"Patient":{"id":"p1","active":true,"birthDate":"1974-12-25"}}`},
+		{"missing resource", `{ Patient(id: "p2") { id } }`, nil, `{"Patient":null}`},
+		{"nested lists", `{ Patient(id: "p1") { name { family given } } }`, nil,
+			`{"Patient":{"name":[{"family":"Chalmers","given":["Peter","James"]},{"family":null,"given":["Jim"]},{"family":"Windsor","given":null}]}}`},
+		{"enum", `{ Patient(id: "p1") { gender } }`, nil, `{"Patient":{"gender":"male"}}`},
+		{"alias and typename", `{ p: Patient(id: "p1") { __typename key: id } }`, nil, `{"p":{"__typename":"Patient","key":"p1"}}`},
+		{"fragments", `query { Patient(id: "p1") { ...names ... on Patient { id } } } fragment names on Patient { name(use: usual) { given } }`, nil,
+			`{"Patient":{"name":[{"given":["Jim"]}],"id":"p1"}}`},
+		{"filter by property", `{ Patient(id: "p1") { name(use: official) { family } } }`, nil,
+			`{"Patient":{"name":[{"family":"Chalmers"}]}}`},
+		{"offset and count", `{ Patient(id: "p1") { name(_offset: 1, _count: 1) { use } } }`, nil,
+			`{"Patient":{"name":[{"use":"usual"}]}}`},
+		{"fhirpath", `{ Patient(id: "p1") { name(fhirpath: "family.exists()") { family } } }`, nil,
+			`{"Patient":{"name":[{"family":"Chalmers"},{"family":"Windsor"}]}}`},
+		{"_filter", `{ Patient(id: "p1") { name(_filter: "family eq Windsor or use eq usual") { use } } }`, nil,
+			`{"Patient":{"name":[{"use":"usual"},{"use":"maiden"}]}}`},
+		{"variables and directives", `query ($id: ID!, $skip: Boolean = false) { Patient(id: $id) { id @skip(if: $skip) active @include(if: $skip) } }`,
+			map[string]interface{}{"id": "p1"}, `{"Patient":{"id":"p1"}}`},
+		{"contained reference", `{ Patient(id: "p1") { managingOrganization { resource { ... on Organization { name } } } } }`, nil,
+			`{"Patient":{"managingOrganization":{"resource":{"name":"Acme"}}}}`},
+		{"versioned and absolute references", `{ Patient(id: "p1") { generalPractitioner(_count: 3) { resource(optional: true) { ... on Practitioner { id name { family } } } } } }`, nil,
+			`{"Patient":{"generalPractitioner":[{"resource":{"id":"pr1","name":[{"family":"Welby"}]}},{"resource":null},{"resource":{"id":"pr1","name":[{"family":"Welby"}]}}]}}`},
+		{"reference of other type", `{ Patient(id: "p1") { managingOrganization { resource(type: "Practitioner") { __typename } } } }`, nil,
+			`{"Patient":{"managingOrganization":{"resource":null}}}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			executor, err := NewExecutor(newTestSource(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+			response := executor.Execute(context.Background(), Request{Query: test.query, Variables: test.variables})
+			if len(response.Errors) > 0 {
+				t.Fatalf("errors %+v", response.Errors[0])
+			}
+			if string(response.Data) != test.want {
+				t.Errorf("got\n%s\nwant\n%s", response.Data, test.want)
+			}
+		})
+	}
+}
+
+func TestExecuteSearch(t *testing.T) {
+	source := newTestSource(t)
+	executor, err := NewExecutor(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := executor.Execute(context.Background(), Request{Query: `{ PatientList(_id: "p1", _count: 10, name: ["Chalmers", "Peter"], general_practitioner: "Practitioner/pr1") { id } }`})
+	if len(response.Errors) > 0 {
+		t.Fatalf("errors %+v", response.Errors[0])
+	}
+	if want := `{"PatientList":[{"id":"p1"}]}`; string(response.Data) != want {
+		t.Errorf("got %s, want %s", response.Data, want)
+	}
+	want := url.Values{"_id": {"p1"}, "_count": {"10"}, "name": {"Chalmers", "Peter"},
+		"general-practitioner": {"Practitioner/pr1"}}
+	if len(source.searches) != 1 || !reflect.DeepEqual(source.searches[0], want) {
+		t.Errorf("search parameters = %v, want %v", source.searches, want)
+	}
+}
+
+func TestExecuteOn(t *testing.T) {
+	executor, err := NewExecutor(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := "1"
+	active := true
+	response := executor.ExecuteOn(context.Background(), fhir.Patient{Id: &id, Active: &active}, Request{Query: `{ id active }`})
+	if len(response.Errors) > 0 || string(response.Data) != `{"id":"1","active":true}` {
+		t.Errorf("got %s, %+v", response.Data, response.Errors)
+	}
+
+	response = executor.ExecuteOn(context.Background(), map[string]interface{}{"resourceType": "Foo"}, Request{Query: `{ id }`})
+	if len(response.Errors) != 1 || !strings.Contains(response.Errors[0].Message, "unknown resource type `Foo`") {
+		t.Errorf("errors %+v", response.Errors)
+	}
+}
+
+func TestExecuteErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   Request
+		data      string
+		message   string
+		locations []Location
+		path      []interface{}
+	}{
+		{"syntax error", Request{Query: "{ Patient(id: \"p1\") {\n  id"}, "null", "", []Location{{Line: 2, Column: 5}}, nil},
+		{"unknown field", Request{Query: "{\n  Patient(id: \"p1\") {\n    id\n    foo\n  }\n}"}, "null",
+			"cannot query field `foo` on type `Patient`", []Location{{Line: 4, Column: 5}}, nil},
+		{"unknown argument", Request{Query: `{ Patient(id: "p1", foo: 1) { id } }`}, "null", "unknown argument `foo` of field `Patient`", nil, nil},
+		{"missing selection", Request{Query: `{ Patient(id: "p1") { name } }`}, "null", "fields have to be selected on `name` of type `HumanName`", nil, nil},
+		{"selection on scalar", Request{Query: `{ Patient(id: "p1") { id { value } } }`}, "null", "fields can't be selected on `id`", nil, nil},
+		{"mutation", Request{Query: `mutation { Patient(id: "p1") { id } }`}, "null", "mutation operations aren't supported", nil, nil},
+		{"unknown operation", Request{Query: `query a { Patient(id: "p1") { id } }`, OperationName: "b"}, "null", "unknown operation `b`", nil, nil},
+		{"missing variable", Request{Query: `query ($id: ID!) { Patient(id: $id) { id } }`}, "null", "missing value of variable $id", nil, nil},
+		{"unknown fragment", Request{Query: `{ Patient(id: "p1") { ...foo } }`}, "null", "unknown fragment `foo`", nil, nil},
+		{"failing read", Request{Query: `{ Patient(id: "fail") { id } }`}, `{"Patient":null}`, "read failed", nil, []interface{}{"Patient"}},
+		{"unresolvable reference", Request{Query: `{ Patient(id: "p1") { generalPractitioner(_offset: 1, _count: 1) { resource { __typename } } } }`},
+			`{"Patient":{"generalPractitioner":[{"resource":null}]}}`, "can't resolve reference `Organization/missing`", nil,
+			[]interface{}{"Patient", "generalPractitioner", 0.0, "resource"}},
+		{"invalid filter", Request{Query: `{ Patient(id: "p1") { name(_filter: "family eq") { use } } }`}, `{"Patient":{"name":null}}`, "", nil,
+			[]interface{}{"Patient", "name"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			executor, err := NewExecutor(newTestSource(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+			response := executor.Execute(context.Background(), test.request)
+			if string(response.Data) != test.data {
+				t.Errorf("data = %s, want %s", response.Data, test.data)
+			}
+			if len(response.Errors) != 1 {
+				t.Fatalf("errors = %+v", response.Errors)
+			}
+			// compare the error as it is serialized
+			b, err := json.Marshal(response.Errors[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got Error
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(got.Message, test.message) {
+				t.Errorf("message = %s, want %s", got.Message, test.message)
+			}
+			if test.locations != nil && !reflect.DeepEqual(got.Locations, test.locations) {
+				t.Errorf("locations = %v, want %v", got.Locations, test.locations)
+			}
+			if test.path != nil && !reflect.DeepEqual(got.Path, test.path) {
+				t.Errorf("path = %v, want %v", got.Path, test.path)
+			}
+		})
+	}
+}
+
+// TestSchemaSnippet checks parts of the generated schema the executor relies on.
+func TestSchemaSnippet(t *testing.T) {
+	for _, want := range []string{`
+type Reference {
+  id: String
+  extension(_offset: Int, _count: Int, fhirpath: String, _filter: String): [Extension]
+  reference: String
+  _reference: Element
+  type: String
+  _type: Element
+  identifier: Identifier
+  display: String
+  _display: Element
+  resource(type: String, optional: Boolean): Resource
+}
+`, `
+enum AdministrativeGender {
+  male
+  female
+  other
+  unknown
+}
+`, `
+  Patient(id: ID!): Patient
+  PatientList(_filter: String, _count: Int, _offset: Int, _sort: String, _id: String, _lastUpdated: String, _profile: String, _security: String, _source: String, _tag: String, active: String, address: String, address_city: String, address_country: String, address_postalcode: String, address_state: String, birthdate: String, deceased: String, email: String, family: String, gender: String, general_practitioner: String, given: String, identifier: String, link: String, name: String, organization: String, phone: String, telecom: String): [Patient]
+`} {
+		if !strings.Contains(fhir.GraphQLSchema, want) {
+			t.Errorf("schema lacks%s", want)
+		}
+	}
+}
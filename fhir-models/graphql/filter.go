@@ -0,0 +1,274 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhirpath"
+)
+
+// filterExpression is a parsed _filter argument following the syntax of http://hl7.org/fhir/search_filter.html,
+// where parameter names are paths of elements, e.g. given sw "Jo" and (use eq official or use eq usual)
+type filterExpression interface {
+	matches(item interface{}) (bool, error)
+}
+
+type filterLogic struct {
+	and         bool
+	left, right filterExpression
+}
+
+type filterNot struct {
+	expression filterExpression
+}
+
+type filterComparison struct {
+	path     *fhirpath.Expression
+	operator string
+	value    string
+}
+
+var filterOperators = map[string]bool{"eq": true, "ne": true, "co": true, "sw": true, "ew": true, "gt": true,
+	"lt": true, "ge": true, "le": true, "pr": true}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func parseFilter(filter string) (filterExpression, error) {
+	tokens, err := lexFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	expression, err := p.or()
+	if err != nil {
+		return nil, fmt.Errorf("invalid _filter `%s`: %v", filter, err)
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("invalid _filter `%s`: unexpected `%s`", filter, p.tokens[p.pos])
+	}
+	return expression, nil
+}
+
+// lexFilter splits a filter into parentheses, quoted strings, which keep their quotes, and words.
+func lexFilter(filter string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(filter); {
+		switch c := filter[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(filter) && filter[j] != '"'; j++ {
+				if filter[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(filter) {
+				return nil, fmt.Errorf("unterminated string in _filter `%s`", filter)
+			}
+			tokens = append(tokens, filter[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for j < len(filter) && strings.IndexByte(" \t\n\r()", filter[j]) < 0 {
+				j++
+			}
+			tokens = append(tokens, filter[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end")
+	}
+	p.pos++
+	return p.tokens[p.pos-1], nil
+}
+
+func (p *filterParser) or() (filterExpression, error) {
+	left, err := p.and()
+	for err == nil && p.peek() == "or" {
+		p.pos++
+		var right filterExpression
+		if right, err = p.and(); err == nil {
+			left = filterLogic{left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+func (p *filterParser) and() (filterExpression, error) {
+	left, err := p.factor()
+	for err == nil && p.peek() == "and" {
+		p.pos++
+		var right filterExpression
+		if right, err = p.factor(); err == nil {
+			left = filterLogic{and: true, left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+func (p *filterParser) factor() (filterExpression, error) {
+	not := false
+	if p.peek() == "not" && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1] == "(" {
+		not = true
+		p.pos++
+	}
+	if p.peek() == "(" {
+		p.pos++
+		expression, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if t, err := p.next(); err != nil || t != ")" {
+			return nil, fmt.Errorf("missing `)`")
+		}
+		if not {
+			return filterNot{expression}, nil
+		}
+		return expression, nil
+	}
+
+	path, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := fhirpath.Compile(path)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	operator = strings.ToLower(operator)
+	if !filterOperators[operator] {
+		return nil, fmt.Errorf("unsupported operator `%s`", operator)
+	}
+	v, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(v, `"`) {
+		v = strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(v[1 : len(v)-1])
+	}
+	return filterComparison{path: compiled, operator: operator, value: v}, nil
+}
+
+func (f filterLogic) matches(item interface{}) (bool, error) {
+	left, err := f.left.matches(item)
+	if err != nil || left != f.and {
+		return left, err
+	}
+	return f.right.matches(item)
+}
+
+func (f filterNot) matches(item interface{}) (bool, error) {
+	result, err := f.expression.matches(item)
+	return !result, err
+}
+
+// matches compares the values the path evaluates to with the value of the filter. Except for ne, which requires all
+// values to differ, one matching value suffices.
+func (f filterComparison) matches(item interface{}) (bool, error) {
+	values, err := f.path.Evaluate(item)
+	if err != nil {
+		return false, err
+	}
+	switch f.operator {
+	case "pr":
+		return (len(values) > 0) == (f.value == "true"), nil
+	case "ne":
+		for _, v := range values {
+			if c, ok := compareFilterValue(v, f.value); ok && c == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	for _, v := range values {
+		s, ok := filterString(v)
+		if !ok {
+			continue
+		}
+		lower, value := strings.ToLower(s), strings.ToLower(f.value)
+		switch f.operator {
+		case "co":
+			ok = strings.Contains(lower, value)
+		case "sw":
+			ok = strings.HasPrefix(lower, value)
+		case "ew":
+			ok = strings.HasSuffix(lower, value)
+		default:
+			c, comparable := compareFilterValue(v, f.value)
+			ok = comparable && (f.operator == "eq" && c == 0 || f.operator == "gt" && c > 0 ||
+				f.operator == "lt" && c < 0 || f.operator == "ge" && c >= 0 || f.operator == "le" && c <= 0)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func filterString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return fmt.Sprint(v), true
+	}
+	return "", false
+}
+
+// compareFilterValue compares numbers numerically and all other primitive values as strings, which orders dates
+// and times correctly as long as they have the same precision.
+func compareFilterValue(v interface{}, value string) (int, bool) {
+	if n, ok := v.(json.Number); ok {
+		a, okA := new(big.Rat).SetString(n.String())
+		b, okB := new(big.Rat).SetString(value)
+		if okA && okB {
+			return a.Cmp(b), true
+		}
+	}
+	s, ok := filterString(v)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(s, value), true
+}
@@ -0,0 +1,112 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graphql executes FHIR GraphQL queries as described in http://hl7.org/fhir/graphql.html on resources.
+//
+// Queries are checked and resolved against the schema fhir.GraphQLSchema, which the generator derives from the same
+// definitions as the resources. Resources are navigated in their generic JSON representation like in the fhirpath
+// package, so the Go types returned by a Source don't matter as long as they marshal to FHIR JSON.
+//
+// Besides the selection of fields, queries support the following features of FHIR GraphQL:
+//
+//   - reading single resources with Patient(id: "1") and searching with PatientList(name: "Smith"), whose arguments
+//     are passed to the Source as search parameters, with underscores in names like general_practitioner turned back
+//     into the dashes of general-practitioner
+//   - the arguments _offset, _count, fhirpath and _filter on list fields as well as filtering lists by the value of a
+//     property of their items, e.g. name(use: official)
+//   - following references with resource(type: Patient) on Reference, including contained resources
+//
+// Mutations, connections and introspection aren't supported.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Source provides the resources queries are resolved against. Resources are either generated resources or their
+// generic JSON representation.
+type Source interface {
+	// Read returns the resource with the given type and id or nil if it doesn't exist.
+	Read(ctx context.Context, resourceType, id string) (interface{}, error)
+	// Search returns the resources of the given type matching the search parameters.
+	Search(ctx context.Context, resourceType string, params url.Values) ([]interface{}, error)
+}
+
+// Request is a GraphQL request as posted to $graphql.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Response is the result of a request. Data holds the selected fields as JSON object in the order of the query.
+type Response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []*Error        `json:"errors,omitempty"`
+}
+
+// Error is a GraphQL error with the location in the query and the path of the field it refers to.
+type Error struct {
+	Message   string        `json:"message"`
+	Locations []Location    `json:"locations,omitempty"`
+	Path      []interface{} `json:"path,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Location is a position in a query.
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Executor executes queries against the resources of a Source.
+type Executor struct {
+	schema *schema
+	source Source
+}
+
+// NewExecutor returns an executor of queries on the given source, which may be nil if only ExecuteOn is used.
+func NewExecutor(source Source) (*Executor, error) {
+	s, err := loadFHIRSchema()
+	if err != nil {
+		return nil, err
+	}
+	return &Executor{schema: s, source: source}, nil
+}
+
+// Execute executes the request on the system level, where the fields of the query are resources and lists of
+// resources, e.g. { Patient(id: "1") { name { family } } }.
+func (e *Executor) Execute(ctx context.Context, request Request) *Response {
+	return e.execute(ctx, request, e.schema.query, nil)
+}
+
+// ExecuteOn executes the request on the instance level, where the fields of the query are the elements of the given
+// resource, e.g. { name { family } } on a Patient.
+func (e *Executor) ExecuteOn(ctx context.Context, resource interface{}, request Request) *Response {
+	value, err := decode(resource)
+	if err != nil {
+		return &Response{Data: json.RawMessage("null"), Errors: []*Error{{Message: err.Error()}}}
+	}
+	resourceType := resourceType(value)
+	if e.schema.types[resourceType] == nil {
+		return &Response{Data: json.RawMessage("null"), Errors: []*Error{{Message: fmt.Sprintf("unknown resource type `%s`", resourceType)}}}
+	}
+	return e.execute(ctx, request, resourceType, value)
+}
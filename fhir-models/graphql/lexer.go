@@ -0,0 +1,228 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of document"
+	}
+	return fmt.Sprintf("`%s`", t.text)
+}
+
+// lex splits a GraphQL document into tokens. Commas, white space and comments are insignificant and skipped.
+func lex(source string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case strings.HasPrefix(source[i:], "\uFEFF"):
+			i += len("\uFEFF")
+		case c == '#':
+			for i < len(source) && source[i] != '\n' && source[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(source[i:], "..."):
+			tokens = append(tokens, token{tokenPunctuator, "...", i})
+			i += 3
+		case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+			tokens = append(tokens, token{tokenPunctuator, string(c), i})
+			i++
+		case c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z':
+			start := i
+			for i < len(source) && isNameByte(source[i]) {
+				i++
+			}
+			tokens = append(tokens, token{tokenName, source[start:i], start})
+		case c == '-' || c >= '0' && c <= '9':
+			t, err := lexNumber(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+			i += len(t.text)
+		case c == '"':
+			s, n, err := lexString(source[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%v at %d", err, i)
+			}
+			tokens = append(tokens, token{tokenString, s, i})
+			i += n
+		default:
+			r, _ := utf8.DecodeRuneInString(source[i:])
+			return nil, fmt.Errorf("unexpected character %q at %d", r, i)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(source)}), nil
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
+
+func lexNumber(source string, start int) (token, error) {
+	i := start
+	digits := func() int {
+		n := 0
+		for i < len(source) && source[i] >= '0' && source[i] <= '9' {
+			i++
+			n++
+		}
+		return n
+	}
+	if source[i] == '-' {
+		i++
+	}
+	if n := digits(); n == 0 || n > 1 && source[i-n] == '0' {
+		return token{}, fmt.Errorf("invalid number at %d", start)
+	}
+	kind := tokenInt
+	if i < len(source) && source[i] == '.' {
+		i++
+		kind = tokenFloat
+		if digits() == 0 {
+			return token{}, fmt.Errorf("invalid number at %d", start)
+		}
+	}
+	if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+		i++
+		kind = tokenFloat
+		if i < len(source) && (source[i] == '+' || source[i] == '-') {
+			i++
+		}
+		if digits() == 0 {
+			return token{}, fmt.Errorf("invalid number at %d", start)
+		}
+	}
+	if i < len(source) && (isNameByte(source[i]) || source[i] == '.') {
+		return token{}, fmt.Errorf("invalid number at %d", start)
+	}
+	return token{kind, source[start:i], start}, nil
+}
+
+// lexString returns the value of the string or block string at the start of s and its length in s.
+func lexString(s string) (string, int, error) {
+	if strings.HasPrefix(s, `"""`) {
+		var b strings.Builder
+		for i := 3; i < len(s); {
+			switch {
+			case strings.HasPrefix(s[i:], `\"""`):
+				b.WriteString(`"""`)
+				i += 4
+			case strings.HasPrefix(s[i:], `"""`):
+				return blockStringValue(b.String()), i + 3, nil
+			default:
+				b.WriteByte(s[i])
+				i++
+			}
+		}
+		return "", 0, fmt.Errorf("unterminated block string")
+	}
+	var b strings.Builder
+	for i := 1; i < len(s); {
+		switch c := s[i]; c {
+		case '"':
+			return b.String(), i + 1, nil
+		case '\n', '\r':
+			return "", 0, fmt.Errorf("unterminated string")
+		case '\\':
+			if i+1 >= len(s) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+			switch e := s[i+1]; e {
+			case '"', '\\', '/':
+				b.WriteByte(e)
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'u':
+				if i+6 > len(s) {
+					return "", 0, fmt.Errorf("invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(s[i+2:i+6], 16, 32)
+				if err != nil {
+					return "", 0, fmt.Errorf("invalid unicode escape")
+				}
+				b.WriteRune(rune(r))
+				i += 4
+			default:
+				return "", 0, fmt.Errorf("invalid escape sequence \\%c", e)
+			}
+			i += 2
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+// blockStringValue removes the common indentation and the leading and trailing blank lines of a block string.
+func blockStringValue(raw string) string {
+	lines := strings.Split(strings.ReplaceAll(strings.ReplaceAll(raw, "\r\n", "\n"), "\r", "\n"), "\n")
+	indent := -1
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed != "" && (indent < 0 || len(line)-len(trimmed) < indent) {
+			indent = len(line) - len(trimmed)
+		}
+	}
+	for i := 1; i < len(lines) && indent > 0; i++ {
+		if len(lines[i]) >= indent {
+			lines[i] = lines[i][indent:]
+		} else {
+			lines[i] = ""
+		}
+	}
+	for len(lines) > 0 && strings.TrimLeft(lines[0], " \t") == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimLeft(lines[len(lines)-1], " \t") == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
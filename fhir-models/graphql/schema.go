@@ -0,0 +1,256 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// schema is the part of a GraphQL schema needed to execute queries
+type schema struct {
+	query string
+	types map[string]*typeDefinition
+}
+
+type typeDefinition struct {
+	kind          string // scalar, type, interface, union, enum or input
+	name          string
+	fields        map[string]*fieldDefinition
+	possibleTypes map[string]bool // members of unions
+	values        map[string]bool // values of enums
+}
+
+type fieldDefinition struct {
+	name      string
+	arguments map[string]typeRef
+	typ       typeRef
+}
+
+var builtinScalars = []string{"String", "Int", "Float", "Boolean", "ID"}
+
+var fhirSchema struct {
+	once   sync.Once
+	schema *schema
+	err    error
+}
+
+// loadFHIRSchema returns the schema of fhir.GraphQLSchema, which is parsed only once.
+func loadFHIRSchema() (*schema, error) {
+	fhirSchema.once.Do(func() {
+		fhirSchema.schema, fhirSchema.err = parseSchema(fhir.GraphQLSchema)
+	})
+	return fhirSchema.schema, fhirSchema.err
+}
+
+// parseSchema parses a schema in the GraphQL schema definition language. Descriptions and directives are skipped.
+func parseSchema(source string) (*schema, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	s := &schema{query: "Query", types: make(map[string]*typeDefinition)}
+	for _, name := range builtinScalars {
+		s.types[name] = &typeDefinition{kind: "scalar", name: name}
+	}
+	for p.peek().kind != tokenEOF {
+		p.description()
+		keyword, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if keyword == "schema" {
+			if err := p.schemaDefinition(s); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if s.types[name] != nil {
+			return nil, fmt.Errorf("duplicate type `%s`", name)
+		}
+		t := &typeDefinition{kind: keyword, name: name}
+		s.types[name] = t
+		switch keyword {
+		case "scalar":
+			_, err = p.directives()
+		case "type", "interface", "input":
+			err = p.fields(t)
+		case "union":
+			err = p.unionMembers(t)
+		case "enum":
+			err = p.enumValues(t)
+		default:
+			return nil, fmt.Errorf("unsupported definition `%s`", keyword)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if s.types[s.query] == nil {
+		return nil, fmt.Errorf("missing query type `%s`", s.query)
+	}
+	return s, nil
+}
+
+// description skips the optional description of a definition.
+func (p *parser) description() {
+	if p.peek().kind == tokenString {
+		p.next()
+	}
+}
+
+func (p *parser) schemaDefinition(s *schema) error {
+	if _, err := p.directives(); err != nil {
+		return err
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.skip("}") {
+		operation, err := p.name()
+		if err != nil {
+			return err
+		}
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		name, err := p.name()
+		if err != nil {
+			return err
+		}
+		if operation == "query" {
+			s.query = name
+		}
+	}
+	return nil
+}
+
+func (p *parser) fields(t *typeDefinition) error {
+	t.fields = make(map[string]*fieldDefinition)
+	if p.skip("implements") {
+		p.skip("&")
+		for {
+			if _, err := p.name(); err != nil {
+				return err
+			}
+			if !p.skip("&") {
+				break
+			}
+		}
+	}
+	if _, err := p.directives(); err != nil {
+		return err
+	}
+	if !p.skip("{") {
+		return nil
+	}
+	for !p.skip("}") {
+		p.description()
+		name, err := p.name()
+		if err != nil {
+			return err
+		}
+		f := &fieldDefinition{name: name, arguments: make(map[string]typeRef)}
+		if p.skip("(") {
+			for !p.skip(")") {
+				p.description()
+				argument, err := p.name()
+				if err != nil {
+					return err
+				}
+				if err := p.expect(":"); err != nil {
+					return err
+				}
+				if f.arguments[argument], err = p.typeRef(); err != nil {
+					return err
+				}
+				if p.skip("=") {
+					if _, err := p.value(true); err != nil {
+						return err
+					}
+				}
+				if _, err := p.directives(); err != nil {
+					return err
+				}
+			}
+		}
+		if err := p.expect(":"); err != nil {
+			return err
+		}
+		if f.typ, err = p.typeRef(); err != nil {
+			return err
+		}
+		if p.skip("=") {
+			if _, err := p.value(true); err != nil {
+				return err
+			}
+		}
+		if _, err := p.directives(); err != nil {
+			return err
+		}
+		t.fields[name] = f
+	}
+	return nil
+}
+
+func (p *parser) unionMembers(t *typeDefinition) error {
+	t.possibleTypes = make(map[string]bool)
+	if _, err := p.directives(); err != nil {
+		return err
+	}
+	if err := p.expect("="); err != nil {
+		return err
+	}
+	p.skip("|")
+	for {
+		name, err := p.name()
+		if err != nil {
+			return err
+		}
+		t.possibleTypes[name] = true
+		if !p.skip("|") {
+			return nil
+		}
+	}
+}
+
+func (p *parser) enumValues(t *typeDefinition) error {
+	t.values = make(map[string]bool)
+	if _, err := p.directives(); err != nil {
+		return err
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.skip("}") {
+		p.description()
+		name, err := p.name()
+		if err != nil {
+			return err
+		}
+		if _, err := p.directives(); err != nil {
+			return err
+		}
+		t.values[name] = true
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integrity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// ReadStructureDefinitions reads the StructureDefinitions of a Bundle like profiles-resources.json or
+// profiles-types.json of the specification.
+func ReadStructureDefinitions(r io.Reader) ([]fhir.StructureDefinition, error) {
+	bs, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := fhir.UnmarshalBundle(bs)
+	if err != nil {
+		return nil, err
+	}
+	var definitions []fhir.StructureDefinition
+	for i, entry := range bundle.Entry {
+		var header struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if err := json.Unmarshal(entry.Resource, &header); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		if header.ResourceType != "StructureDefinition" {
+			continue
+		}
+		definition, err := fhir.UnmarshalStructureDefinition(entry.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+// element is what the checker knows about an element of a resource or data type.
+type element struct {
+	// known is false for elements without definition
+	known bool
+	// path is the path of the definitions of the children, like Observation.component or Identifier
+	path string
+	// reference is true for elements of type Reference
+	reference bool
+	// targets are the allowed types of referenced resources or nil for any type
+	targets []string
+}
+
+// element returns the child element with the JSON property name of the element with the path. Properties of choice
+// elements like valueReference are looked up as value[x] restricted to the type.
+func (c *Checker) element(path, name string) element {
+	if d, ok := c.elements[path+"."+name]; ok {
+		return c.define(d, d.Type)
+	}
+	for i := 1; i < len(name); i++ {
+		if name[i] < 'A' || name[i] > 'Z' {
+			continue
+		}
+		d, ok := c.elements[path+"."+name[:i]+"[x]"]
+		if !ok {
+			continue
+		}
+		for _, t := range d.Type {
+			if strings.ToUpper(t.Code[:1])+t.Code[1:] == name[i:] {
+				return c.define(d, []fhir.ElementDefinitionType{t})
+			}
+		}
+	}
+	return element{}
+}
+
+// define returns the element of the definition with the types. Children of elements with a single complex data type
+// are defined by the definition of that type and children of elements with a content reference by the element
+// referenced.
+func (c *Checker) define(d fhir.ElementDefinition, types []fhir.ElementDefinitionType) element {
+	e := element{known: true, path: d.Path}
+	if d.ContentReference != nil {
+		_, e.path, _ = strings.Cut(*d.ContentReference, "#")
+		return e
+	}
+	for _, t := range types {
+		if t.Code != "Reference" {
+			continue
+		}
+		e.reference = true
+		for _, profile := range t.TargetProfile {
+			target, ok := c.types[profile]
+			if !ok {
+				target = profile[strings.LastIndex(profile, "/")+1:]
+			}
+			if target == "Resource" {
+				e.targets = nil
+				break
+			}
+			e.targets = append(e.targets, target)
+		}
+	}
+	if len(types) == 1 && c.datatypes[types[0].Code] {
+		e.path = types[0].Code
+	}
+	return e
+}
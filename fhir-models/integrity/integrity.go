@@ -0,0 +1,381 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package integrity checks the referential integrity of Bundles and sets of resources before they are loaded.
+//
+// A Checker walks all references of the resources, resolves them following the full URL rules of Bundles and checks
+// the types of the resources referenced against the target profiles of the elements in the StructureDefinitions of
+// the specification. It reports unresolvable, wrong-type and circular references and orphaned contained resources:
+//
+//	definitions, err := integrity.ReadStructureDefinitions(profilesResources)
+//	outcome, err := integrity.NewChecker(definitions...).CheckBundle(ctx, transaction)
+package integrity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/bundle"
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/reference"
+)
+
+// Checker checks references against StructureDefinitions.
+type Checker struct {
+	// Resolver resolves references to resources outside of the ones checked, like reference.Server. Without it,
+	// such references are unresolvable.
+	Resolver reference.Resolver
+
+	// elements are the elements of the base definitions of resources and data types by path
+	elements map[string]fhir.ElementDefinition
+	// types are the types of the definitions by URL
+	types map[string]string
+	// datatypes are the complex data types with definition
+	datatypes map[string]bool
+}
+
+// NewChecker returns a checker of references against the StructureDefinitions, like the ones of
+// profiles-resources.json and profiles-types.json read with ReadStructureDefinitions. Types of references of
+// resources and data types without definition aren't checked. Profiles only map their URL to their type.
+func NewChecker(definitions ...fhir.StructureDefinition) *Checker {
+	c := &Checker{
+		elements:  make(map[string]fhir.ElementDefinition),
+		types:     make(map[string]string),
+		datatypes: make(map[string]bool),
+	}
+	for _, definition := range definitions {
+		c.types[definition.Url] = definition.Type
+		if definition.Derivation != nil && *definition.Derivation == fhir.TypeDerivationRuleConstraint {
+			continue
+		}
+		var elements []fhir.ElementDefinition
+		if definition.Snapshot != nil {
+			elements = definition.Snapshot.Element
+		} else if definition.Differential != nil {
+			elements = definition.Differential.Element
+		}
+		for _, element := range elements {
+			c.elements[element.Path] = element
+		}
+		if definition.Kind == fhir.StructureDefinitionKindComplexType && !definition.Abstract &&
+			definition.Type != "Element" && definition.Type != "BackboneElement" {
+			c.datatypes[definition.Type] = true
+		}
+	}
+	return c
+}
+
+// CheckBundle checks the references of the resources of the Bundle. Expressions of issues start with
+// Bundle.entry[i].resource. Conditional references of transactions aren't checked. An error is only returned if the
+// Bundle can't be decoded or the resolver fails.
+func (c *Checker) CheckBundle(ctx context.Context, b fhir.Bundle) (fhir.OperationOutcome, error) {
+	prefixes := make([]string, len(b.Entry))
+	for i := range b.Entry {
+		prefixes[i] = fmt.Sprintf("Bundle.entry[%d].resource", i)
+	}
+	return c.check(ctx, b, prefixes)
+}
+
+// CheckResources checks the references of the resources, which are pointers to generated resources like
+// *fhir.Patient. They reference each other by type and id. Expressions of issues start with the resource type.
+func (c *Checker) CheckResources(ctx context.Context, resources ...interface{}) (fhir.OperationOutcome, error) {
+	builder := bundle.NewBuilder(fhir.BundleTypeCollection)
+	prefixes := make([]string, len(resources))
+	for i, resource := range resources {
+		builder.Add(resource)
+		prefixes[i] = typeName(resource)
+	}
+	b, err := builder.Bundle()
+	if err != nil {
+		return fhir.OperationOutcome{}, err
+	}
+	return c.check(ctx, b, prefixes)
+}
+
+// check is a run of the checker over the resources of a Bundle.
+type check struct {
+	*Checker
+	reader *bundle.Reader
+	// entries are the indices of the entries of the resources
+	entries map[interface{}]int
+	labels  []string
+	edges   [][]edge
+	outcome fhir.OperationOutcome
+}
+
+// edge is a reference between the resources of two entries.
+type edge struct {
+	to         int
+	expression string
+}
+
+// found is a reference found in a resource.
+type found struct {
+	element    element
+	expression string
+	value      map[string]interface{}
+	// contained is the index of the contained resource the reference was found in or -1
+	contained int
+}
+
+func (c *Checker) check(ctx context.Context, b fhir.Bundle, prefixes []string) (fhir.OperationOutcome, error) {
+	reader, err := bundle.NewReader(b)
+	if err != nil {
+		return fhir.OperationOutcome{}, err
+	}
+	run := &check{
+		Checker: c,
+		reader:  reader,
+		entries: make(map[interface{}]int),
+		labels:  make([]string, len(b.Entry)),
+		edges:   make([][]edge, len(b.Entry)),
+	}
+	for i, entry := range reader.Entries() {
+		if entry.Resource != nil {
+			run.entries[entry.Resource] = i
+		}
+		run.labels[i] = label(entry, i)
+	}
+	for i, entry := range reader.Entries() {
+		if entry.Resource == nil {
+			continue
+		}
+		if err := run.resource(ctx, i, entry, prefixes[i]); err != nil {
+			return fhir.OperationOutcome{}, fmt.Errorf("%s: %w", run.labels[i], err)
+		}
+	}
+	run.cycles()
+	if len(run.outcome.Issue) == 0 {
+		run.add(fhir.IssueSeverityInformation, fhir.IssueTypeInformational, "", "no referential integrity problems found")
+	}
+	return run.outcome, nil
+}
+
+// resource checks the references of the resource of the entry with the index and its contained resources.
+func (r *check) resource(ctx context.Context, i int, entry bundle.Entry, prefix string) error {
+	var value map[string]interface{}
+	if err := json.Unmarshal(entry.Entry.Resource, &value); err != nil {
+		return err
+	}
+	var refs []found
+	r.walk(value, element{}, prefix, -1, &refs)
+
+	fullUrl := ""
+	if entry.Entry.FullUrl != nil {
+		fullUrl = *entry.Entry.FullUrl
+	}
+	resolvers := []reference.Resolver{reference.Contained(entry.Resource), reference.InBundle(r.reader, fullUrl)}
+	if r.Resolver != nil {
+		resolvers = append(resolvers, r.Resolver)
+	}
+	resolver := reference.Chain(resolvers...)
+	for _, ref := range refs {
+		if err := r.reference(ctx, i, resolver, ref); err != nil {
+			return err
+		}
+	}
+
+	contained, _ := value["contained"].([]interface{})
+	for k, item := range contained {
+		resource, _ := item.(map[string]interface{})
+		id, _ := resource["id"].(string)
+		if !referenced(refs, k, id) {
+			resourceType, _ := resource["resourceType"].(string)
+			r.add(fhir.IssueSeverityError, fhir.IssueTypeInvariant, fmt.Sprintf("%s.contained[%d]", prefix, k),
+				"%s: contained %s %s is neither referenced by the resource nor refers to it", r.labels[i], resourceType, id)
+		}
+	}
+	return nil
+}
+
+// walk collects the references of the value of the element at the expression. Objects with a resourceType are
+// walked by the definition of their type.
+func (r *check) walk(value interface{}, e element, expression string, contained int, refs *[]found) {
+	switch v := value.(type) {
+	case []interface{}:
+		for i, item := range v {
+			r.walk(item, e, fmt.Sprintf("%s[%d]", expression, i), contained, refs)
+		}
+	case map[string]interface{}:
+		path := e.path
+		if resourceType, ok := v["resourceType"].(string); ok {
+			path = resourceType
+		}
+		if s, ok := v["reference"].(string); ok && s != "" && (e.reference || !e.known) {
+			*refs = append(*refs, found{element: e, expression: expression, value: v, contained: contained})
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			switch {
+			case name == "resourceType":
+			case name == "contained":
+				items, _ := v[name].([]interface{})
+				for k, item := range items {
+					r.walk(item, element{}, fmt.Sprintf("%s.contained[%d]", expression, k), k, refs)
+				}
+			case strings.HasPrefix(name, "_"):
+				r.walk(v[name], element{path: "Element"}, expression+"."+name, contained, refs)
+			default:
+				r.walk(v[name], r.element(path, name), expression+"."+name, contained, refs)
+			}
+		}
+	}
+}
+
+// reference resolves the reference found in the resource of the entry with the index and checks its type.
+func (r *check) reference(ctx context.Context, i int, resolver reference.Resolver, ref found) error {
+	s := ref.value["reference"].(string)
+	if strings.Contains(s, "?") && !strings.Contains(s, "://") {
+		return nil
+	}
+	parsed, err := reference.Parse(s)
+	if err != nil {
+		r.add(fhir.IssueSeverityError, fhir.IssueTypeInvalid, ref.expression, "%s: %v", r.labels[i], err)
+		return nil
+	}
+	if parsed.Kind == reference.KindCanonical {
+		return nil
+	}
+
+	resourceType := parsed.ResourceType
+	resource, err := resolver.Resolve(ctx, parsed)
+	switch {
+	case errors.Is(err, reference.ErrUnresolved):
+		r.add(fhir.IssueSeverityError, fhir.IssueTypeNotFound, ref.expression, "%s: reference %s can't be resolved",
+			r.labels[i], s)
+	case err != nil:
+		return err
+	default:
+		resourceType = typeName(resource)
+		if j, ok := r.entries[resource]; ok && parsed.Kind != reference.KindContained {
+			r.edges[i] = append(r.edges[i], edge{to: j, expression: ref.expression})
+		}
+	}
+	if resourceType == "" {
+		return nil
+	}
+	if t, ok := ref.value["type"].(string); ok && t != resourceType {
+		r.add(fhir.IssueSeverityError, fhir.IssueTypeInvalid, ref.expression, "%s: reference %s refers to %s but has type %s",
+			r.labels[i], s, resourceType, t)
+	}
+	if ref.element.targets != nil && !contains(ref.element.targets, resourceType) {
+		r.add(fhir.IssueSeverityError, fhir.IssueTypeInvalid, ref.expression, "%s: reference %s refers to %s but the element allows %s",
+			r.labels[i], s, resourceType, strings.Join(ref.element.targets, ", "))
+	}
+	return nil
+}
+
+// cycles reports each cycle of references between the resources found by a depth-first search once.
+func (r *check) cycles() {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make([]int, len(r.edges))
+	var stack []int
+	var visit func(i int)
+	visit = func(i int) {
+		states[i] = visiting
+		stack = append(stack, i)
+		for _, e := range r.edges[i] {
+			switch states[e.to] {
+			case unvisited:
+				visit(e.to)
+			case visiting:
+				start := len(stack) - 1
+				for stack[start] != e.to {
+					start--
+				}
+				labels := make([]string, 0, len(stack)-start+1)
+				for _, j := range stack[start:] {
+					labels = append(labels, r.labels[j])
+				}
+				labels = append(labels, r.labels[e.to])
+				r.add(fhir.IssueSeverityWarning, fhir.IssueTypeBusinessRule, e.expression, "circular reference %s",
+					strings.Join(labels, " -> "))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		states[i] = visited
+	}
+	for i := range r.edges {
+		if states[i] == unvisited {
+			visit(i)
+		}
+	}
+}
+
+func (r *check) add(severity fhir.IssueSeverity, code fhir.IssueType, expression, format string, args ...interface{}) {
+	diagnostics := fmt.Sprintf(format, args...)
+	issue := fhir.OperationOutcomeIssue{Severity: severity, Code: code, Diagnostics: &diagnostics}
+	if expression != "" {
+		issue.Expression = []string{expression}
+	}
+	r.outcome.Issue = append(r.outcome.Issue, issue)
+}
+
+// referenced returns true if the contained resource with the index and id is referenced from outside of it or
+// refers to its container.
+func referenced(refs []found, index int, id string) bool {
+	for _, ref := range refs {
+		s := ref.value["reference"].(string)
+		if ref.contained == index && s == "#" || ref.contained != index && id != "" && s == "#"+id {
+			return true
+		}
+	}
+	return false
+}
+
+// label returns the type and id of the resource of the entry, its full URL or its index.
+func label(entry bundle.Entry, i int) string {
+	if entry.Resource != nil {
+		var header struct {
+			Id string `json:"id"`
+		}
+		if json.Unmarshal(entry.Entry.Resource, &header) == nil && header.Id != "" {
+			return typeName(entry.Resource) + "/" + header.Id
+		}
+	}
+	if entry.Entry.FullUrl != nil {
+		return *entry.Entry.FullUrl
+	}
+	return fmt.Sprintf("entry %d", i)
+}
+
+func typeName(resource interface{}) string {
+	t := reflect.TypeOf(resource)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
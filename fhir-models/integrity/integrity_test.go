@@ -0,0 +1,272 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integrity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/reference"
+)
+
+// testDefinitions are parts of the definitions of the specification and a profile of Patient.
+const testDefinitions = `{"resourceType":"Bundle","type":"collection","entry":[
+	{"resource":{"resourceType":"StructureDefinition","url":"http://hl7.org/fhir/StructureDefinition/Observation",
+		"name":"Observation","status":"active","kind":"resource","abstract":false,"type":"Observation","derivation":"specialization",
+		"snapshot":{"element":[
+			{"path":"Observation"},
+			{"path":"Observation.identifier","type":[{"code":"Identifier"}]},
+			{"path":"Observation.hasMember","type":[{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Observation"]}]},
+			{"path":"Observation.subject","type":[{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Patient","http://hl7.org/fhir/StructureDefinition/Group"]}]},
+			{"path":"Observation.focus","type":[{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Resource"]}]},
+			{"path":"Observation.performer","type":[{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Practitioner","http://example.org/StructureDefinition/my-patient"]}]},
+			{"path":"Observation.component","type":[{"code":"BackboneElement"}]},
+			{"path":"Observation.component.code","type":[{"code":"CodeableConcept"}]}
+		]}}},
+	{"resource":{"resourceType":"StructureDefinition","url":"http://hl7.org/fhir/StructureDefinition/MedicationRequest",
+		"name":"MedicationRequest","status":"active","kind":"resource","abstract":false,"type":"MedicationRequest","derivation":"specialization",
+		"snapshot":{"element":[
+			{"path":"MedicationRequest"},
+			{"path":"MedicationRequest.medication[x]","type":[{"code":"CodeableConcept"},{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Medication"]}]},
+			{"path":"MedicationRequest.subject","type":[{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Patient","http://hl7.org/fhir/StructureDefinition/Group"]}]}
+		]}}},
+	{"resource":{"resourceType":"StructureDefinition","url":"http://hl7.org/fhir/StructureDefinition/Patient",
+		"name":"Patient","status":"active","kind":"resource","abstract":false,"type":"Patient","derivation":"specialization",
+		"snapshot":{"element":[
+			{"path":"Patient"},
+			{"path":"Patient.managingOrganization","type":[{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Organization"]}]},
+			{"path":"Patient.link","type":[{"code":"BackboneElement"}]},
+			{"path":"Patient.link.other","type":[{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Patient","http://hl7.org/fhir/StructureDefinition/RelatedPerson"]}]}
+		]}}},
+	{"resource":{"resourceType":"StructureDefinition","url":"http://hl7.org/fhir/StructureDefinition/Identifier",
+		"name":"Identifier","status":"active","kind":"complex-type","abstract":false,"type":"Identifier","derivation":"specialization",
+		"snapshot":{"element":[
+			{"path":"Identifier"},
+			{"path":"Identifier.assigner","type":[{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Organization"]}]}
+		]}}},
+	{"resource":{"resourceType":"StructureDefinition","url":"http://example.org/StructureDefinition/my-patient",
+		"name":"MyPatient","status":"active","kind":"resource","abstract":false,"type":"Patient","derivation":"constraint",
+		"snapshot":{"element":[
+			{"path":"Patient"},
+			{"path":"Patient.managingOrganization","type":[{"code":"Reference","targetProfile":["http://hl7.org/fhir/StructureDefinition/Patient"]}]}
+		]}}},
+	{"resource":{"resourceType":"CodeSystem","url":"http://example.org/CodeSystem/x","status":"active","content":"complete"}}
+]}`
+
+func testChecker(t *testing.T) *Checker {
+	t.Helper()
+	definitions, err := ReadStructureDefinitions(strings.NewReader(testDefinitions))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(definitions) != 5 {
+		t.Fatalf("read %d definitions", len(definitions))
+	}
+	return NewChecker(definitions...)
+}
+
+// issues returns the issues of the outcome as severity, code, expression and diagnostics.
+func issues(outcome fhir.OperationOutcome) []string {
+	var issues []string
+	for _, issue := range outcome.Issue {
+		s := issue.Severity.Code() + " " + issue.Code.Code() + " " + strings.Join(issue.Expression, ",")
+		if issue.Diagnostics != nil {
+			s += ": " + *issue.Diagnostics
+		}
+		issues = append(issues, s)
+	}
+	return issues
+}
+
+func checkBundle(t *testing.T, c *Checker, entries string) []string {
+	t.Helper()
+	b, err := fhir.UnmarshalBundle([]byte(`{"resourceType":"Bundle","type":"transaction","entry":[` + entries + `]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	outcome, err := c.CheckBundle(context.Background(), b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return issues(outcome)
+}
+
+func TestCheckBundle(t *testing.T) {
+	c := testChecker(t)
+	tests := []struct {
+		name    string
+		entries string
+		issues  []string
+	}{
+		{"no problems",
+			`{"fullUrl":"urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11","resource":{"resourceType":"Patient"}},
+			{"fullUrl":"urn:uuid:c5e1c5b4-3c3e-4b8e-8f5e-1f0e4b1e2a22","resource":{"resourceType":"Observation",
+				"subject":{"reference":"urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11"}}}`,
+			[]string{"information informational : no referential integrity problems found"}},
+		{"dangling",
+			`{"fullUrl":"http://example.org/fhir/Observation/o1","resource":{"resourceType":"Observation","id":"o1",
+				"subject":{"reference":"Patient/p1"},"hasMember":[{"reference":"Observation/o2"}]}}`,
+			[]string{
+				"error not-found Bundle.entry[0].resource.hasMember[0]: Observation/o1: reference Observation/o2 can't be resolved",
+				"error not-found Bundle.entry[0].resource.subject: Observation/o1: reference Patient/p1 can't be resolved",
+			}},
+		{"dangling urn:uuid",
+			`{"fullUrl":"urn:uuid:c5e1c5b4-3c3e-4b8e-8f5e-1f0e4b1e2a22","resource":{"resourceType":"Observation",
+				"subject":{"reference":"urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11"}}}`,
+			[]string{"error not-found Bundle.entry[0].resource.subject: urn:uuid:c5e1c5b4-3c3e-4b8e-8f5e-1f0e4b1e2a22: " +
+				"reference urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11 can't be resolved"}},
+		{"relative to the full URL",
+			`{"fullUrl":"http://example.org/fhir/Patient/p1","resource":{"resourceType":"Patient","id":"p1"}},
+			{"fullUrl":"http://other.org/fhir/Patient/p1","resource":{"resourceType":"Patient","id":"p1"}},
+			{"fullUrl":"http://example.org/fhir/Observation/o1","resource":{"resourceType":"Observation","id":"o1",
+				"subject":{"reference":"Patient/p1"},"performer":[{"reference":"http://other.org/fhir/Patient/p1"}]}},
+			{"fullUrl":"http://other.org/fhir/Observation/o2","resource":{"resourceType":"Observation","id":"o2",
+				"subject":{"reference":"Patient/p2"}}}`,
+			[]string{"error not-found Bundle.entry[3].resource.subject: Observation/o2: reference Patient/p2 can't be resolved"}},
+		{"wrong type",
+			`{"fullUrl":"http://example.org/fhir/Organization/org1","resource":{"resourceType":"Organization","id":"org1"}},
+			{"fullUrl":"http://example.org/fhir/Patient/p1","resource":{"resourceType":"Patient","id":"p1",
+				"managingOrganization":{"reference":"Patient/p1"},"link":[{"other":{"reference":"Organization/org1"}}]}},
+			{"fullUrl":"http://example.org/fhir/Observation/o1","resource":{"resourceType":"Observation","id":"o1",
+				"identifier":[{"assigner":{"reference":"Patient/p1"}}],"subject":{"reference":"Organization/org1"},
+				"focus":[{"reference":"Organization/org1"}],"performer":[{"reference":"Organization/org1"}]}},
+			{"fullUrl":"http://example.org/fhir/MedicationRequest/m1","resource":{"resourceType":"MedicationRequest","id":"m1",
+				"medicationReference":{"reference":"Patient/p1"},"subject":{"reference":"Patient/p1","type":"Group"}}}`,
+			[]string{
+				"error invalid Bundle.entry[1].resource.link[0].other: Patient/p1: reference Organization/org1 refers to Organization but the element allows Patient, RelatedPerson",
+				"error invalid Bundle.entry[1].resource.managingOrganization: Patient/p1: reference Patient/p1 refers to Patient but the element allows Organization",
+				"error invalid Bundle.entry[2].resource.identifier[0].assigner: Observation/o1: reference Patient/p1 refers to Patient but the element allows Organization",
+				"error invalid Bundle.entry[2].resource.performer[0]: Observation/o1: reference Organization/org1 refers to Organization but the element allows Practitioner, Patient",
+				"error invalid Bundle.entry[2].resource.subject: Observation/o1: reference Organization/org1 refers to Organization but the element allows Patient, Group",
+				"error invalid Bundle.entry[3].resource.medicationReference: MedicationRequest/m1: reference Patient/p1 refers to Patient but the element allows Medication",
+				"error invalid Bundle.entry[3].resource.subject: MedicationRequest/m1: reference Patient/p1 refers to Patient but has type Group",
+				"warning business-rule Bundle.entry[1].resource.managingOrganization: circular reference Patient/p1 -> Patient/p1",
+			}},
+		{"type of unresolved references",
+			`{"resource":{"resourceType":"Observation","id":"o1","subject":{"reference":"Organization/org1"}}}`,
+			[]string{
+				"error not-found Bundle.entry[0].resource.subject: Observation/o1: reference Organization/org1 can't be resolved",
+				"error invalid Bundle.entry[0].resource.subject: Observation/o1: reference Organization/org1 refers to Organization but the element allows Patient, Group",
+			}},
+		{"contained",
+			`{"resource":{"resourceType":"Observation","id":"o1","subject":{"reference":"#p1"},"focus":[{"reference":"#p9"}],
+				"contained":[
+					{"resourceType":"Patient","id":"p1"},
+					{"resourceType":"Organization","id":"org1"},
+					{"resourceType":"Observation","id":"o2","hasMember":[{"reference":"#"}]},
+					{"resourceType":"Patient","id":"p2","managingOrganization":{"reference":"#org1"}}
+				]}}`,
+			[]string{
+				"error not-found Bundle.entry[0].resource.focus[0]: Observation/o1: reference #p9 can't be resolved",
+				"error invariant Bundle.entry[0].resource.contained[3]: Observation/o1: contained Patient p2 is neither referenced by the resource nor refers to it",
+			}},
+		{"circular",
+			`{"fullUrl":"http://example.org/fhir/Observation/o1","resource":{"resourceType":"Observation","id":"o1",
+				"hasMember":[{"reference":"Observation/o2"}]}},
+			{"fullUrl":"http://example.org/fhir/Observation/o2","resource":{"resourceType":"Observation","id":"o2",
+				"hasMember":[{"reference":"Observation/o3"}]}},
+			{"fullUrl":"http://example.org/fhir/Observation/o3","resource":{"resourceType":"Observation","id":"o3",
+				"hasMember":[{"reference":"http://example.org/fhir/Observation/o1"}]}},
+			{"fullUrl":"http://example.org/fhir/Observation/o4","resource":{"resourceType":"Observation","id":"o4",
+				"hasMember":[{"reference":"Observation/o1"}]}}`,
+			[]string{"warning business-rule Bundle.entry[2].resource.hasMember[0]: circular reference Observation/o1 -> Observation/o2 -> Observation/o3 -> Observation/o1"}},
+		{"skipped and invalid references",
+			`{"resource":{"resourceType":"Observation","id":"o1","subject":{"reference":"Patient?identifier=123"},
+				"focus":[{"reference":"http://example.org/fhir/ValueSet/x|1.0"},{"reference":"Patient/"}]}}`,
+			[]string{"error invalid Bundle.entry[0].resource.focus[1]: Observation/o1: invalid reference Patient/: expected [type]/[id] or [type]/[id]/_history/[vid]"}},
+		{"elements without definition",
+			`{"resource":{"resourceType":"Observation","id":"o1",
+				"extension":[{"url":"http://example.org/x","valueReference":{"reference":"Device/d1"}}],
+				"component":[{"code":{"text":"x"},"extension":[{"url":"http://example.org/y","valueReference":{"reference":"Device/d2"}}]}]}}`,
+			[]string{
+				"error not-found Bundle.entry[0].resource.component[0].extension[0].valueReference: Observation/o1: reference Device/d2 can't be resolved",
+				"error not-found Bundle.entry[0].resource.extension[0].valueReference: Observation/o1: reference Device/d1 can't be resolved",
+			}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := checkBundle(t, c, test.entries); !reflect.DeepEqual(got, test.issues) {
+				t.Errorf("got issues\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(test.issues, "\n"))
+			}
+		})
+	}
+}
+
+func TestCheckBundleResolver(t *testing.T) {
+	c := testChecker(t)
+	var resolved []string
+	c.Resolver = reference.ResolverFunc(func(_ context.Context, r reference.Reference) (interface{}, error) {
+		resolved = append(resolved, r.String())
+		if r.Relative(false) == "Organization/org1" {
+			return &fhir.Organization{}, nil
+		}
+		return nil, fmt.Errorf("%s: %w", r, reference.ErrUnresolved)
+	})
+	got := checkBundle(t, c, `{"fullUrl":"http://example.org/fhir/Patient/p1","resource":{"resourceType":"Patient","id":"p1",
+		"managingOrganization":{"reference":"Organization/org1"},"link":[{"other":{"reference":"Patient/p2"}},{"other":{"reference":"Patient/p1"}}]}}`)
+	want := []string{"error not-found Bundle.entry[0].resource.link[0].other: Patient/p1: reference Patient/p2 can't be resolved",
+		"warning business-rule Bundle.entry[0].resource.link[1].other: circular reference Patient/p1 -> Patient/p1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got issues\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if want := []string{"Patient/p2", "Organization/org1"}; !reflect.DeepEqual(resolved, want) {
+		t.Errorf("resolved %v, want %v", resolved, want)
+	}
+
+	failing := errors.New("failing")
+	c.Resolver = reference.ResolverFunc(func(context.Context, reference.Reference) (interface{}, error) {
+		return nil, failing
+	})
+	b, err := fhir.UnmarshalBundle([]byte(`{"resourceType":"Bundle","type":"collection","entry":[
+		{"resource":{"resourceType":"Patient","id":"p1","managingOrganization":{"reference":"Organization/org1"}}}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CheckBundle(context.Background(), b); !errors.Is(err, failing) {
+		t.Errorf("err = %v, want the error of the resolver", err)
+	}
+}
+
+func TestCheckResources(t *testing.T) {
+	stringPtr := func(s string) *string { return &s }
+	patient := &fhir.Patient{Id: stringPtr("p1"), ManagingOrganization: &fhir.Reference{Reference: stringPtr("Organization/org1")}}
+	observation := &fhir.Observation{Id: stringPtr("o1"), Status: fhir.ObservationStatusFinal,
+		Subject: &fhir.Reference{Reference: stringPtr("Patient/p1")}, HasMember: []fhir.Reference{{Reference: stringPtr("Patient/p1")}}}
+	outcome, err := testChecker(t).CheckResources(context.Background(), patient, observation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"error not-found Patient.managingOrganization: Patient/p1: reference Organization/org1 can't be resolved",
+		"error invalid Observation.hasMember[0]: Observation/o1: reference Patient/p1 refers to Patient but the element allows Observation",
+	}
+	if got := issues(outcome); !reflect.DeepEqual(got, want) {
+		t.Errorf("got issues\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestReadStructureDefinitionsErrors(t *testing.T) {
+	for _, s := range []string{`{"resourceType":"Bundle"`, `{"resourceType":"Bundle","type":"collection","entry":[
+		{"resource":{"resourceType":"StructureDefinition","kind":"unknown"}}]}`} {
+		if _, err := ReadStructureDefinitions(strings.NewReader(s)); err == nil {
+			t.Errorf("no error reading %s", s)
+		}
+	}
+}
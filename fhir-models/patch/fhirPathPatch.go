@@ -0,0 +1,392 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/fhirpath"
+)
+
+// ApplyFHIRPathPatch applies a FHIRPath Patch, a Parameters resource with one operation parameter per change as
+// described in http://hl7.org/fhir/fhirpatch.html, to the resource, which has to be a pointer to a generated
+// resource like *fhir.Patient. The resource is only modified if all operations succeed and the result is a valid
+// instance of its type. Otherwise an *Error is returned.
+func ApplyFHIRPathPatch(resource interface{}, patch fhir.Parameters) error {
+	v, err := target(resource)
+	if err != nil {
+		return err
+	}
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	document, err := decode(bs)
+	if err != nil {
+		return err
+	}
+	object := document.(map[string]interface{})
+	resourceType, _ := object["resourceType"].(string)
+	for i, parameter := range patch.Parameter {
+		if parameter.Name != "operation" {
+			return newError(fhir.IssueTypeInvalid, "", "parameter %d: unexpected parameter %s", i, parameter.Name)
+		}
+		operation, err := parseOperation(parameter)
+		if err != nil {
+			return newError(fhir.IssueTypeInvalid, "", "operation %d: %v", i, err)
+		}
+		ts := types{}
+		collectTypes(ts, object, v.Type())
+		if err := operation.apply(object, ts); err != nil {
+			return newError(fhir.IssueTypeProcessing, operation.path, "operation %d (%s): %v", i, operation.kind, err)
+		}
+	}
+	return store(v, object, resourceType)
+}
+
+type fhirPathPatchOperation struct {
+	kind        string
+	path        string
+	expression  *fhirpath.Expression
+	name        string
+	value       *fhir.ParametersParameter
+	index       *int
+	source      *int
+	destination *int
+}
+
+func parseOperation(parameter fhir.ParametersParameter) (fhirPathPatchOperation, error) {
+	var operation fhirPathPatchOperation
+	kind, ok := parameter.GetCode("type")
+	if !ok {
+		return operation, fmt.Errorf("missing type")
+	}
+	operation.kind = kind
+	if operation.path, ok = parameter.GetString("path"); !ok {
+		return operation, fmt.Errorf("missing path")
+	}
+	expression, err := fhirpath.Compile(operation.path)
+	if err != nil {
+		return operation, err
+	}
+	operation.expression = expression
+	operation.name, _ = parameter.GetString("name")
+	if value, ok := parameter.Get("value"); ok {
+		operation.value = &value
+	}
+	for name, target := range map[string]**int{"index": &operation.index, "source": &operation.source, "destination": &operation.destination} {
+		if i, ok := parameter.GetInteger(name); ok {
+			*target = &i
+		}
+	}
+
+	var required []string
+	switch kind {
+	case "add":
+		if operation.name == "" {
+			required = append(required, "name")
+		}
+		if operation.value == nil {
+			required = append(required, "value")
+		}
+	case "insert":
+		if operation.index == nil {
+			required = append(required, "index")
+		}
+		if operation.value == nil {
+			required = append(required, "value")
+		}
+	case "replace":
+		if operation.value == nil {
+			required = append(required, "value")
+		}
+	case "move":
+		if operation.source == nil {
+			required = append(required, "source")
+		}
+		if operation.destination == nil {
+			required = append(required, "destination")
+		}
+	case "delete":
+	default:
+		return operation, fmt.Errorf("unknown type %q", kind)
+	}
+	if len(required) > 0 {
+		return operation, fmt.Errorf("%s requires %s", kind, strings.Join(required, " and "))
+	}
+	return operation, nil
+}
+
+func (o fhirPathPatchOperation) apply(resource map[string]interface{}, ts types) error {
+	nodes, err := o.expression.EvaluateNodes(resource, fhirpath.Options{})
+	if err != nil {
+		return err
+	}
+	switch o.kind {
+	case "add":
+		if len(nodes) != 1 {
+			return fmt.Errorf("path matches %d elements instead of one", len(nodes))
+		}
+		container, ok := nodes[0].Value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("path doesn't point to an element with children")
+		}
+		key, t, value, err := o.fieldValue(ts.of(container), o.name)
+		if err != nil {
+			return err
+		}
+		existing, exists := container[key]
+		if key != o.name {
+			// another type of the polymorphic element may already be present
+			for k := range container {
+				if _, ok := structField(ts.of(container), k); ok && k != key && strings.HasPrefix(k, o.name) &&
+					unicode.IsUpper(rune(k[len(o.name)])) {
+					return fmt.Errorf("%s already exists", o.name)
+				}
+			}
+		}
+		if items, ok := existing.([]interface{}); ok {
+			container[key] = append(items, value)
+			return nil
+		}
+		if exists {
+			return fmt.Errorf("%s already exists", key)
+		}
+		if t != nil && t.Kind() == reflect.Slice {
+			value = []interface{}{value}
+		}
+		container[key] = value
+		return nil
+	case "insert":
+		container, key, items, err := o.list(resource, nodes, ts)
+		if err != nil {
+			return err
+		}
+		if *o.index < 0 || *o.index > len(items) {
+			return fmt.Errorf("index %d out of bounds", *o.index)
+		}
+		_, _, value, err := o.fieldValue(ts.of(container), key)
+		if err != nil {
+			return err
+		}
+		alignElements(container, key, len(items), func(elements []interface{}) []interface{} {
+			return insertItem(elements, *o.index, nil)
+		})
+		container[key] = insertItem(items, *o.index, value)
+		return nil
+	case "delete":
+		switch {
+		case len(nodes) == 0:
+			return nil
+		case len(nodes) > 1:
+			return fmt.Errorf("path matches %d elements instead of at most one", len(nodes))
+		case nodes[0].Parent == nil:
+			return fmt.Errorf("the resource itself can't be deleted")
+		}
+		node := nodes[0]
+		if items, ok := node.Parent[node.Key].([]interface{}); ok && node.Index >= 0 {
+			alignElements(node.Parent, node.Key, len(items), func(elements []interface{}) []interface{} {
+				return removeItem(elements, node.Index)
+			})
+			node.Parent[node.Key] = removeItem(items, node.Index)
+			return nil
+		}
+		delete(node.Parent, node.Key)
+		delete(node.Parent, "_"+node.Key)
+		return nil
+	case "replace":
+		if len(nodes) != 1 {
+			return fmt.Errorf("path matches %d elements instead of one", len(nodes))
+		}
+		node := nodes[0]
+		if node.Parent == nil {
+			return fmt.Errorf("the resource itself can't be replaced")
+		}
+		name := node.Key
+		if node.Type != "" && strings.HasSuffix(node.Key, strings.Title(node.Type)) {
+			// polymorphic elements may change their type
+			name = strings.TrimSuffix(node.Key, strings.Title(node.Type))
+		}
+		key, _, value, err := o.fieldValue(ts.of(node.Parent), name)
+		if err != nil {
+			return err
+		}
+		if node.Index >= 0 {
+			if key != node.Key {
+				return fmt.Errorf("the type of a list item can't be changed")
+			}
+			node.Parent[node.Key].([]interface{})[node.Index] = value
+			return nil
+		}
+		delete(node.Parent, node.Key)
+		node.Parent[key] = value
+		return nil
+	default:
+		container, key, items, err := o.list(resource, nodes, ts)
+		if err != nil {
+			return err
+		}
+		source, destination := *o.source, *o.destination
+		if source < 0 || source >= len(items) || destination < 0 || destination >= len(items) {
+			return fmt.Errorf("source %d or destination %d out of bounds", source, destination)
+		}
+		alignElements(container, key, len(items), func(elements []interface{}) []interface{} {
+			return moveItem(elements, source, destination)
+		})
+		container[key] = moveItem(items, source, destination)
+		return nil
+	}
+}
+
+func insertItem(items []interface{}, i int, item interface{}) []interface{} {
+	return append(items[:i:i], append([]interface{}{item}, items[i:]...)...)
+}
+
+func removeItem(items []interface{}, i int) []interface{} {
+	return append(items[:i:i], items[i+1:]...)
+}
+
+func moveItem(items []interface{}, source, destination int) []interface{} {
+	return insertItem(removeItem(items, source), destination, items[source])
+}
+
+// alignElements applies the change of the list of primitives with the given key to the list of their ids and
+// extensions, which is padded with null to the length n of the list before the change.
+func alignElements(container map[string]interface{}, key string, n int, change func([]interface{}) []interface{}) {
+	elements, ok := container["_"+key].([]interface{})
+	if !ok {
+		return
+	}
+	for len(elements) < n {
+		elements = append(elements, nil)
+	}
+	container["_"+key] = change(elements)
+}
+
+// list returns the list the nodes of an insert or move operation belong to. An empty list is located by evaluating
+// the path without its last element.
+func (o fhirPathPatchOperation) list(resource map[string]interface{}, nodes []fhirpath.Node, ts types) (map[string]interface{}, string, []interface{}, error) {
+	if len(nodes) > 0 {
+		first := nodes[0]
+		for _, node := range nodes {
+			if node.Parent == nil || node.Index < 0 || reflect.ValueOf(node.Parent).Pointer() != reflect.ValueOf(first.Parent).Pointer() || node.Key != first.Key {
+				return nil, "", nil, fmt.Errorf("path doesn't point to a list")
+			}
+		}
+		return first.Parent, first.Key, first.Parent[first.Key].([]interface{}), nil
+	}
+	i := strings.LastIndex(o.path, ".")
+	if i < 0 {
+		return nil, "", nil, fmt.Errorf("path doesn't point to a list")
+	}
+	parent, err := fhirpath.Compile(o.path[:i])
+	if err != nil {
+		return nil, "", nil, err
+	}
+	containers, err := parent.EvaluateNodes(resource, fhirpath.Options{})
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if len(containers) != 1 {
+		return nil, "", nil, fmt.Errorf("path doesn't point to a list")
+	}
+	container, ok := containers[0].Value.(map[string]interface{})
+	if !ok {
+		return nil, "", nil, fmt.Errorf("path doesn't point to a list")
+	}
+	key := o.path[i+1:]
+	if field, ok := structField(ts.of(container), key); !ok || field.Type.Kind() != reflect.Slice {
+		return nil, "", nil, fmt.Errorf("path doesn't point to a list")
+	}
+	return container, key, nil, nil
+}
+
+// fieldValue converts the value of the operation into the generic representation of the element name of an element
+// with the Go type t. It returns the JSON property name, which includes the type of polymorphic elements, and the
+// Go type of the property.
+func (o fhirPathPatchOperation) fieldValue(t reflect.Type, name string) (string, reflect.Type, interface{}, error) {
+	return parameterValue(*o.value, t, name)
+}
+
+func parameterValue(parameter fhir.ParametersParameter, t reflect.Type, name string) (string, reflect.Type, interface{}, error) {
+	suffix, primitive := valueField(parameter)
+	key := name
+	var fieldType reflect.Type
+	if field, ok := structField(t, name); ok {
+		fieldType = field.Type
+	} else if field, ok := structField(t, name+suffix); ok && suffix != "" {
+		key, fieldType = name+suffix, field.Type
+	} else if t != nil {
+		return "", nil, nil, fmt.Errorf("%s is not an element of %s", name, t.Name())
+	}
+
+	switch {
+	case parameter.Resource != nil:
+		value, err := decode(parameter.Resource)
+		return key, fieldType, value, err
+	case primitive.IsValid():
+		bs, err := json.Marshal(primitive.Interface())
+		if err != nil {
+			return "", nil, nil, err
+		}
+		value, err := decode(bs)
+		return key, fieldType, value, err
+	case len(parameter.Part) > 0:
+		elementType := fieldType
+		for elementType != nil && (elementType.Kind() == reflect.Ptr || elementType.Kind() == reflect.Slice) {
+			elementType = elementType.Elem()
+		}
+		if elementType != nil && elementType.Kind() != reflect.Struct {
+			return "", nil, nil, fmt.Errorf("%s can't have parts", key)
+		}
+		object := map[string]interface{}{}
+		for _, part := range parameter.Part {
+			partKey, partType, value, err := parameterValue(part, elementType, part.Name)
+			if err != nil {
+				return "", nil, nil, err
+			}
+			existing, exists := object[partKey]
+			switch {
+			case partType != nil && partType.Kind() == reflect.Slice && partType.Elem().Kind() != reflect.Uint8:
+				items, _ := existing.([]interface{})
+				object[partKey] = append(items, value)
+			case exists:
+				return "", nil, nil, fmt.Errorf("%s.%s is repeated", key, partKey)
+			default:
+				object[partKey] = value
+			}
+		}
+		return key, fieldType, object, nil
+	}
+	return "", nil, nil, fmt.Errorf("value of %s is missing", name)
+}
+
+// valueField returns the type suffix and value of the value[x] field of the parameter which is set.
+func valueField(parameter fhir.ParametersParameter) (string, reflect.Value) {
+	v := reflect.ValueOf(parameter)
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		// the ids and extensions of primitive values are no values of their own
+		if strings.HasPrefix(name, "Value") && !strings.HasSuffix(name, "Element") && !v.Field(i).IsNil() {
+			return strings.TrimPrefix(name, "Value"), v.Field(i)
+		}
+	}
+	return "", reflect.Value{}
+}
@@ -0,0 +1,304 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  *string         `json:"path"`
+	From  *string         `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// ApplyJSONPatch applies a JSON Patch document according to RFC 6902 to the resource, which has to be a pointer to
+// a generated resource like *fhir.Patient. The resource is only modified if all operations succeed and the result
+// is a valid instance of its type. Otherwise an *Error is returned.
+func ApplyJSONPatch(resource interface{}, patch []byte) error {
+	v, err := target(resource)
+	if err != nil {
+		return err
+	}
+	var operations []jsonPatchOperation
+	if err := json.Unmarshal(patch, &operations); err != nil {
+		return newError(fhir.IssueTypeInvalid, "", "invalid JSON Patch document: %v", err)
+	}
+	bs, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	document, err := decode(bs)
+	if err != nil {
+		return err
+	}
+	resourceType, _ := document.(map[string]interface{})["resourceType"].(string)
+	for i, operation := range operations {
+		document, err = applyJSONPatchOperation(document, operation)
+		if err != nil {
+			code := fhir.IssueTypeProcessing
+			if e, ok := err.(jsonPatchError); ok {
+				code = e.code
+			}
+			return newError(code, "", "operation %d (%s): %v", i, operation.Op, err)
+		}
+	}
+	return store(v, document, resourceType)
+}
+
+type jsonPatchError struct {
+	code    fhir.IssueType
+	message string
+}
+
+func (e jsonPatchError) Error() string {
+	return e.message
+}
+
+func invalidOperation(format string, args ...interface{}) error {
+	return jsonPatchError{code: fhir.IssueTypeInvalid, message: fmt.Sprintf(format, args...)}
+}
+
+// applyJSONPatchOperation applies the operation and returns the new document, which only differs from the old one
+// if the whole document was replaced.
+func applyJSONPatchOperation(document interface{}, operation jsonPatchOperation) (interface{}, error) {
+	if operation.Path == nil {
+		return nil, invalidOperation("missing path")
+	}
+	path, err := parsePointer(*operation.Path)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	switch operation.Op {
+	case "add", "replace", "test":
+		if operation.Value == nil {
+			return nil, invalidOperation("missing value")
+		}
+		if value, err = decode(operation.Value); err != nil {
+			return nil, invalidOperation("invalid value: %v", err)
+		}
+	case "move", "copy":
+		if operation.From == nil {
+			return nil, invalidOperation("missing from")
+		}
+		from, err := parsePointer(*operation.From)
+		if err != nil {
+			return nil, err
+		}
+		if operation.Op == "move" && len(path) > len(from) && *operation.Path != *operation.From &&
+			strings.HasPrefix(*operation.Path, *operation.From+"/") {
+			return nil, invalidOperation("can't move %s into one of its children", *operation.From)
+		}
+		if value, err = get(document, from); err != nil {
+			return nil, err
+		}
+		if operation.Op == "move" {
+			if document, err = remove(document, from); err != nil {
+				return nil, err
+			}
+		} else {
+			value = deepCopy(value)
+		}
+	case "remove":
+	default:
+		return nil, invalidOperation("unknown operation %q", operation.Op)
+	}
+
+	switch operation.Op {
+	case "remove":
+		return remove(document, path)
+	case "replace":
+		if _, err := get(document, path); err != nil {
+			return nil, err
+		}
+		return set(document, path, value)
+	case "test":
+		actual, err := get(document, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(actual, value) {
+			return nil, jsonPatchError{code: fhir.IssueTypeConflict, message: fmt.Sprintf("value at %s differs", *operation.Path)}
+		}
+		return document, nil
+	default:
+		return add(document, path, value)
+	}
+}
+
+// parsePointer splits a JSON Pointer according to RFC 6901 into its unescaped reference tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, invalidOperation("invalid JSON Pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func pointerString(tokens []string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteByte('/')
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
+
+func index(token string, length int, allowEnd bool) (int, error) {
+	if token == "-" && allowEnd {
+		return length, nil
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	max := length - 1
+	if allowEnd {
+		max = length
+	}
+	if i > max {
+		return 0, fmt.Errorf("array index %d out of bounds", i)
+	}
+	return i, nil
+}
+
+func get(document interface{}, path []string) (interface{}, error) {
+	value := document
+	for i, token := range path {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			child, ok := v[token]
+			if !ok {
+				return nil, fmt.Errorf("%s doesn't exist", pointerString(path[:i+1]))
+			}
+			value = child
+		case []interface{}:
+			j, err := index(token, len(v), false)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", pointerString(path[:i+1]), err)
+			}
+			value = v[j]
+		default:
+			return nil, fmt.Errorf("%s doesn't exist", pointerString(path[:i+1]))
+		}
+	}
+	return value, nil
+}
+
+// add adds the value at the path. Since items can't be inserted into a list in place, the parent of a list is
+// updated with the new list.
+func add(document interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parentPath, last := path[:len(path)-1], path[len(path)-1]
+	parent, err := get(document, parentPath)
+	if err != nil {
+		return nil, err
+	}
+	switch v := parent.(type) {
+	case map[string]interface{}:
+		v[last] = value
+		return document, nil
+	case []interface{}:
+		i, err := index(last, len(v), true)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", pointerString(path), err)
+		}
+		items := append(v[:i:i], append([]interface{}{value}, v[i:]...)...)
+		return set(document, parentPath, items)
+	}
+	return nil, fmt.Errorf("%s isn't an object or array", pointerString(parentPath))
+}
+
+// set replaces the existing value at the path.
+func set(document interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := get(document, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	switch v := parent.(type) {
+	case map[string]interface{}:
+		v[path[len(path)-1]] = value
+	case []interface{}:
+		i, err := index(path[len(path)-1], len(v), false)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", pointerString(path), err)
+		}
+		v[i] = value
+	}
+	return document, nil
+}
+
+func remove(document interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, invalidOperation("the whole resource can't be removed")
+	}
+	parentPath, last := path[:len(path)-1], path[len(path)-1]
+	parent, err := get(document, parentPath)
+	if err != nil {
+		return nil, err
+	}
+	switch v := parent.(type) {
+	case map[string]interface{}:
+		if _, ok := v[last]; !ok {
+			return nil, fmt.Errorf("%s doesn't exist", pointerString(path))
+		}
+		delete(v, last)
+		return document, nil
+	case []interface{}:
+		i, err := index(last, len(v), false)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", pointerString(path), err)
+		}
+		items := append(v[:i:i], v[i+1:]...)
+		return set(document, parentPath, items)
+	}
+	return nil, fmt.Errorf("%s doesn't exist", pointerString(path))
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			result[key] = deepCopy(child)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, child := range v {
+			result[i] = deepCopy(child)
+		}
+		return result
+	}
+	return value
+}
@@ -0,0 +1,313 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package patch applies JSON Patch and FHIRPath Patch documents to resources as described in
+// http://hl7.org/fhir/http.html#patch.
+//
+// Patches are applied to a generic representation of the resource. The result is validated against the Go type of
+// the resource before it replaces the original, so that a failing patch leaves the resource unchanged.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// Error is returned if a patch can't be applied. Its outcome lists all problems found.
+type Error struct {
+	Outcome fhir.OperationOutcome
+}
+
+func (e *Error) Error() string {
+	var messages []string
+	for _, issue := range e.Outcome.Issue {
+		message := issue.Code.Code()
+		if issue.Diagnostics != nil {
+			message = *issue.Diagnostics
+		}
+		if len(issue.Expression) > 0 {
+			message = strings.Join(issue.Expression, ", ") + ": " + message
+		}
+		messages = append(messages, message)
+	}
+	return "patch failed: " + strings.Join(messages, "; ")
+}
+
+func (e *Error) add(code fhir.IssueType, expression, format string, args ...interface{}) {
+	diagnostics := fmt.Sprintf(format, args...)
+	issue := fhir.OperationOutcomeIssue{Severity: fhir.IssueSeverityError, Code: code, Diagnostics: &diagnostics}
+	if expression != "" {
+		issue.Expression = []string{expression}
+	}
+	e.Outcome.Issue = append(e.Outcome.Issue, issue)
+}
+
+func newError(code fhir.IssueType, expression, format string, args ...interface{}) *Error {
+	e := &Error{}
+	e.add(code, expression, format, args...)
+	return e
+}
+
+// target returns the struct the resource pointer points to.
+func target(resource interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(resource)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("patch: expected a pointer to a resource but got %T", resource)
+	}
+	return v.Elem(), nil
+}
+
+func decode(bs []byte) (interface{}, error) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// store validates the patched document and stores it into the resource.
+func store(resource reflect.Value, document interface{}, resourceType string) error {
+	object, ok := document.(map[string]interface{})
+	if !ok {
+		return newError(fhir.IssueTypeStructure, resourceType, "the patched resource is not a JSON object")
+	}
+	if object["resourceType"] != resourceType {
+		return newError(fhir.IssueTypeInvalid, resourceType, "the resource type must not be changed from %s to %v",
+			resourceType, object["resourceType"])
+	}
+	prune(object)
+	e := &Error{}
+	validate(e, object, resource.Type(), resourceType)
+	if len(e.Outcome.Issue) > 0 {
+		return e
+	}
+	bs, err := json.Marshal(object)
+	if err != nil {
+		return newError(fhir.IssueTypeValue, resourceType, "%v", err)
+	}
+	result := reflect.New(resource.Type())
+	if err := json.Unmarshal(bs, result.Interface()); err != nil {
+		return newError(fhir.IssueTypeValue, resourceType, "%v", err)
+	}
+	resource.Set(result.Elem())
+	return nil
+}
+
+// prune removes empty objects, lists and list items, which FHIR doesn't allow, and returns nil if the value itself
+// is empty. The null items of primitive lists and of the lists of their ids and extensions, like given and _given,
+// stay, as the items of both lists are aligned by index.
+func prune(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			_, aligned := v["_"+key]
+			if child = pruneChild(child, aligned || strings.HasPrefix(key, "_")); child == nil {
+				delete(v, key)
+			} else {
+				v[key] = child
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case []interface{}:
+		return pruneChild(v, false)
+	}
+	return value
+}
+
+// pruneChild prunes the value of a property. Null items of aligned lists are kept unless all items are null.
+func pruneChild(value interface{}, aligned bool) interface{} {
+	v, ok := value.([]interface{})
+	if !ok {
+		return prune(value)
+	}
+	items := v[:0]
+	empty := true
+	for _, child := range v {
+		child = prune(child)
+		if child != nil || aligned {
+			items = append(items, child)
+		}
+		empty = empty && child == nil
+	}
+	if empty {
+		return nil
+	}
+	return items
+}
+
+// validate checks the structure of the generic value against the Go type t. Properties unknown to t and missing
+// required elements are reported.
+func validate(e *Error, value interface{}, t reflect.Type, path string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8:
+		items, ok := value.([]interface{})
+		if !ok {
+			e.add(fhir.IssueTypeStructure, path, "expected a list")
+			return
+		}
+		for i, item := range items {
+			// the ids and extensions of primitive values without them are null
+			if item == nil && t.Elem().Kind() == reflect.Ptr {
+				continue
+			}
+			validate(e, item, t.Elem(), fmt.Sprintf("%s[%d]", path, i))
+		}
+	case t.Kind() == reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			e.add(fhir.IssueTypeStructure, path, "expected an element with children")
+			return
+		}
+		known := map[string]bool{"resourceType": true}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, omitempty := jsonName(field)
+			known[name] = true
+			child, ok := object[name]
+			if !ok {
+				if !omitempty {
+					e.add(fhir.IssueTypeRequired, path+"."+name, "missing required element")
+				}
+				continue
+			}
+			validate(e, child, field.Type, path+"."+name)
+		}
+		var unknown []string
+		for key := range object {
+			if !known[key] {
+				unknown = append(unknown, key)
+			}
+		}
+		sort.Strings(unknown)
+		for _, key := range unknown {
+			e.add(fhir.IssueTypeStructure, path+"."+key, "unknown element")
+		}
+	default:
+		switch value.(type) {
+		case map[string]interface{}:
+			if t != reflect.TypeOf(json.RawMessage{}) {
+				e.add(fhir.IssueTypeStructure, path, "expected a primitive value")
+			}
+		case []interface{}:
+			e.add(fhir.IssueTypeStructure, path, "expected a single value")
+		}
+	}
+}
+
+func jsonName(field reflect.StructField) (string, bool) {
+	parts := strings.Split(field.Tag.Get("json"), ",")
+	return parts[0], len(parts) > 1 && parts[1] == "omitempty"
+}
+
+// structField returns the field of the struct t which has the given JSON name.
+func structField(t reflect.Type, key string) (reflect.StructField, bool) {
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if name, _ := jsonName(t.Field(i)); name == key {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// types maps the identity of every object of the document to the Go type it corresponds to.
+type types map[uintptr]reflect.Type
+
+func collectTypes(result types, value interface{}, t reflect.Type) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if t == nil || t.Kind() != reflect.Struct {
+			return
+		}
+		result[reflect.ValueOf(v).Pointer()] = t
+		for key, child := range v {
+			if field, ok := structField(t, key); ok {
+				collectTypes(result, child, field.Type)
+			}
+		}
+	case []interface{}:
+		if t == nil || t.Kind() != reflect.Slice {
+			return
+		}
+		for _, item := range v {
+			collectTypes(result, item, t.Elem())
+		}
+	}
+}
+
+func (ts types) of(object map[string]interface{}) reflect.Type {
+	if object == nil {
+		return nil
+	}
+	return ts[reflect.ValueOf(object).Pointer()]
+}
+
+// equal compares two generic values as required by the test operation of JSON Patch. Numbers are compared by value.
+func equal(a, b interface{}) bool {
+	switch x := a.(type) {
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		r, okX := new(big.Rat).SetString(string(x))
+		s, okY := new(big.Rat).SetString(string(y))
+		return okX && okY && r.Cmp(s) == 0
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, v := range x {
+			if w, ok := y[key]; !ok || !equal(v, w) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
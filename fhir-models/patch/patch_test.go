@@ -0,0 +1,312 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+const testPatient = `{
+	"resourceType": "Patient",
+	"id": "p1",
+	"active": true,
+	"name": [{"family": "Chalmers", "given": ["Peter", "James"], "_given": [null, {"id": "g1"}]}],
+	"telecom": [{"system": "phone", "value": "555"}],
+	"birthDate": "1974-12-25"
+}`
+
+func unmarshalPatient(t *testing.T, s string) *fhir.Patient {
+	t.Helper()
+	var patient fhir.Patient
+	if err := json.Unmarshal([]byte(s), &patient); err != nil {
+		t.Fatal(err)
+	}
+	return &patient
+}
+
+// canonical compacts JSON and sorts the members of objects.
+func canonical(t *testing.T, b []byte) string {
+	t.Helper()
+	value, err := decode(b)
+	if err != nil {
+		t.Fatalf("invalid JSON %s: %v", b, err)
+	}
+	bs, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(bs)
+}
+
+// patchTest is the outcome of a patch: either the resulting patient or an error containing err.
+type patchTest struct {
+	name  string
+	patch string
+	want  string
+	err   string
+}
+
+// check compares the outcome of applying a patch to the expected one. Failed patches leave the resource unchanged.
+func (test patchTest) check(t *testing.T, patient *fhir.Patient, err error) {
+	t.Helper()
+	if test.err != "" {
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Fatalf("err = %v, want an error containing %q", err, test.err)
+		}
+		if _, ok := err.(*Error); !ok {
+			t.Errorf("err = %T, want *Error", err)
+		}
+		if !patient.Equal(*unmarshalPatient(t, testPatient)) {
+			t.Error("the failed patch modified the resource")
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := json.Marshal(patient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := canonical(t, bs), canonical(t, []byte(test.want)); got != want {
+		t.Errorf("patched resource = %s, want %s", got, want)
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []patchTest{
+		{
+			name:  "add",
+			patch: `[{"op": "add", "path": "/gender", "value": "male"}]`,
+			want:  strings.Replace(testPatient, `"id": "p1",`, `"id": "p1", "gender": "male",`, 1),
+		},
+		{
+			name:  "add at the end of a list",
+			patch: `[{"op": "add", "path": "/name/0/given/-", "value": "Jim"}]`,
+			// the ids and extensions of the values are padded to their length
+			want: strings.Replace(testPatient, `"James"], "_given": [null, {"id": "g1"}]`, `"James", "Jim"], "_given": [null, {"id": "g1"}, null]`, 1),
+		},
+		{
+			name: "add into a list",
+			patch: `[{"op": "add", "path": "/name/0/given/0", "value": "Jim"},
+				{"op": "add", "path": "/name/0/_given/0", "value": null}]`,
+			want: strings.Replace(testPatient, `["Peter", "James"], "_given": [null,`, `["Jim", "Peter", "James"], "_given": [null, null,`, 1),
+		},
+		{
+			name:  "remove",
+			patch: `[{"op": "remove", "path": "/telecom/0"}]`,
+			want:  strings.Replace(testPatient, `"telecom": [{"system": "phone", "value": "555"}],`, ``, 1),
+		},
+		{
+			name:  "replace",
+			patch: `[{"op": "replace", "path": "/birthDate", "value": "1974-12-26"}]`,
+			want:  strings.Replace(testPatient, `1974-12-25`, `1974-12-26`, 1),
+		},
+		{
+			name: "move",
+			patch: `[{"op": "move", "from": "/name/0/given/1", "path": "/name/0/given/0"},
+				{"op": "move", "from": "/name/0/_given/1", "path": "/name/0/_given/0"}]`,
+			want: strings.Replace(testPatient, `["Peter", "James"], "_given": [null, {"id": "g1"}]`, `["James", "Peter"], "_given": [{"id": "g1"}, null]`, 1),
+		},
+		{
+			name:  "copy",
+			patch: `[{"op": "copy", "from": "/name/0/family", "path": "/name/0/text"}]`,
+			want:  strings.Replace(testPatient, `"family": "Chalmers",`, `"family": "Chalmers", "text": "Chalmers",`, 1),
+		},
+		{
+			name: "test",
+			patch: `[{"op": "test", "path": "/name/0/given", "value": ["Peter", "James"]},
+				{"op": "replace", "path": "/active", "value": false}]`,
+			want: strings.Replace(testPatient, `"active": true`, `"active": false`, 1),
+		},
+		{
+			name:  "extension of a primitive",
+			patch: `[{"op": "add", "path": "/_birthDate", "value": {"extension": [{"url": "http://example.org/time", "valueTime": "10:00:00"}]}}]`,
+			want:  strings.Replace(testPatient, `"birthDate": "1974-12-25"`, `"birthDate": "1974-12-25", "_birthDate": {"extension": [{"url": "http://example.org/time", "valueTime": "10:00:00"}]}`, 1),
+		},
+		{
+			name:  "failing test",
+			patch: `[{"op": "replace", "path": "/active", "value": false}, {"op": "test", "path": "/birthDate", "value": "2000-01-01"}]`,
+			err:   "operation 1 (test): value at /birthDate differs",
+		},
+		{
+			name:  "escaped pointer",
+			patch: `[{"op": "add", "path": "/a~1b~0c", "value": 1}]`,
+			err:   "Patient.a/b~c: unknown element",
+		},
+		{
+			name:  "index out of bounds",
+			patch: `[{"op": "add", "path": "/name/2", "value": {"family": "X"}}]`,
+			err:   "operation 0 (add)",
+		},
+		{
+			name:  "missing path",
+			patch: `[{"op": "remove", "path": "/gender"}]`,
+			err:   "operation 0 (remove)",
+		},
+		{
+			name:  "extension of a complex element",
+			patch: `[{"op": "add", "path": "/_name", "value": [{"id": "n1"}]}]`,
+			err:   "Patient._name: unknown element",
+		},
+		{
+			name:  "missing required element",
+			patch: `[{"op": "add", "path": "/link", "value": [{"type": "seealso"}]}]`,
+			err:   "Patient.link[0].other: missing required element",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patient := unmarshalPatient(t, testPatient)
+			test.check(t, patient, ApplyJSONPatch(patient, []byte(test.patch)))
+		})
+	}
+}
+
+func TestParsePointer(t *testing.T) {
+	tokens, err := parsePointer("/a~1b/~01/-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a/b", "~1", "-"}; !reflect.DeepEqual(tokens, want) {
+		t.Errorf("tokens = %q, want %q", tokens, want)
+	}
+	if pointer := pointerString(tokens); pointer != "/a~1b/~01/-" {
+		t.Errorf("pointer = %s", pointer)
+	}
+	if _, err := parsePointer("a"); err == nil {
+		t.Error("expected an error for a pointer without leading slash")
+	}
+}
+
+// operations returns a FHIRPath Patch with the given operation parameters in JSON.
+func operations(t *testing.T, parameters string) fhir.Parameters {
+	t.Helper()
+	var patch fhir.Parameters
+	s := `{"resourceType": "Parameters", "parameter": [` + parameters + `]}`
+	if err := json.NewDecoder(bytes.NewReader([]byte(s))).Decode(&patch); err != nil {
+		t.Fatal(err)
+	}
+	return patch
+}
+
+func TestApplyFHIRPathPatch(t *testing.T) {
+	tests := []patchTest{
+		{
+			name: "add",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "add"}, {"name": "path", "valueString": "Patient"},
+				{"name": "name", "valueString": "gender"}, {"name": "value", "valueCode": "male"}]}`,
+			want: strings.Replace(testPatient, `"id": "p1",`, `"id": "p1", "gender": "male",`, 1),
+		},
+		{
+			name: "add to a list",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "add"}, {"name": "path", "valueString": "Patient"},
+				{"name": "name", "valueString": "telecom"}, {"name": "value", "part": [
+					{"name": "system", "valueCode": "email"}, {"name": "value", "valueString": "p@example.org"}
+				]}]}`,
+			want: strings.Replace(testPatient, `"value": "555"}`, `"value": "555"}, {"system": "email", "value": "p@example.org"}`, 1),
+		},
+		{
+			name: "add a typed value of a polymorphic element",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "add"}, {"name": "path", "valueString": "Patient"},
+				{"name": "name", "valueString": "multipleBirth"}, {"name": "value", "valueInteger": 2}]}`,
+			want: strings.Replace(testPatient, `"id": "p1",`, `"id": "p1", "multipleBirthInteger": 2,`, 1),
+		},
+		{
+			name: "insert",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "insert"}, {"name": "path", "valueString": "Patient.name[0].given"},
+				{"name": "index", "valueInteger": 0}, {"name": "value", "valueString": "Jim"}]}`,
+			want: strings.Replace(testPatient, `["Peter", "James"], "_given": [null,`, `["Jim", "Peter", "James"], "_given": [null, null,`, 1),
+		},
+		{
+			name: "delete",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "delete"},
+				{"name": "path", "valueString": "Patient.name[0].given[0]"}]}`,
+			want: strings.Replace(testPatient, `["Peter", "James"], "_given": [null, {"id": "g1"}]`, `["James"], "_given": [{"id": "g1"}]`, 1),
+		},
+		{
+			name: "delete the extended value of a list",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "delete"},
+				{"name": "path", "valueString": "Patient.name[0].given[1]"}]}`,
+			want: strings.Replace(testPatient, `["Peter", "James"], "_given": [null, {"id": "g1"}]`, `["Peter"]`, 1),
+		},
+		{
+			name: "delete a missing element",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "delete"},
+				{"name": "path", "valueString": "Patient.gender"}]}`,
+			want: testPatient,
+		},
+		{
+			name: "replace",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "replace"}, {"name": "path", "valueString": "Patient.birthDate"},
+				{"name": "value", "valueDate": "1974-12-26"}]}`,
+			want: strings.Replace(testPatient, `1974-12-25`, `1974-12-26`, 1),
+		},
+		{
+			name: "replace with a boolean",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "replace"}, {"name": "path", "valueString": "Patient.active"},
+				{"name": "value", "valueBoolean": false}]}`,
+			want: strings.Replace(testPatient, `"active": true`, `"active": false`, 1),
+		},
+		{
+			name: "move",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "move"}, {"name": "path", "valueString": "Patient.name[0].given"},
+				{"name": "source", "valueInteger": 1}, {"name": "destination", "valueInteger": 0}]}`,
+			want: strings.Replace(testPatient, `["Peter", "James"], "_given": [null, {"id": "g1"}]`, `["James", "Peter"], "_given": [{"id": "g1"}, null]`, 1),
+		},
+		{
+			name: "add an existing element",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "add"}, {"name": "path", "valueString": "Patient"},
+				{"name": "name", "valueString": "birthDate"}, {"name": "value", "valueDate": "1974-12-26"}]}`,
+			err: "birthDate already exists",
+		},
+		{
+			name: "add an unknown element",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "add"}, {"name": "path", "valueString": "Patient"},
+				{"name": "name", "valueString": "color"}, {"name": "value", "valueString": "blue"}]}`,
+			err: "color is not an element of Patient",
+		},
+		{
+			name: "insert out of bounds",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "insert"}, {"name": "path", "valueString": "Patient.name[0].given"},
+				{"name": "index", "valueInteger": 3}, {"name": "value", "valueString": "Jim"}]}`,
+			err: "index 3 out of bounds",
+		},
+		{
+			name: "delete several elements",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "delete"},
+				{"name": "path", "valueString": "Patient.name.given"}]}`,
+			err: "path matches 2 elements",
+		},
+		{
+			name: "missing value",
+			patch: `{"name": "operation", "part": [{"name": "type", "valueCode": "replace"},
+				{"name": "path", "valueString": "Patient.active"}]}`,
+			err: "replace requires value",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patient := unmarshalPatient(t, testPatient)
+			test.check(t, patient, ApplyFHIRPathPatch(patient, operations(t, test.patch)))
+		})
+	}
+}
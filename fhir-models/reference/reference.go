@@ -0,0 +1,204 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package reference parses references (http://hl7.org/fhir/references.html) and resolves them to resources.
+//
+// Parse splits a reference into its kind, base URL, resource type, id, version and fragment. Resolvers look the
+// resources up in contained resources, a Bundle or on a server and can be chained:
+//
+//	resolver := reference.Chain(reference.Contained(observation), reference.Server(c))
+//	subject, err := reference.Resolve(ctx, resolver, *observation.Subject)
+package reference
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// Kind is the kind of a reference.
+type Kind int
+
+const (
+	// KindRelative references like Patient/123 are relative to the base URL of the server or Bundle entry
+	KindRelative Kind = iota
+	// KindAbsolute references like http://example.org/fhir/Patient/123 include the base URL
+	KindAbsolute
+	// KindContained references like #p1 refer to contained resources or, with # alone, to the container
+	KindContained
+	// KindURN references like urn:uuid:... refer to entries of a Bundle
+	KindURN
+	// KindCanonical references like http://example.org/fhir/ValueSet/x|1.0 refer to canonical resources
+	KindCanonical
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindRelative:
+		return "relative"
+	case KindAbsolute:
+		return "absolute"
+	case KindContained:
+		return "contained"
+	case KindURN:
+		return "urn"
+	case KindCanonical:
+		return "canonical"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Reference is a parsed reference.
+type Reference struct {
+	Kind Kind
+	// BaseURL is the base URL of absolute references, like http://example.org/fhir
+	BaseURL string
+	// ResourceType and Id are empty for URNs and canonicals and for absolute references not ending in type and id
+	ResourceType string
+	Id           string
+	// Version is the version id of references ending in /_history/[vid] and the version of canonicals
+	Version string
+	// URL is the URN, the URL of canonicals without version or the complete URL of absolute references
+	URL string
+	// Fragment is the part after #, which is the id of the resource of contained references
+	Fragment string
+}
+
+var (
+	resourceTypePattern = regexp.MustCompile(`^[A-Z][A-Za-z]+$`)
+	idPattern           = regexp.MustCompile(`^[A-Za-z0-9\-.]{1,64}$`)
+)
+
+// Parse parses a reference. References with a version separated by | are parsed as canonicals.
+func Parse(reference string) (Reference, error) {
+	switch {
+	case reference == "":
+		return Reference{}, fmt.Errorf("empty reference")
+	case strings.HasPrefix(reference, "#"):
+		return Reference{Kind: KindContained, Fragment: reference[1:]}, nil
+	case strings.HasPrefix(reference, "urn:uuid:") || strings.HasPrefix(reference, "urn:oid:"):
+		return Reference{Kind: KindURN, URL: reference}, nil
+	}
+	reference, fragment, _ := strings.Cut(reference, "#")
+	if canonical, version, ok := strings.Cut(reference, "|"); ok {
+		return Reference{Kind: KindCanonical, URL: canonical, Version: version, Fragment: fragment}, nil
+	}
+
+	u, err := url.Parse(reference)
+	if err != nil {
+		return Reference{}, fmt.Errorf("invalid reference %s: %v", reference, err)
+	}
+	if !u.IsAbs() {
+		if u.RawQuery != "" {
+			return Reference{}, fmt.Errorf("invalid reference %s: conditional references have to be resolved", reference)
+		}
+		r, ok := restful(strings.Split(u.Path, "/"))
+		if !ok {
+			return Reference{}, fmt.Errorf("invalid reference %s: expected [type]/[id] or [type]/[id]/_history/[vid]", reference)
+		}
+		r.Fragment = fragment
+		return r, nil
+	}
+
+	segments := strings.Split(strings.TrimSuffix(u.Path, "/"), "/")
+	for _, n := range []int{4, 2} {
+		if len(segments) < n {
+			continue
+		}
+		if r, ok := restful(segments[len(segments)-n:]); ok {
+			u.Path = strings.Join(segments[:len(segments)-n], "/")
+			u.RawQuery, u.Fragment = "", ""
+			r.Kind = KindAbsolute
+			r.BaseURL = u.String()
+			r.URL = reference
+			r.Fragment = fragment
+			return r, nil
+		}
+	}
+	return Reference{Kind: KindAbsolute, URL: reference, Fragment: fragment}, nil
+}
+
+// restful returns the relative reference of the segments [type, id] or [type, id, "_history", vid].
+func restful(segments []string) (Reference, bool) {
+	if len(segments) != 2 && (len(segments) != 4 || segments[2] != "_history" || !idPattern.MatchString(segments[3])) {
+		return Reference{}, false
+	}
+	if !resourceTypePattern.MatchString(segments[0]) || !idPattern.MatchString(segments[1]) {
+		return Reference{}, false
+	}
+	r := Reference{Kind: KindRelative, ResourceType: segments[0], Id: segments[1]}
+	if len(segments) == 4 {
+		r.Version = segments[3]
+	}
+	return r, true
+}
+
+// ParseReference parses the reference of a Reference element, whose type has to match the type of the reference.
+func ParseReference(reference fhir.Reference) (Reference, error) {
+	if reference.Reference == nil {
+		return Reference{}, fmt.Errorf("reference without reference")
+	}
+	r, err := Parse(*reference.Reference)
+	if err != nil {
+		return Reference{}, err
+	}
+	if reference.Type != nil && r.ResourceType != "" && *reference.Type != r.ResourceType {
+		return Reference{}, fmt.Errorf("reference %s has type %s", *reference.Reference, *reference.Type)
+	}
+	return r, nil
+}
+
+// Relative returns the reference as [type]/[id] or, if versioned is true and it has a version, as
+// [type]/[id]/_history/[vid]. It returns an empty string for references without type and id.
+func (r Reference) Relative(versioned bool) string {
+	if r.ResourceType == "" || r.Id == "" {
+		return ""
+	}
+	relative := r.ResourceType + "/" + r.Id
+	if versioned && r.Version != "" {
+		relative += "/_history/" + r.Version
+	}
+	return relative
+}
+
+// String returns the reference as it is written.
+func (r Reference) String() string {
+	var s string
+	switch r.Kind {
+	case KindContained:
+		return "#" + r.Fragment
+	case KindURN:
+		return r.URL
+	case KindCanonical:
+		s = r.URL
+		if r.Version != "" {
+			s += "|" + r.Version
+		}
+	case KindAbsolute:
+		if r.BaseURL == "" {
+			s = r.URL
+			break
+		}
+		s = r.BaseURL + "/" + r.Relative(true)
+	default:
+		s = r.Relative(true)
+	}
+	if r.Fragment != "" {
+		s += "#" + r.Fragment
+	}
+	return s
+}
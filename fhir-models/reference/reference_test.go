@@ -0,0 +1,126 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reference
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      Reference
+	}{
+		{"Patient/123", Reference{Kind: KindRelative, ResourceType: "Patient", Id: "123"}},
+		{"Patient/123/_history/2", Reference{Kind: KindRelative, ResourceType: "Patient", Id: "123", Version: "2"}},
+		{"Patient/123#p1", Reference{Kind: KindRelative, ResourceType: "Patient", Id: "123", Fragment: "p1"}},
+		{"http://example.org/fhir/Patient/123", Reference{Kind: KindAbsolute, BaseURL: "http://example.org/fhir",
+			ResourceType: "Patient", Id: "123", URL: "http://example.org/fhir/Patient/123"}},
+		{"http://example.org/fhir/Patient/123/_history/2", Reference{Kind: KindAbsolute, BaseURL: "http://example.org/fhir",
+			ResourceType: "Patient", Id: "123", Version: "2", URL: "http://example.org/fhir/Patient/123/_history/2"}},
+		{"http://example.org/Patient/123", Reference{Kind: KindAbsolute, BaseURL: "http://example.org",
+			ResourceType: "Patient", Id: "123", URL: "http://example.org/Patient/123"}},
+		{"http://example.org/fhir/metadata", Reference{Kind: KindAbsolute, URL: "http://example.org/fhir/metadata"}},
+		{"#p1", Reference{Kind: KindContained, Fragment: "p1"}},
+		{"#", Reference{Kind: KindContained}},
+		{"urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11", Reference{Kind: KindURN, URL: "urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11"}},
+		{"urn:oid:1.2.3.4", Reference{Kind: KindURN, URL: "urn:oid:1.2.3.4"}},
+		{"http://example.org/fhir/ValueSet/x|1.0", Reference{Kind: KindCanonical, URL: "http://example.org/fhir/ValueSet/x", Version: "1.0"}},
+		{"http://example.org/fhir/ValueSet/x|1.0#vs1", Reference{Kind: KindCanonical, URL: "http://example.org/fhir/ValueSet/x",
+			Version: "1.0", Fragment: "vs1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.reference, func(t *testing.T) {
+			r, err := Parse(test.reference)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(r, test.want) {
+				t.Errorf("got %+v, want %+v", r, test.want)
+			}
+			if s := r.String(); s != test.reference {
+				t.Errorf("String() = %s", s)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		reference string
+		err       string
+	}{
+		{"", "empty reference"},
+		{"Patient?identifier=123", "conditional references have to be resolved"},
+		{"Patient", "expected [type]/[id]"},
+		{"patient/123", "expected [type]/[id]"},
+		{"Patient/123/_history", "expected [type]/[id]"},
+		{"Patient/123/versions/2", "expected [type]/[id]"},
+		{"Patient/" + strings.Repeat("1", 65), "expected [type]/[id]"},
+		{"Patient/%zz", "invalid reference"},
+	}
+	for _, test := range tests {
+		t.Run(test.reference, func(t *testing.T) {
+			_, err := Parse(test.reference)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("err = %v, want %s", err, test.err)
+			}
+		})
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	stringPtr := func(s string) *string { return &s }
+	r, err := ParseReference(fhir.Reference{Reference: stringPtr("Patient/123"), Type: stringPtr("Patient")})
+	if err != nil || r.Relative(false) != "Patient/123" {
+		t.Errorf("got %+v, %v", r, err)
+	}
+	r, err = ParseReference(fhir.Reference{Reference: stringPtr("urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11"), Type: stringPtr("Patient")})
+	if err != nil || r.Kind != KindURN {
+		t.Errorf("got %+v, %v", r, err)
+	}
+	if _, err := ParseReference(fhir.Reference{Reference: stringPtr("Patient/123"), Type: stringPtr("Group")}); err == nil {
+		t.Error("no error for a reference of another type")
+	}
+	if _, err := ParseReference(fhir.Reference{Display: stringPtr("John Doe")}); err == nil {
+		t.Error("no error for a reference without reference")
+	}
+}
+
+func TestRelative(t *testing.T) {
+	r := Reference{Kind: KindAbsolute, BaseURL: "http://example.org/fhir", ResourceType: "Patient", Id: "123", Version: "2"}
+	if s := r.Relative(false); s != "Patient/123" {
+		t.Errorf("Relative(false) = %s", s)
+	}
+	if s := r.Relative(true); s != "Patient/123/_history/2" {
+		t.Errorf("Relative(true) = %s", s)
+	}
+	if s := (Reference{Kind: KindURN, URL: "urn:oid:1.2.3.4"}).Relative(true); s != "" {
+		t.Errorf("Relative of a URN = %s", s)
+	}
+}
+
+func TestKindString(t *testing.T) {
+	for kind, want := range map[Kind]string{KindRelative: "relative", KindAbsolute: "absolute", KindContained: "contained",
+		KindURN: "urn", KindCanonical: "canonical", Kind(9): "Kind(9)"} {
+		if s := kind.String(); s != want {
+			t.Errorf("%d.String() = %s, want %s", int(kind), s, want)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reference
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/bundle"
+	"github.com/samply/golang-fhir-models/fhir-models/client"
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// ErrUnresolved is returned, possibly wrapped, for references a resolver can't resolve.
+var ErrUnresolved = errors.New("unresolved reference")
+
+// Resolver resolves references to pointers to generated resources like *fhir.Patient.
+type Resolver interface {
+	// Resolve returns the resource the reference refers to or an error wrapping ErrUnresolved if it doesn't know it.
+	Resolve(ctx context.Context, reference Reference) (interface{}, error)
+}
+
+// ResolverFunc is a function used as Resolver.
+type ResolverFunc func(ctx context.Context, reference Reference) (interface{}, error)
+
+// Resolve calls f.
+func (f ResolverFunc) Resolve(ctx context.Context, reference Reference) (interface{}, error) {
+	return f(ctx, reference)
+}
+
+// Resolve parses the reference and resolves it.
+func Resolve(ctx context.Context, resolver Resolver, reference fhir.Reference) (interface{}, error) {
+	r, err := ParseReference(reference)
+	if err != nil {
+		return nil, err
+	}
+	return resolver.Resolve(ctx, r)
+}
+
+// Chain returns a resolver asking the resolvers in order until one resolves the reference.
+func Chain(resolvers ...Resolver) Resolver {
+	return ResolverFunc(func(ctx context.Context, reference Reference) (interface{}, error) {
+		for _, resolver := range resolvers {
+			resource, err := resolver.Resolve(ctx, reference)
+			if !errors.Is(err, ErrUnresolved) {
+				return resource, err
+			}
+		}
+		return nil, unresolved(reference)
+	})
+}
+
+// Contained returns a resolver of contained references within the resource, which refer to its contained resources
+// or, with # alone, to the resource itself.
+func Contained(container interface{}) Resolver {
+	return ResolverFunc(func(_ context.Context, reference Reference) (interface{}, error) {
+		if reference.Kind != KindContained {
+			return nil, unresolved(reference)
+		}
+		if reference.Fragment == "" {
+			return container, nil
+		}
+		contained, err := ContainedResources(container)
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range contained {
+			if resourceID(resource) == reference.Fragment {
+				return resource, nil
+			}
+		}
+		return nil, unresolved(reference)
+	})
+}
+
+// ContainedResources returns the decoded contained resources of a resource.
+func ContainedResources(resource interface{}) ([]interface{}, error) {
+	raw, _ := field(resource, "Contained").([]json.RawMessage)
+	contained := make([]interface{}, len(raw))
+	for i, r := range raw {
+		var err error
+		if contained[i], err = fhir.DecodeResource(r); err != nil {
+			return nil, fmt.Errorf("contained resource %d: %v", i, err)
+		}
+	}
+	return contained, nil
+}
+
+// InBundle returns a resolver of references within the entry with the full URL in the Bundle read. Following the
+// rules of http://hl7.org/fhir/bundle.html#references, relative references are resolved against the base of a
+// RESTful full URL, and absolute references and URNs match the full URLs of entries. If the entry has no RESTful
+// full URL, relative references match the type and id of resources in the Bundle. Versioned references match only
+// resources with that version id.
+func InBundle(reader *bundle.Reader, fullUrl string) Resolver {
+	return ResolverFunc(func(_ context.Context, reference Reference) (interface{}, error) {
+		var entry bundle.Entry
+		var ok bool
+		switch reference.Kind {
+		case KindURN:
+			entry, ok = reader.FullUrl(reference.URL)
+		case KindAbsolute:
+			if reference.BaseURL == "" {
+				entry, ok = reader.FullUrl(reference.URL)
+			} else {
+				entry, ok = reader.FullUrl(reference.BaseURL + "/" + reference.Relative(false))
+			}
+		case KindRelative:
+			entry, ok = reader.ReferenceFrom(fullUrl, reference.Relative(false))
+		}
+		if !ok || entry.Resource == nil {
+			return nil, unresolved(reference)
+		}
+		if reference.Version != "" && reference.Kind != KindCanonical && versionID(entry.Resource) != reference.Version {
+			return nil, unresolved(reference)
+		}
+		return entry.Resource, nil
+	})
+}
+
+// Server returns a resolver reading relative references and absolute references to the server of the client from
+// that server.
+func Server(c *client.Client) Resolver {
+	return ResolverFunc(func(ctx context.Context, reference Reference) (interface{}, error) {
+		switch reference.Kind {
+		case KindRelative:
+		case KindAbsolute:
+			if reference.BaseURL == "" || strings.TrimSuffix(reference.BaseURL, "/") != c.BaseURL() {
+				return nil, unresolved(reference)
+			}
+		default:
+			return nil, unresolved(reference)
+		}
+		resource, err := fhir.DecodeResource([]byte(`{"resourceType":"` + reference.ResourceType + `"}`))
+		if err != nil {
+			return nil, fmt.Errorf("reference %s: %v", reference, err)
+		}
+		if reference.Version != "" {
+			_, err = c.VRead(ctx, reference.Id, reference.Version, resource)
+		} else {
+			_, err = c.Read(ctx, reference.Id, resource)
+		}
+		var clientError *client.Error
+		if errors.As(err, &clientError) && clientError.NotFound() {
+			return nil, fmt.Errorf("%w: %v", unresolved(reference), err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return resource, nil
+	})
+}
+
+func unresolved(reference Reference) error {
+	return fmt.Errorf("%s: %w", reference, ErrUnresolved)
+}
+
+func resourceID(resource interface{}) string {
+	id, _ := field(resource, "Id").(*string)
+	if id == nil {
+		return ""
+	}
+	return *id
+}
+
+func versionID(resource interface{}) string {
+	meta, _ := field(resource, "Meta").(*fhir.Meta)
+	if meta == nil || meta.VersionId == nil {
+		return ""
+	}
+	return *meta.VersionId
+}
+
+func field(resource interface{}, name string) interface{} {
+	v := reflect.ValueOf(resource)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	f := v.FieldByName(name)
+	if !f.IsValid() {
+		return nil
+	}
+	return f.Interface()
+}
@@ -0,0 +1,213 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/bundle"
+	"github.com/samply/golang-fhir-models/fhir-models/client"
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// testBundle has full URLs of two servers, a urn:uuid and an entry without full URL.
+const testBundle = `{"resourceType":"Bundle","type":"collection","entry":[
+	{"fullUrl":"http://example.org/fhir/Patient/p1","resource":{"resourceType":"Patient","id":"p1","meta":{"versionId":"2"}}},
+	{"fullUrl":"urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11","resource":{"resourceType":"Patient","id":"p2"}},
+	{"fullUrl":"http://other.org/fhir/Organization/o1","resource":{"resourceType":"Organization","id":"o1"}},
+	{"resource":{"resourceType":"Practitioner","id":"pr1"}}
+]}`
+
+func testReader(t *testing.T) *bundle.Reader {
+	t.Helper()
+	b, err := fhir.UnmarshalBundle([]byte(testBundle))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := bundle.NewReader(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// resolve resolves the reference and returns the type and id of the resource, or an empty string if it is
+// unresolved.
+func resolve(t *testing.T, resolver Resolver, reference string) string {
+	t.Helper()
+	r, err := Parse(reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource, err := resolver.Resolve(context.Background(), r)
+	if errors.Is(err, ErrUnresolved) {
+		return ""
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reflect.TypeOf(resource).Elem().Name() + "/" + resourceID(resource)
+}
+
+func TestContained(t *testing.T) {
+	observation, err := fhir.UnmarshalObservation([]byte(`{"resourceType":"Observation","id":"o1","status":"final",
+		"code":{"text":"x"},"subject":{"reference":"#p1"},
+		"contained":[{"resourceType":"Patient","id":"p1"},{"resourceType":"Organization","id":"org1"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := Contained(&observation)
+	tests := map[string]string{
+		"#p1":        "Patient/p1",
+		"#org1":      "Organization/org1",
+		"#":          "Observation/o1",
+		"#p2":        "",
+		"Patient/p1": "",
+	}
+	for reference, want := range tests {
+		if got := resolve(t, resolver, reference); got != want {
+			t.Errorf("%s resolved to %q, want %q", reference, got, want)
+		}
+	}
+
+	resource, err := Resolve(context.Background(), resolver, *observation.Subject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resource.(*fhir.Patient); !ok {
+		t.Errorf("subject resolved to %T", resource)
+	}
+}
+
+func TestInBundle(t *testing.T) {
+	reader := testReader(t)
+	tests := []struct {
+		fullUrl   string
+		reference string
+		want      string
+	}{
+		{"http://example.org/fhir/Observation/o1", "Patient/p1", "Patient/p1"},
+		{"http://example.org/fhir/Observation/o1", "Patient/p1/_history/2", "Patient/p1"},
+		{"http://example.org/fhir/Observation/o1", "Patient/p1/_history/1", ""},
+		{"http://example.org/fhir/Observation/o1", "http://example.org/fhir/Patient/p1", "Patient/p1"},
+		{"http://example.org/fhir/Observation/o1", "http://example.org/fhir/Patient/p1/_history/2", "Patient/p1"},
+		{"http://example.org/fhir/Observation/o1", "http://other.org/fhir/Patient/p1", ""},
+		{"http://example.org/fhir/Observation/o1", "Organization/o1", ""},
+		{"http://example.org/fhir/Observation/o1", "http://other.org/fhir/Organization/o1", "Organization/o1"},
+		{"http://example.org/fhir/Observation/o1", "urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11", "Patient/p2"},
+		{"http://example.org/fhir/Observation/o1", "Practitioner/pr1", ""},
+		{"http://other.org/fhir/Observation/o1", "Organization/o1", "Organization/o1"},
+		{"http://other.org/fhir/Observation/o1", "Patient/p1", ""},
+		{"urn:uuid:00000000-0000-4000-8000-000000000000", "Practitioner/pr1", "Practitioner/pr1"},
+		{"urn:uuid:00000000-0000-4000-8000-000000000000", "Patient/p2", "Patient/p2"},
+		{"urn:uuid:00000000-0000-4000-8000-000000000000", "Organization/o1", "Organization/o1"},
+		{"urn:uuid:00000000-0000-4000-8000-000000000000", "urn:uuid:00000000-0000-4000-8000-000000000000", ""},
+		{"", "#p1", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.fullUrl+" "+test.reference, func(t *testing.T) {
+			if got := resolve(t, InBundle(reader, test.fullUrl), test.reference); got != test.want {
+				t.Errorf("resolved to %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestServer(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		w.Header().Set("Content-Type", "application/fhir+json")
+		switch r.URL.Path {
+		case "/fhir/Patient/p1", "/fhir/Patient/p1/_history/2":
+			fmt.Fprint(w, `{"resourceType":"Patient","id":"p1","meta":{"versionId":"2"}}`)
+		case "/fhir/Patient/p2":
+			w.WriteHeader(http.StatusGone)
+		case "/fhir/Patient/p3":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	resolver := Server(client.New(server.URL+"/fhir/", server.Client()))
+
+	tests := []struct {
+		reference string
+		want      string
+		request   string // the path requested or an empty string if there was no request
+	}{
+		{"Patient/p1", "Patient/p1", "/fhir/Patient/p1"},
+		{"Patient/p1/_history/2", "Patient/p1", "/fhir/Patient/p1/_history/2"},
+		{server.URL + "/fhir/Patient/p1", "Patient/p1", "/fhir/Patient/p1"},
+		{"http://other.org/fhir/Patient/p1", "", ""},
+		{"Patient/p2", "", "/fhir/Patient/p2"},
+		{"Patient/p9", "", "/fhir/Patient/p9"},
+		{"#p1", "", ""},
+		{"urn:uuid:2f2a0b38-5b0e-4a8c-9e1c-4e0d1e3e1a11", "", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.reference, func(t *testing.T) {
+			requests = nil
+			if got := resolve(t, resolver, test.reference); got != test.want {
+				t.Errorf("resolved to %q, want %q", got, test.want)
+			}
+			if test.request == "" && len(requests) != 0 || test.request != "" && !reflect.DeepEqual(requests, []string{test.request}) {
+				t.Errorf("requests = %v, want %s", requests, test.request)
+			}
+		})
+	}
+
+	r, _ := Parse("Patient/p3")
+	if _, err := resolver.Resolve(context.Background(), r); err == nil || errors.Is(err, ErrUnresolved) {
+		t.Errorf("err = %v, want a server error", err)
+	}
+}
+
+func TestChain(t *testing.T) {
+	observation, err := fhir.UnmarshalObservation([]byte(`{"resourceType":"Observation","id":"o1","status":"final",
+		"code":{"text":"x"},"contained":[{"resourceType":"Patient","id":"p1"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := Chain(Contained(&observation), InBundle(testReader(t), "http://example.org/fhir/Observation/o1"))
+	tests := map[string]string{
+		"#p1":             "Patient/p1",
+		"Patient/p1":      "Patient/p1",
+		"Organization/o1": "",
+	}
+	for reference, want := range tests {
+		if got := resolve(t, resolver, reference); got != want {
+			t.Errorf("%s resolved to %q, want %q", reference, got, want)
+		}
+	}
+
+	failing := errors.New("failing")
+	var asked bool
+	resolver = Chain(
+		ResolverFunc(func(context.Context, Reference) (interface{}, error) { return nil, failing }),
+		ResolverFunc(func(context.Context, Reference) (interface{}, error) { asked = true; return nil, nil }),
+	)
+	r, _ := Parse("Patient/p1")
+	if _, err := resolver.Resolve(context.Background(), r); !errors.Is(err, failing) || asked {
+		t.Errorf("err = %v, asked = %v, want the error of the first resolver", err, asked)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// definitions is a subset of search-parameters.json of FHIR R4 covering the parameters of all resources and the
+// common parameters of Patient, Practitioner, Organization, Condition, DiagnosticReport, Encounter, Observation,
+// Procedure and Specimen. The bases of shared parameters are restricted to these resources. gen-resources.sh of the
+// generator replaces it with the complete file of the specification.
+//
+//go:embed search-parameters.json
+var definitions []byte
+
+var (
+	parseDefinitions sync.Once
+	parsedParams     []fhir.SearchParameter
+	parseErr         error
+)
+
+// Definitions returns a copy of the embedded SearchParameters of FHIR R4, which are parsed on the first call. They
+// only cover the parameters of all resources and the common parameters of Patient, Practitioner, Organization,
+// Condition, DiagnosticReport, Encounter, Observation, Procedure and Specimen, so that searches of other resources,
+// like MedicationRequest?status=active, are unknown. All parameters of the specification can be read from its
+// search-parameters.json with ReadDefinitions.
+func Definitions() []fhir.SearchParameter {
+	parseDefinitions.Do(func() {
+		parsedParams, parseErr = readDefinitions(definitions)
+	})
+	if parseErr != nil {
+		// The embedded file is checked by the tests of the package.
+		panic(parseErr)
+	}
+	params := make([]fhir.SearchParameter, len(parsedParams))
+	for i, param := range parsedParams {
+		params[i] = param.DeepCopy()
+	}
+	return params
+}
+
+// ReadDefinitions reads the SearchParameters of a Bundle like search-parameters.json of the specification.
+func ReadDefinitions(r io.Reader) ([]fhir.SearchParameter, error) {
+	bs, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return readDefinitions(bs)
+}
+
+func readDefinitions(bs []byte) ([]fhir.SearchParameter, error) {
+	bundle, err := fhir.UnmarshalBundle(bs)
+	if err != nil {
+		return nil, err
+	}
+	var params []fhir.SearchParameter
+	for i, entry := range bundle.Entry {
+		var header struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if err := json.Unmarshal(entry.Resource, &header); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		if header.ResourceType != "SearchParameter" {
+			continue
+		}
+		param, err := fhir.UnmarshalSearchParameter(entry.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"testing"
+)
+
+func TestDefinitions(t *testing.T) {
+	params, err := readDefinitions(definitions)
+	if err != nil {
+		t.Fatalf("embedded search-parameters.json: %v", err)
+	}
+	if len(params) == 0 {
+		t.Fatal("no embedded SearchParameters")
+	}
+	for i, param := range params {
+		if param.Url == "" || param.Code == "" || len(param.Base) == 0 {
+			t.Errorf("SearchParameter %d without url, code or base", i)
+		}
+	}
+	if _, err := NewIndex(NewParser(params...)); err != nil {
+		t.Errorf("compiling the expressions: %v", err)
+	}
+
+	first := Definitions()
+	first[0].Code = "changed"
+	first[0].Base[0] = 0
+	if second := Definitions(); second[0].Code != params[0].Code || second[0].Base[0] != params[0].Base[0] {
+		t.Errorf("Definitions returned the changed definition %s of %s", second[0].Code, second[0].Base[0].Code())
+	}
+}
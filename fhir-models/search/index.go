@@ -0,0 +1,411 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/fhirpath"
+)
+
+// Index keeps resources in memory and runs searches parsed by its parser against them. When a resource is added,
+// the expressions of the search parameters of its type extract its search values, which are matched with the
+// semantics of the parameter types. It is safe for concurrent use.
+//
+// Modifiers which need a terminology service, in, not-in, above and below of tokens, and parameters of type special
+// are not supported. Quantities are compared in the units they were recorded in, without conversion.
+type Index struct {
+	parser      *Parser
+	expressions map[string]*fhirpath.Expression
+
+	mu        sync.RWMutex
+	resources map[string]map[string]*indexed
+}
+
+// indexed is a resource with the values of its search parameters.
+type indexed struct {
+	resourceType, id string
+	resource         interface{}
+	// values by parameter code
+	values map[string][]fhirpath.Node
+}
+
+// Result is the result of a search of an Index.
+type Result struct {
+	// Matches are the matching resources as they were added, sorted and limited to the page given by the offset and
+	// count of the search
+	Matches []interface{}
+	// Included are the resources added by _include and _revinclude
+	Included []interface{}
+	// Total is the number of matching resources
+	Total int
+}
+
+// NewIndex returns an empty index of the parameters of the parser. It fails if an expression of a parameter can't be
+// compiled.
+func NewIndex(parser *Parser) (*Index, error) {
+	x := &Index{parser: parser, expressions: make(map[string]*fhirpath.Expression), resources: make(map[string]map[string]*indexed)}
+	for _, param := range parser.urls {
+		sources := make([]string, 0, len(param.Component)+1)
+		if param.Expression != nil {
+			sources = append(sources, *param.Expression)
+		}
+		for _, component := range param.Component {
+			sources = append(sources, component.Expression)
+		}
+		for _, source := range sources {
+			if _, ok := x.expressions[source]; ok {
+				continue
+			}
+			expression, err := fhirpath.Compile(source)
+			if err != nil {
+				return nil, fmt.Errorf("search parameter %s: %w", param.Url, err)
+			}
+			x.expressions[source] = expression
+		}
+	}
+	return x, nil
+}
+
+// Add adds a resource, given as generated resource or generic JSON value, replacing the one of the same type and id.
+func (x *Index) Add(resource interface{}) error {
+	value, ok := resource.(map[string]interface{})
+	if !ok {
+		decoded, err := fhirpath.Decode(resource)
+		if err != nil {
+			return err
+		}
+		if value, ok = decoded.(map[string]interface{}); !ok {
+			return fmt.Errorf("expected a resource but got %T", resource)
+		}
+	}
+	e := &indexed{resource: resource, values: make(map[string][]fhirpath.Node)}
+	e.resourceType, _ = value["resourceType"].(string)
+	e.id, _ = value["id"].(string)
+	if e.resourceType == "" || e.id == "" {
+		return errors.New("resource without type or id")
+	}
+	options := fhirpath.Options{Resolver: stubResolver(value)}
+	for _, param := range x.parser.Params(e.resourceType) {
+		if param.Expression == nil {
+			continue
+		}
+		nodes, err := x.expressions[*param.Expression].EvaluateNodes(value, options)
+		if err != nil {
+			return fmt.Errorf("search parameter %s of %s/%s: %w", param.Code, e.resourceType, e.id, err)
+		}
+		if len(nodes) > 0 {
+			e.values[param.Code] = nodes
+		}
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.resources[e.resourceType] == nil {
+		x.resources[e.resourceType] = make(map[string]*indexed)
+	}
+	x.resources[e.resourceType][e.id] = e
+	return nil
+}
+
+// Remove removes the resource with the given type and id.
+func (x *Index) Remove(resourceType, id string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	delete(x.resources[resourceType], id)
+}
+
+// Get returns the resource with the given type and id as it was added.
+func (x *Index) Get(resourceType, id string) (interface{}, bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	e, ok := x.resources[resourceType][id]
+	if !ok {
+		return nil, false
+	}
+	return e.resource, true
+}
+
+// Search returns the resources matching the search. Unsupported modifiers and parameter types are rejected with an
+// *Error.
+func (x *Index) Search(s *Search) (*Result, error) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	var matches []*indexed
+	for _, e := range x.candidates(s.ResourceType) {
+		ok := true
+		for i := range s.Params {
+			matched, err := x.matches(e, &s.Params[i])
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matches = append(matches, e)
+		}
+	}
+	if len(s.Sort) > 0 {
+		sort.SliceStable(matches, func(i, j int) bool {
+			return less(s.Sort, matches[i], matches[j])
+		})
+	}
+
+	result := &Result{Total: len(matches)}
+	if s.Summary == "count" {
+		return result, nil
+	}
+	if s.Offset < len(matches) {
+		matches = matches[s.Offset:]
+	} else {
+		matches = nil
+	}
+	if s.Count != nil && *s.Count < len(matches) {
+		matches = matches[:*s.Count]
+	}
+	for _, e := range matches {
+		result.Matches = append(result.Matches, e.resource)
+	}
+	for _, e := range x.includes(s, matches) {
+		result.Included = append(result.Included, e.resource)
+	}
+	return result, nil
+}
+
+// candidates returns the resources of the type or, without type, of all types ordered by type and id.
+func (x *Index) candidates(resourceType string) []*indexed {
+	var types []string
+	if resourceType != "" {
+		types = []string{resourceType}
+	} else {
+		for t := range x.resources {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+	}
+	var result []*indexed
+	for _, t := range types {
+		ids := make([]string, 0, len(x.resources[t]))
+		for id := range x.resources[t] {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			result = append(result, x.resources[t][id])
+		}
+	}
+	return result
+}
+
+// matches reports whether the resource matches the parameter.
+func (x *Index) matches(e *indexed, param *Parameter) (bool, error) {
+	if param.Has != nil {
+		for _, source := range x.candidates(param.Has.ResourceType) {
+			if !x.references(source, param.Has.Reference, e, "") {
+				continue
+			}
+			if ok, err := x.matches(source, &param.Has.Param); ok || err != nil {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+
+	nodes := e.values[param.Definition.Code]
+	if param.Missing != nil {
+		return (len(nodes) == 0) == *param.Missing, nil
+	}
+	if param.Chain != nil {
+		for _, node := range nodes {
+			target := x.resolve(node.Value)
+			if target == nil || !contains(param.TargetTypes, target.resourceType) {
+				continue
+			}
+			if ok, err := x.matches(target, param.Chain); ok || err != nil {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+	if param.Modifier != nil && *param.Modifier == fhir.SearchModifierCodeNot {
+		for _, node := range nodes {
+			for _, operand := range param.Values {
+				if matchToken(node.Value, operand) {
+					return false, nil
+				}
+			}
+		}
+		return true, nil
+	}
+	for _, node := range nodes {
+		for _, operand := range param.Values {
+			ok, err := x.match(param, node, operand)
+			if ok || err != nil {
+				return ok, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// references reports whether a value of the reference parameter of source points to target, which has to be of the
+// target type, if given.
+func (x *Index) references(source *indexed, param *fhir.SearchParameter, target *indexed, targetType string) bool {
+	if targetType != "" && target.resourceType != targetType {
+		return false
+	}
+	for _, node := range source.values[param.Code] {
+		if x.resolve(node.Value) == target {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve returns the indexed resource a reference points to or nil.
+func (x *Index) resolve(value interface{}) *indexed {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	reference, _ := object["reference"].(string)
+	resourceType, id, ok := referenceKey(reference)
+	if !ok {
+		return nil
+	}
+	return x.resources[resourceType][id]
+}
+
+// includes returns the resources included by _include and _revinclude, applying the iterating ones until no more
+// resources are added.
+func (x *Index) includes(s *Search, matches []*indexed) []*indexed {
+	seen := make(map[*indexed]bool)
+	for _, e := range matches {
+		seen[e] = true
+	}
+	var result []*indexed
+	sources := matches
+	for first := true; len(sources) > 0; first = false {
+		var added []*indexed
+		add := func(e *indexed) {
+			if !seen[e] {
+				seen[e] = true
+				added = append(added, e)
+			}
+		}
+		for _, include := range s.Include {
+			if !first && !include.Iterate {
+				continue
+			}
+			for _, source := range sources {
+				if include.ResourceType != "" && source.resourceType != include.ResourceType {
+					continue
+				}
+				for _, param := range x.referenceParams(source.resourceType, include.Param) {
+					for _, node := range source.values[param.Code] {
+						if target := x.resolve(node.Value); target != nil && (include.TargetType == "" || target.resourceType == include.TargetType) {
+							add(target)
+						}
+					}
+				}
+			}
+		}
+		for _, include := range s.RevInclude {
+			if !first && !include.Iterate {
+				continue
+			}
+			for _, referencing := range x.candidates(include.ResourceType) {
+				for _, param := range x.referenceParams(referencing.resourceType, include.Param) {
+					for _, target := range sources {
+						if x.references(referencing, param, target, include.TargetType) {
+							add(referencing)
+						}
+					}
+				}
+			}
+		}
+		result = append(result, added...)
+		sources = added
+	}
+	return result
+}
+
+// referenceParams returns the reference parameter of the resource type with the given code or all of them for *.
+func (x *Index) referenceParams(resourceType, code string) []*fhir.SearchParameter {
+	if code != "*" {
+		if param := x.parser.Param(resourceType, code); param != nil && param.Type == fhir.SearchParamTypeReference {
+			return []*fhir.SearchParameter{param}
+		}
+		return nil
+	}
+	var result []*fhir.SearchParameter
+	for _, param := range x.parser.Params(resourceType) {
+		if param.Type == fhir.SearchParamTypeReference {
+			result = append(result, param)
+		}
+	}
+	return result
+}
+
+// stubResolver resolves references to contained resources and otherwise to a resource with only type and id, which
+// is enough for expressions like subject.where(resolve() is Patient).
+func stubResolver(resource map[string]interface{}) func(reference string) (interface{}, error) {
+	return func(reference string) (interface{}, error) {
+		if strings.HasPrefix(reference, "#") {
+			contained, _ := resource["contained"].([]interface{})
+			for _, c := range contained {
+				if object, ok := c.(map[string]interface{}); ok && object["id"] == reference[1:] {
+					return object, nil
+				}
+			}
+			return nil, nil
+		}
+		resourceType, id, ok := referenceKey(reference)
+		if !ok {
+			return nil, nil
+		}
+		return map[string]interface{}{"resourceType": resourceType, "id": id}, nil
+	}
+}
+
+// referenceKey returns the type and id of a relative or absolute reference like Patient/123/_history/2.
+func referenceKey(reference string) (string, string, bool) {
+	if i := strings.Index(reference, "/_history/"); i >= 0 {
+		reference = reference[:i]
+	}
+	parts := strings.Split(reference, "/")
+	if len(parts) < 2 || !isResourceType(parts[len(parts)-2]) || parts[len(parts)-1] == "" {
+		return "", "", false
+	}
+	return parts[len(parts)-2], parts[len(parts)-1], true
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,303 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/fhirpath"
+)
+
+// testResources are the resources of the index used by the tests.
+var testResources = []string{
+	`{"resourceType":"Organization","id":"o1","name":"Acme Clinic"}`,
+	`{"resourceType":"Patient","id":"p1","meta":{"lastUpdated":"2021-03-01T12:00:00Z"},"active":true,
+		"identifier":[{"type":{"coding":[{"system":"http://terminology.hl7.org/CodeSystem/v2-0203","code":"MR"}]},"system":"http://example.org/mrn","value":"123"}],
+		"name":[{"family":"Müller","given":["Hans"]}],"gender":"male","birthDate":"1970-05-12",
+		"telecom":[{"system":"phone","value":"555-1234"}],"managingOrganization":{"reference":"Organization/o1"}}`,
+	`{"resourceType":"Patient","id":"p2","identifier":[{"value":"456"}],"name":[{"family":"Smith","given":["Anna"]}],
+		"gender":"female","birthDate":"1985"}`,
+	`{"resourceType":"Patient","id":"p3","name":[{"family":"Smithson","given":["Bob"]}],"gender":"male"}`,
+	`{"resourceType":"Observation","id":"obs1","status":"final",
+		"code":{"coding":[{"system":"http://loinc.org","code":"8867-4","display":"Heart rate"}]},
+		"subject":{"reference":"Patient/p1"},"effectiveDateTime":"2020-01-01T10:00:00Z",
+		"valueQuantity":{"value":72,"unit":"beats/min","system":"http://unitsofmeasure.org","code":"/min"}}`,
+	`{"resourceType":"Observation","id":"obs2","status":"preliminary","code":{"coding":[{"system":"http://loinc.org","code":"2345-7"}],"text":"Glucose"},
+		"subject":{"reference":"http://example.org/fhir/Patient/p2"},"effectivePeriod":{"start":"2020-02-01","end":"2020-02-10"},
+		"valueQuantity":{"value":5.4,"unit":"mg","system":"http://unitsofmeasure.org","code":"mg"}}`,
+	`{"resourceType":"Observation","id":"obs3","status":"final","code":{"coding":[{"system":"http://loinc.org","code":"8480-6"}]},
+		"subject":{"reference":"Patient/p2/_history/1"},"effectiveDateTime":"2020-03-15",
+		"valueQuantity":{"value":150,"unit":"mmHg"}}`,
+	`{"resourceType":"DiagnosticReport","id":"dr1","status":"final","code":{"text":"Panel"},
+		"subject":{"reference":"Patient/p1"},"result":[{"reference":"Observation/obs1"}]}`,
+}
+
+func newTestIndex(t *testing.T) *Index {
+	t.Helper()
+	x, err := NewIndex(testParser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range testResources {
+		resource, err := fhir.DecodeResource([]byte(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := x.Add(resource); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return x
+}
+
+// keys returns the type and id of the resources like Patient/p1.
+func keys(t *testing.T, resources []interface{}) []string {
+	t.Helper()
+	var result []string
+	for _, resource := range resources {
+		value, err := fhirpath.Decode(resource)
+		if err != nil {
+			t.Fatal(err)
+		}
+		object := value.(map[string]interface{})
+		result = append(result, object["resourceType"].(string)+"/"+object["id"].(string))
+	}
+	return result
+}
+
+// search parses and runs the query against the index.
+func search(t *testing.T, x *Index, resourceType, query string) *Result {
+	t.Helper()
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := testParser.Parse(resourceType, values, Strict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := x.Search(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestIndexSearch(t *testing.T) {
+	x := newTestIndex(t)
+	tests := []struct {
+		resourceType string
+		query        string
+		want         []string
+	}{
+		// string
+		{"Patient", "family=smith", []string{"Patient/p2", "Patient/p3"}},
+		{"Patient", "family=muller", []string{"Patient/p1"}},
+		{"Patient", "family:exact=Smith", []string{"Patient/p2"}},
+		{"Patient", "family:exact=smith", nil},
+		{"Patient", "family:contains=SON", []string{"Patient/p3"}},
+		{"Patient", "name=anna", []string{"Patient/p2"}},
+		{"Patient", "name=hans,bob", []string{"Patient/p1", "Patient/p3"}},
+		{"Patient", "family=smith&given=bob", []string{"Patient/p3"}},
+		{"Organization", "name=acme", []string{"Organization/o1"}},
+
+		// token
+		{"Patient", "_id=p2", []string{"Patient/p2"}},
+		{"Patient", "gender=male", []string{"Patient/p1", "Patient/p3"}},
+		{"Patient", "gender:not=male", []string{"Patient/p2"}},
+		{"Patient", "active=true", []string{"Patient/p1"}},
+		{"Patient", "identifier=123", []string{"Patient/p1"}},
+		{"Patient", "identifier=http://example.org/mrn|123", []string{"Patient/p1"}},
+		{"Patient", "identifier=http://example.org/other|123", nil},
+		{"Patient", "identifier=http://example.org/mrn|", []string{"Patient/p1"}},
+		{"Patient", "identifier=|456", []string{"Patient/p2"}},
+		{"Patient", "identifier=|123", nil},
+		{"Patient", "identifier:of-type=http://terminology.hl7.org/CodeSystem/v2-0203|MR|123", []string{"Patient/p1"}},
+		{"Patient", "identifier:of-type=http://terminology.hl7.org/CodeSystem/v2-0203|MR|456", nil},
+		{"Patient", "phone=555-1234", []string{"Patient/p1"}},
+		{"Observation", "code=http://loinc.org|8867-4", []string{"Observation/obs1"}},
+		{"Observation", "code:text=heart", []string{"Observation/obs1"}},
+		{"Observation", "code:text=gluc", []string{"Observation/obs2"}},
+		{"Observation", "status=final", []string{"Observation/obs1", "Observation/obs3"}},
+
+		// date
+		{"Patient", "birthdate=1970", []string{"Patient/p1"}},
+		{"Patient", "birthdate=1970-05-12", []string{"Patient/p1"}},
+		{"Patient", "birthdate=1970-05-13", nil},
+		{"Patient", "birthdate=1985-06", nil},
+		{"Patient", "birthdate=lt1980", []string{"Patient/p1"}},
+		{"Patient", "birthdate=ge1985-01-01", []string{"Patient/p2"}},
+		{"Patient", "birthdate=gt1985-01-01", []string{"Patient/p2"}},
+		{"Patient", "birthdate=ne1985", []string{"Patient/p1"}},
+		{"Patient", "birthdate=sa1980", []string{"Patient/p2"}},
+		{"Patient", "birthdate=eb1980", []string{"Patient/p1"}},
+		{"Patient", "birthdate:missing=true", []string{"Patient/p3"}},
+		{"Patient", "birthdate:missing=false", []string{"Patient/p1", "Patient/p2"}},
+		{"Patient", "_lastUpdated=2021-03-01", []string{"Patient/p1"}},
+		{"Observation", "date=2020-01-01T11:00:00%2B01:00", []string{"Observation/obs1"}},
+		{"Observation", "date=2020-02", []string{"Observation/obs2"}},
+		{"Observation", "date=2020-02-05", nil},
+		{"Observation", "date=ge2020-02-05", []string{"Observation/obs2", "Observation/obs3"}},
+		{"Observation", "date=ge2020-02-01&date=le2020-03-31", []string{"Observation/obs2", "Observation/obs3"}},
+
+		// quantity
+		{"Observation", "value-quantity=72", []string{"Observation/obs1"}},
+		{"Observation", "value-quantity=5", []string{"Observation/obs2"}},
+		{"Observation", "value-quantity=5.0", nil},
+		{"Observation", "value-quantity=5.4", []string{"Observation/obs2"}},
+		{"Observation", "value-quantity=gt70", []string{"Observation/obs1", "Observation/obs3"}},
+		{"Observation", "value-quantity=le72", []string{"Observation/obs1", "Observation/obs2"}},
+		{"Observation", "value-quantity=ap145", []string{"Observation/obs3"}},
+		{"Observation", "value-quantity=5.4|http://unitsofmeasure.org|mg", []string{"Observation/obs2"}},
+		{"Observation", "value-quantity=5.4|http://unitsofmeasure.org|g", nil},
+		{"Observation", "value-quantity=150||mmHg", []string{"Observation/obs3"}},
+		{"Observation", "code-value-quantity=http://loinc.org|8480-6$gt100", []string{"Observation/obs3"}},
+		{"Observation", "code-value-quantity=http://loinc.org|8480-6$lt100", nil},
+
+		// reference
+		{"Observation", "subject=Patient/p1", []string{"Observation/obs1"}},
+		{"Observation", "subject=p1", []string{"Observation/obs1"}},
+		{"Observation", "subject:Patient=p1", []string{"Observation/obs1"}},
+		{"Observation", "subject:Group=p1", nil},
+		{"Observation", "subject=Patient/p2", []string{"Observation/obs2", "Observation/obs3"}},
+		{"Observation", "subject=http://example.org/fhir/Patient/p2", []string{"Observation/obs2", "Observation/obs3"}},
+		{"Observation", "subject=http://other.org/fhir/Patient/p2", []string{"Observation/obs3"}},
+		{"Observation", "subject=Patient/p2/_history/1", []string{"Observation/obs3"}},
+		{"Observation", "patient=Patient/p1", []string{"Observation/obs1"}},
+		{"Patient", "organization:missing=true", []string{"Patient/p2", "Patient/p3"}},
+
+		// chains
+		{"Observation", "subject.family=muller", []string{"Observation/obs1"}},
+		{"Observation", "subject:Patient.gender=female", []string{"Observation/obs2", "Observation/obs3"}},
+		{"Observation", "subject.organization.name=acme", []string{"Observation/obs1"}},
+		{"Patient", "_has:Observation:subject:status=final", []string{"Patient/p1", "Patient/p2"}},
+		{"Patient", "_has:Observation:patient:_has:DiagnosticReport:result:status=final", []string{"Patient/p1"}},
+
+		// all types
+		{"", "_id=p1,o1", []string{"Organization/o1", "Patient/p1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.resourceType+"?"+test.query, func(t *testing.T) {
+			result := search(t, x, test.resourceType, test.query)
+			if got := keys(t, result.Matches); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+			if result.Total != len(test.want) {
+				t.Errorf("total = %d, want %d", result.Total, len(test.want))
+			}
+		})
+	}
+}
+
+func TestIndexResultParams(t *testing.T) {
+	x := newTestIndex(t)
+	tests := []struct {
+		resourceType string
+		query        string
+		matches      []string
+		included     []string
+		total        int
+	}{
+		{"Patient", "_sort=birthdate", []string{"Patient/p1", "Patient/p2", "Patient/p3"}, nil, 3},
+		{"Patient", "_sort=-birthdate", []string{"Patient/p2", "Patient/p1", "Patient/p3"}, nil, 3},
+		{"Patient", "_sort=gender,-family", []string{"Patient/p2", "Patient/p3", "Patient/p1"}, nil, 3},
+		{"Observation", "_sort=-value-quantity", []string{"Observation/obs3", "Observation/obs1", "Observation/obs2"}, nil, 3},
+		{"Patient", "_sort=family&_count=2", []string{"Patient/p1", "Patient/p2"}, nil, 3},
+		{"Patient", "_sort=family&_count=2&_offset=2", []string{"Patient/p3"}, nil, 3},
+		{"Patient", "_offset=5", nil, nil, 3},
+		{"Patient", "_summary=count", nil, nil, 3},
+		{"Observation", "_id=obs1&_include=Observation:subject", []string{"Observation/obs1"}, []string{"Patient/p1"}, 1},
+		{"Observation", "_id=obs1&_include=Observation:subject:Group", []string{"Observation/obs1"}, nil, 1},
+		{"Observation", "_id=obs1&_include=Observation:subject&_include:iterate=Patient:organization",
+			[]string{"Observation/obs1"}, []string{"Patient/p1", "Organization/o1"}, 1},
+		{"Observation", "_id=obs1&_include=Observation:subject&_include=Patient:organization",
+			[]string{"Observation/obs1"}, []string{"Patient/p1"}, 1},
+		{"Patient", "_id=p1&_revinclude=Observation:subject", []string{"Patient/p1"}, []string{"Observation/obs1"}, 1},
+		{"Patient", "_id=p1&_revinclude=Observation:subject&_revinclude:iterate=DiagnosticReport:result",
+			[]string{"Patient/p1"}, []string{"Observation/obs1", "DiagnosticReport/dr1"}, 1},
+		{"Patient", "_id=p1&_revinclude=DiagnosticReport:*", []string{"Patient/p1"}, []string{"DiagnosticReport/dr1"}, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.resourceType+"?"+test.query, func(t *testing.T) {
+			result := search(t, x, test.resourceType, test.query)
+			if got := keys(t, result.Matches); !reflect.DeepEqual(got, test.matches) {
+				t.Errorf("matches = %v, want %v", got, test.matches)
+			}
+			if got := keys(t, result.Included); !reflect.DeepEqual(got, test.included) {
+				t.Errorf("included = %v, want %v", got, test.included)
+			}
+			if result.Total != test.total {
+				t.Errorf("total = %d, want %d", result.Total, test.total)
+			}
+		})
+	}
+}
+
+func TestIndexNotSupported(t *testing.T) {
+	x := newTestIndex(t)
+	for _, query := range []string{"code:in=http://example.org/vs", "code:below=http://loinc.org|8867"} {
+		values, _ := url.ParseQuery(query)
+		s, err := testParser.Parse("Observation", values, Strict)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = x.Search(s)
+		var e *Error
+		if !errors.As(err, &e) || e.Outcome.Issue[0].Code != fhir.IssueTypeNotSupported ||
+			!strings.Contains(err.Error(), "needs a terminology service") {
+			t.Errorf("%s: err = %v", query, err)
+		}
+	}
+}
+
+func TestIndexAddRemove(t *testing.T) {
+	x := newTestIndex(t)
+	// replacing a resource updates its values
+	if err := x.Add(map[string]interface{}{"resourceType": "Patient", "id": "p3", "gender": "female"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := keys(t, search(t, x, "Patient", "gender=female").Matches); !reflect.DeepEqual(got, []string{"Patient/p2", "Patient/p3"}) {
+		t.Errorf("after replace = %v", got)
+	}
+	if resource, ok := x.Get("Patient", "p3"); !ok || resource.(map[string]interface{})["gender"] != "female" {
+		t.Errorf("Get = %v, %v", resource, ok)
+	}
+
+	x.Remove("Patient", "p2")
+	if got := keys(t, search(t, x, "Patient", "gender=female").Matches); !reflect.DeepEqual(got, []string{"Patient/p3"}) {
+		t.Errorf("after remove = %v", got)
+	}
+	if _, ok := x.Get("Patient", "p2"); ok {
+		t.Error("Get found the removed resource")
+	}
+	if got := keys(t, search(t, x, "Observation", "subject:Patient.given=anna").Matches); got != nil {
+		t.Errorf("chain to removed resource = %v", got)
+	}
+
+	for _, resource := range []interface{}{
+		map[string]interface{}{"resourceType": "Patient"},
+		map[string]interface{}{"id": "x"},
+		"Patient",
+	} {
+		if err := x.Add(resource); err == nil {
+			t.Errorf("Add(%v) returned no error", resource)
+		}
+	}
+}
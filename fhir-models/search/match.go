@@ -0,0 +1,598 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/fhirpath"
+)
+
+// match reports whether the search value of a resource matches the operand of the parameter.
+func (x *Index) match(param *Parameter, node fhirpath.Node, operand Operand) (bool, error) {
+	definition, modifier := param.Definition, param.Modifier
+	switch definition.Type {
+	case fhir.SearchParamTypeString:
+		return matchString(node.Value, operand, modifier), nil
+	case fhir.SearchParamTypeToken:
+		if modifier == nil {
+			return matchToken(node.Value, operand), nil
+		}
+		switch *modifier {
+		case fhir.SearchModifierCodeText:
+			return matchText(node.Value, operand.Value), nil
+		case fhir.SearchModifierCodeOfType:
+			return matchOfType(node.Value, operand), nil
+		}
+		return false, notSupported(param, "modifier %s of token parameters needs a terminology service", modifier.Code())
+	case fhir.SearchParamTypeReference:
+		return matchReference(node.Value, operand, param), nil
+	case fhir.SearchParamTypeUri:
+		return matchURI(node.Value, operand.Value, modifier), nil
+	case fhir.SearchParamTypeDate:
+		low, high, ok := valueRange(node.Value)
+		if !ok {
+			return false, nil
+		}
+		searchLow, searchHigh, _ := dateRange(operand.Value)
+		return compareRanges(operand.Prefix, low, high, searchLow, searchHigh), nil
+	case fhir.SearchParamTypeNumber:
+		n, ok := node.Value.(json.Number)
+		return ok && compareNumber(operand.Prefix, string(n), operand.Value), nil
+	case fhir.SearchParamTypeQuantity:
+		return matchQuantity(node.Value, operand), nil
+	case fhir.SearchParamTypeComposite:
+		return x.matchComposite(definition, node, operand)
+	}
+	return false, notSupported(param, "parameters of type %s are not supported", definition.Type.Code())
+}
+
+func notSupported(param *Parameter, format string, args ...interface{}) error {
+	diagnostics := fmt.Sprintf(format, args...)
+	return &Error{Outcome: fhir.OperationOutcome{Issue: []fhir.OperationOutcomeIssue{{
+		Severity: fhir.IssueSeverityError, Code: fhir.IssueTypeNotSupported, Diagnostics: &diagnostics, Expression: []string{param.Name},
+	}}}}
+}
+
+// matchString matches strings starting with the value, or containing or equal to it for the modifiers contains and
+// exact. Complex values like HumanName and Address match by any of their string parts.
+func matchString(value interface{}, operand Operand, modifier *fhir.SearchModifierCode) bool {
+	for _, s := range stringParts(value) {
+		switch {
+		case modifier != nil && *modifier == fhir.SearchModifierCodeExact:
+			if s == operand.Value {
+				return true
+			}
+		case modifier != nil && *modifier == fhir.SearchModifierCodeContains:
+			if strings.Contains(normalize(s), normalize(operand.Value)) {
+				return true
+			}
+		default:
+			if strings.HasPrefix(normalize(s), normalize(operand.Value)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// stringParts returns the string itself or the string parts of a complex value except their codes and periods,
+// ordered by property name.
+func stringParts(value interface{}) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var result []string
+		for _, item := range value {
+			result = append(result, stringParts(item)...)
+		}
+		return result
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			switch key {
+			case "id", "extension", "use", "type", "period", "system":
+				continue
+			}
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var result []string
+		for _, key := range keys {
+			result = append(result, stringParts(value[key])...)
+		}
+		return result
+	}
+	return nil
+}
+
+// foldings maps letters with diacritics to their base letters.
+var foldings = map[rune]rune{}
+
+func init() {
+	for base, letters := range map[rune]string{
+		'a': "àáâãäåāăą", 'c': "çćĉċč", 'd': "ď", 'e': "èéêëēĕėęě", 'g': "ĝğġģ", 'h': "ĥ", 'i': "ìíîïĩīĭįı",
+		'j': "ĵ", 'k': "ķ", 'l': "ĺļľł", 'n': "ñńņň", 'o': "òóôõöøōŏő", 'r': "ŕŗř", 's': "śŝşš", 't': "ţť",
+		'u': "ùúûüũūŭůűų", 'w': "ŵ", 'y': "ýÿŷ", 'z': "źżž",
+	} {
+		for _, letter := range letters {
+			foldings[letter] = base
+		}
+	}
+}
+
+// normalize lower cases the string and removes the diacritics of Latin letters.
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if base, ok := foldings[r]; ok {
+			return base
+		}
+		return r
+	}, s)
+}
+
+// token is a code with optional system.
+type token struct {
+	system *string
+	code   string
+}
+
+// tokens returns the codes of a value, which are the codings of CodeableConcepts, Coding, the values of Identifiers
+// and ContactPoints with their systems, and codes, strings and booleans without system.
+func tokens(value interface{}) []token {
+	switch value := value.(type) {
+	case string:
+		return []token{{code: value}}
+	case bool:
+		return []token{{code: fmt.Sprint(value)}}
+	case map[string]interface{}:
+		if codings, ok := value["coding"].([]interface{}); ok {
+			var result []token
+			for _, coding := range codings {
+				result = append(result, tokens(coding)...)
+			}
+			return result
+		}
+		t := token{}
+		if system, ok := value["system"].(string); ok {
+			t.system = &system
+		}
+		if code, ok := value["code"].(string); ok {
+			t.code = code
+		} else if v, ok := value["value"].(string); ok {
+			t.code = v
+		} else {
+			return nil
+		}
+		return []token{t}
+	}
+	return nil
+}
+
+// matchToken matches tokens by code, system|code, |code for codes without system and system| for all codes of the
+// system.
+func matchToken(value interface{}, operand Operand) bool {
+	for _, t := range tokens(value) {
+		switch {
+		case operand.System == nil:
+			if t.code == operand.Value {
+				return true
+			}
+		case *operand.System == "":
+			if t.system == nil && t.code == operand.Value {
+				return true
+			}
+		case t.system != nil && *t.system == *operand.System:
+			if operand.Value == "" || t.code == operand.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchText matches the texts and displays of CodeableConcepts, Codings and the types of Identifiers like strings.
+func matchText(value interface{}, text string) bool {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	var texts []string
+	for _, key := range []string{"text", "display"} {
+		if s, ok := object[key].(string); ok {
+			texts = append(texts, s)
+		}
+	}
+	for _, key := range []string{"coding", "type"} {
+		if items, ok := object[key].([]interface{}); ok {
+			for _, item := range items {
+				if matchText(item, text) {
+					return true
+				}
+			}
+		} else if item, ok := object[key].(map[string]interface{}); ok && matchText(item, text) {
+			return true
+		}
+	}
+	for _, s := range texts {
+		if strings.HasPrefix(normalize(s), normalize(text)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchOfType matches Identifiers by a coding of their type and their value.
+func matchOfType(value interface{}, operand Operand) bool {
+	object, ok := value.(map[string]interface{})
+	if !ok || object["value"] != operand.Value {
+		return false
+	}
+	codeOperand := Operand{System: operand.System, Value: operand.Code}
+	return object["type"] != nil && matchToken(object["type"], codeOperand)
+}
+
+// matchReference matches references by id, type and id or absolute URL and canonical URLs with optional version.
+func matchReference(value interface{}, operand Operand, param *Parameter) bool {
+	if param.Modifier != nil {
+		switch *param.Modifier {
+		case fhir.SearchModifierCodeIdentifier:
+			object, ok := value.(map[string]interface{})
+			return ok && object["identifier"] != nil && matchToken(object["identifier"], operand)
+		case fhir.SearchModifierCodeAbove, fhir.SearchModifierCodeBelow:
+			return matchURI(value, operand.Value, param.Modifier)
+		}
+	}
+	if canonical, ok := value.(string); ok {
+		url, version := canonical, ""
+		if i := strings.LastIndex(canonical, "|"); i >= 0 {
+			url, version = canonical[:i], canonical[i+1:]
+		}
+		return url == operand.Value && (operand.Version == "" || version == operand.Version)
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	reference, _ := object["reference"].(string)
+	if reference == "" {
+		return false
+	}
+	resourceType, id, isKey := referenceKey(reference)
+	if param.TargetType != "" && resourceType != param.TargetType {
+		return false
+	}
+	switch {
+	case reference == operand.Value:
+		return true
+	case !strings.Contains(operand.Value, "/"):
+		return isKey && id == operand.Value
+	case strings.Contains(operand.Value, "/_history/"):
+		return strings.HasSuffix(operand.Value, "/"+reference)
+	}
+	searchType, searchId, ok := referenceKey(operand.Value)
+	if !ok || !isKey || searchType != resourceType || searchId != id {
+		return false
+	}
+	// relative references match absolute ones of any server
+	return !strings.Contains(operand.Value, "://") || !strings.Contains(reference, "://")
+}
+
+// matchURI matches equal URIs, URIs below or above the value and, for contains, URIs containing it.
+func matchURI(value interface{}, uri string, modifier *fhir.SearchModifierCode) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+	if modifier == nil {
+		return s == uri
+	}
+	switch *modifier {
+	case fhir.SearchModifierCodeBelow:
+		return strings.HasPrefix(s, uri)
+	case fhir.SearchModifierCodeAbove:
+		return strings.HasPrefix(uri, s)
+	case fhir.SearchModifierCodeContains:
+		return strings.Contains(s, uri)
+	}
+	return s == uri
+}
+
+// matchQuantity matches Quantities and Money by their value and, if given, their unit. Without system, the code
+// matches the code or unit of the quantity.
+func matchQuantity(value interface{}, operand Operand) bool {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	n, ok := object["value"].(json.Number)
+	if !ok {
+		return false
+	}
+	system, _ := object["system"].(string)
+	code, _ := object["code"].(string)
+	unit, _ := object["unit"].(string)
+	if currency, ok := object["currency"].(string); ok {
+		system, code = "urn:iso:std:iso:4217", currency
+	}
+	if operand.System != nil && operand.Code != "" {
+		if *operand.System == "" {
+			if code != operand.Code && unit != operand.Code {
+				return false
+			}
+		} else if system != *operand.System || code != operand.Code {
+			return false
+		}
+	}
+	return compareNumber(operand.Prefix, string(n), operand.Value)
+}
+
+// compareNumber compares a number with a search value, whose precision determines the range of equal numbers.
+func compareNumber(prefix fhir.SearchComparator, number, search string) bool {
+	value, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return false
+	}
+	target, ok := new(big.Rat).SetString(search)
+	if !ok {
+		return false
+	}
+	// half of the last significant digit of the search value
+	decimals := 0
+	if i := strings.IndexAny(search, "eE"); i >= 0 {
+		search = search[:i]
+	}
+	if i := strings.Index(search, "."); i >= 0 {
+		decimals = len(search) - i - 1
+	}
+	half := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Mul(big.NewInt(2), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	low := new(big.Rat).Sub(target, half)
+	high := new(big.Rat).Add(target, half)
+	switch prefix {
+	case fhir.SearchComparatorNe:
+		return value.Cmp(low) < 0 || value.Cmp(high) >= 0
+	case fhir.SearchComparatorGt, fhir.SearchComparatorSa:
+		return value.Cmp(target) > 0
+	case fhir.SearchComparatorLt, fhir.SearchComparatorEb:
+		return value.Cmp(target) < 0
+	case fhir.SearchComparatorGe:
+		return value.Cmp(target) >= 0
+	case fhir.SearchComparatorLe:
+		return value.Cmp(target) <= 0
+	case fhir.SearchComparatorAp:
+		tolerance := new(big.Rat).Abs(new(big.Rat).Mul(target, big.NewRat(1, 10)))
+		return new(big.Rat).Abs(new(big.Rat).Sub(value, target)).Cmp(tolerance) <= 0
+	}
+	return value.Cmp(low) >= 0 && value.Cmp(high) < 0
+}
+
+var (
+	minTime = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+)
+
+// valueRange returns the range of a date, dateTime, instant or Period.
+func valueRange(value interface{}) (time.Time, time.Time, bool) {
+	switch value := value.(type) {
+	case string:
+		return dateRange(value)
+	case map[string]interface{}:
+		low, high := minTime, maxTime
+		start, hasStart := value["start"].(string)
+		end, hasEnd := value["end"].(string)
+		if !hasStart && !hasEnd {
+			return low, high, false
+		}
+		if hasStart {
+			var ok bool
+			if low, _, ok = dateRange(start); !ok {
+				return low, high, false
+			}
+		}
+		if hasEnd {
+			var ok bool
+			if _, high, ok = dateRange(end); !ok {
+				return low, high, false
+			}
+		}
+		return low, high, true
+	}
+	return minTime, maxTime, false
+}
+
+// dateRange returns the range a date or dateTime of the given precision covers, from its start to the start of the
+// next year, month, day, minute, second or fraction of a second. Times without time zone are taken as UTC.
+func dateRange(s string) (time.Time, time.Time, bool) {
+	date, zone := s, ""
+	if i := strings.Index(s, "T"); i >= 0 {
+		if j := strings.IndexAny(s[i:], "Z+-"); j >= 0 {
+			date, zone = s[:i+j], s[i+j:]
+		}
+	}
+	location := time.UTC
+	if zone != "" && zone != "Z" {
+		offset, err := time.Parse("-07:00", zone)
+		if err != nil {
+			return minTime, maxTime, false
+		}
+		_, seconds := offset.Zone()
+		location = time.FixedZone(zone, seconds)
+	}
+	for _, layout := range []struct {
+		layout string
+		next   func(t time.Time) time.Time
+	}{
+		{"2006", func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }},
+		{"2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }},
+		{"2006-01-02", func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }},
+		{"2006-01-02T15:04", func(t time.Time) time.Time { return t.Add(time.Minute) }},
+		{"2006-01-02T15:04:05", func(t time.Time) time.Time { return t.Add(time.Second) }},
+	} {
+		if t, err := time.ParseInLocation(layout.layout, date, location); err == nil {
+			return t, layout.next(t), true
+		}
+	}
+	if t, err := time.ParseInLocation("2006-01-02T15:04:05.999999999", date, location); err == nil {
+		precision := time.Second
+		for range date[strings.Index(date, ".")+1:] {
+			precision /= 10
+		}
+		if precision == 0 {
+			precision = time.Nanosecond
+		}
+		return t, t.Add(precision), true
+	}
+	return minTime, maxTime, false
+}
+
+// compareRanges compares the range of a value with the one of the search value.
+func compareRanges(prefix fhir.SearchComparator, low, high, searchLow, searchHigh time.Time) bool {
+	equal := !low.Before(searchLow) && !high.After(searchHigh)
+	switch prefix {
+	case fhir.SearchComparatorNe:
+		return !equal
+	case fhir.SearchComparatorGt:
+		return high.After(searchHigh)
+	case fhir.SearchComparatorLt:
+		return low.Before(searchLow)
+	case fhir.SearchComparatorGe:
+		return high.After(searchHigh) || equal
+	case fhir.SearchComparatorLe:
+		return low.Before(searchLow) || equal
+	case fhir.SearchComparatorSa:
+		return !low.Before(searchHigh)
+	case fhir.SearchComparatorEb:
+		return !high.After(searchLow)
+	case fhir.SearchComparatorAp:
+		// widen the search range by a tenth of its distance from now
+		distance := time.Since(searchLow)
+		if distance < 0 {
+			distance = -distance
+		}
+		searchLow, searchHigh = searchLow.Add(-distance/10), searchHigh.Add(distance/10)
+		return low.Before(searchHigh) && high.After(searchLow)
+	}
+	return equal
+}
+
+// matchComposite matches the components of the value with those of the operand, each evaluated relative to the
+// value with the expression of the component.
+func (x *Index) matchComposite(definition *fhir.SearchParameter, node fhirpath.Node, operand Operand) (bool, error) {
+	for i, component := range definition.Component {
+		componentDefinition := x.parser.urls[component.Definition]
+		nodes, err := x.expressions[component.Expression].EvaluateNodes(node.Value, fhirpath.Options{})
+		if err != nil {
+			return false, err
+		}
+		componentParam := &Parameter{Name: componentDefinition.Code, Definition: componentDefinition}
+		matched := false
+		for _, n := range nodes {
+			if matched, err = x.match(componentParam, n, operand.Components[i]); matched || err != nil {
+				break
+			}
+		}
+		if !matched || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// less orders resources by the first values of the sort parameters. Resources without value come last.
+func less(params []SortParam, a, b *indexed) bool {
+	for _, param := range params {
+		aKey, aOk := sortKey(param.Definition.Type, a.values[param.Name])
+		bKey, bOk := sortKey(param.Definition.Type, b.values[param.Name])
+		if !aOk || !bOk {
+			if aOk != bOk {
+				return aOk
+			}
+			continue
+		}
+		c := compareKeys(aKey, bKey)
+		if param.Descending {
+			c = -c
+		}
+		if c != 0 {
+			return c < 0
+		}
+	}
+	return false
+}
+
+// sortKey returns the lowest value of the nodes comparable for the type.
+func sortKey(paramType fhir.SearchParamType, nodes []fhirpath.Node) (interface{}, bool) {
+	var key interface{}
+	for _, node := range nodes {
+		var k interface{}
+		switch paramType {
+		case fhir.SearchParamTypeDate:
+			if low, _, ok := valueRange(node.Value); ok {
+				k = low
+			}
+		case fhir.SearchParamTypeNumber, fhir.SearchParamTypeQuantity:
+			n, ok := node.Value.(json.Number)
+			if object, isObject := node.Value.(map[string]interface{}); isObject {
+				n, ok = object["value"].(json.Number)
+			}
+			if r, valid := new(big.Rat).SetString(string(n)); ok && valid {
+				k = r
+			}
+		case fhir.SearchParamTypeString:
+			if parts := stringParts(node.Value); len(parts) > 0 {
+				k = normalize(strings.Join(parts, " "))
+			}
+		case fhir.SearchParamTypeReference:
+			if object, ok := node.Value.(map[string]interface{}); ok {
+				if reference, ok := object["reference"].(string); ok {
+					k = reference
+				}
+			}
+		default:
+			if t := tokens(node.Value); len(t) > 0 {
+				k = t[0].code
+			}
+		}
+		if k != nil && (key == nil || compareKeys(k, key) < 0) {
+			key = k
+		}
+	}
+	return key, key != nil
+}
+
+func compareKeys(a, b interface{}) int {
+	switch a := a.(type) {
+	case time.Time:
+		switch {
+		case a.Before(b.(time.Time)):
+			return -1
+		case a.After(b.(time.Time)):
+			return 1
+		}
+		return 0
+	case *big.Rat:
+		return a.Cmp(b.(*big.Rat))
+	}
+	return strings.Compare(a.(string), b.(string))
+}
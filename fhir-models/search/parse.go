@@ -0,0 +1,660 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// Search is a parsed search of a server.
+type Search struct {
+	// ResourceType is the type of the searched resources or empty for searches of all types
+	ResourceType string
+	// Params all have to match
+	Params []Parameter
+	Sort   []SortParam
+	Count  *int
+	// Offset is the number of matches skipped, which servers set with _offset in the links to further pages
+	Offset     int
+	Include    []Include
+	RevInclude []Include
+	// Summary is one of true, text, data, count and false, or empty if not requested
+	Summary  string
+	Elements []string
+	// Total is one of none, estimate and accurate, or empty if not requested
+	Total string
+	// Outcome lists the ignored parameters as warnings and is nil if none were ignored
+	Outcome *fhir.OperationOutcome
+}
+
+// Parameter is a parsed search parameter.
+type Parameter struct {
+	// Name is the code of the parameter, like birthdate or _has
+	Name       string
+	Definition *fhir.SearchParameter
+	Modifier   *fhir.SearchModifierCode
+	// TargetType is the resource type a reference parameter is restricted to, like Patient in subject:Patient
+	TargetType string
+	// Missing is set for the modifier missing, which has no other values
+	Missing *bool
+	// Values of which any has to match
+	Values []Operand
+	// Chain is the chained parameter, which applies to the referenced resources of the types in TargetTypes
+	Chain       *Parameter
+	TargetTypes []string
+	// Has is set for the reverse chaining of the parameter _has
+	Has *ReverseChain
+}
+
+// ReverseChain matches resources referenced by resources of the type by the reference parameter which match the
+// parameter.
+type ReverseChain struct {
+	ResourceType string
+	Reference    *fhir.SearchParameter
+	Param        Parameter
+}
+
+// Operand is the unescaped value of a search parameter. The meaning of its fields depends on its type, which is the
+// type of the parameter or the one its modifier takes:
+//
+//   - number, date: Prefix and Value
+//   - string, uri, special: Value
+//   - token: System, if given, and the code as Value. For the modifier of-type, System and Code are the type of the
+//     identifier and Value its value.
+//   - reference: Value and the Version of canonical references
+//   - quantity: Prefix, the number as Value, System and Code
+//   - composite: Components
+type Operand struct {
+	Type       fhir.SearchParamType
+	Prefix     fhir.SearchComparator
+	Value      string
+	System     *string
+	Code       string
+	Version    string
+	Components []Operand
+}
+
+// SortParam is a parameter of _sort.
+type SortParam struct {
+	Name       string
+	Definition *fhir.SearchParameter
+	Descending bool
+}
+
+// Include is a value of _include or _revinclude. Param is * for all reference parameters of the resource type.
+type Include struct {
+	ResourceType string
+	Param        string
+	TargetType   string
+	Iterate      bool
+}
+
+// Handling is the handling of unknown parameters.
+type Handling int
+
+const (
+	// Lenient ignores unknown parameters and reports them as warnings.
+	Lenient Handling = iota
+	// Strict rejects searches with unknown parameters.
+	Strict
+)
+
+// PreferredHandling returns the handling requested by the Prefer header of the request, like handling=strict.
+func PreferredHandling(header http.Header) Handling {
+	for _, value := range header.Values("Prefer") {
+		for _, preference := range strings.Split(value, ",") {
+			preference = strings.ReplaceAll(strings.TrimSpace(preference), " ", "")
+			if strings.EqualFold(preference, "handling=strict") {
+				return Strict
+			}
+		}
+	}
+	return Lenient
+}
+
+// Error is returned if a search can't be parsed. Its outcome lists all problems found.
+type Error struct {
+	Outcome fhir.OperationOutcome
+}
+
+func (e *Error) Error() string {
+	var messages []string
+	for _, issue := range e.Outcome.Issue {
+		if issue.Severity != fhir.IssueSeverityError {
+			continue
+		}
+		message := issue.Code.Code()
+		if issue.Diagnostics != nil {
+			message = *issue.Diagnostics
+		}
+		if len(issue.Expression) > 0 {
+			message = strings.Join(issue.Expression, ", ") + ": " + message
+		}
+		messages = append(messages, message)
+	}
+	return "invalid search: " + strings.Join(messages, "; ")
+}
+
+// unknownError reports a parameter without definition.
+type unknownError struct {
+	message string
+}
+
+func (e unknownError) Error() string {
+	return e.message
+}
+
+// Parser parses searches with the parameters defined by SearchParameter resources.
+type Parser struct {
+	params map[string]map[string]*fhir.SearchParameter
+	urls   map[string]*fhir.SearchParameter
+}
+
+// NewParser returns a parser of the parameters. Parameters with the base Resource or DomainResource, like _id and
+// _lastUpdated, apply to all resource types.
+func NewParser(params ...fhir.SearchParameter) *Parser {
+	p := &Parser{params: make(map[string]map[string]*fhir.SearchParameter), urls: make(map[string]*fhir.SearchParameter)}
+	for i := range params {
+		param := &params[i]
+		p.urls[param.Url] = param
+		for _, base := range param.Base {
+			if p.params[base.Code()] == nil {
+				p.params[base.Code()] = make(map[string]*fhir.SearchParameter)
+			}
+			p.params[base.Code()][param.Code] = param
+		}
+	}
+	return p
+}
+
+// Param returns the definition of the parameter of the resource type or nil if it is unknown.
+func (p *Parser) Param(resourceType, code string) *fhir.SearchParameter {
+	for _, base := range []string{resourceType, "DomainResource", "Resource"} {
+		if param, ok := p.params[base][code]; ok {
+			return param
+		}
+	}
+	return nil
+}
+
+// Params returns the parameters of the resource type including those of all resources, ordered by code.
+func (p *Parser) Params(resourceType string) []*fhir.SearchParameter {
+	byCode := make(map[string]*fhir.SearchParameter)
+	for _, base := range []string{"Resource", "DomainResource", resourceType} {
+		for code, param := range p.params[base] {
+			byCode[code] = param
+		}
+	}
+	codes := make([]string, 0, len(byCode))
+	for code := range byCode {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	result := make([]*fhir.SearchParameter, len(codes))
+	for i, code := range codes {
+		result[i] = byCode[code]
+	}
+	return result
+}
+
+// resultParams are handled by Parse itself and _format and _pretty by the server.
+var resultParams = map[string]bool{
+	"_sort": true, "_count": true, "_include": true, "_include:iterate": true, "_include:recurse": true,
+	"_revinclude": true, "_revinclude:iterate": true, "_revinclude:recurse": true, "_summary": true, "_elements": true,
+	"_total": true, "_offset": true, "_format": true, "_pretty": true,
+}
+
+// Parse parses the query of a search of resources of the given type or, without type, of all types. Each value of
+// a parameter becomes its own Parameter. Unknown parameters are ignored and reported in the outcome of the search or,
+// with strict handling, rejected. Invalid values and unsupported modifiers are always rejected with an *Error.
+func (p *Parser) Parse(resourceType string, query url.Values, handling Handling) (*Search, error) {
+	s := &Search{ResourceType: resourceType}
+	var e Error
+	report := func(name string, err error) {
+		diagnostics := err.Error()
+		issue := fhir.OperationOutcomeIssue{Severity: fhir.IssueSeverityError, Code: fhir.IssueTypeInvalid, Diagnostics: &diagnostics, Expression: []string{name}}
+		var unknown unknownError
+		if errors.As(err, &unknown) {
+			issue.Code = fhir.IssueTypeNotSupported
+			if handling == Lenient {
+				issue.Severity = fhir.IssueSeverityWarning
+				if s.Outcome == nil {
+					s.Outcome = &fhir.OperationOutcome{}
+				}
+				s.Outcome.Issue = append(s.Outcome.Issue, issue)
+				return
+			}
+		}
+		e.Outcome.Issue = append(e.Outcome.Issue, issue)
+	}
+
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range query[name] {
+			if resultParams[name] {
+				if err := p.parseResultParam(s, name, value); err != nil {
+					report(name, err)
+				}
+				continue
+			}
+			param, err := p.parseParam([]string{resourceType}, name, value)
+			if err != nil {
+				report(name, err)
+				continue
+			}
+			s.Params = append(s.Params, *param)
+		}
+	}
+	if len(e.Outcome.Issue) > 0 {
+		return nil, &e
+	}
+	return s, nil
+}
+
+func (p *Parser) parseResultParam(s *Search, name, value string) error {
+	switch name {
+	case "_sort":
+		for _, field := range strings.Split(value, ",") {
+			sortParam := SortParam{Name: strings.TrimPrefix(field, "-"), Descending: strings.HasPrefix(field, "-")}
+			if sortParam.Definition = p.Param(s.ResourceType, sortParam.Name); sortParam.Definition == nil {
+				return unknownError{fmt.Sprintf("unknown sort parameter %s", sortParam.Name)}
+			}
+			s.Sort = append(s.Sort, sortParam)
+		}
+	case "_count":
+		count, err := strconv.Atoi(value)
+		if err != nil || count < 0 {
+			return fmt.Errorf("invalid count %q", value)
+		}
+		s.Count = &count
+	case "_offset":
+		offset, err := strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			return fmt.Errorf("invalid offset %q", value)
+		}
+		s.Offset = offset
+	case "_include", "_include:iterate", "_include:recurse", "_revinclude", "_revinclude:iterate", "_revinclude:recurse":
+		include, err := p.parseInclude(value)
+		if err != nil {
+			return err
+		}
+		include.Iterate = strings.Contains(name, ":")
+		if strings.HasPrefix(name, "_include") {
+			s.Include = append(s.Include, include)
+		} else {
+			s.RevInclude = append(s.RevInclude, include)
+		}
+	case "_summary":
+		switch value {
+		case "true", "text", "data", "count", "false":
+			s.Summary = value
+		default:
+			return fmt.Errorf("invalid summary mode %q", value)
+		}
+	case "_elements":
+		for _, element := range strings.Split(value, ",") {
+			if element = strings.TrimSpace(element); element != "" {
+				s.Elements = append(s.Elements, element)
+			}
+		}
+	case "_total":
+		switch value {
+		case "none", "estimate", "accurate":
+			s.Total = value
+		default:
+			return fmt.Errorf("invalid total mode %q", value)
+		}
+	}
+	return nil
+}
+
+// parseInclude parses a value like Observation:subject:Patient.
+func (p *Parser) parseInclude(value string) (Include, error) {
+	parts := strings.Split(value, ":")
+	if value == "*" {
+		return Include{Param: "*"}, nil
+	}
+	if len(parts) < 2 || len(parts) > 3 || !isResourceType(parts[0]) {
+		return Include{}, fmt.Errorf("invalid include %q", value)
+	}
+	include := Include{ResourceType: parts[0], Param: parts[1]}
+	if len(parts) == 3 {
+		if !isResourceType(parts[2]) {
+			return Include{}, fmt.Errorf("invalid target type %q of include %q", parts[2], value)
+		}
+		include.TargetType = parts[2]
+	}
+	if include.Param == "*" {
+		return include, nil
+	}
+	param := p.Param(include.ResourceType, include.Param)
+	if param == nil {
+		return Include{}, unknownError{fmt.Sprintf("unknown parameter %s of %s in include", include.Param, include.ResourceType)}
+	}
+	if param.Type != fhir.SearchParamTypeReference {
+		return Include{}, fmt.Errorf("include of parameter %s of type %s", include.Param, param.Type.Code())
+	}
+	return include, nil
+}
+
+// parseParam parses the parameter of the first of the resource types that defines it.
+func (p *Parser) parseParam(resourceTypes []string, name, value string) (*Parameter, error) {
+	if strings.HasPrefix(name, "_has:") {
+		return p.parseHas(resourceTypes, name, value)
+	}
+	head, chain := name, ""
+	if i := strings.Index(name, "."); i >= 0 {
+		head, chain = name[:i], name[i+1:]
+	}
+	code, modifier := head, ""
+	if i := strings.Index(head, ":"); i >= 0 {
+		code, modifier = head[:i], head[i+1:]
+	}
+	param := &Parameter{Name: code}
+	for _, resourceType := range resourceTypes {
+		if param.Definition = p.Param(resourceType, code); param.Definition != nil {
+			break
+		}
+	}
+	if param.Definition == nil {
+		return nil, unknownError{fmt.Sprintf("unknown parameter %s of %s", code, strings.Join(resourceTypes, ", "))}
+	}
+	valueType, err := p.applyModifier(param, modifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if chain != "" {
+		if param.Definition.Type != fhir.SearchParamTypeReference || param.Modifier != nil {
+			return nil, fmt.Errorf("chain on parameter %s", head)
+		}
+		targetTypes := []string{param.TargetType}
+		if param.TargetType == "" {
+			targetTypes = nil
+			for _, target := range param.Definition.Target {
+				targetTypes = append(targetTypes, target.Code())
+			}
+		}
+		chainCode := chain
+		if i := strings.IndexAny(chain, ":."); i >= 0 {
+			chainCode = chain[:i]
+		}
+		for _, targetType := range targetTypes {
+			if p.Param(targetType, chainCode) != nil {
+				param.TargetTypes = append(param.TargetTypes, targetType)
+			}
+		}
+		if len(param.TargetTypes) == 0 {
+			return nil, unknownError{fmt.Sprintf("unknown parameter %s of %s in chain", chainCode, strings.Join(targetTypes, ", "))}
+		}
+		if param.Chain, err = p.parseParam(param.TargetTypes, chain, value); err != nil {
+			return nil, err
+		}
+		return param, nil
+	}
+
+	if param.Modifier != nil && *param.Modifier == fhir.SearchModifierCodeMissing {
+		missing, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q of modifier missing", value)
+		}
+		param.Missing = &missing
+		return param, nil
+	}
+	ofType := param.Modifier != nil && *param.Modifier == fhir.SearchModifierCodeOfType
+	for _, v := range split(value, ',') {
+		operand, err := p.parseOperand(param.Definition, valueType, ofType, v)
+		if err != nil {
+			return nil, err
+		}
+		param.Values = append(param.Values, operand)
+	}
+	return param, nil
+}
+
+// parseHas parses a name like _has:Observation:patient:code.
+func (p *Parser) parseHas(resourceTypes []string, name, value string) (*Parameter, error) {
+	parts := strings.SplitN(strings.TrimPrefix(name, "_has:"), ":", 3)
+	if len(parts) < 3 || !isResourceType(parts[0]) {
+		return nil, fmt.Errorf("invalid reverse chain %s", name)
+	}
+	reference := p.Param(parts[0], parts[1])
+	if reference == nil {
+		return nil, unknownError{fmt.Sprintf("unknown parameter %s of %s in reverse chain", parts[1], parts[0])}
+	}
+	if reference.Type != fhir.SearchParamTypeReference {
+		return nil, fmt.Errorf("reverse chain on parameter %s of type %s", parts[1], reference.Type.Code())
+	}
+	if !targets(reference, resourceTypes) {
+		return nil, fmt.Errorf("parameter %s of %s doesn't reference %s", parts[1], parts[0], strings.Join(resourceTypes, ", "))
+	}
+	param, err := p.parseParam([]string{parts[0]}, parts[2], value)
+	if err != nil {
+		return nil, err
+	}
+	return &Parameter{Name: "_has", Has: &ReverseChain{ResourceType: parts[0], Reference: reference, Param: *param}}, nil
+}
+
+// targets reports whether the reference parameter may reference any of the resource types.
+func targets(reference *fhir.SearchParameter, resourceTypes []string) bool {
+	if len(reference.Target) == 0 {
+		return true
+	}
+	for _, target := range reference.Target {
+		for _, resourceType := range resourceTypes {
+			if resourceType == "" || target.Code() == resourceType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// applyModifier sets the modifier or target type of the parameter and returns the type of its values.
+func (p *Parser) applyModifier(param *Parameter, modifier string) (fhir.SearchParamType, error) {
+	definition := param.Definition
+	if modifier == "" {
+		return definition.Type, nil
+	}
+	code, ok := parseModifier(modifier)
+	if !ok {
+		if definition.Type == fhir.SearchParamTypeReference && isResourceType(modifier) {
+			if !targets(definition, []string{modifier}) {
+				return 0, fmt.Errorf("parameter %s doesn't reference %s", param.Name, modifier)
+			}
+			param.TargetType = modifier
+			return definition.Type, nil
+		}
+		return 0, fmt.Errorf("unknown modifier %s of parameter %s", modifier, param.Name)
+	}
+	if len(definition.Modifier) > 0 && code != fhir.SearchModifierCodeMissing {
+		supported := false
+		for _, m := range definition.Modifier {
+			supported = supported || m == code
+		}
+		if !supported {
+			return 0, fmt.Errorf("unsupported modifier %s of parameter %s", modifier, param.Name)
+		}
+	}
+	param.Modifier = &code
+	valueType := definition.Type
+	ok = false
+	switch code {
+	case fhir.SearchModifierCodeMissing:
+		ok = definition.Type != fhir.SearchParamTypeComposite
+	case fhir.SearchModifierCodeExact:
+		ok = definition.Type == fhir.SearchParamTypeString
+	case fhir.SearchModifierCodeContains:
+		ok = definition.Type == fhir.SearchParamTypeString || definition.Type == fhir.SearchParamTypeUri
+	case fhir.SearchModifierCodeText:
+		valueType = fhir.SearchParamTypeString
+		ok = definition.Type == fhir.SearchParamTypeToken || definition.Type == fhir.SearchParamTypeReference
+	case fhir.SearchModifierCodeNot, fhir.SearchModifierCodeOfType:
+		ok = definition.Type == fhir.SearchParamTypeToken
+	case fhir.SearchModifierCodeIn, fhir.SearchModifierCodeNotIn:
+		valueType = fhir.SearchParamTypeUri
+		ok = definition.Type == fhir.SearchParamTypeToken
+	case fhir.SearchModifierCodeAbove, fhir.SearchModifierCodeBelow:
+		ok = definition.Type == fhir.SearchParamTypeToken || definition.Type == fhir.SearchParamTypeUri ||
+			definition.Type == fhir.SearchParamTypeReference
+	case fhir.SearchModifierCodeIdentifier:
+		valueType = fhir.SearchParamTypeToken
+		ok = definition.Type == fhir.SearchParamTypeReference
+	}
+	if !ok {
+		return 0, fmt.Errorf("modifier %s doesn't apply to parameter %s of type %s", modifier, param.Name, definition.Type.Code())
+	}
+	return valueType, nil
+}
+
+var (
+	numberPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+	datePattern   = regexp.MustCompile(`^\d{4}(-\d{2}(-\d{2}(T\d{2}(:\d{2}(:\d{2}(\.\d+)?)?)?(Z|[+-]\d{2}:\d{2})?)?)?)?$`)
+)
+
+// parseOperand parses a value of the given type, which is still escaped.
+func (p *Parser) parseOperand(definition *fhir.SearchParameter, valueType fhir.SearchParamType, ofType bool, value string) (Operand, error) {
+	operand := Operand{Type: valueType}
+	switch valueType {
+	case fhir.SearchParamTypeNumber, fhir.SearchParamTypeDate:
+		operand.Prefix, operand.Value = parsePrefix(unescape(value))
+		pattern := numberPattern
+		if valueType == fhir.SearchParamTypeDate {
+			pattern = datePattern
+		}
+		if !pattern.MatchString(operand.Value) {
+			return Operand{}, fmt.Errorf("invalid %s %q", valueType.Code(), value)
+		}
+	case fhir.SearchParamTypeQuantity:
+		parts := split(value, '|')
+		if len(parts) != 1 && len(parts) != 3 {
+			return Operand{}, fmt.Errorf("invalid quantity %q", value)
+		}
+		operand.Prefix, operand.Value = parsePrefix(unescape(parts[0]))
+		if !numberPattern.MatchString(operand.Value) {
+			return Operand{}, fmt.Errorf("invalid quantity %q", value)
+		}
+		if len(parts) == 3 {
+			system := unescape(parts[1])
+			operand.System = &system
+			operand.Code = unescape(parts[2])
+		}
+	case fhir.SearchParamTypeToken:
+		parts := split(value, '|')
+		switch {
+		case ofType && len(parts) == 3:
+			system := unescape(parts[0])
+			operand.System = &system
+			operand.Code = unescape(parts[1])
+			operand.Value = unescape(parts[2])
+		case !ofType && len(parts) == 1:
+			operand.Value = unescape(parts[0])
+		case !ofType && len(parts) == 2:
+			system := unescape(parts[0])
+			operand.System = &system
+			operand.Value = unescape(parts[1])
+		default:
+			return Operand{}, fmt.Errorf("invalid token %q", value)
+		}
+	case fhir.SearchParamTypeReference:
+		parts := split(value, '|')
+		if len(parts) > 2 {
+			return Operand{}, fmt.Errorf("invalid reference %q", value)
+		}
+		operand.Value = unescape(parts[0])
+		if len(parts) == 2 {
+			operand.Version = unescape(parts[1])
+		}
+	case fhir.SearchParamTypeComposite:
+		parts := split(value, '$')
+		if len(parts) != len(definition.Component) {
+			return Operand{}, fmt.Errorf("expected %d components but got %d in %q", len(definition.Component), len(parts), value)
+		}
+		for i, component := range definition.Component {
+			componentDefinition, ok := p.urls[component.Definition]
+			if !ok {
+				return Operand{}, fmt.Errorf("unknown component %s", component.Definition)
+			}
+			c, err := p.parseOperand(componentDefinition, componentDefinition.Type, false, parts[i])
+			if err != nil {
+				return Operand{}, err
+			}
+			operand.Components = append(operand.Components, c)
+		}
+	default:
+		operand.Value = unescape(value)
+	}
+	return operand, nil
+}
+
+// parsePrefix splits off the comparator, which defaults to eq.
+func parsePrefix(value string) (fhir.SearchComparator, string) {
+	if len(value) > 2 && (value[2] >= '0' && value[2] <= '9' || strings.ContainsRune("+-.", rune(value[2]))) {
+		var comparator fhir.SearchComparator
+		if comparator.UnmarshalJSON([]byte(strconv.Quote(value[:2]))) == nil {
+			return comparator, value[2:]
+		}
+	}
+	return fhir.SearchComparatorEq, value
+}
+
+// split splits the value at the separators not escaped by a backslash, keeping the escapes.
+func split(value string, separator byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case separator:
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, value[start:])
+}
+
+// unescape removes the backslashes escaping characters.
+func unescape(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+		}
+		b.WriteByte(value[i])
+	}
+	return b.String()
+}
+
+func isResourceType(name string) bool {
+	var resourceType fhir.ResourceType
+	return resourceType.UnmarshalJSON([]byte(strconv.Quote(name))) == nil
+}
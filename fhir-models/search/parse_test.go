@@ -0,0 +1,344 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// testParser is a parser of the embedded R4 search parameters and probability of RiskAssessment, the only parameter
+// of type number.
+var testParser = NewParser(append(Definitions(), fhir.SearchParameter{
+	Url:        "http://hl7.org/fhir/SearchParameter/RiskAssessment-probability",
+	Code:       "probability",
+	Base:       []fhir.ResourceType{fhir.ResourceTypeRiskAssessment},
+	Type:       fhir.SearchParamTypeNumber,
+	Expression: stringPtr("RiskAssessment.prediction.probability"),
+})...)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// describe returns a compact description of the parameter, like subject:Patient.[Patient]name=[string eq Smith].
+func describe(p Parameter) string {
+	if p.Has != nil {
+		return "_has:" + p.Has.ResourceType + ":" + p.Has.Reference.Code + ":" + describe(p.Has.Param)
+	}
+	s := p.Name
+	if p.Modifier != nil {
+		s += ":" + modifierName(*p.Modifier)
+	}
+	if p.TargetType != "" {
+		s += ":" + p.TargetType
+	}
+	if p.Chain != nil {
+		return s + ".[" + strings.Join(p.TargetTypes, ",") + "]" + describe(*p.Chain)
+	}
+	if p.Missing != nil {
+		if *p.Missing {
+			return s + "=true"
+		}
+		return s + "=false"
+	}
+	values := make([]string, len(p.Values))
+	for i, operand := range p.Values {
+		values[i] = describeOperand(operand)
+	}
+	return s + "=[" + strings.Join(values, ", ") + "]"
+}
+
+func describeOperand(o Operand) string {
+	s := o.Type.Code()
+	switch o.Type {
+	case fhir.SearchParamTypeNumber, fhir.SearchParamTypeDate, fhir.SearchParamTypeQuantity:
+		s += " " + o.Prefix.Code()
+	}
+	if o.Type != fhir.SearchParamTypeComposite {
+		s += " " + o.Value
+	}
+	if o.System != nil {
+		s += " system=" + *o.System
+	}
+	if o.Code != "" {
+		s += " code=" + o.Code
+	}
+	if o.Version != "" {
+		s += " version=" + o.Version
+	}
+	for _, c := range o.Components {
+		s += " (" + describeOperand(c) + ")"
+	}
+	return s
+}
+
+func TestParseParams(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		query        string
+		want         []string
+	}{
+		{"Patient", "family=Smith", []string{"family=[string Smith]"}},
+		{"Patient", "family:exact=Smith", []string{"family:exact=[string Smith]"}},
+		{"Patient", "family:contains=mit,ith", []string{"family:contains=[string mit, string ith]"}},
+		{"Patient", "family=a&family=b", []string{"family=[string a]", "family=[string b]"}},
+		{"Patient", `family=a\,b\$c\|d\\e`, []string{`family=[string a,b$c|d\e]`}},
+		{"Patient", "_id=p1", []string{"_id=[token p1]"}},
+		{"Patient", "gender=male", []string{"gender=[token male]"}},
+		{"Patient", "gender:not=male", []string{"gender:not=[token male]"}},
+		{"Patient", "identifier=http://example.org|123", []string{"identifier=[token 123 system=http://example.org]"}},
+		{"Patient", "identifier=|123", []string{"identifier=[token 123 system=]"}},
+		{"Patient", "identifier=http://example.org|", []string{"identifier=[token  system=http://example.org]"}},
+		{"Patient", "identifier:of-type=http://terminology.hl7.org/CodeSystem/v2-0203|MR|123",
+			[]string{"identifier:of-type=[token 123 system=http://terminology.hl7.org/CodeSystem/v2-0203 code=MR]"}},
+		{"Observation", "code:text=glucose", []string{"code:text=[string glucose]"}},
+		{"Observation", "code:in=http://example.org/vs", []string{"code:in=[uri http://example.org/vs]"}},
+		{"Patient", "birthdate=2020", []string{"birthdate=[date eq 2020]"}},
+		{"Patient", "birthdate=ge2020-01-01&birthdate=lt2021", []string{"birthdate=[date ge 2020-01-01]", "birthdate=[date lt 2021]"}},
+		{"Observation", "date=2020-01-01T10:00:00%2B01:00", []string{"date=[date eq 2020-01-01T10:00:00+01:00]"}},
+		{"Patient", "birthdate:missing=true", []string{"birthdate:missing=true"}},
+		{"Patient", "birthdate:missing=false", []string{"birthdate:missing=false"}},
+		{"RiskAssessment", "probability=gt0.8", []string{"probability=[number gt 0.8]"}},
+		{"RiskAssessment", "probability=-1e3", []string{"probability=[number eq -1e3]"}},
+		{"Observation", "value-quantity=le5.4|http://unitsofmeasure.org|mg",
+			[]string{"value-quantity=[quantity le 5.4 system=http://unitsofmeasure.org code=mg]"}},
+		{"Observation", "value-quantity=5.4", []string{"value-quantity=[quantity eq 5.4]"}},
+		{"Observation", "subject=Patient/p1", []string{"subject=[reference Patient/p1]"}},
+		{"Observation", "subject:Patient=p1", []string{"subject:Patient=[reference p1]"}},
+		{"Observation", "subject:identifier=http://example.org|123", []string{"subject:identifier=[token 123 system=http://example.org]"}},
+		{"Observation", "subject=http://example.org/fhir/Patient/p1|2", []string{"subject=[reference http://example.org/fhir/Patient/p1 version=2]"}},
+		{"Patient", "_profile=http://example.org/sd", []string{"_profile=[uri http://example.org/sd]"}},
+		{"Observation", "code-value-quantity=http://loinc.org|8480-6$gt150",
+			[]string{"code-value-quantity=[composite (token 8480-6 system=http://loinc.org) (quantity gt 150)]"}},
+		{"Observation", "subject.name=Smith", []string{"subject.[Patient]name=[string Smith]"}},
+		{"Observation", "subject:Patient.name=Smith", []string{"subject:Patient.[Patient]name=[string Smith]"}},
+		{"Observation", "subject:Patient.organization.name=Acme",
+			[]string{"subject:Patient.[Patient]organization.[Organization]name=[string Acme]"}},
+		{"Observation", "subject.gender:not=male", []string{"subject.[Patient]gender:not=[token male]"}},
+		{"Patient", "_has:Observation:patient:code=http://loinc.org|1234-5",
+			[]string{"_has:Observation:patient:code=[token 1234-5 system=http://loinc.org]"}},
+		{"Patient", "_has:Observation:patient:_has:DiagnosticReport:result:status=final",
+			[]string{"_has:Observation:patient:_has:DiagnosticReport:result:status=[token final]"}},
+		{"", "_id=p1", []string{"_id=[token p1]"}},
+	}
+	for _, test := range tests {
+		t.Run(test.resourceType+"?"+test.query, func(t *testing.T) {
+			query, err := url.ParseQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s, err := testParser.Parse(test.resourceType, query, Strict)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, param := range s.Params {
+				if param.Definition == nil && param.Has == nil {
+					t.Errorf("parameter %s without definition", param.Name)
+				}
+				got = append(got, describe(param))
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseResultParams(t *testing.T) {
+	query, _ := url.ParseQuery("_sort=-date,code&_count=10&_offset=20&_include=Observation:subject:Patient" +
+		"&_include:iterate=Patient:organization&_revinclude=DiagnosticReport:result&_revinclude:recurse=DiagnosticReport:*" +
+		"&_summary=count&_elements=id,%20code,&_total=accurate&_format=json&_pretty=true")
+	s, err := testParser.Parse("Observation", query, Strict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sort []string
+	for _, p := range s.Sort {
+		if p.Definition == nil {
+			t.Errorf("sort parameter %s without definition", p.Name)
+		}
+		if p.Descending {
+			sort = append(sort, "-"+p.Name)
+		} else {
+			sort = append(sort, p.Name)
+		}
+	}
+	if !reflect.DeepEqual(sort, []string{"-date", "code"}) {
+		t.Errorf("sort = %v", sort)
+	}
+	if s.Count == nil || *s.Count != 10 || s.Offset != 20 {
+		t.Errorf("count = %v, offset = %d", s.Count, s.Offset)
+	}
+	wantInclude := []Include{
+		{ResourceType: "Observation", Param: "subject", TargetType: "Patient"},
+		{ResourceType: "Patient", Param: "organization", Iterate: true},
+	}
+	if !reflect.DeepEqual(s.Include, wantInclude) {
+		t.Errorf("include = %+v", s.Include)
+	}
+	wantRevInclude := []Include{
+		{ResourceType: "DiagnosticReport", Param: "result"},
+		{ResourceType: "DiagnosticReport", Param: "*", Iterate: true},
+	}
+	if !reflect.DeepEqual(s.RevInclude, wantRevInclude) {
+		t.Errorf("revinclude = %+v", s.RevInclude)
+	}
+	if s.Summary != "count" || s.Total != "accurate" || !reflect.DeepEqual(s.Elements, []string{"id", "code"}) {
+		t.Errorf("summary = %s, total = %s, elements = %v", s.Summary, s.Total, s.Elements)
+	}
+	if len(s.Params) != 0 || s.Outcome != nil {
+		t.Errorf("params = %v, outcome = %v", s.Params, s.Outcome)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		query        string
+		code         fhir.IssueType
+		diagnostics  string
+	}{
+		{"Patient", "family:not=a", fhir.IssueTypeInvalid, "modifier not doesn't apply to parameter family of type string"},
+		{"Patient", "family:foo=a", fhir.IssueTypeInvalid, "unknown modifier foo of parameter family"},
+		{"Patient", "identifier:ofType=MR|1", fhir.IssueTypeInvalid, "unknown modifier ofType of parameter identifier"},
+		{"Patient", "birthdate=yesterday", fhir.IssueTypeInvalid, `invalid date "yesterday"`},
+		{"Patient", "birthdate=2020-1-1", fhir.IssueTypeInvalid, `invalid date "2020-1-1"`},
+		{"Patient", "birthdate:missing=maybe", fhir.IssueTypeInvalid, `invalid value "maybe" of modifier missing`},
+		{"RiskAssessment", "probability=gtx", fhir.IssueTypeInvalid, `invalid number "gtx"`},
+		{"Observation", "value-quantity=5|mg", fhir.IssueTypeInvalid, `invalid quantity "5|mg"`},
+		{"Patient", "identifier=a|b|c", fhir.IssueTypeInvalid, `invalid token "a|b|c"`},
+		{"Patient", "identifier:of-type=MR|123", fhir.IssueTypeInvalid, `invalid token "MR|123"`},
+		{"Observation", "subject=a|b|c", fhir.IssueTypeInvalid, `invalid reference "a|b|c"`},
+		{"Observation", "code-value-quantity=http://loinc.org|8480-6", fhir.IssueTypeInvalid, "expected 2 components but got 1"},
+		{"Observation", "subject:Medication=m1", fhir.IssueTypeInvalid, "parameter subject doesn't reference Medication"},
+		{"Patient", "family.name=a", fhir.IssueTypeInvalid, "chain on parameter family"},
+		{"Patient", "_has:Observation:code:status=final", fhir.IssueTypeInvalid, "reverse chain on parameter code of type token"},
+		{"Patient", "_has:Observation=final", fhir.IssueTypeInvalid, "invalid reverse chain _has:Observation"},
+		{"Patient", "_has:Encounter:service-provider:status=final", fhir.IssueTypeInvalid,
+			"parameter service-provider of Encounter doesn't reference Patient"},
+		{"Patient", "_count=-1", fhir.IssueTypeInvalid, `invalid count "-1"`},
+		{"Patient", "_offset=x", fhir.IssueTypeInvalid, `invalid offset "x"`},
+		{"Patient", "_summary=all", fhir.IssueTypeInvalid, `invalid summary mode "all"`},
+		{"Patient", "_total=some", fhir.IssueTypeInvalid, `invalid total mode "some"`},
+		{"Patient", "_include=Patient", fhir.IssueTypeInvalid, `invalid include "Patient"`},
+		{"Patient", "_include=Patient:organization:Foo", fhir.IssueTypeInvalid, `invalid target type "Foo"`},
+		{"Patient", "_include=Patient:gender", fhir.IssueTypeInvalid, "include of parameter gender of type token"},
+		{"Patient", "foo=bar", fhir.IssueTypeNotSupported, "unknown parameter foo of Patient"},
+		{"Patient", "_sort=foo", fhir.IssueTypeNotSupported, "unknown sort parameter foo"},
+		{"Patient", "_include=Patient:foo", fhir.IssueTypeNotSupported, "unknown parameter foo of Patient in include"},
+		{"Observation", "subject.foo=bar", fhir.IssueTypeNotSupported, "unknown parameter foo of"},
+		{"Patient", "_has:Observation:foo:code=x", fhir.IssueTypeNotSupported, "unknown parameter foo of Observation in reverse chain"},
+	}
+	for _, test := range tests {
+		t.Run(test.resourceType+"?"+test.query, func(t *testing.T) {
+			query, err := url.ParseQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = testParser.Parse(test.resourceType, query, Strict)
+			var e *Error
+			if !errors.As(err, &e) {
+				t.Fatalf("err = %v, want *Error", err)
+			}
+			issue := e.Outcome.Issue[0]
+			if len(e.Outcome.Issue) != 1 || issue.Severity != fhir.IssueSeverityError || issue.Code != test.code ||
+				!strings.Contains(*issue.Diagnostics, test.diagnostics) {
+				t.Errorf("outcome = %+v, want %s: %s", e.Outcome, test.code.Code(), test.diagnostics)
+			}
+			if !strings.HasPrefix(err.Error(), "invalid search: ") {
+				t.Errorf("message = %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	query, _ := url.ParseQuery("foo=bar&family=Smith&_sort=foo")
+	s, err := testParser.Parse("Patient", query, Lenient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Params) != 1 || s.Params[0].Name != "family" {
+		t.Errorf("params = %+v", s.Params)
+	}
+	if s.Outcome == nil || len(s.Outcome.Issue) != 2 {
+		t.Fatalf("outcome = %+v", s.Outcome)
+	}
+	for i, expression := range []string{"_sort", "foo"} {
+		issue := s.Outcome.Issue[i]
+		if issue.Severity != fhir.IssueSeverityWarning || issue.Code != fhir.IssueTypeNotSupported || issue.Expression[0] != expression {
+			t.Errorf("issue %d = %+v", i, issue)
+		}
+	}
+
+	// invalid values are rejected regardless of the handling, reporting all problems
+	query, _ = url.ParseQuery("foo=bar&birthdate=x&_count=y")
+	_, err = testParser.Parse("Patient", query, Lenient)
+	var e *Error
+	if !errors.As(err, &e) || len(e.Outcome.Issue) != 2 {
+		t.Fatalf("err = %v, want two issues", err)
+	}
+	if err.Error() != `invalid search: _count: invalid count "y"; birthdate: invalid date "x"` {
+		t.Errorf("message = %s", err.Error())
+	}
+}
+
+func TestPreferredHandling(t *testing.T) {
+	tests := []struct {
+		prefer []string
+		want   Handling
+	}{
+		{nil, Lenient},
+		{[]string{"handling=strict"}, Strict},
+		{[]string{"return=minimal, handling = strict"}, Strict},
+		{[]string{"return=minimal", "HANDLING=STRICT"}, Strict},
+		{[]string{"handling=lenient"}, Lenient},
+	}
+	for _, test := range tests {
+		header := http.Header{"Prefer": test.prefer}
+		if got := PreferredHandling(header); got != test.want {
+			t.Errorf("PreferredHandling(%q) = %d, want %d", test.prefer, got, test.want)
+		}
+	}
+}
+
+func TestParserParams(t *testing.T) {
+	if p := testParser.Param("Patient", "birthdate"); p == nil || p.Type != fhir.SearchParamTypeDate {
+		t.Errorf("Param(Patient, birthdate) = %v", p)
+	}
+	if p := testParser.Param("Patient", "_lastUpdated"); p == nil {
+		t.Error("Param(Patient, _lastUpdated) = nil")
+	}
+	if p := testParser.Param("Patient", "code"); p != nil {
+		t.Errorf("Param(Patient, code) = %s", p.Url)
+	}
+	params := testParser.Params("Patient")
+	for i := 1; i < len(params); i++ {
+		if params[i-1].Code >= params[i].Code {
+			t.Errorf("parameters not ordered: %s before %s", params[i-1].Code, params[i].Code)
+		}
+	}
+	if len(params) == 0 || params[0].Code != "_id" {
+		t.Errorf("first parameter = %v", params[0].Code)
+	}
+}
@@ -0,0 +1,506 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package search builds and parses the parameters of FHIR searches (http://hl7.org/fhir/search.html).
+//
+// Values are typed after the search parameter types, escape the characters ',', '|', '$' and '\' as the specification
+// requires and carry comparator prefixes where the type allows them. Parameter names are built with Param, which
+// adds modifiers, type restrictions and chains, and Has for reverse chaining:
+//
+//	query := search.NewQuery().
+//		Where(search.Param("subject").Type("Patient").Chain("name"), search.String("Smith")).
+//		Where("code", search.Token("http://loinc.org", "8867-4")).
+//		Where("date", search.Date("2020-01-01").Prefix(fhir.SearchComparatorGe)).
+//		Include("Observation", "subject", "")
+//	values, err := query.Values()
+//
+// Queries built with NewQueryFor also check the parameters against their definitions, so that unknown parameters and
+// values of the wrong type are reported by Values as well:
+//
+//	query := search.NewQueryFor(search.NewParser(search.Definitions()...), "Observation").
+//		Where("code", search.Token("http://loinc.org", "8867-4"))
+//
+// Servers parse queries with a Parser built from SearchParameter resources, which yields a Search of typed
+// parameters with unescaped values.
+package search
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// Escape escapes the characters with special meaning in search parameter values.
+func Escape(s string) string {
+	return escaper.Replace(s)
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `$`, `\$`, `|`, `\|`)
+
+// Value is the value of a search parameter of a certain type.
+type Value interface {
+	// Type returns the type of the search parameters the value belongs to.
+	Type() fhir.SearchParamType
+	// String returns the escaped value.
+	String() string
+}
+
+// Param is the name of a search parameter including its modifiers and chains.
+type Param string
+
+// Modifier returns the parameter with the modifier, like code:not.
+func (p Param) Modifier(modifier fhir.SearchModifierCode) Param {
+	return p + ":" + Param(modifierName(modifier))
+}
+
+// modifierName returns the modifier as written in search parameters, which differs from its code for of-type.
+func modifierName(modifier fhir.SearchModifierCode) string {
+	if modifier == fhir.SearchModifierCodeOfType {
+		return "of-type"
+	}
+	return modifier.Code()
+}
+
+// parseModifier parses the modifier as written in search parameters.
+func parseModifier(name string) (fhir.SearchModifierCode, bool) {
+	if name == "of-type" {
+		return fhir.SearchModifierCodeOfType, true
+	}
+	var modifier fhir.SearchModifierCode
+	if name == "ofType" || modifier.UnmarshalJSON([]byte(strconv.Quote(name))) != nil {
+		return 0, false
+	}
+	return modifier, true
+}
+
+// Type restricts a reference parameter to the resource type, like subject:Patient.
+func (p Param) Type(resourceType string) Param {
+	return p + ":" + Param(resourceType)
+}
+
+// Chain returns the chained parameter of the resource the reference parameter points to, like subject.name.
+func (p Param) Chain(name string) Param {
+	return p + "." + Param(name)
+}
+
+// Has returns the reverse chained parameter _has:resourceType:reference:param, which matches resources referenced by
+// resources of the given type by the reference parameter, which match param.
+func Has(resourceType, reference string, param Param) Param {
+	return Param("_has:" + resourceType + ":" + reference + ":" + string(param))
+}
+
+// modifier returns the modifier of the last link of the parameter.
+func (p Param) modifier() (fhir.SearchModifierCode, bool) {
+	name := string(p)
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	i := strings.LastIndex(name, ":")
+	if i < 0 || strings.HasPrefix(name, "_has:") {
+		return 0, false
+	}
+	return parseModifier(name[i+1:])
+}
+
+// modifierTypes lists the types of the values each modifier accepts, which differ from the type of the parameter for
+// in and not-in taking a ValueSet, identifier taking a token and text taking a string.
+var modifierTypes = map[fhir.SearchModifierCode][]fhir.SearchParamType{
+	fhir.SearchModifierCodeExact:      {fhir.SearchParamTypeString},
+	fhir.SearchModifierCodeContains:   {fhir.SearchParamTypeString, fhir.SearchParamTypeUri},
+	fhir.SearchModifierCodeText:       {fhir.SearchParamTypeString},
+	fhir.SearchModifierCodeNot:        {fhir.SearchParamTypeToken},
+	fhir.SearchModifierCodeIn:         {fhir.SearchParamTypeUri, fhir.SearchParamTypeReference},
+	fhir.SearchModifierCodeNotIn:      {fhir.SearchParamTypeUri, fhir.SearchParamTypeReference},
+	fhir.SearchModifierCodeOfType:     {fhir.SearchParamTypeToken},
+	fhir.SearchModifierCodeBelow:      {fhir.SearchParamTypeToken, fhir.SearchParamTypeUri},
+	fhir.SearchModifierCodeAbove:      {fhir.SearchParamTypeToken, fhir.SearchParamTypeUri},
+	fhir.SearchModifierCodeIdentifier: {fhir.SearchParamTypeToken},
+}
+
+// Query collects the parameters of a search. Errors are reported by Values and Encode.
+type Query struct {
+	values       url.Values
+	parser       *Parser
+	resourceType string
+	err          error
+}
+
+// NewQuery returns an empty query.
+func NewQuery() *Query {
+	return &Query{values: make(url.Values)}
+}
+
+// NewQueryFor returns an empty query of resources of the type, whose parameters are checked against the definitions
+// of the parser. Unknown parameters, modifiers and chains, values of another type than the parameter and invalid
+// values are errors.
+func NewQueryFor(parser *Parser, resourceType string) *Query {
+	return &Query{values: make(url.Values), parser: parser, resourceType: resourceType}
+}
+
+// Where adds the parameter matching any of the values. All values have to be of the same type, which has to be one
+// the modifier of the parameter accepts. Calling Where repeatedly with the same parameter requires all values to
+// match.
+func (q *Query) Where(param Param, values ...Value) *Query {
+	if len(values) == 0 {
+		return q.fail(fmt.Errorf("parameter %s without value", param))
+	}
+	modifier, hasModifier := param.modifier()
+	if hasModifier && modifier == fhir.SearchModifierCodeMissing {
+		return q.fail(fmt.Errorf("use Missing for the modifier missing of parameter %s", param))
+	}
+	strs := make([]string, len(values))
+	for i, value := range values {
+		if value.Type() != values[0].Type() {
+			return q.fail(fmt.Errorf("parameter %s with values of type %s and %s", param, values[0].Type().Code(), value.Type().Code()))
+		}
+		strs[i] = value.String()
+	}
+	if hasModifier && !applies(modifier, values[0].Type()) {
+		return q.fail(fmt.Errorf("modifier %s doesn't accept values of type %s in parameter %s", modifierName(modifier), values[0].Type().Code(), param))
+	}
+	value := strings.Join(strs, ",")
+	if q.parser != nil {
+		parsed, err := q.parser.parseParam([]string{q.resourceType}, string(param), value)
+		if err != nil {
+			return q.fail(err)
+		}
+		if t := valueType(parsed); t != values[0].Type() {
+			return q.fail(fmt.Errorf("parameter %s of type %s with values of type %s", param, t.Code(), values[0].Type().Code()))
+		}
+	}
+	q.values.Add(string(param), value)
+	return q
+}
+
+// valueType returns the type of the values of the last link of the parsed parameter.
+func valueType(param *Parameter) fhir.SearchParamType {
+	for param.Chain != nil || param.Has != nil {
+		if param.Chain != nil {
+			param = param.Chain
+		} else {
+			param = &param.Has.Param
+		}
+	}
+	if param.Modifier != nil {
+		switch *param.Modifier {
+		case fhir.SearchModifierCodeText:
+			return fhir.SearchParamTypeString
+		case fhir.SearchModifierCodeIn, fhir.SearchModifierCodeNotIn:
+			return fhir.SearchParamTypeUri
+		case fhir.SearchModifierCodeIdentifier:
+			return fhir.SearchParamTypeToken
+		}
+	}
+	return param.Definition.Type
+}
+
+func applies(modifier fhir.SearchModifierCode, t fhir.SearchParamType) bool {
+	for _, allowed := range modifierTypes[modifier] {
+		if allowed == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Missing adds the parameter with the modifier missing, which matches resources with or without a value.
+func (q *Query) Missing(param Param, missing bool) *Query {
+	param = param.Modifier(fhir.SearchModifierCodeMissing)
+	if q.parser != nil {
+		if _, err := q.parser.parseParam([]string{q.resourceType}, string(param), strconv.FormatBool(missing)); err != nil {
+			return q.fail(err)
+		}
+	}
+	q.values.Add(string(param), strconv.FormatBool(missing))
+	return q
+}
+
+// Include adds the resources the matches reference with the reference parameter of the resource type, like
+// _include=Observation:subject. The target type restricts the included resources and may be empty.
+func (q *Query) Include(resourceType, param, targetType string) *Query {
+	q.values.Add("_include", includeValue(resourceType, param, targetType))
+	return q
+}
+
+// IncludeIterate is like Include, but also includes the resources referenced by included resources.
+func (q *Query) IncludeIterate(resourceType, param, targetType string) *Query {
+	q.values.Add("_include:iterate", includeValue(resourceType, param, targetType))
+	return q
+}
+
+// RevInclude adds the resources of the given type which reference the matches with the reference parameter, like
+// _revinclude=Provenance:target. The target type restricts the referenced resources and may be empty.
+func (q *Query) RevInclude(resourceType, param, targetType string) *Query {
+	q.values.Add("_revinclude", includeValue(resourceType, param, targetType))
+	return q
+}
+
+// RevIncludeIterate is like RevInclude, but also includes the resources referencing included resources.
+func (q *Query) RevIncludeIterate(resourceType, param, targetType string) *Query {
+	q.values.Add("_revinclude:iterate", includeValue(resourceType, param, targetType))
+	return q
+}
+
+func includeValue(resourceType, param, targetType string) string {
+	value := resourceType + ":" + param
+	if targetType != "" {
+		value += ":" + targetType
+	}
+	return value
+}
+
+// Sort sorts the results by the parameters, which are prefixed with - for descending order.
+func (q *Query) Sort(params ...string) *Query {
+	q.values.Set("_sort", strings.Join(params, ","))
+	return q
+}
+
+// Count sets the number of resources per page.
+func (q *Query) Count(count int) *Query {
+	q.values.Set("_count", strconv.Itoa(count))
+	return q
+}
+
+// Summary sets the summary mode of the results: true, text, data, count or false.
+func (q *Query) Summary(mode string) *Query {
+	q.values.Set("_summary", mode)
+	return q
+}
+
+// Elements restricts the elements of the results.
+func (q *Query) Elements(elements ...string) *Query {
+	q.values.Set("_elements", strings.Join(elements, ","))
+	return q
+}
+
+// Total requests the total number of matches: none, estimate or accurate.
+func (q *Query) Total(mode string) *Query {
+	q.values.Set("_total", mode)
+	return q
+}
+
+// Set sets the parameter to the value, which isn't escaped. It is meant for parameters the builder doesn't cover.
+func (q *Query) Set(name, value string) *Query {
+	q.values.Set(name, value)
+	return q
+}
+
+// Values returns the parameters or the first error of the building.
+func (q *Query) Values() (url.Values, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	values := make(url.Values, len(q.values))
+	for key, value := range q.values {
+		values[key] = append([]string(nil), value...)
+	}
+	return values, nil
+}
+
+// Encode returns the URL encoded query string or the first error of the building.
+func (q *Query) Encode() (string, error) {
+	values, err := q.Values()
+	if err != nil {
+		return "", err
+	}
+	return values.Encode(), nil
+}
+
+func (q *Query) fail(err error) *Query {
+	if q.err == nil {
+		q.err = err
+	}
+	return q
+}
+
+type stringValue string
+
+// String returns the value of a string parameter.
+func String(s string) Value {
+	return stringValue(s)
+}
+
+func (v stringValue) Type() fhir.SearchParamType { return fhir.SearchParamTypeString }
+func (v stringValue) String() string             { return Escape(string(v)) }
+
+type uriValue string
+
+// URI returns the value of a uri parameter.
+func URI(uri string) Value {
+	return uriValue(uri)
+}
+
+func (v uriValue) Type() fhir.SearchParamType { return fhir.SearchParamTypeUri }
+func (v uriValue) String() string             { return Escape(string(v)) }
+
+type tokenValue struct {
+	system, code, value string
+	hasSystem, ofType   bool
+}
+
+// Code returns the value of a token parameter matching the code in any system.
+func Code(code string) Value {
+	return tokenValue{code: code}
+}
+
+// Token returns the value of a token parameter matching the code in the system. An empty system matches codes
+// without system and an empty code all codes of the system.
+func Token(system, code string) Value {
+	return tokenValue{system: system, code: code, hasSystem: true}
+}
+
+// OfType returns the value of a token parameter with the modifier of-type, which matches identifiers by the system
+// and code of their type and their value.
+func OfType(system, code, value string) Value {
+	return tokenValue{system: system, code: code, value: value, ofType: true}
+}
+
+func (v tokenValue) Type() fhir.SearchParamType { return fhir.SearchParamTypeToken }
+
+func (v tokenValue) String() string {
+	switch {
+	case v.ofType:
+		return Escape(v.system) + "|" + Escape(v.code) + "|" + Escape(v.value)
+	case v.hasSystem:
+		return Escape(v.system) + "|" + Escape(v.code)
+	default:
+		return Escape(v.code)
+	}
+}
+
+type referenceValue string
+
+// Reference returns the value of a reference parameter, which is an id, a relative reference like Patient/123 or an
+// absolute URL.
+func Reference(reference string) Value {
+	return referenceValue(reference)
+}
+
+// Canonical returns the value of a reference parameter matching a canonical URL with optional version.
+func Canonical(url, version string) Value {
+	if version == "" {
+		return referenceValue(url)
+	}
+	return canonicalValue{url, version}
+}
+
+func (v referenceValue) Type() fhir.SearchParamType { return fhir.SearchParamTypeReference }
+func (v referenceValue) String() string             { return Escape(string(v)) }
+
+type canonicalValue struct {
+	url, version string
+}
+
+func (v canonicalValue) Type() fhir.SearchParamType { return fhir.SearchParamTypeReference }
+func (v canonicalValue) String() string             { return Escape(v.url) + "|" + Escape(v.version) }
+
+// prefix is the optional comparator of ordered values.
+type prefix struct {
+	comparator *fhir.SearchComparator
+}
+
+func (p prefix) String() string {
+	if p.comparator == nil {
+		return ""
+	}
+	return p.comparator.Code()
+}
+
+// NumberValue is the value of a number parameter.
+type NumberValue struct {
+	prefix
+	number string
+}
+
+// Number returns the value of a number parameter. Its precision determines the range of matching values.
+func Number(number string) NumberValue {
+	return NumberValue{number: number}
+}
+
+// Prefix returns the value with the comparator.
+func (v NumberValue) Prefix(comparator fhir.SearchComparator) NumberValue {
+	v.comparator = &comparator
+	return v
+}
+
+func (v NumberValue) Type() fhir.SearchParamType { return fhir.SearchParamTypeNumber }
+func (v NumberValue) String() string             { return v.prefix.String() + Escape(v.number) }
+
+// DateValue is the value of a date parameter.
+type DateValue struct {
+	prefix
+	date string
+}
+
+// Date returns the value of a date parameter, which is a date, partial date or dateTime.
+func Date(date string) DateValue {
+	return DateValue{date: date}
+}
+
+// Prefix returns the value with the comparator.
+func (v DateValue) Prefix(comparator fhir.SearchComparator) DateValue {
+	v.comparator = &comparator
+	return v
+}
+
+func (v DateValue) Type() fhir.SearchParamType { return fhir.SearchParamTypeDate }
+func (v DateValue) String() string             { return v.prefix.String() + Escape(v.date) }
+
+// QuantityValue is the value of a quantity parameter.
+type QuantityValue struct {
+	prefix
+	number, system, code string
+}
+
+// Quantity returns the value of a quantity parameter. Without system, the code matches the code or unit of the
+// quantity and without code, quantities of any unit match.
+func Quantity(number, system, code string) QuantityValue {
+	return QuantityValue{number: number, system: system, code: code}
+}
+
+// Prefix returns the value with the comparator.
+func (v QuantityValue) Prefix(comparator fhir.SearchComparator) QuantityValue {
+	v.comparator = &comparator
+	return v
+}
+
+func (v QuantityValue) Type() fhir.SearchParamType { return fhir.SearchParamTypeQuantity }
+
+func (v QuantityValue) String() string {
+	s := v.prefix.String() + Escape(v.number)
+	if v.system != "" || v.code != "" {
+		s += "|" + Escape(v.system) + "|" + Escape(v.code)
+	}
+	return s
+}
+
+type compositeValue []Value
+
+// Composite returns the value of a composite parameter from the values of its components.
+func Composite(components ...Value) Value {
+	return compositeValue(components)
+}
+
+func (v compositeValue) Type() fhir.SearchParamType { return fhir.SearchParamTypeComposite }
+
+func (v compositeValue) String() string {
+	strs := make([]string, len(v))
+	for i, component := range v {
+		strs[i] = component.String()
+	}
+	return strings.Join(strs, "$")
+}
@@ -0,0 +1,163 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *Query
+		want  url.Values
+	}{
+		{"string", NewQuery().Where("name", String("Smith")), url.Values{"name": {"Smith"}}},
+		{"escaping", NewQuery().Where("name", String(`a,b|c$d\e`)), url.Values{"name": {`a\,b\|c\$d\\e`}}},
+		{"or", NewQuery().Where("name", String("a"), String("b")), url.Values{"name": {"a,b"}}},
+		{"and", NewQuery().Where("name", String("a")).Where("name", String("b")), url.Values{"name": {"a", "b"}}},
+		{"code", NewQuery().Where("gender", Code("male")), url.Values{"gender": {"male"}}},
+		{"token", NewQuery().Where("code", Token("http://loinc.org", "8867-4")), url.Values{"code": {"http://loinc.org|8867-4"}}},
+		{"token without system", NewQuery().Where("code", Token("", "x")), url.Values{"code": {"|x"}}},
+		{"token of system", NewQuery().Where("code", Token("http://loinc.org", "")), url.Values{"code": {"http://loinc.org|"}}},
+		{"of-type", NewQuery().Where(Param("identifier").Modifier(fhir.SearchModifierCodeOfType), OfType("http://terminology.hl7.org/CodeSystem/v2-0203", "MR", "123")),
+			url.Values{"identifier:of-type": {"http://terminology.hl7.org/CodeSystem/v2-0203|MR|123"}}},
+		{"date prefix", NewQuery().Where("birthdate", Date("2020-01-01").Prefix(fhir.SearchComparatorGe), Date("2019")),
+			url.Values{"birthdate": {"ge2020-01-01,2019"}}},
+		{"number", NewQuery().Where("probability", Number("0.8").Prefix(fhir.SearchComparatorGt)), url.Values{"probability": {"gt0.8"}}},
+		{"quantity", NewQuery().Where("value-quantity", Quantity("5.4", "http://unitsofmeasure.org", "mg").Prefix(fhir.SearchComparatorLe)),
+			url.Values{"value-quantity": {"le5.4|http://unitsofmeasure.org|mg"}}},
+		{"quantity without unit", NewQuery().Where("value-quantity", Quantity("5.4", "", "")), url.Values{"value-quantity": {"5.4"}}},
+		{"reference", NewQuery().Where("subject", Reference("Patient/1")), url.Values{"subject": {"Patient/1"}}},
+		{"canonical", NewQuery().Where("profile", Canonical("http://example.org/sd", "1.0")), url.Values{"profile": {"http://example.org/sd|1.0"}}},
+		{"composite", NewQuery().Where("code-value-quantity", Composite(Token("http://loinc.org", "8480-6"), Quantity("150", "", "").Prefix(fhir.SearchComparatorGt))),
+			url.Values{"code-value-quantity": {"http://loinc.org|8480-6$gt150"}}},
+		{"type and chain", NewQuery().Where(Param("subject").Type("Patient").Chain("name"), String("Smith")), url.Values{"subject:Patient.name": {"Smith"}}},
+		{"chained modifier", NewQuery().Where(Param("subject").Chain("name").Modifier(fhir.SearchModifierCodeExact), String("Smith")),
+			url.Values{"subject.name:exact": {"Smith"}}},
+		{"reverse chain", NewQuery().Where(Has("Observation", "patient", "code"), Token("http://loinc.org", "1234-5")),
+			url.Values{"_has:Observation:patient:code": {"http://loinc.org|1234-5"}}},
+		{"text on token", NewQuery().Where(Param("code").Modifier(fhir.SearchModifierCodeText), String("headache")), url.Values{"code:text": {"headache"}}},
+		{"missing", NewQuery().Missing("birthdate", true), url.Values{"birthdate:missing": {"true"}}},
+		{"result parameters", NewQuery().Include("Observation", "subject", "Patient").IncludeIterate("Patient", "organization", "").
+			RevInclude("Provenance", "target", "").RevIncludeIterate("Provenance", "target", "Patient").Sort("-date", "code").Count(10).
+			Summary("count").Elements("id", "code").Total("accurate").Set("_format", "json"),
+			url.Values{"_include": {"Observation:subject:Patient"}, "_include:iterate": {"Patient:organization"},
+				"_revinclude": {"Provenance:target"}, "_revinclude:iterate": {"Provenance:target:Patient"}, "_sort": {"-date,code"},
+				"_count": {"10"}, "_summary": {"count"}, "_elements": {"id,code"}, "_total": {"accurate"}, "_format": {"json"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.query.Values()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestQueryErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *Query
+		err   string
+	}{
+		{"no value", NewQuery().Where("name"), "parameter name without value"},
+		{"mixed types", NewQuery().Where("name", String("a"), Code("b")), "values of type string and token"},
+		{"modifier missing", NewQuery().Where(Param("name").Modifier(fhir.SearchModifierCodeMissing), String("true")), "use Missing"},
+		{"modifier of other type", NewQuery().Where(Param("name").Modifier(fhir.SearchModifierCodeNot), String("a")),
+			"modifier not doesn't accept values of type string"},
+		{"first error", NewQuery().Where("a").Where("b", String("x"), Number("1")), "parameter a without value"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.query.Values(); err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %v, want %s", err, test.err)
+			}
+			if _, err := test.query.Encode(); err == nil {
+				t.Error("Encode returned no error")
+			}
+		})
+	}
+}
+
+func TestQueryValuesAreCopies(t *testing.T) {
+	query := NewQuery().Where("name", String("a"))
+	values, _ := query.Values()
+	values["name"][0] = "b"
+	values.Add("name", "c")
+	if again, _ := query.Values(); !reflect.DeepEqual(again, url.Values{"name": {"a"}}) {
+		t.Errorf("values changed to %v", again)
+	}
+	encoded, err := NewQuery().Where("name", String("a b")).Count(2).Encode()
+	if err != nil || encoded != "_count=2&name=a+b" {
+		t.Errorf("Encode = %s, %v", encoded, err)
+	}
+}
+
+func TestQueryFor(t *testing.T) {
+	parser := NewParser(Definitions()...)
+	query := NewQueryFor(parser, "Observation").
+		Where("code", Token("http://loinc.org", "8867-4")).
+		Where("date", Date("2020-01-01").Prefix(fhir.SearchComparatorGe)).
+		Where(Param("subject").Type("Patient").Chain("name"), String("Smith")).
+		Where(Param("code").Modifier(fhir.SearchModifierCodeText), String("heart")).
+		Where("_id", Code("1")).
+		Missing("value-quantity", false)
+	want := url.Values{"code": {"http://loinc.org|8867-4"}, "date": {"ge2020-01-01"}, "subject:Patient.name": {"Smith"},
+		"code:text": {"heart"}, "_id": {"1"}, "value-quantity:missing": {"false"}}
+	if got, err := query.Values(); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, %v, want %v", got, err, want)
+	}
+	if _, err := NewQueryFor(parser, "Patient").Where(Has("Observation", "patient", "code"), Code("1234-5")).Values(); err != nil {
+		t.Errorf("reverse chain: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query *Query
+		err   string
+	}{
+		{"unknown parameter", NewQueryFor(parser, "Observation").Where("gender", Code("male")), "unknown parameter gender of Observation"},
+		{"other type", NewQueryFor(parser, "Observation").Where("code", String("8867-4")), "parameter code of type token with values of type string"},
+		{"other type in chain", NewQueryFor(parser, "Observation").Where(Param("subject").Type("Patient").Chain("birthdate"), String("2020")),
+			"parameter subject:Patient.birthdate of type date with values of type string"},
+		{"other type in reverse chain", NewQueryFor(parser, "Patient").Where(Has("Observation", "patient", "code"), Date("2020")),
+			"of type token with values of type date"},
+		{"unsupported modifier", NewQueryFor(parser, "Observation").Where(Param("date").Modifier(fhir.SearchModifierCodeExact), String("2020")),
+			"modifier exact doesn't apply to parameter date"},
+		{"chain on token", NewQueryFor(parser, "Observation").Where(Param("code").Chain("name"), String("x")), "chain on parameter code"},
+		{"invalid value", NewQueryFor(parser, "Observation").Where("date", Date("yesterday")), "yesterday"},
+		{"unknown missing", NewQueryFor(parser, "Patient").Missing("code", true), "unknown parameter code of Patient"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.query.Values(); err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %v, want %s", err, test.err)
+			}
+			if _, err := test.query.Encode(); err == nil {
+				t.Error("Encode returned no error")
+			}
+		})
+	}
+}
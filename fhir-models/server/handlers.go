@@ -0,0 +1,535 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/patch"
+	"github.com/samply/golang-fhir-models/fhir-models/search"
+)
+
+func (s *Server) read(w http.ResponseWriter, r *http.Request, resourceType, id string) {
+	resource, err := s.repository.Read(r.Context(), resourceType, id)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	s.writeVersion(w, r, http.StatusOK, resource)
+}
+
+func (s *Server) vread(w http.ResponseWriter, r *http.Request, resourceType, id, version string) {
+	resource, err := s.repository.VRead(r.Context(), resourceType, id, version)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	s.writeVersion(w, r, http.StatusOK, resource)
+}
+
+// writeVersion writes a read resource with its version headers, or only the status 304 if the client has the
+// version given by If-None-Match.
+func (s *Server) writeVersion(w http.ResponseWriter, r *http.Request, status int, resource interface{}) {
+	setVersionHeaders(w, resource)
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" && w.Header().Get("ETag") != "" &&
+		versionId(ifNoneMatch) == versionId(w.Header().Get("ETag")) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	write(w, r, status, resource)
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, config *Resource, resourceType string) {
+	resource, err := readResource(r, resourceType)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if criteria := r.Header.Get("If-None-Exist"); criteria != "" && config.ConditionalCreate {
+		query, err := url.ParseQuery(strings.TrimPrefix(criteria, "?"))
+		if err != nil {
+			writeError(w, r, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "invalid If-None-Exist: %v", err))
+			return
+		}
+		matches, err := s.match(r, resourceType, query)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		switch len(matches) {
+		case 0:
+		case 1:
+			s.writeResult(w, r, http.StatusOK, matches[0])
+			return
+		default:
+			writeError(w, r, NewError(http.StatusPreconditionFailed, fhir.IssueTypeMultipleMatches, "%d resources match If-None-Exist", len(matches)))
+			return
+		}
+	}
+	SetResourceID(resource, "")
+	created, err := s.repository.Create(r.Context(), resource)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	s.writeResult(w, r, http.StatusCreated, created)
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, config *Resource, resourceType, id string) {
+	resource, err := readResource(r, resourceType)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if ResourceID(resource) != id {
+		writeError(w, r, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "the id of the resource has to be %s", id))
+		return
+	}
+	s.store(w, r, config, resource)
+}
+
+// store updates the resource, checking If-Match and, unless the resource type allows update as create, that it
+// exists.
+func (s *Server) store(w http.ResponseWriter, r *http.Request, config *Resource, resource interface{}) {
+	version, ok := s.expectedVersion(w, r, config)
+	if !ok {
+		return
+	}
+	if !config.UpdateCreate {
+		_, err := s.repository.Read(r.Context(), ResourceType(resource), ResourceID(resource))
+		if errors.Is(err, ErrNotFound) {
+			writeError(w, r, NewError(http.StatusMethodNotAllowed, fhir.IssueTypeNotSupported, "%s/%s doesn't exist and the server doesn't allow client defined ids", ResourceType(resource), ResourceID(resource)))
+			return
+		}
+		if err != nil && !errors.Is(err, ErrDeleted) {
+			writeError(w, r, err)
+			return
+		}
+	}
+	updated, created, err := s.repository.Update(r.Context(), resource, version)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	s.writeResult(w, r, status, updated)
+}
+
+// expectedVersion returns the version id of If-Match, which is required for versioned updates.
+func (s *Server) expectedVersion(w http.ResponseWriter, r *http.Request, config *Resource) (string, bool) {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" && config.Versioning != nil && *config.Versioning == fhir.ResourceVersionPolicyVersionedUpdate {
+		writeError(w, r, NewError(http.StatusPreconditionRequired, fhir.IssueTypeRequired, "If-Match is required"))
+		return "", false
+	}
+	return versionId(ifMatch), true
+}
+
+func (s *Server) conditionalUpdate(w http.ResponseWriter, r *http.Request, config *Resource, resourceType string) {
+	resource, err := readResource(r, resourceType)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	matches, err := s.match(r, resourceType, r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	switch len(matches) {
+	case 0:
+		if ResourceID(resource) != "" {
+			s.store(w, r, config, resource)
+			return
+		}
+		created, err := s.repository.Create(r.Context(), resource)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		s.writeResult(w, r, http.StatusCreated, created)
+	case 1:
+		id := ResourceID(matches[0])
+		if ResourceID(resource) != "" && ResourceID(resource) != id {
+			writeError(w, r, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "the id of the resource has to be %s", id))
+			return
+		}
+		SetResourceID(resource, id)
+		s.store(w, r, config, resource)
+	default:
+		writeError(w, r, NewError(http.StatusPreconditionFailed, fhir.IssueTypeMultipleMatches, "%d resources match the criteria", len(matches)))
+	}
+}
+
+func (s *Server) patch(w http.ResponseWriter, r *http.Request, config *Resource, resourceType, id string) {
+	version, ok := s.expectedVersion(w, r, config)
+	if !ok {
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resource, err := s.repository.Read(r.Context(), resourceType, id)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if version == "" {
+		// the patch applies to the version read, so that concurrent updates aren't lost
+		if meta := ResourceMeta(resource); meta != nil && meta.VersionId != nil {
+			version = *meta.VersionId
+		}
+	}
+	contentType := strings.TrimSpace(strings.Split(r.Header.Get("Content-Type"), ";")[0])
+	switch contentType {
+	case "application/json-patch+json":
+		err = patch.ApplyJSONPatch(resource, body)
+	case fhirJSON, "application/json":
+		var parameters fhir.Parameters
+		if parameters, err = fhir.UnmarshalParameters(body); err != nil {
+			err = NewError(http.StatusBadRequest, fhir.IssueTypeStructure, "invalid FHIRPath Patch: %v", err)
+			break
+		}
+		err = patch.ApplyFHIRPathPatch(resource, parameters)
+	default:
+		err = NewError(http.StatusUnsupportedMediaType, fhir.IssueTypeNotSupported, "unsupported patch format %s", contentType)
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if ResourceID(resource) != id || ResourceType(resource) != resourceType {
+		writeError(w, r, NewError(http.StatusUnprocessableEntity, fhir.IssueTypeProcessing, "the patch must not change the type or id of the resource"))
+		return
+	}
+	updated, _, err := s.repository.Update(r.Context(), resource, version)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	s.writeResult(w, r, http.StatusOK, updated)
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request, config *Resource, resourceType, id string) {
+	version, ok := s.expectedVersion(w, r, config)
+	if !ok {
+		return
+	}
+	err := s.repository.Delete(r.Context(), resourceType, id, version)
+	if err != nil && !errors.Is(err, ErrDeleted) {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// conditionalDelete deletes the resources matching the criteria, atomically if the repository is a
+// ConditionalDeleter and multiple resources may be deleted.
+func (s *Server) conditionalDelete(w http.ResponseWriter, r *http.Request, config *Resource, resourceType string) {
+	if deleter, ok := s.repository.(ConditionalDeleter); ok && config.ConditionalDelete == fhir.ConditionalDeleteStatusMultiple {
+		parsed, err := s.criteria(resourceType, r.URL.Query())
+		if err == nil {
+			_, err = deleter.DeleteMatching(r.Context(), parsed)
+		}
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	matches, err := s.match(r, resourceType, r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if len(matches) > 1 && config.ConditionalDelete != fhir.ConditionalDeleteStatusMultiple {
+		writeError(w, r, NewError(http.StatusPreconditionFailed, fhir.IssueTypeMultipleMatches, "%d resources match the criteria", len(matches)))
+		return
+	}
+	for _, match := range matches {
+		if err := s.repository.Delete(r.Context(), resourceType, ResourceID(match), ""); err != nil && !errors.Is(err, ErrDeleted) {
+			writeError(w, r, err)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// match returns all resources of the type matching the criteria of a conditional interaction, which may not contain
+// unknown parameters.
+func (s *Server) match(r *http.Request, resourceType string, criteria url.Values) ([]interface{}, error) {
+	parsed, err := s.criteria(resourceType, criteria)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.repository.Search(r.Context(), parsed)
+	if err != nil {
+		return nil, err
+	}
+	return result.Matches, nil
+}
+
+// criteria parses the criteria of a conditional interaction.
+func (s *Server) criteria(resourceType string, criteria url.Values) (*search.Search, error) {
+	if len(criteria) == 0 {
+		return nil, NewError(http.StatusBadRequest, fhir.IssueTypeRequired, "conditional interaction without criteria")
+	}
+	return s.parser.Parse(resourceType, criteria, search.Strict)
+}
+
+// writeResult writes the result of a create, update or patch with its version headers and location as the Prefer
+// header asks for.
+func (s *Server) writeResult(w http.ResponseWriter, r *http.Request, status int, resource interface{}) {
+	setVersionHeaders(w, resource)
+	location := s.baseURL(r) + "/" + ResourceType(resource) + "/" + ResourceID(resource)
+	if meta := ResourceMeta(resource); meta != nil && meta.VersionId != nil {
+		location += "/_history/" + *meta.VersionId
+	}
+	w.Header().Set("Location", location)
+	switch preference(r, "return") {
+	case "minimal":
+		write(w, r, status, nil)
+	case "OperationOutcome":
+		diagnostics := http.StatusText(status)
+		write(w, r, status, fhir.OperationOutcome{Issue: []fhir.OperationOutcomeIssue{{
+			Severity: fhir.IssueSeverityInformation, Code: fhir.IssueTypeInformational, Diagnostics: &diagnostics,
+		}}})
+	default:
+		write(w, r, status, resource)
+	}
+}
+
+func (s *Server) searchType(w http.ResponseWriter, r *http.Request, resourceType string) {
+	s.search(w, r, resourceType)
+}
+
+func (s *Server) searchSystem(w http.ResponseWriter, r *http.Request) {
+	s.search(w, r, "")
+}
+
+// search runs the search given by the query or, for POST, the form body.
+func (s *Server) search(w http.ResponseWriter, r *http.Request, resourceType string) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, r, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "invalid search: %v", err))
+		return
+	}
+	query := make(url.Values)
+	for name, values := range r.Form {
+		query[name] = values
+	}
+	parsed, err := s.parser.Parse(resourceType, query, search.PreferredHandling(r.Header))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	count := defaultCount
+	if parsed.Count != nil {
+		count = *parsed.Count
+	}
+	parsed.Count = &count
+	result, err := s.repository.Search(r.Context(), parsed)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	base := s.baseURL(r)
+	bundle := fhir.Bundle{Type: fhir.BundleTypeSearchset, Total: &result.Total}
+	bundle.Link = s.pageLinks(r, query, parsed.Offset, count, result.Total)
+	for _, mode := range []fhir.SearchEntryMode{fhir.SearchEntryModeMatch, fhir.SearchEntryModeInclude} {
+		resources := result.Matches
+		if mode == fhir.SearchEntryModeInclude {
+			resources = result.Included
+		}
+		for _, resource := range resources {
+			entry, err := resourceEntry(base, resource)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			mode := mode
+			entry.Search = &fhir.BundleEntrySearch{Mode: &mode}
+			bundle.Entry = append(bundle.Entry, entry)
+		}
+	}
+	if parsed.Outcome != nil {
+		raw, err := json.Marshal(parsed.Outcome)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		mode := fhir.SearchEntryModeOutcome
+		bundle.Entry = append(bundle.Entry, fhir.BundleEntry{Resource: raw, Search: &fhir.BundleEntrySearch{Mode: &mode}})
+	}
+	write(w, r, http.StatusOK, bundle)
+}
+
+// pageLinks returns the links to the current page and the neighbouring ones, which select the page by _offset.
+func (s *Server) pageLinks(r *http.Request, query url.Values, offset, count, total int) []fhir.BundleLink {
+	link := func(relation string, offset int) fhir.BundleLink {
+		q := make(url.Values, len(query)+1)
+		for name, values := range query {
+			q[name] = values
+		}
+		q.Del("_offset")
+		if offset > 0 {
+			q.Set("_offset", strconv.Itoa(offset))
+		}
+		u := s.baseURL(r) + strings.TrimSuffix(r.URL.Path, "/_search")
+		if len(q) > 0 {
+			u += "?" + q.Encode()
+		}
+		return fhir.BundleLink{Relation: relation, Url: u}
+	}
+	links := []fhir.BundleLink{link("self", offset)}
+	if count > 0 && offset+count < total {
+		links = append(links, link("next", offset+count))
+	}
+	if count > 0 && offset > 0 {
+		previous := offset - count
+		if previous < 0 {
+			previous = 0
+		}
+		links = append(links, link("previous", previous))
+	}
+	return links
+}
+
+// resourceEntry returns the Bundle entry of a resource with its full URL.
+func resourceEntry(base string, resource interface{}) (fhir.BundleEntry, error) {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return fhir.BundleEntry{}, err
+	}
+	fullUrl := base + "/" + ResourceType(resource) + "/" + ResourceID(resource)
+	return fhir.BundleEntry{FullUrl: &fullUrl, Resource: raw}, nil
+}
+
+// history writes the history of a resource, a resource type or the system, limited by _since, _count and _offset.
+func (s *Server) history(w http.ResponseWriter, r *http.Request, resourceType, id string) {
+	query := r.URL.Query()
+	var since time.Time
+	if value := query.Get("_since"); value != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339Nano, value); err != nil {
+			writeError(w, r, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "invalid _since %q", value))
+			return
+		}
+	}
+	count, offset := defaultCount, 0
+	for name, target := range map[string]*int{"_count": &count, "_offset": &offset} {
+		if value := query.Get(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				writeError(w, r, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "invalid %s %q", name, value))
+				return
+			}
+			*target = n
+		}
+	}
+	versions, err := s.repository.History(r.Context(), resourceType, id, since)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	total := len(versions)
+	bundle := fhir.Bundle{Type: fhir.BundleTypeHistory, Total: &total}
+	bundle.Link = s.pageLinks(r, query, offset, count, total)
+	if offset > len(versions) {
+		offset = len(versions)
+	}
+	versions = versions[offset:]
+	if count < len(versions) {
+		versions = versions[:count]
+	}
+	base := s.baseURL(r)
+	for _, version := range versions {
+		entry := fhir.BundleEntry{}
+		if version.Resource != nil {
+			if entry, err = resourceEntry(base, version.Resource); err != nil {
+				writeError(w, r, err)
+				return
+			}
+		} else {
+			fullUrl := base + "/" + version.ResourceType + "/" + version.Id
+			entry.FullUrl = &fullUrl
+		}
+		requestUrl := version.ResourceType + "/" + version.Id
+		if version.Method == fhir.HTTPVerbPOST {
+			requestUrl = version.ResourceType
+		}
+		tag := etag(version.VersionId)
+		lastModified := version.LastUpdated.UTC().Format(time.RFC3339Nano)
+		entry.Request = &fhir.BundleEntryRequest{Method: version.Method, Url: requestUrl}
+		entry.Response = &fhir.BundleEntryResponse{Status: versionStatus(version.Method), Etag: &tag, LastModified: &lastModified}
+		bundle.Entry = append(bundle.Entry, entry)
+	}
+	write(w, r, http.StatusOK, bundle)
+}
+
+// versionStatus returns the response status of the interaction which created a version.
+func versionStatus(method fhir.HTTPVerb) string {
+	switch method {
+	case fhir.HTTPVerbPOST:
+		return "201 Created"
+	case fhir.HTTPVerbDELETE:
+		return "204 No Content"
+	}
+	return "200 OK"
+}
+
+// processBundle processes a posted transaction or batch Bundle.
+func (s *Server) processBundle(w http.ResponseWriter, r *http.Request) {
+	resource, err := readResource(r, "Bundle")
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	bundle := resource.(*fhir.Bundle)
+	interaction := fhir.SystemRestfulInteractionBatch
+	switch bundle.Type {
+	case fhir.BundleTypeTransaction:
+		interaction = fhir.SystemRestfulInteractionTransaction
+	case fhir.BundleTypeBatch:
+	default:
+		writeError(w, r, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "expected a transaction or batch but got a %s", bundle.Type.Code()))
+		return
+	}
+	if !s.supportsSystem(interaction) || s.Bundles == nil {
+		s.notAllowed(w, r)
+		return
+	}
+	response, err := s.Bundles.Process(r.Context(), *bundle)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	write(w, r, http.StatusOK, response)
+}
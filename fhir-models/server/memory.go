@@ -0,0 +1,686 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/search"
+)
+
+// Policy configures how a Memory repository versions and conditionally deletes the resources of a type.
+type Policy struct {
+	Versioning        fhir.ResourceVersionPolicy
+	ConditionalDelete fhir.ConditionalDeleteStatus
+}
+
+// defaultPolicy applies to resource types without policy.
+var defaultPolicy = Policy{
+	Versioning:        fhir.ResourceVersionPolicyVersioned,
+	ConditionalDelete: fhir.ConditionalDeleteStatusMultiple,
+}
+
+// ConditionalDeleter is implemented by repositories which delete the resources matching a search atomically. The
+// server uses it for conditional deletes if the repository implements it.
+type ConditionalDeleter interface {
+	// DeleteMatching deletes the resources matching the search and returns their number.
+	DeleteMatching(ctx context.Context, s *search.Search) (int, error)
+}
+
+// Memory is a Repository keeping all versions of the resources in memory, which is safe for concurrent use. Resources
+// are copied on the way in and out, so callers may modify them freely.
+//
+// With a directory, every change is appended to the file <type>.ndjson in it as history Bundle entry, from which a
+// new Memory on the same directory restores its state.
+type Memory struct {
+	index *search.Index
+	dir   string
+
+	policies map[string]Policy
+
+	mu sync.RWMutex
+	// log contains all versions in the order they were stored
+	log []*version
+	// versions contains the versions per type and id, oldest first
+	versions map[string][]*version
+	files    map[string]*os.File
+	// transaction is set while a transaction runs, which writes its versions to the files when it commits
+	transaction bool
+}
+
+// version is a stored version with the resource as JSON.
+type version struct {
+	Version
+	raw []byte
+	// superseded versions of non-versioned resources aren't part of the history
+	superseded bool
+}
+
+// MemoryOptions configure a Memory repository.
+type MemoryOptions struct {
+	// Dir is the directory the changes are written to and restored from, which is created if it doesn't exist. The
+	// repository is kept in memory only if Dir is empty.
+	Dir string
+	// Policies are the policies per resource type, which default to versioned with conditional deletes of multiple
+	// resources. They also apply to the versions restored from Dir.
+	Policies map[string]Policy
+}
+
+// NewMemory returns an empty repository searching with the parser or, if options.Dir isn't empty, one restored from
+// the files in the directory.
+func NewMemory(parser *search.Parser, options MemoryOptions) (*Memory, error) {
+	index, err := search.NewIndex(parser)
+	if err != nil {
+		return nil, err
+	}
+	m := &Memory{
+		index:    index,
+		dir:      options.Dir,
+		policies: make(map[string]Policy, len(options.Policies)),
+		versions: make(map[string][]*version),
+		files:    make(map[string]*os.File),
+	}
+	for resourceType, policy := range options.Policies {
+		m.policies[resourceType] = policy
+	}
+	if m.dir != "" {
+		if err := m.restore(); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *Memory) policy(resourceType string) Policy {
+	if policy, ok := m.policies[resourceType]; ok {
+		return policy
+	}
+	return defaultPolicy
+}
+
+// Close closes the files of the repository.
+func (m *Memory) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var err error
+	for name, f := range m.files {
+		if e := f.Close(); e != nil && err == nil {
+			err = e
+		}
+		delete(m.files, name)
+	}
+	return err
+}
+
+// Read returns the current version of the resource.
+func (m *Memory) Read(_ context.Context, resourceType, id string) (interface{}, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.read(resourceType, id)
+}
+
+func (m *Memory) read(resourceType, id string) (interface{}, error) {
+	current, err := m.current(resourceType, id)
+	if err != nil {
+		return nil, err
+	}
+	return fhir.DecodeResource(current.raw)
+}
+
+// VRead returns the version of the resource. Only the current version of non-versioned resources is kept.
+func (m *Memory) VRead(_ context.Context, resourceType, id, versionId string) (interface{}, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.vread(resourceType, id, versionId)
+}
+
+func (m *Memory) vread(resourceType, id, versionId string) (interface{}, error) {
+	for _, v := range m.versions[key(resourceType, id)] {
+		if v.VersionId != versionId || v.superseded {
+			continue
+		}
+		if v.Resource == nil {
+			return nil, fmt.Errorf("%s/%s/_history/%s: %w", resourceType, id, versionId, ErrDeleted)
+		}
+		return fhir.DecodeResource(v.raw)
+	}
+	return nil, fmt.Errorf("%s/%s/_history/%s: %w", resourceType, id, versionId, ErrNotFound)
+}
+
+// Create stores the resource with its id or, if it has none, a new random id.
+func (m *Memory) Create(_ context.Context, resource interface{}) (interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.create(resource)
+}
+
+func (m *Memory) create(resource interface{}) (interface{}, error) {
+	resource, err := copyResource(resource)
+	if err != nil {
+		return nil, err
+	}
+	id := ResourceID(resource)
+	if id != "" && m.versions[key(ResourceType(resource), id)] != nil {
+		return nil, NewError(http.StatusConflict, fhir.IssueTypeDuplicate, "%s/%s already exists", ResourceType(resource), id)
+	}
+	for id == "" {
+		if id, err = NewID(); err != nil {
+			return nil, err
+		}
+		if m.versions[key(ResourceType(resource), id)] != nil {
+			id = ""
+		}
+	}
+	SetResourceID(resource, id)
+	return m.store(resource, fhir.HTTPVerbPOST)
+}
+
+// Update stores a new version of the resource. Resources of types with the policy versioned-update require the
+// version id.
+func (m *Memory) Update(_ context.Context, resource interface{}, versionId string) (interface{}, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.update(resource, versionId)
+}
+
+func (m *Memory) update(resource interface{}, versionId string) (interface{}, bool, error) {
+	resource, err := copyResource(resource)
+	if err != nil {
+		return nil, false, err
+	}
+	resourceType, id := ResourceType(resource), ResourceID(resource)
+	if id == "" {
+		return nil, false, NewError(http.StatusBadRequest, fhir.IssueTypeRequired, "%s without id", resourceType)
+	}
+	if err := m.checkVersion(resourceType, id, versionId); err != nil {
+		return nil, false, err
+	}
+	_, err = m.current(resourceType, id)
+	created := err != nil
+	resource, err = m.store(resource, fhir.HTTPVerbPUT)
+	return resource, created, err
+}
+
+// Delete deletes the resource. Resources of types with the policy versioned-update require the version id.
+func (m *Memory) Delete(_ context.Context, resourceType, id, versionId string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.remove(resourceType, id, versionId)
+}
+
+func (m *Memory) remove(resourceType, id, versionId string) error {
+	if err := m.checkVersion(resourceType, id, versionId); err != nil {
+		return err
+	}
+	return m.delete(resourceType, id)
+}
+
+// DeleteMatching deletes the resources matching the search as the conditional delete policy of the type allows.
+func (m *Memory) DeleteMatching(_ context.Context, s *search.Search) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	policy := m.policy(s.ResourceType)
+	if policy.ConditionalDelete == fhir.ConditionalDeleteStatusNotSupported {
+		return 0, NewError(http.StatusMethodNotAllowed, fhir.IssueTypeNotSupported, "conditional delete of %s isn't supported", s.ResourceType)
+	}
+	unlimited := *s
+	unlimited.Count, unlimited.Offset = nil, 0
+	result, err := m.index.Search(&unlimited)
+	if err != nil {
+		return 0, err
+	}
+	if len(result.Matches) > 1 && policy.ConditionalDelete == fhir.ConditionalDeleteStatusSingle {
+		return 0, NewError(http.StatusPreconditionFailed, fhir.IssueTypeMultipleMatches, "%d resources match the criteria", len(result.Matches))
+	}
+	for _, match := range result.Matches {
+		if err := m.delete(ResourceType(match), ResourceID(match)); err != nil {
+			return 0, err
+		}
+	}
+	return len(result.Matches), nil
+}
+
+// Transaction runs fn with a repository whose changes are discarded if fn fails or they can't be written to the
+// directory. Other users of the repository wait until the transaction completes, so fn must only use the given
+// repository.
+func (m *Memory) Transaction(_ context.Context, fn func(Repository) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	start := len(m.log)
+	m.transaction = true
+	err := fn(memoryTransaction{m})
+	m.transaction = false
+	if err != nil {
+		m.rollback(start)
+		return err
+	}
+	if m.dir != "" {
+		if err := m.write(m.log[start:]...); err != nil {
+			m.rollback(start)
+			return err
+		}
+	}
+	return nil
+}
+
+// rollback removes the versions stored since the log had the given length and restores the index.
+func (m *Memory) rollback(length int) {
+	changed := make(map[string]*version)
+	for i := len(m.log) - 1; i >= length; i-- {
+		v := m.log[i]
+		k := key(v.ResourceType, v.Id)
+		versions := m.versions[k]
+		if versions = versions[:len(versions)-1]; len(versions) == 0 {
+			delete(m.versions, k)
+		} else {
+			m.versions[k] = versions
+		}
+		changed[k] = v
+	}
+	m.log = m.log[:length]
+	for k, v := range changed {
+		if versions := m.versions[k]; len(versions) > 0 {
+			versions[len(versions)-1].superseded = false
+		}
+		m.reindex(v.ResourceType, v.Id)
+	}
+}
+
+// reindex indexes the current version of the resource or removes it from the index if there is none.
+func (m *Memory) reindex(resourceType, id string) {
+	current, err := m.current(resourceType, id)
+	if err != nil {
+		m.index.Remove(resourceType, id)
+		return
+	}
+	if resource, err := fhir.DecodeResource(current.raw); err == nil {
+		_ = m.index.Add(resource)
+	}
+}
+
+// memoryTransaction is the repository of a transaction of a Memory, whose lock it holds.
+type memoryTransaction struct {
+	m *Memory
+}
+
+func (t memoryTransaction) Read(_ context.Context, resourceType, id string) (interface{}, error) {
+	return t.m.read(resourceType, id)
+}
+
+func (t memoryTransaction) VRead(_ context.Context, resourceType, id, versionId string) (interface{}, error) {
+	return t.m.vread(resourceType, id, versionId)
+}
+
+func (t memoryTransaction) Create(_ context.Context, resource interface{}) (interface{}, error) {
+	return t.m.create(resource)
+}
+
+func (t memoryTransaction) Update(_ context.Context, resource interface{}, versionId string) (interface{}, bool, error) {
+	return t.m.update(resource, versionId)
+}
+
+func (t memoryTransaction) Delete(_ context.Context, resourceType, id, versionId string) error {
+	return t.m.remove(resourceType, id, versionId)
+}
+
+func (t memoryTransaction) History(_ context.Context, resourceType, id string, since time.Time) ([]Version, error) {
+	return t.m.history(resourceType, id, since)
+}
+
+func (t memoryTransaction) Search(_ context.Context, s *search.Search) (*search.Result, error) {
+	return t.m.search(s)
+}
+
+// History returns the versions newest first.
+func (m *Memory) History(_ context.Context, resourceType, id string, since time.Time) ([]Version, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.history(resourceType, id, since)
+}
+
+func (m *Memory) history(resourceType, id string, since time.Time) ([]Version, error) {
+	if id != "" && m.versions[key(resourceType, id)] == nil {
+		return nil, fmt.Errorf("%s/%s: %w", resourceType, id, ErrNotFound)
+	}
+	var versions []Version
+	for i := len(m.log) - 1; i >= 0; i-- {
+		v := m.log[i]
+		if v.superseded || (resourceType != "" && v.ResourceType != resourceType) || (id != "" && v.Id != id) {
+			continue
+		}
+		if !since.IsZero() && v.LastUpdated.Before(since) {
+			continue
+		}
+		result := v.Version
+		if v.Resource != nil {
+			resource, err := fhir.DecodeResource(v.raw)
+			if err != nil {
+				return nil, err
+			}
+			result.Resource = resource
+		}
+		versions = append(versions, result)
+	}
+	return versions, nil
+}
+
+// Search returns copies of the current versions matching the search.
+func (m *Memory) Search(_ context.Context, s *search.Search) (*search.Result, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.search(s)
+}
+
+func (m *Memory) search(s *search.Search) (*search.Result, error) {
+	result, err := m.index.Search(s)
+	if err != nil {
+		return nil, err
+	}
+	for _, resources := range [][]interface{}{result.Matches, result.Included} {
+		for i, resource := range resources {
+			if resources[i], err = copyResource(resource); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// current returns the current version of the resource.
+func (m *Memory) current(resourceType, id string) (*version, error) {
+	versions := m.versions[key(resourceType, id)]
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("%s/%s: %w", resourceType, id, ErrNotFound)
+	}
+	current := versions[len(versions)-1]
+	if current.Resource == nil {
+		return nil, fmt.Errorf("%s/%s: %w", resourceType, id, ErrDeleted)
+	}
+	return current, nil
+}
+
+// checkVersion checks the expected version id, which resources of types with the policy versioned-update require.
+func (m *Memory) checkVersion(resourceType, id, versionId string) error {
+	policy := m.policy(resourceType)
+	if versionId == "" {
+		if policy.Versioning == fhir.ResourceVersionPolicyVersionedUpdate {
+			return NewError(http.StatusPreconditionRequired, fhir.IssueTypeRequired, "the version of %s/%s is required", resourceType, id)
+		}
+		return nil
+	}
+	versions := m.versions[key(resourceType, id)]
+	if len(versions) == 0 || versions[len(versions)-1].VersionId != versionId {
+		return fmt.Errorf("%s/%s isn't at version %s: %w", resourceType, id, versionId, ErrVersionConflict)
+	}
+	return nil
+}
+
+// store stores a new version of the resource, which has to be a copy, with the next version id and returns another
+// copy.
+func (m *Memory) store(resource interface{}, method fhir.HTTPVerb) (interface{}, error) {
+	resourceType, id := ResourceType(resource), ResourceID(resource)
+	v := m.next(resourceType, id, method)
+	meta := ResourceMeta(resource)
+	if meta == nil {
+		return nil, fmt.Errorf("%s has no meta", resourceType)
+	}
+	lastUpdated := v.LastUpdated.Format(time.RFC3339Nano)
+	meta.LastUpdated = &lastUpdated
+	meta.VersionId = nil
+	if m.policy(resourceType).Versioning != fhir.ResourceVersionPolicyNoVersion {
+		meta.VersionId = &v.VersionId
+	}
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	v.Resource, v.raw = resource, raw
+	if err := m.index.Add(resource); err != nil {
+		return nil, err
+	}
+	if err := m.append(v); err != nil {
+		m.reindex(resourceType, id)
+		return nil, err
+	}
+	return fhir.DecodeResource(raw)
+}
+
+// delete marks the resource as deleted.
+func (m *Memory) delete(resourceType, id string) error {
+	if _, err := m.current(resourceType, id); err != nil {
+		return err
+	}
+	if err := m.append(m.next(resourceType, id, fhir.HTTPVerbDELETE)); err != nil {
+		return err
+	}
+	m.index.Remove(resourceType, id)
+	return nil
+}
+
+// next returns the next version of the resource.
+func (m *Memory) next(resourceType, id string, method fhir.HTTPVerb) *version {
+	versionId := 1
+	if versions := m.versions[key(resourceType, id)]; len(versions) > 0 {
+		last, _ := strconv.Atoi(versions[len(versions)-1].VersionId)
+		versionId = last + 1
+	}
+	return &version{Version: Version{
+		ResourceType: resourceType,
+		Id:           id,
+		VersionId:    strconv.Itoa(versionId),
+		LastUpdated:  time.Now().UTC(),
+		Method:       method,
+	}}
+}
+
+// append writes the version to the file of its type and adds it to the history.
+func (m *Memory) append(v *version) error {
+	if m.dir != "" && !m.transaction {
+		if err := m.write(v); err != nil {
+			return err
+		}
+	}
+	m.add(v)
+	return nil
+}
+
+// add adds the version to the history, superseding the earlier versions of non-versioned resources.
+func (m *Memory) add(v *version) {
+	k := key(v.ResourceType, v.Id)
+	if m.policy(v.ResourceType).Versioning == fhir.ResourceVersionPolicyNoVersion {
+		for _, earlier := range m.versions[k] {
+			earlier.superseded = true
+		}
+	}
+	m.versions[k] = append(m.versions[k], v)
+	m.log = append(m.log, v)
+}
+
+// write appends the versions as history Bundle entries to the files of their types. If it fails, it truncates the
+// files to their previous size, so they contain either all versions or none.
+func (m *Memory) write(versions ...*version) error {
+	sizes := make(map[*os.File]int64)
+	err := m.writeAll(versions, sizes)
+	if err != nil {
+		for f, size := range sizes {
+			_ = f.Truncate(size)
+		}
+	}
+	return err
+}
+
+// writeAll appends the versions to the files of their types, recording the size of each file before its first write.
+func (m *Memory) writeAll(versions []*version, sizes map[*os.File]int64) error {
+	for _, v := range versions {
+		f, err := m.file(v.ResourceType)
+		if err != nil {
+			return err
+		}
+		if _, ok := sizes[f]; !ok {
+			info, err := f.Stat()
+			if err != nil {
+				return err
+			}
+			sizes[f] = info.Size()
+		}
+		if err := writeVersion(f, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// file returns the file of the resource type, opening it if necessary.
+func (m *Memory) file(resourceType string) (*os.File, error) {
+	if f, ok := m.files[resourceType]; ok {
+		return f, nil
+	}
+	f, err := os.OpenFile(filepath.Join(m.dir, resourceType+".ndjson"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	m.files[resourceType] = f
+	return f, nil
+}
+
+// writeVersion appends the version as history Bundle entry to the file.
+func writeVersion(f *os.File, v *version) error {
+	fullUrl := v.ResourceType + "/" + v.Id
+	tag := etag(v.VersionId)
+	lastModified := v.LastUpdated.Format(time.RFC3339Nano)
+	line, err := json.Marshal(fhir.BundleEntry{
+		FullUrl:  &fullUrl,
+		Resource: v.raw,
+		Request:  &fhir.BundleEntryRequest{Method: v.Method, Url: fullUrl},
+		Response: &fhir.BundleEntryResponse{Status: versionStatus(v.Method), Etag: &tag, LastModified: &lastModified},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
+
+// restore reads the versions in the files of the directory.
+func (m *Memory) restore() error {
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+	names, err := filepath.Glob(filepath.Join(m.dir, "*.ndjson"))
+	if err != nil {
+		return err
+	}
+	var versions []*version
+	for _, name := range names {
+		read, err := readVersions(name)
+		if err != nil {
+			return err
+		}
+		versions = append(versions, read...)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].LastUpdated.Before(versions[j].LastUpdated)
+	})
+	for _, v := range versions {
+		m.add(v)
+		if v.Resource == nil {
+			m.index.Remove(v.ResourceType, v.Id)
+		} else if err := m.index.Add(v.Resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readVersions reads the versions written to a file.
+func readVersions(name string) ([]*version, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var versions []*version
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var entry fhir.BundleEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", name, line, err)
+		}
+		if entry.FullUrl == nil || entry.Request == nil || entry.Response == nil || entry.Response.Etag == nil ||
+			entry.Response.LastModified == nil {
+			return nil, fmt.Errorf("%s:%d: incomplete entry", name, line)
+		}
+		resourceType, id, _ := strings.Cut(*entry.FullUrl, "/")
+		lastUpdated, err := time.Parse(time.RFC3339Nano, *entry.Response.LastModified)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", name, line, err)
+		}
+		v := &version{Version: Version{
+			ResourceType: resourceType,
+			Id:           id,
+			VersionId:    versionId(*entry.Response.Etag),
+			LastUpdated:  lastUpdated,
+			Method:       entry.Request.Method,
+		}}
+		if entry.Request.Method != fhir.HTTPVerbDELETE {
+			if v.Resource, err = fhir.DecodeResource(entry.Resource); err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", name, line, err)
+			}
+			v.raw = entry.Resource
+		}
+		versions = append(versions, v)
+	}
+	return versions, scanner.Err()
+}
+
+// copyResource returns a deep copy of the resource.
+func copyResource(resource interface{}) (interface{}, error) {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	return fhir.DecodeResource(raw)
+}
+
+// NewID returns a random id for a new resource.
+func NewID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func key(resourceType, id string) string {
+	return resourceType + "/" + id
+}
@@ -0,0 +1,395 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/search"
+)
+
+var testParser = search.NewParser(search.Definitions()...)
+
+func newMemory(t *testing.T, options MemoryOptions) *Memory {
+	t.Helper()
+	m, err := NewMemory(testParser, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = m.Close() })
+	return m
+}
+
+func patient(id, family string) *fhir.Patient {
+	return &fhir.Patient{Id: stringPtr(id), Name: []fhir.HumanName{{Family: stringPtr(family)}}}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// searchFamily returns the ids of the patients with the family name.
+func searchFamily(t *testing.T, repository Repository, family string) []string {
+	t.Helper()
+	s, err := testParser.Parse("Patient", url.Values{"family": {family}}, search.Strict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := repository.Search(context.Background(), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, match := range result.Matches {
+		ids = append(ids, ResourceID(match))
+	}
+	return ids
+}
+
+func statusCode(err error) int {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.StatusCode
+	}
+	return 0
+}
+
+func TestMemoryConcurrency(t *testing.T) {
+	ctx := context.Background()
+	m := newMemory(t, MemoryOptions{})
+	const writers, updates = 8, 5
+
+	var wg sync.WaitGroup
+	errs := make(chan error, writers*(updates+2))
+	for i := 0; i < writers; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			id := fmt.Sprintf("p%d", i)
+			if _, err := m.Create(ctx, patient(id, "Doe")); err != nil {
+				errs <- err
+				return
+			}
+			for j := 0; j < updates; j++ {
+				if _, _, err := m.Update(ctx, patient(id, "Doe"), ""); err != nil {
+					errs <- err
+				}
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < updates; j++ {
+				if _, err := m.History(ctx, "Patient", "", time.Time{}); err != nil {
+					errs <- err
+				}
+				s, _ := testParser.Parse("Patient", url.Values{"family": {"Doe"}}, search.Strict)
+				if _, err := m.Search(ctx, s); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	for i := 0; i < writers; i++ {
+		resource, err := m.Read(ctx, "Patient", fmt.Sprintf("p%d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := *resource.(*fhir.Patient).Meta.VersionId; got != fmt.Sprint(updates+1) {
+			t.Errorf("p%d has version %s", i, got)
+		}
+	}
+	history, _ := m.History(ctx, "", "", time.Time{})
+	if len(history) != writers*(updates+1) {
+		t.Errorf("system history has %d versions, want %d", len(history), writers*(updates+1))
+	}
+	if ids := searchFamily(t, m, "Doe"); len(ids) != writers {
+		t.Errorf("search found %v", ids)
+	}
+}
+
+func TestMemoryHistory(t *testing.T) {
+	ctx := context.Background()
+	m := newMemory(t, MemoryOptions{})
+	if _, err := m.Create(ctx, patient("p1", "Doe")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Create(ctx, patient("p2", "Roe")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Create(ctx, &fhir.Observation{Id: stringPtr("o1")}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+	since := time.Now().UTC()
+	if _, _, err := m.Update(ctx, patient("p1", "Poe"), "1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Delete(ctx, "Patient", "p2", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	type entry struct {
+		id, versionId string
+		method        fhir.HTTPVerb
+	}
+	tests := []struct {
+		name             string
+		resourceType, id string
+		since            time.Time
+		want             []entry
+	}{
+		{"instance", "Patient", "p1", time.Time{}, []entry{
+			{"p1", "2", fhir.HTTPVerbPUT}, {"p1", "1", fhir.HTTPVerbPOST},
+		}},
+		{"deleted instance", "Patient", "p2", time.Time{}, []entry{
+			{"p2", "2", fhir.HTTPVerbDELETE}, {"p2", "1", fhir.HTTPVerbPOST},
+		}},
+		{"type", "Patient", "", time.Time{}, []entry{
+			{"p2", "2", fhir.HTTPVerbDELETE}, {"p1", "2", fhir.HTTPVerbPUT},
+			{"p2", "1", fhir.HTTPVerbPOST}, {"p1", "1", fhir.HTTPVerbPOST},
+		}},
+		{"system", "", "", time.Time{}, []entry{
+			{"p2", "2", fhir.HTTPVerbDELETE}, {"p1", "2", fhir.HTTPVerbPUT}, {"o1", "1", fhir.HTTPVerbPOST},
+			{"p2", "1", fhir.HTTPVerbPOST}, {"p1", "1", fhir.HTTPVerbPOST},
+		}},
+		{"system since", "", "", since, []entry{
+			{"p2", "2", fhir.HTTPVerbDELETE}, {"p1", "2", fhir.HTTPVerbPUT},
+		}},
+		{"type without versions", "Encounter", "", time.Time{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions, err := m.History(ctx, tt.resourceType, tt.id, tt.since)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []entry
+			for _, v := range versions {
+				got = append(got, entry{v.Id, v.VersionId, v.Method})
+				if (v.Resource == nil) != (v.Method == fhir.HTTPVerbDELETE) {
+					t.Errorf("%s/%s has resource %v", v.Id, v.VersionId, v.Resource)
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := m.History(ctx, "Patient", "p3", time.Time{}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("history of missing resource returned %v", err)
+	}
+	resource, err := m.VRead(ctx, "Patient", "p1", "1")
+	if err != nil || *resource.(*fhir.Patient).Name[0].Family != "Doe" {
+		t.Errorf("VRead = %v, %v", resource, err)
+	}
+	if _, err := m.VRead(ctx, "Patient", "p2", "2"); !errors.Is(err, ErrDeleted) {
+		t.Errorf("VRead of deletion returned %v", err)
+	}
+	if _, err := m.Read(ctx, "Patient", "p2"); !errors.Is(err, ErrDeleted) {
+		t.Errorf("Read of deleted resource returned %v", err)
+	}
+}
+
+func TestMemoryVersioningPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no-version", func(t *testing.T) {
+		m := newMemory(t, MemoryOptions{Policies: map[string]Policy{
+			"Patient": {Versioning: fhir.ResourceVersionPolicyNoVersion},
+		}})
+		if _, err := m.Create(ctx, patient("p1", "Doe")); err != nil {
+			t.Fatal(err)
+		}
+		resource, _, err := m.Update(ctx, patient("p1", "Roe"), "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta := resource.(*fhir.Patient).Meta; meta.VersionId != nil || meta.LastUpdated == nil {
+			t.Errorf("meta = %v", meta)
+		}
+		if versions, _ := m.History(ctx, "Patient", "p1", time.Time{}); len(versions) != 1 {
+			t.Errorf("history has %d versions", len(versions))
+		}
+		if _, err := m.VRead(ctx, "Patient", "p1", "1"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("VRead of superseded version returned %v", err)
+		}
+	})
+
+	t.Run("versioned-update", func(t *testing.T) {
+		m := newMemory(t, MemoryOptions{Policies: map[string]Policy{
+			"Patient": {Versioning: fhir.ResourceVersionPolicyVersionedUpdate},
+		}})
+		if _, err := m.Create(ctx, patient("p1", "Doe")); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := m.Update(ctx, patient("p1", "Roe"), ""); statusCode(err) != http.StatusPreconditionRequired {
+			t.Errorf("update without version returned %v", err)
+		}
+		if _, _, err := m.Update(ctx, patient("p1", "Roe"), "2"); !errors.Is(err, ErrVersionConflict) {
+			t.Errorf("update of wrong version returned %v", err)
+		}
+		if err := m.Delete(ctx, "Patient", "p1", ""); statusCode(err) != http.StatusPreconditionRequired {
+			t.Errorf("delete without version returned %v", err)
+		}
+		resource, created, err := m.Update(ctx, patient("p1", "Roe"), "1")
+		if err != nil || created || *resource.(*fhir.Patient).Meta.VersionId != "2" {
+			t.Fatalf("Update = %v, %v, %v", resource, created, err)
+		}
+		if err := m.Delete(ctx, "Patient", "p1", "2"); err != nil {
+			t.Error(err)
+		}
+		if _, _, err := m.Update(ctx, &fhir.Observation{Id: stringPtr("o1")}, ""); err != nil {
+			t.Errorf("update of other type returned %v", err)
+		}
+	})
+
+	t.Run("restored", func(t *testing.T) {
+		options := MemoryOptions{Dir: t.TempDir(), Policies: map[string]Policy{
+			"Patient": {Versioning: fhir.ResourceVersionPolicyNoVersion},
+		}}
+		m := newMemory(t, options)
+		if _, err := m.Create(ctx, patient("p1", "Doe")); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := m.Update(ctx, patient("p1", "Roe"), ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		restored := newMemory(t, options)
+		if versions, _ := restored.History(ctx, "Patient", "p1", time.Time{}); len(versions) != 1 {
+			t.Errorf("restored history has %d versions", len(versions))
+		}
+		if ids := searchFamily(t, restored, "Roe"); len(ids) != 1 {
+			t.Errorf("restored search found %v", ids)
+		}
+	})
+}
+
+func TestMemoryDeleteMatching(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		policy  fhir.ConditionalDeleteStatus
+		family  string
+		deleted int
+		status  int
+	}{
+		{"multiple", fhir.ConditionalDeleteStatusMultiple, "Doe", 2, 0},
+		{"single with one match", fhir.ConditionalDeleteStatusSingle, "Roe", 1, 0},
+		{"single with two matches", fhir.ConditionalDeleteStatusSingle, "Doe", 0, http.StatusPreconditionFailed},
+		{"no match", fhir.ConditionalDeleteStatusSingle, "Poe", 0, 0},
+		{"not supported", fhir.ConditionalDeleteStatusNotSupported, "Roe", 0, http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMemory(t, MemoryOptions{Policies: map[string]Policy{
+				"Patient": {Versioning: fhir.ResourceVersionPolicyVersioned, ConditionalDelete: tt.policy},
+			}})
+			for _, p := range []*fhir.Patient{patient("p1", "Doe"), patient("p2", "Doe"), patient("p3", "Roe")} {
+				if _, err := m.Create(ctx, p); err != nil {
+					t.Fatal(err)
+				}
+			}
+			s, err := testParser.Parse("Patient", url.Values{"family": {tt.family}, "_count": {"1"}}, search.Strict)
+			if err != nil {
+				t.Fatal(err)
+			}
+			deleted, err := m.DeleteMatching(ctx, s)
+			if statusCode(err) != tt.status || (tt.status == 0 && err != nil) {
+				t.Fatalf("DeleteMatching returned %v, want status %d", err, tt.status)
+			}
+			if deleted != tt.deleted {
+				t.Errorf("deleted %d, want %d", deleted, tt.deleted)
+			}
+			if ids := searchFamily(t, m, tt.family); len(ids) != 0 && tt.status == 0 {
+				t.Errorf("search after delete found %v", ids)
+			}
+		})
+	}
+}
+
+func TestMemoryTransaction(t *testing.T) {
+	ctx := context.Background()
+	m := newMemory(t, MemoryOptions{Dir: t.TempDir()})
+	if _, err := m.Create(ctx, patient("p1", "Doe")); err != nil {
+		t.Fatal(err)
+	}
+
+	failure := errors.New("failure")
+	err := m.Transaction(ctx, func(r Repository) error {
+		if _, _, err := r.Update(ctx, patient("p1", "Roe"), ""); err != nil {
+			return err
+		}
+		if _, err := r.Create(ctx, patient("p2", "Roe")); err != nil {
+			return err
+		}
+		return failure
+	})
+	if err != failure {
+		t.Fatalf("Transaction returned %v", err)
+	}
+	assertUnchanged := func(t *testing.T) {
+		t.Helper()
+		if ids := searchFamily(t, m, "Roe"); len(ids) != 0 {
+			t.Errorf("search found %v", ids)
+		}
+		if ids := searchFamily(t, m, "Doe"); len(ids) != 1 {
+			t.Errorf("search found %v", ids)
+		}
+		if _, err := m.Read(ctx, "Patient", "p2"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Read of rolled back resource returned %v", err)
+		}
+		if versions, _ := m.History(ctx, "", "", time.Time{}); len(versions) != 1 {
+			t.Errorf("history has %d versions", len(versions))
+		}
+	}
+	assertUnchanged(t)
+
+	// Closing the file makes the writes fail.
+	if err := m.files["Patient"].Close(); err != nil {
+		t.Fatal(err)
+	}
+	err = m.Transaction(ctx, func(r Repository) error {
+		_, err := r.Create(ctx, patient("p2", "Roe"))
+		return err
+	})
+	if err == nil {
+		t.Fatal("Transaction with failing write succeeded")
+	}
+	assertUnchanged(t)
+
+	if _, _, err := m.Update(ctx, patient("p1", "Roe"), ""); err == nil {
+		t.Fatal("Update with failing write succeeded")
+	}
+	assertUnchanged(t)
+}
@@ -0,0 +1,139 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/search"
+)
+
+// Repository stores the resources of a server. Resources are pointers to generated resources like *fhir.Patient.
+// Implementations report missing resources with ErrNotFound, deleted ones with ErrDeleted and versions not matching
+// the expected one with ErrVersionConflict, which may be wrapped.
+type Repository interface {
+	// Read returns the current version of the resource.
+	Read(ctx context.Context, resourceType, id string) (interface{}, error)
+	// VRead returns the version of the resource.
+	VRead(ctx context.Context, resourceType, id, versionId string) (interface{}, error)
+	// Create stores the resource with its id or, if it has none, a new id and returns it with its id, version id and
+	// last updated set.
+	Create(ctx context.Context, resource interface{}) (interface{}, error)
+	// Update stores a new version of the resource with its id, creating it if it doesn't exist, and returns it like
+	// Create and whether it was created. Unless versionId is empty, it has to be the one of the current version.
+	Update(ctx context.Context, resource interface{}, versionId string) (interface{}, bool, error)
+	// Delete deletes the resource. Unless versionId is empty, it has to be the one of the current version.
+	Delete(ctx context.Context, resourceType, id, versionId string) error
+	// History returns the versions of the resource with the given type and id, of all resources of the type if id is
+	// empty and of all resources if both are empty, newest first. A non-zero since excludes older versions.
+	History(ctx context.Context, resourceType, id string, since time.Time) ([]Version, error)
+	// Search returns the resources matching the search.
+	Search(ctx context.Context, s *search.Search) (*search.Result, error)
+}
+
+// Transactor is implemented by repositories which run transactions atomically.
+type Transactor interface {
+	// Transaction runs fn with a repository whose changes are kept only if fn succeeds.
+	Transaction(ctx context.Context, fn func(Repository) error) error
+}
+
+var (
+	// ErrNotFound is returned for resources which don't exist.
+	ErrNotFound = errors.New("resource not found")
+	// ErrDeleted is returned for deleted resources.
+	ErrDeleted = errors.New("resource deleted")
+	// ErrVersionConflict is returned if the current version isn't the expected one.
+	ErrVersionConflict = errors.New("version conflict")
+)
+
+// Version is a version in the history of a resource.
+type Version struct {
+	ResourceType string
+	Id           string
+	VersionId    string
+	LastUpdated  time.Time
+	// Method is POST for creations, PUT for updates and DELETE for deletions
+	Method fhir.HTTPVerb
+	// Resource is nil for deletions
+	Resource interface{}
+}
+
+// ResourceType returns the type of a generated resource, given as value or pointer.
+func ResourceType(resource interface{}) string {
+	t := reflect.TypeOf(resource)
+	if t == nil {
+		return ""
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// ResourceID returns the id of a generated resource or an empty string.
+func ResourceID(resource interface{}) string {
+	id := field(resource, "Id")
+	if !id.IsValid() || id.IsNil() {
+		return ""
+	}
+	return id.Elem().String()
+}
+
+// SetResourceID sets the id of a generated resource given as pointer. An empty id removes it.
+func SetResourceID(resource interface{}, id string) {
+	field := field(resource, "Id")
+	if !field.IsValid() || !field.CanSet() {
+		return
+	}
+	if id == "" {
+		field.Set(reflect.Zero(field.Type()))
+		return
+	}
+	field.Set(reflect.ValueOf(&id))
+}
+
+// ResourceMeta returns the meta of a generated resource given as pointer, adding one if it has none.
+func ResourceMeta(resource interface{}) *fhir.Meta {
+	field := field(resource, "Meta")
+	if !field.IsValid() {
+		return nil
+	}
+	if field.IsNil() {
+		if !field.CanSet() {
+			return nil
+		}
+		field.Set(reflect.ValueOf(&fhir.Meta{}))
+	}
+	meta, _ := field.Interface().(*fhir.Meta)
+	return meta
+}
+
+func field(resource interface{}, name string) reflect.Value {
+	v := reflect.ValueOf(resource)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v.FieldByName(name)
+}
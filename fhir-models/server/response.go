@@ -0,0 +1,256 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/patch"
+	"github.com/samply/golang-fhir-models/fhir-models/search"
+)
+
+const (
+	fhirJSON = "application/fhir+json"
+	fhirXML  = "application/fhir+xml"
+)
+
+// Error is an error returned to the client as OperationOutcome with the status code.
+type Error struct {
+	StatusCode int
+	Outcome    fhir.OperationOutcome
+}
+
+// NewError returns an error with a single issue.
+func NewError(statusCode int, code fhir.IssueType, format string, args ...interface{}) *Error {
+	diagnostics := fmt.Sprintf(format, args...)
+	return &Error{StatusCode: statusCode, Outcome: fhir.OperationOutcome{Issue: []fhir.OperationOutcomeIssue{{
+		Severity: fhir.IssueSeverityError, Code: code, Diagnostics: &diagnostics,
+	}}}}
+}
+
+func (e *Error) Error() string {
+	var messages []string
+	for _, issue := range e.Outcome.Issue {
+		message := issue.Code.Code()
+		if issue.Diagnostics != nil {
+			message = *issue.Diagnostics
+		}
+		messages = append(messages, message)
+	}
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), strings.Join(messages, "; "))
+}
+
+// toError returns the error to send to the client for errors of the repository, the search parser and patches.
+func toError(err error) *Error {
+	var e *Error
+	var searchError *search.Error
+	var patchError *patch.Error
+	switch {
+	case errors.As(err, &e):
+		return e
+	case errors.As(err, &searchError):
+		return &Error{StatusCode: http.StatusBadRequest, Outcome: searchError.Outcome}
+	case errors.As(err, &patchError):
+		return &Error{StatusCode: http.StatusUnprocessableEntity, Outcome: patchError.Outcome}
+	case errors.Is(err, ErrNotFound):
+		return NewError(http.StatusNotFound, fhir.IssueTypeNotFound, "%v", err)
+	case errors.Is(err, ErrDeleted):
+		return NewError(http.StatusGone, fhir.IssueTypeDeleted, "%v", err)
+	case errors.Is(err, ErrVersionConflict):
+		return NewError(http.StatusPreconditionFailed, fhir.IssueTypeConflict, "%v", err)
+	}
+	return NewError(http.StatusInternalServerError, fhir.IssueTypeException, "%v", err)
+}
+
+// format is a serialization of resources.
+type format int
+
+const (
+	formatJSON format = iota
+	formatXML
+)
+
+// parseFormat returns the format of a MIME type or value of _format.
+func parseFormat(value string) (format, bool) {
+	mediaType, _, err := mime.ParseMediaType(value)
+	if err != nil {
+		mediaType = strings.TrimSpace(value)
+	}
+	switch mediaType {
+	case "json", fhirJSON, "application/json", "application/json+fhir", "*/*", "application/*":
+		return formatJSON, true
+	case "xml", fhirXML, "application/xml", "text/xml", "application/xml+fhir":
+		return formatXML, true
+	}
+	return formatJSON, false
+}
+
+// responseFormat returns the format requested by _format or the Accept header, defaulting to JSON.
+func responseFormat(r *http.Request) (format, bool) {
+	if value := r.URL.Query().Get("_format"); value != "" {
+		return parseFormat(value)
+	}
+	accept := r.Header.Values("Accept")
+	if len(accept) == 0 {
+		return formatJSON, true
+	}
+	for _, value := range accept {
+		for _, mediaRange := range strings.Split(value, ",") {
+			if f, ok := parseFormat(mediaRange); ok {
+				return f, true
+			}
+		}
+	}
+	return formatJSON, false
+}
+
+// readResource decodes the resource in the request body, which has to be of the given type unless it is empty.
+func readResource(r *http.Request, resourceType string) (interface{}, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	f, ok := parseFormat(r.Header.Get("Content-Type"))
+	if !ok {
+		return nil, NewError(http.StatusUnsupportedMediaType, fhir.IssueTypeNotSupported, "unsupported content type %s", r.Header.Get("Content-Type"))
+	}
+	var resource interface{}
+	if f == formatXML {
+		resource, err = decodeXML(body)
+	} else {
+		resource, err = fhir.DecodeResource(body)
+	}
+	if err != nil {
+		return nil, NewError(http.StatusBadRequest, fhir.IssueTypeStructure, "invalid resource: %v", err)
+	}
+	if resourceType != "" && ResourceType(resource) != resourceType {
+		return nil, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "expected a %s but got a %s", resourceType, ResourceType(resource))
+	}
+	return resource, nil
+}
+
+// decodeXML decodes a resource from XML into a new instance of the type named by its root element.
+func decodeXML(body []byte) (interface{}, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			// DecodeResource creates the instance of the type named by resourceType
+			resource, err := fhir.DecodeResource([]byte(`{"resourceType":"` + start.Name.Local + `"}`))
+			if err != nil {
+				return nil, err
+			}
+			if err := xml.Unmarshal(body, resource); err != nil {
+				return nil, err
+			}
+			return resource, nil
+		}
+	}
+}
+
+// write writes the resource in the requested format or, if it is nil, only the status.
+func write(w http.ResponseWriter, r *http.Request, status int, resource interface{}) {
+	if resource == nil {
+		w.WriteHeader(status)
+		return
+	}
+	f, _ := responseFormat(r)
+	var body []byte
+	var err error
+	if f == formatXML {
+		if body, err = xml.Marshal(resource); err == nil {
+			body = append([]byte(xml.Header), body...)
+		}
+		w.Header().Set("Content-Type", fhirXML+"; charset=utf-8")
+	} else {
+		if body, err = json.Marshal(resource); err == nil && r.URL.Query().Get("_pretty") == "true" {
+			var pretty bytes.Buffer
+			if err = json.Indent(&pretty, body, "", "  "); err == nil {
+				body = pretty.Bytes()
+			}
+		}
+		w.Header().Set("Content-Type", fhirJSON+"; charset=utf-8")
+	}
+	if err != nil {
+		writeError(w, r, NewError(http.StatusInternalServerError, fhir.IssueTypeException, "%v", err))
+		return
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// writeError writes the error as OperationOutcome.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	e := toError(err)
+	if _, ok := responseFormat(r); !ok {
+		// the requested format isn't supported, so the outcome is written as JSON
+		r = r.Clone(r.Context())
+		r.URL.RawQuery = ""
+		r.Header.Del("Accept")
+	}
+	write(w, r, e.StatusCode, e.Outcome)
+}
+
+// setVersionHeaders sets the ETag and Last-Modified headers from the meta of the resource.
+func setVersionHeaders(w http.ResponseWriter, resource interface{}) {
+	meta := ResourceMeta(resource)
+	if meta == nil {
+		return
+	}
+	if meta.VersionId != nil {
+		w.Header().Set("ETag", etag(*meta.VersionId))
+	}
+	if meta.LastUpdated != nil {
+		if t, err := time.Parse(time.RFC3339Nano, *meta.LastUpdated); err == nil {
+			w.Header().Set("Last-Modified", t.UTC().Format(http.TimeFormat))
+		}
+	}
+}
+
+// etag returns the weak ETag of the version id.
+func etag(versionId string) string {
+	return `W/"` + versionId + `"`
+}
+
+// versionId returns the version id of an ETag like W/"3".
+func versionId(etag string) string {
+	return strings.Trim(strings.TrimPrefix(strings.TrimSpace(etag), "W/"), `"`)
+}
+
+// preference returns the value of the preference in the Prefer header, like representation for return.
+func preference(r *http.Request, name string) string {
+	for _, value := range r.Header.Values("Prefer") {
+		for _, p := range strings.Split(value, ",") {
+			key, v, _ := strings.Cut(strings.TrimSpace(p), "=")
+			if strings.TrimSpace(key) == name {
+				return strings.Trim(strings.TrimSpace(v), `"`)
+			}
+		}
+	}
+	return ""
+}
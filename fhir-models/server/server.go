@@ -0,0 +1,325 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server serves the RESTful API of FHIR (http://hl7.org/fhir/http.html) over the generated models.
+//
+// A Server is a http.Handler which stores resources in a Repository and offers the interactions registered per
+// resource type and for the whole system. It negotiates JSON or XML by _format and the Accept header, sets the ETag,
+// Last-Modified and Location headers, honours Prefer: return and conditional requests, reports errors as
+// OperationOutcome and describes itself by a CapabilityStatement at /metadata:
+//
+//	srv := server.New(repository, search.NewParser(search.Definitions()...))
+//	srv.Register("Patient", server.Resource{Interactions: []fhir.TypeRestfulInteraction{
+//		fhir.TypeRestfulInteractionRead, fhir.TypeRestfulInteractionCreate, fhir.TypeRestfulInteractionSearchType,
+//	}})
+//	http.Handle("/fhir/", http.StripPrefix("/fhir", srv))
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/search"
+)
+
+// Resource configures the interactions of a resource type.
+type Resource struct {
+	Interactions []fhir.TypeRestfulInteraction
+	// Versioning defaults to versioned. With versioned-update, updates, patches and deletes require If-Match.
+	Versioning        *fhir.ResourceVersionPolicy
+	UpdateCreate      bool
+	ConditionalCreate bool
+	ConditionalUpdate bool
+	ConditionalDelete fhir.ConditionalDeleteStatus
+	// Profile is the canonical URL of the profile of the resources
+	Profile string
+}
+
+func (r *Resource) supports(interaction fhir.TypeRestfulInteraction) bool {
+	for _, i := range r.Interactions {
+		if i == interaction {
+			return true
+		}
+	}
+	return false
+}
+
+// BundleProcessor processes the transaction and batch Bundles posted to the base of a server.
+type BundleProcessor interface {
+	Process(ctx context.Context, bundle fhir.Bundle) (fhir.Bundle, error)
+}
+
+// defaultCount is the page size of searches and histories without _count.
+const defaultCount = 50
+
+// Server serves the interactions registered for resource types and the system.
+type Server struct {
+	// BaseURL is the URL the server is reachable at, like https://example.org/fhir. It defaults to the scheme and
+	// host of the request followed by the prefix a handler like http.StripPrefix removed from the path.
+	BaseURL string
+	// Bundles processes transactions and batches, if registered
+	Bundles BundleProcessor
+	// Software is named in the CapabilityStatement
+	Software *fhir.CapabilityStatementSoftware
+
+	repository Repository
+	parser     *search.Parser
+	date       string
+
+	mu                 sync.RWMutex
+	resources          map[string]*Resource
+	systemInteractions []fhir.SystemRestfulInteraction
+}
+
+// New returns a server without interactions, which stores its resources in the repository and parses searches with
+// the parser.
+func New(repository Repository, parser *search.Parser) *Server {
+	return &Server{
+		repository: repository,
+		parser:     parser,
+		date:       time.Now().UTC().Format(time.RFC3339),
+		resources:  make(map[string]*Resource),
+	}
+}
+
+// Register offers the interactions of the resource type, replacing earlier registrations of the type.
+func (s *Server) Register(resourceType string, resource Resource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resources[resourceType] = &resource
+}
+
+// RegisterSystem offers the interactions on the whole system. Transactions and batches require Bundles.
+func (s *Server) RegisterSystem(interactions ...fhir.SystemRestfulInteraction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.systemInteractions = append(s.systemInteractions, interactions...)
+}
+
+func (s *Server) resource(resourceType string) (*Resource, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.resources[resourceType]
+	return r, ok
+}
+
+func (s *Server) supportsSystem(interaction fhir.SystemRestfulInteraction) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, i := range s.systemInteractions {
+		if i == interaction {
+			return true
+		}
+	}
+	return false
+}
+
+// baseURL returns the configured base URL or the one of the request.
+func (s *Server) baseURL(r *http.Request) string {
+	if s.BaseURL != "" {
+		return strings.TrimSuffix(s.BaseURL, "/")
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + strippedPrefix(r)
+}
+
+// strippedPrefix returns the part of the requested path a handler like http.StripPrefix removed, which leaves the
+// RequestURI unchanged.
+func strippedPrefix(r *http.Request) string {
+	requested, err := url.ParseRequestURI(r.RequestURI)
+	if err != nil {
+		return ""
+	}
+	prefix := strings.TrimSuffix(requested.EscapedPath(), r.URL.EscapedPath())
+	if prefix == requested.EscapedPath() && r.URL.EscapedPath() != "" {
+		return ""
+	}
+	return strings.TrimSuffix(prefix, "/")
+}
+
+// ServeHTTP dispatches the request to the interaction its method and path select.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if _, ok := responseFormat(r); !ok {
+		writeError(w, r, NewError(http.StatusNotAcceptable, fhir.IssueTypeNotSupported, "unsupported format"))
+		return
+	}
+	var parts []string
+	if path := strings.Trim(r.URL.Path, "/"); path != "" {
+		parts = strings.Split(path, "/")
+	}
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+
+	switch {
+	case len(parts) == 0:
+		switch method {
+		case http.MethodGet:
+			s.system(w, r, fhir.SystemRestfulInteractionSearchSystem, s.searchSystem)
+		case http.MethodPost:
+			s.processBundle(w, r)
+		default:
+			s.notAllowed(w, r)
+		}
+	case len(parts) == 1 && parts[0] == "metadata" && method == http.MethodGet:
+		write(w, r, http.StatusOK, s.CapabilityStatement(r))
+	case len(parts) == 1 && parts[0] == "_history" && method == http.MethodGet:
+		s.system(w, r, fhir.SystemRestfulInteractionHistorySystem, func(w http.ResponseWriter, r *http.Request) {
+			s.history(w, r, "", "")
+		})
+	case len(parts) == 1 && parts[0] == "_search" && method == http.MethodPost:
+		s.system(w, r, fhir.SystemRestfulInteractionSearchSystem, s.searchSystem)
+	default:
+		s.serveResource(w, r, method, parts)
+	}
+}
+
+// serveResource serves the type and instance interactions of the path Type[/id[/_history[/vid]]].
+func (s *Server) serveResource(w http.ResponseWriter, r *http.Request, method string, parts []string) {
+	resourceType := parts[0]
+	resource, ok := s.resource(resourceType)
+	if !ok {
+		writeError(w, r, NewError(http.StatusNotFound, fhir.IssueTypeNotSupported, "unsupported resource type %s", resourceType))
+		return
+	}
+	var interaction fhir.TypeRestfulInteraction
+	var handler func()
+	switch {
+	case len(parts) == 1 && method == http.MethodGet:
+		interaction, handler = fhir.TypeRestfulInteractionSearchType, func() { s.searchType(w, r, resourceType) }
+	case len(parts) == 2 && parts[1] == "_search" && method == http.MethodPost:
+		interaction, handler = fhir.TypeRestfulInteractionSearchType, func() { s.searchType(w, r, resourceType) }
+	case len(parts) == 1 && method == http.MethodPost:
+		interaction, handler = fhir.TypeRestfulInteractionCreate, func() { s.create(w, r, resource, resourceType) }
+	case len(parts) == 1 && method == http.MethodPut && resource.ConditionalUpdate:
+		interaction, handler = fhir.TypeRestfulInteractionUpdate, func() { s.conditionalUpdate(w, r, resource, resourceType) }
+	case len(parts) == 1 && method == http.MethodDelete && resource.ConditionalDelete != fhir.ConditionalDeleteStatusNotSupported:
+		interaction, handler = fhir.TypeRestfulInteractionDelete, func() { s.conditionalDelete(w, r, resource, resourceType) }
+	case len(parts) == 2 && parts[1] == "_history" && method == http.MethodGet:
+		interaction, handler = fhir.TypeRestfulInteractionHistoryType, func() { s.history(w, r, resourceType, "") }
+	case len(parts) == 2 && method == http.MethodGet:
+		interaction, handler = fhir.TypeRestfulInteractionRead, func() { s.read(w, r, resourceType, parts[1]) }
+	case len(parts) == 2 && method == http.MethodPut:
+		interaction, handler = fhir.TypeRestfulInteractionUpdate, func() { s.update(w, r, resource, resourceType, parts[1]) }
+	case len(parts) == 2 && method == http.MethodPatch:
+		interaction, handler = fhir.TypeRestfulInteractionPatch, func() { s.patch(w, r, resource, resourceType, parts[1]) }
+	case len(parts) == 2 && method == http.MethodDelete:
+		interaction, handler = fhir.TypeRestfulInteractionDelete, func() { s.delete(w, r, resource, resourceType, parts[1]) }
+	case len(parts) == 3 && parts[2] == "_history" && method == http.MethodGet:
+		interaction, handler = fhir.TypeRestfulInteractionHistoryInstance, func() { s.history(w, r, resourceType, parts[1]) }
+	case len(parts) == 4 && parts[2] == "_history" && method == http.MethodGet:
+		interaction, handler = fhir.TypeRestfulInteractionVread, func() { s.vread(w, r, resourceType, parts[1], parts[3]) }
+	default:
+		s.notAllowed(w, r)
+		return
+	}
+	if !resource.supports(interaction) {
+		s.notAllowed(w, r)
+		return
+	}
+	handler()
+}
+
+// system runs the handler if the system interaction is registered.
+func (s *Server) system(w http.ResponseWriter, r *http.Request, interaction fhir.SystemRestfulInteraction, handler http.HandlerFunc) {
+	if !s.supportsSystem(interaction) {
+		s.notAllowed(w, r)
+		return
+	}
+	handler(w, r)
+}
+
+func (s *Server) notAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, NewError(http.StatusMethodNotAllowed, fhir.IssueTypeNotSupported, "%s %s is not supported", r.Method, r.URL.Path))
+}
+
+// CapabilityStatement describes the registered interactions, the search parameters of the parser for the
+// registered resource types and the formats of the server.
+func (s *Server) CapabilityStatement(r *http.Request) fhir.CapabilityStatement {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	base := s.baseURL(r)
+	rest := fhir.CapabilityStatementRest{Mode: fhir.RestfulCapabilityModeServer}
+	types := make([]string, 0, len(s.resources))
+	for resourceType := range s.resources {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+	for _, resourceType := range types {
+		resource := s.resources[resourceType]
+		var t fhir.ResourceType
+		if err := t.UnmarshalJSON([]byte(`"` + resourceType + `"`)); err != nil {
+			continue
+		}
+		versioning := fhir.ResourceVersionPolicyVersioned
+		if resource.Versioning != nil {
+			versioning = *resource.Versioning
+		}
+		readHistory := resource.supports(fhir.TypeRestfulInteractionVread)
+		updateCreate, conditionalCreate, conditionalUpdate := resource.UpdateCreate, resource.ConditionalCreate, resource.ConditionalUpdate
+		conditionalDelete := resource.ConditionalDelete
+		capability := fhir.CapabilityStatementRestResource{
+			Type:              t,
+			Versioning:        &versioning,
+			ReadHistory:       &readHistory,
+			UpdateCreate:      &updateCreate,
+			ConditionalCreate: &conditionalCreate,
+			ConditionalUpdate: &conditionalUpdate,
+			ConditionalDelete: &conditionalDelete,
+		}
+		if resource.Profile != "" {
+			profile := resource.Profile
+			capability.Profile = &profile
+		}
+		for _, interaction := range resource.Interactions {
+			capability.Interaction = append(capability.Interaction, fhir.CapabilityStatementRestResourceInteraction{Code: interaction})
+		}
+		if resource.supports(fhir.TypeRestfulInteractionSearchType) {
+			for _, param := range s.parser.Params(resourceType) {
+				definition := param.Url
+				capability.SearchParam = append(capability.SearchParam, fhir.CapabilityStatementRestResourceSearchParam{
+					Name: param.Code, Definition: &definition, Type: param.Type,
+				})
+			}
+		}
+		rest.Resource = append(rest.Resource, capability)
+	}
+	for _, interaction := range s.systemInteractions {
+		rest.Interaction = append(rest.Interaction, fhir.CapabilityStatementRestInteraction{Code: interaction})
+	}
+
+	description := "FHIR server"
+	capabilityStatement := fhir.CapabilityStatement{
+		Status:         fhir.PublicationStatusActive,
+		Date:           s.date,
+		Kind:           fhir.CapabilityStatementKindInstance,
+		Software:       s.Software,
+		Implementation: &fhir.CapabilityStatementImplementation{Description: description, Url: &base},
+		FhirVersion:    fhir.FHIRVersion4_0_1,
+		Format:         []string{"json", "xml"},
+		PatchFormat:    []string{"application/json-patch+json", fhirJSON},
+		Rest:           []fhir.CapabilityStatementRest{rest},
+	}
+	return capabilityStatement
+}
@@ -0,0 +1,456 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+)
+
+// allInteractions are the interactions registered for Patient by newTestServer.
+var allInteractions = []fhir.TypeRestfulInteraction{
+	fhir.TypeRestfulInteractionRead, fhir.TypeRestfulInteractionVread, fhir.TypeRestfulInteractionUpdate,
+	fhir.TypeRestfulInteractionPatch, fhir.TypeRestfulInteractionDelete, fhir.TypeRestfulInteractionHistoryInstance,
+	fhir.TypeRestfulInteractionHistoryType, fhir.TypeRestfulInteractionCreate, fhir.TypeRestfulInteractionSearchType,
+}
+
+// newTestServer returns an HTTP server of a Server over a Memory repository serving Patient with all interactions
+// and Observation with read only.
+func newTestServer(t *testing.T, patient Resource) *httptest.Server {
+	t.Helper()
+	s := New(newMemory(t, MemoryOptions{}), testParser)
+	if patient.Interactions == nil {
+		patient.Interactions = allInteractions
+	}
+	s.Register("Patient", patient)
+	s.Register("Observation", Resource{Interactions: []fhir.TypeRestfulInteraction{fhir.TypeRestfulInteractionRead}})
+	s.RegisterSystem(fhir.SystemRestfulInteractionSearchSystem, fhir.SystemRestfulInteractionHistorySystem)
+	ts := httptest.NewServer(http.StripPrefix("/fhir", s))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// do sends the request with the headers given as name and value pairs and returns the response with its body.
+func do(t *testing.T, ts *httptest.Server, method, path, body string, headers ...string) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, ts.URL+"/fhir"+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", fhirJSON)
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	res, err := ts.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res, string(b)
+}
+
+// outcomeCode returns the code of the first issue of an OperationOutcome.
+func outcomeCode(t *testing.T, body string) fhir.IssueType {
+	t.Helper()
+	outcome, err := fhir.UnmarshalOperationOutcome([]byte(body))
+	if err != nil || len(outcome.Issue) == 0 {
+		t.Fatalf("expected an OperationOutcome but got %s", body)
+	}
+	return outcome.Issue[0].Code
+}
+
+func TestServerCRUD(t *testing.T) {
+	ts := newTestServer(t, Resource{})
+
+	res, body := do(t, ts, http.MethodPost, "/Patient", `{"resourceType":"Patient","id":"ignored","gender":"male"}`)
+	if res.StatusCode != http.StatusCreated {
+		t.Fatalf("create: %d %s", res.StatusCode, body)
+	}
+	created, err := fhir.UnmarshalPatient([]byte(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := *created.Id
+	if id == "ignored" {
+		t.Error("create kept the id of the client")
+	}
+	if location := res.Header.Get("Location"); location != ts.URL+"/fhir/Patient/"+id+"/_history/1" {
+		t.Errorf("Location = %s", location)
+	}
+	if res.Header.Get("ETag") != `W/"1"` || res.Header.Get("Last-Modified") == "" {
+		t.Errorf("ETag = %s, Last-Modified = %s", res.Header.Get("ETag"), res.Header.Get("Last-Modified"))
+	}
+	if !strings.HasPrefix(res.Header.Get("Content-Type"), fhirJSON) {
+		t.Errorf("Content-Type = %s", res.Header.Get("Content-Type"))
+	}
+
+	res, body = do(t, ts, http.MethodGet, "/Patient/"+id, "")
+	if res.StatusCode != http.StatusOK || res.Header.Get("ETag") != `W/"1"` || !strings.Contains(body, `"gender":"male"`) {
+		t.Errorf("read: %d %s %s", res.StatusCode, res.Header.Get("ETag"), body)
+	}
+	if res, _ = do(t, ts, http.MethodGet, "/Patient/"+id, "", "If-None-Match", `W/"1"`); res.StatusCode != http.StatusNotModified {
+		t.Errorf("read with If-None-Match: %d", res.StatusCode)
+	}
+
+	update := `{"resourceType":"Patient","id":"` + id + `","gender":"female"}`
+	if res, body = do(t, ts, http.MethodPut, "/Patient/"+id, update, "If-Match", `W/"2"`); res.StatusCode != http.StatusPreconditionFailed ||
+		outcomeCode(t, body) != fhir.IssueTypeConflict {
+		t.Errorf("update with stale If-Match: %d %s", res.StatusCode, body)
+	}
+	res, body = do(t, ts, http.MethodPut, "/Patient/"+id, update, "If-Match", `W/"1"`)
+	if res.StatusCode != http.StatusOK || res.Header.Get("ETag") != `W/"2"` ||
+		res.Header.Get("Location") != ts.URL+"/fhir/Patient/"+id+"/_history/2" {
+		t.Errorf("update: %d %v %s", res.StatusCode, res.Header, body)
+	}
+
+	res, body = do(t, ts, http.MethodPatch, "/Patient/"+id, `[{"op":"replace","path":"/gender","value":"other"}]`,
+		"Content-Type", "application/json-patch+json")
+	if res.StatusCode != http.StatusOK || res.Header.Get("ETag") != `W/"3"` || !strings.Contains(body, `"gender":"other"`) {
+		t.Errorf("patch: %d %s %s", res.StatusCode, res.Header.Get("ETag"), body)
+	}
+
+	if res, body = do(t, ts, http.MethodPatch, "/Patient/"+id, "x", "Content-Type", "text/plain"); res.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("patch in unsupported format: %d %s", res.StatusCode, body)
+	}
+
+	res, body = do(t, ts, http.MethodGet, "/Patient/"+id+"/_history/1", "")
+	if res.StatusCode != http.StatusOK || res.Header.Get("ETag") != `W/"1"` || !strings.Contains(body, `"gender":"male"`) {
+		t.Errorf("vread: %d %s %s", res.StatusCode, res.Header.Get("ETag"), body)
+	}
+
+	if res, _ = do(t, ts, http.MethodDelete, "/Patient/"+id, ""); res.StatusCode != http.StatusNoContent {
+		t.Errorf("delete: %d", res.StatusCode)
+	}
+	if res, body = do(t, ts, http.MethodGet, "/Patient/"+id, ""); res.StatusCode != http.StatusGone || outcomeCode(t, body) != fhir.IssueTypeDeleted {
+		t.Errorf("read after delete: %d %s", res.StatusCode, body)
+	}
+	if res, _ = do(t, ts, http.MethodDelete, "/Patient/"+id, ""); res.StatusCode != http.StatusNoContent {
+		t.Errorf("second delete: %d", res.StatusCode)
+	}
+
+	res, body = do(t, ts, http.MethodGet, "/Patient/"+id+"/_history", "")
+	history, err := fhir.UnmarshalBundle([]byte(body))
+	if err != nil || res.StatusCode != http.StatusOK {
+		t.Fatalf("history: %d %v", res.StatusCode, err)
+	}
+	var statuses []string
+	for _, entry := range history.Entry {
+		statuses = append(statuses, entry.Request.Method.Code()+" "+entry.Response.Status+" "+*entry.Response.Etag)
+	}
+	want := []string{`DELETE 204 No Content W/"4"`, `PUT 200 OK W/"3"`, `PUT 200 OK W/"2"`, `POST 201 Created W/"1"`}
+	if strings.Join(statuses, ", ") != strings.Join(want, ", ") {
+		t.Errorf("history = %v, want %v", statuses, want)
+	}
+}
+
+func TestServerStatusCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Resource
+		method  string
+		path    string
+		body    string
+		headers []string
+		status  int
+		code    fhir.IssueType
+	}{
+		{name: "unknown resource", method: http.MethodGet, path: "/Patient/x", status: http.StatusNotFound, code: fhir.IssueTypeNotFound},
+		{name: "unsupported type", method: http.MethodGet, path: "/Medication/x", status: http.StatusNotFound, code: fhir.IssueTypeNotSupported},
+		{name: "unsupported interaction", method: http.MethodDelete, path: "/Observation/x", status: http.StatusMethodNotAllowed,
+			code: fhir.IssueTypeNotSupported},
+		{name: "unsupported method", method: http.MethodPut, path: "/", status: http.StatusMethodNotAllowed, code: fhir.IssueTypeNotSupported},
+		{name: "unregistered interaction", config: Resource{Interactions: []fhir.TypeRestfulInteraction{fhir.TypeRestfulInteractionRead}},
+			method: http.MethodPost, path: "/Patient", body: `{"resourceType":"Patient"}`, status: http.StatusMethodNotAllowed,
+			code: fhir.IssueTypeNotSupported},
+		{name: "unacceptable format", method: http.MethodGet, path: "/Patient/x", headers: []string{"Accept", "text/html"},
+			status: http.StatusNotAcceptable, code: fhir.IssueTypeNotSupported},
+		{name: "unsupported content type", method: http.MethodPost, path: "/Patient", body: `{"resourceType":"Patient"}`,
+			headers: []string{"Content-Type", "text/plain"}, status: http.StatusUnsupportedMediaType, code: fhir.IssueTypeNotSupported},
+		{name: "invalid resource", method: http.MethodPost, path: "/Patient", body: `{"resourceType":"Patient","gender":"x"}`,
+			status: http.StatusBadRequest, code: fhir.IssueTypeStructure},
+		{name: "wrong resource type", method: http.MethodPost, path: "/Patient", body: `{"resourceType":"Observation","status":"final","code":{}}`,
+			status: http.StatusBadRequest, code: fhir.IssueTypeInvalid},
+		{name: "update with other id", method: http.MethodPut, path: "/Patient/a", body: `{"resourceType":"Patient","id":"b"}`,
+			status: http.StatusBadRequest, code: fhir.IssueTypeInvalid},
+		{name: "update as create not allowed", method: http.MethodPut, path: "/Patient/a", body: `{"resourceType":"Patient","id":"a"}`,
+			status: http.StatusMethodNotAllowed, code: fhir.IssueTypeNotSupported},
+		{name: "update as create", config: Resource{UpdateCreate: true}, method: http.MethodPut, path: "/Patient/a",
+			body: `{"resourceType":"Patient","id":"a"}`, status: http.StatusCreated},
+		{name: "versioned update without If-Match", config: Resource{UpdateCreate: true, Versioning: versionPolicy(fhir.ResourceVersionPolicyVersionedUpdate)},
+			method: http.MethodPut, path: "/Patient/a", body: `{"resourceType":"Patient","id":"a"}`,
+			status: http.StatusPreconditionRequired, code: fhir.IssueTypeRequired},
+		{name: "invalid search", method: http.MethodGet, path: "/Patient?birthdate=x", status: http.StatusBadRequest, code: fhir.IssueTypeInvalid},
+		{name: "strict search", method: http.MethodGet, path: "/Patient?foo=bar", headers: []string{"Prefer", "handling=strict"},
+			status: http.StatusBadRequest, code: fhir.IssueTypeNotSupported},
+		{name: "lenient search", method: http.MethodGet, path: "/Patient?foo=bar", status: http.StatusOK},
+		{name: "patch of unknown resource", method: http.MethodPatch, path: "/Patient/x", body: "[]", headers: []string{"Content-Type", "application/json-patch+json"},
+			status: http.StatusNotFound, code: fhir.IssueTypeNotFound},
+		{name: "conditional delete without criteria", config: Resource{ConditionalDelete: fhir.ConditionalDeleteStatusSingle},
+			method: http.MethodDelete, path: "/Patient", status: http.StatusBadRequest, code: fhir.IssueTypeRequired},
+		{name: "metadata", method: http.MethodGet, path: "/metadata", status: http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := newTestServer(t, test.config)
+			res, body := do(t, ts, test.method, test.path, test.body, test.headers...)
+			if res.StatusCode != test.status {
+				t.Fatalf("status = %d, want %d: %s", res.StatusCode, test.status, body)
+			}
+			if test.code != 0 || test.status >= 400 {
+				if code := outcomeCode(t, body); code != test.code {
+					t.Errorf("issue code = %s, want %s", code.Code(), test.code.Code())
+				}
+			}
+		})
+	}
+}
+
+func versionPolicy(policy fhir.ResourceVersionPolicy) *fhir.ResourceVersionPolicy {
+	return &policy
+}
+
+func TestServerFormats(t *testing.T) {
+	ts := newTestServer(t, Resource{UpdateCreate: true})
+	do(t, ts, http.MethodPut, "/Patient/p1", `{"resourceType":"Patient","id":"p1","gender":"male"}`)
+
+	tests := []struct {
+		path        string
+		headers     []string
+		contentType string
+		prefix      string
+	}{
+		{"/Patient/p1", nil, fhirJSON, `{"resourceType":"Patient"`},
+		{"/Patient/p1?_format=xml", nil, fhirXML, `<?xml`},
+		{"/Patient/p1?_format=application/fhir%2Bjson", []string{"Accept", fhirXML}, fhirJSON, `{`},
+		{"/Patient/p1", []string{"Accept", "text/html, application/fhir+xml;q=0.9"}, fhirXML, `<?xml`},
+		{"/Patient/p1?_pretty=true", nil, fhirJSON, "{\n  \"resourceType\""},
+		// errors are written as JSON if the requested format isn't supported
+		{"/Patient/p1?_format=html", nil, fhirJSON, `{"resourceType":"OperationOutcome"`},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			res, body := do(t, ts, http.MethodGet, test.path, "", test.headers...)
+			if !strings.HasPrefix(res.Header.Get("Content-Type"), test.contentType) || !strings.HasPrefix(body, test.prefix) {
+				t.Errorf("got %s %s", res.Header.Get("Content-Type"), body)
+			}
+		})
+	}
+
+	// resources may be sent as XML
+	xml := `<Patient xmlns="http://hl7.org/fhir"><id value="p2"/><gender value="female"/></Patient>`
+	res, body := do(t, ts, http.MethodPut, "/Patient/p2", xml, "Content-Type", fhirXML)
+	if res.StatusCode != http.StatusCreated || !strings.Contains(body, `"gender":"female"`) {
+		t.Errorf("update with XML: %d %s", res.StatusCode, body)
+	}
+}
+
+func TestServerPreferReturn(t *testing.T) {
+	tests := []struct {
+		prefer string
+		body   string
+	}{
+		{"", `{"resourceType":"Patient"`},
+		{"return=representation", `{"resourceType":"Patient"`},
+		{"return=minimal", ""},
+		{"return=OperationOutcome", `{"resourceType":"OperationOutcome"`},
+	}
+	for _, test := range tests {
+		t.Run(test.prefer, func(t *testing.T) {
+			ts := newTestServer(t, Resource{})
+			res, body := do(t, ts, http.MethodPost, "/Patient", `{"resourceType":"Patient"}`, "Prefer", test.prefer)
+			if res.StatusCode != http.StatusCreated || res.Header.Get("Location") == "" || !strings.HasPrefix(body, test.body) ||
+				test.body == "" && body != "" {
+				t.Errorf("got %d %s %s", res.StatusCode, res.Header.Get("Location"), body)
+			}
+		})
+	}
+}
+
+func TestServerConditionalInteractions(t *testing.T) {
+	ts := newTestServer(t, Resource{ConditionalCreate: true, ConditionalUpdate: true, ConditionalDelete: fhir.ConditionalDeleteStatusSingle})
+	smith := `{"resourceType":"Patient","name":[{"family":"Smith"}]}`
+
+	res, body := do(t, ts, http.MethodPost, "/Patient", smith, "If-None-Exist", "family=Smith")
+	if res.StatusCode != http.StatusCreated {
+		t.Fatalf("conditional create: %d %s", res.StatusCode, body)
+	}
+	location := res.Header.Get("Location")
+	if res, _ = do(t, ts, http.MethodPost, "/Patient", smith, "If-None-Exist", "family=Smith"); res.StatusCode != http.StatusOK ||
+		res.Header.Get("Location") != location {
+		t.Errorf("conditional create of existing: %d %s", res.StatusCode, res.Header.Get("Location"))
+	}
+
+	res, body = do(t, ts, http.MethodPut, "/Patient?family=Smith", `{"resourceType":"Patient","name":[{"family":"Smith"}],"gender":"male"}`)
+	if res.StatusCode != http.StatusOK || res.Header.Get("ETag") != `W/"2"` {
+		t.Errorf("conditional update: %d %s", res.StatusCode, body)
+	}
+	if res, _ = do(t, ts, http.MethodPut, "/Patient?family=Jones", `{"resourceType":"Patient","name":[{"family":"Jones"}]}`); res.StatusCode != http.StatusCreated {
+		t.Errorf("conditional update creating: %d", res.StatusCode)
+	}
+	do(t, ts, http.MethodPost, "/Patient", smith)
+	if res, body = do(t, ts, http.MethodPost, "/Patient", smith, "If-None-Exist", "family=Smith"); res.StatusCode != http.StatusPreconditionFailed ||
+		outcomeCode(t, body) != fhir.IssueTypeMultipleMatches {
+		t.Errorf("conditional create with two matches: %d %s", res.StatusCode, body)
+	}
+	if res, _ = do(t, ts, http.MethodDelete, "/Patient?family=Smith", ""); res.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("single conditional delete with two matches: %d", res.StatusCode)
+	}
+	if res, _ = do(t, ts, http.MethodDelete, "/Patient?family=Jones", ""); res.StatusCode != http.StatusNoContent {
+		t.Errorf("conditional delete: %d", res.StatusCode)
+	}
+}
+
+func TestServerSearch(t *testing.T) {
+	ts := newTestServer(t, Resource{UpdateCreate: true})
+	for _, id := range []string{"a", "b", "c"} {
+		do(t, ts, http.MethodPut, "/Patient/"+id, `{"resourceType":"Patient","id":"`+id+`","name":[{"family":"Smith"}]}`)
+	}
+
+	res, body := do(t, ts, http.MethodGet, "/Patient?family=smith&_count=2&_offset=1&foo=bar", "")
+	bundle, err := fhir.UnmarshalBundle([]byte(body))
+	if err != nil || res.StatusCode != http.StatusOK {
+		t.Fatalf("search: %d %v", res.StatusCode, err)
+	}
+	if bundle.Type != fhir.BundleTypeSearchset || *bundle.Total != 3 || len(bundle.Entry) != 3 {
+		t.Fatalf("bundle = %s", body)
+	}
+	if *bundle.Entry[0].FullUrl != ts.URL+"/fhir/Patient/b" || *bundle.Entry[0].Search.Mode != fhir.SearchEntryModeMatch ||
+		*bundle.Entry[2].Search.Mode != fhir.SearchEntryModeOutcome {
+		t.Errorf("entries = %s", body)
+	}
+	links := map[string]string{}
+	for _, link := range bundle.Link {
+		links[link.Relation] = link.Url
+	}
+	base := ts.URL + "/fhir/Patient?_count=2&"
+	if links["self"] != base+"_offset=1&family=smith&foo=bar" || links["previous"] != base+"family=smith&foo=bar" || links["next"] != "" {
+		t.Errorf("links = %v", links)
+	}
+
+	// searches may be posted as form and of all types
+	req, _ := http.NewRequest(http.MethodPost, ts.URL+"/fhir/Patient/_search", strings.NewReader("_id=a"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	posted, err := ts.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer posted.Body.Close()
+	var result struct{ Total int }
+	if err := json.NewDecoder(posted.Body).Decode(&result); err != nil || result.Total != 1 {
+		t.Errorf("posted search: %d %v", result.Total, err)
+	}
+	if _, body = do(t, ts, http.MethodGet, "?_id=b", ""); !strings.Contains(body, `"total":1`) {
+		t.Errorf("system search = %s", body)
+	}
+	if res, body = do(t, ts, http.MethodGet, "/_history", ""); res.StatusCode != http.StatusOK || !strings.Contains(body, `"total":3`) {
+		t.Errorf("system history: %d %s", res.StatusCode, body)
+	}
+}
+
+func TestServerBaseURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		baseURL string
+		want    string
+	}{
+		{"stripped prefix", "/fhir", "", "/fhir"},
+		{"no prefix", "", "", ""},
+		{"configured", "/fhir", "https://example.org/r4/", "https://example.org/r4"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := New(newMemory(t, MemoryOptions{}), testParser)
+			s.BaseURL = test.baseURL
+			s.Register("Patient", Resource{Interactions: allInteractions})
+			mux := http.NewServeMux()
+			mux.Handle(test.prefix+"/", http.StripPrefix(test.prefix, s))
+			ts := httptest.NewServer(mux)
+			t.Cleanup(ts.Close)
+			base := test.want
+			if test.baseURL == "" {
+				base = ts.URL + test.want
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, ts.URL+test.prefix+"/Patient", strings.NewReader(`{"resourceType":"Patient"}`))
+			req.Header.Set("Content-Type", fhirJSON)
+			res, err := ts.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+			if location := res.Header.Get("Location"); !strings.HasPrefix(location, base+"/Patient/") {
+				t.Errorf("Location = %s, want prefix %s/Patient/", location, base)
+			}
+
+			res, err = ts.Client().Get(ts.URL + test.prefix + "/Patient?_count=1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			body, _ := io.ReadAll(res.Body)
+			bundle, err := fhir.UnmarshalBundle(body)
+			if err != nil || len(bundle.Entry) != 1 || len(bundle.Link) == 0 {
+				t.Fatalf("search: %v %s", err, body)
+			}
+			if !strings.HasPrefix(*bundle.Entry[0].FullUrl, base+"/Patient/") || bundle.Link[0].Url != base+"/Patient?_count=1" {
+				t.Errorf("fullUrl = %s, self = %s, want base %s", *bundle.Entry[0].FullUrl, bundle.Link[0].Url, base)
+			}
+		})
+	}
+}
+
+func TestServerCapabilityStatement(t *testing.T) {
+	ts := newTestServer(t, Resource{UpdateCreate: true, Profile: "http://example.org/StructureDefinition/patient"})
+	_, body := do(t, ts, http.MethodGet, "/metadata", "")
+	statement, err := fhir.UnmarshalCapabilityStatement([]byte(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rest := statement.Rest[0]
+	if len(rest.Resource) != 2 || rest.Resource[0].Type != fhir.ResourceTypeObservation || rest.Resource[1].Type != fhir.ResourceTypePatient {
+		t.Fatalf("resources = %+v", rest.Resource)
+	}
+	observation, patient := rest.Resource[0], rest.Resource[1]
+	if len(observation.Interaction) != 1 || len(observation.SearchParam) != 0 {
+		t.Errorf("Observation = %+v", observation)
+	}
+	if len(patient.Interaction) != len(allInteractions) || !*patient.UpdateCreate || *patient.Profile != "http://example.org/StructureDefinition/patient" ||
+		*patient.Versioning != fhir.ResourceVersionPolicyVersioned || !*patient.ReadHistory {
+		t.Errorf("Patient = %+v", patient)
+	}
+	params := map[string]fhir.SearchParamType{}
+	for _, param := range patient.SearchParam {
+		params[param.Name] = param.Type
+	}
+	if params["birthdate"] != fhir.SearchParamTypeDate || params["_id"] != fhir.SearchParamTypeToken {
+		t.Errorf("search params = %v", params)
+	}
+	if len(rest.Interaction) != 2 || *statement.Implementation.Url != ts.URL+"/fhir" {
+		t.Errorf("system interactions = %+v, url = %s", rest.Interaction, *statement.Implementation.Url)
+	}
+}
@@ -0,0 +1,719 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/patch"
+	"github.com/samply/golang-fhir-models/fhir-models/search"
+)
+
+// Processor executes transaction and batch Bundles (http://hl7.org/fhir/http.html#transaction) against a repository
+// and is the BundleProcessor of a server:
+//
+//	srv.Bundles = server.NewProcessor(repository, parser)
+//	srv.RegisterSystem(fhir.SystemRestfulInteractionTransaction, fhir.SystemRestfulInteractionBatch)
+//
+// The entries of batches run independently in their order. Those of transactions run in the order DELETE, POST, PUT
+// and PATCH, GET and HEAD, after the full URLs of created and updated resources are replaced by their ids in all
+// references, other elements equal to them and the links of narratives, conditional references like
+// Patient?identifier=x|1 are resolved and conditional creates and updates are matched. A transaction which fails
+// leaves the repository unchanged if it is a Transactor. Otherwise the changes already made are undone by further
+// changes, which remain in the history.
+type Processor struct {
+	// BaseURL is the URL of the server, which absolute URLs of entries may start with
+	BaseURL string
+
+	repository Repository
+	parser     *search.Parser
+}
+
+// NewProcessor returns a processor storing resources in the repository and parsing searches with the parser.
+func NewProcessor(repository Repository, parser *search.Parser) *Processor {
+	return &Processor{repository: repository, parser: parser}
+}
+
+// operation is an entry of a Bundle to process.
+type operation struct {
+	index    int
+	entry    fhir.BundleEntry
+	method   fhir.HTTPVerb
+	path     []string
+	query    url.Values
+	resource interface{}
+	// existing is the resource a conditional create matched
+	existing interface{}
+	// resolved is set if the conditions of the operation were resolved before the operations of a transaction ran
+	resolved bool
+}
+
+// Process processes a transaction or batch Bundle and returns the transaction-response or batch-response Bundle. A
+// failing transaction returns an *Error naming the failed entry.
+func (p *Processor) Process(ctx context.Context, bundle fhir.Bundle) (fhir.Bundle, error) {
+	switch bundle.Type {
+	case fhir.BundleTypeTransaction:
+		return p.transaction(ctx, bundle)
+	case fhir.BundleTypeBatch:
+		return p.batch(ctx, bundle), nil
+	}
+	return fhir.Bundle{}, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "expected a transaction or batch but got a %s", bundle.Type.Code())
+}
+
+func (p *Processor) batch(ctx context.Context, bundle fhir.Bundle) fhir.Bundle {
+	response := fhir.Bundle{Type: fhir.BundleTypeBatchResponse, Entry: make([]fhir.BundleEntry, len(bundle.Entry))}
+	var operations []*operation
+	for i, entry := range bundle.Entry {
+		op, err := p.operation(i, entry)
+		if err != nil {
+			response.Entry[i] = errorEntry(err)
+			continue
+		}
+		operations = append(operations, op)
+	}
+	for _, op := range operations {
+		entry, err := p.execute(ctx, p.repository, op)
+		if err != nil {
+			entry = errorEntry(err)
+		}
+		response.Entry[op.index] = entry
+	}
+	return response
+}
+
+func (p *Processor) transaction(ctx context.Context, bundle fhir.Bundle) (fhir.Bundle, error) {
+	operations := make([]*operation, len(bundle.Entry))
+	for i, entry := range bundle.Entry {
+		op, err := p.operation(i, entry)
+		if err != nil {
+			return fhir.Bundle{}, entryError(i, err)
+		}
+		operations[i] = op
+	}
+
+	response := fhir.Bundle{Type: fhir.BundleTypeTransactionResponse, Entry: make([]fhir.BundleEntry, len(bundle.Entry))}
+	run := func(repository Repository) error {
+		if err := p.resolve(ctx, repository, operations); err != nil {
+			return err
+		}
+		for _, op := range ordered(operations) {
+			entry, err := p.execute(ctx, repository, op)
+			if err != nil {
+				return entryError(op.index, err)
+			}
+			response.Entry[op.index] = entry
+		}
+		return nil
+	}
+
+	if transactor, ok := p.repository.(Transactor); ok {
+		if err := transactor.Transaction(ctx, run); err != nil {
+			return fhir.Bundle{}, err
+		}
+		return response, nil
+	}
+	undo := &undoRepository{Repository: p.repository}
+	if err := run(undo); err != nil {
+		undo.rollback(ctx)
+		return fhir.Bundle{}, err
+	}
+	return response, nil
+}
+
+// operation parses the request of an entry.
+func (p *Processor) operation(index int, entry fhir.BundleEntry) (*operation, error) {
+	if entry.Request == nil {
+		return nil, NewError(http.StatusBadRequest, fhir.IssueTypeRequired, "entry without request")
+	}
+	raw := entry.Request.Url
+	if base := strings.TrimSuffix(p.BaseURL, "/"); base != "" && strings.HasPrefix(raw, base+"/") {
+		raw = strings.TrimPrefix(raw, base+"/")
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.IsAbs() {
+		return nil, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "invalid request url %s", entry.Request.Url)
+	}
+	op := &operation{index: index, entry: entry, method: entry.Request.Method, query: u.Query()}
+	if path := strings.Trim(u.Path, "/"); path != "" {
+		op.path = strings.Split(path, "/")
+	}
+	if len(op.path) == 0 && op.method != fhir.HTTPVerbGET && op.method != fhir.HTTPVerbHEAD {
+		return nil, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "request url %s without resource type", entry.Request.Url)
+	}
+	switch op.method {
+	case fhir.HTTPVerbPOST, fhir.HTTPVerbPUT, fhir.HTTPVerbPATCH:
+		if entry.Resource == nil {
+			return nil, NewError(http.StatusBadRequest, fhir.IssueTypeRequired, "%s entry without resource", op.method.Code())
+		}
+		if op.resource, err = fhir.DecodeResource(entry.Resource); err != nil {
+			return nil, NewError(http.StatusBadRequest, fhir.IssueTypeStructure, "invalid resource: %v", err)
+		}
+		if op.method != fhir.HTTPVerbPATCH && ResourceType(op.resource) != op.path[0] {
+			return nil, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "expected a %s but got a %s", op.path[0], ResourceType(op.resource))
+		}
+		if op.method == fhir.HTTPVerbPOST {
+			SetResourceID(op.resource, "")
+		}
+	}
+	return op, nil
+}
+
+// ordered returns the operations in the order of their methods.
+func ordered(operations []*operation) []*operation {
+	rank := map[fhir.HTTPVerb]int{
+		fhir.HTTPVerbDELETE: 0,
+		fhir.HTTPVerbPOST:   1,
+		fhir.HTTPVerbPUT:    2,
+		fhir.HTTPVerbPATCH:  2,
+		fhir.HTTPVerbGET:    3,
+		fhir.HTTPVerbHEAD:   3,
+	}
+	result := append([]*operation(nil), operations...)
+	sort.SliceStable(result, func(i, j int) bool {
+		return rank[result[i].method] < rank[result[j].method]
+	})
+	return result
+}
+
+// resolve assigns ids to the resources a transaction creates, matches conditional creates and updates and replaces
+// the full URLs of entries and conditional references in all references and search urls.
+func (p *Processor) resolve(ctx context.Context, repository Repository, operations []*operation) error {
+	ids := make(map[string]string)
+	targets := make(map[string]int)
+	for _, op := range operations {
+		op.resolved = true
+		var target string
+		switch {
+		case op.method == fhir.HTTPVerbPOST:
+			if op.entry.Request.IfNoneExist != nil {
+				criteria, err := url.ParseQuery(strings.TrimPrefix(*op.entry.Request.IfNoneExist, "?"))
+				if err != nil {
+					return entryError(op.index, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "invalid ifNoneExist: %v", err))
+				}
+				matches, err := p.match(ctx, repository, op.path[0], criteria)
+				if err != nil {
+					return entryError(op.index, err)
+				}
+				if len(matches) == 1 {
+					op.existing = matches[0]
+					target = op.path[0] + "/" + ResourceID(matches[0])
+					break
+				}
+			}
+			id, err := NewID()
+			if err != nil {
+				return err
+			}
+			SetResourceID(op.resource, id)
+			target = op.path[0] + "/" + id
+		case op.method == fhir.HTTPVerbPUT && len(op.path) == 1:
+			matches, err := p.match(ctx, repository, op.path[0], op.query)
+			if err != nil {
+				return entryError(op.index, err)
+			}
+			id := ResourceID(op.resource)
+			if len(matches) == 1 {
+				if id != "" && id != ResourceID(matches[0]) {
+					return entryError(op.index, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "the id of the resource has to be %s", ResourceID(matches[0])))
+				}
+				id = ResourceID(matches[0])
+			} else if id == "" {
+				if id, err = NewID(); err != nil {
+					return err
+				}
+			}
+			SetResourceID(op.resource, id)
+			op.path = append(op.path, id)
+			op.query = nil
+			target = op.path[0] + "/" + id
+		case op.method == fhir.HTTPVerbPUT:
+			if ResourceID(op.resource) != op.path[1] {
+				return entryError(op.index, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "the id of the resource has to be %s", op.path[1]))
+			}
+			target = op.path[0] + "/" + op.path[1]
+		}
+		if target == "" {
+			continue
+		}
+		if i, ok := targets[target]; ok {
+			return entryError(op.index, NewError(http.StatusBadRequest, fhir.IssueTypeDuplicate, "%s is also changed by entry %d", target, i))
+		}
+		targets[target] = op.index
+		if op.entry.FullUrl != nil {
+			ids[*op.entry.FullUrl] = target
+		}
+	}
+
+	for _, op := range operations {
+		if op.resource != nil && op.existing == nil {
+			resource, err := p.rewrite(ctx, repository, op.resource, ids)
+			if err != nil {
+				return entryError(op.index, err)
+			}
+			op.resource = resource
+		}
+		for _, values := range op.query {
+			for i, value := range values {
+				if target, ok := ids[value]; ok {
+					values[i] = target
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// rewrite replaces the full URLs of entries and conditional references in the references of the resource. Full URLs of
+// entries are also replaced in all other strings equal to them, like uri and canonical elements and Attachment.url, and
+// in the quoted attributes of the narrative, like href and src. The value of an identifier, which has a system, is kept
+// as it names rather than references the resource.
+func (p *Processor) rewrite(ctx context.Context, repository Repository, resource interface{}, ids map[string]string) (interface{}, error) {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var tree interface{}
+	if err := json.Unmarshal(raw, &tree); err != nil {
+		return nil, err
+	}
+	var walk func(node interface{}) error
+	walk = func(node interface{}) error {
+		switch node := node.(type) {
+		case map[string]interface{}:
+			for name, value := range node {
+				s, ok := value.(string)
+				switch {
+				case ok && name == "reference":
+					target, err := p.target(ctx, repository, s, ids)
+					if err != nil {
+						return err
+					}
+					node[name] = target
+				case ok && name == "div":
+					node[name] = rewriteNarrative(s, ids)
+				case ok && name == "value" && node["system"] != nil:
+					// identifiers keep their value
+				case ok:
+					if target, ok := ids[s]; ok {
+						node[name] = target
+					}
+				default:
+					if err := walk(value); err != nil {
+						return err
+					}
+				}
+			}
+		case []interface{}:
+			for i, value := range node {
+				if s, ok := value.(string); ok {
+					if target, ok := ids[s]; ok {
+						node[i] = target
+					}
+					continue
+				}
+				if err := walk(value); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(tree); err != nil {
+		return nil, err
+	}
+	if raw, err = json.Marshal(tree); err != nil {
+		return nil, err
+	}
+	return fhir.DecodeResource(raw)
+}
+
+// rewriteNarrative replaces the full URLs of entries in the quoted attributes of the XHTML of a narrative.
+func rewriteNarrative(div string, ids map[string]string) string {
+	for fullURL, target := range ids {
+		for _, quote := range []string{`"`, "'"} {
+			div = strings.ReplaceAll(div, "="+quote+fullURL+quote, "="+quote+target+quote)
+		}
+	}
+	return div
+}
+
+// target returns the reference to use instead of a reference to an entry or a conditional reference.
+func (p *Processor) target(ctx context.Context, repository Repository, reference string, ids map[string]string) (string, error) {
+	if target, ok := ids[reference]; ok {
+		return target, nil
+	}
+	if strings.HasPrefix(reference, "urn:uuid:") || strings.HasPrefix(reference, "urn:oid:") {
+		return "", NewError(http.StatusBadRequest, fhir.IssueTypeNotFound, "reference %s to no entry of the transaction", reference)
+	}
+	resourceType, query, conditional := strings.Cut(reference, "?")
+	if !conditional || strings.Contains(resourceType, "/") {
+		return reference, nil
+	}
+	criteria, err := url.ParseQuery(query)
+	if err != nil {
+		return "", NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "invalid conditional reference %s: %v", reference, err)
+	}
+	matches, err := p.match(ctx, repository, resourceType, criteria)
+	if err != nil {
+		return "", err
+	}
+	if len(matches) != 1 {
+		return "", NewError(http.StatusPreconditionFailed, fhir.IssueTypeMultipleMatches, "conditional reference %s matches %d resources", reference, len(matches))
+	}
+	return resourceType + "/" + ResourceID(matches[0]), nil
+}
+
+// match returns the resources matching the criteria of a conditional interaction or reference.
+func (p *Processor) match(ctx context.Context, repository Repository, resourceType string, criteria url.Values) ([]interface{}, error) {
+	if len(criteria) == 0 {
+		return nil, NewError(http.StatusBadRequest, fhir.IssueTypeRequired, "conditional interaction without criteria")
+	}
+	s, err := p.parser.Parse(resourceType, criteria, search.Strict)
+	if err != nil {
+		return nil, err
+	}
+	result, err := repository.Search(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Matches) > 1 {
+		return nil, NewError(http.StatusPreconditionFailed, fhir.IssueTypeMultipleMatches, "%d %s resources match the criteria", len(result.Matches), resourceType)
+	}
+	return result.Matches, nil
+}
+
+// execute runs the operation and returns the entry of the response Bundle.
+func (p *Processor) execute(ctx context.Context, repository Repository, op *operation) (fhir.BundleEntry, error) {
+	request := op.entry.Request
+	switch op.method {
+	case fhir.HTTPVerbDELETE:
+		id, err := p.instance(ctx, repository, op)
+		if err != nil || id == "" {
+			return responseEntry(http.StatusNoContent, nil), err
+		}
+		err = repository.Delete(ctx, op.path[0], id, versionOf(request.IfMatch))
+		if err != nil && !errors.Is(err, ErrNotFound) && !errors.Is(err, ErrDeleted) {
+			return fhir.BundleEntry{}, err
+		}
+		return responseEntry(http.StatusNoContent, nil), nil
+	case fhir.HTTPVerbPOST:
+		if request.IfNoneExist != nil && !op.resolved {
+			criteria, err := url.ParseQuery(strings.TrimPrefix(*request.IfNoneExist, "?"))
+			if err != nil {
+				return fhir.BundleEntry{}, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "invalid ifNoneExist: %v", err)
+			}
+			matches, err := p.match(ctx, repository, op.path[0], criteria)
+			if err != nil {
+				return fhir.BundleEntry{}, err
+			}
+			if len(matches) == 1 {
+				op.existing = matches[0]
+			}
+		}
+		if op.existing != nil {
+			return responseEntry(http.StatusOK, op.existing), nil
+		}
+		created, err := repository.Create(ctx, op.resource)
+		if err != nil {
+			return fhir.BundleEntry{}, err
+		}
+		return responseEntry(http.StatusCreated, created), nil
+	case fhir.HTTPVerbPUT:
+		if len(op.path) == 1 && !op.resolved {
+			// the conditional update of a batch
+			matches, err := p.match(ctx, repository, op.path[0], op.query)
+			if err != nil {
+				return fhir.BundleEntry{}, err
+			}
+			if len(matches) == 1 {
+				SetResourceID(op.resource, ResourceID(matches[0]))
+			}
+		} else if ResourceID(op.resource) != op.path[1] {
+			return fhir.BundleEntry{}, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "the id of the resource has to be %s", op.path[1])
+		}
+		var updated interface{}
+		var created bool
+		var err error
+		if ResourceID(op.resource) == "" {
+			created = true
+			updated, err = repository.Create(ctx, op.resource)
+		} else {
+			updated, created, err = repository.Update(ctx, op.resource, versionOf(request.IfMatch))
+		}
+		if err != nil {
+			return fhir.BundleEntry{}, err
+		}
+		if created {
+			return responseEntry(http.StatusCreated, updated), nil
+		}
+		return responseEntry(http.StatusOK, updated), nil
+	case fhir.HTTPVerbPATCH:
+		return p.patch(ctx, repository, op)
+	case fhir.HTTPVerbGET, fhir.HTTPVerbHEAD:
+		entry, err := p.get(ctx, repository, op)
+		if err == nil && op.method == fhir.HTTPVerbHEAD {
+			entry.Resource = nil
+		}
+		return entry, err
+	}
+	return fhir.BundleEntry{}, NewError(http.StatusMethodNotAllowed, fhir.IssueTypeNotSupported, "unsupported method %s", op.method.Code())
+}
+
+// instance returns the id of the resource a DELETE or PATCH url like Type/id or Type?criteria refers to, which is empty
+// if no resource matches the criteria.
+func (p *Processor) instance(ctx context.Context, repository Repository, op *operation) (string, error) {
+	switch {
+	case len(op.path) == 2:
+		return op.path[1], nil
+	case len(op.path) == 1:
+		matches, err := p.match(ctx, repository, op.path[0], op.query)
+		if err != nil || len(matches) == 0 {
+			return "", err
+		}
+		return ResourceID(matches[0]), nil
+	}
+	return "", NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "invalid request url %s", op.entry.Request.Url)
+}
+
+// patch applies a FHIRPath Patch given as Parameters or a JSON Patch given as Binary.
+func (p *Processor) patch(ctx context.Context, repository Repository, op *operation) (fhir.BundleEntry, error) {
+	id, err := p.instance(ctx, repository, op)
+	if err != nil {
+		return fhir.BundleEntry{}, err
+	}
+	if id == "" {
+		return fhir.BundleEntry{}, NewError(http.StatusNotFound, fhir.IssueTypeNotFound, "no %s matches the criteria", op.path[0])
+	}
+	resource, err := repository.Read(ctx, op.path[0], id)
+	if err != nil {
+		return fhir.BundleEntry{}, err
+	}
+	version := versionOf(op.entry.Request.IfMatch)
+	if meta := ResourceMeta(resource); version == "" && meta != nil && meta.VersionId != nil {
+		version = *meta.VersionId
+	}
+	switch body := op.resource.(type) {
+	case *fhir.Parameters:
+		err = patch.ApplyFHIRPathPatch(resource, *body)
+	case *fhir.Binary:
+		if body.ContentType != "application/json-patch+json" || body.Data == nil {
+			return fhir.BundleEntry{}, NewError(http.StatusUnsupportedMediaType, fhir.IssueTypeNotSupported, "unsupported patch format %s", body.ContentType)
+		}
+		var data []byte
+		if data, err = base64.StdEncoding.DecodeString(*body.Data); err != nil {
+			return fhir.BundleEntry{}, NewError(http.StatusBadRequest, fhir.IssueTypeStructure, "invalid JSON Patch: %v", err)
+		}
+		err = patch.ApplyJSONPatch(resource, data)
+	default:
+		return fhir.BundleEntry{}, NewError(http.StatusBadRequest, fhir.IssueTypeInvalid, "expected a Parameters or Binary but got a %s", ResourceType(op.resource))
+	}
+	if err != nil {
+		return fhir.BundleEntry{}, err
+	}
+	if ResourceID(resource) != id || ResourceType(resource) != op.path[0] {
+		return fhir.BundleEntry{}, NewError(http.StatusUnprocessableEntity, fhir.IssueTypeProcessing, "the patch must not change the type or id of the resource")
+	}
+	updated, _, err := repository.Update(ctx, resource, version)
+	if err != nil {
+		return fhir.BundleEntry{}, err
+	}
+	return responseEntry(http.StatusOK, updated), nil
+}
+
+// get reads a resource or version or searches.
+func (p *Processor) get(ctx context.Context, repository Repository, op *operation) (fhir.BundleEntry, error) {
+	switch {
+	case len(op.path) <= 1:
+		resourceType := ""
+		if len(op.path) == 1 {
+			resourceType = op.path[0]
+		}
+		s, err := p.parser.Parse(resourceType, op.query, search.Lenient)
+		if err != nil {
+			return fhir.BundleEntry{}, err
+		}
+		if s.Count == nil {
+			count := defaultCount
+			s.Count = &count
+		}
+		result, err := repository.Search(ctx, s)
+		if err != nil {
+			return fhir.BundleEntry{}, err
+		}
+		bundle := fhir.Bundle{Type: fhir.BundleTypeSearchset, Total: &result.Total}
+		for _, mode := range []fhir.SearchEntryMode{fhir.SearchEntryModeMatch, fhir.SearchEntryModeInclude} {
+			resources := result.Matches
+			if mode == fhir.SearchEntryModeInclude {
+				resources = result.Included
+			}
+			for _, resource := range resources {
+				entry, err := resourceEntry(strings.TrimSuffix(p.BaseURL, "/"), resource)
+				if err != nil {
+					return fhir.BundleEntry{}, err
+				}
+				if p.BaseURL == "" {
+					// full URLs are absolute
+					entry.FullUrl = nil
+				}
+				mode := mode
+				entry.Search = &fhir.BundleEntrySearch{Mode: &mode}
+				bundle.Entry = append(bundle.Entry, entry)
+			}
+		}
+		return responseEntry(http.StatusOK, bundle), nil
+	case len(op.path) == 2:
+		resource, err := repository.Read(ctx, op.path[0], op.path[1])
+		if err != nil {
+			return fhir.BundleEntry{}, err
+		}
+		if ifNoneMatch := op.entry.Request.IfNoneMatch; ifNoneMatch != nil {
+			if meta := ResourceMeta(resource); meta != nil && meta.VersionId != nil && *meta.VersionId == versionId(*ifNoneMatch) {
+				entry := responseEntry(http.StatusNotModified, resource)
+				entry.Resource = nil
+				return entry, nil
+			}
+		}
+		return responseEntry(http.StatusOK, resource), nil
+	case len(op.path) == 4 && op.path[2] == "_history":
+		resource, err := repository.VRead(ctx, op.path[0], op.path[1], op.path[3])
+		if err != nil {
+			return fhir.BundleEntry{}, err
+		}
+		return responseEntry(http.StatusOK, resource), nil
+	}
+	return fhir.BundleEntry{}, NewError(http.StatusBadRequest, fhir.IssueTypeNotSupported, "unsupported request url %s", op.entry.Request.Url)
+}
+
+// responseEntry returns the entry of a response Bundle with the resource, its location and version.
+func responseEntry(status int, resource interface{}) fhir.BundleEntry {
+	entry := fhir.BundleEntry{Response: &fhir.BundleEntryResponse{Status: statusLine(status)}}
+	if resource == nil {
+		return entry
+	}
+	if raw, err := json.Marshal(resource); err == nil {
+		entry.Resource = raw
+	}
+	if id := ResourceID(resource); id != "" {
+		location := ResourceType(resource) + "/" + id
+		if meta := ResourceMeta(resource); meta != nil {
+			if meta.VersionId != nil {
+				location += "/_history/" + *meta.VersionId
+				tag := etag(*meta.VersionId)
+				entry.Response.Etag = &tag
+			}
+			entry.Response.LastModified = meta.LastUpdated
+		}
+		if status == http.StatusCreated {
+			entry.Response.Location = &location
+		}
+	}
+	return entry
+}
+
+// errorEntry returns the entry of a batch-response Bundle reporting the error.
+func errorEntry(err error) fhir.BundleEntry {
+	e := toError(err)
+	outcome, _ := json.Marshal(e.Outcome)
+	return fhir.BundleEntry{Response: &fhir.BundleEntryResponse{Status: statusLine(e.StatusCode), Outcome: outcome}}
+}
+
+// entryError returns the error of the entry with the given index, whose issues name the entry.
+func entryError(index int, err error) error {
+	e := toError(err)
+	result := &Error{StatusCode: e.StatusCode, Outcome: e.Outcome}
+	result.Outcome.Issue = append([]fhir.OperationOutcomeIssue(nil), e.Outcome.Issue...)
+	for i := range result.Outcome.Issue {
+		expression := "Bundle.entry[" + strconv.Itoa(index) + "]"
+		result.Outcome.Issue[i].Expression = append([]string{expression}, result.Outcome.Issue[i].Expression...)
+	}
+	return result
+}
+
+func statusLine(status int) string {
+	return fmt.Sprintf("%d %s", status, http.StatusText(status))
+}
+
+func versionOf(ifMatch *string) string {
+	if ifMatch == nil {
+		return ""
+	}
+	return versionId(*ifMatch)
+}
+
+// undoRepository records how to undo the changes to a repository which doesn't support transactions.
+type undoRepository struct {
+	Repository
+	undo []func(ctx context.Context) error
+}
+
+func (r *undoRepository) Create(ctx context.Context, resource interface{}) (interface{}, error) {
+	created, err := r.Repository.Create(ctx, resource)
+	if err == nil {
+		resourceType, id := ResourceType(created), ResourceID(created)
+		r.undo = append(r.undo, func(ctx context.Context) error {
+			return r.Repository.Delete(ctx, resourceType, id, "")
+		})
+	}
+	return created, err
+}
+
+func (r *undoRepository) Update(ctx context.Context, resource interface{}, versionId string) (interface{}, bool, error) {
+	restore := r.restore(ctx, ResourceType(resource), ResourceID(resource))
+	updated, created, err := r.Repository.Update(ctx, resource, versionId)
+	if err == nil {
+		r.undo = append(r.undo, restore)
+	}
+	return updated, created, err
+}
+
+func (r *undoRepository) Delete(ctx context.Context, resourceType, id, versionId string) error {
+	restore := r.restore(ctx, resourceType, id)
+	err := r.Repository.Delete(ctx, resourceType, id, versionId)
+	if err == nil {
+		r.undo = append(r.undo, restore)
+	}
+	return err
+}
+
+// restore returns a function restoring the current state of the resource.
+func (r *undoRepository) restore(ctx context.Context, resourceType, id string) func(ctx context.Context) error {
+	previous, err := r.Repository.Read(ctx, resourceType, id)
+	if err != nil {
+		return func(ctx context.Context) error {
+			return r.Repository.Delete(ctx, resourceType, id, "")
+		}
+	}
+	return func(ctx context.Context) error {
+		_, _, err := r.Repository.Update(ctx, previous, "")
+		return err
+	}
+}
+
+// rollback undoes the changes in reverse order.
+func (r *undoRepository) rollback(ctx context.Context) {
+	for i := len(r.undo) - 1; i >= 0; i-- {
+		_ = r.undo[i](ctx)
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/search"
+)
+
+// recordingRepository records the changes and searches made through it. It doesn't implement Transactor, so that
+// transactions are undone by further changes.
+type recordingRepository struct {
+	Repository
+	calls []string
+}
+
+func (r *recordingRepository) Create(ctx context.Context, resource interface{}) (interface{}, error) {
+	r.calls = append(r.calls, "POST "+ResourceType(resource))
+	return r.Repository.Create(ctx, resource)
+}
+
+func (r *recordingRepository) Update(ctx context.Context, resource interface{}, versionId string) (interface{}, bool, error) {
+	r.calls = append(r.calls, "PUT "+ResourceType(resource)+"/"+ResourceID(resource))
+	return r.Repository.Update(ctx, resource, versionId)
+}
+
+func (r *recordingRepository) Delete(ctx context.Context, resourceType, id, versionId string) error {
+	r.calls = append(r.calls, "DELETE "+resourceType+"/"+id)
+	return r.Repository.Delete(ctx, resourceType, id, versionId)
+}
+
+func (r *recordingRepository) Search(ctx context.Context, s *search.Search) (*search.Result, error) {
+	r.calls = append(r.calls, "GET "+s.ResourceType)
+	return r.Repository.Search(ctx, s)
+}
+
+// bundleEntry returns an entry with the request and, unless empty, the resource and full URL.
+func bundleEntry(method fhir.HTTPVerb, url, fullUrl, resource string) fhir.BundleEntry {
+	entry := fhir.BundleEntry{Request: &fhir.BundleEntryRequest{Method: method, Url: url}}
+	if fullUrl != "" {
+		entry.FullUrl = &fullUrl
+	}
+	if resource != "" {
+		entry.Resource = json.RawMessage(resource)
+	}
+	return entry
+}
+
+// statuses returns the response statuses of the entries.
+func statuses(bundle fhir.Bundle) []string {
+	var result []string
+	for _, entry := range bundle.Entry {
+		result = append(result, entry.Response.Status)
+	}
+	return result
+}
+
+func TestTransaction(t *testing.T) {
+	ctx := context.Background()
+	m := newMemory(t, MemoryOptions{})
+	for _, p := range []*fhir.Patient{patient("p1", "Doe"), patient("old", "Old")} {
+		if _, err := m.Create(ctx, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	repository := &recordingRepository{Repository: m}
+
+	bundle := fhir.Bundle{Type: fhir.BundleTypeTransaction, Entry: []fhir.BundleEntry{
+		bundleEntry(fhir.HTTPVerbGET, "Patient?family=Roe", "", ""),
+		bundleEntry(fhir.HTTPVerbPUT, "Patient/p1", "", `{"resourceType":"Patient","id":"p1","name":[{"family":"Doe"}],`+
+			`"link":[{"other":{"reference":"urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a"},"type":"seealso"}]}`),
+		bundleEntry(fhir.HTTPVerbPOST, "Observation", "", `{"resourceType":"Observation","status":"final","code":{"text":"x"},`+
+			`"subject":{"reference":"urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a"}}`),
+		bundleEntry(fhir.HTTPVerbPOST, "Patient", "urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a",
+			`{"resourceType":"Patient","id":"ignored","name":[{"family":"Roe"}]}`),
+		bundleEntry(fhir.HTTPVerbDELETE, "Patient/old", "", ""),
+	}}
+	response, err := NewProcessor(repository, testParser).Process(ctx, bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Type != fhir.BundleTypeTransactionResponse {
+		t.Errorf("type = %s", response.Type.Code())
+	}
+	want := []string{"200 OK", "200 OK", "201 Created", "201 Created", "204 No Content"}
+	if got := statuses(response); !reflect.DeepEqual(got, want) {
+		t.Errorf("statuses = %v, want %v", got, want)
+	}
+	// DELETE, POST, PUT, GET regardless of the order of the entries
+	wantCalls := []string{"DELETE Patient/old", "POST Observation", "POST Patient", "PUT Patient/p1", "GET Patient"}
+	if !reflect.DeepEqual(repository.calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", repository.calls, wantCalls)
+	}
+
+	created, err := fhir.UnmarshalPatient(response.Entry[3].Resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reference := "Patient/" + *created.Id
+	if *created.Id == "ignored" || *response.Entry[3].Response.Location != reference+"/_history/1" ||
+		*response.Entry[3].Response.Etag != `W/"1"` {
+		t.Errorf("created %s at %s", *created.Id, *response.Entry[3].Response.Location)
+	}
+	observation, err := fhir.UnmarshalObservation(response.Entry[2].Resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *observation.Subject.Reference != reference {
+		t.Errorf("subject = %s, want %s", *observation.Subject.Reference, reference)
+	}
+	updated, err := m.Read(ctx, "Patient", "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if link := updated.(*fhir.Patient).Link; *link[0].Other.Reference != reference {
+		t.Errorf("link = %s, want %s", *link[0].Other.Reference, reference)
+	}
+	if *response.Entry[1].Response.Etag != `W/"2"` || response.Entry[1].Response.Location != nil {
+		t.Errorf("update response = %+v", *response.Entry[1].Response)
+	}
+
+	// the search ran after the changes
+	searchset, err := fhir.UnmarshalBundle(response.Entry[0].Resource)
+	if err != nil || *searchset.Total != 1 {
+		t.Errorf("search = %s, %v", response.Entry[0].Resource, err)
+	}
+	if _, err := m.Read(ctx, "Patient", "old"); !errors.Is(err, ErrDeleted) {
+		t.Errorf("read of deleted patient = %v", err)
+	}
+}
+
+func TestTransactionRewritesFullURLs(t *testing.T) {
+	ctx := context.Background()
+	m := newMemory(t, MemoryOptions{})
+	const fullURL = "urn:uuid:3c1f6a52-8d0e-4b8a-9f7e-0d2b5c9e4a11"
+	bundle := fhir.Bundle{Type: fhir.BundleTypeTransaction, Entry: []fhir.BundleEntry{
+		bundleEntry(fhir.HTTPVerbPOST, "Patient", fullURL, `{"resourceType":"Patient"}`),
+		bundleEntry(fhir.HTTPVerbPUT, "Patient/p2", "", `{"resourceType":"Patient","id":"p2",`+
+			`"meta":{"profile":["`+fullURL+`"]},"implicitRules":"`+fullURL+`",`+
+			`"text":{"status":"generated","div":"<div xmlns=\"http://www.w3.org/1999/xhtml\"><a href=\"`+fullURL+`\">x</a></div>"},`+
+			`"identifier":[{"system":"urn:ietf:rfc:3986","value":"`+fullURL+`"}],"photo":[{"url":"`+fullURL+`"}]}`),
+	}}
+	response, err := NewProcessor(m, testParser).Process(ctx, bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := fhir.UnmarshalPatient(response.Entry[0].Resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reference := "Patient/" + *created.Id
+	resource, err := m.Read(ctx, "Patient", "p2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2 := resource.(*fhir.Patient)
+	if p2.Meta.Profile[0] != reference || *p2.ImplicitRules != reference || *p2.Photo[0].Url != reference {
+		t.Errorf("profile = %s, implicitRules = %s, photo = %s, want %s", p2.Meta.Profile[0], *p2.ImplicitRules, *p2.Photo[0].Url, reference)
+	}
+	if !strings.Contains(p2.Text.Div, `href="`+reference+`"`) {
+		t.Errorf("div = %s", p2.Text.Div)
+	}
+	if *p2.Identifier[0].Value != fullURL {
+		t.Errorf("identifier = %s, want %s", *p2.Identifier[0].Value, fullURL)
+	}
+}
+
+func TestTransactionConditional(t *testing.T) {
+	ctx := context.Background()
+	m := newMemory(t, MemoryOptions{})
+	existing := patient("p1", "Doe")
+	existing.Identifier = []fhir.Identifier{{System: stringPtr("http://example.org/mrn"), Value: stringPtr("1")}}
+	if _, err := m.Create(ctx, existing); err != nil {
+		t.Fatal(err)
+	}
+
+	ifNoneExist := "identifier=http://example.org/mrn|1"
+	create := bundleEntry(fhir.HTTPVerbPOST, "Patient", "urn:uuid:0b4c2d37-3f41-4f1c-8a57-2b16a7d2f5a1", `{"resourceType":"Patient"}`)
+	create.Request.IfNoneExist = &ifNoneExist
+	bundle := fhir.Bundle{Type: fhir.BundleTypeTransaction, Entry: []fhir.BundleEntry{
+		create,
+		bundleEntry(fhir.HTTPVerbPOST, "Observation", "", `{"resourceType":"Observation","status":"final","code":{"text":"a"},`+
+			`"subject":{"reference":"urn:uuid:0b4c2d37-3f41-4f1c-8a57-2b16a7d2f5a1"}}`),
+		bundleEntry(fhir.HTTPVerbPOST, "Observation", "", `{"resourceType":"Observation","status":"final","code":{"text":"b"},`+
+			`"subject":{"reference":"Patient?identifier=http://example.org/mrn|1"}}`),
+		bundleEntry(fhir.HTTPVerbPUT, "Patient?identifier=http://example.org/mrn|2", "",
+			`{"resourceType":"Patient","identifier":[{"system":"http://example.org/mrn","value":"2"}]}`),
+	}}
+	response, err := NewProcessor(m, testParser).Process(ctx, bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"200 OK", "201 Created", "201 Created", "201 Created"}
+	if got := statuses(response); !reflect.DeepEqual(got, want) {
+		t.Errorf("statuses = %v, want %v", got, want)
+	}
+	for _, i := range []int{1, 2} {
+		observation, err := fhir.UnmarshalObservation(response.Entry[i].Resource)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *observation.Subject.Reference != "Patient/p1" {
+			t.Errorf("subject of entry %d = %s", i, *observation.Subject.Reference)
+		}
+	}
+	if ids := searchFamily(t, m, "Doe"); len(ids) != 1 {
+		t.Errorf("patients = %v", ids)
+	}
+}
+
+func TestTransactionRollback(t *testing.T) {
+	for _, transactor := range []bool{true, false} {
+		name := "undo"
+		if transactor {
+			name = "transactor"
+		}
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			m := newMemory(t, MemoryOptions{})
+			if _, err := m.Create(ctx, patient("p1", "Doe")); err != nil {
+				t.Fatal(err)
+			}
+			// the update of p1 fails after it was deleted and the other changes were made
+			var repository Repository = m
+			if !transactor {
+				repository = &recordingRepository{Repository: m}
+			}
+
+			stale := bundleEntry(fhir.HTTPVerbPUT, "Patient/p1", "", `{"resourceType":"Patient","id":"p1","name":[{"family":"Roe"}]}`)
+			ifMatch := `W/"1"`
+			stale.Request.IfMatch = &ifMatch
+			bundle := fhir.Bundle{Type: fhir.BundleTypeTransaction, Entry: []fhir.BundleEntry{
+				bundleEntry(fhir.HTTPVerbPUT, "Patient/p3", "", `{"resourceType":"Patient","id":"p3","name":[{"family":"Roe"}]}`),
+				bundleEntry(fhir.HTTPVerbPOST, "Patient", "", `{"resourceType":"Patient","name":[{"family":"Roe"}]}`),
+				bundleEntry(fhir.HTTPVerbPUT, "Patient/p2", "", `{"resourceType":"Patient","id":"p2","name":[{"family":"Roe"}]}`),
+				bundleEntry(fhir.HTTPVerbDELETE, "Patient/p1", "", ""),
+				stale,
+			}}
+			_, err := NewProcessor(repository, testParser).Process(ctx, bundle)
+			var e *Error
+			if !errors.As(err, &e) || e.StatusCode != http.StatusPreconditionFailed || e.Outcome.Issue[0].Expression[0] != "Bundle.entry[4]" {
+				t.Fatalf("err = %v, want a version conflict of entry 4", err)
+			}
+
+			if ids := searchFamily(t, m, "Roe"); len(ids) != 0 {
+				t.Errorf("patients named Roe = %v", ids)
+			}
+			if ids := searchFamily(t, m, "Doe"); !reflect.DeepEqual(ids, []string{"p1"}) {
+				t.Errorf("patients named Doe = %v", ids)
+			}
+			for _, id := range []string{"p2", "p3"} {
+				if _, err := m.Read(ctx, "Patient", id); err == nil {
+					t.Errorf("%s exists", id)
+				}
+			}
+		})
+	}
+}
+
+func TestTransactionErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []fhir.BundleEntry
+		status  int
+		index   string
+	}{
+		{"entry without request", []fhir.BundleEntry{{Resource: json.RawMessage(`{"resourceType":"Patient"}`)}},
+			http.StatusBadRequest, "Bundle.entry[0]"},
+		{"post without resource", []fhir.BundleEntry{bundleEntry(fhir.HTTPVerbPOST, "Patient", "", "")},
+			http.StatusBadRequest, "Bundle.entry[0]"},
+		{"wrong resource type", []fhir.BundleEntry{
+			bundleEntry(fhir.HTTPVerbGET, "Patient/p1", "", ""),
+			bundleEntry(fhir.HTTPVerbPOST, "Observation", "", `{"resourceType":"Patient"}`),
+		}, http.StatusBadRequest, "Bundle.entry[1]"},
+		{"absolute url of other server", []fhir.BundleEntry{bundleEntry(fhir.HTTPVerbGET, "http://other.org/fhir/Patient/p1", "", "")},
+			http.StatusBadRequest, "Bundle.entry[0]"},
+		{"reference to no entry", []fhir.BundleEntry{
+			bundleEntry(fhir.HTTPVerbPOST, "Observation", "", `{"resourceType":"Observation","status":"final","code":{},`+
+				`"subject":{"reference":"urn:uuid:9d5ab0a7-3b8e-4c07-a6a0-6a3f0f6cf0b4"}}`),
+		}, http.StatusBadRequest, "Bundle.entry[0]"},
+		{"unresolved conditional reference", []fhir.BundleEntry{
+			bundleEntry(fhir.HTTPVerbPOST, "Observation", "", `{"resourceType":"Observation","status":"final","code":{},`+
+				`"subject":{"reference":"Patient?family=Nobody"}}`),
+		}, http.StatusPreconditionFailed, "Bundle.entry[0]"},
+		{"same resource changed twice", []fhir.BundleEntry{
+			bundleEntry(fhir.HTTPVerbPUT, "Patient/p1", "", `{"resourceType":"Patient","id":"p1"}`),
+			bundleEntry(fhir.HTTPVerbPUT, "Patient/p1", "", `{"resourceType":"Patient","id":"p1"}`),
+		}, http.StatusBadRequest, "Bundle.entry[1]"},
+		{"update with other id", []fhir.BundleEntry{bundleEntry(fhir.HTTPVerbPUT, "Patient/p1", "", `{"resourceType":"Patient","id":"p2"}`)},
+			http.StatusBadRequest, "Bundle.entry[0]"},
+		{"read of missing resource", []fhir.BundleEntry{bundleEntry(fhir.HTTPVerbGET, "Patient/missing", "", "")},
+			http.StatusNotFound, "Bundle.entry[0]"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newMemory(t, MemoryOptions{})
+			processor := NewProcessor(m, testParser)
+			processor.BaseURL = "http://example.org/fhir"
+			_, err := processor.Process(context.Background(), fhir.Bundle{Type: fhir.BundleTypeTransaction, Entry: test.entries})
+			var e *Error
+			if !errors.As(err, &e) || e.StatusCode != test.status || e.Outcome.Issue[0].Expression[0] != test.index {
+				t.Errorf("err = %v, want %d at %s", err, test.status, test.index)
+			}
+			if versions, _ := m.History(context.Background(), "", "", time.Time{}); len(versions) != 0 {
+				t.Errorf("history has %d versions", len(versions))
+			}
+		})
+	}
+
+	if _, err := NewProcessor(newMemory(t, MemoryOptions{}), testParser).Process(context.Background(), fhir.Bundle{Type: fhir.BundleTypeCollection}); err == nil {
+		t.Error("processing a collection returned no error")
+	}
+}
+
+func TestBatch(t *testing.T) {
+	ctx := context.Background()
+	m := newMemory(t, MemoryOptions{})
+	if _, err := m.Create(ctx, patient("p1", "Doe")); err != nil {
+		t.Fatal(err)
+	}
+	repository := &recordingRepository{Repository: m}
+	processor := NewProcessor(repository, testParser)
+	processor.BaseURL = "http://example.org/fhir"
+
+	bundle := fhir.Bundle{Type: fhir.BundleTypeBatch, Entry: []fhir.BundleEntry{
+		bundleEntry(fhir.HTTPVerbGET, "http://example.org/fhir/Patient/p1", "", ""),
+		bundleEntry(fhir.HTTPVerbDELETE, "Patient/p1", "", ""),
+		bundleEntry(fhir.HTTPVerbGET, "Patient/p1", "", ""),
+		{Resource: json.RawMessage(`{"resourceType":"Patient"}`)},
+		bundleEntry(fhir.HTTPVerbPOST, "Patient", "", `{"resourceType":"Patient","name":[{"family":"Roe"}]}`),
+		bundleEntry(fhir.HTTPVerbPUT, "Patient/p2", "", `{"resourceType":"Patient","id":"p3"}`),
+		bundleEntry(fhir.HTTPVerbHEAD, "Patient?family=Roe", "", ""),
+	}}
+	response, err := processor.Process(ctx, bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Type != fhir.BundleTypeBatchResponse {
+		t.Errorf("type = %s", response.Type.Code())
+	}
+	// the entries run independently in their order
+	want := []string{"200 OK", "204 No Content", "410 Gone", "400 Bad Request", "201 Created", "400 Bad Request", "200 OK"}
+	if got := statuses(response); !reflect.DeepEqual(got, want) {
+		t.Errorf("statuses = %v, want %v", got, want)
+	}
+	wantCalls := []string{"DELETE Patient/p1", "POST Patient", "GET Patient"}
+	if !reflect.DeepEqual(repository.calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", repository.calls, wantCalls)
+	}
+	for _, i := range []int{2, 3, 5} {
+		outcome, err := fhir.UnmarshalOperationOutcome(response.Entry[i].Response.Outcome)
+		if err != nil || len(outcome.Issue) == 0 || response.Entry[i].Resource != nil {
+			t.Errorf("entry %d without outcome: %+v", i, response.Entry[i])
+		}
+	}
+	if response.Entry[6].Resource != nil {
+		t.Errorf("HEAD returned %s", response.Entry[6].Resource)
+	}
+	if ids := searchFamily(t, m, "Roe"); len(ids) != 1 {
+		t.Errorf("patients named Roe = %v", ids)
+	}
+}
+
+func TestServerTransaction(t *testing.T) {
+	m := newMemory(t, MemoryOptions{})
+	s := New(m, testParser)
+	s.Register("Patient", Resource{Interactions: allInteractions})
+	s.RegisterSystem(fhir.SystemRestfulInteractionTransaction)
+	s.Bundles = NewProcessor(m, testParser)
+	ts := httptest.NewServer(http.StripPrefix("/fhir", s))
+	t.Cleanup(ts.Close)
+
+	transaction := `{"resourceType":"Bundle","type":"transaction","entry":[` +
+		`{"request":{"method":"POST","url":"Patient"},"resource":{"resourceType":"Patient"}}]}`
+	res, body := do(t, ts, http.MethodPost, "", transaction)
+	if res.StatusCode != http.StatusOK || !strings.Contains(body, `"type":"transaction-response"`) ||
+		!strings.Contains(body, `"status":"201 Created"`) {
+		t.Errorf("transaction: %d %s", res.StatusCode, body)
+	}
+
+	// batches aren't registered
+	if res, body = do(t, ts, http.MethodPost, "", `{"resourceType":"Bundle","type":"batch"}`); res.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("batch: %d %s", res.StatusCode, body)
+	}
+	if res, body = do(t, ts, http.MethodPost, "", `{"resourceType":"Bundle","type":"collection"}`); res.StatusCode != http.StatusBadRequest {
+		t.Errorf("collection: %d %s", res.StatusCode, body)
+	}
+
+	failing := `{"resourceType":"Bundle","type":"transaction","entry":[` +
+		`{"request":{"method":"POST","url":"Patient"},"resource":{"resourceType":"Patient"}},` +
+		`{"request":{"method":"GET","url":"Patient/missing"}}]}`
+	if res, body = do(t, ts, http.MethodPost, "", failing); res.StatusCode != http.StatusNotFound ||
+		!strings.Contains(body, `"expression":["Bundle.entry[1]"]`) {
+		t.Errorf("failing transaction: %d %s", res.StatusCode, body)
+	}
+	if versions, _ := m.History(context.Background(), "", "", time.Time{}); len(versions) != 1 {
+		t.Errorf("history has %d versions", len(versions))
+	}
+}
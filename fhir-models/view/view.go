@@ -0,0 +1,469 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package view runs SQL on FHIR v2 view definitions (https://build.fhir.org/ig/FHIR/sql-on-fhir-v2/), which flatten
+// resources into rows of a table.
+//
+// A ViewDefinition is compiled once into a View, which turns each resource into its rows. The FHIRPath expressions of
+// a view may only use the functions the specification requires of shareable views. Rows are written to CSV with
+// CSVWriter or into a database/sql table with SQLWriter.
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/samply/golang-fhir-models/fhir-models/fhir"
+	"github.com/samply/golang-fhir-models/fhir-models/fhirpath"
+)
+
+// ViewDefinition describes the table a view produces from resources of one type.
+type ViewDefinition struct {
+	ResourceType string     `json:"resourceType"`
+	Url          *string    `json:"url,omitempty"`
+	Name         *string    `json:"name,omitempty"`
+	Title        *string    `json:"title,omitempty"`
+	Status       string     `json:"status"`
+	Description  *string    `json:"description,omitempty"`
+	Resource     string     `json:"resource"`
+	FhirVersion  []string   `json:"fhirVersion,omitempty"`
+	Constant     []Constant `json:"constant,omitempty"`
+	Select       []Select   `json:"select"`
+	Where        []Where    `json:"where,omitempty"`
+}
+
+// Constant is a value the expressions of a view refer to as %name.
+type Constant struct {
+	Name string
+	// FHIR type of the value, e.g. string, integer or boolean
+	Type string
+	// value as string, bool or json.Number
+	Value interface{}
+}
+
+// Select defines the columns and nested selections of the rows produced from its focus.
+type Select struct {
+	Column        []Column `json:"column,omitempty"`
+	Select        []Select `json:"select,omitempty"`
+	ForEach       *string  `json:"forEach,omitempty"`
+	ForEachOrNull *string  `json:"forEachOrNull,omitempty"`
+	UnionAll      []Select `json:"unionAll,omitempty"`
+}
+
+// Column is a column of the table and the expression of its value.
+type Column struct {
+	Path        string  `json:"path"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Collection  *bool   `json:"collection,omitempty"`
+	Type        *string `json:"type,omitempty"`
+	Tag         []Tag   `json:"tag,omitempty"`
+}
+
+// Tag carries additional information about a column, like the type to use in a database.
+type Tag struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Where is a criterion a resource has to meet to be part of the view.
+type Where struct {
+	Path        string  `json:"path"`
+	Description *string `json:"description,omitempty"`
+}
+
+// MarshalJSON writes the value as choice element value[x].
+func (c Constant) MarshalJSON() ([]byte, error) {
+	if c.Type == "" {
+		return nil, fmt.Errorf("constant %s without type", c.Name)
+	}
+	return json.Marshal(map[string]interface{}{
+		"name": c.Name,
+		"value" + strings.ToUpper(c.Type[:1]) + c.Type[1:]: c.Value,
+	})
+}
+
+// UnmarshalJSON reads the value from the choice element value[x].
+func (c *Constant) UnmarshalJSON(b []byte) error {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	*c = Constant{}
+	if err := json.Unmarshal(object["name"], &c.Name); err != nil {
+		return fmt.Errorf("constant without name")
+	}
+	for key, value := range object {
+		if !strings.HasPrefix(key, "value") || len(key) == len("value") {
+			continue
+		}
+		decoder := json.NewDecoder(strings.NewReader(string(value)))
+		decoder.UseNumber()
+		if err := decoder.Decode(&c.Value); err != nil {
+			return err
+		}
+		c.Type = strings.ToLower(key[5:6]) + key[6:]
+		return nil
+	}
+	return fmt.Errorf("constant %s without value", c.Name)
+}
+
+// shareableFunctions are the FHIRPath functions shareable views may use.
+var shareableFunctions = map[string]bool{
+	"where":           true,
+	"exists":          true,
+	"empty":           true,
+	"extension":       true,
+	"join":            true,
+	"ofType":          true,
+	"first":           true,
+	"not":             true,
+	"getResourceKey":  true,
+	"getReferenceKey": true,
+}
+
+var namePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// View is a compiled ViewDefinition.
+type View struct {
+	definition ViewDefinition
+	columns    []Column
+	where      []*fhirpath.Expression
+	selection  *selection
+	options    fhirpath.Options
+}
+
+type selection struct {
+	forEach  *fhirpath.Expression
+	orNull   bool
+	columns  []column
+	selects  []*selection
+	unionAll []*selection
+	width    int
+}
+
+type column struct {
+	Column
+	path *fhirpath.Expression
+}
+
+// Compile checks the definition and compiles its expressions.
+func Compile(definition ViewDefinition) (*View, error) {
+	if definition.ResourceType != "" && definition.ResourceType != "ViewDefinition" {
+		return nil, fmt.Errorf("expected a ViewDefinition but got %s", definition.ResourceType)
+	}
+	if definition.Resource == "" {
+		return nil, fmt.Errorf("missing resource type of the view")
+	}
+	if len(definition.Select) == 0 {
+		return nil, fmt.Errorf("the view has no select")
+	}
+	v := &View{definition: definition, options: fhirpath.Options{Variables: make(map[string]interface{})}}
+	for _, constant := range definition.Constant {
+		if !namePattern.MatchString(constant.Name) {
+			return nil, fmt.Errorf("invalid constant name %q", constant.Name)
+		}
+		v.options.Variables[constant.Name] = constant.Value
+	}
+	for i, where := range definition.Where {
+		e, err := v.compile(where.Path, fmt.Sprintf("where[%d]", i))
+		if err != nil {
+			return nil, err
+		}
+		v.where = append(v.where, e)
+	}
+	root, err := v.compileSelect(Select{Select: definition.Select}, "")
+	if err != nil {
+		return nil, err
+	}
+	v.selection = root
+	seen := make(map[string]bool)
+	for _, c := range v.columns {
+		if seen[c.Name] {
+			return nil, fmt.Errorf("duplicate column %s", c.Name)
+		}
+		seen[c.Name] = true
+	}
+	return v, nil
+}
+
+func (v *View) compile(expression, path string) (*fhirpath.Expression, error) {
+	e, err := fhirpath.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for _, name := range e.Functions() {
+		if !shareableFunctions[name] {
+			return nil, fmt.Errorf("%s: the function %s() is not supported in views", path, name)
+		}
+	}
+	return e, nil
+}
+
+// compileSelect compiles the selection and appends its columns to the columns of the view. Of the branches of a
+// unionAll only the columns of the first are appended, as all branches have to produce the same columns.
+func (v *View) compileSelect(s Select, path string) (*selection, error) {
+	result := &selection{}
+	var err error
+	if s.ForEach != nil && s.ForEachOrNull != nil {
+		return nil, fmt.Errorf("%s: forEach and forEachOrNull are exclusive", strings.TrimPrefix(path, "."))
+	}
+	if s.ForEach != nil {
+		result.forEach, err = v.compile(*s.ForEach, strings.TrimPrefix(path+".forEach", "."))
+	} else if s.ForEachOrNull != nil {
+		result.forEach, err = v.compile(*s.ForEachOrNull, strings.TrimPrefix(path+".forEachOrNull", "."))
+		result.orNull = true
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	start := len(v.columns)
+	for i, c := range s.Column {
+		columnPath := strings.TrimPrefix(fmt.Sprintf("%s.column[%d]", path, i), ".")
+		if !namePattern.MatchString(c.Name) {
+			return nil, fmt.Errorf("%s: invalid column name %q", columnPath, c.Name)
+		}
+		e, err := v.compile(c.Path, columnPath)
+		if err != nil {
+			return nil, err
+		}
+		result.columns = append(result.columns, column{Column: c, path: e})
+		v.columns = append(v.columns, c)
+	}
+	for i, nested := range s.Select {
+		n, err := v.compileSelect(nested, fmt.Sprintf("%s.select[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		result.selects = append(result.selects, n)
+	}
+	var unionColumns []Column
+	for i, branch := range s.UnionAll {
+		branchStart := len(v.columns)
+		n, err := v.compileSelect(branch, fmt.Sprintf("%s.unionAll[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		branchColumns := v.columns[branchStart:]
+		v.columns = v.columns[:branchStart]
+		if i == 0 {
+			unionColumns = append([]Column(nil), branchColumns...)
+		} else if !sameNames(unionColumns, branchColumns) {
+			return nil, fmt.Errorf("%s.unionAll[%d]: the branches of unionAll have different columns", strings.TrimPrefix(path, "."), i)
+		}
+		result.unionAll = append(result.unionAll, n)
+	}
+	v.columns = append(v.columns, unionColumns...)
+	result.width = len(v.columns) - start
+	return result, nil
+}
+
+func sameNames(a, b []Column) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name {
+			return false
+		}
+	}
+	return true
+}
+
+// Definition returns the definition the view was compiled from.
+func (v *View) Definition() ViewDefinition {
+	return v.definition
+}
+
+// Columns returns the columns of the rows in their order.
+func (v *View) Columns() []Column {
+	return v.columns
+}
+
+// Rows returns the rows of the resource, which is a generated resource or its generic JSON representation. Values are
+// nil, string, bool or json.Number, and []interface{} for collection columns. Resources of other types and those not
+// meeting the where criteria have no rows.
+func (v *View) Rows(resource interface{}) ([][]interface{}, error) {
+	value, err := generic(resource)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok || object["resourceType"] != v.definition.Resource {
+		return nil, nil
+	}
+	for i, where := range v.where {
+		result, err := where.EvaluateNodes(object, v.options)
+		if err != nil {
+			return nil, fmt.Errorf("where[%d]: %w", i, err)
+		}
+		if len(result) == 0 {
+			return nil, nil
+		}
+		b, ok := result[0].Value.(bool)
+		if len(result) > 1 || !ok {
+			return nil, fmt.Errorf("where[%d]: the path %s doesn't evaluate to a boolean", i, where)
+		}
+		if !b {
+			return nil, nil
+		}
+	}
+	return v.rows(v.selection, object)
+}
+
+// rows returns the cartesian product of the rows of the columns, the nested selections and the union of the unionAll
+// branches for each item of the focus.
+func (v *View) rows(s *selection, value interface{}) ([][]interface{}, error) {
+	foci := []interface{}{value}
+	if s.forEach != nil {
+		nodes, err := s.forEach.EvaluateNodes(value, v.options)
+		if err != nil {
+			return nil, err
+		}
+		if len(nodes) == 0 && s.orNull {
+			return [][]interface{}{make([]interface{}, s.width)}, nil
+		}
+		foci = foci[:0]
+		for _, n := range nodes {
+			foci = append(foci, n.Value)
+		}
+	}
+
+	var result [][]interface{}
+	for _, focus := range foci {
+		rows := [][]interface{}{nil}
+		if len(s.columns) > 0 {
+			row := make([]interface{}, len(s.columns))
+			for i, c := range s.columns {
+				value, err := v.columnValue(c, focus)
+				if err != nil {
+					return nil, err
+				}
+				row[i] = value
+			}
+			rows = product(rows, [][]interface{}{row})
+		}
+		for _, nested := range s.selects {
+			nestedRows, err := v.rows(nested, focus)
+			if err != nil {
+				return nil, err
+			}
+			rows = product(rows, nestedRows)
+		}
+		if len(s.unionAll) > 0 {
+			var union [][]interface{}
+			for _, branch := range s.unionAll {
+				branchRows, err := v.rows(branch, focus)
+				if err != nil {
+					return nil, err
+				}
+				union = append(union, branchRows...)
+			}
+			rows = product(rows, union)
+		}
+		result = append(result, rows...)
+	}
+	return result, nil
+}
+
+func (v *View) columnValue(c column, focus interface{}) (interface{}, error) {
+	nodes, err := c.path.EvaluateNodes(focus, v.options)
+	if err != nil {
+		return nil, fmt.Errorf("column %s: %w", c.Name, err)
+	}
+	if c.Collection != nil && *c.Collection {
+		values := make([]interface{}, len(nodes))
+		for i, n := range nodes {
+			values[i] = n.Value
+		}
+		return values, nil
+	}
+	switch len(nodes) {
+	case 0:
+		return nil, nil
+	case 1:
+		return nodes[0].Value, nil
+	default:
+		return nil, fmt.Errorf("column %s: the path %s returns %d values but the column isn't a collection", c.Name, c.path, len(nodes))
+	}
+}
+
+// product returns the concatenations of each row of a with each row of b.
+func product(a, b [][]interface{}) [][]interface{} {
+	result := make([][]interface{}, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			row := make([]interface{}, 0, len(x)+len(y))
+			result = append(result, append(append(row, x...), y...))
+		}
+	}
+	return result
+}
+
+func generic(resource interface{}) (interface{}, error) {
+	if object, ok := resource.(map[string]interface{}); ok {
+		return object, nil
+	}
+	return fhirpath.Decode(resource)
+}
+
+// Writer receives the rows of a view.
+type Writer interface {
+	WriteRow(row []interface{}) error
+}
+
+// Run writes the rows of the resources to w.
+func (v *View) Run(w Writer, resources ...interface{}) error {
+	for _, resource := range resources {
+		if err := v.write(w, resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunNDJSON writes the rows of the resources read from the NDJSON stream r, for example a file of a Bulk Data
+// export, to w. Errors report the line of the resource.
+func (v *View) RunNDJSON(r io.Reader, w Writer) error {
+	reader := fhir.NewNDJSONReader(r)
+	for {
+		raw, err := reader.NextRaw()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := v.write(w, raw); err != nil {
+			return &fhir.NDJSONError{Line: reader.Line(), Err: err}
+		}
+	}
+}
+
+func (v *View) write(w Writer, resource interface{}) error {
+	rows, err := v.Rows(resource)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package view
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testPatient = `{
+	"resourceType": "Patient",
+	"id": "p1",
+	"active": true,
+	"name": [
+		{"use": "official", "family": "Chalmers", "given": ["Peter", "James"]},
+		{"use": "usual", "given": ["Jim"]}
+	],
+	"telecom": [{"system": "phone", "value": "555"}, {"system": "email", "value": "p@example.org"}],
+	"address": [{"city": "PleasantVille"}]
+}`
+
+const testPatientWithoutName = `{"resourceType": "Patient", "id": "p2", "active": false}`
+
+func compileTest(t *testing.T, definition string) *View {
+	t.Helper()
+	var d ViewDefinition
+	if err := json.Unmarshal([]byte(definition), &d); err != nil {
+		t.Fatal(err)
+	}
+	v, err := Compile(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func decodeTest(t *testing.T, s string) map[string]interface{} {
+	t.Helper()
+	var value map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+func rowsTest(t *testing.T, v *View, resources ...string) [][]interface{} {
+	t.Helper()
+	var result [][]interface{}
+	for _, r := range resources {
+		rows, err := v.Rows(decodeTest(t, r))
+		if err != nil {
+			t.Fatal(err)
+		}
+		result = append(result, rows...)
+	}
+	return result
+}
+
+func TestRows(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       [][]interface{}
+	}{
+		{
+			name: "columns",
+			definition: `{"resource": "Patient", "select": [{"column": [
+				{"name": "id", "path": "id"},
+				{"name": "active", "path": "active"}
+			]}]}`,
+			want: [][]interface{}{{"p1", true}, {"p2", false}},
+		},
+		{
+			name: "forEach",
+			definition: `{"resource": "Patient", "select": [
+				{"column": [{"name": "id", "path": "id"}]},
+				{"forEach": "name", "column": [{"name": "use", "path": "use"}]}
+			]}`,
+			want: [][]interface{}{{"p1", "official"}, {"p1", "usual"}},
+		},
+		{
+			name: "forEachOrNull",
+			definition: `{"resource": "Patient", "select": [
+				{"column": [{"name": "id", "path": "id"}]},
+				{"forEachOrNull": "name", "column": [{"name": "family", "path": "family"}]}
+			]}`,
+			want: [][]interface{}{{"p1", "Chalmers"}, {"p1", nil}, {"p2", nil}},
+		},
+		{
+			name: "unionAll",
+			definition: `{"resource": "Patient", "select": [
+				{"column": [{"name": "id", "path": "id"}]},
+				{"unionAll": [
+					{"forEach": "telecom", "column": [{"name": "contact", "path": "value"}]},
+					{"forEach": "address", "column": [{"name": "contact", "path": "city"}]}
+				]}
+			]}`,
+			want: [][]interface{}{{"p1", "555"}, {"p1", "p@example.org"}, {"p1", "PleasantVille"}},
+		},
+		{
+			name: "where",
+			definition: `{"resource": "Patient", "where": [{"path": "active"}], "select": [
+				{"column": [{"name": "id", "path": "id"}]}
+			]}`,
+			want: [][]interface{}{{"p1"}},
+		},
+		{
+			name: "constant",
+			definition: `{"resource": "Patient", "constant": [{"name": "use", "valueCode": "usual"}], "select": [
+				{"column": [{"name": "id", "path": "id"}]},
+				{"forEach": "name.where(use = %use)", "column": [{"name": "given", "path": "given.first()"}]}
+			]}`,
+			want: [][]interface{}{{"p1", "Jim"}},
+		},
+		{
+			name: "collection",
+			definition: `{"resource": "Patient", "select": [
+				{"forEach": "name.where(use = 'official')", "column": [{"name": "given", "path": "given", "collection": true}]}
+			]}`,
+			want: [][]interface{}{{[]interface{}{"Peter", "James"}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := rowsTest(t, compileTest(t, test.definition), testPatient, testPatientWithoutName)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("rows = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRowsOfOtherResourceTypes(t *testing.T) {
+	v := compileTest(t, `{"resource": "Observation", "select": [{"column": [{"name": "id", "path": "id"}]}]}`)
+	if got := rowsTest(t, v, testPatient); len(got) != 0 {
+		t.Errorf("rows = %v, want none", got)
+	}
+}
+
+func TestRowsMultipleValuesInColumn(t *testing.T) {
+	v := compileTest(t, `{"resource": "Patient", "select": [{"column": [{"name": "given", "path": "name.given"}]}]}`)
+	if _, err := v.Rows(decodeTest(t, testPatient)); err == nil {
+		t.Error("expected an error for multiple values in a column that isn't a collection")
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		definition string
+		err        string
+	}{
+		{`{"resource": "Patient"}`, "no select"},
+		{`{"select": [{"column": [{"name": "id", "path": "id"}]}]}`, "missing resource type"},
+		{`{"resource": "Patient", "select": [{"column": [{"name": "id;", "path": "id"}]}]}`, "invalid column name"},
+		{`{"resource": "Patient", "select": [{"column": [{"name": "id", "path": "id"}, {"name": "id", "path": "active"}]}]}`, "duplicate column"},
+		{`{"resource": "Patient", "select": [{"column": [{"name": "n", "path": "name.count()"}]}]}`, "count() is not supported"},
+		{`{"resource": "Patient", "select": [{"forEach": "name", "forEachOrNull": "name"}]}`, "exclusive"},
+		{`{"resource": "Patient", "select": [{"unionAll": [
+			{"column": [{"name": "a", "path": "id"}]},
+			{"column": [{"name": "b", "path": "id"}]}
+		]}]}`, "different columns"},
+	}
+	for _, test := range tests {
+		var d ViewDefinition
+		if err := json.Unmarshal([]byte(test.definition), &d); err != nil {
+			t.Fatal(err)
+		}
+		_, err := Compile(d)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("Compile(%s) = %v, want error containing %q", test.definition, err, test.err)
+		}
+	}
+}
+
+func TestConstantJSON(t *testing.T) {
+	c := Constant{Name: "limit", Type: "integer", Value: json.Number("3")}
+	bs, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != `{"name":"limit","valueInteger":3}` {
+		t.Errorf("json = %s", bs)
+	}
+	var got Constant
+	if err := json.Unmarshal(bs, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, c) {
+		t.Errorf("constant = %+v, want %+v", got, c)
+	}
+}
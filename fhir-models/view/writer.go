@@ -0,0 +1,231 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package view
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CSVWriter writes rows as CSV with a header of the column names. Collections and complex values are written as JSON.
+type CSVWriter struct {
+	w *csv.Writer
+}
+
+// NewCSVWriter writes the header of the view to w and returns a writer of its rows.
+func NewCSVWriter(w io.Writer, view *View) (*CSVWriter, error) {
+	writer := &CSVWriter{w: csv.NewWriter(w)}
+	var header []string
+	for _, c := range view.Columns() {
+		header = append(header, c.Name)
+	}
+	if err := writer.w.Write(header); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// WriteRow writes a row. Rows are buffered until Flush is called.
+func (w *CSVWriter) WriteRow(row []interface{}) error {
+	record := make([]string, len(row))
+	for i, value := range row {
+		switch value := value.(type) {
+		case nil:
+		case string:
+			record[i] = value
+		case json.Number:
+			record[i] = value.String()
+		case bool:
+			record[i] = strconv.FormatBool(value)
+		default:
+			bs, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			record[i] = string(bs)
+		}
+	}
+	return w.w.Write(record)
+}
+
+// Flush writes the buffered rows.
+func (w *CSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// Execer executes SQL statements and is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// QuestionMarkPlaceholder returns the placeholders used by MySQL and SQLite.
+func QuestionMarkPlaceholder(int) string {
+	return "?"
+}
+
+// DollarPlaceholder returns the numbered placeholders used by PostgreSQL.
+func DollarPlaceholder(i int) string {
+	return "$" + strconv.Itoa(i)
+}
+
+var tablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// sqlTypePattern admits type names like VARCHAR(64) or NUMERIC(10, 2) but no quotes, comments or statement separators,
+// as the type of the ansi/type tag is part of the CREATE TABLE statement.
+var sqlTypePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9 (),]*$`)
+
+// SQLWriter inserts rows into a database table with a column for each column of the view. Values are passed as
+// string, bool, int64 for integer columns and float64 for other numbers. Decimals are passed as string to keep their
+// precision. Collections and complex values are passed as JSON text.
+type SQLWriter struct {
+	// Placeholder returns the placeholder of the i-th argument, starting at 1, and defaults to QuestionMarkPlaceholder
+	Placeholder func(i int) string
+
+	ctx     context.Context
+	db      Execer
+	table   string
+	columns []Column
+}
+
+// NewSQLWriter returns a writer of the rows of the view into the table, which has to be a plain identifier,
+// optionally qualified by a schema. The table and column names are quoted in statements and the values of the rows
+// are passed as arguments. The statements are executed with ctx.
+func NewSQLWriter(ctx context.Context, db Execer, table string, view *View) (*SQLWriter, error) {
+	if !tablePattern.MatchString(table) {
+		return nil, fmt.Errorf("invalid table name %q", table)
+	}
+	columns := view.Columns()
+	for _, c := range columns {
+		if _, err := sqlType(c); err != nil {
+			return nil, fmt.Errorf("column %s: %w", c.Name, err)
+		}
+	}
+	return &SQLWriter{ctx: ctx, db: db, table: quoteTable(table), columns: columns}, nil
+}
+
+// CreateTable creates the table of the view. Column types are derived from the FHIR types of the columns or their
+// tag ansi/type, and default to TEXT.
+func (w *SQLWriter) CreateTable() error {
+	var columns []string
+	for _, c := range w.columns {
+		t, err := sqlType(c)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", c.Name, err)
+		}
+		columns = append(columns, quoteIdentifier(c.Name)+" "+t)
+	}
+	_, err := w.db.ExecContext(w.ctx, "CREATE TABLE "+w.table+" ("+strings.Join(columns, ", ")+")")
+	return err
+}
+
+// WriteRow inserts the row.
+func (w *SQLWriter) WriteRow(row []interface{}) error {
+	if len(row) != len(w.columns) {
+		return fmt.Errorf("expected %d values but got %d", len(w.columns), len(row))
+	}
+	placeholder := w.Placeholder
+	if placeholder == nil {
+		placeholder = QuestionMarkPlaceholder
+	}
+	names := make([]string, len(w.columns))
+	placeholders := make([]string, len(w.columns))
+	args := make([]interface{}, len(w.columns))
+	for i, c := range w.columns {
+		names[i] = quoteIdentifier(c.Name)
+		placeholders[i] = placeholder(i + 1)
+		arg, err := sqlValue(c, row[i])
+		if err != nil {
+			return fmt.Errorf("column %s: %w", c.Name, err)
+		}
+		args[i] = arg
+	}
+	query := "INSERT INTO " + w.table + " (" + strings.Join(names, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ")"
+	_, err := w.db.ExecContext(w.ctx, query, args...)
+	return err
+}
+
+func columnType(c Column) string {
+	if c.Type == nil || c.Collection != nil && *c.Collection {
+		return ""
+	}
+	return *c.Type
+}
+
+func sqlType(c Column) (string, error) {
+	for _, tag := range c.Tag {
+		if tag.Name == "ansi/type" {
+			if !sqlTypePattern.MatchString(tag.Value) {
+				return "", fmt.Errorf("invalid ansi/type %q", tag.Value)
+			}
+			return tag.Value, nil
+		}
+	}
+	switch columnType(c) {
+	case "boolean":
+		return "BOOLEAN", nil
+	case "integer", "unsignedInt", "positiveInt", "integer64":
+		return "BIGINT", nil
+	case "decimal":
+		return "NUMERIC", nil
+	default:
+		return "TEXT", nil
+	}
+}
+
+// quoteIdentifier quotes the name as SQL identifier, doubling quotes within it.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// quoteTable quotes the table name and its schema separately.
+func quoteTable(table string) string {
+	parts := strings.Split(table, ".")
+	for i, part := range parts {
+		parts[i] = quoteIdentifier(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+func sqlValue(c Column, value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case nil, string, bool:
+		return value, nil
+	case json.Number:
+		switch columnType(c) {
+		case "decimal":
+			return value.String(), nil
+		case "integer", "unsignedInt", "positiveInt", "integer64":
+			return value.Int64()
+		}
+		if i, err := value.Int64(); err == nil {
+			return i, nil
+		}
+		return value.Float64()
+	default:
+		bs, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		return string(bs), nil
+	}
+}
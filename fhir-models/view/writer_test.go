@@ -0,0 +1,193 @@
+// Copyright 2019 - 2022 The Samply Community
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package view
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type statement struct {
+	query string
+	args  []interface{}
+}
+
+// recorder records the executed statements.
+type recorder struct {
+	statements []statement
+}
+
+func (r *recorder) ExecContext(_ context.Context, query string, args ...interface{}) (sql.Result, error) {
+	r.statements = append(r.statements, statement{query, args})
+	return nil, nil
+}
+
+const writerView = `{"resource": "Patient", "select": [
+	{"column": [
+		{"name": "id", "path": "id", "type": "id", "tag": [{"name": "ansi/type", "value": "VARCHAR(64)"}]},
+		{"name": "active", "path": "active", "type": "boolean"},
+		{"name": "births", "path": "multipleBirthInteger", "type": "integer"},
+		{"name": "weight", "path": "extension.value.value", "type": "decimal"},
+		{"name": "given", "path": "name.given", "collection": true}
+	]}
+]}`
+
+const writerPatient = `{
+	"resourceType": "Patient",
+	"id": "p1",
+	"active": true,
+	"multipleBirthInteger": 2,
+	"name": [{"given": ["Peter", "James"]}],
+	"extension": [{"url": "http://example.org/weight", "valueQuantity": {"value": 1.50}}]
+}`
+
+func TestCSVWriter(t *testing.T) {
+	v := compileTest(t, writerView)
+	var b strings.Builder
+	w, err := NewCSVWriter(&b, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Run(w, decodeTest(t, writerPatient), decodeTest(t, `{"resourceType": "Patient", "id": "p2"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	want := "id,active,births,weight,given\n" +
+		"p1,true,2,1.50,\"[\"\"Peter\"\",\"\"James\"\"]\"\n" +
+		"p2,,,,[]\n"
+	if b.String() != want {
+		t.Errorf("csv = %q, want %q", b.String(), want)
+	}
+}
+
+func TestRunNDJSON(t *testing.T) {
+	v := compileTest(t, `{"resource": "Patient", "select": [{"column": [{"name": "id", "path": "id"}]}]}`)
+	var b strings.Builder
+	w, err := NewCSVWriter(&b, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := `{"resourceType": "Patient", "id": "p1"}` + "\n" + `{"resourceType": "Patient", "id": "p2"}` + "\n"
+	if err := v.RunNDJSON(strings.NewReader(input), w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "id\np1\np2\n" {
+		t.Errorf("csv = %q", b.String())
+	}
+}
+
+func TestSQLWriter(t *testing.T) {
+	v := compileTest(t, writerView)
+	db := &recorder{}
+	w, err := NewSQLWriter(context.Background(), db, "fhir.patient", v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Placeholder = DollarPlaceholder
+	if err := w.CreateTable(); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Run(w, decodeTest(t, writerPatient)); err != nil {
+		t.Fatal(err)
+	}
+	want := []statement{
+		{query: `CREATE TABLE "fhir"."patient" ("id" VARCHAR(64), "active" BOOLEAN, "births" BIGINT, "weight" NUMERIC, "given" TEXT)`},
+		{
+			query: `INSERT INTO "fhir"."patient" ("id", "active", "births", "weight", "given") VALUES ($1, $2, $3, $4, $5)`,
+			args:  []interface{}{"p1", true, int64(2), "1.50", `["Peter","James"]`},
+		},
+	}
+	if !reflect.DeepEqual(db.statements, want) {
+		t.Errorf("statements = %#v, want %#v", db.statements, want)
+	}
+}
+
+func TestSQLWriterPassesValuesAsArguments(t *testing.T) {
+	v := compileTest(t, `{"resource": "Patient", "select": [{"column": [{"name": "id", "path": "id"}]}]}`)
+	db := &recorder{}
+	w, err := NewSQLWriter(context.Background(), db, "patient", v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostile := `x'); DROP TABLE patient; --`
+	if err := w.WriteRow([]interface{}{hostile}); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.statements[0]; strings.Contains(got.query, "DROP") || !reflect.DeepEqual(got.args, []interface{}{hostile}) {
+		t.Errorf("statement = %#v", got)
+	}
+}
+
+func TestSQLWriterRejectsHostileNames(t *testing.T) {
+	hostileTag := `{"resource": "Patient", "select": [{"column": [
+		{"name": "id", "path": "id", "tag": [{"name": "ansi/type", "value": "TEXT); DROP TABLE patient; --"}]}
+	]}]}`
+	if _, err := NewSQLWriter(context.Background(), &recorder{}, "patient", compileTest(t, hostileTag)); err == nil || !strings.Contains(err.Error(), "invalid ansi/type") {
+		t.Errorf("err = %v, want invalid ansi/type", err)
+	}
+
+	plain := compileTest(t, `{"resource": "Patient", "select": [{"column": [{"name": "id", "path": "id"}]}]}`)
+	for _, table := range []string{`patient; DROP TABLE patient`, `"patient"`, `a.b.c`, ``} {
+		if _, err := NewSQLWriter(context.Background(), &recorder{}, table, plain); err == nil {
+			t.Errorf("NewSQLWriter accepted table name %q", table)
+		}
+	}
+}
+
+func TestSQLWriterRowLength(t *testing.T) {
+	v := compileTest(t, `{"resource": "Patient", "select": [{"column": [{"name": "id", "path": "id"}]}]}`)
+	w, err := NewSQLWriter(context.Background(), &recorder{}, "patient", v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow([]interface{}{"a", "b"}); err == nil {
+		t.Error("expected an error for a row with too many values")
+	}
+}
+
+func TestSQLValue(t *testing.T) {
+	integer, decimal := "integer", "decimal"
+	tests := []struct {
+		column Column
+		value  interface{}
+		want   interface{}
+	}{
+		{Column{Type: &integer}, json.Number("3"), int64(3)},
+		{Column{Type: &decimal}, json.Number("3.10"), "3.10"},
+		{Column{}, json.Number("3"), int64(3)},
+		{Column{}, json.Number("3.5"), 3.5},
+		{Column{}, map[string]interface{}{"a": "b"}, `{"a":"b"}`},
+		{Column{}, nil, nil},
+	}
+	for _, test := range tests {
+		got, err := sqlValue(test.column, test.value)
+		if err != nil {
+			t.Errorf("sqlValue(%v) failed: %v", test.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("sqlValue(%v) = %#v, want %#v", test.value, got, test.want)
+		}
+	}
+}